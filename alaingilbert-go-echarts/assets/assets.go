@@ -0,0 +1,14 @@
+// Package assets embeds the echarts scripts referenced by the charts: echarts itself, its extensions,
+// the themes and the maps. Files are named like the JSAssets entries, e.g. "themes/macarons.js" or
+// "maps/china.js", so they are served or inlined without reaching the default AssetsHost.
+package assets
+
+import (
+	"embed"
+)
+
+// FS holds echarts.min.js, echarts-gl.min.js, echarts-liquidfill.min.js, echarts-wordcloud.min.js,
+// themes/<theme>.js for every types.Theme* and maps/<file>.js for every datasets.MapFileNames entry.
+//
+//go:embed *.js themes maps
+var FS embed.FS
@@ -0,0 +1,36 @@
+package assets
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/datasets"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssets(t *testing.T) {
+	for _, name := range []string{"echarts.min.js", "echarts-gl.min.js", "echarts-liquidfill.min.js", "echarts-wordcloud.min.js"} {
+		_, err := fs.Stat(FS, name)
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestThemes(t *testing.T) {
+	themes := []string{
+		types.ThemeChalk, types.ThemeEssos, types.ThemeInfographic, types.ThemeMacarons,
+		types.ThemePurplePassion, types.ThemeRoma, types.ThemeRomantic, types.ThemeShine,
+		types.ThemeVintage, types.ThemeWalden, types.ThemeWesteros, types.ThemeWonderland,
+	}
+	for _, theme := range themes {
+		_, err := fs.Stat(FS, "themes/"+theme+".js")
+		assert.NoError(t, err, theme)
+	}
+}
+
+func TestMaps(t *testing.T) {
+	for name, file := range datasets.MapFileNames {
+		_, err := fs.Stat(FS, "maps/"+file+".js")
+		assert.NoError(t, err, name)
+	}
+}
@@ -0,0 +1,16 @@
+// Package inline registers the assets embedded by the assets package for the charts rendered with
+// opts.Initialization.InlineAssets. Import it for its side effect, in the programs inlining the assets:
+//
+//	import _ "github.com/bygui86/go-csv-view/alaingilbert-go-echarts/assets/inline"
+//
+// The other programs don't import it and don't link the assets, about 16MB of scripts.
+package inline
+
+import (
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/assets"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/render"
+)
+
+func init() {
+	render.RegisterAssets(assets.FS)
+}
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("安庆",{type:"FeatureCollection",features:[{type:"Feature",id:"340802",properties:{name:"迎江区",cp:[117.09115,30.511548],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@I@BLBB@D@@BB@B@B@@B@@@@@@@@B@@BD@@@@BBBB@B@@@B@D@@@@@@@@@@FA@@@@BAF@D@B@B@B@D@@@BB@B@FB@A@@F@F@@BD@FLKDADAFAJ@BA@@B@FBB@B@@@D@B@@@B@BDDBDBB@DFB@D@D@B@@@B@B@D@B@@@B@B@B@D@B@B@B@F@DA@@BBB@BB@@@B@DAD@DA@@D@D@B@DBHBFBBFDJJDFFFBBLLDHDHHNJJ@@DDDBFFFNHJHFFH@D@BBB@DBL@HAHAT@@AV@DDDFHDJHNHTDHHLBBADIBIBIGEAMDAFDFDFD@FABBB@LFDBD@@@BA@@@AECAA@AFCD@PFD@BBB@@A@@B@BABABA@@@@@A@@AACC@@@A@A@@@@@AB@BABABA@@DE@CBA@AB@BADAAA@AAAGGAGACAI@G@G@EBEDKBEDILI@GBKFIJINIDCBCJGDEFEDEBABGDI@@BG@C@C@OAIAKEICIAEAC@KCK@E@AAAAMCIAKEEEICGEGEEGGGECCCCECIGGAAAMAMBOFGBKDGDEDIDIFKHEDGDEDWFC@E@MBK@C@M@O@K@C@@@I@OAC@C@A@E@A@I@A@C@E@A@C@A@C@A@A@"],encodeOffsets:[[119851,31227]]}},{type:"Feature",id:"340803",properties:{name:"大观区",cp:[117.013469,30.553697],childNum:1},geometry:{type:"Polygon",coordinates:["@@DKFK@CJWDI@@OCEACBEBABA@E@K@@B@B@F@FAFADOVGNENCLADCDABCB@D@BABABAD@DF@BB@@@L@D@J@B@@@@@@CB@@A@CACCEACACACACCAEAAE@AB@@GDCDEDABC@EBG@KBCAEAGECC@EAA@@EAE@C@AAICA@ACCEABIDGDEFCBCDA@EFCDELAD@JHFJJFJBBHHPFRBNBN@HBRDJDDDFDFDDDD@@@FDBBHDRFLF^LTD@B@BADIJBHJFXDBFAFEFEFBDHFFHHHBH@D@@AD@DDDFFBH@D@DD@JADDFLDHBFHAH@FDBD@DBF@D@FD@BB@CD@BBH@F@JGJGDAF@FDBDFGDEB@FE@BHCH@@@D@F@NHF@D@HDBBDHFDF@LFLJBCDI@ADCDCBEBQ@A@EBEDEHC@@@@BA@@JCJK@ABA@ABABC@@@@@@BA@BB@@@DBFD@CBED@@@@@B@@@DAB@DG@EAC@@@E@EB@A@@E@AAA@@C@A@A@A@C@E@AB@@@@EB@@@@@@@@@@@C@A@@@AAAAA@@@@AC@@@A@@@@@@A@@@@A@AAA@@@CAAAKA@A@C@C@@@A@A@C@OEOCMKGEGEKIEC@@QSAACCSO]O]ECAEAEC[UKNCFKBICGEAI@M@CBECGCG@CBODMBE"],encodeOffsets:[[119698,31117]]}},{type:"Feature",id:"340811",properties:{name:"宜秀区",cp:[116.987542,30.613332],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@C@A@A@@@A@C@C@A@CEA@CACAACA@@@A@C@@@A@A@EAA@@@ABI@EBCBCBKLCHA@CB@@A@@@@@C@AF@DECCA@@A@@AAB@@@@@@ADAB@BAB@BILID@@AB@@@@GDCFAF@F@BARAFCDCD@BCJADKIKEE@ECCGAAGCC@E@MGE@C@@@G@GDDBDDFDBBBB@D@N@DBBABADAB@DAB@@AB@D@B@H@B@BCTABEBA@AB@D@RAFFDHHBFBDDBJFB@ABCDCDCFCJABGFEAGACHAFBFBFJBDB@F@B@@DBB@@HBDD@DB@LBB@BJ@HBBD@FDFJJDBH@D@BFBDBB@F@BE@I@CBEBAD@FDH@@@B@@DHBBBDBBFD@@B@D@B@@BB@B@B@BBBDB@BD@BB@@BBB@BBB@F@B@BCF@D@B@@B@B@FCDCBABABAB@@AB@JBF@F@D@DBB@FAB@JDB@DDBB@B@@BB@DBB@BFJ@@@BDFDBH@ZCJArEHGHGJCHADEDCBCBKBEDCTOHEHCBCDEDCHABADAD@DAD@BC@C@C@A@@B@BAFABABA@AB@DACECEBENCFBJHJAJABCAAGKCGGSGMCIEGCC@CBU@@BSBG@GAK@CAA@A@CEGGEGIEMEECACC@@IIGMCGCGKKAAEECEIIECAAAEAG@C@A@C@CB@@CBC@C@A@@AAA@AA@@CBE@A@A@A@C@A@A@A@@@"],encodeOffsets:[[119916,31260]]}},{type:"Feature",id:"340822",properties:{name:"怀宁县",cp:[116.829475,30.733824],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@AAEEECECGAE@A@ABE@CBABGJEHCDEBI@GBA@A@@@EDIFSFIBALDFHBBBBF@FCNCFEDABA@ABELGTAH@H@JDDBDBFAF@BAFCFCFABAFLBFFB@@@DBDD@BBBBB@@BBD@TDJBD@B@D@DAB@DAB@JBTDFDNFHBHBD@L@D@HCFCDGFIDCBABAB@F@D@HBJDDBDBFA@@D@FEBC@ADGDAJGDADAD@D@THD@HB@DKDADAB@D@B@BBBBDBD@DAD@BDNBH@BBF@BB@NH@DBFFRBDBDBHDJ@L@FCDCDEFEB@D@B@B@BBDBBB@FH@BAHBF@DBBH@DBCJC@EAAACBCD@F@DAFG@EFCDEHCH@@@BADAFGNCF@DAJ@DCJEDABABCBCDEFAH@DB@@@@DD@LDHDB@HHFDPHNLHFFDFDHBDJDFPJLHB@NNDBF@DADBDHDFDDDBBFBH@DFHFJFHBFDHFJBD@B@DAJHFFDHHFDDBFDDHFLDFFFBBFFDFFF@BB@BH@FLL@DABA@GDAB@B@BBBBHDFFBDBF@DBHBFBL@B@D@DCJEB@B@D@D@F@F@D@B@@@BABABA@ADAF@DANCLAJ@B@DBDBBBD@D@FAFAHEBAD@H@FBLBFBLBNDBBD@BAB@FCBAD@DAB@B@DBB@B@DADABAFCB@F@DABABABCB@DEDABAHCDCDAJAB@DGBAD@D@D@L@D@DCBCBEDCBE@CCC@ABA@@B@DBJFB@H@D@B@DAFGFE@ADGLM@@@AFEDA@C@@ECAAACAACG@@@A@@CG@EBCFADAJ@F@@A@EAAACAEC@G@CAIICE@EACGAI@@AAA@KCAC@AC@GA@CA@@@A@ECAIAAEAEBEDGEACACBEDGD@@EBE@E@IECAIECE@C@C@CFCBADCDCDEBCHCJCLAJAF@F@B@F@BE@Q@CBAB@FABADS@A@A@G@A@CBA@@BA@CBABCBAAA@C@M@CAAAAECCCCA@AEFA@CFEHACECE@CBIHIHE@G@AAC@@DAAC@@E@CAE@CACECG@GBAECGEKCCIBC@@C@CAGEECC@CBC@@@CAGGGEGGEACFEFEBEAEWCIEAGJIBC@A@ASC]KKEQEGCAAEC@@C@CCECECCCICQCGAM@MAQAOEGGAAEIIIGE@IBCFKDCFEB@DCDAFEHCJCBAAACAC@A@E@CBGBGBCAGCBA@ABC@EBGBA@CDCDC@CAEEAC@C@@BCDIAACCCCAAACAEAE@EDIH@AEAEAAFCACGAAAC@@EEAAGAGAC@G@C@CBIFABC@IEEDA@EA@@CEBEFBB@DA@@@CCECECCCGACCAA@CAC@A@C@@B@B@FABGFCDCBA@EBCBABABAB@BCDA@AB@@@@A@A@AAKEC@A@CBCBAFCBCBA@E@C@E@CBA@CBA@GAEAIAIACACAAAGCC@G@EBEA@@"],encodeOffsets:[[119361,31094]]}},{type:"Feature",id:"340824",properties:{name:"潜山县",cp:[116.581371,30.631136],childNum:1},geometry:{type:"Polygon",coordinates:["@@EDIDKJCBCB@@ABI@KDCFAB@FIPKCI@A@@H@FBJ@@D@DABAB@ACAAB@DAB@BBDBDB@@B@B@B@@@ABAF@BBBDBFD@BFFFD@BBBBFBBBBFDHFFDLFJDH@DBFCB@DABAD@DBB@HDFBBBFHHCDAFAJAF@N@B@DB@BB@BJ@BBDB@DDFBAH@F@PABAB@BADB@@DDFBFFDHFDDFBDBB@JDHHJHDDB@BBFDDF@DBFBHBBDBBBBB@D@BB@BBH@JBLDF@FAB@D@B@DBDDBDJHDBNFRJHD@BBDDHDJCBI@IF@FGBG@CB@DCBCBAD@BADAF@DFBD@@DE@C@CD@DDDHBBDBBHBJDFBDD@BBB@N@FBBBDBBDFBNADGH@DDP@DGBAFHJBDFP@D@DABCBCDADBBBBHDFBLHZVDFDBRLBBLFDDBF@B@B@DLPDF@DAFAFJ^AD@B@DBDBDDBHDHBDHHFDAF@J@F@DBDDBDDDDBD@DDBBDDBB@BDLBFBDBDHFBBBBF@F@LAD@HCBCB@B@@@BBBDBHDBBDDBFBB@BDBB@BBBB@DBBBB@BB@HBBBDBBB@F@D@DAB@DEDABAF@DABALGJEDABABA@ABC@ABAD@B@FBFBDBHHBBB@BAD@B@F@F@DADADABADGBADCFALCLCH@B@B@DE@E@EAIAI@E@E@GDKBABAFBJ@B@BADADA@A@C@C@A@@AAA@A@@@BEBGDCBC@ABA@AAACCCEGICA@C@AAEBABC@A@CAECIEKCOAEIMEICCA@A@C@C@CCBG@AAGACEGGM@ABCDCHCBCB@BA@EBE@AFADADCFK@C@E@EBEDANGHEDABABGDO@CBABADA@@B@B@RBD@DADAD@D@FBF@@@B@LEDA@AFCFEBCBC@KEAGACAE@CAEACEAGAA@A@ABAHCB@BA@CKK@EAGA@@AEECEEEAAEECEEKCGECCAECGGECGEBI@C@AACEICGAEEGEIEG@CAGAECACCCECGCACBE@CAMMA@KGOICECIGAECECGEMKOGECGGA@GCKCC@@C@@A@@CBGFEDCDABABAFCDI@CBI@CDEHMBEBC@A@@DGFGDCFEH@BE@C@EDCDABBFBD@DICAG@AA@CAEBG@AEGA@AAAC@A@A@A@CFAFEDCDC@E@KCIAGACACEQAE@CMGA@@AAE@AAGCM@ABC@CACACAA@A@A@CBABCLC@CGAC@SGC@C@CBCBIHCBCH@BADEFC@@@EBCACAICGAC@E@A@ABABCDEJCHEDGDC@K@C@GAGAMEECSCIAA@CBA@CBC@A@C@IASCC@AA@@AAAA@ACCCA@@A@EEKAKBcF@@A@C@GBC@CDADAJAFABBFHPDDDBBDAHIFCDCHCHCJAJAL@F@BAJAV@NBDFHFDDD@DEFMJEDBD_NA@EBC@E@CBKFE@G@E@C@CD@D@H@FADMCGEEEICCCCAC@CBCBCBA@E@E@IDIBIBO@CDEJAH@@I@QAGBCFENALADADABAB"],encodeOffsets:[[119081,31364]]}},{type:"Feature",id:"340825",properties:{name:"太湖县",cp:[116.308795,30.45422],childNum:1},geometry:{type:"Polygon",coordinates:["@@EDOT]bIFODA@GBA@IBKFGFGHAF@LA^@XAJEHIJGFIDA@ABGJCDCHADAD@FCFABADCDCBCBEDABABAFCDEBKFMJCBAACC@AEECA@C@A@EAAAAAAC@C@EBE@E@@BAF@BCFKPCDEBC@ABCFCDGFCDAB@DAD@BADGFABCDADABAB@@@BBB@DBDCJCP@J@D@DDDDH@@CFEFKDCDGFCBABEBIDI@I@KBABAB@B@D@DADABABEDUHCBC@EAC@EAC@CBI@E@A@CCA@A@A@EBE@A@C@ACC@C@A@EBA@AD@B@J@FABABA@C@ICCAC@E@E@EAGKACAACAAAI@GBC@ABADKJA@AAEEAAAACC@CAAACAAAAEAA@C@C@A@GCA@A@ABCFELABEBEBGBABEBABAB@DABBD@D@B@DA@ADCDEFIHIBEBEBA@MBGBCB@@@B@D@BA@@@CBM@KBC@E@EAEAICCAA@KBM@I@MBQDOBEBEACBAB@@@DAD@HAFADCBE@EBCBCDEDEBE@CDAF@HBB@BBDHDBD@FADAFAD@JBDDHBHB@BFDJDD@DDFDDJHFFDDABC@E@C@EBEFCBAD@DCJADCH@@GDOFCB@FAHCJ@HMRADFLBFCDADCBCBKHG@E@EBADCHCJGDIBG@C@GBEBEBCCAC@EAEC@EDEHINENBD@BLL@FAJ@HAB@DCBADGFEBCBEF@F@FAFEDEDE@KHEDI@@JEJEFCBEDCD@FBBDDDB@DAHARBFBDBDDDDFHTNnDHFDJDJ@LBH@FADAFGFCDCF@D@FDHDFDF@D@HAB@NBDA@A@GAM@G@CCACEACDCNEHCDAAI@S@EDEDCFADCFEBAF@NAF@J@HBJD@K@G@GDCB@DBH@DBBCDAFBDBD@FA@@@@@@B@BAF@HDLFBFDBDBD@DCDAF@JBVFFDF@BABCFFBDHDFBDDHB@@@@B@@@B@BADG@CBIBKBCDC@E@EBAB@F@DFBDFFDDFBJBHALAN@JAHAH@HAD@FAFAH@DAD@FCDADAFAF@F@FBFBDBPFLDJ@J@HCFCDCDKAK@ABAR@HAF@H@RAFBDBPL@@BBRAR@H@HBFDLDJDLBHBD@DADEBA@CFM@CBEBCD@FBJ@@@B@J@LDJO@EBADELCJ@BA@@DADALIJCFCBABABCBCBKFMDEHARBJ@@@BGFIDCP@JAJAJCF@F@B@DADADAD@DBDDJDFFHFNDBC@E@G@CDCD@F@H@F@LEDAF@D@FAB@`MACFCNIFE@CCCECEGAC@MBUBI@A@EBKBIDIDGDGDCJEBGACCACCGOAEBABEBIBCDCD@HAD@B@@@dELABEBADEDEBE@ABEAEACCC@I@GBGHSFKBAB@BAFCDEDM@EAEAAGACEBKGCEEGGC@KBI@EAGGCCAC@GBIACCE@CBG@EAA@@OCKCCA@GAE@C@EAM@IHK@GBGB@HAHAFCBG@A@EHSDEFAJANCLGLIDEBC@AACAAKEGG@A@I@ACE@@ACAA@C@CHQJOFI@C@EAOGMCCAMEKCQGaEQC_MFEDOLORCF"],encodeOffsets:[[119235,30870]]}},{type:"Feature",id:"340826",properties:{name:"宿松县",cp:[116.129105,30.153746],childNum:1},geometry:{type:"Polygon",coordinates:["@@DCHEDCDEBAD@FADCLODE@ABE@AF@F@FAD@D@BBBBBB@F@B@DDBFF@BDDBBDANILEFADCBEBABAFCDADADCBCBADE@EBCBCDGDCHIBAB@JCHEJIFGBI@WB]@KBEHGHELEJAB@HAB@PCJE^aPSFCDEPQPKFCNERGRCTGVCBABA@ABCBCBCF@B@BAVULIBCDABAF@P@V@@OBCBCHCDCFCHM@EBCBABAJELEGMGQK_C_BS@CEcC[AKIe@AAKEIGSIG@@KGMEE@@@KAWAcBQ@O@MAKA[QKEKIICWKMGCAqU@@]KcMSIMEUOSOMMGGIGIEOKKEOISGOCCAGBG@ADCB@@GLCBADEHMVMPSNIDGDUBU@Q@I@KAOC`AF@LANBJ@F@N@FBFBF@F@FAD@FAH@BEHAD@DABCDGL_dCFGJINKNGJCDEFA@CBCHCF@H@FDFDDDDDJ@B@B@FAJ@TADAD@D@F@HFHFJBBHFBFBD@J@FAB@@CJGJCFABCFAJ@FBBDJFJ@F@H@@@@CH@HBF@D@@DFBDBFBH@FALAJALGFCBABGDCFCFCD@@CFGPAH@@AFABETAD@L@HBDBDFHFJDP@FCDCFADABCFCBA@EHGDCDI@CBA@GDE@ADCD@FCLCDGD@@OJABA@EBI@C@GDEFEJCF@DAD@JADALAJ@H@@AFAB@BEDEDEBIDA@CBA@IFEBIBC@EBIAEBC@SEEAGCGFIDGDID@@CHBDFFHFHF@BDD@N@DCDAB@BGHEFKHGJ@BEP@BKPEL@D@BFHFDLJDFDDH@JBDBJLDJFLFDHDF@DAHABAHDDDDHNAJ@N@LAB@DBJDFBFBF@D@LAN@DA@@B@@A@C@A@@DAHANAB@FAFAJAJGFEDCBCB@@C@A@CACBA@CBABAFABAHAFAFABAFKDEBAB@B@HDB@D@D@B@FBBBBBBDBB@DDDBBBBFFBBB@LIBCBAD@HAJ@BBDBBBBDHLFBF@F@D@DBJDD@B@BABA@E@I@ABCB@FAB@D@D@BDD@B@F@FAB@B@B@DDB@F@J@DAD@FBD@FBD@DAVGFCBABABC@C@C@ABABALAJ@J@JCFABADAHEDCLCFEDE@@CGCC@C@C@IDODIAC@CAA@A@@BABABCDCBAHEBC@ABC@CBA"],encodeOffsets:[[119052,31033]]}},{type:"Feature",id:"340827",properties:{name:"望江县",cp:[116.706498,30.128002],childNum:1},geometry:{type:"Polygon",coordinates:["@@IFABABAD@FGNEDCDGDADAD@PU@O@E@ABCBADKJUVABA@E@ADADAD@BABABUDSHQDQHD`FRHbDRFLBNDDHNBP@F@DEJIPGR@D@DBBBD@@DF@B@J@BHHLFBBBD@BADCFKJKHMDIBEBCFGT@F@BAHEDGBGBA@AH@HGL@JBN@F@DBF@HDBLDPD@@BB@FAH@DDFBDAJ@HBDDDHHFBJ@LAD@HHFFHDJATEJEFC@@B@B@HAJ@FADCFGHIBADAF@BAB@F@HBFDFDFFBB@@B@@@FBFAH@D@HDBBDBDBJBJBFBHBB@DAB@DAF@D@F@B@DADABEDADAB@D@LFBBB@B@@@@@BAB@DC@ABABABADAFAB@DADCHEBA@E@A@AD@B@D@DBB@DBBDDHDDDFDF@D@@CBA@EAAFDF@@FBB@FCJFD@BAJEDAD@H@D@HBHBBBFF@@BDBBDHDBBEFBFB@BJGFCF@FBDBBBDBDDBDJBDC@AD@D@FBBF@DCDCD@DABAH@FAD@BABHDDBHAHADAF@B@D@DBBBDFBDB@JDBBD@F@FB@@BB@FDDHFFBDBLAH@FAD@BAFCDCHC@@BAF@BBBFDDDBDBDBFBDDDBB@@@DA@@@@@@@A@I@C@K@@AAE@@CBCBABA@A@CDABADCBCDKFMHMPUBCBE@E@E@A@AL@F@B@BAFADAFBPD@CBCBGBCH]BEB@FMDGBCDEBCHSBEBCBKBAAMASACAC@CACGOGEMGAAECKEMIAASQ]cEYCM@AEW@GACCEAC@@AC@@KSMWGIMQQUKOECEE]YEEAAWUGGIIAAGCKGKGOKYEC@MAKBKDKFMHC@WNCBWHODIBSAI@G@eEGASCMGOMS[AAKF"],encodeOffsets:[[119378,30770]]}},{type:"Feature",id:"340828",properties:{name:"岳西县",cp:[116.359692,30.849762],childNum:1},geometry:{type:"Polygon",coordinates:["@@CDC@CACAAEKEGCE@ABA@@@@@@@EBC@CAEACBADCAG@CAA@CD@H@H@LICGAI@E@MBE@ABEFCDEBCDCF@F@TBJCBGDMFCDBDDFDB@D@HBN@H@BCBBHAFBDDDLDHDJFBFDD@DCDA@GAIDABAD@FDDLNBHEHADBF@JEDIHGFCDADCH@FBFBFFFJLFDDBDD@B@DBDBHFDHBHDDDHHFFLDPFNBDDFBDBF@D@BAD@J@HDDBB@FT@DBDDBFAHDNJBBDDPHJDFDDD@JDDDDHDHBH@FBFDDDDFFDFBFDHFFBJBHBFBFAF@HCDDDFBDDBH@H@HAH@N@PA@DAHAH@FCFAHCDAJANAFCD@D@JAFAHBD@FA@A@I@KB@JBNBJ@HFH@HAJBDNTFHJNHDPFNFHDHFBBBB@D@BAF@@BBD@DBBBDBBBB@DADCDADAFAFABABADBB@FBFDLDFBJBPDRFF@LCHCFCFEFEDGBGAEAC@CBCFKJGJCNAF@J@BBF@B@JBHDJDHFFFTLRFFBBBJDBBBDDDBD@D@@@F@@BBBD@@BB@BABB@@@J@@D@@@B@BD@B@BBDBAB@B@BD@BABBBBFBBB@B@B@@@@B@D@D@BBABABBBD@@@B@B@BBAB@@HB@@@BFD@@DHBB@BBBAF@@@B@BBD@BBBDBHFHDJBB@D@D@DABBFBB@DBBBBBDB@@BBBBBBBBBB@D@D@B@D@BBD@@HBBBB@DBBBDDFF@@B@DAHAJENGB@J@H@@@JED@BABBB@D@BBF@J@D@D@B@DA@ADA@ADA@ABA@@BAHEFGDCDEBEBE@KBCFCDADAF@D@DAFAD@F@DBB@B@BFDFDBDDFBDBH@F@FBJDHHDFBDBBB@@@B@DE@CDABCB@B@F@B@@BB@BDDHDHDDDFFH@@DBB@DB@BDDBBBD@@BBFDHDB@BB@BBD@B@@@BBBDBBB@@B@B@B@DAFAD@@AB@BABC@ABA@AAA@AAAACAA@A@AAGBC@C@CBCBAB@BEBC@A@A@@AC@A@@@C@@@C@G@ADE@A@@@C@ACCAC@A@A@ADABABADEBA@C@AACACCEAAEGKKAA@CACACAECCCCCIAE@CACBA@A@A@CAACCACAC@@@CBC@A@C@C@A@C@@@AACACAEAA@ABC@ABA@AAAAG@C@CGECGGAGCCAACAC@C@ABCI]BEBE@CCEKO@C@A@AAECCKEAAQKCACEYUKGEAGCAAAABCDCDABA@C@CEOACGIBEHA@CCO@CHGBCAMCEAAACAA@E@MAA@ACCEAICGAAAACGACC@CDCD@F@@CC@EA@CBEBC@ABCDADA@CDAH@HA@EJEJ@DACICGAC@AGCQIMECAIGACCCCAA@C@A@EBE@KCIAG@AAA@@A@CAAAACAAAAGAE@CCEECAAA@CCIGGGICA@CAEACCGEECAECE@CA@BC@ABABA@O@EBGEACCA@AC@AAIA@@ACAA@M@E@IBEBCBGDEGAAEAGCA@CAC@ABCBA@EDCAG@ICKEECGEECAAAAAEAA@AECEE@AECCAAA@ABEBA@@A@A@A@@@CACAAAA@CBA@BBBDA@ABCBC@@@AI@E@G@@I@EAC@ADAF@DEN@DABCFCBC@GAKAICKCECGAG@Q@QBAA@@OKCAEAQBG@E@GBQ@AB@BBLCLCDEDGDI@I@KCOECAEAEAE@E@EBCBCBEDC@CBG@EBEBC@GBG@GBIBM@KBGBIAEACCEEACCEE@A@AB@F@FCDADALAJ@DCHABA@@@A@@@@@GACCEAGCACEEADABE@ECUEIAE@CB"],encodeOffsets:[[118730,31425]]}},{type:"Feature",id:"340881",properties:{name:"桐城市",cp:[116.936748,31.035848],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@AF@H@@@D@B@FDB@B@B@BAB@BAD@@ABCI@KAMESKCBABA@@BAB@DCF@@ABABABA@@BBAF@B@B@B@BBJ@@@BBDB@@@@B@@A"],["@@@CDE@A@A@EAA@AAA@AA@@AACA@ACAAA@A@A@@AA@C@A@@@@@@DCBEF@B@@KNCH@BEFEHCBA@C@G@A@IECAA@@@AB@BDD@DAFCDAFADCDC@K@C@C@C@ABCHA@IBCBCDGDABCBCFA@ADABABCBE@A@EDABCBCBA@A@CAA@A@CBC@ABEDA@ABC@AAMCKAEAKAEAG@C@ABGFEBEBC@C@AACACAA@I@KBMDCBE@CB@BABABAB@@A@C@E@E@C@C@A@A@IFCDC@A@K@@LADADEFED@BCBKFA@@@E@EAC@C@CBCBC@QAA@A@@@CBABAB@DCPAHABCBGFMHCBAF@F@F@DELCDCBEB@BAF@FABA@ADGDCDAD@BHNFHBDBH@BAHDDD@D@B@B@DDFJJNBFDPFLDJBF@D@BADABBF@B@DDBHJDFDDBB@BAB@BADCDAHAF@@B@B@BB@@@B@D@D@BCBCBABA@I@EAABABCL@H@F@FBJBJ@F@FCFFDHNHLDBBBJBH@JFDA@@DA@ADAF@J@DBLJBB@BB@B@B@F@DBDBHBB@DAD@D@DADADCBA@@B@B@DBHDFHBFBBHDB@BBBH@@BBB@BBF@DBD@FHFHDFFFFDDBBAD@HBFBBBB@@FAJ@D@BBDBFBFDDHBJBDBHFH@JCLANBPFRLLHJHFDFDFDBHBF@DB@FBLAP@D@DBBBBLAFBLBH@BBDHFFFDHDHBJBBBHDH@BBFDHFLFJFJDFBBDFDHNJJFLHJRFFF@D@D@FAHEHCRKH@DADADCHQDGFCDCD@D@B@F@LBD@F@DADAFM@C@ABGPW@@@ADENSHIFC@A@EBE@@@ABA@AFEDG@E@AHMBAFI@CDEFCBE@EAAACAAAA@A@E@ABCBA@A@ACAACCA@EBE@CBADC@CBEBM@ACEAA@AAGAM@ANIHCPIDAF@HBD@DBF@D@FACGEMCGCACAAAAA@Q@I@AECCCACAIDIBIBC@ECEGGIA@@@@E@E@OHEDCBGBQAIEACBKB[AEECGGIIAGAG@KDYBKBIDSHaDKHiJsD]BED]Ag@cEeAIqFIBYDG@CACE@A@@EI@AAA@CAA@@@AAACCA@ICA@EBA@CAC@E@E@IAA@@BA@ABABABCDEDA@A@@@@A"]],encodeOffsets:[[[120034,31431]],[[119815,31497]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
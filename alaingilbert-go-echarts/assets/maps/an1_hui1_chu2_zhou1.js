@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("滁州",{type:"FeatureCollection",features:[{type:"Feature",id:"341102",properties:{name:"琅琊区",cp:[118.305961,32.294631],childNum:1},geometry:{type:"Polygon",coordinates:["@@EA@CVB@BJANCDADDBBBAJFDEHFPQDCAA@@JKFGFCAA@@CA@@@AABC@CBCDA@@BAAACC@AACHCFCD@DCA@@A@A@@@A@@@@@A@@A@@A@@@@@A@@@A@@@@@A@@@AA@@A@GAC@A@AAA@A@@@A@@@AAA@C@@@@@A@@@@@AA@@@@A@@@A@@@@@A@@AA@@@A@@@A@@@@@AA@@@A@@@@@A@@A@@A@@@@@A@@@@@AAA@AA@C@A@A@C@A@@@A@A@@@A@@@A@@@A@@@A@C@AB@@BDBHBBFDHF@BBDBB@B@B@B@@BB@@@DABBBBB@BABFB@@@SJKFGJSAiCMKE@E@cBWNANDXNXR\\PrDNBHDTR@X@V@L@HBFEN@D@^EF@X@JFCBM@CPBJAFABALIF@JGFEHIHDDDBD@FAJCPBLBDBBHB@B@BB@B@DDBBBF@DFA@BBF@ADDB@BBDJAH@J@JCFAFAFCFEFCDCDE@C@C@EAC@G@GBCD@@ABAB@DAF@DBHBDDD@D@DAFCDCBE@CACECCACEBADCFCFCF@FADCDCDI@C@AHE@@BAD@FAF@DBFAHEHAHCHCFEFCBCDCDCFGDC@CCCGBGBEBE@CACAEC@E@C@A@ADCDCDCBEDEDEDEBCBE@AG@OBSDO@MBKBCBBCACACECCEAAEE@@@AAAAA@A@@@@@ABAB@BB@@B@BA@A@AB@@A@A@AA@BAAC@AAA@@@@@A@@DC@ABA@AB@FEFC@AD@@@@@@@AAAAA@EAA@A@A@AB@@CFAB@B@@@BCBABA@A@@@YXEBECCDI@EFAJGNC@CEGC@@GCKI@@@@EG@@A@EG@@@@KE"],encodeOffsets:[[121151,33059]]}},{type:"Feature",id:"341103",properties:{name:"南谯区",cp:[118.41697,32.200197],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@ABAAA@@@AA@@@AAA@AA@@AACAAC@AAA@@A@A@@@A@@@A@C@@@CAAA@@AA@AA@@@A@A@@BA@A@@AA@@@@A@A@@AA@@@@A@C@AAA@AAA@AA@AA@@A@AA@@@A@AB@BA@@@A@@@@@@A@A@A@@AA@@A@G@C@A@EAAB@@@B@@A@AFADCDGJEHABAFCBCBADABCHGHCFA@ED@BA@@BAB@@A@@@AB@B@@@BB@@BCDADADCF@D@DFDDF@HAD@BEFADGFCBCDEBEBC@GDAH@JDFDFFD@DCDCBE@C@E@E@C@E@C@EBC@EBEBE@CBE@GDCBGBG@EAG@C@E@G@E@E@ABG@EBI@C@E@C@GAEAE@E@G@GAE@EBEBEBCBCDGBEDCBABAFEFCDCHA@CHADE@CAEECEACACCE@AAAACEACAEECECCG@EBEBCBGFEFEDIFCAEAEAGBEBEBEBE@G@C@GAE@IBAFCDADCDCBEDEBC@E@KAI@E@EAG@E@EBIBC@GBEBIBEBC@EAE@CAG@E@EBEBC@CBEBCBEDABEBCBEBEBIBCBG@CBE@E@C@E@GAC@G@G@C@E@C@GAC@EAGAEAECCCCCACCCAGCEAEAEACAG@EBAFGDC@CCCCAE@EAEAC@CAIAC@CBGBCBCDEBEBCBIDC@C@C@E@EAGAC@GAECG@ADCFCBAD@B@BBD@DABE@ABEDCDCDGDEBC@IDDD@DDDFDBBDDHDH@F@F@D@F@D@DBDBHBH@FADBBD@DAFAD@FADBH@JBF@HBFBFBFBF@DBFBDBDDDF@FBFAFBFBBDDFBBFFHFHFFDDDDFDBBHDFBH@H@FBFBF@FCFBFBFBDDFDFFFFFFBFFJDD@JCF@J@D@H@DDHDDDDDDD@FDFDDBDBDHBDFDDBDDDDDFDDBDDDDFBFDFBDBFDHDFDDDDDFFDD@F@F@F@F@F@F@H@HAFBF@D@FBDBJ@DDBJBDAF@FAHAH@HAHAD@H@F@F@H@J@F@JBFBDDFD@B@@@@BBBBB@BAB@B@B@BA@@BAB@@@@BB@B@B@FAD@B@BB@@BB@@D@@@BA@A@@B@@BB@B@B@@@DDB@@@B@@C@@BA@@BB@@@@BBA@@DB@@@@@B@BC@@@@@A@AAAA@@A@@@@BA@AB@@AB@@B@@@BBB@@B@@@@ABA@@B@@@B@B@D@@@B@B@B@B@@@@A@@@@AA@ABA@@@@B@@@B@@@BBBBD@B@@A@@@@@A@@@AB@BAB@@@DHDDFBDBD@FCBAFCDCJCH@FAFCDCFE@ADCBCBCBCFAFAF@FBFBDBHDD@DDHDDDDDBFBDFFJBDBDCBADADEBA@@BD@DBBBBABCD@@AB@D@DBDBB@D@B@D@B@BBD@B@@CBA@@@@B@B@@@@@BBDDBF@D@H@H@@@@B@@DBBBB@@B@@@@@BA@@BA@AA@@A@A@@BABB@@DBB@@BBDABBBDDHDHBJBH@D@FBF@JBFDLDHDHDFDHFJFHDFFJDFBDFJFHDFDB@@BDDHBDBFDDHFDDBCDGDCD@FADADADADAN@N@BC@CAE@CEE@CAE@CAE@AAAEEAAGGAACAACAACCCCCCCCCGA@@EBC@EDGBCBE@CAECCAACECGAEACACACBEDEFC@ADAFEBEFE@CBE@C@CCGACECEBEDEFCDA@@@@@A@@@A@A@@@A@@A@A@@@AA@A@@A@@@@@A@A@@@@@@AA@@@AB@@@AABABAB@@A@@@@@AAA@A@@@AA@@@AAB@AA@@AB@AA@@AAAAA@A@@@AA@@@@AA@A@@@@A@@A@@A@@@A@@AABA@A@@@A@A@@@A@AA@@AA@A@@A@@AB@@AEAC@ACABCE@AAB@CEE@AACA@C@AAAA@A@AGCAKAOAIDEBC@CACCJGFGHE@IJEBKBABEAIDON@DAIEW@E@]FC@M@EFGAK@U@W@Q@CSAGCMOqQ[MWCWBMXMdAF@F@NLjDTBHILETI@@@EABA@AAAAABA@C@@AA@@@A@A@AAAAC@AGEECAAAGAC@@BAD@B@@@B@@@B@@@B@@@B@B@@@B@D@B@B@D@B@@BBB@B@@@@@B@@@@@BB@@@@B@@@@@B@@BB@@@@B@@@B@@@B@@BB@@@@@B@@@B@@@@@BB@@@@B@@@@@D@B@BB@@B@@@B@B@BBB@D@HBB@@@BB@@B@@@@@B@@@B@@@@@B@@@@BB@@@@@B@@@B@B@@@DB@CDCDEDGBBD@BDBB@AB@DCDAD@BA@B@@DB@@BBEDEHIL@@BBCDORGECFIEABAACCCBMDIB@AUA@DFBLF@@@@FHB@@@FH@@@@LJHD@@HDDFD@HMBIFEJ@DCFDFAZW@@B@B@BADA@A@@@ABADE@@BAB@B@B@FBB@BBBB@@@@@@C@@BEDEFA@@BAB@BCD@@@B@@@@BB@BBDABB@@B@B@BA@@B@BABA@@@AAA@AB@B@@@@@BBBBB@B@@FFBBDFFDBDBDADDALANAP@TCPAH@@@@AACCCCEAEAEBE@E@G@EAEAEAECCCCCCE@CBEBEBG@IACI@C@EBA@@BAB@@BB@@BBBB@BBBABABA@AAC@A@A@AB@BAB@H@@@BAFGB@@@B@DBD@B@BA@A@@@A@A@CAAA@C@C@A@A@@A@E@A@A@AAAAA@@BA@AB@DAHA@@BAB@@EBABAFGBA@C@ABCBAB@BALAB@B@@AFIB@B@B@@@B@BD@BBBB@@@HADAB@B@@B@D@BB@@BB@B@BA@@BA@A@@CE@@AA@ABA@@BAB@@@BBB@BB@BB@BBB@B@BAB@@A@A@A@A@AB@BA@@D@@BB@D@DAD@BAD@B@B@B@@@B@@@B@F@BAF@B@B@DA@@D@F@BAFAA@@@@@AA@@@@@@@A@@@@@A@@AA@@@@@A@@@@@A@@@A@@@AB@@@@A@@@A@@AA@@@@AA@@@@A@AACAC@A@CAAAAA@@@A@CBCAMBCBA@AB@@ANc@@@ABA@@@A@@B@@@@@@A@@@@@A@@@@@@A@CBABCBABA@@AAA@AAA@AB@@ABAAA@@@AA@C@A@@A@A@@DE@CBABA@@DC@@@A@AA@@@@@C@ABA@A@C@EACAC@AAAAA@AAA@EBA@A@CAAA@A"],encodeOffsets:[[121317,32886]]}},{type:"Feature",id:"341124",properties:{name:"全椒县",cp:[118.274149,32.08593],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACBA@ABC@AACCC@A@A@G@G@CAAE@A@EAECA@@@GBAFCJEHGCKCQ@GBABOFEBA@CBABA@CB@@GBABA@ABKD@@EBGDC@ABA@CBA@ABEBABC@@@WXCHABABC@C@IFG@IBA@ABCDCFAB@BgTABA@ABU@A@A@EDQHCBA@A@IAAAA@C@AB@AA@A@@@A@@@CBAB@@A@@@@A@@@@A@@@AB@@AB@@AA@@@@@A@@ABA@CA@AA@@BA@@B@@A@@@A@@@A@A@@@@DB@@@B@@@@B@@@@A@CB@@@@BB@@BA@@B@@@@B@B@BAD@@DB@@B@@@@B@@@BA@@CA@@@@@ABBBA@@@CAA@@@AB@@BB@@B@@B@@@@@@@B@@B@BB@@AD@BA@@@A@A@@@A@@B@@B@@BAB@@@B@@@BB@B@@@@@@B@@@BEBA@@@A@@@A@AAA@AB@@@@@BA@@@A@ABAB@B@B@B@@@@ABAAA@A@@BC@A@@@@B@@CJAB@@A@ABA@@@@BBB@@BAB@@@@B@@B@@B@@@B@@@B@@A@AB@B@@@@@AA@A@@@AB@BC@AB@@ABAD@@@B@@B@@B@@@B@B@BA@@BA@@B@@A@@BABAFAB@@KDKBA@CDGF@@DBDDBBB@BBBBB@DDDBDBFDD@DBDD@DBD@DADAF@D@D@DBDDDBBFDDBHBJBFBB@FBHBDBB@F@B@BB@B@B@B@@@@@@DDHDBBDFDBDDFDCDGBC@EBEFADABADAB@B@B@B@BAF@DCFCDGFCB@FAB@FBD@JCDADADAFBDDJDDFFDDDDDDHFFHBD@DCHAFBHAF@DAFAF@DAF@D@F@FAF@DAD@HAFAFH@FDHBD@HBFBF@D@D@D@JCDAFAFADCDAHADAD@JBDBD@FBFBF@DBDD@DCDEHAB@FBHBDBFBFDFBHDDBDDDDDFDFBHBFBD@HBD@F@D@H@H@D@HBF@D@F@F@DAH@DAJAFAFADAFABAFCDAFADAD@FAFAF@H@DBF@FBD@FAJAFAHAD@JAFAF@H@FBF@J@LBF@D@FAFCDADCBCDCBEJAF@HBD@H@F@FAFAFAHAFBFBDBJEFCFEHEDAFAFAH@DDDFFFDBFBBDBB@BDFBDBDDFFFDBF@BCDGB@DGDCFEBEBADAFCHADCDAFAFAFAF@HBH@F@F@FBHBD@F@D@J@FAH@BAF@F@H@F@D@H@FBH@HADAHCF@DAF@FAFAD@FAD@F@D@F@F@D@F@DADC@CECCECE@IBGHCD@FAFADCDAHEBCFE@ABC@GCEEC@C@CDEBCBCDC@AA@@A@@@ABA@@B@@@BA@AB@@AFCB@DEHGDGBABCDADABEBAFGHIDCBCBEB@@@@A@@BAC@@@A@A@ABAB@BA@@A@@EK@@AA@@A@C@@@A@A@A@@@A@@@@@@@@A@@@C@@@A@A@@@AA@@@A@@BABABA@A@C@A@AA@@@A@A@@BABA@@@A@@@@@AAA@A@@@A@CA@@AAAAAAAA@A@@@@@AB@@A@@@A@CC@@@@@A@A@@@A@@@@@@A@AAA@A@AAC@EAAAA@AAC@@CCACAA@ECEACAEKCAAAEC]GIGAAMGCAECAOKEIW@@@K@E@E]KGE]SA@USGEaUSSUQUFI@ACKASKIWGGECGCGBKF]JICIEI@WDICQ]IECAGACCICCAAC"],encodeOffsets:[[120921,32643]]}},{type:"Feature",id:"341125",properties:{name:"定远县",cp:[117.698562,32.530981],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABA@EBEDCBEDEB@BEBBBB@@FE@C@AAABCBCDCBADCBEDCBEDGDCBEDEBGBE@EBGBEDCBEFAD@FAB@@@@@B@F@F@D@DAFGDCB@FADK@CCA@GBAFCFCDADCFAD@@@DBFBDBDDFDHDFBDBH@BBBDJDFBDHHFHDHDFFFDBDBNBJBFD@H@H@JCNAHIDG@EBGBE@GBE@A@CBEBIDCBEFADEDCFEDEAEAEAGAEAE@GAE@GBGDBDDDDDFDHBDDFBDBBDDFBFBH@DBFAF@H@D@B@D@D@FBDBDDH@BBFFHDFDFFFDBFFFFFFBD@BEBCDCDADGDEBCAECAAEICECCEAECCACCEAGCEAC@CAEAGCGCGAECGAGCE@CAE@EBC@CDCDEDEDCBC@CAIEECECEAE@C@C@A@A@@BCBEBCBKFCDC@CBIFC@ABCBA@CBEBEBA@EBA@EBC@C@C@E@G@C@A@CBA@A@@@ABA@@BCFABABAB@@AB@DDD@FAHAV@D@FBP@BBB@F@F@DCDCDEHADADADAH@@BFBFBFDBFDFBBDDFBD@B@HAD@BKJABAB@BABABKRAHAD@F@P@VADADCH@@@BADF@DDHBF@H@PBTJXBRAL@J@L@B@D@F@HBFBF@D@D@HBFBHBD@@@B@D@JFFBHDDB@@FBPJVJB@FBLDRDRF@@HBBBGNABBDLDF@BKD@@@F@D@FAHGFCBCFCDADCDAFAH@F@DBDBHDHRFHLDH@NKDAJODGFEB@D@F@D@D@D@DDFDFDHDD@HBF@F@F@FAHAH@D@HAH@D@H@HBH@FBH@DAF@HBH@FAF@DAFCDCFCBEDAFCF@DBFDDDHFBDFBHFHBHBDADAFCDAFEFADAFADAFADCFAF@FAF@DAFAHCFCFEBCBCDCDAFABAFAD@F@J@HB@D@FCFAD@HBDBDDDDDBDFDDBDDFFDBDBBDDDBDDDDDDHDDFJBDBFBD@DBF@F@D@DBDDDJDDBFBHDF@HADBFCBCDAHGBC@CBABCAC@CBA@GBEBE@CBE@C@CFEDEBE@C@C@CBI@E@GBCBGDEFAFBFFBDDHDDDFDBFBFA@@HEDCDCDADAHCD@F@FDDFDFBFFFDBFBFBFAJBFBF@FBH@DBHBDBH@DBDBFBD@F@D@F@H@F@DBFDHDDBD@F@F@D@H@B@D@JBD@D@FBFB@@DBNDD@HFDDFFB@HADHDDHCFEAACACAAEAAACACDGBE@CAEAAAAACBCBCDADEDCFGFCDAFBDFDBB@F@@@DAD@BC@@BAFCFCDCDCBAHAHAHAFCFADAHC@@B@BA@@B@FCDAB@B@@@BAB@F@FADAB@B@D@D@H@B@B@B@@@D@B@B@F@F@DAB@F@F@FCHADEDCBEBCBC@CCE@EDCDCDADE@CBCBCAEACCECEEACCACACDEHCBAHCBEDEBEBEBEBEB@@E@CBEBGBEAGBGBC@ABCDEFCDAH@FAFE@E@C@CBC@C@O@@@AECCCEAIAE@I@G@E@E@G@C@GBGBG@GBEBE@CBIACA@CAIAC@E@CAEBE@G@G@E@E@E@E@E@ECCEECCCCECGCECCAEAECEACCCCCAECCCCCACCCCEGAACACCCCE@ECCCCCCGCCCG@C@I@E@IDC@ICEEEAEEEECECEACAEAEDE@EAEAE@E@GAGCEAGCACECCECGEGEEEAACEACEAEAEBEAE@CCACACAE@CAEAEAEAE@GAE@IAGBC@EBCBE@CACCAEBG@GACACAC@E@C@E@E@G@GCCCAAECCC@CCCCBCDEDCDCDE@EEECECCACCCACAC@AAA@E@C@EDABAD@@CDABEHCDEDCDCDGBGBGBG@C@CBCCIAEB@LEBABI@C@CAA@EAAAA@ACGACEEAEAC@CDCFADALEBKBAACFA@A@E@@@A@IAABC@BA@@A@@@ACE@C@C@AH@NSASBOE[SBROKCAQ@CE@@@@MQC@KBA@CAECE@EACACACCGAECCCCAEAEECACCAAC@CCGCGAE@EAE@AA@@A@A@A@AAE@AAC@EAE@CAE@GAGCCAEAE@"],encodeOffsets:[[120321,32976]]}},{type:"Feature",id:"341122",properties:{name:"来安县",cp:[118.435718,32.452199],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@AA@@@@@@@A@@@@@A@@@@@@@AB@@@@@@@@AB@@@@@@A@@B@@@@A@@@A@@@@B@@A@@@@@A@@@@@A@@@@@A@@@A@@@@@AB@@A@@@@AA@@@A@@@A@@@@A@@@A@@@A@@@@@A@@@A@@B@@@@@B@@A@@B@@@B@@@B@@@@@B@@@B@@@B@@@B@@@@AB@@@@@B@@A@@B@@@@A@@BA@@@@@@B@@@B@@A@@B@@A@@B@@A@A@@@@@@A@@@@@AB@@@@A@@@@B@@A@@@@B@@A@@@@BA@@@@@A@@@A@@@A@@@@@AB@@A@@@@@A@@@A@@@@@A@@@@@AA@@AA@@@@@@AA@@@A@@@@@A@@@A@@B@@A@@@A@@@@BA@@@A@@@AB@@A@@@AB@@A@@@A@@BA@@@@@A@@@A@@BA@@@A@@@@@A@@@A@@@ABA@@AA@@AA@ABA@@@A@A@C@A@A@@BAB@B@BA@A@@@A@A@A@AC@@@AA@@A@@BA@@@A@@A@@@A@B@AA@@A@@@A@@@A@A@@AB@A@@A@@AAA@@@@@A@@@@@AA@@A@@ABA@@@@@A@@@@@@@@@A@@A@AA@@A@@@@@A@B@@AB@AA@@@@@@AB@@@AA@@@@@@@AA@@@@A@@@@@A@@@@@@@A@@A@@@@@@@@@@@@@@@@@BA@@B@@@@AB@@@B@@@@@BA@@B@@@@AB@@@B@@A@@B@@@@@BA@@@@B@@@@@BA@@@@@@@AB@@@@@@A@@B@@@@A@@@A@@B@@A@A@@@A@@@@@A@@@AB@@A@@@A@@@@@A@@@A@@@@BA@@@A@A@@@A@@@A@@@AB@@A@@@A@@@A@@@@@A@@B@@A@@@@@A@@@@@@BA@@@@B@@@@@B@@A@@@@@AB@@A@@@@@A@@@@@@AA@@@@@@@AA@@@@@@A@@@@A@@A@@@@@@@AA@@@@@@AA@@@@A@@@@AA@@@A@@@@@A@@@AB@@A@@@@@A@@@@@@@AA@@A@@@A@@AA@@@@@AACA@@@AA@@@@@AA@@@@@@AA@@@@A@@A@@A@@@@@@AA@@@@@A@@@AA@@A@@A@@@@@@@A@@A@@A@@@@@A@@@@@@AA@@@@@A@@@@@A@@@AA@@@@A@@@A@@@A@@@@@A@@@A@@AA@@@A@@@@@A@@@A@@@A@@@@@AA@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@AB@@A@@@@@@BA@@@@@AB@@@@@BA@@@@@@@@BA@@B@@@@AB@@@@@BA@@@@B@@@BA@@@@B@@AB@@@@@B@@A@@B@@@BA@@@@B@@AB@@@B@@A@@B@@@BA@@B@@@B@@@BA@@@@B@@@B@@@B@@@B@@AB@@@@@B@@@B@@AB@@@B@@@B@@A@@B@@@B@BA@@B@@A@@B@@@B@@A@@B@@@@AB@@@@@B@@A@@B@@@@@@@@A@@B@@@@A@@@@B@@@@A@@@@B@@A@@@@@A@@@@@@@@@A@@@@@A@@@@AA@@@A@@@@@AA@@A@@@A@@A@@A@@@A@@A@@A@@@@@A@@@@@A@A@@@@@AB@@@@@BA@@@@B@B@@@B@@@B@@@B@@@B@@@B@@@BA@@@@BA@A@@@@@A@@B@@@@A@@@@@A@@@A@@B@@A@@@A@@AA@@@@@A@@AA@@@@@A@@A@@@@@@AA@@@@@AA@@@@@@AA@@@@A@@@@A@@@@A@@@@A@@@@@@A@@A@@@@@@A@@A@@A@@@A@@@AB@@A@@@A@@@A@@@A@@@A@@@A@@@@@A@@@A@@@A@@@A@@@@@A@@A@@A@@@@AA@@@@A@@@@@A@@@@@@@@@A@@@@@A@@@@@A@@@@@@@@@A@@@@@A@@@@@A@@@@@@@@@A@@@@BA@@@@@@AA@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@C@A@@@A@A@@@@BA@@@@@A@@@@B@@A@@B@@AB@@@@@B@@@@AB@@@B@@@B@@@B@@@@@B@@@B@@@@@B@@@B@@@B@@@B@@@@@B@@@@@@A@@@A@@@A@@@AB@@@@A@@@A@@B@@@B@@@B@@@B@@@B@@@B@@@@@@@B@@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@AB@@@B@@A@@B@@@B@@@@@B@@AB@@BB@@@@@B@@BB@@@B@@@@B@@B@@BB@@@B@@@B@@@B@@@BA@@B@@@B@@@B@B@@@@@B@@@@@@@BB@@@B@@@@@B@@B@@B@@@@@@BB@@@@@@BB@@@@@@B@@@B@@@B@@BB@@@@@B@B@@@@AB@@@LCHABAJA@AD@D@@@@@D@DBHB@@@@@@B@FB@@H@B@B@@@@ABIBC@AAAAC@C@A@ABC@C@AA@@CA@@E@AAI@CAGAI@A@A@ADK@C@A@@@AAGCK@GAAAAEICEAA@ABEBAB@JGDADKBA@@B@@@BB@BBB@B@B@@BBBBB@BAB@@@DCBC@A@@@AAAA@AAA@A@@A@@@@BA@@B@B@B@B@B@BA@@@A@E@@@AA@@@A@@@A@CBA@@@A@AAHKBADA@AAACAA@A@C@A@@AAAAA@A@ABABAB@D@B@B@@@ACCA@A@A@ABCBAB@JADABABCACAC@AAAAAAAC@E@EBA@CBC@A@CAC@CC@AACBAB@DAD@D@F@BAB@BA@C@A@@A@AAA@GBC@C@I@EACACCAAAA@ACACACAGACCCAAA@C@A@A@@@ABABAD@BAD@BBB@@@B@BA@@BCBC@A@C@A@A@A@@@AAAACEGCGEIAC@A@A@CDABCDABADAF@BAD@B@@@BA@@BA@AA@@AA@@AB@@@@AO]@ACA@AA@ACCCGGMMGIAAACA@AAA@A@@AGAECEC@@AAC@A@A@A@EDA@CBA@A@C@CACAAAA@AAKAA@CAC@AAAAAAAA@@@AA@@@@@AB@BA@BD@BA@@BA@AAC@AAC@@@C@C@A@CBAB@BAB@@A@C@EAECCACAA@@AA@A@A@CBCBCBC@@@AAA@@A@@@A@AA@@AOCA@A@@@A@EDCB@@A@A@@AAAAAACA@AAAAA@AAA@A@ABC@ABEDGD@@EBABE@C@@@CBA@A@E@ABE@A@@@A@@@A@A@A@C@ABC@CBC@A@@AC@@@ABA@@B@B@B@B@BA@ABA@A@AAA@@AAAA@AA@@A@AB@@AB@BBB@@DF@@@BAB@@ABA@A@@AA@@A@C@AA@A@CBGB@@A@AA@AACA@@@A@A@A@EJ@BA@A@KBABA@ABAD@B@DABEHABAB@FA@AB@@GBCBA@@BAB@@BBBB@B@B@B@F@BB@B@D@D@B@BB@D@B@B@@@BABA@C@CAA@@@A@EHAB@@G@A@ABA@@B@B@BBD@BABABABAAA@AA@AA@@AA@AB@@AB@F@DDJJBH@FAFADAF@DDDDDDBFBFBF@F@H@FAFBFBFDFDDBD@B@@@BAFADCFCFCFAFCDCDCD@B@B@D@FFDDBDBF@FAHAHADD@DCDEHCDCDADEDEFGDGDGBGFEBCAE@EBC@AB@@GF@B@DCJCDCDEBE@EDEDCDABDFDBFDBD@DAFCDEDCBC@C@CCGACAE@CBA@AB@BC@AD@H@HBD@F@D@DCFCDEDEFEDEBEBIDI@G@IBBF@@BA@@@BB@B@@BB@@B@B@@@B@B@@@BABBB@@@B@@@BB@@@@B@@B@B@@B@@B@@B@@@BBBBB@BB@@BBA@@BBA@BB@@B@@B@@@BBB@B@@@@@BA@ABABBB@@A@@B@@BB@@@@@@@B@B@@@@@BB@B@@B@@@B@BB@@@B@B@@@B@@@@@B@DCFEFCFAFDBDDH@D@DAF@DEFAFEFCB@BEDCFAFBDBDBDBFDHDFBBDDBF@DAFADCH@FAD@FB@DHDDDDDDDDBBBDDBBBHHBBFFBB@BBF@DBF@DFF@DBF@DADL@H@@@DBD@DBJ@HAHAFBF@BD@D@F@D@D@B@DHFBDFFFDHFFDDDBDAFCFABEFEBEHAFBDDDHBFFFDDB@FBDBF@D@FBD@F@DAB@FAHCFEDCDAHCF@FBD@DDD@D@D@DBDBDB@DDDBDBDDBDDDDBBDBBDDBBBD@BDDDBDBDDD@ACBABGBA@CDEFIDCF@B@DADAF@D@D@DBBAB@HADBBBFBD@B@B@DAD@D@F@F@B@@@BB@@@@B@@@@@B@@B@@B@@@@@B@@@@@BB@@B@@@@@B@@@@@B@@@B@@AB@@@@@B@@A@@B@@@B@@A@@B@@@@BB@@@@@B@@@@BB@@@@@B@@B@@B@@@B@@@B@@@@@B@@@B@@@B@@@B@@@@@B@B@@@@@BB@@B@@@B@@@B@@@BB@@@@B@@@B@@@@@B@@B@@B@@@B@@@@@B@@@@@B@@@@BB@@@B@B@@@B@@@@@B@B@@@B@@@B@@@B@@@@@B@@@B@@@B@@@@@B@B@@@B@@@@@BA@@@@@@B@@A@@@@B@@@@@@AB@@@@@B@@A@@@@B@@@@@@AB@@@@@BA@@@@@@@AB@@@B@@A@@@@BA@@B@@A@@B@@@BA@@@@B@@@@@B@@@@@B@@@B@@BB@@@B@@@B@@BB@@@@@B@@BB@@@@@B@B@@@B@@@B@@@B@@@@BB@@@BB@B@@B@@@B@B@@@B@@@@@B@@@B@B@@@B@@@@@BB@@@@B@@@@@B@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@@B@@B@@B@@@@@B@@B@@@@@@@@BB@@@@@BB@@@@@@B@@B@@@@@@BB@@B@@@@BB@@@@@BB@@@@B@@@@BB@@@@@BB@@@@B@@@@B@@BB@@@@@BB@@B@@@B@@@B@@@B@@@BB@@B@@@B@@@B@@@B@@@B@B@@@@AB@@@B@@A@@B@@BB@@@@@@@BB@@@@@@@BB@@@@@@@@BB@@@@@@@BB@@@@B@@@@@B@@@@@B@@@B@@@B@@@BA@@@@B@@@B@@@@@BA@@B@@@@@B@B@@@BA@@B@@@B@@@@AB@@@@@B@@A@@@@B@@A@@@@BA@@@@@@BCHAJCL@FEPAJADA@@@@@AB@@@@A@@@@@@@A@@A@@@@A@@@@@A@@@@@@BB@@B@@B@@B@@B@@B@@@@AB@@@B@@@@@BA@@B@@@@@BA@@B@@@B@@@B@@@BA@@@@BA@@@@B@@A@@A@@A@@@A@@@A@@@@@@@AB@@@B@@@B@@@B@@@B@@@@@B@B@@@@A@@@@@@@A@@@@@A@@@AB@@@@@B@@A@@B@@@@A@@B@@@@A@@@@@A@@@@AA@@@A@@@A@@@AA@@@@@A@@A@@A@@@A@@A@@A@@@@@A@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@A@@AA@@@@@AA@@A@@@@@AA@@@@@A@@@AA@@A@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@BC@CAC@CBE@CBEAGAG@E@@@@@A@CAEAEAECCEECACAE@GAEAC@EAC@C@EAECBEDCF@FAFAF@F@J@FABCBE@CBCBCDEBEDGBEB@@@@A@@@AB@@@@@@@@A"],encodeOffsets:[[121547,33389]]}},{type:"Feature",id:"341126",properties:{name:"凤阳县",cp:[117.531622,32.874735],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@E@GACCE@A@IZShAH@@EVAHCFIHEHAF@DDFDBFBDBBBDDFDD@FBHBDD@LBDBD@DBD@DBFBBJDPLFBABEBIFCB@B@B@@BB@FHHDFHBDDDDHD@@DDB@DFDDBDFFDFDDFFBDBDDD@BDFDBDHBDDF@FBFDDDDFFDBB@HCLCJBHDB@BBJHBHBBBFBBD@@@B@@@D@B@B@DAB@H@DBF@BBB@F@@BDLBBDBBBB@B@B@BABANA^DHBNBHNNHD@JEL@B@R@P@HALEPALAFF@PDH@@D@PEDA^@L@D@FALCFCJ@LHDDFLDJPVHDDDJ@F@F@FFDHBHCNA@EFIHA@@BEF@BBDBFDDBDBD@D@D@B@DBDBH@FBDBDDDBFDBDBFD@@B@F@@@D@DBDB@F@D@D@@BJ@FAJ@FALBJDN@B@BFAJCBAFCFEBADCDADCDCDABCDABCB@DCDCDCDABEFE@@DCDEFCFCJCFADCFEDCDADCDCD@H@BBH@F@FBH@FBFCDCDCFCBADADAFADAFAH@DAF@FBBBBBFDHDDBDJBDBFBF@FBFBFBHDHBDDFBDDFBFDDBBDFDF@DBF@DBDBF@DBDBFBFDF@DBDDFDDFBDBFBFBD@@@HCHADAFAB@F@HBBBBBDFDFDHBBBFBDBDDFDF@DBDBBBFBDBDBBDDFDBBFBDBF@D@B@D@HAFAFCDCBCFEBABCDEBAB@JC@@F@H@F@D@H@HBDBF@FBHBB@DBHBFBF@FBF@H@@BB@F@FBDBB@FBFBHBFFFDB@@@DIJMEEDKBA@@B@B@@@@A@ABA@ABA@E@@AACCECGEGEA@A@CAA@@A@A@AA@@@AAGAA@EBCBEDCEECCCGCEAECEACCECE@CCCG@EBEBIBG@GBCBEBCBGBCBE@C@E@G@E@E@GBI@KBA@E@CBC@CBCACBE@CBCBC@CDCDCBCFAFCFADADAHAFAFAF@HBD@HBFBHAD@LCDADADCDGBCBCBEBC@EFK@C@EBCAACCACGCEEACCGAE@EAE@EBEBCDGBEBC@@DE@C@EBCBCBC@ABEDCBEDAHCFAD@FAJCD@FAFCDCBCAEAGCCCCCACAGCCE@EBC@EBEBE@CCCCEACCC@CBGDEBE@C@ABUCSAE@A@CLOBAFAEECCGEC@MCCA@@EAEAC@C@IAC@A@G@C@E@E@C@CAGCECCAE@G@E@C@E@C@EACACAG@CAGACAG@EAE@EAIAEBEAEACAEEAECECEECE@C@GDCBCBCDCDGF@@EBEACACECCCGACEEEAEBCFAHAD@H@FAJ@D@D@DAFCFEF@D@DAF@DAFAF@HAB@DBDADAB@DADGHCBADEDCAGBE@GCEACAICCCAC@C@C@EAE@CACAEACEICCCGCCCCACCCACCACAEECCCAECACCCCCACAC@GBCDE@E@CGAI@E@C@EBABEBCBCDADADEFEDGDEBCBE@EBE@EBCDEBCBEBCBEBEFCBEDCBCBGAGAGEEAACGECCECCAE@EDCBAFEDCDEDCBE@EBG@GAE@CBG@EAG@GAG@C@G@GBC@G@GBEBE@E@E@GAC@GCECECCCC@C@C@E@C@A@EFCHIPCBMLG@KCEGGQGCCACAE@G@EBCBCDCBEDADEDGHEBC@E@@@C@ALE@KCACBAHMAAGA@@QEQCKCEAA@UIOIEA@@CAGCEAIEC@A@@@C@GAEAGAC@C@E@EAGAE@C@A@K@I@K@QBWASIOA"],encodeOffsets:[[120021,33404]]}},{type:"Feature",id:"341182",properties:{name:"明光市",cp:[118.018193,32.78196],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@C@E@A@E@A@A@A@@@A@@A@@A@@@A@@BCDEAKAOACAWAW@C@AA@SAIAACPDAIOA@@PB@EVDB@@ADK@K@EAA@A@@AA@@@@A@A@@@A@AB@@A@A@@@A@AB@@A@@@A@@@AB@@A@A@@@ABA@@@GE@@@@B@BA@@B@@@B@@@B@B@@AB@@@B@B@@@B@@AB@B@@@B@@@B@BA@@B@@@B@B@@@B@@AB@B@@@B@BIDIDGA@A@A@@@@@A@@@A@A@@@A@@@A@@@AB@@A@A@@@A@@@A@@@A@@@A@@A@@@A@@@AA@@@@A@@@A@@@A@@A@@A@@@A@@@A@@@@B@B@@@@@B@@@B@@@BAB@@@B@@@B@@@B@@@B@@@B@B@@@B@@ADB@@BA@@B@B@@@B@@@B@B@@@@@DGBABEFKDWBIBC@O@O@GBO@CFKB@BGFMFEBAFETKNA@@JBJ@L@B@J@H@FAB@FCB@@@@ABA@@@AB@@A@@@@@A@@@@@A@@AA@@EEA@CACACCECACA@AAAAA@A@AAA@AAA@A@AAA@A@A@A@AAA@A@A@A@A@A@A@AA@@A@A@A@AAA@A@A@AAA@A@AAA@A@A@AAA@A@AAA@@@A@AAA@A@AAA@A@AAA@@AA@A@AAAAA@@@AA@@AAAAA@AA@AAA@@AA@AAA@@@A@A@A@@@A@A@A@A@ABA@A@A@A@ABA@@@A@A@ABA@A@A@A@@@A@ABA@@@A@A@ABA@A@A@A@ABA@A@@@A@A@ABA@A@A@ABA@@@A@ABA@@BA@AB@@ABA@A@A@@BA@A@A@A@A@AFI@EDUBBFDJFHDHBD@F@L@H@D@B@DAAE@E@ABCFCD@FBFBD@DA@@EM@A@E@C@ADCB@@ADAAC@@ACBEBABCBC@EBE@GCCCACAC@EAAC@CBC@CFK@EDCDEFADAF@D@D@H@FAD@DCBABE@CAEBGBAHAF@BBBDDDBBBDBBDDHBD@F@LAFAF@BABABA@EBEDCDE@A@E@G@@@C@EBA@CCCCCCEAC@CBE@CDABCDADCB@BADAF@D@F@FBDBFBD@H@F@DCDAFCD@F@D@FBJBACAC@C@C@CCC@CAC@EDEBGDCFCDEBG@EBA@C@EAC@E@CAEAC@ECAECEEGACCACBEFGFAFEBADEBEACCCECGEECEEACGE@C@A@C@C@E@CACE@EAGBGBI@CAC@CA@@G@K@M@M@CBCBCBCBEBC@CDCHADCCGECCAEACCGAC@@CACEEGEIACCEEICEEGEICGCECGCGCKAE@IAE@E@CAGAICGCGACAACBAA@@AA@CA@BA@AB@B@@@BBB@@AB@@A@@@@@AA@AACA@@@A@@G@G@C@E@CAAC@A@@@@@A@A@@B@DA@@@AAC@A@A@C@A@CAAAC@C@CBA@@DCBAAAAA@CAC@@ABCFCBABCDCAIAEEACAECCCCGCCCC@GCCAEAEAE@EBEBADADADCD@BEFCDEDEBG@IDCDEDABEDC@CAEACCCG@@A@ABA@@B@@@B@@@@@BA@C@AAAA@@A@@@A@@@@@AB@BBB@@@@@B@@A@A@A@A@@@C@A@A@@@A@@@AB@B@@A@@@AA@A@@@AA@@BA@@BAB@@@@@BB@BB@B@B@@@@ADA@@@@@A@@CB@AA@@@@AA@@AB@@@DA@@@A@CC@@A@A@A@@AA@@@@BAB@@C@@@AA@@AAA@C@EBA@A@A@@A@@A@AB@@ABA@A@A@ABA@AAAA@@@P@DAD@D@D@FEFEBG@CBEDCFAD@BADAHBHAFAHAF@D@FA@AFAFAFAFCFAFGDABGDCFBDBDDDFBDFDFBDBFADAD@DCFCBCDCD@FDF@DADADAFCDCFGBEDE@E@A@CBE@E@A@A@C@@@A@A@A@G@C@C@A@A@CBEBE@A@AB@@A@A@CBEDA@@@ABA@@@GDCBEBEDGBGBGBABCDCDEDEDAB@@ADC@CB@@E@A@CACEEACBEDEHCDCFCBADADBDBBBBBF@DAFCHBDBDBBBFDBDBBBEFGDCCCGGBA@EBABKP@D@BBFDTAV@B@DAFCFAH@DDDBDDFDD@DAFAF@FAD@FDFHDDBDBDDDDBHBFADCDEDEBC@IDEBC@EBGDCBAFCDAF@BADADAD@F@DCF@@ADAFCHADAF@FBF@FBFDHBDFFHDBDDDBBAD@F@DEL@FADAFADADCHCDCBCBKDC@GBEAGAC@GAE@EBEBGBCBCBEBEDEBADCDCD@DADAD@DAFBDAD@DAD@D@FAB@LAJ@H@F@F@H@F@DAFADAHADAFAD@HAHAJAF@FDHDDF@FDDDFBFDFBHDDDFDDFFCDAFAB@HBBB@@B@B@DAFAFCBABABA@@B@DCDADABAHADADADAJBDDFDDDDDFFDBHFBBFFFDFFFBDBDBF@HCDCFCDAHEDABAD@H@DBDDBDDDDFFAJADADAFCDCDADAHAH@FDDDFDDBFDDBDBHFDBBDDBDF@FCDCDCBEDE@CBE@GDE@EAGAEAEBCBABAF@FBFBFDHBDBFDBFBFBFDFBBDFFBDJDDDFDFBFBH@JAF@BAJBL@FBFBFDDDAF@FCFCFADCF@FBDDFFFDH@DBDBD@D@F@FAD@H@F@B@@A@@AA@@@A@A@@AA@A@@@AAA@@@A@AA@@@@A@A@@AA@@@AA@@A@AA@@A@AA@@A@@AA@A@@AA@@@AAA@@@A@@@A@@@A@@@@@AB@@A@@B@@A@@B@@BB@@@B@@@BB@@B@@@BB@@@B@@BB@@@@@B@BB@@@@B@@@B@@BB@@@B@@@B@@@B@B@@@BB@@B@B@@@B@@@B@@@BA@@B@@@B@B@@@B@@@@@BB@@@B@@@@BB@@@B@@BB@@@@BB@@B@@BF@DBRLDBHBNFhND@RFD@XHBBB@HB@@DBDBBB@@BBD@@BB@@BDF@B@DCJAF@H@FBHDHHJ@@JJFDDBHBBBLE@@LC@@FADANIHELIBAJKHS@ABI"],encodeOffsets:[[121060,33973]]}},{type:"Feature",id:"341181",properties:{name:"天长市",cp:[119.004816,32.667571],childNum:1},geometry:{type:"Polygon",coordinates:["@@H@TIHCNATFDB@@@@@BB@@B@@@@@BB@@@@B@@B@@B@@B@@@@@BB@@B@@@@@@@BB@@@@B@@B@@@@B@@@@@BB@@@@B@@BB@@@B@@@B@@@@@B@@@@@B@@@@@B@@@@@B@B@B@@@BB@@@@B@@@B@@BB@@@B@@@BB@@B@@@B@@@BB@@B@@@B@@@@B@@@@@B@@@B@@BB@B@B@BADA@@B@@BB@@@@@@@@A@@@@BB@A@@BB@AB@BA@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@AB@@@@@@@@@@@B@@@@@@A@@BA@@@@@@@@@@@@@AB@@@@@B@@@@AB@@@B@@@@@@AB@@@@@B@@@BA@@@@@@B@@@@@BA@@B@@@@@@@BA@@@@BA@@@@BA@@@@BA@@@@@@B@@@@@@@@@B@@@@@@@B@@@BB@@B@@@@@B@@@B@@@B@@@B@@@@AB@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@BB@@@@B@@@@@B@@@@@@@B@@AB@@@@@B@@A@@B@@@@@@@BB@@@@BB@@@@@@BB@@@@@@BB@@@@@@@@BB@@@@B@@@@B@@@@B@@B@B@@@@@B@@@B@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@BA@@B@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@B@@A@@B@@BB@@@@@B@@@@@B@@@B@@@B@@@B@@@@@B@@AB@@@B@@@@@B@@@B@@@@@B@@@B@@@@@@@B@@@B@@A@@B@@@B@@@B@@AB@@@@@B@@@B@@@@@B@@@B@@@@@B@@@BA@@@@B@@@B@@@@@B@@@B@@@B@@A@@B@@@@@@@B@@@@@@@B@@@@@BB@A@BD@@@B@@B@@@@@@B@@@@@@A@@@BB@AB@@@@B@@@@@@B@@BB@@@@@@@@BBB@@@@B@@B@@@@@BB@@@@AB@@@B@@@@@B@@@@@@A@@B@@@@B@@@@B@@@B@@A@@@@@B@@B@@@@@@A@@B@@@@BBA@@AAB@@@@@B@@@@A@@B@@BB@BAB@DCB@BB@ABA@AB@BBBBB@BABAB@DBBADABABAFBF@DAF@FFHDD@B@@@@@B@@@@@@@B@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@BB@@@@B@@BB@@@@B@@@B@@@B@@@@@B@@@@@B@@BB@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@AB@@@@AB@@@@A@@B@@@@A@@@@B@@A@@@@@@@AB@@@@A@@@@B@@@@@@@B@@@@@B@@@@@@BB@@@@@BB@@@B@@@B@@BB@@@@@@@@B@@@@@B@@@@@B@@@@B@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@B@@@B@@B@@BB@@B@@@@@BB@@@@B@@@@A@@B@@@@@@@B@@@@@B@@@B@@AB@@@B@@@@@@BB@@@B@@@@@@@B@@@@@B@@@B@@@@B@@B@@A@@B@@@BA@@@@BA@@@@BA@@@@@AB@@A@@B@@@@@B@@@B@@@@@B@@@@@B@@@@@@@B@@B@@B@@@@@@BB@@@@BB@@@@@BB@@@@@B@@B@@B@@@@@BB@@B@@@@@@BB@@@@@@@B@@@@@B@@@B@@@B@@@BA@@@@B@@AB@@@@@B@@@@AB@@@@@B@@@@A@@@@@@BA@@@@@@B@@@@AB@@@@@B@@AB@@@B@@AB@@@@@B@@AB@@@B@@@@@BB@@@@@@@B@@@@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@B@@@B@@B@@B@@B@@@@BB@@@B@@@@@@@BA@@B@@@B@@@B@@A@B@@@@B@@B@@@@@B@@B@@B@@@@@BB@@B@@@@@@@B@@@@AB@@@@@@@@@B@@@@@B@@@@@@@@@B@@@@@B@@A@@B@@@@AB@@@BA@@@@B@@A@@B@@@@@@AB@@@B@@@B@@@@@B@@@B@@AB@@@@B@@B@@@@B@@@BB@@B@@@@@B@@@@@B@@@B@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@@@BA@@@@B@@@@B@@B@@@@B@@@@@@BB@@@@@B@@@@@B@@@@BB@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@BA@@@@BA@@@@BA@@@@B@@@B@@@BAB@@B@@B@@@@BB@@@BB@@@@B@@@@@B@@B@@B@@@@@B@@@@@@@B@@@B@@@B@@@B@@@@@BA@@B@@@B@@@B@@@B@@AB@@@B@@B@@@@@B@@@@@B@@@@BB@@@@B@@@@@B@@@B@@@B@@@@@B@@@@@B@@@@@BA@@B@@@@@B@@@B@@AB@@@@@@@B@@A@@@@@@@A@@A@@A@@@@@@AA@@@@@@AA@@@@@@AA@@@@AA@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@AB@@@@A@@@@@AB@@@@A@@B@@@B@@@B@@@B@@@B@@@B@@@@@B@B@@@B@@@B@@@B@@@@B@@@B@@@B@@@B@@@@B@@@@@B@@@B@@@B@@@@A@@B@@@@@B@@@@@BA@@@@B@@@@@B@@@@AB@@@@@B@@@B@@AB@@@B@@@B@@AB@@@B@@@B@@@B@@@BA@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@@@B@@@B@@ABB@@B@@@@@@@BB@@@@BB@@@@B@@B@@@@@@B@@B@@@@BB@@@@B@@B@@B@@B@@B@@@B@@@B@@BB@@@B@@@B@@@B@@AB@@@B@@@B@@@B@@@B@@@B@@@@@@@B@@@@@BA@@@@B@@@@@B@@BB@@@B@@@B@@@@@B@@@B@@@BB@@@@B@@@@@B@@BB@@@B@@@@@B@@BB@@@B@@@BB@@B@@@B@@@BB@@@@B@@@B@@BB@@@B@@@B@@BB@@@B@@@@@BB@@B@@@B@@BB@@@@@B@@@BB@@B@@@B@@@@@@BB@@@B@@@BB@@B@@@@@BB@@B@@@@@B@@@B@@@B@@@B@@@@@B@@@B@@@BB@@@@@@B@@@B@@@B@@@B@@@@@B@@@B@B@@@B@@A@@A@@A@@@A@@B@@@B@@AB@@@B@@@B@@A@@B@B@@@B@@@@@B@@@B@@@BB@@B@@@B@@@@@@@@@@A@@@AB@@A@@@A@@@@@AB@@@@AB@@@@AB@@@@AB@@@@AB@@@@A@@@@@A@@@A@@@@@A@@@A@@@@@@AA@@@@@A@@@@@AB@@A@@@A@@A@@@@@AA@@@@@@A@@@@A@@A@@@@@@A@@@@@AB@@@@AB@@@@A@@@@B@@@@@BA@@@@@@@AB@@@B@@A@@B@@@@@@A@@@A@@A@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@A@@A@@@@A@@A@AB@@@@A@@@@@@@A@@@@A@@@@@@@A@@A@@A@@@@@A@@@@@A@@@@@@@A@@B@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@AA@@@A@@A@@A@@@@A@@A@@A@@@@@A@@@@@A@@@@@@@A@@@A@@@@B@@AB@@@BA@@B@@@@A@@BB@@B@@@B@@@B@@@@@B@@BB@@@@@B@@@B@@@B@@@@@B@@@@@B@@@B@@@BB@@@@B@@@B@@@B@@@@@@@B@@@@@B@@@@BB@@@@@B@@@B@@B@@B@@@B@@@@@BB@@B@@@@@BB@@B@@A@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@@A@@@A@@BA@@@@AA@@@@@A@@@@@A@@@A@@@@@A@@@@@A@@B@@@@A@@@@B@@@@A@@@@@@BA@@@@@@BA@@@@@@@AB@@@@@@@BA@@@A@@@@@AB@@@@A@@@A@@B@@A@@@@@A@@@@B@@@@A@@@@@@@@BA@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@B@@A@@B@@@@A@@@@B@@A@@B@@A@@B@@A@@@@B@@A@@@@B@@A@@JAJCBCNKZaPQHIRGNANB^BXBvBF@F@H]@EBI@C@CBK@IBA@CBEBEBC@CBG@CBE@CBCBE@EBC@EBADCDEBABCDE@C@E@C@E@GBC@A@GAIFK@A@q@AAMAS@IAe@CBABCFCLEBC@C@C@AACACAC@A@CBE@EDCFAFAD@FACG@@@@@@KCAEACAG@I@E@G@C@CCEE@EAEBKHC@ABG@A@A@ABEHCHAD@B@@FH@@ADCF@BA@@@IEECCA@A@@@A@@@A@@A@@A@@@@@@B@ACBC@AA@@AB@@A@@BA@@@A@@@@@@@@@AAA@@@A@@@@@@@A@@BAA@@@@@A@AA@@@@@@@@A@@A@@A@@@@AB@@@@A@@@@@@BA@@@@@A@@]GIECC@EACDCDEFCDAFADCBEB@@C@EACCCEC@@CAAAECAAGO@CB@@AAGCEACCCACGIDCDCBABCC@K@GAC@EEGCCCCCEEECCAEACACCKCAAGCCCGMCCCCAACCE@@@GBID@@KNA@@B@@@@@@@B@@A@@@@B@@@@@@@B@@@B@@@@AB@@@B@@@@@B@@@@@B@@@@@B@@B@@@@B@@@@@B@@A@@B@@AB@@@@@@@B@@@@@B@@@@@@@@@B@@@@@B@@@@@@@BB@@@@@@B@@@@A@@B@@@@@@@BA@@@@@@BA@@@@@AB@@A@@B@@A@@@AB@@A@@B@@A@@@@BA@@@A@@BA@@@@@@BA@@@A@@B@@@@A@@B@@A@@B@@@@A@@B@@@@A@@B@@@@A@@B@@@@AB@@@@@BA@@@@B@@@@@BA@@B@@@@@@@@@B@@@BA@@@@B@@@B@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@B@@@@@@AB@@@B@@@@@B@@@B@@A@@@@@AB@@@@A@@@A@@@A@@@@@A@@@A@@@A@@@@@A@@AA@@@A@@@A@@@A@@@AB@@@@A@@@A@@BA@@@@@A@@@@@A@@@@BA@@@AACB@@AA@@@@AA@@A@@A@@@@A@@AA@@@A@@@A@@@A@A@@@A@@@A@@@A@@@@A@@@@@AA@@A@@@@@A@@@A@@A@@A@@@@@@AA@@@@@@A@@A@@A@@@@AA@@@A@@@@@A@@AA@@@@@A@@AA@@@A@@@A@@@A@@@@@A@@@A@A@@@@@@@A@@@AB@@@@A@@@A@A@@@A@@@@@A@A@@@A@@@A@@@AA@@@BA@@@A@@@@@AB@@A@@@A@@@@BA@@@@@@BA@@@@B@@@@A@@B@@@@AB@@@@@BA@@@@B@@A@@@@B@@@@A@@B@@@@@B@@A@@B@@@B@@@@@B@@@@@B@@@B@@@@@B@@@@@B@@@B@@@@@@@BA@@@@@@B@@@@A@@B@@@@@@@BA@@@@@@BA@@@@@@@@@AB@@@@@@A@@B@@@@A@@@@B@@@@A@@@@B@@A@@@@@@B@@A@@@@@@@@BA@@@@@@@AB@@@@@@A@@B@@@@@@A@@B@@@@A@@@@B@@A@@@@@@BA@@@@@@@AB@@@@AB@@A@@B@@A@@@@BA@@@AB@@@@@BA@@@@@AB@@@BA@@@@@AB@@@@@@AB@@@B@@AB@@@@@B@@AB@@@@@B@@A@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@A@A@A@@@AA@@@@@@ABA@@@A@A@@A@BA@@@A@@@A@@@A@@@A@@@@AA@@@@@@@@@A@@@@@@B@@@@@@@@@@A@@@@@@@@@@@B@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@A@@@@AA@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@@A@@A@@@@A@@@@@@A@@@A@@@@@A@@@A@@BA@@@A@@@AB@@A@@@AB@@A@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@B@@B@@@@@B@@A@@@A@@@A@@@@AA@@@AA@@@@@A@@@AA@@@@AA@@@@@A@@@@@A@A@@@@@A@@@A@@@A@A@@@A@@@A@@@@@A@@@@BA@@@A@@B@@@@@@@B@@@B@@B@@B@@@@BB@@@@B@@B@@B@@@@@BB@@@@B@@@@BBA@@@@B@@AB@@@@@B@@@@@B@@B@@@B@@@@AB@@@B@@@@@B@@@@@B@@@@@B@@A@@B@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@AFD@BBBFJFJD@FABADKEGACAA@A@A@A@C@@@A@@@A@@@@@A@@A@@A@@@A@@@@@AA@@A@@A@@@@@A@@@@@A@@@AB@@A@@A@@A@@A@@@AA@@@@A@@@A@@@A@@B@@A@@@@B@@A@@B@@@@A@@B@@A@@@A@@@@@@@@@@A@@@@@A@@@@@@@@@A@@@@@A@@@@B@@A@@@@@A@@A@@@@@A@@A@@A@@@A@@@@@A@@@@@A@@@A@@@@@A@@@@@A@A@@@@@@@A@@@A@@@@@A@@@@@A@@AA@@@@@@@A@@@@@A@@@A@@@A@@@@@AA@@A@@@@AA@@@@@AA@@@@@@@@@A@@@@A@@A@@@@A@@@@@@A@@A@@@@@@@@AA@@@A@@@@@A@@@@@A@@ACBA@@@@B@@@@@@@B@@@@@@BB@@@@@@@B@@A@@B@@@@@@@@@BA@@@A@@@@@A@@@A@@A@@A@@@@A@@@@AA@@@@A@@@A@@@A@@@A@@@@@AB@@@@@@A@A@@@@@A@@@AB@@@@A@@@@@@B@@@@@B@@@@@B@@@B@@@B@@B@@B@@@@@B@@@@@@@B@@@B@@A@@@@B@@A@@@@@A@A@@@@@A@@@A@@@@@A@@@A@@B@@@@@@AB@@@@@B@@@BB@@@@@@BB@@@B@@@B@@@BB@@@@@@@@BB@@@@@B@@@@@B@@@@@@@B@@@B@@BB@@@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@BA@@@@@@B@@@@@BA@@@@@@BA@@@@@A@@@A@@@A@@@A@@@@A@@A@@AA@@@@@@@A@@AA@@@A@@@AA@@@@AA@@@@@A@@A@@A@@@@@@AA@@@@AA@@@@@@@@AAA@@@@@A@@@@@AA@@@A@@@@@AB@@A@@@A@@@@@AA@@@@A@@@@@@@AA@@@@@AA@@@@@@AA@@@@A@A@@@A@@@A@@@A@@@A@@@A@A@@AA@@@@@A@@@@@A@@@AA@@A@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@BA@@@@@A@@@@B@@A@@@@BA@@B@@@@A@@BA@@@@@A@@@A@A@@@A@BB@@@@@B@@@@@@@B@@@@@@@@@B@@B@@B@@@@@@@B@@@@@@@@@B@@B@AB@@@@@B@@@@@B@@@B@@A@@@AB@@@@A@@@@@AB@@@@@@@@A@@@@@AB@@@@A@@@@@@@A@@B@@A@@@@@A@@B@@A@@@@@A@@@A@@B@@A@@@A@@@A@@@A@@@A@@@A@@@A@@AA@@@@@AA@@A@@@@@AA@@@@A@@A@@A@@@@@AA@@@@A@A@@@@@A@@@A@@@A@@@A@@A@@@@@@@A@@@A@@@A@@@A@@@@@@@A@@@@@@@A@@A@@A@@@@@@AA@@@@AA@@A@@@AA@@A@@@@@A@@@A@@@AB@@A@@@@B@@A@@@@BA@@@AB@@@@A@@B@@A@@B@@A@@B@@A@@@AB@@@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@AB@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@BA@@@@@@@@B@@A@@B@@@@@BA@@@@@A@@B@@A@@@@@A@@@@B@A@@@@@@A@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@AA@@@@@@@A@@@@@A@@@A@@@@@A@@@@@A@@@@@A@@@A@@@A@@@@@A@@@A@@@@@A@@@A@@@@@A@@A@@A@@@A@@@A@@@A@@AA@@@@@A@A@@A@@@B@@@@@@A@@B@@@B@@A@AFCHAFCFADAD@DAFADEBI@E@E@EBEBE@CDAFFDFBD@D@FBD@FBHBF@DBDBFFDDBFBFBF@D@B@@@@@FBHBHAF@DAF@DBD@DAD@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@BB@@B@@@B@@@@BB@@@@B@@@BB@@@@B@@BB@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@B@@@@@B@@@@BB@@@B@@@@BB@@@@@B@@B@@@B@@@B@@BB@@@@@B@@@@@B@@A@@@@B@@A@@@@BA@@@@@A@@B@@@B@@@@@B@@@@@@@B@@A@A@@@@@A@@@A@@@A@@@A@@@A@@B@@@@@@@B@@@B@@@BB@@@@BA@@@@@AB@@@@AB@@A@@@A@@@A@@@AB@@@@A@@@AB@@@@A@@@A@@B@@A@@@@AA@@@@AA@@@AA@@@@@@@B@@@@@B@@B@@@@B@@@@@@@B@@A@@B@@@@"],encodeOffsets:[[121539,33513]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("阜阳",{type:"FeatureCollection",features:[{type:"Feature",id:"341202",properties:{name:"颍州区",cp:[115.806942,32.883468],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@C@@@D@@@"],["@@@@@@C@EA@B@@@BB@@AB@@@@@@BBA@BB@@@B@@A"],["@@EBG@CBEDCHCJCDBDAFCDADADEBGBC@G@GAA@CBCDCD@FDFBDBDDFBBFFDDHFDBFDAB@B@B@@@@A@@@@@A@@A@@A@@@@@@@@A@@@@@@A@@@@@AA@@A@@@@@@@@@@A@@A@@@@BA@@@@@@AA@AB@@AA@@@@@@@@A@@@@@A@@@A@@@@@CAA@@@AA@@@@@@A@A@@@A@@@A@@@C@A@@@A@@AA@@BA@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@A@AB@@@@A@@@AB@@A@A@@@CBA@AAE@I@E@A@G@CDEBCDCBEDC@CBCCECAC@CCE@AAACCACAECG@CACAIACEAACAAECEBEBCDABCFCB@BG@CBGBCAECCE@CEEGAIAEAAAE@GCC@EAE@G@C@@@EDAB@@BDBBBH@FBFBFBBDDBB@BBFBD@@@BBDFDDDDD@@BH@BBDBJBBFHB@BDBDDBBBBDBDDDBBHJPXDBBDFF@BBBBBBB@BBBDD@BB@@B@@@@@@@B@@@B@@AD@BB@@B@@BB@B@@BB@D@@BB@@@@@B@@BB@B@@@@@B@@BB@@@@@B@@@@@B@@@@B@@B@@A@@@@B@@@@@BB@@B@BB@@B@@@@BB@@BD@@@@@@A@A@A@A@AB@@AB@@@BBBDBBB@BBBAB@DAB@D@B@@BBBBDFBBDG@ABA@ABAB@DADBB@BA@@B@@ABCBA@A@ABAAC@CA@@ABADAB@B@BBB@BADA@ABA@A@ADABAFDB@DBBBBBBD@B@BBB@BD@D@B@FAD@BABC@E@C@CAC@A@ABABADABADAF@B@@BBBAB@@BBBBBBDFBBBBD@BABEBC@A@ABAB@B@BBB@B@BA@@@C@C@@@A@AB@@@BABBJ@D@B@DADAB@BBDD@@B@B@B@BEBCBCDABAB@DBBBDDDB@@BA@@BABA@C@A@ACCACB@BADEDCBA@AAACC@A@A@AB@D@BBBBB@DAD@B@B@DBDBD@BBB@BADA@A@A@@AABA@AB@FCB@DA@@B@F@B@D@JBLBHBJ@LB@@@B@BAB@BCB@@@@B@JDB@FBBB@BAB@BBBBBD@B@D@B@BAFAFAB@D@DAD@BABCBA@CB@D@D@FAD@DA@@PEFADADADAD@FADAJCJCB@ZIHABAB@@@B@JEJC@@FAB@@@DA@@B@@@DA@@DADAAC@@@A@C@@@C@A@@@@@@AE@ABAA@@@@@@E@@AE@@@AB@BCDA@BD@BD@@@@@@D@@D@D@B@@@@@@B@D@B@@@@@@@F@@A@@@@BC@@@@@A@CJ@B@@@DB@A@@DCB@DAD@B@@@@@@ALA@ALAJADAB@FAB@DADADEB@BABAB@B@B@D@D@B@DABABE@EBA@AB@DCDEBC@A@A@E@A@A@A@@BAHE@@FCTIDADAD@BBDBDBF@D@LCDCFE@G@@BCBADAD@D@@@B@B@FDHFDBFBB@D@@AB@@@@C@AAAGGAA@A@ABA@@BA@@DADA@@J@FAFALEAACECA@ACG@@@ACGAEACEAGDCBCDEDCBC@CACCCEACCECE@A@@@AAA@AEAG@GAE@GAEAC@CAA@AE@CDCDC@CDEBE@A@@AACB@CAB@CC@@AA@@AGBBD@@ABSAI@E@MASBWD@@MFIDEBAB@BABEAE@G@EBCBC@EDCDABGDCBABGBE@CAGACACAEBCBGBEBGDEBC@E@CAAA@ECGA@CAE@A@CAA@AAA@@@A@@B@AA@@@A@AA@AEAABC@CAC@ACCB@@A@AA@@@BA@@BC@@FEBI@@CC@AB@CC@@BC@AMC@@EA@@@A@@@@@@@@B@@A@BB@B@@CB@@@@A@@B@@AAA@@AAB@AC@A@@AA@@@A@@A@AG@@@A@@AB@A@A@@DA@@@@BGB@@@@@@CBB@@@@D@@@BA@@BA@BDA@@BA@A@KB@D@@@@AB_gGI@@B@B@BABB@@B@D@@BHA@@@AB@@CB@@A@@@A@@@CF@BQ@G@@@E@@@ABA@AD@B@@@B@@C@AC@@EBAD@@A@A@A@AAA@AAEAGEDBBCBGDEA@CCA@DEDIEE@@BCDBBEFDD@@@BB@BAD@BDD@BFB@@BB@BFB@@DB@@DA@@B@@BH@@GBA@AAA@@BAB@AA@@@A@AAA@@@C@@CCBAA@A@@A@@DA@DHGDA@EBCBAB@@AB@@C@C@@BBBABABA@@A@AE@CBBDABE@A@CBDD@BA@@D@@E@@A@B@@@B@@@@@B@BBBBDA@@B@@@DDDBBB@@@@BD@B@B@@@@BB@@AB@@DD@@B@@@BBBE@@D@@@BB@@FA@@B@B@@@BABADBA@BA@BDD@@BAFB@@B@B@@B@BB@@@DBABD@@A@BB@@@BB@@AB@BFDCHI@BB@@@B@@@DABBA@@D@@@D@@@BB@DL@BA@A@@B@@@DABAAC@@B@@BHA@@BA@BBD@@B@FC@@D@@BDC@AB@@@DB@@@B@@@B@@@@D@B@@ABAAA@@@AB@@AB@BC@@@@JA@@DF@@HC@@DE@@BCB"]],encodeOffsets:[[[118286,33778]],[[118286,33769]],[[118423,33637]]]}},{type:"Feature",id:"341203",properties:{name:"颍东区",cp:[115.856762,32.912477],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@CACCCECAAAC@C@C@C@AB@B@B@DDFBFHDFDBB@DADABADGLEBGDMHGBC@A@A@CBCBCDCDADAJAB@BABC@ABEB@BAF@@BDBH@F@DCDABGBABA@CBC@A@A@AAA@@ABABC@CACA@CAM@C@A@A@AAA@A@GAEAEACAAAACCCAGAAAAE@C@E@EBCBKJEBA@GDEBA@C@A@IAKCC@CBA@ABKFEBEBI@@@CBCB@@AB@@AB@B@BBBHHBB@B@D@@A@@BC@A@EACAGEECA@A@@@C@C@CBABAD@@@HEFCDKDC@E@CACAAAC@CBCBSJED@@GFAB@@@B@B@B@F@B@BADCFCDA@@BAB@FAFABCBA@C@C@A@A@A@ABABA@CFCBCBA@EBA@CBIBKB@BKB@B@@@@A@C@CBA@CD@@@BAF@BAB@@@B@B@FA@@B@D@@@@DAFC@D@BAB@D@@AF@BCBAD@B@D@@B@@BA@B@@B@@@B@@B@ADAA@BABBDD@FD@@BBDBB@@B@@@@B@BBB@DBBBBBD@BBDBDBFBFBD@@@@@BBBBBBHJBBDB@DFD@@DDBBFHBBFFBDBBBB@@@@@BC@A@A@A@@ACABDC@@BB@@BA@@BF@@DD@@@BB@BB@BB@@@B@@@@@BBABBA@@BB@B@@B@@A@E@C@A@ABC@A@A@@HE@@D@@A@@@@B@B@B@@B@@BB@@BC@E@@BD@@DB@@@B@@@B@BBB@@B@H@B@HHXBB@BFBAB@B@BXB@FAXL@H@BFCF@BBNGBC@@B@@@@A@@B@@@DD@BABB@DD@@BB@@@C@@HA@CB@@A@A@@D@@@B@@@@@DC@BFA@@BB@@@@@B@@@BFB@@@B@@BD@B@F@@@C@@ABA@@BA@@DCB@BA@@DA@A@@BB@@@DB@@A@@@@@@B@@B@@@F@@@BF@B@D@F@@D@@@B@D@BHAHAJA@@HAJAHALCDA@@JCLAB@JCFEDAB@JAFCDAFCFAD@FALAJCBC@EG@AAEEAGFCF@JFHBF@VDHBNCDCBIDE@@BCAGES@CFCJ@@C@CBG@KIIEEBEDCD@BAH@FAD@D@H@FAFAD@F@FCH@DAFAFAHALAH@@@JAJCHAH@HAH@F@B@FAH@@@F@@@@@BBB@@@B@@@B@@@@AB@BABAB@DABAB@B@DA@@@A@@@AB@@@B@@@@@BA@AB@@A@A@@AA@@A@@A@@@AA@AA@@@@AAB@@@BC@@@@@A@@AA@@@A@@B@@AB@@A@A@A@@@@AA@C@A@@BA@@@@AA@@@AAA@@BAB@B@@ABA@@@@@A@AFEBC@AB@@@ACB@@@BA@A@@@@BCBADABCFADCBAJEBA@A@ABA@@@ABCBC@CBCBA@@BCBE@C@A@A@AAC@A@AACA@AC@AAA@IAI@A@A@@@A@@AA@AAC@CCE@CAA@A@CAA@C@A@CBG@A@G@A@C@C@A@A@A@C@AAAAACCAAAA@@I@C@G@EEEACCAACAEEAAACACAE@AACAECGAA@@BCBABABE@AAABC@A@@DCB@BAH@FAD@BCBAAAA@C@AAAAGKCEEEACABIBABCBEBA@"],encodeOffsets:[[118927,33550]]}},{type:"Feature",id:"341204",properties:{name:"颍泉区",cp:[115.80835,32.925211],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBCB@@CB@@A@@@CB@@A@EB@@IDIFA@@@A@ABGBYJA@IDIDCBEBC@CBCBCBEBOF@@CBC@EBC@C@A@@DABADABC@CBC@A@EBEBABA@C@A@C@AAAA@ABA@AAAEAA@ICA@@@@@DA@ABA@A@A@@KAI@GAKAIAC@A@E@A@@@CBA@EDA@@BABBB@@@B@BCBABA@AAC@CACAA@A@C@CBA@AAAAC@A@@B@B@BDDBB@BABCDCFABA@BDDD@B@B@DABAB@@AB@@CACCAACAA@ABCBADADAFA@A@A@@@CCAAA@CBCBA@C@I@AAAB@@A@@B@B@@@D@D@@ABA@A@AAA@A@AB@B@BADAFABC@AAAACEAAAAAA@@BAAA@AA@E@CBABCBABAB@B@BBD@D@D@FADABC@EBA@C@C@@AAA@A@AACAAAACAA@ECABCB@B@BAB@BCBABA@AAA@A@CBAB@BB@@DBDAB@B@BABAD@BA@@@ABA@CACBA@AB@BAB@BCH@@@BABA@A@@DB@@@@@AB@@@@@@@B@BBHDNB@DDBBBB@@BB@BBD@JBBABEDABABABA@@@BD@J@D@D@@@F@D@FDJ@BBB@@F@@BBBBBDBBB@@BBBBDBDBB@FF@@BBFBDBHBDBFBFBDBHBDBDDFBFBDBDBDDDBBFDD@DDHBD@B@FDFBHFDFBDBFBF@FBDBB@H@B@BBBBFFBB@@B@@AD@FC@AB@FEBCB@@C@AA@ECEE@A@A@@@ADCB@P@B@BAB@BA@ADQBCBCB@B@B@B@BBFHDDFF@@B@BBB@@AB@BABCBAB@DAB@DAB@FAFCREDCDADCFAJEBA@@FDFBFBDDFDB@DDD@HHFBFBFFJDFBFDFBDBFDDDDBDBHBB@@DBDADF@B@FBD@B@ABABADCB@B@BBD@BABABCDCB@BBBDBFDHDDBHFF@D@F@D@D@F@D@D@B@F@HADCBCDCDCDADBHDHBLLTAF@HAFABE@@@A@@@QAIG@MA@@@@@A@ABAB@BA@A@G@@BAB@B@N@H@L@D@D@BCBAN@B@D@@G@IE@@AAC@EA@AIIA@@@C@KB@L@F@FAB@F@F@F@B@BBD@DAB@@@D@B@@@B@BAD@D@B@@ABADC@@B@J@JABAD@FAJAB@@A@C@A@@@CE@C@A@E@@A@@@E@@@AA@@@@@@@@BA@@C@@AA@@@BCB@@ABA@CD@@AB@@AB@BD@@@E@A@C@@AA@@@A@AE@@A@@@@@A@@AB@AED@@C@@@@@A@@@CB@B@@@DAB@@GD@@@A@@AC@@CAAABC@@C@@@AB@@@@@@AD@HAAM@ADEAEG@K@BW@EWA@A@ABAEA@AAAGW@G@A@G@AA@AAA@@@A@@@A@@CC@@AF@D@@AA@@AA@@@@A@A@A@@B@@@@CF@@GB@B@D@BAB@D@F@B@@@@AA@A@@AB@AAAB@A@@@@@A@@AAA@@AAA@@C@@CE@@AB@@AA@@AD@ACDB@BB@B@B@D@@A@@@@AAAAACEEAAEGAACC@@EC@CCAAAGIAAAAAA@@@@C@EAEACACAAAC@AAAACAA@AAA@@@@@@AA@CAAA@@ECC@ACBA@ABBBCA@@@@A@@@AA@B@@AA@@@@C@ABCDA@ABE@@@CBA@A@CEDCB@@@@@C@AB@@E@A@A@@BA@ABECA@@A@I@@D@B@@@@AD@@@@@BE@@@@@@@A@C@A@@@@@@@@A@C@CC@@@@@@@ACC@@ACBADA@@B@@BF@@@F@@@@B@AB@BBF@@@@@@@B@D@@@D@B@@BD"],encodeOffsets:[[118599,33704]]}},{type:"Feature",id:"341221",properties:{name:"临泉县",cp:[115.263115,33.039715],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@HA@@@A@@B@@@@AA@@@@@@A@@@@@A@@@@AA@@@A@@@@@AAA@@@ACB@@@B@P@@"],["@@C@@@D@@@@OA@@@A@@AAB@A@@@@A@@BA@@A@@@AFBD@@@DA@@@@AA@@@CAA@@@AAA@@@AA@@ABC@@@A@@@A@@@@@@@AA@@ACCAA@AAAAAAA@AEEACCAOWGIAACCACACAACAACACA@EGAAAIAC@AAG@@CCCCECAC@A@@ACAE@AAACCAAAEAE@EAGAAAC@@BAFC@@D@DKFADEFEDCBGBA@A@CBG@CBCFAFADCFADCFAHCBEDG@C@ABCAEBC@EAE@A@A@C@G@C@CAEAC@G@CBC@EBCBEBA@ABA@@@CDCDEDCDAAGCAEGCA@A@@ECCCCCEECCAAEICCCACCECAECAAAAA@@CCEEACACGECA@@A@CACCCA@ACCACAG@C@IBCBEHCBAFCDCBC@C@CBCAEBCAGBCIDC@CBCBA@CBEDCBG@CBEBCBEBC@E@EBEBA@A@EDIBC@EBC@EBG@E@E@C@GBE@EBG@C@G@E@GBEBC@A@@BC@A@EBC@AFEHIF@BCBCBGDCBGBEBED@@E@IACACC@@@@AG@CDGBE@EBCBCBEBCBEB@@ABCBADG@@BC@AAAACCKCAC@IAG@C@@@CAGO@AIO@CMUIOAE@@@EBIHM@@@@AA@@B@@ABABA@AAAAACAAAA@EACAEACCA@CBABCB@@ABCBCB@BCB@@@DD@H@BBBB@BABCDCDA@C@AA@@ECA@A@CBC@A@CAAA@CAAKEAA@@ACAC@AAA@@C@A@A@ABAB@@@B@@DBDDB@BB@DBB@@ABABA@C@C@E@ACCAA@A@C@CBADADA@ABEAC@E@A@AB@B@@@D@BABGFCB@B@@@B@BB@@@@@B@@@@@BBDBBBD@B@D@@ABA@BB@@DB@DBB@BB@B@BCBGFCBABA@@@@BBDBBBDBBAD@F@BABABA@@@AAAAAAA@A@@B@B@@@B@D@B@D@D@B@B@@BDBB@@B@DBB@D@BA@@@A@AAC@ABA@@BADADA@@B@BBBBDBB@BB@@AB@@E@A@A@@B@BAB@B@D@D@B@@B@DBD@BBB@BBBBBBBF@B@@@B@@C@A@C@A@A@A@@A@C@AAAA@CAA@C@A@@@@B@@@BDF@B@D@D@B@@@D@B@B@D@FCDCDGFGFC@CBAAAA@ADCDCFCBABC@@@AAA@ACAAAA@E@@@AAA@A@@AA@@@A@AB@@@BBD@F@JBBAD@DA@A@C@CB@@@B@BB@BDBBBB@FBD@BABCDAF@B@FBDBD@BDFBJ@D@B@DABABCBAB@FAF@@BH@H@BBBDBBJDNBBDNBDBB@F@FADCBADEB@DA@@@AF@@BH@B@BBBDBFBB@B@B@FCHCHAJ@F@D@BD@B@B@@GJ@BCDKFGDABABEHABAB@F@DDLFHBD@D@@CBCDCDABAFBFBB@@JBLADB@BAFAHCHCDCBE@ABKAGAA@G@MFE@ABKDGF@BAB@HBHAF@@ABCDA@IBCDCB@B@D@BBFBD@BABABCDA@E@GBI@IBG@E@C@GCGCAAEAA@@@A@CBEBEFGFCBABA@C@O@MAA@GB@@E@A@KBGBE@M@SBEBABAB@B@BBBBBBBB@@@PD@@BBBB@B@DADABCD@BADABEBUJGB@@KFMBI@K@G@CBMAK@MAG@C@@@ECAAIEE@E@CBCFCJGTED@@CDGBCBA@CDCFADCHADA@A@E@M@K@IDGBCDCFCF@BABAH@DBB@BDBDBJDBBRH@BABAHKLEJ@DBB@BXERCB@D@L@FBB@@@@@@@@B@@@BB@@B@@@@B@B@@@@@B@@D@@@B@@@@@@BB@@@@@@B@BB@@B@@@B@BBB@B@@@@BEF@@A@A@CB@@AD@@C@@BA@@@AB@BA@A@AB@D@@@B@@@D@B@B@JCF@BCD@BABABCF@BBBBDBDBB@B@@BB@FAB@BA@@DAB@DABCF@BE@CBCDABC@IAC@@F@HBF@BFHDFBD@FB@BLBD@FAFAD@@A@AB@B@@@B@B@BBBFBBB@BBBBDB@@@VDD@F@B@@B@@BBBF@BB@B@B@BB@BB@@F@BAB@BA@BD@@BAF@D@B@B@HA@@D@F@B@BAD@B@BAF@@ABC@@B@BBDBBADA@@@A@A@CCB@@@A@@D@AA@@FA@A@A@@BA@@@A@A@A@A@A@@@AB@@@DCH@B@D@DADE@AB@BA@@B@@@BCB@F@B@@ABAAE@CAE@C@G@A@E@AB@DCF@JAF@HAD@@@D@HEJEDCF@DAH@BAF@LCB@BBFBBBFDBB@@B@D@@@BABKBA@AB@@@BAB@B@DBDBBBB@BBD@D@J@D@NAH@DAFAH@J@B@NAPBF@^@F@H@F@D@JBHBJBJB@BB@BD@D@NBD@DFJFFFDBBPFPHDBB@@ABAD@B@D@BAB@BAB@BABC@AB@DHHHBBBBDBHDD@L@@@J@FBDDDH@@@BDD@@B@D@BO@IBM@CAG@ACG@E@@@A@A@AB@FAD@HE@@B@D@B@DBBDBBB@B@D@D@BA@@@@@A@@A@@A@G@@BAD@D@B@@@B@BD@DBF@BB@B@@@BABADANCNEHA@ABC@C@A@A@AB@D@DAB@@@BAFAHCDADCB@HCFCDABABECCEA@GBA@ADEH@DBJDD@FBFAB@@@DC@E@E@EDCF@FDF@H@HCBADCDA@ABABCFG@@@A@CBCBABAD@F@@@B@@ABAB@B@B@B@@@BA@@@@BB@@@@DFBBB@@@@CBAB@F@@AB@@@B@@C@AAA@@@CB@@AF@AC@BC@@A@A@@@AAAB@BAB@BAB@BBBBBBDBFCAEEGB@BC@@HBD@BBDB@F@BABAB@B@B@DB@BBD@B@DAB@B@@@B@FBB@D@BAD@LKBA@ABA@CBAB@B@@@BBBD@B@BAF@B@D@BF@D@B@BBDB@B@D@D@@BBDA@@B@BABAHEBABADAD@BAD@L@FBB@D@HDRNBBBB@@BA@@@@B@@CBCDCB@@C@@@A@@AAA@CBA@AACAAA@@@@@ABABA@@B@B@BBD@DBD@DBBBBAB@B@BA@A@@AACEAAAA@@@A@CBA@CBAAA@AAACAAA@A@@BA@@BAB@B@B@B@@@@@@@AC@@AA@@@@@AA@@A@AA@@A@@GB"]],encodeOffsets:[[[118286,33778]],[[118286,33778]]]}},{type:"Feature",id:"341222",properties:{name:"太和县",cp:[115.621941,33.160327],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@EAABG@AB@@ANCB@@A@ABABA@CBC@ABADCBA@@@ABA@AA@@@AAAAA@@A@AEAA@@AACAE@A@@ABEDABGHCDCBONCDABCDAB@@ADCBABGFEBABABC@A@CBGBA@A@CB@@ABCBCDCBABGDMDEBA@EBAA@AAC@@EACCAA@E@CCG@E@E@E@E@C@@@ABGBEBCBEDE@A@@@EBCBEBC@@BABEDEDCBC@CAEAEACECC@@@AAEAGCCCCCCCACAC@C@C@EBE@EBC@CBEGAGCCACBCDCDADCDGBE@A@C@C@E@C@C@E@C@E@GECAGCECCAAA@ADADCBABA@AAC@A@ADABCBABAA@C@EAA@E@BCAC@CA@GACACACCECCAEAECEAICEEEAEAGGC@CCA@ECCCEAEAEC@@ABIFEBCDCBCDQFEDEBA@CBA@CBA@ABADABA@@BA@AAA@@@EECCEGAAA@A@A@A@ADADCR@BABA@ABA@O@A@CD@B@@@B@BFFFDB@@B@DA@ADEFA@@BEDC@@BA@@@AAEEAAAAA@G@A@CAEAE@EACAEAECAGCE@E@AACCG@CCCAECACCCACAEAEACCCAGACAEAEACAGACAEAAA@@EEA@CACAAAAA@@AACAAAAA@AE@@@@BDB@@@D@F@BBF@HA@A@EBGDEBCBCDCBCBEBEBCDEBCBEDCBGHADCBADCFADAFELAFADADAFCDAB@BEBEDEBGBA@A@G@CAE@CAE@ABEBEF@BAB@DAF@@@F@D@FADCBCFEF@D@J@HB@BFBDBDBDDDBDDBDDBDDDDFBDBD@D@F@DAJAD@@ABADADCJADADADAD@BADDFDFBDFDDDDDFDBHB@@BBB@F@BAF@B@FDF@D@@@@@BADADCFABA@ADEFCFBJADBD@F@B@BBH@D@FBD@DBF@D@B@D@F@@@FBJ@H@FBB@@@B@DAF@BAH@D@F@FCF@DAD@D@F@FAF@DAHCFADADAHAFADAD@DABBHDBBFBF@F@FAD@D@D@F@DCDADCFCBEDCBCDCB@@EDCBCDGDABEDCDEDEFAFBFFFFBD@DBJ@FBBBD@FDAD@DEHCDQLIFFHDH@FBBA@@@AF@@AFAB@BB@DBBBB@BD@@ADADBB@BBBB@B@B@BB@@BBBBBF@FBHBFDDHH@@D@BBH@JBLDLB@@N@D@DDFF@@D@D@D@@ABABCBCFCBAB@BAFBH@F@BBHBJFHBFB@@BABA@EBC@@DAFEBAD@DBBDDBDBFBD@DBBDFBF@D@D@JDDBF@F@F@D@DEDAD@FDBBBFBP@@@BB@H@J@F@H@JB@@L@D@D@B@D@DBF@HBDBD@@@B@DBFB^DD@F@FB@EDMFUBCBC@AA@@AA@@ABA@A@@BAB@B@@EBE@CBA@@@CBA@@DEDCBCFIFIJKHKDCFIDA@AB@BAB@BBD@F@F@FBB@FDB@B@B@D@B@DAB@@@D@HB@@DBB@F@DBB@B@BALCLCBA@@B@HABADAB@BABAB@D@BA@@BA@CBA@@B@DAD@B@DABB@AD@DABAB@FA@@BE@ADABABAF@B@D@BALC@@@ABCBABADCBA@@BA@@@ADA@@@A@AB@F@DAB@J@DCF@BA@@BABABA@@B@@AB@B@BADA@@BAB@@AB@@AB@B@@ADCB@BA@@B@B@B@@@@@B@B@BB@@B@@@B@BAD@D@B@B@BAD@B@@@BADCBA@ABCBA@A@@BAB@BADCDC@@BABABABABA@AB@@ADCBABADABABADCBABABCB@@A@A@AB@@@@@D@@@BAB@DADABABA@A@C@A@ADAB@BABABC@ABA@AAA@ABA@EAA@AAAAAAC@A@ADA@A@A@AB@@A@C@A@A@A@AAA@A@CAABA@A@A@ABC@A@A@A@ABABA@ABCBABABABADABA@ABABA@@BA@CBG@A@@DA@ABA@@AA@CBAB@D@BABABA@ABA@@AA@A@A@ABC@AAAAC@@DAD@DABA@A@AAC@AAADA@ABE@ABACBAAGAKCA@A@AA@@@C@AB@BABA@ABA@I@EA@CCCA@AA@@A@AA@@A@C@AB@BA@ABA@AAAEE@@ACAA@A@C@@BAD@"],encodeOffsets:[[118692,34054]]}},{type:"Feature",id:"341225",properties:{name:"阜南县",cp:[115.595643,32.658297],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@DA@DB@A@@@@@@AC"],["@@@DADCHENADAFABCHABA@EDCBABAB@@@B@BBD@B@BA@CAA@AB@@AB@@@BFF@B@@@BABA@C@EAA@A@@BAB@B@BBD@D@BABCBC@AA@@CAC@E@ABA@CBGD@@ID@@GDEDCB@@EBCBAB@@@B@@@B@BD@DB@BBBBD@B@B@BADABABA@A@A@AA@AAA@@A@A@@B@D@B@@CF@@A@A@CACAA@C@ED@@A@ABC@AAAA@CA@AAC@@@A@AB@BBB@BBF@BBDBB@B@B@B@B@F@D@BAD@@@@@F@B@BA@CDC@A@A@AAA@AAACAAEAC@A@@AAEACA@A@@@A@@@@B@BBD@@ABA@A@C@CAE@A@OA@@CAMAMACAE@IAGAMAG@AAA@A@@@ABCBA@ABA@@@A@A@@@@A@@DE@ABABA@A@@@AA@@AA@A@C@AAC@C@EAA@@@A@A@C@A@AB@@ABCBGJA@AB@B@@@B@@BB@@BB@@BB@BBB@BB@@@@@B@@@FA@@B@@@FBB@@BB@@@@B@@A@@@@@C@A@ABE@CBC@AB@@A@@B@B@@@BB@B@FDDDBD@B@@@BADCBABA@A@@AAC@CAAAAC@AAABA@AD@B@D@DAB@BE@GBA@A@@@GNAJ@F@@BFJPNV@DJP@BHPDB@@D@H@JBD@DBDLBDBB@BAD@@CHABAD@BA@AFADAFADAD@FAFCH@DBH@@@@DDDBJBF@@@FCFAHADAHCDADA@AJEFGBED@FAB@D@@AB@D@FAHAF@H@D@H@FAF@HAD@F@F@H@FAD@FAD@JAFCB@B@FAFAF@D@FADAFADAH@DAFCDAB@DADAD@JCADBHADBFAD@D@DADCDEDABGDAFAD@J@DBHBDDD@BDBDDDBB@@@DBHFBDBDFFDD@@BBBBDBBFFDDDDBDDFJBBDDFFDDDDFD@@@BDBFHDBBHCBCDCFCD@D@@AB@BABAFAD@FAD@D@HBDBF@D@D@H@D@B@BBF@FADBFAD@B@DCHAFGDEBCDEBCDEBEBAD@DAH@D@BABAHCDEFCFEBCLH@F@FBD@HDF@BBFBJBHBFF@DDFFDDBHADAH@@ADADEBADCFAFAFDBBBDFBBDBJBD@DDHBFBDDDBB@BDF@DBDFDDDDAD@FCDADCFADCH@B@F@J@F@BBB@DA@@B@B@@@BA@@B@@@@@BAB@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@AB@@BB@@@B@D@@@B@@@B@@@B@B@@@@@@@BB@@B@DB@@@@B@@@B@@@@@B@@@@@@@@@BB@@BAB@@B@@@@B@@A@@B@@@@B@@@@@@@@B@@@BB@@@@B@@@@@@@@B@@@@@@B@@@@BB@@@@@B@@@@@@A@ABAECCAGECCEEAACEACACCE@EDCDCDAB@HBH@D@HAFABCBCDCBEACDCDIDGFCDAH@FADA@AF@@CD@@GE@@CB@@I@@D@@ABA@@BA@@B@BBBA@@@A@C@@A@@@A@@@A@@C@@BAD@AC@@@CD@@E@AC@AAB@@AB@AG@@@AD@BBBA@C@@@AB@B@@ACKA@@A@@@C@@@CB@AACB@@A@@@A@@AGJCDAEA@@BA@@A@@AAB@@@ACAB@C@@AAA@@@@A@AA@BE@AC@ACB@@AABBCBA@A@@@A@AB@@EA@@A@@@CF@AA@A@@@AC@@CA@@BA@@A@@A@A@C@@A@@A@AACC@C@@@AB@ACAA@A@A@@@@@A@@@A@BF@@@@CB@@ACCDAB@F@BAACDAF@@B@BB@BABAAA@AD@D@@@BA@@BADAFAB@HCCGB@@CB@@@@BBBDA@DD@@@B@BBB@@@B@@BBA@AB@BBB@HA@@AG@@@AB@@CA@@CA@AEA@@AA@AEC@ACC@ABA@@A@@CCFEAADC@AF@JFFC@CDB@DFBHCDAAAFCBHBF@BBB@B@B@B@BC@AB@FD@@B@DA@@@A@C@@BAB@B@@@F@@@HARE@@D@@@B@@@BA@@DA@@B@@GB@AC@A@@@AAABA@A@@@HJ`hBA@@@@@CLAB@B@@AB@ACB@@AB@@A@@@C@@A@DA@@@@@@HA@A@@B@@CB@B@A@@BB@@@H@@B@BB@@@B@@BB@D@@BBA@BB@BB@@@AB@@@@@DA@@@AAAB@@@@A@@@@@@B@@@B@@FD@BND@@AD@@DBAD@@DJ@FA@ED@@AB@@A@@BBB@@@DABDD@DBD@BAFB@BBBB@@@B@@B@AB@@@B@BBB@DBB@F@DBB@DH@FBBDBF@D@FAHCFAHADAFADBDBHBDBF@HABADAHCBADCFCD@DAFAH@F@FBBA@ABAFAJCNE@@XCTANBF@J@TBBA@@ACHA@BB@@BD@@DBA@DDABB@@BADG@C@G@A@EFCFCFA@AFCDCDEBCBE@C@C@EBGAECECE@E@C@A@G@C@AAE@EBEDGBADCFEFEFADCBC@CAEAAAAEGACCECCAAAECAAEECCEAAAACEACCEACACCCACCG@CDCFCFCFAHCD@F@DAFAFAFAPEOOAAAAAAY_CEQG@@C@CDGHIFGBK@A@C@GBEAA@A@EAAEAECEEGACCAACY_GGCA@AA@QEKEIGAC@AAG@OBMBG@A@AA@AAE@MDGBC@GKAACCIEECCAECEESWECAAAACAC@A@AB@@BBBDFJBB@DBD@FAFAHABEHAD@@KLEFCBCAAA@A@G@MAEACCCACCCCAAAC@CAEAC@CA@@A@CAA@A@AB@@CBEBEBKFOFEBEDIHCBCBCB@@A@EAGGCAAC@C@A@C@CFEDCFCBA@ABAAAAA@@AACAG@I@Q@EAA@AA@ABCDEBCBABEBC@@@ABA@A@A@C@AAA@CAC@AAAAAACAA@AAAAA@A@A@A@@@AAA@A@E@AAA@EAA@ACCAC@@ACA@@AC@AAC@C@A@A@AB@@AB@B@B@@@B@BBD@D@B@B@D@BBF@B@B@B@B@@ABA@A@@AA@CGCEEEACECGCGEIECAAAAAA@A@@BABCFADCDIFEFCD@D@B@@@BCBC@OEA@@@GAKEKGQMECACCAAA@@A@ABC@EDCBEB@@AB@D@BBB@@B@B@D@BADAD@DBDBDBBBBDBF@BABC@IBMBC@C@C@A@CB@@CHABCJAB@BABEDA@C@E@C@G@ADA@AB@DBF@BABA@EBGBAB@BABBHABCFAFADBDBADF@@AA@B@AA@@AA@BB@@@@A@@@CA"]],encodeOffsets:[[[118282,33246]],[[118281,33248]]]}},{type:"Feature",id:"341226",properties:{name:"颍上县",cp:[116.256772,32.653211],childNum:1},geometry:{type:"Polygon",coordinates:["@@M]O]ILAFELW@O@CPAFEP@DGBYJGDGPCHADARENCLCJAHEPCHS`CAKFMFiDYJA@IDADAF@BCPENM^CFKFCBWJGDCBC@CBKBC@c@IGOFEBEBEBCBE@C@GDEBEDEDCD@DDHBDDDBDBDDFBDDFBBBBDFFDBFDBBFBBDDDFBDFHBBBBBF@DADCDEBEFEFCDABCHAF@FBF@B@D@H@B@D@FDFDFBFAH@F@D@DAFADCFCDED@BEBEDED@F@B@H@DCHAB@BAFCF@DCDCD@DBFB@DBD@FBHBF@HBH@FB@BBB@B@@@BDFDFBDDFDDDBD@DAFCDCDAHCFBBDBFDH@B@@DH@BDBDFBBBAB@DAD@LDJBB@D@B@FAHCB@FALIDAFAF@D@F@BBBBBHDDBDBBDBFBFBHBB@B@BBB@B@D@N@DBB@BD@DADAB@BB@BBB@B@D@DAB@BAHABADC@C@EAGAC@@BE@AFABAD@BA@ABABIBCDCDCDADAB@B@D@HANGHCFAHKBCBABC@CAAECGCAECE@C@A@ABAD@D@D@D@BBDBDFDDDBB@B@B@FADABAJABABDFFDFHLBBBBD@B@BBABADC@EBG@ABA@CD@@@BADBB@BAFABABAD@@BBDHBFBD@BBFBDBDBBFFDBBBDDFBFFH@D@J@@@BBBBDDBBBB@B@D@B@B@B@D@D@B@H@BAH@D@B@DBB@D@BBB@DDF@DBD@BBB@@@B@@@B@BBJ@JBB@BBDB@BD@B@BBD@B@BHCFAFAH@@@H@F@F@F@F@JBDAF@FADAFC@EBC@E@CDEHAJAFCDABCB@FCFAD@DCFAHAB@B@FAH@FBFBFDHBDBB@BBB@BBFBHBFBHDF@JDJB@@@@LDHBHDFDDDDHBBFDFFDD@@FB@@DFBBBBB@D@B@DAB@TADADABABABABC@C@CAC@A@AAA@CAC@ACCAEAAAAAC@E@C@ABA@A@ABADCBAD@BAB@DAB@H@D@BAB@DBB@HBFBDBB@DBB@D@BAHAB@DCBAHCFCB@BAHEBC@@@A@@@C@A@A@A@CAGAA@AAAAA@CCE@A@A@ADCBAHCB@BA@AA@CAAACCAA@AAA@C@A@C@@@ABABABEDA@ABABA@ABA@@@ABC@AACAA@E@@ACBA@G@A@O@EAE@AACAECG@A@A@CD@@C@@AC@A@CBC@C@ADAB@PC@AAI@GAA@A@GACA@E@ABGM@A@ABADC@AAA@AAAB@@AB@D@BAB@BC@@AC@A@ABABABABABADABADC@ABADAB@B@B@DBHBD@FAF@NABD@BDBH@LAF@BABA@C@E@A@CAEBGBABAD@D@FAHAJ@D@BABA@ABA@E@ABC@C@CBCBCDCBEBC@AB@@GBE@CBABABAB@B@BAB@@C@A@AAAAAA@A@AA@@BGDC@E@G@@@A@@DBF@LA@@JCAA@A@IAKBCLBD@F@L@B@FBF@D@FBPBF@J@F@LCNEF@FADABADCBADCBA@CDIBE@CBC@EDIMCOGMGW@G@C@]@A@Y@A@ogMICCUQ@O@MAOKSMa_JCOCQCEGMYAYJOFSHGBOCQCWEIAKDABEBEBABOHiTGDS`GNIT@@O\\YVS@G@U@K@cBE]CKCGAEcUDUBADO@ACOIAKPEHYMSHS_YN"],encodeOffsets:[[118980,33234]]}},{type:"Feature",id:"341282",properties:{name:"界首市",cp:[115.374821,33.258244],childNum:1},geometry:{type:"Polygon",coordinates:["@@BCBI@C@E@CACACCECCACCCCAACCCACACACAEA@@G@I@CFEDEDABC@E@C@E@@BE@CBA@AFEFABAF@DBF@DBH@B@B@HAFAFCFA@ABADCBEBCBCBEFKBEBCDEBCDABCHGDAFCDAFADCFAFADADADCDAFAHCFAB@B@@GAE@A@E@C@@CA@AAA@ACI@E@C@E@@@C@C@IAC@@B@BABABAFCBAAA@IAC@AAA@@AAAACCA@CMAG@A@A@@@@@@BA@@@@A@@CABAACAC@CAC@AAA@A@@@ABAB@B@@@@BBDBBBB@DAB@BB@@@B@@@DA@CDAD@DA@@@@@AB@@AAAAQMGCC@A@EAK@C@ABC@CBABABGFABABA@@@CBAA@@@C@C@ACAAAA@C@E@@A@C@ABE@A@AACAA@@A@A@AB@DAB@BABKLC@ABC@A@EAA@@@A@A@CBA@C@AAA@@C@A@ABABA@A@ECAAAC@GA@@ADA@FHBFEDCAAAAAAAA@ABA@ABA@BB@B@@@B@BD@@ABDE@@BA@@D@@BB@B@DA@@@A@@BE@A@AB@D@@A@AACE@@@@AA@@@@AB@@A@A@A@A@AB@BA@@@E@C@ABABAD@D@B@@EHADAB@BCBCDABGDG@E@ECE@CD@F@F@FCD@@A@EBEAC@ICCAG@CF@BAB@HFBDDAFABCBEDGDA@CDCBGDEBAB@@A@CBC@A@@B@B@B@DAD@BGBMFMDCBABAB@@A@A@@AAE@CACA@@@A@C@C@AB@@@H@BB@@@@B@@@@ABC@C@A@A@AAACCAA@C@A@@@GFC@EBA@@B@B@B@@@FDH@BBH@DAN@JAPD@D@HCDADAD@F@B@BBBF@@BBBBDARC@@B@D@BADCB@B@B@B@@BBDBL@B@B@@B@D@DCBAB@BBB@@BDN@BC@AB@@@B@BA@AB@@@@@BDJ@FABABAFADAFAB@B@H@@FJ@D@@@B@BCB@B@@@B@@C@@BBJBB@DBB@@@B@B@@@@B@B@BBBBBBB@LBLBB@BDF@DBF@BBB@BB@B@D@BCBIBGB@B@@ABBD@@@DDBDBHBBB@@BDBBDDBBBBBB@B@F@@CJ@D@HBDHHHFDDBD@F@B@B@@@B@@@B@@@B@@@BA@@DAB@B@BA@@@@B@BAB@B@B@@AB@@ABA@AB@B@@BJ@B@B@B@@@B@B@B@B@@B@@B@@@@B@@@BA@BBBFBDBD@D@DABADAD@FABBD@B@BD@BAHCF@B@@@D@DDJ@HBF@D@@FT@BA@CBI@IAEBAB@B@BBF@FAJ@B@B@DCJ@BCP@BEBEAM@@@C@G@G@I@A@ABABAB@B@B@B@BNT@BDDBBFBBFDD@BBB@B@DADCDCFABMR@DAJ@D@H@FDF@@@BBB@F@B@DABABA@@DD@BAB@BBF@@@@@@DBBABAB@@BB@@@B@@BDDAFC@ADCB@BEB@BA@@B@JBBBH@BB@F@J@LBFBH@PA@@@@BC@@@ABA@@DB@@B@BB@@@BAB@@@@B@@BDA@BBB@BD@BCBA@@B@@B@@@@BA@@@@B@FAB@B@@A@@B@B@BA@@@DDDFDDLHHFBBBDDD@@B@@B@@@@@@BB@@@@@BDCDADA@@@GB@D@D@D@BADAB@DAHE@E@@@AB@@FB@FC@@BA@EBAB@DDBB@@JERKDCFG@CBCECC@AAEAI@CAC@EAEEAEBEFEFCDCFCBAHCDCDAFC@@DADCDAFCDADEBCDC@C@E@C@CBC@E@EAEAECAAGBA@CBCBCBEBGBCBCDEBG@CBE@E@E@CBC@CDE@E@E@CBG@ABE@C@A@@AA@E@GAI@E@@@E@C@A@CAE@CAC@E@CAG@A@A@EACBCAIDEFEBCB@BADEBCBC@A@@@@@CCE@E@ABE@A@EAA@AA@AGECCCCCECACCECEBC@ABCBCBCBCDIBCBCBA@@"],encodeOffsets:[[118214,34023]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
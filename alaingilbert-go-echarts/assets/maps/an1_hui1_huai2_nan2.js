@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("淮南",{type:"FeatureCollection",features:[{type:"Feature",id:"340402",properties:{name:"大通区",cp:[117.053314,32.631519],childNum:1},geometry:{type:"Polygon",coordinates:["@@LDFBFBJDD@@@@@DDDBFBFALAFADAFCHAD@F@F@D@DABABAAC@CBEBCD@HADBJBFBFA@ABCF@BCD@DBDALA@@@A@ADAJEFABAEAOKICAAAE@CAC@CACAC@KCCGAEAC@ECCCAACAEACACE@CBEFGJGDEBGFU@@BGTgJYB@BC@A@@DGBCBC@U@O@EBCBGLQBABA@ABABALI@ABC@G@AACCEACEAECCAAEAEAE_@A@E@C@@FG@CAG@A@@@AA@@A@A@A@A@EB@@C@A@AAA@@A@@@@CC@@AEA@@A@@AA@@ECAAA@AACA@@@A@@@@A@@BA@@AA@@@AA@@@@A@@B@@@@A@@@@@A@@@A@@B@@@@ABA@A@@@A@A@A@CD@@A@A@AACBA@CACAA@AA@@A@@DA@EHA@@BCBABA@A@@@@ACA@@@@A@@@AB@@@BA@@@AA@@A@C@AB@@AB@@@BAAAAA@A@A@A@@@A@A@A@A@@A@@@A@@@@@@A@@A@@@@A@GECAYNGCU@EDADNP@BA@AB@@A@AB@@A@A@@@@@@@AB@@AAA@@@ABA@A@ABC@@@A@@@@@@BA@@@B@@@@B@@@@A@@@A@@@@BCBABA@@@@@@BBB@B@@@@ABCBA@@B@@@BB@@@@@@BADBB@@A@@B@B@@@@@@@@BB@B@BF@D@FDBD@J@DABCDGD@BADBPBVJN@DABBB@B@@@@BB@@B@@D@BBBJC@B@D@BBB@B@@@B@BABA@AD@BA@BD@@@@@D@@B@@D@DBBBC@AB@@B@@B@@@BABA@@BBBB@@@@@@@B@@@@AB@@AB@BA@@@@@@B@@@@CDAB@@@@@@ADCFEF@@@BB@LB@@@@@@BBD@BBB@@@@@B@@@B@BBD@ABBB@@A@@D@FF@@D@@@@@@@@@@@@@@@@@D@DG@@A@@@@E@@@@BC@@BDB@@A@@@E@CPADCFLFD@DBDBHBB@DBNBNBD@DBB@BBHDDBDDHHHJDJ@F@DADEHCFABCBA@EDED@BABBF@DBDBDDDB@DBPBFB"],encodeOffsets:[[119911,33535]]}},{type:"Feature",id:"340403",properties:{name:"田家庵区",cp:[117.017349,32.647277],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@A@@@@@@@AC@@AA@@@A@@BEAI@GEEAAEAiB@@BNBJ@D@F@BDHDDDD@DA@@BAHAL@B@B@D@BADAF@DAB@BBBBB@B@B@@ABBHBF@J@HFBJAFBHDHHBBFDL@DBBR@FEJEBECGCG@CBCFCDGDCD@FBFHZ@F@BEFAD@DDFAFCDKJIPCDCB@@SFQHGFIDEBIAE@EB@F@FBFHHB@BBDD@FAFAD@P@FDDHFBD@DEFCBKAS@@@C@C@ADB@BAB@B@BBADC@@DADGA@@@@@@@F@@@@@BB@@@AB@@@BCHEBAB@@@NABCFAB@B@BFF@D@H@DDRDJDFHHBBNDPDFBD@DBF@D@DBJ@NBBBR@ZAB@B@@@FA@@DALCLENCF@D@JDFDDDB@@@BBDD@@@@FB@@DDJFJFDEBCDOF@@@B@@@CA@AD@@A@@F@@@@@@BH@@C@C@@@@@@@@@@@@@@@@@CE@@E@CB@@@AABAC@AAA@@@A@@@@@A@AAC@AA@@@@@@KAA@@A@@FEDEBC@@@@@@BADC@@@@@A@@@@B@@ABA@@BA@@@@@A@@@@@@AAAA@@ABAB@@A@@@@AA@@BADAA@C@CA@@@@C@@@@ACB@@ABCB@BA@A@A@@@AAA@A@C@AIDAA@A@CA@@@AA@@@@@AAABA@CIMAUAOBC@AHCDCBA@C@IACECC@E@@A@AAA@@@@@@@@@A@AB@@@AABC@A@@@@A@@A@@@AB@DABA@@@@@AAA@A@@@@B@BADA@A@@B@@@B@@@@@@A@@A@@@B@@A@@@@B@@@D@BAB@B@BA@@B@BB@@BA@@@@@@B@B@@@BAB@@@BAB@@AMOBCFCV@HDZMAAACAIFADCBCAI@GCAACAA@G@EACA@AAAC@@ACCC@C@C@A@C@A@@B@@@@A@A@A@A@AB@@AB@AEACCE@AAAA@ACAAAA@@AAACA@AC@@AAA@AAAEA@AA@@@CA@@@AAAAA@@@@AA@@ABC@@BA@@AA@@@AA@BAAAB@@CAA@AA@@C@AAAAAAC@@AB@@AA@@@A@@@A@AA@@CA@@A@@A@@@A@CA@@A@A@C@A@GA@@CAAAA@@AC@A@@AAAA@AA@AA@AAA@A@A@@AA@"],encodeOffsets:[[119820,33183]]}},{type:"Feature",id:"340404",properties:{name:"谢家集区",cp:[116.859188,32.600037],childNum:1},geometry:{type:"Polygon",coordinates:["@@aAQBKFGHGHOX@JAF@F@^@VBJJLVNHDABGDQDSFCFBFNHJJFL@L@JEFOJABEFINCFCBIFAHBNAFA@ABM@KFCDCFGFCDIHCCGECAE@AB@DCHCFAFBL@FCDCBYDEBAHA@@B@F@FHAF@@BBFDDDB@DADAFABILCDA@@DB@@BB@D@BB@@@B@@BB@AB@@@BA@@BA@@BA@@B@@@@@BA@@BA@@DAB@@AB@B@@@B@B@BB@BBBB@@B@@B@B@ACBC@@@ABCB@BAD@B@@BD@AA@@BA@ABABC@@@C@A@CH@B@B@B@BAFA@@DADBBB@@AB@@ABADADABBBDC@@DF@@@B@B@@@B@@@@AHA@D@D@B@@@B@@@BA@@@@FC@B@@@@@@B@@@@@@@BB@BBABA@A@@B@@@DCB@B@B@@B@@B@B@B@B@@@B@BB@B@BA@DFAB@@B@@@@BABA@@BDDB@@BBB@BBFK@@@KE@@A@@B@B@@@BB@@BB@DBDBFNDD@BBD@@GFIFIH@DABGDEDB@MH@@DDBDDDDFBB@@BABBBDFEFCLGBA@@DC@@FIBEBC@@DGHJ@DDAD@B@F@B@FBFDDDD@BBD@HAB@CICQ@C@G@CEE@A@ABADEBA@M@@BAFADG@A@@BA@@A@@A@@@@@E@@@@@@HBBC@CD@BCAAA@A@ABA@BCD@D@@@T@LBDAFE@CACGECC@E@OBCBE@ECCAAA@GGAE@E@EFAF@JBFAJCHERGTE@@DADCJOLIDCBECE@CBCFE@A@EGYAE@EDCHCDCDEDAH@HDFDFAFI@EAQCAK@ECAAGGGCEAIBEA@G@IAEAGBA@@@A@AAAAA@ABA@CBEBC@A@C@A@ABKBG@AB@@CCCCCCG@A@E@CAIAMO@[BK@A@A@UBI@"],encodeOffsets:[[119742,33165]]}},{type:"Feature",id:"340421",properties:{name:"凤台县",cp:[116.71105,32.709444],childNum:1},geometry:{type:"Polygon",coordinates:["@@QCKCWECJ@FAD@DAFCJ@DABCDABCDABCBEBE@MFKDE@I@E@OAEAC@E@EAA@K@E@C@KAADBL@J@BBBID@@KBE@CA@@@B@@@H@FCDAH@@BBB@B@BBBB@B@B@DA@ABA@A@ABABAB@DAF@HA@@BADAFCDADAD@D@DAD@B@FAB@BABABC@I@GBEBC@C@ABABAHBF@D@B@F@DABABE@KBG@CA@AACMBE@EBC@GACAA@A@A@CBAB@BCDABCBABABABABAB@B@BBD@@ADA@ABC@A@@BA@BB@BBB@BCDAB@B@BHNBAF@B@BD@H@BBB@HBJ@BODA@CB@B@DAD@D@BBD@@@DC@@D@B@BDHBFBD@BBF@F@P@B@HABBD@@@FBBBD@BAD@B@@AB@BABAB@BCBAFABAB@B@@@D@B@DBB@BBBDDBBDBB@@BABA@GDABCD@B@B@BDF@DBBBB@BBBBH@D@B@B@B@D@@@B@@ADGFABA@EDGDABCDA@GBABC@A@CAA@CAEAGAA@CAA@ABC@G@A@CBA@ABC@ABCDAB@B@BAB@B@D@FBDBBBBBFDD@BBD@DBB@B@BBD@D@D@@@D@BCH@D@D@FBH@B@B@@ABEF@@CFAB@BCDEFADIJ@@CBQRAH@DBBBD@@BFDF@D@BBDBF@DB@@BDDDBBB@BAB@H@DBF@BADBBBDAD@DAF@B@BDFDBBDBD@BD@DAJAVGLADADARCTGJAXGFAB@NCRC@@B@XELARCJAPEPCZEJARCNCnIFAhI@@ZERCJAJAHAD@TA`@@AB@T@R@TAZARA@AAG@A@AD@B@@CBKCE@AAGACAAOBA@AA@@CI@A@C@M@AJ@BA@AAEACAA@@A@CGCE@AAC@@AAB@DCDABAB@AACCCCCACAC@EACACAAAKGCCCAAC@ABA@A@ABA@C@ABEBABI@ABA@@@A@EAGCM@EAC@CBABA@@D@@@DG@A@AAAB@@CBABA@CBAFABA@ABCBAFCB@BABA@CAE@CFCDCFFHBJAD@@@@BAD@@BBFFDB@B@@B@DBJBB@D@B@DABADCFIBKBABCB@FBB@@B@@HADAB@BA@A@@AA@A@@@CBABEBABC@C@AC@ACACFUF@B@DAB@DAB@JEBAD@@E@A@ACCCEAA@ABCBE@ABCB@JA@@HAHAF@HCB@RECEDAEEACCEEGA@CBGGAEAA@A@ABAJEBADC@ABA@AAGEGGEGEACBC@A@C@CAAE@CBCBABC@ABABAB@BA@ABA@A@@AAA@@@@@ACCIEGACCA@@ADGHWBC@EACACAEEIIGECEAQGGAOGA@UOIIACEECEACACCGGEGECAMEMC"],encodeOffsets:[[119462,33343]]}},{type:"Feature",id:"340406",properties:{name:"潘集区",cp:[116.834715,32.77208],childNum:1},geometry:{type:"Polygon",coordinates:["@@BCDAFAD@DBFBB@@C@A@G@AAED@F@HKDG@C@ABCDALAFAF@BC@AECQGAA@AFE@@B@@@@@D@BBFDJFHBHBD@BCJID@LHVNBADEFGBC@C@ECIGIGGCCCAGCAAA@CAC@MAMACAA@GACACAC@KEIEIECC@@EA@@@@CCAA@@A@CCECICC@E@MDKFKDCB@@EB@@A@A@YBQ@AAMAI@CAC@E@CAC@EAOCMCAAGGCEA@GBC@AAC@CCECEAA@E@A@C@CB@CGICH@@ADAFEJ@@CD@@ABKHEDEFAC@BGFIFABCCEEEGAACC@@AA@@AAAAGFABCDAFABAB@FAH@DAFAHAFGF@BEBEDA@CB@@CBC@@B@@A@SHEBAB@@QFA@GDE@GBGB@@IBA@AD@BAFAD@BBBDFDD@B@B@FC@ABIFA@CBA@CBA@E@EVBDBDD@@B@DADABAFAB@D@@@BBB@@@BABA@CBGB@@@AA@EAA@ADABALEJCDABCBA@C@A@IACAA@@@@ACAEEAA@@BC@A@@C@IBGAEECDED@DBF@DABABA@EDABAD@BABEBAB@DABAB@DA@BB@B@BCH@@C@@@ABAB@DBD@FDNBH@F@B@@AB@BAJABAF@B@DAB@B@BAB@BBDDBDDLHBBDBDBFBD@DBDBDDDDBBA@ABCBCDA@BB@@BD@BDFDHB@@@BBBDBF@BABI@@B@N@D@BDJ@@BBB@PABBBDBH@BDFAL@DA@C@@B@BBH@BPCRENCFAD@DAPCVCD@DA@EBCDCDADBBBDF@BNATEBIB@DABA@GBCDAD@DCBAF@FBDBBAJGHCBAB@BAJCB@P@JAFA@ABA@CEGAGCABADADC@@ZO@ATKHEHGD@HE@@BABAFCDAHDFBF@FAFEDE@EACCAFCFCLIDARKHC@CCC@C@CB@LAD@JBDCFCLGDAJEJEFED@HAFAZGLAHAFADA@A@@@@AA@@B@D@B@D@@@BABABCBABABABAFCBAFIBA@A@C@@"],encodeOffsets:[[119825,33547]]}},{type:"Feature",id:"340405",properties:{name:"八公山区",cp:[116.83349,32.631379],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@@BBC@@AA@C@ABA@AD@B@@ADBDA@A@@@@AA@AA@AAAA@A@@@A@A@@BA@CB@@AB@@AB@@@@A@@@AB@@AB@@AB@@A@@BAA@@@A@@AAC@A@@AA@@CCDIB@B@BADABCFCD@BBF@D@DADAB@BABCBCBC@IBC@ABAB@@AD@F@FBB@D@D@BADBDHFHFFHBH@BAB@BCDABIFAB@B@BBBBFHHDAB@FHDFBDFFCBDF@@BAFATGB@@@@AD@DA@@DAB@FCFA@AHEBEBGBE@CBG@EBABABEDCBAHEBBBB@@BB@@DDBBFHFFDDBAJEHE@AAAAB@@AACECCACCC@@NGA@FCHCBA@CJGJEHE@@AC@ACCEMCACAA@@AA@@A@@@A@AB@@@LF@@L@AE@AAA@AA@CC@AB@BA@A@@A@@@BACEB@@A@AAAA@@@A@A@A@A@@@@AA@A@A@CD@@A@@@@BABAB@AAA@@@@@@A@@@@@@@@AED@@@@AB@@A@@@A@C@C@B@BG@@@@@A@@@A@A@@CE@@CDAABABCBCBA@@BA@@AACACB@@EBABA@A@A@G@@D@B@D@@ADAB@B"],encodeOffsets:[[119640,33391]]}},{type:"Feature",id:"340422",properties:{name:"寿县",cp:[116.798232,32.545109],childNum:1},geometry:{type:"Polygon",coordinates:["@@GAWAMCMEGEAECEECAAGCGEEEECCEDGGGKAGCCEIEEECACCGAI@MM@CMEKCAIFOEAMVMCA@MCGDJBFFHJIFC@@BG@IBMFK@I@GAGAMAGCGCECECGCGCGAGCG@IFEJCLBH@F@H@H@P@RAVDVAFCHABGBE@GBEB@@GDKD@BEB@@ABGBCBOFA@KFGDGB@@@@CBOFEFCDEDGFCBCBABEDEDGDA@CBGBGAA@G@CBIBCBC@GBGDGBCBBBB@BHDL@FBB@DABA@@BCFCNABABC@@B@BBF@D@DBB@BDD@BDBB@@@BBE@DB@D@DEHCHCD@DSPEPABGTFRBH@@BJ@PAFCNSNGZAFEVPTAPQNGFCFHHBBRFPZJJAH@DIT@B@@@HJV@DAPGVAHAfCDQPADMdSREDA@CDAFENAJ@B@B@BAFB@JVNPAFEDEBEFEDADADDFBD@DBFBD@F@FBF@D@DBHHNDFDRDP`INbLTBP@N@PVRDDNJphB@Z@B@^@D@H@X@NHPHNDXFLDRDNDNFDBHFHFDHBDBDDFFFBDJJVPB@PHHBRHFBFDJHFJBFBDBD@FADGXCH@BB@DDHBJFDD@B@@@@BB@BB@B@BAB@@ABABABAD@BADADAF@@E@EBC@@BABAD@JAD@DADABA@ABABC@C@CAE@ADCDEBABC@A@AJADCB@DCJKBABEBC@CCACCAE@AE@GB@E@E@AB@BGFAZCDADC@EAKBEDEDG@CBAF@DBHFDDJGDCHEDEDCLEN@BAB@BEAMBGJEDADEJMFEBAPIFE@I@KEKIIMGAEDETERCHCBAGCUMIKAI@U@]@EBE@IPWHGHGLERAbBJ@VAB@B@L@\\AP@@@jAFBBBRCJALAFBP@PDB@B@BA@A@C@IBEAGAE@IAG@I@E@CAEEACCDEBCDCBEH@FDDBFAFABCACAAEGEEGC@CAE@AAGCCCCCCACCE@CAE@CBE@EBCBGBEDCF@D@FDDDDBBBDBDBFBHCDEDCBC@@@@BC@CBG@C@CACCGCCAG@C@AAGAE@CACAAAAACECACECAACAECEAA@EAG@C@E@E@C@E@C@CAEBG@CAECCEEE@C@CBK@CNI\\MACBC@E@C@@@C@EEECGACCACCCACAGCC@GAE@E@E@GBE@E@EAAEAE@EBA@EDGBC@EBGBCBEBCDCBCBC@ADAHCDA@@HAF@D@B@FCDEFE@@BEBCBGBCBEBA@C@@@C@CBC@C@EBCBE@C@@BCBEBADCDCFCFADCFABABADCDE@E@GAC@C@CAGAECEAEAECCAECGEGAA@ACC@AEACCCACAMCMCIEKABFBJCJGDGHED@DAHBNADK@G@E@K@EBSHCAC@KBGFODGADIBCBGBC@EEGEACAAAACDCHI@OPQAAAAGCGCCAGCQCOCOAC@"],encodeOffsets:[[119701,32744]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
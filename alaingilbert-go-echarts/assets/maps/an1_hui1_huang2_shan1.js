@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("黄山",{type:"FeatureCollection",features:[{type:"Feature",id:"341002",properties:{name:"屯溪区",cp:[118.315329,29.696108],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBCBCDABABC@A@@BA@@B@B@BBB@BBBBD@BBF@D@D@B@D@B@D@B@B@@@@@FKJABABC@A@A@EECAC@A@IAC@KCA@CAGCC@EDE@A@ABADABEHIJ@B@DDB@B@FCDAB@DCB@B@@@@JHHBB@BA@@@@DBDD@BAD@BDFDBLDH@D@D@@@B@D@B@LBNBF@HAB@F@B@@@@BDFBDBDBF@FBBBD@DAD@BAD@B@@A@@A@@A@A@@@AB@@@B@@@@@@A@@AAA@@A@AA@AAB@@B@@B@BA@@B@B@@@@ABC@@@B@@BA@@@ADA@AAABAAABA@@A@BA@@@@B@@ABB@AB@@A@@@@@AB@A@@@B@@@B@@@BA@@@@@B@@@@@BB@@@@@@@@@@A@@@@@@B@@@@@B@@BB@@@B@@@B@A@@B@@BBA@@B@@B@@@@@@@@@@@B@A@@B@@@@@BB@@B@@@@@B@@B@@@@@@@@@B@@@@B@@@@@@B@@A@@@BB@@@B@@A@@@A@@@@BA@@@A@@BA@@B@B@@@@@B@@A@@@@BA@@BA@@BA@@B@BA@B@@@AB@@@B@B@@@@BB@ABB@@BAA@BA@@@@@A@@@BBB@@@@@B@@B@@B@@AB@@B@@A@AB@@@B@@AA@BA@BBB@BB@@@@@BB@@@B@@A@@@@@A@@A@B@BB@@B@@@BAB@@@B@@@B@B@B@@AD@@@B@@@@ADAB@@B@@B@BA@@BB@B@@@@@@BOBFBLJLFJBLBBAD@JIT@NDDGBIFGBBBABBBB@BB@D@BC@CDCDAJCBCDIBYe@@UFID@@@DBDBDDDBB@B@@ABCDADAD@D@DC@A@ABAD@D@DABABABAD@B@@@D@FE@A@CBA@AD@D@F@JHFDDB@@@F@F@B@BBBBB@BBBDFFD@@B@@@BA@AB@@E@A@AB@@@B@B@D@@BB@B@@BB@DF@B@@B@DA@@BABC@AB@BAB@@@F@@AB@BAB@BA@CB@DA@BBABABC@@D@@@D@@@@@@ABAB@AC@A@E@ABEBABABA@@D@BAB@B@@AB@@@BA@@@CAAA@AECIACEQ@C@A@AA@AAC@A@A@A@ABCDKPA@I@GE@C@AAK@@CMAE@CKACBC@E@GC@@CACI@A@AHC@A@@CAEAAABABADABABABADAB@B@FAB@DECBA@C@C@@A@@AA@AAAAA@CB@DAB@BA@CACAA@@ACCECCECCACA@AA@G@CAC@C@G@CDEDABEBAB@B@B@@FHFJDB@B@BABEDCB@B@D@@A@ABC@A@ABC@A@A@@@AAA@AAA@CCA@A@EBAB@@@@CD@@EHAB@B@@@@@@AD@B@BAB@@AA@@@AACCAAAA@AAACAA@@AA@@CEAC@@@@JIBADCDAFEB@@C@@KGAAGG@@@@@ABA@A@@BA@@AA@@A@C@C@EAC@CBCB@@ADABGDCBA@"],encodeOffsets:[[121100,30376]]}},{type:"Feature",id:"341004",properties:{name:"徽州区",cp:[118.336743,29.827271],childNum:1},geometry:{type:"Polygon",coordinates:["@@BADC@@@@FADC@A@@AAA@A@@A@C@A@A@CBA@ABA@@@A@@AAA@GAA@CCAAA@@@CB@@CA@@A@@@CAC@@@@A@CBA@CDADAD@@@BAD@D@@ABABAB@FDDDHBBB@BB@BB@B@DAB@BAB@B@@BDNP@BJ@J@HABAJCJCDC@@ACACAICCAAEEA@@CAAAA@@@C@C@@@ABA@A@A@AAEACA@ACAGAE@A@A@A@@@AC@@@@BA@A@@@@@@A@A@@CAA@A@ABA@C@CACACAAAABA@@BE@@@A@ABA@@BADAB@@CBA@@@@ACEA@@AA@A@@AC@A@A@@@A@@B@B@FA@@BAB@@A@@@ECCEAA@AAAAA@A@A@E@E@@CAECIGE@C@C@@BAB@D@BEFC@@@A@C@ABABABCBC@C@AB@B@BCDC@C@CBCBAD@BA@A@CACCCACA@@C@EJ@Vf@AZCJADIDCBCD@DADC@A@@AAAAAABAAEHAJCHMCS@IJC@ABKAIAKEKIEAEDGDCZ@JAL@J@J@DALGNAD@HEJCJGFIBMBKEGAA@A@IAEBIHEHEJCL@BAFALCLAHEHEFIHGFEFOLELANCJG@GAIBCDGLAFAFAL@NBNBLB@@A@ABA@ABA@AD@@@D@BABBBA@BB@B@@AB@@@D@@@FBJD@A@@BABA@ADA@ABABADADAB@F@B@D@@BBBAF@FABCD@B@B@D@@@@D@B@BBBBBBBDDDBB@DDD@B@B@B@B@BD@LEDA@@BBD@BBDABAB@AA@AB@@C@@AA@@A@@C@@AAA@@A@@@A@A@CAC@CB@@@B@D@B@D@B@B@B@@@@B@@BABAD@B@D@B@BADCBBBB@@B@B@@ADA@AB@@B@@@D@B@DBDFFBFCB@B@BBDD@BBBBBB@@AB@B@@@@@@B@DBB@B@B@B@FBD@BB@DBB@BAFABCF@B@@@D@@@B@BA@ADADCF@BBBA@AF@B@BBBB@F@@@@@AB@F@B@D@B@B@@D@@BB@@AB@@ADABA@@B@BB@@BBBBB@B@D@BB@@D@DB@@B@BADAB@B@BB@@D@B@@@@DBBDDB@@BBBBB@B@BDB@BB@@BB@@@B@J@@@BBB@FCB@H@@@BABAB@BAF@@A@A@@BBB@B@F@B@B@BADA@@B@B@D@@BB@B@DABAFAB@B@@@BA@CBA@AB@@ABC@@@A@AAC@@AAAAAA@@@@BA@@B@@@@A@A@@@A@AAA@A@E@AB@B@@@BAB@@ABA@A@@@CAE@C@@@A@A@@@CB@DABADCBABA@@AAAC@AA@@@AAACACAA@A@AA@ABEAAAAA@A@@@@AB@@@@@D@@@BEA@@@@@ABC@AB@D@DA@@BBB@@B@@B@B@@ABE@@@BB@B@BABFB@@@BCBA@@@A@A@ABAB@@A@@A@@BA@@@AAA@A@A@@BA@EA@@@D@@@@A@@E@C@A@@@@A@ABED@@A@AAED@@@AA@@@C@ABE@CA@@AAA@@BAB@BADAB@B@BEBEB@@@@@BAAAAAA@A@@@AAA@A@@@ABA@ABACC@A@@BAD@@@B@B@@@B@@AB@B@B@BBB@D@@@@@@@@G@C@@@AB@@C@C@A@A@ABABCBA@AB@BAB@D@BA@@@AAA@AA@BCAE@A@ABABAJCHCHEBA@CEM@A@A@AFEHEDCJEPEB@LCDADCFEFIFGJIDEBG@AACA@AAGC@A@@AA@@B@@AAA@ABA@@@@@A@@@A@A@A@@@@BA@@BA@@@A@@@@@@B@@AB@@AB@@@B@@@@@@A@@B@@@BAB@@BB@B@@@@@@A@A@AA@BA@A@A@@@AA@@A@@@A@@B@@@@A@@@A@@B@@@DA@@@@@A@@@@AA@AABGCC@GEGE@@C@MFE@A@ABAA@@@A@ABE@C@A@A@A@@BA@@BA@ADABAFE@AB@@AAAAAACDBDBBB@@@@BBBBD@@ABAHFFADAEI@CAC@C@A@@@AB@F@BB@@DDFDBB@@DDJHD@DB@@D@B@@@@@"],encodeOffsets:[[121214,30560]]}},{type:"Feature",id:"341003",properties:{name:"黄山区",cp:[118.141567,30.272942],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@ABEB@AA@ADEBCBCB@@A@A@@@C@@@ADEBABE@AAA@CAAC@EAA@A@A@A@CAA@@@@@@@@ABA@@AAAAAAC@AC@A@ADAAEEEAC@C@A@C@@@AA@@BCB@BA@A@@@AAAACDABA@C@A@C@ABAB@@@A@@A@A@A@C@A@C@A@@@A@@DBD@D@B@B@@@BB@BB@@@DB@@@BB@@@DA@@BBBA@ABCBAAC@AA@@CBKFC@@A@A@A@A@ACC@CAACCACAAAAAAA@C@@@@@@C@A@ADCBA@EBEAA@AC@A@E@A@CBCBABAB@BCB@BABAB@@@BICEA@@C@@@A@@BA@A@@AABAAABC@@@C@@BAB@BAB@B@BA@@BBLCNCL@FCFCHEJCRGJCPCRCJADCHG@MAOAMEKIICO@ACCA@@AB@@A@@DAB@@BB@DADA@@BAD@@BB@DBB@BA@AAA@AA@@A@AA@@C@AA@@AA@@AAAA@@@A@@@A@@A@@@@@AA@@A@@A@@AA@@@A@A@ABA@AB@A@@AA@A@@@ABA@A@ADCBA@CBA@A@CCG@G@AAA@A@A@A@C@A@@@A@KCGAIECAEEEEEGAEIEG@CFCLALANBLALALAJCHKLGNEJEHEHIJGNCL@J@J@FBD@JCL@DEHCBEBGAGCKEKEICCCCC@EBGAGBIDKBG@IBEAEAEAC@EACAEECK@GFCDGHKHEHEH@LDNDN@DBJFLBHDHBHCJ@F@FCHALDHBHDJFJ@JEFKBEBMFGBGBIBEDCHALBJLLLJHJFFHDNDLBFBJBF@L@F@FBLDFBBB@@EHAH@H@FAHAFDDH@LAH@H@FDFDBB@BGFCH@DBBLBBB@BDDDD@@@BBB@BB@B@D@H@B@B@@B@B@B@@@B@@@B@@F@@@@B@BEBCB@@ABAB@BB@@@B@B@D@@@@@@@BDBBF@@BBB@@B@DABA@@BB@@ABA@BDAB@@AB@BABABA@@B@@ENADEHABEBOPDBFFFDHDJDF@DDHDBDBBHFDDBBFF@L@DAHGBCFEDEHAHAD@F@F@DDJ@BBFBHBHBNBB@FBNAD@FBLBJBBBLDJDJBDDHBBDHHHNHFABCJGDGJEL@P@JBH@D@NBXAJAB@NBNDJDFFFDJDBBHDD@JDLBPBLALAHCHADADAJATIBCDGQKCIAIGEIGGKEIBEBGFEDE@GAIAI@GBEA@@A@A@A@@B@BADAB@DAJCD@DAD@DFFFBBBAH@B@B@BBBBBBDDB@D@H@HAFBB@BDBDBBBDBF@B@B@@BBJDB@D@BAJ@B@D@B@BB@@@D@@@B@@@@A@@A@@ABA@@@A@@@AB@@AA@@@BA@@@@@A@@@@@@@AB@@@@@@A@A@A@CA@BBB@@@@A@BD@BBDBBB@JBBBDBDDDF@FBF@@@@B@B@B@@@AG@E@AAA@A@@@@B@DD@@HC@@DDDDB@D@BBB@D@FABB@@BB@BBA@@DA@@B@BC@@@A@C@ABC@ABALEB@@AD@BA@@BA@AB@BC@A@AB@DA@ABA@AAA@@@C@@CA@AA@AAC@A@A@A@G@CAA@@@@@@E@ADABCBA@AAA@ADA@ABAB@B@@A@AAAAC@AB@@CACBABCFCBAFCFCFCDCBAD@D@B@B@BAFEDA@AAAAA@A@AD@HBB@B@@A@@@@@A@@CC@@EC@@BAD@BA@A@@AC@AB@BA@@B@D@B@BBB@@@BA@@BCB@B@@@DBB@HBJDBABA@ABA@A@@@C@AB@BAB@DADCB@D@B@BDB@BB@@@@D@DAB@B@CA@ABA@A@AA@AA@@BC@@CEAC@@@ABABA@@BBB@B@BABAB@DC@A@@@@@@@@AAAECG@@A@@C@@AAB@DG@C@C@C@@@A@ABABEBCBAB@D@BA@@@I@@@ABAAC@CCAC@ABA@C@AAAA@AACAAAAA@AA@@@AB@@C@@@@DAD@DBBADADABAD@BA@ABABBB@D@J@@@AAECEEGCIAM@KAICGEGEGEIECGCG@CAI@E@ABEBIBEFEF@JCHCFADGBG@E@E@GBAAI@IBIJGJ@JALCFEBABABCDADEFEFGDAJEFC@AB@BCBABEDIDIBI@IDQHOLI@C@EAA@CACGECAEAMCMGKEOGGE@@@ACCEGAAA@AA@AAAAA@@AA@C@@A@@ACECAAAAB@@A@A@EBABCBA@A@@AC@A@A@@@CBABA@A@E@A@A@AA@@@B@BE@ABA@ABAB@@G@A@EDA@AA@@I@A@@@A@@AA@@ACA@A@AAAAA@AA@CCAA@C@@A@C@@@AAA@A@CBABA@@@CAC@@@AAC@A@A@AAAA@@AAA@@@ABCB@BA@@BA@@AC@@@@A@A@C@A@EBA@@@@E@A@AA"],encodeOffsets:[[121045,30779]]}},{type:"Feature",id:"341021",properties:{name:"歙县",cp:[118.415345,29.861379],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABGBI@I@@AMOAC@@@ABA@ABA@C@AAAA@@AAAGACCECA@ABAB@BC@C@AB@@C@CBCB@DAB@D@B@@D@DB@@B@@@DB@@DA@@B@BBDDB@HBB@BB@@@B@@AB@BAB@D@B@B@D@BB@B@BB@@@BCDEB@@@@CDAB@@@@A@C@@@CAC@IGCC@@AAECCC@@AAE@A@@B@@@B@DBD@DFJCBEBGEAB@BC@AAAA@@@@AACACABDBBBB@BA@@BEFABCB@BAB@@AB@@@B@B@B@DAF@B@B@@BBBAB@F@NED@@@HFHFD@HDBA@BBB@@@@@B@@@@CB@@A@@@@B@@@B@@A@@@@B@@@BB@@B@@@B@BABB@@B@B@B@@@@A@A@@AA@AB@@A@@@@B@@@@A@@@A@@BA@@BA@@@@@@@@B@@AB@@AB@@@@@B@B@B@@@B@@@@AB@BBB@BA@@@BB@@@BHDBBB@BD@BAHCFIJEHEJEFCDCBKDA@OFIFCDGFEF@B@B@BFN@DABGFGDIDABAB@B@BBFADB@@BBB@B@@ABC@A@ABA@@BABADAB@B@B@B@D@DA@@B@@@D@H@@@@@@C@A@AAA@A@A@@BA@@@A@A@@@C@AB@@@BDDAB@BAB@B@@@BBB@B@@@BBBBBBB@A@@@@FAFA@A@ABABC@ABA@AB@BB@@DBF@BAD@@@B@@B@@FCBBB@@@FCBAB@@@@@@B@D@FB@@@@@@C@@FBB@@AB@B@B@BB@@B@@AB@@@@BA@AB@B@B@B@@ABAD@@A@AEABA@A@@A@@AF@BA@A@@@@AA@AA@@CBC@A@@BAD@B@@@@FB@A@@@C@@@@BA@@@@@BBBBBFBBAB@@B@BBBBDBDBB@@B@@BBDBB@@ABABCDABCBA@@D@@@B@B@@@DBF@D@@@BAB@BA@AB@@A@A@@B@F@BBB@B@B@@@B@B@@A@@@AB@@@@BBBBBB@@BD@B@B@@AD@BA@@BAB@DBBDBDF@BB@@@@DBB@@BBBB@BBBB@BBFHDD@B@@HFPHLFNHNDFBDBHFJEJILGJCJAH@FDFHBFH@LEHELCNALDFBJBJ@FBFBL@D@FCDEBEDCJIFEHEHCJGJCXEHEBEBCFMFEFKBGHKBECIIMOCAAAE@I@MBGFGB@BG@ABCB@B@BFBF@BDBF@H@DADADGBA@CCEAGAI@GFKBCBABCBBHINMJGB@HIF@FAJBD@HFDDFJFLDFHFJDRBJADBHBFDDDHHBBBDBD@H@DBDBFDHGD@D@BDF@BBDFFHDHFFDD@BBFBNFNDPAJ@L@LBJDJBB@FBBDFDDH@J@J@DBHBDBFFDF@JDLDJHJBLDJJDLFLFLHAJGFMNENAF@L@PDJ@PFJLHJJDLJHHHBLDJDJBJBJBLCHAHCB@HIFIBA@A@C@C@EBG@CBABCACBC@EBABC@CBCFAD@D@BADABADCBEDADCBA@@@A@CAACCACA@AA@AAADEDEDEFAD@B@@A@ABAAACIAA@C@A@AAAA@CCCAAACA@A@A@@B@BCDC@ABC@C@A@A@AA@@AACAA@CBCHGDGBAACAECAAGAAACAKAGDC@G@@AC@C@A@ABCBE@CBC@AA@A@C@@@@BA@A@C@@@C@A@A@EHCDCBCBA@@@A@@@A@AA@@CC@CACCCAAA@C@A@EBGBEB@BA@C@C@C@EACCAA@A@CDG@@DCBI@EBEBC@E@E@A@E@E@C@CAA@EAAAAACECGECCCCEA@@ECAAIGCACACCIECA@CA@ACCCCACACCCAEECAAA@CAEAACCA@CAC@A@A@ABCBABGDCB@@ABA@A@A@AAAAE@CACAA@@C@@@E@A@CAA@ACC@AAABA@@BAB@D@@@B@HAB@@AB@@@BADABA@A@@@AA@AAAAAA@@@A@@AACAC@C@GBG@C@A@CCCAACAIAG@E@@@EAQ@A@CDCFEFCBEBC@ABCACAA@CBADEBC@A@C@CAG@CACAC@CAGACAAA@EACCGIEACCCACC@CAC@A@A@CACEGCA[EC@C@CCCCCCCC@AAE@ACACAEAACAAAC@C@C@AAAGECCAA@E@CAAAACCGCMMECACCGEC@ACACCCAC@A@A@CBCDCDCDEBEBEBGD@@A@A@CCEAAAUKA@E@E@CACAE@GCIAG@CAEACCAC@C@E@A@@AAECCECAEICCCAA@CBCEEGCCAACIACACCCAC@AGAGCGAC@CAEACACCECCAEACAC@AAAC@GAC@G@EAAACAC@AAA@C@CBCBCBC@A@A@CAGAC@AACBC@G@E@A@A@A@@@CC@EAA@CAECEACAAACACAEACAEAE@EAC@AB@@CDEDCBADC@AAABA@A@C@AB@BABAFCB@D@B@DDD@@@FBJBNAFEDEHAHBJBH@DBB@H@H@HGFE@EBIBE@CBCBEDCFAF@HAFCHCFEJA@@BCDGFALBLBFBD@BDFDJDJ@F@D@@CFEHABA@E@GCKCABABCHELABCJ@B@D@D@BEBA@ABEBA@CFCFA@EBA@A@CBABABABCBABABBBFBDB@@@BGD@B@BDJDB@@HDF@D@DALB@DBFDN@@BL@B@DHFJ@B@LODCBAB@B@B@D@BBB@@B@B@DFRBDDJBFB@BB@D@@AB@@A@@BA@A@ABC@@@ABABABAF@B@F@BBDA@AB@B@@@@C@@@C@@@ADABAB@ACBA@@DABA@BBDBDBDBD@B@BAB@B@DB@@@B@B@@@@B@B@@A@@D@@B@@@B@B@BBFBHBDB@BDBF@B@B@BAB@B@@@D@D@@BBBB@DB@FFBBDDBJBDBD@@CDIDID"],encodeOffsets:[[121249,30547]]}},{type:"Feature",id:"341022",properties:{name:"休宁县",cp:[118.193618,29.784124],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ABC@ABC@CACAA@EAEACACCE@A@@A@E@A@GBE@MAKAA@C@A@@@C@C@G@KCCACE@ABC@ACCCA@@@@ABA@GAIG@@@@@ADA@CBADC@E@ACA@C@AJIFGBABCBAB@F@FCD@HDDBB@LDD@JBB@D@DBFFB@B@D@BABALI@E@@@@@A@A@C@A@C@A@C@CAE@AACAA@AAA@A@A@AB@@AB@D@BABADCDADAB@DAHCBABC@@DADAD@FBD@D@B@@@BB@@AB@@@BAB@B@@@@HHBBLH@@@DA@EFCBCDABIJ@@@@BDDF@@BB@@BBBDBBB@BBDBBD@B@@BB@@BA@A@ABC@@@@@@@ABAFG@@DC@@@@BAFAB@B@DDB@BBB@BB@@B@B@D@BAB@D@BAB@@@@C@ADAFCBA@A@ACAEIEG@@@A@ABAFABAFCDCH@D@D@DBH@B@@BDBDBFDDDDFBD@@BBBD@DABA@CBA@@DBBBB@BBB@@@BD@D@B@DADEB@FABAB@FA@A@C@C@ADIBAFKDGBABALDHDF@B@BAFGDE@@@C@ECICICE@AACAEAKBKHEDC@AB@FIDEDGBE@GBEDEFCDADAF@JAFAF@HE@G@G@GAA@CAGAIBGFGFCBEAMAI@E@@CC@C@A@CDABEBA@ABAD@B@B@BAECCAAAA@@BABAAA@@CACEAC@CAA@A@ABC@CDEDEBE@C@EAC@EAC@G@E@A@A@A@C@EBEBABA@AC@AACCE@AA@ACCACCACAA@CCACCCECAAAAEACAAAACAEAE@CAC@ABAB@@A@@D@BAB@@CBA@CBCDADCDABADAFABADC@C@C@EAC@C@C@C@A@EAACAA@A@C@@BE@A@C@AAA@CDC@ABE@CBCBABC@CBE@CBCBCBA@C@C@C@AAC@G@E@G@A@CBE@E@@@C@A@AAAC@GAEBA@C@A@CAAAC@E@C@@@ABA@@DADADC@E@AAC@CAAAC@@@ABABABCB@@A@CBEAG@CAE@A@ABCBADCBA@C@AAGCCACACCE@E@C@A@@@C@AA@@CACACACAAC@C@C@ACCCC@AEIAAAA@CCGCAAAA@C@C@CDC@@DBB@B@@AD@DCDCBA@A@AAEAE@A@A@CBEFGDKDEBEBSDKBKDCDCDCFAH@FFDBBDBFDBF@H@LAHEHGLABCFAH@@FHJHHHJL@LAHEHEFEDGFGDIDIFABKFMREBEBCBGBIAG@GBGDIBGBIBEBCBKDCD@BAD@H@D@BCLEJIFEDA@IDQDKFKBIAIAGG@@IICEEE@@AAE@EHGFCBEBG@ICKEIKIICAGGK@EDGDEBE@OAKAOEGC@@ICIBIAGB@@GBCFEDGDGBG@G@EBGDGBKDEBCBABCFCDEFEBEBI@I@EAGCIAICECECC@IGMEG@GBKBGBKAKGKGKAK@MAQ@A@MBGDEDEDEFEJCH@@@HAH@H@H@H@FAFCDGBGBC@CF@D@FCFEFABIDQBCF@D@HDHFJFJFHHHJHHFLJHFFFLJNJFDHFLFLJLHLHNHHFFJFFBFBHDHHJLDH@BBRBTDTHD@HBF@F@F@DAFEDEHEDGFGHGJCH@JAL@L@N@LDJBJBJFDDDFDHBHDHFHFJDHFHDDBFDFBFBF@B@J@FFFHBHFLFFCHEDEHE@ADEBGFEF@DBHFFHDDDHBFDHBF@H@H@@FFBFDFDHHHDDHAF@HAJAJ@JBFDBBD@H@DBDBFBBDBBBBCBCDADAD@BDD@BBFCBADADADAF@BABAFADAF@@FF@\\CJ@DD@JCNARENCHADFFHDFJJFFHHHHFFDFAHAHELAN@HAH@BBH@H@JBL@HAHCHEFEFEJAFDHDFFFDFFDJJLHJHHHJFDFJDJHHJHLFJDKDMAK@AAKAM@MBKBEBEHKDCJAHBH@DIBMFKPKFEHEJGFEFGBGDKBKBE@ADKFIFGJGFAJBB@B@HBLFNAJAHEDIFI@GBCHMBK@C@I@IBK@IDYHCFCPA@A@@@@A@A@@AB@@A@AA@@@BABC@@@@@A@@BC@@@A@A@A@@@A@@BA@A@@@AA@@A@A@BB@@@@@B@@@@A@@AA@@@@A@@AAA@AABB@@BA@@@A@@B@BA@@@BA@@@AA@@@@A@@@@AA@A@@@B@@@@ABB@AB@@AA@BAA@@@@@A@A@@BA@@A@B@@A@AB@@AB@@AB@@A@@B@@@@A@@@@@A@AB@@AB@@@B@@A@@B@@@B@@@@A@@AA@@B@@@@A@@@@A@@@@@@A@@@@@@@@@AA@@@@@A@@@AA@@@@A@@@@B@A@@@@@@@@@@@AA@@@AB@AA@@@@B@A@@@A@@AA@@@A@@@@@A@@@@B@@@@@@@@@@@AA@@@@A@@@@@B@@A@@@A@@@A@@@BBA@@@@B@@@BAA@BA@@@A@@B@@A@BB@BABBBABBB@BC@@B@@AA@@@D@BA@@@@@A@AB@@A@AA@@@BA@BBBB@@@BB@BB@@@@@@@@A@@BA@@B@B@@@@BB@@@"],encodeOffsets:[[121095,30480]]}},{type:"Feature",id:"341023",properties:{name:"黟县",cp:[117.938373,29.924805],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAECIAI@IBGBE@GBCCGGCGCEAEEEC@IDQCIAGAI@G@G@GBIDGHCFCH@JAFDJBDDDDFFDFDHDFDHFJHFHBFCFCJCJAF@@CHEF@BGFIFGBG@IAECGAIAMBMBMDIDIJCHCJAJBDDFFHDHDLBLBJ@JAFEHIDIJIFMHAFCFEHIBIBK@MBGDAFBJ@HCHGFGDGBIAK@IHAF@LJJBN@J@HCHCJGHKDKDGBK@M@KAIAEF@DDHDH@HBL@H@NAJALEHGBIBCAG@A@CCEACAE@A@A@ABA@@B@AA@@ACB@AA@AAKC@@A@@D@@@B@B@F@BA@ABIAC@CAAAA@A@C@A@A@CGGIAAEEAA@@A@@@AD@FAB@@@BC@A@ED@@A@AAABA@CB@DA@@@BD@B@@@B@BBBBBDD@BHBBB@BB@BBBB@B@D@B@DB@@B@B@BAB@@@B@B@H@@B@J@D@B@@@B@@B@@@BB@@A@AB@@BBA@@DA@A@@@@BB@BB@@BA@ADA@CB@BA@@@BBB@BD@@B@B@@B@@GF@DD@F@B@D@DBB@@BB@BBBB@BAB@BCBABABBB@@@@B@B@DBBBBBD@@BFBFBB@BDADB@@@@@DBB@B@B@@@B@B@B@F@B@DBBB@@JBXFPDLDLBN@LBP@PBNBHDJDHDJFLHJLJFFFJ@L@PJPJL@BKDG@E@EDIAGCGAGEKAI@CCMCM@KFGFGLGHGDCHEL@FDBFBD@FBDBFBFAF@JAHCLAJBHAH@FDDDDJDLFLFHDHBFADAFG@CDK@IAC@E@I@IDKHMJIFGFGFIHMLKDGBIBKBKAKBMBKDKDEH@JFBFFHFFFFDBJFHBLDB@@@B@D@B@B@B@B@BBH@H@DDB@B@DAB@DABCB@B@BA@@B@B@@BB@A@@BAB@B@B@B@@BB@@@BB@@@BB@@@@B@@@@B@@@B@@BBBB@@BB@@BBD@@@BBB@@@BBB@BBB@@AAA@CAA@@BC@AB@BC@CAA@@BA@CB@@@BA@@DBBDP@JDLJNFPBNBH@DGBCDIDQDOHIDQFIDGDE@EEIGKGIIGICCEIEGGIGKGIIECCEEECECGBEFIFEFEDGBG@GAK@I@GAG@ABG@GBMFKBGBGCEEEGGGGEEIICEEGCEGBMDQFMBIDC@@CDI@[EE@@BEBCBEBA@ABEBCBCBCDAAE@ACC@ABCBCDCDAAAAAACEACACAG@C@"],encodeOffsets:[[120832,30527]]}},{type:"Feature",id:"341024",properties:{name:"祁门县",cp:[117.717396,29.854055],childNum:1},geometry:{type:"Polygon",coordinates:["@@GGEGEIEICG@G@CDEKAGCGEKEGEGEIGMIKEKCGAGAE@CBEDGDGDC@CAE@G@G@A@A@ADABABI@C@@@A@@@CC@A@AA@@CA@@@@@@BADABAB@B@B@B@BABA@@@A@C@@@@@@DCFAB@@@BA@@@C@E@@@@BDDB@@B@@@B@BABAB@@BB@B@D@@A@@BA@CB@@@@@@B@BBBB@BB@@BABABA@@B@B@B@BA@@B@@@BB@AH@BBBBB@D@D@D@@BBFDBBBD@FADCDEDGFEDEBE@E@C@GCEAGAG@GBEBCDGDEBGDCDEFEHEHEFMHAHAHBF@LDJ@FBJDP@J@JCN@H@HEDCFIFEDCBG@CBEFILCFCF@BCDEFEFCF@@ELAD@HAFDFDHFJFLFNDJDFAF@JAHCFEBGBIAMAOEI@KECCAKAIAICGAGKCKCKA@@EBGHCDCFCF@B@DCJCLCJCHCDE@EACEECICIAIAG@G@EAEECEAIAEAE@@E@@@E@GBIBEFGFE@CAI@EDA@ABEDEHAF@D@FDHDFBHDFBFDH@D@DDJBJAH@HCH@HCJCHCHEHGFIDGBGFA@IDKDIDI@K@AHAPAR@J@B@DAB@H@JDJBFBBFDHBD@F@LBLBJDPBJ@ZFNCHBHDJBFDNDFBJBHDJDFFJ@H@NCD@FBJDFDHFJDHBLBJ@JBJBJ@JEHBDDBD@FBHBFDHDDBBNDVAPGHEHCLAF@LBJBDDFDDFDFHHDBJBLAJ@JAJALAN@NBJBFDLFJDLHLFLFCBBHAHAFAF@@@B@BABAHAD@@BBBBBBBBB@BDBBB@@BB@@B@B@@A@@@CAA@A@GC@@@B@BBB@BAB@@AAA@A@ABAB@@CD@DA@BH@B@B@BBBB@FBDD@BB@BBD@@BABBD@@A@@B@@@@D@B@@A@@BA@ADA@A@A@A@@@A@@BA@@D@BBB@FA@@D@BA@@@C@@@A@AAAAAA@@AAA@A@A@@@@@@BAHD@B@AB@A@ACA@@A@@BAB@@BB@@@B@B@@A@@@@@AAC@@@@BABA@@B@DD@@BBB@BB@@B@BBB@@@@@@BBB@B@B@BAD@B@BB@@@BD@B@B@BA@C@GBGB@BB@@BB@D@B@@@FBB@@@@B@B@DA@@BB@BBB@@DBBB@B@B@DBB@@@F@@@D@B@@BB@BBDF@BB@BB@@BBBBB@FD@@H@@AB@BD@@@@A@@@A@@@@B@@B@B@BBDAD@B@BBB@B@BB@@BCBA@@@AAABA@@@A@A@ABA@@@A@A@ABC@E@A@AB@@AB@D@BADCDCDCB@FAB@@@BC@CD@B@BBDD@@DC@@@@DB@B@@AD@@@@@@DBBBDLBFJBJ@DGBADABA@@@G@A@A@@BA@A@A@AA@@C@A@C@AAAAAA@@AAAGA@ACCAAAA@A@A@@@AAC@@B@@CDAB@BABBB@@@FCB@D@@A@@BA@EBC@@B@@@BBFFBBHJDHB@B@D@B@B@BBDBD@JBBAB@@A@E@A@A@@@CB@@@LDBBB@@BDA@BB@@B@AB@BAB@B@F@DBFBDDB@H@DBJAHAFGBKBI@M@GAK@GCGCG@CFEJBLBN@L@HALCLCHGDIDG@G@IAMII@KBEJGL@JBHAHCHEDG@GAIBEHCNAL@JAJAFGDEBENGJEJIJCFGBE@IAIAKCKCGEGCEACBIDIDGJIJCNCNANAJBHBFDJBH@HAJEHE@AFEDG@@BEDIDIDEAEEGIGGEECGCECECCECCACCIBE@IDGDEHGJCHAH@H@J@HBJBRDJCD@@@@G@GAECGAECGCCEGGECAE@EFAHCF@BGFCFGFEDKEGEGAEE@E@I@AAEAECEAECCEGCGEIEGCGAGCGCECCIEIAIAKCM@K@K@IBG@IDGHEHCHGFCFEFCBE@E@E@GAC@SGSCQAAAG@KCGICGAGAEEEEIGEMGKGKGKIKEGEECMIKIEEGEKIGEIG"],encodeOffsets:[[120485,30357]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
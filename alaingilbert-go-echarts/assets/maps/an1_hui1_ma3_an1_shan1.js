@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("马鞍山",{type:"FeatureCollection",features:[{type:"Feature",id:"340503",properties:{name:"花山区",cp:[118.492565,31.71971],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@A@A@A@C@A@@@@@@@@@@@@D@@@@@@@@@B@B@@@@@B@D@@G@K@@DBD@BD@@BBD@F@@@BAFAB@@ADCD@@@B@@EFBB@@C@A@@@EDA@CDOLKDA@EBI@AA@@@E@K@EACCACAE@KBK@G@ABABA@A@EBA@QAC@E@C@DVH\\LZDDHDHFJFLFHFHFHDHFB@FFFDBBBB@@DFDDFH@J@BHCD@B@BAF@DBD@DBB@B@BBB@BA@A@A@CB@@ABCBC@@@@B@@A@@BAB@@AB@@@@@B@@AB@@@B@@@B@@@B@@@B@@@B@@A@@B@@@BA@@B@@@B@@@@@@AB@@@@@B@@@@@B@@@@@B@@@@BB@@@@@B@@@@BB@@@@@B@@BB@@@@@@@B@@B@@B@@@B@@@@B@@B@@@B@@@@@B@B@@@@A@@@@B@@A@@@@@@@AB@@@@A@@@@@@@@BA@@@@@AB@@@@@@BB@@@@@B@@@B@@@B@@@B@@@@@@@BA@@@@@@BA@@@@@A@@@@@@@@@AA@@@A@@@A@@@@@A@A@@@@@A@@@A@@@A@CG@A@@@A@@@@@AB@@A@@@@@A@@@@A@@@A@@@@@A@@@A@@@@AA@@@A@@@@@A@@@A@@@A@@@@@A@@@@@AA@@@@A@@@@@@AA@@@@@A@@@@@@AA@@@@@@@@@AA@@@A@@A@@A@@B@@@B@@@B@@@@@B@@@@@B@B@@@@@B@@@@@B@@@@A@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@A@@@@B@@A@@@@@@@ABB@@@@B@@@@@B@@@@BB@@@B@@@@BB@@@@A@@@@B@@A@@B@@@@AB@@@@@BA@@B@@@@@BA@@@@BA@@@A@@@@B@@@@@B@@@@B@@@@@@@@@B@@@@B@@B@@@@@@@B@@@@@@@BB@@@@@B@@@@@B@@@@@@AB@@@@A@@@@@@@@BA@@@@@@@A@@@@@A@@B@@@@A@@@@@@@A@@@@B@@A@@@@@@B@@A@@B@@@@@@B@@B@@@@@B@@@@B@@@@BB@@@@@BB@@B@@@@BB@@@B@@@@BB@@@@@BB@@B@@@@@@BB@@@@B@@B@@B@@B@@B@@B@@@@BB@@@@B@@@@B@@B@@@@@@BB@@@@@@@BB@@@@B@@@@B@@@AB@@@@@BA@@@A@@@@BA@@@@@A@@BA@@@@B@@@B@@@B@@@B@@@B@@@@@BB@@B@@@@@B@@@B@@BB@@@B@@@@@B@@BB@@@B@@@B@@@B@@@BB@@B@@@@@@@BB@@@BB@@@@B@@B@@B@@BB@@@@BB@@@B@@B@@@@B@@B@@B@@BB@@@@B@@@BB@@B@@@@@BB@@B@@BF@BAB@@AB@@@B@@@BA@@@@@@B@@A@@@@BA@@@@BA@@@@@@B@@@@AB@@@@@B@@@@@B@@B@@@@B@@@@@BB@@@B@@@@@BB@@@@@BB@@@@B@@B@@@@@@BB@@@@@B@@@@@@@B@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@BA@@B@@@B@@@@A@@@@@@@A@@@@@A@@@@@@B@@A@@@@@A@@@@@@B@@A@@@@@@B@BA@@B@@@B@@@@A@@@A@@@@@@@A@@@@AA@@A@@A@@A@@@@AA@@@A@@AA@@@@AA@B@@A@@@@BA@@@@@@B@@@@AB@@@@@B@@@BA@@@@@@B@@@B@@@B@@BB@@@@AB@@@@@@@@@@A@@@A@@@@AA@@@@@@@A@@@A@@@@@A@@@A@@BA@@@@@A@@B@@@@@BA@@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@@@B@BA@@B@@@B@@@B@@@B@@@B@@@B@@@@@B@@@@@B@@@B@@@@AB@@@@@BA@@@@B@@A@@B@@@@@B@@@@@BA@@@@B@@@B@@@@A@@@@B@@@@A@@BA@@@@@A@@@@@A@@@@@A@@BA@@A@@A@@@@@@@A@@@@B@@@BA@@@@B@@@B@@@@A@@B@@A@@B@@@@@BA@@@@@@BA@@@@B@@@BA@@@@@@BA@@@@AA@@@@@AA@@@A@@@@@A@@@@@A@@@AA@@@@AA@@@@@AA@@@@@AA@@@@@@A@@A@@A@@@@A@@A@@@@@AA@@@@@@@@AA@@@@@@AA@@@@@@AA@@@@A@@@@A@@@@A@@@A@@@@@A@@@A@@@@@A@@@A@@@@@A@@@@@@@A@@AA@@@@AA@@@@@@AA@@@@A@@@@@A@@@@@A@@@@@AB@@@@A@@@A@@@@A@@@@A@@A@@@AA@@@@AA@@@@@@AA@@@AA@@@@AA@@@@AA@@@@AA@@@@@@@A@@A@@A@@@@@@@AA@@@@@@A@@@@A@@A@@@@@A@@@@AA@@@@@@@AA@@@BA@@@@@A@@B@@A@@@@@AB@@@@@BA@@@@B@@@@A@@@@@@@A@@@@@A@@@@A@@@@AA@@@A@@@@AB@B@B@B@@A@ABAD@@@B@@@BDB@@C@AACA@@@AA@A@@@CB@B@B@@AB@B@@@BBB@@AD@@AA@@A@A@@@@B@@@B@B@@@B@BABC@@AA@AB@B@@@BA@@BA@@AA@@@@@A@@@@@@BA@CAA@A@@AB@@@@A@AC@A@A@@@AG@@A@@@AABAAA@@@A@AA@@A@EAAA@@@AACA@@@@BAAAA@AA@@@A@@A@AB@BC@A@A@C@@@@A@A@@BAAA@@BA@@A@@@E@AA@A@@AC@ABAAA@@@@AAABA@@@BA@@@A@@A@A@@BA@A@CB@@B@A@A@A@@A@@A@A@@B@BA@@@@BA@@BAAABA@EA@ACAA@A@ABA@@B@@A@@@AB@@AB@@A@@B@@A@@BA@@@@@A@@@A@@@AB@@A@@@A@@@@@A@@@A@@@@@A@@@@@A@@@@A@@@@@A@@@@@A@@@@@A@@@@@@@@BA@A@@A@@@A@CAA@ADA@@@AA@@A@@A@@@A@@CAA@@A@@@@E@@A@@A@BBA@A@@@AA@@A@A@@H@B@B@@CAE@@@A@@@A@CA@@@@@@@@@@@@C@C@CA"],encodeOffsets:[[121363,32427]]}},{type:"Feature",id:"340504",properties:{name:"雨山区",cp:[118.498578,31.682132],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@C@@@@@G@ECKOCGCAA@CD@P@@BN@@@@BL@@AJ@HCTK^GJEHAHAD@FETEL@XBJADABADH@L@LAF@DBDBBD@F@L@F@@BBJ@FAB@LCPKDCB@FC@@B@D@@@AAFE@@@A@@DCBC@@BABE@A@@@EAC@AC@@AAC@CL@H@@@@C@A@@@@@A@A@@@@@@@@@C@@@@@@@@@@B@D@B@B@B@B@B@DBD@D@@@@@@@@@@@@@DBB@@@B@@@F@DB@@@A@A@GB@B@@@BB@@B@B@AAB@@@@BF@@@@@@BB@DB@@@B@@@BB@@@BB@@B@BCB@DBB@@@B@@@@BAB@@@@@@@@@B@@@@@B@@@@@B@@@@@B@@B@@@@@B@@@@@B@@@B@@@@@B@@@B@@@BA@@B@@@B@@@@@B@@AB@@@@AB@@@BA@@BA@@B@@@@AB@BAB@B@DB@BFBB@BABB@AB@@A@@B@@A@AB@B@@@@BB@B@B@A@@@DAB@B@@AB@B@@@@B@@AB@@B@BABB@@@@BBAB@BBD@@@BBBF@@@B@@@AB@@BBAB@@@B@B@@D@B@B@D@@ABAB@@@@B@@BBB@BBAB@@@@DBBB@@B@BB@F@BB@@B@B@@BBABBB@@B@@@BH@@B@B@D@@B@B@@A@@BB@B@DBB@@A@@@@B@@@@@B@@BB@@AB@@A@@@ABAB@@BD@BA@A@A@@@A@A@@@A@@B@B@@@BB@@BC@@AA@A@@@ABA@@@A@ADA@@B@B@@B@@DBBBD@@@CA@A@@@A@@BCBAB@@@@A@A@ABA@@@A@@A@@A@@@A@@@@@A@@A@@A@@@@AA@@@@@A@@B@@A@@@@@@BA@@@AB@@@@AB@@@@A@@@@BA@@@@@A@@@@@A@@B@@AA@@@@@@@@A@@@@@@@A@@@@@@@A@@@@AA@@@A@@@@AA@@@A@@@@@AA@@@@A@@@AA@@@@A@@@@@A@@@A@@@A@@B@@AB@B@B@@@@C@A@ADE@A@@AA@@@A@A@A@@@@@AAA@AA@@@A@ABA@A@@AC@C@EIAACAAAC@CBA@CAC@ABA@AAA@C@@@ADA@AA@@@@@A@A@@AAA@@@ABA@AA@CA@@A@A@EACAAG@EAAACA@@@@@AA@AACAA@ABA@@@A@@@AB@A@@AB@BJ@@@@@B@DBBBA@ABA@C@@@AB@BCB@B@BCDED@AAA@AAI@A@A@@@A@@F@B@BCBCBC@CDKBA@A@A@@@@@AA@CE@@A@ABAA@@@@BA@A@@@A@@A@AAAA@AA@@@AAC@CAAAA@@@@BABCAA@@@AA@A@AC@A@A@EAAA@AC@@AA@@@A@@B@@CD@@EGAAACA@AAC@@B@BCBGFCDABA@@@@@AA@@GCAAOGA@AAA@CBA@A@EB@@@DB@B@BB@B@@@B@@BDBBB@BBBDA@@B@D@@@B@@GHA@A@C@A@A@CAAA@AA@@A@@@A@@@A@@A@@B@@@@A@@@A@EBABCBAD@BDDFBBB@D@D@BABA@@ACAC@C@A@CAEACBA@"],encodeOffsets:[[121332,32358]]}},{type:"Feature",id:"340521",properties:{name:"当涂县",cp:[118.497972,31.571213],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AA@@ECAAC@EAE@C@CBEBCAEAG@C@EBCACBEAC@CAECECEAE@EAC@E@E@C@A@AA@@CAAAA@A@E@A@AB@@A@CBA@AAA@CAC@A@A@A@ABA@AAA@AAA@A@CAC@CAEAC@E@C@CB@B@BAB@BAD@B@B@BABA@CBA@A@ABAD@D@DADCBCBADCDCD@D@B@@@@@BBB@BB@@BADEDCDAD@BBD@BDDFHBDBBABABADADAFADEBC@EBAB@B@DBDABA@ABCBABCDGDCBABEDCBADAB@DBH@J@BBD@B@H@D@B@@A@CBM@@B@@ABEDI@@@@@K@KAGCEGEG@GI@I@IDCDGFEBG@GCKEEC@B@B@BAD@B@B@@@D@D@DA@@B@@@BADADAFAF@DAFAFA@ADADAB@@@BABAD@BA@@B@BAD@DAD@DAD@DADAF@B@@AB@@@B@B@@@B@@B@DADAFJBNAH@BGFGD@@EBCJ@@@@@BCNAFAFED@@A@QDQJMRUd@@FJDJ@DBJBBBLDPDNBXHZ@@FTHb@JBFFf@JJfHVBB@DBFDDBHBFD@F@D@RBB@FAB@B@BABABCBABCAI@WFKFS@EBCBGFGHIL]DS@GBI@@AK@@@@AM@@@ODCB@DBDHLPFDH@@@@@D@B@B@DAFBDBB@D@D@DB@BB@BA@A@C@CAAEACC@ABCDABAFAB@@@B@@@@@@AB@@@@B@@@B@@@BB@@BBBDBB@B@D@B@B@HG@@@A@@@C@AB@ACAAA@AAAC@@@A@@@AAAA@A@@C@@FAB@B@DAB@BBB@PHBBHD@@BB@@@@B@BADCHEDA@A@AD@BBB@BDBBFH@@DC@@@AB@@@B@@BD@@BBBFBB@B@D@@B@BBB@@B@DBBA@A@@B@BBDBD@BB@@B@@BBBBBB@@@@B@@@BAB@@@@BBBAB@@@DFB@@B@@@@@B@BABVBHCFGHCHAD@HAD@FADCBEFK@MBC@GDGJE@@DEBEAIEKCSDCNANCJ@B@F@L@HBD@DBD@BBB@BBB@FFDAHCDAJEJGLNDBB@DBDBJBHFLNLTDBFCPIDCNKJGFAHCJEDE@IEUCMBEHKPKRI\\ObULGXO`STKDA@A@@@A@@@@@AB@@A@@@@@A@@@A@@@A@@@@@A@@@A@@@A@@@@@A@@@A@@@A@@@A@@B@@A@@@@B@@A@@A@@A@@A@@A@@@@AA@@@A@@A@@A@@@@AA@@@@@AA@@@@A@@@@AA@@@@@A@@A@@A@@@AA@@A@@@@AA@@@@@A@@AA@@@@@AA@@@@A@@AA@@@@@A@@A@@A@@@@AA@@@AA@@@@A@@@AA@@@@CACAAA@@@A@AD@@A@@@AA@@@@@AA@@@AA@@@@A@@A@@A@@@@AA@@@@AA@@@@AA@@@@@ACA@AAA@AAA@A@GCIAAAEGEAECEACAE@CAA@C@AAA@C@GBC@A@A@CAC@A@A@CAAAA@GA@@CAA@@@AAAAA@ABA@AB@BBDDFBH@D@BADADCB@DABAF@@A@@@A@@AA@@@@@A@@@A@@@@@AA@@@@A@@@E@AAOUACK_IWGKIOCK@@@@AA@@@@@@@A@@@@A@@A@@@@A@C@EAC@@AA@AACE@CA@AAGK@@AAA@C@ABE@CBC@"],encodeOffsets:[[121542,32061]]}},{type:"Feature",id:"340506",properties:{name:"博望区",cp:[118.844538,31.558471],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@A@@@@@@@A@@@A@A@@@@B@@@B@@BB@@@@@KMCBE@PR"],["@@@@@A@@@@@A@@@A@@@A@@@@@A@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@A@@@@AA@@@@@A@@@AA@@@@A@@@@@A@@@@@A@@@@@@@A@@OQC@GCCACCCECCEECEAC@AAEBABADAH@FDBDBFFHFFDFDBBBDBBB@DABLNB@@@B@@@@@B@@@B@@@@@B@@@BA@@@@B@@@@@B@@A@@@@BA@@@A@@B@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@A@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@A@@@A@@@A@@@A@@@@@AA@@A@@@A@@@A@@@A@@@A@@@@@A@@@A@@AA@@@@@A@@@A@@@@@A@@@A@@@@@A@@AA@@@@@A@@@A@@@@AA@@@A@@@@@A@@@AA@@@@A@@@A@@@A@@A@@A@@@@@A@@@@@A@@AA@@@@@A@@@A@@@AA@@@@A@@@A@@A@@A@@@@@A@@AA@@@@@A@@A@@A@@@A@@@@AA@@@@@A@@AA@@@@@A@@@AA@@@@A@@@@@AA@@A@@@@@A@@@A@@A@@A@@@A@@@A@@@@@AA@@@@A@@@A@@@A@@@@@AA@@A@@@A@@@@@A@@@A@@A@@A@@@A@@@@@A@@@A@@AA@@@@@AB@@A@@@@@A@@@A@@@A@@@@@A@@@A@@@A@@@@@@CBSL_TWPKHaV[PQJOLGLAFDNFV@JCFIFGDEBIHMLCDOJEDCAKSKMGEIACACAA@CAKMIHIFCBGDCBEEA@AAA@AAC@CAC@GAK@E@A@I@MDMBCDDTFLBJAFCF@@IFCH@HAD@NELAFCDEBC@GBC@GBGDEHGDUACL@DADADADA@E@@@@B@@@B@BBJ@BBB@BFCDC@A@ADA@ABA@@D@B@BAB@AACAA@@@@@I@@ABA@@@BBA@@B@@@B@BAB@DBBBB@@B@@@@DBBBFBH@BBBD@F@B@BB@@DBBB@BA@@B@BB@@@B@B@@@@BBB@BC@@D@B@BBB@BAD@DBB@DAD@BBDBBBFJD@D@@BB@B@BAB@@@B@@BBB@B@@@@@B@B@B@@BB@@@BCF@B@B@DB@@@B@B@@AB@@A@@B@@@@AB@@@@AB@@@@AB@@@B@@AB@@@@@B@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@@AB@@@B@@@B@B@@@B@@@@@B@@@B@@@B@@@@A@@B@@@@@@@B@@@@@BA@@@@B@@@@@@@B@@@@AB@@@@@@@B@@@@@@@BA@@@@@@B@@@@@B@@@B@@@@@B@B@@@@@B@@B@@@@B@@@BB@@@@@@B@@B@@B@@@@@B@@BB@@@B@@@B@@@BA@@@@B@@@@@B@@@@@B@@A@@@@B@@@@@@@B@@@@@B@@@@@@BB@@@@@B@@@@@@BB@@@@@@@B@@@@BB@@@@@@@BB@@@@@@B@@@@@B@@@B@@A@@@@B@@@BA@@@@B@@@@@BA@@@@@A@@@A@@@@@A@@@@@@@@@A@@@A@@@@B@@A@@@@@@@A@@@@@@@A@@@@@A@@B@@@@A@@@@@@@A@@@@@A@@@@@@B@@@B@@@B@@@@@B@@@B@@@@@B@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@B@@@@@@@BB@B@@BB@@B@@@@@@A@@B@@@B@@@BB@@B@@A@@@@@A@@@@B@@@@@@@@@@@@B@@@@B@@@@@B@@@@@@@@@BB@@@@@@@@@B@@@@@@AB@@@@BB@@@@@B@@@B@@@B@@@@B@@@BB@@@@@@BB@@@@@@@B@@@@AB@@@B@@@@@@@@@B@@@@@B@@@@@@B@@@@@BB@@BB@@@@@@AB@@@@@@@@@@A@@@@@@@@B@@@@@@@BBB@@B@BBB@@BB@@@ABB@@@@@@B@@B@A@@B@@@@@@AF@D@@BB@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@@BB@@@@@@BB@@@@@@BB@@@@@@BB@@@@@@@B@@@@@B@@@BA@B@@B@@@@@@B@@@@BB@@@@@B@@@B@@@B@@@B@@@@@B@B@@B@@B@@@@BB@@@B@@B@@B@@@B@@@@@B@@@@A@@B@@@@@@@B@@@@@BA@@@@@@B@@@B@@@@@BA@@@@BB@@@@@@BB@@@@@@B@@B@@@@B@@B@@@@B@@@@BB@@@@@@@B@@@B@@@@@BA@@B@@@@@BA@@@@B@@@@@@AB@@@@@@A@@B@@@@@@A@@B@@@@@@A@@B@@@@@@A@@B@@@@A@@B@@@@@BA@@@@BA@@@@B@@@@AB@@@B@@@B@B@@@B@@@@AB@@@B@@@@AB@@@B@@@@AB@@@@@@A@@BA@@@@AA@@A@@A@@A@@A@@@@A@@A@@@@AA@@@@@AA@@@@AA@@@@@@AA@@@A@@@@@A@@@A@@A@@A@@@A@@@@@A@@@@AA@@@A@@@@@A@@A@@@@@@@A@@@@B@@A@@@@@A@@@@@A@@@@@@BA@@@@B@@@@@@AB@@@@@@@@@BA@@@@@@B@@@@A@@@@BA@@@@@A@@@@@A@@@@@@@@AA@@@A@@AAB@@@@A@@@@@A@@B@@@@AB@@@@AB@@@@@BA@@@@@@@A@@@A@@@@@@@A@@@@@@@A@@@@A@@A@@@@@A@@A@@A@@@@@AB@@@B@@@B@@@B@@@BA@@B@@@@A@@@@B@@A@@B@@@@A@@B@@@@A@@@@BA@@@@@AB@@@@A@@@AB@@@@A@@B@@@@AB@@@B@@@B@@@@@B@@@@@B@@@B@@@@@B@@@B@@@@@B@@@@@B@@@@@BBB@@@@@B@@@BB@@@@B@@@@@@BB@@@@@@@BB@@@@@@@@BB@@@@@B@@AB@@@@@B@@@B@@@B@@@@@@B@BB@@B@@@@@B@@@B@@@B@@@B@@@B@@@@@B@@@B@@@BB@@B@@@B@@@@@B@@@B@@@B@@@BA@@B@@@B@@@B@@@BA@@B@@@B@@A@@B@@@B@@AB@@@@@DADAB@@@B@@@B@@@B@@@@@B@@@B@@@B@@@B@@@@@B@@@BA@@@@@A@@B@@A@@@@@@BA@@@@@A@@B@@A@@@A@@BA@@@@@A@@BA@@@@B@@@BA@@@@BA@@@@B@@@@AB@@@@@@A@@@@@A@@@A@@B@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@@@@@@AB@@@@A@@@A@@@@@@@A@@@@@A@@@@A@@A@@A@@@@@A@@A@@A@@@@@A@@A@@A@@@@@A@@@AA@@@@A@@@@A@@@A@@@@B@@AB@@@B@@@B@@AB@@@B@@@B@@@B@@@B@B@@@B@@@B@@@B@@@B@@@@@B@@A@@B@@@@A@@@A@@@@AA@@@A@@@@@A@@@@@AA@@@@A@@@A@@A@@A@@@A@@@@@@AA@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@BA@@@A@@B@@A@@@@BA@@@@B@@A@@@@B@@AB@@@@@@AB@@@BA@@@@@@BA@@@@@@AA@@@@@@@AA@@@@A@@A@@A@@@@@AA@@@@@@A@@A@@A@@@@AA@@@@@A@@@A@@A@@A@@@A"]],encodeOffsets:[[[121727,32293]],[[121729,32311]]]}},{type:"Feature",id:"340522",properties:{name:"含山县",cp:[118.101421,31.735598],childNum:1},geometry:{type:"Polygon",coordinates:["@@DALCDAD@F@B@@@B@BA@A@@BAB@D@@@B@@AAAACCECECGAE@A@CDCDEDC@A@AAAAAAA@@K@OBE@N[HMB@@QFG@A@@@@@A@C@EACDEHIDYFKBMDIBMDcFUDKDKBCECCE@CBGFAH@JEPIHELABJBD@HBD@JBFBHDDDCBA@CBEBI@OHO@CDA@FRNBHH@B@BA@A@EBAB@@BHABADEBEBAFAF@FCDCDC@IBGBCD@BBBHFDDBBBBBBBDBBB@@BABABEBE@E@EAGAEAC@CBKPEFABC@OGAB@D@B@BBB@BAH@@BBJF@B@D@BBBB@@@DBB@B@B@B@B@BBB@B@B@D@BB@B@B@DBBB@BBB@@BBBBB@B@B@B@@AB@AA@A@@@ABA@AB@D@B@DB@@@DAB@D@@BBD@B@@@BAB@F@F@@@DABCF@D@B@B@@B@DBBBBB@@@BAB@B@@CFBBBB@@BDB@BD@@BFBBDBDBB@BA`@EIBKXH@@@@@B@BBX@@@@@B@B@@@@@B@@@B@@@@GHCHCPDDEB@FFFC@SHCDGFKACBE@DDBB@D@B@B@@@BC@CBGBCBGFCDCFAFA@MLCJAXDBHNDJ@LCPABCD@B@D@F@DBBDDFBDDBBB@@@@BABABCBEBCBC@@@C@CBABCDCDAFBB@FDDBBBDDFLBCFCD@BC@EFCDAFADCHCLBFBDBDBFBFBDBF@FDFBDFHFBHDDBFDFBBDDBFDDD@D@BDFBFDFBDBHBH@@BJDJ@B@@@@@DBB@BDJ@FADAB@@@BDDBBB@DCBAB@JAH@JED@D@BABADGXW@@D@BAFABAB@DAB@BAD@HCFA@@LCBAB@BAHA@@DAB@BADAB@FAPEBAHAR@LDHDFGDIBEHA@@B@FDFBB@F@D@BA@A@@CC@A@AAC@A@@@A@CBABCBAB@LIDCB@HCFABADADA@@FCB@D@B@DB@@JDH@FCFADEHCF@HBFBFBJBF@FA@ECEEEEEGKAEBEFEH@HBHBH@B@F@HCDEDG@KAI@GBG@IDEBE@@@ABC@AAAECAAIMGKCKCIDCBCCGAC@IB@BAB@BAFABAF@D@BBBBD@B@HDD@BBJDLDB@FBD@BBF@FBHBB@@@BA@AFCBCDE@AAAGECCGC@@AA@@CAA@IACACAAAACGE@A@@@C@ADEBABC@GAGCKEEGIGEEEGCEECIBGDKDG@G@AACCAEEGAECEAECEACCCCAEAKBGAI@IAIAE@I@EBG@I@G@IAICGCGECCEEC@Y@CBCCCCAAA@C@MDM@@@A@@@@HIBCBC@A@G@CBABC@ABC@A@@BAFAD@@A@@@@@A@@@@A@@@@@@A@A@@@@BA@@@@@@@@@C@@@@A@CBAB@@A@@@@@@A@@@A@A@@@@@@A@AB@@AB@@A@@@@BB@@B@@@@A@@BA@@ACAACCAMIA@AA@AB@@A@@@AAC@A@C@ADE@A@AAI@ABC@ADCB@D@F@D@B@FAB@DBD@B@"],encodeOffsets:[[120985,32192]]}},{type:"Feature",id:"340523",properties:{name:"和县",cp:[118.353667,31.742293],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CCIEI@@CGAGCKCICGCIEICIAECIAC@ACGAECKGMAA@ACECEA@CGEEGECCEAEAE@E@E@@DAHAH@J@FBNBLBFBFBFBBBDAB@BCDAB@DA@A@ABED@@AB@@@BAB@@@B@BA@@@A@@BB@@@B@BB@@@B@@@@@B@@AD@@AB@@ABA@@A@@A@A@AB@E@ABE@A@A@AAAA@@@C@C@A@A@C@CBCBABCBCDCBGBC@ABEDCD@@@@@@A@A@@@A@@@@A@@A@@@A@@BA@@B@@B@@BB@B@@@B@@B@@AB@@@@@B@@AB@@ABA@A@@@A@@@@@@B@@@@A@@@@@@@@@@AA@@@@B@@@@@B@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@A@A@@B@@@@@@@B@@@@AB@@A@@@@@@AA@@B@@A@@B@@ABA@@@AB@B@@@BAB@B@@CA@@A@@DAB@DOEGCC@A@GAIACA@@C@C@A@C@CBE@A@C@GAA@AB@BAB@BABAD@BCBEBMDE@@@@BC@AAA@C@KEKECCCAGGACCAECEAEAA@G@C@ABABAD@D@ZFDDFFDDHDHBJ@J@H@JAH@F@JBFBJ@JBJAHBLBFDDDDFBFDFBFDHBFFDBBD@B@HCHCLAHDJFFHDFFHFHJFFDLBH@HADABCF@B@D@@@BHFBDBBDBDBJBB@DB@@BB@@HDDDHFBB@BCFADED@BAB@@A@GAEAE@AAC@EAA@KCICAAC@GCA@C@AAAAC@E@ABEBABA@ABA@@JBDDHADCDDJDLHLJNBBFDBB@BAD@B@@AFCF@JAH@HBJ@LCHCFGDE@A@G@GAGAG@EFAFBFHLFFFFDF@FEBE@IAEAEAGAE@GDCFEBEDG@IC@@CAA@C@A@ED@@CBCBABEBGDA@CDKJA@ABADAB@D@B@@@BBD@B@BDD@@@BABC@BB@D@H@H@B@BDDBD@BAD@BABBDBDDBJDDDHBDBJFR^JDXCJ@JFJD^ILEHAHDFDHHJXTLLBBDJ@VEVRTTbVHFVTB@^THF^L@F@F@L@@JXLFBPFDDBNHBBJH^HFDBBDBFLDBFBFDB@DBDB@DD@BBB@BBFBD@BBB@B@BBB@@@@@@ABA@@@@@ABAB@BAB@B@B@BA@ABA@A@@@A@A@AA@AA@@AA@@A@AAA@@A@@@AAA@A@@@A@A@AAA@A@A@A@A@A@@@AB@@AB@BA@@DAHCHADCBEACC@G@A@C@@A@@AA@@AA@A@AA@BA@@@A@A@A@A@A@A@A@AAA@A@@@@@A@ABA@@@AB@@C@A@C@@@EAEACAAAAC@A@A@EAC@@@C@AA@A@@@ABABCBABABC@C@CAAAAA@AACA@ACCAA@ACCAA@C@A@C@A@A@CBC@C@AAAAAACAAAAAC@C@A@ABABAB@DAD@DAFCBADABEB@BCJGD@F@JA@BD@D@B@DADABADA@ADCB@BADEBABAFABAB@TGPED@DEBABAD@DAB@DABABAB@LCF@DAD@FAB@DABA@A@A@AAAAA@AAAA@AAACAA@C@ABA@A@A@C@@ACBAFABABABABCBAHALELEJAFAFBB@DA@AU{O_EY@AAE@A@IEGCCCE@@AAAAECEEA@GEGCGEGEKEIEGEGCCCKYG[CUAEAGCCAE@CAAGUIe@IEeAE@IGaES@@GYAWCMCOAKAAAI"],encodeOffsets:[[121223,32302]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
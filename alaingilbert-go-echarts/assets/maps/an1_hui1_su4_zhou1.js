@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("宿州",{type:"FeatureCollection",features:[{type:"Feature",id:"341302",properties:{name:"埇桥区",cp:[116.977203,33.64059],childNum:1},geometry:{type:"Polygon",coordinates:["@@BBLAB@@@ACACGWTBFADAJEJCLCVM@@\\@B@@J@B@@J@JIFCJGH@PBL@J@D@D@LB@@@J@BB@P@@@DBDDFBBDFB@@FBF@JA@CBCDCFCFAHAHAJ@D@BFBFBFBFDDBDB@BBFBFAHBDBD@DBHBD@F@DBF@H@D@DA@@DCFAFEBAFCFCDADABCBCAEACECAC@CDEHAH@H@FCFCDCBCDABCDEBCBABECE@ECE@EBGBGBGDIFI@EBE@E@CBEBGDG@C@G@GAEAE@C@CAA@CAGAGAG@EAE@GBIBI@ICGHSLMFGHA@AA@@ABC@CAA@A@@@@A@AACAAACACA@@A@@@AA@AA@AAAA@@A@@@@A@@@@AAAA@@@@@A@A@A@@AA@@AAAAAAA@@AA@@A@A@AAA@@@C@A@A@A@A@@BA@@BAB@@@BAB@BAB@@@@ADCDE@@@CB@@AB@@AB@@@B@BAD@BA@@BA@@B@@@BA@@BB@AB@@@@@BA@A@@@@BE@CBC@C@ABA@@BABAB@BA@@BA@@@@@A@C@CS@MCKI@KDYIAKCACACGCIEECEA@I@E@CAC@C@EBG@GDE@@BG@ADGB@FCHBB@@ADa@BJBD@D@AMEAEE@@CE@A@M@@LAD@@A@A@AIOCG@IDCBCDGBC@@NEBA@A@O@@AAA@CI@E@IFCDA@@DE@AAG@CAAKAI@EMQJA@CCCKDAB@AI@ACGDCDGQC@AAE@GAEAEACD@@GC@AACCA@uBAAAAAACACEEACCCAG@EBEBCDCDE@C@C@C@ABADAB@FBDDB@FEBGD@@CECECAMQEGAI@@DQFYLA@G@MDG@@AEACAA@A@A@@@ABA@C@A@A@C@C@CB]BC@CBA@GC@E@E@A@A@A@CBA@C@A@C@A@A@CBG@E@EBA@C@C@CBCBCBC@CBAB@@A@A@A@A@C@E@@MAG@ACGAAAC@@ACCECECCEECEEEECEE@@@EC@@@CG@@I@AA@G@CAGAGAMAEAICDEDEFIFIHGHIHEDGFIHEFCBCSEQAAA@@@ABED@@KBE@@@EEAC@AA@AAGBAAAA@KCKD@FAFA@AFCBCFEDEB@AAAC@A@A@AAC@@@AA@@AAA@AAAACAC@ACGAA@@A@A@@@@AA@AA@@@@AA@@ACAA@@A@@@@BA@@BABA@@@A@A@A@@@A@A@@BA@A@CBABABABEB@B@@AB@BBB@B@@@B@BBB@@@B@@AB@@A@@@AA@@AA@AA@@@A@A@A@AB@BA@A@@@A@CA@@A@ABAB@@AB@BC@ABABAB@B@BA@A@A@A@CACAAACAAAACAA@@AAA@@BCC@AAAECGAGOKYPAB@@@DF@@B@@@RENEFAFADADCDEHGDCB@@CACACAAA@EASCAA@@ACCIACAAA@E@I@CABU@ALCZAF@@AF@DAB@FC@@HK@@@A@@@C@@@CAA@A@A@A@A@A@@AA@A@@@A@A@A@@@AAA@A@@@A@C@@AA@A@@@@ODIBA@E@IBE@G@A@CBYBMBEBI@EBGBGDAAGIAC@@CCCECCCE@@CAG@G@GBC@EBEBGBGAGCG@G@GBEDIDGDCBEDCDEDADEFCDCDEBEDGBCBEBDDBFBBDH@HAFAB@@CBABABA@ABE@@BDD@BBB@B@BAB@BA@EDA@@BCD@B@BDBBBDAB@BABBFBBB@D@DBF@B@BBDBBB@FBB@@BBDBB@BBBBDBBBFBF@BBBBBB@FAD@FAFAD@D@@B@DBF@@DDDBBBDFBBB@FBDBB@B@@BAB@BA@ABCBEDABAB@B@BDD@DFP@B@BCBA@AD@DBBDHDFBB@B@DA@ABA@A@AB@D@H@HKDAB@@@BBFAB@BABAAG@C@E@GBA@C@CBCBCDABCBCDABAB@B@B@B@H@B@BC@GBE@EBC@A@A@AFA@EBE@A@AB@@@B@BBBBBFDDBBBDDBB@BBBFDB@@BEBAD@D@@CBAB@BGBCBA@AB@B@BBDBFBF@DBHBDBBBF@HBD@DBBBDBDD@BDBDBBHA@DHA@FBD@BGBBFBDBABB@FBBBF@B@@@BBB@DBB@@@BBB@DBDBABHBDAB@BA@E@GC@AE@E@CAC@ABC@ABAAAAAC@@AACBABA@A@AAA@CAABAAACCDABAD@@A@GBEBCBCDEBCDEFEDADCDADABCHCH@DAD@HAFAD@DADAH@DBDDFBDHHDFBHBH@F@BAD@DAD@D@D@F@DBD@FBDDFF@D@DBFAB@FCF@D@DAF@DAFAH@BADBJBDBDB@@BAFAFADAD@BB@D@B@L@H@@@DANAN@BBD@@F@@B@ABB@BF@HB@@@D@HCPCLAFAD@B@DAD@B@H@L@@DD@BBADAB@F@H@B@D@@@F@CHADADABCB@NAD@H@BAD@B@BBBFDBBBB@D@D@DBBBB@DBDAF@F@J@L@DBNBD@B@D@FADBJ@D@DBBDHDPBH@F@J@FBD@BBH@D@P@F@FBFBFBD@BB@FBH@FBDBDBDBBBBB@@BBDABAB@BBDBFFDDBD@BDD@DBDA@@B@@CBABAB@F@BCD@B@BBBDDBB@BB@BB@DBBBB@BBBF@FDHDDBJFF@DDFDDDDDDFDDBDBFBHBD@BBDBFBF@FDFDDD@F@FCDADADCFCDAFDDDFDDDFDDBDDDBBDDDFFBBDDDBFFDDFHDFBFDFDF@DBHBDFF@DDD@DDD@FBDDFBD@BA@B@ADBD@J@DBFBFBF@DBHDB@H@BDJLCBFDDF@DFF@H@DDDH"],encodeOffsets:[[119962,34918]]}},{type:"Feature",id:"341321",properties:{name:"砀山县",cp:[116.367095,34.442561],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@ACC@@B@@ADIA@E@AA@@FKA@@@DC@CAEAE@EAA@EACACEACIDEBAD@B@BAFBDDBBD@DDFDFDFBFAD@DAF@B@BCD@B@DADADADCDABIDCBCBEDGB@@GD@@EBGBA@CBE@CB@@GDCDGDCBG@EB@BCBGDGB@@CDCBABADABCDADAFAF@BED@@CHEBEDCBCBA@CB@@CDC@CBEBA@G@EBE@E@EAE@AAE@G@@BE@GBGBEBCBA@CBA@EDCD@@CF@D@B@@FDB@DDDD@FAD@FCBADCFCDCFCBEDA@EBEFCBEDEDCB@@GBED@BABAH@DAD@BAHABABGHCBEFAFCD@@JLBF@BADBDH@DDDFBB@B@BBBBBBB@D@FANXBHJJHJF@L@F@DIF@DCFCDAD@@ADCF@F@FBB@DBFBFDDBFAFBD@D@F@D@B@BABFBAFADDDFBJAJALC@@DCDCFEFMDCBAFCBAFCHABDDDDFBFBFBHBFDFBDDBDFB@DBFBDBD@DBJ@D@DDBDBD@B@F@F@D@F@DBBBDFBDBB@F@DAH@F@F@BADAHCDCBAH@F@DBDD@@BDBD@DBF@D@H@DDDDBDBFBF@D@HBF@DBD@FBD@FBHFFBFBDDDBBBFDDAF@FADDJBDBDBJDDBDBDDFBDBDBFBF@DBF@D@F@FABCBCBE@E@G@@@CACBEBCBCHCFCFADCDADAF@HCD@DAH@H@D@FBHEBC@EBA@CAEDCDEFCDCFEDEAE@C@AAC@C@EBEBEB@DCFEDABAHEBABAB@DCFADABAB@FEFCHCFADAD@F@F@F@B@FADAHEFEFEFABC@ABAHEFCDCDADADCDAB@DABADADCBAB@D@B@B@D@BA@AB@@A@CBA@ABA@C@ABA@@CG@@@A@@A@EA@AA@I@IBKFE@GAAC@A@ADADCDAF@D@H@D@D@D@D@J@D@J@FAD@B@BBB@B@PADB@@DEBEDE@A@M@MBCBCBAFCBAHIBI@G@GAE@AGGKISCGGAG@C@G@ABA@A@ABGDIB@BA@@@@HCFGJM@I@AOEIAGB@GPEFCBKFKDIAGCG@EAU@IFCFKSaACCCMKAEDCDA@AMCEAC@MACBAB@DE@EA@AIKA@GDGDCBGFC@@BE@CBGBE@EBGBG@@@EDGBE@@@CBE@EBA@EBEBGBC@C@I@G@EAA@EACAECCACAEEAAECAA@AAAEC@@CAEEAAGESC@DAFEFGHHLCDA@E@A@CAA@CACA@@@@@AAA@AA@@BA@BCCAB@CAABCE@@AABABABIA@@@ADAACAA@@DKE@BA@@A@@ABA@@AAA@D@@@CA@@@ADC@AH@@@CABAA@DA@@B@@@B"],encodeOffsets:[[119204,35099]]}},{type:"Feature",id:"341323",properties:{name:"灵璧县",cp:[117.549395,33.554604],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@FCFCFCDAFCDCDCFCDCFE@AFCBCBAAE@CAG@CAEBGBIDAFCFCHADCBABCDE@CBC@ABEDEDCBCFAHADBJBDBHDDDDFBB@BJDFBHBF@DBFBFDBFBF@B@H@B@FAFCFCDCDADFDHDJCLCD@ZS@GDAB@BADCLEBCDAFCDAFC@ADA@A@ABAFABC@E@C@ACMA@A@@AEGAEAGBA@C@@@EDCBCBCDAFEBCAE@E@@@C@G@C@CFGDCFCBCFCDCBCBCDCBCBEBA@ABCDEBEBABEBCBC@@DGBAFEFCBABADADAD@HBDDDBD@HFDDDBDBHDD@FBD@FAB@DAFCBCBC@A@A@E@@@A@ABGCIAEAG@@E@BGBEBG@A@C@@AAAAAA@AAO@CAM@AEA@AA@@CFKFI@@@@@GAC@E@CAE@CAEAECGAG@CAG@A@AAC@GAC@E@@ACACAECACECEC@EAGACECDE@IBA@GBKKAGB[GABO@G@CCKCIBK@GBM@I@A@A@K@E@AAG@G@GAGAC@EDKDKCOBU@CBWAAEAI@EBG@E@G@E@GAG@GAECEACCBCDOBEBA@AH@J@B@BEBGBEAQ@K@CAA@CECGC@A@C@CE@A@@@Q@A@@CEGAA@@ACCEGGA@@A@AAGAOACACB@@A@C@@HAF@HEDCBA@@AE@C@OL@B@@G@CB@HDLDBBB@DCFEHK@A@AA@AA@AAAA@CC@@CGAM@CAKIAAA@CBIHS@@DAB@@C@A@AFEFUBCBEISAI@@@@@G@EAIJA@EBAAA@AA@A@A@CCAE@IEGCCCEGEECCCCECECCAEACAE@A@@G@MTEBKBUMOKGCEAQKEAKAEDGDIFI@CBIBGGC@A@EDK@GDOLOFIAOHCHA@Y@IBICEDGBOLGFaVIJ@@QDEPAHCFENDD@H@DEAECEBADGDCHAFCBADADEBELCBABBFAD@FHFAFCHOZIHAB]H]J[D[DKAUdGBIBIB@HAB@DADA^@D@D@D@B@B@DAB@B@@@B@BBBBDBF@@CH@N@HKBEZCR@@BJFHNRDBDFDF@@HCFA@ECAAC@EBABCBAD@D@D@F@DCDCFAFAH@DBDDFBDFDBBBBBBBvAB@DDBBD@@HC@BDBFBF@HBF@BRDCHCDDH@BBJA@CBDLDDB@RIFNJ@LBBB@DBH@BCF@@CBED@J@FDJB@BB@@@P@BABMF@@ADCHADCD@JDHJP@B@B@BC@KB@@@N@BDF@@FFFBBNC@C@IA@ACb@BA@GAEDA@CH@BAH@@CF@HAH@F@DBD@D@F@JFBFDJFHDBDBDLDJBCZ@LLJNDT@@D@D@B@@@@AB@@ABA@ABAB@@AB@B@DAD@DAF@@@@@BAB@@@@A@@BAA@@AB@@A@@@AB@@ABC@ABA@@@A@@BA@@BA@@D@@CFCD@B@@A@ABA@AB@@A@AB@@AB@@@B@B@B@B@D@@BB@B@B@BB@@BB@BBBBBB@@BB@@@B@B@B@@@@BBBB@@@@@B@@B@@@BBBBB@@BBB@@B@@@DBDBBBDBBBB@@@@@@BBB@DAD@BB@@BGBEHKNGTDH@JAJAJ@HBF@FBHBHBH@DBB@D@DBFBF@H@H@DCHAHAF@D@FAF@FEJCJAHAHAH@FDF@FDFBGBCDCDAFCD@FAD@H@FBH@D@JDF@B@HDFBFBDBDBHBDBB@BBHDDBDBDDDBDDFBDBH@@@FBJ@HBFBFBBFBFBDDDD@F@HA"],encodeOffsets:[[120335,34879]]}},{type:"Feature",id:"341322",properties:{name:"萧县",cp:[116.947349,34.188732],childNum:1},geometry:{type:"Polygon",coordinates:["@@FEHEFEBCFGL@F@H@D@D@H@D@HBHBH@F@DBFDFDDDHFFB@@DBJBD@JBHDFBD@D@HBFAF@JADAJAFCDAD@DCDAFABAHAFAFAH@HADADCLA@CO_AA@K@AB@@ARAF@@@BALEB@@@BA@@BE@C@G@C@CACCE@AGIEECCAEAE@C@EBCBCBAFCD@AKEICECG@E@E@GBEDABADCFCBALGHEHCLEFC@AB@JCFAH@F@DABCBS@ANE@@FFBA@A@CDGBEGEAAAA@C@ED@AA@@EA@AGG@B@@@@A@@EC@@BA@ED@DAAA@A@CBCB@B@B@DAF@@BD@BBDAB@B@@A@@@AAA@ACGGMEG[CGLBB@ECECIGIEBE@A@EBO@EGA@@@AHGPCHCEGGCGOA@CBDF@FBHADMDAIAICIJS@@JMBA@@PFLDL@BD@B@H@@BBBBBBBA@BB@@@DCB@DA@@BB@BBDHHJJD@NKB@@B@BCF@HBD@@D@B@HBBADEBEDABABMB@@@JDFBNCFAFEDAFAHAH@J@J@FCFE@@B@BCDEDCDCDCFAFAH@F@D@F@HE@ABA@CBCBC@E@A@C@CBEAC@E@@H@PEJA@@FB@@CGCCG@E@CEE@CCAEKDCI@A@GCAAG@CAEAEAE@C@IACBCA@B@@AACCEAC@ECC@CCC@CEEACAG@CCECEAECEEGCCEECACCAAEECCACCACCCAECCCECCCECCBEDCDCBCBEDE@C@CCCE@EAEAEAC@AACAGAEACCCCECCCCECCCE@IECAGCECE@AABB@D@B@DAD@BEDA@ABABADABDDBB@B@BABAB@DABEBGDCAECA@AAG@C@EDCBCBMD@@@BBD@BBBB@BBBDBBBBBBA@BB@B@@B@@BD@BB@@B@@B@@DB@BBBB@@BAB@@@@BB@@BB@@BBBB@B@@@B@@@@@BADA@@BA@@@@BJLBDOPE@BHBPEFA@ODS@C@@BBB@B@BBB@@@B@@@B@B@@@B@@A@@BAB@@@BAB@B@@@@@B@@@B@B@B@B@@@BBB@B@@@@BB@@BBBBB@BB@BBB@@BBBBDBFDBB@BBBBBBB@@DDBBBB@BBB@BB@@BB@BDBBCBA@SBIEI@WFE@M@FL@BGH@@GDCBEBCBEBC@GDC@ABA@AA@AAAAA@AAAAAAAAAAAA@AAAAAAAAAAAAAA@ACAA@AAAACAA@AAAAC@AAAAAAA@@BCBAB@@CBA@C@A@ABCBA@ABC@A@CBA@EBCAGCBADCCMEAIAICAIA@EC@@OPAFEFAF@FHN@BA@KGGEEEFIFGGGCSAC@C@KEM@G@@@A@@@@@ABA@ABA@@@ADCBW@ABAAA@EAC@CAAAA@@@A@@BC@ACC@EA@ABA@C@A@BB@D@BABAHIDEBA@EBA@BF@BQBK@C@UBI@EHG@ICEAGC@FCBA@A@QCG@K@GEGCIDQJKCAGC@C@EBMHCBIDGBSDCEE@K@EFC@CCICCBCBGBE@CBE@E@G@@B@BAB@BABABAFGR@BAB@@A@ABKH@BA@@B@D@B@@ABEDAB@B@@@B@D@@AB@@ABAB@B@BABA@B@@DF@@DEA@BAB@@A@@@B@@@A@@BA@BBA@@@C@@BC@@@@AEJ@@@@BH@FAFADCHCDCDEFEBEDE@GBGBA@A@E@EDAB@@CBAFADAD@D@D@F@D@@@BBF@F@DBDB@BFBHBD@DBDBD@FDD@@BDDDJHBBB@JDF@FBHDHFAB@@@BBB@B@@AB@@ABA@@BABA@@@@B@@@@@@@B@@@B@@@B@@@BA@@D@B@B@@@@CB@@EA@@A@@BA@A@AB@BAB@B@BA@AB@@AB@@AB@@@@@B@@@@@B@@@@@@BD@@@BABCBAB@B@@@@@B@@@@B@@@DB@@BB@@@B@@A@ABCD@@@B@@@B@B@B@@@B@@@@@B@@@B@@AB@@@B@@@B@@BB@@@B@B@B@@BBDBBB@@@B@@@BA@ADA@@B@@BD@B@B@BA@@BAB@BBB@BB@@@D@B@B@@@B@B@D@B@DBBB@@BB@BBDB@@BB@DB@@DBD@BBB@BBBD@BFDDDBB@@DD@@BFDBB@@B@F@B@D@B@BEDABHCB@JL@BFBF@@CBADANBD@FBND@BCBCDBFNLDDBDTbELED@JBV@FDHBHCJELALEDOF@HHAJBPF@B@JINEHGD@@@@ABA@CJAH@B@BAB@B@H@DBHHHTDLJHH@BBF@H@HAJGJABEDABADAD@N@N@BCFAFCFD@LABAJAHEDAFADCDADAD@B@FAHCB@DA^@@@rAD@TBT@BA^ELA@@HAFA@@FA@@DAFABAHCTM@AFCBABCBC@CBCDE@A@C@CAE@@BA@@B@B@DABADA@@DE@@DGBAHENGDCDCDC@@FE"],encodeOffsets:[[119618,35230]]}},{type:"Feature",id:"341324",properties:{name:"泗县",cp:[117.910629,33.482982],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@A@@@@@A@@AA@@@@@A@@@A@@BA@@@A@@@@BA@@BA@@BA@@@AB@@@@A@@BA@@@AB@@@@@BA@@B@@@B@AA@@A@@@AAA@@AA@@@@A@@A@@@@@G@EAE@E@E@EAE@EBC@E@E@E@GBE@C@I@G@@@GA@B@F@@EHADADEFUG@@@A@ABI@@ECCAC@C@EAG@E@E@E@G@CAGAEAECCCACAC@EAE@CAEBGACCEACGCC@EAC@EBCBGBEBEBC@EBEAC@EAA@CC@EBE@CAEAEECCCEAEAGBEBEBEBA@EBIB]ECAeEEACDKLCDcCMLUFEX@DYJCFGHGVCBOD]F@BBFBDBFDDDFDFDDFDHFDFDDFH@JBFDDB@B@B@@BBBAB@FIBBJ@F@H@@@@BJJTAFADEVEF@B@B@DA@CB@@GTAJ@DBBJBBL@DBNDH@@DDB@BB@BBBB@@B@BGLEFCDA@AAKCGCA@@D@HA@K@@P@DBF@@ABCDGFE@GB@@@D@BA@BDBDBPBH@B@BB@HHDFBD@@BBFH@DB@R@@@B@F@@D@D@BHDFD@DBB@D@LBRAFAHAFA@I@G@@BABAFCPADDDFBFDHBH@HBF@H@F@H@FAJ@FBBBAX@DAVDPCLCL@FBDBH@H@HBH@B@F@L@B@B@JAN@HALDJDL@D@HAPHBA\\BHLLHAB@JAF@DC@@BA@A@AAA@A@A@@@A@ADA@@BAB@DAB@@AD@@@BABABCBE@@CGB@AAB@@CBA@DNCAAFAA@DAAKFAD@BBD@BB@@@AB@@FF@BF@@B@B@F@BBDFBBB@B@F@F@D@B@HDDDB@BB@@BBB@DB@BB@@B@B@BB@@@DBD@B@BBB@D@@@FBD@B@H@B@BA@@B@@A@BB@B@D@D@DD@@B@D@DBDA@@B@DA@AB@@@B@B@B@BA@@B@B@@@BBF@B@BBB@D@D@D@HAD@D@BAB@BA@@B@B@DAD@DAD@BA@@D@B@@@B@HCB@B@H@B@B@BKD@JENB@FCHCD@HFB@DJ@@AJBADLB@@BEB@BC@@DAF@DAHAHAHAFBFBHBFDDFBB@D@@@FDH@D@F@@BDDDBFFBFFBB@@@@DABA@@BABA@ABA@@@A@@@@BI@A@AB@@@@@DAB@BAB@@@D@B@B@B@B@F@DBFBFB@@@ED@@AHAB@FA@DBAD@@BDA@FB@@@B@B@B@@ED@D@D@ACB@@EB@@CD@BBB@@AF@@BHB@@CL@BD@ABF@F@FBB@FB@AA@BCDB@EF@@DA@AFD@B@ABFDNH@AJD@@BB@@B@@BBB@DBFD@D@B@F@B@F@D@H@DAB@@@B@D@B@B@@@B@B@B@B@F@D@F@B@B@B@@ABBBBFBDBB@DADAFC@AB@FE@@BCB@@@@@@A@@@@@@B@BCBABAB@@@DC@@B@FCFABA@@B@B@DAD@B@B@@@B@DBFBFBF@BBB@B@@@@@B@FAB@@@@@@@HAD@HCF@HCB@@@@@@@@@@A@@CACCA@@@@A@AA@@@@AAAA@@AA@@@AA@@A@AAA@A@A@@@IBC@A@CBA@@@@AACCGGQ@@@AFAJALCBA@@@A@@AAAC@AAA@CAE@C@C@A@C@EAA@CBA@A@A@CBA@C@A@C@A@C@A@C@A@ABCBC@A@A@A@A@@B@@A@@@@A@CC@@@@AAGEAAOM@AIGGE@AA@AAAAA@AAACA@@@CCAACAACA@ACA@CCCCA@@@@AA@AA@A@@IEA@A@@AA@@@@@AA@@@@BCBEBCBA@GBIBG@C@@@A@CAC@A@CCU@G@@CW@C@A@EAIAMAI@E@A@CA@@CMAEB@EB@@GJA@CF@@A@@@AD@@CAA@GAIAC@EBI@A@G@Q@I@E@@aLEBA@GDE@IDCBCBEBIBCBEBA@E@I@@A@AA@@@@A@@@A@@AA@@@A@@@AA@@A@@@@@AA@@A@@@A@@AA@@@A@@@@@AA@@A@@@@@A@@@AAA@@@@@AA@@@A@@@A@@@A@@A@@@@@AA@@A@@@@AA@@@A@@AA@@@@@AA@@A@@A@@A@@AA@@A@@AA@AA@@@@@AA@@@@AA@@@@A@AA@@A@A@@@A@@AA@@@A@@@A@@@A@@@@@A@@@A@@@A@@@A@@@A@@@@@A@@AA@A@@@@@AA@@@AA@@A@@A@@@A@@@A@@@A@@@A@A@@@A@@@A@@@A@@@A@@@@@A@@@@@A@@A@@A@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@@@@A@@@A@@@AA@@A@@@@@A@@@A@@AA@@@A@@AA@A@@@A@@@AA@@@@A@@@A@@@AA@@A@@@A@@@@@A@AA@B@@@@A@@@A@@BA@@BA@@BA@@BA@@B@@AB@@@@AB@@AB@@A@@BA@@@@BA@A@@BA@@@A@@@@@A@@@A@@@A@@AA@@@A@@@A@@@AA@@A@@@A@@@A@@@A@@@@@AB@@A@@B@@A@@BA@@@@BA@@@@@@@A@@@AA@@@@AA@@@AA@@@@A@@@@AA@@@@@AA@@@@@@AA@@@AA@@@@AA@@@@A@@A@@@@AA@@@@AA@@@@AA@@@AA@@@@AA@@A@@A@@A@@AA@@@@AA@@@@@AA@@@@AA@@@@AA@@A@@A@@A@@A@@A@AA@@A@@@@AA@@@A@@AA@@@A@@AA@@@A@@@@AA@@@A@@@A@A@@@A@@@A@@@A@@@A@@@ABA@@@A@@@A@@@A@A@@BA@A@@@A@@@A@@@A@@@A@A@@@A@@@A@@@@@A@@@A@A@@@A@"],encodeOffsets:[[120803,34150]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("铜陵",{type:"FeatureCollection",features:[{type:"Feature",id:"340705",properties:{name:"铜官区",cp:[117.85616,30.936272],childNum:9},geometry:{type:"MultiPolygon",coordinates:[["@@@A@@@@@AA@@@@@@@@BA@@@A@@B@@B@@@B@@@@@B@"],["@@A@@B@@@@B@@@B@@@@A@@@@A@@@@@"],["@@BABA@@@AD@@@B@B@BAA@@ABAAB@@ABA@A@AA@@ABA@@B@@ABC@A@@@@@@BBBA@@@@@B@@BAB@@@@@@C@@B@@@AD@@@B@@@@@AB@@@@B@@@@A@@@@B@@B@@@A@@@@B@@@@A@@B@@@@A@@@@"],["@@A@@@@@A@@@@@@@A@@@@@C@@@@@@@BA@@A@@AA@@@A@BA@@@@@@B@AAAB@BCBC@@@BBB@@@@@@@B@B@@BBA@@AB@@@@@@ABA@ABAB@@A@@@DA@@B@BB@@@@B@B@B@@@BABA@BB@@BD@@AB@@BB@BBB@@@BB@@@BAB@@@B@@BBBB@@@BB@@A@A@@@AA@@@B@@AA@@@@@@A@@@@@A@@@ABB@@@@BA@BB@@AA@@ABA@@@@@A@@@@A@BABA@@@AD@AAB@A@A@@B@BAB@@A@A@AA@A@@@@B@BCDA@AB@@@@@@@B@@@@AB@A@@@@@A@@B@@@@@@A@@@ABABCB@@A@@B@@A@A@@@@@A@BBA@ACB@B@@@@@AAAB@@@@@@A@@BB@@@@@A@@B"],["@@AA@@A@A@@BA@@@A@@B@@AB@B@@A@B@@D@@A@AA@@@B@@@BB@@@B@@@B@BBB@@@@@BB@@B@@@BA@@@@BAB@@@@BB@AB@@@@B@@@B@@BBABF@@@@@@@@ACAACCB@ACA@B@AABA@A@@BD@B@@B@@@@@B@@@@BBBA@BB@@B@@@BB@@@@@@@@@A@@A@@A@@A@@A@ABA@@@@@@@A@@ABA@@@AA@@AAB@A@BAB@@BB@@@@@B@@@@ABB@@B@@B@@AB@@BB@@@BBBB@@@@@@B@@B@@@B@@AA@AA@AAA@A@@@AA@@@@@CA@ABA@BB@BA@@B@AA@@B@A@@ABAB@@@BA@A@@AA@@@BA@A@@BABAAA@@B@BA@@@@@A@AA@@@BA@@@@@@BAA@B@@A@A@@@A@@@@B@@@BBB@@"],["@@@A@@@@@@@B@@B@@DFA@@A@@A@@B@@@@@B@@BB@@@B@@@@@BA@@@AB@A@BA@@BAB@DAB@@B@@@@D@BADAAA@AAAA@ECB@DAA@@A@@@AA@@A@@A@@AAA@A@@@@@AB@BCA@@A@@@AAA@A@A@A@A@@@A@A@@@A@@@@BA@@AC@@AA@A@A@@AACB@B@DAB@DA@@@@@AB@@BB@F@@@D@BB@@BADA@@@@@@@B@@@@B@BABA@@B@B@B@BA@@B@@@BBBA@A@EAC@AAA@E@@@A@@@@@C@@AA@A@AA@@@A@AE@A@@BDB@B@@A@CB@@@BB@D@@@BBB@@BB@B@B@@@@B@@B@@@A@@@@@@BA@AAAB@@@@BB@B@@@AB@B@@@@@@AB@@@@@BB@@B@@@@B@B@BA@@B@@@@@@@B@@@@A@@@@@ABB@@B@@@@@B@@@BA@BBA@@B@@B@B@@@B@@@BB@@@@B@@A@ABA@A@@A@@@A@@@@AA@ACB@@A@@B@B@D@F@@@@@@@A@@@@B@@D@"],["@@BADB@@@@@C@AB@@AA@BAA@@@@@A@@@ABA@@@@B@@B@@@@@BB@@AB@@A@A@@@@AA@@BD@@B"],["@@A@DBABD@@@B@@@@A@@@BBAAA@@@@@@@@B@@@@A@AF@@C@@E@A@@@@BCB@@B@@B@@A@AB@@@@"],["@@EDA@KEEHABAD@@BB@@@@@@@@@B@@@@@B@@B@@B@@@B@@@@@@@@@@BB@@@@@@B@@BA@@@@BA@AB@@@@@@A@@@@A@@@@@@@@A@@A@@@@@@AA@@@@@@@@@@AA@@@@@@@@@AAB@@@@@@@@AB@A@@A@@@A@@AA@A@A@AA@B@BB@@@@BA@@@@@@BA@@BB@@@@B@@@@A@@@A@@@@@A@@@A@BBA@@B@@BBA@@@@@A@@@A@@@@BA@@A@BAC@@@@@@A@@@@@AAAAB@@@@A@@@@@@A@@@A@@@@@A@A@A@A@A@@@@AB@B@@@BAA@@AAB@@@@@A@@ABAA@@@@AB@@B@EBA@A@A@ABA@@@@@AB@@@@BBA@ABC@@@@@A@@@@AC@CBQHHDTTBDDFDHBFJA@@B@BA@@D@BAD@BA@DD@D@BBB@@B@BABB@DBB@B@DAB@BBB@BBB@B@@@B@D@B@BA@AD@B@@B@BBB@B@B@B@BA@@@A@@@A@A@A@@@@B@BAD@BAB@D@BE@CFGAFHC@A@CAAAA@@BA@@@AAA@@@CABADA@ACCABBB@BA@@B@BC@@A@A@@@ACACA@@AAA@EAGZ@R@HbA@NR@@F@NG@M@K@M@TLRDJDNFTPL@LERAD@`IPAJCRAPARDHBFCMYBABCBIBCDCDAFADA@@@CACA@C@G@CAAEACIOAGAGDIBCB@DCBAD@BBFDD@BA@CECJC@G@C@CJAF@@@AGC@MB@E@C@CFCF@HBDB@@BAAACCECKAAA@A@AHCDACAECE@C@CBEDABABE@GAEAEGGECCCIAEAA@ACEA@I@M@AFAF@BAB@D@FADCBE@E@E@G@@@@ABA@A@A@A@ABA@AA@AACA@AA@@@@B@@A@@@@@@@A@CBA@CBA@@@C@A@A@@BABB@@BDD@@"]],encodeOffsets:[[[120601,31658]],[[120865,31610]],[[120869,31593]],[[120857,31658]],[[120848,31610]],[[120821,31671]],[[120811,31686]],[[120803,31686]],[[120654,31642]]]}},{type:"Feature",id:"340722",properties:{name:"枞阳县",cp:[117.250594,30.706018],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@B@B@NDRDBK@K@EBOEG@K@K@KAIAKAKAK@IAAAGAI@CAGAGCGAAEMKOIIMMIGYSIGMKMIMCA@C@AAQAIAEAUMCKCOHEDMLGFAFAFA@AB@B@DA@ADEFCBIHCBCDGDEBIDGB@DDDA@@J@@BDDDBBBDCFGNBDB@D@J@B@B@DAB@B@DBFBDFBDBBD@D@BBFABBFBBA@@@B@@ABB@B@@@B@F@B@B@@@DABB@@C@ABDB@B@@@B@B@B@FBBA@@B@@BABBB@@ABA@A@AA@AC@C@@@EFBLCBCGGCE@C@@EKJCJCNCTOR@N@H@PEB@XTFDD@J@PGBAD@PFB@DDNLHFLNHH@@LRBBBDFLBD@@@D@@A^BV@NBH@HBLAH@H@H@F@L@@JBDB@@"],["@@MBiCUCYEMEEAOEOEQCOGOGKG]QOGECcOGCOE_OKJCJAFCLAF@F@H@HBJBDBHHHBB@BBBTLNFLBJ@AD@BC@ABA@ABA@A@A@ECA@C@@@G@E@@B@@@BA@@@@@CAAA@@I@AAA@A@A@E@AB@@@@@B@B@@DDBB@@@B@@@@ABABABA@@@@BA@AAC@OEC@ED@BBBFD@B@@AB@@C@CAKEA@AAEBC@CBA@@BABABEBABA@@@@B@D@DADC@CBC@CBABGBCDCFADGDGFSPCDAFALADCDCFGBIDGHGHBJFf@dBhC^AFC^ItGjCLGbCTAJALCZ@LBHBHJJHHFDBFA\\ALBDJFRBHADAFCPGF@F@@@@@JBHHDF@FADAJCJBJBDDDFD@B@J@RBBBBDBDBDHFNDHDBFFLNFDF@B@DADALIDCBGDCDAFCFCDAFCDCFALEDEDIDIHEFIDE@EBIBGB@DCHGLCJEDABAFCLKNKJGHGFELCFADBHDH@FBDHDHHND@H@DAHIFEHEN@FAF@FFFBFBFAFAFCDA@A@CFGHIFIDEBIAAACCAA@BCBCBEDADAJ@D@JBBD@B@H@B@FBDFDF@JDNHFDB@FDFBF@HAFAFAJCHABADAJADDBFBHBFBFDBFBNHNDRBJ@FBB@FBJBFBLJFBHDFAFCB@RKHAD@@@D@LCB@BA@AGMCQBCBCFAFAFAH@D@DDDFBDD@J@DADCBADABAHBH@F@FBBBFFFFFBF@L@JAFCPEHBFAF@NE@@BA@@DAB@BABA@@B@@A@@BAB@BA@A@@@@@ABAB@@ADBB@D@@ABAAA@@@AB@@AB@@A@A@AAADCNOBA@@AA@C@A@@B@BBBBBBB@B@D@@BB@@A@C@ABA@AAAAAAAACFCJCB@FBA@BB@@AA@G@AEDAAECAAAEW@@EBAACBC@A@E@AC@AOABGAAACCACA@A@I@ABBBA@@@AHA@@NEACQa@ACEioFCNIOSC@A@[RJJJL_TCEABA@GJECAAAAAACACAA@GACAAAA@EAE@A@C@C@G@KDEA@F@@@DBDB@@B@BAB@BA@AAAB@@@AB@AA@E@A@A@A@@@ACABAD@@@AACB@@A@A@E@A@@@A@A@BA@@@A@@ABEAAAEBAAC@C@AAACCEEACAA@A@CBA@A@I@C@A@ACHMDEACAACCAC@@@IB@CC@CHAJCFAHCDCDAJGDAFEBCB@@C@ABAB@BEBEHENKFCPGaIaMSGKCWKICaMGCAEEA@@AIAIAC@AAAAAAC@AACAA@CACACCC@ACCCEAAAA@AEACAGEGACAA@C@A@I@C@I@I@E@GBK@KBSDUFA@OFCBCBABCDCB"]],encodeOffsets:[[[120548,31671]],[[120212,31432]]]}},{type:"Feature",id:"340706",properties:{name:"义安区",cp:[117.791544,30.952823],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@ACAC@C@@@@A@YN@@`A"],["@@KAJN@ABI"],["@@@A@AB@@AAA@BA@@@@@AA@@A@@@A@A@@@@AB@AAB@@A@@@A@@@@@AA@BA@@@@B@@@@@@A@@@@@@@AB@@A@A@A@@A@@@AA@@@@A@@B@@@@A@A@@B@@@AAA@@@@BABBB@@A@@@@B@@@A@@@@A@@A@A@A@@AA@AA@@C@A@@A@@DAB@@@@ACA@AB@F@@B@B@@BBB@B@@BD@@@@@B@@@F@B@BBD@FBB@B@AA@A@@@AB@@A@A@A@AB@BA@A@A@@A@@@@@@@B@BC@AA@@A@C@@@EAA@@BA@@@@B@@CBA@C@AFEHK@IDEAEBIKUBA@CECC@EGCA@BC@CE@CAAMEEAIIEGGEEEACACACECCBADADCDCDCFEJABGBE@GCCACECCECACEEEAECGCKCIAIAI@C@C@GAICEAEEECEEIEIEEEIEEGAE@E@IEEECGACAICGCGCCA@@A@A@@B@@@AA@A@A@AA@@A@@@@AA@@@A@@@@@@@@@@A@@A@@@@@@@A@@@A@@@A@@@@@@@@AA@@A@@@@@A@@@A@@AB@BA@A@@@AA@@@A@A@CAA@@@AA@A@MBI@ABA@@B@BBBDJBB@D@@@B@BA@ABA@A@IAEAA@M@MAA@ABA@@B@B@@@BDDBDBBAB@B@B@B@@DBB@DDDJBDDB@D@HBH@FBFDDDDDF@B@J@FBBD@LBD@BDDHFFD@BBDBBBBBADC@AD@BBDBDFBF@LBH@FBDD@@BB@FBDBDFBFBFBD@FDBDBF@DBBFDDBHDFB@DBDADADBFBD@DDBDBD@N@J@B@DF@BBBBFDJDDHFFHFBHBF@BABAFCDAD@F@FDDBCBGD@B@BBBLBFDDDBBAB@@CAGAE@ED@D@D@FNAD@BH@@E@IB@D@D@HIDFD@DABC@ECAAC@ABCDA@ADCJBHBHJPBDBFDBH@D@B@BD@DF@@C@@DBB@B@@BB@B@@@@@B@@@@@B@@@F@@@@@BA@@@@@AB@@B@BB@B@@@@B@@AB@B@@BAB@@@@@@A@@@AB@BC@@BCBBHAACB@F@B@@@BB@B@@DB@DB@B@B@BBBA@A@CBABCAC@CBAFAF@CADABBBABDFCBBJGHG@ACB@@BABCE@ACAGCA@ABADAJ@ACB@@ABB@@@@BAB@@@@AA@@@DA@A@@B@F@@@@DE@@B@BB@@B@@@B@@@@B@@@@@@@@BB@@CBA@AC@@AB@@B@@B@B@@@BA@@AA@@@@A@@@@A@@B@BA@@B@@@@@B@"],["@@LVAJBFCF@JFBH@@DFB@FB@DADAFDH@DAHD@C@AD@DDDBB@Qn@@@AB@VgF@DBDC@ADABA@@@A@@@@@A@@AAA@@@AAB@B@@@BBBB@BBBBDDBF@@@@ADA@A@A@A@@@AAA@CAAA@@A@@@@@A@@B@@A@@@A@@B@B@@@B@@BFD@@BAB@@BB@@@B@@@BA@ABAB@BA@@AA@@A@@A@A@A@AB@@ABA@A@A@ABAEBEBA@@@GBCBEBGCECCA_B@B@@A@@@A@@@@@@AB@@@@@B@@@ZMAAC@@@@@@AA@@A@@A@@@@@A@@ABA@BBA@@B@@AB@@@B@B@@@B@@@@@B@B@@A@@@@AA@@AA@@AA@@BA@A@@@@@@@AA@A@B@AAEBA@A@@@C@@B@@ABAB@@@@@B@@A@@@@B@@A@@@@@@B@@@AA@@@@@@B@@A@@@@@BA@@@@A@@@C@@B@@@AD@@@@@@@BA@AA@@@@@B@AA@A@@@@B@D@BA@@@AB@BA@@BBB@B@BA@@BA@@AE@@@@A@@BABAA@@A@@@C@AAA@@B@@@@C@@@C@EBE@CDEFAFAF@DCFABABAJB@B@@@BB@@AA@A@@@A@@B@@@B@B@@@@ABB@A@@@@B@@A@@BBB@@@@@B@@A@AB@BBBA@AB@B@@A@@BB@@@BAB@@A@AB@BB@A@@@BBA@@@ABA@@AAB@BDB@@@@B@@B@@@BBB@BBBB@@BA@@@A@@@@A@@@@A@AA@A@@AA@@BA@@@AA@@@AA@B@@A@@@@@A@@AA@ABB@A@BB@@BB@@B@BA@@@B@@@@@@AB@B@BB@@@@BB@@@@B@@@@@@@@AA@@A@@@AAB@AA@A@@A@@@@@A@@@@AAC@@@BABBBA@B@BDA@DDBBBD@@@@@@@@AEAB@AA@@@A@@@@@BAA@@A@@A@AB@@@@AB@@A@@@AA@@@@A@AAA@@@A@@@A@@A@@@A@@BBB@@@@CA@B@@@@ABA@@@AB@@@B@IMCAECEA"],["@@BB@BA@@B@BABB@@BA@@B@D@@@@CAABAB@DA@@A@@@@@@A@@@@@@A@@@AA@@@A@@@@@@@@@BBAB@A@@@B@@A@@@C@BACAB@AA@BA@BDI@CBAB@BDBBHBDF@ADAB@@DA@BGHIHAAEDACABAACBDBE@EBAB@DBDADAB@D@BABAAA@A@A@@CCA@@@AAA@@A@E@A@BDGBAAAD@@ADA@@B@@@B@@@@A@AB@@@ABA@@@A@@A@A@@A@AA@@B@@@@AB@@@@E@@@A@@@@@A@@@@@A@A@@AA@A@CA@@@DE@@@CBEBCBCDADAJADABNZEDGAQCOBQBIDOB_JC@QBKFK@SOMEICQCSKN@L@N@H@@M@EQ@@MaB@G@QHYFBB@BB@@DBDB@B@@@B@BD@@A@AB@@AAABADD@BCBABDB@@B@BB@@B@@AB@BBDBB@D@EGHBDEF@@A@CBA@ABC@A@A@@B@B@B@@@B@@@B@@A@A@A@AAA@A@AA@C@@BABA@C@A@@@A@A@AAA@AAA@CBA@A@CAA@BA@A@AA@AAC@C@@CABC@ABC@@@ABA@@@IBAECGCEACSSGCGEWOAP@F@LALAHANBR@LAB@@EZ@BA@@D@B@B@B@B@B@@@@@BAD@D@H@D@BAD@JBH@FBL@DBJFPRhL\\BD@BJXRn@BPTNLJHFBHF\\TrTVBR@RCTIVWJY@UDIBADKPOXKHATGH@H@H@H@JBDBHBJDHDFDFBFFLHFGDAF@J@FA@GF@DEDGLDLHNEBCDAJEEIOSAC@A@@@AAEJQHEPADKAGLGJAB@FAVAL@NALCDGDGFGFEFEFADAHADAFGDIBGFGDCHAHAHAJ@F@F@FBH@FBH@F@H@HAFCDADCCGDEDCAAAAAE@E@EAAAAAECI@EBEBCJA@CAAIBBEDID@@KBCJCACIDACCCEA@@A@GAAAAFG@G@GAGAGCGAGCIEECGCGCGCGEGECGEEEEAA@A@@EAE@GB@@AB@B@B@BAB@BA@@B@B@B@BB@@@BB@@ABA@AB@BAB@@A@@@A@@AA@AB@@EC@AA@@@A@A@@@@B@@@BA@@@@B@@@@@BB@BB@DBB@B@@@B@B@BCB@B@@E@CAACAA@AAAAA@@A@A@BB@@B@BB@@@B@@@@@B@@ABCB@BCDCAE@Uh@@DABABA@@B@@@@@@@@AB@@@@@B@A@@B@@A@@@@@@@A@@BCBADA@@@@@@BBBB@B@@@BA@A@AB@B@A@BBC@@B@@ABABB@@@@@@B@@@@AB@BB@@BA@@AAB@@@@AA@B@@@B@@@@@B@@@@B@@BA@@@B@@B@@@B@BA@@A@@AAAA@@@A@@BA@A@@AA@@A@AAA@@AA@@BC@@AA@@AABAB@@A@A@A@@@@@AAA@@@CB@@B@@@BABAB@BA@@@@@@BA@@AB@AA@A@@@@@@@A@AA@@D@DA@ABABBA@@@@@@@ABB@@@B@@BB@@@AB@@@@@@D@@@@@B@@@@@@@B@@@@@B@@AB@@@@@A@@AB@@@@@@@BABB@@@@A@A@BDB@AAB@@@@@B@B@RmA@CACCC@@B@DGCCBG@ECCBCBA@@EEA@CG@EAGLEFDABB@@@B@BBB@@BD@@AB@@@@@B@@@B@B@@@B@B@B@BBB@B@@@BB@ADA@@B@@@@@BBB@BB@@@@BB@@B@@@BB@CBA@FDB@BB@BBBCBABC@@@@@@AA@CBA@AB@@ABB@A@@B@@AB@@@@A@@@A@@AA@@@@@A@@@@BB@@@EB@CA@@@@A@@@@@@@BC@@@@A@@B@@@@@@@E@C@A@A@@@@BA@BDB@@B@@B@@@B@@@@BAB@B"]],encodeOffsets:[[[120882,31609]],[[120846,31604]],[[120815,31681]],[[120832,31599]],[[120815,31677]]]}},{type:"Feature",id:"340711",properties:{name:"郊区",cp:[117.768026,30.821069],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@IBKBIDGDADCFCDCDABED@D@D@DDFJFDBJFF@F@FA@@HCFCDADBFBHBFBHEBADIDEDCDCBAA@IECAACAEGGECE@A@E@E@"],["@@BA@@A@@@@B"],["@@AA@E@I@ACECCCCAE@EAG@G@CCAACCICCA@CA@@E@CBABCBCBAB@B@DDBFBBBBB@DBB@HADCF@FADABA@IAABAD@BABA@A@AEECGEAAMICCC@EAA@I@K@I@EAABBB@DDF@B@@BN@DJHNNJJLPFNBBDHBHBH@DBJBHBB@JBLBLBLBJ@L@L@LFHXPHFRGDAD@@B@@B@@@@@D@@A@@A@@@A@@@@AB@@@B@@A@@@@@@B@@B@@@@@B@@B@AA@@@@BA@@@@B@BAB@B@B@FAA@@@BA@@@@BBBA@@@B@@@@BA@BB@AB@@A@A@@B@@B@B@B@B@B@@@@@B@@@B@@@@@@@@B@@A@BBBB@@@@B@@@@@@@BD@A@BB@@A@@B@@@B@@@@@B@AA@@@AB@AAB@@@B@@@@@B@@@B@@@@@@A@@A@@AB@@A@@@@B@@A@@A@@A@ABBB@B@B@@BB@@@B@@@@BBA@@@@@@@@BA@B@@@@@@@@BB@@@@@@@@@@BB@@@@@@@BB@@@@@@@@@@B@@B@@@@@@@BAB@@A@@B@@AA@@@@@@@AA@@@@@@@@@@@A@@@AA@@@@A@@@@@A@@@@@@@@AA@@BCBAFGLFB@FC@@CC@AA@BA@AB@B@D@@@B@DAB@DAB@@@@@@@B@@@@A@@B@@BDBBBB@@BAB@B@B@B@BAB@B@@H@F@F@F@DABC@E@CBA@ABEBEC@CACA@CACAEBCBCAC@CEAGCCAECAA@CAEACECC@EAEAEAACAC@EAA@@CCEAG@KAE@EAACAC@ABCD@BCAAAACAAAC@EECGACC@KAC@"],["@@BGLK@CECIEOIEAE@IFIFGBI@I@EACB@DBDBDBDH@FBDDBB@F@BDDFF@DCF@@EBG@CFAF@@@FBDHDH@FFFBF@DBBB@FFDH@PJDFDHDF@@BBBDFFFDFBH@H@JCFCDE@ABGBG@G@ICECEACMACACECICICCIA@@GAEE@AAE"],["@@ECWSA@OFG@M@Q@SPMDIDIDFL@@@DDFHHADH@D@D@B@F@FBB@BBDBHBB@DBDBBBBBBBFDHIB@BADF`SIKII\\QB@"],["@@PTMJEDjpDF@BRbBDMF@@GB@B@@ABAA@B@J@BDBDBBDBBAHPB@BBDF@B@D@DABBFA@@FXBBDBBFCBBFH@B@@BA@@ABEAA@IDEDBDBBBBBB@BAB@B@D@BA@@AC@A@A@AAAAAAA@@@@B@DBB@B@B@B@D@D@@BBBBBD@DBDBBD@CN@HDBBBFBD@B@DBD@D@B@BAB@AHABFHHFBFEBFDBDH@BDBHAFDDD@BBBDEPDBB@B@D@B@D@B@HHHHF@B@@A@IBABCB@B@D@H@D@D@B@AK@EAG@IBC@A@C@G@CBC@A@@@@@A@A@A@A@A@CB@@AFY@@BA@KAQBMBGQCMCA@A@@@CAIA@@@K@E@G@GBGAK@GAG@MAUB]@@@C@@ACEKACAAKQ@@GGKMGEMKCCA@OEC@ABOHI@"]],encodeOffsets:[[[119758,31364]],[[120600,31659]],[[120605,31557]],[[120108,31168]],[[120478,31559]],[[120480,31559]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("芜湖",{type:"FeatureCollection",features:[{type:"Feature",id:"340202",properties:{name:"镜湖区",cp:[118.385009,31.340728],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@GCECCAEA@@A@A@A@@@@@EDA@EBCB@@@@AB@@EBA@CBIF@@@@A@@@@B@@C@@@@@@@A@@@A@@@@@G@G@CBAB@B@@@BAB@@AH@BADAF@F@@@B@@AD@H@@AD@@@@@B@@@B@F@DAD@FAD@F@@@B@BAD@@@BAFCJEP@BT@LA@@@@J@F@D@@@H@@@DABADB@ED@@BD@BAB@B@@BFADAIGC@AA@@@A@@DCD@DA@@F@FCCC@AF@FAEMB@HBCG@@AAD@LA@G@@F@H@B@DA@C@A@CA@AC@@AAAACA@A@@@@@@@AHBBA@@A@@@GC@A@A@A@@@ABCBAB@@@B@@@B@@@@@B@@@B@@BD@FBJBB@@BB@@@@@B@@ABCBGC@CAAAAAAEAA@@A@@AA@A@A@C@@@A@C@EAA@"],["@@@@@CDGAM@CBE@C@A@A@ABA@CBAAGAEA@EDOAMFMDO@A@GD@HJNFFFDBBBD@B@BAB@@CBWBQAAH@FAA@B@@@@@D@@@BAB@@@B@@BB@B@F@D@DBB@@B@@@B@@@B@B@DAB@F@D@HBHDBBDBDDB@DDFBBDFF@FBD@NBD@F@R@HAF@@ABAD@JBFBDBFBDBDDDFNBF@HCHIPCB@BA@@BEDABCDAFCBADED@BAB@B@@@B@@@@AB@@@B@D@BBD@B@@@BAB@B@B@DB@@BB@@@@B@@@B@@@@@B@@D@D@FAB@NEDAB@B@BA@A@@@A@AAA@ABA@@BAHAB@DAJAF@DAD@FBDBD@BB@@D@B@@A@A@AACAE@AAACC@C@@DCJE@CBIDGFC@EAGCGCECCCC@ACE@E@E@@FI@AFMBCH@BAFADCBCBAAI@IAOAKAKAIAEAKAEGAGAK@IAIBEAECAAAC@A"]],encodeOffsets:[[[121246,32072]],[[121302,31928]]]}},{type:"Feature",id:"340203",properties:{name:"弋江区",cp:[118.372655,31.311756],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CAAAACC@C@ABA@AB@BAD@B@N@B@BBDBBBBBBDBDBHDB@HBDBB@HBD@B@B@D@HAB@H@@@FBBBBBBBDDBDADABCBIHABCBCBABBHBF@@@@@F@F@HADABCLHBFDHDDDFFDDBDB@@H@B@F@DBFCH@DDAH@H@@@@@B@@@B@@@@@@@D@@@@A@@B@@@@@JEDAB@FA@@BA@@@@DAFAB@FC@@@@B@B@B@@@FBDBFDHD@@B@FBD@B@@@D@B@B@B@@BB@@@BBBFBBBBDBD@@@H@D@DA@A@@@@@A@@@A@@A@@AA@@C@A@ABA@A@@@AAC@A@C@A@@BA@@@@@A@@@ABA@AFCBCDABEDCBAFC@AB@@ADAJODG@GAEEMCCACACAEACAE@IBCBA@@BE@G@Q@EAC@MAC@EEEACEACCA@CCCAAAGCGAC@E@A@CBA@A@@@A@@@A@@@AA@C@C@E@AAA@@@A@@BA@A@@@C@@@@@ABB@EBGA@@@AA@AAAAAEC@AA@@@A@A@@BA@CAAAA@AA@@@@@@A@AAEGAAAA@CCEAAECAAC@ABA@CB@BADADDD@NALAFABALCBEBC@CCEAEBCBCBEBC@CACAEAAAMCKGGGEGCE@AAE@@ACA@C@A@CBGHOHOJABCDADDDFBJDVHDBHDJFPLBBB@FH@DBF@B@DCNGJCBADCBA@EBA@AA"],encodeOffsets:[[121157,31977]]}},{type:"Feature",id:"340208",properties:{name:"三山区",cp:[118.268101,31.219568],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAE@C@GAC@G@CCA@A@AA@CA@CC@@C@AAA@@BAB@B@B@DC@BB@D@DABCBA@A@C@CD@DCBIHGDEBCDE@AF@DCDC@CBADA@@DABGFBDHFNDNBTHF@THNJRPJLLFNJLJLHJFJFDBHFLHLDHBDBFDJBBBJDJBHDLBPDVHHBJBD@LDRDHBJ@H@FBJ@L@J@HAHAHADABAN@PA@@H@FBD@DKBABC@G@E@E@@@@AEAGBADADABAJGDABABCACCCAAAAAAEA@@G@A@GBC@A@A@C@GAA@CAGAA@GCCACAAAAAAAAC@A@A@M@ABC@ABAB@BAD@D@BDBBDB@@BBB@FAB@DABCDAHIDM@C@AAE@CEGA@AAOKIEGCCAUGICEACCBCDCBAPIPGHGDAB@D@B@BD@@BF@BDFFHHHLHNDBBFBDBDBD@FADADAFAFBDDD@FADABKBABEBK@MCCECGEGEAAACACECAA@C@CBA@GBGBE@@BEBADC@C@A@CAAACAC@A@CAABAAAA@EAIACAC@IEA@A@A@ADABCBAAABCA@AA@@BA@@B@BC@A@@@A@@BC@EC@BA@AB@BEAAAAA@@CA@CAAA@@@@@@@ABAAA@C@C@A@@AC@M@A@Y@C@C@CBG@@BA@CBGHCFGFIDIDE@EAECEAQAGDEB@BBFBB@BIH@HB@ADB@@BA@AFBDFAD@BBAF@DBD@BC@ABFBBDAB@BHDCDBDABABABDHDBDDEFAB@@ABADAB@@@LA@BJBDADBDADACABGBCCCCCB@@CA@HCPAD@@EDIBK@SBI@C@BEDCBE@CA@A@A@CBCACC@A@CHCB@@A@@@ABBH@BABC@C@CBAAC@CBC@CCCCEE@ECCABCEACBE@EA@BGBC@CA@BEAA@BB@BE@CDCBABADD@BB@AD@@B@BABA@@B@B"],encodeOffsets:[[120981,31923]]}},{type:"Feature",id:"340221",properties:{name:"芜湖县",cp:[118.576124,31.134809],childNum:1},geometry:{type:"Polygon",coordinates:["@@K@GDCF@PBBDB@FBBNFBHAJKP@DABMAGBCBA@IAAAECCEBEBGBCFCBCCCCAC@ABGDEFAFAHCFCBGAAEBE@E@CCAEGE@C@ABCF@JBF@B@B@D@FABCB@FEN@DFFADKC@@E@C@EDCDABBDHPDJFDDFXHNNABADB@BFBHAB@DAB@B@B@B@DAF@DBNCH@D@@@BBDBBFDFBJAJBL@HBHBBFBLBFBJBLBLBP@JBJABADCDEBABG@ADEN@BEJ@@@F@FDF@BDDDDDFDHBH@FEDCHAJ@DIFCD@@@DDDBB@BBFBD@BBADCBAB@DA@@@@@@F@DA@@HAJ@@@HAB@D@PADAB@FBF@D@@@BA@C@A@A@A@AB@@AB@@BBBDDBBB@@@D@DABADAFAD@HANALAJAF@DAB@DAD@F@D@FBDBD@DBB@B@BBB@BBB@BAB@B@B@D@DBB@BBB@DAB@@@BAB@F@B@B@BBDB@@BBB@D@F@F@D@FBF@FBFDFDDBD@FBDAB@@ABA@ABA@A@A@CBC@A@ABA@A@AAE@G@AAC@ABC@G@CAA@AAACCAAECACAC@AAC@A@ABA@AA@AA@A@A@@@A@@AA@@A@@A@ADAFADCB@DABAB@@@DAB@@AB@BA@A@@@ABG@ABA@ABABABABADADCB@DABAFEBABAB@@A@A@@@AFI@AB@@@BA@A@A@ABA@CBA@@BCBABABA@A@@BA@CBAB@BA@@BA@CDABA@@FCBAB@BABA@@BAB@B@BAB@B@BAB@B@B@BA@ABCBADGBABCBABA@A@ABA@A@A@@BAB@BA@@BA@@@ABA@A@@B@@AAAA@A@C@A@GAECGAGCMGICIIEEIKEECIAE@E@CBGFC@C@@CE@@GCGICE@A@CBC@@HEDCBCA@CCGM@@AAECEEECEGGIEIEGGGMGCACCIGEEGCMAGCGA@@CBGDEHEH@B@H@F@DFJ@@@DADABABC@C@A@CGCIAEMUICGCEE@E@GDKFIHMBO@IEGGCIGGAI@I@G@CAKAICIEMEIEIEGCEA@ACAAA@@@CFEFCBC@A@@@A@AA@IBI@ABMBC@@@ABA@CJAB@B@BADAF@B@FBH@F@\\IRCBOQCAGACAEDCDAFAFBHBBHDNFLBBD@FALAJGJFDHDLBBBHBFDFBJKHCDBANHT@FBB@B@V"],encodeOffsets:[[121357,31799]]}},{type:"Feature",id:"340222",properties:{name:"繁昌县",cp:[118.198703,31.101782],childNum:1},geometry:{type:"Polygon",coordinates:["@@EHAHCJEHCBGBCBEBEFEFEHCHCHKDMBK@UBEBA@IBKHBHCLOBGFIRBF@B@@@BBDPTFJIFCBADMFKGKCCHCFE@@HEBI@E@CBEHJDHDFDJDDDFHFJFHBH@LBLAJANAJAJBLAH@JDJDJLHJHLBNDHBH@JBLBHDLFHDFFFF@@DHHFJJHEBA@CB@BCDAD@DC@CBEF@DCFAHCJGDA@CDCD@B@B@DABA@C@CAAD@@C@A@ABA@AB@BBD@@@DDB@@DBBB@B@DDH@D@HBD@F@BB@A@AB@BA@A@AC@@BAAC@BCBADADCF@@AAAB@FB@ADBD@HA@AFBF@DAFBADDBFDF@DFDD@DAD@DBDAB@D@DADABG@AA@B@@@BA@GD@D@BDDDBDAB@B@B@@DAFCDAFD@J@TAL@JAFC@@BCDO@GDB@@DADDDDHABABDBCACBCACAIB@@K@@BABCBA@@BAFECCCACGBABABAACDCGC@ABAACEABAD@@AAC@CBEAAC@EBACBEB@@AA@BCA@@GJG@AAAAE@AFAHCRBFBFDFBF@JCJCHEDEHGDAB@@AH@DAD@D@Z@B@N@D@@BB@D@D@B@BBBA@@@@@@B@BB@DDB@@BBBBFB@ABAB@@AFDD@@AB@@@B@D@@A@AB@@AB@@BDBBABBDABABCB@B@B@JFD@DBJBFBB@BC@EAAAAAACE@CDGBEDQAE@C@C@ABICC@ABECOBCBGBGDAHAFEBACG@A@C@ABA@EEC@@AAC@AAAA@CBA@EBEDA@IACA@ECCGEEAEC@ADC@CBI@GI@AACE@@FG@GEKAEDBFABC@E@E@ADAACDEAECEBABCFEDEHCDAFA@CBCBAA@@@AAD@D@@CABAA@ABA@@AAA@BBBEBAFA@BCBCACABBBAB@DABG@G@CBCC@G@CAEBCG@G@@AEFA@GDAAEBCAALC@@AD@AAA@CBC@@FABECGDDFALADE@@HEFA@ABCBE@A@ACC@@B@@BBA@CAAA@AAEC@ACA@AGAEA@@AAAA@@EAA@CCC@AAABACCBADBDC@@@AD@B@@CD@BDHAACC@EIA@@GA@AABAOMOCAGEEM@GEECECCDCBEDGBG@E@G@EAG@EAE@E@I@GBGBGBCD"],encodeOffsets:[[120938,31717]]}},{type:"Feature",id:"340207",properties:{name:"鸠江区",cp:[118.391734,31.369373],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@ABC@@AC@@FCAABCB@@G@@@C@E@I@@@@@KBS@@AFODIBE@AIAE@KCEJC@@@EABCDGMBI@O@BH@B@@AKCQ\\ABFT@BBADLDVF@EBC@EBC@C@E@A@@@A@@@@BC@@@GBC@@@A@@@EBEBC@ABG@@BA@A@@@ABA@CDGAE@C@E@A@GA@ACCCEECCGCECGAC@EAG@@@OBM@ABCBGBGBGBI@K@I@EAG@I@GAQCKCC@IAGAUGOCKAGCIAICAAIAECCAGAKCKGGECAIEIEKGKIMIKEIKQOMISGE@SGMAMCGEACIIGECG@@EEEEGCKEGCKAIAG@GAMCKAIGCD@D@R@FBH@JBLBFBLBHBFDFBFBFDHOFA@@@EBIBABCB@DEBACA@BFDHBFBFDD@DDDBBDD@HDFDFDFDDABBDABDDDADBBDFDDDBDB@FFD@HD@@LBDBJBHBBDDBBB@DBB@B@BB@FAB@DBD@B@BBBBBBBBBBB@@BBBD@BBB@BBD@D@D@B@D@D@D@@BB@@@@B@@B@BBB@B@@B@@B@DBB@B@B@B@D@BAB@D@D@B@B@D@D@D@@@BA@A@AF@@DADB@ABDB@BBBAFBFBD@DBB@CJBFFBB@FD@@B@B@BCD@BCBEBAAKD@BBB@BC@@BB@@BB@BRA@@HBB@J@B@BABG@CAG@@JAB@FB@@BBFDHGD@F@H@FDHMF@PAL@@@BBBBBB@B@BCDCFCD@D@BBFDHDFDFBDBB@BA@@@C@A@AB@@@BABA@@@A@E@C@CBKDCBA@C@CAA@EBA@C@E@C@A@CD@BAD@BBJ@B@BCF@B@D@BBD@B@@@BA@@BBBB@NJDBBDDB@BB@@AB@@@@@@AA@@A@@B@@@BA@@BAB@@@@@@@@B@B@@@B@@@@B@@@BADAB@@@@@@D@@@@@@@@AB@@@@@B@B@@@@B@@@@@@B@@@@@BC@EBAB@@@BAD@BADAB@D@H@BADADGJ@@@@@B@@CN@N@DBBDBDDBABAD@H@B@FBFBFDDBBDHHDBDDLFLFD@B@BBD@@A@@F@NCFADA@ABCBA@ABA@ABAB@HBD@B@F@DAD@B@D@D@@@DBJBHBB@D@HDPF@CBA@CB@@@DB@@@ABA@A@@@ABA@@B@BA@@@AB@@@@AB@@B@@@@B@@@BA@@@@@A@@@@@@@AB@B@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@A@@@@@A@@B@@B@@@@@@@@B@@@@@@A@@@@B@@@B@B@BA@@BA@@@A@@@@BA@@@AA@@@A@A@@AA@@@@AB@@AB@@@B@@@@B@@B@@@B@B@@@@@@@DCFCBAD@HADADCDABADADAD@B@B@D@D@@@B@BB@B@B@BAF@B@FBAB@B@@@@BB@BA@@BA@@BC@@@A@@@@@A@@AAA@@@A@@AB@@@B@@A@A@@BA@A@@BA@@FCBAB@B@@CBADC@ABAACAAAEAEAEAKAM@E@IBGBG@CF@F@F@FBFBDDHFFFDHB@DFDF@BBBHNDLBFDH@BBDDJBFDJFJDJDHDJDLBHDHVcNQRIRCB@@@FCBEBEDM@A@@@@DIFA@@HCHE@ABGAMEICBCBA@@@@A@@@A@A@@BA@@@ABEBC@CBC@CBC@CBC@A@AB@@ABCBA@A@@BABCBCB@BEBE@CBEBEBCBC@A@@@AB@@C@C@C@@@A@ABC@A@A@AFDLFHDH@FAHEDCJCJ@J@@HFHFHHDLBL@@@@@J@FCBA@@@AN@DAB@@@@A@C@G@AAC@A@IAG@CBABCDAFCBADAHCDCBADABAB@BAAC@C@ABAFAD@FABCBEBCBCBABAAAACEGCC@AAC@ABCDCFCBC@AA@@AAA@A@@@@@A@CDCDCBCDADABC@C@CBCBAB@B@DAB@BA@A@A@ABC@ABA@A@AA@CBE@IBKBMBGBC@EBCBABCBC@@@A@AACCAA@AA@@BA@@B@B@B@B@DAB@@C@E@EAA@CBOBC@A@GB@@I@GB@@CBE@@@@@@@CBA@ABCDAB@B@BA@C@@@AAC@CAEAC@CBE@IBCBA@GBAB@@AB@BBB@B@B@@@BABA@A@CBMFA@EBC@C@@@CBC@G@@@AHAD@BA@@@@@A@@AA@IAEAC@@AA@@@A@@@@@A@@@A@@@A@ABAD@B@@@B@B@BHD@@B@@@ABGA@B@@@@@@@BDBBBBB@@BDB@@D@B@DCBA@G@E@@@@HKBC@BB@@DHGAA@FNEBE@@BDDEDE@@@CBC@CD@@@B@@BBD@JHCBEB@AA@"],encodeOffsets:[[121220,32138]]}},{type:"Feature",id:"340223",properties:{name:"南陵县",cp:[118.334359,30.914922],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@@@@@CCA@BKE@C@GAE@AEA@@IAK@@GIECEDEIECCABCIGGAAE@CECACGAAB@FDD@DE@@DE@AAEAEEC@EECCMC@HBFBHAFCDBDHBBFDDBBBDBDDFFHJ@HHAD@HDFFHJFFHBFMBCF@HAJDBCBAFCDE@GAEHEJIDBFD@BDAD@DABC@A@EBAHEACAC@ACBAGAAB@@A@EFC@ACA@@AA@CB@B@B@BCAA@A@ABABC@A@@BA@CB@BB@BDCBMHEBIFEFCHGFEDGDEBCBGBGBI@KBIB@@GBK@CBED@BADABCJEDCDIBA@I@G@ED@DDFFHFHFHLLJLDF@HCBCB@FDDADADBB@DDFD@@ADBFHD@FD@DABFBFDDBLBBABADE@CBEBEFEDCF@FAD@@@D@@@@@@AB@BBD@@@B@@@BBBA@AB@@@@@BF@@AB@BB@ABFABBA@B@B@@B@@@@@@@BAB@@BB@@BB@@BB@@@@@BA@A@@@@@A@@@A@A@@@A@@BA@@@AB@AAB@BB@@@@@B@@@@BB@@B@@@@D@BBB@@@@@@DBDBDDBFDHDFADAHA@@B@FAFA@@HAF@FB@@@BBBFFFFDHHFHFHDHDHDFDJFHDHBHDHBHBH@H@BEBBHBB@@@FBDDBDJCBDIDAD@LC@CJAFJABB@DIBADAF@FDJBFBBBB@F@FBFBBBBCDCFDHFDFDHFN@FFBHPDPNABBBB@@HB@FJD@BDGBACC@@DA@C@@B@@CDCAABDDABBB@BDD@DBB@FB@BB@BB@BFBHB@BDD@BF@BBBDBB@AA@@@AD@BDB@F@DABAB@FE@GF@BCBKCEHCFDBA@ED@DAB@BBC@@BD@BKDBFABBHCB@FE@BH@H@ADBF@D@HDDDAH@H@BA@CBAAABABDADADB@BEFAAA@ABB@BB@BAB@ABDB@@@CBC@B@@BBDADAB@BEDCFGFCDEBAFAFDFBDCBBBCF@F@D@BAAEFCLBHFH@@EF@BD@BHJJ@DAD@BCD@BFFFDHFDB@BD@JCBAF@FAB@DBBBBD@BB@@FD@FAB@B@D@BDHABEFGBCBAHAHADDPAF@BDDAJ@B@D@DBFCRAFCH@DDFBBBBBB@FADBBABBB@D@BBDBDBB@D@B@DCDABAF@@AFAH@HAB@D@DBBFDBDBDBBHFHFFDBCBC@ADAB@BAD@BBFDBBDF@DBBBBFHBBB@@@@@@@BBB@BBDBB@@AB@B@@@B@@BFDBBBB@BBB@@B@RBXADA@@BA@A@AACAAECEEIM@GHCB@P@NCNEPBFCBCBAMMWGCEECCIGOACBADCFCD@F@@@LDBCEE@CFM@EDABA@E@C@A@AAE@IDEBAD@F@FHDB@D@FAFBFHBDADEBGBEFEHCBAD@DBDDADEDADAHAFDFFDBBJBB@DAHANBBA@CLOBIAGMEAA@ECAAA@ODEHCL@@U@AAA@EGSBMCAGDILEAECGAAAKAGCECHIBIBK@EACKAMEGCAAAGBEBEDCFCDBHBDBPRDAJQ@[@EAG@E@ABEBC@A@ABADI@@@ABA@@DCB@BCBADADCFCFCBADEBE@A@C@AACAACEECGAEACA@@AACACCAAAAAA@A@@@A@AFGBC@@@A@E@@@A@CAAAAACA@AACAAA@@C@A@CB@B@BABC@A@A@@@C@A@AA@@A@@A@@@ABC@ABABA@AB@BBB@D@@AHABA@@@CAE@AAAAAA@AA@ACE@@@@BABBD@BBDDDF@BB@B@B@B@BA@@BA@A@@@ABA@@B@BAD@@@BBB@@@@A@@@A@A@@AAAAA@AA@@C@@A@@@A@@@@@AB@@AB@A@@@@AAB@@EA@@AGDA@@@A@@@AAA@@A@A@C@@@GCEE@AAA@A@A@A@ADEDC@E@@B@B@D@@@B@B@@@B@B@B@DAFCBA@@@@@ABABABCB@@A@@BAA@@@BABA@@B@@@@A@A@@@@@CCC@AB@BDB@@@@B@@@BB@@@@@@@BB@@B@@ACA@A@A@@B@@@@@A@A@AA@A@@@@AA@@@B@@A@A@A@@@AAAA@@CC@A@@AB@@AC@AIEGCAACEAAACAEBM@O@EC@OCCBFHABBBC@E@ADC@GH@B@BC@A@@@EB@BC@ADHL@BEBFNCJG@MCEBMGEJA@KJAJGDCBA@AF@BDBBAB@@BLBBCAAFCFFF@AFCBBJD@ADO@FPABBD@DI@GBGD@FC@CBACC@ABA@AAC@BCAAABE@ACEEA@@@@NADE@EHI@IEEAG@CEQF@A@C@GEA@CICCGCKQDIICB@BCBCCGAA@A@C@A@@@@AA@BAA@AAB@AA@@AA@@A@@@@AAA@@@A@AA@@@AA@@@A@@@AA@A@@@AA@@@@A@@@A@@C@@@@@ABA@@@@@A@ADA@AB@@@BABA@@@C@A@A@@A@@@@BA@@@AB@@@BA@@@CD@@A@A@@B@@@@AAA@A@AA@@@A@@@@@ABC@@@CB@B@@@@@@@DA@@B@@A@AB@B@BA@@D@@A@@B@@@B@@A@A@A@@B@@A@A@@@A@@@@A@@B@@A@@@@@A@@@A@@B@@@@ABCB@@@BC@A@AB@@@A@@@A@@@A@@@A@CAA@@@C@A@@@@DADA@A@@A@@@AAA@A@@A@@@@AAA@@AA@A@A@ID@G@EACDEF@@E@CCEC@ADE@BACCCEEC@EACCA@AE@CCAEDE@ADCBAFA@C@ATBDCBAAC@EDGN@D@FBHCDC@AAABAB@CEACAC@CAA@@C@E@EAA@EDA@G@AAE@CDADABA@@@AFEB@DEBGAIBAB@@IECBCAGIGC@ECE@@KFEAAB@@CDKBED@BADGFH@BBABC@AACBMDAAC@@E@CCEEAEEEACBEFECGGECIEEBEC"],encodeOffsets:[[121014,31430]]}},{type:"Feature",id:"340225",properties:{name:"无为县",cp:[117.902366,31.303167],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@J@NACHADFB@@D@FILDF@JB@@BC@A@A@@UEKCBCAAS@AE[BDRBL@@@AAGP@"],["@@AEGEEGBABGA@ABA@C@C@CAA@C@EAAACA@GDMC@AAAC@CACAAAA@@@C@C@A@A@A@@ABMPCDBB@B@B@BA@@BA@@B@@BBAB@BC@A@CA@BA@AB@B@@@@@BABA@AB@@@BA@@@ABABA@CB@@AB@@MFE@EBGAOFEDIBK@E@EAEEEEAAEAE@G@GAABCBABCDCBI@C@ACCECCC@G@EBEBEBADADDRHN@BABA@KDC@@@C@GBQLA@EDEBO`CFCHCHEBCDIFAHDDHFLDNBV@B@BBBFFJDLHJDJDHBHAB@BAH@HAFCFCDKH@@EHAD@DDHFDH@H@DFBF@F@H@HBFDFLFLDJ@JFBBDFALJH@TCFCLOLIFKFCBSVJDVFHDNHJHBHBTDDFHX`BHAHAFCTNBFBJBHBJ@PBJHBB@@@BFFDFBFDHDDFBJBFCJBFFDFDHDHFLDHDJBHDH@BDL@HBJDJFJBH@BDDDJDJFJFJDFDFFHHH@@HHFHBFBBBFBJBF@HBF@FBFDDBF@FBFBJ@@DNBDBFDDFDDDHBHBFATEH@FAF@FBDDDDDBJDJAB@DADEBAH@BDBFDHBFBDBBDDJBF@F@H@HAHAHAHAJ@HBDDFDFBF@J@F@DAHAFAFBFBFBFDDBB@FCLFHHHDJFF@HFFBB@FBFBJ@FAJ@JADABADCDADCDCDA@ABAFCDAF@D@D@F@DBDBLHFDFBDBDBBAFAB@B@@A@AGGMAEQB@DCP@PGJ@FADAB@DACCGCEAIAC@GAC@IABAFKJGFO@IBGHEDAF@DDDFLALCVCdENCJANCLAZEJCFGDCFBD@B@@@@@B@H@RE@@NA\\GCG@E@G@EHCCGAE@AA@@EBA@IH@DBH@BA@A@A@IAA@GB@AQA@@AA@@AD@@AAA@ALCBBFADA@ADC@A@A@AC@@EAAEEIA@DAA@CACAEBEAA@ACABAA@BC@CE@@B@BAB@@C@C@C@A@A@C@C@A@ABC@A@A@A@A@CAA@@@@AA@A@AAA@@@@A@@A@@AC@C@C@A@C@C@C@AAA@AAC@AA@AA@AAAAAAAAAAA@C@CAA@EBA@@A@AAA@CAACAACGAIACAKA@@GCC@EEA@ACCCECACCACBCCBAACBACCCECECE@GCCAACC@CCCAEAECGAEB@BDFA@CDABAJAFA@@B@PECGAEAECEAEAGAKAEAK@IAG@E@Q@CDCKGCICI@IBGAKBIBIBMBIAK@KAGEGEIEGCCICECGCICKGEEEAECGCICGACAIAG@G@G@G@SHGBWLOPCLABCJ@VIZUXSJQDQ@UAqS[SGEEAIGMKOS@AQmIW@AACK[QgEOAI@CA@C@C@G@C@A@A@ADAB@J@BA@E@GGGGA@C@A@C@A@A@CAFOACAAC@CCBEAGACG@ACECFA"]],encodeOffsets:[[[121154,32121]],[[120514,31728]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("宣城",{type:"FeatureCollection",features:[{type:"Feature",id:"341822",properties:{name:"广德县",cp:[119.420935,30.877555],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAEGABAAKBMDA@K@W@O@K@IAEECCAEAEAAAAEEGAGCMAK@CBGFCFAH@J@JCHGFKFADCJCH@BABADCHCJCNDJBHALEHGLCFGPGNCPAHAHAFAHBHBLDH@FAHCHEFEBGDGFEFAJCNGJIDOFQHAD@F@FDFFDHFDHBN@HFJDFDFDFFJBJBHBD@FBLDLFFJFHBFBFBHDJDHDJFHFHLLL@BDH@J@JCHAH@LBHBDDDJBJ@J@HBJDD@AJ@N@L@NJRHDH@BBB@NDV@FAPCTBNDBBPLDFFH@FCHEN@LDHLLJHJFD@@@FBJAFCFCL@HBFDHBJ@J@H@LBH@HEJCJCHBFHBF@D@HBFDFDFBHBL@HAJCH@JDJDL@H@BEDIBIDEH@JAPABCD@@ABIFG@KHOJE@@F@D@FC@@BADHJ@@DHBDDB@@J@HADFBHBDBF@@DDF@JAFCF@FAHBFBJDDDBD@FDHDDDDD@JAB@DAB@ALADA@CB@B@B@BDDDDHPBF@D@@@@@B@@@@@BB@@B@@@@@BB@@@@@BB@@@@@BB@@@@BB@@@@@@BB@@@@@BB@A@@@@B@@@@@B@@@@@@A@@@A@@@@@A@@@@@A@@@A@@@@BA@@@AB@@@@AB@@@@@B@@AB@@@B@@@B@@@B@@@@BB@@@@@@@BB@@@@@@B@@B@@@@B@@@@@BB@@B@@@BB@@B@@@@@BB@@B@@A@@@A@@@@@A@@@@B@@B@@B@@B@@@@B@@B@@@@@B@@@@BB@@@B@@@@@B@@B@@@@@@@@BB@@@B@@@BA@@@@B@B@@@@@B@@A@@B@@@@@BA@@B@@@@@@AB@@@@@B@@@@A@@B@@@@@@A@@@A@@@@A@@@A@A@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@@@A@@@@A@@A@@A@@A@@@@AA@@@@@@AA@@@@@AA@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@A@@@@@A@@@@BA@@@A@@@@@@@A@@B@@A@@@@@A@@@@B@@@@@@@BA@@@@B@@@@@@@B@@@@@B@@@@@B@@@@BB@@@@@@BB@@@@@@B@@B@@B@@@@@B@@@@BB@@@AB@@@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@BA@@@@BA@@@@B@@@@AB@@@@@BA@@@@@@B@@A@@B@@@B@@@@@B@@@B@@@BB@@@@B@@B@@BB@@@@@@B@@B@@@B@@@BA@@@BB@@@@BB@@@@@@BB@@@@@@B@@@@@@@B@@@B@@@@@@@B@@@@@@@B@@@@@B@@A@@B@@@B@@A@@@@@A@@@AA@@@@A@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@B@@@@@@@B@@@@@@@BA@@@@@@B@@A@@@@B@@@@@@A@@B@@@@@@@BA@@@@B@@@@@@A@@B@@@@@@@BA@@@@@@B@@A@@B@@@@AB@@@@@@@BA@@@@BA@@@@BA@@@@B@@AB@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@BA@@@@@AB@@@@A@@B@@A@@@@B@@@BA@@B@@@@@@@B@@@B@@@B@@@B@@@B@@@B@@@@@B@BB@@@@B@@@@@B@@@B@@@BB@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@BA@@@@@@B@@A@@@@@@BA@@@@@@B@@A@@@@@@BA@@@@@@B@@A@@@@B@@@@@B@@@@@@BB@@@@@@@@B@@@@@@@B@@@@@@@@BB@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@BB@@@@@@BB@@@@@@BB@@@@B@@@@@B@@@B@@@B@@@@@B@@@@AB@@@B@@@@@B@@AB@@@@@BA@@B@@@@@BA@@B@@@BA@@B@@@@@BA@@B@@@BA@@@@B@@@BA@@B@@@@@B@@@B@@@B@@@@@BA@@@@@@B@@@BB@@@@B@@@B@@B@@B@@@@@B@@@B@@B@@B@@@@@B@@BB@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@AB@@@@@B@@@@@BA@@DAJIHGFADAHCFCFAHILKDA@IDUFGJALBJ@PAFFNXHLDB@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@AEAIFMVKTEHC@@@CCEA@GAGCGECECEAE@EBEBI@ABEDGBE@CAICOAE@UBK@EBEBIEICECC@AGEMEGGGGAEEAAAEAEAAEKGECEECECE@AAC@A@CAA@A@C@CDG@GBC@AEMAABC@EDE@CAAGCIGEACCA@CACE@C@@@C@ICGEGAA@CFI@E@A@A@ACM@C@CBABEDEJKDEDEHE@@@AAK@EDKDEBCACACA@CAI@EBC@C@A@AAAA@@GCCEEECEAECEEOCEGIAEGMCE@CAAAAACAAGCCAC@C@C@EAAC@@C@E@G@EDCBADEDC@GAC@CAAAAA@A@ABG@G@AACAE@E@A@ABAHG@ABCAACGCC@@@AAA@A@CFE@[BWIIKOCECC@ACCGECACCAACEEECKAIAIGIMGGC@AI@A@GAE@GAE@CBCBCBGHAFCDCJAPCJ@FAHADCFABABAB@@C@AACAGGCAE@CBA@A@A@CAEEACC@CACCEEAAAAC@EBA@GCCACEAAGGEIAC@CCCCGAEAAAA@AAE@ACCCCECGECC@@AAE@E@E@EAEAECCAE@C@@@C@KD@@E@IAGAG@CAE@E@CDADCDA@A@EC"],encodeOffsets:[[122108,31354]]}},{type:"Feature",id:"341802",properties:{name:"宣州区",cp:[118.785561,30.944076],childNum:1},geometry:{type:"Polygon",coordinates:["@@@G@M@GGECEG@G@IBIBM@GCGCEACCIGCCACIAIBGFGHCHAJAJCJGHKDEBMGBHBD@D@BBH@FDJBHBBBFBL@BBBBBJDBB@@@BAJAbARADCJA@@@E@EAGAC@A@ADCFEF@@@BBBDPBFBH@JCD@@CBIBI@EBCDCJABCJCJ@J@JAHAJEJCH@@IFAFCBGDIBKDGDABGHGHGDI@GACAMGA@GCKEE@ABGJCJ@@@BCHWTOHEBCBE@CAC@@BADCBC@@@@@A@@@@@@B@@@@A@@B@@@@@B@@@@@@B@@@@B@@@@BD@@@@@BBB@BB@BA@@@@BBB@@@BB@@BB@@@@@@@A@ABA@A@@@@@@@B@BB@@@@A@A@C@@BBB@B@@DBB@BBBBDDB@@@@@@@AAAA@@AAEAC@@@@B@B@B@BFBB@@@@@@BAB@@B@@@@@BAB@@@BB@@@@@@ABA@@@@@@@B@@B@@A@@@@@@B@@@AA@@B@AA@@@BA@@@AAAC@ABAB@@@DB@@@BBB@B@DAB@@AB@@AB@@ABA@@BA@@B@B@@B@@B@@@@@@CB@BC@@B@@B@B@D@D@BBADAB@BAB@@BB@D@D@BCBABA@A@A@ABBBA@@BCDE@ABB@BBB@@B@B@@@D@@AAC@@AB@@@@A@@A@ABCB@@@AAAA@@CAA@A@@BBB@@A@@@@@AB@AAB@D@B@@AAA@AB@@AA@@@@@@AB@B@@@AA@@BAB@B@@@B@@A@@@A@@A@@BA@@@A@@@AA@@@C@@@AA@@@@B@B@@@FA@@@@@@A@@A@AA@A@@@@@@@B@BBB@@@@@BA@@@@@CA@@@AB@@@@@C@@@@C@@@@@DAB@@@@AA@BA@A@A@@@@@B@DBB@@@@BA@@B@B@@@@@B@@B@A@@B@@@B@@A@@@AA@@@A@@AA@@AAAACA@@@A@@@@@@@@ABEDCBA@A@A@@@A@A@@@C@A@A@@@@FCDCF@B@B@B@BBB@BFFHD@@D@B@B@@@BB@B@@@B@@CBBH@@FB@@BA@B@@B@A@@BA@@B@@@@@B@@@BD@@@BBB@BBBB@@@B@B@@@B@@A@AA@@C@ABA@@@AB@B@@@BAB@@ABA@A@A@A@@ACECCAAC@AAAB@@@@DF@BBBB@BBBB@BBF@D@@ABGB@BC@A@AAA@@BABAB@BAD@B@@@BB@@@BBB@D@@@B@B@D@BA@A@ADAB@D@@@BBDBBBB@BDBBBB@D@B@@@F@B@@ADEH@B@B@@@BBBBBBBDDDBBB@@DBFBHBFDDFBBBD@B@D@BAFCFABEDEDCDCBABADA@CD@@AB@B@@B@BA@@D@NABAJ@JAB@@B@B@@@BADEDEF@D@@BBDB@BFBHDJFJFNFJFJDLBDBH@J@J@HBJHHDFH@JAPGNEJCL@H@FFFHDJDNVBFDJDHB@D@D@BABABC@C@@EI@C@E@G@AFGFGHCDA@@HBHDNBHDFFJHDDDBNHHHFHFJHJFHFDFFFDBB@@HNDDB@ADCDGF@@AD@D@BDFHJHD@@DF@@@DEDAH@D@FBFDJFFJLFFJJJDNHHDHBFDHBB@D@B@B@BB@BA@@@@BAB@B@@AB@@ABA@AB@@@B@BAB@B@BABABADABCHABAD@BABA@A@A@ABA@A@ABA@A@AB@@ABABA@ABED@@ABCB@DAB@@ABA@AB@DAB@@@BABABABAD@@AB@DAB@B@B@BAB@@A@@BEJ@B@@@B@BA@ABABEFABCBA@CDCBABABABAB@BAB@BAH@B@@@BABA@@BA@CB@@A@ABCBA@CDEBCB@B@BB@@@BB@@@B@@@B@BBBB@@BAB@B@BBD@BBDBDFDBBDDBB@BBB@D@HAD@BBD@B@HBF@B@BAB@B@BAD@D@B@BAB@BAB@BA@DBFAD@H@FBDBFADAD@F@FBD@BBFD@@BBB@D@DAF@BAD@B@BB@@HLBBB@@DDFBBB@@BD@FBD@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@@A@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@AA@@@@@A@@@@@A@BA@@@@@A@@BA@@@A@@@@BA@@@A@@@@BA@@@A@@@@B@@A@@@AB@@@@A@@@@@AB@@@@@@A@@@@BA@@@@@@@A@@BA@@@@@A@@@A@@A@@A@@@@@@AA@@@@@@ADAB@DAB@BABAFADCDAFCBALKFADABABBB@D@F@HAL@HAJ@B@FADAFCDEFE@ADADADABCFEBA@ABC@EAG@EAA@ABCBEBAHGDC@@DAD@DA@ABAB@@A@@B@B@B@B@D@@@B@@@@@@A@@B@@@B@BBBBB@@@@@@BB@@B@@BB@@@B@@@@@@B@@@B@B@B@BBB@B@@@B@@@BB@@B@@@B@@@@@@AB@@A@@BABABA@AB@D@BA@@BB@@B@B@BABB@@B@BB@@@@@@BB@@B@@@@B@@@@@@@B@@@@BBB@BBB@@BB@@A@@B@@@B@@@BBB@@@BBD@BB@@B@@BB@@@B@B@B@F@DBF@LBB@BBBA@@BA@@BAB@B@B@B@B@@@B@@@BA@@B@@@B@@@B@@@B@@@@@B@@@B@@@B@@@@@B@@@BA@@@@B@@@B@@@B@@@@@B@B@@@B@@@B@@@B@@@B@@@B@@BB@@@@@B@@@B@@@B@@@B@@@@@B@@@BB@@@@@@B@@BB@@@B@@@BB@@@@B@@@@@BB@@B@@@BB@@@@B@@@@@B@@@BB@@B@@@B@@@B@@@@@B@@@BA@@@@B@@@@A@@@@@A@@B@@A@@B@@@@@BA@@@@B@@@@@@@B@@@B@@@@@@@B@@@@@B@@@@@B@@@B@@@@@B@@@B@@@@@BB@@B@@@BA@@@@B@@@B@@@@@@@B@@@@AB@@@B@@@@AB@@@@@B@@@@AB@@@B@@@@A@@B@@@B@@@@@B@@@@@B@@@@@B@@@@@BB@@@@B@@@@@BB@@@@@@B@@@@@B@@@B@@@B@@@@@B@B@@@@@B@@@B@@@B@@@B@@@B@@@B@@@@@B@B@@@@@B@@@B@@@B@@@@@B@@@BA@@B@@@B@@@@@B@@@@@B@@@@@@@B@@@B@@@@@@@B@@@BB@@B@@@@@BB@@B@@@@@BA@@@@B@@@@@BA@@@A@@@@@A@@@@BA@@@A@@@@@A@@BA@@@@@@B@@@B@@@B@@@B@@A@@B@@@@@BB@@B@@@@@B@@@@@B@@@@@B@@@@B@@B@@@@@@@B@@@@@B@@B@@@@B@@@@@B@@@@@@@B@@B@@@@B@@@B@@BB@@@@@BB@@@@B@@B@@B@@@@BB@@@@@B@@B@@B@@@@B@@B@@@B@@B@@@@@@B@@@@B@@@@@@@@@@B@@@B@@@B@@@B@@@B@@@@@B@@A@@@@BA@@@@B@@A@@@@B@@A@@B@@@@@BB@@@@B@@@@@B@@@B@@@B@@@B@@AB@@@B@@@B@@A@@B@@@B@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@AA@@@AE@CAAACBIAEEE@CJABAD[@@FE@@BEFABC@@DGBEBA@@BM@@AB@@C@E@I@EDC@ICMAAAAA@@@@@CDGB@AAB@@@@A@CAAAC@AAA@GAIAC@A@C@AAC@AAAGAaO@@DUN@ABCB@BA@A@@DE@AD_BCFADGDEDI@GBK@ODIFGDEBEDCDCFGDEDGBEAE@CAEACEECECE@IBGFIDI@MBIBIDGBEBKAICKCCACCEGIIIIIMIGIECGECEAG@CDEFCDCDGBGDODIJEHGFCJCHCHCHE@@HEJAP@FABAFADAF@@GAMCGGEECCE@E@EBCECCGAECCAAAAA@C@C@A@A@AAECECCCCCCCAC@AEAEAECGGCI@AAACBCBIDK@AACACCAEAAACECABCDCBCBIDGDCBGDIFCBCBKDOBIA@@G@ICSGCCECA@CAKAKCG@A@EAC@GACBMAGCGEEEEGGCCCECKEMAKCIAGGCEAG@CBG@EBI@GBEBGBI@I@GAECGEECCCAACGECEBE@CBKBE@ECCCGCGCCKGKGI@QCKAEEICGCGCGAICGC"],encodeOffsets:[[121601,31325]]}},{type:"Feature",id:"341823",properties:{name:"泾县",cp:[118.419859,30.688634],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAIGBGDI@G@C@C@@@A@AAA@@A@A@CBC@@@@@AAA@ACA@C@A@CDCBA@ABA@@B@D@@@BAB@BABABICGAA@CA@@A@A@AD@@AB@@A@AAA@C@A@@@ABA@@BBD@@@BABC@AB@@FD@@DD@@@B@@@@@BA@A@GAC@@B@BBBBB@BCBEFABA@A@C@C@ABCDEDEDEDABEDADABBD@DA@@BBDBB@B@BA@A@AB@BCB@BBB@BABADCB@B@F@@@@B@DBH@B@B@B@D@BBB@@BDB@@@D@@BB@BAB@BCBA@@B@BADA@@BAB@@ABC@@BA@KFAB@BAD@B@D@B@@ADA@@@CB@@AB@AAA@@AAEBC@A@AAC@A@CCCC@@GD@@CCA@@@@@@BBB@B@FBH@@A@A@A@@@@@AE@ECECCCAAAIAA@AAAC@AACB@@@@@AA@ADBB@B@B@@@@@@@BA@@@@@@B@@@@@B@@A@@BB@@BA@@B@@@B@BA@@@BB@@@@@@A@@@C@@AAA@C@A@I@ABC@A@ICAA@@@A@AAEACAAACACA@EAGBG@C@A@CCAAAAAAA@A@G@ABAAEECEC@CBC@IDCBA@CBABA@@@@B@B@BB@AF@HBJBJ@HCFEFAHAFFJHLJHHFBJDJRLCHADSJIBCBCBGBGDKBKBOAKAICC@GCAAICECEEICMCMAA@IBWBMAC@G@IAO@K@IFCHIHADEBFHDDDHHHDDDHBDBHBH@H@BAJCJ@JHJHFHDJBH@H@FBFBFBJBFDFDBBDBBDFFFHFFJHJDJBJAJBFD@@BDBDDHDJDJBHHFHDBBNHBBJJNDDDFFD@FFFBBBF@@CF@@CCC@EBAHBBDFD@DBFHBJHADDBFDFJFCFDHJ@@BL@JB@BFF@HBD@F@ALB@DD@@@@B@B@FDFAJFFDHHFDFEDAFBFFFBDF@D@FD@BBNCDABBD@BAAAG@HEBC@AFCLADC@@BAFBLE@@DF@FHDHJDBDAJF@@BAJAHBFA@CFABE@@B@BABCDCF@BBH@B@FCB@FBF@D@@@BB@DBDBDDFA@ABBB@BCDGDEAC@M@CH@FBDABCDSA@B@DEBABCD@BCFBFDDF@@BDBBD@FFDDFDDABF@BCD@DF@D@FE@CFBD@F@HJCB@B@B@@BB@BB@@@@@BB@B@BB@@B@@@@BCBCB@@@@@B@D@@BB@D@B@@@B@@@B@@@B@@BAB@D@@A@@DABA@@@@@AB@@@B@@@@@B@@@@AB@@@@@@B@@@B@BA@@@@B@B@BA@@@A@@@@BC@@@ABA@A@@B@BA@@@CB@@@@@@A@A@@D@@AD@B@@@@@B@@BBB@B@BB@@@@@AB@B@@@DC@@B@@A@@BA@@B@@A@@B@@@@B@B@D@@ABAB@@A@@BCB@B@B@@@@AB@B@@@@@DB@@@B@@@@@BB@@B@B@@B@@@B@@BB@@B@@B@B@@BB@B@@B@@@BB@@BBA@BBB@ABB@@B@@B@D@B@B@HBDDDA@ADAJJRCDLDHJD@DFB@H@D@BREDFH@FBJFJ@FGF@BC@M@@B@FFBDF@BABBADD@BBB@BAD@BDDAD@@EHCHAJ@@CACBAEOP@BCC@AIDABEE@EEEDBBADKA@AA@ABCA@ABEB@DAHCBILIB@FINHFANDH@DIEMFA@AGKBCD@@AFA@@B@D@@A@AHGD@BCF@D@AABAEGDAPDD@@F@PANBFBDBBDFBBHDJF@BBD@@BA@@@BDD@@BBBB@@B@B@B@@@@A@@BB@@@@@BBBB@B@@@@@A@@@@B@BDB@BA@@@AA@@@@@@A@@A@@@A@@A@ACA@@BDD@D@@@@@B@B@@A@@@ABAB@@B@AB@@@BA@ADABAB@B@@@@@B@@DBBBBB@@BB@@@B@@BB@@B@@@@A@@@AB@A@@@@A@@@@@A@AB@@A@@A@CAA@@@@@B@B@B@@ABB@@@@BA@C@@@@@D@@D@@@@@A@@B@@DB@@@@B@@A@@@@AA@A@A@@@@@@@BBBB@@@@B@@@@B@@E@@@A@A@@B@@B@@@D@@BB@@B@@@B@@AB@@@@B@@@BA@@@A@A@AB@@BB@@A@A@@B@@@@B@@BA@@BBB@BA@C@A@BBA@@B@@@@@BA@AA@@@BBB@DB@BB@B@@DABAB@@@@B@@A@@BD@BB@@@C@@@A@AA@AAA@BAF@DC@AB@AABAB@B@B@BADA@A@C@CAA@@BA@ABABCAAC@C@A@A@@@@AD@@ADA@@@@@@@AA@@@@A@AB@@AB@BA@@BA@@BA@@BA@C@AAA@A@@CA@@A@AB@BBDBB@@B@@A@@BBA@@@BB@@A@@@@@@@@BA@@@@A@@@@@@AB@B@@@@A@@A@@BA@A@@@@@AA@AB@@@@@@AAAEA@A@A@@@@@BDBF@BB@BB@B@@@@@@CAACAA@AAA@CA@A@AA@@@D@B@B@@A@@A@A@@@@@@@BAB@B@B@@@@@@AA@@AA@@A@AA@@@@ABA@@AAA@A@@@@AC@@@@@A@@A@@@@@@@@A@@@@@AB@@@@@@A@@@@B@@@@@D@DABC@AD@DBF@DAFAPGXSDG@A@@DIHIBAF@LFHDB@NHDBHBJ@HCHGHGBAHCLCJAHCDABEJE@@DGFIBIBG@I@IDIDIBADIDCFAJ@JADA@@DC@IAGAECOAA@A@@FEDEBCB@D@HBFBF@@@B@DIBCBQBaBI@A@@AAICAAAA@AAKAEAAAGCI@EAG@A@CACAGKGCG@@CGAG@ECEGGGEECIEGAGECE@G@EBE@ADCFGFGDGDEBE@GAG@ACIAGAGACAGYEEAICCGCGAC@CAE@GAEACA@ACEKCCGIEGCGKGCEA@AAGCKEQAU@AACAECGAA@C@CBC@CAECA@A@CAGCAAGICIAC@CAAAAA@C@@AA@A@C@@@A@A@C@@@@@EBA@A@@AE@CBC@A@@@@@@A@@@@C@@@@AA@@AAAA@A@A@@@A@@AA@@@A@AAE@C@@@AB@BA@CHADA@ADED@BCB@@A@C@@@ABC@@@EBCD@@@DABABABA@A@C@@@GBKB@@CB@B@@BJ@B@B@BAD@@ABAD@@@@A@@@AACCAAA@AAEBA@@@AB@@@B@@@BCDA@@BA@@@E@AAA@A@AA@AA@@AA@C@AA@@CAACAC@@@@EAGAG@GAG@I@CECCCCEGGEEGEEECEEGCEBEDEDGBM@M@MC"],encodeOffsets:[[121145,31125]]}},{type:"Feature",id:"341821",properties:{name:"郎溪县",cp:[119.179656,31.126412],childNum:1},geometry:{type:"Polygon",coordinates:["@@AGAIEICECECEEIE@CBEBABEBO@IBGF@@GFGDGDIDEDGHIFCJCPAHCHCDEDCF@DBHDFHFFDHJNJJJJJHJDFBDDDDLBJALAFCHAJAJ@NCJEJAH@JDFDFFFBDBF@DBFAFCHCFEHCDCDAFCFEHCJ@PAL@HCJCFCHEBADC`@BCF@@@BABA@AD@BMCV@@bPHBBB@BBD@B@D@BBDBJ@HBB@BBDBB@D@B@@A@BBA@CH@D@@@@BBBBNBJDD@FCJ@F@D@@@BA@@AN@@ABAFCH@@ADEBAF@@EF@@C\\ABIB@DFFBFAJBDBB@DBF@@B@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@B@@@B@@@@@@@BB@@@@B@@@@@@@@BB@@@@@@AB@@@@@@@@AB@@@@@@@@AB@@@@@B@@@BA@@@@B@@@B@@@BA@@@@B@@@B@@@@@B@@@@@B@@@B@@@@@B@@@B@@@@@B@@@@BB@@@@@BB@@@@@@BB@@@@B@@@@@@BB@@@B@@@BB@@@@B@@@B@@AB@@@B@@@@@B@@@B@@@B@@@@A@@B@@@@@@@@AB@@@@@@@BA@@@@@@BA@@@@@@BA@@@@@@B@@@B@@AB@@@@BB@@@@@B@@BB@@@@@BB@@@@@BB@@@@B@@@@BB@@@@@BB@@@@@@B@@B@@@B@@B@AB@@@@AB@@@B@@@@AB@@@@@@@B@@AB@@@@@B@@@@@B@@@B@@@B@@@@AB@@@B@@@B@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@B@@@@@B@@A@B@@@@@B@@@@@@@B@@@@@@@@@BB@@B@@@B@@@@@B@@@B@@@@@B@@@B@@@@@B@@BB@@@@@B@@@B@@@@@B@@@B@@@@BB@@@@@@@BB@@@@BB@@@@B@@B@@@@BB@@B@@@@B@@B@@B@@B@@@@@@B@@@@@BB@@@@@@@@B@@@@BB@@@@@@@@@B@@B@@B@@@@@@@@@B@@@B@@@B@@@B@@@@@B@@@B@@@B@@BB@@@B@B@@@B@@@B@@@@@BB@@@@B@@@@@@BB@@@@BB@@@@@BB@@@@B@@@@B@@@@@B@@BB@@@@@B@@@B@@B@@B@@@B@@@@BB@@@B@@@@@B@@B@@@@@B@@@B@@@@@B@@BB@@@B@@@@@B@@@@@B@@@B@@@B@@@@@@B@@BB@@@@@B@@@B@@@@@B@@@BB@@@@B@@@B@@@@@BB@@@@B@@@@BB@@@@@B@@B@@B@@@@BB@@@@@B@@B@@B@@@BB@@@@B@@@@@@D@BAD@BAD@B@B@@@D@@@D@B@BBB@@@B@@@BB@@@@@BBB@@@B@@BB@@@@BB@@@@B@@BB@@@B@@@B@@@B@@AB@@@@@@@B@@@@@BA@@@@B@@A@@B@@@@@BA@@@@B@@@@@B@@AB@B@@@B@@@B@@@B@@@B@@@@@BA@@@@@@BA@@@@AA@@@@@@@A@@@@@A@@@A@@@@@A@@@A@@@A@@@@@A@@@@@A@@@A@@@AA@@@@A@@@A@@@A@@@A@@@A@@@@@@@A@@@@@@AA@@@@@@@A@@@@@A@@@@@@BA@@@A@@@AB@@@@A@@B@@A@@@@BA@@B@@A@@B@@A@@B@@@@@@@B@@@@@@BB@@B@@@@@@BB@@@@B@@B@@B@@B@@B@@@@@BB@@@@B@@@@@@BB@@@@@B@@@@@BB@@@@@@B@@@@A@@@@@@@A@@@@@@@A@@@@@@AA@@@@@@@@@A@@@@@A@@@@@@B@@@BA@@@@@@BA@@@AB@@@@AB@@@@@B@@@B@@@@@BA@@@@B@@@B@@@@@@@B@@@BA@@B@@@B@@@B@@@B@@A@@B@@@@@B@@@@@B@@AB@@@B@@@BA@@@@B@@@@A@@@@@@@A@@@@B@@A@@@@@@@A@@B@@@@A@@B@@@B@@@@@B@@@B@@@@@B@@@@@B@@@B@@@@@BB@@@@B@@@@@@BB@@@B@@@@@@BB@@@@@@B@@@@@B@@@@@@@B@@@@@BA@@@@BB@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@B@@@@@@@@@B@@@B@@@BA@@B@@@B@@@@@@A@@@@@A@@BA@@@@@A@@@@@@@@@A@@B@@A@@@@@@@A@@@@@@@A@@B@@@@A@@@@@@BA@@B@@A@@B@@@B@@BB@@@B@@@@@BB@@B@@@@@BB@@B@@@@@BB@@@@BB@@@@B@@B@@B@@@BB@@@@BB@@@@B@@@B@@B@@B@@@B@@@@@@@B@@@B@@@B@@@@@BA@@B@@@@@BB@@@@B@@@BB@@B@@@@BB@@@@B@@BB@@@@B@@@@@B@@@B@@@@@B@@@B@@@@@B@@@B@@@@@B@@@B@@@@@BB@@@@B@@@B@@@@@B@@@@@BB@A@@B@@@B@@@@@B@@@@@@B@@B@@@@@B@@@B@@@BBB@B@@@B@@@B@@@@@@@BA@@B@@@@@B@@@B@@@@@B@@AB@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@@@B@@@@AB@@@@AB@@@@A@@B@@A@@@@BA@@@@B@@@@@BB@@B@@@@BB@@@@BB@@@@B@@B@@B@@@B@@B@@B@@@@BB@@@B@@BB@@@@@@@B@@@@@@@B@@@@A@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@BBBBBDBB@@@@@@BB@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@B@@@@@BB@@@@@@@BB@@B@@@@@B@@@@@BB@@@@B@@@@@B@@@@@@A@@B@@@@A@@@@@A@@@@B@@@@A@@@@@A@@@@@A@@@@@@B@@A@@@@B@@A@@@@B@@A@@B@@@@@@A@@B@@AB@@@@@@AB@@@BA@@@@BA@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@BA@@@@@A@@B@@A@@@@@@@AB@@@@A@@@@@A@@@@@A@@@@@@BA@@@A@@@A@@@A@@@@@A@@@A@@@@BA@@@@B@@@@@B@@@B@@@B@@AB@@@@@B@@@B@@A@@@@BA@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@A@@@@@@AA@@@@@@@A@@A@@A@@@@@@@A@@A@@A@@@@@@A@@@A@A@@@A@@@A@@@@@A@@@@@A@@@A@@@@AA@@@@@A@@@@@@@A@@@@A@@A@@@@@@@@@AA@@A@@@A@@A@@A@@@@A@@A@@A@@@@@AA@@A@@@@@AA@@A@@@AA@@@@@@AA@@@@@@@A@@@@@A@@@@@A@@@@@@BA@@@@@@@@BA@@@@@@@A@@@A@@@A@@@@@@@AA@@@@A@@@AA@@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@AA@@@@@A@@@@@@AA@@@A@@@@@@@AA@@@@A@@@@@@BA@@@A@@@@@A@@@@@A@@@AB@@@@@@A@@@A@@@@@A@@@@@AB@@@@A@@@@@A@@@@B@@@@A@@@@@@@A@@@@B@@A@@@@@@@@@A@@B@@@@@@A@@@@@A@@@@AA@@@@A@@A@@@@@AA@@A@@@@@A@@@@@CAEGOCCCC@A@A@ADAB@BCBKA@CBA@IBC@CCCCCG@EACCCICEAGAEBE@EDIBE@CC@@AEACAGCEGBI@@@CAACCG@@GIBC@AD@@E@C@EF@PILGH@JEBA@@DCBABO@IFGJCJAFC@A@GCKCI@IDGBI@GAKAGCECEAE@G@CAEEGGAIDIDGFG@KAG@I@I@GAECGAK@EDEDIBEA@@C@IEIGKKCG@KFMDG@EEGCEOKAAMCSAODEBU@MCA@AAG@GCIQ@M@K@MBIC@ICGAI@I@IACCACAG@KBGDG@I@ICG@AKKGKGEIEGCICGCEAEAGAIEEECKAK@EAC"],encodeOffsets:[[121943,31581]]}},{type:"Feature",id:"341824",properties:{name:"绩溪县",cp:[118.578519,30.067533],childNum:1},geometry:{type:"Polygon",coordinates:["@@I@IAEAKCMBKDGFKFG@AEEGECG@IBIDKHIJIFBD@DBB@F@DKJVBNGRAN@JBFDDPDJHJFHJJ@@JJFJ@BFFDHBDHFHDFFDD@@BH@D@F@F@L@JBB@BBDFFJFBB@@BBBBH@LBLCXIJAR@N@NBJ@TDHBL@J@NDLDJDFBHFFDHHFHDFBFDFFFJDJ@LBJHHFDD@FAJDJDFDDFFFFDDBFDDFHDDBDFFBDDD@F@FBHBHDHBFDFDDB@BB@@@BPFLDJFJBB@D@@@J@FCJAJCJERG@CCKAE@@@@CB@@C@@@A@@@CBCBEBA@@@AAAACGA@@ACA@@AAAC@A@AA@@@C@E@CAA@@A@ACA@@AABA@CBA@A@ADA@@@ABC@AAA@A@ABA@E@A@A@@AA@@AACACA@ADA@@B@@ABABA@@D@@ABA@AAAAA@A@C@EDCDEDEBA@@@E@@@ABABBF@BBDBD@@BH@B@BB@@BDBB@D@B@B@B@@B@BB@@@BAB@@AB@BC@@@ABA@@B@B@BBD@D@BBD@B@DBB@BB@BBB@@@D@H@F@FBHDFBFDJBJ@F@@BLDNDLBPBNCTAPARCNANCJ@HAH@HAH@JBJBHDDDBDFDBDFBFDJHJBBBbFNFDO@E@E@CACCCAC@E@EBC@ABEHODE@C@CBA@A@CDCBC@A@@BAAAA@C@GAAA@A@CBADADAD@DABAB@BA@A@CFIBA@EDGBGDEBCDCNAH@H@HADABA@AB@@CDE@CBAAA@AA@C@CAAAEAAAGEAECC@AAAAAC@G@ACACC@EAKCA@GCA@CAMEGAECKCCAC@E@CAEACAAACCACACAEBABADABADCFABAF@DBB@DBDDH@BADADCFAH@F@H@F@DBDBB@BA@E@AAIAEACAACCEE@A@@@I@C@CAAAAA@A@E@A@C@ICCCAECE@C@C@CGJA@GDGBKDIAIAIAICKCGAGGKIICGIIKOEI@OCK@E@MBMFENIHGBEKEKCKIIKCIAIGKCICE@ECAEACAG@C@I@ICGECACEAA@IAICKAK@I@OBMCMEEAAAC@ECGEGCEEAC@ACE@A@CHCCGAEAC@C@GACACAAGGCCECGACAIBQAICGECEEKEICCGEC@IAEBE@GJA@IHMNGJAAADABADEL@HBJBHDF@DABCHCBCBG@E@CA@AAEAEA@A@AD@BAHA@EHAH@N@JBFBBPDJNDJAFGLAHELEFENADAFGFWFIDIHGDGFEFIJCDAFCFEDC@K@EAEA"],encodeOffsets:[[121279,30824]]}},{type:"Feature",id:"341825",properties:{name:"旌德县",cp:[118.549861,30.298142],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@A@AAA@@AAA@C@AAC@A@CAC@A@A@AB@BA@@D@@ABA@@BA@A@@AAA@@@@A@A@A@CAAAC@@AAA@G@@AC@CAAAE@AAAB@B@@@F@@ABCFCFCD@F@D@BBBBB@BAB@BC@@@ABAB@BA@@@CB@BDBDBBB@@BB@@@B@B@FAB@B@BBB@BAD@B@@CB@B@BAB@DABBB@@DB@B@BB@DBF@D@@@B@@B@BBDBB@@DB@BB@DHBBBB@@B@FADADA@@B@@@D@@@DA@@@@@E@E@GBCASCECG@ABEB@"],["@@IIEGGICICOECIAM@QBMHUAGPCR@JAJCJCJAFABADA@@BEDIFCBEHEFCFCBADABABEFKDIBI@IHAJ@JBJAB@H@F@FAHCHEBGDIDE@EFAFAJAF@B@FBJ@DDHDHJFHFHFHFJDLBN@JBHDFFFDBB@@I@C@A@AAAB@BABC@ABCBCBABCAC@CB@@@@@DA@@B@@BBB@BBBBBD@BBBBBD@B@BAD@DB@DBDAB@B@@@J@@ABC@A@ABADAFAB@B@B@@@D@D@DCHA@BB@@@DB@@@DHBFBB@@@@@@@@@BCDA@ABABA@A@AA@@ABAB@B@@BDDF@@AD@@BBB@@B@BAB@BDB@@BB@B@B@@@D@D@HCJAHJHDBNDN@N@HAFCFCFAHDFFFDFFFHHFFHDDDDDFJ@H@HBH@HBFB@@@@BDBDDB@@BBD@B@@BB@@BBBB@B@BBF@@@B@@AB@DC@A@@@A@@BA@@B@FABBB@BBDDBB@@B@@@@@BCBA@@BC@A@A@AAI@@@ADA@@LAHA@@D@B@B@BABABA@C@@DCFA@@D@BA@@D@B@@@DA@AFCBCB@BCDGB@@ABA@@D@F@BBB@@@B@@BB@@@B@B@B@BB@BB@@B@@D@@@@@@B@@@@B@D@DAF@@BB@B@FA@@@@D@B@B@@@D@B@B@@BD@B@BBBB@DBDDJHJBBHDDBB@B@FDDBD@DAD@B@HBFDDBBBV@RBLFHDBBB@DFLHDHFHHJDDFLBDB@BDBF@HBF@DBDDHDHJDFBZFV@PAHCFEFEFGFEDGBABCDG@GBEFIDGDGDEFEDK@IAICEEGAEAEBGBABCHCLEJELCF@F@@@D@B@B@B@D@D@F@@@B@B@B@B@B@B@DAF@JCFEFCBG@CCCEGAAACCGAEECKAIAAAA@AAAA@O@G@CAECEGICCGEQHIFIDIBEDI@@@C@A@IAIEKCOE@A@@AAA@CCCEAECGAGAG@E@ECCACEEACCCEGCCAECCEEEECCCECIBI@ECCGEIGKAI@ICEECEAECEEGGGECGEEAICKCMCI@K@GASCI@MAM@Q@IBWJKDKAG@AAAA@@AAIEEEAC@AAA@I@K@E@E@CAG@@CCEEGCGEACCGEE@AEIII@@"]],encodeOffsets:[[[121540,31010]],[[121250,30876]]]}},{type:"Feature",id:"341881",properties:{name:"宁国市",cp:[118.983171,30.633882],childNum:1},geometry:{type:"Polygon",coordinates:["@@BEBOBADCD@F@L@BADADAB@DAB@@C@GACVOF@H@JBHBDDRJRLBFDBHDFBB@D@FBD@D@B@@ABCACBCACBCDIFGFI@ACM@EAACGAE@C@AFEBE@E@S@G@ABE@@BABICK@C@AB@LCBAFADA@@BABAAI@CDEBCBC@@AE@CCCCE@E@CBA@AB@B@HBHBB@B@DAHEBCBI@ECK@E@CBADAD@H@FBH@LALAFCFEFG@AAE@ACCA@EACACBEACAAAAACCCEEAECGAEAQEGAG@E@GJAF@HABABCBGFCBCBCDCB@@C@EAYGGAEACAC@@AAAAEAAGGGGCCCAGECA@ACAA@A@A@CBA@@@A@@@GEAAA@CAC@C@C@CBEDEBA@A@@AIKAECG@ABADAB@BABA@AAGCIACCKCGCGAEAACCAACAA@AAA@A@@BAD@D@FADABABCBA@A@CACAA@C@GDA@@@CC@EAE@AAAA@A@E@G@C@A@C@A@ABEDCBC@C@EAC@CBCB@BA@ADA@A@A@A@A@AAA@A@CBADADC@@BC@@@C@A@G@G@M@@@EBC@C@A@CDCBCDKBE@CDGDEFCBC@CBABCDCBA@C@CAGACCMGCCCCEGECAAAAA@A@E@E@CBAB@BAB@BADAB@BA@@@G@KAC@C@CBE@C@CBEDCB@DABABA@EBC@CDAB@BABABABC@CBKBC@@@@@@@A@@B@@EBC@@@AB@B@H@D@FBD@BAD@BA@A@GAC@EAA@C@A@ABADABA@C@C@AAC@ACA@@AA@ABABAF@DAD@D@BABIAGCKGAAA@CABCAGACCACAEAGECEEACAC@C@A@A@CAAAIGCCCCCAGEICMACAA@@A@CMEaEAAIAIGECEAACECACCCGCIAIAG@GBG@GBI@MDMBQDOBSBMDOAKAMCKC@AE@I@IAECEAGCEAE@G@C@A@AF@BDHDFBTAD@H@F@FBFDL@DHFDDHJDFBF@D@H@PBBBBB@BBJBLBFDBFDHBDBBFHDD@DAHEDEFIDE@CBA@A@A@A@A@A@@@E@C@C@A@A@A@C@@@E@E@KDIFKFGDADABAHBFBFFHDFBJ@JCLEFCFCHCHEJAF@HCHADABCHEFEHEFEFGDOBU@BHBDBHBHDJ@BBH@HAFCFCHEHEHCD@BAF@F@HDFHFHBJFFDHFHHDF@FBHDH@@DHLHNHFALCHGDIBIBIDGHGHEJAJBBDDDJHDDFBHDHDN@JAJAH@H@DFHF@H@N@HHDJDHBHDHDJDFFLBRDJ@LHLHDDDHDHDD@FAFAL@DAFDFHFBDDBDDFFDHBF@H@JAJAHAF@HAJ@FAH@DBHDFHHJBLDNBLFFDDDHDFHFFHFHDNBDAHBD@FBB@H@LDLBDBB@FDDDTHJDH@@@JBPALCDADAJEHCDAHCJCDADADCBAFDBDBBBFDDDBBBL@JCDADABB@BDJHHFDFBFB@BBDDDDDDDFDFDBBB@B@D@D@B@BBBBDDBFDHFDRGPEJCHIDMBIFEHEHCFAFEDGBG@ECGAKAGBGBEBGBGDOHMHODEHKFGBKAGCIDMDIDGBCBA@ADGDIBCLEHEDG@I@IBGDEHEDAL@NBHDHBFFBBBBBFBFDDFFJBL@P@X@L@B@NCLABBBAACCEBC@CJGDCNEBABAAAAA@C@ABADAH@BCDCDA@CAC@ABC@A@AAACAC@AAEEAAMEEE@@AA@A@E@CACCAA@ECAEBE"],encodeOffsets:[[122100,31277]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
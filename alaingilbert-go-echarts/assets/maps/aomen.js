@@ -0,0 +1,2 @@
+!function(e,o){"function"==typeof define&&define.amd?define(["exports","echarts"],o):"object"==typeof exports&&"string"!=typeof exports.nodeName?o(exports,require("echarts")):o({},e.echarts)}(this,function(e,o){var t=function(e){"undefined"!=typeof console&&console&&console.error&&console.error(e)}
+return o?o.registerMap?void o.registerMap("澳门",{type:"FeatureCollection",features:[{id:"820001",type:"Feature",geometry:{type:"MultiPolygon",coordinates:[["@@LADC^umZ@DONWE@DALBBF@H@DFBBTC"],["@@P@LC@AGM@OECMBABBTCD@DDH"]],encodeOffsets:[[[116285,22746]],[[116303,22746]]]},properties:{cp:[113.552965,22.207882],name:"花地玛堂区",childNum:2}},{id:"820002",type:"Feature",geometry:{type:"Polygon",coordinates:["@@MK@CA@AAGDEB@NVFJG"],encodeOffsets:[[116281,22734]]},properties:{cp:[113.549052,22.199175],name:"花王堂区",childNum:1}},{id:"820003",type:"Feature",geometry:{type:"Polygon",coordinates:["@@EGOB@DNLHE@C"],encodeOffsets:[[116285,22729]]},properties:{cp:[113.550252,22.193791],name:"望德堂区",childNum:1}},{id:"820004",type:"Feature",geometry:{type:"Polygon",coordinates:["@@YMVAN@BFCBBDAFHDBBFDHIJJEFDPCHHlYJQ"],encodeOffsets:[[116313,22707]]},properties:{cp:[113.55374,22.188119],name:"大堂区",childNum:1}},{id:"820005",type:"Feature",geometry:{type:"Polygon",coordinates:["@@JICGAECACGEBAAEDBFNXB@"],encodeOffsets:[[116266,22728]]},properties:{cp:[113.54167,22.187778],name:"风顺堂区",childNum:1}},{id:"820006",type:"Feature",geometry:{type:"Polygon",coordinates:["@@ ZNWRquZCBCC@AEA@@ADCDCAACEAGBQ@INEL"],encodeOffsets:[[116265,22694]]},properties:{cp:[113.558783,22.154124],name:"嘉模堂区",childNum:1}},{id:"820007",type:"Feature",geometry:{type:"Polygon",coordinates:["@@MOIAIEI@@GE@AAUCBdCFIFR@HAFBBDDBDCBC@@FB@BDDDA\\M"],encodeOffsets:[[116316,22676]]},properties:{cp:[113.56925,22.136546],name:"路凼填海区",childNum:1}},{id:"820008",type:"Feature",geometry:{type:"Polygon",coordinates:["@@DKMMa_GC_COD@dVDBBF@@HJ@JFJBNPZK"],encodeOffsets:[[116329,22670]]},properties:{cp:[113.559954,22.124049],name:"圣方济各堂区",childNum:1}}],UTF8Encoding:!0}):void t("ECharts Map is not loaded"):void t("ECharts is not Loaded")})
@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("福州",{type:"FeatureCollection",features:[{type:"Feature",id:"350104",properties:{name:"仓山区",cp:[119.273545,26.046743],childNum:1},geometry:{type:"Polygon",coordinates:["@@YFC@C@C@E@CBC@C@C@KBCBA@A@A@@@C@GDIBK@M@GAEDA@@@EBIBC@IDA@CDGFCDEFCBUO@@@@@@@@@@A@@AAA@@@@A@CB@AA@CA@@@BAB@@AB@B@@ABCFAD@@AB@B@@A@ABB@BD@@DBXTEDABIDINHBBBEHCD@BCDAFADAF@@ANE@@D@HAR@J@D@BADET@D@BAHABAD@BABCB@DFBB@DBDBBBDB@@F@DBBAB@BA@@DEDC@ABE@@B@@@@@B@@A@@@@BA@@@ABA@@BC@@@A@@@@@@@ABA@@BA@AB@@A@A@@BA@@A@@ABC@@BA@@BAFC@@B@@@BA@@@@B@DC@@@@BAHE@@@AB@DC@@@@@@@BFE@@@@@ABBAAB@BC@@@ABAB@@AB@@@@@@AB@B@@@B@@@@@B@@@@@BB@@D@@ADADA@C@@B@@@@A@@B@B@@@@@B@BAAAB@@@BA@@@@@@BA@@@@B@B@D@@@B@@@B@B@@@B@B@@@D@B@B@@BB@@BBB@B@@@@BBBAB@@@@@BB@@@@@@H@BBB@@@F@B@D@@@B@B@@@B@B@@@@@HAD@@@D@DA@@B@B@B@BA@@NCB@BA@@B@@@@@@@@@@@DA@@B@JEFCDADACAHGDADEBADCHIBAFCDCBA@ADAFADALCB@B@@@RCFA@@HAB@@@BADAHCDADCDCDCFEDCBABCBCBC@EHD@@@@BEDCEBEBA@C@CAA@C@@AAACAAAAACAACACAAAAAACAAACAGCCAA@AAA@@@@ACAMDIDIFMBIFCBKDMF"],encodeOffsets:[[122231,26598]]}},{type:"Feature",id:"350102",properties:{name:"鼓楼区",cp:[119.303917,26.081983],childNum:1},geometry:{type:"Polygon",coordinates:["@@BA@C@@A@C@A@A@A@A@ABA@@B@@@B@B@@A@A@@AA@A@E@C@AAA@@@A@A@C@C@C@A@A@A@A@@@@@@CAAA@A@@@AAA@EAEA@@@AAA@@ECC@AA@B@@@@EF@A@@@@@@CDA@@B@@GFAB@@@@CDA@@@@@AB@@A@@@EDAB@@AB@@AD@BB@@@AB@@@B@BA@@BAB@@AB@B@@@@@@@B@@AD@@AB@B@@AB@@@@@BA@@@@@@BB@@@@@JDAFEDDBD@@@BBBBJH@@@@BBBBBBB@@BB@AB@B@@D@@@B@@B@@D@@BA@A@BB@@B@@@@BAABB@@BBBAFDB@B@HBBBBAB@B@@BBABB@@BA@A@AB@D@D@D@B@@@@@@A@@@@B@@@AA@@@@@@@@@A@@@@@@A@@@@@@A@@A@@@@@@A@A@@@@B@@@BB@B@@A@B@@B@@BDB@@A@@@BB@A@@BB@@@@BB@@B@A@BFA@@NC@@D@AC@A@@@A@@BA@ABC@@@ABA@@DI@@BEBABE@@BA@A@@@GBE@A@@@CBA@@BABC@A@@BC@@BC@C@C"],encodeOffsets:[[122186,26699]]}},{type:"Feature",id:"350103",properties:{name:"台江区",cp:[119.314041,26.052843],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@AAA@AA@@AA@A@C@@@A@A@@@A@A@@@A@@@C@A@A@@@@@AB@@@@@@AB@@A@BBABA@@@@@A@A@@@@B@@A@@@@DCBCB@BC@@@AA@@@@A@@@@@A@@@A@A@@B@@@@A@@BA@AB@B@@ADA@BBD@FD@@BB@B@@FBFBB@BB@@B@B@BB@D@@@@B@B@B@B@D@D@D@B@B@@@B@BBD@F@B@B@@BB@B@@@@A@A@@@AB@BAB@B@B@B@D@B@@C@A@C@@@A@A@@@AB@@A@@@@@@B@BAH@H@B@B@@@HBB@DAD@@@J@@@D@DAD@@CF@B@DABA@A@@B@@A@AA@@@@AD@@BB@BEBE@A@ABCBCBA@AA@CBCBEDIFA@@@CB@@@@@@@@@@A@@@ABA@MD@@ABA@A@A@@@CBC@@@C@GB@@@@A@A@@@A@A@@@C@A@E@@@A@AAG@@@@@@@AA@@@@A@ABAA"],encodeOffsets:[[122175,26676]]}},{type:"Feature",id:"350105",properties:{name:"马尾区",cp:[119.455588,25.9895],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@AAC@A@EBIBCDAF@@@@GC@FADADADABCDEFCDCDCDCBGDCBAB@@A@GB@@EBQD@@A@A@KDCBEBCB@BABCDEDABGJCDABCFCBGHDBB@FCFAB@DDBBJHRHB@@AB@@A@@@AB@@@D@@B@@B@B@BAA@D@B@@@@BA@@@B@@B@@@BAB@B@B@@FD@@@DB@ABBB@B@B@@@@@BBBCDCBA@@B@BAB@BBB@B@@BB@@@@@@FDBB@@@@DD@@B@@BB@@@B@@@@@@@@@@B@@BD@@@@@B@D@@@B@BAB@@B@@B@@@@@B@@AD@@@@A@@B@@B@@B@@@@@B@@@B@@@B@B@@BA@@@AB@@@B@B@B@@B@@B@@ABABA@@@@@@B@@B@@@@@@B@@@@@@B@BB@B@@BB@@@@@BBAB@B@@B@B@@@B@@B@@BBB@@@ABBB@@AB@@@D@@@B@B@BBB@@@@@@DA@A@@@B@@BB@BB@@@@BA@@BA@BBA@@B@@A@@@@BA@@BA@A@@@@BA@@@A@AB@@A@A@AB@@@@@B@@@B@@@@@B@@@B@@BBBBB@@BBB@@B@@A@@B@B@@@@B@@A@@@@BB@@@@BB@@@B@B@B@@@B@@@BA@@@AB@@@@@BABAB@@B@@@@@@@B@@@BB@@B@@@B@@@@@B@@A@A@@B@@@@A@@@AB@@@@@B@@@@@BAB@B@@@@@@@@@B@@AB@@@B@@@B@@@@@@BBBA@@BBB@B@@@B@@BB@@B@@@BAB@@@B@@@@@B@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@B@@B@@B@@BB@@B@@@@@@AB@@AA@B@@A@@A@@@@@BA@@@@B@@A@A@@@@B@@A@@BA@@@@BB@B@B@@@@@B@@@@@BB@@B@@@BA@@BBB@BBB@BAB@@@BA@@D@B@@@D@BAB@@AB@BABBB@@@D@B@DAD@@@@@BCBAB@@AB@D@B@B@@@@@B@@@BC@A@@B@@ABA@A@@B@B@@A@@BAB@B@BB@@B@@@@B@B@@B@@@DBB@@ABAB@B@@BBBB@BD@@B@BD@@@@BA@A@@BA@@B@BB@AB@@BBB@@B@@@@A@@@@@@B@@B@@@ABABA@@@AB@@@B@BA@ABA@@DABA@@@@@BB@@@BB@AB@BAB@@AB@BA@A@@@ABAB@@@@@D@BA@@BB@@BA@@@A@@@@A@@A@ABABA@AB@BA@A@@@@B@@A@@B@DAB@@ABBBA@@DABBA@AD@@B@@@@@@AB@BAB@@@BDB@B@@@B@@AB@@A@@B@@@B@@@@@B@BC@ADAAAB@NIDFDHFB@BHDFD`HB@hFN@@@@IBEDEBCFABAFAPANKDA@ECEFUDCD@LBB@@AB@@S@CBCDCBEBEBCDa_BYBYBG@GBCAoFGBA@CDEFAB@@AAC@ABC@CBIDAB@@A@@@@@@@@B@@@B@@@@E@@@@@@@BABC@AA@GFIJMJQNKMGICEEICECC@@CEACCEACAA@CAA@@AI@EBA@E@C@C@CA@@AEEMM@@CAAAIKCCDCACAAACCECCCIAACEAACACEGCECCAEA"],encodeOffsets:[[122331,26601]]}},{type:"Feature",id:"350111",properties:{name:"晋安区",cp:[119.328521,26.082107],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@ABA@@@@@@@@BA@@B@@@B@B@@@D@B@D@@@DAB@D@DAD@@AD@@@BADAB@@AB@D@@@BAF@H@@@BAB@@AFABAF@@CJ@@AB@B@@AD@BAB@@@B@@@BBDC@@@MD@@EB@A@B@AA@@A@@A@@AB@A@@A@@@BA@AC@@@AA@B@@@@AAA@@A@@@@@@B@B@@@@B@@@@B@@@@B@@@@@@@@B@@@@@@@@BB@@A@@@@@@B@@@@A@C@C@C@A@@B@BAB@@AAAB@AA@A@ABAAGAA@A@ECABAA@@AABB@A@@A@@@AAB@B@@AC@@@@AA@@@C@@@AFCH@@B@@@ABB@A@@@AB@A@@@@@@@@@A@@A@@@@BABA@@B@@@B@@@BA@@@@D@@A@@@@@A@@BAA@@A@@@@@@@A@@@A@@BA@A@@@@@A@A@@@@A@A@@@@A@@@@BA@A@AB@@BB@@@@@@BB@BB@@@AB@BAAAAA@@@@@AB@BAB@@@BBB@BBB@@@BED@@@B@@@B@@@@@BBBB@BB@@@B@@@@BDB@@B@@@BDAB@B@@@B@@BB@@@@B@@B@@@@@@B@@@@BB@@@BBB@@AB@@@@A@@@@@A@@@@B@B@@B@@@D@@BBBDB@B@@CBABAD@BAB@B@@@BAB@@@BA@@@@@@@A@CAA@A@@@@@AB@B@@@FABAA@@AB@@@@ABB@@B@B@@@BB@@BA@AAABA@@@ECA@A@AAC@AAA@ABCBA@@@BB@@A@@BA@@@BBA@@@A@@BA@CAA@@@@B@B@B@@FD@@BBBB@@@BB@@@CB@@ABA@@@@BCB@B@@B@BB@@@B@@@@ABC@A@AB@@ABABA@@@@BB@@@@@ADA@@B@B@B@@@@B@@BDBBB@@@BB@B@BBB@@@@@@@AB@@@@BB@@BA@B@@@B@@B@@@@B@@@BAB@B@@@DB@@BB@@B@@@@@BAB@B@BBB@@@DB@@B@B@@@@A@@@@@BB@@B@@B@@@@@DABAD@@@@ABABABA@A@A@@AA@AB@@A@CAABA@AB@@@@@BBABDBB@@B@BB@@ABAB@@@@A@A@A@@@A@A@@@ABA@@A@@A@AA@AA@@@@@@@AB@@@@@@A@@@@@A@CB@@A@@B@@A@@B@B@@@B@B@@@BAB@@A@A@@@CDAB@@ABA@@BA@@@@@@BBB@B@@@@@B@B@@B@@BB@@B@@@B@DBB@@@@H@D@B@BAB@@@B@B@BBB@@B@BBB@@CB@B@@@@@BAB@@@@AB@@AB@@@B@@AB@@AB@@@BA@@BA@A@A@A@@@CDA@@BA@@@ABABA@@B@@@BA@@@C@ABABA@A@@B@@BB@@@@B@B@@@BB@@@B@@@@@@BB@BFN@@@DAL@@AJ@DB@DABBB@@@B@B@@@D@@B@@@B@@@@DBB@@@B@@@@@BB@@@@A@@B@@@@BBBA@@B@@B@@B@B@B@@@@@@B@@AB@@@@A@@@@@A@@B@B@B@@D@@@@BABAB@@@BA@BB@@@B@B@B@BA@@B@@@B@BA@AB@@@@@B@@@@AB@@@B@@BBCD@D@B@@@BB@DDVDDBFB@@@@@B@@@@@@AB@@@@A@@B@@B@@@@@BB@@B@@B@@@@B@@BB@B@@@@@@AB@@@B@@@@A@@B@B@@@BA@@BBBBB@@@@@@BCF@@@@B@@@@@BB@@@@BA@@B@@@@B@BB@@@B@D@B@@AB@@@B@@@@@BB@BB@B@B@@BB@@@B@@@@AB@B@B@@@B@BA@@@@@B@B@@@B@@BB@@@@B@@@@@B@@@BA@@@BBA@@BA@@@A@@@A@A@@A@@@FAF@DB@@B@@@@@B@ABA@@@CBABABA@ABA@@@A@@BAB@@A@@BAB@BA@@@AA@@A@A@C@C@A@@@A@A@A@@@A@@@A@ADADAD@BAB@BBB@BAB@D@@@@B@B@B@B@BBB@HDDB@@B@B@BAB@DC@@@ABC@AB@@@BB@@@@B@B@B@@@AB@B@@@@DB@BBB@@@A@@B@@@B@@@B@@@@@@B@B@B@@@BB@B@@@BD@B@@@@B@B@@BB@AA@A@@@@@@@@B@@B@@@@B@@@@@@@@BD@B@@BB@@BB@@A@@B@@@BAB@@@@@B@BB@@BADA@@@@BCBCDB@@B@@@BD@@B@@@B@B@@@@DB@@B@BBB@BD@@B@@@@ABA@BB@B@@@@BB@@B@B@@@@A@@@AAC@A@A@A@A@@@@@A@@@@@AA@@@B@@@A@@@@A@@A@@@@@AA@@A@@@A@@@@@A@B@D@B@B@BA@@B@@@@@@@@AB@@@@A@@@@B@@@@A@@@@B@@@@@@A@@B@@A@@@@BA@@A@AAABAAA@@@@@@A@@@@@@B@AAB@@A@@BA@A@E@@C@@@@@@A@@BA@A@C@A@@AAAA@@AA@AAA@@@A@@A@A@@@A@@@@AAA@@BAA@@@@@AAB@A@@A@@@@@AB@@@AA@A@AB@BA@AB@B@@@B@@B@@BBB@@@BA@@BAB@H@FAB@HA@ICG@@BCB@@@B@@@@AA@@@BA@A@@@A@@BC@AB@BBB@@@BB@@B@@@@B@@B@@@B@@@@B@@@@BB@@@@@B@@B@@B@@B@@@B@@@@@BB@@BB@@@@AB@@@@@@C@@@@@@@ABA@@B@@@@B@B@@AB@B@B@@@@B@BA@A@A@A@A@@@A@@@BBBBBDB@BBBB@@@@@B@@B@@@D@@B@@@B@@B@@@@@@@@A@@@@@@@@@@@AB@@@@@@@@@@@@@@@@A@@@@@@@@@@@BA@@@@@@@@@@A@@@@@@@@@@BA@@@@@@@@A@@@A@@@@@@@@@B@@@B@@AB@@@A@@@@@@@A@@@@@AA@@@@B@@@@@@A@@@@B@A@@BB@@@@@B@@@@@@@@@@A@@@BB@@@@AB@@@AAB@@@@@@@B@AA@@@@B@@B@@@@@@B@AAB@@@@@@@@B@@B@@@@@B@@@@@B@@@@@@A@BB@@A@@@@@@B@@@@@AAB@@@@@B@@@@@@@@@@@@@B@B@@A@@@@@@@@@@@AB@A@@@@@@A@@A@@@@A@@@@@@@@@A@@@@@A@@@@@A@@@@@@@@@@@A@@B@@@@A@@@@A@@A@@@@@@A@@@@@A@@@@@B@@@@@@@@A@@B@@@@@@A@A@@@@@@@A@@@@@@@A@@@@@@@A@A@@@@BA@@@@@A@@BA@@@C@@@@@ABA@@AAB@@@AAA@@AA@AAA@@@B@B@B@@@@@B@BB@@BB@@BC@@DCB@@AB@B@B@@@B@@@BAB@@BB@@@BA@BB@@A@@B@BA@@AAB@@A@@@@B@@B@@@A@@@AAAAA@@AA@@B@@@@@@AB@@@@A@@@B@@@@BA@B@A@@@@A@@@@@@A@@@@B@@@A@@AA@@@@@A@A@AA@AA@A@@@AA@@C@AAAA@@@A@@@A@@@AA@@@@A@@@@@A@A@@@@@A@@BAB@@@BA@@B@@A@@@@@@@AA@@AA@@@@AA@@@@A@@@@ABA@A@@@@I@AA@A@@AAA@@B@@@@AB@B@BABAA@@AB@@@B@BAB@@AB@B@D@B@@@BA@A@@@AA@CA@A@@@@AA@@@@@A@ABABA@@B@@@BAB@BA@@BA@@@@B@@@AAA@@AC@A@@A@@@@@A@C@@@@@@@AB@BA@@B@BAB@B@@@B@@@B@@@@@B@@@@@BC@AA@@@@AAA@@@@AAA@@AA@@A@@@@BA@@B@@@@@BA@@B@@A@@@A@@BA@AB@@@@@BA@@@@@@B@@A@@@@@A@@@@@@B@@@@@@BB@@@B@BA@@@@@@@A@@B@B@BBBB@A@@B@@@B@@@BBB@@@@@B@@@@@@@@BB@@AB@@@@@@A@@B@BAB@@@B@BA@@B@@@@A@@BA@@@@B@@AB@@AAA@A@A@AB@B@B@B@@AB@B@@@@@BA@@AIAG@EA@@@@@@@BA@@@@@A@@BA@@AA@A@@@@@A@@BAD@@@B@@@BA@@@@@A@@@A@@@AA@@@@@@A@@@AA@@@@@@A@@AA@@@@AAA@@@@A@@@A@A@@B@BBB@@B@@@@B@@BB@@@@@B@B@@@B@BB@@BA@@@ACA@@B@@A@@@@B@AA@@@@@@BADAB@@AB@@A@@BCB@@@B@@@B@@@B@@@BA@@@@@@@A@@A@@A@@@A@AA@@@@AB@@A@@B@D@D@B@B@D@@@BB@@B@BA@@FBB@BBB@@AB@BAB@B@B@@@@@BD@@B@@@BA@@@@D@@@@@B@@@@@@@@A@A@@@@@@BA@A@@@@@@@AB@@A@A@@BABA@@BAB@@@@@BA@@@AB@B@BAB@@@B@@A@@@A@A@@@@@A@C@C@@@A@AA@A@@@A@CA@BAABAB@B@@AB@BE@A@@@AA@A@AAA@AA@@AB@@A@@@AA@@@@A@@@@@A@A@A@@A@@B@@AB@@@@A@@B@B@@@@A@@B@@A@@@@@BB@@@@ABB@@BA@@@@@@@AA@@AA@@@@AA@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@A@@@@@A@@BA@A@@@AA@@AA@@@A@A@AA@@ABAA@@@@@@@A@@@A@@BA@@@A@@@@@@@@@ABA@A@@@@@A@@@@BA@@B@@@@@@AB@B@@@@A@@@@@A@@@AA@@A@@@A@@@@@@@AA@ABAB@@@@A@@B@@AB@@A@@@A@A@A@@@A@@A@@A@@A@@B@@@@A@@A@A@@@@BA@@@AA@AA@AAAA@@@A@@@A@@@@@A@@@A@@@@BAB@B@@@BAB@@@B@@A@@B@B@@AB@@A@@B@@@@AB@AAB@@AB@@A@@A@@AAA@@@A@@@BCB@@@@@@AA@A@A@A@@@C@@BA@@AABA@@A@AA@@@AA@@@A@A@@@@ABAAA@@@@A@@AA@A@@A@A@@@@A@@@@@@@@AA@@@@@@@ABAB@BA@@@@AA@A@A@@@A@@B@@AB@@@A@A@@@A@@@A@@@@@AA@@@@AB@@@@@BC@@@A@@@@@AA@@@BA@A@A@@@C@A@@@@AC@@@A@@@@@@@@A@@@A@@AA@@@CC@@@@AAEC@@@@@@AA@@@AAA@ABA@A@AB@DADCAA@A@@@@@A@AAABAA@@C@@EC@@@A@ABA@A@@@AA@@@B@@A@@A@C@B@ABA@A@@@@AC@@@A@@B@@@BA@@BA@QGIGAACCA@EBED@BABADAD@B@BAFAFA@@AC@@B@@B@@B@BA@@@@BABCBA@E@@DC@CBC@@@I@@@C@CBA@GA@@A@A@G@"],encodeOffsets:[[122194,26686]]}},{type:"Feature",id:"350121",properties:{name:"闽侯县",cp:[119.131724,26.150047],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AB@@A@@@@B@@A@@@@@A@@@@@@@A@@@A@@AA@@@@@A@@AB@A@@@@@@@@A@@@A@@@A@@@B@AA@@@B@@A@@A@@@@@@@@@@B@BABA@@@@@@@@@AB@@@@@B@@@@@@@@@@A@@B@@@@A@@@@@@@@@B@@@@BA@@@@B@@AA@@@AAA@CA@@A@A@AAA@A@@AC@@A@@@@@AB@@CB@@AB@A@@AAA@AA@B@@ABA@@@AD@@@BCBA@@@@@@B@@@B@@@@@@A@@@@@@@A@@@@@@@@A@A@@@@@@@@A@A@@@@@@A@@@@@@BA@@@@AA@BA@@@A@AB@@@A@@AA@@@@A@@@@@@@A@@A@@@AA@@@A@@@@@@AA@AAA@@@@@A@@@@@AB@AA@@A@@@@A@@@@@AA@A@@@@@A@@AA@@@@@@AD@@A@@@@@@@@A@@@@@A@A@A@@@@@B@AA@@@@AA@@A@CAAA@@@@BA@AB@@A@@@@A@@@@AB@@A@@AA@@B@@@@@B@@@@@B@@@@@B@B@@@@@@@@A@@@@@@@@B@@@@@A@@AB@@A@A@@@@BA@@@@A@@@AA@@@@BA@@@A@A@@@A@A@C@@CAAAA@@A@@@A@@@@CA@A@@@@@@@A@@@@@@@A@@A@@A@@@@@@@A@@@@A@@@@A@@@@@A@A@A@A@@@@@@@@@A@@@@@AAAA@@@AA@@@C@A@@A@@@A@@@AA@@AA@E@AAA@@ACAACAAA@@ABA@A@AB@@AAA@A@A@C@@@@@AAA@@@A@@@@@AB@@AB@@@B@@@B@@@@@B@@A@@B@@@@@@@B@@@B@B@B@@@BA@@@@@@B@@@@@@@@@BAB@@AB@@A@AA@A@@ABA@AB@BAD@@B@@BB@@@A@A@A@@@@@A@CA@@AB@@@@@B@@A@@CA@@@A@@A@@@@@A@A@A@@@@C@AAAA@@@@@@@AA@A@A@@@A@@@A@C@@@@@@@@@A@@@@@BB@@@@@B@@CB@BCBA@@B@B@@A@CBA@A@CB@@AB@@AB@@@@A@@@A@@@@@A@A@@@A@@@ABA@A@@@A@@B@DAF@B@@AB@BCD@@@@@B@BA@@@AB@@@BAB@BAF@BAD@@CAAAA@A@AAC@@AA@@@@AB@@A@@@@A@@@CC@A@@@@A@AA@@A@@@A@@@@@@@C@@@A@A@@AA@@@A@@@A@ABAB@@CB@@@@@B@D@@@D@@ABA@@BAB@B@B@@ABAB@B@B@@A@@B@@@B@B@B@@@B@@@@@BBB@@@B@@@B@@@@CACAAA@@AB@@A@AA@@A@@@A@@A@@@AA@@A@@A@@@@@@AA@@@@A@@A@A@@@A@@@C@AA@BA@@@@@A@@@A@@@@A@@@A@@@@@@@@CA@@@A@@@@@A@@B@AA@@@@AB@@@@@@A@BAA@@A@@@@A@@@@@@BA@@@@B@@@AA@@@@B@@A@@@@BAA@@@@@@@B@@@@@BA@@A@@A@@@A@A@@@@BA@@@AA@@@@AB@@@@AAAA@BA@@@ABA@@@@@A@A@@@@@AA@@@@@A@@@@@A@@@@BAA@BA@@AA@A@@@A@AA@@@@A@@@AA@BA@@@A@@@AB@A@@@AA@@@AB@@@@@A@@A@@@@@@@A@@@@@AA@A@@@AB@@@@@B@@BB@@@@@BA@A@A@@@@B@@@B@@@B@@@@AB@@@BAB@@C@@@ABABAB@@@@A@C@@@CDABABA@@@@AA@@BABA@@B@BA@AD@D@B@@A@@@A@A@CBC@@BA@@BAB@BA@@@CBA@@@@BAB@@BB@@@B@@AB@B@B@B@BAB@BADD@BB@@@B@@@B@@@B@@@B@@@@BB@BDD@@@B@BB@@B@@@@AB@@AB@@@@@B@@BB@@@@A@@B@B@@A@AB@@@@A@@@AB@AA@@@AA@@@AA@@@A@@AA@@@AB@@ABA@@B@@A@@@AB@@AB@@@@@@@BA@A@@A@@A@ABADA@@@A@@B@BBB@@@BAFBB@BA@@@A@C@@@C@@@A@AA@@CAA@ABAAC@@@A@@A@A@@@AA@@@ABA@A@@@CB@B@@@BAB@B@@@@@DA@AB@@A@A@ABA@AAAC@@A@@@C@@@A@@AA@@A@A@@@AA@A@AA@@@AA@@@@A@@A@@@E@@@@A@@@A@@A@@@A@@@A@@@A@@AAA@B@@A@@B@@A@A@@B@@A@@@AAA@A@A@AA@@A@@B@B@BDD@@B@@@BB@@B@@@@@BB@@BBB@B@@BBA@@@BA@CB@@@BAF@@A@ABA@AA@@CAAB@@CD@@@BABAB@@@@AB@B@@@@@BA@@@ABA@@B@@B@@B@@@B@@@B@@@@AB@@@@@@BB@BBBA@@@A@@@@@@BA@AAA@@AE@@@@B@B@B@DCB@@@@BB@BBBB@@B@BBD@B@@BBBB@@AB@@@@BBBBA@@B@@BB@B@@AD@@@BB@@BB@@@@@BB@@@@AB@@@BBDDB@BBB@@FB@@BBB@B@@@BBBB@@@@A@@@@@@@@BA@@B@@@@@B@@A@@B@@@@A@@@@@@@A@A@@BA@@B@B@@AB@@A@@A@@@A@@A@@@@@@B@@@@A@@AA@A@@@@A@@@@@@@AA@@@@A@@@@AA@@@@@@AAA@A@A@AAA@AB@A@@@@@@@A@@@AA@@@A@@@AB@BBBC@@@A@AB@@@@@B@@@BB@A@@@@BB@@B@@AB@@B@@B@@@B@@@B@@B@@@@@B@@@@@B@@@@@@BB@@@@@@@@B@B@@@B@@@B@@B@DDB@@@AB@@@@@B@@@B@@BB@@BBBB@@B@@B@@A@@BB@@BB@@@@B@B@@A@@BA@A@@@A@@BA@@BA@A@@@ABB@@@CB@@@BA@AB@@@@BB@@@BABBBA@@BA@@@C@@@A@A@C@A@A@@@@@AB@@ABAFA@@@@BA@CBA@A@@@AA@@AB@@CBCB@@AB@@A@@B@@@@AACAA@A@@@@@BB@@AB@@@@A@BB@@@B@B@@@B@@A@CAA@@@@A@A@C@@@AB@@AA@@AABA@@AA@@B@@@B@@@BA@A@@@@@@@@BA@@@C@@BABABAD@@ABC@CAC@AB@@@@ABA@@@ABA@@AA@AACA@@@@A@AB@@ABA@@BAB@@@BA@AAA@A@A@ABA@A@AB@@A@A@@@A@CBAB@@C@CA@@@@@A@@EEAC@DAB@DA@ABCB@@AD@@@B@@@B@@BD@B@@@BABAB@B@@@@AB@@A@A@A@@@A@C@A@CC@A@AA@ABA@@@A@@AC@CAEA@@CA@@A@CB@@@@AA@@A@AA@@A@@@A@@BA@@@@@ABA@@@@@AB@@CB@@A@AB@@A@@@@@@@@B@@@@@@BB@BB@@@@BB@@BB@@BB@@B@@B@E@EAA@CAA@@BAB@@@@@B@@A@@@@AA@@@@@AA@@@@AA@@@A@@@@@@A@@BA@B@@@ABAA@BA@ABABEFABCDAB@@A@@A@@C@@A@@A@@@@@@@ABAB@BA@@@A@@F@@BBB@@@@BBBB@@B@B@@@@B@AB@@BD@B@@AB@@AD@@@B@@BB@@BB@@BB@@@B@@@B@D@BABA@@@@@A@@@@@A@@B@@A@A@@BA@A@AAA@@@@@@AAA@AA@A@A@A@@@A@A@AA@BA@AB@@@@C@A@@@A@@@A@@BA@A@ABA@A@ABC@@B@@CAAAB@@AB@@@@@@@@AA@A@@AAA@@AAAAAA@@@AA@A@@@AA@@AA@@@@@AA@@@@AAAA@@@@@A@A@@BA@@@@AA@A@@@A@@@A@A@A@@@@A@@A@@@@@AA@@@A@@ABC@@@@@@BA@@@AB@@@@AB@@@@@@@B@@AAA@@@@BA@@@@@ABA@A@A@@AAB@@@BA@AB@BBB@B@@BB@@B@B@@A@@BB@AB@B@@AB@BA@@B@@BB@@@@BB@@@AB@@BB@@B@@@@D@@B@@@BBB@@@@D@B@@BB@@BBABB@@BB@BDB@@B@B@B@@@B@@@D@@@B@@@@@@B@BBB@@@@@@B@@@@C@@@A@@@@BAA@@@@A@@@A@@@@@@B@@@B@@@@@@AB@@@@A@@@@B@@B@@B@@A@@B@@@@@@@@BBBB@@@B@BABAB@@@B@BBH@BB@@@@BAB@@@B@@@DBB@B@BB@@BB@BB@@BBBD@@@B@@@B@B@@@D@BB@DBB@@BBBBD@@AB@@@B@BBB@@@@@B@BBB@@@BB@BB@@@BA@@B@BAB@@A@ABABA@@B@B@@@B@@@@BB@@@@BBB@BB@@@BAB@@@BAD@B@@@@B@@@BBB@@@@BB@@B@@DDDDBB@B@@@@BB@@@B@@B@B@B@BB@@@@@B@@@@@B@@@@@B@@@@@B@B@@@B@@@B@B@@BB@@B@BBB@BB@@@@@@B@B@@B@B@@@@BBB@B@B@B@B@BBB@BB@@@B@@@B@BAB@@B@AD@@B@@B@@@@@B@H@B@@AB@@@B@B@@@@@B@@@B@B@BB@@BB@@B@B@@@@B@B@@@B@B@@@@@@B@@@B@@@@@B@B@@A@@@@BA@@@A@ABAB@@A@AB@@@B@@@@@BB@@BB@@BDB@BB@@B@B@@ABAD@BAHBB@@@@@BBB@@@@BB@@B@B@B@@BBBBBDB@@BB@BDB@BBB@B@BBBBBBBBBB@BB@@BB@B@@BDB@B@B@D@@@@@@B@@B@@A@@B@@@@B@@@@B@@B@B@@@@@@A@@@@@@@@@A@@@@B@@@@@@B@@B@@@@B@@@BAB@@@@B@@BB@@@B@@@@@@@B@@@@@B@@@@BB@D@@AB@BABA@@B@BA@@BC@@@A@ABA@@@@@C@A@AAA@@@AA@@A@A@AA@@A@A@@AA@@AA@@A@@B@@A@A@@@A@AA@@@AACAA@@@AA@@A@@A@A@@AAAA@@AA@@@@A@@@AC@A@@AAA@AA@@A@AB@@@@CAA@AA@@C@ABA@CACB@@@@A@@@@BAAA@@@BB@@A@@@A@@@A@@BCBABCD@@@@A@@A@A@AAABA@@@@@A@@BA@A@A@@@@@@@ABA@A@@@ABA@@A@@@A@ABA@@@AA@@@@@@@@@A@@@@@@@A@@@@A@@A@@A@A@C@@B@@@B@BA@@@@@AB@@A@@@AB@@@@A@@AAA@A@BA@@@AAABA@@@A@@B@BA@@@ABCA@@@@A@@@C@@@@@@D@DA@@B@B@F@F@F@@@B@@AF@D@B@BCH@D@@@DBDAF@DAD@F@@BBB@B@BBB@DBB@@@B@@B@@B@@BB@BB@A@A@@@A@A@@@@@@BB@@@@@@@@@A@@@@B@@@B@@@B@@@@B@@AD@@@@@@B@@@@@@@B@@@@@B@@BBB@@A@@@@B@@B@@@B@@@B@@@@@@B@@@@@@AB@@@@B@@@BB@@@@B@@B@@@B@B@B@@@B@@@BB@A@BB@@@B@@@BB@@B@@@@@@@BB@AB@BB@BDBB@@@BAB@@AB@BBBBB@BB@DBBD@@B@@@@B@@@@A@AB@@@@BB@@B@@BA@@@AB@@BBA@@B@BAB@B@B@B@@@B@@@@@@@B@B@B@B@@D@@@@B@@@@@B@@B@@AB@@@BA@B@@@@BB@BB@BB@@A@@B@B@@@@@B@@ABA@A@@@@@@BA@@@A@@B@@ABB@@@B@@@@@AB@B@@BB@B@@@@B@@BB@@@@BB@B@@@B@@@@@BB@@@BB@@A@BB@@@@@B@@A@@@@BB@@B@B@BAB@@BA@@BA@B@@@@BB@@@B@@@B@BB@@@ABB@@@BBAB@B@@BB@@@@B@@@B@@A@A@A@@@AB@BA@BB@BB@F@B@@@BB@@B@B@@B@@@@@B@@@@@B@@@BCBBB@BBBBD@@@BBB@B@@B@BBBBBB@@C@ABA@@@A@A@@BBBA@@@@@AB@@A@@@@@@@@@A@@@@@BBA@@B@@@@@@@@@B@@@BBB@@B@B@B@B@BAD@@@D@B@@@B@@@@@@@AB@@@B@@@@A@@BB@B@@@B@B@BBB@@@@AB@@@B@@@@AB@@A@@BB@@@@@B@@DDB@@B@B@@C@@BCBAB@@@@B@BB@@A@@BA@AB@@@@@@@B@@B@@@@@B@@@@@B@@B@@@B@@@@A@BB@@B@@B@@@@@B@@B@@@@@@B@BB@@B@@BBB@BB@BF@@@BB@@@@B@BA@@@BBBBD@@B@B@B@@A@A@@DC@@B@@B@@@@B@@@BFBB@BDB@BD@@B@@AB@@ABA@@@@B@@@B@@@BB@@@@@@BB@@@B@@@@@B@@@B@@@BB@@@@@B@@A@@@BB@@BB@@@@@@@@BB@@@@BB@@B@@@B@@@B@B@@A@@BA@@B@@@B@B@B@@@B@B@@BDA@BB@B@@B@@@@@B@BB@@BBB@B@@@B@@DB@@B@BBBBB@@@@B@@@B@@@@@BB@@@B@@@@@B@@@@@B@@@@@@A@@@BB@@@@@@B@@@@B@@B@@@@BBABB@ABB@@BADAB@@@BB@@@@B@@@B@@@@@D@@@B@DAB@@@D@@@@@B@B@@@@A@@B@B@@AB@@@@@B@@@@DB@@@@@B@@@@@@@B@B@@@@BB@@@@@BB@@BBBB@@@B@DBDBB@B@@B@@BB@B@@B@@@B@@@B@A@@BB@@@@B@B@@@BAB@@A@A@A@A@@B@@@@@@@@@B@@A@@@@@A@@B@@@@A@@B@@@@@BD@@@@@BB@@B@@BB@@@EB@BBB@@@@@B@@A@@@@B@@BB@B@@A@CB@@@@@B@@@@AB@@C@@@AB@BA@@BAB@@@B@BC@A@C@@@A@@AB@AA@BA@A@AAAAAAA@@@A@@@AB@@B@@@ABAB@@@B@@@@@B@@@@@B@@AB@@@B@B@@A@@B@B@@@BAB@@@@BB@@@@B@@@@B@@@BB@A@@B@@@BB@@BBB@@@@D@@@B@@@BB@@B@@AB@B@@B@@BB@@@B@@@@@BDBB@@@BB@@@@D@@@BA@AD@@@@A@@@AB@@@@A@@@@@C@@@@@@@@A@@@@@@A@@@@BABA@C@A@@@A@@@@@@@@BB@@B@@A@@B@B@@A@B@@@B@@A@@B@@@B@B@B@@@B@@@@BBB@B@@@B@@@@@AB@@@B@B@D@@ABA@@@B@@B@@@@@@@B@@B@AB@@AAABA@@@AB@B@B@B@BB@@BBB@BA@@@@@A@@@C@@@ABA@E@@@A@@@AB@@A@A@A@@BABB@@@@B@@@@@B@@A@@AA@@@@B@@A@@B@BA@A@@@@B@B@BADA@A@A@@@@B@@ABBB@B@@BBDAFDB@@@@@B@@@B@@@@AB@@AB@@@B@@@B@@A@@@@@A@@@@@@@@B@@DB@BB@@B@AD@@@@@@@@B@B@@@@@B@@BA@@@@B@@@@@BABA@@@A@@@@@AB@@B@@@@@BB@@@B@@@@B@@B@@@BFDB@BB@@@BAB@@ABAA@@A@ABA@@B@@BB@@BB@B@B@@BB@@B@@@@AB@@B@@BB@@@@@B@BBB@@@B@@@@@B@@@@@BA@@B@@@@@B@BB@@@B@B@B@DABADA@AB@@BB@@@@BBDAB@B@@AB@@@@@B@@@B@B@@@@B@@@@BB@@@@B@@@@B@B@@@BABB@@B@@AB@@@@@B@BA@@B@@@@@@BB@@@B@@@B@@@B@@@@@B@@@@@@@@A@@B@B@@@@@@B@@B@BA@@B@@@B@@A@@@@B@B@D@@@B@@B@B@@@@B@@@@@@B@@@@B@@@B@@BB@@BB@@B@B@@@@@B@@@BBA@@@BD@BB@@@AB@@@@ABA@@@@B@B@@@B@@@A@@A@@@A@@B@A@@@@@@A@A@@@ADAB@B@B@@@@@@BB@@@@@BA@@@@B@@B@@@@BA@A@@@@@@@@A@@A@@@@B@B@@@@A@@@@@A@ABABAB@@@@A@@BA@@@@@A@@@@A@@@@@@AAC@@A@@@@@ABA@@@@BA@AA@@A@ABAA@@@@AA@A@@BA@A@@AAAA@@A@A@@@@@@@AA@A@@@AA@@@A@@@@A@@@A@ABC@@@@@A@@@@B@B@F@B@B@D@@C@A@@B@BB@@B@B@@@@@@@@BB@B@B@D@B@@@@@@@@B@BB@@@@@@@B@B@B@D@@A@@@@@@@A@@A@@A@@@@@AA@@@D@DA@@B@B@@@@@B@B@BBBA@@BBD@@D@B@@A@@BB@BD@@@@AB@@A@AA@B@@BBB@@@B@BB@@@@BAB@@AA@@@@@@E@@@@BAB@@@@AAABCDE@@@A@@@CA@A@@@@A@@@@B@B@BAB@BA@@BABABA@@@A@@@@BAB@@AB@@@@@@A@AAA@A@@@A@A@@@A@@B@@@B@@AB@@@@@BA@@@@@A@@@@@@AA@@@@BA@A@@B@@@B@@@@@B@@BB@B@B@@@BA@@B@@@BB@@@@@@@@@B@@@B@@@@BBB@H@J@B@@@BAB@B@@ABA@@@@@A@@@@@@@@@@@@@@@@@@@@@AA@@@@B@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@A@@A@@@@@@@@@@@@@@@AA@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@A@@@@@@A@@@@AAAAA@A@A@@@@@A@@@AB@@A@@@AA@@@A@AAB@B@@@@@B@@A@@@@AA@@@A@A@@AA@@@@AA@B@AA@@A@@@@BA@@@A@@@@@@@AB@@@@@BAB@@A@@BA@@@@@A@@@A@A@@@@@AB@BA@@@@B@B@@@@@@@@A@@@@B@@@@@@@@A@@B@B@B@@@B@@@@@@@B@@@AA@@B@@@BA@@B@@A@@B@@@B@B@B@@A@A@@B@@@@@BA@@@@@BB@@A@@@@@@@@@@BA@AB@F@B@FBB@BB@@B@@@@AB@B@@A@AB@@@DABA@@@A@ABADA@@B@@CB@@AB@@@B@B@HC@@B@@@BAB@@@@AB@@C@@AA@C@@@@BA@@AAAAA@@@AA@A@@@AA@@AA@A@A@A@@@@@A@AAAA@@CAA@@@@A@@CAA@E@@@@@AA@@BABAB@BADA@AB@B@@@BAB@BAD@@ABAB@BA@@B@@@B@B@DCB@B@@@@ADADABAB@DCBAD@@@BAB@B@D@B@B@@@BA@@D@D@@@@@@A@A@A@A@A@@AA@@BA@AA@@@@AB@B@BAD@B@BAB@BA@@@@BADA@@@@FB@@@@@@BA@A@@B@B@BA@@BABA@A@A@@@A@ABA@ABA@@B@@@D@@@BAB@B@BCBAB@BA@AB@@A@@B@BAA@@@CAGCA@AAA@A@A@A@@@@@@CBA@AAA@ABA@ABCBCBCB@@@B@@@B@B@B@@@B@D@D@B@B@@@BB@@B@@ABA@AB@@@BA@AB@@@B@BAB@BABADA@@B@BAA@@@@@A@@@CAE@EB@@B@@@@B@B@@@B@@AB@@AB@A@@AB@@A@@@@@A@@@@@AA@@@A@@@A@A@@@@ABA@@@A@A@A@@B@@A@@@A@@AA@A@A@@AAA@@@@A@@@A@@BA@C@A@@@A@@A@A@@A@@@AB@@@@AA@@@@A@@@@@DE@A@@@@A@AAAA@@AB@@A@A@@@@B@@A@@@A@@B@@@@A@A@@AA@@@@@@AA@@@AA@@@@A@@@@AB@@@@@BA@@@@@@@A@@@@EACAUCCCA@@A@@@A@CDCAA@@@A@@BA@@@@@A@@@@BAB@@A@A@@@AB@@A@A@A@A@@AAB@@A@@BABA@A@@C@@@@A@A@AB@@@@@B@@@@@BA@@@A@@@@A@A@A@@@@AA@@@ABAA@@@@@AB@@@@@AA@@@@A@@@A@CA@@@@@A@@@AC@@@A@A@@@A@AACBA@@CBI@@BK@C@@EM@AAA@@@@@@@A@@AA@@A@A@@@@@AA@@@AB@B@BABAD@@@B@@A@@@AB@BABA@@B@@AB@DC@@B@B@B@B@@AB@@A@@BA@@BA@@@A@@BA@@BA@@@@BA@A@@@@@ADA@@AA@A@AA@AAA@A@@@A@ABA@C@G@@@@@AA@C@A@@@AA@@AA@@@@A@A@@@@@AAA@A@@@@B@@AB@BA@@BADC@@B@B@@@BA@A@@@A@A@@@A@AB@@@@AB@@@DAB@@@@@B@@@@@@@BA@@@@@@B@@BBBB@@@@BB@BA@@B@B@@@B@B@B@@@@@BABA@@AAA@@@AAACAB@A@@@@BAB@BADBB@@@BAB@@BB@B@B@BABABA@@@@BCBA@C@@@@@AA@@@AA@@@@B@@@@@@A@AA@@C@@AA@A@ABA@A@@@@@AA@@AA@@C@@@ABA@A@@@A@@A@@@@A@@@AAB@@AA@@@@BA@@@@@@A@AAA@A@@A@@AACA@AA@@@@@@A@A@AB@BC@@@@A@@A@@B@BABA@@BAB@D@BA@@@@@A@@AAA@@@@ADA@A@@B@BA@@DA@@A@@A@@AAAA@@EC@@@A@A@A@@B@DBB@@AB@@@B@AA@@B@@AB@@@AA@@B@DABAB@BBD@BBB@B@FD@@B@BABBB@@AA@@A@@@A@AA@BA@@@@BA@@BBBA@E@@@ABA@@@@B@B@DBB@@@@@@@B@@A@@BA@A@@@ABA@ABCBADA@@@ACAAA@AC@@@A@@@@A@A@@B@@@@@B@@@@@BA@@AA@A@@AA@@@@@A@@@@A@@@@A@@A@@AA@@@A@A@CB@A@@@AA@AC@@@@@A@@AAA@AA@A@@@@@A@@@A@@FC@A@@AA@AAA@A@@BA@ABA@@@@B@BBBB@ABA@@A@@AAA@@@@@@AA@@BAB@B@@A@@B@@@@@@B@B@@B@B@@@@@B@B@@AB@@@B@@@@@@@B@@@BB@AB@@@@@B@@@@C@@B@@A@@@A@@@AB@BA@A@@B@@@@B@@@@@@@@@BBA@@B@A@BA@@A@@@DGBE@ABAA@@AA@AAAAAA@@@@IGAAAA@@C@CAFCBEIC@@@@A@@A@@@@A@@@AF@BCDCF@@ABA@ABCAE@@@CAAACACAA@EA@CDABA@ABCBABG@A@CFSBC@A@C@IBQ@G@CF@BM@@BEBCBEDC@ADCFGAAGAJMJCBAFCWSCA@@ACA@BAB@@@@ABA@@BCDEBA@@@ABA@@BA@A@@DBB@@BDAB@@@@@BB@BB@@@@@@@@@@@VPDAFEDCHEDCB@JCD@JAFA@@B@FCHBN@L@JAHCD@@@B@B@B@DALAD@D@D@DAF@D@D@D@ZENELCDAJENAJEJCNCDBACAA@@CC@@@@AAAA@@@@@@BA@AAAB@@@@@@AA@@A@@AAAA@@@A@@@@AA@@@AB@@@BAB@BA@@BCA@@A@@@AB@@ABA@@@@@A@@B@@@@@@AA@@@@@@A@@@@@@@@@@B@@A@@@A@@@A@A@AAA@@@@AA@@@A@@@@@@@@B@@@B@@@@@@AB@@@B@@@@@D@@@BA@@@@@@B@@A@@@@@A@@B@@BB@@A@@B@@B@AB@@@B@@@B@@@@@@AB@AAB@BAAA@@@@@A@A@A@A@@A@AA@@@A@@B@@A@@@@@AB@@A@@@@@A@@@A@AB@@@@A@@@@B@@A@A@@@@"],encodeOffsets:[[122290,26523]]}},{type:"Feature",id:"350122",properties:{name:"连江县",cp:[119.539704,26.197364],childNum:45},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@B@@B@"],["@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@B"],["@@@@@@@BB@@@@@@@B@@@@A@@A@@@@@@@@@@@@@@@@@A@"],["@@A@@@B@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@A@@@@@@A@@@@@@@@"],["@@@@B@@AA@@@A@@@@@@@@BB@@@"],["@@@@@@@A@@A@@@@@@B@@@@@@@@@@@@@@@@B@@@@@"],["@@@@@@@@@@@@@@@@@@@@@@@A@@@@A@B@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@B@@@@@B@@@B@@B@@@@@@A"],["@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@B@@@@@@"],["@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@A@@B@@@@B@@@@@@@"],["@@BBB@@@@@@@@A@@B@@@@@@@@@@@@@@@@A@@@@AB@@@@AA@@A@@@@B@@@@"],["@@@@AB@B@@B@@@B@@@BA@@A@@A@@@@A@"],["@@A@@@@@@@@@A@@@@@@@@@@@A@BB@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@@A@@@@@@@@@@A@@@@@@@@@"],["@@AB@@@@@@B@@@@B@@B@@@B@@@@@B@@@@A@@A@@@@@@@AA@B@@A@@@@A"],["@@@@BB@@@AB@@B@@@@BA@@A@@@@@@@@A@@@@A@@@A@@@AB@@B@@@"],["@@@@BCA@@@C@@@@@@D@@@@D@@@"],["@@@@AC@@A@AB@@@@@DB@@@D@@A"],["@@@@B@@B@@B@@@B@@@B@@@B@@B@A@@@@@@@AA@A@@@A@@@A@@@AA@@@@@@A@@@@@@@@@@B@@@@@@@@B@@@"],["@@@B@@B@@A@@B@@@@A@A@@A@@@A@@@A@@@@B@@@B@@B@"],["@@@@@@@@B@@@@@@@@@@@@B@@@@B@@@B@@@@A@@@@B@@@A@@@@A@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@B@@"],["@@@@@B@@B@@@@@B@@@@@B@@AA@@@@@B@@@AA@@@B@A@@@@A@@A@@@@@@B@@@B@@@@@@AA@A@@@@@@@@@A@@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@"],["@@@B@@B@@@@@@@@B@@B@@@@@@A@@@A@@@@@@B@B@@@@AA@@@@@@@@@@@@A@@@@@@@@@@A@@@@@A@@@@@AB@@@B@@@@@@@@"],["@@@@BB@B@@BB@@BA@@@@@@@@B@@@AA@AA@@@@@@A@@@A@AA@@@A@@B@B@@@B"],["@@@B@@@@B@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@BBA@@@@B@@@@@@@@@@@@@B@@AB@@@@@@@A@@@@AA@@@@@@@@@@@@A@@@@A@@@@B@@@@A@@@@@A@@@@@A@@@@@A@@@"],["@@@B@BBBB@B@B@@A@AAA@@CAAB@@"],["@@B@@B@@B@@@@@@BB@B@B@DB@@@A@@@@A@@AA@AA@@AA@@A@@@@@A@@@AB"],["@@@@@BB@@BB@@BB@@BB@@A@@@@@C@@@AA@@@@@@AA@@@A@A@@@@B"],["@@DBBB@@@@@@@A@@@@@@@@@@@A@@@A@A@@AA@@@@@@@A@@@@@@@@A@@@@@A@@A@@A@@B@@B@@B@@@BB@@@@@A@@B@@@@@@@@@@@B"],["@@@@@@BB@@@BB@BBBA@@@@@@@@@A@@AA@@@@@A@@@@@AA@@@@@A@@@A@@@BA@@@@AA@@A@@@@@@B@@@B@@@BBB"],["@@@BBBB@@@@@B@AA@@@@@A@@@@@@@@B@@A@@@@@@@@@@@@@A@@A@@@@@@A@@@@@@AA@@@@@@@@@@@@A@@AAB@@@B@D@@BB"],["@@B@B@@@D@B@@@BA@@@@@A@@@AA@@@A@C@A@A@@@AB@@@@@B@@BB@@"],["@@C@@@AB@BB@@@B@B@@BB@@@@DBB@@@@B@@AB@@@@@DB@@B@@@@A@@A@@AAACAAA@@A@@AA@"],["@@@@@@A@@@@@@@A@@@@@@A@@@@AA@@@@A@@@BB@@A@@@@B@@@B@@AB@@BB@@B@@@@@B@B@@@@@D@B@@BB@B@B@@A@A@@AA@@@AA@AA@@@@A@A@@B"],["@@A@A@@B@@BB@@@@BB@@@@B@B@@@BBA@@@@B@B@@B@@B@@@B@B@@A@@@@B@@@@@@@B@@@@@B@@B@@@@@BA@@@@@A@@@@B@@@B@@@@@B@@@@A@@@A@@BA@AB@B@@AAAA@AAA@AAC@CAA@@A"],["@@@B@BBDBB@@BD@BB@B@@@@@BBDDB@FDB@@@BA@AAAA@ACA@AA@AAA@ACAA@@@AA@AAAA@C@"],["@@@B@@@BBB@BBBBD@@B@@@@@B@@@BA@@@@BB@BB@@BB@B@@AB@@@B@@@@AACAA@@A@@@@@@A@AA@@AA@@BA@@ACA@AA@CAAB@@@B"],["@@CD@B@BB@BBBBBABAB@B@B@B@B@B@D@@@B@@A@AAA@@BA@@AA@@AA@@@A@@@C@@AAA@AB@BA@KH"],["@@@AAA@ACACAA@A@@AAAC@@B@@@B@@@@A@C@@B@BBBB@B@BB@BBDDBBDBD@BBBDBD@B@@@BAA@AAAA@A@A@ABA@ACAA@@A@@B@B@"],["@@CBABCD@D@BBBDBBBB@@BABAB@BBBFDB@@BDBB@B@BCDI@A@A@ACA@A@A@@BAAAACCAE@CA"],["@@BC@AAC@AB@AEBA@A@AEAABBD@FABCFABA@CBA@@B@DABA@EBCBBBBB@DBBB@B@@BFDD@D@@B@FBBF@H@JADCBE@CAAEA@@ABC@AAACAACA@CBC"],["@@BFBBDBD@D@BAB@@ABACC@ABAD@DBB@B@BABEDCBC@AAAAACBABA@A@A@AB@BAB@@AA@A@A@AEEAAAAAA@CAAA@@BA@@BAB@AA@A@C@@BA@@@AABA@AAAA@CBCF@DDB@B@BAB@B@@B@B@B@BBADCBC@A@ACA@AB@B@D@D@BABBBAB@@A@AA@CAAACAACB@@ABBD@B@B@BABBBB@DBB@BB@@AB@DBBD@B@BA@@DBBA@@@ABA@@BABA@A@C@AAC@C@AB@B@B@B@BBB@B@B@B@@B@D"],["@@AAE@EACACACAG@G@EACACCAE@C@CAC@ABA@A@A@C@@AAIAA@A@AB@N@F@DDJBB@DCNBB@@B@DBD@FDDDD@D@DBB@JJDBFHBDD@F@D@@ABABA@@D@B@BAB@@AACBA@ABAB@D@F@B@BA@A@ECCA@AAAA@A@@BA@A@@@A@AB@BAB@BA@AAAC@C@ABABGLABA@AAAA"],["@@A@CDC@ODGD@FBFHJBHC@@B@DFD@HAD@HBFB@F@DADCB@F@DA@A@ACAAEAC@AFAD@BA@AH@BABCD@FDBHDDDDJDT@JC@AACAABACC@CECGKC@ABCBACAACCCCCCEAC@CBCBCACC@A@AEAEA"],["@@B@@A@CA@C@A@@ABA@@@C@ADA@AAC@EA@C@MEC@ABBBAFEBKBE@EDE@KBABADAFCDGFCBCDADBF@@BL@H@DDB@@D@@@B@DDF@F@PBDCHCBEDGH@@CFGD@LBHAFCB@DBD@D@B@@A@CCAEBC@CAAE@A@A"],["@@C@EAGCG@A@AF@FAFCDGFA@GBIBG@OIICkOECAC@C@ABCBABAHAJAD@DC@EAIEIKKGGECAAG@CBGFEFEHIHIFI@CAGCEA[IOCaIYCOAI@mGECECACAEBEDGJKDCDABE@EAGIEAAGAACAC@E@A@C@IAICCAA]GMCCAEECESSA@WIGCA@ECAAAA@A@CAG@I@CBCDEDCDCB@BA@ABIDMM@gEA@_GECGC@AEACGCEMJA@BBCB@BADA@@@@@A@@@A@@@@BA@@BA@@@A@A@AC@@A@ABA@@B@@@@A@@@BCB@AACB@@ABAA@BA@CBA@@@@BA@@@@@@BABA@@BABAB@B@BB@@@@@@B@@AB@@AA@@ABC@@@@@A@AB@B@@@BABA@@BA@ABA@@BAA@@A@@A@@@@ABCB@@AB@BABA@@@A@@B@@ABAB@B@@@AA@@@@@@@@B@@A@@@AA@AA@@BAAA@@@AB@@@BAB@@@@ACA@@@ACA@AA@AA@A@AB@BA@CA@@A@@@@A@A@@A@@@AAA@A@AB@@@BA@A@@@@BAB@BA@@@@BAD@@A@@@@@A@A@C@A@@BA@ABAD@@@@C@CBA@C@@@A@AAABA@@BA@ABC@@@A@C@@@AB@@A@ABB@@B@@@BAFA@@BA@A@ABBB@ADBB@@@B@B@@B@B@@@D@D@B@@@@@B@B@@@BA@@@A@ABA@A@@B@@AB@@@@A@AB@@ABAB@@@B@BA@@B@@@@@@@BAB@@@@@@@B@B@@@@@@A@@@@@C@@@@@AB@@A@@@AC@@@@A@A@A@ABA@@BA@AAA@EA@@ABA@@@AA@@C@A@A@C@C@A@@@@BA@@B@@B@@B@B@@@BB@@@@B@@@@@@AB@@A@@@A@@@A@@@A@AD@@@BA@@BA@CBAB@@@@@@BBA@@@@@@BA@@@DB@B@@AB@@AAA@@@A@A@@@@@A@@AA@@@@@@AA@AAA@@@@B@B@@@B@@B@BB@@@@BB@@@B@@@@B@@B@@@B@@@@B@@B@@@B@@@B@@@@AB@@A@@@C@AB@@@B@@@@@BBB@@AB@@@B@@@@AB@@@@@@B@@FBHBJ@@AB@@@@A@A@@BA@A@A@A@@B@B@BBB@BA@@BA@@@@@AB@@@B@@A@@@ABA@@@A@ABA@@@@B@@@@A@@BA@@A@@@@@@A@@@@@A@AA@@A@@@A@@@@BAAAAA@A@@@@B@@@@@@ABA@@@A@@A@@@@A@@@@@@@@B@@@@@BA@@@@@@@AB@@@@A@@BAB@@@B@@@BA@@@AB@@@@A@@@AB@@@@@BB@@BB@BB@@@@BB@B@@B@@BAD@@@@A@@@@@A@@@A@@@A@A@ABA@@@ABA@@B@@@@@@@D@B@@@@@BB@D@@BB@BB@@A@@@@@AB@@ABA@AB@@A@@@ABAB@B@B@@@@BB@@@@@BDBB@@B@@@BAB@@A@C@A@A@@BA@ABA@@@A@@BB@ABABA@A@@B@@A@@@BB@BB@B@@B@J@@B@B@BA@@@@@B@@B@@B@@B@@BB@@B@@@@@@@BA@@@AB@@A@AB@@@B@@@@@B@B@@@@@B@@B@@B@@@B@@@B@@BBBBD@@@BB@@B@B@@BBBB@B@@@@@B@@BB@@@A@@@@@@B@@@@B@@@@@@B@AAB@@@@@A@@@B@@A@@B@@@@A@@@BB@@BBBB@B@@@B@@@AA@@@@@@BA@BB@@ABA@@@@BA@@AAB@@A@@AA@AB@@A@@@A@A@A@@BA@CD@@AD@@AA@@AAA@@@@@A@A@A@@@B@BBB@@BB@BB@@A@BB@@AB@B@@@@@D@@AB@@@B@@@@AB@@@@@B@B@@@@@@@B@@@@@@@B@@@@@@@B@B@@@@A@@@@B@@@@@@A@@@@@B@@@@@B@@@@@@@@BB@@@@@@B@@A@@@@B@@@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@B@@B@@@@B@@@@B@A@@B@@@@@@@@@@@BA@A@@@@@@@@@@@@@A@@@@@A@BB@@@@A@@@@@@@@BA@@A@B@@@@A@@@@@A@@@@@A@@@@A@@@@@@A@BBA@@@@@@@@AA@@@@@BBA@@@@@@@A@BB@@A@@B@@A@@A@@@B@@@@@@@@A@@@@@A@@AB@A@@@@@@B@@@@A@@@@@BB@@@@B@@@@@@@B@@@A@@BA@@@A@@@@@@@@@B@@@B@@@@@@@@@AB@@@@@@@@@@@B@@@@@@@@B@@A@@@@@@@@@@@B@@@@@@@@@@@@@@A@@B@@@@@@@@@@@B@@@@@@A@@@@A@@@AC@@@A@@@@A@@@@AAAAA@ACAAAA@@B@@@B@B@B@B@B@@A@A@@A@A@A@@BA@A@@@@@@AB@BA@@@@@@D@@@@@@@BA@@@@AA@@AA@@@@A@@@A@@@@AA@@@@A@@@@AA@@@@@A@@A@@@A@@@@A@@A@@@AA@@A@AAA@@BAD@@@B@@@BAB@@B@@B@@A@@@A@AD@@DH@JGBA@EBG@A@AB@@AB@@A@AA@@@AA@@@A@A@@BABA@@B@BBB@@A@@B@@@@@BB@A@BB@@@@B@AB@@BB@B@@B@@@B@B@@@@B@@BB@BBB@@BBBB@@@B@D@BAB@@@B@@@@D@@@@F@BAB@@@BA@BBA@@@@@@@@B@@@@B@BBBABBB@@@AB@@@@@BA@@@@B@@@@A@@@@@@B@@A@@@@@@B@@A@@B@@@@@@A@@@ABA@A@C@A@B@@@@@B@@@B@@@BB@@@@B@@@@B@@B@@@A@@@B@@B@@@@@B@@@@B@@B@D@@@B@B@F@@@B@B@@@B@BB@@@@B@@@@B@@@@@@BB@@@BB@@B@B@B@@@D@B@@@B@BB@BB@@B@@@@BB@B@B@@@B@@@@@@B@@@@A@@@A@A@@@AA@@@@A@@AA@@@@@A@@@@A@@A@@A@@@@@A@@A@@A@A@AB@@A@C@@@AAAA@@@@AABAAA@A@A@@@@@@@A@@@@@@@A@@@AB@@@A@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@B@@@D@BA@@B@@@HC@B@@BB@@@BAB@@@BA@AB@BBB@@AD@@@B@B@@@BB@B@@@B@B@B@@@B@BB@@BB@@@B@B@@@BB@@@AB@B@@BBB@B@B@@@BB@@BB@@@B@B@@B@B@B@@@@@DBB@@@B@@@@@B@B@@BB@@@B@@@B@@AB@BAB@@@@@B@@@@@B@@@B@@@@@BA@@B@@A@@B@@@BA@BD@BBB@DB@@B@@@DCBA@A@@BAB@@@BCBA@@B@D@D@B@B@BBB@B@@BD@FBD@@@@@@B@@A@A@A@@B@@@@@@D@B@B@B@B@BBB@@@BB@@B@BB@@B@BB@@BB@B@B@@A@@B@@B@@@BBB@@@D@@@B@@A@AB@@AB@@@@BBBBB@@@B@@BB@ABA@@B@B@@@@@B@@@BB@@@B@@B@@ABAB@B@@@BBDB@@B@B@B@B@@@CH@BBB@@AB@@BB@@@@B@B@@@B@B@B@B@B@@@@@@A@@@@@A@A@A@C@@@@@@B@@A@ABA@@@AAA@AA@@A@A@A@@BC@@@@@A@@@AAAAA@@AA@AA@AA@@A@@@BABA@@@@D@@@@AB@@@@@B@BBB@@@BA@@DB@AB@BA@@B@@@BA@A@@@ABA@@@A@AA@@@@@@AB@@@@@@A@A@@A@@A@@AA@@@A@@BA@@@A@AB@@AB@@BB@@@B@@@BB@@@@BB@@B@@@BB@@@@B@@@B@@@B@@B@@@B@@@@B@@@@@B@@@DB@@@@@AB@@@@@B@B@@@B@@@B@@A@@FABADBBA@@@A@@@@BAB@B@@@B@DB@@@@D@B@B@B@@@@BB@B@@B@@D@@@@@BBB@BBDD@BB@@@BB@@B@@B@@BABAB@B@@@B@@@B@B@DAB@@@@BB@@B@@@BBB@@@BB@@@DB@@@@@B@@BB@@@B@@A@@B@@@@BB@BB@@BBBDBBBD@@@BAB@@BD@BBD@B@FBDB@@@@BAB@B@B@BB@@B@B@@@B@@BB@@@@A@A@@AA@@@A@@B@BA@A@AB@@AA@@@BAB@@@D@B@@@@@D@@@B@@@BB@B@B@@B@@@B@@@@@@BD@DBB@@@B@@B@BBB@@@@DB@@@@BBB@@BB@@@BBBBB@@@@@@@@@@@@AB@@@@@@@B@B@@@B@@@B@@@B@B@A@@A@@@A@@A@AA@@AAA@@A@@AA@A@@AAA@@@A@@AA@@@A@@A@@@ABA@AB@@@@@@A@@AA@AA@@@@AB@B@BBB@B@@@BA@@DB@@B@B@@@B@@@BBB@@BDBDDD@@BB@@@@ADAB@BB@@BAAA@@BA@@@@@A@@@A@A@@@ABABA@@B@B@B@@@B@DAB@@@B@BA@@@@@A@@@@@A@@@@AA@@@@B@@@@@@AA@@AA@@@@@A@@@A@@AA@@@AA@@@@@@A@BC@A@A@AAA@AA@AACAA@AA@@@A@@@@BAB@B@BABA@@@AAA@@B@@AB@DA@AB@@@B@B@B@B@BB@@B@AB@@BB@@BBD@@@@@@B@B@@BB@BB@B@@@@B@@@@@B@BB@DB@@@@BB@@@A@@B@B@BABA@@B@B@@@@A@@AA@@@@A@@A@@B@@A@@B@DB@@@@B@B@B@@@@@AA@@@@@A@@@@B@@@@A@@@@@A@A@@@A@@B@@@BA@@DB@@B@@@BB@@@@@@@@B@@@@@@B@@BBB@BBBB@@B@B@@BBBB@BBB@@BFB@@@B@@@@BB@BBA@@DB@B@@@B@D@@@BBB@@BBB@B@@@@@@@@B@@@B@@@B@@B@@@BBB@@@B@@B@@B@@BB@D@@BD@B@B@DAB@B@B@@@B@B@BBB@@@BBBABBB@D@B@DBB@BBB@@@B@BD@@AB@BA@@B@B@@@B@@@B@@@B@B@BB@@B@@@B@B@B@@@B@@BB@BA@@BA@@BAB@BBB@@@B@BBB@B@B@BBB@BB@@B@@B@B@LFNDVOZUDADCTYJKJK`_VIJ@tRhVbTHLBFFJ^JXF@@FEDCBADEFGDEHKJKBEBE@GBGBCDEHCFEJGDCDEBAACGGAC@A@CBALCFAD@H@F@L@NDNDZLJDFBHDFBFBDBJ@F@L@FA@CCKGOCICEMIWUQKOIGE@E@CAEEECAG@IAQBIBC@G@E@"]],encodeOffsets:[[[122919,26870]],[[122899,26847]],[[122918,26872]],[[122684,26858]],[[122846,26708]],[[122756,27019]],[[122684,26856]],[[122539,26774]],[[122686,26859]],[[122780,26924]],[[122774,26924]],[[122689,26858]],[[122776,26927]],[[122971,27014]],[[122900,26866]],[[123102,26881]],[[123104,26891]],[[122688,26897]],[[123295,26793]],[[122532,26772]],[[122703,26916]],[[122705,26880]],[[122896,26865]],[[122827,27009]],[[122857,26818]],[[122597,26897]],[[122755,26998]],[[122779,27057]],[[122685,26889]],[[122855,26792]],[[122500,26759]],[[122598,26867]],[[122853,26998]],[[122762,27045]],[[123107,26964]],[[122880,26870]],[[122508,26742]],[[122798,27046]],[[122867,26893]],[[122549,26752]],[[123388,27005]],[[122887,26845]],[[122808,26762]],[[122528,26775]],[[122754,26942]]]}},{type:"Feature",id:"350123",properties:{name:"罗源县",cp:[119.549776,26.489558],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@BB@@@@@BB@@@B@@A@A@@@ABABA@A@@@@B@@AA@@@@CAA@@A@A@@@@@A@@A@A@@AAA@@@A@A@@@@C@AA@@AA@@BAA@@@AAA@A@A@A@@@A@@BCBA@@BA@@@BB@B@@ABABA@A@AB@@@@@B@@BBB@DBBBB@@BBB@B@B@BADB@@@@@@@BB@@B@@BB@@@B@@@@@B@@BB@@B@@@@A@@@@@BB@@@@@B@@@@@B@@@@ABA@@@A@CBA@@@A@A@A@@@ABAB@B@@@B@B@@@B@@@@AB@@BBAB@@AAA@CB@B@@A@@AC@CCCA@AA@AA@@A@@@A@A@@@CA@@AB@@A@A@AAA@A@@B@@B@@BBB@@@B@@@@A@@BAB@B@@@BB@@@B@@BB@@@B@BB@@@BBB@@@BB@BB@@BBB@@@@B@@@BB@A@A@@@A@@@A@@@A@A@@@@@@@A@@B@@@@@@@@@@A@AAAA@@A@@AA@AA@@@@CA@@@@AA@A@AA@@@A@CAC@@A@@@@A@@@A@@@@A@AAA@@A@@@C@@@@@A@C@@@A@AB@@B@@BA@@B@BABA@@@@B@@BB@@@B@B@@A@@AA@@@A@A@@@AAA@A@A@AB@@@@CAEAA@C@AAC@@AA@AB@@C@AACAAA@AA@@AAA@@@@@AB@@@@A@@AA@@@A@@@@CA@@A@@A@@AA@A@@@AA@@A@@A@CBA@A@@@A@@@A@A@ABAB@@@AA@@@AA@@A@@ACCAAA@AA@@@@C@@@@AA@A@@A@@A@A@A@C@@@@@CAA@@@A@A@AB@@@@@B@@ABCAABEB@@@BA@@@A@@@A@A@@@@@A@@B@@@@CA@@A@@@@@A@@@@@@A@@@AA@@@A@@@A@@@@@AA@@A@@@AA@@@@AA@@A@@@A@@AA@@BA@@B@B@@AB@@@B@@BB@@@BB@@@@B@B@@@@A@@B@@@@B@@B@B@@AB@B@@@BAB@@A@@@ABA@@BCA@@AB@@A@AAA@@@@@A@@B@@C@@@@@ABAB@@B@@@BBB@@BBB@@BBBB@B@@@B@@@@AD@@@B@B@BB@@BBB@B@@AB@B@BA@@@@@@@@D@B@B@B@@@@@B@@@@A@A@A@A@A@@@A@A@@@@@AA@@BA@@AA@ADG@@A@A@A@A@@@CAAA@@A@A@AB@BA@@@@A@@AA@@A@@@@@A@A@@@AB@BAA@@@A@@AAAA@A@@A@@BA@@B@BA@@@C@@@A@AA@@A@@@@AB@@@@A@AAA@@AAA@@@AAA@@@AA@@A@AAA@A@A@A@C@@@@@@@@AB@B@B@@@@A@@@@C@EAC@@AA@A@AAA@A@C@C@A@@@ABAD@@A@AB@@@BABCD@@A@@@CAA@AAC@@AAB@@A@@@@BA@@@AB@@@@A@@@A@@@@@A@@@@@A@ABA@@BA@@@A@@@A@@AA@A@@@@@A@@@A@CA@@@@A@A@A@@@@A@A@@AA@@AA@@A@A@A@AA@@@ABA@@A@@A@@@A@A@@AA@@AAA@@@A@A@A@@@A@A@@A@@@A@A@@BC@@AA@ABAB@@A@@BA@A@@AA@@@AGD@@A@@@ABC@@@A@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@B@@@A@@B@@@B@@@@@@@B@@@@@@@B@BBBABBB@@@@BBBB@@D@B@@@BAB@B@@@@BB@@@@@B@@@@BB@@@@@@B@@@@BB@@@B@@B@@B@@@B@B@@@B@@A@@@@@@@@A@@@A@AAA@@@@@AA@@AAAA@@@A@C@@@A@A@A@@@AA@@A@@A@@@@A@@@@@@A@@A@@A@A@@@A@A@@@E@A@A@@@C@AA@@@@@@B@B@B@BBD@B@@@B@@A@A@@@AA@@@@@AAAB@BA@@@@@AC@@AAA@A@AA@@C@@A@A@@@A@@@AC@@A@@@CAADAD@@@@CBAB@@AAA@@@@@A@AB@@A@@@@BA@@AA@@AA@C@@A@@@@@@A@@@@@@AA@@@@@@@@@@BBBA@@AA@A@@@@@@AAC@@A@A@@A@@@A@A@A@@@@A@@@A@@@A@@@@B@@AA@ACA@@@@@ABA@@A@A@A@@@@@AA@@A@@BAD@B@@CDA@ABA@ABA@@@@BA@@BABA@ABADA@A@AB@@C@@@A@@@AB@BAB@B@B@@@B@BABAB@@ABA@A@@@@BAB@@@@@@EA@@@@CBAB@@@@ABA@ABA@C@ABA@A@@B@@B@@BAB@@BB@@@B@B@B@B@B@@@@C@C@@@AB@@A@A@C@A@A@AB@@C@ABCDA@ABCBCB@B@@A@A@CDA@A@@@A@@@ABA@AB@BC@ABA@AB@@A@A@@BCBABA@ABAB@@BB@@@@F@B@DB@@@B@@B@DB@@BBBBB@@@@@B@B@B@B@@BB@@B@@@BBB@@B@BBBB@@AB@@@@@DBB@@@DA@@B@@A@AB@@A@@@GDA@A@@@A@@BA@@DA@@@CBAB@B@B@@ABCB@@A@@B@BA@A@@B@@A@@@AAA@EAA@E@A@@BAB@@@@@@@@@@@BA@@A@@@@AB@@@@A@@@@B@BA@A@A@@@A@@@@BA@@@AB@@A@@@BB@@A@@@@@@@A@@@A@A@A@@@@B@@@@@@A@@@@@@B@@@@@@A@A@@@@@ABA@@B@@@@@B@B@@@B@@@@AB@@@BA@AB@@@@A@@B@@@@@@@B@@AB@@@@@BB@@B@ABB@@@@BB@@@B@B@@BB@@@@@BA@@@@@A@A@BBB@@@B@@B@@@BA@@B@@@B@@@@@B@BBBBB@B@@B@@@@@@@@B@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@BB@@@@@@@@@@@@@@B@@@@B@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@A@@B@@B@@@@@@@@@@@@@@@@@@@@@B@@@ABAB@@@B@@B@FBBB@DBD@B@@@BA@@BAB@BA@@D@D@@@B@@BB@@AB@@@B@B@@B@@@B@B@B@BBB@@@@@BB@B@@BB@BB@@@@B@@@DA@@@@@BD@B@B@DBB@@@BDB@B@@BB@B@@AB@@@B@@BB@@@@B@BAB@@@B@@BB@B@@ADB@@@@BB@F@@B@@BB@B@BBB@@@B@@@@BB@BBB@@@BB@@@@AB@@@DABABAB@BAB@@@DABA@@BA@A@@@A@C@@BA@@@AB@@A@@@ABA@@@@B@@A@A@@A@@@@A@@@@@@@@@@@@@@DC@@@@@A@@BA@@B@B@@ADA@@@@@@B@@@B@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@AA@ACAAC@@@A@@A@@AA@@ABA@ABA@@@@@@@A@@@@B@BAB@@@@@B@@A@@BA@@@@B@@A@@@A@@@A@@BA@@BCBABA@@B@@@@@D@@A@@B@@@@B@@@@D@@A@@B@@B@@@BBBBB@@@@B@@@@@B@@@D@@@B@@@B@@@DB@@AB@@BB@@@@B@@B@@B@B@B@@@BD@@@@@B@@BB@B@@BB@@AB@@@BBB@@AB@@B@DB@BB@@B@@@BBB@@@BBB@@@B@BB@@@@@BAB@B@B@@A@@@@BAB@@@@@@AB@@@B@@@B@@@@A@A@@B@BA@@BB@ABB@@@@B@BABC@@@@BAB@DAB@@AB@A@A@@ABA@@@@B@@@@@@A@@@@@@@@@@B@@@D@BB@@BA@@BB@@AD@@AB@B@BBD@B@@@B@D@B@@@BBBBB@B@B@@AB@@@B@@B@@@@B@BB@@BA@@B@B@@@@@B@@@@@D@@@B@@BBBB@B@@AB@BB@@@@B@@@B@@@DB@BB@@BA@@DBB@@@@B@BA@@@@B@B@@@@ABA@@D@B@@B@@BB@@@B@@@B@@@@BB@@@@DBBBB@@D@@ABBDB@BBBB@BBBBDBB@DBB@@@@BBB@@@A@A@@@@B@D@@@B@@@B@@@@@BA@@@@BA@@@A@AA@@A@@@A@@B@@@B@@@@@@@B@BA@AB@@AA@@C@@AA@@@A@@B@BABA@BB@@@@@B@BD@@DBB@@@B@BB@@@@BAB@BA@@B@@@@@BBB@@B@@@B@B@@@@B@B@BB@@BB@B@@B@@@B@BA@@D@B@@@BDBBB@@@@@@B@BAB@@ABCBA@@@@@@@BBBB@@A@@B@@BB@@B@B@@@B@B@@@BABA@@@@@B@B@@@D@D@@B@@@BAB@B@@@B@B@B@BA@@AA@@B@B@@A@@BB@@B@@@B@B@B@@@B@B@@@B@@@@BB@@AB@B@@@B@DB@@@@AB@@BBB@@@@BBB@B@BBB@@@@B@@@@@@B@@BBBB@@@B@@BB@B@@AB@@@B@@@B@B@B@B@@@@BB@BB@@@@@B@B@B@B@@@B@BBB@@@B@@@BA@@DB@@BA@@BBB@@AB@BA@@BA@@B@F@B@BAB@@@B@@ABAB@@@@@@ABB@@@@@@BBB@@@@@BB@@@@@@BBB@@@@@B@BB@BA@@BB@@@@@A@ABE@A@@@@B@@@BA@@@A@@@CBA@@BA@@@A@@@@@A@@@AD@@A@B@@BB@DB@@@AB@@A@@@@@@B@B@BB@@@B@@ABAB@@@@BBB@@@@BB@@@B@BADB@@@@BA@@BA@@B@@@B@@@@AB@@@@A@@@@@A@@@A@@BA@@@@@@BA@AB@@@@@DA@@D@@@@A@@BA@@@B@BB@B@B@AA@@B@B@@BBABB@@@@@@B@D@B@B@B@BBB@B@@@BB@BB@B@B@B@@B@@@@@DB@@@@@D@B@B@@A@@B@@B@@B@@@@@B@@@@A@@D@@@@A@@B@B@B@D@B@B@@@B@@@B@@AB@@@@@B@@AB@@@F@@BB@@@@B@BB@B@@BB@@@@@BAB@BABAB@@@B@@A@@DAB@BAB@BA@@D@@@B@@@B@@@B@@A@A@@@AA@@@@A@@@@D@B@BA@BB@B@@@BA@@D@@B@@BB@@B@B@BA@@@ABA@@BA@ABA@@BAD@B@@@@A@@@@AA@@@@@A@AB@AA@@@A@@@A@A@A@@B@@@@@AA@@B@@AB@@AB@@A@A@@AA@A@@@A@@D@FBB@@@BA@A@@@@@@BA@@@@BABA@@@@@A@@@@BA@@@A@A@@@@B@D@BADA@@B@@@@@BA@A@@AA@@@AB@@@BAB@@ABABA@@@A@@@@@A@@@A@@@@@@AA@@A@AAA@@@@@@A@@A@@@A@@@A@@AA@BAB@@A@@@@@@B@@@B@B@@A@@@@@@@@@A@@B@@A@@@A@@@ABA@A@@@A@@BAB@@AB@@AB@B@BA@@B@B@B@D@BBB@@@@A@@B@@@@AB@@@@ABA@@@@B@B@@BB@@AB@@@DAB@B@@@B@@@BAA@@AB@@A@A@A@AA@@A@@@AB@@A@@AC@@@@B@B@B@B@@@@@BBBBBABB@@B@B@@@DCBAB@@@DCBA@@BAB@DB@@@@B@@@BBD@B@B@@@@@BABA@A@@@@@A@A@@B@B@@@B@B@@@B@@A@@@@B@@@BBB@@BB@@B@@B@@B@@BBB@@@DB@@@@BB@@B@@A@@@A@@@@AC@A@@@@B@@@BBB@@A@@B@@AA@@AB@B@D@BA@@@@B@@AB@AA@C@A@@@@B@DA@@B@@A@A@@@@@@@A@@B@@@B@@@@@@@B@B@B@BBBABA@A@@@AA@AA@@@@@@@@@@B@@ABA@@B@B@B@D@B@@BB@@@B@DB@@B@BBD@BB@@BB@BB@@@@@BBB@B@@@B@BA@A@@BBB@@@@BB@@@BBBAB@BABADA@A@@B@BBBB@A@@@@@@@@BB@B@B@@@AB@@@B@@@@@@A@@BA@@B@B@@@B@@BB@@@@@@BB@@@A@@B@@ABB@@@AB@@BB@@@B@BBB@B@@B@@B@@@@B@BA@@@@BB@@B@BA@@B@@A@@B@@@D@BBBBD@@B@BBB@@B@@@@@@B@@@@@@BB@@@B@BA@@B@@@B@B@@B@@@BD@B@@B@@@@B@@@@@B@@@@@B@@B@@BA@B@@D@@@B@@BD@DBB@@@@@@BB@@@@@@BBBB@@DB@@B@@@BA@@@@D@B@@@B@@B@@@@B@BBBB@@@@BB@BB@@B@@D@FB@@FA@@@@AA@@B@@@@AB@@CB@BA@@BBB@DBBBB@B@BCB@@A@A@@B@B@@BBAB@DAB@@@B@@AB@@@@AB@@@@@BA@@@@DB@BB@@BB@B@BB@@@AB@B@@BBBDDBB@@BBB@DDB@@B@@@@@@ABA@ABA@@@@B@@@@@BB@@@@@ABB@@@@BBA@@B@@@B@B@@@B@@A@@BA@BB@@@@BB@BAB@@@B@B@@BBBB@BABB@@B@DBBABB@@B@@@B@@@BA@A@@B@@@B@@AB@@@BA@@BAB@B@@@B@BA@@BA@AB@DAB@DCB@B@@ABBB@BAB@F@HKJGDK@A@AACJWHORgBEHYFQTuBCBIBGAICEBGBCWE]IEIAEGKaSgUsQI@UJ_`ILILSZCDCBYVUPMCKEA@A@@@@AA@@AAA@A@A@AAA@A@A@@AA@ABA@AB@@AB@@AAA@@@A@@@A@A@A@@@AA@@A@A@A@@@A@@@A@@@A@AB@@ABA@@ACA@@@A@AAA@CAA@C@A@AAABAA@@A@AAA@A@@@A@A@A@CBA@A@C@@AC@A@@AA@@@@AA@@@A@AA@@A@@@@A@@@A@@@A@@@@@@A@A@AA@@AA@A@@@C@A@@@ACA@@AB@AAA@@@@@A@@EA@AA@AAA@AA@AA@A@@@AAAAA@AA@@@A@@@@A@@@@@@@@@AA@@A@@@CA@@AB@@A@@@@B@@@B@B@@@@@B@@A@@@@@@B@@@@BB@@@@A@A@A@@@@@CAA@@@@BA@"],encodeOffsets:[[122450,27022]]}},{type:"Feature",id:"350124",properties:{name:"闽清县",cp:[118.863361,26.221197],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@D@@@@@@@@A@A@@@A@@B@@@@A@@@@@@@@BA@@BA@@@A@@@A@@@@@A@@A@@A@@@@@A@@@@@A@@A@@@@@@@A@@@@A@@@A@C@AB@@BB@@@@@B@@@@@@A@@AA@@B@@@B@@A@@@@A@@@C@@@@A@@A@@@A@@@@@@@AA@C@@A@C@@@@A@@BA@A@@@@A@A@A@@@@@AB@@AB@@AB@@CAA@AAA@A@@@@@A@A@@@A@A@@@@@@B@@@@@B@@@@@B@@@@@@@BABB@BBB@@@A@@@ABA@@@AB@@@@A@@@@BA@AB@@@@@B@@AA@BA@@B@@@BBB@@@@@@@B@@@@@@B@@@@BB@@@@@@BBA@@@@BB@@@B@@@@ABB@@@CB@@B@@BA@@@@@@@B@@B@@A@@@@B@@@@@@A@@@@@@@@B@B@@@DBB@BAFBBBB@B@@@@A@@@A@@@A@A@@@@@A@E@@@A@@AA@@@@@@A@A@A@@@AA@A@AAA@@A@@@A@@BA@@@@@A@A@A@@AA@@@A@@A@@@@@@@@@@@A@@@A@@@@@B@BA@A@@@A@@BA@@@A@@@@@AA@@@@A@@@@AB@@@B@@@@AB@@AA@@@@@@AA@@@A@BA@@@@B@@@@@@A@C@AA@@AA@@A@AB@@@@@@@AA@@A@@@A@@A@@@@@@@A@@@@@@A@@AA@B@@@BA@@AAB@@AA@BAA@@@BB@@@@B@@@@@@BB@@@@@BABA@@B@@@AA@B@@A@@@@A@@A@@A@@@ABA@@@C@@@A@A@@@@BA@AB@@@@D@@@@@@B@@ABA@@@BB@B@@@@@@ABAB@@@@@B@@@BA@@@AA@@AD@BAAAB@A@@@AACA@A@A@C@@B@@@@@B@D@B@B@B@@ABB@@BA@@@@@A@@B@@@@@BA@@@AB@A@@@AA@@@@HA@A@A@@@@@A@@@A@@BA@@B@@@B@@@@@B@@AD@@@B@@D@@ABB@B@B@@@@AB@@@B@B@@@@@@A@AB@@AAA@@A@@@AAA@@A@@AA@@AA@@@@@@A@@@@@@CAA@AAAA@@AA@@AA@@@B@@@@@BBB@BA@AA@@AA@A@@A@A@A@AA@@A@A@@@@@A@A@@@@@@A@@@@A@@@@@CC@@@AA@@@@@A@@BA@@A@@@@AB@@@@A@@@AAA@A@@AA@CAA@A@AAA@@@AA@BA@A@CAA@A@@A@@A@@@A@@BAB@@@B@@@B@@AAA@@@AB@@@D@@@B@@@D@B@@BDBBB@@B@BBB@B@BA@A@BB@BB@@B@B@@@@@@@B@@@@@@@@@BB@@@@@@@BB@@@B@@@@A@@BA@@DABABAB@B@@ADB@@BA@AB@B@@A@@B@@@B@@@@@B@@@@A@@@@B@@@B@@D@@@@B@@@@@B@BA@AA@@@AA@@A@@@AAE@@ABA@@@AB@@@B@BA@CA@@AA@@A@A@A@@@@B@B@B@@B@@B@@@@@BA@@BAB@@B@@B@@@BABA@ABAB@@A@@@@B@@@B@@@@@B@@@@@@@@AB@@@A@@@AABCA@@@@@@A@A@@@A@@@A@@@@@@@@AA@@@@BAB@DBBA@@AA@@A@@AA@@@A@A@A@@AAAAAABA@@AAA@A@A@A@@@AD@@A@AAA@@C@@@@@@ABBB@B@@BB@@BB@B@B@B@@@@@AA@A@@@@@A@@@@A@@@AA@@A@@A@AAA@@AA@@@A@A@@@CB@@AAC@@@A@B@AAB@@A@@@A@@@AB@AAB@A@@A@A@@A@@@@@@BAA@@@A@@A@@AA@@@@A@@A@@A@@BA@@A@@A@@@@@@AB@@ABA@ABA@@AA@@@A@@@ABA@@@@BA@AB@B@@CD@@@@A@@A@@A@@@@@@@@AA@A@@@A@@BAB@B@BA@AAC@@@A@A@@BA@@D@@@B@B@@@B@@AB@B@B@@@BAB@B@@@@CDADAB@@ABA@A@A@@@@@C@@@A@A@@B@@AB@@@@@B@@A@@BBBADB@@@@B@@A@@@AB@@@@BB@@@B@@@B@B@BB@D@@BBBB@@@@BBB@BA@@B@B@F@B@B@@@B@@BB@@@B@B@@A@BB@B@@A@@B@@@@@B@@@@@BA@@@A@@BAB@BB@@@@BA@@@AB@AABBBA@@BB@@B@@@@@B@@@@@@@B@BAB@B@@@@BBB@@@@A@@B@@AB@@@B@B@B@@@B@B@DA@@B@DA@@@B@@BBB@B@@@@BB@@@@@BAB@@@B@B@BAB@B@B@@@B@@@D@B@B@@BB@@B@@B@@AB@B@B@BBB@D@@@BB@@B@B@B@B@B@@@@@B@B@@@B@B@B@BB@@B@@@@@@@B@@@BB@@F@BBB@@@B@B@DA@@BA@@@BB@@@B@B@@@BAB@@A@@@A@A@@@@BAB@B@@@@A@@@@B@@AB@@A@@BA@@BAB@@AB@@BD@DB@BB@@@BB@@B@@@B@@BABAB@@A@@B@@AB@@A@A@A@@B@@@@@BA@@@@BAB@@@@BB@B@@BB@@@@B@B@BB@@@@@BB@@@BBB@@@BB@@@BB@BB@@@B@B@@@B@B@BAA@@A@@B@@A@@@A@@@@AAAA@AA@@A@@@A@@@@@ABA@@BA@@BA@@@@@BB@@@@@B@@@BAB@B@B@B@@@B@B@B@@@BA@@B@@@B@@BD@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@B@@@@@@@@@AA@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@BABA@@B@BA@@@@B@B@@A@BB@B@BB@@B@@@B@@BB@@@BADAB@BA@@@A@@@BB@B@@@@BBB@BBB@@@@@@BA@@@B@@@AB@@@B@@@@@@@@@B@@@@@@@B@@@@B@@B@@@@@@BD@@@B@@@@@@B@@B@@@@@@A@@@@@@A@B@A@@A@@@@@@@@@A@@@@@@B@@@@BB@@@@@@@@@@B@@@@@@@B@AB@@@@@B@@@@A@@A@@@@@@@@@BB@@@@@@B@A@@@@@@@@BB@@@@@@@@@@B@@@@@@B@@@@@@@@@@@BA@@@B@@@@@@BA@@BA@@@@BAA@@@@@AAA@@@@@@A@AA@@A@@AA@@@A@@@A@@@A@@AAB@@A@@@AA@@@@A@@@A@@@@BCDA@@@@@A@AB@B@@AB@B@@ABA@A@@@@@@@A@BB@@B@@B@@@B@@@B@B@@BB@@AB@@@B@@@@@B@@@B@@@B@@AB@BB@@B@@@B@@@BCB@@@B@@B@@B@@B@@BB@B@@B@B@B@B@BBB@B@@@@@BABAB@@ABABB@@D@BB@@D@@@B@B@@@B@B@@B@B@B@B@BADABABAB@B@B@@@@@BBB@B@@@@B@B@@@B@@@@@@AB@B@@@B@@CDABABADA@@BB@@B@@BB@B@@@@@B@@BBBBB@@D@@BB@@ABB@@BA@@@A@@B@B@@@BB@@B@@AB@@@@B@@B@@@B@@@@@B@@B@@@BA@@@@D@@@@B@@BB@@B@B@@B@@@BA@@BB@@BD@BB@@B@BA@@B@B@B@@@@BB@B@B@@@@AB@BC@@@@B@@B@BBB@@@@D@BAB@B@BABABABABA@@B@@@@B@@@BBBBB@BBB@@@@ABADC@CDCDA@@D@DA@@BA@@BA@EBA@ABBB@B@@D@BBBBBBBB@@BB@@DB@BDDBB@@B@@@@@@A@@B@B@B@@@B@DA@@BAB@B@D@DBB@B@@@BAB@BBDBB@@@B@@@B@@A@@@@B@@@@A@@B@@ABB@@@A@@@@@@@A@@@@@@BA@@@A@@@A@@@@B@@@B@BBB@B@B@@@B@@A@@DA@@@@@A@AB@@A@@D@B@B@@A@@@@@@BB@BDB@BB@@@@BA@@BB@BBFBBA@A@@BABA@@@@B@@BB@BBB@@D@@@B@B@B@B@@@@B@@BB@@@B@@@B@BB@@BD@BA@@BAB@@@@AB@@B@@BB@@@@A@@B@B@@@@@@@@B@@@B@B@B@@@@@B@@@@@B@@@B@@@B@@@@@B@@BB@@@@@BA@ABA@@B@@@@@B@@@B@@A@@B@@@B@@@B@B@BB@@@BB@@BBA@A@A@@BAB@@A@@B@@A@@B@@A@@B@@BBA@@@BB@@@@B@@@BB@@@BABA@@@@@BDB@BDB@@B@@B@@BBBB@B@@@@@B@@@B@@BB@@BB@B@B@@BB@@BB@@@@BB@BB@@@BA@ABA@@BABA@@@@@@@B@FB@@BAB@@ADB@B@@@B@@@@B@@@B@B@@@@@BB@@B@@A@@CA@@@A@@@@B@@@@A@@@@B@@@B@@@@@@AB@BB@@@@@B@B@@@@B@@B@@@AB@@B@@B@@@@@@B@@@B@B@@@@ABA@@@@@@@A@@@@@A@@@A@A@@@@@A@@@@B@DB@@@@B@@@@@B@@@B@B@B@B@@@@@B@@@B@B@@@@AB@B@@BB@@@@DB@@B@B@@B@@@@@@@@BBBBB@@BBBB@@@B@BB@@@B@B@BD@@@B@@@@B@@B@B@@@@@B@@BB@@@@B@B@@BBB@B@B@@DB@@B@BB@@B@@@BBBB@BB@@@@@B@B@@@BB@@BA@@@BB@@BB@@B@@@B@B@@@@@D@@AB@@B@@@@BA@@B@@@BB@@B@D@@@B@@@B@@@BA@@BAB@@@@@B@@@@@B@@@@B@@B@@@B@@@B@@@B@@@B@B@@@B@@@BABBB@@@@@D@@@B@@@B@@EBC@@BA@@BABBB@@@BA@@B@@@B@BABBF@@@BA@@@AB@@@@@B@@@B@B@B@@A@A@@D@@@BAB@@@@BBA@@BB@@@@B@@@@B@@@BB@@@B@@B@@B@BB@@@B@@@@@BD@@BB@@BBB@BB@D@@BB@BA@@BBB@BBB@@@DB@@@@A@@BA@@@A@@@A@@@@BBB@BDB@@@B@@@B@B@@@BBB@@DDB@@B@@@B@@@B@B@@@BB@@BBBA@@B@BAB@@A@@BAB@@A@AD@@A@@@C@A@@B@@@B@B@@@@AA@@A@@B@@@@@BA@A@@@C@@AAA@BA@ABAB@@A@A@@B@BA@@@@@@BC@@@BB@@AB@@@@B@@@@@@@B@AB@@AB@@AB@@CBA@@B@@@BA@@B@@@@A@@@@@@@@BA@@@@@BBDBBA@@@B@B@@ABA@@@@@A@@@AB@@@@A@@B@@@BAAA@A@@@@@@@A@@@@B@@@BBD@@A@A@A@A@@@AA@@@@ACA@ABA@A@@@@@@B@B@@A@@@@B@B@@@@@@A@@@A@AB@@DD@BBD@@@D@@AB@BA@B@@BB@H@B@B@B@@B@@A@@BABCB@@@BA@@@@BBBBB@@@@AAB@B@AB@B@@A@B@BB@@B@B@@@@@@@@FBD@@@@@B@@A@@@@@CA@@@@@B@@A@@@@B@@CB@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@A@@@@@A@@@@@@BA@@BA@@@@BA@@@@BA@A@@@@B@@@AA@@@@@A@@@@@@@@@@@A@@B@@@@@@A@@@@@@@@@AB@@@@@@@@@@AB@@@@@@@B@@A@@@@B@@@@@@A@@@AB@@A@@@A@@@@@@@@@@@@@@@@@@@@@@@AB@B@@A@A@@@A@A@@@A@@@@@A@@B@B@BBBBB@@@BBBB@BB@BB@@D@B@BA@@BCB@@@B@B@DA@BB@B@@BB@B@BB@BB@@@B@@AB@@BBAB@@BD@@@@AD@@BD@B@B@@BB@BB@@B@@B@BBB@@@BDB@@B@@D@@@@BBB@@DB@@B@@@D@B@@@BB@BB@@@@B@BBB@BBF@@@B@@ABA@EBA@@@@@AB@BBB@BB@@B@BAB@@CBABA@@B@B@D@BDD@B@B@@@@B@B@D@D@@@BA@@B@@@@@B@DBHAD@B@@A@@BAAA@@B@B@B@@@B@D@B@BBB@@@@@BB@AB@@@BA@@B@@@B@B@DA@@BAB@@@@@BA@@@A@@@A@@BA@@AA@AB@@@B@@A@@A@AAAA@@ACAA@@@AB@@@D@B@@@@B@@BB@@BABAD@@ADA@@BA@@D@BAB@BA@@D@B@BB@@@A@@@C@@BADCBA@A@A@@@E@@@@AB@@AD@@@@@A@A@A@@B@@A@@BA@@@@B@@A@@A@@@AA@@@CACAA@C@@AAECAA@@A@@@CAA@A@B@@AB@BC@@BADCB@B@DC@@BAFCDABADA@@BAA@@A@@CB@AA@A@A@@B@@A@@@A@@A@@@A@ABAAAB@BA@@@A@@@@@AA@@@@@@@BAB@BA@@@A@A@ABA@A@@B@B@@@BAB@@A@@@A@@@A@@DA@AB@D@@@@@B@@ABAD@BBB@BB@BB@DA@BDA@@B@@@@@@BBB@@@@DB@@B@DB@@@@B@B@@BB@@@B@@@BB@@B@D@BB@@@@@B@B@@@@BB@@B@@@B@@@B@B@B@@@@@@BB@@B@@@@B@@BB@BB@@@BBB@@AB@@@B@@@BB@@BB@B@BB@@@BB@@B@@AB@DA@@BBB@@@@B@B@B@B@BBB@@BB@B@@@BBBB@B@B@BAB@@@BB@@DBB@B@@B@@BB@B@D@B@B@BB@@B@B@B@B@B@@BBBB@@@B@@@D@BB@@@@B@@B@@B@BB@@B@BB@@@@BBBB@@B@@@BB@@B@B@@@@BBB@@B@@@@@B@@@B@BAB@BA@@B@@@B@@@B@B@B@@@@@@@B@B@B@@@B@@B@@D@B@B@@@B@B@@@B@@@B@B@@AB@@@@@BA@@BA@@@AB@@AB@B@@@@ABA@AB@@A@@@@@ABAB@BABAB@@@@@B@@BBAB@@@@@BB@@B@B@@@B@@AB@@BBA@@B@@B@@@@@@@@BB@@@@@@BBB@@@@@@B@@@BA@@B@@@@B@B@@@@@@D@@@B@@A@@@@DB@@BBB@BBB@B@B@B@B@@B@AB@BAB@@@@@BB@@@@@@@@B@@A@@@@BBB@@AB@@A@ABAAA@AAABAAA@A@A@@@AA@@@B@@AB@BCD@@A@@@@B@B@@@BBBB@@B@@@B@@@@@@@B@@@B@@B@@@@B@@@@@B@@@B@@@@@@B@B@@@BB@@@BB@BBBB@@B@@B@BB@@@@@@B@@@@BBB@@@@@@@@@@@@@@@A@@@@B@@A@@@@@@@@@@B@@@B@@BB@@@@A@@@@@@@@B@@@@@@AB@BB@@@@@B@@BB@@B@@@@@B@@@BA@@@@@DBBA@B@@B@@@B@@@@@ABB@@@BB@@BB@BB@BA@@@@BB@BBB@BB@@@@@BBB@@@@@B@BA@@B@@@@@@@@@B@@@B@@@@BBB@@@@BB@@@@B@B@@@@@B@B@B@@@B@@AB@@A@BB@@BB@@@@@@@B@B@@A@@@@@@@A@B@@B@@@@@B@@@B@B@@@@@DC@BBA@B@A@A@AB@@AB@@@B@BA@@@@BB@@@@B@@@B@BA@ABD@@@@BB@@@DB@@@@@BA@B@@B@@@@@@BD@@@@@@@A@AB@@@DABA@@BA@@B@@@B@@B@@@@B@@@BAB@BABB@BAB@BA@@@BB@@BBA@AB@B@B@@@BA@@B@@@F@B@BA@@D@@@B@@@@@B@@AAA@AA@@A@A@A@ABA@@B@BABB@@BAA@@@@A@@@@@@@AA@@@B@BA@@@C@A@A@@BA@@@@A@@@A@A@AA@@@@@A@@@A@A@A@@@AB@@@@A@@@A@BA@A@@@@BA@@@AA@@@@@@@@@B@@@B@DABAB@@@@@B@@@@B@@@@@@@@@@D@@@@@B@@A@@B@@@B@@C@@BAB@@C@@@@@AA@@A@CA@A@@@@@A@@AA@@@AA@A@@BA@@@AA@@A@@@C@@@@@AA@AA@@A@@@AB@A@@A@@@A@@A@@@@@AA@@@@BA@A@@@A@AB@@@@A@A@@BA@@@A@@@@@A@@@@@A@@BABA@@A@@@BA@@B@@@B@BBBBBBB@B@@ABBA@@BB@@@D@B@D@@A@A@@BA@AB@@ABA@@D@@@BA@@@@@A@@@@DAB@@@@AAA@@@A@@B@@@@A@@@@AA@AFA@@A@@AA@@@AA@@@@C@@A@@@@@AB@@@@@@AB@@@@@B@@@@A@@@@@@@@@AB@B@B@B@@@BA@A@@@A@A@@A@@AB@A@@@A@@@A@@@@AAA@@@AA@A@CACAA@@@A@AA@AA@@A@@@@AA@@@@@A@A@@@@@@@A@@@@CA@@@@@A@@@@BA@@@A@AB@@@@@@A@A@@@@@C@@BA@C@A@@@C@@@@@A@@@A@@A@@A@@BABC@AA@BAA@BAAA@@@@@AA@@@@@@A@@@@A@@A@@@B@@@@A@@@@@A@@@@@A@@@A@@A@@@@@A@@@A@@A@AAAAA@@@CA@@@A@@@AAA@AA@@A@A@@@@@AA@A@@ACB@AA@A@@@A@A@A@@@A@@@AB@@@BA@A@@@A@@@A@@@AA@@@@AA@@@@@@@@AA@@AA@@B@@@@A@@@@AA@@A@@@A@@@@@A@@@A@@A@@@@A@@A@@@A@@@A@@B@BA@@BA@@@AC@@ACA@AAAAE@@A@@@@@@AA@@@CD@@@B@BA@A@A@@@ACAA@A@@ABA@@@@@AA@@E@@AAAA@AA@@@AA@@A@A@@@@A@@@@A@@@@@AA@@@AAB@@@@@@A@@@AA@@@@@A@@@@@A@@@@A@@@@@@BAB@@AB@@@AAA@@@@@BADA@AD@@@@A@AA@CC@@@A@@@@AA@@@BA@@B@@A@@@A@@B@@A@AAA@A@@@A@A@@AB@@@@@@A@@BA@@@@@@A@@@A@C@@@C@ABA@A@A@A@@@AA@A@@@A@@@@@@@@@AB@AA@@@@B@@@@@@@@@B@@@BA@@@@B@AA@AB@B@@@B@BAD@@@AAAAAAA@@@@AAA@A@@ACAA@AAADA@A@@@A@@@@@A@@@@@AA@A@@@AA@@A@E@A@@AAAB@@ABA@@B@B@B@@@@A@@@A@@A@@AA@A@AB@A@@AA@B@@AAA@@@A@@@A@@A@@A@B@@AB@@AA@ABA@A@@@AA@@@@@BA@@@@@A@@A@BA@@A@@AA@@@@A@@@A@A@@A@@A@@AA@@@@@@AAA@@@ABA@@@@A@@@A@BA@@@AB@@@B@@A@@@@B@B@BA@@@A@@@@@A@AB@@@AAA@@AAA@@@@@AAB@@A@@BA@@@@A@@@@@A@@C@@@@A@A@A@A@@@@@@@A@@@A@A@ABA@A@AB@AA@@BA@@B@@AA@@@AA@@@@BAB@@@@@@A@@A@@@ACCAA@@AAAAA@ABA@@BA@A@@AAACA@@ABAA@@A@@@@@@@AA@@A@@@A@@AAB@A@@A@@@A@@@A@A@A@@@AA@@@@@AA@@A@@@@@BA@@@@@@@A@@@@A@@@A@@@A@@@@A@@B@@@AA@AA@@@@@A@@@@@@@A@@@@@@@BC@@@A@@A@@@A@@@A@@@@@@B@@@@@@@@AA@@@@@@@B@B@@@B@BAAA@@AA@@@@AA@@@A@CAA@AAA@A@AA@@@EBC@CBEAC@C@@@CDG@A@A@CBE@@@A@@@E@E@E@A@AB@@C@C@@@@D@@@B@@@@@DBBA@@B@@A@AB@@@B@BABB@@B@@A@BBB@BB@@@@@BA@@B@@@BA@@@@B@@A@A@@@AD@B@B@@@@BB@@@@@@B@@@@@@@B@@@@@@@@BB@@B@BAB@@@B@@@AB@B@@@BAB@B@@@@@@@B@BAB@@@B@@@@ABBB@B@B@BB@@@@@DCBADA@AB@@@B@@@B@@@AA@@B@BB@A@@B@@@@@DADBB@BAD@@@BBB@DB@@@@BAB@@@BBB@BB@@@BBD@@B@@@@@BB@@BBBB@@@B@BB@@@BB@@B@DBBB@@B@@B@B@@@B@BA@@@@BB@@BB@@BB@B@@@BBB@B@@@BB@@B@BBB@D@@@@@B@BAB@@@D@@AB@@A@AB@BA@ABA@@@CAA@@@@@A@@@@@A@@@@@@@A@@AA@@@A@@A@AB@@A@@@@@@AA@@@@@@@@A@@B@@@@@@@@@B@@@@@@@@A@AA@@@@@@A@@A@@@@BA@@@@A@@@@C@A@A@A@AC@@@AAA@@AAA@AAAAAAAA@A@AAA@ACA@AAA@@CAAAAA@AA@A@A@@@AA@@@@AA@A@@@@AABG@ABCBA@@@A@AA@@ACA@AA@@AA@@A@@@@@A@@BAB@@@BABAB@@@B@@A@@B@@@@A@A@@@@@A@@@A@@@@A@A@@@A@A@@@@@@A@AA@@AA@@A@A@A@@@A@@@@@A@A@@BA@@@A@G@A@@@@@AA@@@BCA@@@BA@A@A@@@A@@AAA@AAA@A@A@A@A@AA@@@@@A@AA@A@@@@@@@AAA@AAA@@@AA@@@A@A@@@A@@@A@A@@@@@A@@@@@A@@@@@A@@@@AAA@A@A@@@@A@@AA@@@@@AAACCCC@@@AA@@A@@A@AA@@A@@@@@@ABC@A@@BA@A@@AAA@AA@@@@AA@@@@@A@@@A@AB@BABAB@@@BA@A@AB@@A@@AAA@@A@@AA@A@A@@@@AA@A@A@@BA@@ACAA@AA@CAA@@A@C@@@A@A@@@A@@ACAA@@AAA@@AA@@A@AAA@C@@@A@@BA@A@@A@@AAG@A@A@@BABA@A@A@@AAAA@@@@@@@@@AB@@@@AA@@@@A@@B@@@@@BA@@@@@@@A@@@A@@@@B@@@B@@@@@BB@A@@B@@@D@@@@@@A@@@@A@AAA@@@@@@@@A@@@C@@@A@@@A@A@AA@ACA@@AA@BAAA@@AA@@@A@C@@A@AA@@A@@@@C@@A@@@AA@@BA@@A@@A@@A@@AA@@@ABA@@BA@A@@BAA@@@BA@A@@@AA@@AB@@A@AB@@@B@@@BA@AAA@@@A@@@CBA@ED@@@@@AA@@A@B@B@@AB@@@@@@AAA@@@ABA@@@@B@BAB@@@BA@A@AAAA@@@@B@@@@@A@@@@A@@@@@@@@A@@@@@@A@@B@@@@@AA@B@@@@AA@@@A@@@@A@AAA@@@@@AA@BA@@B@BAB@@@@@AAA@AAA@A@@@@@AB@@@@@@A@@@@@A@ADBBB@A@CB@@ABCB@@@@A@@AA@@@@AA@@@A@CA@AA@@@AA@@AA@A@@@@BA@@@AA@BA@@@AA@@A@BAAA@@@@@AB@@@@@@@@ABABA@A@@B@@C@A@A@@@@@@@A@@@@BA@@AA@@@@@@@@@AB@@A@A@@A@A@@@@@@@@AB@@@@A@@@@@A@@@@A@@@@A@@@@AB@@@@A@@@@@C@A@@AABA@ABA@@@A@@A@@ABA@@@A@AA@@@@A@@@A@@@@A@@@@@B@@@@AA@@@@@A@@@@@@@A@@@@AA@@@@@A@@AA@@@@A@@@BA@@@A@@@AB@@AB@@@@@BAD@AA@A@@@A@@A@@A@@@A@@BA@ABA@@@@BA@@@A@A@@@@A@@@@A@@@@A@@AA@@@A@@@AA@AAA@@@AA@A@AB@A@@A@ABA@@@@A@@A@@BA@@@A@@BA@@B@@AA@@@@@@A@B@@@@BA@A@@@@@A@@@AB@@@@AA@@A@ABC@A@@@@A@@A@@@@@@AA@B@BC@AAA@@@@@@AA@B@@@A@@@A@@B@B@@A@@@AA@@AA@AB@@AD@@@@A@@@@@@C@@@@A@@@A@A@@@@A@@A@@@@A@BA@@@B@@BA@C@@@@@AA@@@B@@A@@@@A@@AAA@@AA@@C@@A@A@@A@A@@@@AA@@CA@@AA@@@@A@@A@@@A@@@AB@BA@@@@BA@@@ABB@@B@AA@@@AA@@A@@AA@@@AA@@A@@@C@A@@AA@@@@@A@A@AA@@A@@BA@A@@@@@@@@A@@@A@@@A@@AA@@@@AABA@@@AA@@@@BA@@@@A@@@@@A@A@A@A@@A@@@AA@A@@@@AB@BC@A@@@@AA@A@@@@CC@@@@A@@@@@AAA@AA@@A@@A@@@A@@@@@@@A"],["@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@A@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@"]],encodeOffsets:[[[121608,26570]],[[121678,27068]]]}},{type:"Feature",id:"350128",properties:{name:"平潭县",cp:[119.790168,25.49872],childNum:90},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@@@B@@@@@@A@@@@A@@@@@@@@@@@@B@@@@"],["@@@@@@@B@@@@@@@@B@@A@@@@A@@@@@@@"],["@@ABB@@@@@@@@@@@@A@@@@@@@@"],["@@@@@@BB@A@@@@@A@@A@@@@@@@@B"],["@@@@@B@@@@@@@@@@B@@@@@@A@@@@@@@@@@A@@@@@"],["@@@@@@@@@@@@B@@@@A@@A@@@@@@@@@@@@@@@@B@@"],["@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@B@@"],["@@@@@@@B@@B@@@@@@A@@B@@@A@@@@@A@"],["@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@A@@@@@@@"],["@@B@@@@@@@@@@A@@@@@@@@@A@@@@A@@B@@@@@@@B@@"],["@@@@@@@@@@@@B@@A@@@@@@A@@A@@@@@@@@@BA@@@@@@@BB"],["@@@@@A@@@@B@@@@@@@@@@@A@@@@@A@@@@@@B@@B@"],["@@@@B@@@@A@@@@@A@@A@@B@@@@@B@@@@@@"],["@@@@B@@@@@B@@@@A@@A@@@@@A@@@@B"],["@@AB@@@B@@@@B@@@@A@A@@@@@@@@@@@@@@@@"],["@@@BAB@@B@@@@@@@BA@AA@@@@@@@"],["@@@B@@@@B@@@@@@@@A@@@@A@@@@@@@@@"],["@@@@@@@@B@@@@@B@@@@@@@AA@@@@A@@@@B@@"],["@@@@@@B@@@B@@@@A@@A@@@A@@@@@@B"],["@@@@@@ABB@@B@@@@B@@@@A@AA@@@"],["@@@@@@B@@B@@B@@@@@@@@A@@AA@@@@@@@@A@@B"],["@@@@@@B@@@BB@@@@@A@@@@@@AA@@@@@@@@@@A@@@@@@B"],["@@@@@@BB@@@@B@@@@A@A@@@@A@@@@@@@@@A@@@@B"],["@@@A@@@A@@@@A@@@@@@B@@@@@B@@B@@@@@@@"],["@@@@B@@@@B@@BA@@@@@@@A@@@@@@A@@@@@@@@@A@@@@@@@@B@@@@"],["@@@BB@@@@@@@@@B@@A@@@@@A@@@@A@@@@@AB@@"],["@@@@@B@@@@@B@@B@@@@A@@@@@@@@@A@@@@@@@@@@A@@@@@"],["@@@@@@@@@A@@A@@@AB@@@BB@@@@@B@@A"],["@@@@AAA@@@@@@B@BB@@@B@@@@A"],["@@@B@@@BB@B@@@@@@@@A@@@@AA@@@@A@@@"],["@@@@@A@@A@@BA@@B@@@BB@@AB@@A"],["@@B@@B@@B@@@@@@A@@@A@@@@A@@@@@@@A@@B"],["@@@@@A@@@@@@@A@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@B@@A@@@@@@@BB@@@AB@"],["@@@B@@@@@BB@@@B@@@B@@@@A@@@@A@@@@@@@@AA@@@@@A@"],["@@AB@@@@BB@@@@B@@@@A@@@@BA@@A@@@A@@@"],["@@B@@A@@@@@@AA@@A@@@A@@@@B@@B@@@@@@@BB"],["@@@@@@@@BB@A@@B@@A@@@@AA@@@@@@@@A@@@@@A@B@@B@@@@@@@B@@"],["@@B@@@@@@B@@@@B@@A@A@@@@@@@A@@A@@@@@@@@@@@A@@B@B"],["@@@@@@A@@@@@A@@@@BB@@@@@@@B@@B@@B@@@@@@A@@@A@@A@"],["@@@@B@@A@@@@@@@@@AC@@@@B@@@@@@AB@@@@@BB@@@BA"],["@@@B@@@@B@@A@@@@@@@@@@@A@@@@@A@@A@A@@@@@@@@B@@BB@@"],["@@B@@@B@@@@@B@@@@A@@@AA@@@A@@BA@@B@@"],["@@BB@@@@BB@@BB@@BA@@@A@@A@@@@AA@@@A@@@@@A@@@@@"],["@@B@@A@@@@@AA@@AA@@@@@A@@B@@@@@B@@B@@@@@B@@@@B@@"],["@@@@B@@A@A@@B@@@@AA@@@A@A@@B@B@@BB"],["@@@@@@B@@@@A@@@@@BB@@@@@@@@A@AA@@A@@A@@@@@A@@@@B@@@@@@B@@B@B"],["@@@@@@@@AA@@@@A@@AA@@@@A@@A@@@@B@@B@@BBBBBBB@@B@@@@A@@AA"],["@@@A@@AA@A@@AA@@@@A@@@@B@BBB@@BB@@B@"],["@@@@@B@@BAB@@BB@BA@AB@@@@A@@A@@@A@@@AB@@@@A@AB"],["@@AB@B@@B@B@@@B@@@B@@@BA@@@@@@A@A@@@@A@@@@@@@@A@A@@@"],["@@@@@B@@@BBBB@@@B@@@@AA@@A@@@@AA@@@AB@@@AA@@@@@@A@@B@@@@@@@@@B"],["@@@@A@@B@@B@BB@A@@B@@@@AB@@@@@@A@@@@@@A@@@A@@@AB"],["@@A@@@@BAB@@@@@@BB@@@@B@@@@@@@@@BA@@@@@@@@@A@A@@AA@B"],["@@@@@@@@A@@@A@@@A@@@@B@@@@@@B@@@@@B@@B@@@@BB@@@@B@@@@@@A@@@@@@@A@@@@@@@@@@@@@A@@@@A@"],["@@B@@AB@@B@@B@@@@@@@@A@@@@AA@@@@@@@@@A@@A@@@@@@@@@AB@@@@@@@@A@@@@@@B@@@@B@@B@@@@"],["@@E@@@@@@@@B@@@@@@@@@@@@@@AB@@B@@B@@B@@@@@@A@@@@@@@@B@@AB@@@@@@@BAA@@@@@@@"],["@@AA@@AB@@@B@@@BBBB@@@BA@@@A@AA@"],["@@B@AA@AA@@@A@@@@B@B@@ABB@@BB@@ABA"],["@@@B@@@BB@@@B@@@B@@@B@@A@@@AA@@AA@A@@BA@"],["@@@@@@@@@@AAA@@@AB@@BB@@B@BB@@@@B@BB@@@@@@B@@@@@@@@AA@AA@A@@@@A@"],["@@@@@B@@B@@B@@@B@@@BB@@@BAB@@A@AA@@A@@A@@@C@"],["@@@B@BB@@BB@@@B@@@@AB@@AAA@AA@@@A@@BA@"],["@@@A@@@@@@B@@@B@@@@A@@A@@@AA@@AB@@@@@BA@@@A@@B@@BB@@B@B@@@@@@A"],["@@@B@BBBB@BB@BBA@@@A@@@AAA@@@AAAA@@@AB"],["@@@@A@@BABBD@@@@B@B@BA@A@A@@AAA@"],["@@@BB@B@B@@A@A@A@@A@@ACBA@@@AA@B@@@@@B@BB@@@B@@@"],["@@@A@A@A@@AA@@ABA@@B@@@B@@AA@@@B@BAB@@@@BB@@@AB@@A@@@@B@@@@B@@@@BA@@B@@@"],["@@@@A@ABA@@D@BB@B@@@@@B@B@@@B@@A@A@@@AA@AA"],["@@B@@@B@@A@A@A@ABA@@@@@A@@@@BA@@A@@AAB@@AB@BA@@BA@@B@BB@@B@@@B"],["@@A@@@A@@@AB@@@@@@AAAA@@A@@@A@@@@@@B@@BBBBBBB@B@B@B@@@@A@@@A@A"],["@@BDBBBBB@B@@@BB@@BA@@AC@AA@AAAAA@A@AB"],["@@@@B@ABBB@@B@BAB@@@B@@@BA@A@@AA@@AA@@A@A@AB@AABABB@@B"],["@@BA@@B@@B@@@@B@@@B@@@@A@@@A@@@A@@AAA@A@CBABAB@B@@@BB@BAB@"],["@@BBB@@BB@@AB@@A@@@@@@B@BA@@@A@@@@AA@@@A@@A@@@CAA@A@@BA@@BA@@@@B@B@BB@B@@@"],["@@@@AA@@AA@@@A@@BA@@A@@AA@@@@B@@A@@@A@A@@@@B@BB@@@@BA@@@BB@@@@@B@BBB@BBB@@B@BA@@B@@@@AA@@@@A@@@@@ABA"],["@@A@CBCBABABADBB@BBA@@B@@@B@@AB@B@@@B@@A@@@AB@@@B@@A@C@AA@"],["@@A@A@@@ABA@AAA@@@A@@B@@@BBD@BB@BB@@B@DAB@D@B@@A@@@A@A@@@AC@AA"],["@@BC@ABA@A@@@ABAB@B@B@@A@@AAA@AB@@A@@AA@AAAB@@@B@B@@@@ABA@@D@@@B@@@@AB@@@B@B@@A@@@@@@BA@BB@@BBBA@@B@BABA"],["@@AA@A@@@A@AA@A@A@AB@D@B@BBB@H@B@B@@B@B@BBB@@@BA@A@AA@@ABA@A@A@AAA@@"],["@@@ABG@AAEA@A@AAA@CB@BAB@HBD@@HDB@BA"],["@@BDBB@BB@B@D@@@BA@A@A@@@AB@B@BB@@BA@ABAA@@A@A@A@@AAA@@AA@BA@@@@AAA@@BA@@@A@A@@@A@@B@@@B@@@BAD@@ABA@@B@B"],["@@ABA@@AAAA@A@A@ADADBHBF@BDFDBDDFDDBD@BABCBCBCBADAFCBAACACEAC@AAAAA@E@C@CAA@"],["@@BCBC@ABA@ABAD@B@DBBBB@BABA@ABCAA@ACAA@AB@BC@AA@AAA@@CAC@A@CAA@AAAA@C@C@A@@C@GBA@@B@B@@B@BBBBAB@B@BBD@D@BABADABAB@HBF@BDDB@BB@B@BBDBBBBHFDBB@B@BC@EBCBCAA@AA@@@C@A@AAAA@ABA"],["@@BABCACAAA@@AACABCA@AA@A@C@@@@@AD@BABA@C@AAAAAB@@@@@CA@A@A@@A@@ABAB@B@@@@B@@@@B@BABA@CAC@E@A@@BAJBBBB@BA@@B@HBB@DAB@B@@F@FABABE@AAA@@@@F@F@DBBBFD@@DA@A@AA@@@AA@A@@@AAAAA@ABAB@@@B@DA@@@@BBB@@BAB@D@BBBABAB@D@BBBABBB@BD@BA@A@AA@BAB@AABAB@@AB@BBB@BAAE@@AAB@B@@AA@AA@@@AB@BAAA@@"],["@@CACC@@C@AAA@@A@AA@CAEBEAC@AAAA@BABE@CACCA@C@@B@@BB@D@@CBC@@@@@A@ADAHAD@B@@BBJ@FB@@DAB@@B@@CDABA@C@@B@BDBD@F@D@BADCB@D@FB@BCB@B@@BBB@B@@BBB@@B@B@FAB@BA@C@@CCA@@A@AB@@A@@AA@@FAHA@@BA@AB@BC@@A@"],["@@C@A@ABABC@C@ABA@C@@@ABABABCBEB@@@BB@@BAB@BDDB@B@@AB@@D@BBB@BBBD@BC@E@ECEB@BAD@@BBBDDBDBBB@BB@@@@@DBD@B@@AB@@@@@BBBDBHBBB@@@B@@FHDBB@@@D@BB@@DB@B@BAB@@@BBBFBF@DA@@@@HBBBBDB@@A@A@CCA@CBC@ACKGG@@ACBC@@AACB@@CA@@@A@EEC@@A@C@CA@@EC@@CBAA@A@@BAAAAEAAGC"],["@@CACAABAB@BMJC@@@@BAHBDDFFHJDFFF@DABAAC@C@A@ADAFBDBLDB@NBJDFBHJJDF@DA@EACCE@C@ADEBA@C@A@C@G@@AEECEAC@GBEFCDC@CAEEGAC@E@@BC@CCCAA@CBC@A@"],["@@@AA@@@@@@@@@@@@@@B@@@@A@@@@@@@B@@@@@B@"],["@@@@@B@BD@B@@@B@FBF@BBBBAD@DADA@CB@AAA@AI@C@A@ABCBAFGDCD@D@BBBFFFH@D@BC@C@CAGJ@@AB@D@@@H@@@BA@@@@@@BE@DBHR@D@@@BC@ABAB@BBDBBFDBFVHBDBHBDDDJD@@JBJA@@DAB@BAF@@@FBDB@BBB@B@BSHAB@BDH@BADAD@B@B@B@B@BB@D@B@@V@D@D@@ABB@@BA@ADGTCJAB@@CBABKDCBKDAB@@AH@DABCDCDAH@F@DDBBDDBDADBBD@FBDDDDBBB@DBD@@@BHDLFDBD@B@DAB@B@DB@DBD@HDHHFTJDBH@FBBBD@LFHFBB@DA@BB@@B@BAB@B@FDNHHD@BNHD@B@D@BABADE@C@C@CACAACEKM@@AAGK@C@@ACBC@EBGBAHKBADAFAD@D@B@RFDBF@FAD@BCDC@AB@FCF@B@DABA@AB@@@HEDAFABADABADE@C@AAAAAAACAAA@C@@ACEEAAAC@ABABAD@B@B@DCDABABCDAFAZGHAJ@D@DB@@VJD@D@B@BA@C@AAQCICECEYUKEGAE@C@CDADCHADCBCBCBE@AAIEC@SIEAECAA@@CCCMAGAK@KDO@ADEBCB@DAHAD@D@B@DCBA@CBCBC@AB@BADBB@HBDBB@FABAB@BC@CB@@ABB@@DBB@HDBDB@DABC@CAGBC@E@@ACACA@AECE@@AA@ABC@A@AACCAG@C@EBABCLADABABCBG@GBGBEAA@ECA@@A@A@@@AA@GACAA@ABA@A@C@AAA@CAAAEEKCKIOKGEE@CAGACC@AACCG@AAEBC@CBAFA@@DAJBBABAB@B@@@JADABA@@@A@@@A@@@A@@@A@@AAA@EAA@@@A@@@@AB@@A@@AA@C@@@AAA@@A@A@@@@@@@A@BA@@B@@@@A@@@@@AAAA@C@A@A@ABA@@AA@@@AB@@@B@BA@AAA@AAA@A@A@A@A@IJGFCHAHABCBA@ABADA@@@A@@@A@A@CBA@C@A@@BA@A@@@ABA@ABA@A@EAA@AB@B@BEF@@CDCBGBE@G@I@C@@B"]],encodeOffsets:[[[122691,26034]],[[122588,26246]],[[122699,26124]],[[122628,26e3]],[[122631,26e3]],[[122696,26121]],[[122495,26224]],[[122653,26290]],[[122686,26264]],[[122693,26038]],[[122572,25914]],[[122575,25918]],[[122621,26012]],[[122643,26004]],[[122691,26237]],[[122634,25999]],[[122681,26067]],[[122643,26330]],[[122580,26168]],[[122568,26189]],[[122432,26265]],[[122691,26122]],[[122575,26281]],[[122731,26321]],[[122702,26141]],[[122691,26129]],[[122786,26210]],[[122722,26189]],[[122651,26005]],[[122568,26287]],[[122577,26283]],[[122587,25929]],[[122734,26322]],[[122677,26060]],[[122583,26146]],[[122578,26154]],[[122589,26028]],[[122751,26184]],[[122648,25899]],[[122562,26282]],[[122571,25956]],[[122533,26092]],[[122751,26085]],[[122571,26142]],[[122602,26331]],[[122725,26103]],[[122715,26195]],[[122491,26293]],[[122729,26233]],[[122580,26144]],[[122646,25905]],[[122695,26032]],[[122582,26156]],[[122673,26266]],[[122717,26127]],[[122543,26120]],[[122590,26165]],[[122569,26228]],[[122581,25951]],[[122579,25943]],[[122634,26281]],[[122707,26281]],[[122700,26281]],[[122702,26287]],[[122556,26068]],[[122558,25943]],[[122602,25887]],[[122602,25961]],[[122548,26104]],[[122747,26090]],[[122601,25881]],[[122619,25901]],[[122562,25956]],[[122587,25989]],[[122678,26264]],[[122823,26043]],[[122544,26056]],[[122499,26286]],[[122685,26055]],[[122741,26203]],[[122600,25866]],[[122632,25906]],[[122527,26270]],[[122573,25936]],[[122784,26207]],[[122610,25972]],[[122452,26243]],[[122550,26250]],[[122556,26196]],[[122566,26041]]]}},{type:"Feature",id:"350125",properties:{name:"永泰县",cp:[118.932592,25.866694],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@AACA@@AAB@@ACA@@AA@@@@BABA@@B@@@@@@AAA@ACAA@EC@AABCBAB@A@@AA@@A@@@C@CBA@@@AACAA@@BAB@@AB@B@@A@@B@@@B@B@@A@@BC@@@A@AB@BA@AB@B@BA@AB@B@BA@@BA@A@@B@@CBAB@@@@@@C@@B@@@BAB@B@@AB@@@B@B@@A@A@@@A@@B@@AB@@A@AB@@@@AB@@AB@BB@AB@B@@CD@BABA@@BA@@@A@A@@BA@ABAB@@@B@@CB@@@@@@@@@@@B@@@@BB@B@@@@@@@B@@@@@@@@@@@@@@@@@@AB@@A@@A@@AB@@@@A@@@@@A@@@@BA@@@@@@@@@@@A@C@@@@@@@A@@B@@@@@@@@A@@B@@@@A@@@@@A@@@@@@@A@@@@@AB@@@@@@A@@@@@@B@@A@@@@@AB@@@@A@@B@@@@A@@@@@@@@@@BA@@B@@@@A@@@AB@@@@@@@B@@@B@@AB@@@@@@@@@@@@A@AB@BA@@A@@A@@@A@@@@@AA@AAAA@A@AA@@@@ABAB@B@BA@A@A@@B@@A@A@@@A@A@@@@BAB@B@@@BD@B@@@AB@BCBA@AB@@A@A@AA@@A@ABA@@@@BA@@BA@@BBDA@@DA@@B@@A@BBAB@@@@@B@B@B@B@B@@A@@@@BBD@@BBB@B@@B@@@B@@@B@@@B@B@D@@@B@B@@@@A@CAC@@@@B@@@BB@@BAF@@A@@BA@@BA@ABA@@BAB@@@BAB@@@@ABBB@@@B@@@B@BBBBBBB@@@@BB@@BB@@@B@B@@A@@@@BA@AA@A@@A@AAA@@@ABBBAB@@@@@B@@@B@@@@A@AD@B@@AB@B@@@BA@AD@@@BA@@B@B@D@@AB@@A@A@A@A@C@@BE@A@IPEF@BA@@AA@@@A@ACA@A@@B@BAD@@A@A@A@A@@B@@@@@B@@@@@@B@@BBB@@BB@B@BB@@@B@B@B@@BDBBDDBB@BB@BAB@@@B@B@@@B@@@@@@@B@@@B@@@@@@BB@@@B@@@@@BA@B@@@@B@@@@@@AB@B@@@@@@@BBB@@@@@BB@@B@B@@@B@@@B@B@BBDBB@@BA@@@@@A@@@@B@@@@AB@@@@@@@BA@@@BB@@A@@B@@A@@@@B@@B@@@AB@@@@@@@B@@A@@B@@B@BAB@@BB@@BB@B@B@@B@@A@@BA@@B@B@BB@@@@@D@@@@BB@BB@@@@@DB@@FDBB@@B@B@B@BB@@@@A@A@@B@@@@A@@@@@A@@@@B@@@@@B@@A@@@@@@@@B@@@@@@@BA@@@@@@BA@@@@@AB@@@@AB@@@@@B@@@B@@@BAB@@@@@@ADA@@@@BBB@@@B@BBB@@@@CBCBA@CBA@A@AB@@@B@@@@@BBB@@@BB@B@D@@B@D@B@D@@@BB@@BB@B@@BBB@@@B@@BA@@@AB@B@@AB@B@@B@D@@AD@B@@AB@F@B@@B@BBBBB@@@@BDD@@@B@@BB@@B@B@@@@@BB@BBB@@B@@BB@@B@@B@@B@@BD@B@@BBBB@@@@@@@A@@B@B@B@@@@A@AB@B@@@BA@AD@@A@AD@@@BA@A@@@A@@@@B@@@B@D@@AB@@AHCBA@@@AA@@@@A@@BA@A@AB@@A@@B@@AB@BA@@@@BB@@B@@B@@@BB@@A@AB@BAB@@@BA@@@@@@@A@AAA@A@A@@@A@@@AAA@A@A@A@A@@@@@A@@AA@@B@AA@@@A@AA@@ABAA@@A@@@A@A@@@A@@@@@A@@AC@@@A@A@@@A@@@@A@@A@A@A@@@A@@@A@@@ABB@@@@BB@B@@B@@@@B@B@@@@@BB@@@@BB@@@@@BA@@B@BB@@BA@@BA@@@@BB@B@@@@@@BAB@@A@@BD@@@A@@BBB@@B@@@BA@BB@@@@@@@B@@@@@@@B@@@@@@B@@D@@@B@B@B@B@B@@@B@@@BB@@@A@CD@@AB@BA@@B@@@@BB@@@@BBB@@@@@AB@@B@ABADABAB@B@@@BBB@B@@@@@@BBBB@@BBB@BBD@@BB@B@B@@@@@B@@@@ABAA@@@@AB@@@B@BA@@@@@AB@@@@@@@@AB@B@@@@@B@@@BA@@DB@@B@@@B@@@BAB@B@@@@@B@@@@@B@BA@@B@B@B@D@HB@@@A@@A@BA@@@A@@@@@@BA@@B@B@AA@@@A@@@@B@@@@@@@BA@@A@@@@@@@BA@@@@@@@@B@@@@A@A@AA@AAD@B@@@B@B@BB@@@@BBAB@@ABC@@B@@A@BBAB@B@@A@@@ABA@@@AB@BBB@BAB@B@@AB@@AAA@@B@@@BB@DB@@@@BB@B@@@B@B@@@@@@@BADAB@@@@@B@@BA@@@@@@@B@B@@@@@D@@B@@@B@B@@@@B@@BB@BB@@B@@BB@BBA@BBBBD@B@B@@@BB@@BB@@BA@@@@@@@@BAD@@AA@@A@AAA@A@@@@@B@@B@@AB@B@@A@@@BB@B@@@@@@@@@B@B@@@B@@BB@@@@@B@@@BA@@@@@BB@B@@AB@@B@BD@@BA@@@B@BBB@@AB@@B@@B@@BB@@@D@@A@@@@B@BB@@B@AB@@@@D@@AB@@AB@@@@A@@@@B@@@@@@BBA@AB@@@AAB@@B@@@@@@B@@@@@BA@@@BB@@@B@B@@@@B@@@@BB@@@@B@@B@@@AB@@@@BB@B@@@@@B@@AA@@AB@@@B@@@B@@A@@@@B@@@B@BA@A@AA@B@@BB@BB@@B@BB@@BB@@B@B@@DB@BBBAB@@BBDBAB@@A@@BA@B@@B@@@B@D@BA@@B@@@@@B@BABB@@@@B@D@BABCBA@@@A@@BA@B@@@@@@@B@@@@@B@@B@BAB@@@@@@@BA@@@@BA@BB@@B@B@@@B@@@@@@@B@@@@B@AB@B@@@D@@@@@B@B@B@BA@@BABCDC@@@@@ABA@A@@@A@ABA@@@A@@@A@A@@@CB@@AB@B@@@D@BBB@@A@ABA@AB@@@B@B@@B@@@@@@B@@@@BB@@@@@DC@@@ABAB@@A@@B@BA@@B@@@B@@BB@BAB@BA@@BA@@@@@@@BB@@@AB@@@BB@@@@B@@B@@BB@@@@B@@BB@A@@@@B@@@@B@BB@A@BBA@@B@@@B@@@BA@BBA@B@@@D@BB@@DA@@B@B@@@B@@BB@BBB@@@@BB@@B@@@B@@B@@@@@B@B@@B@@@@@A@A@AAA@@AA@@@AAABA@@@@@@@DB@BBB@@@BC@@B@B@B@B@BB@@ABBBBBBB@@@B@B@B@@BB@@@BB@@BB@AA@CBA@A@@B@@B@@@@@@B@@@B@@@B@B@@@@@@@DBBA@B@@@B@@BA@@@@@@@@@A@@@@@A@@@A@@B@@@BABAB@BA@A@@@AA@@@BA@AB@@A@@@@@AA@@@@A@A@A@@B@B@B@@@BB@@DBB@@A@A@@BA@@B@BA@@BF@B@@@BB@@B@@BBB@@A@A@@@@@A@@C@@@@A@@@A@@B@@@@@@A@@@@@A@@@AB@@@@ABAB@@AA@BC@@@ABABABA@CB@@AB@@@@@@A@@AA@@@@@@A@@A@@@@@@@@@A@@@@@@@A@AA@@AAAB@B@@A@AAA@A@AA@AAAC@@@A@C@@@A@@@C@@BA@@B@BB@@@A@@@A@@BA@AB@@@B@@@@BB@B@DBB@B@@ABB@@B@BBB@B@DBB@@BB@B@BB@@B@@@@@BA@@@@@BB@@AB@@@@@B@@B@@DD@@@@B@@@@@@B@@@@B@B@@@@@B@B@@@BBB@B@B@@@@BBB@@BBB@@AAA@A@@@@@A@@BB@@BB@@BBBBB@DB@@@@@@@B@@@@B@@BB@@BB@@@BB@B@@@BB@BB@@BAB@@@@@@@@A@A@@BA@@@@@A@AAA@BC@@@@A@@BC@@@A@@@@@A@@@AB@@AB@@@B@@@@@B@B@B@@G@@B@@B@@@BBA@@B@@A@@@@@AB@@@@@B@@AA@BA@@@A@A@A@C@A@@@@@AD@B@B@B@BD@B@@@BBABB@ABC@@BB@@B@@A@@@A@@@@BABA@@@@@@@AAA@@B@BA@@@A@@@@C@@@@@BAB@@A@@B@B@@@D@@@B@BA@@B@@@@BB@@@@@@BA@B@@B@@@AB@BA@A@@@@AA@@@@@@@A@@A@@A@@BB@ABB@@BA@BB@@A@@@ABB@@@B@@@@B@@@@@@@B@@@@B@@@BB@@B@@@@@@BAB@@@BB@@BBD@B@@@@@@@@A@@B@@A@B@@BB@@@@@@BB@@BA@@@@@A@@BA@@@@@B@@B@@B@@@@@B@@AB@@@B@@@BABA@@@@@B@@@B@@@@@@@@@@@B@@@@B@@BB@@@B@B@B@@@@AB@@@B@@@BB@BBB@B@@B@@@B@B@B@@@@B@@BB@@@F@B@@@@@B@B@@@B@@@B@@@@@@AAAAABE@AAA@C@@@A@A@@@@@@B@@@@@@@@A@@B@@@@AA@@@@@@@B@@AA@@@DA@@A@BA@@@@@A@@AA@@@@AB@A@@@@A@@A@@A@@@@@@@@A@@@@@@AA@A@@@AB@@ABB@@@A@@@@BAB@@A@@B@@@@@BA@@B@BA@@B@@@A@AAA@BA@A@@@@@@@A@@@@@A@@@@@A@@@@B@B@@@B@B@@@@@B@B@BBB@DB@@BA@@BA@@BA@@@@B@B@B@@@@@@BAB@@@B@@D@B@@@@DBB@@@@@@B@@@B@@@@B@@D@@@B@@@@@@BA@@@A@@@BB@@@B@@@@A@@@@@A@@AA@@B@D@B@@@B@@B@@@@@@@B@@@@B@@@@@B@@@@@BB@@@@B@@@@@B@@@B@@AB@@AB@@@@@@@@@B@@A@@@@B@@@B@B@@@@@@C@@@@@@@@B@@@@@@@B@@@BB@@@BBB@BB@@@@B@@@@@DD@@@@@BBB@@@@@BADA@@B@@B@B@@B@@@BB@B@B@B@@@@@B@@@@@AB@@@@BB@@B@BA@B@@B@@BB@@@B@@@B@@@@@@@@@@BAB@@@BB@@B@B@B@@@@BB@@@B@D@@@BB@@B@@BB@@@BB@@B@@BBA@@@AA@B@@AB@@@@ABA@@B@@@B@@@BB@@@@@BB@@DB@@BB@@@@@B@BB@B@@@@DB@@BB@BB@@@B@@B@@@@A@@BB@@@@D@B@@AA@@@B@@A@B@@B@@@@B@@B@B@@@B@@@@@@D@@@@B@@@@@BC@@BAB@@BB@@B@@@BA@A@@@@B@@@B@@@ABB@@@@@@BB@BADA@B@@B@@@@B@@@@B@@B@D@BAB@@@BB@@@@BA@@B@@@@@B@B@@A@@A@B@@@@@@@BB@@@AB@@AB@@@B@@AB@@@@B@@B@BAB@@@@BBAB@B@@B@@BB@BBB@@B@@@B@@BB@@@@@@B@@B@@@@@@B@B@@AB@@@@AB@BAB@@@B@@@BB@@@@B@@@BBBC@AB@@@@A@@BA@@B@@@B@@AB@@B@@@@@BB@@@B@@@@BB@@@@@B@@@@@@@B@@@@BB@@@@@A@@@@@B@@B@@@B@@@@@BBB@@@B@BA@@@BB@@@B@BAB@BA@BB@D@@@@@B@@@@@BA@@@@@B@@B@@@@@@B@@@@@B@@A@@B@@@@@@B@B@@@@B@BA@@B@@@@@@@@BB@@AB@@@@@B@@@@@@@B@B@DA@@@@BABAB@B@@@@@@A@@B@@@@BBABB@@@BB@@B@@ABB@@B@@A@@B@B@@BB@@B@@BBB@@D@B@@BB@@@@BB@@@B@@A@AD@BA@@D@BAACA@B@B@@@@@B@@@@A@@B@@@@@BBB@BBB@B@@@@BA@A@AB@@ABB@@@@B@BBB@@@@@@B@@BB@@@@@ABB@@@@A@@@@B@@@@B@@@@@@@@@@B@@B@@@@@A@@@@@BBBBB@B@@A@@BA@A@A@@B@BA@@B@BB@@@@@@BA@@@A@A@BB@@B@@@@FCB@DA@@B@@@B@BBB@@A@@@A@@BAB@@@BA@@@@@AAA@ABAB@@A@@BA@BB@B@B@BA@@@@B@@A@@B@BB@@@A@@@@@@BA@@@@BA@@B@@A@@@@D@BA@@@B@@BB@@@@B@@@@B@@B@B@B@@@B@@@B@B@@B@@B@@AB@B@@@@@B@BB@B@@B@@B@@@@BB@@BB@@B@B@@B@@BBBBBB@@BB@BB@B@@B@@@@@@A@@BA@BBDB@@@AD@BAB@B@BAB@B@@AB@@@B@@@B@D@@@@@BAB@@ABBB@B@@@B@B@B@B@@BBB@B@@@@B@BBB@B@@AB@B@@@@AB@@@@@B@@@@@B@BA@A@C@A@@@A@@AA@@AA@@AA@@@A@@BC@@BA@@@AAC@@BAA@@@@@@A@AA@AA@A@@A@AA@@@EB@@@B@@ABABA@@@@@@B@@@@BD@@@@BB@@@BADCBAFEBABAB@@ABBBA@@A@B@@AB@@@@@@@@B@@BB@@@@BB@@@@B@@B@@B@@@@A@@@@BA@AB@DBB@FBF@A@@@@AA@@AA@@AA@@A@@A@@AAA@@@@@@@A@@@@@@B@@@BAB@@@DA@@BA@@@@B@BA@@@@B@@AB@@@B@@@BBB@@@BB@@@@DAB@@@DB@@FBDBD@@BB@@@B@BAB@@B@BDDB@D@B@@@B@B@B@@@BA@@@@@ABABA@A@@@AAC@@@A@@@A@@BC@@DABAB@@CBA@CBDFF@@@B@@@@DBD@@@BADAB@@@B@B@@@BAB@B@BAB@B@B@BBB@@A@@BA@AB@BA@@BAB@@@@@DBBBB@@BB@BA@@B@BA@@@@BAD@DBD@BA@@BCBABA@AD@@@B@@A@@@@@@B@B@@A@@@A@@@AB@@BB@BA@BB@@BA@@B@@@D@B@B@@B@DBB@@@@A@@@A@A@@AAB@@@@@BA@@AA@@@@B@B@DBBB@@@@@AB@@@BA@@DADA@@BA@@BB@@B@B@DAB@@A@@B@BEBA@@BA@@@@B@B@D@B@B@@@D@@@B@@AB@AABA@A@@AA@@@@BAB@@A@@DA@@A@BA@@B@B@@AB@@AB@@@B@B@@AB@@@@A@A@@A@@AA@@AB@@@@AA@@@AAAA@@AA@@@A@@@A@@@@BA@@A@CCA@@@@A@@@A@@@A@A@@@@@@A@@A@@@@A@@@@@A@@@@@A@@@@A@@@A@@@AA@@@BA@@@AA@@A@@B@A@@A@@@A@@@@BAB@@@D@AA@ABA@@B@@@B@@B@@@B@@@@@@@BBAB@BBB@B@B@BB@@@@@@BB@@@@@B@@B@@B@@@@@@@B@@B@B@@BB@@@@@@A@@@@B@@@@B@@@BB@@@BA@@@A@AB@@AB@B@@@@@@@B@@@@@@AB@@@@A@@@@@AB@@A@@@@@@B@@@@@AAAA@@A@A@AA@@EA@@AA@ACAAC@A@@BA@@@@AA@@@@A@@AA@@A@@BC@@@AAA@@@AB@AAAA@@@@BA@@AAAA@@@AAC@A@AA@AA@AAA@@@@DA@C@A@A@A@@F@@BB@BBB@@A@@@@B@@@B@AA@AAA@@@@@@BA@@@@@A@@@A@@@AA@@@@AB@BA@@B@@A@@@@@ABA@@@@BABA@A@@DC@@BADB@@BBB@BAB@@@BE@A@@DAB@@A@@AB@AA@A@AA@@AA@@@@A@@@AA@@A@@@CC@A@A@AB@@@BBB@B@B@BB@@B@@@@AB@B@@@@AB@@@@ABB@BB@@@B@@@B@@@B@@@@B@@@B@@F@@@B@@@@B@@B@@B@@BBB@B@@B@@@B@BB@@BB@@@D@@@B@@@BDBBB@BAB@B@@@BAB@@C@@@@@ABA@A@@@ADA@@B@B@BA@@B@@B@@@B@BB@@@D@BBBAB@DB@@BBB@@@D@@@D@B@@@B@@AAABE@A@@AA@A@AB@@@B@BCBAB@@@@BB@B@@A@@@@@@BA@@BA@@B@@@@AB@BA@@BA@@B@@BB@@@B@@B@@BB@@B@@BBA@@B@@@@@BAB@@@@A@AB@@@@@AA@@@A@@@@BA@@BA@@@@@AA@@A@A@@CC@AAA@@@@@A@@@A@@@A@@@A@@AAC@BC@ABA@A@A@A@ABA@@@A@@AA@@BA@A@@B@DA@@B@@ABA@AB@@AD@DAB@B@@@B@@@@A@CBCB@@A@AB@BA@AB@A@ACA@AA@@A@A@AB@@CA@AA@A@@A@@@A@A@@CAAAA@C@@@AA@@@@AAA@A@@@A@@ACA@AA@@@BE@A@@AA@@AAA@AAA@AA@@@BA@C@A@A@@@AAAA@@A@@@AB@@A@A@@@CA@ACAA@A@@A@@@AAA@@@@@@A@@A@@@@@@BA@@B@@@@A@@@@AA@@@A@@BC@@D@@@@@@AEB@@@AAA@@@@BABA@@CC@@AAA@@@@@@AA@A@@ACA@@@@@A@@@AA@AAC@AAA@@ACAA@AAA@@@A@A@A@CBABABABAB@@A@A@AC@@@@A@CA@@@@AAAC@@@@EDABCBA@ABA@A@A@@@A@@@@B@@@B@@B@@@@BAB@@@@A@@BA@@@BDB@@@E@G@A@A@ACC@A@@AA@AAA@AA@@@@A@C@CB@@A@C@AA@@AA@C@@A@@@A@A@A@A@AA@@@@@ABA@@@A@A@AAA@CAA@@@@AB@@AB@@@AA@@A@@AB@@ABA@@@@@AA@@@A@@BA@@A@A@A@A@@@@@BAA@@A@@B@@A@@@@B@@@B@@@@@@@B@@AA@@AA@@A@@@@A@@A@@@@@@@A@@A@@@@@@@@@@AA@@A@@@@@@A@A@@B@@@@A@@A@@A@@@A@@@@@AB@@B@@@@B@@@BA@@@@BADA@ABA@A@@@@@AAAA@A@A@AAA@A@@@A@@@A@@@A@@@A@@B@@A@ABAAAB@@A@C@A@@B@@@@@B@@@A@@@AAA@AA@A@C@@@@BA@A@@A@A@A@A@@@C@AAABEAA@@@@BA@@@A@@@AA@@A@@@@@AA@@AA@@A@@BA@A@@@@@AA@AAA@@A@CC@@CAC@A@@@A@@@A@A@@@@@A@A@@@@BA@AB@@@@@@@AA@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@B@@@@@@@@A@@@@@CA@A@@@@@@@A@@@@AA@A@@A@C@@@A@@@@@A@@@@@@@A@@@AB@@@@A@@@@@@@@A@A@@@AA@@AA@@AA@A@A@@@A@A@A@CB@AA@ABA@A@@@A@A@AAC@A@@@A@A@@@AB@@AA@@A@A@A@@A@@@@@AAA@AA@@@ABA@A@A@ABA@@BC@A@@@@@AA@@@@A@@@@@@@A@@AA@@@@@@@@@A@AA@@@@@@A@@A@@AA@@@A@@B@@A@@@AA@@@@A@A@@@@A@ADAAAB@@@@A@@@@@AAAC@@@A@@@@A@C@@@A@@A@@@@@ABC@AA@@@@@@@A@AACA@@@@@@AA@@@@AA@@@@A@@@AA@@AB@BA@BD@@@B@B@@@B@B@@@@AB@@@B@@BB@BBB@@@B@@@BAB@B@@@B@@@BD@BBBB@@@@@B@DA@@B@@@B@@@BB@@B@@@B@@@BBBCD@@AB@@AA@@AB@@AB@@@@@D@@@B@@@B@@@@B@@B@@@B@@@B@@AB@@@B@@@@@@B@@@B@BA@@DA@@BB@D@@@@@B@B@@@@@@@B@@ABA@A@@@A@@@AA@@A@@@@@AA@@A@AA@@AAAA@@@A@@@@@@@@@A@@@@@AA@@@@AA@@@@A@@AA@@@@@AA@@@A@@BA@@@A@A@A@@@@@@AA@AAA@@@@A@@A@@A@@@AAAA@@AB@@@@AA@AA@@@@AA@@@@A@@@@A@@@@@@@@@A@@A@@@@@@@AA@@AA@@AA@@AAA@@@@A@A@@A@@@@@@@@B@@@@A@@@@A@ABC@C@@@@A@@@@AB@@@@@@A@@@@A@@@A@@@B@A@B@@AAAA@@@@@@@A@@B@@@A@@A@@@@C@@@@@BA@AB@BAB@@C@@@@BA@@@@@AAA@A@@@@AA@@A@@AA@@@A@@@@@@A@@@@@A@@@@@AA@@@@AAA@@@@@@AA@@@@BA@@@@A@@@A@@AA@@@BA@@AA@@@@@@@AAB@@AA@@AA@@@@@@A@@AA@@A@AA@@@@AA@@A@AACA@@BA@@@@@A@@A@@@@AA@AA@AA@@BAA@@AAA@@@@AAA@@@A@@AA@@A@AB@@A@A@@BA@@@@B@BD@@B@@@B@@BB@BB@B@@B@@@B@@@@B@@B@@ABA@A@A@@@A@A@A@@AAAA@C@@@CDA@@@@A@@A@A@@@CA@AA@@@A@@@A@@AA@CAAA@@C@EBC@@@A@@BAB@BCD@@A@A@AAE@@@@BA@@B@@@BA@A@A@A@@@@@@B@B@@@@A@@BCBAB@BA@@@@@A@@B@@@@@B@@@@@@@@AB@@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@AAA@@@@@@@@@@@A@@@@@A@@@@@@@@@CB@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@A@@@@@@@A@@@@A@@AC@@AC@@A@@@CA@ACAA@@AA@@B@@@B@@A@@A@@@A@@@AB@B@@BDADA@AB@AA@@@@AA@@C@A@@A@@@A@@ACCAAA@@A@@A@@@@@A@@A@@@AAA@AA@AA@A@@AA@@@AA@C@@A@A@CB@@AA@A@@C@AAA@AAA@AAA@@@@A@@@AA@EA@@@@@@@BA@A@@@A@@@AA@@A@AB@@A@@AA@@@@@AD@BA@@@@@A@AA@@AA@@A@@B@@@@AB@@ABA@@B@@@@@B@@AB@@@B@@@@@@AB@B@@A@@@@@A@@B@@AB@@AAA@@A@@@A@@BAB@BA@A@@@C@AAA@AAC@@AA@@AA@@AA@AA@@@A@@AA@@@A@@A@@AAAA@@AAE@@@@@@@@@@B@@@BBB@BA@AA@@@@@AA@@AABAA@@@C@A@@@AAA@@AAB@@A@@@AB@@@C@@@A@@A@@A@@@A@@@AA@@A@@@BB@@@BB@@B@@A@ACC@A@A@A@AB@@@B@D@D@@@BBB@DBB@DB@@BBD@@@BBB@@@D@B@@@B@B@@@@B@@B@@BD@B@B@@CA@AA@@A@@@@A@A@@@@@@A@@@@AAA@@AA@@@@@@A@@@A@@@@@A@@@@ADCB@BC@@@AB@@@A@A@A@@@@BA@@@@@A@@A@E@A@AAA@AA@AAA@@A@@AA@@@EDABA@A@@B@@BB@BAB@@@@A@AAA@@@@@A@@@A@@B@@AB@@A@AAA@A@BB@@@B@BBBB@AB@B@@@@@@ABC@AAA@@@A@A@@@@@@@@AA@@@A@@@A@@@@BA@@@@@@@A@AB@@@AABAA@BA@@@A@@BA@@@@@AAA@@@A@A@ABBBB@@@B@@BB@@@BBB@@B@@D@@B@@@@@BA@@@A@A@@B@@A@@@AB@DAB@@@B@@A@@@A@A@@BA@@@ABA@@@AAA@C@A@@BA@@B@@A@A@@@A@@AAB@B@@@@A@A@@@A@A@C@@@A@AAA@AA@@A@A@AA@@AB@@A@@@C@A@A@@AAAAC@@@@AA@CA@@A@@DC@A@@AAEA@AA@@@A@A@A@@@ABCBAB@B@@A@ABC@A@ABA@@BA@@B@@@DAB@BAB@BA@CACA@@@A@ABA@C@@BA@ABABABA@@A@A@AAC@@@AD@@AB@@AAA@@@@@AABC@C@@@AB@@A@C@C@AAC@A@A@@@@A@@BAAAA@@@@A@E@@@CB@BA@@DAD@BAB@B@@C@EAA@BE@@@C@C@A@@AA@@A@@@AA@@@A@AAA@@@@A@@B@BAB@B@@ABCBCD@@AD@@@BCA@@AA@@A@AA@@@@@A@A@C@@@@@@DAB@@@@@BC@@BADAA@AAA@AA@AC@AA@@@D@@AB@BAB@@@@ABA@@BAB@@@@C@@@@AA@A@AA@@AA@@A@@AA@A@AA@@A@@A@@A@@@@@ABA@@BA@@@ABA@@AAACE"],encodeOffsets:[[121393,26290]]}},{type:"Feature",id:"350181",properties:{name:"福清市",cp:[119.384201,25.72071],childNum:50},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@A@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@"],["@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@A@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@"],["@@@@@@@@@@@@B@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@"],["@@B@@@@@@@@AA@@@@B@@@@@@@@"],["@@A@@@@@@B@@@@@@@@B@@@@@@@@A@@@@"],["@@@@A@@@@@@@@@@B@@@@@@B@@@@A@@"],["@@@@@@@B@@@@@@@@B@@@@@@@@@BA@@A@@@@@A@"],["@@@@@@B@@@@@@@@@@A@@@@A@@@@@@@@@@B@@"],["@@@@@@@@B@@@@@@@@@@A@@A@@@@@@@@@@@A@@@@BB@@@"],["@@@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@A@@@@A@@@@@A@"],["@@@@@@@@@@B@@@@@@A@@@@A@@@@@@@@@A@@@@@@@@B@@@@B@@@@@"],["@@@@AB@@@@@BB@BA@@@@@AA@"],["@@@@@@@A@@@@@@A@@@@B@@@B@@@AB@"],["@@A@@B@@@@@B@@@@B@@@@@@A@@@@@AB@A@@@@@"],["@@@@@@@@@@@@@BB@@@@@@@@@@A@@@@BB@@@@@A@@A@@A@@A@@@@@@@@B"],["@@@@@@@@@@@A@@@@B@@@@@@@@@@@@A@@@@@@A@@@@@AB@@@BB@"],["@@@@@@@@@@@@@@B@BB@@@@B@@@@@@@@@@A@@A@@@@@AA@@@BAA@@@B"],["@@@@@@@B@@@@@@@B@@B@@@@@@@@A@@@@@@@@@A@@@@A@"],["@@BBB@@@@@B@@@AA@@A@@@A@@@"],["@@@B@@@@@B@@@@B@@A@@@@@@B@@@@@@A@@@@A@@@@@A@@@"],["@@AB@@@@@B@@B@@@@A@@@@B@@@B@@A@@@@A@@@@@A@@@"],["@@A@@B@BB@@@B@@A@@@A@@@@A@@@"],["@@@@A@@@@@AB@@@@@@BB@@@@@BB@@A@@@@@@@@@@B@@A@@AA"],["@@A@@B@@BB@@@@@BB@@@@@B@@@@A@@@@@AA@A@@A@@@@@@@@"],["@@@@@BA@@@BB@@@@B@@@@A@@B@@A@@A@@A@@AB"],["@@@@@@B@@BB@@AB@@@AA@@A@@@A@A@@B@@@@B@"],["@@BD@@B@@@@@@CA@@@@@@A@@@@@@@@A@@@@@A@@@B@@@@B@@@@"],["@@BB@@B@@@@A@@@A@@@@@@@@BA@@@@A@AA@@@BA@@@@B@@@@@B@@"],["@@B@@@@@@@BA@@@@@A@@@@@@@A@@A@@@@@A@@@@B@@@@@B@@@B"],["@@@@B@@@@@@A@@@@@A@@@@A@@@@BA@@@AA@@@B@@@@@@A@@@@@@B@@@@@@@@B@B@B@"],["@@BB@@@@B@@ABAA@@AA@@A@@A@@@@@A@@B@@BB@@@B@@"],["@@@@BB@B@@@B@@B@@@@CBAB@@@@@@A@@AA@@A@A@AB@B"],["@@B@@BB@@@BAB@@@@A@A@@@@AAA@A@@BA@@B@B"],["@@@@@@A@@@@B@@DB@@@B@@B@@B@@@@B@@B@@B@@@@AB@@@@AA@@A@@@@AAA@@@@AA@@@A@"],["@@@B@@D@BAB@@ABA@@AA@@C@A@A@@@@@@B@@@B@B@@"],["@@AA@@A@BA@@@AB@@AAA@@@A@@@A@@A@@@@B@B@B@@AB@@@B@@@@@@A@@B@B@BB@@@B@@A@B@@B@@@@@BA@@"],["@@BBB@@AB@@@@@B@@@BB@@B@@@@@@C@@@@AA@BA@@@A@@A@AA@@AA@A@@B@B@B@B"],["@@A@@AA@A@@@AA@B@@AB@@AB@@@BBB@@B@@@@@@@@@@A@@B@@@@@@@B@@@B@@@B@@A@A"],["@@B@B@@@@@@A@@B@@AA@@AA@@A@@@@A@@@@@@@A@@@@@@@A@@@A@@@@B@@@@BB@@A@@B@@@BB@B@@@B@"],["@@A@A@A@AA@@AAA@@@@B@@@@BB@@@@@@@B@@@@@B@@BD@@B@@@B@@A@@B@@AB@@C"],["@@AB@BB@DBBBB@@@B@B@BA@@@A@@A@AAAA@@@AAAA@ABAB"],["@@@@@C@@AA@@@AA@AA@@AAABA@@@@B@@BB@BAB@BB@@@BA@@@BB@@BB@BB@@B@@A@A"],["@@@@AA@@AA@A@@B@@CBAB@BADAB@@@BA@@AAC@@@A@AB@BG@I@@@@B@@@B@@@BBB@B@@BB@@B@@@BBB@@BBBB@@AB@"],["@@HFDDDF@@FB@@B@@@@AAABACCCIGIAAA@AAC@A@AB@BAB@BBBBBDD"],["@@AE@C@C@ACC@A@AFEBC@AAAAAE@CBABC@A@E@AAABABABA@A@@@A@A@@@A@A@A@@BBB@@B@@B@BAB@@BBB@B@@BBB@BB@BBAB@BBBDBBBB@B@B@DBFBDBB@B@DB@@@A@@@C"],["@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@@"],["@@BAB@B@BB@@B@@A@@B@B@@@DBB@@@@@B@@@B@@@@@BAB@@@BB@@@@@B@@B@@@HBB@@AB@@@@BB@@B@B@@@@BBBA@@@@@AB@@@DADADA@@@@BFB@@@DBBAB@@@@@@@@AB@@@@AB@B@DB@@D@B@F@@@DBB@BB@@DC@@@@B@BB@BBBBB@@@@@B@@@B@@B@@@B@@A@AB@FAF@B@@@BABAB@@@@@B@AA@C@AB@@@@@BAB@BBFBB@B@@A@@@A@@@A@A@A@@BA@@@@A@@AAC@@@A@@AAA@A@@BA@@@A@@@@AA@CA@AA@AA@@@@BAA@B@AA@@AA@@@@@A@@A@@@A@@@@B@@ABA@AA@@@A@@@B@@@@A@@@@@@@AC@@B@@@@@@B@@@@B@@@@@@@@A@@@@@@A@@@@@@@@A@@@@B@@@B@@@@@@B@@B@AA@@@@@@@A@@A@@@@A@@@AB@@@@A@@BABAB@BA@@@@BBB@@@@@B@BA@@@@@C@@@ADB@@BBB@B@B@@@@@@A@@AA@@@A@@@@@A@@@@@@@A@@@@DA@@B@@@@AB@D@@@BB@@B@@@DAD@FABAB@B@BBB@@@B@@@B@BAB@@@BAD@DC@@@A@@D@BAB@@@@A@@B@@@B@@@B@B@@@D@@ABAB@@@@@@AAAA@AA@@A@@@BA@A@@@@CA@AB@@@AA@@@@B@@@@@@@AAAC@AAAA@@@@@AA@@@@AA@@A@CAAA@C@@BAA@@@@@@@@@@A@@@@@A@@AA@@@A@@@A@A@A@@@CA@@A@@@A@@BABA@@@ABA@@@@@@@A@@BABCBA@@@A@@BA@AB@BAB@@@@@BA@@B@@@B@@@@D@A@@B@@@@A@@@@@A@@AAAEAC@@@@@ABAA@@A@@EC@ABC@A@@FKA@MBE@C@[FG@UBO@G@IA[GKACACAOAIC@@@CAGEAGCKBAAECAE@@@IBEBADEBG@CBIAE@CBATBL@FCBCFEFAJFF@LARCFBDFDDJ@FCBGAG@C@A@CBCBEBC@G@AAAAACC@@ACCAEE@@@A@AA@@A@@@@DKB@B@@@B@B@B@DAB@FAFAD@@@FBH@D@J@DAFADBFB@@JDPNJBD@H@DABCFE@CACCM@ABAFCBA@@HEBABGDIHC@GAGFSBCBE@ECGGGCCAA@A@G@E@CDMFEJIBAFIDC@E@EGE@A@C@CBADAD@F@DBBBFFDFFFDDDBFBB@DABA@EAKBK@AB@hHF@@A@@@@B@@A@@@G@@@CBA@@HIC@CDCFAB@DuMEA@@AA@GCK@CCEIEOGG@CC@CDCDAD@F@FADAB@F@BBBF@BB@B@D@FC@@DED@DAD@@@@FAF@B@B@D@@BBB@D@BBBAB@B@BBB@DABADE@G@G@CBCBC@A@ACEAA@E@E@AAAA@A@AACBC@@BC@CA@C@C@C@AAA@E@CDGFK@CDC@C@A@C@CDA@ACCCA@CBAAAA@CDCDC@EAGGA@CCKAEACACAC@@A@A@A@@DAD@FBF@B@D@LDHDDDDDB@D@@@BA@@@A@A@AAC@AAA@CCCACAAAAA@AAAA@CCAEAEAC@CAECE@C@E@EBC@C@ABMBC@AAAAEAACCAEAC@C@ABCHCDGJ@D@DDBBBD@D@B@@@@BCDEJCBCFELCJ@D@B@FBFBDDBDFDRCFAH@F@BABA@ABC@@DCB@B@D@@@D@D@@BDDDBB@BABA@EDCB@@AB@BBBBD@B@N@@AFADCDCBG@A@CAEAEECEBEAGAEA@AGBA@AFEB@@@B@BA@@@@DABCBA@E@CBA@@B@B@D@DBBCBABEBEDG@A@ACACCAAEAEAAEAC@CAA@AA@@AA@CCCAABE@@@CAAAAA@A@C@A@AA@@@AAA@@@@A@ABA@A@A@AACA@AAA@CAC@AAA@CACAA@AAA@AB@@@@@B@@@D@B@BBBBB@B@D@D@DCBA@EBGBGAG@CAGIGAGCA@AA@CACAE@AA@A@GBIF@@ABAB@F@DDFFJLJRNDD@D@D@BADCHQVGFEBA@ABAB@HBFBLFRBHADABABEBE@A@AAC@C@A@G@IB@@E@A@AACIEKACEECECCCCG[EACDBTCJMJAD@DPJTT@HABEBABA@AD@DB@@HBNBFDBDFHXBFAFGDMBI@OSAAEAiHCBABATABCDsVIFCBEBEAC@ACCEEOAEEYEYAODOFODIJO@EBI@G@EACCCI@CECBCDGHO@IGG[IEIDM@GAG@SBWTOJKVA`JZJRHFCDC@A@A@AA@@CAA@AAA@A@EBCBAB@B@N@F@@A@@@GEOIQICCA@AA@A@C@A@@AAA@CBA@A@C@C@AACACDADABA@A@A@ABAB@BDD@B@BB@@@@B@B@@@BAD@DA@@BABABA@AB@@BD@@A@B@@@B@@B@AB@@@@@@@@@@@@@BBA@A@A@@A@B@@@@B@@BB@@@@@AA@@B@DADD@@@ABA@@B@D@B@D@B@@AB@BA@@@@@@B@BB@DBBDD@B@B@BB@@@@BDB@@@B@@@B@@@BBB@@ABCD@@AB@BA@@BB@@BB@@@@A@@@AB@BAB@DAD@@@@@BBBB@BB@BBB@D@@@B@@@AB@BB@CF@B@B@@BD@@B@@DBB@B@B@@@B@B@B@B@@@B@B@@ABAB@@@BA@@BA@@B@@A@@@@@@D@BAB@B@@A@A@ABAB@B@B@B@@@@CB@@@B@@BD@B@B@B@B@B@@A@A@ABABAB@BA@@B@BB@@B@@A@@B@@@B@B@@@@A@@AA@AAAA@AA@@ACACCAAAAA@@AA@@@A@A@CAAAA@AAAAA@AA@AAA@@@@@A@@@@@@@@AB@@AB@D@B@@B@ABAB@@@@@AA@@@@@A@AB@@A@@@A@AA@BABABA@@@@@AB@B@@A@@AAAC@@@C@@@A@@@A@ABA@@@A@@@AB@@@B@B@BA@A@@BAD@@@B@@A@ABCBA@A@A@@@AB@BAB@DAB@BABBB@@ABAD@@ABAB@@ABA@ABABA@EBBD@BB@@@BBF@@@@@BB@@B@@BAB@BB@@@@@B@@A@@@@BE@@@@@A@@@A@@B@@CB@@@D@@@@@@ABA@@@B@B@B@B@@@BA@@BAB@@@BB@DD@@@@AB@@A@@B@BA@@BA@A@@@A@@@BA@A@A@@@AA@A@@@@@A@@@A@@@C@@@@@BDBBBB@BBD@@@D@@@@@BAD@@A@A@A@CAAB@@B@@@ABA@A@AB@@@B@B@@@B@@@@BB@@@B@@@B@@@@BB@@@@ABA@@BA@@@CD@D@B@BA@@BA@@@@@ABAAA@ABA@A@@BA@@BA@A@@@@B@B@B@BA@A@A@@BA@B@@B@@@B@B@B@@A@ED@@@B@@@B@BBB@B@B@@ABABAD@B@@@BBB@@BB@@@@@B@B@@@@@BB@@B@@B@@@@B@@BBBB@@@@@BB@@@@@@B@@@B@BBB@B@B@B@B@B@@@BFBBB@@BB@@@BA@@B@@@@@BB@@B@@A@AB@BAB@B@B@B@@B@@@B@@AB@B@B@@@B@B@DAB@@ABBB@D@BB@@@BA@@BABABCDABA@A@@@@@@AB@DC@A@@@@A@@@@@ABABA@ABAB@@A@A@B@@B@@@@A@@@@B@@@@@@@B@@@@@B@@@@@@@D@@@B@B@@@B@@@@@B@@@@ABCD@BA@@@B@@@B@D@B@B@B@@@DBB@B@@@DB@B@@A@AB@@AB@@AB@@@@B@BBBB@B@@@@@@A@AB@BA@@@@@@@@A@@C@AAA@@@@@A@@D@B@BAB@BA@ABA@@@AB@@@@AA@@@@A@A@@@A@@@@@@B@B@B@@@@@B@@A@AAA@AB@@@@AAA@@A@@@@BA@@@@AAA@@@@@A@@@@BA@A@@@@A@@BC@@@@@@A@AB@@@BAB@@BB@@BBBBBB@@DD@B@@B@BBBB@BB@BB@BB@B@BB@@@@B@@@@B@@@@B@@@B@B@@BBBB@@B@@@@@@@BB@B@@@@@@@@@B@@@@@B@B@BB@@@@@@@BA@@@@@@B@@BB@@@B@@B@@@B@@@BB@@@B@BB@@@@B@D@B@@@B@B@B@BA@A@@BA@@@AAA@A@A@A@C@@@A@@@@@A@A@@@AB@@@@A@A@@@@B@@@@A@@B@BA@AD@@AB@@@@CCA@@AABA@@@@@AA@AA@AA@@@@@BAB@BA@@DA@A@G@AA@@ABA@A@@@AB@@EA@@@B@@A@@@ABA@CAA@A@CA@@@@@B@@A@A@@A@@AA@@@AA@@@CAAA@@A@AA@@@@ABA@AD@B@@@B@@BB@@A@A@@BC@A@@@@AA@AA@A@@@CC@@AA@@AC@A@@@A@ABCBA@@@A@@BAB@@@B@BA@BBBB@@@@A@@@@@A@@@@B@D@BA@BBAB@B@BA@@@@B@@@B@@@B@@@B@@@BBB@B@B@BBBBB@@@@B@B@BAB@BC@A@@B@@A@@@A@@A@@@BA@@@@B@@@B@@@@BB@@@@@@AB@B@@@@@@@@BB@@B@@@@@@@@B@@@@B@@@@@@@BB@@@@@@BB@@BB@@BA@@@@@@@A@@@A@@@@@@BA@@@@BB@AB@@@@B@B@B@B@@@AB@@@B@@BB@@@@B@BA@@BA@@@BB@@B@@BA@@BA@@@@BB@DBB@B@B@B@@AB@B@@@@BBB@B@B@B@@@B@@@@DBB@@BBD@B@@@DAD@B@@@@@BBB@BBB@@BB@D@BDB@B@H@F@@@A@AC@@B@@AB@@@@@BA@A@@A@@@@A@@@A@@B@@@B@B@B@BAB@DABAFC@@@@BDBB@@@@DBB@@@@@BDB@B@@@BABABABADAB@B@B@@@B@BBB@DB@BB@BBD@BBB@@B@@@B@@@@DB@BB@B@@B@@@@B@BB@@DD@@ABAB@@@@BB@B@@FA@B@@@@C@@@AD@@@B@@BB@@@@@B@@A@@@AB@@@@@@@BB@@@@@@@BB@B@@@BB@B@DB@BDB@@B@B@@@BA@@B@@@BBBB@@B@B@D@B@@A@@BBB@BBB@BB@@BB@@@BAF@@B@@BDB@BB@@@B@B@BB@@@@BB@@D@B@BBDB@@@B@B@@@BB@B@@BDB@@BAB@B@@@BBB@BDB@@B@@B@BABADC@@D@B@@@@@BABABA@@D@@@BA@A@@BA@@@@@A@@@A@@@A@@B@B@B@@A@@@@AA@@@A@@@@BA@@B@B@@B@@@@@B@@@@@@@BB@@@@@A@@B@@BB@@B@A@@B@@@B@@ABB@@B@@@B@@B@@B@B@@@BBB@@ABB@AB@@@@@B@@@@@B@@@@BB@@@@B@B@@@@@B@BA@@B@@ABBBB@@@@BA@@@@BB@@B@@A@@B@B@@@B@@@@BB@@A@@@@@A@@@@@@BB@A@@B@@@@A@@B@@B@@@A@@B@@A@@@@B@@@@@@BB@ABB@@@@@@@BA@@@@BBA@@@@B@@@@@B@@DB@@@@@@@@@B@@@B@@B@@@B@@@@@B@@ABBD@@@B@@@B@B@@@@B@@B@@B@@@@B@@@@BB@@B@@@BB@@@B@@@BBB@@@BA@@BBDBDB@@@@@A@@@A@@AA@A@@@@@A@@@A@A@A@@@AB@@@@A@ABABA@@@A@ABA@AB@BA@@@C@@@C@A@@@@DA@@BABAB@@@B@@@B@@BB@B@@@D@@@@@@@B@@@B@@@BBB@@@@@@BDD@@B@@@@@@BA@@B@@B@@BD@BBB@B@BBDB@@BC@ABE@ABA@A@@BA@@B@@A@A@@@@DC@ABA@@@ABE@C@AB@@@B@B@BA@@B@@@B@B@@@@@B@@@B@@@@@BA@@BA@@DAB@B@DAB@@@@A@AB@DA@ADA@@@A@@@@AA@@@@B@@@@@@@@@D@B@@@B@@@B@B@B@@B@@@@@@BBBBD@@@@@@B@B@B@@@@@BB@@@B@@DB@@@@A@@@@BA@@DBB@@@@@B@B@B@@@A@@AA@@@BC@ABAB@BA@@@BBBB@@@BA@@BA@A@@@@@@@@@A@@@@B@@A@@@A@A@A@@@A@@@@@@@AB@@@@A@@@@@A@@@A@@BA@@BA@@@@B@@@B@BB@@@@D@B@B@B@BB@@BAB@B@BA@@BBDBBBBD@@BB@B@FBB@@BB@@B@@@B@@@@B@D@@BB@@B@BB@B@@@@@B@@@@@@@@@B@B@B@B@@@@@B@@B@@@@@@B@@@@@@@BB@@@@B@@@@@@@B@@@@@@@BDB@@@@@B@@@BB@BBDB@@@BB@@BB@B@B@@@BBBB@@BB@@DB@B@@@@@BB@DB@B@@BBB@@@B@B@@@B@B@@@BABA@A@@AA@@@A@@@A@@@A@@B@@@BABA@@BA@@ACBCAA@@B@B@@@B@@@@@BB@@@@@B@@@@B@@@@B@@@@BB@@BA@@BB@@B@@@B@BA@A@@B@BB@@BB@@@@@A@@A@@AAA@@@@@A@@B@@@B@@@B@B@B@@@@@@@@C@@B@@ADABAB@@@B@B@DABA@@@@BA@@@A@AAA@@BA@@AA@@@A@@@@AA@CAA@@@A@AB@AAA@@@BCB@@A@@@AB@@BD@BBBAB@BABA@@@@BBB@B@D@B@@@@@BA@C@A@A@A@@BA@@"]],encodeOffsets:[[[122528,25978]],[[122330,26023]],[[122477,25932]],[[122483,26194]],[[122480,25932]],[[122375,25975]],[[122375,25972]],[[122496,26124]],[[122443,26032]],[[122438,25999]],[[122489,25944]],[[122491,25946]],[[122499,26142]],[[122337,25968]],[[122420,25975]],[[122542,26008]],[[122358,25920]],[[122245,26043]],[[122414,26223]],[[122499,26129]],[[122376,25970]],[[122403,25968]],[[122280,26060]],[[122299,26064]],[[122511,26041]],[[122231,26018]],[[122498,26124]],[[122518,26070]],[[122455,26007]],[[122336,25979]],[[122478,26197]],[[122386,25960]],[[122351,25932]],[[122414,26229]],[[122355,25916]],[[122331,25960]],[[122480,26186]],[[122438,26058]],[[122440,26037]],[[122548,26024]],[[122478,26205]],[[122542,26005]],[[122233,26022]],[[122346,25914]],[[122475,25940]],[[122264,26054]],[[122450,26285]],[[122357,25958]],[[122367,26411]],[[122340,26408]]]}},{type:"Feature",id:"350182",properties:{name:"长乐市",cp:[119.523266,25.962888],childNum:18},geometry:{type:"MultiPolygon",coordinates:[["@@B@@@@@@@@@@@@AA@@@@B"],["@@@@@@@A@@@BA@@@@@@BB@@@@A@@@@@@"],["@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@BB@@"],["@@@@@B@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@"],["@@AA@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@B@@@@@@@B@"],["@@A@@@@@A@@@AAA@A@@@BB@@B@BB@BDB@@B@@@@A@AA@@A"],["@@B@@@B@@@@A@@@@A@@A@@@AAA@@A@@@AA@@A@@@@B@@@B@@BB@@BBBB"],["@@@@BBB@@@@@BAAA@@@@@A@@B@@AA@@A@@@@@@A@@@@@AA@@A@@@@@@B@B@@BB@@@BB@@@A@@@@@@B@@"],["@@B@B@@A@@@@@AB@@@@A@AAA@@@@A@ABADA@@@@B@@B@BB"],["@@@BA@@@A@@B@@@@AB@B@@BBB@@@@@BAB@B@@@@@@@@AA@@AB@@A@@A@@@@A@@A@"],["@@@@@A@A@@A@@@A@@@@BA@A@AB@@@B@BB@@@B@@@@@B@@AB@@@B@@A"],["@@DBB@@@@@@AB@@@@@@A@A@@@@@@BB@@@@B@@@B@B@BBBA@@@AB@@@B@B@@@@A@@AA@AA@@@@@@@@@@A@A@@B@@A@AA@AA@@C@C@EBABAD@B@B@@AB@@@B@B@B"],["@@CA@@A@AA@AAAA@@BA@A@AAAAACCACAA@@B@@BB@BA@AB@BDDDFD@BBBA@AB@BBBBB@B@@@B@@@FFDB@@DA@@@AGG"],["@@@@A@@B@@@@BD@BBD@BB@@BA@@@@@@B@B@@D@DBBBBBBF@DBB@@DDDABBD@@A@AB@BAB@BB@BBDB@BBDABA@A@A@@CACC@ABA@@@@AAA@A@CCA@@@A@@@@@@ABC@@AA@@A@@@A@@@AA@@A@@@@A@@@A@@@@CB@@AD@@@@@@A@@ACACE@@A@@@@@AC@@A@A@"],["@@@B@B@B@BCBAAA@A@EJAD@D@B@@DDBADBF@DCDBBBNBB@@A@A@@FB@@DC@AEEAAEC@AA@C@A@@@A@BC@@A@AAAC@@CAA@"],["@@@@@@@@B@@@@@@A@@@AA@@@@@A@@@@@@@@BBB@@"],["@@CBB@@@@@@@@@@@@@@@@@@@@@@@BA"],["@@AEAGAICICIGCKAGAOAWAS@MCICEEEE@AGWCSAMACAS@I@GBYBa@SBO@G@EBU@CHIBCH@BEBIAGAAACQKEIEECAIEAAAA@CCIAAAAA@AC@AC@AA@C@AB@DAB@BABA@C@@@ABAB@BA@@@A@@@CCECGAACGCGCAMGSAEL@@@BAD@BFD@@@BB@AB@B@@@@BDBFBB@@@B@@@@@B@@A@@@@B@C@@A@@@A@@@AB@@@@A@ABA@@BAB@@@B@@ABADAB@@@B@@@@@@AB@B@@ABAB@@@B@@@BB@@D@@@B@B@B@@@B@@BB@@@B@@@@@B@@@@@@@@B@AB@@@DBBDBB@@@BB@@@@BB@@@@B@BB@BBDBB@@@@@@A@@@@@BB@@A@@BDB@@@@@BAB@@B@@@BBB@BB@B@@@@A@AB@BC@@@A@A@@@A@@@A@@@@B@@A@ABC@@@@B@@CDC@AB@@A@ABA@@@A@@@A@AAA@A@ABEBC@CB@@A@@@AA@@C@A@@B@@A@@@CB@@@@@B@@@@@@@B@@@@@B@@BB@@@B@@@@A@A@A@AA@@CA@B@@@D@@@@ABA@@@@@A@AA@@@@ABA@ABAB@@@B@@A@@B@@@B@@B@@@@B@@@@@@BBA@@@@A@@@@A@@@A@@@@@@B@@@@@@B@@@@@@@@B@@@@@@A@@@@@@A@@@@A@@@BD@@@@@@B@@@@@@A@@@B@@BBB@BA@@@A@@B@@@B@@@@B@@@@BB@@BBA@B@AB@@@@BBB@@BDBB@@B@@B@@@B@@AB@B@BB@@@B@@BD@BB@@@@@AB@@@B@B@B@@@B@@@BA@A@EAAAA@AB@@@@A@@B@DBBA@@@@@A@ABAB@@A@E@EBA@@B@BA@@@A@@@@A@@@A@@@@AAAA@AAAA@@@@@CD@@AAA@CA@@E@A@C@@@CAA@A@@B@@A@@B@@@@@@A@ABCA@@A@AE@@@@CBCBAB@@@@@B@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@A@@B@@@@ABAA@@@@@A@AA@@A@@A@@BA@GA@@A@@@@A@@@@AA@@A@AB@@@@A@@@A@@@@@A@CA@@A@A@@@@BA@@@AAA@A@AB@@AB@@@B@B@B@DAB@@@@A@C@A@A@AA@@@@ABABA@ABAAC@@AA@@B@@@BA@AD@@B@BBA@@B@B@@BB@DBB@@@@@B@@BB@@AB@@BB@B@B@@AB@@@@ABCBA@A@@@A@ABCB@BA@@@@D@@@@@@A@A@A@@@A@@@A@@@@B@@@@BB@BB@@@@B@@@@AA@@AAA@@@@BABA@@@A@@@AA@@AB@@AA@@@@@A@@A@@@@@@A@@@@AA@@@@A@@@A@A@@@BBADBD@@AB@@ABAB@@A@@@@B@@@B@@@B@@BB@@@BABAB@@A@A@@@A@A@@@A@AA@@@ACAA@@A@@@@@ACA@@AA@@AAAA@@A@A@A@@AA@@A@@@@@D@B@B@@@B@B@@AB@@@@BB@@B@@@@@AB@@@@@B@BA@@BB@@@@@A@@@@@@@@@@B@@@@@@A@A@@@@@A@@@@@A@@@@@A@@@BB@@@BA@@B@@B@@@@@@BA@@BAB@@@@BBDBB@@@BB@@@@BBA@@@@@B@B@B@@@@@B@@@@@@@@@@BC@@B@@@@B@@BB@@@@@B@B@@B@@@@@B@@B@@@BBA@@B@@@@@B@@@@BB@BBB@@@@@@@B@@BB@@B@@@@B@@@@@@@B@@B@@BB@@@A@@B@B@@ABB@@B@@B@@A@@@@B@@@@@@@@B@B@@@@@@B@B@@@@@@@@@@B@@@@@@@B@@@@A@@@A@@@@@@@ABAD@@C@@B@@AB@BA@B@@BBB@BB@A@@BA@@DA@@B@@@@@BD@@BB@B@BBB@B@@@DBB@BB@@B@@BA@@@@@AB@@@@@A@@@@@@@@@B@@A@@@@B@@@@@@@@A@@@@@A@@B@@@@@@@@ABABA@@@@@@@@@@@@BB@@@@A@@BBA@@@B@@@B@@@B@@@@@@@@@@BBA@@@B@@@@BB@@@B@@@B@@@@@@@B@@@@@BA@@@@@@BA@@B@@@@@B@BA@@@@@@B@@A@@B@B@@@B@@@@@BA@@B@@@@@BA@@@@B@@BBB@@@@B@B@B@B@@@@BBABA@BBA@@B@@@@A@@@A@@@A@@B@AA@@@@BA@@AA@@@@B@@@@@BA@@@@@@@AB@@C@@@@@A@@@A@@B@@@@A@@@A@@@@@@@@@@B@@BB@@@@BB@B@B@B@@@B@@@BA@@@@@@@@@@@@B@@@@B@@B@@@@A@@@@B@@@@AB@BA@@B@@@BB@AD@@ABA@AB@@A@@B@@BB@@@@@B@@BBBB@@@BB@@B@@@@A@BB@BAB@@@@@@BBBB@@@@DD@@BBBD@B@@B@BBB@DBHDDBBBDBBBBBBBBDBDDBBBBBDBBB@BD@B@DBD@B@FAFAJAFAB@D@BBD@FBDBFDHDDFDBBBDFBBDJDDDFBDBBBDCDDDJLBBDB@@NNFF@BB@@D@D@D@FAB@FBJ@@BB@DBBBDDFBDDF@@DDDFFJDFHJLNRMNIJIHEB@@BADAB@@@@@@F@@@@@@A@@@A@@@@@@B@@@BAJCDAD@BAD@BB@@BAFEDCB@HApEDBHAH@ZAZA`ABMH]BEFAHBLDDBFBFBHDH@F@NERGFGFGDM@G@EA[CUEWCIEKK_GUAICI"]],encodeOffsets:[[[122534,26303]],[[122478,26331]],[[122686,26379]],[[122877,26603]],[[122537,26398]],[[122544,26307]],[[122567,26383]],[[122846,26566]],[[122498,26320]],[[122485,26325]],[[122530,26371]],[[122543,26384]],[[122528,26293]],[[122847,26569]],[[122816,26587]],[[122597,26600]],[[122368,26410]],[[122573,26544]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("莆田",{type:"FeatureCollection",features:[{type:"Feature",id:"350302",properties:{name:"城厢区",cp:[118.993884,25.419319],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAC@GAIBEBA@A@G@C@@@A@ABABA@C@@@A@A@AAAAAA@CAACAAAEAAAMEKEEACAC@ABEBC@@@C@C@A@BEBCMLEF@@@B@@@B@@A@@@@B@@@@@@@@@@A@@B@@@@@@@@@@A@@@@@AB@@@@@@A@@@@@A@@B@@@@A@@@@@@@@@@@A@@@@@@@@@AB@A@@@B@@@AA@@B@@@@@@A@@@@@@B@@@@@B@@ABA@@B@@@@@BBDB@@B@D@B@@BBB@AB@@@B@B@@AB@B@@@B@B@@@B@@AB@@@B@@@B@@@B@B@@@B@@@B@B@@@@@BBBB@BB@BA@BBA@@@@B@@@@A@@@@@@B@@@B@@@@A@@@C@@@@@@B@@AB@@@@@@AAA@@@A@A@A@@@@@C@@@@DA@@B@@@@CA@@A@@@A@@@AAA@@@A@A@A@@@CBC@@@@B@B@@@B@@AB@@AB@B@@@@@BBBB@@@B@DB@@B@@B@@@B@@CDAB@@E@A@ABABA@AB@@B@BBAB@@A@CC@@A@@@@BAB@BA@@@@@AD@@BBB@@BB@B@@B@@@@@B@@@B@B@B@@@@A@A@AB@@@@AA@AA@@@A@A@AAC@@@@@CBA@A@ABCB@@@@@@BBB@BDB@DFDBBB@@@BB@BB@@@@@B@@@@BA@BBABB@@@B@@B@BAB@B@B@B@@B@@@BEFAB@@A@@@@B@@A@@@@B@@A@@@@B@@@B@@@BB@@@AB@@@BC@@BABAB@@DBBD@BB@@@@@@@@D@D@@@@@B@B@B@@@@@BA@@@@@A@@@A@@@@@@BAB@@@@@BA@@@@@@@@@ABB@@@@@AB@@@D@BCFCBAD@@@B@B@B@@@B@@@@@@B@BAB@@@@@@B@@@@@B@@B@BB@@@@@B@BAFAB@B@BAB@@A@@DAH@B@BBDBBBBBBB@@@AD@BAD@@@B@@@@@@@@BB@@AB@@A@@@@B@@@@BB@@@@@BBBBB@BD@@@@D@@AFAB@@@A@@A@@@@@@A@@A@ABA@@B@@@BA@ABABC@@@@@AC@AA@@@@AA@A@AAAA@AABA@ABA@AA@@@@A@@@@@A@@@@@@AA@@@AB@@A@@@@BA@@BA@@@AB@@AB@B@@@B@@BAB@@@B@@BB@B@B@@@BB@B@@@BCF@@@B@BA@@BA@AB@BAA@@AB@@@@@BABAB@@A@@@@B@@B@D@DAB@BBB@@@BBB@@@@@B@B@B@DB@@@BBBA@@D@@@D@B@BA@@B@@@B@@@@@@@@@@@B@B@@@BABBF@@BB@DB@A@@@@@@B@@@B@@@@@BB@@B@@@@@B@@@@@BB@@B@@@@B@@B@@@@BB@A@BB@@B@@@B@@@@BA@@@AB@@@B@@@BD@B@@@DBB@B@BB@@B@B@B@@@BBD@B@BBB@B@@AB@@@B@B@@@B@@@BBB@BBBBBB@@@BABA@@B@@@@BBABBB@@B@@BBDB@BBB@BBB@BABBB@D@BA@@@BF@BA@@B@@@AA@@@@BA@@@@@@@@@A@B@@AA@@B@A@@AB@@@@ABB@BB@@BB@@@B@B@B@@@@A@@A@AAAA@@@@@@@@B@B@@@BBB@@A@A@@@A@@AA@@BA@@B@@@B@@@BAB@@@@BB@@@BA@@@@@@@@@A@@@A@@@@B@@@B@@@@AB@@@@AB@@@B@@@@@B@@@@@BA@@@B@@@@B@@B@@@@BA@@@AACB@@@@@@@B@@@@@@B@@@@@B@@@@@B@@@@@@B@B@@@@B@@@B@@@@@@@@@D@B@@@@B@@@@BB@@B@@@BBB@@@BB@@B@@B@B@@@B@B@BB@AB@@AB@@@BDB@@BBB@BB@AB@@BBABA@A@@@AAA@@@A@@B@B@@@@@@AB@@@@@DBB@@ABBDB@@@@BA@@@@@BB@BD@@@B@@@@@@AB@@@B@@B@F@DB@@BB@BB@B@B@B@@@@@BE@@BAB@@AHCB@@@B@B@@@BA@@@A@@AA@A@A@@@@B@@@@AB@@A@B@@@E@@B@@@@@@A@@@@B@B@@@@@AAA@BA@@@@@@@A@@@@@AA@@A@@@@@@A@@@@@@@@A@@@@@@AAB@@@BA@@B@@@@@BB@@B@B@D@D@@@DBBB@@B@@@B@B@@B@@BABA@A@@BDDBBB@@@@@AB@@@B@@@B@B@B@B@@B@@@@@BB@@@@B@A@BBA@B@@@B@@B@@@@B@@@A@@@ABA@A@@B@@BBABABAB@BB@@@B@B@@BB@@@BD@@@BB@@@@B@@BB@@@@B@B@B@BABA@@DAB@@ABA@@B@@@@BB@@@B@@@B@D@BA@AB@@AB@B@@@@ABB@CB@@ABB@@@@@@@ADAB@@ABBB@BA@@BA@@B@B@BB@B@B@@BB@@@@B@@@@BA@@@@B@B@B@@AB@@A@@B@@@@BDBDBBB@@BB@@B@B@DBBA@@B@@@@A@@@@@A@A@@@@@@@A@AB@@@@A@@@@@A@@@@BA@BB@DD@@@@@B@@@BB@@BB@BAB@AAB@BC@A@@@AB@@@B@@@B@B@BBBBD@DBD@@@@@@A@ADADCBCBGBAAA@AEC@@E@@A@@CAABA@@@A@@@@@@A@@A@@A@@AA@@@@A@@@@@@@@@A@@@@C@A@A@@@A@@@@CA@A@@@@@A@@@A@A@@AA@@@@@AB@@AB@@AA@@@@@AA@@B@@@B@@@@@@A@@A@AACA@@@A@@B@B@B@@A@@AA@AA@@A@@@AB@B@@A@A@A@@@A@A@A@A@@@AB@@BB@BA@@BBB@B@@@B@@@BB@@@@@AB@@@B@BB@@BA@BB@BB@@B@BAB@@BDA@@B@@AA@DAB@BA@@@@ACAA@AAAAAAA@AB@B@BA@@@@@AB@@@@AA@@@@AD@B@@A@@BA@@D@@@@@@@CC@@@@@AB@@@@A@@AA@@A@A@A@A@@@@A@@B@@@@@@AC@@@@@@A@@@A@A@@AA@@A@@@@@BA@@BAD@@@B@@@B@BABA@@JAB@B@B@@@B@BC@AB@BAB@@@AB@@@BB@@@B@BABBB@BAB@B@@AB@@C@A@A@@@A@ABA@A@ADABA@@B@FA@@@BBAB@B@@@@@BBB@@A@@BA@@@@@A@A@AB@@@@@@@@@B@@A@@AA@A@@@A@@@A@@B@A@BA@@@@@@A@BA@@BA@ABA@@@@B@B@B@@@@@@A@@@AD@@@@@@AA@A@@@@@@@@A@A@@@@@AB@BA@@@A@A@@@@@@@@A@@B@A@AA@@@@@@@@A@@@@@@A@@@A@@@@@@@A@@B@@@@@@@@A@@@@A@@@@@AA@@A@@A@@@@@@A@@@@@A@BA@A@@B@@@@B@@BA@A@@@@A@A@@@A@@@AA@@@@AAA@AB@@@B@@@B@@@@@@A@@@@@AA@@ABAAAB@@@@A@@@@@AA@@@@@@A@@@@AAAAB@AA@@@A@@@@@A@@@@A@BAA@@@@@@@@@@A@@@@@@AA@A@CAA@@@@@@@@@A@A@@AA@@@A@@B@B@@A@A@@BAB@BB@@@A@@A@B@@@@@@@BA@@@@@@@@B@@@B@@AD@BC@@@@@ADAAA@@@@@@@@B@@@BAD@B@ECFAB@BA@AB@@A@A@@@@A@A@@@CBA@ABA@@AA@@@@A@E@@AAAAA@A@A@@@@@@B@@@D@@@B@DABAB@@A@@@@@AA@A@A@A@CAA@AAA@@AAA@A@AAAAAA@ABA@@@@@A@@@AA@@@AA@@EBAA@@A@@@@A@@@@AA@@A@A@AB@@@B@B@@BBBB@BB@@@@@@BA@@@@@A@AAA@@AA@@@B@@@@@A@@@@AAA@@@ABA@@@@@@@A@A@@@@A@@@@@CA@@A@A@@AAC@AB@@@AA@A@@AAAAAC@@BA@@@A@AB@@@@A@A@AA@@C@@B@B@@A@@@@AAAAA@@AAAA@ACAAA@@@AAAAA@AAA@@AA@@@AA@A@ABA@@BA@A@@BA@@BA@A@@@AB@BA@@@@BC@ABA@@AA@A@C@A@@DAB@@@B@@A@BDBB@D@BB@@B@B@@AB@B@B@BBHB@@BBB@@@DC@@B@@A@AAA@@@@@BA@@@@A@A@@@@A@@AB@BA@A@A@@BAB@BA@A@A@@BABAB@@AB@@@@@B@@@B@@@D@@@@AD@@@@@@@@@BAB@@B@AB@BB@@@@@@BA@@@@BAB@@@@@BA@@@@BD@@@B@@@@@@@@@@BBB@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@AAB@@@@@BA@@B@@@BA@@@@@AB@@@A@@A@@A@@A@@@AA@@@@@@A@@AA@ABA@@@A@@A@AA@A@@A@@A@@B@@@@@BA@@A@BA@@B@@@@AB@@@BA@@@A@A@A@@@@B@@AA@@AB@@A@@B@B@BBB@@@@@@@@A@A@@B@BA@@B@@@B@DA@@@A@@@@@AB@B@B@DAB@@@@@@@@@BA@A@E@AEU@AEQ@@@@FACKCB@@EBCK@@@@JA@@@@@@A@C@E@CBABIDMA@DABABADABCBE@A@C@A@AAC@A@C@KFCBE@CACAAACCAA"],encodeOffsets:[[121843,25878]]}},{type:"Feature",id:"350303",properties:{name:"涵江区",cp:[119.116289,25.45872],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BA@@@A@@@B@@AA@AA@@BA@@@@@A@@A@@@@@@CA@@AA@@AA@CA@@@B@@@B@@@BBB@B@BA@A@@AA@@@@C@@@@@A@AAEA@@ABAAA@AA@@@A@A@@@A@B@@BA@@@CB@AA@ABA@@@AAA@@AAB@@AAA@@@A@@B@@@@AA@@A@@@A@A@AA@@ABA@@AA@@B@@@B@B@B@B@@@B@B@BA@A@@B@@@BB@@BBB@@@BA@A@A@@@@B@@DBBBB@@@@B@@@@A@@@A@@@BB@@@@B@@BA@@BA@@B@@@@BB@@@B@B@@@B@@@@@BDB@@@@@B@@@B@B@D@@B@@@@@@@@@B@@@@@BB@@@BB@@@@B@@@@B@@@B@BADB@@@BF@@@FD@BBBABAHADCDCB@B@B@@@@C@CAC@AAAAA@A@@@A@@@A@@B@@@BADA@BBA@ABA@@AA@@A@@@A@@@@CCA@@AAB@@@@@B@@@@@B@@A@@B@B@@@@@@@B@B@@@@@B@@A@@@ABCAA@A@@@AA@@AACACA@A@@A@@@@BA@@BA@A@A@@@@@AB@@@@@@BB@B@@@BA@CBAB@@AB@B@BBB@B@@@BB@@B@@DF@@@B@B@@A@@B@@ABBBA@BBAB@B@@@@@BAB@@B@@@@B@@@@@B@B@@B@@B@@@@B@@B@@@B@@@@@B@@@B@@CBE@A@@@A@A@A@@@CA@AA@@@C@@@AA@@@A@@A@AA@@AA@@A@@AA@@@@@@@C@A@A@AB@AABAAA@@BAB@@@B@@AA@@@AA@@@AB@@A@@@AB@@AB@@BBABA@@BBBBB@BBB@@@@@B@@@@@B@@@BB@B@B@@B@@BB@@@@@@@B@@@@A@AB@@CA@@A@BDA@@@ABCDA@@@@@A@@B@@A@A@AAAAA@AA@@@@AB@@@@@@A@A@@@A@@@A@A@ABA@@@ABE@@@A@@@A@@@AA@@A@A@@@A@AB@@@@@BC@@@C@@@@@@BBBB@@@@@@B@@@@@B@B@@@@BBBB@BBBB@@@@B@@@@AB@@@@BBCA@B@@E@C@@@CB@@AA@@AB@@AAAA@B@AA@@@A@A@A@@@BB@@B@@@@@@@B@@@@B@@@@B@BB@@@@ADA@@DAB@DBB@@B@AD@@@@A@@@A@@@A@@@@BA@@@@@@@AB@@@@BB@@@@AB@AA@@@@B@BB@@B@@AB@B@@B@@B@@AB@B@@@@@@BBB@@B@@@@AB@B@@@BA@@@@B@BA@@D@@@@@@B@@@B@@@BB@@B@B@@@@@@B@B@@@BB@ABAD@B@BB@B@@@DBB@@@@B@@@B@BAB@@CB@@@B@B@BBBABB@@BA@AB@@AB@@AFB@@@@@BBDBB@@BB@@@B@@@B@@BDB@@B@B@@@@B@@B@DC@@D@B@BB@BB@B@B@@@B@B@B@BA@@@AA@@@@@@A@@@AA@A@@AAA@@@A@@@AC@@A@A@@B@@AB@B@@@BAB@@@BB@@@B@@BB@B@@B@BB@@BB@AB@@BBBB@@B@@B@@@@B@@@@AB@@DBBBB@@@BB@@@@BBB@@@BB@@@B@@@@B@@BB@@BA@BB@@@@@@B@@BB@@A@@BB@@@B@@@B@@B@@A@@B@@B@@@@B@BB@@@@BB@@@@B@@@@@B@@@@@@@@B@@BB@@@BB@@B@@B@B@BB@@@@B@@A@@D@@@BA@ABAB@@A@@@@@D@@B@@@@B@@@AB@@@@@@@B@BB@BA@B@A@@@B@@@B@@@@@@B@@@@A@@B@@B@@@@@@DAD@B@B@@B@@@@@@A@@@@@@B@@@@B@B@@B@BB@@BB@@BB@@BB@@@@@@B@@@@B@@@@@@@@@B@@B@@@@@BB@@@@BBB@@B@@A@@BB@BB@B@@@BB@@@@B@@B@BBB@@B@@@@B@B@B@@@B@@AB@@@B@@B@@@@BB@@@B@@B@@B@@B@@B@@@@@B@@@@@@@@@B@@BBBB@@BBB@@@BB@@@@B@@@BB@@B@@@B@B@BA@@@A@@@@@@@A@A@@@@@CAA@@CB@@ABA@@@A@@@@@@@@A@@BA@@@A@@@A@@@AA@@@@@@A@@@A@@@C@@@@BA@@BA@@BB@@BA@@DCAA@A@@@A@@@AA@@A@@@A@@@AB@@C@A@@@@AAAAC@@A@@@A@@@ABA@A@@@A@@AA@AAA@@@A@@BA@@@@@A@A@@@A@A@@ACB@@ABA@@BB@@B@@@@@BB@@@@BB@@@@@@DBBBB@@@@@@@B@@BAD@B@@@@@BB@@@D@B@@@@@@B@@BDBB@@@@B@@@@@BABBBCB@@@@@@B@B@@B@@B@@@BA@@@@B@@BB@@@BB@@@@@@@BBB@@@@@@@@@B@@BB@@@@@@@B@@@@@BB@@@@B@D@@AB@BAB@B@B@BA@@B@@BBB@B@@@@@BB@B@B@@@BB@@BA@@B@B@@@B@D@BBB@B@@@B@B@BAB@@BDAB@B@B@@@B@B@B@@BB@@BB@@B@@@B@B@@@@@@B@@@@@BA@@B@@@@@@@B@@@@@B@@@D@B@@@@BBB@@@@@B@@@@@@@BDB@@@@B@@@@@@@@@@A@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@B@@@@@@BAB@@A@@B@B@@@@@B@B@@@B@@@B@D@DB@@DDB@@@BB@BBB@@@@B@B@@AB@@@BB@@BB@@@@B@@@BB@@B@@@B@@A@@B@FBBABBD@@@B@B@B@B@@@@BAB@@@@@D@BBBB@BB@@B@@@AAAAB@@A@A@@BA@AB@@@B@DABAB@@@B@D@@BB@@BD@@D@@@BBBB@@B@@B@D@@AB@B@@@AA@@@A@@@ABCB@BA@@@@BBB@@@BBDB@@B@@B@@BB@@@BB@B@@@@A@@@@DBB@B@DBB@BA@@B@@@@A@@FB@@BA@@B@B@BA@@BBH@B@B@@CB@@ABA@A@@@@BBB@@BBB@@@@B@BA@BB@DD@@@@BA@@BCB@@A@AB@@@@@@A@@B@B@@@@@BA@@B@B@@@@@B@@@D@B@B@B@B@BB@@B@@AB@B@@A@A@A@A@@@A@C@A@@A@@A@A@@AA@@A@@@A@@@@A@@AA@@@A@@@@B@@A@@@@@@AAA@A@@@@@A@@@@@@@@@A@A@@A@@@@@@@AA@AA@AA@A@@@A@@@@@@A@@A@@@@@AAA@A@@AAAA@@AAAAAA@@@@ACC@@AAAAAA@@AA@@BA@A@@BAB@@@@@@@AD@@@B@@B@B@@A@@B@@@@@B@BB@@@@AB@@@@@BB@BB@@@@BAB@BBB@@@@A@@@@@A@A@A@@@@B@@@B@B@@@@@BB@@@@BA@@B@BAB@@ABA@A@A@CB@@@@@B@BBD@@@@B@@@@@@B@@ABAB@@@@@@@@AAAAAA@@@@@BA@@BA@@BAB@@@@ACA@@A@A@CA@@A@A@A@C@A@@@A@@@B@@ADCBA@@@@@A@@@@@A@@@A@A@@@C@@@@@@@A@@@@@A@@@@@@@A@@B@@@@@@AA@B@B@@@BABAB@BABA@@@@B@@@@@@BCDA@@B@@@@B@B@BADCBABA@AB@@A@@AAC@A@AA@BA@CBA@A@@@A@A@A@@BA@@@A@@@@A@A@ABA@ABAB@@@@AA@@A@@@@@AB@@A@@AA@@AAEA@A@@@A@A@A@A@AAA@A@A@@@A@@@@A@@A@@@@AAAA@@@A@@A@@@@AA@@A@@@@@A@A@@@@AA@@AA@A@@@ABCBABA@@@A@AAA@A@A@@@A@@FCB@@@@A@A@A@@@AA@B@@AB@B@B@@A@A@A@A@@B@B@@AB@@AB@B@BAB@BBBA@@@@B@@AB@@A@A@CDC@@B@@AB@BA@@@@AA@@@@@A@@@A@@AA@@@@@A@@@A@A@@BAB@B@BA@@A@@@BADBB@B@B@@@BC@A@@@@@C@@AC@AAAAAAC@@@@D@@@B@@@B@@@@@B@B@@B@@@B@BAB@@B@@@B@B@@AB@@A@AB@@@BA@@@@CCA@@A@@BA@AB@@A@@@A@A@A@A@@AB@B@@@@C@@@A@@DA@@@@B@@@B@@@@AF@@@@@BA@@@@@A@@ABA@AA@@@AA@@@@E@AA@@A@@AACFAB@BABAB@BA@@BABA@@BCBA@@AABA@ABA@CBA@ABA@@B@B@B@DABAB@@@@A@@BC@AB@B@@A@A@A@@BA@@B@@@B@BAB@@@B@@@D@@@D@BB@BB@@@@ABA@@@@B@BABA@ABBB@@@B@@@BAB@@@@@B@@B@@@@BABAA@@@@A@CBA@@BA@@@@@@@@@B@@@@BB@BBBB@BBBBB@BBDBB@B@@@B@@BB@BBBBDDDB@BB@@BBBBBB@@BB@@@@@@A@A@@@AB@@@@AA@@A@AB@@ABABABAB@B@@@@A@A@A@A@AAC@@@A@@DA@@@@@A@A@ABABAB@B@@@@ABA@A@C@@@@B@@@@AB@@AB@@A@@BABA@@@A@A@@@A@A@A@A@@@A@AAA@CA@@@AC@@@A@ADEA@@ABA@@A@@@C@A@AAA@@AAAAA@@@@C@CBA@ABA@@B@@@B@@A@@AA@@AB@@ABA@@DCBA@@AA@A@@@A@@@A@@CA@A@@A@@A@A@ACCAA@CAAA@@@@@@@ABA@@BA@C@A@C@A@@@AB@B@@CCCBA@@@BB@@@@A@@AA@@@@@@A@BB@B@B@AA@@@@@@@@@@@@A@@B@AA@@@A@B@@@AC@@BAB@BABA@AB@@CBC@A@@@A@A@@A@@A@ACC@ABABAB@B@B@BABCDCEECAam@UAIIAKFURKFMGIEAA@AGGACAMGYAW@IBEQJIPEHGHADAB@DAJAFAD@B@BCBABABA@A@@@A@CC@@CC@ACAAAA@C@A@C@A@E@A@CAE@E@A@A@@@A@@B@@AB@B@D@BBB@D@BBF@@BB@@AB@@AB@@CBA@E@CBE@C@A@@@@@@B@@@@@@@B@@@@@B@@ABA@A@@BA@AB@BA@@AA@@@A@@@@B@@@@ABB@ABB@A@@@@BDA@@@D@DBB@@@B@@@@BB@@@@@@CB@@@@@@@B@@B@@@A@BB@@@@@@@@@@B@@BBA@B@@E@@@B@B@AB@@@@@@@@@@@B@B@@@@@@@@@@A@@@D@AD@@HBD@@@@B@BCD@@AB@@@B@@@B@@@@ABA@@@@@A@@@A@C@@BA@@@@@@BAB@BB@B@BB@@@B@@B@@@B@B@@@B@@@@@AB@@BBBB@@@B@@B@BB@@B@B@BBCDAB@@A@A@@@@B@@@@@@AD@@A@@A@@@@@B@@@@@@@B@@@@B@@@@@@@BB@@@@@@@BA@@BB@@@BBA@@B@@@@@B@B@@A@A@@@@@@B@@AB@@@@@B@@@B@@@B@@@@@BD@@BB@@A@ABA@B@@B@@BB@BA@@@@@B@@@@A@@BA@@BA@@@@B@@A@A@@@@@A@A@A@@B@@@@A@@@@BA@A@@BA@A@AB@BA@@@@@@B@B@@@@@B@B@D@@@B@BA@@B@@@A@@AB@@A@AA@@A@@BA@@BA@@B@B@@ABA@@@AD@BA@ABA@A@@@BB@BB@B@@@@B@BBBBB@@@B@@@B@@@@@@@@B@@B@@@@BBB@@@@@@@@B@@@@AB@@@BBD@@@B@@@@@@@@@B@BB@@@D@@A@B@@@@@B@@ADA@@B@@@@@@D@@@@B@@@@A@@@A@@@@B@@@B@@B@@BA@@BBB@@@@@B@@@B@@A@@@@B@@BD@B@@@@ED@BC@@@A@CC@@@BA@AB@@AA@@AA@@@@AA@@AB@@@@A@C@CBA@A@@A@@AA@@@B@@@@A@@@ABA@A@@@A@A@@@A@CC@@@AAA@@A@@@@A@@@A@ABE@@@A@@A@A@A@AA@@A@AAAC@@AA@@@ABA@AB@@@@@AA@@A@C@@AAAA@@@AA@A@@@@AB@B@B@@BBCB@B@@@B@BA@@@A@@@A@A@@@@@@B@DA@@BA@A@AB@AA@A@@@A@AA@@A@A@@@"],encodeOffsets:[[121851,26160]]}},{type:"Feature",id:"350304",properties:{name:"荔城区",cp:[119.015061,25.431941],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@@B@@@@@@@AB@A@@@@@@@@@@@"],["@@B@@@@B@@B@@@@@@AAA@@@@@@AB"],["@@@B@BB@B@@@B@@A@@CA@@A@@@@@"],["@@K@QAAA@@ABA@@@@BC@@B@@@@@B@B@@@@AA@@@@@@A@@A@@AB@@@@A@@A@@AAAC@@A@@@@@AA@@@@A@@@@A@@A@@@A@@@A@@@@@@BAB@@BB@@@B@B@@@@BBA@@@BB@@@BA@@@BB@BC@@B@@A@@BA@@B@@@@@AA@@@@BAB@@AB@@@@A@@@AB@@@B@@@@AB@@@@@BB@@@AB@@AAA@@@@BA@@B@B@@B@@@ABA@@BCB@@A@@B@@AA@BCB@@A@@@@AAB@@AB@@A@@@AB@@@@@BA@@@@@@@@B@D@B@@@BA@ABA@@BABA@A@@@ABAB@@@@@@@B@@A@@BA@@@AB@BAA@@C@C@@@A@@BA@A@A@A@A@A@@@@BAA@B@@@@@AA@@@@B@@AA@@@@@A@@AB@@@A@@@@@@@@AB@A@@@@A@@@@@A@ABA@@@@BA@@B@@@B@@@B@B@B@@@@@BB@A@@B@@@@@B@@@@A@A@@@A@@@@@A@AD@@@@@AA@@@A@AB@@AB@@@@@@@BA@@@A@A@A@ABA@@B@@A@@@@@@B@@AD@@AB@@AB@B@@A@@B@@A@@@@A@@CAAA@@@B@@@@@@AA@@A@A@@@@@@@A@@@A@@B@BAB@@@@A@@@A@@@CACA@ACA@@@@@BA@@B@@A@A@@AA@@BAA@BAAA@A@@B@@@@@B@@@@@@@@AB@@@@@@@@@@@B@@A@AB@@@B@@AA@@@@@D@@@@AA@@A@@@A@@@@AABA@@B@AAA@@A@@@A@CB@@A@@@AA@@@@AA@B@@AAC@A@@A@@@@A@@@@A@@@A@A@A@@@@@AB@@@@AB@@@B@@A@@@A@A@@A@A@AA@@A@@@@@@B@@A@@C@@A@@AB@@@@@@A@AA@@@A@DA@@@@@AA@@@@@@AA@@@@A@@@A@AA@@@@@@@@@ABA@@@C@A@@@AA@@@@A@C@@@@@AA@@@@@A@@@@AA@@@@B@BA@@B@@@AABA@@@@B@@AB@B@B@@@BABA@@@A@ABA@@A@@@A@@A@BA@@@@@A@@@A@@BA@@AB@@@@A@@@BA@@@A@@A@@A@@@@AA@@B@A@@A@A@@@A@@@@A@A@@@AA@@@AB@@A@@A@@@A@@BA@@@A@@@@@A@A@@@A@A@@A@@@@@@B@B@B@@AB@@A@@B@@A@@@ABB@@@AB@@@@A@@@@BB@@@@BBBB@@@@B@@AB@BBB@@@B@@@@B@@B@@@BB@@@@BB@@@A@@B@@@@AB@@A@@@AB@@@@A@BB@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@A@AA@@@@@@@@@@@A@@AC@@@@AB@@@@A@AB@@@@AB@@@@@@AAA@@B@AA@AB@@@@@@@@C@@B@@C@@@A@@@A@@@@@A@@BA@ABAB@@@B@BABA@AB@@@B@BABA@@BB@@@@@@B@B@@B@@A@@@@BB@B@BA@@@CD@@A@AA@@GAAAA@A@A@@BA@A@@@AAC@A@CA@A@BA@@@A@CB@@@B@D@BBB@@AB@BAD@@@@ABA@@B@@@BAB@@AB@@@BAB@@AB@B@BBB@@B@@BB@BBB@BBBB@@B@BBBDB@BB@BB@BBBB@@@@@BA@A@@@@DB@@B@B@B@@A@@B@B@@AB@@BDBBBB@@@BBB@@A@@BBD@BB@B@@@DB@@@@B@@@@@@B@B@@@@@@AB@B@@BB@B@@B@@@@@A@@@B@@BB@BBB@@@@@B@@A@@@@A@@AAAAA@@@A@A@@BAB@B@@@BB@@@@@B@@B@@@BBFA@@BB@@B@@B@@@B@@@@AB@BBBBBBBB@B@BB@@BB@BBB@D@B@B@BBB@@@@B@@@BABA@C@A@@@C@@@A@@@@B@B@B@BBBB@@@F@B@@B@@BB@BAB@DA@@B@B@@@@@@B@BA@@BABA@EBFDA@C@AB@@A@@@@@@@@@BBCB@B@@@@ADC@@BA@@@A@@@@@@@@@AB@@@@@@A@B@@@@B@@AAA@AB@@@B@BA@A@@@@B@@BB@@@B@B@@@@@@@@BB@D@BBB@@@@@@@B@@@@@@@@B@ABB@@@@@@B@@@@@B@@BBA@BBBB@@@@@B@@@@B@@B@@@@@B@@A@BBAB@BB@@B@@@@@B@@@@A@@@A@@@A@@BBB@B@@B@@B@@@B@@@B@B@@B@B@@AA@@@@@@AB@B@@A@B@@@@@B@@@@B@@@@BB@@B@@@@@B@@B@@@@@@@@@@AB@@@@@@@B@@@B@@@@@@@@B@@@@@@B@@B@B@AB@@@@@@@@@@B@B@@ABA@@B@@@@@B@B@@@@@@B@B@@B@@@@C@@B@@@B@@@@A@A@A@@@@@AB@BAB@@ABB@@@@@@@ABB@A@@@@B@@@B@@@BBB@@@BA@@@@@@@@@A@@B@B@B@@@@AB@@@BA@AA@@@@A@A@AB@A@@EBA@@@ABCB@B@BAB@B@B@@@B@B@DA@@BA@A@ABA@AAABA@@@A@@A@@BA@@A@ABA@@BADA@@@A@A@A@IB@@ABABA@@@A@@@C@AB@@AB@@@@B@@@BB@@@B@B@@@B@@@@D@@B@@@@A@@@@B@@B@B@B@B@@@BB@@@B@@A@@B@@@@DD@@@@@@C@@@AB@@@BA@C@@B@@B@@B@@A@@B@@@@ABA@A@@BBBBBBB@BBBBD@@@@ABA@CBB@@@B@B@@@B@@BBBAB@BB@BF@B@B@@@@@D@@B@@BB@B@@A@AAA@A@@@A@@@A@@DBB@@BB@@BB@@D@@@@B@@@@B@@@@AB@@BBB@@BA@@@B@@@B@@A@@B@B@@@BBB@@@B@B@@BBAB@B@@AB@@C@A@@@@B@B@@@B@@@B@@A@A@@@ADAAA@@@A@ABA@@@@@BBB@@B@BB@BD@B@@@BB@@@@A@@BAB@B@@BB@@BDBBB@@@BBB@B@B@@@@B@@AF@B@B@@@B@@B@@@BB@B@@DDB@@@B@B@@@B@B@BA@@B@@@@@@A@@BB@@@BB@B@DAD@B@@@@@BA@@BB@@@@BB@@BB@@BAB@@A@@DDB@@@D@@AFC@@@@@AAC@@@A@@B@@@@A@@@A@@@@AA@AB@@AA@@@@A@@@A@@B@@@B@@@@@@A@@C@@@@@@@@AB@BC@@@A@@@@@A@BC@@@A@@A@A@@@@@@@@@A@@AC@A@@BA@@@@@A@@@@@@A@AA@@@@@AA@@@@@@@@@@A@@@A@@AAAA@A@A@@A@A@@AAA@@B@B@BAB@@ABC@@B@BA@@@A@AB@@AB@@AB@@@BBB@@@BA@@@B@@@AB@@A@A@@@C@A@A@@@@@A@A@@@@B@@ABAB@B@@AB@B@@A@@B@@@@@@AB@B@B@@@@@B@B@@@@A@@B@@AB@@AB@@@@@@A@@@@ABA@@AA@@@@AAB@B@BA@@AC@@A@@@@@A@@@A@@@A@@@@BA@@@A@@@@B@B@@@@A@A@@@@@AB@AA@@A@@AB@@A@@@@@@AA@@@@@@A@@@@@@A@@@@@@@A@@@@@BB@@@BC@@@@@@@A@@B@B@@@BADCAAA@A@@@AAA@@@@A@@AAAA@@BA@@@@A@@@A@A@@@A@@@@A@@AAA@A@@ABA@A@@@@B@@AD@B@@@B@@@@@B@BA@@@@@A@@@A@@BA@@DC@A@A@@C@GA@@BCC@@@B@@@@@@@@@@@@A@A@@@@@@@@@@BAA@A@@@F@@@@AAB@AA@@@@@@@@@@@AAB@@@A@@@@A@@@@@@DA@@@@@@AA@@@@@A@@AA@C@C@@CB@A@@B@A@BAA@BA@@@@@A@@B@@@B@@BB@@ABAB@@AB@B@BA@@@A@@@@@A@@@@@@@A@@@@B@D@F@DAF@B@DA@@BA@@BA@@AA@@AE@A@CAA@A@C@ABA@@@AB@@@B@B@F@F@DBB@F@B@D@B@D@B@BBDB@BDD@@DDB@@@B@B@BABADA@A@ABCBEBI@CBABCHGFGJORILCF@DBFBFDB@B@B@B@BABABA@E@GDCLAREJCHAFAJ@D@@BBBBBB@BAD@LAFADEDEBC@C@EFCBCAE@EBGFGFGDC@OFCDC@C@A@@DAFAEUQS@AO@E@]A"]],encodeOffsets:[[[122027,26e3]],[[122033,25986]],[[122061,25979]],[[122070,25900]]]}},{type:"Feature",id:"350305",properties:{name:"秀屿区",cp:[119.105494,25.31836],childNum:27},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@BB@@B@@@@A@@AA@@A@@@@B"],["@@@@@@@@@AB@@@@@@A@A@@A@@@@@A@@@@@@B@@@B@@@@@@BB"],["@@@@@@B@@@@@B@B@@@@A@@@@@@@A@@@@A@@@A@@@AB@@@@@B"],["@@AD@@@@A@@@AB@@BBB@B@BA@AB@AA@AA@@@"],["@@@@@@DBB@B@B@@A@@@AA@@@A@A@A@A@@B@@"],["@@@B@@B@@@B@@BB@@@B@@@B@@A@@@@@A@@@AA@@@A@@@A@@@A@@@AB@@@@"],["@@@BB@BB@@B@@B@@@@@BB@BA@@@@@A@@@@@A@@@@B@@AAA@@@@A@@@A@@@C@A@A@@@@BB@"],["@@@B@@@@@B@@B@@@@B@@BB@@@A@@@@BA@BDB@@@@BA@@B@@@BA@A@A@AA@A@ABA@A@@@A@C@"],["@@@@@@DBFADABA@@AAAAE@CBAB@B@B"],["@@@@BBBB@@@BB@@B@B@@ABBB@@B@@ABC@@BA@@@A@A@@AA@@@A@@@AAAA@@@A@ABAD"],["@@D@B@B@@@D@B@D@B@B@@A@@@A@AA@@@@@AAA@C@@@ABA@CBA@ABA@A@A@A@@B@BB@B@B@BA"],["@@A@@@A@@BA@C@ABAB@BBBB@@@B@@AB@@@@BBBB@B@BADABA@C@@AAA@C@"],["@@AAB@@AB@@A@A@@A@@@A@AB@@@BA@@B@@A@@@@B@DBDBBB@@@B@BB@@B@B@BA@A@@@@@A@@@@AA@A@@@AA@A@A@"],["@@B@B@BBB@B@B@FBB@BA@@@A@AA@AAA@@@AA@AA@@ACA@BA@@AAA@@@@ABBB@@@B@@@@@BA@@BA@EDA@@@@B@@@@B@D@BA"],["@@AAAAACACA@C@A@@B@@BBABAD@DBBFDB@B@@AB@B@BA@C"],["@@ABAB@BBBBABB@BBD@BB@F@B@@A@@AA@@@AB@B@D@B@@@AAAA@@@@BA@@@A@@@AA@A@@A@@A@AB@@AB@@A@C@AAA@"],["@@@B@DBBD@D@BA@A@A@A@A@AB@B@B@@@@A@C@@BA@@@AA@A@ABAB@@AA@@ABAB@@A@@AA@A@@BAD@BBB"],["@@@@@@AAAAA@@@A@@AA@A@@D@BB@@B@B@@BB@B@@A@@@A@@BABBB@@B@DAB@@@B@@@B@B@@@@B@@@BB@@AB@BA@@@@@ABA@@@@AA@@BAA@@AA@@CA@ABA@"],["@@@B@D@@AA@@A@@BA@A@A@@B@B@@BBA@@B@BBB@@@B@B@@BD@BB@BBBA@@@AAABAB@@A@C@ABAB@@@BBB@B@BAA@AC@AA@A@BAA@A@@@AA@AA@"],["@@@B@@BBDBBA@@BA@@B@@@B@B@@@B@BA@@@AA@@@@A@@B@@A@@@AA@@A@@A@@AABAAA@@@C@A@EBABAB@BBBB@B@@B"],["@@MFKFCD@D@BB@D@DAFCHCHADA@A@CAAC@"],["@@ABA@A@@B@BBD@B@@ABA@A@AB@B@B@@BBB@JL@@B@@A@AAA@A@A@@B@B@BBBA@@@A@A@ACAAA@A@AAA@A@C@AA@"],["@@EBGFEFCF@B@DDBD@FAFCDEDG@CAAAA"],["@@A@C@AAA@ABED@BBB@B@BAB@BDBBBB@B@@B@B@@B@BABAHCBA@C@CGE"],["@@@BD@B@@A@AD@BBB@@ABAAADADA@AAA@C@ABAB@@C@@C@A@A@CBA@AAA@@@ABCA@AA@A@ABA@C@A@@BBDB@ABABBBBBFB@B@@BBBD@B"],["@@L@BDB@BHDFB@BABEBAB@DBB@BABCD@FBFHHDJ@FAFABC@CECA@AABABCBAAACCEAIACBE@ADCBCAACAABCDKAA@AG@EDCC@CDEHIBC@A@AA@AA@ABCB@@@@AEACAGAE@C@EBGACAEACCC@A@AB@DBB@BADCDGFIBEBAB@B@B@BA@AB@B@DBDBB@BA@KDC@C@CBCBIDCAC@ABCDABCBGDGDGBA@OBI@IBIDAJCJDJFFBFCFKL@D@HDDD@JED@DADDBHDHDDD@D@FAB@BB@HBJFHFBFADCDEBIAECA@CBAFCFBBBABBDDBD@FAHCDEAQ@KBC@CDIJEH@FBDA@ABE@ADCNCNEFA"],["@@AACAAE@C@E@EBCBCBABC@@AAAAAAAC@ABA@AD@D@DB@AB@@C@G@@AA@@C@AAA@@A@@AA@A@AAAA@@@A@AA@@@A@AACCC@AAAAA@CCM@CA@AAA@A@AA@ABABCBC@AAC@@C@CACBA@A@@D@D@DBFAB@B@D@B@BC@CB@BABCDCBC@C@ABAB@@@BBB@BD@B@BB@@@BADADCBODCDKHAB@@@BBBDBF@D@BAJAJCDAD@DBDDDD@B@B@@@B@@ADAD@BGHAD@BAB@@@BABABAB@BBB@@BBBBB@D@B@B@@BBBDFBFBBDBD@BBBBBBBDDBBBD@F@F@B@FBDB@D@BADCBEDG@uDsBMQSFMJEDGNMFEBA@ABCDEDIRCJ@LAJBL@DAD@FBDBLDDBDDBB@D@BADCJCRCJBHJLRDFBJBH@D@HAJBDDHFFFB@DBDCBIPEFADIAMAGADQCCC@iIC@GCC@EACAEEIEECCAC@C@CBABC@A@CAEAA@C@@BAD@DBH@BIJGHEF@BADBFDHHJFD@DBD@F@FBJHHBF@@BD@DDBFABADCDCBC@CBA@@BAD@DALDBBBDBBDDDH@BNBJCBADAF@D@B@@@@@@@IB@@@@DLFA@@DADLEB@@@@FR@BFV@B@F@BAB@@@@@@@@A@CBA@A@A@@B@@@@@B@@CBA@@@A@@@ABA@@@@B@B@@@@@@A@AAA@A@@@@BA@@BB@@B@@@B@B@@@B@B@@@@@B@@AB@@@B@@@BB@@@BA@@B@@B@@@B@B@@B@@@B@B@@@@B@AB@@B@@B@@@@BB@@@B@@A@@@B@@A@@BB@@AB@@@B@@@@@B@@A@BB@@@B@@@AB@B@B@@ABAB@@A@A@A@@BA@@@@@ABBB@@A@@@ABA@@@@@BB@@@@@B@@@@BB@@@@D@B@@@@@BB@@B@D@@@B@BA@@@@@@@@B@@B@B@@@B@@B@@B@@@@B@@B@@@@CBB@@@B@@B@B@@@@A@@BB@@@@DB@@@@A@@@@B@@@BBB@B@@@@B@B@@@BA@@@A@@B@@A@@B@@@@@B@B@B@@@B@@B@@@@@@BB@D@BB@@@ABB@@@@BB@@B@@@DAB@@@B@@@BB@B@AB@BA@B@@B@@@B@@@BB@@@@@C@@@@BB@@@A@@BAB@@@@A@@@@@@@@@@BA@@@@@@@@@A@@@@@AB@B@BB@ABB@AB@@BB@B@@@@AB@@A@@@@DB@BDBDB@@B@@@B@@@@@BA@A@AB@@@B@@@@@@@B@B@@@BB@@@@@@@A@@BBDB@@@B@@B@@@@AB@@@@ABA@@BA@@BC@@@A@@@@B@@@@AB@BAB@B@B@@@B@@A@@@@@@BA@@BAB@@@B@@B@@@@BCB@@@@@B@@@B@B@@@@@@A@@@@@AB@A@@A@@@@@A@A@A@@@A@@@AB@@A@@B@BAB@@@@@B@@@@@@BBA@@@@@@@@@B@@BA@@@B@@@@BB@@@A@@B@@B@@@@@ABB@A@@B@B@B@B@B@B@@AB@@@D@D@@@BB@ABA@@B@@AB@@@@A@@@@@@BABA@@B@B@BA@AB@BAB@@A@@@A@C@A@@@@@@B@@A@@@@BA@@B@@@BA@@BA@B@@B@@@DA@ABB@@@AB@@@DA@AB@BA@@A@@@@A@AB@@A@@B@BB@@BA@@A@@A@@@@BA@@@@@A@@BA@@B@@@@@BA@@BA@A@@B@@B@@@@@AB@@AB@@@@AD@@AAA@@B@@A@@AA@@B@AA@@@@@A@A@@AA@@BA@A@@@@B@@@B@@@B@@@@B@@B@@@@@BB@@@@B@@@BDBB@@@BB@@@@@BA@@@BB@@@@@@@BB@@@@@A@A@@@@@AD@@A@@B@BA@@BBRBL@^BF@P@@BRTFVB@DB@DBFAJ@FAH@BBDD@F@HALEHCHAJ@JBJBDBB@B@F@HADABABA@CBA@ALOBABEDEFELKNKNMDCDCDAJADAD@DABBB@B@B@B@BABABABA@ANEHAB@F@DBHDDBB@D@@@B@@A@AACCCAE@CAG@CBG@E@AAACCYIIEIAK@KBE@GACE@IEAGBC@EBEDABEBGBKEC@ABCDE@C@C@AAAC@ABCDE@CAC@EDEBE@CBGDKJMNEBCBA@A@AA@AAA@EAAACCEICCAAA@C@@@AB@BA@CAIEGEGECCAC@AACC@CAAAEAC@AB@B@B@D@BA@CB@B@B@BBBBD@BABEBkTIFEBWCCAGEKGICIAK@E@EBE@CBABABCBABEBGDEDEJQNQLGJCHBLAHEDMCEGACAAACA@AAA@C@AAA@@AAAAA@C@A@A@@AA@@AAAAA@@A@C@AAA@@AAA@CB@C@CJUDEDCBABCB@B@B@FBB@DADADCBABAB@BAD@BBB@D@BABABABC@AAE@A@EEC@@AAA@ABAA@@@ABCFCBABADAB@D@B@BBBBBDD@@@N@FCDG@GAG@E@EAIBG@EI[AC@K@GACAAC@A@A@AA@CAAAAA@AACACAA@"]],encodeOffsets:[[[122434,25772]],[[122143,25765]],[[121933,25635]],[[122231,25718]],[[122423,25772]],[[122389,25759]],[[122461,25821]],[[122480,25849]],[[122266,25758]],[[122350,25860]],[[122474,25841]],[[122516,25833]],[[122134,25957]],[[121906,25704]],[[122128,25963]],[[122463,25792]],[[122449,25855]],[[122446,25869]],[[122237,25718]],[[122461,25848]],[[122240,25932]],[[122209,25915]],[[122329,25582]],[[121884,25776]],[[122388,25886]],[[122404,25807]],[[122003,25686]]]}},{type:"Feature",id:"350322",properties:{name:"仙游县",cp:[118.691637,25.362093],childNum:1},geometry:{type:"Polygon",coordinates:["@@IAE@CD@@C@GA@@@A@@@@@@@@@@@@@AC@@A@@@@A@@@A@@@@A@@A@@@A@@@@@A@@@@@@A@@@A@@@@@@@A@@@@@@B@@@@@@A@@@AB@@AA@@A@@@@@A@@@@@AA@@@@@@A@@@@@@@@@A@@A@@A@@@A@@@@AA@@BAA@@@@B@AAB@A@B@@@@@@@AABB@AB@A@B@@@@@@@@@A@@A@@@@@A@BAA@A@@@@@@@@@@@A@@@B@A@@@A@@@@@@@@@@@@@A@@@@@@@@A@@@B@A@BA@B@A@@@@@C@@@A@@@@@ACCGC@A@@@@B@@AB@@A@@@B@BFBB@@A@ABA@@B@@AB@@@BA@@@@A@@@@@@@@C@C@@@A@A@AB@A@@@@@@BA@AB@AAAAAAAAA@AA@@A@EBA@CAAAAAC@ABA@@@@BCAABCAA@AAA@A@A@CBC@A@A@CCA@C@@BA@@B@@@@@BB@@DB@@@@@GAC@A@A@@@ECAAA@AAA@@A@@C@C@@@@A@@B@@@ACA@@@AAAACAAA@@@@AA@A@@@@AB@A@@@C@ABABA@@@A@A@A@C@@BA@A@@A@A@A@A@A@@@@AA@C@C@A@@@@BA@@BA@AAABA@AA@@EA@C@@AACA@@@@A@@@@@A@AA@@A@C@@@A@@@CC@@A@@A@@A@@@@@@A@@A@@@@ACBAB@@AB@@A@@@@AAABA@@@@ACC@@@AAAA@@@A@A@AA@@@A@@@@A@@A@@@@@@A@@@@A@@A@@A@A@A@@A@@@A@AAA@A@@A@@@AAABCB@@AAC@A@A@@AA@A@@@AAAA@@AA@@CA@@@@@@@@@A@A@@@CA@@@@@@A@@@@@A@@A@@@A@A@@@@@@@@A@@A@@@A@A@C@A@A@AAA@A@A@@@A@AA@A@@@@@A@@AA@@@@A@@@@BB@@@A@A@@@@B@@@@B@B@@@@@AB@@@BB@BB@@B@ABA@@@AA@@@@ABBB@B@@BB@@@@@B@B@@AB@@AB@@@B@@BBBB@B@@@@@B@@A@@@A@@@@@@B@@@@EBAB@@C@@@A@A@A@@@@BB@@B@@A@@BA@AB@@A@@AAB@@A@BB@@@@@@@B@@A@AB@@@BBB@B@@@B@@A@@@@B@@@B@@@B@@@@@B@@@@@BB@@@@B@@@BB@BB@@@B@@ABA@@@ABA@@@AB@@A@A@@@@@@@BB@B@@AB@@@@@@@B@@DB@BB@@@@@B@@@B@@@D@B@@@B@BA@@@@BB@@@BB@@B@@@@B@@B@@@BD@@@BB@B@B@B@@@@D@@@@B@@@@@@@@@B@@B@B@@@@BA@@@@BB@@@B@@B@@@@@@A@@@A@@A@@AA@@@@@AAB@B@@@B@@@D@@@@@@AA@@@@A@@@A@@@@AA@@BA@@@@@A@@@@AB@@A@@@A@@@AA@@@A@@BB@@@@B@BAB@B@@B@@B@@@F@@@BBB@@@@A@AB@B@B@@@@B@@@B@B@B@@@@@@F@@@@@BA@ADAB@@@@B@@@B@@BDBDB@@@B@@@BBB@@@BA@@@A@@@@@AB@@@@@B@@A@@B@B@@A@AB@@@B@B@@BB@@@@BDBB@D@B@@B@B@@BD@@@@@@@CD@@@@@@B@@@B@@@@@BB@@@@BB@@B@DB@@BA@@ABABAB@B@@A@@@ABA@@B@@@@A@@@A@A@@@AB@@ADA@ABA@@B@@@BA@@BAB@@A@@@C@AA@@A@@A@AA@@AAAAAAA@AABA@A@A@@@A@@@AB@@@@@AA@@A@@@@A@@BABAB@@@@A@B@@AA@@@@@@A@@@A@@AA@@@AAA@C@@@AAA@@A@@BA@@D@@@BA@CB@@A@CD@@@BAD@BA@A@@BA@@B@@@B@@@B@@@BB@BB@@@@@@BBA@BB@@@@@@B@@B@@@@@@B@@@B@@@@@B@@A@@@@BA@@@@BB@@@@@B@@B@B@BBBBB@B@@BBB@@@B@@@B@@BBB@AB@@A@@@@B@@@@B@@@@BB@@BFB@@@@@B@@A@AB@BAB@BABAB@BC@@@@@@BBBB@@B@BB@@@@BA@A@C@ABA@ABA@@@A@@@@@AAA@A@A@CA@@@AA@@@@@A@@BAB@@@@AA@@@A@@@@A@A@A@@@@@@@CB@@@@@B@@C@A@AB@@AB@AA@@@A@@B@AA@@@@A@@AAAAAAAA@@@@A@@@@@@AAA@AAAA@@A@AA@@@@BA@@BAB@@A@@B@@AA@@@AA@@@A@A@C@A@@@@B@@@@@B@@CBAA@@@BA@@@A@@@A@@@@@@@@B@BAB@@A@@@AA@A@@@@@A@@@AA@@@B@A@@@A@@BAB@@AB@@@B@@A@@BCB@BA@@B@@A@@AA@@@A@A@@AA@@AA@AA@@A@@@@@A@A@@@@@A@@@A@@@@@A@AB@AA@@@AAA@AA@@@@@B@@@BB@@B@@AB@@AA@@@B@@@B@@@@@@A@@@AAB@@@@A@@@@@@AA@@@@BA@@@A@@@A@@ABC@@B@@AA@@A@@@@@@A@@@A@@@@@A@@@A@@@@@@AB@@AB@@@@AABC@@B@@@@A@@@AB@A@@@@@@@@A@@B@@@@@AAA@BA@@@@@@@@BAA@BA@@@@@@A@AB@@@@ABBDA@@@@@A@A@@@C@C@CBCAA@@BA@A@@@A@AAA@C@A@AB@@AAACA@A@@@@@A@@B@@@@AB@@@@@@A@@B@@@B@@@B@@@@AAABA@A@AA@BA@@@AA@AA@A@A@@@AB@@BBBDAA@B@@@@BB@@@BAB@B@B@@B@@@AB@@A@@BA@@BAD@@@B@B@BAB@@A@@@@@BBB@BB@@BBB@@@@@@@@B@@@B@@@@@B@@@B@@BBB@@@@BB@@@AB@@@B@@@DB@@B@@@@@@A@@@@@@BA@@@@@A@@B@@@B@B@@@B@@@B@@C@AA@A@BAA@@@BA@@@@@@AA@AA@@@A@@@@@AA@@@@@@@@AB@AAB@@A@@@A@@@@A@CA@@A@@C@@@@A@@@@A@A@@B@@@B@@B@@@@@A@@@AA@@@@@A@@@@A@@@A@@A@A@@B@@AB@AA@AAABAB@@@BA@AB@@@B@B@B@BBB@BB@BB@@BBB@@BA@BB@@ABB@@@@B@@@B@@BB@@B@BB@@BBB@B@@B@@BAB@@AB@@@BBB@@BA@@@A@@B@@A@@@@@A@@@A@A@A@@B@B@@BB@A@@@B@@B@BD@@ADA@BB@B@@@@@BAB@BAD@B@BDB@@B@@B@@@BB@B@BBBB@@@@@B@@@@BB@@BB@@@@@B@@@@@@@@@@@B@@@@@@@@@@A@@B@@@@@@A@@@AB@BA@@@ABAA@@@@C@ABCBA@@@@@BBB@@@BB@@@@@@AB@@@@@B@@@@@B@B@@B@@@@B@@A@A@@@A@A@@@@B@B@B@@@@A@A@@@@BA@A@@BA@A@@B@@@@@@@@A@@BA@@@@B@@@B@AAB@@@BA@@B@@BBA@ABB@@@BB@@A@@BC@@@@@@@@@A@@@A@ABEC@@@D@@@B@@@BB@@B@@@@@@@B@@@@AACAEC@@@@A@@@AB@B@BAFA@B@@BB@B@@@B@@@@B@BB@@BBB@@@B@B@B@@DB@@@@ABABA@A@@AA@@B@@@B@@@@A@@@@@AA@@@@@A@@@@@@C@@BA@C@A@@B@@@@BB@BAB@BA@@@@BB@@B@@@B@@A@@@B@@BA@@B@BA@@DBB@@@BA@@D@@@@ABBBA@@@B@@BB@@BABAF@@A@@AA@@@A@A@@@A@@A@@A@E@@@A@A@A@A@@@@@A@A@A@@@@B@B@B@B@@@B@@@BA@@@@BA@@@@B@B@BA@ABA@A@@@@@A@BB@@@B@@D@@@AB@B@@AB@B@@AB@@ADAB@@@@BB@@@@@D@BBB@@@B@@@B@BB@DB@BBB@@BB@@@@B@@BBBBB@@@@@@@@@BDD@@@F@B@@@BBB@@B@@AB@B@@AB@A@@@@@@@BA@@B@@@BA@@BB@B@@@BA@@@@@C@@@@@@BD@BB@@@@E@B@@B@B@@D@@@@@@BA@A@@B@B@@B@@B@B@@@@BBB@A@A@ADB@B@B@@@B@@@@@ABAB@B@B@@@@A@@@AB@AA@@@@@A@A@@BAA@@@@A@@@@@@A@@AB@AA@@@AA@AABA@A@@B@@@@BD@@@@B@@@BB@AB@@@@@B@@@@@B@BB@@@@@@BB@@@@@B@BB@@@@B@BAB@@BB@BA@@@@@@B@@AA@@@@AA@@AA@@A@@@@@@@@BB@@@@BAB@@@@@@A@C@A@@@AB@@A@@B@BB@@BB@@BBD@B@@A@@@@@A@@BA@@AA@A@@@AB@@A@B@BB@@@@B@@B@@BB@@@@A@@BA@@@@B@B@@BB@@@@@BB@DB@@B@@@@BB@B@B@@@BBA@@@AB@@@BA@@@@@C@@@A@@@@@@@@@A@@AA@@@@@@@@@@BAB@@B@@@@@B@@@@@B@@@@@BB@@B@B@@@@@@@@BA@@B@@@B@@BB@@B@@B@@BBB@BB@@B@@BDBB@AB@B@B@@@@@@B@B@@BB@@BB@B@@@@BAB@@@@@B@@@@BB@@@B@@@@@BB@@@DD@@B@@B@@B@BB@BB@@@@@BB@@BB@@@@@@@@B@@@@B@@@@B@B@@@@B@D@@C@@@AB@BBBBBB@@BDBB@@B@@@@@B@@B@@BB@@@@B@@B@@@B@@@@@B@@B@@@@B@@@BA@@@BB@B@@BB@@BB@B@@@B@@A@@BBB@@@@B@@@@B@@B@@A@@B@@B@@@@B@B@B@@@@@B@@BB@@@BA@@@@BB@@BA@@BB@@B@@@B@@@@@BA@AA@@A@@@@@@@@B@BB@@B@@@BBBBB@@B@@@@@@@@BDB@BBB@@B@BBBB@B@@BA@BBB@@BB@B@@@B@@@@@B@@D@BBBBB@BB@@B@@B@BBBBB@BB@@BB@@BBBBDBB@BA@@@AB@@A@AB@B@@@@B@BB@@B@@@DA@@BA@B@@@@BBDDB@@@@ADA@BB@@@@@@BA@ABCBAB@@A@@BB@B@B@FBD@B@@@@B@@A@AB@@AF@@@BB@BBB@@@@B@@AB@@BBBDBBBD@@BB@@B@BB@@@@@@@@@@ABA@A@ABAB@B@@C@CBAAA@ABCBCBA@A@A@@@ABA@AB@@@BAB@@B@@BB@@@BDB@BB@BB@@BB@BBB@@BB@B@@@@@AB@@DDDB@@B@BBAB@@AB@@AB@@ADA@DB@BBB@B@@@@A@@@ABAB@@@@BB@@DB@BA@BB@@DBBBB@DFBB@BB@BA@@B@@AB@BA@@@@B@@@@BB@@@BBB@B@@BB@@@BB@@BBB@B@@B@@D@@@@@BA@AB@BA@@@@BA@ABA@@@C@@BBD@@BBBB@BBB@CBAB@@AD@@@@A@CB@@@@@@@D@B@B@@@@BBB@@@BB@@DB@A@@BC@@DCDABA@@@ABA@A@AB@@@@@BB@B@B@@BB@@B@@@BB@@@B@D@D@@AFB@FBD@@@@ABA@ABC@CB@@ADA@@F@B@@@@@BBBB@AB@@@@@@B@BBD@B@D@D@BA@@B@@@DADBB@@@@B@BB@@BA@@BC@@D@BBB@B@@@ABABAB@BAB@@@DAB@B@B@@DBDBB@@ABA@ABA@C@@@AB@@AB@BAB@D@BAB@@@@ABADABA@@B@B@B@@@B@@BFBBB@@@BCD@@@BB@@DBB@@@@BDBB@BB@B@D@@@B@@@BA@@BBB@B@@@BBB@BBB@@@D@B@B@@@B@B@@@@@@ABA@BB@@@B@B@@@@AB@@AB@D@B@BB@@B@BA@@B@@AB@B@@@B@@@@A@@BA@CBA@@B@@@@AB@B@@@B@@A@@@@@AC@@@@AA@AA@@A@@AA@@@A@AABAB@B@@@B@BB@@@@B@@AB@@@B@@ABBBA@B@@BAB@@@@@@@B@@A@@B@@@B@@@B@@B@@@@@@B@B@@@B@BBD@BA@@@@@@@ABAA@AA@A@A@@AAB@B@BBB@@@BA@@@AB@@@B@@@@@B@BBB@@@@@BA@AAA@@@AB@B@BAFC@@B@@BB@@@BB@BBBB@BBB@F@B@@@@B@@@@AB@@@@@B@B@B@@BA@@D@@ADABC@@@@@B@@@@@B@@@B@@@@B@@BB@BB@@@@@B@@@@B@B@@@@@@BB@@BDB@@@A@AAC@@@AA@@@@@@A@A@@@A@C@@AA@A@@AC@AA@@CAA@CAA@A@@@C@C@A@@BAB@B@B@D@BDB@@@@AA@@A@@AA@@B@@@BB@@B@@@B@@@@BB@@@D@@@A@@B@@@BA@BB@@BB@B@@@B@D@@BBBA@BB@@B@@@@BBB@@AAA@A@@@A@@@@@@@@F@BB@@BBBB@@@BB@@@B@@BB@@@B@@BBB@@BB@@BB@@BD@BBB@B@D@@@BABA@AB@@@B@@@BB@BB@@BA@@@AB@@@@@B@@@@ABA@@@@@@@A@@BA@@@A@@@@@AB@BA@@BA@@@@@AB@@@BB@@BBB@@@@@B@@ABC@@@@B@@BB@@@BAB@@@BB@@B@@@B@B@@A@@@@@@FBB@@B@@@B@@B@BBB@BBB@BBD@@@@BBB@@DAB@B@@@@DBB@@B@@BB@B@@BBBB@BB@@B@@@@B@@@@@BB@@@BBDBBD@@@B@@@BB@D@@@BB@@@@BBA@@BCBCB@AA@A@@B@@@B@@@BB@@@@A@@@AB@@BB@DB@BDB@@B@@@BD@@BD@@@B@@B@@@@@@@B@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@DA@@@@@@@@B@@@@@B@@@@@@@@@@@B@BBB@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@@@BA@@@@@@@@@A@@@@@AB@@@@@B@@ABADA@AB@@@@@@A@A@@@@B@B@B@B@@A@@@AB@@A@@F@BBB@B@@@DC@ABA@AB@@@D@FAD@@@A@BE@@BA@@BAB@@AA@BAAA@A@A@A@@DA@@BA@A@A@@@A@@A@CA@@A@A@@A@ABCBAA@@A@@@A@A@@@@A@A@@@AA@@A@@@A@@@@@@@@CB@@A@A@@B@@A@@@ABA@@@@@AA@AA@@@@@@@ABA@@@AA@@@@ABA@@@AA@@A@A@@B@@BBA@@@@AA@@@@BA@@@@@@B@@A@@B@@@B@@@B@@@@@BCA@@@AA@CBA@CB@BC@@@@AAA@@@@@@A@@A@@@@@@@A@@@AA@@B@B@B@@@B@@B@ABBBB@@BA@@BB@@DA@@D@F@@@@ADBAA@@@@BA@@@@@A@@A@AA@AAAAA@@@@@A@A@@@@@A@@@@A@AA@A@@@@D@@@D@@A@@@@BAB@@@B@B@@@BB@@B@@@B@@@F@BA@@B@BAB@B@@@B@@@B@B@@@@@@@BA@@@@BBB@BBBBB@B@@@@AB@@@@@B@DCBA@@B@ACB@@@DB@@BAB@@@@@@A@@@@@@AA@@@AA@A@A@@A@@@A@@@@@A@@@@AA@AAAAA@AB@BAAA@@BA@@BA@@B@@@BA@@B@@B@@BB@@@A@@BA@AB@BBBA@BBAB@B@D@@@@@@@B@@BB@@@BB@@BBB@@@@B@@BB@@D@@@B@@BDB@@B@B@B@@@B@F@DA@@@A@@@A@@@@@A@@@AA@@@@@@AA@@@@A@A@@@@@A@@A@@@BA@A@@@@@ABAAAB@AABA@@@AB@@@@A@A@@CE@@@AA@@A@@@AAA@A@ABA@@BADAB@@A@@@AAA@@@@@@@A@@A@@AA@A@A@@A@A@AB@@AB@@AAABA@@BABC@@@@@@A@BA@@DAA@BA@@@@@ABA@@BAB@@A@C@A@@@A@@AA@@@@@AB@BA@@BA@CB@BA@A@A@A@A@@A@@AA@@@@@AA@@C@@A@@AA@@@A@A@@AAA@ABABAB@AA@@@@BAB@B@@@B@@@A@@A@@@@A@@@AAB@A@B@A@@A@@A@@@@@AA@A@A@A@@@A@@@A@@B@@@@AACAAC@@@BABAB@@@AA@A@@@A@@@AACA@@C@C@A@A@@@AA@@@@A@@@AB@@A@BB@@@@@@@B@@@@@@B@@@@@@@@BB@@B@@@@@B@@@@@@ABB@BB@@@@A@A@@@@@@B@@@@A@@@@F@@@A@BA@@B@@A@@@@@@B@BBB@@@B@@AB@@A@A@@@A@GD@BA@AB@@AF@@@@A@A@A@A@@AAA@@CAE@A@@@@A@@BA@@@@@@@A@@ACA@@A@@@@AB@@@@CAAA@BA@CA@@@@A@@B@@@@A@A@@@@B@@BB@B@@@BABAB@AA@@BAAA@AA@@CA@A@@BA@@BAA@@A@A@A@@@A@AA@@@AA@@A@AA@@A@@@AA@@@@@A@@A@C@@@@@@@@@A@@@A@@@@@@A@A@@@@A@@@@@A@@@@@A@@@@@@@@A@@@@@@DABB@@B@@A@@A@@@@A@@A@@@B@@A@@@@@A@@@@@A@@BA@@@@BA@@@@@A@@@A@@B@@@B@@@@@@@@@B@@A@@AA@@@@BA@A@@@A@@@AB@@AB@@BB@@@B@B@@@AA@A@@@A@A@@@@@@B@BB@B@BB@@@@@@A@A@A@@AA@@AAA@BA@@@@BA@@@B@AB@@BA@B@@@@@@@@@B@@A@@B@@B@@@AB@@AAE@@B@@A@CAABA@AAA@AAA@ACAAA@@@AA@AAAB@A@@A@@@ABAB@@A@AAAA@AAA@A@@@A@@@A@A@@BA@@@AAA@A@AAC@A@@@A@A@AA@@A@AAA@C@@@AAC@@A@@@A@@B@@AB@@@@@A@@@AA@@A@BAA@@@@@AA@@@@@@AA@@A@@@@@A@@@@@AA@@A@@@@@A@@@A@@@@B@A@@CAA@@AEBA@A@@@A@A@@@@@@@@@@@A@@@AB@@A@A@C@@@CB@AA@A@@CAA@A@A@@@@@A@AA@@A@AAA@CBC@A@@@@A@@B@@@BABA@A@@@@BA@@BB@ABAB@@AB@@A@A@@DE@A@@@AAA@@A@A@A@@AA@@@A@AB@@@A@@@ABA@@BA@@B@@AB@@A@@B@@@BA@@B@@B@@@@B@@@@@B@@@@@BBB@BAB@BA@BBBBBB@B@@B@@B@@BBD@@@@D@BABAB@@A@@@AB@BAB@@@@B@@@@B@@@@B@@BABE@@@C@@C@@AAAAA@A@@@@AA@@@@@A@@B@@@BA@@AA@@@@@@@@@A@@BC@ABC@@A@AAAAAAAC@A@ABG@CB@@@BA@A@ABABE@A@A@@@@AAA@@@@A@@@@@A@@@@A@ABA@@@@@@@@A@@@A@A@A@@BCDADE@A@C@@BA@@@@A@BA@@@@@@@@B@@A@@@@BA@A@@@@B@@@B@@@@@B@@A@@@@@A@A@A@@@@@C@C@@@@@@A@@AACCA@@BABA@AD@@A@@BA@@A@@A@@@A@@@A@@B@@@@A@@B@@@@A@@B@@@BAFE@A@@@AA@A@A@A@ABA@@@@A@@AAAB@AAB@@@@@A@@@@AAA@@A@@AACACEA@ACA@AA@@@@@@DABAB@B@DA@@@@D@BBB@B@@@B@@BBB@@@@BAB@B@@@@@@A@A@A@@@A@@@@@AA@A@@AA@AA@@BC@@@@B@@ABA@A@@B@@@DDB@@@BAAAA@@@BAB@BABAB@F@@@BADC@@@A@@@AA@@@CAA@@@A@AA@A@@@@@ABA@@BA@@@A@@@A@A@@D@DA@@B@B@B@@@B@BB@@B@@@B@@@DB@@@@@AB@@C@@D@@@@@B@B@B@@@B@BB@@@@@@BA@@@A@@@@D@@@B@@@@@@A@@@A@@@@B@@@@@@A@@B@AAB@@AAAA@AA@A@@@@@A@A@@@A@@@A@A@@@A@@@A@@BA@@@A@@@A@A@@@ABA@@@A@A@@BAA@AA@@@A@C@AA@AC@A@@@@@AB@BA@@@A@@@@@A@@@@B@@@@@@@@AB@@B@@@A@@@BBA@@@@@@@@B@@@@@@@@@@@B@@@@@@AB@@@@@B@@@@@@@BA@@@@B@@@@@@@@@@@@AB@@@@@@@@@@@@A@@B@@@@A@@@A@@FENKDIACUCOAQA"],encodeOffsets:[[121745,25848]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
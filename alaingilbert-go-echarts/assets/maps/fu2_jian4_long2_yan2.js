@@ -0,0 +1,4 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("龙岩",{type:"FeatureCollection",features:[{type:"Feature",id:"350802",properties:{name:"新罗区",cp:[117.037155,25.098312],childNum:1},geometry:{type:"Polygon",coordinates:["@@BDBBDFBDBBBB@B@D@@BBB@@A@A@A@@@@BB@@@ABA@@@@BAB@@@@@@@B@@@D@@@@@@ABA@@@@B@B@B@@AB@@@B@@A@A@@BA@@DB@@@@B@@@A@@@@BA@@B@@BD@B@@@B@@@B@@DBBB@@@BB@B@B@@@B@@@@@FBB@@@BBBB@B@@AB@@@B@@@@@@@B@B@@@@@B@@@@AB@@@D@@@B@B@@@B@@BB@BBB@@B@@BDB@@BB@@BBBBB@BB@@B@@@BB@@B@ABB@@@AB@DA@@B@@@B@@@BAB@B@B@@@BBB@@@@B@@A@@BBB@@ABA@@B@@@@@B@@@@B@@B@B@B@@A@B@@B@BA@@@BB@@@@B@@@@@B@@B@@@BB@@BB@B@@@B@B@B@BAB@@@B@BA@@B@@B@@B@@@@BB@@@B@B@@@BB@@B@B@@@D@B@@@@@BB@@BBB@BB@@B@@@DBB@@B@B@@@BA@@@@BAB@@@@@B@@A@ADABA@A@@B@@A@@BA@ABA@@@@@A@@B@@A@@@A@@B@@@BC@A@@B@B@B@BA@@@@BA@@B@B@@BBBB@B@@@BB@DBB@B@@B@@@D@B@@BB@@@B@B@@B@@B@@BB@@B@@BB@@B@@@BAB@@@@@B@@AB@@@B@@@@A@A@@@A@A@AB@@ABA@@@@@A@@@@@A@@@AAA@@@C@@B@@BB@B@@B@@@B@@@@@B@@BB@@A@@B@@@@@@@B@@@B@@@B@@D@@@BAB@B@@@@@@@CA@A@A@@BABA@@@@DBDBDDB@@ABA@A@A@@@@@AAA@@@@@A@@D@BB@@B@B@B@@ABA@@@A@A@A@A@A@@@@@@BA@A@AB@DAB@DAB@BABA@@@A@A@@BA@@AA@@A@BA@@AAAA@@@@@A@@D@@@BAB@@A@A@@B@@@B@@AB@@@@@B@@A@@BA@@@@@@@AB@@A@@@A@@@@@@@A@AB@@ABA@@A@@A@@@A@@A@AA@@CA@@@@@A@@@@B@@@@@BA@A@@@AB@@AB@@@@@@A@@@@@@@C@@@A@AB@B@@@B@B@BBB@BA@AB@@@D@@@BB@@BBBAB@B@@@BB@@@BA@BBA@@@@B@BB@BB@@@@@BBAB@@A@@B@@@@AB@@ABB@A@@@@@@@A@@B@B@BBBB@@B@BA@AAAAA@@AA@ADAB@@@BB@@BAB@BAF@@A@@B@@@FA@@BA@@@CBABA@A@@@@BA@@@@B@B@@@@@@@@AB@@@B@@A@@B@@@B@@A@@BA@@@@BBB@B@B@@@B@@BB@@@@@BCB@@@A@@@A@A@AAA@AA@@AAA@AAA@@AAA@A@A@@BA@A@A@@A@@AA@@A@@BC@@@AAA@@@A@@AD@@AB@@@D@@@@AB@@A@@@A@@@@@A@@@A@@@A@@@AC@A@@@AA@@AAAA@@AA@@@@@AA@ABE@@@AB@@@BAB@@@@A@@B@@CBA@A@@F@BA@@@@BA@@AA@@B@@A@@@@@@@ABA@@BA@A@@B@@A@@DCBAB@@@@@@AA@AA@@@AAA@C@@@A@@A@@@@@AAAB@AA@@@A@@@@A@@B@@@BCBA@ABABA@@B@B@@C@A@A@@AA@A@@@A@@@AA@@A@A@AB@@A@@@@@A@@@ACCAC@ACA@@@@@AA@@A@A@@@A@@@AA@@A@@AA@@@A@@@A@@BAB@BAB@@@B@@@B@@ABA@@@AB@BADABADA@@@A@BB@@@@BH@@@@@@@B@@@FA@A@@@@@@B@@BB@B@@A@@@@@@@@B@B@B@@@B@@@D@B@B@BAB@@@@A@ABABABA@@BA@C@A@@@A@A@@AA@C@@@@A@@@A@A@AAA@CA@@A@@AA@@A@@@AA@@A@@A@CBABAAA@@BA@AAA@AA@@@@BA@@DAB@BA@@@AA@@A@A@C@@A@A@A@@AB@B@DAB@@BB@BBB@@@B@B@@@B@BABA@A@@@A@@BA@@BA@AB@@@@@@AB@@A@@BAB@@@@ABA@@@AA@@@@AA@@AA@@A@@@A@A@@AA@AAA@@@AAAAA@@A@A@@@CAA@@@A@A@@@CA@@A@@@C@AA@@@@AA@A@ACA@A@@A@@AB@@@@ABABAB@BAB@BA@A@@BA@CB@@A@@@@AAACAA@@@A@@BA@@BAB@@@@E@AB@@A@@@@@@AA@@@AA@@@AAAAA@@@A@@@@AA@@CA@@AB@@AB@@A@AB@BA@@B@@A@ADB@AB@DBB@@@BA@@@@BABAB@B@BAB@B@@@BA@@@@AE@@@C@A@E@C@ABCAA@@@@@AAAAA@AA@@@@@ABA@@@A@@@AC@@AA@@@AA@AA@@@@@ADA@@@@@A@@@@B@@@B@@A@@B@@A@AB@@A@@@A@@AA@C@A@@@A@@CAAAAA@@@@A@@AAA@A@AA@A@AAAAA@@@A@@@AD@@@@A@A@AAC@@AA@A@@@ABABC@CBABA@@AAAA@AAA@@@A@@@A@@@@AA@@AA@AAA@@@AAA@@AA@A@AAA@@AA@@A@@CA@AAAA@E@AAA@A@ACA@@@C@@@A@AAA@AA@AAA@AA@A@AAAAAAAAA@AA@@A@@@@AA@@@@AAAA@@A@@AA@@AAAAAA@@@@BA@@@@@@AA@@@A@@@A@@@@A@@AAC@@A@@@@A@A@A@@@@B@@@B@BB@@BA@@@@D@DAB@B@B@B@B@B@@A@A@@BA@A@C@@A@A@A@@@@A@@@A@AB@A@@ACAAAA@AC@@A@AAA@A@EAC@ACA@@@A@@@@@AA@AAA@@@ABA@A@@BAB@@A@@@@AA@@@A@@@ABA@CBA@E@@B@@ABBBA@@@@BAB@DAB@B@B@DB@A@@B@DAF@B@@@B@BAB@BAB@BAB@@@B@@@@@@A@A@C@ABA@@@A@@@AAA@@AECA@@A@A@A@@@A@@@A@@@@@ABA@A@A@@@@@@A@@@@@C@@@ABC@AA@@ACAAA@@CAA@@@@A@@@@@@@@BAB@@@@@@C@C@@@A@@@AAA@A@@A@A@AA@B@@@@BB@@CD@@@B@@A@@@@@AB@@AAA@@@A@@@@A@@AACA@@@A@@@@B@@AAA@@@A@@B@@@@A@@@AB@@@BA@@@A@@AA@A@@@A@@@A@@@@A@@B@BA@A@@@AA@@@A@@AA@A@@@A@@B@B@BABABAAA@A@AB@AC@AAAAA@AA@BCA@BA@@@@@AB@BA@ABA@@@C@A@@C@A@@@A@@@CAA@AACAAAAA@@AA@@E@A@@A@@A@C@ACA@@A@AAA@@@ABABABA@@@@B@@@BA@AB@@A@A@@@A@@@AA@A@AA@@BA@@@@AAA@@@@A@@@@A@@@A@@AB@@A@@@@A@C@AB@@@BA@@@@@A@@@A@ABA@@@A@@@C@ABA@A@@@AAA@AA@@AA@@@@A@AC@@A@@@A@@AA@@@CAAC@AAA@@C@AA@@@CAAAA@C@@@AA@AA@@@A@@@A@@@@@A@CAAAC@A@@@A@C@@A@@A@@@A@@@@@AA@@@@A@@@@AA@A@@AA@@@A@@@C@A@A@A@A@@@A@A@@BA@@AA@@A@A@AAA@@@@AAA@AAA@@@ABA@@@C@AAAAAA@@A@@@@@AB@@ABA@@@@@A@@B@@@@@@A@ABA@ABA@@@@BA@@@@@@AA@A@@A@A@A@@AA@A@A@@AA@@AA@AA@@@A@@@A@@AA@@BA@@@C@@@AAA@AB@@@BADAB@B@@AB@@BB@BAB@@@@AB@@@@@@A@@AA@@@@@@AA@@BA@A@@BA@@@@BA@@@A@@@A@@@ABA@ABA@@@@B@B@@@B@@@BABAB@B@B@BAB@@AB@B@@@B@@AB@@@B@BAB@@@BABA@@B@@@@@@A@@@A@@@@@A@@@@@C@A@@@AAA@A@A@@AA@@AAEA@@A@AB@@@@A@@A@@@AA@A@@@CACA@@@@@A@@@AAA@A@CAA@A@A@@AA@@A@C@A@@@@@AA@@@@AA@@@@@A@A@@@A@@@A@A@C@C@A@A@@@A@A@A@@@A@@B@BA@A@@@A@@@A@@@@@A@@AA@@BA@@@A@@B@@A@@B@@@@@B@AA@@AA@@@A@@@AAA@@@@BA@A@@@@BA@@@A@@@@BA@A@@BA@@@@BA@@@A@CB@BAB@B@D@BA@@@@BB@A@A@AB@AA@A@@@A@A@@@A@@@A@@B@@@@@@@BA@@@@BC@A@CA@@A@AAA@@AABAA@@@@@C@AA@@AA@@@@A@AAA@AB@@@@@@@AAC@AAA@@A@@@AAEACCA@A@AAA@@@@@@A@@A@@@AAEBA@AA@@A@@@@@ABA@@@AA@AA@@@A@@AB@@@@A@A@@B@B@BA@E@@@@AA@@@@BA@AB@@@@@BA@@BAB@AA@@C@@@A@@@@A@@@@@A@@@@@A@@FA@@@@B@B@@@@@BA@@BA@A@@B@A@@@@AA@A@AACA@@A@@@@AB@BABAAA@A@AB@B@@AB@@@@@@@@AB@@@A@@A@@@A@@B@@@@@@AAA@@A@@A@A@@AAA@@@AAAA@@A@@@BA@@@@@A@A@A@A@@@@A@@AA@@AA@@@A@@@AA@@CAA@AAAAAACA@@@@AA@@B@@AB@@@AAAA@A@E@A@AAA@@@AA@@A@@@A@@@@@AA@@A@@@A@@AAA@@@A@@AA@@@@@AAA@@A@@@ABA@@@@AA@@@@A@C@AA@@@@BA@@@@B@BAB@D@B@B@@A@@@AAEA@@ABA@@AA@A@@A@@@A@A@@BAA@@@@A@@@@@AB@B@@AA@@A@@@AA@AA@CEA@A@@A@A@@@A@@@@A@A@ABA@@@AAC@@@A@@@ABABA@A@@@@@AA@AAA@@A@@@@@@B@B@B@BA@@@@@AB@@A@@@A@@BAAAB@@CB@B@B@@ABABA@AB@@@@A@@B@@A@A@@@@@A@@@@BBB@@A@@@@@A@C@@@C@@@A@@BA@@@@@A@@A@A@A@@@@BA@@AA@@@@@@@AA@@BA@A@@ACAA@AAA@A@A@AAA@A@@@A@A@@@@AAA@@@@AB@@A@A@@@@@@B@@@@AF@@A@@@ABA@ABC@@B@@@B@@@D@B@@@B@BB@BD@B@@BBB@BB@B@@@@A@@B@@@BAB@@@@@BD@@@@B@@@@AB@@@@BB@@@BA@@B@B@@AB@@AB@@@@@BAB@@@@@BB@@@@BB@@B@BB@@BA@@B@@@B@B@B@B@B@@A@@@AAA@@@A@@@A@@B@@@@@@B@@@@BBB@@@@@@@BA@@@AD@@@@@BAB@B@BCB@@@BABA@@BAA@@A@@A@@@@AA@@A@@@@AA@@@AB@BAB@BAD@B@@@@BBDDB@@@@B@B@@DBBBBB@B@@@@BA@@@AB@B@BA@@B@B@@AB@B@@@@B@B@@AB@BABCBA@A@@BC@ABB@@@B@@@A@@B@@BBDDFAB@B@@BB@B@@@B@@@B@@@B@@@BBA@@@@BB@A@CBA@A@AB@@B@@B@@@@AB@B@@A@@B@B@@BB@B@@@BAB@@@B@B@@@B@@@B@B@@@@@BB@@BB@ED@@AB@@ABA@A@@@C@@@@B@@@BA@ABAB@BA@@BAF@@ABABA@A@@BAB@@A@CBA@A@A@A@ABA@@@ABB@AB@BBB@BB@@B@@@FA@@B@@@BAB@@@@B@@BB@@AB@@@B@@@BA@@B@BB@@@@DA@@B@B@@@BB@@@@AH@B@@@@DBD@@@@B@@@@@B@@AD@@@@@B@@@B@@BB@B@@B@@@@@BBA@@@@@A@A@@@A@@A@@A@G@A@AAC@@BAB@@@B@B@@@BA@A@A@@B@@AA@@A@@@A@CBC@@@A@@BBB@@@B@@A@@BAD@@@B@BBB@@@DBBA@@BA@@BAB@@@BA@@@@@AAEAA@@@AA@@A@@@A@@@@@A@@@@@AAA@A@@@@@@@AC@@@@C@@@@@A@@@@A@@@@AA@B@@A@A@@@A@@@AAA@@A@@ABA@@@@@@B@B@@C@@@@B@@@@@B@DB@@BABAB@FA@@@@BAD@@AB@B@B@B@@@@@BCBABAB@B@@@@BB@B@B@@AB@@@BABA@@BA@@BA@@BAB@@ABA@@@@BAB@@A@@AC@AAEAA@@@A@AB@@CBCBABCBCDA@AA@@@@A@@AAAAA@A@C@CAA@@@@@@@A@AA@@A@@AAA@@@@AA@@AAA@AA@@@A@A@@B@@@@A@A@@B@@CBCBA@@@@BB@@B@@@@A@@AA@@@A@@@@@A@@A@@@@@@A@@A@@B@@@AA@@@A@@A@@A@@@@BA@@@@A@A@AB@@A@@@AB@@@B@B@@@@@@@BAB@@@@@A@@A@A@@A@@@AAA@@@@GA@@A@@BA@A@AAE@@@A@@@AB@@A@A@@AA@@AA@A@@@A@@@@BAB@@@B@B@H@B@BAB@B@BA@B@BDBBDBBBDBB@@BBB@@AB@BB@@BB@@D@B@BABA@@@@@@BDB@@@@@B@@@BBB@@@@@B@B@BA@@B@@@@A@A@@BAB@@A@@B@@B@@BA@@B@@B@@@AB@@@@A@@B@B@@@@A@@@@@CA@@A@C@@@A@A@A@AB@@A@@DA@@B@@@@AB@@@B@@AB@@@BABA@@@@DA@A@A@A@A@AA@@@@A@CA@@E@A@@D@@A@A@@@CBEBCBA@@@A@@@@B@@@BBBAD@B@B@D@@BDB@@@@@@@AA@@B@@@B@@@B@@@@ABB@@B@@@BB@@@DBB@@@B@@@@A@@BABAB@B@F@@AB@B@@@BB@@B@@@BBB@@AB@@ADA@@B@BA@@@ABC@@@@@BB@@@D@@@B@@@BAB@BA@AB@BAB@@CB@BA@A@@@CDCB@@AB@BAB@@AB@@ABAB@@A@AB@B@@AB@@A@AB@@@@@B@BA@@B@B@BAB@@A@@@A@A@A@@A@@ABAA@@@@AB@@A@@@@@ABA@AB@B@@@@A@AC@@@AA@AF@@A@AB@@@@A@AAAAA@@@A@@@@@@@CA@@A@@@@A@A@@A@A@@BABAB@@AB@@@B@@B@B@@B@@@@A@@BA@@B@@@@B@@BB@@BA@@@CDC@@BA@@@AB@@A@A@@@AAA@ABA@@@@@A@@@A@@@A@A@A@A@@@@@A@@A@@A@AA@@B@@@BAB@B@@@@A@ADAB@B@@@@@@AC@@A@@A@@@C@@@@@ABA@@@A@@BA@@BABA@AB@@A@@@A@@@@@AA@@B@@@@A@@@@A@@@BABAB@@AD@BA@@@@@@@AAA@C@@A@A@A@C@@@@@AAA@A@A@@@A@@@A@@@CD@@CB@@AB@@A@A@@@CAA@@@A@A@@B@@A@@@AAAAA@@@@@@AA@@AA@@@AA@@@@@@@A@@@@A@@@@AAAAA@@AAA@A@A@@@@A@@@AA@A@@@AAA@@@A@@@A@@BAA@B@@CA@A@@@@A@@AA@@A@@@@@@A@@@A@@@A@@@@@A@@@A@@@A@@@@@A@A@@B@@AB@@@D@BA@A@@@C@@@AB@@ABB@@B@B@B@@@B@@@@AB@@A@@ACA@@@@@B@@BD@BBB@B@B@BBB@@@@BB@@@@@BAD@@@BA@@@A@@B@D@@@@AB@D@B@B@D@B@D@D@B@BA@@@@B@@@B@@AB@B@@@B@B@BA@A@@@@@AA@A@@@AAAAA@A@@@@A@CF@@A@@AA@@@@@ABCB@@@@@@B@B@@B@@@@@B@@B@@BBB@@@AB@B@@@B@@@BB@@B@@@@B@@@B@@B@@@B@B@@B@B@@A@@@AAA@@BCA@BAAAB@AA@@@A@A@CAA@@@@ACB@@C@C@A@@@A@@BABA@@@ABA@@@A@A@A@CAA@AA@@@AA@@@@AA@@@@B@BA@@@@B@@@BBB@B@@@BBB@@B@@BA@@BA@@BA@A@A@A@C@@@@@@@@BB@A@A@CB@@@BA@@@A@@@A@C@AB@@A@A@@@@@A@AB@@A@AB@@A@@@C@@@A@@@A@A@A@@@A@@@A@@@@@AB@B@@ABB@@@@@@@@B@BB@@B@@BB@BB@@BB@@@@@B@B@@@B@BBBBBB@@B@B@@@@@@@B@@B@@@BB@BB@@BA@@FD@@@A@@@@@@BBD@BB@BBBB@@@@DB@B@@BD@@@@B@@@AB@@B@@@@@B@@B@B@@B@@B@@B@@@@BA@@B@BABB@A@@@@BB@@B@BA@@B@BA@@B@@@@ABAFAB@B@BA@@B@B@B@B@B@@AB@BABA@@B@B@@@@@@@B@@BBA@AB@BA@@BA@@B@@@@@B@@A@@@AB@@A@ADA@@DA@@B@@@BB@@@@B@@@BBB@BB@@@@@BAB@BBB@@AB@@BB@@@@@B@@@@B@BB@@B@@@B@@B@@B@@BBA@B@AB@@@BAB@@@@A@@BA@@B@@BD@@BD@B@B@B@@B@B@@BF@@AD@B@@@@BA@BB@@@BBBB@@@B@@@B@@B@BB@@@D@BA@@B@@@BBB@B@B@@@@B@@B@B@@B@@@B@@@BBB@@@BDB@@@@@@@B@BAB@B@D@@@BA@@@AB@@@BAB@@@B@@BB@@@BAB@B@B@B@@@BBBB@@B@@@@@B@@@B@B@@BB@BAB@BABA@@@AD@B@B@B@B@B@BB@@@@BBBB@B@@B@@@B@@B@@AB@B@@@B@@B@@@BBB@@BB@@B@D@@B@@@@B@@@@@@@BB@@BBBDD@BBB@@@@AB@@@@BBDB@@BBB@@@@@B@@BB@B@@BBBB@@BB@@@BA@@@BBBBBB@D@@B@@B@AB@@@@BB@B@@B@B@B@@B@@B@@@@@B@B@BB@@D@B@@B@BB@@@B@B@BB@@@B@@BB@BD@@BB@BA@@@BB@@BB@@@F@@@@BB@D@B@B@BAB@B@BAB@@@@B@@B@@BB@@@@@B@@@B@D@@@B@B@BBB@@BB@@@BB@ADAB@@@B@@@B@@@B@@@B@@@DA@@BA@BB@@@@@BB@@@@@@BB@@@BB@B@@@B@ABB@@@B@@@B@@B@@@@B@@@B@@@B@B@@B@@@@@@DB@@B@BB@@BB@@B@@@@BBA@BD@@B@@B@B@@BD@@@DBB@BB@@B@B@@@BDBB@@@B@B@B@B@B@@A@A@@@@@@BA@A@@@A@A@@@A@@@A@A@@@A@A@@@@BA@@@@@ABA@@@@@A@@@A@@B@@@B@@@B@@AB@@@@@@@BCB@@@@AB@@B@@@A@AB@@BB@BABA@AB@@AB@@@@@@AB@@A@@@A@AB@@@@DB@@@@@@@B@@@@@@@@BD@@@D@B@B@BA@@@A@@AA@@@AB@@A@@@@AAAA@AAA@ACA@@@@B@@AB@BA@@B@@ABA@A@AB@@AD@@C@AB@@AB@BAB@B@BB@@B@B@@A@@@ABA@@BAB@B@B@@@BCB@B@@@BBB@@@@BB@@B@BA@@B@@@@@B@@ADAB@@@B@B@B@DA@@@@B@@@B@@@B@@@@@@AB@@@BA@@B@@@@A@@B@@CBB@AB@@@@B@@B@@B@@@B@@D@@@@B@@@B@B@@@@BB@@B@@@B@@B@@@B@@BBB@@B@@@@@BA@@B@@@@@BB@@@@BA@@@@@@B@BA@BBB@D@BA@@B@@AB@BAB@@@@@@B@@@@@BA@@@@@@@C@@@@B@@A@@@@BABA@AB@@@@@B@@A@@BBB@@@@BA@@@BBB@@@A@@@@@A@@@A@@@@@AB@@@BA@AB@@@@BB@@@@@@B@@@@B@@@@@@B@D@BAB@BB@@@@@@B@@BBBB@@AB@@@B@@@@BBBBDD@@@B@@@@BD@@@B@@@FABAAA@@B@BABA@@B@@BD@@DD@BB@DD@BA@@@@BBB@B@@@@@B@@@BB@@BBBB@@@B@@AB@@AB@@BB@@@@BB@@@BA@@B@BADB@AB@@A@@@BB@@@B@B@@@B@BA@@BB@@@BAB@B@B@@BB@@BFBB@@@@@DABAB@BAB@@@B@BAB@@@@A@@@@B@B@@AD@B@B@@ABA@@B@@A@AAAAAAAAA@@@@B@@@B@@@B@BA@B@@BA@AB@@AB@@@@A@C@A@@@A@A@@AAA@@A@A@@BA@ABA@A@A@@B@@@B@B@@@@DBCB@@@B@@@B@@A@AB@@BBB@BBBB@B@@BBD@@@@@BB@BB@@@B@@BB@BBB@@B@@ABA@A@@BABCB@@@@ABA@@@@@AB@@BB@D@B@B@@@@ABA@@@@B@@@B@B@@BB@@@@@B@B@BAD@@@B@@AB@@BB@B@B@@A@@AA@@@@B@B@@@B@@@BBBBB@@AB@BBB@B@@BA@@B@B@B@@@@B@@@B@@FBFBDBB@BB@@@@BBBB@@@BA@AB@@@B@B@@BB@@AB@@@@B@@BB@BBB@@@B@@@B@@@@@@@B@BB@@B@@@B@B@B@@A@@B@@@B@@B@@@B@B@@@@C@@@AB@@ABAB@@@@@@B@@@B@@@@@AB@B@@BB@@@@@@B@B@@B@@@BA@ADA@@@@@A@A@A@@@AB@D@BA@@BA@@B@B@BBB@@@@@B@@A@BB@B@@B@BB@B@@@@@B@@A@@B@@@B@@@B@@@@@BA@A@AB@BA@@@ABA@ABA@@@A@@@@@ABC@@@A@@B@@@B@B@@@BB@@B@@@B@@@BBB@@BBA@@BB@@B@@@@@@ABBB@B@@@B@@BB@BB@@@BA@AB@B@@@@@B@B@@@@@@A@@BA@@@@D@B@BABAB@@@B@@@@B@@@DBB@BA@@@BB@@@B@@@B@B@@B@B@DA@B@@@BBB@B@@@@@@@B@@BB@@A@@B@B@@@BA@@@@B@@@B@@@BC@@BA@@@@@@BA@@@@B@@@@ABA@@BA@AB@@B@D@B@B@@@@@@B@B@@@@BB@@@@@B@@D@@@@B@@@BGAE@@@CB@@@@@BB@@D@@@B@@@@@B@@B@@@@@B@@BB@B@BB@@B@@@B@@@BBBBB@@@@@BBBAB@@@@@@@B@@BB@@B@@@@@DAD@B@@B@B@BB@@@B@B@@B@B@BB@@@@B@BA@@@B@D@B@B@@AD@B@@@@D@@@B@BB@B@@FA@@BAA@@AAA@ABA@A@@@AAA@@@A@@@AB@@@B@BAD@B@@@B@@ABBB@@@B@@@B@@@B@B@@@@@BB@@@@@B@@BBBF@@BB@@@BBB@@@B@@@@BB@@@B@@B@@@@@BB@B@@@BB@B@@@B@@@@@B@@B@@B@BBB@B@@@@@B@@@@@BAB@@@DBB@@@B@@@@@B@@@BB@@B@@@B@B@@@B@@@B@B@@@AA@A@A@@AA@@@A@@@@@@B@B@@@DA@@DA@@BBB@@@@@@ABAB@@@@@BA@BBA@@@BDA@@B@B@@@B@B@B@B@@@B@@@B@@@@D@@@@DBBB@@B@@@B@@AB@@A@@@@@@BA@@BADA@@BB@@@@B@DA@@@@BA@@BA@@B@@AB@BA@@@AB@@C@AB@@@@ABC@@AA@A@A@@BBB@@@D@@@BABA@@B@@A@@B@@@@ABA@@BAB@@@@@BAB@BA@@B@@@B@@@B@B@@@BAB@B@B@B@BAF@BA@@B@BADABAB@D@DAB@D@@AB@B@B@F@@@B@@@BAB@@@B@B@DAB@@@B@B@DB@B"],encodeOffsets:[[119923,26111]]}},{type:"Feature",id:"350821",properties:{name:"长汀县",cp:[116.357581,25.833531],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@CAA@@@CAA@@@AFI@A@@AA@@BA@@AA@B@@AB@@@@AA@@ECA@A@A@A@BAA@@@@@AAAA@AAA@@AC@A@AAA@@EA@A@@AA@A@@@A@C@@@@@ABA@@DABABABAB@B@@A@@B@@@@A@ACAAAACAAAABA@AABABAAA@@@ABAB@AA@AB@@AB@@AB@BA@A@AB@@A@@@A@@@AAAA@AA@A@@@@@AB@@A@A@@B@@C@A@A@A@@@A@@B@BA@@B@@@B@@A@@BCBA@@B@@BBBB@B@B@@@BB@DA@@@B@@@BABABBB@@@BA@@BABAB@BAB@@AD@BA@BB@@@B@@AD@@@B@B@@@B@@@@AB@@A@@B@@AB@@AB@@@B@@@@@@B@@B@B@@BB@@@@BB@@B@@A@@@@BD@D@@@BB@BB@@@B@BABB@@B@B@@BB@@AB@@@B@DBBABB@AB@B@@B@@BB@@B@BB@@@BBB@BBB@@B@@ABAB@@AB@B@@@BBBBD@B@@ABABB@B@@B@@@AB@@A@@@@B@@BB@B@@BBBBB@@B@BB@@B@@B@@@@@B@@AB@B@@@B@B@B@@@B@@AB@AC@@@AF@BA@BB@@@@B@B@@@B@BAB@@@BB@@@@B@@@@BBB@@BB@@B@@@@B@@BD@@@B@DBBBBD@B@@@@@@BA@@@BB@BB@@@DBDBBDB@@B@B@@@B@@B@B@@@ABA@@@BB@@@@@B@@B@@@BBBB@D@@@B@@AB@B@BB@@B@B@B@@@BB@@@B@@B@BA@@B@B@@AB@@A@@B@@BB@@@@@BB@@@@@CD@BA@A@A@AAAAA@@@@@A@@@CA@A@@A@ABA@@@@B@@B@B@A@ABA@A@@@@@@BBB@@@@@BA@AB@@A@AA@@AA@@A@A@@B@@@@BB@@BB@@@@CB@A@@A@@A@A@@@@AB@B@@@B@@@@BB@@@@AB@@@@@AA@@@@@AB@BA@@BA@@B@BA@A@@@C@@@AB@@@B@@@BBADBD@@@B@BAB@@A@B@@@BA@@@AB@@AB@@ABA@@@A@@@AA@@A@@@@B@B@@A@@B@@AB@@@@AB@@A@@@@AA@A@@@@@@A@@@@AB@@A@@@AB@@ABCBAB@@A@AAAA@@A@@@@A@A@@A@@@A@ABCBA@A@@@C@@BA@CDA@AB@@@@A@A@@BCBABAB@D@B@@BB@DB@BBBB@@@@AB@@@A@@A@@@@@A@@A@@@B@@ABABA@@@A@AAA@AA@@AAGIA@@@AAA@A@@@@@CBA@A@ABA@A@@BA@ADAB@@@AA@@@A@@@@@@@@BAAA@AA@@A@@AA@@@AAA@@A@@A@CBA@@@AB@BAB@@@@@@A@@@A@ACA@ABAD@@AA@@@@AA@@@@A@A@AB@@AB@@C@@@A@@@C@BB@BA@ABABA@@B@@A@A@@BA@@@A@@@A@@@@A@@A@AA@BA@AB@BA@AB@B@BA@A@@@A@@@@@A@B@@A@@AA@@@@@A@@@@@AA@@@@@@@A@@@@AAA@@B@@@@CAA@@BA@@@@@@AC@AAA@@@A@@A@@@A@@@A@AB@@A@@@@@A@AC@@BA@@@@CB@@C@@@AB@@CB@@AA@@@A@@BA@@@A@A@@@@AA@@A@A@@BAAA@C@@@A@AB@@@BA@@@@ACCAA@ABABA@AAA@@@A@@@@B@B@B@B@@@@@B@@@B@@AB@BAB@BAB@@@@@BA@@@@@@B@@@B@B@@@@@@AB@@@@@BABAB@BA@@@@DA@@@@BAA@@AA@@AA@@@@@A@A@@AA@AB@@@@@@A@AA@A@CA@@AC@@A@@@@C@AAA@C@A@@@AA@A@@C@AA@@@@ABA@@B@@AD@B@@@@@B@@@@@@@@A@@@C@A@A@@@@BA@@@C@@@A@ABA@@@@A@@B@@A@@@@@@@A@@@A@AA@@@@A@A@@@@A@@@@A@@@@@@@ABBBA@@BABC@A@@BA@@B@@@B@BA@AD@@@@AB@@A@@@@@BB@@BFB@@B@@@DCB@@@D@BA@AB@BAB@@AD@B@@@@A@@@A@A@A@@B@@@@@@@B@@@@AB@AA@@@@CA@@@@@EA@@@@A@@A@AB@@A@@B@@A@AB@@AB@@CC@@@A@@@A@AABA@@@A@@AA@@@A@@A@@A@@AA@@@AAAAAAA@AAA@@@ABA@A@@@@@AB@@@@C@AA@@@@AB@@@B@@AAA@AB@@ABA@@@@@@C@@@AA@@BA@A@@B@@AB@@A@@B@@ABA@@@A@@@@@A@@B@@@@BBB@B@@B@@@A@@B@@@B@@@@@@@@@B@@@AB@@AB@@A@@B@@@@ABD@AD@BA@@BAB@BAB@BBB@@A@@@@BBA@@@@@@@@@B@@@@BB@@A@@@@BB@@@@@B@B@@@B@@@@@@BB@@@BBBBA@@B@@A@A@A@A@AAAA@@AB@@@@AA@@A@AA@@@@A@@@@@@@@BA@@B@@AB@B@@@B@BA@@@A@@BAB@A@A@A@@AA@BCB@@@@@@A@@A@B@@A@@BA@@@@@@@@B@@A@@@A@@@@@@@A@A@@@@@@@@@A@@@AB@@BB@@@B@B@@AB@@@@@@A@@A@@@@@@A@A@@@AA@@@@A@@@A@@@@@@@A@@@CAAA@@@@@BAF@B@B@B@@@@@@CB@@A@A@@@A@A@@A@@A@@A@@@@@A@@A@@@A@AAA@@@A@@@A@@B@@A@@@A@ED@@A@C@BC@A@C@A@@@C@@@@A@@@A@A@@@A@ABA@@B@BA@@BBB@B@@CBAB@@E@@A@AA@A@C@A@@B@@@@AB@@@@@@A@ABAB@@C@A@A@A@A@@@@B@@@B@@@B@@@@BFBBBB@@@@@B@@B@@B@@@@@@AB@B@B@@@@@BAB@@@@@@ABA@AB@@@@@AA@@@@B@@AB@@@@A@@A@@@A@@AA@@C@AB@@@@A@@@A@A@@@AA@@A@AB@@EACCAAA@CBCD@DABBL@DBD@@ABA@AB@B@BBB@@DBDDFHBHDLHBFBPBLH@@ADAD@@BD@D@DAD@D@D@D@B@D@DBB@DBDBF@BBB@@DBDADAD@D@D@D@DBDBBD@B@BBDDBBBBFBD@DCDADCBABBD@D@BCDCF@N@PCJIFGBED@FHLFH@BC@CDADAF@DDBD@D@D@D@BDBD@BBFBBBBBBFBB@BABCDCB@@@B@BD@B@DBF@D@D@@CDEBAB@DADABABCDAB@DDBBBDD@D@BCB@D@@@@BF@B@@B@B@B@B@@@@B@@@B@@GDEDABAB@BDD@BDFAHBDBBD@BABE@C@@FB@FAH@FBFB@BBDCBCD@DBDCDADED@D@DDBBBBD@FAD@F@DBD@FBBDDB@D@@@D@FAB@FAH@HBBBFBBDDBBDBB@DDAB@BAB@FBFBDBDBBBBFFBBBB@BBDBFBBF@F@D@FBBBFADABBBDD@D@JFJBB@@@D@B@B@BA@AAACE@A@ABG@@@E@C@ADAB@BA@@FBFD@@@D@B@D@BBD@B@D@F@FBDBFBDDD@D@@@DEDADAFCDC@CBAD@BAD@@ABCDCBC@CBBB@D@BBD@DABAFCBABCBABCBEDC@CBE@CD@FADABA@@@CCCACD@F@H@DADAD@B@D@F@D@LBD@@BB@DAFB@@BB@DDDBDBDBDBBD@@BFBBFBFFFBBBF@D@FB@@JAD@DBBHBD@D@BBBBDBB@DBBHDJFBBFBD@@@D@D@DADBF@JBBBBBFDD@FBB@DBFBD@DABEB@BCDCFAFBFBB@DBF@D@DAFAB@DBDB@@BDBDBH@@DDDBFBF@D@F@F@D@D@DDDDF@D@D@DBFBDBDB@BBB@DBD@BBFDBBBDDDBDBD@BBDBBBB@BD@DBFBF@@BHDDH@L@FDDBBBLBL@B@HAL@DBB@DDBBAD@DAD@@@B@BAFAFADAFADCDADAF@FBD@@@B@B@B@B@@BB@BBBB@@BBB@@D@D@B@B@DB@D@BAD@@DD@@FD@@BDBB@@@B@@BB@B@BB@@BBB@BBB@@@B@BBB@BB@@BB@@@B@BBB@B@@@B@B@B@B@BBB@B@B@B@B@B@@BBA@ABAB@BCDAB@BA@@@@BA@@BAB@B@@A@@BC@@@@B@@@DA@@DBB@B@D@B@D@F@D@B@BABCBADADAB@BAB@BAD@DABAB@@@@@DD@@BBB@@@DA@@B@BBBA@@@B@@@@BB@@BB@@F@@B@B@AB@@B@@@BB@@@DB@@@@B@@A@@BB@@BAB@@BBA@@D@@@BA@A@@AA@A@A@@@@@A@@@ABADAB@B@@@B@B@BABA@@@AB@B@@AA@@AA@AAAA@@@A@AAA@A@@@ABA@@BA@A@ABCB@@ADA@A@@AA@@@@BAAA@@BA@@A@@@@@AA@@@A@A@@@A@@B@@CAA@@AA@@@CAABA@ABC@@B@BCBABAB@BAB@BB@@B@@BB@AB@@B@BB@@B@DB@@D@@@@B@@@B@BA@CB@@BD@@BA@B@B@@@B@@@BB@B@@@B@@AB@@A@@B@@@@@@@@B@BB@@BB@BBBBB@@@BBBB@B@BA@@B@BBB@@@@@@@A@@B@@A@@BABC@AA@@@BAB@D@B@@@BB@@@BAB@B@@BBB@BBBBBBDBD@@BDDB@BBB@BB@@@B@@BBB@DBB@FBD@@@@@@A@A@@BA@A@@BGBA@@B@@AD@BBD@B@B@B@BDBA@B@@B@@@@@@@B@@@B@@@@A@A@@@A@A@@AA@@@A@@@@B@@@@BDD@@B@B@D@@@BA@@B@B@B@B@@@B@@@@AB@@AB@BA@@@@@A@@CAAAA@@@@A@@B@@A@@B@B@B@D@@@@@@@@@@@@@@A@AAA@AA@@@A@@AAA@A@@DABCB@@@@@B@@@@@B@@@@@@@B@@A@@B@@A@BB@@@BB@@B@B@B@@@B@@BA@@@@@@@B@@@@B@@@B@@@B@@@@@B@@@@@@BA@@@@B@@@@@@A@@B@@@BA@@@@B@@AA@A@A@@@AA@A@@B@B@@@BBB@BB@@@B@B@@@BB@AB@B@@@@@@B@B@@@BB@@BABA@B@@@BA@@BA@@@C@AB@@@@@BBB@@B@@ABAB@BA@@B@@B@@BB@@ADABBB@@B@@@@@@ABC@C@A@@B@@@@@B@B@@@BBD@@@B@@@@B@@@BB@B@B@BAB@@@@BB@@BBA@@B@@@@@@@DA@B@A@@@C@A@@@@B@D@D@B@@BDB@@@@AB@@B@@BDA@BB@@@ADA@@@@@A@@BB@BB@@@@@D@BB@B@DB@@D@B@@@@@@B@B@AB@@@B@@@@B@B@@@B@@A@@BAB@@@BB@@@@@ABA@@@@BBB@B@B@@AB@@@B@B@@@B@@@BBBAB@@FBB@D@BBB@@@B@B@B@@@@@B@B@B@@A@@@@@@@A@@@@BB@@BA@@@@@A@@F@@@@AA@@@@A@@@@AA@@BA@A@@@@@@@AA@@@@@@A@@A@@@@@@@@@@A@@@A@AB@@@B@@@@@B@@@BB@@@@B@BAB@@BB@@@DA@@@AA@@@@@@A@@@@@AA@@@@@@@A@@@@A@@@@A@@A@@@A@@@A@@@@@AB@@@@ABA@@@@BA@A@@@@BA@@AC@@@@A@AA@@A@@AA@@@@AA@@@@@A@A@A@@@@AB@@@@@@A@@@AB@@A@CBA@@BA@B@@@BBA@@@@@AA@@A@AA@@A@A@@@A@@B@B@@@@@@AB@@A@AB@@@B@@@B@BA@@@@@AAA@C@A@A@A@AA@@@@ABA@A@@@@CA@@@A@A@A@A@A@@@ADA@A@@@AAC@@@@BA@CBA@A@AB@@BD@FB@BDBDBB@BBB@B@B@@@BAB@D@B@@AB@@A@AAA@A@ABC@C@@BAB@AA@@AAAAA@@AA@@@CBA@@@A@AAAA@@A@C@@@@@AA@AA@@CAAACAA@AAAB@@@@@B@BAD@@@BADAD@@ADA@@@@@A@AA@@@@@A@@A@@@AA@BA@C@A@A@A@@AA@A@@@AAAAAA@@AA@AA@AA@@@@A@@BABA@A@ABA@@@ABG@AAA@A@@BA@AB@BE@@@A@@@AA@A@A@ABC@@BC@@@@@A@A@@AA@@@AB@@AB@@A@A@@@A@AA@@C@@@@A@AA@EA@@AA@@@A@@@A@@@@@@AAC@@AA@A@AAA@A@@@@@A@AB@@@BAB@@ADABABC@A@AB@A@AAC@@@A@A@@AA@AA@AB@AA@@@@AAAAE@A@AAA@A@A@@B@@@B@D@@AB@@@B@@@B@@@B@B@@@BAB@@@@A@AAC@A@@B@BAB@B@@@@@BA@@B@@@BBB@B@B@DA@@BA@@@A@A@A@C@A@A@A@@DE@@BA@@A@@AA@C@@@C@@@@A@@@A@@BC@@@@@AB@@@BA@@B@@@BA@@B@AAB@BA@@B@@@@@B@B@B@@@B@@@BBBBB@@@B@@@B@@@@@B@@@B@BB@@BA@@@@@A@@@@@@@@@@@A@@@@@@@AB@AA@@BA@@B@@@@@B@B@BAB@B@@@@@B@@@@@@@@AB@@@@@B@@B@B@@@@B@B@@AB@@A@AAA@@ACCC@@AA@A@@A@@AA@@AA@@A@A@@@A@@@@BAA@A@@A@@AA@AAC@@A@@@A@@@AB@@AA@AAA@A@@AAA@@AAAA@@A@AA@AAAACAAA@@@A@A@@@AA@@AA@A@A@A@A@A@@@@@DAB@BA@@@AA@AA@@@@A@ABA@C@A@C@@AA@@@@@@AA@@@AAAA@@BA@A@@@A@ACE@@@A@C@AAC@CAA@@BCB@@A@A@A@A@CACACAA@@@C@ABAAA@A@@@A@A@A@@@@@CA@@AB@@@@A@CAAAA@A@@@@A@@A@A@@@ADABA@@@@@@AAAAAAEAAA@@ACAA@AAA@@@AECAAAAACAE@AA@AA@@@@@ABA@A@AAA@@@AB@@CB@@@@AA@@@AA@@A@@A@@@ABABC@A@A@@@@A@@A@AA@@@AAA@A@AB@@C@A@CACB@@A@@AA@@@@C@@AA@C@A@@@A@E@@B@B@B@@@B@@BB@B@B@@@@A@@@@@@@ABA@A@@AAA@A@EA@@A@@@@@@C@@@A@@@A@@@@CA@@A@@A@AAC@A@@BA@@@A@A@AAE@@BA@@AC@@@C@@@A@@C@@@@@BCBC@@@ABA@G@G@@@A@@D@BA@@@A@AA@BG@A@A@@@@BA@@B@@@B@@A@@AAA@@@AC@A@AA@@@@A@@AA@A@AA@ACAAA@AABA@@@A@@@A@@@A@@BEBAB@@A@@AA@@@A@@A@A@A@@@A@@@@A@@@A@AAABEBEA@@A@@BA@AB@@A@@AA@@AA@@BA@C@AAC@A@@@@DAB@B@@ABA@@BA@@B@@@@A@A@@@A@@A@@A@A@A@@B@@@@A@@A@@A@@@A@@@CBA@@@A@@@@B@@A@@@ABCBA@A@@BA@@@@@@AA@@@A@@@A@@A@A@@@A@@@@@@@AA@@A@@BA@@@@AA@@AA@@@@AAAAC@@CA@@@A@@@C@@@@@AA@@@AB@@EB@@A@AB@@A@A@A@@@A@@A@@@@BA@A@@@A@@@A@@@A@A@@@ABA@@@A@A@A@AD@@ABA@@@@AA@A@@@ABA@ABA@@@@@C@@AA@A@@@A@A@@@ABA@@@@BA@@@AB@ACB@@@BC@@@A@@@@@A@CBA@A@A@@BA@@@@AC@@@AA@@A@@@A@@A@@@A@AA@@ACB@@A@@@@@AAA@A@ACAAC@A@A@@AEAA@@@@A@@@@A@@AA@A@@A@A@@@ACAA@@@@ACAA@A@@@C@CAA@@AAACAA@A@A@@@A@@B@@@@@CC@CCA@A@CACAC@AA@@@A@@@A@A@C@@@@@A@@@C@A@ABA@A@@@@@A@@BABAB@@C@CB@@@@AB@BAB@@@B@B@@AB@@@@CACAA@@A@@@@AAA@@@@AC@A@@@@A@@@A@@BAAA@C@@AA@@@ACC@@@@AAA@BA@AAA@AA@@@C@@@AB@@@@A@@@A@CA@@@A@@@A@@@A@@AA@@A@@@AA@@A@@@@AAAA@AA@@@AA@@@AA@@@AAA@AA@@A@@BA@@B@A@@A@A@A@@AA@AA@AA@@AA@@@A@@@@A@A@@@CDA@AB@@A@@@A@@@@BA@C@A@@@@AA@@@AA@@AAA@A@@@A@AD@@ADAB@@@@@BA@CD@@@B@B@B@@A@ABC@@@AAACA@@@@@@A@@C@@@@AA@@A@@A@AAA@@AA@@@@AA@@@A@CBA@@@@A@@@C@AA@@@@AA@AA@@@C@@@@@@C@@@AA@@A@A@@@@A@@@A@A@A@A@@AA@@B@@@@B@@B@@@@@BA@A@A@@@A@A@@@@A@@@@AA@@AAA@@A@@@BCB@@AAA@@BAB@@B@@@@B@@A@A@@@CB@@@@A@@BA@@@A@@@@@AA@@@@@AAA@@@@@AA@@A@@AA@AAA@C@A@AB@A@@@C@A@A@AA@@A@A@A@@BA@@A@@A@@@@@A@@@A@AAA@A@A@@@@@@A@A@@@C@@ACA@A@@CBC@@@EAA@@AAB@@A@@BA@A@AB@BA@A@A@E@A@A@A@CBCBABA@ABCA@@A@A@A@@@@AA@A@@AA@@@@A@A@@A@@AA@A@@@AAA@@@A@AA@@@A@AA@@@A@A@@@A@CB@@@@AB@BADADCBABA@@B@@@@@B@@@B@@@@B@@@BB@@@BB@@B@D@B@B@B@@@@ABA@ABAB@@@BCB@@@@AAABA@@@A@@@@@C@@BA@A@ADABA@@@@@@@A@@AA@AAA@AA@AA@@@CCA@A@AAAA@ACACAAAAA@AACAEAA@@AA@AAA@@@@AB@@@B@@@B@@@B@B@B@@A@ABAB@B@@ABA@@@A@@@@A@A@@CAA@ACAA@@@@DBB@@@@A@@AC@@@@A@@ABA@@@@B@B@@A@@BAB@@@@ABA@ABAA@@@AA@@A@A@@@@@A@A@A@@@AAA@@AA@AAA@AAA@A@AB@@@@AC"],encodeOffsets:[[119110,25966]]}},{type:"Feature",id:"350803",properties:{name:"永定区",cp:[116.732091,24.723961],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABADAB@DADABADEDABEFCBCDADAD@DE@EAECCAAAADADABAD@HBBAF@DC@@@EBABCJGLCFA@EDGDABABABAD@BADAB@DABABAB@B@B@@@B@@@B@@@@BBB@@@BBB@@B@@@@@B@@AB@B@BAB@BBB@@@@B@B@BAD@B@D@BAB@B@@BB@@@BBBBB@BB@BB@BB@BBB@B@BABAB@BABCBADABCF@DCFABADCBIJADABCDCFABAB@@A@@@CCCACAAAAACCECGCCA@@A@@@ABBB@BBDFB@B@@@B@@CBA@ABA@ABA@CBA@CBCBA@ABCBABCDABADCBADCDABCDCFADCFAFAB@B@D@B@DBB@B@B@@@BA@A@M@CDCBCDADC@ABAB@BA@ABABAB@B@BAB@D@B@D@BBBBDDBB@BBBBB@BBD@@BB@@@CLELEHBFBBNPRPDDDD@BBDBB@BBBBBBB@B@B@@BB@BBBDBB@DB@BB@BBB@BB@BBBBBAD@B@BAD@D@B@B@DBB@BBBBBB@B@@@B@BBB@@BBBBBB@BBD@@@BB@@BB@@@B@B@BBD@B@@BB@B@@@B@@@BABA@@BAB@BAB@B@B@B@BABABABADABADADCDAD@@A@ABA@@@C@AAA@C@A@CBC@CBE@A@A@A@@@A@AAA@AAACKKCCCAEEQS@@A@A@@@CBABCDCDCF@B@@@@AB@BA@ABA@A@C@E@C@A@C@AACAA@CA@@A@@@A@ABA@ABA@A@@@CAA@C@E@CCAA@C@CAAAACAE@EDEBCB@@ICGBG@A@I@G@GACAA@GAC@AA@AA@@CA@A@AAABA@A@AA@@A@A@A@A@AAAAAA@@A@C@A@AA@AACAE@A@@A@A@@@@BA@A@A@A@C@@@AB@@A@AB@BAB@BA@ABABA@A@AAA@A@C@ABABABCBCB@BA@@@@BC@ABC@A@AAAAAAAC@@@AAA@@BA@AACCCAECC@@A@AAA@AAC@CAA@AAA@AAA@AAAAAA@A@A@A@A@A@@@A@AA@@AC@AAC@CAC@@AAA@@@AC@A@AAA@A@AAAA@AE@C@CAA@C@CACACACACACACAA@A@A@A@@@AB@@ABCDABABCBA@CBA@ABCBABABEJB@@B@@BBB@@B@@BB@BAB@B@B@BBB@BBB@@@@BB@B@@@B@B@B@B@B@DBB@DBBB@BBBB@@@DB@@DA@@B@B@@ABA@A@@@ABA@@B@B@@@BAB@@@BABABABABABADABADAD@F@F@DAD@B@B@B@BBD@BBB@B@B@B@B@DA@@BAB@BABABCDABCDADA@ADAD@BAB@B@BAB@BA@ABAB@@AB@@A@A@@@A@@AA@@AA@AAA@@@A@A@A@ABA@ABA@A@A@IEQEI@IBGFCLANADAD@@@@ABA@AB@@C@A@AAABAB@B@B@B@BA@@@@@ABC@C@A@C@ABA@ABAB@BAB@BBB@B@BB@@@DBD@@BIH@B@BDDBBB@D@@@@BAD@@AB@B@@@B@@B@B@B@B@BBDBBBBBB@B@@B@@BB@B@@@@BB@@@D@B@@@@@@B@@@B@@@B@@@BB@@@B@B@@B@@@B@B@@@BBDA@@BB@BB@@B@@D@DBB@B@@BBA@@BAB@@@B@DCDC@@BA@C@A@@@A@@DC@AB@B@BAB@BABA@@BA@@@BB@BBB@@BB@@B@B@BB@@@B@D@@@B@BBBD@@BBB@@@@BB@@B@@BBBB@@@B@B@@@B@B@@@@@@A@AB@@@@@@BB@@@@ABA@@@@@@B@@D@@@B@BA@B@@@B@@@B@@B@@@B@@@DBD@@@@B@@@@B@@@BBBBB@@@@A@@B@@AD@B@B@@BB@@@@AB@B@@@B@B@@@B@B@@@@BBB@@@@BB@AB@@@BCB@@@BBB@DB@@@@@C@@@@B@D@BB@@BBBBBBB@FDB@BBBB@BB@@@BB@B@@@BBBB@D@BBB@B@@@DB@BB@@@B@B@@@@CBA@@@@DAB@@@@ABA@@BA@A@@@AA@A@@@@A@@B@@A@@@A@@A@@A@@AA@@A@A@@@@@@@@@AA@@BA@@@@BA@@AA@@@C@@BCBC@A@ABABA@@@@B@B@@@@@B@@ABA@@@AB@@ABA@@B@B@@AB@@BB@B@@BB@BA@BBAB@B@@AB@@@@@D@B@@@BB@D@@BB@BB@@@@@B@@@D@B@BBB@@@@@B@@@AB@BBFBBBD@B@B@B@B@@D@BBDB@@AB@B@B@D@@BBB@@@BB@@@BBB@@@@@B@@@B@@BBB@@@@@@B@B@@@B@@B@BABB@@B@DAB@@BB@B@@@B@@@BB@AB@B@@A@@BBBBBAB@@@B@B@@@B@BA@@D@DA@@BA@B@@@@@BAD@@@B@@A@@B@@@BBBB@B@B@DA@@BBB@@@@B@@@@B@BA@@B@B@A@@A@@@@BA@@@CBC@@BA@@BB@@@A@@@A@@BA@@BC@A@@@@B@@@B@@@BAB@@@@@B@@BB@@@BABA@@@@B@@@@A@@@AB@@A@@@A@@@ABABADA@@AA@@AA@A@@@CBA@@@@D@B@@@DA@@@@@@BB@B@@B@@@B@@@BB@BB@B@@@@@@B@@B@DB@@B@B@@A@@@@B@BBB@B@@@B@B@B@@@@@BB@@B@@B@@BB@D@@AB@@@B@@@@@B@@@@@B@@BA@@@@@AB@BA@@B@@@BAD@B@DADA@@B@D@D@@@B@BB@@BB@@@@A@@@A@A@@BA@@@AB@@AB@B@BDB@DDD@@BBB@BBB@B@@@BABBB@@@BB@@B@D@B@BAB@@B@@@@BB@D@B@BA@@@C@@@ABA@@@@@@@AB@@@@A@ABAB@@A@AB@@@@@@AB@@@BC@@@AB@@A@@@A@ABA@@@@B@@@BA@@BA@AA@@@BA@@@A@@B@@A@A@A@ABABAD@@A@A@@@C@CA@@A@@BA@ABA@@@AAA@A@@@ABA@@@CBABA@@@@@BD@@@BAB@@A@@@@@@@AA@@@@A@@@@@A@A@@@@BAB@@@D@BADAB@B@@@D@@@BA@AB@@A@@B@@BBB@BBB@B@BB@@@B@B@@@@A@@B@@AD@@@@A@@@A@@@@BAB@@AB@B@@@D@@@BAB@@@B@B@@BBB@BB@@BBB@BBB@@BAB@@@@AB@@A@@@@@@B@@AB@@AB@@A@A@@B@@@B@@@B@@BB@@@@AB@@@@BBB@DBD@@B@BB@@@BB@@BB@@@@@B@@@@@@AB@@@@@@B@@D@@B@@@@@@@B@BA@@@@@@BA@@@A@ABA@AB@@B@@@B@@@B@@DBBB@@@AB@@@BB@@@@@A@@@@@A@@@A@@@A@@BA@B@@@@BBB@@@B@B@B@B@@@B@@B@B@@@@@@B@DA@@B@@@BA@@@AB@@@@A@@@AB@@B@B@B@B@@@B@@A@@B@@B@B@@@B@@B@@B@ABAD@@@B@@B@B@@@@@B@@@B@@@BBBB@@@BB@@@D@@@BB@@D@B@D@@@@A@@@@B@@@@BB@B@@@B@@@@@BB@@@@ABABG@A@A@@@A@@@@@B@BB@@BBB@B@B@B@@BB@BBBB@@@@B@@@D@@@BAB@@@D@@@B@BA@@B@@@@@B@B@BBD@@@@B@@DDBB@@@BB@@@B@@ADBBAB@@@@AB@BA@@BA@AA@@ACA@@@@B@@@B@B@B@B@@@D@@@@A@@BA@A@AB@@@BADA@@B@@B@@@@@B@@@B@@B@@@BABA@@B@@BB@BA@@@B@@DDBBBBB@@@@@B@@BB@@BBDB@@B@@@@@@D@@@B@BB@ABB@@@@BBB@@B@@@@@@CBAB@B@@B@@@@@@B@B@@@BAD@DABB@@@D@@@@@BBD@@@@B@@CB@B@@@@@B@@B@@@@BBB@@@B@@@@@BA@@@A@E@A@@B@B@B@B@BAB@BDD@BB@@B@@@@B@@BA@@BA@@BBB@B@@D@BB@A@@B@@BB@@@BA@@@@@A@A@@D@@A@@B@DBB@@@BBBB@B@BBBAB@B@@@B@@@B@D@B@B@B@B@@ABA@A@A@@@A@AB@@@A@@@@ABA@@@@B@B@@@B@@@B@@AB@@@@ABA@@B@@A@A@AB@BA@A@@@A@CD@@B@BB@@B@@@@@@BA@@BA@@@@@A@@@@BC@AB@@@@BD@@@@ABB@@BA@A@AB@@@@@BAB@@A@@@@@AB@@@B@@AB@@@B@@@B@DBDB@@B@@@BA@BBB@BBB@BBB@BBD@@@@BBBB@@D@D@@BDB@@BB@B@@BB@BAB@@@BA@@B@AABA@A@@B@BAB@@@BBBA@@@BB@@@BBB@B@DB@@BB@@B@BB@@BBD@BB@@@@@BBB@B@@@@BBB@BB@BBBBB@BBD@B@@CBA@@B@@@B@BBB@BA@@BAB@@@BAB@B@BAB@@@B@@@B@@AB@B@@A@@B@D@@A@@B@@A@@@@BA@A@@@@@A@A@CB@@@B@@@B@B@BA@A@@@C@CB@B@@A@BBB@@DBB@@@B@B@@@BABA@@B@BB@@@B@@@B@@@@@B@@@B@@@B@@@@@@@@BB@@BB@@@@@@BDB@@@ABB@AB@@@B@@@B@BB@@B@B@@B@@@B@@B@B@B@BB@@BBBB@B@@B@@@@@@B@@@@@@BB@@B@@BB@@B@@@@B@BBBB@@B@@@@AB@B@@@B@DB@@B@B@@@BA@@DA@@DC@@B@@@B@@@B@B@B@BB@@@@D@B@B@B@@@@DBB@B@@@@@@ABC@@BA@ABAB@@B@@@@@@B@@A@@@BB@@@@B@@@B@@@BAB@BA@AB@@AB@@@B@BA@@@@D@@@B@@@@BD@@B@@@@A@A@CB@B@B@@A@A@AB@@A@@@BBB@@@@BB@@@@@B@B@B@B@@@B@@@B@@@@@B@BAB@BB@@B@B@@@BA@@B@@AD@DC@@B@@AA@@AA@@@@@@AB@@AB@@@@@@AA@A@@@@A@@BA@@BABA@AB@B@@@@B@B@@B@@@DB@@@@@@B@@@B@BBBBB@@@@@BAB@@@BEB@@B@@BDB@@@@@@ABAB@BA@@@@B@@@BA@@@@BBBA@@@BB@B@B@@@B@@@BA@A@A@AB@@A@A@@@@BAB@@@BA@@@ABAB@@@BABA@@BA@@BA@ABA@@DADC@@B@B@@ADA@@BA@ABAB@@ABA@A@@@A@@@C@@AA@@@@D@BA@@B@@A@AB@BC@@BA@@AA@A@@@AA@@A@@@ABA@@@E@ABABA@AB@@@@@@A@@AA@C@@AA@@A@@@AA@B@@A@@@A@@@A@@@BB@@@@@@A@AC@@@C@A@ABCAA@A@@@A@@B@@@B@DAFADA@@B@B@@@@CB@F@@@DBB@@@@@BBB@B@B@B@B@@C@@B@BA@A@@BA@@@A@@BA@@@@@AB@@CB@@@BAB@B@B@@@D@B@@@DB@@@@B@@@@@@A@AB@@@@@BA@@A@@@@AB@@AA@@@@AB@@@BA@AB@B@@@@@@AB@@A@A@A@@@@AA@A@@@A@@@@CA@A@@@@B@BA@A@A@CA@@AA@@ABA@@AA@AA@CAAACAAAACA@B@@A@ABA@A@A@G@A@A@@BA@A@@B@@@B@B@@BB@@BB@B@@@BA@@B@@@F@BBB@B@@AB@@@HB@@@@BB@B@@@BB@B@@@@B@@@@BA@A@@@@@@@A@A@@BA@@B@@@BAB@B@@@@@AB@@@@@BB@@@@B@@BB@@A@@@@BB@@@@@@@@BB@@@@@B@@@B@@BB@@@@@@AA@@A@@B@DADA@@@AB@B@@@@@@AB@B@@@B@@BBB@BB@@B@@B@BB@@@BB@@B@B@@@@@@BB@D@D@BBBBB@BB@@@@@BBB@DCDABADADA@@BAB@@@B@FBBBD@@BB@@@BA@A@@B@BA@@BA@AB@@AB@@AB@BA@A@@BA@@@A@AAA@@@@@ABABADA@A@@@@@A@A@ABA@@BC@A@@B@@EBABA@AA@@C@A@@@@@A@@D@@@@A@A@@@@B@BA@@@BB@BB@@B@@@B@B@@@@ABB@@@@@B@@B@@@@@D@@@@@BD@@@@@@B@B@BB@@@@B@@@@@B@@@B@@@BB@@B@FBBB@@@@B@@A@@BA@AB@@AB@AA@C@@AA@A@A@@BC@AB@@@@A@@AA@AB@@@D@DAB@@@B@@@BB@@@AB@B@B@@A@@@A@A@@BA@AD@BBB@H@B@@@@BB@@@B@B@@@@@B@AA@@@@A@@@@AAA@@@A@@@A@@@@BC@@@A@@@@@A@@C@CA@@@@@ABG@@@@AA@@A@A@@@CB@@@@AAA@@@AB@@A@@@A@@BA@@AA@@@@@BA@A@@@AB@@E@@@AA@@AAA@ABAA@BA@@B@BAB@B@B@B@DAB@@@BA@AB@B@BABA@@BE@AB@@ABABAB@@A@@@A@@D@@@B@B@BA@@BA@@FCA@@AA@@A@@@@@A@A@@@A@@@A@A@@BA@A@@@AAA@@@A@AB@@@@ABA@@@@@AA@@@BAB@B@DAB@A@@A@@B@AA@@A@@@A@@@A@@@A@A@@AA@A@EBCCAA@@@AB@@@A@@@A@BAD@@AB@B@DABA@ABA@@@A@A@@A@A@@BA@A@@@ABA@A@@B@@AB@@@@@AAAAACA@@@A@A@@A@CCAA@@@@@ABC@ABA@ABA@@B@A@@CAA@ACAAA@AA@A@@@@A@@AA@A@C@A@@@@@AAAAA@@@AAC@A@ABC@@@A@AA@@A@@@A@@B@B@@@@@AA@A@@@A@@B@B@@@BA@@@@@A@A@A@@AAAA@AA@A@@@A@A@@A@@@A@AB@@@@@@@AAC@CAC@ECC@@B@@@@A@AC@@@@AAB@@@BA@ABA@@@AA@CAAAAA@@BC@@@@@AAA@@@A@@@@AAA@C@A@@@A@@@A@@AA@@AA@@A@BAAAA@@AA@@@AA@@@@BA@CAAAAA@AB@@@@@B@@@B@@@@@@AB@@A@@BA@AA@@ABAA@BCBC@A@A@@A@@@@A@@B@@@@ABAB@@A@@BA@@BADE@@@A@@A@AAA@@@AAAAC@@A@@@@@A@A@A@@@@@@AA@@AA@@BA@A@@@@@AA@@A@@@AA@@@@@@ABABAA@@@B@@@@@@A@@@A@@A@A@@@AA@@@A@@B@@@B@@@B@@@@@AA@@@@@A@@@AA@@A@A@@@A@@@A@@@@AAD@BAA@BA@@@A@@AA@@AA@@@AB@BA@@BA@@@A@@B@@@BA@@B@BA@@B@@@B@@A@@@@BB@@@A@@@@AA@@BA@@B@@@BB@@@@@A@A@@@AAAA@@A@@B@@A@@@A@C@@@@@@@@BABBB@D@D@@@BABB@@@A@@@@BB@@@AB@B@@@@@@@B@BAAA@@@C@A@A@A@@A@BC@AB@@@@@@@AAA@@@A@@A@@@C@@@A@A@@@AA@AA@@AA@AAA@@@A@A@@@@@AB@BA@@BA@@B@BA@@BA@@@A@@B@@A@@BCBA@A@A@@@@B@B@@ADE@ABA@@AA@A@A@@AA@AA@@@@A@@@@BA@@A@@@@A@@@AB@@@B@@AAAA@@@@AA@ABABA@@@A@A@@@AA@@B@BE@A@@BAB@BA@A@A@A@A@AAA@@A@@A@@AC@@@AA@@@@@A@@BAF@BA@@B@@A@@@@@@@A@@B@@AAAB@@A@A@@@AB@@@@A@@@AB@@AA@A@A@@C@A@C@CA@@A@@@@@@A@A@AA@@C@A@A@@@@@@BAB@BC@ABA@A@@BA@@@@@@@AB@@@@@BABC@A@@@A@A@@BAB@@A@@D@@@@A@@@@AE@A@@@A@@AA@@@@@A@@BA@AA@A@@@A@@B@@A@AA@@@@@@AAAAA@@@A@AA@@@A@@@@AC@A@@@@AA@@@AB@@AB@@CBCBE@ABA@A@@B@@ABA@A@A@A@A@@@@@@@A@A@@@A@@@AAA@A@@@A@A@AAA@@@@@@@@@AA@@A@A@A@A@A@@@A@A@@AC@AA@A@@AA@@@@A@@A@A@C@A@@@@@A@AA@@@A@A@@A@@A@@AAA@@@@@ACEGAACA@@ABABCD@@ABAB@@ABCB@BA@@@CAA@A@ABC@@@A@E@C@IAEAAA@@ABCBA@@@@A@@A@@@ABKDABA@@@A@AA@@AAA@A@A@@AA@C@C@@@@@@@AC@CAA@@@@C@@@ECAA@@AA@A@A@@AAC@@@AA@@B@@A@@B@@A@@BA@@BCBA@@@A@ABA@@@C@A@A@@A@@@AB@@@ACC@@@ABA@@AAAE@AA@A@AB@@@BA@@BAB@B@@@BAB@@AB@@@BA@@B@@@B@@@BA@@@@@@B@@@B@@@@@@@BA@@B@@A@@@CAICCA@@@@@A@@BA@@AA@@@A@@@A@A@A@@B@@@BAB@@A@@@ABA@A@@@A@@BA@@B@@A@@BAAA@@@A@CA@@AAABAAC@@@@@A@A@A@@B@@@@AB@@A@@@A@AB@@@B@@@BA@ADABA@@BA@A@@BABABA@@@@B@@@@@AAA@@@@@D@@@@AAA@@@C@@@AA@BA@@D@BA@@@AA@@A@A@@BAB@@A@A@@@A@ABABABA@@D@B@@@BAB@@AB@AC@A@C@A@@BA@@B@@AAA@@BA@@@A@ABADC@@@A@@@@AA@A@@@A@@@@BAAAB@@ABA@@@A@C@@@A@ABA@ADE@@BA@@AC@A@@@A@AAG@@@A@ABAA@A@ABA@A@@ACA@A@BA@A@@@A@A@AB@@@B@@A@BC@@@C@@BAAA@A@@@AA@@A@@@@AAA@@@@A@@@A@@@AA@@@A@@A@@@ABC@@@AACA@@AA@@AAAA@@@B@@A@@@@@A@AA@BA@A@A@A@ABA@A@C@A@@ABA@@@A@A@@@@A@@FCA@@AA@@@C@@AA@@@@@A@@@AB@@AB@@E@CAA@@@AA@A@A@@A@A@A@@BA@ABAB@BAD@@@B@@@@@@A@AB@@@@A@@AA@@B@@A@@B@@AA@@@A@@BEBE@ABC@A@A@@@A@A@A@@AA@@@@"],encodeOffsets:[[119710,24956]]}},{type:"Feature",id:"350823",properties:{name:"上杭县",cp:[116.420098,25.049518],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@B@@A@@@@@@A@A@@AA@AA@@BAA@@A@ABC@@@A@@@C@AAA@@@AB@@@@@D@@@BB@@B@@A@@@@B@B@B@B@@@@@@@B@AAACABA@@AAAA@@@B@B@BC@@BA@A@A@@B@BB@A@@@BD@D@@A@AB@@@B@B@@@@@B@BBD@BBBA@AB@@@B@@AA@@@@@@ABAA@@AA@@E@G@A@ABA@@@A@A@AA@A@@ABA@@@@@@@A@ABA@A@@@A@@@A@A@A@A@@BA@A@@@A@@@A@ABAAA@@B@@@AA@@A@@A@A@@@@AABA@CBA@A@A@@B@@ABA@E@A@@@A@@B@@ABC@A@@B@@BBB@@B@@AB@B@B@@@BCBA@A@@@@BAB@@C@@BA@A@@B@@@@@BBD@@@@@@AB@@CB@@@B@B@@@B@B@@@@BD@B@B@@AD@@@@DD@BBB@@B@@BBB@@B@B@@@BB@@B@BBB@@@BBBB@@D@DBB@@@BBB@@@@BB@B@@@B@B@@B@@B@@B@@AB@@ABABBB@B@B@BBB@@@B@@B@@@@B@@BBB@@@@@B@@@B@@@BBBB@B@@@BB@B@BBDB@B@@@BBB@B@@@@AB@@@BA@A@@B@@@@@B@@@@BBA@@B@@A@@@@@@@AB@@@B@@CB@@@@@B@@@@@B@@@@@B@@A@@D@BA@@BCA@@@AAB@@@@@@ABA@@@ABA@@@@BCBA@@@A@@BA@@@@@@B@@@BDD@BB@BDB@@DB@@B@@@BABBBBBBDBBDB@B@B@@A@@@@BA@A@ABABABCB@@AB@B@@@@@D@B@@@BBB@@@BFB@@BB@B@BBD@@BB@BBBBB@@@@B@ABB@B@B@B@FD@@BB@@@@BA@@@ABB@@AB@@BB@@@BEJ@B@@BB@D@@BB@DB@BD@@@@BAB@B@BBB@BBB@@BB@BB@@B@B@B@@@@@B@B@@@BB@@B@AB@BAB@B@@A@AB@@@BA@A@@@@@AB@BB@@@@@BD@@@B@@A@CA@@@@BBBDB@DB@@@B@B@@B@@@B@BA@@@ABABAB@@@@A@A@A@@@A@@@A@@BA@@@@BB@BBB@@BBBFBD@BBBBBDBDB@BBBBBB@B@DD@@B@@BBBB@BBB@@BB@@@@@@@B@BABCB@B@@AD@@@@@B@@@B@BABB@@@@DA@A@@BABAB@BA@@@@@A@A@A@C@AA@@A@@AA@@A@@@@@@A@@@A@@@@@AB@BADABCBC@ABA@@@@DAB@@@B@B@@@B@@B@B@@BBB@@@B@BB@@B@B@@BB@@@@B@B@@B@@BB@B@@B@@B@B@B@@@DBBAB@BADADAB@B@B@F@B@B@B@@ABAB@B@@AB@@@BA@BB@FB@@D@DA@@@BDB@BD@@@B@B@@@@@@@@B@BBB@B@B@@@B@@@@@BB@@@AB@@@B@B@BB@@B@B@B@D@@@BBAB@D@B@BBB@@BB@@@BB@@@@B@BB@@@@B@@B@@@@@B@@AB@@@B@@A@@D@@@B@BA@@@@@@AA@AB@@BB@BA@AD@@B@@@BB@BB@@B@@B@@@@@@B@B@@@B@BAB@@@@A@@@@A@@A@@@BB@@@B@B@B@B@@@B@@B@B@@@BB@@D@@@@@@@@D@@BBB@@B@@B@@B@D@@@B@@B@DAB@@@B@@B@@B@@BB@BBB@@@@BB@@B@@D@@@@B@@@@B@BDBB@@D@BAB@@@@A@A@A@@DCB@@A@@@@BABC@@BCB@@@B@B@BB@@BB@@B@@B@@B@D@B@@A@@B@@@B@@@BAB@DC@@B@B@@@@@@B@@BB@@BBB@@BBB@@@B@B@BB@A@@@AB@@@BB@@BBB@B@@BB@@B@@B@@BBB@BB@B@@B@@@BB@@B@@@BB@@@B@@@B@@@B@@DBB@@@B@@@@@BA@@D@@@B@@BBB@BABB@BB@@@@DD@B@@BB@@@DBBAB@@@B@@@B@@B@D@@B@@B@BB@@@@@BB@DBDB@@@@BA@@@A@A@@BA@ABA@@@@DAD@@@BABA@AB@@@@@B@B@BAB@D@@@B@@@@@D@B@B@@@B@@@BAB@@ABA@@@C@@@AB@@A@@@AAAAAB@@AB@@@@@@A@A@C@A@@AAAA@@AA@A@AA@BA@A@@@A@@B@@@@@@ABA@@A@@@@A@@@C@@@@@C@ABC@ABC@A@@BAAA@A@AA@CA@A@@@C@AAA@@AAA@@@AA@A@A@@@@BA@@@A@@AAAA@@@AB@@AAA@@@A@A@@@@AAAAA@@@@A@@BAAAAA@AAAAA@@AA@@BAAABA@A@AB@@A@@@@AA@@@A@A@@BAAA@@@@AC@A@@@A@@@A@@@@BA@@@BB@B@@@B@DA@@BB@@FCBA@@@@@A@A@@@@B@@AB@@A@A@A@C@@@A@@@AB@@A@@B@B@B@B@B@@B@B@@@B@@@@@@BBB@@@@@@@B@B@B@B@@AB@@AB@@AB@B@B@@@AB@@B@@BB@@@BA@BB@@AB@@@DD@BB@@@@B@B@@@@@BBB@@@B@@ABA@C@@@@B@@A@CB@@@B@B@@A@@@@@@@@B@@DBB@B@B@@D@B@BB@@@@@B@B@@BBB@@D@D@@@BBB@@@@@BCBA@@@@B@@@B@@ABA@@@@AA@@@@@A@A@@@@@A@@@@BA@@@@BAB@B@BA@@@A@A@@@A@@C@@A@@BA@@@@BA@AB@@@B@@@@@@BB@@@@AB@@@@@@ABA@@@AB@@@@@@A@@@AB@BA@@@AB@@@@A@@@ABAB@@AB@D@@@@@@@@ABB@@@AB@@@@AB@@@B@@@@A@@BC@AB@@@BA@A@@BCB@@BB@B@@AB@@@@@B@@@@@AA@@@@@C@@AA@@CBAAA@A@@@@A@@A@@A@@A@@@@AA@AA@A@@A@@@A@@@@A@ABAAAB@@A@@@@@A@@@A@@@@@A@A@@AA@@AAC@CB@@A@@@A@A@@@A@AB@B@BB@@@@@@@A@@@A@@@AA@@A@@@@A@A@CA@@@BA@A@AAC@A@@@A@@@A@A@@@ADCB@@ABA@A@@@@BAB@@@BA@@@@B@D@@@@A@@B@@@DADABA@@@@@@@@@A@@@@@@A@AA@@@A@@B@@A@C@@@AB@@A@A@@@@@@BA@@@@@A@AB@@@@@BADA@@BAB@@@B@@A@@DA@@B@@@A@@A@@A@A@@@A@@@A@AA@@@@DCBA@@@@@A@@AAAA@A@@@@@A@A@A@@@@@A@@B@@A@A@ABC@@@@BA@@BBB@@@BA@A@@B@@@@B@@BB@A@@@@@AB@@@@BB@B@@@BA@@@@B@@B@@ABB@@@@BA@@@@DB@@@BBBB@@@@@ABAB@@A@@@ABCB@@@B@B@@AB@@A@@BABAB@@@BA@@@@@AB@@@BA@@B@@@@@@A@AB@BAB@BAB@B@D@@@@A@@@A@@B@@A@@@A@@B@B@@@B@@@@@BA@@BABC@@@@BBB@B@BA@@B@@@@AB@BAB@B@BA@@@@B@B@FA@@BA@@@B@@B@@BB@B@@B@@BB@@@@@BAB@@A@@B@@A@AB@@ABA@@BA@@B@@@@A@@@@B@@A@@@BB@BABAD@DAD@B@B@D@B@B@@CB@@ADA@@BABABA@ABABA@@B@B@B@H@@B@@@@B@@B@@BB@@@DBBBB@@B@B@D@BB@@BBB@@@B@BB@BB@B@@B@@BB@B@B@@@B@@@DAB@@@@ABABC@@B@@AB@@@B@@@B@@AB@@@B@@AA@@@@A@@B@@AB@@A@AB@BAB@@@BA@@@@@BB@B@@@@@@BBB@@BB@@BBBB@@B@@@BADA@A@@BA@@@@@@B@@@@@B@B@@@@@B@@B@@@D@B@@@B@@@B@@@@@B@@@BA@@B@@@BA@@@AB@@@@@@@B@@@D@B@@BBB@@B@@@@BB@@@BBB@B@@@B@D@DA@@B@@AB@DABB@@BABA@@@@BBBA@@@BB@@@D@@@B@@AB@@@B@@@@BB@BB@@B@D@@BBB@D@@BBB@@@@@@B@@@B@@ABA@@B@@@B@@@@@B@B@B@BB@@B@B@@@@BF@@BB@B@@@B@BD@@@BB@@D@BB@@BB@@@B@B@@@D@BBBBD@@@BAB@@AB@@ABC@C@@B@B@B@B@D@BCB@BAB@B@D@@@@@DBB@@BB@B@@B@B@B@@@B@@@BBBBBBB@BBD@B@BBD@B@@@@B@BB@@B@@@@@B@B@@B@@D@@@B@@@BB@B@@@@@@BB@D@B@B@BB@BB@B@@@B@@@B@@B@BAB@F@B@B@@@B@@AB@@@B@B@D@@@DA@A@@B@B@@ABA@@@A@AB@@AB@@A@AB@@A@@B@@@@@B@B@@@B@@@@@@B@BB@@BBB@BDD@@B@@@@B@@@B@@@@B@@BBB@B@D@B@@A@@B@B@BBB@@BB@@B@B@B@@B@@@@@BABAB@@@@BBB@@B@@B@@B@@@BBBBDBB@@@B@@@@B@BAB@@@B@@@DAD@@@BA@AB@@@BA@@BBB@@@DA@@B@BA@@BA@@@@@@@A@@B@BAB@AA@@A@@@@@@@@@@A@AA@@A@@@A@@B@@A@@@AD@@C@@@@@A@@A@BA@A@A@@@AB@@@B@BBBB@@@@B@@@@BB@BB@@@@@D@@@@@B@B@BB@@@LF@@A@@@@B@@@@@B@@@BB@BB@@BBAB@@@@AB@B@@AB@DBDBB@B@B@@@B@@@@@B@@A@@B@@BD@B@@@B@@@D@@B@BBA@@B@@AB@B@B@@AB@@@@@BB@B@@@@@BA@A@@BA@@@AB@B@@@DA@AB@B@BABABA@@D@@@@@BCB@@AD@BA@@@@B@B@B@@A@@B@@AB@@@BA@@BAB@@@BABA@@@@B@@AB@@AB@@AB@BAB@@@BABA@@@@@@BB@B@@@@BAB@@@@@BA@@BA@@@@D@B@B@@@B@@@BA@AB@@@BA@@B@DB@@BD@@B@BAB@B@BA@@B@@@@@BABA@@DCDABCB@DA@@B@@BB@B@B@@@@A@@@A@@DACA@@@@@A@A@@@AB@B@B@BAB@@AB@B@@@BB@BB@B@@@B@D@B@@@@@BA@@BAB@@AA@B@@A@A@@@A@@@A@@B@BBBBBBBBB@@@@AB@BA@@@A@ABC@@@A@A@@B@@@@@BA@A@A@@BA@ABABA@C@@@@AAAE@@AA@@@A@ABA@A@@AA@@ABA@@@A@A@@@A@@A@@@BA@@BCAABA@@@AB@@A@@A@@A@@AA@@BA@@BA@@@A@AA@AA@@A@@@A@@@@@AAA@A@@B@@ACCA@@ACC@@AC@@@AB@BA@A@AB@BBBA@E@@@A@@AC@@@@@A@@CCAAAA@@@@@A@@BA@@AAAA@@@A@@@@A@@ABA@A@C@A@@@@A@@@@@@A@@@@A@@A@@A@@BAB@@A@@B@@@@@B@@@B@@@@@B@@AA@A@@AB@@@@AA@AB@@@@A@@@@BAB@BA@A@@B@@@@A@@D@@@@@@@B@@A@@@@@A@@@@A@ABA@@BA@@@ABC@A@AAB@@A@A@@@@B@@A@@A@@A@@@@@AB@@A@@@@@AA@AA@@@A@@@AA@@@A@@@AA@@@@@A@A@@@A@@C@@@@A@@@AA@@@@A@@A@@BAA@DA@@@@B@@A@@@AB@@A@@B@@@@A@@@A@@@A@@@@@CBA@A@A@@@A@CB@BA@@@@@A@@@ABA@@@AA@@@@AA@A@@@ADA@A@@@A@ABA@AB@BA@@B@@@@A@AA@@A@ABA@ABA@@BAD@@@BC@@BAB@B@BA@@@AB@@ABA@@@A@@B@BDB@BBB@BB@B@@B@@@BA@@B@@BB@@@B@@A@A@A@C@@AC@@@@@@@@@A@@@@@@CA@@@@BAB@@@B@@@BA@@@@@@BA@@BAB@BA@AAA@@BAB@@@A@@@BA@@@@DA@A@@@@@@BA@@@A@@@A@@@AB@@@B@@@@@B@BA@@@@B@@A@@B@B@@@B@B@@@B@@@B@B@@@B@B@@A@@@@B@B@@@@A@A@A@A@A@@AAAC@@A@A@@@AAA@CA@@C@@AA@A@@@@AC@@AAB@A@@A@@@AA@@AAA@@@CA@@@@@@@AA@A@@@A@@@A@@@@@@AA@@@A@@@A@BAA@@@A@A@@A@@AA@@@@@@AA@@@@A@@AAB@@CB@@A@@@A@@@A@@@A@@@A@CB@BAA@@A@@AA@AAA@A@@@C@A@@@A@@@@@A@@AA@@@@A@@A@ABA@A@ABA@A@C@A@@A@@E@@@A@@AA@@A@@ABA@@AC@@AAA@@@A@@AAA@A@@@A@@A@AA@C@@@AAA@A@@@@@A@@@@AA@A@A@@@@AAA@@@@BAA@@@@AC@A@AAAA@A@@AB@@A@@AA@AA@AA@A@@AA@@@@@A@AA@@CAAA@@@@BA@@@@AA@ACCAA@AA@@A@@@@@@@A@@A@@@@C@AA@@AA@AA@@A@@@@A@@@ABA@@@AA@@@A@@@@AAAAA@@@@AAA@A@A@A@A@C@@B@@ABABA@ABA@@AA@A@@@A@@@@@A@@@AAAA@@A@A@A@A@AB@@A@@AA@@@A@AB@@A@@B@@AB@@C@A@A@ABA@@@@@@@A@AC@@A@AA@@A@@@A@@@@A@AA@@@@@@A@AAA@A@@@AB@@A@C@@AAA@@@@A@@@A@@AAAA@@A@@AAB@@@@@ABC@@AE@@@A@AA@A@A@C@@AC@@AA@@@AB@@@B@@A@AB@@A@@B@AAB@AA@@@@AA@@@A@@@AAA@@@@@@A@@@@AA@@BA@@AA@ABA@A@@@@AAA@AA@@A@@@@@AA@@A@@@CB@@CB@B@BA@@B@@@BA@@@@@A@@@AB@@ABA@@BAB@AA@@@@@@@A@A@@@ABABA@@BA@A@A@A@A@@@ABA@A@EBAB@B@@A@@@ABA@@@ABA@@@AA@@@@@BAAABA@@@AB@@@@@AA@@@@AA@A@@@@A@@@@@AA@@B@@@A@@C@@AA@A@@C@@A@AA@AAAC@AA@@@@@@@B@@EC@@AB@@AAA@@A@@@AA@@@@@@@A@A@@@AAAAAAA@@@A@A@@@@@A@@AA@@AAA@@@AA@@A@A@@@@@@A@BA@@@ABA@@@@B@@@B@@@B@B@B@@@B@@@D@@@B@@@BAB@@@BAB@@@@@B@B@@@BAD@B@@@B@@@B@@A@@DAB@B@A@@A@@@@@@D@B@B@B@B@@AB@@AB@@AA@@@AA@A@@@AAA@A@@@A@@B@@A@A@@B@@B@@B@@B@@BBB@DBB@B@B@@@B@BA@@B@BA@AB@@@B@D@D@@@DA@B@@B@DBB@B@@@B@@BBABB@ADB@AB@BB@@B@@@@A@AA@A@@@A@@@@A@@@A@@A@@@AA@@A@@@A@A@@B@@AA@AA@@@@A@@@@@AA@A@@@@@@@DABA@@@@B@@BB@@@DEB@@@@@@BBBBB@B@@@BBB@@@@B@B@@A@A@A@@@ABA@@@A@@@A@@B@@A@A@C@C@A@C@A@A@CBA@@@@@C@AB@@@B@@A@@BC@A@@@@AA@@@@AA@A@A@AAA@AAC@@@A@@@@DB@BB@@@BA@@@@@A@@@A@A@AA@BA@@BA@@D@@@B@B@@A@C@@BA@@@AB@B@@@@@@A@AB@BA@A@@@A@A@@AA@CA@AAB@@@@ADAD@@@B@B@@A@A@A@@@A@@DAB@B@@@@@B@B@@A@@B@@@@AB@@@@C@AB@@@@ABA@@@A@@@A@@BA@A@ABA@A@@BA@AB@@AAA@A@A@@@AB@DA@@@AAC@AAAAA@AAAA@AA@@@@@AAA@A@@@@AAC@AA@@AAA@@@AA@@CAA@A@AA@@A@@A@@ABAA@@A@ABA@@@@BABBBA@@@AB@@A@ABA@@AA@A@@AA@AC@@@C@CA@AA@A@@C@AAA@AAA@AAA@AAB@@A@@@AA@AC@C@A@@@A@@BA@@@A@@BA@@@@B@@@BA@A@@@@BAB@B@@AA@BA@@@@AC@@@@BAD@@A@@B@@@@@B@@AB@@A@@@@A@@@AAA@@@DCB@@@B@B@@ABAB@B@@@@AB@BA@@@@BA@@@A@@@A@@@A@A@@B@BA@@@@@B@@BAB@@@B@B@B@BA@@@A@A@A@A@C@A@@@A@@@ABAAA@A@AAAAA@@A@CAA@@@@BC@@@@B@B@@@@AB@@A@@AA@@@@BAAC@@@@AAA@AB@@AB@@AA@@@@@@AA@@ACC@ABA@A@A@A@A@AB@F@B@@@B@@A@@@@@A@@AA@A@@A@@@@A@@@@@ADA@@@A@@C@AA@@@@C@@@A@BA@CBC@A@@@A@A@@@@A@@@@ABADA@@@@@@@AA@AA@@@@AA@BAAA@@@C@@@@@@@@AA@AC@AA@@AA@@@@@A@AAAACC@@@A@@ABA@@AA@@@ABAB@@A@@@@A@@@A@@@@@AA@@@CBAB@@A@@B@BAB@@@B@@C@@@A@A@A@A@@@A@@@@@DB@BB@@BAB@@ABA@@B@@A@ABCA@BA@@@A@@A@@AACC@@@A@@C@AAA@A@@@@@A@@@ABA@@@C@@@A@AB@@C@@@A@@@@@AAAAA@@AA@A@A@A@AA@@AAA@@@@@B@@@B@B@H@BABA@@@@AA@@@@A@@@A@A@@A@@A@@@@@@B@@C@A@C@@@AA@@C@@@A@@A@@AAAA@@A@@@A@@@@@A@A@@@@A@@BCBAA@@@@AA@@@A@A@@@@AB@@@@A@@@A@A@A@AA@@B@@@B@@A@@B@@AB@@A@@@CBA@@@@@@@@A@AA@@@A@A@A@A@@@A@AA@@@@@AAB@@@B@@@B@@@B@@@@@B@@@@AA@@A@@B@@AACA@@@A@@@A@@@AA@@BAB@B@B@@AB@@@@@@ABA@@@@@@@A@@@@CA@@@@@@@BA@@@@@@@A@@@@AA@@AA@@A@@A@AC@CAA@AA@@@@BA@@@@AA@@@A@@@A@@@AB@B@@@BA@@BA@@@A@@@@B@@@BA@@@@BA@AA@AAA@AA@@AAA@AA@@@A@A@@BA@A@@@C@@@ABA@@BA@A@@B@@@B@@@@@BC@@@AB@@@@@@A@A@@AAA@A@AAA@AA@@@AB@@@BAB@@A@@@C@@@ABABC@A@C@@BA@A@@B@B@@@@@B@@@@@BB@@@@@@B@@@BA@A@@AC@@@@B@BADA@@B@BA@@B@B@BB@@B@BAB@@AB@@@DBD@@@B@B@@@BCBABAB@B@B@@@@AB@@@B@@A@@BBB@@AB@@A@@@A@@B@BAB@@@B@@@BA@@D@@A@@BA@@@@@@BAB@@@BABAB@@@@@BA@@@@@@B@BA@@D@@@B@@A@A@CAA@@@@@AA@ABA@C@A@@@AA@@A@AAAB@@A@A@AAA@AA@@CC@CCA@A@ABA@@BA@@B@@AB@B@@@B@@@@@AA@@AAA@@@C@C@A@@@CBCBA@C@AB@@A@@@ABA@@B@@@@AB@@@A@@@@@A@@@@@A@@BA@@@CAA@@@AA@@@AA@@@@A@A@A@@@A@A@AAA@@@@@@BA@A@@@CAA@@@@A@@@@A@A@@AAA@@A@@@A@@@@AAA@@@@@@CB@@A@C@@@@@AB@D@@@BBB@@BB@@CBABAB@B@@@B@@@BA@@B@@@B@@A@@@@@ABAB@@A@@AA@@@@@A@AB@@A@@@A@@@@@@BAD@@AB@@@B@@@B@@AA@@AB@@AD@D@@AB@@@@@BB@A@A@@@ABA@@@@@@A@@A@AA@@CBA@A@A@AA@A@@@AB@@@@A@@BC@A@@@@@AAB@@CBC@@@ABA@@@A@A@@@A@ABAAAA@@@BA@A@@BAA@@A@@@A@A@@AA@CBA@@@AAABA@@@@A@@@A@A@@@@A@AA@@@A@@@A@@@@AA@A@@AA@@A@AA@@@C@A@ABA@@CAAAC@@@@A@A@A@AACAAAE@ABA@@A@@@@@A@AAA@C@@@A@@@@@A@@AAA@@@CAA@@A@C@@@@@A@@BA@A@AB@AABA@@AA@A@@AA@@BA@A@@@AB@BA@@B@@AB@BA@@@@@A@A@@@@@ABAB@B@BADAD@@@D@@BB@@AB@@@@AB@@BB@@@@@@@@B@B@@@BB@@@BB@@@@B@@@BA@@@@B@@B@B@@B@@@BAB@@AB@B@@A@CB@@@@AB@D@@A@A@@@A@@ACA@@A@A@AAC@A@AA@A@@@AAA@@A@@AAAAAA@ECA@AAAAAA@@AAC@A@@@@@@D@@@@CAA@AA@@A@AD@@A@@BAA@@@@AA@A@@A@A@@@A@A@@@A@A@@B@@A@@AA@A@C@@BA@@@@B@@A@AAAA@@A@@@@@@A@@C@CA@@A@@@A@@@@A@@@A@@@AABA@@@C@@@@A@@@@B@BA@@@@AA@@@@@@BAB@@@@@@@@A@A@@@A@A@@AAAA@@@AA@@A@@A@AA@@ACAAA@@@C@A@@@A@@A@A@AA@@AA@AAA@@A@@AB@@ABABA@ABA@A@@BCD@@@B@@@B@DAB@@CDCDA@@@A@AB@@AB@AA@A@CAC@@@@AA@@AAA@@CBAA@@A@A@@@A@@@@A@A@@AA@@A@@@A@@@A@@@@@@@@A@C@@AA@@@@@AAA@@@AA@A@AAAACAAAA@A@A@A@@@@@CDEFEFA@CDCBABADAB@B@@AD@BAD@B@B@BADADC@ECECE@I@CDABBD@B@DCDCB@@@@AB@@ABA@@B@BAB@BBB@DBB@B@BBD@B@BAB@B@BBB@BBBBBB@BBB@BBB@BBD@B@@BB@BBB@B@B@@@@@B@B@B@@BB@@@BB@@B@BBDBB@DBB@DBBBB@BBD@BBB@@@BBBBB@@B@B@@@B@@AB@@A@@BAB@@AB@BABA@@@A@A@A@@@A@@@@@AB@B@BBD@@@@AB@@A@@@A@CAA@A@A@@@EBABCDAD@B@B@B@BBB@BBB@DABA@ABABABBB@@BBB@B@B@BB@@@B@@@B@@@BA@@@A@@B@@BB@B@B@B@@@@B@@B@@@@B@@B@@@BAB@@BB@@@@AB@@@B@@B@@@@B@@A@@@A@@@@@AB@@A@@B@@@@A@@@A@@@@B@@AB@@A@@B@@AB@@@@@@B@@BB@@@B@B@@BB@@BBBBBAB@@@@AF@@@B@@@B@@A@@B@B@@AB@@B@@@@@C@@@ABADAB@BABBB@@@@B@@B@@BB@@B@@@@@B@@@BBB@@BDD@@@B@@@B@@@B@@B@@BAB@@ABA@@@AB@@ED@@@@CAAA@AA@@@A@@@@@@AA@@BA@@D@@@B@B@@@@B@@@B@@@BB@@@@@@BD@@BBA@ABAB@B@@BB@@BB@@@@@BB@@@@D@B@@B@B@BBBBDB@BB@@@BBA@AB@@@B@BAB@@AB@@@@A@@@A@@@A@@@ABADABCBAF@@AB@@AA@AA@@A@AA@@AAAA@@@ABA@A@A@@@@@ABBBA@A@AAA@@@@@A@@BBF@B@B@@@@ABABA@@BAAA@@@@A@@@ACAC@A@@A@@A@@@@BA@@@@@@B@B@@@@ABA@C@@@A@@B@@@B@B@B@@BBBB@@ADA@ABA@@@A@@B@@ABA@@BA@EBA@A@@A@@AA@@A@@@AA@@A@@@@@A@@@A@A@AB@@AA@A@@BA@@@A@@@@A@A@@BA@@A@@A@@@A@A@@@A@@@@A@@C@@B@@@AA@@A@@A@@@@A@@A@@@A@@A@@@AA@@@@A@@@@A@A@A@AB@@@BA@@BA@@@@AAC@@@@A@@@A@@@A@A@@@AAA@@@@@@AA@@@A@AB@@@A@@AB@@CAAA@AA@@BA@A@@BA@A@A@A@@@A@@@A@A@ABA@AB@@ABA@@BB@@@BB@@@B@B@@@BB@@B@@@B@@BB@B@@BB@@@@@@@B@@@@@@@@@@AB@@@B@@@B@@@B@@@B@@@@AB@@@B@BADA@ABCB@@A@@D@D@BB@@@B@B@@AAA@@DA@B@BBB@BAB@@A@@B@@@@A@@@A@@@A@@@ABABA@@@A@BBAB@B@BA@ABCB@@ABABCBA@@@@B@@@@@B@@@B@B@@BB@BBB@BDBBBDBBBDAD@@@B@@BB@@@B@@BB@@@@B@@@BD@@B@@A@AB@@AB@@@@@B@@BB@B@B@@@B@@B@B@D@@@@@B@@@B@@@BB@@@DA@@BA@@BB@@B@BB@@@@@@B@@B@@@@@@@B@@@BAB@@@B@@@B@@@@@B@@@@B@@@B@@B@B@@@@B@@@@@@@B@@@B@@A@A@@@A@@B@@@BABA@@@AB@@BB@@@BAB@@@@ABB@A@@B@BAD@@@@@BA@@@@B@@A@@@A@@DB@B@B@@@B@@B@@ADAD@D@@A@A@A@@@@@AD@B@B@B@@@BA@AB@@A@@@@B@@@BA@A@ABA@@@@B@@A@@BDD@B@B@@AB@BB@BB@BB@@B@B@@@@BB@B@@@B@@@@B@@B@@@@ABA@@@A@@@@B@@B@@B@@@BABBB@B@B@D@@@B@@@@DB@@@@BC@@@AB@B@@@DBB@B@@@@@@AB@BB@@BBBB@@@@@@@@@@DB@B@BB@@@B@@@B@AA@@BA@@@@@@B@@@@AB@@@B@@@@@B@@@@@ABB@@B@@@BA@@B@B@@@BDBD@@@D@D@@@B@B@BB@@DD@@A@@@@@@@@@@@@BB@@@B@@@@@B@B@B@@BB@@@B@@@@@B@@A@@@@B@@AB@@@B@@@D@@@DAB@@@@@@@BA@@@B@@BBB@@@A@@B@@@B@B@BA@@@A@@@A@@@A@@@AA@@@B@@@B@B@@@B@@@B@@@B@@@@AB@@@@@@B@DBB@@B@@@@@@@B@B@@@@@BB@@@ABB@@B@B@@@B@@DBBD@@B@@B@@B@@@DD@AB@@@@@@@BBB@B@B@D@BB@@BBB@B@B@@@B@@A@@@@B@@BB@B@@@@@B@@ABA@@@B@B@@@B@@AB@@@BA@@@AAAB@@@@C@A@CB@@@@BB@B@@@@DB@@@@@B@@A@@@@D@BABA@A@@B@@@BABAB@@@@@@BDB@@B@@@BBB@@B@B@@@BA@@BA@@@@@AB@@@BBB@B@B@@BB@@@@AB@@A@@@A@@@@BA@AAA@@@@B@@A@@@@@@@@@@@A@@@AB@@A@@@@@@@@B@B@D@@@@@@A@@BA@@B@B@@B@@@B@@@B@ADB@@DB@@B@@B@@@@BA@@@@BAB@@B@BBB@@@@ABB@@@B@@BB@BB@@@@BBDAB@@@@@B@@B@@B@@@@B@BB@ABB@@B@B@FAF@D@B@BA@@@ABCBCBA@@@AB@@AD@@CDAB@BA@AB@BA@@@@B@D@B@BABAB"],encodeOffsets:[[119163,25796]]}},{type:"Feature",id:"350824",properties:{name:"武平县",cp:[116.100414,25.095386],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@ABA@E@@@E@EAC@A@AAAA@@A@@@A@@A@CBC@AAA@@A@A@C@A@ABEDCACAGI@ACA@@@A@@CCGE@@@A@AB@@A@@@A@C@A@CAA@AAC@A@C@C@A@CAC@CACACA@AAAAA@A@A@@@A@A@A@@AA@AA@AAC@@AA@@AB@@@B@D@D@@IGSI@@ACCAACCACACACA@A@@A@@AA@AAACAAA@EAEAEAE@CAC@C@A@E@C@E@C@C@C@C@ABCAA@CAAAAAA@AAEAAAA@A@C@A@C@C@EDOJ@D@@@B@DBBBBBDBBBDBBBB@@BBB@BDBBBBBBB@BBB@B@BB@@@@@B@B@D@B@BAB@@A@ABA@ABC@A@C@C@AAC@CAAAA@AAAAA@AB@@ABC@ABA@ABAB@@@B@B@D@F@FAD@BAB@BABABC@ABA@@@AD@BADAB@BABA@ABC@C@C@@BA@IN@BCH@DAFAFGBICCAEGACEC@@EECCCA@ACCA@@@AAAAAAA@IKEGC@CAI@@@AAC@C@ABABABC@CBC@C@C@A@CBC@@@A@A@@@ABCB@@@@A@C@ACA@E@CBC@ACA@A@CDA@E@AA@@A@BCBCAAACACAAAA@@@@A@@@ABCB@@AB@@BB@D@FAFCF@@A@@@@@A@C@C@@@C@CB@@A@@@C@@@@@AA@A@AAAA@A@@@@BAB@DAB@@@BAB@B@@A@@@@BA@@B@@@@A@@@C@A@A@A@ABA@ABA@@@A@@@A@@B@BAB@@@BA@A@@BAB@B@@@BB@@B@BBB@BA@@BA@@@AA@@A@@AA@@@@AA@@@AAABA@@@@B@@BBB@@@@@BB@B@@ABA@@D@DAHCFGFABGFGJEFABABCBA@ABA@A@A@AA@AA@CCCCCACC@@A@CAC@C@C@ADCBCBC@A@A@A@@@@@A@@BAB@DAD@BAB@BA@@B@@AF@@@@@B@B@B@@@@@@A@A@@@A@A@A@AAA@@@AA@@@@@BA@@@@AAB@@@@@@A@@B@@@B@@@@@AA@@A@@@A@@@AA@@@AB@@@@AA@@@@@@@@AB@@A@A@@@A@@B@@@@@B@@@@A@@@AB@@AB@@@@A@@@A@@@@@@@AB@@A@@@@@AA@@@@@A@@A@@@@@A@A@AB@@@@@A@@A@@@@AA@@@@B@@AD@@@@A@@@@BA@A@A@A@@@A@A@A@G@GBC@A@A@A@A@CBCBCBCBC@A@A@C@@@A@AAA@@@A@@@@BAB@B@BAB@BEFA@ABADEDABCAC@CFGLAB@B@B@@AB@B@@ABA@ABA@A@C@A@@@ABC@ADCD@D@D@D@D@D@BBDBDBBB@DBDBB@BBB@DB@AB@B@@@@ABA@@@ABA@@B@B@BABAB@BA@GDEDAF@FDDBFALGHAB@B@D@FBBBAHEFGNQPSTCBGBUBADA@@BBB@@@DC@ADCB@BADAD@B@D@F@D@DADBDDBDBBBB@B@FAHADFBJ@@D@FBBFBDBBB@DABBBDBBDBD@DBD@B@DCBADADDBDDFD@D@BD@@BB@@AD@@ABA@ABCBADAD@D@DA@@@@@A@@@@@A@@@@@@@A@A@A@@@A@@@A@@@@B@B@B@@@@BB@@B@BB@BAB@B@BBDD@BB@@@B@@CBABAB@BCFIHEBGDKBM@C@E@C@ABAF@JBFFF@BCBABCBADCBCFAD@D@B@@@D@B@BBBB@@BDAF@B@FD@@DBBDBB@B@DAF@@AFAH@@EBCDAF@FCD@@C@GCGAGBCDADA@EAC@ADAHAHBBJBFB@DABABCDCBAFAB@@B@BBFB@DAD@BBD@DAD@FBD@BAJ@FAH@FDDFDFDDFBFBBFAHCDGDA@@JBFDBADBDBBBBBBDDBBDDDBDDDDBDB@@F@D@F@DAFCBBB@BBDBDBD@DBDB@DBDBD@@BBBBBBBD@@BB@DBD@BBDBBDDDB@FBDDBDD@DBD@D@BAF@DCD@BAH@B@B@DBBDFDBD@B@HCB@BAB@FEBAB@F@B@@@@@DBDBBB@F@D@B@B@@BBBBB@@@B@D@@@BA@@BAB@@AB@@@BGBA@@B@BBFF@@HD@BBBDDDB@DB@BDBF@BBFBD@BDDBF@DEDGBA@@DFBBBBD@LEHCFIBGB@@@B@BBB@BB@DBFBBFBHEJEHADAD@HDFBDNNBDDBBBDF@BADAFABAHADAD@HBBDDF@DAD@FBBBB@DDBBDD@HAD@D@@@DBD@DBDADABABED@B@@@HEJCH@HBHBFDFDHBBB@@@BB@@B@B@@@B@@@@@BAD@@@BB@@@B@@@BB@@@@@BA@@@A@@B@@B@@@@BAB@BA@@@@@@BA@A@@@@@A@ABA@@@@@@@AA@@@@A@@@@AAAAAE@@@@@A@@@A@@@A@@B@B@B@B@D@@@BABAB@@@@@@@BA@@@@@AB@D@B@B@@B@BF@@@BADA@@@AAA@AB@@A@AB@BAB@@@B@B@@@B@@@@@@D@@@B@D@BADD@B@@@FCB@@@B@@@@AB@@@B@@@B@BBB@@@B@@@@B@@@@@BB@@@@BB@B@@@B@B@@@DA@@@@@@@A@A@ABE@A@@@@BBDB@@B@@@@@@@B@@@B@@@@@BB@@B@B@@@@@@@@BB@@@@@@@BA@@@A@A@@AA@@BA@@B@@@@@@@@@B@B@@@@@@@B@@@B@@@@A@@@@@@B@@AB@@@@A@BB@@@@@@@DA@ABB@@@B@B@BBA@AB@@@B@@A@A@@@ABA@@@AB@@A@@@@@@B@@@@@BBB@@@BB@@@@BA@@BBBBB@B@B@B@@@@AB@AAAA@@A@@A@@@@A@@@A@A@@@@@A@@A@@B@@@AA@@@@@A@@@@@@@@AB@A@@B@@@AA@ABA@ABA@AB@@ABCC@BA@@@@@AB@@@BA@@BA@@A@@@@@@@@@A@@@A@@@@B@@@AA@A@AA@@@@@AB@@@@@B@@@B@BA@@@AB@@@BA@@@AB@B@@AB@@B@@@D@@@@B@BA@@BAB@BB@@@A@@BA@@@@BBD@@@@@BA@@@@B@B@BA@@B@BBB@BBBBBB@@B@@BB@@@@BB@@@B@@BB@@@B@BA@B@B@@@B@@DD@@BA@@BAB@@@@AB@@@BAB@@@@B@@B@@F@@@@DB@@@@BBA@@B@@A@@@@@@@A@@@@B@B@B@@@B@@A@C@@BA@ABA@@BABC@@@A@CD@@A@@@EA@AA@@A@@@@@BA@@B@@C@@BABA@@@A@@@AB@@@BADAB@@ABAA@B@@@@@@@B@@B@@@@@@B@B@@B@@B@B@@@B@@@@@@@BA@@@@B@@B@BAB@@@D@@@B@@A@@B@B@D@@@B@@@@@@@@@@A@@@@@ABC@@@AB@BA@@@@BBD@@@@BBB@@B@D@B@BBD@@@@@@BD@@BB@@D@BBBB@@@@@@@BAB@@BB@B@@@@@B@@BB@@BB@AB@@@@CB@@@@ABA@ABAB@@@@A@@B@@@@A@A@@@A@@@@@@@AB@@@@A@ABA@ABA@@BA@@@A@@@@@A@A@A@A@@@@@@B@@BB@BABAB@BBBDD@B@@B@@A@@BAB@@@D@B@BB@AB@B@@@BB@@@@@B@B@@AB@@@B@@BB@@DA@@BA@@D@@@DA@@@@AB@@BDB@@@@@B@@@BAB@@@B@@@B@@@@B@@BB@BBD@@@@@@AB@@BB@D@@A@@@BB@B@@B@@@@@@@B@@B@@@@@B@@@@BB@@@BA@B@@@@@B@@@B@B@@A@ABAB@@ABAB@@ABBB@@@@B@@B@@@B@@@B@@AB@B@@@@AB@BABAB@@AAAD@@@B@@@D@@@BA@@BAB@B@@@@@BB@@@@BB@@BCBAB@BDB@@@B@@@@@@@BA@ABA@@B@DAB@@@@BB@BB@@B@@BB@@@BBB@BB@A@@@@@@B@@@B@@B@@BABCB@@AB@B@BAB@B@DA@@@@B@B@BB@@B@HJBB@@BBB@BBB@@@B@BABA@@@A@@@BB@@@@@B@@@@B@@BA@@@@AAAAA@@CAA@@@A@CBABADA@AB@B@@@@@BAB@DCB@@AD@@@B@B@DABAB@@@B@@@@B@B@@B@@@BBBBB@@@BADABA@@BA@@B@@@BA@@@@@B@@@@B@B@@B@@B@@@BA@@@@BA@@@AB@@@@A@A@@B@@@BB@@B@@@B@BA@@BA@@BA@@B@@A@@@A@BA@ABA@@@C@CAAB@A@@@A@@BA@@D@@@B@B@@A@AB@@AB@@ABA@@@@B@@B@@@@BA@@@@AA@@@@@A@@@ABA@@@@@B@BB@@@@BDA@@@@AA@@AA@@@@@AB@B@@@BB@@BBB@@@BAB@@A@@@@AA@A@@@@B@B@BAB@A@A@@@@A@@B@BAB@@@@BDB@@B@@@@@B@BBBBB@B@B@@ADC@@@@A@@A@@@@AA@@@AB@@@BA@@@A@AB@@A@AA@@@A@@A@@@A@A@AA@@A@ABA@@@A@@@CAAAA@@A@@@@A@@@@AA@@B@BA@@A@A@@@@A@@@A@AA@ACCACA@@A@@AAA@@B@@A@@@@A@C@AAAA@C@A@@AC@@@A@@A@@@AA@@AA@A@@A@@@@@AA@@A@ABA@@@A@A@@@@@AAB@@ABE@@D@@BBA@@@A@@@A@A@A@@@ABA@@@A@@@@@AA@@@AAA@@@AAAA@AA@A@@AA@@@@@@BA@@B@@@AA@A@BABA@@@AACAA@A@@@ABA@@BABA@@@AA@AAA@AA@@A@@A@AA@@AA@@@@ABAA@BAAA@C@A@@BA@@AA@@@A@AA@BA@A@A@@AAA@@A@@@CAC@@@@@BA@@@AA@@@@AA@@@A@AA@@@@@@@@A@@BA@@BA@@@AB@@@BA@@@@@A@@@A@A@@BC@@@A@@AAB@@ABC@@BA@ABABA@AB@@A@@AABABA@A@@@A@@CBA@@A@@@A@AAAAA@@@AB@DA@AB@@@@A@@@AB@@A@AB@@@B@B@B@D@@@@AB@B@@@BA@@@@B@B@@BBBBB@@B@@@B@@@BAB@B@@ABA@@BA@@BAB@@BBABA@@B@BBBABA@@@AA@@CA@ACA@@ACC@A@@@A@@@@B@@AB@@@B@DA@A@@B@BA@@B@BA@@@@@@BA@B@@DB@AB@@A@CB@@@@A@@@@@A@@@@@A@@@@DA@@@A@@BA@@@@@@B@@@@AB@AA@@@@@A@@@@@AB@B@@A@@BA@@@@@AAA@A@@@ACAAAA@A@@A@@@AAAAA@@A@@@A@@@@@A@AA@@@A@@A@@@@A@@AA@A@A@AAABABA@@BA@@@AA@@@@AA@A@@@A@A@@A@@A@AA@@A@CAC@@@AAAA@@A@AAA@@@AA@@A@A@@@AA@AA@@@AA@ACC@@@@BC@@@A@AAC@@@@@A@A@@@A@A@@DA@@BA@@@@@@AC@A@@@@@AB@B@@AD@@@BA@A@@B@B@DA@A@@@A@ABA@@@AA@AA@@@AB@D@BA@@@AB@@@B@F@B@BA@@@AB@B@B@DAB@BA@B@@B@B@@@@BB@@B@@@AB@BBBAB@@@B@@@B@B@@AB@B@B@B@@@B@@@B@B@BAB@@@@@@@B@BA@@@BBBB@B@@@B@BAB@H@F@@@BB@@BBBA@@@@@@BB@@@A@@BAB@AA@AAC@A@A@@@@@A@A@@BAB@@@@CAC@@B@A@@A@AB@B@B@@AD@@A@A@A@@BBBB@@ABDBBB@B@A@@@@@@@A@A@A@A@@B@@@@AA@@A@@@C@@@@BA@@B@BBD@@@B@@@D@BAB@@@BB@AB@@BBB@@@B@B@@@@B@@@@ABABA@A@A@C@A@@B@@ABAB@@ABADC@@BC@@BA@@B@DADABA@@B@@A@A@CBE@E@A@AA@BAA@@A@A@@A@@@@AA@@@@@A@CBAA@@@@AAA@@AA@@@A@BA@@@@AA@A@AA@AB@@@@AB@@@@@AA@@@CA@@CA@B@A@@@A@@@AA@A@@@AB@@@B@@@@C@A@A@@@@@@@@@@BA@@B@@@B@@@@@@@@@@@BA@@@@@BB@BAB@@@@@B@@@BA@@B@@A@@AA@A@A@AA@@A@@B@@@@AB@@AB@@A@A@@@AA@A@@@AA@AC@@@@@@BABA@A@@@AB@B@BA@A@C@@B@@@@A@@@@CA@@@@@AAA@@@@DAB@D@@@@@BABB@@B@@A@@BA@@@A@@@A@A@@AB@BA@@@@@A@A@@AA@@@@@@BA@@@A@A@A@AA@@AAC@A@A@A@AA@@@@@@A@@BCC@@A@@@@AA@@@ACCA@@@A@@@A@AA@BA@@A@@A@@@@@A@A@@@@@@@AA@CAA@@@@@@@BA@@@@@A@@@A@@@A@@@A@A@@@A@@BB@@B@@@B@@@B@@@B@@A@A@A@@@AB@@@A@AA@@@A@@AB@@@@@@A@CB@@C@@@A@@@A@@BA@@@@@@BA@@@@@A@@@A@@AA@A@A@@@@@A@@@A@@A@@@@@@@@@@B@@@CC@@AAA@A@@@C@C@@@C@CA@A@@@A@AB@@A@@@AA@BA@@@@A@@@@@A@@@A@@B@@A@@@@@@@AB@@BBA@@@A@@@A@@A@ACA@@@@@@@@@@AAAA@@AAA@@B@@@@A@A@CA@@A@A@@B@@AD@@@@CA@@@@@A@@@C@A@AAABA@A@@@AA@@@@A@@B@@@B@BA@@@@@AA@@@@@@A@@@AAA@@@@@A@AA@@AAAA@@ABA@@@A@ACC@AB@@@@A@@B@BAB@B@@A@@@A@@B@@@BAB@@A@@@A@A@ABC@@@@B@B@B@@@@CBCBC@@@AA@@@A@A@A@@CB@@@B@@@@A@@B@@A@@@@BC@A@AB@A@BA@@@@BA@A@@AA@@BA@@B@BA@A@@@AB@@@B@B@@@@A@@@A@@@@@@@A@@A@A@@@@A@@@A@@A@@@@@A@@@A@@@A@AB@@A@@@@@@@A@@@@A@@@@A@@A@AA@@AB@@AB@@C@@AA@@A@@@A@@@@@C@A@A@@@@A@@@A@AAA@@@A@@@@BA@@BAB@@@@AC@@A@@@A@@A@@AA@@@A@@AA@@@C@CBAACAAACA@AAA@AAA@@@A@A@@@A@@@@@A@@B@DABABA@@DABAB@@A@ABAAAB@@@B@BABA@@B@@@B@@@B@@@@@@AB@@@BA@AAA@A@ACB@@BB@BA@A@@@A@@A@C@CB@@@DABAB@BC@A@A@@BA@@@@@A@@@A@@@A@@@A@@BA@@@@@@@@@@@A@@@@@@AA@@@AAA@@@A@@@AA@@A@@@A@A@@AA@@A@@AB@BA@@BAB@BAB@B@@@B@@@B@B@B@B@@AB@B@@AB@@BBBDB@@BA@@@B@@BAB@@@B@@B@@@@B@BB@@B@B@@@B@@@B@@@@@BD@B@@B@@AB@@A@@BAB@B@B@@@@@@B@@B@@B@@@BB@@@B@@@@B@@B@@@@BB@@B@@@AD@@@@B@@B@@@B@B@@@B@@@@BB@@AB@B@@@@@@B@@AB@@@BBB@@BAB@B@@@B@@@@@B@@@BB@@B@@@BB@@@BB@B@FAB@@AB@BA@@@AB@@@B@BAB@BC@@AAAA@@@A@A@A@@@AB@@@D@B@BA@@@@@A@A@@@@B@@A@@B@@@@BB@D@DB@B@@@B@@B@BB@AB@BABA@@@@@A@AAE@AB@@@@@B@BBB@B@AABA@@@@B@B@B@BA@@B@BB@BB@@B@BB@@BBB@@BA@@BEDABABCBA@@B@@@B@@@B@@@@@BA@@BA@A@A@@BAB@AA@@A@@ACAAAAAA@A@@@@A@C@@A@@A@@@@AA@@AA@@@ABABAB@AA@@AC@@@@@@AA@@A@@@A@@@@@@A@A@@@CB@@AB@@B@@@@B@@@B@@BBBDB@@@@FC@@BA@@B@BA@@BA@AA@@@@A@@@A@@@A@@CC@AA@AA@@A@@@@@A@@@AA@@@AA@@@@@AABA@ABABCBA@@D@@@@@A@@@BA@@@A@AB@@@@A@@@A@@BE@@@@BAAAAA@AA@@AA@A@@@A@@AA@@@@@@@BA@@@AB@@@BA@@@A@@B@@@B@@@@@@AB@@@BA@@@@B@@@B@@@@A@@A@@@@A@@BA@@@@AA@@BA@A@@@AA@@@@@@AA@@@@@@A@A@AAA@@@A"],encodeOffsets:[[119188,25475]]}},{type:"Feature",id:"350825",properties:{name:"连城县",cp:[116.754472,25.710538],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@@G@A@A@AB@BABAB@BABA@AB@BC@@DA@@@A@A@C@A@ABC@CBCBA@AAA@@B@@@@A@@B@@@@@@AB@@AB@BA@@BAB@@@@AB@@@BA@A@@@@AA@@@AA@A@@AA@@@@A@@AB@@EBA@A@@@@@ABA@A@ABA@@B@@A@@@ABA@A@AA@@@@ADAB@@AB@@@@A@@@A@A@@@@B@@@BA@@@@B@@@B@@C@A@A@ABA@ABA@@B@B@@@@A@@@AB@@A@@B@@@@AB@@A@ABAB@@@BA@@BA@A@@@A@AD@B@@@BA@AB@B@@@@AAAA@@A@@C@@B@@A@@A@BA@@@AA@@@@@AB@@A@A@@A@@A@@B@@@@@BAA@@@A@@A@@@@BAB@@A@AA@@AB@@@@AD@B@B@BA@@@@B@@@@@B@B@B@@@@@BBBBB@@@B@@@@ABCD@@@@BBB@@@B@@@B@B@@@@BB@@@A@@@CB@@@BA@@@A@AB@@CBAB@@@@A@@B@B@@@@AB@@@@@@@B@BA@@B@@@D@BA@@@@B@@BBB@@@@@@@@B@@@@@@@@ABCBCB@@A@@@@B@@C@A@@@@@AB@@A@AB@@@@@BAB@BA@CD@B@@@B@B@@@B@@@BBD@B@BAB@@B@@D@B@B@@B@@@BB@@B@@@B@@@@@@@A@@A@ABAB@@@B@B@@@B@@@DAD@BB@@BB@@@B@B@@@@@B@@@B@@@@@BA@BBAB@B@B@@B@@@B@@@@BBBB@@B@@B@@@@BB@@@@B@@B@B@BBDA@@BB@@@D@@@@BB@@@@A@@@@@A@@BA@A@@AA@AD@@@BAB@@A@@BAD@@@B@@A@@@A@@B@@A@@B@@AA@B@@@@@@C@A@@BA@AB@B@@@B@@A@@B@@ABA@@B@@@B@@@@A@@B@@AB@B@@@@A@@B@@A@@A@@@@A@@@A@@BAB@@@@AB@@@BD@@@@B@@@B@B@@ABA@A@AB@@@@AB@@@@@B@@@@@B@B@@@@BB@@@@AB@BA@@@A@@@@@ABAD@@@@A@AA@@C@C@@@AA@AA@A@@@@@A@@A@A@CA@A@A@CA@@@A@@@@@@B@@@@A@A@@DAB@@@@A@@D@B@BA@@@A@@AA@A@@@@@A@A@@A@@ACC@@A@@BA@@AAB@@A@@AA@@@BA@@A@A@A@@BA@@BA@@BA@A@A@A@@@@@@@A@AA@@@@@@@A@@@A@AA@A@A@A@A@@@@BA@@B@@@B@@@D@B@B@BA@@BA@@@@@@B@B@@@@ABED@@AA@@CBA@@@A@A@@A@@AB@@@@@B@@@B@@@BBD@@@@BBAB@@@B@B@@BB@@@@@BA@@B@BABBBAB@@BB@@BBBB@BBBBBAB@@@B@@B@BBBB@@@@@B@B@@BB@BA@@B@@BBBB@@@B@@AB@@@@@B@BBB@@B@BB@@BB@B@D@@@BDBB@@B@BBBAB@@@BAD@BAD@B@D@@@@@D@@@B@@B@@@AB@B@@@@A@@@@B@@@BABB@@B@BBB@@BBBB@@@B@D@B@B@@@@A@@BA@BBBB@B@@@BA@@B@@@D@@AB@BA@ABB@@BBDBD@D@BDB@DDD@@@@A@@@@B@@@B@BBBBDBB@@BB@D@D@@@BBBBD@@@@BBBD@@B@B@@@@BBB@@@B@@B@@@@@BBBF@@@B@BBDDB@B@BBB@B@@@@@BA@BD@@BBB@@@B@@@@B@@@BB@@B@@BD@@@@AB@@@B@BAB@D@B@@@@@B@@AD@@A@BDA@@B@@AB@@@@AB@B@@@B@B@@@BBB@@@D@@@@AB@BAB@B@@@BBB@@@@AB@BC@@B@B@B@B@@AB@B@@@B@B@@@B@@@B@@@BAB@@@@@BB@@@B@B@B@@@BAB@@@FA@@BA@@B@@B@@@@@D@@@B@@DB@@BDBB@B@@B@@BB@@B@@B@@AB@@@BB@@@@@@B@@@B@B@@@@B@@@B@@BB@@@@@@AB@@@BABAD@B@@@BA@@@@@@B@@AB@D@@@B@@@BB@@@@B@@A@@@@B@B@BB@@@@B@@@B@B@@A@@@AB@@AB@BA@A@CB@@@@@BBD@B@DAB@@BB@@BB@@@BA@@BAB@@@BB@AFAFBB@B@B@@@B@@B@@@B@B@B@@@@B@@BB@@@BA@ABAF@@@B@@@B@@@B@@ABBBB@BBBDB@@B@BBB@@@B@@B@@B@BBD@@B@BB@@@BA@@@A@@@AB@@@@@B@BAHB@@B@B@@ABC@@@@B@@@H@HAB@B@@ADAD@@@@D@@@@B@@@D@@BD@@AB@@BF@B@B@B@@AB@@@BBD@B@BB@@@DB@@@@@B@@@B@@@D@@@@B@@@FBB@B@BB@@@BAB@B@@@@@@@B@@A@A@A@@AA@@@A@A@A@@@@F@B@@@B@DBB@@@D@@B@@BB@@@DADBB@D@@@BAB@B@BB@@B@@B@BB@@@@@@B@BADAB@B@@@BB@@@BB@@B@@B@@A@@DA@@B@@BB@B@BAB@B@@@@BBB@@BBFBDBBBBFD@B@@BB@BBBBD@@BBFBBBBBBB@@@@@@ABCB@B@@@B@BB@@@@@@BBBBB@D@B@@A@@BB@@D@@@@@B@B@B@@@BBBAB@B@D@@BBBDBD@D@B@B@B@BA@AD@@BB@DBD@B@D@B@@DF@B@B@@@BAB@@BBBB@@B@@B@@@@B@@BD@B@D@B@BAB@@@@@BBB@@B@@ABA@CB@@@@B@B@B@B@B@B@@BB@@B@@@B@B@@BBDBBBBBB@@B@BB@BB@BB@BB@@@BBB@BBB@@BA@@B@@@B@@@BD@BBB@@@BB@B@AB@@@@@B@@@B@BB@@BB@@BB@@@@BBB@@DDBD@@BB@B@BA@@BA@A@@@@@@A@AA@@@@@A@@B@@@@@@A@@@@@A@A@ABA@A@@@@@A@@@AB@@BBA@@B@@@@@@@B@@@@@@@@@@@B@@@@AB@@AAA@@@A@@@@@A@@@A@@@A@AAAA@@A@@@A@A@A@@@@@A@@@ABA@BBA@@@AB@@A@@@AB@@A@@B@@@@AD@@@B@@@B@@D@@@D@B@@BB@@@AB@@CF@@@B@B@B@D@B@B@B@@AB@@CBA@A@A@AA@@A@@@AB@@@@A@A@ABA@@@@BBD@B@B@@A@AB@@A@A@@@A@@@A@@@A@@BC@A@@@A@@@@B@BBB@B@BBFBB@B@@B@@BBAB@@BBB@@@B@B@@BD@B@BBAB@D@BABABC@@BA@A@@BAB@@@@@B@B@BBB@B@@BD@BB@@@@@@@B@@@B@@BB@@FBB@@B@B@@D@@@BBB@@@B@B@@@BA@@BA@@B@@BB@B@@@@@D@@AD@BAB@B@B@@B@@@B@@AFA@@BAB@@@BBB@BAH@B@@AB@B@BABAB@@@B@@B@@BBBB@@BB@BBBB@B@@@BBB@@@B@B@B@DABB@@B@@@BB@@@@@B@@B@B@@@@CB@BC@CBAB@@C@ABA@@@@@A@BB@BBBBDBB@DB@@BBB@@@@D@B@@@BBBBB@@@B@DA@@B@@BB@BBBB@@BBBADC@@@@B@B@BBBAB@@@B@B@@@B@@@B@BC@A@@B@BB@@B@@@@BB@@@B@@BBABB@AB@BA@@@@B@@@@@@ABA@A@@B@@A@AB@@@B@@A@@@@@@@@@AB@@@A@@@BAB@@@@ABA@@@@@@DB@@BA@@@@B@@B@A@@@A@@@@@@BA@@B@@@B@@@@@@A@@B@@@@@@AB@@@@A@CA@@@@@@AB@BA@@@@@A@@@ABA@@@@@@@@AA@@@@@@B@@@@A@@B@@@@A@@B@@@B@@@B@@@@@B@@@@@@ABB@A@@B@@B@ABBB@@@@@BBBA@B@BB@@AB@@@B@@@@AB@@@@@B@@AB@@@@@@A@@@@BA@@@B@@B@BA@@A@AA@@@@@@B@B@@@B@@AB@@@B@@A@@@@B@@@@@B@B@B@@@B@DB@@B@B@@@B@B@B@D@@@@@@@AC@@@@AA@A@@AA@@A@AA@A@C@A@@AEEE@A@A@@AA@@@@BA@@BE@A@@@AA@@A@A@A@@@@A@@@@A@A@@@AAA@@@@@AB@@@B@@AD@@A@A@@@A@@@A@AAC@@@@@AB@@ABA@A@@@@BAB@BABA@A@@B@@@B@BA@@DADA@@@AB@B@@AB@BCBA@@@@B@BB@B@@BB@B@B@B@B@@@@@D@@BBDDB@BB@@@B@@BD@@@B@BB@B@@@B@B@@@AA@@@@A@@@B@@@B@@@@@B@@@B@@@@@@@@B@@B@@@@@@@@AB@@AB@@BBA@@D@D@B@@@D@@@BB@@@@B@B@@@@A@A@@AABA@A@@B@@@@@@A@@A@AB@@A@AB@@@@@B@B@@C@C@A@DCDA@@BA@@AA@A@@DC@AB@B@@A@@@@AA@A@AA@A@@A@ACCAC@A@@@A@AA@@AAA@@@A@ABA@@AA@@A@@A@A@AAAACACAA@AAC@A@@B@BAB@@@BA@ADC@@@ADGDCB@@@DAB@@A@@B@@AD@@@B@BC@@@@@A@@BA@@@@@@B@D@@AB@BABA@A@@B@@A@@@A@@@A@@@@FBB@@@BA@@AA@@C@@@@@AAB@BA@@@@@C@@@@BA@@B@@@@@BBBD@@B@BB@AD@DA@@B@@A@A@@AA@@@AA@AA@@@@B@D@@AB@@AB@@@B@B@B@@B@@@@@@BB@@@@BC@@@@@AB@@@B@@@B@@@B@@@BBB@B@DBB@BB@@B@B@@@BB@@@B@@B@@B@A@@B@@@@AB@@@BBBB@B@@@@@@@@B@A@@A@@@@@AB@@@B@@@BB@@@B@BA@@@B@@@B@@@@BB@@@BA@@@@B@@@@@B@@BB@@B@@@B@B@@A@A@A@@@@@@A@@A@@AA@@@@B@B@@B@@@B@@@@B@@B@@@@BB@@@@@B@@@@BBB@A@B@@B@@@@@@@@@B@@@B@@@@@@@@@@@B@B@@@@@@@@@@@B@@@@@@@BAD@@@B@@B@@BBD@FBBBB@@@@@BB@BB@@B@@@B@@@BB@@B@BAB@B@@@B@@BBB@@B@D@@@B@@@BAD@@AB@B@@@@B@@@@@B@@@B@@@BB@@@A@@@AB@@@B@@B@@B@B@BBB@@@@B@@@@B@@@@BB@@@B@BB@@@@B@@@B@BB@@AB@@@B@BBB@@@BBB@@@B@@BB@BB@@BB@@@@@BF@@@B@@@@AA@@@AA@@AA@@@@@A@A@BBB@ABBB@@@@@B@@@@@@@D@BDAB@@BB@B@B@B@@@BDDDD@@BBABB@@BB@@D@B@FBB@@BBB@BBBBBB@@BBB@@BB@@BA@@BA@@B@B@@@@AB@@AFBBBB@DAB@B@B@D@D@B@B@BA@@@AB@BAB@FAB@B@@AA@@@@A@A@@BA@@BA@AB@@A@ABABC@AB@@@B@@@BB@B@@D@@BB@ABAD@@BB@D@@B@@@B@B@B@B@D@BA@@BA@@B@BA@@@@B@@@B@B@F@@B@B@@@@BB@DBBBB@@@@B@@@@@B@BB@@D@DB@@@@BBBB@@@A@@B@@BB@B@D@@@B@B@@AB@@@B@@@@BB@@B@@BB@@BA@A@@B@@@B@@@@@@B@D@@@BAB@@CB@@B@@@A@@@@@D@BBB@@@BB@@@@A@A@A@@@A@@@@@@@@@@@A@@@@@@@A@@@@B@B@@@@@A@@AB@@AA@@@@@BB@B@@@BBB@@@@@B@@@B@@@@@BB@BBAB@@AF@B@@B@@@@@ABABA@@B@@@B@B@BAB@BABA@@B@BAB@D@@@@@@AB@@CBA@ABA@A@@BA@ABABA@@@AA@@@BA@@@A@AB@@A@AB@@A@C@@BA@@@A@@@@@A@AA@@AB@@@ACAA@A@@@A@@@A@@@A@@@@@A@@@@@@BA@@@@@@B@@@@AB@@A@@A@@A@@@@@@@A@@@@BA@@@@@@@@@AB@B@@A@@BBB@B@B@@@BA@@BB@@BADA@@@A@@@A@@@AA@@@BCB@@@@AB@@@BA@@@@@A@@@A@@@@A@@BA@@B@@AA@A@@@@@@@AA@@@@@B@@AB@@A@@B@@@B@@@B@B@B@@@@A@@BA@ABA@@@@B@@@B@@@B@@AB@BA@@@@B@BB@@@@B@@@@@@A@@CA@A@@@ABA@@@@AA@@@@BA@@B@D@@@@@@@@A@@B@@@@@@@@@@A@@@AA@@A@@@@@AB@@@DBB@BA@AHA@A@@@A@@BA@A@A@ABA@A@@@@A@CAE@A@AAA@@@@A@AA@@@BA@A@A@@@A@@@@@A@@A@@A@@@A@AA@BA@@@AB@F@BA@@@@BA@@@@BA@@@@B@AA@A@AB@@@@@@A@@AA@@AAA@AA@@AAAB@AAB@AA@@@@@@A@AB@@AAC@AB@BA@A@@@@BA@@BA@@BA@@BA@@@AAA@AB@@@BA@@@A@@BA@A@A@@BA@@@AA@@@@@ABA@@BABA@A@@@A@BA@@A@@@@@@AAA@AA@@@AAA@@@@@@@BA@@D@BA@@@@@A@@A@A@A@A@C@@@A@CAA@A@@@AAAA@@@@@AB@@A@@@@DAB@BA@AB@@@@ADAB@BA@A@@B@BADA@@@@@@@@@@@D@@@BD@@@@@@@@BA@@@A@A@@@A@@B@B@@BB@@@B@@@@C@@@@@AB@B@@BB@B@@@@B@B@@@@@AA@A@@@@BAB@@@B@@A@@AC@@@AB@@BB@@@BA@@BA@@@A@A@@@AA@A@@@AA@@B@@@B@B@@@B@DABAB@@@BA@@@@AA@@AA@A@@@ABA@A@A@A@A@A@@@A@@@A@@@@@A@@@@AC@@BA@C@@@@@@@AA@A@A@@@@A@AA@@@@@A@@@@AA@@@AA@@AB@@AAA@@A@AA@AA@A@@@@@@BA@@BB@@@@BAB@@AB@@@@@@@B@@@B@@@B@@@BBB@D@B@@@@B@@@@@@@@A@@B@@@@@@@@B@@B@@BA@@B@BA@@@@@A@@A@@@AA@A@A@@BAB@@@BB@@B@@BBA@@BB@@@AB@@@@@AAAA@@@@@A@@@@@@B@@@@A@@B@@AAAB@@@B@@@B@B@BB@B@@BA@AA@A@@@@@@A@@@@CA@@B@@A@A@C@A@@@@A@@@@@CD@@AB@A@@AA@@A@@@@B@@BB@@B@@B@@E@@@@@@AA@A@@A@@BC@AB@@@D@@AB@@A@@@@BC@@DA@A@@@CAA@@@@@ABA@@@@@@@A@@@A@AB@BA@@@@B@BB@@D@DB@@F@B@B@@@B@@B@@B@D@@BBBBBBB@B@@@@AAAA@B@@@BBD@@B@DBBBB@B@@@@@B@B@@@@@B@B@@@@@@@B@@AB@@@B@BB@@BA@@@A@@@@@AB@@A@A@@@@A@@@AA@AA@@A@@@A@@B@B@B@B@@@D@@@@BBB@BB@@@@B@B@AB@BA@@@C@ABA@A@@@@@A@A@C@@@A@@B@B@BB@@@@B@@@@@BA@@B@@@@AAAA@@@@@B@@@@A@@@A@@@@@@AA@@BAB@@A@@@@@@@@BAB@@A@@@@BA@A@@BA@@B@@@@ADC@@BAB@@AB@@AB@B@@@@ABA@@B@@A@@@A@AB@BA@@@@@A@@@AB@@@BA@@BA@@@@BA@@AA@@@@A@@A@@@AB@@AB@@@BB@@B@@AB@@@D@@@@B@@@@@B@@B@B@B@B@@@@@@BB@@@@@B@AAB@A@B@@@@AB@@AB@@@BA@AA@@AB@@A@@@A@@B@@A@@@@BA@@@A@@@A@@@A@@@C@A@A@@@A@@B@@AA@@@A@AC@@BABA@@DA@@BAB@BA@A@@AAB@@@BA@@AABAACBAA@@@@A@BA@@A@@@@AAAC@@@A@@BC@A@AA@@A@ABA@@@ABCBAB@DABBB@BBB@@@@@@@AA@A@A@A@@@AAA@@@A@A@A@@A@@A@A@AB@@A@A@@BA@A@A@A@A@@@AAA@@AA@@A@@@@A@@@@@A@@A@@A@@ABA@@@@A@BA@A@A@@@@A@@A@@@@@A@@@AB@BA@AA@@@BA@@@@@AA@A@@@A@ABA@A@@@A@@@AB@@CBA@@A@BAA@@@AA@@A@@@AAA@AAAA@@AA@@CA@AA@@@AA@AAA@@@A@@@A@A@@@C@@BA@@@@@A@@@@@A@A@@@@@@@A@@BA@@@AAAAA@@A@EA@@@@A@@@A@A@A@@A@@AACA@@@A@@@A@@@AAC@@@AB@@A@@B@@@A@@@@@CA@@AB@@@B@BA@@@A@@BA@A@A@@@@@AB@B@@@@C@@@A@@@@@@@A@AB@@@@AB@B@@AA@@@@@B@B@BA@AA@@@C@AAAAAACCEAAAC@ACAA@A@@@A@CBA@A@@@A@AB@@A@@@E@A@A@A@@BC@A@CBC@A@ABCBABA@@@ABE@ABA@A@A@A@AB@@A@A@@@A@@@A@@@ABA@AB@@@@A@AB@@AB@B@@A@@@@BA@@@ABAB@@C@@@A@AA@@@B@BBB@@AD@B@@A@@B@DA@@B@@ABA@@BA@@@AB@@AB@@@@CBA@@@@@AA@@CBAB@@AB@@@@@@@BA@@BA@@@A@@@AACA@@@@@C@@A@@@A@@@A@A@A@A@@@A@A@@@CB@A@@AB@AAB@@@@A@AB@B@@@@A@AA@@CB@@CB@@A@A@@@@@@@@B@@BB@@@B@BBB@@A@A@@@A@@@A@A@@@A@@@AA@@A@@@@@A@@@A@CA@@A@AB@@@@A@@@@@A@A@AAA@@@@AA@@@@@A@@@A@A@@A@@@AAA@@@@A@@@@A@@AA@@@@@A@@AA@A@@AA@@AEAA@@@A@@@@AA@@@@A@A@@@A@@@A@@@A@AA@BA@@@A@C@ABA@@@A@@B@@@B@@BB@B@@@BAB@BBB@BB@AB@@EB@@@AAAA@@@C@@@@@@ABC@@@A@A@C@A@@ABA@@@@@AAA@A@@@@A@A@@AAA@A@@@@ABC@C@@@@@AA@@AA@@@@@@@A@ABAA@@@@A@AAAA@@A@@@A@@@AAA@A@@AA@@@@@A@@@@A@@@@@A@@@CA@@A@@@@DA@@F@HB@A@@@A@@C@@@@A@@@@AA@@@@@A@A@@@@A@A@C@A@@@BAB@@AB@BA@@@@@A@@B@@A@@@@B@@AD@@A@@@A@@@A@@B@@A@@@A@AB@@@AA@@@A@@@@@@@AAA@A@@@ACBA@A@@@@A@A@@@A@@AA@@B@@AAA@C@@@A@@A@@@A@ABABA@C@@@@@AB@@@B@@@@A@A@@@@@A@A@@BAB@@A@@AAA@@@A@@@AAABA@@@@@@@AA@@AB@AA@@AA@A@@@A@@@AA@@A@@@A@A@@@AB@@@D@BA@@@@B@@@B@BAB@BA@@B@@ABAB@B@@A@@@@@A@@@A@@@AB@@@@A@@@@@AAAA@@@@AAAB@@@@A@@@@AA@A@A@AB@@AB@@A@CBA@@B@B@B@@@@@B@BCB@@A@@@AA@A@@@@@@@AA@@@ABA@@@@A@@@A@@@@@@@BABA@@BA@@D@@@@@@A@A@@@AA@@@A@@@@BA@A@A@@@A@@@AAA@@@@@@@A@@@A@@@A@AAA@@AA@@@@@BA@@AA@@@A@A@@BAB@@A@@AAAA@@@@AAA@CAEAEA@@@A@@@A@@A@A@A@@@AB@@@AAA@ABA@@AAAA@A@@@A@@@A@A@@B@@BB@@@@A@AAA@@BA@@@A@@BC@A@A@A@@@@AA@@@A@A@@@A@@B@BA@@@@@A@A@CAA@@BA@@@@B@BA@@@@DABA@AB@B@BA@@@AA@AAA@@AA@@@A@@AAA@@@@C@AA@@@AAAAAA@AA@@BAA@A@@AA@@@CBA@ADCBCD@@ABAB@@@@A@@@ABA@A@ABA@@@AC@@CAA@@@AB@@A@@BAB@@A@@@A@A@C@@@@@AB@@AB@@@@A@AB@@@@@AAA@@@@@@ABAB@@A@ABA@@BA@@BA@@BA@@@@@ABAB@@A@AB@@AB@@A@@BA@@@@BA@A@A@@@@@ABC@@BA@AD@@@@C@@@ABABABA@A@@BCB@@A@A@@B@@AB@@@BAB@@@@A@A@@A@@@@BA@@@A@ABA@@@AB@AAA@@@@C@@@A@@@AAC@@@AB@@@@A@@@@@A@@@A@AAAAC@CBA@@@ABA@@@@BAAA@@AAA@@A@@@A@@@@@A@@B@@@KE@@A@@A@A@A@@@@@C@@@@AAA@@A@@A@@@@@AAAAA@@@A@@B@@@B@BABB@@@@B@@@@@DC@@B@@@BA@@@@B@@@BB@@B@B@@@@@@@@B@@@BBA@ABA@@@@B@@@@@@AB@@ABA@@@CB@@A@AA@@AB@@A@@BAB@@C@CB@@A@@@A@ABA@@@@@@A@@AAACAA@A@@@AA@@@@AA@AA@@@@BABA@A@@@@@AA@A@A@@@AA@@AA@A@A@AB@@@@A@C@AAA@AA@@@@@@A@@@A@@A@@@CC@AAA@AA@@A@A@@@@A@@@A@A@@@@@A@@@@BA@@B@BA@@BA@@B@B@@AB@BA@A@@@@BCB@@C@A@A@@@A@@BA@@@A@A@E@A@ABA@@@@A@@@A@@@AAAA@@A@A@A@CAA@@@@@@@AAA@@A@@@C@@@@AA@A@@@@@A@@@AAA@@@@@@AAC@A@AAC@AAAAAAA@A@@@A@@@A@A@AA@A@@AA@CA@@@@C@A@A@ABA@ADC@A@A@A@A@@@@DAD@BA@@BA@AB@@C@AAAAC@@@A@A@@@A@@AA@@A@CA@@A@@ACA@@@A@A@@AE@@A@@A@A@@@AAA@A@A@@@@@A@@@A@@@AB@BA@@@A@@@@@@@AA@AC@A@AA@@@C@AA@@AAA@@@@@A@@BA@@@A@@@C@@AA@@B@AA@A@@B@BA@AA@BA@CBA@@@AB@@C@C@A@@@AAA@A@@AA@@@@@AA@AA@@@A@C@@@A@@@@@@BA@@B@@A@@@AB@@A@@@A@@@@@A@@@A@@@A@C@@@AA@@@@@A@A@@@@@A@@@@@@@AB@@@BCBAB@@A@@@AAAA@@AA@@AA@A@@@@A@A@@A@@@@AB@@A@ABA@@B@BA@@BA@@@@B@@B@@BA@@@A@@BA@@@A@@@A@@BA@@@ADAB@B@@A@CB@@A@@@A@A@A@@AA@@@@AAAA@@A@A@@AA@AA@@A@C@A@AA@AACA@@A@@AA@@@@A"],encodeOffsets:[[119460,25882]]}},{type:"Feature",id:"350881",properties:{name:"漳平市",cp:[117.419998,25.290184],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@AB@@@@@AA@@@A@@@@AA@AAA@@@A@@@ABA@@@A@@AACC@A@@AA@@@A@@A@CC@AA@AA@@A@@A@@@A@A@AB@@A@@@@@AA@@@A@@@A@@@AB@A@@@@A@A@@B@@AAA@@@ABA@@AA@@@@D@@A@@@AB@BA@@@@B@@@B@@@@A@@B@@@BA@AB@B@@@D@@@@A@A@AB@@@@A@CBA@@@A@AB@@@@@@A@@@A@@AA@@@ABEAABA@A@@@CB@@ABA@ABA@AAC@@@AA@B@@@B@@@BABA@@@@B@@@B@B@B@@@B@B@D@D@B@B@@@B@@@B@B@@@@BB@@@@BB@@@@B@D@B@@@BB@@@B@BBB@D@BBB@B@@@B@@@@DBDB@@B@B@@B@@@BB@@@@@BAB@@@FBBB@@BB@@@B@BBB@B@@@B@D@@@@@B@@@@@B@@@B@@@@A@@@ABAB@@A@ABA@@@A@@BA@@@A@A@@BA@ABA@A@A@ABAB@@A@@@A@A@@@@@AB@BAB@B@@@B@@@B@@AB@@@@AB@@@BAB@@BB@@@@@@BB@@@B@@@@A@@B@@A@ABA@@AA@@BA@A@CBAB@@A@@BBB@B@@@D@@AB@@BB@@@B@@@B@@BBB@@BB@@BB@B@B@@BB@B@B@@@@BBB@@@@@@AB@@@@AB@BAB@B@B@@@@A@@@@B@@@@AB@BA@@B@@@@@BB@BBBB@B@D@@AB@B@@BB@BBB@B@@B@BBB@B@@@BB@@AB@@@B@B@@@B@B@B@B@D@@@B@@BB@@@BBB@@@@@B@@B@@B@@@@@B@@@BB@@@@D@B@@@BBDBB@D@B@@@@@B@@@B@@BBB@@B@@@DBBBB@D@@BBD@@@BB@BBDDB@@B@@BB@@@B@@@BDB@@@@@BB@@BBB@BB@@B@B@BAD@@@B@@@B@BAB@@@B@@@@@B@@A@@BAD@B@@@@@@BA@@BB@@@B@@@@@@B@@B@BB@@@@AB@@BBB@B@@B@@@B@@@B@BA@@BAB@@A@@@@@ABABAB@B@@BB@B@BB@BDD@B@@@@BB@F@@@BB@@BBBBDBBBB@DB@@B@@@B@D@@@@B@D@@AB@BABA@@B@@@@ABB@ADB@@BBBBB@BBDA@@B@BBBABABABA@A@@@@B@@@BBB@@@B@@BB@@B@B@@A@AB@@@@@@B@@@B@@@BBB@@@B@@AB@@A@@B@@@B@@A@@@@B@@BB@BA@@@@@@B@@DBBB@@@B@@B@@@B@BB@@BA@@@@B@@@@A@@DC@@AA@@@@@ABBB@B@@@@BBB@B@@@B@@@D@D@@@@A@AB@@@@@@@@@B@@B@DB@@BBDB@BB@@BAD@B@@@D@@@@@B@@@@B@B@B@BA@@@@B@@@B@@@B@B@B@@@DBBF@@BB@B@@@B@@AB@B@D@B@B@@@@A@@@A@ABA@ABA@ABA@@@A@E@CBA@@@@BCAA@A@A@CBA@AB@@@@ABAA@BA@@@@FAB@DAB@B@@@B@@BB@@@@@BA@AB@@@BAB@B@@BB@BBB@@@@B@@@B@BDD@FBB@B@BBB@@@BDB@BBDB@BB@A@@B@B@@@B@@B@B@B@@@@D@BAB@@@B@BA@A@A@A@A@A@CBC@@@@@AB@@AAA@@@A@@@@@@B@B@B@@B@@@BD@BB@@@@@@B@@@B@@BB@@@@@@AB@@@@BBBBBB@@BB@@@BB@BB@B@@B@@B@@B@@@BBB@BBBBBBBBB@B@@BBB@BBBB@BBB@@@D@@@B@BDB@B@BBF@B@BB@BDB@@@BB@@BB@BBB@B@@BB@BB@@B@BBB@@BB@@B@@B@@@B@@@B@BBB@BB@BB@BADAD@BABA@@B@B@@BD@BBB@B@@@@@BC@@B@@@B@BBBBB@B@@B@BBB@BB@@@@@BBBBDB@@@B@@@B@DBB@@@B@@@BA@@B@BA@@@@BA@@@A@@@@@@B@@@@CB@B@@@@BBB@@B@@BB@@BD@@B@@@B@BA@@@@B@@BBBBB@B@@@@BBAD@B@D@F@B@D@@BF@@@@AB@@A@A@ABA@A@ABAB@@@@AB@@A@CAA@@BCA@B@BA@@@ABA@@B@BA@@BA@@BB@@DB@@B@@B@@@B@BBBB@@B@@B@@BB@@@@@@@BA@@B@F@@A@AB@@AB@@@B@@BBBDBB@@@@@BA@@DAB@@@BABA@ABA@ABAB@B@@A@@BB@@@@BDB@B@BBB@@@@BBD@@@B@@@DB@@B@B@@@B@DB@@B@B@@@BBBB@B@@BB@BBB@@@B@B@@@BB@@BB@@B@@B@@B@@AB@B@@A@AB@@@BA@@B@@@@A@@BAB@@AB@@@B@@@BABABA@@@A@A@@@A@AAA@@AA@CBA@A@@BB@B@B@@@@D@B@BB@@B@@ABA@CB@@AB@@@@BBB@BBB@@AB@BBBADAB@@@@BB@@B@@@BB@@BB@@@DBB@BBB@B@@@B@@@@@@DBB@@@B@B@@@B@DAB@@ABABAB@B@B@@A@ABA@A@C@@@A@@@A@A@A@@@@@@@@@@BA@A@@AA@@@@@@@@BEB@@A@@@@@@@G@@A@@A@@A@B@@CBABCBABA@@B@@AB@BA@@@A@@@A@ABA@AB@@@B@@@B@@BB@@@BB@@B@@@B@@@B@BB@@B@@@@DB@BBDDD@B@@@B@@@@@BA@@B@B@BB@@B@@@B@@@BBB@@@B@B@DA@A@@@ABAB@BABAD@@A@@@@B@@B@@@B@@BBABB@@@@B@@@@B@@@DBB@B@@BBB@@B@@@@A@ABCD@@@BA@@@@BAB@@AB@B@@@@@@@BA@@@BB@@AB@@@@ABE@@@@BAB@DA@@@@B@@A@AB@@A@@B@@AF@BBB@@@@B@@BB@BB@BB@@B@@@BBD@@B@@@B@@@B@@@@@B@@@B@@@BA@@@@@C@@BA@@BC@@@B@@BB@B@@AD@@@BB@@BB@@@@B@BAB@@@B@BBB@BB@BBB@BB@@BBB@BBB@B@@@B@@@A@AD@@@@A@@AA@@@A@A@A@AA@@@@AB@@@BA@@@A@@@@BA@@@A@@B@@@@@@A@A@@@@@AB@@@@@BABAB@D@@AB@@EB@@A@@@@BE@ABA@AB@@AA@@A@CB@BBB@@BBBB@BABA@@@AAAAA@A@@@@B@@@@@@@BAA@BA@@B@@A@@@@BA@AB@A@@@@AAA@@A@A@@B@AAB@@A@@AA@@A@A@ABAA@@AA@@C@@@A@@BABA@AAA@A@@@A@A@@B@B@@@D@@@@@@@B@@@@A@@BA@@B@@@BAB@@@@A@@@@@@B@@@@DB@@BBB@@@@B@@@BB@@@AB@BA@@B@B@@@@@@@B@@@BA@@B@@@@@@AB@@@BA@@@@@A@@BA@@@A@@@@B@BA@AB@@C@@@@B@@@@BBBB@@ABB@@@BB@@AB@@@B@B@@ABABA@CBA@CBA@@B@BAB@@@@@@@B@B@B@B@B@@AB@BA@A@A@@@AAC@@@@B@@@@BB@B@@@@@B@BAB@BA@CCCACA@@@@ABAB@@@B@BDB@@@@@@A@A@AB@@C@@@@A@@@A@@@A@@@@@@@AB@@@AA@@@A@@@@@A@@@AA@A@@AA@@@@D@@BB@B@@@B@@@@@B@@@@AB@BA@@B@B@B@@@B@B@@A@@@A@@BA@@@@@A@AB@@A@@@AAA@@@A@ABA@A@@@A@A@@@A@@@CAA@A@A@A@@BB@@B@@@BB@BB@DB@@@BB@@B@@BB@@B@@@BBB@BB@@@@D@B@B@DBB@B@@B@@@@@@@B@@@D@BBB@@BB@@BB@@@B@@@B@@A@@BA@CB@@@B@@A@@@B@BBB@BB@B@@@@@@D@@B@@@@@B@@B@@@BAD@B@@@B@B@DAB@@@D@B@B@BBDB@BBB@@@@@@@BA@@B@B@BABA@A@AB@@BB@@BB@B@@B@B@@BBBBB@B@BB@@@@C@A@@B@@@@B@@B@@@B@B@BABAB@@AD@@@B@B@@B@ADB@@A@B@@@@@@@@@@@@@BBB@@@@@B@@B@@AB@@@BA@@@A@@B@@BB@@@BDB@@B@@AD@@BBBB@@@@ABABA@AB@@ABA@AB@@@B@@@@B@@B@@@B@BBD@@AB@@BBB@BB@BBBBBB@@B@@B@@A@@BA@@@ADBBAB@B@D@@@B@@B@B@B@@DB@@HBBBB@B@@@@@AB@@@@@B@@@B@B@@@B@@B@B@B@@B@B@@D@B@B@B@@@BA@@B@@@@BBB@@DD@@@BD@@@B@BBBB@@@AB@@@B@@@BA@@B@@@@BB@@@BADB@@B@@BBB@@@AB@@@BBBB@@@@@B@@AB@@A@@BA@A@@@@@AD@BAB@@ADA@BD@@A@CBA@AB@BA@A@C@C@AAC@@@A@@AA@AB@AA@AAA@@@A@@B@@CBCD@BA@@@ABA@A@@A@@A@BA@@@A@@@@E@A@@BA@@@AD@BA@@@A@A@@@A@@@@B@@@@ABB@AB@@A@@@A@A@@@@@AB@@A@AB@@B@@BB@@B@@B@B@B@@@B@@@BB@@AB@@D@BBB@@@@@@@@B@B@@@@@@BBDB@@BBB@DB@@BB@B@B@B@FA@BB@@@@@@@BB@@B@@@@@@@B@@@B@@@@@@@B@@@B@@BDB@@@B@FBB@@@@@BBB@@B@@@@AB@@AB@@@B@B@@@B@@@B@@@@@DBB@B@B@@@BBBDA@BB@@BBAB@@@B@@@B@B@B@B@@@B@DDB@@B@@BBABB@BB@@@BB@B@@@@B@@@@@@@@@BB@A@B@@@B@@@@BB@B@@@@@B@@@@A@@B@@@BAD@@AB@@A@@BAB@@@BBB@@@@B@@B@B@B@@@B@B@@AB@BBB@D@@@BB@@B@@@@@B@@@B@@@D@B@@@BD@DBB@@@@@BA@@@@BB@BB@AB@B@B@B@@@B@@@BF@@@BB@B@BB@@BBB@@@D@B@@BB@BADAB@@@@@DB@@DA@A@@B@@@@B@B@B@@@@AB@@@B@@@B@@@B@D@B@@@BABA@@B@@@BABBB@@@B@@B@@@@@@B@BAD@B@@@@BF@B@B@@B@@BB@@@B@@B@@@B@B@@@BBB@B@@@BB@BBB@@@FBBBB@@@BABA@@BA@@B@DAB@DA@@B@@@B@@BDB@@BB@@BA@@BA@@B@@@B@B@B@B@@@@@BABA@@BABABA@A@A@A@@AA@AAE@C@A@@AA@@@ABA@AB@@@B@BAB@@@BA@A@ABG@C@A@A@@B@ECAA@AC@AAAAA@@@@ABA@@B@@ABA@A@@@@@A@@@@BABA@@@@B@@@B@@@BA@@@@@A@@@@AAA@@ABA@@@A@ACCAC@@B@@AB@FADABA@AB@@@BA@@@@@CB@@A@@@@B@B@B@@B@@@@@BAB@B@BDD@@@B@B@B@B@@ADAB@@@@CDAB@@@@B@B@BB@@B@@@BBB@B@@@B@@@@@AA@@@C@@@@@A@@@@BC@@BA@C@A@@@@BA@A@A@@@ABA@@@EAA@@@AAA@@@@BAAA@A@@@AB@@C@C@A@@B@BBBAB@B@@A@A@@BA@@@A@@A@@@@@A@A@@@AA@@BA@A@@B@@@AABA@@BA@CA@AB@@A@AB@@@@A@@@A@@@AB@AB@@@@@@AB@@@B@@A@@@A@@@A@@@@A@@A@A@@@A@A@C@@BAB@BADC@A@AAA@@@A@A@@@A@@AA@@@@AB@@AA@AA@@AA@@@@A@@@A@@@@@A@@B@@A@@@A@@BABADA@@B@@@@AAA@@@ACCC@@@A@@AAAAAG@@AAA@@AA@@@@B@B@BB@@BA@@B@DBB@@@BA@@BA@AB@BABA@@BBDBB@BBDBB@BA@@B@@BDBBB@BB@@@B@BAB@B@B@B@B@BBFD@@BAB@BBF@B@B@@BB@B@B@@B@B@@@@@BB@DB@@@B@BB@BBB@@@B@@@@BBBBB@@@@@@@BA@A@@B@@@BCB@@@@@BBB@BDF@@BBB@BB@@B@B@@B@@@@@BA@@BABA@@@@BAB@@@@@@DF@@BD@@BB@@F@@@DBBBDB@BB@DB@B@@@D@B@@@B@@AA@@@@AA@@@@@A@@@@@@AD@@@BDD@@@B@B@@AB@@@@BBB@BB@@@B@@B@@@@B@@@B@@@@B@DB@@B@@BB@@@B@@@@@B@D@B@@BB@@@@ABB@B@@@@AB@B@@@B@@B@@B@D@@@@@@@B@@AB@@@A@@@@@@@BA@@@@@@@@AAB@@@@@@@B@@@@@@A@A@@@@@@@A@@BB@@BA@@@@@A@A@@@A@A@@@@A@@AA@@A@@@@@A@@@@@@@AB@@A@@@@@AD@B@@@BBBDBD@BA@@@@@A@@B@@@@@@@B@D@HDDBB@@@B@DB@@BB@@@@@@A@CBA@@@BBD@B@B@@@@@@@@A@@AA@@B@BB@@B@@AB@@@B@B@B@B@@AB@@@@@@A@@@ABA@@@C@@BAB@@@@AB@@@BBB@BA@@BB@@B@@@DDB@BBB@@AB@B@@A@@@@@A@AA@@@@@@@@@@AA@@@A@@@@A@@@@@AA@@@AA@@A@@@@AA@@@A@@@AACC@@@AB@@@@@@A@A@@B@@AB@@@@A@@@@A@@ABA@@@@B@@@BA@@@ABA@@EC@ABA@@@A@AAA@@@@@@@AD@B@@@B@@BB@B@@AB@@AA@@AAA@A@@BA@@@@@A@@BA@@BBB@@B@@BB@@BBBBDB@@@D@BB@@BB@B@@B@B@@BB@@@@@B@@@@BB@@B@@BB@@@@BB@BB@BB@@@B@@@@ABB@@B@@BD@@@@BB@@@B@DB@@@@B@B@@@BAB@@B@@BB@@@B@@@B@@@@FBB@@@B@@@BAB@BA@@BA@A@@@@@AB@@@@A@@@A@@@AA@@@@@@A@@@AB@@A@A@@@@@A@@B@B@@BBA@@B@@A@@@@@ABA@E@A@AA@AA@@@@@AD@@@D@B@BAB@@@B@B@BAB@@@@@@@@@@@B@@@AA@A@@BA@@@@DAB@@A@@D@@@@@BA@@B@@@@@BBB@@@@@@@BA@A@@BA@BB@BB@@@@B@@@@DB@BB@@@@@@B@@A@@D@@@B@@@BA@A@@B@B@@@@@@@BAB@@@@ABA@@BC@A@A@A@A@@@@@ABAB@DABA@@@AB@@@@@BA@A@@B@@ABAB@@A@@@@@@@A@AB@CC@@EA@@AACA@@A@@A@@@A@@@@@A@@@CB@@@@BBA@B@@@A@@@A@@@A@@B@BAD@@@B@AA@C@@@@@A@@BAB@@A@AB@@A@A@AAABA@@@@B@B@B@D@B@B@BA@BB@@@B@@B@B@@@@@@B@@@@A@@@@BA@@B@B@@@B@@B@BB@@@@@BAB@B@B@BDB@B@@BB@@B@@B@@B@@@BBBB@B@@@@B@@BB@@BADBB@BDB@ABAD@@B@@B@@@ABA@@@@@@B@@@BA@@B@@A@B@B@BA@@@@BBB@BD@BBBB@@@@B@@@D@@@BA@@@@BA@@@B@@BB@@B@B@@@BBABB@@BB@B@B@@BB@AB@@B@@B@@B@@@B@@A@@@@BABA@CBAD@BA@@BC@A@@@AB@@@BADA@@D@@AB@@A@@@C@@A@@AA@@A@@@@@AAA@@@ABAB@@@@@B@BA@AA@@@A@A@@@@@A@@@A@@@@@@A@@BA@@@@@A@A@@AA@A@@@A@AAA@AB@@@@@@@B@@AB@@BB@@@B@@@B@B@@@BAB@@@@A@@@@@AB@@@@@@AB@@@BA@@@@B@B@@@@A@@BA@@@@B@BA@@@@B@B@@@@@BA@@@AB@@AB@@@B@@@@@BB@@@@@@B@@@@@BB@@B@@@@AB@@A@@BA@@B@@@@A@@@@BA@@DC@@@A@@B@@A@@@@BA@@D@D@B@@@BA@A@A@A@@BA@@BA@AB@ACB@@AB@@AB@@@BA@@B@B@B@AA@A@A@A@@@A@@A@@@@C@@B@B@@@B@B@BA@@@A@@@A@@@@@AA@@AAC@@@A@@@AB@@AB@BABAAAB@@@@A@@B@B@@BB@BB@B@@@BB@@@@@B@B@B@B@@AB@B@@A@@@@@@B@D@B@@B@@BBBB@@BBB@@BB@@@BB@@BAA@@A@A@A@A@@D@FB@@B@B@BA@@@A@@@A@@@@B@@@B@@B@D@B@@@BB@@@@@B@@@B@B@@@@B@@B@@@@A@@@A@A@@@@@A@@@AB@@@@A@A@@B@@@@AAA@A@AAA@@CA@A@A@A@A@@CA@@BA@A@@B@@@CCA@@A@ABA@CAA@@@@@A@AB@AA@A@@@@@B@@C@AA@@A@@@AA@@@@BC@A@@A@AA@@@AA@A@AA@@@@@@AAB@AABA@@@A@@A@@@CD@@AA@@AA@A@A@A@@A@@A@@@@@A@@A@@@@@AA@@A@@A@@@@@A@@@AA@@@A@@@A@@@ABA@@@@B@BA@@@@B@B@AA@@@A@@B@@CDCD@B@@A@@@@@A@AB@@BBA@@@@@@@A@A@@@@@A@@@A@@@@@@B@@@D@@@@@@CA@AA@A@A@@AAA@@CC@BA@AB@@A@AE@AA@@A@AAAAI@A@@AA@@@AA@@CBA@AAAA@@AAA@@@AAA@@@C@A@A@AA@@A@@@A@@AEA@@A@@A@@@@AAA@A@A@@@@BAB@@@@A@@@@BABABAB@@ABC@@BA@@@@BB@B@@BBB@@@BBBB@@D@B@FFBA@ABA@@B@@@@AB@BA@@B@@@B@DCB@@AB@@@@@B@@@@@@BBB@@@@BA@@B@@B@A@C@C@@@A@@@AB@@A@@@AAA@@AA@@AA@A@@@A@AA@@@BC@@@A@A@A@@B@@A@@@AB@@@@@@G@A@ABAB@BA@@@AB@B@@@D@@A@@@@A@AAA@A@A@C@@@AAA@AA@@@A@@A@AA@AA@A@@A@@A@AA@A@@@@@AAB@@A@@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@@@AA@@@A@@@@@@@A@@@A@@@@BA@@@A@@BAA@@A@@@@A@@B@A@@A@@@@@A@@A@@@A@@A@@@A@C@A@@@AAA@@@A@@A@@AA@@@@@AA@@CBA@A@@@A@A@A@@@AAA@@@@AA@@@AA@@A@@AA@@@AB@@@B@@@B@@ABA@@@@@A@@@A@A@A@A@ABABA@AB@@@@B@AB@@BB@@@B@@@@B@BB@@@B@B@@A@@@@AA@A@@A@AAA@@A@@@A@@@A@@@AAAA@@A@@@AA@AA@AAC@A@@@A@@A@@@@A@@AC@AAACAAAAA@@@C@@AAC@AA@@@ABA@AA@@@@@@A@@B@@AB@@@B@@A@@@A@A@AB@@@DA@A@@@@B@@ABAB@@@@A@AA@@AA@@A@@@C@@A@@A@@@@@A@@@A@@BA@@B@@@B@@@@C@@@@@C@@@@@A@@@A@@@@BA@@B@@A@AAAAA@@@A@A@A@@@@AA@@@AA@@@@@@A@@@@@@@A@@@@A@@@@A@A@@@A@@@AB@@@@@A@@@@@@AA@@@@@AB@@@@@@@@@@E@@@A@@ACBA@@@@@AA@@@A@@AA@AB@B@BA@@@@@@BA@AB@@@@@@AB@@A@@@A@A@@A@@AA@A@@@@@@@AAAA@@@@A@A@@@A@A@AA@@EA@AA@@AA@A@@@CAA@@@A@@@@@A@AA@AB@A@@A@BA@@@AAAA@@@A@A@@AA@A@@@A@A@@@@@@AA@ABA@@AAACA@@A@@AA@A@@AC@A@@A@@@CAAAA@@@A@@A@@@@@AA@A@AA@@@@A@AA@@@@@AC@@A@@@@A@@@@@A@@@A@AA@@A@@@A@@@A@A@@@@@@A@@@A@@@AA@A@AAA@@@A@AA@@@@@A@AA@@@A@@@C@A@@AA@@@@A@@@@@@@AA@@A@@A@@@@@A@A@@@AAA@@@@@@BAAA@@BA@@@@@A@AB@@@@A@@@@@A@AAEA@A@@A@@AA@@@A@@A@@AAAA@A@@BA@@@AB@B@BC@AB@@ABA@AAA@AB@B@@ABA@A@@@AAA@@@A@@@@@@@A@@AAA@@A@@B@BA@@AA@@@A@@@A@@@A@@@AA@@A@A@@@A@@@AA@@@AA@@@A@@@@@@@@@A@@AA@A@@B@B@@@B@@@B@@@@@B@BABA@@B@@@B@BAB@@@B@BA@ABA@A@@BA@@BA@@@@B@@@@@BADA@@@@@A@@@A@@@A@@A@@A@@@A@@B@@@B@AA@A@A@@BA@@@A@@D@@@@A@@A@@@@AA@@@@AAA@@AA@AA@@@@@@AA@@AAA@@@A@@@@@@A@@@@@@@@@A@@AA@@@@AB@@@@A@@@@@AAA@C@@@@@@A@A@A@@AA@A@@@A@CB@D@B@@A@@@A@@@@AAA@A@E@@A@@@A@@B@@A@ABAB@@C@@@A@A@AB@@@@D@@B@@@@@B@@@@@B@@@BA@@@A@C@@@A@@@CAABA@A@CDGFA@ABCAA@@AAAAC@@AA@AC@@AACA@@AA@C@AAAA@AA@@A@ACA@AA@A@@@@CBA@@AAA@@@A@@@C@A@@@A@@@A@A@A@@@A@@AB@@A@@@@@@@AAB@@@@@DA@@@A@@A@BABCA@@@AA@@@DC@AAA@@@ABC@@@@@@@C@A@A@@B@@ADA@@BA@@@A@@@CBA@@AA@@A@@AABABA@CBC@@@AB@@@@@@@B@@@BABABABA@ABAB@B@@CC@AAABCA@@A@@@A@AAA@@BAAA@AAA@A@A@C@AAA@@@A@C@@@BC@ABA@@@@A@@@A@AA@@A@@A@@A@@@AA@@AAAA@ABABA@A@@@A@CA@@@@EBA@AB@@AAA@@A@@A@E@A@@AAA@@A@@@ABA@EAAA@@@A@ABA@ABAB@@A@@ACA@@BA@AAA@@@A@@B@@A@@@AB@@@@A@@@@@@BA@@@@A@@A@@@AAA@A@@A@@BC@@@EBA@@B@BAB@D@@A@@@@A@@A@A@@@@ACAACE@@A@@@@A@@@A@C@ABA@ABABA@A@@AAA@A@@@@@@@@AA@@A@A@AA@AAAA@@A@@@AAA@AA@@A@@ADEBA@@AA@A@@AA@AB@@@@ABB@@@@B@@@BAD@@@BCB@@@B@B@@BB@@A@@B@@A@@DABAB@BA@AB@@AB@B@B@B@@@B@@A@@@@B@BADADABA@@BABA@@@ABAAA@AA@@@@A@@@AB@AAB@@@@A@AA@@@BAB@BCB@@ABA@A@AAA@A@A@@@C@A@A@@@A@A@@@A@@BAA@BC@A@@@A@A@@@@@A@@@@AAB@AA@@@@A@@@@@A@@@A@@A@@@C@C@ABC@@@A@ABA@@@A@@B@B@BA@A@A@@@A@@@AA@@A@@@A@@BA@@A@@A@@BA@@AAA@@AAA@@@@@ABA@@AAAA@A@@AC@@@AAAA@@A@ACC@AAC@@@@A@AAAAA@C@@@@AA@@@AA@@AB@@A@@@@AA@@@@@@CA@@@A@@AB@BA@A@@AA@@A@@AB@@@@AB@@BD@BB@A@@@@@@@@@C@@@@@BB@@@@AB@@@@@@A@A@@@@@A@A@@@A@@@@@@@A@@@@G@@@@A@@@@@@@@@@@@@AA@@@@@@A@@@@@A@@A@@@B@@@@@BAA@B@@A@@AAB@@@@@@@@@@@B@@@@@AA@@AA@@A@A@@@@@BAAC@@@A@@@A@A@G@@DC@@@A@@@AA@@C@A@@@A@@BA@@BA@A@@@AA@AA@A@A@A@@@ABA@@@AA@@A@@@A@A@@@AA@BC@A@@BC@A@@@A@@@A@A@A@A@@@A@A@@@A@AAA@@A@AACAC@A@A@A@ABA@C@@@@@ABAB@@ABA@A@AB@@A@C@A@CAA@@@@A@@@@A@@@@BA@@@AAA@A@AAA@A@GDAB@@@B@@@B@@@B@B@@@@A@@A@@@@CA@@@AA@A@A@A@A@AA@@@@AB@@AB@@@BA@@@AB@@EBA@@@@BA@@B@B@@@BA@AD@@A@@B@BA@@B@B@B@@@@CBE@@BA@A@A@A@@BA@@@@@ABAACBA@@@@A@@@@A@A@CD@@A@A@@@A@@B@@AB@@A@@@E@@@C@CBA@@@A@A@@AAA@C@@A@A@C@C@@@@B@@AB@@@@ABB@A@@B@@A@@@@@@BA@@B@@CBA@@@A@AAAC@@A@@BA@@@AA@@A@@@CAAAAAA@A@@A@@A@A@@A@A@@AC@@AA@@@@A@@@@@A@@@AA@@AA@@A@A@@@AA@@A@EB@@AB@@CBA@@B@BA@@@AB@@AB@@A@A@A@A@@BA@AB@@@@@BAB@@@@A@@@@A@A@@AAC@@@AACC@@ACA@@@@AA@@@@@A@A@@@A@ABA@@@AAA@A@AA@@AA@@A@CAA@@AAAA@@@CA@@ABCBAA@@AAA@@A@@@@AB@@AB@@CB@BAB@B@@CB@@@@@@@B@B@@@BA@@@A@A@@@@@@BA@@BA@@AA@C@@A@@@@AA@AA@@@@B@@@@A@@AAAAA@AA@GBAD@@@B@@DBBD@@BB@@B@@B@@@B@@BBB@@@@@@B@B@@@@@@BBB@@@BB@@ABAD@@ABA@@@@B@@@@@@A@AB@@@@A@AAC@A@AAA@@AAC@@@A@@A@@A@@AA@@A@@A@@AAAB@ACA@@A@A@CAAA@@CA@@@A@@A@A@@@A@@AA@@@AA@@A@@@CA@A@@@AAA@A@@@AB@@A@@A@@@A@@AAAABA@BC@@@@A@A@@B@@A@A@AB@@A@@@AA@AAA@@AAA@@@A@@@A@@@A@AB@@AD@BAB@@A@@A@AAAA@AACAAA@@AA@C@A@C@@@@B@@@B@BABBB@B@DBB@BA@@@@@@BBB@B@D@BAFAB@DA@@@A@@AA@A@@@@BA@@@A@@@AB@@@A@@A@@@@@A@@@@@@AA@A@AAAAA@@A@A@@@A@@A@@AA@AA@@A@@AA@@A@A@A@@@AAACA@@@"],encodeOffsets:[[120053,25533]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
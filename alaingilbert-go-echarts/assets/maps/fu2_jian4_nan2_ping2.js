@@ -0,0 +1,4 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("南平",{type:"FeatureCollection",features:[{type:"Feature",id:"350702",properties:{name:"延平区",cp:[118.182036,26.637438],childNum:1},geometry:{type:"Polygon",coordinates:["@@D@@@B@@ABA@ABAB@@ABA@AB@B@B@@AB@BC@@B@B@@@B@@@B@BAB@B@@@B@BC@@DA@@@@BA@@B@B@B@@AB@@@B@@CBA@@@@D@@@B@B@B@@AB@BA@@BA@@B@DAB@BA@@A@@AACA@@@@@@@AB@@@@A@@A@A@@@A@@B@B@@A@@@ABA@@AAB@@@BA@@@ABA@@@@B@@@@A@@@@@AA@@@@@@@@@A@@@A@@@@A@@AA@@@@EAA@ACEC@AAAA@@@BA@@B@@@BA@@@A@@@AB@@C@@B@B@@@@A@@@AB@BA@A@@@A@AAA@C@@AA@@@AB@@ADA@@B@@A@A@@BABA@@B@BABA@AB@@A@@AA@@AC@@@A@@D@@@@AA@@AB@AA@@B@@A@@@A@@@@@A@A@@A@@A@AAA@@BA@A@@@A@@@ABA@@@@B@BA@A@@AA@@A@@@@@AA@@B@AA@@@AB@@@@AB@@@@AB@@@BA@AB@B@B@AA@@AA@@A@@A@A@A@@@A@@@AB@@@@A@@@A@@@@@AB@BAB@@A@@@@AA@@@A@@@@@A@@@A@@@A@@@@@A@@@@@A@A@A@@@A@@@@@@A@A@@@@B@@A@@AA@@@@@@B@@@@@@@@A@@B@@@@@@@BA@@@B@AB@@@@@@A@@@@B@@A@@@@@@BA@@@@@@@@@@BA@@@@@@@A@@@@B@@@@@@@B@@@@@@@@@@@@AB@@@@@@@B@@@@A@@B@@@@@@@@@B@@@@@@@@@@@B@@@@A@@@@@@@@@@@AB@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@AB@@@B@@@@AB@@@@@@@BA@@@@@@@@@@@AB@@A@@@ABA@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@A@@@@@@@C@@@@@@@AB@@@@@@@@@@@@A@@BA@@@@@@@@B@@B@@@@B@@A@@@@@A@@@@@@BA@@@@@@B@@@@@@@@@@@@AB@B@@@@AB@@@@A@@@@@AB@@@@@@@B@@@B@B@@@B@@@@@B@@@@B@@B@@A@@@@@@B@@@@@@@@@B@@@@@@@@@B@@A@@@@@@B@@@@@B@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@A@@@@@@B@@@@@@@B@@@@@@@@A@@@@@@@@@AA@@@@@@@@@@A@@@@A@@@@A@@@@@A@@@@B@@A@@@A@@@@@@BA@@@@@@@A@@B@AA@@@@B@@A@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@AA@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@B@B@D@B@B@@@@@B@BAB@B@B@BA@@B@@A@A@@B@@@@AB@@A@@DA@@@A@@@A@@@A@@@BA@@AA@@C@@A@@@@@@AB@@A@@@@@A@@@AB@@A@@B@@@@@@AB@@@@AB@@@@@@@@AB@@B@@B@B@B@@A@@@@@A@@@@B@@AB@@A@@BA@@@@BA@AB@@@@@@BBAB@@@@@BB@@@B@B@@@BB@B@@@BB@@BBD@@@@A@@@@A@@@@A@@@@@A@@@A@A@@@AB@@@A@@AB@@AAA@@@A@@A@@@@@@@@A@A@@@AB@@@@A@@B@@@@@@AB@@@@@@AA@@@@A@AA@B@@C@@B@@A@AA@@A@@AA@@@@AA@CA@@A@@@@@@@ABA@@@@D@@AA@@A@AB@@@@A@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@B@@BBB@@@@@AB@@@BB@@@@@@@B@@A@@B@B@@@@@B@@@B@@@BA@@@AB@@A@@@AB@@A@@B@B@BA@@B@@A@AB@@@@A@@@A@A@AAAB@@@BA@@@AB@@A@@A@A@A@@@@@@A@@@AB@@@A@A@@A@@BA@@@@@CB@@@@@@@@@AA@@@@@@@A@A@ACAA@@B@@AB@BA@A@@@A@@@@ABAB@@@AA@@@A@C@@@A@A@@@A@A@@@AB@@A@A@@@@@@@AA@@@@A@@@@@A@@@A@@@@@AB@B@@A@@B@DAB@@@@AB@@@B@BA@@B@@BBBD@@BB@@BA@B@@@@@B@@A@@@A@AB@@A@@@A@@@A@@@@@@B@@@B@B@@@@ABA@ABA@AAC@A@@@@@A@@B@@A@@@A@@@A@A@@@A@@BAB@@@B@BAB@BABA@@B@BA@@@AA@@ABA@@AA@AAA@@AAA@AA@@A@@@A@@@@A@@@A@@A@@C@A@@A@@@@A@A@@@@@@AA@A@@@A@AA@@AA@@A@@@A@@@AA@@A@@AAA@@@AA@A@AA@@@@A@A@@@@@@AAA@@@@AA@CA@@@A@A@A@@@A@@@AACA@A@@@@A@@@CCA@@@A@AAA@@@A@@@BBA@@B@@@B@@@BAB@@@@@B@@BB@@A@@B@@@BA@@B@B@@B@@BA@A@ABA@A@ABA@A@CB@@A@A@CB@@@@@BA@@@@B@@@B@@@B@B@@ABA@@@@@AB@@@BAB@@AB@@@B@@@B@B@B@@@@A@A@ABA@@B@@ABBDA@@B@B@B@B@B@B@@@B@@@B@B@@@B@B@@BB@BB@@@BD@@@@@@BBB@@BB@BBA@@B@@@BB@@BB@@@BB@@B@@A@AB@DA@AB@BA@@BADEBABADABAB@@@@BB@BB@BBBB@@@@B@B@@BB@@@D@B@BA@@@AB@BA@AF@@BF@B@B@B@@@B@@C@A@A@A@AA@A@A@C@@@A@@@AAA@@A@@AC@A@A@@@A@@@ABAB@@@B@@@@A@@@AA@@C@A@A@A@@@@@A@@BC@@@AAA@@@CA@@@B@@AB@@@@A@AB@@A@@@@AA@A@@AA@@@A@A@AA@@CB@@@@A@@@@A@@A@@A@AA@@AA@@BC@A@@@@@A@@@A@@A@@A@@@@@A@A@@BA@AA@@ABA@@@@@@@A@@AA@@B@@A@@@A@@B@@A@@@A@@@ABA@A@@@A@@@@BA@@@@B@@A@AB@BAD@B@@AB@@B@@@@B@@A@CB@@A@A@ABA@@@@BA@@@@@A@@BA@@BA@A@@@A@@@@@@A@A@@@A@@BAA@A@@@@@AAA@AA@@AA@AA@@@A@@A@@A@@@@A@@@BA@@B@@@@@@@BBB@@A@A@A@@@A@A@AA@AA@@@A@@BA@A@ABA@@A@BA@@B@@@B@@@B@@AB@B@@@B@@@B@BAB@DABAB@@A@@@@@A@@@AA@@AA@A@@@A@@@@@A@@@@BAA@@@@@@@BA@@A@@A@@A@A@@@@@@B@@A@@@A@@@A@A@@BAB@@@@@AA@@A@@@C@A@@A@@B@@A@@@A@A@@@A@AA@@A@@@@@AB@BABAB@BA@AB@@@@@B@B@@A@@@@B@@@B@@@B@@@@@BB@BB@B@B@BB@@BB@@@@@BBB@@BDAB@@@@@@@B@@BB@@@@@@@B@@@@B@@@B@@@B@B@B@@B@@@BB@@@@@@@B@@@@@B@B@BBD@@@@@BA@AB@@@@AA@AA@@@AA@@C@@AA@@AA@@@AA@@@@AA@@@@A@@A@@@@@A@@@@@@AAA@@@A@C@@@A@@@@BA@@BA@A@A@AAA@ABAA@@AB@@@@@@@BDB@@@BA@AB@@A@@DA@@B@@A@@@AA@@EA@AA@AAA@AAAA@AA@A@@AD@B@@A@@BAB@@@@@@A@@@A@@A@@@@@AA@@@A@@@@BABA@@@@@@@A@@@@A@@A@@@@AA@@AA@@A@@@A@@@A@@AA@AA@@A@@AA@@@@AA@@BAA@@A@@@@@A@@@@@ABABA@@B@@@B@@@B@@@B@@AB@BA@@A@@@@A@A@@@@@@@@B@@BB@@@B@@BBB@@@@B@@BBBB@@BB@@@@@B@@A@@B@@A@@A@AA@@@@A@@@@A@AA@@@@A@@AAA@@@@@@AA@@@@A@@@@AAAA@@AAA@@@@@@@D@B@B@B@@@B@@@BA@@B@@ABA@A@@A@@@@@A@ABA@@@AA@@A@@BC@@BA@@@@A@A@@AAA@@@A@@@AB@@@@@A@A@@AA@@@@@@@A@@A@AA@@@A@ABA@@@@@A@@B@@@@@@A@@BA@@B@@A@@BAA@@@AB@@@@@@A@A@@@A@@@AAA@A@@BA@@@AB@@A@A@@BA@@@@DA@@@@@A@A@A@@@A@AAAB@@@@@@AA@@@@@A@@@@@A@@AA@AA@AA@@AA@@@A@AA@B@@EB@@A@@BA@@@@AA@A@@@A@AB@@AA@@@BA@@@@@@@A@@@AB@@@@@AA@@@@@ABB@@B@B@@@@B@BB@B@BB@@B@B@@@@A@@@@@AA@@A@@@@@A@@@A@@B@@C@ABA@@@@AA@@@@@AA@@@BAB@@A@A@C@@@@@@@A@@@A@@@@BB@@B@BCFAB@@A@@@@@A@@@@@@@@A@@BA@@@@@AA@@@AC@@@@@@@BA@ABA@@@@@AB@@AA@A@@@AA@@A@@@@@@@A@@AA@@AA@@B@BA@@AA@@@@@A@AB@@A@@@ABA@A@@@@A@AA@@@@@A@@@@@AA@@@@@C@@@AA@@@@CB@@@@@A@@AA@@AA@@@A@A@@A@@@AAA@AAA@@@@@A@AAC@A@@@@@A@AAA@@@AA@@AAA@A@A@@BA@@B@@ABADA@@@@BA@A@@@@@@@A@@B@@AB@@@@A@@AA@@@A@@@A@@BA@@B@@B@BB@@BD@@@B@@@@@@A@A@@@A@A@@A@C@A@@EAA@C@@AA@@A@@@AA@@AB@@@A@@A@@A@B@@A@@@A@@BC@@AA@@AA@@A@A@ABA@@@AA@@@AA@@AA@@B@@@BA@@@A@@BA@@@ABA@@@@A@@A@AA@@A@@A@@AA@@AAA@@@@@AA@@AA@@A@@@A@AAAAA@@A@@AA@@AC@@A@@@@AA@@@A@@A@@@@@AA@@B@AAB@@@AAB@@@@A@@@@B@@@BB@A@BB@D@@@B@B@B@@BDAB@@AB@@A@@@AA@AA@@@AB@@ABABA@@@AA@@A@A@@A@@GDAB@@A@@@@@AAA@ABA@@@AB@D@@B@@BB@@B@@BBB@@B@@@@BB@@@@@@@@A@ABA@@BAB@D@D@@@B@AA@@@AA@A@@@@@@@@C@@BA@A@ABAAAB@@A@@@ABABADAACAA@ABAAAB@AA@C@@@@@@B@BB@AB@BBB@B@@A@A@A@@BA@AB@B@B@@@B@BB@BD@BBB@@@B@B@@@BA@@@BB@@ADCB@@ABABA@@B@@@@@BB@@@@@@@@@@BA@@@A@A@A@CBA@@@@@@B@@A@@B@B@@@B@@A@@@@@A@A@@ACACAA@A@@@A@@B@@@B@B@@@@@@@@AB@@A@@BAB@B@@@B@@@BB@BB@@@BA@@BA@A@A@@BA@@@A@@@A@@B@@AB@@@@@@@@B@B@@@BA@@@@@@BA@@@@@@@B@@@@@@@@@B@@@@@@@@A@@@A@@@@@@B@@@@@@A@@@A@A@@@@@B@@@@B@@@D@@@@@@@@@ABBB@@@@@B@@@@@@@@@@@@@B@@B@@A@AA@@@@@B@@@@BB@@@@@B@B@@@B@@A@@@@@@B@B@@B@B@@@DB@@@BB@@BAB@@ABABABA@AB@@@BA@@@@@@BA@@@@@@@A@@D@@@B@BAB@@@@@@@@@BB@BB@B@B@@@BA@CB@@@@@BA@A@@@A@@BA@C@AB@@@@@BB@@@A@@B@@@@A@@A@@@@AA@@A@@AA@@@@A@@AAAB@@@@A@@@AA@@@@AA@AAC@@@@@AAAAAA@@@@B@BA@@BAB@BA@@B@@AB@B@@@@BB@B@@AB@@A@@@@@@@AB@@@B@@BB@@AB@@@D@@B@@@B@@B@@@B@@A@@@@B@B@@BBB@BBD@@@B@B@B@@B@@B@@@@BA@@@A@@@@B@@@B@@@@A@A@AAA@@@@@@@@@BBB@@B@B@@@B@@@BA@AB@B@@@@A@@BBB@A@@B@B@@BB@@@@B@BB@@B@B@@@BB@BBBB@@@@@@BABC@@@@@@B@@BB@@BB@@@@DA@@B@@AD@B@@@@C@@@ADA@@B@@AA@@@@@@A@@@G@CA@@A@AA@B@@@@A@@@A@@AA@AAA@AAA@@@A@AA@@@@@B@@B@@B@@@@@@@@A@@B@@@@ABC@C@@B@@AAAA@@C@@@A@CA@A@@@@@A@@@@A@@@@B@BC@AB@@@B@@@@ABA@@AA@@A@B@@@BBBBBBB@@BB@BB@AB@B@BA@AB@@@BBB@@@@BB@@@B@@@BA@ABB@@B@@BB@@@BA@@B@BABB@@BA@CBA@@@@BA@@B@B@@@@@@@@A@A@@AEBABA@@B@@B@DA@B@@@@ABA@@@AA@@@@@@AB@@@@AB@@BB@@A@BDA@BD@BBJABB@@@B@B@B@B@BB@@B@@B@@BBB@@BCD@@B@@@BB@@@B@BDB@BBBBB@@BB@@B@B@@B@@BB@@B@@@@@B@@B@@@B@B@@@@ABA@A@@@CA@@A@A@A@A@ABA@AB@@@@@B@@AB@@ABAA@BAB@@E@@@A@@@AB@@AB@@A@@@@AA@@A@AA@@@A@@BAB@@@B@@A@E@A@A@@@@@@A@A@@DABA@A@A@A@AB@A@BA@A@@@@@AA@@A@@AAA@@A@@@A@@A@AAA@@AA@@@@B@@C@@@ABAB@@@@@@ABAB@@@@@BA@@A@@@@AAABA@AA@@@@AC@@A@A@@BA@@@@AAB@@@@@@@@A@@@@@AA@BA@@@A@@@A@A@@@BB@@A@A@AA@@AA@@@@@A@@@@A@@AAB@@@@ADA@@@@B@@BB@B@@A@@BA@CB@B@@@BA@A@@B@@@@ABAAA@@@ABA@@@@@@@A@@A@@BA@AB@A@@AA@A@BAA@@A@@AA@@@@@AA@@@BA@ACA@@CAA@@@@@A@A@@@A@@@@@@A@AA@AA@AA@A@A@A@@@A@@@A@@@A@ABA@A@@@@AA@@@@@@@A@@BA@@BA@AAA@A@@BB@@B@@@B@DA@AD@B@@A@@@AA@@AAAAAAA@@@@@@@@BAB@@A@@@@@@@AB@@@BBDB@@@BD@@@@A@@B@@@@@A@@@@A@@@AAAAAC@@C@@@ABAAA@@A@@A@@AA@AB@@A@@@@@@@A@@@BA@@@@@@@@AA@@@B@@@@@@A@@@@A@@@@AB@@@@@A@@@B@@A@@AA@@@@A@@A@@A@A@@A@@@@@@A@A@@@AA@@A@@A@A@@A@@AB@@@B@BAB@BABBBABA@@B@@@@AB@@B@@B@@B@@@BB@B@B@@@DA@BB@@@B@@@B@BA@@B@@AB@@@B@@@B@@A@@A@@AAA@@@@B@@B@@BB@CB@@@@A@C@@@AAAAA@@@@B@@ABC@@@@@@B@@@@A@@@@@BB@@BB@@@BBB@BBB@BDDA@@B@B@BAB@B@B@BA@@@@@A@AB@@@BA@@@A@A@CBA@@@@@AB@B@D@B@B@@@B@B@@BB@@@BA@AB@@@B@BBB@BA@A@A@A@A@B@@B@BBB@@@B@@@B@B@@B@@AB@BA@@B@@@@@B@BBBDB@@B@@B@@A@@BB@B@@@B@B@@@@B@B@@@@@@A@A@AA@@AB@@@B@@@DA@@@@@@@D@@B@@@@@B@B@@@@@@BA@@BA@@@@B@@B@B@@@B@@@B@@@BB@@@B@@@B@@@@@BA@@@@BCA@B@@@@AB@@AA@@A@A@A@C@CB@@@BABBBA@@BABABA@A@@BA@A@@B@BBD@@@@AB@@@@@BB@BB@@D@@B@@@D@@BBBD@B@@@BBB@D@B@@@@@BAAABA@A@@@A@@@AA@@@AB@@@BAD@AA@@A@B@@A@@@@A@CBA@ABA@@@@BA@@B@@@B@B@@A@A@@@A@A@@@ABB@BD@@@AB@BBB@@@@BB@@B@BBD@BB@@@BB@@@BA@@B@@CBB@@@B@@@B@@BB@B@BB@BBA@AB@@AB@@@@@B@B@BCB@BB@@@@B@@@@AB@@@@@B@@@B@B@B@BA@@B@@@BB@@@@@@@@@B@BBB@@@@@B@B@@B@@@@@AD@D@B@B@@@B@@@@@B@@@B@B@BBB@@@B@@@@B@@B@@B@@@@@BB@@@B@@@@BB@@@@@B@@EB@@AB@@@B@B@@@B@@B@@@@BB@@D@B@@@@@BB@@@B@B@@BBBDBBB@@@B@@A@@@AB@B@@@D@B@@@@B@B@B@@@B@B@B@@A@BB@@@BBBD@B@B@@@B@B@DBB@F@BAFA@BB@BBB@@B@@D@B@@BB@BB@@@@BB@@B@D@@BB@@BBB@B@@@@@@B@D@B@B@@@B@B@BA@@B@@@B@BAB@BBB@B@@@BB@BB@@@@@BCB@B@@@B@BA@@@@B@@@@BB@@@D@@AB@@@@@BABABBBBB@@@@@B@B@BB@@@B@D@@AB@@@@B@@BB@@@@BBDABBB@@BB@B@@BB@AB@B@B@@@BB@@@B@@@B@B@B@@@BB@BBB@B@BB@BBB@@@D@H@@@DBB@F@H@B@B@DBB@F@BA@@B@@A@@BAAA@AAAAGAABC@@@ABABA@ABAB@B@@@B@@BBBBB@@@B@@@@B@B@@@@@@D@B@@BBBB@B@@@B@@BBBB@@@B@@B@@@BA@@@AB@B@B@@@B@@@B@B@B@@B@@B@@@B@@@BBDB@@@@@@@BCB@B@@A@@@@D@B@B@BB@@@@B@@BB@@@@ABAB@@AB@BA@@B@@@B@@BB@@B@B@B@@@D@B@@@B@@BB@@@B@B@@@@@B@@BBB@@@@@B@BB@@BB@@@BBFDB@B@@@BA@@B@BA@@@@B@@@B@@@@BB@@@DAB@@@@B@@@B@@@B@@BBBB@BB@D@D@@@BB@@@@@D@D@B@@@@A@@@CB@@A@AB@@@@@B@@BFA@AB@@@B@BB@@B@@BB@@@@@BBB@@BABA@@B@@@B@@@B@@@BAB@DB@@B@BAB@DABAB@@@B@B@DBB@BA@@B@@@B@@@BB@BBDBBD@@@@B@@BD@@@B@@B@@@@@@@@ADB@@@@@@@@B@@@@@BBB@@@BB@@@B@@B@B@@@@@B@B@@@@B@B@@AB@@@B@@B@@@@@B@@BBB@@@B@B@@@@B@DC@@@BB@@B@@@B@BCFAB@D@@@BB@@@BBB@@@B@@@DA@@B@@AB@@AB@@@BCB@@AB@@@BCBCBABA@@@AB@BAB@@ABA@@@@DA@@BAB@@@@AB@@@B@@@@@@@AB@B@B@BAB@BB@BD@@DHBB@@B@@@@A@AB@@@@ABB@@@@DEBADABC@@BA@@@BB@B@@@B@B@B@@@B@BA@@@@@A@@AI@A@@BA@@@C@A@A@@@AA@AA@A@@@@@AAA@@@@@AA@@A@@BA@A@@B@@AA@@ABABC@AB@@C@AB@@A@@@@BB@@B@@@B@@@@AB@@@B@B@BBB@BB@@B@BAB@B@B@@C@@B@@@@@B@@AB@@CB@BA@@@@BABC@A@@@C@@@A@@B@BB@@B@@@@AB@@@@@@@BB@A@@B@@@@@@@BA@@@B@@B@@@@@B@BBB@@@@@@BABB@@BA@@@@B@@@@B@@@B@@@B@@BB@@@@B@@@@@@BBBA@@@@BAB@BAD@D@@@B@B@B@BA@@BAB@@@B@@@B@@A@@@@B@B@B@B@@@@@BB@@@BB@B@B@@@B@DB@@B@B@B@B@B@B@BA@@B@BAB@@@@@B@B@@@B@@@B@@@B@@@B@BA@@BA@@@@@@BADC@AB@@A@@AC@@BA@@AA@@@@@AAA@A@@@A@@B@@@B@@@B@BABA@@BC@@BA@@B@@@@AB@@@BA@@@@@A@ABABA@A@@@AAA@@B@@@BA@@BC@@@@B@B@@A@AB@@@BA@@B@@@@B@B@B@D@@B@D@B@B@@@B@BABAB@BABABABCBA@@BA@A@@@AB@B@DABCB@B@@@B@@@F@DBB@@@@@@BBBB@@@@D@@BB@ADB@@B@@AB@@@@@B@@@BB@@BB@@B@@@@@@@C@@BA@@@@AA@AB@@AD@B@@B@B@@BB@BB@@B@@@B@FA@@B@@@@BB@B@B@@@@@@@B@@B@@@@@BB@@@B@@B@B@@B@B@D@B@@@B@@@@BDB@BA@BBBAB@BB@@BB@@@@A@@B@@@@@@@B@@@B@@A@A@A@@@B@BB@@BB@@@@@@B@@@B@B@@@@B@@@@A@@B@@@B@@@@B@@B@B@@AB@@@@D@@A@A@@B@@@B@@@B@@@BA@@BB@@BABA@@B@B@@@@@@@@B@H@@CD@DCF@@@D@DAD@BCJ@@@B@B@@@B@@@@ABAB@B@@@B@BB@@B@@ABBBA@@@@B@@@@BD@BBB@@BB@@BB@@BB@@@@@B@BBB@@ABBD@@@@BB@@BB@BDB@@@@AB@@A@A@@@A@@@A@@@A@@@@@@@BB@BBBBD@BB@@BBB@@@D@BBBABBB@@AB@@@B@@AB@@@@@BBBBDB@@BDA@@B@B@B@B@B@@@F@@AB@@@B@@@D@B@B@B@BBB@B@@@@@@ABA@@B@B@B@@AB@@@BA@A@A@@@A@A@C@@@A@AAA@A@A@A@AA@@ABA@@B@B@@@@G@@B@@@AA@@@@@@@A@A@A@CB@AA@A@@EA@@A@AA@CAABC@G@@B@B@@@@C@A@A@AB@@AB@@ABBB@@@D@FB@A@@A@@AAC@@@A@ABA@AAA@A@@@AA@@@CA@@@@@AB@@@@@@@@AA@AA@@@@@@@AA@@BAA@@@AA@@@@@AA@@A@@@@ABABA@@@@B@@AB@@C@@BAB@BA@ABA@@@A@AA@@AA@@AA@A@A@AAA@@@@A@@@@A@@@A@@A@@@@@@BAA@@A@@B@@@@@@@@@@@@A@A@@BB@@@@@@@A@@@A@@BBBB@@@@@A@C@@@A@@@@@@A@@@AAA@A@A@@@@@A@@B@@@@@@A@@A@A@@@A@@@A@@B@BC@@BA@AAA@@BA@A@A@@DBD@F@B@BA@@B@@@BB@@B@@B@@B@@@@A@@@C@@@C@@B@B@BA@@@@@CAA@@@C@@@A@@@A@@AAA@@A@A@@AAC@AAA@@@@@@@BA@@@@@@@@B@@@BA@ABA@A@A@ACI@ABA@@@AB@B@B@@@BC@@B@D@B@@@B@B@@BB@@BB@@@B@BB@@BB@@@@BC@A@A@A@AB@@@B@@@B@@@B@@@B@@@BC@A@@@ABA@@@AAC@C@A@@@A@@@A@@B@@@B@@@FA@A@AB@B@@ABA@A@@@@A@EA@AAA@A@@B@@A@@@AB@@ABAB@B@DABA@@B@BCFABA@@@A@@@@D@B@@@B@DBB@@@B@@@B@@@B@B@@@D@BB@@@@@@@A@A@@BC@@BAB@BBBEB@BABC@@@BB@@@@B@B@D@@@@@@B@DBB@@@B@BABC@@@A@@BA@@@AA@@@@CB@@@@@B@@B@@@BB@@@B@@A@@@CB@B@B@@A@@B@@@@@B@@@B@B@@@BA@@@A@@@A@@@@B@B@B@@@B@@@B@A@AA@@@ABC@A@@@@BA@@BAB@BA@@@AB@@AB@@@@@B@B@@@B@@B@@@@B@B@@@AB@D@@@B@B@@BB@@DBBB@BB@BB@@BB@@B@B@F@@@ABAD@B@@@@B@F@B@@A@@B@@BB@BA@BB@B@B@@BB@@@BA@@@A@@@@@ADA@A@@@@@@B@@@BD@B@@BD@@@BBB@@@@AB@BB@@@BB@B@@BBB@@AB@@@@@@@@@@@@@D@@@BAAA@@@ABB@A@@@@B@BA@A@@@@B@@@B@@A@A@@B@@@A@@@AA@@@@@A@@AA@@B@@AB@F@@@@A@@B@BA@@BBBB@@@@B@B@@@BC@@DE@@BA@@@AAA@@@AA@@@CD@@AA@A@@AAA@ABABA@C@AB@@@@A@@ABC@A@A@A@A@@BC@A@@B@D@@@B@@@B@B@@@BBB@@BBDBBB@@@@@BA@@BA@@@@@@DE@@B@@A@A@A@ABABA@@@ABC@@B@@CB@BC@A@@B@B@@@BAD@@@B@BA@A@@B@BB@AB@DCB@BAB@BABABC@AB@@@B@B@BA@@@@BB@@@B@D@B@B@B@@@BB@@@B@BBBBB@@AB@@BB@@@@@B@@@B@@@@B@@@BADA@@B@@@@@BB@B@@AB@@@@@BA@CB@@@@@B@@@BBF@@@BA@@BA@@@AAABA@@BA@@@AB@B@@@@BB@@B@@BBBB@@@C@A@ABA@@B@@@B@@@B@@BB@D@@BB@@B@@BB@@@@@BA@BB@@@F@B@@@@@@B@B@BAB@@@DA@@@A@@BAA@@A@@@@B@@@@@@@B@@@@BB@@@@BBA@BB@BBBABB@@@@B@@@@@BBB@@@@B@@@BBBBB@@B@@@B@B@@@BB@@@BAB@B@BA@AB@BBDDH@B@@AB@@ABA@ABA@@@AB@@@BA@@@@@@B@@@BB@@@B@DBB@B@@@B@@@@@@B@@@BA@@@@@DBAB@@@@B@@@@@@@BA@@B@@BBA@@@@B@BA@@B@B@@@B@B@@B@B@@BD@@B@BB@@@B@B@@B@BBB@@B@@@@@B@@@B@@BB@@B@@@@@B@@@B@B@B@BAB@DAB@@@@@DB@@B@B@@@@AB@BABAB@BA@@B@@@BB@@AB@B@@BDBD@@@BB@BB@@@@B@@@B@@B@@@@@BB@@@FB@@@@A@@C@@@@A@@A@@@AA@@A@@BA@A@@@@BB@@@@BBBBBB@@BB@@@@D@DA@@@AA@@A@@@@AA@A@@@@@@AAB@@@@A@@B@BC@@BA@@@AA@@A@AA@AAA@ACAAAAAACCA@@A@@BA@@@C@@@@BAB@B@@AB@BAB@@@@@B@B@B@B@BB@@BAB@@@B@B@@@BAB@B@@@@@B@@@FEBAB@@@B@B@@@@@BC@ABA@ABA@A@A@@AA@A@@@@@C@@@@@A@@AA@AA@@A@@AAAAAA@@@A@@BA@A@@@@@A@@AA@AAA@A@@@@@@BA@A@@@C@A@A@@A@@@@A@A@@AA@@@@A@@@@A@AB@@A@@@@AAA@A@A@@@@@@BA@@AA@AA@@@A@AB@@@AC@A@A@@@CB@@@@@@BBA@@@@@A@@@@@@@A@@@@CA@A@A@AA@@@@CAA@A@A@@@AB@B@@@B@@A@@@@BA@ABA@C@ABA@A@C@C@C@AA@@AAAA@@@A@B@AA@@BA@AAC@AAE@@AAA@@A@@@A@@BA@A@@B@B@@A@@B@@AA@@A@AA@@AAA@A@@@A@A@@AA@@@@A@@@@A@A@AA@B@AAB@@@AAA@@AB@@@AA@@@AB@@AB@B@BA@@@@@AB@@A@A@ABCBA@@B@@BDA@@B@@@@@B@BBB@@@BABBBADB@AB@B@@A@@@@@A@C@@@@@@B@B@DD@@@@@BB@B@@@@@BB@@@@B@@BD@D@@@D@B@BA@@B@DAB@@ABA@C@A@@A@@AA@@@A@@A@CA@@@AAA@AA@BA@@@@A@ABCBA@CD@@@@A@@@@@AB@@@B@@A@@@A@@@@AA@@A@@@@AAAA@A@A@@@@AC@@@@@@A@@BA@AB@BA@A@@@@@A@@AAACA@@A@@@AA@@A@A@@@@@@@A@A@@@A@AA@@A@@CAA@@A@@AC@@AA@@@@AA@@@ABA@@B@B@@A@@@A@@B@B@@@@@@A@@BB@@@@B@@@@@AC@@@@BA@@@@@@@@A@A@@@A@A@@@A@@A@@BA@@BABA@@AA@@BA@ABA@A@@@A@@A@A@AAC@@@A@@@B@@AB@@@BC@@@@@A@@@@A@@AA@@@@@@@A@@@C@AB@A@A@@BAB@@A@@CA@ACAAA@@AAAA@@@@@BADA@B@@B@B@@B@ABAB@@A@A@AB@@@@A@@@@A@@@CBC@@@@AA@@@@@AA@@@A@A@@@@@A@@@AAC@@BA@@@AA@AA@@@A@@@A@@@@@A@@A@@A@A@@A@AA@@@EAA@AAAA@ACA@@AACEAEAC@@@A@@BC@AB@@@AA@@@AA@BAA@@@@AA@@AA@@@AAA@@A@@A@AA@@@@A@BAB@@@F@@@DA@@B@BCB@@@DA@@@@@A@ABCA@BA@@@AA@@@@A@A@@@@@AA@@A@@BA@@@@@@@A@@@@@@AA@A@@@AA@AA@@AB@@@AA@@@AA@@@AA@@A@@@@@A@AA@@@@@@AA@@@@AAA@@KDAB@@@A@C@@@A@C@@@@@A@A@A@A@A@@@@C@@@@@@A@@@A@@@A@@@@BA@@@A@A@A@AA@@A@A@A@@@AB@BABC@@@@AA@@B@@A@@@@@@B@@@BBBA@@BADA@@BAB@B@@AB@DA@@B@@A@@@@@AB@@A@@@@AABA@@@A@A@AB@A@@@@AB@@AA@@@@@@A@@@@BA@@@@@A@@@AAA@@@A@@@A@@@AACA@BAB@BA@@B@@@B@BBB@@@BBBD@@@@@B@B@D@@FAHADA@@@@D@BDB@@@BB@@@B@@BB@@@@@B@BAB@@@@BB@@A@@@@@BB@@@@B@@@@@B@@@@@BA@@@@BA@@@AB@@AA@B@A@@@@@@A@@AA@@AA@@AA@@AA@@@A@@B@@A@@AA@ABABA@@@@@C@@@@BA@@D@B@B@B@B@@@@@AA@@@@@@@@@AB@@@@A@@@A@@@@B@@AA@@@@@B@@C@A@@@@@C@@BA@@@BB@@B@A@@B@@@B@B@@@BB@@@@BB@@@@@@@@BB@B@@B@@B@BB@@@@B@@@@@@BD@@BB@B@@B@B@@AB@B@B@@@B@@@@B@@@B@@B@BA@@@@@@@BB@@@B@BB@BB@B@B@"],encodeOffsets:[[121469,26999]]}},{type:"Feature",id:"350721",properties:{name:"顺昌县",cp:[117.810357,26.793288],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@LC@@B@@@BBBBBBB@@@BBDDBBBB@@B@@AB@BBB@B@B@D@D@DBBBB@@@DCD@F@@@B@@@DA@@BAB@BE@ADABABABA@AB@@@BCDAB@BAD@BA@@BAB@@CBABA@@@AAA@@CA@A@@A@AD@@@BA@@@A@@BAA@@A@@@A@AA@BAAA@BAHCB@@@@AAA@@@@CCA@@@AACA@@@@@A@C@A@A@@BA@C@AB@@C@CBACC@@@@@@@@@A@@@AA@@A@@A@AA@@AA@@@A@A@@HC@@B@@@@B@BB@@@DB@BB@@B@@@BB@@@FA@@B@@B@B@B@B@@B@B@B@B@BAB@BAB@@A@@B@@BB@B@B@BB@@@BBB@@@@B@D@BA@@@@AC@@@AA@@@@A@A@AAABEA@@AAAB@@ABABA@@BB@@@@BB@@B@B@F@@@@@DC@A@AA@@AEBAA@@A@@@@C@ABA@@@A@A@A@@BABADA@@@A@@@@@A@@BCB@BA@A@A@@B@@AB@@@@A@A@@BA@@B@@AB@@AB@B@@@B@@@@@BAB@@@@AB@@ABA@AA@@AA@A@EA@@@A@ABA@@D@DA@@@B@@@@BBB@@@BB@B@@BBB@@B@BDD@@@BDB@@BD@B@@B@@@@A@A@A@@BAB@@@@@B@@@B@B@@@@@BA@@B@@AB@BABAB@@ABAB@B@B@@@@C@@B@@A@@B@@@B@@@BBB@BABAB@BADCB@@A@ABABA@A@@@@BBB@@@B@BADCB@B@BA@@BA@@@A@@B@B@B@@AB@@ABA@@D@BA@@B@@@B@@BBB@BBBBBB@@B@@@@@@A@@@A@@@@@A@@BBBBD@D@@@B@@AB@@@@B@BBBBB@@@@@@D@@AB@@@@@@FD@@BB@@BBB@@@DDDB@@BBB@B@@@B@HA@@DA@@D@B@@@@BB@@DDBB@@@@@B@@@@@BA@@BA@@B@B@@@BAB@BA@@@@@@@A@@@@B@@@@@@@B@@@@BB@@@@@B@B@@@@A@@@A@@@AB@BA@AB@@AB@B@@@@A@@BABAB@@@@@B@B@@A@@BA@@B@B@@ABABA@@@@@A@@@@AA@@@AA@@@BABA@@@@DA@@B@B@BBBD@@DB@@@BB@@B@@B@@@@@@@BB@@@AB@@@@B@@@@DBB@D@@@@@BA@@@@B@@B@B@@B@@@B@DA@@@@@A@A@@B@@AD@B@@@BA@A@@BA@AB@@A@AB@@A@@A@BA@@@@@@@AB@@@AABC@A@ADA@@@A@@B@DA@@@@@C@A@@BA@ABADCBABA@@@A@@A@@BA@CBA@@@@@@@A@C@C@A@A@AA@@AAA@A@@@@A@@@@A@AA@@AA@AAE@A@@@ACA@@@A@@@A@@@AAC@@@A@@@A@A@@@AB@B@B@@@BA@@D@@@B@@C@@@AB@B@@@DBB@@BBB@@@DD@B@DABAD@BA@AB@BABABC@A@@@A@CAA@@AA@@@A@@@@AA@@@@@ABC@C@AAA@A@ABCBCBA@A@CBB@@BA@@@@B@B@B@@@@AA@@AA@AAA@A@@A@@@A@A@@@AA@@AA@@ACAAA@@AA@@A@@A@@AAAA@@CAAAAAC@AA@@@@AA@@@@@A@@BA@@D@@AA@@@@AAA@AA@A@@A@@@@AAA@@@@@A@@@ABCB@@A@@@@BA@@@A@A@@@@A@@A@@AA@@@@AA@@AA@BA@@AA@A@AAA@@@@BA@@DBD@B@B@BAB@@@AAB@@ABAB@@A@@@A@@@@CB@@C@A@@@@AAAECC@ACA@@@CBC@A@AAAA@A@@@A@@A@@@@AC@@AAAC@ACCAAA@@A@AAA@CCA@@@AB@@@@A@@@CA@A@@AA@@A@AFA@AB@B@@@B@@@B@B@B@@@B@@@B@@AB@BAF@@@B@BBH@B@@AB@BA@@BA@A@@@@@A@@@@@A@@@A@@@@B@@A@@@A@@@@A@@A@A@@BA@ABA@@BCB@@@@@BAB@@AA@@@A@C@E@@@@A@@@A@AB@@@@@BAB@B@B@B@B@@A@A@AAA@C@A@AB@@@@BB@@@BB@AB@BEBABA@@@@@@A@@A@@@@@@C@A@@@@@AA@@@AB@@A@@AA@@@@C@A@@@AB@@AAA@@@@CAA@@@@@@A@A@@A@C@@@A@AC@@A@A@@A@@@@@A@@C@A@A@A@@A@@AAA@@AA@@A@@@@@@A@@A@@@A@A@@@A@A@@@AABAAC@AAAAAAAACA@@@A@AAA@@@A@@A@@@C@@A@@CAA@@@CBA@A@A@A@ABA@@@AAA@AAA@A@@@A@@B@@A@@@A@@@AA@@@@@BA@@B@DA@@BADAB@BB@@BA@@@@BAB@@@BB@@B@@@@BB@B@@@@@BBBB@@B@@@B@B@D@@AB@@@BBJ@@@B@@@@ABA@@@A@A@A@@@A@A@@A@@AB@@ADCBABCF@@@@AA@B@@A@@B@B@@A@@@AACG@@ACA@@ABA@A@A@ABA@@@@@@A@@@A@@B@@A@AB@@CB@@@@AB@BA@ABA@@B@@ABABADAD@@A@@BA@AD@@A@@BA@@BA@@@CB@@A@@@A@AA@@A@@A@@@CBADE@A@A@@@AA@@A@@CDA@@@@@@A@A@@AA@AA@@@@@A@@@@A@@BA@@@A@A@@A@A@@@@@A@A@@@@A@@AA@@A@AA@@@@A@@@@@@@@@CA@B@@@@@@A@@@@A@@AC@@@A@@C@AAAC@AAA@@A@@@A@@@ABA@CAA@A@@@A@ABCBA@ABA@@@CAA@AB@@A@@@A@@@A@@@ABAB@@AA@A@@@@AA@@@AA@@A@A@@BAB@AE@@@A@@@@BAB@@@DA@@B@@@@@@A@C@C@@@@AA@@C@C@A@@AAAAA@@@A@@@A@@@A@@A@CB@@A@@A@@A@@@A@@@@@ABA@@@AB@@A@A@ECAA@@A@@AA@@A@A@@@@AA@AA@@@@@A@A@@@A@@AA@@@A@C@@@A@A@A@@@AA@@@A@@@AB@@ABA@@BABA@@@@AA@@@A@@A@@A@A@A@C@@B@@@@ADA@A@@@@@@CAAA@@A@@@A@@@@AA@A@A@@@A@@@A@A@A@@B@@AB@@A@@@@A@@AAAA@@@A@@@AAAAA@@@A@C@@@@A@A@@@@@@A@@AAAA@AA@@@A@A@AB@BABAB@B@@ADBBBHBB@BBBAB@@@BA@@@ABE@A@CAA@A@G@E@A@CA@@G@C@@@A@AAA@@A@AAA@AAA@@A@A@A@@@A@@@A@@A@@@A@ABAA@@AA@A@@AA@AACBAA@@@@AA@@@A@@A@@BC@A@@@A@@A@A@A@@@@AAAABABA@A@@@@BA@@@C@@AA@@@@@A@@B@@A@A@@@ADA@A@@@@AAA@@A@@@AAA@ABA@A@A@@@AB@@A@A@A@@@A@A@C@A@@@@A@A@AA@@AA@@@C@AA@@A@@A@@AAA@@@A@CA@@@AA@AAAB@BE@A@EAA@C@A@A@@@A@AACAA@@A@@A@BA@A@A@@@A@A@A@@@@@@A@C@@@ABA@@B@@@@A@@AACAAA@AA@A@@@A@@A@@@@@A@CA@@A@@A@@@@A@@@A@A@@BA@@FA@@@A@@@@AA@@@@@A@@AA@@@@A@@@@AA@@@@@@A@@AA@A@A@A@@@A@@@@@A@@@A@A@CBC@@@@A@@@@A@A@@@@AA@A@A@@@@@@@@AA@@A@@@ABA@A@A@@@A@@@@@A@@B@@A@@@@@AAA@ADA@A@@@@@A@@BA@@BABA@@@AA@@@@AB@@@B@@@B@B@BC@@BAB@@A@AA@@C@@@@@A@@@@@A@@AB@@@BABA@@A@@@ABA@@@@A@@EB@@AACAA@@@@@@AA@AA@@@@@A@AA@@AA@@A@@@A@@A@E@A@AAA@@@C@C@@@AA@@AA@@@@@B@BABC@AB@@@@@B@@ABAA@@AB@@@@@@@A@@@A@@@A@A@@@@A@@@@A@AA@A@@@@@AB@@AB@@ABBB@@CB@@A@@@@B@@A@A@A@@A@@B@@@@A@@@@@@AB@@@@@A@@@@@A@@A@@A@@@@@@@BA@EBA@A@@@AAAAC@@@@AAA@@A@BA@@@@@@A@C@@@A@@@@@@@AB@@@@A@@@A@AA@@CBA@@@ABAB@@AAAA@A@@@@A@A@@@A@@A@@A@@@A@@@@@AA@@@AA@@@@A@A@@@A@@@@@@AA@@BAAA@@A@@AA@@@@A@@A@@@ABABABA@AB@@CBA@CBA@A@@@@B@@AB@AA@AAE@AACAA@A@A@@ACB@@AB@BAB@@@DBB@@CBCDA@@A@@@ACA@@@A@ABABA@@A@@A@@@@A@@@@@A@@@A@@@AB@B@@BB@@@@A@A@A@@@@BABA@@@AA@@@@@@A@@@AA@A@@@A@AA@@@@BABAA@@AAAAA@A@@@@@A@@@AB@@A@@@@@A@@C@@AAC@A@@@A@AAA@A@@@C@@@@A@@@@AA@AA@AA@@A@@A@AA@AA@@AA@@@@@A@@A@AGBAA@@A@AA@@@A@AB@@@@ABA@A@A@A@A@A@@AA@@@@@AB@@@BAB@B@@A@AA@@A@@@A@@@@A@AAAB@@@@A@@@@@@A@@ABA@@@AA@AA@@AA@@@@@@ABA@A@@@AAC@@@AA@@@@C@@AA@@B@B@BAB@BCD@B@@B@@@@BA@@BAB@@AB@@@D@@@@D@@@B@@BD@@B@@BBB@@@@@B@BBB@@@@B@@@@A@@@A@@@@@AB@@@@A@BB@@@B@BBBA@@B@@A@A@A@@AA@AAAAA@@@A@@@@@A@@BCAABC@@@@@A@@@EBA@AA@@@@A@@@A@C@@@AB@@@B@@@B@@@BAB@@@@A@AB@A@@@@@@A@@A@@@@@@A@AB@@@@@BAB@@A@@@@@@D@@B@B@@@BA@@B@@@B@BB@@@@@@@BB@B@@@@BA@CBAB@B@B@D@B@B@@A@@BA@A@@@A@@@A@@@@@A@@@@B@@@@@@@B@@@@A@@@A@C@C@A@@@@@ABA@A@ABAB@@@@A@@AAA@AA@@@@@AB@@@BCBAB@BA@@B@@AAC@A@A@@B@@A@@@A@@@A@@@@@@B@@@BA@@@A@ABA@@@@BBB@@BBBB@@B@@@@@@BBB@@BBB@@@AD@B@@@B@@A@A@@@A@@@ABAB@@A@A@@@@@AA@@@@CAA@AB@@@B@@@@A@ABA@@@A@@B@BA@AB@@BBBB@B@BBB@@B@B@D@B@@BB@B@@B@B@@@D@B@@BDB@BBBBBBB@@@@B@B@@@B@BAB@@B@@BBB@@@BBB@@@@B@DB@@DAB@B@B@B@@@FAB@@@B@B@@@@@BB@DBB@BB@BB@B@B@BBB@@BBB@F@B@B@B@B@B@@BDDB@BBBAB@@@B@@BBB@B@@@D@@@@@@BB@@@@@@@B@@B@@DB@BD@BBB@BDB@BBB@@B@@@@B@@@B@BB@@D@@@B@BADA@BDBB@B@B@BBBBB@@BBB@D@@@DB@BB@@@BDB@@BB@@@@BBB@@BB@@B@BD@B@@@B@B@@A@ABA@ABAB@@AD@B@BBB@B@@@@@BAF@@@BBBBBB@@@BB@@BB@@AB@@A@@BA@A@@@@B@@A@@@@B@B@@A@@B@@CB@@A@CB@@A@@@@B@@@@CBABABA@@BABCB@B@B@@@B@B@BCD@BABABABADABAB@@AB@B@B@@EDCBABA@ABAB@@@B@@@B@B@@@D@@AD@BCB@@ADAB@B@@BB@DB@@B@@CD@@@@CBABABA@@B@BA@@B@@AD@@B@AB@@ABA@@BA@@@ABA@@@A@CA@@AB@BCB@@@BAB@BCBABA@@D@@A@@B@@AB@@ABABA@@@A@A@A@@B@@A@@@C@@AA@A@@@A@@BB@@@ABA@@B@@@@@B@BA@@B@@@D@@A@@@@BA@AB@B@B@@@@@B@B@@AB@BA@ABA@AD@BABA@@BB@@B@@BBA@@B@B@B@@AB@B@@@@AB@@@B@B@BAD@B@@@BAB@@ABADA@@@A@@AE@C@A@AAA@EAA@@@ADABABA@ADABA@ABA@A@AAA@C@AAAAAA@AACAA@@@@C@A@A@A@@@ABA@ABA@CDA@EB@@CAA@CAA@C@@@AA@@AAAA@@@CA@AA@@AACBA@@@@@@B@@A@@BABCB@BA@CDABA@@BA@@BBB@@@@BB@@@BAD@B@B@F@D@BDB@B@@@B@@@D@B@B@BBB@BHF@BB@BABAB@BBD@@@B@BBDA@@D@D@BB@@@@BB@@B@BBDBBB@B@@@B@@AD@B@B@B@D@BBB@B@@B@@@@@@B@B@D@D@B@BBB@FB@@@BB@@B@@@B@BB@B@@B@B@@@B@@@B@@@@@@BAB@@@B@@DD@@BB@@BBBBB@B@@BB@@@AB@@@@@B@@BB@BB@@@B@@@B@@@@@BA@@B@@@@@@@@A@A@@BA@@@@B@@@@BB@@@@B@B@@@BA@@BA@@B@@A@@BA@@B@@A@@D@@@DBBBBB@B@B@BAB@BA@@@@@ABABA@@@A@A@@B@AABA@@@AB@D@@@B@B@B@@@B@DBBB@@@BB@B@B@@@@@B@B@B@BB@@B@DAB@@A@A@@B@@AFA@@BA@@@A@@BA@@@@AA@@AA@@A@@AA@@A@@@AB@@@@AB@@CBA@@BAA@@@@AA@AA@@@A@A@@@@@A@@@A@@AC@A@A@CBA@C@@@A@@BADA@@@@AA@AAEAC@A@C@A@E@C@@@@BABABAB@@@@ABA@AB@@@@@@@@A@@@A@@BAB@BABA@AB@BADE@@@AB@@BB@B@FD@BB@@@B@BAB@DAD@@@BB@@@BB@@@@@@B@@@@B@B@B@BA@@@@@A@@BA@@@B@@@F@B@B@@@@B@@@B@B@@@@AB@B@B@@AB@B@@A@@BA@@@@@EBE@A@A@A@A@@BA@@BAD@@@@C@E@@@ABA@@BAB@B@BBB@@BFDBD@@B@@@B@@@@@@B@@B@BA@AB@B@@@@@@A@@B@@@@@BA@@BB@@@@AB@@@@@@B@B@BB@@B@AB@B@B@@DBB@B@@@D@@@B@DBB@D@B@BBB@@@@@D@B@@@@BDBBDBDBB@@@B@@@B@@AB@B@@@@@@B@B@@@@@@@B@B@B@BBB@@@B@BA@@@@@ABC@@@A@AB@@@BA@@BB@@DA@@B@@B@@@B@B@@@@@@DB@@B@@AB@@@B@@AB@@@B@DA@@@@BB@@@B@@B@@@BAB@B@@@B@@B@B@@BBBDB@BDBBBB@@B@@@@@BB@DBBB@@@B@@@B@@@@@@@B@BB@B@@BBBB@@@@@B@@BB@@@@BBB@@@@@@@B@@@BABAB@@@B@@@@BBBA@@@B@@B@@@BA@@@@AA@@@@B@@@B@@@@@@@B@@@B@@B@@@B@@BB@B@B@B@@@@@BB@@@@B@@@BA@@@@B@@@@DA@@@BB@@@B@@@@@BB@ABA@@B@BBBBB@@A@@BA@EBAB@@@BB@F@D@B@BB@@@@B@B@@@@BB@AB@@BB@@@@@B@BA@@B@BBB@@@B@@@@ABC@A@@@AB@@A@A@@@@@AB@@@B@@@@B@@@@B@@@@B@@@@A@@FBFBB@D@@@@B@@@D@B@DAB@@ED@BAB@B@@BBB@BD@B@F@B@@FDHDBB@@BBB@@@B@@B@@@B@BAB@B@@@BA@@@A@@@A@AD@@AB@B@@BD@@@@EDADA@@BADAB@@@BA@AB@@@@@B@@@B@BBB@@AB@@AD@D@B@B@B@B@@ADAB@@BB@BBDBBBDBB@@BD@B@@@BAB@@@@@BBB@BBB@BBB@D@@@@B@@B@ABBB@@B@@@DB@@BBBBDB@@B@@@B@@@@D@BBB@B@D@B@BBB@BBDB@@BDB@@@B@@@D@D@@BBBBB@B@@@B@@@@BBB@@BBB@B@@BB@@@@@@BB@@@B@@AB@@@@@B@@AB@@B@@@@@BB@@@@BB@@@@BABB@@@@BA@BB@@@B@B@@B@@@@@@B@@@B@B@B@B@@BB@@@BB@B@@@B@@@@@B@@@BBB@B@@@B@@@@BB@D@@@@@@@BBBB@@DBB@@@B@@@BAD@@@B@@@B@B@B@@BB@B@@DFBD@@@BBBDBDFBDB@BBB@BBBDBBBB@BB@@B@@@D@BB@@BBB@@@D@B@@BBBB@@BBB@B@B@B@BAF@BBDB@@D@@@BBBBDB@BB@@DB@@BBB@@B@@@@@B@BDBB@BDD@BB@BB@@B@@@@DB@BD@@BB@BB@DB@@BB@B@@BBBBB@B@BB@@J@D@BAB@@@@@@@@C@@@AB@BA@AB@BCBA@AB@@@DBB@DBB@@BB@BBB@B@B@B@FAB@@@@@BBB@@@DB@@D@D@@B@@BB@@BB@A@@@@BA@C@A@AA@AC@A@A@@@A@ABADAFA@@BADAB@BAD@D@BA@@B@@A@ABA@@@@BA@@@@@AAA@@@E@@@A@@@A@AAA@@AAA@CA@AAA@@@ABA@A@A@@BC@@@C@ABA@@BA@ABAAA@A@C@@@@@@@AB@B@DAB@@BB@D@DBD@@@@@B@@BAB@DAB@@BB@@@BD@D@B@B@B@HADABABAB@@@BAB@FAB@BABABA@A@ABC@@B@B@@ABA@@BA@C@@A@CA@AA@@@@AAA@CBA@C@@BA@ADC@@@A@@A@@A@@@@BABABAB@BC@ABA@@@A@A@@@@DAD@B@F@D@B@B@BBB@@@B@BBB@BB@BFBD@@@B@@A@@@ABA@AB@@AB@@@B@@@@@B@@@B@@@BA@AB@@A@A@@@@BA@@@ABA@@BABA@AB@@@@A@AB@AA@@@A@@BE@@BC@@BA@ABC@A@@@@AAAAC@@@AA@@A@@@AA@@@@A@@@@@@AA@@@A@@@A@@@B@@AAA@AB@BA@A@@@A@@@A@A@@AA@A@@BE@@@AAA@A@@@@AC@AAC@AAC@A@@@A@@@@BA"],encodeOffsets:[[120822,27701]]}},{type:"Feature",id:"350722",properties:{name:"浦城县",cp:[118.541256,27.917263],childNum:1},geometry:{type:"Polygon",coordinates:["@@DCFCB@BA@@@@BB@BBDBBBDFBDBB@B@@@@B@B@@@B@@BBBD@@@B@@C@@@@AA@@@A@@@AB@@@@BB@@@BBBAB@@A@@@A@@@@@A@@DA@@DA@CBAB@@BDBDBJ@B@BBBBBB@B@B@BBBB@B@BB@B@B@@@B@@A@@@@@@B@DEDEDCBADAD@H@FBB@H@DBFBHBD@FBD@@BB@@D@H@F@D@BB@D@DCHCD@B@F@JDD@BBDAFAB@D@FBD@B@D@DABAB@BCDEFEDABCBA@CAC@ACC@E@E@AB@BABABAD@B@@@B@BBDB@@DBD@F@FAF@D@D@FFBBDBB@B@B@B@B@BDBB@BDHDD@BBDB@D@@@B@D@B@BBHD@@B@D@@@BADABABCFADAD@F@F@DBB@DDJLDHLRHVFHFH@@@@BBD@B@B@B@BA@@B@DBB@BDB@B@D@DAD@DABADAHIDE@C@E@AACAEAG@A@C@A@CDAH@LCB@BE@E@IBAHEJIHIDA@@DAJCNCNCHEN@@@@@@@@@@@@@B@@@@@@@B@J@@@B@@@@@@@B@FAFADCDEBEBADADCBCACAEACCCACAGAE@AAAA@@@A@A@@@A@CAE@AAEEGIEEECE@EAGEEEKSCE@E@@@@@@@A@@@@@@@CBA@@H@H@@@@@B@HDHDHDJ@JCLCDAFEBEBCBI@CAG@@AGAICGAE@@@@@@@@BEBE@CAG@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@BAAA@@CACAEACACCCGCAC@C@EBG@E@EAEAEEAE@EAE@@@@@@@@@@A@@@@@@AA@@@A@@@@@AA@@A@@@AA@@@@@@@@@@@@A@@@@@AB@@@@A@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@BCAEACCA@@AACAE@@AA@@A@CCACAA@A@AAA@@CAECCACAAGAAA@C@C@@A@C@A@E@G@I@@@@@@@@@@@A@@@@@@BEJCHGDCDCBEBCAE@@@@@A@@@@@@@@CEEG@@@A@@@@@@@@@@@@@@@@CIAG@A@E@A@A@C@CBABABA@@@@@A@@@@DEBCBAA@AC@A@@BC@A@CAC@CAC@I@A@ABABADAFABCDCHGFEFEHIBC@C@A@AB@B@D@B@D@B@F@D@DADABAZERGLCBAHCBE@ACAAAAA@A@@@AD@H@FAD@@@JAD@@CEGBADCDE@C@A@A@A@CAABCBCDCDCFCFACCCCCC@A@ABC@CDAFADABEBC@C@A@ACCAAAE@EAC@C@ACACA@@@@AA@CA@@@AAA@CCAACACAA@A@C@A@A@@@@@A@EA@@AAAAAA@@AAA@C@A@CAEAAAA@A@CAA@@@A@@@A@@@A@A@@@@@A@@@A@A@A@AA@ACAAA@@A@@AAA@@A@A@EAAA@@A@AAA@@@@A@@A@@AA@AAAA@@@@A@@A@@AA@@A@A@@@@@AAA@A@@A@@AAAA@@@A@@A@A@AAA@@@A@CAA@@@@@A@@AA@AACCA@A@AA@@A@AA@@@@A@AAA@@@A@AA@AAA@@A@AACAA@A@AA@B@A@@@@@@@A@@@A@A@@@@AA@@AA@@AA@@A@@@@@@AA@AC@@@AAAA@A@A@@AA@@@AAA@@@A@AA@@@AA@@AAB@@A@@@AB@@A@@@A@@B@@@@ABA@@@A@@@ABA@@@@@AA@@@A@@AAAAAA@@A@AAA@A@@@@A@@B@@AB@BA@@@@AA@@AA@C@A@@@@@A@A@@@@C@A@CA@@@@@A@ABA@@@@@AAA@A@@A@@BA@A@AA@@@@A@@@@@AA@@@A@@@AA@@@A@@A@@DA@@B@@@B@@@B@@@BAB@@@@A@@@@@@BA@@B@@@@@BABA@@@A@@A@@BAB@@AA@@@B@@@@@B@@@@@@A@@@AA@A@@@@BA@AFABA@@@@@@@A@@@@AA@@A@@AAABA@@AA@@@@@A@@@A@@AAAAAA@@A@@A@@A@@CA@@A@@AA@A@@@A@@@@@A@@@@@A@A@@BA@@@@B@@AB@@@@@@AB@@@@A@@BA@@@@A@@A@@@@@AA@@@@A@@@@AA@@A@@AA@@@@AA@AAB@@@@AB@@@@@B@@@FC@@@@B@@@B@@@AA@@@AA@@A@@B@@A@A@A@A@@@AB@@@B@BB@ADB@A@@@@@A@@AA@@@AA@@@@@@@@@AA@@AA@@@A@@@AB@AA@@A@A@@@AA@@@@@@BAA@@A@@BA@@@@@A@@@@@AA@@@AA@@AA@AA@AA@@@AA@@@@A@ABA@@@A@@@AA@@@@A@@A@@@A@@@A@@@@@AAB@B@BA@@@@AA@@@@A@@A@@@@AA@A@@A@AA@@A@A@AAA@@@A@AAA@AB@@@@@@@@C@AAA@AB@@A@@A@A@@AA@AA@AAAAA@C@AA@A@A@A@@@AAA@@@@A@@AA@@@@@@A@@AAAAAA@@@AAA@@@@@@AA@@@@@@A@@@A@A@@@@AA@@A@@A@@@@@A@@@A@@A@@A@@AA@@@@AA@A@@@@@@@@AAA@@@@@@@A@@A@@A@BA@@@@@AA@@A@@@@@@@A@@AA@@@A@@@@@A@@A@@@@AA@@A@@@A@C@@@@@@@AAABAB@@@@A@A@@@@AA@@@A@A@@@@@C@@@A@AAA@AA@@AA@@A@@A@@@@AA@@ABA@@@AAA@A@A@A@A@@@@B@B@@A@@BA@@@@BB@@B@@@@AB@@@@AA@@CBA@A@@@@@AA@@A@@@@@A@A@AA@@AB@@@@A@@@AAA@@BA@@@A@@@@@A@@@@@A@@@A@EB@@@A@@A@A@@@@@B@AA@@@@A@@@BAA@@@A@@A@@A@@A@@@A@@@@@AA@@A@@@A@@@A@@@AB@@A@@BA@@@@@ABA@@@ABAB@@A@@B@@@BA@@B@BC@@@@@@A@A@@@@@@@A@A@@@A@@@A@@@A@@@@@@A@@@ABB@@B@@@B@B@@@BA@@AA@@@A@AA@BA@@BA@@BA@@B@@@@AB@@A@@@@@@B@@@@@BAB@@A@@@A@@@@A@@@@AA@@@A@AA@@@A@@A@@BA@@@A@@@A@@@AAA@@@@AA@@A@@@A@@@AA@@@@@@@@A@@@@@@BA@@B@@@BA@@B@B@BA@@B@@@B@@A@@A@@@AB@@A@@@@AA@@@AA@@@A@@A@@@@AAB@@C@AB@@@@AB@@A@A@@@@@A@AA@A@@@@AA@@@@@@BAB@B@B@BBBA@@B@@@@AB@@@B@@A@@A@@@AA@@@@@@BA@@B@@@BA@@@@BA@@@@B@@A@@@@@@@@BAB@@@AAAB@A@@A@@AA@@AA@@@AA@BAAAB@@AAA@AA@@B@@A@@@@B@B@@AB@@@B@@@B@@AB@@@@@@@@@B@@@@@@@@@@BB@@@@@BA@@BA@@A@@A@@@A@@@@@A@@@A@@@@AC@@@AB@@@@@A@@A@@@@BA@@@@@@BA@@B@BA@@B@@@@AAA@@CAAA@@AA@A@@A@@A@@@A@AA@@BA@@@@@@@@B@@A@@@@BA@@@@@@@A@@BA@@@@@A@A@@@A@@@A@A@@@@@C@ACAAA@C@A@C@@@@AA@@@A@@@@AA@@@@@A@@C@CA@AA@@C@@@@C@@BAB@@@@AB@@@@@@AA@C@@@BA@@@@A@A@A@@BB@@B@@CB@@A@@@@AA@@@@@AA@@@@@@@@A@@@AB@@@@AAA@@@CA@@@@@A@A@@AA@@@@A@AAAA@@@A@@A@@AAB@@@A@A@@B@@@@@@A@@A@BAACBA@@AA@@@@A@AAA@AB@BA@ABAAA@@@@C@@AA@C@@DABABA@@AA@A@@BC@@@AE@AAAAAA@@A@@@A@A@E@@@AD@@AB@@ABAD@BAB@BAB@@A@CBAB@@A@@A@@CA@@@@AAAA@AAA@@A@A@A@@@@B@@@BAD@B@BA@@BBD@@@@BBD@BBB@@B@@DB@@@@@BAB@B@B@@@@@B@B@BA@A@@@A@ADAB@@A@@@A@@@AAA@@@@@@AA@ABA@AB@@@@@B@@@@BB@@@BA@AC@@A@A@@@@@AB@@@@@BA@@@@@AA@@ABA@C@A@A@@BA@@A@@AAA@@@@A@@@A@A@@@@A@@@@BA@@@A@AB@@@@AD@@@BA@AB@B@B@@A@A@C@ABC@ABCAA@C@AA@BC@@@A@AA@@@@A@A@A@A@A@A@@@@BA@@B@B@@@B@@@B@B@B@B@@@@@@@BABB@@@CB@B@@@@@BA@@BA@@@@B@@A@ABC@@@A@@B@B@@ABAB@@@B@BBB@@BDA@@@AAA@@BA@@@AB@@A@@@AB@@@@@B@@A@@B@@@@@@@B@@@@A@@@@@@B@@A@@@A@C@ABA@AA@@AAA@A@@BA@@@@B@@@@A@@@C@A@A@@@@@A@A@A@@@AA@@ABA@@@@BA@@@@@@B@BB@@@B@@B@B@@@DAB@@@@AA@@@@AAA@@A@@ABA@CFA@@@ABAAAB@@@@AAAAA@@@AAAACA@@@@@BA@AB@@ABA@ABA@@@@B@@@@@B@@AB@@C@A@@B@@@@@B@D@D@@@BAD@@BB@@A@@B@B@@AB@@@BA@A@AA@@AAA@A@@AAA@@AA@A@@AA@@AA@@AAC@@BAB@B@@@@@@@B@BA@@@A@@@AAA@@AA@@@@@AA@@@AA@@@A@@BCB@@A@A@@@ABA@@@A@@B@@A@@@BB@BDBBB@@@@BB@@AD@B@B@@@B@@A@@@@@@AA@@A@@@@A@@AA@A@@@A@C@@B@@@@AB@B@@A@@B@BA@@BA@@@@B@BA@@@A@A@@@@BA@@BB@@@@B@@@B@@BB@B@@BB@B@DB@@BB@@B@B@@@@@B@@@B@@BB@@AB@@AB@@@DB@@@@B@@A@AB@@@BC@@@@BAB@@ABA@A@ABAB@@@@A@@@@AA@@@A@@B@B@@@B@@@BA@@B@@@@C@A@ADAB@@A@AB@@A@@B@@@BABBBA@@B@B@BA@@BA@@@@B@@A@A@@BA@@@A@A@@@@B@B@B@@@B@@BB@@B@@B@BB@@@B@B@@BB@@@@B@@BB@@BB@@@AB@@BB@BA@@@@B@@@@@BB@@B@BAB@B@@@@@B@@@@@DDB@@B@@BBBBB@FF@@@@A@ABABA@@B@BB@@B@@@@@B@@AB@@@B@@@B@@@B@@@B@@@@@@@B@@@B@@A@A@@B@@A@@@@B@@A@@@A@@@AB@@@B@@@BA@A@@@@AA@@@AA@@@@AA@@@@AAA@@A@@@A@@A@ABA@@@A@@AAB@AA@@@A@@B@@@BAB@BA@@@AB@@A@@B@B@@@BAB@D@@ABBB@@@B@B@@@BA@@@@B@@BB@@@@@B@@@@@D@@@BB@@B@@@B@@@@AB@B@@@BB@@@@@@B@@@B@@BBB@@@@@@@@B@BA@BBAB@@@@@B@@@@@BAB@@@B@BBDAB@@@B@@B@@@@B@@BB@@B@@B@@BB@@@@BB@@@@@BA@@@ABA@@BA@@@@BAA@@AB@@A@@@A@@B@BA@@BA@@@@BA@@@AB@B@@AB@@@BABA@A@A@@B@B@B@@A@@@@@@@@@@@A@@@@@@@AA@@A@A@@@@@A@A@@B@BA@@@@B@@@BA@@@@@AB@@@B@@BBBB@@@B@@@@C@A@@B@@AB@@A@@@@B@@A@@B@@A@@@@D@@@@@@D@DBBBB@BB@@@B@@AB@@AB@BA@@B@@C@A@AA@@AA@AA@@A@@A@AA@@AAA@AAA@@B@@@@A@C@A@@@@@A@@@AB@@A@AB@@@@A@@@@A@AA@@@A@@@A@@@A@@@AAA@@@@@@@AA@@A@@@@@@@A@@@@@AA@@@@@AA@@AA@@AAA@@@@@@AA@@@@A@AA@@A@@@A@@@AA@@@AA@@@@@A@@@A@A@A@A@@@@@AB@@@@A@C@A@@B@@@@AB@@A@@@ABABA@@@A@AB@@A@@@CB@B@@A@ABA@@B@@A@BB@@BB@@A@BB@B@@@@@B@@@B@@DAF@@@B@@BBBDD@@B@BB@@@@@@@@@@@@@@@BA@@@AB@@C@@@@B@@A@@@@@@@@@@@B@@@BA@@B@B@@AB@B@@@BBB@BB@@@@@BA@@@@@A@@@@@A@A@@B@@A@@D@@@@@@D@@@@@BB@@@B@@@BB@@B@@BB@@AB@@@@A@@B@@AB@@A@AB@@@@A@@@A@@BAA@@@B@@A@AB@@A@A@@@A@@BA@@@@B@BA@@@@@@@@B@B@@AB@@ABA@@@A@@B@@ABC@A@A@@@AB@@@@A@A@@@@BA@AB@BC@@@@@@B@@@@@@ABA@@@@B@@B@@@@DA@@B@@@B@@@@B@AB@BBB@@@@@BB@BA@@B@@@@ABB@@@A@@B@@@@B@@@@@B@@BB@@@A@@BA@@@ABAD@@BB@@@@@@@@@B@@@@@@@B@@@@@@AB@@@B@@@BBB@@BB@BBBBBB@BB@@@@@BB@@B@@BAB@@@B@B@@@@BBB@BB@BB@B@B@@@B@@@A@@@@@ABA@@B@@@@@@@B@@@@@@ABA@BA@A@@A@AA@@ABC@@@@BBBB@@B@B@B@@BBA@@@AAABC@A@@AA@@@ABA@A@@BB@B@B@@BB@BBB@@@B@@@B@BBB@BB@@@@@@@B@@A@ABA@ABA@@@ABA@AB@@@BBA@@@@BBBA@@@BB@BB@@@@B@@B@@@@B@@@@@@@B@B@@BB@@@@@B@@@@B@@@B@@@@AB@@A@@B@@@@@B@@@@@BABAB@@BB@@B@B@B@@@@@B@B@@BB@@@B@B@@BBADA@A@@@B@@B@BAB@B@@@B@@ADB@BB@@B@@BB@B@@B@@B@@BAB@@BB@@B@BA@@BAB@@AB@@@B@@@@@@B@@B@@B@@B@BB@@ABB@@@AB@@@BAB@BAB@@@B@@AB@@@B@@B@B@@B@DA@@D@B@@@@BB@B@@@B@@B@@@B@@DD@@B@@B@@@B@@A@@@@@AA@@@B@@@@A@@@@@@@A@@@@@@B@@@@B@@@@@@B@@C@@B@@@@B@@@@B@@A@@BA@AB@@A@@@@B@@AB@B@@@@ABBB@@@B@@@B@@@D@@AB@@@B@B@@@@@B@B@B@@@B@@@@A@C@A@A@@BA@@B@@@@@D@BAB@@B@B@B@BDDD@@@@DB@@BBBA@B@@@@A@@@@B@B@B@@B@B@BA@@BBBB@BB@@@@@B@BA@@BB@@DB@@@@B@B@@A@B@@BB@@B@BBBB@BA@A@@@AB@@A@@@@BB@@B@D@@AB@B@@@@@B@@AB@@@@ABA@@B@@@D@@@@@B@@AB@@@@B@@@BBBB@@@B@@@B@@A@@@@BB@@@A@@@@B@@@B@@@BA@AB@BA@@BABA@@@@BA@@@@@@B@@@@@B@BAB@@A@@B@BA@ABA@@BA@A@A@@B@BAB@@BB@@@@B@@@BB@@@@@B@@@@@@@B@B@@@B@D@BB@@@@B@B@@@B@@@B@@@B@@@BA@@@AD@B@B@@@@BB@@A@@B@@@B@@BB@@BB@@@D@@AB@BDDBFB@@BB@@B@B@@@@EB@@@BA@@@@BABAB@BA@@@AB@@A@@B@@DD@B@@@B@BA@@@A@A@@@@B@B@B@@ABA@@B@@@@ABAD@@@B@B@@CD@@@B@F@@BB@@B@@@B@@B@@B@@@B@@B@@@B@BB@BB@@@B@@@B@@@BAB@@A@AB@@AB@@AB@BCBA@@BAB@@DF@B@BBBB@@@@@@@@B@BBB@D@@B@@B@@BB@B@@@B@@@B@@A@@@@B@@@@@B@B@B@@@@BBAB@@@B@B@B@B@B@@B@BB@@B@@@@@@@B@B@DBBBFDDDDDFDDBDBDBBBB@D@D@F@B@DBBBD@BADAD@B@DBFB@@BBB@BBNFFBD@BAHA@@BAB@FD@@D@B@D@PAB@D@@@B@@@@EB@@@BAB@FBHBD@DB@@FF@BBLAH@D@BBBDF@DBD@@AB@BABAD@H@F@BB@DDFBB@FB@@DB@@@B@@ADABA@EH@BAD@DB@@F@DABEF@@@B@@B@@DBD@@BBD@FBFBFFHDBB@@DB@@@B@BABCDCH@HBN@BADCB@@EBA@@@E@E@CBABC@ABA@C@EAEA@@C@@@@@EBCBCB@@@BA@@FADAB@FCF@@CB@@A@EBIFGFEFABAD@D@@@B@@DBHFBBRHJDFD@@B@@@B@D@BA@@B@BB@BBDBB@BFFBDAJBB@@D@HBB@D@BBB@B@@@D@@B@@BBAB@BBBFHJHDBB@B@@@BCB@B@D@B@DB@@D@BBB@DDBB@@BB@@@@@B@@@B@@@@@@FDBBD@@@HBB@@B@@@@@BBB@@BB@@FDDBB@HBDDDBFFBBB@D@F@BBBB@@B@@@B@@@@A@CBA@@@A@@A@@@@A@AAAAEAC@@@@@A@@BABA@AB@BE@EBA@@@A@A@@@@B@@@@@@BB@B@B@B@BA@@BAAA@A@@AAAA@AA@@@@@BA@@@@@@B@@@F@F@@@D@B@@@B@@A@A@ACAAACC@A@@@A@A@AB@B@DB@@BA@@BCB@@@BABAB@B@@ABBB@B@@AB@@A@@D@B@@AB@BAB@BB@@BB@@DBB@D@D@@AB@BAB@BB@@BB@@BBBAHE"],encodeOffsets:[[121329,28921]]}},{type:"Feature",id:"350703",properties:{name:"建阳区",cp:[118.120464,27.331876],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@AA@A@A@@@@CCA@A@@@@A@@@A@@@@AA@@A@CAA@AA@@@ABC@A@A@C@@@AA@@ACC@@@C@@AA@AAA@A@A@A@@AA@@A@@@A@A@CBA@ABC@C@A@@@C@EA@AAAA@AAA@@@A@AAA@A@C@E@A@C@CB@@@@@B@B@@AB@BADA@ABABAB@@@@@BB@@@@B@@CD@BAB@@@DAB@DBB@B@@B@@BDBB@@@@DAB@@AB@BA@A@@@AD@B@BABABABA@EBA@AB@@A@ABABCBGBA@A@A@C@C@@A@@AA@@BA@CBA@AA@@@@@C@CAC@A@@AA@CBA@A@@B@@@@@@@D@BBBAB@BAB@@AB@B@D@@AD@@@B@BAB@B@@BB@BDBB@BB@@BB@B@B@@@B@@@F@@BB@B@@@@AB@@@@AB@B@BA@@@ABC@C@ABA@CBAB@@EBCBAB@B@B@@@B@BBDB@@B@B@DAB@@@@@BAA@@AA@@@AC@C@@@CA@@A@AA@@@@A@EBA@A@A@A@AAA@@AA@CAA@CA@@A@@BABADA@@BABA@@B@@@D@@@@@@A@ABC@I@@@AAA@A@AAAA@@@AAA@@CAA@@AAA@@ACA@@C@@A@@@AAA@@ACC@AAAACA@@@@@A@@@AA@AA@@CA@@ACAAAAA@@C@@@CAAAE@BF@B@B@F@@BB@B@@@B@B@@F@D@D@@@@B@BB@@@@@@B@@@B@@A@AB@B@@@B@@@@FA@@B@@BB@@B@@@B@@A@A@A@A@@BCB@@@@@BABBD@D@B@@@BA@CH@B@BA@@@A@@@A@GCC@A@CBA@@@A@AAA@A@A@A@ABADABA@A@@@A@ADA@ABC@G@A@ABC@@BA@@BA@CDA@@@@B@D@BBB@@CDAB@D@@A@CBC@ABCD@@EDABCBCB@@@B@B@BADAB@FAB@BA@A@EAC@@@@BBBBD@@AD@@BB@BBB@@@B@@A@C@EBC@A@A@@@CBCBA@G@@@A@AAA@@AACAAAA@@AC@CAA@ACCACA@@A@@A@C@CBC@@@A@@AAA@@A@@AAA@@AA@@@@AACA@AAA@A@A@@BA@@@@@AA@@@AAA@A@A@@@A@@@@BAB@@A@A@@@A@AAA@A@AC@@A@A@@@A@CBABA@ABC@@@CB@@@@@B@BBD@@DD@BBBB@DBB@B@@@BB@@@B@BBB@@A@AB@@@BBB@@@@@BA@ABAB@BADCF@@@BBB@B@BAD@B@B@@BBBBBB@BBB@@@B@D@@BBBB@@BBB@BBB@@B@BAB@B@@@B@@@B@@@@@B@@B@@B@@@@@BAB@@@DBD@D@BAB@BB@@B@B@B@BBB@@BD@BB@BB@@B@D@B@@BBB@BDBDB@@@B@B@BBD@B@@@@A@ABA@@BAB@B@B@@@BBB@BDD@BB@BBB@@BDD@@@@ABA@C@E@ABA@@@C@C@ABA@@@@@A@C@@AAA@@A@@@A@@@A@A@@@A@A@ABCDEDA@A@@BAB@B@B@D@@A@@@ABA@C@ABA@AB@@A@@B@B@B@@@@DBDBDDBBBB@B@B@B@D@BAB@DA@@@AAAAC@@@AB@B@@AB@@ABA@AB@@@BA@@BA@A@@@A@@AA@A@@BA@@AA@@@A@AD@@@BAFBDAD@B@@@B@BB@@B@@AB@BCB@@@DA@@@GDC@A@@BA@@@A@A@@@@@A@@BA@ABEDABA@A@@BAB@B@BAB@@@@B@DBB@@@B@@@@BAB@BBB@@A@A@@@@BA@@B@BADA@@B@@A@A@A@AB@@@@@BBBABADBFBDHFDB@BBBBB@DAD@DABAB@@@BABAB@DA@@B@BBB@@A@@@AB@@A@@B@BA@@BA@@@E@A@@@ABAB@@C@@BC@@@A@@DAJ@DADAD@BABCF@DABAB@B@B@@BD@BDH@@@B@@@@AB@@ABBB@B@D@@@@ABABEBCBAB@BA@@@A@CACA@@ABABCDA@@BA@@B@D@B@@@@@B@@@@ABA@C@A@@@CA@@A@@@C@CBA@@ACAA@A@A@C@CAABA@A@@@A@A@A@ABC@@@A@@BABCB@@ABAB@@BB@BB@BB@BDH@@@@AD@@@B@@@@FF@@@B@@@DA@ABABEH@B@@BB@B@@@BC@ABCB@@ID@@A@ABA@ABCBABEBCB@@@@@BA@BB@BBB@@@B@B@@@BAD@DAB@BBD@@AB@DCDABADAB@BA@@@A@C@@BABA@@B@D@BA@@BCB@BABA@@BA@AB@@A@CAA@@@ABABAB@@@BB@DD@@BB@@@B@B@BB@BBBBBDDDDBB@FBD@DB@@B@@@@B@D@D@B@DDJBF@BB@@BDB@@@@@BBD@@DDB@@B@B@@@BAD@@@@ABE@@@AB@BBD@BFH@B@B@@DH@B@B@B@B@@AB@@CBABB@BD@BB@D@B@BB@B@BBBB@BBBBB@BBB@@@BB@B@B@@@BB@BBB@B@B@BABAB@B@@@BBDDDB@@B@D@D@DBD@BBBB@BDDFF@@@B@BB@BDBBBBBB@BBD@B@@BB@@B@F@B@B@@B@@@@A@@BB@@@@@BB@@BB@@A@@BA@@B@@@DA@@BAB@@@@@B@B@@AB@B@@@BB@DB@BDD@@B@BBB@@BDN@H@BAB@@@@B@@BDBBBB@@D@@@BBB@B@@B@B@B@D@D@B@BBDBDD@@BB@D@BB@D@@AB@BADAH@B@BAB@@@@A@@@@AA@AB@@A@@HC@AB@@ABABADAB@DCB@BABAB@B@F@D@B@D@@AB@@A@ABA@A@AAA@A@A@CA@@A@AAA@@@A@@@@B@@@@C@@BA@BB@B@B@BA@@BBDBB@@@JABBB@B@BBB@F@D@B@DBDBB@@@@AB@BC@@@AB@B@B@B@@@B@BA@ABCBA@CBABEBA@ABA@@@ABA@G@AAC@A@@A@@A@@@@@@BAB@D@BB@@@@@A@A@@BA@@@ABAB@BABAB@@@B@BAB@@A@@BAAA@@AA@@@C@A@A@@@A@A@@@@@AA@@@@@@A@A@@AAAAAA@A@AA@@@@A@@A@@A@@@@@@@@BA@@@ABA@@A@BA@AAA@AA@@AAA@A@@@C@@AA@@A@@AAA@A@@AA@A@A@@A@@A@A@@AAAA@@A@@@@C@CAA@AA@AA@@@A@A@@@@A@A@AA@@A@@ABC@@@@BABA@ABABA@AB@@AB@@@@A@A@A@@@A@@@A@@AA@@@@@ABABA@A@A@A@@@AA@@ADA@@B@@ABABABAD@BBF@@@B@@@@@BA@@B@@ABAB@@@DAB@@@@ABA@A@A@@@AB@BA@@B@B@@@FBBB@@B@B@@@B@BA@AB@B@@@B@B@@@D@B@@@@@@A@A@A@A@@@@BAB@@A@@@@B@@@BBB@D@@AB@@AB@@AB@@@B@@@BAB@B@BB@BB@@BB@B@@@BA@@BA@ABAB@B@HBB@BBB@@@DE@ABABAD@B@B@@ABABA@ABC@@@A@@@AAA@@@ABA@AAE@@@@AA@@@CAA@@@A@@@ABC@@BA@@BC@@@@@A@A@AA@AA@@AA@@AA@@@A@@@@@A@@AA@@AA@A@@@AAA@@@ABA@@A@@A@A@@@@AAAA@@A@AC@A@@A@@@@ABAB@@AB@B@@@BA@@B@@@B@BB@@@@BBBB@@B@@@B@B@B@@B@@B@@@B@B@BADA@@@AA@@ABA@@BB@@@A@@B@BA@@B@BA@ADABAB@@A@@@@B@@@@A@@BA@@@@BABB@@B@@@B@B@BBB@@@@@@BB@@D@@@@DB@B@@B@@AB@@@B@@B@@BB@@@B@@B@@@@@@@BB@@@@@BA@@B@@ABA@@B@@B@@BB@A@@B@@@@@D@@BB@@@B@@D@@AD@@@B@B@@@B@@AB@@@BA@@BA@@DA@@D@B@BA@@@@BBB@@@@@@@@B@@BB@@@@BBD@@@B@B@@@B@@@@B@@@DBB@B@BB@@B@@BB@B@F@@@@@BBA@@@@@B@@@B@@@BB@@@B@@BBAB@@ABA@@B@B@@@@A@@B@BA@@BAAAB@BABA@@@A@@@A@@@ABAB@@A@@B@@ABA@@AA@A@A@@@ABA@@@A@A@@@AB@D@BB@@BAB@@@@BB@BBBB@@B@@@BB@@@@BC@A@@@@@@B@B@@A@A@A@@CA@A@@@@@A@@B@AA@@@@@A@@@AB@@@B@@@BABABA@A@@B@@@B@BBB@@BDA@B@@@BBAD@B@B@@@@A@@@@@C@A@@@A@A@@@@BAAA@@@AA@@@B@@AB@@A@A@@A@A@@@AA@@BA@C@@AA@AA@@@@@@A@A@@A@@AB@@AB@B@A@@AB@@@B@@@@AB@B@B@@A@@B@BAB@@AB@BB@ADB@AB@B@@@B@DABBB@@AB@D@@@B@@@BB@@BB@@@@D@B@@@@@B@@@D@@@B@DBB@B@@@BABA@@DAB@@@BA@A@AB@@A@@B@@@@AB@@B@@B@BBB@@@B@B@@@B@@@BB@@B@@@B@@A@@BBBBB@@@@B@@BAB@@A@@@@@A@@@@@@@@D@B@@@B@@A@@B@@@@AB@@ABA@A@ABABA@@BBB@B@B@BB@@DB@@BBB@@BB@@@BB@@B@@BB@B@B@@@DD@@@@BABB@@B@@ADB@@B@@@@BB@@@B@@B@@B@@BB@@@@@B@@@B@B@BB@@B@B@B@B@@@B@@@B@@B@BBB@BDBB@@D@BBB@@@@@@B@@B@ABB@@@@FB@BB@B@@@@@DB@@B@@@B@B@@@B@@@@BB@B@@@BBB@B@@@BB@@@@B@@@B@@@@BB@B@@B@@BA@A@@BA@@BAB@B@@A@CB@@@BAB@B@@AB@@A@@@A@BB@B@BB@@@@BBDB@@BB@@B@B@@@@B@@AB@@@BB@@@@BBB@@@BB@@@B@B@B@@@@@B@@@BBB@B@@@@@@AB@@@B@@@B@@@@BB@B@@@B@@@@@B@B@@@BAB@@@@@BABA@@B@B@@@@A@@@@B@D@B@B@@@@@@DB@@@DBB@@B@@B@B@@@@@B@B@@@BBB@@@BB@B@@B@B@@B@@B@@@@BA@@B@@@@@BDB@@B@BBB@@BEBA@@BCBID@B@@CD@BCDABA@@D@D@FBB@B@DB@@@DA@BB@@@@B@@@BB@@@BBB@@@B@DAJAB@B@@ABA@A@@AA@@@ABA@ABA@@B@B@B@BA@@@@BA@@@ABBB@@@BA@@B@@@B@@BB@@@@@B@@BDB@B@B@BB@@BD@@@@@@@@B@@BB@@@B@B@@AB@BBB@@A@@BA@@@@@BB@B@B@@@B@@BB@BAB@@@@@DAB@@@B@@@@B@@@B@@@@@BA@@B@@@@A@AB@@@B@B@@@BBB@BB@@@BBB@BB@@B@@B@@@BBD@BB@@@BB@@@FA@@BBBA@@BBB@@@DD@@BB@D@@@BB@@B@BB@@@B@@DB@@BBBB@B@BBBBB@B@@B@@BDBB@@BBDFBB@@@B@B@@@@ABABA@@BABA@@B@@@BBBBB@@@BAB@@B@@B@@BBB@BD@@BB@D@BBB@@@BB@@BB@@BBB@@@DB@@@@DA@@@CDAB@BA@@@BB@B@@@B@@@@@B@@@B@@@D@@@BA@@BA@ABAB@@@B@@@B@@BD@B@@@B@B@@@B@@@BA@@BA@@@@B@B@@@@AB@@@BA@@B@@@B@B@@BDBD@@@B@BAB@@@BCB@@@@@BB@@B@@BB@BBBB@BBB@@ABBB@BBBBB@@@B@@ABA@@B@B@BABAB@@@@@BBB@BAB@@@BBB@BAB@@@@A@ABC@CB@@A@AAA@A@@A@A@@@AA@@A@BA@@@ABA@@BA@A@@B@FA@@@AB@AA@@AAA@A@@@A@A@@@@@@ADA@@@@@A@@BC@A@@@A@@BABA@@@A@@@@@A@A@@@CBABCBA@A@@AA@AA@@@@C@@@@@A@@BAB@@AB@B@DA@@@@@AAA@A@ABC@@AAA@@AA@@AAA@AA@AAB@@@B@@A@A@@B@@@@A@A@@@A@@@AB@@@@@B@@ABAB@BAB@BABA@@BBBAB@@@B@DAB@@@@@BA@AB@B@B@B@B@@@B@@BB@B@BBD@DBB@DADAB@DAB@@ABA@ADABABAB@B@BBD@DBF@BB@@B@@@B@@BB@@@@B@@B@@@DB@@B@@@B@B@BB@@@BB@@B@@B@BAB@@BB@B@@B@AB@@B@BB@@BB@B@BABA@AB@BAB@B@@@@@@A@@@AB@@@B@@A@C@@B@@@B@@A@@BBB@BB@@@@B@BB@@@@@AB@@AB@@B@@F@@@@AA@@A@@BA@@@@@AB@@@B@BA@@B@BA@@BB@@B@D@@B@@B@@@BB@@@@BBB@B@@@@B@@@@AB@B@@A@@BB@@BB@@@B@B@@@B@@@@@B@@@@@BA@ABA@@@@@@B@@BB@@@D@B@B@@BB@BB@@@DBB@B@DDB@@@B@B@B@@B@@B@@@B@@B@@@BABA@@@A@A@@D@@@@A@A@@BA@@@@@AB@@A@@BB@@BA@@@@@@@B@@B@@@@@@@@@@@B@@@@B@@B@AB@@B@@@@@BB@@B@@@B@@BB@@@@@@@@B@@@B@BBB@BBB@@@B@@DBHBB@@@B@B@@B@@B@@B@@@@AB@@@BDDBD@@B@@BBB@B@@@@@BAB@B@B@B@@B@@@BD@@@@@@BBB@@@@@@@@@@BBB@BB@@B@@@BBBB@@@B@BA@@B@B@@@DA@AB@@@B@@B@@BB@@@@B@@BB@BB@@B@@@B@@A@A@@@@@@@ABA@AD@BB@@BB@@BB@@@BBB@@BB@BB@B@BB@@BBB@B@@A@@BA@@@A@AB@@@AA@@BC@A@@@C@C@A@@@@@AB@D@@@BA@@@A@@@@@A@@B@BAB@BA@@BAB@@A@@@@DBBBBB@@B@BBBB@@@@BABBBA@@B@DEB@BA@@@BB@BB@@@@BB@@@@BA@C@@@A@AA@@@A@@A@A@@@@B@@A@@B@BA@@BB@@B@B@B@@@@@B@B@D@@@B@@@@@@A@@B@@AB@B@BB@@BBB@BAD@B@@@B@@@@A@@@@B@@@@@@A@@@@@@@AB@@@@A@@@@BA@@B@@@BA@@B@@AB@BB@@B@AC@@AA@A@A@@BABA@@@A@AB@@@D@BAB@@@@A@@B@@AB@@A@@@@@ADA@@A@BA@A@@@@@@@A@A@A@A@@@A@@@A@AB@@A@@B@B@B@B@B@B@@@@@BBB@@@D@@ABBD@B@DBBAD@BAD@B@B@@@@A@ABAB@@A@@BC@@@@BAB@@@B@@A@@B@@@@@@B@B@@@B@@B@BB@@@BB@@AB@B@D@B@BA@@BB@@@@B@@A@@@@BA@@@@B@B@@@BDB@@A@@AA@@@@@A@@@@BAB@BAB@@B@@@@B@BB@@B@@@B@@@BABCB@@@B@B@@A@A@A@@@@@A@ABA@A@@@@CA@@@AA@AAC@AA@@@@AC@AB@@A@ABC@A@@@A@@B@B@B@@@BB@BBBBB@@@@DB@@@BB@@@BADAB@@@BA@ABA@ABCBA@@BA@@BC@@F@B@B@@@B@@@BBBBBBF@@B@@AD@@@BBB@@ABABCB@@@DBB@@@D@@B@BBBAB@@ABAB@BBB@@@@@BB@@ABBDABB@@@@B@@@@A@@@@B@B@@BA@BB@@@@B@@BBBBB@@@@@BB@@@B@B@@@@DB@@B@BB@@@@BA@@B@@@@@@@@@BB@@@@B@@B@@B@@@DA@@@AA@@AB@B@B@@@@@AB@@D@B@@B@@@@A@@B@@A@AB@@@D@@D@@@BBB@@D@DB@@@@@B@@B@@B@@@B@@B@@D@B@D@B@BBBDD@@@@@B@B@@@B@@@B@B@@@@@B@@AB@@@@@@@B@@A@@B@@@@A@@@@@@B@@AB@@B@B@@@BB@@@@BBB@@BBDB@@BBBA@@B@@@@@@@@@@AB@@AB@BA@@BBB@BAB@B@BBB@@BB@@A@@@A@@BA@@@@@@@@B@B@@BD@@@BBB@@@B@@AB@@AB@@AB@@@B@BA@AB@B@BA@@@@B@@BDDB@@@@BBB@@@B@@@@@@B@@B@@B@@@@@@@B@@B@@B@@@@@@@B@@@@@@@B@B@B@B@@@BA@@B@B@@B@B@@B@@@B@BA@@BB@@B@BBBABB@@B@@@B@BB@B@@B@@@B@@A@AB@@AB@@@@@@AB@@B@@@A@@BA@A@A@A@@@AA@@@@A@@@C@@@AAAA@A@@@A@@@@A@A@@@AA@@A@A@A@@@AA@@A@@B@@@@ABABA@@B@@@B@@@@@@A@AA@AA@A@A@@AA@@AA@@AAA@AA@@A@A@A@A@ABA@@@A@ACB@@@@A@@@@@A@@@@AA@@@A@@@@B@@@@@@@@A@@@@@A@@@@@A@ACAA@A@@@ABCB@@A@@@AA@AAAAA@@C@AA@AA@A@AAA@@@@@AA@AA@@C@@@A@@AA@A@A@@@A@@A@@@A@@@C@A@ABAA@@@@BA@@@@AAB@@@@@ACAA@@@AA@@@@A@@@@@A@AB@@A@A@@ACC@A@AAA@BAB@BA@@B@B@@ABAB@BA@@FA@@@AA@A@@@CBABA@A@AACA@@A@C@@@@@@A@@@@BA@@@@AAAA@B@@A@A@AD@@AB@D@B@@A@@BAB@B@B@BAB@BA@@@@@@@AA@@@AA@@@@@@A@@@A@@@A@@@@AAA@B@@A@@@@B@@A@A@@CA@@@@@A@@@A@@@@@@BA@@B@A@@@@A@@@@@@@@@A@@@A@@@A@@@@@@@@@@@AA@AB@@@@@@A@@@EB@@AA@AA@@A@@AAA@A@@@@A@@@@@A@@A@@@A@@@@@BA@@BA@@@A@A@@B@@A@A@@@A@@@AB@BAA@@AAA@@@AA@@@@@@A@@@ABA@A@@@@B@@A@@A@AA@@@A@@@AAA@@B@@A@@AC@AAA@@A@@@A@@A@A@AAC@@@AA@AA@@@@@@@A@@@ABA@A@@BA@@@AA@AAA@A@A@@A@@@@@A@@@@A@AAA@@@@C@@A@@@BA@A@A@@BABC@@B@@@B@@@@@B@@@AA@@B@@AB@BB@@@ABA@A@@BA@C@@BA@@B@@A@@BB@@B@BA@BB@BA@@@@@@FB@@B@@A@@A@AA@AAC@@@@@ACAAAAAAABA@@@@@AA@@@AA@A@A@@@A@@A@@AAA@@@@@@@A@@@A@@@ABA@A@@BA@@@@AA@AA@AA@A@@AAA@@A@@BA@@@@C@@@A@@@A@CA@@@A@@@AB@BAB@@@B@@@@AB@@@B@@@@A@@B@@A@@B@@AAAB@@A@@@A@@@A@AB@BA@@@@@@@A@A@@@ABA@@@@@A@@@@@A@@@A@@@@BA@A@A@AA@@@@@DAB@B@BA@@@@@AA@AAA@@A@@@A@@@@@CB@BC@AB@@@@@@A@@@@@ADA@A@AB@@ABA@@@AA@BA@A@@A@@@@@BA@@@ABAB@@@@BB@@@@A@@@@BAAA@@@A@@@@@A@@BAD@BAB@DAFA@@B@@B@@@B@@DD@B@@B@BB@B@@@B@@BBBB@@B@@B@@A@@@BB@B@@@@@@@@@B@@AB@B@@B@@@BB@@@B@@@BBB@B@B@@@@@B@@@B@@B@@B@B@@AB@@AB@@@@@BB@@B@@@B@@@@@@B@@@DD@@@B@@@@BBD@@@B@@@BBBAB@BA@@BBB@@@@@D@@@@@B@@@B@@@@@B@@@BBFAB@@A@@A@E@AA@A@@@C@A@AA@@@A@A@A@@@@@C@AAA@A@AA@@@@AA@@B@@AD@@@BA@@B@BA@@@@@CJ@D@@@BA@@BA@@@@@@BABA@A@@@@@A@@B@@@B@B@B@@A@@B@B@B@D@BA@@BA@@@@B@@AB@@@B@BA@AF@BBB@B@BA@@DBD@B@B@BA@A@@@@@A@@@AB@@@B@@@B@D@@@@AA@@A@@A@A@@@A@@C@@@@B@B@@@@@@AA@@@@@@@BA@@@@@@BCA@@@@@BA@@@A@A@AAAA@@@AC@BAA@@A@@@@@@@@@A@@@A@@@AA@@@AAA@@@AAA@@@A@@@@@AACA@AA@AA@@A@@A@@@@@@AA@@@AA@@@@@AA@@@@B@@CBAB@@BB@BBBB@BB@@ABB@@B@@@@CA@@@@@@A@@AA@@@AA@@AA@@@@AAABA@@@@@A@@@A@@@@@A@A@A@A@A@A@@@@@@@BB@@@B@B@BABA@ABABA@@@@@@BAB@BAB@B@@A@@@@B@DC@@@@AACAA@AA@A@@@@@@B@B@B@DA@AB@A@@@AAAAA@@@@A@AB@@CB@@A@A@@@@@A@A@A@A@CDADA@AB@@A@A@@AA@@@@@A@@BA@A@A@A@@@AA@AAA@@@AA@@@@@A@@@A@@@A@A@A@A@@@B@@@@@@A@ADA@@@AB@@AD@B@@AAA@@@AAA@@A@A@AA@@@@A@@@A@@B@@A@C@A@A@A@A@AB@@@@@@AA@BAA@@AA@@@BA@C@@BA@@@@@@BA@B@@BA@@@@@@@@BA@@B@AA@CAAAA@@@A@A@@@CF@@@@@@@BA@AD@@A@ABABCB@@@@@B@@@BA@@@@ACB@@@AC@AA@@A@@@A@AB@@A@A@A@A@AB@@@@@@@C@A@AA@@@A@@BA@@@@B@@@@@@AA@@@A@@@@@A@@AA@@@@A@@BA@A@A@C@C@@@@@@A@@A@@@A@@@@B@@A@A@A@@@A@@AA@AA@@@A@@@@@AB@@@@AB@@@AAA@@AA@@@A@@A@@AAA@@BA@@@@B@@@@A@A@@@@B@B@B@@AB@@@@AA@AA@@B@@@@AB@@A@@@@@@@AACE@@A@CA@@@AAA@A@@@AA@@@@@@BA@AB@@AA@@@@@@A@@AA@A@@@@A@@@@AACAABAA@@@AA@A@A@A@CB@@C@ABAAA@@AA@@A@C@A@AAA@@AAA@EAA@@@AAA@@@A@@@@@@B@@AB@BA@@@@B@B@@AB@@A@A@A@AA@AAA@@A@A@AA@@CAAA@AB@B@@@B@@@B@@@BA@@BAB@@@@A@@B@@A@@@A@A@@@@@A@@@@B@@@B@DA@AA@EE@AB@BC@@@@AAAC@A@@A@AAACCA@AA@C@A@ABC@ABA@ABA@AB@@ABA@A@@@AA@@A@A@AB@@A@@@AA@@A@@A@@AAB@BA@@@AA@@A@@@@@A@A@@@A@A@@AA@A@@@AA@@@A@@@ABAAA@AA@@A@@@A@@AA@@@@AA@@BA@@@C@@@A@@@A@@@ABA@CA@@@@A@@AA@@AA@@@A@@@@A@A@@@@A@C@@@@@A@@@CBABA@A@@@A@ABAB@@@AAAAAA@A@@@@A@@@AA@@@@@AAACA@AB@@A@AA@A@@@AA@AA@@A@@@AB@BGBA@AB@@AB@@@@@B@@A@B@AB@@BBB@B@@@B@@@B@@B@@@BA@AB@@ABA@@@A@@A@D@@AB@@A@@@BB@@@@@@@@@@AF@BAB@@CAA@@A@@A@@@AA@@AA@@@C@A@@BC@@@A@AAA@@@AA@CAECA@CAE@C@@@A@CAAA@AAA@AAA@AA@AA@AC@A@CAA@@@AB@@@@BBBB@@@B@B@BABAB@@@@BD@@@BAAA@@@AAA@EAA@A@A@ABA@CB@@C@@@A@ABA@EDC@C@A@@@ABAB@@A@@@AA@@EAA@@@@@@B@D@@@BA@CDAFABCD@@@BBB@@A@@BA@A@ABA@ABC@C@AB@@ADCDAB@D@BAF@B@@A@A@@@A@AB@@A@@@@@@@@@A@@A@@@@A@@A@@@@AB@@@@@@ABA@@@@@A@ECCAC@@AA@@A@@AACAAAA@A@CAC@@@AA@@E@AAA@C@E@C@E@@@AAEA@@E@@CC@A@@@A@@@CA@@@@A@CDCA@AA@@AA@G@C@@@@BB@A@@BC@C@@@@@@BAB@@@@A@@ACAAAA@A@A@@A@@@@@A@CA@@AA@AAAAEA@@A@A@@@@@A@AAAA@@AAAAAAA@@ACCAA@A@@BABC@A@C@@@ACBE@A@@@A@BA@ADCBA@@@A@@@AB@@@@A@@@@A@@@AA@@AA@A@@BC@AA@@@A@@AA@@@A@CBE@@AA@A@AA@A@C@A@A@AAA@@@A@@@AB@@@B@@A@A@A@@@@BA@@B@@@B@@ABA@@@AA@@@@AB@@AB@@@@A@@@@@A@@A@A@A@@A@@@AAA@@@AA@AA@@A@AA@A@AB@@A@@B@@A@AAAA@@A@@@A@@@@@A@@@@BA@@@A@@@A@@@ABA@@@@@@BA@@@ABA@@@@@AB@DB@@@@@A@@@@BA@@@@@@B@BA@@B@@@@@@C@A@AAA@@@A@@B@@@@@BA@A@A@@@@BAB@BA@@BA@A@@ACAC@A@@@AAA@AAA@@A@@@A@EBCBAA@@@A@AA@@AAA@@@@@@A@AB@@@@ABA@@@A@@@A@A@ABA@AB@BA@A@@@EB@@@@AC@@@@@@A@@@@@@AAE@@@A@A@@A@@@AB@@@AA@@@@@AB@@ADCD@@AB@@A@AAA@AAAB@@AA@@@@A@AB@@A@@B@@A@@AAAACAA@@@@A@@@AAAAAAAAACCCA@A@A@ABA@A@CA@@A@ABA@E@@@A@@@A@@@A@@@A@@BACA@@@@A@AA@@@@A@AA@@AA@@AAAA@@@@@A@A@A@@@@@CAC@@@A@@AAAA@A@AC@@@@@@AA@@E@@@AACA@AE@@AA@@AA@C@A@@@A@@CA@@C@@@@@AC@@AC@@@@AA@@@ABA@@ACAAA@@@@AB@@A@@@AAA@A@A@@@AAAAA@A@EAA@AA@AA@@@@@A@A@@@AB@AC@A@@@@@CDABA@ABCBA@C@@@"],encodeOffsets:[[120845,27790]]}},{type:"Feature",id:"350724",properties:{name:"松溪县",cp:[118.785468,27.526232],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@@@@@@BA@@@AAA@@@C@@@@@AA@@ABABA@AB@@A@@BA@A@@@A@ABA@@@A@A@AB@@A@@@AAC@AB@AC@@@@A@@AA@@A@@@@A@@AA@@A@AA@A@@A@@CAA@@AA@A@@@AA@@@ABA@AB@BC@@@AB@@A@@@@@@@A@@B@@A@@@A@@BA@AB@@@AA@@@@@A@@@@@@AA@@A@A@@AD@@@@A@@@A@@AA@@@AA@BA@@@@@A@A@A@AB@BA@@A@@ABA@@@@@A@A@A@A@@AC@AA@AAA@A@@@@@AA@AC@@CAAA@@A@AA@@@A@@@ABC@@A@@@AA@AA@@@@@@A@@A@A@AA@A@@A@@@CACA@@AB@@@@AAAA@AC@ACA@@@A@@AA@@AAB@A@@@@@A@@A@@@AA@@AA@@AB@@AB@BA@@@A@A@A@@@A@AB@@ABA@@@A@@@@@A@@@C@@BA@@@@@A@A@A@@@AB@@@AA@@@@BA@ABAB@@AA@@ABA@A@AB@@ABA@@@A@@@@@ABAB@BA@@B@@@BA@ADC@@BABA@@B@@@@A@A@A@ABA@A@@@@@@BABABABA@@BA@ABABA@@@@D@@@@@B@B@@A@@@@@A@@@BB@@A@@D@@@B@B@@ABC@A@A@A@A@A@A@A@@B@@BB@@AB@@@D@@@@@BB@@@@@@BBB@@BB@@B@@A@@B@B@@B@@B@@@@B@@A@@BA@@@BB@BA@@@@B@@@@@B@@@@@@BBBB@B@@B@@B@@B@@DA@@B@@@B@B@@@@@@BB@@B@@@@B@@@B@@@@@@AB@@BB@@@BA@@@@@AB@@@B@@@@@B@@@@@@@BA@@B@@ABA@@BAB@@@BA@@@A@@@A@@@AB@AAB@@@@@@A@@B@@B@@@@@@BA@@BA@@B@@A@ABA@@@A@@@@@A@@@@@A@AB@@@B@@A@ABC@@@@AA@@B@@A@AB@@ABABA@@@CB@@A@AB@@A@@@CB@@A@A@@BA@@@A@@@@@@B@@@B@@A@@BABCB@@A@@@A@@@@BA@A@A@AA@AA@A@A@@AAA@@@AABA@@@@B@@AB@@C@A@A@AD@@@B@@@@@@A@A@AA@@A@AAA@@B@@AB@@A@A@@@CB@@AAA@AD@@@B@@AB@B@@A@@@@B@@AB@@@B@B@@@@A@@@@B@@@@CB@@@@@B@@@@@B@@B@@B@@BBBD@@@@A@BB@@@@AB@@@@BBBAB@D@@@B@@@B@@@@B@@@B@BBB@@@B@@@DB@@BBB@@@@B@BBB@B@@BBBD@@@BBBB@BBB@@B@@@DABA@@B@B@DB@B@B@@@@@B@@@@@B@@@@@@A@@@@@@B@@BB@@@@@B@@@@@B@@A@BDB@@@B@BAB@B@B@B@@@BBB@BB@@BB@@BB@@@B@BBBB@@B@B@@@@A@@@A@@@ABAB@B@@A@@@@BB@@B@@@B@B@BB@@B@@@B@B@@B@@@B@B@BB@B@@@D@@@B@@B@@B@@@B@B@BAB@@@B@@@AA@@B@@@@A@@BA@@B@BA@@@A@@@@AAAA@@@A@@@AAABAAA@@@AA@@ABA@@@A@@@@A@AA@A@@@AB@@A@A@A@A@@@@@@@A@@@@@@@A@@@@AA@@@@@@@A@@@@AA@@@@@@@@A@@AA@A@@A@CC@AA@@@@@ABA@A@@BABA@@@A@@BA@@BA@@BA@@@A@AA@@C@@AA@A@@@@@@@@@AB@@@B@@@BA@@AA@AAA@A@ABA@AA@@ABA@@BA@@B@@@@@@@@A@@@AB@B@@A@@@ABA@@@AB@@@@@@AB@@@@@BB@@@@@A@@B@@BD@@@@@B@@@B@@@@@B@@@BB@@@AB@@AB@@@@A@@A@@@@@@@@A@@@@@@@@@A@@BA@@@A@@@A@@BA@A@@@@@@BA@@@BBB@BB@@BABB@ABB@@B@@BB@@BB@@@@BBABB@@A@AB@@@@@@@@@BA@@@@@AB@@@@AB@@A@@@AB@@@@@@BB@@B@@@@BA@@@A@@B@@A@@@ABAAA@A@A@AB@@@@@@BB@@@@@BBBB@@@@@B@B@@@BA@@@@BAD@@@BA@B@@B@@@@B@@BB@@B@@B@@B@@@BA@@B@@@@BA@@@A@@@ABA@A@@@AB@@A@@@AB@@@@@@@B@@@@@@B@@B@@@B@@@BB@@B@@B@BB@@B@@@B@@@B@@AB@@@@B@@BBB@@@B@@B@@B@@@@@@B@@@BA@AB@@@@A@@@@@@@@BA@@B@@A@@@AB@@AB@@ABB@@B@B@@BB@@AB@@A@A@@@A@@@AA@B@@@B@@@@B@@@B@@@B@@@B@B@@@@@@@B@B@@@@@@@ADA@@@AB@@A@@@@BA@AB@B@@AB@B@@@@AB@@@BA@@B@@@B@@@B@@@BB@@B@@@@@B@@@BB@@@@BB@@@B@AB@@B@@@@@BBA@@@@@B@B@@@@B@@FAB@@@B@@@@@B@@@@@B@@@B@@AB@BB@@B@@@@@BA@@BBB@B@@@@@B@@@BB@@@@B@B@DA@@BB@@@@BA@@@@@AA@@A@@B@@AB@@@@A@A@@B@B@B@B@B@BB@@B@BA@@BB@@@@@BB@@@BB@@BBB@BBB@@@D@@@@@B@B@@@B@@B@@B@B@@@@@BABABB@@@@@@D@B@@@B@@@BB@@@@@BB@@@@@B@@@B@@BB@@@@@@@B@@@BB@@@@B@@A@BB@@@@B@@@@@@BB@B@@@@@@B@B@@B@@B@@BB@@@@BB@@@B@@@@@B@@@@BB@@B@@B@B@@@B@@@@@@@BB@@@@@@BB@B@@BBBBBB@@@B@@@@B@@BB@@@@@BB@B@@@B@B@BBBD@B@BBBBB@@BBB@@@B@BB@@@BAB@BBD@@@@@@@@@BAB@BBB@@@B@BBB@B@@@BBB@@@@BBB@@@@@BB@@@@@BB@@@@ABA@A@BB@@@@B@@@B@@@B@@@@B@@B@@B@@@B@@AB@B@B@@B@@B@@BBB@@BBB@@BB@@B@@B@@@@@B@@@@AB@@@BB@AB@@@@@@BB@@B@B@@@BBA@@B@@@B@@BB@@BB@@@@@@@@B@@B@@BB@@@B@@@@@BCA@BAAA@@@A@@B@@@B@B@B@BA@@@@BB@@B@@BB@@A@@@A@@@@@ED@@A@@@@@A@@B@@A@BBB@@B@@B@@BB@@@BB@@@@@B@@B@@B@@@@@BB@@@@@AB@@@B@@A@@B@@@@A@@BA@@@@@AB@@@B@B@@@@@B@@@@@B@@@BBB@@@BB@@DB@@@@BB@@@BBBBBB@@@B@@@B@@@@BB@@ABBB@BB@@@BB@@@@@B@@@@@@ABEB@BAB@@@@@BBB@@B@@@@@@@@A@@@@@A@@BB@@BA@AB@@@@B@@ABAB@@@@A@@@AB@@@@@@@B@@A@AB@@A@@@A@@@A@@@CB@@@BB@@@B@@B@@@B@@BB@@@@B@@@@@BBB@B@@AB@@@@BBB@B@@@@AB@B@B@@@@DBB@D@@@@@@B@B@@@@@B@DBB@@BB@@@@ABA@@BA@@@@B@@B@B@BBB@@@BBBBBB@@@B@@BB@@@@B@BA@@B@@@B@BA@@@@@AB@@@B@@@BA@@B@@@BA@BB@@B@@BBB@@@B@BB@@B@@BB@B@B@BB@B@@BDB@@B@@@@B@@@BB@@BB@@BB@@@@@B@B@@@B@@@@@@@B@ABBB@B@DBBBB@@@BB@BBBB@@@B@BBB@@@@@BBB@@@BBB@B@DDBBB@@BB@@@@@B@DBB@@@B@BBB@B@@@@B@@BBBB@@@BB@B@BB@@@@B@B@@@BB@@@BB@@@@@BBBBB@@BB@@@@B@@B@BBB@@@BBFBB@B@@@BB@BB@@@BBDB@BBBB@B@B@@@B@@@@@B@B@@@B@@@B@@@B@DBB@B@BBFBDBB@D@B@BB@@BBBBBB@@FBB@@@@@B@B@D@B@B@DBDBBBDDB@BB@@B@@DBB@@@@@AFEDABCB@DCBC@A@ABC@@ACAAB@@ABCBE@EDEBAFEB@DA@CBA@C@A@A@@ACBCDGBCBECAE@ICECDGBCCC@ADAB@HCBCBAF@D@BA@@@C@GBCBCDABCBADGBCDGDGBEBC@@@ABC@ABCB@@AB@D@DAFCBCDEDCBCDCDCD@FBD@B@@CAEDEDC@C@CAAG@KBI@G@E@CAAGDEFEBEAECEEEA@@C@@@A@ABABADCBC@@@A@A@A@ABCFEB@DEHCBAB@DCBADGBCDEFE@C@A@@@@@@@@@@@@BCAC@AAAAA@ABABABAAA@A@C@A@CBABAB@@@B@D@@@D@@@D@@ABA@A@@@@@CAA@CAACAA@@AAC@C@EAE@A@@AABC@CAAAACA@A@ABC@C@I@KFEHCCEGAIAGECMIQKGAAQCGA@@EGEMCAC@AAAC@@@AAC@C@A@ABAFCDAD@BBFBFBB@BBBADA@A@ABGAA@CBAD@BA@CCCDCLD@ABABCBCBC@A@C@EAAAA@@@C@@@ABA@AB@A@@@AAA@@A@A@@A@AAA@@AAC@@AAA@@AA@@A@E@@AA@@@A@@@A@A@@AA@@BA@@BABA@@@@B@A@@A@@@@@@@A@@BAA@AA@@@AAA@AA@A@@@A@@@A@@@A@@@@@AAAA@@@A@@@@AAAA@AB@@ABA@@AA@@@AAB@AABA@CC@@AAA@@@C@ABA@@AA@@@A@@@@A@A@A@A@@@@A@AA@AA@A@CAA@A@@@@AAA@@@AB@@ADAB@@A@A@A@AA@@@@@AA@@A@@@ACCA@@@@AA@@A@ABAAABCB@@A@A@@@@A@@AA@@B@@AA@@@A@AA@@AA@@A@A@@@@A@@A@@@@@AB@@A@@B@BBB@@@B@B@@@@CB@AA@@B@B@@A@@@A@@@AA@B@@@@@B@B@@@BA@"],encodeOffsets:[[121677,28087]]}},{type:"Feature",id:"350723",properties:{name:"光泽县",cp:[117.334106,27.540987],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@AB@@AD@B@B@BAB@@AB@@A@@@CA@@ACA@AAA@@@A@@@ABAB@BAB@B@@A@@@@@A@@CAAA@AAA@@B@@ADADABA@@BC@@B@B@@@@A@A@AACAA@AA@AAA@AAA@E@CBE@A@A@@@ACC@CAECACAA@@@A@A@CBAB@@@A@AAC@EAA@CB@@A@@BE@@BAAC@A@AAC@C@C@C@GBE@AB@DAB@@A@@@@@A@A@@AC@@A@@ACAAAA@AAAAAA@AA@@ABCD@BA@ABAB@B@@@@A@@AAA@A@@AA@@@A@@@A@@@@AAA@A@A@A@ABA@A@A@@AA@@AAA@@A@A@ABABCDAB@@A@EAAAAB@@GB@@A@@@AA@@@AC@@@AAA@@@@@@@AA@@A@@@G@E@@@C@@@EACBKB@@A@@BBD@DBDDBBFBD@FAD@BAB@@@@A@A@@@A@C@A@A@C@C@A@EBCBIDCBCBCAAAA@@@A@@@@@EFCBCF@@CF@@AFBD@FBFBD@F@FAFAD@BBB@@BD@BBD@DADADCFAB@D@BDBBBB@B@DB@B@D@D@B@F@BBDLDBBFBFDFBDDBDDDBD@FBDBDBHBFBFAB@@ABABADA@@B@@@BABA@@B@@@B@B@D@F@LAF@B@B@@ABA@CAC@A@EDCBCDCD@DBF@B@@@BCD@DBDBDBB@DABCDCBCD@F@B@BBD@BDDBBDD@B@@B@D@@BABADA@A@@@ABAD@FADADCBAB@@@BAD@F@J@FBH@H@JAFABCFC@CBEBAB@BA@@B@B@BABA@BB@D@B@BADCFCDABABCBCA@@C@AA@AACACACCACAECACAAAECCCCCACAABADAFABC@A@A@CA@@CAA@A@@BCBCBA@A@A@C@CBC@CBCBABCBABABAB@BAB@BAB@D@DDFBF@DBD@@BFBJ@F@DDD@DCDADAD@@@@@D@BCBABCBC@AB@DBBDDBD@BABCBABCBCDABADADABABA@@B@@@@BB@@BB@BB@DB@D@BB@B@BAFCB@BBBDBDBFFJAD@DAH@DDFB@BBBD@@BDDBDBBBFBDDBDBBD@BBD@D@D@FBDBBAD@FAD@DAFCBCBCDEFEDCDCDABABA@C@AAC@CBCBADCHGLIBADCDAF@BBDBDBD@BBDBBBA@@BABA@AB@D@H@B@BB@B@B@B@BB@@BB@BKTGNADEDC@@B@@@@@@@DFNBB@BD@BBDBBB@BB@@DAB@@@BB@@@DBD@@@BDDDBBBBBBB@B@@@F@B@@@BA@AB@B@B@DABCBABA@@B@B@DBDB@@B@D@D@B@DADAD@BABA@@@@B@BBB@D@@@BA@@DAHAHCHADCDADBB@DBB@B@DBDB@BBBB@H@B@B@DBB@DBF@DCDB@@@@@@@B@BAB@H@D@DBD@DAFAB@F@@@B@@BD@FDFBBDBB@D@FA@@FBDBDBD@DBF@H@F@D@DADABBD@BBBDBDDFBBDBDBB@B@D@BAB@DADBBBBD@JDDBFDBDDBDD@DBDB@BBB@DADABAFAF@FDJD@BABAHCBBD@DAD@D@D@@B@@BBBDBBDD@BBBDBBDBF@FAFCFADCFAFCF@DBD@H@HBHBF@HBB@FBFBF@DADADEFCDED@@E@I@GBGDAD@@BLDRBBBD@BABABADB@@@BB@@B@@AB@@@B@@@B@@A@@@A@@B@@@B@BA@@@@@BB@DBBBB@F@H@HDLJ@LBBDBBDBBFHBDDB@@D@@@B@F@DAFCDCDCBI@CBEAC@CAC@ABA@CACAA@C@CB@DADABABC@@@AD@D@B@D@DB@@@@B@BBB@@@BBBB@B@D@B@@@BBDDDDDDFFJBDDDDDD@BBDBDBDB@@B@@@D@B@BABAB@@@B@BBB@@@B@BBBBFDDDB@D@F@H@FBFBDDBBDFDDJHHFDDDDBB@BBD@DBBBBDBFBDBDBBD@BBDBB@BBBBBB@DBFJFBBBF@D@BABC@@@A@ABABAB@DA@@D@@AB@@A@A@@B@DAB@B@@@BB@BFJDDHHHDHF@@JFFDDDFDBFBDBF@F@FBF@BDDFDFDFDBBD@D@B@B@BAB@@C@C@CBABABADAHAFADAFADBD@BADADADABCBCBADA@ABCAAAE@CAAACBEBGDCDCDADA@@HNLHJCDC@@AIAA@AB@BA@@@C@C@E@E@EBABC@AACACAC@@@A@C@ABC@@BAFADADCB@@ABE@CBCBA@@DABCDCBAAEBCAGAGBGBCB@B@BA@ADCB@FAD@@@DBD@B@B@DCBAFAJCD@BADAB@@@FAFADAD@FADCHEHCHADADA@ABCBCBCBEBCBCBABA@@DADABAB@@A@A@CAAAEAA@CAA@CAA@@@A@A@AAE@A@A@@@@@ADCBA@@@@@A@@@@@A@A@@@A@AAAAA@@BA@C@A@@@@@A@@@A@@AA@A@@@@A@@AA@AAA@A@@@AA@@@@AA@@@@A@A@AA@@A@@@AA@@A@@AA@@@AAAA@@AA@A@A@A@@A@@BCAA@@@@@AAA@AA@@AAA@@@@AAA@@AA@@@A@ABA@AAA@A@@@A@AA@@C@@AA@A@C@A@A@@@C@@@@A@@@@AC@CAA@@@A@A@A@@BA@@@A@@AA@@A@A@@@C@A@A@A@@BA@ABC@A@A@@@A@AAA@AA@CE@@AA@A@A@CAA@@CCCAAAA@C@C@A@A@A@@@@AAA@A@@@CA@AACA@AA@@@@@BA@A@GCM@AA@AAA@@@CC@ACAA@@A@@@ABA@@@A@A@@@@BA@AB@@C@@@AB@@AB@@@AA@@AA@@@@A@@AB@@@@@@AA@A@E@A@@@AA@@@AAC@AAAAAAAACA@@A@A@@EECC@AAAAAC@CAC@C@A@@@CACCAA@@A@A@ABABA@A@A@AAA@@A@@@A@AAA@@A@AAA@AAAAA@AA@A@AAAA@C@A@@AACA@BADA@@BA@@@A@A@A@ACG@@@A@AEG@AAC@ABA@@F@BA@@@@BC@A@@@A@AA@CC@@AC@A@@@@CA@AA@@AAECI@C@A@C@C@A@@A@@@CAC@EAA@CACCACAAAAA@@DAD@@@B@BCB@BA@A@A@@@AAA@@@@@@@ABA@AA@@@@A@@B@@A@@@@A@AC@AAAAA@C@AAABA@@@AAC@@@AA@@AB@@CAAA@@@@@@AB@@@@A@A@@@@@@BA@@@@@A@A@AB@@A@@@@@@@A@@@@@@@A@@@@@A@C@@@AB@@@A@@BABCBC@AB@@AAA@A@@@@A@A@AAC@BABC@@@A@A@C@C@@AC@A@@AAAA@@AAA@AA@ACA@@@AAC@A@A@@@AB@@@AAC@@AABA@@@A@CF@BA@@@@@A@@@A@@@A@C@C@A@CB@@A@@A@@@A@C@A@AA@AA@C@@A@@@A@A@@@A@AA@@ABCBE@ABAB@@ABAB@@A@A@AA@@AAAE@AAA@AAAA@@AAA@@AAEAGE@A@C@@A@A@I@C@CAA@@AA@CAA@@@A@A@AACAC@CA@@@@ABABA@@@A@@@@@AG@A@@BC@@@A@@AAAAACAAAAAC@AA@AA@AAA@AB@@ADADABCBADABE@@BC@AAC@E@C@EAC@ACC@A@@@@@@BA@@BAD@BA@@@C@C@A@@@ABC@EBABA@AB@DCBADABADCBAD@BA@@A@C@C@ABC@@@ABA@CB@@C@A@@@C@C@@BCBABA@ABA@C@E@AAC@AAA@A@@@A@CBA@@@CAAA@@CACAA@A@@@C@A@A@AAAA@@CBA@A@A@A@ABABA@ABAB@@A@C@A@AAA@AACC@@CAAACCCCAAAAAA@@E@@@AAA@A@A@@@ABCBA@@B@@A@@@@@@@@AA@@@AA@@@AAC@AAAAA@@@@ACAAEAA@A@A@EDA@C@@@AB@BCDABAD@@ABABA@@@AA@@A@A@C@CA@@@@@@DCBABA@C@@@AA@A@C@AAAA@@A@BA@@@@@AAACC@A@@@ADEDABC@@@A@@AAA@AAAA@@AA@A@A@C@C@@AE@AA@@@A@A@ABA@A@CBA@CA@@CAA@AAACAA@@AA@ABC@A@A@@AA@@@ABA@A@@@@BA@@B@@ABABABABC@AB@@ABA@@A@AAACAAAA@A@A@@@@@AAAAA@A@@BA@@BEB@BA@@@A@@@@C@AA@@AAA@@AAA@@@A@C@@@@AAA@A@@AAA"],encodeOffsets:[[120073,28014]]}},{type:"Feature",id:"350725",properties:{name:"政和县",cp:[118.857642,27.366104],childNum:1},geometry:{type:"Polygon",coordinates:["@@@C@A@AB@BC@@BA@ABA@A@A@@@@BABA@@BA@A@ABA@A@@@A@A@A@@@CB@@A@AB@@@@AA@A@@@CA@@@AA@@@A@@@@AB@@A@@@AA@C@E@C@C@ABC@@@@B@@@B@B@B@@@@@B@B@@CBABA@AB@@@@@B@B@B@BAB@@ABA@A@A@A@@@A@@@AB@@@B@B@B@BB@@@@B@@@B@@EDAB@@ABA@A@A@AB@BEBEDAB@@@B@B@B@B@@@@@@@BDBBB@B@B@@@B@@AB@@ABB@@B@@@B@@@@@@CAA@A@A@CB@BA@@@E@AAA@A@@@A@@@A@@@A@A@@@@B@B@B@B@B@@@@AAAAA@@@BB@B@BABABA@@AA@@ABCB@A@AAA@AAA@A@A@A@@BA@@@@@@@@A@@@A@@@AA@@@A@@@A@A@A@@@A@@B@@@B@B@B@@BBBB@@@@@@ABA@A@@@@@AB@C@@@@A@@@A@BA@@@@AA@A@@@@AA@@A@@AA@@B@@@B@@A@BB@BA@@@A@@@@@@BA@A@@@@BA@@@ABA@A@@@A@@@@A@@@A@AC@C@A@@@@A@@ABA@@B@@@AA@@@A@@@@@A@A@@@A@@BA@@AA@@@@A@@A@AA@@A@@@A@@@A@A@@@A@A@AB@@AB@@AAAAA@@AAA@@AAA@AAA@@@@@@@A@@@A@@@AB@@AB@B@@@B@@@B@@A@@@@@A@@@@AA@@@A@@@A@@A@@@AA@BA@@AA@@A@A@@@A@@@A@CB@@@B@@@@ADABAA@@AB@@A@@B@@@@@B@B@BAB@@@BA@@@@@BBB@@@BBBDBB@@@B@@@B@@BB@BB@@B@B@@@@@@@BD@@@@B@@@@B@@B@@@@@B@@@@A@ABA@@@@@@B@@@A@@AB@@A@@@@A@@ABAB@BA@@@@@AB@@@D@D@@AB@@@@BB@@@BBBB@@B@@@B@@CB@@AB@B@B@@@BBB@@@AB@@@@@B@@BAB@B@@@@AB@@@B@@@@@B@BAB@@ABA@@BA@A@@@A@@BB@@@B@BB@@@@@@BB@B@@@@@@@B@B@@@@@B@@@@A@@BA@@@@B@@AB@BAB@@ABA@@@A@CAA@CAA@@@CBA@@@CB@@AB@@A@@BA@ABAB@@@@@@AA@@CB@@@@AA@@@@A@@B@@@@A@@@C@@@AA@@EBC@@@A@A@AB@@@@@B@@AB@@A@@AA@@@A@A@@@AB@BA@AB@@@@@BA@AB@@@@A@@@A@@@@@A@@@@B@@@@@@@BA@@B@@ABA@@@ABAB@@ABA@A@@@ABABA@@BA@CB@BC@@@AB@@@A@@@@A@@@AB@@A@@@A@@A@A@@A@@@@B@@@BA@@B@@A@ABA@AB@@EA@@A@@@A@A@A@@B@@AB@@EDA@@B@@@BA@EDAB@@@B@@@B@@ABCBABAB@@@B@DAB@@A@@@@BA@BBD@@BB@@@F@@@BBB@@@B@@@@@BB@BBBA@@B@@@@A@AAA@@B@@AB@@@@@@A@A@@@A@A@AACBAAC@A@@@AA@@A@@BCDAB@@@@A@A@@@AAAAA@@AA@@BA@ABAD@BAB@BAB@@@@BB@@@B@ACB@@@@@B@BA@AAA@AB@@A@@@A@A@@BDB@B@BB@@B@B@@AD@@A@ABA@A@BB@@BBF@B@@BB@@B@@@B@@B@@@@@A@CB@@AD@@AD@@@@@@@B@@@B@B@@BB@B@@@@AB@@@B@BABB@@BBB@@@B@@@@ABA@@@AB@@@BAB@BBBA@@@AB@@@BB@@@@@ABABA@C@@@@@@B@@@@BB@@@B@B@BAB@BB@@BDBB@DD@@BBBB@@@@C@ABA@A@@@AA@@A@A@C@A@@@A@CBCB@@@@ABAB@@AB@@A@A@ABA@A@A@A@@@A@A@@@ABA@ABC@A@@@A@AA@BA@@@A@@B@@ABA@@@@B@BABA@ABA@ABA@A@@@@@C@@AAAA@@@AB@@AAAB@AA@@@E@AB@BAB@@A@@@A@A@@@A@A@AA@@A@@@E@@@A@@AABAA@@@A@@AC@@A@@@@A@AA@AAAC@@AA@@AAAA@@CBA@ABAA@@A@A@BA@A@AB@@@@@A@@@A@A@@@A@@BA@ABAB@@AB@@AB@A@@AAAAA@@@A@ABAB@@@@AA@A@AC@AB@@CB@@@@A@@@@B@D@@@@@BBB@BBB@BA@@@@B@@BD@B@@@B@@@ACB@@@@AB@@A@@B@@A@@@AA@@AB@@@@@B@@@B@B@@@@AB@BA@@@A@@BA@@BA@EDA@A@@B@@@@ABA@@@ACA@@AC@A@A@A@@@AA@@@A@@A@@@ABABA@CB@@@AA@@@@A@A@@@@@@@AA@@@@A@@A@@BA@@BAB@B@@@B@@BB@@AB@B@@@@A@@@@@A@CB@@A@@@@@C@@@AB@AA@@@AB@@ABA@AAA@C@A@@@C@@@A@@@C@@@AAA@@@AB@@A@A@@@A@@@A@A@A@AAA@CAA@A@CD@@A@CBA@AB@@@AA@@AA@C@@BCB@@AB@@@@@B@@ABA@A@@A@@@@@AA@AB@@@B@@BB@@@@A@A@@@A@@@@@A@@@A@@A@@AB@@A@A@A@ABA@@B@@@B@@AB@@@@B@@B@BA@@@A@@@@BA@@B@@@BA@@@@@AB@@@@BB@AB@@BA@@@BB@@@BA@@B@@@B@AA@@@AA@AAA@@A@A@@@@B@B@B@@AB@@@@B@AD@@@@@@AB@@@@@@B@@B@@@@A@A@@@@@@DB@@@B@B@@@@BB@@B@@C@A@@@A@@@A@@B@@@B@@@@@BABA@A@C@CA@@ABA@A@AAE@@BABA@@@A@@BA@@@@@AB@@ABC@A@A@A@@@@BA@A@A@@@A@@@@B@@@@@BABAB@@@@@@AB@@AB@@C@I@@D@@@@ABA@@@AB@@C@@BA@@@BB@@@@BBB@B@BBD@@@@@B@B@B@@@B@@B@B@D@@@BBBF@B@@@@BA@EBAA@@A@@@@@A@@@A@@@@@C@@@@@A@AA@@ABA@ABAA@@A@@@C@AA@@@@@A@@CC@@A@@@@@@@@A@@@AA@@A@@@@BA@@BA@@@A@AA@@@@A@@@A@@@@@A@AAA@A@@@A@@AA@@A@@@@ABA@@@A@@@@@@@@@AAA@@B@@@@AA@@@AAAA@@@A@@@AAAA@@@@ACC@@@A@@@AA@@@EBCBA@ABC@AB@@@B@@@@@B@@BBAB@@@@@B@@A@@A@@A@AB@B@@AB@@@@B@@@@BABB@@B@@AB@BA@@B@BCB@B@@@@@B@@@@A@@BADA@@D@@@@@B@@@BB@BBB@@B@@@@ABA@A@CB@@@@B@@B@B@BAB@@@@@B@@@B@@@@@B@@@@AB@B@@@B@B@@@@@@ABA@@B@B@@@B@@@BA@BB@BA@@@@BA@@@@B@@A@@@A@@B@@A@@@A@ABA@@B@@@B@@DBB@@@B@@@D@@@@@AB@@@BB@BB@@@BBBB@@BBB@@@@AB@@@BAB@B@@@B@@@B@@@@@@BB@BB@@@@B@@@B@BBB@@B@@B@@@@ABBBBBBBDB@B@@@@BD@BBBB@@@@BA@@@EA@@@@@@ABA@@AABA@@@AA@@@BA@@@AB@@@DAB@@@BAB@B@@AAA@@BA@@@BB@@A@@@@@A@@@A@@@ADAB@@@B@BAB@@B@@@@D@@B@BBB@@@@@@B@@@@@BB@B@B@BBB@@B@@AB@@@BAB@B@@@B@@@@@@BBB@@B@@BD@B@B@BB@@@B@@@BB@BBD@@@BA@@@BB@B@@@B@@BBB@@@@BA@@@@@@BAB@B@@@B@@@@B@@B@@BB@BB@ABA@@B@@@B@@@B@BA@@@@B@B@@AB@@AB@@@@B@@@B@@@@B@@@@@B@@B@B@BB@@@BB@@BBB@@FA@@B@@@@@@@BAB@@B@@@@@@@@@@@B@@@B@@@B@@@@@@@@@B@@B@A@@@AB@@@@@@@B@@@B@@@@DB@@@B@BA@@@@@@BA@B@BB@@@@@B@@@B@@@B@@@@B@@B@@BB@@@@@@B@@ABA@A@A@ABA@AB@@@@A@CBA@@BCB@B@@@@ABBBB@@@@AB@@@@@B@@@@D@B@@@DBBBB@B@BADA@@B@B@@B@@EB@@ABA@AB@BA@A@@@ABA@ABB@BBB@D@BD@@@B@BA@@B@@@@DA@@@@@A@@B@@@@@@A@A@@BA@@@A@@B@@@@ABA@@@A@@BCB@BB@@DA@@B@B@@@BA@@@AB@BBB@@@BBB@B@@@@@@@@A@@BCB@B@D@@@BA@@@A@@B@BA@B@@BB@BB@B@B@@BBBB@B@B@@A@@B@@@B@@@DABA@AB@@@@A@@@A@@@@B@@@B@@AB@B@@@DA@@B@@@BAB@@@DA@@B@BABA@@BAB@@@@AB@@B@@D@BAB@@@@A@@BAB@@@@@B@@@@@B@@@B@BAB@@@@AB@@AB@@A@@@@A@@@@AB@@@@@@@BA@BBA@@B@@@B@@@B@@A@@BA@AB@BA@@@AB@@A@@@@@@@A@@@@@A@@BA@@@@B@@A@@AA@@BA@@@@@@@A@@@A@@A@@@AA@@@@@@@A@A@@@AB@@CA@@@@AA@@@@AAAAA@@@@@@@A@@@@@A@@B@@AAA@@B@@AB@@@@A@@A@@@@AA@A@@@@BA@@@AA@@AA@A@@@@A@@A@@@@@C@@BA@@AA@@@AB@B@B@B@B@B@B@D@BA@@@A@A@@@CB@@@AA@@B@@@@@B@@@@A@A@@@@@C@@B@BABAB@@AB@BABABA@A@@@@B@B@BAB@B@B@@@@@@AB@BA@AD@BCB@@A@@@AB@@ABABA@@@@B@@@B@BA@@BAB@B@BA@@BB@@BABAB@@A@@B@@B@@BA@@B@B@B@@@@@B@@AD@@@B@@@@@B@@@B@BA@@BAB@@@B@B@B@@@B@@ABA@@BA@@BB@@BB@@B@@@@B@@@@@BBA@BB@@BB@@@B@BDD@@BBBBB@@@@BA@@DBDB@@B@@@@BBBB@B@@@@B@@@@B@@BBB@@B@@@AD@B@@@B@@BBB@@@BBDB@@BDB@@B@@@@@BBB@BBBD@@BB@B@B@B@@@@@B@BA@@@BB@@ABAB@B@B@@@@@B@@ABB@@B@@BB@@@B@@@@@BC@@@B@BB@@B@@@@B@@@@@B@@B@@BAB@@AB@@@B@@@@AB@@@@@@@B@@@BA@@D@@ABAB@BA@@B@@B@@@BBB@@BB@DB@@@@BBBB@@@BB@@@B@@B@@@BB@@@B@@D@@BBAD@BB@@B@@@BAB@B@@@B@BAB@@@B@B@@AB@@@BAB@BABA@@BB@@@@D@@@B@BB@@B@@A@@@@B@@BB@@A@@@A@A@@@@@ABB@@B@@@B@@@@A@AB@@BDA@@@@@A@A@@AA@A@AB@@@BA@@@@B@@@@B@@B@B@@@BB@@BBB@@@B@@BA@@@BB@@@B@@B@B@@@DABABBBAB@@@BB@@@@DBBD@@B@@@BB@@@@B@@B@B@B@BA@CB@BA@@B@@BB@B@@B@B@DBB@B@@BBBB@@@@@@B@B@B@B@@B@@@B@@BB@BAD@@@B@BB@@DDB@BA@BBA@B@@BB@@AB@BA@@BBBBB@@@@@B@@BBBB@@@@B@@@B@@@B@@@B@B@@BBB@B@@BBB@AB@@@B@@@@@@@BB@A@@@@@ABAB@@AB@@BB@@@B@B@@@B@@BB@@@F@BB@@BB@BB@@BD@BB@BBB@@@@B@BB@BB@@B@B@BBD@BABADADEBCBCBEAC@C@A@ADAB@FAFAFBD@FBBA@BD@B@F@B@D@FBD@D@DAB@BA@CBADAD@DAD@B@DADCBCBA@@BADBBBDHBB@DBDBD@BADADCBFDHFD@D@FBDBFBBAD@D@D@DBDBBD@DAFBDBB@DEFEDA@AD@FNHBBHGHEF@H@JBNBHAFGLEDGDCDCDCFCD@DFNCFCB@BE@E@AHCFCDADBBBHFBBB@B@B@B@DA@ADEDCDAD@D@DBDBDFBBB@BBBBDDDBHDFBDBDDBBBBF@FADADABAB@DCBCBC@CBEBE@CA@C@K@ICBCHEDC@A@A@CAA@A@@AC@AAGAC@C@ABAB@DADEB@B@D@B@BBDB@@D@D@HADC@@BA@@@A@EBAB@@@B@BBDBBBB@B@BABADADBB@DBBB@@B@@@BA@ABAB@D@F@@@FDDBD@B@@ADADABAB@DAFADEBAB@D@DBD@FABB@@BBDBBBD@FADADAB@FC@@DABBHBHBD@BABABABBB@BBBBBABB@L@FFDBABE@I@E@@DBBBHDHAFA@@@AA@@AAA@A@A@@@@@@@A@@@AAA@@BA@A@A@AB@@A@ABC@AA@@@@@BA@@@@@A@A@@BA@@BAB@@A@@@A@@B@@@@A@A@A@@@@BA@@B@@@@AB@@@B@@@BA@@B@@@BA@A@A@ABA@A@@@A@@BC@A@@@AB@@AA@@@@AA@@@@A@@AA@@AAA@@A@A@@AAAA@AA@@@@A@@@A@@A@@@AAC@C@@@A@@@A@@@@@@@@AB@@@@ABA@@A@@AA@@@@@@B@@@@@@A@@B@@A@@@@BC@@@@@E@@@BA@@BAA@@AA@@A@AAAB@@@@@@A@@@A@C@@@A@@@ABABABA@AAA@@AA@@@A@@@@@@A@A@@@@AA@AAA@C@@@@@@A@C@@BA@A@@@@@@CA@@@AA@@@@C@@@@@A@A@@@@@@AAA@@AAAB@@AB@@A@AB@@@AAB@@A@@@A@@AAAABA@@BA@A@@@AA@I@@@@@AB@@A@@@A@E@AAAA@@@A@@BA@A@@AACC@@@A@@A@@@@@A@CBCB@B@@AB@@@@CCA@@AAA@@AA@@@A@@AAAA@@@A@AAA@AAAAA@@@A@A@A@@@ACA@@@@@A@A@@@@@AB@@A@A@A@AAC@ABA@@AA@@A@A@@@@@A@@A@A@@@A@@@AB@AA@AAA@ABA@@B@@@@A@@BA@@@AAI@@AAAA@@AA@@B@@@B@BABADA@AB@ACA@AAA@A@C@AB@@@A@@@@@AB@BAB@@@BABABABA@@B@BA@AB@BA@@F@BABA@ABC@ABA@@BAB@DA@A@AB@@@DA@@B@BABABAB@@AB@@@B@@@@BB@@@@BB@@BB@D@B@@@D@B@@A@A@@@AB@@A@@BB@@@@BB@@@A@@B@@@B@@@@@BADEBA@@@@@B@@@B@B@@BB@@B@DA@@@@@A@AB@@AAA@A@C@AB@@A@@DAB@@ABA@@B@@A@A@@BC@@@A@@@@BA@@BBB@@@B@@AB@BAB@BC@@AA@AA@AA@@@A@A@ACEA@AA@A@C@@@AAA@A@@A@@@ABAB@@@D@B@@@BA@@BA@A@A@AB@@A@@@AA@@AA@@A@@AABA@@@@AA@@C@@@AA@@@A@@@@@AB@B@B@B@BAD@B@@AB@@ABA@C@@@AA@A@@A@@@A@@@ADA@@@@BA@A@@B@B@BB@@B@BB@@B@@@D@@@BA@@BAB@B@B@B@B@@A@@@A@ABA@A@@AA@AAAB@B@@A@CBAB@@AA@AAA@@A@A@@@A@AAA@@@A@@BABAD@@AB@B@B@@@@BB@@A@A@A@A@ABAAA@@A@@@AA@@A@@@A@ABAA@@@AA@@@A@@@A@@@@@A@@@A@@@A@A@@@@@A@@@A@@AC@@@A@@@@@A@A@C@A@A@A@@@@A@A@CBA@A@A@@@AAACAA@@A@@@E@@@C@A@C@CBABA@@@A@@@A@A@@AB@@AB@@AB@@A@@@A@@@A@@@@AA@@A@@A@BA@@@AA@@AC@A@@@A@A@@BABAB@@AAB@@@AA@@@@@@@AA@@@ABA@A@@@@BA@@@AB@@A@A@A@ABA@ABA@AA@AACAA@@AAA@A@@BA@@AA@@AA@@@A@@B@B@@B@@BBB@@@DA@@@@B@@B@B@BBB@@@ABAB@@@B@@@B@@@B@BAB@B@@@@AB@@AB@@A@@@A@A@@@A@@A@@@@ABABA@A@@@@@@AAA@A@@A@E@A@AB@BA@@@@@A@@A@@AA@@A@A@@@@@A@AA@@@A@@AAA@A@A@@BABAAA@AA@AA@@@@@ABA@ABA@ABA@@B@@@@@@@@@B@@AB@@@BA@@B@B@BAB@B@@@BA@@@@BA@@@@B@DB@AB@@@@A@@B@@@B@@@@@B@@@@ABCBA@@@AA@AA@@@@AA@A@@@E@@AAAA@@A@@@CB@@@@AAAAAA@@@AA@@A@@A@@@@@@@AB@@@BA@ADA@@@ABABA@A@@BA@A@@@A@A@@@@@AC@@@@A@A@C@C@A@A@@@A@@A@A@@A@AAG@C@AAA@A@@A@@@@@C@@AA@@AA@@@@@A@@@@AA@@@C@@AA@A@@@AB@@AB@@@BAB@@@@A@@@@AC@@A@@AA@A@@@C@@@A@@@@@@AA@A@CA@@A@A@CAEAA@A@@@A@@@ABE@@AA@CAAA@@C@C@C@AA@@A@@A@@BA@@@A@A"],encodeOffsets:[[121988,27733]]}},{type:"Feature",id:"350781",properties:{name:"邵武市",cp:[117.492533,27.340326],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@A@AA@CAA@@@@A@@@AAAAACA@ACAA@@@A@AA@@@A@A@AB@@A@@@@A@@AA@@@@CBA@@@A@@BA@@@A@@BA@@@CA@@@@@@@A@A@@@AA@@@CB@@AA@@AB@@A@@B@B@@AD@B@@@@ABA@@@A@AAA@A@A@@@@@@@A@A@@@@@@@@ABA@@@A@@@A@@AAACACCA@A@@A@C@@@@@A@AAA@C@A@CAA@@@C@@@A@A@CA@@@A@ABAA@@@AAA@A@@@@@@@BA@@@@AA@@AB@@@@A@@@@B@@@@A@A@@BABA@@@@A@@@@A@@@A@@@ACEC@AA@AAA@A@AB@@AB@B@@@F@D@@C@AB@@AB@@@B@B@B@BAF@F@@@@AB@@@BA@A@@BA@A@A@@B@@A@A@@@A@@@@@@A@A@E@@@A@@AB@@@B@@@@ABA@A@A@@@@@@A@@@@A@@A@@AA@@C@CBA@ABA@@@A@@AECA@A@@AA@@B@@CFABA@@BABABA@AB@@@B@@@B@@@@@@A@@BAB@B@@A@ABABAB@@@@@D@F@B@D@BBDBF@BBB@@@@CBAB@@@B@@@DAB@D@B@BBD@@@B@@@B@@@@@B@B@@BBB@@B@@B@AB@@AB@DA@@B@@A@@B@@@BB@@BB@@@BB@@BB@@@@AB@@@B@@AB@@EB@BA@@@@B@BA@CBA@@@AAA@A@A@@@@@A@A@A@@A@@AACAA@@@A@A@A@@@C@A@@B@@ABBBA@@@@B@B@@ABAB@B@@@@ABA@ABA@A@A@AAAA@C@@@CB@@@@AB@@AB@@@@AB@@AB@@A@@@A@A@@A@@A@@A@@@@@AB@@@B@B@@@@@@A@@@AB@@@@A@@@A@@@A@@AAA@@@A@@@@BA@@A@@AA@A@AAABAB@BAB@@@@A@C@A@AAE@C@@@A@@@AB@D@@@B@@BDBBABA@CBA@@@CA@AA@@@CA@@A@@@CB@@ADAB@@@B@BABAB@@A@AACA@@AB@@@BAB@@BB@DBB@@@BA@CAE@AA@@@A@CAA@@CAC@@@CBCBC@@@A@CB@@AA@@A@AAA@@@A@C@@@A@@@@@@@@D@@BB@B@B@B@@@@@B@@B@BB@@BB@B@@@BA@@@@@@BB@@@BBA@@@CBABA@A@@@AAAA@@@ACA@AAC@@@A@C@@@@AAA@A@CAA@CAA@EC@@@@CBA@@AA@@AACAAA@AAA@CCA@@@A@@@AB@BA@AD@@AB@@AB@@@FAD@B@BBB@@@@@B@@@B@D@BB@ABA@EAABA@@BAB@@BB@@DDBB@BBB@@@B@BABAB@@@BBD@BAB@B@BAB@B@D@@AB@@@B@@@@CBC@@@EAA@A@@A@@AC@AAACC@@CBCBCB@B@@B@BB@@@D@@BFB@@@@@BBB@@@BBB@@@@B@@@@BB@@DFFFDF@@@B@BA@CB@@A@A@G@ACA@AAAAAA@AG@A@ABAAA@A@AAEEA@@AAAAAAA@@AAA@GBAB@BAB@@AB@@@BA@C@AD@@DB@B@@AB@BAB@@@D@@@B@D@@@B@@@@A@A@A@@@AACA@@@BA@@@@BA@@B@@E@@@@BA@@@A@@A@@@A@@@@A@@@A@@BAB@@AB@@@BBB@B@B@@AB@@A@@@@B@@B@@B@@B@@@@@BB@@BBCB@BAB@B@B@BBB@BB@@BB@B@B@@@@@BB@@AD@@AB@@B@@BB@@B@@ABED@B@@A@@@CA@@AAABA@A@CDA@CAA@EBABA@@B@AA@@BA@@@AAA@@@@ACAA@@@@B@@A@@@@@A@A@@@@@A@@@@A@@A@BAA@@@@AA@@@@@AAC@A@A@@B@@AB@@AB@BA@CBABA@A@C@ABA@@BA@CB@@@@A@@@A@AB@@A@@@AB@B@@AFCB@B@@A@CB@@AB@@AA@@AB@A@@A@@@@@A@@@@@A@A@@@@@A@@@@B@@ABA@@@A@A@@@A@@@A@@@AB@@ABA@A@CBEB@@@BAB@B@@A@A@A@C@@BA@@D@@@B@@@@CBA@A@A@@BE@I@C@@@CCCCA@@A@@AA@C@@@@@A@A@@@A@@A@@AB@@A@@AAA@@A@A@C@AB@BA@@@@B@@B@@B@B@@@BBBB@@@B@@@BB@@@@@B@@@B@@@B@@@B@@@B@@@@BD@DA@A@@@A@@@A@@BA@@@C@E@A@@@@BAB@@@@AAAA@@@BC@@@@AA@@@A@A@@@@@@A@@@A@A@@@@@AAAAC@@@@AAAAA@A@@@AB@@@B@@@@AB@@@B@@@B@@A@@@@@@BA@@@@@A@@BAA@BA@@@A@@@AA@@AB@AA@@@@AA@AA@@A@@B@@@@@@A@@@@@AB@AABAAA@CA@AA@@@A@@@A@@@I@C@A@@@AA@AAA@A@ABA@@@@A@@A@AAEE@@AAA@ECCCEACA@@@ACG@AAA@@BCAA@A@@AA@AC@@@AAA@AAA@@@AAA@EBCBA@@@ABB@@B@B@DAB@B@BAB@BAB@@C@@@A@@BA@@@A@@BA@@AA@AB@@A@@@A@@B@@ABA@AD@B@@A@C@A@@@@@A@@@@@A@C@AB@@@@A@ABCBA@@@CDABA@@@A@A@AA@@@BA@A@@BAAA@ABABCBCB@BABAFABADABA@ABAB@B@B@@@BA@@@BB@@@BBBA@@BAB@@A@@BAB@@AD@@@@A@@BA@@B@@@@@B@@@@@@@B@@@BABA@@@@B@BAB@B@B@@AB@@@@AD@@AB@@A@@@AB@@A@@@ABA@ABAD@@@BAAA@ABCBCBA@ABAB@B@BAD@@AB@@@@@A@@ACA@@AA@CB@@A@A@@@CAAAAAAB@AA@@@A@@@@C@AC@@A@@A@A@@AAAAAAA@@A@@@AA@@C@C@A@AAAAA@A@AAAAA@@@A@AAA@@A@@A@ADA@AB@@@B@@AB@@@@AB@BA@@@@B@@@@A@@@A@@@A@@B@BABA@CB@@ABA@@B@@@@ABA@@@@@@@@@AB@@A@A@@@A@ABA@A@@@AA@AAC@A@A@AAA@@@@A@A@@AA@AC@AA@@ACA@@@AA@C@AB@BA@@B@@AB@@A@@BB@@@@@B@@B@@@@BD@BCBAB@@A@@@A@@@ABA@A@@@A@A@A@@@AA@A@@AA@@A@@@@@A@@AA@@@@B@B@@AB@@BD@@ABBBA@AB@@A@A@AA@@A@@AC@@A@@A@@BCA@A@@CA@@AA@@@@@AA@@@@A@B@BADAFAJ@JADEHAJAJA@@H@FBD@@@DBB@D@BBBBDBBDBFBDDDB@D@@BFBDBDHHBBDFDDDBDBB@DBDBDBDBBBB@BBBDBBBFBDBFFHFLDDB@BDD@B@BC@ADAD@FBDBFBDBBBBDBFBF@@@@DBB@B@BBBBBDBDDBB@DBDDDDDBBDBDBDFBDDDB@BBBABAD@DBBDDFF@D@HBD@BBDBBB@DBBAF@BBBDB@@F@B@DB@BB@@BBAB@@AB@B@BBBBDBF@DBBBFCDG@GBCDCFAFAB@@@@B@@@BB@@@@@@B@BB@@D@@B@@BB@@B@@@HA@@BABBFBB@@@BADCBABAB@B@@@BB@BB@@BB@B@B@BAB@B@B@B@BB@@@@@B@@@B@@BB@@@BBB@BB@@@@@@ABABAB@@ADCBA@@BBB@BBBB@BBBBBBD@@@BD@@BB@B@@@@@B@@@BA@CBAF@HAD@D@D@D@BBB@D@BB@AF@@AB@@@DAB@FBD@BBB@@@A@AB@D@B@B@@BBBDFDDBD@BD@@B@B@F@DAF@B@BBB@BBB@@BBBBD@B@B@B@@A@A@@@AD@@ABCBCB@BA@@@BB@BBBDB@@@B@@@@@BA@A@ABA@AB@B@@@B@@BB@BDB@BB@@D@@@BA@@BA@ABA@A@C@@BA@@B@@@BBB@BB@B@BB@@@@@D@B@@BB@BB@BB@@BBD@@@@@@B@@ABA@AF@@AB@@@BBBBB@B@@@@@B@BBBBBBDBBB@@@AB@BA@@BADABABAB@BA@@@AB@@@@@BAB@B@@BB@@@B@BAD@BBB@@BBBDBBB@DB@@DBB@DAB@B@BAB@B@@@B@@BBF@@@D@D@B@BBB@@BBBBB@BB@@@B@@ADCBCF@B@@@BDDBB@B@@@@ABB@@@BBBBD@B@B@@B@@@DABABCD@@@@@@DBD@B@B@@@BB@@B@BABA@@BCBADC@ABA@@D@B@FCB@B@B@FBBBBD@@@@BBBB@BBD@B@@BB@@B@@B@@@@@@B@@@@AB@DABA@@B@B@B@BB@@F@@@BBBBBBDDDDBBDB@@DDBBB@BBB@D@B@@@BABAB@BABAB@B@B@B@DA@@BBBBB@B@D@@@B@B@DBDB@@BBDB@@B@DAB@@@B@B@BBD@BBF@D@B@BAB@BADA@AD@D@@@B@D@@@DAB@BA@@D@BAD@D@B@@@ABC@ABCDABCBABCDA@@BABAB@FAD@B@@@B@D@D@@ABC@AB@@AB@@@@@@@BDD@BBD@F@D@FBD@BAD@@AFCBABADCBCB@BA@@BBB@BBBB@@BBDBBBBBDBBBB@@@B@@AD@@@BBH@@@@B@@@B@BABA@@@@DBD@DBBBB@B@@@B@DBB@@BB@DBD@J@B@B@@@@D@BHFFBBB@@BB@BB@BB@BBB@BBFBB@@BBB@B@@@BABA@@BABAF@DABA@@BBB@@@B@B@@@B@@@@DBBB@@B@B@D@B@@@BB@@@DAB@D@D@B@@@B@@@B@@@@@B@@ADEB@@@B@BA@BD@BB@@A@@B@@@B@BBD@B@@DB@BBBB@BB@@BBBB@@@BBD@@@D@D@B@B@@ADABD@BBB@B@@@@@@BBB@BA@@BADADAB@@@B@@BA@@D@B@@@@@B@@@@@@@B@@@@@@@B@@@BAB@B@@@@@B@@A@@@@B@B@@@@@BA@@@@@@BBDB@@BA@@BB@@D@BB@@B@BABBD@B@BBBBD@@B@B@@B@@@@AB@@@@@BBB@BA@@@@@@B@BB@@B@B@B@@ADA@A@A@@BC@C@A@A@A@@AA@@CCA@@A@@BABABA@@B@DBB@@@BAB@@AB@BA@ADA@AB@@A@C@AB@BA@AD@B@@@B@@ABABCBADC@CBA@@AC@ABA@CBC@A@@@A@A@@AA@AAAB@@A@@@@DAFABADABAB@BAB@@@JC@@DABAD@@A@@@AAA@@@AFGBABAB@@C@@@A@@EE@@@@@A@@BC@@@@CG@AAAA@@AAA@@BABA@@DABA@AB@@@D@BAB@B@B@@@B@B@BADBD@B@B@B@DB@BB@DAD@@@B@@@DB@@B@D@B@BA@@@@@A@@@@@A@C@AB@@AB@DCBABA@@DBDBB@@@B@@ABADAFABABA@@@@@C@AAABA@@BA@@@@@A@@CG@AAC@@@A@ABABA@CDEBA@ABCBC@CBI@CB@@@D@@AD@@@BABA@@B@F@@@B@@AB@@A@AB@@@BA@@B@@@AA@A@AB@@CBABA@A@@BABA@CBC@CAAAA@ACAGEACAEBCBAAA@A@@@@BAB@B@B@@@@AB@BC@A@AB@@A@@B@B@@@AA@ABA@A@@A@@@A@CAA@@@@@BA@A@ABA@AB@B@BAFCBAB@@AB@@@@@B@B@@@B@@AB@D@HC@@B@@C@@DA@ABA@@@AA@@A@A@@@ABCACBE@A@@BCB@@@B@@BB@@AB@B@@BB@@@B@B@@AB@@A@@BAB@BA@@BA@@@ABA@@D@BBBB@@B@@CBA@A@C@A@A@AAAAACCCACA@@@@@A@A@AB@@@BAB@BAD@B@BA@@B@@@@C@A@ABA@AB@B@FCDCBAB@B@@@B@B@@@B@@@B@@@BB@BD@B@@@@@B@BAD@D@@@B@BAF@D@B@BA@@@@CC@AA@AAA@@ACC@AAA@A@@@A@ABA@AB@BAB@@@@@@AAC@A@A@A@@CACA@AAA@AA@C@A@@@AAA@@AAC@@AA@A@A@A@AA@@ABA@A@CAC@C@@BA@A@@@@@AA@@@@A@@@@@A@@@A@@@ABA@A@AA@AAA@AA@@AAAA@@@C@A@@AA@AAAAAAA@@@A@ABC@A@AAA@A@@DEBC@ABABAB@@A@@@@AA@A@@BAB@@@AA@A@A@@AA@@A@A@CAA@AA@ACC@@AC@A@A@@@@DA@@D@BAB@BADAB@@@B@B@@@BDB@B@BBB@@@B@B@@@BA@A@@B@@@B@B@B@BB@@B@@B@@@@AB@@@B@BBB@BDBBB@@@@BB@@BB@BB@@@BB@BB@@@D@DAD@B@@@@BB@BDDD@BBB@DBD@@BBBBBD@BB@BBB@@@H@B@DADA@@B@B@D@FAD@B@@@@A@@AA@AAA@@BC@@ACAA@A@@D@FBB@B@@ABA@EBABC@A@A@A@@DADABAFC@@DCBAD@DAB@@@@CBADC@@AA@A@C@A@@B@DCB@@AB@@AD@BAB@H@D@BAB@BCB@@@B@B@BABCBAB@B@B@B@BBB@@@B@DAB@D@HDB@@@B@@@B@@A@ADGB@@A@@@A@CACBA@A@@@@DA@AB@B@B@B@@@@A@@@AA@@AA@@@EB@@@@@A@@@ABAB@@@@A@@@A@@@@A@@A@A@@C@C@E@@@@A@A@@@AAA@@@E@A@AAEABA@A@A@A@AA@@AAAA@@@A@C@@AA@AA@@A@C@@@AA@@AAAAAACAAA@AAA@ACCECAAA@A@@ACCE@@@AAA@@@A@A@A@@@A@@BC@A@@@A@@AA@CA@AA@A@@@@@@@CAA@@@@@A@@@AAA@A@@@A@@@@@A@@@AAA@@A@@AA@A@A@A@@@A@@@@@@@@AA@A@@@A@@AAB@@@@AAAB@@@@AA@@@@AA@@@@A@@@BA@@@A@@@@BA@@@A@@AA@@@@@@AA@@@AAA@AA@AA@@@@@A@@@AAAAA@AC@C@@@A@@@A@AC@@CAAAA@AAA@C@A@A@AAC@@@@@@A@@@AA@ACAA@AA@@C@@@AA@AA@B@AA@@@@@@CAA@AAA@AAA@A@@@@BA@A@@@AAC@@AAACAAAC@AAA@@BABC@@@A@A@A@A@CBC@@BA@@AA@A@A@@@A@@@@BAB@@A@@BABC@AB@BCFC@@@@AC@@@ABA@@BCB@@@B@@@B@@A@@@ABA@A@A@@@AA@@@A@AA@@AAGCEC@@@A@E@AACA@AA@@@ABA@AFC@@BA@C@A@C@@@A@@C@A@EAEA@@@B@@A@@@@@@A@@A@@@@@@A@@BA@@@@B@B@@@BA@@B@D@BA@@@@@A@@AA@A@AB@@A@A@@@@AA@@BAA@@A@@A@A@@@@@AAA@C@E@A@@A@@BAFAB@@AB@@@AAAA@A@AB@BAA@@A@@@@@A@@AA@@B@@C@@A@@@@@AB@@A@@@@@AA@@@@A@A@A@A@@AA@@@A@@@@A@@@A@@@@@@@A@@@A@@B@@B@@B@@A@@@AA@@@B@AA@A@@A@@@A@ABAB@@A@@@@@@@A@AA@@@@AA@@@A@@@@AAAA@@@AAAA@@@@@@@A@@@A@"],encodeOffsets:[[120543,27598]]}},{type:"Feature",id:"350782",properties:{name:"武夷山市",cp:[118.035309,27.756647],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@AA@@A@@@@@A@A@A@@@A@@A@@AA@A@@A@@A@@BA@@@A@@A@A@@@AA@@CAAA@@@@AAA@A@@A@B@@@BA@@BA@A@AB@@A@@D@BA@A@AB@@AB@@@BAB@@@AA@A@@A@@A@@@A@@@@@AA@@A@A@AA@@A@A@@A@@A@@@A@A@@@A@@@CA@@@@A@A@@AEA@@@@AA@B@AA@@@@@@@AA@A@CA@CA@AAA@A@AA@@@A@@@A@A@A@A@@@AAA@A@@@A@@@@@A@@AA@@@@AA@@@A@@A@@A@@@CA@BA@@@AAAB@@@@CC@@A@A@A@@AA@@@AA@@A@@AA@AA@@CA@@AAA@A@A@AA@@ABAB@B@BAB@BA@@B@@A@@@@BA@@@A@C@@@@@@@@@@B@@@@@BA@AB@@@A@@A@AAAA@@@BA@@@A@@@AA@@A@@@A@A@@@A@AAA@@@@AA@@B@@A@@@@BA@@B@BAB@@A@CB@@ABAB@@A@A@CAA@@@C@@@A@@@@@A@C@@@@@AA@@AA@@A@@@C@A@@BA@AACBA@@@A@A@@BCA@BAAA@@BA@ABA@@@@BA@A@A@@B@@A@@@A@@BB@A@A@@BA@@BB@@@@B@B@@@@B@@BBB@@@DAB@@BB@@B@B@@@@B@BA@@BA@@@B@@B@@BBAB@@@@@B@B@@@B@D@@@@@B@@A@A@C@AB@A@@@ACB@AA@AAA@@@A@@@@BABABAB@@A@@@A@@B@@@B@@@@BBA@@@@B@@@@@BDB@@@B@B@BA@A@@@@@@@@BAD@@@@AA@@A@@@AAAAA@@A@@A@AB@@AAC@A@@B@@@B@B@@AB@B@@@B@BBB@@AB@BA@@@@BA@AB@B@@@B@@@B@@ABABA@BBAB@@ABA@@@@B@@A@A@@@AB@BA@AB@AA@@@A@@A@@@A@@@A@@@@AB@A@@@@@E@AAA@@@AA@@A@AAA@C@@@A@@A@@@A@A@@@C@AA@@@@AA@@@A@@@@@@A@AA@@@@ABA@C@@@CB@@AB@@AB@@A@@BA@@@A@A@@@C@@BC@@@@A@@AA@@@C@@@@@AB@A@@AA@@@@AB@BA@@@AB@@A@@@@AA@@@@@@A@@@@A@@AA@@@AA@@@A@@BA@@@@ACA@@@@@CA@@A@@@@A@AAA@A@@@A@@@AAAB@@@@AB@@@B@@A@@@@@@BA@ABCB@BABA@@@ABA@@@@B@@AA@@AB@BB@@B@@CBABA@A@@@A@@@@AA@A@A@@@A@@@AAAA@@@@AAA@@@A@@@AB@@A@A@@BA@AB@B@@B@@@@BBDB@@@BBBB@@@@@B@BB@@@AB@B@@BB@B@@@BBB@@BB@@@B@@@@@B@@BB@@BB@@BBB@@B@B@B@@@@AD@@AB@@AD@B@@@B@@BB@D@@BB@@@@BF@BAB@B@@BB@B@@@B@@AD@BABAB@BA@A@C@ABAB@BCF@@A@AAA@GAA@A@AB@BAB@@AB@@A@A@@AA@@AAAA@A@AB@@A@@@A@@BA@@BA@@BC@A@AA@@A@@@@@@BA@AB@@@@@B@B@B@B@@@@A@C@@@A@A@@@A@A@@BABA@@@A@A@@@AAEA@@A@A@@@ABA@@B@@@B@BAB@B@@A@CB@@A@AB@BA@@@AB@@@@A@@@E@AAC@ABABAB@BA@@@CB@BB@@B@@@B@B@BABAB@B@@@@BB@@@B@@@B@@@B@B@B@@A@@BA@@BABAB@BABAB@@@@AD@BB@@@BBB@B@@@@@@B@B@@BBB@@BBB@D@D@@B@@@BBBB@@@B@BB@@@@B@BBB@@@BBB@BB@@@BB@@@D@@@BBB@BB@@BBB@BABB@@@AB@B@@AB@@@@@@@@@BB@@@@B@@B@@B@BBBBBBB@@@B@B@@@@B@@B@@@@@B@B@@@B@B@D@@BB@@BBAB@@@BA@ABA@@@A@ABABA@AB@B@@AB@@@B@B@@@@AAC@A@AB@@@@@@@BB@@@@BBD@B@HAB@B@@AB@BABAFAB@DABAD@BABA@@@A@A@A@A@@B@@ADA@@B@@A@CACAA@C@E@A@AAA@A@AAIB@@A@CAAA@@ABA@A@A@@AAB@@@D@@A@@@@@@B@@BB@B@BB@@D@B@BBB@B@BAB@B@BA@@BC@A@C@E@A@A@ABABA@CDA@CBABAB@BA@@BGD@@@BA@@BBB@@@@@B@@A@ABA@G@CBABA@@BC@A@@BBB@@DFB@@BBB@B@B@@@B@BAD@BAB@@@B@B@B@D@@@B@BB@@BB@@@B@@AB@B@B@@@B@DBD@@B@@B@@@@@@D@@@B@B@D@BBB@@@DB@@B@B@@@BBB@BAB@B@B@@BB@@BB@B@@B@BB@@BBB@BB@@@@B@DB@AB@@@@B@B@BBB@@BBBB@@B@@BB@@@BB@@B@@@BBB@B@@@@@BB@@@@BB@@B@B@BBB@@BB@@@@@@BBB@@@B@@@B@@@@@B@DAB@@BBBB@B@B@@@B@B@@@@@B@@@@ABCD@B@@@@@B@BBF@B@B@B@@BB@DBB@DBBBFBB@D@B@BB@B@DBBDBBD@DADAB@FD@@DDDFHB@@BABCBCBCDCDABABCBEBCBADAFAFCDEFEBCDABADAD@F@BBDDDDBDDBBBFBBBBBBDBD@HBFDHFHDBFDFFF@F@DDBB@FDDDHBB@@@@@BB@@B@@BDB@@@@@@B@@B@@@BDAFABCBADAB@D@FBHDDBFBFBFABAHAD@FBFDBDBB@B@FBDBDDBF@DDB@@DDJDBDDD@D@@BBBBBBFBDBFBFBFBF@FBDDDBDDFBFDFDDFDDDFBB@BFBDADCBC@ABCDADBBBDDDBF@DBFBDBDDF@FBDAD@FAD@BBFDFBDAD@@@D@@D@DDDBBDDBBBFBBBBD@DBFBD@D@DDBBDD@@DDB@FBF@FADAHCD@DABCBCBADADADBDBHBDBDBB@D@BDFBDBBDDDBH@DBDDDDDDFBBB@B@BABCFIDEDBDBD@D@DAF@DDDDFDDBF@D@D@D@FCDGBCDAB@B@B@BBBB@@B@F@B@D@B@D@BBBBFAHCBADCBAAABC@A@C@ABABAD@D@DABA@@H@F@F@D@FB@@DB@D@BADADAHCD@D@B@DDD@F@DAB@D@B@B@B@DBD@DDDBBBBDBB@BBBBBB@D@B@DB@@BB@B@BADADCB@D@JAD@B@B@@@BBB@@@DDBBBB@BAJ@B@@AD@DA@ABEFAB@DAH@B@D@BB@BB@@B@@@DB@B@B@B@@B@@@HFB@BDDBB@@B@B@@BB@BB@BB@@B@@AD@D@B@@@B@DBFB@@DDB@BBB@@@B@BABAFCB@@AB@H@D@F@B@JC@@@@@@DCHCB@@@B@D@B@B@BBFB@@B@BABABA@@D@B@@@B@BBB@@@FD@@FFDBDBB@DA@@BBD@HBD@B@BBDBHFFFFDDF@@FHB@@@@@DCFCDABABA@@A@@@AAA@@@@A@A@A@A@A@@BAAA@@@@@A@A@A@@@@@A@@B@@@@A@@@A@@@AAA@@@AA@@@@CAA@A@A@@@@@@A@AA@A@ACE@@BA@AB@DA@ABA@@BA@@BAB@@@BA@A@@@A@@@A@@AAA@@A@A@@@AA@@@A@@@@AA@@@A@@@AA@@@E@A@@DC@@@A@A@@BCBA@@@@@AB@BA@@@A@A@A@@B@B@@@B@@A@A@@@ACC@@@AB@@@BA@@B@@ABABA@A@@B@@A@@FA@@@@@A@AA@@AA@AECC@ABA@@@C@@AA@@AA@@@A@@@AB@@@AA@@@@@A@ABC@@B@@A@@@A@@@A@@@A@@@A@A@@A@@A@C@A@@@A@A@@@@@@@A@@@@AA@@A@@@@@AA@@BA@A@AB@B@B@@AB@BAB@@A@AB@@@BA@A@A@@@@@A@@@@B@@A@@B@BA@AB@@ABAB@@A@@@A@@@A@@B@@@A@@A@@B@@@@A@@@A@@AAAA@@A@@@@@BA@@@A@@@@@C@@@AB@BA@@@@BA@@@A@@@@@ABA@@@C@AA@@A@@B@@@BA@@B@B@@AAAAAA@@@AA@@@A@BA@A@@@@@CA@@AA@@ABA@@@@@A@@AAAAA@@ABA@A@@@@A@A@A@@B@@@@@@AABAA@@CA@@@@CCACA@A@A@@@BA@A@C@@@@@AB@@AB@B@D@B@@@@@@A@@@A@A@A@@@@@A@A@@BA@@@C@@@A@@@A@@AABA@@@@@ABA@@@A@@B@@@BAB@@AB@@@@A@@A@@@@@@AD@@@@A@@@@A@@@@@@A@@@@B@@@@@@@B@@@@@@A@@BB@@@@B@@@@A@@@AA@@@CC@@@A@@@AA@@@A@A@@A@@A@C@@@CBA@@@@A@AA@@@A@@BA@@@A@ABA@AB@@A@@B@@AA@BA@@A@AA@@@@AA@@@@@@@@A@@BA@@BA@AB@@A@AA@@AA@AB@@@AA@@@@AAA@@@AA@@ACA@BA@@@A@A@ABA@@@@A@@@BCBAB@AA@A@@@A@@AA@A@@@@@A@A@A@@@AA@@BABA@A@@@@@A@@@@@AB@@@BA@@@@@A@@@A@@A@@@@@A@@AA@A@@@@@@@A@@@@@@AA@@@@@AAA@@A@@ABAA@@@@AB@A@@BAB@BA@@B@BAB@BAB@@@@A@@@@@@AAA@AAA@@@A@@@A@AAA@@AA@A@A@@AB@B@BA@@B@@BB@D@BABB@@B@AA@@@A@A@AA@AA@A@@D@BA@@BBB@@@@BABB@BA@@@@@@@A@@@@@@@AB@BA@@@@B@@@A@@@A@A@A@@AAAA@AA@@@@@A@A@@BA@AA@@@AA@@@@A@@AAAAAAAA@@AA@AA@@A@@@A@@B@@@@A@@@@@@@A@@@@@@@@@A@@AC@AB@B@@AB@@@B@@AA@@@A@@@@@A@@A@@@@B@@AA@B@@A@@@ABA@@A@@@@AA@ABAA@@@@@@A@@@AB@@C@@A@@@@A@@B@BA@@@@@@@A@@@@D@@ABAB@@A@@B@B@@@@@BA@@B@B@D@BA@@@AB@@@B@BA@@BA@@@A@A@@@@@@B@@A@A@@B@@AB@@@B@B@@@BAB@@@@A@@BB@AB@@@B@@@@@BAB@@@BA@@@AB@@@@@BA@@AA@@@AA@@A@@@A@@AA@@@@C@@@@@@@@CB@@@@AB@B@@@@@B@@@@@B@@A@@@@AAA@AA@@A@A@@BA@A@@@AB@@A@@@@@@@@@@@B@@@@A@@D@@@BA@@B@@A@@@@@@@@@@@@@@AAA@@@CCAA@AA@@@E@CB@@@A@@@A@@@@@AAAB@@@AA@@AAB@@@@AB@BAB@@@@ADA@@B@@@BAB@@@B@BABA@@B@@@BA@@@@@AB@D@B@@@@@BA@@@@B@B@B@B@@@B@@@@@B@@B@@BB@@B@@@B@@@BBB@@@@@BB@@@@@@BB@BB@@BB@@B@@@@BB@@@@B@@@@@@@B@@@BB@@@@@@B@BB@@B@@@B@@@B@@@B@@B@B@@B@@@@@BAB@@@BA@@B@@@@@B@D@B@@@@@@AB@BBB@BB@@BBB@@@@BB@@BBB@@BBB@D@@@@AB@@ABA@@BA@@@A@@AAA@AACAC@@@@@@@@C@@B@@@@AB@@@@A@@B@@@BA@@@AB@D@@@@@@A@@AAAA@@@A@@BA@@@@B@@A@@@A@@B@@A@AB@B@@@@@B@B@@@BB@@@@@@B@@@@@@@@@@@B@@@@A@A@AB@B@B@BA@A@@BA@@@ABA@@B@@A@@B@@AB@@A@AB@@@B@@@BA@@BB@A@@B@@AB@BA@@B@@A@@@@AA@@@@AA@@@AA@@@AA@@@A@@A@@@@A@@BAAC@A@A@@BA@A@@@@@A@@@@BAAAB@@A@A@@@@@@A@AA@@@A@@@A@@@@A@@A@@@ABA@@@@@A@@@AA@@A@@@C@@@@@A@@@@AA@@@A@@B@@A@@@A@A@@AABA@@@CBA@A@@@A@AB@@@BA@@B@@ABA@A@@@AB@@@B@@BBA@BB@@@B@BAB@@@@B@@@BB@BB@@@@BB@@@@BB@@B@@B@@B@B@@A@@@A@@BA@@B@@@B@@@@A@@B@@@@AB@B@@@@A@@@A@@@@@@@A@@@A@@@A@@@A@@BA@@@A@@@@@AA@@A@AB@BABAB@@@@@EEA@AAAA@@@AA@CC@@@@A@@@@@A@A@ABA@@@AA@@@@A@@@@@ABA@@AA@@B@@AA@@AA@@@A@@A@@AA@A@@@A@@A@AA@@@AA@@@A@@@A@A@A@@B@B@@@B@@AB@B@@@@A@@B@@AB@@A@A@AB@AABA@A@@@AB@@@BAB@@@BABCB@D@@@@@@AB@@A@@@A@@@A@AB@@@B@@B@@B@@@@@BABAB@B@BA@@BA@A@@D@@A@@BAB@@@@A@@A@@C@@BA@@BA@@AA@@@A@@@A@@@@@A@AA@@AA@@C@AAA@@@AAA@@@A@@@A@@A@@AB@@A@@B@B@@@B@@A@A@@B@@AB@@A@AB@@@@ABA@@@@@AD@B@@@B@B@@BB@@@@@@BB@@B@@@@B@@@@A@@@A@ABC@@AA@@@@AACA@AAA@@B@@@@AAA@A@@@AA@@AAA@A@@@@@@@@A@AA@@@@@@AC@@A@@@@A@A@ABA@A@@@@@AAA@AA@@@ACCC@A@@BA@@@@@AA@@@@AA@A@@@A@GACA@@@A@@AA@AAA@A@A@@@A@@@@@@A@@AA@@@A@@@AA@@@@A@@@BAA@@@@A@@A@@@@@@@@@@@A@@@@A@@@@@@AB@@AA@@@BA@@B@@@@AB@@@B@B@@C@@@@B@B@@ABAB@@A@@@@A@@@AA@@@@A@A@A@@CA@C@AAA@C@@AAA@@AA@A@C@@@A@@AA@@@@@@@AB@BAB@@@@A@@@@@A@@@A@A@@@A@@AA@@AB@@@@ABA@@@@@A@@A@A@AA@@@@AA@@A@@@@AC@A@@@AA@@ABA@@@ABA@@@A@@B@@@@AB@@@BB@@B@@E@@@@AA@@BA@@B@@@@AAA@@@@@AAA@AA@@@BA@@@A@@@@D@BA@@@A@@B@@@B@@@@A@A@ABA@@BABABA@A@@AA@@A@AA@@B@AA@A@@AA@ABA@@@@AA@@A@@AAA@A@@@A@@@CA@@A@@@@A@@A@@AA@@@A@@@AAE@CAC@AAA@A@ABABCB@BAB@BA@CBA@CBCBA@CAC@AAA@A@@AA@@@A@A@A@A@A@@BAB@@@@A@CBA@@@A@ABAA@@ABABA@ABA@AB@BA@@@@@A@@B@@@B@@@B@B@@A@@@@B@BA@@@A@BBB@@BBB@BB@@BB@BB@@AD@B@BBB@B@@@@CBA@A@@BA@AB@@@B@@@@@D@@B@@BBB@@@BABADAB@D@@@B@B@@@@@B@@ABAB@@@B@@@BAD@@@B@@@@CB@B@@@@B@B@@@B@B@BB@@BBA@@B@@EBA@@@@BAB@@AB@B@@ABB@@@BB@@B@B@@@@BBB@B@BA@@DAD@B@B@@A@ABA@AA@@A@ABA@AA@@@@A@ABABA@A@@@AB@BA@@@A@AAAAA@AA@BA@AAA@AA@AAA@@@AA@@A@@@@DA@A@@BA@A@A@@ACAC@@@A@A@@@AB@@A@@BA@@@@@A@A@@B@@AB@@A@@@A@@@A@A@@@AAC@@@A@@@A@@BABAB@@AB@@A@@@C@@@A@@@A@@@@@A@@@AAA@@B@@ABADC@@B@@C@@A@@C@@AA@AA@@AA@@A@@AA@A@CAA@@ACA@AA@@@AA@@@BA@A@@AAAA@A@@@AB@BA@AB@BABA@@@@@A@A@@AACEAA@@AAAC@@@AA@A@AAAAA@A@AA@AA@@CA@@@A@@A@AA@@A@@@CAA@@CC@@A@AA@@ABAA@@EB@@A@@A@@AAC@AA@@A@@@@AA@@AAA@A@@AAA@AA@@A@A@@@A@@B@B@@A@@@AB@@@@A@@@A@@@@@@A@@BA@C@@@@BA@AAA@@@A@@@A@AAA@@@@B@@AB@@@AA@ABA@@@A@A@@AA@@@A@@@@@@C@@AA@@A@A@ACA@AA@@@@@A@@AA@@@A@@@AB@@A@AA@B@@AB@@@@ABA@A@A@@@AB@BAB@B@@BB@@@BAB@BA@A@IBCBA@@@A@AA@@A@@A@@@A@@A@@ACB@@A@@C@AAA@E@C@CB@BADC@ADC@@@AJCDA@AB@FA@AA@AAA@@@CA@A@@@@@AB@@A@@A@@@@AA@A@@@@AAA@@A@AA@@A@A@@@@@A@A@@@@AA@CA@@A@@C@@@@A@A@C@A@@@@@@B@@A@A@@@ABAB@@@@A@AB@@A@A@@@@@A@@@A@A@@A@@A@@@A@@@A@@B@@@@"],encodeOffsets:[[120883,28146]]}},{type:"Feature",id:"350783",properties:{name:"建瓯市",cp:[118.304966,27.022774],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@AB@BC@A@@@A@@A@@@A@@@@@A@@AA@@BA@AAAAA@@@A@@A@@@A@A@A@C@A@@AA@@@@ABA@A@@@A@@BADABABA@ABA@CDA@@BAAA@@@@BABA@@@C@AB@@A@A@@@@BADA@@DA@@@AD@B@@ABAB@B@B@B@BA@@@CF@@@@@@AB@@AB@@@@A@AAAC@AA@AA@@A@A@@@A@@@C@A@@@@BAD@@@B@B@B@BAD@BB@@@@@BAD@B@BABAB@BB@@@BBB@@DC@@B@@B@@BB@B@@AB@@CF@@AD@@A@A@@@@@AAAA@@ABA@@@@B@@E@A@@BA@@@BB@@@B@@@@BB@@B@@@A@@@@B@BA@@@A@@@@@@BABA@@@@@@BAA@B@@BBAB@@C@@@@@@@@@@@@@A@@BA@AA@@@AAA@@A@@ABA@@@@AA@A@@AC@@@AAC@@A@@@@@@@@BCB@B@@@@@B@@AB@@A@@AA@A@A@@AABA@@AA@@@@BA@E@A@@@@@@ABCBA@@E@A@A@@@AA@@AAA@@AAACA@@AA@@@A@A@@@CBA@@A@A@@@@@@AA@@@A@A@@@@@A@@BA@@B@@ABA@AB@@AB@@@@@BAD@B@@BBB@A@@@A@@@A@A@A@@@@@@B@@@B@@AB@@A@A@@@A@@@@@A@@@@BA@A@A@@D@@@BA@@@A@@A@@@AA@@@@@A@@D@@B@@B@@AB@@@B@@ADABA@@@A@CAA@@@@@@@@C@A@A@@A@@A@@ADABA@AFAAA@AB@@AD@@@B@B@@@@@@AAC@@@A@A@@@A@@@A@@@A@CAA@@@A@C@@@@@@B@@ABEBADA@@@ABCBA@A@AB@BA@@B@@@BA@@@@BBB@BFBB@@@@@@BAB@BA@A@@B@BEB@@A@@@A@@@@B@@@B@@@B@DBD@B@@AB@B@@@BAD@@A@@@A@@@A@@@A@@@A@@B@B@B@BAD@@@@AA@@AAA@@@A@@AA@@AA@A@@@A@C@A@@@AD@@A@A@A@@B@@AB@BDJ@B@B@BAB@BAB@@A@@@@@@@@@AB@@@@@@B@BBD@BB@@@B@BB@BB@@@B@@@B@@@D@@BB@D@@@@ABA@A@@@@D@@@D@@@B@@A@@@@AA@@@AA@@A@@@ABA@E@C@CA@@@B@BAB@@BB@BAB@@ADA@@@@B@@@B@@@B@BB@@@@@@@@AB@@@@@B@B@B@BB@@B@@@@@@@@B@@@D@B@@@@AAAA@@@B@@@B@@@@@@AA@@@B@B@@@@@@@@@@A@@@@BB@AB@@@@@@@BB@@@B@@@@@@B@@B@BBB@B@B@@BB@@BB@@B@B@@AB@BABA@AB@@@DA@@BA@@@@@ABAB@B@@B@@@BB@@@@B@@B@@BB@AB@@B@@@@@@BBB@@B@@@@@@A@@B@@@@DB@@B@@B@@@BBB@BAB@B@B@@BD@BB@@@@BEAC@@@A@AA@BA@@BA@@B@B@B@D@@A@A@@@@HADBB@DBBB@@@FB@@@BBBA@@D@B@B@B@@@@@@BB@@A@@@@H@@A@A@@@AB@BB@@B@B@B@BBB@B@B@@@D@B@B@@@B@BAB@@A@@BA@A@A@@@AB@B@@@@A@A@AAA@A@A@C@@@A@@@A@@BE@@@A@A@A@A@A@@@CB@AA@ACAA@A@@@@BA@@@A@@BA@@AABAAA@A@C@@AA@AA@@AACAA@AAA@@@@@@B@@@B@@@B@@@B@B@@@BA@@@@CA@AAA@@AA@@@@ACBA@@AA@A@A@@@@AA@@AA@@AA@@AA@AAC@@@@@A@@B@AABA@@@AA@@A@A@@@ABABA@@@@@A@@@A@A@@DI@ABC@C@C@@DE@CDC@@@G@A@@@@@@A@A@@@ABAB@@AA@@AB@@A@@@A@@@A@@@@B@BC@@@@@BA@@@A@AA@@@@@@A@@@AB@@@@@@A@@A@A@@@A@@@@@@@AA@@AAA@@@B@B@B@@@@A@@@A@@@@@@@AB@@@@@AA@@AAA@ABAAB@@ACA@A@@A@@@A@C@A@A@@@@A@AA@@@A@@A@@@@@AA@@@@@@@A@A@A@@A@@A@@@EBA@@@A@@@AAA@@AA@A@@@@ABC@@BAB@@B@@B@@AD@@@@@@@A@@@AA@@AA@@A@@@@@A@@BA@@@CA@BAA@@@C@@A@AA@A@@@@A@CAE@@@A@@@A@A@ADCBA@A@@B@@@BAB@@ABADABABABA@ABABA@@@A@A@C@A@@@@C@A@A@A@@A@@@AB@@A@@B@BA@A@@@@@AD@@AB@@A@@@BB@B@@@BABAB@B@B@@@@AB@@A@@B@@A@@@AB@@AD@@ABABA@@@A@@@A@@@@B@@@BBB@B@@@@BB@@AB@@BD@@@BA@@BCDAB@@@@@@AB@@ABA@@@A@@@A@@@A@@@A@A@@@@@A@ABA@@@ABA@A@A@A@A@A@@@CAA@@@A@A@A@@A@@AA@@@@A@A@A@A@@@@@@BA@@@A@@@A@AB@@ABA@A@A@@@C@C@ABA@AB@@@@ABAA@@@@@@@A@@A@@AA@@@A@@@A@@@@@@A@@B@@AA@BA@A@@@@A@AAA@@@@@A@@@@A@@AB@@@@@@A@@@@BAA@@@@@@A@@B@@A@@@AAA@@@@B@@@D@@@BADAB@@@@ABA@@DA@@BA@@@@@A@@@@DB@DA@@B@DB@@@BD@@@B@@@@B@@BB@B@B@@DBBBBBBBBBD@BBBA@B@@@B@B@@@B@B@@@BB@@@@@@@@BB@@BB@BB@@@BB@B@B@D@@@@B@@@@@BB@B@@@BDB@@@D@B@@@@B@B@@@@B@DB@@@@BB@BA@@B@@@B@D@@B@@BB@@@BA@@B@@B@@@@@B@D@@@@B@@@@B@@@@B@BAFA@ABAA@@A@@AA@@@@BAB@D@B@BBB@B@@@@A@A@A@ABA@A@@@@BAB@@@B@@@@@@F@D@B@@BB@@BA@A@@@@DA@AB@BAB@@AB@B@@@@B@@B@@@B@@@@A@@B@@@B@@@@@B@@@@@B@B@@AB@@ABA@@@AAG@A@A@@BE@ABA@@@A@@@A@@@A@A@A@@@A@@@ABAB@BEB@@@BB@@@BDB@@B@@@@@BA@@B@DDB@BBB@@@BBDBBDD@BB@BD@@B@@B@@@@B@@@BBBBBB@D@DA@@B@BDD@FDBB@B@@B@D@@@DA@@@@@B@@@BA@AB@BA@BB@@A@ABA@A@C@CA@@AB@@@@BB@B@BBB@@ABB@@BB@@B@@B@@BB@@@@B@@B@B@@@B@@A@@B@@@DABA@@B@@@@@B@BB@@@@@BB@B@@BBB@B@@B@@BC@@@AB@@@B@@@@BB@@@@BBD@BBBBDB@@BBBB@@@BB@@@BB@@BBDB@BB@@BB@@B@@@B@B@@@BB@B@BBB@@BB@@B@@A@A@A@@@@@AB@@AA@D@BABADAD@B@BBB@B@DAD@B@@@@BB@@@@@B@@BB@@BB@D@B@@@BADABABA@@BABC@ABCBA@C@@C@@AA@AA@CA@@A@A@@B@@@DA@@@C@@@AB@@A@A@A@@B@@@B@B@@@B@@BD@B@@@B@@@B@@DB@B@@@BBF@BBB@@BBB@@@@@@B@@B@B@BB@@BBB@B@D@D@B@@@@@@@B@DAB@@AB@@@@B@@ABABCDAB@BAB@@@B@D@@@@CBA@@@@B@@CB@B@BADBB@@A@@B@@@@@@ABB@@@@BA@@B@BA@@BAB@@@BAB@@A@C@@BA@@@@B@B@@@@CBA@@@A@@@@A@AA@@@@@AB@@@@C@A@CA@@@@@A@@A@@B@@AA@@@@@@A@@@@AA@@A@@CA@@ACAAA@A@@@CB@@@@ABAB@@B@@B@@BB@@@@@B@@@@ABAB@BA@A@@@AB@@@BA@A@@@@@A@ABAB@@@B@@A@A@@BA@@BABA@@B@@@B@@@B@@A@A@@@@@A@@A@@A@@@@@@@A@@@@@@B@@@@@@ABA@AB@@A@A@@@AB@@AB@@@@A@@@@@A@CA@CA@@A@@A@C@@@CB@@GBA@@@A@A@AA@@CACC@@A@AA@@AA@@EC@@@@@@BA@@@C@@@@A@AAAAA@@@@@BA@@@A@@@CACAA@AB@@@@@B@@@B@@@@@@@@AA@AAAA@AAA@AA@@@A@@@ABC@@@AB@BA@@BA@A@A@@@@B@@AB@@ABA@A@CDABA@@@A@AA@@@@@BABAB@B@BA@CDABA@ABABA@AA@@A@@@A@@@@BA@@@@D@@A@A@A@AB@BA@ABABA@@BA@@@AB@@@@A@A@@@A@@@@@A@AB@@@B@B@B@@A@@@@AAC@@CA@A@@CC@A@AA@AA@@@AAA@@A@AA@@@@@A@@CBC@@@AB@B@B@@FBB@B@@BB@@BAB@BA@@B@@A@AB@@@@A@@@A@A@@BA@@BA@@@AB@@@B@B@@A@@BA@@@@B@BABA@AD@@@B@@@@@B@@CBABAB@@@B@B@B@@AB@B@D@@B@@@BBFA@BB@@B@BCD@@@@E@A@A@@@AA@@@@AA@@ABAB@BA@BB@BB@AFBB@B@B@B@@B@@B@@BD@@@@ABC@A@@@@@AA@A@@AAA@A@A@@AA@@@@BA@ABA@ABA@A@A@A@@@@A@A@A@AA@@@EB@@A@@A@@@AA@@ACA@@A@@A@A@@A@@@GD@@@B@B@@BB@@BBB@@@@BB@@B@@@B@@@@@@@@DDAB@D@DA@@B@DAB@@@B@B@D@B@@@@DBBB@@B@DD@@@@BB@B@@A@GDABB@BB@ABBB@@@B@@@BB@AB@@@B@@A@@BCB@@@@BDB@@BB@B@@ABAB@DA@AB@@ABC@ABA@CBAD@@A@@BABABABCB@BAFA@AB@@CB@@A@@@E@C@CD@@A@AACAC@C@A@A@A@AAA@@BA@@@AAAACCAA@@A@AAAAAA@@A@@@KDA@AB@@@@@B@@@BBD@BBD@BBD@@@@@BBB@B@@AF@@@BBB@@@B@B@@@B@@@BABA@@BBB@BA@@@B@@@B@@@B@@B@@@@B@@@@@BB@@B@@@BB@@D@BBBB@@@@@BAD@BAB@@AD@@AF@@@B@@BBA@@B@B@@A@@BABAB@@AB@B@@AB@@@@@B@BA@@BAB@@A@@@A@@@@@A@@@A@@BA@@BAB@B@@@BD@D@BAB@DAB@B@@@B@@@BB@@@B@B@BBB@BBB@@@D@@DD@BB@@B@@@D@B@BAD@B@@BBB@DBB@@@BB@@@@@B@@@B@@B@B@DD@@@@@B@BBBB@@@D@B@DABAB@BADC@@@@B@D@@BBA@@B@B@@@@@B@@BBBB@FBB@B@BBBB@@B@B@B@BB@@B@@@BA@@@@BBDB@BB@BA@@B@@B@@D@@BD@@B@@@@@DB@@DB@@@B@D@B@@BB@@BF@@BDBBB@@F@@@BB@@@@@@BDB@B@BB@BB@@@D@DB@@@@B@B@B@@@@@B@BB@BB@@BB@@B@B@@B@@B@B@@B@BD@AB@@@B@@@B@@@B@@@F@B@BAB@@@DBB@B@BAB@B@B@DDBDBBBBBBBB@@B@@@@@BBBDBB@BB@@@@AB@@@BAB@@@@@BB@@BABBB@BBB@@@BA@@DCBC@@BA@@@@B@@B@@BA@@B@@@@B@B@@BF@B@@@@B@@@@@@@BD@@@@FA@@B@B@@ABAB@BAB@B@@@B@@@B@BA@@@@BAB@@@@@@@BB@BB@@B@B@@BBDAFAB@@@B@@@BB@BBB@B@@@BBDBD@@@BAB@@ABA@AB@@@@@B@BAB@@@@A@@@@B@@BB@B@B@D@@@@A@@@ABA@@@@@@@AB@@@@@B@@@@CAA@@B@@@@AB@B@@AB@@@@@@AB@B@@@B@@@B@@AB@@@@@B@@@@@B@@@BB@BB@B@BA@@@@BA@@B@BBBB@@@BBB@@B@@BB@B@@@BB@B@B@@@@B@@@@@B@@A@@BA@@B@@B@@B@@AB@BA@@@A@@@AB@@@@@B@B@BA@@@A@@B@@@B@@BB@B@B@B@D@BBBB@B@@BF@DAB@@@B@@BB@@@B@@BAD@@@BBB@@BB@@B@@@@@@B@@A@@B@@@B@@ABCD@BABB@@@B@F@DA@B@@@D@BADAB@@@BBBDD@BB@BBBBBB@@BBBBB@@@@@B@B@@@FBBBBBB@@BB@@D@B@@@@@BB@B@B@BBDB@BB@@@@@BA@A@@@@D@D@@AB@A@@A@@D@H@B@@BB@@BDBDCB@@@@@DB@@B@@@B@D@@DF@@@FBBB@@F@D@F@D@B@BBF@@@BB@@D@DBB@B@BBDBBB@@@BB@@BD@DBFDB@@@@@B@BA@@@@@@BA@@@@@BB@@@@@@BB@@@@@@@@@B@@@BAB@@@B@B@@@@ABE@A@CBADCBC@@BAD@D@BAB@BAB@B@@AB@@@AA@A@@DCBABEDCB@@A@@@C@A@@@@B@FB@@BB@@B@@@BABA@@B@D@D@FCB@BAB@@@D@@@DAB@BAB@B@B@FBB@BB@@B@BB@A@@AC@@@@BABA@A@A@A@@AAAA@@@@BA@@B@DBB@D@@BBBB@@BBB@BBB@BBBDBB@@@D@F@DBB@FDDBB@@B@@BB@B@B@@AD@@@B@D@@BB@@BB@@B@@@@BB@DB@@BA@ABE@@@@@@@@@@AA@@B@@@BA@@@C@BB@@@B@BA@@BAB@@A@@@AA@@@A@@@A@A@AA@@BAA@B@@@@A@@@@BA@@BAB@HA@ABA@@B@@@BBB@@B@@@BBBB@@@BAB@BDBB@@@@B@@B@@@B@@B@B@BBBB@B@@BABAB@@@B@B@BADA@@B@@@@@D@B@@@@@@B@B@@B@@@B@@BB@@BB@@@@@DBB@BA@@B@@@B@@@D@@@B@@AB@@B@@B@@BB@@@B@@@BBB@BBBA@@B@@@B@@B@@@BBB@@@B@B@@@B@B@@@@@BB@@B@@ABA@BB@@@BB@@@BB@@B@@@BAB@B@@@BB@@B@B@BA@@BAB@BAB@BAD@BAB@D@B@@BDBBDBBB@@@@BBDBB@@@@ADA@@BFFB@@BCBA@@@A@@@@@@B@@@@@B@B@@@BA@@@@B@@A@AB@@AB@@A@@@A@@@A@A@@BBBDB@@BBB@B@@@BB@BBBB@B@B@@@BA@@@A@A@@B@@ABA@@@A@@@@B@@@B@BB@@B@FBB@BB@@BB@B@B@D@BB@@BB@BBBAD@@@DAB@B@B@B@@B@@BBBADBBB@@@@@B@@B@B@@BB@@@@@@@BB@@BAB@@A@@@@B@@B@@@BBB@B@@DBB@@@DFBB@@@@@@B@@@BA@@@@@AB@@BBB@@@@BA@@@A@A@A@@B@B@@@@@@A@@B@@AB@BB@@@BB@@@B@@BB@BB@@A@@B@@A@@B@@@@@B@@BBB@@BB@@@B@B@B@@@@A@@B@@@B@@@@B@@@@D@D@B@B@B@@AB@@@@@BB@@@B@@@@@B@@BB@@@@@@@A@@B@@AB@@@B@@B@B@D@@@@@@BAB@B@B@B@@@BAB@@@B@@@BBD@@B@@DA@B@@B@@A@@@A@@@@DABABAB@@@BCB@@A@@@@@@DE@@B@B@@@B@BBDBB@@B@AB@@A@@@@@@B@@AA@B@@A@@@@B@@AD@B@@A@@BB@@BB@ABB@@@@@@BAB@B@B@B@D@B@@@@AB@@@B@@@@@BBB@B@@@BB@B@@BB@BA@C@@BA@@B@@CB@B@B@@@@A@@@B@B@B@B@@@B@@@B@@@@@B@@B@@BB@BBB@@B@B@B@B@@AB@@@@@B@@BB@B@@@BAB@BCDCB@B@B@B@@@@@B@B@@@DA@@BAB@@@@@BBBB@B@@@BBAB@@C@A@A@A@@@@B@B@@BBBBD@B@@D@@C@A@@B@@@@ABA@ABA@A@@@@B@BABAB@BA@A@A@A@@AA@@@@@@B@B@B@B@B@B@@@@@B@@@B@@@@@B@BABB@@@@BB@@BB@@B@@BB@@@@@@@DB@@@@@AA@BA@@AAA@AA@AAA@@BADA@@@A@@B@@B@@@@BB@@B@@B@@@@B@@@@BB@@BBBB@BD@B@@@@@B@@BB@B@@BB@B@@BB@@B@@@B@@@@@@@@@B@@@BB@ABD@@B@BB@@B@B@@@BB@BBB@@B@@B@A@@@AB@@A@@AA@@B@@AA@@BAA@@@@BA@@@@B@@A@@@AB@@A@@B@@@B@@A@AA@@@@@BA@@@A@@@AB@BAB@B@B@@@BA@@@BB@@@B@@@@@B@@@B@B@@@@@AA@@@A@@BAB@@B@@@@@BB@B@BA@@@A@@@@BA@@DA@AD@B@@BB@@B@@BAB@DAB@@@DCB@B@DBB@BBB@B@B@@@B@@@B@B@@@BA@@B@BB@@D@@@B@@@D@@@B@D@B@BBB@BA@@BA@@B@@BBA@@D@@@@@B@@@DAB@@@@@B@@@@@@ABA@@AA@@@A@@@ABA@AB@@AB@@@@B@@B@@B@@@@@@@B@B@@B@@B@@DAB@BABA@@B@@@@B@@BB@@B@B@B@D@@BB@BD@@B@BA@@@@@AB@B@FCB@@AB@@AB@@@B@@ABA@@@@@A@A@@@A@@@@BA@@BB@@B@@@@AB@@@BA@@@@DA@B@@@A@@@AAC@@@A@@B@@AAA@AAA@A@@@@@C@A@@B@@@@@DA@@BAD@@B@BBB@@@@BABAB@@@B@BBBB@@@BBA@@BA@@BABAB@@AB@@@B@B@@@B@@@@@A@@B@BABB@B@@@BBBAB@DA@@BBBB@@BB@@BDBBB@@B@B@@B@@@BD@@@B@@BBBA@BB@@@F@@@B@@@BBB@B@@@B@B@@@B@@@BA@ABAF@@@B@@BBABB@@BA@@B@BB@BD@@@@@B@B@BAB@BAB@BA@A@A@@B@BA@@@AB@@@B@@ABBB@@@B@D@BAB@BA@@B@B@@@B@B@B@B@BAB@B@@@BA@@BABA@@@@DADAB@@@B@D@B@B@@@BB@@B@B@BAD@@@@@AAAA@@CCA@CA@AA@@ABA@A@A@A@@AA@@@@@A@@@@D@B@BABA@@@@A@@A@@BA@@B@AA@ABA@A@@BA@@B@BA@@@@@A@@AA@AA@BA@A@A@@BA@@@@@AAA@@@A@A@@@A@@@@@@BC@@BC@@DAB@@@@@A@@@@A@@@AA@@AA@E@AA@@AAB@B@BAB@@@BC@@@A@AA@@A@ACA@AB@B@@@B@@@BAB@BBB@@A@A@@@@DA@B@A@@AA@@@@BA@ABA@ABCBAB@@AB@@BB@BBBB@@B@B@@@@@BADC@AB@@@BB@@B@D@BBDABBB@B@@@B@B@@@@@@@BA@@@AB@BBB@@@@@@AB@AA@AAA@@@@A@@@A@AA@@E@@@A@@AC@AAB@@A@@B@@@BA@C@A@@BABADABA@@@A@@@A@@BAFCB@@A@@@AB@FC@@BA@@@AB@B@B@@@B@@@FB@@BAB@BAB@@@@AB@@A@@@A@@B@@@@B@BB@@@B@@@BA@@B@@@@@@B@@BA@@D@@ADAB@@AB@BABA@@B@B@BA@@BABA@@B@BA@@@AB@@A@@@@@@@A@@B@A@@@A@@@@A@@A@@A@@CA@AA@@@@AA@@A@@A@@A@@@@@AB@BA@@@@@@DA@@@A@@AA@@@AAA@A@A@@B@B@BBB@@@@@@BB@B@BB@@B@@B@A@@@A@@@A@@A@@@@@B@@AA@@A@@@@@A@@@@@A@@@A@@A@@A@@@@@AA@@@@@@A@@@@@AA@@@@@AA@@A@@@@A@@AA@E@@@AA@@@@@@A@@@AA@@@@@@@BC@CBCDB@@B@B@B@@ADB@@BABB@@FBBB@@BBB@@@B@AE@@A@@A@A@A@A@@@@@@@AAE@C@@@A@@B@@@B@@@B@BB@@B@B@B@B@BABBB@@A@@BAAC@A@@@A@A@@@AB@BA@@@A@A@A@@C@A@@A@@AA@AAAAA@@BA@@AA@@A@@@AC@@@@@A@@AA@@@@A@@AA@@@A@A@A@ADA@@@A@A@@@A@@BA@@@A@@@A@ABCDA@A@@BBBA@@BA@@@@BA@@@@@A@CAAAA@A@AA@A@@@C@C@C@@@@AAA@@AAAEC@@AC@@ECA@@AC@@@AA@@@@AA@BAAA@EC@@AA@@A@@@@A@@@@@@@A@CAA@@@A@CA@@A@@@A@@@@AA@@@A@@@A@@@A@@@@@A@AA@@CAAA@@AA@@@AA@@@A@@A@@A@@@@A@@@@A@@@BAAABA@@A@@@A@AB@AC@@@@A@@@AA@@A@@@@A@@@A@A@@AA@@@AB@@@A@AAA@A@AAA@AA@A@@@A@@@A@AAA@AAA@A@A@E@A@C@A@@AA@AABA@A@A@@@A@@@@A@A@A@@BABA@@@A@@A@A@@@A@@@@AA@@@@@AA@@AC@@AAC@AA@@ABAA@@A@CC@@C@@@A@@@ABABA@@@A@@@@@@AA@ABAA@AA@@@AA@AA@@@AAA@@@@@@AAAA@@A@A@@B@BE@ABC@@D@B@@@AA@A@AA@@A@@AA@@@@@@@A@@B@@AA@AA@A@A@@@ACACA@AA@@A@@BA@@@A@@@A@@@@BA@@BA@@AA@@AAAAA@@@@@AAAAAB@@A@A@@@A@@A@@@@@A@@A@AA@@AA@@@@AAAAA@@AA@@@AAAA@@A@@@A@A@A@@AAA@AA@@@A@ABC@@@A@@@A@A@@BA@@@@@A@@AA@AAA@@BA@@AA@A@@AA@@AAAAA@@AAACA@@A@@CC@@AAA@@AA@@ABAB@BA@@B@@A@A@@@@@@@A@A@@@A@@A@@@A@@AAAAA@@@@@@A@AAA@@@BA@@@@AA@@AAAAA@@AA@@@@A@@A@CB@@A@AA@@AAAA@@@@A@@AA@@@A@@@@@@@AB@@AB@@A@ABA@@B@@A@A@A@@B@@AB@@CBA@@@A@ABA@@@A@C@AACAC@C@@@A@CBA@@@A@AB@@A@@BA@@AA@GDA@A@@B@@ABAB@@ABA@@@@AA@@@ABA@@A@@@@BA@@@@A@@A@@ACAA@@@@AAA@A@A@@A@@AC@@@@@AA@@@A@@@A@AAA@@@A@ABA@@A@@@@@@AA@@ABAAA@ADA@A@A@CBA@AB@@A@@@@@A@@@@@@BA@CB@@@BBD@D@@ABADABABAB@@A@@B@B@B@@A@@@C@AA@@A@C@AAA@ABA@C@@BA@@@@@AA@A@AA@AC@C@@AA@@@A@@@A@@@@@AAC@@@B@@@AA@AA@@AC@A@@AAAA@@D@@@@@B@@@@A@@D@@@B@@@B@B@@AB@@A@@@A@@@A@@@A@AFABAB@@ADAB@BABA@@@@@AA@@@BABA@@BABA@@@@B@@AB@BA@@@ABA@A@AB@@A@@@A@ABC@A@A@@BA@@A@@A@@@ABA@@@ABA@AB@@AB@@@BA@@@@@@BA@@@@B@@A@@@A@@@A@@A@@@A@@@AAA@@A@@@A@@@A@@@A@E@@AA@@@@@A@@@A@@BA@A@AA@@AA@@A@@AAA@AA@@A@AA@@A@@A@AB@@A@@@A@@BA@@A@@A@@BA@A@A@@@ABA@@@A@@@@@A@@A@AAAA@CAA@A@A@@@ACBA@AAA@@@@@AA@@@@@@A@@@@AA@@@@ACB@@@@A@@@A@A@@@@BA@@@A@@@AB@@@@@AA@@AAA@@@A@@A@@@A@@@A@@@A@@@@@A@C@@B@@A@A@@BA@@B@B@@E@@@AAA@@@@AA@A@@BA@@@@@A@A@@A@AA@@@@@ABA@A@@@@A@@BA@@A@@@A@A@@@A@@AA@@@@@@AB@@@BC@A@@@@@AAA@@A@AAA@@@AB@@@@ABA@@@C@A@AAA@@@AA@BA@@@A@@AA@@@@BA@EA@A@AB@ACAA@A@AAA@@@C@@@@@@@AA@@AA@@@@A@@BAAAB@BA@@B@@@BA@@DABA@@@@@A@@@ABA@@BA@@BABADABA@A@@@@@A@A@@B@@A@@@A@ABABA@A@@@A@A@@@@@@@A@@@AB@@A@@AAAA@@@@AA@@@A@@@A@@@C@@@A@@@A@AA@@@A@@@@A@@BA@@@@@A@@AA@@@@@A@@@@@@@A@@@@AA@@@@@@@A@@A@@AA@@A@@@@@A@@@@A@@@@@CA@@@@@@A@A@AB@AA@CC@AA@@@@A@@@@B@B@@A@@@@@AB@@@@AB@@@AA@@A@@@@A@@B@@A@@AAA@A@@@@@@A@@@A@@@@@A@@@A@A@A@@A@@A@@@@AA@A@AAAB@AA@@@@C@@@@@@A@@@@@@@@AA@@@A@@@@@A@@@A@AA@@AA@@@@A@AA@@@A@@@BCA@@@@@@A@@@AA@@A@@@AAA@AA@@@@A@@@A@@@A@ABA@A@A@A@@@@@@@A@@@@@C@@@@BA@A@@@AA@@AAA@@@@@A@@AA@@@A@AA@@AA@@@A@@@@AA@AB@AA@@AAA@@@@@A@@BA@@@@@A@A@@A@@A@A@AB@@@AA@@@@@A@@@A@@@@AAA@@A@@@A@@@A@@@@AA@@@@@AB@@@@A@@@@AA@@@@@@@A@@@@@@@@@A@@@@BA@A@@AB@@@@@@A@ABA@@@A@A@@@ABA@A@@A@@A@@@A@@A@@AA@@@B@@@@ADA@A@@@@@@@@AA@@@@AA@@A@@@@@A@@@@A@AA@@@AA@@@@A@@@@@AA@A@@@@@CBC@@@@@AA@@AAAAAA@@@@AA@@@@@BAB@@@BB@@B@@@BB@@@@@@DB@@@@@EA@@A@@A@@@@@AA@@@A@@@@@AAA@@A@@ACAC@@@ABA@@AA@@A@@@ABA@ABABA@@B@@A@A@@@CA@@@@A@CBA@ABA@A@A@@@A@@@@@A@@@AA@@@A@@@A@@@@@AA@AAA@@@@A@A@@AAA@@@AC@@@A@AA@A@@@A@A@@@ABA@@@@@AB@AA@@@AB@@@@@@A@@@@@BACA@@@@B@@A@@@A@@@@A@@@A@A@CAA@@@A@@A@@@A@@@@B@@A@@BA@@B@BAB@BA@@BA@@@ACGAC@ABAB@@A@ABA@A@@AA@@A@A@@@A@@@AAAA@A@@@A@@A@AA@@@@A@@@@@AAABAAA@@AAB@A@@A@@A@@A@@@@@@@A@@@@@@BB@AB@@@B@@CB@@A@ABA@A@@@@@@@@A@E@@AAB@@A@@@@AA@@@AA@@AC@A@@AA@@@A@@@A@@@AB@B@B@D@@AAAA@@@AA@@A@@A@A@@B@@AB@@ABBB@B@@AB@@AB@@E@AA@@A@@@@@A@@DAB@@@@A@@BA@A@@A@@"],encodeOffsets:[[121324,27300]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
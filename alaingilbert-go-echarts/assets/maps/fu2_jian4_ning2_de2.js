@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("宁德",{type:"FeatureCollection",features:[{type:"Feature",id:"350902",properties:{name:"蕉城区",cp:[119.526299,26.66061],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@@@@A@@@@AAA@CB@@A@@@@@A@@@@@AA@@A@@BAB@B@BBBBB@BBD@@DBD@@@@@@A@@B@BABABABC@AA@@AA@A@"],["@@A@@AA@@A@@B@BA@@@A@@A@@AAABA@@@A@@@@@A@@@AA@@AA@AB@@A@@@A@A@A@@B@@A@A@A@A@@B@@@BA@@B@@@@A@AB@@AB@BBBDBB@B@B@@AB@DBBBB@FBDBB@@@@A@@AA"],["@@@C@ABAACECGAAAC@CCC@A@AB@DBFBFDFFDD@FAF@BA"],["@@ACCCC@CBCBG@ABE@@AACCCCAGAGAC@EACCAAC@AD@BEBC@C@CDCDAFADCH@FBBFBFBHDLBL@LDFBFBDBB@FBDA@CDAH@JCHCFEBCACECCC"],["@@OGYGC@UCSAE@KAM@A@MFCDABM^@N@DJXDHPLHDH@PKTG@@jEZHTJ\\HRCLGBOIOgWCCSK"],["@@A@@@A@A@AA@@@@A@@AAA@@AAC@AAA@@@CAA@@@A@@AA@C@A@A@A@@@AB@BA@@@@@@@@@@@BBB@@B@@@BABABAAA@A@A@@@@@@@A@@@A@@@@B@@@@@@@B@BA@@@CBA@@@@@@B@DBBA@@BA@@@@@ABC@A@A@@BB@@BA@@@@BA@AA@@A@@@@@@BBD@@@@@B@@@BA@@@AA@@@@CA@@A@AA@@@AA@@@@AA@@AA@AA@@A@@@@@@BA@@@A@A@@@A@A@@@@B@B@@@@@BABAB@@@@A@A@C@AA@@A@@@@@CAA@AB@@ABCD@@A@ABCD@@A@A@@@AAABAAAA@@@@A@A@A@A@@@@@BD@@@BA@@B@@@BB@@B@B@B@BA@@BB@AB@@A@@@A@A@CB@@A@@BA@@AA@A@@@@@AB@B@@A@@B@@A@@@@B@@A@AAC@A@A@A@@@ABA@A@@BA@@BA@AB@@@B@@@BAB@B@@@B@@@BA@@@@B@@@@@@@@@BA@A@@@A@@@@@@@@BA@ABB@@BB@@@B@@@B@@@@B@@@@B@BBB@@@BB@@@@@@@B@@@B@@@@@B@@ABAB@BA@AB@@A@@B@@BB@@@BAB@@@@A@@@CBABC@A@@@@@@B@B@@AB@@@@@B@@@@ABAB@@@@AB@@@@@@@BAB@@A@EAC@@@@B@@@BBB@@@B@BA@@BA@@BA@@@BB@@@@A@@@@B@B@B@@@B@@BBA@@B@B@@@@BB@@@@@B@@A@C@AB@@AB@BAB@@AB@B@@ABA@A@@@AA@@@AC@@@AB@@A@A@@AABA@C@@@@@@B@@B@@B@@@B@BA@@@A@@@A@@@C@@@ABA@ABA@CB@@@BA@@@A@ABABA@AB@@@@A@@AA@A@@A@A@@A@@AE@@@A@@BA@@@@@A@@BA@@@A@@@A@A@C@A@A@A@@@@B@@C@@@@B@@A@@@@@A@@@@AA@@@@BA@A@C@@@@@A@@C@@@@@AA@A@A@A@@AAA@@A@A@AAA@A@A@C@A@@@@@@@AAAB@AA@A@@@BBA@A@A@@A@A@@AB@@@B@@C@@@CB@@@@A@@BAB@@@@@@AB@@@B@@@B@@@@@B@@A@@B@@A@@@A@@@AB@@AB@@@@CAABA@@@A@@A@@A@AA@@@@BABA@@@A@@AAA@A@@@@@@@@BA@@B@@CAA@@AA@B@@@BC@@B@@@@@B@@@B@@AB@DA@@B@@@B@@A@@@A@@B@F@BAB@@@@@A@@AB@@AAAA@@@@@A@AA@@@@@@AA@@@@A@AA@@@@@@AA@B@@@@A@AB@BA@@@A@ABA@E@A@@@AB@@ABA@@BA@AA@@AB@@CA@@AB@@A@@@@B@B@@@B@@@@B@@@B@@@@@BB@@BAB@@B@@@@@@@BA@@@@B@@@B@@@BB@@BA@BB@@@BA@@@@@@@BB@@AB@BA@@@@@AB@@A@@@@B@@AB@@@@@B@@@BABAB@@A@A@@@A@@B@@@@AA@@AACA@@C@A@A@@@@@AAA@@AA@@@@@AAC@@@A@@BA@@@A@@ACCA@A@@AABCA@@@@AB@@A@A@@@A@C@@AA@@@@A@@@@A@@@@@@B@@@B@B@BABA@@@A@@@AB@BCD@BB@@@B@@@B@@@@@@B@@B@@@BB@DAD@@DBD@@@BB@@B@B@BAB@@B@@AB@B@B@@A@@@EJA@@@CBC@@@EBCB@B@@A@CAA@AB@@AB@@@B@@BB@B@@@@@@EFABADA@CD@B@@@@@@A@A@@@CBC@A@A@A@A@C@@@AB@@ABA@@AA@@@A@AAC@CAA@@@A@@B@@A@A@@@AAA@@@A@@A@@AB@@@@A@A@@@AA@@C@@ACA@@A@@@AA@@A@A@A@ABAB@@A@AB@D@BAB@BABADA@@@AD@@@@ABAA@@@@@@A@@@A@@@@BA@B@@@@BA@@BA@@@A@A@AA@@C@A@A@A@A@@@AB@@A@C@AA@@A@@@A@A@CB@@A@AB@@A@A@B@BB@@@@AB@@@@@B@B@B@@@B@@@B@@@BA@B@@B@@@BA@@B@@@@BB@@A@@@@B@@@B@@B@@@BB@@B@@B@@@B@D@@@BAB@BA@@DAB@@@B@@@B@B@B@B@B@@@@@B@@@@@BA@@BA@@BA@AB@@@B@@@B@@BD@B@B@@B@@B@@A@@@ABAF@@A@AB@@@B@B@@@B@@@B@@AB@@A@@@AB@@ABABAB@@@B@BA@@@@BA@A@AA@@AAAAECA@A@ACAAAAA@A@C@@@@@AA@AAA@@@AAC@A@@@@@ADA@@@AA@@@BA@@@A@AB@@@A@A@@@ACCC@A@@CC@@@@@@A@AAA@C@@@@B@@@@@@@B@@EB@@ADAB@B@@@@A@@ACA@AA@AA@@@@AB@@BB@B@@@BBB@B@@ABA@AAC@A@A@@@A@A@CAA@C@AAA@A@A@A@A@@@ABA@A@@@@@EA@@A@@AA@@BA@AA@@@A@@@@@A@@@@@C@A@@A@@@AAAA@@AA@@B@@A@@@A@@@@@BA@@@AB@@A@@@E@A@A@@@@B@D@@@BA@@BA@A@A@@@@@AB@@A@@BA@@BA@@@A@A@AAA@ABA@CBCBA@A@A@@B@@B@@B@B@B@@@@@@ABAA@BA@@A@@A@@@AA@@@@E@@@A@@@@B@@@B@F@B@@@@@@@B@@B@@@@@B@@@@@@BB@@@@@DA@@BB@@BBDB@@DB@@BB@@D@@@BBBB@@BB@B@@@@B@B@@@B@B@@@@@@B@@@BBBBB@@B@@@AA@AAA@@B@@A@A@A@@@AB@@@BBBBB@@@B@@AB@DA@@BB@B@B@@B@@@BA@@@@B@@@@B@@@B@@@B@@@BBD@@BB@@@@@@BBB@@@@B@@@@B@@BBA@B@@B@@@BBBBBB@@@@@@@B@@A@@B@B@@@B@@BB@B@B@@@@@@@BBBBB@@BBB@@B@@@B@@@B@@@B@BB@BBB@@BB@@@@BBB@@@@B@@@B@D@@ABAB@@@B@@@B@@A@@@A@@B@B@B@B@B@@@B@@B@@BB@@BB@B@@BBBB@@AB@@@BB@@B@@@@@BA@AB@@@B@@@B@B@@@BA@@B@@@B@@@BBB@@@@@@AAA@@@A@@@A@AC@@@@A@A@@@AAA@@@C@@AA@A@@@@B@@@BAB@@ABA@C@@@A@ABCF@BAB@@AB@@@B@B@@@@BB@@@B@B@@BD@@BBB@BB@B@@@BABA@@@@DBB@@@B@B@@BB@BBB@@A@@@@@@B@@AB@@@@B@BDB@@B@@B@B@@@@@B@B@@@@B@@@B@@C@@@@@@B@@B@BBB@@@@@BAB@@@@@DA@@B@DABBB@@@@@@@@B@B@@@@@B@B@@@@BB@@@@B@@@BA@@D@@AAA@@@AB@@@@@B@@BB@@A@@B@@@@@@@@@AC@ABA@A@@B@@@@AAA@@@@@AB@BB@@B@@@BAB@BAB@@BB@B@@@@B@@@B@@@BA@@@A@@@@BA@@B@@BB@B@@BBB@B@@BFDBBB@B@@B@B@B@@@@AB@B@@@BAB@@A@A@A@A@@@C@@BADA@@BA@@BABAFAB@B@@BB@@BBA@@@@B@@DBBB@@@B@B@BBB@B@B@BCD@@@@@BB@@BB@B@B@@@B@@@BB@@BDBB@B@BA@@B@@@B@@@BA@@@C@CAE@C@@@A@@@@@B@@BBBBB@@@B@@@B@@@BDD@B@@@BBDDD@BDDBB@@@BDB@@@BB@@@DDB@@@@B@B@@@B@B@@ABAD@@@D@B@B@B@@B@BB@@BB@B@@D@@@DBD@@@B@B@@B@@@@@@AB@@CD@@@@BB@B@@@@@@@@ABAB@BA@AD@@@BA@@@A@@BA@A@@@@B@@@@ABA@@B@@A@AB@@@@@B@@@@AB@@C@CBA@A@@B@@@@@@BB@@AB@@A@ABA@@BAB@BAB@@ABABAB@BB@@BB@B@BAB@B@B@@@B@BA@@B@FBBBBBFD@@B@D@BBB@@@@B@@@@@B@@@BBB@B@BA@@B@B@B@BBB@@@@BB@BBBBB@@BB@@@@B@@@@@BDB@@@DADAB@BA@AB@@C@A@@B@BAB@@A@@@A@@EE@A@@@AB@@@@AB@DC@AB@B@@@BA@@@A@A@@@AB@BABA@@B@B@B@@A@ABAAAAACA@AA@@A@CB@BA@@BA@ABA@AB@DCB@BA@@@CB@B@BAB@BBDBB@@@BC@ABA@@DA@@AA@@@@A@A@AA@@@@@AA@@ACAAA@ABA@@BA@AD@BA@@D@@@BBA@@@BB@@BBB@@BB@@@@BB@FBB@F@@@@B@@@BA@@@AD@BAB@@@@@@DBD@D@@BAB@@@@A@@B@@A@@@A@ABA@AB@@@@@@@@@B@@DB@@D@B@@@B@BA@@B@DCD@BA@@BAB@B@B@B@B@BBB@BB@@B@BAA@BC@C@@@@B@@BB@@@BB@@@@B@@B@@@@@B@@@@BB@@@@BABCDABABCBABA@@@@@@F@DBD@BBB@@@B@@A@ADCBCBA@ABA@@@@@A@A@@@A@AAABCBCBA@@BA@ABA@@@A@@@@B@D@HB@@@@BABA@A@@B@@BD@@@@A@@B@D@D@D@B@B@B@B@BBB@DBF@@BBB@@@@BBB@B@@BBBA@@B@DA@@B@@@@@BD@B@BB@BBB@@D@B@@@BA@@B@B@B@BBDB@@D@B@@@B@@@@@BADA@@BAB@@C@A@AB@@C@@@A@@@A@@@@BBB@@@DB@@@BB@@BB@DB@@BC@AB@@@B@@E@C@A@@@@B@B@@@@@B@B@D@HB@@@B@@@B@D@@BB@@@@B@@@BB@@@BDBDB@@F@B@B@@B@@B@B@@@B@@@B@@@@@BBBB@@B@@@@BA@@BCB@B@@B@BBB@BB@B@@B@B@BD@@@B@@BBB@@@BBB@@@@@@B@@@@@@AB@@ADA@@@@B@B@@@B@@@BDBDD@BB@BB@@@BCD@B@@ABAD@@@B@@BBBB@@AD@@@D@B@@BB@@BBDBBBF@FBD@BBB@BB@@@BB@@BB@BBB@DDB@FBDBB@DBHBB@@@BBB@B@B@B@@BB@@@@AB@@@B@@A@@D@BA@BBB@@@@BB@B@@BB@B@B@@@BB@B@@B@@@B@BB@@B@@BBB@B@B@BBB@B@@BB@@BB@B@B@BB@@DAB@BA@@H@B@BAB@B@@@@BBBBB@B@AB@BADCBA@@B@B@@A@@B@AA@ABA@CB@BAB@@ABA@@@AB@@BB@@BB@B@@ABA@@@A@AB@@@@@DAD@@@FA@ABA@@@@B@@BB@B@@@B@@@@A@@BC@@BA@A@A@@BABAB@@AB@AA@@@@B@@@AE@ABA@@@A@A@@@ABA@@@A@ABA@@@@B@@AB@@@@@@@B@B@BABB@@A@@@B@B@B@@@@@BCB@B@@AA@@AA@@A@@@C@A@@@@@A@CB@@A@@B@D@@ABAB@BA@@@AA@@A@@AAAAB@@@BA@@@ABA@A@A@A@@@C@@@@@A@@@@@@AAA@AAAAA@@@@C@@AAAAAAACAC@A@AAAAA@@BABA@@@AB@@@@A@@A@@A@@@A@@E@@@@@@AA@@AA@@@@AA@A@@AA@@A@@BABAB@FCBA@@@B@B@B@@@B@@@@BB@@DA@BB@BAB@@@@@BC@@@@@@B@@B@BB@@BB@@@@@@BBFBFBBB@B@BA@ABA@A@@@@BABAB@@@DBFAB@DA@@B@@A@@BAB@@A@A@AB@@@BA@@B@FC@ABAB@@@BA@BH@JBB@B@B@B@BBB@B@FBD@@@BBB@B@@@BABAB@@A@@BA@@@AAAAA@@@A@A@@AAA@EA@AAA@A@@A@CA@@@AAACACAGEAAAAA@AABA@@@A@@BAAABA@ABABA@@D@B@B@@A@@B@B@@@BAB@@A@@BAB@B@F@FBDBF@@@BA@@BAB@BAB@B@@@B@B@BA@A@@@@AAB@@A@@@ABAB@B@AA@@@@@@@@A@@@@@BA@@@CA@@AA@AB@@A@@@A@C@A@CACA@@ABA@AAAACAA@AA@@@C@AAA@ADA@A@@@@@@CAA@ABEB@@A@A@@AAA@@A@A@A@@A@@@A@A@@@AAAAC@AAABA@A@A@A@AAA@@@A@@B@@@@AB@@@@AA@@AAAA@AA@@@A@AAAA@AAAAA@AA@@AA@AAAA@@@@@A@@AC@A@@ETBPB\\B@A@EAKCMAG@M@CFCVANAF@DABAFIFIFENEBEFEJE@GACEUEIOCMFEDCAECCCBGNM@EMQC@G@CMAC@CAIAGCGCGIEGCAAGAGCKG@@GGA@A@A@CBCB@@A@GEAAEC@A@C@CBAXWPO@@JIHCDCFCFAF@HBFFB@DDLBBDBBDBRF@@LDJBJDBBB@DDBBBBBDDBB@DBF@DAD@DAB@DABADCFCRAB@JBH@@@LBPDF@F@H@F@FCDAFEFC@AB@DCDGBABCBAB@FAJEP@VIPEHMBECCACAA@A@ABAE@A@ABA@AA@BA@A@CDA@CBA@@BAB@@ABA@@@A@A@AB@@AB@@A@@BA@@@A@@@@BAB@@A@@@A@@@AAABCAA@@@AAABA@AA@AA@A@@@A@ABA@@A@@A@@AAB@@@BA@@@A@A@@@A@@@AB@A@@A@BA@@@@A@@A@@@@@A@@B@BAB@BA@@@@@@@AA@CCA@AA@@AACCAA@AA@A@@B@@AAA@A@@AA@@ACA@@@@AB@@@@A@@B@@A@@BA@@@A@CBA@AB@AA@A@@@@B@BA@ADA@A@AACAA@AA@@ABA@@DA@@B@@A@@@BB@@@@EB@@EAC@@@@AA@@AAA@@@@AAAAA@@@@@@AA@@@A@C@@@@@AB@@A@@@CA@@AAAA@@@@@@AA@@@@@@AA@CAC@@@A@@@CA@B@@AA@@@@A@@@@@A@@@@@A@@A@@@@AAC@@A@@@@A@A@@@AB@@A@A@@AA@@@@@@@A@@@@A@@@AA@A@AC@AAAAC@@@A@@@@BA@@@ABA@@@AA@@@@ABA@@@@@@AA@@@@AAA@A@A@@AA@@BA@@A@BA@@@AB@@@A@@A@@@@A@@AA@@@A@A@@@AB@@@B@@@@A@@@A@@B@@@A@AAA@@@@@@@@@BAAAAA@@@@BCBABABA@ABAA@@A@@A@@A@AA@@@BAB"]],encodeOffsets:[[[122681,27237]],[[122693,27209]],[[122667,27329]],[[122690,27252]],[[122602,27280]],[[122511,27165]]]}},{type:"Feature",id:"350921",properties:{name:"霞浦县",cp:[120.005146,26.885703],childNum:22},geometry:{type:"MultiPolygon",coordinates:[["@@B@B@@@B@@B@@BA@@@@@A@@@@@@@@@A@@@A@@@@@@@@@AA@@@@@@@@@A@@@@@@@A@@@@B@@@@BBA@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@B"],["@@@D@B@BB@@@B@@A@@@A@A@@@ABABA@@@A@@@@AA@@A@@@ABAB@D"],["@@BB@@B@BA@@B@@@@@@@@A@@@@B@B@B@BBB@@@BAA@@AAAAAA@A@C@ADA@ABA@@B@@B@@@"],["@@DB@@BBB@B@@@@@@A@@B@@ABA@A@AA@A@@@AB@@@@A@@A@@A@A@@@A@A@@@AB@@@BB@@BB@"],["@@@@@BB@@@@BB@@@BBB@@A@@B@B@@@B@@@B@@@@@@@@@@ABBB@@@B@B@@@@@@@@A@@AAAAA@C@CAA@A@A@@B@@A@@@A@@@@B"],["@@FBF@B@B@D@FBD@B@B@AA@@@@A@@@@A@@AACAC@AB@AA@@AA@A@A@C@C@A@AAA@@B@B@BDB"],["@@BDDB@BB@B@B@@ABBB@B@@A@@@A@@B@BB@@BA@@@AAA@@AAAAA@CAA@A@A@@AA@A@@@A@@B@@@@@B@@@@@D"],["@@BBB@B@B@BB@BD@@B@B@B@B@@D@@@B@BC@CB@@AB@@ABAB@@@@A@@AAA@@A@@@@BA@@@@@AA@A@A@@BA@A@A@A@AA@@A@@@A@A@A@@@C@ABA@@B@BB@@@BBA@BB@@"],["@@AB@B@BB@D@DBF@BBBBBBBBB@B@@ABC@A@AA@@A@A@@@AAAA@AA@AACCACACBAA@@AB@D@B@B@@AD"],["@@AD@B@B@B@@AB@DBBB@D@BAB@BA@@AA@A@AAAA@@A@@B@DBDAB@@A@A@CAAA@AAA@@A@A@A@AA@AAAAACC@C@A@AB@BBBBBBDBDBBBD@B"],["@@D@B@BBBADAB@D@BBB@@BA@@BBBBBD@D@DBB@BA@A@@A@@A@@BAB@BA@A@@AAAAEAEAE@ABA@AAA@A@C@CAA@AB@@AB@@A@A@@B@BBBBB"],["@@@B@@@BBBB@BB@BBB@@BBBA@@B@B@D@BA@@@@@AB@BA@@@A@ABABAB@BBBBB@B@BA@@BA@@AA@@A@@A@@A@@@AAB@@A@@@AA@AACB@@A@C@@BAB@B@B@@A@AA@@@@A@@B@@AA@@AAA@@B@@AB@@AA@@@@AAAB@@@BAB@B"],["@@A@A@A@AB@B@@@BA@A@A@A@EBCBABAB@B@@AB@@AB@@BB@@D@@@B@@B@@BBB@@AB@@A@@@@B@@@B@@@@@@@@@@@BA@@BBDBB@BA@@BC@AB@B@D@@ABA@AACACCA"],["@@A@AFADA@AB@BB@@BBDDDDBD@BBDBB@F@B@B@@AAAA@AA@ABA@@@AA@@@BA@@B@BA@@AAACCAAAECC@C@"],["@@@BBBB@B@@AB@DBBDBB@DABAD@@@B@BB@B@B@BBBADB@BBB@@BA@@@A@A@A@ABABAB@@ADA@@@ABA@ABAA@A@A@AAA@@AAA@A@@AAC@A@A@ABA@C@C@C@A@ABAB"],["@@A@CDCBABA@ABABAB@@@B@@@BB@AB@BB@B@B@B@B@@@D@B@@@B@BADABABAB@D@BABAB@B@@@B@@A@@AAB@@AB@BB@@B@BB@@B@B@@A@A@@A@BA@@D@B@@@@AA@@@@A@@AA@@A@A@A@@AAA@AAAA@AAA@AAA@A@C@AB@B@@BBBB@B@BABEBA@C@"],["@@@B@B@@A@CACBA@@DBB@@AB@@BDBDB@B@@A@A@@D@FBBDBDFBBBB@BA@AB@@@B@BA@A@@BADBB@@A@A@AD@B@BA@A@CAAA@E@C@A@AAAAC@A@A@CAAACAAAAAA@@B@D@B"],["@@@@B@B@B@@@B@B@D@DBB@B@@A@A@A@AC@A@@@@AAA@ABAAAA@@@AB@BA@AA@@ABA@A@@@A@AA@@@A@@A@A@@@ABAA@@@CAAA@A@C@@B@B@HBBBBDBB@@B@@ABA@A@AB@DB@@B@BAB@D@BBBB@B@DC@AD@B@B@@AB@@@@BB@@B@BBB@@B@B@@A@@B@BBD@D@BBB@B@@@@A@AE@@@@@AAA@ACA@@AECAAAAA@@A"],["@@@ABAACCCAA@ABCAACA@A@ALGFI@AAAC@GBEBAFABABA@A@ACA@E@A@AB@DBB@DAD@DABA@A@C@C@AAC@ABADEBEBAB@B@BCBAAAB@BDBBBADBDBBD@B@D@HBF@FAJ@J@JBFDBBFANADC@AAACCECC@A@@A"],["@@@EAEAECCAE@EAAGECAABABA@C@E@A@ABAAAAAEAACCG@E@E@E@EDCD@D@FBBBBFBB@BDBFBDBD@BADCB@BBBBBLBB@DDDBF@FAFABABAB@B@@HBDDDFBF@DCDC@C@G"],["@@CAAC@EBC@CCCECIEICI@ABGFIHEJAF@DDBLJLFFHBFDHDDJHFHHJFDHBJ@LGFE@I@MG[ACCAC@CBC@ECE@"],["@@A@ACCEAGACAA@@A@A@A@C@E@AB@DDB@DA@A@ABC@EAC@C@ABABA@ABAAA@QAE@CAA@C@CBGDEFABC@I@G@E@C@C@CACAIACBE@ABABAB@BA@A@A@@AMKCAAAA@ABA@ADABCDCBC@G@CCACACBM@CFKBG@I@ECECCEEGCAAECEEGAE@I@G@I@GACACAEECCEAIAIBGBE@E@A@C@EAEACCAC@CAC@EBG@AFSDIBC@AFEDAF@HAh@RANB@@JDPBFECCKEEGDMBIDEDEBCDAD@D@LFJBD@B@BABCB@BAF@LFD@B@B@BC@ECGGEKEECCACEAEAGEEAGEGA@EEAC@CLMDCF@F@DAHAnPDBF@D@BAB@@CBAACACEECAACACB@BARDDADABA@A@G@GCGCECASMMCI@IAC@EAKCMKIIACAA@CB@DCBG@@BIDIB@@ABAAC@EAC@C@@BABAFAFBHBHBB@@CIGAE@CBC@@JBJDD@FABADA@A@ECEIGgS]OKK@@GAUBGDIDCDAFCDABABC@C@C@CC{c@@QIYMCASCIAG@IBKDGBMDAAG@KCICECCCAEEEAEEEAA@ADABAAABCBGFCHA@CAAEAAA@ACAEAAOAAAAAAA@KAA@C@E@CAGCICEGIE@ACABEEIEIACAAAACA@@GCGAE@IAABGAC@E@C@GBCBC@EBGBC@EBE@E@CBCBAD@DABAB@BA@A@ABA@AB@DBBBHBD@B@B@BAB@B@BBDBBDFDDBD@D@D@FA@@B@D@D@BBBDFB@FDDBBDBB@F@DABAD@@AD@B@FBDDDDDHHFDHFHBD@LFHFHDJLB@DBF@B@DBNLFDD@D@HAJCD@D@DBFBB@F@D@DAFBF@F@FGBI@@BCDA@@BBB@BB@B@B@B@D@BAJDDHBDBDD@BBFDF@HEFAJFHN@F@DD@BAFAF@B@D@B@BBBDDDDDDBB@B@BABABCBEFAB@DAD@BBD@DDBDDFDDDDFFDDBBFDFDHFLNHRDL@JD@F@BBDA@@BADBB@BB@DDHBFDBDDDBBFBD@FARRRRFFBF@D@FADCFCFCBCBC@KCQCI@GAI@]BQAI@KC@@EAMGEAE@C@EBA@AB@D@BBDBD@FEDAAE@GGG@IDM@SGAAECAAACCCAA@CBABADCBADC@ABCDCFMBA@ABC@EAEAAACAA@CCKAAACACECMIIECCEECGACACAEACCCCCA@AA@ABCBIDGB@BIEEACBABEHADC@EECAAE@EAAACCCAEAEAAAAAACAAGAGCEAG@IBEBEHAH@J@DFJDFBF@BAFUPOHCBKFEBE@GAEAGAGAEAECGDQDKDGBG@SAYEFdHPFNBDDPBDBBHNFRDLHZE\\@JEV@BBJBDBFBBDJDFFFFDLHDBDBNDHDBDRHPFJFJDFAPHIHEBABA@AAA@A@AAC@@A@@A@@B@@AD@BA@AB@BAB@@CBA@ABAB@B@B@BA@@B@B@@@B@@@F@@@@@@D@D@D@BB@@BBBB@@@B@BAD@BA@AB@@@B@B@D@B@B@B@B@D@BB@B@@@@@BABAB@@@B@BAB@@B@B@B@@@BA@@@@BBB@@@B@B@B@@B@BB@@@@@@GB@@ABAB@@@@A@A@@A@@@AA@@A@AAA@BA@A@@@@@@A@@AB@@@@@@@@@B@@@@A@@B@@A@@A@@@@@@ABA@@@@@@@@B@@@@@@AB@@@B@B@@@@BBB@B@@@B@B@B@D@D@@B@@AB@@@D@BA@@B@@C@@BA@B@@B@@@B@B@B@@BD@BB@ABBB@BB@@@BB@@@@ABA@@B@@@@BBAB@@A@@BAB@@AB@B@B@BBBBBBB@@@A@@D@@A@BB@@@@B@@@@@BB@@A@@@AB@@@BBB@BB@@DBBB@BB@@@AB@@@B@@A@@@@BA@@BA@@@@BA@AB@@@B@@ABA@@BB@@DAB@@@BAD@B@BCB@BA@@@@B@@@@@BDBB@B@B@B@F@D@BBB@BB@@BBB@@BBB@B@@AB@@CB@@@@@B@B@@AD@@@@@@BBBB@@BDBDBB@@D@BB@BBBBBBB@@@BB@@@BA@@B@@@B@@@BB@@@B@D@BB@@@@@D@B@@B@@B@@B@@@B@@A@ABA@@B@BABA@A@A@A@AA@@AB@@@@AA@@A@@BA@@@A@@@@BA@@@ABA@@@AB@B@BAB@B@B@BAB@@@@@@EA@@ABCBA@A@AB@@@@@BAB@BBBBF@@A@@B@B@B@@@BA@ABA@A@E@@@C@@@A@@@A@AA@A@A@CA@@AACAAACA@@AC@A@@@A@A@CDABCB@B@@BBDDBB@B@B@BBBAB@B@BAB@@A@@@ABA@@@ABA@@B@@A@@B@@@@BBB@@BB@B@DBB@B@BBBB@B@BDDBB@B@B@@@BBB@@@B@@B@DD@@BBBB@@@@@B@@@@A@@@ABABAB@B@@A@@B@@@D@@AB@@@@A@AA@@A@A@@A@@@@A@A@@@A@A@@BA@A@C@@@A@@@@B@BBF@BBBBBBDBBBBBB@@A@A@A@A@A@@@A@A@@@@B@D@@@BAB@@A@A@CB@@A@A@ABA@@@@@AAC@@@A@@@@B@@@@A@@B@@@@B@@@@@AB@B@@@B@@@B@@@B@B@@A@A@CAC@@@A@@@@DABBBAB@@@B@DAB@@ABCBABA@@@@B@B@@@D@B@@@B@B@@@@@@BBB@B@DB@@BAD@BAD@B@B@F@D@D@B@BBDB@@BD@H@B@B@B@@@B@B@@@B@@@@BB@@B@B@F@B@@@B@B@BBNBB@@AB@BA@@BAB@B@D@D@B@D@B@B@B@BBFDB@@@@@@@@@AA@@@@@@@@AAA@AAA@@@AA@@@A@@@A@@B@BA@@@@@@@@A@@@A@AA@@@@@A@@A@@@BA@@@@BAB@@@@A@@@@AC@@A@@@@@@@AA@AA@@AA@@@AB@@A@@@@@@@ABA@@@AA@@@@@@@AAAB@@@AA@@@@A@@AA@@@@@@@@@@@@A@@BB@@B@@@@@@@@@BB@@@@B@@@@@@@@@@@@@@@@@@B@@@B@@@@@B@@@@@@B@@@@@BA@@@@@@B@@@@@@@@AB@@@@@@@@@@@@@B@@@AA@@@@@A@@A@@A@@@A@@@AA@@@@A@@@@@AA@@@B@@@@@@@@@B@@@@@B@@@@@@@@@@@BBB@@@@@B@BB@@B@B@BBDBBDB@@A@AB@@@B@@B@@@DB@DBFBB@DBBBB@B@DBD@B@@@BB@@@@@BA@@@BBBB@@B@B@B@@@BDB@BBB@BBDA@BB@DBBBBBBA@@@@@@@AD@@@B@BABA@@@ADA@@@A@A@@B@B@B@@@B@B@@@B@B@@@B@BBBA@@BBDB@@@BB@@@B@B@B@@@@@@B@B@@BDD@B@@@B@@B@@B@@@B@@BB@BABBB@@@@@B@@BB@D@FB@@@@BA@@@@B@B@@@BB@@@@@@B@B@BA@@@A@@@@BA@@@AAA@A@@AEA@@AB@BAB@@ABABAB@@ADC@@@@B@@@B@@@@@B@BBBAB@B@@@@@BA@@B@BAB@BAB@@@B@FBB@@@B@@A@@B@@@BA@A@A@C@@BB@@B@@ADCB@@@B@@@B@@A@AB@B@@@DA@@@@BBB@@@BBB@@BB@BBD@D@@@BAB@@@@ABA@CB@@@BA@A@@@@B@@A@@@A@@@A@@AA@@@@@A@A@@@@BA@@@@BBD@B@BB@@B@B@B@B@B@@@B@@@@DABD@DBDB@@BB@@D@@@@@@@B@@B@@B@@BB@@@B@@@@@BA@@@@B@@@B@B@@@DC@B@A@@@@BA@@D@@A@@B@@@@@@@BA@@BA@@B@B@@@@A@A@@BA@@@@AAB@@@B@@@BB@@B@BABA@@BAB@BABAB@BA@@FCBABA@@@@@@B@DCBAB@@@@@B@@B@@@B@@@B@@BA@@@@B@B@@@@@@@@A@@@@@A@@B@B@@AB@@@AABA@@B@@BB@@@B@@@B@@BB@@@@A@@BA@@@@@@BB@@B@@BBB@B@@B@B@@AB@FC@@@BB@@B@@B@BAB@B@@@B@BA@@B@B@@@DAB@B@DABA@@@BB@@@@BBB@@B@@@@A@@BA@@@A@@AAB@@A@AB@B@B@@@B@BA@@B@B@@@B@@@BAF@D@B@B@@@BA@@@@B@@@B@@@BBF@@@DB@@@@@@@@B@B@@@@@B@@@F@FA@@B@B@@@D@FB@AB@@BB@@@@B@BABA@@@AB@BAB@B@@@@@B@@@@AB@@ABBB@D@@@@@BB@@BBB@@B@@@FAB@D@B@B@@@@@B@@@@@B@@@@B@B@@@BB@F@@@BA@@@@B@@@@@BB@@B@BB@@B@@@BB@@@@@BBB@@B@@B@@A@AD@@@@AB@@@@B@@@@@B@@B@@@@@@@B@@B@BBB@BBB@@BB@B@@B@@B@@@DAB@@BBC@@@A@@BAB@@@BAB@B@B@B@B@@@@A@@D@@@B@@@@CBABA@@B@AA@@@@@AB@D@BBBD@@BB@@B@@@B@@@BA@@B@@@@ABA@@BB@@@@@@B@@B@@@@B@BBF@B@@@B@@BB@BA@@@@@@@A@A@@@A@@@AA@@A@@AA@C@@AAA@A@A@@@A@A@A@A@A@A@CBC@A@C@@AA@A@@@BA@@@@@@@@AA@@B@B@@ABA@@B@B@@AAAB@@@@C@@@C@A@A@@@A@@B@@@@@@A@@A@@A@AB@B@@@@@AA@A@A@@@@A@@@A@BE@@@@AA@A@A@@@AB@BA@ABA@ABBBAFBF@@@BA@@@A@A@@BABA@AB@BAB@D@DB@ABABA@@@@B@@A@@@@A@A@A@@A@A@@@AA@@AA@C@A@@A@@@C@ABABC@@@AB@B@@@BA@A@@@A@AB@B@DA@AB@@@B@D@@AB@@@B@@@@ABABC@ADABA@@BADAB@@@BAB@DAD@BA@@@@B@BBD@@@B@B@@@@@B@BB@@B@@@DBD@B@@@@AD@@@@@@@B@B@@@@AD@D@BAB@@@B@F@@AB@@A@ABA@@AAA@A@@@A@@A@@@@B@D@@A@@@A@@@@BA@@@@BAB@BAH@LGAA@CCCGECA@A@C@@DGBC@AAAA@AAAAAAAC@CAA@A@C@AAICK@@@AB@B@BBB@@@B@@A@@@A@AAA@C@A@G@C@CACCAGCAA"]],encodeOffsets:[[[122865,27137]],[[123438,27501]],[[123137,27586]],[[122850,27191]],[[122984,27247]],[[123002,27474]],[[123209,27290]],[[123034,27193]],[[123017,27126]],[[122884,27182]],[[123025,27443]],[[123240,27281]],[[123021,27325]],[[123105,27518]],[[123016,27168]],[[122683,27314]],[[123239,27335]],[[123145,27571]],[[122823,27325]],[[122954,27152]],[[123037,27208]],[[123126,27566]]]}},{type:"Feature",id:"350923",properties:{name:"屏南县",cp:[118.985895,26.908276],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@@AA@AA@AA@@@@@@@A@AB@@BDBB@@@@@@@@@BA@@@AA@@@@A@@AC@A@@AAA@@A@@@C@AB@@@@AA@@AA@@A@AAA@@A@@A@@@@@A@@@A@@@@@@@A@@@@AA@@A@@AA@@@A@@@A@AA@@AA@@@@A@C@@@AAA@A@@@@AA@@@@@@@AB@@A@@@@@@@C@@B@@@@@B@@@BA@@@@A@@@@@@C@A@A@C@@@@B@@@B@@@B@@@@@B@@@B@@AB@@CBA@E@A@A@ABA@CD@@@D@@AB@@@@@DA@@@@@@@A@@@BB@D@@@@@@CBC@A@A@C@AB@@C@@@EBI@CBA@@@A@@@BCBA@@AACCA@@AACAA@@A@@@EDA@A@@A@@C@A@A@AA@@AA@AA@@@@@A@@@@@A@ABA@A@A@AA@@AA@@@EACBA@AAA@@AAAACC@AA@AA@AAC@@@@@A@@B@@AB@@@@A@@@AA@@@@@A@A@A@A@A@A@EBE@A@EBABCAA@CAAACBA@@@A@A@CBC@A@A@@BAA@@A@@A@A@A@C@AACA@A@@@A@A@AB@@AAA@A@@@@@@@BD@@@BA@@DA@@D@@@@@B@@@B@D@@@@@@CBA@@@@B@B@@@B@@B@BBB@BBB@@BCD@B@@@B@@@B@@BAB@B@@@@@@D@B@BB@@@@@B@@@D@BA@@BBD@@@B@@@BDB@B@B@@@B@@@BB@@B@@@BB@@B@B@@@B@BB@@@BB@@BBBB@D@@@B@@BBB@BBB@@@B@B@B@@@@BBB@B@D@@@@@B@@@B@@BB@@BA@@B@@@@@@@@AB@BC@@B@@@@@D@@@@@BA@AB@@@B@@@D@B@@AB@BABA@ABC@@@ADAB@@@B@@BB@B@B@@@@A@@B@@ABAB@@A@@@AA@@GCA@AA@@A@A@@BAB@B@@AB@@A@@@A@@B@@@@B@@B@@@B@BAD@@A@A@A@@BCB@@A@A@A@@@@B@@@@@@@BA@@@@AA@CAA@@AAA@B@@@@@@CAA@A@@@@AA@@@@@@@@A@@@@@@@@AA@@@@AB@@@@A@A@@@A@@@AA@@@A@@@AA@A@AA@AAA@A@@CA@@@A@A@@AA@@E@CA@@A@@@@@A@A@A@A@@@A@AA@@@@A@@AA@AACA@AAAA@A@@@A@@AA@@AAA@AA@@@A@@@A@AAA@@AAAAA@@@@@@A@BDA@A@@@@@@B@@@BA@@@AA@@@AAAAA@@A@@@AB@@A@AA@@AAC@CAC@A@ABA@A@AA@@@@AD@@ABABA@A@A@AAA@AA@@@C@AA@AA@@CA@@@@@AA@@A@A@A@@@@@@CA@@A@A@@@@@@B@BB@@B@@A@A@A@@AA@@@@@AB@@@B@BBBA@@@@BA@A@ABA@@@AB@BBB@@@BA@CB@@AB@@BBAD@@@BA@@BA@@B@@@BAB@DAB@@@@@B@@@@@BB@AD@BBB@B@@@@AB@@C@E@G@@@AB@@A@@@A@@BC@@@A@@@@BA@C@A@C@@BA@AD@@@BCBABA@@@A@A@CB@@A@@@AAA@A@CBA@A@@@CA@BA@A@@@A@@@@A@@AB@BC@AB@B@@ABAB@@@B@B@B@@AB@@AB@B@@@B@B@B@B@B@B@D@B@BAB@BCBAD@BA@A@@BA@@B@@@BB@@BB@@BB@B@BAB@B@FBBBF@BBB@@@@B@@AB@BCBCB@BABADABABCDABA@A@@@AA@CACAAA@@@A@@BC@@BC@A@@@CBC@A@A@@@ABA@@BABA@CBA@EBABA@@@@BABA@@@@B@@@@@B@@AB@B@@@B@BAB@@@BB@@@AB@@@B@@@BA@@B@BB@@@BBB@@@BBB@BB@@@BB@@BB@@B@BAB@@@B@@@B@@@@BB@@@F@B@@@B@@@B@@@BB@BB@@B@@@B@@@@B@@@B@@@BA@@@@@AB@@@@@@AB@@A@@BA@@BAB@B@@AB@B@@@BB@@@AB@@@B@BAD@B@B@@@BA@@B@BAB@B@@ABA@@BA@@@@@ABAB@@ABAB@@B@@B@@@@ABABA@CB@BA@ABEB@B@B@@@B@@@B@@@BA@@BA@A@@@A@@@C@@@@B@@A@@@@@C@@@BBBB@@@BBD@@BBB@@B@@@A@@BD@B@@@@@B@@@B@@BB@@@DBDB@@B@BBB@@@@B@@AD@B@BAB@BBD@B@@@BBD@@@B@@@@A@A@AB@@@BABABABCBA@@@CAC@A@@DAB@@A@@@@B@@@@@B@@@BAB@DAB@B@B@BCB@BBBA@@BB@@@@@@@BB@BAB@@@B@BBB@@@B@@@B@@B@@@@BD@@@BB@B@B@BB@@@@BBBD@@@BB@@@@@AB@@@@@BB@BA@@B@@B@@B@BA@@BABA@@@AB@B@HCB@@BB@@AB@@@BAB@@@B@DAB@@@D@D@DBBBD@B@@@B@BAB@@@B@DA@@BA@@@AB@B@B@@@@AB@BAB@@@BA@@BA@@@@@@B@@@B@@BB@@@@@BBBB@@BBB@@@DAB@@@@B@@B@@BB@BBBB@@BB@@@@AB@@B@BBB@@@@@@@BBBB@BB@@@B@@@@B@@@B@B@@@@@@@B@BA@@@ABA@AB@BB@@BB@BB@@DD@@@BB@BDBB@@BBBB@BB@@BB@B@@BB@@AB@BBB@@BB@@@@@B@@AB@B@@@B@@@D@BAB@@@B@@BBB@BB@B@B@@@B@@@BBBB@@B@@BB@BBBB@@@@BB@@BBB@@@@B@@@@@BB@@@B@B@@@BABBBB@@@@B@BBBB@@BB@@AB@@AB@@@@@B@@@B@@AB@@@BB@@BDBDB@B@@@B@BBBB@@BA@@@@B@@@@@@BB@@@BB@@B@BBB@@A@C@@@AD@BAFA@@@@B@BB@BB@B@@@@B@BB@@B@@BBB@@B@@BBBBAB@@B@@@@B@@@B@BABA@@B@@@D@@@DDB@@@BBBA@@BBD@BB@@BD@@BB@@@@B@@B@@B@@@B@B@@@@B@@ABAB@@@B@B@B@@B@@@B@B@B@BA@BBB@@@B@D@B@F@B@BBB@BBB@B@B@@@B@@@BBBB@BBB@B@BBB@@@A@@B@@BB@@@B@B@@@B@@B@@@BB@@B@@@@@BDA@@B@B@@@BB@BABB@A@@@B@@B@@@@@@BB@@@@B@@BB@@B@@BB@BB@DB@@B@B@@@@@B@@@B@@@B@@BB@@@@@B@@@BB@@D@B@@BB@D@B@@@@@@@B@@B@@@BB@@FDB@BB@ABB@@@@BB@@D@@BB@FD@@BD@@FDBB@BB@BB@@@@@D@D@D@@@BBBB@B@BBDBB@@@@@B@@A@@B@@AB@AA@AB@B@DCBAB@@@B@@@B@@AB@@@B@B@@@B@BCB@B@B@@@B@@BB@@@@@BB@@@B@@@@BD@@B@@@BB@@AB@@BBBB@BBB@@@BB@D@@@@B@B@B@@ABA@@B@@@B@B@@@BBDAB@@@BA@AAABA@A@A@A@@@AAA@@@A@@@A@@@@B@@@DBF@B@@@@@@@B@B@B@BB@@@BFA@@@A@AA@@AAEA@@AAAB@@CA@BA@A@A@@@CAAD@DAD@@@@@@B@@B@@@B@@@@B@@B@@@FBB@@@B@@B@@@BB@@@@B@@B@@@@@B@@@@B@@B@@@@@BB@@@@B@@@B@@@@@B@@@@@BB@@BA@@@@@B@@@@B@@@B@@@B@AA@@@AAA@A@@A@@@@A@AAA@A@@@@B@BBB@B@@BB@@@B@@CB@@@@@@ABA@@B@@@@@BB@@@@BB@@B@@B@@BDB@@@BB@@@@B@@B@@@B@@@@@B@@@B@@@@@BAB@@A@@@@BAB@@ABA@@B@B@@@B@@BB@@@BA@@@A@@@@BAB@B@@@D@FA@@BB@@D@@@B@@@@@@AB@@@@@BB@@@@DA@@BB@@@@@@BABAB@@AB@@@BA@@DA@@B@DA@@B@DBB@DBB@@@B@BA@@BA@ABA@@@A@@B@@AB@@@@@@A@@@@@A@A@@@@@@@AAA@@@@@@AAA@@@A@@AB@@@B@B@@AB@BA@@BA@A@A@@@@@A@@BA@@@@@ABA@AA@@@@@A@@B@@AA@A@@@A@ABA@@DA@@@A@@@AA@AA@A@@AA@@@@BA@@@C@C@@BA@@@@B@@ABABA@@@B@@B@@@BA@@@B@@@A@@@@B@BAB@@@@@@A@@@@@AA@@@@@@A@@C@@A@@@@@@@A@AA@@AAA@@@A@@@A@@AAACAA@@A@AA@@@@B@@A@@BA@A@A@A@@@@@AB@@@BA@@BBBABC@@@@@A@@DAB@@@B@@@B@B@@@BB@@ABB@@B@@@BB@@@B@@@B@@B@@B@@@@@B@@@@A@@@A@@@ABA@@BA@@B@@@B@@@@@@@B@BBB@BB@@BB@BB@BBBB@@BA@@BAB@B@@@B@B@@@B@@@B@@@BBB@@@@B@@B@@BB@@AB@@@B@B@@@@@B@@@B@@B@@@AB@BA@@@B@@B@D@D@@B@B@@@B@@B@@@B@B@BA@@B@@A@@B@B@@A@@@@B@@@B@@AAAB@@@@A@@@AB@@BB@@@BB@@@@@BBB@@@@ABB@@@B@@@@@@DBA@@@@B@B@BA@@@@@@AAAA@@@A@A@A@@@AB@@@B@B@B@@@B@@@B@@B@@@B@@@B@@@@@@B@@AB@B@B@B@BBB@BBB@A@AD@BB@@BB@BABA@A@AAA@@B@BBBB@@@@@A@A@A@A@A@@B@B@@@B@@@B@@@B@B@BBF@@@B@@ADAB@B@B@DB@@@@@@@A@@@AA@BA@@BA@@@A@@@A@AAACA@A@@@@@@@A@A@A@A@@BAFCFA@ABAB@B@B@BA@@BAFC@@@A@@@A@@A@@A@A@A@A@@BA@@B@@@B@B@B@B@BA@@BA@A@A@A@A@@@@BAB@BADA@@@A@A@@@@@A@A@A@@AA@A@A@@BCB@@@DAB@BA@@@A@AA@AA@A@@@@@@@@FC@@B@@A@C@@BA@A@A@@BADA@AB@BABA@@B@B@B@BBB@DAFA@@B@@A@A@A@@@C@@@@@A@@D@DA@C@@@C@A@AA@AA@A@@@C@A@ABA@@B@B@F@B@B@@@@@@A@A@@@@@AB@@AAC@@@AB@@A@C@@@ABA@@DAB@BABABC@@B@B@BBBAB@@@B@D@BBB@B@FB@@BAB@@@BC@@BABABABABABA@ABCBABC@ABC@A@@B@@AD@@@BA@AB@@AB@@@F@B@@AB@BAB@BAD@FBB@B@@@@AB@AABABG@@B@@@@@B@BD@@@@B@@A@@@A@@BC@A@AB@A@@@A@A@@@AA@@A@@@A@@@AA@@@AA@ABC@@@@AA@A@A@@@A@A@A@@@@@A@@@@A@@@@AB@@A@@A@@A@@@A@A@@@AB@@A@@A@@@@@@AAA@@@A@@@@@@A@A@ABA@@BA@AB@@A@@@CAAAC@AA@@@BA@@B@@AB@BA@A@@B@DCB@@@D@D@@AB@BA@AB@@BBB@@@B@@BABABA@@BA@ABA@AB@BAB@@@BA@@AA@@@@@@@AB@B@DAD@@@BA@@@@@A@@@@BAB@@@@AB@BA@@@@@A@@B@B@@AB@@@B@@A@@BCB@@ABABA@@@@@@@@@AAA@@@@DC@@BA@@@@@@@AA@A@@@C@CA@@C@@@@AAA@@AAA@@@@A@A@A@C@@BCBA@@@A@A@@@A@A@@A@CC@@A@@A@@CA@A@@AACC@ACCAC@A@@@ACC@A@@@A@@@A@@AAAA@AA@@@@@B@@@D@F@DBD@@@B@@A@@@A@@@AB@@A@AAAAC@@AA@@A@@@A@A@A@@AA@@A@@@@DC@A@A@AAA@A@A@A@@AACA@@@A@@B@AA@@AA@@@ABABEBA@AB@@AB@BC@AD@@@B@B@B@B@@@BA@A@@@ABA@@@@@A@A@AA@A@AAEC@AA@A@AA@@@AAA@@@AB@@A@@B@@@B@@A@@@A@@@A@@A@A@@AA@ABA@AB@@A@@@AAA@@B@@@@BB@B@@A@@@@BAB@BBD@@@@@@@@A@@@@BA@@AA@@@@@A@@B@@BB@BC@@@AB@@A@@@@@AA@@@@@A@A@@@@@A@A@@@@@@A@AACBA@@@CB@@@@A@AB@@@@A@AAA@@@@A@@@@D@@@@A@@@A@@A@A@@@@@A@A@@@@AA@ACA@@@@@BA@@@A@@@@B@@@AA@AAA@@@A@A@@AA@C@@B@BA@A@@@AAAA@AA@@AC@@@A@A@@AA@@@@@A@A@@BA@@BA@ADEBAB@@@D@B@BA@@BA@A@@@A@@B@B@@BD@@@B@BB@@B@B@@@@@BDB@@@B@@@B@BB@@@@@@AA@A@@@A@@@AB@@A@@@A@A@@@A@@BAB@@A@@@@@AA@@A@@BA@@AAAA@@@AAA@@AA@@@AA@@@A@A@A@A@A@@@@B@@@BA@@@A@@@A@AB@BC@A@@@A@@@@@AA@A@@A@@AA@AAA@@A@A@@@A@@@A@@@AA@AA@@AAAA@A@@@@@@@A@AAA@@@A@@@A@AB@@@@A@@@@@@AAAAAA@@A@@@@AAB@AA@@@@@@A@@A@AA@@@@@@AA@@AC@A@@@A@@@A@@@A@@A@@@@@AB@@A@@@@A@AAA@@CBA@@BA@@@A@AAAA@@A@@B@@@B@B@BA@@@BB@BBB@@A@@@AAAA@A@@@A@@@@A@A@@@A@A@@@@@@AAA@@AAAA@@C@@@AA@@CA@@CAAA@@AA@@CB@@@@A@@A@@@@A@@@@@@@ABABABABC@@@ABCAC@CAAAAAA@@@CAA@MAC@E@A@C@@@AAA@EAA@@@C@@@C@@@A@@B@@A@@@A@C@A@EBABC@AAC@@@C@A@CAAAA@A@E@A@@B@@AB@@A@@@AA@@@AA@EC@@A@@@@DA@@BA@@@A@@@A@@@AB@BABABA@A@A@@B@@@B@B@@ABA@@B@DBB@@A@CDCBA@BBBBB@@B@@A@@BAB@@@BB@@BBBDFBBBB@BBBB@BBBBB@F@@@@B@@@B@@@@@B@B@@@@BB@BB@@BA@ABAB@@@B@BC@A@A@@@@@ABA@A@@@@AA@@BA@@@@B@BB@@BAB@@BB@B@@C@@@CAA@@@@@A@AAA@@@ABB@AB@@A@@@@@AB@@@BA@@AA@@C@A@@@@A@A@A@@@ABAB@@"],encodeOffsets:[[121887,27426]]}},{type:"Feature",id:"350922",properties:{name:"古田县",cp:[118.746284,26.577837],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@@BA@@@@@@P@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@BO"],["@@BABAB@B@B@@@BB@@B@@@DB@BD@@@BB@@B@B@@@@@BA@@@BB@@@B@BB@@B@B@@@@AB@@@B@DBD@BBB@@@B@@BB@BA@@BA@@D@B@B@B@B@D@DA@@B@B@@@@@@@@ADCB@BCBAFE@@@@@@@AAA@@@A@@BA@@BAB@DBB@@@@ADAFA@@D@DA@@B@FI@@B@@@@A@ABA@@@AA@ABA@A@@@AA@@C@CA@@BC@CAA@@A@@@@A@@@@A@@@A@@@A@@ADC@ABA@@B@@@B@BA@A@A@A@@@A@@@@B@@@@@@B@@B@@BD@B@@@B@B@@@BA@@@@DBBA@BB@B@DD@BB@@@B@@AB@@@D@BB@@@@B@@BB@BB@@@@B@B@D@@@DBBB@@BB@@@@@AB@@@B@B@@@BABA@A@@@A@@@@BA@@@A@@B@@@BA@@@@B@@ABA@@AA@@@@@@B@@A@@AAB@@AA@@A@@@A@@@A@@B@@A@@@@@@@AA@AB@@AA@@@@A@@@A@@@@@@A@@@A@AA@AAA@@@A@A@A@A@@@@@A@@AAA@@@@@A@A@A@A@@@A@@BA@@@AAA@@@A@@AAAA@@@A@@@@A@@@@@AA@A@AAA@A@@A@AA@@BA@@@@CAA@@@A@A@@BA@@A@@A@@@A@A@@@A@A@A@@@A@@@AA@@@BA@A@@@BB@@ABA@A@A@@@A@A@AB@@A@@@@C@C@@@A@A@@@@ABAB@@A@A@@@A@A@@@AA@@@AB@@@AAAA@@@@@@B@DABA@@BA@A@A@@@@A@AAAC@@A@C@@@ABA@@@A@@@@AAA@@AAA@A@@@@@@A@A@@@AA@AA@@@@A@@@ABA@AB@@@@AAA@@@A@CA@@ACA@@@@@A@@AABABA@@@@B@@BB@@@DB@@BA@@BABA@@@@@@@A@@@A@@@@B@@@BB@@B@B@@AB@@@@AB@@@@A@@@A@@@C@A@@@@@@B@B@@AA@A@@A@CAA@CAAAAAA@AA@ACAAA@BC@@@AAAA@C@@A@@A@@A@@@A@@@A@@AA@@@@A@CB@BA@@@@@A@A@@B@@A@A@@A@CA@@AB@@AAA@@C@@@A@@@A@@A@@ABA@@@AAAAA@@@A@@@C@@@@@A@@@@@A@AB@@AA@@A@A@@A@@@@A@@BA@@@A@AAAAA@A@@@A@C@A@@@AAC@A@ABA@@BC@@AA@@AB@@AAC@@@A@@@@@@@@@@@@B@@@@A@@@@@AB@BB@B@A@@BA@CBA@AB@@@@ADABA@@@@@AA@BAA@@ABA@@@@B@B@@A@@@A@@@A@@B@@@@A@AB@@@@@BA@A@A@AB@@@@A@@A@A@@AA@A@@AA@A@@@AA@@ACAA@@@BA@@AA@A@@BA@@AA@@@AAA@@@A@@@@AC@@A@A@A@@@@AA@@@@@AA@@BA@@CA@@A@@@A@@@C@@@A@@@@@A@@@@@AAAA@A@@@AA@@@@BC@@@@@@A@@A@@@@BC@@@@@A@@@ABABAD@@AB@@@B@@@B@@@BA@@@@@AB@@@BA@@@@@A@ABA@@@@@@B@@@@@@AB@BAB@BB@@BB@@@@B@@BDDB@BBB@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@A@@A@@A@@@A@@@@@@@CB@BA@A@@@AB@@@B@@@@CD@@@@@@@@@@@@@@@B@@B@@@@B@BA@@@@@AB@B@@@BA@@B@@AB@@@D@B@@@BAB@@ABCB@@A@ABA@ABABCB@@A@@B@@A@@A@@AA@AAA@@@@@A@@AA@A@AAA@@@AE@A@@A@@A@BC@@@AAA@@@A@@BA@A@A@@A@@AA@@@A@@BA@A@@AA@A@AC@@A@CAA@A@C@@A@@@@CB@@A@@@@@AAA@@AA@A@@A@@@@AA@A@A@A@A@@@AA@A@@@A@@BA@@AA@@@C@C@@@ABA@AB@@AB@@A@C@CAA@AA@E@AA@@@A@AB@B@@AB@BA@A@AB@@A@I@G@A@AA@@@@@A@@@A@@@@@@@@AA@@A@@@AB@@A@A@A@@AA@@@@@A@@@A@@@@BAB@@@@BB@@@@@@@B@@@@AB@@@@A@@BA@@@A@@@@B@@@B@B@@@BBB@B@B@@@@A@@BA@AB@@@@@B@@ABABAB@@ABA@ABA@A@@@@@@B@@B@B@@D@@@B@@CFADBB@B@@A@AB@@@@@F@@@@B@@BA@AB@@@@AAA@@@A@AA@@@ABBB@@@BA@@@@ACA@@AB@@@@A@CC@AA@@ABAAA@A@@@@@A@A@@@CBC@@@B@@B@@@@@BB@@@@B@@@@@@@BC@A@A@A@@@@@@@A@@A@A@@@@@@A@C@A@A@A@@A@@@@@@A@A@@@AAA@@@@B@DC@A@A@E@A@A@@@@@@B@@@@AD@B@B@@@B@@B@@@B@@B@@@BBB@@@@@@B@B@@@BBBB@@@BAB@@@BBB@@@@BBBAB@@@BBB@@A@@B@BA@@@@B@@@BD@B@@@@B@@@@@@B@@@@AB@@@B@@A@ABAB@B@B@@@@@B@@A@A@@@@@@BB@@@@@@@@@@BAB@@@@@AA@@@@@AB@@@@A@@A@@@@A@A@A@CB@B@@@B@B@@@@B@A@@@@B@@@BB@@@A@@@A@A@@@@@AB@B@@A@@B@@AAC@@A@@AB@A@@@A@@@@@A@AA@@AA@@AA@@@A@@@@@@A@@@@A@@@@@@A@AA@@@C@A@A@@@@@@BA@@@A@@@ABA@@@@A@@@@A@A@@@@B@@@@@@A@@@@@A@@@A@@@A@@@A@@A@@@@A@@@ABA@@@@@A@@BA@@@AAAB@@A@A@@@@@@A@@A@@A@@A@@@@@@A@A@@@A@@@@BA@@@ABAAC@A@@A@@AA@@BCBABCBA@A@A@@@A@@A@A@@@@@AB@@A@@@@@A@@@@@A@@AA@A@A@@@@AA@@@AA@@B@@A@@@AA@@@A@AAA@@AA@@@AB@BAB@@@BBBA@@BA@A@@AAA@EC@A@@@AA@@@@@@A@@AA@@@@A@@@BA@@@@B@@@B@BA@A@@@@@A@@B@@AA@@@@@A@A@@@@@@@@@BCA@@@AAA@@CA@@@@@@@@@@B@@@@@BA@@@A@@@A@@BA@@B@@A@@@A@@@@@A@ECCBAA@@@AAABA@@@A@@B@B@B@BC@A@A@A@@B@B@@A@AB@@@@A@@B@@BB@@@@A@@@@@A@@A@@AA@@A@@ABA@ABA@BA@ABA@A@@@A@@A@@@@A@@@AB@@AB@BA@C@@BAB@@@@@@@C@@A@@@@A@@@@AAB@@@@A@@C@@AA@@@@AC@BABA@@@A@@@@@AA@@@@ABA@@@A@@BA@@B@B@B@AAB@ACD@@@@A@A@@@A@@@@@A@@@@A@B@@@@@@@BA@A@@@@@@@A@@AA@@A@BA@@BA@@@A@A@A@@@@@A@A@@@@@AA@@@@AA@A@@A@@@A@@@@@@@@@A@@@ABA@@@@@A@AA@@@@A@@AAA@AAA@@@@ABA@@AAA@@AA@@A@BA@@@@A@@@A@@@@AABCA@@@@B@@A@@@A@@@@@AA@@AA@@@@@A@@ABA@@@@@@@A@@@@@@B@@@@@AA@@@A@@@A@@@@@@@@B@@@@A@@B@@@@@@@@@@@@@@@A@AA@@@@@A@@@@A@@A@AA@@@AAAAA@@A@@AA@@A@A@@@@@@@@A@@@A@@@@@A@@A@@@@A@@@A@@@@@@@A@@@AA@AA@A@@@A@A@@B@@@DC@ABA@@@A@@BB@@B@B@B@BBBABBB@BBBAB@@@BA@@AA@A@@B@@@@A@@@@@@A@@A@@@@BA@ABAA@@@@A@A@A@AAA@AAA@AA@@C@@B@@@@A@@@C@@@@A@A@@@@@@AB@@A@@@A@@@@A@AA@@@@@@AA@@@@@@@@@AA@@@AB@AA@@BA@@@A@A@@@AA@@@@A@AB@AA@@@@@A@ABABA@AB@B@@@@@BA@@BAB@B@@A@A@@BA@@B@@AB@@AB@@@@A@@BA@A@@@A@@@A@A@@@A@A@C@@@@AA@@@A@A@A@@@@@@@A@A@A@@@A@@@A@@@ABA@ABA@@@A@@@@@A@@@AA@A@@A@A@@@AA@@A@@@AAAA@@@@AAA@@@AAA@@@@AA@@@@@AAC@@@A@@@A@AA@AA@A@A@A@A@@@AAA@A@C@A@A@@AA@@@@AAA@CA@@A@@BA@A@A@AAAAA@@A@A@@AA@AAA@A@A@A@@@@@AA@AB@@CBA@@@AA@@A@@AAA@A@@AA@@A@@@A@@BA@@AA@A@@AAA@@AA@@@@@@AA@@A@@@@A@A@A@@@A@@@A@@@AA@@@@@A@A@@@@AAC@A@@@AA@@A@@@A@@AA@A@@@@@CAA@@@CA@@@@AA@A@@@@A@@@CB@ACBA@@AAAA@AAC@ABAP@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@B@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@OC@A@@BCB@@@B@@@B@@@BA@AB@@A@A@@@@BAB@B@B@B@@ABA@AB@@@@@@B@@B@@@@@B@@ABA@BBAB@B@B@@@BB@@@B@@@@AB@B@B@@BDA@@@BB@AB@@CBABCBEDAB@@CDA@A@CDAB@@ADA@@BA@B@B@DB@@B@@@BBFDBB@@@DBBBD@D@@BB@@B@@@@BA@@@@@AB@@@BA@@@@B@B@B@@@@BC@@BA@@@@@F@@@B@BABCDAB@@@D@@@B@@AAA@C@@@ABA@ABC@@@AB@@CB@BC@ABAB@@AA@@@A@@A@C@@@A@@B@@BBBD@@BBBBB@@@@BA@@@A@@BBB@@AB@@@B@@@B@@AB@@@@A@AB@@CBA@A@@@A@@@AB@@A@@BAA@@@@A@AAA@C@A@@@A@A@A@@@BBAB@@@BA@C@GBCAA@@@@@A@@@AB@@C@C@A@A@@@@@@A@ACC@A@C@A@AB@BADA@@BA@A@AA@@AAA@ABA@@@@B@FAB@BA@@@A@@AE@AAA@A@A@@A@@AAA@@A@C@@@A@@@CA@@AA@A@@C@@@@AA@AC@@A@AAA@@@@AA@@AAA@@@A@AAC@@BC@@@@AC@@BAAA@@BA@@@A@@AAA@@A@AAA@@@AAAB@@C@A@A@@DA@AB@@A@A@CA@@AAAA@AA@A@@AAAA@A@A@AB@@@@@B@@@B@B@@@B@B@@@@ABA@@@@@@@@@@@@@@@@@@@@@@B@@@B@@@BA@@B@@@@@@@@A@@B@@@@A@@@@@@BA@@@@@@@@@@BA@@@@@@@@B@@@@@@@@AB@@@@@@@@@@@B@@@@@B@@B@@@A@@B@B@@A@@B@@A@@B@@AB@@A@@@@B@@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@DA@@@A@@B@@@@A@@@@DB@@@@B@@@@A@@@@AC@E@@@@@@A@A@@@AAA@B@@@@ABAA@A@BB@@@@AAAA@A@@B@@A@@DABA@AB@@@@AA@A@A@G@A@@AA@B@@ABA@@@C@@AC@ACC@@BAB@@@B@@@@@@@@A@A@@B@@@@A@A@@@@B@B@BAB@BD@@@@BB@@B@B@B@B@@@AC@A@@@A@@B@@@@@@@B@B@BB@A@@@AB@@@@@BA@@B@@@@@B@BA@@@A@A@@ABCAAA@@@@B@@A@@@@@@B@@@@@@AB@@A@@@AB@DA@@BA@@BA@@BAA@@@@@@@A@@@@@BA@@AA@@D@@A@@@@B@@A@AB@B@@@BABAB@@ABB@BD@@@B@B@@A@@@@@AB@@@BB@@@@@A@A@@@AB@D@@@B@@@BCB@@@BA@AB@@@BA@A@AB@AA@AA@@A@@@A@A@@@A@@@AA@CC@@AA@A@@@A@A@@@A@@CA@AAA@A@@B@@@B@@@B@@AB@@@@@CA@@A@AAA@AA@@ABA@@AC@A@@AAA@AA@@AC@@A@@@@@A@@AAA@@@@AA@@@A@@A@@@A@@A@@@@@AA@@AB@A@@A@AB@@C@@@@B@BA@A@A@@@A@@@@@A@@B@@AB@@E@AAABA@@@A@@@AB@@A@AAAB@@AB@@AD@FA@@@A@@@C@@@@@A@AAAB@@A@@@A@A@@@A@@@A@@@A@@@A@@@@A@@A@@@@@A@@@@@A@AB@@AB@@A@@@A@@@C@A@@@AA@@A@@@AB@@@@@AA@@BC@@@@@A@A@@@A@@@AA@@AA@@B@@AA@@A@@@A@A@@@@AAA@AA@A@@@AA@@A@AA@@CA@A@A@AA@@@A@A@@A@@AA@@@@@A@A@@@@A@@A@@@C@@A@A@A@@AAA@@@A@AA@AA@AAAA@@@@@@@@@AA@A@@@CA@@@@AA@@@ABA@@@@@A@A@@@A@@@@@A@A@A@A@@@A@@@@@A@@A@@C@A@@B@@@@@B@B@@@B@@@@@B@@@@@@@B@BA@@@@@A@A@@@A@@@@A@@@@AA@@B@@@AA@@@@@@A@A@@@@AAA@@B@@@@A@@@A@@@@@@B@@A@@@@@@B@@DB@@@BA@@@AA@@@@A@A@@@A@@@@@@A@@@ACA@BA@AB@@EAA@@@@@@@B@BA@AB@BAB@@A@@AAA@@A@@A@@AA@@AA@A@A@@AA@@AA@@@A@@@@@A@A@AA@AA@@@@AA@ACA@ACAB@B@@@B@@@BAB@@BB@B@BB@@@@B@B@@@@@B@@@BA@@@BBA@@BB@@B@@C@@@@B@@BD@@BB@@@BA@@BABABA@@@ABAB@@@B@BA@@@CB@BA@@B@@BB@@@DBB@B@B@@@BABA@@B@@@B@@A@A@@@@DA@@B@@@B@@AB@@A@@@AB@@B@BB@BFDBDB@FB@@@@BB@@@B@@B@@@B@@@@@@@@@B@@B@@@@@B@@A@@@@@AB@B@@AB@@A@BB@@AB@B@@@BA@A@@@@B@@@B@BB@@@@@BA@@@@@@B@BD@BB@@@ABA@CBA@@@AB@@ABA@@BA@A@A@@@C@@@@@AB@DA@@@A@@BA@A@A@@@AB@@@@CB@@ADA@@@A@A@ABA@@@A@@@A@A@@@ADA@@BA@A@A@@BAB@BA@AB@BAB@BA@@@C@A@A@A@@AAAA@@@A@@A@@@@@@ABA@@@@A@@@A@@A@@@A@A@A@@BA@A@@@@AAA@@AC@@@@@@@A@@A@@A@AA@@@@AAA@@@@@@@@AA@@@@AA@@A@A@@@A@@@@B@AA@@A@@AB@@@D@@@@@B@DA@@@@@B@@BA@@@@@@B@@@B@@A@@B@@@@@@@@BB@@@@A@A@A@A@C@@@AB@@@@@D@@@@ABAB@BBB@@@BA@@@@B@@BB@@BB@@BB@@BB@@@B@@@@B@A@B@@BA@@B@@AB@@@@AB@@@@A@@@@@A@@@@@AA@@@@B@@@AA@@@@BA@A@A@@@@AA@@@A@@AA@@A@ACC@@@@@CBGBEB@@@C@A@A@@@@ACAA@@A@AAA@@@A@@@ABA@ABB@BD@B@@@B@@@B@@BB@B@@@B@@@@AB@@@@@B@@@@B@@BA@@B@@B@A@@B@B@B@@ABBB@@@@@BA@@B@@@@@BA@@@CBA@@BA@A@AB@@CBAB@@ABAA@@A@@@@@@@@BA@@@BB@@@@ADABA@@B@@@B@B@BB@@B@B@B@B@@AB@@@@@B@@@B@@@B@@@@D@@@@@@B@B@B@B@B@@@@@D@B@@@D@B@@BALC@@BB@B@@B@@B@@@@B@@B@B@@@@@BB@@B@@BB@@B@@B@@BA@@BBB@@B@@@BBB@@@@@@@B@@@@@@AB@@@BB@@B@@@@@B@B@@B@@B@@ABB@AD@B@B@@@@CB@@A@ADA@@@CB@@E@@@A@ABB@@@@@BBB@@@@BB@BB@@B@@BB@@B@@B@ABB@@B@@BB@@A@@BAD@@@B@@BDBFDFBB@@DB@BBBBBB@FB@@B@@B@BB@B@@@@BB@@@@@B@@@B@@@B@@BBB@@B@@AD@BB@@B@@@@@B@B@@@B@@B@@@@BB@@@@AD@D@@@B@@B@@@@@BAB@B@@@BABAA@@@@A@AA@B@BC@A@@@@BBBB@@BBDB@BDB@@@BA@AB@@@B@BBAD@@@B@@@@@@@B@@BB@@@@@@B@@@@AD@@A@@BA@@@B@@@D@BBB@B@@@@B@@@BAB@BAB@@BB@@ABAB@@AB@@@BB@@@B@B@@@B@B@@@@@@@@@AB@@@@BD@@@@A@@@@@AA@@@B@@@@A@A@@@@B@@@BA@A@@@AB@B@@BB@@@@BB@@BD@@@BB@DB@@@BB@@B@B@@@B@B@@@@@@@B@BB@@B@@@BB@BDBB@@@B@@@@@BABA@@BAB@@@B@@@@D@@B@@B@B@B@BB@B@@B@@@BB@@@@@B@@@BA@@@A@@B@@@@@B@@C@@DABAD@B@B@@B@@ABBB@BB@@B@@D@BB@@@B@@BB@@@@B@DAB@BA@CBA@@@ABA@C@@@C@C@@AA@@@@@AA@@@@A@A@@A@@@@CCA@A@@@@@@@@D@B@@@@@BA@A@@BCAABAAAB@@A@AAA@@@@@A@@@CB@AA@@@ABAD@B@B@BA@@B@@@@ABA@A@@BA@@B@@BB@@A@@BB@BB@@A@BBA@B@@B@B@B@@B@@@@@BB@@@B@B@@@BBB@BB@@B@BB@@BA@@@@BA@A@@@@BAB@@@B@@@BB@BB@@BF@BBD@BAB@@BBA@B@@@B@BB@BB@@B@D@D@D@BAB@B@DAB@BAB@@@@@BA@@@A@A@@B@@@B@BBB@D@@B@@B@B@BDB@@@@@B@@@@@@@B@@@@AB@A@@@@A@@D@@@B@BBD@@A@@B@B@@BBB@@BB@@A@@@@B@B@B@BB@@@@@BA@@B@B@@B@@@@@BB@@@B@B@@B@@@@B@B@D@@@BAB@@@@@@@BBB@BBB@@@B@@@@@BAB@@@B@@BBBBBB@@@BB@@BBB@@@B@@@@@D@@@@@BBB@@@B@BAB@BAB@BAD@@@@A@A@@@A@ABEF@@A@@@@@A@A@AB@@A@A@@@A@AB@@AAA@A@A@A@@@@@A@ABA@@BA@A@AB@@@@@D@@AB@@@BB@DDBBBBBBBDB@BBB@@B@BB@@B@@AB@@ADA@@@@B@@A@BB@@@@@@@BBB@@@@@BB@@B@@B@B@@B@@@@@B@@B@@B@@BBB@@@@@@B@@@@@BB@@B@@B@@B@@@@@@B@B@BC@@@@@A@@BB@@@BB@@@B@@@@BB@B@BA@@B@B@@@B@BAB@@@@@@@BA@@@BAB@@@@@B@@@@@@@@@B@@@@@@BB@@@@@B@@A@@B@@@@BB@@@@@B@@@B@@@BB@BB@@@@@B@@@B@@@@BB@@A@@B@B@BB@@@@B@B@@@@AB@@@B@@@@BB@BB@@BBAB@@B@@B@@@B@@BB@@B@B@@BB@@@B@@@@BB@BB@@B@@@BAB@@@@@D@@@@@B@@@@@@@B@B@BAB@B@B@@@B@@@B@@B@@BBB@B@@@BB@@B@@@B@@@@B@AD@@B@@@B@@B@B@@B@@BB@@B@B@@@B@@@@@B@@BB@@@@@@@@@B@@@@D@@@@@BBA@BB@B@BBB@@@@@BB@@@@B@B@B@@@B@@@@@B@@@B@@@@B@B@BB@@@BA@@@@B@@B@@@BB@@A@@B@@A@@B@@@@@BA@A@@@@@@B@@B@@BDDB@@BBAB@B@@@@@@@DB@@@@B@@@@@@B@@@@@BB@@BB@@@@B@@@@@@BB@@@@@B@@@@@@@B@@@@BB@@@B@@@@AB@@@B@@B@@@B@@B@B@@@B@@@D@@@B@@@B@@BB@@@@BBBB@@@BA@@B@@@B@@@@@@@B@B@@@BABAB@B@B@@@BA@@@@B@B@@@@@BABCBABAB@@AB@@AB@B@@@B@@@@ABCB@@AB@@A@@@ABA@BBAB@@@B@@B@@BB@@B@@@@@@@D@@BB@B@BBBBDA@@B@BFBB@@A@@B@@BB@@@B@@ABB@@B@BBB@D@@@B@BA@@@@BA@@B@BBB@@@BB@B@@@@@BAD@@A@@B@@@@B@@BB@@@B@B@@@B@@@AB@@@B@@B@B@BA@@@@B@@B@BB@B@@@@@B@@AB@B@@B@@B@BB@@F@@@@A@AB@@AB@B@@@@AD@B@@@@@B@@@B@@@B@@@B@@@@B@@BB@BB@@B@@@@BA@@B@@@B@@A@@B@B@@@B@@@@@DA@B@@B@@B@@B@@@@@@@BB@@@@B@BBB@DA@B@@@B@BBB@DBBBBB@@@@@B@BA@A@@B@BAFAB@DAB@BA@AB@BA@@B@B@D@DA@@B@D@@AD@@AB@@@B@BBBD@DBB@@B@B@BADCBABABCBA@ADADA@ABA@@@A@@A@AAE@AAEAA@A@ABA@A@@AA@@AA@@A@@@AB@@AB@B@@ABCDA@ABA@A@A@C@A@A@A@A@A@A@@@ABA@@BA@@@A@A@A@@BABA@@@ABAD@@ABA@@@B@@B@@@B@B@@ADB@@B@B@DAB@B@BB@@B@@@DAB@B@@@B@BADA@A@@BCB@@AD@B@D@B@@A@@B@@@D@@AB@@@B@@@BA@@H@F@D@@@BA@@@@@AAA@ABCA@@A@@@@@A@@@@BA@CBA@A@@@AB@@AB@@A@@BCAA@@BA@@DAB@@A@@AA@ABA@@B@BAB@B@@A@@B@AA@A@A@@BA@@@@B@@BB@B@B@@@@AA@@A@A@@@@B@B@@@DB@@@@@@@B@B@BB@@B@@@@DB@@BBB@@B@D@@BBB@BBB@B@B@BABA@@BC@@@@BBB@B@BAB@D@DBD@BB@@BBB@@@BA@@B@@@BBBB@B@@BB@@B@@A@@@A@@@@B@B@ACB@@@@@@@BBBB@BB@BBB@@@B@@@B@@BBB@BB@@BB@@@B@B@BB@BDBBBB@@BB@@@@@BBB@@@B@B@B@B@@@@@B@@@DBF@@@BB@@@B@B@@DB@@@BBB@BBBB@B@@B@@@B@@BB@@B@@@B@B@@@@@BA@@@@BB@@@@@@@@@B@@@@@@B@@B@@B@B@DB@@@@@@@ABB@BB@DBB@@B@@B@@A@@@@@@@A@@B@B@B@@@DA@AB@B@B@@@BC@A@A@@@AA@@@@@@AB@@@B@@@BA@@@ABA@AB@B@@@BBB@HD@@BB@@B@@@BABA@@@AB@@@@@@A@AAA@@@A@@BABC@@D@BAB@BA@ABA@@@A@C@@@A@@BAB@@A@@@@@C@@@@@AD@@ABA@@@@@@@@@AB@@AA@@AA@@@A@@@@@C@A@A@AA@@@@@A@A@A@@AA@AAA@AA@@@C@A@AA@AA@@A@@AAA@@@A@A@@@AA@@A@@@AA@@A@@@A@A@A@AC@@A@@@C@AA@@ABC@@@A@@@@@A@@A@A@C@@@@A@A@AB@@@A@@@A@@@ADC@AA@AAA@AAA@@@@A@@@A@A@@B@DA@@@@@@@C@A@@@A@@@@@CB@@CB@@A@@AC@@@@@@B@B@BB@@BAB@B@@@B@B@BD@B@D@B@B@BB@@@BB@AB@B@D@DAB@B@@@B@DABBDBB@DBBAFAB@F@FAB@B@B@B@B@B@@@@@B@@B@@@B@@A@@BA@@@@B@@@@BD@BBBB@@BDDBBBB@@BB@BABBD@F@@BB@@BBB@B@B@BAB@@@@@B@@@@@B@@BBB@@BBB@B@D@@@@BB@B@FC@@B@@@BBBD@BB@DDBB@@ABAD@@B@@@B@DAJ@FA@@D@@@BAD@B@B@D@DA@@@@@@@CAA@@B@@@@@@@B@@C@@@@BA@@@C@@DCB@BAB@B@F@B@DA@@BA@@@A@@@A@@@@@A@@@A@@@A@@D@B@B@D@@@@@@@@B@@B@@A@@@A@@@@@AD@@@@@@@B@@@BA@@@@@@B@@B@@B@B@BB@@D@B@@@@@BB@@BBB@@@B@@@B@@BB@@@BB@@@@@B@@@@@@@B@@@B@@@@@BB@@@BB@B@BB@@BB@@B@@A@@B@D@@@BB@BB@@@BBD@@@B@@B@@B@@AB@@@@@@@@A@CA@AA@@B@B@@@@@@BBB@@BBB@@BA@@BABA@@B@B@B@@@@@@B@DB@@BB@@A@@BA@@@@B@@@BAA@BA@@B@BBB@@@@@B@DB@@D@@@@AAA@@BA@AA@@A@A@@B@@AB@@B@@B@B@BA@@@@B@B@D@@A@A@@BABAB@@AA@@AAA@@@@@A@A@@@@@A@@@A@@E@A@AAAAA@AA@AAAAACEAA@AA@@A@@BA@AB@@@@AA@AAAAB@DADCB@@@AA@C@AB@BA@@@A@A@@@AB@B@B@BABA@ABA@@B@@@B@@@B@@AB@@C@@B@@@FDB@@B@@BB@@B@@@BA@@@AB@F@B@B@BBDBB@D@@@D@BBD@BAFAB@D@B@@@B@@@@AB@@@D@@@D@@@B@FBB@BB@@D@B@F@D@NBB@DB@@B@BBBBDBD@DBBA@@D@BABABABA@@@@@@A@@@@A@@@@@@@A@E@A@@@A@@B@@@F@@@@@BB@@B@@@@BB@@ABBBA@@@@@@@A@A@AA@@@@AB@B@B@DADAB@BAB@BBB@B@@@B@@AB@@AB@@@BA@@@@B@B@B@@AB@@A@@@C@A@@B@B@F@@@B@@@BA@@B@@A@@@@@B@@@BA@@@BB@@BBBB@@B@@@@B@D@@@@@B@@@@@B@@BBB@@AB@@BB@@@FB@@@@B@B@BA@@B@B@B@B@B@BBD@B@DBB@B@@@B@B@D@BBB@BA@@@AAA@A@@@AAA@@BA@@@@BBB@@BDB@BB@@@@@@ABABC@@FA@@@A@@@@@@@A@@D@B@BBB@@@@@@@DD@@@BDDBD@@B@B@@@A@@B@B@@AB@@B@@B@@CB@B@@@@@BBD@B@@BB@BBB@@@@D@B@B@BBBBBDB@B@FDBBBB@@BBB@B@@A@@B@@A@A@@BABABA@@BA@@B@@@BA@@@A@@@A@@@A@A@@BAB@@@BEBA@@B@@@@AA@@@@A@AAC@@@A@@@A@@BAB@@AB@@AB@@A@@@@@A@@@@@A@A@A@A@A@@@A@@BA@CB@@ABA@A@@@C@A@@@AA@@@AA@@A@@@@A@@@A@@B@@@AA@@@@@AB@@A@@@AA@B@@A@@@A@@@A@@@A@A@A@@@@BA@@@@AAA@B@B@@@BAB@@@DAB@B@@@B@@@BBD@B@@@BA@@B@B@B@B@D@@@BBB@B@@@B@@AB@@A@@A@B@@A@@B@@@B@@@@@@@BBBA@@@@BC@@B@BCBA@ABA@A@CBAB@@@"]],encodeOffsets:[[[121673,27060]],[[122152,27315]]]}},{type:"Feature",id:"350924",properties:{name:"寿宁县",cp:[119.514986,27.454479],childNum:1},geometry:{type:"Polygon",coordinates:["@@D@D@@@@@BBB@@@B@DAB@B@BBF@B@B@B@@@BB@@BB@BD@BB@@BA@@BB@@@@@B@B@BDB@@B@B@@@DC@@BA@@@@B@@@AB@B@@BB@BDDB@@B@B@@@BAB@B@BAB@@CBAB@BAB@B@@@BB@DH@BBBB@DB@@@B@B@@@B@BBB@@CDAB@DA@AB@@ABA@@@ADA@ABA@A@AB@@ABB@@@DDB@B@B@BBBBB@@B@@@B@@AB@B@@@BBBBBBDBB@B@@A@@B@@C@ABAAA@GAA@A@A@EAA@@AA@ACCA@A@C@@@@@AA@A@A@@BED@@C@@@AAC@AA@@AAAAAAAA@@@@AB@@ADADAFA@@@@@AB@@A@A@AB@@A@AB@@@BAA@BA@@@@B@@A@@B@@@BA@@BA@@B@@@@CB@@@@A@@@@BA@@@@@AB@@A@AB@@A@@@@B@@@@@@ABAAABB@A@@B@@A@@@B@@@ABA@@@@B@B@B@@@@@@@BAB@@B@@B@@A@BD@BAB@BA@@@A@AA@A@@AA@@@@A@@@@BA@CB@@AA@@@B@@@@@@@B@@A@@@@BAA@@@@AAA@@@@B@@@@@@A@@@@@A@@@@B@@@B@@@@A@@@@@BDB@@B@@@@@@A@@@@AA@@AAA@@@@@@ABEBA@A@@@ABA@@@ABABA@G@@@A@@@A@@@A@CA@@ABA@@@@@ABA@A@A@A@A@@@ABA@@A@@@@@@A@C@A@A@ABAB@BAB@@C@@@A@A@A@@@@@A@AC@CAAAACGA@@A@ACA@AA@@@A@A@C@@B@@@D@@@BB@BD@@DBBB@BB@BBBB@@@B@@@D@B@@AB@@A@@@@@AB@@@BAB@@A@A@A@@@AAA@AAA@A@E@A@A@@AA@A@A@A@A@ABA@@@A@@@@@@@@@@A@@@@@AC@A@A@A@A@AA@@A@@@A@A@@@ABABAB@@A@@@CA@@C@C@A@ABCBABAA@@A@A@@@A@@@A@@@A@@@ABB@@@@@@@AB@@@@A@A@@@@@@@ABA@@BA@@@@@@@@BA@@BA@C@@BA@@@A@@@A@@@AB@@@@@DAB@@@@BB@B@@HH@B@@@B@@@BAB@@ABBBBB@@@B@@@BA@BB@@A@@B@BA@@BA@BB@BB@BB@@@@@@@B@B@@@@@D@@B@@B@@DB@@@@@@@BAB@@@D@B@@@@D@B@BBB@@B@@B@B@@@@@@@@B@@BB@@BB@BABABAB@B@@@B@@@D@B@@@B@@@@A@BB@B@BB@@BB@AB@@AB@@F@@@@@D@@A@@B@@@@AB@@@@@@@@A@@@@B@@BB@@@AB@B@@A@@B@@@@@@B@@@B@@@D@D@BB@@B@@@@B@@@B@@B@@BBBBB@@@B@BB@BB@@BB@@@B@@B@@B@@B@@BA@@B@@@BAD@@@B@@@BAB@B@B@BAB@@A@@@AB@@A@@@A@@B@@A@@@AB@@@@@B@B@B@@A@@@@B@@@BA@AB@@AB@@@B@B@@@@AB@@@@B@@BAD@B@BA@@B@B@BAB@@BB@B@@@B@@@@@@@B@BBB@BB@@BFBF@BB@DDFDHBB@@BBDDBF@D@@@BDJDJFNJFDBPFLFDBPFNFLHHBFBLHFH@@@@B@@@@@B@@@@B@@@@@@AB@@@B@@@@@B@@B@@@B@BB@@B@@BA@@@@B@@@B@B@@A@ABA@@@BBAB@@@BABBB@B@@@B@BA@@BA@@@FHJHFFFFHHRNDBHDDBPDVFJ@L@F@P@LEBAHI@E@CDMHKJIJDFBLDJHTT@@HLHLBBDL@L@FLTDL@BALANAB@B@@@@HPBDDHDDPLDBBD@BAFAB@BAD@@BHADADDDBBAFBN@FBH@F@DF@H@FHDAB@DCB@FADBBBBDBD@F@BBBB@FAH@FABBD@BDBD@FAFCFBB@D@@B@F@@AB@@CBABAF@BA@G@A@ABADBD@DA@@D@FFB@BAFABBFBBBDBBDBBB@DAFE@@DADAH@FBFBBD@DBBB@FBJDD@D@B@BAD@B@B@DBDABABCD@FAF@H@DA@@B@@ABA@@B@D@HE@A@CACAECEA@@ABA@CDADGDCDC@ACI@AC@AAAA@AAC@C@AAACBCBAACACCC@AACA@CAGBEBABCB@BA@EACAA@E@ABCBC@E@E@C@ABE@ABAD@D@BBD@B@BAFCB@F@B@FAB@DAD@B@B@B@FAJ@FAD@F@BC@@@@BA@AACCAACACAC@CBA@C@AA@A@@@C@ACACCGCCAC@ACE@A@@B@@@BAB@@@BAB@@AB@@A@@@A@@@@AA@@@@B@@@B@@@B@@@@BBBB@BBB@D@B@@@BBB@@@B@B@@@B@B@B@@@BA@@@@BBB@@@@@@B@@@B@B@@@@@BB@@A@@BABA@@@AB@B@B@@@B@@A@@@@A@@@A@@A@@@A@@BA@@@@BAB@@AB@BCDA@@BCB@DA@AB@@@@AA@@@@A@@@@BA@A@A@ABA@AB@@@B@B@BA@@@@BA@@@@B@@@@@@@BA@@@A@@@A@@@AAA@@CA@@AA@@AA@@@A@A@A@A@A@@@A@@A@@A@A@@BA@@BAB@B@B@DA@@@@BAA@@A@A@ABC@A@A@@@@AAA@AAA@@@@A@A@@@A@A@@B@@A@@B@BB@@B@@@@A@@@A@A@@A@AA@@A@@@@A@@@@BA@ABA@@@AAAA@@@@AAA@A@@@@BC@@BA@A@@BA@ABA@@@@AA@@@AA@AAA@AAA@@AA@@@@AB@@ABA@A@@@@@AA@@@A@@A@@@C@C@A@AAA@@A@A@@AA@@@AA@@CAA@@@A@@AB@@A@@AA@AA@@@A@EB@BA@@@@@A@@AA@AAAA@@B@@AB@@A@ABA@ABCB@@AB@B@B@D@BAB@@A@AB@@AB@@@@AB@BB@@B@B@@@BAB@@@@A@@@A@C@ABA@@@A@@AA@@@@@A@@@@@@B@@A@@@@@@@AB@@AB@@AB@BABA@@@A@AAA@A@A@@@A@@@@BA@@BAB@B@B@B@B@B@BBBAD@B@BAB@BA@@BA@A@ABA@@BAB@DA@@B@@A@@AA@@@A@@BA@AB@@AB@BA@@B@B@BA@@B@@@@@@@@@A@A@@AA@@@@@@@A@A@A@@@@@ABA@AB@@@D@@AD@@A@A@A@@@A@@@@@@@@B@@A@@@@@@B@BCB@@A@@@AB@B@@A@A@AAE@A@@AA@@@E@@@A@@A@C@AA@AB@@@A@@@AB@@AA@AA@@@@A@@@A@A@@@@@C@AB@@AA@@A@@@A@@@A@@A@@@@@@@A@AA@@@A@@@BCB@@@AAA@@@@@@AA@BABABA@@B@@@BCBA@@A@A@@@@A@A@@A@@A@@@A@@@A@@@A@@@@AAA@AAI@A@AAAAC@CCCAAAA@AA@@C@A@A@E@E@ABA@@AA@AA@A@@@A@@AAA@A@EBA@A@@@AAABAAABA@@@A@@@A@A@@@@@C@@AA@@AA@C@@@CDA@AB@@A@A@@@AAEAA@AAACEE@A@A@CAA@AAAACCA@AA@AD@B@@A@A@@B@@@@@BA@@@@@A@@@A@@AEA@A@A@A@@@@AA@@@@BA@@A@@@AA@@AAA@@A@E@G@ACE@@@A@@@A@@@@A@@@@A@@@@AC@@@@@@D@D@BA@AAA@@BA@@@@@A@CA@@CB@@@@A@@B@@ABC@@@@BAA@@@A@@A@@AB@@A@@@@@BB@@@@@BAB@BA@@@@BA@@@@B@@@@A@C@@@@AB@@C@@@@@@A@@@ABA@A@CAA@A@AACAA@@@@@BAD@B@B@BBB@B@@@B@AA@CAC@A@@@AFBB@BADA@AB@B@B@@@@@@B@@@@B@@@B@@B@@B@@@BB@AB@@@B@B@@@@@B@B@@@@BBDBBBBB@@@@@B@B@@@DC@C@@FC@ABA@@B@B@BBB@@A@@B@@@@@AA@@A@@@A@@AA@A@A@A@@CA@@@ABA@AB@@@@CAAA@@@E@@@@AACCAA@@AA@@@@@@@A@@@AB@BABA@@@A@A@@@ABA@A@A@AAA@@@A@@@ABC@@@AAAAC@@AACAAA@@@@AA@@AA@@@@FCBA@A@ABAB@@A@@A@@ABA@E@A@ABAAAAA@@@ABA@C@@@A@C@@@CA@AAEC@AAA@@@@@ABABA@@DADCBA@@@A@@AA@AA@@@BCFCBABA@@@@CACA@AC@AAAAA@A@AA@@A@@A@A@@AAAACA@@AAAAAAA@@AA@AB@@A@AA@@@BA@A@A@A@@@AA@@AA@@ABCDCD@B@B@@BB@@@B@B@@@@B@@BB@@@@AB@@@@@@BB@@B@@@@ED@B@@@@ABABABA@AB@@@@ABAA@@A@@AA@AAABAB@@A@@AAA@@@@@@A@@BEDA@@@A@AAAB@@AB@@E@@@ABEBA@AAAAAA@@A@@@C@@@A@@@A@@B@@AB@@@B@@@@A@@@A@@@A@@@A@A@@@ABA@@@A@@@A@A@@@CBE@A@CDCBA@A@@@@@A@@@@BA@A@EB@@@@A@A@@@A@A@A@A@AAA@AA@@AAAA@@A@@@@BECCAA@A@@@C@A@@@C@A@@@A@@ACA@@@AA@A@@@@@A@@A@@AAACA@AA@@AA@@AA@@A@ABA@@@AB@@CAAAA@E@EA@@A@@AAA@@AA@@A@A@@@A@@@A@@A@AAA@@A@CAA@A@AB@@@@@B@@B@DB@B@@@@@@@B@@@@@AA@@AA@@@@@AB@@@A@@@@@AA@@@A@@@AB@@A@@@@@@A@@@@@@@@A@A@@A@@@@@@BA@@@@@@@AA@@@ABA@@@AA@@@A@C@@A@A@@A@@B@B@L@B@BA@@@@A@A@@@@A@@A@@@AA@@@A@AB@@AAAAAA@@@C@@@AAA@@@@AA@A@AB@@ACAA@A@@AAAA@@A@C@@@@@@@@AAC@A@A@@B@@@@A@@@AAC@@@A@@AA@@A@@@CBGB@@AACA@@@ACA@@@@@AAA@@@@A@@BAA@@A@@@@B@AAB@@@AA@@@@@@BA@@@@@A@@@@@AA@@@@A@@@A@@A@@AAA@@@@A@@@@A@@@AAA@@@A@A@@@@@@A@@C@@@@@AB@@AB@@AB@@A@@@@@@@@AAB@AA@@AA@@@@@A@@@@@@BA@@@AB@@AA@@@@AA@@@@@B@B@@D@@BB@@B@@DB@@@BABAH@B@@@BBB@@@B@D@@AB@BB@@BA@ADA@@BBBA@@@C@@BA@@B@@BB@B@@ABA@@@AB@@BD@BB@@@BCDADAB@@@@@@BB@AB@F@@BBB@@@B@BAB@B@B@"],encodeOffsets:[[122271,27855]]}},{type:"Feature",id:"350926",properties:{name:"柘荣县",cp:[119.900609,27.233933],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@@@AAAA@@B@@A@@@@AA@@A@C@CAA@A@AACAA@EACAA@@C@@@AA@@@A@@B@BA@ACCAAAA@A@@@AAA@@@@@A@AA@@@@@@@@@@A@@@@@A@@@@@@@@@A@@@B@@B@@@@@B@@B@@B@@@B@@@BB@@@@B@@@@BB@@A@@@@@@@@@@@@@A@@B@@@@@@A@@@@@@@AB@@@@A@@@@@@@@A@@@@@A@@@A@@@@@@@@@@@@@@@@A@@@@@AA@@@@@@@@A@@@AA@@@B@@@@@@@@@@B@@BB@@@@@BB@@A@BB@B@@@@@@BB@@B@BA@@@@@@B@@@BA@@B@@BB@@BBB@@@@@@B@@@BD@@@@@B@@A@AB@@@@AB@@B@@@@B@@@@BBB@@@B@@@@@@@@@ABA@@@@B@@@B@@BB@@B@BBB@BB@@@@@@@@BB@@@@@@@@A@ECAAA@A@A@C@A@C@C@A@A@AB@@ABA@@BA@MAAAA@A@@@A@E@A@A@@@AA@@@@@A@@@A@A@@@A@A@A@GAC@@CAAAA@C@C@E@A@A@C@ABC@AB@@CAA@A@ABC@AB@@@@CAA@IB@@A@ABAB@@AB@@B@@@BAB@B@@@@B@BAB@DA@@B@@C@AB@BA@BD@@D@BB@@@@BBBB@@@@ABA@BB@@@@BBB@BB@@@@@BA@@@@@@BA@@@@@AB@@@@ABAC@@A@@@A@ABA@A@A@CBC@EBAB@AA@@@@@A@A@@@A@AACBA@AB@BA@@@@F@@@@@B@D@B@B@BABB@BD@@@@@@A@@@A@@BA@B@@B@@A@@@A@@AA@A@@BA@A@ADA@@BABADAB@@C@@@ADCF@BA@BB@@BB@BBB@B@@BB@B@B@@@BAB@B@@BBBBB@@@@BD@B@BA@@BAB@B@@B@@BBBD@@@B@DA@@@@D@@@D@B@B@@D@@B@@BBBB@@@BA@@BAB@@@@AAA@@B@D@@@@@@@@CAA@AA@@A@@BA@@@@B@@@@AA@@@@A@@B@AAB@@@@AB@@AB@@@@@BB@@@@@AB@@@@@@@BB@@B@@@@@BA@@@@@@@@BA@@B@@@@@BA@@@@@BBB@@@B@ABB@@@@@@BB@@@@B@@@BB@@@@B@@@BBBD@BBB@BB@@B@@@@@@AB@@AB@@@@ABA@@BA@@@@BBBB@@BDB@@DB@BBBDB@@B@@AB@@@@BB@@@B@@@@@@B@@@BB@@ABB@@@@@@BBF@@BB@B@BBD@@BB@@@B@@@BB@BBB@B@B@D@B@B@DA@@B@BBBBDBBBBBFBB@BB@@@@@@AB@@AB@@AA@@A@@@A@@@@@@BB@B@EDABAB@@@@@BH@@@@B@B@@@D@@@@@BB@@B@@@B@@@BABABA@A@A@ABA@ABCBCBCDEBABABCDCBGF@@@@@BA@@BA@@@@BB@DDBB@@B@BB@@@@BA@@@@@@@A@@B@@AB@B@B@B@@@B@B@B@DB@@@@HE@AB@BAB@@BB@B@@BBB@BA@A@@B@@CBCB@@@@BBBB@@B@B@D@B@@@BB@@@@AB@@CBA@C@@BA@@@@B@@BB@BBB@B@@@@@BBA@BDD@@@BB@@DB@@B@@BBDFBBBDBBBB@B@B@@@BGB@BA@A@ABA@ABC@A@A@@B@@@BABD@@@B@@B@@@@CB@BA@@BB@B@@@D@@@DAB@BAB@DAB@D@D@B@@@DA@@B@BB@B@DBB@B@B@BB@@@@BB@BAD@BAD@BAB@FCB@BAB@B@BA@@BA@A@@BCBABAB@BADAB@B@B@@@BB@@@B@D@BB@@B@@@@B@@@B@@@@A@@B@@@B@@@@@@@@@@@@@@A@@@@BAB@DBB@@@BBB@B@B@B@DABAB@B@B@@BB@BD@BBD@B@BA@A@C@ABA@CBAD@@ABA@@BB@BB@@BDDDBDDDBBBBB@B@B@BABA@AB@@@@AB@BABABA@@BA@@BA@@@@@@B@@@BBBB@@B@B@BAB@B@BA@@BB@@BAB@B@B@BBBA@@@@@@B@@AA@@A@@AA@AA@A@AAA@A@AA@@@A@A@@@AB@B@@@D@B@BBD@@@B@@@@AB@AC@A@A@@BA@@BAB@@@BB@@BB@B@F@DBD@@@@B@B@BA@@B@@@@@@AB@@AB@@@@B@@B@@B@B@@@B@B@BB@@@@@B@BAB@BABA@@B@@@BBB@@@B@B@B@B@@@@@@B@B@DB@@@@BBBB@BBD@B@@@@@B@@@B@@@B@B@@@B@B@D@@@BABABABAB@@AB@@@@@@@DB@B@@AB@@B@@@BBBAB@F@@@B@B@B@B@B@@@BB@CAA@AA@AACCAA@@@@@A@@BABB@@B@DCB@@@B@B@B@@@B@@@@A@A@A@@@@B@B@B@@@B@@A@@@@@CA@@AA@@@@@@CAE@A@@@@BA@A@@B@B@B@F@BAB@BAFABB@B@B@@B@B@@ABA@@B@B@F@D@B@B@@@@@@A@@CAA@@A@@@A@@@AAAA@@AB@BA@ADB@AB@BADADA@@@A@A@@@@AAA@@A@@@A@A@A@A@AAC@@BA@A@@@A@@@@B@@@@A@AB@@@@ABA@CDCDCBAB@FCBAB@BAB@B@B@DA@@BA@@D@@@@A@@A@AAAA@@@A@@@@BAFAD@DAD@DA@@@A@A@A@@@A@@@AB@B@B@DCD@DADA@A@@@A@@@A@ABCDA@AD@@@@A@A@C@C@A@@@@B@DA@@BBBBFBDBD@@@B@BABCB@@AD@FAB@BADCBAB@BAD@B@BBB@B@BAFAD@@@@A@@D@@AB@@@@A@E@C@@BC@@@A@ABA@ABA@@BEBC@A@@@C@@@@@AJ@F@BAB@BA@@@A@C@@@A@@BA@@AAA@A@AA@@A@@C@@A@EAA@AA@ACA@AA@@ABA@C@@BAB@@@@@@A@C@@@@AA@C@@@A@@AAA@A@@@A@AB@@CB@A@@A@BA@ABA@AAA@@@AA@A@AA@B@BAB@@@B@@@B@@AB@@@B@@A@@B@@A@@@@@A@@B@@@B@B@@AB@BAB@@A@@BC@GAC@C@A@A@@@CA@@ABA@A@A@E@CBCAC@A@C@E@CBC@A@@@@@@D@BA@@@GBA@@@CD@B@@A@AB@@AB@@@B@@A@A@@@AAA@ABCB@@A@@A@@@@@A@@@@AA@@@A@@CC@A@A@@AA@@@AA@@@BAB@@@@@@@B@@@@@@@@@BA@@@@@@@A@@B@@@@AB@@BDA@@@@@@@A@@@@@A@@@@@@EBMHIFAEFEFCBIAG@CDEFMBG@CBCBKEII@GBA@ACA@@@AA@@ABAA@@@@@A@@@@A@A@A@@@@A@A@@CA@@A@@@AA@@@AAC@C@C@CAAAC@AA@AAAA@AAC@ACEAA@@@A@BA@@@A@EAA@@@A@ABA@ABA@@@AB@@@@A@A@ABAAA@@@@@A@@@A@@@@@CD@BA@ABAB@BA@ABA@@BB@BF@@@BBB@B@@AB@@@@@B@@ABA@A@@@@@@@AA@@A@A@@@@@AB@@@@EAC@A@@AA@@@@@A@AAABA@@AA@@@A@@@@AA@@@A@C@AC@@@A@A@@@@A@A@A@@@A@@A@@CAAA@@ABAAA@@@A@A@@@A@A@@@A@A@A@@@@B@B@@CB@B@@ABABA@@@C@@B@@@@@@ABAAAACAA@@ACBAAA@AAA@AC@@A@"],encodeOffsets:[[122745,27752]]}},{type:"Feature",id:"350925",properties:{name:"周宁县",cp:[119.339025,27.104591],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@@@A@C@@@CAAAA@A@A@@@AB@@A@C@@@AA@AAAA@C@@A@@@@@AB@@C@AB@AA@AA@A@AA@@@@AA@AE@CAA@AAA@A@A@A@C@C@C@A@@@@B@@C@@AA@@@@BABAB@@@@GAC@A@@@@@@B@@AB@BAB@@ABADADBB@B@B@@@B@B@@@@AB@BABADCD@B@BA@@@A@AAC@CAE@@@@@@@ABABADABCBADAB@@@@AA@@@@@A@@@@@AA@@@@@AA@@A@@AA@@@@@@DADB@ABA@@@AAA@AAA@A@A@A@A@AB@@ABC@CDA@@@ABA@@@A@C@@@CA@@@A@@@@@@@@BAB@BAB@@@B@@@@AB@@@@@BA@AC@C@CA@@@@@@BA@ABC@@B@@A@@@A@@E@A@EAA@@A@@A@@AA@AA@@AA@@B@AA@@C@@@ABC@@BAB@@AB@BBBDB@BB@@B@@@@BBB@B@@@@@BB@@CB@@AB@BAD@@A@CAAAA@ABA@A@@D@@ABA@CDA@@BAB@BAB@@ABA@@D@BB@@BDBBBBBAB@B@BA@A@A@@@ABABA@@B@@@B@B@@AB@@A@A@@BCDA@@B@@A@@B@@@BFF@@@B@@@BA@ABA@@@@B@DA@@BABA@CBCB@@A@AC@@@@A@@@@@AA@@AAAA@AAA@@@@AA@A@A@A@AB@@A@AAA@A@@@A@@@@@A@@A@AAC@A@@@ECAAAAEAA@@@ABA@@@A@A@A@ABA@A@@AA@@A@@@A@@AA@AA@@BABA@@BC@C@@@A@CDA@@@@BABA@@BA@@@AB@@B@@BBDBB@D@@@BA@@BAB@@AB@B@B@B@@@@B@@@B@BB@@@@@@@BB@@@BA@@B@B@@@B@@@@BB@@@BA@@@@@B@@B@@@@@B@B@B@@@B@B@B@@B@@D@BAB@@B@@BB@@B@@@B@@@BB@@B@B@@@B@A@@B@BAD@@@B@@@BA@@@@@ACA@@@@@A@@@AHABBBA@@B@@A@A@EAC@ABA@ABA@@BA@E@@@A@@BA@@BAB@@C@@BA@@@@BAD@BADABAD@BABABABABABAB@@AD@@A@AB@@EAA@A@AAC@A@@@A@ABAAA@A@@@ADABABA@CB@@AB@B@@@D@BA@@B@@BD@BA@@B@@@@@B@B@@@@A@A@E@A@A@@@AB@B@B@D@@@BBBB@@B@B@D@@@DCBC@@@@B@@@@@D@@@B@B@BA@@@EBCBA@AAA@A@A@@@ABABA@@BCBAB@@@B@BAB@@@D@BA@@@ED@@@@@@@@@BBBB@@B@B@@ABA@CB@@A@AD@@@B@BBB@A@@D@BAD@D@F@D@B@@B@@@BA@@B@@B@@@B@@B@@DB@@B@B@@B@@A@@B@BA@@D@@@B@B@B@@@BAB@B@BAB@@ABAB@@@@@B@BAB@BAB@@ADA@@B@B@D@B@B@@AB@@@BB@@@BBD@D@D@@@BBDBB@@BF@BA@@B@@@B@B@FBDBB@B@@@DBB@B@@B@@@@B@@@D@@@B@B@@BB@@@BD@@@@@B@@A@AB@@A@@BA@@B@@@BBB@@@D@@BB@@@@@B@@@@BB@@BB@@@D@@@@@BB@B@BBD@H@BBB@@@@B@BB@@@B@B@D@D@B@B@@@@@BD@@@@B@B@@@B@B@@AB@B@BABA@@B@BCB@@A@@BA@@@@@@B@@@@BB@@B@@BBBBBB@@@@DA@@B@@@BBBB@@@F@@@BBB@@@@BBB@@B@@ABAD@B@@A@@@@@A@@@A@@@@B@@A@@BCAA@@@@@AB@@@@AB@@A@A@ABA@A@@@AB@@A@@BA@@@@@@@@@A@@@AB@BAB@BAB@B@@@@BBB@@BBBABAB@@@B@BBB@BB@@@B@@B@B@@@@@B@BB@@BB@@@@B@@@@ABA@@B@B@F@BB@B@BB@@@@@@@BABAB@B@@B@@@@B@@@B@B@@@BA@@BA@@B@@A@A@ABA@@@A@@@A@@@A@AB@B@@AA@A@A@AA@@@@@CB@@A@AA@@@AA@A@@@@B@@BB@@BB@@AB@@@BBB@BB@DBBBB@@BAB@BAB@B@B@B@BA@@B@@AB@@@@@BAB@B@@BB@@@@@@B@@B@@BA@BA@ABAB@@@B@B@@@BBD@@BB@@B@@A@BB@@@BB@@@@@B@@@B@@@BA@@BA@@BA@@BB@B@@@B@@@B@BADAD@B@D@@@F@@@B@@@BBBDBB@@B@B@B@DAB@B@@@@@@B@B@B@D@B@B@@@@@B@@BD@@@B@@@B@@@@@B@B@@@B@@@B@@@@@B@@@B@@BB@@B@AB@B@B@@@BB@@B@@@BB@BBBAB@B@B@B@@@AA@@@@@A@ABA@@BCBA@AB@@@B@BBB@@@B@B@@@BB@BBB@@BADAB@@@@ABABBB@@BB@B@BAB@@@B@@@@A@A@A@ABA@AB@@A@@@C@@@AA@@A@AA@@A@A@AB@B@@A@@B@BC@@B@@@B@@@@BBB@@D@B@BA@@BA@@@ABC@A@A@A@ABA@@@@B@@@B@@B@@@DB@@B@@B@BA@BB@@@BB@@BB@@B@@@BAB@B@B@@AB@@A@@@A@C@@BABA@@B@@@@BBB@B@@@D@BBBB@DF@B@B@B@@BBB@@BBB@@ADA@ABA@@BA@@@A@AA@@AB@@@@@B@@AD@@@B@BA@@@AB@BA@CB@@@BA@@B@D@BBB@BA@@B@B@@@@CBA@@@AA@@@A@A@@@A@@@@ABCFAB@@@@A@@@A@@@@B@@AA@@@@AA@@@BA@@B@@@B@BA@C@@@A@C@A@@AA@@A@@A@@A@@A@@@A@@BA@ABABABA@@@CB@@A@@B@BCBA@AB@@AB@BAD@BABABE@@@ABA@@BABA@@@ABABABAB@@A@ABA@@B@@@@@B@@BAD@B@B@BBB@BDA@@BCBABABA@@@A@@@BB@@BBBB@@BJ@B@@AB@@@B@@A@@@AB@BBB@BBBA@@B@@@B@@@B@BB@@@@@B@B@@@BB@@AB@BBD@B@B@B@BA@@B@@@@@B@B@@@@DB@B@@@B@B@B@@BBBB@BBB@B@B@@BBBB@@@B@@BB@@BB@BB@DD@@@@BA@@@ADADAB@@@@@B@@@@B@@DDBB@@@BAB@@@B@@BBBBF@B@@@B@@@BA@@@@J@B@@@@AGG@@@AAA@@@@BA@C@@@@BA@@B@@@B@@@B@@AD@B@@AB@@A@@@@@@B@@AB@BA@@@@@@B@B@@@@@BA@@@@@@A@BA@@B@@@B@@@B@@@B@B@@@BBBADABAB@D@D@@@DB@@B@@@BABABA@@B@B@@@B@@@BBB@B@B@B@D@@B@@@@@B@@@@@@@@B@@@B@BAB@B@B@B@B@@BB@B@F@B@B@BBB@BB@@B@B@B@@@BA@A@@BA@@@@B@@@BA@@@A@C@@@A@@AAAAA@@AAACA@@ACA@@A@@@C@@@AD@B@B@@@B@@BDB@B@BB@DHBBBB@DBDB@@@@@B@B@B@@@D@@@BA@ABABAB@B@D@B@@@@@@@@BB@BA@@B@B@B@B@B@BA@@@@B@BA@@DBB@@@B@@@B@@@H@B@BABA@@B@BA@@B@B@FABA@@@@@@BB@BB@@B@@B@@@@@@@@AA@AC@@@@B@@@@@@A@@@A@@B@@@@@B@@@@@@@@A@@B@BB@@@@BB@A@@B@@@@A@@@@@@@A@@BB@@DAB@@A@@B@@@@@BB@@@BBBB@@@B@@ABA@AACB@@@@AA@@@BA@A@@@@@@@A@A@A@@B@BA@@A@@@B@@@@AB@A@BABBBA@@@@@@@A@@B@@@BAB@@@BA@@@@B@@A@@B@@@@@DA@@@@@AB@@AB@@A@@@AB@@@@A@@B@@ABB@A@@BAB@@@BAB@B@@@BA@@@@B@BEBCBC@@BA@@@@BBBBBBBB@@BBD@BB@@D@@@FC@AB@B@B@@B@@@@@D@BDBBDB@@BB@FBB@B@B@HBB@BBBAD@@@@AB@@@@AAAACAAAA@A@@@ABA@@@A@@@AA@AAAAA@A@A@CC@@A@BA@@BAB@B@BAB@BC@@B@BA@@BAB@@CBADC@@AA@A@A@@@A@A@@CAA@AA@ACGA@@A@@@ABA@ABADA@@BA@A@ABA@A@@@A@AA@CC@AAA@@@ABA@@A@@@@@AB@@CD@@A@A@@@CA@A@A@A@@@@AA@@AB@@AAC@@AAA@@AA@@A@A@A@E@AAA@A@CBA@@@A@AA@@@@C@C@A@A@A@ABA@@@A@AA@@@EBAA@@A@@@@A@CBCBAD@@A@@AAC@@BA@@B@BA@@@AAA@@@AB@@AD@@@B@AA@AB@BCB@@AA@@ABA@@@C@A@@AA@A@@@ABGBA@A@@CA@@@AA@@AC@@@@A@A@@@@@@@@AA@@@AAA@@BADC@@BA@A@@@@AA@@B@@A@@BC@A@@AACCEE@@@AAA@A@@BA@@@C@A@CAC@A@A@@@@@CB@@ABA@A@@B@D@@@@AB@@AAC@@B@@AB@BA@AB@BC@@@@BBB@BBB@@@BBB@@@B@FAB@@@@@@@B@DAFAB@@@@@@ACAA@@A@@@A@A@@@@@AB@B@@@BA@A@A@@ACAA@@@A@A@A@A@@B@@ABA@A@@@@B@@AB@@A@@@@@A@A@C@@AA@A@@BA@@@C@@@@BAB@@@D@@@BBBAHBB@D@DB@BB@BB@@B@@@@@BCBA@@BA@@B@B@DBDFB@B@@@BB@@BB@@@BB@@@B@@@B@B@@A@A@@@@BA@@B@@@BAB@@@@A@@CCAAAA@AA@@CAA@A@A@@BABC@@@A@A@@C@A@CAA@@@A@@A@@@AB@BAB@BA@@BABABA@@@A@@@AA@@@E@A@C@@@@@CCA@A@A@A@A@C@A@A@CAA@@@A@A@A@@BAAC@CAA@CEA@@AAC@C@@AB@@@@@@CB@B@@@@A@AA@A@A@@@AB@@A@@B@BA@A@@@@C@CAAAA@A@C@@@A@A@AACA@C@@@A@A@A@@@@AAAA@@@@@@A@@AAA@@AA@A@@A@@@@@@@@ADABABAB@BA@ABCBCBC@B@@@B@@B@@BDAB@BBB@@@B@@B@BBA@BBBB@@@@@B@BB@@BBBB@@BBB@B@@@DDBB@@@@BB@@D@B@B@@BB@B@@@BADA@@@@CAAAA@@@@A@@@@@@BCBABA@@@AB@BDD@@@BBBB@@BBB@D@DAB@@@@A@AAAAAA@@@@A@@@@BABABCBA@@@@@@CAA@AA@@@@@ABA@CBA@@B@D@B@@@@@@@AA@@AAAA@A@A@@@@BA@@B@@A@ABA@A@@B@B@D@B@B@B@@@@AB@@AB@@@@A@@@@@A@@B@@@@@BBB@@@D@BA@@B@@@BA@@@@BB@@@@BCDC@@D@@@@A@@@@@@BA@@B@@A@@@@@@@@AA@@@@@@@@B@@AB@BABA@AB@BAB@BA@@AAB@B@@@AA@@B@BA@@BBB@AA@@A@@@A@C@A@A@@EBA@ABA@A@A@ABA@AB@@@B@@@BA@@@C@E@@@ABA@@@@@@@A@@@A@@@A@@B@@A@@@@@AB@@A@@@AA@@A@A@@@AA@A@@A@@@A@AAA@@@AAA@@@@AA@AABC@@@@BA@@@A@@B@@A@@AA@A@A@A@AA@@A@GACAA@CAEAA@CCA@AAA@@AA@@A@@AAA@AAC@EAE@AACAAA@@AA@@@A@C@@BC@@AAAA@@@A@@BCBA@@@ADC@A@@AAA@@ACCCA@A@@@A@@@A@A@@B@BC@@BA@@@@@@@A@@@@A@AA@@A@AA@@@A@@ACA@A@@@@AAAA@AAA@@@@ADA@AB@@A@@A@@@AAAA@@@@A@@@A@@@A@A@@@@AA@A@E@@@CACA@A@@AA@@A@@@@@AA@@@C@A@@@A@@GAC@A@A@@@@@A@A@@@@@@B@D@FA@@@A@@BAD@@CAA@@AA@@A@@CA@@A@AA@@@@@B@@@B@@@DA@@B@B@DA@AB@@CBAB"],encodeOffsets:[[122250,27549]]}},{type:"Feature",id:"350981",properties:{name:"福安市",cp:[119.64785,27.08834],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@A@@@@@@@@A@@AA@@@@BA@@@AA@ACAAA@@CA@AC@@AAAA@@@@AB@@AB@B@@A@@BA@@B@@@@A@@@AAA@AAC@AA@A@@@A@@A@@A@@@A@@A@@A@@@@A@BAA@@@A@AA@@@@B@@A@@@@@AB@@A@@@@@@B@@A@@@@@AA@@A@@@@@@BA@@@@A@@A@@@@BA@@BA@@@@BA@B@AB@@@@@BB@@@@@A@@B@@AB@@@BBB@DB@@@@@@@@@C@AB@BB@@@@BA@AB@@A@@AAAA@@@AC@@@@A@A@C@@@C@@B@@C@A@@ACAA@@@AA@A@AB@@ABA@C@@A@@A@AAAA@@@ABA@A@@@A@AAA@@@AAA@AAA@@AAB@@ADEBC@@D@@@BABCBA@AB@BCB@B@@AB@B@@BB@@@B@@@@AA@B@@AB@@@B@@@@@@@ACA@BA@A@A@A@C@A@@@@@E@@B@@ABAB@DABBB@@@B@B@@@@@B@@BBAFAD@DAB@B@B@BAB@@@B@@@BDBA@@@@BA@@@@B@@A@@@@B@@A@@@@AAA@AA@@@@AAB@BA@@@@AAAA@@@@AAC@@@ACB@@ABAD@@@@AB@@CBA@A@A@@A@A@AB@@A@@@BA@@BABAB@@@JAB@DB@@@@BAD@BAAA@@@@@@@A@A@@@A@C@@@A@A@@B@BADABA@@BA@C@A@@BAAABA@C@@B@@@D@DBB@B@@@@A@A@@@A@@@A@@@ABA@@@@A@@@@@@AB@@@@@@A@@B@@@D@BB@@@@B@BAB@B@@@DAB@B@@@BA@A@@@C@A@@B@B@@@B@B@B@B@B@@@AAAAAAACAAAA@AAE@A@A@@B@@@D@B@B@@AB@B@@@B@B@@@@@@BB@B@@@BBB@@@@@BA@@@C@@@AB@@@@ABABABA@@B@@@@@@A@@@@AAAA@@CCA@@@@A@@AA@A@@@A@AAACC@A@AAAAAA@A@CAA@A@@AA@AA@@@@@AB@@@@AB@BA@@B@BA@@B@@@BA@A@ABAAA@A@A@AAACCAA@@@ADABADCB@B@@@B@D@@BB@BDBBBD@BB@@D@B@BBBB@@@B@@@D@@@F@B@B@BAB@@A@@@A@A@AB@@@AEAA@ABA@A@@@@BAB@B@DABA@@FB@@@@@@BA@A@A@ABA@A@ABA@@B@BA@@B@@A@@B@@@B@@AB@@@BB@@@@BA@@BBB@B@B@B@BA@A@AB@BAB@@@@A@@@AA@@@@AA@C@@@@@A@@A@C@A@@AA@@A@@@A@@@AB@@A@@A@@AAAAAA@AAAC@@@AAACAC@@AAAA@@@@@@BC@@@A@A@@@@DA@@BA@@@AAA@AA@AA@@AAA@AAC@E@A@A@A@A@CA@A@@@@@A@@B@@ADA@A@ABC@A@@BA@CA@@AB@BA@@@A@@BAB@@A@@B@@AB@@A@@B@@@@A@@BA@@A@@AAACA@@AAA@AA@@A@@B@@@BA@@A@@@@@A@@A@@A@BC@@@@B@@AAAAAA@A@A@ABA@@BA@AB@@@BAAA@@@@@AB@BA@@@@AA@@A@@AAABAA@@AAC@@@A@A@A@@@AA@B@@AD@@@@AB@@A@C@@BA@@@AC@C@A@A@A@@@A@A@AA@@@@@A@A@@BA@@@@@@@A@@@@@@B@BA@@@@@@@BB@@@@AB@@@@@@A@@@@@@@@BA@@@B@@@@B@B@@ABB@B@BB@@B@@@BB@B@@@@@BABA@@HA@@@@@@AAA@@@@A@A@A@@AA@A@@B@@A@@@A@A@AA@ABA@@@A@ABAB@@@@A@A@@A@C@A@A@A@A@C@A@A@@BAB@@ABC@A@A@@AAAA@@AAC@C@C@@@@@@@@E@@@A@@@A@AB@@A@A@ABABAB@DA@@BA@ABAB@@ABC@@@AB@@@@BD@BBB@B@BBB@BAFAJGOGEBICIEOEQGACGCMCCACAKGECEECECIAAAEACAI@AFU@IF[GYCKEQGMAAACCOACEMGOEcA@eEW@Q@SFIDUNAB[\\CBKLQR@@CHCLCHAF@DG@@@BGBI@E@CMFEFEJEJABCBE@MBUBED@D@NBHDNBL@F@B[AOASA@FB@D@@BB@@@@@B@BB@BBB@@BBB@BBBBB@BB@B@B@@BBB@BB@BB@@B@@A@@B@@A@@@@B@@BB@B@B@B@BABBB@BBDBB@B@@@B@B@@@BB@B@B@@@BB@BB@B@@@FABAB@DB@@@@@@@BCB@BBB@B@D@@BBB@DBBBBBB@BA@@DBDBB@D@B@@@B@@@BAB@@BB@@D@@AB@@@@B@@@@@@@@@BBA@A@AB@B@@@BA@BB@@@@@BABA@A@@@A@A@ABA@AB@@AB@@E@CAEAE@A@A@AB@@@BA@AB@@A@A@@@@BA@A@C@@@ABAB@BABBBAB@@@B@@ABBBB@BBBBHFDBDBBB@B@@DBB@@@@BBB@BFBB@BB@@@B@B@@BBBB@B@@AB@@@BA@ABAB@@A@A@AA@@C@EAA@A@AAA@A@A@A@IAG@@AAB@@A@AB@BEDA@@@AB@@A@@B@B@BA@AB@@@BA@@@CBA@EBCA@@A@ABAB@@@@@BAB@BABA@A@AAAEAE@A@@@@A@@AA@@A@AA@@@@@@@AD@@@@A@ABA@@ACB@@AA@@@@@A@@@A@A@A@@ABEDA@ABAB@@@BB@@BB@B@@B@@B@@BB@@B@@@@F@@@@B@@@BB@@@@B@@A@@B@@ABAB@@BBBB@B@BBDBDBBBBBB@@@D@@B@BBBBB@BB@@@@@@@B@@@@@D@@@B@B@BAB@B@@AB@@A@BBBB@@@BB@@B@@ABA@AB@BC@A@@@@BA@@D@B@@@@@B@D@@@BB@@BB@@BA@A@AD@@@@A@A@A@@@B@@@AAABA@A@@@@@@@A@@BA@@@@@AB@B@B@@AB@B@@@B@B@@AB@BBF@@A@@@@@BBA@@BA@ABAB@@@B@BAB@@AD@@@B@@A@@@A@A@@AA@@@@@AB@BEB@@C@CB@@@@A@@B@B@@AB@BA@A@@AA@@AA@@B@@AB@BA@ABA@@DAB@BBBA@@@@BA@A@@@ABCDABA@@B@AAAAA@A@@A@A@ABA@G@@@ABA@CB@@AAA@A@A@@AA@@AA@A@AAA@A@A@AA@BA@@B@@@@@BA@@@@B@@@B@@@B@@@@@@AB@B@@@F@D@@AB@@A@@@A@@BAB@B@B@BAB@BAB@FB@B@D@B@@@B@@@BBA@AA@@ABA@@@BB@@A@A@BB@@ABA@ABA@@BABABA@@BA@@@@@@@@@BB@@@@@@@@@BA@@@AB@@@@@@@B@@C@@@CDAD@@@@AA@@@@AB@@A@@@ABC@@@A@AA@@@@A@@@@B@@@@@B@@A@@BA@@B@@A@A@A@C@A@A@@@@BAB@B@BA@@@AB@@@@@B@BBBBB@@BB@@@@@@A@C@A@@@AB@DAB@B@@@@BBB@DB@@@@@@ABADABAB@@@@@B@@B@BBBB@B@B@@A@CBC@A@AA@@AAAA@@C@ACA@@B@@ABABAD@@@@@@@B@@B@BBDB@@@@CBAB@@A@A@@AA@A@C@@@AA@@@@AACC@@A@A@AA@@AAAA@@AAA@@@@@A@AA@AAB@A@AA@@@A@AAA@CB@AA@@@@A@@@AADADAD@BABA@ABABCB@B@@@@@@B@@@@BBB@@BB@BB@@@@@@@BBBB@@@@@B@B@B@@@DDBBBB@B@@@D@B@B@BBDBD@@@@@@BABA@@@@BA@@B@@@B@BBBB@@@@@@ADA@@@@@@BA@@@DBD@BB@DFB@DBD@BB@AB@B@B@@@B@DBB@B@D@B@B@B@B@B@DD@@@@D@B@F@@@B@@B@@@B@@ABABAB@@ABA@ABA@@B@@@BB@@@B@DBB@D@@@@B@B@@ADAB@@@B@BBB@DB@@BBBBBDD@@@B@@A@AB@@A@@@AB@@@@@B@BA@A@@@A@@@A@@A@@AA@@AA@@A@A@CECAA@A@@@AB@@ABAD@@@@A@@@AAA@@ACAC@A@GAABAA@@C@@@A@AB@@@@@D@@AB@@@BBB@@@D@B@B@@@@@BA@@BA@@@@@@BAB@BA@@@@B@B@B@B@@BBBD@@@B@BAB@@A@A@@B@@@@@B@B@@@BB@DB@B@@@@A@EBCBA@@@@@@@A@EBA@@@A@AA@@A@AAA@AA@@@@ADA@@BABA@@BA@@@BD@BA@@B@@C@A@@@@BAB@BA@@D@@@@@B@BBD@D@B@D@@AB@@@BBB@B@@FFDDBB@@@BAD@@@BA@@@BB@@@@@BAB@@CDAB@@BB@B@@BB@@@@BB@@@@BB@@BA@@B@@A@@@@B@@@@@B@@BB@@BBA@B@@@@@@B@@@BA@@BA@@BA@@@@D@@@@B@@@@B@B@@@B@BB@@B@@@@@@B@@B@BB@@@BB@@@B@@@@@BB@@@@B@@@@@B@@A@@@@B@@B@@BA@B@A@@B@@@BB@AB@@@@@BB@B@@@@DB@B@@DBBB@@HADA@@B@@@BB@@@B@@BD@B@@@B@@A@@@@B@BBD@B@@@@@@D@B@@@BBBB@@@BBBBD@@BAB@B@@B@@B@BB@@D@@@B@BBBB@BA@@B@B@@BB@@B@@@@B@@B@B@@@@@ABA@K@A@A@@@@BB@B@@@@D@B@@BB@@B@BA@@B@@B@@@@@@AB@@@@@@@BB@B@@@@@@@@@@B@@@@B@@@BA@@B@@@B@@B@@@@@B@@BA@@@@B@@BB@@B@@@@@A@@@@@@@ACAA@@@@A@@@@BAB@B@DBB@@@BB@B@BB@@@B@@@B@B@@@BB@@BB@BB@@@FBF@B@BBDB@@BA@@B@BAB@@@BB@@BB@@BBB@BDBB@@@BB@@@@@B@B@@B@@DB@BB@@@B@D@@@B@D@@@B@B@DBFD@A@@B@@@BBBB@@BBB@BBB@B@B@B@@@B@B@@@@@FAB@B@@A@@B@@@@@B@B@DADCB@F@DA@@B@B@@@B@@@B@BA@@B@B@@@B@@@B@@@B@@@@@@A@@BA@@@AB@@@B@@@D@@@B@@@BBBBBBB@FABA@@F@@@BA@@BABBB@@@B@FC@AB@@@@@@@BB@BB@@@BABABBB@@BB@@@BBBA@@@@BAB@BABABA@@@@@AFC@@@@@AA@@A@@@@A@@B@@A@@AA@@@@@@A@A@@AA@@@A@ADCDCBA@@BB@@BB@@B@B@B@B@@A@@BBB@@@BAB@@BB@BBBBBB@@DBBBBB@@@B@BB@@@BBB@B@BBBBD@@BDBDB@@@@ABABEDAD@@B@@BBB@@@B@@ABCDCB@@ABAB@B@@@@BB@BFDBBB@@D@@@D@B@@@DAB@B@@BBBBAB@B@B@FAB@BB@@@@BA@AB@B@BABED@@@@BB@@BB@@@@BBDBBB@@BDBB@B@@AD@B@@@B@@BB@B@B@BAB@B@@@B@B@@ABABA@@B@@@B@@@@@@BB@@BBDDBB@@@@@F@@BBDB@@@@BAB@BA@@B@@DB@B@B@B@@BB@@@B@@@BB@@@@A@@@@BA@AAA@A@@@AB@BED@@@DCD@@A@A@@@@@A@AAAAAC@A@@A@A@@@@@A@A@@@A@@BAA@@A@@@@AA@@@A@@@@@@A@@@@A@A@A@@BCBABA@EA@B@@@BBD@DBBA@@@A@A@AAA@A@C@AB@@@@B@DBBBB@B@DBB@B@BA@@B@@@@@@@@DA@@B@@D@B@@@@@@A@@B@@A@@B@@ABA@A@@@@AA@@@@B@@@BA@@@BB@@@B@AB@@@@AD@BA@@@@B@@A@@DB@@D@B@@@@AB@@BB@BABC@C@@@@@@@BD@@@@@B@@B@@@@@@B@@@B@@DF@B@H@F@BB@BB@@BB@@B@@@AB@@@@BB@@@@@B@B@BFB@BB@@@B@@@@@B@@A@@@@@AB@B@@@@ABCB@@BDBBDBB@BBB@D@B@BFFBDBBB@FBBB@@B@B@@@BAB@DC@@D@B@@BB@@BD@@@@@B@B@@@B@@@B@BABBBABB@@B@B@FAB@B@BB@@@B@@@BBBB@@BB@BAF@F@B@B@D@@@BBB@BBDBDDD@BBBBB@J@BBB@BB@@@@@B@@@B@@@B@@@BB@@@@B@B@@B@B@@@ABAD@@A@@@ABABABB@@B@@@@B@BB@@A@AD@@B@@@B@@B@B@@@@@@@BB@@@B@@@B@@@BB@@BAD@@@@@B@B@@@B@@@@@BBB@@BA@@B@@@B@@BAB@@B@D@BB@@@F@@@B@@BB@F@BBB@B@@@@ABA@@B@@@DA@A@A@@@@B@@@@A@@@@@@B@@@B@B@B@@@BC@@@C@@BAB@BA@@@@B@B@B@@@@@@@B@@BB@B@@@@@B@@@@A@CBA@C@A@A@A@AAA@@BA@@@@@@B@BBBBB@DBB@B@B@@ABA@A@@@A@A@@AA@ACAA@AAAACCAAACA@@A@@@@BAB@B@BAF@@AB@@@@ABC@C@ABA@@@ABA@AB@@@B@B@B@B@BAB@@A@@B@@AB@@@D@D@B@B@B@B@B@@@@ABA@ABAAA@AAA@A@A@A@A@ABADABABAB@B@B@B@D@BAB@B@@@@@BABABABA@A@AAAAACAAAAAA@A@AD@BCDABABA@A@@@AA@A@A@E@A@@BC@C@C@A@A@@AAAA@AA@@AB@@AB@BA@@@AB@@ADA@@@@@AA@@@C@BA@A@@@AB@B@D@BAB@BAB@B@@AHA@A@@@A@AAAAAACAACEAA@@@AA@@CA@@A@@CC@AAB@A@@@@@AAA@AAA@@@A@@B@@AD@B@DA@@BA@@@@AA@@A@C@A@A@@@AAAA@@@@DADA@@@AB@B@@AAA@AA@A@@AA@ABA@@BGF@@@@CAA@A@A@@@A@A@A@A@@BA@@@@B@@@@@@AB@@@@AAA@@@AACCA@@A@@B@@AB@@A@@@@HEDADCBABAFADCDADABAB@BAB@B@B@BABA@A@@@A@@@AA@@A@@@@@C@@@A@A@@G@@A@@@@BABAFCA@A@@A@@@@B@@@B@@@BB@@BA@@BA@@@@@@AAA@EAAAAACAAAAAA@@@CBA@A@C@A@A@A@AAA@@A@@@A@@AA@@AC@A@AAA@@AE@A@@@@A@BA@@AA"],encodeOffsets:[[122628,27861]]}},{type:"Feature",id:"350982",properties:{name:"福鼎市",cp:[120.216977,27.324479],childNum:12},geometry:{type:"MultiPolygon",coordinates:[["@@AA@@AB@@@@@@@B@@B@@@@AB@@@"],["@@B@@@@@@@@AB@@@@A@@@@@@@@A@@@@@A@@@A@@@@@@B@@@@@@A@@B@@@BB@@@B@@A"],["@@@@A@@@A@ABA@@A@@A@A@A@@@@B@@DBB@@@B@@@B@@B@@BBB@@@B@B@@@BAAAB@@@@A@A@@A@@@A@A@"],["@@BB@@D@B@B@DDB@B@AE@ACAA@CAA@A@@@@BAD"],["@@@@A@@@A@A@@@@@A@@@@@A@@@@@AA@@@@A@@@@B@B@@@@AB@@@BB@@@@B@BB@@@B@@@@A@@@@@@B@@@@A@@@@B@@BB@@@@A@@@@@BB@@BB@B@@@BA@@@A@AA@@@@A@@@@AA"],["@@AAA@ABC@A@A@AA@AA@A@A@@@AAA@A@@@A@@@@A@@A@AB@B@@BBABA@@@AB@@@@AB@@@BBBB@B@B@@A@A@@BA@@B@B@@BBBBBB@HAJAB@@A@A"],["@@@AA@A@C@ABA@AACACA@@ABABBBDDB@DDBBBBFBFBD@F@B@BB@@B@BA@@@AAAAAACAA@@@AA@A@A@A@CA@@"],["@@AB@AA@@AAA@@@@@@@@AA@@@@B@@@@@BB@A@@@@B@B@@@A@@AAA@@@@B@@AA@@AABA@A@@@AA@@@@A@@@@@@AA@@AAA@@@@@A@@@AC@A@A@AB@BB@@BA@BB@BB@@B@B@@A@AD@@@BCD@B@@B@B@BBB@@BAB@@BB@@@AB@@@@@B@@B@@BA@@@@BA@@B@B@@@@@@@B@@@B@@@BA@@B@@B@@B@B@@A@@@AB@@@BA@@A@A@@@"],["@@A@CBA@@B@B@@@BD@B@@B@@@B@@A@@@BB@@@BA@@B@@A@@@A@@B@B@@BBB@@@BB@@@@AB@@@@@B@@@BBB@@B@@@B@@@B@@@@@B@@BB@@@B@@@@A@AB@B@B@BBB@@@BA@@AA@@@@@A@@@A@@B@BA@@@AA@A@CAA@A@A@A@@BA@@A@@@@BA@A@@@@D@D@@A@@@AA@@@A@@AA@AB@A@@@@@A@@BAB@@A@AA@@@A@A@@AA@@AA@@@A@@B"],["@@@@A@@@@@AAA@ABABA@CA@@@AC@@A@@@A@AA@A@@@@ABA@A@AA@A@A@A@EBAAC@AB@BA@AAA@@@@BABAAABC@AAA@A@IDEDCDABBDDBD@@AD@B@FBDBDBF@DB@@@@ABBBF@B@F@B@DAHAD@@A@A@AA@A@BA@@D@@@B@@BBBBBB@BA@A@@AA@A@@B@B@BA@A"],["@@IAKBWJOLSREFEJAFBDBNFHHHXLLDRARGPKJKFUDIFANEHCDEBEACEAMDG@C@KEAAOK"],["@@@CAABABA@@BBB@D@D@BADADEB@DEDCHKHGmgGGQMUI@@QGGCAC@E@G@EACIGCCA@A@W@QDYD@@OBA@MBWAE@CB@BA@@FADABA@E@EAKEICCAC@EBEDIFCBC@CACAAEBKDCHGBEAIAECIDEDIDELOBG@CGCY@[@I@C@E@CAAEDADAH@L@DAFA@ABABCBEDUAK@AAAAC@ABABABC@ABC@CAEACAAAAC@[AAAA@KHG@ABA@AB@@@@AB@@@@@B@@@BC@A@@@@@@BB@@@B@B@BB@@AB@B@BA@@BE@A@@@A@ABC@C@@B@@A@A@@@@@@@C@@B@@A@C@CA@@A@@@AAA@@@@@A@A@@@C@AAA@@@@@ABC@CBA@AB@@A@CBAB@@ABCB@BADAB@B@@A@@@A@@BC@A@@@A@@BCBA@A@@B@B@@@BAB@@A@A@@B@@ADAB@B@D@@@BB@D@B@@BB@@B@@@B@BB@B@B@@@@@@BA@@@@@ABAB@BCAC@A@ABA@@BABAB@@@B@B@@AB@@E@EAABAA@BAB@BABA@@B@@@B@BBB@@@@AFB@@@B@@@@@@B@BBB@@@@A@A@@B@BB@@@@B@@@@A@@@@B@@@B@B@D@@@D@@A@BB@BA@A@@@AB@BA@A@@@BB@@@@@@@@AB@@B@B@@BD@B@D@DAB@B@B@B@B@B@@@B@B@B@BB@@@DBB@@@BB@@B@@@B@@@B@B@@@@@@ABA@@AA@@@A@E@AAA@@@@@@AA@@@@@@@AA@@AB@B@@A@@@AB@@A@@@A@@@AA@@ACAAC@A@@B@@@@BBA@@@ABAB@D@@A@@@C@@@@B@@A@A@A@A@A@AB@@A@AB@@@B@@AD@AA@CB@@A@@@@AA@A@@AA@AAA@AAA@@@@A@@@@@@@AA@@@@@A@@@@@BA@@@@BCB@@@@AA@@@AA@A@@@@AA@@A@@@AAA@@@AA@@@@A@@@@@AB@@E@A@@A@@@A@A@@A@@@@@A@@@@@A@A@C@A@EB@@A@@@AA@AA@@A@@@@@CAABA@@BA@@@@@A@@@@@ABA@ABA@@B@BA@A@A@@A@@AA@@BEAC@@@A@A@@@EBE@@@A@@@@@A@A@@@@@@@@@CA@@E@AA@@A@@@A@@@@@AB@@A@A@C@E@AB@@A@@@A@A@@@ABA@@@A@A@A@@B@BA@BB@@@B@@AB@@@B@@A@@@AA@A@@A@@A@@ABCBA@A@CB@@A@A@@@ABA@@@A@A@ABA@@@@AA@@A@@EDA@@BA@A@@@@AAA@AA@@@AA@@@@@@AB@@@B@@A@@AA@@@A@@@A@@AA@@@ABBB@@A@@BA@A@@@@B@@@@@B@@@@@@A@A@@@@@AB@@@A@@@A@@@AA@@@@@A@ABCDA@@@@@@@ABABED@@ABA@ABABA@AB@@ABABA@@@AA@@A@@@A@BB@@@@AB@@@B@B@@A@A@@@AB@@AB@@@@@@A@@@@BC@@@AB@@@@@B@ACD@@A@A@@@A@@@@@AB@@@@A@@@A@@AA@@@@AA@@@@@@@C@@@AA@@CACAC@BA@C@@A@@@A@A@A@A@A@@@AAA@C@AA@@@@AB@@@@@B@B@@@@BB@@@B@@@B@@@BA@@@@@@BAB@@A@@DAB@B@@A@AB@@C@C@AAA@@AA@AA@@A@AA@@@@CB@@A@A@@B@BA@@@A@@@A@CD@BA@@@AA@@@D@B@BAB@@A@@@@B@@BBDF@BBD@BBBBBB@@BBDBB@D@D@DBD@B@@BB@@B@@@DB@@@B@B@@B@B@B@@@@@@B@@@@BBBA@@BB@@B@BDB@HAJ@FJALAD@DAHENCF@DBHAJEDEFBFJENGFA@@@@@@@B@@@@@B@@@@@@CB@AA@@B@@A@@@@B@@@@@@AB@@@@@@@@A@@@@@@@A@AB@@B@@B@@BB@@@B@BDD@@@B@@BB@@@@@B@@@@@BB@@@DABAB@BB@@B@B@@@@A@@BA@@BAB@@@@ADC@@B@HA@@B@@A@C@@@@B@D@DAF@D@B@D@DBDAF@B@B@B@BA@@DB@@B@B@D@D@HBD@@AB@@@BA@ABA@@@A@A@@@AB@@@@@B@@@@AB@@@@A@@BA@@@A@@@A@@BA@A@ABBB@B@@B@@BB@BAB@BABB@@@@BDA@@BAB@@@B@B@BB@@@B@@@DBB@@@@@D@B@@@@A@AB@@@DAB@BB@@BDB@BBBB@FBB@@@@DB@@@BBB@B@BB@@AB@@@B@@@D@B@@ABA@ABE@I@@B@@@@@D@@@BADAF@@AB@BAB@B@B@@AD@@@D@F@B@@A@@BC@@@@B@@C@EBABA@A@AAA@C@ABA@ABCDABA@EBC@@BA@ADABA@@@C@CAEAAAAA@@CBA@@@@@@B@D@D@B@B@@C@@BCBAD@B@B@@@B@@@BCBCBC@CDA@A@A@@B@@@B@@@B@B@B@@CBC@CBC@EBAB@@@@@B@@BBBBB@@@@B@@C@@@AB@@CBA@A@A@ABA@ABEDA@ABCDCD@DAB@B@@A@@B@B@@A@@@@@@B@@@BAB@@BD@B@B@B@B@B@@@BB@BB@@@@@B@B@@CBCBABA@@BCA@BABA@@BB@BB@B@@@B@@@BB@DB@@@B@@@@A@A@C@E@A@A@@@AB@BA@A@@@@A@AAAEBABA@ABE@A@A@A@@@@BAB@@@@@BBF@D@@@@B@@BB@@D@@@@@BA@@@A@A@A@@@@@@B@B@B@@A@@@A@A@A@@@A@CDA@@@AAAB@@@B@@@@BBDDBBB@@BBB@D@@D@DAB@B@B@B@BAB@@AB@B@@@@BB@@B@B@BCH@@@BA@@@AAA@A@AB@B@BB@BB@@ADA@@B@B@@BBHFABC@E@AB@@BBBBD@B@F@DBBB@@BD@DDF@@BBB@FDJFDDDDBD@DABGAGCADBHHDBBLBD@@@D@FBHBDBD@D@D@B@B@@@BB@B@B@D@BB@BBD@DD@@B@@@BAB@B@NCD@BABC@C@G@C@@@A@@@AB@B@DBD@B@@ABADGJIFE@GAEDC@@F@J@FABALCB@H@D@BF@FBDBBF@F@DHBHBBBDDD@@DBD@BBDDBHBB@@@BFBF@BDFDFFD@F@BBDBAF@@@L@D@@DDD@@BD@JFVDHA@@FABEFAJBFH@FBDCJEF@@CHBFFFBBNDTBPBB@B@B@BBD@BAB@@A@A@@D@B@B@B@B@BAB@BAB@@AB@@BB@@@F@FDJFDBBBD@D@F@FBD@@AB@BABCFCBADAHABBBBDAB@@@BDB@D@BBB@@BBDB@@BB@B@B@B@DBBBB@HFFBDDDD@FD@JEDAJEDE@G@I@ECACCCE@E@@DEJIBADEBCDEDGDAH@DB@@DB@@DBBDDDDBD@BBDAB@DADAFCD@D@B@BBBDB@AABA@@@@@@B@@@BA@@B@@@@@B@@@BB@@@@@B@BAB@B@@BB@@@@@B@@@@@@@BB@@@@B@@@B@@@@@F@B@BDBBBDA@@B@BABA@A@CAACAAA@AB@NEB@F@HDFBDBBADCFCHGBEBC@A@CCCAC@ACC@ACA@CBA@A@A@AA@AAAA@A@ABABC@@DAHCDAB@BE@AACAA@@AA@CAABCBCB@BADABA@C@AB@@AB@DAFCDABAF@DAB@BAHKDGBE@@BCBCDCBA@ABAFABAJE@@BAJG@ABE@GBK@@JOFEFCDABAFCDCHEFGB@@EAACEICGDEHI@QKAAEGBEJGLEBADCBA@@@A@ACEACAA@AAACACC@CBA@AB@@A@@@@AAA@CA@@AAA@@@B@@A@@B@@@B@D@D@B@@A@CCACA@A@ABA@@HEBGAACA@A@AH@JA@@BCBCJC@ADABCAAAC@CBCBAHCHC@@BADE"]],encodeOffsets:[[[123588,27577]],[[123588,27575]],[[123365,27629]],[[123398,27612]],[[123387,27618]],[[123283,27604]],[[123306,27747]],[[123606,27653]],[[123613,27622]],[[123214,27577]],[[123255,27564]],[[123328,27818]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("泉州",{type:"FeatureCollection",features:[{type:"Feature",id:"350502",properties:{name:"鲤城区",cp:[118.587097,24.907424],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BAA@DA@@DABABA@ABAD@A@@@@@@@@C@AB@B@D@@A@BAA@@A@AA@@AGBE@A@IA@AA@@A@@F@@@BA@@DC@@KC@A@@BA@@@@@A@@B@@A@@A@@@@E@@@@BA@@@A@@AA@BBA@@@@@A@@B@@A@@@A@@B@@A@@@A@@@@@@AA@@@@@@AAB@@@B@@C@@BC@@B@@BBA@BDABC@CA@@AA@@A@A@@D@B@@@A@@@@B@@@@@@@@@@B@@B@@A@@@@@@@@@@@@@@@B@@B@@B@@@@B@@@@@@@B@@@@B@@B@@@@@@@@@@@@@AB@A@BA@@@@@BB@@@@A@B@@@@@B@B@@@@A@AB@B@@AB@B@@@@@@@B@@@@@@@@@@B@@@@@@@@@@A@@@@B@@B@A@@BA@C@A@@@@BAA@BH@C@@BA@@@@@B@@@B@B@@BB@@BC@A@BB@B@B@@@@@@@BA@B@DB@@@@@@A@@@@BBB@B@@@@@@A@@B@@A@A@A@@@@B@@B@@BC@@BA@ABAB@@CAA@@AAA@@A@@@AA@AAA@A@@@@A@@@@@@BA@@@@@@@AB@A@A@B@@A@@@@AAA@@AD@B@BAB@BCDA@AFADCB@@A@A@@@ABA@AB@@ABA@@@AB@@AB@@@@@BA@@B@A@A@@@A@@@A@@A@@@A@A@A@@BA@@@AB@D@B@@CB@@@@@@@B@@BBB@@@@B@@A@@BAB@B@B@@B@DB@@@@@B@@BB@@FB@@@@@BBB@@@BBD@@@@BBB@@B@@@D@B@@@@AB@@@BAB@D@@@B@BB@@@D@B@DBD@B@BBB@B@@@BBD@BBAD@@@B@@B@@@ABA@@@@BBB@B@@B@BBABB@B@@@@BBBBB@@HCB@@@B@DAB@HAD@B@B@D@FBD@JBB@B@F@@@BADABABABEFGBABA@ABC@C@AACBADAB@@AF@@A@A@@B@BB@@@B@BBB@@B@B@@@DA@@B@AB@BAD@BB@FBB@F@@@BAA@@A@ACA@BA@@A@@A@AB@@BAAAA@@A@@DBDB@@DB@A@BB@@@B@B@@@@@@BB@BB@@BBB@DAB@DA@@DA@@@G@@ACAE@A@@@@@@@A@@BC@@@A@@@@B@@C@@@@@AAC@@AA@@@@@@@@@C@@AA@AA@@A@@@@@@@B@@A@@@ABAA@B@AA@@AA@@@@A@@@@@@@@@@AAB@AA@@B@AC@B@@A@@AA@@@@@@@@@@A@@@@@AA@A@@@@@@@@@@A@@A@B@@@@@@@@A@@@@@@@AA@@@@@ABC@EF@@@@CA@@@@@@ACA@@@A@@@@BA@A@@A@@A@C@A@@@A@@@@@A@A@AAEEAB@@A@@AA@CA@@@A@A@@@@@A@@AAAA"],["@@AAA@C@BFBF@DHAF@@GGA@@@@AA"]],encodeOffsets:[[[121404,25477]],[[121378,25429]]]}},{type:"Feature",id:"350503",properties:{name:"丰泽区",cp:[118.613172,24.891173],childNum:1},geometry:{type:"Polygon",coordinates:["@@@M@CBCJGHKFM@C@KA@CECEEGIE@AGACAICYCQCEN@DCHCJABABABCBABA@E@E@A@GBCBA@C@@@Q@C@CB@@A@EBC@ABC@ABCBE@ABCBADAB@B@@AF@B@B@@ED@DD@BA@@@@B@@B@@@@@@@B@@@@@@A@B@@@@B@@@@@@@@B@B@@B@@@@@B@@@@@@@@B@@BB@@@@ABDA@@@BBA@BB@@@@@@@@@@@B@@B@@BB@@B@ABBBA@@B@@@@A@@@@@@@BB@@BBB@@@D@@@@@@@@BB@@BD@B@@@@@DA@@@@@@B@@AD@@@B@@@@@@@BBFBD@@@H@@CB@@CBA@CBA@AA@@AAA@@A@@@@A@A@@@A@@A@BCA@@CACA@@@BB@BBAB@@BAB@@@@BB@@ADB@B@BB@AB@@E@A@EAA@@ABC@ABAA@@@CB@@A@A@@@AA@A@A@@AAA@@@@B@BE@@BA@CBABBD@B@DAD@BABABEHAFABABCBAB@@E@A@A@IAC@EAC@A@A@@F@@@@@@BBD@@@@@@B@@@@B@@@BB@BB@@@@B@@B@ABB@@BB@@@@@@@B@@BBA@B@@@B@@@@@@@@@B@BC@@@BBBBDBB@A@B@B@@BA@AB@@B@@B@@@@@BB@@@@B@@@BB@@@BBB@B@@@B@@BAB@@BBB@@@BB@@DBABF@@BB@@@@BD@@@BB@@BB@@@BB@@@@B@B@@@@@B@@@B@B@@@BA@@@AF@@ABAB@@@@@B@B@@@B@@@@BBB@BAB@@B@BBB@@@@B@B@BBB@@AB@DA@@@BB@@AB@@@B@@BB@@@DBB@B@B@B@B@BB@@@@@@@B@BA@BBBBB@@B@@DEBA@A@@@@@A@@A@@@@AB@@A@C@A@@@A@@B@@@@@BA@AAAAAAA@@A@A@A@BAB@B@B@@@BABAB@@@B@B@@AB@B@@AAA@@B@@@@CBA@ADC@@BB@@B@@@B@@@BB@@BBB@BB@@@@BEB@@A@A@C@@@AB@@@B@@@DB@BBBBBB@@C@@DCB@@@@A@ACE@@B@F@D@H@@@BA@BB@@@BB@@D@@A@@B@BABABA@@B@@@B@B@@@@BB@@@B@BA@@@@@@@A@@BCB@@A@A@C@@@@@@B@@@@@BA@BB@@@@@@BA@@B@@BBBAB@@@@@A@@@@@@AAA@@@@B@BAB@@@@A@AB@@B@@@AB@@@@@B@BA@@B@BC@@BA@@CABCB@@@DBFB@A@@@CBC@CAA@@@A@A@A@A@ADE@BBBJC@@F@@B@@@@@B@@F@CFCBC@@@@B@B@BBBBA@B@@@@@ABAAADAB@@@DBB@@@D@B@@@B@BA@ABCBEBC@A@ADKEICE@ACM"],encodeOffsets:[[121532,25500]]}},{type:"Feature",id:"350505",properties:{name:"泉港区",cp:[118.916309,25.119815],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@A@@@@@@A@@@@@@B@@@@@@B@@@@@@@@@@@@@"],["@@@@@A@@@AA@AB@@@B@@@@@@B@@@B@@@"],["@@@@@@@@@A@@A@@@@@@@A@@@@@A@@@@@@B@@@@@BB@@@B@@@@@B@@@@@@A"],["@@@B@@@BB@@@@@B@@A@@@A@@@@@A@@@A@@@@@@@@@A@@A@@@@BA@@B@B"],["@@@@@A@@B@@@@A@@AAAA@AAB@@AAAC@ACA@@A@@AAA@C@AA@A@@B@B@BAB@B@BBBBBB@@@@AB@@@BB@B@B@BBB@DBBDBB@D@@@@@@AA@@A@@"],["@@CAICQGAAC@C@C@ABABC@OAE@A@@AACA@AAC@YE@@I@C@EAKC@@EBA@A@A@AAA@@AAA@A@@AAA@A@AAC@C@CBAAA@@@@@@AA@@AA@@@A@@@A@C@@@CBCB@AC@AA@BCF@B@@@BBB@@B@@@B@BB@@@B@@B@@A@@BB@BDBBB@B@@@@@B@@@@@@A@@B@BA@@B@BA@@B@@@@@B@@@@AB@@ABCBBB@BB@@@AB@@@@AA@@@BA@AA@@CDCACAA@C@@@@@@@C@@BA@BBA@@B@BA@@B@DAB@B@D@B@BA@@@@@C@@AAA@@@ABA@A@AA@@A@@C@A@A@ACAA@@B@BA@@@AB@AA@A@AAA@C@@@A@A@@@@@@AB@B@@A@E@@CCA@B@@E@@@A@AB@@@BA@@@@BA@A@C@A@A@@AABAA@B@@@B@B@B@@@@C@AA@@@@BA@A@@@@AA@@@@@B@@A@@BAA@B@@@BA@@B@@@@AAA@@A@B@@AA@@BA@@@AA@@@A@C@@@A@@A@@BC@AB@@A@@A@C@@@@BA@A@@@B@@A@AAA@@@@C@EB@@ABCD@@@@BBB@@@@@@BA@@B@@@B@@@B@@@@@DBBBB@@BBB@@@ABA@@BA@@@A@CA@@A@@ACA@@CBA@@@@B@@A@AB@@@BB@@B@@@@BDB@@BBB@@@B@@A@@BA@@@AB@@A@ABA@@BA@@BA@@@ABA@A@A@@@A@@@A@A@A@AB@BC@@B@@A@AA@@A@E@A@@BA@@@AD@@@@@@@BABA@@@C@IB@@AB@BA@@@CCA@@@C@@BA@@AA@@@A@@BA@@AA@ABCAAAC@AB@@A@A@@@@@A@@@@@A@@@A@@@@@@B@@ABA@@@@BBBAB@@C@@@A@@@AB@@@B@@BD@D@@BB@@@B@@@BAA@B@@@@B@@@@@@BAB@@@B@@@@A@@@AA@@@@A@@@@A@@A@@@@BA@@@@@@B@@@@A@BB@@@@AB@@@B@@@B@@BB@@@@@B@B@@@@AB@BAB@@@@B@@@@B@@@@@@B@B@@@B@@@@B@@@@@B@@@@B@@D@@@B@B@@@@@@@@DB@@BB@@BBBB@@B@B@@BB@B@D@BB@@DABABB@@B@@@@BBB@B@B@@@BB@B@B@@@@BB@@@@@@B@@@@B@@@@B@@B@@@B@@B@B@B@@BBBB@@D@BD@@@@ABBB@B@@B@@@BA@@BADA@B@@B@@@@B@@@@B@@@@BB@@@DD@@B@@@D@B@@@BBB@@@@@B@@@@@DBBB@@@DFB@@BBB@BABBB@@AB@@A@@B@D@D@B@@B@@B@B@B@B@B@@@@BAB@@@D@B@B@B@@ABAB@B@D@@@BBA@@@@@BBB@@@@BBDBBBBB@@B@BD@@A@@@@B@@D@D@@@@BB@BBB@BBFD@@B@B@D@HB@@@@A@@CA@@A@@@@@AB@@AD@B@DDB@B@D@DAB@B@B@BBB@DBBADB@A@@B@BAD@BBBBDBB@FAB@@@BBB@BBBBBBBBA@@BAB@@@@@@@BBAB@B@@@D@D@@@@@@@@@@B@@B@@A@@BA@@@AB@BAB@@@AAAEA@@@B@@@BA@@@A@@B@D@DHBD@@@@B@@@D@@@@@B@A@B@@A@B@A@@@B@@@@@@B@@@@@@@@@@@@@B@@@B@A@@@B@@@@@@@@@@@B@B@ABB@@@@@B@@@@B@@@@@@@@@A@BBAA@BA@B@@@@@@@A@BBA@B@A@@B@AB@@BB@@@@@B@@@BB@@@@B@@@@@@@@@B@@@@B@@B@@@@@B@@@@@BB@@BA@@B@@@B@@@@A@@@@@@@@B@@@@@@@B@@@B@@@@B@@@@@B@@@B@@@@B@@B@@@B@@@@@@BD@@B@@@@@@@@@@@@@B@@HBD@@@DC@@@@BAB@@@D@@@DBH@J@JADAFDDBB@DA@ADAD@B@BCBC@A@@@ADAH@FABADCDCFA@@F@B@DABA@C@AACCAACBADEBAB@DCDCF@FBF@F@HBFBB@D@DA@ABEBGBCFABAHAB@D@@A@A@C@C@W@A@I@GB@@A@A@@CAA@CCGEGCMIAAC@A@GAE@C@A@AA@C@C@IBI@I@EBA@@@ABAD@D@BBD@D@BABCBADAD@@@D@DAB@@ABA@CAAAAKEICIAAACAAC"]],encodeOffsets:[[[121838,25757]],[[121820,25703]],[[121804,25819]],[[121837,25804]],[[121861,25790]],[[121810,25709]]]}},{type:"Feature",id:"350504",properties:{name:"洛江区",cp:[118.671193,24.939796],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@B@DADAD@H@F@HMDGBGDMAG@AEECGEE@@CL@B@BADAFAD@BABA@@@A@C@@@A@CA@@A@CBBBAB@B@@@@@AABAA@A@A@A@@D@DADEE@@@@A@@@@@AE@@@IDAA@ACF@B@B@B@B@B@@BB@DAD@D@@@BEACA@@A@ADDB@@AB@@ADA@@@ABA@@@@@A@@B@@@AA@@B@B@@A@ABA@@@@@BB@B@@@@B@@@@@A@ABAA@@@AB@@A@@@@A@@AAB@@@@A@@@@@@@@D@B@BA@AD@@@B@@@@@@ABA@@@A@@A@@A@A@@@A@@@ABABABA@@@@BC@@@AA@@A@@AAB@@G@C@E@A@@@DF@B@B@@A@CD@@@DA@AAAA@ACA@@A@@@A@@B@@@D@B@BA@AF@@@@AAA@AA@@AA@@A@@@A@@@AA@@CD@BAB@D@@A@@@BB@BA@A@@BA@A@@@A@ABAB@@A@A@A@ABB@B@B@@@BBBBBB@BAB@@@@A@@@@B@@@B@D@BA@@B@@B@@@@B@@@@@BABCF@B@@AD@@@@DBBB@B@B@B@BB@@B@B@@AB@B@@@DBB@@@B@@@B@B@@@B@@B@@B@@@@ABABA@@BAB@@@@@@@B@B@B@B@@@D@@A@@B@@@@A@@@AB@@AB@B@BA@AB@@@@AB@@@@ABAB@B@B@B@@@BB@@B@@ABABB@DDD@B@@@BB@@@BA@C@ABA@@@@B@@@@@BA@@BABA@A@@@@BAB@D@@A@@@A@CAAA@@A@ABAB@@A@@@@@@@AB@@A@A@BD@BA@@B@@@@@@@B@@@@@@@B@@@BABB@@@@@@B@B@B@@A@CA@@@B@B@@BBB@B@@@BA@@B@@BB@@@B@D@BB@@@B@B@@DH@@MLA@CACBCFAB@BBBFFA@@B@@C@A@@@@D@@@B@B@D@D@@@@@D@@@@@B@BA@AB@@AB@BA@@BB@AB@@@BA@@@@B@@@BDB@@@BB@@@@@B@B@@BDA@BB@@@@BB@@B@B@BBD@B@@BB@@BBBBBB@@B@BB@@@@@B@@@BB@BBB@D@B@@B@@DD@@@B@@@B@@@B@@ABAB@B@@@B@B@@AB@B@@@B@BB@@@BDB@@B@B@B@@BD@B@B@B@@@B@B@BB@@@@D@@BFB@@B@@ABA@A@@BB@@@@@@@B@@BB@BA@@B@@BB@AFBB@BB@@@@@B@@BAB@@@B@BAB@@@@AB@@@B@@CD@DAD@BA@@BABAB@@@BB@B@@B@@BB@@@B@BA@@@@BA@@BABAB@B@@@@B@@@@B@@A@@@@@A@@BB@@B@@BBAD@@@@@B@@@BA@@B@@A@AB@@AB@B@@@@@BB@@B@AD@D@@@B@D@BB@@@B@@@B@@@FBB@DBBB@@B@@@B@DB@@B@B@@BB@@@BA@@BBB@@@D@B@B@D@@@@@@@@@@AB@@@B@@B@@B@@A@@@AA@BA@@@@BA@@@@@ABAB@BAB@@@B@@BBA@AB@B@@A@A@A@@@A@@BABA@@@AA@@@@AA@A@@AA@@@@@@@AA@@A@@@@A@@BBB@@@@CBABA@@B@B@@A@@@@@@@@@BBBB@@A@@B@@E@A@@@@@BBBB@@CB@@@@@B@@B@@@@@@BB@@@B@@@@B@BAB@BB@@@ABBB@@B@@@BB@@@B@D@@BBA@@@@@A@@@@B@@@B@@@BA@@B@@@@@@@B@B@@@@@@@@@B@@@@@@@BA@BB@@AB@@@B@@@@BB@@@B@@BB@@@B@@B@@BB@@BB@@@@@B@@@BB@@@BAB@@@@BAB@@@@@@@ABB@AB@@@@@@@@ABB@BB@@@@A@@@@B@@@@@@B@A@@B@@@B@@A@@@ADBB@@@@BA@@BA@@@@@@@B@@@B@@@@@B@@@B@@@@B@@@BB@@@AD@BA@@@B@@@B@@AB@@@@BB@@@@@@@B@@A@BB@@B@@@@@@@@A@@@A@@BB@@BA@@@AA@@A@@@A@@@@BBB@BB@@B@@BBAB@@@@@B@@@B@@@@@B@B@@@@@B@@@BBB@@BB@@BB@B@@@B@@BB@@@@AB@@ADA@AB@@@@A@@BA@@BA@AB@@@B@A@@@B@@B@@@B@@@@@BBB@@B@@@BA@A@A@@@@@@B@@@B@@@B@@A@@BBDA@@@A@@@@@A@@B@D@B@B@@@B@@B@@BB@@@AB@@@BA@AB@@A@@B@@B@BB@BB@BBB@B@@@@B@@@@@BBBBBBBB@@@B@@B@@B@AB@@@B@@BBA@@BAB@D@@@@A@@@@DA@@@@@@B@B@B@@@@@@B@@BB@@@@BA@AB@@@@@B@@B@@DBB@B@B@BB@@@@B@@@B@BAB@BAD@B@B@@A@@A@@A@AA@AA@A@@@@D@@ABABA@ABA@ABAB@@@@A@@@@EA@AA@@A@@A@@@@@@A@@B@@@BAA@AA@@@A@@@A@@AA@AA@A@AAAAA@A@@@@A@@@@AA@@@@AB@@@@@BA@@@@@A@@@A@@@@@@@@AA@@@@@@@AAB@AA@@@@@@AAA@@A@@@A@@@A@@@AB@@AB@B@@ABC@A@@DCB@@@DAB@@A@@@CB@@AB@@@BB@B@@@DBB@B@@BB@@@B@@@B@@@@B@@BA@B@@@@@BABA@AB@@@@@@BB@@B@@@@BA@@B@@@B@B@B@BA@BBBBBBB@BB@@B@BB@@@BBD@@@B@@@BA@AB@@A@@@AB@BAB@BC@@BA@@B@B@@@B@@@@@@AB@BA@@B@@@@ABABABA@@AB@@CAA@@@AA@@@@AA@@@@A@@@A@@@@@@@DC@@@@@@C@@AA@A@@@@A@CAAAC@@@@AA@@@A@A@@BAB@@@@A@AB@@@@A@@@@BA@@@@B@@@B@@A@@AA@A@@@A@@CACA@AA@@@A@@@@@BABCB@@A@@@@@E@@@@A@A@A@@@A@@@@@@A@ABAB@@@@@AA@A@@@E@@@AA@@@@ABA@A@A@@A@@AB@@@B@@B@@@B@@@BA@@B@@B@@@@@B@@AB@@B@@B@@@B@@@@@BB@@@@@@@C@@@A@@@ABA@B@@@@BB@@@BB@@@B@@@@@@@@AA@@@A@@A@@B@@A@@A@A@@@@A@@@@@@@@@A@@C@@@@@@A@A@AAA@@C@@A@@@AA@@@@@@AA@@A@@AA@@@@ABA@@@A@C@@@A@@@A@@@@@A@@ACA@@@A@@@@@@BA@@@AAA@@@@@@B@B@@@BA@@B@BA@@B@BA@@@A@@AAA@@A@@@A@@A@@A@@@@@A@@@@@A@@@A@@@A@@B@@@@A@@@AAA@A@@BAB@@@@A@@@@@@AAB@@@BA@BB@@@BAB@@AB@@@@AA@@A@@B@B@B@@@D@@@BAFA@@@@@A@@@@B@@@B@@@@A@@@@@AAAAA@@@A@@BA@@BA@@@A@A@@@@AA@@@AAABA@@@@BB@@B@BAA@@@AAA@@A@@BA@@@@A@A@@@@@@A@@B@B@@@A@@A@@B@@@@@BB@@@B@@@@@BBBB@@@B@B@B@BBB@B@D@B@B@@@BA@ABA@@@@@A@A@@@@AA@@@A@@@A@@BA@@@@AAB@@@@@@A@@@@B@@A@@B@@@@B@@B@@@@@BB@@B@@@@@@A@@BA@A@@@@A@@@@@@ABB@A@@@A@@AA@@@CAC@@@A@@BA@@B@@@D@@@BAAA@A@@B@BA@@@A@@@@B@@@B@@@@@B@@@@@B@B@@@BA@A@A@@AA@@C@@@@CA@@A@@@@@A@@A@@A@@C@@@A@@@A@A@@@@AB@@A@@@@@@A@@@AAC@@@@AAACA@@AAA@AA@@A@A@@A@@A@A@A@@@ABA@@BA@@@@B@@@B@BAB@@@@@BA@AAABA@A@A@A@A@A@ABC@@@@A@A@@@A@A@@@A@@BABB@@B@@@B@@@@@@@A@AA@A@@A@@@@@AC@A@@@C@@AC@AAEAA@A@@A@A@@@A@@@BA@ABA@ABA@A@@@AB@@A@@AA@@@@AA@@@@@AA@@@A@@@@AA@@A@@@@BA@@@@ACAA@A@@@A@@A@@A@@AA@@@@@ADBD@@@BA@@BA@A@A@@@AB@@@@AB@@A@@@@@@@A@@@AB@@@BA@A@@@@AAA@@AA@@@@@A@@A@A@@A@@A@A@@AA@@ECAC@@C@A@@@AA@@@AA@@@BA@@@A@A@@@@AA@@AB@@@@A@@@A@@@@@A@@AA@@@@@C@AA@A@@@A@@AA@AA@@@AA@C@@@@DA@AB@B@@ABA@@@A@AAAAA@@A@@A@AA@@AA@@A@@A@@@AAAAC@@A@@AAA@@AA@A@@@@A@AA@@@@@A@A@@@@@@A@@@@@AA@@@A@AA@@C@@AB@BA@@@AB@@@@@B@@@@A@A@A@A@@@A@@BABA@AB@@@CAC@@A@@A@C@ABAA@@@A@@@@@AA@@A@@A@@A@@BA@A@@@ACBAC@@B@@ADABAB@@A@AB@B@@@@A@AB@BADA@AB@@AA@@@@@BC@@DABA@AB@@@BA@@BAB@@@@@DC@@BABA@AB@BABA@AB@@ABA@A@@BA@ADABA@@BA@ABCBG@@BGBCBAB@@AB@BA"],encodeOffsets:[[121525,25555]]}},{type:"Feature",id:"350521",properties:{name:"惠安县",cp:[118.796607,25.030801],childNum:27},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@@@@@@@@@@B@@@AB@@@@@@@A@"],["@@@@@@@@@A@@@@@@A@@@@B@@@@@@B@@@"],["@@@BA@@@B@@@@@B@@@@@@@AA@@"],["@@@@@@@A@@@@@@@@@@AB@@@@@@@B@@@@BA@@"],["@@@BA@@@@@@B@@@@@@B@BA@@@@A@@@@A"],["@@@A@@@@A@@@A@B@@B@@@@@@@B@@@@@@B@@A@@"],["@@@@B@@@B@@@@A@@A@@@A@@@@B"],["@@@@@@AB@@@B@@B@@@@@@A@@@@B@@B@@@@@@BA@@A@@A@@@@@@@@A@"],["@@B@@@@A@@@@AA@@@@A@AB@@@@@@@@@B@@D@"],["@@@BB@@@B@@ABAA@@@@AA@AB@B@@"],["@@B@@A@@@@@AA@@@A@@@A@@@@B@@@@BB@@B@@@"],["@@BA@@AA@@A@@@A@@@AB@@B@@B@@B@@@B@@@@@"],["@@DAB@BA@AA@@@A@CD@@@B"],["@@ABA@AB@BB@B@@@BAB@@A@@@AA@@@"],["@@BA@@B@AACAC@@BBB@BD@"],["@@@@AAAACBABBBD@B@BA"],["@@@@A@@B@@@BB@@@B@@@@@@@@@@@BA@@B@@@@@@A@A@@@@@@A@@AAB@@@@@BA@"],["@@@B@@@BD@DA@@@A@A@@A@A@A@@@AB"],["@@BBD@B@B@@AB@AA@AC@C@A@@@@B@@@B"],["@@@@@@B@@B@@B@B@@@@B@@@@BB@@@@@@@@B@@@B@@@@@@A@@AAAA@@@@@A@@A@@@@@@@@@A@@A@@@@A@@@A@@B@@@@A@@@@@@@@BB@"],["@@@@AB@BB@@@B@D@@@@@@A@@@@@@BB@B@@B@@@B@@AAC@@@@@A@@AA@BA@@@AB@A@@CB"],["@@@@@@B@BE@AAC@AAAAA@@AB@B@B@FB@BF"],["@@@@DA@@D@BBB@B@@A@@AC@A@@E@E@A@AB@B@B@@BBB@"],["@@@A@@DA@@@A@@@@AAEBC@AAEAA@@@AB@@@B@@@BFBBB@@BBBBD@B@B@@A@A"],["@@A@AB@B@B@B@B@BB@BB@BBBD@BB@@BAB@@A@C@A@@AAEE@AA@A@"],["@@@B@D@@AB@B@@@B@BA@AB@BBB@BB@@@@A@@B@@@D@B@BAB@@AAA@A@@@A@AB@BAB@B@@@B@@@@A@@AAAAAA@@AAA@EB@@AB"],["@@@A@MCGCCCAGBABCD@HAL@DADCB@BA@C@SBMBKBGDCDAB@D@DDJ@@BDBH@DCBCBC@A@@A@L@DENGLIHAD@D@NDN@BDFFJ@@FFDHFF@BBHCNAHCHGNE@G@C@CBCBA@@@@@ABA@@BA@ABADAH@@AHAD@BAB@@ABCB@BAB@@@BAB@BA@@BABABA@@BABAB@@CD@@@@A@AB@@AB@@A@@BABCB@@AD@@@@B@@BA@@BCBABA@@B@B@@A@A@@B@BA@ABCB@BA@@@BDDA@B@@@BAB@@@BB@@@@BB@@B@@@@@B@@BBBAD@B@@@@BD@DB@@A@@BABAB@@@B@@@B@B@B@B@@A@@@@@A@@B@@ABA@@BD@@@BBB@@@B@@B@@@@@B@@@@B@B@@@@@B@@B@B@@B@B@@BB@BB@@@BD@BBBB@@B@@@@BB@@BB@@B@BB@@@BBBB@B@B@@AB@BA@A@@BCB@@@@@DBB@@B@@BBB@@@B@@@BBBD@@@@@B@@BB@@@@@B@@@B@@@@@BA@@BB@@@@@B@B@@AB@@B@@B@@BB@@B@D@@@BDFD@@BB@@@B@BB@@@@B@BB@@@@@B@@BB@BB@@@@@BAB@@A@@B@@@B@@@@@@@BA@@B@@A@@B@@@B@BAB@@AB@@C@CA@B@@@@BB@@@BB@@@@B@@@BBBBD@@@@AB@@@@@BB@@B@@B@@@B@@B@@@@BB@@@@BB@@@BA@@B@@@BAB@BAB@BAB@@B@@@B@B@@@@BBBBF@BBD@@@D@@@BBD@@@@B@@@@BBBB@@@@@@@A@@@A@@@AAAB@@@B@@@B@B@@@B@B@@D@BAB@B@B@B@B@B@BABBB@@A@@@@BA@A@A@@@A@@B@@AB@BA@@B@B@B@@@@BB@B@@@BBB@BB@@DBBB@B@@D@BB@@B@@@@@@@@BA@@B@@B@B@@@B@@@D@@@@BB@@@@B@@@@@BB@@D@@D@@@BB@@@B@BD@BBDBBAB@@BB@@AB@@@B@@BB@@AD@@@B@DD@@B@@ABA@@JAD@@@B@BA@A@@@@@@BC@@B@@AB@F@B@@@BBB@@@@AD@@ABAB@B@B@@@B@@@B@B@B@BA@@B@@AB@@AB@BAB@@@BA@@B@@AB@@@@A@@AA@AA@AC@@@@@AA@@A@@BAB@@@@A@@B@DA@@DB@BB@@@DBB@@@B@@AB@BA@@A@AA@@AAAA@C@@@@@A@@@A@@@AB@@A@@@@A@AA@@@@DCBA@@FAD@@@@@BB@B@BA@@@B@B@@A@@D@B@@@@BA@@BAD@@@BB@@@D@B@@@B@@B@@AB@@BB@@@A@BB@BB@@@@@AB@@A@@@ABB@AB@@@@A@@@@BB@@@@@BAB@@@@BBD@@@@@@A@A@A@@@ABBBA@BB@B@D@B@B@@A@@B@@A@@BAB@@@F@@@@ADB@DF@B@@@@ABA@@@@@@@B@B@@@DBB@B@BBBA@@B@@ABA@@@BBBDB@B@D@@@@BB@@B@BAB@B@@BB@BD@@@@@B@@A@A@C@ABA@C@AB@@A@AB@AAB@@AD@@@@@@@D@B@DBDBDC@@BBB@@A@@BB@@@@BA@@A@@AAADABA@@BA@@@@@A@@@@@AB@@A@AB@@A@AB@@@@@@@@A@@@@@AAACA@AAA@@@BA@@@@A@@AAA@@@A@@@AA@A@@@ADE@ABBD@@BDADA@@D@B@@@B@@@B@@BB@@B@@@@B@BBDAD@D@BBB@B@BB@@@BBB@BB@BBB@B@B@FA@@_MFABA@ABEBCBCFIFE@AJGJ@TBJALEHMAYHK@@BAJEDAFAB@HAF@DBFDHBHAFCJEHAL@HBHDDDDFBH@BDLBFDBD@HADBDBFDFFBBPHB@B@B@B@BAB@BABCBAB@B@D@B@BA@A@A@AAAAAEIEGCCAAAAA@A@AA@@AC@CBA@A@C@@@AAAAAAAAG@A@C@AB@BABABABA@AA@@AA@A@A@C@A@A@@A@A@@@AB@@@@@BABA@@@A@@CAC@CAC@C@@@AB@BA@AB@@@@ABA@AAA@@@AAAA@CACAA@E@E@@@CBA@AJSBA@@DABADABABAB@B@BBD@BBB@@@BABABC@E@G@AB@B@DBFDFDDBB@B@@ABAB@B@D@D@F@DA@@@@@@AA@AB@@A@AKGMKAAIIEAE@A@QASGKCABAB@BFBABC@CBABCBIDA@OFaNA@E@GAGEA@EGCCGIKMAE@A@CBCBEBAB@D@FADABC@EBGBCDAB@F@DBDBBAD@BCDABC@ADCDCDAD@D@FBDBDBJD@@F@H@H@HCFCBABC@C@EBADCBCBA@AACAAEAQEYCGAAAA@AAE@@@@@A@@BB@BB@@@B@@A@A@AAAAC@ABADCBGAC@A@EC@@CCA@CAA@C@CBEBGBA@A@C@IBMDIDCBCDABADIBC@MBGDC@G@E@CAA@C@CACA@@EEAAA@A@CEIEA@A@E@CBA@A@AA@AAC@ABAAA@@AAAA@@BA@@B@B@D@DAD@BA@@BAAA@@AAA@@A@AACAACCC@CA@@A@@BA@@BABABADKJIHGDE@C@E@@@CAECCCCCCCAAMGCA@@@@CAcIC@ICSECCAAAC@G"]],encodeOffsets:[[[121890,25561]],[[121820,25470]],[[121885,25580]],[[121818,25469]],[[121650,25451]],[[121897,25672]],[[121870,25623]],[[121642,25448]],[[121685,25431]],[[121871,25658]],[[121692,25439]],[[121684,25454]],[[121739,25478]],[[121876,25629]],[[121843,25475]],[[121837,25534]],[[121815,25501]],[[121880,25681]],[[121623,25425]],[[121892,25563]],[[121800,25650]],[[121798,25647]],[[121740,25685]],[[121627,25430]],[[121899,25675]],[[121885,25631]],[[121598,25433]]]}},{type:"Feature",id:"350527",properties:{name:"金门县",cp:[118.323221,24.436417],childNum:40},geometry:{type:"MultiPolygon",coordinates:[["@@B@@@DB@A@@@@@A@@@@C@@@AB"],["@@@@B@BBBAB@AAA@A@A@@@@B"],["@@@@@BB@BA@@@@AAA@@@@@@B@@"],["@@@@@B@@BA@@@A@@@@A@@@@B"],["@@@B@@B@B@@A@AA@A@@B"],["@@@@@BB@@@@A@@@AA@@B@@"],["@@@BB@@@@@BA@@AA@@A@@@@@@B"],["@@@BB@@B@@@@BA@@@@@AAA@@@@@@A@@@@B"],["@@@@@@BB@@@@B@@A@@@AA@@@@@A@@B"],["@@BBBBD@@@@AAAAAAAA@@B@@@B"],["@@D@B@B@@@AAA@C@@B@@@@@@"],["@@@BDBDBB@@@@A@AAAC@AAAB@@"],["@@B@@@@@@@B@@@@@@@@@@A@@@@@@A@@@@@A@@B"],["@@@@B@@B@@B@@A@@@@@A@@A@@@@@A@@B"],["@@ABCBCBCBE@CBCBAD@B@BDBD@BBBD@DBBADAF@D@BDDBBD@B@D@B@B@@DDVDJDBDBH@F@D@BBBDFBDBDAB@DCJGJEFAB@DA@CAA@EAEAAACBIAECEAAC@E@ABAD@@E@A@AAEC@A@ABA@AACACECC@A@CBABC@EAEICCA@CA"],["@@@@@B@@B@@@@@@A@@@A@@A@@B@@@@"],["@@@@B@@A@AA@A@A@A@@BBBD@"],["@@B@@@@@B@@@@A@@@@@@@AA@@BA@@B"],["@@@B@@B@@@B@@A@@@A@@@@A@A@@@@B"],["@@@@@@B@@@@@B@@@@@@@@@@A@@@@@@A@A@@@@B@@"],["@@@@@@@@BA@@@@@AA@@@@B@B"],["@@@B@@BB@AB@@@@AA@A@@@@@"],["@@@@@BB@@@B@@@B@@A@@@@@A@@@AA@A@AB@@@@@B"],["@@@B@AB@B@B@@@B@@@@A@@@AA@@A@A@ABA@AB@@@B@B@BA@BD@B@@@B@B@@@@AAA@AAAA@CAA@ABA@@BA@@BA@A@A@A@@@@BB@@B@@@B@@@BCBA@@B@B@B@@BB@@B@"],["@@B@@@@A@@@A@@A@A@@@@@@B@@@@BB@@"],["@@@@@A@@AA@@@@A@@@@@@@@@@B@@BB@@B@"],["@@B@@@@@B@@AB@@A@@B@@@B@@@B@@@B@@@B@@@@@@@@A@@@@A@@@@@AA@@@@A@@@@@A@@@AA@@A@@@AB@@@BA@@@@@A@@@@@A@@@@@@@@B@@@@@@@B@@@@BB@@B@@@"],["@@@@@@BB@AB@@@AA@@@@@@A@@@@@@B"],["@@@@B@@@@@@A@@@@@@@@A@@@@@@B@@"],["@@B@BB@@BB@@@@B@B@@@@@@@@A@@@@AAA@A@@AA@@BA@"],["@@@@BB@@@@B@@@@@@@B@@@BA@@@@@@@AA@@@@@@@@@A@@B@@@@@@@@A@@@@@@@@@A@"],["@@@DBJAFABBB@BB@B@BC@ADABAB@@@@B@BHBBB@ABAB@BB@ABAB@B@BB@@BA@@AAAA@@BABABC@ABIFEDAD@J@LAFA@A@AAC@C@EBCDAB@BC@A@EBGDEDCBAB@DBDBDAD@@CAACCCC@CAG@MFMDC@ADADBDABA@E@GCEEAC@@BABAACA@CA@A@AA@ABCB@@ABCDC@ACCCCC@ABA@A@AAAA@CBA@A@AEEECKCC@IECAGAI@C@CB@D@BBBHDF@D@BDADCFEBEBM@KDGDCDCDGBG@Q@K@[CMEIEGEGCCECGSeCECCCCGAG@G@EBMJEDCBC@CBC@C@@@@B@@@BADEDAB@@@B@B@BSLCBAD@@@B@BBBBBD@F@D@DB@DABADAB@@BD@@B@FBb@B@@B@BAD@H@FALI\\CHCDKF@@AB@DAFBF@DBBFFHDFBJDD@BB@B@D@@BB@@D@FAFCDCNGRMLGNEPCHCLAFADAF@HB@@DBDBBBFFBD@BBD@DAFABABEFCB@B@@BB@@DAF@B@D@BFDBHD@BBDAFABA@A@@B@BB@@FAHAB@DABBDD@F@HBHBFDDB"],["@@@BB@B@@A@@@@A@@@A@@@@@@@"],["@@@@@B@@@B@@B@@@@@B@@@BA@@@AA@@@A@A@"],["@@@@AB@@@B@@B@BB@@B@BA@@@A@@@@@@@A@@@@@@@AA@@@@@AB@@@@A@"],["@@@BB@@@@@@@BA@@A@@@@@A@@@@@"],["@@@B@@@@@B@@@@@@B@@@@A@@@A@@A@"],["@@@B@@@@@@B@@@@@@A@@@@@@A@@@@@@@@@@@@@@@"],["@@@@@@@BB@@@@@@@@A@@B@@@@@@@@@@A@@A@@@@@A@@B"],["@@@A@@@@A@@@A@@@@B@@@@@@@B@@@A@@B@@@@B@@B@@A@@@@"]],encodeOffsets:[[[121281,25132]],[[121310,25121]],[[121247,25105]],[[121294,25099]],[[121225,25095]],[[121313,25080]],[[121308,25078]],[[121048,25070]],[[121078,25061]],[[121224,25052]],[[121098,25050]],[[121217,25047]],[[121087,25044]],[[121071,25039]],[[121068,24987]],[[121068,25037]],[[121114,25038]],[[121054,25036]],[[121052,25033]],[[121055,25030]],[[121314,25021]],[[121312,25017]],[[121034,25014]],[[121002,24979]],[[121184,24974]],[[121182,24971]],[[120996,24966]],[[121259,25116]],[[121278,25108]],[[121282,25109]],[[121304,24997]],[[121255,25103]],[[121040,25021]],[[121071,24986]],[[121034,24979]],[[121012,24979]],[[121005,24963]],[[120979,24957]],[[120982,24961]],[[120987,24961]]]}},{type:"Feature",id:"350524",properties:{name:"安溪县",cp:[118.186288,25.055954],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@AA@@@@A@@@@@@A@@AA@@@CAAA@@A@@@AAAA@@A@A@@A@@A@A@@AAAAA@A@@AA@A@@A@@A@@AA@@@@@A@AC@A@A@@@@BA@A@@@A@@@A@@@@@@@@B@@@@@B@@B@@@@@@B@@@@@@@@@B@@@@@BA@@@@B@@@@@B@@@@@B@@B@@BA@BB@@@@BB@@@@@B@BAB@B@BB@@B@@@BBB@B@@@@@B@@AA@B@@A@@@A@@@A@@@@@@@A@A@@@A@A@AB@@@@@@A@@@@A@@AA@@@@@A@@AABC@@AA@A@AA@@AABAAA@@@A@AB@@AB@@AB@@A@ABA@AB@@@BB@AB@B@B@BB@@B@@@@@BC@A@@@CBABA@@@ABA@AB@@@@@B@@@@@@BB@@@@@@A@@BAB@@B@AB@@AD@@@@@D@@@@BB@@B@@@B@@@@@@B@@@B@@AB@BA@@B@B@@@@AB@@AB@@A@A@@@A@@@A@@@@AA@@@@@A@A@@@@AAA@BABAB@@ABAB@@A@A@@@@@A@@@A@A@@BA@@@@@AB@@A@A@AB@@@A@@A@@AAAB@@A@@@@@A@@@@AA@@@@@ABAAA@@B@@@BA@@@@B@AAB@A@@AA@@@A@@@A@@@A@A@@@AA@@A@A@@@@B@@AB@B@@@@A@A@A@@@A@@@@@AB@B@@@BAB@@@@@BC@C@A@@BA@@@@@@AABA@A@@@@@@A@@@@@A@@@@AAA@A@@@A@@A@@@A@A@@B@BABAB@@@B@@@@@B@@AB@@@BB@@@@@B@@B@@A@ABB@AB@BC@@@ADB@B@@B@@@B@BA@AB@AA@@B@AA@@@@A@@@BA@@@@B@@@B@@A@@B@@@B@@@DA@A@@BAB@BA@A@@@A@ADA@@A@ACAA@@@B@@A@@@AAA@@@A@AB@@A@@@AB@@@@A@@@ABA@@@@BA@@B@@A@@@A@@@@@AAA@A@@@A@@@A@@A@@@A@@@@@@A@@AA@A@@@@AA@@AAA@@@@@G@AB@@AB@@ABA@@@@FC@@@@B@@@@@@BB@@A@@AA@@AA@@@@@A@@@@@A@@A@@@@@AAA@A@@@@@A@A@@@A@@@A@@A@@AA@@@@A@@@A@AAAAC@AAAAA@CA@@@@AB@@C@A@AA@@@@@AB@@A@@@@@@C@A@@BAB@@A@@B@@@@@B@@@BA@@@@@@@A@@@@BA@@@A@@@@@@B@@B@AB@B@B@@@@@@@@A@@@@@A@@B@B@B@@ABBB@@A@@@@B@@@BAB@@A@ABCB@B@@AB@@A@ABA@@@@@@@AAA@@A@@@BC@@BA@AA@@AB@A@@A@A@@@@A@@A@A@@AA@A@@@AA@@C@A@@A@@C@@B@BAB@BABAB@B@@@@A@@BA@@BA@@@A@A@@BA@ABADABA@C@@@@B@@AB@@@@ACA@@EA@@AA@@AAC@@AA@AAC@@@@A@@AA@@@@AA@@@AB@@AA@BA@@@@@@@ABA@@@A@C@@B@B@BA@AB@BA@A@@@@BAB@@@@@@@BABABCB@BA@@B@DDB@@@@@B@B@@@BC@@@@@A@@B@AA@@@@@A@ABA@@BCB@BCB@@@B@@AB@@@BA@@@@A@@A@@@@@@@@A@@@@@ABCB@@A@@ACAA@@@A@@A@A@@A@A@@@@@AA@C@A@@@AA@A@@@C@C@A@AA@@A@@AB@@@@@@AA@@ABAB@DCBABA@A@AA@@@@@@AA@@@@A@AB@@A@A@A@A@AC@@@AB@@@@@AA@@@A@AA@A@A@A@A@@@@@A@@@AB@@A@EB@@CBABABABA@@BA@A@@B@@@@A@@@A@EB@@A@AA@@AA@AA@AA@@A@@@A@@AA@A@@@BAAA@@@A@@@AA@B@@AB@@AB@AAB@@AB@@AB@B@@@AA@AB@@@@@@A@@@A@@A@@@@A@@A@@@@@@@@A@@@@A@ABA@@@AB@@AA@@@@@AA@@@BA@@@ABA@C@@@@@A@@@A@@A@ABAA@@@@@@C@@AAA@@@A@@@@A@@@AA@A@@A@@A@@A@@@@@A@@@@@@B@@A@@@@@@@A@@@A@@@A@@@@BA@@@A@A@@@C@@@@B@@@@@B@@@B@@A@AB@B@B@@@B@D@@@@@B@@@@AB@@A@@@@@AB@@@@@BAB@@@@A@@@A@@AA@@@@@@A@A@@@A@@AA@A@@AA@@BA@@A@@@A@@@@AA@@@@AA@AA@@@@@@@AA@@@@AA@@@A@@@AAA@A@@@@@BAB@@@@@@A@@@AA@@@@@@@@A@A@@A@@@@AA@@@@@@B@@ABA@@B@@@@@@@BA@A@@BABA@@@A@AAAD@@@@@B@@A@@B@B@@A@@B@@@@B@@@B@@@@BB@@@@@B@@@@@@@AB@@@B@@@@A@@@@@@BAB@B@@@@@B@BAB@@BB@B@@A@@B@B@BA@@@@B@B@@A@@@A@A@@A@AA@@AA@@@@@AA@@AA@@AB@@@@A@@@AB@BA@A@ABA@@@@@@BA@@@A@@@@B@@@B@@@BCBA@A@CA@B@@@B@@BB@B@@@B@@@@@BAB@@AAAAA@@A@@BAA@@@@@@@C@@@@BA@@@B@@BA@@@@BA@AB@@AAAB@A@@A@@A@@@CAA@@@@AA@@@AA@@AA@@A@@CDAB@@@BAB@B@B@@@@EAC@@@AA@@@AA@AA@@@@AA@@A@@@@AA@A@@@@AA@@A@@@A@A@@@@B@@@@AB@@ABC@@@@@@@A@@ABA@@@AB@A@@@@@@@@A@@@@@@B@AA@@@@@A@@@@@@@@@@@A@@@@@AA@@@A@@@@@A@A@@@A@@A@@@@AA@@AA@A@A@AAA@C@@@A@A@@@C@@@@@@@A@A@@A@@@@@A@@AAAA@A@@AAABA@A@AB@@AACA@@AAA@@@A@@@AAAA@@@A@@@@@@A@@A@@A@@@@@@@AB@@@@A@@B@@A@A@AAA@@@@@A@@B@@@@BBA@@@@@@BA@@@@@BB@@@@AB@@@B@@@B@B@AA@@@A@@@@BA@@@C@A@AA@@AB@@A@A@A@A@AAA@C@AB@@@BAB@@@BA@C@@B@@AB@@@AA@@@A@CAA@@@A@A@AAAB@@@BAB@@A@A@@@@BA@@@@@@B@@A@A@A@@@@@@B@@AB@B@@@@A@@BA@A@@@C@@@A@@@CA@@C@A@@B@@@BAB@B@BB@AB@@@@@@@B@@A@@@@@@BB@@@@@@BA@@B@@@B@@@@@DB@@@@B@@@@A@ABA@CB@@@B@D@@@@AB@BAB@B@@AB@BAB@BB@@@@BBD@@BB@@@@@@@B@@@@@B@@@@BB@BB@@@BBBBB@B@@BB@@@B@@@@B@@@BB@@B@@B@@@@B@BBB@@@BB@B@@@@@@@BB@B@BB@@@@DBB@@@B@@@B@@A@@@@@@B@B@B@@BB@BBBA@@B@BA@AB@AA@C@@ACC@@AAA@A@@@A@ABA@AB@@@B@@ABAB@@@BA@@@@B@BA@@AAAAA@@@BA@AAA@A@A@A@AB@DAB@B@BB@@@BBBB@B@@@B@@A@AD@@AB@B@@@@@BAB@@@B@@@B@@@@A@@@@BBB@@AB@B@BBBB@@BB@@BDBB@@@B@@@@BBBBB@@B@@B@BAB@@B@@B@@BBA@@BA@@BBBB@B@@@@@FBBDBBB@F@@@B@@AD@B@BA@@DBBB@@BB@@B@@@@@@@@@DBDBBBB@B@@@@B@@BBDB@@@@@B@@BBB@BB@BD@DBFD@@D@B@@@@B@@@BABAB@@@B@B@B@BBB@B@B@@@B@B@BBBB@BB@@B@B@B@B@@@B@@@B@@@DBB@B@BBDB@@@@BAB@@@B@@@@@@A@@DAB@FC@@B@B@@ABCB@@A@@FE@AB@@@@B@@DBB@BB@@B@@@@@B@@@BBDB@@@@BB@BBB@BB@@@@@@B@@BD@@A@@@@@@BB@@@@B@@@@@BAB@@@@B@@@@@BB@@B@D@@@BB@@@@DA@AB@B@@@BBB@BBB@@ABADAB@@@@@BB@BAB@B@B@D@@@@BBBBAB@@AF@@B@@@@D@@@@@@BB@@B@@@@BB@BBB@B@BABBDAB@BBBB@@@B@B@@@@@BBB@@B@B@B@B@B@B@@BB@@B@@@B@BB@@B@B@@BB@@@B@@@BBBABAB@@A@@@A@@@@@@DA@@@@B@@ABAA@BA@A@@B@@A@A@A@@@@@A@@@A@@@@@CB@@AB@@BBDB@BB@BBBBBB@D@@@B@B@@@B@@A@AB@@A@@BAB@@@BA@BF@BBB@B@@ABB@@D@@@BAB@D@@ABABA@@B@D@BADAB@@AA@@AAA@A@@@A@A@A@A@A@@@@A@@@ACC@@@A@@@A@@@@BA@@@A@@@@@AA@@@AA@@AACH@BABA@CBA@@@AB@B@@AB@@A@@B@B@@ABA@ABA@ABA@C@A@A@@@B@A@@@A@@@@@AB@ACB@@@@C@@A@@A@A@@@@B@@@D@BAD@@A@CBC@A@@BA@@BCD@B@@@BAB@B@BBDA@@@@@A@@B@B@@@B@D@@BB@B@@@B@@@B@@@B@@@@@B@@@@@B@@@B@@@BAB@D@@BBDDA@@B@B@BABB@@B@@@BA@@@@@AB@@ABE@@@AB@@@@@B@@A@@BA@@BA@@@A@A@@B@@@B@@C@C@CA@@@B@@A@AB@@A@E@A@E@@@A@AA@@A@@@ABA@@BAA@@A@@@@@A@@@@@@@AA@@@@ABAB@@@B@@@BBBAB@D@BA@@@AB@@AAA@@AA@AAA@A@A@ABADCF@BEH@@@BB@@@@@BBBB@BBB@@BB@@AB@@@B@@@DAB@B@@@@A@@@CBA@C@ABCAA@@BA@@BAAA@@@A@A@C@@@ADCBABA@@@A@@@@B@B@B@BAB@@@BA@@@A@@@@@A@@@A@@@@@A@@@A@@@A@AB@@A@@@A@@@A@@@@AA@A@A@@@A@@BA@@BABA@@@A@@@@@A@@BAA@@ADAB@@A@@BA@@B@@@B@@@BBBBBB@@B@@BB@@A@@BA@ABA@A@C@C@A@A@AB@@A@AA@A@A@@A@AA@@@B@@@@@@A@@@@@@@A@@B@@A@@@AB@@AB@@@@@B@@A@@@@B@@A@@@BB@B@B@B@@@BB@BB@B@@BB@@@BCB@@@BB@@@@B@B@B@@@@@D@@BD@D@@BB@@AB@D@@B@B@@BDB@B@BB@@BBBBBD@B@@BB@BD@BD@@BBB@@BDBB@BB@DBBAB@HEDCB@B@BADB@@B@@@D@B@@@B@@A@@@A@@@@@A@@@@@AC@@@@@BAB@B@@@D@@@BABAB@@@@AB@@@B@@@@F@BBB@B@@B@@@B@@@@A@CDAB@@@B@B@@BB@B@B@@@@@@@@DBB@B@@@@@B@@A@@B@@B@@BB@@@@@@@@@B@@@@@@@@B@@BB@BB@@B@@@@B@@BBB@@BB@B@@B@@B@@B@@@@B@@C@@@@B@@AB@@@B@BBBA@@@A@@@@B@@@BB@@@@B@@@B@@@B@@@@CBAB@@@@A@@@@@AB@@AB@@@BAB@BABA@@@A@ABA@@@A@@@ABABA@@@@@A@@@A@@@A@A@@@@BBB@@@B@@@@@@@@@B@@BB@@B@@B@@@B@@@B@BB@@B@@@B@@@B@@@B@@BB@@ABA@@@@BB@BB@@@B@@@@@@@B@@BB@B@@@BAB@BA@A@@BBB@BAB@BA@@BADA@A@@B@@AB@@@BBBBB@@@BB@@@B@@BB@@@@BFBBBB@@@@@B@@@@@BAB@@@@@B@@AB@BB@A@@@@B@BB@@B@B@@@@@B@@@@BB@@B@@@@@@@B@@B@@BB@D@@@B@@@B@@B@B@@@@BBB@@@B@BBB@B@@B@@@B@@@B@@@@B@B@@@B@@@B@@@BBB@@@B@@@@@B@@@@@@BD@@B@@@@BBB@@@@@BBB@B@@B@@@@@BB@@@B@BBDB@@B@@@@BBD@@@BBB@@@BB@BDBB@@AB@BBB@@@@@@@B@B@@@BBB@@@B@B@@BBBB@@B@@A@BB@A@@BBBB@@@@@B@@@B@DB@@B@B@@BB@@BFB@@BBB@B@@@B@B@@@@@BBBB@@@@@@B@B@@BB@@@@B@B@@@BA@@B@@@@A@@BAB@@@@@@ABA@A@@BBB@@@B@@BB@@@@B@DA@BB@@@F@@@@@@@@@@@BA@@@@B@@B@@@@B@@@@@A@@B@@@B@@@B@B@@B@@@@@@B@@@@@@@B@@@@B@@B@@BB@@@@@B@B@B@@BBBB@B@BA@@@AB@@@@@B@@@B@@@@@D@@@@@D@@A@@@A@@@AB@@@B@@@B@@@@@BB@@@@D@@@BB@@BB@@B@B@@A@AB@BA@@@@@@BCB@@A@@B@B@B@@@BA@@@A@@BA@@@@B@@@@B@@BAB@B@@BBD@BB@@@D@@BBBBDBBB@BBD@@@B@@B@@@@B@@@BBD@BBBB@@B@@@BB@@@@@@@B@BAAA@@@AB@@A@@@@@@@@@AB@@@@AA@@@@ABA@@@@@A@@DAB@B@@@BAB@BBB@@@@@BAB@BAB@@@BA@@@@@@A@AA@@@@BAB@@ABA@A@A@@AAAA@@@@@@AA@@@@@AB@@@D@@AD@@@B@@A@@@@@@@@B@B@@A@@@@B@@@@@@A@@B@@@@@B@@@BAB@@@@A@@B@@A@@B@@@BA@B@@@A@@BAB@@B@@@D@B@@@@DD@@BB@B@@@B@BBB@@@@@AB@BAFADABBDA@@B@DA@@@BB@@BB@B@B@B@B@@@B@@@HBB@@B@B@B@@@@@@B@B@B@BB@@BB@@@@@@BB@@@@BB@@B@@@@AB@@@B@@@B@@@@@B@@@@A@@@@BA@@B@BA@@@B@@@@B@@@@@B@@@B@@@@@@A@@@@@A@@BA@@B@@@@BB@@@@@@@@@@BBB@@BB@@B@@@BA@GB@@@@@BB@BBB@B@@BBAB@@@B@@B@BBB@@DB@B@@BB@B@@B@ABBBB@@D@@@BA@@B@@BD@B@@@@BB@BB@B@@AB@@B@B@@@B@@@@AB@@@D@@@BA@@@@BA@@B@@AB@@@@@AA@@@A@A@A@@@@@A@@@AB@@@@@@@B@B@@@@@BA@A@AB@@@@AA@@@@A@@@@@ABB@@DA@@@@AA@@@@BA@@BABAB@B@@@@A@A@@B@B@@AB@@CBA@@@A@@@@@AA@E@@@@A@@@@@@BA@@@@B@B@@A@@@@@@@@B@@A@@A@@@@@@@DA@@@@@@B@@@@B@B@@B@BB@@B@@@B@BAB@@@@@DA@@@@@AB@@A@@@@BA@@@@B@@@BAB@@AB@@@@@@AB@@B@@D@BB@@@@ABA@B@@B@@@B@@@B@B@@@B@@@@B@@B@B@BDB@@@BB@BBBBBBBB@@@@@@B@@@BAB@@@B@@AD@@@@@@@@A@@@@@A@@@@DA@@B@@AB@@A@@BA@@BC@@@@@@@@BBBBB@@@BD@@BB@@@@BA@@B@BAB@@@@AB@@@@@@@B@@@@@B@@@DADCB@@@BA@@B@@@@@@EBA@@BABA@@@@@@@B@BBB@@@@@B@@D@@BB@BB@@BB@@@@BBD@@@FB@@B@D@@@D@@@@@@@@@BA@@@AB@@@D@@@B@@B@@@@@@@@A@A@@@A@@@@B@@@@@@BB@B@@B@B@@AB@@B@@@B@@@@BD@@@@@@HBB@@B@@@B@@@BBBB@BA@AB@@AB@@@BA@A@AB@@@B@BBB@@A@A@@@A@@BAB@BAB@@@@@@@BA@B@@B@@@@AB@@@@@B@@@B@@@@@@@@A@@@A@@@@@A@@@@@@@@@A@@@@A@@A@@@A@@B@@@B@@BB@@@@B@@@@B@@B@@@@@B@@@@B@@@@B@@@@@BB@@B@B@@@B@@@B@BB@@B@@@@B@@BB@@@@B@@BB@@B@@@@@@@BB@@@AB@@B@@@@@@@B@BB@@@AAB@@AB@B@@@B@@@@@@@B@B@@B@@AB@@BB@B@@@BC@A@@B@@@BBBBA@@B@@@@@@@B@@AB@@B@@B@@B@@@@@@@B@@B@@@@@@@B@B@B@BBB@@AB@@@A@@AB@@@@@BAB@@A@@AA@@@A@@@A@@A@AA@@@@@AA@@@@AA@@@@AB@@BBA@@AA@@@@BAB@B@@@@@@@B@@A@AB@B@@A@@D@@@B@BB@@@@BA@@D@@@D@@@BABABB@@BB@@BADA@@DAB@@@B@@@B@B@@@@@BBB@@BB@@@B@@@@BB@@@@@@AA@@A@@@@BA@@BB@@@A@@@@B@F@@@B@B@@B@B@@@@B@@@B@@@@BB@@@@@B@@@B@@@@@@@@@B@@@BB@A@@@@B@@@B@@A@@C@AA@@@AAA@@@@@@@AAA@@@@@@@@B@B@@@BAB@@AB@@A@@@@AA@@A@@@AB@@@@AAA@@@@@@A@ABABC@@@A@@B@@@DA@@@@@CA@@@AAA@@@@AA@@A@CBA@@@A@A@@B@B@@ABA@@@C@EBA@A@@@A@@B@@@@A@@@A@A@A@@@AC@@@@@@A@@B@@@B@B@@@B@@@D@@BDBB@@@@@@BB@@@@@@@@@B@@@B@@@BB@@B@@@BA@@B@@AB@@@@@@ABA@@@@@@@BB@@@@B@B@@@@B@@@@@B@@@BAA@@@@A@@@@BA@@@@@A@@A@@@@@BA@@@A@@BA@@@@@@@A@@A@BA@@B@@@B@B@BBB@BD@@@@@@A@@BA@AB@@@@B@B@B@B@@@BCFABA@@@@B@A@@AAA@@@@@AB@@@@@AA@@@@@@@@AA@@A@A@@AAA@@BC@A@@@@DE@@@@AABA@@@A@@A@A@BA@AB@@A@@B@AA@@A@@AB@@@@@@@AABA@@BA@@@A@@@AB@@A@AB@@@@@BA@@@AB@@@@@@A@@@@@@B@@A@@BA@@BA@@@@@A@@BA@@BA@@B@@A@@BA@@@@@@@AB@@@@@@@@@@AB@@A@@B@@A@@B@@A@@B@@@@@B@@@@@B@B@@@@B@@BA@@B@@@A@@A@@B@@A@@BB@A@@@@@@@A@A@@@@BA@A@@@@@A@AA@@AAA@@A@@A@@@@@@@@@AB@B@@@@C@@D@@@BA@CBB@BB@@@@@B@B@@AB@BABBDA@@@@@@@B@@@@AB@B@@@BB@@@DA@@@@B@@@@B@BB@A@BBA@@@@BB@@BB@@B@BBB@@BAB@B@@@@@@@BA@@@@@A@@@@BA@@@BB@@@B@@A@A@@BA@@D@F@B@@@B@@A@A@A@@@AB@@A@AB@@A@@@C@A@A@AB@@A@@A@@ABAB@@@@AB@@AB@@@@A@@@AAA@@@@@ABA@@@@@@B@@@B@BDB@BB@@@@@B@@BBB@B@@AB@B@@@@A@@@@@@@B@@BB@@BB@@@BABA@@@@BAD@@C@@B@@B@B@@A@@@@B@@@@@@AB@@B@@@@BAB@@@@BB@@@B@AB@B@@@@@B@@@@@B@BB@@@B@B@@@@B@BF@@@@@BA@@BABABBB@@@BB@BB@@B@@@B@@BB@@@@@@B@@@@AB@@@@@B@BA@@@@B@BABB@@DABA@@@@B@@@@@@@@B@B@BA@@BB@B@@@@AB@@@@@@B@A@@B@@@@@@@BAA@B@@@@A@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@B@@BB@@@@@@B@@B@ABB@@B@@B@@@B@@AB@@@BB@@@@@BB@@@@A@C@@@A@@@A@A@@@@@AB@@@@BB@@@@@@@B@@@@@B@@@@A@@@@BB@@@@AA@A@@B@B@@@@@B@BAB@@@B@@@B@@A@@@@@@@A@@@@AA@@@@@A@@@@@A@AA@@A@@AA@@@A@@@A@@A@@@A@@@@@@A@@A@@@@@A@@A@@@@@@B@@A@@BBBABA@@@AA@@@@@@@@@B@@@B@@@@A@@B@@A@@@@AA@@@A@@@@@AA@@AB@AA@@A@@A@@@@@@B@@@AAAB@A@@A@@@@A@@B@B@@BB@@AA@@@@@A@BA@@@@@@A@@@@@@@B@@A@@B@@@@@@@B@@A@@@@CAAAA@@@@A@@@A@@@AA@B@@A@AA@@A@A@@BA@@BA@A@A@@A@@AA@A@A@@@AB@@@@@AA@@AAA@@@AA@@A@@@A@@@A@@@@BA@A@@@A@@BA@C@@BA@@@@@AA@@AA@@AA@BAB@@A@@D@B@@@BABA@A@@@A@@B@@@BABABA@@B@@A@@@@D@@@B@@@@@B@@@@AB@@@B@@@@@@AA@@@@@BA@@@@@@@AB@@@@@BBB@@A@@B@B@@@B@@@@@AA@@@@B@@@@AB@B@@@B@@@BA@@A@@@@AA@@@@A@@A@@@@A@@@@A@@A@AB@@@@@@A@@A@A@@@@@AA@@@@AA@A@AAA@@@AA@AAA@@@AAA@@B@@A@AA@@@@A@BAA@@@BA@@@AAABABAB@@@AA@A@@A@@@@@@AAA@@AA@A@@BAFCBBA@BB@@@BB@DB@@@A@A@@@@@AB@@@@@@A@@@@C@@@@@@A@@B@@@@A@@@AB@@@B@@@BCB@BA@@B@BBB@@@@A@@B@B@AA@A@A@@B@@@BB@A@@@@@A@@@@D@@A@@BA@@AA@@@@@AB@@A@@@@B@@@AA@@@@BA@BB@@@@@BB@@BB@A@ABAB@BA@@@B@@BDB@B@@@@@@@B@@@@@BADABA@@B@@@@@B@B@B@@@@@BB@@@@B@@@B@@@@@B@B@@@B@@@B@B@B@@@F@BA@@B@B@@A@@B@@BB@B@@@B@AA@A@@@@DB@@@@BAA@@@BC@AB@@@@@B@@@@@@A@A@AB@B@@A@A@@B@@@@@B@@@@@B@@@B@@B@@B@@@B@B@@@B@BA@AAAAA@@@@@A@A@@@A@@BB@ABB@@@AB@@@BC@@B@@@@@BBB@@AD@@A@@@@A@@A@@@@BA@@BA@AB@@@BA@@A@BA@@B@@@@@@ACG@C@@F@@A@A@@@@A@A@C@CAAAC@AAA@A@@A@@@@AA@@@@AABA@A@C@A@C@@@@@A@@@AAA@@@A@A@A@AAA@AAA@A@A@A@CB@@A@@B@@C@A@AB@@AB@BA@AB@AABA@@@@@A@@@@@@@@@@@@B@@@B@@@BAB@BAB@B@B@B@@@B@@ABAAABAB@@ABB@A@@BA@@@A@@@C@@B@@AB@B@B@@@BABABA@@B@@AB@@AB@B@@@@B@@BB@@B@@B@@B@@@@@BB@BA@@@@@B@B@BBB@@@BB@@@@D@BA@@BA@@@@A@@A@@@A@@@@AA@@@@A@@@@A@@@@@@@@B@@@BB@AB@@@@@B@@AD@@@BA@@BA@@BAB@@@@@@BBBBAB@B@B@@B@@DBB@@@BABA@@@@B@@@@@BBD@B@@@B@@B@@B@@BD@@@BB@@BABABA@@A@AA@AAAAA@CA@A@@@AAAA@A@@@A@C@@@@A@ABCBAAB@A@@@@@AA@B@A@@@@A@@A@@@A@@@@@A@@@@@@@@AAAACCAA@A@AA@B@BA@A@@@A@@AABA@AC@@@@A@AAA@A@@@A@@CC@ACE@@CBA@A@A@@@BA@A@@AAAAAAAA@A@A@ABA@@@@@@BADA@AB@BADCBA@AHEBA@ABA@@@A@@AAA@C@C@A@A@@@@A@A@A@A@A@A@@@A@@AAAAAE@@ACAAA@@AA@@@@@@B@@A@@@@B@@B@@@@B@@A@@@@@@@B@@@@@@BA@A@@@A@@@A@@@@@BAA@@@A@@@@@A@A@@@AB@@@BB@@@@@A@A@@@AB@@@AA@@@AB@@ABA@AA@@@BA@@A@@A@@AA@A@@@@@A@@@A@A@@@@B@@@@A@@@A@@@@@A@@@A@@@@@A@@@C@@B@@@@A@@BA@@@A@@@A@@@A@A@@@A@@@AB@@AB@BA@A@@@CB@B@@@@@@AA@AA@@AA@A@A@A@A@@@ABA@A@ABA@A@ABA@ADEEAA@@AAAACA@@A@A@@@CD@@A@@@@@@@@BAB@B@B@@A@EBA@ABC@@@ABABCBABAB@@@@@@@B@@AA@@@@@@AA@@@A@AAA@AA@@A@@C@@@A@A@@@A@@BA@@@AAAAAA@A@@@@AAA@A@A@@@A@A@ABC@@BC@@BC@CBA@A@AB@BA@@AA@@@CCA@@@@A@@BA@@B@@A@AB@@A@@@A@A@@@A@@@ABA@A@A@A@A@ACEAA@A@@@@@A@@@AB@@AB@@@@AB@A@@A@@@@@AB@@A@A@@@@@A@A@@@@A@A@A@@@A@@AAA@@@@@@A@@@@A@@@A@@A@@A@@@AB@@A@AB@@@@A@@@@@A@AAA@A@A@AAE@C@A@A@@AAA@AA@@B@@@@@@A@@BA@@@ABC@@@@A@@A@A@@@A@@AA@@@@A@@@AAA@@A@@BAAAA@@A@A@AA@AA@A@@@I@@@@B@@@BAA@DABA@A@AAA@@@A@@@@@CB@CC@@AA@@BAAA@@@AAA@@@@@@BAB@@@B@@@B@D@@@@@B@B@BA@@BA@@@CB@@AB@@@B@@@@@@A@@@@@ADA@@BAA@@ABA@@AA@@@A@@B@BAFCB@@A@@@E@C@@AA@@A@A@A@A@@@A@A@A@@@A@@@@@A@CBA@@@A@@@A@ABA@A@@@@BAA@@A@@@@@@@@@@A@@A@A@@@A@@A@@A@@@@@A@@AA@@@AAA@@@A@@AA@AAA@@AA@@A@AAA@@A@A@@@AAAA@@CCAA@@AA@A@@@@AAAC@@@@A@A@A@A@AB@@C@@@@A@@@@@AB@AA@@A@@@AB@@@AA@@@A@A@C@C@A@"],encodeOffsets:[[120931,25470]]}},{type:"Feature",id:"350525",properties:{name:"永春县",cp:[118.294048,25.321565],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@A@A@C@A@A@A@A@AAA@A@@@@@AAAA@@A@A@CA@@E@@@@@AB@BA@A@@@A@@B@B@@A@A@@B@B@BA@@B@BA@@BA@@@BB@@A@ABAAC@@@@@@A@A@@@AA@@A@@@@@A@@A@@@@AAA@AA@A@A@AB@@A@A@A@@@@@AB@@@BAB@@@B@@@B@@@B@AA@@@AA@@B@@A@AA@@A@@A@@AA@@A@A@@@@BA@@BBB@@@B@@@@@AA@@@@@A@@BA@@CA@@A@@@A@@@AAAA@@@ABAA@A@@AAAA@BAA@@@@@@@AABAB@@@@@B@@@B@BA@@@@@AA@@A@AAA@A@@@A@@@A@@@@@ABAA@@@@BA@A@@A@@@@@@B@@@@A@@@@@AA@@B@@@@A@@@@@A@@A@@@@AB@@@AAAAA@@A@BB@@@@@B@B@B@@@AA@@@A@@@AA@A@A@AADAB@@@@@@DBBB@@@@CBA@@@A@@B@@@D@B@B@@CBA@@@A@@@@@@@@@@B@@C@AA@@@A@@@@@@@AA@@@@@@A@@@@A@@@A@@@@@@@@@@@@@A@@B@@@@A@@@@@A@AB@@@@@@@BB@BB@@@@BB@@@@AB@@CCA@@@@@A@@B@@@BC@@@BA@@@A@@@@@AB@@@@A@@@@@@@@@@B@@@@@BA@@B@@@@@@@BAB@@A@@@@@A@AA@A@@@@@@@@@@@B@@@@@AA@BAA@@@@A@@@@@@@@A@@@@@@A@AAD@@@B@@@@@@@@B@@@@B@@BB@@@B@@@B@@@@C@A@@@@B@BAA@A@B@BA@@CA@@@@@@@@@@BAB@@@@@@@@@B@@A@@@@D@@@A@@@BAB@@@@@@A@@AB@AA@@A@@@@@A@@@BA@@@@@@@@@AB@@@@@BAA@C@@A@A@@@@@BA@@@@@@B@@@@@BA@@@@@@A@A@@@@@A@@AA@@ABC@@@@@@A@A@A@@AA@AC@@A@@@AB@@A@@B@@A@A@@BA@@@C@AB@@AA@@A@@BA@@@@@@BA@@@A@A@A@A@@BC@A@A@@@A@@@@@DD@@DDB@A@@B@@@@A@@@@@@@@@AB@@@@@@A@@@A@@@@@CB@@AAAA@@A@@@GB@BBB@B@@@@A@A@@@@@A@@@@@A@A@A@@AA@AA@@@@BCBA@@A@A@AAAAA@@A@@@@A@@B@@A@@@AB@@@@@@AC@@@@@AA@CAA@A@@AA@@AA@@AAA@@@@@AEB@@A@@AA@@AA@@@@@A@@@A@@@@BAB@@A@@@A@@@C@@@A@A@@B@@@B@@CBE@@@@@@B@@A@@@A@@@ABAB@@A@@@A@@B@@A@A@@B@@ABA@@@@B@@@B@@@BA@@@@B@@@@@BA@@B@@@@@BA@@@A@C@@@@@@B@@BB@@@@BB@@AB@@@@@@@BB@@@@BB@@@@@@B@@@@@@@@D@@BAB@@B@B@AB@B@@AA@@@B@@@@AB@@@B@@@@@B@@@@A@A@@AAA@@@@A@@@@BA@@@@B@@@@@@@B@@@@@BB@@@@@BB@@BB@B@B@@@@AB@B@@@B@BBB@@ABAB@@AB@@B@@BB@@B@@@@A@AAEA@@@@A@A@@@@AA@@@@AA@@BC@A@ABA@AA@BB@@B@@@@@B@@BB@@@@@BA@@@@B@@A@@@A@@@@@@@@@B@@B@@ABABAA@@@BA@@@@@@@@BB@@@@@B@@@@B@@@@B@@@B@@@@B@@@B@@BB@@@BB@@B@B@@@@@B@@@@BB@@@@@B@@@@@@@BA@@@A@@@A@ABA@@@@@A@A@@@@BBB@@@@AA@@@@@B@@A@@@@@A@@@@@@@A@@A@@A@@B@@@@@B@B@@@B@@@D@B@@A@@A@@@@AA@@A@@BA@@@A@@@@AA@BAA@@@@A@@@@A@@AA@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@A@@B@@@@@@@@@B@@A@B@AB@@@@@@A@@@@B@A@@@@A@@B@@A@A@@AB@@A@A@A@@@@@@A@@@@@ABCB@@AAABA@@@@@ABA@@@@@A@@B@@A@@@@@@@AA@@@A@@@AA@@AAA@@A@AAABAB@@AB@@@@E@@A@A@@A@A@@@A@@A@A@@@@@A@@@@@ABAA@@@A@@A@@A@AB@@@@@AA@@B@@@@A@@@@@@BA@A@@@@AD@@@BC@A@@B@BA@A@@AA@@AA@@@A@@@@@@@B@@A@A@@BA@A@AA@@@A@@@@AAA@ACA@@@A@@@@@@@AB@B@@@@BB@B@@@B@@A@@BA@@B@@A@AB@BB@@@@BA@@B@B@B@D@@@BA@@B@BA@@B@@@B@B@BA@@@A@E@C@@@AB@@@B@BA@@@A@@A@@AB@@@@@B@@@@AB@@@@@@A@A@AB@@AA@A@AA@@AA@@A@@B@AAB@A@@A@A@@A@@@@@CB@@A@@A@@@ABA@@@@@A@@@@@@CBAAABA@@BA@A@@@@@A@@AAA@DAB@@C@@@@D@@A@A@@B@@@@@@@@@BB@@@BB@BB@@B@B@@@@@BAB@@@@@B@B@@@@@@@BAA@@@BA@@@@BB@@@A@@@AB@@@A@@A@A@@@@@A@@@@@A@@@@BA@@@@BA@@@@BA@@B@@@@@@@@A@@B@@@@@@AB@@@BA@@@AB@@AB@@@B@@@@AB@@AB@@@BA@@@@@@@@B@@@@A@@B@@AB@@@@A@@B@BA@@B@@@B@@AB@@ABBB@@@@@@A@@BB@@@BBA@@@@BA@@BABB@B@@@@B@@ABBB@@@@CF@@@@@BAD@@BB@BB@B@@@BB@@@@@@@@BB@@@@A@@B@@@@BB@BB@A@@@@@ABEBAD@@A@A@A@A@@@@@BAB@@AB@@@@@@@ACA@AAA@A@@@A@@@ABB@@@@B@@@@@@AB@@@B@@AB@@@@B@@@@B@@@@AB@@@@@B@@B@AB@@A@@@@@A@@@@@@A@A@@A@@A@@@@@@AB@B@@@@A@@BA@@@AB@@A@@@AA@@A@@@A@@@@@@@@@A@@A@@@@A@CA@AC@@@A@@@A@A@@@A@@@@B@@@@D@@B@@@B@B@B@@@B@@A@@@@B@@@BAB@F@D@@AB@BA@A@@@@B@B@@AB@D@BB@@B@@B@BB@@B@@D@@@@CB@@A@@@@B@@ADAB@B@B@@@@B@BB@@@@BA@@B@@@BB@@@@@BA@@BA@AB@@A@A@@@@@@@@@BB@B@@@@@@BB@B@@BBD@@@@BA@@@A@@@@@@BAA@@A@@@@@@@@@A@@@A@@@@@A@@A@@A@@@A@@@@@@A@AA@A@@@E@A@@@@@@B@@AA@@AB@@@@@BB@@B@@@@A@@A@@A@@@A@@AA@AA@@@@A@A@@@A@@@A@CB@@CBAB@@AA@@AAABAB@@C@@@C@@@AB@@@@AAA@@@C@@@@BA@A@@B@BA@@@@@@@A@A@AB@@@@BB@@AB@AA@@B@@B@@B@@B@@B@@@@BBB@@@@B@@@B@@BB@@@BA@AB@@@@A@@BB@@@A@@BA@AAA@A@A@@@@@@@A@@@@A@@@@@@@AA@@@@AA@@BA@@@@@@@A@@@ABAA@A@@@AB@D@@A@@@AAA@@BA@@@AA@A@@@@@@@A@@@A@A@@BA@BB@@A@@A@A@@@@@@@AA@@B@@AA@@@@@@A@@@AA@@@A@@A@@AA@@@@@@AA@@A@A@@@A@@@A@AA@@A@@@@@A@@A@@@@@@A@@@@@AA@@@@@@A@@A@@AA@@@A@@@@B@@@BB@@@@@@B@@@@@@@@@B@@@@@B@@@B@@@@@@A@@@A@@@@@A@@B@@A@@@@AAB@@@@@@A@ABA@AB@@@B@@@B@BA@AAA@@@A@@B@BAB@@A@@BA@@BABA@AA@A@@@A@@@AA@GA@@@@@@AC@@@@@A@@@AA@@BA@A@@@@AAA@@@@@@@A@@B@@@B@B@@@@@@@@@@AA@@@C@@@A@@B@@AB@@@@@@@@C@@@C@A@@@EA@@C@AA@@@@AA@@AAA@@AC@@@@A@@@@AA@A@A@@@@@@ABAB@@AB@F@@@@A@@@AB@@A@CDCB@@A@@@@@A@@@@@@@A@@B@@A@ABA@@@AB@@@@AA@@AC@@A@AAAA@@@@@@@@AD@@AB@@@BA@@BA@@@CB@@@@@B@@@@@B@@@@@@C@@BA@@@A@AB@@A@@@@@@@AAAAAAAAA@@A@@CA@A@A@AA@@@@@@A@@@A@A@@@A@@@AA@B@BA@@@@AAC@@@@AA@@B@@@@A@@BA@AB@@A@@@@@AB@@@@@BA@@B@@@@CB@@@@A@ABA@@@A@@@AAA@@@@A@A@@A@@@@@@@CB@@@@@@B@@@@BA@@@@@@@@@@BA@A@@@@@AB@@@@@@@B@@F@B@@B@@@@@B@@AB@DA@@BA@A@@@@B@B@@A@A@ABAB@@AB@@@@BB@@@@CB@@AA@B@@@@@B@@B@@B@@A@@B@BAB@@@@A@A@@@@@@@A@@B@@@B@@@@@B@B@B@@BB@@@@A@@BA@@@AB@@@@AB@@C@@@A@@B@@A@@@A@A@@@BA@@@AAAA@@A@@A@C@@AA@@@AB@@C@@@AAAA@B@AA@A@@AA@A@@CA@AAA@@@@A@@BAAA@@@AAA@AAA@@@@A@@HAB@@A@@@AA@@AA@A@@@@@@@@A@@A@@A@@@AB@@@B@@@@@B@@@@A@@@A@@@@@A@@@@@@A@@ABA@@@AB@@@@@B@@A@@@@@A@@@A@@@A@@B@@A@@@AA@@@@AA@@@@@@AA@@AAA@A@A@@@@@@@@A@A@AA@GA@@A@@@A@A@A@A@A@@AA@@A@@CBA@@@CBAACBEBABA@@B@@@@AA@A@A@@@AAA@@CC@@@@@A@C@@@AA@AB@@@B@@@AAB@@A@@@@BA@@@@B@@A@AB@@A@@@@@A@@@@B@@@@A@@@@@@BA@A@@@@@@@@@@BA@@@C@@BC@@@A@@B@@@@BB@@@@@@BBBB@@@B@BAB@BA@AB@@@@BBB@@@@@@@AB@@A@ABA@AB@@@@A@AAA@AB@@A@A@CB@@@B@@@@AB@B@@B@@B@@A@@B@@@@@@@@@BA@@B@@BBABA@@@@@@@BB@B@@@B@@@B@@@BB@BBB@@@@BBB@@@@@BA@A@@@A@@A@A@@A@@@A@@AA@@B@A@@A@@BABAB@B@B@B@B@B@@@B@@@@AB@BA@@@A@@@A@@B@@BB@@@BB@@B@@BB@@@@BB@B@@@B@B@B@@@BAB@DB@@B@@@@BB@@@BB@@@B@BB@@B@D@B@@@B@@@@B@@@BB@@@@@B@@@@B@AB@@@@@@BB@AB@@@B@@AB@@@@@B@@@B@@@@@@@B@@BB@@@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@@B@@@BA@B@@@@@BBBB@@@@BB@B@@BBBB@@@@B@@BBB@BB@@D@B@B@B@BBB@@@@@@BC@@@A@A@@B@@ABA@AB@B@B@H@@@@A@@B@@@BA@@@@B@B@B@@AD@@B@@B@B@@@BBB@@BB@@BB@B@@@BA@@B@@@B@@@D@D@B@@BB@BB@BBB@@AB@BAB@B@BABA@@BB@@@BB@B@@@B@@BB@@@B@B@@@@@D@@@@@B@@@@@@@@@AAB@@@@@@@@@@A@@@A@@A@@@A@A@@@@@A@@@@A@A@@DAB@@@@B@B@@B@@B@A@@@A@A@@@AB@@A@@B@BB@A@@@@@@B@DA@@@@BAB@@@@@B@@@@@B@@@B@@BB@@@B@@B@@BB@BB@@@B@BA@@B@@A@@BBB@@@B@@B@B@@B@@@@AB@BAB@B@@A@@@A@AA@B@@A@@@AAA@@@@@A@A@ABA@@BC@@B@D@B@BB@@B@@B@@@B@@@BB@@@BABA@@@@BABABA@@@A@A@@@@BADBBA@@@@@AA@@A@@@@@@@@@A@@D@@@@@@AB@@@B@@A@@@AB@@@@@@AB@@@@@@AB@@@@@@@BA@@BABA@@@@BA@@B@@@@AB@@BD@@@BAB@B@@@B@B@@@B@@@@@@B@@@B@B@D@B@@@BB@@@B@@@B@@BAB@@@@@@A@@@@@A@@@@@A@@AA@@@A@@BA@@B@@@B@@@@@@AB@@@@@B@@@@AAA@@@@BA@@@@B@@A@@BA@@@@@BB@@@@@B@@@B@@@BB@@B@@@@B@AB@@ABB@@BAB@BABB@@B@@ABB@AB@B@@@@@@BBB@BB@@@@B@B@@@B@@@@@B@@@@@D@D@DB@@@AB@@AB@@A@@B@@@@@B@@@FD@B@@@@@@@@@@A@@B@@@@BBD@@@B@B@BB@B@@@@@BB@@@B@B@@@B@B@@@D@B@@@B@BA@@DA@@B@@AB@@AB@@A@@B@B@@A@@BC@@@ABA@A@@@AB@@@@ABABA@AB@@ABA@@@@@AB@@AFCBABC@@@ABA@AB@@@B@BAB@@@B@@@@BBCB@@@@@@B@@@@B@@@D@B@@@@@B@@@@@@B@@@@B@@BB@@BB@B@@@B@@B@B@@A@@B@@BA@BBA@@BB@@@B@@@@DBBBB@@@@A@@BB@@B@@A@@BAB@B@@@@@B@B@@@B@@@DB@@@@BBB@B@B@B@@@@B@@@@@@@@BBB@@@BB@@@A@@@@BA@@@@B@@@B@@@@@B@@@B@B@@@B@@@B@@AD@@@@@BB@@B@@@@@B@@BBDBB@B@@B@@AB@@@@AD@B@DBB@@@B@DBB@@@B@B@B@@B@@@@@B@@BB@@@@BBB@@BBB@@B@BA@@@AB@B@B@BB@@B@@@B@@BB@@@B@@@@BD@@@@@@A@A@@@A@@@@BAD@@@@@@B@@@@@@@B@BB@@@@BDA@BB@@@@B@@@@A@@@A@A@A@@@A@@BBB@@@@@@B@@B@@@BBB@@@@@@@@BBA@@BB@@@@BB@@@@@B@@@@@B@@@@@@@B@@@@@@BB@@@@@@@@@@B@@@BB@A@BB@@BB@@B@BB@@B@@@B@@AB@@A@@BB@@@@DB@BB@@@B@@@BBD@@@BBBA@@@@@@@@DB@@@B@@@BA@B@@B@@@@B@@@@@@@B@@@BAB@@@B@BA@@@@B@@@B@@A@@@@@A@@@@BAD@B@@@@AB@BAB@BA@B@@BA@@@AB@BB@A@@@@@A@@@@D@@@@@@@@@@A@@B@@A@@@AB@DA@@B@@@@A@@B@@@BB@BBB@@AB@B@@A@@@A@@B@@@@A@@@@@@@@@A@@A@A@@@BABB@@B@BB@B@@B@@@@@@AB@@B@@BA@@B@@AB@B@@@B@ABB@BBBB@B@@@BBBB@B@@@@@@@B@@B@@@@BB@@@@@B@@@@@@@@@@@B@B@BBB@@@B@@@B@D@@@D@@@AB@D@@@B@@@B@B@@@@BB@@@@@@@BBA@@@B@@@@DD@@@B@B@@B@@BD@D@@BB@B@B@B@B@@B@B@B@@BB@@@BB@@@@@BA@A@@@AA@@AB@@@@A@@@@@AB@@@@ABA@A@@B@BABBB@@A@@@@@A@@BAFCDAB@DCDA@A@@@@B@@@B@@@B@B@B@@A@@@A@@AAAA@A@@@@@CAA@AA@AA@A@@B@BA@@@@BA@A@@B@B@@@B@@A@@BADA@@@A@@B@B@B@BBB@FBB@BB@@BBBB@@@@B@B@@@@@@B@B@@BB@@B@@@B@@@B@ABB@@BBAB@DA@@@@@B@B@@B@BFB@B@BBDBBBB@B@@@B@@A@ABAB@@AB@B@@@D@B@DADC@@B@@@B@DB@@@@@B@@BBBB@@@BB@@@HAB@@BB@@@B@DA@@@BB@@BB@BABA@ABA@@D@@@@A@A@@@@@C@@DADA@AB@BEBC@CBA@A@AB@BABA@@@AAC@C@CB@@A@@@AA@@A@@@C@@DA@@@A@@AC@CA@@@@AB@@@B@@@B@B@@@@@B@@@@@B@BA@@@@@@ACAAA@A@@AA@A@AAA@@@@A@A@@@A@AAA@A@A@CBA@@@AB@D@@@D@FC@AB@B@B@B@@AB@@@BB@@BA@@@B@AB@@A@@@@@@B@@@@@@@@ABAB@@@B@@A@ABC@@B@@@B@@A@@@@@A@@@@@@B@@A@@B@BA@@B@@@BB@@@@@@B@D@B@BADA@@@A@@@@B@@@BA@@BADAB@B@B@@@D@BB@@BBB@B@@ABABC@@BBBB@@D@@@BB@B@@@@BA@AB@@@BA@B@@@B@@B@@@BB@@@BB@@ADA@@@AB@@@B@BA@@@AB@@A@@@@@AB@@@@@B@@@B@BABAD@@@BABBD@B@@@B@@@@@@@AC@@@@B@@@B@@@@A@@@@B@@B@@@@@A@@BA@@BB@@@ADA@@@@B@@@@B@@A@@@@@@@A@@B@@BB@@@A@@B@@BAB@@@@@@B@B@B@BB@@B@B@BBB@@@B@@BB@@B@@B@B@@@@@B@BA@@B@DA@BD@B@BABADA@AB@DABABAD@HA@@@C@AB@@@FAB@BABABCBA@@B@DADA@@@@@C@AB@@@@@AC@@A@@@A@@@@@@A@A@@DCBA@A@A@A@C@@DA@AB@@@@ABADAB@BA@A@@@A@@BC@@@@B@@BB@@@B@@BBBB@@@@@BAB@@@BA@AB@@@B@@@B@B@@@@AB@@ABA@CAA@@BC@AB@@A@A@@@A@A@@@A@@B@@@D@@@B@@BB@@@@BB@@BB@B@DBB@DB@@@@BB@B@@@B@@B@B@BB@@B@BBB@@@@@@@B@BBF@B@B@B@@@BB@B@BAB@B@@A@A@@@A@@@@B@BA@@@@B@@@@A@@B@@@@ABA@@@@@@BA@@BAB@@AB@@@B@@@BBBABBB@@@@@@B@@B@@@@BD@@B@@@B@@@DB@@BA@@BB@BB@B@DB@@@@@BABABCB@@@@@@DD@BB@B@B@@@B@BBB@B@B@D@BBBB@BBB@B@@@B@@B@@@H@B@B@@@F@D@@@B@BB@BB@B@BA@@@@D@@@BBB@B@B@B@@@B@@@BB@@DDB@@@DBBDB@@DBB@@@@B@DABBBBB@BB@@BA@@BB@@@BF@@BBABB@ABB@@D@@@BABBB@@AB@@@@@@B@@B@@@@BB@@BB@@@@@@@BABCBA@A@A@A@@AA@@@@AA@@@A@@A@@AA@@@@@@BAB@@AAA@@@@AA@@A@@AA@@AA@@A@@@@AA@@@AA@@@A@@BABA@ABC@AAA@@@ABA@AB@@BBD@BBBBD@@BB@@@@@@BB@ABDB@@@BB@@B@@@BAB@@@DBB@BA@AB@BBB@BBB@@@@AB@@@@@B@BA@@B@@@B@@@BA@@@BB@@ABB@AB@@@@@BA@@@B@@B@@@BA@@B@@@B@@@@@B@B@@@B@@@@@@@@AB@B@@AB@@@A@@@@AAA@@B@@@@BB@@@@A@@BA@@B@@@@B@B@@AB@@@@@@@@B@@@@@@BB@B@B@@@@B@B@B@F@@AB@@BBA@@@BB@BAB@BB@@BB@@@B@@@D@@@@@BB@@@@BADAB@BC@@@@B@B@@@B@BAD@@@B@@AB@@A@A@@@A@@C@@AAEA@@A@@@ABC@@@AB@@@D@B@B@B@BA@@B@DBB@@@@@@B@BBD@BDD@@BBB@B@BBB@B@@BB@@A@@@A@@@@DDB@B@@@B@B@B@@B@AB@B@BAB@@B@@B@@@B@B@@@B@B@FC@@AA@@@@BABC@@BA@@@ABA@@@ABA@@C@@@@A@@AAB@@@@@B@B@BAB@@A@A@A@@B@@A@@B@@A@@@A@@@A@A@A@A@@B@B@B@@@@@B@B@B@B@@@F@B@@@@BB@@@B@B@@@B@@BB@@@BEBA@AA@@AA@@@B@AABA@@@@@CB@@A@@AA@CB@@A@AB@@AA@@@B@@@@A@@@AA@@A@@B@@ABA@AAA@@@@@AB@D@B@@AD@@@@@@@@B@@@@BB@@@@B@@@@@@A@@@AB@@BB@B@BABA@@@@CA@@@A@A@A@@AA@AA@@A@A@@A@@@A@A@@AA@B@BE@A@ABA@@B@@@@@FDDBBB@@@@@A@@@@@@@AA@@A@@@A@@@C@@FDBAB@@@B@@@@@@@@@D@@AB@@@AA@@A@BAB@AA@@@AB@@A@@BA@B@A@@@A@@B@@AB@@@@@@@@@@AB@B@@AB@B@@A@@B@B@@@@@@A@A@A@@B@B@@@B@B@@@@A@@A@@@@A@A@@@@@A@@@@BA@@@@@@AA@@A@AA@@@@B@DABAD@@@@@BBBA@@B@@ABA@@B@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@@@@A@@@@AA@@AA@@@@@A@@@@AAAAA@A@@A@@@AA@@@CA@A@ABC@ABA@A@@@@@AAAB@BC@@ACA@@@@A@@@BAA@@@A@AB@B@B@@@B@@@@@B@@@@AB@@@B@@AA@AA@@A@@BA@AB@@@AA@A@AA@@AAA@@@AA@@@A@@@A@@A@BA@@AAB@@AA@AA@@AAA@@AAA@A@A@A@A@@BAB@@A@@BABABBB@@BBA@@@AB@B@@@@B@@@B@@B@@@@@B@@B@@@B@@@@@AA@@@A@@@@B@B@@B@@@@@@DB@@@DBB@@@@@@B@@@BA@BBA@@B@@@@@@B@@B@@@@@B@@BBB@@B@@@@B@@A@@BB@A@BBBD@@@@A@@@A@@@A@A@@@AB@@@@@B@@A@@@@B@@@@@@@@AA@@C@@@A@@BA@@A@@A@@A@AA@@@A@@@A@@@@@A@@@A@@@@@@A@AA@@AAA@AA@@@@B@@@BAAAAACD@@CBABA@AB@@A@@@A@@A@@A@@@A@@@@AAAAAA@ACA@AB@@@AA@@@AA@@A@@AA@@@@@A@AC@@AA@@@@A@@B@@@@A@A@@@@@A@@@AA@B@A@@A@@@A@A@@@CAABA@CC@A@@@A@@@AAA@@A@@A@@@A@A@@@@AC@@AAAA@@@BA@@@ABAB@@@@AB@@@@@B@@@@@B@@A@A@@@A@A@E@@@A@@BBB@B@B@@@@@@@@@AAA@@AB@@@BA@@BAD@@CB@@@@AA@@@AAA@@@B@@A@@A@@A@BB@@@B@@@@CBA@@@@AAA@@@@A@@@@@@@@@@B@@@@@@@@A@@@@B@@@@B@@BA@@@A@@@A@@B@B@@@BA@@B@@@@ABA@@BAA@@A@@@A@@@@@AC@@@@@@A@@@@@@A@@AA@AA@@A@@@AA@@DA@A@@@@@@@@@@BA@@@@@A@@BC@@B@B@@@@@@@@A@AE@@AA@@@A@C@AAA@AA@@@@A@AA@@@A@@@A@@@A@@@A@@B@@@@@@AA@@BA@A@@@AA@@BA@@@A@@@BA@@A@A@A@A@A@@@A@A@AB@@@@A@@@A@@@@@AB@@A@@A@@@@@@@@A@@B@@A@@@@@A@@AAB@@@@@@A@@A@@@A@@A@A@@@@B@@A@@AA@@@A@@A@@@@AA@@AA@@@A@@A@AA@BAA@@A@AA@@B@@@@@@A@B@@B@@@@@@@@@@AAA@A@A@@@@@A@@B@@@A@@A@@@@@A@@@@A@@A@A@@@@A@@@@@A@A@@@@@@AA@AA@@@BA@@A@@@@@A@@@@A@@@@@@@@@@@A@@@A@@@@@AA@@@B@@A@@@A@@A@@A@AA@@AA@@@ACAA@@@@@@A@@@@A@A@@A@@@A@@@@@@A@BA@A@A@AA@@A@@@@A@A@@A@@@@@A@@@@@@BA@@@@@@AAA@A@A@C@A@C@@@A@@@A@A@@@@A@@@AA@A@@@AA@@@@CAA@@AA@@AA@ABA@ABC@A@CA@@ABA@@@@@ABA@CA@@ABA@A@@@@@AB@@@@A@ABC@@@@@@B@BAB@B@@@B@@@@@@A@"],encodeOffsets:[[121179,25867]]}},{type:"Feature",id:"350526",properties:{name:"德化县",cp:[118.241094,25.491493],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@B@D@@@@CBCBA@@@ABADABABA@EB@@A@@B@D@@GBC@ABABCBA@@BCBABABA@C@@ACBA@@@ABA@@@@@A@A@@@@AA@@AA@@@A@AAA@A@@@AAA@A@A@@@@@@@BA@AA@@@@B@@AA@@@AB@@@@@@@B@@@@A@@A@@@@@CB@B@@AA@@AB@@@B@@@@@AA@@@@@@B@@A@@@A@@@@@BD@@@@@@A@@@A@C@AAAB@@C@ABABA@@@A@@@@@A@@B@@@@@BA@@B@@ABA@@@A@@B@@CB@BA@@A@@AA@@A@@@@A@@@AAB@@A@@BAB@@@@@AAA@@C@@@AAAA@@ADAB@BA@A@AA@@AAC@@@A@A@A@CBAB@@AB@@A@@@@@@B@@CBABA@C@A@@@@@@@AA@@A@@@ABA@@@@BA@@@@@@@@B@@@@@BA@@@A@@@AD@B@BA@@@A@AB@B@@@@@@A@@@@@@@@BA@@B@A@@AB@@AA@@A@@BA@A@A@A@@BEDC@@@C@A@@B@@AB@D@B@BBB@B@B@@@B@B@@B@BBB@B@@BB@B@BBBD@@@@@@ABA@@@@@A@@@@@A@A@@@A@@@A@@B@@B@@DBD@@@B@@CB@@@D@@@BB@@B@@@BA@@D@DBD@B@@ABABA@@B@BAB@DADAFA@@BCBCB@@@D@@@@@B@B@@C@@@AB@BABABA@@AA@@A@@CBA@@@A@@AA@GB@@A@@A@@AAAA@@@A@@@@CAA@@@A@@@CDCBA@C@@@A@A@@BA@AB@B@BA@@@A@A@AACAAAA@A@AEA@@@@A@A@@@@CBA@AB@AA@BAA@@@A@@@A@@@AA@@@A@A@@@@A@A@@@@@AAAA@@AAA@EAA@AAA@A@A@@@@B@@CBAB@@@BA@@@A@A@@@@BAB@@@@ABA@@@@BBBB@@BBB@D@@@@@BBBBB@@@B@@@BA@A@A@@@A@@@A@@@@@@BCBCDA@CBEDAB@@@B@@@@@BA@AAABA@@@@BAB@B@@A@@B@@@@@B@@A@@BB@@B@@@BAB@@BBB@B@BB@B@@@@B@@B@@@@@@@BB@B@@@B@@B@B@BBBB@@B@@B@FAB@@@@@B@@D@BB@@BBA@BB@@@A@A@@B@@AH@@@BB@@B@BBB@B@@B@@@B@FA@BB@@B@@@BCBA@@BADAD@B@@BB@@BBB@@@B@@BBA@@B@B@@@@@@B@D@B@@@BBB@BB@DB@@@@@@@B@@@B@@BH@BBBBB@@B@@@@@@B@@@@@@A@A@@@A@AA@@A@@@@BBB@@@@@B@@@@@@@B@@@@BB@@@B@@@@AB@@AB@@B@@B@@@@@@@B@BABB@@BB@@B@B@@CB@DAB@@@B@D@D@@@@CA@@A@A@A@@@AA@AA@@BA@A@A@CBAB@@@D@@@@@@A@@@AC@EAA@@@AA@@@AA@AA@@AA@@@@A@@A@@@AAABC@A@A@ABAAA@A@AAAAA@AAA@@@A@AAAAA@@@A@@B@@@BA@@@@@@@A@BBBD@@@@@BA@@BA@@@A@@A@A@A@@@A@AAB@@@@@A@@A@@@@@@B@@A@@@@@@@@BA@@@AB@AA@@@@A@@AC@AA@@@@@ABA@@@B@BB@B@B@@@@@B@@@@A@@@@@@B@@@AA@@@@B@@@@@@@@A@BB@@@@@@@@@@@@B@@BB@@@@B@@B@@A@@@@@A@@@B@@@@@@B@@@@@@B@@@@@@@@@A@@B@@@@@D@@@@B@@B@@@BD@@@@B@@B@@@@A@@@CCAA@@@@@B@@@@@@B@@BA@@@BB@@@@AAA@@B@@@@A@@B@A@@A@@A@@@@A@@A@@A@@@A@@@@@@@@A@@A@@@@@@AA@@@BB@DB@@BA@A@AA@@A@@@A@A@@@@B@@ABA@ABC@A@@B@@BB@@B@@@@B@@ADA@@B@BCBA@@@A@A@@@A@@@@@BB@@@B@B@B@@CB@@A@@B@@@@@B@@@@@@A@C@AAA@AAABA@@@A@AAAA@@@AA@@B@@@DAB@BABAB@@@BAB@B@@@BB@@BBB@@@B@@ADA@@@CBC@@A@@@AC@@@AA@@@@CCA@A@A@A@@@@@A@@@A@@@AB@@@@@@@BBF@B@@@BB@@B@@DB@B@@@@@@A@@@ABA@@@@@B@BB@@B@@@B@@@@BAB@@D@B@@@B@@B@@F@D@@@@@@@B@@B@@@B@@B@@@@@@@AB@@@@@B@@@@@@B@@@A@@@@@A@C@@@@@AB@B@B@@@BB@@@B@@@B@B@@A@@@A@AB@@BB@BB@AB@BBBA@@@@BA@@BAB@@BB@@@@@B@@BB@@@@@@B@@B@@B@@@@@@@@@B@@C@@B@@AAAB@@@@@@@BA@@@@@BBB@@@@@B@B@@@BB@BB@@@BABA@@@@B@@D@@B@B@@BB@BB@@@@@@BD@@@@B@@@B@B@BBB@BAB@@@@@BA@B@@B@@A@@@@@A@@B@@AB@@@@@BB@@@@BBB@B@@@BB@@@@B@@B@@B@@@BB@@B@@@B@@@@@B@@@B@@@B@@@B@@ABBD@B@BA@@B@@B@@BB@@@ABB@B@@@@@@@B@@@@@@@B@B@@@B@@@@BB@@@B@@@BB@@BB@@B@@@@@BBB@@@@@BA@@B@@@B@B@B@@@B@B@@B@@@@B@@@@BBBB@@@@B@@BB@@@@B@@@B@@@D@@@@@@@@@B@@@@@@B@@@AB@@B@@@@@@BBB@@@@@@@BBB@@A@@@@@@B@@@@@B@B@@@B@B@@BB@@@@A@A@@AA@@@ABA@@@A@@@@@@A@@A@@@A@A@AA@@A@@@B@BA@@@AA@@A@@@AAA@AA@@@@@@@@AB@@@BAB@@@@@@@@@AA@@A@@@@@A@@AA@@@@BB@@@@B@B@@A@A@@@B@@B@@BB@@@BBB@B@@B@@@@BA@A@@@@B@@A@@@AAA@A@A@A@AB@@A@@@@B@BB@A@@@@B@B@@@B@@@@D@@@@BB@@@@@B@@@B@B@@@ABABABA@@@@@@AA@@@@@C@@B@@A@AA@@A@@@@@@@@B@@@@A@@AA@@B@@@@@@@B@@@@AB@BB@BB@@A@@B@@@@@B@@@@@B@@@B@@A@@A@@@@A@@@@@A@@A@@A@@BAB@B@@BB@@@@@B@@@@AAA@@AA@@B@@BB@B@@@BA@@@A@@@A@A@@@@B@@@@BBA@@@BB@@BA@@@@B@@B@@A@@BB@B@@@@BA@A@A@@B@@A@@@@AA@@@@BB@BDDB@B@@A@CA@@AAA@A@ABA@@@@BB@B@@B@@@B@@A@@BABB@@B@@@@B@@A@B@@@@AB@@ABB@@@@@B@BABAB@DAD@@@@@@@@@@B@@@B@BBB@@@B@@AB@B@@@B@@@@@@B@@@A@@B@@BBA@@@@@@@B@@@@@B@@@@@@@@B@@@DB@@@BD@@@@@ABAB@BA@A@@B@BAB@B@@AB@@AB@@@B@@@@@B@@@@@@@B@@@@B@@@@B@@@BD@@B@B@BAB@B@@@BB@@@@@B@BD@@@@D@B@B@@BD@B@@AB@BB@B@@BBB@B@@@BC@@B@B@@@B@@@@@B@@@@@B@@BB@@AB@@@BAB@B@D@B@@A@A@A@@B@@@BBB@@BB@@B@@B@@@B@B@@@@@B@B@BBBBB@D@@@D@@@B@BBBBBBBB@@@BB@@@B@@@B@@@B@BBB@@AB@@@@BBD@BBFBBB@BB@BFB@@@@@BA@@@C@C@ABCB@@@@@B@@@@B@@@B@@BB@BDDBB@B@@AB@B@B@BA@@BA@A@GF@@A@@B@B@@@@AB@@ABAB@@C@ABA@@@@@@B@BAB@BAD@@AB@@ABA@@@ADA@@B@@ABB@@@B@@BB@B@B@@@B@D@@@@AB@@BB@B@B@@@@@@@B@B@@@BB@@@B@BB@@B@@B@@@@B@@B@B@B@B@@@B@BA@@@@@ABABABCB@@@BA@@@ADB@@B@@@B@@@DD@@B@@B@@@@BB@@DAB@@@@@B@BADADAB@@@B@@AA@@@BA@@@A@A@@BC@ABAD@B@B@D@@@DB@BBBB@@BB@@@B@@B@@B@@B@@@@@B@B@@@B@@@B@@B@@@@B@@B@@B@@@@BB@@@@BB@@B@@@B@@BB@@@@B@@B@B@@@B@@BB@@@@A@@B@@B@@B@@@B@@@@@@B@@@@@@@@@B@@@@@BB@B@B@@@ABA@B@A@@@AA@@@B@@@@@B@B@@@BA@@@@@A@@BC@AB@BA@@BC@A@@B@@A@@B@@@@@@@B@@@@BB@B@BBDBD@@@BB@BAB@@@BA@A@@B@@@B@@@DBB@B@@AB@@@@D@@@B@B@B@@@@BAB@BAB@BC@@B@@@@B@@B@@@B@B@D@@@@@@@BD@@AB@B@@@DD@B@BB@@B@@@@AB@@@@@DAB@@AB@@AB@@@@B@B@@@B@B@@@@BB@@B@BB@@B@BB@@@@DBB@BBB@@@BBBA@@B@@@B@@@@@BB@@BBBB@@B@B@@@BA@@@C@@@AB@@@@@@AB@@@@ABBD@@B@B@@@B@@@@B@BB@@BB@@B@B@@@B@B@B@DAB@@BB@@@@BB@@@@BB@@@@BB@@@BB@@@@B@@@@@BB@@BB@BB@@BB@BABA@@B@@@D@@@B@@@BBB@@BB@@@B@@@B@AA@@@A@@@A@@@@@@@A@A@A@@@@B@@@@@@@B@BA@@B@B@@@BAB@B@D@BB@@@@B@@@BB@ABA@@@@B@AC@A@C@A@@@@A@A@A@A@@A@@@AAA@A@@@AB@B@@@@A@@BA@A@@@A@@@@@A@A@@B@@@@@@@@@@@@@@@@@B@@@B@D@@@@@@BB@@@@BB@@@B@DAB@@@BA@@B@@@B@@@B@B@@BB@@@B@B@BB@BB@@@B@@BBB@@BB@@B@@@BB@@@B@@@@A@@@@@@BB@B@@B@@@@@B@@@@AB@@@@@D@@@@@@BBB@@@@AB@@@@@@@@@BD@@BB@@@@B@@AB@@@@@BA@@@@B@B@@AB@B@@@@@B@B@@BB@D@A@@AA@@@B@@A@@@@B@B@@A@AB@@AB@@ABABA@@@@@@B@@AB@B@@@@@@AB@@A@AB@@@B@@@BAB@@@@@@A@@B@@@BBB@@@B@B@@@B@@@@B@@@@@BBB@@BB@@@@@@BB@@D@BBBB@B@@B@B@@B@@@B@@@@@B@@B@BBB@@BBA@@B@@@@BB@@BD@DBB@DBB@@@B@@ABA@@BA@C@@@@B@@@B@BBDB@BB@@B@@B@@@@B@@B@@@B@@@AB@@@@B@@BBB@@@@B@BB@@@B@@@@@@@@AB@@B@@@@@@BA@@@AA@@A@AA@@A@AB@@@B@@@@B@BB@BBBADAB@@@@A@@B@@@AA@@@@BA@AA@B@@@B@@@B@B@@AB@@B@@@BB@@@@ABB@@B@@@@@@@B@@B@@B@@@@BB@AB@@BB@@@B@@BB@@BA@@@AB@B@@@@@BB@@@B@BAD@@B@@CB@BA@@BB@@@@@@@B@@@BAB@B@@@BB@@@@BAB@D@B@@BB@B@@@@ADCB@@@@A@A@ABA@ABA@@@@B@B@BABA@@DD@@BB@@@@@BBB@BB@@@@@B@B@@@@@BDB@@DB@@BBB@@B@@ABA@@B@@@BA@C@@@ABC@@B@@A@@B@@AB@AC@CB@@@@A@@BABCB@@@B@BA@A@@@@B@BAB@B@B@B@B@@@BBB@BBBBDA@@@@DAD@B@@@B@@@BBA@AD@@@@DBD@@BA@@@@@@BA@B@@@@B@@@@@B@@@BB@@B@@@B@B@@@B@@@@BBB@@B@BB@@B@@@B@B@B@D@B@@@@B@@@@@B@B@B@B@@BB@@@B@@@@B@@@BB@@@@BA@B@@@D@B@BA@@@@B@@@AA@@@@@@@A@@@A@@@@D@@ABA@@A@DA@AB@BA@A@ABA@@@A@A@@B@AA@@@A@@@CBCA@@AAA@@BA@@BA@@DA@@B@B@@@@AB@@@@A@@@@B@@A@@@A@@@@@A@A@@@C@@@A@@BAB@@@B@@@B@@@@A@ACA@A@@AABA@A@@@A@AB@@@AABCA@D@DAB@@@B@DBDBDD@@@@A@A@A@@@AB@B@@@@D@D@@@B@@@D@BAB@@@B@FB@@BBB@BBB@@@@AB@@@@ABAD@BA@@B@@AF@BADA@A@@@@@AB@@A@@B@BBBABAB@A@@A@@@C@A@C@AC@A@A@@A@@AA@A@@@@@A@@BAB@B@DAB@DADA@@@@AA@A@A@@AA@A@@B@BC@@@@@@BA@A@@@A@@@A@@@@BA@@@@BA@@@@B@@A@@@@B@@A@@@@@@@A@@@@@@B@@@@A@@@@@A@@B@@@@@B@@@@@@AB@B@@@@@AAA@A@A@@@AAEC@@CA@@@@A@@AAA@@@@@C@@@@AAA@A@@@AB@@@BA@@@@A@AAA@@AA@@BA@A@AA@@@@BA@@@@@@@A@@B@@@AA@@@@@@BA@@@@BA@@A@@AB@@@@@@A@@B@@A@@@@@@B@@@@AB@@AAAC@A@A@A@@@A@@@A@AA@@A@@@@AA@A@@@@@@@ABA@@@@@@@A@@A@B@@A@@@@@A@@AA@@@@@@@A@@@A@@@@@@@A@@@A@A@@BA@AAAA@CAACCA@AA@A@A@@@A@@A@AAA@@AA@AA@@@@@@@@A@@@@@AB@B@B@B@@@BC@A@AB@B@BDB@@@B@@BB@@AFEJOB@F@@AD@B@B@B@B@@@BA@@@C@A@AB@@A@@BCB@@A@@@ABA@@@ABCB@@@@@@A@@@A@@@@BAAABA@@B@BBB@@@@BBBB@@A@@B@@@@A@A@@AA@@AA@@@@AAAAAA@A@A@@@A@@AABA@@@@BA@A@@BA@AB@BAB@@AB@@AB@@@BE@AA@@A@@@A@@D@DBB@@@@@@A@A@@@C@A@A@@@A@@@A@@@AA@A@AA@@AC@A@@B@@@@A@A@A@A@A@@@@BAAAB@@@@AB@@CB@AC@AB@@AB@@A@@B@BAB@@@BBB@B@@@BAB@DA@ABA@@A@C@@A@@@ABA@A@@B@B@@@B@B@@@@AB@B@B@@A@ABABA@@@@BBB@B@BB@BBB@@@@B@@@B@@@@BB@@ABAB@@@@@@@@@@@@@BA@@@A@@@A@@@@@@BA@@B@@@@@@AB@@A@@@@@@@@B@@@@@@AB@@@@@BA@@@@B@@@@A@@@@B@@@@@@@BA@@@@B@@@@@@@B@@@@@B@@@@@@AB@@@@@@@@@@AB@@@@@@@D@B@@@@@@@@@@@B@@A@@B@@@@@B@@@@@BA@@@BB@@@BA@@@@@@@@@@@@@@@@@@@A@@@@@@@AAA@@@@@A@@@@@@@@@@DA@@@A@@BABAB@@AB@B@@@B@@AB@BA@ADC@@@ABAA@@ABA@@BA@@@@BAB@@@BA@@@AB@@@B@B@@@@A@A@@BA@@@ABA@A@@@AD@@@@@@@BADA@@@AB@B@@AB@@A@ABAB@@A@ABAB@@ABAB@@@D@@AB@@@@A@A@@@AB@@@@ABA@@BA@AB@DBBB@@B@DAD@@@B@@@BB@@@BBADABA@BFDB@B@BC@@BA@@BA@@BAAAA@@@CACC@@BA@@@@A@A@@AA@AAA@@AA@@AAAA@AC@@A@@AA@@@BA@A@@BAB@BA@@B@B@B@DADABAB@BBDAD@@@@ABABAB@B@BA@@@@@@@@@@AAA@@@AA@@ACAAACAA@@BA@@@A@@A@AAA@@A@@BE@@BAB@@@@A@@A@C@EAA@A@A@@AB@@@BADABAB@@A@@@@A@@ACB@B@@A@CCAA@@@@@AAB@@CB@@A@@@AAA@@@@@@ABAB@@@BA@@B@@AAAACAA@AA@@AA@@AAAAA@A@AA@@@AAA@AAAAC@@@@A@@@@@A@@@AAA@@AA@AAB@@@AAAAAA@@@AA@ACA@A@@@@@@A@@@AAAA@A@@@AA@@A@A@@@@@@B@@@BBB@@A@@@@@A@@@AA@@AB@@AA@@A@@B@@A@@AA@@@A@@@@@A@A@A@@A@@@@AB@@@@AA@@@@@@A@@A@AA@@@BA@@@A@A@@AA@@AA@A@@A@@AB@@A@@@@@@AA@@@@@A@@@A@@@@A@@A@@AA@@@@A@@@@@AA@CA@AA@AAAA@ABA@@D@@@@C@A@@A@A@@@@@@A@@A@@@@@@@@@AA@@AA@@@@A@@AAAA@@@@AA@@@CC@@A@@A@@@@@A@@AA@@@@@A@@@@BA@A@@A@A@@AA@@AA@A@@@@@@@@A@ABAA@CA@AA@@@AAA@AA@@@AA@@@AA@@@A@@@AB@@A@@@@@@A@A@@@AA@@@@A@@@@@A@@@@@A@@@BA@A@@@@@@@@B@@BB@@@@@@@@@B@@@D@@@@@B@@A@@BA@@B@AA@@A@A@A@@A@@A@@@CAA@@A@@@@AA@@@A@A@@B@@AB@@@@@AA@@@AA@@@@@AAA@B@@@BA@@B@B@@BB@@AB@@@@@B@@@@AAC@AA@@AA@@A@AB@@@BA@@B@D@B@@@@@@@BA@A@@A@@A@@@@@@B@@@BB@@BB@@@@BB@@@A@@@@B@@AAA@@BA@A@A@@A@@A@A@@@@AA@@@@@@AAA@@@@@A@@@@@A@@BAA@@A@@@@@AC@@@@@AB@B@BA@BBB@@B@@BBA@@@B@@@@B@@@@@BB@AB@B@@@@@B@@BBA@@B@@@@@@A@ABABA@@@@A@@@A@A@A@BCB@B@A@AA@@@@@A@AA@@@@A@AB@B@@A@@@@C@@@@A@AA@F@@@@@AAC@@A@@@@D@@@@@B@@A@@@AAA@@AB@@A@@@AB@@@@@@B@A@@BA@A@@BA@@@AA@A@@@A@E@@CC@A@@@@@@@@AAAA@AA@@@@@AA@@AA@ACAA@@A@A@@@A@@AA@A@C@@EDA@A@@@A@A@@@A@@@@AA@ABA@A@@B@AA@A@A@@@A@A@CC@@@@@B@@@BA@@AA@A@AAA@A@AA@@CC@AAC@A@A@@@@A@CAA@@@ABA@A@A@C@@@A@@B@@AD@B@@@BB@BF@BD@@@@B@@@B@BA@@BA@@@C@ABA@@@A@A@@@@@ADA@CBAB@@@@AA@@@@C@@@A@@@A@@AA@@ABA@AAA@@B@AA@@BA@@@E@A@A@A@@A@A@A@@A@@@@A@@@@@@@@@BA@@@A@A@@A@@@AB@@@B@@A@@A@@A@@@BB@B@@B@@@A@@BA@A@@B@@@@@@A@@@A@A@@@@@A@@@A@@@AB@@A@@@@A@@AB@@@@A@@BAA@BA@@A@@AB@@A@@@A@@@ABA@@@@@A@@B@@A@AAA@AAA@@BABA@CA@@A@AB@@A@@@AA@@A@AC@BAA@@@@@@AA@@ACAA@AAC@AA@@BAB@B@@BB@BAD@BABAB@@@B@@BB@@B@@B@@B@@@BB@@BB@@@BB@@B@@B@BB@@BA@A@@@@B@@BB@@@@B@@BB@@@@BB@@@B@B@BABADAB@@@@@@A@@AA@@A@@A@@@@A@@@@A@@BA@AAAB@@C@@@AA@BAAAB@AE@@A@@AA@@AB@@AAA@AAAACBA@@@@@AA@CA@ACCA@@A@CC@@AA@@A@@@A@A@A@A@AA@@C@@@@@ABA@A@@AAAA@@@C@E@@@A@A@G@@@A@@@@A@@@AAA@AAAAAC@A@A@A@AAA@@@A@A@A@@ACC@@@@@@DABABA@A@@@@CAA@A@@AAA@@AB@@CA@@A@@@A@@@AC@@@@@AA@@@@@@@AABAAA@A@@@A@@BA@@BA@AB@@A@@@@B@BA@@@@@AB@@@@@@A@@B@@A@A@@B@@@B@B@@@@ABA@A@AAA@@A@A@A@E@AAA@@@@@@@A@AAA@@@AAA@A@@@@A@@@AAA@@@@CAA@CAA@A@@AA@@A@@A@@AA@@@C@@@A@@@@B@@@B@B@@@B@BA@@BAD@@BB@DAB@BA@@B@@A@A@@@A@@@A@@BAB@@A@AB@@@@A@AA@AA@@@A@@AA@@@@@AD@@@B@@@BABA@CBAB@B@@A@@BCB@@@D@B@B@BABCD@@@B@B@@@@B@@@B@@@BD@@"],encodeOffsets:[[121086,26043]]}},{type:"Feature",id:"350581",properties:{name:"石狮市",cp:[118.648066,24.732204],childNum:7},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@B@@@@@@@@@@@B@@@@A@@A@@@@@@@@@@@A@@@@@@@@B"],["@@@@BB@@B@@@@A@@@@@@@@@@@@AA@@@@@@A@@@@@@B@@@@"],["@@@@@@D@@@@@@A@@@@A@A@@@@B@@"],["@@@@@@BBB@@@@@B@@@@@A@@@@A@@A@@@A@@@"],["@@A@@B@@B@@B@@B@@A@@@@@@@A@@@@@@AA@B"],["@@B@@BB@@BB@@@@@@AB@@@@@@AAAA@@@A@@@@@A@@@@@@@@@@B@@"],["@@A@@@@@@@@B@@A@@@A@@@@A@@@@@AA@@@@@@@@@@AA@@@AA@@@@@AAA@@@@A@@@@@A@B@@@A@@@@AA@B@@@@@@AA@@@@@@@@A@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@A@@@@@A@@@A@@A@BAA@B@@AAB@A@@@A@AB@@@@@@AA@@@B@@@B@@A@@A@@@BA@@@@@@@@@AA@B@@@@@@AAAA@AA@@A@AAA@AA@@@@@@@@@@@@@@@A@@@A@@@@@A@@@@@@@@A@@@@@BA@@@A@@@@@@@@@AA@B@@A@@B@@CBB@AB@@@@@@A@@@@@A@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@BA@@@A@@@@@A@@BB@@@AB@BABB@@@@@@@@@B@@B@@@@@@@@@@A@@B@@@@@B@@@A@@@B@@@@@@@@@@@@@BA@@@@@A@@@@B@@@@@A@@A@@B@@B@@@@B@@@@@@@@@@@BCDABAA@@A@@@AB@@ABA@C@@@@A@@A@@B@@BB@@AB@D@BAB@@@@@B@BB@@BB@@@@@@BB@@BBD@@@B@@BB@@@@BB@@@@ABB@@B@@@@@@@@@@@B@@@@AA@@@BB@@@@BBA@@B@B@@B@@@B@B@@B@@@B@@@B@@BB@@@@BB@B@AAB@@@@@@@@@@A@@B@B@@@B@@A@@@AB@@@@BB@@@A@@B@@@@@@@@@B@@@@B@@@@@A@@@@B@@@@@@B@@@BB@BB@B@B@@B@@DB@@@BBB@@@@@@@@@B@@@@@@@@B@@@@@@@@A@@@@@@BA@B@@@@B@@@B@B@BB@@B@@@@@B@@@@@@@BA@@B@@@BA@@B@@@@@@B@@B@@@A@@@BB@@@@@B@D@@B@DAB@BB@@BBB@@B@@@DBB@BABADB@@@@@AD@@@@BB@@@@@@CDABDB@BAB@@ABABDDC@DDDB@ADC@A@A@@BA@@@AD@DB@@B@B@@@@@B@@@@B@B@@BD@@BBD@DBBB@@BBBBB@B@DB@@B@BDBB@BAD@BFBNBBF@BHCHCJ@FBPHBBB@BADAB@JCDAF@FBF@FDBB@DCJ@BBDFJJDV@DEBCEMAE@A@EBGDKDGFCDAHAHAF@HBF@@@BA@@B@B@@BB@@@BAB@BABAB@B@B@B@B@BBB@B@DAD@@A@@B@@@B@B@BBB@D@B@B@@A@@@AAA@@AAA@@A@A@AD@@CEG@A@@BABC@@@A@A@@CCCKACAE@CCEA@AAA@A@A@A@@@AAECAC@@@A@@@A@@@A@@@A@@@A@@@@@A@AA@C@CA@@A@@ABA@A@@GGCACAABGHABA@A@A@AAAAAA@CAADAF@HBBA@AOOA@@@A@@@@@A@@@@A@@@A@@@@@@A@A@@@AABE@@@A@@@A@@@AB@@@FBBA@ACAAC@AACA@CAE@AAAABAACCACB@BABA@@AAA@@@@@@@AB@@@B@@@@@@@@@@A@@@@@@@AB@@@AA@@@AAA@@AA@A@CCA@@A@A@E@A@@@CCAA@AA@A@A@AA@@A@@A@AAACACCCAA@MCA@E@G@@@CBEBGFEHCHCJICGN@B@@@B@BABA@@BBB@@@B@@AB@@@@@B@@@@@B@@@@@@@B@@B@AB@@B@@@@@B@@@A@@BB@@@BBB@@@A@@BB@@B@@@@@@@B@@@@@@@@@@@B@@@@@BA@B@@@@@@BAA@@@@@@@@@@@@@@A@@@BBA@@@@@@@AA@@@@@BA@@@@B@A@B@@A@@@@@A@@@@@@@A@@BA@@@@@@B@@@@@@@@@@A@@B@@B@A@@@AB@@B@@B@@@@A@@@@@B@@B@@@@@@@B@@@B@@A@BB@A@B@@@@@B@@@@@@@@@@@B@@@@@B@B@@@@@BA@@A@@@B@A@BA@@@@@@@@@A@@B@@A@C@@AA@@@@@@@@@@@@A@@@B@@@AA@@@@@@@@@@A@@A@@@@@@B@@@@@B@@@A@@@B@@A@@@@@@@@@@B@@@@@@@@@@A@@@AA@@@@AB@@@@A@B@A@@@@@@AA@@B@@"]],encodeOffsets:[[[121552,25256]],[[121609,25377]],[[121535,25397]],[[121606,25376]],[[121624,25406]],[[121555,25259]],[[121488,25314]]]}},{type:"Feature",id:"350582",properties:{name:"晋江市",cp:[118.551682,24.781636],childNum:10},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@BB@@@"],["@@@@BB@@@AB@@@A@@A@@@@@B@@@@@@A@@@"],["@@@BB@@@@@BA@@@@@@A@@@A@@@@@"],["@@@BB@@@B@@@@A@@AAA@@B@@"],["@@@@@@B@@A@@A@@A@@@@A@@@@B@@@@@BB@"],["@@@@@@@A@@@@@BB@@A@@@@@@@AA@@@@@@@@@@@@B@@A@@@@@@A@@AB@@B@@B@@B@"],["@@@@B@@@BB@@@@B@@@@A@@AAA@A@@B"],["@@B@@@BA@@@A@@@@AA@@@@C@@@AB@@@@@BBB@@B@@@"],["@@AB@BABABCB@@CBB@ABBBBB@@@B@@@@@B@B@@DBB@@BB@@@BAFFBBB@B@@@@@B@@@B@D@B@@@@BB@B@@A@@B@@@B@BD@@@@@@DB@@@@FE@CFC@@@A@ABE@@@ABABCDABAF@DABAD@BAD@FAB@@@CCAAAA@AA@@@A@AA@@AA@@@BAA@@BA@@B@@@@@A@@A@@@@AAA@@@@@A@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@AB@@@@@@@@A@@B@@A@@@@B@@@B@@A@@@B@@B@@@@@@AB@@@BB@@B@@@@CA@@AAA@A@@@@@AA@AGABAA@@BA@@A@CCAAAAAAAAC@@AA@A@@B@@@@@@@B@@A@@@A@A@@BE@A@@@C@@@A@@@A@A@@CA@@@@B@B@@@BA@CB@AA@ABCAB@AA@@B@@A@A@@@AA@@@@@@@@@A@@@@@A@@AA@@B@B@@AA@@A@@AAB@@@A@@@@A@@@A@@@@@@@@A@@@@@@@@AB@BA@@BA@CB@@A@@B@@A@@@AA@@@@A@@@@A@B@AGBC@@B@@@B@@@@@BAA@B@@@@@@@B@@@@A@AA@@AB@AAA@@@@A@@@A@A@A@@@@AA@@A@AA@@@E@GB@C@@@@A@@@@A@@A@@@@@@@@@A@@@@@A@@@@@@@AB@@@@@@AB@@B@@@@@@@@@@@@@@@@@@@@@@B@A@B@@@@@@B@@@B@BB@@@@@@@@@@B@@@A@B@@@BB@@@@@@@B@@@@@@@BB@@@@@BB@@@B@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@B@@@@B@@A@@B@@@@@@ABB@@B@@@B@@B@@BBED@B@@@@A@@@@AAA@@A@@@AA@@@@A@@@A@@B@@AA@@ABB@ABB@@@@@@B@@@@@@@@A@@BA@C@@@@@@@@B@@A@@@@@@@@B@@@B@@@@@@A@BB@@@@@@B@@@@@@@@B@@@BB@@A@@@@B@@@A@@BB@@@BB@@@@@@@BB@@@@B@@@@A@B@@B@@@@@@@@B@@@B@@@B@B@@@BB@@DBD@BAACB@AA@@@AD@@AD@@@@A@@BA@B@@@@B@@B@@@@B@@@B@@@@AB@@@B@@@@AB@@@@@B@AAB@@BB@@@B@@A@@F@@@@@@BB@@@@AB@@@@@B@@AB@D@@LD@@CB@@A@@@EB@@@BBB@@J@BAFBH@@BBB@@@BB@A@BC@A@A@@B@D@@@@@@B@C@"],["@@@OBOBIBCBEDCBC@ADADAD@D@B@BB@DDFBHDBJBB@@@BA@A@AB@B@B@@FBBDABC@ABCAMBO@C@E@AAC@@CEACAA@C@C@C@AAC@AEOCGECCCE@E@EAEACACCACAC@CBC@A@C@ABGAAG@EBIACCGE@CGEGAEECEAA@AA@CBC@@AEMACCGIEMEKAMAGGICCACACBEBCDE@KAEBADFFFFFFAJ@H@FAD@DAFOHMFEFAH@DBDBDDDDBJBDBBF@FCFQRKJQNADGFKJGFMDUFIBA@E@[FG@WBeAEP@BAB@D@F@@@J@L@@@J@DBJDNBDBDFHBFAJEFAL@H@FDJBF@BBH@BB@@@@BAB@B@B@BA@A@@@AB@@@B@BA@@B@@@B@@BB@@@F@@@@ADA@@B@B@BGH@B@BAB@@@BA@AB@@AB@@@@@@ABB@BB@@@BBBA@@B@BAB@@BB@B@@@@@BBBBB@@@B@@@BB@@B@@@BA@A@A@@@@@CBC@@@@BD@@D@@@B@@B@@BAB@@A@BB@@@@A@A@@@@@ABA@@@A@@@A@@@@@A@@@ABA@A@@@@@@@@D@@A@@BBBB@BD@B@@@@@@@BB@@@@@@@@@@@@BDBB@@@B@@@@@@BA@@@BBBA@@B@AAB@@@@@@BB@B@@BAB@@@D@@ABGF@@@@@BB@@B@@@@@@C@ABA@@A@@AAAA@@@@A@A@@A@@CBA@A@AB@@A@@@AACB@@A@ABBB@B@B@BBBBB@BB@@@@B@@B@@@D@BB@B@@B@BBBBB@@@BB@BB@@B@@@BB@@@@@BB@@@@B@@B@@@@@@@B@@BBA@@B@@@@@BB@@@@@B@@@@@BABA@@FAB@@@@@@@HHAB@@A@@@B@@B@BADB@@@@B@@@@@@@B@@BB@B@@@BBBBB@@@B@@BB@BBB@@@B@BB@@@@BABAB@BBB@@@B@@@B@@@BA@@BC@@@@@AFAB@BAB@B@@ABA@@@BB@@@@D@@@BB@@BB@@B@@B@@@B@B@@B@@BA@@@A@A@A@B@@@@D@@@@@@ABA@@@A@A@@@BBBB@@@@@@AB@@@B@@AB@@BB@BB@@@BA@@@@@A@@@@B@@@@@@@@A@@B@@AB@@B@@B@@@@B@@@@@B@@BA@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@BA@@@BB@@@@@@@@@@@B@@@@A@@@@@@BCB@BBB@@@@@ABAB@@ADB@@B@@@BB@@@@@@BBA@@B@B@@AB@@@B@@@@@B@@@D@@@@@B@@@@@B@B@@@@@B@@@B@@@@@BBAB@@B@BB@@@AB@B@B@B@@@@@B@@@@@@B@@B@@@@@@@@B@@@@@BB@DAB@B@@AB@@@@@BBBB@B@@AB@B@@@B@@@@B@@AB@@@BBB@@A@@BBB@@@@@@@@@@@B@BB@@@AB@BB@@BB@B@@@D@@@B@BA@@BC@@B@@@@A@@B@@BB@@C@@BA@ABA@@BAD@D@@CD@@@@@@@B@@@B@@@@@BB@@@B@BD@@BB@@@@@BBBABBB@@BBB@@@AD@B@B@BBB@B@B@@@BA@@B@@A@@B@@@BB@ABA@B@@@@@B@BB@@@@B@@@B@@@B@@@@B@@@@@@A@@B@@@@@@A@@B@@B@@@B@B@@@B@B@B@B@@@@@B@B@@@@A@@@@@@B@@A@@@@B@@A@@@@@@DA@@@A@CBA@@B@@AB@B@B@@@B@@@@B@@@B@@@B@B@AB@@A@@@@BA@@BA@@B@BA@@BAB@BA@@B@B@@@DABCBEB@DC@ABA@A@ABC@@BB@B@@B@@@@A@B@BBA@@@@@@B@@A@@@@B@@@@@@BBB@BBB@@B@@@BB@BB@DB@@BABAB@@AD@@AA@@@@A@@B@B@B@@@@AB@@@@@@@@AAA@A@@B@@@@@@@CAA@B@@A@@@@@@@A@AAAB@D@@AA@@AA@A@@@A@@@@@B@@AD@G@@ABB@A@@B@D@B@@AB@A@@@@A@@B@@@@@@@@@@@@A@@@@@@@@A@@@@@@@A@A@@BA@A@@B@B@@A@A@@@@@A@B@@@@@AA@@@@B@@A@BBA@@@@@@@@@@@@A@@@@A@@A@@@@@@@A@@@@@@AA@@@@A@@@@@@@@@@@@@@@BA@@@@A@@@@@@@@A@@@@@@B@@@A@CB@A@@@A@@@A@@@@@@@@@@AA@B@@@@@@A@@A@@A@@@@@@AA@@A@@AB@@@A@@@@@@BA@@A@@@A@@@@@@A@@@@@@@AAB@@@@@@@@A@@@A@@@@@@B@@@@A@@@@@@D@B@@AB@@@@@@@@@@A@@@@A@BAA@BA@@BB@@@AB@@@B@@@@@BB@@B@@@BB@B@@B@@@@@@AFCAA@@@AA@@@A@@@AA@B@@@@A@@@@BA@@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@A@@AA@@@@A@@A@@@@@@@A@@@@@@AA@@A@B@@@A@@@@@@@@@@@AAA@@@A@@@@@@@@A@B@A@@@@@@@@@@@@@@@@@@@@A@@@BA@@@@@@BA@@@@@@B@@@@@B@@@@@@@@@B@@@@B@@B@@@@@AEAED@B@BBBB@@@@HB@H@@B@@B@BB@@B@@B@B@B@@@B@@@@@BB@BBA@@BBB@@@@@@A@@@@@@@ABB@A@@@@@A@@@AD@HA@B@A@B@@B@@@@@BB@@B@@@@AB@@@DAB@@AB@@ABA@@@@@@@@@B@@@@@@B@@@B@@@@@@B@@BA@BB@@@BB@@@A@AB@@BB@@@@@B@@@@@@@@@B@@B@@@B@BA@@@BBA@DBBAB@@BDAB@@A@@@A@A@@B@@DB@B@@@B@@@D@@@B@F@@AB@B@@@B@@@@A@@@@@@@AB@B@@BD@BBBBBBBBDDB@@@AB@@BBBABHB@@B@@@@@BBB@BB@@D@@A@@@AA@@A@@B@@@@A@@@@A@@@BA@@@A@@@@@@BA@@@@B@@@@@@A@@B@@@B@@@@@@@@@@@B@@@@@@@B@@@@B@@@@@B@BB@@@@@BB@@@@@A@@@AB@@BB@A@@BB@@BBB@@@B@@BBBBBDD@@@@DAD@R@@@D@B@DAHAB@F@F@B@BADABABABADIDG@CFMC@ACAA@A@CAG@E@C@CBGBGBKDKFEJAHCFI@AAEMAEA@ABC@AAAACA@@@CAA@A@AAAA@@AACAC@AA@@AC@@@A@A@@A@@@@@A@A@@@CAC@@B@@AB@@@B@BCD@BCACCD@CCBABA@@BA@ACABADC@@@@@@AA@@@@BC@@@@A@BCBA@AAA@C@@@AA@AA@@AAA@CBA@@@@C@A@@@@AA@@B@@@A@@@@A@@@@A@@@AB@@A@@@AB@@@@@@A@@@@@A@@@AAA@A@@@A@@@@@@AAB@@@@@@@B@@@@@@A@@@@@@@@@@A@@@@@@@@AA@A@@CA@@@AA@A@A@@AAA@@A@@@@@@@@A@@B@@@@@A@@@@@@A@@@@@@@@@AB@@@A@@A@@A@@B@@@BA@@@A@A@@@@B@@@@@@@@A@BBA@A@@A@@A@@AA@@@A@@@A@@@@A@A@@@AA@A@@@AB@A@@A@@A@@BB@@@@@A@@@@@@@@@@@AA@BA@@@@AA@@@@AA@@@A@@AC@AA@@A@@@@A@@AA@@A@A@@@@BA@A@CBA@@AA@@@AB@@@@B@@D@B@BA@@BA@@B@@@BBBADC@A@@@@@@@@@@@A@@A@@@@AB@@@@B@@@@@A@@B@@@@@B@@A@@@@@@@@@@@@@A@@@B@@@A@@@@@AB@@@@@@@@@@@@AA@@@@@@@@@A@BA@ABA@@A@@AB@@@@@B@@@B@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@B@@@@@B@@@@@@@BAA@DA@@@AB@@@@ABB@@@@@@@@B@@@B@@A@@@@@B@@@@@@B@@@@@B@@@B@@@@@@@@@@@@@@@B@@BBB@B@BB@@BBBBB@@@@@@@ABB@@@@@@@@B@@A@@@BB@@@@A@@@A@@BB@@@@@@BAB@@@B@A@BB@@@ABB@A@BB@@@B@@@@@B@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@B@@@@@@B@@B@@@@A@B@@B@@B@@@A@B@@@@@B@@@@@BB@B@@@@BB@@B@@B@@@@@@@@B@@B@@@@@B@@B@@@B@@@@A@@@@@@B@@@@AB@@B@@@@B@A@B@@@@@BA@@@@BB@@B@@@@@@@@@@@@A@@@@@@@@B@@@@A@@@B@@@A@@@@@A@@@@B@@@@B@@@@@@@@B@@B@@@A@@@B@@@@@@@@@@B@@BD@B@@@@AB@@@@@@@@@B@@A@B@A@@@BB@@A@@@@@A@A@@@@@A@@@@@@@@@@@A@@@@@A@BAAB@@@@A@@@A@@@@@@@AA@@@@@B@@@@@@AA@@@BA@@B@A@@@@AB@@@@@@@@@@@@A@@@@B@@AB@@@@@@@B@@@@@B@@@@A@B@A@@B@@A@@@@BB@@@@@@B@AA@@B@@@@@@@@@@@@@@@BB@A@@@@A@B@@A@@@@@A@@@@@@@@@@@A@@@@@@@AA@@AB@@@A@AA@@A@@AB@@@A@@@@@A@@@BAA@@@@A@@@@@@@A@@@@@A@@@@BA@@@A@@AA@AB@BA@A@A@@@AHMCAECECCGCQAM"]],encodeOffsets:[[[121539,25232]],[[121359,25192]],[[121401,25127]],[[121505,25152]],[[121506,25149]],[[121483,25132]],[[121549,25420]],[[121508,25224]],[[121411,25470]],[[121501,25246]]]}},{type:"Feature",id:"350583",properties:{name:"南安市",cp:[118.386279,24.960385],childNum:4},geometry:{type:"MultiPolygon",
+coordinates:[["@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@"],["@@@B@@@B@@@@B@@@@@@@@@BA@@@@@@A@@@@@A@@A@@"],["@@@@@B@@@@@B@@@@@@@@B@@@B@@BB@@A@@@@@A@@@A@@A@@@@@A@@A@@A@@B@@"],["@@GEGCICUKAAEASBI@AB@FAJCD@@AB@@A@AA@@@@ABA@ABCB@B@@AB@@@@@@A@@B@@@@@@@@@@@@@B@@@BA@@@@@@B@@@@@B@@@@@@@@@@@B@@@@BB@B@@@D@@@@@B@@@@@@@BBB@@ABBB@B@@AB@@@@@@CCB@A@@A@A@@@@@AA@@A@@A@@A@@A@@@AA@@@@AA@B@A@@AA@@A@A@@@@@CBA@ABB@A@@B@B@@@@@@@@@B@B@BBB@@@B@@BBB@BB@AB@BABB@@@BA@@B@@@@BBB@@B@@@@@B@BBBA@AD@@@@@B@@@BB@B@@AB@B@@@@@@@BB@@@@BAD@@@@@@@BA@DB@@@@B@@@B@@@@@BA@@B@@AB@@B@@BB@@B@@@BB@@BB@@B@@@B@BBB@@AD@@AB@BA@@B@@@@@B@B@@@B@@@@CD@BABDBB@@BBB@@BB@@@BB@B@@@BBB@BB@B@@@BAB@@@B@D@B@@@D@B@BBBBD@@ABA@@B@@AB@@A@CB@@@B@@@BA@A@AB@@A@@@ABA@@BABAC@@AB@B@@@BA@@@@B@B@@@B@@@@DBBB@@@B@B@@@BA@A@@@@@@@@@@@AB@@@B@@A@@AAA@B@B@@A@@@@@@B@@A@@@A@@BAAA@@BA@@BAD@@AB@@@AA@@@A@@BA@@@A@@@@B@BA@@@@@AB@@@@@B@@ABA@BF@@BB@@B@@@@@@@BB@@BD@@BB@@@@@BBBBB@B@@AB@@B@D@@@@@@B@@@B@B@@@B@@@BB@@@B@@BB@@@@B@BB@@@@@@@@@B@@BB@@@@@BB@B@@@@@@@@BB@@BB@@@BBB@@@@ABE@@@@B@@A@@@@B@@@B@@@@B@@B@@@BABABAA@BA@@@B@@@@@@BAB@@A@@@A@A@AC@@AB@@@BA@@@A@AA@A@@@@@AA@@B@@@@@B@@A@@@@@@@@@@BB@@@BB@@@@@B@@@@@BA@@@@@A@@@A@AA@B@@B@@B@@B@@@@@@B@@A@@BA@CB@@@BBBB@@@@@@B@B@@@B@@@@@A@@A@@@AB@@@BA@@BA@AD@@@@@@@@BB@@@@@B@@@@@@@@@BB@@@B@@@@BB@@@B@D@BA@@@@B@@@@@@BB@@@@@@@@BBA@B@@@@@@@@B@@@@@@BB@@@@@BB@@@@@A@AB@BABA@A@@BBFB@B@@@@AB@@@@BB@@@B@@@D@@@B@BBBB@BB@@AB@@@@B@@@@@B@@@BB@@A@A@ABA@@B@BAD@@AB@@@BB@B@@@@BBBBB@BBA@@B@@@B@@@@A@@@@@@A@@@@@@@B@@A@@B@@@@A@@@@B@@A@@B@@@@@B@@@@B@@B@@B@@@@A@@AA@@@@B@@@@@@A@@BB@@@B@@@@@AB@@CB@BB@@@B@B@@@@@B@@@@@@@AB@@A@@B@BA@@BB@AB@@@@@B@@A@@@A@A@A@A@@@A@@@@B@AAA@@@AACBACAABCBA@@BA@@@AB@@@B@@A@@@A@@@AB@@@@A@@@@@@BAB@BA@A@BB@@B@DB@@@BBB@@@BABB@BB@@@@@@B@@@AB@BA@A@CB@B@B@@BB@@BD@@A@A@AB@@A@@@C@AB@@@B@@@D@@@@ABABAB@BA@A@CB@@EBAB@@BD@@@@AB@@AB@@@@A@ABABA@@@@@AAABB@AB@@ABA@B@@BB@BD@@@B@@BB@B@@@B@@@B@@A@@@A@@@@@A@@BCB@@A@@BA@@@@BA@A@@@A@A@A@AB@@AC@@A@A@@@A@@B@@@B@@@@@BCBA@@BA@A@@@AA@@@@A@@@A@@@AA@@A@AAA@@@AB@@AB@@@AA@@AA@A@@@AA@@@@@AAA@@AA@@@@@@AA@@@@@AA@@@@@@@@B@@A@@@A@@AA@A@@BA@@BA@@BAB@B@@AAAA@@AA@@A@@@A@BB@@@@AB@B@BA@@BA@@BA@A@@@@@A@@@@AA@AA@@A@AAB@AA@@@A@@@@@A@@@A@@A@@@@@@A@@@@A@@@@@@@@@@@A@AA@@@@A@@A@@@@C@A@CB@@ABA@A@@@@@@B@B@@@B@@ABA@A@@@DB@BA@@B@@AB@@ABA@@B@@@@AB@@AB@@@@@BAB@@@BA@@B@BB@@BABB@@@@@B@B@@@BB@@ABAB@@AB@B@@BB@@B@@B@@@@@@@@AB@@@@A@@@@BA@@A@@A@@BA@@@AB@@@@@@A@@@@BA@@@AB@@@@A@A@@@AB@B@@@B@@A@@@C@@@CD@@@BA@@DABCA@@@@A@@A@@AB@B@@A@ABCB@@@@ABA@A@@@ABAB@@ABAB@@AB@@BB@BB@BD@B@@@@BB@@B@@BB@@B@B@@BBB@B@B@D@AB@@AD@BA@AB@@ABAB@B@@@@@@@BA@A@@BAB@B@@A@A@@AA@@@CA@B@@A@@B@@CAA@ABA@@BA@@@@@A@@@@@AC@@A@A@C@@@A@AB@B@B@@CBABCB@@AAA@@@A@A@@@@@A@@@@@A@A@@@A@@@AB@@CACA@@ACAA@@@D@F@@@BA@EDABA@@@@B@@BB@@AB@BB@AB@@CB@B@@@@@B@@@@A@@@A@@BA@@D@@AB@@ABA@A@@@@@C@A@@@A@@@A@AB@@@@@@BB@B@@BBAB@@BB@@DDA@@D@@@@@B@@BB@B@BABCBB@AB@@A@@@@@@J@@@BBBB@@B@B@BB@BBAB@@@BB@BB@@B@@@@@BB@@@B@@@B@BB@@@@@AD@B@@AB@@@B@@@@A@@@BBB@BB@@@B@B@DBF@B@B@BBB@B@B@@@@@B@@A@@B@BA@@B@@@BB@@@@B@@@B@@B@@@@@@@BB@BB@@@B@B@B@@@@@@B@B@@@@@B@BA@@B@@@@@BB@A@@B@@A@@BA@@B@@@B@@@@@BBBDF@B@B@B@B@BAB@B@@@B@@@B@B@@@BA@@B@BA@@@AB@@@B@@B@DD@@B@@BB@@ABAB@B@DAD@@AD@@AD@BAB@B@@@B@B@B@BB@@@@@BBBBBBB@@B@@AB@@@B@B@@@D@@@@BB@@BBB@B@B@@BB@@@@@@BB@@@A@@@@@@BABADABABA@@D@BAB@FAB@@@@A@ABA@A@@@@@@B@@@DC@@B@B@@@DBBBBB@@BBFFBCB@BAB@B@BAB@B@BA@@B@B@B@B@B@@BB@@BBB@@@@@@@ADA@@B@B@@ABA@@BA@@B@@@B@B@@@B@@@B@@@B@@AB@@@@@@AD@@@B@@@@@B@@@B@@@@@B@@@B@@@@@@A@@B@B@@@B@@@@@B@B@@BB@@@@BB@@A@@BBB@BA@@BA@@B@@B@@BA@@B@B@@@@@A@@A@@BA@@B@B@@@@@B@@@B@AB@@@@B@@@B@@@B@B@@A@@@@A@@@@@@@B@@@@A@@A@@@@A@@B@@@@A@@@@B@@BB@BBBD@@BFBBBB@@@B@@@B@B@B@B@B@B@@B@B@D@D@B@BB@@@B@@AB@BABGF@BABCDABA@@BCBAB@@@@@@AB@B@B@BBBBBBBBB@@@BAB@@B@B@B@DA@@DF@BDD@@@B@@@BBB@B@B@@D@@BABBB@@@B@@@BABA@B@@B@BBBDDBBBB@@@@@@@@@B@@@@@B@@@BB@@@@@@B@ABB@@@@B@A@BBDABAB@@@@@@D@B@@@BBBBB@@B@B@@DBBBB@BBBB@@@ABABAB@@AA@@C@@AA@@@@AA@@@A@C@AA@@@@A@@@@@ABAB@@A@CA@@@AA@A@A@ABAA@A@@@@A@AB@@AB@@AB@@C@@BA@@@@@A@@BAA@@A@@@@@@@@@@B@@B@@@@@BB@@@@@B@@@BB@@@@@AB@@ABC@@@@@AA@@A@AAA@A@@@@@B@@AAA@@@@A@@@@AA@@@AA@@@A@@A@A@@BA@@BA@@@ABABAB@@A@A@A@@BA@@@@D@@@B@@AB@@@BAA@BA@ABBBAB@BA@@@A@A@A@A@ABA@AB@@A@@@A@@@@@@@@@@@@@@B@@@@ABBBA@@BABA@@BA@@B@B@DA@@@@BA@@D@B@B@BBB@BBB@B@B@B@B@@BB@B@@@B@@@@@D@B@D@BABBB@@@@BB@@@@@BB@B@BBD@BBDBD@B@B@@@@@@B@BE@@@@DDH@B@@@@A@@@ABB@@@AB@@A@@BAB@@AB@@@@@BB@@@@@@BC@@BA@AA@@@@A@@@AD@@A@@B@@AA@BAA@@@B@@@B@B@@@@BBBB@BABA@@@A@A@@@A@@@@AA@@@A@@@@@A@@@@@A@@@@B@BA@A@@B@B@B@@@@A@@@@@A@@BAD@@B@AB@@@@CA@@@@@BBBA@@@A@A@@AA@@@@BA@A@@@ABE@@@A@A@A@@@A@@@A@A@@@@@A@@@A@@@@@AA@@@@A@A@A@@@@@A@@@ABCBAB@@@@A@@@@@@@A@A@AC@@@A@@ABA@AB@B@BAA@@AA@@@@A@@AAB@@@@BB@@A@@@@@@BA@@B@@@@BB@@AB@@@BC@@@@@@B@@@@@BAA@@A@@@@B@BBBA@A@@@@B@@A@AAA@@@ABA@AD@@A@@@A@@B@@@B@@A@@@@B@@@@D@@@@@@B@@@@A@@B@@@@@B@B@@CAA@@A@@AAB@AAEDAB@@@BBB@@BB@B@@@@B@@@@BBB@@A@ABABBB@B@@AB@@B@ABB@@@@@BBB@@@@AB@BB@@B@BBB@@B@@BB@B@BBB@@@@BB@@@@B@B@@@@B@@@@A@@B@BB@@@@@@B@@B@@@@B@@B@@B@@B@@@AB@@A@@@A@A@@B@@A@@@@@BB@@@@A@@@A@A@@@@BA@AA@@@@A@@B@@@@@@AB@@@@B@@B@@@@A@@@A@@B@@A@@@@@A@@@C@@@@@@BA@@@ABABAB@@A@@@@B@@@BABAB@@A@C@@@@BA@ABB@@BB@@BB@@B@@@@AB@@@DAB@@@B@@@BAB@@@@@B@@@B@@@BB@@B@@BB@BB@@B@@@@BA@@B@B@B@@BB@@@@B@BAB@@AB@@@B@BB@@B@BA@B@@B@@@B@@@B@@B@BBDB@@@@@BA@@@@@@@A@@@@BA@@@@@@@B@@@@@@@ABB@@@@@B@@BA@@AA@A@@@@B@@B@@@@BBABB@@A@@@@@@@@BB@@@BBA@BBB@BAB@D@@AB@@B@@B@@B@@B@B@@@@@FBBBB@@@@@@AA@@AA@@@BA@@BABA@@AA@A@A@@@ABA@@@@@A@AAA@@AA@@@@A@@A@@@@@A@@@@@@@A@@B@@A@@B@@@@@BB@BB@B@@@@@@A@@@@@A@@BA@@@@@A@@BB@@@ABAA@A@@@BA@AC@@@@@@@@@@A@@@@A@@A@@A@@A@@@@@@BA@@AA@@@@AA@@@A@@@@D@B@@@B@@A@@@@@AB@@A@@@@@A@@B@@A@@@A@@@A@@B@BA@@@AB@B@@@@AB@@@B@@@BABA@@B@@@B@@@@A@@@@F@DA@@@A@@@AB@B@@@D@@@B@@@B@@@BA@A@@B@@@B@@@@@B@@BB@@BB@@@FA@B@@@@BB@BB@@BB@@BB@B@DBB@@B@@@@BD@@@@@@BA@@B@@@@AB@@@@@@BB@BBBBB@B@@@ABAD@@@@BBB@@BB@B@B@@@@@B@@@@@B@B@@@@@@AAA@AHA@@B@@@BBBB@@DA@@@@B@@@B@@@@@@@BA@@@@@@@@B@@@@@@AB@A@CC@@CC@@@@B@@@B@B@D@@AB@B@B@B@@@B@@A@@@@B@@AB@@@BB@@BAD@@@B@@AB@B@@@@AB@@@BA@@B@@@BDB@@BB@B@B@@@@@@@AD@BB@@BB@@@@@B@B@@@@@@@AB@@@@A@@@@@@@AB@@@@B@B@@@@DBB@A@@@@BA@@@@@@@@B@@A@@@B@@@@@BB@@BBA@@@B@@@@A@AB@@B@@@C@@@@@@BA@@@@@@@@@A@AB@@@@@@@@@@DB@@ABA@B@B@ABA@@@@@@B@D@@A@@@A@@@A@@AA@@@@@@A@@@@@@A@@@C@BBB@@@@@@@@B@@@@@@B@@@@@BB@ABB@@@@A@@@@@@@@@@@B@B@@B@B@@@@@BA@AB@@@@@@A@@@AB@@@@A@@@@@@@@@@@@B@@A@@B@@@@@B@@AB@@D@@A@@@AB@@@@@B@DD@@BA@@@@AA@@@@AAA@@A@@@@@@BAB@@@@@B@@@@@@AB@@@@@@@@@@@@@B@@@B@@@@@@B@@@@B@@B@@@@@@@B@@BBD@@@@A@@@@@@@@B@@@B@DA@@@A@A@C@@@AB@@@B@DA@@@@AACA@@@@@@BABC@B@B@BBB@@B@@@B@@B@@@A@A@A@@@@AAB@@@BBBB@B@@BA@@@@@BB@@@@@B@@@@@@AB@@B@@@@@B@@A@@@@@@@@BB@B@@A@@B@AB@B@@@@@B@@@B@@@BBB@B@BB@@B@@@@ABA@@@A@@@@@A@ABBB@@@@@@B@ABB@BB@BB@B@AB@B@@BBBB@@B@@@B@@@DB@@AB@@@B@@@@BB@@@@A@@@A@AA@@AB@@@@@B@BB@@BB@@@@BB@@B@BA@@@BB@@B@@B@A@@@A@@@A@@BA@A@@BA@@@@B@B@B@@@BAB@B@B@@BBB@B@@B@@@@B@@@@@BB@@B@@@B@B@@@@D@BBBAB@@@AA@@B@@AB@@A@AB@@A@A@AB@B@@@@A@AB@@@B@B@@ABA@@@@F@@@DBB@B@@@BBBB@@@@B@B@BBB@B@B@B@D@B@B@B@B@@@@@@@@A@@@ABA@A@A@@@@D@BAB@@@@@BA@@@@B@B@BA@@DBB@BA@@@@B@BA@@DBB@D@BAB@BAB@@BB@@BB@DB@@@@BB@@B@B@@B@@@B@@B@B@@@B@@@D@B@D@B@B@B@BB@@@@@@AB@@@@@@@B@@@@@BB@B@@@@@@BB@@B@B@BABB@@@@@@@@B@@@BB@B@@@@@@B@@@@B@DB@B@@BB@@BBB@@@@BB@@@B@@@@A@@BB@@@@B@@@B@@@@@@@@@@@B@@@@@@B@@@@@BB@@A@@BBB@@B@@@@B@B@@@@@B@@@@@@B@BB@@@@@@B@@@@@BB@@@A@@@@B@@@@@B@BBB@B@@@@@@@@A@@@@A@B@@@@A@@@BBB@@@BB@ABBB@@@@B@@BB@@BB@@@@@BB@@@B@@BB@@@@A@@B@B@@@@B@@@BB@@@@@@@BA@BB@@@@@B@@@@AB@@@@@@@@@@BB@@@BA@@@@B@@@B@@@@@BAB@B@@@B@B@B@B@B@@@AB@@B@@@B@@AB@@B@@@BAB@@BB@@@@@@@AB@@@B@@@B@@@B@@@B@@B@B@@B@@BBB@B@D@B@@BB@@BFB@@@@@@@@@@A@AD@@AB@@@@@B@@A@@@@@@@@B@B@@CB@@B@@@BB@@BBB@@@B@@@@B@@@@@@@BD@@@@B@@@B@@@BB@AB@BA@@@@@AB@@A@@@A@AB@@@B@@@B@@AA@@@@@@A@@B@@@@@@@@@@A@@@@@@@@B@@@@@BB@B@@B@DA@@@@@A@@AAB@@@@BB@@@@A@@BB@B@@BB@@@@DA@@BC@AB@@A@@BA@@BB@B@@@@@@@@@A@AAA@AB@@@F@B@B@@@B@B@@@@A@@@@@A@@@@BA@@@@BABA@@B@@A@@BBBB@@BD@@@@@B@B@@@BB@@@BB@B@@@B@@@BDDB@BADB@@B@B@@@B@@@@B@ABB@@B@@@@@B@B@@@@@@AB@@@@@BB@@BDB@@@@@B@@BB@@@BB@@B@@B@@BAB@BDB@BBBB@B@@B@@@B@@@@BB@@@B@@@BAB@BADA@@DCBBBB@A@A@A@@BC@AB@@AB@@@BA@@A@@@@A@ABA@A@@AA@@@@@ABBACAA@@BA@@B@B@B@@BBB@@B@@ABBB@B@BABB@@@@@A@@@A@@@AB@@@@@@@BA@@@@@AB@@@@@B@B@BDBB@@BAB@D@B@BBB@@@B@B@@AB@DBDAD@D@@@B@B@@@@@B@ACBABA@A@@AA@@A@@@@@A@@AA@@AA@@@@A@@AA@@@A@@AA@@@@@A@@BA@@AAB@@A@@@@@@@A@@@@@@@@@A@A@@@@@@@AB@@A@@@A@@@A@@B@@@@@B@AA@@@C@A@@AA@@A@@@AABA@@A@@ABA@A@A@@A@@@A@@A@@@@A@@@@A@@@@DA@@AAAA@@@@B@F@@@@AB@@@AAAA@@@@@@@@B@@@@A@AB@BADA@@@@AA@AB@@@@@@BB@@B@@@@@@BB@@@BBB@@@@BB@@B@BA@AB@@@B@B@B@@@@ABAB@AA@@@A@@BA@ABABA@@@@B@@A@@B@@ABB@@B@@@@AA@@@@A@@BA@@@@@@@@@@@C@A@A@C@@AA@AB@@A@@AA@@@A@AA@@C@A@@@AA@AA@CAA@E@@@A@@@A@@AAC@A@@@C@C@@B@AA@@A@@@@@ABA@@BAB@@@@AB@@A@@@A@@@@BCAA@@@AA@@AB@@@@@B@@@@A@@A@@@@@@ABABA@AB@@A@@B@@A@A@@AA@@@AA@A@@A@@BABA@AB@@ABC@CDC@@@A@@BA@@@@BA@A@A@@BA@AA@@@@@A@@AAABEA@@AA@@@ABA@@AA@@@@@@@A@@AB@B@BA@@@AA@AE@@@C@@A@@A@A@A@@@A@A@AAC@@@A@A@AA@AC@@A@@A@A@@@ABA@@@A@A@@@ABABA@@@A@@@A@@@A@@CC@@@AA@C@A@AAA@@A@@@A@@@@AAA@@@AAAAAA@@AA@@@AAC@A@A@AA@@A@@A@@ACB@AA@A@@@@@A@@A@@CA@A@@@A@@B@@A@@BAA@@AB@@ABA@@BAB@@A@A@@@@@C@@@@@C@C@A@A@@@C@@B@D@@@@AB@EEAA@ABADEDADBB@NK@@CG@@@A@A@@AAC@A@@@A@@AA@@@AB@@A@A@AA@@@A@A@@DBB@@@@A@A@A@@@@A@BA@A@@@A@@@@@@@A@@@@@@@AB@@AACB@B@@@BA@@@@@@B@@@BABAB@@@BBDBB@@@B@@@@CBA@A@@B@B@BA@AB@@A@@@@@A@@B@BAD@B@@A@@AA@@A@C@CCA@BABA@@@AA@@A@@@A@A@ABABA@@@@BA@@@@BAB@@A@ABA@@BA@@B@@@@@@AB@@@@C@@@A@A@A@A@@@@@@BA@AB@BABA@@@@@AA@@@@A@@@A@A@@@A@@AA@C@@@ABA@@@A@AA@@A@A@A@AAACA@@@@BC@@@A@@@AA@AAAAB@@A@A@@@@@@AAA@A@A@A@A@CA@@A@@AA@@@A@@BA@@A@@CBA@@BA@AAA@A@@@@@AA@A@AA@ABA@AA@@@@@A@@@A@A@@@@BABA@@BE@@B@@A@@@A@A@@@A@@@@@A@A@@A@@A@@AA@@AA@@C@@A@@A@@AE@BACA@@AA@@A@AA@@BA@AA@@@A@A@AA@@A@@A@@@A@@A@@A@@@@@AA@@@BAB@@AA@A@B@A@CAAAAA@@D@@A@A@@@@@@@@@A@@@AAB@AA@@@@@@@A@@AA@BAA@@@@A@@A@@AAA@@A@@@@@@A@@@@C@AA@@@@@@@EC@GBA@CBA@@@A@GD@@AAAA@A@@A@A@BAAAA@@@@AAA@A@@B@BA@@A@@@@A@@BCAAC@AA@@A@A@AAA@C@CAA@C@@@A@@A@A@@@CBA@A@@BA@@@@@A@C@@@AA@AA@@@@AC@A@@AA@A@@@@EA@@AA@@@A@@@@CAA@@@@A@ABA@AB@@@@A@@A@AA@@A@@@@@@BA@@@@@@@@B@@A@A@@@@@A@A@A@A@@@A@A@@@A@@@@AB@@@@@@@@AB@@@@@@@@A@@A@@@A@@@A@@@@@AAA@@@@@A@B@BAA@@A@@@AB@@@@AB@@A@@@A@AAA@A@A@ABC@@A@AA@@AABAAA@A@@@@AA@@ACA@@@A@@A@@@@@A@@@A@@@@@@DC@@@CBC@AB@BAB@@AD@@@AA@@@AB@@@@@@AD@@AB@@A@A@@@C@@@AAA@@AAA@@B@@AAA@@@@@@@@@@@A@AA@@@BA@AA@@A@@BA@@@@@@A@@@ABA@@@AAAAA@@@@A@@BA@A@CBA@@A@@@@@A@@@@@@A@@@@A@@@@A@@@@@A@A@A@A@@B@@AAA@@@BAAA@@@@A@@@A@@@@@A@A@@@@@A@@@@@C@@@A@@@@@A@@@A@@BA@A@@@AB@A@@@@A@@A@@@AA@BC@@BABA@@@@A@AAA@AD@@@@@@@B@@A@@@@@@@@@@@A@@A@@AB@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@AB@@@A@@@@@A@@A@@@@AA@@BA@@@@B@@@@@@A@@@@@@B@@@@AB@@A@@AAA@@BA@@@A@@BA@@@@@@AAAA@@B@B@@@B@BA@@@@@@@C@@A@B@B@B@@@B@@AA@@@@A@A@@@AA@@@AA@@AA@@C@@@@@AA@@B@BA@@@ABA@ABABE@@@@D@@AB@@A@@@A@@@A@@AA@ABABA@A@@A@@A@A@@AA@AAA@@@A@@AAAA@A@@@AAA@@@A@@@@@@@A@@A@BC@A@AA@@@B@@@BAGG@@@@@@A@EB@@ABAB@@@@A@@@@@A@@A@@@@@AB@AA@@@A@@@@@@@AA@@@@@AA@@@@A@@A@@@AA@@AAA@@A@AAAAA@@@@AAAC@@@A@@@@A@@A@@AAAAA@A@A@AAABAB@@@DABB@@B@@@BAB@B@DA@@@BB@B@@@@@BBBB@@@BB@BAD@@@@@@@@AA@@A@@@@HEBA@@@C@@BA@AA@A@@A@@@@A@BBA@@@ABAA@@B@@A@@@@A@@@A@CA@A@@@@@@@@@@A@@A@@@@@@@AACA@AA@AB@@@@C@@@@@@B@B@BA@@B@@@@@B@@@B@@@B@BA@@@@B@B@@@@@AAB@@@BA@AA@@@@A@@@CC@@A@@D@DA@@@@B@B@B@@A@@@AA@@A@@@A@@AAAA@A@@@@@AAA@@BA@A@AB@AA@A@@AAA@BA@@@@@@BA@@BAB@@A@@BA@A@AHG@A@A@AB@BC@@@@@E@@AA@@@A@@@AB@@A@A@@BA@@B@B@@A@A@ABA@A@@A@@AAG@AAECI@E@GBKFEBIAEEGACACCMAI@C@I@@@K@I@@@E@CBA@AFOIECACCIEEEEEECC@CAGAA@GAECICAAEA"]],encodeOffsets:[[[121270,25180]],[[121289,25160]],[[121301,25152]],[[121240,25180]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
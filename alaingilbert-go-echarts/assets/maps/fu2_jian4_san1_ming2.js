@@ -0,0 +1,6 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("三明",{type:"FeatureCollection",features:[{type:"Feature",id:"350402",properties:{name:"梅列区",cp:[117.645855,26.271711],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@ACA@@@BA@@AA@@BABA@@@@@@B@BA@@B@B@BA@@BA@@B@@@B@@A@A@AB@@@BAB@@C@@@@AAA@@AC@@@CB@@A@@@A@A@@AA@A@C@A@A@A@@@C@@@AB@BBBBDB@AB@@@B@@@BBBB@AB@@@BA@@BA@A@AAA@A@AA@@A@@B@@@D@BAB@@@BAA@@@@AD@@A@@@AD@@@@A@@@A@AA@AA@A@@@A@A@AA@@A@@BA@A@A@ABC@GBA@@@A@@@EBA@@@AB@@@@@BA@@B@@A@@@@BA@@@BBBBAB@@@B@@@@@@A@@B@@@FB@B@EB@B@@A@@@@@A@A@@@A@A@@@@@AAABA@@@BB@@@BADBB@BADC@BC@AA@@@@@C@A@@B@@@@ED@@@@AB@@CAACA@CCAE@@@@A@@BAB@@@B@@C@@@@@@AA@AB@@A@@B@B@BA@@@@@AB@@@BA@@@C@@@A@AB@@A@@@A@C@AAA@A@@@AA@@@A@A@AA@AB@@A@A@A@@@A@@@CAA@A@A@@B@@@B@DB@@B@@@BAB@@@B@AAB@@A@@B@@A@A@@AA@@@A@CBA@AB@@@@A@AAAA@@A@@@A@@@@@A@@BA@@AA@@@AB@@AA@@A@@@@@@BA@@A@@A@@A@ABA@@@@B@@AB@B@@@B@@A@@@AA@@@CA@@@@@@@A@@@AA@@AA@@@A@@@A@@@@@@A@@A@@AA@AB@@AB@@@@@@A@@@@@A@@A@@AA@@@@AAB@@A@A@A@@@@@@AA@@@@@@A@@@@@AAB@@@B@BA@@B@@@B@@BBB@@B@AA@A@@@@@ACE@@@@@@@A@@@AA@@@A@@A@@BC@@@AB@@A@@B@@@D@@@B@@@@ABA@@AA@@@C@@@@AA@@CA@@@@AC@@@C@@A@A@AAC@AA@AAA@@@A@AA@@@@AA@A@@@@@@A@AA@@@A@@@AB@@@@CAA@A@@@ABABAB@@@@A@CBABA@A@@B@@BB@@BB@@@B@@AB@@@@@@@BA@@B@B@@@BA@A@AAA@CA@@@BA@@A@@A@@AABA@CBAB@@AAC@A@EB@@@@@@BD@@@@@@@B@@B@ABA@A@@AA@@BA@@B@B@@AD@@A@@@@BB@DBB@B@@AB@@@@@@BAB@B@@A@@@@@A@@@@@ABA@@B@@@B@@@@@@DB@@B@B@@@@@@B@@A@@B@@@@@@@B@@@@A@@@A@A@@@ABAB@BAAAB@@@BA@@@@B@@A@@B@BA@@@@@@B@@@@@B@@@BB@@BB@@@@ABE@A@A@@B@@@@@@AB@@@B@B@@@B@@AB@@AH@B@D@DB@@@@@@@@AB@BABABAB@@@@B@@BA@BB@@A@@@@B@@@@AB@@ABA@AB@@@B@BAB@@@B@@@@B@BB@@DB@BB@@@B@B@@B@B@@@B@@@@@@@BA@@@A@@AA@@@ABAB@@A@@A@@A@@B@@AB@B@@@BB@@B@B@@@B@@@@@B@@@@A@A@@A@@AB@@C@A@@@@@@AA@@B@@@BA@@BA@C@@@@@@B@@AF@@@BA@@B@@A@@@A@A@ABA@@@@@DDB@@@@@BBDB@@B@@A@@@AB@@B@@@BB@BB@@@B@@@@@@BBB@@B@@@@@D@B@B@@D@B@BBBABB@@B@B@BBB@@BB@BB@@@@@BB@BDB@@@B@B@@@@@@BBBA@@@A@@@A@A@@@@BAB@BAB@DCBED@@@B@@BB@@@BB@@@AB@B@@@B@@@B@@@B@@DBBBB@@@@BBBBBB@@B@B@BBB@D@B@B@BDB@@@@@BBB@@@@@BA@@B@B@B@@BA@@B@@@BBB@@B@@BAB@@B@@@A@A@A@AAA@@@@BA@@FB@@@@@B@@@B@@BB@@@@@@@F@B@@@D@BBD@@@D@@@B@@@BAB@BAB@F@@@@@BA@@B@@A@A@A@A@@AA@AAA@@@AAA@@@@@A@@A@@@@A@@@A@@@@@A@AA@@@@@@@BBBB@@B@@@@@BBDBB@BAB@B@@F@D@D@@@B@@@@BA@CB@@@BA@@BA@@@AAAAA@@@@@A@@BA@@@@@@B@B@@@@@BBB@@BBB@BBB@@@AB@@@B@@@@AB@@B@B@@@B@@@@BB@@@B@BABB@@BAB@@@@@@@B@BB@B@@B@BB@@DB@@@ABBBA@@@BB@@@@B@@@B@@BB@@BB@@@BB@@@B@@@B@B@B@@@@@B@B@@@BAB@B@B@@@@BB@B@@@B@@B@@BBB@DABBB@BBDBB@@@B@@@@@DB@BBB@@BB@BB@@@BB@@@B@B@BAB@BB@@@BAB@B@@@B@@A@@@A@@@A@@B@A@@A@@B@B@@@@@BB@DB@@F@BBBBFBB@@B@B@@AB@@CB@B@@@@@@AAAAA@AAA@@@@@AB@@@D@B@@@BAA@@@@AB@@A@@A@@@@AB@@AA@@A@@@@@@A@@@@@@@@@A@@@AAB@BBB@B@@@B@BB@@BD@@B@FADABAB@@@BA@@@A@@BB@@BBB@@A@@@@@A@A@A@CB@@@B@@@@BB@@@B@@@B@AA@@B@AA@@@@@@@@B@@A@@@@@@AAB@@@@@@@A@BA@@@@@@@AA@@@@@@@@A@@@AA@@A@@@A@@@@@AA@@A@@@A@AAA@@F@@AB@@A@@B@FB@@B@B@@B@@BDBB@DB@B@@BB@BBBB@@BBDBBBB@@@@D@@B@D@B@BA@@@BB@BAB@BB@@@@@A@@BAB@@@B@BAB@FA@@@AB@@@AA@@@@BCDAD@@@@@BA@@@@B@@A@A@@@A@@AA@@@E@@@AB@@@@@@@B@@@BA@A@@AA@@@A@AB@@A@@BAB@B@@AB@@@BC@@BA@@B@@@@AB@@@B@@@BB@B@@@@B@BA@@B@@@B@@@BC@@BAB@@@AABCAE@@@A@@FABA@@BA@C@@@A@@@A@@@@@AB@DC@@BABA@@BB@@@@@@@@@B@@@B@@@B@@@B@@@B@@B@ABBBB@@B@@@B@BB@@B@@B@BA@@B@@A@@AA@C@@@@@AAA@A@@@@DAB@B@BAB@@A@A@A@@@A@@B@@ABCB@@@@@@@B@B@@@BBDB@@B@@@BC@CB@@A@@BA@@@A@A@@BA@@@EBCAAA@@@CC@@@AAC@A@A@@B@BA@@@A@@@@B@B@@@@@@B@@@BBD@@B@@B@@BBB@BBB@B@BDBB@B@B@@@@@@D@B@@BB@@@@@D@@@@ABA@@BAB@@AB@@@HA@@@ABB@@BBBFDF@D@@BBBBBB@@BBB@FBB@BBB@BDD@D@D@F@B@D@DBBBBBBDDDBBBB@B@@B@@AACAAAA@@@@BA@AB@@@B@@@B@@@BAB@AAB@@A@@@@AAAA@@@@C@AB@@@A@@@A@@@AA@@AA@@@@@@A@@@@AB@@@@@@AAB@A@@@@ABA@@B@@A@A@A@@@@@AB@BABA@@@@@AB@@@DAB@@AB@BAD@@@B@D@@@@@@@@@@B@@@B@@@@B@@@@B@@@BBBB@@@@@BA@A@@@@BB@@@@@BBB@@B@B@@@@@@CAA@A@ABA@AA@AA@A@ABA@@DABAB@@BB@B@@A@ABAB@@@@@@@B@@@B@B@@@B@@AB@@@@@B@@@@@D@BA@A@@BAAABA@@@@B@@BB@@BB@@@B@@@BBB@ABABAB@DBB@@@B@BB@@AB@BA@@B@@A@A@AB@@A@@B@@@@A@A@A@EBA@A@@B@@@@@@A@@AA@@A@AA@@@@AAA@@@@@AA@@@@BA@AB@D@BAB@@A@A@ABA@@@AA@@@C@@A@@@AA@AA@@@@A@A@@@@@@B@@AAA@@A@@@@A@@@A@@@AAA@A@@A@@@AA@@A@@A@ACAC@@AA@AB@@@@AB@@@@@@A@@@@BCB@@AAAAA@@@@@@CA@@@@A@@@@@@@@@@BA@@@A@@@A@AB@@A@@B@@@B@@AB@@@@@D@BA@@@@B@@C@AB@@@@@@B@AB@@B@A@@B@@@@@B@@A@AB@@BB@@@B@@A@@@@@@@@B@@@@A@@B@@AB@@AAAAACAAAA@A@E@@B@@@CAAA@AAAAC@@AA@@@@AABA@AAA@AA@@A@@A@@@AAAA@@@@@@A@A@@B@B@B@@@A@@AC@A@@B@@@B@@@B@B@@@BB@@@A@A@@AA@@AAAAC@@A@@@ACAA@A@A@@@A@A@A@CA@@AA@@AA@@@@EA@AAAA@@A@@CC@CAAAB@@CFA@@B@@@@C@@@ABAB@BA@@@GB@@@@@@@C@@@A@@AB@@A@@@@@A@@@A@@BADAB@B@A@A@A@@@A@@@@@AA@@@A@@AA@@@@@@A@@@BA@@B@@BB@@@@@BA@@@@@@@@BB@@B@@BB@@@@@BA@@AA@@@@B@@C@@@@@@@AB@@@@BB@@@B@B@B@@A@@@AA@AA@A@@@A@@@A@@@@@A@@B@@A@@BAA@@DEBAB@@@BC@@BA@@BE@@BABADC@@@@@@@ACCAA@@A@@@@B@@A@@@@@A@AA@@A@@@A@@@@@A@@B@@A@A@@@@B@BA@@@@ACC@@@@AA@A@AA@@AA@@@A@@@@A@A@@@A@@A@A@AA@@A@"],encodeOffsets:[[120512,26864]]}},{type:"Feature",id:"350403",properties:{name:"三元区",cp:[117.608044,26.234019],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@AAA@@@@A@A@A@@@AB@@AB@@AB@@ABA@ABA@ABA@@AAAA@A@A@@@A@AB@@@@AA@BA@@BCBA@@@@AA@@A@A@CC@@@@ACA@A@CAA@@@@A@@@@@AB@@@B@@A@@DA@@@A@A@A@C@@BAA@C@CD@B@@@@@@B@@@@@@B@@@B@B@BABA@@BA@@AA@E@@@AAAC@A@@@AA@@@@B@@AD@@AB@@A@@@A@A@@B@B@@@BBB@BDB@@@@A@@BCC@AAC@CAA@@A@@@@@A@@@@B@B@@DL@BB@AB@BA@A@EACAC@AAAA@@AA@@AA@@@AA@@CBCAA@C@@@A@@CE@A@@@A@@@@CA@@@@A@@@AB@@@BC@AAC@CBA@@@@@@@AABA@A@@BA@@@A@@CA@AIC@@A@@@A@@A@@@@@C@@@@ACCA@@AACC@AAAA@CCAA@@CE@CCCAA@AAA@@@@@B@B@@@BA@A@EBCDA@@B@@BB@B@B@@@BA@ADAD@BA@AB@@@B@BAD@A@@@A@C@@@AA@@@A@A@@@AB@A@@@@@A@@@@@@CCAAA@@@A@@@A@@@@@A@@@@@A@A@@@A@@@AA@@A@@@A@@BA@AB@@A@A@@@ABABAB@@ABBB@B@B@BB@@@BB@@@BB@@B@DBB@BABBB@@BB@@@@A@C@A@@@A@A@A@A@AB@B@@ABA@A@@AA@@B@@@BA@@@A@A@CA@@A@ABA@@@@@@BD@BB@@B@B@@@B@@B@@@B@BA@@D@@@B@@A@@@A@A@@B@B@@A@A@ABA@@@A@A@@@@B@D@DBF@@AB@@BB@@B@@B@B@@BB@BBB@BBBBB@@BB@D@F@@@BA@@@AB@@C@A@AAA@@@EB@@A@AB@@ABA@EBA@C@A@@@A@AAA@C@@@@@A@@@@@@@A@@@@BA@@BA@@@A@@B@@@@@B@@@B@@@@@B@@BBB@@BAB@BAB@@C@@BA@@BABA@@@A@ABC@@BABBB@B@B@@@B@B@@A@@@AD@@@@EBA@@BA@@BCBABA@@@AAC@CA@@@@AA@A@@@@A@A@@@A@@B@@A@A@@@@BAB@@B@DD@@@B@@AB@B@@@@@@B@@@@@@A@@@B@@@B@@A@@@A@@AA@A@@@@@A@@@@@A@@BA@@@AA@B@@@@@@@@ABA@@@@@A@@@@@A@@@@@@@AA@@@@A@@@AB@@AFA@@BA@A@A@A@@@AB@A@AA@@@@@A@A@@B@@A@@AA@@@@A@@@A@@A@A@ABAB@@A@A@@@@@@B@@@BA@CDC@@BA@AB@B@@AAA@@AA@AA@@@@AA@@@AA@@@A@@BA@@@AB@BA@@@A@@@AAAA@A@A@@@@@@@@ABA@@B@BCBAB@BA@A@AB@@ABA@@@AB@@A@CBA@ABA@A@A@@@AB@@A@@@@@AACA@@@@CBE@CB@BA@@@A@A@CAAB@@@@A@@B@@@B@@@@A@@@A@@@@B@BAD@@BD@@@@B@@AB@@BBBB@BB@@AB@B@@@@@@@@AAA@BA@A@@@@@@A@@@@B@@A@@@@A@@@@A@@@@@AA@@@@@@@@BB@B@@C@@@@@AB@B@B@BABA@@B@@B@@A@@B@@@@@@@@@B@@@AA@AB@@@@@@B@BBB@B@@A@A@A@A@@@AAC@A@@@@@@A@AAA@A@@AC@@@@A@@@@A@AAA@A@@A@@@@AA@@A@@A@@@A@CC@@@@BA@@AA@C@@A@@AAAA@@@A@A@@@AAA@@@A@@B@BA@@@@@A@A@@A@B@@AD@B@@ABAB@B@B@@@@@@B@B@B@@A@A@@@A@@@A@@@AB@@@B@@@B@@B@@AB@@@B@@@BB@@@BA@@@@@@@B@@A@AB@@@AA@@@@@@B@@A@@@B@@B@BA@@@A@@@AA@@BB@@@B@@A@AA@@@B@@@BBB@B@@B@@@@BA@@BB@@@B@@B@B@@A@A@A@ABA@@BA@@B@@@D@@@B@@@B@@@@AB@@A@@@A@@@@BCBA@@@@@@B@@@BB@@B@@@@@BB@@BBBBB@@@B@BAB@@@@@B@@@@ABAD@B@BA@@@@B@@@B@@AB@@A@CB@@@@A@AB@@A@@B@@@@@@B@B@@@@B@@BBD@@@@B@@ABA@A@@AA@AA@AA@@@AA@@@@A@BB@B@@BB@@@@@BB@@BBB@@B@@@B@@BB@@BBB@B@B@@@@@@A@CDA@A@@B@@@B@@AB@@A@@@A@A@@@@BA@A@AB@B@B@@A@CB@A@@AB@@@@A@@@A@@@A@@BA@AA@@@@@@A@@A@AB@AAB@AA@@B@@@@A@A@@B@@@B@AA@@B@@@@AB@@A@@@C@@@A@@@@@A@A@@@@@A@@@A@@@C@A@@@@A@AB@@A@@@@BAB@@@B@@@@@@@BA@@@@BAAA@@BC@AD@@C@A@A@@@@@A@@@A@A@@@AC@@A@@C@AA@AAA@A@@@GA@@A@A@ABCB@BCB@BA@@@AB@@@BBB@@@B@B@@AB@@A@@@@@A@A@@@A@@@A@@@A@ABAB@@A@A@@@B@BBB@@A@@@@B@@@@AB@@@B@@@@@@@@ABB@@@B@@@B@BB@AB@@AB@@A@@@A@@@AAA@@@A@A@@@BB@BDB@@@@B@ABB@@BBBB@@@@@@B@D@@@B@@A@A@AB@@A@A@@@@@@B@BA@@@A@@@@BB@A@@B@@B@@@@@@B@@B@@@@BA@A@A@A@@@AA@D@@BBB@@A@BB@@@BB@@@@@B@B@@BB@@A@@@@@A@@@@@@@@@@@A@@@@AA@@AA@AA@BA@@B@B@@B@D@@@DB@@@@@B@AB@@@BBD@@@@@@@@@C@@@A@@@@@A@@@BB@@@B@@@@@@@@BA@@@@B@@BAB@BA@AB@@ADABB@@BB@BBD@BB@@@@AB@@@@BB@@@B@@BB@@B@BB@@@B@@B@B@@B@@B@@BA@@@@BB@@BB@@BB@BB@@B@@@@BB@@@@@@BB@@@BBBB@@B@@@@ABA@@B@B@B@BB@B@@BBB@@@@B@@@B@BB@@BB@@B@@BB@@B@BB@@@AAA@@@A@@BCB@@@@@B@B@@A@@@@B@@@BB@@B@@@B@BB@@B@@A@ADA@@@@@@AA@@A@@@A@@@@@@A@@@@@AB@@A@@@@BAB@@A@BDA@@@@@@@B@B@@ABB@B@@@@BBD@@BB@@B@@@B@BB@@@@@@AA@AA@@@@@@@@AB@@C@@B@@B@@B@@B@B@@B@BB@@B@@@B@@@BB@@@@AB@BAB@B@@B@BB@@B@@@@@BBB@@B@@B@@@BB@@BBAB@BB@@B@@BBBB@@@BB@@B@@@BAB@@BBA@@@@@@B@B@B@BBBAF@@@BA@A@@@ABA@A@AB@B@@@@BB@B@@@@BB@@BB@@D@@B@@@B@@B@B@BA@@B@@@@@B@B@@@DAB@@@B@B@@@@BB@@@BB@@B@B@@B@@@@BBB@@@BB@BBB@@B@@A@@B@DA@@@AB@@@B@@A@AAA@@@@@A@A@@B@@@@@@@B@@BB@@B@@BB@@@@@@B@B@DAFAFAB@@@BAB@@@@AB@@@B@@@B@@A@ABA@@@@@@B@@@@BB@B@A@@BBB@B@@ABA@@B@@CB@@CB@BC@@@A@@@AB@@BB@BF@@BDB@BBB@@@B@@@BB@@@@@BB@BBB@@@@B@@AB@BA@@@AD@B@@@B@B@@@BA@@B@@B@@B@@@B@@AB@@@@@@B@B@@@@B@B@@A@A@@@@@@FB@@@@BBB@BBB@BBB@@ADA@@@@@B@@@@@@BB@@@@BB@@@@B@@@@A@B@@B@@@@@@B@@B@@BB@@@@B@@BBB@@@@AA@@@B@@@@@@@@@A@@AA@@@@A@@@@@A@@B@A@@@@@A@@@@A@@@@@@D@@BD@@B@B@@BA@@BBB@B@B@D@BBB@@@@B@@@@AB@B@@BBBB@@BB@@BB@B@@@B@B@B@@BB@B@@BD@@B@@@DAD@DBB@BB@@B@@A@@@@@@BA@@D@@@@@B@@@B@B@@@@A@ABCB@@@B@BB@@B@B@@@B@@@B@@@B@B@@AD@@@B@@@B@B@BABA@@D@B@@@@BB@@B@@@@BA@@D@B@@AB@@AB@@@B@DBD@D@B@B@BDD@BB@@B@B@@A@AB@B@BB@@B@@@B@DBB@B@D@@@A@@A@@AA@@@A@@FCDA@CBA@ABA@A@@B@B@@@B@@@B@AA@A@@@@A@A@@@A@ACA@@A@@@@AAA@@AA@AAA@A@@@AAABAAA@C@@@@A@A@C@@@@@AA@AA@@@@@@@A@@AAA@@A@@@AA@@B@@@BA@@@CAAA@@@@A@CC@@@@B@BAB@B@@@B@@@@AB@@A@@BE@@@A@@@@D@B@@AB@@A@@@AB@@B@@@@B@D@@@BA@@@BB@B@@@@@@A@@@@@A@@@A@AA@@A@@@ABA@@@AB@@@@BB@@@BABA@@B@@BB@@@B@@A@@@@@@@A@@@A@AA@A@@@A@@ACA@@AAA@@@@@@A@@BA@A@A@@BAB@BA@@BA@@@@@A@@B@@@AAB@@AA@@@@@BABABA@ABA@@@@@@@@CAC@A@G@@BA@@BA@@@A@A@@@A@@B@@@@A@@@@B@BAF@B@@A@@AA@@A@@@A@@@@@A@@@@B@@A@AB@@@@A@@B@@A@@BABB@ABABA@@B@B@@@B@@@@@@A@@@@@@@AB@@@@A@@@@A@A@@@CA@@@@@@@A@@@AB@BA@@@@B@@@@@B@@@@ABA@A@@@@A@@BA@A@CAA@@A@@B@@@BC@@@A@AB@@AB@@BB@B@BAA@@@@A@@@@@@AC@@@@@@FAB@D@BB@@BADAB@BA@BB@@@@BB@@A@@DBB@BBB@B@@A@@@A@AB@@A@@@@@@BA@@@A@@AA@@AA@@@AB@B@BADAB@@@@@BABABA@@B@B@DB@@@@BA@@B@@@B@@B@B@@@@B@B@@B@@B@@B@B@@BB@BBBD@BBB@B@@@@D@@BD@@@@DB@@BB@@@@@D@@BB@@AB@B@@A@@@C@@@A@@@@BA@@B@@AD@@@BB@@@B@@B@@@B@@@@@@DF@B@@@@@BBBA@@@AA@AA@@@A@@@ABA@@@A@BB@@@@B@@@@@@@BB@@@@@@@B@B@BA@BB@@@@BB@@@BB@@@@@B@@@@@@@BA@@BAB@@BB@@@@B@@@@B@@@B@@@B@@BB@@B@@@B@@@@@@DB@@B@@B@@@BA@@@A@A@@BA@@@@@AB@B@BB@@@@BB@@A@@@@B@@@BB@@BA@@B@@BB@@AB@@@@@B@@@B@@@BBBBB@@@@@BAB@DAB@@@B@@BB@B@@@@AB@@@BA@B@A@@BA@A@@@AA@@C@A@@@AB@B@B@DB@@B@@@B@B@B@@@BAB@@B@B@B@@BB@@B@B@BBD@B@@@B@@@BAB@@@D@@@B@@A@@BA@@@@B@@A@A@AB@@@BAB@@B@@@@D@@@@A@@BA@AB@@@@@BFDDB@BDDB@@BA@@@@FC@@@@@AB@D@@@@@B@@BADD@BC@AAABC@A@@AA@@B@BABB@@@@B@B@@@B@B@@@@@B@@@@AFAA@A@@E@@@AB@@@@@@@@A@@BAAAAA@@B@@A@@B@@@@AB@@A@@@@BA@@B@FA@@B@@@B@HAD@BAB@B@B@@AB@@@BBB@B@@@B@B@@BBBB@@@B@@@@@BC@@B@@@BC@@@@BB@A@@BA@A@C@@@AB@@@BBB@B@BBB@B@@AB@@A@@BAA@AA@A@@@A@@BAA@ACAA@ABA@@D@@@B@B@B@D@B@B@@BB@B@@@B@@@DA@@D@@BB@BB@@@@@DA@AB@@A@@B@B@BA@@@A@@@AB@@ABA@A@@@ABA@@@@@@@ABAB@@BB@@AB@@B@BD@@BBB@@@BBB@B@@@@B@@@B@B@@B@@@B@@BB@@B@BBB@@@@DD@B@@B@@A@A@@B@B@@@@AB@@@@@B@@@B@@@BBB@@@@@B@@@@A@@B@@@BBDD@B@@@@@@CDABAB@@AF@@AB@@AD@@A@ABCF@@BB@AB@@@@AB@@@@@B@@@B@@@B@B@@BBB@@B@@@@A@A@A@@AA@@@@BA@@@@@@D@@@@A@@B@@BB@@A@@@@AA@@@AA@@A@@@@@@B@@A@@@@AA@@@AB@@A@@@B@@@@B@@BB@@@B@@B@@@@@B@@@B@B@B@ABABC@AB@@@B@@@@@B@@@BA@@@B@@@D@@@@@@HA@@B@@ABABA@@D@@@@@@AB@DE@@BA@@AA@@@@AA@@@@AC@@@AAA@A@@@@@AB@BA@@@C@@@AB@@A@@B@@@@A@@BC@ABC@A@@@A@A@@@@D@@@@@BA@ABA@@B@DBBBB@@@@@B@DAB@B@B@B@@@@A@@BA@A@@@AB@@@@@BB@@@@B@@A@@@AA@@AB@@@@@B@B@@@@A@@B@B@BA@@@@AA@AA@@AA@A@CAB@@@BA@@B@BA@@@@@A@A@@@A@A@@@A@@@@@CB@AABA@AAA@AAA@@AA@AB@AA@@B@BA@@@A@@@A@@@@@@@@@A@@A@@@@@BAB@@A@A@B@@BB@@@AB@@A@@BA@A@@@C@@@@@A@@@C@@@A@@@A@@@A@@@@@AAA@A@@@@@@@@A@@@@@AB@@AB@@@@A@@@@@A@@A@@@@BAB@@A@@@AA@@AA@@A@@B@@AAE@@@@AB@@@B@@A@@@AB@@C@@@@@C@@@@@@@@A@@@A@@A@@A@@@BA@@@@@A@@@B@@A@@@@A@A@@@@@BA@@@@@A@@@A@A@@@@@A@A@AB@@@@@@A@@B@@A@@@@@A@@@C@A@A@A@A@@@@@A@@@@@@BABB@A@@@@BA@@@A@@@A@@@A@@@A@@@@AAA@@@AAA@@@@@@@A@@A@@@A@ABABA@@A@A@A@CB@@A@@@A@@@AB@@@ABA@@BA@@@A@A@@@@@AB@@@DA@BA@B@@@D@@@@@@A@@@@@AA@A@@@A@@@AA@@A@@@AA@@@@@B@@AB@@A@CBC@@@A@@@@A@A@@@A@@B@BAB@@A@@@@@@@A@@@@A@@@A@BA@@AA@@@A@@A@@@@@A@AA@@@ACAC@A@BA@A@@AA@A@@@@A@@B@@@BA@@@@@ADA@A@@@A@AB@@A@@@@A@@@@AA@@A@@@A@AA@@A@@@A@@@AA@@@@A@@@@@A@@@AA@A@@A@ABA@@@AA@@A@A@AAA@@AA@A@AA@@@@AA@@A@A@@A@@A@A@@@@@AA@A@AA@@@A@@@AAAAA@@@A@@@A@A@@@@A@@A@A@AA@@@@@A@A@@A@@AA@A@CAA@@A@A@@@AA@@@@@BA@@@@AAA@@@A@@@A@A@AC@@AA@@A@@@AAA@A@@B"],encodeOffsets:[[120489,26715]]}},{type:"Feature",id:"350423",properties:{name:"清流县",cp:[116.816909,26.177796],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@@A@A@@AA@@A@@@A@@B@@@@@BB@A@@@@@@@@@@AAAAA@@A@@B@@A@@@@B@AA@@@@A@@AA@@A@A@@@AAA@CAA@A@AA@@A@@@A@@@A@@@A@@B@@@@AD@@@@AA@@@@@@@AA@A@A@@@A@@BA@@BC@A@@@@@BBB@@B@@BB@@@B@BA@@@CBC@@BAAA@@@ACAA@@@@A@@@AB@@@@@D@@@@ABA@BB@@@@D@@@BB@@AB@@A@EA@@@@@B@@@B@@@BA@@@@BABABA@@BC@A@@@@@@@AB@@@B@@@@ADA@@@C@@BA@@@@BA@CB@@A@CDCH@B@@CD@BAB@@A@ABA@@@@BBD@BBBBDBDBB@B@B@BB@@@BB@@AB@B@B@@BB@BB@@B@B@@@BBDDD@B@BB@B@@B@BBB@@@@@BA@A@@BCD@@@BBB@@AB@@CBCDB@D@D@@@@A@A@@@@BAB@@@BAB@@@@B@@@@A@@@@BABBB@@@B@B@@A@A@@@@@AA@@C@@@A@C@C@@@AB@AA@@BA@@B@@@@@@A@@@@A@@@@@@A@@@A@@@@@A@@@A@@@B@@@@@BB@@A@A@@@A@A@@A@A@@AC@@@A@@AAA@CCAA@@@C@@@@@A@A@A@AAA@@@AAAA@@@@@ABADA@@BA@A@@B@@CBCB@@ABA@@@A@@@@BABABA@AB@@@@@BAB@BA@@B@@@@BD@B@B@@@B@@@B@BC@@BA@@@A@@B@@@@BB@B@@@B@B@@B@@@@@@B@B@BB@@B@@@BAF@@AB@@@@BB@@@B@BFFBF@@@B@D@BBBB@@@BB@@@BBB@@@@BD@@@@@@C@A@A@A@@@A@A@@@CAA@@@A@A@A@@@@@A@@@@@@BA@@@A@@BA@@@A@A@@@@@@@BBB@@@ABA@@@@A@@AB@@@@@B@@@@A@@BA@@@@@A@@B@@A@@@A@@BA@@A@AABAA@@@@A@AA@B@AA@@@@BAA@B@@@@A@@@@@A@@@A@@@A@@@@B@@A@@@@B@@A@@@@@@@BB@@@@@@@@AB@B@@@B@@@@ABA@@B@@@@B@@D@B@@A@@B@@@@A@@@@B@@@@A@@@A@@@AB@@@@@@@B@@@B@AA@@@@@AB@@CA@@@@@@AB@B@@A@AB@@B@@@A@@B@@@@@@@@@BA@@@A@@B@BA@@@@BAB@B@@@@A@@@@@ABA@@BAAAB@AA@@@A@@A@@A@@@AAA@@@@BADA@@@A@@@A@A@@@A@ABAAA@A@@@@@CDABA@AB@@@DAD@B@BBB@B@BA@@BA@C@A@AB@@A@A@A@AAA@CACA@AEAC@@AA@@B@BAB@DAB@@@@BD@B@@@BCB@B@@@B@B@B@B@B@@DB@@@@@BAB@B@@B@@B@B@B@B@DBB@B@@@@ABA@@@A@@@A@@B@BA@@B@@@@A@A@@@@B@@@B@BB@@B@BB@@B@@@@AB@A@@@AAB@@AB@D@BA@@B@@@B@@@@A@@B@@B@B@B@@@@@B@@B@@B@@BB@@@BBB@@@@@BD@@AB@@@@@BAB@@@@AB@B@@A@@B@@@@@B@@@B@@@BB@@@@@@B@@B@@@@@@@B@@B@@@@@B@@@@B@@BBB@@@@@BAB@B@@@@BBB@@BDAB@@A@@@@@ABA@@@A@@A@A@A@A@@@@@@@@ABA@@B@@ABB@@BABAD@B@B@@B@@@B@B@@@B@BB@D@@@@B@@AB@@A@@@@@@@@@A@@@@B@@B@@@@@@B@@@@@B@@A@@B@@A@@B@@@@@BA@@@B@@@@@BBA@@@@@@B@BAB@@@@B@@@BBB@@@@@@@@B@@A@A@A@@@@B@@BB@@@@@BA@@@@@@@@B@@@@@B@@@BA@@@@B@@A@B@@@A@@@@@BB@@@@@B@B@@@@@@@@@@@B@@@@B@@@@B@@@B@@@BB@@BBD@@BB@B@BBBB@@D@@BBB@@@DDBB@@B@DB@@B@@@B@B@@A@A@@B@@@B@BB@AB@B@B@BAB@B@@@B@B@@@B@@@@@@AB@@A@@@@@@B@@@@@BA@@@A@@B@B@@@B@@@@@@A@@@A@@@ABB@@@@@@@@@@@@@@B@@B@@@@@@@@@A@@@@@BB@@@@ABBBA@B@@@@@@B@B@@@@@B@@@@@B@@B@B@@@B@@@@@@@@B@@@B@@A@ABA@@@@BBABB@B@@@@B@@@BBBBB@@B@@@@BBB@@B@@@@A@A@@@A@@BA@@B@@@BB@@A@@@BBBB@B@D@BAB@@BB@B@@BA@BB@@BB@@@@@FJB@@@BBB@D@@AB@B@B@@@B@B@@BB@@@FA@@B@@@DAB@D@@@B@@ABA@CB@@ABAB@BBB@@@BBBBB@@@BADC@@@AB@@A@AB@@@B@B@@@BBBBB@@@@FCB@@BB@@B@@@@B@@BB@@@@BBDB@@@@@B@@@BBBB@BBBB@BB@BABB@@@@BBB@BB@@B@B@B@@B@@@BBAB@@@@BB@@BBB@@BBBBBB@@@A@@@A@@@AB@@BB@BD@@@@@@BB@B@@B@@BBB@@B@@@@A@@AA@@@@@AB@B@B@DAF@@@@A@@@@@@A@A@@@A@@@AAA@@@AA@@@@B@DA@@B@@@@@BBDB@@@BBDBB@BB@@B@@B@B@@AB@BAB@@@@AB@@@B@@@@@B@@@@B@@BB@@@@@B@@B@BB@@@AB@@@@@@B@@@@@@@@ABABABABA@AB@B@@B@BB@@@B@@BBDB@@B@AB@@A@A@A@ADAF@@CB@B@@C@ABA@A@A@@A@@A@@@@@@BB@@BBBBDBB@@@B@@ABA@@B@@A@@@@AAC@@@@CB@BB@@@A@@@ABCAA@ABA@@BA@@B@B@@@@DBB@@@@@@@@D@B@B@@DB@BB@@B@@@B@@AF@@B@@@@B@@@BBB@BBB@@@B@@@D@@@B@DBB@@@B@BAB@@@@BBB@B@B@BB@@B@@B@@@DB@@@AB@B@BAB@@@@@BB@@AB@BAB@@@BB@@@@@@@BC@EF@@@@@B@@B@@@@@BABB@AB@@@B@@@@@B@@@@B@B@@@B@BA@@B@@A@@BA@@B@@A@@@@@@@A@ABABA@@BAAA@A@AAA@@@A@C@AAEA@@ABAA@BA@AA@@@A@@AAA@@A@@AA@AA@@@AB@@@BCD@@A@@B@B@@@B@@@B@@@B@@@B@@AD@BA@@BA@@@@@AD@@@@@BB@AB@B@@BBA@B@@B@@AB@@A@@BC@A@A@@@AB@@@@AB@@@@@@@@BB@B@@@BB@B@B@@@@B@BA@@@A@A@A@@@CB@@AB@@@@@BB@@@@@B@@@@@@BAB@@C@@BA@A@@@ABADA@@@A@@@C@@@AB@@@B@BBBBBD@BB@@@B@BBDAB@@@BAB@@@BBB@@@BBB@BB@@BB@@@@B@B@@@B@@B@@B@@D@@@@@BD@@@BB@B@B@A@@@BBB@@@@B@BAB@BB@B@@BB@@B@@AB@DAB@@A@@@@B@B@@A@@BA@@@A@@@@@@@AB@@A@@@@B@B@@@B@@ABAB@@@@@A@@@A@@@AA@A@@@AB@@@B@B@@CDAB@B@@B@BBB@@B@@@@@B@BAB@@@@@@@B@B@D@B@@@BBB@BB@@@@B@BB@@B@@BB@@@B@@B@@@@BB@B@BB@@@B@@@@@B@BB@@B@B@@@BD@BAB@BB@@@B@@BB@B@@B@BBBB@@B@@@B@@@@B@@@@@B@@B@@@@A@@@@@@BB@A@@@@B@B@@@@@BB@@B@D@@@BBBB@B@@BB@@@@B@@B@@B@@A@@B@@@@@BB@@@AB@@@@@@@@@@A@@BAB@B@@@@@@@B@@@A@@@B@B@@BB@D@FBB@@@@@@@@B@B@@BB@@@@BBBB@@BB@B@D@BBDBD@B@@@B@D@@B@@B@B@@@B@BAB@B@@AB@@@B@BAB@B@@@@DD@BBB@@A@@@B@@B@@BB@@BB@BBD@B@BBB@@B@@@B@@BBB@BB@BAB@DB@AB@BA@@@@@@@@@@BB@@@@@@BB@B@AB@BAB@B@@@B@BB@@D@@AB@DA@@@@@B@@A@@B@B@@@@@@BBB@@@@@DA@@@BB@@AB@@@@BDA@@BA@@@CAA@@BC@@@@D@B@BB@@B@B@B@D@B@B@@@@A@A@C@@@@BA@@@@B@@@@B@@B@@@B@@@B@@@@A@@A@@@CA@@@AAA@@@@B@B@@@B@@BB@BB@@BAB@@@@BB@@ABA@@@@@B@BBB@B@B@@B@BBB@DBB@BBBBB@BD@@@@D@BB@@BB@@@@ADAB@B@B@BDDBB@@@@B@B@DA@@BA@@B@@@@@BABA@@@A@@@@D@BA@@BC@@@@D@D@@@BBD@B@BBB@@@@@AB@@AB@BCB@B@@@BBB@B@@@@@@DA@@BBB@@B@B@@B@B@@DABABAB@B@@@BB@BB@@BB@B@@B@B@@@D@BB@@BB@@@@@B@@B@@@@@B@@ABA@@B@BAB@BC@@B@BA@@@A@@DAB@@@@AB@@@D@@@B@B@B@@B@@@@@@B@@@@BAB@B@@BD@@@BAD@B@BAFBB@D@B@@B@B@D@DB@@BAB@@@BCBCBADE@A@@@AAC@E@AAA@A@A@A@@BA@ABABA@A@@@C@A@@@A@ABA@@@AB@HDBAB@B@B@D@B@@@@@@BBBB@BBB@B@D@@@BAB@@@BBB@B@BB@BBBDBBBBB@BBB@@@@BBB@DBB@@@@@@C@@@AB@@@@A@A@AA@BAA@B@@A@A@@A@@A@@@A@@B@@@@@@@DB@BB@@@@@BA@@@AB@@@@B@BDF@@B@@@@AB@B@BAB@@@@@BBB@@AB@BABABAFC@@@A@AB@@@@BB@@B@@@@@B@@@BABAB@B@B@B@@@B@B@@B@B@B@@@B@@@BAB@BB@@@@@@@AB@@@@@B@@@@DB@AB@DBB@@BA@@@@@@B@@A@@A@@A@@B@A@BA@AB@@A@A@@@@B@@@AA@@@A@@BAB@@@@BB@@B@@BBB@BB@B@@B@@A@@@@B@@DBBB@@@AD@@@@@@B@@BB@B@B@@BB@D@B@@@@@@@@B@@@B@@A@C@CC@@A@A@@@A@@@A@AB@@A@@BB@BD@BBB@@BB@B@BDFBD@B@@D@F@B@@@DDBBB@B@B@@@B@@A@@B@@@B@DBDA@@BBB@@BB@@@B@@@@@BBB@@@@@@@@A@@B@@@D@@@@@@AB@AA@@@AA@@A@@@A@@B@@@@BBAB@BABABA@A@@@@B@@@B@D@@@@@B@BABBD@B@@@B@B@@@@B@F@BBD@@BB@@BB@@@@BB@@A@@BA@@@@B@B@@@B@B@@@BBB@@@D@B@D@@@B@B@@AB@@@@@@@BB@@@DABBB@@A@@@@B@@@B@DC@@@A@@@@BC@@@@@A@@@@@A@@@@B@@@DA@A@@@@@AB@BA@@BC@@@A@@A@@AA@@@@A@@DA@ADCBC@@B@B@@ABABA@@B@B@B@B@@@DA@@@@@@@A@@@A@@@@BABAB@B@@@@ABA@@@ABA@@@@BA@A@AA@@AB@@A@@@A@AA@@@@@@A@@BA@@B@FAB@BAB@B@@AB@B@@AB@BA@@@A@@@@@A@@@AA@AA@@@@BA@@@E@@D@@@HC@AB@@A@ABC@@BA@AB@@AA@@C@C@@BA@@BA@@BA@@@A@@BA@@@A@A@@@A@@@AB@@C@@BA@@@@@@@@BAAA@A@@B@@A@AAAAAB@@@B@@@@@D@BA@@BBB@B@@@@@B@@@BA@@@AB@@@@A@@@AA@BA@@CA@@A@@A@AAA@@@@AA@A@@@@AA@@A@A@@AA@@A@@A@AA@AAA@A@@AA@@@@AA@@A@AAB@@@AAAA@A@@@AB@B@@@B@@@@@AA@@@@BAB@@@B@@@@@@@@@@A@A@AB@@@BABAB@BAB@@A@A@A@@@@@@B@B@@@B@BA@@B@BAB@@@@@AC@A@A@@@AA@AA@@@A@AB@@A@@@A@@B@@@B@B@B@@@D@@@@AB@@@B@B@B@BAB@@AB@@AB@@AB@BA@@@@B@B@B@BA@@DB@@B@@@@@BA@@@@B@@@@B@@@@@@BBBB@@@B@@@@@DB@@@@AB@@@B@@@@@B@@@@@@@@BB@@@BA@@B@@@B@@@@@BBB@@A@C@@A@@AB@@@@@@A@@B@BA@AB@B@@CAE@A@@AA@@EA@@CA@@@ABA@A@A@@AA@A@ABA@A@@@@BA@A@@@@AA@@B@@AB@@@@C@@@A@A@@@A@@@@@@@A@A@@BA@@@B@@@@@A@@B@@@@@@A@@@@@A@A@A@@BA@@@@E@@AA@@AA@@@@AAAA@@@@AA@A@@A@@A@@A@@@A@@BACA@@B@AAB@@A@@@@@AB@@@@@AAAA@@@@AA@@AA@@@@@A@@BAB@@@@@BA@@@AB@@@BA@@@A@@B@@@AA@A@A@@@@@A@@@@@C@@@A@@@AB@@A@A@@A@@A@@@A@@@A@@A@AAA@@@A@C@B@AA@A@@@AB@BAB@BA@@B@@@BAD@@@BAB@@@@AB@@A@@B@B@@@@@@AAABC@@A@@@AAA@@@A@@@@@A@@@AA@@AA@@AA@@A@@AC@@@A@@A@@AAA@A@AA@@A@@@A@@@@@A@ABA@A@@@C@@@A@AA@@@AA@@@AA@AAACC@A@A@@A@AA@@A@AAA@@@AAAA@A@BA@@@@AE@A@@@C@A@@@@B@B@@C@@BA@@BA@@@A@@B@@A@ABABA@E@A@C@A@A@AA@@CAA@@@A@A@@@A@A@AAA@@@A@@@ABC@C@C@A@A@CAA@CAACAA@@C@A@@AA@@AA@A@A@@AAAAA@A@@@A@@@A@@@A@@AA@@@@@AB@@AAA@@@AECAAA@C@A@@@AAA@@@AB@@A@@@AAA@@@@@@A@A@A@@@A@AAA@@@@A@@ACCA@@CA@@AA@@@AB@@@@@@@A@A@@BC@E@@B@@ABA@@@@@A@ABA@A@A@@@AA@@@@AAA@ABCA@@AAA@@@A@@@ADAB@@A@CB@@A@A@@AAA@A@AACB@ACC@AAA@@A@AA@@@AEC@@@@@AB@@@@@B@@@@AD@DAB@@ABA@@@AA@AAA@@@A@A@@BA@EB@@A@C@@A@@@A@@AA@AC@AA@@@ABA@AA@AA@C@@@@@A@@BBB@BA@BB@B@@B@B@@B@@@BAFAB@BAB@B@DA@@B@B@B@BB@@B@@@B@@@@C@@BE@@A@A@@@CA@@@@@@@@@A@A@@@A@@@@@@A@A@@@A@@@@@A@@BC@C@C@A@AAA@@AAA@@@@B@D@B@J@D@@@@AAAAAA@AAA@@@A@@A@@@@BABAA@@AAAA@@AA@A@A@@@@@@AAA@@AA@@@ABA@C@@@AB@@A@@@A@C@A@@@AB@@AD@@ABAB@@@@ABAB@@@BA@AB@BC@A@@@AECAA@A@@B@BA@@BBB@DBB@B@DB@@B@BAB@BABA@@@BD@DB@@B@@AB@@AA@@@A@GCAAA@@A@ABA@A@ABAA@@AA@@BA@@@A@@@EBAB@@ABABC@@BA@@@AB@@@BABAB@@@B@@@A@@A@BA@@@@@@@AAA@AB@BABA@@@ABADA@@@@AA@@@AB@@@@@@@@AA@@@AB@BA@ABA@A@CCEA@@A@@BA@AAAB@@@A@@A@@@AA@@@ABABCB@AAA@A@@@A@@@BB@@ABAB@@CB@@A@CAE@@@CB@@@@AAAA@CAEAEAA@ABA@A@@AA@@@A@@C@@@AA@@AACA@@A@A@@BCBGBABA@ABA@A@@BA@@BAB@B@@ABA@A@AB@@@@A@@@A@@@C@@@@@@AA@ABA@@@A@@@@@@BA@@@CAC@@AA@@@A@@BA@CA@@A@@@A@A@@BA@@AA@@@@@ABA@@@@@AB@@A@A@AB@@A@@@AAC@CAAB@A@@AA@@A@@A@@AA@AAA@@A@@A@@AA@AAA@@BA@A@@@AA@BAA@@@@A@A@@B@@@BA@@@@@@@@@AB@@ABA@@@@B@@@BA@@@AB@@@@@@AB@@ABC@@B@@@@A@@@@@@@A@@@@BA@@@A@@@@BA@@BAA@@@@@@BA@@@AA@@@A@AA@@AAA@@@A@@@A@A@@@@@@BAAA@CCCAAA@@@A@@@@AA@@A@@AAAA@@@A@A@A@E@@@@@A@@@A@@@AAEAC@@@A@C@@AAAC@A@@BAB@@@@A@@AC@A@@B@@@BA@@@A@@A@@A@@BA@@@AA@@AA@AAA@@A@@@A@C@A@C@C@ABC@@AAAACAA@AAA@@@@@A@@@A@A@@AA@A@A@BC@@@AA@AAACACAA@A@@@AAAAA@@AE@@@AA@C@C@A@A@A@CBA@AAEA@BA@@B@@A@A@@@AB@@AB@@AA@@AA@AA@AAAA@AAA@AA@EAA@C@@@AA@@AAAB@AC@CCAC@@A@A@A@A@@AA@CB@A@C@@@@@@@A@@@@AABAA@AAB@B@@@@@B@@BB@@B@@BB@@@@@A@@AE@@@@A@@AA@@AAA@@@A@@AA@A@@AA@A@A@@BA@@AAA@@@A@@@@@AAA@@@A@@A@@A@@@@@@A@@A@AAA@A@@@@AA@@@A@@B@@@B@@AA@@A@@@A@@@@@A@@@@@@ABA@@BC@A@@@A@@@C@AA@AA@@@A@@@ABA@A@AA@@A@@@A@@@AA@@AAA@@@@A@AAEAC@AA@@@AA@@@C@AB@@@@@@A@@@@@@@@@@@A@A@@@@@@@@@@@A@@@A@@@@@@@@@A@@@@A@BAA@A@@A@@@@@A@@A@@A@@@@A@@A@@@A@@@@A@A@@B@@BB@@@@B@@@@B@B@B@@@@A@A@@@AA@@AA@@@@@A@@@@@AB@@A@@A@@A@@@A@"],encodeOffsets:[[119649,26406]]}},{type:"Feature",id:"350421",properties:{name:"明溪县",cp:[117.202226,26.355856],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@A@AB@@A@@@@@AA@A@@@@@@A@A@@@@AA@@@A@AB@@@@AA@A@@@AA@@A@A@@@CACAA@@@@AA@@@@@A@@@@@@@A@@A@@@@AACA@@CAA@AA@@A@@A@@A@AA@@@@A@@@@AA@@BAA@@A@@@@@A@@@AA@@@@@A@@@@A@@@@@A@A@@@A@@@@AA@@@AAA@A@@@@@A@@@A@@AA@A@@@AA@@A@@@@B@@@@@BA@@@BB@@@@B@@@AB@@@@@@@B@@@@A@@@@BBBA@B@@BA@@@AA@@A@@@C@@@BB@@AB@@@B@BA@@@B@BB@@B@@@ADBB@B@@@@A@A@@@@BA@@B@@A@AB@@C@AB@@A@@@ABA@ABA@@B@@@BBBA@D@B@@@B@BBB@@@@B@@@B@@@BB@@@@B@BA@@B@@@B@@@D@@@@@B@@@@@B@BBB@@A@@@@B@@AB@@A@@B@@AB@@@@A@AB@@@B@@@@BB@@BB@@@@BBBB@@@@A@@B@@@@@BA@BBA@@@DBAB@@@B@@@BB@@@@BB@B@@B@@B@BB@B@@B@@BB@@BF@@@@@AB@@@B@B@B@@@@@B@@@@A@@@@B@@@@@A@@AB@@@B@B@@@@@@@B@@@B@B@@@D@@A@@BA@@@BB@@@@@BAB@@@@@BAB@B@BBB@@@B@BAB@B@@DB@@FB@@BB@@@BBF@DA@A@@BABA@@@@B@@@@A@@BB@@@@D@BA@AA@@@@A@@@A@@@AB@@A@@A@@@@@@A@@@@@A@@@A@@B@@A@@C@@@@@A@@AAAA@@@@@@@A@@A@@@@@AB@@@@A@@@CA@@ABA@A@A@@@@@ABA@@BA@@BA@@BA@ABA@A@A@@@A@@B@@C@@@A@A@A@@@A@@@@B@@@BA@@B@B@@BBB@@B@@@B@BBD@@@@A@ABA@@@ABA@@@A@A@@@@@@@@B@B@BA@ABA@ABAB@@A@@B@B@B@@@@@@@@A@@@A@AB@@@@BB@@@@A@@@A@A@@B@@@BBBBB@@A@BBB@@@BB@@@@BB@@@BBB@BBBB@@@@BB@@BB@B@@@BB@@@@@BBB@@@@BB@B@BB@@@DB@@ABB@@B@@@B@@A@@B@@AB@@A@@@@@A@A@AA@@ABC@@@@@A@@@A@BBBB@B@BA@@@@BBBAB@@@@@@@@AB@@@DA@@B@@@B@@@B@B@@AB@@@B@@AB@@AB@@AB@@@D@DB@@BA@@BAB@@AD@B@BA@@BGD@@C@@@@F@@AB@@@@BBB@@B@@@B@@@@@B@@ABA@@BA@A@@BA@A@ABA@EBA@@@AB@@@B@@@@B@@B@B@@@BA@@BB@@B@BAB@@@@AB@B@@AB@B@@A@A@ABAB@@@@@B@@@B@@@@@@CB@@A@A@A@A@@@ABAB@BA@A@@@ADCD@BCB@@@B@@B@@BB@@@@B@@AD@@ABA@@B@@@@@BCB@@A@@@@@@B@@@@@B@@@@AD@@@@@B@@CDA@@@A@@@@@@BA@AACB@@A@@A@@@@@@BA@@@A@A@@@C@A@C@@AA@A@@@A@A@@@A@A@@B@@AB@@@AA@@@@AA@@AA@@AC@A@E@A@@A@A@@@A@C@AAABA@@@@@C@A@@@A@@@@@@BABABABA@AB@A@@A@@@@B@@@BB@@B@@BBA@@B@@@@C@@@A@@@@B@@@@@@A@AA@@@@A@@@A@@AA@AA@@CB@B@@@@AD@BAD@@@B@B@B@@@B@@ABCB@@ABAHAB@BABEBABA@@B@@C@@@ABBD@B@BB@@B@@AB@B@B@@BB@@@B@@@@@@@B@@A@@BBB@B@@@@BABAB@AA@A@ABAB@@@@BB@@@@@@B@@B@@@@@B@BB@@@@ABB@@@@@@@BB@@@B@@B@@@BC@@B@@@@BB@B@@BA@@B@@B@@@@@@@@BB@@@@B@@A@@@A@@@@@@BA@@@@@@AAA@A@@A@@B@@A@@@A@@@@BA@@B@@ABA@A@@B@@@@BB@@BF@B@@AD@@AB@B@@ABB@BBBD@BB@@B@@AB@@@@A@C@CA@@ABC@@@@@AB@@@@@@A@A@@@A@ABABABABA@@@@@@@BBB@DBB@B@@B@B@@B@@@B@@@@BB@@@DB@@@B@B@@BB@@@@@BAD@DA@@@@@BB@@B@B@B@B@@BB@@B@BA@@BCDCF@@@B@B@B@@ABCBAB@@@B@D@@@@@@G@A@@A@@A@@A@@AAAAA@@@A@EB@@A@@B@@@BB@BB@BDF@@@B@B@@@B@@A@@@A@AA@@@@A@CBEB@@A@@@@@@@@@@F@@@B@@AACB@@@@A@@@@@AA@A@A@@A@@AA@@@@@A@@B@BAB@D@B@B@@BB@DB@BBB@@BB@@@@@DD@@@BB@BA@@D@D@@ABB@@@BB@BBD@B@@BB@BB@DBB@BB@BDBBBB@@@@BB@@@BB@B@B@BB@@@@AB@@ABA@AB@@@B@B@B@@AB@@A@C@A@A@C@@@@A@C@@@@AAAAA@AAAC@@A@@@@@@B@B@@ABC@@@AB@B@@A@@@@@AAA@@A@@@C@@@A@A@@AA@@A@CAA@A@A@AA@@A@@@CBABABA@ABA@ABCB@@AA@@@BA@@@ABA@A@A@A@@@@@AC@@@ABA@AB@FCBA@@B@AA@@A@AA@A@@@AAAA@@@A@@B@@@BAB@@@B@@A@@A@@@AA@@AAAAA@@A@AACBA@@BA@@@AAAA@@CA@@A@@@ABA@AB@@@@A@@@A@@@ABBBAB@@CACAC@C@@@A@@B@B@D@B@@AB@BCB@BA@ABC@@@@@BABA@@@A@AA@@A@@AA@@B@@ABCBA@@A@@AAB@@A@AB@BA@ABA@@BA@@@A@@@@@@@A@@@@BA@@@@AAA@@A@@@A@A@@@A@@@@BB@A@@@@@@@A@@@@A@@@A@AB@@@@@BA@@@@@A@A@A@ABAB@@@@A@@@@@@AA@@@AA@@@@@C@@@A@A@@@A@@A@@A@@@@@@@A@AB@BA@AB@@@BAD@@@B@BB@@BBB@@B@@@@BA@ABAB@BAB@B@@@B@@BB@@B@BBBB@@@@ABA@A@A@A@@@A@@@BA@@@C@@@A@A@AA@A@@@A@@@@AA@@A@@A@@EBC@@BABA@@@@@@A@A@A@A@@@A@AC@@EA@@AAA@@@@@ABA@@BA@BB@BBB@@@BA@@@A@@@AB@BA@@@@@@AAA@@@ACE@@@A@@BAB@@@@@BABA@@@@@@CECECC@AAAC@@AAB@@ABAB@@@@@@A@CC@AA@@@@@@BAD@@A@@A@@A@@@@@@AA@@@BA@BBA@@A@@@BCBAB@@A@AA@@@@AA@C@A@AB@@@@@B@@@B@@@@@B@BA@ABA@@@A@AAE@C@CA@@A@@B@BA@@@@@A@ABA@C@ABA@AB@@@@@BB@@@B@BB@@@@ABA@CBA@@BABADABAB@BADCB@@@B@@@B@@AB@B@@BB@B@@@B@@AB@@@B@@@@@B@@@@AB@@@B@@AB@@@@A@@@@A@@A@@@@@A@AB@@EBA@@B@@A@@@CAA@AB@@AB@BIBC@@@AB@@ADABA@@B@F@BA@@B@BBD@@AB@BAD@B@B@B@BBD@BBD@@@@B@@@@AB@@AB@BB@@DB@BBBA@@BABCDAB@B@BA@B@@B@@@@BB@B@@BB@@@@@BAB@@C@@@@@AB@@@@ABA@@@A@@@A@EAC@@@@@A@@@@@A@@@A@@@@@@B@@A@@@@BA@@@@B@@A@@@A@AB@@@B@@@@@@@@@@@B@@@@@@@B@@@@CB@@@@@BA@@@BBA@@@@@A@@A@@A@@@@@@BABABAB@@@B@B@@ABABABAB@B@@DB@@@B@B@B@@@@CBA@B@@@@D@@@@AB@@@@@BB@DB@@@@@@C@AB@@@@BB@@@@@B@@@@B@B@B@DB@@DBB@@@BBB@@@B@@B@@ABA@@B@@AB@@BB@B@@@B@@@B@@@@FD@B@BB@@B@@BBB@B@DBB@BBDBF@@B@@@@BD@@DDBB@@@BBBABBB@B@@@@B@@@B@BABA@ABC@A@A@A@@@@BCBCBA@A@ABA@@BA@A@@@AB@@@D@@@@ABA@@DAB@B@@@BB@@@@@@B@B@@@@BBBD@@BB@@BBBDDB@@BD@D@B@@@BBB@B@@@DABAB@@@D@B@BB@@B@B@@@B@BBD@B@B@@A@AA@AA@@@@@A@AB@DCDCFC@@@@BBBBB@DBB@DB@BB@@@@@BB@H@D@@AB@@@@C@@B@BBB@BB@@@BB@@@@B@@@B@B@@@BABABABAB@@@@A@@@@BBB@@BBBA@@B@@@@@BB@@@@@B@@B@@@@@@@B@@B@@@B@BB@@BB@@B@@BB@@B@@@@BBB@@B@B@@@B@@@B@B@@@B@@A@A@@B@@@B@@@B@@BB@B@@AB@BA@CBADA@AD@@@BB@B@BBB@@B@B@D@D@B@B@@@BA@A@@@@B@@B@B@@B@@@B@@B@B@B@HBB@@@DA@BD@@@@@@@BA@@BABA@@B@BBB@BB@@@F@F@@@@@BAB@@@B@B@@ABA@ABAB@@C@C@A@@@AB@D@@@B@BA@AB@B@B@BB@@@B@@@@@B@BB@@B@B@B@@@@@BB@@@BADA@B@@@B@B@@@B@BBBB@BDDBB@@@B@@@@AB@@@@@B@B@@@B@@@BCB@@@@@@@BB@@@BB@@BA@@@@B@@@@AB@@@B@B@@@BBBA@@BB@AB@AA@@BA@@@A@@@@@A@@@@A@@A@@B@AA@@@C@A@@@A@@@A@ABA@@@ABA@@@@@A@@FABA@@D@@@@@B@B@@B@@B@@@@@@@BA@@B@B@BA@@BABA@@@AB@BA@ABADAB@@@@@D@B@@@B@DA@@@A@@@ABCD@@@B@@@B@B@B@BA@@B@B@@@D@B@@@D@@AB@@@@@D@B@DA@AB@@@@AB@@@BAB@B@D@@@B@@@B@@@@@B@@@B@@BBBB@@@BB@@BB@@BAB@@@B@BA@@BABABAB@@@@@BA@AB@B@BAB@B@@ADCA@@AB@@AB@@AAABC@@@ADAA@AA@AAA@AAA@@@@BA@@@@BA@A@@@A@@DA@@BABAB@B@@@B@@@BC@@BA@A@ABA@AAA@AA@AAB@BC@@BE@ABA@@@@@A@AB@@@B@B@@@BAB@@@@@B@BABA@@BB@@@B@@BB@BB@@BBBB@BB@@B@DBBBB@@@@B@@@@BB@@@BBB@@B@B@@@B@@A@@B@@A@@@A@@@A@@B@@@B@@@B@D@@@B@@A@@B@@@B@@@@@@A@@@A@@@@@A@@@@BBB@B@@@BA@@B@@A@@B@@@B@B@@@BAAE@@B@@A@A@A@@@A@A@@B@BA@ABA@@AA@A@@B@BA@@@A@@@A@@B@B@BBB@@A@@ACAA@@A@AA@@@ADA@BB@BA@@B@@@BA@@@BBB@@B@@C@@B@@A@@@@B@@@BB@@@@B@B@@B@@@@BB@@@@B@@@@B@@B@B@@B@@BBB@@@B@@B@@B@@B@@@BB@@BB@@B@@@@@B@@@B@@@@BAB@BABA@@@AB@@@B@B@B@B@@@D@BA@@BA@@@BB@B@D@D@B@B@B@BBD@@A@@@@B@@@B@@@BB@@B@@B@@@A@@@A@@@@@AB@D@BA@@@@BA@AB@@@B@@A@@@ABAB@B@@@@@B@B@@@@@B@BBBB@@@@B@@@B@B@B@@@@AB@@@B@BA@@B@B@@ABBB@@@@@B@@@B@B@BBBAB@B@BABABADAB@BAB@F@B@BABB@BB@B@B@@@B@@@B@BBB@@@@@BBB@@@BADA@@@ABAB@@@D@@@D@@@B@F@BAB@@ABA@@@C@@@AB@@@BABC@@@A@@@@B@@ABAB@@AB@B@F@D@F@DADAD@B@@@D@B@BA@@BB@@@@B@@@@@D@BB@@B@@AB@@ABA@AB@@@AA@AAA@A@@@C@@@A@A@@@A@C@@ACAACC@@A@@A@@@C@ABA@E@@@@BA@@@@@AA@@@@A@AAA@@A@@A@@AA@@@A@@B@@AB@@A@@@A@@@@@@A@@@AA@AA@@@@AA@@@AAA@AA@@@A@@@AA@@@@AA@@@AA@@@A@ABA@A@@@AB@@@B@@@DA@AB@@AB@B@@A@@@ABA@A@A@AA@AA@AAA@@AA@@@@BC@A@@@A@@@@AA@AAA@@AA@@A@@@@A@@@A@@@@BBBBBABB@@@AB@@@@A@@A@@A@@@AAA@AAA@A@@DB@@D@B@@@@@B@@@@ABA@ABAB@B@@A@@BB@@@@@A@A@@@@A@@A@@@A@@@A@A@@@AAA@@AA@@@A@A@@B@@ABBBA@@B@B@@@@AB@@@@@BA@A@@BA@AB@@@D@BC@@BAB@@@BAB@@@B@@BB@B@@@B@@B@BB@@BB@@@BBB@BAB@@BB@@@@B@@BA@@D@BAB@B@B@@A@@@A@A@@B@B@BA@@B@@B@@BB@@@@BB@@B@B@@BB@BBB@B@@@BA@@@@@A@AB@@@B@@@B@HBB@@BBB@@@@@B@@@@@B@@@@@B@B@@BD@@@BB@AD@@@@@B@B@B@@@@@@@B@@@@BB@@B@@@@BABADAB@@@B@@B@B@B@B@B@B@B@B@D@BB@@B@@@DB@@@B@@B@B@B@@@BAFA@@BBBA@@@@B@@@B@@@B@@@B@@@B@@@BA@A@@@A@@AAB@@ABA@@@A@A@@@@AA@@@E@@DK@ABBB@B@@@BADC@@@A@AB@B@B@BA@CBABAA@@@BAB@@ABAB@B@@@B@@@B@@@B@D@BABA@ABA@AB@@@B@@@B@BB@BB@@BB@ED@@AB@@BB@BAB@D@@@@B@B@FB@ABABB@@@BBBBA@@BBDADAB@BA@B@@DB@@B@@BB@@BBB@@@BD@@B@@B@B@B@@@@B@@B@@@BB@@@@@@B@@@BA@A@@BAAABAB@@@BBB@@@BA@AB@@@BB@@BA@@@A@@@@BA@A@A@@BA@A@@@@@A@A@@BA@@@@BABA@@@@DA@@BC@A@A@@@@DBBB@@@BB@@B@DBB@@@@BBBBBB@@B@B@BB@@B@@@@BB@B@BBB@@B@BB@@BB@BAB@@BB@BBB@@@@BB@@@B@@@BB@@@D@B@@BVEVAB@@A@@B@@@B@@AB@@@@A@@B@@@B@BAB@@AB@@@@@BABABBBA@@@A@@@@B@BB@B@DDBB@@@BB@B@D@@@B@@@B@B@B@B@B@@@D@@BB@BB@@@@B@@AB@B@DAB@@@BA@@BBB@DB@@@@@AD@@AB@B@BAB@BA@@@@AA@A@C@ABABABABABAB@@@@@DBF@B@@@BB@@@@B@@@@@@@@B@@B@B@B@@@DA@@B@@@BB@@@@@B@@@@BB@@B@@@@@@@BB@@@B@@@D@@@@@@@@BDB@B@@@@@@B@@B@BBB@@@BD@BB@BB@@BB@@@BBBBB@B@@BD@B@@BB@@B@B@@@BBB@BB@B@@@DBBBB@B@B@@B@BBBA@@B@B@D@B@@@B@@BD@DB@@@B@@B@@BB@@@@B@@B@@B@@A@@B@BBB@@BB@@A@@BBB@BBBBF@BA@@B@@@BADA@@@ABAB@@BBB@B@B@BAB@@B@@@@A@@B@@@@B@B@@@@@BB@BDB@@BB@@@@BB@@@BB@@@B@BB@B@@@@@BBB@@B@ADA@@@AB@@A@A@A@AAA@@@ABABAB@BAB@@ABA@CBA@@@@D@B@B@B@B@BB@BB@@B@@A@@BBBB@@BB@@@D@B@@@B@@@@BBBDBB@BBB@B@@@BB@BBB@BA@A@A@@B@@@B@@@BA@A@@@A@@@A@@@@BABABABA@@B@@AB@@@B@B@B@@@B@@A@@DABA@AB@B@@@D@B@BB@@DA@@BAB@@@B@@@B@@B@@@B@@@B@F@@@B@@BBBBB@@@@@CDADA@@@C@A@@@ADCDA@@BCDA@@@@@@BB@@B@@@@D@B@DBBBB@@BB@B@B@BA@@BABCB@@AB@@BB@BD@@B@B@@@B@@ABAB@DABAB@B@D@B@@@BADADA@@@B@@@B@B@B@@BBDDB@B@BBD@BA@@B@DBBB@@@@D@@@B@B@@@B@@@@AF@B@@@B@@@@@B@BABA@@@AA@@A@@@A@@B@@@BB@@@@@A@@BAB@BA@@@A@@BA@@AAA@@@@@AAA@EC@@A@@@@@A@@AA@@AAA@A@@@CAA@AAAAA@@@A@@AA@@AA@A@@AA@@@AAAAAAA@@@@AA@@B@@@@A@@BA@@@A@@B@BCA@@AAA@AA@A@C@@AABCA@@@@@AAAB@@@@@@A@@A@@A@@@@A@@A@@@@@@BA@AAA@@@@@AAAAA@A@@@A@AB@@A@@@@@A@@@A@@@A@@@@@A@@@@@A@@@@@A@@B@@@@A@@D@BABAB@@@@@D@@@@@B@@@@AB@@A@@BA@A@AAC@A@@@A@AB@@ADC@@@@B@@B@@B@@B@@@@BB@B@B@@BB@@@@@@BB@@@A@@BAB@@@B@@@BA@BBAD@@BD@F@@@@@AB@@@B@@@@B@@@BBBBBB@@@B@@B@@@B@@@@@B@@@B@@@BB@@@A@@@A@A@@@@AAAA@@@@@AB@@A@@BAB@@A@@@@@@@AAAA@@@AA@@B@@AB@@@BB@@B@@BB@BA@@@@AA@A@@@AB@@@@AA@@A@A@@@@BA@@BA@@B@@@@@BC@A@@BAB@BA@@@CA@AAAA@AA@@ABA@@@AAA@@@A@@@A@@AA@@AAAA@@@@@A@@@A@@A@@@A@@@AA@A@@@A@@@A@@BA@@@@BAD@@AB@@B@@BA@@B@@@EE@@@@AAA@@A@AAA@@@A@AAAAAA@@@A@AA@@@A@@@@BABC@@BABC@@@ABE@AB@B@B@@@B@@A@@@AA@@AC@ACAAAA@AA@@@A@@@A@A@@@A@@@C@@@AAA@A@A@@@AA@@@@AA@@AA@@@A@@@@@A@AA@@A@@AA@@@A@@@@B@@AB@@@@A@@@@A@@@AAA@@@A@@A@@@@@@A@@@@@BC@@@@EEEG@@A@AAAAAA@AA@@A@@@@B@@AB@@@@A@@B@@@@@B@@@@@BA@@B@B@@A@@B@@@@@@B@@@@BA@A@A@A@@AA@@A@@AAA@@AA@ACA@@@@A@@@A@CAAAA@AACBA@AA@@@AA@@@A@A@@A@@A@A@A@AB@@A@A@@@@@A@A@A@@@A@@@A@@A@@AA@@AA@@@A@@@A@@A@@A@@ABAA@B@@CA@@AAA@@@@AAAA@@@@@@@A@AB@@AAABA@@@A@@A@@A@@@A@A@@@BA@@@@@A@@BA@@A@AAA@AA@@AA@A@@@@@A@A@@@@B@@AB@@@@@B@BBBB@@B@@AB@@A@@DAB@@A@@A@@@C@C@E@@@@ABA@AAAAC@A@@@@@AA@AA@A@@@@@@BBB@@@@@B@@@B@@@@@@BB@@@@@B@BB@@B@BBB@@BB@B@B@B@@@@AB@@A@@@@@EBA@ABA@A@@@A@@@C@@AC@A@C@@@A@E@@@@@@AA@@@A@@@A@@@@EA@@AB@@@@BB@B@B@B@B@@@AA@AB@@@AA@AA@@A@@@AB@@@A@A@AB@@A@@@@AA@A@@@@CA@A@A@A@CAA@A@A@AA@AAAA@A@@A@AACA@@@A@@@A@@@A@@@ABAC@A@A@CAA@@@A@@@AAA@A@@B@BA@A@@@AAC@ACA@A@C@C@CAA@@@A@@BA@@BA@C@@@AB@@@@@AA@@A@@A@C@@@ABABA@A@@@A@@@C@@BA@A@@@A@@@A@@@A@A@@@AAA@@@A@AD@B@B@@A@A@@@A@@@@@C@@@AB@@@@@@@BA@@@AAA@CAC@CB@@A@@@AC@@@AAA@@@A@A@A@@@AAA@@AA@@AAAA@@@ABA@@@@@A@@A@AAC@A@A@A@AA@@AB@@@A@AC@@AC@@@@@@@@B@@B@@@@@B@A@@@@B@@@@@B@@B@@BB@@@@@@@@@A@@@B@@B@@A@AA@@@A@@A@@AA@@@@A@@@@A@@@@A@B@@A@@@@@AA@@@@AA@@@@@@@A@@@@CB@BA@AAA@AAA@AA@@@@EA@@@@@@@B@BA@A@@@@@@A@A@@@@A@@BA@@@A@@@@AA@@@AB@@A@A@@@A@C@@B@@ABA@@BA@@@@@AA@AAA@@@@A@@A@@@A@@AA@ACA@AE@@AAA@@BA@@B@@@D@@ADA@@DA@@@AB@BA@@@AAA@AB@A@A@@A@@A@@@@@@@AB@B@BA@@@A@@@A@@B@@A@AB@@A@EBEBCBA@A@@@@@@@AA@@@AA@@AA@@@@@@@A@@@@B@B@@@@BB@B@BA@@@A@@B@@CBA@@@@BA@@@AA@AAA@@A@AA@@@@@AA@A@@@AA@@A@@A@@A@A@@@A@CB@@A@A@@@@@A@@@ABA@A@@@@A@@@AC@@@AA@@AA@@@@@AAA@@@@@ABAB@B@BA@@B@B@@A@@BEAA@A@A@A@A@@@@B@AA@@BA@A@@@AA@@A@@AAAA@@@AA@@ABAAA@@AA@@A@@@@AA@AA@@@@A@@@AAA@@@@ABA@ABA@@@@AA@@A@@@A@@@AAA@@@@A@AA@@@@AA@@@@DA@@B@@@@@@B@@BBB@@@@@@AAA@@@A@@@AA@@AC@A@@A@A@BA@@@A@A@@@@@@CB@A@BA@AB@@@@@BA@@B@@@@@B@@@@B@@@B@@@BB@@@@@@CB@B@BA@@@AAA@@@A@@@AA@@A@@@@@@BA@A@@@@@A@AD@@@B@@BB@B@@AAA@@@AA@@@AA@@AA@@A@A@@@A@@A@AA@@@AAAA@A@A@@@AB@B@@A@@@AAAA@@A@@A@@@@A@@A@@A@@@AAA@@AA@@AA@@A@@B@@AA@@@@AA@A@@@@A@@AAA@@@AA@@@A@@AA@@@@BA@@@@AAC@AAA@@AA@BABC@@BAB@@ABA@AA@@@@@AB@@@@@@@@@A@@AA@@B@@@@@B@@@D@@@@@@@@@C@AA@@A@@B@A@@@@CA@@C@A@@@@A@AB@@ABBB@@BB@@B@@B@@@@@@@@@@@B@@@@@B@@@AA@@@A@A@@@@AA@@A@@A@BA@AA@@@CBB@@B@B@B@B@@A@@A@@@@A@@@@A@@@@AB@A@@A@@B@@@B@@A@A@@@@B@B@@@BAB@B@@@@A@@@C@A@@@@A@AA@AA@BAA@@@@@CA@AAA@@B@B@@@B@BB@@B@@@B@@@BA@@BAA@@A@A@@@A@@AA@B@@@@@@A@@@A@@B@@A@@@@@@BA@AAA@@@AA@C@@BA@@BAD@@AB@@@B@@@B@@@B@BAB@@@@ABA@@B@B@@A@@@@BA@@BABA@@@@BA@@B@B@B@B@B@BBD@B@B@B@@@BAB@@A@A@@B@@@BA@@@@BA@A@C@@@A@@@A@@A@@@@A@A@@B@@A@@AA@@@A@@@A@A@@@ABC@@A@@A@@@CAA@@CA@@AA@A@A@A@@@A@@A@AB@@@@@@A@@@@@A@A@AAA@@A@@GA@@ABA@@@A@@A@@BA@@B@@A@@@@AAAA@@@AA@@@@@A@@@A@A@@@@@@@AA@E@@@A@@@AACAE@AAA@@@@@@@BABA@AB@D@@A@@B@B@@AB@@@B@B@BBD@@BB@B@@BB@B@@@@@B@@@B@@BB@BBB@BDBE@@@A@@BA@AB@@@A@@@@@@@@@AA@@@@@@@A@@@@@@A@BA@A@ABCACA@@ABA@ABA@@@AAA@A@@@@AA@@@A@AAA@A@@AA@@@A@@@ABA@@@@@AB@B@@@@AA@AAA@@A@@@A@AB@@@@@@@@@AA@A@A@AB@A@@AC@@A@@@@@A@@AA@A@@BA@A@@@@@@@@AA@@@AA@@A@@@ABA@@B@@A@A@@@A@AA@@A@@@CBA@@@@B@@A@@@A@@AA@A@@BA@@@@A@@AB@@AA@@"],encodeOffsets:[[120041,26784]]}},{type:"Feature",id:"350424",properties:{name:"宁化县",cp:[116.654365,26.261754],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAAAAAAAA@AA@@@ABA@A@@AA@@A@C@A@AB@@B@@BADAB@@@BA@@@@B@@@@@@AA@A@AB@@A@AAAAA@@A@AAAAA@@AA@@A@A@@@@@@A@@@@BA@@BA@@@A@A@@A@@@A@@@A@AAB@@AC@@DAB@@A@A@@@A@@C@@@CAA@@@AAA@@@BAA@@AA@@@AAA@ABA@ABABADA@@@AD@BABBB@D@@BB@@BB@DA@@@@B@@@B@B@@BB@@@@B@@@AB@@BBAB@@@@BB@@@BCB@BA@AD@B@BAB@@AB@B@@@BBB@B@B@@BBBBB@@BB@@BA@A@@B@@ABABA@A@@@A@A@CBAB@B@@@B@@@@@B@BBB@@@BAB@@C@@@AB@AA@AB@@AA@@@BA@@@@@CA@@A@@A@@@AA@@B@A@AE@@@AA@@AA@@@@@A@@ABAAA@@@CB@@A@AA@@CC@@@@A@ABCBC@ABA@ABA@CBCBABADABA@C@E@C@A@C@A@A@CA@@CB@@A@@@@@AD@@@BA@A@AB@@AB@@@@ABA@CBADA@AB@BABDDBFAHAHDB@@DBHBHDFBFDDF@B@FBJDDFBFBDDJHPFHFHF@BDB@@HHBDD@DBD@DFFDJFFDDBDBHHDDFF@FBJAF@D@DCHADEDCD@D@FBDBDAB@BCBAD@B@DBFBB@B@@@BABBB@@A@@@@B@@@BA@@@@@AB@@A@@@@@@@@@@@@B@@@@A@@B@@@@AB@@@@@BA@@@@@@@@B@@@@BB@@A@@@@B@@@@B@@@@@@@@@@@@BA@@@A@@@ABCBA@C@@@E@@@A@A@@@@@AA@@@@@A@@A@@@@A@@@@@AA@@@A@@@@@AA@AA@@AA@@BA@@@@@@@A@@@@AA@@@A@@@@@@A@@@@@A@@B@@AA@C@@AA@@@@@@@A@@B@@A@CASIO@AAA@ABC@AA@AA@AB@BBDDBBBBBABABABABA@AAACCGAA@AC@A@@@C@AB@BADABI@CBCBC@ABABED@B@BBD@@@BB@@BD@BBBBAB@B@B@BBD@@BBA@BB@HB@BBB@@B@BA@A@AA@B@BADBBB@@BAB@@A@@AA@A@A@AB@B@DB@D@DBB@@BABABA@@BA@BB@D@@A@CBAAAAA@@AB@D@@A@@AAA@C@A@@@CBABABA@BB@@DBB@@@B@B@B@@B@BBFBDCNCDCJCNDDHFB@DBDB@D@@@FAFABABAB@B@@BFBDDDB@BD@FADDFFDJBDBB@FBFBF@DDBDBH@@@F@DKHGFPBNBBBHDFFBBBBBL@HBHFFDBB@BBB@@@DDFBDB@BBFDDFRLHPF@@N@DFHDHFD@H@HDBB@BCFGDBDDF@B@D@LBJFBLAHH@BBH@LFNHDLARABE@IVGJBFD@@DC@A@GHO@I@CGOAE@CDANFHDHBL@@@FA@CDAFADDFBDDFBH@B@DBBF@DFFDDDD@DBHBH@@CFEJAFABBH@HADBD@@B@BBAD@FBD@D@@BFFFFFFFB@FBFBFD@@DF@DBF@JBHFFFDBD@@@DAFBFBF@DG@EAEBE@I@GAA@IACDA@CHCBAAA@E@CB@BDDBDBD@BA@ABA@A@KDABAB@BBBLHDFBD@FCDABCAC@A@AB@BED@HGBG@AB@DBF@JADABEBGDEFIHA@GFCBEBGDIFGDAFADBB@@@DBHDDF@B@NLFTBLDJ@BBXDXFN@D@LBBBABBBBB@B@@BBFBBDHB@@B@@BBDBB@@BDF@@BBBBBB@@B@F@B@B@@@@@@BB@B@@@B@@@B@@@@BB@B@BB@BDADB@@BBBB@@BAD@@@BBB@@@B@@A@@@@CC@@AA@A@@B@@@BBDBDBBBD@@B@@BB@BB@@@B@@@DC@@B@@@@@B@B@@@DBB@BB@@D@B@B@DA@@@@BA@@@A@@B@B@@@B@BBD@B@B@@@B@@@D@@@B@@@B@B@B@B@@@@@@@@C@@@@BA@@BAB@B@@@BA@@D@B@@@D@@@B@D@@@B@@DB@BB@BB@@@D@@@B@@@B@@@@A@@@AB@@@@@DDB@@@@@@AB@@@B@@A@@B@BA@@@@@A@AB@@A@@@@@@AA@@@A@A@@@AB@B@@@AA@CB@B@@@B@@@@B@@@@BB@A@B@@@@B@@@@@BABAB@@ABCBC@@B@BA@@B@BB@@BD@@@@@@@C@@B@@AFAB@B@@A@@BBBABB@@BCB@@@DBB@@@@@@A@@@AD@@@@A@@B@@@@@@@BBB@@@@BBB@@@@B@BB@@@@@B@@A@@B@BBB@@@B@@@B@@@@@@@B@@@@@B@B@@BBB@BBB@@@BBBBDBBBB@AA@EAAAA@AAAA@@@@A@@A@@A@@@A@@@@BBB@BBB@@BBDBB@@B@B@B@@@BA@@@A@AA@C@@AECCA@@@@@@BADCB@@@@AB@@AB@@@BA@A@ABA@AB@@AB@D@BAHA@A@@A@@A@A@@AA@@AA@@A@@@ABC@@@AAA@A@@AA@ABA@@AA@@@@CA@@C@@@@A@@A@@@@AAAA@@AA@@AAA@A@@AA@AA@AAA@@@C@AACAA@A@@@@@A@@A@@@AA@@@@@@AB@@@B@BA@@@CAEACA@@@@@@@AB@D@B@BAD@@@B@FCB@@@B@B@@@@@B@B@@A@ABA@ABAB@@@B@@@BA@@@A@@BAB@@@AAB@@@BBB@@@BB@@@@@D@@@B@B@B@@@@@@B@B@BB@@B@@@@D@BB@@@B@@@@@BA@@@@@B@@@B@@@@B@B@@BB@@@@@BABADAFEBA@@B@@@B@B@@@DEDC@@D@@A@@@@@ACE@A@@@CAA@A@ABABABA@@@@@AA@@ACA@AA@@A@@EACA@AABA@A@@@C@AAA@AACAA@@@GA@@AA@@AE@EA@BA@AB@@A@@B@B@BABA@@B@@A@@@A@@B@B@BBB@@@BBB@B@H@@@BBBB@@BBD@@BBAF@B@@@B@@@B@@@@AB@@A@ABAB@@@B@@@@A@@@A@@@@@AB@B@@@B@BB@@B@@A@@@A@@B@B@@ABA@@B@B@D@H@B@@@B@@@@C@A@C@@@ABA@ABA@A@@@@BA@@@@BBDBB@@@@AB@@ADADAB@@@B@BBB@@@B@B@B@B@B@BABABA@A@@@@B@@@@BB@@@@@B@AA@@B@@A@@@@DA@@@@@A@@@@@@@A@@@@@@@@@@@A@@BAB@@@B@@@@A@@B@@A@@B@@@@A@@@@B@@@B@@@@@B@@@@@D@FBB@@@B@@@B@BA@@@@BA@@@@D@@@BA@A@@@@AA@@@AAA@@@@@AA@B@@A@ABADCBA@AB@AA@ACA@@AAA@@BA@@B@@A@@@@@AC@AAC@A@A@A@ABC@ABA@@AC@A@AB@@A@E@AB@BABC@@BA@@D@JA@ABA@@BAB@DB@@B@@@@AB@FA@@BAB@@@@@B@@@@B@@B@@@@@BA@@@A@@BA@@@@@A@@@@@A@@BA@@@A@@@AAA@@@ABA@@@A@@@A@@DABC@ABABABCBA@AB@DAB@BA@@@@AAA@@@A@@A@@@@BAB@BAD@B@BAB@@@@@B@@A@AB@@@DBD@F@BBB@@@B@BAB@@A@A@@@@@A@@@A@@@@BAB@D@B@@B@@B@@B@BA@ABAD@@B@@@AB@AAB@@B@@B@@@@B@@@@BB@@@BC@A@@@@B@@BDDB@@@@@@@BABA@@BA@BD@BB@BDDDFDF@@@@@@ABAB@@@@A@AB@@@B@@DF@B@@BB@B@@@@B@@ABA@@B@@@B@@A@@AA@AAAB@@AB@BA@@@@B@BB@@FB@@BDB@@@B@B@B@B@@@@@@@ABAB@@AD@FB@@@@BB@@B@@B@@@B@B@@B@B@B@@@D@@AB@@B@@@B@B@B@B@BA@@@@AAAAA@@@AA@@@A@@@ABA@ABABAB@@A@@A@@@AA@AA@@A@A@@BC@A@@BAB@@ABAB@@@@@@@B@@@@BB@@@B@B@@@D@@@@@B@@B@@BB@@@@@@@B@@A@AB@B@B@B@B@@@@AB@@@@A@@B@B@@@B@@B@@@@@@@B@A@@A@@B@@@B@B@@@B@@@BB@B@@B@@A@@B@@@@@@@B@@@B@@AB@BAB@@ABAB@@@BA@BB@@@ABAD@BA@@@BB@@@BB@@B@B@@ABAB@@@@D@BAB@@ADA@ABA@@@A@C@A@AB@@@D@D@DBDB@@BAAABA@@B@@@B@@@@@BAB@BA@@B@@@DB@@BBBB@@B@@AB@DABBB@@@BBBB@BB@@B@@@BB@@@@A@@BA@A@@@AB@@@B@BB@B@@@BBBB@@@BBA@@@ABEDA@@BAB@B@@BD@@@@B@B@B@B@BA@@B@@A@@BB@@DABAB@BAB@BABADA@@B@@@BBB@B@B@DBB@@@BB@@@B@B@@@D@@@BB@BB@@@@B@@@@ABA@@D@BA@@@A@A@@@@B@@@BDBBB@BBBB@@@@@D@B@@D@B@B@D@B@@@BA@@@A@A@A@@BAB@BA@@BA@@@@AAA@A@A@@A@@AA@@@@AAAAACA@@AAA@CAAA@@AA@C@AAA@@A@@AA@BC@C@@@ABA@@A@@CC@@@@A@@AA@AAA@@CAA@@@A@A@CBA@A@AB@@@@@B@@BB@@@@B@BBB@@@@B@@@@@DABB@@@A@@@E@@@@@@@@B@@@FADAB@@@@@BBB@@@B@@@@A@@@A@A@@CE@AAAA@@A@@@AB@@@FAB@@@B@BBBB@@@BB@@@@BB@H@@@@@@@@C@A@@BADABA@@@A@A@A@@DEDC@AB@@A@AA@@AA@A@A@A@@@AA@@@@B@@CBC@A@@@@AA@@@A@A@@CA@@A@@A@@A@@@A@@@@A@AA@A@CAA@AA@@@@@@B@BABABABAB@@@B@B@@@@@@@BA@@@@D@BA@@DBD@B@@@@@BA@@@AA@@AACAAA@BA@@@ABA@@BC@@@AAE@@AA@@@@@AB@B@BA@@@AB@@A@@B@@@B@@@@AB@@@@BBB@B@@@@B@@A@@@@B@@@B@@@@A@@A@@A@@@@@@A@@@@AB@@AA@A@@A@@A@@@AD@@A@@@@A@@AA@@@@@@A@BA@@@@AAA@@@@@A@@@@A@@@@A@@A@@A@AB@B@BBBA@ABAB@@@@@AAA@AB@@@@A@@@@@@@A@@AA@@@A@ABA@@@AA@@A@AACBA@@D@@@@AB@BAFABA@ABABGBA@@DABA@@@A@@@A@A@A@@BC@ABC@@@@@ACAA@@@A@@@@BA@@@A@A@A@AACC@@A@E@C@@@@AACCE@A@AAA@@AA@AACA@@AB@@@BAB@@@B@@@B@B@@@DDD@B@@@@A@@@A@@@@@@A@C@A@@AA@A@A@@AA@@@@@@@BC@@A@AA@CA@@@@@@BA@@@@AAAA@AA@@@AA@@A@@A@AB@@@B@@BB@@A@@@@@@B@BA@@BABB@A@@@@BB@@@@BA@@@@@@@AB@@AA@CBAA@@C@@A@@@@@A@@B@@@@@@AAA@AB@@A@@@A@A@A@@@@A@A@@@A@A@ABABA@A@@@A@@@@@AA@@A@@A@@B@B@@EDABABABA@@BA@AA@@@@A@ABA@A@@B@@A@@@CE@A@A@@A@@B@@AB@@@@A@@ACA@@@@@@A@@@@B@@@BB@@@@B@BA@B@ABB@@B@B@B@@A@@B@@@D@@@@A@CAA@AA@@@@AA@AAAAACAAA@AAAA@A@AA@@A@AB@@C@A@A@AAA@AA@A@@@@A@C@A@A@A@ABGCA@@B@@AB@B@B@@@B@D@@@BABAB@BAB@@@B@B@BBB@B@FBD@B@@@BCFABADAD@@A@AB@@CAC@A@A@@@@A@CAABE@A@ABC@A@@AC@@@ABA@A@@A@@@@@@@@AA@A@A@@@C@@@A@@B@@A@CB@@@B@@ABA@@@ADA@ABA@@@AB@BA@@@@@A@@@@A@@@@AA@@AAC@@@A@A@@@@AAA@@AAA@@A@@@ABABABA@CA@A@@@@A@AA@AA@@CB@@@@@@@AAA@A@@@ADA@ABA@@BA@@@@A@AAA@C@AA@@C@C@@@@@AD@@ABC@@@@@@B@@ABAB@@@@A@@@AB@@CBA@A@@@@@AACC@A@A@ABABC@@@@AA@@AAC@@@@@ACA@AAAAA@CAA@AAA@@@@A@AAA@A@A@@@@AB@BA@@A@@A@AB@@AAA@@AA@@@A@A@@@@@BB@B@@DB@@B@@@@B@@A@@@A@@@A@@@@A@@A@@@@@AB@@@@@D@B@B@@A@A@C@A@A@A@@@AAA@C@@@@@AD@@BB@D@@AB@@CB@A@@A@@BA@@A@@CB@@@@A@AA@@@@@@@A@AB@@@@A@@@@CBA@@BC@@@AAA@@@A@A@ABA@@B@AAA@@@@@@AA@@@@@@@@@@ABA@@BCAA@ABA@@AAA@AA@@@A@@@AA@A@AAC@AAA@@AA@@@AA@@@B@@@AA@ACC@@@@@ABA@A@A@@BA@@@ABA@A@A@@@A@AA@@@@C@A@@@AACAC@A@C@AAA@@AAAA@@@@AA@@@A@A@@@@@@A@EAC@A@@AA@A@@@B@@@A@@@@@@@A@A@AB@@@B@@@@@@@@A@@B@@AA@@@@A@@@@BA@@@@AA@@@@@AA@@@AAAAA@@C@A@@@AA@@@@A@A@@@@@@BAA@@@@@@@B@@A@@@@A@@@@@A@@A@@@A@@@AAAAA@@@@AAA@@@A@@AAA@ABC@@A@@@A@AA@@A@A@@@@@A@@AAA@A@@A@@A@@@@A@@AA@@@AA@@A@A@@A@@AAA@A@@@A@C@A@A@@@@@@BA@A@A@@@@@AA@AAA@@@@ABADC@@@A@A@@BA@@B@B@@B@@@B@@@B@@@@BABA@@@A@@@A@A@@B@@@BA@@@@@@B@@@B@@AB@@@@A@A@@B@@@BA@CBA@@@AA@@AA@A@@ABA@A@A@@A@AA@@B@A@A@A@@A@@AC@@@@C@@@@AA@@@@A@@@A@A@@A@@AA@@AAA@A@@AA@A@@BA@A@@BAAC@A@A@@AAC@AAAA@A@A@@BA@@D@@@B@@@B@BCBA@@B@B@@AD@@@BA@A@@@@A@@@@@A@@A@@@@BA@@DA@@B@B@B@@@B@@A@A@@A@A@A@@A@@@AAA@@@@@@@@BA@@@@BA@@B@B@D@@AB@@@BA@@@AA@B@AA@@@ABAA@@A@@@@BC@@@@B@@AB@@ABC@@@A@@@A@@@A@@@A@@@A@AB@@@DC@A@@BA@@B@@BBB@@@BB@BB@@@B@@BBB@@ABBBA@@FBBBD@B@@@B@BBB@B@BB@AB@BABAB@@@@@@@B@@@@AB@@AB@@@@AB@@A@A@@@A@A@@A@@@@@A@@@A@@BAAAB@@@@A@@@@A@@@@FED@@A@@@@@@AA@@A@ABA@@BA@@A@@@@BA@A@ABA@@A@@C@@@AA@@@AAA@A@A@AA@@@@BA@A@A@@AA@C@A@@@C@@@A@@AA@AAA@A@@@A@@A@@@BE@@@A@@@AA@@ACA@@@A@A@C@@@@@@A@CA@@@@@A@AB@@AB@BAB@DBBA@@B@@@A@@ADA@@@@BD@B@@B@@@@AB@BA@@@A@@AAACAA@AA@@A@@@@B@@@@BB@B@B@BAD@@@@ADA@@BEBCB@B@B@@@BAA@@@CAAA@@@A@@AAA@@@@ABAB@BABABABA@@@@@@@@@A@@@@A@@B@@AAA@@@@A@@@@AA@@@A@@A@@@@@A@@@A@@B@@A@ABA@@BA@A@@@@AA@@AAAAC@A@@CAAA@@@@A@@@CBA@@@@@BB@@B@BB@@B@@@B@B@@@@@@@@B@@E@CBA@A@A@@B@@@@BB@@@B@@A@@@AA@AA@@@@AAA@@@@@@ACA@@AA@@B@@@B@@@B@@AAAAAA@@AA@AA@@A@@A@AB@A@@@AA@A@A@@@AAA@AA@@@@AAABA@@AAAAAA@AA@A@@@A@@@@CAAA@@@@AA@@@A@@A@@@AA@@DA@E@@A@AAAA@@A@A@@@A@@B@BA@@B@@CDAB@@A@AAAA@@A@AAA@AB@BA@@DAB@BA@@@C@A@CB@@A@@@EB@@A@@AA@A@@@A@A@A@@BC@A@AA@@A@EI@@@@A@@AA@@AAB@@@AAA@@BA@A@C@AAAAA@@B@@@AA@@A@@@AB@@@B@@@B@B@@A@@@AA@A@@A@@@AAAA@A@@@A@@A@A@BAAA@@@@AB@B@BA@@@A@@@@@@@@@@A@@@A@AA@@@@@A@@@@@A@A@@@@@@@@AABAA@B@@A@@A@@@@@B@@@@@@@@@AA@@@@@@@@@@@@@@@AA@B@@@B@@@B@@@@A@@@A@A@@@@B@@AB@@@@A@@@@@@@@BA@@B@@@@A@@@A@A@@@A@A@ABA@A@A@@BAAA@@@A@@@@B@BA@A@@@A@@@CAA@@@AACC@@A@AA@@@CA@AA@A@AAA@@AC@AA@@A@@@A@@@A@@A@@@@@@A@@@@@@@@@@@A@A@@@@AA@@@@B@@@A@B@@@@A@@B@@A@@@A@@@@@A@@@@@@B@@A@@@@AA@@@A@@B@B@B@@@@A@@@@@@A@AA@@A@@@@@BA@A@A@@@@B@AA@@@@A@@@B@@A@@@@@AB@@@@AB@@@@A@@@@@A@@@@A@@@@A@@B@@@@@@@@@B@@@BA@@@A@@C@A@@A@A@@@A@A@@@AA@A@C@ABAB@@AA@BA@@@AB@B@@@@@@B@B@B@B@@@@B@@AB@B@@@@@BA@CB@AA@AA@@@@@ABA@A@@@@AA@@CB@@A@@AA@ABA@@@@@AA@@A@@@@@A@@@A@@B@B@@@B@@@@@@@@B@@@@B@@@@B@@B@@@@@@@BAB@@BB@@@@@B@@B@@B@@E@@@@B@@@@AB@@AA@@@@@B@@@@@@@BA@A@A@@@@@A@A@A@@@A@AAC@A@EA@@BAAA@A@@@A@@@A@A@@BA@@@A@AAA@A@@B@BA@@@@A@@A@@BA@AB@@@@A@@@A@A@@A@@@A@@B@A@A@@@@A@C@@@CAA@A@@A@C@@@@AAA@@AB@@@@@B@BC@@A@@ACB@AA@@@BA@@@@CA@AA@C@C@A@@@@@@B@D@@@B@ACB@@@@@@A@@@AB@AA@@A@@A@ABA@A@A@@A@@@A@@A@@@C@AA@@A@A@@@@@A@@@@B@DAD@B@@@@A@@@AABABC@@AA@@@AA@@@ABA@AB@BA@@@AA@A@@@@BAD@@@B@@AB@@A@@@AABAB@@AA@@A@A@@@@@@@@ABAA@@A@@@A@A@@AAA@AA@@A@A@@@@BBB@@B@B@B@@BA@@@@@AB@@A@@@@B@@@@A@@@@@AB@@@@@@@A@@@@@A@@@A@@@A@@A@@@@@@@B@@AA@@@A@A@A@@@AA@@A@@A@BA@@@@BA@@@@@@@A@@@@@A@@@@@A@ADCB@@@BBB@BB@@@B@@BBB@B@B@@@@@@@@@@@@C@A@A@A@@@@BA@@@@B@@B@BBDB@@@B@@@@ABA@@BA@@B@@A@@@A@A@A@A@@@AB@@C@A@A@@@CC@A@@A@@@@@@B@@BB@@@B@B@@@B@B@@A@@@A@@@@@@@A@@@@AABACA@A@A@C@AAC@@BA@@@ABAH@@@BAB@@@B@B@@@@C@EAA@CAA@AA@@@A@@AAA@AAA@CC@AC@"],encodeOffsets:[[119318,26617]]}},{type:"Feature",id:"350425",properties:{name:"大田县",cp:[117.847115,25.692699],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@AA@@A@AA@@@@@@@@@A@@A@A@A@A@AA@@@@CA@@A@@@A@A@@@@@A@A@AB@@@BA@@@AA@@@B@@A@AACA@@@@@A@@AA@@AB@AAB@@@AB@@@@A@AA@@@A@A@@AA@@AA@@@@@@A@@@@A@@@@@A@C@@@A@@@@@@A@@@@A@AD@A@@A@@@A@ABA@@@A@@BAB@B@@ADABED@BA@@B@@@@AB@BA@@BABAB@B@@A@@B@@@BAB@B@@AD@@@BA@A@@@@BA@@BA@@BA@@@CB@@ABA@@@A@C@@@A@A@@@A@A@@@A@@A@@@@@AAAA@A@@@C@AA@@@@@AB@@@@@@@@@@@@AEC@@A@@@@@A@@@@BA@@BA@@B@@CAC@C@@@@@A@@@@@A@@@A@A@@@@@AAA@AA@@@@@@@ABAA@BA@@@AA@BA@ABA@AA@BA@@BAA@@@@@@AA@@A@@@A@@@A@@@@AA@@@@B@@AB@@@@A@@B@@A@@B@BB@@@@@A@@@@BA@@@@@@@A@@@AB@@AB@@@B@@BB@@@@@B@@@@@B@@@@@@@BA@AA@@@A@@@A@@A@@@A@C@A@A@@@A@@@@A@@@A@A@@@A@A@AB@@C@@AA@@B@@A@@@AB@@@@ABAB@@AB@@@@@@A@@B@@@@A@@B@@@@A@@B@@@BA@@@A@@B@@@@C@@@@B@@@@@@@@@BB@@B@@@@ABCAAB@@@@@B@B@@ABABAB@@@@ABAB@@A@@A@@@A@@@AA@@@AAA@C@A@@@AD@@AB@B@B@B@@@@BB@B@@@BA@B@@B@B@@@BA@A@ABA@@B@@A@@@@A@AA@@@A@AA@@@BA@@@ABA@@@A@@AAA@@@AA@@@A@@AA@@@A@@@@@A@@@@@A@AB@@@@CBA@@@@@@@@BAAA@@@@BA@@B@@@B@B@@@B@AA@@@@A@A@@A@CB@@@B@B@@B@@@@@B@B@@@B@@@@B@@@B@@@@@@@@A@BB@@@@@@@@A@@@@@C@@@@@A@A@@@A@@AA@@@A@A@@A@@AA@@ABABA@A@ABA@@BA@AAA@@AAA@@@AA@@@AB@@@@AA@A@@@@A@@@@@A@@BA@CDA@@@A@@@ABA@@B@@A@@@AB@BABEEA@C@@@AAAA@@A@AA@@@AAA@@@@AB@@AD@BA@ABABAB@@@@@B@@A@AB@@@@@B@BBB@B@@B@@@@BB@BF@@@B@@@BB@@B@B@B@D@@BB@B@@BB@BB@BB@BAB@DB@@B@@BB@@@BBJBB@B@BB@@BBFB@@@BAB@@ADD@@BB@BB@B@B@@BDB@@@@@@@C@@@A@@@@B@@@B@@@@@B@B@@@@@@@B@AA@@BAB@@@@@B@@@@ADCDC@@@AB@@@B@@B@A@A@@B@@A@A@@B@BA@@B@@@B@@@B@@B@@@B@@@@@BB@@@BB@@@@B@@@@B@@@@@BB@@@@B@B@BBB@@BB@@DC@@B@@@@B@@ABBBA@BB@@@@@@BBB@B@@B@@BBB@@@@BAD@@@@BB@@B@@@BBD@@@@A@@@@@BBBA@@B@B@@@@BB@DAB@B@BB@DD@@A@@@@BAB@@DB@@@B@B@B@BDB@@BB@B@BBB@B@@A@@@@B@B@@A@@B@@@B@@@@@B@B@@@B@@A@@@@A@@A@A@@@A@@@@@A@@A@@@A@C@AA@@@A@@@@@@B@@@B@@ABA@A@@@EAC@@@@B@B@B@BB@AB@@AA@@A@@AA@AA@@AAAA@@@AA@C@A@@@@@@@@BA@A@@BA@A@A@A@@@@@A@@A@@@AAAA@@AA@A@@@@B@@A@BBABABA@@BA@@B@@@B@@BD@BB@@B@@@@@B@@@B@@ABA@A@@@A@A@@@@D@@B@@@@B@@@B@B@BBBA@A@A@@@AB@@A@@BA@@BA@BDA@@BAB@@AB@@@B@B@BAB@@A@C@C@@@AB@@@@@BA@@@@B@@CD@@AB@@@@@B@@A@@@AB@@@BA@@B@@A@@@AA@@@@A@@@@@@@AA@@@@A@@@A@@BA@@B@@AB@@@@A@A@@@@@ABA@@@@@AB@@@B@@A@A@@@@@AB@@A@@B@@@@A@@B@@@@@B@@A@AB@@A@A@@@A@@@A@@AA@@BA@@@@@@@A@@BBB@B@B@@@BBB@@@B@B@@@@AB@@@B@@@@B@@@B@@@@@B@B@@@B@@BABA@@@@@A@AB@B@@BB@B@@@@@BB@@BB@@@@D@@@BA@@BC@@@CBAB@@A@@B@@@BAD@@ABC@AB@DABAB@@@@@BA@@@A@@@@AA@@@BAA@@AA@A@A@@AA@BAAA@@A@A@@@AA@@@A@@AB@@@@AB@@C@@@A@@@@@AAAAC@@AAA@A@@B@@A@A@A@BA@@@AB@@A@@@@@@@AB@B@@@AA@@@BCBAA@ACA@CAAB@@AA@@@A@@A@A@AA@A@@@AA@@@@AA@@AA@A@ACA@A@A@AB@@@@A@@A@AA@@@A@A@@@AB@@@@@B@@A@@@@@@@@A@AA@@@A@@AABA@A@C@A@A@A@@@@@ABBB@B@B@BA@@B@BA@AB@@@B@@@@@DBBA@@@C@ABA@@@@B@@@B@@@B@@@AAB@A@@A@@D@@@B@@@@@B@@@BB@@@DBBB@@FB@@DDA@@B@B@@@@@@@BA@AB@BA@@@@BAB@@@@A@@B@@ABCBA@AB@B@@@@@B@B@B@BAD@@AB@B@@A@AB@@@@@@A@A@@@@BAB@@A@@@C@@@@BA@@@@@@@AAA@@C@@A@@@@@AAA@@AAB@@@BAB@@@@@@A@AA@@@@A@@@AB@@@@C@@@@BA@CB@@@@AB@@@BBB@@A@@@@@@@@@@@A@ABA@A@@@A@ABA@C@@@C@@B@@@@BBB@@B@B@FAB@B@@@@@BA@@@AB@AA@A@@@@B@@@@@B@BA@@B@@@B@@@@B@@B@@@B@@@B@@A@@B@@@@@BAB@@ABA@AB@@A@@@A@EA@@@@@A@@@A@@AA@@@AA@AB@@A@A@@@@@CAA@@@A@@A@@C@@AA@@@AA@B@@A@@@A@@AAAA@@A@@A@@AA@@@AA@@@@@A@@@@AA@@@A@AA@A@@AA@@A@C@@CAAAAA@@AA@@@AA@AA@@AB@@@B@@@@AB@@@BBB@BB@@BA@@BA@A@@AA@@@A@C@@B@@@@@@BB@B@B@@AB@BFD@@AB@B@@AB@@A@@@@@AB@BB@@@@@@B@@A@@BA@@@@B@B@@@@A@@B@@DDBB@@B@@@B@@B@@B@@@BB@@B@@B@@@@@B@@B@@@B@@B@@@@@@@@B@@B@B@@@@@BA@A@@B@@@@@B@@@@B@@B@@D@@@@@@@@@@B@@@@BB@@@B@@@B@@@B@@@B@@@B@@@BABA@@B@@@@@B@B@@@B@@@B@@@@@@A@@@A@@@@B@@AB@@A@@@@@@B@@@BB@@@@B@B@@@B@B@@@B@B@@ABAB@@AB@BA@AB@@B@BB@@@B@@@@@B@@@@B@@B@@B@@B@@BB@@D@DB@@B@B@@@B@@BB@@@B@B@@BB@BB@@@@@BA@B@A@BBABB@@BA@@B@@@@BB@B@@BB@@@@@@@B@@BB@B@@@B@@@@@B@@@@@B@@CB@BA@A@@BAD@@@BA@A@@@@@@@@BAB@@A@@@A@@@@B@B@@CB@B@@@@@@@B@B@@B@@@AB@B@@@B@B@B@@@@@B@@@B@B@@@BB@AB@B@@@@B@B@@@BB@@B@A@@BB@@@BB@@B@@BB@B@@BBBB@BB@BB@@@@@B@@@B@BB@@@@B@@B@@@@B@@@BA@@BA@@@@BABA@AD@B@DAB@B@DB@@B@BB@@B@@@B@A@@B@@@B@@B@@A@@B@@@@BB@BB@BBDBDBBB@@B@@@BCDA@@BAB@@AB@@A@@@@BB@B@BBB@@@BDB@BB@BABAB@@AB@@A@@BCA@@AB@@@BBB@B@B@B@B@B@@@@HDB@@@B@B@@@@BB@@@D@B@D@B@BAB@@A@@@A@A@@B@BB@@@@@B@@@B@B@@BBDB@BB@@@@AB@@@@C@@@@B@@@FA@@@B@BAB@@BHB@@B@B@B@B@B@BBB@BBB@@B@@@DD@BBB@B@@@@CDA@@@BD@B@@B@@@B@@@@@BC@@@A@@B@B@@BB@B@BBB@B@B@DA@@@@@A@AA@AEA@@@@@B@B@@A@@@@B@@@BA@@@BDFBBB@@@B@BABA@@B@@B@@@B@@B@@@B@B@B@@@@@B@B@BBB@DB@@@CBC@A@AAAAA@AAA@@A@@@A@@A@@@@@A@@AA@@@@@A@A@@@@@AB@@@@@A@CCCAAA@@B@@@DA@@BA@@BC@@BA@@@@@AAA@@A@@@@@A@@AA@@@B@B@D@D@B@@@AA@@BAAA@@@@@@@A@@@@AA@B@AA@BC@@BADADABA@@@@@A@@@A@A@@@AB@B@@@@BB@@@B@@@BAB@@BB@@A@@BA@@@@B@B@@@BBB@B@@@B@BA@@@@B@@@D@@@@@B@@@@BB@@B@@D@@@B@@@B@@@@@BBB@@BB@BB@@B@@@@@BBB@@B@@@BB@@@@@@B@B@@BD@B@BB@BA@A@@BA@@B@@@@@@B@B@B@@BB@@B@@@@BB@@B@@BB@@@BB@B@BBB@BBB@@B@@D@@@@@B@@B@@B@BB@@B@BBBBB@BBBBDDBBBB@@@BBB@@BB@@@@@BB@@B@@B@B@@AB@B@BE@AB@B@@AB@@AB@DAFA@@B@@@@BA@@B@@BB@B@D@@@@@@B@BABA@@B@@@@B@D@BB@@@B@@@BBBD@B@B@B@@@@BB@@D@B@BB@@@B@@@B@@B@B@@B@@@@B@@BB@@@B@@@B@@B@@@@@@@B@@B@@@@B@@B@@BBB@@B@BB@@B@@@@BB@@B@@B@@B@BB@@@BBBB@@@@@@@B@@B@@@B@@B@B@@BB@B@@@@@B@@A@@B@@B@@B@@B@@@BB@@BD@@@@AD@BB@@BAF@D@@@B@@B@@@B@@@B@@B@@@D@@@B@@AB@BABA@@@BDB@BBB@BBB@@@B@@B@BBB@@D@@B@@BBBB@B@@@@A@@BCD@BA@A@AAAB@B@@@@@@BB@@B@@B@@@D@B@@B@B@@@@@BB@F@@DB@BB@@BBBBBB@BBBB@B@BB@@@@B@@BBB@@@@BBB@BBBB@BDB@@@@@B@BAD@@@B@BBBD@B@@@@B@@@@@@@@ABC@@@@@@B@BB@B@@B@B@@@@AB@@@BBBB@@@@@B@BA@A@@B@@A@@@@B@@@@B@@@@@B@@B@@@B@@BBDBB@@@@@B@@A@BB@B@BABA@@@@B@@B@@@@B@@A@ABA@@B@@@B@@@@BBBB@@@B@BB@@@@BB@D@@BBB@BA@@DBB@@B@B@BB@@BBB@@BB@B@@AB@@@B@@@@@B@@@B@@AD@BBB@@A@@B@BAB@@@B@B@BBB@B@@BBB@@@@DBB@B@@@BGBEDA@A@ABA@GBA@@@@B@@C@A@A@A@@A@A@@@AA@AAA@A@@@@@@BA@@B@@ABA@@BA@@@AB@@ABA@A@A@@@A@@A@AA@A@CB@@AB@@A@@@@B@@@@@@@@B@AB@@CBAB@@@@@B@@B@@@@A@BB@B@@@B@DA@@BABB@@@@AB@B@@B@BB@@BB@@ABABABAB@D@@AB@BB@BB@@@B@@AB@B@B@@@BB@@@B@B@@@@@B@@B@@@B@@@BBB@B@B@D@@@B@B@@BB@BB@BB@@AB@BBBA@@@A@@B@@AB@@A@AAA@@@ABA@@@A@A@A@A@@B@@A@@A@@@@ADA@@@A@A@A@@@@@AB@@@@A@@BAB@@@@A@@@CB@@ABA@@@@@@@@@AA@@@A@@B@@AB@FA@ABA@@A@@@EAE@@@AAA@@@@@@A@A@AB@@@@AAA@A@@@ABA@AA@@A@@A@EBA@E@A@A@ABA@A@A@CB@@AA@@A@AAAA@@A@@@AA@@@A@A@@@@CBAB@BA@@@AA@@A@@AAB@@ABAA@@C@@@@@AB@@@@@BA@@B@@A@@AA@@@@BA@@BA@A@@@AA@@AB@@@BA@AB@@@@AB@@@@AB@@AB@@@B@@@B@@@@@BA@@B@B@@A@@BA@C@A@A@@@@A@@@@C@AAA@@@CAAACAA@@@@@@@ABAB@@@D@B@B@B@@CB@@@BA@@@A@@B@@@@@B@@B@DBB@B@BD@@@@DDB@B@@@BB@@@@ABAD@@ABB@@B@@A@@B@B@@@B@BBBBB@@AB@BAB@BAB@BA@@BA@@BA@@B@@@B@B@B@@B@BBB@@@@AB@B@BB@@B@@@BB@@BDB@B@@@B@@@B@BB@@@@AB@@@@B@@B@@@B@BB@DBB@B@@BB@@@@B@B@@@@BBB@B@@@@B@@B@B@@@B@@@B@BBBB@@B@@@B@@B@BBB@@@@B@B@@@@BB@B@@@BB@@@@BBB@B@@BB@BBB@B@@@BB@@B@BAB@@@@BBB@@B@@@@@B@@@@@BB@@B@@@B@@@BBB@@@B@@@BB@@@@@B@@B@@@BAB@@@B@B@BC@@@@B@@A@@@AB@@@@@DAB@B@B@B@@@B@@@B@@@@AB@@B@@BA@@@@B@@@AB@@B@@B@@B@@AB@@@@AB@@@@@@@@@@A@BB@B@@ADC@@BB@AB@B@@@BA@@@AB@@@@@@AA@@@AA@@@@BAB@@AB@@@@@@@@@@A@@AA@@AA@@@@A@@B@AA@@@A@@@@A@@A@@@B@@A@@BBB@@@B@B@@@DCDA@@B@@A@A@@BA@@@@@@@@B@B@@AB@@A@@BB@@BB@@@@D@@@@@B@BBA@@@BDB@@@BBB@B@@@@@B@@@@AB@@@@@@@BB@@@@@@@BB@@@@@@@BB@@@BBBBB@@B@@@@B@@@B@@@BB@@@BB@@BBB@@@BBBBB@B@B@@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@B@@B@@B@@B@@DB@@@@DB@@@@@B@@A@@BA@@@A@@@AB@@@@@@@@@B@@@BB@@@@@B@B@@@BA@@@@B@@@@@@B@B@B@@@AA@@@@@@@@@@@@@@@@@@B@@@@BB@A@@BBB@@B@@@B@@A@@@A@@@@B@@@BB@@@@@@BBAB@@@B@B@@@B@B@BBBB@@@@@BET@@@D@@@@ABBB@B@@BB@@BB@@B@@@BB@@AB@@@@@B@@@@@@A@@B@@@@A@@@@B@@B@@@@B@@@@@B@@@@@@@B@@@@@B@@A@@@AB@@@@B@B@@@B@@@B@B@@@BA@@B@@@@@B@@@B@BB@@@B@@@@@@B@AB@@B@@B@@@@@@@@BAB@B@@A@@B@BA@@@@@@B@BA@@BA@@@@@AB@@@@A@A@@@A@@@C@CBA@@@AB@@B@@@@@B@@@ABB@@BA@@@@@@@A@@B@@@@A@@@@@@BA@@@A@@@@@A@@@A@@A@A@@BA@@@A@@@A@@@@@@@@@A@@A@A@@@@BA@@B@@A@CB@@@@@BC@A@@@@@A@@B@@@B@@A@@@AB@@@B@@B@AB@B@@@B@@@@@@@B@BA@@B@@B@@B@@AB@@@@@B@B@@@@@B@B@@@@@B@@AB@@@@@BBB@BA@@B@@A@BB@@@@@BA@@@B@@B@@@@A@@@A@@@@@@@A@@@@@A@@@@A@@@AABAA@@A@@@@@A@@@@A@@A@@@@A@@A@@@@@@B@@@BB@@@@B@@@@A@@@@@BB@@@@@@@@@@@@@B@@B@@BA@@@A@@BA@@@@@A@@@A@@@A@@AA@@@@@AAAAAB@@A@@@@@@@A@@B@B@@@@@BB@@B@B@@@BA@AB@B@@@@@@@@@@B@@@@BB@@@@B@@BB@@@@BB@@BB@@B@@BB@@@@BB@@@@B@B@@B@@H@@@@BB@@B@@BB@@@B@@BB@@@B@@B@@@@@@@B@@A@A@A@@BA@@@A@A@@B@@@B@B@B@@@@@B@BAB@BB@A@A@@@AB@@@BAA@@@B@DBB@@AB@D@@@BBB@A@ABA@@B@B@@B@@BB@@AB@@@@BB@@@A@@@@B@B@B@@@@@@@B@@@@B@B@D@BB@@@@@@B@@@B@B@@@B@BA@@B@@@@@@CB@B@@BB@BA@@@@@A@BB@@@@@BA@@@BB@@@B@@@@A@@@@@@@AB@@@@@@@@@@@@@@@@@@@@A@@A@ABA@A@AB@@A@A@@ABA@@@ABBB@@@B@@@@@@A@@@@AA@@@AB@@A@@@@A@BA@@AA@AA@@@@@@@BA@@@@A@@A@@BA@@B@@A@@@@@A@@@A@@B@@AB@@AB@@A@@@@@A@@B@@A@@@@@A@@BA@@@A@@A@@A@@@@@A@@@A@@@@BA@A@@B@B@@@BA@@@A@@A@@A@C@@@A@@@@A@AB@@A@@@A@A@@@@A@@@A@@@A@A@@@A@@@A@@BAA@@@@@B@@A@@@@@A@@@@B@@@B@@@BA@@@A@@@@@@@A@@B@@A@@@AB@@@B@@@B@@@@A@@B@@A@@@@@@@A@@@@@A@@@@@A@@A@BAA@@@@A@@A@@A@@@@A@@A@@A@@AB@@@@@@AA@@A@@AA@@B@@@AA@@B@@@BA@@@@@A@@@A@@B@@A@@@A@A@B@@A@@@@@A@BA@@A@@@@@@@AAA@A@C@AA@@A@BA@@@@@@@@B@@A@@B@@@@@BA@A@@C@@@A@@A@@@@@AA@@@@A@@@@@@AA@@@@@A@@@@B@@@BA@@@C@@@@@A@@@A@A@@@A@@A@@@@B@@A@@BA@A@A@A@@@A@@@@A@@@@@@@A@@A@@A@@@@A@@A@AA@@@B@B@B@BA@@@@@@BAB@@@AA@@@@AA@@A@@@@C@@A@A@@@AA@@@@@A@@@C@@@@@A@CA@@@AAA@@@A@@@@@@BBB@B@B@@A@@@@@AA@@@@AA@@@@A@@@@A@@A@@@@AC@@@AAAA@@A@@A@@@@@@@A@@@A@A@@A@@@@@AA@@@@@AA@@A@@@A@@BA@@@AB@@ABAAAA@@AB@@@B@@AA@@AA@A@A@@A@@@@B@@@B@@@BA@@@AB@@AB@@A@A@AB@@A@@@@@@B@B@@@B@B@@@@@@BB@@@@BB@B@B@@@B@B@@@@BAB@@@@@D@@@@BB@@@B@@B@@B@@B@D@@@BA@@B@@@BC@AB@@AB@@@B@B@BABCDA@A@CBA@AA@@@BAB@@@B@@@@A@@@AB@BA@@@CB@@@@@@AA@@A@@@CAA@AB@@A@@@A@A@@@AB@@AB@@@@AB@BBBBB@BB@@@@@D@B@@@BB@@@@B@BB@@@B@@@B@@A@A@@@@BA@@@A@@B@@A@A@@B@@@B@@A@@B@@A@AB@@@B@@A@A@@B@@@@@BB@@B@B@B@@@B@B@B@@B@B@DBB@BB@@@B@B@B@@@@BB@@BBB@@@@D@@@BBB@B@@@BA@@@B@@@@@BA@B@@@@B@@@BB@@D@@@@@@B@@@@@BBDB@@B@@A@@@@BA@@@@B@BB@B@@@B@@@@@B@BB@@@@@@@@B@B@@BBB@B@@@@B@B@@@B@B@BB@@@BBB@BBB@@@@@BB@@B@B@@@B@@ABBB@@@@@B@@B@@@BB@@B@@@@B@@@@BB@@@@B@@B@@@@@B@@A@@@@BA@@@ADA@AB@@A@@B@BA@@BA@@B@@@@AB@A@@A@@B@@A@@BA@@@A@@B@@@BBBA@BB@BA@@B@@@BA@@B@@BB@@BB@@BB@@@@@BAB@@@BB@@B@@@B@@@@@B@@B@@@B@@@@@B@@@@BBA@@B@@@@@BA@@B@@@B@B@@@@AB@@@B@@@@B@@@@@@B@@@@B@D@BB@@B@B@DBBB@@@@BBB@@@@D@@B@@B@@@BB@D@B@BA@@@@@A@CBABAA@B@@AB@@@AA@@@@BA@@@@@@@A@AB@@A@@@AA@@A@@@A@@@A@@AAAA@@B@@A@@B@@AB@@A@@BA@@DA@@@@@AB@@@@A@@@@@@AA@@@@B@@A@@@@@@@@@A@@AA@A@@@AB@@@@@AA@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@@@@@A@@@A@A@@@@A@@AB@@A@@B@@@@AA@BAA@@AB@@A@@B@B@@@@@B@@@@A@A@@@@@A@@BA@@@A@@AA@@@@B@@C@@@@@@EB@BA@@@@@A@A@@@A@A@@AA@A@@@@@E@@@A@@B@A@@@@B@@AB@@@@@AAA@@@@@A@@A@@A@@@@@@A@@A@@@AA@@@AACBA@A@C@@@A@AAA@A@@AAB@AC@A@@@@@AAC@A@A@@A@A@@@A@@A@@@@A@@A@A@@A@@A@A@A@A@A@A@@@A@@@@@A@@@A@@@AAA@A@@A@@@@@@C@@@@BA@@@A@@@@@@@A@@BA@@AA@@@@@@BA@A@@ACAA@A@AA@AA@@@@A@A@@@A@@@A@@AA@@AAA@@E@AAA@@@@@AA@AA@@@A@@AA@A@A@@A@@AA@@@A@@BABA@C@@B@B@@A@@@C@A@C@@@A@@D@B@@@B@@A@A@C@@@A@@@A@@@A@@@@@@@A@A@@@@@A@C@@@AAA@A@A@@@A@@@@@A@@@AA@@@@AAA@@@AB@AA@@@A@@@@@AA@@@@A@@@A@@@A@@@@BA@@@@@A@@BA@A@C@@A@AA@@B@@A@@@AAAB@@@@A@A@A@@@A@@BABA@ABA@A@@@@@@D@@@B@B@B@BA@@@@@AB@B@@BBA@@B@B@@BB@BBB@@@BA@@B@@@B@@A@@@@@@BA@@@@DA@@BBBA@@B@@@@A@@@@BABAB@B@D@DAB@BAB@@@@A@@BAA@@@@A@@@@@AAE@A@A@@@@@A@@A@AD@B@D@D@@AB@@@@A@AEA@@AAAEAAAC@AA@@@@BA@@AA@A@A@@@A@@@A@@AA@@A@AAAAAA@A@A@@@C@@@CAAAA@A@A@A@@@@@A@A@@@AA@@@AA@@AA@A@@@AB@B@B@@@@A@C@ABA@A@@BA@@AA@@@A@@@@@A@@@@@A@@@A@AD@@A@@@AAA@AA@A@@ABA@@@AAC@@@A@A@C@@C@@A@A@@@@AA@@A@A@ABA@A@@@AC@@A@@@@@@A@@A@@@@@@@A@@@@@A@@@A@@BA@@BA@A@ABA@@@@BABA@AB@B@@@@AC@@A@@C@@@A@@@@@@A@@@@@A@@@@@@@B@AA@@@AB@@@A@@@@@@@@A@@@ABA@@@A@@AA@A@A@@@A@@@@@@@@C@CBA@ABABA@@@@@A@BA@@BA@@@@@A@BA@@@@@@AA@BA@AB@@@@A@@@AA@A@@A@@B@BAB@B@BB@@DBB@@@@ACAACA@@A@@B@@B@@B@@@@AB@B@B@@A@@A@A@@AB@@@@AA@@@@@AB@@AA@@B@AA@@@@@A@@B@B@@@B@@@B@@A@@@AAA@@@AB@@BB@BB@@@@@A@@@@AA@@@ABA@AB@@@@BB@@@@@B@@@@@@BB@@@@A@@@A@@@@@A@@@@@AB@@@AAA@@ABA@@@@@A@@@@@@@AB@@BB@@@@@@A@@@@@@B@@@BBB@@@@AD@@@@@B@@B@@@@B@BABABA@@A@A@@@A@@@@@A@@A@@C@@@@@@A@@@A@A@@B@A@@A@A@@B@@@BAB@B@B@B@BB@@B@@@@A@@B@B@@A@@A@@@@AAA@A@@AA@@@AA@@@B@B@@@@A@A@@A@@A@@B@@BB@@@@@B@@@BB@@@@@@@@A@AB@@A@@B@@@@@@B@@BBB@B@@@BB@@B@@ABA@@@B@@@BBB@B@@@B@@@@B@@@@B@@@B@BA@@B@@BB@B@@@@@AA@@@A@A@@@A@A@@@@@A@@@@B@@@AA@A@@@@@@AA@A@@@@A@@@BA@@A@@@@@@@@A@@@@@@@@@C@@@A@@@A@@A@@AA@@@@@AAAA@@@@@A@@A@@@@A@A@@@A@A@A@@@AB@@A@@@@AA@A@@@@@AA@@AA@@A@@@A@@AA@@@@@A@@@A@A@@@@@@@A@@@@@@@AAA@B@@AA@@@AA@@@ABA@C@AA@BA@@@A@@@A@@@A@@@@@A@@@A@@@AA@@A@@@@AA@@@@@AA@@A@A@AA@@@@AA@@@@A@@BA@@@@B@@@@@BA@@@@AAB@@@@A@ABA@AAA@A@@@A@@@@@AC@@@@@@AAA@@AA@A@@@@CA@@@@@ABAB@@A@@AAA@@A@A@@@@@A@AA@@@@B@@A@@@@@@BABB@@@AD@@@@A@@@@@@@@@AA@@@@A@@@@A@@AA@@@@@A@@AA@AB@@AB@@@@ABAAA@@BAAA@@AA@@B@B@@@BA@A@@@A@@@A@@A@@@A@ABA@@@@D@B@@@@@B@@@A@@@@@@@@A@@@@BA@@@@@@A@@@@A@@@AA@@@@@@@C@E@@@@AA@@@A@C@@@BA@A@@A@@@A@@@AAA@@@@@B@BA@@B@@@@@@@@ACA@@@AA@@A@@@AAE@A@@@@@@BA@@B@@@B@@@@@B@B@B@B@DD@@@@BB@@D@@B@@@BD@DA@@B@BC@@@A@@AA@AA@@A@@@ABA@A@@BABA@ABA@C@@@AB@@B@@BBBBB@@@B@BABBB@BBD@B@@@@@@@@A@@@@@AB@@@DA@@@A@A@A@@AA@@@@B@@@B@B@@@B@DA@A@AB@BC@@@A@@A@@@AA@@@AB@D@BAB@BA@@@A@@B@B@@@B@@@BBB@B@@AA@@CAA@@B@@B@@@@B@@@@B@@@@@@B@@@B@@@@BB@@@@@@BB@@@@B@AB@@@@@@AB@BB@@@@AA@@B@@AA@@@@@@@@A@@@@BBDD@@B@@@@@@AA@@@@@AC@@A@@@@A@@C@@@@@A@@@@B@@@@@@@@@A@@@@A@@@@@@@@A@@@B@@@@@BA@@@@A@@A@@AA@@@@@@@@@@@@@AAB@@@@@@@@@@A@@B@@B@@@A@@@@B@@@@@@A@@@@@A@AAAA@@@B@BA@@@@B@@BBD@@@B@@B@@BBA@@B@@A@@@@@@B@@@@A@@@@B@@@@B@@@@BA@B@B@@@B@B@BB@@@B@@AB@@A@@@@ACAAB@@@@@@@B@@A@@@AB@@@B@BBBBB@@@B@BBB@BBBBB@B@BBBAB@B@D@BABB@@B@@@@B@@B@@BB@@BBB@@B@@B@@BB@FBD@@B@@@@@@@@C@@BADAB@B@B@@AB@@BBB@@B@B@B@@@DB@@@@@C@C@A@@BA@CDA@@@A@AA@@AA@BA@A@A@@@@@@@AA@@@BA@@BA@@@@@A@@AA@@@@@A@@@@@@@A@@@@AA@A@@B@@@BBB@@@B@B@@@@@@@@A@@@@A@@@AAAA@AAG@@@A@@@A@@@@@@CAA@@AAA@A@@@A@C@A@@@@A@A@@@AB@AA@@@A@AA@@AA@@@ABCBC@AB@DA@A@@@AA@@AEBA@@@A@@@@AAA@A@AA@@A@@BG@@@AB@B@@@A@@AAB@AA@@A@CA@@@@@A@EBA@@@@AA@AA@A@A@AA@@@A@A@@A@@@@@@@AA@@@@@@AAAA@A@AA@@A@@A@@AA@@@A@A@AA@A@A@A@A@@AC@C@@AA@@@@A@A@@CC@@@@@A@@AB@A@@@@@@AA@@@@@A@A@@@A@@@CBA@@C@@@C@A@@@A@@@A@AAA@A@@@@@@@@@@@A@@@@@A@@A@@A@@@@A@@@@@@@AAAAA@@A@A@AA@AAA@B@A@@@ABA@@@AB@@AA@@@BA@@@@@@@AA@A@@A@AA@BA@A@@@B@BB@@@@@@@@@B@@@@@@AB@@@B@@@@ABA@@AA@AAA@@A@@@@B@@A@@@CBA@@B@@@BA@@@@B@@@@@@@@C@@@@@@B@@@@@BAAA@@B@@AB@@@AABA@ABA@@B@@A@C@AB@@@@@B@@@@@BA@@@A@@@@@ABA@@@A@AB@@A@@@@@@@A@@@@@@AA@B@@A@@@A@@CA@@@@@@@@ABAA@@C@AA@@A@@@A@@ACA@@@@AA@@@BA@@BA@@@A@@@AAA@@@AA@@AAB@A@@A@@@A@@@@@@@@A@@A@@@@A@@@@@@@A@@@@@A@@@@@A@@A@@A@@AB@AA@@@@@@@@AA@A@@@AA@@@@@@@AA@AB@@@B@B@B@@@B@@@@@@A@@A@@ACB@A@@A@@A@A@@@@@@@A@@@@C@AB@@@@@B@@@B@B@@@@C@@A@@A@@@A@@AA@@@A@@@AAA@A@A@@B@@ABA@@@AA@AA@AA@@@@AA@@@A@@@@@AA@A@A@@@A@CAA@@@A@CAA@C@@B@@A@@BA@@@@AAAAC@AA@@@@@A@@@AA@@@@C@@BA@@@A@@@A@A@@@A@@@@@A@@@A@@@@@AB@@@@@B"],encodeOffsets:[[120712,26112]]}},{type:"Feature",id:"350426",properties:{name:"尤溪县",cp:[118.190467,26.170171],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@@@@A@@AA@@@A@@AA@@@AA@@A@@G@@@@AA@A@@@@@AA@@@@AA@@@AA@@AA@@A@@A@@AA@@@@@AA@@@@@A@@@@@@@@A@A@@BAB@@A@A@@@AA@@@@A@A@@@@B@@@@@@@BA@BBBB@B@@@@BB@@@B@@@B@@@B@@@@AB@@@B@@AB@@@AA@@@@@@@@@@@@@A@@A@@@@@B@@A@@@@@AA@@A@@@@@@@@BB@@@@@@BB@@@@@@B@@@@@BB@ABBB@@B@@@@@@B@@@@@B@@@@@@@B@@@B@@A@@@@A@@AB@@@@A@@A@BA@@@ABA@AA@@@@A@@BA@@@@@A@A@@@@@A@A@@@@@A@@BA@@@@AA@@@ABA@@@@@@@A@@@A@A@@B@AA@@@A@@B@@@BA@@@A@@@@B@@@@@BAD@@@@A@@D@BA@@@AB@@@@@B@BB@@@@@@@@@B@@@B@@@B@@AB@B@@@@B@@@B@@@@@B@@AB@@@@@@@B@@A@@@@B@@@@@@AB@@AA@B@@@A@@@@@AA@@B@@AB@D@D@@@B@@@B@B@@A@@B@@@@AB@@ABA@@@@@@@ABA@@@@BA@A@AB@@@@@@@@A@A@A@AB@B@@B@@@B@@@B@BB@BB@@BB@@@@@DAB@@@@@@BCB@@@B@@A@@@ABAD@@@B@@@AB@B@B@@@@@@BAD@@@BB@@@BB@@B@@B@@B@@@BBBB@@B@BBBDBDBB@B@B@@@B@@B@@@@B@D@@B@BB@@B@@@BAB@@@@B@@@D@@@@@@B@@@B@@@@@B@B@B@BB@B@@BBB@@@@BBB@@B@BB@@@@BAB@@B@@@@@B@B@@B@@B@@@B@@@@@B@B@@@@@D@@@@@D@B@B@B@B@@@@@@@B@@@@BB@@@@@B@@@@@@@B@@@@A@A@A@C@@@@@@@@B@B@B@@@B@@@@BB@@@@AB@@BD@@@BABA@A@@@A@A@CB@A@@@AA@@A@@AA@@@@A@AB@@@B@@BB@@@@@B@B@@B@BBB@BD@@B@BBB@B@@B@@@B@@BBB@@@@B@B@@@B@@B@B@BAB@@@@C@@@AB@B@B@@A@@DC@A@@@@@ABAFABADADA@@B@@@@B@@@BB@@@@@BCBAB@@@B@B@@@BB@@BD@DB@@@@BB@@@B@@@@@@@@@@@B@@@B@@@B@@@@ABADA@@@@B@@@B@D@FD@@@B@@B@@@@BB@@AD@@@B@@@@BAB@B@@@@B@@ADB@@BBBB@@@B@B@@@@@@@@B@B@B@@AB@@@FA@@B@BA@@B@@A@@@@@A@@@@@@@AB@B@@@@BB@@@B@@@@B@@@@B@@B@@B@@B@@@@@B@@@@@@BB@@B@B@@A@@B@BA@@@@B@@@BA@@@B@@@@B@@BB@@@@B@@B@BA@@A@@@@A@A@@@A@@BAB@@AB@@B@@@@AB@BB@@@B@B@@A@@@B@BB@@B@B@B@BBDB@@BDB@BB@@@@B@@@@@@@@@B@@@@@@@@B@@B@@@B@@@@@B@@A@@@B@@B@@@@@@@@BB@@B@@B@@B@@@@@@@AB@@@@@@BB@@@@B@@@@@@BB@@@@@@@B@@B@@BB@@@@@@BBA@BB@@@@B@@@@BB@@BB@@B@@@@@@@@BB@BB@@@BBD@@@BBBD@@AB@B@@@@@B@@@@@BA@A@@B@@C@@@BB@B@@@@@B@B@@BB@@F@B@BBB@BBB@@B@@@@@@BB@@@@@@@@BB@@@@@@@@B@@B@@B@@BB@@B@@@@@@@@@BAB@B@BAB@BABAB@@@@@BA@AB@@@B@@@@@@@B@@@B@@BB@B@@@@B@@@@A@@B@@@B@@@BA@@BAB@BA@@BA@@B@@ABAB@@@BAB@@@B@@BAB@@@B@BBD@@BBBB@@B@@@B@@BBB@@@B@@@B@@@B@B@@@@BBD@B@FCBB@@BB@@D@@B@@B@@@@@BB@@@@B@BB@B@@@@BBAD@@@B@@B@@B@BB@@@B@@@BCBADABABBB@@B@@B@@B@@@@@@B@@@B@@@@ABA@@@AB@B@@A@@B@@@@@B@@@@@B@@BB@@@@B@@B@@B@@@BB@@B@@@B@@A@@BA@@B@B@@@B@@@B@@@@B@@BBD@B@@@BBA@BB@BAB@B@@@BA@@@BB@@B@@@@BB@@@@@@BB@@AB@@AB@B@@A@A@@B@@AB@@@@B@@B@@B@@BB@BA@@BB@@B@BB@@BB@B@B@B@BBB@@B@@@B@@@@A@@A@@AB@@A@@BA@@@@D@BB@@@@@BB@B@@@@@@BB@D@@@@@B@@B@A@@AC@@BABEB@BA@@@A@@@@@@B@@@@@@BB@B@B@@@@@@ABA@A@@@A@@@@D@BAFA@@@@@@@A@A@A@@@@@@B@@@@@BA@@AA@A@A@@@@B@B@B@@@B@B@BB@BBB@@DBD@D@D@B@B@DB@@B@B@D@D@BA@@B@@@B@@@@B@@BB@B@@@@@B@@@BB@@@BB@@BB@@@B@@@@D@@@@B@D@@B@@@@D@B@B@@@B@@@@@@@B@@@B@@A@@B@B@@@BBB@@@@BB@@@D@B@BB@@@@@B@DAD@BA@@@@@@B@@AB@BAB@@@@@@@B@BABC@@BABABA@A@@B@@B@@BBBBB@@@DD@@BB@@B@B@@@B@@BB@B@BB@B@B@@@B@@BB@@B@BBB@@BB@BD@@@B@@BD@B@@@B@D@B@F@@B@@B@@@B@@AB@@@B@@@@@BB@@@ABAB@B@@@B@@B@@B@@BB@@@B@@BB@@BBBAB@BB@@@@B@@@BAHC@@@BB@B@@@BB@@B@BABA@@BA@@B@@BBB@@B@@@BA@@BAAC@@@A@A@A@@@CAAB@A@@A@@@A@@B@@@@@BA@B@@BA@B@AB@@B@@@@@BB@@@B@@B@@B@@@BD@@BB@@@BB@BBBBB@@@B@@@BB@@BB@@@@B@BB@@BB@@@BB@@@BBB@@@@B@@B@BA@@B@@AB@@@B@@A@@@AB@@BB@@B@@BB@@B@BAB@B@@@BB@@BB@@AD@@@B@@@BA@B@@@@BB@@@A@@BB@@B@@@BB@@BD@B@FB@@@B@D@BB@B@@@B@B@@@@@@@@A@@AC@@AAA@@@@AB@@AB@@@B@@@B@@BB@@@@@BA@@@AB@@@@@@@B@B@@A@@B@BCBA@@@AB@@AB@B@B@BB@@BB@@B@BBB@@@@@B@D@BBB@@@@@B@BBB@BB@@B@@@@B@B@@BB@@BB@@@B@@@@DA@@@@BB@@D@@@@@B@@B@@@@@B@@@@BBB@@@@@@BAB@B@@@BA@@B@B@@@@BB@@ABA@@@BB@@BB@@@B@@@@@@@BB@@B@@@BBB@@BA@@@@B@BAB@@A@@@@@@BD@@B@@B@@@@AB@@@B@@@@@@B@@@@@B@@@BADE@A@AA@@A@@B@@@B@@@@@@@D@B@B@@@BA@A@@BB@@@@B@@B@@B@BAD@@@@AB@@@B@@@@@B@@@BB@@@@B@@@@@@A@AA@@A@AAAA@@@@@@A@AA@BA@@@@B@@B@@@@BA@@B@@@@@@@B@@A@@BB@@BAB@@@B@B@@B@@B@@AB@@@FA@@@ABBB@@@B@@BB@@BBBB@@BB@@@@@B@@@@@B@@B@@@@A@BB@B@B@@@B@B@B@@@@CB@@@@AB@@@B@BA@@B@@AB@@@BBB@B@@@B@@@B@B@@@@A@@B@@BB@AB@@@@AB@@AB@@@@@@@@AB@@@@@B@BAB@@@B@@B@BB@@@@@@@B@@BB@B@@@@@A@@B@@@B@@BB@BB@B@@@@@AB@@AD@@@BB@@B@@AB@B@B@@@@@BB@B@BA@@@AB@@A@@@A@@@A@A@A@C@@@@@@BB@BB@BB@B@@B@@@@@BB@@@@@@BB@BB@@@@@BBB@@@@@@B@@B@@B@BB@@@@AB@@@@A@@@@AA@@AAAA@@@A@@A@AA@@@A@@AA@@@A@@@@@@B@B@@@@@@BB@@ABA@@@A@@@A@@@A@@@AB@BABA@@@@B@@@@@B@@@BB@AB@@B@@B@@BB@@@BBB@@BB@@@B@@@B@@@BB@@BB@@@@@BB@@@@@@B@@@@@@ABAB@@@@@B@@BB@@@@B@@@@B@@@B@@@@A@AB@@@BA@C@@BB@B@@BBBBBB@BBB@@BFB@@BB@@B@@@@AB@@CB@@@BAB@@A@@CA@A@@@@@@BA@@BBBAB@BBB@B@B@@AB@@A@@B@@@D@B@@@B@BB@@@@@@@B@@@@@BB@@@@@BB@@@@BB@@B@@BB@@BD@@@BB@@B@@BBB@@@@BAB@@A@@@@AC@A@A@A@@@@@A@@@@@@AA@@A@@@@A@A@A@@@A@@@A@@A@@@@@@@A@@AA@@@@@@@A@CB@AA@AA@@@@A@@AA@@A@A@AAAA@@A@@@@@A@@@A@@@A@@B@@@@A@A@@@@BAB@@ABABA@ABA@@@@B@@@BBB@@@B@B@@@B@@@@AB@@@@B@D@@@BB@@B@@@@BA@AB@B@@@B@@@B@@@@A@@@@B@B@@@@BB@@@AB@@@@@@B@AB@@@@@B@@@@@B@@@BBB@@BB@@B@@@@@B@@@BABA@CBA@A@A@@@A@@@ABA@@@A@@@A@@@AB@@A@BB@BAB@B@@AB@@@B@@BBBB@B@@@B@B@B@@@AA@A@@@@@@@AB@@A@@@B@@B@@@@BB@@@B@@BBB@@BBB@BB@@@@B@B@AB@@@B@@@B@B@@@@B@@@B@B@@AB@@AB@@@@@B@@A@@B@BAB@B@@@DAB@@@@A@@A@@@BA@@@ABC@ABAB@@@@A@@B@@A@@B@@@B@B@BA@@B@@@B@@@@AB@@@B@@@@AB@@BB@@@@@@@B@BA@@BBB@@AB@B@@@@@B@@@@BB@@@B@@@@@B@D@@AB@@BB@@B@BB@@@@B@@B@@@@@DA@@BBB@B@@@B@@BB@B@@B@@B@@@BAB@@@@@BA@@@A@@DB@@B@BB@@D@@AB@@@@@B@B@B@D@@@BB@@B@@@@@@A@@BABA@@B@@@B@B@D@@BB@@@BB@B@@@B@@@D@B@BBBB@B@B@D@@@@A@@@A@A@AAE@@BEB@@ABA@@B@@A@A@C@@AA@@@A@A@@A@AA@AAAA@@A@@A@ABCBABABCFAB@@ABA@@BCBA@@B@BA@@@AA@@A@@AA@@A@@@AB@AAA@@AA@AA@@@@@@AC@@A@@AAA@@@A@A@@@A@A@@@A@@@A@A@A@A@A@AB@ACBA@@@AB@BAB@B@@@@@@A@A@A@@@A@@BA@@BA@A@@BA@@@@B@BA@@@A@A@@@A@@@A@@B@@A@@@@DAB@B@@@DAB@B@BAB@B@BAB@B@@AA@@@@A@AB@@A@@@AB@@@AA@@@A@@@@BA@A@@@A@@@AB@AA@@B@@@B@BBB@@@B@DD@@B@@@@@@BDBBB@@B@@@B@B@B@@@B@@DBB@@@@BB@B@@@@B@B@@@@@BBB@B@@B@@BB@BB@@@BB@@B@@@B@@@BB@@BBB@@@B@B@@B@@@@B@B@@@@@@BB@D@@@@BB@@@B@@@@@@B@@@BB@@BBB@BB@BBB@@BB@BA@@BB@@B@@A@AB@BA@ABA@A@A@@BA@AB@@@B@B@@@B@@@B@@@@AB@@@@@B@D@BBB@BAB@BA@@@@@A@A@@@A@@@@B@@@B@@@B@@@BAB@@@B@@@@A@@@@@AAB@@AA@@ACAA@@@AB@@A@A@@BA@@@@BA@C@AB@@@@ABA@@@@B@@@B@@@@@B@@@@@BB@@@@@@B@B@@@BA@@B@B@@@B@B@@@D@B@@@B@@B@@BABA@@@@@B@@@BABA@@BA@@@BBBDB@B@@@@@@@B@@B@@@@@@@@DA@@@@B@@AB@@@@B@B@@BA@@B@@@@@@@@B@B@BB@@@BA@@B@@A@@BABBB@B@@@B@@@@@BAB@@@@AB@@A@A@AB@@@BA@@B@@@BA@@B@@A@@@A@@@A@@@@@A@AB@@@@A@@@@@@AA@@A@@B@@@@AA@AA@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@B@@@@@BAB@@@BB@@@C@@B@BA@@@@@@B@@@DBB@@B@@B@@BB@@@BBB@@@@AD@@@@ABBB@@@@@BB@@@@@@BA@@@@@@@AB@@@@@BA@@B@B@@@@@@@@@@BB@@@B@BB@@BA@@@B@@BA@@B@B@@@B@@@@@B@@@@@@B@@B@@@@@AC@AA@@A@@@AAA@@A@A@@@A@@A@@@@BAAA@@@@@@BAB@@A@@B@@AB@@@BA@@@A@@B@@@@@B@@@@A@A@AA@@@BA@@@@@@@@BA@@@@BA@@@@@@@AB@@@BA@@B@@@@@B@@@BA@@@@@@@BD@@@BB@@AB@@B@@@B@@@B@@@B@@CB@@@BA@@@@@AB@B@@@@AB@@A@A@ABA@A@A@@@@@A@A@C@A@A@@@@B@@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@B@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@B@@@@A@@B@@B@AB@@@@@@@B@@A@@@@B@@@B@@@@A@@B@@@@@B@@@@@@B@@B@@@@@@@@@@@BB@@@@@@@@B@@@@@@@@@@A@@@@@@@A@@@@B@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@A@@@@@A@@@@B@@@@A@@@@@@@@@A@@@@@@@@@A@@@@B@@@@AA@@@@@@A@@@@@A@@@A@A@@@A@@@@@@BA@@@@B@@@@@BA@@@@@ABA@@@@@@@@@@@@@A@@@@B@@A@@@@B@@@@@B@@@@A@@A@@@@A@@@@@@B@@AB@@@@@@@@@@@@@BA@@@@@@D@@@@@@@B@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@B@@@@B@BA@@B@@@BA@@@@@@@@@@B@@A@@@@@@BA@@@@@A@@BA@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@BA@@@@@@@@@@B@@@@@@A@@@@@@@@@@@A@@@@@@@@@AB@@@@@@A@@@@@@BA@@@@@@@@@@@@@A@@@@@@@A@@B@@@@@@@B@@A@@@@@@@@B@@A@@@@B@@@@A@@B@@@@@@@BAA@@@B@@A@@@@@@@AB@@@@@@@@@@A@@@@@@BA@@@@BA@AB@@AAC@@AAA@@@A@@@A@@AA@@@@@@A@A@A@A@@@CA@AAA@@AA@@@@@ABAB@@@BABEAAAA@@AB@@A@@A@@ACA@AAA@@@@@@@BA@A@C@@@@BA@@B@B@@@@CB@@@BA@@@A@A@A@AAA@@@A@@@@@@B@@@B@@AB@@@@@@@B@@@@@@@B@@AA@BA@@@@B@@A@@@@@@BA@@@A@@@A@CAAAA@AB@@A@A@CAC@A@A@AB@@CBA@@@A@A@A@@@@B@@@@A@@@AACC@ACA@@AA@@AAAAAAAAC@@@@AAABAB@FAB@@AB@@AB@@C@CB@DCDCD@BCBA@@@@AA@AAAAA@A@@@A@@A@@@ABABABABABA@A@ABC@@@@@AA@A@AA@@@@@ADA@@B@@A@A@A@@A@@A@A@A@@@ABA@@@AAC@@AA@@AB@@A@@@AA@A@@@AA@@@A@@C@@@@@AB@@A@@@@A@@@@@A@@@AA@@@@@BA@@@AA@@A@@@A@AB@@@B@@AA@BA@@AA@@@CA@AAAA@@@A@@@@@AAA@@@AA@@AB@BCBABADC@@@A@@@ABA@@@@@@@A@@@A@A@@A@A@AA@@@@A@A@A@ABABCBABA@A@A@A@@@@A@A@@@A@A@@@CA@@A@CA@BABA@@BABA@A@@@@@AAA@A@A@A@A@AA@A@@AA@@@@AA@@@@A@@DA@A@@@A@@@AA@@ABA@@@A@@@A@@@A@@@@@A@@BA@@AA@@@A@A@@@A@@@AA@@@AAB@@@@@@@B@B@BA@@@ABA@@@ABAB@@@@@B@DC@A@@B@@@B@@@@@BB@@B@@@BA@BB@@@B@@@B@@@B@@BB@@@BBB@@@@@@@BB@B@@@@BB@A@@B@@AB@@A@@@@A@@@B@@A@@@@@@@@@@@A@@@@A@@@@@@@@@@@AA@@@@@@@@@B@A@@@@A@@A@@@@@@@@@BB@@@@@@A@@@@BAA@@@@@@@A@@@@@@@@@@@AA@@@@@A@@@@B@@@@@@@@@B@@@@B@A@B@@@@B@@@@@@@@AA@@@@@@@@A@@AC@@@@@@@AA@@@@@@A@@@@@@@A@@@@@@@@@A@@BA@@A@@@B@@A@@@@A@AAA@AA@@@@@AAA@@B@@@B@@ABABC@A@@AA@@@A@@@AA@@A@AAAB@@@@A@A@@B@@A@AB@BA@A@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@B@@B@@@@@@@@@A@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AAC@@@A@@@AB@@A@@@A@A@A@@@A@A@ABA@A@@@A@@@@AA@@@@B@@AB@@AB@BA@@@@B@@@B@@@BBB@BB@B@@B@@@B@@@@AB@@@BB@A@A@A@@@A@A@@AAA@@A@@AA@@A@AA@@A@@A@@@@AAA@A@@@@@AA@@@AAA@@@@BA@A@@B@@A@@@@@AB@B@B@@@BA@@@AB@@@BABA@AA@@@A@@@AA@@A@@ACAC@@AA@@BA@AB@@AB@@@BA@@BA@@@@@@B@@A@A@AB@@@@@B@B@@@BA@AB@@A@A@@@A@@A@@AB@@CBA@A@@@A@AAE@@@AA@@A@@@@@@@A@@@AAA@A@A@@@A@A@@@@@A@A@A@A@A@@@AA@@C@A@AAA@A@A@@BA@@@@AA@@AA@A@C@@@A@@@A@A@A@ABA@A@@@A@AB@@@@A@@A@@A@A@AA@@@A@@CBA@@@CBA@A@@@A@A@A@@@A@@BA@@@@B@@A@AA@@@@@ABA@A@A@@@@@@@A@@@@@AA@@AB@AABA@BBA@@B@@A@@A@@ABA@AB@@@B@@A@@@@@A@@@@@AB@@@@AAAB@@@@A@A@@AA@@@A@@@A@A@E@A@AB@@AAA@A@@A@AA@AC@A@@A@A@A@@@A@@AA@@@@BA@@B@@@@A@@A@BCAA@AB@@@@A@@@@BA@@@A@@A@@@@@@@A@@@A@A@@@AAB@@A@@B@@A@@@@@@BA@A@AA@@@@@A@@@@@@@A@B@@AB@@@B@DABA@A@C@A@@A@BA@A@A@@@@@AB@@A@C@A@@@AA@B@@AB@@@BACAAA@@BAAA@ACA@@@A@AA@@AA@@A@AA@@AAA@@@ABBB@B@@A@A@@@A@@B@@@@A@@@A@@BA@@BB@@@A@@@@@AAA@@@@BA@@A@@@@A@@A@@A@@A@@@@@@A@A@@AA@@B@@A@@@@@A@@@@A@@@BA@B@@BAB@AA@@@@@@@A@@B@@@@@BA@@BA@@@C@@A@@B@AA@@A@A@@B@@@@C@@AA@@@AA@@@BA@@AA@A@A@@AB@@ACA@@@BA@@@AAA@@@@B@@A@@@A@@@@AA@@@A@@@A@A@@@@@@@@@AAA@@B@@@@ABA@@@AA@@@@@B@B@BBB@@@BB@@BC@A@@@@@@B@@AA@@AA@@A@@@A@AACAA@AAB@AAA@@@AA@@AAA@@@A@@A@A@@@A@@@@C@@@@@A@A@@@@@@AB@@@A@@@@BABC@A@@@@@@@A@A@@@AAA@@@@CAA@@A@@@AB@BB@@BA@@@ABA@AAA@ABA@@B@BA@@B@@@@ABAAAB@@@@AD@BA@@BAAA@@@@AAA@A@@@A@C@BBB@@B@B@B@@A@@@@@@@@@AB@@@@@@B@@@AB@@@@@@A@@@@@@B@@BBA@A@@@AB@@@@@@@B@@ABB@@@@B@@GAC@A@A@A@@@ABA@@@@@A@@@@@A@A@AB@@A@@@A@@@CA@@AB@@A@@@@@A@A@@B@@@@@@A@@B@@@@ABA@@@A@@B@@B@AB@B@@A@@@@@A@A@A@@AC@AAA@AA@@AAAA@@@@@@@AAA@A@@@ABABABCBAA@@@BA@@@@A@AA@@@@AA@@@@@AB@@ABA@@DCB@@@A@@A@@@A@@@A@A@A@A@A@@@CA@@@@@@@@A@@@@@@@A@@@@@@AAB@@A@@@AA@AA@@@B@@AC@@@BA@AB@@@@@@@AAA@@@@AB@@AB@@AAA@@@AB@@@@A@@A@@A@@A@@@@@A@A@@A@@@@AAA@@@@AA@B@@@B@@@B@@@B@B@BB@@@@@@B@@@B@B@@BD@@@B@@@@@B@@@B@B@@@BB@AB@BB@@B@B@@BBA@@@BB@@@B@@@@@B@B@B@BBB@B@@@B@@@B@BBB@B@B@@@@@@AB@@A@ABA@@B@BA@@A@@@AA@@@AA@@@@ABA@@BA@@@@BA@@B@BAB@@@B@@B@@B@@ABGD@BA@@BC@A@@@A@@@@@@B@@@BAB@@C@@B@BC@@BAB@@A@A@@B@B@@A@A@A@@@@B@@@@@@AAAA@@@AAC@@@AA@@@@AA@@AA@@@AA@AAA@@@@A@A@@@AA@@@A@@CC@A@@A@AAAAA@@@@A@EBA@@@ABC@@@C@AA@A@@BA@A@@B@@AB@@@A@@AA@AA@A@@AA@ABABAAA@@@@BA@@B@@@@@BCBABE@@BA@@@ABC@AB@B@@A@@B@@A@AAA@AA@@EAA@@@A@ABC@@@A@@@C@C@@@@@@ABA@@B@B@B@@@@@CCCACAA@@@A@CBC@B@ADBB@@A@@B@B@@@BABBB@@@BDB@B@B@@A@@@A@@@A@AB@@@B@@@D@@@B@B@@@@@B@@@BA@@@@@@B@@A@@B@@A@A@@@CB@@AB@@AB@@BB@BB@AD@D@@@B@@BBA@@@@B@B@@AB@B@BABA@@BCBB@@@AB@BC@@@@@@B@@@B@@@@@@BB@@A@@@@@ABA@C@@@A@B@@A@@A@@A@@@A@@A@@@A@@AA@A@A@A@@@@@A@@@@@@A@C@A@A@A@@@AA@@A@AA@AA@@@@@A@@@A@A@@@AA@@A@@@A@@@@@A@@A@B@@A@@@@B@@AC@CA@@@@BCB@AA@@A@@@A@C@CB@@@@CBAAAAA@AA@@A@A@A@A@A@ABA@@@@@@BABA@@@A@ADAB@@@B@@A@@DBDA@@BA@@@@BA@@@AD@B@@@DAB@@A@@@AB@BA@@@AA@AA@@CA@AC@@@@A@A@@@@@A@@AAA@A@@@@AA@@CC@@ABABA@A@@@@@AB@BAB@B@B@B@@A@CD@B@@A@A@@AA@C@A@AB@@@@AA@@A@A@AB@@A@@@@@@@A@@AB@@ADA@@@AC@ABA@@@A@@A@@@@@ABA@@B@@AA@@AA@@@A@@AA@@BAA@@@@@AA@@@@A@@@@@@@AA@BA@@@@AA@@A@@@BA@@@A@A@@@A@@@ABBB@@A@@B@@B@@@AB@@@@@BABCAA@AAAA@@@@@@A@@BAB@@@BBB@@@BB@@B@@A@@@@A@@@BA@@@@@@@@@A@@AAA@@@@@AA@AA@@@@@BA@@A@@@A@@@@A@@A@@@@AA@@ACAAAA@@@A@@@@@@DAB@@AB@BA@@@A@CAA@CAC@@AA@@A@@A@@@AB@AA@AAA@@@@A@@@@@A@@@AA@A@@@@AAAAAC@@@AA@@@@@@AA@@AA@A@@@@@A@@A@@@A@A@@@A@AA@@A@@@@B@@@@A@AB@@A@@@A@@B@BA@@B@@@@A@A@@BA@@@@@@@ABAB@BA@@BA@@B@BA@A@@@@@@BA@@@B@@BB@C@A@@AA@A@@@@@A@A@@BA@A@@@@@AB@@@@A@@BA@@@@@AA@@AC@@@@@@@@A@@B@@A@AA@@@@@@@C@@@@BA@@@@@A@@@@@AA@A@@A@@@@B@@@@@@A@@AA@@A@@@AA@@AA@AA@@@A@@AAAA@A@@@A@@AA@A@@@A@@@A@@@AB@@A@CBA@@@A@@A@@A@@A@@@@C@A@@@A@@@@@@@@@@@@@@@@@A@@@@B@B@@@@@B@@@BAB@@@B@@A@A@@B@@@BBB@B@@@BB@B@B@B@@@@@@B@D@BBDA@@@@@AB@BAA@@A@@@@@AAC@A@A@AB@@A@A@@@ABA@@@@@@@A@@@@@@B@B@B@@@@@@@B@@@B@@BBA@@@A@@@A@@AA@AA@@A@@@C@@@A@@@ABABA@@AA@@AAA@@AA@@@@A@@@@@AA@@A@@A@@A@@A@@A@@A@@A@@AA@CBA@A@A@@@A@A@@@AA@@AAA@@@@@@A@@@A@AC@AA@B@@A@@B@@@@A@@B@@@D@@AB@@A@A@@@AAAA@@AA@@@@A@@@A@@@ABAA@@A@AAA@CA@@@@AAA@@@AAA@@@AA@@@@@A@A@@@A@A@@A@@BA@@BA@CB@@@@A@@B@@A@@@AAA@C@@C@@@ABA@@AC@@@@@@C@A@A@@@A@@@@A@@A@@@ADA@ABA@AB@@@@@A@A@A@@@C@@A@@BA@A@CA@@A@@@A@@@@BAB@@A@ABA@@A@@ACAC@A@AAA@@@@@A@@@@@@@AB@@@@AB@D@@AB@@ABAD@@AB@@@@@B@@A@@@A@A@@@@@A@@BB@@B@A@B@BA@@A@A@A@@A@@@@@A@@@@@@@@@A@@@@A@@@A@@@@AA@@@@B@@A@@AA@@@A@A@@@@A@@A@@AA@@@A@@@AA@@@@AA@@@@@AA@@@@A@@A@@@@A@@@A@@@A@A@@@@@AA@@@@AA@@@A@@AA@AA@ACA@@C@A@A@C@AB@BAD@@@B@B@@AB@@B@@BA@@@A@CBCBABA@@@@@A@CB@@AA@@@@@AA@@@CC@@A@@@A@@@CA@B@@AB@@A@ADABAB@B@@@@ABA@@@A@A@A@A@@@@A@@A@@@@AA@@A@A@@AA@@A@A@@@@@@@A@A@A@@AA@@B@@C@A@@@A@A@A@@AA@@@A@BA@@@AB@BC@@B@BA@@BA@@BC@ABA@A@A@@@@B@BAD@@@BABA@@BA@@@@@A@AB@@@HEB@B@@AB@@A@A@ABA@@@AAACC@AAA@@@A@@@A@@A@@@@B@@@@@B@BBF@B@@@@@B@@B@AB@@@B@@A@ABA@CBC@A@A@ABAB@@@@@B@@A@@@ABAA@@CB@@@@AB@@@@@@@BA@@@A@@@AB@@A@AAA@@AA@A@@@AB@AA@A@@B@@@@ABA@A@A@@@C@@@@@@@@BAB@BABAB@@@B@@@B@B@B@@A@BB@B@@@BA@@@BBB@@@@D@BAB@@@B@@@@AB@@@@@B@@@B@@@B@@B@@B@@@@@B@@BBA@@B@@BB@B@@B@@B@@@B@@@@@B@@@B@BBB@B@@@D@B@@@@@B@B@@@@@@@B@@@B@@@B@@@D@B@BA@@@A@C@@@@B@@@D@B@D@@@BA@A@@@@DABAB@@@B@@BB@@@BB@B@B@@BB@@@B@@BBB@@@@B@BBF@@@BB@BB@@BB@@@B@@@B@B@@@@@BBB@@B@BBBBD@@@BAB@@@@@@BB@@AB@@@B@@@@@@@B@@AB@@@@@D@@@@B@@@@BBB@B@@@B@@@B@@@@@B@@@B@B@B@B@B@BB@@@@B@BB@@@@@@BB@@@B@B@@@@B@BBD@B@@@@@BBDA@BB@@@BBB@B@B@@@D@BABBD@B@@BB@@B@@@@B@@@@B@@@@BB@@@@@B@BB@@@@A@@BA@@@@@@B@AB@@@F@@@@@B@B@@BB@B@@@B@B@@@@@B@@AFA@@@@@@@DA@@@@@BB@@@B@@AB@@@B@@@@@B@B@@A@@@@@A@A@@@@BA@@BB@ABB@@B@@A@@@@BA@@BB@@@@@@B@B@@@B@@@@@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@BB@@@@A@@B@@@BBB@@@B@@@@@@@@@BA@@@@@BB@@@@@@@B@@A@@B@@@@CB@@AB@@@BA@@BA@@@@BA@@@BBBB@@@B@@@B@@@BB@@B@@@BA@@B@B@@@@@@AB@@@@BB@@A@@BA@B@ABAB@D@B@@@@ABA@C@A@@A@@@AA@@@@C@@A@AA@@@@AACAA@A@@@AAC@A@@@@@@A@@@@A@@@@@@A@@BA@@@@@A@A@@@AB@@A@@@@@AB@AA@@@@@A@@@@@A@@@AA@@@@@A@@@A@@@AA@@A@AB@@@@A@@AA@@AA@@AA@@@AB@@A@@@ABA@@AABAA@@A@@@@B@@AB@@@BA@@@@BB@A@@B@@A@@@AB@@ABA@@@@BA@@BCB@B@@AB@@@@@BA@@@@@A@@@@A@@A@@A@@A@@@@@@AA@@A@@@AA@@@@@A@AA@BA@@@A@A@@@AA@@@@A@AAA@AA@@A@@A@A@A@@@A@A@@A@A@AA@@@A@A@@@@@@A@@A@A@@@@@A@@@AAAA@@@@@AB@@@@@BA@@@CAAA@@@@A@@@@@@@@CA@@A@@@A@@A@B@@A@@@@@A@@AB@@A@A@AA@@C@@@@@AA@AA@@A@@A@A@A@@@A@@AAA@C@A@AA@A@A@@@A@A@A@@@AA@@@@A@@@@B@BA@@@A@@B@BA@@@@BA@@@A@@@@B@BA@@@@B@@AB@@@@@B@BA@@@A@@@A@@A@A@@A@@A@@@A@C@@@@AAA@AAAAA@@B@@A@@BA@@B@@@B@B@@@BA@@BBB@D@@@BB@@B@@@@A@@D@@ABA@@B@@@B@@A@@@A@AB@@B@@BAB@D@BCBADABA@A@@@A@@BA@@BAD@@A@@@AB@@C@A@@@@AA@@@@A@@AA@@@@@C@@@@BA@A@@A@A@@@A@A@A@@A@@A@@A@@@@A@A@@@A@A@@@@@@@@BA@@B@B@BA@@BA@@B@@AB@@A@@@A@@@@@@BB@B@B@@BB@@BA@@@A@@BB@BBAB@BA@@B@@AB@@@B@@@BB@@B@@@@BB@@@@B@@@@B@B@@@B@@@@@@@BB@@@BBBB@@D@@B@@B@@@@B@@B@@@@@BB@@@@BB@@@@B@@@@A@AAA@A@@@@B@@@B@BB@@B@@D@B@@@@@D@@@B@@@@BB@@B@B@@@@D@@B@@@BB@@@@BB@@A@AB@@@@@@ABA@A@A@@@B@@B@BB@@@@@@BB@@@@B@@@@@@@B@@B@@@B@B@B@B@@AB@@@@A@@B@@@@B@@@B@B@@@B@@@@@D@@AB@@A@@@@@@B@@@@BB@@@@@@@B@@B@@B@@@@@BB@@@D@@@@BAB@@@@A@@@@BA@@@@@@@@@ABB@@@BBD@B@B@BB@@@@@@B@@@ABB@@@@@B@@@@A@B@B@@@BA@@@@B@@@B@@@@AB@@A@@@BB@@A@@@BB@@@BB@@B@@@@A@@BB@@@@BB@@@@@@BB@@@@B@@B@ABB@@@@B@@@@@B@@@@@B@@@@@@@BA@@@@B@@A@@@A@@@A@@B@@@BA@@@@B@@@@@@@B@@AB@@A@@@A@@@@@@B@@@@@BA@@@@@B@AB@@@B@@@B@@@B@B@@@B@@@B@@B@B@@@B@@@BAB@@@@@@B@@@D@BB@@@@B@@AB@@A@A@@@@BAB@@@@@B@@@B@@@@@BB@@@@B@@AB@@@B@@@@@BA@@@@B@@@@@BA@@BA@@BA@@@@B@@@B@@@@@BA@@@AB@@@BB@@@@@AB@@@@@@B@@BBB@@ABB@@@@@@BA@@B@@BB@@@@@B@@@@A@@@A@AA@B@@AB@BB@B@@@BAB@B@BAB@@@@B@@@@@@@@@@@@@@@@@@A@@B@@@@@@@B@@A@@@A@@A@@AB@@@@A@@A@B@@@@ABA@@AA@A@@D@@@@A@@@ABA@@@A@A@@@A@@@@@@@AAC@A@A@@@@@@A@@@@@@@A@A@A@@B@@@A@@A@@A@@BA@@AA@@@@A@AB@BAB@A@AA@@C@A@@BA@CAA@@@B@AB@@A@@B@@@B@BAAA@ABA@@@@@A@A@A@@@A@@@@B@B@@AB@@@B@B@BA@@@"],encodeOffsets:[[120699,26750]]}},{type:"Feature",id:"350427",properties:{name:"沙县",cp:[117.792396,26.397199],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@@@@BBB@B@@@@@B@@@B@@@B@@@D@@@B@@@@@D@@@BAB@@@BA@@B@@AA@@@A@B@BA@AB@@@@B@@@@B@@@@@@@@@B@@@B@@ABA@@@BBA@@BBB@@BB@BBB@BABBBA@@D@@@@@B@@@B@B@@@B@B@@@@ABA@@@AB@@A@DBB@B@@BB@@BBB@@@@ABA@A@@@@B@@A@A@@@@@A@@BB@@B@@@BA@@@@@AA@@@@A@@B@@@BAB@@@B@@A@A@A@A@CBA@@@@@A@AACAA@@@AB@BAB@@@@C@@@@@@B@B@@@BAD@BAD@@@B@@A@@@@BA@@B@@@D@@ABA@@B@@@@@BBB@B@@BD@@@@BB@@@@BB@@BB@DDD@@@BB@BB@BFB@@@@BB@@BB@@DBB@B@B@@@B@B@B@DB@B@@@BD@BBBB@@BB@@@B@B@@AA@@A@A@@@A@@@A@@@@BBD@@@B@@@A@A@AB@B@@@@@@@BBBB@@B@@@@BB@@BBB@BABBB@@@@BB@@BDBB@BBBDB@@A@@@@F@BBBBBBDBBBB@@BA@@@AB@@@@@@A@@@@@@B@@@@A@@AA@@BAB@@@@A@@@@@AB@A@@@BAA@@@@@@@BAD@@@@A@@B@@A@C@@@@BA@@@A@@@AB@@@BAB@@@B@@@B@@A@@@@@@@@B@@@@@DB@@@@@@BBBB@BA@AD@@@@@B@@@@A@@B@@A@@BBB@@BDBDB@@@@BB@@B@@@BB@B@BB@@B@@@B@@@@@@BB@BB@@@A@@@@B@B@@@@@BBB@@B@@@BD@@@B@@B@@AB@B@B@BA@ABC@A@@BAB@@@@BB@@@@B@BB@@@@BBB@@@BB@@@B@@@@A@@@@BAB@F@B@B@B@@A@@@@BA@@B@B@BA@@@ABA@@BA@@A@A@@AA@CBABABAA@AA@@A@@@A@@AA@@AA@@@@@ABBBAB@@@BABC@@@@@A@@@@@A@@BA@@@A@A@@@A@@@@@@@A@AB@B@BA@A@@AA@ABCB@@AB@B@BBBB@@BAB@B@BBB@D@@@@A@A@@@AA@A@@@@AA@@@@@BAB@@@@A@AA@A@@@A@@A@@@@@@A@@@A@@@@@@@@C@A@@@C@ABA@@BA@CB@@A@@B@@@@ABABA@@B@@@@@B@B@BA@@@AB@B@@B@A@BB@@@@@@BA@@@@@B@@@@B@@BB@@B@@@B@@@B@@BAD@@@@@BBBB@@@@@BA@BBA@AB@@A@@@A@@@A@@BAB@@@@BBBBBD@BA@@@@AAAAACCACAAAACAC@A@E@C@C@C@ACA@AAA@EAA@AA@@AAAAAA@@@CCEAEAA@@AA@B@@GB@@A@@BA@AB@@AB@B@@C@@@@@A@@AA@C@@@@@@@@A@AAAACA@A@AAA@AA@@@AA@@@AC@A@@@A@@@@A@A@@@@@@B@@ABA@@@@B@BBD@B@@DD@@B@BBAD@F@@AB@@@B@B@@AB@@@BA@@DAD@@A@@@CAAA@@A@A@@@@@@@A@AD@BA@@@@B@@@B@B@BA@ABA@A@CB@@@@@BBB@B@@@@@DBB@@@BA@@@ABA@@@@AA@@A@A@@@AA@AABAA@@@@A@@@A@@@A@@@A@@@A@@@@@@@@AA@@ABAB@@CDA@@B@@@@@B@@@B@@@DAB@@ABEB@@@B@@BFADBB@@A@AB@@AD@@A@@@A@@@ABA@@@@@@AAA@@A@@@A@@B@@A@@@AB@@AD@@A@@BA@A@AB@@@BA@@B@B@@BB@@@BAB@@A@@@@@@@A@@B@@@F@@BB@@@B@@@B@BA@@@@@AB@@@@C@CBAD@@@@BB@@A@@B@@EBA@ABA@@@A@AB@@@B@@@@AAA@ABA@@A@@ABA@C@A@@@@C@@A@AACAAA@@AAAAA@@AA@A@@CAAAC@@@AA@A@@@EAA@@@@BA@@BE@@@BB@B@B@@@BB@@B@@@@@B@@@BB@@B@@@B@@@@@@B@@B@@@@@@ABB@@@@@@@A@BB@@@@@@@BA@@@@@@@@@BBA@@@BBA@@@A@@@A@@A@@A@@@A@@D@B@B@B@@@@@BA@AA@@AA@@@B@@AB@@A@ABCBEBA@@@AC@@AAA@@@A@A@AAA@BB@@B@@@@@@@@@B@@@@@@@@BB@@BA@@B@@B@@@@BA@@B@@B@AB@@A@C@@@A@@B@@@@BB@BBBBB@B@@@@A@A@@DA@@BA@A@@@AAAEAA@A@EA@@CAA@@@@A@A@@@@BB@A@@@@B@@@B@@@BA@@@A@A@AB@@A@@A@@@@@A@@@@A@@@@BA@A@@@AB@@@@A@@@@@A@@@@B@@A@@BA@@@@@@BB@@BBBBBBBB@@@FHFF@@@@AD@@@@B@@@@@@@@BB@@@B@BB@@B@@@@@@B@@A@@BA@@@@@@B@@BB@@@BB@@B@B@@@@@B@@BB@@BB@@@@BB@@B@B@B@BB@@D@@@B@@@B@B@@@B@@@B@@BBBBBBDD@@BB@@B@@@BA@@@A@A@A@@BAF@B@@ADAB@@ADAB@@@@@B@@BBB@@@B@BBBB@B@B@@BB@B@BB@BB@@@@FF@@A@@@AB@@@AA@@BC@AB@@@@AB@@@B@@@B@@@BBB@@B@@@B@@@@B@@@B@@@@BBBB@@BB@@@B@@@B@@BB@B@@AB@BB@@BBBBBB@@D@@ABA@AB@@@BAD@@@@A@@@AB@@AB@@@@@B@BB@@B@@A@@B@@@BBB@@@@ABA@@AA@@@AA@@A@@BA@@@BB@@B@BB@B@@@@@@@BA@AB@@@BA@@B@@@@BBBB@@@@@B@B@@@B@@AA@@A@@@A@@@@@A@@@A@@@@A@@AAAAAA@@A@@@@@@A@@BA@@@@E@C@@AC@AB@AAB@@A@@@A@AB@@@B@@AA@@@@@@AA@@@A@AAA@@@@@AA@@@@AA@@@@@CD@BA@@B@B@@@BBD@B@BAB@@@BA@@B@@A@@@@@C@@@@@A@ABABC@@@@B@@A@@@@B@@@@@B@@@@@B@@@@@B@@@B@@@B@@@@@BA@@B@B@@@BBBBB@B@@@@BB@BAB@@@@@@@BB@@@@@@BB@@@@B@@@@A@BB@B@@@@DBBA@BD@B@B@@BBB@BB@ADA@@@@B@@AB@@@B@@A@@@BB@@@@BBBBBB@B@@BB@@@BBB@@BB@@@B@@BBBB@BBB@D@@@BBB@BB@@BB@@@@@B@@@FDB@BB@@@@B@BB@@AB@@@B@@ABA@AB@@@B@@@@AA@@A@@@@B@@@BB@@B@@ABABA@@@@@A@@@A@E@@B@@A@@@A@A@B@BBBB@@B@@B@@@BA@@BBB@@@@BB@B@@@@@BB@@@ABC@@@@@@B@@A@ABC@@@A@A@A@@@A@AA@@A@@@A@@AA@@@@BCBA@A@@B@@@@A@@@A@@BAD@BBBFB@@B@@@@B@B@@@B@BB@@B@@@B@@BBDBB@DBB@B@@BB@B@DBB@@@BBDB@@@BB@@@@@B@@@@B@@DB@B@@@@@BB@@BB@BBB@DBB@BBBB@@@B@BB@@@BBB@B@B@B@B@@@@C@@@AB@D@@@@@@@BB@@B@@@BAAA@A@@@AA@@@@A@A@@BADCDBBD@BB@BDB@@@@@@BB@B@AB@@ABA@A@@@@B@DBB@@BDB@@BB@BBA@@AA@A@@@@@CD@@@@A@@BABB@@@BB@@@@@@@BDD@BBD@@@BB@BB@BB@@@@B@@@BB@@B@@@B@@@B@BB@BB@AB@BBB@@@B@@@BB@@BBBBB@@@BBB@@BDB@@@BB@B@@@D@@@@AB@@BB@B@@@@@@@@B@B@BB@@@B@@@@@B@B@@@BB@@B@@@@ADA@@BB@@@BB@@BB@@@@@B@B@B@@@B@@@@B@@@@B@@@@@@@@BB@@@@@@@@BBAB@@@@@BA@A@@@A@@@A@@BA@@D@B@@@B@@@@@BBB@FA@@B@@@@@D@BADB@AB@@@@@B@@@B@BBBBB@@BB@B@B@@@@AB@AA@A@A@@AAB@@@@@BA@@@@B@@@B@@@@@@A@@A@AAA@@@@@A@AA@@@AC@@AA@@@C@@@@@@C@@BA@@BA@AB@@A@@A@@@@ADC@ABA@A@A@AB@@BD@@@@@BB@@D@BB@@B@B@BA@@@@@@BB@@BBB@@B@@AB@BB@@@@@@@@B@@A@BB@B@B@@B@@@B@@@BBB@@@@ABA@A@@BA@@@@B@@BB@B@B@B@B@B@BA@@@@BAB@@@B@@B@BB@ABBHB@@@@B@@@@BB@@BBB@@B@BB@@@BBB@@BBB@@@@@B@@D@@@B@B@BBB@@@B@D@BB@@@DB@@@@@B@@@BA@@B@@@@@B@B@BBBB@@BBBA@A@@B@@B@B@@@BBB@@B@@@@@@@BB@@B@BA@A@@B@B@B@@@@@AA@@@ABA@@B@@@B@@@@@B@@@@@@BB@@@ABAB@B@B@@DB@B@@@BB@DCDA@@AA@C@@BA@ABA@@DA@BB@B@B@DBBBF@BBB@@BBA@@@A@@B@B@DAB@DA@@BAB@BABABA@@B@@@@B@@B@@BB@@@BBAB@@BB@@@@@@@B@@@B@B@@B@@B@@BB@@@@B@@@B@@@@BB@@@B@B@@@@@@BBBBB@@BABA@@B@DA@@BBB@@@B@@@@@BA@@@@@@B@@@D@B@@@@@@@ABB@@@BB@B@@D@BBBB@@B@B@FAB@@A@@@@@@@BB@@@@B@@@@@B@@@@BA@@@@@@@B@@A@@@@BB@B@B@@@@A@@B@@@DA@@AABA@@BA@@BA@@@@B@B@@B@B@@B@@@@@@B@B@@@B@@@B@@@@@@BA@@BBBA@@@A@@@@BAD@BA@A@A@@@@BB@@BB@@D@D@@@B@BBB@F@B@@@@B@@@BB@@BB@@B@B@@@@BBB@@B@@@@B@DBBB@@FA@@@B@@B@BA@@B@@@ABAB@@A@@BB@@@@@B@@@@@D@@@BBB@@@BA@AD@@A@A@A@@@A@@BA@@@@BB@@B@@AAAA@A@@AA@@@A@@@A@DA@@@AB@@A@@AA@@A@@AAC@A@AA@@A@@A@AAA@@B@@ACA@BA@@B@B@@@B@B@@@@A@A@@@AB@@A@@B@BAB@DAB@@@@@@BA@B@@@BBC@AB@@A@@B@@BB@@B@@@B@B@BABB@A@@@@@A@CAA@A@@@AACAA@@@C@@@AC@@@AAA@@A@@@@BA@@@@AC@A@AB@B@@AB@B@BABA@AB@AABA@A@@DAD@B@B@B@@@BB@@BA@@@@@ADB@A@@B@@A@@@@@A@@@A@@AA@@A@@@A@@@A@A@@@@A@@B@@AB@@A@@@@A@A@@@@@A@@@@C@@@@@@CB@@A@@@A@@BB@@B@B@B@@@@A@A@@@@@@A@A@@@AAA@@@BA@@@@AA@ACAAA@@@@@A@@@ABA@@BA@@@@A@A@@@A@@AA@A@AA@B@B@B@B@B@@AAA@A@A@@BAB@@A@@AA@@@A@A@@@A@A@C@ABA@@@@B@DAB@B@@@B@@A@@BAB@@@@@B@@A@A@ABA@A@A@AB@CC@AAA@AAA@A@@AA@@AA@@@@B@@@@@@A@@@@D@BA@@@A@@B@BBBB@@D@B@@@@@DAA@@AA@@@@A@@B@BB@@@BB@@@@A@@@A@@BA@@@AB@@A@A@@@A@@AAB@@C@@@A@AAA@@A@@@@AA@@@BA@@@@@AB@BAAABA@ABA@A@A@@BA@@@BB@B@@@@BB@@B@@@B@B@@@@B@@@@B@BB@@@@B@@B@@BB@@@@A@@@B@@@@BA@@@@@B@@B@@@@@@@@A@@BB@@@@@@@@AB@@B@@@@@@@B@@@BAB@@BB@@@@BB@BBBA@@D@@@BDBBBB@@B@@@@@@B@@@@@AB@@@@@AC@@A@AC@A@@BA@@@@@@B@@@BA@A@@@@@@B@BBBBBB@@BB@@B@@@@ABCB@@C@A@@@AA@@AB@B@BBB@@AB@@AB@@@@@@@B@@B@@B@B@BAB@@@B@@@B@@@B@B@B@B@@BBBB@@B@B@@@@B@@@B@B@@@@@B@DB@@DB@BAB@@B@@B@@@@BB@@@BB@ABB@B@@BB@A@@BAB@@@BB@@@@@@@B@BA@@B@BBBA@@@@@AB@B@@A@@@ADAB@@AB@@@@AAA@@@A@@B@BC@@@@BA@BB@@@@@@B@@@@BB@@BBB@B@@@AA@@B@B@@@B@@@B@@ABB@@@@B@@@@@@@@@BA@B@@B@@AB@B@@@BD@@@@BBB@BABB@@@@@BB@@A@@@@BABA@@@@@@BABA@@D@@@@A@@B@@BB@BBB@@@@B@@@BB@BB@@@BB@@B@@@@@BABB@A@@B@B@B@BABCB@@@B@B@@@@B@B@F@B@@@@A@@BA@AB@@@B@@B@BB@@B@@B@@@BA@@BA@@B@@@F@@@BA@ABBBA@@BA@@@A@@@@BAB@BAB@B@B@B@@@DB@@B@B@BA@@@@@A@A@@@AA@@@@@A@@@AA@@@AA@A@@@AA@@AAAA@ACA@A@A@@AA@@A@@@DC@AA@AA@@@AA@@@AAA@A@A@A@@@A@BAAI@AACB@ACB@@@AA@@BA@@@@BA@@@@@@BB@@B@BA@@@@@ACBA@@@@AB@BAFA@BB@B@@@@@@@@@@A@AB@@A@@B@DAB@@AA@BA@A@AB@@A@@AA@@@AA@BAB@@A@@@A@@AA@@@@AA@A@@BAB@@A@ABAA@@AAA@@AAAAAA@A@@@A@BB@@BB@BA@@@@@A@@BAD@@A@A@@B@@@@@@B@@@@@BDBB@@@D@@@BBBB@@@AD@D@BA@@@@@AB@@@@@@@@@@AA@@@@A@@@@BBB@@@B@BBB@BBB@@BB@@@B@@@@@@ABBB@@@DBH@@@B@@@@@@@BB@@@AB@BC@@D@@@@@@ABC@@@AB@@C@@A@@AA@@AA@@@@@@@ADAB@@@@@@AAAAA@@A@@@A@AA@@A@A@@A@@AA@A@@@@BAA@AB@@@@@@ABAB@@A@@@A@@@A@AA@AA@@@@@@@@B@BBB@B@@@@@@A@@@A@@B@@@B@@A@@A@@@@AA@A@A@@@C@AAA@AA@@@A@A@@B@@@@A@@@AA@@@A@@@@C@@BA@@AA@@@A@@BA@@@@@@B@@@BA@@@AAA@@@@@ABA@@@AB@@ABA@AB@@A@A@@B@BBBB@B@@@@BD@BBB@@@@BB@@B@@@@@BABB@@@B@@B@@BB@@@BB@@@@@BB@@@@@@AB@@@A@@A@@@@BAD@B@@AB@@@B@B@@A@@@@DAB@@A@@@A@AAAA@@A@@@@@@@@BA@A@A@@@CB@@@@@@@B@@A@@@@B@@A@@BAB@BABABA@@BA@AA@@A@@CA@@A@A@@@@A@A@@@@B@@@@A@@@A@A@@@@AA@@@@@A@@@@BBB@@@@AA@@@@@@@@@@@@@A@@@@@A@AA@B@@@@@@@@@C@@@A@@A@@@@@B@B@@@B@@@@@@@@A@@@@B@@@B@@@@@@@@@@A@@@@@@@@@A@@@@@@AB@@@@@@AB@@A@A@@@@@@@@@BA@@@AB@@@B@@@B@@AB@B@B@@AB@@A@@AAA@@A@@@A@@@ABA@AB@@@BA@@@@@@@@@A@A@@@AB@@@B@B@DBDB@BB@B@@@@@B@@@@A@@@A@AB@@@@A@@@@B@DAB@B@B@@@B@@A@@@@@@@@A@@A@@@@@AB@BABA@@DABC@@AA@@B@@A@@@A@A@@AA@AACA@@A@A@@@A@ABAB@@AB@B@B@@@@AAA@ABAA@@A@A@@@@D@B@@BBABBBAB@DBBBBCBABA@@B@@@BABBBAB@B@@AD@@@@@@@@@@BBB@@B@@B@A@@@C@CBA@AB@BAB@@@@@@@@@AA@@@@@AA@AA@@@AA@@AA@@@@CBA@@B@AA@@AA@@@A@@AA@@@AA@@@@A@@@ABABA@@A@@A@@@@@A@@BA@@@A@@@AA@@@@E@A@C@A@@@AAC@@@A@@ACA@@AA@AAA@@@AA@@@A@@@A@AAAA@A@@AA@@@A@A@@@AA@@CC@@A@AAAA@@@AA@@@@BABABAB@@ADABA@@@@@@@A@ABA@@BA@@@@@@@ABC@CBA@@@@@A@@A@A@C@@AA@@@@AA@A@@@A@AB@@@@A@@@A@@@@@@@A@@@A@A@C@@A@@@@C@A@@C@@@@@@A@@AA@@AA@@A@@A@@@A@@@@@AAA@@@A@@A@@@A@@@ABC@C@A@A@@@CAA@A@C@C@C@CA@@AA@AAAA@A@@@A@A@A@@@@@@B@BBB@@AB@@@@A@@@@@@@@B@B@B@@@@@@EBABC@@@@@@B@@@BAB@B@@@@A@A@A@@A@@@@A@@@@@@@@B@@ABA@AFAB@@BD@@@B@AA@@@@@C@A@@A@@@@A@A@@A@@@@AAC@@@@@AB@@@BA@@BB@@@@B@@A@@@A@@@AA@A@A@A@AAA@@AAA@@@AA@@ABA@@AA@@@@AA@@@@@BA@@@AB@B@@@@ABA@@BA@@AA@@@@@@AA@@@@@AA@@A@@AB@@A@A@ABA@@AAB@A@@@AAC@AA@@@@@@A@@@A@@@A@AB@@AB@@@@A@@@AA@@A@@@AA@@@@A@@A@@AA@@@@@A@@@@@A@@@@BA@A@@B@@AB@B@@A@@@A@@@@@@@@AA@@@@AA@AAABCBABAD@@A@@@A@@A@AA@@@@A@@BCAA@@@@@AAAA@@@@@AA@@@@A@@@@AC@@@AA@@AAEDA@C@AA@@@@@A@A@@@A@@@A@@AA@AA@@@A@@@AAAA@@AC@A@A@@BA@AA@@@A@AB@@A@AB@BA@@@AB@@ABA@AB@@AB@@A@@@A@@@@B@@A@@@@@@AAA@@@A@@@A@@@@@@@A@@BAB@@A@@@@BABA@ABA@A@ABA@A@@@@@@@@@AA@@AA@@@@AA@@@@@@@@@AA@@@@@@@@AA@@@@@@@AA@AAA@AAA@E@@@AA@@@A@A@@@@@AAA@@D@@@@AB@B@@A@@@@@A@@@@@ABA@@ACAA@@C@AA@@A@@AAA@@@@@@@@@AA@@AA@@A@@A@@@@@AAB@AA@@@@@@AA@@@AA@@@@@@@A@@A@@@@A@@@@@AA@@@@@@BA@@@@@@A@@@@AA@@@AA@@@@@@@@@AA@@@B@@@@A@@@@@A@@@AA@@@@@@@@@@A@@@@@@@@@A@@A@@ACA@AA@AC@A@A@A@AA@@A@A@@@BA@A@@@A@@ABA@@@@@AA@@BA@AB@@@B@@@B@B@@B@@@ABA@@@@A@@A@@AA@@@@@@A@@AB@@A@@@@@ABA@@@@BA@A@@@AA@@@@@@@A@@@@@AA@@@@AA@@@@@@A@@A@@@A@@A@@A@A@@B@@@@@@@B@@@@@BA@@@ABA@@@EB@@A@@BA@A@A@@@@@@@@@@A@A@@AAAA@@CA@BA@@@@@@ABA@A@@A@@@C@@BA@@A@@A@@@@A@@ECC@A@@@A@@@@@CBAB@B@@A@@@A@@@A@@@@@@@@@@@A@@@A@@A@@A@@CAC@@AA@@A@A@@@A@ABAD@@@@A@@A@@@A@@A@@@CBCBABEBAB@B@@@@@BCD@@@BA@A@A@@B@@@D@@A@ABA@A@@@@A@@@A@A@@A@AA@@@A@@@AA@A@AAA@@@ACA@AAA@@@@A@A@@@@AA@@@A@@BAB@@@@@BB@@@BB@@B@@@BDAB@B@@@B@B@BA@A@@AC@@BA@@@@AA@@@@@A@@@A@A@A@@@@@@D@B@B@B@@@@@@A@@@@@@@A@@@@AA@@@@@A@@@@@@@A@A@A@A@C@@@@@C@@@@@A@A@@@@@A@@@AA@@@@A@A@@@@@AA@AB@@@@AAA@@@AA@A@@A@AA@@@AAAA@A@A@@@@@A@@@A@@@@@@@@C@@@A@@A@AB@@A@@@AAA@@@@C@A@@A@@@@A@@@A@AAAACACAAA@@@AAAA@@A@@@@AA@@@AA@@A@@A@@BC@A@@@@A@A@A@@B@@@A@@BCBA@@B@@@@A@@DA@A@@@@A@CB@@@@A@@AA@@AAAA@@@A@@@A@@@@ABAB@B@B@@AA@@@BAA@@@@@@@@A@@AAA@@@A@@@@@A@@@AB@@A@A@@@A@@@A@A@@@@@BA@@B@@@@A@@@@@A@@@@@@@A@@@@@A@@A@@@@A@@B@@@@@@AB@@@@@@@@A@@@@BA@@AA@@A@@@AA@@AA@@@AAABA@@@@@C@@FS@A@@@@AAAAA@A@@@A@A@@@A@AB@A@@@@A@@A@@@A@@B@@@B@@@@A@@@AA@AA@@@BAA@@@@@A@@@@@@@@@@@@@@@@B@@B@@@A@A@A@@@@A@@@@@AB@@A@A@@@@@A@@A@@@A@@@@@@@@BA@@B@@@B@@AB@@@@A@@@@CA@@@@CA@@@AA@@@@AA@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@A@A@A@AAAA@@A@AA@@AA@@A@@A@@@A@@@A@@A@@@AAAA@A@@AA@@@@@@A@@A@@@@@@AA@@@@@@A@@B@@A@@@@@A@A@AA@@A@AC@@B@AAA@@@@@C@@@@@AA@@AA@@@BA@@BA@A@@@@@@@@@AB@@@B@BA@@@CBCD@@A@A@@@A@AA@@@BA@@@B@@@@B@@B@@@B@@B@AB@@@@@BB@@BB@@@B@@@@@@@@A@@BA@AB@@@@BB@@B@@B@@@@A@@B@@AB@@A@A@@BAA@@CD@BA@A@@A@B@@@@@@@@A@@B@@A@@BA@@@@AA@@@BA@@A@@@@@AB@@@AA@@B@@A@@@A@@@A@A@A@A@CB@@@@@BBB@@@BABB@D@DB@B@@BBB@@@@@B@@@@B@@BB@@ABB@@@B@@@@@@B@@@@@@@BA@ABA@@@@B@@@B@B@@B@@@D@DAB@@@BA@@@A@@@@BB@@B@@@BB@@B@@@B@B@@B@@@@@B@@@@C@@@A@B@@ACB@@A@@B@@@@@B@B@@AB@@AB@B@@BA@@B@@@B@@@DAB@B@B@@@ABAB@B@B@@@BB@@@@@@@B@BB@@B@BB@@@@@B@@@B@@@B@@@B@@AB@@@@@BAAAB@@@@@@@B@@@@@B@B@B@B@D@@@B@@@@@BA@@@@B@@@@A@@B@B@B@@@@@B@B@@@B@@@@AB@@@@B@B@@@@@@BA@@@B@@@@@B@@A@@@BB@@@@B@@@B@@@@@@D@@@@@D@@@BA@@B@@@@A@@BA@@@@BF@BA@@@@BB@@BB@@B@@@BA@AB@@@@@BB@@@@@B@@@@@BA@@BA@@@@B@@@"],encodeOffsets:[[120586,26799]]}},{type:"Feature",id:"350428",properties:{name:"将乐县",cp:[117.471372,26.728952],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AB@@@AA@@AA@A@AB@@@@AA@@@@A@@A@@AA@@@@A@@CAC@@AA@@@A@C@A@A@@@ABAAA@@@@A@AAAAA@C@@@AAAA@AA@@A@A@@@AA@@@AAC@@@AAAAA@A@@AA@@AAA@@AAC@@A@AAA@@@@A@@@@A@A@AC@@@@@@@@@C@@@A@@AA@@@@@@A@@@AA@@@@@A@@@@AA@@A@@@CB@@A@A@A@@@@A@@@@@@A@@@@@AA@@A@E@CA@@@@A@ABABABABAB@B@D@BBB@@@@ABA@ABA@A@@BC@@B@@@@CAA@AA@@AB@@A@CBA@A@@BA@@@@@AAA@@AC@@@A@A@A@A@A@@@A@@@C@A@A@@A@@AACCA@A@@A@A@@B@@@B@AABABA@A@@@@BA@@BA@A@A@@@AB@@@@@BA@@@A@@@AB@@@BAFUAU@@@A@C@@AA@@A@@@A@@A@@A@AAA@@AA@ABA@@AA@@A@AA@AAA@A@@A@@A@@@AAA@A@@@AAAAAA@@@@AA@C@AA@@A@@AACA@@@@@B@BAD@@CB@@@@ABAB@@@@AB@@@B@B@@@@@BAB@@@B@BAB@@@@@B@@AB@@AA@@A@@BAB@@A@AA@@A@ABBBAB@@@BAB@@A@@@@@@@AA@@A@@@@A@@A@A@A@@@@AC@@A@@AA@AA@@AA@@@CA@@@AABA@CBCBAA@@ABAA@A@@AAAB@BEAA@A@@@@@@CBA@AAA@@BA@@FCA@@AA@@AAAA@@@A@@@A@@BAB@BABABC@A@@@A@@@A@@@A@A@AB@BA@AB@@B@ABAB@DABA@A@A@@B@B@@CDAB@@A@A@AA@BCL@@@F@@BB@@@@@B@B@@AB@BA@BB@@@B@@@BAB@@A@@@A@@@A@@@A@@@A@@@@@ABAA@@EBAB@@A@A@A@@@@A@@CA@@A@@@AAC@A@A@A@A@A@A@A@A@@@@A@@BABCBA@A@@A@@@AA@@@@@A@@@@@@@A@A@A@@@@BCA@@A@@AC@@@A@A@@@@@A@@@@@A@@@@AA@AA@GAA@@@A@@@A@@B@B@@@@AB@@A@A@AAA@@AA@A@@@AA@@@@AA@@@AA@@@ABA@A@@@@B@B@@@BA@A@A@ABC@@@AB@@@A@@A@@AA@ABA@AA@@A@@AA@@A@AA@@@A@A@@AA@@@A@AB@@A@AB@@ADC@@@A@@BAB@@@BAB@@@@A@@B@@A@A@@@ABAA@BA@@@@B@B@@BB@@BB@B@@@B@B@@@B@@@BB@@@@@@B@B@@@@AA@@@BA@A@AB@BAB@B@@A@@@@@A@C@@@CA@@@BBB@BBB@B@@@BB@@@@B@@A@@B@@AAABAAAA@@@@@B@@@B@@B@@@BB@@BB@BBB@@@@@B@@@BAD@@@@BB@@BB@BBBB@@B@B@BAB@B@@@BA@A@@BA@@BCB@@A@@@A@@B@@@BAB@B@B@@BB@@B@@B@@B@@B@@@B@@BBB@BB@@B@@B@@B@@BBB@@B@@@@@@@@B@@@BA@@BA@@@@B@@BB@@@BB@@@BB@B@B@@B@@B@@@@AB@@@@@FAB@B@D@@@BB@@@DDBBBD@@@D@B@@@B@B@@@D@@@BBB@BBB@@A@@BAB@BA@@BA@@@AAC@@@@@A@@@@@AA@@ABA@C@@@A@C@CBCBE@C@E@A@A@@BA@AB@BA@@@@@@B@@ADAB@@A@@B@@@D@@AB@BA@ABE@A@@@C@@@C@@@A@AB@B@@CBAB@@A@AA@@@@A@AAA@@@A@@@A@A@A@@AAAABA@E@A@ABA@CBABABABA@A@ABAAA@A@@@A@@@@@A@AA@BA@A@@@ABA@@@A@@B@@A@A@A@@@A@@@@@AAAAA@@@@@A@A@@@@@A@A@AB@B@@@BA@@@A@@BAB@@@@ABC@A@@B@@@@@B@@@B@@@AA@@@AA@@A@@@A@@@@@@BC@AAA@A@A@C@C@A@A@@A@@AB@@ABC@@@A@A@A@A@@@A@@B@@ABABA@AB@@@@@@DB@@@B@@BB@@BB@@@B@@BB@@@@BB@@BD@@@B@@A@@B@@BB@@@B@@@B@@BB@@@DB@@B@B@BABABAB@@AB@@@@@BDB@@BB@@B@@@B@@B@B@BB@@B@@@D@B@B@B@D@BBBB@@@BB@@@D@B@D@B@B@D@B@BB@DB@BD@DBDBB@BB@@B@@@BBB@BB@@B@@@BB@@BB@BB@@@BB@@@AB@B@@A@@@A@@@AAA@AAC@A@@BCD@D@B@D@B@B@@@B@@B@@BB@@BB@@@@B@@@B@@B@AB@BA@A@@@@@@@@@A@@BA@@@@BA@AB@B@@@B@@@B@B@@@@@@B@@B@@@AB@@@@BB@@@@@@@BB@@@@A@@@@@@B@@@@AB@@@@AB@B@@A@@BBB@@@B@B@@@BB@@@B@@@B@@@B@@ABA@@D@B@BBB@B@@@BA@@HBB@BB@BD@@C@BB@BB@@BBB@BB@@@BBB@@@BB@@@B@@@B@@@B@@@B@@@@@@@B@@AB@B@@@B@@@@AB@B@@@B@@AB@B@@@@@B@@@@@B@@@@AB@B@B@BBB@B@@@B@B@B@@@@@B@@@B@@D@@BABBB@@@@@B@@B@@B@BB@@FB@@@BB@@B@@@@@@B@@B@@@B@@A@@@BBBB@@@B@B@B@B@@BB@BA@@B@@@B@@A@@B@B@@@@@BB@@BB@@@@@BB@@B@@@B@B@@B@BB@@@@@@BB@BB@@@A@@@@@@A@@A@BBA@@@@@@@A@@@@@@@@@@B@@B@@B@@@@@@@@A@@A@@@@@@A@@@@@@@@@@B@@@AA@@B@@A@AD@DABB@@B@B@B@B@@AB@D@@@BB@B@@@BBB@@@@@@@@@B@@@@BBB@B@@@B@@B@B@B@@@@AB@@@B@@@@AB@AA@@BA@@AAB@B@BAB@@@@@B@@@@@@B@@B@@B@@B@@@B@@@@B@BA@@@@BB@@B@@B@@AB@@@BB@@@BA@@B@B@B@B@@AB@@B@BA@@B@@AB@BAB@@@B@@@B@@AB@B@B@@@@BB@B@BB@@@@@@B@@@@BB@@@BB@B@@B@@@@@@@B@@@BBB@@BB@B@@B@@@@@B@@BBB@@B@BB@BB@B@@@@@@@B@@A@BB@@AB@@@@@B@BA@@B@B@B@@AF@@AF@B@@BBAB@@@B@B@@@@AB@B@@@@@B@B@@A@BB@@A@BBBBBBB@@@@B@@B@@@@B@@BB@B@BBBB@@@BAB@@BB@@BB@@@B@@BB@@@B@@@@@B@@@BA@@B@@B@@B@@AB@@BB@B@@@BA@@@@B@BB@@B@B@@@@@B@B@B@@A@@B@B@B@B@@B@@B@@@@BB@@@@@@@B@@B@B@F@B@BB@BB@@B@DB@@@@@B@@@@@@@BB@@@A@BBA@@@A@@B@@A@@@@@AB@@A@ADA@@@@@ABA@ABA@AB@@A@@@A@@@A@A@A@C@A@A@@@CB@@@BA@@@AB@B@@@@@BA@@B@@C@A@A@A@A@@@@B@@A@AB@@A@@B@@@@@BAB@B@@@@A@@BBBBBBB@@@B@BBD@BBB@B@B@@A@@B@@@BB@@B@B@B@@@B@@@B@BA@@@@@@BA@@@@BA@@@@@@@@B@@@@@B@@@BBB@@@B@@@@@BA@@@@D@@@BA@@@@BA@@B@@@B@@@BA@@@A@@@@B@@AB@BAB@@AB@BA@@B@B@@@@B@@@@B@@@B@@@B@@@B@@@@@B@@@B@@@@@BA@@B@@AB@@@@@@@B@B@BB@@@B@@@B@D@@BB@@@@@@D@@BB@@@@@BB@B@@@B@@@BB@@B@BB@@@@@BB@B@@@@BBAB@BB@@@BB@@@@@BBBAB@@@B@@@B@B@@@@@B@@@BB@@@@@@B@@@@@B@@@@@BB@@@@B@@@@@@@B@@@@@@BB@@@B@@@@@@@B@@D@@DB@B@F@@B@@@@@BBA@@B@@@@B@@@B@@@B@B@@@B@@@@@B@@@@@@B@B@@A@A@@@ABBBB@@@@BBB@@ABA@AB@@@BBBA@@@@@A@@B@B@@@@AB@BA@@@@A@A@@@A@@@@@B@@@@ABAA@@@@A@AB@A@A@@A@@A@@AAA@@@@@A@@B@@@@@@C@A@EBE@AHBDDDF@D@@@BD@@@@@BB@@B@@@B@@@B@@@B@B@@@@B@@@@@B@BB@@@B@@B@@@@B@@@B@@@BA@A@ABAA@BA@@@@B@@A@@AA@@@@BA@@@@@@@@B@@@@A@@BA@@B@@@@AB@@@B@@@@@B@@@@@@B@@B@@@@@B@@A@@B@@@B@@BB@@@BB@@@@@B@@B@@A@@@@BA@@@@BAD@@AB@@BB@@@BBB@@@@B@B@@BB@@@@@BB@@B@@@@B@B@@@B@@@BAB@@AB@@@B@B@@@B@@AB@@@B@@@@BB@@@B@B@@@B@@@BBBB@B@@@B@@@B@@B@@@@@@BBBB@@B@@B@@B@B@B@@A@A@AD@BB@@@BAB@@@B@@@@@BB@DBB@@@B@BB@@B@@BB@@D@@@B@B@@BB@@@@ABB@@@B@@@@AB@@@B@BA@@B@@@@@@@B@@A@@B@@@B@@@B@@A@@@A@@A@B@@@@@@A@@@@@@@@AA@@@@AA@@@@AA@@A@B@@@@@@@@A@@@@@BB@@@@@@BB@@BB@@B@@B@B@AAB@@@AA@@B@@A@@@AA@B@@@@@@@AA@@BA@@@@@@@@@@@B@@@@@@BA@A@@@A@BB@@@B@@@@@BB@@@@BA@@BA@@@@BB@@B@BB@@@@BBBB@@B@@BB@BB@@@@@@BB@BB@B@B@BB@@@B@@@@@@@BB@BB@@@@DBBB@@@BBBB@@B@@BD@B@@@@@@BA@AB@B@BA@@BB@@BB@BBB@@@@@@B@BB@A@@BAB@B@BB@@B@@@B@@B@@@@B@@@@@BB@B@@@@BB@@@@@B@B@@@@B@@@@BBB@@B@@@@@@@B@@@@ABB@@BBBBBB@B@@B@@@B@B@BA@@@@@@B@@@BBB@@@@@AAA@@A@@B@B@@BB@@@@@@@B@@@B@@BBAB@DAB@@@@BB@@@@@BB@@@@BBD@BB@@@@@@@BA@CBC@@@A@@@@@@@BBB@B@@@@B@@@@@@@@@@@@CA@@@@@B@@@A@@@@A@@@@@A@@@A@@BB@@@A@@@@@A@@@@@@@B@@@A@A@@@@@@@@@@B@@@BB@@A@@@B@B@@BA@@@B@@@B@@BA@@AB@@@@B@@@@@B@@@@@@@BB@@@@@@B@@@@@@@@@A@@@@B@@@B@@@@@@@AAB@@@A@@B@@@@@AA@@@@@@@@A@@AA@@@@B@@@@@@ABBB@@@B@@AB@B@BA@@@ABA@@@A@@BA@B@@BB@@B@@@B@@@B@BB@@B@BAB@B@B@@BB@@@@@B@@@@B@@@@BB@AB@@@@@B@@@BA@@B@@A@@B@@A@@B@@@B@@@BB@@@BB@@@B@@AB@B@@@@BB@@@@@B@B@@@B@@BB@@B@@B@@AB@@@@@@@B@@AB@B@@@@@BB@@B@BDBB@B@@@@@AB@B@@@B@@BB@A@@B@@A@BB@BB@@BB@@@@@A@@B@AAB@@A@@@@@@AC@@@@@AD@@ABB@@B@@@B@B@@@BB@@B@B@@@B@@A@@B@@@B@@@@@BBB@BB@@@BBB@@@@@BBB@@B@@@B@@@@@BB@@BB@@@@BBB@B@B@@@@BB@@@BB@ABBB@@@@@B@@@B@@B@@@@@BDD@ABBB@@@@@BD@@@@@B@BA@@@B@@@BB@@@@BAB@@@B@@@@@@@B@@@@@@B@@@@@BB@B@@@@@@@@BB@@@DAB@BA@BB@BB@@@BB@@@@@@@BB@@@@B@@@@BB@B@@@@B@@B@@@B@@B@@@BBAHAB@BB@@BBBBBB@BB@FFBBB@B@BBBAB@H@@BBBBBBBB@BDH@B@B@@@DAB@@A@A@@CEEECE@@AA@@@@@A@@A@AA@@A@AA@@@@A@AE@@@C@@AAA@@@@ADADADA@@DDBB@BBD@@@BB@B@FB@@D@DA@@@@@A@@BA@@@C@ABA@A@ABA@AAC@A@@BABA@A@A@@AA@AAACC@@AA@@BA@AB@BAFBB@BAA@@A@C@A@@@A@@@@AA@A@ABC@E@@BA@@BA@@BCB@@ABA@@B@@@B@DDB@BBB@BBBD@BB@@BB@DA@@@@FDB@DBB@DBB@B@BB@@@@@D@B@@BD@BDB@B@@BBBB@@B@B@BADA@@B@AA@@A@@A@@@@B@@A@@@AAA@@AAA@@@@A@@@@@A@A@AAA@@@C@@@@@@B@@@D@B@@@B@BBB@@@BB@@DAB@@@D@DADA@@D@DB@@BB@D@B@@BBF@DBB@@A@@AA@CAA@@BA@A@@BA@@DBBBB@@@BABA@A@A@@BABC@@DA@@B@@@DB@@B@@BDB@@B@DAB@BAAAAC@@@A@@@CBA@@B@@@D@F@BBB@D@B@@@@@BA@ABABAAA@@AA@@CC@@@A@@BA@A@@@@A@@@A@@@A@A@@@@AAAA@@@A@@@AA@@A@@EAA@A@A@C@C@A@A@@@@A@@@@AAA@A@C@A@A@ABC@@@A@@@AAACAAAA@@@AA@@@@AAC@C@@@CBAAA@@@C@AAA@ABABA@@AGE@AAA@A@A@A@C@@@A@@@ACA@A@C@E@A@ABC@A@@AA@@@@AA@AB@@AB@BADCB@@ADABA@AB@@@@A@@@@B@DABB@@BBB@@D@@BBBB@@BB@@D@B@DBB@DB@@FAB@DCB@BAB@BA@@B@B@B@D@@@@@BBBD@BBBBBBBD@B@BBB@B@BAB@BABCB@BABABC@@B@FBB@BBB@D@F@@BB@@@B@BCBA@@BA@A@@@ABC@A@A@A@@BA@@@@@ABA@@@A@A@AB@AA@@@AA@@AB@BA@ABCB@BAB@@ABA@@@A@A@@@@@A@ABAB@@A@@B@@@@C@@@AB@@A@A@@@@@AB@BA@@A@@AB@@@B@B@@BD@@@B@@@@AB@B@B@@@B@BABA@@BA@@@AB@@@@CB@BADA@ABA@A@@DA@ABA@@DBB@@@B@BA@@B@@AB@BA@@BAA@@@BC@@@AB@@A@AB@BABADA@@@@DC@@@AA@@CAA@@@ABABC@@DA@ABC@@@C@@@A@A@@@A@@BABAB@BADAFC@@@A@ABA@@BABABCBABABA@ADC@A@A@A@@@A@ADABA@AB@BABADA@@@@@A@@B@@@DAB@@@DA@@@AB@@@@A@A@@B@@@@A@@B@B@@AB@@@BA@@AA@@AA@@A@AAAA@A@@BE@A@@@@@AAA@A@ABC@@BABAB@BAB@@@@A@A@@@AACA@@@AA@@AA@A@@A@@AA@AC@@A@@ACA@@C@A@AA@@AAAA@A@A@AAAACB@BC@A@A@@@CA@@A@A@@@A@@A@@@AA@ACA@AAA@AACA@@CA@@@@A@@@@@@AA@@@@@@@C@@@AAA@AA@@@A@ABAAA@CC@AA@A@A@A@A@E@A@AA@@AA@A@A@AAAA@@AAA@CAA@@@@A@A@@@A@EB@@A@A@A@A@CB@@CAA@@@@@AA@A@@AA@AA@@@BA@A@A@@@A@A@@A@AAAAAAA@AC@@@A@C@@@A@AA@A@@AA@C@A@A@@@AA@A@AAAAA@@BAB@@A@AB@@@B@BAB@@@@@@A@@BAB@DB@@@@BB@@@@B@B@@@BABA@@B@@@B@B@@@@A@@@ABC@@A@AA@@AA@A@@@@A@@@AAAA@@AA@A@@B@BAB@@@B@@A@@@A@@@@B@@@B@@@B@@@@AB@B@D@BB@@@AB@@ABADA@A@@BA@@@@B@@BBB@BB@@@@@BABAB@B@BA@@@@B@D@D@B@@@B@@@@@@A@@@@@@@A@@B@@@@@B@@@B@@@B@B@@AB@@@@A@A@C@A@ABADAB@@A@@A@A@@A@@@@@@AAA@@@A@@@AB@@A@A@@@@C@@@@B@@@BA@A@@@@BA@@@@@A@@A@@@A@A@A@@@@@A@@AA@@A@@AA@@CB@B@@A@@@AA@@A@A@@@@@A@@@A@@A@A@A@@@@@@A@A@@AA@@B@@C@@@A@A@@A@@CA@AA@AA@@A@AAAA@@AA@@A@@@A@AAA@@BAAA@@A@A@@@A@@@AA@@A@@@A@@A@@AAAA@@A@@AC@ACC@A@@@@@@AA@@A@BA@AB@@@@@DC@@@@B@B@@BB@AAA@@AA@AC@@AA@C@A@@B@B@BA@@BAA@A@@A@@@@A@ACA@@AACCACDAB@@@B@B@@B@@B@@@BBBAB@@A@@@AA@@@@@@C@A@@B@@@D@@A@A@A@A@A@AA@@A@@A@A@@AAAAA@CAA@AAA@@AA@@A@@@@@ACA@@@A@@A@@@@@A@@A@@CAAA@@A@CAA@A@@AA@A@CAA@CAAA@@@A@@@AA@@A@A@@@A@A@@A@@@EAAA@ABC@AB@@@B@@@@@@AB@B@DA@A@@B@@BB@@@B@@@BBB@@@B@B@B@@@D@BAB@@@@A@@@@D@BA@@A@@A@@@@@AAA@@@@AA@AB@@A@@@AA@@@AAAAA@@@C@@@@@AACAA@@@ABC@AAA@A@CCAA@@@A@A@A@@@A@@CBCBAB@@A@C@A@A@ABCBA@AB@BA@@@A@A@@@ACA@@AA@@BA@ADAB@@ABA@A@A@@AA@AACAA@C@@@@@@AA@@A@@@@B@DC@AB@DCBC@@B@D@@@B@BCDC@@@@A@AAAA@@@A@@@E@A@@@A@@@AA@@@A@@@A@AB@@CB@@AAA@C@@@A@A@@BABCB@@@BA@@@A@A@A@@@A@@BA@@@ABABABAB@@@@@B@@@B@@@BAB@@A@@@A@@@@B@BABA@AAA@@A@@@AAA@AAAACAA@@@@@A@@@A@C@@AA@@AAAA@@@BA@@@AAA@@A@A@A@A@A@C@@B@DAB@BA@@BA@ABABABA@@B@BBB@B@B@@@BA@@B@BCA@@@AA@A@@@@@AAAA@@@A@@A@@AA@@@@AA@@CA@AAA@@@@A@A@@@@@@AB@@@@@@AA@ABA@A@A@AA@@BABA@@B@BC@A@@@AB@@AAEAA@AAA"],encodeOffsets:[[120304,27151]]}},{type:"Feature",id:"350429",properties:{name:"泰宁县",cp:[117.17574,26.900259],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@AA@@A@A@AA@@@A@@@AA@@CA@A@@@@BA@@BABABA@A@A@AA@@C@@AA@@@A@@@A@@AA@@@AB@@@@A@@AC@@AA@@@@AA@@@A@@AA@@AA@@@A@@CA@@@A@@@A@@@@@AA@@AA@@A@@@AA@@@@AA@@@A@AA@@@AA@A@@@@A@@A@@@@@AA@@@@@AA@A@@@@@AA@@A@@@@@@BA@@@@DA@@@AA@A@@AB@@A@@@ABA@@ACB@B@@BBB@@@BBBD@@@BA@AAA@A@@@@B@@@B@@ABA@@@@BBB@@AB@BABA@@@@B@B@@@B@B@BA@@@BFAB@@A@A@@@A@@@@BA@@@AB@@A@A@AA@@@@@B@@@@@B@@@B@@@@A@@@A@@@@BA@@@C@A@@@A@@@A@@@@B@@@B@@@BA@@@@BA@@@A@A@@@AA@A@@AA@@@@@A@@A@AACAA@@@AAA@AA@AA@@AAA@@@A@@AA@@ABABA@@@@@A@AB@@A@A@@@A@@B@B@@@@AB@BAF@@ADA@BBB@@BBB@BAB@B@BAB@@AD@@A@@@A@A@ABAB@@CB@@@B@@@BAB@@@@AB@@@@BB@BBB@BBBB@CB@B@@ADBB@BA@@BA@@BB@CD@BA@A@ABA@A@@BAB@@@@A@ABABAB@@ABA@@@A@AB@@AA@@AA@@A@AA@AA@@@A@@@@@A@@@A@@@C@A@A@AB@@A@@B@@A@@BCBA@C@@@@@A@@BC@@@A@C@@@A@A@@@ABA@A@A@@@A@@@C@AD@B@@@B@@CBA@@@A@C@@@@@A@CBAB@BABA@@B@@ABAB@@ABA@A@@@AB@@@@@@A@@@@AA@A@@@@@C@@@ABEB@@@B@@@@AB@B@@AB@B@B@@@B@@@B@D@@BBA@@@@BB@@@@@@B@@@@@B@@AB@@BBA@@BAA@@ABAA@@A@A@@@A@@B@@A@@@@@AB@@AB@@@B@@@BB@@B@BBB@@@B@@BB@@C@A@AB@@@@A@@BAAAB@@ABBD@@@@@B@@@BAB@B@B@@B@@B@@@B@@@B@@AB@@@BBF@B@B@BAD@B@B@B@@A@@B@@A@@@A@@@CB@B@@@@@BA@@@A@AB@@A@@B@@@@@BB@@B@@@@@BA@C@A@@@A@@B@@@BA@@B@@A@A@A@C@A@A@@@@B@B@@@B@@@B@@@@@D@@@B@B@BB@@BA@B@@B@@@@@B@@ABA@AAADA@@BA@@BA@A@@B@@B@@D@BBBBDD@FDBB@@DBB@@BB@BB@@BBBB@@B@@@B@B@B@@BB@@B@BBB@BBBB@BB@@@@BB@@@B@@BB@@BBB@@@B@@BB@@@B@@A@@BA@@BABAB@B@BB@@BB@@BB@@B@@BBB@@@@B@@B@@BB@BBB@@@@@@B@@@@AB@@@BA@@D@@BB@BA@@@BB@@@@@B@@BD@@@B@@B@@B@@B@BB@B@B@B@@@B@@@@BB@@@@@@@B@@@@@BB@@@@B@@B@@B@DA@BBA@@@BB@@@B@B@BB@@@@@B@BB@@@@@AB@B@B@@BBAB@@@@ABABA@AB@B@@@BBB@@@BB@AB@@@@BB@@@@@@@B@@@@@@BB@@@@@@@@@B@@@@@B@@@@AB@DBBB@@BB@BB@@@BBB@@@B@@B@@D@@A@@@@@@AA@@@@@A@@@@@C@AA@@A@@D@DAB@B@BAB@@@@@B@@AB@AA@AA@@A@@AAB@@A@ABA@@BA@@@A@@@A@@@@@A@A@@@@@@B@@@@BBB@@@BB@@BD@@@B@@BB@@@B@@@@@B@@@B@@@@@B@@@@@B@@@@BB@A@B@@@@@@@@BA@@@@@A@@@@BA@@BA@@@@@A@A@@@@@@@AB@AC@@BA@@BA@@BABABA@@B@@@@@@AB@@AB@@@BD@@@D@B@B@@@B@B@@A@@B@B@@@B@B@B@FB@@@F@BA@@B@B@B@B@B@@AB@B@B@@B@@BBB@@BB@@B@@@@B@BBB@B@@BB@@@B@BBB@B@@@BBBB@@A@@BA@@@A@@DA@C@@BCBA@@@@@AB@@@BA@@@AB@@@@@@@@@@A@@@A@@@@@A@@@AD@@@ABC@ABC@@@A@A@A@@B@@A@AA@A@C@A@@@A@@@@@@AA@CB@B@@@BA@A@@@A@A@@@AB@@@@A@@B@@@B@B@@@@@BB@@@@D@@@@@@@B@@@B@@@@@@@@AB@@@@@@@B@@BB@@BD@@AB@BA@@@ABABEEAACA@@C@A@A@@@CBAB@@A@@@@B@@A@@@@@ABA@@BA@A@@@@@@B@@@BB@@B@@@@@BBBAB@@@B@@@@@@@B@@A@@@ABA@@@@BA@@@@BAB@@@@@B@AAB@@A@@@@@@B@@@BA@@BB@@B@@@B@@@B@@@@BB@@BB@@@@@@BB@@@@@BA@AB@@@B@@A@@@@@@B@BA@@BA@@@@@AB@@@@A@@@@B@@CD@@@BBDB@@@BBBBBB@@@@@@@B@@@B@@@D@@@B@@@B@@@@@B@@@BA@@B@@@DA@@@@@AFABAB@BA@@B@BB@ABA@@BB@@@A@@B@B@@@B@@@@@B@@@@@B@@@@@B@B@@@BB@@@@@@B@@@@B@@@@@@BB@@@@B@@@@@@B@B@@BB@@@@BB@@@B@@@@B@@@@@@@@@B@@@@@B@BA@@BB@@@@B@@@@A@@B@@B@B@A@@BB@@@B@@B@@D@B@@@@B@B@@@@@B@@@B@@@B@@@B@@@@ABBB@@@B@@BAB@@@@BB@@@B@@BB@@@@B@@@@@BB@@@B@B@@@BBBB@@BBB@D@BB@@DD@@@@BB@A@BB@B@@BBB@@@@@BB@@@@@@BB@@@@@B@BBB@@B@B@@@@BB@@@B@@@@@B@B@@B@@@B@BB@@@@@@B@@@@@@BB@B@@@@B@@@B@@@@@BBB@@@@@@BB@@B@@@B@@B@@B@B@B@@@BABBDAB@BAB@@@BA@@BB@@@B@B@BBB@BB@F@@@D@B@B@@A@@AA@@AA@@BAB@@@@@BB@@D@@@B@@B@@BAB@BBB@@@B@@BB@@@@@@BB@@@BB@@B@@BB@@@B@B@A@BB@@BB@@@BB@@@DBABB@@B@@B@@BBB@@@B@@BB@@@B@@@B@@F@@@@BB@BB@BBBBB@B@@@B@@BB@@@B@@@@@@B@B@@A@@BA@@B@@@B@@AB@DABAB@@@@@BB@B@BB@DDB@B@@@B@@BB@@B@@B@@BB@B@B@@@B@@B@@B@@@BB@@@@BB@BB@@@B@@B@@@@B@@@BBB@DB@@@B@@@B@@B@DDB@@D@@BB@BBBBB@@BB@@@B@BBBA@@B@BAB@@@BB@BABB@@@@@B@@@@AB@@@B@@@@@@@BBBA@@@@B@@@@@B@@ADA@@@A@A@A@A@A@@@A@AB@@@@A@@@@@B@@B@@@@@@@B@@@@@@@B@@@@@@B@@BA@A@@B@@@@@BBBBB@@B@@@BB@@@@@@ABC@@@A@@@@B@@BB@@B@B@@@BA@BA@AB@@@B@@@@@B@B@A@@A@AB@@@@@B@@BAB@@BAB@@AB@@@BB@@@B@@@@B@B@@B@@@@@ABA@@@@B@@B@B@@@@@@@@BA@A@AB@@A@@@@@A@@@@@@@A@@BA@@@@@@B@@AB@@AD@BA@@@AAA@A@CB@@ABAB@B@B@@@@@B@@@@@BB@@BD@B@@B@@B@BB@@BBA@@BBB@@D@@B@@A@A@A@@B@@@B@@BB@@@B@@@@@B@@D@AB@BB@B@@AB@@@@B@@@@@B@@@@@BBA@@@@B@@B@@B@@@@@B@@B@@B@@@@@B@@@@@BB@@@@A@@@A@E@@@@B@@@B@B@B@BBB@@BB@@@BB@@B@@@BBB@B@@@@BB@@A@@B@@@@@@@B@@AB@@A@@B@@@@A@@@AB@BB@ABB@@@@@@@@BA@BBABB@ABB@@@AB@B@B@B@@@B@@A@@@@B@@A@@@AB@@@@A@@B@@A@@@@@@B@@B@@B@@@@BB@@DB@@@BDB@AB@@@@BD@@BB@@@BBB@B@@@BAB@AABA@@AC@@BA@@@A@A@@B@@BB@@@@@B@@@BB@@@BBB@@B@B@B@@@B@B@BA@@B@@@B@@@BADA@AAC@@@@@AA@@@@@A@@AB@@@BA@@@AB@@ABAD@B@@B@@DB@BB@@BBDB@@BB@B@@@@@BB@B@B@BBD@BBB@@B@B@BAB@@@B@B@@@BA@@@@@@@@B@BA@@@@@AB@BA@@DAB@BA@A@AB@@@B@@@B@@@@@@A@@B@@ABA@@@@BA@@@A@@BAB@BCB@@@@BB@BBB@@@B@BBBBB@B@BBBBB@D@D@@@BB@@B@@@BBBBBB@BB@B@@@@BD@@B@D@@B@@@B@@BBABBBBDB@@B@B@@@DAB@@BB@BD@@@B@@@@BA@@BC@A@ABABAB@DADABAB@BB@A@@BCBAB@BA@@B@@@BA@@B@@@BA@@BC@@@@BA@@@A@ABA@A@A@@B@BA@A@@@A@@@@@@@A@@@@@AB@@AB@@@@@BC@@BA@AB@@@BA@AB@AA@A@@AA@@B@@A@@@A@ABABAB@BABCBABEBA@ADADABABAB@BB@AB@B@@A@@BBB@B@@@B@BADC@@B@DABAB@@@@@BAD@B@@@@@B@@@@@B@D@B@@@@ABCB@BA@@@AB@@@B@@@BAB@@BB@@AB@@@B@@AB@@@D@@@BA@ABA@A@ABA@C@A@AA@BA@@B@DAFAB@BB@@B@BBB@BB@@D@@BBB@@@BBBAD@@BB@BDH@B@@DBFBDDFDB@BB@@FFBBB@@@@B@@B@BAB@B@BBB@@B@@@B@D@J@@@B@@@B@@BBB@@DBBABBBA@@B@@@@@B@@@@A@@@@BB@@BBB@@@@BBA@@BB@@B@@@B@@ABB@AB@@@B@@@@AB@@@@@@@BA@@@A@@@A@@B@@A@@@A@@B@@@BBBBB@B@@D@BBBB@@@@B@B@@@B@@@@@@@@B@B@@BB@@@@AD@@B@BB@B@@A@AB@@@@@B@F@D@@AB@@@B@@@B@@@BCBC@@A@@A@@@A@@@A@@@A@@@A@@@@@A@@A@@@A@@AAAA@@A@A@@@@AA@@@@@ABA@@B@D@B@BB@BB@@@BA@@BB@@@@B@@@B@B@@@@@DBB@@@BB@DDDD@@D@J@F@@AB@B@B@DA@@@@@A@@@CB@@AD@B@B@B@@@@ABA@A@@FADAB@B@BA@@BA@@B@@@B@@@B@B@@@B@BA@@@A@@B@@@@@B@B@@@@@B@@@@@B@@@@BBA@@BB@@BA@@DAB@@@@ADABE@@@ABA@@B@@@BAB@@@B@@@@@DAB@@AB@BAD@B@B@BADAB@@ABA@@BA@@@AB@B@D@BB@@@@B@@B@@B@ABB@@@@B@@B@@@@@B@B@@@@@B@@@@A@@B@DB@B@@B@BB@@B@@AB@@B@AB@BAFAB@DBB@DCB@B@BABB@@DB@@B@@@@AFCBA@@@AA@@AA@@@BA@@BC@@AA@@@@A@A@A@@AA@@AAA@A@A@ABA@ADAAA@@AA@@@@A@@@@AA@@@@A@@B@@@BA@@@A@AAA@A@@BA@@BA@AB@@@B@@@@@@B@@@BB@@@B@@A@@F@@@@AB@@A@@B@@A@@DBBB@@B@B@B@@@@@@A@@@C@A@@@C@@BA@ABA@@@ACA@@BCD@B@@A@@BA@@BA@A@A@@@AA@@@A@@@@A@@A@A@@A@@A@@@@@AA@@@@@@A@@A@@AAA@@AABA@CB@@A@@A@@@@@@A@A@@A@@@@@A@@@@A@@@@@@@A@@@A@AB@@@@AA@@A@@@B@@A@A@@@@AC@@@@A@AA@BCC@A@@@@@AA@@@A@@@@@A@AA@BAA@@A@@A@@A@A@A@AA@@@@AA@@AA@@@@A@@@A@@@AA@A@@@@AA@A@@AAA@AA@@@@A@@@A@@@@BA@@@A@A@@@AA@@A@A@@@A@@@AA@BC@@B@@@@BD@@@@@@@BA@BBA@@@@B@@@@AA@@AAA@@A@BA@@@@BAA@@@A@@@ABA@@@@A@A@CA@A@AA@@A@@@@@ABA@@@A@@@@@@BA@@@AA@@@AA@@@A@@@A@A@@@@AA@@@@@ABA@@@A@@AA@@A@@A@@@A@@@AB@@@@AB@@@@AB@@A@@@A@@@@BAA@@A@@A@@@@@@A@@@@AA@@@A@ABA@A@AA@@A@A@@@A@@@AA@@AA@B@@AB@@@B@BA@@B@@A@ABA@@@A@@AABA@@@@@@@A@@B@@BB@@@@@@@@@BB@@@@A@@@@B@@BA@B@@@@@@@A@@@A@@B@@@@@@@@@A@@@@@@@AA@@@@@@A@@@@@A@@@@@BA@@AB@@@A@@@A@@AB@@@A@A@@@BA@@A@@@A@@@@@@@@B@B@@@A@@@@@@@B@@@@@B@@@A@@AB@@@B@@@@@B@@@@@@B@@@A@@@@DB@@@@@@@@@@@@@A@@A@A@AA@@@@@@B@@@D@DAB@@A@@@@@@AAC@AA@@@@AA@@@@A@@A@@A@CBA@AB@AA@@@A@@@@@@@A@@AA@A@@@@BB@BB@@@@A@AA@@A@@@@@@@ABA@A@@@A@@@@AAAAAAA@@AA@B@@A@@@@@@@A@@@AA@A@@A@@@@@@A@A@@@@AA@@@@@AAA@@@@A@@@@@@AA@@@A@@@AAA@A@AB@@@BAAA@@@@@@@AA@AAA@@AA@@ABA@A@@BAB@@@@@@@AAC@@@AA@AA@A@@AACA@@@@AAA@@A@@@@@@@A@@AAA@A@A@@AAA@@@@@@AAA@@AA@@@AAAA@@@@AAA@@@AA@@@@AB@@AB@@@@AA@@@@A@@@A@@A@B@@@BAB@@@@@@@A@@@@@@@@@@AB@@BB@@@@@@A@B@@B@@@BA@@@BB@@A@BBA@A@@@@AA@@AA@@A@@@@A@@A@@@@@B@@@@@@A@B@@@BB@@@@BB@@@@BB@@@@@@@@@B@@@@A@B@@@@B@@@BA@@@A@@@A@@@@BA@@@@@@@A@@@ABA@@@A@@B@@A@@@A@BA@@@@AA@@@A@A@@@CA@@AA@@@AAA@@@A@CAA@@A@@@@@A@@BA@A@@AAC@@B@B@BA@A@A@@@@AA@@@AAAA@@@@@@@AA@@@A@@@A@A@AA@A@@@A@@@A@A@@AA@@@@@A@@BA@@@A@@@A@A@@BA@@BA@A@@@A@@@A@A@@A@@@AA@@@@A@@AA@A@@@@@AA@A@@AA@@BA@@BC@A@@B@@A@@B@@@@AA@@@@@A@@A@@AA@@@A@@@AB@@@@A@@@@@AA@@@@@@@@A@@@@@A@@BA@@@@@AB@@AB@@@@@@A@@@@@@B@@A@@B@@BB@@@@A@@B@@ABBBAB@B@@A@@@A@@@A@@A@@@@A@@AAA@@@@@A@@@@@@A@A@@@A@@@A@@@AA@@A@@@@AC@@C@E@CCACBGF@FAB@D@@@@@@@@AB@@@@@B@BB@@@BB@@@@B@BBAB@@@@@BBBA@@@@@A@@@@@B@@@B@B@@B@@ABA@@@@@A@AB@@@@@B@AA@A@@BAB@BA@@AA@A@@A@AABA@@B@B@@@@A@A@@@@A@@@@@A@@@A@A@@@A@@@A@@@@@@AB@AA@@@@A@@@@E@ACA@@@CA@@@@@@@A@@@A@@A@@@@A@@@@@@@A@@@@@AA@@@@A@@@@@A@@@@@@@AA@@A@@@@@A@A@@@A@@@A@ABAA@@@@A@@A@@AAA@AB@A@@A@A@@A@@@@AAA@@@AA@@A@@@A@A@@A@@@@AA@@@C@@@@A@@AC@A@@@A@@@A@@A@A@A@@@@@@BA@@@AB@@A@@@@@A@@@A@@@@@A@@@A@@@A@@@A@@A@@@@@@A@AB@@ABA@@BA@ABA@@@A@@B@@@B@@A@@@A@@@AB@@A@@B@@A@@@C@@B@@A@@@@@A@@AA@A@@@A@@@@@A@@@@@@B@@A@@B@@A@@@@B@@A@A@@@A@@@A@A@AA@@A@@@AB@@@@A@AAA@AAC@A@A@@AAAAAA@AB@@@@@@ABA@A@@@@@AB@@@BAB@@@@A@@B@B@B@B@D@@@@AB@@A@@@@@ABA@@B@@A@@DA@@B@B@D@B@B@B@@@B@@@B@@@BAB@BAB@BA@@@@B@BCB@@@BA@@@@B@@@@AB@@@B@AAB@@@A@@A@@@@@@@A@@@@CAA@@@AAA@@A@A@E@A@AA@@@@@@@A@@A@@A@@@@AA@A@A@A@@@@BA@A@A@@@@@A@A@@@AAA@@@@@AB@@A@A@@AA@@BA@@@@AA@@@AB@@A@@@@@A@@@A@@AA@@@A@@AA@@AA@AB@@A@AA@A@AAA@@@A@@A@@@@A@@A@AAAAAAB@@@AAB@@@@A@A@@@@@ABA@@@@@A@A@@BAAA@@@ABE@@BE@@@A@A@AB@@A@A@@@@BA@@AAB@@@@A@@@@@@@AAAA@@A@AA@AA@@@A@@@@@AA@A@@AA@AA@@A@@@@@@@A@@@@AAA@@A@@A@@A@@@@@@@AAA@A@@A@@A@A@A@@BA@@@A@@@A@ABA@@BA@@@ABA@@@BA@@@A@A@A@AB@@A@@AA@@A@@BA@@@@AA@@A@@B@@A@A@@A@@@A@@@@AA@@@@A@@@@A@@@@@A@ABA@A@BB@@AB@@BBA@@B@@A@@@A@@B@@A@A@A@@@@A@@@AAA@A@@A@@@@@@@@@A@AA@@A@A@@A@@@CBA@@@A@A@A@AA@BA@CBCB@@@@AB@@B@@@A@@@@@@@@B@@@@@@@@BB@@@@@@@@@@AA@@@@A@@@@@@@@B@@@@@@@B@AAB@@@@B@@@@B@@@A@@AAA@@@@@@AAA@@@@A@A@@@AA@@A@@@@AA@@AA@@@@A@A@@@@BA@@@A@@@ABA@@AA@A@A@A@@@A@AA@A@@@BA@@@A@@@@A@@@@A@@@AA@@A@@EA@@A@AA@@@@A@@@@AABA@AC@@@@A@@@A@@@@@A@A@A@@@AAA@A@A@ABA@@@@@A@@@@@A@@@@@ABA@@@A@@@ABA@@@@@A@@@ABA@@@A@@@@@@@A@@@A@@@A@@@A@@AA@@A@AA@@A@@AAA@AA@@AAAD@@@ACA@@AAA@GB@@A@@@AAA@A@A@CB@BA@@@A@@@A@@@A@@AA@@A@A@@@A@AA@@@BA@A@@B@@A@@B@@A@@@@@@@@B@@A@@A@@@@@@AA@@@@BA@@A@@@@A@@@@A@A@@@A@@@A@A@@BAB@@@@AB@@@B@@@@@@@@@BABA@@B@AA@@@A@@@@@AA@@AA@@@AA@@@A@A@C@A@C@C@AD@@@BBD@BBB@B@@@B@@@BA@A@@B@@AA@@A@@AAA@@AA@@A@@@AAA@AA@@A@@@AAA@CACAC@@ACAA@@A@A@C@A@A@C@A@C@@AA@@A@AA@A@C@A@A@A@C"],encodeOffsets:[[119912,27237]]}},{type:"Feature",id:"350430",properties:{name:"建宁县",cp:[116.848443,26.833588],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@AAABAAA@A@@AACC@@AC@@@@@AE@CAAAA@CAA@A@AA@@@AA@@A@AEC@@@@@A@@@A@@@AAA@@BA@@@AB@BA@@@AA@@@A@AA@@A@CA@@CAA@A@A@@@@@@A@@@@AA@@@@BAD@@@@@@@CAA@@A@@@@BA@@@@@C@@A@B@DA@@@@@A@A@A@@CA@@@ABABABABA@@@A@A@@A@A@A@A@A@@@A@AAA@@@A@CBCB@BA@@B@@A@CAAA@@@@AB@@@@@BAB@BAB@B@@@D@B@D@@A@@@A@G@C@A@A@@@AB@BA@A@@@@B@@@BA@@@AAA@@@A@A@@B@@@@@B@@@B@@A@@@A@AB@B@BA@@B@@A@@@A@@@A@E@AB@AC@AA@@AAAA@@G@A@A@AA@@A@AAA@A@@@@B@@@BA@@@ABABA@A@@@@BA@@BABB@@FBF@@BB@@HB@@B@DBBBB@BBD@@@B@B@BA@BDBFB@@@BB@@BDB@BB@@B@@@@ABABAB@B@BBB@D@@@BDF@B@@@@@BC@@@CDCF@@A@A@@@A@@@ABEFCBABAB@@@@A@@AA@A@@@@@@A@@@A@@@@AB@@@@A@@@A@@A@C@@A@@@AAA@A@@@@@@@@A@A@A@@@C@@@@AA@@A@AA@@A@BB@@A@AB@@@B@@AB@@A@@@A@AB@BAB@B@BA@A@@@@@A@A@@@A@EDA@@@C@ABA@C@A@@B@@@@@@DBFBDB@@B@@A@A@@BA@@@@@@BB@@B@@@@B@@@@@BBBBD@B@D@@BB@BBBB@@BB@B@BB@@BB@@BBBB@@@@@BB@@@@@@DB@@D@@B@@BB@BAB@@BB@B@BB@@D@BA@@B@@@BB@@BB@@@B@BB@@@@BGBABC@A@@BA@@BAB@B@BAB@@A@@BA@@B@@A@CDAB@@@@@@DBFD@BD@B@@B@B@@AB@@A@A@A@@@AAAC@AA@AAA@AA@@@@@B@@@BB@@@@B@@B@BB@BBBBB@FBBA@AACAAAAA@@A@AAA@AA@@@A@A@@@@@A@@@@@@@A@@@A@@AA@A@AB@@@@A@@@@AAA@@@@@AA@A@@A@AA@@@@@@A@@@@B@@C@@B@@@B@@@@A@CA@@A@AD@@AAABAA@@@BA@A@EB@BA@@@@D@@@@@@AC@@AAA@@@ABA@@@ADAD@BA@ABAB@@@@A@@@@@@A@BAA@@@@@A@@A@@@A@A@@DBB@@A@A@@B@@@B@B@@BB@@@@@@@BA@@B@B@@@@ABA@@@@BA@@@A@@B@@@@A@CC@@@@A@@B@@@B@@A@@@A@@@C@@@A@@AAAA@@CA@@@C@A@@@C@@@A@C@@@AB@@A@A@AB@@AB@@@@@D@@@@@@A@A@A@A@@@A@@@C@@@A@@@A@A@C@AAA@@@A@A@@@@B@@AB@@@@CBA@A@C@@@AAA@CA@@A@A@@@@@A@@@CD@@A@@@A@@AAA@@@AC@AACACAAA@@A@@@@BBB@@DD@@@@@BA@@@A@AA@@C@AB@@AAAA@@CACB@AAAA@A@@A@@A@@@A@@@A@A@@A@@@@A@A@E@A@@@AAAAAA@@CE@AA@CAAA@@@AA@CGAAAE@AA@A@AAAAABFLEPIBK@A@@@A@E@IRIFCDCFABKLORCDGLCRCR@BBH@@DPFDHJRHLJB@BHXVJNBDFNANINCFCJCFCPCJ@BBL@T@FBFF\\FFDFHLHF@@DBDDHBLLJJVVNFHJBBXdJNFLJJNTNPFDJJBBHJJJDFRNJJLHNFRLPFDBFBF@@BLBPANBF@XGHEPEFLJVRLRFPDB@PBTFRFJARAPGNAJCRDB@FFHDPJJLTHHDTBLBH@JAHAJARYHFJRDNBBDHRVRRNHLFB@LHHD@@TLHBPHPBPDJBNJJFB@@@B@DBFBDB@B@@B@@@@AB@@@@@BA@@B@@@@A@@B@@@@A@@@A@A@ABA@@A@BAA@BAAAB@@A@@@@@@A@BAA@@ABA@@B@@@@@@AB@@@BA@@@A@@@@@@@AB@@@AA@@@@@AAA@A@@@AA@@A@@AA@@AA@A@A@A@A@@@A@@F@B@@@B@@@@@AA@@@@A@@@@@A@@@@AA@@@@@A@@@@AA@@@@@AB@A@@@@@A@@@@@A@@A@@BA@A@@ABAC@@@@A@@@@@A@@AA@@@A@@@AB@B@B@@@@AC@@@AA@AB@AA@@AAA@@@@AA@C@@AA@@A@@@@@A@@@@@A@ABABA@@DAB@B@BB@@B@@ABC@@BA@@@A@@@@B@@AB@@@@@@@B@@@@@B@@@BAB@B@@A@@@@@@A@A@@@@A@@B@BA@@@@A@@@@A@A@@A@@@A@@A@@BA@@BA@AA@AB@@@A@@@@BAB@@@@B@A@A@@@@@A@@BAB@@AAB@@A@A@@@AA@@@A@@B@@@D@BA@@@@@@AA@@A@@@AAAA@A@@@@@AB@B@@AA@@@@@@@@A@@@@@@@A@@@@@@@AA@@@@@B@@@@@BAB@@@B@B@B@B@B@@@B@BC@@@A@@@@@A@@B@AA@A@@@@@@@A@@BA@@@@@A@@@@AAABA@@A@@BA@A@AB@AA@A@A@@AA@@AAAA@AAA@@@CA@CCA@@@@A@@@A@@CAA@AA@@A@@@@@@AA@@@A@@AAA@@@@AA@@A@@@@AA@@@A@A@A@@AA@@@@AA@@AA@@@A@A@CCA@@A@AAA@@@@A@ABCBA@@BA@@@A@@@AB@@@BA@A@@@@@@@@A@@AA@@@A@@@AAAAA@AAAA@@A@@E@@@@A@@@A@@AA@@@A@@AA@AA@@@@AA@BACA@@A@@A@@AA@@AAB@A@A@@@A@@AA@@@AA@@A@@A@@@@A@@AA@@@A@AAA@AB@@@AA@@@C@@@AA@@@@A@AB@@BB@@BB@@@BA@A@C@@@E@A@@AAA@A@A@A@@AA@@AB@@A@ABA@CBAAAB@@A@A@A@@@@AA@@@A@@@AA@@@@@@AA@A@@@@@A@@@A@@A@A@@A@@@@A@@@@@@@AAA@@@A@@@@A@A@@@@@A@@AA@@@@@A@AA@AAA@@@@@A@@A@@@@A@@A@@@@AA@AA@A@@A@BAA@@@@CC@@AAC@A@AA@@AAAA@@A@A@@@A@@A@@@@@A@@A@@AA@@@A@@A@@A@AB@@@A@@AABA@@@@@A@@@A@@@A@@@A@@@@@A@A@@A@C@@@@AA@@@A@@AB@A@A@@@@AB@@@@@@A@@A@@AB@@A@A@@@@@A@@@@@@@@@A@@A@@@A@@A@@A@@AA@A@@@@@@@@A@@A@@A@@@@A@@@@@@A@@@@A@@A@@@A@A@@@@@A@@@@@A@@@@@A@@@A@AB@@@A@@AB@BAA@@A@AB@@ABABABE@@@@B@@C@@@AB@@A@@@A@@@@@A@@@A@@@C@@@A@@@A@@@@@@AAAAAA@@A@AC@A@@DC@@@A@@B@@@@@BA@@@@B@@AB@@A@A@@@@B@@@@A@@BAB@@A@@@@AA@@@@@@AA@@AA@@@@@A@@@A@@@AA@@AB@@A@@@A@@@@B@@@BA@B@A@@@@BA@A@@B@@A@@B@BA@@B@@@@A@@@@@@@A@@BAAA@A@@@@@AA@@A@@@A@@@@B@B@@AB@BA@@@@B@@@@A@@B@@@BADA@@B@B@D@@@DBBBFFBABA@@B@@ABA@@AC@@AA@@@A@@@@@@BA@@@@@@@@@A@@@A@@@@@@@C@@A@@A@@@@@A@A@@@AB@@@@@BA@@B@B@@@B@B@@A@@@ADAB@@B@@@@B@@@B@D@B@B@@B@BA@@@@B@B@B@@AD@BAD@B@@BC@@B@@@@@B@@@B@@@@@@@@@@@BA@@B@@A@@BA@@@@B@DA@AD@B@@CB@@@B@@AB@@@AAAA@@A@A@AAA@@@A@@AA@A@AAA@@@@@@AA@@AA@AA@@@AA@A@A@@BA@A@A@A@A@@@ABE@@@A@@E@A@A@A@@@A@AB@@@@A@A@@@A@A@C@@AC@@A@@BA@@B@@@@A@@@ABABAB@@AB@@AB@@BDA@@B@@@@@@@B@B@@@@AB@@AB@@@@@B@@@@AB@@@@@@@@@A@BAA@@@@@A@@@@@A@@@@@A@@@A@@@@@A@@AA@@@A@@AC@@AA@@A@AA@@@@@A@@@@B@B@@@@@B@@@B@@@B@@AB@BAB@@@BA@BB@@@BBB@@BBA@@@A@@@@BA@A@ABA@C@CB@@@BBD@@@@@B@@@@@B@@B@@@@B@@@@CA@@@@A@@AA@A@@AAA@@AA@AA@CBA@@@@@A@@@@@A@@@@@@@@AA@@@@@@@A@@@@@@AA@@@@BAA@@A@@AA@A@@@ABAB@BABA@@@@BAAA@@@A@ABA@@@@A@@A@A@@@@AAA@A@@@A@@A@@AB@ACBA@@@@AA@@@@@AA@@@@A@@@@@@@A@@A@@A@@@A@A@A@A@@A@AA@@@@AA@@@C@@AA@@@@@A@@A@@ABA@@AC@@@AB@@A@@B@@A@@@@@@@AA@AAA@@@AA@@@@@AA@AA@@@AA@@AA@@AAA@A@ABAB@@AB@@@BA@@@A@@AA@@@A@AA@@AA@@@A@@AA@@@@AAA@AA@AAA@A@AA@@AA@A@A@@@A@@@AAAA@@AAA@@AA@CA@@AAECC@ACAA@A@CA@@@@AB@B@@AB@@AB@BCBBB@BA@@@A@@@@@AA@B@@AA@@A@A@A@@@C@@@@@A@@@A@@@A@A@@B@B@D@B@B@B@@@@AB@@A@@@AB@@@B@D@B@@A@@@@@AA@@A@@@@@AB@@@BAB@@@B@@A@@@@@ADA@@B@@@B@@@@AB@@@@A@A@ABC@A@A@AAE@A@@BA@@@A@@@A@@@AA@@@@A@ABA@A@@@A@@@@ACBA@@BABB@AB@@@@@BAB@D@@@AA@@@A@@AA@A@AA@@A@@@A@@BAAA@@A@@A@@@@@@DA@A@@@A@@@A@A@@@@BA@@@@@A@@AACC@AAAAAA@@@A@A@@@A@B@BC@A@@AA@@@@A@A@A@@@AAA@@@@@A@@@A@@A@A@ABAB@@A@A@@@CBA@@B@D@D@@@BABAB@BA@@@A@A@@BA@A@@@@@E@E@@AAA@AAA@@@ABAB@@AB@@@@@@C@@ACB@@A@GAA@A@A@@@@A@@@AA@A@@@@A@@B@B@@A@@@A@A@C@C@A@AA@AAA@A@@A@@BCB@BCDAB@@ABA@@@AAA@@@A@@@A@@@AB@B@@@@A@@@A@A@@@A@@@A@AA@AA@@@@@AA@@AA@@@AA@@AAA@@@A@@@@A@@@@@@@AA@@@@@A@@A@@@@@AB@AA@AA@AA@@@@@B@@A@ABABABAB@@A@A@@@A@@@@@AA@@A@@AAA@A@AD@@@@@BA@@@C@GAA@@@@A@@ACAA@CAA@AAAA@@@@EDCDCDA@@B@B@@@@BBB@@B@BA@A@C@AAA@@@A@A@@@AAA@C@@@A@ABCB@@A@A@AA@@A@C@C@@AA@CCAA@AA@@AC@AA@A@@A@A@@@@@@@AA@@A@A@CB@@AB@B@@C@@@A@@B@@@BAB@@AB@B@BABADAD@@@@@B@B@BAD@BABABA@@@A@@@"],encodeOffsets:[[119626,27226]]}},{type:"Feature",id:"350481",properties:{name:"永安市",cp:[117.365052,25.941937],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BA@@@@B@B@@@B@@@BAA@BA@@@@@A@@B@@@B@B@@@B@@ABC@@B@@AB@F@@@@@@B@@ABB@@@@BB@B@BA@@B@@ADCDA@@@AB@@@B@BBB@@BBAB@@BB@@@D@BBD@D@B@B@@ABAB@DAB@@@ACB@BC@@BA@ABC@@@@B@B@@AB@@@BA@@@A@@@@AAAA@@A@@B@@AA@AA@@@CAAB@@A@@A@@A@@@AB@@A@@@A@@B@@AAAAA@@@C@@A@@CC@@AA@A@@A@@@AB@@A@A@A@C@@@@A@AA@A@A@@@@A@@@A@A@@@A@@@@BA@@@@A@A@AAGA@@CA@@@A@A@AA@@@C@A@A@ABCA@B@@AB@@@BA@@@@AA@AAAA@AAAA@AA@@BA@@AC@A@A@@@AA@@@@@@A@@BAB@BA@@BAB@BABA@@@@AAAA@@BC@@@AA@AC@@A@@AA@@@@B@@AB@@A@@BA@@@@A@@@@AA@A@@@@@@@@@@@@@A@BA@BCA@@@@A@A@@BC@@BABA@A@A@A@@@AA@@@@@@AB@D@@@@@AAA@A@AAAA@@@A@AA@A@@AA@@AA@@B@BABABA@A@@@AB@@@@@@A@AAA@AC@A@A@A@C@@BA@C@A@A@@@ABC@A@@@AA@@@@@A@@@@C@@@@A@A@@AAA@A@A@@@BA@@@A@@DAB@@@BA@@@A@@@A@@AA@@AA@AAC@@@A@@@@@@@A@@@@AAA@C@A@A@C@@A@@AAA@A@@@AA@@AA@@@AA@@A@@CAAA@@A@@@AA@@AB@B@B@B@DB@@B@@@B@B@@@B@B@BAB@@@B@@@@AA@@AA@@@@AA@A@@@A@@AA@C@@@A@@@@AAA@CAA@@A@A@AA@AA@A@@@AB@@@@ABA@A@A@@@@BAD@@A@@@@B@@@BA@@@@B@@@@AB@BAB@@@BA@@@@BABCB@B@BA@@@@@A@AB@@@@AB@@A@A@@@AA@C@@@AA@@AAA@AA@@A@@@@@A@C@@@A@AA@@A@@@A@A@@AA@@@@@AA@@@@B@BB@@@@B@B@B@@BB@B@@@B@B@BBB@@@@@@A@AAA@AACBA@ABAD@B@@AB@B@BB@@B@BAD@@@B@@BDBB@@@@@BB@@A@B@@B@ABBDABBB@@AB@@A@BB@@@BABA@AB@@CB@@ABAB@@BDB@@@B@@BA@@@@B@@@B@B@D@@@B@@@B@@@B@@AB@@@@@BA@@@@B@@@BA@@BB@@BAB@@A@@BA@@B@@A@B@A@BBA@@@@@A@@A@@@@A@A@A@A@@@@A@@@@@A@@C@@@A@@BA@@@AA@@A@@BA@@B@@@BB@@@@@BB@@AB@@@@AB@@AB@@A@@B@@@B@@@@ABA@@B@B@@@BA@@@AB@B@@A@A@@BA@@BA@AB@@CD@B@@A@@@AB@@@BAB@@@@@BA@AB@@@@@@@@@BA@AB@@BB@@@@@@@B@@@B@@A@@@@@B@BB@B@@A@@@AB@@@@A@@@@@AAA@A@@@@B@@@D@B@B@@@@@BAB@B@D@@ABA@@B@A@A@@A@@AAA@A@@C@@@A@A@A@A@@@@B@@@BB@@BBB@@B@@@@@@B@BA@@B@@@@@B@@AB@@AAA@@@A@@BA@@@@@@@A@A@@@@@A@A@@@@@A@A@AACAA@@@AC@A@@@ABBBB@@@@@AAAAAAA@AC@A@@@@AA@@@A@A@E@@@CAC@@@AAA@@@@@ABA@@B@B@@@B@@@@@@AB@B@@@@BB@D@@@BCB@@AD@@@@@BA@@BC@@@A@@BAD@@@BB@B@@B@@@@F@@@@AA@@@AA@@@A@@B@@@BB@@@BBA@@DC@@@@B@@@@@@B@D@B@BA@@@DB@@@@@B@@@@B@B@@BAB@@@AAAA@A@@@A@@@A@BB@BA@@@@B@@A@@@@@@@@B@@@@BBBB@@@@A@@B@@AA@@AB@AA@@@AA@@A@AB@@@B@BBB@@B@@@@B@@@@ABA@@@AB@@@AA@@@@@@@@@@AB@@@@@@@@@@A@@A@C@A@AA@@A@@@A@@@A@@@@@@@A@@BA@AB@@@@AA@@AB@@@@@@@BBBB@@B@BB@BB@@BA@@BB@@B@@B@@B@@@@@B@@B@B@@B@B@B@@B@@@@@@@DAB@@BD@@@@@B@@@@@B@@@B@@@B@B@B@B@BAB@B@@@BBB@@BB@@@@AB@@A@ABCBA@@@A@A@@@A@@@BB@@B@B@@B@@@B@B@@AB@@AB@@A@@AA@@B@@BD@@@BA@@@A@AB@@@@@BBB@@@@A@A@@@@@@AAA@@@ABA@@@@D@@@@@@A@@AA@@@A@AB@@@B@B@@@B@@A@@@@@@C@@A@@@C@@@@@@@@@@CBABA@@@@BABA@CB@B@@A@@BABA@CB@@@@@BA@@B@@@@BBBB@@B@B@DBB@@@D@B@B@B@B@@@@B@@@@ABC@@@AB@@@@@@B@BB@@B@@BBB@B@@@@B@@@ABB@@@B@B@BA@AB@BA@@@@B@@B@@AB@@@B@BAB@@@B@@AB@@A@@BBB@B@@AB@@AB@@AB@@AB@@@@@BABA@@BBD@BA@@B@B@@@@B@@BBA@BBABB@@BBB@BB@@BB@@@B@@@@A@@B@BBBA@@@@@AB@@@@AB@@@@ABE@A@@B@@ABB@@B@B@@@BB@@@@B@@@@@B@@@B@BAB@@B@@B@B@@B@BBB@F@DBB@@@@@@BAB@B@B@BAB@@@B@@@BGB@BABA@CA@@A@@B@@@@@BB@@B@@@B@@@@@@@@A@@@@B@@@@@@C@A@@@AB@@@@BB@@@@AB@B@@@BDB@@@B@@@@A@@@@@AAA@@@@@ABA@@BA@@@A@@@A@@@@@AB@BAB@@@B@@A@A@A@@@A@@@A@@@@BA@@BA@@@@@B@@B@@@@@@@BBB@@@AB@@AB@@@@@@B@@@B@@@@AB@@A@@B@@A@AD@@B@@B@@@B@@@B@@CBAB@@AA@@AB@@A@A@A@AA@@@BA@A@@B@@@@@@@@AB@@@@@B@@@@@@@BB@@@@BA@@B@@A@@@@@@@AB@@@@@@@B@@@@@B@@@B@@@B@@@BBBBD@@A@@BB@@B@B@@@@@B@@AB@@@D@BA@@B@BA@@B@B@@AB@@B@@B@@ABAB@BAB@@@BAB@BAB@DA@@B@@@@C@A@ABA@@@ABABA@ABA@A@@@A@@@ABAB@B@@@@@AA@E@@BA@AB@AAA@@@@A@@@A@@@@@A@AA@@A@A@@A@@@@BB@@BA@@@B@@@@@A@A@@B@@@@@@@B@@@@@@@@@@@B@@@B@B@B@@@@@AA@@A@AAC@@@@@B@@@A@@@DA@@BA@A@@@C@A@@@@A@@@A@@@@BAB@@AA@@@AA@@A@@A@@@A@@BA@A@@@C@A@A@@AA@@@@B@@AAAA@@@@CAC@@@AAA@@@@@A@@@@@AAAA@CAA@@@@@A@AE@A@A@@@A@@@@@ABA@@@AB@@ABC@A@A@A@A@@@A@@@@CAA@@BCBAA@@AC@@@@AAA@@A@@@A@@BADAB@B@BA@@BAB@@AB@@@B@B@@B@@BA@A@EBA@ABA@@B@@ABA@@B@@BF@@BBBB@B@@@BBBBDBDBBB@@B@@ADB@B@B@@BB@B@@@B@@@@@B@BBB@DBBBBB@@AD@B@D@D@B@D@B@@@BB@BBB@@BB@@B@@AB@@@BB@@@@B@@AB@@A@@@@BBD@@@B@@A@AB@@@BBDBB@@@D@B@@BDBF@B@@@B@@@B@@@@@F@B@B@B@@BBBB@@@BB@@B@@B@@@B@BBDD@DBBAB@@@@@@@B@B@@@B@@BB@BB@@B@B@@BB@@B@@A@@@@B@AB@@AB@@@@@B@@AB@@@@@B@@@@@@@B@@A@@@AD@BA@@B@@@@A@@B@@AB@@A@@@@@AB@BA@@B@@@@@@@@AB@@A@@@@B@B@@B@ABB@@B@@@BAB@@BB@BBB@@@BB@@@BB@BBB@@@BB@@@BB@@@BBADBD@BB@@B@@@BAB@B@@@BA@@@@B@BA@@@@B@@BB@@AB@B@@@B@@@DBB@@AB@@@B@@BD@DB@@B@@A@@@@B@@@B@BAB@@B@@@@D@@@B@@@B@@@@@BAB@B@BA@@@ABA@AB@@AB@B@BAB@BAHADA@AB@B@@@DBBB@@BB@@D@@@@B@@BB@@@BAB@BBBBFBF@DBBBB@@@@DA@@F@DBB@@@DA@@BABA@@AA@@B@@@B@B@BBA@ADAB@B@@BB@@B@@@@B@@BABBB@@AB@@@FBDDB@B@BAB@@ABA@@B@@B@@@@@@A@@B@@BB@@@@CBAB@B@@ABABA@@BBB@B@@@@@@ABB@@@@B@@@A@@BABA@A@@BA@@B@@AD@BABA@@BAFA@@B@@@B@@AB@@BB@AB@B@BAB@B@BB@BBHDB@@@B@@BA@@BA@@@CAC@@A@@ABABA@ABA@@@CAA@A@CAA@AA@@ABA@@@@BBBFD@B@@@BADA@@BAB@@A@AB@B@@A@AB@BC@@BA@@B@@@B@D@B@@@BA@@B@@@DAB@B@@BB@@BB@B@@@@B@B@B@@BB@BB@BB@ABAB@@@@@BB@@@B@BBB@BBBB@B@@C@I@A@C@A@@@@@BB@BB@BBB@D@D@D@@AB@@@@@B@@@B@B@@@@@@@@B@@@B@B@@@@@@@@DB@@B@B@@@AF@@@D@@A@@@A@@@AAA@A@A@@@CBA@A@ABA@EBAB@@A@@@@A@AA@A@@AABA@AA@@@B@@@@@DBBB@@BAB@B@@BBD@@BBB@@@B@@@BD@B@@@FAB@@AB@B@@@B@BBB@@B@@AB@BA@CBC@@B@@A@@@@@A@@B@@@@FD@B@@BBB@@@BB@BDD@BDABBB@B@BB@@@B@BA@@D@BA@CB@B@@@B@@BB@BB@AD@BBB@B@@B@@B@@@B@BAB@B@B@@@@AB@BA@@@@FAD@@@B@B@@@@@@BA@@B@@BB@@DB@DD@BB@@@@@BB@B@B@@@B@B@B@@@@B@BB@@B@@@BA@@B@BB@@B@D@B@BBFD@B@@BB@BA@@B@@@@BB@@@B@@@B@@@B@@@BBBBB@BB@B@B@@BB@@BB@D@@@BBBDDBB@DBB@B@D@D@D@BA@@B@@@B@BBB@B@@@B@B@@@B@DB@@BBB@B@D@B@F@B@BABAB@@@@AB@@@B@@AB@@AD@@@@A@A@@B@D@@@B@F@@B@@B@@A@BBBBB@@B@BBB@@@BBB@@@@B@BDDBB@BBB@@B@@B@@BBB@@@D@@@B@B@BAB@@@@@B@@@B@@@BBB@B@BB@@@BB@@@D@@BB@@@BB@@BB@@BB@@B@@@@@B@B@@A@A@@BA@@AA@@D@@@B@@@BB@@B@@AA@B@AA@A@@B@@@@@@A@@@@@@BA@@A@@@@@AA@@B@@A@@@@@A@@B@@@BB@@B@B@@BB@@@B@@@@@B@B@BB@@B@@B@@B@@@B@B@@@@@B@@@@@@B@@@@BB@@B@@@@@B@@@BB@AB@@B@@B@@@@@BBB@@@@BB@@@BBB@DB@@DBBB@@@@@BB@@@@@@@B@@@@@B@@B@@B@DBDB@@B@B@@@BB@@B@B@@B@@A@@B@B@@BB@@@@@B@B@@@@B@B@@B@@@@@BA@@B@B@@@@BB@@BA@@@B@@B@@AB@B@@BB@@@B@@@@A@@B@DA@@B@@@BBB@@@B@B@@@@AB@BA@@B@@@BB@@B@@B@@@@@@B@B@@AB@B@@BB@@@@@B@@@BD@@@BBAB@B@B@@B@@@@@@@@BAB@@@B@@@BB@BBB@@@@@ABA@@@@B@BA@@B@@@B@@BB@B@BBB@@@B@@B@@B@B@BB@@B@BAB@BA@@DBDBBAB@B@@A@B@@@@B@@@@@@@B@@B@@@@@@@@@B@@BAB@@AB@@@F@CA@AAA@AAA@@@A@@@A@@@@@AAA@@@AAA@@AC@A@A@A@@BA@@@A@AB@@@@CBAB@BA@A@@@@@@BB@BBFBD@B@@@B@@@FBB@@@@@@B@B@@@B@@@@@B@@B@@BBBB@@@@@BA@@@AB@@@BB@@@B@BA@@HB@@@BB@BBB@B@@@@@B@@@@@@@BAB@@@@B@@@B@B@BBB@@DB@@BB@D@@@BB@@@AD@B@@@B@B@@@B@@BB@@@BA@@@@B@B@@B@@@@B@@@B@@@D@BAB@@@@AB@@A@@@@B@BA@AB@@A@A@A@C@AAA@A@A@A@A@@@AB@@@@ABAB@@AB@@@@@BA@A@@@AB@B@@A@ABA@@@A@@@A@@@A@@BC@AB@@AB@@@DBBB@B@@@BABAB@@@B@@@B@@@B@B@@@@@B@@@BA@@@A@A@@AA@A@@BA@@B@@ADA@ADABAB@B@@@HB@@B@B@BBB@@B@DB@@@BD@@B@B@@@B@@@@@B@B@D@@@BCD@@AB@BB@A@@B@@A@@@@@@@A@@BA@A@@B@@@BAB@@@@@@B@D@@@B@@@B@@@@@B@B@@@@@B@@@D@@@BA@@B@@A@@@BBA@@@A@@@@B@B@@A@@@BBA@BBA@@B@BB@@@@@@@BBB@@AB@@@B@@@B@@@@@BA@@@BDAB@@@@A@ABAB@B@@A@@B@B@@@B@@@BA@@@A@@@AB@B@DCB@@@@@@@@A@AAA@AA@@AA@@@A@@@AA@AA@@A@@@@AA@@@AAAB@@@@@BB@@B@@BBBB@@BB@B@BA@@@A@@C@AA@@@A@@A@A@@@@@@@@AB@@@BAB@@@@@DAB@@@BA@@@A@@@A@@B@@A@ABCBA@@@@@A@@@@BA@A@A@@AAAA@AA@@A@@@@@AA@@A@@@A@@@@B@DA@A@@B@@@B@@@BA@@@@@A@@@A@@@C@@@AB@@AB@BAB@B@B@@@@A@AA@@@A@@AB@@A@@A@@@@AAA@A@@@A@@BBB@@@@A@@AA@@BB@@B@@@B@@A@AA@@@B@@@@A@@@@B@@B@@BAB@@@@A@@@@@@AB@@A@@A@@@A@@BA@@@AA@@@A@@@A@@B@@@B@@@B@@@B@BA@A@A@@@@@@@@A@ABABA@@@ABC@@@A@BB@B@@@@@B@@A@AB@@@B@BB@@B@B@@@B@BB@BB@@@@DBB@@AB@@@@DDB@@@B@@@@BB@@B@@B@@@@BBB@B@B@@B@@@@@BD@@@BBB@B@B@@@@B@D@BB@@B@B@B@B@@@@AAA@A@A@@@@A@@BBB@@A@@@@@@@@@A@@@@BA@@@@AB@BA@A@A@ABA@@@@D@@@@AAA@@@@@@@@BB@@@@B@@@@@@B@@B@@@@A@@B@@@@@@@@BABB@BB@@@@@@@@@ABA@@AAA@AA@AA@@BA@@@@@AC@@BC@A@A@@B@@@B@@@@@@A@@@AB@@@@@BADBB@B@@@B@@ADAF@DA@@@@DBBB@@@@B@@@BA@@B@B@B@BAB@DAB@@@BA@@B@BA@@BAB@B@@ABADA@A@AB@BA@@@@@@@@@B@BBBBB@@B@@@B@@ABA@@B@@AB@@@B@@B@@BB@@@@BBB@@BB@BB@@@ABAB@@AD@DCB@@A@@@A@@@@B@B@@@BABAB@B@@@@B@@@B@@B@@BB@@@@AB@B@@@@@B@@B@BBA@@B@B@B@B@@AB@BE@@BA@@B@@@@@BB@@@@@@B@@@@@B@@@@@B@BA@@@@@@@@@ABB@@B@@AB@@@@@B@@@@@B@B@@BB@@@B@@@@A@@@A@@@B@@@@A@@@@@@@@ABA@@@A@@CCA@@@BA@A@@B@B@@@@AB@@@B@B@@@@@@BBB@@@@DBD@BB@@B@BADA@AB@@AB@FA@@@@BC@@B@@@@A@A@@@A@AAABA@AD@BAB@@@B@BA@AB@@AD@@@BA@ABA@AA@AA@@@A@@@@@A@@@A@@@@@AB@@@B@@AB@@A@@B@@@@@@@B@@@@@D@B@BBB@@@B@D@B@FAB@BA@@BAB@@@FA@@B@BBB@D@@@BA@@B@@A@@@E@CAA@@AAAA@AAA@AAA@@@A@AA@@@AA@@BA@@AE@C@C@A@@B@B@@@B@BAB@B@@@@A@AB@B@@@B@@@@A@@@CB@@A@A@@@AA@@@A@A@@@AAC@@A@@@@B@BAB@@@DBB@B@@@B@@A@@@AB@@BB@B@BA@@@ABAB@B@B@B@@@B@D@B@@@@@AA@@AABA@AAA@C@AA@@A@@AA@@A@@A@A@AAABA@@BABABA@@B@B@@@BAB@@AB@@@B@@@BB@@B@@@B@B@@@@@B@@@@@B@@@B@@@B@BBDD@@@@@@@B@@@@@BBA@@B@B@@@B@@B@@@D@B@@@BBC@A@A@@BAB@@ABCBCB@@A@@@A@AAA@@@AB@DCFAB@B@@A@@@A@A@@@@BB@BBBDD@DDF@@BBDDB@BB@BDDBB@@DBBD@@@@@D@@@@@BB@@@B@@@JD@BDB@@@B@@AB@@@BABBB@@@@@@B@DAD@BBD@@A@@BA@@B@@@@@DB@@@@@B@@@BDF@@@B@@@DBBAD@DB@@B@@BB@@BB@@BBBBD@DBFBB@B@@ABAA@@ACK@@@A@A@@B@@@@@B@@@BB@DBD@BDD@AB@@@@@CA@AAA@A@@@A@AB@B@@@B@@@BA@@BC@@@A@@B@@B@@@BBDBB@@F@B@@BB@@AB@BA@A@A@A@@@A@@@@A@@@@@@@@ADCD@B@BA@@@@@@B@DBBBDB@@B@BBD@@@@@@B@@B@B@D@B@@AB@@@@A@AB@@A@@@@@A@@@A@@BA@@BA@@@@BA@@@@BAB@@A@@BA@@BB@@B@B@@AB@@A@@B@@BB@@@@AB@@A@@@@BA@@@@D@@@BBBA@@BA@BB@@@BB@@B@@ABADA@@@@@B@B@@BB@@B@@@BBBBB@@@BB@@DAB@B@B@BAB@B@F@B@FAB@@@@BB@@BAB@B@@@BBB@B@@A@@B@B@B@@@@B@BB@@F@FB@@B@@@AB@BEBA@@BA@@@@B@@BB@@@@@@@@B@BA@@DA@@B@@@@@BA@AB@@@@@BA@@@@B@B@B@@@B@BC@@@@@BB@@@@AB@B@B@B@@@B@BA@@B@BBB@@@BA@@@AB@@@B@AA@ABA@@AAA@@AAA@@@A@A@@@C@A@AAA@A@@@A@@@AA@@@@@A@A@@@A@@A@@@A@ABA@@@A@@AAA@@ABA@@@CBABABABA@@AA@@AAA@@@@ABA@@@@AAAB@@CBA@@@A@A@@A@B@@A@@@@A@@@@BADA@@BAA@@@@@@@@@@A@@B@@@BA@@DAB@B@@B@BB@@@B@B@B@BA@@BA@@B@@AB@BA@@@AB@@A@@@@B@B@BBB@@B@@@B@BB@B@B@D@@@@A@@B@HAB@BAB@B@FCHA@A@@@AAA@C@@A@AA@@@AAA@A@A@A@@BA@A@AB@@@AA@ABC@@@A@@@A@@@@@A@@BA@@@AAA@@AA@AA@@A@A@AA@CA@@ABA@AA@@@CAA@@@@AAA@@@A@AA@A@@A@@@A@@@AB@B@BA@@@@@@AA@@@@@ABABA@A@@A@BA@@@@@A@CAAA@@@A@@@AA@@@@@A@@@@@@A@@B@@@@AB@B@@A@A@@@@AAAA@@A@@B@@A@A@@@@AAAA@@@@@@@AD@B@@@@@@A@@@@@@AACAAA@@@C@ABA@@@@@A@ACA@AA@AAA@A@@A@AA@@@A@@A@@A@AAAAAA@AAAA@AA@@ACA@@@EAA@@@@A@A@@@@A@C@@@AA@@@AA@@@@@@@ABABBB@B@@ADC@AB@@@@@@AAAAA@@@AC@@@AA@A@AA@@@A@AAA@AAA@AC@@B@BA@ABA@@@A@@@C@@@AA@@@A@@@A@@@@A@@@CBE@AA@@ABC@@@@AC@@AA@@A@@@@AA@@@@AB@@@@A@@@@@AAA@@@A@AA@@@A@@@@A@@@@@@AAAA@@A@@A@AA@@@@AA@@A@@A@@@AAA@@@AA@AA@@@@A@@A@@@@A@@@@@@@AA@@@A@@@A@@AA@@@@@@AA@A@@@@A@@@A@@AAA@C@@@AA@@@@@A@A@AACAA@@A@@@A@@A@C@A@@A@@@ABABA@@@@@@@@C@AAA@@@AB@@A@@A@@@EBCBA@@BA@@BA@A@@BAFA@A@@BA@A@@@@AA@@A@@@@AA@@AA@A@@AAAACCAAAAA@AAAAA@@@AAA@@@@AA@@@@@C@@@@AA@AAA@AAA@A@@A@@AA@@@AA@@A@@A@@@AA@@@A@A@A@@@@A@@@AB@@@BABA@@A@AAC@@@A@A@@@@A@@A@@@AA@AA@@@@A@@@AAA@@AA@AA@@@@A@@@A@@@C@@@@AA@@A@@A@@@@@C@@@A@@@@@ABA@@@A@A@AA@@A@A@@@@@AB@@@BA@@AA@AB@@A@@@A@@A@@A@A@@B@@@B@B@@@B@@@@ABCBCBAB@@ADB@@B@ABB@@@@@B@@@@@@BBAB@@BB@@A@C@C@A@A@@@B@@BB@@@@@B@@@BB@B@@@@AB@@AD@@AB@@CB@@A@@@BBDBDDB@@@@@A@@B@@@@@B@B@@@@BB@@@B@@@@@BB@@@B@@@BB@BBBBB@B@BAD@D@@CAA@AAA@A@@@@@A@A@A@@@A@@@@A@@@AA@@@ABABA@@@A@AACE@A@@AB@@A@@@@@@BA@A@@@@@B@BFB@@B@B@@@@CBA@A@A@AAA@A@@AA@A@@@@B@@AD@@@@A@@@A@@@@AAC@@B@DC@@@@@AAA@ACC@@A@@@AA@AAA@A@A@A@A@A@AA@AG@@BA@A@A@@EB@@A@@@@@@D@@A@@B@@A@@ACAAA@@@A@A@@@A@@@@AAA@@@@B@B@@@BA@ABA@C@A@C@@@A@@A@@A@A@@@A@GC@@@@@A@A@A@A@AAA@A@@BA@@DB@AB@@@BA@@BABA@AAAA@AC@@A@AAA@A@@A@@B@@@BA@@BA@AB@DC@A@@@AA@AAACAC@AAAA@@A@@A@@@@BA@@@@A@@@AB@A@@@A@@@AAA@@@CAA@A@CBA@C@@BABAB@@@@AB@@AB@@A@@@@@@AA@@@@@AAA@@@A@@@@@A@@AAAA@AA@AA@A@@AA@@@AA@@A@@AB@A@@@AA@@A@A@@@@@@ABAA@@A@@@A@A@@@A@@@@@A@A@A@@@ABA@@A@@@@A@A@@@@@@@ADA@@@A@A@@B@@@B@@@BA@A@@@@@@B@B@@A@@BC@AB@B@@ADA@@@A@@@@@A@@@@@A@@@AAA@@@A@@@@@@AA@@@AAA@@@@@AB@@AA@BAAAB@A@B@@A@@@@AAA@@AA@A@@@A@@AA@@@A@A@@@CAC@@@AA@@@AA@@@@AA@@@@@@A@@@@@A@@AAA@@@BAB@@ABA@@BABA@@@A@A@@@A@A@@@A@A@@A@@A@@@A@@@@B@@@BA@@@A@@B@@@B@@@@@@@@A@@@A@@@A@A@@@@@AB@BA@A@@@A@@@A@@@A@@@A@@@A@@AA@@@@@A@@@@@@@@C@@@@AA@@@@@@A@@@@A@AAA@CC@@A@@@AA@@ABA@AA@@A@@B@@A@AB@@@D@@AB@B@@@B@@@@A@@BA@A@A@A@@@A@@BA@@@AAA@@@BB@@@B@@@@@@A@A@C@AA@@B@DAB@@@@@@@AA@@CAA@@@A@CAGCC@A@@@@@@@A@@@@B@@@@ABC@CAAA@A@@@ABC@@@@B@@@BA@@@@@@B@@@@@B@@@BB@@@B@@B@B@@@B@B@@@@@B@@AA@@AB@@@@@@@B@B@@@@@@@@A@@@@@@BA@B@@@@@@B@@A@@@@@@@B@@BA@@@A@@@@@@@C@AA@@@@A@@@ABA@@@@@AAA@B@@A@@AA@C@A@@@@@A@@@A@@AA@@@CAA@@@@@@A@@@A@@A@@@@A@@AAA@AA@@@@BA@@@A@A@@CC@A@@BC@@@@@@@B@@@@BB@@@@BB@@@A@@@A@C@@@ACAA@@ACAAACA@@E@@@AA@@AC@@CE@@@@@@BA@A@@B@BA@AB@@A@@@@@AA@A@@@AAA@AA@@CE@AAA@A@@@@DA@A@@@AB@B@@A@@@@@@AAAA@A@@A@@@A@AAA@@A@A@@CAA@@A@@@@@A@AA@A@A@@AA@A@E@AAA@AB@@ECAAA@A@A@A@A@ABA@@@A@@AAACA@AA@@@ABA@CAAAA@CAAA@@ABABA@@BAB@@AB@@A@CAA@@@AB@@AAA@A@@@@@BB@@BB@BH@BBBB@BB@@@D@DD@B@@BB@B@@A@@@CBABAB@@@B@@@BA@@@@B@@@@@B@@@B@@B@@BB@@BBB@@BA@@@@BB@@@B@@@B@B@@BB@B@BCDABA@AB@@@D@B@B@@@B@BB@@@@@@B@@@B@@@BA@@@A@@B@@@@A@@BBA@@B@@@B@@@@@BAB@@@BAB@@DAB@@ABBB@@A@@@@BAB@@BB@@B@B@@@@@B@@@@B@@AB@@@B@BA@A@ABAAA@@@@B@D@DA@@B@@@BBBAB@@@@BB@B@@BB@F@@AB@B@@@B@BAB@@@@@B@DAB@@AD@@@@@B@@@@@D@@BB@@@@A@@@A@A@AA@@A@@@AAA@A@@@@@BADC@@@@BABC@@@A@A@A@A@@CC@A@ABA@A@@@@@AA@A@A@@@@@@BA@@D@@@@AB@@A@@BABCBEBA@@BA@@@BDDD@B@B@@AB@BB@BB@@@@@B@@@@AB@@A@@@A@@@@@ABAB@@@@@B@@@@@BAB@BA@@@AB@B@@B@BBBBD@@BBBFDA@@@@B@B@DAH@B@BAB@@A@ABA@@@A@@BAB@B@@BB@@@B@DBF@BBB@@@B@B@BABABAB@@ABAB@@@@A@A@A@A@@@A@@@AB@@AB@@AA@@CA@AA@@@A@@@CBA@CBA@@@AB@@ABAB@@A@AAEA@@A@AAA@@A@@@AAA@A@@@A@A@@@AA@@@A@@AA@@@@A@AAE@@@@@ABC@A@A@@@@A@@@@A@@AABA@A@@@AB@BA@A@@@A@C@A@@@A@@@A@@BA@@@@@A@A@A@@A@@@@BCB@@CA@@@@A@CBABA@@AA@C@@@A@AA@@AAA@A@@A@@AE@@A@@@A@A@A@A@@BAAA@@A@@B@@A@@@@AA@CAC@@A@C@@@A@@@A@@@@@A@@@AA@@C@A@AAA@@BA@A@@@A@A@A@@@@A@@A@AA@@A@AB@@@BA@@BC@AB@@A@@@@B@@A@@@@@A@A@@A@@A@@@A@B@A@@A@@@@@@@@@A@@A@A@@A@@AAA@BAAA@@@AA@CCA@@@A@A@A@A@@@A@@@A@AB@AA@@ACBAA@A@@@A@AAA@C@@@@@A@@@A@@@A@A@@BA@@BA@@@@@AA@AA@@@@A@EAA@@@A@AC@@@A@@@A@@@@@@@A@@@A@@@@@@@AA@@A@@@@@@AAB@@E@A@A@AAA@@CAA@AA@@CAAA@@@@@@@A@A@@@@@@A@AAC@@@BA@@AA@@A@@@A@A@A@@@@AA@@AA@@@BAB@"],encodeOffsets:[[120059,26307]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
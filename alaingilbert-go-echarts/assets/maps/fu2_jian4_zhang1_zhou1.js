@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("漳州",{type:"FeatureCollection",features:[{type:"Feature",id:"350602",properties:{name:"芗城区",cp:[117.653968,24.510787],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@BBB@@BBB@@B@@B@@B@@BB@@@BBBBB@@@@B@@@@B@@@@@B@BB@@@BB@@B@@@B@@@@BB@@BB@@B@F@B@F@@@@AB@@BB@B@B@@@B@@AB@@@BA@@BA@@@@BAA@@@A@BA@@BA@AACAD@@A@@@A@ABAA@@@A@@DAB@@@DAAE@ABA@ABCBC@AAC@@BC@C@C@C@C@A@A@AACACAAAAAA@AAA@@@A@A@AB@B@@AB@BABABA@@B@B@B@B@D@B@HBD@@@BA@@@@@@BE@@BCBABADCFAB@HAFAB@B@@BB@BBD@BBB@DD@@BBB@BBD@BBB@DBB@B@BA@@BADCBA@@DEBCB@@A@A@@@A@@B@B@@@B@B@@@@@AA@@@@B@@@@A@BB@@@@BB@@A@@@@@@@@B@BA@@@@@BB@@@@@@@B@@@@A@@@@BE@@@@@A@A@@B@@@B@@BB@@@B@@A@@@ABAA@AA@AA@@A@A@@@@@ABA@@@@@A@A@ABA@@AA@@@@BA@A@@@@@@@ADCLAB@AAA@@@AA@@ECCA@@@AAA@A@@BA@@BEB@BA@AB@@@DE@@BAB@D@@AB@@@@AAA@@@@BE@A@@@A@@@ABC@@@@KGA@A@@@A@A@@@AA@@@@@@A@@@@AAB@@@BA@@BA@ABA@@@@@AAA@@@@@A@@BABA@@@@@AA@A@@ACAA@@A@A@@@C@@@@@AB@@A@@B@BA@@@@@AAAC@AAB@A@@@A@A@A@@AACC@BA@@@A@@@A@@@@A@@@A@@A@@@A@@B@@A@@BA@@@@@A@A@@IB@@A@GAA@A@@@A@@@@@@@A@@@@@@A@@A@@AAAC@AA@CCBAB@@C@@@@B@B@@@@@@@@A@@@@@@@ABB@A@@@@@@@@B@@@B@@@@AB@@AD@@@FCB@@B@@AA@@@B@B@@BA@B@@B@@B@@A@@A@@@@@@AB@@@B@@@@B@@@@BBA@@B@@@B@@@@@B@@@@@BABA@@@@@@@A@@@@@@B@@@@@@@@AB@@@@@@@BA@@@@@@@AA@@A@@B@AAB@@A@@@@@@@@@@@@AACABAAAB@@A@@@@@A@BAA@@@@@@A@@A@B@A@@@@@@@A@@@@@@AA@AAAAA@@AC@@BAA@@@AC@@@@A@@@AA@A@ACE@@@@@@AA@C@@@G@A@A@@AA@@@@GKGG@BA@ABADABCBCC@@A@@AC@A@@@BA@@BA@@@@@@@AA@@@B@A@@A@B@@@@AAB@A@BA@@@@AA@@@A@A@@A@@@A@@@@A@@A@@@@@CBBBA@@@@AA@@@CBAB@@@@@AA@A@A@@A@AA@@@A@BB@@@@@@@B@@A@@BA@@@@@A@@BB@@B@@@@@B@B@@@@@@@@@@A@@B@@B@@B@@AA@@@@C@A@@@@AA@A@@@@B@@A@A@@B@@@@@B@B@@@@BAB@@B@@@@@@@BB@@@@@B@@@@@D@@@@@@D@@@B@@B@@BB@@B@@B@@@@B@B@@A@@@A@C@EBGBCBAA@@A@A@@BA@@@ID@@@@EBCB@@A@@@A@ABCB@BCB@@A@BB@@@B@B@@@@@B@@AB@@A@A@ADABA@CDA@AB@@@@@@AB@D@@@BABAB@@A@@D@BABCDAB@@@@@B@@BB@@AD@@@@AB@B@@@B@@@@DD@@@B@@AF@DAFABAD@B@BAB@B@@ABIF@BABABAB@@ABABA@AD@@AB@BA@@BA@A@A@@BCB@@AB@@ABAB@@AAC@CA@@A@@AA@AAA@A@A@A@CA@@AA@@AC@@@@A@@@A@A@A@@@@@A@@A@AA@AAA@C@AA@AAAAA@AAA@@A@A@ACA@A@A@C@ABAB@@@BAB@@AB@B@@@B@@@@B@@@@B@@@@B@@BB@@@@@@B@@@D@B@@@B@@@@A@@@@DB@A@@@BB@@@B@@@@@B@@@@@BA@@BA@AB@@@@@@@B@@@B@@@@@@@@B@@@AHA@B@BD@@@@H@B@@@@@@@@AFB@@BC@A@@BBBA@@@@B@@C@@B@@AJB@@@@@@@@@@AB@@B@@@BB@@@@@B@@@@B@BB@@@@BB@@@BA@@@@BB@@@B@@BADAB@D@B@@@@@@@@ABA@@B@@@B@@A@@@@BA@ABA@A@A@@B@@A@@@@@@A@@A@@@@B@@A@@@A@A@@AB@@AA@A@A@A@AB@@@@@@@BAAAB@@@@A@@@@@@@A@@B@@@BAB@@A@@A@@@B@@@@@@@@@B@@A@@@@@A@AA@@@@@AB@A@@@@@@@AA@@A@@@@B@@A@@@@@@B@@@@B@@BB@@@A@@@A@@@AB@@A@@BB@A@ABA@@BB@@B@AA@AA@@A@A@AAA@AAA@@@@@@BBB@@@BBB@@@B@@E@C@@@@@AB@B@@AB@@@BAD@@@B@@A@@@A@@@E@@@A@@@@@AA@AA@AA@@@BAB@@@B@@ABB@@B@@B@@B@@BD@B@@@B@B@@AB@@@@@B@@B@B@@BBB@@B@@@BBB@@@@D@@A@@B@BAB@@@BB@@@BBAD@BA@@@@B@@@@B@@@@@BBBB@BA@@B@@BB@@@B@BBBB@@@@@BD@@BBBB@@B@@BB@@@BB@BBB@BB@@@B@B@DABAB@DAB@B@B@@AB@@@@@@@B@@@BBDA@@@BB@B@@@BB@@B@BB@@BA@@@@BAB@@@B@BBB@B@@@B@@@B@@@BAB@@AB@@@BA@@@A@@@ADC@@B@@@B@@BB@@@B@B@B@@@D@B@D@@B@@B@@@BA@@B@BA@@DAB@@B@@@@BBBBD@B@BBB@@DB@@@BBB@@B@@BBB@@@@BB@B@B@@@BB@@@DB@BB@@@B@B@@@BB@@B@@B@@@@@BB@@BB@@BB@@@@@@@@BB@@B@@@@@BB@@@BDB@@BB@@B@B@BB@@BBB@@@B@@@B@BB@B@@B@@@B@@BB@"],encodeOffsets:[[120425,25276]]}},{type:"Feature",id:"350603",properties:{name:"龙文区",cp:[117.709754,24.503113],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@EDCD@@CBAB@BA@ADA@CBA@A@C@EA@@CA@@@@A@CBABA@CBC@CBC@A@CCCAA@A@AAC@GCCAC@CAA@C@A@A@ABGDABAB@@B@HHHL@@@@BB@@@B@B@H@@@DBB@@@@@@DF@B@BBB@@B@@@@@BD@@B@AB@@BD@@BBBB@BBB@@@@@@@B@@@@@@@B@A@BB@@@@@@@BB@A@B@@@@@BA@BBABDBBB@@@@@@@@@@@@@BA@BBA@@@@BB@@B@@@@@@AB@@@@@@A@@B@@@@@@A@@@@@@@@B@@@@@@ABAB@@@@A@@@@@A@@@A@@@AB@A@@A@@@@@@A@@BA@@@@@@@BB@@@@AA@@@@AAB@@@A@A@@BB@@@AA@ED@@C@@BA@@B@@A@@@A@@@@@@@@@@BAA@B@@@@@@@B@@@@@@A@A@@@@@@DA@ABDDB@@BBDBB@@@BB@@@@@@@@B@@@@@@@B@@@BBB@H@BA@@JB@B@@@@@B@@AB@@@@AB@@@B@@@@B@@@B@@B@@@B@@@B@@ADDBB@@@B@B@B@@@BBA@BBDBB@@@@B@@A@AB@@@BA@@@@D@@@B@B@@@BBBD@@@BBB@@@@B@BA@AB@@@@@B@BB@@@@B@BAB@@AB@@A@@BA@B@@B@@@@@@@BB@@B@B@@@B@B@LH@@@@AD@B@@@B@@@BAF@@@@BB@B@@A@@BC@A@AB@@CF@@A@@BABA@AF@@AB@@@BBB@B@@DBFD@@BB@@B@BB@@B@FA@@@CBA@A@@@@@@@A@A@@@@AA@@@@@A@A@A@A@@@@B@B@BB@B@@@ADAB@B@BA@AB@@A@@B@@@B@@@@@BA@@@A@AB@B@B@@@@B@B@@@@@@B@@A@@@@@@ACB@@@@B@@@@D@@@@AB@@@@B@AB@@@BCBABBB@@@@BB@B@@@BA@@B@@AB@B@@ABBDADABA@BB@@A@@B@@@BB@AB@@ABA@@@A@A@@DA@@@A@AA@@@@@A@A@@@AAAA@@@AB@B@B@@@D@D@@ABB@BB@BB@@BBB@@@@@B@B@@@@@B@BABABA@@DBB@@BB@@@B@@@@@@@B@@A@@D@@@B@@@@@@@@AB@B@@AB@BA@@@@@@@BB@@@@@B@BBB@@@BA@@@@B@@B@@BA@@@@BB@A@@@BB@@@@BB@@AB@@@BB@@BA@@D@B@@@@@B@@BB@@@B@B@@A@CB@@@B@B@BA@A@@BC@ABA@A@@B@BB@@@AB@@A@@@A@@BA@@A@@@BC@A@A@@A@@AAAA@@@@AB@@A@A@AAA@@@@@AA@@@A@@A@AAAAA@AA@CAA@@AAB@@A@@BAAA@AA@@ABA@A@@@@AAA@A@A@@A@AA@@@@@AA@@@A@@@CAA@@BA@@A@@@A@@AA@@@AA@@A@A@@A@@A@A@A@AA@@A@BA@AB@@@@CAA@A@A@@@A@@@@AA@@@AA@@A@BA@A@@BA@@@@@@@@@A@@AA@@AA@@@@AA@A@@@ABA@@A@AA@AA@@@@@A@@@ABAAA@@@@A@A@@A@@@AA@@AC@@AA@@@@@@@@A@@@@@A@A@@BA@A@AA@@B@@@@A@@@@@@@A@@AA@@AB@@A@@ABA@@AAAA@AAA@@@@B@@AB@@A@@A@A@@@BABA@@@C@@AAACAA@@@@@A@@@@@CAA@@CA@@@@@A@@@AAABCC@A@@AA@@@AAC@@@A@A@A@A@C@@@ABCBAB"],encodeOffsets:[[120561,25069]]}},{type:"Feature",id:"350622",properties:{name:"云霄县",cp:[117.339573,23.957936],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@B@@@A@@@A@@BA@A@@@AA@@ABC@@@@@AB@@A@@@@@AD@@@@@@AB@B@@ABA@@BA@@B@@@@@@A@@B@@@@@@@@@@@@A@@@AB@BA@@B@B@@@@A@EAA@A@@AEC@A@AA@@@@@A@AB@@E@A@A@A@CAA@A@AB@@A@AAA@@BA@@B@@B@@BB@@B@@@@@@@B@@A@C@AB@@@B@@@BAB@@@@A@AA@@A@@B@@AA@@@@ABB@A@@@@@A@A@A@@@@@A@AA@@A@@@@@@A@@@@AAA@CBA@@@A@AAAACA@@@@@@A@@B@A@A@AA@@@@@A@ABA@@@@A@@@A@@@@@AA@AAA@@ABA@A@@@A@@@@@@@AA@@@@@A@@@@@@@@B@@@@A@@@@@@B@@@@AA@A@@BAAA@@@@CBCB@@@@@@A@@@@@@@ABA@@@@@A@@@@@A@@BA@@@@@@@A@@@@@@@@@CAA@@@AA@A@BCB@@@@AB@@ABA@C@@@A@A@@@A@@AA@@@AB@@A@A@@@@@@AA@ABA@@@ABA@@@AB@@A@A@@@A@@@A@@@@@@A@AA@@A@@AAAA@AAAA@A@AAA@@@@BABABCDABA@G@@@BBB@@D@B@@AB@B@@AB@@A@A@ABABA@@@@B@B@B@D@BAB@@@@ABA@A@A@C@@B@@@D@@@@@@BB@@@@A@@@ABAB@@A@A@A@@@@B@@AAA@@@A@@BA@@@@B@B@@@@A@ABABAB@@A@@B@@BB@D@@@@A@@BED@B@@@@DDDB@@B@B@@B@BA@AB@@@B@@ABA@@BA@@@AAA@@@@B@@@@A@A@A@@@ABC@@@@@@BABB@AB@@B@BB@@@@A@@@@D@@@@AB@@@@@BAB@BA@@BAB@@@B@@@@BBB@@@BB@@@@@B@@@@A@A@ABAB@@AAABA@@B@@AA@@A@@@A@A@@@@@A@@@@B@@@AA@@@@@AB@@A@@@A@@BA@@B@@AB@@C@@@@@@@@@B@@BB@@@DB@@@@@B@BA@@@ABC@AB@@@B@@B@@@@B@BA@ABEBA@CB@@A@@B@B@B@@@B@BB@BB@@A@@B@@@@@B@@A@A@@AAB@@@@@B@@@@ABA@@@@@@@@B@@@@AB@@A@BB@@ABA@AB@@@@AB@@@@@B@B@@@B@@A@@B@@C@@@@BA@@BA@AB@A@A@C@@A@A@CBC@@@@B@@@@@B@B@B@@@@B@@B@@@@@B@@B@B@@@@@F@B@A@BD@@@D@@@BB@@B@@@B@B@@B@@B@@B@B@@@B@@@BA@@B@@@@B@@AB@B@B@@@B@@BB@@BB@@AB@@AB@@@BAB@B@B@B@B@@@@@@@B@@@B@@@@A@@BA@C@A@A@C@@@@B@B@B@@BD@@@BA@EB@@@BB@JDDBBB@@B@@B@@@DAD@BB@@BBB@@A@AB@B@@@@@@CB@@@@@@AA@@@@@@A@AB@@A@@B@@ABA@@BA@@DA@ADA@A@@@A@@@@@AB@@@@@B@@@B@@B@@@B@B@@@BB@@B@@DB@@@B@@DBB@B@@@B@@@@@B@@@@A@@B@BAD@@@B@@@BADADAB@B@@@B@BAB@D@B@B@DA@@DABAB@@AB@@@DA@@B@@@@@@B@A@ABA@ABAB@@A@A@@@AB@BA@AB@@@B@@ABBB@B@@@@@B@@B@@@B@@A@B@@BB@@@@@@@AB@@@@A@@@@@@BB@@BA@@@@@AAC@@A@@@A@BAAA@@@A@A@@F@@@BA@ABB@@@@@@@B@@B@@@DB@B@@@B@@B@D@@@DB@@B@@@B@@@B@B@DD@@B@B@@@@B@B@B@@AD@BB@@B@@@B@@@B@B@@@B@@@B@@@BBB@@@B@B@@BB@@@@@B@BB@@@B@BB@@B@B@BABAB@BA@@BA@@@@@B@@@B@@@@@@@B@B@@@@@@@BBB@B@B@@@@BB@@B@@B@B@B@@@BB@@@BB@@BAB@@@BA@A@@B@@@B@B@@@BA@@DA@@B@@@BB@@@@A@@@@B@@@BB@@AB@@@@A@@BB@@@@@BBA@@B@@CBAB@@@@@@@B@@A@@B@@A@@@@@@B@@@@@@B@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@AA@@BA@@@@@@@@@@BA@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@B@@@@@@B@@BABC@@@@B@@@@A@@@@@@@@B@@@AA@@@AB@@A@@DB@@@@@@BAB@@@@@@AB@@@@A@A@@B@@@B@@@@@BA@AB@BA@BBAB@@@BA@A@@B@@AB@@A@CBA@@@@BBB@BD@@B@@@@@@B@@A@@@@@@@@@@B@@@@B@BC@@@@@@BBB@@@@@DAB@@@@BB@B@BB@@@@BADABAB@@A@@@@@AB@@@@@@@B@@@@@B@@@@BB@B@@@@@BA@@@A@@@@@A@@@@BA@@BB@@@@B@A@BABBB@@@B@@@B@@@@@@@@@@@@B@@@@DD@@B@@@@B@BABABB@@@@BD@B@@BB@B@B@@B@@B@@@@B@@B@B@@@BB@@@B@BB@@@ABA@@@AB@@@A@AA@@B@@@B@@A@@B@@A@@@@@A@@A@@@@@@@@@@@@A@@BB@@@@@@@@@@@@B@@@@@@@@B@@BA@A@AA@@@B@@B@@@@@B@@@@B@@A@@@B@BA@@@B@@@@B@@@@B@@@BA@@B@@B@@B@@@@@@@@@B@@@@@@A@@A@@@@CAA@@@A@@@@@BBB@BB@@@BB@@B@@@@@A@@@@@AB@@@@@@@B@@@@B@@@@@BA@@@B@BA@@@B@@@BA@@BBA@B@@@B@B@@@@@@@@BA@A@@@@@BB@AB@@BB@@@B@@@@B@@BAB@@B@@@B@@@B@@@B@@@@B@@@@@@BB@A@@@A@@@@B@@@B@B@@@@BB@@@@@B@BB@@@B@@AB@@@BB@B@@@@@B@BB@B@@@B@@@B@@@B@D@@@BA@@BB@BB@@ABAB@BBB@BA@A@@@B@@@A@@BABAA@@@@@B@@@B@@A@@@@@B@@@@@BA@@@@B@@B@FAD@BA@@BC@@@@B@DBB@@@B@B@@@B@@@@A@@@AB@@@@@BB@@BBD@B@@@B@@@@ABCD@DA@@@@@B@@@@BBB@@@BBD@@AB@B@B@@A@ABABABAB@@AB@@A@@B@BABCB@@@@AB@@@BABAB@BCBABAB@@AB@B@B@B@@@@A@A@@@AB@@@BBB@@@@@@@B@B@@@B@@AAAB@@AB@@@@ABAB@@A@@B@@A@@@@@@@@B@@@@@B@@@B@@@B@B@B@@DD@D@@A@@BA@@@@@@B@@@@DD@B@@@@@B@@@@BBB@BBB@B@@@BB@B@@BBB@FB@B@@B@@AB@@@@BA@@@BB@@@AB@@@@@@B@@@@B@B@@A@BB@@B@@AB@@@@BB@@@@@@B@@@@@D@@@@@BAA@B@@AB@@A@@BA@@@BB@@A@A@@@@@@B@@@BA@@@@@@@@@A@@@@B@@A@@B@B@@B@@B@B@@@@@@B@@B@@@B@@@@@D@DA@@BBBAB@@B@@AB@@B@@@@@B@@@BA@@@@B@@@@B@@@B@@@B@@@D@B@@@B@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@B@@@AB@@@@@B@@B@@@B@@AB@B@@@B@@@@B@@@@D@@@@AB@@@B@@@B@BBBBB@@@B@B@@@B@@@B@@@BB@@B@@BB@B@BB@BB@@B@@@@@BB@@B@@AB@@@@A@@@@BAAAB@@@@AB@@A@@BABAB@BAB@@@@@B@@@B@@@B@@A@@BA@@B@@@BA@A@@@A@@@A@@@@@A@@@@@AB@@@@@@A@@@@D@@@@@DAD@B@BBB@@@@@BC@A@@DA@@@A@@D@@@BA@@FB@@@@@C@@@@B@@@B@@@B@B@@A@@BA@@BA@@@A@@@AB@@@@@@@@A@@@@@A@A@@AA@@BA@@@A@AB@BABAB@BAFG@AB@BAB@BA@@@@@AB@BCB@@A@AB@FI@A@@@CBA@CDC@@AA@@@@@@AA@A@@@A@A@A@@@A@@@AA@@AA@BAA@@AAA@@@@AA@@A@@@@B@@A@@@@@A@@@A@@@@@A@A@@@A@@B@ACC@@@@BA@ABA@@@@@@@ABC@@@@@A@A@@@A@@@@@A@@D@@AB@@@B@@A@@@A@C@@A@A@@@@@AC@A@@@A@@@A@@@AAA@A@A@@@A@@@AB@@@@ABA@@@A@@@@@AB@@ABA@AB@DC@A@@BABA@AB@@CBC@A@CBA@A@@B@D@B@BBBB@@B@@@DB@@B@@@B@@BBB@@@@@BBBBB@@BBB@DB@BB@BBDB@@B@BABBB@B@B@D@B@D@DA@AB@BA@@BA@@@AB@BA@A@ABBBA@@@@@@B@@@@@BBB@@@@@B@B@@@B@@@BBB@@B@@B@B@@@@BB@@@BAB@B@BBB@@A@@B@@A@@@@@@BAD@@@B@BA@@BAB@@@@CB@B@@ABA@ABA@E@@@A@A@A@@BA@@@A@ABA@@@AB@@@DCBA@@B@@AB@@@BB@@BA@@@@B@@@@@BB@@BB@@B@BBB@BBBB@@B@@@B@BBB@B@@@DF@BB@@@DABA@@@C@A@A@A@A@@@A@@@@@AAAA@@A@@@A@A@@@@B@@@@@@@@@B@@@@@@@@@@A@@@@B@@@AA@@@@@@@@B@@@@@@@BB@@@@@@@@@@B@AA@@B@@B@@B@AAB@AA@@@@BA@@@@@@@@@@@@@@BA@@@@A@@@A@@BA@AA@@@BAA@@@@BA@@@@B@@@@@@@BA@@@@@@@@@A@@B@@A@@@@B@B@@@@@@@BADCB@@A@A@ABC@@@A@@BAB@@ABA@@@A@A@@@A@@@ABA@@BABA@C@@BA@@@@B@@@@A@@@@@@@A@@@@BA@@@@@A@@@@BEA@@@BAAAB@BC@A@@@@BAD@@@@AAA@@AAA@@@@@@@@AA@@@@A@@A@AA@@@AB@@A@A@@A@A@@A@@@@AAA@@@@A@A@@@@@A@@@AB@@ABA@@BA@A@@@A@A@@@@AA@@B@@A@@BAAC@AACAA@AB@@A@@@A@AB@@AB@@@AABA@@@A@@B@@AA@AA@@@@@A@@@AB@@@@@@@B@@@BAB@B@D@D@@@D@B@B@B@BAB@B@D@BBB@B@B@DDB@@@@@B@@AAA@A@ABA@C@@@@@AA@@@@@@BA@@@@AA@@A@@@A@@B@BCB@@AD@@AB@@A@@BADAB@@A@A@AA@@A@@A@C@EAC@A@@@@A@@BCBA@@@AA@@A@AA@@A@A@ABA@@@@B@B@@@B@@@@A@@A@AA@AA@@A@@@AC@AA@@@CA@@ABC@A@CAA@AA@@@@@A@@@A@AA@@AAACA@AA@@ACCIAA@AA@E@@AC@A@C@@@@@A@@@ABCA@BAB@@A@@A@@@@AA@AB@@@@@B@@@BABAEA@A@@@AAAA@BCAABA@ABA@AB@BAB@DA@@@@@A@@@A@@@@@A@@A@HEBADCDAD@HAZCTCHCDAZUBAVSJGVSDEPMDMAOAUAa@E@E@C@K@Y@KRiyN@@eFSAIAOGKEKCCAGCWICAKEMGEAA@GACBEFCBAD@@CFILEDIFA@GDEBIFCDEHCDC@G@@A@@C@A@AAAAA@CAGCEAEACCCFADAB@@AA@B@@@A@@AB@B@@A@@B@BA@@@@B@@@@A@@@@B@@@@AB@@@@@@@B@@A@@@@@@B@@@@@@A@@BA@@@AB@@@@CB@@@@@@A@@B@@@@A@@@A@@B@@A@@@@@AB@@@@@@@@@D@@@@@@@@AB@@A@@B@@@@@@@B@@B@@BA@@BBB@BB@@D@@@@@BBB@@@@@B@@@B@@B@@B@@@@@B@@@@@BB@@@A@@@@B@@@BBBB@@B@@@B@@A@@B@@AB@@B@BB@@@B@BB@@@AB@@@B@@BA@BA@@@@@D@@@@@AB@@@B@@@@B@@B@@@@@@@BA@@@@B@@@@@B@@@@@BA@@@@@@@@BA@@@@B@@@@@@@@@BA@@@A@@@@@@@@@@@@@@@@@@BA@@A@@ABB@@B@B@B@@@@@@BB@@B@B@BBB@@@BBB@@BBB@@BBB@@@@DBB@D@B@@"],encodeOffsets:[[120183,24420]]}},{type:"Feature",id:"350623",properties:{name:"漳浦县",cp:[117.613808,24.117102],childNum:16},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@B@@@@@B@@@@@@A@@@@@@@@A@@@@@@B@@@@A@@@"],["@@B@B@@@@@@A@@@@A@@@@@A@@@@B"],["@@@@@@@@B@@@B@@A@@@@AA@@@BAB"],["@@@B@@@@@@B@@@B@@@@A@@@@@@@@@@A@@A@@A@@@@B@@@@"],["@@@@A@@@@@@@@B@@@@A@@@@@AB@@@@@@@@B@@@@B@@B@@A@@@@@AB@@@@@@A"],["@@@B@@A@@B@B@@B@@@@@@A@@@@@@@@@AB@@@@@@@@@@@@A@@@@A@@@@@@@A@B@"],["@@BB@@B@@@@@@AAA@A@@@@@@A@@@@@@@@B@@@@@B"],["@@A@@@@@@A@@@@@@A@@@@@@B@@A@@@@@@@@B@@B@@@@@@@@@@@@@@@@BB@@@@@@@@@@@B@@@@BB@@@@@@@@@@A@@@@@@AA@@@A@@"],["@@@D@@B@@@B@B@BA@@@A@A@@@@AAA@A@@@@@A@@B@B"],["@@@@AB@@@@@B@@@@B@@@@@@@B@@@@BB@@@@AB@BA@@@@B@@@@@@@B@@@@AAA@@@@@A@@A@@@BAA@@@@@A@AB@@@@A@@BA@@@@B@@"],["@@DBBBB@B@@@BBB@B@@BB@@AB@@@@A@@@@AA@AA@A@@@AAA@A@@@A@@@@AC@@@A@@B@B@@"],["@@@@B@@A@@A@@A@@@@@AB@@@@AAA@@A@A@AB@@@@ABA@@@A@@@A@@@@B@@@@A@@B@@@B@@@@B@@@B@@@@@B@@A@@@@BB@A@@B@@@@BB@@@D@"],["@@A@@BA@@@A@@BA@@@@@C@@@@@@B@@@@@B@@@B@@@@@B@@@@B@@@@AB@@@@@B@BA@@BB@@BB@@@@B@@@@@@A@@@@@@@AB@@A@@@A@A@@BA@B@A@@B@BA@A@@@A@@@@@AA@@@A@AB@@A@@@A@@B@@@B@@@B@@"],["@@@B@B@DAB@@@D@B@B@B@B@BBBB@@@B@@ABCBA@ABA@CA@@A@A@@B@BA@@@AAA@@@@@AB@B@@@@@BAA@AC@@CA@@CDABAB@B"],["@@ABABAD@B@B@@@BBABA@@B@@@@@@B@@B@@@@AB@@@BBB@@A@AB@B@BBB@B@BA@BBA@@@@BA@@B@@@B@BA@AAA@@@AB@@A@@@@@AA@@BA@A@AB@@AB@@A@@BAA@A@@@AA@@AA@AAA@A@A@A@@B@B@@@B@@AB"],["@@EIMSEM@@CISqIOMGK@I@UJOH@zE\\EVCHQj@L@Z@L@D@F@FBbBVBPCNONCFUTIHUTABYVCBGDSDYDGBC@CBCDABGFB@@@@B@@@@@B@@@B@@@@CBA@ABA@@BAB@BABBBADB@BB@B@@@BFBABAB@@A@@@@@A@@BBB@@@@@BB@@@BA@ADBBA@@B@@@@@D@B@D@@BF@B@@BBBDJBD@@BBB@BDBB@@BBB@@@B@@@@@B@@BBB@D@BAD@BB@@D@@BBD@@B@@@BB@@BBBB@@@@B@@A@@@A@A@@@@@AB@B@B@BB@@B@BB@@B@@ABAD@@@B@@B@D@FBD@B@@@@BB@@B@B@BA@CBAB@@@BA@@BC@@BA@ADA@@@@B@@@BB@@B@@B@@A@@@@B@@B@@@@@DAB@B@BBB@BA@@@@@A@CCA@A@A@AAC@A@A@ABA@A@A@C@@@C@C@A@A@AB@@A@@@@@@@A@@B@@@B@@@@BBB@@BA@@@@B@@ABBB@@A@@BA@@B@B@@@BA@@BBBBD@BBDAB@@@BA@@@BB@@@@@B@B@@@BAB@@AB@BA@@B@@@B@@@@@B@B@@B@BB@@@@@BB@B@@@@B@BA@@B@@BBB@@@@B@@B@@B@@@@@@B@BB@@BB@B@@C@AB@@@@@BADA@BBAB@@B@AF@@@@@B@@@@AB@@@@@B@@@@@@@B@@A@@@@@AB@@@DABAB@@AB@B@@@B@@@B@B@@AB@BA@AB@@@B@@AD@B@B@BA@CDAB@@@@@@A@A@@@@@@BA@@@@B@@@@@@@@AB@@@@@@A@@@@@AB@@@@BB@A@@BBB@@AB@@@B@@@@@AB@@@@@@@@@@@@@@AB@@@@BBA@BBA@@@@AA@@@BBA@@@@@@@@@@@AA@@@@@@A@@@@@@@@@BB@@A@@@@@@B@@@@@@@@A@@@@@@@@@A@@@@@@B@B@@@BB@BB@B@@@@@B@@@B@B@B@B@D@@@@D@BBB@@B@@@B@@@B@@@@@BA@@@CDCDA@@B@@@B@@AB@@@B@@@B@BAB@@B@@BDB@@@B@BAB@DAB@B@BA@@@@B@BA@@B@@@B@@@B@@B@AB@@AFB@B@@@@@@B@@@@@BAB@@@@B@@@@B@@@B@@B@@B@BAB@B@@@B@@@@@@AB@@@@@@@B@@@B@B@B@B@B@@@B@B@@A@@BBBD@@@AB@B@@BB@@@B@@B@@DABBBA@@B@B@BB@@B@B@BAB@B@BABA@@@@BBBADAB@BAD@B@@@@@BA@@@AB@@@B@D@@@@BB@@ABA@@B@@@@@B@@@@A@@B@@@@@BA@@BBBABA@BB@@@@@@@B@B@@@B@@@@A@@B@@@@@B@@B@@BBB@@@@@@BB@@@@BB@@@@@BA@A@AB@B@@@@AB@B@@@B@@@B@@@BA@@@A@@B@BA@@BA@@@AA@B@@A@@@@B@@@@@B@B@@AB@@@@@B@B@@@B@@AB@@BB@@@@ABABAB@@A@@@ABAD@@@B@B@BA@ABA@@@@B@BBD@@@B@@AB@B@BB@@@B@@BB@@@BB@@@@B@@AD@DB@A@@@@@@DD@@@AB@@@@ABA@@BA@@BB@@FBB@@@ABB@@AB@@AB@BA@@@@BBB@B@BAB@@@@B@@@B@@@@@@D@BA@@B@B@@@B@@@BB@@@D@@@@@BA@A@@@A@ABA@@@@@@B@@A@@B@@@B@@A@@BA@@@@BBD@BAB@@A@@@AB@BA@@BB@@@AB@@@B@BB@@@AB@@@B@@AB@B@@@@AB@@@B@B@B@@@@@@BB@BB@@BBB@@@B@@@BAB@@@BAB@@AB@@@@AB@@@B@D@@@@B@@AB@BAB@@@@@B@BAB@@@B@BAB@@ABA@@B@@@@@BA@@B@B@B@@@B@BB@D@@@@BA@CBA@@BA@@@A@@@BBB@@@@@B@@B@B@BA@@B@BAB@FA@@@AB@@@B@@B@B@@B@@@@@@@@@B@B@B@@@B@@@@D@@@@@@@BBDB@@BB@@B@BB@BA@BB@@@BD@@@B@@BB@@@@@BBBAB@@@B@@@@BBBB@@@@BBBBBBB@@@BB@@BB@@ABB@@@BBB@BBB@FAB@B@@AB@B@B@B@@B@@BBB@@@B@@B@@B@BBCB@@@BBBBB@@@@@B@B@@B@B@B@@@@@@@B@@ABB@@B@@@@@@B@@B@@@AB@@A@@@BB@@@B@B@@A@@B@@B@@@@@@BB@B@@@@@BB@@AB@B@@@B@@@BA@@B@@@B@B@@@B@@@@@B@AAB@@@B@@B@@@@@@BA@@B@@@B@@@@AB@B@@@B@@@@@B@@AB@@AB@@B@@B@B@B@@@@@BB@BBB@B@B@B@@@BAB@@@@A@@B@@AB@B@@@@@BA@B@@@@B@B@@ABB@@BAB@@@BA@@BA@@B@BAB@@A@@BB@@B@@B@BAB@B@@BB@@B@B@@@B@B@B@B@@@@A@@@@AA@A@@@@@@BC@A@@B@@C@A@@@AB@BA@@@A@@@@BAA@@AA@@AB@@A@@A@AAAA@@C@B@@@@@@@@B@@ADBB@@AB@@@BA@@AA@@ABA@@A@@A@@@@@@@A@@@@@A@@@A@@@A@@@A@@@AB@@@BAB@@AB@@@@A@@B@@A@A@@@A@@@ABBB@@BB@@B@@B@@B@@BBB@@AB@@@@A@@@A@@@@@@@@@A@@B@@@B@@@@AB@@@B@@@@@B@F@BB@BB@B@B@@B@B@BA@@@BB@@ABB@@B@B@BB@@BA@BBBABB@@@AB@@A@@@A@@@@BA@AB@@@BA@@B@BABCBA@@@A@@@@@A@@@A@@@@BAB@@@B@@@B@D@DB@@BAB@@@@@@A@A@@B@B@B@@BB@@B@@@B@@B@@@BA@@B@@BB@AB@B@@BB@@@@D@BB@@B@@A@@@@B@@BBA@@D@BA@@B@B@@A@AAABA@@B@@@@AA@@@@A@@@@@A@@B@@@@AA@@@@AB@@@B@@@@ADABC@A@@AEAEA@@C@@AA@@A@A@A@@@EAAA@A@AA@@@A@@@BA@CBABA@AA@@A@A@@@@AAA@@AA@@@@AAA@C@@AA@@A@@@@@@AB@@A@@@ACA@@A@@@@@BC@@BA@@@A@A@@BA@@B@@AB@@@@A@@B@@A@A@@@A@@@@B@@AB@B@BBB@B@@@BA@@@@B@B@@@BAB@@BBA@@@@BA@@AA@@B@BA@@AAB@@A@@@@@@DABA@@BBB@@BB@B@BB@@BAB@DB@@@B@BDDDB@B@@BB@@@@B@B@@@BA@@@AB@@@@A@@@@B@BB@BB@DB@@@@@B@@@B@@B@@B@@BB@@@BB@BB@@BB@B@BAD@B@@BBBB@B@@DD@@BBA@BB@B@B@B@@B@@B@@@B@@@B@@B@@B@@@B@@BBBB@B@B@BB@@BDB@BD@B@@B@@DD@@@BBB@@@B@B@@@B@F@B@BBB@BBBBB@@@@B@BB@@@B@@@@@@B@BB@@@B@@@@@B@@@B@B@BAB@BB@@BBB@DB@@@B@@@@B@@B@D@@@@@BB@@@BB@@@@@@B@B@B@@B@@B@BAB@B@@BBD@@BBB@@BBB@B@@@@BA@ABAB@@BD@B@B@@B@@@B@@BBB@BB@B@@BB@@@B@D@@@BB@@@B@@@B@@@B@D@B@BBB@B@@@B@BB@@BB@BB@@@A@@@@@A@@@A@@@A@AB@@AB@@AB@@B@@B@@BB@@@@A@@A@@@AAAAA@@@@A@@@@@C@@@@B@@@@A@@@A@@B@@AA@@@D@D@B@DD@@B@@@@@@C@C@C@C@A@@@AB@@CBABC@EBA@@DEBGBA@@@AA@@@@A@@BA@@@@B@BB@@B@@AB@@@@A@@BA@@B@DBB@B@B@B@B@@@@BBD@B@@@@@B@@B@@BBBBBD@@@B@B@@@BB@B@@@B@B@B@@BBA@@@A@@@@@AA@@@@@@AB@DA@A@A@@BA@@BABADAB@B@BA@@@A@A@CDA@@@@B@BB@AB@B@B@BA@@BA@@B@@@@B@@@@@@BB@@@@@@BB@@@@BB@@B@@B@@@@B@B@B@@@B@B@@BBB@@@B@@@@BBBBB@@B@BADCBABA@@B@B@@@@C@@@A@@@@B@@B@@B@B@BAB@DC@@@A@@@A@@B@@A@@@@@C@@CG@@B@@A@A@@@ABA@ABCBABA@A@@BAA@@@@@@A@@BAB@BB@@@BB@BBD@@B@@@B@BB@B@B@DAB@B@D@DA@@@@BC@ABA@@B@@@B@@B@BBBAB@BDB@B@BBB@B@@D@@@B@@B@@@BA@BBB@@@@@B@BADAB@DA@@BA@@DABAF@B@B@DAB@B@B@B@DAB@D@BAB@@A@@@A@A@A@@FA@@B@BA@@BA@@B@B@B@BAB@B@B@BB@@@@@B@BB@@@B@@@B@@A@@B@@@B@@AB@@@DA@@@AB@BAB@@@B@BBBB@@D@D@@@BB@BB@@@B@B@B@B@BABA@@DAB@B@@@B@@@B@BA@@@@BABA@@B@B@BA@@@@B@B@BAB@@ABCBA@@@@A@@@@@@A@@@@AA@@A@@@@@ABAA@@A@@@A@@@@@A@@B@@@@C@@@@B@@@@@BAAAB@@@@@@AB@@@@AB@@@@A@@@AB@@AB@@A@@@@@A@@@C@A@@@@@@@@A@@@@@@@@AA@@@@AA@@BA@@D@@@@@@@@A@@@@@A@@BCBA@@B@@@B@@BBB@@BAB@B@DABA@@@A@@@AB@BAB@@A@@@@@@@AB@@@@@@A@@@@@A@@@@A@A@@@@@@@@AA@@@BABB@A@@A@@A@@@@B@@A@A@@@@BA@@@@@@@A@@BA@C@A@@CABA@AB@B@B@@A@@@A@C@AB@BA@@@@@A@A@@AA@A@@B@@@@B@@BA@A@@@@BAB@@@BA@A@A@@AA@@@ABA@@B@B@B@@@@A@@DAB@BABAB@@@B@BA@B@@BBBB@@A@@@@@AB@@B@@BB@@B@@@BB@@@@@DAB@B@@ABAB@B@@@@@@@@@AD@@@B@@HC@@B@B@FABA@@BBB@B@BB@@BBB@@B@D@@@@B@@@@AB@@@@BB@B@BABAB@@@@@BA@AB@@AB@@AB@@A@@BA@A@ABABB@B@@B@B@@AB@@@@AB@@@@BB@@@B@@@B@@@B@B@@@B@@@BB@@BBB@BB@@B@B@B@F@B@B@BBF@@@BA@@@@B@@@B@B@@@B@@B@B@@@@@@@D@BBD@BB@@@@BA@@B@@@B@@@BB@@@@BB@@@DB@@@@@B@@AB@@@@@@AB@@@@@@@D@@@@@B@@@B@@A@@@AB@@AA@@@@@B@@@B@@CB@@A@ABAB@BAB@@@BBD@B@@B@BB@ABBB@@@BBB@@@B@@BB@@A@@@@@@B@@BA@@@BB@@B@@@@@AB@@B@DA@@B@BB@@@B@@@B@@@B@@B@@BB@BB@@@@DB@DB@@@BA@@@AB@@AB@B@@A@@A@@A@@@@@@@@B@@@@@B@BC@@@A@@@@@@@@@A@@AAB@@AB@@A@@@@AA@@@@@AB@BA@@@@@A@@A@@A@@@AB@@A@BDB@@@@@@@AB@@AB@@@@@@D@B@@B@B@@B@@@@B@@A@@@@@AAA@@AA@@AA@@@@@A@@B@@@@A@@B@B@B@@A@@@@A@@A@@AAB@B@@@@@BB@@@A@@@@@@@@@B@@@@B@B@B@DBB@@B@@B@BBB@@@B@B@@BB@B@@@@B@@B@@@@@BABADA@AB@B@@@@AB@@@@@BA@@BA@@BBBB@@B@@@B@BB@@D@@@@@BAB@@AB@@@@A@CBAA@@AA@@A@@BCBABA@@D@@@BBBBBB@@BBB@@@B@@@@@B@BBB@@@@BB@B@BA@@@@BAB@B@@B@@B@@@BBB@@AB@@@B@B@@AB@B@BABBF@B@B@B@BADADABA@@BA@A@@@AA@@@AB@@@@C@@@@@@@AA@C@@@@B@@@@A@@@A@AAEAE@@@@B@@BB@B@@AB@@A@@@@AAACAA@@@A@C@@@AEC@AA@@@BA@A@@DA@AB@B@@A@@@ADABA@@@A@A@E@CAC@A@@AAA@A@AAA@@A@@@A@A@A@AAE@A@AI@ABEA@A@@@GB@DBBDJ@@A@@@@@@BAB@B@DAB@BAD@@@@A@A@@B@BAJWICMCKAM@EI]]AC@C@W@I@GIKIGSIKGUOAAWSIGUWIKEI@AACCW@C@KB@DUBSCMEKKQEOIIUKSCY@Y@ICIMMU[UQOKMGEQQEMCGCKCMKcIMQKSKMABgDI@YAKEKCCCIGIQGOIS@CEUEcAMBG@@DGDEFEBCBC@EAG@ACEIGUUGGCG"]],encodeOffsets:[[[120694,24484]],[[120498,24353]],[[120559,24424]],[[120562,24394]],[[120641,24511]],[[120570,24387]],[[120543,24340]],[[120560,24355]],[[120535,24416]],[[120521,24355]],[[120585,24385]],[[120557,24362]],[[120514,24350]],[[120494,24362]],[[120537,24344]],[[120461,24335]]]}},{type:"Feature",id:"350624",properties:{name:"诏安县",cp:[117.175184,23.711579],childNum:1},geometry:{type:"Polygon",coordinates:["@@CmAcA@bA@@@F@F@F@@BDAB@BAB@BA@ABABCBA@@@AAA@E@CBAA@@A@C@AA@BABABABGB@@EAABEBEDEFCFCD@BCD@@AB@@@@AA@@CAAAA@@@AB@BA@@@A@@@AAA@A@C@A@@@CA@AECEAAA@@AB@D@BAB@B@BADEFCJAFA@A@C@CBEAE@E@C@CBABABAB@@A@@@CAC@ABABADADCBADEFCHAD@DABCD@@A@A@A@A@@@AAAAA@A@CBABE@CBEDAFAD@D@D@F@BBDABABAB@B@B@BBB@B@@@B@BABAB@BAD@B@BBBBB@BAB@B@B@B@B@BBB@B@BAB@BAF@B@R@DCJ@BA@ADCB@BAB@B@DAD@BAD@DAD@B@DADCDAB@DAB@B@BAB@@ABABEDCDEDCDCBAD@@@B@@@BBBADAD@B@B@BDDFDDDDD@BAB@B@BAB@BADAL@D@DABADADABAB@B@DAB@@ABA@ABAB@@@B@B@B@BA@EBEBIBCBABEDA@EBC@EACACCAEQ@OFEFADCFBBDDDBF@F@NACFBFBH@BFD@BABIBAB@DBBBDCHA@ABGBIDCBCB@BABBDBBBD@@@HAFCBAB@BCB@DBDHBFAJBJFBB@@DDBDBD@D@B@DDBBD@BBD@D@DAFADBBBBBDBFEHGHAB@BCD@F@BB@@D@D@BADBD@FBB@B@DDDDDBBBBBFEFCDCBABCBC@C@A@AAAAAAAAAAA@A@@@A@@@CBABA@ADAB@B@B@B@D@BBBBB@D@@@B@B@BCBABABABAB@@AB@@ABABAB@@A@A@A@@@ABABAB@B@@@B@B@@BBB@BBD@@@B@B@B@@BB@@BBB@BBD@B@D@@@DAB@B@D@@B@@@BB@@BB@B@BA@@@@@A@A@@BA@AAA@A@A@CAAAC@A@A@AB@@@BAD@B@BADA@@BABA@ABAB@BA@@B@DBB@B@DAB@D@D@@@DAD@B@F@BBD@B@D@BAB@B@BAB@BAB@D@BBB@B@B@@BBBB@B@@@@BB@BBBBJDPBD@D@BB@BB@BB@B@BB@BB@@B@B@@BB@@B@B@B@@@B@BB@@B@@@BB@BBBBDBDBBBBBBB@BBBBBB@@BDDB@BB@BBBBD@D@@@D@D@B@@@B@BB@BBD@BBBBBBBBBBBBBD@B@DBB@@@BBBBBB@BBB@B@@AA@AB@@@BA@@@AB@B@@AB@@A@@BA@A@@@@@A@AB@@A@@B@@@D@B@B@@@B@@@AABA@A@@@@A@B@@ABCB@BABAB@B@@@B@BBB@@@@@B@@@BABE@@BAB@BAF@B@@@@@@@@A@@@@@@@@@A@AB@@@@CBA@@@A@A@A@@@@B@@BBB@@@@BA@@@@B@@@BAB@B@BAB@@@@AA@@@AA@@BA@@B@@A@@@@BA@A@@B@@@@A@@@A@@@@BAB@@C@@AA@@@@@@@@A@@@AA@A@ABA@@@CB@@A@@@@@@B@BBDB@@B@@@BA@@D@BABABA@A@AB@B@@ABABABAB@@@B@B@BAB@@@BCB@@@@@@AB@BBBAD@B@@AB@FA@@B@BAB@@AB@DAD@@@@AB@BA@@@@@@B@B@@@B@@@B@@AB@@CB@@ABA@@BA@@BCBC@EBA@@B@BAB@@@B@@ABCBA@@B@@@@A@@B@@@B@@A@BD@B@B@B@@@B@BA@@@BBDBA@@@AA@@@BA@@AA@@@A@A@A@@@@@@B@@AB@@AB@@A@@@@@@@@@A@@B@BAD@@@@AB@@A@@B@B@@A@@B@@A@@@C@@AA@A@@BC@AB@@A@@@@@AB@@ABA@@@@BA@@@@BABA@@@@@E@@@@BA@@@@@A@AB@@@B@@ABA@CBABC@A@@B@@@B@B@@@@@BAB@@@@AB@BA@@@@@@@@D@@@B@B@B@@A@@@AB@B@@@@ABBB@@@B@BB@A@@@ABABCBC@A@@@A@@BC@A@AB@@@@@@A@@@@@A@@@AAA@CA@@@A@@CA@@@AA@@A@A@@@A@@@@A@@@A@@@@BA@@@@B@@@B@B@BCB@@CB@@AB@BA@@@AB@@@BAB@@@@@@@BB@@@@@@DA@@@@@@@ABAB@@@AA@AA@@ABC@C@@@AA@@@AACAICA@@A@@FAB@@A@@AC@@@A@A@A@@D@B@B@D@B@@AB@@@@@@A@@@A@@@@@@@A@A@A@ABA@A@@BA@@BA@@AA@@AA@@@A@@@A@ABA@@@A@@A@@@AB@@A@@@A@A@@@@AA@@@@A@A@@@AA@@A@@@C@@ACB@A@E@@@@@A@A@@@@A@@@@@AA@@@@@@A@A@A@@@@@A@@D@DAB@B@@@@D@B@BBAB@@AB@@A@@D@@@@AB@@@@A@@@A@A@@@@BA@@@@BAB@BA@@AAB@@@BA@@@@@A@@@@@@B@BA@@@@@A@@@@BA@BB@B@@@@A@@@@@AB@@@AAA@@A@A@@@A@A@AB@@@DAB@FABAB@@A@A@@A@@@@A@@BAD@BA@@B@@A@A@@@@CA@@A@@AA@@@@@@@@@D@@@BA@@@AB@@AB@@@B@@@BA@@@@B@@B@@@A@@B@@@@@B@B@@@B@@@BB@@@AB@BABB@@BABAB@B@@@@@@A@@@@AA@@A@AA@@@@@A@@BA@AB@@ABA@A@@@@BA@@@@@C@@B@@@@@AAA@@@BAA@BA@A@@@@D@BA@@B@B@B@@@@@@A@@B@BB@@B@@AB@BA@@@A@@BAB@@A@AA@A@@@CACC@@@@@AFC@AB@@@@@@CAA@@@AB@@@BABABAB@@@@@@A@A@@B@@AB@@@B@BB@@@A@@B@B@B@@@BABA@@B@@@@@AA@@@@@@@C@@@AD@B@B@B@BA@@@@BA@A@C@A@A@A@@B@BABAB@B@@@BA@@@ABA@@@A@CA@AA@@H@B@BADCBABA@A@@B@BBB@B@BB@BBBBB@@@BB@@B@B@@@@B@@@B@@@B@B@@@BA@@B@BA@@B@BAB@@B@@@@B@B@@@BA@@B@@BB@@@B@B@@@D@B@BA@@BA@@@@DA@A@BBB@@B@DB@@@@@@@@B@@@@@@@B@@AB@@@@@B@@@@@B@BA@@@@@@B@@@@@@@DADA@@@@BBAB@@@BBB@@@@@A@@@@B@@@@@@A@@@@@@B@@@@@B@@B@@@@@@@B@@@BAB@BB@BBB@@B@@@@@B@@@B@@B@BAB@@@@@B@@B@B@B@AB@@@@@@@DBBBBBB@@@B@DAB@BB@@@@@B@@@@B@@@BBB@@@@@B@B@B@@@@@B@A@BA@@@@BB@@@AB@@@BBB@@@@@BA@A@@@A@@BAD@B@@@@A@@@@@@@AA@@AA@@@@AB@@AB@BBB@@@BAB@B@DBB@B@B@F@@@BAB@@@@@B@@B@BFD@BB@B@FBB@@@@@@A@AB@@ABA@@B@@@@@@@@@@@@@@AB@@@@@@@@AB@@AB@BA@@@ABA@@@@@@BC@@@@B@@@BA@@@@D@BA@@BB@@B@B@@AB@@@B@@@A@@@@A@@@A@CAA@C@@A@AA@@AA@AA@AA@@A@AAA@A@@@AA@@@@@@@A@A@AA@BA@@@BB@@A@@@@@@@@@@@@@@@@B@@@B@@A@@@@@@@@@A@@B@@A@@@@@@B@@A@@@@@A@@@@@A@@B@@A@@@@@@@AA@@@@@@A@@BA@@@@C@@@@@B@@AAB@@@A@@BA@@A@@A@A@@AAA@@@BA@@@AB@@@@A@@@AA@AA@A@@@A@@B@@@A@@A@@@@@A@@@@@AA@@@@A@@@A@@@@AA@A@@@@@CA@@AAA@AB@@AA@@@@A@@@@@@@AB@@@BA@@@@@@@@@C@@@@@@@@BA@@@@B@@@@AB@@@B@@@@@@AB@@@@@@@DA@@@@BA@@B@@AB@@@@@@@@A@@@@B@@@@A@@@@@@BA@@@@@A@@B@@@@@@A@@B@@A@AB@@@@ABA@@@B@@@ABB@@BABCDEA@EEAA@@CAAA@CCACGACAA@@KKA@CAOKACEQACIUAEKWEKEUIcAMCIGeCKKmIAOE]CJALWEIAGAI@"],encodeOffsets:[[120081,24132]]}},{type:"Feature",id:"350626",properties:{name:"东山县",cp:[117.430061,23.701262],childNum:7},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@BA@@AA@@@@A@@B@@@B@@B@"],["@@@BB@@@B@B@@@@@@A@@@@A@@@@A@@C@@@@@@B@@"],["@@@BBB@@B@@@B@@A@A@@@@A@@A@@A@@@A@@B@@"],["@@@@B@@@@@B@@A@@@@B@@@@A@A@@@@@@@@@A@@@@@AA@@BA@A@@BA@@BA@BB@@@@@B@@@@B@"],["@@DA@@@@BAB@BA@@@A@A@AA@@@@@A@@@@A@@A@@@@@@B@@@B@@@BB@@B@@A@@B@@A@A@@B@@@B@@@@"],["@@B@BB@B@@@@B@BBB@@BB@@@B@@A@@@A@@@AA@@@@@A@@AAA@@A@A@@@@@AA@@AA@@@@AB@@BD"],["@@AKBIBKJGFGBI@KACIKCCEEGCOCM@OBIAWKoSICM@G@IDIBI@OA_G_CM@QBGHGJ@BF^BPJLnDLHfDJBNJdFVFLLXBFJVBDFRBDPLDBB@LL@@BBBDDHDB@DBBDB@@BBFFB@DDFBFBHDDBB@BBBBB@D@@@@BH@D@DCFGDCJEFAHCB@JEFCJKDE@@BCDAFEDAHBB@FBNHLFDBXJHDDBLDLFPHJBTBfE@@zMDGFUF[@yEDaDODEAA@CAA@A@CACAQMA@AAC@A@S@G@@@E@A@AACGACIOAEEO@I@ABCHAFADCFO@G@ICGECGEKAUAS@EACAGIEI@K"]],encodeOffsets:[[[120494,24116]],[[120517,24095]],[[120243,24135]],[[120293,24163]],[[120278,24145]],[[120239,24127]],[[120274,24190]]]}},{type:"Feature",id:"350625",properties:{name:"长泰县",cp:[117.759153,24.625449],childNum:1},geometry:{type:"Polygon",coordinates:["@@BABABA@@@A@A@@@@B@@@@@B@@@@@@A@@AA@@@@@@@@FAB@BA@B@@BBB@BB@@B@@@B@@@B@@@@@BBA@B@B@B@B@@B@@A@BB@@@A@@D@B@@@BAB@@@@@B@@A@@@@B@B@@@@A@AB@@AAA@@@@AC@@@@ACBCA@@@@C@@@AAA@@@@BC@A@AA@@@@CA@BABCBA@A@@@AAA@A@@@C@AA@@@A@@@A@@A@ABA@@@ABAAABAAABA@A@@@A@@@A@@@A@@@A@@@AB@@A@@@@@AB@BA@A@@@A@AA@@@@AA@BA@@@A@@@@@@@A@@A@@@@@@A@@AABA@@@A@@@@@A@@AABAAC@A@@@A@@A@@@@A@@@AA@@A@@@AA@@A@AA@A@@A@@@@@@@AA@@@@A@@@@BAA@@A@@@AB@@A@@@@A@@@AA@@AAA@A@@@A@@AA@@@A@A@A@@@AA@@@@BABAB@@@@A@A@@@@AA@A@@@A@@@CA@@A@AB@CAAA@@@@BA@A@A@@AA@@@A@@A@@A@@@@A@@@@A@@@A@@@A@@@A@@@AA@@A@@BA@C@@@@@@BA@@A@@A@A@@B@@@@A@ABA@ABA@A@@@AB@@A@@@A@@@A@@@@@ABAA@@@B@@AB@@A@@@A@A@@@C@@@A@AB@@AB@@@@@BA@@B@@A@@@@@AB@@@B@@@@@B@B@@A@@BA@@@A@@AA@@B@AA@A@@@A@@@A@@@A@@@@@@@@@A@@BA@@@AB@@AB@@@@AB@@A@@@A@ABA@@BA@A@@@@@A@@@@@ABA@@@@BA@@@A@@BA@ABA@@@A@C@A@A@@@ABA@A@@@@AA@@@AAA@@@@AA@AA@@@@A@@BA@@@@@@BA@@@@BABA@A@@@@@A@@AAA@@A@@@@AAA@@@@AA@AA@@A@A@@@A@@A@@CA@A@A@@@E@AAAA@@AA@A@@@C@A@@@@AA@@AA@@@CCA@B@A@@CA@@A@@@AA@@A@@A@@@CAA@A@@@AAA@AA@@A@AA@AAA@@AA@A@@A@@A@@A@@A@@AC@CAA@A@A@A@AA@AAAA@@@@@AA@@A@A@@AA@@@@A@A@A@A@@@A@@@A@@@@@@@A@A@@@AA@@ABA@@@@@@AA@@@AB@@A@A@A@@@A@@@@@A@A@@@A@AAA@@@@B@@AB@@A@A@A@@@@@A@@@A@@@@@@A@@@@@AA@@@@@@A@@@@@A@@@A@A@@@ABABA@AA@@@AABC@A@A@@@@@ABAA@@@@ACA@@@AAA@A@A@@@@A@@@@@@@AAA@@@A@AAC@@@@AAAA@@AA@@@@@A@@B@@@BA@@@A@@@A@@@@@A@@@@@A@@@AA@@A@@@A@A@@@@@@@A@@AA@@A@@@@B@@A@A@@@@@@A@@A@AA@B@@A@@A@B@AA@@AC@@@C@@@@@@@AAA@@@A@@AA@@@@@@@AA@@@AAA@@@@@A@@BA@@AE@A@@@A@A@@@A@@@A@A@AAC@@@A@@@@B@BAB@B@@A@@B@@@BA@AB@@A@@AA@@@@A@@@@@@AA@@C@@@@A@@A@@@A@@@A@AC@@@A@@A@@AA@AA@@A@A@C@A@C@AA@@@AB@@@C@@@@AA@A@@@@A@AA@A@A@AA@BA@@B@@AAA@@@@BAB@BAD@@@BABA@A@@@A@@D@BA@A@@@A@@AA@@@@@A@C@@@AB@@AA@@A@@BA@@A@@A@@A@@@BAA@@@@AB@@@AA@@@@@AB@@A@AAA@@@@@A@@A@@@@@@ABA@@BA@A@@B@@@@@@A@@@C@@@@BA@@@@@@@A@@@A@@AA@CA@@ABABABA@@@@@A@A@@@@@A@AA@@AAA@@AAA@BC@C@@@A@A@A@@B@@BBBB@@B@B@@@@@B@@B@B@@CB@@@B@B@@AB@BA@@BAA@@A@@@@BA@@AABCBCBAA@BA@A@@BA@@@AB@@A@A@@A@@A@AAABAD@@A@@B@A@@A@@B@@C@@@@@@A@@A@BD@@@@@@@BA@@@@@@@@A@A@@A@A@A@@B@B@@AB@@@@A@@@A@@@@BA@@BABA@A@CB@B@@@AAAA@A@@@@@@B@B@B@B@@@@BB@@@@@B@B@@@@@@@BAB@D@@EBA@@@A@KBCD@B@@@@@@@BAB@@@@BB@@AB@B@B@B@@@@AB@B@@@@@B@BB@@BBBB@AB@B@@@BA@@@A@@AA@@@A@@@@B@B@@@@AF@@@@@B@@@BB@B@@B@B@@@D@@@@B@DA@@DA@@@BB@@BB@@@B@@@BB@BB@@B@BA@AB@@@B@B@BA@@B@@A@AA@@@@A@@@AD@B@@A@A@@@@@@@@B@@@B@BA@@@@BA@@@A@@B@@AB@@@B@@@@@@AD@@AB@@@B@@@BBB@B@@@@BC@@BA@ABA@@@@DDBBB@A@@@BBB@B@B@B@@@BB@B@@@BA@AB@@A@@@@B@@@B@B@B@@@B@@@D@@@B@B@B@@@BA@@@@@AAA@A@@@A@@@@A@A@@@@AB@@@@AAA@AAA@@@@@@@A@@@EA@@@C@A@@ACBA@@@@A@AA@@@AA@@@@AAB@@@@@B@D@@@D@@@B@@A@AB@B@@@@@B@@ABAB@@@B@@BDB@@BAB@@B@@B@@B@@B@@B@@@B@@@B@@@@BA@A@@@@@@B@@B@@B@@@B@@@@@B@@@B@@@B@B@@@@BB@@@@@B@@@@AB@@AB@@@B@@@B@@@BA@AD@@@@A@@@@AA@@@A@@@CAA@AB@A@@@A@@@A@@@@A@A@@A@@@@@A@@@@@@A@@@@@@@AB@BABA@@@@@A@AC@AAA@ABAAA@@A@A@A@@@@@@BAB@@@A@BA@@@@@A@@@A@@D@B@@A@@@CB@B@B@D@@BB@@@B@@@@@BB@@@B@@@B@@BBD@B@@D@@B@B@@B@B@B@B@@BB@@@B@BAB@BABBB@FDB@@@@B@@@BB@B@D@@@BB@@B@@FAB@@ABAB@@AB@@EBAB@@C@@@A@@@A@B@@B@@@BCB@D@@@B@@@B@@AB@BAB@@A@@B@@BB@BBD@@@@@B@@@BA@@B@@A@A@AB@@@AA@A@@AA@@@@@A@A@A@@@C@@AA@A@A@C@A@@AA@A@@@CBAB@@@@AD@B@@ABA@ABCB@@CB@@A@A@@B@@AB@BA@@@@BA@@@@B@@@B@@@@@B@BA@@B@B@@@B@BA@AB@@AB@@A@A@A@@@A@@BA@A@@@@B@BB@@@AB@@@B@@AB@B@@A@@@AD@B@BABA@AB@@@@@B@B@BCB@@A@@B@BA@AB@@@BADA@@@@BA@@@A@@@@@AB@@@BA@@BA@@@A@@@A@@BA@@@@B@B@B@@B@@BB@@BBBB@@BB@B@@@@B@@@BB@@@@B@@AB@B@@@B@@BBB@@@@BB@@@@BBBB@@@@BBB@BB@B@@@@@B@B@@B@@@@A@AB@BAB@@@B@@A@ABA@@@A@@@A@@@@B@@ABA@AB@B@@A@@B@@AB@@@@@BAA@@AB@B@@AB@@AA@@AA@@A@@BAB@B@BADA@CB@BA@ABCB@@@@@B@@BB@BB@@@B@@@B@@@@BB@@@@B@@@B@@B@@@B@BBBA@@@BB@@@B@@@BBB@BA@@B@@B@@BBBD@@@B@@@B@@AB@@BBB@D@B@FB@@@@@@A@BB@@@@@B@BB@@@DB@@@@C@@@@B@@@@B@@B@@@@@B@@AB@@B@@B@B@B@D@@DD@@@B@BA@AB@B@@@B@@A@@BABA@AB@B@@@@BB@@@@@D@@@B@@D@@@DB@@B@@@D@@@B@B@@AB@@@@@@ABA@@@A@@@@B@B@B@@@@A@@@@B@@A@@B@B@@@BA@A@@BABBB@B@@@B@DBB@@@B@@B@@BA@@@AD@B@@A@@BA@A@@BAD@B@BBB@B@B@B@@@BA@@BBB@D@@@B@@A@@B@@@B@@B@A@A@@@A@@BA@@@@AA@@@@B@@A@@@@B@AA@@@@@AB@@@@@B@BBB@B@@@@AB@@@@@BA@@@@@@B@@@@BB@@@@@@@@B@@BBBB@@B@@@B@BB@@DBBB@@BAB@B@BABB@@@BBBBB@@@B@@@@@BB@B@@@@@@@D@@@B@B@@@D@B@BBB@B@B@@BB@@B@@B@@@@B@@@B@B@@@@@B@@BB@@@@B@@@@@@@@@@@B@@@@@B@@B@A@@@@B@@@@@@@@@@BBA@@B@BA@@@B@@@@@@AD@BA@@B@@A@@@@@@B@B@@@BB@@B@@B@B@@B@@B@@@BB@@@@BBB@@B@@BB@@D@FB@@@@@A@ABA@A@@BADC@@@BB@@BB@@B@@BB@@@@BB@D@@@BB@@@@BBABB@@BAB@@A@@B@@AA@@@B@@A@@D@@@@@@@B@AB@@@BB@BBBB@@BA@A@@@@@A@@@AAA@@@A@@@ADBB@B@DA@A@@@A@@@A@@B@@@B@@A@@@@B@BAB@B@@ABA@@B@@@@@BA@@BB@@BB@@@@B@@BB@@B@BB@B@@@B@@@@A@A@@B@@A@A@AB@@@@AAA@@BA@A@A@@@@@ABA@A@@@@B@@@@@@A@@BA@@@@@@A@@@@@A@@A@@A@@@A@@@@@@AB@@@@A@AB@@@@A@@@@BCBBB@@@B@BA@AB@B@@A@@@@@@@AB@BA@@@A@@@@B@@B@@B@@@@B@B@@@@@@B@@B@@@B@@@@A@AB@@@@B@B@BB@@B@@@B@@B@@B@@B@@@@@@BBB@@B@@B@@B@@B@@@B@@@AB@@BB@@@BBB@@@B@@@B@B@@@@B@@BB@@@B@@@@@BA@A@@@@BA@@@@B@@@BA@@@@@A@@@@@C@A@@@A@ABAB@@@@A@@@A@@@@@A@@D@@@B@B@@@B@@A@@B@@@B@@@B@@@@@@@B@@@@A@@@@B@@@@@B@@@@BB@@@@BBB@@B@@@@@@B@@BB@BD@@@@@@@BBBAB@@@@B@@@B@@@@@DAB@B@@AB@@B@@B@@@@BB@@BA@@B@BAB@@@@@@B@@@@@@B@@@@B@@B@@@@B@@@B@@@@A@@BBB@@A@A@@BA@@BA@BBA@@BA@@BA@B@@B@@@B@@BBAB@@B@B@@BB@@@B@@@BBB@@BBB@@BBB@@@FAB@@@B@@@@@@AB@B@@AB@BABABADA@@FAB@@@BA@@B@@@@@B@B@B@B@B@@B@B@@BB@@@@A@@B@@BDB@B@B@B@@@BAB@@@@@BB@@@@@@BBB@B@BADA@CBABA@@BB@@@@@@BA@@@BB@@B@B@D@D@@@BBB@@B@D@B@@B@@@@@B@BB@B@@@@B@@BBBD@@@BA@AD@B@@@@@B@@@@@@B@@@@B@@B@@A@@BA@@@A@@DA@ADA@AB@BAB@@@@@B@@B@AB@@@@@D@@A@@@A@A@@@@CA@C@AB@@ADABABA@A@@@@@@BA@A@@B@B@@ABAB@@A@A@AD@B@@@B@BA@@@@@@B@@ABB@@BA@@B@@B@@B@@BB@B@B@@@B@@CBA@@BAB@@@B@@@B@@@@AB@@A@@BA@@B@BB@@BA@@@@B@@@@@@AB@@@@@B@@A@@B@@A@@@AB@@@BAB@@AB@@@@EB@@ABABA@A"],encodeOffsets:[[120767,25447]]}},{type:"Feature",id:"350627",properties:{name:"南靖县",cp:[117.35732,24.514654],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@@A@AA@@@@A@@@@@A@@@A@@@A@@@A@A@@CA@@@A@A@@BA@A@@@@BA@C@@@@@@@A@A@@AAAA@AA@@@C@@@@AA@@AA@@@@A@@AA@@A@@AA@@@@@@A@@@A@AA@@@@AAA@@A@@@AA@AAA@@AA@A@@@@AAACAA@@@@A@CA@@AA@@@@A@@@@@@@A@A@A@@AA@AAA@@@@@AB@@@@@A@@CC@@@@@@ABC@A@C@ABEBCB@@ADEDA@@D@B@D@@AAC@@AAAC@@@@@A@@AB@@AA@A@C@A@C@AA@@AA@@@A@@@A@A@@@@A@CAA@CAA@@BA@@@AB@B@@ABA@@@ADCB@B@BAB@@CA@@AB@@@B@@ABA@@@@BA@@B@@@BABA@@@@@A@A@@B@@@@@B@@@B@@A@@@C@@B@@@@AB@B@BBFA@@@@@@@A@@B@@@@AB@@@BA@@B@@AB@@@@@@A@@B@@A@@@AB@@@B@B@@@@AB@@@@@BA@@@@@AB@BA@@@A@AA@@A@@@@@A@A@A@A@@BCA@@AA@@@A@@A@@A@A@@@A@@A@@AA@@A@@@AA@@@@@@@A@A@A@@@A@@B@@A@@BA@AB@@@@A@@@AB@@ABA@@AA@@@@@@@A@@@A@@@@@@@AB@@@@A@@BA@@@A@@@A@@@@@AB@@A@@@A@@@A@@@A@@B@@A@@B@@@@@@@B@@@@BB@@@@@B@@@@BB@@@@@@@B@@@@BB@@ABAB@B@@@@@BA@@@@B@BA@@B@@@B@@@B@@AB@BA@@@A@@@@BA@@@@BA@@@@BCB@@A@@@A@@@@@A@A@@@A@@@@@A@@B@@CB@BA@CB@B@@C@ABABA@@@A@AAA@IAA@@@A@C@@@A@@@A@GBABA@AB@@@B@B@B@@AB@@A@@@@@C@@@@A@@A@@@@A@@@A@@AA@@AAAAA@A@@@E@@@A@AAC@@@C@AA@@@@AA@A@@@AAACAAC@ACE@@@A@@@@@@A@@@A@A@CBA@@@A@ABA@C@A@@@@A@CAA@A@A@AA@@C@@@C@@BA@@@@AA@@B@AA@AA@@@A@AAA@A@CCA@AA@A@@AA@@ABA@CBC@AB@@ADAB@B@D@@A@A@C@A@@BA@@@@@A@@@@A@@CAA@A@@@@@@@AA@@A@A@A@AB@@AAAAA@@AAA@@@AAAE@ECCAAAC@CA@@A@A@A@@BA@AB@BA@A@A@@@A@@@A@@@A@A@@BA@@@@B@@BB@@BFABBB@BB@@B@@@B@BABBBBD@B@@A@ABA@@@A@@B@B@@@BA@CBA@@@A@A@A@A@@@@AA@@@C@@@@BA@BB@B@@@@B@@@B@@@@@@B@B@BBB@@@B@@@@A@A@@BA@@BB@@B@B@B@@@@AB@@A@@@A@@B@@AD@@@BA@ABC@CBA@ABAB@@@BADA@@@@@@BA@@@@B@@@@@@A@CB@@A@@@A@@@AB@@A@@B@B@@@B@BA@@@@@@B@B@B@B@@@@@BA@ABC@A@@@@@@@A@@@A@@@@BAAA@CA@@A@@@@B@@A@@@@BA@@@@@ABAB@@A@A@@BA@@B@@A@@@AB@@A@@B@@@@BBBB@B@BB@@D@@@B@@@@@BAD@@@@AB@@@@@B@@A@@@@@CB@@A@@@A@@B@B@BA@ABA@AB@@A@@@A@A@@@A@@B@@AD@BAB@@C@@BA@A@C@E@@@AB@BAB@@A@EB@@AB@@A@A@CBEDA@@@@@C@@@C@A@@@A@@@@@A@AFAB@B@B@@A@AB@@ABA@@@@BC@@@A@AAA@@B@@@@@BA@@@A@@@@@@@A@@@@BA@@@AB@@@@ABE@@@AB@BA@@@@BAB@BAFAD@DA@@BA@@B@@BB@@@@@BBD@@@@@B@@BBB@@@B@BBBB@@@@@@BBB@@@@AB@@@B@B@@BAB@@@B@@@@BB@@@B@@@BBF@@@@@B@@C@@@@BA@AB@@@B@B@@@BADAB@@@@A@@B@@@@@@AB@@@BAB@BADA@AB@@@@@@@B@B@DB@@B@B@B@@@@B@@@DBD@B@D@@@@B@BBB@@BA@@B@@@@@BA@@B@B@@@BABB@@@AB@@@@@@@B@@@@AB@@ABE@AB@@@@@B@@B@@BD@@@BB@@@BB@B@B@B@B@BABA@AB@@@BAFA@@@BB@@B@B@@@B@BABAB@@B@@B@BB@BA@@@A@@B@@@B@@B@@@AB@@@@@B@@B@@BBB@@@B@BBB@@AB@BCF@BA@A@@@@@@B@BABAD@@@BA@@@@B@@AB@@ABA@@@AB@@ABA@@B@@@@@B@B@@BB@BBB@@BBB@@B@@@B@B@@@D@@@BB@@@B@BB@@@@@@A@@BADB@@@@B@B@B@D@@BBABA@@@@@@@A@A@@B@@AA@@@@@B@@AAAB@@C@C@A@AAAB@@@@@@@@@D@B@@@BA@@@@BB@BB@B@@@B@B@@@@AA@@A@@@AB@@BB@@@@@B@@AA@@@@@BA@@@A@@@ABA@@@AB@@A@@@@B@@AB@@ABA@@B@@BB@@BB@@@B@@ABB@ABC@BB@@@@@B@@@B@@@B@BB@@B@@@B@@@@BB@@@@A@@@A@@@A@@@@B@@BB@@B@B@@@@B@@@B@@@@A@@@B@ABAB@B@@@@B@@B@@@BB@@B@@@@@BAB@@BB@@BB@@@@@@@B@B@B@@@@@BD@BBBB@@B@BBB@@@@B@@CFAB@@AB@@@BA@AB@B@@A@@@@B@@B@@@@B@BADADB@AB@BB@@BAB@@@BA@@B@@@@A@@@A@@@@@A@@BBBBBDBB@@A@@B@@B@@BB@@BBBB@A@BB@@BB@@BB@@@B@@@B@D@B@BB@@@@@B@@BB@B@@@@AD@@BBBBDBB@@B@@AB@BAB@@A@BB@@@@BDB@@@@@@AD@FDD@DBD@BB@@@@@@A@@B@B@@@BB@B@@@B@B@@BBBBB@@@B@B@B@@@@AB@@A@A@@@@B@@@BBB@@@@A@A@@@@B@@@BB@@B@B@@AD@B@BBD@B@@BBBB@B@@@@@B@D@BBB@@@B@@B@B@@BBBDB@BBB@DB@@B@@B@@@BB@@@@@BB@@@BB@AB@BA@A@@DA@A@ABA@A@@@@BC@@B@@A@@@@@@AA@A@@A@@@@@@@@AB@@@B@@@B@BB@@B@@@@A@A@A@A@A@@@AB@@AA@@A@AA@@A@@A@@A@@@@BA@A@@@@BA@@BA@@@A@AB@@A@@AA@@@@BA@@@@@A@@C@@A@@@@BA@A@@@AB@@@@@@AAAA@AA@@@AACA@@A@A@@@A@C@@@A@@@AD@BAB@BA@@B@@@BE@@@@@A@@@@B@B@@@BA@@@@BB@B@@B@B@@@B@B@BBB@B@B@BBB@DB@BB@B@@AB@@B@@@@@@BB@@AB@@@@@B@B@BB@@@@@B@@AB@@@D@@@D@B@@@@@B@@@AA@A@@B@@@@@B@B@@@@AB@@@@@BAB@BABA@@@A@ADA@@BABB@AB@@@B@@@BA@@@@B@@A@A@A@A@@@@B@@@BB@BBB@@@@B@B@BABA@@B@@@D@BB@@B@BAB@B@@@@@@B@@@B@BB@B@DFB@@BBB@@B@@@BB@@@ABA@@@@B@@@@@BB@AB@B@@@B@@@@BBB@@AB@BB@BF@B@@@BA@A@C@A@ABA@@@@@AB@@@@BBD@B@@@@@BB@@@@AB@B@@@BB@BB@@@@B@@BB@@@B@BB@@@B@@@BB@@B@@@@@B@@@BB@@B@@BB@B@B@F@BBBBB@@A@@BA@@@BB@@@@DBBBBBBBB@DB@@BB@@B@@@B@@BB@@BB@@@@@@B@B@B@B@@@@AB@@B@@@BBBB@@B@BB@@@B@BB@@@BB@B@@@@A@@@@B@@@BB@@@A@@B@@@@@@A@@BA@A@@B@BBBABABA@@B@@B@@@DBBBB@B@@B@@B@A@@@@BAB@@AB@@@@A@A@@@@@EB@@@B@@@@@B@@@@@B@@B@@@D@@@BBA@AB@@AB@@@@A@@BAB@@@@BB@@@@@FABA@A@@@@B@B@@A@@BB@@@B@@BBB@@B@BA@@@@B@@@BBB@FABB@@B@@@@B@@@@B@BBB@B@DDFBBB@@B@@@BB@BBD@B@@@@@@BAB@BBB@@@@@BB@@BBD@@@@@B@ABBB@@BB@B@BB@@D@BAD@@@@AB@@@@@@A@@@@B@@@B@@@B@B@@@BBBA@@B@B@BAA@@@@ABC@A@A@ABA@@D@B@@AB@@@@AB@@@BAB@@@@@B@@AB@@@@@BAB@@@@BB@B@@@B@@BB@@BBA@@@@@A@@@@BA@@@@B@@AB@@BB@@@B@@@@BB@@D@BBB@BAB@BA@@DA@@B@B@BAFBBA@@B@@BB@@@B@@@@@@@BAB@@@B@DAB@@@@@BAB@B@@@@@@C@@@ABAB@@A@@@AB@@@@@@A@@@A@@B@@ABA@@B@@@@C@@B@@BB@BA@@B@BB@@@AB@B@@@@@@BBA@@B@@@B@@@B@@B@@@@@BA@@B@B@B@@@BB@@@BBB@@BDDB@@@@B@@BB@@@BDDBB@@@B@@AB@B@@@B@@BB@BBB@@@@@B@@BB@@@@A@@B@@B@BDBB@@B@B@B@@@BB@@@BB@@BBB@@@BB@@@B@B@@@BBBB@B@BBB@@@@@@@B@@@@@BB@@@A@@B@@@B@@AB@@@@@BBB@@@B@@CBA@EBABC@A@A@AA@@@@@@ABA@CAA@A@AAABA@@@A@@@@@@D@B@DBB@@BBDBBBB@BB@B@BB@@@BA@ABC@@BAB@@@B@@@B@@@B@BB@@BB@BBB@@B@@@BAB@B@@@@AB@B@@@@@ADB@BABB@BB@@@B@@@@BA@@B@@@BBB@B@@@BDB@@B@@@BB@@B@@BB@@@B@B@@@@B@@DB@@BBDBB@B@@@DB@BBABB@@@BB@@@BB@@@BB@@@@B@@BD@BB@BBB@D@BBB@@@@@BAB@@@@@@@@A@@B@BA@@BCBA@@AA@@A@AA@@@@@@@A@A@@@@A@AA@@@A@@@AA@@@AA@@ACCA@@@A@@BCHAB@@BBBBB@BB@@@@@@A@@B@@BBB@@@@@BD@B@@BB@@AB@@A@@@@B@B@@@B@@A@@@A@A@@@@@@DA@@@ABA@ADA@@BA@@BA@@@@@BB@BB@@BBDABA@@DB@@B@BB@@@@ACAAAAA@@AC@A@AAAC@@@@A@@@@@A@@@A@@@@@@@AA@@@A@@BA@@@AB@@A@A@@@@AA@@@A@@@A@@A@@@ACCAAAAAA@A@A@@@AAA@CCA@A@@@A@@@AA@A@@@ABEA@@@CAA@@@@@@A@@A@@@CAA@@@A@AA@@@@AA@@@@A@A@@AB@@@BABC@@B@BA@@@@B@@BB@B@@@B@@@B@@@@@B@@@@B@@@B@@@@BAB@B@@A@@@BB@@BBA@@BB@@B@@@@@@@@BB@@@@@@B@@@@@@@B@@@@@@B@B@@@BA@@B@@@@@B@@@@@@A@@@A@@BADCBABABAB@D@@@@A@@@@FBD@B@BABA@@B@@BB@D@B@B@@@@A@A@@@ABCB@@@@A@@AA@@AA@A@A@@@A@@@A@@BA@AB@@A@@@A@@@A@@B@B@BAD@B@BA@@@@@@@@@A@@B@@@CA@@AA@AA@@@AB@@A@AAABA@A@@@A@@@@@ABABAA@@A@@AA@@@@@AA@A@@A@@@C@A@CAAA@@A@A@@@@A@@@@@AD@@AF@@ACC@A@CB@BA@@@@@CA@@@@A@@@B@B@@@@AA@@A@@@@C@C@ABCB@DC@@BCBA@@@AAA@A@ABC@@@AA@AA@@@@B@B@@@BAB@@ABCBA@@B@@@B@B@@@B@BAB@B@BABAB@BB@@B@B@BA@@BABC@@@@@A@A@@@@B@@@B@@@B@BB@@B@@@BBB@@@B@@@@B@@BBB@BBB@B@B@@@B@@@B@@A@A@A@@BA@A@@B@@AB@@A@@@C@A@@@A@@@@@A@@@@BA@@@A@@@@BA@@@A@@@ABA@A@@BA@A@@@C@@@AAAAAAC@A@A@@@AD@@@@ABA@@@@@BB@@@@B@DBD@DBD@@@@B@@AB@B@@@@@B@BA@@B@DAB@B@BABA@ABA@@@ABAA@BAB@@AB@B@BA@B@@D@@@@A@@@@@@BA@@@A@@B@@@@@B@@@B@@@AA@@@@B@@@@@@A@@B@@@B@@@@A@@@@@@B@@@BA@@B@B@@@BABCBC@@AA@@AAAAAA@AB@@AB@B@BA@@@A@A@@@CBEBE@C@A@C@@B@BAB@@@@AA@AAA@@@@A@@@AA@A@@@A@@A@@@@BAB@B@@@BA@@A@@C@@@A@@@@CAC@@A@@@A@@B@B@@AB@@A@@ACAC@@@AA@@@@CB@@A@@B@@@@@@A@A@@@@@A@@DB@@A@@B@@@@DBB@@@B@@@@@BB@@@BB@B@@@@BB@@B@D@@@@D@BBB@B@@A@@BABCB@@ABA@CB@@A@@D@BA@@BBB@B@@@BAB@BA@BD@B@@@B@B@BAB@@A@@B@@@BB@@B@BAB@@@B@@@BAB@D@@@B@@@B@B@@ABA@CBAB@@A@A@ABABABA@A@@B@@E@AAA@AB@@AB@BCBA@@@@A@A@AC@CAA@@@@AA@ABABC@ABA@@@@B@BBB@DB@@B@@@BB@@B@@@B@BB@A@A@@BABC@@@ADAD@B@BAB@@@D@B@B@BA@@BA@@BAB@@@D@@@B@@@B@@@B@@A@@B@@@BBB@@AB@BB@@BBADB@@BBB@@B@BBB@D@B@B@@@DDBBB@@@@AB@@AB@B@@ABABA@@B@@BB@@A@@@A@@BA@@BIBABA@@B@@@B@@@@@B@@@B@B@@@B@@@@@@AB@@ABABABE@ABCAEBC@A@A@@@A@@@A@@BC@@@@@A@C@AB@AA@@@@A@@BA@A@A@AA@@AEAAAAEACCEE@ABA@@@AB@DE@A@A@AA@AA@@AA@EBA@@BA@A@A@ABA@@B@B@@@@@BA@@BAB@@ACBA@A@@@A@@AAA@AAA@@A@@AA@@@AAAA@@AC@@@@A@AA@A@A@@AA@@@AB@@AAAAA@@@@A@@@@@@A@@B@@ABCAA@@A@@A@@BA@A@AB@@@@C@@A@AA@@A@@@AA@AA@A@@@@A@@@@BA@@@A@A@@@AAC@@@AA@@@@AA@BA@@@A@@BA@A@@BBB@@BBB@@@@B@@@F@@@B@@@B@@@@A@@BC@A@@BA@@@ABA@@@@D@F@@@@A@@AA@A@@AA@A@@@@B@BBB@BBB@B@@@BBB@@B@AA@@AB@BAB@A@@AB@@@BA@@B@@@B@@@A@@AA@@@@@@A@@@@B@@@@A@@B@@@BB@@@@@@B@A@@B@@@@BBB@@@@@B@@@@A@@@@@@@@@A@@@BB@@@BA@A@@@AB@@@@@@@B@@@@@BABB@A@@@@@@BAB@B@B@B@@BA@@BB@B@@@B@@@@A@@B@@@@B@@@@B@@@@AB@B@B@BAB@@A@@B@@@@A@@@AB@BA@@@@@@@@@A@CBABC@AA@@@A@@A@@B@@A@@AA@@@@AAA@@@@@@A@@@@AA@@A@@@BA@@@@@@@@@@IA@BA@@@@DA@@@@@ABAA@@@BAD@@EA@B@@@@@@A@G@@@@@ACA@B@BG@@A@@@@@@@@@@A@@@A@@@@@@BAB@@AB@@A@@@@@A@@@@@A@@AA@@B@A@@C@@B@@@@@@A@@@A@C@@@A@@@@A@@AA@@@@@@A@@A@@@@@@A@@@ABA@@BA@A@@BABAD@B@B@B@BDB@B@@@BB@BBBBB@BBBD@B@BBB@@B@BB@@@@@B@B@B@@@B@@@@@BD@@BB@@DBB@B@B@B@BBB@@BB@@@DBD@BB@@BABA@@BA@@DA@AB@B@B@@AB@@ABA@@BCB@BABA@@BABABA@AJEBA@@@ABA@A@ABCBABE@CBE@@@A@@CC@@@@@A@@@ABA@@@@BC@@AA@@@A@@@@BADCBA@A@CB@@@BABA@A@@@CBA@@@@@@BAB@DCB@BABCB@B@@@BA@@@A@@@@ECB@BA@@A@@@ABA@@@AA@@A@@A@A@@@@B@@A@@A@CA@@@B@@A@@AA@@AA@@AA@@A@@@A@@@AA@@ACAAE@@B@@A@@@@@A@@AA@A@AA@A@@@AAA@@A@@@@@@BAA@B@@A@AAA@@AA@@@@@@A@AA@@@@A@@AAA@@@AAA@A@@@A@AA@C@@@@A@@@AAAA@@A@@@A@@@@A@ACA@AAA@@@@A@@@A@@B@@@BA@@B@@@@@@A@@@@AABAA@@@@A@@_BE@ABCBACA@@BABA@@B@@A@@@@@A@@@@B@@C@A@@@ABA@@@@@@@@@ABA@@@@@A@@@A@@BA@@@@@@BAB@B@@@BCD@@ABA@@B@@A@@@AA@@A@A@@@A@A@@@@@A@BB@BB@@@BBBBB@B@@@@@@@AB@@@B@B@B@B@BABA@A@AB@BA@A@@@@AABA@@@AB@@AB@@@BA@@B@@A@@BA@ABA@@@@@@@@@AAAB@AA@A@@@ABA@@@A@A@@@AB@B@BA@@BA@@B@@@@AB@@C@@@AB@@@A@BA@@BA@EHAD@@@@@@A@@@A@A@@@@@C@@@A@@@A@@@A@@A@@@@A@@A@@AA@@@@A@@@@@A@@@AB@@A@@@A@@@@@A@AB@AA@AAAA@@@AAC@@@@AA@@@@A@@@CA@@@A@A@A@@@A@@A@CACAA@A@AB@A@A@@AAA@@AA@@AAB@AAAAA@@@A@@AA@A@@AA@@AA@@@AAA@AA@@A@@@@CB@@@AA@CCAAAA@@A@C@C@ABA@A@A@@@A@@@@@A@A@C@AA@@@@ABA@@@A@@@"],encodeOffsets:[[120250,25068]]}},{type:"Feature",id:"350628",properties:{name:"平和县",cp:[117.315017,24.363508],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@AAA@A@@A@@A@CB@@@@A@AA@@@@@@ADA@@@@@@A@@@@@@@@B@@@@A@@@@A@@@ACA@AA@@@@AB@D@BA@@BA@@@@BAB@@A@AB@AABA@@BAB@@@@A@@@A@@@@B@B@@A@@B@@@@A@AB@@@@@@CA@@@BA@@B@@BB@@A@@@@@@@@@@B@@A@@@@@ADAB@@@A@@@@A@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@AB@@@@@@@@@@AB@@BB@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@A@@@@A@@@@@@@@BA@@@@BA@@@@@@@A@AB@DA@@@AB@A@@@@AA@@@B@@A@@BA@@A@@@A@@B@@@@@AA@@A@@@CB@@AB@@A@A@@@A@@@@BAB@@A@AB@@AA@@A@@A@@@A@A@AA@@@AA@@@@@A@AAA@A@@@@@@@A@A@@@@@@@A@@@A@@@@AB@@ABA@ABABA@A@@@AAA@@@A@@A@A@@@@AA@@@A@A@@AA@A@@@A@@@A@@@A@A@@@A@@@AA@@ABC@@@A@A@A@@A@A@@@CCA@A@@@A@@@A@@@CA@@C@A@@@@A@@@ACA@@A@@@@A@@@@@@AA@BAB@@E@@@@B@B@@BBABB@@@B@@@BD@B@@@@AB@@AA@@@@@@@B@@A@@B@@@@@@AA@@@A@BA@@@A@@@@A@@@@@AAABA@@@A@@BAB@@ABA@@B@B@@@BABAB@BAB@A@@@@@@@@AB@@C@@BA@@BABA@CB@@C@A@A@CBA@AAAA@@@A@AA@B@@A@A@@B@@@BA@A@A@@@C@@@@@@@@@ABA@@B@@A@AB@@@@A@A@@@A@@@@BADAB@DAB@BA@@@A@@B@B@@@@AB@@@@@F@@@@ABAB@@@@AB@@@@AB@BA@@B@@@@@BA@@BAD@@@BBB@@@D@@@BA@@@@BA@A@@@@BA@@B@@C@ABA@@@@B@@@@@@@@@BA@@BA@@BA@@@@@@@@B@B@B@@BB@@AB@@B@@B@@ABAC@A@@ABA@@@A@A@A@C@@A@BA@@@A@@@@B@@A@@@ABAD@BA@@@A@ABA@@@AB@FADAD@@AB@@AB@BA@@DA@@BA@@@A@@@A@A@@@@@@@ABA@@B@@C@CBA@@BA@ABA@@@EBA@@BA@C@ABAAA@@B@@@@A@AD@@A@ABA@A@@@A@ABABAB@BA@A@@B@BABABABC@@@AB@@A@@@CAAAA@@@@@@@@BA@@D@@AB@B@BBB@@B@@@@@@@@@BB@@@DA@AB@@@@@B@@@B@@A@@@@BAB@@@@@BA@@@AB@@BB@@B@@B@@A@ABA@A@AB@@A@@@@@AB@@@@AA@AA@@@@@@B@B@B@@AB@D@@A@@B@B@@@@@@@@@B@@@@@@A@E@ABA@AB@@AFAB@@A@@@@@A@AAA@@@A@A@ABABA@AD@BA@B@@@@@@BABBB@@A@@@A@A@C@@@A@@@@BA@@B@B@@@@@BAB@@@BA@@BA@A@@B@@AB@@A@@BBB@@@@BD@@BBB@@BD@BB@DBB@B@DABADCDABEDCBEDGBCDAD@@ABABA@EAA@AAAB@@AB@BABABAB@BAB@B@BABA@@BA@ABABC@ABAB@B@B@B@BA@@@A@A@A@C@C@C@A@@@AAC@CBGBC@AB@BAD@F@BBB@BBD@B@@B@B@B@@@@@@B@@BD@D@D@D@B@B@@AB@BABAB@DA@@B@B@BBB@B@BBD@@@B@B@BADBF@@AD@BBB@BD@@B@@@D@B@BBBAB@@A@A@A@@B@@@D@@BBBB@B@B@@@BAB@@@@ABA@ABC@C@ABGAICA@A@CBCD@B@H@HAB@D@B@B@@@B@B@BAB@B@B@B@B@B@B@BBB@DBBHJBBAB@BA@@BABA@ABABA@ABA@AB@BAB@B@B@B@B@B@D@B@D@BGFABKHCD@FBLDHHHBD@B@@@@BB@@@B@B@B@@@B@BAD@BAFAF@@@B@@BB@@@AB@@@@AB@@BB@@@@@BAB@@@@@@@@A@@BC@ABABAB@@AB@B@B@@@@B@BBB@@B@DBF@@@BA@@BA@@B@@@@@B@@BD@@@B@@BB@ED@@@B@@B@B@@@B@BA@@@B@D@BAB@B@B@B@BABB@@B@B@@@@@BA@@@B@BB@BB@@BB@BD@B@@AD@B@@@BB@@@B@@B@@@B@@@B@@B@BB@@@@@BB@@B@@@BBBAB@@@D@@ADB@@@@A@@BAB@B@@@B@B@@A@BDB@BB@B@BAB@B@AB@B@B@@BH@B@B@@@BBD@@AB@@CF@BAB@B@B@@@D@B@@AB@BA@BBAB@@@@@B@@@BBB@@@@@B@@CDAB@B@B@@AB@@BB@BA@@@AB@@@B@D@BBDA@@BA@AB@@A@C@@@ABABAB@B@B@@@B@BA@AB@@@B@BB@@B@@ABC@@@ABB@@B@@@D@@BB@B@@C@@@@@B@BB@@@@A@@@@@ABABAB@@@BAB@@ABCB@BAB@@A@@@A@@B@B@@@BA@@B@@A@@@@B@B@B@@@@BDABBB@BB@@D@B@@BBAB@@@BA@@@AB@@@B@@@BAB@B@@@BA@AB@@A@@@@B@B@B@@@B@@BB@@AB@@@B@@@@DBJDDB@@B@@@@AB@@A@@@@@@@A@@@A@@@@B@@A@@@A@@@AB@@A@@BA@@BA@A@@@ABA@AB@@A@@BAB@B@@BBFBB@@AB@B@@DD@B@@BA@@B@@@@B@B@D@@AB@B@B@@ABAD@@AB@@@BA@@@@BA@@@BB@@D@BB@@@B@BBB@@BBFD@@D@@@@@BB@DBD@@@@@@D@D@B@@BB@B@B@BB@@BBB@@@B@BALCBA@@B@@@@B@@B@DABA@@BBFBJBD@F@B@@@D@BAB@B@DB@@B@@ADABA@@BABA@@DCBABA@@DBBBFHBD@@@@B@BB@@@BB@@@@B@B@@BBB@@@@@B@D@B@B@@@@B@@B@@BB@B@@BBD@@@B@B@@@B@B@B@B@BB@@B@@@@@@@@BB@B@B@B@@@BBB@B@@@B@@@B@B@@@@@@@B@B@B@B@@F@BA@@@@BA@@B@@A@@B@@@@@@@B@@@B@@A@@@@@AB@BBB@@@D@@A@@B@BA@@BAB@@@@A@ABABEB@@@@@B@@@B@D@@@D@@@@@B@FCDAB@B@@@BA@@FAB@@@BA@ABA@@F@D@B@B@@AD@@@BA@ABC@@@AB@@@B@B@@@B@@@BAB@BAB@@A@A@AB@@@B@@@DA@@@@B@@@@A@@@@BA@@@@BC@A@@@@@A@@@CA@@A@AAAAA@@@@@AB@@@BA@@B@@@@AB@@AB@B@@@BABA@@@@B@@A@@B@@@@A@@B@@@DBB@BB@A@@B@@@B@@@@@@@B@D@BAB@@A@@@@@A@A@A@A@@@@B@@A@A@@@A@AB@@@BA@@B@@@B@@@DAB@@@@@@@@A@@B@@A@@@@B@BC@A@@BABAB@DAD@BAB@@A@@BC@@@AB@@@B@@@BA@@@@@A@A@AA@@AB@@AB@B@@@@@@A@@AA@A@A@A@@@@A@@@A@@@@@@AAAB@@A@@D@@@B@@B@@B@B@B@B@@@B@DAB@@A@@@A@AB@@@B@BAB@@@@AACAABA@A@A@@@AA@@AAABAAE@@AA@@@A@@B@@AB@B@@@B@@@B@@@B@B@B@@ABAB@@AB@B@B@@@DBD@BBDBFDF@BB@B@@BB@BB@BBBB@@BAB@B@B@@@BB@@@@@@B@B@DB@@@B@@B@@@@@B@@AB@D@B@B@@@@C@ABABC@@BAD@DAB@BA@@BB@@@BBBB@DDB@B@BBB@@@B@@BBBA@@@BB@@@@AB@@@D@@@DB@@B@B@BBB@D@B@@B@D@B@BAB@@@B@DAB@B@@@B@@@@@@@@B@@DF@BBDDBBB@B@@@BBB@@@@BBD@@@D@BBB@@@F@@@B@B@BBBB@@BB@@@B@@@B@@B@@@@B@@D@@@@@B@@@BA@@@A@A@A@@BAB@BAHAB@@@B@@@D@B@@@B@JBB@BBB@@@B@BABAD@@@@ADAB@@ADA@@@AB@@@@@B@@@B@B@@@@@B@@@B@@@DA@A@@B@@A@@B@@A@@B@@@B@@ABA@@@A@@@A@@@AB@@A@A@@B@@A@@@@@ABABA@@AA@@@@@A@@@@@@AA@@@@@A@@@@AA@@@@@A@@@@@@@AB@@@@AB@@@B@@@B@@@B@@@BA@@@@B@@@B@@@B@@AB@@@@@BA@@@@@@B@@@B@@@@@@@B@@BB@BA@@BA@@B@@@@@BAB@@AB@@@@AB@@@B@B@B@@@@@@@B@@B@@@BB@@BB@@@@B@@@B@BB@@@@B@@BB@@DB@AB@B@B@B@@@@@B@@@BBB@@@B@@ABA@@@@B@@A@@@@BA@@@@@A@A@@BA@@B@@@@AB@@@@@@@BA@@@AB@@A@@BA@@@@@AB@@@@@@@B@AE@A@ABA@@@@@AD@@@B@@@@A@@@A@@@@@AB@B@@@@@B@BA@A@@@AB@@A@@B@BA@@@A@@BA@@DB@@BA@A@ADABC@@B@BA@@@ABA@@B@@AB@DBB@DBB@@@@@@B@B@@@B@@BB@@BBD@B@D@B@B@@BA@@BB@@@@@D@BB@BD@BB@@@C@A@CB@FCBC@@DAFABAD@B@D@BA@@@@@@DD@@@B@@@@BA@@@@B@BBB@@BB@B@B@@@@@@@B@@@@@BB@@DBB@@@@@BBBDBB@@@@@BBB@@BB@BBB@@B@@@BB@B@@B@@B@B@@@B@@@@B@@BB@@@BB@@@B@@B@@BB@@B@@D@@@B@@BBBBB@@@B@B@@@@@@@DAB@@@@@BAB@@@B@B@@DB@@@B@B@@@B@@@B@@@B@@@@@B@@B@@B@B@@@@@@B@@@B@BA@@@@BBD@B@B@@@@@B@@@B@B@B@BAD@D@B@@@BBBBDDB@@B@@DA@@@@@BB@@BBB@B@@BB@@BB@@@BBB@@@B@@BBBB@BBA@BB@@BB@BB@@@B@BBAB@B@DBDBB@@@@B@@@B@B@B@@DB@@B@@@@@BB@@@@BD@B@@BBBBB@@BBAB@@@@@B@@@B@@@BA@@B@@@@@B@@@@@BB@@@BB@@@@@@BB@@@B@@@B@@@D@@@@@B@B@@@B@@@@@@@BCFGB@@AB@@A@B@@BA@@D@@@BA@@@@@AB@@AB@@A@ABAAAAA@@@@A@@@@A@@AA@A@A@@AAA@@@A@@@A@@A@@@A@A@@AA@@@A@@@AA@@@@A@@@A@@@@@@BADA@@@@@A@@BAAAAB@@AA@@@@BA@@@@@@A@@A@@BAB@BAB@@@@A@@@@@@AABAA@@@@@@A@@B@@@@@AC@@BA@@B@@@BA@@@A@@C@@@@@@C@A@C@A@@@A@@BA@@@A@AA@@@A@@A@@@C@A@@@AAAA@@@@A@@A@AAAA@@A@DAB@BA@@A@@@@@@AB@@@B@@@BA@@B@@@@ABA@A@A@@B@@A@AB@@A@@A@@CB@@@AA@@C@@@A@@AA@@A@@@A@@@C@@@B@@BA@@@@@@B@@@@@@A@@@@@@@@B@@@@@@A@A@@@A@@@@BA@@BA@A@@B@@@B@@AB@@@@@B@@@BAB@@@BADA@@B@B@@@BA@@@A@@B@BC@@B@@A@@@A@@A@CA@@A@@ACA@@@AA@AB@@CA@@A@A@ABAAA@A@A@@@A@@AAA@@@@@@@A@@CA@@@@A@@A@@@AAAAAA@BAAA@A@@AEBA@@BA@@@AAABA@@@@@A@@@@BA@@@@A@@@A@@@AB@A@@AA@@A@C@@@@@@C@ABA@A@A@@@A@@@A@A@AA@@AA@@@@A@@BA@@B@B@@@@A@A@@@A@AB@@A@ABAB@@@AA@A@@@@AAB@@@@A@AB@AA@A@@A@BA@@BA@ABCB@@@@@B@D@@@B@@@@A@@@AD@F@@AB@@@D@@AB@@A@@BA@@@@@A@ABA@@@@@A@A@@AAB@@A@@@A@AA@BA@A@A@@@@CAA@@A@@@A@@AA@A@@@@@A@A@AA@@@BA@@BA@@@A@@@@@A@@@ABA@@@@@A@@@AB@@A@@@@A@@@@A@@BA@B@A@@@@@A@@@A@A@@@AB@@A@@@A@@@ABA@@AA@@@@A@A@@A@@@@A@@@@AB@@@@A@A@@AA@@B@@@BA@@A@@@@A@@@@A@@@AA@BA@@@@@@@A@A@A@@@@A@A@@@@AAAA@A@@DAAAA@@@@AA@@@A@AA@@@AA@A@A@A@@BA@A@EBA@AAA@AA@@A@BA@@AA@@AA@@A@AAAAAA@@@@AAAA@@@@@A@@BAAA@A@@@@AA@@@A@@AC@@A@@AABA@@A@AA@@AA@AC@A@@@@@@@C@@A@@@A@A@A@@@@@@@@@A@@@@A@AA@@@A@@B@@EBA@ABA@@@ABA@A@@@@A@@@@AA@A@@@B@@AB@@AB@DAB@@@@@CAAA@@@A@A@A@@@AB@@@@A@@@AB@BA@ABA@@@A@ABA@@@@@A@ABA@@BA@@@@@@C@A@@BA@@@@BA@@BA@A@@BA@A@@@A@@AA@AA@@AAA@@@@@@@A@A@A@@BA@@@@@ABA@@@A@@BA@@A@@A@A@@BA@@A@@AB@@ABA@@B@@@BA@AAC@A@@B@@AB@@@@A@@@AB@@@@A@@@@B@BAB@@@B@B@@A@@@@@C@@AA@@A@@@A@A@@@ABC@@@@@@@@A@@@A@@A@ABA@A@AA@@@@ABA@@BA@@BA@BA@@A@EA@@AA@@AB@@AB@B@@A@@B@@CC@@@@@@@BCAC@@BA@@@@@AA@@A@@AA@@@A@@A@ABA@@@A@@AC@A@A@@B@BAB@@A@A@A@@BCBA@@B@@@BABABA@@@@AA@@BA@@@A@@@A@A@@@@BA@@@A@A@@@@@A@@B@@@@ABB@@B@@AB@@A@AB@@@B@@A@@@A@@@A@@@ABA@@@@@ABAB@B@@A@@@@AA@@@@AA@@@@@@AA@AA@@@@A@@@@@AB@@@@@@A@@@A@A@@@@@@AAB@BAAA@AB@@A@@@@@AB@@@@@@A@@@@@AB@BA@@AA@@@@@C@A@@BA@@B@@A@@@@@ABC@ABABCAA@A@@B@BA@A@ABA@A@A@AA@@A@A@AB@AABA@CA@@@@A@@AA@@@ABA@@C@AA@AB@@@@A@A@@@A@A@A@A@A@@@A@@@@@@BA@@@@@@@A@@@ABA@A@AA@@@@A@@@A@@A@@@@@BA@A@@@@@A@@@@A@A@BE@@BAA@@@@A@@@A@@@AB@@A@A@@B@@A@ABA@CBA@A@A@@CA@AA@@@BA@A@A@@@A@@BA@@@A@@@AB@DCDC@@B@@A@@@@@A@@@A@@@AA@AAC@@@ABCB@@A@@ACE@@A@A@AAA@@@A@@@AAAAA@AAA@@@AA@@AA@@@@A@@@@@AB@@AA@@A@@BA@@@ABCD@@A@@B@@AB@B@B@@AB@@@B@B@B@@@FAB@BAB@BA@A@@D@@A@AB@@ABA@@@C@AB@@@@@@@BA@@@@BA@AAA@A@AB@@A@@A@@A@A@@@@AA@AA@@A@@@A@A@@@@@A@AA@@@@A@@@@@@@ABAA@B@BABA@@B@@AB@@ABA@@BCBC@A@C@A@A@A@AAABA@@@CAAAA@@ACAA@AA@@AAAA@A@@@@AA@AA@@@A@@@CA@@A@@@AAAAA@C@A@@@@BAB@D@BAD@DA@@BABAB@@@BCDA@@BAB@BA@@B@@@@@B@@AB@B@@A@@B@@@B@@@B@BBB@B@@@B@@@B@@@BBD@@@@B@B@@@@D@B@@@BA@@@A@@BC@@@@B@@@@@B@@@B@B@@@@AD@B@@@@@@AB@BAB@@@@DD@B@AB@@@B@B@@@@@B@@@B@@@@@B@@@@A@@B@@@BB@@@@BB@BB@ABB@@BB@@B@@@B@@@B@B@B@@@BBB@@@@@@BB@@CD@DAB@D@@@BEJA@@B@BA@ADA@@B@@@@ABA@ABA@@BEHABA@ABABA@@B@B@@AB@@BB@@@B@B@@@@@B@@@@@@A@@B@@@B@@AB@@AB@@@BA@A@@@A@@@A@@@@@@D@@@@EA@@AB@@C@@@@B@@CB@@@BAD@@@@A@AAA@C@CB@@@@C@@@@@@B@@@@A@@B@@@@@B@@@@@B@@@B@@@BAB@@A@@@AB@@@BA@@@A@@@A@@@@@A@ABA@ABAB@@@BA@@B@@A@BBAB@@@@@B@@A@@BA@@@AA@@@@A@@@AAA@@A@AAA@@@AA@@A@@@A@@@A@@@A@A@@AAAA@A@A@@@A@@BA@@@@@C@@A@@@@@@A@@@ABA@@@A@@@AA@@@@@A@@B@@@A@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@A@@@A@C@@@A@@@A@@@A@@A@@@@@AB@@A@@@@@A@@@BA@@@AA@ABAA@@CBC@@@@@A@@@A@@@@A@@@@A@A@@@@AA@A@@@@BA@@@@@@B@@@@@@@@AB@@A@@@@@@@@B@BA@@A@@AB@@@BA@@BA@B@AB@@@@C@@@@@A@@@@@@@AA@@@@BA@@@AA@@A@BA@A@@@@@@A@@@@A@@B@@AA@@B@@A@@A@@BA@@@@AEAA@AA@@@AAA@@A@A@AAA@AA@@@@@A@@@@@ACC@@@@@A@@@@B@@AB@@@@CCC@@@A@A@A@@@A@@@A@@@@@A@@@@@@B@@@@AB@@@BABA@@@@BA@@BABB@@@A@@@A@A@@@@@@AA@A@@BA@@B@B@@@@@@A@A@ABA@@BABADA@ABABA@A@@BA@@@@DABA@A@AB@@@BA@@BABABABAB@@@@A@ABA@@AC@A@@AA@A@@A@@@@@B@@CDCBA@@@@@A@@@AAC@AA@@A@@@@BA@@B@@@@@@A@@@A@A@@AA@C@A@@D@@AB@@ABC@E@AA@@@@@AB@@@@A@@@@@B@@@@A@@@A@@@@BBBA@AB@@@A@@@B@B@@AAA@ABABA@@AAA@@AB@@A@@@C@A@@@A@@@A@@@AAAA@@@@@A@A@@A@@BA@@@A@@AAA@@@@@A@@A@@A@A@@@A@@@@@@B@@@BAA@@@@@@@A@@A@@@A@@@A@@@A@@@BA@AA@@@@@@A@@AA@@BAA@@A@@@@@BAB@@@@@@@@@A@A@@@AAB@AB@@A@@@A@@ABA@@@B@@A@@@@@A@@A@@@@@@@A@@B@@@@@B@@@@@AA@@A@@AAA@AA@@@@B@@@B@DB@@@@@BB@@@@@@@@A@@@@@@@@@AA@@@@AB@@A@@@A@@A@@@@@@A@@ABA@@@B@@@@A@@A@@@@@A@@@@A@@BBB@B@@AA@@@@@@@@@@A@@@@@@@@@@A@@AB@@@@@@@@@@@@@@BB@@@@@B@@@@AB@@@@A@@@AB@@B@B@@BA@@B@BA@@A@@A@A@@AA@@A@A@@@@A@@A@@@@AA@A@A@@AA@C@@A@@A@BABA@A@A@@A@@@CC@@@@@A@@@@@@@@@@A@@@A@@@A@AAABB@A@@@@@AA@@AB@@@@@B@@@@@B@@AB@@@@A@A@@A@@A@@@@@A@@@@@@@A@@B@@@@@BA@ABCBAB"],encodeOffsets:[[120041,24643]]}},{type:"Feature",id:"350629",properties:{name:"华安县",cp:[117.534103,25.004425],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@A@@@@@A@A@@AAAAA@CBAAABA@A@AAA@@A@@A@@@AA@@@@@@@C@@A@@@BE@@BAAAAA@@@@@C@A@ABA@AAA@@@@A@CBAB@BA@AAA@AA@@A@A@@BCB@@@@AA@@C@A@@@AA@@@@A@@@@@BA@A@@@@@A@@A@@A@@@@B@@@AC@@@A@@@@A@@AAA@AAA@@@@CAAA@@A@@@@@A@@@AAA@CA@@@A@@A@@BEF@@@BA@AD@BA@A@@@EDA@CB@@@B@@@@A@@@A@AB@@@@CAAAA@A@CA@@A@@@A@@@A@A@A@A@@@AAA@AA@A@A@A@@@A@AAA@A@A@A@A@@BABA@A@@@A@@A@C@@@ECCAC@@AAAA@AA@@@A@@@@CAAA@@@A@@A@A@AACACA@@@@@@@@A@@@AA@@AACA@@ABA@C@@BA@@@E@A@AAACEA@@@@A@A@AA@AB@@AB@AA@@@AA@@@BA@A@AA@@@AAAA@A@@A@@@A@CA@AA@@AA@AA@A@ABA@@AA@A@@B@@@@@@A@@@A@@BA@A@@@@@ABA@@BCB@@@@A@@@AAAAA@@A@@A@ABA@CBAB@B@B@B@BBB@@A@@BBBB@BB@@A@A@@B@@A@@BABA@@@A@@BAB@BAB@@@B@B@BB@@DD@BD@B@@BBAB@@A@AB@AA@AAA@@@A@A@A@@@@B@@@@A@@@A@@AA@C@@A@@A@AAA@@@@@@A@A@@A@@AA@A@A@@A@@@@AA@@A@@@A@@A@@@A@@AA@A@AAAA@@A@@AAA@@@@@A@@@@@A@@@@@@AA@@AC@A@@A@@ABA@ABA@@@ABA@ABA@@@@@C@A@@DAB@BAB@@@@@@A@@A@@C@@@@@A@@@AB@@A@@@@A@@A@@@@B@@@@A@@@@@@BAA@@A@ABA@A@@@AB@@@@A@@@C@@@@AA@@@@@ABAB@BA@AB@@@@A@@@ABAB@@A@A@@CC@@@C@A@A@AA@@@BA@@@A@@@@@AA@@@@@@A@@D@@@@@CA@@A@@A@A@@@@AAB@@@@@@@EAA@C@A@AA@@BA@@@A@@@A@@ACAA@@@AA@@@ABA@AA@@A@@@A@@A@@ABAAA@@@A@@@@A@@@A@@A@@A@@A@@@A@@@A@@AAA@@@A@@@@DABAB@@ADAB@BC@A@ABA@AB@@@BB@@BB@@BA@@@ABA@@BB@A@@@@BA@@@AB@@@@ABAB@BA@@@A@@B@@@B@@@B@BAB@@@@A@@BA@ABAB@@@@@@AA@A@@@@@A@A@@AAA@A@@A@AA@A@@A@@A@@A@AA@@@A@@@ABA@@@A@@A@@A@@@A@@A@A@@AA@AA@AA@@AA@@@@A@A@A@@B@@AB@@@B@@@B@@AB@@A@@BA@@@@B@@@B@@A@@B@BC@A@@BAB@@A@AB@@@DA@A@A@A@@@@BAB@BA@A@ABC@@B@@@@ABA@@@A@@BA@@A@@A@A@@B@B@@AB@@@B@B@B@@@BA@@BAB@@A@A@@@A@AB@@A@A@@@@@A@@@A@@B@@A@@B@@ABA@@@AB@B@@@DA@@DABAB@BA@@@ABC@@@@BADA@@B@B@@BB@D@B@B@B@@BD@@@B@B@B@@@@@B@@BB@B@@B@@BAB@B@@@@AB@@A@@@A@@@@AC@AAA@@@AB@@@BA@ABA@@@A@@@A@@@CDA@A@@@AA@B@@@B@@@D@@@BAFA@@BA@@BABA@@BA@EA@@@AA@@C@A@A@@A@@@A@@A@ECA@AAABA@ABA@@@A@@AA@A@A@A@@@@A@AC@@@@AAC@AA@@@A@@@A@@A@@@@@A@@AA@@@C@A@ADA@@B@@@@A@CB@@@B@@@@@B@@A@B@@BA@A@@@@B@B@B@@@BBAB@BBB@BBDB@@@@@B@BA@ABA@@@@@@B@@@@@@@@B@@@@@BB@B@@@@@@B@@@B@@@BBAB@DB@@B@@@B@@B@@B@@@@@BCB@@A@@@A@@@A@@BA@@BA@@@@@A@@@@AA@@@@@A@A@@@A@@@A@@@@@A@@@AA@@@@A@@@@B@B@@A@@A@@@A@@@A@@@@AA@@@@AA@@@BA@AA@AC@@@A@@BABA@@@A@@@@@ABAB@@@@A@@@C@@@C@A@@@@BA@B@@B@@B@@BBB@@@@@ABBD@@@B@D@@FB@@B@@@@@@@B@BBB@BB@@@@BA@@@@@B@B@@B@@@B@B@BB@@@@B@@A@@@A@A@A@@@C@@@A@@@A@A@A@@@A@@B@@@BAB@@A@@@AAA@@A@A@A@A@AA@@B@A@AACC@@@@AB@BAB@@AD@@@@@AAA@A@@@A@@BA@@BC@@@@@@@A@@BA@@@AB@@@B@@A@@B@@A@A@@@A@@@@@@B@B@@@@ABC@@B@@@@@BBB@@@@AB@@A@A@A@@BAB@@A@AA@@AAA@@A@@@A@@AA@@A@@CB@@CBA@@@@@@C@@@A@AA@A@@AA@@@@@@@A@@A@@@@ABA@@@@@@@@@@BA@@A@@A@@A@B@@A@@@@@BB@@@@A@A@@@A@A@@@@B@@@B@BA@ADCF@@ABCDAB@@ABA@A@CAA@AAC@AAA@AA@@CCA@AAC@AAA@@AA@A@EBGBA@EBCDABABAD@@AF@@@@@@AB@@C@GAA@C@A@A@A@A@@@ABABABA@@BA@A@@B@B@B@@BB@BBBBBBBBDBD@B@B@B@D@D@D@DAD@@BD@BADAD@BAB@BBFCB@@A@CB@@@B@@BBBAB@@@B@@@BCBD@BAB@@ABB@@@B@AB@@@@AB@@AB@@A@@BA@@@A@A@A@@AA@@B@@E@A@E@A@@@AA@@AA@@@@@A@@@AA@@A@@AAA@@@@@A@@@@@@A@@A@AAAA@@A@@AA@@@@AA@@@AA@AA@AA@@@AA@@AA@@@A@@@@AAAA@@@A@@@A@AA@@AAA@A@@@AA@@CA@A@@AA@@@@A@@@AA@@@@@@@@AA@@AA@@AA@@@@A@@@@AA@@A@@@A@A@@AAA@@C@@AA@@A@A@A@@A@@A@AA@@@AA@AA@@A@@CA@AAA@C@AAAA@@@@@AA@CB@@ABA@@@AB@@A@@@@AC@A@C@@@A@A@A@@@A@@AA@@@A@@@CD@B@@@B@@AB@@A@@BA@AB@@A@@@A@@@A@A@AAA@@@A@AB@@@@AB@@AAA@@@AA@@A@A@@A@@CBAA@@A@@@@@@@A@@BA@A@A@CBA@AB@BA@AB@@AB@@@@A@A@@@AB@B@B@BAB@@AB@FBB@@BBB@@B@B@BCFA@@B@@AB@BFFDDFBBBBBBF@@BBB@B@B@@AB@@@@@BBA@@B@D@B@@@@AD@@@B@@@B@@@B@BADBFAD@BAFABAB@BA@@B@@@@A@@@A@A@@@A@@@@@A@@@A@@@ABABAJ@@AB@@@B@@@BA@@AA@@@ABAB@BA@A@@BA@@B@@A@AACC@@A@A@C@A@AAA@@@AA@AA@BCAA@@AAA@@BA@AA@@A@@@@BA@@@A@@@A@@@C@@@A@AB@@AB@@ABA@A@C@@@A@ABA@C@CB@B@@ADAB@@@B@BAAA@@@A@@@AA@@A@@@CAA@AAA@@@@@AB@BADAB@BBB@@@@BB@DBDB@B@@@@@ABADA@@BA@@BBB@B@FA@@@@BABABAB@B@B@BA@AB@DAB@BA@A@@@A@@@C@A@AB@@A@@@A@ABA@@@AA@@A@@@@BA@ABA@A@@@A@C@@AABA@AB@@A@A@AA@@ABC@@@@BA@@DAB@BA@ADAB@@@BA@A@AAC@@@@@@C@AA@@A@@A@A@@A@@AA@@@@A@@@A@CA@@@@@AB@@@CA@@@B@@@@@B@B@@@@A@@@@BA@@D@@B@@B@@BDBD@@@BA@@BA@A@@@@B@@@BD@DB@@@@@B@@@DB@@@AB@@A@A@AB@@@@@BB@@@B@B@@B@@@B@@B@BBB@@B@@A@ABA@@@@D@B@DAFAF@D@@@B@B@@ABA@A@@BA@@BBBBBBB@@BB@@ADADAB@@A@A@@@AB@@A@@@@@@@@B@@A@@@A@@@@B@@@@A@@@@@BB@@A@@@A@@@@@A@@@@B@@AB@@@@@@@B@@C@@@@AABA@A@@BA@ABB@AB@B@@AB@BABABA@A@CBA@@@ABA@@@@@A@A@@BA@@@@@AC@CAC@C@A@@A@@A@@@@AB@B@@C@@B@@@B@BBDBBBB@B@@@D@@@BAB@@@BAB@B@@@B@@AB@@@@@B@@AB@@@@@B@@@@@B@@@B@D@@@BA@@BA@@@@BAB@@@B@B@BA@@@A@@@A@A@A@AAA@AA@@@A@@A@@@A@AA@@A@@@AAA@@@A@@@A@@@@@@B@B@@@@ADAB@@ABA@A@@@AAA@ABABA@A@ABA@@@A@A@@@A@@@ABAD@BA@AB@@A@A@@@@@BBB@@B@@AD@B@BBB@B@@ABAD@@CDA@AD@B@D@D@@B@@@BB@@@@@A@A@@@B@@B@@D@@@@ABA@@D@BDD@BE@@BC@@B@@@@@B@@B@B@@@BBDBB@D@@@B@@@@BBB@@@@B@@BB@@@BBBABA@@@@B@@@B@B@BABBB@@@BA@@B@@BBB@@DB@@A@@@@B@@@@@@@@ABA@C@ABA@A@@@@B@@@B@@@BA@@BAB@@@B@@@B@@@B@BBB@@BB@@@B@@A@AD@B@@@B@B@@A@A@C@A@@AA@@@ABABA@C@EA@@@@@B@@C@A@ABABABCDAB@@@B@@@B@@@@A@@@@@A@@@AB@@A@A@@@@@@@@A@@@@@@@A@@@@A@@A@@@@@@A@@@AA@@AB@AA@@A@@@BA@A@AB@@@@@A@@@A@@A@@@@@A@@@A@@@A@A@@AA@@@@@ABA@@@ADAB@@A@@BB@B@@@@@BB@@@@BBB@@@B@DB@@B@@@@B@@@@B@DB@@B@AF@B@@@BBB@@B@@@B@B@DDB@BB@@B@B@B@BBBBDBBD@@B@@@@B@@@B@@BB@@@@@B@BA@@B@@AB@@@B@@BB@@@@@@B@@@B@@@@@B@@@@@BDBBB@D@@BB@BBBBBD@@@@@BB@DBB@@@BB@@BBB@B@BB@@B@BAB@@@B@B@@@@@B@@B@@B@BD@@DDBB@@D@BB@@@B@B@@B@@@@@BA@A@@@@BAB@@AB@B@B@B@@@BA@@BA@@B@@A@AB@DA@@BA@@FAB@@@BB@@B@B@@@BB@@BB@@B@@@@@B@@@@@BB@@BD@@@B@BB@B@@@@BB@B@BBBBDB@@B@@@BB@@B@@AB@@@BDBBB@@@B@DA@@@AB@@A@@@@B@@@@AB@A@BA@@@@BA@@@A@@D@D@B@B@@@@DBB@BB@B@@@B@DAD@@@F@@@B@@@BA@@@AB@@@B@B@@@DCB@B@@@@@@B@@B@DABBBA@@@@B@@AB@B@B@B@@AF@DA@@@@@A@A@AB@@A@AB@@@BCB@@A@@@A@AB@@A@@B@FA@@BA@@B@@A@@BA@@BA@@@@BBB@B@B@B@B@@B@@DB@@@@@BB@@@@@@A@A@@@A@@@A@@BAHCB@B@BBB@B@BB@@B@@A@@B@@@@@@B@@B@DBB@D@B@@@BAB@B@BA@@BABA@@@@D@B@BAB@B@B@D@DBBBB@@@BB@B@B@@@B@B@@@B@B@B@B@@@B@@@BAD@@@BADB@@B@@@B@B@@@BB@@B@@AB@B@@@B@B@BBBB@@B@@@BAB@@AB@@@B@@@B@DB@@B@@@B@@CD@@@H@B@B@@@B@@BDAB@@@@B@B@@@BB@@BB@@@@A@@@@@@@@@@@A@BB@@@BA@B@AB@@@@A@@@B@@@@B@@@@@B@@@@B@@B@@@@@@@@@@@@@B@@H@@@@@@B@@@@@@@B@@@B@B@@@@@B@B@@@@A@@B@@A@@A@@@@@D@@@@@@@@@BAAC@@AA@@B@@A@@BB@@@BB@@@BABA@@BB@@@B@@D@@@@B@@B@@B@@@BA@@BB@@B@@B@@D@B@BBBBB@@@@@BD@BDD@B@BB@BB@B@@BD@@@BBBBB@@AB@B@@@@BB@BB@BB@@AB@@@BB@@@AB@@@B@@@BB@@B@@@B@@@B@BABA@A@@@@B@@AB@B@B@@AD@B@D@D@@@BB@@@B@@@@@B@@@@@BBA@BB@@@@@B@@@@@B@B@@@BADB@AB@@@B@@@B@B@@@B@B@D@@@B@BBB@B@BAB@BA@ADA@AB@@B@@B@B@@A@BBA@@B@@@B@@B@@BBBAB@B@@ABAB@@ABCBCBABA@@@@@@BA@@@A@A@A@A@@BA@@BABA@ABCB@@@BA@@@@BA@@AA@A@@@A@AD@@C@AB@@A@@@@@AA@B@@A@@BBB@@@BBB@@ABCF@BB@@@BBB@BB@@B@@@BBBBB@@B@B@BB@@B@@@@@@B@B@BB@@@BABAB@BAB@B@D@B@@@B@@B@@@DFBBBD@@@@@B@BB@@@@@@BC@A@ABA@@@AB@F@@AD@@@BB@B@BB@@B@@@@B@@B@@A@@@@B@@@@@BA@@B@@@@AB@@@B@BBB@@AB@BD@@@BA@AB@BAB@B@B@@BBFBB@BA@@B@@@BB@BB@F@B@@@@BB@BB@@BAB@FA@@@@DBB@@@B@B@BABAB@BB@BB@@B@@@BB@@@@BB@@B@B@@@B@@B@BAD@@A@@@D@B@@BB@B@D@B@BBB@BBBAB@@BB@B@B@@@BB@ADBB@BDD@@@ABABAB@BABABA@A@@@A@@@@@@BA@@D@DAB@BABA@BB@@@BB@@AB@D@@@B@@AB@@CB@BA@@@@B@B@D@@@@@@AD@B@@BB@BCD@@B@@B@@DBBA@A@BB@@@B@@C@@@@BA@B@@@@@@@BA@@BB@@@B@B@B@@@B@@@B@D@@@B@@@B@BBAA@@@CAC@@@C@@@@@A@A@A@@A@@A@@DA@A@@AA@@@AAAA@@A@@@A@A@AAA@@B@@@@A@@B@@@@A@@@@BA@@BA@@B@@@@AB@@@@@@@B@@@@@@@@A@@BBB@@@@B@BBBB@@@BAB@B@D@D@B@B@BAB@@AB@@@AA@@@AA@AAAA@A@@@A@@@AB@@AB@@@BABC@@BB@AB@@@@@B@@@B@BA@AB@@AB@@@B@B@B@@B@@B@@@B@@@B@@@BAB@@@B@@@B@@@@@B@@@B@@@@@B@@@B@@A@@BA@A@A@A@A@@B@@@B@BADABC@@D@B@B@@@B@BB@AB@@AB@DBBAD@B@DA@@B@@@@@@ABA@C@@@A@@BA@@AA@@AA@AAAAA@@@@A@@A@@FG@ADEBCBAB@B@B@BBB@@BB@BB@@BA@@B@@A@CBAAA@A@@@A@@BABA@@@@BB@@@@@@B@@@@@B@@@BB@AB@BA@@B@@@BBB@@@F@B@F@B@@@BAB@@@@A@@DBD@D@@@@A@@@AB@B@@@B@@AB@@AB@@@@A@@@@BA@@F@BA@@BA@@@@B@@A@@@AA@BA@A@A@AB@CCAA@@@CBA@A@@@A@@@A@@@@@A@@@@@A@@@A@@@A@@@AAA@@@C@A@@@A@AB@@@@@B@AC@A@ABA@A@@@ADC@AB@@AB@D@DAB@@@BC@A@C@@@A@@B@B@@@@BD@@@@@DA@BBA@@@@B@@@B@A@@@B@B@D@B@BAB@BAB@BA@@@A@AB@@@BA@@@ABA@@B@DAB@BA@ADGBB@@BB@@B@@B@@@@@B@@AB@@@@@B@@@B@@DD@B@@@B@@B@B@B@B@B@@@B@B@BB@@BB@@BABC@A@C@AB@BABA@@@CBA@A@@@CA@BA@@@AAA@AAEB@@A@@BA@AB@@@BAB@@@@A@@@A@A@@@CAAAAAAA@@ACAAA@@BA@@DA@@@@B@@@B@@@@@B@B@B@@@@AB@B@@ABBBA@@@A@@B@@C@@@@B@@@B@@@BABAAA@A@@@A@@AA@@@A@AA@@A@A@@@AA@@AA@A@A@A@A@A@"],encodeOffsets:[[120557,25626]]}},{type:"Feature",id:"350681",properties:{name:"龙海市",cp:[117.818197,24.446706],childNum:11},geometry:{type:"MultiPolygon",
+coordinates:[["@@AA@@@@@@@B@@B@@@@@@@@@@@@@"],["@@@@B@@B@@@AB@@@@AA@@@@B@@A@"],["@@@BBB@@B@BA@@BA@@@@AA@@@BA@@A@@@@A@@@A@@B@@"],["@@C@@B@B@@@B@@D@B@BA@@@A@@@A@@@@@A@@A@@BA@"],["@@@@@@@@@A@@A@@@@@@@@@@@@BA@@@@@@@B@@@@B@@@@@@@A@@@@B@@@@B@A@@@@@@@@@@"],["@@@@@@@BA@@@@@@@@B@@B@@@@@B@@@@A@@@@@@@A@@@@@@A@@@"],["@@@@A@@@@A@@A@@@@@@@@@A@@@@B@@@@@@@@@B@@@B@@B@@A@@B@@@@@@@BB@@@BB@@@@@B@@@@A@@@@@@AA@@@@@@A@@@@A@@@@@@"],["@@@@@B@B@@BBB@@@D@B@@A@@AAA@AAC@"],["@@@B@@@@A@@@@B@@A@@@@@A@@@A@@B@@@BA@@@B@@B@@@@@@B@@@B@@@@@B@BA@@BA@@@A@@@A@@@@@@B@@A@@A@@@@@A@@@"],["@@@@@A@@BA@@BAAAAA@@CAAB@@AAA@A@@D@B@@@BCB@B@@BB@@CD@BB@@@BB@@@BAFA@@@@@@BAB@B@B@BB@@BD@DABADABA@AB@@CB@BA@AA@@AA@A@@@AA@C@A@@B@@A@@B@"],["@@@@@@@@@A@@A@@@A@@@@@ACHAAE@@@@A@BAA@@AE@AC@@BA@A@I@MACRGjQJCJIDQFOhNTBRBZCD@ODC@A@CAQEWBQCKEGGEAEBCDCFAJDTBNAH@JAHEFEBABA@@BC@SBA@@DAB@BA@A@Y@CB@XGJGBEBCACAG@E@GDCHGHGHKBOAMCIEEECEAGAcBA@@AA_AEECGCQAU@A@CAUAOAKCIGGIEIGEGEKCIXABA@@@@B@B@@C@ABA@CBA@A@AB@@@@@@@BI@ACCAA@@H@@@BFBBAJ@@B@BBF@B@B@B@B@@@BB@BBB@B@BB@@@BBD@D@F@B@B@@ABCB@B@@@BA@A@@BCB@@@BAB@@B@@BFD@B@@@D@B@@BBBDBB@@@@@BA@@BA@A@@AA@@@@@BFBF@B@B@@@B@@A@@@@@@DBB@@@@@@D@@@@@BA@@B@@B@@@BAB@@ABCBCBABA@A@A@E@AAABA@A@@BA@A@@@A@@BA@AA@@A@@@@AA@A@AB@@@@ABA@A@@A@@A@AAA@@@@@A@@@A@AA@@AAAAAA@@C@@@ABABAD@@@BB@@BB@AB@D@B@@A@@BA@AB@@@@C@@@AAA@@@A@@@AAAA@@AB@@AB@@@@A@@B@@A@A@@BCBABAB@@@@A@@@@A@@A@A@@AA@A@@@A@AAA@@@@AA@CAA@A@A@@@@@@A@@@@@@@@@B@@AA@@@@A@A@BB@@@BB@@@@@@BA@A@A@@@@B@@A@@@@B@@@@BB@@BB@@BB@B@@@@@BA@@@@@@AA@A@@@@A@C@@@@A@@BA@@B@@@@@@CA@A@BA@@B@@@BB@@@@B@@@@ABA@@B@@@@BB@@@@@BA@@BA@BB@@@B@@@@@@@@@B@@ADA@@@@@A@@@@@@@@@@BB@@@@BA@A@@BA@@B@@AB@@A@@CCA@@@@AAA@@AA@@@@A@@@A@@@A@@AAA@@@CBA@@@BA@@@@A@@@AA@@B@@AA@@@@@@@@BA@@AA@@@A@AA@@A@AA@BAAA@@@@AAC@A@@BA@ABABAB@@@DA@@@A@@@A@@@@BB@@BA@@B@@@@A@@@A@@@@@C@@@@@@BA@@@@@@BA@@@A@@@@CA@@A@@A@@A@@A@@@A@@@AB@@A@@A@@AAC@A@C@@@@@@@A@AA@@@A@A@@@A@@@@@AB@@E@AAA@A@E@A@A@A@@@AAA@AA@@AA@@A@@@A@A@@@A@@@A@@@A@@A@@A@@B@@A@@BA@A@@@@AAAAB@B@BAB@@@BA@@BA@@BA@@BAB@@@@A@ABABA@A@@A@@A@@B@@A@@@@@@C@AA@AA@@AAA@A@AA@@ABEBA@A@@@GD@@@A@@BC@@@@@@@@A@A@AB@BA@A@CB@@@@A@@A@@@AA@@AA@@@BA@@@@B@@@AAAA@@@AABA@@@A@ABABA@CB@@@B@@A@A@A@@@AB@B@@BB@@@B@BAB@@A@AB@@@@@BAB@@@A@@A@@@@BBB@@@B@B@@@@ABA@@B@D@B@@@BA@A@A@@BABDB@@@B@DAB@@@B@@@@@@AB@@@@@B@BA@@@@@@BB@@@@BAAAB@@B@@B@@@@@@B@B@@@@@@B@@@@@B@@@@A@@B@@@@@@@BA@ABA@@B@@@B@@ABCBA@A@AB@@AA@AA@@@A@@@ABAD@@@B@@@@@B@@@@@@C@@@AB@@BB@@@@BB@@@@@@@@@B@@@@@@B@D@@@B@@@@@B@@@BA@@BA@@B@@@@@BA@@@@BA@@@@@@BABB@A@@@@@A@@D@@@@@@AB@@@@@B@@@B@@@BBBA@@@@B@@@BB@@@@@B@@@@B@@@@@ABAD@BA@ABA@A@@@@@ABA@A@@@ABAB@@@@ABA@@@A@@@A@A@CB@@ABABA@A@A@A@@@A@@AAA@@C@C@@@AAAAA@@@A@ABA@@B@@CB@@A@@BA@@@A@@@@BA@@@A@@@A@@A@A@@@@AAA@A@A@ABA@A@A@@@AB@@ABA@@@EB@@@B@B@B@@@BA@ABC@A@CBA@A@A@A@CBA@A@E@ABCB@@AB@@CBA@CBABA@@@@@A@AAB@@A@@@AA@@@C@@@@AAA@A@ACA@ABAAA@A@AA@@@A@@@AB@BAD@@@@CBC@A@A@CBA@A@A@@A@A@@@AC@AAA@@A@@AAA@AB@@@B@@@@B@AB@@@BABABAD@BAB@B@@@B@BA@@@DH@@@D@@@@@BA@@@@B@@@B@@CDA@ABA@A@@@@AA@@@@@@B@@@D@@A@A@@@ABABCDABA@@@AAAA@A@@A@@@A@AA@@@A@A@@@A@A@A@@A@@@@AA@@A@@A@@A@@@@A@@A@@@@A@@@@@@AB@@AB@@A@A@ABAA@@A@A@@B@DCB@B@@@B@@A@ABABCBA@AB@@AB@B@B@@CBA@@@@@@BB@@@@B@@@B@AA@@@A@A@A@@@AAA@@A@A@@@C@AAAA@AA@@@@A@@@@@AAC@A@@A@A@A@A@A@CAA@@@AB@@@B@@A@@BA@@@AAA@@@@@AB@@@B@@B@@B@@ABAHCF@@AB@FADAB@DA@@B@@@B@D@D@D@D@@@@A@@@CCA@C@C@@@B@@BA@@@@B@@@B@@A@@@@@@D@@@@@B@@B@BBBB@@B@@@@B@@A@@AA@@@@AA@@BA@@BA@@B@B@@@B@@@B@@@@@B@@AAA@@AA@@A@A@@@AAA@A@A@C@A@@@A@@@A@@AA@@C@A@@@A@@AA@A@@AAA@AA@@@A@@@@A@AAC@@BABAB@@A@@A@A@AA@@AA@AC@AA@@@ABA@A@AA@@@@A@A@A@@@@A@@A@@AA@@@@C@A@@@@A@@A@@@A@@CAA@AA@@ABA@A@A@A@@@A@@@@@A@@AAA@@@@@@@@A@@AAA@@@@@AAAA@AAA@A@A@E@A@@@A@A@@AA@A@@CC@@@AA@C@@ACA@AA@@A@A@AAAAA@@@A@@@AA@@@@A@@@A@@@AA@@@@A@A@AAAB@AA@@CC@@@AAAAA@@@ABC@A@AAA@@AAA@@A@@AA@@@AA@@@@A@@@A@@@@CAA@@AAAA@@@@@@B@@A@@B@@AB@@A@A@@@@@AA@@@ACACC@A@A@@CAA@AB@@AAA@A@@AA@AA@@ABCB@@@@@@@BA@BB@@ABA@@@BB@@AB@@@@AB@AA@AB@@A@A@@@@@AB@@A@A@AAA@A@@BA@@@@@@B@@@B@BA@@@@B@@A@@BA@@@AB@@@B@B@@AB@@AD@@@@B@@@DB@B@@@BA@@B@@@@B@@@BB@@@DBB@B@@B@@BB@BB@@@@@B@BB@@BABAB@DAB@@B@@@B@@B@BBBFB@@B@B@B@@@BB@@@DB@BFBF@@@BADCB@B@@A@@@A@@B@@B@@B@@A@@@@B@@@@@B@@B@@B@@A@@@ABBB@B@BA@A@@@ABC@@@AB@AA@@@@@@BA@@@AAC@@@@@AA@@@ABAA@@AA@@@AB@@A@@@@A@@@AA@@AA@A@A@@@@B@B@@@@A@AB@@CAC@A@@@A@@@A@AB@@@@@B@@@B@@@@@B@@ABADABA@@@AB@@A@@BAB@@@@@B@@@BA@@B@@AAABAAB@@AA@@A@A@AA@BA@@AA@@B@@A@A@AA@A@A@@AAAE@A@@@@@A@@@A@@B@@A@@@A@@@@B@@@@@@@@@B@@@B@@A@@BA@AA@@@AA@@@@AA@@AA@AA@B@@@B@@@B@BA@@@@B@@A@@BA@AB@@A@@B@@@B@@@B@@@B@@@B@@@@@B@@@@@@@BB@@@AB@BB@@BB@@A@@BA@@AABC@@@A@@@@@@A@D@@@BBBBB@@@@BA@@BB@@BB@AB@@@@@B@@ABA@@B@@@B@DA@@@@BAD@@@@@@@BBB@@@@@B@@A@A@A@A@@@A@A@@@AA@@@ABA@A@AA@@@AA@@@BA@ABA@@@AB@@AB@@A@AB@@AA@BA@A@@@@@@AAB@@@@A@A@@BA@@@@B@@A@AB@@A@A@A@A@AAA@BB@@@B@@@BB@DB@@@@@B@BABB@@B@B@@@BA@BB@@@B@B@@@@AB@B@B@@@@AB@@@BA@@BC@@@A@@BE@C@@B@@@B@@A@@@C@A@@@@@A@AD@BAB@@ABB@@@@BBBA@@B@B@@A@BB@@@@@BBB@@A@AB@B@BA@@B@B@@@B@B@@A@A@@@AB@@@B@@B@@BB@@B@B@B@@@B@@@B@BAB@B@D@@@@D@B@@@BB@@@BBA@@B@@@B@@@@@AB@@@@@B@@@@ABBB@B@@AB@@ABBF@@@BBBABB@BBBB@B@@@BB@@@@@DB@@@B@@@@@@BB@BB@@@B@B@B@BBBAB@B@@@DB@@BAB@@B@@DB@BB@@@DBB@@@@B@@@BA@@@ADA@@@@B@@AB@@A@A@@@CBAB@@A@AB@@A@@@@@A@@BA@@@A@@@@BAB@@AB@@@@@B@@@B@B@@@@A@@@@@@@@@@B@@@@A@@@@@@@AB@@@A@@@D@@@B@@@BB@@BB@@@D@@@BB@@A@@DB@@@@BA@@B@BA@@@@B@BAB@B@@A@@@AB@@A@@@A@@B@@@@B@@@ABA@CBB@@@BBBBB@@@@B@@B@BB@B@@@B@D@@@BB@@@B@@B@B@@AB@@@B@@@B@D@B@D@@@@D@@@@B@@AB@@A@@@AB@@BD@@@@A@@@@B@@@@B@ABBB@@@@@@@B@@A@ABA@AB@@@BB@@@@@@@AB@@@@BB@@BABBAB@@@B@@@@CBAB@@@@@@@B@@@B@@B@@B@@@B@@BB@@@B@B@@@BB@@@B@@@B@BB@@@B@BBB@@@B@@B@@@@@BBBB@@B@B@@@B@BA@@B@B@@BBABB@@@@@@@@B@BAB@@AB@@@@AB@@A@@BA@@BA@@B@BA@B@@B@B@@ABAB@B@BA@A@A@A@A@A@@BA@@@@@@A@A@AAAA@@A@@AAAB@@@@@B@B@@@B@B@@@BB@@B@@@@AB@BA@@DC@A@@@ABA@A@@@@B@@AB@@@B@@@@@B@BA@@@@@@@@B@BA@@B@D@@@@A@@B@@@@@B@@@@AB@BA@AB@BDDABAF@`A@@@B@@B@ABBB@@@@@B@@@@A@@@AB@@A@@@@B@@@B@@B@BBB@BDB@@@@@@B@@@BB@BB@B@@@B@@D@B@@B@B@@@BBB@B@@BB@BB@@@@@BBB@@@@@@@BB@@BB@B@BA@B@AB@@@@@@@BB@BB@@B@B@@B@BBB@@@B@@@@@BA@@@BFDB@BB@@B@@@B@@@BB@@BB@@BB@@BB@@@@A@@DBB@@@@BA@@@@@@B@BB@@@BB@@B@BA@@B@@@ABA@FD@A@A@@AAB@@@DA@ADABAB@@@B@@@DAFA@@@@JC@@B@@AB@B@@@BBDAHAFAD@B@@@B@@@@A@A@@A@@@@AA@@AA@@@@A@@@C@@@@C@@@@@A@@@@@A@@A@@@@@@@AA@AB@@@@@A@A@@@@@AB@B@@@@A@@B@B@@B@@B@D@@@@@BB@@@AA@@@@AB@@@@@@@@@@@@A@A@@@@@AA@@AB@@@@@B@@AB@@@@A@@@@@@AAB@@@B@@B@BB@B@B@@B@@@@BADA@@B@@B@@B@AADA@@@@B@@@@B@@B@@@B@@@@B@B@@BB@@@@ABB@A@BB@@@@@A@BB@A@@@B@@B@@@@@@AB@@AB@@B@D@@BB@@@DDDABABCBAB@@AA@@@BABAHCBAB@B@D@B@DBD@DBHDD@BBB@B@DBDDB@D@DAD@DAB@BADAB@@@@@DB@@FBD@B@B@DAB@BCB@@ABADA@@DCFCB@BADABA@@D@B@B@B@B@@@D@BB@@B@@BB@D@ADBB@B@@@B@@@@DB@@BB@D@@@@@B@@@@BBBDBB@@@D@@ABAB@@B@B@@@@BA@@BA@@@@@BB@BBBBB@@AB@BB@@@BA@@BB@@@B@@@@@@@B@@A@@@BBB@B@@AB@B@@@@@B@@@@@@@@@BB@@BD@@BB@@B@@@@B@B@@B@BBBA@@B@@@@@B@@BBBB@@@AB@B@@@BBB@@@@BB@@BB@@@B@@@@@@@@AB@@@BABB@@@BB@@B@@B@@B@@@B@B@B@DB@@@@BAB@@A@BB@@B@B@B@BB@@@@B@BB@@B@@BB@@@B@@@BB@@AB@DB@@B@@@B@@B@@@@BBB@@@@B@BBB@B@@B@B@BA@@BBB@BB@AB@@@BA@BB@DBB@@BBBBB@B@BB@@@B@@B@@@@BB@B@B@BA@@B@@B@BB@BB@@@@B@BAD@@B@@@AB@@@B@@BBB@B@B@@B@B@@B@B@@B@@D@@@A@@B@@BBD@B@D@B@B@@@BBB@@BB@@@@B@@BDB@@@B@@@B@@@@B@@D@@@BB@@@@@@@B@@B@@BB@@@BAB@@A@@@AB@@@@ABA@A@A@@B@@@D@BBB@B@@@B@@@B@B@@@B@F@@BB@@AB@@@@@B@BB@@B@@B@@@@@@BB@@@B@@BB@B@@@@@@@D@@BD@@BBA@B@@@@BA@B@@B@BB@@@@@@@@B@BA@@@@@@BB@@BB@@@@@@@B@B@@@B@@@BB@@B@@@@@B@@@@@B@@@B@@@B@@A@@@AB@@@@@B@@BB@BB@B@@D@BBB@@@@A@@@ABA@@B@@@@@B@BA@@@@B@@@@@BADA@AB@@ABAB@@ABA@@B@@AB@BA@AB@@AB@BAB@@@D@BABBB@@A@ABA@AB@@CBA@A@@@A@@AAA@CC@@@AB@@@@AAA@@@A@@@@@A@@A@B@@@@@@@AA@@@AA@@@@@@@@A@@@@@@A@@A@@@@@@@@@@@@B@@@@@@@@@B@@@@@@AA@@@@A@@@A@B@@B@@@@A@@@@@@@@B@@@@@@@@A@@@@@@@@B@@@@A@@@@@@@A@@AB@@@@@AB@@@@@@AB@A@BAA@B@@@@@@A@@@@@@@A@B@A@@@@@@@@@@@@@@@@@A@@@@A@@@A@@@@A@@@@@@B@@@@@@@@@@A@@@@@@B@@@@A@C@@@@BCA@@ABA@@@@B@@A@@B@B@B@@@@@@@@A@@@A@@@@@@B@@A@@@@@@B@@@B@B@@@@@@@@@@BB@B@B@BA@@@@@@BA@B@A@BBA@@@@@BBA@@@@BA@@@@BB@@@@@A@@B@@@@@@@@@@@@B@@@ABB@A@@@@@@@@@@@@@A@@B@@@@A@@@@@A@@@@@@BA@@@@B@@@@@@@B@@@@AA@@@@@@@@@@A@@@@@@@@B@@A@@@@@@B@@@@@BA@@@@@C@A@AAA@@@A@@BAB@@@@A@@BCB@B@DADBBAD@B@@@@A@A@A@A@@@@BA@@AA@@BA@@@@B@@@B@@@@@B@BABABABAB@@@B@@@@@B@B@@A@@AC@@DCBA@@@@@CBA@A@@B@@@@AB@@@CE@@AC@@@A@@@A@@@@@@@@AA@@@@B@AA@@@A@A@A@@@@@A@@@@A@@@@@@@@@@@@@A@@@@@@@@A@A@@B@@AA@"]],encodeOffsets:[[[120921,24767]],[[120918,24768]],[[121075,24739]],[[120861,24766]],[[120924,24947]],[[120975,24931]],[[120919,24946]],[[120963,24945]],[[120972,24909]],[[120991,24909]],[[120791,25062]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
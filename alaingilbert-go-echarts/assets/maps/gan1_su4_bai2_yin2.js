@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("白银",{type:"FeatureCollection",features:[{type:"Feature",id:"620402",properties:{name:"白银区",cp:[104.148556,36.535398],childNum:1},geometry:{type:"Polygon",coordinates:["@@FCBA@A@@@A@@@AAA@@AAEAC@C@C@C@A@GBC@AAC@A@AAABA@AB@@AB@@AF@BAB@@C@A@G@@@AA@@ACCC@AACAC@A@@AACC@ACACAEAEAA@AA@@@AA@@ABA@@@AAK@C@G@A@@@AAA@@@AAA@@AAC@@@A@AAGCCACCEAEAC@@@@AAC@AACAAAC@AAA@ABC@A@A@AACAEAEAA@@AA@C@A@@AA@ABA@ABA@A@AB@@AB@@AAAAACAAA@@AC@@ABABCBABA@ABA@@@EDCBABC@@@A@AB@@@@B@F@@@B@BBBB@@@@@BABABAB@@@B@B@B@@AD@@@@BD@B@B@@BB@B@D@BB@@@B@@BBB@@BB@BB@AB@BB@A@AB@@@B@@BB@@@DDD@BBD@BBB@BFDDD@BA@@@@B@@A@CBA@G@AAC@@BA@@B@@@@A@A@@@AB@@@AAB@@@@@B@B@@BB@@BBB@@B@@@B@B@@@B@B@@A@@@A@@BA@@@@@A@@@ABDD@@@@BB@@AB@@ABC@@@AB@@@B@B@BDB@@BBB@@@@@@@@@@@BB@@B@@BBA@B@@@@B@@@B@B@@BBB@@BA@B@@@@@@B@@@B@@@DBBBDB@@@BBB@B@BA@@@@BAB@BA@A@A@AB@@A@ABA@A@@@AB@DA@A@@@@BB@A@@B@@AB@@@@A@@BA@ABCBA@@@A@@@@A@@@@A@@@@B@@BB@@@BA@@@@BA@@BAD@BA@@B@@A@@BA@@BCDA@@B@@@BA@@@A@@BEH@B@@@B@@@B@B@B@@@B@BBDA@@B@@A@@@@B@@A@@@@@A@@@C@A@A@AA@@C@@@@@@@@B@@CB@@AB@BA@@@@BAD@@@B@@@@@@A@C@ABC@A@@B@@@BAD@B@@A@@@A@A@@@A@AD@@ABA@@@BB@@ABABAB@@@BB@@@@@AB@BA@@@@@@@AB@@@BCB@@@@@B@@DBBB@B@@AB@@C@A@ABA@@BCA@@E@C@@@@@AB@BBBBDBB@@AB@DADABAB@B@BBB@@@@ABAB@@@@@B@BB@@B@@A@@B@BBB@B@B@@@B@@A@A@AAA@@@@@ADABA@A@@@@@@BB@@BBB@@@BB@B@@B@BAB@@@BBB@F@@@BCB@B@B@@@@B@@ADBB@BBB@@@DB@@@@@BBD@BBB@@A@A@AB@BABAF@D@BA@A@@@ABABAB@B@B@@@@BBA@@B@@@@@@@BEBA@CB@@@B@@C@A@@@A@A@@@A@@@C@AB@@A@@@@@@B@@@@A@AB@@A@A@@@ABCB@@@B@BA@AB@BAB@@@BA@@BB@@@@BBBBB@BBB@@@@@BABAB@@@BBD@BBBAB@@@BB@@BB@@D@DBD@B@@@D@B@@@BB@@@@BB@@B@@@@B@B@@@@B@BB@BF@@@@B@BA@@@@@BBBBDBBA@BB@BBB@@@@@BA@@BA@C@A@CF@@AB@B@@@BBB@@@@@B@@BDBBB@@@CB@B@@@FBB@BBB@@DBBB@@@B@DBBBB@@B@B@BB@@@D@@BBB@@@BBB@@B@B@B@@BB@@@@@BABA@EAA@A@@@A@A@@@AB@@C@CAG@C@C@A@ABCBCDA@@@@@DDBB@BABA@A@C@@@EAAA@@@BA@@B@@CDA@A@AB@A@@A@@AA@@@CB@@@@@@ACAAAAA@AA@@A@@BA@@@CA@BA@ABA@A@@@@B@@ABA@A@A@ABC@A@GBA@@DABA@CBABA@@@A@@BAB@BBB@@@B@@CB@@ABA@@@A@@F@@@@AB@@@@@B@@BB@@AD@@@@CBABB@@BBB@BA@GBA@@BC@@@@B@@@@@BBB@@BBB@@@BBBD@B@@BD@@@BB@@D@@B@@@B@B@@B@@@@@B@@EBABC@ABCDA@EDA@@@AB@@EBABCB@B@@@@@@AB@BGDA@@@@F@@@@CBCBEB@BABA@@B@D@D@BAB@@CBA@EBCBE@ABC@EB@@@@AB@@E@@@@BBB@BB@BBBB@@@D@@A@ABA@C@@@CD@@C@A@@@@@BBB@BBDBBBBBB@@B@@@DBB@BAB@@@B@B@@@@@B@@@BB@@@BBD@@@B@BBFJ@@CBA@A@@@A@@@A@AD@@AB@@@B@@A@EF@@C@@@AB@BCBC@@B@@@BB@@B@@@B@@A@@@C@@B@@@@ABA@@B@@@@@B@@DBBB@@BDDBB@DBB@BBF@BB@@BBB@B@BBFBDBBB@@B@@ABA@@BC@@B@BAB@BBB@@@F@B@BAB@@B@@AB@@@BDAB@@@BB@BBB@@BBD@B@B@B@BBFB@@B@BBBBFFBB@BBB@@BBB@@@DBB@BBBB@BB@B@RAD@B@B@@@BBBB@DB@@@@@DA@A@A@@AA@@@@A@@@AA@@@A@@@C@@C@@@@A@A@AB@@@@@@C@@B@B@@@@A@@BC@@@@BBB@B@B@D@D@BBBAB@B@@@@B@@@B@@@B@@BDBB@BA@@B@BB@BFB@A@@DABBB@@@@HDDB@@DB@@@@@@@BADA@@BBB@@@BBBD@B@FADAB@L@@@BA@@H@BAB@@@BAB@H@HABBD@DBB@@@BAB@@AB@@@D@@@B@BB@@@B@@ABBB@@@@DD@@B@@@B@BAB@@@B@@@@@@B@@B@@@B@B@@B@@@B@@B@@@B@B@B@B@B@B@@@@@@B@DA@@B@B@@@@@@D@H@B@B@@@BAB@@@B@@BB@BBB@BB@@@@@B@@@BBADCB@B@BC@AB@@@B@@@@@B@@@@B@@@B@@DA@@@@@B@@@@B@@@BBB@@@B@B@B@@@BB@@@@@A@@B@@@BB@@@B@@@@B@@@@@B@@@@@@@@@BBD@@B@@BB@@B@B@@@B@BB@B@@@@@@@B@@@@BB@B@@@@DB@@@B@@@B@@BB@@@@@BB@@B@@@AB@@A@@BB@@B@@@B@B@BB@@B@B@B@@@BBB@@@BB@@@B@@@AB@@B@@BB@@@B@@B@@@B@@@DBB@B@@@@BB@@@D@p@@@BABA@@B@@@BA@@BB@@BB@@B@@@B@B@@@D@BBB@BAD@HBB@D@@@@B@@@@B@BAB@B@B@@@B@@@B@B@@@B@@@@@@@B@B@@@D@DAB@B@BAB@B@@AB@@AB@@@BA@AB@DCBA@@B@B@B@B@@ABADA@AB@@@BBB@B@F@@BB@BAB@@AB@BA@@BA@@B@@ABA@A@@@AFC@A@A@@@AB@@A@@CA@AA@CACA@@@@ACCCAC@@@@@AAA@@AC@@@@@A@AAAA@@A@@B@@@@AAA@ABA@@@@BA@@BA@@B@BA@@D@@@@@@@@A@@@@@A@@@ABA@@AA@AB@@@@ABBB@B@B@@@FC@@B@BB@@B@BB@@@@BA@BB@@B@@@BB@@@B@B@D@@@B@@BB@@@@B@AB@@@@AB@@@B@BB@@B@B@B@@@B@@@B@BB@B@@BA@@B@@BB@@@@@BA@@B@@@@B@@@@@@B@@@@AB@@BB@@@@@@@@@@@B@@@@B@B@@B@@BB@@@BBB@B@@@B@@@DA@@B@@B@@BAD@@@BAB@BBB@@@@AB@@ABA@@@C@@B@BAB@BA@A@A@@AA@@@A@AA@@AAA@@A@E@CAC@@@AA@@AA@ABC@ABCBEBA@E@CBEAA@ABA@@BABAB@BA@@@AAAAA@@@AB@BA@@B@@ADA@A@A@@@AB@@A@@@A@@A@A@@A@CB@@AB@@A@@@A@@B@B@BA@@@@@AB@@@@@@AB@@A@@B@@@B@B@@@@A@@@@B@@@@@@@@@@@@A@@D@@AB@@B@@B@@@BA@C@@@AB@BA@A@@@C@@@@AA@A@@A@A@@@@@CBA@A@@ACCAA@A@E@A@@AAAA@E@AAA@@@C@AAA@ACEAA@@@AA@@@A@AA@@@@A@AA@@A@@@A@A@AB@@A@C@@@AAACCC@@@C@C@@AAAC@AAC@C@A@K@C@CBABA@ABAB@DA@ADCBABADAHGBAB@BADAB@BA@@@@BA@@B@@@B@@@@ABA@AB@@AB@@A@A@@AC@@@AAC@@@AB@FEB@B@@AB@@AB@BABA@@BB@@D@@@BB@@@@@@B@@A@@BA@@@@@A@@@A@AB@BA@@@A@CBCA@@A@@@A@@BABC@@@A@AAA@@@@BADEBABCBA@@BA@@H@@AB@@@@AA@@@@A@AAA@A@AB@@ABA@A@AB@@AB@B@@@DA@@B@B@B@@ABADABABCBEB@@@B@@A@@BE@A@@BA@A@@BA@@BE@C@@BABABC@@@C@@B@BABAD@@AD@@@@A@@@@B@BA@@@@@A@A@@BAB@@A@@@@@@DA@@@@@AB@@@B@B@@@@A@@@A@@@@@@@@@@@ABA@@BA@@@AB@B@@@D@@A@@@AB@@B@@@@@@DA@@@@@@@@@@@@D@B@B@BA@@@B@@@@@@B@@@@B@@B@@@B@@@@@B@@@BABBDA@@@A@@AA@@@@@@B@B@@@@@@C@@BA@@B@@@B@@@B@@@@AA@@@@@@AB@B@@@B@@A@@@@@A@@@AB@B@@@B@B@@@@B@@B@@BBA@@BA@@D@@@@A@AB@BA@@BA@A@@@AB@@@BA@@@@@A@@@@BAD@DA@@@A@@@@B@@@@@B@@A@@@A@@@ABC@A@@@@DA@@@@@ADGBGBA@@@AA@@ABI@E@A@A@@DCDE@@CACAICA@CAAAA@A@CAEACA@@CCACAC@C@C@A@ABE@A@AHGJGBCBC@ADE@AB@@AAC@A@@ACA@AAA@A@AAG@@@A@AAA@A@EAA@CBCAEACA@@C@CAC@EAGAA@CAC@A@C@C@A@A@CAAAA@AAC@@@A@@@A@@@A@A@ABA@@@A@@BA@CB@@AAA@GBEBC@A@C@G@AAAA@AAA@A@A@AAE@A@AAC@AAACA@@A@AAIECAAACACAA@CAE@IBG@E@EBCBEBEBABABIBC@A@ABA@AAEA@@C@AAMACAEAC@A@E@C@E@A@EACACCCA@AAA@A@@@C@@@ABA"],encodeOffsets:[[106721,37218]]}},{type:"Feature",id:"620403",properties:{name:"平川区",cp:[104.825208,36.728304],childNum:1},geometry:{type:"Polygon",coordinates:["@@BA@ABA@A@@@A@A@@@ABA@ABA@@@BB@BA@@@AB@A@@A@@@AB@@@BA@@@ABA@@@AB@@ADA@@D@@@@AB@@AD@D@@AA@@@@AAABCDCD@BABAAA@ABC@C@A@A@@DAB@@ACB@@A@@BCBCDABEFABIFCBABA@@@A@@ACAA@CA@BABCB@BA@ADABABABA@@@@@ADAB@BABABCBA@AD@@A@@@@AA@AA@@@C@@ACEE@AEEAAC@CAAA@@@A@A@G@A@C@@A@AA@@ACA@@@A@@@AD@B@@A@A@@BA@@@@@A@A@A@AAA@A@@@A@@@@@@@@@ABA@ABA@@@A@@AA@A@@@A@@@@@@@AB@@@BABAB@@@@@B@@@B@B@B@@@@@@@B@@@@A@A@@@A@@@@@AA@@@@@ABA@@A@@@@@@@@@A@@@@@AB@@@@@@A@@@@@@B@@@@@@A@@CA@@A@@@AB@@@@AA@@@@@@@@@AB@@@B@@A@@@@A@@@@A@@B@@AB@@@@A@@@@@@@A@@AA@@@@A@@@@@A@@BA@@@@@@@AA@@@@@@@AB@BABAB@BCBABABABAB@BAB@B@BA@AB@@@@C@A@A@@@@@@@A@@B@B@@@F@@AFAD@BA@@BC@AB@@@@@D@@A@@B@D@@BB@@@F@B@@@D@DA@@BAB@@AF@@@B@@AB@BA@@@A@@@@@A@@A@@@@A@@@@B@BA@A@CBA@@@EA@@A@A@A@A@AAC@A@@@@@AB@B@B@F@BAB@@AB@@@B@BABA@@B@B@F@@AB@D@@@BA@C@@BA@@D@@@BA@A@A@@@A@@BA@@BABA@@B@B@@AB@@ABA@@B@D@B@B@B@@@B@@@B@B@D@B@@AB@@@@AB@@C@@@ABCB@@@BAB@B@@A@@AA@A@AB@@@@A@@@@B@BAAA@AAA@AAAAAAA@A@@@A@@@@A@@A@@@@@A@@@@@C@A@@BA@@@A@AA@@C@ABA@@BAB@@ABAB@@@BA@A@A@@A@@AABA@CAF@BA@@BCBA@CBE@C@@BA@AB@HA@@BEB@BC@A@@BABA@@@@D@B@@A@GFKHIJIHGHCDEFAB@@@BAB@DA@@@C@EBA@C@CBAB@@@B@@@DBB@B@BADABCHADCDEHEFIFABA@C@K@C@A@CBCDIFIHAB@B@B@D@F@FBB@B@BBBDD@D@B@@ABA@I@C@GBA@A@A@@BABAD@BAB@@ABA@G@ABA@@@@A@A@AAEAAA@AAAAE@A@E@C@CBCBGDCBADA@@@AB@@CBC@CB@@C@I@CAA@A@I@A@EBGB@@@@AAAAAAA@C@A@CBOFEBA@A@EBCB@@CHADAB@@ABA@@@A@CDCBCDABABAB@@@BCB@@@@@@AB@@A@@BCDABEB@@ADAB@@OJCBC@CBA@A@@BA@AFAB@@AB@@C@A@GBA@C@A@CBC@A@A@A@@@CAAAAACA@@EBA@A@AACACAA@CC@AAACCA@@AACAA@AACA@EECACA@BCDABA@ABE@C@EAA@AAA@AAKGQK@AA@CAA@@@CAA@AACACAA@@@A@A@A@A@A@@AA@@@A@A@CDCD@@@BAB@B@B@BBB@D@B@D@BAD@@BFBL@BAFBB@DBB@D@@@BAB@DAD@B@B@@DL@B@@@DABCD@BA@@@CAE@@@ABG@AB@@A@A@ABA@ABABABAB@BABCDADCB@@A@@BABA@EJABAD@DAB@F@F@J@@BBD@BBBB@@@@@F@D@B@FBB@D@BA@CH@B@JADAB@BDL@F@BABIBOKICSABJFFHJHJIDABCDCDCBABA@C@C@C@IAA@ABADGLABAB@B@@AF@BAD@@EBABCBABEBABABABCD@@AF@J@B@H@HAH@BABABGDA@ABC@I@I@A@AACAAA@@AAAICK@ACGACEOEIAGAA@@AC@CEKCE@AAAMD@@GDC@@BE@A@IDA@G@A@CBC@KDABAB@@AD@B@B@B@D@@BF@D@BAB@BCDCBG@EBA@ABC@ABABCBC@EDEDABA@@B@BAB@BED@BEDEFCBA@C@ABCBC@ABCBA@ABADADABCLABA@ABA@ABCDA@ADADA@AB@@A@@@AA@@@@C@AAA@ACCAAAA@@AAA@A@@@@@A@@@@A@A@@A@@A@@@@@@@ABB@@@AB@@@@AA@@@@@@CB@@@@A@@A@A@@@@A@@@A@@@A@@AAA@AA@@@A@CA@A@@@@@AA@AB@AA@A@A@@@@A@@@@@@AAAA@@A@@BA@@@@@@A@@@@@@ABA@@@@B@@@@A@@CAAA@@@A@@B@@@@A@@@@@@B@@@@@@AAA@A@@@A@AB@@AB@@A@@@A@@@A@@A@A@@@AA@@@AA@@A@EC@@A@A@@BC@ABABADAB@@EB@@@BAF@BA@ABEDA@@BAB@BAB@BCBADA@ABADAB@@A@EA@BA@@@@@@D@B@BABA@ABC@ABA@CAAAA@A@I@A@@@@BA@@@C@E@A@@BA@@@A@A@@@A@A@@@A@@@A@@@A@@@A@ABA@@BA@A@ABA@C@CAA@EBC@@@@AC@@@@@A@@ACAA@CA@BA@@BA@@@A@ABC@C@AAC@AACAAAACA@AEAEAC@@@A@C@A@@@AA@AAAA@@@BA@ABCFABAB@@@D@B@@AB@@@B@B@D@BBDABBD@@@B@B@B@@BBBB@BB@@B@D@B@BAB@BCDAB@@@DAFADAB@BABGDA@EBA@@BABAB@@C@A@AB@@@B@@@@@BBBB@BBB@DBBB@@B@@@@B@B@B@@B@@B@@@BB@@BBDAB@BADAD@D@F@D@BBDBDBBBBBDHJF@D@HBFBD@D@D@JBDBB@DBB@B@HBBBB@@@FAD@LALADADAFAD@DAF@DAFAB@B@F@FBDBD@@AB@D@BB@@@B@B@@@B@@BBB@@@@D@B@BA@@@A@@@A@@@@@@@@@@B@@@BB@@BBBDD@DBB@BBB@B@@@F@B@@@BBBHH@B@B@FBBBDBB@B@B@@@BAD@@BDBBBBBB@B@@ABCA@@A@@B@@@B@B@FBD@BA@@BAB@@BFAFBB@@BBBBFBBDB@@B@BAF@BBDBD@@B@@@J@F@@@BAHEBAB@DAB@D@DAD@B@@@BABABAB@@@B@BDDDBB@BBBBBBBB@B@@@D@BAD@B@B@BAB@@BB@@B@B@@ADAB@B@@@B@B@B@B@@ABABAB@@ABAHADAD@@ABAHAB@BABBFABAD@@ABAB@B@@@BBB@@DBFBB@B@B@FAHAD@HAB@HAFCDAF@PCLCB@DA@@DCDA@@B@D@@@BBDDBBBDBB@@BB@@D@D@BAB@DC@@B@D@D@BBDB@BBBB@BBB@D@BA@@D@BB@@B@BBB@B@@AB@@@B@@@BB@ADAB@@@B@B@B@B@B@B@B@@@DBB@@@D@B@B@B@@BB@BA@@@@B@@BB@BBB@B@@@DA@@@@DDB@@@B@@@BA@@B@@@B@NDBBB@B@BAB@@AB@B@BBB@DBB@J@@@DBDBD@B@F@B@B@DBJ@B@F@BA@@B@B@B@DCB@@@LDDBB@D@N@B@DBB@B@BA@@DC@A@A@A@AACBA@ABC@C@ABA@ABA@@B@B@DBDBD@BBDDHHFDFBHBD@F@D@B@FCBA@A@@@AAAAACC@@@AACBA@CBCDC@@B@BA@@B@@C@ABCBEBC@A@C@A@ACCAAEGAA@@@C@A@AB@D@B@NAD@B@BBFDDDFDH@NFD@FBD@D@DAD@B@HEDABAFEBABE@A@@@C@A@A@G@C@@BM@A@@@CAC@ACAEEAAGIAACCACAAA@AAA@@@@AGO@C@A@A@ABABI@CDC@A@@@A@@ACCK@ACAAEAA@@AA@@AACACA@@AA@AA@@AA@AAGBAACA@@@ABCBCBADABCDAD@FADAH@B@DADADADADA@@D@B@@@B@DAHABAB@@@DAB@BADADAB@@@BAB@@AB@BA@@LAD@B@FB@@BAFAFC@@BBDDDBBB@@B@FG@@B@BA@@DADC@ADAHCFA@@B@@@@@FFDBB@FBB@B@@@BAFABA@ADC@@BA@@B@J@F@D@D@B@BABABA@ABC@@BA@@D@B@BAD@D@B@DAD@@@JAB@FAB@B@DCB@@ABADA@ABABC@AA@AC@@@@BA@A@@BA@A@@BBBBB@B@B@@@D@DAD@B@B@BAB@@@D@B@B@@@@@BABGBC@A@A@@@@CC@@AC@ACE@CAA@A@A@A@AB@@AB@B@BAH@FA@@P@B@DAHAB@DAD@B@BADADABADAB@B@D@F@@BDBBB@BB@@@B@@@FABCDABC@@B@B@B@DBB@@@JCBAB@@@BBB@BDBBDDHFBBHD@@B@B@B@B@@@B@BBB@@@@AB@DAB@@AB@B@B@B@B@B@@@B@B@BAB@FC@@B@B@B@B@DC@AB@@A@@B@BAB@BABC@A@AB@DC@AB@@@BB@@B@@A@@DA@@B@@@@@@@BABABA@@D@@@@@BABC@ABA@@B@@@B@B@@@BB@@@@B@@@B@@@@@@@D@@@BB@@B@@@BA@AB@B@B@@@@@@@B@@@FAB@DCDABADCDAB@@C@@@AB@@ABAF@BCB@DA@@DC@AB@B@BAHAB@DAFADCHCBAB@@@BA@@AA@AAABC@A@A@C@A@CBABCBCDE@ABC@ABCBABADCDCFCDCDCBADCBABABCBA@A@AAACEAE@MCEACBC@A@A@@@@CA@AAA@ABADADBD@B@BCF@BA@CDAD@D@FBBA@A@C@M@C@AF@@@BCBCBCBCBC@@BCFBB@B@FBF@DCLIBC@AAAA@CCAA@@BCBABCBEDABABABABA@CAAAE@A@ABCBABEAAA@C@C@CAAACCE@CCA@CAEACACEAA@CAAB@B@D@DADAB@@ABA@C@C@ABA@A@ABA@@B@@@@@BBB@B@BABABABA@@B@B@BBB@@AB@@A@A@@@ABABAB@@@B@B@@BB@@@BA@@@@BA@A@AB@@@@@DB@BDBB@BBF@DADBD@BAB@BABAD@DBBBBBBD@@BBBBDBBBFBDBDBB@B@B@BADABCBEDABABABA@ABAB@DABEBADCB@B@BADABADCDCBAFCDCDCDCDABABCBCBCBCBC@ABC@C@ABC@CACAAAE@E@CDEBA@AB@B@@A@@@A@@@AAA@C@EACAAAC@CAAACACAC@ABA@CBABABA@@DCBABB@@@B@F@BB@B@DBFBD@BBD@D@BB@AB@DABEDABABAB@FABCDA@E@AAC@CAC@CAG@A@C@E@CBC@CBA@@BC@C@C@C@CBCBA@E@@B@B@FAF@F@D@BCDCBCBA@ACAAC@CCAAAC@A@A@A@A@C@A@C@A@A@@@@A@AB@"],encodeOffsets:[[107932,37241]]}},{type:"Feature",id:"620423",properties:{name:"景泰县",cp:[104.063091,37.183804],childNum:1},geometry:{type:"Polygon",coordinates:["@@DBDBB@@BDB@@@BA@@B@@@B@BED@B@@@BAB@BA@@@AB@@ABA@@BA@ABA@@AE@A@A@AA@@A@@BCBAB@BA@A@A@A@@@ABCDA@@BAB@@A@@BA@@BA@A@ABA@A@CBC@@@A@A@@@@@@@A@@@A@A@@@A@@@A@A@A@ABA@@@@@@A@@C@A@GAC@ABA@AAC@@@A@A@@@A@@@AA@@AA@@AB@@A@@@ABAB@@o@C@@@A@@A@@A@A@CA@@A@@@A@@@@A@@AA@@@AA@@B@@@A@@AA@@A@AA@@A@A@A@@@AAA@A@@@A@@@AA@@@BA@@B@@@AA@@A@@@@AA@@@A@@@A@@CA@@@@@AAA@@@@@A@@@@@@@AAAA@@@A@A@@@AA@@@AC@AA@@@@@@@@A@@@@@A@@@@@@A@@AA@@A@@@@B@@@@AA@@A@A@A@@@A@AA@@A@@@@@@A@@@@CB@@@A@@@A@@A@@@@@A@@@A@@BADA@A@CDAB@A@@@A@@@@AAA@AAA@@AA@@@A@AB@@A@A@G@C@@@@@@@@A@AB@@C@A@@@@A@A@A@A@A@A@@@A@@@@A@@@AA@A@@@A@@@@A@@@@A@@@A@ABA@@@A@@@CC@@@@AABA@@@A@@AAA@@@C@@@A@@BA@AB@@A@CAC@AAGBG@A@AB@@A@ABG@@@AB@@K@A@CBEBA@C@AA@A@@AA@AB@BC@A@@@@@@CA@@CAGC@@@@AABA@CB@A@AEA@@A@AB@@AAAAC@@@A@@@A@@@A@@A@A@ABAAC@C@A@A@A@AA@@@@AD@@@B@@A@A@@@@D@@@@A@@B@B@B@@D@@@@D@@@B@@BB@@B@@@@@BB@@@B@BCB@@@@A@@CAAAA@@A@A@C@QBA@A@@AAAAAA@CA@@A@AA@@AA@AAAEEAAAAA@@@EAAAA@A@A@C@AA@@@@ABABA@@B@BBB@DB@@@@B@@@D@@BBBB@BBB@@D@B@BB@@BBB@@B@D@D@B@B@@@B@@A@ABA@@@A@AAA@A@C@E@A@@BEBABEBAB@@@@@BB@@BAB@BAB@@@D@@@@BBDD@@@@@B@@ABABABABC@A@AB@@@BA@@@A@ABADA@AB@BA@@@@AA@@@A@A@@@A@@CC@@@A@A@@@A@@@@@A@AA@@A@A@CDCB@@@D@B@@@BAB@@CDAB@@@B@BB@AB@B@@BB@BB@BBBBHBDBD@B@DBB@@@B@@B@D@DAD@B@BAB@B@@ABAB@@AB@@AA@@A@AA@A@@AA@@@@ABABCAA@@@A@A@@@@@@B@B@@@@A@ABA@E@A@@@@@A@@BA@@@@@@B@@@@ABA@@A@@A@CAC@AAA@A@A@@@ABA@A@A@AA@@A@@@@B@@C@@AA@@@AA@@AAC@@BA@ABC@E@A@A@@@@BCD@B@@C@@@ABAB@B@BA@@@ABCBA@A@CCA@CAA@@A@@BA@A@A@@@@@@@A@@@AAAAA@@@A@@AAAA@@A@@@A@@C@A@@@@@@A@GDC@CD@@CF@@@@@BBD@@BB@@BBBB@@AD@B@@AB@DA@@BAB@BA@@B@B@B@@@BA@AAA@CBC@@@@BAB@BA@AAA@A@A@@@CDA@CDA@ABA@C@CA@@A@@B@@@B@BA@@BA@CBA@AB@@AB@BCFAF@BA@@B@@@@@B@@BB@@A@@D@@@@A@@B@@@BA@@@@@A@AA@@AB@@A@A@@@@BA@AB@@@@A@A@@ACA@@@AA@@@AAAAAAAA@@EC@@@@@@@CB@@A@C@@@@@A@@@@B@B@@@@@@AB@@@B@@@@@@A@A@@@@@@BA@@@@@A@@@AC@@@A@@A@@@CBC@@B@@AD@B@B@@A@@BCBA@@@@D@B@BA@@@A@A@@@A@@@A@@D@BAB@@A@@F@@@BABA@A@A@@BAB@B@BA@@@@BA@A@@B@@@@@DBB@@@B@@@BCB@BCB@@@BA@A@CBC@ABABABA@A@C@A@@@@A@A@@@A@@@@@A@@@@@@BA@G@CBA@A@AAA@@B@@ABC@ABA@@@@A@@A@@@AB@BA@@@@@@@@C@@A@@A@@@@@BAB@@AB@@@@A@@@@A@@A@CBCBC@CBA@E@EA@@A@A@C@C@@BA@ABC@C@C@C@C@@@A@@@@BAB@@AB@@A@AAA@@@CDCD@@@@A@@AAA@@@@A@A@@AAACAACCA@@CCAAACAA@AACAA@AAAAC@@A@@@CBC@C@A@AAA@C@A@@B@@AB@B@@CBCDA@C@A@ABC@CBC@@@CAAAAAA@E@C@@@@@@BB@B@@BB@@BAB@D@B@DAB@BAB@BAF@BABABAD@B@B@@@BA@ADA@CB@@@BA@ABCAA@@AA@AA@AA@A@@@A@A@A@@@A@AB@B@B@B@B@@AB@@A@C@A@A@C@@@A@@@AA@@AA@@@@A@@BA@AB@@A@@B@B@@GBI@ABA@@B@BA@C@ABABABC@@@@@@BABABABAB@BAB@B@@ABAB@@A@A@@@@D@@AB@@@@BB@@AB@@@B@@@@A@@@A@@@@B@BABABA@@B@@@BBB@BB@@@@BB@B@@@B@@BBB@@BB@@@B@@@B@@B@@@B@@@B@@B@B@@B@@@B@@@B@@@@BBB@@@B@@BBB@BB@@B@@BB@@B@@BB@BB@A@@B@@A@A@A@A@@@A@ABA@E@A@@BC@@BE@@@@@A@@@A@@BA@@@CBADA@@@A@AB@@A@@BABA@ADCBAB@B@@@DA@@DAB@@AB@@ABA@ABA@@D@BAB@@@BA@@B@BBB@BBB@B@B@@@BEHAD@BAB@@A@AAAB@@@@@B@D@BCD@BAD@F@B@@@@A@@@A@A@@BADA@@@@@AA@@@@A@@BA@@B@@ADA@@@A@@@A@@@@@AD@D@@ABA@AB@BA@@@@@A@@@@@@@A@ABEBABA@@DEN@B@@HJ@DB@F@@@@B@@@BB@BB@@@@@B@@@B@@BBBDB@B@@BAD@B@@@BBD@@BBDBB@@BB@@BB@BAB@@@@B@B@@B@BBB@@BBBBD@B@B@@BBDBB@BB@@@B@BADBH@D@DB@BDBB@BAB@@ABAB@BBB@@C@@@ABAB@B@@AD@@ABAB@@@@ADA@@@AB@@ABAB@@@B@@@BA@@B@BA@@BA@A@@@@AA@@@@@AB@@C@@@A@AB@B@BNJDBBDBBBBJFBB@BBBDDDD@@@B@B@DBB@BB@DB@BB@@@AB@B@@@B@@BB@D@BBB@@DF@B@B@BA@@BLBJDdMB@BAB@@BB@@B@D@D@@@@@B@B@@BBB@D@B@BBDBBBBBB@@@B@@@AD@@@@@BBBB@D@@@@@@BB@@@BB@@@B@B@@@B@@ABA@@B@B@B@BABAD@B@B@HB@@DBB@H@J@BBDA@CD@@AB@FA@ABA@@@@@@B@@CBA@AB@@A@@@CDA@AB@@D@D@B@@@B@BBBD@@DBBBB@D@@@BAD@B@BAD@B@@BD@BBFDBBHB@@BB@D@D@B@BBNADBB@B@B@B@@@BBJBDBB@D@B@BB@@@BB@@BBBBBD@@DDBDBB@B@B@BBBDFBDBF@@DB@BBB@HBF@FBB@BDB@B@BBBBB@BBBBBB@@H@BBB@B@B@B@@@B@BB@@BA@@BB@BB@BA@@B@@BB@B@@BB@@@@AB@@BFFHJDDCD@JEFCDCDAB@DBFDB@B@NEB@LDDBBB@@B@@@DBBB@@@BDBBDDBBDDBB@@@@B@BADA@ABA@G@GBEBCBADABB@@BAD@B@@A@A@C@A@AA@B@@@DBBBDBBBBBBFBB@@DFDBB@D@DBDDDDDDD@@B@BBBAB@BABAHGBABA@@B@DBB@@B@B@BBB@@@@AB@@@D@B@@@B@@@BB@BB@@AB@BAB@B@BA@@@@DBB@@ABAB@BABCB@@CBABAB@@@BAB@BA@@DBBBB@@B@FBBBD@@@B@B@@@BC@@@AB@@@AA@@@ABABC@AFCBDDD@@@B@B@@@BD@@B@BA@@@BB@@@DBB@B@BB@@B@D@BB@BD@@DFB@DB@BBB@@CDABCB@BAB@@CBA@@B@@@B@B@@ABCBCB@@AB@@@B@BB@BB@@@B@D@@@B@@@DFFBBBD@B@BABA@AB@DCDA@A@ABABABCBGDCDADA@@@ABC@@@A@@B@@A@A@A@@BCBABA@A@C@A@A@@B@@@D@BA@AB@@@@@@@B@BBBB@@BB@AB@BABCB@B@@@BAB@@BBBBBBBB@@@B@B@@BBBB@@@D@@HF@@B@@DBF@BBB@BBDBFBB@@@B@DBBBBDDD@@@@@BBDB@@F@HCD@@@BAB@@@B@B@BBBD@BAB@@B@@B@B@BAB@@AD@B@BCD@@@@@B@B@@AB@B@B@@ABA@@D@BBDBBBB@BBB@B@D@BBBBD@DDDDFB@@BBB@D@DBB@B@@BB@B@DBD@DDD@B@BB@@B@@AB@BCB@B@@A@@@A@@@@DAD@B@BA@EA@@ABCDCH@@@B@B@BA@@BBB@@AB@B@B@@@B@BA@A@ABA@A@A@@@ABABAB@@@@@DBB@BAB@D@@@B@D@@@BB@@B@B@B@B@BBBADB@@DBB@@@BB@BB@@@@@BDD@@@@@B@@@BB@B@BB@@ABBD@B@@@@BB@@@BB@BB@B@B@@DB@H@@D@@B@BBB@@B@@A@@B@@B@B@B@@@BB@BBB@@@@B@BBBBB@D@DBDFFDB@@@BAD@BBB@BBBB@@BBB@B@BB@DA@@B@@BF@DB@BBB@BBBB@@BDBB@D@BBRRPLPP@@LLJHHHBBFGBCJKHIHKFEFGDCFCJCHCJEFCFALGPELEPIHADALGFAJGNGB@zkJEbWTMDA^Uh[~kPKBALEXONINEHENGJENILEHEBAPILGRITKFCB@®J\\LDBHBJDFBjP@@BA@AB@@AAE@CBABADADADABCFCHBJ@H@JBLBPD`DB@D@HAHAF@F@@@H@FBFBDFDDNDH@DDBBDDHBJDFBBBBBJDdHF@DBDBD@D@BABCFGFEBEBI@CDABCDAFAFANAJBTDJ@HAFAF@JBHBDDDBBDBDB@D@F@DBBBBBBDBBHAH@DABCFCHADADC@ADADBDBF@FBDBJDHBB@B@LAPCD@\\@RAFADCDCDE@@F@HCJ@H@JBDBDBHAJAJADCHCN@NAJCHCAEECECCAACAI@ICICECCCCAEBGBCFIBCBEBCDAFAJAFAHCDAH@FADCD@JAFBBBDBFBDBD@D@@@@A@C@EBC@C@CACCC@AAEAAAAA@IBCBCACACCCCCAECCCAAC@I@C@C@C@EACCCAACCAAA@C@C@CAC@C@CAAEAEACAAEAAAAC@A@A@@@A@A@A@A@ABQLEDG@GACACA@AAA@A@A@A@ABABABAFC@C@C@AAC@AC@@@GBC@A@CAC@@@@@CAAAEEAA@@KAC@AAA@GCACA@AAA@A@EAA@A@CAA@A@CCA@@@EBA@CBA@ABA@EBA@@@ABA@A@@@EA@AA@BC@@@CBABABABAB@BA@ABA@A@@@AAA@@CCAAAAA@CBA@A@C@CBEBKDCBC@AA@@A@@A@A@@@C@A@@FG@CBA@C@CAA@AA@@@A@A@A@@BABA@@DAB@@ABA@ABCAA@CACAAA@AAA@CAA@A@@A@@AA@A@@@ABA@@BAB@@CDCBA@@@A@@@AA@@@@@C@@@ADC@AB@BA@@B@BA@AB@@A@@AA@ACAA@@@GBEBC@ABGBC@A@CBCAA@@@AAA@AE@@@AACBAAE@A@ACECECEECECAAAA@@EAKCC@GAA@I@C@E@EBKDA@G@A@CAGECAA@C@@@CB@BCBA@C@C@CBA@ABA@A@CAAAAACC@AAA@@@AAAEAAAAAGA@@A@AAEAA@A@C@ABA@CBA@A@C@CAAAAAAA@@AACAE@EA@@CBE@GBGBEBC@ABEAE@AAE@A@CAA@CACAC@AAEAA@A@CACAKAA@AAC@EGAAA@@CACAABA@ABCBADABADABAB@B@F@DBD@HBD@B@@@B@H@B@BABAB@BC@ABCBCDIBA@ABABABAB@BAB@DAFADAFA@@DCBADAB@BA@@@C@@@A@@AAA@C@EAA@A@EBCBA@IBCBEBCBEDCDEBIFA@A@@@CB@@GAA@A@C@@@AAAA@@AAAE@A@CBA@ABA@A@@@@BABE@A@C@A@@AA@CCCCEEACC@AAA@@@ABA@CBCBABA@@@A@CBC@AAA@@AA@@A@C@C@C@CAA@@@CCAAA@@A@@@A@ABA@ABABA@A@A@A@AACA@AAA@E@C@A@A@AA@@@A@@@A@@DEBA@@@A@@@@AC@@@@@A@@@ABA@@@G@AAAAA@AA@C@@@@A@@BABAJGBABABCBA@C@ABAAC@A@AB@@ABA@C@A@AB@@@BA@@B@F@BADAB@@@DA@@@@BB@@@@DABB@@B@BAB@B@@@B@B@@@BAB@@AD@D@B@@@BAB@BABABABA@@D@@AD@@ADABADA@A@@BAB@@@@ABA@E@ABC@ABA@C@A@@AA@A@@ACAAAAEGCC@@CAAAAACAA@@AA@@AAA@C@@BC@A@A@@CACC@A@@@ABE@ABAHC@AB@BCBC@ABA@A@AAE@CCE@@@A@@DABA@ADCBCBCBE@AAA@A@@@A@@EG@AAA@A@@ACA@CAAAC@CACCCAAAA@@@A@@A@A@C@A@AB@@AB@DAHC@@D@JAL@@@B@B@BBDBHDBBDBPJBBHDBBB@F@BBB@B@D@BAB@B@@AB@@ADCBCBC@ABE@A@C@@BABAB@D@D@BADAFABABADA@@BABA@A@ABABG@A@CBA@AB@BAB@B@B@BA@@BA@ABA@A@AA@@@BA@@B@B@@@@AB@BC@ABA@A@@B@@@@@AC@A@@B@@@BA@A@ABC@@BAB@@@B@@@B@@@B@@ABC@@@ABA@@@AB@B@BC@ABAAC@AA@@A@@@AA@@@@A@A@A@@A@@@AACAA@AAA@AA@A@@@@@A@@BAB@D@@@BABA@AB@FAB@HCBA@ABABCBE@C@@BADC@ABA@A@A@C@AA@@AAAAA@@@A@A@A@@ACBAAC@A@C@A@A@@BA@@@A@C@@BABA@ABAA@CAC@@ACAA@@A@@BA@A@AAA@A@AACAC@AAC@A@@@A@@@ACA@AAEAC@@AA@@BA@@@C@AAAAEAAAA@@A@A@C@C@@@@AA@@@A@A@@A@@@A@@A@@@A@ABA@AA@@ABA@@@@A@A@C@@@@A@@@A@@BA@@@CA@@@@C@A@A@@@@@A@@A@AA@AACAA@@@AC@A@@A@C@AA@@C@AAA@AAA@A@@@A@@@AB@@@ACA@@@B@@@BA@@@A@@A@AA@A@@@A@@@@@A@@A@@@@@BA@AB@@@B@@CB@@B@@@@@CFAB@B@@@B@@CDABABA@@@A@A@@@@@AB@BA@@DA@@@@B@@BB@@@@@@@DAB@@AB@@@BA@@@@@@B@@@B@@@B@BBB@@AF@B@@A@@D@@ABAB@@A@@BAB@@A@@B@@CB@@@BA@@@CAA@@@AA@@AAA@@@A@C@@@@@@@@AA@@@AA@@@@BABABA@@@@A@@A@@@CA@@AAAA@A@@@AACAC@@A@@@@@A@A@A@@@@@@DA@A@@@A@@@A@@@@A@@A@A@@AA@@@C@@@@@AA@@@@A@@BA@@A@@A@@@@AB@@@@@@AA@A@@@AAA@@BA@A@@A@ABAB@@@@@@@@@C@@@A@@@@A@@@@BA@@BA@A@A@@BA@A@@@A@C@@@@@A@@A@AB@AA@A@@@A@@BA@@AAA@A@AA@@A@@BAB@B@BA@@@@BA@@@K@A@A@A@ABA@ABA@@@@D@@AB@BA@@B@@A@AAA@AB@@C@AB@@@AA@@@CB@@A@@@A@A@AA@@A@@AA@@@@A@A@@A@@@@@@@@@@@A@@AA@@B@@A@@@@@@@@A@@A@@@AB@@@@A@@AA@@@AB@@@AAAA@@@A@@@A@A@A@@@AAA@@@A@@B@@A@@B@A@@A@@AA@@@C@A@A@@@A@@A@@@AA@@AAB@@@@AAA@@@AAA@@@ED@@A@A@A@AA@B@@A@@BBB@@AB@B@@@B@@@@@B@@@@@@C@@@ABA@@@AB@@AB@@@@AB@BBB@B@@A@@@@BB@BB@B@B@@@@BD@@BB@B@@@@BDDDBD@@@@"],encodeOffsets:[[106724,37639]]}},{type:"Feature",id:"620421",properties:{name:"靖远县",cp:[104.676774,36.571365],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@GACAEAGCCA@ACCECC@CCC@GCIA@AC@CEA@@@C@AAE@A@@@@A@@@C@AAA@A@@C@@AA@@CAA@@A@AAA@@A@A@C@A@A@@@@B@B@@BB@DADCH@B@D@@@BA@@BAB@B@@@@A@A@@@@BAB@@A@@@ACB@@A@AAC@K@E@A@CB@B@@AB@@A@C@@@A@AA@@CBA@C@C@@@@@A@@@AB@@GACA@@AAA@C@A@A@@AA@@@AA@@A@@B@@AD@DAD@B@@AFABABAD@BAD@B@BADEFAB@BAD@@ABABADABABAB@B@@@BBBAHABBD@@@BB@BBB@B@@@BBBBB@JB@@B@DDBB@@B@@@B@DDBBDDDDBB@@B@@@BBB@@BBB@H@@@BBB@BB@A@AF@@A@AB@B@B@BABA@@@@BB@@@BBB@@@DB@@@@@B@@@BAB@@AB@@A@A@CA@@A@@@@BABCB@B@B@@@B@FA@ABABA@@@@@A@@@A@@@CAA@@@A@AB@@@@BD@B@@AB@BA@@@AB@B@@@@AB@@AB@B@B@B@@@BB@@B@@AB@@@B@B@BBB@@@BAD@B@@A@@@@@@B@B@B@@@@@@@@@B@B@BABABAB@@AB@@@BA@A@@B@B@@AB@@@B@B@@ABA@@B@@@B@@@B@BAB@@CBABA@@B@B@BAB@H@D@BBB@D@@BB@@B@@BAF@@@@DD@@@B@@@@AB@BAB@B@B@@@B@B@@AB@BAB@F@B@@@DAB@@@B@B@B@B@@@@@@@BB@@@BBBB@B@@AB@@A@BBBB@BBB@DB@@@ABB@@BA@@@BB@@@@@B@@@@AB@@BBBD@@@BBBDB@B@@ABAB@@@@BBB@BB@@AB@BAD@@A@@B@@BB@BB@@B@@@B@B@@A@@DAB@B@@B@@BB@@DA@@BA@@@A@A@@@@B@@BB@B@B@B@BBBBB@@@B@@@@AB@BAB@@DFB@@B@@AB@B@@@@@@AD@@@BBB@B@@ABAB@@@D@B@B@@AB@@@BABAB@@@B@@B@@@B@BB@@@BA@A@@B@@@B@BA@@@CBCBAB@BA@ADA@@@A@A@A@AB@B@@AB@BA@@BA@@@E@A@AAAACAAC@@AA@C@AACAA@@A@EAAAA@GAA@AAA@AA@@A@A@A@@@@A@@@A@A@A@CAA@@AAC@AA@@@A@@@C@@@@A@A@A@A@@@AAA@OECAAAA@@A@@@@BA@@@A@@@A@@@@@ABA@@@@@A@A@@@A@@B@@@@@@A@@B@@AA@@AAAEACAA@AECCAA@@AA@@@@AA@@@@@B@@A@@@@@AAA@C@@BA@@@@@A@@@@B@@@@@@AB@@@@@BCA@B@@ABA@A@@@@@@@A@A@A@@@@@@@A@@@@@@A@@@@@B@@@@A@AA@@@@@@@@A@@@@@A@@A@@@@A@@@EB@@@BBD@BA@@BCF@BADADIHGH@B@BAF@B@B@D@DBDBDDD@@DBFBDBB@B@BBDBB@JDDBDB@@CFCD@@@B@B@FAJ@BB@@B@@ABAHCH@B@@@@CB@@@@@BAD@B@@@B@@@BA@@@@@A@@@@@@B@@CBC@AB@@@@@B@@@@AB@@A@@B@@@BAB@@ABA@@B@B@@C@@@AB@@AB@AA@@@@A@@A@A@@@A@A@@B@@@B@@@@@BA@@@A@A@@B@@@@B@@B@@A@@@A@@@A@@@AB@@@D@@@@A@A@@@@@@@BB@@@B@@CBAAAB@@A@@@@@A@@@A@@@@A@@A@@@@@@@@A@@ABA@A@C@@@@@@@@@@@@@CB@@@@@@@AA@@B@@@BC@@@A@A@@B@@AB@@AB@B@@@@@@@@@@@B@@@B@@A@A@@@A@@B@@@@CB@@@@@@@BA@AB@@@B@B@@@@ABA@@@@@@B@@C@@BC@ABABA@@@@D@@ADABAB@@@DAF@@AB@@@BAB@@@BAF@@@BA@@@A@AFADABCBAB@BA@A@A@@@CB@@A@A@@BA@@B@BAB@BA@@B@BBB@B@B@@B@@B@@A@@BG@@@AB@@ABADABCFAB@@@@BB@B@B@@ADAB@@@B@@@BB@AD@D@B@@ABA@@B@B@@@B@@@@AB@@@BA@@@@@@@AA@@C@@@AA@@ABABA@@BA@@BA@A@EFA@@B@@BD@B@@BD@@@B@BA@@BA@@BAB@B@@A@@@A@@@AB@@@@ABA@CBABA@ABGHCBABABCD@BCBA@AB@BABAB@D@D@L@B@DBD@BBDBB@@@D@D@@DDBDBB@@D@B@@@BAB@B@@@B@@@BBB@@@@@BBB@@@B@@B@@BBDF@BBB@B@D@@BB@B@FBBBB@@@B@F@BBBDD@BB@B@DA@@@@B@B@@@@BBB@@@@@D@@@BABA@@B@@@DAB@@A@@@@AA@@BC@@@@B@@@@@@@@@@A@@@@@@B@@A@A@@@A@@@@BA@@B@@@@A@@B@@@@ABA@A@@@@B@@@BA@@BA@@D@BB@B@@@@B@@@BA@@B@@@B@BCB@BA@@@ABA@@B@@BBBB@B@@ABA@ABAB@@AB@BBBAF@D@FABAFAD@BAD@BBB@@BB@@D@DBF@B@@@BB@BB@@B@B@@BB@@@B@BB@B@B@L@@@B@@A@@B@@A@ABA@AB@@@BBB@B@BB@@AB@@@B@@@BBBA@@B@BB@@@@@D@B@@@B@B@@AB@B@B@@AB@@A@@B@@@@@@B@@@D@@@@@@@@BABAB@@@@BAB@@BB@B@@@BBB@@@@@@BA@@@@@BB@@@AB@@@B@@B@@B@@@@@D@@BB@@@B@BB@@@@@@B@@@B@@@BCB@@@@@@@B@B@B@@@@@BD@DBBB@@B@B@BB@BB@@D@@@BB@@@@@ABABAB@@@@BB@@B@@B@@@@@@D@B@@@B@BB@@BB@@B@DB@@B@@A@@DA@@@AB@@@BA@AB@@@BABA@@@CB@@@@ABE@@AA@A@A@@@A@@@A@@@@B@@A@@BA@@BA@C@@@@@@AA@@@A@@B@@CB@@ABA@@@@B@B@@@B@BABADC@@@A@@@ABADE@@@@A@@@DA@@@A@@BAB@@A@@@@@BB@@@@@B@@@B@B@@B@BB@@@B@@A@@@A@@DB@B@@BA@@B@@@B@B@BBB@BBD@@@BBD@B@@@@BBD@@B@DBBBB@@B@BB@@@@@B@B@D@@@@@DB@@B@@AB@@@B@@@@@@D@B@B@@B@BA@@BBB@BAB@@@B@@@@B@@@BB@B@@@B@@B@@D@D@B@B@@@BBBBBFBB@B@D@@AB@@BB@@BDBF@BDB@B@@@B@@@BBD@BBDBD@B@BBB@B@BAB@@@BB@DB@BD@DBB@AB@BABBADEBAB@@A@@BBBBB@@B@@@B@D@B@@BDBFBFB@BDBBDBBBD@BBD@D@BAB@@@B@@AB@@ADBB@DB@BB@@@@@D@@B@@D@FAB@DBD@B@BAB@B@@AB@BAB@@@B@@@B@@@B@@@B@B@@@B@B@@@B@@AB@F@D@@@B@@A@@B@J@B@B@BBDBB@BAD@BAB@BA@A@A@C@@@@B@@AFBB@@@BABCBAB@BCDA@ABA@ABA@AB@FCBAB@@ABE@A@@FA@@BABCBABAD@@AB@B@@@FDB@@@BB@@B@@B@@@B@BB@@@B@@@B@@@BA@@BAB@@@B@B@BB@@@@@@@A@@@@B@@@@@@AB@@@B@BB@DB@@@@@@A@@B@BA@@@@@@@B@@@@B@@AB@@@BBBB@@@@@@@B@@B@B@B@@BBAB@@B@@@@@BDBB@@@B@@BBB@BB@@@B@@@B@@@@@@B@BB@@@@@DA@@@@@@BB@@@@BA@@A@BA@@@@@@B@@@@BB@B@@@@@@B@@@@@BBB@BB@BBDBBDB@BBD@@@@@BB@@B@@@BAB@BCBCB@DCBAB@BAB@BADKBABCBCBAB@DABAD@DABAD@B@DAFEFC@AFC@ABA@A@AB@BAFCFCD@DABABAD@BAB@FAH@DADC@ABA@A@CAE@@@C@A@A@ABC@@BABALCD@DAB@H@B@JCB@F@@AD@HC@@NCBB@BDFFL@DBD@@BBBHFJFPBDDH@BDLBJBB@@BBDBBBB@J@J@D@BAB@HCBABA@ABG@G@G@A@IBE@@DCBABABAFABADABAFA@@BC@ABE@@@ABABAHKBCBAB@JBD@D@D@B@BADADCDCBAJCGIGIEEAITBJDPLJABA@A@ECK@ABABC@I@ADGB@@A@CAA@E@A@C@E@@@@AAAAC@AA@@@I@E@EBA@CBCBAFIB@BA@AB@@@DABCDCBA@ABABABABAB@BAB@B@@@BAH@BA@@F@DB@@B@@ADCBA@C@@@ACK@@@A@ABC@CBA@A@@@CAA@CAABE@AAKAE@@BC@A@C@A@CAA@A@A@ABA@A@@DCDCB@B@@@B@@BB@B@B@B@B@@@B@DBDBBBB@DB@@B@DBB@@BRLLHBBB@BBB@FBD@F@BAB@BADC@ADBDBFFB@BD@BBBBD@BB@DDBB@BDDB@DBDBBBB@B@FA@@DBBBBBDB@@B@B@B@D@DAB@D@B@HAB@D@@@BA@@BABEB@@AB@B@DAD@DAPI@@BABC@@FABADC@AB@@@BA@@@@@@DA@A@@BABABADCDADCB@@@B@BA@@BABCDG@@DAFAB@B@FAPEDAB@D@B@BBBBBB@@@@HAFAB@J@B@B@DBJ@D@@@DAD@DA@@BA@@B@BCDAHCDADAD@F@B@F@BBBBB@BBBF@B@B@B@@B@BAH@B@BA@@BA@ABCBA@AB@B@B@HAD@J@B@BA@@@A@CCCAA@A@AAA@E@E@C@A@ABAJGJEDCDAB@D@L@D@B@BAJEFEFGDCBCDGBABC@A@AAA@C@@@A@@BADAD@B@FAD@@@B@@CBA@A@@BAFEDCHGJGJILGHEB@@@@A@C@@B@BA@AB@D@@AFA@AB@@GBAB@@AD@F@DAB@DA@AB@@ABE@C@G@A@@CAA@AAA@@@AAAA@@@BA@@BCBA@@@AB@BA@@@A@A@@@@A@@@C@G@@@@A@@@@BAA@@A@@@C@@@@AAABC@@@AB@B@BC@@@@AAEC@AEC@@@A@ABEBA@A@@AAAABA@@A@A@@@AAA@G@CAA@AAA@AAA@A@@AA@@@@@A@@@AA@@A@@@C@AA@@C@@AA@@ABA@@@A@A@@@AAAA@@AA@@@A@@@A@@@CAA@@A@@@CAA@@A@C@@@AB@@@BA@AA@@A@AB@@@B@@@@@@AA@@@@A@A@AAAAA@@@ACAA@@CA@@A@A@A@@A@@@A@@B@@@F@D@D@B@BBBAB@@@@A@@B@BA@@BABE@@@AA@AAA@A@@@AE@C@@@AA@E@@A@@@@@AC@@A@A@@BA@A@@@A@@AA@@AAA@A@CA@AA@CAAAA@@BEDGDC@A@ABC@@@C@CBEFABAB@BAFABA@@@A@I@A@@AAAA@@AA@AA@@CA@A@@@AB@@ABA@A@@@C@@AA@@@AB@@@@A@@BA@AA@@AAA@A@@@ABADAFCDABCBA@@@A@C@@@A@@A@@A@@@A@@@AAA@A@@@AA@@@@@@BAB@@A@@@A@@@ABA@@@AAAA@@CBA@@A@@@C@A@@A@@@CA@@AB@@@@CACA@@A@@@@@@@AB@BABA@@@AA@B@@A@IB@@A@CBGBC@A@I@A@CAA@A@@BA@G@A@@@EBCBABC@A@A@@B@@A@@@EBC@A@CB@@AAA@@@@@AB@@C@CA@@A@@@@D@BA@GJ@BA@@B@B@@BB@@@@B@BB@@B@@DB@DDB@@BB@@@@B@@@B@@AB@@@@A@@@A@@@ACECAC@@AAAAA@A@@@ABA@A@@@ABABCBAD@@A@A@A@A@@@A@CA@@@@@AA@@AAA@@@A@@@@A@@@AA@@A@@@A@A@@@@@AA@@A@@AA@AA@@A@AACBEA@@A@BB@@@@@B@@B@@@@B@@B@@B@@@@@BBB@B@@@B@@@@@BB@@B@@@B@@B@@BEBA@E@C@OAE@EAE@AAG@EAK@GACAC@G@@@CAACCAACACAC@@AA@A@CA@@AA@AA@@@C@A@@@A@AAA@@C@GAC@CBA@E@C@A@A@A@A@A@C@ICCAA@C@A@ECGAICEAGCCAA@A@A@@@AB@B@F@F@BADAB@BA@A@C@ABA@CDCBABA@@@@@@F@@@BA@AB@BAB@B@BAB@@C@A@@@AAAAAAA@CACA@@A@@A@@@A@@AA@@AACAA@AA@@AA@C@AA@AAA@@@@@A@@@AAB@BA@@AAA@CA@A@@@A@@@A@@@@CAAAA@@@@C@A@@@@GEIGGEECCAACEE@AA@@ACEAA@A@AA@@@A@A@CD@@A@@AAEAA@@A@@@AAEAA@AAA@A@@A@@@A@@C@@A@AA@AA@AA@A@@@AA@@@@@AAB@AA@ECAA@AA@@AA@AAE@C@AA@@AA@CCA@AA@A@CA@@A@C@A@AAA@@@AAGEA@@@EAA@@@A@A@AAA@A@@@A@@@AACAAAA@@AA@AA@@A@@@@@@A@@AA@A@A@@A@@@@AA@@@@@A@A@@AA@AAAAA@A@@AA@@AA@A@A@@@@AAA@@E@AAAA@@A@A@ABC@A@G@G@AAA@@@A@@@@@@@A@A@@BA@A@A@A@@@ABAA@@C@@@A@ABA@@@CCEC@@@A@@@@CA@@EG@@CCAAEAGCCACAEAMEIACA@@C@EDE@C@IBC@GAE@GA"],["@@AA@AAAAC@CACCC@CAAACACAACAACAA@AAB@@A@ABGDCDEBCBA@GBABA@A@@BCD@@CBA@ADE@AB@BA@@B@@@DA@CBCDABCBCDA@EB@@A@@@@@@@A@A@A@@BAB@@A@@@AA@@C@@@@@@@A@@@A@@@@@AA@@A@A@@@A@@@AB@BADAB@@@@C@@@ABABAB@@@@@@A@@@CB@@@BA@@@AA@@A@@BCDA@@B@BADABA@ABA@@@@BA@@BCDA@A@A@A@@@EDA@ABA@A@@@A@A@A@A@A@A@@BA@CBA@@B@@A@AAA@@@A@A@A@A@@@GCAAGECCAAACA@AA@@A@ABID@@A@CAA@A@A@@@ADCBADEB@@A@@@A@@AAACA@AE@C@A@A@CBABCBCBABA@C@CBA@GBCBA@O@@@EBG@ABA@A@@BA@@B@B@B@BBB@DDF@BBD@@DD@@@@@B@BADAHAB@@@@A@A@C@@@A@ABA@A@C@CBC@@@A@A@A@AAAA@@@BAB@@@BAB@@@@BDB@@BADAB@BCBAB@BA@CDA@A@EBA@IB@@C@CBA@C@C@ABA@C@@@AB@@AD@BABABABA@C@C@E@I@A@@@AB@@CD@BABEBAB@@A@A@EAA@CAEE@@@@A@@@EBGDCB@BCDCB@@ABA@@@EHA@@@AACACCAA@@EDEBAB@@EAA@C@KB@@ABA@@BA@AB@@A@CBCBABA@CB@@A@ABGBCBA@@@A@C@@@CBCBCBCBCBA@G@CBEBC@CBADCBABADAD@B@@DBBBHABBB@@BB@@BBB@@DBDBBB@@BB@@BBBFDB@BDLBD@@@B@@@BCD@DAJAB@B@B@B@DHP@B@@B@BBB@BBBDDDBBHJBBFFDB@BBD@D@@@BAN@@@D@H@B@B@D@@@BAFABEFABCBGFA@C@CBC@C@EAC@MEG@ECCCECAAA@C@MBA@C@A@@B@B@D@@BBFHBBDD@B@B@D@BADAFAD@B@DA@@@ABA@@@CDAD@DABBD@B@@DDBBBB@B@@@BABEDA@C@E@C@GAEAECGGCCAAC@CACAA@A@@@AB@BAB@B@DAD@BABBD@B@B@B@BCD@@ABA@A@CAA@M@C@A@CAKC@@A@CDA@A@A@@@ABE@A@I@CAA@A@E@A@C@CACA@@I@A@CAA@AAA@A@@BA@ABA@A@AAMCA@@@A@@@AB@@A@@@A@CC@@@@CB@@A@A@AAA@@AA@@@@@ABA@@AA@A@A@C@@@A@CA@@A@A@A@A@A@A@A@@@A@CB@BAA@@A@@@A@@BA@A@AAA@@@AAC@@@ABC@A@AAA@AA@ACAAAC@C@A@@@CDA@ABC@C@@@AA@@AAACAACCAA@@C@A@@@CBCD@@CBA@KDODE@CBEDGBA@GBC@GBEBA@A@A@EACA@@AA@A@@@ABABA@@BCBAAEBA@ABABGBA@@BCBCBGBA@@BABABA@@@A@A@A@A@@@ABABC@@@A@AA@@AA@ABA@A@C@ABC@@@A@A@AAAAAA@AAACCACA@@@A@ABABAB@@A@C@CBC@A@CBA@ABGFAB@@E@I@@@A@@@ACAC@ABE@A@AA@ACEAAAAA@@AABEAE@@BA@AB@@AAC@E@A@A@@@AB@@@DBBA@@@AAAAAAAAC@@BC@A@@@A@AAAACAA@E@A@AGGAA@A@@@A@E@@@AAA@AAA@CCCAA@AA@@A@@@A@@@@@@@@B@@@B@@@B@@A@A@C@@A@AA@@@A@@@A@A@@AAC@A@@BC@CAEAE@A@A@EBCBE@CBC@EBCBCBKBKBC@EB@@A@AAGAA@A@CAA@CAIAC@C@C@EAGAC@E@GIACAAAAACAC@A@C@E@CBCA@A@@B@@AB@B@@AD@BA@@@A@@@A@@@A@AB@@AD@B@BAB@@A@@@@BBD@@@@A@@@@BAB@BADA@@B@@A@A@@@AB@@B@@B@BAB@BAB@@ABA@A@A@ABA@@BAB@D@BAHAB@B@BABAB@@CBABABEBCBABC@C@A@ABAB@@@D@BAF@BADADCD@BA@@BA@A@ABC@A@A@AAE@A@AAGCAAOICAAAGCCAAAA@A@@@K@IBC@@@GDCBA@@BA@@B@B@D@B@BB@@@B@BBDBDDDBD@BBDBB@BD@@@BBB@BFH@@@B@@@BBB@BAFADADCD@BABCB@@@B@@DF@DBF@B@BAB@BADADA@@BGDAB@BAF@B@@@BDDDB@@@B@BAD@@@DBB@BB@@BB@DBBBBBDB@@DDFHBBBBBD@@@BBB@@@B@DAB@BAD@B@FAB@B@@A@AB@@@BCBABCB@BC@@BC@@@ABABABABA@AB@@A@C@C@@BA@AB@@A@A@@@A@A@ABA@@@AACB@@@@AA@@@@CB@@A@CBABE@A@@@AB@@A@@B@B@DAB@BA@@B@BBDAB@B@DABADABABIHABAB@@@B@@D@B@@BBBBB@B@H@@AB@B@@@B@@@@BD@@@@@B@@ABCF@@@B@@@B@@BBB@B@D@F@B@BBB@BD@B@B@B@BABAB@BAB@B@B@@@BB@BBDD@@B@DBD@D@D@B@@@BB@@BB@BAD@D@B@@ABABAD@DAB@B@@BB@BDDFBDFDD@DBB@@@B@D@BAFAB@@@@@BAB@BAB@D@BBFBB@@BBBB@@D@B@B@HB@@DA@@B@B@JEFADCFCDAFADAJAB@DAFAB@B@FBD@B@BB@@@B@@@D@@ABA@CBABCD@@EBCBEBCBA@ABA@ABABAB@BABCJADAD@BADA@ABABA@G@A@@@A@C@GAC@CAE@A@A@ABCBABCBABAD@BABBBBD@DB@BBFHD@BBB@LBDBDBB@B@FBBBD@DBDBB@DBB@F@BBF@FBBAD@FAHAHAF@DA@@FBF@DBBB@@BBBBBBDBD@B@B@DAB@BAD@B@B@FBBBB@@@HBBBBBFBBB@B@@BB@BDDBBBBDBB@B@BAB@DAD@D@B@DA@ADA@@D@B@DBHFDBB@H@B@LCFAF@D@J@B@HBD@LDFB@@BBBBFDFDDFDFDF@B@BBFABBD@B@@BFB@BB@@B@DBDAB@D@HABAD@FAHA@@B@DB@BBB@@@BA@@BABA@@@ABA@@BCD@B@@@D@@@@BB@@B@@@B@DADC@@BA@AB@BA@@B@B@@BB@@@@BBB@DBB@BBBDBDBB@DBBAB@BA@@BA@CB@BA@AB@B@B@@@B@@BBB@D@DAB@DEH@@@B@D@@@B@BB@@@BBD@DALCFADAD@B@B@DAB@BBBBDD@@BB@B@@@BAB@BABA@ABABABAB@D@@ADB@@BFB@@B@B@BA@@B@FAB@BAB@DAB@FA@@B@DDB@B@DBB@B@FBB@B@BBB@BDHDB@BBD@LB@@BBFFBBDB@@@@D@A@AAAABCCGKOGS@OBCLUBEBCDGHCHILG@A@@BC@C@ABADABADAB@D@D@DADBD@BBBBB@D@D@@@JAD@DBFB@BBB@BB@B@DCDCBAB@HB@@J@DADAB@BBBBDB@@D@D@DAJBPFB@D@H@J@DBFDFDHDBFBBBBFBFBJJJDBB@FBBBBDBJDJBF@HFFDDDBBD@BADADADADABCBABABCBA@CB@BABC@AB@BBB@BBB@F@BBBBBB@@@BB@@@@@@@B@B@@ABA@ADABAP@JAD@BADCBAFE@@ZIFAFAFCDCBC@AAA@@B@BA@@@@@ABA@@@@@@@A@C@CAG@CBAAG@A@CBCBCDADABC@E@EBADADAFADCHABAFC@@BA@@@A@@A@@@@@@AB@@@@@B@B@@@D@@@@A@@B@@@@@@AB@@@@A@A@@@AB@@@@A@@B@@A@A@@@@BA@@@@@AB@@@@@@@@@@A@@@@@A@@B@@@@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@B@@@@@@@BA@@@@B@@A@@@@@@@A@@@@B@@@@@B@@B@@B@@@@@@@@@@@B@@@@@@AA@@@@A@@@@@@@A@@@@@@@@@A@A@@@A@@@@@@@@@AA@@@@@@@A@@@@A@@@@@@@@@A@@B@@@@@@@@A@@@@BA@@@@@@@@@@A@@@@@@@AA@@B@@@@A@@A@@@@@@@@@@@BA@@@@@@@@@@@@AA@@@@B@@@@@B@@@B@@@@@@A@@@@@@@@B@@@@@FADA@@FC@AFCFGDEBE@CCEAEAE@ADCJGFCHCFCDALAJ@HAFCDCBCBE@G@C@k@A@KBAJGJGBAB@B@B@B@B@@BB@D@B@LBHBB@B@@B@B@BBB@BB@@@BA@A@@BA@AB@B@B@F@D@FBD@@@B@BAD@BAB@@AF@@@DA@@EY@CDAJCLCHCBABABADAD@D@D@BBBBBFBDDDBB@@@@DBBDBB@@B@B@B@@@B@BBHDB@@@B@BAFCFCBADAFAFCFAJ@FAJCBABAB@BADAB@BAFAF@BABAD@BAB@F@D@DAB@DAB@DAB@B@HAH@D@F@B@B@B@FAB@D@D@D@LBB@F@DAB@DA@@AA@A@@@ABQ@ABKBGBE@CDCJCBEDAAA@CBC@CBC@E@@BAD@BA@@AA@A@@HC@@@A@AAA@A@A@@@AB@@@@A@@@C@CA@AA@ABA@ABAB@@@@AA@ADC@@@ABGBA@BCDGDM@ABG@E@@@A@CBE@CAC@@A@ED@@@@AA@AHA@@@@EI@A@@NEFCBAB@B@@@@@@B@@@B@@ABB@@@B@@B@@AB@@@B@@BBBBB@BBB@B@@B@@@B@@BB@@DAB@BAB@BBB@BB@@B@FCFADADAB@DC@@@@@@BBFD@@@@@A@CBEBCB@BBHHBBDDBBBAB@BBDDBADAB@BA@AAACA@ABAB@B@@@BBDD@@BABAFCBAHG@@DCDADCB@RMBA@@D@B@BABA@A@C@A@A@CBA@C@A@@@A@AAC@AA@@@@@FCVI@@HCBA@@@AAA@A@@@A@@A@C@AA@A@CBAB@BAD@BA@@@G@A@ADEDCBADCFADABADABALINGB@B@HB@@@@@ABCBA@C@C@A@C@CBC@A@ABAAA@EBCBE@@@C@@BEFM@EAAEAA@C@AACMCGKKGEIAG@G@EDADCBCBABEBC@CBA@C@C@C@A@CBC@CBA@A@@A@@@CA@AC@@@CA@AABA@A@A@@@A@AA@AAC@IDK@GBK@E@K@AA@@@CBA@C@CACG@C@C@A@ABA@ABA@AAA@CAAAEACA"]],encodeOffsets:[[[107123,37084]],[[107742,37611]]]}},{type:"Feature",id:"620422",properties:{name:"会宁县",cp:[105.053358,35.692823],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@BCB@@@BABABABABAB@@A@@@@@AAA@A@@@@@@@@A@@A@@@@@@@@@@@@@A@A@A@@@AAAAC@CA@@@A@A@@BA@@@A@A@@@A@AB@@ABA@@@E@EAA@AAA@AA@@AA@AAEA@@@@@E@CB@@@@A@@B@@A@A@ABA@C@A@@@A@@@@BABA@B@@B@@@@A@BBA@@B@BA@@@BBABABA@BBA@AB@@@@@B@@@BA@@@@@@@A@@@BB@@@@@@@@@@@@@BAB@@ABAD@@@@CBCBA@@@AB@@BB@@AB@@@B@@@BA@@B@@@@BB@@@@@B@@@@@@@@@@B@@BA@@B@@@@B@@B@B@@@@@@@BA@@@@@@BBBB@BB@@@@@B@BA@@@ABA@A@C@@BA@A@A@AA@@A@@@A@A@ABCBA@C@A@CBA@ABA@A@A@@AAAEC@@A@BAAA@AA@@AAB@AAAA@@@CA@A@@A@@B@@B@BB@B@B@B@B@@@@@@A@A@ECCAGECA@@AAAAA@C@CDADEBEF@@A@CBABCBA@AB@@ABAB@@A@AB@@AB@@@B@J@F@D@@ABA@A@IDCBG@C@A@CBC@CBA@@B@@CB@@AB@B@@CB@@ABAF@DAB@BA@@@A@A@C@@ACB@BA@EBA@ABA@C@A@A@@@C@CC@@@BABA@CDCBAB@@@@@@@B@@@BAB@@@@@@@B@BABAB@B@@@BBB@@BB@@A@A@C@@@@@ABAB@BB@BBB@@BB@@BAB@@@B@FAB@@@B@@A@A@AB@@@DBB@@@BDBBBA@@BBBB@BB@B@@@B@B@BABAB@B@@@BBB@BB@@@@BAD@@BD@B@@@@D@@@B@BB@BB@@BB@B@B@L@B@B@@BBB@B@@@@@@@B@B@@@B@B@@BD@@@BA@@@@B@@@BBF@B@B@B@@@@@BBD@@@B@D@@@@@BBBBB@B@@@@@@BBDDBB@B@BBBAD@@BB@DBDBDBFBHBDBD@@@BAB@DAB@BBD@@BD@BBBBBB@@BB@DB@BBBBDB@DB@@D@B@BBB@BBBBBBB@BB@@@@BB@B@DDB@@@@@BABA@BDAB@BC@@BAB@@@B@@ABB@@BBBBB@@@D@B@B@BBD@B@B@B@D@DCJ@@@B@@@@AB@B@B@B@@@DABBB@@@B@BBBBD@@DBBBBB@@@DBH@BBB@BAB@@@B@BABCJ@@AB@@A@AB@@ABGF@@@B@@@B@@@BADAD@@@D@BAB@@ADCB@BA@CB@@ABA@@BA@@B@@@@@@AB@@@@@B@BA@@@@BCBED@BA@ABC@E@@@A@@@@AA@@@C@A@@BADA@@@A@E@A@ABABC@@BA@A@ABA@A@A@AAA@@@A@AAA@@@A@@AA@A@@BA@AFA@AB@B@BCBABAB@@A@AB@@A@@BAA@@@@AB@@@@A@@@CD@@CDCDA@A@AB@@@B@B@B@BABA@@BAAA@A@@@@@@D@B@@@@AAA@@@@@@@ABA@@@@@A@AA@@C@@@A@@B@@CL@@@B@@A@@B@@@@@B@F@@@@AD@H@B@J@@A@ADA@@B@@@DA@@BA@CBA@@@ABAB@DAB@@@BA@A@AACBG@ABA@A@@BA@@@@BB@BB@FB@@BBB@D@B@BBBABBBAB@BABADAB@B@@@BAR@@@B@@AD@F@BAB@B@B@B@BAB@B@BAB@B@B@D@F@D@@A@@@E@E@A@AB@@A@A@AAAAAAAA@@@@A@@B@@A@@@A@@BA@@BA@@@A@A@@@AA@@@@AB@BA@@B@@AA@@@AA@A@AA@A@@@@@@A@@@@@A@@@@@A@BBBD@BBDADB@@D@B@D@B@B@@BDA@@@A@A@AAA@A@@@@@A@@@A@@@@@A@@@A@@AA@@@AB@@AAAB@@CBAB@@@D@F@B@D@B@@@@A@@@A@@@A@AD@@A@C@@@A@A@@AC@A@ABAB@BAB@@AA@AA@@@CBAB@@AB@@A@ABAD@@A@A@G@@@@BA@A@C@@@AB@@@@A@A@@@AA@@A@A@A@@BA@AB@@A@AAA@@@CDC@A@AB@@A@@@@B@H@BBF@B@B@@@@B@B@@@BB@@BDBDBF@BBB@@BB@@@@AB@@@@@@@@@BA@@B@@@@@BA@@BAB@@@@A@AB@@A@A@@@A@@@@BA@@@ABA@ABA@A@A@ABA@A@AB@@A@ADABAB@@AB@@@BA@@@@BA@A@A@C@CBA@ABC@@@@BBB@B@B@@@BAB@@@@@@@B@@@@B@@B@@@B@@@@@@@@@@ABCD@BA@@B@@@BCDABA@CDABA@@@A@@@AC@AA@@@A@@@ABGFA@A@A@ABA@@@@@@@AE@AAA@@AAA@@AC@A@@@@@@@@@A@@B@@@@@@@@@@@@@B@@@@@@@@@AA@@B@@BBA@@@@@A@@@@BB@@@@@@@@@@@AD@@@@@@@@@@B@@@A@A@@B@@B@@@AB@B@@A@@@@B@@@@@@@BA@@B@@@@@@@BA@@@CBBBA@@@@@@BA@A@@@A@ABA@@@@@@B@@A@@@@@@@@@A@@@A@@@BB@@@@@B@@A@@@@B@@@B@AAB@@B@AB@@@@B@@@A@@B@@A@@@@@@@@@AB@@@B@B@@@B@@A@@@@@BB@@A@AB@@@B@@ABA@AB@BA@A@@B@@@BABA@AB@@@BA@@@@B@BCB@@@@A@@B@BA@@@C@@@@@A@A@A@A@A@AAA@A@@@CAA@@A@@AA@@@@@@C@CBCBABAD@@@B@@CBA@CDABAB@BAB@@@BA@@@A@@BA@@BAB@@@D@@@@B@@@B@B@B@@@@@B@DB@@@@@BB@@BBBBB@BBAB@BA@B@@B@@@BB@@B@@B@BB@@@B@B@B@@@@D@B@@DBBB@BBB@@@BB@BBB@BBB@B@@B@@BA@@D@B@BABBD@@@BB@BBB@@FB@@@B@@ABBD@B@B@B@@A@CBA@A@AB@@EDA@A@EDA@A@A@@@AB@B@@A@@@@@AAE@A@G@A@A@A@AAA@@@A@A@@@AAA@@@@AA@@@A@@@@@A@CAA@@@@@AB@@A@AAA@@@AA@@@@A@A@@@@B@@A@A@@@AB@@@AA@CA@@A@A@@@@@ABCBA@@BAB@@AH@B@@@@@@A@A@A@A@A@CA@@@@@AC@A@AA@@A@AAABA@ABA@AAAA@@@AA@@@CAEA@AA@AA@A@@A@AAAA@@AA@@A@@AA@AAA@@AB@@A@A@@AAC@A@A@@@@@AAA@E@A@A@C@AA@@A@@@A@A@ABA@@@B@@B@B@@@@A@@@AB@@E@A@@@ECCAAAGACAGAC@CAA@AAAA@B@@CA@@A@@A@@@@@AA@@@@@CCA@A@@@@BC@@@@@A@@@AAA@@@@@ABA@@@@@CBAAABC@A@@@A@@@CB@@A@AAA@@BA@@@@A@@AAA@C@A@AB@@AB@B@@@@ABCB@BBBAF@@@BDB@@BB@BBBBH@BBBBD@@BBBBD@FBHBF@BB@@BB@@@B@@AD@DABAD@DAD@BA@@@@BA@@B@@BB@@@@B@@@@B@@BB@@BA@@BB@@BBDDB@@B@B@@FBBBBB@B@@B@B@@B@BB@BBDB@@@BAB@B@B@D@B@DDBBB@@@@B@BAB@@BB@@@D@B@@B@BB@@@FD@@@@@@BB@@D@F@@BD@@B@@@@BBD@B@@@B@@B@@@@@B@@DBBBBB@B@B@@@@AD@@@@CF@@@B@B@B@@@@@B@B@@@@B@BB@BBBB@@@@@@B@BAFADA@AB@@AB@B@D@@ABA@@@@BAB@D@B@BBBDDBBDBHD@B@@BB@F@F@B@BBH@BBDAFAFDB@@@B@@DBBD@@BB@B@@DB@B@B@@@BA@AB@@@@@B@B@@A@A@AB@@AB@@C@A@G@CDCBA@@AA@A@@@@@@B@B@D@B@BB@BBB@@@BB@DB@@BD@@@@BBB@B@D@@@B@@B@F@BBD@@@B@DFD@@BJBHDD@DDD@FDDD@BDBHDFBDBHBHBF@HBD@JAD@F@FCD@@@DBJBNFFBDBDBHDFBBBDD@@FH@@DB@@@@@B@@FDDD@@B@BAB@@@D@@@BBBA@@B@B@B@B@@AB@B@@@@@@@B@@@B@BBH@H@B@D@BAB@B@@@BBBBF@@@BB@B@@B@B@B@@BB@@BB@B@BBBBB@@BB@B@@@@@B@@B@@B@@@@B@BBB@@@B@@@@B@@@BBB@@BB@BBDBBB@@B@@@B@B@BBB@B@@@B@FB@@B@HFBB@@B@BBB@D@B@@@DBB@B@@BDB@DBB@@BBD@F@BBB@@BB@@BBBFDB@@BBA@B@@@@BB@@B@B@@BBBB@@B@BD@@@@B@@@BB@B@BBB@FBBB@@B@@@BBBF@BB@@@DCB@B@@@B@@B@BBBDF@BB@@BFFBDDBFDHFJHHF@@@@@B@D@@B@BBDB@@@@@B@@@B@@@BDBB@BB@@ABA@BB@@B@@@@@B@BBB@@B@DBB@@BBB@DBBB@@BB@@@B@@@BB@@@DBDBB@BBBBBB@@B@D@@@BA@A@ABA@ABAB@@A@@@E@@@@B@BADADCB@BAD@B@B@@ABABC@A@E@E@ABA@@B@B@B@DBHDFBJDHBFDB@D@B@DBJDD@B@B@B@B@B@D@F@B@DAD@HBD@@@BB@B@B@@@B@D@@BBB@@BB@@D@BBB@@BDBDBDDBBDDB@@H@D@DBHBL@FBH@BBF@FBF@PBD@F@B@FA@AA@@@@A@@@AA@@A@@@@@A@@@AAA@A@@@@@AA@@@@A@@A@@@@A@@@@AAB@@@FBDABBB@@@BBB@@BB@@@BB@@@@B@B@@@B@@@BB@@B@@@@@@B@@BB@BB@@B@@@@DBB@@@B@B@B@B@@@BCDABABA@@B@B@BA@@B@B@BBBB@@BDFDBD@@B@@@B@@@@@BA@@@A@@@A@@A@@AA@CCA@@CA@@@AAA@@@@@AA@@@A@AB@@AHIB@@A@C@@B@@@DBD@@@BA@@@@B@BB@@DAB@D@FA@@B@@@@AB@B@D@BADAFA@@B@H@B@@AB@B@DBB@J@B@D@HADAB@@@JAB@@@@ABB@@B@BA@ABA@@@@@@B@@@DBDB@@@@BA@@DB@@B@@@@B@D@@@BB@DA@@BBBB@@B@BA@@B@@@B@@@BA@A@@@@B@@B@@@BBB@B@@@B@@@BB@@@@B@@@D@B@@ABADCBEDCBAB@B@@@BBB@BB@@BAB@@@B@@A@@B@@BB@@@D@@@BAB@BA@@B@@@BDB@@BBB@@BB@BB@BB@J@B@@@B@BABE@ABABAFEDAD@@@D@BAB@D@HCFC@AB@BBDBB@@BDBB@B@BB@@BB@@@B@@@BAB@@@B@BD@@B@@@@@BF@B@@B@@@DBF@@B@B@BBB@@B@@AFAB@@ABA@@@@B@@A@ABAAA@C@C@E@@@A@@@@B@@@BB@B@B@@@DB@@BBBD@@B@BBBBB@B@@@@@BB@@@@@@@A@@BAB@@@BBB@@A@@BA@@D@B@@@BB@D@@@BB@DB@@B@@@B@@@B@@BB@BB@B@@@B@B@@AB@BB@@BD@@@BBD@@@B@@@BB@@B@@@@@B@@BB@B@BBB@BBB@DBH@B@BB@@B@B@@@ABBBBB@@@BABAF@B@B@@FD@BFDBB@@@@ADA@A@@B@@ADBB@B@@D@@@B@@@BB@A@@B@@@@@@H@D@@@B@@B@B@@@B@@ABA@@B@DA@AB@@A@@BBBB@@B@BBB@DB@@@B@H@D@DABBB@@@BB@B@B@@A@@BABA@@BA@AB@BAD@@@BBB@@@B@@AB@D@@@@@B@@@@@B@@@@B@@B@@@B@B@BBBBBBB@BBB@BB@A@A@@B@@@@@BAB@B@@BB@@@@ABA@A@@DABA@@D@@@BA@@@@BA@@@A@C@A@A@@@A@@@A@A@A@C@AB@BA@@BA@@@A@AB@BA@AB@@AB@@@B@B@B@@A@@@CB@@AD@B@@A@@@CBA@@@E@A@AB@BA@A@A@@BA@@BA@A@E@A@ABA@@@@B@D@BBB@B@B@B@@@FB@@B@DAB@B@@A@A@@B@@@@@@BB@@@@@B@@@B@@ABA@@@A@@BE@@BA@AB@@C@C@@@A@E@@AA@@@C@AB@@@@C@@@@BAD@@AB@@ABCBE@@@E@@@A@AB@@@@@@@B@B@D@@@@@BAB@@A@ABA@ABABABABADA@ABABA@ABA@@@@@@B@@B@@@@@@AB@@@B@@@@@B@@B@@BB@@@@@@@B@@@@@BA@@@AB@@@@@@B@@B@@@@A@@BA@@@@@@@@B@@B@@A@@B@@@BB@@DB@@@@@@@@A@@@@B@@@@@@@BA@@@@B@@@@@@@@@B@@@AB@B@@@@BB@@@@B@@@B@B@@@@@@A@@@@@@@A@A@A@@@A@@@@BABA@A@@BA@@@@@@B@@@B@B@@BB@@@B@BAB@BA@@@@@@@@B@@@B@B@BBB@B@B@@@@@B@@AB@B@@@@ABC@@B@@@B@BD@@BBB@@@@D@B@H@B@B@@BBDBD@BBFF@BFFBD@@@D@@BBB@@B@@B@@@BCB@DABABA@ABABC@@@@B@BABABABCB@@ADABA@ADBB@DB@BB@@@B@BADAJEBAFEBADCDA@AB@@@DA@BHABA@ADAD@B@D@F@F@D@DA@@DAD@BABA@C@CBC@A@ABCBABCBAFADABADCDCBADCBCBABCBC@C@A@C@A@CACAEACAAAAABCAA@C@AAAA@@CAACCC@C@A@ABABABCBABEBA@CBAA@@CAAAC@AACAAA@AAACAA@C@A@EBA@ABC@C@CBCDCDABAJABAHCBCFABCDC@E@A@AAA@CB@@ADBBB@A@@@AAABGBABCBABA@@@@BA@CAC@A@@@CB@@AFIDGBEAC@A@@FCFA@A@C@A@CDADCDADADABADCDA@CA@AA@@AA@AAACCACCKAI@@A@@@A@ABA@A@A@@@@BA@A@@@@@A@BABCBABE@C@AA@@A@@ACAC@CACACACCC@A@A@AAA@@@AA@@@CB@@AA@A@@@@A@@A@@@@A@@@CBA@A@AA@@@@A@@BA@@BAB@@A@@@AA@@@AA@AB@@AAA@@@A@@@@B@@AB@@A@@@AA@@@@@@ABCD@@@BABCBC@@@C@CCA@ACA@@CA@@@A@ABAB@@@BA@@@AA@@A@A@A@A@A@@@@A@@AA@A@A@@A@@B@@@BAB@BA@@BA@@@AAA@@BA@A@C@A@A@@A@A@@AA@AAACC@AAAAA@A@@A@CACCAAA@@CAAA@AAACACEEACAA@@AAA@@@A@@@@B@@AB@@A@@B@@@@ABAB@@A@@@@@AAAAAAA@A@C@A@@AAA@A@C@E@C@A@AAA@@ABAB@B@@AA@A@A@AAA@AA@@BA@@BA@A@@AA@C@A@CAAAAACC@A@CAAA@@@ABA@@AAAAA@@ECACAA@AA@@A@A@A@@@A@@BAB@BA@CBABA@A@@@@A@C@A@A@A@AB@@A@AAA@@@AAA@@@A@AA@@C@@BA@@AA@@@A@@@A@@@@@@BA@A@AA@@@A@A@A@@@@AAA@@ABA@A@@@AAA@@@AA@@@B@@@@A@@@@@@@AA@@@@@A@@A@A@@@A@A@@BA@@B@BA@AA@@A@A@C@ABE@@AA@@@@@A@@@@@@@@@@A@@B@@A@@@A@@@A@@@@A@@@@@@@@@@@AB@@@@@A@@@@@@@A@@@@@A@@A@@A@@@AA@A@@@@@E@CBKBSCM@IBCAEAKAI@AACCAAEAAAAACBA@CDCDAFEDABCBA@A@EBI@GBE@@BE@@@@@@BACAC@ABE@ECCAACBGFIDIBEBCDA@ABCHEHABAHCF@FBBDBBDABCHEHCD@HAFBDBFCHCJCBA@ADA@ADCDG@C@E@AACACAAGBCDABCDADCACCAAAAEAGC@ACABCBCBCDC@ABCDQ@CAAACAA@CDAF@DBDBDB@AAA@@MOAACCACBABCBCBABEBABAFAFFFLFLDFBB@BFDJJDDFDFBD@D@BCBG@EBAB@JJFB@@DABC@G@GBC@C@WBKBCDCBCBCFA@@B@BBLBFCLGBEAC@CAOCI@G@GD@@@F@DDBBFDFBDADABABCH_BEDABABC@AAA@@BAB@AA@@@AA@A@@@@B@@CA@@A@AB@@CAA@AA@B@@A@C@@@C@@@ABE@C@@AA@@@A@BA@@@@@@@A@@@@@@@@@A@@A@@@AA@@@@@@@@AA@@@@@A@@A@A@@@@A@A@A@A@A@@@@@AB@@@@@@A@AA@@@@@@@A@@@@@@@A@@@@A@@@@A@@A@@@@@@@A@@@@@A@@@@@AA@@A@@@@@A@@@@@A@@@@@@@@@A@@@@@AB@@@@@@@B@@A@@B@@@@@@@BA@@@@@@@A@@@@@@B@@A@@@@@@@A@@@@@A@@B@@@@A@@B@@@@@@A@@@@AA@@@@@@@@@@@A@@@@@A@@@@@A@@@@@@@@@@@A@@@A@@@@@@@A@@@@@@B@@A@@@@@A@@@@@@@A@@@@@@B@@@@@B@@@@AB@@@@@B@@@@@B@@@BA@@@@@@@@BA@@@@@C@@@@@A@@B@@A@@@@@@@@@@AA@@A@@A@@@@@@AA@@@@@@@A@@@@@@@AB@@@@A@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@B@@A@@@@@A@@@@@@@A@@@@B@@@@@B@@@@@@@@@@@B@@A@@B@@@@@@@@A@@B@@@@@B@@@B@@A@@@@@@@A@@@A@@@@@@@A@@B@@A@@@@@@@A@@@@@@@A@@B@@@@A@@@@@@B@@A@@@@B@@@B@@@@A@@B@@@B@@@@@@AB@@@@@BA@@@@@A@@@@@@BA@@@@@@@AB@@@@AB@@@@@BA@@@@@A@@@@@@@A@@@@A@@A@@@@@@BA@@@A@@@@@@B@@AB@@@@ADA@@@ABADA@@@AB@@@BADEB@B@BA@@BC@@@@B@@ABAB@BABABA@@@AB@DA@ABEBA@@@@D@JADBB@BB@@B@@@DBB@B@B@B@@@B@@@@@@A@@@ACA@@@A@A@@AC@@AA@@A@EAE@@@AAA@CA@B@@@@AB@@@@CAA@AACBEAA@@@@D@@@@A@@@@@A@@B@@B@@B@@A@@@A@A@AA@@@A@@@@A@@@A@@A@A@A@A@@BA@@@@@@A@@@@@@@C@A@@@@@@@A@@@@@A@@@@@ABA@@@@@A@@@@@@@@@A@@A@@@@A@ABA@@A@@A@A@@@@@A@A@@@AAA@@@C@@@@@@A@@@@@@@@@A@@B@@A@@AA@AB@@A@A@@@AAC@A@AAA@E@A@A@A@C@I@@@A@A@@@C@@AACC@@AA@A@A@@@@@C@@BEBC@A@CAA@A@ABC@A@@@A@A@@@A@@AA@@A@EAECCAAA@@@@AB@@ABC@ABA@A@@BA@@@C@@@AACA@@CAAAA@ADA@@@A@A@CCA@@@@AA@@@C@@@A@AA@@@A@AACBA@CBC@@@@C@ABA@A@@@AAA@@@A@A@@@@@A@ABA@KDA@A@A@A@A@CAAAA@@@@AAABAB@@ABA@@@A@@BABA@@@@AC@A@@BAAA@A@@AA@@A@A@AAAAC@AAA@A@@@@A@AB@@@@A@ABADCBA@@@ABA@ABA@@BA@@@AB@BCB@BCFI@@@@C@AA@@ABCBA@A@AAA@ABABA@@@C@@AEACAC@A@E@@@AA@@@AGAC@AAA@A@C@CBA@A@CDC@C@@BCBA@@BAB@BABA@A@@@A@@AC@A@EA@@C@A@@@@AA@AC@AAACAA@AC@@CBAB@B@BAB@@C@C@C@CBA@A@C@@@@BA@@BA@@BA@A@CAA@C@@AA@@@BA@@@C@A@@AAG@A@A@CA@@@AA@@@@@A@@@CBABA@A@A@CAAAA@@B@@@D@BCD@D@F@BA@@@AAC@AA@@A@ABA@CBA@@@AAA@@A@AA@@A@A@@CAAA@@A@A@@AA@@C@@AAAA@A@@AA@@A@A@@@A@AAAA@@@A@@BA@@@A@@@A@A@@A@@@C@A@C@AAC@ABCBABA@A@A@A@@@@@@BA@CBABABABABCBA@@@CAA@ECEAMECA@@A@ABB@ABABA@C@C@A@AAA@AA@@@C@E@@@ACAAACA@ACA@@CC@C@@@C@C@AA@@AC@A@@AC@@@C@@@A@@@AAAAA@AAA@A@A@CC@AAA@CA@@AA@CA@AA@@A@A@A@@AAACCC@AA@@@A@A@A@A@ABABCDABA@@@C@@BC@AAC@A@CA@@A@GF@BA@@@AA@@EBABCB@BA@@@AACAA@@@A@A@A@@@AAA@@@EAA@AAC@CAABI@A@A@AAAAAACC@A@@@@"],encodeOffsets:[[107642,36289]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("定西",{type:"FeatureCollection",features:[{type:"Feature",id:"621102",properties:{name:"安定区",cp:[104.610668,35.580629],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@AA@@@@@AA@@AA@AA@@A@@@AAA@@AAA@@AA@AA@EAA@ACA@@AA@@@A@AAAAC@AA@A@@AA@ACA@@AA@@A@@@AB@@@@@@@B@@@@BBA@@@@@A@@@@B@@B@@B@@@@BB@@@@@B@@AB@@@@@@B@B@@@@@BB@@A@@@A@@BB@@@AB@@@@@B@@@B@@@@@@B@@@BB@@@B@@A@@B@@A@@D@@B@B@@BB@A@@B@@@B@BB@AD@BAB@@BF@B@B@BBBAD@B@BA@@B@@@@B@@@@@B@@BDB@BB@@@@B@BBB@@BB@B@D@BA@@@@F@B@@@B@BABAD@@@HAD@@@B@@@BBB@@BDD@@@BBFDBB@@@BBB@@@B@@BB@@@@BD@@@@D@BBB@@@B@B@@@ABABABAB@BA@A@AB@B@@@BADB@ABB@@@@B@B@@@@@B@@BB@D@D@B@@@@@AAAGEA@CCCA@AA@A@AA@@A@@@@BABABCBCAA@A@@@CA@@A@AACACAAA@B@@A@ABA@C@@B@B@BABAB@D@@@BABA@ABABA@A@@@ABA@@@AB@@@D@@A@@@@BA@A@CBA@A@@@A@@@CBA@@@A@A@@@CBE@A@@@@B@B@B@@@B@@AB@@ABA@@@A@AD@@@BAB@@@B@@@D@@@@@BABA@@D@B@@AB@B@@A@@D@@A@A@@B@@B@BB@B@@ABABAB@B@@@B@@ABABAB@@@B@@@@A@A@A@C@E@@@@BA@CBEB@BC@ABABCB@@ABAAABAAA@@@C@C@A@@B@@B@@@BB@BB@BD@B@@@BB@BD@@@BD@DBDB@@FB@@B@@@BBBB@@@BA@@B@DB@@BBD@B@BAB@FA@@B@D@@@@ADABAB@BBB@BBF@B@BAB@D@B@@B@@@B@H@B@BBD@B@@@B@@BBB@@@DAD@BA@AD@B@BAFAB@B@@BB@BB@BDB@BBBD@@@B@B@B@B@@BBDBB@B@B@@@B@DAD@BA@@BAHEB@BA@@B@BB@@B@D@B@DBB@D@B@B@DAD@HCB@B@BBDD@BFDBBBB@@@@AF@BAB@B@B@@@D@FBBBB@@DBFBDB@@FFBBB@D@D@@@B@B@@@@@@BBF@BBD@BBF@D@F@BAB@@@H@B@@C@@BA@@@AD@DA@AD@B@B@B@@BBBBDD@@@DADA@@B@B@@CB@@@B@@@@BB@@BBDBD@DBD@D@B@@@B@B@DBBFB@D@@B@@BBBHBB@@B@@@B@@@B@BB@B@BAD@@ADA@@BA@OBC@ABA@@@@BB@@BCBAB@B@@@@BB@@B@@B@BBBA@@B@@@D@B@B@B@@@DB@@B@B@@@@@B@@B@BBBB@@B@@B@B@B@@@@ADABAB@B@@AB@@@B@@@B@BA@BBA@@@B@@@@B@@@@@@@@@@@B@B@@@BBBB@@B@@BB@B@@DDBB@@B@@@@@@B@@B@@@@B@@@@BBB@@B@@B@@B@@@@@B@@@B@B@@@B@B@BA@@B@B@@BB@@@BB@@BB@B@BD@@B@@B@@B@BBB@B@DA@AB@@@BBB@BBB@@@@B@B@@@BBBB@BBB@DB@@B@B@B@B@B@HB@@@B@@@@@B@B@B@@@BAD@@@BB@AB@BA@@BB@ABBB@@@@@B@@@BB@@B@@BBBB@BB@@@BB@@@@BDDBBBBD@B@@@@BBBB@BBB@@D@D@B@D@B@BA@@B@@@B@@AB@BABA@@@@@@@D@@@@BBDF@@@DBBBBBD@@BB@@BB@BDB@BBBB@BB@BBB@B@BBB@BBBBBBB@@@D@@@B@BA@@D@@@DB@@B@BAH@BABA@@D@@@@B@B@@@@@BFBBBDBF@D@B@DBD@B@D@@DDBBBB@@@BD@@B@@@BAB@@BB@D@@@B@DADA@@BA@BB@@@BBD@@BBBBDB@B@BFDBBBBBBBB@D@@@B@B@B@@BB@@B@F@@@DAB@B@@B@@D@D@B@@AB@DAB@@@B@@@BB@@BD@BFDBBDFB@@@@B@@@@CDABAB@@@BA@AB@@@@@BB@@@@BA@ABAB@@@B@B@BB@BDBD@B@@@B@@BB@@AB@@ABADABAB@@AD@B@@ABCB@BCB@BC@ABCBABA@AF@D@BA@@BBB@@@B@DB@BBBD@@BDAD@@@BBBBB@@DB@B@@@D@D@@@DBDBBA@@BAB@B@BAD@@@@@BA@@@@D@DBD@@BB@@BB@B@@@@B@BCBA@@B@BB@@BB@D@B@B@BBB@B@B@B@D@@@DBB@DAB@B@@@BBBB@@@B@@BBB@@F@@BB@BD@@B@@@B@BABAB@B@B@@@@@@BBB@@@BDB@B@D@B@BB@BAB@@@@BB@@BB@BBD@@@@@BA@ADABAB@B@@@B@BB@@@BBBBBBBDB@@BABB@@B@@F@D@F@BAB@@@BBB@@@F@BA@ABA@@@@B@@@BDF@F@@@@BDBBB@@BB@@B@@@@@@B@@@@@B@BBD@B@B@BAB@@@B@BAB@B@@@B@BADABA@@@@@ABA@@BAB@D@B@BB@@@B@@B@@AB@BBB@@@DA@@B@@@B@D@BABBDA@@@@B@BA@@@@B@BB@@B@@@@@D@@A@@B@B@DD@@@@B@@B@@@@@BB@@@DB@@@ABBBBB@DBD@HBDBHBBBDBFD@@B@F@@@BA@@B@@@@@@A@AA@@@B@BAB@B@@@B@@@BBD@B@B@F@B@BB@@@@B@B@D@BB@@@B@BA@@BB@BBB@@BB@@@BB@@BBBBB@@@@BBBB@@BFBDB@@B@@B@@BBBBB@BAB@BABBB@@@BBB@D@@B@@@@DBB@B@B@B@B@@@@@@@@ABG@@BA@AB@DABA@@@@B@B@@@DBB@@B@@BA@@B@B@@@@A@@B@B@@@@@BB@@B@BBB@@@BA@@@@B@DBB@@@@@B@@@B@@B@@B@BB@@B@B@@@B@BBB@B@B@H@B@F@BB@@@@B@@@@ABA@@B@B@B@FCB@B@FC@@BAB@B@DAB@@@@A@A@AACBA@@@A@@EA@@AA@AAA@@C@AAABA@C@@@AB@@@AA@A@AAA@AAA@@A@@AA@AAACA@@@A@C@@A@A@A@@@A@@A@AA@@@AA@@A@@@@AABA@AB@AAAAA@AA@@A@@@@CAA@@@@@A@A@A@@@A@@@@@@C@@BA@AB@@AB@@@B@@A@@BA@ABABADCB@DA@@@A@@BCBADADAD@@@@@@@BB@@@BB@DB@@B@B@BBB@B@B@B@B@@@@@D@@@B@@A@AB@@@@@DA@A@A@@B@@A@@BAB@BA@A@@@AB@B@@ABAB@BA@@@A@@BAB@@@AA@@@@B@@@@A@@@A@A@@BA@@@@@@@@B@@@@AB@@@A@@@@@BAA@@@BA@B@A@@@A@@B@@@@A@@@@AA@@B@@@B@@@@@@@@@B@@@@A@@@@B@BAB@@@B@B@@A@@@@B@AADA@@B@@A@@@@@@@AB@@A@@@@@@@A@@B@@@@ABA@@A@@@@AB@B@@@A@@@@@@@@@@@BC@@@@@@@@@@A@@A@@B@@@@@B@AA@@@AB@@B@@@@@@@@@A@@@@@@@@@@@@@AB@@@@@@@@@B@D@@BB@BB@@BB@BBF@@@@@@B@BAB@B@B@HEBA@@B@@@B@@BBD@@B@@@B@BADCB@BADC@A@@@AB@@ADCBA@@@@@@@@@@@A@@@AA@@@@@@A@@@@@@BA@A@@@A@AAA@A@@D@BAB@DAD@B@B@B@@A@@B@@A@@BA@@BABABCB@@@BAB@B@BAB@B@B@BAB@BA@@B@@A@@B@@@B@B@@@BAB@@@@@BA@AB@@A@@@@@AB@@A@@@@@@@@BA@@@@AA@@AA@AAEACAC@@AA@@A@A@@@@@@A@AAE@A@G@A@@B@@@BAB@D@DC@@B@BBB@@@BAB@@AB@B@B@@@BB@@B@B@@@@@BA@@D@B@B@@A@@H@B@B@@@BCBAB@@@BA@@BADA@@B@@BBB@@BA@ABABAB@D@@BB@B@@@D@B@@@BCB@@@B@@@B@@@@@@A@C@A@E@C@@BADA@@BABB@@BA@@B@@BB@@@B@@@@@B@@@B@@@@@B@B@BBB@B@@@B@AC@@@A@A@C@A@CA@BCAC@AACAAB@@@@@B@@@@@B@@@@@@@@BBBB@B@@B@@BB@@@AB@@ABA@@@@BB@@B@B@@@B@@AB@@AB@@@B@@@@AB@@@@@BBBBBBBBB@B@@@BAB@F@F@@@B@@@@C@E@C@A@ABA@A@ABA@A@A@A@ABA@A@EBC@@@A@@BQ@A@@@ABABCBA@ABAAABAAA@A@A@CAA@AA@@EAAA@@A@@B@@AB@B@BAH@DABBB@B@@A@@BA@CBABA@@B@DAB@@AB@@C@@@AB@BCB@@@@I@A@GBC@@@@@E@A@@@@@AB@@@@A@@DK@@@AB@@@D@@@BBB@@@@@B@BA@@@@@@B@BB@@@@@A@C@@@@B@B@BB@AB@BA@A@A@A@A@@BAB@B@DCDC@@DC@@B@@@@@BA@@@@BB@AB@@@BAB@@@BABADA@A@ABAB@BEB@@AB@B@@BB@@@B@BBB@@@B@BBB@B@B@BAB@B@@AD@BABAB@F@B@@@B@BC@AB@D@@@B@@B@@B@@@F@D@BAB@@AFCDA@A@@B@@A@A@@@@BA@@@@@@@AB@@AB@BA@@DAB@@ADABC@@BA@A@C@@BCBC@A@@@A@@@A@@HEBA@@BAB@@@BA@@DIBA@A@A@@BA@AAA@AAG@C@@AAAACA@@ACAA@A@A@@AABA@C@@@A@A@ABA@@@@@A@@DI@C@C@A@A@AAC@A@A@A@C@@AAAA@AA@BA@@@A@@BA@AD@@ABAACB@BA@A@@@@CA@C@AAA@@@@AAA@AAAAAAA@AAA@C@@@CAA@ACAA@ACAA@@AA@AAAA@AAC@@AC@ABA@CBA@A@@ACACAGAEACAC@CAA@@BCAA@A@AAACCAA@@@@@@@AAAAA@A@@@@@C@A@@AC@A@@@@@A@A@AAE@A@@@A@@B@@A@@AC@@@A@A@@@A@A@@@@@@@AAA@AA@A@K@A@A@A@@AA@@AAAA@@@C@@@@@@AAC@@BC@A@@A@@AAA@A@@@ABABA@A@A@A@@@AAAA@AA@AB@AACA@A@@AA@C@@BAB@B@@@@A@@BA@E@A@@BA@AA@@AA@AAA@@ABABA@@@@D@B@B@@@AA@@AA@A@@@ABABA@A@A@@@@@@BA@A@@@A@@@@@@BADADCB@BA@A@@DDD@@@B@B@D@B@BAB@FAB@@ADA@BD@B@B@@@B@@ABA@CBEBA@@DA@@@ABA@@DA@@@AB@DAD@DAB@D@H@DAJCB@B@BA@@@C@E@I@A@@BA@@BAB@@@BABA@@BAB@DABADAB@@@FEFABCDCDA@A@@@@@AC@AAA@@@@A@@@@@A@@BA@@B@@@@A@@A@C@A@ABA@C@A@CAA@AA@AC@AAA@@@IEAAAAA@AAA@AAAA@@@@BABB@A@@@@@A@@@@@A@@A@@A@@BA@C@@@A@@B@@@DB@@@A@@@@CA@A@A@@@A@@@@@AA@A@@@A@AB@@@AAACEAEAAAAAACA@AA@ACAC@AA@@A@AAC@A@@BC@AA@@@CA@A@@@AAAAAAAAA@@@AAAACAAAA@A@@@A@@@@CAAA@A@@@AB@A@@A@@BABADA@@B@@A@@AAAA@@@@@A@A@@@@AAA@A@@AA@AAAAEAAD@@A@@B@@CCEAAA@AC@A@AACAA@A@@AE@@@C@@@AACEAC@ACCAAAA@A@A@ABCAABC@@BC@E@C@A@ADC@A@@@@@@A@A@@@A@A@@AA@@AB@@A@@@ACACAC@BAB@B@@@@A@A@@@AA@ECAAAAA@@@GAAAAAA@A@GDC@C@A@E@@@ABA@@AA@@CACAEAAAAA@A@A@@@IBC@ABA@A@A@@B@D@@AB@DABA@@@A@C@ABIJ@@BB@B@BADBB@@B@@@BA@@B@B@@@B@BA@@BBB@@@BB@B@B@@@B@B@BA@A@E@@@A@EBCD@@EBC@C@ABA@A@AAA@@@CB@@C@A@EACAA@@@A@AB@@C@C@@@A@C@ABA@CAA@AAA@A@@@A@@@@B@B@F@BB@AD@D@B@B@B@B@@@B@D@B@BCD@@@BABAF@B@B@@@D@@BB@BDB@DBB@B@B@D@@@H@B@B@B@D@FD@F@AB@B@BA@@BAB@@@@@B@@A@@@@B@BA@AB@@B@AB@@A@EAA@AA@@CACA@@AAA@@@ABABABABADABCFCBABCB@@ABA@A@GAA@ABA@GFA@@AA@@A@@@AAC@A@A@ABE@C@A@@A@GAEB@@CD@BA@A@@@A@@@AA@@AAIAAA@ACC@@@C@A@@AA@A@AEK@@AA@B@@A@@BA@@D@B@@A@@@A@@@AAA@A@A@@AA@@A@A@A@AA@@AA@A@A@A@@@@B@B@BA@AB@@A@CA@@@@@@BA@@AAC@A@@@A@AA@AAA@@AACAAAA@@@AA@ACCA@AA@@CBA@I@C@A@@@A@@A@@AA@@A@A@AACCAA@@A@A@@@ADAD@BABCF@@ABA@A@@@A@AAEA@AA@A@CDA@ABC@CDEFEFA@@@AB@B@@A@@AC@ABABABA@AD@@AD@@CF@@@@C@C@A@E@@BA@@B@@ABGBCBC@E@EAC@CAI@A@CC@@A@ABC@ABA@A@CAA@ABA@E@C@AAA@CCC@CAA@A@AB@@A@AA@@A@A@A@KD@@A@A@C@AAGCA@@AA@A@@AG@A@EBC@A@CAA@@@A@@@A@A@A@A@C@A@E@A@ABA@@@AAC@AAA@@AAACEAC@AAA@@A@@AA@@A@CAA@AA@C@@@A@A@AA@A@CA@@AAAAAA@AA@@A@ABA@@@@AA@@AA@A@@A@@A@@A@E@@@@A@AAAAA@@@A@A@@@AAACC@A@@@@BCB@@A@AB@@A@@@@@@@CCAA@@@AAA@@@A@@A@@@@@@AA@@@@@A@AAC@AA@@@@@@A@@C@@AA@@A@@AA@@@C@A@@@A@AB@@A@@@@@AA@@A@@AA@C@A@A@@@AB@B@@C@@B@@@@BB@@@@@B@@@BABB@@BAD@@A@ABA@@@A@AB@@AB@@AB@B@B@@ABBBA@@BAB@@AB@BBB@@@B@@@B@BA@ADA@@BA@@BA@@B@@@BB@@@BDB@@BAB@@AD@@@@@@A@@@AB@@@BCBA@@@AB@@@@CB@BA@ADA@A@EAAA@@AE@@A@@A@A@@A@C@@@CAA@C@@@CB@@ABA@@@E@A@@@@@@B@B@@BDBBBB@B@@ABABAB@@BB@BA@CB@@C@@@A@@AA@@AA@C@A@A@ABA@AA@@A@A@AAA@CAAAAAA@A@A@@@CDA@@@A@CA@@@@ABABABA@@@A@AA@@C@@@AAA@AA@@@A@@@A@@CACCA@A@A@A@@B@@@D@@AB@@E@@@ADABAB@BABABAB@@@DAB@@A@@@A@@CA@AA@@@@A@@@A@AA@AAACA@@@@A@@@A@A@A@A@A@C@@@ABA@@B@@CA@@A@@AA@@@A@@@A@AA@@A@@B@@A@@AA@@@A@@@AA@@A@A@@A"],encodeOffsets:[[106841,36228]]}},{type:"Feature",id:"621121",properties:{name:"通渭县",cp:[105.24206,35.210831],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@BA@A@@AA@CCCC@@AACAA@CAA@@AA@@C@@@CA@@A@@BA@AB@@@@A@A@ACC@@CAA@CB@@AAAAAAA@AAA@A@@BA@@B@@BBBD@D@BAD@@BB@B@@@@@@ABAB@BAB@D@B@@@@@BC@ABA@ADA@ABAB@B@@ABCD@@@@@B@BAD@BC@@B@@@@AB@@@B@BB@@BABABAB@@@@ABA@@DA@@D@B@@BB@BAB@B@@A@@@@BAB@D@DAB@@@@@BAB@@@@A@@BA@@B@@A@@BBB@@@BDF@B@J@@BDFBBB@@B@@B@@@@@@@@@@BB@B@@@B@B@BADBD@B@B@B@B@@@BC@@@@@@DBDAB@D@@@B@B@BBBBD@@A@A@G@C@A@@@A@A@AB@@ADABBB@BAB@BBDBFDDB@DDJFHDFBBBB@@B@@@BABIJABADA@@BA@@AA@A@CACAAA@@AC@A@AA@A@C@AB@@CD@B@B@B@@A@ABA@AB@BABABABAB@@ABB@@DAB@B@B@BAD@@A@@@A@AAC@A@A@@@AA@@AC@A@AAA@CAA@A@@AAA@@@@@AB@BAFAH@@@FADAD@@@BAB@BB@@B@D@D@@AD@B@FB@@DDD@@@B@B@@@BA@@D@F@BB@@B@@B@FBB@BBDB@BB@@B@BABABAB@@A@@BA@@@A@@@@BB@@A@@@@BB@@BB@@@BFD@B@@DBBB@B@@@BB@@@BBD@D@@@@B@@DABB@@@@B@@ABBB@B@D@B@BBB@@BBB@@BB@@@@DBB@@@@A@CBA@BB@B@@@BEBA@@B@F@B@@@@BD@B@B@HAB@@@@@@B@@@F@D@BBDBB@B@@@@@B@D@@CB@@@BABA@@@ABA@A@CAA@A@CBA@@B@@@B@B@@BB@B@D@B@BABC@CB@@AB@BA@@BA@@@@B@@@BBBABB@@B@B@@B@@B@BBB@@@@@@@B@@ABGFEDCDC@@@ABAB@DBDDB@D@@@B@@@B@@@@@@A@@BA@A@A@A@AB@@A@ABAB@@A@@@A@@BA@A@@@A@CBA@A@@@@A@@@@@@A@ABCB@@@@@B@@@@@@BB@@AB@@@B@@@@ABA@@@AD@@@BA@@@A@A@CCA@AAA@@@AAAA@@CACAA@A@@@A@A@@@A@@@@@A@A@@@A@@@@@A@@@A@A@@@A@A@A@@@@@A@AA@@AB@AA@@@A@@A@@@BA@@AA@@@AAA@@@A@@AA@@A@@A@@@@@A@@@@@@B@@BBBBB@@@@B@@B@@@@@BD@BB@BB@@@@B@@D@@@@@@B@@@@B@@@@@BB@BB@@B@@@A@@BB@@B@@B@BB@@@BB@BBA@B@@@@@@@B@@B@@@@B@@@B@@B@@@@@@B@@B@@@@B@BB@@B@@@B@@BB@@@@@BB@@B@@B@AB@@B@@@@@@@@@@B@@@A@BBB@DB@@@B@@@@BBB@B@BBBBB@@BB@@@B@B@@B@@@@@@@B@B@@B@@BA@@B@@@@@B@@@B@@AB@@@@B@@@@@@B@@@@@B@@@@@BB@@@@B@@@@@@@@@@@@BB@@B@BBB@D@F@B@@B@@B@BBB@HABB@BB@DBB@BBBD@B@@@BABCDABEDA@AB@BAB@@BBBBBBBD@@@B@BAF@B@B@@@B@@@D@@@@BBFBFDFBBBDD@B@@@F@DBJ@@@B@B@@BBB@FBB@B@@@BAB@@@@@BB@DBBB@@BBB@D@BB@B@B@BAD@JA@@B@B@B@BBBBBFBD@DB@@BB@BA@@F@B@D@D@HCB@B@BBBBHB@@B@BBBBFDB@@B@@@B@B@@A@A@ABD@DBDB@B@@@BA@@BB@@BB@B@@@B@B@@@@@@@@BCD@B@B@D@FAD@@ADBBAD@B@B@BBBBBDD@BBDDFBB@@D@@@F@@BB@B@DBBBB@D@@BBBFBDD@@@AB@@@BCFBBBBBB@@BB@B@BB@@@@@B@B@@@@BBBB@@@BA@@@CBABAB@@@BB@A@@B@@@BBBDB@@@@@B@@@BBBBBBDBB@B@@BBBBBBBB@B@@@BDB@@B@@BAD@@@BBD@B@BB@@BBDBDB@@BDBBBBBBBBFDFBB@B@@BAB@@@B@B@@B@@@@@B@@@B@BDB@@@@@B@@CA@@A@@@@B@@@DAB@@@BB@@@@B@@@@@B@@@@@BAAAB@@@@BBBBB@BBB@BBBBJF@@B@BBD@@BBBB@DBB@D@B@BAB@D@B@@@@B@@A@@@AB@@@B@@@@@B@@B@BBD@@B@@@@@BCBD@B@BBBB@@DBHFDBFDB@B@@@@@@@@A@A@A@AAAA@@@@AB@@@@BDB@@B@BB@BBA@BB@@BBBABB@@@FDBB@BB@B@B@BAB@DAB@D@B@DABAB@B@@@B@@@BBB@B@B@@AD@B@B@BA@@B@@A@A@@@@AAA@AA@A@@@@B@@A@@@@@@@A@AA@@@@@@AB@@AA@@@@@@@@@@@@A@@@@AA@@@@@AB@@A@@@A@@BA@@AA@@BA@@B@DADA@@@@BCBA@@BA@A@@@@@@@@@@@@AA@@B@@@@@@@B@@A@@@A@@@@BAB@AAB@BABAAA@@B@@A@AB@AAB@@@@@@AA@B@BA@A@@B@@@B@D@B@BAB@B@@@@AB@@@@@DAF@@@@@B@BF@BBB@@BBB@BBB@FBF@@@B@BA@@BAB@@@B@B@@@B@@AB@B@@@B@@DBDBB@B@@@B@B@B@@@@@@@@@@@@@BB@@@@@@@@@@BBB@B@@@@@BA@ABABABABAB@@A@AD@@@@@BDDBBBBBBB@B@J@BADBD@BBB@FB@@B@BB@@B@B@B@@@B@DBBB@@B@@ADABAFA@@BB@@B@@AHEB@@@DBB@D@BBD@@AD@@@B@BADCBABAB@B@B@B@@@B@@BDDBDBB@@@B@B@BB@@BDBB@@BB@@DBB@BDDB@B@B@BBB@BBBB@@B@@@D@@@D@@BB@D@@BB@@B@D@D@@@DDD@@DB@BDBBBDB@B@@@F@D@@BBB@BBB@D@D@B@BABAA@BAB@@@DBNFFBFDB@DB@@B@DABABABABADAB@@A@@@@B@B@B@B@BADABAD@BBD@B@D@@@B@@@@B@B@@@B@@AB@@@B@@BBBBB@@@B@B@@@BB@@@BBBBB@@@DB@@BB@B@@@BBDB@@@B@BB@@B@BB@BB@@B@DAB@BAB@@@BBD@BB@@B@@A@E@CDC@A@C@@@AB@BBDBB@B@B@BADA@@B@@@@@B@@B@@DBB@B@H@BB@@@B@D@@AB@@B@@BD@B@DBB@B@@AB@@AB@@A@@D@B@B@DAD@D@D@@@BA@A@ABADA@@@@@A@C@C@@BC@AB@@@B@@A@@@A@A@@BA@AB@@@FBB@F@@@BA@@B@B@B@@@BA@@BAB@B@BAB@BA@CDABCBADCBA@AB@DCB@B@BAB@BA@@@@@A@A@ABA@ABA@@BA@A@C@AAA@A@A@ABA@A@A@AB@@A@A@CAA@A@A@A@@BA@@@A@@@A@AAA@A@@@A@@D@B@DAFEBA@AB@@ABAD@BA@AB@BAB@BADC@@D@@@D@@@B@@@BA@C@AAC@A@@@@DADC@@B@D@BAD@D@D@BA@@@@@AAE@A@G@@@A@@B@BCBA@AAA@AA@@A@@BA@@@AB@BAB@@@@@@@B@@@BCB@@ABAB@B@FABAB@@A@@@C@ABA@A@ABA@@@AA@ECGEAACA@@@A@@@@D@D@D@BAF@@@B@@BBB@D@@BB@BD@BBBBDFBB@@B@@@@A@ABABA@@@ACC@A@ABA@AAA@@AACC@A@A@@D@B@@A@@BCBCB@B@B@B@BBD@B@D@B@B@D@DBFFB@DD@@@B@BADABBF@@AB@B@@BD@B@D@B@B@B@BDHB@@BB@@B@B@B@BA@@@A@A@@@ABAB@BABBB@BBBDD@BB@H@D@D@D@B@B@@@@ABA@A@@B@BAB@@@@ACAAAA@@@@AAABA@A@ABA@@BABABA@A@C@@B@BABA@A@ABC@@BA@AA@@@AA@@ACAA@@@A@CB@@@B@B@@@@@@B@B@@B@D@B@@@BAFA@@BA@@B@B@BADBB@BBD@B@@AB@@@@@A@@AA@@A@A@A@@B@F@D@B@@@BA@@BAB@DADAB@B@BCB@@@HAFAB@@AB@@ASWA@@ABGBA@A@@A@E@@A@ABC@@BEBC@@A@A@@@A@A@AA@A@@@@FABBB@B@B@@A@A@CBA@AB@BA@@BABA@@@A@@BADAB@@BB@@B@B@@@@BBJ@DBB@BAA@@A@A@A@A@A@A@@@A@A@A@A@C@@AAAA@@@AC@A@KA@AA@@@@A@A@CBADC@@@C@A@A@CCA@A@G@@@A@@@A@A@@CCAACA@ABCDC@@B@@A@A@ABABABA@A@@@C@@D@@A@C@@@@F@D@B@BADC@@@AA@@AAA@@@C@A@AAA@A@@BA@@BA@@DA@AB@@C@ABABABABA@@DCBA@A@AB@BAB@BA@@@@@A@A@@BA@@BABA@A@@@AB@BABABC@A@A@@BA@@BBB@BB@@B@@@BBBDB@@BB@BBBDB@@B@BBBBB@@DDD@BBFBFDDBB@B@BBBBBBB@BBB@B@@BB@DDBB@BBBB@B@D@F@@@@@@C@@AA@@@AA@@AA@@@B@AA@@@AA@A@@A@@@A@@A@@@AA@A@@AC@@@E@A@@BAB@@A@A@C@A@C@@BA@A@@A@AACAAA@AC@A@@AAA@A@@@@BA@ADCBADB@@B@@@B@AA@A@A@A@A@@@A@@HAB@@ABC@@CEAA@AA@@AA@@AA@@AA@@@AA@@@A@@BAD@@ABBD@@@B@B@B@@@B@@@F@D@@@B@F@D@BAB@BC@A@@@A@@A@@@@@@@@AB@@@@A@@A@@@BABA@A@@@ADAB@@@DEDA@@@AB@@@B@BAB@D@B@B@AAAAAC@AAA@A@@ACCA@A@@@@@E@A@AAA@@@@EBA@A@A@CB@@A@@@C@ABE@ABA@A@A@@@A@@@@BAB@@@@AB@@A@A@A@CD@@A@@F@@@@@B@@@@ABC@C@C@EAAAA@@@A@@@ABADABC@CBABCBA@CBCDA@@B@@@BAB@BAB@BAB@@@B@@AB@@A@AD@@A@ABABABA@A@@@A@A@@A@@@AA@@@AACA@@A@A@A@@A@@AAAACBAA@@A@AAA@A@@@AA@@AA@A@AACDE@A@A@A@ABA@@EA@@CAAAA@AA@@@BA@@BA@C@A@@@AA@@A@@A@@A@@A@@C@AA@@A@A@A@AA@@A@AC@A@@@A@@BABAB@B@B@@AB@BA@@BA@A@A@@BC@AB@B@B@DBB@DA@@DA@A@AB@@@@CAA@A@AB@@@F@DAB@B@BA@@BA@A@@AACC@A@A@@AAA@AA@@@@@AAA@@@AA@@@A@@@@A@@A@AA@@@@@@@AAA@@@@AAAC@A@@@A@@BA@@AA@@@AC@AA@A@A@AAA@A@@B@@@@A@@A@@@@AACAAA@@@@@CACAAA@AA@@AAA@@@C@A@@@ABE@C@CA@@AAEAA@AB@@A@@@@@@@AA@@@A@@@@CAABA@@@AA@@CCCA@@A@@A@@BAF@@A@@B@@CA@@@@A@ABABA@A@@B@BAB@@A@A@@BA@@@@B@@@@@BA@@@ABA@@@@BDD@@@D@B@@@BB@@B@D@@AD@@ABA@ABA@AAA@AA@@@A@@A@A@ABC@AB@@@@@B@@B@@B@@@B@@ED@@CF@@@D@@ABB@AB@B@B@BA@@B@AA@@AACCEAAC@@AA@@@ABA@BB@@@D@B@@A@@@C@@@CACA@@@@@@A@@D@@@BBD@@@B@@@F@@@BA@@BA@ABAB@@@@@B@B@B@B@@@B@B@BABAB@@ABABAB@@@@ABA@GBCBA@@BA@@B@B@@ABAB@B@DB@@BBB@@@B@B@B@B@@A@ABA@A@@@ABA@A@C@C@@@@@@@AACC@AA@AAA@@@@@@AB@@AB@@@CCAAA@AA@@AAE@C@A@A@A@@@A@A@A@@AC@@@@AAA@A@A@@A@C@AACA@@CA@@C@A@CAA@@@AC@@AA@CAEBA@A@@@C@@BA@C@ABAA@@A@@ACAA@@AABC@@AA@@@ABC@@@A@@AAAA@@A@I@A@C@@@@AA@@BA@@@@BA@@@@@@BAB@@@@@@@@@B@@AAA@@@AA@A@E@@@A@@AABC@A@A@A@@@A@@@A@@@A@A@A@A@A@E@@@A@@BA@@@@B@@A@@@@@AB@@@A@A@@BA@A@EA@B@@@@@B@@@@@B@BA@A@A@AAC@A@A@ABAB@BA@@B@@ABABCBAAAA@A@@@A@AC@AAC@A@AB@@@@A@@BA@@B@@@@@BA@@@@@@BA@@@A@A@@AA@A@C@A@@C@A@A@CB@@A@A@A@CAA@C@A@CA@AAAA@CAAAC@CA@@AAA@@A@ABC@ABA@@@@BA@ABCBA@A@@@ABA@A@ABA@CBA@A@EAA@AA@A@@@A@A@@@A@@AC@@AA@AA@@@A@CBA@ABA@@BABA@@@@BCBA@C@@@@@@BB@@@B@D@@@@@@BA@@BA@A@@@A@AAEB@BA@AAA@@@@@ABAB@BA@@@ABAAA@@@@@AA@CAC@C@@BA@A@ABC@@@AA@@@BABA@@B@B@@AB@AA@AA@@A@AB@@@B@@A@@DABA@A@@B@@AB@@ACAAAAC@@@A@A@@BAB@@A@@@@AAA@@AAA@A@A@A@@@@@@A@C@ABA@@@A@A@@@@CA@@@A@A@A@AB@BABC@AB@@A@AB@@C@@@ABB@@@BB@@@BB@@BB@BBB@B@DAB@@BB@@@@B@@AB@@@B@BAB@@ABA@@@@BABABAB@@@BAB@@ABA@@BAB@@C@CBA@@@@A@@@A@@A@AACC@@C@AAE@AA@@@@@AA@@@@@BA@@A@A@@@@A@@A@@@@A@@AAAA@@AA@@@@AAB@A@@@@@AACCA@C@@AAAA@@ACAA@AC@A@A@A@AAA@AA@BADCB@DCDABA@@F@BAB@B@@@BABA@A@A@@BABC@A@A@C@A@CDABADA@AACAC@@AA@@AAA@A@@A@@@AAC@E@A@CACA@@A@@@AAEA@@AA@@@@@A@A@@@A@C@A@@@A@AA@@@C@@@AAAA@CAAAA@@@A@A@@BA@A@@@AEA@@CA@AAGAC@@AA@@A@AAAAC@@@@@C@ACACAACA@A@@@A@ABA@@@A@C@A@@BA@AB@@A@ABCB@@A@@@A@A@I@C@A@AB@DAB@B@BBB@B@BBD@B@@BB@D@D@BA@EHAB@BABAD@@C@CBA@@@CB@@@B@B@B@@@B@@C@EAC@A@A@@@EB@BA@AB@@A@@@CACAA@A@@@AA@@@@@A@A@@DA@@@@@A@@A@AAC@AAAA@@@AA@A@@AA@@@C@AB@@ABC@@BABA@CB@@A@AB@B@@AB@@@BAB@D@@A@CAA@C@A@CBC@AAA@A@A@A@@@CBA@ABABA@@@AB@@A@@BA@@@@@AA@@A@@AE@A@E@EAA@A@A@CBA@@@AA@@@@@AA@@BAB@@ABAB@BA@@@A@@@A@@@CAAACAA@A@A@AB@@ADC@ADAB@@@B@@@B@@@B@@@@@B@@@A@@AA@@AAA@@@A@@BA@@@A@A@AA@@AB@@@@BB@@@B@@@@A@A@C@@AAAA@A@@A@@AA@AA@@@A@@AA@A@@@@A@@@@B@@A@@B@DAD@B@D@@@FC@@@@@A@AA@@@@ABAB@@A@@@A@E@A@@BAB@HA@@@ADADBB@DA@@@A@A@AAA@@A@AB@@AAA@@@ACCCAAAAAA@@AAA@A@@@@A@AA@@@EBA@@@@AA@@AAA@A@@BABA@A@AAA@@@A@@@AA@ABAB@@@@@@DB@B@@@@ABA@@@A@@@CBA@@@A@@@AA@ABAA@@A@@AC@@C@@ACA@@@AA@AAAAA@AA@@A@CAA@CA@@AA@AA@@AAACAAAA@A@CB@@@@CBC@A@@@@@@BBD@B@@ABA@A@AAA@CCC@@@ABA@@B@@A@AAA@AB@B@@@BDB@BB@@@@B@@@BABB@AB@@AD@BA@@BA@ABAAA@ABA@@@CAA@@AA@@@EB@@@@A@@BA@@BA@A@@@@@ABC@A@@@AAA@B@A@@AABA@@A@@AAA@@@A@A@@@@@@A@@A@@@A@@@A@@AAB@@ABA@A@@@@@A@@@C@@B@@@BBB@@B@@B@@BHB@@BA@@B@@@B@@@@@BA@@BAB@@@BA@A@CAE@AAA@@AC@CAC@@@@AA@@@@A@@@A@A@AA@@@@ACACAA@@@@@ACAAA@@AA@A@@@@@AAC@A@@AA@A@A@A@A@A@A@@@ADAB@@@B@D@@AB@BABABCBC@AB@@@BAB"],encodeOffsets:[[107442,35789]]}},{type:"Feature",id:"621123",properties:{name:"渭源县",cp:[104.215467,35.136755],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@FBBBB@@BBD@@@BB@B@DBD@B@D@DAAQCCCAACUP"],["@@@AB@@ABA@C@@B@BA@A@EAA@@@AAA@C@@@AB@B@@ABAB@@A@CB@@AB@BAB@BEBA@A@A@AA@@@A@AA@@AA@@AAA@C@A@AAAA@A@@@A@A@@@@AAA@AEA@AAACAC@A@ABABCDA@A@@@A@AACCC@C@A@A@@A@AAAAA@@@C@A@ICA@A@ABA@CBC@@@C@AACA@@@@@@AB@@E@@@ABA@A@ABEBEBE@ABEBA@EAC@ABA@@BAB@@A@CBCBA@CD@BA@ABCB@FADABAB@D@B@D@@B@D@FDB@BBB@D@FBB@@B@H@HABAB@DB@@B@BA@IJA@@@A@@@A@A@AA@@CAG@IAEBA@@B@DA@@BA@@B@@A@ECAAA@C@A@EBC@@B@B@@BDB@DBABABGBCDA@ABEAC@G@EAABABABC@@@@BA@ABAB@@A@CACACCAAAEACAACEAAECAAA@ABCB@BEBGBAB@B@@@BBDBBBDB@@D@B@DCBAB@@BDAD@BABA@A@C@A@AB@BABEBCBA@@D@BADABA@CBC@CAC@CBCBE@GDE@CBA@ABCDCBCBA@AB@BEBA@CDABA@AAA@AAA@C@ABCBABA@@@A@ECECCAA@C@CA@@A@CAIACAE@@@AA@B@BABGH@@A@ABC@A@AB@BABA@EDAB@@AB@BE@AAA@AAA@CACBA@A@CDCFADA@@@@AA@@@@AB@@A@@AAA@IDA@A@EF@BCB@B@J@D@B@BBB@BBB@@@B@@ABCBABC@@@GBCBCBABABABABABAB@@ABC@@@G@@@A@A@@B@@A@@B@F@@A@A@EB@BCBABABAB@B@B@@BD@@@B@BAB@BA@@@A@@@CA@@@@AB@B@@@D@BBBBBBDBB@B@@@B@B@@EBABABABABAB@D@@@B@DBBBBB@DD@@BB@B@@BBBBB@@B@@@BABADCB@B@@@B@@@@@BB@@@BB@BB@BB@@D@F@@@D@BB@@BA@@BABE@@BC@C@AB@@AFC@ABA@@BA@@B@DAD@@@DCBA@@D@BAB@BABA@@D@B@@@DCDABA@@@A@@BAB@B@B@F@@@BB@@@@B@B@FAB@B@@@@@ABAD@B@B@DAB@B@@ABA@@B@B@@@B@@FDB@D@@@BB@BAD@@@D@@@B@@@@ABCBCBABADA@@B@@BBBBFF@@@BAB@@ABA@AB@@@B@D@@ABABABGB@BAB@D@BAB@B@@AB@@@B@FAB@BBB@@@B@@@B@BB@@@BADA@@F@B@@@FB@@BD@@DBDB@@@@B@BADC@@DA@@@BBB@@BBBBB@B@B@B@B@B@JQB@BAB@B@B@@BB@B@HAB@B@B@B@BB@@@@AB@@AB@B@B@B@B@BBB@@@@B@@AB@B@BAD@B@BBB@@@D@@@BA@@DCBAB@B@BAB@B@BBB@BB@@@B@DBDB@@BB@BB@@@B@BBB@BBBBD@BDB@BB@BB@BB@@F@B@@BBBBBBBBB@@@BBBDCBCB@@@BB@FFB@BBB@BBB@@@BB@@@B@@A@@F@B@BBB@@@B@BB@@B@@BB@D@@BB@@@BA@@B@@AB@@@@AB@D@B@B@@A@@B@@@B@B@@@B@B@B@@@B@@@B@@BB@B@@@@@BB@@@BH@BDD@B@@@@EF@@ABA@CDABA@@B@@@BDBVO@A@ABABABADED@BBDDBB@BCL@BABA@A@A@BRBAB@D@BAB@@@@B@@@@@B@@BDBB@@@B@H@@@BA@@B@@@@@BA@@@A@@@@@A@A@AA@@AA@@@C@@AA@@@@AA@@A@@BE@AB@ACC@@A@ABCDA@@B@BB@BFDD@@@BA@AB@@@@D@D@@@B@BBB@DBB@F@@AB@B@B@BABADAB@@@D@DB@@B@BABA@@B@B@DB@@B@@@B@D@BBD@B@D@ABAB@BCBCBABAD@@@B@BA@@B@B@@BB@@BB@@B@BBDB@@DDB@BB@B@BBBBD@BB@B@DBB@D@DADBB@D@B@F@@@B@@@@@BBB@B@B@@@B@B@@@@@DC@@B@@@B@@@BA@@B@B@@@@A@@@@@@@@B@@A@@@@@AB@@@@@@@@AB@@@B@B@B@B@@@B@@@@@BAB@BA@A@@BA@@@@@@@A@@@@@A@@ACAA@@AA@A@@BA@@@@B@@A@@@C@@@C@A@@@ABA@@BC@C@C@CBA@ABA@@@A@@@@@@BCBC@@B@DC@@BA@A@A@ABE@ADAB@@ABA@ABABABCFK@@B@B@DAFA@@BB@@B@BBBD@BBDB@@B@@@ABA@A@@@A@@B@DABAB@BA@@BBB@H@B@@@B@@@@@@BB@B@@BB@@@@@@@BB@@@@@@B@@@B@B@BB@@@ABB@A@@B@@B@@@@@@@@@BB@@@@BB@@@BBA@@@BBB@@@@B@B@B@B@BB@@@@B@@@BBB@@@@@B@@B@BBB@@BB@@@B@@@@@@B@@B@BB@BB@@@BBBBB@BBB@@@@@DAD@B@B@@@B@@@@@BA@@BB@@BAB@@@B@A@@BBB@@@BBBBBBB@@B@@@@@BBB@@BB@B@B@B@@@@@@DB@@@@@B@B@@@B@BBBBD@BB@@AB@@@B@@B@@BBD@@DDBD@@BBBB@@@B@@@@ABB@@BB@BB@@@@@@AB@B@@@@DB@@@@BB@B@B@@B@@AB@@AB@@B@BDBDDBB@BHH@BBB@@@BA@@BA@@@B@B@@BAB@H@@EB@@@@@B@@B@@@B@@@@@BB@@B@@@B@@@B@@@@@B@@@B@@A@@@@@@@@D@B@@@BB@@B@@B@B@B@B@@@@@@@B@@@@@BD@@@@BAB@@@BA@@@BB@@@@AB@BBD@B@@AB@@ABAB@@B@B@B@@B@@AB@BA@@B@BBBDB@BBB@BB@@ABA@A@@@@BBBB@BB@@@@B@@@@BB@BDBBB@@@@A@@BA@@@@B@@A@@B@@AB@@@@@@@B@@CD@B@BA@@@B@BB@@@@@@@BB@@@@@B@@@BB@@@@B@A@@@BB@@@BB@@@B@@@@BB@@@B@@@@@B@@@@@@@BBBAB@@@@B@@@@@AB@@@@BB@@@D@@@@AB@@@BB@@@AB@@BB@B@@@@AB@@@@@@B@@BBB@@@BB@@BBB@@@@@@@B@@BBB@@@B@@BA@BB@@@B@B@BBB@@B@@B@@@@@@@@BB@B@@BB@@@BB@@@B@@B@@B@@@BB@@B@@@@BB@B@@@@AAA@C@C@@@ADC@A@@@@@ABA@AB@@@B@@@D@D@BAF@@@B@@@BABGFAB@BBBBD@BBDBBBB@@ABA@AB@@@D@@@@AB@@A@@@BBDD@BBB@B@D@@AB@BAB@B@D@B@B@@@B@B@DBBBBBB@@@@B@D@B@@@B@BBB@BDB@B@@@BA@@@ADE@@BAD@@@B@B@BDB@B@@@BA@@@@FABA@@D@@@@@BDBF@@@BBB@@BB@BBB@@@F@@BB@@@A@@B@@@@@@AB@@@@@@AB@@A@@B@@A@@DA@ALAB@B@B@BB@@@@BD@@@F@BBBBDBBDBBB@@@@BB@@BB@@@@BB@@B@@@@@@BB@@BB@@BB@BDB@@B@@@@@@BBB@@B@@BF@@F@B@D@@@BBBA@@@@B@BC@ADCBCB@BABAB@@A@@@@@A@A@A@@@A@A@C@EBA@@@ABAB@@AAE@@@A@A@@@A@AAAB@@A@@B@@ABC@@BBB@BBB@@@B@BB@@B@BD@@@@B@@B@@@@B@@B@@B@B@@@B@@@B@DDDDDDBB@@@@DC@A@@BADABAB@BA@@@@BA@@@@@AB@@AB@@@@A@@@@@@BA@@@@@@@@B@@@@@@@@AB@@@@@@@@@@@B@@@@@B@@C@@@@@@@@@@@@@A@@B@@@@@BB@@@@B@BA@@@A@@B@@@@@@@@@BA@@@@B@@A@@@@@@@@B@B@B@@@BBDB@@B@@@@@@@@@BEB@AAB@@@@A@@@@@@@@@A@@@@B@@@@@@AB@@@@@@A@@@@@@@@@A@@@A@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@AB@@@@@B@@@@@@A@@@@@ABA@@BA@BB@@@B@B@B@@BB@@B@@D@D@@ABC@AB@DAJAB@@@B@@@BB@@DB@BBB@@@BBBD@BBBBB@@@B@@BB@BDB@FBB@@BBB@@BB@BB@BB@@B@@@BBB@@BB@@B@@@@BB@@B@@BB@B@@@BB@@B@@@B@@BB@@@@AB@@@BBB@@@B@@@B@@BB@@@DB@@@AB@BA@@D@B@B@B@B@B@@@B@@@@@DBBB@BBBB@@@B@@@@@BBB@@DB@@@B@@@BA@C@@BABABA@ABABABC@@F@@@BA@@@C@@@AB@B@B@B@DDDB@@@B@@@B@@BBB@BB@@D@@@BBB@@@B@BABABA@@@@DBB@@@B@DC@@B@B@B@BBBBDBB@BBB@B@@@BBB@BAB@B@D@B@@BB@@BB@@@D@@@DAB@@AAA@@BABABA@@@AAAAAAC@@@A@A@@@@B@F@@@B@BA@@DA@@D@B@DB@@D@B@@@@B@BB@@@BF@@BBFBB@B@BCB@@ADA@@@@BA@@B@DA@A@@BA@@B@@@@@@@BC@@BA@AA@AC@@A@@A@@@AB@@AB@@AB@BCB@@A@A@@@A@@AA@ABA@@BA@AB@AABA@@@A@ABA@@BA@@BAB@@@B@BAB@@@BC@AA@BA@A@@@A@@@@AA@@@@@AD@@@@ABA@@B@@A@@@CAAAA@A@A@A@A@A@A@A@@@A@@@@ABA@A@A@AAAACCAC@ABA@@A@@AAACCAACC@AAAAC@A@AA@@AAAAAA@AC@A@CAC@@AA@@@A@ABA@A@AAC@@BGA@@A@@A@@AAAACA@AAAA@@@A@A@@@AA@@@@A@@@AA@AA@A@@@AC@AAA@AAA@@@AA@@A@A@@A@@@@C@AAA@AAC@@AA@@B@@@@@BAAA@CBC@A@C@C@AB@@A@A@A@CACA@@CACAAA@@AAAAAA@AAA@@@AAA@AA@@@@AC@A@@@AA@AAA@AA@AA@@@@AAA@A@@@@C@@@@AC@@@@ACAC@@@A@A@AB@B@@@BA@@@@B@B@B@FBB@DBD@@@BAB@@A@@@ABA@@AA@E@CCA@A@@@@BA@@@A@@A@AA@@@AA@AAACECAAAAA@A@@A@@B@B@B@BA@@@AB@@@@@B@@AAAAB@AA@@@@@@E@AAA@@AAAAA@@@AAAAA@A@@@@@A@AC@@@@@AC@@@@@@@AA@@E@CA@@@A@@@@@A@@@A@@@@@@@@A@ABA@@@A@@A@@@AA@AA@AA@AAA@AA@@@AA@@A@@@@A@@ACAEAAA@AAAA@AA@AB@BA@@BA@@B@@@@AA@C@CB@AA@@@E@EA@@A@@A@@AA@AA@@A@A@A@@@AA@AAAA@A@@@@A@@@@@AA@@@A@@AAB@A@@A@@A@@A@CBE@C@@@AAC@C@AB@@AAABA@C@@B@@AB@@@B@B@BB@@C@@@@@@@@@B@B@B@B@@@DBBBB@BB@@B@D@BB@@@@B@@@B@@@B@B@@@D@@@@A@@@A@@BAA@BAA@@A@@@A@@@@@A@@@@@AA@@@BA@@@@@A@@@@@A@@@A@A@AAA@@@AAA@@@C@A@AAA@A@@AA@@@@@AB@@@B@@@BCB@@AAAAC@A@AA@@@@AAA@@AA@@@A@A@@FBF@@@F@@BB@@B@@B@DBB@BB@AB@BAB@BAB@B@B@D@B@B@B@DBB@BBF@B@B@BAB@BCBAB@D@B@@@B@BBBAD@BA@@@@B@B@@@@@AACACEA@@A@@AA@@A@@AAB@@@A@@A@@AA@@@@@AA@A@A@@C@@@A@A@A@@AAA@@A@@AAA@@@@AAA@A@@@A@@@@@AA@B@AA@@@@@@@@A@@A@CAAC@@A@@@AA@@A@@AA@@@@@A@AA@@A@@@@AA@AA@AA@@A@AA@@@@@AA@@AAAA@@@A@@C@@@AA@@@AA@@@@@@@A@@A@@A@AA@@@AA@@@@AA@@@AA@C@@@@@A@A@@@@@A@@AAA@AA@A@@@A@CACBCDEAA@AC@AAA@@@@AA@@AC@CAAA@@C@ECA@CAEE@@CCAAAABABC@ADCBC@C@A@@@AAACAAAAA@@@AB@BA@@@AA@@@AAAAAA@AA@@A@@B@B@B@B@@@A@@C@A@AA@@A@@AA@@@@@AB@@@@AA@@A@@@A@A@@@AA@@AB@@AB@@AA@@A@A@AA@@@@AB@@A@AB@@A@@B@@AB@@A@@AAA@@AA@A@@@@A@@A@@@@A@@A@@@@@A@@@@@@CAA@@@CAA@@@AAC@@AABA@C@C@AB@@ABAB@@@@@@@D@@@B@@ABB@@BAB@BA@@BA@ABA@@@A@AA@@AB@@@AA@A@@@A@@AAA@@AA@@@@AAAA@AD@@C@AB@@AACAAA@@AA@@BAA@@A@@ABA@A@@BEA@@C@A@C@CAC@@AA@@A@@@@A@AB@@A@@@AAEA@@@ABA@E@A@A@AAC@@@A@E@@BA@@AAAAAA@@@AAA@AAABA@A@@B@@@BA@@@@@@KACAA@A@EAA@AACA@@AAA@@@AA@A@@@A@AA@@A@@A@CB@@C@G@@@ABA@C@@@A@@@A@@@@@AC@@CCA@@AAAAAC@CAA@@@A@@BC@@@ABC@A@@BC@ABE@A@@B@BABABA@@BA@@BAAA@C@A@ABA@CBA@ABABAB@@CBA@@@AB@@G@@@@BBD@D@BAB@B@B@B@B@@A@AB@@@H@@@@ABA@@@ABA@AA@@A@ABABC@EBA@GDCBA@@@A@A@A@@@AA@@@AA@A@@@AA@@A@AA@@A@@@A@@BABC@A@A@A@A@A@C@A@CAA@A@ABA@A@AAA@A@ABAFABA@@B@@@BBBBBCJABCBA@A@@BA@A@CDA@@@AB@@@BA@ABABA@EBG@ABA@AB@B@@A@A@E@@@A@@AA@AAA@A@CBA@ABE@ABE@C@C@AAA@EC@AC@AAA@A@C@A@@@A@AAA@@@@A@@"]],encodeOffsets:[[[106373,36054]],[[106583,35792]]]}},{type:"Feature",id:"621122",properties:{name:"陇西县",cp:[104.634983,35.00394],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AB@AA@A@A@@@ABA@@@C@@@@@C@CBAAAB@@A@@@A@@@BAA@@BA@A@A@@@@@A@A@A@A@@AA@@@C@@@A@A@A@AA@@A@A@ABC@C@C@@@A@@@AB@@A@A@A@@@C@@@A@A@AAC@A@@BC@CBA@@AA@ACCC@@AA@@CA@@AAA@CAAAAAA@A@@@C@@@@@A@@@@@DD@BBB@@@B@B@BA@ADABABE@C@A@C@A@A@AAA@@@AA@@AA@@A@C@@@AA@@A@EBC@A@CAAAA@@AAA@AAA@A@C@@AAA@A@A@@@A@CB@BA@A@@@AAA@A@ABABC@@@ABCBCBA@@@CBABCBCBGDABA@ABCBAB@@A@A@AA@@ABA@A@AB@@CB@@@@A@A@@@A@CBA@AB@@CAAAC@A@A@@AA@AA@@A@C@@@A@CBAB@BA@A@@@A@AAA@CC@@A@C@E@@@C@CAA@A@@@@ACAAC@@@A@@AAAA@A@@@AAA@@@A@A@@BAB@B@@@@@@E@@@@ACECECC@AC@@@A@@CC@@@@@CAA@@ACA@@AA@C@ABABCF@BCBCDC@@BC@A@AB@BA@CBA@AB@BA@@@CA@BA@A@ABAB@@@@@@BB@@@B@DAB@DA@@BAB@B@D@BAFAB@@AB@D@BA@ABA@A@A@AACACAA@@@A@C@@@ABAB@DABAB@@AB@@BD@@@@AB@@AB@@AB@@C@CBCBABA@@@A@A@C@A@@@A@@A@AAA@@A@AAABA@AB@@E@ABA@CAC@ABA@@@E@@@C@C@ABA@@@AACACA@@@A@AA@@AA@A@@@C@@@A@AA@@AB@@@@@DABABA@ABCBEBC@CBABA@AB@@C@AACA@AC@A@A@EDC@A@@@CBA@AD@B@@AB@BA@CAA@@@AAAAA@@@A@E@@@CA@AC@@@A@C@A@ABA@A@@@@@AA@@C@A@A@@A@@ABA@A@A@@@CAAA@@A@A@@@A@AD@@@@AD@BBB@@@BAB@@C@A@C@A@C@CAA@@@C@A@A@A@E@A@C@A@A@@@A@@@A@@@A@CAA@A@@@A@A@@B@@@@@@@D@@@BBBBD@@@D@D@BABAB@B@B@B@B@@@B@@A@@D@BAB@BABA@ADGJ@@CBA@ABCBCBC@@@ABA@ABC@C@CBCBAB@@AB@@AB@@ABA@AA@A@@A@A@A@CBA@@B@@A@@A@C@A@CB@@C@@@A@@AA@A@@B@D@@AB@@A@AB@@A@A@A@@@A@AC@@A@@@A@@@@BAB@@@@AA@E@EAEA@@C@ABA@A@AD@@@B@B@@CB@@@BDD@B@@C@E@@@AB@@@B@D@B@@@@A@CAAAA@@@@B@@AB@@A@@@@B@D@@A@@@AB@@ABBB@@@B@B@J@@@BA@BB@B@@B@@BB@BB@@@B@@@BBB@@A@@BB@@B@B@@AB@BAB@@ABA@AB@@AA@BA@@@C@@@@@@@A@AB@BA@@B@D@DABBB@@BD@B@@BB@D@@BB@D@@@@B@@@@@B@@@@B@@B@@@@B@@B@B@@BB@BB@@@BA@@BA@@@@BA@@B@BA@@B@@B@@B@B@BB@@BA@@BA@@BB@@B@@@B@B@@@BB@@B@@A@@B@@@@BB@@@BB@@B@B@DB@@@A@A@A@A@@@@BB@@BBBBBBB@@B@@B@@ABA@@B@@BBBBDBBB@B@@@B@DADCD@BADABBBBBDD@@FFDBB@FDD@@@BBDBD@@BB@@B@@B@BBD@@BBBCFADBD@D@B@@@BBBB@BB@@@B@@@@@B@B@@@@@DBB@@B@@B@@B@@B@@BBB@@@@BB@@@@@@@B@@B@@BB@@D@@@@B@@BBBB@@BB@@@@B@@B@BB@@BBBB@@B@@B@@@BBB@@@@@B@@BB@@@BB@@B@@@BDDBB@@@@B@@@@@@B@@B@ABB@@@@B@@@B@B@BB@@@@BB@BB@@@BB@BB@B@B@@@D@@@@B@BBB@@@@B@@BB@@@@B@@BA@BB@@@BB@@@BB@DFDBBB@@@@A@A@@@@@ABC@ABAAA@@@A@C@A@ABADA@ABA@A@E@AAA@CAA@A@A@C@A@A@A@ABA@ABA@@BAAA@CAA@@@@AA@@AE@@@E@EA@@@B@B@@BB@@BB@B@@B@@B@BBDBB@BA@AD@@A@@@A@@B@@@@BB@@@BBB@B@B@D@@BB@B@@BB@B@B@B@@@B@@@@@B@@@@AB@@B@@B@@@@@B@@@@@B@@@BB@ABB@AB@@@B@@@B@@C@@@A@A@@@A@@@A@@@@@AAC@A@@@AAA@AACA@@A@A@A@A@@@@@@@@@D@@@AAA@A@@@A@@BA@@@@DABBB@BA@@B@DBD@B@@@DAF@D@BB@@@@BB@A@BB@@@B@@BB@@@@B@@@@@@BBBBBB@@B@@@B@B@BB@@BBB@@@BB@@@FBF@@@B@@BDAD@B@@B@@A@@@AB@@ABA@@BBBB@BB@BBBFBDB@BB@@@@@@BB@@B@@BBB@BBB@@BBBB@@B@@@BB@@@B@BAB@@@@@@@@@@B@@@B@@@@@B@@DBF@@@BB@@@@@@D@@B@@@@BDB@@@@@B@B@BBBB@@B@BBBB@@BB@B@F@@@@B@@BBABB@BA@@@@@A@@B@@ABA@A@A@@@@BB@B@BBBBFDBDBBB@@B@@BBB@@@@B@@AB@@@@@BDB@D@FBB@@AB@B@@@BA@AB@@C@CAA@EAA@A@A@@@@@AB@@A@A@@B@B@B@@BDBD@@@@BD@@@@@D@@B@B@BB@@@@BBB@@BBB@BBB@@B@D@@B@@B@@BBB@B@@BB@BBBBBBB@@BBDBDB@@DBDBB@B@B@@@BAD@D@B@D@DAB@BB@A@@@@@AB@@BD@BBB@BBD@@@@@@BB@B@@@BB@@B@BBB@BBD@@B@@@BBBB@@B@@@B@@B@@B@@@B@B@@BBBBB@BDBB@BB@@@@BB@AH@@BD@B@BAB@B@B@@BB@@BD@D@BBDB@BBBB@BB@@B@BBDBB@BDDBBDDBB@BB@@@AB@BBDDDBBBBB@B@B@BA@@@@@B@@@B@B@B@B@B@B@BBBBB@D@@@BB@D@B@@BB@@@BB@@@@B@@@BAB@@@B@D@@@B@@BB@@@BB@@@DB@@@@@@@BBD@BBB@@@@@B@@B@@@@B@@@@B@@BB@B@@BBDD@@@@@@B@@@BAB@@@DA@A@@B@D@BDBB@@B@B@@@B@BBBBB@@@@@@F@BB@@@@BB@B@@BB@@B@@B@BAB@@@BBB@BBBB@BB@@D@BBBB@B@@@D@B@@BBB@D@BB@@BB@@@BB@BBDDFBB@BB@BBD@BB@@B@BAB@F@B@D@B@B@B@B@@@B@@@B@DBB@D@FAB@H@@BB@B@@BB@HDBBD@B@B@@@LCB@B@B@@@BBB@@@BAB@B@DBD@DDB@BBD@F@B@BAB@DBB@B@BAD@BAB@@@DDB@J@DBD@FBF@D@DAHABA@@@AB@@AF@B@D@D@@@@@DE@@BC@@BCB@BABABAD@@BB@@@@ABA@@B@FEFEDCD@BAB@DCB@B@@BFBBBB@@@B@B@BA@@DEBA@ABCBC@@B@B@@@BBDDBBB@B@@@BB@@@BB@@@B@D@J@B@DA@@BBB@DD@BBB@@B@BBDBBB@@BB@BBBB@@@B@D@BB@@AB@@@@@@DBB@@@BAB@@A@A@A@@B@B@B@B@@BB@@B@B@B@BB@@BB@B@B@BB@@B@@@B@@@@A@CB@@AB@@@@ABB@@FL@B@BBB@@@B@D@@DD@BBBJBBB@@BB@@B@@@B@B@@ADC@@FAHBB@@@@B@DAF@B@B@BBD@B@@@BB@@BB@HEB@BAB@HBB@B@BA@@DABADADEBABCBABABABA@@B@BB@@DBDB@@BBB@FBB@@@BAA@@@BAB@@A@A@@B@@@@A@@@@BA@AB@@A@ABAE@C@@E@C@A@A@A@G@@@C@A@AAA@CCA@AAA@@@C@@@A@ABEBA@A@@DC@A@A@C@A@@@A@A@A@A@CBCA@@A@E@A@A@@B@@@B@B@BBB@DBB@BAD@B@@@D@D@@@BAB@@@B@DBFBB@D@@@DA@@B@BBB@B@BAD@D@FA@@DCFAB@@@F@B@B@@A@A@A@@@A@AAA@@A@AA@@ABA@@@A@A@@@AB@@A@@@AABC@A@AAA@@JIBAD@B@@@B@BA@CBA@@@C@A@A@CAA@AA@AA@CAA@@@@A@AB@@A@A@EAA@AA@@@A@A@@AI@C@E@@@ACCAAEAECEAAA@@@@@C@@@A@@@A@ABE@A@A@@ACAAAAAA@@BA@ABAB@FCBADCBA@A@@@AACAAA@CAA@@AAAGBA@AAA@@@@AA@E@C@A@AAA@@@AA@@@@@@@@@@@@@A@@A@@A@@@@@A@@@@@A@@@@A@@@@@BA@@@A@@@A@@@@@AB@@AA@@@@A@A@@@@@@@AA@A@@@A@@AA@AAAAA@A@AA@@@@@A@@CAA@AAB@@@A@@@@@@@@@@@@AA@@B@AA@@@AA@@@@A@@AA@@@A@@@AAA@@@@@@AA@@@@@@@@AA@@@A@@@@@@AA@@@@@@@A@B@AAA@@A@@AAA@@@@AA@@AB@@@A@@@AAA@@A@@@@@A@@A@@@@@@@@CA@@@@@AAA@@AAC@@@@A@@@@A@@A@AAAA@@@A@@@@B@@@@@B@@@@BB@@BB@@@B@BB@@B@@BB@@A@@@BB@@@B@@BBA@@BBB@@@@@B@B@B@@@B@B@@@B@@@@@B@@@B@B@@@@@B@@@B@B@@@B@B@DBDB@@BBBB@@B@BBB@DDB@B@@@B@@A@@BC@@B@BA@@@@@A@@BA@@AA@@@@@@@A@@@@DABAB@@@@@@@@B@@B@B@DAB@@@B@B@@AB@@@B@@@BABAB@@@BAB@B@B@B@@AB@@@@@@@@A@@@A@@@CCAAC@CBABA@@D@DCFCHEBA@@@A@@@@@@AA@A@AA@@@@A@AA@BAAA@A@@@A@@B@@AB@@ABA@@DAD@BA@A@A@C@AAA@@@A@A@@@AB@DAB@B@DBB@B@BA@@B@BA@A@@DA@@@C@A@@@@@AAAAC@A@C@E@@@A@@@@A@GBA@A@C@@A@@A@E@A@@@ABAF@@A@A@@AAB@D@B@@A@CA@@@@AA@@AA@AA@AAA@C@A@A@AA@BA@@@@@AACB@@@A@@C@C@AA@@A@@A@@@AAACA@@@AEC@A@@AA@@AA@@@@@BA@@A@@B@@@B@@AB@@@BABABA@A@AA@@ACAAAA@EAA@@@@AA@@A@E@CB@@A@@@A@A@@CC@CA@@E@ABC@@@C@C@AA@@ABA@A@@BCBC@E@@BGBE@ABA@@@@B@BB@@@BBB@DBB@B@BBD@@BB@@B@B@D@BBB@@@B@@@BC@A@A@ABA@CA@BA@@BABABABA@ABAB@BAB@@@@A@A@ADC@@BAD@B@B@@B@BBD@@BBDBDBB@B@@BB@@AB@BCBAJIBA@A@@@AA@AAEAGCIECCA@CCAEAC@ABA@AAABABC@@BAB@B@@@B@D@H@B@B@@@ACAA@A@A@A@@@CBAAC@C@@@@D@@A@@@A@A@A@AACBC@A@A@A@@@AAA@@@@@@@@@@@AA@@@AAEAAC@@@I@ACE@A@@AA@AB@@@@AB@@AB@@@@@BA@A@@@@BA@C@CBA@A@@B@@@@ABA@AAA@@@A@CB@@CB@BA@@@@BABABA@AA@@A@A@@BA@@@@@AD@@ABC@A@A@@@@DCBA@@@ABABAB@BCB@BAD@@A@@@@@A@CBA@ABABA@@@@@@@AAA@@BC@A@CACAA@@@AB@@AB@B@BBB@AA@A@@@A@@B@B@BABCB@BC@@B@DAB@@AB@@ABA@@@ACA@@AACA@CA@@A@@@@EC@@@@@A@@B@B@FAB@B@BA@@@A@A@@@ACAACAC@@@C@@@AA@@CAE@@@AA@@AA@@@@@@AB@@@AA@A@AA@A@@AA@CEA@CA@@AA@A@@@A@@@@@A@A@@B@@AB@BA@A@@@@BAB@@A@@@A@A@A@@C@@A@@@C@@ECA@AAC@AB@@CBA@@BA@CB@@C@EA@AA@A@ECC@A@AB@@AB@BA@@@A@A@AB@@ADA@@BA@AAA@@B@@@@C@A@E@A@A@A@@@A@@BA@@@O@ABC@A@@@@A@@@C@@@@@A@AA@@@@@A@@BA@A@@@A@@@AB@@CA@@@BA@@@C@A@AACBC@@BA@@@C@CBA@@@@@"],encodeOffsets:[[107330,35704]]}},{type:"Feature",id:"621125",properties:{name:"漳县",cp:[104.471572,34.848444],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@@A@@BA@AB@AA@@@AAC@@A@@AAAAA@@@AAA@A@A@A@@BAAC@@@@@AAA@A@A@A@AAA@AAAC@AAA@AAA@CAAAA@@AA@@AAAAACC@@@AA@@A@A@A@@AAAAA@ACAA@A@@BAAA@A@@C@A@@A@@@A@@CAA@A@A@A@@@ABA@ABC@@@CDAB@BC@AB@@A@A@A@A@A@EBA@A@A@A@@@A@@@AB@@AHAF@B@DB@@D@@@D@@@BA@@@A@A@E@A@ABCBADA@ABA@A@@@ABA@GDABABCBA@CDABABAB@B@@@B@@@B@@@BABABAB@B@B@BEBGD@BAB@BAD@@A@CBC@AB@@A@CBA@E@A@CBABA@E@C@@@@BAFA@ABABEDGBA@A@@@@A@@AAAA@AA@E@C@A@AB@@CAEC@@AA@A@@AACCAA@C@AAAAACCAAA@ACA@A@A@@@ECECCA@AA@AAA@EAA@A@CAA@G@@@E@AAAB@AC@A@CBA@@BABAB@BAD@BA@D@BA@@B@@@B@@B@B@BB@B@B@@@@B@@AD@@@@BBB@B@B@BCB@@@@@DBBBB@BB@B@@@B@B@@ABA@@B@@@BBD@@DBBBBB@@@@BBB@@D@@B@BB@BBBB@D@@B@@@@ABABC@@@@B@@@@D@@B@@@@A@@BA@@@@@BB@D@@AB@B@@DB@DBB@@BB@@@@@@A@C@C@A@@@A@A@A@C@C@EB@@ABAB@BA@@BA@AB@@@BBB@@A@@BA@C@E@@@A@AB@@@D@D@D@B@D@@ABA@@B@@AB@B@@@@EB@@A@@BABCF@@ABE@A@A@@@A@@@C@@@A@CB@@A@A@O@@@ABA@@BA@A@C@@BA@A@@BA@@@CB@@@AA@@@A@@A@@A@@@A@@AAA@@AA@@AA@@AA@@@@@@AAB@@@AA@@AAA@@B@@@A@@@@@@@@A@@@A@@@@@@@A@@@A@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@BA@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@B@@AB@BAB@@@BBB@@@B@BAB@@@B@@A@@@@BABA@@@A@@@@@AA@@A@@@@@@@@@@@A@AB@@A@@@ABA@A@@@A@AB@@A@@B@@@B@BA@@@@@@@AAA@@@@AAB@@@@A@@@@B@@A@@@@B@@B@@B@@@@@B@@@@@@@@@DAD@@@BA@@BA@ABA@@B@FAD@@AAC@A@@@CBA@ABA@AAA@C@A@A@@@A@A@A@A@C@C@A@AB@@A@@@@@AA@@@ABCBC@A@A@@@A@@AACA@AA@AD@@@@A@A@AAA@@AE@@@ABA@@@@AA@@@A@@@@BA@ABA@AB@@A@@@E@@@A@@@@DAB@@@D@BB@@B@B@B@@@@A@@B@@@@@@BD@B@B@@@@ABC@ABA@A@A@@@AAEBAA@@A@AAA@EAEAC@A@A@A@@@@@BDA@@B@@EBC@C@@@CBC@C@A@A@@@A@G@AAA@EE@@@A@@BABA@@BADE@A@A@C@A@C@@@AACAA@@@E@A@AA@@A@AAAAAACCCCACA@AAAAA@A@@@C@@A@@A@@BA@@AABCAA@@ACAA@@AA@@AAAA@AA@AA@@ABA@AB@@AA@@@@AAC@A@@@@AAC@AAA@CAA@AAC@@AAAAA@@@A@@@A@ABA@@@AAA@@@@@AB@BA@AB@@AB@@@@@@@B@@@@ADAB@@EBAB@@@@ECA@@@@@A@@A@@AAC@A@A@@@A@A@@AC@A@@BCB@@ABABA@AB@@@@@B@BBBBF@B@@@BAB@BAB@@A@@BAAA@AA@@CBC@EBA@C@@BA@A@A@EBA@ABA@CBAB@BA@@@A@A@@@@@A@@AA@CB@A@@A@@@A@CD@@@B@@A@@@G@A@A@C@@BA@AD@BA@@@@B@B@@@@A@ABA@@@AAA@A@@@A@CB@@CBCD@BDF@@BB@@@BEFCD@BA@@@AB@@A@ABABAB@B@@A@ABABA@C@@BA@@@CBC@@A@@@@AA@@A@@@AAC@@@A@@@A@@@@BA@ABCBABABB@@F@@@BBD@BBD@D@BBH@BB@@@@BD@B@DAB@@BB@@@@BB@@D@BB@DDB@@B@D@@@D@@@B@BCD@B@@@B@@DBB@@BB@@BD@BB@@AB@@A@@D@@@B@B@B@A@@C@A@@@@@A@@BABA@AD@@C@C@A@CDA@A@@BC@A@A@@@@BAB@@@@A@@@C@A@@@A@CA@@A@@@AB@@A@A@@@A@@@CD@@A@@@C@C@ABA@I@@@CA@AA@@@ABA@A@CF@D@DA@@BC@C@AB@@@@@B@BA@ABA@CAA@ABA@C@G@@@CBC@A@A@C@A@@@ABCB@@A@CBA@A@@BA@@AA@A@A@A@A@@@AAA@AAA@A@AAAACA@@A@CBG@A@@@A@@@AD@@@D@@@@CBABA@A@ABA@CDA@ABAD@@@B@BBB@BB@@B@@AB@B@D@B@B@D@@@B@@@BBB@B@B@B@@@DAH@D@B@BDBBD@B@@@@@B@BB@@@@@BBB@@@B@@B@@@@@@ABABEBID@@@B@BA@AD@@@@@BBB@@@B@B@@DD@B@@@B@@@B@B@B@DAF@@AB@B@@BF@B@BBD@@@@@BA@ED@B@@IAA@A@@BABABAB@@@@ACAC@CA@CAAAA@C@A@A@A@EAAAA@C@@@AA@@@AA@AAA@G@EBC@A@A@AB@@@B@B@B@BABC@ABAB@DA@@@ABA@A@A@A@ABAB@@AF@D@BA@@BC@@B@@ABAB@@A@C@AB@@A@@D@@@B@BBB@BBBA@@BCB@BBB@BDD@@BBB@BB@@D@DBB@BA@@BB@@A@CBCDIF@B@F@BDB@BB@BFBBDBB@@BB@C@@BA@@BCBA@AB@@AD@@AB@BA@DD@B@@@@A@CA@B@@CBC@@@A@A@@@A@@@CAGBA@A@AAA@@B@BAD@BAB@@@BA@@BB@@@@DBB@D@@@D@@@D@BAB@B@@@B@B@B@@ABC@ABCDABABA@ABCFE@@@@@@@ABBF@BABAB@BC@@@AD@BDB@BAB@@CBGBEBC@ABA@ABAB@@@@BB@B@B@BD@BBB@B@@BBBBBJFBDDBBB@@BBB@@@BBBBB@@@BABA@@@@B@B@B@@@BB@BBB@B@BB@BB@BB@BBB@BB@B@@BA@@B@@AB@@@BAB@BBBDBDBBD@@@FDDBBDBBDBFBBBB@BBBDBDBBBFBH@@DB@B@DFBB@FABAF@FAFABAB@B@BA@@F@@@BA@@@@@@DBBBD@@@D@DAB@BAB@B@JDB@D@@@B@BBBBB@@@@B@B@DDDBD@B@B@@@BCBADAB@B@BBDBDBBB@BFB@BB@@@@@B@B@@@BBBBBB@D@B@BB@@BB@@BBB@@@B@@B@B@BABAFA@ABA@@BA@@D@BA@AB@BA@A@@B@@@DBB@B@@BB@F@BABA@@@@DAB@BA@@B@@@B@@B@BBB@@@B@D@B@B@BBD@@BFDB@BBD@D@F@BAF@BAB@DAB@B@BBB@@BB@@@F@B@B@@@@ABAB@BAH@FAB@BABAB@@A@@BA@@B@DCB@B@@AB@B@DABADIAAAA@A@@@AB@BABEBAB@B@BBB@B@BAB@B@DBB@D@B@B@B@B@B@D@BA@AB@@@B@@@BBB@@@BB@@B@B@@B@@BB@@B@B@B@@@B@DAHCB@FAD@BABAB@@@BBB@BA@@B@BA@@@@@G@@BAB@@@@A@A@A@ABA@A@CAC@A@@H@@@BA@@B@DA@@BABABAB@DAB@BAB@D@B@BB@AB@@AB@BABA@A@AB@F@BAD@@AB@D@BA@@D@@AB@@@B@DBD@BBBB@BB@DD@@BD@@@@B@@@B@@@D@B@BA@@H@D@@@DAB@@@@BB@@B@B@@@BBB@@B@BB@@DBBBB@FBB@B@DBLB@@@@@@AB@@A@@@@BABBB@BBB@B@@BBBBBB@@AB@@@F@B@@BD@B@B@B@FAB@B@@FBBB@@B@@@BAB@@@@@@BB@@BD@DBD@B@D@@@FB@AB@B@BA@@@BB@AB@@BB@@BBDBBB@@BAD@@@BCB@B@@A@@@I@A@A@@AABA@@BA@@B@@@@C@A@@B@@@BA@@@A@@B@BBDBB@@@@@@A@C@A@@BA@@F@D@@@@ACC@A@@DA@@@A@A@@BCB@B@BAD@@@FBFBF@B@@B@@A@AB@@@@@B@@@BD@@B@B@@@B@B@BA@@B@BA@@BC@A@@@@BBB@@@B@@@DA@@D@B@D@BB@@@@AB@DAB@B@B@@@@BBBB@BA@@BA@@BA@@BADADAD@D@BAB@BA@@D@DADABAB@DA@@HIBCB@BA@ABA@A@CB@@@@A@@@A@A@A@ABABA@A@C@C@@ACAA@A@@@C@@@@@@@AB@B@@@B@B@DBB@@@B@@@B@@@B@B@D@B@F@B@B@B@D@@@B@DBD@B@D@B@D@@@BA@A@@AA@ABC@@@@BCB@@@B@B@@@BBDB@@B@B@B@BA@@@BB@B@D@@@BB@@@@B@B@BAB@D@B@@@D@@BDB@@F@B@@@B@BBBB@@B@DBB@@ABA@@@ABCB@DA@@B@D@FCB@B@D@@BDBBBD@@@BAB@BADAD@FADABAB@BABA@C@@@@BA@@BBB@@@D@@@B@B@@BB@@B@B@@DBDBBB@@B@BAD@D@@@F@@@B@BAD@DBB@BAF@@@BAB@BABBB@@@BB@B@BB@@@B@D@B@B@@@B@BADADAD@@@BA@@BA@@BA@@@@AC@@BA@@BABA@CBABA@@D@B@@@B@DBDBBBB@B@B@BAB@@A@CBA@@BABE@A@C@ABA@AB@@CBA@C@A@@AA@@@@@@BABAB@B@@ADB@@B@@ABAB@DAB@@ABAB@D@@AD@DCDA@ADEBABAA@@AA@@A@@B@@A@@@A@@@@AAA@@A@A@ABA@A@@@AAA@A@A@@@A@@BA@ABA@A@@@ABA@A@@@ABA@A@@@@@A@@@@A@@A@@@A@@AA@A@@@A@A@AA@@A@@@AAA@@@A@AAAA@ACAAAA@@AAAAA@@A@@@AA@@@AC@@ACAAAA@CA@@ABA@A@AAC@A@A@AA@@@BCBC@A@A@@@AAA@@@ABADABABAB@D@BABABCBABABADC@AB@DC@@B@@A@A@A@@@ADCBA@A@@@@@AAA@@AA@AAC@AAAAAA@AEAAAA@AA@@@AACAAA@@@AA@C@AAC@A@@@A@A@@@C@A@AAA@@AAA@AA@@@@A@@@AB@BB@@BABA@@B@B@BABADAB@BAD@B@DABAD@@@@@@ABA@@F@B@B@AA@@@ACA@@A@@AA@ACAAA@CA@@AAAA@A@@@A@A@AB@DAB@D@DA@@@A@@@ABA@@BEBA@A@AA@@A@A@ABCBA@A@A@AAEAA@ABA@A@A@@A@AAA@@@CCA@@A@@@@@A@@@@AA@E@AAC@A@ABA@@@AB@DAD@@@@@@@@@F@B@F@@@@B@@D@FAD@@@B@B@B@HBB@@B@D@BB@BBF@B@DAH@BAFBF@B@F@@@@A@A@@B@B@@@B@@@B@BBB@B@B@B@BBDB@@DB@@B@B@@@B@@@B@@@BB@BD@B@DBD@BBB@@BB@B@BB@@@@DBD@@@BB@@D@@BBB@@D@B@@@B@BBB@@@DBD@BBD@B@BB@@B@@@B@@@@@B@B@@@@@AEBC@@@@AA@@@A@A@@@A@@AAAA@CA@@A@AAA@@@@AA@@@A@@CA@A@A@C@AAAAA@AA@@C@@@A@@BC@@B@B@@A@@@ABCACAAAA@AAA@A@@AA@AA@@@AA@@@A@AB@@ABAB@D@@A@@@A@AB@@AA@@@@ABAAA@@@@AA@@@AA@@A@@C@@@@@AAAACAA@@AB@@@B@A@@A@@@A@@@@AA@@BA@ABA@@A@@@BAA@BE@A@AAA@AAAA@AA@@@AAA@@CA@A@@A@@A@A@@@A@C@AAAACAA@A@AAAAAA@@AB@@ABA@ABA@@@A@A@@BABA@@BABA@@BA@ABA@@@A@ABADA@ABCB@@AB@B@@@B@@AB@B@@A@@@@BA@@DA@A@AB@@A@@@A@@@@@AB@@@@@@ABAB@@A@@BAB@@@B@@ADA@AB@@@L@@@F@D@BABB@@DB@@B@@@DD@BBBD@LDB@BB@@BB@BBB@@DB@DDB@@B@D@B@@@B@BA@@B@DA@@BA@@@A@A@A@@@@@A@@@@@@@AA@@@@A@@@@@A@@@@@A@@@@@A@@@@B@@@@AB@@@@@@@@@BA@@@@BA@@@@@@AA@@@@@@@@@AA@@@@A@@@@@A@A@@@@A@@A@@@@B@@@@@@@B@@@@@@@@@B@@@@@@A@@@@@@BA@@@@@@@@@@@@@@BA@@@@@@@@@@@@B@@AB@@@@@BA@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@A@@@A@@@@@A@@@@@A@@@@A@@@AA@AA@@AAC@AAC@@@A@A@AB@@AB@BC@EA@@@A@@@A@A@@@AACA@@CA@@@A@@@@BAB@@A@@@A@@@A@@B@@@@@B@@@@@@AB@@@@@@@@@BA@@@@@@@A@@@@@@@A@@@@@@@AB@@@@@@@@A@@@@@@@@AA@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@AB@@A@@@@@@@@@A@@@@B@@@@A@@@@@@@AA@@@@@@@AA@@A@@@@A@@@@@A@@@@BA@@@@A@@A@@@@@@@AA@@@@@A@"],encodeOffsets:[[107260,35307]]}},{type:"Feature",id:"621124",properties:{name:"临洮县",cp:[103.859565,35.394988],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@AAA@AAAA@@A@@AAAA@@@@A@@@@A@@@A@@AA@AAA@@@@A@@@@AA@A@@@A@@A@@A@A@A@A@A@@A@AA@@B@AA@@A@@A@@A@@A@@@@@@@@@AA@@@@BAA@B@@AAA@A@@@A@@@@@@@AA@@@@@@A@@AA@A@@A@@@@A@@@A@G@A@AA@@ABA@ABCBA@@@@B@@@BAB@B@@@AA@AC@AACAAA@@@AA@@EBCBA@A@@@ELADABAB@BAB@BA@CB@BAF@B@B@BAB@@CDA@@@ADAD@@@@@@@B@@AB@BAB@D@D@DAD@@AB@B@@@B@D@@@D@@@BA@@@@@AB@@@BBB@@BBBD@@@B@@@@@B@@@@@@AB@@@BABA@AB@@@@A@@@A@A@A@A@@@A@@B@@@@@@A@@B@@@@@BA@@@@@@@@@@B@@A@A@@@AB@@A@@@A@@@CD@@@@A@A@@@A@A@A@AA@@@@A@@@E@A@C@A@CACBC@A@CAA@A@@AACAA@A@AAAA@CC@@CAAAA@@@AA@@AA@@@A@AB@@A@A@@BCBADADA@ABABAC@A@C@AAC@A@@@A@@@CAA@A@@@ABABA@@@CAC@@@A@CBABABA@A@A@@BE@A@CAA@AAA@@@C@C@@@@@BAB@@A@@CCAEA@@A@AB@DCBAB@@@DD@BBAF@@AB@@@BB@@@@BB@@@D@@BB@@BBB@B@@@@@B@@@B@@A@@@@@AB@@A@@@G@A@@AAAC@@@A@@@@@A@@A@ABC@A@ABCBC@A@C@CAA@A@@A@@AC@AA@AAEACA@A@@@AB@BADCB@BA@@FE@@@@@ACC@AAG@@A@@A@@@@@AAA@@@A@@@A@@@A@A@A@@@A@A@@@AB@@@@A@A@CBA@@@@BA@@@AB@@A@@AA@@@CAA@@@AA@@A@A@@AA@A@A@EB@@@@A@@AA@@A@AAA@AAA@EEA@@A@@DADAACAA@@A@AAAAAAAA@@@A@EA@@AAAA@@ACA@AACAA@AAA@A@A@@AAA@@AA@AC@C@A@@AAA@AAA@A@ABA@A@ABCD@@AB@@C@@@A@AAA@C@ABA@A@@BA@@@@@AA@A@A@A@A@ABA@@BA@@@@AAA@A@A@A@GBA@A@@AA@A@A@ABA@IRA@A@A@A@A@A@AAAA@@AA@A@@CB@@CDABA@@@@@CACA@@AC@@EA@@A@E@@@CBAB@@A@@A@A@@@A@@AA@ABA@E@A@@BA@@@ABA@A@CBA@AHABABABA@@@C@A@@BAB@BA@@BA@A@@EEAAAA@@@AB@BCBADADABA@@@@@A@@@C@@BC@AAA@@C@A@EC@@@A@@@A@AB@BA@@@ABA@C@A@ABCBA@@@@A@A@EBA@A@@@@@AA@@E@A@A@A@AB@@@B@@ABCBCD@@A@C@@@ABABA@ABC@@@ABCD@@C@CBA@@@AB@@AB@BED@BA@@B@DAD@@AFAB@@AB@@AAC@@@E@@BCDABCBCDEFA@ABA@@@@B@@@@@BDB@@@BBB@@@DAB@@@B@@BB@@@@BBDBD@BBB@BBB@@@@@@B@@ABAD@DA@@BB@@@@B@@@@@B@@G@A@@@AB@@@B@@ABA@ABA@CBCBABA@ADA@@@A@AACA@@AA@AAA@AAAAA@@@@A@@B@BABA@CD@@AB@@BB@@AD@@BD@@@D@BA@@@@B@DAD@@@D@B@@@BA@AB@B@B@B@@@BB@DDB@BB@@@B@B@@BBB@B@@B@@@@@BA@@@@@@B@B@B@B@@AB@@@B@@@@BBBBBBA@AFCDA@@B@BAD@BABAD@@@B@BAD@@@BBB@@BBB@BBB@@BB@@@BB@@@BB@BB@@@@B@@B@@@B@B@BA@@@E@A@A@@@AAAA@@C@ABA@AAA@C@AAAAA@A@A@@@AB@@ABAD@B@@E@E@CBC@A@@BADBB@B@@ABABA@@BBBB@DBHB@@BBA@@BA@@@@B@@AB@@@B@@ADA@@@BD@@@D@BB@@BB@@@@B@@B@@BBB@B@@@@BBB@@@@B@BB@BB@@BB@@BB@B@@BB@BBBBB@BBD@@BB@B@B@BBB@@@@@BBBBD@B@@BD@BBBBB@BBB@@@@BB@B@@B@BBB@@@BB@@@@@B@@@@@BB@@B@B@B@BB@BB@@BB@BB@@@B@@@BBB@BBBBB@@@B@B@@B@@BB@@B@BBBB@@@B@@@@@B@@@@@@@BB@@@AB@BA@@@@B@@BD@B@B@B@B@@@@AB@@@B@@@@BB@@@@AB@D@@@B@@AB@@@@@BBB@@@@B@@B@@@@@BB@BB@@@B@@@@@@@@@B@@@B@@@@@B@@B@DB@@@@@B@B@@CF@B@@@B@@@B@BBBBB@@@@B@B@B@B@@@B@BA@AB@@@D@D@BB@@BBB@@@@B@BBDDBBB@BBB@@@D@@AB@@@@@@@@ABB@@BA@BBABB@@@@BBBBB@@B@BB@@BB@D@@@BBB@@@BB@@B@B@@BB@@B@@B@@@@@BB@@@@@@@@@BB@@B@@B@@@@B@BB@@BBB@@@@BB@BBB@@@@@BB@@@@@@@@BBBB@@@B@B@BAD@BBB@D@@BB@@@@@BB@@B@B@BBB@BDDBB@B@@@BC@@B@D@F@D@BBB@BAB@B@D@@@B@@FD@@@BB@@BB@B@@@D@B@B@B@DBB@@@BBDDFDDBB@@@@@BEBA@C@@BAB@@BBBB@B@BBB@B@BB@D@B@B@B@B@H@@FF@@@@@BA@@B@@@@@@ABB@@@@B@@@@ABBB@@@@@@@B@@@@@@@BA@AB@DAB@@AB@@@BAB@B@@@@A@@BA@@@@B@@A@@@@@@BCBABA@ABA@@B@B@@@@@B@@AB@B@BBB@B@@D@@@BB@BBB@@B@DBBBB@B@D@B@BAB@@@D@D@@@B@@@BBB@@BBDB@@BDD@BBB@B@D@BBB@B@@BD@B@@@B@BBB@@BD@@DD@@BBBBB@@@BB@B@@@BB@BBD@@@DDB@BBB@@@@B@@B@@@B@BA@A@@@@BB@@@BA@@B@@@@@B@@B@@@BB@@B@@@@@AB@D@B@@B@@B@@AB@B@B@@BB@BBB@B@BBBBBDDB@BB@B@@@B@@@@EBGBE@A@@@A@@A@AAC@@AAA@A@@@A@EB@BABADAD@BABABEB@BAB@DIZCF@B@@@BCFADEBA@ABABAAC@A@A@A@@BAB@B@BABCFAFCBADA@@BADABABABAB@D@B@BAB@BCDAB@@ABA@AB@B@@@B@@@BB@BB@@@B@BA@@BABAB@B@B@D@B@D@B@BAJAD@BAD@@@FAFADAB@@ABA@@BABA@AD@B@B@DAB@BABAB@BCBCD@BCD@BCBABA@C@C@C@A@A@ABABAB@BAB@BA@ADABABA@ABADA@AB@@AB@@CBABA@@BA@@B@@@B@@ADABC@A@@@AB@BAB@@@BA@ABA@CB@B@@@B@@@BAB@B@@ABA@A@@@A@EB@BABCDA@ABABGB@BA@A@A@@BA@AB@@@BA@ABA@C@A@A@@BA@ABAB@@CDA@CB@@ABABAD@@ABGDA@E@@BCBA@@BAB@@@BA@AD@BA@@BA@@BA@ABCBAD@@@BABABABADA@@B@@AB@@ABABABABA@GDA@CBABADCD@D@D@B@B@D@B@F@@ADCBABA@CB@@CDA@CBABEBCB@BAB@@@D@D@BBD@@ADADA@ABA@E@A@CBA@EDA@G@A@@@AA@@AAAAC@A@A@@@ABCDAB@@A@@B@B@B@B@B@BBB@D@@BB@@@B@@@B@@@B@B@B@BB@@B@B@@@BAB@@CBAB@BABABABAB@BABABA@@B@@BB@@@@@B@B@B@@ABABA@A@@@C@@@A@A@A@C@CB@BC@CDCB@BAB@@@B@B@BBD@D@BAB@@A@ABABA@A@@AA@AAAEA@@AAAA@CA@@CAA@@@AB@@A@@B@DA@@BAB@FABABADABEDCBCB@@ABAB@H@@AD@DA@@BA@G@@@ABA@@BABADAB@D@D@BAB@B@@CBABAD@BAB@BABAB@@AB@@ABA@@@CBEFABA@@@CAAA@@A@@@EDCDEDABEDA@@B@@ABADAB@BA@@@@BC@@@CBA@C@GBAB@@AB@@@BA@AB@@C@@BB@BBB@@B@B@@@@@B@@@B@BBBBB@@BB@BB@@@@B@@@@@B@B@@@B@@@BCB@@@D@BA@@B@@@BA@BB@BBD@@@BAB@B@BA@@B@@BB@@@B@BAB@@@@@BBB@@@@AB@B@@BBB@BDB@@@B@@@B@DA@ABCBABABABAB@@@@@@@@B@D@B@@@@@@@@@@B@B@@@B@@@@B@@@@@BB@@@B@@B@@BBBBB@@BB@@@@BB@@BB@@@BB@@@@BB@@@B@@B@@B@@B@@@BBB@@B@@@@B@@@BB@@BBBBBBBDB@@B@@@B@B@B@@@@BB@@@BBBB@@@BB@@@BB@@B@@@@B@DBB@B@@@@@B@@@@@B@@@@@@@B@@@BA@@@@B@@BB@@@@@@AB@@A@@@@B@@@B@@@@@B@@@@@B@@@@A@@B@@@@@@@@@B@BA@@@@@@@@@@B@@@B@@@B@@@@@@@@A@@@@BA@@@@@A@@@A@@@AB@@@@@B@BA@@B@B@BAB@B@@@@@B@BBD@DABAD@@AB@@A@@@AAE@A@A@A@@BA@A@AB@BA@A@ABC@ABCBEBE@CBA@@B@@@B@D@@@D@B@HAB@B@BAB@@A@@@@B@@@D@@@BBB@@@@@B@B@@@@@B@@@@BBBBAB@@B@@BA@@@BB@@@@@@@@@B@BBBBB@@@BBB@D@BBB@@@D@@@D@@@@@@BBB@@@B@@@@@@@BB@B@@@@BB@@@BBBBB@A@B@@@@BB@@@@B@@@B@@AB@@@B@B@@BF@B@@@BA@@B@B@@@B@@BBBBBB@@BBB@D@B@B@B@D@D@@@@@@@@BBB@@@D@@DABAB@DA@@BA@AB@D@@BB@BADA@@D@DADB@@B@BAD@@@@@BABABA@@DAB@@A@ABA@@BADCBA@@BA@AB@@C@@B@BA@@@AB@BA@@@@B@@@@@@@B@@@@@@@@@@B@@@B@@@@@@@BB@@@@@@@@B@@@@@BB@@B@@@B@@@B@@@@@@B@@@@@B@@@BA@@BB@@B@B@@@B@@@@@B@@@@@@@@@B@@@B@@@B@@@B@@@@@@@@@B@@B@@@B@@@@BBB@@@@BB@@@BB@@@@@@@@@@B@@@BA@@B@@@@@B@BA@@B@B@@AB@@@BABA@@@@B@@@B@@BB@BB@@BB@@@@B@BB@@@@B@@B@@@@@@B@@B@@BB@@B@@@DCBAB@BAD@FADA@@B@@A@AA@AAAA@@@CBA@A@A@CBA@@B@@ADA@@@@B@@@B@@@BBBB@@D@@@B@BCB@FE@AFA@@BA@@@A@AB@@@D@B@D@@@FAB@@@@A@@@AFC@AFA@@B@@@BBBB@@BAFCBA@@B@DBB@@@B@BABA@ABA@@B@B@BBB@@B@BBBBB@@@@@BBB@@B@BBB@@@B@@@BA@@@@BAB@@@BA@A@A@@@AB@@AB@@@@A@A@@AA@A@A@@AE@@@ACC@@@@@ABA@AA@@AAAB@@C@A@@@A@A@A@@@AB@D@B@@@@A@@@A@A@@AC@A@ABG@GBE@A@@@@@@B@BB@@D@B@BB@@B@DBBBB@B@@@@@DADG@A@A@CAA@CB@@A@@@@BB@B@@B@@B@@B@BBB@@@@@B@B@B@@BB@B@B@BB@@B@@ABBB@D@B@@@D@@BDA@@@ABB@@BA@@D@@@B@B@B@B@@@B@BADABAB@B@BA@A@E@A@@@A@@@ABA@@BABA@@B@@A@ABA@@@@@@@@ACAAA@@@@AAA@AA@@AAC@@@@BA@@@BB@@@@ABA@@@@DC@@D@B@DA@@BA@A@A@C@@BABABAA@@A@CB@@A@C@C@A@A@AAA@A@A@A@A@A@@@A@C@AB@@@@AAA@@@@@@@@@AAA@@@@@@@AA@@A@@@@@@@@@@@@B@BB@@@@@AB@@@@A@A@@A@@AD@BBB@@@BB@@@@@B@B@DB@@BBBBBBBFFBDB@DDBBB@DBB@B@@@FCB@B@FCLK@ABA@A@ADCBABAB@BAD@D@DAFA@@D@@@DADAB@DGDCBAB@DAD@BABCBA@EBGB@BAB@DAD@DAD@FCFAF@D@J@BAD@DCBA@AB@@A@@@AD@@@BABAFCDADAB@DAD@F@DA@@FB@BB@D@B@DADAB@B@@@@CBABA@ADCBCBCB@@ADADABCBABAFED@@@DBB@B@DD@@B@DADAB@F@B@@@@A@ABABCDC@@B@BA@@B@@E@C@E@@@@B@HCDA@@BABCBAFE@@BA@@F@D@DADA@@B@B@@BBAHA@AB@BABCBABA@CBCFCDC@@BC@AAE@ABABAB@DCF@B@B@@@BA@@@A@@HAB@B@B@DA@@B@B@BA@@BAB@@@B@B@@A@CBA@@@ABA@@@C@@@@BA@@@@BA@ABC@@@A@AB@@@BABA@AB@@A@@BA@@@BB@B@B@B@@@B@@BBDB@BAB@B@BAB@BAFCBABCBAB@@@@@@A@A@@B@BA@@BA@@@@FC@@BCBADC@@B@@@BAD@@@D@@@@@B@@@@@BD@B@@@@@@@B@B@@BB@@DB@BBBB@@@@DB@BB@@@@@B@BBDA@B@BBBB@@B@@@B@@@@@B@DB@@BBB@@BBBBBB@BB@@@B@@A@@BAD@BADAB@@BB@@B@B@B@@@BADA@A@A@@@@@C@@A@@A@A@@@@@@D@D@B@@@DBBBBB@@BBBB@@F@@@D@BA@AB@B@BA@ABADA@A@A@@@@BA@A@A@A@@BCDCB@BA@A@@DADCBABA@@@A@AB@BAB@@CB@BC@A@ABA@A@A@ABA@@B@H@D@@@@A@A@@AAAAAAAAA@AAA@CA@A@@A@@CA@@AAAA@@@@A@@@ABC@AB@BAB@B@BBD@DBD@B@@@@ABADC@@DA@AB@B@D@B@B@DBB@B@BBB@BAB@BA@@BC@@@AEAAACA@@A@@A@@@@@ABAB@D@B@BB@@B@@@BABABAB@BA@@F@@@BB@@B@@@BABA@@@A@A@A@A@@ACAAA@ACA@@AAA@@@ABABE@A@ABCB@@ABC@C@@AA@AA@@@A@C@AAA@G@A@@@A@@@@A@CBA@A@AAE@AAA@ABABABC@@@@@C@AB@@EBA@A@AAC@AA@@C@AB@@A@@AAAA@@A@@@EA@@CACAC@@A@@ACA@@A@@@AACA@@AAA@@A@@@@AB@D@D@@@B@BBBABBBA@@DABABAD@@AFADAB@@A@@F@D@B@B@B@@@@@@A@@BABABA@@@A@@@ABABABA@@@AAAA@@@@AB@B@@@@CB@@@@ABA@@@A@CB@BA@A@@@@@C@@@A@@BA@A@@BCB@@@B@BA@@BA@@@A@@@A@A@A@@B@F@DA@@B@B@@@B@DA@@B@@@B@B@DAB@B@@A@@B@@@@C@@BA@@B@BA@@B@B@BABAB@BA@A@@@CBABA@A@A@AD@B@BAB@@@@ABBDBDBBBB@@@DB@@B@B@DBDABABA@A@@B@@@BBB@B@@BDBDDB@HFBB@B@@@@@A@C@CAA@@@A@@@@@A@A@@A@BAA@BC@A@@@ABAB@B@@ABABABABA@@A@A@@@A@AAC@@@@@AC@@@@AA@@@A@@AA@A@@AAECAA@@C@AC@@AA@A@@@A@@BC@G@@BCBA@A@A@@@A@E@@B@@A@C@AAA@@AA@A@A@@A@@ACA@AA@@@@@A@@@@@@AB@@A@ABCAA@A@A@AAE@@BA@ABCA@@A@A@@@AB@A@@AA@A@@@@CB@@@@AB@@@@A@@AA@@A@@@@@@@@A@@@A@@@@BA@@A@@AB@@@B@@@AA@@@@A@A@@@@@@@BA@@@A@@@@AA@@@@@AA@@@@A@@B@@@@@B@AA@@@@@A@@@@@@BAA@IBCBA@@BAD@BC@C@@@@AA@@AA@A@A@@@A@@AAB@@AB@B@@@@@B@@@@A@@@@@A@@B@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@B@@@B@@@@@@@@@B@@@@A@@B@@@@A@@@@@@B@@@@@@@@@B@@A@BBA@AF@@@@@@@@A@@@CAAA@@A@A@A@@@@@@@@@@BA@@@@@AB@@@@@@@@A@@@@B@@ABA@@@@@AA@@@@A@@@@B@@@@@@@@@@@@@DA@@@@@A@@@@@@@@@@@A@@B@@@@@@A@@@@@@@@@AB@@@@@@@B@@A@@BA@@B@@@@AB@@@@ABA@ABCBAB@@@BCD@@@@AACCCCCCA@@@A@@@A@A@@@@AA@@@@@@AA@@@@@ACA@@@AAA@@@A@AAA@AA@@AD@BA@@@@BA@BB@B@B@@@B@B@@BF@BA@AB@B@@AB@F@D@B@B@@@B@B@B@@@@@BA@ABABA@ADCD@BADA@@@@@ABAA@@C@A@E@@@AE@@@AA@AA@@@@@@@AA@ACA@@AA@@AA@@A@@@@A@@@AA@@@@AA@@AA@@@@AACAAAACAA@A@E@@AC@@@@AAA@A@A@KB@BCB@@@BA@@@@BA@@B@@@@A@@B@@@@A@@@@B@@AA@@@E@@AA@AAA@@AA@A@@AEAC@@@@C@@@ABEB@@@@AB@@A@A@ACA@A@@@C@AB@@CF@B@@AB@@A@A@ACA@AAA@@@A@C@A@@@@@AAAAAA@C@A@A@@@A@A@C@ABA@ABA@@@C@AAA@ACCAA@@B@@@BA@@@@@C@@BAB@BA@@AAAAAC@AACAA@ABAHEBA@A@@@A@@BE@A@C@C@@@A@@BAB@BA@@@@B@D@BC@@D@D@B@BB@@@@@AAA@@@@@AA@@A@@@AA@@@@A@@AA@@A@@AA@A@@A@@@@@@A@@@@AA@AAA@A@@@A@@AAB@@@A@@AA@AA@@@@@@@A@AA@@AA@@A@AA@@@A@@@@A@@B@@A@A@@AA@@B@@AA@@A@@B@@C@@@A@@A@@A@@B@@@@@A@@A@ABAA@@@@@@A@@@@@A@@@A@@A@@A@@@A@@A@@AA@@B@A@@@@@AA@@A@@@@@A@@A@@@@@@AAA@@@B@@A@ADC@@@A@@@@@@BA@@@AB@@@@A@@B@@AB@@@@@AACA@AAA@@@@@A@@A@@AAAAA@@@@@BAB@BA@@AAA@ACAAA@A@AB@@ABA@@@AA@A@A@@@BABA@@BA@@@AAC@ABA@@@@AA@@B@@A@@BA@A@@C@@A@@@@@A@@@@@@@A@A@A@AA@@@AA@@A@C@@@@@@@@@@BA@@@A@@@@@A@@@A@@@A@@@AA@@@@A@@@A@@@@A@@@@FA@@@GBA@AA@A@@@B@@AB@@A@@AA@AGG@AAACCCA@A@AA@@BA@@BA@@@@A@AAA@@@@CA@@@@@ABA@@@@@@AAA@@AA@BA@@@@@A@@AAAA@@ACCC@@AC@AA@@@@A@@BA@@AAC@AAAAA@@@A@A@@@@@A@@C@@@@A@A@A@A@@AA@AA@@@@A@@@AAAAAA@A@@AA@AB@A@@@A@AB@@AA@@AB@@@@A@@@A@A@C@CB@@@@A@"],["@@BDDBDDB@B@B@BA@ADK@AAACCAAC@CFABABAB@B@B"]],encodeOffsets:[[[106544,36060]],[[106384,36046]]]}},{type:"Feature",id:"621126",properties:{name:"岷县",cp:[104.03688,34.438075],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@A@@A@CA@@A@A@@@ABC@A@A@@@@CCEACABCBE@@AA@ACC@@A@A@@AAA@AAAACAAA@@A@@@A@A@AAC@@@A@AB@@A@@A@@@CA@@A@@AAA@@@@AAA@@AA@A@AAAAAA@@@@@AA@@@AAA@@A@@@E@CAC@A@@AAA@A@@ACCAC@@AA@@@A@A@A@A@@AACA@BCBCB@B@BADCBABM@ABCB@B@BADA@ABA@A@CDAD@B@BADABABABAD@B@@C@C@CBCDEDCFCD@BAFADABADABA@A@ACAAA@E@GBCDCDAFCBGDKFCFA@A@CAA@C@@BA@AD@BADABCD@B@@@D@D@DBDA@ABCACAA@ABEFAD@F@BA@@@AAA@A@AD@DCBABGBABAF@BAB@@A@A@A@A@A@EBAB@DCBCBAD@B@DABABCBA@CD@B@DBF@B@BCHA@@BBDABADIHCBCBABCFCHAB@B@@@BA@@@A@AA@@A@A@A@A@AA@@C@ABA@A@CB@@CBAFA@@@C@A@AACAA@G@A@@ACAAA@AA@A@@@A@A@CFADADABAB@BABA@AAC@CACAC@A@EDABC@A@AAGGACCACAEAE@CAWGC@IBEDEBCBA@@DAB@B@D@B@D@BEHABABC@CACAC@A@GDE@CBCBC@A@E@C@C@A@@@CAACA@A@A@ABA@A@@A@ACAC@@@CECEAACCEBC@ABEBA@EAA@@@AB@@@DABADAB@@@BA@@D@BBBBD@B@B@BA@@@@B@@@B@BBB@D@B@B@BABCB@BABAB@B@D@D@@@B@@BBBBDHFF@@@BB@FAD@B@BADA@AB@@@B@@@DB@BB@FBDBDDBBB@BBB@DAB@B@B@@BFFBBBD@B@@CB@B@BA@@BBHBB@BB@BB@D@@@BB@BBD@@@@BB@@B@@BBF@B@@B@@AB@D@@BD@@AB@@AB@B@B@@BD@BBBB@B@BBB@BAB@@@@@B@@@D@@BB@@@@B@@D@DB@@AB@@@BDDBBBBB@@@@D@B@@@@@BBB@BB@BBBB@@@@@B@@@@@BB@B@@B@D@@@@@B@@B@@@@BBB@BB@JAFB@AB@D@B@BA@@B@B@D@B@@BBB@@@B@@ABBB@@B@BB@@@BB@BBB@@D@@@DB@BDD@B@@BB@@B@@B@@@BH@@@B@B@@@D@@BB@@@@BB@BBB@@@BBB@@@@@B@@@@@@BBBBBBBB@@@BBB@@AB@@@@BB@@BB@BBBBB@@@B@B@@@B@@AB@@@BA@@@@BAD@D@B@@@B@B@BA@@BBBABAB@@@@AB@@BB@@@F@D@@AB@BAB@B@@AB@@A@@AA@A@A@A@@B@@@B@D@@BB@D@B@D@DBDB@BDBB@@BD@B@B@@BDBD@@@BA@A@CBEDC@A@EBCB@BA@@@@BAH@BA@@B@BABAB@@CBA@AFAB@@@BA@A@ED@@@BADAB@DCBCBAB@@AB@@@B@B@@@@@BA@ABA@ABA@@@@BAB@B@@B@BBAB@B@B@BAB@@BD@B@B@DB@@B@@@@HABAB@BAB@BB@@BAB@@@B@@@DBBB@@BBD@DB@@D@F@DBF@D@FB@BDB@@B@B@@@B@BA@A@@BC@@BAD@BA@@B@DBB@DDJFB@DB@@BBBA@@DBBB@BBB@BBBDB@@@BBB@B@@A@CD@@AB@B@@@BA@AD@@DBBD@B@BDF@BBBDDB@@B@BB@BB@BBD@@BBDBB@@@@@@@@BABA@@B@B@BA@A@ABABA@ABAB@BAB@@A@CBA@@B@B@BA@@BC@@@@BA@@BA@ABAB@B@@BBB@B@BBDB@BBB@@B@BBBB@B@B@@@BBBD@BBDB@@B@@B@BA@CD@B@B@BAB@D@B@BBB@B@DBF@B@@@BDDDBBB@BB@D@@@BB@BBB@BBB@D@B@@@B@B@@@D@@BDDBBD@BBB@B@B@B@B@BA@AD@@AB@B@@BB@B@@@BBB@@@BBBB@BB@B@DBD@@BD@@BF@B@B@@AB@BA@EBC@ABCBA@@@AB@DAD@DA@@@EBAB@BABAB@D@DAD@@@@AB@F@@ABABEDA@CB@BBB@@DBDD@@BBBBB@FBB@DB@@@@@DDB@@@B@B@BBB@@@BBD@BBB@@@BB@B@@B@@@BB@@@B@D@@@DBBBB@FDB@BBD@BBB@B@D@HBBBF@D@BAB@BAD@B@F@HAD@DAD@BA@@B@B@BBB@JFBBB@@AB@DABA@CDA@C@CBABE@@B@BA@@BBB@@@BDBBBB@@B@DBB@B@B@@@BAD@D@@@D@B@BA@AD@@@DAFA@@B@B@B@B@@@B@@AD@B@B@@@BABABC@AB@@@@@DAB@BA@@B@B@@@DBB@B@B@BA@@DDDBDBBDDBBBBDBDBB@@D@B@BB@@B@@AB@BAFCBAB@FAB@D@@BBBDD@B@@@D@@@@BABABCB@B@@@B@DB@BB@@B@B@@@B@@@CB@@@BAD@B@@@BABA@@@AD@@AB@@A@CBE@@BABAB@B@B@B@BA@@B@@CBABAD@BA@A@A@A@A@@BAB@B@D@FAH@B@BBB@@B@@BB@@D@B@BBFBB@B@B@D@B@BBDBB@@DBDBD@@@@BABABA@AB@B@JB@@@AFCB@@A@@@@AC@A@AAE@@@ABA@@BE@C@A@A@A@@@A@@@ACC@@@A@A@@AA@A@@@@BCB@@A@A@@JCFABABA@@@@@@@AA@@@A@AA@@@@A@@A@A@@@@@AACCA@A@A@CBG@C@@@A@A@AAA@A@@@A@@@C@A@A@C@ABA@@@AA@@AAA@A@A@@BCBAB@DCB@BAB@B@BADA@@@@@C@@BC@@B@@@B@H@DAB@@@DBBBBBB@B@BBB@BB@@B@B@B@B@B@@BB@@AB@B@DAB@@@DABA@@B@D@B@B@D@DA@@H@D@B@BAB@DBB@BAB@@A@A@@@@BAD@D@@AB@@C@CDEB@B@BA@@B@@BDB@@J@B@BAD@D@@@B@@@DC@@B@@@B@B@@@BA@@B@@@DBB@@@B@D@@@B@@@@@BA@A@@B@B@D@@AB@B@DCB@D@D@@@BCB@BA@AB@@@@@B@D@@@@B@A@A@A@@@CB@@@BA@@AAC@@AA@@AA@CA@@@A@@@ADC@A@A@@@C@@@C@AA@CCA@@A@CA@@A@@A@@AA@CBA@C@@A@@A@@AAG@A@CAC@AAC@A@@@EA@BABADABAB@@A@@B@@@B@@@D@BB@@B@@@BB@@@@@BD@DA@@B@@AD@B@BABAB@@@@ABABABAB@@@BA@@B@@ADCFE@A@@AA@@CE@ADCDA@@DAB@@@B@B@BB@@B@BAB@@@@@@A@A@@B@@ABCB@@AD@B@B@H@@@B@@@@A@@DCB@@@B@@@@BDAB@@BB@@@@@B@B@@@B@@ABADAB@BAB@FAB@B@B@@AD@B@FAD@DA@@BBB@BB@AB@@@BA@ABA@A@@@AAEAA@A@A@@@@BAB@BABA@@DA@AB@D@@BB@B@@@B@B@D@BB@@@BB@@@@@B@FD@@@@BAFA@@BABC@@@@@A@@@@@@BA@@BAB@@ABA@@@@B@BB@@B@BAB@@@B@@@B@BBBB@@BD@BBB@DBB@BBD@B@@B@D@BB@@@@BB@@BAB@BA@@BBB@@BBBBB@@BB@@BBBD@@BBADBB@@AB@@@BB@@@@D@@@BBBBB@BDBDBDDBDBBBB@B@BB@@B@B@F@@BBBD@B@@@D@B@D@B@BCFAB@@ABAB@@@B@@FFB@BBH@B@@@B@B@D@D@DA@@D@D@FA@@@AB@AC@@@@B@B@B@D@FBFBB@BBB@@@BBFABB@@B@B@B@BAD@BA@@@@@A@AAC@@@@@@@AB@@@@@@A@A@AA@@A@C@@BA@C@@B@@@F@@@B@@@BAB@BAB@@A@@B@@@B@@B@@B@BA@@F@@BB@BBB@B@@@@@BCB@@BDBBB@@@B@@@B@BADAD@B@@BB@@@@B@@@BAB@D@D@B@B@B@B@@@B@B@D@B@BBB@BAB@DA@@B@D@BB@@BC@E@AB@BAB@@AB@@A@@BC@C@@@@@@@@@A@@@@@AA@@@@A@@B@@@@A@@B@@@@@BA@B@@B@BB@@@@@@B@@A@A@@@AB@@@BAB@@@B@B@BA@@B@@@BAB@@@@@@@@@@@B@@@BB@@@@B@@@B@BA@A@@B@@@@A@@BA@A@A@@AA@A@@BA@ABA@@@A@A@@@@@@@@@A@@@@@@@@@@@@@@@@B@@A@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@B@@@B@@@@@@@B@@@B@@@@@@@@@@B@@@AB@BB@@BB@@A@BB@@@@@@BB@@BB@@BB@@BB@BB@@@B@@@@BB@@@B@@B@@DA@@B@@AB@B@@AD@B@B@@AB@BA@@P@B@B@@@DAB@@@D@@@B@@@B@B@F@BA@@DEBA@AB@@@FA@@@@@ABA@@@AB@BA@@@C@A@C@C@C@@BAB@@@F@D@B@@AB@@@AA@A@@BAB@@AB@@ABABA@@FAD@D@B@B@B@@@B@D@D@B@@@@@@@AA@@AA@CCA@@@ABA@@@CAA@@@@B@@AB@@@@@@AC@@@@@@A@@D@BABA@@@@@AC@A@AA@AAAA@@@@CA@AA@@@@AAAACA@@AC@A@@@AB@BA@@@A@A@@@AAAA@AACA@@@@A@ADA@A@A@AA@@@@BC@@@A@@A@A@A@@A@A@AA@@@A@@@ABC@B@@ABC@ABABA@AB@DAB@D@@BBABBF@@@H@B@DBB@B@FBB@BBB@@BDBFDFD@@B@B@B@BDB@BBDDBBBB@B@DBBDDBB@@@BBB@@FDDB@@BAB@D@F@B@@BBBBB@@@B@@B@B@HAFCBABAB@BE@A@@D@F@B@BADAB@F@B@DAB@@@BAD@DAB@@@BC@ABA@AHCFA@A@A@ABABABA@A@@@A@@@A@@@ABABABADCB@DABABAHCB@BA@@B@B@BAB@BCDABAB@F@B@B@@@B@@A@@@C@@@CA@@C@ABEBG@@BA@@B@@@B@B@B@B@FAB@B@B@B@B@@@BAD@@ABADC@@D@BAB@BA@@B@B@B@B@DB@@@B@@@BB@D@@@@BBBAB@@@BBBBDB@BBBB@@@B@B@BB@@B@@DDBBBB@BB@@BB@BBDBB@BBB@BBD@BB@BBB@B@B@B@BBB@B@@@@BDAB@@@B@B@BBB@B@@BBBB@BB@@@BD@B@@BB@@BAD@B@B@B@BAD@B@@@@A@@@ABAB@@A@@@@B@B@BBD@B@@BBB@BBB@B@@@BBB@@@BB@B@@BF@DB@@@BBB@B@@@@DBBBB@@@@B@@BBBBB@B@B@@@BBB@DBDB@@@@DA@@@AB@@ABABA@AAA@A@AAA@@@CA@@A@@AC@AAA@@@C@AAA@C@AAA@AAC@C@C@@@A@@BA@A@@@AB@@A@C@@@A@A@@@A@AAA@AAA@AA@AC@C@A@@DA@@@@FA@@@@@A@@@AAA@AAA@@@ACAAA@A@@@@@A@@@@DAB@B@B@B@DBB@DBB@@BBB@BBBB@DB@@@@BB@BBB@@BBBD@@BD@@DD@BBB@@@@BBB@BAD@BA@@BCB@BA@@@@B@BB@@@@BBFABBB@B@@BBB@@D@B@@@BA@ABABAB@D@B@FADABAB@B@D@@@@@BAB@B@@AB@@AB@DABBB@@ABA@@@A@@BA@@B@@A@AB@@AB@@@BA@@BA@@H@@@D@B@B@@@@@BA@@BA@@D@B@@@B@B@B@B@@@B@HFFBDB@@B@@AB@BAB@B@@@D@@@@BBDBBDBBB@@BB@B@B@@@@B@BBB@BAB@B@B@BB@@BA@@BA@A@@B@B@DBD@D@@@DB@B@B@@@B@@@@B@@A@@BA@ADB@@BAB@BC@@D@@@B@BADA@AD@DAB@@@BB@@BB@@BB@@BB@@B@B@@@B@@@@@@B@B@@@BB@BB@@B@@A@@D@D@@@BBDDBB@@B@B@@@BA@A@@BA@@BA@ABABABADA@@DD@@B@DA@@@@DB@@DAB@BA@@@A@AB@BA@@D@@@@AB@BA@@B@B@@@B@@@BA@ABA@@@A@@@EAG@@@A@@@AAA@@@A@@AABAA@AA@CAAAA@A@@B@BAB@BA@A@ABA@@B@BAFB@@BA@@@A@@AA@AA@@AC@@@AAACAA@@@AA@A@A@C@A@AAA@@A@@@C@@@ABAB@BA@@@AAA@A@@@@BA@@@AA@C@@@AB@@CBABC@C@A@AA@@ABCB@@A@E@A@@@ABA@CB@@AD@@CD@@@@C@@AC@A@@@A@@A@@A@ABCB@@A@@@A@AAA@CAE@@@AAA@@AA@AAE@C@@@AAA@@A@@@@AA@@AB@@ABA@C@E@AAA@EA@@A@A@@@@@A@A@C@A@GAEAAA@@A@A@A@A@A@AA@@EBC@EAABA@AB@@AAA@A@AB@@C@A@E@A@A@AB@@ADAB@@@@A@ABA@CACAA@@@AA@@@@@BA@@@A@C@@AA@@@@A@A@A@AA@AAA@@@AAA@AAC@CBA@@@@@@A@@@@@ADCBAFCBAHC@A@@ACAA@@@A@@BABA@A@A@A@A@ABA@ABA@A@A@A@A@ABADA@ADEBA@A@@@ABA@A@A@AAC@A@@AAA@AC@@CA@AA@@A@AACA@BA@@@@HAD@BA@@@A@AD@BA@@@@BA@C@ABABA@@BABC@@@A@@CAA@AAAAAAA@@ACI@AA@@A@@A@A@@@A@@@ACAAAA@@A@CAAA@@@ACE@AACA@@AA@C@CAC@AAA@@@AAA@E@E@@@C@ABA@@@A@@@AA@@G@CA@@@C@AA@@C@@@AA@C@@@@@@A@C@ABABEDAB@@@@A@@@AAC@AAA@@@A@@@AA@C@AA@@@AAA@@A@E@AA@@ABC@@@C@ABEBABA@AB@DA@@@A@@@A@AAA@@A@A@@@AAA@A@CAA@@@A@@@@@A@@C@@@CC@@AA@A@A@A@A@@BAB@BA@@@CBA@@BA@E@@@@AA@BC@@@AAAAA@A@@BA@@BABAB@@A@A@AB@@A@A@EAA@A@@@@@ABA@A@@@A@ABAB@@AAAA@CA@@@A@@@@@AB@BABA@@@AD@BCB@@@@AAAAAA@@@C@@@A@@A@@@AA@@@@@A@@AAGBCA@@AA@AA@C@A@AB@@@@@@@B@@B@@B@B@B@B@@@D@B@D@BA@ADAB@@@@A@A@ADA@@@C@A@A@ABAD@BABA@A@A@A@A@A@@@AB@@AB@@AB@@A@@@AAA@E@@A@@A@A@@@@@A@A@A@EAAB@@CD@@A@@@@A@@AAA@A@A@@AAA@@@A@@@A@@BADA@A@@@A@@@A@A@@BABA@AA@@@CA@@CAA@CAEA@@@@ABABAB@@A@C@C@@@@@A@@A@AAAA@AAA@A@A@@@AA@AAAACAC@@A@@@@@CD@@ABCBCBA@ABAB@@A@@@@@A@AAAC@C@@AAA@A@A@CAA@A@@@EB@@C@@@ABCBA@C@C@C@@@ABAD@BA@@BAB@@A@@@AAAAACA@A@@AA@A@AB@@AACC@@A@A@@@@A@@@AC@@@CEA@@AA@@@ADA@@B@BA@@@@@A@CAA@@@A@@@CD@@@@A@A@AA@A@A@ABA@ABA@A@@@A@@@AA@CAIC@@@@EC@@AA@@A@@AB@@@AAAA@@@A@@CACAEAAA@@ACAC@@@@A@A@A@A@ABAB@@BB@BAB@B@@AB@@CAA@@@@CA@@@A@@@AB@@A@A@AAA@C@A@ABABA@@B@@@@@B@@ABABADADABAB@BA@@BC@A@AB@@@BA@@@@@AAA@A@A@A@@AFB@@@AB@@A@A@@B@BA@@AAAAAC@A@@@ABA@A@@@AB@@A@@A@@A@@@A@CAA@A@AA@AC@@AA@@AAAACAA@A@@@A@AFAB@@ADA@ABABABADA@AB@@@F@@A@@@C@EBC@EBA@ABABABAB@@A@A@CAA@@@A@A@ABA@@B@@AD@BAB@@@DA@CBC@@@A@A@@@C@A@A@CB@@@@A@@@@AAA@AA@@@A@@@GHED@@A@A@AA@@@@AB@@A@@@A@@BA@@@@@AA@@AB@@ABA@AB@@A@@B@@ABAB@@@BB@BB@@AD@B@DAB@@@BAD@@AB@@A@@BC@@@A@A@AB@@@@@@@DA@@@@B@@AB@@A@@@A@AB@@@@@B@@DD@B@BC@ADCB@B@@@BAB@B@@BBB@BBB@@B@@B@BB@@B@@BB@BFDD@@@B@DADAB@BAD@BA@A@@@@B@@@D@B@@ABABA@@BB@BBDB@@@B@@@B@@BB@@@DA@@DA@@BA@A@@B@@@BABABA@GDABCB@@CB@@@@AB@B@B@D@D@B@B@B@@BBBD@BABAB@@C@C@@B@@@B@@BBBBABA@ABA@@@@@@B@@@B@DA@@B@@A@A@CBC@A@@@AA@AA@@AAB@@A@@BAB@BABA@@@A@@@A@@@CDAB@@@@AB@@A@@@@@AA@@@@A@@A@@AACA@@E@@@AA@@@@@@CC@@@@@@A@A@@@@@@B@@A@@B@@@@@@@B@@@@@@@B@@AA@@@@A@@@@@@AA@A@@@@@A@@C@@A@@@@A@@A@A@A@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@A@@@@BA@@@A@@@@BA@@@@@@@AB@@A@@@@@@@@@AA@@@@@@@@AA@@@@A@@@@BA@@@@@A@@@@@A@@@@@A@@BAB@D@BBB@B@BAB@@AAA@@@CB@B@@@@@B@@BBBBB@@@ABBB@BBB@@ABAB@@BB@@@BBB@@BB@B@D@@@BAB@B@@@@CD@@AB@@@@@@@@AB@@@@@B@B@@ABA@A@ABA@ABA@AB@@C@A@ABA@A@EAA@ABC@A@@@A@@B@@@@@@@@A@@B@@@@@B@@@@@@@B@@@@@B@@A@@B@@A@@@A@@@@@@@A@@CA@@@G@A@A@AACCAAAA@A@@@C@@@A@@@A@@A@AA@@A@A@@BA@A@@@AAA@A@I@@@@B@@@@BBBD@@@@@B@@@@A@@@AB@BABADAB@D@@ABCB@@ABAA@@@@AA@A@AAAAAA@AA@@A@@A@@AAA@@A@A@A@@A@@@AA@@CBA@@@A@A@ABA@A@@A@AA@AEA@@A@@B@@A@A@CAC@@BABABC@@@A@@AACC@AACA@@C@A@@CAACA@@@A@@@A@BDA@@D@@@BABAD@DA@@@A@A@@@CDA@C@A@CB@@AB@BA@A@@@CB@@A@A@A@ABA@@B@@AAA@@@@A@@AAAAA@@@AA@BC@C@@@A@@DA@AB@@EBCB@@@@A@@@A@CBAAA@@@AB@@A@@BAB@@A@A@CBA@@@ABEFCB@@@BA@@BA@@DA@@@@@ABC@@@AACCAAA@A@E@@@A@@AAC@AAAAC@@A@AA@@@@@ABA@@@AAA@ACAAC@@A@AAABA@@@C@A@A@AAAA@@A@CAA@C@AA@@A@A@@@@@ABABAB@@@B@B@@CB@@@@A@AAAACA@@AAAA@@A@AAA@A@A@@@@C@A@A@CAC@A@@@A@C@A@@@@AA@BC@C@CC@@CB@@A@C@A@@@AA@A@@AAB@AA@@A@A@@@@AA@@A@@BA@ABA@@A@AA@AA@@A@@@@AAABA@A@A@@@A@A@AAAAA@G@AAA@A@AA@A@A@@@AAAA@@A@AA@B@BEBA@@BABCBA@@@@AAAA@@@@@A@ABABABA@AD@B@@AB@@CBA@ABC@@@A@ABCDGBA@@B@BAB@@@@A@A@@@A@@@ABADC@A@@@ABA@A@A@A@A@AA@AA@AB@BC@@B@@A@@@ADABCBC@@B@BA@@B@DABABADADA@@DBB@DAD@BAB@FAB@@@B@@A@@BABADAB@@A@@B@AA@AAA@AAC@@AAAA@A@@@A@A@@BA@C@A@A@A@GB@@@B@BA@@B@@@BAAC@@DA@@@A@@A@@AA@@@@A@@BA@@@A@CBA@@@@@A@@@C@@@@@A@@A@A@A@A@A@AA@@A@@AAAAA@AA@CAAA@@CCAA@C@@@C@A@A@@@A@AA@@AA@A@A@AA@@A@A@@@A@AB@@@B@@A@ABCB@@CBCDA@CCEAAAAAA@CBE@@@ABA@@@EAC@CBAAA@C@@@C@@@AB@BA@A@A@A@AA@A@@A@@AAA@@CAA@A@C@A@A@A@A@AA@@A@A@@@A@C@ABABCB@@AB@@AB@@CAA@@BA@A@@@"],encodeOffsets:[[106574,35015]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
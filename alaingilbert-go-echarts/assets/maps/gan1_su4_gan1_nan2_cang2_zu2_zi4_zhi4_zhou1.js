@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("甘南藏族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"623001",properties:{name:"合作市",cp:[102.910484,35.000286],childNum:1},geometry:{type:"Polygon",coordinates:["@@GCCC@@AA@A@C@ABAB@@A@@ACAAAECCAEAA@AC@G@A@CCA@A@AAE@@@A@@E@AA@A@A@C@G@A@CBAA@@ACCC@A@AAA@A@AAAA@A@A@C@AAA@ACAAA@C@AAC@A@A@A@CJ@@EBGDCBEB@B@@A@@F@DAB@D@B@@ED@B@@@B@DABAD@B@B@BDDDFBBAB@@AD@@@B@@@DBB@@A@@@EBIBCBA@CBC@@BABCF@@ADBFAD@L@D@@BBHBB@BB@B@B@@AD@B@BCHEFABA@C@EAA@ABA@GFCDC@G@CBEBABCDEFAB@@CB@B@JBFBD@JADC@GBC@CA@B@BBB@B@@BBBBBB@@BDBBDBBB@@DB@BBBBDDD@BB@@BBBAB@@BB@B@B@@@DBB@B@B@BBF@DBJDH@BBBDDFBHDFDFBDBBBBBDDDHBD@D@DABAD@@BBDDDB@B@B@DADAD@D@DAFAF@D@B@DBF@B@BABCDCF@DAB@D@BAD@F@BAD@B@BBB@DFHFLDFBB@B@B@BABADCB@BAD@F@BAB@@A@O@C@EAA@A@ABC@C@C@E@E@@@EBABCB@@ABAB@BAD@@AHBBBLBBBD@@@B@B@@ABCBABC@CFC@ABA@C@A@AAE@C@CAG@A@A@@@@B@BAB@BABCBC@A@CBAAE@A@A@C@A@C@CBABC@ABA@IAE@CBA@@@@B@B@BAB@BA@AB@BAFABADA@EF@@CBCB@@AB@BCBCBA@CBC@C@C@C@A@CBA@ABCB@@@BAB@D@D@@@DBBBBFBBB@B@B@D@D@D@D@D@B@@@BGFAB@DAB@B@D@H@DBBBDDBDBJFBBB@B@D@F@D@FBHBD@JDD@N@B@HBDBD@D@B@@@@B@B@B@BA@AB@B@@@B@@BDBB@B@BBF@B@DAB@BADA@ABA@A@CBCAC@@BAB@@@B@B@B@BBBBBDF@BBB@B@BADAJ@B@BBBD@DBF@@@B@@BBBBBB@FBBBB@@BBDBBBDBBHFDBDDBBDBB@@B@BB@B@DDBB@B@BA@ABA@E@@BC@A@A@A@@@@@@BB@@@B@@@B@B@B@@@@@@B@B@@AB@B@@@@ABA@EB@@@@@@@BB@B@DBB@BB@B@B@BAB@BADCDEDEDEHEDADCB@B@B@B@NAD@@B@DDBBBB@@DB@BBBBH@D@BABADABCBA@AB@@AB@@BB@D@@@BCD@BAD@BABABDBDB@DBBB@@BDBBBBB@BB@B@B@@BBBB@@BBB@@@@B@BBB@@@@B@B@BB@B@B@FCD@F@H@J@F@B@D@@BB@DBB@@BBBBD@BBBB@DB@@DBDF@@DBDBD@FBDB@@B@@BAB@@ABABA@@@BB@@D@F@D@D@D@DB@@BB@BBBB@F@B@B@BBDBBBBBBB@BBDDHB@@BBDBH@BB@@BB@B@BBDBHHDBDF@BBBBBBFBBBBBBDBBB@BDD@B@D@B@@ABCDAB@@@B@B@D@DBF@DBDDDBF@B@B@F@F@BCD@BEDFDDFB@D@@BB@@B@D@DFFFFBB@B@D@B@B@D@DBB@BBBBBJFBBBB@B@BB@@@DCD@BAB@BAF@@@BCB@B@B@B@BAB@HAB@@@@@B@BA@@@@FBBBB@B@BAB@BAB@@@B@B@@BBBB@B@D@@@@ABA@ABCBCDABABAB@B@B@@@BAB@BAB@BBB@B@B@B@B@@@B@BBB@B@B@B@D@B@B@B@@@B@BAD@@@DB@@D@DBB@@@F@F@BB@@@AAC@A@ABEBAB@BC@@@A@AAA@A@A@ABC@ADG@A@GBA@@BA@BB@D@D@D@F@BAB@@@@E@ABGDAB@BAB@B@BAB@D@BB@@BA@@DBB@B@@@BAB@@ABABAD@FAHAL@@ADALGFEJEJEFCDCBCBCBA@ABCDABAD@LCBAH@L@FABANBHAP@L@F@VCXGNAV@J@H@BBB@BADAD@JAB@F@B@HCFADCB@FEFEB@D@N@@@B@@A@ABA@@B@DA@@DCJEB@@@ACBA@AFAFADADABAFAB@FCJGBAB@FB@@DGFCDABAB@D@DAB@D@HADAD@@BB@@BBB@@B@B@BAB@D@FAB@FBD@B@D@BACC@A@ABEBCDCBC@ADG@CDC@A@AA@ACCAAAA@Q@EBC@CB@DAD@D@H@BAB@BGDEDGFKBCBEAA@C@EAA@A@@@@A@ABAJGDAJCB@@A@A@C@C@CBCDABABEDEDADCBADGBCB@ACACAE@G@CBCDCFCFAB@B@DAB@BA@CBABABA@ABADCJE@@@AACACCCAA@CAIACCCECCCAAAAAC@C@A@@@@FCBADC@@BCBA@C@I@EA@@@CCA@@AAA@CAE@CAAAAA@A@ABEAA@A@GDCBA@CAC@E@E@EAC@AACEA@CAC@A@@@CA@@@A@@@@@@BAB@@ABEDC@CBC@@BA@@B@B@BABA@@BA@@BC@AA@@@@@@@BA@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@A@@@@A@@@@@A@@@@@@@@@@A@@@@@@A@@A@A@@@@@@@A@@@@@@AA@@@@@@@@@A@@@@@@@@AA@@@@@AA@@@@@@@@@@@@@@@@@A@@A@@@@@AC@@A@@@@@@@@@@@@@@@A@@@@@@A@@A@@@@@AA@@@@A@@@@@@@AA@@@@@AA@@@@@@AA@@@@@@@A@@@@@@A@@@@@@@@@@A@AA@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@A@@@@A@@A@@@@@@@A@@@@@@@@@@A@@@@@@@A@@A@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@A@@@@AA@@@@@@@@@@@@A@@@@@@AA@@@@@A@@@@@@@@B@@@AA@@@@@@@@@A@@A@@A@@@@@A@@@@@@AA@@@@@@@@AA@@@@@@@@@AA@@@@@A@@@@A@@@AE@@@@@@AAA@@@@@@@A@A@@@@@@AA@@@@@@@A@AA@@A@A@C@@@@@A@@@@@@@@@@@@@A@@@@@@A@@A@@@@@@@@@A@@@A@@@@@@@A@@A@@@@A@@@@@@A@@A@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@AA@@@@@AAAA@@@@AAA@A@@A@@@@A@@A@@@@AA@@@@@@@@AAAA@@A@@A@@@@@@@@A@@@@A@@A@@@@@@A@@AAAA@@@AA@@@AAAA@@@@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@AA@@@@@@@@A@@@@@@@AA@@@@@@@@@@@@A@@@@A@@@@@@@A@@A@@A@@@@@@@@A@@@@@AAAAA@@@@@@@G@C@A@CAAA@ACCACAAA@A@A@A@CAAACA@A@A@AACAAAACAAAC@AAAAAAAA@A@AA@A@A@@A@@@AA@AA@@AAA@@@@@C@@@AA@A@A@@CA@A@AAAAACAAACACA@A@@BEBA@A@C@@@AA@@@K@A@A@CDABIDA@@@ADCDABC@A@C@CAA@A@CBEDCFABAB@@EBABCBCBCBA@A@A@A@@AACAA@@C@IBC@C@CAA@C@A@C@A@CBABCAE@@@C@@@ABABABA@C@A@A@@BABA@A@A@ABCBABC@C@A@AA@@@C@@@AA@A@@@AAA@A@@@A@AB@@A@A@A@A@AAABA@CBCBCBABA@AAAA@@@@A@AB@@ABC@@AA@@@AAC@ABA@EB@@A@ABAAA@AACACCEAAAA@@A@C@CBCBC@@AACA@@C@A@ABEBA@GBC@A@CDEBK@A@AA@@@A@ABCBCDAHEDEDA@@@E@ABCBCBCDAHEDCDADAB@JAB@FCHCDAB@BCDCBCBA@CBG@ADABABAD@BA@@@@BA@A@A@@BA@@B@DDD@DBD@FAFAB@DAD@@@B@BD@BB@@@@@B@NEDAFCDABABABGBCBABA@@AE@ABA@C@A@C@ABCBADE@CBEBA@A@AAAAACAAA@C@G@A@CBA@@@A@@@AAE@ABCDCBAB@B@B@@@BC@ABABABADCDCDCBA@AD@H@B@@A@AAA@AAC@AAACAAAAA@AAA@@A@C@C@CAAAA@AAE@C@A@ABCBCBA@C@C@EAIAI@G@E@C@IAEBAAG@AAA@ACCAECAAAAAA@C@A@ABA@A@I@ABCDEBABCBABE@A@A@AAACAAAAA@AAC@ABC@CAAAAAAECCCA@AAAA@AAC@AAACAIA@A@@BAF@B@@@@@@CA@CECAACAEAAGCAAACCCCC@@@@@ABABA@A@AAA@AEEEEACAC@@AAA@A@A@CDA@A@AA"],encodeOffsets:[[105508,35462]]}},{type:"Feature",id:"623021",properties:{name:"临潭县",cp:[103.353919,34.692747],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@A@@AACECA@C@C@A@CBABC@CBABAB@BA@AAC@A@IDABE@EAGCIA@@@@AA@@A@AA@@@AA@CC@@AAA@@@@@AAAB@@@@@@@@@@@@A@@@@@@@AA@@@@A@A@@@@@@@AA@@@@AA@@A@@@CE@@CG@@@@@@A@@@@@@@@@A@@@@@ABA@[JAACCAAA@ABABCBABA@@@AAACCGAA@I@KBEBADAF@B@DA@@@AAA@E@MBEBABA@@FCDGBC@C@G@ACGEGAAACACAAA@E@OCCAGCECGECAEAQOIGCEAC@C@A@A@@CACAAC@@@EA@CAA@@@E@CAA@CCAAA@CCAABC@C@AAAAAG@A@@@CCA@A@A@ABCDCBE@CBCBC@CF@@@@CFEDCBA@ABAB@FAD@B@B@B@@@D@DAB@B@@BBBB@D@B@B@D@@BBB@B@B@@@BB@@@@B@@@BB@@@@@@@BBB@@@@@@@@BB@@@@@@@BB@@@@BBBDDB@@B@@@@@B@B@B@@AB@B@@@B@@@B@@@@@@@@@BA@@@@B@@@BA@A@@@A@@@@@C@@@@@@@@@AB@@@@A@@AA@@@@@AB@@@@AB@@AB@@@@@@AB@@@@AB@@@@@@@@@B@BA@@B@@@BA@@D@@@@@@@B@@B@@@@@@B@@@@@B@@@@@@@@@@@@BD@D@BADAD@DAB@H@F@DBB@F@BB@AB@D@B@BBD@@ABABAB@BBBDBDFBBFB@BBDBBBBJ@B@DABAD@B@DANEBADBB@@BBB@@A@@@C@@@AB@@@B@BDBB@F@D@D@DAHGB@@ADBF@DBBBD@D@B@DADAB@FCFCFCBABCBA@@@A@@@ACCAA@@B@@ABBB@BDDDDDBDBD@B@BAFAD@@A@E@AACD@B@DEJ@BE@CBABAB@@A@A@@@A@CBCBABABAH@B@@@@@@@@D@B@DBFBB@DBH@HAB@DBBBD@D@F@D@LBZ@@@AFGF@BAB@HADCDGBG@E@AAA@M@C@AAC@C@C@CCC@CBE@EACBABAJ@BAF@BBD@@JHDD@BDBDD@HBDBDD@B@B@BBBADAH@@@H@B@@B@DB@BBF@F@JAF@@ADBDBFBFAD@DCDEDANCFADAL@H@B@DAB@@@ABBB@@H@DABBBB@@BHAH@DA@MDK@E@C@G@GAE@GBSBA@BD@B@B@BAD@BB@FBFDB@@@@BB@@BAD@DBBDBB@NAHAB@DBBAHAB@BBDF@BCDEDAD@BDFD@BBH@DABABCDIBEBABALBB@D@LBB@BA@AA@CKAG@AAC@AAC@ABADADCBA@EB@@@D@HBDBN@DBFDDDB@@E@A@A@CAA@A@@@@@A@@B@BA@@BA@@@@@ABCBC@@BA@A@@B@@A@@@ABA@A@A@A@@@A@A@A@@@@@BEBG@E@KC@@EG@A@A@@DCFEDAJAFAF@BABB@@B@@@@AB@@A@AAA@ABA@ABA@@@AA@@@A@BAA@A@A@@@BA@@F@@A@@@A@@E@@@A@@@@@@AB@B@@@AA@@A@B@B@@A@@@A@@AAA@AA@A@@"],["@@AAAE@G@@DBD@BAD@D@B@BBDBDDFLDHBBBB@DBDHHJFBBDD@@@B@@@B@B@@A@@@@@AD@@B@BA@@@@@D@B@DBBDDB@FDBB@B@@@BB@@B@@BBHDDCD@J@D@D@B@DADCHA@@B@B@B@DA@ADCDADCDABAB@D@BBFFFFFDBBB@@@FGDCB@B@D@HBDBFAD@DADCFAB@F@B@B@B@@B@B@D@DBDBF@D@BABABE@CBIAEA@@A@CBGBG@EAECCAAA@BA@AHEF@D@BDD@@BBB@NB@@B@@FBBB@BBDAD@BAB@BBD@DBDDDF@@@@FAFBF@JA@@BJ@BABAF@DB@DDHDDBDBBBB@BB@BBD@B@@CJ@B@BDFBBBB@BAD@F@BAB@B@B@BAFADAD@D@BBBDDBBB@H@D@DADAB@PLAB@B@B@B@FBF@FAF@F@D@@DBD@JDFDB@BDBFDBBB@@B@@@BCBA@@B@DBDBD@DAD@BB@@AF@B@FBJA@CDABABA@BFAB@B@F@@ABAB@BGDAB@B@B@@MJCFAB@@A@E@G@@@ABEDA@ABABA@AAC@A@E@C@EACAC@A@A@CAG@AAC@AAACCACCA@CAABA@AB@FBDBDBDB@@BADEJ@B@H@D@DDHBDBB@DABBD@BDB@BBBAD@@@@@BA@@@@BAB@@@@BB@@@BBB@B@BB@BB@B@@@@BB@@@@@B@@BB@@B@@B@@B@@@B@BB@@@@ABAB@B@@B@BB@BB@@@B@@@@B@B@@B@@@@B@@@@@@@BBBBBD@@@B@@B@@@B@@@@@@B@B@@B@@A@@B@@@@B@@@@BA@@@BB@@@@@@@@@B@@@@BB@@@B@@B@@@B@@BA@@B@@A@@@A@@@@@@BB@@@@@B@@@@B@@@@@B@@A@@@@@@B@@B@B@D@@@B@@B@@@@AB@@@@@@DB@@@BBB@@@@@DAB@B@@@BAB@DB@D@B@@BBBABAHADBD@D@@CB@BA@@DAD@@@DBD@@BBB@BBHAB@@@JHBB@@JFBB@B@B@@AD@DAF@BABIFABAF@@@D@D@@@BCDGH@@AB@BBBBB@H@@DBDBD@DBBBJFJFHD@@BBB@AB@BA@@B@@DBBBBD@B@BAD@BBD@@AB@B@BBB@J@DBB@BA@AB@@@F@BHHFCFAB@DCBAJCBA@ABEBABADA@AB@DAB@DAD@B@HCB@FCDCBABABAAE@AAABA@@@CBABABA@@BC@C@@@AB@B@B@H@@@B@BBB@@@BABAB@B@@@BBBDHDDBD@B@BAB@@@BABABA@A@G@A@@BABCBC@@BA@A@ABALI@ADA@@D@B@B@BADGB@@A@@AA@@@AA@AAI@A@C@C@EB@@G@A@C@A@CAA@EACCA@A@IGCACCA@@@@@CAAAABA@E@A@@A@@@A@C@@BAB@D@B@FADAFCBAB@DBB@@@BBBBB@@BBD@@DBJFBB@@@@D@D@F@@BB@@B@BDBBBB@DBD@DBB@B@D@B@@AB@@ABA@@@AAA@@AC@A@A@G@EBC@EBABA@A@@@A@A@AKQ@@A@@@CFA@BBA@@@E@AA@@CAAA@@@ABA@A@@AA@AA@AAAA@EA@@@AAABAA@@AA@@A@A@AAA@CAA@A@AAA@ABEAEAA@A@E@@@AAA@AA@@@C@AAA@AKK@@IGEC@AAA@A@@BCDCBABA@A@@AC@A@@AA@@AA@C@@BABABEBADADAFC@@B@FCB@BBHB@@D@HDB@FDBBBBB@@BD@D@F@B@BBD@B@FBDBD@FBFBJ@B@D@FABBD@DBJD@@DBFDD@D@PAD@B@H@BBLBD@D@B@B@HDB@D@D@DAD@F@F@B@@@BA@@BCBAB@B@F@B@LCHADA@AFG@ABC@I@A@@BAFCD@B@BAHABAB@A@@A@@A@EB@@A@A@@AA@@ABABCBA@@@@BAB@BADA@A@@@A@A@@AAA@ABABA@AB@@ABC@AA@AC@CCEAA@ECAA@A@@AA@@E@AAA@CAC@CAG@C@A@CAGAAACA@C@A@A@@B@@A@@FEDADCBC@C@ACCGEGGCCAA@CBC@C@ACCAEACAACAAA@CEEAEEC@@AABABABADAF@@@BABAAEAAAAK@IAC@AAAC@C@A@ABAB@D@B@B@@BBBBBBBFDDBB@DAB@B@@AB@BE@C@A@A@AAA@AAAAAC@EC@@AAAEA@AC@A@A@@@AB@DEBCDABA@CBADADAFEDEDCBABA@ABE@@@AB@B@D@B@@AB@ACAAAC@A@E@AAAAC@@FEBBB@BB@B@@@BB@D@D@DABCBABABC@@BABADCLEDADCBC@A@AAACCA@@ABABAFAFADAB@FCBABA@@@EBA@@@@BC@C@CBABA@ABAFA@@B@@CBC@CBA@@B@DABAD@FAB@@ABA@@@A@AAE@@AC@@AC@C@AAAA@AA@A@@AA@A@@@AAA@@@ABA@@BCB@@A@A@A@A@AAA@AACCAAC@@@C@@@A@A@@@A@CAA@AAA@AAA@@C@A@@AAACACC@A@@@AAE@C@AAA@A@A@CBA@A@A@ADCB@@A@AA@@@CAAAC@AA@A@@@A@AAAAAA@@@AA@ACAAAA@A@AA@@@ABABAB@@AB@@A@@D@@AB@@A@A@AB@DAB@@@BA@ABABAB@BABAB@B@@A@A@AB@BA@A@@@@@@A@CAAA@@AC@AAAA@@A@AA@CCAA@ACE@A@AACCA@@BCB@@A@@@ABA@@DCB@@@@AAA@A@@CAAA@AAA@AAACA@@ABAA@@CAA@IECCA@CAA@@@ABC@AB@@AD@@@BABA@@@A@A@@@CA@AEAC@E@CAE@C@@@CAC@AA@@AACA@@A@@@A@AB@@AAA@ABA@ABGB@@@@@AA@@C@A@AAC@@BA@A@A@ABAAAA@@@@ABA@A@@B@BAB@BAB@@A@@@@@A@A@@BA@@BADADA@CBABC@A@@FCB@B@@A@@BABEEB@@AA@@AAAAACACA@A@GAA@EBA@A@@CAAAA@AA@A@A@A@ABGFEDABADA@CBABABA@CBC@A@C@CAAAA@A@A@A@C@A@@@A@@BA@@B@@A@A@A@AB@@ADCDCFADABABEBG@EBCB@@AB@BCDA@@BAAC@C@E@A@ABA@@@@BAB@@@BBB@BBB@BAD@@@BA@EBC@A@A@A@A@@@ABA@@B@BBBBBBDBBDBBDBB@B@B@@ABBB@B@BA@@BA@@@A@@@ABEF@@AFABAB@@AD@@@@AB@@@@@@AB@@A@@@@@@@A@@@@@A@A@@@@@A@@@ABA@A@AAA@@@@@@@C@@@@@AA@@A@@AA@@AA@@@@@@@AAAB@@ABA@CDA@ABA@A@@@@BA@@@@@A@@@@@@@@@A@@@A@A@@@@@A@AAA@@C@A@@BCBA@A@ABAHGBA@A@AACAAC@AAA@A@A@CBADA@@B@BBB@BA@@BA@ABC@@@C@A@AAAACE@ACAAAC@ABA@EFAD@D@D@DBDBDB@@@B@FBD@@B@@@D@@ABCBABC@CBA@ABCBA@ABA@A@EACACAA@C@CBCBEB@BA@CA@@AACCAAAAC@A@ABABABCDABC@@BA@@@ECA@AACACAAAA@@@@@ADAFAF@D@B@BDBBBB@DDD@HAD@B@B@BB@B@@@D@@AD@H@DAD@BAB@DBB@F@BBBB@@B@@ABGDA@@B@BB@BDBDBDBD@F@D@@AB@@@@@@@@@@@B@@@@@@@@A@@@@@A@@@A@A@ABCBEDCBCBA@@@A@@@GGCAAAA@AA@@AAA@A@AAAA@A@ABADAF@F@HA@@@@B@@@B@@AA@AAAAA@@ABA@@@@BAD@@@@BB@B@B@@@DC@A@A@@C@ABA@A@A@EAGCAAEA@@G@C@@BA@@@ABCBA@A@A@CDAB@BAD@@A@A@CCA@@@ABAD@BABAD@@AF@B@BAB@B@B@B@BADAD@BAB@BAB@B@@BB@@DBDDDBBB@@@B@B@BAD@DAD@HADADA@ABC@ABCBCBCBC@AAAAAAC@C@A@C@@@@@@@@@@@@A@@A@@@@@@@@A@@@@@@@@@A@@BGBA@@@@@A@A@CAC@EBCBC@@BAB@@A@@@@@@CAA@ECGECA@AAA@BCBEBA@A@A@CB@@A@AAA@@@@A@ABIBC@A@@A@A@A@C@E@ABCBCBA@C@A@OAA@CAGECCAAEE@@A@A@A@EAAAA@@@@@ABBB@BBDBF@DCDCDABAB@HALBFDBB@FBFL@@@BFDDFB@@HBF@F@DABDDB@BB"],["@@@@A@@@@@A@@@@@@@A@@B@@@@A@@@@@A@@@@@A@@A@@@@@@A@AB@@CB@@@@@@A@@BA@CB@B@@@@A@@@@@AB@@@@@@A@@@@@@@@@AB@@@BAB@BA@ABAB@AAAACC@C@C@C@EBABA@@@@@@A@CAEACBEDEBA@AF@DABABGDE@E@A@@AEIEAAA@CC@@E@E@@@ECIGCEA@AAC@A@CBEBA@C@AAAA@CBGBCDAB@B@FBF@B@BA@A@A@AAA@@AA@@C@@AAAGG@@A@@BCDCBC@ABCBGJADA@EBEBA@@@@@@BBBB@BB@@@B@@@BA@A@B@@@BB@@A@A@@B@@@@B@@@F@@@@B@@@BE@@@AB@@B@B@B@ABB@@@B@@B@@AB@BAB@BBB@B@BA@@B@@A@BBAPAJ@@@B@B@B@@@B@B@BAB@B@@@BA@@@@BAB@@ADAD@B@@@@AB@@ABA@@@@B@@@@@BBB@D@B@B@FB@D@DAH@F@B@@ABC@CAA@@@A@@AAA@E@C@ACAA@CBAF@HAF@DBB@@B@B@DBBBDD@JBBB@@@D@DINEH@B@BDB@B@@ABA@DFBBF@HABABAB@DBDBF@D@D@D@D@HBB@D@DADAF@DBDBD@N@@@BAFGDAF@FBHB@@DBJBBBD@D@DAFBF@BABA@@AAAEEECGAEBCGCAA@@@AA@@A@@@AAAECA@CCAA@C@A@C@@@@ABA@@@BC@@"],["@@BA@@DA@CB@BAD@@ABA@GB@DABADEDA@C@AAA@AEEBGAC@AA@C@E@CAEAEEEEC@AAACA@EAI@@@@@A@@DBF@BBD@B@DBBBD@@@B@D@BBBAD@B@B@B@F@BADCFCDAFAB@BBD@FAF@@B@BBDAFAB@DABAD@D@B@FAB@D@"],["@@D@J@@@@@BCBAFCB@@AA@@A@A@@@AAAAE@@@@DCJABAFEBAB@BAFADA@@B@@@IG@@CA@@@AA@@@@C@@A@CAM@GCGAC@@@CAC@A@@@A@G@GAA@A@@@@BABAFABADAF@BABADAB@B@BBB@D@B@B@@ABA@EFCB@BAD@BABIB@@@BBBB@BDBDBD@HBB@BBBB@@@B@DAJEBAFAB@DCBABATA"]],encodeOffsets:[[[105878,35489]],[[105931,35507]],[[105963,35532]],[[105828,35645]],[[105692,35623]]]}},{type:"Feature",id:"623023",properties:{name:"舟曲县",cp:[104.251482,33.793631],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@IACACAA@@A@@@C@C@A@GAAA@EECCCCAAAA@@A@A@G@A@AA@AAA@@@A@C@C@C@@DC@A@@@ACECC@A@@@A@ABC@@AAAA@@AAAAAA@AAA@A@AAA@A@A@CBE@@BCBADCBA@AA@A@A@A@A@I@AAAA@@A@@@A@CA@@A@A@C@ABABA@@AAAAA@@@@C@@@A@AAAACC@@CAC@CAC@@@A@ACAA@@AAAAA@A@A@ABCF@@A@@@AAICC@CEEAA@@@CBABCBABA@CBE@ABABA@CBA@@@CF@D@BA@A@GCECA@C@ECA@GD@@AB@@@@AB@BA@AB@BAH@DAB@@AB@BAB@L@D@FBH@D@@@B@BBD@BB@BD@BBDBB@@@D@BDD@@AB@BCD@BA@CB@BABABA@@BCB@B@BBB@BB@@DBBABCDEF@BADCB@B@DCJAFAD@BABCDAD@BCFA@@BA@ABAB@@ABABABCFADAFAB@@@BAB@@@B@@@AA@EECAIEA@@AAC@@AA@@@A@A@@@AC@ABC@A@GBC@GDCBA@ABAB@@C@A@CA@@AA@@AAA@AACACAA@C@C@A@A@AAAAA@EACAAACA@@@BGDGBCBMACBGDA@EDGBGDGDGDIDKDEBGBGBK@IAMCOCUGICIAIAIBI@G@EAEAE@IBCDADCJAHCJCHCJCHEJAJEHAHCHBBAL@@@BABCBG@EBEFGLCBGDEBGACAIECACAA@CBCBG@CD@H@FAFADAD@F@DBBDDBFABCF@DAHCHEHEFIDEBMDGHEDCHCHGJCLAHAHEVAHCNCJADGJEJAH@D@FADCFADAB@B@B@B@FBBBDHHFDDBBBBHADBJABBBBBBBB@BBD@LD@BBB@@BBAD@BABCBA@CB@D@DBF@B@DBF@BADABEF@BADABAD@BABABABCBGFEDKJGDEFMHGFEDEHEDGFEHIFMNEFIDIFIFIFIDOFIDQBEDEBEBABADBD@BA@AFAD@B@B@BADCHABAB@@@@D@BBBBFFDDFFB@@BFAF@@@BB@@BDB@@B@B@D@@@BBB@@AD@@BB@B@DBB@BDD@@ABABA@A@ABABA@A@ABAB@BA@A@A@A@@BAFA@ABAB@@@BAB@@A@EB@@A@@D@BAB@@A@A@A@A@A@C@@@A@C@A@EBAB@BABABA@ADABA@ABA@CBCBABEDAB@@@BAB@@A@AB@@@BAB@@A@CAC@E@ABA@CHEJ@@A@AAA@A@MFIBCBA@AAA@@@A@EFEFCDABED@BAD@BABAB@@EBC@E@C@A@EBA@C@G@E@EBCDCBABEDEFEHEFILCBA@AB@BAB@BBD@B@@@BABAB@B@B@BAD@BC@ABCBGFC@ABCBABE@E@CBC@CBCDABC@GB@@ABAB@@@BBDBF@D@BBBBB@DBD@BDDD@@B@@BDBDAD@BDBDFDBBBBDBFBDDD@BFDFFBBDBBD@DABABAD@B@BFBBBBFDDFHFF@BBFBB@BB@H@BBDBBDBBB@BBB@DBB@DBBBBBBBJ@D@DBFBFDBBD@B@D@BAB@B@@@FBHBH@JDF@HBDAB@@@B@@@@@@@D@D@B@DBDDDBBBDBDBDBD@BADABAB@D@@@BAF@@@B@BAB@BBHBHDB@BB@@BDDFB@DBBDDDDD@@B@D@B@F@BAD@HAB@B@B@BB@@B@@@AB@B@B@@BBB@ABGFABGDAB@@@DBBBBBBBBDDDDBB@BBBBFDFFDFDDBBBDDDDB@B@DAF@BABBB@BAB@B@BBBBFB@@DBD@@@BA@@DA@AB@@@DADABAB@@@B@@@D@D@B@D@DA@@BA@A@A@ABABABCBA@@@A@@CEAA@@@@BABAFC@ADADAB@DC@@FAB@F@B@B@@@D@B@@BB@B@B@B@BBB@B@@@B@BA@ABA@AB@BADABA@@@@@C@@BADABA@@BBDB@@B@B@BA@@@AB@@A@@B@DABA@@@ABABAB@BABAB@B@B@BAB@JCHA@@@@BA@@AA@C@@AAB@@@BADA@CB@DABABA@AB@@A@A@@@@B@DABAFABA@@DAB@@@BADCBAB@BA@@F@B@@@F@@@B@BABAB@D@@@@AB@@@D@@@BA@@@@H@@@B@@@B@BAB@@@B@B@@@@ABABAB@@AF@B@DABA@@BA@A@ABE@@@AA@@@@A@@HABABABABABA@@BABA@@B@@ABAFABAB@@A@@BC@ADAB@B@DC@@D@B@@@BADA@@B@BABA@@BA@A@AAA@@@A@@DB@@D@B@@ADADADC@@@AB@B@B@@@@A@@@@DAD@B@@AB@B@B@BB@@B@B@B@B@@@B@@@BA@@@AB@B@@ADA@A@AB@DA@@FBB@@@B@B@@@BA@@B@B@@@BA@@@@BAACBA@@D@B@BABAB@@A@A@@@AB@BAB@@@@@@C@AB@BABABAB@BC@@B@B@@@BAB@BE@@@@AC@@@A@ABA@@BADA@@B@BBB@BBB@@@DDB@@@DABAB@DBB@DCB@@ABAF@@@B@@A@AB@B@@@B@B@B@DB@@B@DAB@DBD@H@D@B@B@B@@@BA@@H@B@D@FB@AD@FAD@B@D@@ABABA@@@CB@DCDC@AB@@A@ABABCBA@C@@B@BABC@@BAB@FAB@B@D@@A@@@@@C@ABA@@@@BA@A@@BAB@DA@@FCDADADCBABC@A@@AAAAAC@AA@@A@A@@@A@A@@@A@@@AB@@@BCBABCB@@C@@BA@A@ABC@@AA@@@@B@@@B@@@B@BA@@BC@C@@B@BA@AB@DABA@@B@BAB@B@DA@@B@F@D@F@@@BA@@BBB@B@@@HAB@F@B@@@B@@BB@H@@@@B@@@@B@@@B@@@B@B@BB@@@@B@BAB@BADABA@@B@@AB@@AB@B@BCDAB@DCB@BAB@D@@@@@@@@@@A@@@@@@B@DAB@@@@@@B@@D@BB@BB@@@B@FABABAB@F@B@@ABA@@AA@@CC@AAA@A@A@A@A@A@A@@@ACAAA@AAAA@AA@C@@AA@AAA@@@C@A@A@@A@CAAA@@AA@@ACAAAA@@@ACA@A@@A@@BA@AD@B@@CAA@AA@@ACAACAA@A@@@@@@ABA@@@@AAA@A@AB@A@@A@@@@A@@@@BAB@@@@A@@@@A@C@@@@A@@@@A@@@@@@@@@A@@@AA@@AA@@A@@@AA@A@@AB@@AA@B@@AA@@@@A@@@@A@A@@C@@A@@@@A@@@@AA@@A@@@@AA@@@@@@@A@@@@@@B@@A@@@A@@B@@AB@D@@@@AA@@ACCACAAAAAA@AB@@@DABA@@BA@ABA@AB@DABAB@@@DA@@@A@AB@@AB@@@D@D@B@B@@@BABABCBE@CDGBE@@@CBA@@@AB@@A@@A@AA@@AA@@A@EB@@ABC@A@AB@BA@@@@AAA@A@A@ABA@A@@@@DC@ABA@@@A@A@@A@@AAA@@@A@@DC@AB@BAB@@@@@@@F@@AB@B@@@@BB@@@D@B@B@B@@@@@@@B@@@@@@B@@BA@@@@@B@@B@@@B@@A@@BB@@B@@A@@@AB@@@BB@@BBDD@@BB@@@BBB@D@@@@B@BAB@D@@@BDBB@@B@BA@@DCBA@@BA@@FCB@@AB@@@@A@C@A@@A@A@@@C@@@AAAA@@ABA@@@@@@A@@@A@A@A@@BA@@@@AA@AA@@@AB@@@A@@@@@@@@A@C@A@A@A@EBA@A@@@A@ABA@@@A@@@DAB@BCB@B@BA@@@@A@AAAAA@@@BC@@@A@@@@A@@@AA@AAA@@@ABE@A@@@@E@G@@@@C@@BA@A@A@@@@B@D@F@D@B@B@B@@A@A@@@C@@C@CACAC@@@@A@@DA@@@@B@B@@@B@BB@@B@@AB@B@B@B@B@BBB@@@BA@AB@BCBABCBC@@@A@@@C@@@A@A@@@@@@BA@@@@B@@BB@@@BBBB@@@@@BBBB@@BB@BB@@BB@@@@B@@BB@B@B@@A@@@@B@@AB@@@@@BAB@@@B@@A@@B@@@@@@@@@B@@@@@@@@@@@@@@A@@A@@A@@@@@@@AA@@AA@@@@@A@@AA@@@@@@AA@@@A@@@@@@A@@A@@@@@@A@@@AAA@@@@@A@@@@A@@@@@@A@AAA@@@@@@@@@@@@@@@A@@@@@@@@@AB@@@DA@@@B@B@@@@BB@B@B@@@@BB@BB@@B@@D@B@@AB@@ADCBAFEBABA@@@@@@B@@@D@B@B@B@@@D@@@B@B@@@BAB@@@@@@A@@A@@@@@BE@A@@@@B@B@@A@A@@@C@@@@AA@A@A@A@A@@DCBC@@@ABA@CBAAA@C@@@@CAA@@@@C@AAA@@BC@AAC@@BC@A@C@@@@@@@@A@C@@@@AA@@@@@A@@B@@A@AB@@@@C@A@A@A@@@@@@A@ABABCBC@ABAACBAFGBCDC@@B@@@B@BBDBBBB@D@@@FC@@DEFGDCFCBAB@BAB@BAB@DA@A@@BEB@BA@@FCB@B@B@B@@@@A@@B@DABABA@@B@@@B@@AFA@@BAHAB@@@@@DBB@B@B@F@@AB@@@@@B@BABA@@B@B@B@D@@@@@@A@A@@BA@ABA@A@ABA@AB@@@B@B@B@D@@@B@@A@@BA@@B@@@DBB@BAB@B@@@BA@@D@BAB@DEDABA@@@@B@B@@ADC@@BA@@@A@@JABA@@@@BABAB@BAB@BADA@@BA@A@AB@@EAA@CAC@A@CAA@A@C@CDC@A@@DCDCBABA@@@ABABADCBAFA@A@@BC@@AA@A@A@@BA@@DAF@DADABAD@DADC@@GCCACAGCC@EAA@@AAA@@@A@@@ABC@@@A@G@A@@BCBC@A@AAAAA@AAAAC@CBC@A@ABABA@ABA@@@A@A@@DCB@B@@A@@DC@@@AAAAA@@AAACAAA@@@ACCAA@@AB@@ADC@A@E@ABAFCBABA@@@AB@D@B@HABAB@B@B@BAB@BABCBA@@BC@@@@F@B@BA@@DAH@B@@AB@@A@AD@@AB@FAFA@@BADABACK@AACAC@@G@A@A@CAC@C@CAC@A@A@@AAAA@AA@@A@A@A@A@@AA@@CA@@AE@A@CAAAA@@@@BCBABABC@CBC@CDAB@@AB@@A@@@CCC@C@CAAAC@A@ABC@A@A@CAE@EAABABA@@BG@ABCBA@"],encodeOffsets:[[107188,34105]]}},{type:"Feature",id:"623022",properties:{name:"卓尼县",cp:[103.507109,34.589588],childNum:5},geometry:{type:"MultiPolygon",
+coordinates:[["@@A@AAIEA@AAA@A@@@ABC@CBC@GBE@A@C@ABA@ABC@E@AAGAC@A@A@AAC@AAA@ECA@AACA@@C@A@@@A@@A@@@AA@A@@A@@AA@AAC@A@@AA@A@A@A@@CA@C@@@@CAA@EAA@AAAA@@CCCA@@AA@AEBEBABAB@BB@DDBB@B@BADCDCBKFCDABAB@@ADABABADCBC@C@A@@A@@@AAAA@AAEF@@BDBB@B@F@BBDBBBDA@@BA@C@A@A@@B@@AF@BABABCDCFEFCBCBAB@DABCBADCFA@@B@@@B@BBDB@BFBB@@FDD@BBBB@BBB@B@B@B@DAFA@@BA@A@CBA@CAECAAAAAA@AA@A@C@A@AB@B@B@DBDBBD@JBL@BBBBBFABAB@@E@CBABABABBB@@FDBFFF@DBBDBBBBDBFDD@B@DAD@DBBDDHHHFDD@B@DADCDCBEF@@@BA@@@@B@B@DDBBBHBDBB@D@H@DBD@DBB@BBF@@@BB@@@BBBFDB@FBDDD@@BBBD@BA@@BAB@BABAB@BB@@@B@B@@@BCBABA@AB@@@@ABADAB@BB@@BB@B@@@FAB@@@@BB@@@@@ABAD@BCF@BABAB@@AB@@AB@@AB@B@B@BBB@BBF@FB@BJ@F@@BD@@BBBBB@DBBBBBBB@B@DBF@B@BB@@B@B@F@BAB@BAD@B@@ABA@@BA@AB@B@D@@@BBB@@@B@@A@CB@@ABAD@@CD@BAB@BABBB@@F@DBJBDBB@@@DBD@B@DBFDFDB@@@B@BADABAD@B@BBB@BBB@BADCB@FA@ABAB@DADADCBAB@DAF@HCF@DADAD@DBD@DAB@BABC@A@CB@DAFABA@ABAB@D@B@B@BA@ABCAC@@BADA@C@A@CA@ACAAAC@A@@@ABAHAFA@ADABAB@BBFDBBDFBBBDBFBBDDDBDBB@@@BABAB@@A@@D@BABABAFBH@D@FBBAB@DCHABABACAA@AC@@@A@AD@FAB@D@B@BBFDB@@@@AB@@AB@@C@AB@FAJBH@DB@@BBB@B@@@B@@@B@JIB@@A@AA@@CBABA@G@G@AA@EAC@A@AAA@ECC@A@@@@C@A@CBABABC@EDABAB@@ADCB@DADAB@@@BA@AB@BAD@@C@ACA@@AGAEAAACACAAA@AAEACAAAACCAEC@@C@AAACACAAA@AB@@A@@BA@@@AB@@@AAAA@AAA@@AAAA@@A@AAA@AAA@@A@A@A@@@@@ABAB@@A@AAAA@@A@AA@@AACAACIEAAAA@AA@A@AAC@@A@A@AAA@@@@BABAB@BAD@FAHADA@@BA@ACA@ABC@@D@@ABABA@AAEBA@@@@@@F@DEBAB@BABADCBAD@BA@@@A@A@A@@@ABA@A@C@@@C@@@CAA@C@@A@@AB@@A@@BA@ABC@A@AB@BBB@B@HADB@@B@@@B@B@@@D@DA@@@ADBB@@@@@@ACCB@@ABA@@BC@@BAB@DA@AB@@AD@A@@AA@CAAAAEA@@ACA@A@E@AJEDCDAB@@@AA@@ABA@CAC@@@AAA@AA@@CC@AAA@ADA@AB@AA@AAA@A@A@A@@@A@AA@@ACAA@@@A@A@ADABAB@@@@@C@@@ACCAA@AC@A@EBA@ABEDABA@@BA@@@AAA@C@@@AAACACAACAACCACACC@@ABA@A@A@CA@@A@A@@@ABA@CB@@@@A@@BADABAB@@A@A@C@@BA@@@A@A@A@A@@@EBCB@@C@@BABA@C@@@C@C@AB@@A@A@A@CAA@@@AAAAAC@@A@AA@@ABA@@@AFAB@D@DCB@DABCBA@@B"],["@@@@BIBOAA@@AAABE@EBIBCBEFCD@@@B@BFH@@LDF@H@FA@A"],["@@AACGEKCCCAAAA@C@C@ABC@CA@@@HBFBBJFBF@@@B@FCFAHABCBE@@BABCFAFBDBF@D@B@@@@B@BAFAD@D@D@D@BDBB@BBABAB@@ABA@A@@BA@@@@@@@@B@@@@@@@BA@@@@B@@@@@@ADAB@@AB@@@@@@@DA@@BAB@@@@@@@@BB@@@@@B@@@@@B@@@@@@AB@@@@@@@B@@@@@B@@@@@B@@@@A@A@@@A@@CCAAIEGGAC@CAA"],["@@BB@BBBBBDBBB@BBD@BBB@B@BA@G@C@@BABCDCDCDABABAB@BAD@@A@A@A@ABCDAD@BBF@B@@@B@@AB@D@B@H@DBBDBBBBB@B@BABAF@DCFABAD@B@D@B@DAB@BBF@@ABABADAHABABCBEDCBMFA@@@@@A@@AACA@@@C@CBA@EBEBC@CAC@CCA@@@AB@@@B@BAB@@@@ABC@ABABCB@BAH@DABADCDADA@CBGDEDA@IBA@CBCBCDGFCBADADAD@B@F@@CBCFGFCBADAD@B@B@@BBB@L@FADCB@D@HAB@FABAB@D@@@DBBB@@ADAD@D@D@BB@BBFBDDDBBBB@BBBAB@@@FAB@BAD@BB@@B@@BD@BA@@BAB@@@@@BBBBB@BADADADAB@BABBB@B@B@B@@@BAB@@@B@B@BB@@B@B@@B@@@D@@BBB@D@D@BADABAB@B@B@BA@AB@B@D@B@BABABA@@D@@@F@DBBADAB@D@B@D@B@DBD@D@JAD@@@BBBD@BB@B@B@B@DADADABAFA@@BABADEFCDAB@B@DBD@B@D@BADCBC@@B@JCBADCB@B@L@@@B@@B@@@D@BABAF@@@BDBDBBBDBBBBB@B@BDB@@@B@BBB@@D@@@@@B@BB@@BBB@@B@@@BB@B@B@@B@BBBBBBBBBD@BBDBBBBBBD@B@B@BDBBBDBB@B@B@B@BBBDDD@BBBDBB@D@H@@@@@@@B@BBBB@@@@B@@@@@@@@@@BB@@@@B@@@@@@@B@@B@@@@@@@@@@@@@BB@@@@@@B@@@@@@@@@BB@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@B@@@@@@@@@BBBB@@B@@B@@BBBB@@@B@@@@B@@@@B@@B@@@@@@@@@@BB@@@BBBB@@@@@@@@BB@@@@@BB@@@@@@BB@B@BB@@@@BBBB@@@@B@@BB@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@B@@@@B@@@@B@@@@@@BB@@@@@@@B@@@B@@@@@@@@@B@@@@B@@@@B@@@@@@@@@@@@@B@@@@@D@B@B@@@BBB@@@@@@@B@@B@@@@B@B@@@@@@@B@BB@@@@@@BF@@B@@@@@@B@@@@BB@@@@@@@@B@@B@@@@@@B@@B@@@@B@@@@@B@@@@BB@@@@@@@@@B@@B@@@A@@@@@@B@@@@@B@@B@@@@B@@@@@@@@@@@@@BB@@@@@B@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@BB@@@@@@@B@@@@@@@@@@@@B@@@@@@@BB@@@@@@B@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@BBB@@@@@@@@@@@@B@@@@B@@@@@@@B@@B@@@@B@@B@@@@BB@@@@@@B@@@@@BB@@@@B@@@@B@@@@B@@@@@@@@@@@@@@@B@@@BD@@@@B@@@@B@@@@@@@@@@@@@@@@BB@@@@B@@B@@@@@@B@@@@@@@@@B@@B@@@@B@@@@@@@B@B@@@@B@@@@B@@@@@@@@@@@@B@@@@@B@@B@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@AB@@@@@@B@@BAD@@AB@@ABABA@A@@@AB@@AD@DCDAF@BA@AB@@@@@@@B@@DB@@B@D@DBB@DFBBD@FBF@F@D@DBB@DAHCB@B@FBBAB@B@BBBB@DBF@DBB@BB@DD@@B@@F@J@DABAD@@CDABED@@@@@B@DBDBBBBDDFDDDBDBJ@DBBDDBDBD@B@@IFCDAB@BABABAB@DABA@CBA@A@EBEDCDAD@D@HBFBDBDA@ADCHABCDCBCFAFABCBAD@D@D@D@B@BA@IDCBIHAB@B@B@@B@B@FBD@B@FBDALAHEFCHC@ABA@A@G@CBC@CDAD@FAR@B@BBDBBDB@@B@BCD@DCH@BADCDADAF@B@BDD@@DAB@D@B@FBFDDBBAD@JEDAD@D@DAB@B@B@DAB@B@H@NB@AD@FCHCHAFCHABAHADAD@DAJBDAD@D@D@@@B@@B@BBB@F@DAD@DBHDH@B@@FB@@DBBB@B@@BAB@@AB@@@@BB@@B@B@@@@B@@@BALCPEB@D@D@BADA@@B@FALGB@@@B@B@B@HGDCDAB@FABADABA@@JEBAB@BAPIHCFCFCFAB@HCFCJCFAD@B@RAB@B@BANIDABA@@@A@CHK@@@C@@BA@@DADAD@DABAGG@A@E@@BAB@@AAA@C@IAA@A@ABA@@AC@ABC@A@AACAACA@@@AB@@ABAA@AA@@GCIEIEAACAC@CACA@@@GAAAA@ABA@@HGDC@A@@@C@C@@BEBAJEBA@ABE@CBC@@@A@AAAIE@@AAIG@@A@GBAAA@AA@@AC@C@@BC@CB@@ADA@@@CACBCBGBAAA@AA@C@A@@CBA@A@@@ABA@C@@@@AA@A@@CA@@@@@@BA@@@@@AA@@@C@A@A@@@@A@@@@B@@@@A@@@@@A@@A@@@@@A@@A@@@@B@@@B@@@@AB@@AA@@@A@@@@A@@AA@@@@@A@@@@@@@@AA@@B@@A@@A@@@@@@AB@@@@AA@A@@@@@@@@A@@@AA@@@C@AAAA@A@@@@@@@A@@A@@@@A@A@@A@@@A@@AAAA@@@@ABABA@@@@AAA@@@A@@@@AA@@@AA@@@A@@@@AA@@@@@AAAA@@A@AAA@A@@AA@@@@BA@A@@B@@A@@@@BCAA@ACA@AACBA@CAAACCG@C@C@G@AFIBC@AA@ACACAC@EBAB@BADBB@DDDBBDBBD@BBH@DBB@B@D@DBFBD@F@B@D@BBB@BABAB@FCBA@@H@F@B@@@BADENI@@@A@ABAHC@ABABA@@@E@ABAAEB@BABADCB@AI@E@ABE@@AAC@CBC@CACAA@@@ABAD@@A@@@AACAAEACA@ECICC@CA@@@C@EBE@EAE@E@A@A@ABAOKA@CBCBC@G@A@AACCAA@A@CBCBCBE@A@A@ABA@A@EBC@AAAAACE@A@ADI@@@AAC@AAAA@AACACAGCCCA@@CBEBA@AAI@@IBE@EAEB@@@@CECCCAC@AAA@ABC@CBAAA@AA@EA@@@MAA@AA@B@@@B@BBB@B@@AD@BAB@@AB@BA@@@@@A@@BABA@@@@BA@ABA@AB@@@@ABBBBB@BBB@B@B@@BB@B@@@BAB@B@@AB@D@@@B@@@B@BB@@BB@BBBB@@@@A@AB@@@@@@ABA@@@C@@@A@@@AA@@A@ABEA@@A@@@@B@BA@A@CBB@@BA@A@AB@@A@@@CA@@A@@@A@A@@BA@A@CBE@@@A@A@A@ABAAABA@AACBABA@@B@B@@@B@B@B@DA@@@A@@@A@@@A@A@A@@@AB@@AB@@A@C@C@@A@AA@@@@AC@A@ABC@A@CBIB@@@@AA@EACAA@@@A@CAA@@AAAAC@C@AAA@@@@@@A@@A@AAC@GAA@C@@@ABAB@@ABCFEDABA@@D@@@BA@EBA@@BA@@BAB@@A@@@AAA@A@@@C@AB@@C@C@A@AB@@A@@@C@@@@@ABABA@A@@@@B@@A@A@ABA@@@A@EB@@AA@@@BA@C@ABAA@BA@@@@@@@A@@@@BA@CB@@C@@@@@@A@@@A@@@@@@A@A@AB@@@@AB@BABA@@BA@AAA@A@@@AAC@A@CBAA@@ABAB@@AA@@C@A@A@@DCBCFABCBA@@HAB@BC@ABA@@DCB@@@B@B@B@@@@@D@B@@DB@B@@BBBBBB@@A@@@AB@@@@BBAF@@AB@@@FC@ABA@A@A@@BABABA@C@CACB@@A@AAA@EA@@CBCBCB@@A@@@AAA@CAA@@@C@EBC@@@A@@@A@AB@@@@BBA@@@EBA@A@A@@@@B@@@@CBAB@@A@@@A@ABAB@@CBA@A@C@CBC@A@@B@@@B@BA@@@@@ABAB@@@@AB@@@B@@AD@@A@A@AAA@A@@B@@@BA@@@@BAB@@@BA@AB@@ABA@@BABA@A@A@A@@@A@AB@@A@A@@@A@@BABA@@BA@@@E@AAA@A@A@AB@B@@A@@B@@@@AB@AA@A@@@A@@@A@A@@@A@A@AAAB@@C@A@C@A@A@AA@@C@C@A@A@@@@A@@BA@ADA@A@@DA@@@@B@@AB@BABA@@BABAB@@@BA@ADCBA@@BAF@B@BAB@@A@@DB@@DCA@B@@@@@@AB@BA@@@A@A@@@C@@BCDA@ABA@@@@DA@ABA@@B@BAB@@AB@BADA@@@ABAB@B@B@@@B@D@@@BA@@D@B@BC@AB@@A@AD@B@B@BADAB@B@@A@@A@@AB@@A@@@@AA@@@@@@@A@@@@BAB@B@B@@@BA@@DA@@@@A@@A@@@@@A@@@@@@AA@A@@@ABABA@@@CAABA@A@AA@AC@@@AA@@@@AA@A@@A@@@A@@A@AA@@AAA@@@AA@@A@@@A@C@A@@@@@@BA@@BA@@@A@@@@@A@@@A@A@CBAB@@C@AAA@@@ABC@A@@@AA@@AA@AA@@A@@A@@AAA@@@@@@@@@@A@@@@@AA@@AC@@A@AA@@@A@@@A@A@@@A@@@@@A@@@A@@A@@@@ABA@@A@@@@@@@@A@@GE@A@@@@@@@@@@A@@@@@@A@@A@@@@@@@@@@@@AA@@@@@@@@@@AA@@@@@@@@@@@@@A@@A@@@@@@@@@@CBEBABA@ABEFABIBCD@@@@BFBB@B@@@B@BB@@BA@EDABAD@@@@I@C@SBABABCDA@EBABIFCBA@@@A@AA@AAA@GACACACA@AA@A@@JABA@ABC@ADAFEB@BA@@@A@A@CAA@A@ABABCBA@ABEBCBABEBA@A@@B@B@HBH@B@@@B@D@DB@@D@HBHDN@DBB@@@@D@@B@@B@@DB@@@CAA@@@@@@BA@@@@@@@A@A@@AAA@@A@ABC@@@G@A@A@AAACAA@@A@A@A@A@A@A@AAA@EB@@A@A@A@A@ABC@A@@AAA@AC@@@C@AAAA@A@@@@@C@ABA@@@AA@@@@AAACABABA@@@ABA@A@ABA@ABA@@BCBA@AB@@A@@@A@@@ABA@AAA@A@@@A@@@A@A@AAA@@@@@@@@@@AA@@@C@AA@@ABA@@@A@@BA@@@A@@@A@A@A@@@AA@@AAA@@A@@A@@AA@AA@@@@C@@@A@@@CAA@A@AD@BADA@@@CBAB@@CA@@A@@@AA@@A@AB@@@BCB@@AB@@@@A@@@A@C@A@C@@BA@@@@B@@@@B@@A@@B@@BB@A@@B@@@@@@@@AB@@@@@@@@@B@@@@@B@@@A@@@B@@@@@@@@B@A@BBB@BB@@@@A@@B@@A@@@A@@@@@@@@B@@@B@@@@@@ABA@@B@@B@@@"],["@@EGAAK@AAAA@AKGKCEBC@CBA@@@CACCKGC@QCC@UFE@C@M@GACAGCCCA@A@A@KBEBC@EBC@GBCDM@EACBCBA@ABEAKBE@GBI@I@I@A@EEA@C@GBUH@@@B@DABABGBEBA@GA@@A@ABEBC@@AC@C@ABA@ABAB@BAD@B@@EBA@ABABABEBABC@C@A@@DAB@@E@CBABADABA@E@C@C@CBC@CBABABA@A@GDC@@BA@CBC@A@A@ADABCBABCBC@C@A@C@@@@BBD@B@@@@C@IAAACCCCAGAG@A@@CBCBCBA@A@A@CA@@C@G@EBG@E@IAE@A@C@A@A@CAACCAAAAAA@IAA@CACC@AA@@A@ABADCB@BABA@A@@@AAAAC@ABA@A@A@CAAAAAACCAAAA@A@AAE@A@@GAC@I@E@GBC@EDA@ABC@G@AAA@CAG@C@EBA@EBEBA@A@ECC@A@@@ID@B@@B@DDBB@BBB@D@BAD@@C@E@ABCB@@ABE@@@BB@B@@BB@B@J@B@@DD@@@B@BABB@@B@BBDDNBD@BBB@@@@A@A@EDABGBKDEBCBEBCB@@@@@@@B@B@F@@BDFDDBFH@B@B@H@F@FBB@BBBDBBBNDD@D@BBD@BBBBBBBD@B@HBBDDDD@BFDDDBB@@@DBBBDDFBBBBBB@B@B@BAB@B@D@B@@FLBFBDBFJJBD@B@D@D@B@BDBDDB@BB@@@DBB@BBBDBDDFBBBBBBBBBBBBDBD@BBBFBDDBBD@PDHB@@DB@@D@D@D@B@FB@BBBBBD@D@B@D@BBDBDBB@DBD@D@B@@A@@@ABAB@@@BBHDFDBDBD@D@D@B@D@D@BBFHL@DBBDDBB@@@BA@ABABB@@BBBB@JDB@DDBBDBBB@@BF@BAD@D@BAH@BABABA@@B@BBB@D@B@@ABABCBABGBABA@AB@B@B@B@@HBBBDFFDBB@BBDBBB@@@D@B@B@@BB@@B@HBB@@DDBB@BDDBBBBNFB@BBD@DBHDBBDH@DB@@BFF@@@DB@B@B@D@BBD@B@BBBDB@BBD@B@B@B@BB@B@BBB@B@BDDBD@@BBDAB@H@D@B@B@B@@B@FB@@@F@BBB@B@DDB@H@D@@BBBBFDDBFBBBD@@@BA@AB@B@D@BBB@@DDHDBBB@B@DCB@B@B@BB@@BDBDFFFF@BBB@B@BABAB@B@@@@DDDDBDBBHDBBBFBDDBDFB@@D@@@@A@E@AB@@@BJBDBBB@BBD@BBBBBB@DDFDBBBBBB@DAD@BBD@BBBBBDBBB@B@B@BAFABADABCFAD@B@J@BAB@B@B@DBBBBBBFDDBBDB@BBH@BBFAJBD@F@H@J@JBFBD@D@B@DADABAB@D@F@BBB@BBDBD@D@@@@AB@BA@@@@@@@A@@@A@@@@@@B@@@B@@@@AB@@@@@AAA@AAB@A@@@@@@@@@@A@@@B@@@A@@@@@A@@@@@@@@BA@@@@@@@@@AB@A@@AA@@@@BA@@@@@@A@@B@@AD@B@D@B@@@B@@@@@BA@@DA@A@@BAB@@@BB@@B@@@DB@@BADA@@B@BC@ABCB@B@DB@@B@@@D@@@@@BBB@@BB@@@@BB@BB@@BB@@B@B@B@@@B@@@B@@AB@@@B@BA@@BBD@@@B@@B@@@@@@@@B@BBB@B@@@B@@@B@B@BBB@BA@@B@@@B@@@BAB@DA@AB@BAB@BAB@B@BA@@B@BABABDBB@@@@BB@@B@BAD@@@@@B@B@BB@B@D@@BDB@BB@@@BAD@B@B@B@B@BA@@FBB@B@B@B@B@B@B@BB@DBBB@B@B@B@H@@AD@B@BB@BB@@@B@B@@@@@@AB@@@@@@BB@DJH@@A@@@@@@@@@@BB@@@@@@@@@@@@@B@@B@@@@@@@@B@@B@@@@@@@@@@B@@@@B@@@@B@@@@@@@@@@@@BHF@@@B@@@@@@B@@@AB@B@@B@@@@B@@@B@@@@@B@@@B@B@@@B@@BBB@@@BD@@BB@@@@B@@@@@@@@@@@BB@BB@@@@BB@@BBB@@BB@@B@D@BA@@B@BBD@@@BADAB@B@@@B@@@@@B@@@B@@AB@@A@@@@B@D@B@@@B@@@BB@@B@BB@@BBB@@@@B@@@BB@B@@B@@B@@B@@BDB@@B@BABBB@D@@ABAB@B@@@BBB@@@@@@@B@@@@@BB@@@@@CB@@AB@@A@A@A@AB@@@@@B@@@@@@BB@@@@@BA@@BB@@@@BA@A@CBABA@A@C@@B@BA@@BADA@C@@@AB@@C@A@@@A@A@A@AB@B@@CBABA@@BA@ABA@@@AB@BCB@@@@AB@BCBAD@@@D@@@B@B@@ABA@@B@@@@A@B@CD@@CA@@@BA@ABA@E@AB@@ABCD@BAB@@A@ABAB@@ABABA@@BA@@@@@CB@@@BCB@BAB@@@B@@B@B@D@D@@@BBB@B@D@B@D@@@BABBB@B@@@B@B@@@B@@@B@B@@BBA@@@@@AB@@@@ABAB@B@B@BBF@@@B@@AB@BA@AB@@@B@B@@@BAB@@@B@B@B@B@BA@AB@BA@@BAB@@A@@BA@A@@B@@A@@@AB@B@BBB@B@@@BC@@@A@@BA@@@@BABA@@@@B@@A@A@@@AB@D@DAD@B@B@DA@@BABAB@@@B@@@BADA@@@@@A@@B@B@B@FA@@B@AA@@@@BAB@@@B@@@D@FAD@@@B@DBB@BB@@B@@@DADADA@@FBB@BBB@@@DADBD@B@BABA@AB@B@B@BAD@@E@@BA@@BEAA@@@@BA@@B@@@AAAAAA@@@ACA@@@A@C@@@@@A@A@AABC@A@EBA@C@C@ABCBA@EBCBAAA@@@BE@EAC@ABABEDCDEBC@A@E@A@A@ABCAA@A@C@A@@ACAA@C@AAC@AAE@CB@@@@@J@FBB@BDBBD@FFFFFBDBF@D@B@@BBDAHFF@BBB@BB@B@D@@@BB@@BABA@@BBDAB@D@BB@@B@B@BBB@@AB@BA@ABA@@@@BAB@B@@@@@@@@B@@@B@@@@D@@@DAB@@A@@B@@@@@@@B@@ABBBAD@B@@A@@BB@@FAB@@@B@BAB@B@@@@A@@B@B@BABA@@@@D@@@B@@@BAB@D@D@@@BAD@@@B@B@BB@@B@@@BA@AB@@AB@FAB@@A@@@CB@BAFCDEBA@@BABA@@D@B@HBD@BBB@@@@B@@@@B@BBD@D@BBBB@@BB@D@B@@BBBD@FBB@@@@JADAB@D@BAB@D@@B@@B@@B@BD@D@B@@@BA@@BA@@B@B@B@@@B@@@B@@@B@@C@A@A@A@@@A@AB@BADABBB@BABBBAB@B@B@@@F@DAB@B@@AB@B@@@B@@@DB@@B@@@BAB@B@@AA@DAB@B@@A@A@@B@@@FBBAB@@@BB@@B@@@D@@@B@BA@@@@@@BAB@@@@@AAAAA@@AA@@A@A@@@A@@@CBA@@@ABA@A@@@AAA@@@A@AAA@AAAAABA@@@@BAB@BAB@@A@@B@BA@AB@@@@@B@@ABA@@BA@ABC@@@AAA@A@A@@@A@@CC@A@CFEBGB@@ABBDBFDFBH@HADAB@@@FBJBDAF@BABA@A@CAEAC@C@C@A@AA@A@A@E@A@EBCDCBC@EBCAGAC@A@A@CDEH@@A@AAECEEEEAAC@A@ABCBCDCBCD@BCBA@A@A@@@GBCDCBA@C@C@I@C@CDADBFDHFFBFBB@@ABABE@EACBC@C@AAIACA@@GAEAE@CBEHAB@@M@C@CACAE@CBCBC@A@GAC@C@C@C@E@CACAA@ABABGBE@AACEB@BA@@@ACA@A@AFGJM@C@C@@AAIAC@ACAA@C@A@AA@CAE@GBE@AB@DBBBDD@F@B@BB@@@B@@BB@DAD@BA@E@G@CBC@A@A@CCECCAM@CAGAC@@@A@@FABCDCBAB@BBD@BBD@BBHDLB@@BABA@KAC@A@KAABABAFCJADABCBG@AAC@CE@ABCFCDC@ACEAAA@GBABCAA@GBMBA@CAAA@CBC@AA@@A@@A@ECEAA@@ABC@A@A@AACB@TAHAF@HBH@D@F@L@NCB@@CBGAG@@AAAACBG@@@AABA@@A@CBA@G@K@CBEBMDCBCFCDC@EBEACACA@BE@IBE@E@AAA@@C@AA@G@@@G@CBABAAA@A@C@ACAC@GCCCA@ACCIG@@AC@ABE@ABIBADAFBF@DAD@DDD@D@D@BBD@N@B@BBF@H@HADCBC@GBA@AHEBE@@Y@KAC@E@C@C@AACAA@GBG@CAA@EACAA@C@@@@@@@@@@ABGBABADADAB@@@B@B@@@BABADAF@@AFI@C@ADCBBF@B@@@BCBE@A@AACACCCCCACA@AA@BA@@@BBDD@B@@@B@@ABADABEDEDEDA@CBCBA@C@C@AACAE@CA@BA@GHCBC@C@E@A@CA@A@A@@BA@@D@@@B@@@AA@AA@CAABMFCBA@C@ABCBA@I@AAAAAC@AEAAACECAAA@ABABABA@@AC@A@A@CBAA@@A@EAA@C@E@GBA@CBCBC@A@CAC@@@@@@@@@@@@@A@@@@@A@@@@A@@@@A@@@@@@@CB@@A@@@AB@@A@A@@@@@@@@BA@@@@BA@@@@@@BA@@BA@@@@BA@@@@B@@BB@@@@@BA@@@@@@@@D@@@@@B@@@B@B@@A@@@A@@B@@A@@@@@@@@@A@@@A@@@ABA@@@A@A@A@@@@@AA@CCAA@A@@A@@A@@@@@@AA@@@@@@@@AA@A@@@@@@AA@@A@@@@@AA@@A@A@A@AA@@@C@A@A@CAAAA@@@ABA@C@C@@@A@A@ABC@EBABAB@DAFCDE@@@@DED@DADAF@DADCBAB@B@B@DD@@B@H@BBBB@B@DADBBDDB@BBDDB@DBF@@@B@DBB@@F@@BDDBDB@@@B@B@DBDDFJHRPFBDBHFFDHDDBPDF@B@BBBDBDBBFHDH@B@H@DADCHED@@ABABAF@N@FBB@B@@CBA@E@CBABAF@L@JBBDHBDBB@@B@BADABABAB@BBDDBB\\IB@BA@@@@B@@@@@@@@@B@@@@@@@DH@@DF@@B@@@BB@@@@BB@@@@@@B@B@@@@@BB@@@@@@B@@@@@@@@@@@@@BABB@@@@B@BB@@DDB@@B@@BBB@@@BB@@@@JBHDFBF@BAJCB@D@BBB@@ABABADAD@BADAB@D@D@B@FDBD@BB@@@B@FAFAB@BCHIDABAD@DADC@AB@@@HHBB@BD@@@BB@@BB@B@B@BABA@E@EAA@A@CBADAH@DBBBBD@B@FADAB@D@BBB@DFJHFD@@F@F@@@BA@C@EAE@GA@CEEC@A@@EKEAA@CAAEBK@GBABADCDC@CAEAC@AAABA@@@@B@BBFBB@B@B@@@FFBBDDHFDBB@PBB@D@B@DADABAF@D@B@B@B@@@@BADAJ@B@B@@B@BBB@@@DAB@B@B@FADA@ABB@BDBHFFDB@DB@@@@@@@BA@AB@@ADAD@FBD@D@B@B@@@@ABAH@@@B@@@@@@@@@B@@@@@@B@@@@B@@@@@@@@@@D@B@D@D@BBBBBBD@DADADABAD@BAB@BCBC@GBC@CBC@A@A@A@@AACACCCA@@AA@@@ABA@ABA@ABCBC@A@A@A@ABA@A@ABE@@BCBA@ABCBA@@B@DDB@B@@@BC@ABADCB@B@B@DABA@@B@@AD@H@@@FBBBHDFBB@B@B@BAD@@@@B@BCD@@A@A@A@@A@@C@AB@@@@AB@BB@BBBBB@@BA@@@A@@@@@GBE@E@CBAB@B@BBBBBB@B@BB@@BBB@BBDBHH@@B@@@B@DADAFCDABAB@B@@@B@@@@@B@@@@@@@@@@A@@@@@@@@@@BA@@@C@EACACACACA@@A@AB@HCBA@@@AA@AA@A@EAA@CBA@ABC@C@GBC@@@C@@@AAAA@A@C@GBC@CCA@AACA@A@A@CBEBEBC@@@@B@BBDBDBBBB@FD@@B@@AD@BADCBABABAB@D@BBBBDDBB@@DBB@@AFADADAD@B@DBDBFBB@B@BAB@DABAB@DAD@BADABA@@@C@@@AC@EAA@@@A@ACAC@C@C@CBCFEB@BAD@BBDB@BDFBBBBB@D@@@D@BAB@@AB@@AAA@A@AB@BCDAB@B@B@BBD@BBBD@B@BABGHAB@B@BABAD@@@B@DB@BBB@@@@@B@B@@@B@@@@@@@@@B@@@@@B@@A@@B@B@BAB@DCB@BA@@BABB@@@@@@B@@BB@@BB@@@BB@@@@D@@@@@@@B@BBB@B@BA@@B@@@@@B@B@@@@@B@@@@@@@B@@@BA@@@@@@BA@@@@BC@@BABABE@@FEBA@@B@@@B@@AB@@A@AAABA@@@A@AAAACCAAAACAAAA@A@AB@BA@@B@B@B@B@D@FAB@@A@@BC@AAA@AAA@A@@BA@A@@B@BAB@F@D@D@BB@AB@DC@ABA@@DAFAH@FABABABCDEDCBC@@BAB@B@B@@@@AB@@AB@@@B@D@B@B@B@B@BBDBD@B@D@DAB@BABADAB@BCBAFCHEBAB@B@B@B@@BBBBB@DB@B@FAB@HBB@B@BDBDBBBB@@BB@@FAB@DA@@BABA@A@AB@@ABG@A@@B@@ADAFAB@D@FCDAB@B@@A@@ACAC@@@A@AAC@@AAACA@AC@C@C@A@CAA@@@C@A@@@AB@B@B@B@@BB@@@BA@@@ABA@ABA@@@C@E@@AA@@BA@@@@BABAAA@AB@@A@A@A@@@A@CBC@A@@B@@A@@BA@@@A@@@A@A@@AAAA@AAA@@AA@@@@BA@@AA@A@@AAAAAAAA@@@@@@@A@@@@AA@A@@AA@AAA@@@@AA@@@C@@@A@A@@AG@@A@@@@AA@@AA@C@ACA@@C@@@CA@AAA@@A@@AAA@@@AABA@@@A@@AA@AA@C@A@A@@@ABA@C@A@@BEAIBA@@AAA@A@@A@@@@A@@@@@C@AA@A@@A@@@@@A@@@@AAAAA@@AAA@A@@@@@A@C@@A@AAAACC@A@@BA@@CAC@@@@A@@A@@AC@@@A@@@@@A@ABA@AAA@A@AA@AAC@@@A@ABA@@BA@@AC@@@CBA@@@AA@E@AA@@@AA@@A@@C@AAA@@A@@@CAAA@@AAAAG@AB@@A@ADA@@@AACAAEE@AA@A@A@CBA@AAA@AACCCAEAA@@ACA@@A@@@A@@BCBABA@C@EBA@@A@@EECGAAAA@@@A@@@C@C@ABABA@ADABA@A@A@A@CAA@A@A@@@A@@B@@A@A@AACAA@A@CB@@A@@BABCBA@C@@BA@@B@FBB@FABAD@FAJCBA@@@@@ACA@@@A@A@@C@@AA@CAA@GAA@@@@E@AAC@@@A@@DA@A@@AACAEEAA@C@A@AA@AAE@EAAAIA@@C@A@A@AA@@AA@AA@AAA@C@@@@@@A@@BA@A@AA@ACA@A@C@A@C@ABEDEBABA@EEAC@@@C@AAAAGACAABABAFCBA@A@@AAA@@A@C@AA@ABC@GA@BIDA@A@K@K@EBGBGBEBCDEB@BEBIDOFCBAAC@CAABA@AA@@AACACAAAC@C@CBCAC@EBC@CBGBA@G@C@A@A@A@A@EA@AA@A@A@CAKCGEGCA@AA@CAA@@A@CAG@A@GBA@ACA@@@CBA@A@AAA@AAA@A@IAAAA@EBE@E@A@ABEDABA@@B@B@@@BABEB@@ABCD@@@B@BBFDF@B@@@B@@EDCBA@@BABCB@@AB@D@BGFEFAF@@B@BBD@B@B@BBB@@B@BFDBBD@BBF@D@DBB@D@B@BB@BHBBB@@B@@D@@BBB@DBDDB@@B@@@B@@A@EDABC@AB@@C@CBCDCB@@@B@@@@@BBDBBDDB@PDF@DBB@DBHH@B@@AD@DCBCDABC@C@CBC@ABA@CBC@C@AACBA@EDA@ABK@EBABAB@@@B@BABCBED@@CBC@E@KAA@IA@A@A@A@@BA@CAA@CBE@A@AAAACAAA@A@@@CAA@A@A@AAAA@C@AAACA@AAA@G@CAGACACAC@EACACCACEEGCGEAECEAC@G@A@CAC@A@GAEAEAE@EBA@EDCDCD@@@BBB@B@F@BA@@BA@CBC@CDADA@A@CAA@CE@@@@AB@@CFCBC@ABC@@@A@CBG@C@EAC@EAA@C@ABA@A@@BABAB@B@B@@A@E@CBC@C@GAC@E@SBA@IE@@A@@@AFCBABE@G@AACBA@@@A@ABA@E@E@GBGBA@C@E@A@@BA@CDCB@@@@E@A@EBA@EB@BABAB@@CDMDEB@BE@ABC@AACA"]],encodeOffsets:[[[106408,35583]],[[105879,35523]],[[105950,35514]],[[105626,35569]],[[105685,35116]]]}},{type:"Feature",id:"623024",properties:{name:"迭部县",cp:[103.221869,34.055938],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BC@CDA@@@CAE@CC@CDK@ACAKAA@GF@@KBC@A@@B@DBH@H@BA@CBA@EACAAA@@BEBEBI@AC@C@ECCACA@@ACAA@G@ACAA@E@I@AAC@CA@@AAGI@CAE@A@@AICCAE@CACACACC@C@C@I@I@CBCFGBGBGBA@BFBBDFDDDDAHDJBDBDLNDBBB@B@BDD@BDBBB@BBB@@@B@B@D@F@@BB@BBB@B@BBD@JBD@B@@C@KFE@IHCDAD@BB@F@D@@B@DAJ@F@D@BF@B@BBCFCHABCFEF@DAHAJCHCHEHEHA@EBIBGBI@IAUAQAGAICGCGGEGEKAEAEGGCCECAEAEAIEGGOCCIAGAMCKAIBK@KBO@IAG@I@cEI@KBKDIBIBIFGBEFGBKHGFSHGDCDABA@ABC@EBA@@B@FBDBFBDDBDBBB@BBBALAJADKHMFGBEBCBM@C@GDA@ECECGEMICCCCAGA@E@K@E@I@E@EBC@OHKDEDE@E@E@KBEBEBE@ABGDEFABADBHBFBD@DBDBFBDDJBDBH@B@DDHDF@FAD@BBHFRBHBFBFJNFPBJCLCHAFCFEPEFGDCBG@IBGAKAEBE@G@EDCDCDCFCFADADAF@D@D@FBJDPBDDBDBD@D@FBBBDB@@BBBDBD@BBJ@BBBDDDBDBJFDDBDBF@DBB@BBBAD@BABADCBABCFAHAHAD@NADABIBIBG@ICIEGBADBFBFBJ@@B@BBDDBB@DBDBD@D@DBJBBB@DAPCPAPBRBJBHFDJBFDD@BABOLMH[ZKBIACCCAADGFIHEDADBPDDDFLHB@@BGBADCDAH@LCJBXADEHEDADGFGFKFEDE@KBIBKBM@C@@@ULCDCBIBC@EEEEIEAAE@@@GBIDI@KCGAI@C@A@MDKDIFIHCHEACB@FCFBN@B@JBHBHBJDLBJAH@HCFEFKHEDEDGHCDAH@FAH@FAHGJKLGFKFEBE@EDABA@EBABCB@BBHBHDDDDBBJBD@@@@@@AAC@A@@D@B@D@D@DABADABABCB@B@D@DAB@@AD@HCB@B@BABADAD@DAD@D@F@B@BABCBADAF@@@BA@CB@D@D@BAFABABABAB@FA@@@ABC@ABABAB@BAD@D@@BD@FABAB@@@HBB@FAHABABA@C@A@@VGHAD@B@FFB@J@J@J@HAF@LAFBBAB@DADAFBN@DCHAD@FAD@FALAB@B@B@DDHDDBHBN@D@F@VED@RDD@LHDDDB@@B@DAD@FALDLH@BBBBBL@BBFHDBBBD@BAF@@AFANCDC@@BABA@AFAB@FAB@F@@@@@DADCB@@AB@F@D@B@HAHAF@F@B@BAB@@@B@DABBH@F@BADABE@@B@@@JFB@TAF@D@HBD@D@DAF@B@@@@A@ABABA@AB@B@BAD@B@FBD@FBD@H@DAB@@@D@BAD@DADE@@BA@@@@DFB@DBB@B@BCDCD@DAB@@AB@@A@E@AAA@A@@DCDCFCB@FAF@FBFBHBB@D@DBB@H@D@FBFDFBDHFHDFDBBDBD@FBDBDBDBH@D@HBB@BDBBB@B@DBBBBB@B@B@DB@@B@B@BBBDBB@B@BAF@DBB@DAB@@@B@B@BJBB@LBF@D@DA@@FCDABA@A@A@@BABAFAL@BAB@FCB@DABBD@D@DAB@BAD@DAD@D@BADCDA@CBC@@@AGGCAA@CAE@OCA@CCAAAC@A@@@@@A@@DADCDAD@@@BAD@BAFCB@@@@A@@@AA@CCCAA@AA@@@CA@@@AAGA@AAAA@C@A@CAC@E@AAC@AAEC@A@AA@AAA@A@C@AAA@@@BEFEHE@A@CBA@@DABA@AB@DAFC@@@A@@@ACEAE@A@A@@DCBA@@FABA@A@@@A@AB@BAFCBAB@F@F@FAB@BBJBB@B@BBB@BBB@B@DA@@B@BDB@HAB@H@DBB@@@BB@DBBB@HDHFLDDBB@B@B@@BFBB@B@B@B@D@H@B@HADAD@FAD@DBDAD@D@BBDBDBBB@@BBB@BADBD@BBDAPEJCFA@AFADCFAHAHAFAL@L@B@B@JC@AHBD@BAB@@B@D@BB@BB@@@BABEDABABBBBDBHBB@B@D@@BDFFB@BAFAFCBAD@B@D@B@B@BDB@@B@BAB@@@B@@@@D@B@BBB@@BBB@@BBB@B@D@@@JBBBFBF@BBB@@B@B@DBBFFDBBB@@@BCB@@@B@@BD@B@F@@B@HBB@DBB@@BD@@@@B@B@@DB@B@@@@ABIDDDBBDFDF@@D@DB@B@BB@B@BAB@B@B@BDDB@@B@D@D@F@B@D@DADAF@HCB@D@DBDBD@BABAFG@A@C@A@C@ABA@CB@DAFAFCJAD@XHDBF@FBDBDBBDHHBBB@D@BAFCB@D@DBDBD@BBB@BA@ABABABCBCDEB@B@@@B@B@@BBBDB@BB@H@B@DBBBB@D@@@B@BEDA@@DAB@B@BAD@@@BBB@B@B@B@@@BBB@@@B@@A@@@ABADGDEBADADAJGBCBAAC@AB@DG@A@AAE@C@ADCB@DABABA@C@ABCDADA@CBAFAB@B@B@B@B@@@BA@ABEBAHABADA@CBCB@B@BB@@B@@A@EBCFEBAB@DBDBBAB@AC@C@C@C@@@ADCBABC@ABCB@@AD@B@DBB@B@DELEHCDABEDCDCHAF@B@BBBDB@B@BABCBABCBE@ADCDEFCDCDAD@D@@@@ABCBABABABC@A@ABCDCB@B@BAB@BC@A@ADABAN@BADABC@A@ADADA@A@@@C@C@A@C@@@C@@AAAC@AAAACBE@AAA@AAA@A@@@A@@BADC@@@@@A@@CEEE@AA@@A@A@@@ABAAA@@@A@AA@@AACBA@A@@BAD@@ABA@@@AFC@@@@@@@AAA@AAA@AA@@CAAB@@@@@@@BA@@BADC@AB@BAB@@A@A@@@ABADAB@AC@A@@BC@@@AB@D@D@D@B@B@B@H@B@@@BABA@@@@BBB@B@BA@@DB@@B@@AHA@@@ABC@A@A@AAAAA@@@@DABA@ABCBAFA@A@@@A@AB@DCDC@ABCAA@A@AAACA@@@@@A@AA@AAABE@CBA@A@CCCCAACAECECCEAEAA@AAACCCCAAAAAAAA@C@@BAHCBAHEBAA@AA@@@A@ABA@@A@@@AAA@A@A@GBC@ABE@A@C@A@@@CCCCACCAA@CEAC@@AAA@GCGAAAA@ABA@@@E@AB@@C@A@ABCBABC@CACACAAACACCCAA@C@C@@@@@@@A@@@A@CBGAE@ICG@GAEA@@A@A@ABC@A@C@AAECEACAC@I@AAAAAACAA@CAA@AAA@AAACCAAAG@A@@AAAAE@AEEEGCCAEAAEA@A@ABCBABA@CACCAAAEEEC@ACCACAEACAACACECA@ABCACAC@@@AC@CC@AAC@CAAAA@A@CAEAC@A@@BABA@@HAD@BADCDAD@DAF@F@BADABAD@HEDABAD@@ABC@A@A@ABABA@A@@@AAC@ABA@ABAB@DAJKFEFGFEFCBADADCFAF@H@D@B@FAB@D@F@D@FA@@BABA@ABC@AFCBADCFEFEB@@@B@BBB@DAJANEB@B@BBB@@@FIDGB@BAF@D@DBB@@@BA@A@@BAB@@@BA@A@@BAFCBADADAB@BAB@BABCB@BABA@ABAFAB@D@B@@@D@B@B@B@B@B@@@BA@A@CB@@@FAB@@@BA@A@@BABAB@BE@AB@B@B@B@@ABABAB@B@BABAB@B@BABA@@CC@AAA@C@AAA@@BC@@AA@A@@@C@A@AA@AC@@AA@@E@EB@AA@EECCEEAAAAC@@@@@BABADGBC@A@A@ABCBEB@@AACBCBAE@G@QAG@E@I@GAIAEAECCAEAEAEAEAICG@IEG@I@I@EBE@GBQDIDE@SFE@K@E@IAI@I@I@IBK@KBOBEBM@E@GAIAE@MAMCOAEAEAG@KAI@OEGGIEICICGAQ@IBCBGBABAB@DAFABA@C@IBA@EBABABC@CBA@ABABABABIDA@C@A@G@A@A@ABEDABCDEBMDABQHA@A@@@@G@CCCC@A@ABIJABC@AA@C@AGEC@A@ADCF@B@@DB@BB@@JAB@@C@C@AAAAA@A@CDA@A@AAEEA@@@@B@F@BB@HDBBFFBBPDBB@BBBEFBD@BAB@@A@AAIEA@A@A@A@@@CDAHA@@@@@AACAA@@A@ABC@EACCCC@A@@B@BCFADA@A@AA@A@C@AACA@@@EDC@A@@BAB@BBDBDFJ@B@@@BC@A@"],encodeOffsets:[[106118,34534]]}},{type:"Feature",id:"623025",properties:{name:"玛曲县",cp:[102.072698,33.997712],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@ADCBABCBAB@D@B@BAD@F@D@BBBBDBDBB@D@BADABAD@B@B@BBBDDBDBBBB@BAB@FABABCDAD@B@BBB@BAB@BADABADCF@D@BADABABABABE@EDC@GDCBABAFAD@BBD@DBFBB@BADBD@DBF@D@BAB@B@BABADADABCBE@C@CAC@@AACCAACEAA@CACBEBA@@BAHAB@D@H@FAFCDEHEDEBC@C@E@SGC@A@CDABCBA@C@CAC@C@ABABABAFABABCBC@GAKCGCGAECCCCCCCEACC@@@@C@CAA@C@I@C@C@AAKCC@AA@@@@AAC@CBEDABABC@AACCCCAC@C@CBEBE@C@C@C@CAAACCAAAAAECCAEACAC@GAGAEAE@GAEAC@C@C@A@CBABABA@CBA@EBEBIDEBE@CBC@A@ABCBABAAA@CCAAAAA@EDGFABC@E@EACAC@@@C@CBCBABABCBCDABA@@AAAAAACCAA@AAA@AB@DABABABA@CACAAAAACBE@A@A@EBC@AD@BCD@@CBA@C@A@EBA@C@A@CBG@CBCDCBCBC@AB@BADCDABCBCBCDCBC@CAA@AACCAAAACACAAAAACCAIE@C@E@CAC@CBA@E@C@EACACACAECCACCAA@A@A@ABC@AA@A@E@C@EBCBEDADA@@D@BBB@F@DBDDBDDDBB@BB@BAB@DABBBBBBBBB@@ABEBCBEDABABCBAB@B@D@D@D@FAF@D@D@HAD@D@DBDBBBDDDBDFBDDDBB@BBB@@B@D@D@DBB@BB@B@D@B@B@B@D@@BBD@FAF@DADADAD@DAD@FBDBFBDBFBFBDBDBD@D@@@DAD@B@BADAAABA@KHSNCFCBCDEFAB@B@DAB@BCDC@CB@@CBADCD@D@DBBBDFFBD@DADADEBI@E@EACBGBEFCBABA@A@C@EAC@E@CBC@ABAB@BBD@DADCBA@@BA@CAC@GCGAGAG@G@KBMBIDIBGBEBCBCBADAB@D@L@D@DABADABCBEBE@EACA@CA@ADADCFCFADCDABA@C@AACACAAAE@C@A@CAC@CACCCBCBAB@BCFADADADADAFADADABCBEDCDADABAB@DAHAH@DADADADABAB@BAD@DABABABA@CBA@EBABCBEDABABADADADCB@@A@A@CB@@AB@BBB@DA@ABC@ABC@CDEDCB@B@D@@@DBBBDDBBDBDDD@BA@ABA@A@ABCBCFCD@@@BCDEFADABCBAACAC@AB@BAFEHCFCD@D@B@DCBCD@F@D@BADC@A@ICE@EBCDCF@D@D@DAF@@AFBDBFBDCBEDCFGF@@ABAF@DBDBH@BBD@D@@EBGB@@EDCBCDCDECAAC@CHCF@F@DABEBCBAF@DBD@HCFCFGJCFGFIFEDCFAB@D@BCDADCD@BAFABCDE@E@ADABCBE@G@A@AD@FABCDABE@EBKFGFCBADAB@BBDDD@DBDBD@BDBDBF@BBDBDBDD@DADAD@BBB@B@@B@BBD@DBDDBD@F@DBDBBBFDD@BADED@D@BFBHBDDFDDFDDFHCBGFGHABAD@DBDFFFDBBDF@F@FAFADAD@D@BBDDB@@D@FDDBBBBDAFADBFBDBD@BBDDJBDBDDBH@F@B@FFBB@DDBF@JAH@DBDDDDDBJ@F@FBBDDD@F@BBBFBDDBDBD@DDB@@FDFDDBBHDF@F@D@FBDBDBBD@JBJAH@FAFAB@DBF@DBDBDADBDBD@FAD@DBHFFDDBB@@@@@FCHGFCHCJCHADAHBHFDBD@FEDADCFAD@DAHEFEBEBADCHCDAFABCBCBEBAFCFCB@FCFABABDBDDBH@HCDABABC@E@E@EBA@CFDHDDBF@JBB@D@FADCDADAB@B@HBJDFDDBDABA@@BADAJ@HBHBDBDBHCDAF@DBH@DABAB@BADD@DBDDBF@F@FADAHEFEHEFC@@B@B@BBB@D@B@BB@@B@BBB@@BB@@@BBB@BBBB@@BB@BBBBB@BBB@BB@BBBB@BBBB@B@@@@@@@@ABADAHAFABAB@H@D@D@B@B@B@B@B@D@D@D@DAD@DABABADA@ABA@AAA@AAA@A@ABA@A@AAAAAAAAA@A@A@A@AAA@A@@@AB@@A@@@AAA@@AA@A@ABABABADAD@DAD@B@B@B@BBDDBBBBB@@@B@BAD@BAB@BAB@JAFADA@ABA@AA@AAAAA@@A@A@ADCDCDCB@DAFADAD@BCB@BALBB@D@B@@@B@@ABCBABCB@B@BAHBF@D@DAFAD@B@D@FDDBB@D@BABABA@A@ACEEA@A@A@A@AB@B@D@D@DABC@A@AAA@AA@C@CB@@CA@AAABA@@BAFAB@FBHBFBDBB@HBDAFAD@D@B@B@DB@BB@B@D@BAB@@@D@D@BAB@D@FADA@@DADCDCDCDAF@FAH@L@HAB@BAFABABABCBABAF@H@H@H@J@D@DABC@@D@HCRAN@BAH@NAJAHAHAFCBCBC@AAEEG@EBCDCFAHAFBDBBDBBDBB@B@DABAD@D@B@DDFDBDB@@BB@BBDBBBHDFBJ@D@DABA@ABC@ABAB@DAHBDABA@ABAAE@C@CDCBAF@D@JB@@FBDBDDDBDBBADA@@B@@C@CBCB@@CDAHED@FCFABABAB@J@J@FAH@BBD@DAFABEBC@C@E@CBADAB@F@FCFAB@FADCBEDGDABA@AAECCGCAAAA@@DEBCDCBEBEJKPIJCFCB@DAB@HAD@B@B@BBHAHBBBBBBB@FBDBBBBD@D@B@DAHIFEFE@ABE@CAGACAEAE@C@ABCBAD@BADAD@B@B@DBBB@B@BAJABBDDFDBBBBADA@CBCAA@CAC@EBCBAHEB@B@@BB@@BBB@@BB@@BB@@BB@@@@D@D@B@@BB@@@@B@@@B@@@@BB@@@BB@@@@@B@@B@@@BB@@BB@@B@@@@@B@@B@@@@@@BB@@BDB@@@B@@@@@B@@@BBB@B@B@@@BBB@@@@@@@B@@@@@@@@@@@BB@@BB@@B@@@B@B@B@@@@BB@@@@@B@@@@@@@@B@@B@@@B@@@@@@@B@@@B@@@@@B@@@@@@@@@@@B@@@B@@B@@@B@BBB@@@B@DBB@B@B@B@B@@@D@B@@@BB@@@B@@B@@BBBD@@BD@BB@@BB@@B@@@B@@@B@@@B@@@@@B@@@B@@@B@@@BA@@BABA@@@@BA@AB@@AB@@A@@B@@BB@BBB@BB@@B@B@@BB@B@B@B@B@BB@@B@B@@AB@B@@@BA@@B@BB@@H@JBF@BABCDADAD@JDJHBDBB@BBDBDBFCDADCFADADB@@BD@D@F@PCHADA@A@A@C@CBCBABCBCBEBCBAFAF@D@FAF@BDDDDBFBDADAFCFAHAFCFCBCDAD@D@HCJAJEB@NCPEFAD@BDBD@F@HBH@HBDBDBF@D@FBDBD@FBDBFBD@DABCBC@ED@B@DBB@@BBB@BB@@B@@BB@@B@@@@B@@@B@D@B@@@B@B@@@B@@@@@B@@@@@B@B@B@B@B@B@B@BAB@B@B@BA@@B@@AB@BAB@BAB@@@BB@@DAD@FAF@@@B@BBDFB@D@@@D@@@@AB@@AD@DBD@B@B@BAB@DAB@@@BC@ABA@@@AB@BADCB@B@D@BAB@D@B@B@B@B@B@B@B@BAD@@@B@B@B@@@@@DB@@@@@@BABA@ABAB@BAF@@@BADAFEBADA@A@@@A@A@ABABABA@ABA@CBAB@BA@@B@F@BA@@@@D@FABA@@@@@A@A@A@A@A@C@A@C@CAE@@@A@@B@B@@ABBFBB@B@D@B@BADABADCDEDC@@BCBAB@FEB@@ABC@A@C@@BA@AHABAD@B@DAB@BC@@BA@@BB@@D@B@@@BA@@B@B@BADA@@F@B@B@B@BBBBD@@AB@DABAD@@@BA@ABAB@@ABA@@@A@@B@D@BAB@B@DADAD@DAB@DAD@D@B@BADEHCB@DCB@DABAFCBAB@BAD@B@DCDABAB@D@BADAB@BAB@BEB@@@B@@BB@@@@BAB@BA@ABABCFAB@B@@BBDBDBBDBB@@B@BAB@BB@@BDBBB@@@DC@@BABBB@FAJAB@B@DCDEBAB@DAF@B@@AB@@A@CAE@A@A@C@AA@@AA@A@A@A@A@@@@AAA@EB@@ABABA@A@A@@BADCDAFEDA@@BABADE@@DABABABA@AB@@A@AAAAA@@@A@C@A@A@A@@B@@C@@@A@@BA@@BC@@BA@@@@@AB@@CB@@@@A@EA@ICCEEKEG@EBCFEACE@E@EFABABCD@@@B@@B@BBD@B@BB@BBB@BADCFCDA@ABCAGCAA@ADM@AACEEA@CACBCBC@A@AA@A@AB@DAN@B@B@@AAA@@CAW@C@ABABIHA@A@AABAFG@A@AAA@@AAAACBGBA@@B@BBBB@BBDB@B@B@BA@A@GAC@E@CBCFEFABBBB@F@@B@@@BA@G@M@C@EB@@A@@A@A@C@CACECCAA@@@A@AB@BBBFDDDBDAD@BA@A@A@CAA@@@ABABEDA@A@A@AAGCGAIAEAEAA@AAAAAACAAA@CEG@A@ABCBABAFCBAD@BAD@BADABAHK@@DAB@@C@@DCB@B@F@BAB@DADAFGDABADA@@BAB@@@@A@E@ABCB@BADABADCBCBCDADABABABCBCB@BAFAB@BADEFEDABCDGDEFGHGBAFCBCBABABEBAB@DAH@DAD@FEFADCDABEDGDGDEBE@E@C@I@A@EBE@A@EAECECECCECGGCCEAMM@@CC@C@@@ABADEBAB@DADAD@D@H@JAB@F@VBD@HAD@F@B@J@D@DAJAHAHEFC@@@@AA@A@@@ABCBAHGFGDCFCFEBCBAAG@CAEECMEM@E@IAEAECIGCEAG@E@GBCDATGDADCD@DBFF@@FFBDJLBFFJFD@@DBFBB@LALMBE@CAC@AAAMKIMEICG@A@GAEGICG@E@EACAAAC@CBC@ADEDEDABCBEFEHGDCDEDGBIBEBI@C@C@C@G@CBCDCFEFAFA@@FAD@BAD@FAJ@H@JBD@LEHAHIBAFAFCLKHEHCJCJAJAN@J@FAJCFCBCDIBEDGBCDCFAHCHALCFCDC@A@IAIACCAEAA@G@GDKHE@C@EACCAA@ABEBA@CDGDENIDEBEBCAEEGEGCIAEBCDEJEDAFENGNGFEDC@C@AAACAMDCBCDADAFGFCDA@CBA@A@GCCAAC@CBCFCNCJCDA@C@C@AAAEAC@KFIDG@E@EACGEKAECCEAKAGACCA@EEAAACAA@@A@AAC@A@EAAAAAAA@C@C@CCACAAC@GAC@AAACAMBC@AB@BA@@DEFABABC@C@A@CAA@CA@CAABADEBCDC@EAEAACAA@EBIBCDIJELCDA@E@C@CAECAEGGICGAC@C@M@CBCBCDCJGJEDCBCBCAEAECECCAC@A@C@CBABCFEDEDABC@@AC@ACACAE@C@GBABEBC@CAAA@A@C@C@AAGCEACAEAGAAACECIAKEOAAGG@AAA@CAAACAECACCE@GACACAEAAACCECA@ECA@AAACAA@@A@AA@@@@@A@@@A@C@A@AB@@@@@@@@B@@B@@@@@B@@@@@@B@@@@@@@@B@@@B@@AB@@@B@@@@@@A@@@@@@@A@@@@@@B@@@@A@@@@B@@@A@@AB@@@@@@@@@@@B@@A@@@@@@B@BAB@B@BAB@B@@AB@B@AC@AACACASBCBCDC@AB@@EFCDECM@ADEBIBE@ACEBABEBEDM@EBA@@AECEBC@CBG@ACEGGACA@A@C@CCE@CBADEHCBC@CAC@CBABADC@I@A@KF@BCBIDCDCFKPAB@BBF@BADGNCD@@AB@D@B@@ABAAA@A@A@A@@BA@C@CBC@GBABCDA@@@AB@BA@ABA@E@ABAB@@@@DB@B@BABA@CB@@CB@@@B@@B@@D@@D@B@@B@D@@A@CAC@A@CFAA@@@A@A@@BAB@@AB@@@@AAA@@@A@AB@DABA@@@AA@@ACAA@A@@AAAA@A@AA@@@A@AB@@A@A@@AAA@A@A@A@AA@A@@@@BAB@@@B@B@B@@@B@@AA@@AA@@ABA@@B@B@B@@@@@BAA@@A@AA@@AB@@AB@BA@@@A@@@AA@A@A@A@A@@@@@@A@@BAB@DBB@@AB@@A@AAAA@A@C@CA@@AA@@BC@A@@@@CAACCAA@@A@AFC@GDA@A@@CAA@@ADC@A@AAAC@GEIAAA@AFAB@@ACA@A@CBAB@H@BA@AAAIAAA@ADC@A@AA@A@A@A@@A@@@ABA@CA@@AEDCA@A@ABC@C@C@@@A@@AA@@AAA@A@@@A@AB@@@B@@@B@@@@@BA@A@@@CAA@@@AACACC[Ek@U@A@WDSLGBEBKBMCGGQGKCECA@A@@@A@A@@BABABAAAAEAA@AAK@GBA@CC@AAA@GAIAECGEKACACACCCAA@@GEECCACAE@C@C@C@CAAA@AAA@AECECAAEACAACAA@CCGCA@CAA@A@A@ECGCAAEECCCA@@ACAGBADABCBCBI@EBE@EBCBCFCBA@MDCBABAB@DAB@FCL@BBBF@DBFBFDBBBDDLBD@F@H@DDF@BAH@FADBJBHDLAF@BADEFEHAD@DAD@DCD@B@D@B@BADABE@IDCBCBC@CBGFCDEDCBADCDAB@@A@A@ECCAAAAA@AAA@@@@@@@D@BABAB@B@BABCDCBABCDADEDA@EH@BAB@@BFBF@D@B@BAD@@A@CD@@BF@BBD@@B@B@B@B@HBFBFBB@B@BBBDBDBBBB@BB@F@B@D@@@B@@@DBHAB@@BHD@@@@BBAB@B@@@F@@B@BEBA@AB@HIBABABCB@BA@ABA@AB@@@BBBB@DBDBDBBBD@BBJ@DBBBBDBF@DBFDDBBBFBBBDBBBBDDHDHBB@@DBD@HAF@BBNBBBBB@D@DABCFABAD@B@B@BBDBHBD@D@FAD@B@D@DBDFHBFDB@B@D@D@D@NBFDJBDBDBBFFDBBBBBBBBBFBD@BB@@BBBB@BBD@DFDF@DBBBBHBF@DBDBB@D@BABCF@B@@DDBBBBDDBBDBBBBBB@D@B@DADA@BBBBBBBB@BBH@D@BD@BBDUBKBEDCDAB@DBFDJBFBJ@DADADCBCBE@KDGBCBABADCDCH@BAHAB@H@D@BHN@B@BA@CDEF@BAF@B@DBDBBDHDDBDBFBD@F@@BDABAFADAD@DADABABADABABOFE@CBCDAD@B@BDF@BBF@BABCDGBC@EBEBGDEDCBCBEBCD@@CDCHA@CDGF@C@B@AACAACCAAGC@@AA@A@E@AAAACACAC@ADE@A@ACC@A@@BADEB@@CAA@CACAA@A@E@@AAOAA@KEEA@@AAAC@@A@A@EDA@ABADCD@DCBCAI@E@C@A@CBABAAA@@AAAEECCCCEAAAACBCAEC@EAC@CAAA@A@C@C@C@A@C@CACCCACCCAC@A@CCEAA@AAAA@AAC@A@C@@AAA@AAAAAAAACA@A@A@C@ABA@ECC@CAC@C@CBE@EBGDCBCBABC@A@CAE@AAEAA@A@ABCBABA@CAAAAACAAAC@A@CBABC@CBCBI@KDE@C@AA@AAA@AACAAACGAEAE@E@@@ABA@@B@BAD@BC@A@ABCDADAD@DAD@FCF@DADAFAD@B@DBBBBBBBD@B@DABABCDABBBBDBD@B@DAB@D@BBFBD@BBBAD"],encodeOffsets:[[104058,34365]]}},{type:"Feature",id:"623026",properties:{name:"碌曲县",cp:[102.487327,34.590944],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@AAAC@AAEAAAAAACCAAAA@AAEAGAIAEAC@CAAACAAACACAG@CCAAAEAAACAAAAA@A@CAEAECECCACAC@EAM@C@E@AAA@AAAACAC@A@E@CAC@G@G@A@C@A@AAA@AA@@@ABC@A@A@AACBA@A@ADG@@@AD@BA@ABA@@AA@@A@A@AAACCAAC@EBA@CBCLABE@GCACAECEE@CBCFCDC@IAA@A@ABBBBBFBBDABEFC@AA@@AAACAGACA@G@I@AA@ABADCACAAABCD@F@BBDBLBFDBDAHAFABD@DE@CBAFCDADOBECAIEEECCACBA@@GCAEAABEHGFEJEDEA@F@B@@A@@DA@@B@@@@AB@@AD@@AB@@@B@@@DA@@@@B@B@B@D@B@@BBBB@B@BA@@BABABABCB@@CFABAB@@CBEFCBCDAB@@@B@BABAB@BA@@FBB@B@@B@B@B@B@B@@BB@@B@D@B@BBF@D@BA@@BA@E@CBA@ABCFCDA@A@IBEBA@AAAB@@CD@@A@AAAC@@AAA@ABA@@@AAACCACAAA@@@ABADEBABAB@@ABA@A@@A@@AA@@@A@AFA@ABA@CBABC@A@ABCBCDA@C@ABA@ABEDABCBA@CDA@GDCFABA@C@C@CBA@CBC@CBCBA@A@ABC@A@@@@B@@AB@BA@AB@BAB@@C@ABCBA@@BC@AAAAA@A@A@E@@@CBABA@A@@@AB@@A@C@@@AA@@AB@@ADA@CBA@C@ABGB@BAB@@@D@BAD@BA@EFA@ABAD@@CDCFCDABCBABA@C@A@A@EAAA@BA@A@@@@B@@BF@D@D@B@D@B@B@B@B@B@@@@ABEBC@@@@@ABE@A@@@ABA@AB@DAB@BABABAB@B@B@B@@@BCBABEFCBAB@@E@ABA@AB@BABAB@@@@@@CA@@@@A@A@A@@@C@ABA@A@A@A@A@A@A@C@A@ABC@A@A@CDABA@@B@@AB@BAD@@A@CBA@ABA@A@C@CAC@@BA@@B@@C@BLBBDH@BBFAF@DFHDBJ@LBD@@DELBH@DADCFEDABIHED@@A@@@BBA@@BBB@@@@@@BB@B@@@@@B@@B@DBHAH@B@BDBBB@BBBBB@DBB@D@B@D@D@FAB@F@B@BAB@D@B@DAB@D@B@BBBBBB@BBB@B@B@D@B@D@B@B@D@BADABABABABAB@B@B@BBDBBBB@@@BBBBD@BBD@B@BAD@BEDABBD@@@@@@@@@B@@@B@@@B@@@BAB@@@@BB@@@@@@B@B@@@@@@B@@@@@@@BA@@@@@@B@@BB@B@@@BB@BB@@@@@BB@@@@@B@@@@@@@@B@@@@@B@@@@@@B@@B@@@@@BA@@B@@@@@@BB@@@@B@@@DAH@JAL@D@DCDCHAD@DAFAD@D@D@BAD@FCFAFADADADAF@BABABADAD@DAF@D@B@@@FAD@DB@BBD@DBD@BABAD@D@D@D@DBDDBJFHFB@BDBBBBBBBBBB@BBB@F@B@DBD@DAB@B@B@@@F@BBB@DBBBBDBB@DB@BBB@@BBBB@BBBBH@BDF@BBB@D@BBB@DB@B@DD@@B@@A@@B@@A@@@@B@@AB@@A@@@@@@@A@@@@@A@@@@B@@@B@B@BA@@@AD@B@B@@@@@@AB@BB@@B@B@@A@@B@@@@AB@BB@@@@@@@@DA@B@AB@@@@@@@@@B@@@@@@A@@B@@@@A@@@@@@B@@@BA@@@@B@@@B@@A@AB@@@@B@@BC@@BB@A@@@B@@BA@@@@@BD@@@@@B@@@B@BA@@@B@@@@@@B@@@B@@@@@@@B@@@B@@@@B@@B@@@B@@@@@@@BA@@@B@@@AB@@B@@B@@@@B@@B@B@@B@@@AB@@B@@@@@@@@B@@@@@@B@@@AB@@@@@@@BB@@BB@@BA@@@@B@@@@@@B@@B@@@BB@@B@@@@@@BB@@BD@@BB@B@@@@@B@@B@@BBB@@BB@@@@@B@@B@BB@@@@@@@@@@@@@B@@@@@B@@@@B@@@@@B@@A@@@A@@@@@@@@B@@@@@B@@@@@@@@@B@@@@A@@@@@@B@@@@@@@@AA@@@@@@@@@@@BA@@@@@@@@@@BA@@@@@@@@@@BA@@@@@@B@@@@@B@@@@AB@@@@A@@@@@@@@BB@@B@@A@A@@@@B@BAB@B@BA@AB@@A@@@A@AB@@@@BB@@AB@@A@@BB@@@A@@@A@@@@@A@A@@@@B@@@B@@@@@@A@@@@A@@@@A@@B@@A@@@@B@BA@ADAB@@AAA@@@@@@B@@@@CB@@@AB@@@AA@@ABA@C@@@A@@@ABC@@B@B@@BB@@@@@@A@@@@BAB@@@@A@@@@BAB@B@@ABA@@@@@A@@A@@AB@B@@BB@@@B@@@@C@A@@BBB@@@@AB@@A@@@A@@@@@@@C@@B@@@@D@BBA@ABAB@@BB@@A@A@@B@@@BAB@BA@@@A@@@@D@@AB@@B@@BB@@BAB@@@B@@@B@@B@@@@@ABB@@@@B@@A@@@A@@@@B@@A@@@@@A@@BAB@B@@A@@B@@@BA@@@A@@@@B@@AB@@AB@@@@@B@@B@@@@B@@AB@B@@@@@@@@B@@A@@@@@@@B@@@B@@AB@@@@A@@BAB@B@@A@@@@@A@@@@@A@@B@@@@B@@B@@@@AB@@AB@@A@@@@@A@@@@@A@@@A@@@@B@@@@@@A@@B@@A@@@A@@@@@@@@B@@@@@BA@@@C@@@AB@@AB@@@B@@@BA@@BA@@@A@@AAB@@@@@@B@@B@@@@@B@@@@A@A@@B@@@@@BBB@@@@@@AB@@A@ABABA@@@@B@@AB@@@B@@@BBBB@@BBB@B@@@B@@ABABADAD@@@B@BD@BBBB@DADCBCFGPEHEFADARA@AAQC@@C@A@CAA@CDCFGDEDAHEHEFA@A@C@AAAAC@A@E@C@ABABADABABCBABA@A@ABC@@B@B@B@BABAB@BC@A@C@C@C@A@@@@BAD@FAFAJAF@@@BA@BB@@@B@B@@@BBD@BBB@@BB@@FFDD@B@B@B@@BD@B@BBB@B@@ABAB@D@B@BB@HDB@B@F@BABAB@D@FBB@P@@@B@BBB@BBBB@@FGDCBADAB@B@D@D@B@B@BADEB@D@FAB@F@HAFAB@DAB@B@B@@BB@@B@BB@B@BBBB@BB@B@F@B@B@BA@@B@DB@@BAB@D@B@DA@@B@B@@BB@@B@D@BB@DBB@D@DAF@F@BBB@B@@@@BBB@@@B@B@B@D@@BBBBDB@@BD@@@BBBB@D@B@BB@BB@@B@@@B@BBB@@HBDBBBB@BB@@BB@BAF@D@@@BBF@BBD@BBFB@@BFA@@BBB@B@D@B@HBB@BBDD@B@@@D@BB@FDBBD@DDB@HB@@B@FD@@D@@B@BBBDDB@@BB@@FB@BD@BB@B@DCB@B@@ABABC@@BAFAB@BAB@BB@@BB@BB@@BABBDBBB@@BBBBB@B@B@B@@@BFBB@BB@BBB@BB@B@B@BABAFCBAD@D@FAHCB@B@@B@@@BBB@@AB@@AB@@@@@B@@@@BBB@@@B@@BB@@@BBB@@@BBB@BB@@BBB@@B@@@@@@@@BB@@@@@@@B@B@B@@@@@@@B@@@B@@@D@B@@@BB@@BB@@B@@B@@BB@@BB@@BB@BB@@@B@@@B@D@D@@@BBBBBBB@B@@@B@B@@@B@@@B@@@B@@BB@@@@BB@@B@@BB@@B@B@@@B@@@B@@@@@B@B@BB@@BB@@@BB@@@@B@@@BB@B@@@@@@ABBB@@@B@@@BBB@@@B@@@@@BAB@@@@@BAB@@@BAB@@AB@@@B@@AB@@@@@BAB@@A@@DBH@BA@@BABA@A@@BA@@@@B@@@@@@@BA@@BA@A@A@A@A@A@A@A@A@@@AB@@AB@@@@AB@@AB@B@@A@A@@@A@@@A@ABA@A@A@A@@@A@@B@@A@@BA@@@@@A@A@ABA@@BA@A@A@AB@@@@@B@@@BB@@B@@@@@B@@@B@@@BBB@B@B@@@@CD@BA@ABA@ABA@@@AB@@A@@B@@AB@@@BA@@@@B@@@BA@@@@B@@@B@@@B@B@@@B@@A@ABA@@@A@@@A@AB@@@BA@@@AB@@@@A@AA@@A@@@AA@@A@A@@@A@A@A@AB@@@@A@@BABA@@BAB@@@BAB@@AB@@@@ABA@@@@B@@A@@@@B@@@@@B@B@@@@@BAD@D@@AB@@@@@@@@@B@BB@@B@@BBB@@@BB@@@@B@@B@@@B@@B@@B@@@B@@@B@@@B@B@B@@@BB@@B@@BB@@B@@@BB@@@@B@BB@@B@@BBB@@@@B@@B@@@@BB@@@@@B@@AB@BBB@@@@@BB@@B@@B@@BB@@@@BB@@BB@@@B@@@B@@@@@B@@AB@@AB@@AB@@@BAB@@@B@BA@@B@BAB@B@@@BA@BB@B@@@B@@BB@B@@@B@B@B@@@B@BABA@@@@B@@AB@@@BAB@@@B@@ABA@@@@@A@A@@@A@@B@@A@@B@@@D@@@B@B@B@B@B@@BB@BAB@B@B@B@@@B@B@B@B@B@BAB@@@B@B@@@B@B@BA@@@AB@@ABA@@@A@@B@@ABA@@@@B@B@B@@@D@@@B@B@B@@@@AB@BAB@@@BAB@B@B@B@@@BA@@B@@@B@B@@@B@@A@ABABAB@@AB@@@BA@@@AB@@A@C@C@A@ABA@A@AB@@ABADA@@BABCBCBCBA@A@@@AAA@@EG@A@A@@BA@ABEBA@A@A@ABC@@@AB@@@FAB@BABAB@@ABA@A@AB@@@BCDCDEBCFEBABA@@DADCDCB@BADAB@B@BAB@B@DBF@F@B@B@B@B@@AB@@AB@B@B@B@B@@@B@B@B@B@D@B@D@BAJA@@B@D@BAB@D@JCB@D@BB@@B@B@@BF@B@B@B@B@B@@@B@DAB@FABABAB@B@@A@A@@@A@A@AB@@@BAB@HAD@B@@@B@B@@B@@DD@@BBB@@BB@B@DBB@B@BA@@AA@ABCBA@@@A@AAC@@@A@A@@@A@@BA@@H@D@@@B@B@B@@@DD@@@@BB@@B@B@B@BBBA@@BAB@B@BDBBFDDBBBB@B@HAB@B@BBBBBB@@DAD@BC@A@@DAD@B@@@BAB@@ABEBAB@B@H@@@B@B@BB@@B@BA@@@E@A@ABCBAB@BA@@@A@AD@BE@ABABA@@@@@A@AAC@AAA@@BCBE@@CE@ABC@AAA@@ACAAB@FAB@FC@@B@B@DBD@DBFBB@B@@AFC@@J@BABABAFCDCB@BAFABAD@HBBA@ADC@@@@AA@A@A@A@@BCBA@AAA@@C@AAAAEEAAAA@AAC@C@C@ABA@@BADC@@@AAC@A@A@ABABA@AB@B@B@DBB@H@DBB@BBDBB@BCBABAB@H@B@@@FH@@B@B@DCBABAB@BAB@DCB@B@@@B@BBDBBBB@B@D@@@B@BAB@DAB@BAD@B@@@B@B@DBDDFBBBBB@BDF@@B@DBB@B@@@DF@@B@F@D@BADBB@B@BBB@@B@@CD@@AB@B@BABABADABABEFA@@B@B@BA@BD@BABADBB@B@D@@BB@BB@HDBBB@B@DBB@B@BBBBBB@@BBB@B@@@B@@@BA@ABA@@B@FE@@BA@@AC@CB@B@DAB@F@FAB@B@B@@@@B@@BB@@@B@@BB@B@@@B@@BB@@@@@B@@@BB@@B@@@@B@@@B@B@@@B@BA@@@@B@B@@@B@@AB@@@B@@@B@@@@@@@BB@@@F@@@BBB@B@B@@B@@BBBBB@@@@B@@BB@@@B@@@B@B@B@B@@@B@@@B@B@@@@AB@@AB@@AB@@@@AB@B@@@B@B@B@@@B@B@@@B@@@B@BBB@BAB@B@@@B@B@@@B@@@B@B@@BB@@B@B@@@@@BA@@@@@@BA@@@@B@B@@@B@@BB@B@B@@@@@B@@BB@@BB@B@@@B@B@@@@@B@@@B@@@BAB@B@BB@@B@B@BBB@@BBBBBBBBBBBB@@@@BB@@BB@B@@B@@B@B@@@@DBDBDBB@B@BAB@@CB@@C@A@A@ADEBEBC@ABC@ABEBCDA@AB@BA@ABG@A@CAA@@AABAB@@@H@D@F@LCB@B@DDB@N@B@@BB@@@@B@BABABAB@@AFAB@BA@ABKFGFEFGFAB@D@FBFBDDBF@BBF@BAB@BABA@@B@F@D@B@DAB@BBB@B@@@DCB@BBFBB@BABADEBC@@BAB@BBDBDFDBB@D@B@@@BBBDFBDDDBD@HAD@BC@IACAE@I@ADA@@BAFEDCBAFADAH@D@DCHEB@BAB@FBD@B@BAFEDG@A@ABC@@@A@AAAA@GAAA@@@C@KBCAEBC@@DEBA@AD@DAB@DAJAFA@@B@@@AA@C@@@A@@BC@@BAAACECC@A@A@ABCBA@C@A@@@AFC@@@A@CBA@C@EB@@@@AFADAHCFA@@DI@C@@EE@AA@@CCGAAGCCAC@AAA@MEAAAACC@AAACC@@AA@G@AA@@AA@A@C@@@A@AAAC@AAAECCEAAGA@@@A@A@ABAB@BAHABADABABA@@@A@CAA@A@AB@BABA@ABG@A@CBC@AAE@@AACAAACCA@ICA@AA@AA@BABAB@@A@@AACCAA@CGKAE@A@C@C@A@C@CACACECGCAA@@A@AB@B@@@BA@C@C@CAA@CACAAAC@A@C@C@AAAA@AEAA@C@C@C@@@CA@@GAOCC@AACCEAAA@AACACAAAAAAAAAAEACCCAAA@AAA@C@@AAA@CCCA@A@A@C@C@AACIIAEACAEEK@@@A@C@ABA@A@A@AAAAAAACEACAA@C@@AACCEC@ACCCCAA@G@AACAAAAAAC@AAC@C@MCAACAAA@AAA@E@E@G@A@AEGCAECAC@@@E@A@A@@@@@@DAFADAFALCHABAFCB@B@@@@@AA@AACCMAC@A@AA@BA@A@A@@CC@@@A@I@AAA@@@AAA@@F@BA@@DABAF@D@@@BC@A@CAA@AAACCA@@@@AJCA@@@ACCEAEACCCKCCA@GBIACACA@AKAECAE@IBM@M@_@QBCAAAAAACAC@C@AAAAAAAECGECGAAAAAAA@KAA@C@AAA@AA@A@@@@BG@A@A@A@CBCBA@AA@ABA@@@EBA@CAA@CAG@A@AACACAA@A@C@C@@A@AAA@@A@ABE@ABC@C@AAA@ACAA@A@A@AAC@AAAC@KAAAA@AA@ABA@ABCBCBC@C@E@@A@EDEBCB@@CCE@G@EBIAICGAGCICEGEGCECC@@BEHCJCDARIFAJANEF@JEDEHEDEHEFC@IBGBIDWBEEGGAGAABCDCDABCBE@A@AB@@ABA@ABCBA@A@A@@@A@IFONOBm@UCIAGQCBEBG@C@KEM@KAIAK@IBG@KAIGMCKCYCKEGAGBEDED@BEAAAABA@@B@F@BCBA@EBC@CBC@A@ABEBA@"],encodeOffsets:[[104897,34906]]}},{type:"Feature",id:"623027",properties:{name:"夏河县",cp:[102.521807,35.202503],childNum:1},geometry:{type:"Polygon",coordinates:["@@AC@AAE@A@@@CBE@AAA@@AAA@AACAGA@@AA@A@A@@@AA@@AAAA@C@A@AA@A@@AC@@CAAAAA@@@C@A@A@A@@AA@A@@A@A@AAE@E@CBC@A@CAA@@A@C@AA@@AA@A@@@CBA@C@A@AB@@CAA@@@ABA@A@E@A@A@@AAAAAA@A@@A@AA@@AA@A@A@CBA@EBGBE@A@EBC@A@CFABA@A@C@C@A@A@CBABCDEH@@AAAAA@AAA@@@O@A@EAC@A@ABABE@A@A@GCA@@A@A@CBABA@@@AAA@A@AAC@@@A@A@ACCEE@@AA@@AA@AAC@A@@@A@A@@AAB@@A@@BEBIBE@EBC@A@@C@A@CAC@A@A@CBABA@C@AAC@A@CBC@ABC@C@C@CACAA@A@ABA@CAAAA@CAA@AB@BC@G@E@MBGBC@A@S@E@A@@@@@EBGDEDE@EBEBE@@@EBEBAD@DBDBD@DCDGBGBEBABAFAFADADAD@D@FADABABE@E@EACBCBE@CBED@DAD@BAD@@ADAD@D@BC@ADADAD@DAD@FCFCD@FAD@DDDBB@D@BAFADADBF@J@FAF@DAD@D@D@B@B@D@B@B@B@@@B@@@@@BB@@@@@@BB@@@@@BB@@@@B@@B@@@B@@B@@@@B@@B@@B@@BB@@@@B@@@@B@@@@BB@@@B@@@@B@@B@@@BB@@@@B@@@@B@@B@@@@@B@@@B@B@@@@@B@@@@@BA@@@@BA@AB@@@@A@@BA@@BAB@@@@A@@B@@@BA@@@@B@B@@@@AB@@@@@B@@@@@B@B@@@@BB@@@@@@@@@BA@@@@BA@@@@BA@@B@@A@@B@@A@@@@@@@@B@@@@@B@@@@@B@@@B@@BB@@@B@@@@AB@@@@@@AB@@@@@@@B@@@BA@@@@@@B@@@B@@@@@BB@@B@@BB@@@B@@@@@@@B@@@@BB@@@B@@@@@B@B@@@B@@@B@@@@BB@@@@@@@BA@@B@@@B@@@@@B@@@@@@@BA@@B@@@@@B@B@@@@@B@@@@@B@@@B@BB@BB@F@B@J@D@DDDBF@D@DAD@DAHAF@FAD@FADBF@DBF@FBF@FBBBBF@B@BBBB@DAD@H@J@FBF@F@B@DABABADABAB@B@BDDFBFDBDBFAFCDA@GDED@@AB@D@DCBAFAF@F@DADBD@@BBF@FBD@BBB@BFDDDBDDDDF@HBF@DAD@DBD@DADAB@FBHFHDDDD@DAB@DBHDFFH@B@@AB@@@BA@@BA@@@@@@B@@@@@B@@@B@B@@@BB@@B@@@B@@B@@B@@@@@B@@@@@FAFAF@HBL@NCJCLELAJAHAHBF@RC@DABKNMJGLEFAFBHLLN`BHJBJDHFBH@FCHAB@@DBBBB@BBDBDDDBBDBB@BBB@DBBBBBBBB@BBBBBBD@B@DBBDBB@BBD@D@D@FBB@@B@D@B@B@BDBBBBBBBB@B@@BB@B@B@B@@@FBB@BB@B@B@B@D@DBBDDDBDBBBB@@@@AB@BABABCDCB@BBDBBBBBBBB@B@B@B@B@DADAF@@@BBBD@@@D@D@BBBBBFDFBDDDDBFBBBBD@B@D@B@D@B@F@B@D@D@D@FBFBDBFBB@B@FAFABBDB@D@@@B@D@B@BAB@D@B@DBBDDB@@DBB@D@BBBD@B@B@B@BAB@BABADAD@D@D@BA@AB@DADCD@D@B@B@DA@@FABBB@DBBBBBB@DBB@B@B@DAB@DBFDB@B@B@DBB@DBDBB@DDBBDBBBBBBDBBBBBBBBBB@BBB@D@F@B@D@B@B@DBDBBBDBB@@@B@B@DABBDBHBJ@BB@DBDBB@@B@BBD@B@BBB@BBBBD@B@B@BADAD@DBBBB@@BBD@BAD@D@B@B@BBB@BBF@DDFDDBDBFF@B@DBB@F@D@HBF@DBDBBDBBBB@BBDBD@DABADADADBB@FBBBD@BAB@BABAB@B@B@B@DBB@FBDBD@DDHDDBBBBBBBBDBBDBDBDB@B@B@D@DADAD@FAD@DAB@@CBA@@BCBABAB@BADCBCB@BGHIJ@NAD@FADABEBE@EDCF@FBDFFBD@FADAFBLBD@B@BABCBQHOLEDCDGDEFC@A@A@IGCAC@CBCDA@C@@@EBC@C@A@EBCDEDCB@@ABGBEBCDA@AB@B@B@BABABCBABCBA@CB@@AB@B@B@@@BB@@DBBBDDBBBB@DBBBD@F@DBD@BAD@FAJCD@@@BBBPH\\BH@F@BA@CDGD@@ABCBAB@B@B@F@D@DABAB@@ABA@C@EBEBE@EBA@ABABAB@B@B@B@DBD@D@BADABADABADBF@F@DDD@BDBBBBB@D@BAB@B@B@B@@BDD@BBBBBB@B@D@DAD@D@D@BDDB@DBB@DBDABBBBBB@BBBBBDBDBDBD@B@@@B@BAJGFAH@LBN@JAPCDCHARERCVEHCHCHCHAD@DB@@AJ@DBB@@B@@@B@@@@@B@B@B@B@@@@@B@@ABB@A@@B@B@BB@@B@@@BBB@BB@@BB@B@@@@@@@B@@B@@@@@B@@BB@@@@@@BB@BB@@BB@@B@B@B@@BB@@BA@@@B@@@B@@BB@B@@B@@@@@BB@B@@@BBBBB@@@@@B@@BB@BBDDB@@DFF@H@F@BA@A@E@A@AB@@@AABAB@@@A@@@@A@@B@B@B@@A@A@@B@B@@A@@B@BDLBD@DBBD@F@BB@B@DAD@DBBDD@@@@@@@@B@@@@@@@@@@A@@@@B@@@@A@@@@@@B@@@@@@@@@B@@@@@BA@@@@@@@@@@BA@@@@@AB@@@B@@@@@@@@BDBBFBJDHBNBJ@B@B@DCBCB@BBBB@FBDBHBDBB@BDB@B@B@BAB@@@DB@BFJDDDLBPDHBHBBFBBB@@@@@B@B@@@B@BAB@@@@@B@@BB@B@B@@AB@B@B@B@BB@@@@B@@@B@@@@@B@@@@@B@@@@@BB@@@@@@@@BA@@@@BAB@@@B@B@@@@B@@B@@@@@@@BA@@B@@@@B@@@@@@B@@B@B@D@FCHCD@D@BB@B@D@DILIFAB@B@BDBDBF@BCHGFCDA@@B@F@B@HBB@D@@A@C@A@CBAB@D@FA@@DADCB@BIFEFCDAB@B@BB@DB@@@B@B@FC@@DCDCBABCDEBABADAFAH@BAB@B@@@@@HEBAD@D@DAFAF@FBD@FAF@BAF@B@D@BBBBD@DBD@BAF@D@D@B@@@AGBABAB@RANARAXAB@LAH@FBD@FALAJGNIBAJIB@JEHCFCFEHCFCD@FCBEB@@@BAB@A@BABCBCBCDEBADAFAFAB@BAFA@@BA@@BC@AB@B@B@B@B@BBB@@A@@BCB@BAFAH@HCFABBB@D@F@B@DAFAHAH@DAD@DB@AD@BAD@B@B@BCFGDEBCDCBA@@@A@CBEBC@@@CB@@@DAF@BABABAFB@@BAB@BA@A@AB@@CAA@@AA@A@@@CB@JABA@@BAHCBCBA@@@C@ADA@A@A@@BA@@BBF@DA@@BAB@@A@@FAFAB@@@B@DADAD@@AB@B@BBHNBB@@B@@@B@BBDBBBDDBB@BB@@BDDBBB@BBBBBB@@@B@@B@B@B@D@BABAF@D@B@B@BCDAB@BAB@F@BA@@@@BCBEBA@ADCDCBAB@B@FADABABCDC@@@A@A@A@EDABE@AFCBC@ABC@C@@@A@ACE@GAC@C@A@A@ABABABA@A@EBA@C@AAC@AAACCAC@A@ABA@A@A@AAA@A@C@CBA@@@AAAAC@AAA@A@A@C@AACAA@@DIBAFC@@AA@@AEACGIAA@@AA@@@A@ABC@AFCBA@A@@A@AA@AACAAAA@A@@@CBE@A@ABEBI@ADE@ABA@IBADADA@AB@B@B@@@B@DEBAFADC@@BA@BFC@ADC@A@E@E@A@AAECCAC@CAE@C@C@A@A@@BADCBA@@@A@C@ACC@AAACAAAAAAAAEAAAA@ACECAGGCAAAA@A@@AA@@AAGAC@AA@CGAC@AAAAAAACAAAA@A@E@A@AA@AAA@@CAC@C@C@E@C@@@AA@@B@BABA@@BA@AA@@@CAEAC@CACA@@CECA@@CAA@AA@AACAA@AA@CAA@@AC@A@E@I@G@E@C@EDA@A@A@@A@A@A@@A@AAA@@@@@AA@AA@AA@AA@A@A@@AAAAACA@AA@AA@CCACABABA@ABC@ADC@A@@@CAA@@BA@@BAB@DABABCBA@A@CAGAA@ACA@@AAAACCA@@@BC@M@A@A@ADABCFCFGFCFCDCBC@ABA@A@A@AAAA@CAA@A@@A@@@@@@FAB@BA@@@@@ABA@@@A@A@@@@A@A@A@@@A@@@A@@A@@@@B@B@B@D@@AF@B@BAB@@A@AAACCA@A@@A@AA@CAAACCCAGEAAACAAAC@AA@AAEAA@AAAA@AA@@@E@CAC@AA@A@ABIBC@A@AAA@ACEAAAA@A@A@A@A@@BA@AD@DBDAB@B@BAB@BC@ABA@C@AAE@A@AAAAC@@@A@@@ABAB@@A@A@A@A@@A@C@C@CAGAA@M@C@ICC@GAEAC@E@C@A@A@AAIECACAACAA@C@G@C@ABA@CBAHE@A@@@A@C@C@C@C@C@A@AAAEAAAAA@C@@@C@CBA@A@@DABAB@DAB@D@D@D@D@DAB@DADA@ABA@@DADA@@FEB@BCBABE@ABAB@@ABA@A@A@A@@B@DAF@JBB@BAD@BADAD@B@D@B@B@F@BBDAB@D@DABA@ABA@A@A@@B@B@H@DBD@F@BBB@D@B@BAD@DED@BADABA@@@A@A@@ACAAAKAABG@@BC@ABABA@@DABAFA@@F@F@D@D@D@BAB@B@FBD@P@B@@@BA@A@EBC@ADABCBA@A@A@AAACEEKEG@CAA@A@ABC@A@EBC@A@CBA@CDEDCBA@A@AAE@C@A@CBEBE@C@CBCBC@C@A@ACACCAA@@BCBA@C@CACCGCCAAAACAEAECGCEACCAA@ACGAI@CAE@A@A@AAA@C@@@A@AAA@@BAAA@AA@@ACCACAA@ACA@@AACAAAAC@@AAAAAA@@@AAA@A@ACCEAACAA@@A@C@A@CACECAAAA@AB@@ADCFABABA@EAAAA@CD@@A@A@AAA@CBA@C@E@A@@@ABABA@ABE@AAE@CAACAE@E@CBAHEFEHELEBAB@@ABABE@@BABABA@A@A@@A@@AA@M@A@CCA@A@KDE@C@G@@@A@ABBB@@BB@D@BAH@BABA@@BCBADAF@BAD@BADAFCF@B@B@B@DA@@DA@ABA@A@CACACA@@@@@A@AA@@@@AAA@@AA@@@@AAAAAAAAAAAA@@AA@A@A@AA@@A@ABA@A@@@A@@@A@@@@@A@A@@@AAA@@AA@@@A@@@@@A@AAA@@@A@@@A@A@@B@@A@@@@B@@A@@@@@A@AA@@AA@A@@@A@@@A@A@@@A@A@ABA@AAA@@@A@@@A@A@@@A@A@A@@@A@A@@B@@A@@BA@@BA@@B@@A@A@@@A@@@A@A@A@A@@@A@@@A@@AA@@@@@AAAA@AA@@@@A@AAA@A@@@E@@AA@@@@@@A@@@A@@@A@@BA@@@A@A@@@@@ABA@@@A@A@@@A@@@@@@AA@@A@@@A@@@@AA@@@A@@@AAA@@@A@@AA@@@A@@A@A@A@EBE@A@CBA@A@@DBD@@AB@@EFA@@@AB@BAB@@A@@@A@A@AA@@AAAAAAA@A@CAA@A@AAGCA@@AAA@@@C@AAABCBA@AACB@@A@A@AB@FEBABABCBABA@A@ABA@@DC@@@AA@AAA@A@CAABC@E@A@@@CE@@A@A@CAA@@@CE@AAAAAEACCCAA@A@@@A@C@ABA@CBA@ABA@@@C@A@A@AACAAAA@@@A@A@CDA@ABA@ABABCDA@A@@@EG@@A@G@A@ABABADA@CAAAA@CAG@A@CAA@A@A@@BABAB@B@B@BBD@B@@CDAB@@AB@B@D@DBD@BBBBBFFBBBBD@@@BB@BABAD@@@B@B@BBB@@@@CD@BABGAC@ABEBABA@CDEDABABABI@@@ED@BA@A@EACAC@CAA@A@@@EDA@EBA@BBBD@@BB@BAD@BDF@@AFAD@@BB@BBD@B@B@@@@ABAB@BAFC@@B@B@@ABA@ABAD@B@B@F@@ABA@@@AAA@A@@@G@A@A@ABAF@BA@AB@@A@C@CB@@@BADC@CB@@AAAAAAA@A@GBA@A@AACAECAAACA@A@AB@@ABAAA@A@A@@@AA@@@@CC@@A@A@A@@@C@G@@@AB@@@B@@@B@B@@BD@B@B@@ABAD@BBB@@ABA@A@CAA@A@@AA@AA@@CC@@@AA@A@@@A@C@GBA@AB@@A@@B@B@B@@@B@BA@A@ABABEBA@CBA@@@A@A@A@A@A@E@@AA@A@@@AAC@A@IDC@A@ABC@A@@@IBABC@A@C@A@A@A@A@@@A@A@A@A@A@@BA@@BA@A@A@A@E@E@CAA@A@ABA@A@CBABA@CDCDCB@@ABABEFADCFCDAD@@A@@B@BAB@BA@ABABA@EB@@A@@B@@AD@B@B@BABAF@BAB@@@B@BFH@@BB@B@@@BABADADADAB@@CBAB@BA@@B@BAB@B@B@D@D@BA@@B@@AB@@A@@BA@ABAB@B@BA@@@A@A@@@A@@@AB@@A@A@A@A@AB@@A@ABA@@B@@A@A@A@@@C@@@A@A@A@@@@@AB@BA@@@@B@@AB@BA@@B@@ABA@A@@@A@A@@@A@ABA@A@A@A@A@@@A@A@A@A@ABA@@AA@A@A@A@A@@@C@@@A@@@@BA@@@@B@@@B@B@@@@AB@BA@@@A@AB@@A@@BA@@@@@ABABA@@@A@A@A@@@A@A@@AA@@@A@A@@AAB@@A@A@ABA@@@ABA@@@A@AB@@A@@BA@@BA@@BA@@@@@A@@@A@@@A@@AA@@A@@A@@AA@@@@AA@@A@@@@AA@ABA@@@A@@@@AA@@@@@AA@@@@@AA@AA@@@AAA@@@@@AA@@A@@@AA@@@AA@@A@@@A@A@A@@@A@@@A@@@AA@@@@A@@@AA@@@@@AA@@A@AA@@@AA@@A@A@@@@@@@@BA@@@CBC@A@@@@@A@A@@@@@A@@B@@@@A@@B@BA@@@@BA@@BA@A@@BA@AB@BA@AB@@@@@BAB@B@B@@@B@B@@@BB@@B@@@BBB@@@@@BA@@B@@A@@BAB@@@B@@@B@BAB@@@@A@@@A@A@@@A@@@A@@B@@A@@@A@@B@@A@@BA@@@AB@@@BA@@B@BAB@BAB@@ADC@@@@@A@AAA@A@@@A@@@A@@@@@AA@@A@@@A@@@@BAB@B@B@@AB@BAB@B@@@@@B@@AB@@@@AB@@@B@B@B@B@BAB@@@B@@@B@B@@@@ABA@@BA@@@@BA@@BA@@B@B@B@B@B@B@B@B@B@@AB@@A@@@@@@@A@@B@@AB@B@BA@AB@@AAG@CB@@@BA@A@@@@BA@@@A@@BA@@BA@A@@BA@A@@@@BA@A@@@@@A@@AA@A@@@A@@AABA@@@@@@@AAA@@A@@@@@AA@@A@@AA@@A@A@A@@@@@A@@@A@@@A@AA@@AA@@@AA@@@@AA@@@A@@@A@@@A@@@A@A@@@AAAAAAA@A@@@C@C@A@@@A@@AAA@@AA@@AA@@AA@@@@AA@@AA@@A@@@A@C@@@A@@@A@@@@@@@A@A@A@@@@@@AA@@@@@@@@@AA@AA@@AAA@AA@@A@AA@@A@@AA@@@A@AA@@@@@A@@@@BA@@BA@@AA@A@@@AA@A@GDEBC@C@ABEDABABA@A@A@@AAA@AAAA@EA@A@@@A@A@AAAAA@AA@AAACBA@AA@@AAA@@AAA@ABA@EBAB@@ADAB@BA@A@CDA@A@@AACA@@EA@@AA@CCAA@A@AC@@@ECA@@@GAA@CCC@AAECA@@A@C@@@ACCAAA@GAA@C@A@A@AA@@EB@AA@AE@A"],encodeOffsets:[[104680,35449]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
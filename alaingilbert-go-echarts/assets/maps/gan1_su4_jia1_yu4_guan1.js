@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("嘉峪关",{type:"FeatureCollection",features:[{type:"Feature",id:"620200",properties:{name:"嘉峪关市",cp:[98.289419,39.772554],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@HEDADA@ABABA@@@C@C@G@@@ABA@A@C@@@@B@D@BB@A@@BA@I@A@AAAAAAACAAAEEAA@AC@AAA@@AA@AAEECCAAAAAA@AACAC@E@@@A@@AE@ACE@AAC@AAC@AAACEACAAEAGEAAAAABADCDCDAB@BA@C@AAEBC@CBA@@@CBA@IDG@@BGA@B@@A@A@@@AAA@AACA@@C@A@@@A@@@A@AB@@CBA@@BC@CBA@ABABCBEBEBABABA@EFEDABABCB@@A@@BADCBCBA@@BC@@@ABABA@ABABCAC@CAA@ODEBA@@BABAD@DAD@@ABKBCB@@CBC@A@A@A@AB@@@BBBB@@BBF@BBBDDBDBBAB@@A@A@C@CCC@CBWLIDGBM@M@MAI@OCIAECCAMMEGEECE@AAA@EAE@@@@G@wBS@c@ÁB@AemWSIIJGFCDAFQVCDCDAB@B@BCDCBEBEBEBC@K@A@C@EDC@ABEAC@C@KDSDGBA@GFCBO@[@C@AB@@DB^RBBFDHDDBBBTLB@DDJDBBBBHDB@NHBBHFB@RLJFJDDDLFPJBBJFHDLFDDDB@@BBBDBDFHBBFLDD@BBBBDBBBDDFBBDBBBHDJDFBDBB@BBBBFBFDFBJDHDBBB@BB@@@@GHADuxORCFMPAB@@@@@BBB@@@BFDDF@@@@B@BDFDJDBBHDDB@@B@@BBB@@BBDBBBHDB@B@DBBBB@DBDBF@@@DDDBFB@@B@@B@@@@AB@@C@AB@@A@@@A@@@@@ABA@ABC@A@CB@@A@ABA@A@CAEBA@@@@@@@@@@@@@A@A@A@@@A@@BA@@@A@A@@@@@A@ABA@A@BBD@BBDBB@@B@@@BC@@@@B@@@B@@BBDBB@B@B@B@B@B@B@@@BB@@@DBBB@@ABB@@B@@@BB@@A@@B@B@B@B@B@@B@@@@B@B@@BB@B@@B@B@DBB@DADBB@B@DBDBB@D@@@BA@@B@DBB@BD@BB@BBD@FBB@B@DA@@B@BB@@BAB@@@BBD@BBB@B@BB@@@@@BA@AB@@@BAB@B@B@B@BAD@@@@@B@@@BA@@B@@BB@BB@B@@B@B@BA@@BBBBDB@@@@B@D@BABAB@DABADADABCDCBAB@B@@@B@BDBDBD@JChINCDAJAFAPETCBAFAD@PCdILCFAZAL@ZAJ@XATAN@LAH@H@D@F@B@FAB@L@RApAPAD@B@D@DB@A@@@@AA@A@A@@@@@AB@@@B@FBJAD@F@B@B@@@@@B@@@B@@AD@BAF@BA@@F@JBJ@J@DBF@D@DAD@BBD@D@B@B@@@@B@@@B@@@@@@B@@@DADAF@BA@BF@BBB@BBDBD@HBDBDBBBBBB@@@BBB@BAFAB@@@D@D@FAB@B@@ADCBAB@HABABAB@BABAB@F@N@J@H@F@BBD@DDDBD@HFJDHBBBB@@B@B@D@@BBF@H@@AB@JGBADE@AFCHEDCDCD@@AD@@A@A@@CA@AA@@ABA@ABCBC@A@A@AAA@AA@@AACAC@A@A@A@ABC@A@EBA@@BC@@@CB@DAB@B@D@HAHA@@@AB@BAB@D@B@BAB@B@@A@@CICEACAA@@JI@@@@KIMKEEEEECIICA@@A@@A@AA@AAAAA@CA@@@@FC@@@A@@@AA@AAAABA@@@AB@BAB@@AB@@AAABABCBADE@A@@@C@@AAAAAAGECACAA@OA@ACA@@A@A@@@AA@AA@@@CAAACACAAA@@A@AAA@EACCA@@@A@EAAAOGE@ECA@CAAAA@UIMECAOEA@GCICUI@@KEEA@@A@@@AAYI@A@@@AA@CA@AA@@ABABG@@BA@ABG@CDABEBCBA@@FCDABADA@AB@DABAD@DCD@DAVGB@BAJE"],["@@HDFBHBB@@@B@@@CAAAAAAAA@@AAC@@ABID@@@@"]],encodeOffsets:[[[100760,40692]],[[100197,40667]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
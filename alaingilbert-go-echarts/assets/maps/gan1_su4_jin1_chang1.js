@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("金昌",{type:"FeatureCollection",features:[{type:"Feature",id:"620302",properties:{name:"金川区",cp:[102.194015,38.521087],childNum:1},geometry:{type:"Polygon",coordinates:["@@sEaCI@]C[uKDGBIDIFGDCDEDCDGFAC@A@A@@AAA@A@C@@@A@CB@@A@A@AAA@EEA@CA@AC@A@AF@DABABA@EFA@EDEBEBCBGDABGDEDA@EBIBCBKBQHCBGKMDC@ABYHCC@@@A@A@C@@AA@@A@A@ABCDEBEDEBGB_@yAED@@GBA@WHCFifzABABEDKHIFEDCDA@@@A@@@@B@@@B@B@@ADABAB@BABA@@@@@@B@BA@ABA@ABA@CBA@QAKEIFABGDCBIFOHUZEFMnYG@AAC@AACAACEA@AACAGwGG@@IDABgJE@BJBF@BAD@BABABABA@AB@BA@AD@@ABBDA@SbCdKT±hMIKGOKIEAa@AAA@@AAA@EAE@K@E@C@ABED@@JbBVJl[JMED_BMIGgCQT@@J\\@@BB@@BBB@@B@BNP@@A@@BCBABA@E@ABC@C@E@E@A@eaQMA@AAA@@AC@@AEECAEDAB@BCJCHADCBGFMJCBCBCBSBA@A@ABKNCBCBOFGBEBKDQFGBCBMFmVA@DBLLVXFDL@BBB@BD@BB@BD@@BB@@@@BB@@RNFDFBFDFBBBDD@DDDBBBBDBBBDDBBDBDFB@FBB@BBB@B@B@DAB@DAB@D@DDBBB@BBBB@@B@@@D@H@D@HCJCD@DA@@NC^EFAFAJC@@FCDADBFDHBDBF@HALEHCLGJEHGBADAB@PJZRTPVRTPVPVNLLHDTRJHÌÚ°BFDDDDDBLHÆED@@uXEFA@KJQJEB@@@@FBL@NARAK^CVABArCbAdCB@@EJc@@LCeB@öefGRCRC@@BHNxHALE\\I|U°ilOJCLCEG@@EG@@BA\\M@@BMNyJwHYA@UQ_UKwAO@CE[@GCUQ¡@ABAB@VOBAB@ECAA@@@@BAFCB@B@DA@@FEDABCB@@@B@D@DBB@@@@CB@@AZSBCB@@@JGBBA@BBBADC@AB@@A@CBADCBAB@@ABEBMA@@C@GBE@E@CBA@AB@BAFADAJCDADAHCB@BA@AJKBCDIBEBEBC@CBABG@EBEBCB@FE@@DCBADABCDC\\m^I@A@OaYB@@AEE§QDO@SH[D_DO}MAMAeEUC±K"],encodeOffsets:[[104869,39305]]}},{type:"Feature",id:"620321",properties:{name:"永昌县",cp:[101.984458,38.243434],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@AB@@@B@B@BAB@BA@@@A@@CC@@@AA@AAC@CAABC@EBA@A@AB@@BBABABAB@B@@B@@BB@B@B@@@DBB@BB@@D@@@BABAB@@@"],["@@CACAAAA@C@G@ECA@IACAA@AAACA@@CAE@IAA@CAA@AEECEAACAAA@A@A@AAA@AAEAC@A@ABA@EAIACCCACA@AAA@A@AAA@A@AA@B@@@@A@A@@@@A@AA@@@C@AD@@@@A@@@AA@@A@@A@@A@AAAA@@A@@B@@A@ADAB@@@B@@CDA@AB@AA@@AA@AA@@@A@@@@AAABA@@B@@@@A@@@@A@@AA@@@@DA@@BAAC@@CA@@@@ECA@ABA@@B@D@@AB@@ABA@@BC@@@A@@B@B@B@@AB@@A@@@A@A@CA@@A@@A@@@@@A@@@@CA@@AAAAAAAAAA@@A@@@A@A@C@A@E@CAA@@@@@AA@CCAAACA@AAA@@CAA@CBA@AB@@A@A@@@A@A@@@@@AA@@CA@@@AA@C@A@@@A@@@@@A@@@@AAA@@EBA@@BA@@@@@AAAA@@A@A@A@@@@@AA@A@@@@C@AA@@@@@@AA@A@@A@C@@@CAAACAAA@@BABA@@ACA@CAAAA@@AA@A@@@AB@@A@E@@@@B@@DBD@B@BB@@@@A@A@A@ADA@@@@@A@A@@@ABA@@@A@AAAAA@@@AAA@@@A@ABA@@@A@@@A@@@A@AA@@AAAAA@@A@@@A@AAA@@@@A@@@AB@@AAABC@A@@@@@A@@@@@AB@@ABAAA@A@@@@@AAA@@@@BA@ABA@ABABCBAB@@@DAB@@A@@B@BABA@ADABCBABCDA@@B@B@@@D@B@B@BBB@D@@A@@B@B@@BD@DA@@@@BA@@@@BA@@DC@ABA@@B@DA@@@A@@@@BAFAB@@@@@D@B@BB@@B@B@@AB@@BDAB@B@@A@A@EBA@@@AB@@@DCBAB@@@@A@@@C@A@@@ABAB@BA@@@CBA@C@GB@@ABA@@@AB@@AAEBA@AACAA@@AA@@A@AA@@C@@@@AA@A@@CA@@@@@A@@B@@@@AA@@AA@A@GBA@A@A@AAA@@A@C@@A@@@A@ABA@@@@@@@AAA@@AA@@@A@ABEAE@@@ABAB@@@@AAAAC@A@CB@@@BEDA@@@CBC@A@A@ABEBABA@C@CBC@A@@@CDABAB@B@DA@@BA@A@C@A@A@@@A@AB@@C@@@A@@@ABG@A@@@A@@B@@@B@@A@A@ABA@AAA@A@@DA@AB@@@@E@A@@AAAA@@AA@A@C@A@A@CAC@@@ABA@A@@@@@A@AA@AA@@@E@A@A@@@ABCBA@A@A@@@@AAA@AA@A@AAA@A@AAA@@A@@AA@@AA@@A@A@@A@@@@AAA@@@ACAA@A@@@AAA@@AABA@@@AA@AAAEA@AA@A@@@AA@E@@@A@@CAACAAACCGC@@CAA@A@C@C@C@@@AA@@A@@@CD@@A@AAA@A@A@@BCBA@@DA@@BA@@@E@@@CBABC@A@EB@@C@CB@@AB@@B@@B@B@@A@@B@AA@C@ABC@ABA@C@EBA@ABABABAD@@@B@B@BA@@@CB@@ABA@A@AAA@CBA@A@C@CAA@C@ABAB@BAB@F@B@@A@@@A@AACAC@A@AAAA@@G@@@CAAAA@@@E@A@A@CBI@AB@AA@A@CAA@AA@AAAAACAAAAA@@AC@@AAGI@A@@@ABA@@@AA@AA@@@C@A@A@@@@CDABABABCBABA@E@CBA@CDABA@@BAB@BCBAB@@@@ABCBA@CBABA@C@CBC@ABED@@@@CCAACACAC@A@AA@AA@@ABA@C@A@A@AAA@@@ACCA@@A@A@A@@CCCAA@AAACAAA@AA@@ABA@@BA@@B@@ABA@C@C@ABA@@@C@AAA@AA@@EAAA@@ACAAA@C@C@A@@@A@@@E@A@EAA@EAE@A@A@A@AAA@KB@@CBA@A@G@A@ABABABC@EBA@C@A@CBA@CBA@@AA@A@A@A@C@AAA@A@AB@@@@AB@B@@I@@BA@CBA@@@CACAAACAA@EAA@C@@BAB@@@BA@CB@@@B@@@BA@@@A@A@C@A@ADI@ADADIFABCBCBA@@BADCD@BA@A@A@AAA@AAA@ABA@ABC@A@A@A@ABCBA@ABA@@B@@@B@BADCBADADEFEDAB@@@L@H@B@@C@G@ED@@A@@@A@A@@@A@@AA@@@A@A@A@@BA@A@AB@@A@@@AB@@A@@@@@A@@@A@A@CB@@A@AAEBEDCBCDA@CB@@AB@B@@@@@B@@@@@BB@@BB@@BA@@BABA@BB@@@D@@BB@@AB@@@B@@@BDD@@@BBB@BDB@@@B@@@@ADA@@B@@B@BDB@@B@@@BA@@@@B@@@@@BBBB@@@BB@@@BBB@BB@@@DBB@@B@@B@DBBB@BBB@@B@F@@@B@@BB@@@AB@@DBDDHHBBFDFFJFLLJFBFFDHFBFFNBDHPHHJJBF@DABQLMDCBKTIDSNA@OFMFQHGDED@B@JDD@@@@AB@@A@@@A@A@A@@@A@C@@@C@E@KBABAL@ZEL@@@BCF@F@B@DBI@C@@BAFCHAJ@@@F@@AD@@@D@B@B@@B@@B@B@@@@@B@@@B@@@B@B@@@@@B@D@@@B@@@B@@@@AB@@@@@B@@@@@B@@@D@B@@@B@@AB@B@@@@A@@B@@ABA@@BABAB@@@@@@@BD@@B@@@@@ABB@@B@FBHAFCDABAF@B@@@D@@@BBBB@@@@BBB@D@FBF@BB@B@@B@@B@@@B@@@@ADAB@@@@@B@@AB@BAD@@A@AD@@@B@@@@@BB@@@@@B@B@@@BB@@@@BB@@@BBB@B@@@@@@@@@@D@@@BA@@@BB@B@@@BA@@B@@@B@B@@@@@F@@@B@B@@@DB@@@BB@@@@@D@@@@@@@@@B@@@B@@AB@@@@@B@@@BA@@@@@@B@@@@@BB@@@@B@B@DBBB@@B@D@@@@@D@@@B@BB@@D@B@@B@@@@BB@@@B@@@@BBBB@@@@@B@@@B@@@@@@@@@@F@@@@@D@@@DA@@DC@@@@BA@@@@F@D@@@B@@@B@@@@@@@@@B@BA@@BA@@B@@@B@@AB@B@@@@AD@@@BA@@B@B@BA@@B@B@@@@@@@B@@@D@@@@@@@@@BAD@@@@@@@B@@@B@@@@@@B@@@D@@@@BB@B@@D@@@B@@@B@B@@@DBF@@@DA@@B@@@F@@@@@@BB@BBDD@@BB@@@@@@@@BB@DB@@B@B@@@B@@@@@@BB@@@@BB@@DB@BB@@BB@@B@@@@@@@BB@@BB@@B@@BB@@@B@@B@@B@B@@BB@@@@FDDBDDRLB@BBFDFDDDB@BDD@@BB@@BDFDFBDDFBB@BBB@@BDBD@@B@@@@B@@@@BB@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@B@B@@@@@@@@@@@BA@@@@@@@@@A@@@@@@@A@@@@@@@@@@@AB@@@@@@@@A@@B@@@@AAAB@A@@@@@@A@@@A@@@@@@@@@AA@@A@AA@@@@@@A@@@@@A@@@AB@@@@A@@@@BA@@@@@A@@@A@A@@@@@A@@B@@@AA@@@@@A@@@@@@@A@@@A@@@@B@@@@@@@@@BB@@@@@BB@@@B@@@@@@@@@B@@@@@@@@@@@@@BA@AB@@@@@@@@@@@@@@@B@B@@@@@@@@@@@@@@@B@@A@@B@@@@@@@@@@@B@@B@@B@@@@@B@@B@@@@@@BA@@@@@@BA@@B@@@@A@@@@@A@@@@B@@AB@@@B@B@@@B@B@@@@@B@@@@@@@B@@@@@@BB@@@@@@@BB@@@@BA@@@@B@@@@B@@B@@@@@@@BB@@@BB@@B@@@@@BB@@@@B@@@@@BAB@@@B@@@@@@BB@@@@@@@B@@@@@B@@AB@@@B@@@@@@A@@@@@@@A@@@@@@@@@A@@@@B@@@@AB@@@@@B@@@@A@@@@@@@@@@B@@@@@@A@@@@@@BA@@@@@@B@@@@@@@@BB@@@@@@@B@@@B@@@@@B@@A@@BB@@@@@@@@BB@@@@@@@@@@@@B@@@@@BA@@@BB@@A@@B@B@@@B@@@@@@@B@@@B@@@@@@@@@B@@@@@@BB@@@B@@@B@@@@@BA@@@@@B@@@@@@B@@BD@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@@B@@@@@BB@@BB@@@@@DAB@@@@AB@B@@@B@@@BAB@@ABB@@@A@@BBB@@@B@@@@@B@@@B@B@@@B@@@@@@@B@@@B@@A@@B@@@@@B@B@@A@@BAB@B@@A@@@@@@B@BA@@@@@@D@@@BA@@B@@BB@@@D@@CFAB@@AB@@@@AB@BABB@@@AB@@@@ABA@@B@BABA@@DY\\A@BBB@dLFBJFfVDBBBANCVE`NNRR@@B@nUNEDAHARELCFAHAPEDADALMBAB@B@TADADADANIHEDABCDGDI@ABAFCDBFF@BD@@BB@BBB@RNfbB@F@F@D@D@BAF@B@BADA@AB@@@MO@A@AA@AA@@AA@@I[@@RShDJHANC`NF\\IIkAUIa@@FCBAD@F@L@F@FBB@BB@@BB@BBbJFPLLHNJ²gLSDcTaB@ACBA@@BCB@@ABAB@BABABA@ABC@AAEAIF@hIBAJC@@HHHxDBBBB@DFBBBD@BBD@BZHNmFEVYPGJEDAHCBAJELFRBB@DAB@BAB@BAB@@A@A@@@@B@BA@ABABABC@@@A@A@@@A@@B@@@B@DCFCJELGFCBABAyjeDEXGB@HA@@FCzB`@HAFAFCFADCBAB@B@@@BB@@@D@B@B@@DDZGBAD@NCHLDARGLADAJAFAB@FCHCBAHCDAFAFAFCB@FEB@BABA@CBEB@D@@BDBB@FFB@BBB@B@@@DAB@@@D@B@B@BB@@@B@BBDHEDCFCDCHCJEJCHALC\\v^DJ@bDtF²LVDfFNBNBP~`C\\CTGP@RC²@f@R@j@XC\\CFG@C@C@K@E@ICMAEAIEMCKAKCKAC@A@GBGBEAIAC@CBAAG@G@CA]AC@A@QAQ@AHAF@DABAHK@A@A@A@A@CFGDC@AC@ECA@@E@@LBJBF@@@@B@B@@@AB@@@@@B@@@AB@@@@@@B@@@@@@E@C@@BABAB@@AAA@AB@BCDAB@HYBC@@AA@K@AB@@@A@CCACGA@@@AA@GA@AHMFKBABA@A@@BAAAACAACGAA@A@AA@@@GEBAA@@@A@@A@BAA@@@@@@@@A@A@IDEBMA@BABABECABCA@AE@ABCB@BE@IAA@@ABCD@AA@@@E@A@A@AAC@@DE@C@@A@@A@@A@@@@@DAB@@@@A@ABAAA@CBC@C@C@@G@C@C@E@C@AAA@@@A@C@AB@@CAABA@AA@@CB@@A@A@A@A@@@A@@@@BA@@@A@@@A@A@A@A@A@C@A@@@@BA@CB@@ABA@CBCBCB@@A@AB@@A@A@A@CBADA@ABCBA@@@A@A@ABAB@@A@@AA@G@GBE@C@@@A@@AA@@@AB@@A@A@A@@@A@A@@@AAA@A@ABC@C@A@IBC@@@A@AB@@C@IB@BC@@@@@A@@@@@AD@@@@CBAB@@@B@@@@@BA@C@A@CB@@A@C@AB@@AAA@@AA@@@AB@B@@A@@@A@@AAA@@@@CBCAAAA@C@CAEAA@@@C@@AA@@@CC@@A@AA@A@@@A@@@AAAAAAAAAAA@@A@A@@AA@@@ECA@@BA@@@@@@@A@@AA@@@C@A@AA@@AAA@@AA@A@C@AA@@@A@@@@AA@AAAAACCAECCAAGEC@AACAAAEACACCEACAIAAAAAACAA@CGGCECEACACCAA@CAEAICA@E@SBI@A@CBCDA@@B@B@B@@@BBBCB@BAD@@@F@@DH@@A@@@EBA@@BCDADABEBGDIDIDKBIBC@G@A@CAIAG@CACAA@C@C@A@@@AAA@A@A@A@A@A@CA@AA@@@A@A@@@A@@@A@A@EB@AA@A@E@AA@G@E@@KAOBA@AAAAA@A@E@Q@A@@AAA@@EBI@K@@@@AA@A@@@AAAAC@AAEACAAAGAA@A@"]],encodeOffsets:[[[104561,38947]],[[104713,39036]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
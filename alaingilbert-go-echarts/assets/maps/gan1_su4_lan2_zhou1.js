@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("兰州",{type:"FeatureCollection",features:[{type:"Feature",id:"620102",properties:{name:"城关区",cp:[103.825307,36.057464],childNum:1},geometry:{type:"Polygon",coordinates:["@@@E@AAE@A@@DA@@@A@A@@A@AA@@@E@@@@@@A@A@AA@AEDABABA@@@CBA@@@A@CBAB@@@@@B@@B@BBB@B@@@@@@@@BA@@BA@@@A@@BC@@B@@@@@B@@@@@@ABA@AA@@A@@@@BAB@@@B@@BB@@B@@@BB@@B@@@@B@@@@A@A@C@@@@BAB@@@@@@BBD@@@D@B@@@@@@@@@A@ABA@E@C@CAA@@@@BA@@@AB@@@B@@B@B@@@DBBB@@@@C@@@E@C@@@@@BBAB@@@B@@B@@BB@@@D@BB@@@@AB@@AA@@A@@@A@AA@B@@BB@@@B@@CAA@@@@@@BA@@@A@A@@@ABAB@B@B@BB@B@@B@@@@@@@@@@A@@@A@@BB@BBB@@@B@ABA@C@A@A@A@@@C@@@AB@@B@@BB@@@@@@B@@DBB@@B@@@@@@A@@@@@A@@@CBA@@@@@@B@B@BA@@B@D@B@@@@A@@@A@@@@BAB@B@@AB@@ADA@AB@BA@@B@@A@@@A@@@C@AB@@AB@@ABABA@@B@BABAB@@@@@B@BAB@B@@@DAB@B@@A@E@ABEB@@@@@@AB@@A@@@@BB@BB@@AB@@@BB@@B@@@D@@@@AB@@@@@BB@@@@@@@@@@B@B@@BD@B@@@@@BAB@@@@@BB@@BBB@B@@@B@@A@@@C@C@ABA@CB@@GD@@CBA@ABCBA@@@CBABA@@BA@CBA@AB@@ABFDBB@@@@@B@BDF@D@B@B@@@BB@BBB@@@A@@B@@@@@B@@BD@B@@AD@B@@AB@@@B@@@@ABA@@D@@@BCBA@@DA@@B@B@@AB@B@@@BBB@B@B@@BB@DBBD@@AB@@@B@@BBBBB@BDB@@B@@@B@@@B@@@@@@@@B@B@@@B@B@B@@BB@BABB@@@@BA@@BBB@B@@@B@BADABA@ABABABAB@BBB@@B@@@B@BAB@D@B@DA@@B@BB@@BB@B@BB@@@@AB@@@@AB@D@@@@@AAAA@@@@@A@@@A@@BABC@@@A@@CA@@@A@EBA@A@AB@DA@@@A@@A@@@@@@ABA@A@@@AAABA@@AA@AAA@A@A@@@@BA@BD@B@FBB@DBB@DBB@BB@@B@@@B@BC@@BA@@B@@@@B@@@BAB@@@B@@B@@@B@@BA@@BA@@B@BAH@B@L@@BB@BBB@B@B@BB@@@B@@BBBB@@B@@@B@B@@B@BBBBA@@B@@@@BD@@@@A@@B@@@@@@@B@@@@@B@@@BBB@BBAB@@@B@@BD@@BD@B@B@@A@@@A@@FCB@@@B@D@B@@@B@@@@ABA@@BA@@@A@ADA@@@A@@@@DAB@@@@@BA@@BA@@@@@@AA@@@A@@@@B@BABAB@@A@A@@B@BA@@@@A@@A@@@A@A@ABA@A@@@@AA@@@@@@BA@@@AAA@A@@@A@@BABABAAABA@@@ABA@@BA@AB@@@B@BA@@@@@AB@@@@AAA@@@A@A@@D@BAB@BAB@DB@@BAB@DAB@@@BB@DBBB@@BA@@@ABA@@@@B@BBBDD@B@BBBBB@@B@@B@@B@@@@BB@@@DB@@BBBBBBBBBB@BBBFFBB@@D@@@@@@BBB@B@@@BB@@@BBBD@BBB@@A@@B@@EAA@CAC@@@A@E@C@ABA@@B@@@B@@EBC@AB@@@BAB@@@@BBBBBB@B@@@AB@BCB@BA@A@A@ABA@@B@B@BBDBBBBBBB@@B@BA@@D@BB@@BAB@BA@@@ABA@@B@B@D@B@@@@@B@@A@@BC@A@@BB@@@ABA@@F@BA@@@@BA@@DB@@@@BA@AB@@BB@@A@@BAB@@A@@@A@AB@BAB@B@BABA@@B@@BBBB@@@B@@ABABA@@@A@@@AB@B@@@BA@@AAAACC@@@A@@BAB@BC@@@A@A@A@@BA@@D@@@@A@A@@@A@@@@AAAA@A@AAA@A@@@@BA@@@ACAA@AA@A@@@ABA@@A@@A@@@@@@BA@A@@A@A@@@@A@AB@BABAB@@ABA@AB@BBB@@BB@@BA@@B@@BA@@BBB@@A@@@@AA@@@@@@@@D@@BDBF@B@F@B@@@@B@@A@@@C@@BA@@BB@@BBBB@B@B@B@@BB@B@B@@@BB@@B@@A@@B@BABABA@@FDB@B@@@DB@@@AB@@ABA@ABA@@@@@A@@DC@@BAB@@@AAAC@AACA@ACCAA@@A@@@A@@BC@A@@AEAAB@@A@@BA@@AC@CA@@@@A@@@AA@@@@A@@@A@C@@@A@@BA@@@@@AA@@@@A@@AABA@ADC@@AA@@@@A@I@EAA@CAEA@@C@CACAC@A@ABA@GDA@A@A@A@CAC@C@A@C@@@A@@A@@@A@@BABAB@B@B@B@BA@@BA@@@@@C@@@AB@@@@ABCB@@C@A@A@A@@@C@@AA@@ABA@@BAB@@@@C@@AA@A@ECCAAAA@AAA@AAA@@A@@A@AAAA@@A@CAAAA@A@@A@@@@ACA@@ACC@AA@AAAC@@C@A@@@@@@CA@@BA@A@@@@AB@@A@A@A@@AA@@A@AA@@@@@@@E@AA@AB@@A@@@AC@A@@@A@@BABAB@@@@AAAA@A@A@A@@@CB@@A@@@C@@A@@@@@A@@@@A@B@A@@A@@@@@@AA@A@@@@@@@@AA@@B@AA@AAA@@@A@@A@@A@@@@@@@@@AA@@@@@@@@A@@@@@@A@B@@@A@@@@A@@@@@@@AA@BA@@@@@@@@A@@AA@AAAC@@@A"],encodeOffsets:[[106375,36842]]}},{type:"Feature",id:"620103",properties:{name:"七里河区",cp:[103.785949,36.066146],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@AA@@AAA@A@@@C@@@AB@@AA@B@@CB@AC@@@A@C@A@AA@BA@@@AAA@A@A@@AA@A@A@@@@@A@AAA@@@@AA@@@@AAA@@@@@BA@@DBB@D@B@BCHCB@@@@A@A@AACAA@@@AAA@C@@@AAA@@@@@@@@BAF@HAH@B@BBD@@@B@B@@@B@@A@C@A@@B@@@B@B@B@@@B@DA@BB@BB@@BAB@B@@@@DD@B@@BF@@@B@BBB@@@B@B@@A@@BA@@B@@@B@BAB@@A@AB@@@@AB@@A@@@A@AAA@@@AA@A@@@@AAAA@A@AA@AAA@A@@@AB@BABABA@@@A@CAA@@@ABEDAB@@AAAA@@A@@@EB@BED@B@@@B@@A@EB@@C@A@C@@@A@@B@B@@AB@@EB@BEFA@ADA@@@C@@@AAAA@@A@@@A@@@@@CB@BA@@@AB@D@B@BAB@D@@BBBBB@@B@BA@@@CBEBC@ABA@ABCD@@A@@@AA@@@AA@@@@@@@@AA@@@@@AAA@@@@@AA@@AAA@@AA@@@A@@@@@ABAB@@A@@BA@A@@@ABA@@@@@A@@@AB@@A@@@@@@@@@@@AA@@A@@A@@A@AA@@@@@A@@@AA@@@@@@@@@A@@@A@@@A@@@A@@@@@@@@@A@@@@@A@@@A@A@@@AA@@AB@@A@@@@@A@@@@@@@@A@@@A@@@AA@@A@@@@@A@@@@@@A@@A@@@@@@@A@@@A@@@@@@@@A@@@@@@@A@@@@@ABA@@B@@ABA@@@@DA@@BAB@@ABCDAB@@AB@B@BA@CB@@ABABAB@@@@C@ABA@@@CACBC@@@CBABA@@AC@A@@BAB@@CBA@ABCB@@@H@BA@AD@BABA@@B@D@D@B@F@@@BADAB@B@@BB@@@D@B@B@@BBBB@BDBDB@BB@BF@@BBB@DBF@FBD@BBBA@@BA@A@@B@B@@@BCBA@@B@@ABA@AB@D@BAB@@@BB@@D@@@@@BBBBAD@@BB@@BB@B@BBB@@B@D@@@@BBB@@B@B@BBF@@BBBBB@@BF@D@@@@BBBDB@@@AB@@@@ABAB@@A@@BA@@B@B@D@DBB@B@B@@BBB@@B@BBBBB@@DBB@@@DDBB@B@D@BBF@B@B@D@J@B@B@BAD@@@@@@BBB@@A@@@BB@@@DB@@@@B@BB@@B@@@@@@@@@@@@@@BB@@B@@@@@@@B@@B@B@@@@@@B@@@@@@@B@@ABE@GBEB@@CDABAB@@A@@@AB@@@@B@@BB@@BB@BB@@@B@@@@@@@B@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@BB@@BA@@BB@DB@@B@@@@B@B@@AB@BDB@B@B@B@B@@@@FDBB@@@@@B@D@@@BBBE@G@GBA@A@ABCBED@BA@DDBB@@@D@B@BDB@@@@@D@BBB@@@B@B@B@@ABBDB@DADALIDADCBA@A@@BADABADAH@DAFAJCB@B@D@B@BBB@@BB@@BBBBDBD@@@BBB@@B@BBB@JC@@B@FABADABCBAB@BAD@B@D@H@B@H@D@@@B@D@B@L@B@D@F@B@DABABA@@BABABABABA@@BAB@DAB@@AB@BADA@@B@DABAB@DA@@HC@@DAB@BAD@D@@@B@@@@A@@@AAA@AA@@A@@@@BA@A@@@@@AAC@@@A@A@@@@@@@@A@@A@@@@BA@@@@@C@@@AA@@A@@BA@@AAA@@A@@B@@@BA@@@@@@FABAF@B@@@@ABA@C@@@ABA@A@A@@@@BABA@A@AB@BABA@@BA@@BAD@@@B@@@B@@@@AB@@ABAB@BC@@BA@@@ABA@A@@B@@@B@@@@@@A@C@AB@@A@A@A@@@@B@DA@@B@@@@@B@@@@@@@@AA@CA@@@A@@@@A@@AA@@@BA@@D@@@B@B@B@D@B@BAA@@@A@AAA@@AB@@@B@@@@@@@@@@@@AA@A@@A@A@ABABA@@B@B@@@B@@A@@@@B@DB@@@A@@AA@@@ABBB@@@B@@@BB@@BA@@@@AAC@@@A@@AA@@@@A@@BAAA@@@@D@F@@@D@@@@@AACA@@A@A@@@@A@@BA@@B@@A@@B@DBD@F@B@BAB@@@@@@@@@A@C@@@C@AA@@@@@@BA@A@@D@B@B@@@@@@A@@A@@@AA@@A@@@AA@@@A@@BA@A@@B@@@BBB@BA@@@@@@@A@@@@@AD@@AB@@@B@@AB@@A@@@@@@A@A@AAA@@@@A@@@@BADAB@@@B@DA@@B@BABAFCAA@AAE@A@C@@B@@CB@BA@@BAA@@AAA@CAA@AAA@A@AA@BC@ABA@@BAB@B@B@D@B@BB@AB@@@BADC@@BA@ABAB@BABA@A@ABA@@@@@AF@B@FAB@BAB@@A@@DA@ABA@@B@BA@ADC@@@@A@@AA@A@@AAAACAA@@AA@A@I@@@@@AAAAA@@ACAA@@@CAABA@@@ABADC@ABA@@D@@@@C@C@AB@@AB@@A@C@@BAB@@A@@@A@ABCBA@E@A@C@ABA@C@@AC@C@@@A@A@@AA@@A@AB@@CAAAC@CAA@A@ABA@@BCBA@C@@BAAIAA@A@@@CD@@C@CBA@A@A@A@ABCB@@CB@@@@EA@@@@AD@@ABA@@@AB@@CBABAD@B@BAD@B@@CBA@AB@@A@A@@@C@@@A@CA@AEA@AEC@@@A@ABA@@@@@@@AG@@@AA@@A@B@@AA@@A@@@@BAAA@@@A@@@AACAA@@@A@A@CAC@@CA@@C@@@"],encodeOffsets:[[106323,36708]]}},{type:"Feature",id:"620104",properties:{name:"西固区",cp:[103.627951,36.088552],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@AB@@@BABA@@@@BA@@A@ACAA@@@@@CAE@@AAAA@AE@AAA@A@@@AA@A@@C@A@@@AA@A@AAA@@AA@@BCAAAA@@@@C@@@AA@@A@ABC@A@@BAB@BA@@@ABAD@@A@A@@@@BAB@@ABAAC@EAE@CAA@AA@B@@BB@@JDBDBB@@@B@BA@@BEDABAB@@CAA@A@@@AB@@@B@@DD@@@BA@@@@B@@@B@@A@A@@@@BAB@@@@A@@B@@BBBBBB@B@J@B@@DBB@B@B@@B@@DF@B@@BBDB@@@B@DAB@BABCBABA@A@ABA@@@@@@D@@@@@@A@A@AB@BAB@BAD@DEFCB@BAB@@@DAD@@@BA@A@@DA@@BAB@B@B@@@B@D@B@@CD@BABABA@@DAD@BADCDABCB@@E@@@A@CACAA@A@GAA@ECCAA@@AA@@B@@AB@DBBBF@@@DADAHBF@BA@CB@BA@@@@B@@@BA@@@@BBJAB@@@@A@A@A@C@A@@@AB@@A@GDEBABA@CBA@GB@@EDABCB@@AB@@ABA@C@@@CAA@A@A@@@CAA@A@C@CAA@A@@@A@E@@BA@A@C@ABA@@AA@@@A@A@A@C@A@CB@@@@AB@@A@@BCBCBCB@AA@@@@@@A@@@@AA@@@@@A@@@ABA@A@@BA@@@A@@@@ECA@A@ABA@A@A@A@AAC@@@@BA@@BAB@BA@A@BBABA@@B@@AF@@CF@BA@AA@@C@A@AA@@EA@@AAA@AAAA@AAA@C@A@@@E@E@EAA@CCGA@AAAAC@@A@AAA@@@AA@CAAAAA@AA@ACCA@@AAAAA@ACA@@@A@ABABC@@@AB@AA@A@A@A@A@@@C@A@A@CBA@C@@@@B@B@BA@ABA@ABA@A@A@ABA@AB@@@@@BBB@B@@AB@@@B@B@BCB@@A@@B@BB@@BB@@DBBBB@@@B@B@D@@@D@@@BAB@B@@AB@@BB@@@@@BBABB@@@@@B@BB@@B@BBB@@@B@BB@ABB@@D@B@@@@@B@@AB@@@BBB@@AB@@AB@BABAB@@ABAB@@C@@@@@C@A@AAA@@@ABA@@@A@@@@@@B@BA@A@A@@@@@CACCCACAEAGBC@GAC@@@AAC@AAA@A@C@ABEBGBGAC@A@C@E@ABA@@@AB@@@DADAFABADB@B@@BB@@@@@B@@@@ABB@@B@@@@@@@B@B@@@@@@@@BBA@@@@@@@BB@BBBBB@@@@B@@B@@B@@@B@@@@DDFBBB@@@B@B@BBDB@@B@@@BB@BB@@@BA@@BB@@@@@B@@B@@A@BB@@BBBBBB@@AD@@@B@@BD@BB@@BD@@D@@@B@@@@@BB@@B@@@@A@B@@B@@@@@@@B@@@B@@B@@@AB@@BB@B@@BD@@AB@B@B@@@@@B@@@BBB@@@@@@@@A@@BB@@@AB@@@B@@@B@@@B@@@B@BAB@B@@ABA@@B@B@@@@@@AB@BAB@@@B@@AB@@BD@@AB@B@B@B@B@@BBBBBB@@AB@B@@@D@@@D@BB@BBDBB@@B@@B@@@F@DBD@@B@@BB@BB@FBB@BB@@@@BBFDBB@@D@B@B@@@BAB@B@B@@@BAB@BA@@@@@A@@@A@@BAB@@@B@D@BAD@D@B@@@BABAB@D@@@B@@@@@B@BBB@@@BABBB@DBB@@AB@@ABE@@AA@AA@@@DC@AB@B@BAD@@A@@@A@A@AAAAA@A@A@AB@BCFCBAD@@@BA@@@ABB@@B@@D@BB@@BB@D@BB@@D@D@@@BBB@B@B@@@B@D@B@BB@@B@D@@@DBBBB@BAB@@AFBB@DB@BDB@@BBB@BBB@D@D@B@B@DBB@B@@@DBB@@BDBB@B@DBF@D@BB@@D@B@@AB@BA@@DAB@FBD@B@B@B@BAB@B@F@B@HD@@@@FABAD@BADA@@B@B@B@DBBB@@B@@@DA@@D@@@BABA@A@@BAFA@@@ADA@@@@FA@@@@B@BE@A@A@AB@DC@@DADC@@BA@@B@@CBA@@BA@AB@BA@@@AAE@CA@@A@@B@@ABC@@B@BCFADC@@@@@AA@A@@A@@AAA@AAA@AACA@@AA@@BCB@@AD@@A@A@AB@@AB@@@BA@@@C@A@@BA@@@A@A@A@AA@@A@@@A@@A@AAAA@@@@BA@@@@@A@@A@AC@@@@@A@@@AA@@AA@@@@AA@@@@A@@A@@@@@@A@@@@B@@AB@@A@@@AB@@A@@@A@@B@@@B@@@B@@@BAB@BA@@@@BAB@BA@@@@B@@@BAD@DA@@@@BBDDDBB@@BBBBBDBFDB@DBF@FB@@D@B@F@B@B@DAD@DAJAB@JCHAFAB@BAB@@AFA@AD@BAB@@@DABCD@BAB@@CB@@C@@BA@A@CBA@ABA@@BABAB@BA@ADADA@A@@@@BCDAA@ACBA@@@A@A@A@@AA@A@C@@@@CA@A@A@C@@AACCB@@AFCDABAB@B@HAH@F@AA@A@@@C@A@@@@AAEC@@@@@A@A@A@ACA@ABA@@@A@A@@A@@@CAA@@AB@@AA@@A@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@A@@@@@@@A@@AAA@@AA@@AA@@@@@BA@@B@@@BABADC@@FAHAF@BA@@@A@@@@@@@A@@@@A@A@@@@A@@@@@@@AA@@A@@@@@@@@@@@@A@@@AAA@@@@@CA@@A@@A@@@BA@AA@@@@@@BC@A@A@A@I@C@A@AAE@A@C@AAACC@@A@CA@@AAAA@A@AA@AA@@@A@AAA@C@C@A@A"],encodeOffsets:[[106158,36860]]}},{type:"Feature",id:"620105",properties:{name:"安宁区",cp:[103.719054,36.104579],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAA@@@AA@A@@ACACAA@AA@@AA@AAA@C@A@A@IDEBCBG@CBABCBAB@@@BABCDCBKJCBCBCBAD@@@@@BCBCB@BABA@ABAB@@AB@BAB@D@BAB@@@DA@@DA@ABC@ADCB@@A@ABC@@BEB@BA@ABA@EBGBIDA@IBCBC@CBA@A@E@A@C@@@EAE@CAA@ECCAAAAA@AA@CACCAA@@@@CBC@AB@@A@@@@@ABA@AB@@@@ABA@AB@@A@@@A@@@A@@@@B@@@BA@@B@@@BA@@BA@@@@@@B@@@@B@@@@B@@B@@B@@B@@BB@@B@@@B@@@@BDB@@@@B@@@@AB@@@@BBBBB@@@@B@@@BB@@B@B@B@B@@AB@@@B@D@@AB@@A@@BA@@B@B@BBB@BBB@@B@@@HDBBBBBBB@B@DBBA@@B@BB@@BB@BB@BB@@@BBB@BB@@@@@DBB@B@FA@@BA@@BA@ABA@@AA@@@@AA@@AAAA@@@@B@BAB@@@B@BC@@D@@@DAB@FCDAB@BA@@BA@@@@D@DAB@B@B@B@B@B@DAB@DAD@B@@@DABAB@@@BBB@D@BAD@@@B@DBB@B@B@@ABAFC@AB@@AA@@AB@@@@@DA@@B@@A@ABC@@@AB@B@B@FBBB@@BB@@@B@B@@B@BAB@@@B@DBD@@@D@B@HA@A@@B@B@@@BAB@@@@A@@AA@@@@BA@CAA@@@ABA@@@AB@@AB@@@@@B@F@@B@@@@@B@@BBBB@@BBB@@@@@B@FB@@@@BB@@B@@@B@@B@@BAB@BABABA@@@AB@@AB@B@@@BAB@@@DCBADAB@@A@A@@@A@@BCDC@@DB@@@@B@@AB@B@B@DCB@@@B@@@B@B@@@B@BABA@A@@@@DBB@F@F@B@DBB@@@B@DAB@BBD@B@BAD@@BB@BBHBD@B@@@DC@A@@BA@@@AB@B@@@BABAB@AA@CAA@@@A@AAA@A@@@ABA@@@A@AB@@CB@DA@A@@@CB@BA@@@@@A@@BA@@@ABC@@@AAC@@@A@@@@@AB@@@A@AAA@@A@@@A@A@CCE@A@A@@@@AAECABABABAB@@ABABCBA@E@C@A@K@A@C@A@@@C@G@A@G@C@A@C@ABA@ABADCBABEBA@@@IDA@"],encodeOffsets:[[106213,36960]]}},{type:"Feature",id:"620111",properties:{name:"红古区",cp:[102.859323,36.345669],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BABAB@BA@@@AB@@A@@@@@@@@@@FAB@B@AA@A@@A@@@@@A@@@A@@@@A@@@@@A@@BABA@A@@@@@@@@B@@@BA@@@@@@@@B@@@BA@@B@@@@@@@@@@@B@@@@@@@@@@F@B@@@BCB@@AB@@@@@@@@@B@@@@B@B@B@@@@@@B@@B@@@@@@@@B@@@@BB@@@@@@@@B@@B@B@@A@A@@@AA@@A@@@@@A@@@@@A@@@@@@@@@@DBB@B@BBB@B@B@B@@ADA@ABA@A@A@@@CB@@ABA@AA@@AA@@AA@@@ABA@@AA@A@AAB@B@@@BA@@B@B@@@B@@BB@@BB@@B@@@@@BB@@@@@@@@BBB@@@@@B@DB@@B@B@@@DBBBBB@@JAB@@BB@@B@D@BABCD@BAB@B@B@D@B@D@@@BDDBBBBB@B@@@BAB@BAB@@BB@DDB@@BAB@B@@@B@@BBBDB@@B@@@BAFADAB@@@B@@@@@D@@@B@BBBBB@BB@@B@B@@@BBBB@BBB@DB@BB@BB@@BB@BBB@@@B@@@D@@AB@@ABA@@B@@AB@BBB@B@@BBBB@@@BBB@BB@@D@@@@AB@@CB@BA@@BAB@BBB@B@D@B@@ABAB@@@B@B@B@DDD@BAB@@AD@@BB@@B@B@BAB@@@BA@A@@@A@A@@@@BA@@@A@@@@@A@@@@B@@B@@@@B@@AB@J@F@D@DBB@D@LBD@@@BAFE@AB@BAB@@@@@B@B@@A@@D@@AB@@B@@B@@@D@@BB@B@B@@@B@@BHB@@B@@BB@B@BBDBB@B@@@@@@AB@@B@@@@B@@AB@@BB@@@@B@@@@B@B@@BB@@@@B@@@@@B@@B@@@B@@@B@DBB@DAB@B@BAB@BADC@A@A@@@A@AA@@A@CBA@A@@@A@@BCBA@A@C@C@C@CB@@@@A@@@@@A@A@@D@@AB@@BB@BB@AB@@@BA@A@@@@@@@@AAA@AACC@@@@@A@@A@@@CA@@A@AACAA@AA@@CCAAAA@A@@@@AA@@@@@@AA@C@@CC@AAA@ACAAAA@@AA@@AA@BA@@@A@@@@@@A@@@@BA@CB@B@@CD@@@BADA@@B@BA@ABA@A@@@A@@@A@A@AAA@AAA@@A@AB@AA@@@@@@C@@@@A@@@AAA@@@@CA@@@@BA@A@C@A@@BC@A@@A@CA@AAA@C@A@A@AAA@ABA@@BA@A@A@@BC@@@C@C@@@@BA@@DAFABABAB@BA@ABABA@CBABABC@@@C@A@C@CAA@A@@BABAB@@A@@AAAA@@@A@CB@@ABC@@@@@A@@@A@@B@D@BA@@B@@BB@@@B@@A@A@@B@@@B@B@@@F@BB@@BA@@H@@@DBB@D@@@BABA@A@@BB@@@@BAB@@@B@@@@A@@@A@@@@@@@AB@B@@A@@A@BA@A@@@@@AB@@@@ACA@@AAA@@A@@@@@AB@@@BBB@@@@@DAFC@@D@DAB@@@DB@@B@BAB@BA@@@@B@BA@@@@B@D@@@@@@@B@F@B@B@BBDB@BFDDBBBB@JBBBB@@@BBD@BB@@BB@@@@DEBA@AB@@@B@B@@@B@FABAB@DAFADAD@DABAB@FCBAB@B@@@D@D@FBBBBBB@@BB@@@@@@A@A@A@AA@@@AACC@@@A@C@C@C@CB@@C@@@AFC@A@@@AB@@@BADCB@B@F@@@B@B@FDB@B@@BB@BBB@BBB@FBDB@@BB@BB@BBDBD@B@BBDBBBDDB@BBD@@@D@BA@@@@BCBA@@@@B@B@@@AB@B@@@BB@BBDBFD@@@@BA@@BABABA@AB@D@B@DBB@F@B@@@BAB@BABE@@BAB@@@@@BBDBB@@@@@@@@EBA@AFE@A@ABAB@BABAB@B@B@B@BBB@BB@@@BDBBB@@@F@B@@@B@H@BB@@BBB@B@@@B@BDBBB@@@B@@@DAB@BBBBDBDBBBB@@@B@@@@ABA@@B@@@B@@@B@@@B@B@B@B@@BB@@BB@@B@FBB@B@FB@@BA@@B@BBBB@BFB@@@@@BB@BABA@@B@B@B@BBB@BB@@BBD@B@@BB@@B@B@BBB@@DAB@@BB@@@@@A@AB@B@@BBBB@B@B@@BBBDBB@@DBDBD@@@F@@@B@@@B@B@BB@@BDBB@B@DB@@D@BB@@BB@BBB@DBB@@@@AB@@@@CA@@@BCBA@A@@@A@A@A@AAA@@@A@A@@DA@A@A@A@@@@BA@@@@BAAA@@@A@@@@AC@C@@@@D@B@BAB@BA@@@A@@B@BAB@@AB@@@A@@AC@@A@@A@@A@@@AB@BCBA@@@A@A@@@AB@@A@A@ADC@@@@@AAA@@A@AAC@AACAC@A@@@@A@@@@B@DAB@@A@@@AB@@@@@BA@@@@AACA@AA@@AA@A@A@@@@A@A@@@A@@DCBA@@EG@AAAB@BC@@@AA@@AAAAAA@AAA@AACAACAA@A@@@C@@B@BAB@@A@C@@BAB@@A@@AA@A@AAA@C@A@@DE@@@@@C@ABA@@@AB@@@@C@A@CAA@@@A@@FA@A@@A@CC@@@A@@DC@AB@@@AA@AA@AAA@AAA@AAA@@ACCAA@A@AAC@C@AACCG@A@A@@@@DAFABA@@@@@@@AAA@@@@@@@AB@FAFAFADADA@@BADCB@DABAB@BAB@B@B@B@BB@@HABAFADAB@@@B@DBB@D@@@BB@@BB@@BB@@D@B@B@@@DBB@B@HBF@BB@@BB@@BB@B@@B@@ADAB@BAB@DBBBFBD@DB@B@@BBA@@BAB@B@@@BDBBD@@B@D@B@@@BBDBB@@B@@B@@@B@DA@@B@@@BB@@@BBB@B@B@@B@@BB@BB@@@@BBBA@@D@@BB@D@DB@@D@D@BAHAB@BAH@D@B@BB@@B@F@@@FB@@@@BA@A@@BCBABA@@BA@@D@@@BA@C@A@@BAB@@AB@DABAB@BA@ABADA@AB@BAB@B@BAB@BA@@B@BABABAB@B@B@B@@@@@@A@AA@CAAAA@@A@C@@@C@@@ABA@@AAAAAA@@@A@A@A@ABA@@AC@@BA@@@A@@BA@ABA@@@@@@@A@AB@BA@@@ABA@A@A@@@A@@@A@@@A@@BA@@A@@AB@@@@@@@@@AA@A@@@A@@@@@A@ABA@@AC@@@AAA@@BA@@A@@@@A@@@A@@@@@@@AA@B@@@@@@AA@@A@@@@@A@@@CC@@AA@@AAC@@@A@@BC@@AAAAAA@@AAB@@@@AA@@@@@A@@AB@@A@@AAA@@A@@@AA@AC@A@A@A@@AAEACC@@@@@A@@@AA@@@@A@@A@AAAAA@@A@@@@@@AB@A@@@@@@A@A@@@@@@@A@@@AA@B@@A@@@@@A@@AA@A@@@ABADABEBC@A@C@C@CA@AC@A@@@AB@@@D@B@BAJABABABABEBA@ABAAA@ACC@CACAAAAA@@CAA@@@A@@BA@A@ABA@EDC@A@C@EBABABC@@@AAA@AACAAAAAA@A@CAC@@@E@@@C@@BA@@@@B@@BB@B@DBBAF@BABABAB@@A@A@A@@@A@AAA@AA@@EAA@A@A@A@ABCB@@ABA@A@@@C@E@A@@BA@@@@B@D@D@B@B@BA@@@A@G@A@C@@@I@A@CBGBA@@@@AA@@C@@A@@@A@A@ABA@ABA@@@@BABAB@B@BABAB@@ABABABCB@@A@A@A@CCG@C@A@@@ECAAA@A@C@CACBA@@@@@C@@BA@AB@B@@@B@B@@@B@BAB@DA@ADA@ABAB@@A@@@@BA@@@@@A@@@@@@@A@@@CAAAC@A@C@A@A@@@@@A@AB@BAB@BABABABCD@@A@@@@@A@A@@AA@A@AAC@E@C@@@A@@BAB@B@B@B@B@B@BABABA@CBA@CB@@@B@@@B@BBB@F@BAFABABCDA@ABA@@AA@AAAAAC@AAG@@@AA@A@CCC@AAA@A@@@A@ABC@A@ABA@A@C@AAA@ECCAC@E@A@A@@BA@@B@FABADAD@@CDABC@CBCACA@@A@@@CB@@ABABABAD@DA@@@ABA@A@CBC@ABA@ABEBABCBCB@@AAE@A@CAA@A@AB@BBBBDDH@DDH@B@D@B@DAB@@@B@BAB@@AB@@A@A@A@C@A@C@CBA@@BAD@@AB@@E@E@C@E@C@EBA@C@ABABABAD@BCDCDCBC@EBA@@@ABA@@BAHADA@ABA@A@C@CAC@ABA@ADC@GD@@C@E@CBG@A@ABABEBGFGBGBCBA@@B@@ABAB@DAB@B@BADADCF@DABABABABGDCBCBA@ADABAB@DABABABAB@@A@C@A@C@A@A@C@A@A@ABABA@@BA@A@CB@@C@A@@@A@AA@A@A@@@@@AA@@@AAA@ABABA@@@A@E@@@AAA@@@AAA@A@@@@@AB@BABABA@@@A@A@A@A@ABA@@@AA@@AA@@@A@A@@A@@@ABAD@BA@@BABA@AB@@A@@BA@@B@@AD@BAB@@@BA@@D@D@BCDCF@@AB@BABD@"],["@@@D@@@B@BB@BA@C@AC@"]],encodeOffsets:[[[105300,37231]],[[105298,37231]]]}},{type:"Feature",id:"620122",properties:{name:"皋兰县",cp:[103.947377,36.332663],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AC@@AA@@@A@AB@AAA@A@@@A@@@@@A@@@@@@@AB@@@@@AC@@@@@AB@AAAAA@@@@A@A@@@AA@AA@AA@@@A@@A@A@AAA@AAA@@@K@ABG@A@AB@@AB@@AA@@@A@@@@A@@BA@A@@@A@@A@@@AB@@ADA@@@A@@@AAA@CAA@CAA@EAA@C@@AAB@@@@@B@BBB@BBB@@ABBB@B@@@BAB@B@@@@B@@@@B@@CBA@@B@BAB@F@B@@DB@@@B@@ADAB@@@B@@@B@@@@BBBB@@@@C@A@@B@@A@@B@@A@@A@AAA@@AAA@@@CBA@C@A@ABA@@@A@@@AA@ABABABABAB@BABC@A@A@@@AAA@AB@@A@@A@BA@AAA@@@A@A@A@@@A@A@@@@@@A@@@A@@@A@@@CA@AAAAA@AA@@@A@@BC@A@ABAB@@A@A@@B@@AB@@@BCD@@A@C@GAAAA@@AC@ABA@C@AAA@CBA@@@A@CAA@E@E@A@CA@@@@@BABABA@@@A@A@@@A@@@A@CDA@A@A@@BA@@@@@CA@@CDAD@@@B@@@B@BA@CBABCD@@A@AB@@A@A@@BA@@B@@ABABABA@AB@@@AA@@@A@@@AA@@@@EAA@@@@@A@AA@@AAAA@@@A@@@@@AE@A@@@@@A@@BA@@B@@AB@B@@BB@DAB@@@@BB@@@B@@A@AB@@A@A@@@@BGBA@C@@@C@CAA@@@A@ABA@@@@A@A@@AA@@AAEAA@A@A@@B@@AD@B@BA@@@CB@@@@A@@BB@@BA@@BEDAB@BA@A@A@CAA@@@C@ABC@A@AA@@A@ABCB@@A@C@CBA@CBA@A@A@A@A@A@CBC@@@@@AB@@ABA@CBEDA@CB@@C@@@ADA@@@A@ABA@@@@@BBBB@@BB@@@@BB@@AB@BAB@@AB@@EBA@A@CA@@@@A@@AAA@A@@AAA@@AAA@@AAA@@@ABCAA@A@AAAAAAGC@@A@@@AA@AAAC@@BA@AD@@BB@@DBBBB@BBB@BB@@@BB@B@@B@@@@CDEBADA@@@AD@BA@@@@BB@@DBF@B@@ABA@@BAB@@AB@DA@@@AB@@CDCB@@CDA@@B@B@BAFA@@@@@EB@@@@CB@B@@EBAB@@@BABH@BBBBD@BB@@A@AB@@ABC@GBAB@@@B@@@D@B@BBB@@@D@@@BA@BB@@BBD@B@D@D@D@DAB@B@DBFB@@@AB@BAB@BA@@BBD@@AF@B@@B@@H@F@@@BCB@B@@@BB@@@@@BB@@@@BA@@@@@@B@@@@@@@B@@@@@@B@@BA@@@@@@BB@@@@B@@@BB@@@@@AB@@@@BBB@@@@B@@AD@@@@@@B@@B@@@@@B@BA@@@BB@@@@@@@@@BB@@@@@AB@@B@@@B@@@@@ABB@B@BBBBB@B@@@F@B@B@D@DBB@F@DB@@@@@@@@AB@@@B@BAF@B@BCD@@@B@B@@@B@B@@@@B@BB@@@B@@B@@@BAB@B@@@B@DBDB@@BB@@@B@@AB@B@@AB@@@@CA@@A@ABA@A@A@@@A@C@@@AB@@@BAD@BA@@BB@@BAB@B@@B@@B@@@@AB@@@@@BB@@@@@@@AD@@@@@B@@D@BAB@DD@@B@@BDBB@@@B@@@@@B@@@BBB@B@@@B@@ADA@@B@@@BB@@@@@BB@B@@@BB@@@@DC@@B@@BBB@@@@B@B@@BB@@@BBB@@AB@@@@BB@@@BBB@DDBBB@@@DA@@BBB@@B@@FC@AB@F@@@B@BADA@@B@DBB@@@B@BABA@@B@@@@@B@@@@BB@@BDDDD@B@BDD@B@DBB@D@B@@EH@@DBBBBDBB@@@@B@@B@@@B@@@B@B@@B@@@AB@@@B@@BB@@D@@@DBBB@@BBD@@BB@BDB@@@@@@BC@A@@@BB@@CB@@@B@@EB@@@BBBB@@DB@@@@@@B@@A@@@AB@BEBA@AB@@A@BB@B@@BBBBBDB@BB@@BBB@B@B@@@B@B@D@BBBB@@@BBB@B@BA@@B@B@@BBB@@B@@@@AD@B@@@@BBB@@@@B@@@B@B@B@@@B@@B@@BB@@@B@@@B@@BBBB@@@BB@BA@A@A@@@A@@@BB@@@BA@AB@@@@@B@B@@@@@B@@@B@@B@@@DB@@@@@B@@BB@BB@B@@B@@BB@@AB@@@@B@BBB@BB@BB@@@@@B@@BD@B@@@@@@AB@@@DBBBB@D@@@@BBDDD@@@B@@CDABA@AB@@@DAB@B@BADA@@D@BAD@B@B@@@@BBB@B@@BB@BBB@BB@@DFB@DBDB@@@@@B@BBB@@@@@B@@@@@BB@@@@B@DBB@B@@BB@@@@@BA@@@AB@@@@BB@@@@@B@@@DAB@@@BAB@@@B@@@B@BADAB@@@@A@@@@B@@AB@@@B@B@B@@A@@BA@BB@@@B@@@BAD@@AD@@ABA@@BAB@@@B@@A@EDABA@CDABA@AB@@A@ABABABA@C@A@@DBB@@A@IF@BC@@BA@BB@BBB@@@BBD@BBB@B@BB@BB@B@@BBBBBB@@@J@J@@GFEBIHKHEBGF@@SPBDDD@B@@@B@@C@CDA@CDCBED@@@DA@@D@D@@@F@BBP@D@B@BLDRF@@B@@B@BAB@B@@@B@BDB@B@F@J@H@B@@E@E@A@A@@B@F@BA@A@@@A@@@@B@H@F@DBB@F@B@D@B@F@B@@@@@B@H@@@@@B@B@@BB@@@BF@FAJ@D@@@LDBID@B@B@HBF@D@FBD@@@@@@@BA@@@AB@LBHBFBB@B@@A@@B@@@DBBBB@@@B@BB@B@@BD@@DB@@DBF@@BFBBBB@FD@BB@B@@D@B@@BD@D@B@@B@@D@D@BDD@BBDDD@BBFBB@B@B@BBDBBBDBDBBBDBB@B@BBBAB@D@B@B@BBB@B@B@@@@@@A@@BA@@@@@A@@@@@@@A@A@@@AB@@A@ABAB@@@B@@@@A@A@@@@@A@@BABAB@@@@AB@@A@C@A@@B@@@@@BBABB@@ABAB@@@B@@@BA@@B@@AB@B@@ABCBEB@@@BABA@A@@BC@A@@@@B@@@@@D@B@@AB@@@@A@@@AB@@@B@@AB@@A@@BI@ABCB@@@@@B@HBF@@@@BBBBB@@@@BA@AB@BA@@BA@@@A@CB@@A@A@AA@@@@ABBDA@@B@@B@DBDB@B@@@B@BAB@@@B@B@D@@@BAB@BA@AB@@@@@B@@@B@@@BBB@@@@@B@@@BAB@@@@@BA@@BA@AB@@@@ABBB@@BB@B@BAB@@@BBBA@@@@B@@B@@B@@A@@@@BBB@FBBAB@@A@C@EBAB@@D@DA@@B@@@BDBB@BBBBD@BBBBDBBDD@@DBBDDBBB@BB@B@@@@@BB@BB@@@@@DCDC@@B@BBB@@@BA@@BA@A@@B@@@D@D@D@D@D@BAB@@AD@D@B@B@@@FBF@B@DAD@DADAB@@@@B@@B@@@@@BA@@BA@A@@@@@BB@@@@D@@@@@@B@@ABA@@B@@@@B@@B@BAD@BA@@@AB@BBB@B@DAH@B@@A@@@@B@@@@@B@@@B@B@@@@@@B@D@BABABAB@BAD@D@BAB@@A@ADA@AD@@A@@@A@CA@@@@A@@@@BAB@@@@ABA@A@AB@@@B@BABAB@@E@@@@BA@ABC@@@@B@@@B@@@B@B@@ABA@C@@@@@ABAD@@@BA@A@C@@BA@@@AD@D@@@BB@@@D@@B@@@B@@@@AB@@@@@@@B@B@@@@A@@@A@@B@@@@A@A@@@@@@B@@@@@D@BA@@D@@@@@@FD@@BBBBBBBB@@B@@B@@DB@BB@B@@@@@BAB@@A@@B@B@@@BA@@BBB@@@@@B@@A@@@AB@@@@@@CB@@@AA@@@A@@@@@AB@@ABEDE@ABA@@BAB@DAB@@AB@@A@A@@@AB@@@DBD@B@BAB@DCB@DC@@B@B@B@BBB@@ABA@A@@D@DAB@BBB@@A@@@A@A@AB@@ABA@AB@@CBA@@@ABC@@AAAA@@AA@@AC@A@@@@DE@@DCD@HCB@@@@@@@@B@DB@@@B@@@BBBB@@@B@@BBBB@B@@@B@@@@@@@B@BAB@@@BB@DBB@DDB@B@DABA@@B@@A@ABABA@@D@@@@ADC@A@@B@B@F@D@BAB@@AD@BB@@BB@@B@@BD@@@@A@@B@@@BBB@B@B@BA@@B@B@B@BBD@DBB@@@@BB@BA@@@@@A@@@@B@@AB@@@@@B@F@B@BAB@@@@@@A@A@@@@B@B@@@B@DBBABA@@@@BB@@@BBBB@@@BB@@BA@@BABA@@@ABA@A@ABC@C@C@AA@@@A@CAA@C@CAGAAAAAA@@AAA@@@ABAA@@A@A@@BADC@@BA@A@@@A@C@@DADCB@B@@@BBB@@@@@B@@@B@B@@@D@@DB@@@B@B@@@B@@B@@B@@ABAB@BCBAB@@@B@@A@@BAB@D@BABABABA@@@A@@@@CCAA@@@@@C@@BA@ABA@AA@@A@@@@BAFABAFA@AB@F@D@B@B@BBB@@@B@BAB@@@@A@@@A@A@C@C@AA@AA@@AAA@C@@@AA@AAAAA@@@C@@@A@@A@@CAA@A@AB@BABA@@AA@AAA@@A@CB@A@@BA@@@AA@ABA@E@@@A@AAA@ABA@@@AD@@AB@BA@@@AACAEAAAA@A@AA@@AAE@AAA@CAA@CAAC@@AACA@@@A@@@@@AB@BA@@@@@AD@@@B@@@@A@@@AA@@A@@@AD@DA@ABA@@D@@@FEB@@@@A@@BA@@BCB@@@B@@@B@B@DA@@EIAAA@@@C@AA@@A@@A@@@A@@@@@A@A@@BA@AAA@C@@@AA@AAAACAAAA@AA@@@@B@D@@@DC@@D@B@BAB@@@@C@@AAAAA@@AAA@A@@F@@@BA@@@@FAD@BAF@DAFAB@DA@@BA@A@C@C@AB@BA@AFADADA@@@@@E@@B@HC@ABA@@@@@@@ADABAFA@@BA@@B@FCB@DCBAD@BAFA@@@A@@@@@AA@A@@@A@@@@CA@@A@@AC@@@AACAA@@A@AA@@AA@A@@@@@A@@D@@AB@HAB@@AAA@AA@BADA@@@@BC@@AA@@@A@@@@BA@@@@@EB@@@B@BA@@DA@@@A@@AA@ABA@AB@@@B@BADAB@BA@CB@HAB@D@BAB@B@B@BA@@@A@@B@B@BAB@@ADB@@B@@AB@@@BBB@BBBBBD@@@@@@DA@@B@@BB@@@@BBAB@B@DC@@@AB@@A@@BBFB@@D@B@B@BA@AAACC@@@@B@DCDABAB@D@D@H@DBD@@@BA@@B@B@@@B@B@FBB@BA@A@@@@AA@@@A@A@AA@AA@@A@AA@@@C@@AAA@A@@@AAAA@C@A@@AACA@@AA@AAA@E@@@ADA@@A@AAAC@@@A@@@@AA@A@@@ABA@@DEB@D@B@@AB@@A@@@@AA@AAAB@AAACAA@A@@@@ABA@@@@@AEA@@A@A@@A@A@@@@@@AA@@A@@A@@A@@@A@C@@@AAC@C@CA@@AA@@A@@BAAA@AAC@A@@BABA@A@@@@AA@AAAAA@A@@A@@AB@@A@@BA@ABAB@@A@A@@DABA@@B@B@@@BAB@@@@@@A@@@@B@@@BAD@@@B@@@B@B@@@B@D@@@@A@@DAB@FA@A@@@@@@@AB@AA@@@@@A@ABABABA@@B@B@@A@CBEBA@ABAB@B@@@AA@AAC@A@@@@CA@@A@AAA@CA@BA@@@@@@A@ADA@A@@@EAA@A@@BA@A@AA@A@@A@@AA@AA@@A@@@@B@B@BABC@@@@B@BBB@B@@@@A@@@A@AAA@A@AB@@@@AA@@A@A@@@@BABA@@@@AA@A@ABABABC@CBA@@AAACAA@ABA@@@@D@F@@@DB@AB@BAB@D@@@BA@@@AAACA@@@A@@@@DA@A@@BA@@@@@@B@@ABA@@@@A@@A@@BABABA@@AA@@B@BA@@BCB@@@B@B@@@B@@@@ABC@A@@@AB@D@BAD@B@@@@@@@@A@@BC@A@@B@@ABA@@DA@@@A@@@@@@D@@@BBB@B@D@@@B@@@@@B@@@@A@@B@@@@AB@AC@A@A@@@A@A@A@@@A@@@AFG@AB@@@B@@A@@@AB@DC@AB@@AB@@@@AB@@ABC@AB@@A@@B@@A@@AA@@@A@@B@@@@@@B@@B@@@B@DABAB@@AB@@@@@BA@@@AB@A@@A@@B@B@@CBA@@B@B@BAB@@@BAB@B@B@@ABA@A@@B@@A@AAA@A@@CAAACA@@A@@@A@@@@@@@@AAB@@AA@AA@A@@@A@@@@@@AAB@AA@@@AA@@@@@@@@@@A@AA@@CA@A@A@A@@BA@@D@BA@@BA@@AA@@@@CCBA@@B@@@@@B@@AB@@@B@@@@A@A@@@A@A@@@AA@AA@@AA@@@A@A@@@@BA@B@@BA@@B@B@@@@@@AB@@AD@BBH@B@DAB@@@@A@@B@@ACCEC@AAA@AAC@ACC@C@@AA@@@A@@BAB@A@@ABAA@@AAA@@AA@AA@@@A@@A@C@AAA@@@A@AAC@@@@BC@@@A@A@A@@BABABA@A@@@@AAAAA@@@E@A@@@@@BAB@@@D@BADAFC@@B@BAB@BADABABA@@B@D@FAD@DAB@DABAB@@@B@D@B@B@FBBBBB@@@B@B@@@B@B@B@@@D@DB@@BB@@@DAD@DAB@@@@@BB@@D@J@@BD@@@B@@@AA@@@A@@@@A@AA@@@@B@B@B@HDFDDBB@@@@@@A@A@ABAAAAC@C@EA@AE@C@A@ABAB@@AB@@EBE@ABABCBC@ADAB@@A@ABABA@A@@BA@ABA@ABA@A@@B@@@DBB@BB@@@@@@B@@ABABA@@@AB@@AB@@AB@D@B@B@@@@@BA@@@A@CAG@@B@BBB@F@B@D@@@B@B@B@D@B@@@@@@CBA@A@A@ABABA@AB@DEB@@AAA@A@@AA@@A@@@A@A@@@A@@@A@A@A@A@@@AA@A@A@@@A@@CAA@AA@@@A@@A@@@A@@@A@@A@@BA@@@C@@@@AAA@@@A@@BCB@@@@@@AB@@@A@ABADA@@@@DE@A@CAAAA@AAAAA@@@@@@A@@@A@@A@@@@@AAA@@@AA@BAAA@@@A@AA@@@@@@AAA@@AA@A@AA@@@B@AA@@BA@@AAB@@A@@A@@A@@@A@@@@@AA@@A@A@@A@@@@@ABABABA@@BABABAB@@@AC@@@AA@@@@@@@A@@A@AA@@@@@AA@@@@@AA@@@@@A@@AAA@@AA@@@BA@@ACA@@@A@@A@@@AA@AA@@@ACAC@@A@@@@@@@@AA@@@@@@C@@@A@A@@BA@C@@BA@@@A@@@@A@@@@@@@A@@C@@AA@@@@@@@@A@@C@AC@@AAA@@@@@AAAC@@AC@@BA@ABA@A@ABAA@@@@AA@AAA@AAA@AAA@AAAA@@AA@@@B@@@B@@@@@BB@@@@@@@@B@@@@@B@@@B@@@B@@@@@B@B@@@B@@@@A@@BC@A@@@A@@@@@@@@AAC@C@@AA@A@@@A@@BA@A@@@AA@@AACAC@@@AA@@C@AAC@@AA@@@ABA@@@@A@AAA@AB@@AB@@A@@@A@AA@@A@AAA@CA@@@@E@@@AB@@E@A@@@A@AB@@A@@B@@@B@@AB@@@BA@@@@@@@A@A@EAA@@@AB@@@BA@@A@AA@@@@@@B@D@@@BA@BB@@@@BB@BD@@@@BA@ABA@E@A@@@@@CD@@AA@@@AAA@@@@@@A@CBABAB@@C@@@A@@BC@@BA@@@CACAC@C@C@@@A@@@A@AA@@CA@@@BA@AAA@@AAA@@AA@@A@EA@@A@CAC@CAAAA@@BA@@BA@A@A@A@@@AA@CAA@C@@AAA@@@A@@@AA@@AAAAEA@@A@AA@@@@A@@@@@@@A@@@@@A@@AAB@A@@A@@AA@@@@@A@@@@@@A@@@@A@@@@@@@A@@@@@@A@@A@A@A@AAA@A@C@@@A@@BA@@@@@AB@@@BCB@B@@ABA@@B@@BB@BB@BB@@@@@B@B@B@@AB@@CAE@@@A@A@@@@B@@BB@BA@@@ABADA@@@A@AB@@@@@@AB@@A@@A@@@A@A@@A@A@AA@@AA@@A@CAA@E@AAA@A@@AAAAAA@@@A@AB@@@@AB@BAB@BA@@B@@CA@@A@A@EC@@ABABABA@@@@BA@@@AA@@A@A@A@@AA@A@A@A@AA@AA@@AB@@AD@@@B@@@@A@@A@E@A@E@CA@AC@@@@@@@@@BB@@@@@BA@AA@@AB@@@AB@@AA@@AA@AAA@@BAB@BA@ABABA@@B@B@@B@B@@@@BAB@@@@@@@BB@@@AB@B@@@BBBB@DB@B@@AB@@@@@BBB@B@BBBBB@@@@@B@@@B@B@@C@@@AB@@@B@B@B@@ADA@AB@@@B@@DDBBBB@@AB@@A@A@@B@@@B@@ABAB@BA@@@A@AA@AA@@@ABABA@A@ABA@@B@B@@@BA@AB@@@BA@@AA@@BAB@@@@CA@@AB@@@@ABE@@@AB@B@@AA@@@BAD@@@BA@@@@@A@C@A@A@@@AB@B@@ABA@AB@@AAC@@@ABA@@@AAAAAACAAAA@A@@@AB@B@B@BABA@ADA@@B@@@AAAAAAA@@@@BA@A@@BAD@FA@@@A@@@AB@BAD@F@B@@@D@DBB@FB@@@AB@@@AA@AACAA@@A@@A@@@AAA@A@@@@C@@@AAEEAA@AAAAAAAAAAA@@CA@@A@@A@@A@@@@AA@@@AAAA@A@ACCAA@A@A@@B@BA@@B@@AA@AA@CAA@@A@CBA@AB@@CAA@ABA@ABC@@@@B@B@@BB@B@@A@@B@@@@ABA@@@A@@BAB@@AB@B@@ABBBABABAB@@@B@@@BBB@B@@AB@@@@@@BB@@@@@BAB@B@B@B@@@BB@@@@@ABA@@@@B@BA@ABABA@@@@@@B@@BB@@@@@@AB@@AB@@@@A@CB@@@@@B@@CB@B@B@@AB@@AB@B@@A@@@A@C@A@@@A@ED@@@B@@@BA@A@C@"],encodeOffsets:[[106329,37028]]}},{type:"Feature",id:"620121",properties:{name:"永登县",cp:[103.26038,36.736513],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@AAA@@@@@A@@@C@A@ABAB@@A@C@C@ABC@A@@@A@AB@@@B@@@B@@@@ABA@AB@@A@A@A@AB@@A@A@C@@@AAECAA@@@@AAA@EAA@@AAA@@@AC@CAE@@@A@@@@B@@@@A@A@A@A@ABABABA@@@ABA@ABA@A@ABA@@BCBAB@BABA@ABCBA@@BA@AB@@@B@DAB@@C@@@AB@@ABABAD@@@BAB@@@@EA@@E@A@@@AAA@C@G@ABA@GBABC@C@@@CAC@A@@AC@@@ABAA@@@@AAA@@AA@@@@A@AAA@A@@AA@@A@@@CBA@@@A@@@@AA@CAAA@@A@C@A@@@ACCA@A@@@ABA@AB@AA@@@ACAC@EAAACAA@ABA@CB@BA@@@@AAA@@AA@@AAE@GAA@A@CA@@A@A@C@@@AA@@AA@@AA@@C@A@CAA@@@A@CBEBABGB@@AAA@A@A@A@ABA@ABCBA@CDAB@@CBCBEBEBEBA@@B@@@@@@BB@B@@@@@@ABEBCB@@@@@B@BDHBD@B@DBD@B@BBBDD@BB@BBB@BBB@BBB@@BBB@@A@@BCD@@@B@@DDB@@@@BEB@@@B@@BB@D@B@D@@A@@B@@AB@B@D@@@@CF@@@B@DBB@B@BBB@@@BA@AB@@@D@BA@ABA@@@@D@@@BBBBDDBBBB@BBB@BBBB@BB@@B@@ADA@BB@BFH@@ABCD@@@B@@@B@B@@B@B@B@@BB@@BDBBB@@@@AB@@@@A@@B@@@BA@CBA@@@@@@B@@B@D@DBBBD@BBB@@@BB@B@@@@CD@B@B@BA@@B@@@B@B@@ABADA@@B@@@BB@@@@BD@@BB@@@A@@BA@ABA@@@@B@@ABA@ABA@C@@@@@@DBD@@@@@B@@BBAB@@@@AB@@@@@B@B@BCB@@@B@B@@BB@B@B@B@B@@@BABAD@@B@@D@@A@@B@@A@CAA@AAA@@AA@@A@CA@@C@AAAAC@@AAA@A@@@A@@@E@@@C@CACA@@AAACAA@@@A@AAAAA@@@ABAB@@@@@A@@AA@CB@@AA@A@A@AA@@AA@C@AA@@AAA@AAA@A@A@@@ABABA@@A@@@@EA@AAAAAA@@@AB@@EAA@A@EAA@@@AA@@AA@@@A@A@A@A@@@A@@@A@@@AB@BA@@@@@A@@AAAAACACAA@ABA@C@@@A@@AACA@A@A@@@AAA@AA@@A@G@A@@@A@E@@AACA@A@@AAA@AAA@A@A@A@ABABA@AB@B@BEF@BAB@F@@@@@@A@CAAA@@@@A@AB@@AFABA@AB@@A@E@A@CAA@C@A@@BABABAB@@AB@@@@ECCAAAA@@A@@@ABA@@A@A@@@@@ABAD@@@@ABC@@@C@AAA@CCAACAAAA@C@CAAAA@@AAA@@CAEAA@AAA@AAA@@AA@A@ECA@A@@@E@A@A@CDAB@@A@@B@@@BED@B@@@DA@@D@D@D@D@B@@DDBB@@B@@B@B@B@B@@@@A@@AA@AAAAEAC@C@@@A@A@ABEDA@ABCBC@CBEBCBA@ABEBA@@@A@A@@@A@@BABCF@@@@AA@@AAC@AA@@A@AAIAA@AACAEC@ACAAAA@A@E@A@@@@@@@C@A@@@@@ABA@@@@@ABA@ABA@@@CA@@A@CBC@@@EDCB@@@@A@AA@@A@@B@@@@@BB@BB@@DB@B@@A@@B@@@@@BABB@@@@BA@A@@B@@@@@@@B@@@B@@A@@@A@AB@@@@AA@@@BABAB@@C@A@CA@@G@@@AB@@AAE@@@A@A@@@A@@@@B@BA@@@A@@AA@@@ABC@A@@@@B@@@B@@@@AD@BA@@D@B@@BBBB@@@BA@ABAB@@@BBB@D@D@B@D@@ADABAB@DABAB@BABA@ABABEBCB@@AB@@@@@D@D@@AD@@@B@BAB@@AB@BBB@B@B@B@DBB@BDBB@@@@BAD@@@B@D@BAB@@@@DB@@@@BB@B@@@B@@D@@@@@@@BBA@@B@BB@BBB@BBB@B@@@B@@@B@B@BAB@@A@AB@BC@A@@DC@@@ADAB@@A@@B@@@@@@@@B@@ABB@@BB@@BB@BBDB@BBB@BDD@@@DBB@@@@@@BB@@@@@BBBBBDD@@BBB@DBBBB@@@DB@@B@@@@B@@@@DDBBB@BB@@@@@@@@@BAB@@A@@BAAA@@AA@@BC@@@@B@B@@@@@B@@A@@D@D@D@D@BABAD@@@B@@@BAB@D@BB@@B@B@@@B@BCDABA@ABA@A@CBA@CAA@@@A@@@A@@@@A@@@@@A@@A@@AA@A@@@@@@A@@A@@AA@@BA@@@@@@AA@@B@@@@A@A@CAAAA@A@@AA@@@GA@AA@@@A@A@A@@AC@@@A@@@@AA@@BC@@@@BA@A@@@@@A@ABA@@BEFAB@@C@KAC@A@CAC@E@I@A@@BA@@@@@@AA@@@@@@B@@@@@B@@AB@@@@@B@B@@@BAB@@A@ABA@A@@@AA@@BC@@BA@ACC@C@A@A@A@@BABA@@@A@C@AAA@ABA@AB@@ADA@@BA@@@@@CA@@AAA@A@@AAAA@@@AAA@ABA@@@AB@BA@@BA@@@C@@@A@@AA@AAA@@AAA@@ACAA@AAA@AA@A@@@A@AA@@AAAAA@A@A@@@C@@@@@A@@BABCBE@A@@@AA@ACAA@@@A@@@ABA@AA@CCA@@AA@ABA@AB@@A@A@AAAACC@A@@@C@A@C@A@ABA@ADCBA@A@C@AA@@AA@IB@@AAAACA@@A@A@@@CAA@@@@@A@AA@@@BABA@EDCDCDAD@@ADAB@B@BABA@IFA@A@BBBBDBDBBBBB@DBF@@DFBDBBBB@DA@A@C@C@E@E@A@C@C@@@ABAB@B@B@BBB@BAB@@@BBD@@@B@DA@ABEBMFC@AB@@@@A@AB@BAFAFABAB@BBD@DAH@@AD@BCBABCBA@CBGBCB@@AB@BBBAD@BCDCDCB@@BFDHDDBBFDHDB@D@FBB@@@@BD@@B@@BB@BBB@BBB@BAD@DA@@B@B@BFFB@@B@BCDCDABCD@@AB@@@@@@A@ACAAAAAA@@A@A@@@@D@NAD@D@DGF@BA@ABG@GBEBC@C@CBCBAB@@@BADAB@B@BCDABCDAB@@AFAD@B@BBBBDD@BB@@@@CDE@@@AB@@AB@@@B@@@@@D@B@B@BAB@@@@ABA@CB@@A@@@@B@@DD@B@B@B@BBD@@@@@@@@B@@@BAD@@AD@BAB@B@@BB@@AB@@AB@@@B@B@@B@@D@@@B@@@B@BBB@@@@@B@@@B@@@B@@@@@B@@@B@@@@BBB@B@D@@@BB@@B@@CD@@A@@@A@@BA@ABA@@BA@@BAB@@AB@@@@CAA@@@A@A@A@@@C@@@C@@@A@CDCBABCDCBCBCBEBEBEFCBCBA@CB@@A@@BDB@@DB@@BFBDBDBFBB@BB@@@@B@@@@A@@@A@C@@@A@@@ABA@A@A@ABA@A@@@A@A@C@E@C@E@A@@@@@@@A@A@E@C@ABA@A@A@@@A@A@ABC@@B@@CBABA@@BA@@@@BAB@@@@ABAB@@A@@BA@@@@@AB@@@B@@A@AB@@A@A@@BA@@@AB@@ABAB@@A@A@A@@@A@@@@B@@A@@@EBA@@BA@@@A@AB@BCBCBC@AB@@AB@@A@@@ABA@A@@BA@@@@@AB@B@@@@@@AB@@A@@@ABA@A@C@@BA@@@@BA@A@@@@@@B@@@@A@@BAB@B@@@BAB@@@@@@@BA@@@@B@@A@@B@@@@@@@B@@@@@@@@@B@@@BA@@B@@AB@@A@@BA@@BA@@@@B@@AB@@@@@B@B@B@BA@@@@BA@@@CBCBEBABABAB@BAB@B@B@BBBAD@@@BAF@@@BC@@@@B@B@BBDBD@@BBBD@@@BA@@@AD@BA@@BABABAB@@@@A@@@@B@@B@@B@B@@A@@B@BBBAB@BBB@@@@@@BB@@DBBB@DBDBB@D@B@BBBBBBBDD@B@B@BBB@@D@@BB@@BB@@@B@@ADAFADAFAJCFAF@B@D@BBB@B@DD@B@@@B@@@BBBBDBDBD@DB@D@F@DBHHHHBBHDJBBDFFHLJFFBB@D@D@FAFAFCFAFAFABAD@FB@@@@BB@@@B@@B@B@DAB@@AB@@C@ABA@AB@B@BAB@@A@@AA@A@A@A@AAAA@A@@@A@A@AAAAB@D@D@[OUCg@QMACIQGeHWBAHCZOBA|OZGJ@hBd@DLFZKL@BQNKXJZ`D@BBB@B@BFBBB@BBB@B@B@B@BBFBB@B@D@@@B@B@DD@@@BBB@BB@D@@B@AB@BA@CBA@@DA@A@@@A@ABA@A@AB@B@@AB@@AB@FAB@B@B@BBB@BBB@@@B@B@DAB@B@BBBBB@BB@@BBBBBDDDB@BD@BBF@B@BAD@B@D@@@DABABA@@BA@ABADA@ABA@CAAAA@@@A@A@@@CBA@ABA@@@@B@B@B@@BB@BDDB@BB@@BBBB@@@@BBB@B@@@@@@B@@@BBD@B@@BB@@DAD@BAB@@ABBB@@BB@@@B@@@BA@@@DB@@B@@AB@@@@@BCB@B@B@BAB@B@@CB@@@B@@ABCBABC@@@ABA@AB@BA@E@@@C@GF@B@@ABABABABA@@BABCBABAB@BAB@BAB@@B@BB@@@@B@BB@@B@@B@@@@B@@BB@D@B@B@B@B@@A@@F@@@@B@@B@B@@@B@BAB@BAB@BBB@BBB@B@@@BABA@@B@@@@@B@DDDCDEDEBA@@B@B@B@@@B@@@B@@@B@B@@@@B@@@B@@B@@@DAB@@@BBB@@ABB@@@B@@@@B@@@B@@@B@@@DBB@BB@B@@@BAB@BB@@BB@B@D@@@B@@@BB@B@@@BBB@DABBB@@@@BBABB@BBBBBBB@@@B@BDB@HBB@B@B@B@DABBDBBBB@DB@@@@BBA@@BCDAB@DA@@BA@@@BB@BBBB@FD@@@B@@@BCDA@@B@B@B@BA@CBCBAB@@CB@@@@@B@BBB@@@BABADA@@@CBA@AB@BCB@BA@@B@@@@BD@@@DBB@@DBFD@@B@BBB@@BFBDDFDB@@BB@@B@D@BDF@@@@@B@BB@@@BBB@@@B@@BD@@B@@@BBBBBFBDBDBB@D@LC@@L@B@BAB@DA@@B@BB@@B@BABAB@B@@@B@B@B@F@@@DABAB@@BB@DBBBBBBB@@B@ABB@BB@BDB@@@@@B@@@B@B@D@@@B@@@BB@B@@@B@B@D@@BBBB@B@@BB@DD@@BAB@BAB@B@@@BBBAD@BA@@B@BB@@B@@@B@@@BBB@B@@@FA@@F@B@D@@@B@H@DBBBB@@@BB@B@AD@BAB@FB@@DBB@B@@@FBB@DDB@B@B@@@D@D@@@D@@@B@@B@@@B@@B@@@@@DA@@B@BA@@H@B@B@@AB@@@@B@@D@BBB@BBDBB@@@DABA@@@@BBB@@@D@@@BBBDBB@@@@B@@@BABABA@@@A@AAA@A@A@AB@BEBA@A@@BA@@@@B@B@B@B@D@B@B@H@@BB@B@BB@@B@BB@@B@@BHAD@D@B@BAB@B@DB@@BB@@BBB@DD@@B@FAB@B@B@BBB@B@D@B@B@@@B@B@@@BA@@B@BB@@BALAJCB@DABA@@@@B@@BB@@B@B@B@BB@@BB@D@BAD@J@@@B@B@BA@AB@B@@A@@D@D@B@BBD@B@D@BAF@BAB@@@@A@@AC@AAA@CAAAA@C@A@ABA@ADCBAB@@@BAB@F@DBJ@D@D@B@D@DBB@BBB@@B@@@B@B@BAD@D@D@F@@DCBA@@D@@@BAB@B@D@B@B@DC@@B@BBB@@@BABA@AB@BAB@B@@@B@BD@@B@B@@@B@@BAB@B@BB@@BBB@@A@AD@B@@@@@@@@@@B@B@@@B@@@B@@@@@B@B@@@F@@@BBB@@@@@@@B@BB@@@@DDBBBB@@@@B@@B@@@@B@@@B@@B@@B@@BB@@@B@@B@@@@B@@@@@@@@BB@@@B@@B@@B@@@@@@@BB@@@@B@@@@@B@@@@@B@@@@@B@BA@@@@@@B@B@@@@@B@BA@@F@@A@@B@@@@@B@@@@@@@D@@A@@@@FAB@@@BA@A@@A@C@A@@A@@AA@A@A@@A@@@AA@A@AAA@@AA@AA@AA@@@@CECAAACC@@AA@ACAAAA@@ACCAA@@A@@@AAAC@@@A@C@A@AAAAA@@BA@@DAD@DABAB@BABA@AB@B@HABAB@@@B@BDBBBB@@FBB@@@B@DAB@@@@@B@BDBBBB@@@AB@B@@AF@B@DA@@@A@A@C@@@A@@B@B@BBB@B@DBB@BBBB@@BBB@@@DAB@F@B@DABCFC@@B@@@BB@BBB@@BBB@BB@@B@BA@@B@B@@@@@BB@BDBBBB@B@@@D@@@B@@D@B@@BAF@B@DA@@B@@@@@BB@@@@BCB@@@B@@@BBBB@@B@@@@@BA@C@ABA@A@A@@@A@G@E@C@A@AAC@@@A@@B@BAD@BA@A@@@A@@@@H@BBBAB@@@@@DBB@@@BAB@BC@AB@@@B@@@BB@@@@B@BA@@@C@AB@B@B@@A@@@@AAAA@A@AB@@@@AB@B@B@F@B@@@@A@@@A@AB@B@B@@A@@AA@AAA@@@A@A@A@A@AA@@@@@@A@AB@@C@G@C@AAA@@A@@@AA@@@A@@@ADA@A@@B@BAB@@@@AAC@@BA@@B@BA@@@A@AB@BABA@@@AB@AA@A@AB@@C@A@AB@@@BAB@@@@A@@B@B@@@@ABADABA@@BA@AH@D@@@D@BBDBB@BBBBB@BAB@DABA@@B@@BDBBBB@@@B@B@DA@@D@B@B@HBB@B@BB@@B@@@B@B@BAB@@@B@B@FBD@@@B@@BD@@@DBA@@@AB@@B@BB@@AB@@BB@F@@@BB@BBB@BB@B@@@B@@BBB@B@@@@B@@@@@B@@D@B@@BBB@@@B@@BB@@B@B@@@BD@B@@@BBD@@B@BBBD@@@DBB@D@@A@@B@B@BADABA@@B@@@@@@BBB@@BD@@BBA@@B@@A@@BABAB@B@B@B@@ADA@A@@@ADAB@@@@BB@@B@B@BB@@@@@FC@@@A@@@A@@B@@@B@@@@A@@BA@@@@DABADCB@@@@@B@@A@@B@@@B@B@B@@@@AB@B@@@B@@AB@BABAA@@@B@@@BAD@B@@CBA@@FA@@B@@@BBDFBB@B@@B@B@@@D@@BB@BDB@BBBB@@B@@@@BB@@BBB@BBBBB@@@B@BAB@@@BB@BDBB@BB@@DDB@B@@@D@BAD@@A@@@A@AA@@@BAB@@AD@@A@A@@@@@AB@@@@@@@B@@@B@B@@@B@@A@ABA@@BA@ABC@AB@@CFAB@@ABAD@@@BA@A@@A@@AA@@ABA@@A@@AAA@@@@@A@@@AAA@A@AAC@AAAACA@@A@@@@BABBFAF@D@F@FAD@B@BA@@@@AACA@@@A@A@@@A@AB@B@@@F@B@D@H@B@@@@A@@@AB@B@DCBEF@BABA@A@AB@B@B@@AB@@A@@@@B@B@@A@A@A@AAA@AA@A@@ABA@AA@BAB@@@@ABA@A@@@A@A@A@@@AAAAAAA@@@C@A@AA@@@@@@IDCBA@ABAB@B@B@H@B@@ABEBCBA@A@@AA@ACA@CAC@@A@@@C@@@AA@@@A@A@@@AA@@@ADC@A@@@@@@AA@@GCA@C@A@AA@C@A@@A@G@C@A@CBAB@@A@AACACA@A@@@A@ADCBAB@@A@@@@A@EAE@CAC@EACAA@C@AAC@@@ABAB@@A@@@AAAAAA@@AA@AA@AAA@AAAAAC@E@@A@A@AAA@@BA@@@AB@@AD@@GDCBABAB@B@B@B@B@@ABAB@@CD@@A@@@A@A@@BA@@@ABABA@@B@@BB@@BB@BAB@BBB@BB@@@A@BBA@AB@B@B@D@F@H@F@@@@BB@B@B@BAD@DA@@BC@CBA@E@AAA@CBAAA@A@@AA@AA@@A@@C@A@@AACC@@@A@A@C@@@AACA@@@@BA@@BAB@@@@A@A@C@@@ABAAA@@@@@ABA@GBCB@@@D@BADAF@B@@B@@@B@B@@@@@@B@@B@@@B@@B@@BBBFBB@B@B@D@D@HBB@BBB@@@D@D@D@D@B@@F@TFF@NDPD@@JBDBJBB@@@B@@@B@BBDAF@HBD@B@D@F@JBL@F@F@D@H@T@R@L@JAB@JAF@BABABABCBABADA@@@A@@A@BC@A@A@AB@@A@@D@@@B@@A@@@@B@@@BB@@B@@@BA@A@@D@B@BABA@@@@BAB@BABA@A@@B@BABAB@@@B@B@@@B@@@@@@BB@@@@@B@@@@@BC@@BABA@@D@@@B@@@@A@@B@@@BA@A@@B@@@B@@@B@B@@@BAB@B@B@@@B@B@@@@BB@@@@ABA@@B@B@B@B@BADAB@@A@AB@@@@@B@@@@D@@@B@@@@@ABA@@B@@@BB@@B@@@@@B@@@@B@@B@B@@@B@@@BA@@@@@A@@B@@@BB@@B@@@@DB@B@BB@@B@@@@@BB@@B@@B@B@@@@BAB@B@BBB@BB@@D@@@@B@@@@B@@@B@@@B@BBJAD@B@DBBBD@LDF@B@L@JAA@CAAA@@AC@A@@@ABC@AA@A@ACAA@@@A@@@A@@@@AAA@@A@@@A@@E@A@@CGI@@@AFM@CB@BAFABAB@@@@@@@B@@@@@B@@ABAB@BA@@@CBC@@@@B@@@B@@@B@BC@@@AB@@AB@@@@@BB@@@@B@BC@AB@B@@@B@@@@@@A@EBC@ADC@A@C@A@@@@BABBB@@@BA@ABCFG@A@@@A@AAA@AAA@A@AB@@A@@BA@A@CB@BAB@BA@@BA@@BA@CB@@C@@@ABADABCB@BA@AB@@@BAB@@@B@BCDA@@B@@AB@@@B@@@@@F@@AD@@AB@F@B@BAB@@@B@B@B@B@@@@AB@A@@AAA@@@AA@@AA@@@AAA@AA@@@A@@AA@A@@A@@@A@@@A@@@@A@AA@@@A@@@A@@@@A@@@A@@AA@@AA@AA@@@A@A@@A@@A@@AAA@A@@@AB@BABA@A@A@@B@@@B@@@@@@A@@BA@@AA@@@@BA@@@C@@B@B@@@BABA@@@ABA@ABABABABA@A@@@@D@BABABAD@B@@A@AB@BAJ@HA@@@A@AB@@@BAB@@AB@@@@@BB@@BB@@B@@@D@B@B@D@B@@@BA@@@A@A@A@ABAB@@@B@B@B@@@B@B@@BBBB@@BB@DBBAB@@A@@DAB@BCB@@A@@@A@ABCBABA@ABE@ABA@ABA@C@A@CBA@AA@@AA@A@@A@@@@D@F@B@BBBBDB@@D@DAD@BAB@D@B@DCDA@@@ABA@@@AB@D@B@BBB@D@@@BAFAD@B@@@BAAA@EAA@A@@B@@@@AA@@@@A@@B@AA@A@@BA@A@AAA@@AABA@@@@BAB@@AB@@A@@@@BA@A@@@A@@@@AA@A@@@A@@@A@@@@BAB@@ABA@A@@@C@A@A@@BA@A@A@@@ACACAA@@@@AB@ACBA@@@@BBB@B@@@DAB@@@B@@AB@@ABAB@@A@@A@AAAA@@@@AE@G@A@@@@DABAJ@@AB@@@BA@@@A@@BA@@B@@@@@BA@@@A@C@@@@@A@@B@D@@AB@B@BA@A@@FADABA@@@ABA@@@AB@@A@@@A@@BABA@@AAAB@A@@@@@AB@D@B@@@BA@@@@BABA@AB@@@@@B@B@@@@@@A@@BABAB@@@BA@@B@B@@@@@@@B@@@@@B@@AB@@@@@@@@A@AAA@A@A@A@CBAAA@A@AAAACAAACACAAAC@A@A@AAAAE@ACCAC@ACC@A@C@CA@@@@A@CAC@@@A@CA@A@@AECA@AAEA@AE@CA@@CA@@AC@@@AAAA@@@A@AACA@@A@@@@BA@A@EAGAKAA@@B@@AB@@@@@@C@EAC@E@GAA@A@C@AJKC@@C@I@EBE@@A@@AA@@@A@A@@@@@G@A@@@@@A@E@A@C@A@EAA@C@E@G@A@@B@@@B@B@@A@E@AB@B@F@F@@@@A@G@I@E@ACA@A@A@@@ABA@A@AA@@@QEKC@A@A@CAO@A@E@@@C@CB@@C@@FCDADCB@DCD@@@@A@@@ACCACTO@@HEFALGJGFAHE@@@I@I@@AAAAAA@@@AAAA@@A@AAA@AAC@A@@AA@AAAB@@AD@@AJEB@@@AA@CB@D@B@BABABAB@@@BAB@BADCB@BAFCB@@@@A@@BA@AB@BA@@BC@@BC@A@@@A@@AAB@@AB@@@@A@A@A@@BA@@@A@@B@@@@@BABC@A@A@@@A@@BA@A@@BA@C@@@A@@@@AA@@@@BA@@B@@A@@@@AA@@@AAA@C@A@@A@@A@@@@@A@@@@AA@A@A@@@@CACAA@CE@@AAA@AAA@@AA@A@AA@@@@@A@ABC@A@CB@BC@A@ABA@C@@BAB@BADC@@@A@@CCAC@A@@C@A@AACA@@A@@B@@@@A@C@@AA@@@@@A@@AAAA@AAA@@@@@BA@@AA@@@AA@A@@AAA@@@A@@@@CA@@A@@@@A@@@A@@@@@A@A@@@@BAB@@A@@AA@@B@@@B@B@B@@AAA@@A@AA@AA@@@A@@@A@@AA@@@@A@@@A@A@A@@@A@@A@AA@@@@@ABC@@@@@AA@AA@@@A@AB@@A@AAA@A@@AAAAC@A@A@@@A@A@A@AA@@AAA@ACAAAA@@@AAAB@@@BAB@FA@ABA@@B@@@@A@@@@A@@CA@AA@A@@FA@@@A@@DA@@AA@@B@D@@A@@@@A@ACA@@AC@AA@@AACA@@C@@@AA@@@A@@BA@@A@@@@A@A@@@A@@@AA@@@@@AAACAACA@@FG@@@A@CAA@C@ACC@A@ACCCC@AA@@A@@A@@@@@A@@@ABABA@@@A@CAA@@@CBABA@@@E@A@@BED@@@AA@AA@@CB@@A@AACCA@AA@@A@@A@@A@@BA@AA@@A@@AA@A@@@@@AA@AA@@@CD@@@@AA@@A@A@@A@@@@AA@@A@@@CB@BA@@@A@A@AA@@A@@@@@A@@@A@CA@AA@@@CCA@ABC@@@@A@@@@BC@@@@@@A@@A@@@@BA@@@@@AA@@@@ABA@AA@@AB@@ABC@A@@BA@@D@B@@@B@B@B@BAB@@@DB@@@@BA@@@ABA@@@A@@AA@@CACAA@@@A@A@AB@@A@@@@A@@AAA@@@@@@A@A@@@A@A@@DC@A@ABE@A@A@@BA@@@@@@@@CAE@A@CAC@A@A@E@@@A@A@AAAAA@A@BA@@@@A@@@A@@@BA@@@@A@@A@@@@@@@@AA@@B@@A@A@@@@@AA@@@@@@@BC@@@A@@A@AA@@@@BA@@@@A@@A@@@A@@A@@A@@@@B@@AA@@@@@@@@A@@@@@@@A@@@@B@@A@@A@@A@@@@AA@@A@A@AD@@E@G@@@@AA@E@@BC@AA@@ABA@ABA@@B@@EACAA@A@CBC@C@C@A@C@AA@@AAB@@A@@@C@@AA@A@A@C@@@A@@BAHAD@BA@@BAB@@@AAC@AAAAG@AB@@C@@@CB@@A@@@AACAA@A@A@@@CBABC@ABEB@@@@GCA@E@A@A@ABA@A@A@C@EAA@CB@@ABA@@BA@C@@@AAC@E@CAA@A@CA@AA@CA@@A@A@CAA@A@C@C@A@AAA@AA@@CA@ACAA@EA@BA@ABA@AACA@@C@A@@@AAA@C@A@@@A@A@A@AA@@C@C@@@AAC@A@@AA@@A@CA@@@AA@B@@AB@@C@ABEDADA@@B@B@BBBBB@B@B@B@@@BC@ABA@A@@BCD@@B@@BBB@@AF@BA@@BA@CAA@AAAB@@"],encodeOffsets:[[105905,37093]]}},{type:"Feature",id:"620123",properties:{name:"榆中县",cp:[104.112527,35.843056],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BGBAB@AA@A@@@CBCBC@E@@@AB@@EFABADAB@@CBGDA@@@@@@F@D@FA@@@ABA@@@CDADAB@B@B@@A@E@A@CBCBA@@@CCA@A@CA@@C@EFABABADCBCB@BA@ADADCD@BABAB@D@@A@A@CBCBA@C@A@@AEA@@CBE@C@CBA@CBCBEDABAB@@C@@B@@@BA@@BABCDC@ABI@C@E@EBEDC@CBC@CBA@ABA@AH@FABADABC@CBA@ABCDCHA@CBCB@@C@@@EBCBC@C@ABA@ABABCD@B@BAB@BKLEDA@A@ED@@A@A@CAA@AACCA@ACEEAAAAAA@AA@@C@A@A@@@@AA@@A@AAC@@BB@@@@B@B@@A@@B@@@@AAA@@@@@@@@@@@@@@BB@@B@@@@@@BB@B@@@@@@@@BB@B@@A@@B@D@B@@@B@B@B@B@BBB@B@B@B@D@D@BA@@D@BB@ABABAB@@@D@B@BAB@@CBA@C@@@CD@@@@AB@B@@A@@A@@AB@@@@BD@BB@@BBB@B@@B@BBBD@@@@@@@@AB@B@BA@@@ABAB@@AB@B@@@B@@@B@F@BABA@A@ABCBABA@@@A@A@BB@@BBB@B@@@D@@@DB@@BD@D@B@B@@BBBD@B@@@B@@BBAB@@@@@BB@@BA@B@@@BB@@H@@B@@@@@@AB@B@B@@FD@BFB@BDBB@@@D@@@B@B@@@BAB@DA@@@ABC@A@ABCBADA@@BA@@B@BA@@BC@@@@FB@@@@DA@@DABAB@B@B@B@DAD@@@DC@@B@B@JBBB@AD@B@DA@AB@BAB@B@DBD@BBDB@@BAB@@@BB@@@B@B@@@DBD@@@DAB@B@D@B@FABAD@B@B@@@BA@AB@@@D@BA@@BA@@B@D@D@@C@@@AB@BCDAB@B@@ABBB@D@@BBBD@@BBBB@B@@@@@J@BBB@@BBBDBB@BB@B@@BB@@@@@CD@BABA@@@AB@BCB@@@BA@ABA@EBA@E@@B@@@@AB@B@BABABA@AB@BAB@@CDAB@@A@@BAAA@C@A@A@A@AB@@AB@BADB@@B@BBB@BBB@DBB@BB@AB@@ABA@@DA@@@@D@BBF@BBB@BBBB@B@@@@@@@@F@@BBB@@@@B@B@@CB@@@BBF@B@F@B@@BDBBB@@BB@@@@@@@@@ABB@@B@@@@@@@B@@B@@@A@B@@@@@@@@B@@@@@@B@@B@@@@@@@@@BB@@@@B@@BB@BBBA@@@BB@@@@@@@@@BBB@@@@@@@BB@A@B@@@@@@B@@@@@BD@@@B@@@DA@@B@B@B@B@BB@B@@A@ABAB@@@B@@@BBD@@B@@@BAB@@B@F@@@@@@BBB@@@BB@@@B@B@BA@@B@@B@B@@AB@@D@@@@B@D@@@BDBBB@@BDD@BB@BD@@@@@BB@B@BBDBB@@@BBBBB@@@@BB@BBB@BBB@BBDBFDB@B@@BD@@@@@BA@AB@BA@@BB@@@D@@@B@B@B@DA@AD@B@@A@@B@@@D@@@@AB@@ABA@A@A@A@ABAB@@@B@@@BB@@@D@B@D@D@DBB@B@B@B@HCB@BAB@D@DBDBD@@@FBDBB@FBJ@B@@@@@BB@@CD@BABBB@@@B@@B@@B@@@@AB@@@B@@@D@B@@@B@@B@@B@@@B@@B@@DBD@@AB@@@BA@BBBF@@@BAD@@@B@@@BB@DBBDB@BD@BBDBB@@A@AB@@CD@@@BBAB@@@B@BBBB@BB@B@BBF@B@DBB@@@BB@@BBB@B@@@@B@B@@@BB@@@BA@@@@@@BAB@@@B@BCBA@@B@@AAA@@@A@@B@B@@@F@DB@@BA@@@A@A@A@@@@AAA@@AAA@@@AB@BA@@@ADA@A@@BA@@@@B@@AB@@@D@B@B@BBB@B@B@@@@B@@@@B@@@@@@@B@@@@@@B@@@@B@@@@@B@@BB@@@@BBA@BB@@@@@B@@@@@@@B@@@@@BBB@@@FBBBBB@@BB@@B@@@B@BB@@@DBB@DBB@@B@B@B@B@@AB@@AB@BBDBD@DBB@@@FBB@@@BB@@BB@BB@BBB@@A@@DB@@BBB@@@B@@@D@D@D@DBDB@@B@@AD@@AB@@@D@@@BABADAB@@@@@@@BB@B@@BB@@DC@@@@B@F@B@BAB@@A@@C@@AAA@@@@AAB@@A@@@C@A@@@@B@@B@BB@@A@@BA@@B@FBB@B@@@@@@@B@@A@@BA@@@A@@@AB@@@BAB@@@B@F@@@BA@@F@@@@@DBB@BBB@@@BBB@@@B@@@BA@@BAB@BBB@@@@@AB@B@@BB@@BD@B@DB@@B@@BDBD@BB@@B@@@BAB@@@B@@@BBB@@@DBD@B@@@@@@B@@@B@D@@AB@@@@@@A@@@A@A@@@@@A@@@A@@@A@@@@@A@@@@@@A@@A@@@@@A@@@A@@BB@@BBBBB@BBB@BBB@BBB@@B@@B@AB@B@BAB@BAB@@BD@@BDBB@@@@B@BB@@BDD@@@@B@@@@@@B@@BD@@@@B@@@@@@@B@@B@@@B@@AD@B@@AB@B@@@D@@@@@@@BB@@@@@@@@@BD@DB@B@@BBB@@B@@@BB@@@B@BD@@AB@@B@@BB@BB@@@B@@@@BB@@@@B@@B@@@@BBB@@@@B@@@@@@BB@@D@@B@@BABABA@AB@BABABA@@@@B@@@@B@BB@@B@@@@@B@@@BB@@@@BA@BB@@AB@@BBA@@@B@@B@BBB@@BB@B@@@@B@@B@B@@BBABB@@B@@BB@B@@@@@BB@@@B@@@@@@@BBBB@BBBBB@D@BCF@@@@CBAB@B@B@@BA@@@@@@DA@AB@@@B@BB@@@@@D@@AB@@@BB@@@B@@@B@@@@B@@BBB@DB@@@B@@@B@BBB@@B@B@B@B@@@B@@@B@B@@@B@@@BB@@@BBB@BA@CFA@@BABAB@B@B@BAB@D@@@@A@C@A@A@A@@@C@A@E@A@AAA@@@BH@D@B@@AB@@@@A@A@C@A@@BA@@BA@@B@@ABAB@BA@@@@@@@AAA@CA@@A@@@@BAB@BAB@BAB@@@BABAB@B@BA@CB@BADADAB@BAF@FA@@BA@AB@B@B@DBFB@@F@DBDBBAB@B@B@B@@@@A@CAECGCA@A@A@@@@@BBB@@@@@@B@@BB@@A@@@C@@AI@C@@@AA@@@@A@CBC@CB@@A@@AA@@C@C@@@A@A@A@@@A@A@@AAAAEAA@A@C@A@@@A@ABCBA@CBC@EBC@A@BD@@BBDBBBBB@BA@@BA@@B@BAB@BAB@BBB@@@B@DBB@@BBBFBFBD@B@B@BAD@BBB@BBDBBBD@BBD@B@@D@FBFBDDDBHDBBB@@@D@BB@@BB@B@@BB@B@@@B@H@DBL@B@@AB@BB@@B@@BBB@FBFBDBDB@BDDBB@@@BBDBD@BDDBD@@BB@@H@B@D@@@BA@ABE@@BA@@BAB@BABBB@D@BBD@HAB@D@D@D@D@FBBB@@BB@B@@@B@@@BABEDAB@B@@@D@@@BBB@BDBDDDBFBB@F@D@F@B@D@FBDBNBBBD@@@FBBBB@BAB@D@JABABAFAFADAFAF@H@JAF@DBB@DBDBBBDBJFBBB@@@DBBB@BBD@B@BBF@B@B@BBB@BBBBBH@D@B@D@FAHAB@BB@@DAB@@AB@@@B@BAB@B@@@B@@@B@@@D@BBB@BBDBB@B@D@D@B@D@DBB@HBFBD@DBD@@@DBFBDBDAB@FBB@B@BBB@@@H@BBB@B@BBB@BDFA@@B@@@@@@BB@@@@@B@@@@@@@@@BBB@@@@@@A@@@@@B@@@@B@@@@@@@B@B@B@@@@@@@B@B@BA@@@ADB@A@@@@BA@@@@@@@A@@B@@@@@B@@AD@B@BB@@@@B@@@@A@@@@BB@@@@BB@@BBDDBFB@DBFBBB@BB@@BA@@@@B@@@@A@@@@B@@@B@B@@@@AB@B@@@@@B@@@B@@AB@@@@@BB@BBDBPFB@BB@@B@B@B@B@@@@@@D@@@B@@BBD@BB@@BB@D@B@B@B@@@B@@B@B@B@@@BBB@BBB@HBB@BBFBB@@@BBBD@B@DBB@@BDDBBBBBB@F@@@B@@AB@@ABA@@@ABAB@B@B@@@B@BCB@@ABADADA@@B@@A@A@@@AB@B@@A@@AAA@@@A@@@@A@@BABA@A@@BA@@@A@A@C@@BABA@@@AAA@A@@BC@@@@@@@ABA@@@AA@CE@@BA@ABA@@@@@A@@AAAA@A@A@A@AAA@@@A@@B@B@@@B@@AB@@CA@@AA@@@@ABA@CB@@@@A@A@@@AA@@AAA@@@AB@@@BC@ABA@@AAA@AA@@@@BABA@@@ACAAA@A@@ACAA@@BA@@@@@A@@@@AA@@B@@AA@BA@@A@@CAA@AAAAAB@@@BA@@@AAAAA@@A@@A@@@@@@@A@A@A@A@@BA@C@@@A@EBA@ABA@@@A@A@@@A@ABA@ABA@@@@@A@@CC@@@@BE@AA@@@AA@@@CAA@A@C@GBA@A@A@AB@BADA@@BA@A@A@@@A@@@AB@BA@@@A@A@@BA@@@A@AB@B@@A@@BA@@BABABA@A@A@A@@@@@@@@@A@A@A@@@@B@@@@ABC@A@@AA@A@A@A@@BA@@@AA@@A@@@A@A@ABA@@BA@@@@@ABA@@B@@ABA@@@AAC@@@@BAB@@@B@DB@@B@@@B@@@@@B@BABAB@@E@A@@@A@ADABA@A@@B@@@DBB@B@@@BA@@BA@A@@@A@@@@CA@@A@AA@@A@@A@@B@BA@A@A@ABAB@@@BEB@A@@AAA@A@@@GAA@AA@AA@@A@@@AACCCCAACCA@@@A@@@AACCA@@@IAA@AAAA@@A@A@AAA@@A@@ACBABGAA@A@@@ABABABABCBABA@@BC@ABAFEBC@A@ABC@ABCBABABE@@@ABC@CBC@@@AB@@@BB@@B@@BB@B@D@B@BB@@DBHB@@BA@@B@@@@@D@D@B@DA@@BBB@@@D@B@@@BA@@@ADAB@F@L@D@BBB@@@DA@ACA@@@AAA@@ACCA@@@A@@CABEBEAC@AAG@A@A@E@EAA@@@AGCCAAACCAA@A@A@CBA@A@@B@BA@@@C@ABA@@BAB@BCBE@A@A@@@@A@AA@AAAA@@@@@@A@A@@@@@A@A@A@@DE@@@@BC@@@@@A@AAAAACA@@@A@@@@@AA@@@A@C@AA@@@@@AC@@AE@C@@@AA@@@@@@EC@@A@@A@AA@C@@@A@@AA@ABA@@@@@AACA@C@A@C@A@ABA@A@@CAAAA@@A@AA@A@@@@AAAAAEA@@@A@AA@CCAA@@AA@@AB@@AA@@@A@@A@@@@@AA@@@AB@@A@@B@@ABC@CBCBA@CBC@@@A@@AA@@AAE@GAEAC@AAAA@@ACAA@AAGAA@AAA@@CA@A@@BEAA@AABA@@@A@A@ABA@@@A@ABA@A@C@AAA@@@@@A@@@@@@@@AA@@AA@AAAC@@@@@ECE@A@@@A@@B@BAB@@A@E@@AA@A@@BA@A@E@C@EA@@@AAAB@@CAAAAAAA@A@@AAA@@@A@A@ABCB@BAB@@@@C@AAA@@AA@@A@@A@ABA@@A@A@C@ACA@A@@AA@A@@@@A@A@A@ABABA@@@A@@@ACA@@AE@@@AA@AA@@@A@AA@A@@@ABA@CAA@C@@@C@A@A@AAA@A@A@A@CAA@@AAA@@@ABADA@@@@@@AAA@@AA@@AC@C@C@@B@@A@@@@BC@A@ABA@AB@AAAC@C@@@C@C@@@ACA@@AAAA@A@@BCAC@@ACAAA@@C@A@@AA@AB@@A@CBEB@BADABAD@@ADA@ADABA@@@ABC@@BABABCBA@@BA@@AA@@@A@@@AACACA@@A@A@A@@BABAB@@A@@A@@A@@@@BAB@@A@@BABADC@@@@@A@@A@CEAAEC@AAC@@AA@@A@@@A@CBA@@BA@C@C@@@@AA@A@CB@@E@A@@@AA@@@A@A@A@@@CAAAAAAAAEC@A@ACAAAAA@@AC@A@@AAB@@AB@BC@C@A@@@CAA@@BA@A@@@AC@@A@@AAAACC@@@C@AAC@C@A@CAEACAAAE@@@@A@A@@@@@@CB@BA@ABG@A@AA@@C@@@CB@@A@A@@@C@@AAAAAA@AAA@A@AAA@AAAA@AA@ACA@AAA@@AA@@ACAAAA@C@@CEAA@@@@@C@@@@@@ABABA@@BA@@@A@@@ABA@C@A@C@C@@@AA@AAAAA@@@@@AACAACAAC@@@@AA@@A@@AAAAA@@@AA@@A@@@A@@@@AABAA@@AB@@ABAA@@A@@BC@A@@@A@A@A@@@@@A@@GAA@A@A@A@A@@@CAA@AAA@AA@A@@@A@A@@A@AAA@AA@@A@@BCBA@A@AAA@@@@AA@@@ACA@A@@AA@@A@@AA@@@A@AB@@A@A@A@@@A@A@@@A@@@@@AA@@@@AA@AA@@@@@A@@A@@@@A@@@@A@@@AACC@@@AAA@@@AA@AA@A@@@A@A@@@@@@@@@@@A@@A@@@B@AAB@@A@A@@@A@@BA@@@ABABABC@@@@@A@A@AA@@@AAAAA@@@@A@@@@@A@AA@@C@@@A@A@A@C@@@AB@AA@A@AA@@@AA@@@@@ABADA@AA@@A@@B@BAD@PAB@@AB@BC@@BC@A@AAAA@@@A@@@A@@@AAAGAA@@@AC@A@AECAA@A@@@A@C@C@CAC@CAAA@@AA@@@@@A@@DA@@@A@AB@BC@C@@CCAAAA@@@A@A@ABCB@@CBC@@B@@AD@@@@A@G@@BA@A@E@CAE@AAC@AAE@A@@@@A@A@@@C@C@A@AAEE@@CAEACA@@AAAA@E@C@@@A@ABA@ABE@@@@AAAAEC@ACCAAA@A@GDC@CBA@A@C@A@CAA@C@A@@@AAA@@@ABA@GFAB@@ABC@CBA@@@A@A@A@CAAAABAB@@A@@@AA@@E@@@ABA@ABABAB@@A@@@AAA@C@A@AB@B@@@@@BB@@@DBBBFB@B@@AD@@ABA@ABA@AAA@A@CAA@A@C@A@A@@BCB@@CDAB@B@@A@C@CAC@AAA@A@ABA@@BAD@B@@@B@@B@BB@BB@@DB@@@@BDBB@BBB@BBBBBBBB@@@B@B@@C@G@A@@@AB@B@B@BAB@B@BADA@@DA@ABA@@B@B@@ABABCDCB@@@BABA@CDAD@@@B@B@BAB@@@@@B@BCBAB@BABA@A@@BABC@@@E@@@AAAA@@AAAACA@@A@C@C@@@@@@@@B@BB@@@@D@@@@@B@BCBAB@@A@A@A@@@AA@@BABC@ABC@AB@@@@A@@AAA@AAAA@AA@AA@@CAA@@@@@A@@@A@@@AAAAA@B@AC@A@A@@@@AAA@@C@@A@AA@ACA@@AA@@@A@A@@@@@@@AAC@@@@A@@@@@C@@@C@AB@@A@@@CDABAD@@ED@@@@AB@@ABA@@@@B@B@@@@A@ABADABEDABA@ABA@A@ABA@AC@AA@@@A@A@A@A@@A@@AB@@@BA@@BABAB@@A@@B@B@@AD@BAB@@@@AB@@@@@D@@AB@B@@AB@D@BA@A@@@A@AB@@ABA@A@@@CBA@A@A@GB@@@B@@AB@@A@A@E@CDA@ABAB@BBF@BAD@@CDEDAD@DABABADABA@"],encodeOffsets:[[106554,36542]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("临夏回族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"622901",properties:{name:"临夏市",cp:[103.243021,35.604376],childNum:1},geometry:{type:"Polygon",coordinates:["@@CA@@AACA@AA@@A@A@AA@@A@@C@A@A@A@A@@@C@A@AAA@C@AA@@@BA@CHCJEJBBDBBBDBFDBD@@@BAB@@A@@@A@@BA@AA@@A@@@A@@@@@CDCA@@EDC@AB@@ABCBGJABA@E@A@AA@@@@@C@AA@@@@@A@@BAD@@A@@@CE@@A@@@@@@B@@@FBD@@BBBBDBDBB@@@B@BBD@B@F@B@B@B@B@B@D@HBFBLBD@B@J@B@H@@@@@@BAD@B@@AB@@@@B@B@B@BBDB@BBBB@BB@@@BA@@B@@B@BA@@@@BBB@BB@@@@@B@@B@B@BB@@@B@@@BB@@AB@@@@BB@@BB@B@@@@@DB@@@@A@@@A@C@@@@B@@D@BB@@@@@@B@B@B@@AB@@B@@@B@B@@@@@@B@@A@@@@B@BBDBDDDBJDB@BBB@B@@B@@@@@@@B@@@@BA@@B@D@LDDB@@B@FBFBBB@@BB@B@B@@LHFD@BDDJJ@DFD@@BB@@@B@D@BBB@@B@@B@@BBBBBB@BDDBB@@FDBBBB@B@@B@BBB@@BDB@BDB@BBB@@BB@@@B@@D@@@@@@BBB@@@@@@@B@@@@B@B@@@@BB@@@@@BBB@@@@BB@@BB@B@ZD@@B@@@DA@AB@B@@ABA@@@@@A@@A@@A@@@@@A@@BA@@BCBA@A@A@A@@@A@@@AA@AA@AA@@A@A@@B@BBBBBBDBB@B@@@DABAJ@BAB@HA@@@@@A@@@@A@C@@ACACC@C@@AA@ACA@A@@@@@A@@B@BAB@@A@ABE@A@@A@AAC@A@A@@@@@@AA@@A@@AAD@@@@A@@@@A@@A@@@@@@B@@A@@AAB@@@@@BAAA@AAA@@@@@ADAFA@A@@C@@@A@@A@A@A@@@AAAC@E@A@AAAAA@@@AAA@CB@@A@@@AA@@A@@A@@@AA@ACA@AA@A@A@@@@A@A@@@@AAA@A@@BA@A@@@@A@@@AA@AA@AA@A@A@ACA@@BC@AA@@A@@A@G@@@EBA@A@A@CBA@A@C@CA@@@AA@EC@@A@A@A@@AC@AA@@ECCAE@@A@@AA@@BA@A@A@@@A@@A@@BAB@@A@A@@A@@@@@AA@@@@B@@@@AA@@A@@B@B@@A@AAAAA@C@@@@A@@@AB@@@@A@@BAB@B@@@@AA@@@@A@@@@A@@BA@@@@A@@@A@@AAA@@@@@A@@@@@@B@BAB@@@BA@@BA@A@@@@@AA@@C@@AA@@@@@BA@@B@@@AA@@@@A@@@@A@A@CBA@A@@@A@@@@@@A@@@@B@BA@AB@@@B@B@B@@@@A@A@@A@AA@@@@@@D@@@@A@@@@@A@@A@@AA@@@A@C@A@@AA@@AB@@@@AB@D@@@BA@@A@@AC@@@@@@A@@B@@@@AB@ACACAA@AAC@@@A@@A@@D@B@BADAFC@@@A@A@@@@A@A@C@ABA@@@A@@@A@@A@@A@A@@@AB@AA@@A@C@@AAA@@A@ABC@A@A@@@C@A@A@@@AA@@B@@ABAD@@@DAD@B@@@@ABC@A@A@A@@@A@@@AA@@AA@AA@@@A@A@A@@@A@@A@@AA@@@A@@@@A@"],encodeOffsets:[[105614,36377]]}},{type:"Feature",id:"622921",properties:{name:"临夏县",cp:[103.039826,35.478722],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAA@A@@@@A@@@@AAA@AA@@@@ABA@@@@AB@@A@@AAA@AA@ACAAAA@A@A@@@@@BA@@@ABC@A@@@@G@A@I@A@C@KAEAGAC@A@A@A@A@A@E@A@C@AAA@@@A@CACAAAAA@@AC@E@@@A@@@@B@@@DF@@B@@@BC@AB@@@@@B@@B@D@@@@BBB@F@B@BAHIDABA@@BAD@FC@@DBDC@@@@B@@@B@@@BBB@@AB@@@B@@@BA@A@@ACECCAAACAAAFIDIDGB@@A@@BBD@B@BBB@D@@@B@B@B@B@D@@@@BB@@B@B@BB@@BDBBB@@DBB@@@@@@B@@BB@@@BB@@@B@B@B@@@B@@BBB@@BB@@B@@@B@B@B@D@BA@@@@@ABC@C@@BCBA@@@AB@@B@@@B@B@D@@@B@BAD@B@BB@BB@@@D@BB@@BBA@@B@B@@@@BB@@@B@@@B@BAD@B@B@@@@@@B@B@@EDCBABA@C@@@@BB@@@D@BBB@DBDB@BBA@@@@@AB@@@@@@@BD@@@BB@@A@@@CBA@@@@BA@@BB@@@B@D@B@@BB@@@BB@@@@@B@@@@@@C@@@@B@@B@BB@B@@@@@@A@A@A@@BAB@@A@A@@B@@@@@@@@B@@@BAB@D@B@B@@B@@@@@BB@@A@@@AB@@@@B@@BD@@@BB@@@@B@B@@AB@@A@@BA@A@A@@@@B@@@@@B@BB@@@B@@@B@@B@@AB@@@@@@B@@B@@B@@A@A@AB@@@B@@A@@B@@@B@@D@B@BBBBB@@@@A@AB@@@BB@@@@@A@@B@@B@@@@@BB@B@@@BA@AB@@@@B@@@B@BAB@@BB@@@BF@DBFD@@BBD@@BB@B@B@@@FDB@@B@@DBD@B@B@DAB@B@B@FA@@H@B@@@@BB@@BAD@@DB@B@B@BBBB@@BBB@@B@@@@@@BAB@@@BBB@B@@B@B@@@@@@B@BBBB@BDB@@B@@@BB@@@BB@@B@@@DAB@BB@@B@BBBBB@F@D@BBD@D@BA@A@@@ABABA@A@@A@A@@A@@@A@A@@@@@@B@@C@@@A@A@CB@@AB@BA@@AC@@@AB@B@@@@@@A@A@@@ABC@AB@@@AC@AAAAA@@A@@@C@A@AA@@AC@@@AA@@AB@@AB@DADA@@B@B@@B@BBBB@BD@BD@@BB@@ABA@@BB@@DABABABABAB@BA@@@A@AEAAAAAA@AAA@@A@A@C@@@@@AEAAAAAAAAAAE@AACAAAA@@@CBA@@@AA@CCAC@@@A@@@@FIBA@@@@B@FAF@FBD@BAB@@@B@@A@@AC@A@ABEA@@AAA@@AACAA@A@A@@@A@AA@AAAAACAAAEAA@@@CCA@@@A@@@A@AB@@@@C@AB@@@@ABGHADCFADCF@@A@A@GAGCA@AA@@GB@@ABA@@@A@C@C@EACAA@AACA@@AA@CA@AC@AA@AAA@@@A@AB@BEB@@A@@@A@@@CACACAA@AAA@@@A@ABA@A@@AA@A@@AA@@@@@AAAAAA@AAC@@@A@A@@@@@@@@A@@@A@@@@@@@@@A@@@@@A@@@C@ABA@@@A@AB@@A@A@A@@@A@@BC@@@@@@@@@A@@@A@AAA@A@@@AA@@AAAA@@AAAAAA@@AAAAAACA@A@@@@@ADC@C@GAC@@CC@@CAEIA@@A@AAAACA@AA@@@@@A@ABA@@DCFAFAB@DE@@@A@@@A@A@@DC@C@A@@@A@@AAAA@@@A@AAC@@A@@A@@@A@@BA@@@@AAAE@A@C@@AACC@@C@@@@@@A@C@@AA@@@AA@AAA@AA@@@@ACAA@@AACAA@A@AA@AAA@@AAQI@@CACAECAAAA@AAACA@@@A@@F@@AB@BA@@BCBCB@@ABE@EBC@@@C@@@A@A@A@A@A@E@@@@AA@@A@CA@@A@@@@@@@AAA@AA@A@@@@@AAA@@@A@C@AA@ID@@A@@@AA@C@A@@@@A@A@A@CDKJKJEBA@A@C@AAAA@ADEBEDCDC@A@@ACACAACAECMCG@C@EBCBCDCBAB@@ABCAAAA@A@E@A@C@ABC@ABABCB@DABCBCBCBABCDAB@@@@A@C@CA@@C@@BA@A@A@A@@BA@CDABA@@BAFAB@BABGFGDKHEF@@@BAD@DCDAB@BA@@BA@CACAEAGAAAA@A@ICAAC@EDA@A@A@EAECAA@DAD@BADED@BAFCB@F@B@B@B@@CDADABCBEBA@A@ABCDCD@BABAFAD@@@@ABE@A@ABA@CBADA@A@C@E@ABABC@A@A@A@@@@A@@AAAAAAA@AACC@AA@@AAACCAACAAAA@@@A@@@AAGMAAA@A@@BC@CBCBA@@@A@EBEB@@@BA@AB@@CBE@AA@@AB@@@B@BCB@B@D@@ABADGDAB@@ABIBA@@D@@@BBB@@BB@DA@@B@BABA@AB@@EAABABABE@CB@@A@@D@@ADAF@D@B@@ABCDADCFEHADA@A@C@ABC@@BCAC@CBG@GBEBCBA@E@C@A@AAEBGDG@EBABA@AD@@@BA@AAA@A@A@A@A@@BAD@@AB@@EBABA@EBEBCBABCFADADADABB@@@B@JHFFBBBF@@@@BB@B@B@BDBBBB@BBB@B@BB@@BB@@@B@@B@@BB@@BB@DDFB@@B@B@B@B@@@BB@B@@@D@@BB@@B@BB@@BBBB@@@B@@@BAB@@@@@@@B@@@@@@@B@@@B@@@@A@@@@@A@@@@B@BA@A@A@A@A@AA@@@@@@mIGACGAAAACAA@C@C@GBC@CB@@@B@B@DABAD@B@@DDB@LFB@JFDHDBBBFBF@B@R@VHH@F@DADBDDJLDBD@D@LCF@FBBDCPKNQLYNKNEFL@D@D@DAD@B@BADABA@@FABABAB@@@B@B@DA@@B@@A@AB@B@DADAJ@B@B@DABAB@FCB@@@B@BBB@@@BABA@ABAFABA@AB@B@B@DAD@@@@@BA@@@AB@B@FA@@D@@@DEBA@AB@@BB@B@FAB@B@@A@@@A@A@A@@@AB@B@@@B@B@FBB@B@@AB@HCBAD@B@@@B@@@BB@@@B@@@@B@D@B@F@F@FBFAB@BBBAB@@@B@BBB@B@B@B@B@B@B@D@B@B@B@B@B@@B@@@B@@@B@@AB@B@@DDBBDD@BB@B@BB@@D@D@@BDDB@BBD@@BB@B@D@B@D@D@B@D@B@B@D@D@DBD@B@BB@@BBB@@B@@B@BBBB@BBB@BDBBBHDDB@BFB@@@A@A@@BA@@DAB@D@@@@@@@FCBAB@@@B@BBF@@@B@BBB@@B@@@D@@BBBB@BB@BBHBBBB@@@B@@@@ABA@A@@BA@@@@CA@@@A@AB@@B@AB@@@AA@@A@@@AA@@@@BADC@ADC@@@A@ADC@CBA@@B@BAB@@@BAB@@@FBB@D@BBB@B@@BBB@@DHBF@B@@@BABABAB@BBB@@BBBBDDBBDB@@FB@@@@BB@BADBBAFBB@@@@B@B@BA@@@@BBDBDDB@@@BA@@BAB@B@BBDBBBB@@@BAB@@B@B@@BB@@D@B@B@@@B@@@BBBBDDBB@@BB@@BAB@@AB@BABABAB@DA@@B@B@D@F@B@@B@@@@@D@@@BBB@BBB@@B@@@@BA@CB@@@@@B@DBBDF@@@@@@ABCBCB@@AB@BABABAB@B@BA@@B@B@@@@BBB@HAD@FCB@@AB@DEBA@A@@B@@BB@JDBBD@DB@BDDBBD@@B@@@@@B@@@@C@@@@@AB@@@B@DADBB@BBB@B@@@DA@BB@B@@@BDB@@@B@@@B@@@BCD@@@B@B@B@B@D@B@@@BBB@B@TABBB@B@BBHAB@@BB@BBB@B@D@FBH@H@B@B@DBF@D@@@B@@ABA@@B@@@BAJ@B@B@B@BABA@@@A@@@C@@@CB@@AB@@@B@B@B@FAH@JAN@H@B@BA@DBLFDBDDNDNFHDHBJDJFJFBBDDBBNNHHLMDEDAHIHEHIBADADABADABABABABC@ABABADA@@@@BA@A@@@@@@B@BBB@@@@A@@BA@AA@@@A@AA@@@@BABA@@@A@AA@@A@@BA@@@@B@B@B@@@@ABA@@@@@@D@BAB@BABAD@DA@@B@BABA@@@@@AB@@A@@@A@A@@AA@@@A@@B@DAB@@@BA@ABAB@DAB@BA@A@@CC@@@AB@B@@@@A@@@@BC@AB@BA@@BABAB@BBB@BAB@B@B@B@BADA@@B@B@B@@@BA@A@ACC@@@A@CBAB@BCB@BAFA@@@A@@@A@AB@@@@@B@B@B@B@B@D@@@B@BAB@B@B@BAFCDABABA@@AC@@@A@@BAB@@@BA@ABAB@B@@E@A@AA@@AACA@CAAA@@@A@A@A@A@A@@@AAA@G@A@@@@A@A@@@AA@@@AA@@AB@@@AAAA@BAA@@@@@AAA@@@AAAA@@@AA@@@@@A@@AAAA@@@CYCA@A@@AA@@A@@A@AA@@@@A@@A@@A@A@@@@@@A@@@@@@AA@A@@@@C@@@@A@@AA@@AA@ACA@ACA@AA@AAA@@@@AAAAAEC@@AACC@AAAAAAA@@@AA@@@AA@A@C@A@@AA@@EC@CIICC@AECKG@@@A@AAA@@AAEAEAA@@@CAKCC@A@@@AB@@@@@A@@@@@@@AA@A@AAA@ICCACCCAAAA@@@@@@BA@@@@@@@@A@A@@@AA@@BA@A@A@@@@@@@AAC@@@@A@@D@B@@@B@@@@@CA@@@@A@A@@AA@@A@@A@@BA@@A@@@A@@"],encodeOffsets:[[105620,36429]]}},{type:"Feature",id:"622924",properties:{name:"广河县",cp:[103.575834,35.488051],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@AAACA@AAAACCA@AAA@AACA@@AA@@AAC@AA@@CAC@@AA@A@@AA@AA@@@AA@A@@A@A@A@AA@@@@AA@@@A@@@@AA@@CAA@EA@B@@@B@BBFBB@BA@@BA@A@A@ABA@@B@@@D@@@@@BABB@@@@@@BAB@D@@BB@B@@@B@@@@@@AB@@ABB@ABBBAB@B@B@@@BABABAB@@A@AB@B@@@@@BAB@@@B@@@@@B@@@BBB@D@B@@@B@D@B@D@B@B@@BD@FADBB@B@B@B@@BBB@F@B@@@D@@@B@@@@BA@@B@B@@@@BBB@@@@BBDBF@HBF@B@@@BBD@D@@BD@JBFB@BDBFBD@BBB@BAB@BAB@BABBD@B@BA@@@GDEBABCB@@A@A@IGA@EAA@@@A@@@@B@B@BAF@DABAFA@@BEB@@@BA@BBAB@B@B@B@@@B@@@AKECCA@@@AB@@@B@@AB@@@@@@BD@B@@@B@@@B@@@B@B@@@BBB@BBB@B@B@@BB@@@@@@@B@@@B@@@B@@@@@B@@AB@@A@@B@@@@@B@@@B@B@B@@@@@@@B@@@@@@B@@@@B@@BB@@@@@B@BBB@B@B@@@@@B@@@@B@@B@D@@@@@BBB@@@BBB@@@B@@@@@BA@@@A@@@CA@@A@@@@@A@@@@@@@AB@@@@A@@@CB@@A@@@G@@AC@A@@@A@@B@@AB@@BB@@B@BBFAD@BA@@BBBB@B@@BBBBB@@@BAB@BA@@B@@@DB@@B@@AB@@@B@B@@@D@@A@@@@D@B@BB@@@@HA@@@@B@@B@@B@@@B@@@BA@@B@BAB@@B@@@@B@B@@@BAB@@@FB@@@B@@@D@DBB@B@@DB@@BD@BB@@@DF@DB@@BB@B@B@DAB@B@BBB@@@@BB@@B@@CB@BABAB@B@B@B@D@BAF@@AB@@ABA@@@@@AB@@A@@@AB@@@@AB@@A@@B@@@@@B@@@@B@BB@@@@B@BB@@B@D@B@BAB@B@HBFBB@B@@@@D@DBD@B@ABAB@@@@B@BB@@@B@@@BA@@BB@@AB@@@@ADA@@D@@@BB@B@B@@BB@@CBABABABA@AD@@@D@HDDBB@B@F@D@FAD@F@DBB@D@D@B@B@BAD@B@FABA@@B@DEBAB@@@B@@@B@HDD@B@DAB@@@BABA@@BABAFA@AB@B@H@FBB@@@B@@B@DBB@F@B@BB@@BDB@BB@@BB@DAB@B@FC@AB@@@@@BDB@B@DBB@BB@AB@B@B@B@BB@BB@DBDB@@B@BAD@@@B@D@BBBBB@@@BA@@B@DB@@B@B@DD@@@@B@D@@@B@@@B@@BB@@B@B@@@@BBB@D@@@B@@B@@BD@D@B@B@@B@B@B@@@B@@@D@@@@@@A@CA@@AB@@@BAB@@AB@@@B@@@@@BBBDB@B@B@@@B@@@@@BB@@BB@@D@D@B@@@BB@B@@BB@BB@@BB@B@@@@BAD@B@B@BBB@@B@@@@@BADA@@@@B@@@B@@ABABABABA@@@@BAB@@@BB@@B@@A@@B@@B@@@@@D@B@@@@@@B@@@B@DAB@@@HBB@B@@@@@@@@B@@@B@@BB@@@@B@DA@@B@B@@@BB@@@@@B@B@B@B@D@@@@BB@B@@AB@BAB@B@@BB@BFH@@@@@@@ABC@ABC@C@ADC@@B@B@BA@@BBB@@@@A@@@A@AB@@@B@@BB@@B@@B@@AB@@@@B@BB@@@D@BBB@HF@@BBD@DBDBBB@@BB@@@@B@@A@@@A@@@@B@D@@@NAD@FADAB@@BB@FD@BB@@@A@@@A@ABA@@@CBA@@BA@@@BB@@HCB@D@@@BB@@@@ABAB@B@BBD@B@BAB@B@B@BAF@DA@@B@@CBCBAB@@@F@F@B@DBB@@@BA@ABA@@@@@AF@D@F@@DFBDBBBB@@BB@@@@BBB@@B@@BB@BBDB@DDBBDBBBB@@BBD@@BBB@BBBBBB@@BBBBBB@B@BBBBBBDBD@@B@@@F@@@DB@BBDBAB@BABABCB@@ABA@ABABABAB@B@D@D@D@B@BADA@ADC@ADCDA@ABABA@ABA@C@A@ABCB@BA@AB@BA@@BABCBE@E@@BC@ABCBI@A@A@C@A@C@A@ABABA@AB@@A@A@@AAA@@A@@@A@@@ABAB@BA@@BADC@ABA@A@A@CBABABABABC@AB@BCDABEDEBA@A@ABA@AB@B@B@D@BBBABAB@FABCDE@A@@@ADEJY@CBA@AFABABA@ABCBCBA@AFAB@@@B@B@BB@@BD@B@BB@@@B@F@HAFA@@@@@A@@@AAAA@CCAAAA@A@AAA@AAA@@@A@ABA@@@AA@@@@A@CBA@@@@A@@@AA@@A@@@@A@@@@@AB@@A@@AA@@@@@BABA@@@A@@@@A@@A@AAA@CC@@C@AAA@@A@@@AAA@@A@AAAA@@CC@@AC@@AA@A@A@@@AAC@@@AAA@A@C@AAA@ACC@AA@AC@AA@AA@@A@@@C@C@@@A@ABA@C@A@A@AACAA@@@AA@AAA@@C@@@@AAAAACAA@A@AAA@@@AAAB@@A@@@A@AB@@A@ABA@A@@B@@A@A@A@A@EA@@AAA@AC@@@@A@@@A@A@@B@@ABADA@A@@BA@@@@B@@AB@BB@@BB@@@@B@@A@@@AB@@@@@AA@A@A@@@ABA@CD@BADA@@@AA@@ABABAB@@@B@@AB@AA@AA@@A@A@ABC@C@IDA@@@EAAA@@A@A@A@C@CAA@A@A@CBA@A@CBA@A@A@A@AACAECC@ABC@C@CBC@C@A@A@@@AA@@C@E@EAA@A@I@E@AAC@A@C@ECA@A@A@A@A@@@A@A@C@A@A@ABC@AAC@CAC@AAA@@@EAGAC@CAA@AAAAA@AAEC@@A@CAA@@AA@@@C@A@@AC@CA@@CAA@AAA@GACACAA@A@@@CACACACAC@AAC@A@CCAAEA@@CA@@A@G@A@IC@@CAEAA@C@CCEAAACCA@A@AAAAA@CACACC@@CCCCAA@@AAA@@@AA@@AA@AAAAAAA@AA@ACAAGCAACC@@A@A@@@A@A@A@@AA@@@@C@@@ACA@@AA@A@CAAACA@@@A@C@@AA@CAAACCCC@AACAA@@AA@@CCCC@@CAA@A@AA@AAAACAAAAAAAACAC@AAA@AAAA@AAA@C@C@CAA@AA@CGAA@AAA@AEE@AAA"],encodeOffsets:[[105945,36165]]}},{type:"Feature",id:"622922",properties:{name:"康乐县",cp:[103.708354,35.370505],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@AACAA@A@@@@AA@A@A@AAA@@AC@AAAAA@AAA@@@AAA@@AA@@AAA@@A@A@@A@AA@@@@@AAA@AA@@@@A@@A@@AA@@A@C@@AC@@B@BC@@@A@@BA@@@A@@B@@AB@AA@@GACAA@AA@AB@BABA@@@AAABC@AB@D@DAF@F@@@@ABCBA@@BA@@B@B@B@BBBBD@B@BBB@BAD@@@BBBB@@B@B@F@@@B@@A@A@A@@@AA@@@@@AAA@@A@@AA@@A@@AA@AAA@AA@@AA@A@@BC@A@A@@BCBA@ABC@A@AB@DCBEB@AAAAAA@@@@@A@@BA@@@A@A@A@A@@@@B@@A@@@@@AA@A@AA@@@A@A@@AAA@CCA@@A@@@A@A@ABAB@@A@@@A@C@@BC@C@A@@B@@A@C@@AC@@BC@@AA@@BA@@DCB@BA@A@AB@@@@@BBBB@BBB@BBB@@DBBBB@@@B@BCB@BADADAB@BAB@BA@@@A@@BA@@B@H@@@@A@@@@@A@@A@@AB@@CBCBA@@@A@@@@A@AAA@AAC@CAAA@@@@AA@@@A@@BA@C@@AA@A@@CA@A@@@@@A@@B@BAB@FEDCDABADC@AC@@@AAA@@AAA@@A@@A@@@@@A@@@ADABCBA@A@@@AA@AAAA@@@AAA@@CCA@AAAA@C@A@@@CBABABABABAFA@@@A@A@@@AAAACAAAA@A@C@@@ABA@@@@DB@@B@@@B@@ABA@A@A@@AC@@@A@ABABABADA@AFAB@B@@@@E@AB@@@@AB@B@@@H@@@D@BA@@BABABABABABADADAHA@@D@BADABA@@@A@@AA@AAA@A@A@C@I@ADA@AFEB@B@JCB@BB@@@BA@@B@@B@@B@@B@BCDEDCB@B@DADBB@BBB@BBF@@ABA@@BAFCB@BA@ABAB@D@BAB@@@HGBA@A@ABA@ABA@ADC@@BCBA@@DAB@@@@A@@AA@A@@@C@ABAB@@AB@BA@@@ABC@ABA@A@E@A@AA@@A@AAE@C@AAAAAAACAA@AAAA@@AC@@@EAIA@@EAE@AAA@A@A@ABA@@BA@@BA@@BABA@ADE@ABCBA@@@@A@ABGBABA@C@EDAB@@@B@JAD@BEH@BCBGBKDA@E@A@A@ABAD@@AB@@A@E@E@C@CBC@C@A@GCA@A@C@C@KAAAG@A@C@OBC@C@ECCA@@ICCAC@AAEBC@A@I@EAEAC@CAEAA@C@AAA@E@C@C@@AA@AAAAECA@GCC@@@GAAAA@EDA@@@EDCBCBABAFABAB@@@DBB@@BB@@@BBD@@@BABABCDAD@@@BBB@BFDJH@@LL@BBB@B@D@@BBB@BB@@F@B@B@FBFBBAB@BBB@B@DBB@BBB@B@@@BB@@BBBABB@@B@@FBBBBB@@BBB@@@BAB@B@@BBDB@@BBF@@@B@AAB@DE@@B@@@LR@B@B@B@@@BABAB@FAD@F@H@B@BBD@@BB@B@@AB@BA@@BA@C@A@A@CAC@CAA@AACA@A@AA@@AE@C@C@@@@@AAIECA@@AC@AA@AAAA@@A@CAA@ABEDCBEBA@C@A@AB@@@D@B@@@BB@F@B@BABBDB@@@@B@DDDBJHB@B@DDFBB@DBB@D@B@H@@@FAD@D@B@J@BBB@@B@@BB@@@BA@CHABA@A@C@@@CB@BKJAB@B@BAB@@ADADAB@@@B@H@BABABAB@@A@ABA@C@CAGCACAA@@A@A@ABAB@@A@AAA@@@G@A@A@A@@B@@@DAD@@ABABAB@D@@ABBB@BBF@@FCB@B@B@DBB@DAR@BA@@BABABA@ABAFA@AB@@@B@@B@D@FAHADAFA@EFCDCBEBC@ABADABABCB@BC@G@KDE@EBE@ABA@@BAB@@FH@B@D@DABADIHCJAFAD@FADADCFAB@@EBE@CB@BA@@B@B@BBBDDBB@B@BBB@@AB@BAD@BEDCBAB@BAD@D@BCF@DAF@DADCDAD@BABCF@@@@AB@D@F@DB@@BB@FDD@BBDBBBBDBB@D@BA@@@ABG@A@EBA@GBC@C@GAE@C@@@A@EHCHABAB@@A@A@CCGICACAAA@@AB@@BDAB@@@B@B@BBBDB@B@B@H@D@BA@@@AB@@Q@C@A@CBA@A@AAEC@A@E@@@AA@AAA@C@CDAB@BBFDH@@@B@@ABCBGBA@C@C@@@A@@B@@DH@BDBDDBD@B@D@@ABA@@@AAEAA@KBIBE@EBE@CAA@CA@AA@@@AB@B@B@@AB@@A@A@C@@@@BAB@B@J@@ABA@E@K@@@@@@@BDBBHDBBHDDBBBBB@B@@A@@@A@@@A@A@@@AA@@A@A@A@@@AAA@A@AA@@A@@@A@@@AA@@@@@@AA@@@A@@@@AA@@@AA@AAAA@A@@@@A@@@A@A@C@C@C@EA@A@@@BABABADA@CBE@C@EBA@EAA@IA@@A@CDABEFAB@@AB@D@@A@@BA@@@CFADADBBBD@@@BA@C@G@E@A@@@EBABA@A@KACAA@AB@@ADBFAD@@@@A@EAA@A@@@@BFJBB@B@BA@BDBBBDBB@FFBB@DB@@BB@@@@@B@@BB@@@@BBB@B@B@@@@BBB@@B@@BBB@@@BBB@@BD@DB@@BBD@BB@@BB@BD@BBB@BDBBDBB@BDBBB@BBBBB@BFF@BBB@BBBDHB@@BBB@D@D@DBB@BBBBBB@BBD@DBBBBBBBBBBDBB@BBBB@B@DB@@DDDD@@BB@@BBBD@BDDDDBBDBB@@BD@B@@@B@BDBB@D@BBB@@DB@B@@@D@@B@@BB@B@B@@@B@B@@@DDBBHDBBBDB@@BBBBBBB@BBB@@BB@@B@BB@@BBDDDD@@DDDBDBB@BBBBB@B@DDBBFBDDD@B@FBDB@@JDB@H@B@@@DB@@FBBBDDB@D@BBD@DBDBDBDB@@B@B@DBDBHBB@BBB@DB@@DBD@@BB@D@@@B@@BB@DBB@@@FDBBB@BBBBB@DBD@HBFB@@B@BBD@DBD@BBD@BAB@B@D@B@B@@@B@B@B@B@B@FDD@B@D@BBF@J@B@B@FBF@D@@@BB@@B@B@D@D@DAD@D@BAD@FDDBBBB@B@B@B@DAB@B@DAB@B@B@DBD@B@B@B@@@BBFB@@B@JCD@D@BAB@B@@@BBB@@BBA@@@A@@BABABA@@BB@@B@BC@ADCB@BA@@B@B@B@@B@@@@BA@@B@@@@A@@A@@AA@@ABA@@@A@@B@@AB@B@BCBA@@@AB@B@@@B@@@@@BDB@BB@@FBB@B@B@B@@@@AB@B@BAB@@@BAB@@@B@@@BABB@@B@BBB@B@DBBB@A@ABA@@@A@@@@@A@AB@BAB@BADA@A@@@@B@@@@A@@B@@AB@@@@@@ABA@A@@BA@@BA@CBAB@@A@@@@@@@A@@@@@@AABA@@@@@A@@A@BA@@@@@@@AB@@A@@@@EE@@@G@A@A@A@A@CAAA@A@AAA@A@AA@AA@AB@@@DABAF@@@@A@CAECCCAA@@A@CAA@A@A@C@@@A@A@@AA@@A@@EC@@@A@@@C@ABA@AAA@A@C@E@C@AD@@A@@@AAACC@AAA@A@A@AA@@A@@@@AA@@@CAA@ABC@A@A@A@@AAAA@@@@@@@@AA@@@@A@AAA@@A@@A@AA@@AAA@@@@@@AA@@@AA@@@@@@@@A@@A@@@@@AA@@@AA@@@A@AA@@A@@AA@A@@@CAA@@AAA@@@AAAA@A@@A@BAAAB@@AA@BA@@@@@@@@BA@@@C@@AA@AAACAAC@A@A@@A@AA@@AAC@C@@@A@@BABA@@@A@A@A@A@@@@@AAAA@A@A@@@A@@@ADE@@@A@A@@@@CAA@@@@A@@@@@A@@@A@@@@@@@@@A@@AAA@@A@@@@@AA@@@@@AA@A@@@@BA@@@A@@@CBA@@@@AA@@@@@A@@BA@@@@@A@A@A@AAC@@@A@@B@@ABA@@A@@A@@@@@@@A@@@@@A@@AAAAA@@@AA@@@AA@A@@@A@AAAAA@AA@@A@@@A@@AAA@@AAA@@A@A@A@AA@@A@@@@@A@@@@AA@@A@AAA@@@@AAA@@@@AA@AAAAA@AAC"],encodeOffsets:[[106197,36094]]}},{type:"Feature",id:"622923",properties:{name:"永靖县",cp:[103.285853,35.958306],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AHAB@@@B@@@B@@@BAD@B@@BD@B@B@@@@@BBBBBB@@BBB@B@@BBB@@BBB@BBB@@@BAD@@AB@@@D@@AB@@ABAB@BAD@BA@BDAB@@@D@DA@@B@BAB@@@B@D@B@@@B@@@B@@BB@@@DBB@BBB@BA@@B@@AD@@@B@B@B@B@@@BBBBB@@@@AB@B@DA@AB@D@@@DBB@D@DABCDABCD@@AB@BBD@BBF@DBD@D@D@D@@@DBDBBDDBBBD@D@B@B@D@@AB@B@DBD@BDDBBDDBDBDBBBDDFFDBBBBBD@BBBBDBBBBBFC@A@ABABAD@B@DAD@BABAD@@ABA@ABC@EFABADAB@DAF@BBB@@BDDBDDBB@@DD@@BD@@@@BB@@B@@@BBB@B@DAB@B@BAB@BBB@@@B@@@@BB@@BA@@B@@A@AB@BCB@BA@@DA@@BA@A@K@EBC@A@A@A@@@EBAB@@A@A@@@@@@BAD@@ABA@A@KCGA@@A@@@A@@BABAB@@ABAB@D@@ABADABAB@@@@@B@@A@@@A@A@A@@B@@AB@B@@AB@BA@C@E@A@A@@B@@@DAB@B@@A@A@E@AA@AAA@C@@A@E@C@A@@@A@@B@D@@@B@B@BA@@B@BA@@BBB@BAB@@C@A@C@A@A@A@@BABCD@B@@@B@BBB@D@BBBBB@@B@BBB@B@B@BBB@B@B@B@B@BADABADAF@BAD@@@BBB@DBBBD@@BDFBBDBFBFBD@DBBBBBB@@@B@@@B@@@@@B@@@B@@B@@BB@@B@@@B@BB@@BB@BBB@D@B@FBBBFBF@BBDBH@D@DAB@B@B@@BBB@BBB@BBDDBB@@@DABCF@@ADAD@B@@@B@@BB@@B@B@@@BAB@F@D@@@B@B@BB@@@@B@@@B@B@B@@@B@DF@DBB@@@B@@BB@@@DADBB@BBB@DBBB@BBD@B@@BB@@D@B@BBBFFDD@@@B@@A@@BA@@B@@@B@@@BB@BBD@BBBB@@@B@BAB@BBBD@B@D@F@B@B@B@B@B@BBBBDBBDDDBBBBF@FBB@FBB@DAF@BAB@DAF@F@FABAB@FBDAFADBB@DBFBDBF@H@@@B@D@BB@@BB@@@BB@@@BBB@B@F@D@DBFDB@BBD@B@B@BAB@D@BAB@@@B@B@BBD@DDB@B@@B@@BH@BBDBBBBB@@BB@BAB@DCBABABE@A@EAA@A@A@@@A@@DAB@DAB@BABA@A@A@A@A@A@ABA@AB@@@D@F@D@BBB@B@@BB@B@@@@@B@@@DCBABABA@ABA@ABAB@@@@@B@B@D@B@D@BBDB@@B@@@@@@@B@@@@@B@@A@@B@@@BABAB@BCB@@CBA@A@A@@@A@A@@@ABAB@@AD@@@@@B@DADBD@B@B@BBFD@@B@D@H@DDB@B@B@@@DABABABA@@BABA@A@ABABA@A@@B@BAB@BAB@B@@@B@@@@DB@@B@@B@HADAB@J@@@D@B@H@B@@@B@@A@A@A@C@C@A@@B@@AB@F@D@@@B@B@BA@@DABAB@B@B@B@FB@@BBB@BBB@@@B@B@B@@@BABABA@ABEAA@C@AAA@@@A@@B@@AD@@@F@@@D@DBB@B@BBBBDBBBB@BB@@D@BABAFAD@B@D@FCB@BAB@B@@AB@@@B@DB@@BBBBDBDBD@BDB@BBBAB@FABABABABABI@A@A@C@@BA@@B@D@@BDBD@D@B@D@FABABCBA@@BCBABEBC@C@@BA@@B@BAF@D@B@D@HBHAFABAD@B@B@BBD@BB@@D@HBD@HAFBDBDBDDDB@@@@B@B@B@@A@A@@@@B@@@B@BA@@B@BBB@D@@@@@D@@@BABA@@BABA@ABA@@BA@@AA@A@@BA@@@A@@@@@A@CA@BAA@@A@A@@AA@A@AA@@A@A@@@@AAAB@A@@@@AA@@BA@@@ABA@A@@@C@@@C@A@A@@AAAA@CA@@AA@@A@AB@@@DA@A@A@A@@BA@@@AAA@A@@@@BAB@BAB@B@B@BAB@BAB@@A@A@A@@D@B@DAB@B@D@@@B@B@B@B@B@@BBA@@D@BABAB@@@B@BDB@BBBB@@DBBDB@@BBBBBDBB@@B@@BB@B@BD@BBBBB@DH@DBB@F@F@F@@@B@DBB@BBBBBB@BB@@FB@@BBB@D@@@BBB@@ADE@@BE@@@AB@BAAAB@B@@ABA@AB@@A@@D@BBB@B@B@B@BAB@B@FD@@@@@B@@AB@@@BAB@B@@@B@@@@BB@@@@@B@@@@B@@BDADADA@AB@@@BA@@@@DAB@D@B@B@B@@@B@@BB@BAD@B@B@@AF@B@@@B@B@DBD@B@B@DB@@B@B@B@DB@@D@B@BA@@BA@@DABAFC@@HAB@DAB@BAFAHCB@@@BA@@B@D@B@B@B@@@@@BAAI@A@@B@@A@@@A@@B@@ADAB@@AAEBGBC@C@@AEAA@CBA@@@AB@@BB@DBFDB@HBB@B@DBDBB@@@F@@@DABADCBC@ABC@CB@BABA@ADC@@@A@C@A@@@A@ABA@AB@@CB@B@@A@@BC@C@@BA@ADAFE@CBC@ABA@ABAB@B@@@@@@@@C@@@@B@BAB@B@BADABA@ABA@C@A@@CAAA@@@ACE@@@AA@A@A@CA@@@A@I@AAAAAAA@@@AB@@@@@BA@A@@B@B@@@@A@@@A@@B@@A@@CC@@@A@@BA@@B@B@DB@@BABAFC@AB@@A@A@@AAACIC@@AA@@@A@@AEA@@ACACA@AAAAA@@@A@A@C@@AA@@@ABABC@A@@@E@A@C@C@AB@BA@ABCB@@A@G@C@@AA@A@@@@@@C@C@A@A@A@C@A@AA@@AAAAAA@@@A@@@A@AB@@A@@@AAE@@@A@A@@BA@@@A@@@A@@A@@A@@A@B@A@AAAA@@A@@A@@A@A@@A@@@@@@@A@@AA@A@@@@C@@@C@@@A@AAC@A@AA@@A@AAAAA@@@@@@@@@A@@A@@AB@@@AA@ABAA@A@@A@@@@@A@A@@@@@A@AA@@C@@@A@@@@@@BA@ABA@A@GBA@C@@@C@A@@@A@@@AB@DAFAFAD@BAD@B@BABA@@B@BAB@@@B@B@BBF@B@@@BA@@BC@ABCBC@AAA@@@@@A@A@ABA@A@@@ABA@@@@@A@@B@@@B@@@B@@@@AB@@@@@B@@@@@@A@@@A@@@A@@@@@@@@@@@ABA@@@@@@@@@A@@@@B@@A@@@@@A@@@@@A@@@A@@@@@@BA@@B@@@@A@@AA@@@@@AB@@A@@@@@@@A@@@@@A@@@@@A@A@CAA@@@@@@AA@@A@@AA@@A@AA@A@@AA@@@@@A@A@A@@@AA@ACAAAAAA@@AA@@A@@@@@@AA@AA@@A@@@@AA@@@@A@@AA@@@@AA@@A@@AA@@A@@A@@AA@AAAA@@@AA@@@A@@A@@@@@A@@A@@@A@A@@@@@@@@@@@@A@C@A@@@@@@A@ABABABABAD@BCBA@@@A@@@A@ACA@AA@@@ABA@@@@AA@A@@@@BA@A@A@@AA@@@AB@@A@ABA@A@@AC@AAAB@@A@@@AB@@A@C@@DA@A@@@A@@@A@A@@@@@A@@A@@AAA@@AAAA@A@A@@@A@@@@@A@AA@AAA@A@AB@@@F@@CHA@@BA@ABA@C@C@A@AB@@@BADA@@BABA@A@@@E@CBA@CDGH@B@BAD@@AB@@E@@@AB@@A@@B@DADAD@BA@GF@BA@AAA@A@C@A@@@A@A@GDABA@ABCDCD@@A@C@CBC@C@A@@A@@AAA@@ACEAAA@A@A@E@CBEBCBC@A@CAEAOGCACCCAA@@@C@@@A@@B@@AFADAF@@@BA@@AA@AA@ACCACAACA@AICCA@AAA@A@AA@@AAACCA@AAE@AAG@EACA@@AACC@AACAECKACAGAEAA@AAAOGGCCAC@WGKCCA@@C@C@MCOAEA@@I@C@MAA@ICECCAACCACCCAIIAAAAKECAECWMECKGECICAA@@A@CEMQEEGGMMAACCAAIEIEICGAGCMEMCCCCAKECA@@A@G@G@IBMDCBCDCFAF@BADEFCDCFAB@DABA@@@C@A@CBA@@B@BBDDDBDBDBBBD@@@B@B@@AB@@ABA@A@C@AAA@@@ICEECAC@A@A@A@@@A@CDA@@@E@A@A@ABA@ADA@ABAB@@A@GAA@@@A@EBEBABC@@BA@@BABEFAB@BCD@BAB@F@FA@AB@@E@A@G@A@A@@B@DADAB@BA@A@A@C@A@C@A@A@C@AAA@AA@@ABA@ABA@@B@@GBA@ABAB@BA@A@AA@@A@A@"],encodeOffsets:[[105422,36697]]}},{type:"Feature",id:"622927",properties:{name:"积石山保安族东乡族撒拉族自治县",cp:[102.875843,35.71766],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@AA@ABABABA@A@@@AAECG@@AA@AA@A@AAC@A@EA@@A@AB@@A@ABA@@@AB@DCD@B@B@@CD@BCDAB@@@@BB@@B@@@BB@@A@@B@AA@@B@B@@DB@@@@AB@@@BAB@B@@A@@@A@AAGAAAA@@AAAAA@@@C@@@AA@AAA@@@E@AAA@@@A@ABED@@@@@@C@A@CB@@AB@@@B@B@@EA@ACAGCAACA@AAA@AAAAAA@@@@AA@AA@@AAA@C@CAC@C@A@A@C@A@C@C@A@C@A@A@@AC@AAA@CC@AC@C@@@AAA@A@@ACCAACC@@@ABA@@@A@@@A@@@AA@A@A@A@A@C@A@A@A@A@A@A@A@AAA@@@A@ABAAA@EBEAE@E@A@C@A@@@@@@A@@AA@@A@@@A@C@ABGDA@@BA@A@EAA@A@@@A@A@@B@@@B@B@B@@@BA@A@EBA@A@@AA@@BABCF@@C@@@EBA@A@@B@@AB@@@@C@CBA@A@A@@BABEBAB@BABAB@@A@AAA@@@A@EDA@ABCBA@A@I@CBCBA@A@@B@BA@@@CBA@A@@@A@ABABEB@@ABCBABA@C@CBC@C@K@@B@DADBB@B@DA@ADKH@D@BFD@B@@ADCBAFDFFRDJAH@HAHEDMBIBA@GBGBCDCHCD@DAFCBCHK`GFMHONKRAFABCLAR@D@BAJ@JAF@BCDM\\@BANDHNHBFCRBJRDDDJHDBBLBDBFFBB@D@D@BBB@@@DBDDD@H@D@@@B@D@BBBDBDBBBDBBFD@@FDB@B@@BB@@DBB@BDBBB@@B@@@B@BBB@@BDBFHBBDBBBBB@JB@BBBBHD@@DBFDD@B@DAHCFABADAF@DAD@@@DAD@DAB@FCDA@AB@B@B@DBDDBBBBDBDBH@@@D@F@F@BAF@FABABAB@@A@C@AB@@ABA@@B@BABBB@D@B@BBDAD@B@@@B@FAD@B@@AD@BBDBDBDBD@F@B@D@LEHAB@DAF@F@HBB@D@DCBEDADGBCBAB@B@B@B@@@BBB@DBD@B@D@BABABADA@@DAB@B@BBBBBB@BBB@BB@B@@BB@B@@@BBB@B@@ABABAB@HA@@@AB@BAB@BA@@BBB@BBD@B@B@D@B@D@B@B@B@@ABABC@C@AB@B@H@B@F@@@BAB@@E@EBA@ADC@ABAFEBA@AB@@AD@BAFAFAB@@@B@HBB@@@BABAB@BCB@BAB@B@F@@@B@DCB@@@B@B@B@D@DBFFJD@@B@BBD@B@B@BA@@BA@@@A@A@@ACAAACACCCAC@A@AB@DAB@D@@@B@BA@CBADEDCFEBC@ABEDEDCDANCJAH@H@B@@@B@@A@A@GBM@IBG@E@A@A@A@@BA@@DA@@D@@@B@@@B@BA@A@A@A@ABI@A@@@AB@BA@@@A@@@CAE@C@A@A@GAG@E@C@AAA@AAA@@BAAG@A@AAABA@S@AAA@A@@@A@C@A@A@A@A@@DC@A@@@A@@@A@@CA@A@@@AAAB@@C@@@AAA@AAABC@C@A@@BA@@@@D@@@@@@A@@@@@AC@AACC@ACAC@AAICA@@AA@@@@BABCFA@@BA@EDC@GBA@AA@@@@@A@AB@@A@ABABABA@ABA@@DADABA@@@@@@CEAA@C@A@@@@DAB@@A@@A@@@AA@AAA@A@@@C@@@@@AA@E@C@A@A@@@CBA@ABABABA@@BA@AB@@AA@@AACCAAAA@@A@@@A@A@C@@@AA@@@A@AA@AB@@A@AACAAAA@A@AB@@AB@@A@CCCAAA@@@@ABA@A@@@@@AABEAABC@AAA@@@@EA@@CAAACCAA"],encodeOffsets:[[105494,36448]]}},{type:"Feature",id:"622926",properties:{name:"东乡族自治县",cp:[103.389346,35.663752],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@A@AA@@AA@@@A@@@C@A@@@@@CCA@A@@@CAA@@@AB@@A@AAAAC@A@@@C@ABA@@@CACAA@@AAAA@A@A@A@@BAAA@CAA@A@AC@@@@A@@BEDA@A@CBA@@AA@@ACA@AA@@A@A@EAA@C@AA@@@A@EAG@A@A@@BEBABAB@@ABAB@@A@CBA@C@GCA@@@A@@@A@ABCFA@@@ABEBA@C@ABA@A@C@C@A@CAE@C@EBC@E@A@A@CAGCC@@@C@@BABABABAB@DA@@AA@A@A@@A@@@CB@BC@@@@BA@@AA@@AB@@A@@@A@@A@A@@A@AB@B@AAC@C@C@@A@A@EAGAA@A@ABA@C@A@C@@B@@@@@@@B@@@@@B@@@@@B@@AB@@A@GH@@@@ABA@@@@B@B@BAB@B@B@BBBBDBB@@@B@@@@D@B@B@@B@@BF@BAF@D@B@@E@EAC@CAC@ICEASAAAA@A@AB@@A@@@A@A@@@CAA@@@AAA@@A@AA@@AMD@@A@AA@@A@@AAC@@@CA@@@GA@@EAAAA@AC@@A@A@@@GBAB@@A@A@@@A@AB@B@BAB@@A@E@A@E@A@A@CAA@C@A@A@@@EBA@A@CA@@ACA@@@A@C@AA@@AACAIA@AA@@@@AAAA@A@C@C@CB@@AB@@ABA@@@@AAAA@@@@@AB@@@@A@@@A@A@@ACAAA@@@A@@@@@A@@BE@A@@@ACA@A@AA@AAC@AAC@@@A@@@AD@@@DAB@@@@AB@@@DABAB@@A@EBABB@@@B@B@B@DBBB@@BB@BB@AF@B@BBD@@@BA@@@A@ABC@EAE@EBA@@@@@ABEJ@@@@@B@@BDDDB@@B@@AB@D@@BBBBBD@BBFBBBBBBBBFB@B@@@@@D@B@BB@BBB@BBBBFB@B@B@@ABA@ABABABABCB@@AA@@AB@BA@@AC@@AACA@AA@A@AA@A@@@CBCBA@@BA@@BB@@B@@BD@@BBB@D@@@B@@@BBBB@BBD@@A@@BAD@B@@@B@B@@@@A@A@@B@@BD@@ABA@@BA@@D@B@B@@@DA@@@@@@@@B@B@@@BB@B@@@@BABAB@B@@@BABC@C@@B@@@B@B@BB@@@D@@@@BEBCB@B@@@@BB@BBBAB@@@@A@BB@@@BA@@@@@@@@BB@@@@@@B@@C@BB@@@BB@@B@@@@B@B@D@BBB@@@@BAF@B@BA@ABA@@@@B@@@@@BDB@BBB@@@DDDDB@BD@B@@@@@@B@@@@GBA@ABI@ABCB@@A@A@CAAAAAAAA@@@@B@BB@@BBBB@@B@@@B@@@B@B@BABAD@@AB@@@B@@@@@BB@@@@B@@@@AB@BA@A@@BCB@@A@@@@D@@BBBB@@@B@@@@BB@@B@BB@B@@BB@B@@@@BB@ABBBB@@A@@BB@@B@@BB@@B@B@@@@@@B@HBB@B@@@B@B@B@B@B@@BBDBB@BD@BB@@B@B@FA@A@AB@BAB@@A@AB@@@B@@BD@@ABABCBEDABA@A@A@ABA@@@C@A@A@A@A@A@@@@@A@@B@B@@@B@@EBABA@ADA@AB@D@B@@DD@B@BAB@@A@A@A@@@CBABA@A@A@A@ABA@AAA@ABAB@@ABA@@BAD@@@@@B@@A@A@@B@@DD@@@BABA@CBA@AB@BAB@@A@CBA@@@@B@@BB@@@B@B@@@BA@@B@@@@ABABA@@@CBC@ABABA@ABC@@@@@@@AB@B@@A@A@A@@@@@AB@@@BB@@B@B@@ABAB@@@@BBB@@@B@@BAB@@@B@@A@AAA@@@@@@@@BAB@@@@CBABAB@BADABABABCBABCBCBABGJGFGJCBCFKNFFNRDFB@@@BBJDFDLHFDXNFDDBLFBBBBJJDBDDDBBDDBFDJDB@NBD@J@@@FBPBNDD@D@@@DBLDXHD@DBHDPHBB@BBBBFBHBDDLBFBD@BDDBB@@DBFBH@BBF@BBB@DDBB@BB@@B@BBB@BDBJD@BDBBBBDDD@BBBB@@BB@@A@@BEBCBE@@@AB@@@D@@@B@DBDDDBPHFBDBB@D@DAFADAF@B@B@B@BBDF@BB@BB@@@BB@D@D@DAD@B@@@DCDCBAB@BAHCB@B@@@B@D@B@B@BBB@@AHEB@@ABCBC@C@AB@@@BA@@F@@@BA@@BC@A@AHGDCB@DAF@@@B@B@BA@AB@BC@A@@BAB@D@D@B@BAB@@AB@DG@@@E@@BAB@@AD@@@BAB@@A@@BA@@BAHAD@B@DA@@D@@A@@B@@ABABCBA@@@AB@FCBAFCDCFC@@B@@@BBDB@@B@BAFEDA@@B@BA@@BA@@BABA@ABA@ABCBADA@@@ABA@A@C@CBABCBA@AB@BA@@H@B@@AB@@CBC@@@GBABA@@DADAFCBABCBABA@EBA@AB@@C@AB@@@BA@@B@DB@@DBB@BB@BB@BFBBB@@BB@B@BABAB@@@BA@A@CAC@A@A@A@@BA@ADADCD@@ADAD@B@B@B@@@D@@@B@B@BABA@@@A@A@A@@@@AA@@@AB@BABA@ABABABABA@ABADA@@BA@A@@@A@AA@@A@A@A@A@@@A@@@A@@AA@@@CAA@A@A@A@A@A@AB@@@BADCBA@@B@B@D@BBBB@@BB@@B@H@B@FCB@DAB@F@B@BAB@BCBC@@AC@A@C@C@@BA@ADAFABADAB@DC@@DAB@BADABC@@@E@A@C@A@A@C@CDCBCBADAB@HCB@BABABABA@@BA@@@AB@BCBABABA@A@@BCDABAB@@AB@@AB@@ABCB@@A@@BA@AB@DA@AF@B@HCBA@@BCBABA@@DAB@DC@@BABAB@@AB@B@D@B@BAB@@A@@BAB@@AB@B@B@@AHABABAB@DCBA@AFAB@@@B@B@BA@@@ABA@A@@@A@@@ADAB@BAB@@A@@BA@ABA@@B@D@BABC@@@A@@@AB@@AB@BADA@@BA@@BAB@BCAC@ACA@@E@@@A@@@ACACAAAA@A@AAAAAAA@@AAAAAAA@AA@@AC@AA@AACAAACCA@AC@AAA@@@AA@AA@@@@AA@@AAAAACCE@@@E@CBE@@@@B@BAB@@A@@AA@C@A@E@E@@BADADA@@@AB@@CBE@A@A@ABA@A@AAC@A@ABABA@@@@AA@@C@A@GD@@AA@@B@@AB@DA@@B@BAB@@@B@@@A@@AECA@@AA@CBEBC@MB@@C@A@@@@@@B@@@BA@@@@@AA@@AACACAC@AA@@GEA@AAC@@@A@@A@A@@A@@BA@@@@AA@@AA@@@A@@B@B@@@B@@A@AA@@ABA@A@@@CD@B@DAD@BAD@B@@@@@@EG@AAA@@@ABA@ABA@@@AAA@@@@@C@A@A@A@A@@@@AA@@A@A@@@CBA@@@@@AA@@@A@@@A@@@@@@A@A@GA@@A@CBA@@@A@@@@@@@@A@C@@@@@AA@@@@BA@@@AA@@A@AB@@@@ABABABAB@BA@@@A@@@@@CBAB@@@@A@@@AA@A@A@ABC@A@@A@A@@AA@@AAA@@@AAA@@A@C@C@@@AA@@AA@@@@A@@@A@A@A@ACAA@@@@A@@@A@@BA@AB@@A@@BB@@D@B@@@@C@@@A@@@A@A@A@@@@A@A@CAC@@@AA@@@C@A@AA"],encodeOffsets:[[106085,36373]]}},{type:"Feature",id:"622925",properties:{name:"和政县",cp:[103.350997,35.424603],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAC@@CA@@@AAA@C@C@@@A@@EA@@A@AB@@A@A@@@@@@AA@ABA@@@AB@@A@@@A@@@@AA@@@@@GB@@@@AAA@C@@@@@@BC@@@A@A@@@A@@BA@@@CA@@A@@@ABA@AB@@A@AAAA@@@AAAAA@@ABC@EBAAA@@@AA@@BA@@@AB@@@B@D@@BH@@@B@@@DA@@B@@@@@BA@@@@@@B@@@@@B@@@DB@@B@@@B@@A@@@@@A@@AA@A@@AA@A@@@@@C@AA@@@@@@A@@@@@A@AAA@A@A@@@@AA@@@A@@A@@@@@@@@A@@@@@@@A@A@A@@@A@@@@@AB@@@BA@@@A@@@@@A@@@A@@@A@@@@@@AA@@@A@AAA@AAA@A@@@A@A@@@A@@@A@@@AAC@@@@@@BA@@@A@@BA@@B@DDLF@B@@@A@@@A@A@ABAAAB@@A@@FA@AB@BEBA@CBE@A@A@A@@B@@@B@FBB@JHB@B@@@DABAFAHC@@B@@A@AACBA@ABA@ABA@AAA@AACAEACA@AE@IAC@@@CAC@A@@@AAE@GAEAC@A@@A@AA@@@@@A@AB@@A@@A@@@C@@@A@E@A@AA@@@A@A@AAABC@EAC@@@A@A@C@A@C@A@@@A@CAA@A@@@A@@@@@A@@BA@A@@@@@ABAB@@@BABABA@A@@@A@ABAAABAA@BA@@BA@@@@@@@A@@@AAA@@@CBA@A@@@@A@BA@A@@@@@C@@@AB@BAB@B@B@@AB@@AAAAE@A@A@@@A@EAAACAAACB@@A@AAAEI@A@@B@B@FBB@@@@@BCAEBC@@BAB@DBLBB@B@BAFA@@B@F@H@D@B@@A@@ACAABCBCDE@@B@@AB@@@@CBA@@BAFEBADCB@@@JBB@FBB@FAD@F@DAB@BCBABA@A@@@BFBD@D@D@B@B@@@B@@@@@@BBBBBB@@B@@BB@@@@@B@@BB@@@@@@BB@@B@@@B@@@BBB@B@BB@@B@B@B@@@BB@@B@B@@@B@@@B@@@@AAAAACAGCAAGCAAAC@@@@@@L@F@B@BA@@@I@ABA@A@@D@B@B@@@BA@@@A@ABA@@B@@BDBB@DBF@FAF@JALAB@FBBB@@B@BA@@@C@AACCCCA@ACG@@@AB@@@D@D@B@HADABA@@@A@@CGAE@ABADCD@B@BBB@@B@@@F@BFDBBB@B@DAB@D@R@@@BA@@B@@A@C@G@A@ACAAA@A@A@A@@BAAC@@BA@@BBDBDBHJDDB@B@@@BABADGFGB@@@D@F@HBD@D@HAB@FAB@H@BA@@B@@A@CAAACAACAAAC@ECA@@AA@@C@E@CBA@@@@DEBA@ABCDCBC@CBE@CDE@A@CBC@ABADAFC@ABC@ABA@@AA@A@AAACCAA@A@A@AB@@ADAF@FA@@BADEBCBC@EBCBEDIJGBCBA@C@C@AEG@@BA@AB@BAF@FAF@LCH@D@@ADABABABCBAD@FADADCFEB@BEBCBG@E@C@AA@@@A@@BEBAB@BABABAB@@ABQ@CBA@CAA@A@A@ED@@ABABABCDEDA@GDA@C@CBA@CBA@@BCBABABAF@BABIDABCDA@EBEDABCBC@CBCB@@AB@@@D@@GL@D@B@@ABCBMJABA@A@QBA@C@EBIDEDGDA@EBEDEDGDOJABA@ABIF@@ABCBABEBA@CBCDGHA@A@A@@@A@KHEBA@@@CBABC@C@A@OFKDAB@@A@@@@@@A@AA@@A@@A@@BA@AB@@@AAACA@@EA@@@ACGAG@CBC@C@EAA@A@AA@@@C@C@C@CBIACBC@CBGBABGBEDGBGDEDC@@BMAG@A@A@CBA@A@A@CBC@C@CBIFC@ABCAECEAA@C@A@CB@@ABC@A@C@EAA@EBC@A@ABA@A@@@AA@AA@@AC@CBGBC@A@CBC@A@ABCBEDCH@@EAA@ABIHEDA@EBABCBCBEBEB@BABBD@@A@IFCD@@CBA@@@AB@B@BA@@@M@C@A@EFEFA@CDEBGDA@E@A@IBC@CBABA@AAG@I@U@MBWHUDE@K@O@GBMAABEBK@G@ABKDC@ABCBAD@BABADADCDEDIFIFEFKHCB@BK@GBEBC@ABAB@BA@AB@@A@A@CA@@AB@@AAC@A@ABA@A@ABA@CBAH@B@F@@A@ABE@C@C@C@A@@AAB@@AB@H@BCH@BAD@B@B@BBB@B@B@@ADA@ABAF@B@BBD@B@@AAE@E@@@A@CAC@@@CA@@C@ABA@@@A@A@A@C@A@A@A@A@AAA@@@A@A@A@A@A@AAA@ABA@AB@@A@A@A@ABABCBADAD@BAB@B@@C@A@A@AA@AA@A@@@A@ABA@ABA@A@AAEA@@@@ABA@@@@@A@GBA@ABA@A@A@A@AD@@E@ABA@ABC@CD@@A@@A@AAAAAIEAAAA@AAA@C@C@A@A@C@AAAEEEE@C@C@AA@@AC@A@CEEC@AAB@@CDEBABCFA@@@A@A@A@@BCBCBAB@JAB@BCF@BAJAF@B@BAF@D@@@BBBBBBD@BBBB@@@@BABED@BAD@B@B@@BB@@BBHJBDBF@@BB@@EDABCJ@@BBBD@B@D@B@BBB@BBDBB@B@@AB@D@D@BBB@B@B@BAB@B@BBDDDBB@BBD@B@DAB@F@BABABAB@B@B@B@DBD@HDF@B@B@@BBFDFBB@B@B@FCD@BBJDB@B@BBHBFBDBDBB@@AB@@ABADC@CBC@A@@FELGHCHEBA@ABABE@AB@BADCB@@AB@B@B@B@@AD@@@DBD@B@@@@@BADCBADADADABA@CDABABAD@BAD@B@F@B@B@BBDBBA@@BADADCDAFAD@H@NDFDDBBBBDBD@@@BCDCDAFCF@BBBBBD@B@B@FALILIDCB@B@B@@@@@@B@DBB@@B@@@JCB@@B@D@B@@BB@B@@@@@BBBB@BB@@@@@@B@@@DBB@@@BB@@@@@F@B@B@B@B@B@@@D@@AD@FAF@BA@ADAD@@ABA@@BE@@@@B@@DBBB@BBBBBFDDBDB@@RJBB@@BB@BBBB@B@DBBB@@BBBD@@@@BBB@BBB@@B@@BB@@@D@B@@@@D@@@DDBB@@@D@BBFBB@@@@AB@@@B@@@BB@@@BD@B@B@@BBBB@@@B@@@B@DCD@@@B@B@@@B@@CFA@EBEBCD@@AB@B@B@@@@BBB@BDBB@B@BB@FJDB@@DD@@BD@H@DCD@B@@@@@BDBBBBBBB@@BBBBBB@@BBBB@@BB@@B@B@BBB@@@B@@@@@@@@@D@@AB@@@B@B@B@@@BAB@@@B@BAD@@@B@@@@@B@@@@@@@@@B@@@B@@@@@@@@@@B@B@@BD@BBBBBBB@@@@B@@BB@B@@BB@B@BAB@@@B@BBB@DBDBDB@@B@@@B@@@FA@ABAB@@@B@BBB@@BBDB@@DBB@@DBBBB@DBFBD@D@B@@@B@BA@@HA@@BBB@HDHBB@B@@@DEBCDEBCHGBA@@@@BAD@@@@@BAB@@@B@@@B@DD@@B@FBBBDBBBBB@BBBBAFAB@@@BABA@C@@BA@@@@BA@C@@BC@@B@@@D@BBD@BBB@@B@BDB@B@@@BAF@@@B@@@@@B@@BBDB@BB@B@@@B@@@@@BA@@@@B@BB@B@@B@BA@@BA@@DAD@D@B@B@BB@B@@B@@BJBDBBB@@BBD@B@@@B@BD@@DBB@B@FA@@B@B@D@B@DBB@B@F@B@F@B@@@BA@A@ABAB@@@B@B@@@BAHA@@B@B@@@BDB@BBFB@@HB@@B@@D@@BD@BB@@@BBB@@@NC@BB@@B@BB@BB@@B@DB@@B@B@@@B@@@BAB@B@BBTBFBJDD@DBD@FBF@@@@A@CBE@AAE@@@AA@A@C@@@@@@A@@AAACAA@A@A@ABA@A@A@A@@B@BA@@@@HGB@@@BA@@@A@@@@@A@@@@@A@@@@@@@AD@@@AAA@@@@@AAA@@@@@@A@@@@@AB@@@BA@@@@BA@@B@@@BA@@@@B@BA@@BA@@BE@A@C@A@A@ABABA@ADA@@@AA@@A@@A@AAA@A@CBA@A@A@@AA@@CCE@@A@"],encodeOffsets:[[105920,36321]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
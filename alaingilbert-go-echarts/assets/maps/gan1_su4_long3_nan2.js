@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("陇南",{type:"FeatureCollection",features:[{type:"Feature",id:"621202",properties:{name:"武都区",cp:[104.926337,33.392211],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@A@A@A@ABA@A@@@CAAAAAAAAAC@@@@@E@A@A@@@@AAACCCCACAA@@AA@A@A@@@A@@@E@@@A@ABA@A@C@@B@@AA@@@A@AA@A@ACAAAA@AACA@AAA@@AA@A@@@@B@@CBA@EDAB@@CB@B@@@@BB@B@@@BABABABAB@BCBABA@@B@@BB@@@BBB@@@BGF@BAB@B@B@D@BBDA@@BA@ABABA@@B@@@BB@@DBB@@AFAB@DAD@@ABC@CDABCBCB@BABA@@D@@CDA@@BAB@B@BBB@BBD@@@BA@@D@@@@ABADABEBABCBC@A@@@A@@BCBABAB@@ABA@@BA@@BB@@B@B@@@@@BA@@DABAB@DAB@B@@ABABAD@BAFAB@BA@CBEFCB@@AB@@A@CDABAB@@CDCB@@@@A@AAECA@C@AAA@A@C@A@@AA@AA@A@ABA@A@A@A@@AAACAA@ECE@A@@BA@@@ACGAACAGCAA@@@A@A@AA@AA@@@A@CAA@ACCAA@AA@AAA@@A@C@A@@C@A@A@AA@@A@@A@BA@CD@B@BAD@@B@@B@@B@@@AB@@A@A@@@@@@BA@@@@@@BA@@@@@@B@B@@ADAB@DA@AB@@A@ABBB@@AB@@A@CAAAECA@CBGBA@A@@AA@A@A@AB@@A@AAAAA@@@@@@A@A@@@AA@@AA@@@EAA@A@ABC@A@@@A@@@EC@AAA@A@CACAA@AAAAA@ACCAA@A@@BA@A@C@A@@AAA@C@A@@@AAC@A@@@AA@A@AAA@A@@@BEDGDA@ABC@@BABCFCDEBA@G@ABABBB@B@B@B@@B@BBB@@BB@@BAB@BB@B@@B@@@@@BA@@BCF@B@@A@@@C@A@@@@B@@@@A@C@CB@@A@@B@@@@BBB@@B@@@@ABA@A@@@A@@@AA@@C@AB@@AB@@BB@B@@@BA@@D@@@B@@AB@AA@A@@@@@@@@BAB@@A@A@@@@@@B@@@BBB@@@@@B@@AB@@@B@B@B@@@@A@@@CB@@@@@BBD@@@@AB@@AAC@CAE@A@ABCBA@@BA@C@CBA@@@ADADIJC@AB@@AD@B@BCD@BABABA@ABEBA@CBCFEDA@CFAB@@ABA@CD@@CBE@CBC@A@CB@@CBA@ABABAB@B@B@@A@AB@@@BA@@BCHA@A@@@@@@B@B@BA@ABAB@B@B@@BD@@@B@B@@BB@D@B@B@@@B@BADAB@@ABA@@B@@AB@B@@@D@@AB@@CBC@ABAB@@@B@@@B@D@@BB@B@@@B@BAB@B@@BB@B@@@BA@ABAB@BABAB@@AB@B@@@B@D@BAD@BA@ABCD@@ABABCBCB@@@@@B@B@B@B@@@FABAF@D@B@B@@@BBBB@@B@B@@@BADBBBB@@@BBBBBB@B@DB@@F@BA@@BBB@DABBBBB@BBB@BBD@B@BB@@@B@@@B@@@BB@@@B@B@@BBBB@D@@@BB@@@B@@@B@@EDCDA@@B@DBB@B@DB@@BAD@@@BAD@@@B@@BD@B@@BBB@BD@@@@@DAD@BBD@D@@AB@B@B@@DB@@@@@B@B@B@@@B@B@@@DBB@B@@BBB@D@B@B@@@@BA@@BADABAB@@@@ABA@CBCDABAB@B@FAB@DAHAF@B@B@D@BCF@DCBADA@@B@B@@@BBBB@BBB@D@B@@@DBDBB@BBBBB@DFDBDBBB@B@D@BBD@BDBBBBD@@@D@@ADABAB@BBB@@DDB@@@@B@@@BABABA@BB@@B@BB@@B@@B@@CBC@K@@@A@ABA@GBABG@A@@@ABABABCD@D@BA@@B@BAB@BA@@@@B@@@B@@A@A@A@C@AA@@ABCB@@ABCDA@@@A@@@AB@BAB@@AB@B@@@B@D@BCDABCB@BA@A@A@EBABA@ADCFCD@B@BAD@@ABEBC@A@A@@@A@@@ABCDAF@@ABAD@@CBCBA@A@A@A@AB@@A@@BA@@@@BAF@@AB@@C@CBG@@@AB@@@B@D@B@@ABCB@@ABAB@B@BADADAD@B@BAB@@@@C@C@A@AACAA@A@@@A@@@A@@DCH@BA@C@A@A@CBABA@C@AB@@@@@B@@@BABA@A@CBCAA@@@@B@@A@A@A@AAE@A@A@@@A@@@@@AB@@@@AB@B@D@D@@@BBB@B@B@D@@@@ADAB@B@B@@@@AB@@@B@@@BADBB@B@@ABABA@ABCF@@C@AAA@CBC@CBA@C@GDAB@BCBA@E@@@ABCBA@ABA@IBC@C@@@AA@@@C@@@AA@EAG@CAA@C@C@E@A@A@IAEAC@CA@@ABA@C@E@EBCAC@A@@BA@@@ABA@A@A@@@ABCBAB@@EBEBA@@BC@@B@BA@@BA@G@CB@@ABA@E@@@@@AD@@ABADABA@ABA@A@A@ABGBA@C@A@@B@@ABABEDAB@B@F@BCD@BA@@BB@DBBD@@B@BBBDBB@@BBBB@B@@CD@@@BA@A@CD@@@B@B@@AB@BABAB@B@BAD@DBDBB@BBBBB@B@BADAD@@@B@H@B@@AD@B@@@B@@BB@BB@FBD@HDDBDBHD@@CDCBC@ABCBCBE@CB@@AB@@@B@BBB@@AD@@@BEBABCDABAB@B@@ABABCDCD@@@BCD@D@D@BBB@D@BBD@DBB@FA@@B@BAB@@CBABA@ABA@ABAB@@@@ABIB@@@B@@AB@@CD@BA@A@@@@@ABCBCFA@ABC@@@AB@@A@A@ABA@CA@@A@@@AB@@@BA@@@C@A@A@A@@@A@@BAB@B@BAB@BAB@@@B@B@@@@C@A@A@A@@@ABABA@@@@@A@@BE@A@A@A@CA@@@@A@GBAB@@EB@BA@@@A@@@ABABCBA@@@@B@@A@A@A@A@ED@@ABA@AF@@@BCBA@ABA@ABA@ABEDCDEHCF@@ED@@C@A@AACAAAA@@@A@@@CDADEHABBDAB@BADADAB@B@B@@@@B@B@B@D@@@@@BAB@@@@AB@@@@@B@@B@@D@B@@@@@@@@@@D@BAD@@BD@BAD@@BB@B@D@@B@DB@@@@@DBBAB@DAB@B@@ADCD@@@B@B@B@BBB@@@@@D@@@B@BA@A@@@@@BBD@BBBBDB@BDBB@@@@BBBB@@BB@@B@@BB@B@@ABA@@@A@A@@BCBCDAB@@A@IB@@CBA@@DAB@BBB@D@B@B@B@@BDAD@@@B@B@@ABA@@@BB@@@BDDBFB@DBBB@@BBB@@BB@B@@@@@BB@@BB@@FBDA@@B@B@@@B@@@@@@@B@B@@@@@B@@@B@@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@B@@@@@B@@@@@@@@@DB@@@@@BB@@@@@@BB@@@@@@@@B@@@B@@@@@@@@B@@@B@@@@@@@BA@@@@@@B@@@@@@@BB@@@@B@@@BA@@@@@@BAB@@@@@@@@@@B@@@@AB@@ADAB@B@@@BBB@@BBBB@@@DAB@B@@BBBB@BBB@B@@@BB@BDB@@@@@B@B@@@BCB@@@D@B@FA@ADABA@@@@@A@A@@@@@A@@@@@A@@@@A@AB@@@A@@@@AA@@A@@@ABA@A@@@@@AA@A@AD@DA@@B@@A@@AAA@AA@@E@@@A@@@@ABCB@BABCBADAB@BAB@B@D@@@B@@A@A@@@@@A@@AA@@A@ABCBCBA@@@@@@@@ABABABA@@A@@@AAA@CCA@@@CCA@A@@@@A@@BCB@@@AA@@@@A@@@A@A@AA@@@@DAB@BAP@@@@@@A@A@E@AA@@@@A@A@@AC@A@@@A@A@@B@DAFAFAB@BAB@BAB@DABAB@@@@@@@BA@A@@BABA@ABA@@@AB@@ABA@@@@B@@@@@@@@@@@@@BABA@@@@B@B@B@B@B@BA@@@BB@@@@B@@B@@@@@@@B@@@@@@@B@@B@@@@@@B@@@@B@@@@@@@@@@B@@B@@@@@B@@@@@@@@@B@@B@@@BABABA@@@@B@B@@@@BB@B@B@@@B@@AB@B@BAB@@@B@@@B@@@@@B@AB@@@BBB@@@@B@BA@@@C@@@C@@DCDCBAB@BAB@BAB@@@BBB@@BJJDBFDBBFDDBFB@@DB@@@@B@@@B@@@@@@@@@@@B@@B@@B@@@@@@@B@@@@@@@@@@@@@@@BDBB@@@@@BB@@@@@@@@@@@@@@B@B@@B@@B@@@@@@DBB@@@@BB@D@@@@@@BB@B@B@B@B@@@@B@@@@@@ABA@A@@B@@ABCDADAB@@@BBB@B@@@BA@@@ABC@@@ADA@@BA@AB@@CF@B@@AB@@@BBD@B@@A@ABABA@@DCDAB@B@BA@C@@@ABA@@B@DA@@BABCBA@@@AB@@AF@B@F@B@B@BB@@@BBB@@@@@B@BAB@B@@@@@@@@@@@B@B@D@@@@@B@@@@B@@B@@@@@@@@@@B@@@@B@@B@@@@B@@@@@@@BBFABBJ@B@B@@@B@D@BBDBB@@@BAFAD@BAB@B@@@DBFDBABA@@B@BBD@B@@@B@@B@@@BB@@ABBB@DBFDBBBB@@B@@@FBFBB@D@@@LDB@B@@@@A@ABA@@FEDAHA@@B@@AAC@CB@@ABBB@BAB@DCLGB@D@B@@BB@@A@@BA@@B@DA@@B@BAD@@@BADAD@BA@@@@B@@AD@DBFB@@B@DBBBDBB@@@@AB@DAB@D@H@BAF@BAD@D@D@@@BBB@BDDDDB@D@D@DAB@B@@BBB@@B@B@B@@DDBBB@BBB@B@@@BB@@DB@BBBHBB@BBB@@B@B@BBB@@@B@@ABAB@B@DBB@BBD@@@@@BEFC@AB@B@B@BD@@BDFBB@BBBB@BBD@DD@@HBB@BBBB@BDB@@B@@B@@BBB@@AJEFCB@@AB@@A@@DAB@D@BB@AD@@@BC@AB@@A@@@A@@A@A@@AA@A@@AAAHEDADCFCDC@@B@DBB@BBDBDDB@BBBBB@B@@@@@BABA@A@ABC@C@AAC@@@ABABC@@AAB@@ABA@@@A@A@@@A@@@A@@BABABA@@@A@A@@BA@@D@JA@AB@D@@@DA@BFA@@DAD@@@BABA@@BA@ABA@ABC@@@@@A@@AACA@A@@@A@ABA@ABA@@AC@@@@@CA@@@@@@AB@A@BA@CB@@@BEBADCBA@@D@BAFCFCD@BA@ADAB@@E@A@A@@DC@C@@@@BA@@@@B@DBBBB@@@B@@@@A@CBA@ADCBADAD@@@BBDB@B@DBBB@B@D@B@D@B@@@BBHBB@B@D@BA@@H@D@H@F@B@BDB@@B@D@@@BBB@@@B@BABB@BBFBBBBBBB@B@@BDDD@@@BAB@F@BADAB@@@@@B@@DB@@B@B@B@DAD@BAB@B@BADAB@@@B@DBB@F@BAB@@C@ABA@ABABAB@BAB@B@B@JHB@BD@@B@BBB@BDBBB@AB@@BB@@@@D@@@BBD@B@BA@A@@@@BAH@@@BAB@@@@@B@@BB@@BBBB@BBB@@@B@D@F@@@BAB@B@@BBB@B@@@D@@@BB@BBB@DB@@F@@@FDD@D@FDFBB@B@B@B@@@D@@@B@FD@BBD@@FBBB@BDDBBBBBBB@BBBBBBBBDBB@B@@B@D@@@B@@AB@@@@BBHF@@DB@@@@@BBBB@B@@@BBB@@BFBDBB@B@DDFDB@@@B@@BB@BB@BB@@@D@FDHBFBFBFBB@B@BBDBDBHDBBBBB@@BB@@@DBD@B@BDB@B@B@BBDDDBD@BBB@@BBBB@B@@@@@B@B@FA@@B@D@@@B@@@BABA@AB@@@@ABA@@BAB@@ADCBABCBCJEFCDA@@@@@A@CBA@@@A@ABABADAFCB@FAFABAB@B@@ADABA@@B@@ABA@@B@@@B@BABAB@@AA@@A@A@A@@@A@A@A@EAA@A@@@@A@EBG@C@CBC@A@@AAAAACA@A@@BA@@BAD@DAB@BABBD@@@B@@@@@B@@BB@B@BB@@BBDB@@B@@@DCB@B@BAFBB@DAD@B@B@B@BAD@@AFBB@BABAB@@@@@DB@@B@B@B@DBB@@AB@B@B@B@B@D@BAB@@@@BB@@@BAB@@@B@BB@@@@B@@@BABAB@B@HDBBB@HBF@@@@BBB@@BB@@JBB@@@BAB@DABC@@EGAA@AAC@C@A@AAC@@AC@A@AA@@AA@@C@A@@@CAA@A@AAA@AACAA@CAA@@BA@A@@@ACACA@AAA@@@A@@AAA@AA@AAA@@GAA@A@AA@@AA@C@A@@@AA@AACAACKE@AG@@@AA@ABA@@AA@A@@@AA@@A@@@A@C@@AA@@GAA@AAA@AAA@CAG@A@A@@@@@@D@@@@@B@AA@A@C@ABA@ABA@AACDA@ABA@A@@@A@EA@@A@CD@@A@@@A@AA@@A@@A@ABA@AAC@ABE@C@A@A@A@C@A@AAA@@BC@@@A@@C@AA@AA@@A@A@E@E@A@A@@@@AAA@CF@@@B@BA@@@A@CEA@@AAAC@AACAAAACAABA@A@AAABC@A@AA@@ABABEB@AAB@@A@A@A@A@@@AAA@ABABCBA@@@G@CAA@A@A@@@A@ABC@AAA@A@@BABA@A@@@A@A@@GG@A@@ACACAC@@@AACCE@A@@@C@CBA@@@@@@BAD@@@DBB@BBB@B@DA@@FBF@B@B@@@B@DBF@B@H@B@B@B@B@B@@@D@DBB@B@BABABA@ABABCBAFCBAB@BA@@BCDE@AB@B@@@B@@BBBB@BBBABA@@D@BAB@@@BADCHEBAB@@A@C@CAA@C@@BC@ABADE@CDGBEBCBC@AB@@@BADBFBD@BABB@AB@B@BADCBADCDIBADCBABCBEBA@ABABEBC@@BABAB@@A@ABCBABA@A@A@@@A@AAAACA@ACCAAAAA@@AA@@GA@@A@AAAC@AAA@A@A@AA@@AA@@@A@@A@@BADA@@FA@@DAFCA@AC@@@A@@AAAA@@B@BAB@@@BA@@@E@@B@@A@A@C@A@@DAB@@@@A@AB@@ABADC@A@@@AB@BAD@@E@A@A@@A@AAA@ECA@CAA@ECAAAA@@EAA@E@A@C@A@@AAAAA@@E@@@A@AACACAA@EEA@@A@AA@@@AAA@@ACA@@AAA@@CA@AA@A@@@@@ABADABADCBA@A@A@@@AACAA@A@A@ABC@@@C@@AE@GAC@@AAC@A@AAA@AAAA@@ECA@AA@ACEAA@@AC@A@@@@@ADABA@ABABA@ABC@@BAB@D@@@B@@A@A@@@A@A@A@A@A@A@ABABC@@BA@@BA@AB@@C@@@AB@@@@AA@AC@@@ABA@@@C@AAAECCCAA@A@@@AB@@A@AB@AA@@GGA@@A@A@@@AB@DC@@B@F@B@@AB@BA@@B@D@D@@@@@BADI@CBADEDE@AB@@@BAB@@AB@@AB@@AD@BABABADABABC@@@A@A@@AA@@@AAABC@C@@B@@AAA@@B@@AB@B@BAB@@ABA@@@@BBD@B@B@B@@@BBD@@@FB@B@@B@BBB@D@DBB@B@FCDEB@B@B@@@BABCFCBA@@B@@@D@@@BA@@@@BABA@@@AB@@@BBD@@@B@BA@BB@@@@@B@DBB@BBB@B@BCB@DCBABC@@DA@A@@BCAA@ABA@ABC@@F@B@B@BAB@B@BABAH@@ABABCB@DABA@@@A@@D@DBB@BCDC@ADCBABABAB@D@B@B@DBB@B@DAD@B@B@B@B@@ABA@@@ADA@@@AB@BA@@@A@@@A@@BAB@@@BA@@BABA@@B@@CDABA@@BA@AAA@@@AB@@C@A@A@@BC@@B@BCBA@A@@@ADCBA@ABA@CB@AABA@AA@BA@CBAB@@A@AB@BA@C@@B@BA@A@ABA@@B@DC@@DA@@B@B@B@D@@@BA@@BA@@BADADABAB@@@@A@ABA@@@AAC@@B@@@BA@@@@@C@A@ABA@@AEAA@@@ABA@@@AACAA@@BAB@@AB@BC@@B@DAB@DAB@@A@@BC@ABEBEBABA@@D@F@D@@ADA@@D@JA@@D@@@B@D@@@B@@@B@B@BCBAB@B@D@BB@@BBB@B@B@B@@@B@@A@@BA@@DABABA@@@@@CBA@A@AAA@A@ABA@AAA@C@@BCB@DIBA@@B@D@B@LCB@BBB@BAFALA@@@A@A@C@ABA@@@G@EBAB@D@BAB@@@BA@AA@@ACACCAA@@@A@@@AA@@AC@AAAAAAAAACA@AAA@AAA@AA@AEBCBABA@A@C@E@AAA@AAAAA@C@A@C@A@AA@@@ABABAB@BA@@BA@A@@@AA@AAA@CA@A@@BABABABC@C@ABABA@ABADA@AB@B@BA@@B@BC@CBA@@BAB@@C@@B@@AF@@@B@@A@@ACA@AA@@AA@@@ABA@C@@@ABABG@C@C@@CE@A@C@@AAAA@@@C@A@ADG@EBABAI@IAKAIBK@@@I@ABCDIHEFCHAHCDCFCFEFCDEBEBGAKDC@ECGAIBEDKREDC@KCC@AB]OC@CDCFC@CDEFGDGDC@C@GDGFEBEBIBKAI@IBK@IAIAAACCCCGCCECEAQIeBG@AGCEAG@EBA@AAECCCCAE@@@AB@@@@@AAABA@ABA@B@@@@B@@@@@@@@A@@@@BA@@@@@A@@@@B@@@B@@@B@@@@@@@@@BA@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@AB@@@@@@A@@@@@AB@@@BA@@B@@@@@@AB@@@@@@AB@@@@@@@@@@@@@A@@@@A@@@@@@@AA@@@@A@@@@BAA@@@@@@A@@@@A@@@C@A@@@@@@A@@@@@AB@@@@@B@@@@@BA@@@@BA@@@@@@@@B@B@B@@@@@@@B@@@@@@A@@@@@A@@A@@A@@@@@@@@@@BA@@B@@@@@@@AA@@@A@@A@@@@@@BA@@@@@A@C@@@A@@A@@B@@@B@@@@A@@@@@@@@@@A@@@A@@@@@AA@@@@@A@@B@@@@@B@@@B@@@@@B@@@@@@@@@@A@A@@@@@@DAB@@@@A@@@@AA@@@@@A@@AA@@@A@@@@B@BA@@@@@A@@@@@@@@D@@@@@B@@@@@@A@@@AA@@@@@A@@@A@@@A@@@A@@B@@AA@@@@@B@@@@@@A@@@@@@@A@@@AA@BA@EAA@@@GAA@E@AAC@A@@@A@CBCBA@A@A@CEA@A@A@A@C@A@ABAB@@@@"],encodeOffsets:[[107885,33623]]}},{type:"Feature",id:"621221",properties:{name:"成县",cp:[105.742424,33.75061],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@BABABA@@@A@AACA@AA@@C@A@CBCBAACBC@@@C@A@EA@@AA@AACA@@AAACAAA@@CBCAC@@@A@A@A@CB@B@B@@@BB@DBB@@B@@@BABCF@B@@@BBBAB@B@B@BA@@@AACAAAA@A@A@A@ADA@ABA@@BE@IBCAA@@@AA@@@A@ABA@ABABE@@@A@@ACA@A@@@A@A@A@CDABAB@DAB@F@@@BABCD@@A@A@ABEAA@A@EBCBAB@@C@A@A@A@CC@@CAA@A@A@A@@BABADABCB@@ABA@C@A@AA@@C@ABABA@C@IBA@A@ABEBEBA@@@A@A@ABA@GBA@CB@@AD@BA@ABA@ABA@ADA@A@@B@BAB@@CBA@@B@B@BAF@BA@@FAB@@CBCB@@@@ABADAB@@CBA@A@CBA@A@GB@@A@CB@BA@A@@@EAAAC@@@A@@@CB@@A@A@A@A@CA@@CAA@A@C@A@CB@@ABCFCB@BA@@@CBA@A@@BA@AD@@ABA@CBC@A@A@A@EAA@ABEBEB@BABAFAB@@A@A@ABABA@ABCDEBAB@@E@A@ABCDADA@@B@B@BBB@BBBB@B@@@BAD@@@BBB@B@B@@@DABAJ@D@B@B@HBB@BB@@@B@@@@ABABABE@ABA@AB@BA@EDA@EBA@CDAB@@A@AB@@CA@@C@@@AAAA@C@CAA@AEAA@@A@A@@@AA@EAC@A@CDEBABA@ABABA@ABC@C@C@CBCBABEDCBC@ADCBA@ABB@@@@BBB@@@BB@B@B@D@BB@@BBB@@@B@DB@@DBB@D@B@BBFBBBB@FDBBD@BBB@@@BBA@@@CAE@CBC@@@AACBA@ABA@@B@@BBBB@B@@@B@@CB@B@B@B@@B@@B@BB@@@B@@BBB@BBB@B@B@B@@B@B@D@@@B@@@@BCB@B@@BD@@B@@@@BB@@B@B@@@@@@BBB@@BB@@@@@@BB@@@@@@BB@@BBB@@B@D@@@BB@@BB@BB@@@DD@@@BBB@B@D@B@@B@DBB@@BB@@@B@@@B@@B@@@BA@@@CA@@A@AB@@AB@@A@@@@@BB@@@@@B@@@@@BD@@BBB@@BBB@B@@B@B@@@BBB@@@BA@@@@B@@@BA@@@AB@@@@@B@B@@@BBB@@@@BA@@DA@@B@@B@B@@AB@@@D@@@@DD@@B@@AB@@@@B@B@@@B@@@B@@B@BBB@D@BBB@@@AD@B@BA@@B@B@BB@@BB@BBA@@@@BA@@@A@@@B@@@BB@@@B@DA@@BB@@B@@@HA@@@@@DB@@@@@@@BABA@@@@BBB@B@@@B@@AB@@@@@B@BADB@DBDD@@BB@BBB@BDD@BB@BBDB@@D@DBB@BB@@BB@@B@B@BBB@BBBB@BB@B@@AB@@BB@BB@@B@@@D@B@B@DDD@@BB@@B@B@B@B@H@B@@@B@B@B@D@B@B@HB@@F@B@D@H@B@F@B@B@BCB@@@B@B@@GD@B@@@@ABBBADAB@@@B@B@B@B@@@D@DAD@BBBBD@BBBDF@@BB@D@@@D@@@D@B@B@@@BA@@BCB@B@@@B@@AB@BAACB@@@B@B@@@@ABC@ABABABE@@B@BBBB@BDDDB@@B@BB@BD@B@@@BA@BBABA@A@ABA@AB@@AB@BBBBBBDB@DB@@DBDB@@BB@@DF@B@@AN@B@B@B@@@BBBB@BBB@@BBBBB@@FBDDD@DBB@@@B@BBB@DBBBB@F@D@DDHBB@D@DBB@DAB@@@B@BA@@B@H@DAB@BBD@B@B@@B@@@BB@B@B@B@@B@@@@B@@BB@BB@@BBD@D@B@D@@@BABBB@B@BB@BB@@@B@B@B@J@@@B@B@@@B@BB@@F@B@@@BA@ABABAB@@@B@BBB@H@@B@@@@B@B@B@D@BBB@@@B@B@@@AB@BABBB@@BDB@@BB@BBB@B@BBB@BB@BBB@@@B@@BBBBB@@B@B@B@@BB@@B@BBB@@BB@@B@B@@AB@BB@@DBB@@BBBBD@@BBBB@@BBBDBB@@B@@@B@@@@@@@DBBABB@@BB@D@FB@B@@BB@@BBD@@BB@BBB@@B@B@B@B@B@@BBB@DD@@@@@B@BABCD@@ABA@EDAB@B@B@@@BB@D@B@B@BAB@BBB@B@B@D@B@@@B@@BBB@BBBBB@@BBBBB@DBB@B@@@@BB@@@B@BB@@@@BBB@BBB@@@@@B@@@B@@AB@B@F@@@D@F@B@@@B@B@BBB@@@BB@@@@@@@@BB@@@@@@DB@@BBBBB@@@@B@@@@BB@@@@@@BBB@FBFB@DBB@@BBB@@FB@B@B@BBDB@@B@BDBADBBAB@DA@@@C@@BA@@BAB@BA@@D@F@F@B@B@B@DAB@@@B@@BBABA@@@AAA@A@ABABCB@AAACAAAA@AAC@A@C@@AE@@@@FADABAB@BA@@@A@@AA@C@ABC@AB@@ADC@ABABCBC@AB@BCBABA@A@AB@@A@@BABAB@BEB@@AB@AA@CAA@AA@@ACA@AC@@@@A@AACBC@CBC@C@A@@@AAA@AAC@@ACAA@CA@@G@AAC@@EE@AA@A@A@AAC@A@A@EAA@AAAAA@A@A@CBABA@A@A@@AAAA@@@C@A@@AA@@A@@A@@@A@@A@A@@@ABCBA@A@A@@@AAA@@@@DCB@BAB@FABADA@A@@CA@AA@@E@A@A@A@C@A@C@AA@@AE@@@BE@A@@AACCAAAAAC@@@A@@BABC@A@@@ACEAAAA@@@ADABA@AB@B@@AB@@A@C@ABA@A@@B@B@B@B@@@B@B@@@@FB@@@BB@@@@B@@BBB@@@@BBB@@B@@DBB@BB@@@@BB@B@BB@@BB@DAD@B@@AB@B@B@@@BE@A@@@@BA@@@AB@DEDCBCBA@@BA@ABA@A@ABAB@B@B@@A@A@A@A@A@C@@@@B@@A@@AA@@AA@@C@AACB@@@A@A@@@@B@@@BAB@BAD@@@BBD@B@D@@@BA@@B@BAB@@@@@BB@@BBB@BA@C@@AAA@@A@@@@@@B@B@D@B@BA@@@C@@B@B@B@@@@@@@@A@@BADC@@BA@ABA@A@@@A@@@C@C@AAAA@AAACA@@@A@EBA@@@A@@@@A@@BCBA@A@@@A@A@A@@@@AA@AAA@@AA@@B@BAB@@A@@@C@@@AD@@@BB@B@@B@@A@@BA@@BA@@BCBAB@BA@@DABA@@@AB@@@@@@@B@@B@@BBDAB@@@FC@@B@@B@B@BB@B@BA@@@A@ABABA@@BAB@B@BABB@@B@@@FDDB@@B@@ABBFB@@B@@@B@@@@@B@@@B@@@@@@@B@@@@@B@B@B@@@F@@@B@B@@@@B@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@BB@BDBBB@@@@@AAE@AB@@@B@B@F@DAF@@@B@@@@B@@@@B@B@@B@BBBB@@BB@B@@BB@B@@@BB@BBB@@BAD@DA@BBBBBB@BB@BBAB@@AB@@@B@@@BD@BB@B@@@D@D@DA@@@@B@@D@@BB@@ABA@@B@B@B@BBBBB@B@@B@B@@@BA@A@A@A@A@@@A@A@AAA@@BA@A@@@AAC@@AA@@@A@AAA@A@@@@@@DBB@@@@@BA@@@AB@@ABA@@AE@A@@@@@@DA@A@C@@@A@A@A@@@@@@BA@A@A@A@@B@D@B@@@BABA@AB@@ABC@A@E@A@@BAB@BA@@@A@@@A@@DBB@@@@@DA@@@@@@@A@@@@B@D@B@@ADABABAB@DC@@BA@ABABADCB@@@B@B@@BBBBF@@DA@@@@@AAA@A@@@E@@@@D@B@D@D@H@@@B@@A@@BA@A@@@AB@B@BA@@BAD@BA@@@@@A@@@@CCCC@A@@@@B@BBB@B@@@@@@A@A@EAA@A@@@AA@@AE@EAAA@AAAAA@AA@AC@@AA@@@CCAA@AAA@@@AA@@@@@ABA@A@@BAB@@@@A@@@@@@@A@@@@@@@@AA@@@@@@@A@@@@A@@@@A@@@@@A@@BA@@@@@@@@@@@@@A@@@@@@BA@@@@@@@@@A@@@@@@@@@@@@AA@@@A@@@AA@@A@@@@@@BA@@@@@@BA@@B@@@@@@@@A@@@@@@@@@@AA@A@AA@@AB@@@@@BAB@@A@@@@B@@@B@F@B@BAB@@@@@B@B@@@BA@@DABA@@@@@@AA@@ACAAAC@@BA@@@@@A@A@@@A@A@AA@@A@@A@AA@A@@@@@@@@@@@CB@@ABA@@@A@@A@@@A@@@AAAAACACAA@ACGAA@@@AA@@A@A@@@A@A@@@@@@@@@@BA@@@A@@@@AA@@@A@A@A@@@A@@@ABAB@B@B@@@B@B@@@B@@@@@B@B@@@B@D@@@@@B@D@@A@@B@@@@@BADAB@@@@AB@@@@A@@@@AA@@AA@A@@@A@@@@B@@AD@@@@@@@BABA@@BA@@@AB@BA@@@@@@AA@@@A@@AC@@@@@E@A@A@@BCB@@A@A@@@A@AB@AA@@@A@@AA@A@C@A@@@@LKAEAABEAAAEAEACCCCCECCAACCECGEEACECCEECCCKIICC@M@A@EBG@ECACCECOCCICGCAAABCBA@@@@BAAC@A@A@A@AAC@@@A@A@ABABA@A@C@ABA@@BA@@BCB@@A@A@A@A@A@@AA@CACAA@C@A@A@@BAB@@@@A@CAA@AA@AAAA@@@AAA@@BA@AB@B@@@B@@BBD@@BBB@BA@@@ABA@A@@@AA@@A@@@AB@DA@@BA@A@A@A@@B@@@@@J@DCF@B@@AB@@@DAB@@AB@@AAECAAC@A@@BA@@BBBBBBB@BADABABCDABC@CBA@A@A@AB@@@B@B@B@BA@@BA@@@AAA@@@AIA@CE@@AA@A@@A@@@A@C@E@GBC@EB@BC@@@A@@B@@ABCF@@@BAB@BA@@BA@@@CAAACA@@A@@@@D@BA@@B@@CBCBA@@@AA@A@A@A@@@ABA@@BA@A@AA@@@CAA@AA@@A@C@A@KBC@C@A@@AA@@AA@@AB@BABAA@@AA@@@A@A@E@CBE@A@AAA@A@AAAA@@@A@ABA@A@@B@D@F@BABABAB@@CB@@C@@@A@@AAA@@@@BABAD@B@BA@ABC@A@@@AAAA@@A@@@C@@@C@C@ABAA@A@@@@A@BA@@B@DAB@@@B@@A@CA@@ACAA@CAG@C@@@IDA@A@A@AAAA@@"],encodeOffsets:[[108232,34355]]}},{type:"Feature",id:"621222",properties:{name:"文县",cp:[104.683433,32.943815],childNum:1},geometry:{type:"Polygon",coordinates:["@@CHA@CBK@GDABCDABCBGBE@I@E@C@GDEBODAAC@A@@AEGCA@@CCCACACAE@GAA@CB@@A@EAC@CBEACAAAGECAEBC@C@C@E@ECIECCMECAEAGAGCC@EAEACAAACCACACEAE@G@ECGAAAEAC@A@C@K@I@EAEBECA@A@ADCDEFA@AD@F@FBJADCFADCDCDGDCBCBCBADABADGHADCDAJEBEBGFGDED@@IBGAA@AA@CAGAAACECCAQEMGC@CBG@C@CAM@E@IDE@I@GCECGCEAECIACBABEFCDA@KHGDEJKPGHEHEFKDKCKGQEOCC@CBC@C@C@C@C@CAGAC@A@A@C@ABABABABCBABAB@@ADABABABCDA@EDEDABAD@BA@ABCBCBCBABEDC@CBC@EBCBC@CBABABABAD@BABAB@BADABAB@BAD@B@@BD@B@DAB@B@B@@@B@BABABA@GDCB@BAB@@CBABC@ABE@CBC@ABC@ABCBA@C@AACACACACACAEACAEAC@C@ABABA@ABAD@DABAB@DABAB@B@B@D@B@B@@A@C@A@AAA@CB@BAB@BADABBB@DAB@BA@A@EBC@GBE@C@A@C@CAA@CACAAACAE@CAC@AACAC@E@C@C@C@C@A@C@AB@B@B@BABABAB@BABCBABABADC@ABC@C@C@CBA@ABC@ABABC@ABC@AAC@AB@@@BBB@BBBB@@BBD@BAB@BABCBABA@CBA@CBC@CBC@CBC@CBABABCB@B@B@B@@@BAB@@A@ABCBC@ABEBABA@@BA@AB@BABCBCBABCBA@C@A@C@A@@BBB@B@B@B@@@D@BA@ABABA@AB@BAD@B@BC@ABABC@C@A@E@C@C@CBE@C@ABC@C@C@CAC@A@A@C@ABABCBA@A@C@CBCAABC@ABABABA@A@ABA@AAC@CAC@@@ADAD@BA@A@C@@BABBF@B@@@BA@AD@@@D@B@D@D@BAD@B@BAD@B@D@DAB@D@D@BAD@BCBAB@BABAB@D@BABBBAB@@CBABABAB@BAB@B@B@B@@@D@BAD@DBFBB@BDD@B@BBB@FBDBFDF@J@HBJ@HBF@DBBBBB@D@D@B@BB@B@BBDB@@BHDBBB@D@B@BADABADAHCF@B@B@B@DDFBD@FAD@HCFCHEBAD@DBD@F@DBD@NBDBHDJJ@@FFBHBF@H@HAJ@H@J@JDHDHHBHBHBHDHDHFHBLDJBJFBFAHCD@@EHIFGDKFIBIDKBIAKAA@ICICKAG@IBIBIDGFGFCF@JDFFFHBFD@DHHFHHHBLFH@@BBDDD@D@D@@@@B@@BDA@@@@BA@A@@@ABCBC@A@CA@@ABAF@DBDBF@DBBHBDBHDDDD@BBB@@D@D@DCHAFCFEHEHINAFEJCLCHCDADAL@JAJCFCLAFADCFADELEHCLAD@FAJCL@JALB@BB@B@D@DAJ@FBDAFAFCDCDAB@DBH@DADADAFADAFADCBGFCBCDBD@B@D@DADCBEF@@@BBBBB@@D@D@FBFBDDDBBBHDFDB@DBD@DAFADCHIFEDCDCD@J@D@J@F@R@JAD@JAFAJCBAFEDCHGJEDA@@D@BBBB@BADADABA@ABAAABGFEDED@B@B@@@B@D@B@B@B@@CB@B@B@BCDA@BBDB@BB@@@LBHDJDLFLBHBTC@B@@DBBBDBFBB@BBBBB@B@D@D@B@DBDBBBB@BB@@BB@@DBB@D@@@BABAB@DAHCD@HAB@D@BAD@@B@@@B@B@@BB@@BD@BB@JFDBFFB@@B@@@A@@BA@A@@BABEBCDEBABABA@@BABAB@@AB@DE@ABCDCBA@ABCBEDI@C@ADABC@AFEDCBAAA@CA@@AAA@A@ADA@AB@BABA@ADAB@@ADC@ABA@@CC@A@C@@AAAC@AACA@@AAC@A@A@@@CAG@E@C@KBA@ABA@@BA@CBG@ABAB@@ABA@@@@BA@@HCB@FDD@B@FDHDB@B@@A@CDE@@B@DAB@BABAF@DAB@BADABADA@@B@FBDFD@JDBB@@B@@@DEBAB@B@B@BBBB@@BBBDB@@@D@DBD@DB@@DDBBBBB@@@D@@@@@BBBB@BB@BABAD@B@B@@@DBB@@@B@@@BBBBJ@B@B@B@B@B@@BABCDABAD@@AF@D@B@BBB@B@BBB@BBBBBBB@@BBBB@@AD@B@B@@@BDDDF@B@@@BCD@@@D@D@D@B@@BB@BBBB@H@B@B@@@BBBBDDDDFFB@BB@H@B@D@D@@@BB@DBDBJB@@B@DABAH@@AB@BABAFBF@DBB@B@D@BAB@D@BBDBD@D@DD@@B@@@BA@@BADCD@DAD@BABADA@A@@B@BBDBB@F@@BB@@DB@@BB@B@B@B@@@BBB@BB@BB@B@D@DBD@D@DBB@B@H@@@BDBD@BDL@@B@B@B@BA@@B@@AB@D@DBFAF@D@B@BA@@DBD@FBJBB@B@F@D@D@B@DBH@FBB@@B@@@D@@BB@@D@D@JAB@BAB@DABA@@F@B@DA@ABAHCD@B@DAD@DAB@BBD@@@DEBAB@BABA@@@AAABC@A@@@A@@BA@@@@@A@ABABC@@@@@C@A@AAA@A@@@C@C@ABA@@@@BA@@@@B@@@B@B@F@BBB@B@B@@@@A@@B@DBDAB@B@BA@A@@@A@@@@BAD@B@BADAB@B@D@B@@ADG@CB@@@B@@@B@B@DBBBB@D@D@@@@@BA@A@ABCBCBC@A@ABABA@@DABA@@@A@C@A@@BA@@H@DAD@@@BA@@BE@A@@B@@AB@@@BAB@B@B@B@DADA@@BCBA@@BEDCBA@@B@@@B@B@D@FABA@@BC@A@ADCDEBCB@BAFAB@B@B@@ADABADC@A@C@A@@@ABA@@BA@ABA@@B@@@B@DCBA@@DABA@@BBD@B@B@B@@@@A@@@A@@B@@ABA@A@AB@@A@CDCBABABA@@B@H@BAHAB@BAB@@@L@D@DA@@@AA@@@AAA@@@AAB@BABA@A@@@A@@A@CC@@AA@ABABABC@@@C@@ACAACA@AAC@A@C@AAACACACEA@AAAAA@CACA@@A@C@A@AAA@AA@A@@@A@AB@BCDA@CDE@A@C@A@ABEBG@CBA@E@ABABADCDAB@BA@@@@BABABC@AB@@A@@A@A@C@A@AA@@@AAA@C@@@A@A@@@A@A@A@@@@CA@@@A@ABA@@@CAC@ABC@C@@@@ACA@AA@@@AAC@@@A@@BC@A@@BC@AA@@C@AAA@C@AB@DCFC@@@A@@@A@@AA@@C@A@AA@AA@A@@@A@@A@@@A@@@A@@AAA@C@AAA@AAA@AAAACBA@AA@@ABE@@@CAA@A@AAAA@A@@AAAABC@A@@@A@AA@AA@A@@@A@A@CBEBA@E@@@A@A@A@A@@@@DADABABA@@DCBAB@@ABC@A@C@A@@@ABA@@BABA@ABABAB@@A@@@AAA@@@ABA@A@A@@@AAA@@@C@A@@@A@@BABAD@DA@@BA@@@C@@@ABA@@@AB@BA@@BABC@A@A@@@A@A@CAA@@@A@A@@AC@@@A@ABABAB@@A@A@A@@@@B@B@DG@AB@@A@@BAB@@@@A@ABABABAB@DA@@DAB@D@DAF@DA@@DCB@BA@@BADEB@FCDEDAB@FABAB@BABA@ADC@A@ABC@@BAD@JIBCBC@@B@DAD@B@@AB@DABAB@F@DBD@BB@@BA@@@@AC@A@@@@DA@@B@@@@@@A@A@A@@BA@@@A@@@@AA@A@@@A@@@@B@B@@@BA@A@@@@@@B@B@@BBA@@@A@@@CB@@A@@@AAA@@BA@@BAD@@@BB@@B@@@B@B@BA@@@@@AA@AA@@@@@AB@@@DAD@B@@@@@@A@@B@D@@@B@@@@ADE@AB@@A@@@@@AA@A@@ABA@AA@@AA@AAA@@@@A@A@AAABABAH@B@FADCDEBA@AD@BAB@HCFC@A@@@BBB@B@BBB@@B@D@BB@@B@D@B@BB@@@B@D@BAB@@@BBBDD@BBBBB@BBBBD@D@BBB@BFD@@B@@@B@D@BAB@B@FB@@B@@BB@@B@@@B@B@@@@B@BBBBB@@@BAB@B@B@@BB@B@HADAB@FDBBDBB@@@BA@@AABAB@@@BAB@@CBABC@@@A@A@@@@B@@A@@@@B@@A@@@@B@B@@@BA@@A@@@@AA@@@BC@A@ADCB@@A@BB@@@BBB@B@D@@@@B@D@BB@BBB@@BBBDD@BBB@D@B@@BBB@@B@B@B@@BBHDDBBBDH@B@@AB@@@BDF@FBBBDBB@@@B@B@BAB@B@BBBB@@BB@D@B@B@BBD@B@FDBBB@@@@@DADC@@BABADCB@@@BA@@DAFEDAB@@ABABE@ABCBABA@@@ABA@CBABA@CB@@A@@@@@A@AA@@AB@@AB@BA@@BABADA@AB@@@B@D@DABAFABABCBA@@@@@CB@@A@@AC@AAA@A@ABA@AB@DC@@@CB@BA@ADADABADCD@BA@@BC@CBABE@@AA@CA@@A@@@AB@BABAB@@AB@AC@A@C@A@ABA@AHE@A@@AA@A@@AA@@@AB@BADA@ABABABABA@A@@@AAA@@@@@ADA@@BAFCB@DA@@@A@@B@B@@BB@BBB@BD@BBBBBBDB@B@@B@B@@BB@@@AD@B@B@BAB@@@F@@@B@@@B@B@B@@BB@DBDBDDBDBB@@@@@B@B@F@@@@BDBBBBBBBB@D@@@BAB@B@B@B@B@@@B@@@@BABAB@D@B@B@B@B@DFB@B@B@DADAB@@@B@D@BBF@B@HB@@B@FBB@@A@@@@@@BABABABAB@HEB@@ABA@@BABAB@@A@A@AB@BABC@@@@@@BABADC@ABABA@ABC@@@CB@@ABA@A@AB@@@BA@@BC@C@ABAB@@A@A@@BA@@@A@ABC@A@ABCB@BAB@@GAEAC@A@@@AF@BAD@FAD@FBB@D@BABA@A@AAC@A@A@CBCBA@@B@B@DD@B@@@B@B@B@@BBBB@@B@D@BA@ABA@A@@@ADA@A@ABIBECCCAAA@A@G@GBA@A@AGAAAAAAACAC@GBCBG@E@C@C@@@E@CAEGGGCASGCACACAAACACAC@E@ICQEABADCBA@CAECGIEGEACE@CGKCC@EACMGC@E@MAEAAGACC@G@M@C@CECAGIAEEECAEAC@E@GBEBC@EAGAGCEACBA@CDEDQRGDCBC@CAIAIACBCBE@EACG@ACGBWAK@ECCCAEAA@IAA@@A@@BABA@A@AC@O@GAEAAAAG@A@CAAA@GBCBAAACACAAAAC@A@CAEAIAAACAA@EAC@C@AAA@ACAAAAE@EAG@AAA@A@AAMAGAC@A@GCC@CBABAD@@ABCDCBEDABABADAFADAFABCD@@@DGLABA@AD@BADAB"],encodeOffsets:[[107604,33417]]}},{type:"Feature",id:"621223",properties:{name:"宕昌县",cp:[104.393385,34.047261],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@C@C@CAA@AA@A@@AB@@ABC@@@CBAA@@@@@C@C@@ACACA@AAA@A@A@@@AA@@@A@@@@BBB@B@BABABA@@@A@@B@@@@@B@@@BAB@@@@A@@@AC@@AA@@A@@@A@AAA@AE@C@CAC@@@@@A@@DADAD@@A@A@@@A@C@IDC@A@C@C@E@EBC@@@A@@@@AA@A@@AA@@@@A@A@@@A@@@A@@@AA@@A@A@@@@AA@@@@AA@@@@@A@A@@@A@@@A@A@@@A@AA@@A@@@@@@BA@@@@BA@@@@@A@@B@@@@@@@BABA@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@AA@@@@@@@@@@@@AA@@@@@@@@@@A@@A@@@@@A@@AA@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@AA@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@A@@@@@@@@@@B@@@@A@@@@@@@A@@@@@@@@@A@@B@@@@@@@@A@@@@B@@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@A@@@@@@@@A@@@@@@A@@@@@@@@@@@A@@@@AC@@@@BA@ADA@@@A@AAAAAAAAA@A@A@@@C@AAAAA@@A@@@ABC@@@A@ABA@@@CCACA@BA@CBA@AA@@@@A@C@A@A@ABAB@BAF@@@B@@AB@@A@@DBD@@@BADA@A@@F@B@B@BABADC@@@@@A@@DA@@BA@ABA@@@@BCB@@@D@@@@AB@@@DAB@B@@@@@@AAC@@@AA@@A@A@@@@B@AA@@A@@@AA@@ABA@@@ABAD@@A@AB@@C@A@CAAAA@@AAAA@EAEAA@AAAAA@CDA@A@A@A@CAC@@ACAAAAACAAA@@@AAA@A@@@@D@B@@@@A@ABCBC@A@@@A@A@@@A@@DEBA@A@A@ABADC@AB@D@FABAD@@@DAB@B@BAFADAB@DAB@BABABAB@BADCDADAB@@AD@D@DAB@BABABA@ADADA@@B@B@@@B@BC@ADC@AB@@@BB@@B@@C@@AA@@@@A@AA@A@AAA@A@@@@@A@@@@@@@@AAA@@A@AA@@A@A@A@AAA@@A@ABA@A@@@A@A@@@A@@@A@A@A@@@CAA@@@A@@A@ABA@@@E@A@CAE@CBE@C@@@ABA@A@A@@AA@@@A@@@@@AA@@@@A@@AA@@@A@@@C@AB@BA@@@A@@@AA@@@@@A@@AAA@@A@E@CBA@C@@@AA@@@@AA@AC@E@C@CAE@A@@@AAAA@A@A@ADE@@@AA@CAC@A@I@AAEBAA@@@@@@A@@@@@@AA@@@@@@A@@@@@@@@A@@@@A@@A@@@@@C@A@A@@@@@@@@@@@A@A@ABA@@@@@A@AA@@A@@A@A@A@E@ABE@@BA@@B@DABA@AB@@C@AB@BA@@D@B@@A@ABADC@CB@BABAB@@@@AAC@A@@BA@@@ADE@@BAB@@AB@BC@@D@BA@@B@@A@@@AAA@A@@BABCDCBA@@@AB@B@BA@@@@@@@A@@A@A@A@A@A@@A@@@@C@A@@A@@A@CA@@@@@@@AA@@@@A@A@@@@@@@@@@@@A@@A@@@@AAAC@@@@@@@@@@@@@@A@@@@@@@A@@@@AA@@@@@@@@@@@A@@@A@@@@@CA@@EACAECAAECCAII@AA@AA@@A@ABA@ABA@ABCDCD@@@D@@@D@@ABA@@@@@AA@A@@BAA@@@@@A@@@A@@@A@ABA@A@@BA@@@A@A@A@@A@@A@A@@@@@ABABAB@@A@@@@A@@@@@@@@@A@@@@@AA@@@@@@@@@@@@A@@A@@@@@@@@AA@@@@@@@A@@@@@@@A@@@@A@@A@@A@@ABA@A@A@A@A@@@@@ABAB@@@@@@@@@@@@A@@@@@AB@BA@@B@@AB@BABAB@@@BAB@@@@@@A@ABCBA@ABA@ABA@EBEBCBA@@@@B@B@@@BBD@@@B@B@@B@@B@F@B@B@@@@O@ABA@CB@@@@BBB@B@@@B@@@@@BB@@A@AD@@@B@@B@B@DD@@B@DDB@BB@@B@@@ABABAB@B@@@@@@B@DADABAB@@@BB@@@B@@@@@B@BA@@@C@A@A@ABA@CBABADABA@AD@B@@B@@@F@@@BBB@BB@@@BA@@@CBC@@B@BBB@@@@B@B@BA@@B@@@BB@@@@@B@@BAB@@@@@@B@@@@@B@@@@@B@B@@@@ABCB@BEBA@C@@@A@AD@@A@A@@@@@A@ACA@@A@@@AAA@AAAAA@@@ABA@C@@AAAA@@AA@A@@@ABABC@@BA@@@@@A@@@@@@@@A@AB@@@@@@AB@@A@@@@@AA@@@@@@A@@@@@@@AB@@@@@@A@@@A@@@@@@@@@@A@@@A@@@@@@A@@A@@@@A@@A@@@@CA@@@@@@@@A@@@@@A@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@@A@@@A@@@@@A@A@@@@@@@A@@@A@A@@@CBEA@@AA@@AA@@@@A@A@@AA@AA@@AACAA@AECC@A@@AA@@B@BA@@@A@A@@BCAC@@@A@A@A@CAA@ABA@CB@DA@@JAB@@@BADCDA@AB@B@@@B@BA@@@AAA@@@AA@@AA@AA@A@@A@CA@ACAAAAAC@AA@@@@@BAF@@@@B@@@@B@@@@A@AB@@A@A@@@C@@@A@A@A@C@@@A@@@@@@@ABABEFABCD@BA@@BA@C@@@@AA@@AAA@@@@@A@AAA@@@@@A@A@@CB@@A@@B@@@@@@@@@B@@@@@@@@@@@@@@BB@B@B@@@@B@@@@@@B@@@@BB@B@@@B@@@@B@@@@B@@@@B@@@B@@B@@@@B@@BB@@@@@B@@BB@@B@@@@@@@BB@@@@B@@@@@@@@@@@@A@@@@@@@@@A@@@@BA@@@A@AB@@@@A@@BA@@@@@@BA@A@A@@AA@@@@@AA@@AAA@@AA@AA@A@@@@AAAA@@A@@AA@@@@@AB@@@@@@@B@B@@@D@@@B@@ADADABADA@@BAB@@A@AAA@A@A@A@A@@BA@@@AAA@@@A@A@@@@@CB@@@B@@D@DBDBD@@@@D@@@B@BA@A@A@C@E@C@A@@@@@@B@BAB@@@D@@H@F@@@@@@BAF@B@@BB@BBB@@B@@@@@@B@@AD@@B@BBBBB@@@@@ABA@A@ADA@CB@@B@@@B@BAB@@@B@B@FAB@B@B@D@B@@@@@@@@@@B@@BA@@B@@BBB@@@@AB@@@B@B@B@@@B@@@@B@BA@@BBBB@@D@@@B@B@@@@B@D@B@@A@@BA@ED@@AB@@ABCD@@ABA@@@AAAC@@C@A@ABA@@@@@@CAA@A@@AA@@CCAA@@AA@@A@@B@@@BA@@@AA@@@BA@@@A@@@@A@@@@AB@@@A@@@@A@@@@@@@A@A@A@C@@@A@@A@@A@A@@BE@@@@@@@A@ABA@@BCD@@@B@@BB@BB@@@@B@B@@AB@BCD@@@@@BAB@B@B@BBB@B@@B@@ABAB@D@BA@@FAB@@@BB@@BBB@@@@BA@@B@@AB@D@@AFCH@DAFADABAB@@A@A@C@C@@@A@@BA@@B@B@@CB@@A@ABCBA@@BAB@BAB@@ABCB@@A@@BBBBBBBBDDD@BB@@B@@C@A@@BA@@@@B@@@BA@@@@@@@@B@@@@@@BB@@@@@BB@@B@@B@@@@@@BD@@@@B@B@@B@@@@@BB@@@ABB@@BA@@@BBB@@B@@@BB@@BB@@B@@@@@@@@@B@@@@@@B@@D@B@@@@@@B@@A@AB@@@@@B@@B@@@@BBAB@B@BB@@@@AB@B@@@@B@B@DBBBBD@@BBB@DB@@@ABCB@@AB@@@@BDB@B@@BBBBBD@@BB@@BBDBB@@@@B@B@D@@BB@BBB@@@DBBB@BB@BBBDB@B@@@B@B@B@B@B@BBB@BDD@@BB@@AB@BA@E@A@ABABEBA@@@A@@AAAC@@@@A@@@@A@CBA@@@@@@@@B@@@@@@@@C@A@ABA@CDA@CBADA@A@@BA@@BA@@@ABCBABA@ABA@@@@@AAA@A@@@A@@@A@@@@@@A@@G@A@@AA@@@A@E@A@GB@@A@A@AA@@AB@@E@C@E@A@@@CBA@A@ABA@@@ABCBA@@BABA@@@@DAD@@ABA@@@A@@@A@@@@@BB@@AD@B@BAB@@@DA@ADABAD@@A@@B@@@B@@@B@B@@@B@BB@@BBDBBBB@@@BADABCDCBCBED@@CBA@AB@@@BAB@@@@AB@B@D@@@@@BC@A@A@EBA@AB@@ADABA@@@@DABADAB@B@BA@@BCDCDA@@D@@ABAB@BC@A@C@EBC@@BEAC@A@G@@@AB@@A@A@A@C@G@C@CAA@CBA@@@CAA@A@A@@@A@A@@B@BA@@@E@AB@BA@CDA@CAA@ABCB@@A@CC@@A@AAA@AAA@@@CBAB@@AB@B@B@@BD@@@@AFA@AB@@A@A@@@ADA@ABABABA@@B@D@@@@A@ABA@@B@@@B@BA@ABABA@C@@@ABBDAB@@@@AB@@A@A@@@AB@@A@A@@@A@EA@@CBA@@B@BCB@BA@A@@B@@AB@@A@@@A@A@A@A@@@AAA@A@A@@BA@C@CB@@@@@B@@A@A@A@@B@@CDCBCB@BA@C@@@CA@@@B@@BB@B@BAB@@ABABA@@@CBAB@@A@C@@@CDA@A@CB@BAD@@@BA@ABEBAB@BA@@@ABAB@@ABABABABABGB@@@B@@B@@B@@AF@B@BAB@@ABCBA@E@@BA@ABAB@B@@A@A@@@A@ABA@@@A@@@G@@@@@AB@@C@@@A@@B@@C@A@ABABA@@@E@@@A@E@@@ABA@ABCDAB@@A@CB@@ABEBABCBA@@@@@@B@BA@@BABABCBA@@DCBAB@@A@BB@@@DBB@@AB@@@@GBIDA@ABA@A@A@ABABA@ABAB@B@@ABCBA@@@@BA@@B@@ABA@A@@@CAAA@@ABCBAB@@@D@@@@ABCBABA@@BAB@BABA@@@A@A@AAA@A@A@A@@AA@C@@@A@A@E@A@EB@@CDA@CBCB@BEDABAB@@@@BBDF@@@B@@ABADABAB@B@B@BAB@@CBC@A@C@C@@@A@@@A@ABCBCB@@A@@BCB@@AB@@C@CA@@EAAAAAA@A@AB@B@B@@@@DBBB@B@BBBAD@BCDCDA@@B@B@@@BEBABAD@BABCB@@@@BBBB@B@B@BAD@B@@GB@BA@@@CA@@ABA@A@AA@@@@ABAB@@A@G@A@A@A@C@C@C@A@@B@@AD@@@BBDA@CBAB@B@@@B@BA@ABA@@BCDAB@@AB@@@@@@A@BB@DB@@BBB@BBB@B@@@@@@ED@B@@AB@BC@AB@@@BABBD@BB@@B@B@@BBAB@B@@@B@BB@@BFFDF@@@B@@@@CDAB@@@B@@@BBB@BBB@BAFBDBB@BBDBB@@@D@@@D@B@D@D@@DBBB@@@B@B@B@B@@BB@@BDDD@BB@B@BB@@@BBD@D@F@@@BB@BB@@B@@B@@@@BBBBBBB@B@@BB@BB@@@@BB@BB@@@DB@@B@@@@BA@@B@B@@BD@B@B@B@@@BB@BBBDBB@BBB@BB@B@@@DD@BBB@@AFADDBFBDD@@@@@B@BAD@B@@@B@BB@@D@BB@@@B@@@B@B@@AB@DB@@BA@@BA@@DABABAD@B@@@B@B@@@BBB@B@B@B@D@B@B@DB@@BB@BB@@@@BBBB@B@B@B@@ABA@@D@@@D@B@BBDAD@FB@@B@BA@@F@DAB@BBBBFBDDB@DCDA@@DABAB@@@@A@@BAB@@@B@B@@@BBB@B@B@@BB@@B@B@@@B@B@D@@@DBBDD@@BBDBB@@BBBBB@BB@@@BBB@B@B@B@B@@@@B@@@@@D@@@B@@@@AB@D@B@@AB@@@B@@B@@BB@@@@B@@CB@@BDAB@@A@@@ABA@@@A@@H@B@B@B@DAB@@@B@B@@@BBBBB@@BD@BBB@BBBA@@@@BA@CBABAB@@@BA@@@A@EBA@ABC@CBA@CA@@CBCBABABCBA@@@ABA@@@ADADCB@B@@@BA@@@ADA@@BBBB@@B@B@B@B@BAB@B@@@BCDAB@B@@@B@@@B@B@@A@ABA@@@ABCHAD@B@B@@AD@BAB@DA@@BA@C@@BABABAB@B@B@@@@BBBB@@@@ABADAB@@ABAFA@B@@B@BB@BB@B@@@B@BBBB@B@BBH@B@BBBBB@B@@@B@B@B@BABB@@@@@BB@@BBBB@@@AB@BAB@@@BB@@B@@B@B@@@BBA@BB@@@BBB@@B@D@B@@@DA@@DDD@D@@ABB@@@@@B@D@B@@@BBD@D@B@B@D@@B@B@B@BBB@@@BBBB@@DBBBBBB@@@@@DA@@@A@A@@BABABA@@@@B@B@@@BBD@B@DBB@@@BBBBB@B@D@@@B@BABBB@@@BDDB@BBB@B@@AB@B@@@@BBB@@@BDBB@BBD@BB@@@F@B@B@BBDDBB@@D@BA@@@@B@@CB@@AB@@A@@DAFEBA@@B@DAB@B@@@BA@AB@@@BA@@B@BBDAB@@@B@@@@@DAFA@@BAB@@CB@@@D@D@@ABB@@B@BBBB@@@B@@B@BB@@@AB@BAB@B@B@@@DA@@B@B@@ABA@@DAB@D@B@DC@@B@B@@@B@@CBCBA@A@@@CB@ACB@@@B@@@B@BDDB@@@B@DB@BD@BDDBB@@@B@@ADABAB@@BD@D@B@BA@@@@BB@BFB@@B@BB@B@BAB@B@@@B@DA@@BB@@B@@@@B@B@BB@BB@@@BB@@@BBB@BBBB@B@BBB@@@@BBBA@@DABA@@@CBABCBA@ABA@@B@@@@@@A@@@@ACAA@@@@@A@@J@B@B@BB@@B@B@@AB@B@@@BBB@@@@B@@@B@@@D@@@BBBBBDDBBB@B@H@@@B@@DB@@@@@@@B@@@B@@@@AB@@@@A@@@@@A@@@@@@@A@@@@@AB@@@@@@@@@@AB@@@B@D@BAB@FBB@B@BAB@D@@@BAB@BAB@BAB@B@BA@@@A@A@@@@BA@@@@@@@@BA@@DC@@@@@ABA@A@@@C@AAA@@AA@A@@AA@@BABA@@AA@AAABA@@A@AAAA@@@A@@@@@ADA@@B@BB@@BA@A@AAA@A@CBA@AB@@@@@B@@@@@B@@@@@B@@A@@B@@@@@BB@@@@@@@@BB@@@@@@@@B@@@BA@@@@@@B@@A@@B@@@B@@A@@B@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@B@B@B@@@@B@@B@@@@DB@@@@@B@B@@B@@@@B@@@@@BB@@@A@@@@@@@A@@@@@@@AB@@@@A@@@@B@B@@@@@@@DD@@@@@@BB@@F@@@DBBB@@@BB@@@@@BB@@@@B@@@BA@@@@BADC@@B@@@B@@@B@BA@ABA@AB@@@BA@BB@@BBB@@B@D@DAB@B@@@@AB@@C@A@@@A@@@@B@BAB@BAAAAA@@@A@@@AD@D@@@BABA@AACAA@@@A@A@A@C@C@A@ABA@@@@DA@@DABAHCB@BABA@A@@@AB@B@@AB@@CB@@C@@AA@@@A@@@A@@CAAAA@@AB@BABA@@@A@C@@@A@@B@B@@ABC@ABABC@C@A@@CCAEA@@AA@@@AAA@@@@AA@AAA@AA@@@ABA@A@@@ADABCD@@A@ACC@@@A@@@@BAB@@@B@@@BA@@@A@@B@@C@@@@@@BAB@B@@@D@@AB@@@BA@@BC@A@@BA@C@ABC@@AAA@@A@@BABA@@@AB@@@BAB@BA@@BA@@BB@@@@B@@AB@@@B@@@BA@@@@BBB@B@@@FCHG@@B@@@B@@BBB@B@@B@@@@@DAB@B@D@@@B@B@@@D@DAB@@C@@BA@ABC@@@AB@BAB@B@@@B@DBB@B@@@BABABABAB@FAD@FAD@@@B@@@@E@@BAB@BCBABABAB@BC@@BABEB@@@B@B@DBBBBB@@BB@@BDB@@B@BBB@D@B@@@BB@@@@BA@@B@@@BAB@B@@@BBDBBBB@@ABA@@@@B@BA@@B@@EA@BB@B@B@B@BB@@@@B@@A@@BAB@D@@AB@@ABABABCBCBABA@@@A@@@@@AB@BABAB@D@B@BBB@B@@@BA@@B@@@B@@D@@B@DB@@BA@@@ABA@AAA@@BABAB@B@B@B@@@@@BDBD@@BBFBDBDB@@@B@@BBBB@@A@@BB@@@BB@@FD@@@@JDDBB@@B@@@B@@@BAB@BAB@B@B@BBBB@B@@@@@DC@@B@@@B@DBB@@@@@B@@A@AB@BC@@B@@BB@DF@@D@@B@@@B@@B@B@@@DDBB@@BAB@B@@BB@B@BDBBBB@@B@@@BA@AB@@ABCBA@@D@D@D@B@DABA@@D@@@FA@@@@AA@C@@A@A@AA@@A@@A@A@A@ABA@@A@ABC@A@@@A@@AA@AA@@@A@A@A@A@E@@@A@A@@BA@@BA@@@@@@@A@@AAA@AA@@A@@@@ABA@@AA@A@@@AA@@@C@AAA@@A@AAA@C@@@@@A@@A@BA@@B@@ABA@C@@@A@AB@@A@ADC@A@A@@@A@A@ABADAB@@@BADCBA@A@A@@@A@A@A@@@@@A@@@AD@DAB@DA@@@ABA@A@@@@@@B@F@B@@@B@@AB@@@@@D@BABA@@@A@@B@B@@AB@@A@A@@BA@@@CBC@@BC@@@@B@@A@AAC@@B@@AAABAA@@@CC@A@@@A@@DAB@@@@@@A@@BADC@@D@@@@ADA@@@A@@@C@@@A@@B@B@BC@C@@@AD@@AB@@CB@@@DAB@DAB@DAD@BBB@B@@@@ABA@ABC@A@A@AB@B@B@@ABABABAB@DADA@ABA@@BA@CB@@ABC@A@@BABA@@@EDE@ABE@@@CB@@CB@D@@@@ABABA@@@@B@@@BB@@BA@C@@BABA@A@A@AB@@A@A@AB@@ABBBBB@BCB@@@BAB@B@@ABA@A@@BAB@@A@A@A@@@@B@@@BABC@EBC@@@@B@@@BB@ABA@@B@@@BAB@BA@@@ABA@A@@@@@C@@@A@@BA@@@A@AB@@A@A@A@@@ABABCDC@AB@BA@A@ABA@A@@BA@BBA@ABA@@@@B@@A@@BABCBABA@A@@@C@@AC@A@A@A@A@@BA@@BA@C@@AA@A@@A@BA@@B@BC@E@@B@@A@@BA@@B@B@DA@@FABA@@B@DAB@B@@@B@@BB@@@BD@@@@@BB@B@@B@BAB@@@B@B@@B@@BB@@@BBB@@@BABC@@@A@@AC@@@@AA@@@A@@BAB@@ADAB@@@B@@BB@DDB@@BB@@@D@B@@@@A@@@A@ABC@AD@DAB@BC@A@ABA@A@@AA@A@ABA@@@@@A@@AAA@CAA@CAAACAAAAE@@@@AA@A@AAA@A@A@A@@BC@@@A@@@A@A@AA@@@BA@@BA@@BC@@@@@A@@@@@A@A@@@@@A@A@@@AA@@@@A@@@A@@B@@BB@@BB@@@BBB@BB@@@@@B@@@@B@@@@A@@@@@A@AB@@@@@@@BA@AAA@@BA@@BA@@BA@@B@B@@A@AB@@@BA@A@@AA@A@@@A@@@@AA@@B@@A@@BA@@@@@A@A@@@@BC@@AA@@A@A@AA@@C@@@@@@ACACCA@CAAAB@@A@@B@@@@@@@@@@@@ABA@@@@@A@@@A@@A@@A@@@@@@@A@@BA@@@A@@B@@@DBBBB@@@@@@@@@AAAA@@BA@@BB@@B@@@AA@A@A@@@@@@AAB@@A@AAAA@C@@@A@"],encodeOffsets:[[107316,34711]]}},{type:"Feature",id:"621224",properties:{name:"康县",cp:[105.609169,33.329136],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@GEEIECCEEAGCI@I@KCA@ABAB@FCH@B@B@D@@BBBB@@@D@BDF@@@D@DAHAB@B@@@DAB@B@@BB@@BBB@BD@@@BA@@@E@@BA@@@@DA@AB@@AB@DADA@@@ABA@A@@BCBAB@BABAB@B@DADABABAB@@@BDBB@BBB@@B@@@BAB@@ABA@ABAB@B@@BBB@D@B@D@B@BBBBB@BBF@D@B@B@BADAFA@BBBB@BBB@BBB@BDBBBBBBBBD@@BB@@B@@@B@@BBDDDB@BB@@BAB@@A@ABC@A@AB@F@H@@AB@B@D@B@B@@KBEBABA@AAA@KDA@C@A@@@ABCJA@AD@@@DBB@BAB@B@BBB@B@BAB@D@@@@ABABCB@@AB@@@BA@@@A@A@A@A@AA@@AAC@A@A@ABADA@A@@@A@@@C@A@@@C@@@IBC@@@CB@BC@E@C@@@ABABAFAF@BAD@@@BA@CBA@CBA@@@ADA@@BA@AB@@BBBD@B@@AB@B@@BBBF@@AB@B@B@D@@@@AB@@A@@@BD@B@@AB@B@B@@A@ABCBCBAB@@AB@@AB@@C@A@A@A@@@CB@@CDA@@@AB@B@BABA@@@@DABA@@B@BA@AB@DABB@@BABBBA@@DAB@BABCD@B@@@BABADA@@@AD@@@B@B@DA@@B@@BB@BAB@@ABCB@DA@@@ABAB@@AB@@A@AB@@@B@@@B@@ABA@@B@@CB@B@@AB@BA@A@A@A@C@CBA@A@CAA@A@C@A@ABABABCD@BCDADA@CAC@@@@B@@ABCBA@ADAB@BG@ABABA@A@ABA@A@E@@@AD@BAB@BBBAD@@@BCB@@ADABCDA@ADA@A@AAA@CAA@@@@@A@@AABA@@@C@AA@@A@@B@@ABAB@@@@AB@@C@@@A@@@ABEDADAB@@A@A@A@CFEDA@A@CAC@A@AAA@@@@AEA@@C@AA@@A@A@A@C@AA@@@@AB@BA@ABA@A@@BA@@@BB@BA@@@@DADBB@B@@BB@@@B@B@@ADABCBABABABC@@BA@@BA@@BA@AB@@A@@BCFCFAB@DCJAB@@@@C@C@A@@@ABA@@BA@E@A@@@CDA@@B@@@B@BB@HH@@BBA@@B@BA@@B@@@BBBDDFDBB@B@D@@AB@B@@BDB@@B@@A@@B@@@DA@@BAB@@AB@@ADAB@B@B@B@B@B@B@B@@@B@BA@@@C@A@AB@@AD@BABAB@BABCB@B@@@@@BBD@@BBDF@BBBB@FD@@BBBBB@BBB@D@BB@@BD@HBF@@@D@@AD@B@B@BBBBD@B@@@B@BABCDABCBAB@B@@@@@BBBB@@DB@BB@@DB@BB@BB@@B@@B@BB@FFB@DBDBBBB@@@F@@@BBBB@BB@D@B@F@B@FB@@BBBBFDB@DBB@FDB@BBB@@@@B@B@FC@ABA@@B@@@BCDAB@BA@@B@B@@A@CB@@@B@D@B@BA@@@@F@@AB@@A@ABA@@@BBBB@@@B@@BDB@EDCB@@EB@@CBAB@@@BB@@@B@@BB@@B@B@BBB@BBDBBB@@@HB@@BB@@BBBBDBBDB@BDBB@B@B@@@B@BABABAD@B@BA@ABAB@@ADAFAB@BABAFADABCDABCJCDABCDABA@A@@BAAABC@EACAAB@@A@@BADADAFCH@DCFAB@BAD@@@DBB@D@D@BA@ABGFCDAB@@A@ABC@@@ABABAAA@AA@AA@@@A@A@@BCFAD@@ABA@ABEDABADAB@BABABABA@A@CAC@@@A@A@A@A@A@G@A@E@CAA@@@A@A@E@EA@@CBA@A@AAA@CA@@C@AB@@@@@@AB@D@D@@@BDFBD@B@@BDBDBD@@@BHH@@@B@B@@@BABAB@@@BBB@BAD@B@B@@@B@BBB@D@H@@ABADAB@BBB@B@@@B@B@B@BA@BBA@AFAB@BB@@B@BADBB@B@BABBBBDBBDBBBD@BB@BB@DFB@@@B@@A@A@@DEB@BB@@@@@B@B@F@F@B@BB@@BBBD@@@@B@@AD@@BB@B@B@D@B@B@B@DAF@BBD@BAB@B@BB@@@BBB@@@B@@@DCB@@@FBB@@@B@B@BAB@DCBBB@BAB@BAD@B@B@@B@A@@@@@C@@@@B@B@H@DBB@BBB@BBB@HB@@BB@@@D@B@@@BB@@B@@@BBB@@AB@BBB@@H@@BLFBDDBBBB@@B@@@B@DBB@@BBB@B@HB@@BB@BBBB@BB@@@B@@BB@BDBDB@B@@@BAB@@BB@DBBBD@BBB@B@BBB@D@@@B@DB@@BB@@B@BBD@@BD@B@B@DBD@BBBFH@@D@DAFCBADADAD@D@D@BAB@BABAB@BAFADCB@D@FBB@@B@@@B@BB@FB@BBB@D@DBBBB@@D@@@DB@@BAB@@@BADCB@FAB@FCB@@ABAB@BAF@BABABA@@@@@A@@AAA@GAA@A@C@I@ABCB@@A@A@A@AA@@C@AB@@A@A@AA@AAA@A@A@AB@BCDCBAB@F@@@BAFADCBAB@BABAB@B@@@BABEBA@AFAFABAB@FBB@B@B@D@DAB@BA@@BCB@@AB@B@DA@@B@@ADADEBA@@DAB@D@B@B@DB@@DBB@B@B@B@@@DA@@B@@@D@BBFB@@B@B@@ADAB@@@HAB@B@DAB@B@DA@@BABCBA@@@@DADA@@BA@EB@@ABE@A@A@AB@DA@@BA@A@AB@B@BCB@BAB@BAB@@ABC@@DAB@HAB@BAB@B@@@B@FAFABAB@B@JAD@B@BABAD@@@BBB@D@B@BA@@DABABCBA@AB@B@B@B@DB@@DDB@B@B@D@@@BADAFAB@B@FBBAB@B@@@DCBA@A@@@EBA@CBABADCB@B@B@@@B@B@BD@@@B@@AFAB@BAB@B@B@@BB@@B@DBJAF@@AB@BAB@BCB@B@B@B@BBDBBB@@B@@A@A@ABAAA@A@@@ADEBA@A@@@AA@CAA@@A@@@A@ADAB@B@B@@@D@DBDA@@BBDBBB@BB@BD@BBB@@FBB@D@@@D@DABBDADAB@D@@@BBB@BD@B@B@@ABABAB@@@B@@BBBBB@B@B@JC@@D@H@DBB@DB@BB@@D@BA@@@A@CBA@@@ABB@@@@@@BBBBAD@D@@@D@@@B@@@BBBB@@B@D@BAB@@A@ABCBA@A@@B@BB@@@B@@@DA@@DA@ABABABE@C@A@@@@BAB@B@B@@BBBBB@B@BBB@F@DAF@B@B@@@B@@BB@ABABA@@BB@@BB@@BB@D@D@LAB@D@B@@@BBB@DB@@B@@B@BAB@@AB@B@@@B@B@BBB@@B@DADA@@@AB@@A@C@@B@@@DBBBDB@@B@@AB@@ABA@A@@DEBA@@@AB@@@D@@AFAD@HAF@D@B@@@B@@@@BBB@@DFB@BJ@@B@BB@@B@@AB@@A@A@A@A@@BAB@B@B@DAD@BADCBABABC@AAAAAAA@AB@@AB@D@BBFDBB@@BA@@BA@C@@BA@@@ADE@C@I@@@@@AB@B@B@B@@AB@@CBA@@B@@@BB@@B@B@BA@@B@@AAA@AC@AA@@@A@@@ABAB@@AB@BB@@B@BB@BBBB@DBB@@@@@BA@AB@B@D@B@DBDBB@@BB@B@B@B@B@@@DA@AB@@AB@BAD@B@B@BABAB@B@@@D@BBB@B@B@D@BB@A@@B@DABABBAIAIIO@AECGGCCGCSMGAIGAAAACGBE@E@GAGAEGICECEAECEBIBMFK@GBE@ABI@E@CBI@KCG@ICEAECM@CACACCEIAGAGBA@C@IAI@@@@@IDQBIDEBA@K@@@@@I@K@K@I@GB@@E@C@EBEDCBABCBG@A@IAGCKCCACCAEDCDEHGFGDGJMFGFGBABCFCHGDAFCFEDCFCDCBGCG@CAEKECC@IBC@ABGFGFEFEDCDABALEN@N@HAJCDABGCC@GBGBA@AHEFCFAHAFAJAT@B@H@NEHEDGFEFEFADCFAPA@@LDLBP@HDH@FBJ@F@L@F@HAHAFABADABABAFGLKLIHCDALCHC@@NGFEDI@GBGAEBE@@@GAG@GBG@KAGBC@E@CAEIIEACBABCFGDEBAB@@AB@@@B@J@D@@ADABA@ABABBBBBBBBDBB@B@B@@ABA@EAEAC@C@A@CB@B@B@BBBDBJH@BD@FADB@B@DCBGBEFBDAB@BA@ABABC@CBAAC@AA@AAC@A@@@A@E@ABCDAA@ECA@CACCCCAA@@@@DGLM@@KG@AGEIAECIGEECE@EAADEDCDAF@NFFHFBHDDBFADCDABCCCEACCEEACBGBCBABG@@TBHE@CAE@AECEBG@GG@@GCACBGAM@CBCFCDCDGBC@@FCFC@AEA@A@CAAEAG@G@CCACAGAI@A@EFGHGDCHADA@E@IBGBEAAECEG@@@S@GEGAICGECCCOMKM@@EGGECC@@AAGGEEEECECEEGECGGGCG@ADCBGBMHIDGBGBA@G@CCICCCGCEEICWKKGEAEAC@GEGACACAIAGEIEICIEKAGCA@IAG@IAG@GBE@C@GAI@SCGAGAG@CA@@GAE@EAE@E@GC"],encodeOffsets:[[108193,33685]]}},{type:"Feature",id:"621225",properties:{name:"西和县",cp:[105.298756,34.014215],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@BABA@C@AA@@C@@@@@AA@@BAA@AAACA@AAA@@@ACA@IGA@A@A@ABA@ABAB@BAB@B@DA@ABE@A@CAA@@@A@CBABA@A@ABC@CBA@A@A@@@CABB@B@@ED@@ABA@@@AB@BAB@BA@ABCBA@ABAB@@@B@B@BBB@DB@@B@B@@@BA@ABA@@BAB@@@B@BB@@BBB@B@BB@BBAB@B@B@@BB@@@BB@B@@B@BAB@B@BBB@@BB@D@@@BB@H@@@B@BB@@B@BB@@B@BD@BBB@@BBBBDD@BB@DBBB@@BB@@@H@B@B@B@F@B@D@@ABAB@@@B@B@@@@BBD@B@BBB@DD@@B@FBB@H@B@H@D@FBDBB@@@B@B@DAH@B@F@DBB@B@D@FAD@B@D@BAB@BAFADCD@B@B@B@DBDBB@@AB@FEDCBADCB@BAB@B@B@@@DBBBBB@B@B@@@DEHAF@B@DBDBDBB@@@D@@@BABB@@D@@@D@B@BAB@@AD@BABIHEBABA@A@@@A@A@ABADA@ABC@ABA@@@C@@B@@@B@@@BBB@B@DBB@D@@BBAB@BAB@BBBBB@D@B@B@@@BA@A@@@@B@@BB@BBB@BB@BBBB@DBB@@DB@@DBB@JFB@B@@@@B@@A@AFADA@@B@@BB@B@@@BAD@B@B@B@@@BB@FF@BB@@BA@@@ABA@@@AB@@AB@B@B@@@B@@AD@@A@@@C@A@@@C@@BA@CA@@CBA@C@C@C@ABA@A@E@@@ABAB@@AB@BAD@@@BB@BBD@BB@@@BBB@@BB@BAB@BABA@CBA@CBAB@@@@@B@BB@@@@@@@@@BB@@@@@@@@@@ABB@B@A@@@@@C@BBDB@@@B@@@@@B@@@B@@@B@@BB@B@BA@@@ABA@AA@@@@A@@B@@B@@B@BB@@@@@@BB@@B@BB@@@B@@@B@@@@B@@BB@@B@BB@@DB@@@@@B@@@B@@@@AB@@@@@@@@@@A@@@AB@@@@AB@B@@@@@BA@@@A@@@A@@@@@@@@BA@@@@@@B@@A@@@A@@@CBAB@@AB@B@B@BBD@BABAB@@@@@@@B@B@@BB@@BB@@@@A@A@@@@B@BB@@BA@AB@B@@AB@B@@BBB@BBBB@@@D@B@@AD@B@B@@@BB@@BBB@@AB@BAD@H@F@B@@BDB@BB@@B@D@F@FBD@@@D@D@J@@@B@DBBB@@BB@@@D@BB@BB@BB@@B@@@@@BA@CDA@@B@F@B@B@@BB@@@BB@@BB@@@@B@@@B@@@@@BA@@@A@@BA@CB@@@@@B@@@BB@@B@@@BB@@@BBBB@@@@BB@@@@@@@@@B@@@@@@B@@B@@@BBBBB@@@BB@@@BBB@@BB@BDBB@B@BCFADA@ABA@@@@@AB@B@@@BB@@@BB@@AB@@AB@BA@A@@BA@A@@BA@@BA@@B@@@@ABA@@D@B@@BB@@@B@@BBBB@@B@@@B@BB@@BBB@@@@BB@@@@@BB@@@@@BB@DB@@@@@@B@@B@@B@@@@BA@B@BB@@@B@@@@@B@@@@@BAB@@@@@@@@BB@B@@@@@B@B@B@@@D@D@B@BABBB@@@@ABAB@@A@@BB@@BB@BB@@@@@@@BB@@@B@B@@@B@@@@@BBD@B@B@@@F@@@BBB@@@B@B@BB@@BB@@@@BB@B@@B@@B@@@@B@B@BB@@@BB@B@B@@BBBBBD@B@@B@BBD@@BB@@@B@@@BB@@@B@BD@DAB@@BBBB@@@BB@@@@B@B@@BBAB@B@@@B@@@B@@@B@@@B@B@@@@BB@@@@@BABABCBCB@@A@@B@BB@@@@BA@@BB@@@AB@B@@B@@@@BBB@BAB@B@B@@ABG@@B@@AB@BADCBAB@@@B@B@@BB@@@B@B@B@BA@@BA@CBA@@@AB@@@BA@@B@B@@@@@@@B@@@B@@@B@@BBB@@@@B@@@B@@@@BB@@@@DB@@@@BB@@@@B@@AB@B@@BBBBB@B@B@B@B@@@B@B@@DD@@B@@AB@D@B@@@BB@@B@@@B@@@BBBBB@DB@B@BBB@@BB@@FBD@DB@@@@@BB@B@@BB@@@B@B@BA@@B@D@@@B@DA@@@BB@@@B@BABBD@@@@@B@@B@@B@B@B@@@AB@B@B@@@@@D@@DF@B@@@@B@B@@BB@@@@BBB@@@B@@@B@@@BB@@@BBBB@@BDBB@@BBDDBB@@B@BA@@DB@@@@B@@@@@B@@@@@@BB@@@@@B@@@B@@@@@BA@B@@BAA@BA@BB@@@@@@@B@@AB@B@BA@BB@@@@@BB@A@BB@@@@AB@B@@AB@@AB@@@BB@@B@@@@@@@B@BB@@@@BB@@@@B@@@BA@@DA@@@AB@FBB@@BB@B@BBBB@@DD@BBB@BBBBDBB@@B@@BB@BBBB@@B@@@BDB@B@BA@@B@B@@@B@@@B@DBB@D@HBD@BBB@BB@@@BDBB@BB@D@BB@BB@AF@@@BB@B@@B@@@BB@@BBB@@D@@@@B@@@FCDAB@@@BBBB@@@BBF@B@B@BBD@B@@AB@BA@@B@BA@@BAD@F@@@B@@AB@@@BAB@D@B@B@BAB@B@@AB@@@B@@@B@@@B@B@@@F@@BDDB@BBBB@B@FB@@DB@@D@B@@@B@@BB@@AB@BB@@BBB@@BB@B@@@BAB@@@BB@@BB@@B@B@BBB@B@BB@@DBB@@@B@@BB@BB@@BB@@B@DB@@BBB@BBB@@@D@BB@@B@B@@BB@B@D@D@@@@AB@BA@A@A@@@@AAA@AA@A@@@A@@B@BABAB@B@B@B@BBB@BA@@AA@@@ABA@ABABABC@CB@@ABC@@BA@ADEBA@@DCBA@@BE@A@ABABCBA@AB@@@@A@A@A@@B@BA@A@@@C@A@ABC@CA@@C@@@A@AB@@ABA@@@ABABA@@@A@C@@@ADA@A@AB@@@B@B@B@BBB@@@AA@A@@@A@@A@@A@@@A@@@A@@@@@ABAB@@AB@@A@@@C@AB@@@@ABA@A@@@A@A@AB@AA@AB@@@@A@@B@DCB@@@@@@@@A@@@AB@@A@@@ABADA@@@@@A@@@A@@@A@@@A@@@A@A@AB@@A@A@@@A@A@@@ABA@C@@@A@C@@@AB@@A@AB@BA@AB@B@@A@A@ABC@@BA@@@@AA@A@@@A@@@AB@@A@@@A@A@@@AAA@@A@BA@A@A@AB@@@@@BBB@B@@@@@@@@A@AB@B@DA@@FCBA@A@@B@B@D@BB@@DAD@@@BBBAD@@BB@BAB@DA@@BA@@AAB@@@B@@BD@B@D@B@BBB@DAB@@@DABAB@@@B@@B@BBBB@D@@@BB@@DDBB@@BD@B@@BBBB@@BB@B@@AB@@@BABAB@@BB@D@B@@AB@B@@@B@@@@@BD@BB@@B@BA@@@@BA@A@AB@@@@@B@@@BBB@@@B@D@@@B@@@@F@DB@@@B@@A@@B@D@B@B@@@B@@@@B@B@B@B@D@@BBB@BA@@B@BBBA@@BA@@B@@A@@@CBA@@B@@@@AB@@@@AB@@C@@BABA@AB@@ABCBAB@B@@@@@@D@BBD@@B@D@B@B@B@BAB@B@@BF@B@@@@AB@@ABA@@BAB@@@@AB@@A@ADADA@@B@@A@A@@@@AAAABCN@@AB@BAH@B@DADAB@A@AAA@@A@@A@@A@@B@@AB@J@BA@@FC@A@@BC@@@@CE@AAAAA@@CA@A@ABC@A@AAA@A@@@A@ABABA@@BABEDADA@@BBD@@@BBBBDDBB@@DABGBCBA@CB@AA@ACAAAA@@@BABADA@AB@BB@ADA@@@@@AAC@A@A@@@@@@B@DBB@B@B@B@@@@@@ABA@CBEBCBABAB@@@BC@C@ADADAB@B@B@D@B@D@@AB@FE@AB@BAFCB@BA@@DCBA@A@A@@@@CCA@AA@@@A@A@A@A@AA@AAA@@AC@AA@@AA@@@AEAC@A@@AA@BAAA@C@@@@@@@A@@@AA@CAAA@AA@AA@AC@AAAA@@AA@CA@@ABA@@@A@AA@@AA@AAA@@@AA@@@A@A@AA@AAAA@@@A@@AA@AAAA@AAA@A@AAA@@AA@AC@@AABA@ABA@@A@A@@@A@AAC@A@A@A@@@@@@AG@A@AAA@@@A@ABAB@BAB@@A@E@@@AAA@@@A@A@@@I@A@A@A@@@A@@AAAA@A@AAAB@@C@A@C@C@AA@@AAA@@AA@@@@@@AA@A@A@A@@A@@@AA@A@C@AAA@CBG@A@@@ABA@@@A@CBA@CAC@A@GACCC@E@A@AACAA@AAA@@@A@CAC@CCEA@@AAAA@AA@AAA@AA@A@@@A@A@ABM@@@ACE@@AA@@CACA@@CAA@ACAAAA@ABA@@BAB@BAB@B@BAAAB@@A@@@AACA@@A@AA@CCACA@AA@A@AF@BABABAD@BA@@@@@A@A@@DABB@ABA@@@A@@@ADA@AB@@A@@@A@A@C@@@C@@@CAA@@CEAA@AACAA@ABC@C@C@@@A@A@A@A@@BABCAABA@@@@@AHC@@@A@A@@DA@A@A@A@E@A@G@C@A@EA@@G@A@A@C@A@A@A@@@A@G@A@A@A@AA@@AC@CCA@A@C@@@A@@@AAA@@AA@@BA@A@@AAAAAA@AAA@A@@@AA@@AAA@CAC@@@CAAAA@@ACC@AAA@AAA@@CCCAA@BC@A@A@@@@BA@@@A@@@AAA@A@@B@BA@A@@@@@@CA@@@@B@@G@@@AA@@AB@@C@A@@AA@@A@@@B@@@B@@A@@B@AAA@@AA@@A@A@AB@@A@ABC@@A@AAC@A@AAA@@@@A@@@A@@@A@A@@A@@BA@@@CC@@@@@C@@BA@@@A@AA@@@CB@@AB@@@@AA@A@@@A@A@@@@BA@@B@@A@@@A@@B@@A@@AA@A@@@A@AA@A@AA@@AA@AC@@A@@@@@A@@@@AA@@@@B@@@BA@@BAB@@@DB@@B@@A@@@AA@@@A@@@A@@AA@CAA@@@@A@C@AAA@A@@CC@@A@@AAA@@AA@@C@A@@@AA@AA@@A@@@@A@@A@@@@A@@AA@AA@@@@@@@A@AA@@A@@A@@@AC@@@ADA@A@@A@@@C@A@A@@@@A@A@AAA@AAA@AA@@@A@@A@AA@@@@A@A@ADA@@@A@@@AAAAA@@@AB@BAB@DABB@@D@DAF@DB@@B@AA@@A@AAC@AAECA@AAEAAAA@C@A@CA@@CAA@@@A@AA@@AAC@A@A@A@@A@@AA@A@@A@@@A@IA@@AA@@AA@A@@E@GAA@AAGCA@A@ABAB@@A@@@@@AAA@@@A@AB@@A@@A@@A@ABC@A@A@A@A@A@@BA@CAA@A@A@@@CA@@@@A@ABABA@EA@BC@ABA@A@A@C@CBA@EAABA@A@CD@@A@@@CAAA@@AAA@A@@AA@@@@@A@@@C@AAABA@CBC@AB@@AB@@@BDBBBBB@BB@D@DAD@H@FAB@@@@@@BBB@F@B@B@B@@@B@B@BB@@BA@ABABA@@@A@@@AB@BA@@@ABCB@BA@A@ABEBEBA@EDCBABAB@B@B@@AB@D@B@@@@CBEDIFADADABCD@BA@AB@@AB@B@@A@@BABAB@@A@@@C@A@@@EBA@A@@@@@A@A@AA@AA@AAC@CACCAAA@A@A@ACA@C@CA@@A@@AA@AAAAGCCACAAAA@A@EAEAEAGAECC@@@A@@AAAA@@AA@@@A@ECCCA@A@CAEA@AA@AA@@A@A@AA@A@@@@CA@@GEAA@@@@BA@@@A@@@C@AA@A@CAAAAAAAAAA@AAAAAACC@AAAEA@@AC@AECA@@@C@@@A@A@A@A@EAECC@C@EC@@E@@@CAA@AAA@@A@@@C@@@AAA@AA@A@AB@@E@C@A@@@A@AAA@AA@AA@@AA@@@@@A@AB@@G@AB@@"],encodeOffsets:[[107638,34437]]}},{type:"Feature",id:"621227",properties:{name:"徽县",cp:[106.08778,33.768826],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@EBGBGDIBE@CFCBGBCBA@A@EAA@ABABAB@B@DC@CBA@AB@B@DBD@FABCBAB@BB@BBB@BBBBDBDBDABB@@BB@@@BABA@A@@B@@CAAA@AC@K@G@ICAABABEBE@@C@E@GAA@C@A@E@EAE@C@A@CBCF@B@@BBDJHNABAB@BBDPABAD@B@HFJH@DCJKAC@@@CAA@ABEBEHABSBABADA@C@CB@@GBABEBA@AAIEEAA@GEACME@@C@ABGFA@A@EBEBC@C@CAAAA@CGEECA@@EACEAAC@A@ABE@CBAD@H@H@FA@ABEA@@ACE@EAC@GCC@AACBC@@@ABC@ADEFC@ABIBI@E@K@EACDC@C@AA@B@D@BBB@@@B@@BBA@@B@B@@@B@BA@AD@@@B@B@F@@@@BD@@@B@@BB@@@@@@ABA@@B@@AB@@ABAB@@@@@@C@@BA@@@@@@B@@@BBB@@BB@@@@@B@@A@@B@@A@CBAB@@@@A@@@@BC@A@@@@@C@A@@@A@A@@@@@A@@@A@A@@@A@A@A@AB@B@@@B@@@B@B@B@@BB@@@@@B@@AB@@@@@@@@@@@B@B@@@B@BB@@B@@BBDH@BBBBDBDBBBB@@B@@@B@@@@B@@AB@BA@@D@@@@@@@@@@@BBBB@@@@BB@@B@B@B@@@B@B@@@@AB@@BDBBBD@@BB@@@@@@ABCB@@AB@@A@A@@@@@A@ABA@E@A@@@A@@@@@@BA@AB@@@@A@@BB@@B@BBB@@@@@@@@@@@B@@@@@@A@@@AB@@@@@@AB@@@@@@@BB@@B@@@B@@BB@@@@@@@@@@@@@B@@@@@@@@AB@@@@@@@B@@@@@@@@@@@@AB@@@B@@@@@B@@B@@@@@@B@@@@@@BB@@@@@@@@@B@@@@@@@B@@A@AB@@@BAB@B@@@@BB@@B@BBB@DB@D@@BB@@BDB@@BBBBB@BBBFBF@@BB@@B@@@BBB@F@B@B@@@@A@A@AAA@@@@@@BDDDD@@@@@B@@@@ABC@AB@@ABA@A@@B@@@BAB@@@BA@@@G@C@C@A@C@@@@@@F@@@BBB@B@@@@CB@@AEAA@AA@A@@@A@CDABAB@BAB@@CDA@ABABCB@BA@C@A@@@@@@B@@@@@@CB@@@@A@CA@@@B@@@B@@ABA@AB@@@B@F@BAD@BA@@BABAB@@A@C@A@@@@B@B@BAB@@@@@@@B@B@B@@@D@BCB@@@@@@@BBF@@AB@BA@@B@@AB@@@@A@CA@@@@@@@BBB@B@B@@BB@@BD@B@@@BAB@@BB@B@B@B@@@B@B@B@BAB@@A@A@@@@AAAAA@A@A@A@AB@BA@@AA@@@CA@@@@@CBC@C@@@A@A@@AAC@@A@@@A@@BA@AB@AAAA@AAAA@ACBC@AB@@AA@AAA@@A@A@@AA@A@@AA@AA@A@AA@A@@@@@@A@@A@@@E@CBE@A@A@@@A@@BBF@B@@@@AACA@AAA@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@A@@A@A@@@E@@@A@A@A@@@@@A@@@@@@@A@@@A@@@@@A@@@A@@@EAAA@BA@@@CAEC@@A@@@AAABA@A@AB@@ABAB@B@B@@ABA@A@@A@A@AA@@@ED@@A@CBAA@@@AA@@@@@@@A@@B@@ABCB@@ABA@ABAD@@AB@@AB@@@BA@@@@AAA@@C@@B@@@D@@@BA@ABA@@@BB@@BB@BBB@@@@@B@B@B@@@BABAD@@@B@@B@@@B@FAB@@@B@BDBBB@BB@B@D@D@@@B@@@BAB@BAB@@CDAB@@@B@@@@@@A@A@A@@@@D@@ABA@C@A@A@@@@@@@@BB@BB@@@DABA@AA@@AA@@@@A@ABA@@@AB@@C@A@C@AA@@C@ABA@AB@@A@@@@@@B@B@@DABBD@@@BB@@BB@@@BA@@@@@@D@B@B@B@B@BA@A@A@AB@B@BAB@BAB@@ABADCDCFA@@B@@AB@@@@@BAF@@A@A@A@@BA@C@CBA@@AA@@A@AAA@@@@AAA@CA@@@AA@AA@@@@AA@AA@@@@@AA@@A@@E@@A@A@@@A@A@A@A@@@@BAB@B@D@BA@@BA@A@@BABCB@B@@BBBBDF@B@@@BADAB@@@B@@BDBBBBDDBB@@@BAF@@F@@BB@@B@D@B@D@B@B@B@FB@@BDB@@@BCBABEBA@ABA@CD@@@@BB@B@@@B@BABAD@B@@@B@BB@@@B@@@@BB@@BB@D@@@B@BB@BB@B@B@BADAB@B@B@BBBBB@FBB@B@D@BBB@B@B@@BFF@@BD@B@HB@@DBBBD@@BD@BBB@B@@@B@DAD@DADBD@B@B@@D@@BDB@BB@@BBB@DBBA@@BA@AFA@ABAB@@@BA@@B@BABABADA@@BADADAB@BCD@BA@@BAD@B@DBB@@@B@@ABA@ABCBEB@@@@BF@@@D@BBD@BBBBBBDBBA@ADAB@B@BBB@B@@ABAB@AA@@@A@CBA@A@A@E@E@C@@@ABA@AB@@AB@@@D@@CBA@ABCAABACA@@@CAAAA@A@A@@EA@AA@@AA@CEAEAA@AA@@@@@@AA@@@@@A@@A@AAAA@@CA@@@@@@AA@@@@@@@@AA@@A@AAA@A@@@A@E@C@@@E@A@A@@BA@@@A@@@@@A@AAA@AA@@@@AAA@@@A@@A@@A@A@CAA@AAAA@@AAAA@AAA@AA@@@A@C@A@A@A@AAA@ABA@A@C@A@@A@@@A@AA@@BEFA@@BC@A@C@A@A@A@CBCB@B@DAD@@A@ABABADAF@DAB@B@@@@A@A@A@A@CAA@@@@@@@@B@BBD@B@@@@CB@BAAA@@BCBABAB@@B@BBDB@BBBA@@DABADAHCB@@AACCAAAA@@C@AA@@CBCBAFAB@@ABAB@B@B@@@BBB@B@BAD@B@BDB@@BBBB@BDF@@@@AD@@@BED@@ABI@A@@BA@@@@BB@@@@BB@BBB@BB@@B@B@@@@@@@B@@B@BB@BBB@BAB@B@AG@A@ABC@E@@B@B@DB@@@B@D@D@H@D@BBB@BDBDB@B@B@@ED@BAB@@CBA@@@@@@F@B@@@BBB@B@@D@BB@@B@D@D@DAD@DA@ABABCBAB@FEB@@@BBBB@@@@B@@@B@DADA@@@@AA@AACCCAACACA@@A@@CAA@AB@BC@AB@J@B@@@B@D@BA@@BBDD@@B@@@B@@@B@@@B@@@B@BAD@DA@@B@B@@BB@@BBB@BBBBB@@AB@BAB@DAD@BBB@B@BBBBB@B@@@B@@@B@D@B@BB@@@B@B@B@DFDB@B@D@FAFBF@B@@B@B@BBDB@BDABB@@BB@@@@B@B@@@@@BBBB@B@B@B@B@@@B@@@D@B@@@BAB@B@@@B@B@B@@@@@BB@B@DD@BB@BBB@D@B@@BB@@B@B@BB@BBBBB@@@B@@@B@DB@@B@B@D@@BB@BB@BB@@@B@BAB@@@B@BDB@B@@@B@BB@BB@DBDDB@@B@@B@BA@@B@@BBAB@BAB@B@@@@B@@@@@@FABA@@@@@AD@BB@@BB@@@BD@@@@@AD@B@@@D@@AB@@@B@@@@BB@@@@AB@BBB@B@@B@BAD@@@BBBB@@B@@@@@B@@A@@@AB@B@B@@@@@B@@@@@B@@@BDB@BBBAB@BAB@BA@@DBB@BADC@A@@B@B@B@FBB@D@B@@AB@@@@@@A@@@@B@BA@@@@@C@@B@FA@@B@BAB@BB@@B@DA@@BA@@@A@@@A@E@@@C@@@ABA@@BAB@@@BA@@@ABA@AB@BA@@@@@A@@@AA@AAA@@A@A@AA@@ACAAA@@@ABABA@@@AAAAA@A@C@@AC@@@@@AB@B@BAFCBA@C@@@@BAFA@@B@BBD@B@@BB@@B@B@@@BBBB@D@D@B@@@BB@B@@DFB@BDB@BD@@BBBB@@@@B@B@BA@@B@B@BAD@@@B@@@@@BB@@@BAB@DAB@B@@@BBDBBBBBBBBB@@@B@BABABA@@B@B@B@@@@BBB@@B@@B@@B@B@D@B@@@B@@B@BBBBBBB@@B@@AB@FAB@B@@@BB@BDAF@RB@@B@B@B@B@B@@A@@BCBABC@ABC@ABABC@@@C@@@A@AEC@A@A@A@@BA@C@A@@BAB@@AB@@@@A@A@@@@B@@BB@B@@@@@B@BA@AB@@AB@@@@@DBD@B@@@B@B@FC@@B@B@BA@@D@B@BBDB@@B@B@DA@@H@BABA@@B@@BB@BBBDB@@DBBDDB@@@B@BD@B@B@@@@DDB@B@B@@@BAB@B@B@BA@@B@B@BBBBB@B@FBB@@@BB@BB@BB@@@B@B@@BB@@@@BBBBD@FDDBB@DA@@HD@@BBDB@@B@D@F@D@B@DAFAB@HBB@@@@B@@@BB@BBB@B@D@@@JFB@B@@@BAB@@@B@B@FBF@DBBBB@@@F@D@JBB@@@BBB@BBBB@@B@B@B@DAF@BAD@D@FBB@F@FBB@B@@@BA@@B@BADA@@@@BC@CB@@ADBB@DAB@DB@@B@@A@@@@@A@ABABA@@B@B@B@B@D@BA@@B@B@B@B@B@B@BA@@DCBA@@@A@@B@BAB@@A@@ACAE@@@@@A@A@@BA@@BAB@@@@@BA@@B@@A@A@A@A@AB@@@@@BBBBD@B@D@H@B@@@F@D@@@BA@@@CBABADEB@@@BAB@JAB@DEBC@@BA@@BBBB@@B@DADAB@B@B@B@J@BB@@BB@@FBB@D@@@DD@BB@B@BB@@BB@B@D@@BBB@BBDD@@@@B@@A@AB@BAFAFA@@B@DA@@B@B@DADAHABA@@FAB@D@B@B@@@@@DABAB@@AB@BAFA@@D@B@F@D@FBFBBBB@D@B@@@@@BBHF@@DBB@B@B@BAB@B@FAD@B@@AACAA@A@@@A@@@@@@AA@@A@@@AA@@@AA@@AA@@@AA@@@AA@@@@A@AA@@A@@AA@@@A@@AA@A@@@@BA@A@@BA@@@A@@@A@@@@@AB@@@@A@@@A@@@@@AA@@@@@AA@@@A@A@@@A@@@A@@@AA@@A@@@@@AA@A@@@@@AA@@A@@AAA@@@@@A@@A@@A@@@A@@@@@A@@@@@@@@@@@AB@B@@A@@@@@AAA@@@A@@@AA@@A@@A@@@@@@@@AA@@A@@@@AA@@AA@@@@@AAA@@AA@A@@@@@@@A@@@@@@B@@A@@B@@@@@@@@@@AA@@A@AAA@A@@@A@@@@@A@@@@@ABA@@BA@@@@@@B@@@@@B@@@B@B@B@@@BB@@B@@BB@BB@@BBB@@@BB@@BBB@@BB@B@@@@@BB@@@@B@@B@@B@@@@@B@B@B@B@B@B@@@B@@@B@B@B@@@@@@AB@@@@@@AB@@@@@B@BB@@B@@@B@@@B@@@B@@@B@@@B@@B@@B@@@@@B@B@B@@@BAB@BA@A@@@@BA@@@@@@B@@@@@B@B@@@@A@@@@B@@A@@B@@A@@@@@@B@@@@A@@@@B@@@B@@@BAB@@@B@@@B@@@BA@@B@B@B@@@BBBA@@BAACB@@@DABA@@@A@A@A@CBC@A@A@@@AD@BC@@B@@CBC@@@@AA@@@@AA@A@@BABC@@@A@A@@@ABA@A@A@A@A@A@A@AA@@CAABA@@AA@@@@A@A@@@@@@BA@AD@@CB@@C@A@E@C@AACBC@A@@@@@AAA@A@AA@@@@A@A@A@@@@@A@A@@@AAA@A@@@A@A@AA@@@A@A@@@@BCBA@@@A@A@C@AB@A@@A@@@AAAB@@A@@@AAC@A@@@@BA@@@AAA@A@@@@@A@A@C@@@@AAAAAC@@@C@A@@@A@@@@AA@@@A@@AA@@ACA@AA@AACB@@A@A@A@@@AAAA@@A@AAAAC@@@@B@BABCBA@@@@@AC@@AA@@AA@GCAA@@@@@A@@@@@@AA@@A@@@A@@AA@A@@@@@AA@@@@AA@B@@AAAA@@@@@@A@@@BABC@@BABABC@@B@@AB@B@BB@@@@BBB@B@@@@B@@B@@@@@B@@A@@@@@@@A@@@ABABC@A@ABABA@@AAB@BA@AAA@@ACAAACB@D@@@B@B@BAFAB@@@BADAD@@A@@BCD@DA@@@@@@@A@@@@@A@@@A@@@A@@A@@@@@@AAA@@@AB@@A@A@@@A@AB@@@BABC@@@AAA@A@@B@@AB@@CAAAE@A@A@C@AA@@AB@BCBA@A@@AA@@BABA@@@CAA@ABC@AA@@AA@@@AAAAAAAA@@A@A@@@AA@A@@@AA@AAA@AC@A@AAAA@@C@@@@BAB@BA@ABABA@ABABAB@B@BA@@@ABA@@AA@@BA@@BA@A@@AA@A@C@@@A@@@A@A@A@@@@AA@@@@AACA@AA@AAAAAAAAACAA@AA@CG@@@A@A@@BC@@@@@A@@@A@@@@A@AAA@@@A@AA@AAAAAA@A@GCAAA@C@@ACAC@CAE@CACAA@A@A@A@A@@@A@CA@@@A@A@AB@@AA@@AAC@@@ABABABAB@BBBAB@B@BA@@@A@A@A@@AACA@@BA@@@@B@B@@@BB@@D@@@BABA@@@A@@CCAE@AAAA@@ACE@A@@AABA@ADAB@@AAA@@A@A@@A@AB@BCBAB@@A@A@@B@@ABBB@F@DA@@@@BCBA@@B@B@@@BBBB@@BDBBB@BB@@B@DA@@B@@A@ABA@ACE@@@@AAA@@@@A@@BA@ADAB@BAD@D@@@B@B@BA@ABA@ABCB@@@BA@@BBB@@B@@BBAD@@@B@BB@@BB@BA@@B@BA@@@A@A@ACA@@@A@A@@B@@@DBB@@@B@@B@B@B@BB@FBFBBBB@@@D@B@B@B@@B@@@D@D@@@DBBAB@BBB@B@@B@@@B@B@B@B@@@DBB@@BB@@B@@B@BAB@BAB@F@@AB@B@@@DABAD@D@@@BA@@@@@@BCA@@@@AA@BA@@D@D@B@@@@@@B@B@@@B@@@B@@@@DBJB@@@AAA@AB@@C@@B@BE@@@A@@@@B@BA@@B@BB@@D@D@DAD@@A@@AA@A@@A@@AAAA@AA@@@A@AAA@@@ABAB@DADAD@B@BAB@@@DA@@B@@A@@@@@@@ABAAA@@@A@@@AA@@AA@@AA@@AA@@@AA@@@@@@AA@@@AA@@A@@@@@A@@@@@A@@@A@@@A@@@@DA@@@A@ADADCB@BAB@@BB@B@B@FCDADAB@D@D@BBB@@@@BB@BADADA@@BA@A@ABADC@@@C@ADA@C@ABABA@A@A@@B@B@B@DCB@@@BADBH@B@FB@@B@H@BAD@@AB@BBB@B@BB@@BBB@B@FAB@BA@@@A@CB@@AA@AA@@@A@@@A@ABADAB@JEHC@@BA@@A@CA@@A@@C@EAA@A@@@C@@BCBA@@@A@@CCAA@A@@@ABA@@@E@A@ADAFCBAB@B@B@BAB@B@BBB@DADBF@B@B@D@DAB@B@BABAF@BAB@F@FAB@FABA@@@A@@AAGECA@A@@@@@@BAB@@@BA@@@A@AAA@A@AABCBGFA@QDG@IAK@G@K@KEE@I@OEECGIGGECGCGGICGCGCEAA@G@G@GBGDKDCDEFEBCFEDGBKBICI@A@IAGAKEKGMIGGGGEEGECCEEGEGEIAKCIAGA@@G@KEIKGCMAGA"],encodeOffsets:[[108738,34352]]}},{type:"Feature",id:"621226",properties:{name:"礼县",cp:[105.17864,34.189345],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@CA@AAAAAA@GA@@CCC@AAA@AA@AAACE@AC@@A@A@ABAD@FE@A@@@@AC@AAA@C@ABABA@@@A@@AA@A@A@AA@AAA@GAAA@ACA@@AA@@A@A@AAA@AACC@@@A@A@AA@AA@@@ABA@C@C@CCACCACA@AA@@C@C@C@ABE@ABG@C@A@CBA@@B@@A@CAAACAA@@@EACAC@@BA@@@@@ABC@CBAB@@C@ABA@@@CBA@@@AB@@@BA@@AA@C@A@KHCDA@ABA@AA@BA@@DBD@BA@@@GBCBEF@@AB@B@B@@A@A@KC@@C@A@EAEA@@A@@@AAAAECCAA@AA@BA@@A@@@AA@@@A@C@AAA@@@ABABECCA@@A@A@ABC@EBAB@@A@CAAAC@A@@@A@D@DBB@@B@@CF@B@B@BBBBB@@B@F@DBD@F@D@@BBB@@@@BB@@D@B@DAF@B@@@BB@BB@@@@@B@@B@@@B@@ABA@@B@D@@@B@@BB@@@B@@B@@B@@@@@B@@BB@@@B@BAB@B@@@DAF@DBF@D@B@F@@AB@B@BB@@@B@DB@@B@B@B@@@B@@@B@B@@@B@B@BAB@@@BB@B@B@B@BB@@B@BB@BB@@@@@@@@@B@@@@@BBB@B@BBBB@@@@@BB@@@DA@@@AA@@A@@BCD@BADA@@@A@A@@@CBCB@BABABABA@CBC@C@@BA@CBCBCDABA@ABABABA@CBA@CBEBABA@A@CB@@C@ABEBC@A@@BCDAB@B@B@BABCF@@@B@@@B@B@@@BADAD@B@B@@A@C@@@@@@BBB@B@@BBDBBBBBDB@BD@DBB@B@B@B@DCB@BBBBB@FBFBB@BB@BB@BBDBB@D@@@BAB@@@BCBA@@B@BA@@BB@@B@@@BBA@@@@@@B@BB@@B@@BD@B@@@@A@A@CB@@A@@B@@C@@@A@AD@@@@AB@BAB@@CB@@@B@@@@CDABABA@A@E@@@@BCBAB@@C@CA@@@BA@@BA@@@E@ABA@AB@B@B@B@D@B@@B@@BAB@DABB@BDDD@@B@BAB@@@D@BA@@B@@@BBBB@B@D@@@B@BBBBBBBBB@B@B@@CB@BAB@@@@BD@@@@@B@@@@@@@@@@@B@@@@B@@@@@@@@@@B@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@A@@@@@@B@@@@@@A@@@@B@@@@@@@@@B@@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@BB@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@BB@@@B@@@@@BB@@@@@@@@@@@BB@@@@@@@@@@@@BB@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@ABAB@@@@@@A@@@@B@@@@AB@@@@AB@@@@@@@BB@@B@B@@@B@B@@@B@@@B@B@@@@BB@@@@BB@@@@@B@BB@@B@@@B@@@B@@@B@B@@B@@BB@B@@B@@B@@@D@FAF@D@D@B@D@JCD@B@@@B@B@@@BCBC@CB@@@@@D@DBD@F@@BBB@B@B@@@BB@@BD@@B@@@B@@A@AB@@A@@@@@A@@@@B@@ABABABA@A@AA@@@@@B@@BB@@B@B@B@BBB@BDBD@@@D@D@@@@BBDA@@D@BA@@BA@@@BBBB@DBD@D@@@B@@@D@B@BB@B@BA@BB@@@@@@@B@BBB@@A@@@AA@@AB@@BBBB@@@@@@@@A@AACA@@A@@@@B@@AB@@@B@@@@@@@BB@@@@B@@@B@@@@AB@B@@@@@@@@@@A@@@@BA@BBDBB@DDDB@B@@@@D@@@BBB@B@@@BB@@AD@@@@@B@B@@@@AB@@@BA@@@BB@@@@@B@@@BBB@@@BAB@@A@@B@BA@A@@@AB@@AB@@AB@@BB@BAB@@@@@@A@@B@B@@@@@B@@A@@@@@@A@@@@AAA@AA@@A@@AA@@AA@@@@B@@@B@@B@@B@@@B@B@@@@@B@B@@@@@B@@@@AD@@AB@@AB@@B@@B@B@B@@@B@@AD@@@B@B@BBB@B@BBB@@@@BFBBDBBBDBB@DBB@BB@@@B@@@@AB@B@BBB@@@BAB@B@BADA@CBC@@BAD@B@B@@@B@@A@C@@@A@@AA@CCA@@AA@@@A@CB@BA@AB@@@B@@BB@@@@BD@@@B@@ADAB@@A@AA@@A@@AA@@@@A@A@@BA@A@AA@A@@A@@@@AC@@A@@AA@@@A@A@CBA@@@ABEB@@CBA@A@@@AB@@@BA@@@@FADA@@@ABB@@@@BBB@@@DAB@@AB@@@B@B@B@BBD@@@D@@@BABABADAB@@@BA@@@@@AB@BAB@AAB@@@BAB@B@BABA@@BCDADAB@B@@@B@B@BA@@B@B@@AB@@@B@@@D@@@@@BAB@B@@ABA@AB@@A@@@AB@BAA@@A@@@@@AD@FADAB@@A@@@@@@B@B@BA@AB@@@BAB@BA@A@AB@@A@ADA@AAAA@BA@@B@B@BA@@B@B@BABAB@@@DAB@@AA@@A@@@@@ABAB@B@@C@A@@DA@@D@@AF@BCF@F@@ABAB@@@BAD@BA@@DAB@@AB@BCBCBA@ABABAB@BA@A@A@@B@B@BAD@BAB@B@@A@A@AAC@CBA@CBA@CB@@A@@DA@@BC@@B@@@DADA@A@@@@B@@@D@@@B@@CB@B@@C@@@CDAB@@@B@@@@A@CB@@@B@@@BDD@@B@ABBB@BA@@@BD@B@BA@@@@@AD@@AD@D@@AB@@@B@BA@@BA@A@@@@B@@ABABC@@@@@A@@BA@@@A@E@A@@@@@@@@BAB@B@@CBA@CBC@@B@@@B@@@@@B@B@B@@@B@BABCDAB@@A@CBAB@B@B@B@@@B@BCD@B@BA@@B@B@@@DAB@BA@@@ABB@@@@B@@@@@DBB@B@BB@BBD@@@B@@B@@@BBB@@AB@B@@B@@@BBB@BB@@@B@@@@@@AB@@AB@@@B@B@@@F@B@B@B@B@@BBB@@BB@@@B@D@BAB@@@AB@B@B@B@BB@@@BBB@B@@@@DBB@@B@B@DBB@B@B@BB@@@DBDBBB@@@@@B@@@BABAB@DADABA@@DC@@@@B@@@BDBDBB@BBB@@B@B@B@BBB@BB@B@BB@@@@@D@D@B@@@BABABA@@@@FBDBB@DB@@DB@@B@@BABAB@@@B@B@@@B@@@BCBAB@@@B@@@B@@BB@BB@B@B@BB@@@B@@B@@@DC@@BAFBB@B@B@@@@@B@B@@@@BF@B@BB@@B@@@BA@@BA@@BA@@B@B@B@B@B@B@BA@ABCBAB@B@D@@@B@BCB@B@@@@@BABCB@@A@C@A@C@@@A@A@A@AA@@@@A@@@@@@BAB@D@B@@BBB@@DBHABB@@@B@@@@BB@@B@@@@B@@@D@@BBBBBB@@@@DA@ABC@@B@BA@ABA@@@@B@@@B@@DBBBB@@BABAB@@@B@B@BA@@@@B@B@FBB@B@@@BAB@B@@@BABA@AB@@AB@B@BBBB@@D@@ABB@@@@@FAB@@AB@D@@ABA@AB@@@B@B@B@BBB@@DD@@D@@@@B@@@@@B@@BB@D@BBB@B@@@B@BB@BBB@@@B@@@B@@CBAB@BAFABAD@@@D@BA@@BBF@B@@@BB@B@@BBD@B@@B@@@B@@BB@BBD@B@@@B@@A@CBAFAB@B@D@B@@@@D@B@@B@@@DB@@B@D@@DBH@@@BB@@B@@@B@BAD@@@F@F@B@BB@@B@BBD@DBD@B@@BB@BD@BDF@B@@BBDBB@@@BBBBBD@@B@@@B@B@@@@BB@@BDJ@BB@BBBBBBB@DB@@@B@@ADAB@@ABAB@B@DAB@@@@ABC@@B@B@@ABC@GB@@@@ABB@BD@B@BB@@BDB@@BDB@BB@@@BBD@B@B@BAB@B@@@BABCF@BCBAB@B@B@B@B@BAB@BAB@B@B@B@B@BABAB@@@B@@BBBD@@@BGDABEDABCD@B@@@@@B@@@@B@DAD@BBB@BB@@B@BBB@@B@B@B@B@@B@@BD@B@@@B@@A@@@@BB@@B@DBDBB@BAB@@@@@BABC@@BAB@B@F@B@D@@@BAB@B@BB@@BAB@BAFBD@FA@@BBB@B@B@B@B@@@BBFBHBB@D@B@B@@@@@B@B@@@FBB@BBF@D@B@BA@@BA@@BB@@@@@BB@BB@@D@F@BBB@@BB@BB@@F@DBB@BBB@@@B@@@DABAB@@@@BB@@@B@D@@BD@@@@@DC@@BC@@DAB@BA@@B@F@B@@@DABA@@BBB@D@D@BADA@@BA@@D@B@@B@@AB@@@@@BBB@B@@ABA@AB@B@@@D@@@BB@BBB@D@B@B@B@@B@@BBBDBB@@D@@BB@@BBB@@@B@@AB@@EAABA@@@AB@B@BABA@ABA@@@@BBBBB@DBBB@ABBB@@@B@@BB@B@@@B@@BH@FD@@@BBB@@@BCB@BB@@BABABA@@DBBBB@@@@@B@BC@@@A@A@@B@D@B@F@B@DA@@F@BBB@@BBB@B@@B@BA@@BADABAD@BAD@B@B@B@@@@BB@@B@D@@DFBD@@B@BBB@@BDBB@BB@@BBDB@BBBBB@BD@@@@BBB@BBB@@F@B@B@@BB@@BBB@@BBDAD@@@DA@@DA@@B@@B@@BB@@@B@BB@B@BB@@@@@DBBBB@@BDBBB@@BBBB@B@@@@B@@@B@@@B@@BBBBB@@BD@BA@@@@BAB@@@B@BBBB@BB@B@H@@BDCB@BA@@DB@@BAB@BBFBB@BB@@@B@B@BAD@@@@@BBB@@DDB@BBBD@@@@@DBJ@@BD@@@D@@BBBBB@@@@@DD@@@D@BBB@@BBB@B@@@@B@B@@BB@@CB@B@@@@@B@H@BBB@@BB@BBD@@D@B@@BBBB@@BB@B@B@@@D@B@@@D@B@B@B@@B@@@B@B@@@BAB@@@B@@@@BB@B@@@D@@@BB@@B@@HBD@B@B@B@@@BADABA@@@B@@@@@BBB@@@BBB@@@BB@@@DABAB@B@@AB@@@@A@AB@@AB@BAB@@@B@@@@BB@@BB@BBB@BBB@@@BABA@@BA@@@@B@@@B@B@DA@@BA@@B@@@B@@@FB@@B@@@@@B@@@BBLFB@B@@@@@B@A@@A@@@@@ABCBA@AA@@A@@B@B@@@B@B@@@BB@@BBBA@@B@@@B@@B@@@BB@@BB@D@@@@@BB@@@BFBBBDB@B@@@FD@@BD@@@B@@@@C@ABC@@B@B@D@B@D@B@D@B@B@B@BBB@@@BABAB@@G@ABA@@BA@A@A@A@A@@@AA@AAA@A@A@A@A@AA@@@@@AB@FA@A@@@ABA@ABAB@D@@@BABE@@FA@A@ABA@@AA@@BA@CBI@A@AB@@AB@@A@A@C@C@@@AB@@ABA@ABC@C@A@A@ABC@@@A@@@AAC@@@A@@BA@A@A@@AA@@AA@C@C@@@ABE@ABA@@@@BAB@F@B@BADA@@@C@@@@@AB@@BB@B@@BB@D@@@B@BAD@DA@A@@BA@@@AB@B@D@F@B@@ABC@A@@@@AE@@@C@C@@@A@@H@@@D@BA@@H@B@B@BBD@BBFBDBBAB@F@DAB@B@@@@BA@ABEBEDA@A@@@A@@@A@@B@@@B@D@@CB@@AB@@B@@@BB@@BBBBB@BCB@@@D@B@@ABAB@B@DADA@@D@BAH@@@B@BABA@@B@B@@@BC@@B@BAB@@AB@@@BA@@B@@@@BB@@@@BBD@@BB@@B@B@B@@@B@@@DC@ADCB@BCFA@@@@BABA@@@@B@B@B@DC@@@AB@B@@@@@BA@ABABADC@@DAD@@@DAB@DCDAFA@@@@BB@@@BBBB@BBB@B@BBDADBBBBBB@@BD@B@BBDBDB@@BB@BB@@@B@BABA@@FC@@B@B@B@D@@@B@B@DCBA@@@AB@B@B@B@BABADAB@@ABABA@@B@DA@@BA@@B@@@BA@@BCBA@@@@B@B@B@F@@@@@BC@@BA@@FCB@B@B@@ABA@@@A@@DCDABABA@A@A@A@A@AB@@A@ABA@@B@@BB@B@B@DAB@@A@@B@AA@A@@@AB@BABA@@@CBA@@BAD@@AB@BC@A@ABA@A@ABABABAB@@@BA@@BA@@BAB@B@@ABA@@@A@E@@BABADA@@@A@@@A@CAC@@BC@@@ABA@@BA@A@@BC@@BC@ABA@A@A@@BA@A@@BA@@B@BAB@@@@C@@BABA@@BABABA@CB@@ADABADCBA@C@@BABABAB@BA@@@@DBBBD@BB@@BA@@BA@@@@BB@@D@B@@BBBBBBF@@@@D@@@DBFDDBFDB@@@B@@@BA@@@ADCDCBEB@@A@A@@BA@@@C@@@ACAACGE@@ACAAAE@A@@A@AAA@CAA@@@A@@BA@@@A@@@A@@CA@@@EAAA@@A@AAAAA@@A@A@@AA@C@@@A@A@@AA@@@@@AB@@AHC@AB@@@@@B@@BB@@@BABADCBABA@@F@@@BA@@DCB@@@@A@@A@@A@@@@BAB@B@@AB@@@@A@@BA@@BA@@B@@A@A@A@@AAC@AA@@AAICA@@@AAA@@@@A@@@A@@@A@@BABA@A@ABC@E@CBA@ABAB@D@H@BABADCBADC@ABC@@ACA@AAAAA@E@@@A@@@@D@@@B@B@@@@A@A@C@@AA@@A@A@ABA@ADE@ABAD@@AF@B@@A@@BC@C@A@A@A@A@AAC@@@E@A@A@A@@@@@A@@A@@A@@@AAE@@@AAA@ABA@A@@BADABA@A@@@A@@@@BA@A@A@@ACCA@@AA@@@A@@BCBAB@@A@@@A@@@@@AAA@@@A@@C@A@CA@AEC@@AA@@@A@E@ABA@ABA@A@ABCBC@@DABA@AB@D@@@F@B@@@B@BA@@@A@AA@@AAACA@@ACAA@A@@AA@C@AA@A@AA@@@A@A@A@AAA@AACAA@A@@@ABE@A@G@@BABA@@@C@C@A@@@@A@E@@AA@A@ABA@A@A@C@A@@@AC@A@C@@@@A@A@ABAD@BA@@BABAB@@@DA@@B@@A@@B@@A@@@AB@D@@@BA@@@AB@@ABAAA@@@ABA@AA@@@C@A@A@A@A@@A@@@A@A@C@A@@@@BA@@@A@@C@E@@A@@@C@A@@@A@AA@@A@@@@@A@@B@BAB@@@@ABA@@@AAC@@A@@@@@A@@@ABA@@@A@CAA@@BABA@A@@BA@@@AAA@@AAAA@@@AAC@@AACC@@AA@@C@A@AA@A@AA@@@A@ABCB@@A@CBA@AAA@C@A@C@@AA@@@A@BB@@AB@@CBA@ABA@@AC@ABAA@@C@CB@@AAC@A@A@@@@BABED@@CBA@A@@B@B@@@@@@A@A@AA@@@@A@@B@B@BABB@@@BB@B@@@B@B@@@BA@@B@@@B@@@BBB@@@@AB@@AD@B@B@BA@A@@BABA@@B@BA@@B@@@D@B@@@DAB@B@@@B@B@@@B@BA@@B@B@B@@@B@@@B@@@B@@@B@@@@CBAB@B@@@BA@@B@@@B@@@@@@A@CDA@@@@B@@A@@BBBA@@B@B@B@@@BAB@B@@A@@B@D@@@BA@@BA@AB@B@@@@@B@@@B@@@BB@@@@B@@@BBB@@A@AAA@A@A@@@A@@B@BCB@B@@@D@B@@ABAB@B@@AB@BA@@B@B@@@DB@@DAD@B@B@D@@@BABA@@@@B@B@B@@A@@BABADAB@B@BAF@@ABCD@@ABCF@BAB@@AD@BA@@DADABAB@BAB@B@@BB@@ABA@AAA@A@A@A@ABABA@@@@B@@@BBBB@BB@@@@@B@BABA@@B@@C@C@A@A@@AA@A@@@AAC@@@A@AAA@AA@@CAA@@@AA@@AAA@@AA@@@A@CA@@AAA@A@AAA@A@@@AA@@AA@@A@AB@@A@A@@AA@AA@@AAA@@BA@@AA@@@A@C@@@CA@@EAA@A@AA@ACAAC@@@E@@@A@A@@@A@@@A@@BA@@@ABA@A@A@A@CBA@A@@BA@@@A@@@EBC@AB@@A@AB@@ABA@@@AAC@A@A@AAE@A@@AAAA@@A@CBED@@A@@@@@@CA@AA@@AA@@A@@@@AAA@@E@@BAAA@@A@CAAA@CA@A@@AAA@AAC@GAC@A@CAA@@@A@@@A@A@@@ABA@A@AC@@A@@@AAAAA@@AA@@@AAACAA@AAA@ACC@@AAAAA@A@@AA@EAA@@B@@CB@@AB@@A@@@@@AA@@@@AAA@@@@@@@A@@@AA@@A@@BA@@BA@A@@B@@A@@A@BAA@@@@A@@AABA@A@@BA@@@@@@@A@@AABB@AB@@@AAB@@@@A@@@A@@@@@A@@A@@@@A@@@@@A@@@@@CA@@ABA@@@AACCAA@@AAAC@@AAAA@@A@@A@@@A@@@A@@AA@A@@A@@AA@A@@@@@@ACE@@@C@@@@@A@ABA@@A@A@A@@@@AA@@@@@C@AAABA@@@A@@A@@CBA@@@C@A@@@ABA@A@@@A@@AA@A@@A@@@@CAC@EA@@AA@@AA@A@ACAA@AAAA@@A@@@A@@@AA@@A@C@A@@BA@@@CC@@@A@A@@@A@A@A@AAAAA@AA@A@@BA@@@@@AA@@@@CA@@@@AA@@@@@A@@@A@@A@AA@@@A@@@A@@@A@@@@@@@A@AB@@A@@BA@@B@DAB@@AB@@A@A@A@A@@AA@@@A@A@@BADABC@ABA@@@AH@BA@@@A@ABA@AAA@A@@A@@@@ABA@@A@@AB@@A@@A@@A@AB@@@DADABABA@A@@@@AA@@@@@A@A@@@A@@@A@@@A@@@ABAAA@@@A@A@@A@@A@@AAAA@@BA@CACA@@@A@@A@@@A@@AA@@AC@A@AA@C@AAAA@AA@A@A@@A@@AAA@A@@@@@@AA@@@@AAA@@@@AA@@AAA@A@@@A@AA@@E@@@A@A@C@AA@@@@A@@@A@A@@@A@@A@@@@@@AAA@@AA@@AB@@@BABA@@@@AABA@A@A@C@C@@@A@A@A@@@@@AAA@@@@@@@@BA@A@@@@@A@@@@@A@@AAA@B@@A@@A@@@@AA@@@@@@@CAA@@A@@@@AA@@@@A@@A@@A@AA@@AAA@@@A@@@AAAA@@@A@@AA@@@A@CB@BA@@@@@AB@@AB@@AB@B@@AB@B@@ABA@@BA@@AA@@A@@A@@@ABA@@@@B@BAB@BCDE@A@AAAACA@@AA@AA@@A@@A@@AAAA@A@@@AA@@@@@@@@A@@@@@@@@AA@@@@AAAA@@A@@A@@@AA@@A@@@A@@@@DAB@@AB@@@B@@A@@@@@A@@@A@@A@@AA@@A@@AA@@@A@A@E@AB@DCB@@A@@@@@AA@@AAAA@@A@C@@AA@@AACAA@@@I@C@C@@@C@EAE@C@A@@@AAA@AC@@@A@E@GBC@ABA@@AA@AA@@A@@@A@ABC@@@A@C@@AAAAA@AA@@@ABA@@@ABAB@@AA@@A@A@@B@B@@@@@AA@@AA@@@A@A@@@@@@BABA@AAC@A@A@ABA@@BADA@@B@@@B@@@@A@@@@B@@A@@@@@@B@@@B@@@B@@A@@@@@ABA@@@@BA@@B@@@@@@@@@@@BA@@@@@A@@@A@@@@CA@@AAA@@@AA@@@A@@A@@@A@@@A@@A@AA@@A@@@@A@@A@AA@@@@AB@@@@@BBB@BA@@B@@A@AAA@@@A@@@A@@@A@@@@@A@@CAAAD@@@@@B@A@A@BA@@@@@@@@@@AA@@@@@@@@A@@A@A@@@@BADAB@DAB@BA@ABA@AAA@@AA@A@@AAC@AAA@@A@@BC@ABA@@BABA@@F@B@B@BAD@D@D@B@DA@@DBB@@AD@@@B@D@@@B@@@BC@@@A@@@A@ABA@@BA@@B@BA@@B@@AA@@AEEA@@A@@@A@A@ABC@A@@@AAA@@@AB@BCBEB@@@@A@@A@A@IEA@CA@@CA@@AA@CAAAAA@@AAA@AAA@@@A@@B@B@@A@@@A@A@CAAAA@ABA@ABAAA@@@CAA@C@AAA@A@@@A@@@AD@@@B@BAD@BAB@BCBAB@B@@@B@B@BAFAJGBA@ABC@@BA@A@A@C@@@CA@BA@A@@@C@@AAACAC@C@ABEFG@C@@@A@AAAAACA@@A@A@A@ABA@CDABCDEFA@@BA@CACAA@A@A@C@CDEBABA@ABC@A@C@EBC@A@A@CAE@A@G@CBA@A@@@A@CAEAC@G@A@G@A@EAA@@@CCA@AAA@C@AA@@@@@A@A@@BABA@@@C@A@E@A@A@A@G@@AA@@AACAA@@ACCAAAA@@AA@AACA@@@AAA@@@AAA@@@G@A@@A@@@CAA@@AA@A@ABA@A@AA@A@@A@@AA@@@A@ABAAAA@@A@AAA@AA@@A@A@@BA@AB@BAB@@A@@@A@AA@@CAA@A@A@A@@BABAB@DABAB@@ABA@ABA@@B@BA@@FC@@@AAA@@@A@@@@BABC@A@EBA@A@@CCAC@@@AAAAAAAEAAAA@BA@A@A@@AA@A@@@C@AA@ACA@E@G@C@G@@@ABC@A@A@GAAA@@A@C@A@C@A@A@AA@C@ACAAA@@C@CBABCD@BAB@D@B@@A@@@A@AACAA@@@@@AB@@@@@DCD@@@B@B@FA@CB@BABC@EDEDABC@@@ABCDABAF@@A@@DABB@A@@B@@@@B@@D@@@@BD@@AB@BAB@B@B@@@BDBBB@@@B@@@@AD@BAB@BAB@@ABAB@@C@CB@@EB@ACB@@C@A@@BIBC@@@AB@@@B@B@@ABABAB@@@B@@@B@@@B@B@@AB@BA@BB@@ADAB@B@@BD@B@DAD@B@BABAB@@@@A@A@AAAAA@CCCAAAA@CAA@@@CDEDCDCBGFBB@BB@B@@BB@B@@@@B@@@BA@@BAD@@C@@BAAC@A@CB@@@BA@@BA@EDIF@BA@AA@@@A"],encodeOffsets:[[107426,34507]]}},{type:"Feature",id:"621228",properties:{name:"两当县",cp:[106.304966,33.908917],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@BBA@CB@BAD@D@DCDABA@@BAAA@AB@BIDEBEAAACCCCGCKGGBGH@@IFGFEFCJ@b@RAPCJBL@LAJAJAJAFCH@D@B@B@BBB@B@B@@AB@@A@AB@@@@@@@BDBHFBB@@@B@@ABEBA@EBE@A@ABE@ABABA@A@CBC@A@A@E@CACBA@AAA@A@ABA@A@A@ABEDCB@B@B@F@@AB@B@@@BBBDD@@@B@@ABAD@@@D@@@BBB@F@DB@@@DBB@@@AB@@GDIFA@CBAB@B@B@@@B@@BBB@@BA@@D@B@@ABA@EBA@A@AA@@AAA@A@AAA@@BC@ABG@A@@@EAA@G@CAAB@@A@CDA@A@A@@@@B@BABAB@B@DCB@B@D@@CDAB@B@BAB@@CBCBABA@@A@@A@AAC@C@A@CBCBEDA@A@A@@AA@ABA@CDCB@B@B@@CB@@@@@B@@@B@@@B@@@@@B@@@@@BB@@B@@BB@@@@@@BB@@B@@BB@@BB@@BB@@B@@@B@@BBAB@B@@@@@@@BA@@@CB@@A@ABA@C@CBCBA@AB@B@@BB@B@B@@BBB@BB@BB@@@@BBB@@@BC@CBC@C@@@AAA@@@ABA@@@@@@B@@AFA@@@@DA@@BBB@B@@IACA@@@@@A@@@A@@@A@A@@@@A@C@C@@@ABB@@B@@B@AD@@@@@@AB@@C@C@ABCB@@A@A@@BE@A@ABA@ABA@@@@AA@@AA@CA@@A@A@A@A@@@A@@@@AAA@ABAAA@C@@@C@C@@@AA@A@A@C@@@A@AAEAEAA@@A@A@A@AA@@@A@CA@@A@@@@B@B@@DB@B@B@B@@ABA@@@ABA@@AA@@A@A@@BCAA@@@AA@AA@@AB@@A@AD@BAB@BABA@A@@@C@C@ABA@CB@BAB@@@B@@B@BB@@@@DF@BAB@B@BA@@@CBA@@@AAA@AAAC@@AAAA@@A@A@@@ABAD@@@@CBE@A@AA@BA@@@@B@BA@ABADA@@B@BB@B@@@BB@BA@CB@BABBB@@@BDF@BB@BB@BBFDD@@@B@@ABAB@@C@@@AA@@A@A@@@@@AB@@DBBB@@@B@B@B@@ABA@A@ABAAA@ABABAB@B@@BD@BB@@BA@@B@B@B@@DBB@@@B@B@B@B@B@DBDBF@DBD@DB@BD@B@BBHDB@B@BBBB@BBBB@@@B@BBB@@@@@@B@@@B@@@@AD@@@B@B@@DHB@@BBBBDBBBBBBBBB@@BDBBB@@@@BB@@@@@B@B@B@@@B@@@D@BBB@@@BAB@@AB@@BB@@AB@B@@ABA@A@ABAB@BABAB@BABA@AB@@@@@DB@BB@B@BBDB@BBB@@B@@@BBB@@B@B@@@BBBBBBBB@@B@@BB@@BAD@BBB@D@@ABAB@@BB@@@BABADA@@BB@@B@D@B@BBFBB@DA@@BA@@@@BBB@B@@ADAB@@A@@B@B@@@B@BA@@B@@BB@B@@@@B@@@@B@@@B@@@B@@@@@B@@@@@@CBC@AD@@@BC@CBAB@@A@EBABA@A@@@C@A@BDBBBD@@BB@BABA@BB@@ABAB@B@BADAB@B@@@B@@@@@@@BA@@@@@A@@@@A@@A@A@AA@@@@AAA@A@@BA@@@ADABAB@@ADAB@@B@@@@@@@BBBB@@@ABB@@@@BB@@@@B@B@@BB@@@B@@@BB@@@@@@@B@@@@BBHDB@@BB@@BD@@B@@@@ABADABA@@@@@BDBB@B@BB@BB@B@@@B@B@BA@BD@BBBB@BD@@BB@@@B@@BB@@@@@B@@@B@D@@BDBBBB@@@@@D@B@B@@@@@BBB@B@@AB@@@@@BBD@B@@@BA@BB@B@@@BB@A@@B@D@B@B@@ABAD@@@@@B@B@@BBB@B@@@B@B@BB@@B@B@@@@@B@B@B@@@@@BBB@B@BB@@@@B@D@DABBD@F@B@D@@@DA@@BCB@@A@@@@B@B@@@@@BB@@ABBB@DB@@B@B@B@B@B@B@BAB@B@@@B@B@@ADAB@@@BBB@@@@BB@@@@AD@DA@@@ADC@@B@@@B@BAD@D@B@B@B@@ABCB@@A@BDAB@@ABAA@@A@A@A@@@AB@@A@@@A@@@A@AB@@A@@@A@@@@@@B@@A@@@@@@@@BA@@@@BA@@@@@@B@@A@A@@@@@A@@@@@@@AB@@@@@BABA@AB@@A@A@A@@@@@A@@@@AA@@@A@@@A@@@A@@@A@@@A@@@AAA@@@@@A@@B@@@@A@@B@@@@A@A@A@@@A@@@A@A@A@A@A@A@@@@@A@@@@AA@@@@@AA@@@@A@A@@AA@AA@@AA@@A@AA@@AAA@@AA@@@AA@@A@A@A@@@A@@@@@A@@@@@@@AB@@AB@B@@@@@B@@@@@B@@@BBB@B@BB@@B@@@@@@@@A@@@@BA@@@@@@@@B@@@@@@@BBB@@BB@B@@@@BB@@BB@@@@@BB@@B@@@@@@B@@@@BB@@B@@@B@@BB@B@@@@@BA@A@@B@@@@@@@@@@@B@@@@@B@@@BB@@@@B@@@@BB@BB@@@BB@@@@B@B@@B@@@@@BB@@B@@@B@@@B@@@B@B@@BB@@@@B@@B@@@@@B@@@B@@A@@B@@@@@B@@@B@@AB@@@BAB@@@@@BBB@@@B@@BB@@@BB@@B@B@@B@@B@@BB@@B@@BB@@B@@BB@@B@@@BB@@@@@@@B@@@BBBBD@B@@BBFFB@B@B@BB@@@@@D@@@BB@BB@BD@@@@B@@D@@BBBBBB@B@BB@BD@BB@@@@AB@@@B@@BD@B@B@@A@AB@@AB@B@@@BBBAB@B@BA@A@@@@B@@BFBD@@BBF@BB@@@@BC@@BAB@B@B@B@@@B@@B@@@@DBBB@B@@DBBB@B@BB@BBD@BB@@@@@@@D@B@@@B@@@B@@@B@@AB@@BB@BBBBB@B@@BB@D@@AB@@@BBBBDBB@DB@BBBDBBBF@B@B@BA@@BA@AB@@@@@B@BBD@@BB@@@B@@@BC@AB@@@BABADAB@@BBB@BB@@BB@B@D@BB@@@FBDB@BB@@B@BBB@B@B@DBB@@B@B@BBBBB@@@B@B@@@DB@@HBD@@@FB@@DAB@FBD@B@B@@@B@DABCB@HB@BB@DBB@@@@@BA@@AA@C@@@@BA@A@@@AA@@A@@B@@ABA@A@A@A@@D@B@B@D@@@BA@A@A@AAA@CA@@E@ABA@A@A@C@AD@BABAB@@ABADADA@AB@@A@A@@BAACBA@@BA@@@@@A@@EC@@@@@A@A@A@A@@@A@A@@@AAA@@@AB@@AFIB@BA@AAA@AAA@A@@A@AAAA@@@AAC@@@A@AA@AAACCEAAA@A@CA@@@@AC@A@AAAAAA@@@@@AABC@A@@AA@AA@@A@A@A@@BABAB@@AB@@A@@@@BA@@@A@C@@AC@@@@BABA@@@C@A@AAAB@@@DA@@BA@@BC@A@ABA@A@C@C@@BABABA@A@@@@BABA@@D@DBBABB@@B@B@B@BAD@BAB@@@@@FBD@@BBB@@FADAB@@ABA@@B@D@B@@@B@@BBBB@B@B@DB@@@D@@B@@BB@B@@@B@@B@B@B@BAB@@@B@@@@D@BBB@@AB@BA@@@@B@B@BBB@B@BB@@@@DABAB@D@@@D@@@B@@AB@@AB@B@B@B@BBDB@@B@BAB@B@BB@@B@@@B@FDDBBB@D@DB@D@BBB@@@BBB@B@B@HBB@@BB@B@B@B@D@BBBBDBB@@@BB@@@B@BAB@@@B@@@@@B@@AB@@@B@@BBBD@BDBDB@BB@B@BB@@BBB@BB@BBB@@@@DADABA@@FDBBB@@@D@D@@B@@@BBB@BB@BF@B@DB@@B@@AB@@BB@BABBD@@ADADAB@@@@@B@@@BBD@@@D@@FF@B@@@BAB@B@@@@DD@@@BDDB@@@@B@B@@@B@@B@D@H@@@B@@B@@@B@B@@BB@@BBDBDD@B@@DJAB@B@@@@@B@BB@@BAB@@@@@B@B@B@@@BBHA@@BAB@BAB@B@B@BBD@@AB@B@@@F@D@D@@@BBH@BA@@B@@@@BDBB@@@BA@AB@@A@A@@@@B@B@@@@A@CB@@AB@@@B@@BB@BB@BBAABA@@BAD@B@BEBEBENCHBNDBBDDDBFFDDFDDDHDHAHAHEJGFEDIFIDE@EBIDIDKBIBIBKBGFM@GBKDIBEBGDEDEDEDCDEAC@EAA@A@ACAA@AAEI@AKM@ABAA@@@@@A@A@@@@ACGGKAC@C@AA@CBA@AAA@A@@@CBA@@@ICK@K@G@G@K@IBGAGCA@GACA@GBIBK@IDGBG@IBI@GAGAG@@CCGIGEGGIGCAAAICEEEGCGBI@IDIDGBGDGBE@G@E@GCKCGACAAGICEECCGGEGCCGGECECIACAAECCA@@CACCAEAACCCACCCAAA@AAAAACA@@AAC@EA@AGC@C@ADCBA@@BABABCBEBCBA@K@AAIBI@EDCBABCDCFAL@J@J@RFDBD@@B@@@@ABA@A@A@@BA@@B@B@@B@@@B@BA@@BBFBBBB@BB@@BB@BA@@@EBA@@@@B@@@BD@DBB@B@@ABA@ABA@ABA@ABAB@D@B@B@B@B@BA@A@C@ABA@C@ABABA@ADAJEDE@@FGDGBEBA@IBEEGKCGEGCECEEGE@G@@AGAIEICIAGBE@AHEFCJCF@HCBCDABEDGBEBE@G@E@G@EAE@AAG@GBE@IAEAGAECEAEAABEDI@@HCNEJCL@H@J@FAD@H@F@LEHCJGFG@EHKHIDIFEFKDGDIHGDGDCFK@@FGBGBIDIDM@ACA@CBC@GAEBEBE@CCAAC@@AC@@AA@@@@AA@CJC@C@CC@A@@@G@GCIAEACACG@G@GDIDOBECKAECGCEACC@E@@ACCA@GKCGC@CDGBI@E@GDC@ACAA@AEAEB@@"],encodeOffsets:[[109069,34319]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("平凉",{type:"FeatureCollection",features:[{type:"Feature",id:"620802",properties:{name:"崆峒区",cp:[106.674767,35.542491],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@F@@AA@A@AA@D"],["@@AEAA@A@@@@DAB@DABA@@@@@A@AAA@@@A@@B@@@BA@@DG@@@@DC@@BABC@@@A@E@@@@B@B@@BB@BD@DB@BD@@B@B@@@BB@@B@D@B@B@@@DABABABA@@B@BA@AB@BA@A@@@@AAA@@@@A@A@@@A@AAA@@@A@AAA@@CC@@@@@AAA@@A@CBA@A@C@@@A@@@AAAACBCBABABA@@@ABC@A@@@A@A@ABABA@CBA@A@A@AA@@@A@@@AA@A@A@@@@@@AA@A@@@@BA@@@@B@@G@A@ABC@ABC@E@IB@@A@@B@@@BB@@@@D@@@@@@@B@@BBA@@@A@@@A@@@@@@@A@@A@@AB@@@@BB@@BB@@@B@BAB@B@@AB@@A@A@A@@BA@@B@AA@B@AC@@A@@@ABCDA@AB@AAA@AAAAAACAAAAA@@@@@AB@B@@AAA@C@C@A@AAAA@@@@AB@@@@@@A@@A@@@@@@ADA@ABC@C@@@ABC@C@@@AAA@A@CACAA@@@@A@A@A@@A@@@C@CAAA@@A@@@ABA@@B@B@@AB@BA@@@AACB@@@@@B@B@@@B@@BB@@@BAB@B@@CDA@A@CA@@ABADAB@@@B@@A@AB@B@B@BA@@BCBAB@BCBA@@B@B@@BBB@@B@@@@@B@B@BA@A@@@ADAB@@@BC@ABA@@@@@@D@@AB@@ABE@A@@@@@A@EBA@A@@@CAC@@@A@@@AAAAAA@@E@AAA@@@CBAB@@@B@@@BA@@@@@@@AA@@@BADA@@@@@A@@@A@A@ABA@A@C@@@@@@@A@@@A@@@C@ABA@A@AB@@@B@@A@@@@@@B@@@@@BA@@@@B@@AB@B@@@B@@AB@@@B@@@@AB@@@BA@@B@@@@AB@B@@AB@@BB@B@B@@@@@@@B@@@@ABA@@@@@AB@@@@@B@@@@@BAB@@@B@@@B@@@B@B@@@@@@@BAB@B@B@@@@@B@D@BAB@@A@AB@@A@@@ABABA@@@@B@@AAECAA@@AACA@A@@A@ABC@AB@@AA@AAA@@AA@@AAA@@@@@AA@@@AA@AAAA@@AA@B@@@B@D@D@@@B@@@@A@A@ABEA@@A@@@BA@@@@EAC@@@CAAA@@A@A@@BA@@@BB@@@B@@ABA@@B@@@B@@@B@B@D@@BB@B@@CB@@@BA@BBAB@BA@@B@@BB@B@@A@@@@B@B@B@@A@@BA@@B@@A@A@AAAAAA@@@@A@CBA@@@EDABEBEBA@@ACA@@@@A@@B@@AB@BBBA@ABABA@@B@@@A@@@CBC@@@C@@AAEAA@@@AB@@@B@@@B@B@@@D@@BBB@BB@@@BAB@BAB@@A@CBA@A@@@A@@@A@@@AAAE@A@AA@A@AD@@ABA@AB@@AAA@A@A@A@A@A@A@A@@BA@CB@@C@@@@@@A@@CACAC@AC@@A@CB@@ABAB@AA@A@AA@BAD@@EA@ABC@@@A@@GB@@AAC@C@A@A@@@CBA@ABA@CAABAB@@@DA@ABA@ABBDBNBDB@HP@BBBDD@BABCDA@@BA@@B@BAD@B@FEJKTCFCBAD@B@B@@@@@B@@@@@@@B@@@@@@@@BBA@@@@@@@BB@@@@@@@@@B@@B@@@@@@@@@BB@@@@@@@B@@@@@@@@B@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@BBIBBJDJBHBLAD@BC@@@A@@@@@@@@@A@@@@@@@@A@@@@@@A@@@@@@@A@@@@@@@@@@AA@@@A@@@@@@@@@@@@@A@@@@@@@@@A@@@A@@@A@@@@@@@A@@@@@@A@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@A@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@BA@@@@@@@@@A@@@@@@B@@A@@@@B@B@@@LBD@BADAD@FAB@DABCDIFKDABBDBB@@BD@B@BCBC@EBA@@@AB@BAD@BAB@B@B@@DD@@DDDDBDFBFBBB@D@@ABA@AB@B@DBDBBAB@BABAFBDBFBDBBBH@@BHDJ@BBDBD@BB@BBD@@@@@@@B@@@B@@@BA@@@@BAB@@@BADAD@DAFA@@FB@@@@B@AB@BABA@ABCBCD@BBB@B@BAB@BAFAJAFADABABCBABAB@D@B@B@H@B@BBD@B@BABABABABCDGDCD@@CF@D@B@@BB@@BBDBBBBB@BBBBBAB@@CBCBCBC@CB@BAB@BCDADAD@B@BBD@DABA@@@C@CCC@@BAB@B@BBBBB@B@BABCDAD@D@B@B@B@D@D@B@D@D@BD@DBBBBBBB@@BAB@@BB@B@DBB@@BBBBB@BDFBBDDDFDF@@DDDF@BBBDD@@DBJBBBB@H@PDDB@@ABBD@@@@CB@@@BB@BBBBDBB@@B@@AB@B@@BBDBB@FBF@@CAA@@AA@A@@A@@@A@@@@@BA@@AA@A@@@AD@D@B@BB@B@DBF@@@B@BD@DA@@L@BBHB@BHADBBC@GBEBCBGB@BB@D@F@HAJ@BBBF@F@DAD@BAH@F@FBFAB@DAHADB@@H@DADCDCFAFBDCBCD@D@H@FA@AB@DADB@@BBBBB@BB@BBB@DCBAD@F@FDBB@B@D@BABA@EF@BC@C@C@C@CBADB@D@FBFBFFFD@DA@G@ABMBGBCDCHIBCBAFC@@F@DADCDAH@B@BB@F@B@BB@BABABEBA@ADAJ@BBBB@BBBB@DA@@@@BBAD@BB@FED@@BADCF@B@BDDBD@DBFBBD@DBB@DA@@B@@A@ACAAAAA@AB@@AFBBBBBB@BA@C@CDEFCFCFAFCHCFCFADAJGDAHCDEHCB@@CBABAB@BB@B@BB@BCBABBDBDBF@FADAB@D@@A@@A@@AB@@@BA@@BAB@BAB@BABAB@B@B@BAD@B@FABA@@B@@@DAD@DA@@@AB@@@DAB@DAB@B@BA@@BABCBA@@B@D@B@FA@AB@BAB@BA@@BADAB@DABA@@D@BABA@@B@DAB@@@D@BAB@@@D@@@BA@@D@DB@@@AB@@@B@@ABA@@B@B@@@DAB@@@B@@AFABAB@B@B@B@BA@@@@B@@@BA@@B@BAB@@AB@BA@@B@@@B@@A@@B@B@@@BA@@BAB@B@BABAD@@A@@BA@@B@B@@A@@B@FCB@BA@@@A@@@@B@@@B@BA@@BA@@@AD@@@@A@AB@BB@BB@@@B@B@@@B@@@B@DB@@@AB@@A@A@A@@@@D@@@@A@@AACC@@@AB@@@@@DA@@BA@@BA@A@@@@BA@@B@@A@@@@F@@@@@@A@@B@@@BA@@@@@@B@@AB@@A@@BA@@BA@@B@@A@@@A@@B@B@@@@A@@BC@A@@@A@@B@@AA@@@@A@@@AB@@@B@B@@A@@@@BA@@@@B@B@@A@A@@BA@AB@@A@@A@@@A@@@@@BA@@B@B@@A@A@@BA@@@@BA@ADA@@BA@@@A@@A@@A@@@@D@@@@@BA@@@A@@BBBB@@BA@@@BB@B@@@@@@@@@@ADB@@@A@AA@@@A@@@A@AA@@@A@@@@@@B@BC@@@ABA@@DBBA@@@@BABBBA@@B@B@@@@@BA@@BA@@@@@ABABA@@@AB@DA@@@@@A@@B@B@@@B@@@@@B@@A@@A@@A@@@@B@@@B@@@@A@@@@@A@@@@DB@@@A@@@@BAAA@@@@B@B@B@@@BAB@@@@@AA@@@@@@B@@@@@@@@BB@@@@@@@AB@@B@B@@@@@BA@AB@BAB@@A@@@A@A@A@A@@@@BA@@@@BBBD@@B@@@@AB@@@B@@@BA@@B@@A@@@@AA@@@@@A@@D@@@@@@@@AB@@@@@@BB@@@@AB@@@@A@@A@@@@@@AB@@@B@B@BB@@@AB@@A@@@AA@A@@@@@@@@AB@B@BB@AB@@@@@@A@@@@B@B@@A@@@@B@@@@@B@@@@@@ABA@@@A@@D@BA@@BA@@BAB@@@@AB@D@@A@A@@@A@A@@B@@@DAB@BA@@B@BC@A@@@A@A@AB@B@@@@A@@A@@@@@@AB@@@B@B@@@@A@@@A@@@@@@B@@@@B@@B@@@@A@A@@BA@@@AB@DAB@B@BA@@@B@@@@@B@@@B@@BABCBABA@@BAB@@A@A@@@@@@BB@B@@B@@@@@@A@A@AB@B@B@BA@@@@@@BA@@@@B@B@B@@AB@@@@A@B@BB@@@@ABA@@@AB@@@B@@A@@B@B@@@@A@@BA@@BAB@BABA@@@A@@D@@A@@B@B@B@@ABAB@@@B@@@B@@A@@@A@@@A@@@ABA@ABAB@@@B@B@@@B@@@AA@@@ABABADABE@@@A@@@@@@@A@@@C@@BC@@@@B@@ABA@A@@A@A@@A@@@A@@AC@A@@@ADAB@@@@C@@@A@@B@@@@B@@@@@@@@DBBB@@BB@B@@@D@B@D@@B@BB@@@B@BAB@B@B@@@@B@@@B@FAB@F@F@B@FABABAHGBABABA@A@@B@@@DAB@@@BABAB@D@@@BABABBDB@@@@ABAB@@@B@@@@@@@@@@@B@@@@@@@@@BB@DD@@@@@@@BAB@@@@@B@@AD@@@@@@@@BB@@B@BBB@DBBBBB@@@@@@@@B@BB@AB@@@@@B@@@@BB@@@@@@B@@@BBBBBDDBBBB@@@B@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@B@@@@@@@@@B@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@B@B@@@@@BA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@B@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@DAB@BA@@@@@ABB@@@@B@@@BAB@DADAB@@AB@B@@AB@DA@@BABAB@B@B@BAB@@AB@@A@@@@@A@@D@@@B@@@@A@A@@BA@@BAB@@@BABCB@@@B@@@@@BA@@@A@@B@B@B@@@@@@A@@@@D@BAB@D@@A@@@A@ABAB@B@@A@@B@@@@@@A@@@AB@@BB@@AB@A@@A@@@@AB@@@@@@AAACGEA@AAA@CAAA@@AA@ACA@@@@AB@B@@A@@@@BA@MDA@AC@A@@@@@A@AAC@CB@@AD@DA@@@@@C@@BABCB@@A@@@@@A@@AA@AAA@C@AAAB@@@AA@@@ABA@A@@B@B@B@D@@@AC@@AG@AAA@A@@@AA@@AAC@@AA@A@@BA@A@AB@@A@@@@@A@@@@A@@AAC@@@CAA@@CBIBABC@A@@@A@@A@@A@@@AAAB@@@B@@@@A@@@@DCB@@A@A@GDGDKDC@CBCBC@@@@@ABGFABAAA@AEAA@A@@@@B@@@@C@@B@@A@@@@A@A@@A@@@A@@@@B@@@@A@@C@@@@@@@@@DA@@@@@C@@AB@@@@A@@AA@CE@A@@@@BA@@@@@@AAA@@@@@@CA@@@A@@AA@@@AB@@A@@@@A@@AB@@@@AA@@AAAA@@A@A@C@C@@@AB@B@@@B@@@@GC@@A@@AAA@@C@@@A@@AA@A@@@@@CBABABB@@@@B@@@BBB@@@BAB@BKEC@AAA@ABA@@@@@@AAA@B@@A@@@@B@@A@@@@A@@BA@@@@@AAA@@AA@@@@@@A@@@@@@@@AA@@@@A@@@@AB@@@@A@@@@@@@@@A@A@@@@@@@@B@@ABABA@@@@BA@A@A@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@A@@@A@@@@@@@@@@@A@@@@@@@A@@@@B@@@B@@A@@@@B@@@@@@@B@@@@@@A@CB@@A@@@@@@@A@@@@@@@@@AB@B@@@@@@@@AA@@A@@@@B@@A@ABCBEBED@@@@AA@A@@@A@@@@@@AB@@@@A@@@A@AA@@AB@@@@A@@@@@@@AA@@@@@@A@@@@@@@@@@@@@@@A@@@@@A@@@@@A@@@@@A@A@@@@@@@@@@@A@@@@@A@@@@@@@A@@BA@AAA@GBEB@@A@@A@AAAA@@A@@AA@A@@@@A@AB@@@B@BCD@@A@CB@B@@@B@@@@A@CCAAA@A@E@@@BFA@@@@B@@@@BFBBBBC@AB@@A@@@BC@A@A@AA@@@A@@@A@@F@@ABA@A@AD@@AA@A@@@@@@@@AA@AAA@@@@@BA@@@@@A@@B@@A@AB@AAB@@@ABA@@A@@@@@BA@@@@@A@@AA@@A@A@AB@A@A@@@A@@@@@AA@@AA@@@A@@@EDAB@@BB@@A@@BBB@B@@A@AAAAA@@A@A@@@@AD@BAB@@@@DDB@@BABABABABA@A@@@@@A@@A@A@@A@A@@@A@@@@@ABA@@DCFA@@@AB@@@@AA@@@A@@A@A@AB@@ADAB@A@@@@@AAA@@A@@@AB@@AA@@@@@@@A@AB@@AAA@@@@ABABA@@A@@@A@AAAA@@@C@A@@A@@@@B@@A@@@@@@C@@@@@@AB@@@@A@@A@@@@@@@@@@@A@@@A@@A@@B@BA@@@@@AA@@@BA@A@@AC@@@@@@A@@AB@BABA@@@ACC@@@AB@@@DQA@AB@@@@@@@AB@BA@@B@A@AAA@AA@@A@@BA@@@A@ABA@A@@@A@@@@@@@AB@@@@@@@@@B@@@@@@@@@@@@@@@@AA@@@@@@A@@@AAA@@A@@@@@@@@AA@@@@@@@A@@@@@A@@@@A@@@@@A@@@A@@@@@A@@B@@@@AB@@@@@BBB@@@@@@@B@@B@@@@BB@@@@B@@@@@@AF@@@@A@@B@@@@@@A@@@@AA@@@@@A@@A@@@@@@@A@@@@@@AB@@@@A@@@C@C@@@@@@@@@@@@BA@@@@@@@@@A@@@@@@@A@@@@@@@@AAA@A@A@@@@@@AB@@@@@@@@A@BABA@@A@@@@@A@@@@@@BA@@B@@AB@A@@A@@@@@@@@A@@@@@@B@@@A@@@@@@@@@@@@@A@@@@A@@A@@@@@@@@@A@@@@@@@@B@@@@@@@@A@@@@@@@AA@@@@@@@@@A@@A@@A@@@@ACAAAA@@AA@@A@@@@@@@@@@B@@@B@@@B@@B@@@@@@@@B@@A@@@@@AA@@AA@@@@@@A@@@@B@@@@@@@BAB@B@@@@@@A@@@@@A@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@A@@@@B@@@@@B@@A@@@@@@@@AA@@@@@@@@@@@A@@@@@@@@@@A@@A@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@B@@@@@@A@@@@@A@@@@@@@@@@@@@@@AA@@@@@B@@@@@@@@@@AB@@@B@@@@@@@@@@C@@@@@@@@@@@@@@B@@@@@B@@@@@@C@@@@A@@@@@@@BA@@@@@A@@@@@@BB@@BA@@@A@@@@AAAAC@AA@CAA@A@@A@@B@BAB@BAB@@@@AAA@@@@@AB@@A@@A@@@@@A@@@@@@@@A@CBE@ABA@@BB@@BB@B@A@@BA@@AA@@@@B@D@@@@@AEAC@@@C@AAA@@@@@@@ADAB@@A@@@A@@@A@@B@@@@BB@BADC@@@A@@@A@@B@@@@@@@B@@@@@B@@@@@BA@@@@@@@@DAD@@AB@@@@@@@@@@A@@@@BA@ABA@@B@@A@@B@@@@@@BB@@@@@@@@@DC@@@@F@B@B@B@B@B@@@B@@@B@@@@@BA@ABA@C@@@A@@@@D@B@@@@AB@AA@@@A@@AAG@C@CA@@@AAA@A@@A@@@@@@B@@AB@@@@A@AAAAAA@@A@EBCAA@@@AA@A@A@@@@@@ADABAB@@AB@B@BBDBFBBA@@BG@ABEB@@A@ABABA@@@@BAD@@AB@@@@CA@@A@E@A@ABAB@@AAB@BEBC@@@@A@E@A@@@@@A@CIAACAAC@@@@@@A@A@AAAACAAAA@ABA@@@C@@@C@AAA@@ACE"]],encodeOffsets:[[[109058,36596]],[[109386,36158]]]}},{type:"Feature",id:"620821",properties:{name:"泾川县",cp:[107.36785,35.332666],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@A@A@@B@B@DA@@@@@A@@A@@@A@A@@G@A@@@@@AA@@A@CAA@@@AB@BAB@@A@A@@@A@AB@@@@@B@BA@@AA@A@C@A@@@@A@@@A@@DC@@@A@@@A@@AE@@A@AA@A@@@A@C@@AAA@CBA@A@@@@B@@@B@@@B@@@@AB@B@@@B@@BB@@A@ABE@@@@@BB@B@D@@@B@@@@A@@@AAAA@@@@@@@@@D@@A@@@A@@@@@A@@ABCA@@AAA@A@A@@@A@A@@AAA@@AAAAC@@@@AB@B@B@B@FB@AD@BBB@D@@@BABA@@@A@@@A@@@AB@AA@@@A@@@ABA@A@@BAB@AEB@@@@@D@@A@@BC@@@CC@AA@@@@@A@@@A@AAA@A@A@@@BB@BBD@BB@ABA@ABC@AB@B@@@B@@B@@B@B@@@D@@A@ABA@@@AB@@@B@@@F@@@@A@@@CAA@AAAB@@@BBB@@@@BB@@@@@B@@@B@@@@B@B@@@@@@@ABA@@@ABA@@@A@@AB@A@@@AAG@A@G@E@A@A@@@AA@@@@A@@@ABA@@@CCAA@@A@@@@@@B@B@@@BA@AAA@@@@B@@AB@@A@A@A@@@AB@@@@AA@@@@@@@A@A@ABA@A@A@@A@@@A@@@A@@@CBA@CA@@AA@@@@AB@@BD@@AB@D@@AB@@AAA@CAA@@@@@@B@@BB@@@B@@A@A@@@ABA@@@A@@@@@@@@E@@AA@@AB@@@BAB@B@@A@@BA@BB@@@B@@A@@@A@A@@A@@AAA@A@AA@B@@@@AB@@A@A@CAE@@@@@@B@@A@BB@@DBDB@BB@AB@B@@A@A@@@AA@@@@@B@@@DBBBD@BAB@BC@@BA@@@A@AAA@A@@@@@@A@@@@@@A@@@@B@@@@A@@@BA@A@@A@@@@BA@ABA@@AA@A@CAA@AAA@@@@AB@@A@A@A@@@A@@A@@A@BA@@@EP@@AA@@@@@B@B@B@@A@@@AA@A@@@A@C@E@A@@@@A@@@C@AAA@@@A@@@@@AA@@A@@@@A@@C@A@@@@@@@BA@A@@@A@@AA@@A@@A@@A@@BAB@@A@@AA@@B@@A@E@@@@@@B@B@@@@@@AA@@AA@AA@@@A@@@@BAB@@A@A@@@@A@@AA@@@A@@@@@@BA@A@A@@A@@@@@A@@DA@@@C@@B@B@@@@A@A@@@A@@@@@@B@@@@C@A@@@AAEAAA@AAA@@AACA@A@AACAA@A@@@@AB@B@@@@A@A@@AA@@@@A@A@@@@A@@@ABA@@AAA@@A@@@A@@@@B@B@@ABA@A@A@@AA@AB@BA@A@A@A@@A@@@A@@AA@@@@@A@@CAA@@@AB@BA@@BA@AB@@A@A@@@@A@@@A@@A@C@ABC@A@A@A@@@AAA@AA@AA@@A@A@C@ABA@@@@@@@@A@CBA@@@@@@C@@AAC@@@@@A@ABAA@@A@@@AB@B@@@B@B@B@BAB@B@@A@CAA@@@@@AB@@@B@@AB@@A@AA@@A@@@@BBD@@A@@@@@C@@@@@@@@BBBBB@@@@@B@@A@@@A@C@AAA@C@@@A@@@AA@C@@@@A@A@@@AAA@A@CDA@ABA@@AA@@AAC@@@@@@AD@@A@@@@A@A@@@AA@@@CDABA@@@@@@A@@BA@A@@A@@@@@A@@BA@@@@@AB@@@@A@@@BABC@@@@@@A@@BAB@B@B@BAH@@A@@@@@A@@B@@E@AA@B@@@D@BA@@@AB@B@@@BB@B@@@AB@@@@@BB@B@@B@@@BADABA@AD@@CBA@ABAB@@ABAFA@@@CAA@@@@A@@BABC@@@@CAA@@@AAAA@A@C@@@A@AA@@C@@C@@@A@@C@@A@A@@@AC@@@A@@@A@@@@AA@A@A@@@AB@BAD@DADA@@@@@@@AC@@AAA@@AAA@@@A@@@@@@A@@@@@A@@A@AA@@A@@@@D@@@B@@A@@@A@@BB@@BA@@DADABBDAB@@@AAA@@A@@B@@@BC@C@CACA@@@@ABABA@ABA@CB@@@@@B@@A@A@@@ABA@A@ABA@@B@@A@@@AB@@@@CBAB@@@@@BC@ABA@@@ABA@@@A@A@AB@@@@CB@@@B@@A@@@ABA@@H@D@BABAD@D@@@@@@CA@@@@@@@B@D@@A@@@C@A@EC@@@A@A@A@AAB@BA@@BA@C@GDA@CDCBA@AD@@@BBB@BDD@BBF@B@BBD@@@B@BBB@B@B@@@B@@AB@@@BAAA@CA@@CD@@@@E@@@C@ABA@@@AB@@BB@@@@A@@@ABC@C@@@@@CDAB@B@BB@AB@B@B@B@D@B@@@B@@@@@D@@@@@BAAC@A@CBCBA@CB@@@B@BB@AB@B@@BB@@@B@BABBBAB@@B@@B@@@BB@@@@B@@@B@@BB@@@@@@B@FC@@B@@@@@@BBH@B@@BB@@@BA@@@@BB@@@@BA@@@BB@B@@BBB@BD@@@@@@@@@B@@B@B@D@@@B@B@@@B@B@@@BAB@@@@@B@@@BD@@B@B@@@@@@BBBDB@@BA@@D@@B@A@@BB@A@B@BBB@@@AB@B@BA@@B@@@@B@@B@B@@B@@@BAD@B@B@D@B@B@@B@@@@@@@@@B@@@B@@@B@@@@@@@@@@@@@@@@D@F@D@@ABA@A@@D@BA@C@A@@@C@@@@@@@@@@@@A@EC@@AA@@DA@@@@B@@@BABCB@@@@A@@@@@@@@@@BB@@B@@B@@@BB@@@@@@@@B@@B@@@B@@@@@B@@@@@@@@@B@@@@BBDB@B@@@@@@@@@@CBAB@@@BBD@B@@@@@@@BA@@BAA@@@@@@A@@@@@@AA@@AAAAA@@A@@@@@@BA@A@@@@@@B@B@B@B@@A@@BA@@@AAAA@BA@ABAD@@@@BBFD@@A@@@A@@@@A@@A@@@@@@@A@@@@@A@A@@@GDA@AAAAA@AA@@AA@@AA@@@@@@@@@@A@@@AB@@@@@@A@@BA@@@@@@@@@A@@B@@@@A@@@@@@@A@@B@@@@A@@@@@@@@@AA@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@@BA@@@@@@A@@@@A@@@@A@@@@@@@A@@@@@@@@AA@@A@@@@@B@@@@@@@@ABA@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@B@@@@A@@@@@@AA@@@@@@@@@A@@@@@@B@@@@@B@@@@A@@@@@A@@@@@@@@@@@AB@@A@@@@B@@B@@@@@A@A@A@A@CA@@@@AB@@@@A@A@@@@B@@@B@@A@ABA@@@A@@A@ABA@AA@@BAA@@A@A@CAAA@@BA@A@@B@B@@@@A@A@@@@@C@@A@@@C@@@B@@CA@@@@@@@A@A@@@@@@@A@@B@@@@@A@@A@@BA@@@@@@DA@@@@BB@D@@@C@G@@@A@A@CBCAC@@@@B@B@@@BBB@B@B@D@B@B@BAJ@B@@BB@@@@BA@@@B@@B@@@BA@@B@@BB@@@B@@D@@@@B@BB@@@@@@AB@@@@@BDFB@@BB@@@@@BA@@@D@@@@CB@@@@@@@@D@@@@B@@A@@@@@@B@@@BB@B@@@@@@BA@@@@D@@A@@@@@@BBBBFB@BBBAHEBA@@@@D@DADAD@LCHCHCB@B@@@DA@C@@B@@@@@@A@@BABB@@B@@@@BB@@@B@D@BAJADA@@BB@D@@BD@BB@@@@@@B@@@@@BA@@B@BAB@@@BBB@@BD@BB@@B@@@BBB@BBH@@BD@@C@A@A@A@@@@BAB@B@@BB@@A@BB@B@DBB@BBB@@@B@@@@@BA@ADAB@@@D@@@@CBC@@BA@@DBD@B@B@@@@@BBDB@NCB@@A@@B@@@@ABA@@@@DB@BBB@@BBDBB@BBB@HFBDBB@@@@@@BA@@@@@BB@A@@BA@@AA@@B@@@B@@@@A@@@@BA@A@AB@B@B@@@BC@A@ABC@@@@@@B@@@@A@A@A@@@@B@@AB@@@@A@@@A@ADAB@@A@AB@@AB@@@B@B@@A@@@C@@@@B@@@B@@BB@B@BB@DDB@@@BBB@@BBB@@BBBB@B@B@B@B@@@B@BB@B@@@@BBB@F@@B@@@@@@@@BE@CBA@@BCDA@AB@@AF@DA@BBBB@@@@BB@@BB@@B@BBB@@@BB@@DB@BB@@@@BBBA@AB@B@@BBBB@F@B@@FB@@@@@D@@@B@@B@@BB@@@@ABADAFCBA@C@AAE@@@AB@@@@@BBBA@@BBD@@@D@B@DBBAB@B@@BBB@@B@@@B@@@@@B@BB@AB@B@@@@@@@BB@AB@B@@@@B@@@@@@B@B@@@BBD@B@@@B@B@B@@@B@B@@@DB@BB@B@BABABA@@@@@@@A@@AB@@AA@AAA@@AA@@B@@A@@@@@@B@@@@A@BB@@@B@B@@AB@@@AA@@@AB@@A@@@@BABBDBBBB@B@B@F@@@@A@@@A@A@A@A@@@@@A@@@@@@F@BAD@@@@B@@B@@B@@DD@@BB@@@D@BBB@@BB@@EAA@@@A@@BA@@@@F@D@H@B@D@B@@ABCD@@@@F@@@B@@@@@BB@@@@@@BAFEFABA@@BA@@F@D@D@@@@@@A@AB@@@B@@@B@@@B@@@@@B@@@D@B@BB@@B@B@@@@@B@B@@@B@B@B@@@BB@@@@DB@@@@@@D@@@@@BA@@BABBB@H@@@B@@@B@@@AC@@@E@A@C@@A@@A@@BA@@B@BA@@DCBADADAB@@@BB@@B@AA@@F@HABA@@B@@@@A@@@A@@@@@@@A@@A@@@@A@A@@@@B@B@@@@A@A@A@A@@@@FCB@DA@@B@@@@@@A@A@@B@HBF@B@@B@@BB@@BB@@B@BABA@@B@@@B@@B@@@A@@B@B@D@B@@@@BBB@@@B@B@@BBA@BDB@B@@@@B@BB@@A@AB@@@@@B@@@@@@@@@B@@@@@B@@B@@DA@@B@@A@@DBFCD@BAB@BAB@B@B@B@B@B@DAB@DA@AB@@ABA@@BAB@B@@@D@D@DB@@BB@@@B@BA@B@@B@@BB@B@@BB@@@@@@BA@@BA@@@@BB@@@BBF@D@B@@A@EB@BDB@@BD@@@BBB@ADA@AB@@A@@@@B@BA@@@@@C@@@A@@@@D@BBB@FA@@BB@DBBB@B@B@B@B@B@FAB@B@@@BA@C@@@@B@B@@@@@B@@@B@@@B@@@@A@@@@@A@@BCB@@AD@B@@@@@BB@@@@@@AB@@@@@B@BB@@@@@B@@A@AB@@@B@DADAB@@@D@@@DBB@@@@A@@@@@A@@AA@@@A@AA@@A@@BA@ADA@AB@B@D@@A@@@@B@@@BAB@B@@@B@BDB@@@B@@ABA@@@AHA@@@BB@BBB@B@B@D@@@@AB@@@@@@@B@BA@A@AAA@A@@AA@AAA@A@@@@B@BABABA@@@@AAAA@@@A@@D@@@AAA@AA@A@@@@@@@@@@@A@@BAB@@A@@@@BABAB@DABA@@B@@B@@@@B@B@D@@@B@BBB@B@@@@BB@@@@@BAB@DCBA@@B@@@@@BB@@BBBB@@B@BB@@@BBB@@@@@@@@B@@@@B@@@@BBB@@B@BBBAB@B@@@B@B@@@B@BA@@@@DB@@B@@CF@B@BBBB@B@@@B@@A@@B@@B@@@D@@@@D@@@BA@@DD@@BB@B@@B@B@BB@B@@B@FBB@BB@@@DBB@B@@@@BB@B@@DDBB@@@B@@@B@@BBBB@@B@B@B@FAB@BAD@@A@@@A@@@AAA@@@A@AB@@@FA@@DA@@@A@@AC@A@@B@@@@A@@@A@A@@A@@@@A@@BAAAB@@@@@@C@@CA@@@A@@B@BAD@@@B@B@@@BA@ADB@@BC@AD@DBB@BBF@BB@@@@BBB@B@@A@@@@F@B@@@B@@@@@@@CAA@@@@@B@@@@A@@@@B@@@D@@@@A@AC@@A@@@@AA@@@I@A@AB@@A@@@@A@@@AA@@@A@@FA@@AA@A@@BABA@AB@@@@@@@@@DB@@@@B@@@@ABA@@A@@@@@@@BCFB@@ABABADAB@B@@@B@@B@@@@@B@@B@@@@B@@@BBB@D@@@@@@@B@@@B@@B@@B@@@@@B@@@B@@@@@@@@@@@@@B@@@@@@BB@@@@@@@@@DBBBB@@B@@B@@@@B@@B@@B@@@@@@@@ABA@ABEBC@@BA@@@ABA@@BAB@B@@@B@@@B@B@B@@@B@@B@@@@@BB@@B@@@@@B@@@@@B@DB@@BB@@B@@@@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@@@@@@BB@@@B@@@@BB@@@@@@@@@@B@@B@@@@@@@B@@B@@B@@BB@@@@@@@@@@@@@BB@@@@@BA@CBC@A@@@@@AA@@@E@@@@@A@@A@A@@BA@@@A@@DAB@BAD@@@BA@@@AA@CA@AC@C@A@@@@AAA@A@@C@@@@@@AB@B@B@@A@@@A@AAA@A@CACAC@@@AB@BADA@A@@BA@@@AB@D@BADA@@B@B@B@@@BBBDFDBBBBFB@@B@@ADA@AD@BA@@B@BC@@DA@@@@@AA@@AA@ACA@@@@AB@@AB@@@B@@@BAB@@AD@@@DE@@B@B@@@B@@@BA@@@@@A@C@AA@ACA@@A@@@A@@BA@A@A@@BA@@@@B@@@@@@@B@@@@@@@B@@@B@@@@@@A@@AA@@BAD@@@@@B@@@BA@A@@B@@AB@@ABB@@B@@B@@@@@@BBBBBDB@BB@@@A@@@A@@BAD@B@B@FB@@B@@@BADAB@@AB@@@BB@B@@@B@B@BBB@@B@@@FCDA@@@BB@@@@@@D@B@B@@@BB@@@B@BAB@@A@@BAAEB@@AB@B@D@D@B@D@D@FCBAB@BA@@B@BAB@F@B@@@@@BABCBC@C@A@A@AA@AAAACCAAA@AA@C@A@@@A@@B@FA@@B@@@BA@AA@AA@@AA@CA@AA@@@@BCB@@AB@@A@A@AA@@CA@@@AA@A@@A@@@@@AA@@A@@@@A@@@AB@@C@@BA@ABC@@@ABABABAB@D@B@@AB@BA@@@A@A@@@@BAB@@@@@B@@A@@@@@@DA@A@@BA@ABABA@@B@@@BAA@@@BA@@@AD@BAB@@@@A@E@@BCB@@@DABBB@B@DB@@BBD@@@B@@@B@DABABEB@BA@AB@@C@@@ABA@@BA@ABC@A@A@AA@ACCECC@AA@@@A@ACA@@ABAB@BA@@@ABA@A@A@ABABABAB@BAB@@A@@BAAABAA@E@CAGEKCICMACAACCACCCC@C@@DAD@DBFB@B@DBDDBF@B@B@HBDAFC@ADABEBE@EAW@QC@C@EFABEBE@AAECCC@@AE@@CEA@ACCCBCBCFCDC@CBAAA@A@@@@@@AAECEBCDCBADAHCFCBABA@CAA@CEEAC@@@C@C@EBI@GBMHEACACCAACEAAACEA@AAA@C@CD@B@FBD@B@BABA@A@A@AAAACAABA@C@@@@@AB@@@@@@@@@@@@@@@@A@@@@@@BA@@BA@@@@@@BA@@@@B@@@@C@@@@@A@@@@@@BA@@@@@@@@@A@@@@@@@@@A@@@@B@@@@@@@@@B@@A@A@@@A@@@@B@@@@@BAB@DB@@DBDBDBD@DBDABABABABCBC@A@@@A@A@A@A@@@@@AB@@AD@@A@@@@AAA@@@@A@@@@@A@@C@CA@@@@@A@AAAA@AA@@@@@A@@BC@@@@ABA@@@A@@@@A@@BA@@@@@A@@@@A@ABA@@BA@@BABA@@BA@A@@@@@@A@G@A@@@@A@@@@@@B@B@B@@@BA@@@A@CAA@AA@@@@@B@B@@@BA@AA@@A@@@@@@@@AA@@@@@@@@@AA@A@@@@@@@A@@A@C@@@@B@@A@@@@@@@GA@@A@@@@B@@A@A@@@AD@B@@@BA@@@A@A@@@A@@@A@@@@B@@@@C@ABAA@BA@@@A@@@@A@@@@BA@@@@A@A@@A@@AA@@BA@@AACC@@@@A@@@@B@@@@A@@BAAA@A@@@@ABAB@B@@A@@A@A@@@AA@@CB@@@@AB@B@@A@A@ABA@A@@@@@ACAA@ACAAA@@@@A@AB@BA@@@"],encodeOffsets:[[110200,36031]]}},{type:"Feature",id:"620822",properties:{name:"灵台县",cp:[107.595874,35.070027],childNum:1},geometry:{type:"Polygon",coordinates:["@@GG@CAC@E@G@G@CCECAGAIAG@GBKFIBIAE@EDGBCBEBA@E@EBG@IAEAEAI@G@IBCBIBGDEBCDGDA@EDA@@BA@E@GBC@EBG@IBGBG@GAGAGCGEECCCCEEAEAIB@@EBCBCDCFAFAFEFGBEBA@IBG@GBG@E@E@G@KAI@I@IAIAGEAEAAACAG@E@G@GAC@AA@ECC@@@EDEB@@AAA@C@CAC@@ABCAAAAAE@@@AEI@AEE@GCG@A@@A@C@A@ED@@CBA@@B@B@B@BABA@C@C@C@C@AD@@@BA@@@@@CIAAAE@AECACA@A@G@CAA@@@A@@@ABABAB@D@@A@@@EAK@EFEHEFCDCJCJAJAJAHAJAFAHEFCHEDGDGDCFODIBIAG@CB@AAAA@@@A@AB@DAD@@AAAAGBC@CBIBIDCDEDEBABEDAFADCBCDEDCDAFCDBDBH@FDHBFDFBFBF@F@BAFADCDCFCFCFCBGDCBGBGAEBCBG@@@I@@BD@@@BD@@@@FD@@@B@@A@@B@@BFBB@B@B@BBBBFB@@B@@B@@@DD@B@B@@@DA@@B@BABAAGA@@@@A@BB@BBB@B@B@@AB@@@B@@@@A@@@@@AD@@BBBB@D@@A@@BA@ABCJ@@@BBD@@@B@@CDABA@AB@@@@@@A@A@A@A@@@AB@@A@@@@AA@A@CAAA@@@@A@A@C@@@@@A@@@A@@@@@@@@BABAB@@@@A@@@A@@A@@A@@@A@@@ABA@A@@BA@@@A@@@@@@@@@@@A@@@@@@@A@A@AB@@@B@B@@@B@BA@@BBD@B@D@@@@ABA@@B@@A@CAA@A@@@A@@@A@ABAB@@@@@BAB@@DDBB@@@B@@C@@@@@@@@@ABA@ABA@@B@B@B@@ABB@@B@@@@@B@B@B@@B@@@DA@@B@B@BB@@@@@@@BAB@@@@BDBBBDDFB@@BBDB@@B@@A@@B@BAB@@@B@@@D@BAB@B@@CJ@@@@B@@@@@@@@@@@@@@@B@@@B@B@B@DB@B@@@B@DBBBB@B@@B@B@B@@@BAB@@@B@@@@@@BBBB@B@@@B@@ADADA@@DBB@BBD@@@@BB@@@@B@@B@@@@B@BA@@BB@@@@@BA@@@BB@@@B@@@@@@A@@FBB@@@B@D@BB@@BB@@@BBA@@@@AA@@@C@@BA@@B@BAB@@B@@BB@@B@@A@@BB@@BB@@@BB@@BA@@@BBB@B@@@@B@B@@@@@BBAB@@B@@B@B@@BB@@@ABC@@BAB@@CD@B@@@@BBDD@@AB@@A@@@@@A@@BBB@@@B@B@@@@BA@A@@B@@@BB@@BB@BB@@B@@@B@@@BBB@BABA@@@A@@B@@@B@B@BBB@@BB@@@@AB@@@@@B@@@@B@@@@B@@@B@@BB@@@@@B@B@@BB@@@B@@A@@@@DAD@@AB@BA@AB@@@@B@@@B@@@@BAB@BA@BB@@BDBFB@B@@@BBH@@@BBA@@@ABA@ABAD@@ABABAB@@@B@B@@A@@BC@ABABA@@@A@@B@@@B@@@@@B@@B@@@@B@@A@@B@B@@AB@@@BB@AB@BB@@B@@AB@@BDA@BB@@@BBB@B@@BBBB@@HBDBB@B@BAB@BB@@B@@A@@B@B@B@@@@A@AB@BBB@@@@@B@@@B@BAB@B@@@@@BB@B@@A@@B@@@@F@DB@AB@@A@@BABA@AB@@@@@@C@ABA@@BA@A@ABABA@ADA@@B@@@@A@@@@@@@@@A@@@@BA@@@@@@B@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@B@@@@A@@@@@@@@@@@@A@@@@@C@A@A@@@@@@@@@@@@@@A@AAA@AA@@@@@@@@@@@@@A@@@@@BA@@@@@@B@@@@@BAD@B@@@B@D@@@@@@@B@@B@@@@B@@A@@@@@@@A@AB@@A@@@@@@@AAA@@@@@A@@@@B@@@B@@AB@@@BA@@BA@A@@BA@@@@@@@@B@@@@@@@@@@@@@BA@AB@B@@@B@@@@@BBBD@BB@BBBBB@@BBB@@B@FAD@@@BB@@@BB@@@@B@B@@@BB@B@BDB@B@@@DA@@B@@@B@DBDBBBAF@@B@B@B@BA@@@@@@@BA@@@B@@@DBD@@@BAD@B@@AB@B@BAB@BAB@@BB@B@B@B@@@B@B@B@@B@@B@@@DB@@B@B@@@B@BBB@B@@@@@B@B@@@@@@@@@@@@@B@@@@@@@@@@@BA@@@@BA@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@B@@A@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@AB@@@@AB@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@B@@@DD@@BB@BBDBD@@BAB@@@B@BBDBB@@@@B@BBBBBB@@@D@@A@BB@@B@@BBBB@@B@@@@A@@DA@@@@BAB@B@@@B@BA@@B@BAD@@A@@@@BADA@@@@BA@@B@@@@AB@BAB@B@BA@@B@B@@@@A@@@@DAB@BAB@BABA@@@@DBDBD@D@@A@@@AB@@@BB@B@@BAACBABC@CB@@AA@@AB@@@B@@@@A@@@C@@B@@@BBB@@@@B@@@@@B@@@@B@@@B@BB@@BB@BD@@B@@@@@@CBCBC@ABA@@B@@@B@BBB@@@@@B@@@B@@BD@@B@B@@@@DB@@@D@@@@DB@@B@B@@@D@BBBBB@@B@DB@@@@ADAB@@@B@@B@DB@@B@BEBA@@BABAB@DA@@BCB@BABC@A@@@AA@A@@A@@@@BA@@A@A@@A@@@ABA@@B@@A@C@@@ABBF@@@@AB@@@@@B@@@BG@A@A@ABA@AB@@@@@@@ADAB@@B@@@@@BA@@@@B@@AB@@@@@B@@@@BAB@@@B@@@@B@BADC@@B@@B@@@B@B@@B@@@BC@@@@@@BD@BB@@BB@BAB@DCB@B@BB@@B@B@@@@@@DBB@@B@@@D@B@BBD@B@@@B@@@@A@@@@AAAA@A@@@@@@D@@@@@B@@@AC@A@@B@@@BBB@@@BA@@@A@@BA@@@@B@DBB@@@@ABA@A@A@A@A@@@ABA@@B@@@BBBAB@@@@@D@BB@@@D@@@@B@DAB@@@@@@@@@AB@B@D@B@BB@@BBBB@BB@@B@B@B@D@BAD@B@@@@B@@@B@@B@B@@@BAB@@AB@@ABA@@B@DB@@@B@@@@BB@@@B@@@BB@B@B@B@@ABAB@@BB@B@B@BA@@@A@A@@B@@@B@@@BB@BB@BA@@B@@@@@@B@B@@B@@BB@B@@@@@@ABA@@@@@BBBBD@B@BDBBB@@BB@BBBFBBB@@B@D@@@@@@A@@@@B@@@B@B@@@@@@A@AD@@@B@@CB@@@@@B@@@@B@BAB@@@@@@@B@@BB@@@B@@B@B@@@BA@A@@B@@@B@@BBB@@BB@@@@@@@A@A@@@@F@B@@@@AB@@BB@@@BA@AB@@@@BB@@@BB@@@B@@@BAB@@@@@@B@D@@@@B@@B@@@BB@@@@B@@@B@BBD@@@B@@@@@@B@F@D@B@@@BBB@@B@@@@A@A@A@@@@BB@@FO@@B@@A@BB@@@@B@@@B@B@BA@@B@@B@BBB@DBB@B@@BB@BAB@@A@@B@@@@BAB@@B@@@@@@A@@B@@@@@@@@B@@@@B@B@BBB@@@B@@AD@@ABA@AACAA@C@@@A@@@@BB@@B@B@@@@ABAA@@ACACA@@AAB@@@@A@@@@F@DBB@B@@@BA@@@@@ABBB@B@BB@@@BB@B@@@B@@@@A@@AAB@@AB@@@@ABA@A@@BA@@BB@@@F@@@@@@B@@@B@BA@@B@B@@@@A@@AA@@@A@@@@B@DBB@BB@@BA@@@CBA@@AC@@BA@@@@BB@@DBB@DA@@B@@@B@@@B@@@@B@BAB@B@B@B@@@@@@BB@@@@BA@@B@B@B@@@BA@@@A@@B@BBB@@A@@@A@A@@@@B@@@BBDD@@B@BA@@B@@@@@BB@@B@B@F@H@B@H@BB@@B@A@@BB@@@B@BA@@B@BA@@@@@@A@A@@@@@@A@@@A@@@@AA@@@@AA@A@@BABBB@DB@@B@@@@@@E@@@A@@BA@@B@BAB@@@@C@@@A@AA@@@@A@@@ABAD@BAB@BAA@@AAC@AAA@@B@B@B@BBB@@@B@@@@@B@@BDD@@D@@AB@@@@C@@@@FA@BBA@AB@B@BA@@B@@@B@@BBA@@B@@@B@@@B@BA@A@@@CAA@ABCA@@E@A@A@ABA@@@@BDBB@BB@BB@@@B@B@@@B@BBB@BB@AD@BB@@@@@B@@@B@@@@C@@@@@@@@BBBB@@B@@@@@@A@@@C@AAA@@@@F@BAB@@@AA@@@A@@@ABA@@@@@A@@@A@@@A@@B@B@DAB@BB@@@D@B@@@BBBB@@@BF@@@B@@@B@@CD@@@B@@@B@@B@D@B@B@@BB@@A@A@@@@BAB@@@B@B@@@BA@ABA@@B@DBB@@@BB@@@@B@H@@@@B@B@@@BB@@@@@B@@C@A@AB@B@@@@B@@B@@ABAB@@@@@BBDB@BBBBD@@@@B@B@BAB@B@@@@ABA@@@@DA@@BB@@B@B@@@@BA@A@AB@B@@B@B@BB@AB@@@@@@A@@B@@@@@DDBB@@AB@@BB@@@BB@B@@@@@AB@@@@@B@@B@@@B@@ABBBAD@@@@@@A@@B@@@B@@@B@B@@@B@@A@@@ABC@@B@B@@@@A@@B@@@HB@@@@@@B@@@@A@@D@B@@@@B@@@@@@@BBB@@@@@@@@B@@B@@@@@@B@@@BBB@@A@@@A@A@@@@BBB@DBB@@@B@@A@@@A@A@A@@@@B@@@@@@B@H@B@@@@B@B@@AB@BA@AB@@AB@BAB@@@@@@B@@@@AB@@@B@@B@@@B@BA@@@@AD@@@B@@@@BBB@BB@B@B@@@@DBD@@@@B@@@@@B@@B@BB@@@@@BC@@BA@@B@@@@@B@B@B@B@@DCDE@AB@DKDADCDEBCBCDGDEBEDGFIBA@ADADA@@@A@@@@@@@A@@@@A@@@@@@A@@@@@@@@A@@@A@@@@A@@AA@@A@@A@@@A@@BEBC@@DCDABCBC@CBABABA@IBG@E@@BADEFKFKFIFIFIFG@AD@D@B@FABABEDEHG@A@A@AAAAAAAAA@AA@@AB@@A@A@@BA@@@A@AB@@ADA@@@@@@@A@@B@@@@@@@B@@AB@@@B@@@B@@@@@DC@A@@B@@@@@@@@@@@B@@@@@@AB@@@@@@@@A@@@@@A@@B@@@@@@@B@@A@@BA@@@@@A@@@A@@B@@A@@@@@@BABB@AB@B@@@B@@@@@@@BA@@B@BAB@@A@@@A@@B@@A@@B@@AA@B@@@@@@@@A@@@@B@@AB@BA@@BA@@@AB@@AB@@ABAB@@@@A@@B@@@@A@AB@BC@AB@BA@@@@BADAFCFADCDCDAF@H@FCB@FAJDJDBAD@D@B@H@BA@A@AAACCECCA@CBCBCBABEAC@CFADCF@DBD@FBLC@C@@@A@A@@AA@@GOCAGCGCEAEHA@A@A@@@@EDC@AAAA@A@A@ADEBIC@@C@ECCCAAC@@@ABA@CAC@C@A@A@C@AAAAAAA@ACAAAAA@@@BCBCFMA@@@@@CDKDKDMBIBA@KAKCEAICQAGEGA@@C@GBA@G@AAEAEAKAC@@B@H@D@@AB@B@@@B@@AB@BABA@@@AA@A@@A@@A@A@AAA@@@@@AAAA@@@ACACAAA@E@CBA@AAA@A@A@CBA@E@@@ACA@ABCBCA@@CCAAA@A@@@@BAB@B@@ABAAAAAAA@@@A@A@@AAA@C@AA@CB@@@AAAAAAB@ACAACAA@C@@A@AAAA@AC@A@A@C@AA@@A@CDABA@E@A@AC@@@A@A@@@C@@@ABC@C@A@A@A@ABCA@CDA@E@C@EAE@A@A@@@DBBBBFBDBFBD@HBB@BBB@F@B@B@B@BBD@FBBAF@BCDCFGFCDE@A@E@ICCCGCEBGBGBEDGBCBEDGDGBGB@@E@GEECAAACCGAGAIACAEACCGGCKC"],encodeOffsets:[[110123,35775]]}},{type:"Feature",id:"620823",properties:{name:"崇信县",cp:[107.025763,35.305596],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AB@B@BA@@B@@@B@@@@BB@@@B@@@@@BABA@A@@@@@@B@@@B@@@@@D@B@B@@@@@BBDBB@B@@B@@B@@@BA@@B@B@B@B@@@@@@@BAB@BADC@@@A@@AC@A@@DIBAB@@AB@@@@CAAAA@@BC@@@@B@@@@@@A@@BA@@@A@AAA@AAAB@@@@@HBBBBA@A@AB@@C@@@A@ACC@@A@@@@AA@AEAA@A@A@AAAAE@@@AB@@@@A@@EC@@@@AC@@C@@AG@GBE@BFBJ@DDDABA@ECC@EFA@A@CEAEAAACEECAC@CBABCBCBC@CCEAE@C@CB@BDFBD@BAFA@@BAFADABABCDAFEFCDABC@EAC@E@GD@BDDHFDFCBA@E@E@MEMCGAE@E@GBCBEBCF@BAFAHAB@DA@C@ABG@EDCA@CAC@E@AIBC@GDIBEBCB@B@D@DAFABGB@@ABCD@@@B@@A@EAIEEACACBADADA@@@@BA@AB@@AB@@@@AB@@@@@@@@@BB@@@@@@@@B@@A@@@@B@@@@@@BB@@B@@@BB@@@@AB@@A@@@@@A@A@@@A@@AA@@@@@@B@@@@@@@B@@@@BB@B@@@B@B@@@B@BAB@@@B@@@B@@BB@@@B@@@@@BB@@@B@@@@@@@B@@B@@B@@BB@@B@@@@@@@B@@@@@B@@@@ABA@@@@B@@@@@@@B@@@@@@@B@@@@@@B@@@B@B@@@@B@@@@@@@@AB@@@@A@@@AB@@@@A@@@A@A@@@A@A@@@@@A@@BA@@B@@AB@@@BAB@@@@A@@BA@A@A@AB@AA@A@@@A@@@CAA@A@ABA@A@A@A@CA@@ABA@ACC@@AA@AA@@@D@BAB@@@B@@@B@B@BAB@@@BAB@@AB@B@@@BA@@DAB@@@BE@G@A@CAA@@@@@@B@B@@AD@B@@@B@@@DAB@B@@@@@BCAA@A@@@AB@BA@@B@@@@A@@B@@@@@B@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@A@@@BB@@@@@@@@A@@@@@AB@B@@@@@@@@@B@@@@@@@@@B@@A@AB@@@BA@@@BD@@@BAB@B@@@B@@@B@@B@@B@@@BDB@@@B@@@B@@BBBB@@@B@B@@BBBD@@@BB@BB@@DBBABBBA@@B@@@DAH@@@@@FDB@BB@@DAB@@@@@@BDB@@@B@DBB@@@B@BA@@B@@C@@B@@ABBB@@AB@@@@C@A@ABA@CAA@A@@BA@@@@B@@A@@BA@AB@@@@@BB@A@@@A@@BA@@B@@@B@B@@BBBD@@BB@@@@B@@@@BBBB@DBB@@@B@@@@D@B@@AB@@@B@@@@@@BB@@@@@BBB@BB@DJBBB@@D@@@BABAD@B@BAB@DA@@BAB@BAB@@@B@BA@@B@BBB@B@B@@AB@B@B@@@F@@A@AAA@A@@@@@@BAD@@A@ABC@A@AA@@@@@@@A@A@@AB@BA@@B@BAB@@@@A@BB@@DBDB@@BBBB@@B@@@D@B@B@DAB@@@BB@B@@@@DD@@BB@B@B@@BB@B@B@@@B@B@@B@BB@@@@@BABA@@BABA@@@ABABABCB@@A@A@C@A@@@AA@@A@A@@@ACA@@CACA@@AA@A@@@@@@F@B@@ADAB@@CD@@@@CH@@AB@@A@@@@B@@BB@B@B@@@@ABCBA@CB@@@@@BBBBFDF@BB@BBD@@@D@@@B@BAB@BBDBBBBBB@B@@@@@@@BDDBBBDJB@@@@@B@F@B@@@@@ADAFA@BB@@BABAB@F@B@@@DB@@@@BA@@BC@A@@B@BABAB@@@FABAH@@AB@AAAEAC@A@ABA@@BABABC@@@@@@@B@BBB@@B@DBFAB@@@BBBBBBB@@@@@BA@@@A@@@@B@@@@BBB@B@@DBD@H@BB@@@B@@BBA@@B@@A@C@@@@@@B@@@DAB@BAB@@@@A@@@A@@@A@A@A@A@A@E@@@@@CD@@@@@@@@A@@A@@@@A@@@@BA@@@AB@BAB@@@@@B@@@@@@@@A@@BC@CB@@@@@@@@AB@@@@A@@@@@A@@@@@@@A@@@@B@@@B@@CDABA@@A@@A@@@@B@@@B@@@BA@CB@B@@@@@@BB@B@D@@BDBF@@@@C@A@@@@@BB@@AB@@@B@AAA@@AA@@AB@BAF@D@B@@@@@@B@@@@@B@@@@BA@@B@@@@BB@B@@A@ABA@ABA@@@@BB@B@DBB@@BBDBB@B@@B@@@B@@AA@@A@@@@B@@@@@B@@A@@@@@@@B@@D@@@@@@@@A@@@@@A@@@@@@@@@@@@D@@@@@@@@@@@@A@@BA@@@@@@@@@@@A@@@@BB@@@@@@@@@@@@@@B@@@@@B@@@@@@@@A@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@@B@@B@@@@@@B@@@@A@@@@@A@@B@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@B@@@@@B@@@@@@@@ABA@A@@@@@@@A@@B@@@@@@@BB@@BB@@@@B@@@@A@@@@@@A@@@@A@@@A@@@A@@@@@@@@B@@@BB@@BBBBBD@@@@@BB@@@@B@@@@@@@@BB@@@@@@B@@@@@@@@@@A@@@@@@B@@@@@@@@@B@@@@B@@B@@@@@@@@@@@@@B@@@A@@@@@@@@B@@@@@@B@@@@BBA@@@AB@@A@@@@B@@@@@B@@@ABABB@@@@@@@@@BA@@@@@@@B@BBB@B@@@@@@B@@@@@@@B@@@@@@@@@B@@A@@@@@@@@@@D@D@@@B@@@@@BA@@@@@@@B@@@@@@@BB@@@@@B@@B@@B@@@@@@@@AB@@@@@BE@@@@@@@A@@A@@A@@A@@@@A@@@@@@AA@A@@@@BA@@@@@AB@@@@@B@@@B@@@@@B@@@@@@B@@@@@B@@@@@@BB@@@@@@@@@BB@BB@@B@@@@@@@BB@@@@@@@@@@@@@@@@@A@@@@@@@@BA@@@@@@B@@@B@B@BAB@@@B@@AB@@@BBB@BBB@A@@@ABA@@B@@@@@@BAB@CR@@A@@B@@DD@B@@ABABA@@BB@@@@@@@BD@@@BAB@@B@@B@@@@ABA@@@@BB@@@B@@@@@@@@@@@B@@@@B@@A@@B@@@@D@@@@@@@@BA@@@@@@BB@D@@@B@BB@B@B@@@BB@BABA@@@@BB@BA@@B@B@@@@@@BB@@BA@@B@@@BB@B@@@@@BBABC@@BAB@B@@@@B@@BB@@@@BA@@B@DE@CB@BA@@@@B@@@B@B@@@@B@BB@@@@@B@B@BABABABA@AA@CC@@@@BA@ABC@@@@@B@BB@BBBBB@@@@AAA@AB@@@AA@@BAFC@@B@@@B@@BB@@B@@@@@B@@@B@BBAB@B@@@BB@@@B@@@@AB@@@@B@@@AB@B@@BA@BBAB@@@@AB@@@@@B@@A@@@@BB@BBB@@@@@@@@@BBB@@BCB@B@BA@@@EB@@@B@@@B@@B@B@BAD@@B@@@BAD@AAAAAE@@@@@A@@B@AE@@F@B@B@BBDDB@@@@@@A@@@ADAB@@@DC@A@A@@BAB@@@@@@BBB@@@BB@BB@B@BB@@@FAHAB@BBB@@AB@@@@@@@B@@@@@B@@@@@@@@@@@B@B@@@@@B@@@@@B@@@@@B@@@@@@@@@@@@@@@B@@@@@@@BB@@@@@@B@@@@@BA@@BBB@@@B@@@@@BA@@@@@@@B@@@BBB@@@@FCFADABAB@@@@A@@B@@@BB@@@@@@@@@ABA@@@@@@@@B@@@@@@@B@@@DAB@@@@@@@@A@@@@@@@A@@B@@@@A@@@A@@B@@@@@@@B@@@@@@@@@@@B@@@B@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@B@B@B@@A@@B@BABA@@@A@@@@@@B@B@@@@@@@@@B@@@@@BA@@@@@B@@B@@B@@@@@@B@@@@@@@BB@@BB@B@@@@AB@@@B@@B@@@@A@@B@@@@ABB@B@@@@B@BAB@BBD@LF@ABA@A@@AA@A@@@A@@A@BABADA@@@@B@B@@BB@@@D@@@BB@BB@@@HD@@@@@A@@@ABA@@D@D@B@B@@@BBBB@ABI@A@A@A@C@A@AAA@A@@@A@A@@D@DBDAB@B@@@H@D@@@C@A@@A@@B@@C@@@@B@@AB@@@@B@@@@@AB@@@@@@@B@B@@@@@@@B@@DA@@@D@@@B@@@@D@@@@@B@B@@A@A@@@@BAB@@BBDBB@B@@@BB@AB@@BAB@B@BB@@@B@BAB@@@@A@@@A@@B@B@@@@@BA@@@@DBB@B@B@B@@@@@A@@@@A@@B@@@BA@@@@@@@@@@B@@@@@B@@@@@@A@@@@@A@@@@B@@@@@@@@@B@@B@@@@B@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@B@BA@@@@@@@@@A@@@@@BB@@B@@@@@@B@@@@@@@B@@@@@@B@@B@@@@@@@AB@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@@@@BB@@@@@@@@B@@@@@@AB@@@@@@@B@@@@@@AB@@@@@@@@@B@@AB@@@@@@@BA@@B@@@@@@@@@@@BB@@BB@@BBB@BBBBB@HC@@B@B@@@@@B@@@@@@@B@@@@B@@B@@@B@@@ECAA@@@@BCBAB@@ABBBB@@B@@AB@@@@A@A@A@A@@@@B@B@@A@@@@B@@@BBBB@BB@@B@@@@B@@@@@@@BB@AB@@A@@@@@@@AAC@A@@BADA@@@@@@@@@@@ACAAA@@@@@A@@@@@@@@@A@@@@@A@@@AA@@@@@@@@@AA@@A@@@@AA@@A@@@@@@@@B@@@@@DABA@A@@@A@@B@@CB@@BD@@F@B@@@@@@@@@@D@@@B@D@B@@A@CB@B@BA@@@C@E@C@@@@@@@@@@@@@@A@@@A@@@A@@@@@@@@@A@@@@A@A@C@A@ABC@A@@@AA@A@@@@A@@A@@@ABA@A@@B@@AA@A@A@BAA@@@B@AC@@@AB@@CAAA@A@@@@A@A@@@AC@@A@@@@@A@AB@@A@A@@@A@A@@@C@A@A@@@@A@@@@@@@@ACA@AA@@@AAA@@B@@A@@A@@A@@B@@A@@AA@@@AAG@A@@@@A@@@EDA@@@@@@@AA@@@A@@@A@@A@@A@@@AA@@@BAAABA@A@A@@AA@@@ABAA@@A@A@@DAB@DADAB@D@BB@A@@@@@C@@@@@A@@@A@C@A@A@ABAA@@A@ABADC@@@@D@D@BA@@B@@@@@AA@@BA@@B@BAD@@@F@@@@@DC@@DBB@BB@A@@BA@@@A@@@A@AAA@A@A@@AC@A@AAE@ACC@AAA@A@@BCB@DADCB@HCD@B@@AB@@ABA@B@B@B@B@@FDB@D@@@B@@@@C@A@@@@@@DB@@@@@@@CBCBA@A@C@GB@BAAAAA@@@AA@@A@BC@@@A@AAAA@A@A@@A@CAAAA@@@A@AB@@ACAC@AAA@@CC@@A@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@A@@B@@A@@B@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@BA@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@AB@@@@AB@@@@@@@@@@A@@@@@@@@@@@@@A@A@@@@@A@A@AAA@@@A@A@@@CA@@A@@@@AA@A@A@@@A@A@A@A@@AA@ABA@ABA@A@@BA@C@AB@@C@CA@@A@@@B@@A@@@@@@ABA@A@A@@@BEAACACAA@@@A@@@CB@@A@A@ACA@A@@A@@@A@A@@A@@A@@AA@@C@EBA@@@AA@AA@AAAAA@@AACAA@@@@A@@@A@A@@BAB@@@@@@@@@@@@A@@@@@@@@@AB@@@BAB@@AB@@A@@BA@@@A@@@@@@B@@@@BB@B@@@@@@@BA@@B@B@@@@@@@BA@@@@@@AA@@@@@@@C@A@@@A@C@AB@@@@A@@@@@@@AB@@@@B@@@@@@@@@@@@@B@@BBB@B@B@@@@@@@@@@@@B@B@D@@@@@B@@@@@@@@@@@@@@B@@A@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@A@@@@@@@AB@@@@@B@@@@@@@@@B@@A@@@CB@BABAB@B@BAB@@AB@B@D@@@@A@@BABAB@@@BA@@BCAE@@@@@@AB@@@@AAA@@@@A@A@ABA@@@A@@@@@A@AAA@@B@B@@A@A@A@@@@BA@@@AAA@ABA@A@CAGA@@AAAA@@@AAA@A@@AAB@AC@@BA@@@AA@@ABAA@@A@@BA@@@A@AB@@@@A@@A@@@@A@@@@@A@@@AB@@@B@BABAD@@AB@@@@A@A@@BABABA@@BCBAB@BA@@B@AA@@G@AA@@A@A@AEAC@@AAB@@ABA@A@@A@@@A@@@@@BAB@@ABA@@BC@C@@B@@@@A@@AA@@@A@A@@@@AA@@@A@@@A@@A@@@@@@A@@@@BA@@@@AA@@AA@A@A@A@@@AB@@@B@BA@AAA@A@@@A@@@AA@@AAA@@AA@@A@@@@BAB@@@@@A@A@@AA@AB@@@@@B@@@BA@@CCAA@@@@@ADC@@BA@AD@BA@@A@@AA@A@@@@AA@AB@A@@@@@A@A@@A@A@AA@@B@@AA@@A@@AA@@AA@@@BA@@@AA@@@AA@ABA@@@AB@@@D@@BB@@@@AB@A@@AA@@AAC@A@@@A@EA@@@B@@@@A@@@A@@A@@AB@@@@A@@AB@@A@A@@A@@@@A@@A@@A@@C@AAA@CA@@CBCB@BA@@@A@A@AA@A@@@@A@@@A@AB@@A@A@A@@@@AAAAA@C@A@@@ACAA@A@A@@@A@@@@@@@@@@@@@@@A@@@@@DI@@@ABA@A@C@@@A@@BA@A@AB@@@@AA@AC@AA@CEACAAAC@@@@BA@A@@@@@@AAA@A@@@CB@@A@@@@A@A@A@@@@@AA@BA@@@A@A@AB@BAB@BA@@@@@@@@D@@@@A@@AACC@@BA@A@@@@BABAB@@@B@@@B@B@DBB@@@@AB@BA@@@@@C@AAC@AB@@A@A@@@A@A@@BAB@B@@@@@@@B@@@@@@@@@@@B@@@B@"],encodeOffsets:[[109600,35924]]}},{type:"Feature",id:"620824",properties:{name:"华亭县",cp:[106.653158,35.218292],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@DDDBDDBDDDFBBDALEHEJCLBFFDJ@HCHE@@B@BAB@DBDDDBD@BB@@B@D@FBF@FDBDD@HCBCD@DBDDBDBF@BDDDBDHBDDBB@FD@@BBDBF@@ABAFBBDFDDBFDBBB@BB@DB@B@D@D@DBB@HBDBDDBDDBFDDDBDDFBBDBDDBBA@@DAFAFBB@BDD@D@D@DBDBBBDDAF@DDBBDDBBD@D@BB@@@@@BCBED@@CBADABBDBBAFADEBADABBF@DBD@DB@@BDBB@BAB@DA@@B@B@D@D@BB@@HA@@@B@@AD@BFB@@BC@ABBB@B@@BBABA@@DAB@@@BDD@DBDB@@@B@@@@D@@@DAB@@AB@B@B@B@B@B@B@B@BB@@BAB@BA@@BCB@B@@B@BBFBB@@B@@@B@@@B@B@DAB@@@BA@ABA@A@@AAA@AA@@@C@@@A@A@@@A@@BA@@B@FBBB@@@D@@AD@D@@@B@@@AB@BABAB@AA@ABA@@@AB@@@@@DB@BB@FAFABAFC@@B@DAB@@@@@BBBBBBB@B@@@@AB@@AB@@@@A@A@A@@B@@@@AAA@@@AB@@ABAAAB@@A@@DA@@@AAA@@@C@A@A@@@A@@@AB@BA@@@A@@AA@@B@@AB@B@@@BBDB@@D@FB@@@@AB@@B@@@FBBAB@B@@@@@@A@@@C@C@A@@@ABB@@BBBBB@@B@@BB@@@@B@BB@@BB@@BB@BBB@@BAD@BAB@@@@BDBBB@@BBFDBB@@@A@@B@BABA@@B@@@BAB@@@BA@A@C@A@@@@@A@ABA@A@@@@@@@A@A@@@A@@@A@@BA@A@@@@@A@@@@BA@@@@B@BA@@@@@A@@@@@@@A@AAA@@BA@@@ABA@@@@@AB@@A@@BA@@@@@A@@BA@@@A@@@ABA@@@A@@B@@A@@@@@A@@@@B@@@@A@@BAB@B@BAD@@@B@@@B@@@@@@@D@B@B@BAB@B@@@B@@@@@B@BC@A@@BB@@@@@@B@@A@@@A@@BADA@@B@BBF@@@BBBBBB@@B@@@D@DB@@B@B@FAB@@@@@B@F@BA@@BA@@@C@@@@B@BAD@@A@@BABC@@B@B@@A@A@A@@@@@AA@AA@@@A@AB@DA@ABADA@AB@@A@A@ABAB@@@@A@@BABCBA@@DBB@B@DC@@@ABA@A@@AA@@@A@@@A@A@@@@DABB@@B@@ABA@@@A@AB@BA@@B@@@BBDBD@@@B@@@@B@B@B@@B@DBDBB@B@BB@@D@D@BA@@D@D@BAB@BC@@@@@@@BB@@@@@@@BA@@@@BBBBB@D@D@B@BB@@@ABA@@@@B@BBBBBDBBBB@BBB@BBAB@DCBA@@B@@@BDA@B@@B@AB@@AB@B@B@@@BA@@@ABA@A@A@@AA@@AA@@@@BA@@@BB@@@@@@@B@@@B@@@B@AA@@@A@@@@@@@C@@A@@A@@@AB@@@JAF@D@BAD@BAB@H@@@@A@@B@@A@@B@B@@B@@@@B@B@B@@B@@@B@@BBB@B@B@DAB@BABAB@B@@@B@D@BA@@B@BABADADA@@CACA@@AAB@@@@@BA@A@AB@@ABA@@@B@B@@@@@@BBB@D@BAB@@@BC@A@@@@B@B@BBB@@@@E@@@A@ABA@@@A@AAA@A@AB@@A@A@@BA@ABA@AB@@CBA@A@ABCBA@A@@@CA@AACIA@@AAA@A@@@@AA@@@@@@@A@@BA@@@A@C@@A@@@A@CAA@AA@A@@A@@@@@AA@@ACAA@@@A@A@@@AB@@AB@@@B@A@@A@@@@BAB@@AB@@@@A@@B@@AB@B@DBB@BAB@D@@@@@BA@@AABA@@@AD@@@@AB@@A@A@@AA@C@A@@CA@A@@@@A@CB@@AAA@EC@@@@G@CB@@A@@@ABAAABCA@@AAA@@A@@ACAA@@@A@A@@AAAA@@@A@@@A@@CA@A@@@AA@@@@A@@@A@@@ABA@A@@AC@@B@@A@@BAB@@@@A@@@@@@@@@A@@@@@@@@@ABA@@@@B@@@@@@@@@AA@@B@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@A@@@@@AB@@@@@@AB@@ABA@@B@B@DB@A@@@@@ABA@C@@@A@@@ABC@@@A@A@@@@B@DBB@H@F@@A@@BA@CB@@A@@@ABA@@BA@A@@BA@A@A@A@@@A@@BA@A@C@@BBB@@BD@BDB@BA@@DBB@B@B@B@BAB@B@DB@@B@@@B@B@@BBAB@B@B@@AB@@@@@BA@A@@BA@@@AB@@AB@@@@@B@B@@@B@B@@@B@@@@@BA@@B@@@@@BA@@@@@@@@@A@@A@A@@@A@@@@@@@@A@@@@@@@A@@@@@@@A@@B@BA@@@@@A@@@@@A@@@@@@@AA@@AA@@@@AA@@@@@@@A@@@A@@A@@@@@A@@AA@@@A@@@A@@BA@A@A@@@A@A@@@AAA@@@@@A@@@@@@@A@@@@B@@BB@@@B@B@@@@@B@@@BA@@@@AA@@A@@@AA@@@@@@@A@@B@@@@A@@@@@@A@@A@@@@@@@@BA@@@@BA@@BAB@@AE@CAGAG@@@EBG@G@EACCECCAACC@IE@@GCGBK@A@CBE@GBEBE@GBMDEBGBGDA@GBGBIDIBGBGBK@KDKBI@@@A@GBG@IAK@EA@AAFAB@@CDCDCAACAEDABA@A@@BC@C@CC@AAEAACAA@C@C@A@@CAC@C@CAEAE@C@A@C@C@C@CAAAC@ABAAC@CAA@CBA@C@A@CA@C@A@AACA@A@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@A@@@@@@@A@@@@@@B@@@@@@A@@@@@C@EA@A@A@@BA@A@AA@CA@@A@G@E@EBE@CBAA@A@AC@ABC@ABA@AA@@AAAB@@AB@@AB@DC@KDIBEDA@GFGDID@@G@EDA@A@AACA@@@A@A@A@A@ABABAAACAABCBAB@BADCDEFABA@EAKEE@CAC@AC@@@A@A@AB@BEBG@CBAACACC@AECCAA@AAAAC@@@@@@@A@@@@@A@@@@@@A@BAA@@@@@AA@@@@@A@@A@BAA@@A@A@@AA@@AAAA@@@A@@@A@A@@AAAA@@@AA@@AAA@A@A@A@A@@@C@@@A@A@@@AA@@@A@A@@@@@AAAAA@@@@@@@A@@B@@A@@@@@AB@@ABA@A@AA@@@@GBCBAB@@C@ABA@A@EEEI@C@@GAGA@A@@A@ABA@C@@BA@@@@BA@@@@@A@@@@DAB@BAB@@@BA@@B@@ABAB@@A@@@A@@BAB@@ABA@A@ABA@AB@@AB@BC@ABAB@@@A@@A@@AA@A@@@AAAAA@AA@@A@@@AAEACAA@CBA@C@ABABA@AB@@A@C@C@@BC@A@@@AAA@A@A@AAA@AAC@AAA@A@AAEAA@@@CBABA@@@A@E@A@ABABAB@@ABAB@B@BAB@B@BA@A@ABAA@@A@@@@B@BBB@B@B@B@B@@A@C@A@@@AB@@C@A@@@@B@B@BABABAB@@C@A@@@@B@@@B@B@D@BBB@BBB@@@DBB@BB@@BB@@@B@@BB@@@BBB@BB@@BB@@BB@BHH@BBB@@@@@@EBC@GBA@C@C@A@@BC@@@@@A@@@C@@@@@AB@@@@@B@B@@@B@D@@@@@@AB@@@B@B@@EHABAB@@B@@@B@BB@B@BBB@@@BD@B@H@BB@@@@C@A@@B@@@B@B@@AB@@@@A@@B@DABCF@BAD@B@@BB@B@B@BBB@B@@AB@@AB@B@B@@BB@B@B@@@B@B@@BB@@BD@@@@@B@@@@B@B@BBBB@@BB@B@DBB@BB@BBB@@@B@D@@B@@B@@BAD@@B@D@B@BBB@DDB@@@@BBB@BD@@B@@BBBDBD@BB@@B@@@@AB@@A@@BA@@@AB@D@@CBABA@@BA@AA@@C@@@AB@BA@@B@@@B@@@B@@@B@@AB@BAB@D@BABA@AB@@@B@@DD@@@B@@@B@@@B@B@BB@@B@B@@A@ABCB@BA@@B@@@D@BAB@BA@@BAB@H@@B@D@@@@@@BABAFAB@H@@BBB@@B@B@@A@GBA@A@ABA@C@@@CB@@CB@BAB@B@@A@@BA@@@A@ABAB@@@@@B@B@@@D@@@B"],encodeOffsets:[[108917,36085]]}},{type:"Feature",id:"620825",properties:{name:"庄浪县",cp:[106.036686,35.202385],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@BBB@BAB@BA@A@AAAAAA@@@AAAB@@@@AB@BBD@B@@"],["@@HFNF@@AC@CAA@AAC@ABCDEBC@@D@DA@A@EBCDE@C@A@AAEACBABAA@@@A@ACAA@AA@@@@@@@A@@B@@@A@@@A@AA@@B@BAB@B@BBB@@BBBD@B@@ABA@CBCDAB@@ADABA@A@@A@A@@@CBA@C@AAB@@CB@@@@A@@@@@@BD@@B@@A@A@@@BB@@@F@@EA@@AF@B@@B@@@@B@@@B@B@@BB@B@@@B@@@@@@@BB@@@@@@BA@B@ABB@A@B@@@@B@B@@ABB@@BBB@@@@@B@@@@AB@@@@"],["@@@@AB@J@B@B@BA@@@C@@@@@@DCD@@A@AAA@A@GAE@@@@@@@A@A@A@A@@A@@A@@@AB@@@@AA@AAA@CA@A@@@A@C@C@CBA@@@@@A@A@AB@@@BAB@@@@@@A@AA@@A@A@CBA@ABA@A@AAAAACAAA@A@EB@@AB@@@@BBBBB@BBB@DB@@ABABAB@BCB@@A@A@A@A@A@@B@@AD@B@@@@CBAB@@@@BB@B@B@B@B@@@D@@AB@@@B@@D@B@@BB@@D@@B@BBFBF@B@DBDB@@B@@@@B@@B@AB@@AB@@@BA@AB@@@@A@@@A@@AA@@@A@@@A@@BA@@@@@@BA@@@AB@@AB@@@@@B@@BB@BB@@@@BBB@@@@@@@BAB@B@@@BA@@@A@A@A@@@A@@@AB@@@@A@@AA@AAA@@A@@A@E@@D@@@@@@@@@@AB@@BB@@@@B@@@B@B@B@@@BB@@@B@B@@A@@@@@ABC@ABA@ABA@AB@@ABAB@@AB@@ABB@@@BD@@DB@@@@@BAD@B@@BB@BB@@B@B@B@@@B@B@@@B@BBB@@BBBB@@B@B@@@B@B@B@@BBBB@BBBB@@@B@@@B@@AB@BAB@@BB@@AF@B@D@B@B@B@D@@@@@@AB@@AB@B@BABAB@DAB@@@@BB@BDF@@@BAB@B@@@@@BB@DBBBDBBBBB@@DBB@B@BBB@B@@@B@@@@@@B@@@@@@BB@@AB@@B@@B@BB@@@@@ABEF@@A@@@@@@B@@ABA@@BBBBB@@DBDBDBB@BB@@BBB@@B@D@@@B@BBB@@H@D@FBDBBB@D@@@@B@DBB@@@@@@D@B@@A@@B@@@@BB@@@BBB@B@@DB@@@B@@@BB@@@@B@@@BB@BB@@A@BB@@@@BBA@@@A@@B@B@BBBBD@@@@ABA@A@@B@@@@@BFB@BB@BD@@@@@@D@@@B@@BA@@BCD@B@@@@BB@@D@B@BAB@@@@@@@@B@BA@AB@@A@@BB@@B@BAB@@@B@BDB@D@B@BBBDB@B@@BA@@B@@A@AB@B@B@@@B@B@@BBA@@BA@AB@@B@@@@@@BB@A@BB@@AB@@@@@B@@F@B@B@B@@@D@@@@ABA@@BA@B@@@B@@@BB@B@@@BB@@@B@@ABABA@@@B@B@BA@ABA@@B@@@@BB@@B@B@B@@@BB@@@@BA@@B@@@@@@@@D@D@B@@B@@@B@@A@@@A@@BA@@B@@@@BB@@BB@B@FAB@@@@@BB@@CH@B@@@B@B@@@BA@AB@@BB@B@B@B@BA@AD@B@B@BADAD@@@@D@@@@B@@@@@B@@ABCBE@ABCB@@@@@B@DABB@@BB@BB@@@B@BADAB@B@BABAB@@AB@BAB@B@BA@@B@@@B@@FB@@BB@@@BB@@@B@AB@@A@A@@B@@@B@@@BB@B@@@@B@@A@C@@@ABAB@BA@@@@@A@@A@@@AA@@@AB@B@BA@AB@B@B@@BDBBB@BBD@N@B@BB@@@@@B@B@@@@BB@BFDB@BD@D@@AB@BA@@B@@@@@B@D@@B@BBB@@@B@BCB@B@B@@@@@@B@B@B@@@B@@@@A@@BA@@B@@@B@B@@@B@@@@BBB@@BFHB@@@@@BAB@BA@ADC@@B@@@DBBBBBB@@@BB@@B@@B@@BB@@BB@@@BBB@@BB@@BB@@B@@BBB@@@B@@@@@@AB@@AB@@ABAB@@@@@BA@@@A@@@@BA@@B@@@@@B@B@@@B@D@@@BB@@B@@@@@@B@@@B@B@@B@@BB@@@@@@@@BDDBBBBBB@@BB@@@D@D@B@B@@@D@BBBBHBFBBBB@B@B@B@B@BBBBBDB@@BDBBAB@B@D@D@D@BADADAB@@@B@@D@B@DBDD@B@D@B@D@@@BBBB@BBBD@BADEBC@A@@BADBDBFBFB@BAB@@BDFBDBHBF@@@B@DBF@BD@D@F@BBDABAB@B@B@@BBBAB@DCFABABC@A@CAA@ABAD@DBHD@BBB@B@BADABAD@B@DBBB@BBFDDD@B@B@BBBDBD@D@B@BBBDDBDB@@DBB@DABEFEBCDA@A@A@A@A@C@AD@BAD@BBDAB@@ABC@A@AB@@@BBB@@@@AB@BABBBF@FABHF@@@@BA@@@@@A@@@@AA@AA@BA@@@A@A@@A@B@A@BAA@B@@A@@@@A@@A@@@@@@@A@@@AAA@@@A@A@@@A@@A@@@@ABE@@FB@@@E@@AA@@B@B@@@@AC@@A@@@@B@@@@@DA@@BAAA@AAA@ABA@@BAFCBABABAB@B@@@@B@@B@@B@B@@@B@@@AB@@@@@@@B@@BBBBDB@@@B@DEDEBA@CACCC@@@AFKDGBCB@B@F@J@D@B@BA@C@ACEAA@EFCH@BA@AAACCAA@EBEFC@CBC@E@A@A@C@@DCDAD@F@@@BFABCD@DADBDFDF@DCD@BFADBBDD@B@D@DBDBB@BBB@BBDA@@DADD@H@FBDDJFFFFFFFDF@@FDDDHBD@BBDJBDBJ@@BF@@DBBBF@DCBC@OBCB@@@@@BBDB@@@@@B@B@@D@@CBGBGBC@CDCFCDAH@@@B@F@F@D@D@HBDDD@DAFAD@DAD@F@F@D@@@@ABC@A@CBA@C@CBC@E@A@CBEDOBEBAFABABA@E@AAEKGACAC@CBA@AFCDCFEBABE@EBC@CBCJGFAF@F@D@F@DADEFMDC@@BC@@DCBCDC@CACAC@EHEFADCFCBEDCDAF@FBD@DEBCBADCDADABA@C@CAEAC@CBCD@DAFCBEDCBCDCDADADADBDBF@D@HCBABC@E@CBCDADAL@@@@A@@@C@@@A@A@@@@BABAB@@@B@@AB@@@@ABA@ADA@@DA@@D@B@BAB@B@HAB@@@@A@AA@AA@@@GBABEBA@A@@@@C@A@@@@GBA@AB@@ABA@A@C@@@AB@@ADABAB@@@@A@AA@@A@A@A@@@A@@@A@@CC@@@A@@BAB@BA@A@CBA@ABA@@@A@@@A@@@A@@@AB@@ABA@@D@@@BBB@@AB@BADA@@@CBA@@B@@AB@@@BA@@@@@AA@@AACACAA@@@AC@@AAA@A@@A@CCA@AAA@C@A@@@BC@AA@@@@AC@A@@@A@AAA@@AAA@C@AAA@@AAAAA@A@@@@@@A@@@@AC@@AA@@A@CBA@A@AA@@@@ABAB@@A@@@AA@AA@ACA@A@A@AAA@@@A@AB@@@BA@AA@@AAA@@@CA@@@@@A@@@@A@@@A@A@@BA@AA@@CA@ACA@@@A@AA@AACAA@@@@A@AA@CAA@@@A@CBA@@@A@A@@ACACAC@AA@@@AA@A@A@@@AC@@@@ABABAD@BDF@BBB@B@BAB@B@@@B@@@@BB@@B@@B@@@@AD@BA@AAA@E@@@A@AAC@@@ABAD@D@B@H@D@B@@@@@DA@@BABCBABA@ABABABA@CB@@@BA@@@A@C@GBCBABCDCHA@@B@@@B@BA@ABADA@@@@B@@@@A@AACAA@A@ABCB@@AA@A@AA@AAAAAAA@@@ABABA@A@CA@@A@@@AB@@A@@BEA@@@@@A@A@A@@EC@@CC@@AA@@C@AAA@@AA@C@@@A@ECC@C@E@@@@AA@@@C@AA@@AAA@@AA@ABABA@AAACA@ABC@CFADAB@@A@A@A@A@@@A@ABC@A@EFA@C@C@CBCDA@@@A@A@@@AA@A@A@CA@@C@AAC@A@A@AAAAA@@AAAAA@AAA@AAAA@@BCAA@CCCC@AA@AAA@CA@@A@@@A@@BC@A@@@CBA@CBA@@@A@@AAC@AAA@A@@@A@@@A@@@CHABA@@BAF@BA@A@ABABA@AB@B@BBB@@BBBD@BBBADB@AD@@@B@B@@@@EBCAIEA@@A@A@C@@@@ACB@B@BA@A@@BAA@@AA@@@C@EAGAA@ECKECA@@CCCACAA@A@AAC@EAA@C@CACACAC@@AA@CAA@A@A@EAA@A@@@ABA@@@CBA@GBCBABABG@CBA@@@AB@@AB@BAAAAA@CA@AAAAA@@@A@@@AAAA@AA@@AA@ACCA@EEA@CA@@EAAAAC@@AAAACAA@AAA@@AAA@A@@@@@AA@@@A@@AAAAAA@C@A@A@A@A@A@C@A@@AB@@A@@@@@AA@A@A@@BAB@BA@@@@@A@BAA@@AAAECEAC@A@@AA@@@@B@B@BBD@@B@BB@@B@@BB@@@BA@@@@B@@BB@BB@@B@@@@B@@BB@B@@@B@@@@B@@@@BBB@B@D@BA@@@A@@@A@A@@@A@@@CA@@A@@@@@@B@B@B@BA@@@A@@BA@@@C@@BC@ABABA@AD@@A@@@AB@D@D@BA@@BAB@@C@AB@ACAA@A@@@A@AB@AA@AACC@A@@@@A@A@ADA@@@@A@@@@@AB@@A@@@AA@A@GEEA@ACA@AACAA@A@@AA@AAA@@@A@@@@@AA@@@ABA@@@A@C@C@GC@@@@A@I@@BA@@B@@@@A@A@AA@@AA@@@BA@G@CBAA@@@@@A@@@AB@@@@A@CBC@C@A@A@A@C@C@E@A@@@@@A@@CEAA@@BA@@@@BA@@@@@AB@@@@@@@@AB@@@@@@A@@@@@@@AB@@@@@@@@A@A@@@@@@@A@@A@@@@A@@@@@A@A@@@@@C@A@@C@AAA@@@C@@@A@A@@@@@A@@@@@A@@@AB@@AB@B@@@BA@ABABCDAB@@A@A@C@A@A@CA@BA@ABA@@BA@@@AAA@AAAA@@AAA@@@@AAA@@@A@@B@BA@@@@@ABA@@DA@@@A@@@CAC@A@@AA@@A@AB@@@@AD@D@D@B@@@@A@@B@@ABABA@A@@@A@ABA@E@@@@@@ADE@@@C@AACAA@@C@AAC@A@C@CBA@A@CBABC@A@A@EAA@A@GDABED@@AB@@A@A@C@@@A@@B@@"]],encodeOffsets:[[[108620,36306]],[[108607,36309]],[[108391,35902]]]}},{type:"Feature",id:"620826",properties:{name:"静宁县",cp:[105.732556,35.521976],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@D@DDBHBHAD@DBDBBBBFD@@D@F@FAF@@@DCDEDABC@ECACAC@AAA@AAAC@CAA@@@@@AA@@@@@@@@BA@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@A@@A@@@@@@@@A@@@@@A@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@A@@@@@@@@@@@@@AB@@@@A@@@@@@@A@@@@@@@AA@@A@@@@@@@A@@@@@A@@@@@A@@@AAAA@A@C@CBABGBC@A@@B@@EBC@G@@AE@@MEGEGEBA@EAEAAABA@@B@D@B@D@B@@AB@@ABAD@BBBBBBB@B@BABA@AB@D@B@DBB@B@D@@BBBDFHBBBD@BAB@B@BBBB@@@DBB@D@@B@@@@FB@AB@@@@@@@B@@@B@"],["@@A@@@A@A@A@@@A@@@AB@@A@@@AB@@AB@AA@CA@BA@@B@B@@AB@B@@@@ABABAB@@C@ABCBAB@@ADAB@BCB@@@@@B@@BD@@@B@@AAA@A@@@A@@BA@ABAB@BABAB@BABA@A@@@A@@@A@A@@A@@AC@AAA@@@@A@AB@@@B@@A@A@@B@@@@CBCBAB@BAF@BAF@BA@@@@@A@@@A@AAC@@BA@ABA@@@@@@B@@@B@@A@A@A@A@@@@BB@@D@B@D@B@B@B@D@B@BABAD@BB@@@B@@@B@@@B@B@@@@@BBABBB@@BB@@DB@B@@@DBD@@@@BB@B@B@F@@@@@BDBBD@@@BBB@BBDBBBBA@A@C@A@ABA@@@A@@B@@CBCF@@A@CB@B@@@BABAB@@B@@@@B@@A@@B@@@@@@B@@@@B@@@BADA@ABC@E@A@@@C@E@@@A@@@A@A@A@@@C@AA@BC@AB@@@B@@BB@@B@@BB@@BB@@BB@@BBBDF@@AD@BA@GB@@@B@@@B@B@B@BBBA@@@A@@@CAABCD@BAB@@@@@BBB@BB@D@@BBBDBBBB@@@@BAB@@@D@B@D@B@BA@AB@@@B@F@@BD@@@BBB@@B@@@@B@@@BB@B@@B@@BBA@@@B@@BB@@B@@BB@@@D@@@@E@C@A@A@AA@AAACCA@@AA@A@AAA@AAAAAAA@A@CAECEAAAC@CC@@AAAA@A@AA@ACAAA@@AA@ACAA@@A@@@AAA@AA@@AB@@@B@BADABABA@@B@@@BABAB@@AB@@@B@B@@@@ABA@ABA@@B@BABCD@@ABABABAB@B@DA@@BCB@@AB@@AB@@@BBB@B@B@D@@BB@BB@@B@@CDABA@C@E@@@@@@D@BC@@@@D@@@BABABAB@B@B@BA@@@CDAD@BDBBBDD@@@B@B@@@B@@@H@BDB@D@B@B@D@@CDAB@D@B@B@@B@@BLBB@D@@B@@BBBB@@@D@B@B@B@B@@@B@B@B@B@B@BB@ABA@CAI@AA@@@@@A@AA@@AA@CBAB@@@B@@ABAB@@ABA@@BAB@D@B@BA@A@A@AAEB@@@@@BBBB@B@@@B@B@@@ADAF@@AD@B@BF@B@@@@BABAH@BB@TX@BA@@BA@EBGB@@A@ADA@A@CBCBA@AB@@AB@@A@C@E@A@@@@B@B@BB@@BB@@@@@A@@BA@C@AAA@CAABA@A@@@AB@@EBAB@@A@C@A@@@@A@A@@@@A@A@@@A@@D@B@@BBBD@@BB@@B@@BAB@@AD@B@BABABA@@@@D@BABABAB@@AB@B@BABBB@B@@B@BBDB@B@@A@ABA@@@@BAB@B@@A@A@C@C@C@G@A@@ACCAA@AAABA@ABABA@@B@B@@@B@@A@A@A@AA@@AA@CG@A@A@A@A@C@AAC@@@ABA@@AEBABC@A@A@@CCA@EECAC@A@A@C@A@C@AAA@A@A@A@ADAD@@@BA@C@@@@B@BDDBB@@BB@BAB@B@BDD@B@@ABAB@B@B@@A@@@AACEAAAAC@@AAA@@@CAA@AA@@@E@ABC@C@C@@@@@@B@@DBBBHFFDB@@B@@AB@B@BAB@B@D@@@BA@ABEBA@A@AB@BA@AD@@A@@@@@@@A@ABA@@B@@AB@@@BB@@BBB@BABADA@@@@B@@@H@BBF@B@@@@ABC@C@C@ABC@A@@@CDCB@@@@@BBD@B@DAB@@A@@@C@@@C@@@CDABA@ABA@@BABC@AB@BA@@BABEFCBA@C@@@@B@@@BBB@B@B@@@B@@AB@@@B@B@BBB@D@B@BA@@B@B@BAB@B@B@BBB@B@D@BAB@@AB@BAB@B@B@B@@@@ABA@ABA@A@CDA@@BABCDABADCB@DABA@ABA@A@AB@@AB@@A@A@A@@@AB@@E@A@EA@@A@@BAB@@@B@B@@@BA@@@A@@BAD@@@D@D@B@@BDB@DBBB@BBDB@@B@@B@D@@@FBB@D@@BB@@@B@B@BA@ABA@AB@DA@AD@D@DCB@B@DAD@B@B@BBD@HB@B@@BB@@F@B@D@DBFB@BD@@@B@BABAB@BBB@B@DABA@@BBD@@@@@EJADA@ADA@@B@@AB@@AB@BAB@B@@ABCDAB@B@B@@A@@B@B@@B@B@BBD@BBBBB@B@@@BB@@@BBBAB@@@BBD@@@@ABAB@@@B@@AB@BA@ABBB@B@@B@BBDBB@B@B@B@B@LCB@BAB@@@@@B@B@@@B@BB@@B@B@BAD@@@@@AD@DABBD@B@B@@BBB@@@D@@@B@@B@@B@DDB@B@@@B@BCB@BBDB@@DBBB@@D@@@B@@AB@B@BAD@BA@@BA@@@@BBDBFDFBB@@@BB@@@B@@@B@B@@@BAD@B@BBB@D@BADAF@@@D@@@@@B@BBB@@DDBB@@@D@@@B@B@@@J@D@B@B@B@FBB@B@BBD@B@@@B@BA@@BBB@@@BA@@@@B@@@@@@@@@B@@@@D@@@B@BB@@B@B@@@@@B@B@@@@BB@BAB@@@@@@BB@@@@@@@@@B@@@@@B@BA@@@@B@@@@@B@@@@@@@B@D@@@@@@@B@@@@@@@AB@@@B@B@B@BB@@@B@@@@@@B@@BBB@B@@@B@@@@AA@@@@AB@@@@@B@@@@@@C@@B@FBDABBB@DB@@@@BA@@@@@ADBB@BB@@F@FBB@@@BB@@BD@@@B@B@@DB@B@@@B@@@@A@@@A@A@A@A@CA@@A@@@AAA@CAIBC@@@@@ABAF@BCBA@@B@@ABABABA@AB@BA@@@@@AD@@ABA@A@CFAB@@A@@B@@CBAB@B@@CB@B@@A@@BA@@@@@@@@B@@AB@@@@@@@BB@@@@@@B@@@@@@@B@@@@AB@@@@A@@B@@A@@B@@@@@@AB@@@@@@@B@@@@AB@@@@A@@B@@@@A@@@A@@@@B@@A@@@A@@@@@@BA@@@@@@@@B@@A@@@@B@@@@@@@B@@@@@@@BA@@@@B@@@@@@@B@@@B@@@@@@@BA@@@A@@@@@A@@@@B@@@@@@A@@@@BA@@@@@@@@@@@A@@@@@A@@@@@@B@@@@@@@B@@@@@BA@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@B@@A@@B@@@@@@@B@@@@@@BB@@@@@@@BB@@@BB@@@@@@@@@@@BA@@@@B@@@@@D@@@@AB@@@@@@@@AB@@A@@@@@A@@@@@A@@B@@A@@@@@A@@@@@@@@B@@@@@@@B@@@@@BA@@@@@@@@B@@@@@@@B@@@B@@@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@BB@@@@@B@@@@A@@@@B@@A@@@@B@@@@@B@@@@@@@BA@@@@@@@@B@@@@@@AB@@@@@@A@@@@BA@@@@@@@A@@B@@@@@B@@@@@@@@@B@@@@@B@@@@@BB@@B@@@@@B@@@@@B@@@@@@@BB@@@@@@B@@B@@@@@@@B@@@@@@@B@@B@B@@@@A@@B@@@@@B@B@B@B@B@@B@B@@@@B@@@@BB@@@@@@@@BB@@B@@@@B@@@@@@@@@B@@@@@@ABB@@@B@@BD@F@BA@@D@@@D@B@@@@ABBB@DB@@BAB@@@DB@@@A@@B@B@@B@@BBA@AB@@BB@BADBAFAB@D@H@DABC@@BAD@FBF@DCDCD@B@DDFDJBHDFBF@FCDABCD@FBBBDBFFJAFAH@FADCBADEBCBE@C@CAAACGIACAC@CDKBCBAD@@@BFDBDBDBDF@F@B@B@DCD@DEN@DF@D@D@FADAFBDBD@F@D@FABD@@AB@B@DBBBBFBBBDBB@@@@@@@B@B@@@B@@@@@B@@BB@@@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@B@@B@@@B@@@@@B@@@@@@@@@B@@@B@@BB@@@@@@@@@B@@@B@@@B@@@@@@BB@@@@@@@@@B@@@@@@BB@@@@@@@@@B@@@@@@@@@@@@BB@@@@@@@@@BB@@B@@@B@@@@BB@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@B@B@@B@BA@@@BB@@@@@@@B@@@@@@@@@B@@B@@@B@@BBB@@@@@B@@B@@B@@@@@B@@@B@@@@@@@@@B@@@@@@@@@@@@@@@BB@@@@@@B@@@B@@@@@B@@@@@@B@@@@@@@@@B@@@@B@@@@@@@B@@@@BB@@@@AB@@@@@B@@@@@@@@B@@@@B@@B@@@@B@@B@@@@B@@@@@B@@@@@BA@@@@@@@B@@@@B@@@@@B@@@@@@B@@@@B@BB@@@@B@@@@@B@@@@@BB@@@A@@@@@@BB@@@@B@@@B@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@BBFABABC@ABE@CBCFAD@@@BBBD@DADBDB@B@F@D@BA@A@@@A@@@@@A@@@@@A@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@ABAHAFAFADABBBB@@@@@@DDBBD@D@F@DCBCDAFBBAD@FANOFADB@D@BBDBDFBD@DCBAFBFBDCBABE@@AEAA@CACACCACCAECAAACCAAACCCACEAE@ABCBG@AF@HAB@LAFADABBBBF@BAB@BE@IICEAA@ECGCCCCAECAEBCBGCGEAA@@BE@C@C@E@AAC@@@CBA@@F@JDFBD@DCBADCHAF@DADABCDE@CACCAACBC@C@@@AECACCIAEBC@ADAF@BADABEDABCF@FFJBH@FCBECGAG@A@CHAJCDBDDBD@DBDBBDBDADABCAEAA@ACC@C@CACA@C@E@GAAA@ADEF@DBDAAGFABCBABAHAD@F@PCDCDABAC@@@@@@ABABA@@B@@@BA@@@A@A@@@@@@@A@@A@A@@A@@A@@@ABA@CA@@A@@@@A@@@ABABA@@B@@A@@@@@@@A@@@@@@@@@@DCB@FABADCBCBCDCDCFEDAB@BBBDF@DBDBNJBCBCBCBC@A@CBABCB@@A@CAACCBC@@BEBCD@D@@CEECCACBCPHDBDCBA@A@C@E@E@E@ABC@ABACCAE@EBE@IBYLID@B@DBHNBB@FHTFBBBDB@BBBBLBH@D@DBFDLBBFEDAHAD@D@F@@D@B@BBBAF@BBBFBH@F@D@@@HDD@@@DCDADCDCFAD@FAF@B@D@JAD@FAHAJ@L@LCREDBJ@H@FAD@FADAB@FAD@F@HCBADADCDABAD@DF@@DBD@D@DC@ABC@C@C@EAAACC@C@AABCDADCBABA@A@C@CBCFAD@FBB@B@F@D@FBDBF@DBF@D@@BB@F@D@@DB@DBFADAF@@@BB@FBBDABE@E@CBEBAA@@@A@@@@@@@A@@BEA@@@@@AC@A@CA@@A@AA@AABCBA@C@GAE@C@AAAAAAA@E@CBCBA@C@AACAACA@@ACAGAEAA@CBA@CBAAA@AACCA@CAC@E@A@A@@@CAA@A@A@A@AAAA@AA@AACAA@C@AAA@AAA@AB@BA@A@EAA@@@CCCCGAECE@A@C@AAAAAAC@C@AAA@C@A@C@A@A@A@AACCCCGEEAC@CAC@C@AB@BABABA@G@CAC@CAE@@@E@CA@AAAA@A@AAAEAI@@@GBE@G@EBC@ABCBA@C@A@CBCB@BC@@AC@@@@@@@@AA@@@AA@A@@@A@@@@@@@@AA@@A@@@C@A@@@A@A@@@@@AB@@A@@B@@@B@@A@@@@BABA@@BA@@BA@@@@@@@A@@AA@AA@@@AA@@AA@@AA@A@@AA@@AA@@@AA@@@AA@@A@AAAACA@@A@@@CD@BABA@AB@@@@A@EG@AA@AA@@@@@A@@@A@A@@@AB@@AB@@@@@@A@@@A@A@A@@@@A@A@A@ADA@@@A@AAA@@@@C@A@@@@@AB@@ABA@@@CACA@EC@AAA@@@@@A@A@@@@AAA@M@C@AAA@AAAC@@@A@ABAB@@A@ABA@@B@@B@@@BB@@@@@B@@ABABA@@D@B@@@@A@@A@A@@A@@@A@@@AB@B@@@BAA@@@A@@A@@AA@@EA@@@A@@@AB@@A@ABA@ABA@@BABA@A@ABABC@A@A@@AAA@@AA@BA@C@A@@@@DABAF@DABA@@@A@@@@@A@@C@@@@@BCBC@A@A@ABCB@@A@A@A@AAA@@BAB@@A@@@A@A@@@ADG@@AA@@@@A@EBA@A@@AA@@A@@A@@@AB@@@B@@@BA@@@A@@@@A@C@C@@@@@@A@@@AB@@@@AA@@A@A@A@@@AA@@@@@AB@BAB@@A@A@A@@ABAB@BA@@@A@@A@@@AAA@@A@@@A@B@@AB@BA@@@@@C@@@A@A@A@EA@@@@@A@@BA@@A@BAA@@@@@@@AA@@BAB@@AB@AA@A@@@A@A@A@@B@BA@@@AB@@@ACAAA@A@A@CCA@A@A@@BA@A@AA@@AB@@@BAB@@A@A@@@@@@A@ABA@C@@@AA@@@@@ADC@AB@@AA@@@C@@@@@@@ACA@@AEA@A@@@@@AB@B@BA@@@@ACAA@A@A@AB@@@B@AA@@@@AAB@@@AAA@@A@@@A@@A@@A@@@A@@CA@@@AAA@A@@AA@@@@@AB@@@@A@C@@@@A@CAA@@@@@@CAACAEAC@G@@@AA@A@A@@@C@AA@AA@@AAA@CACACA@@AAAA@AB@BA@@@A@@@@B@@@FEBA@@@@A@@A@AA@@@BA@@AA@@@@@@@A@@@@A@@@A@A@AAA@A@CA@@AAAACAAACAA@@A@@@@@ABA@A@@CE@AAA@@@@BA@CBABA@A@ABA@@BA@@@@@@@C@A@A@A@C@ABE@@AA@@BA@ABA@@@A@@@A@@AAAAA@AA@AA@A@A@@@A@A@@@AAAA@@AA@A@A@@@A@A@@@A@A@AA@@AAA@@@ABC@A@@@@CA@@AC@@A@BA@@BA@@BABA@@BAB@BAB@BAD@BA@@@@B@@@@A@A@@AA@@A@A@A@@@A@@@@@AA@@BA@@@@@@@@@@@CF@B@@@@BB@BBB@@BB@@@@@BA@@B@@@B@B@B@@@B@@A@@@ABA@A@@@@@@AA@A@@A@@AAA@@@A@@@@BA@@BA@@B@@A@@@@B@@AB@@@B@@@B@@BB@@@B@@@@@BAB@@A@@BA@@BAA@@@@A@@A@@@CACAA@E@EAAAA@@@@CA@@AA@C@@@@A@@BA@@@C@@@A@A@A@AAA@@@@BADA@@@@@ABC@@@AA@CCA@A@@A@@@A@@BABA@AB@@@A@@@C@AAA@AEAA@A@@@@@A@@B@B@B@@@@@@C@A@A@CAAAE@@AAA@@C@AAC@@AA@CAA@AAA@ABA@@@@B@F@@@B@B@FDDBB@B@@@A@@A@@@@B@AA@@@@@@BA@A@@AAB@AA@@B@A@@A@A@A@@@@@A@@@@@@@@@@@AA@@A@@AAAAA@@AA@@@@@A@A@A@@@A@A@@@AAAA@A@@C@@@AAA@@AA@AAC@A@C@A@A@A@CBEBC@A@A@@AAAAACAA@@AAB@@AB@BAB@DA@CB@BC@@BAB@B@B@@@BAB@@@@@B@@B@@B@@@B@@B@B@@BB@@BB@BB@@DD@BBB@@@@@B@@@BABCD@B@B@BA@ABE@A@ABC@AA@@CAA@@@@@A@@E@A@@AABA@C@A@A@C@ABA@A@C@AC@@@CAA@@@C@@@A@AAAB@@A@@@@B@@@@@BAF@B@@@BAD@@@BAB@BA@@D@@AB@@EBC@@@@@@C@@CA@CAA@@BA@@@A@@@AA@@@C@@@A@@B@@A@@@@B@B@@@@@BABB@BBB@B@@B@D@@@D@D@@AD@@@@@B@@@@AB@@@@@@@BB@@B@BB@A@@B@@@@@B@B@@@B@@@@@B@@@B@@A@@@@@@B@@@BA@@B@B@@AB@@ADA@@BA@AD"]],encodeOffsets:[[[108617,36344]],[[108238,35916]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,5 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("庆阳",{type:"FeatureCollection",features:[{type:"Feature",id:"621002",properties:{name:"西峰区",cp:[107.651077,35.730652],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@AEACA@@AAAE@BA@ABA@@@A@@A@@@A@A@C@@@@A@@A@@CBED@@A@@@AA@A@A@A@@@AAA@@A@@BA@CBAB@@A@@@EAA@A@C@AB@@@B@@@B@@AAA@ACAAAA@@@@@@@AA@@@AA@BA@@BA@@@@BAB@@A@@@@@C@AB@@BB@@@B@@@@A@@@A@@@@@@@A@@@@@@@A@@@@@AB@@@B@BAB@@@B@@@BB@BDB@@B@D@B@@@@AB@@A@@@A@A@@@CF@@C@@BA@AB@@A@@@A@@BA@@B@@B@BDB@@BB@@B@@@@CB@@ADA@@@ABC@@BCB@BA@@@EAAAAAECACAA@@A@A@A@@@CBABC@A@@B@@AB@@@BCBABA@@B@@BDBD@D@BBB@B@B@@@BA@A@A@@B@@@@D@@@@BBB@B@@B@D@D@@BDBB@@B@@AB@@C@ABA@CB@@@B@@AB@@@B@BB@@@@@F@@@B@@B@@B@@@@B@@B@@@@@B@@@@BB@@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@A@@@@B@@@@@BA@@@@@@B@@@@A@@B@@@@@@@@@BA@@@@@@@@BA@@@@@@@@@@B@@@@A@@B@@@@@@@B@@A@@B@@@@@@@@@B@@A@@@@@@@@B@@A@@B@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@A@@@@@@AA@@@@@A@A@@@@@@@@@@@@A@AB@@@@@@@B@@A@@B@@A@@@@@@@A@@@@@@@A@@@AA@@EACAA@@@@@@@A@ABAB@@AB@@@B@@@@@@@@B@@@@@@BAB@@@@BB@@DBDB@@@@@@@BA@@@@@@@AA@@@@@B@@CB@@@@A@@BB@@@B@B@@@CBA@@B@B@@@@@BD@@@@@@@ABA@ABE@C@@BAB@BA@@@@@@@BBB@F@DB@@AB@BA@@B@B@D@@DBBBA@@B@@@B@B@@@B@BA@@BC@ABAB@@@@@DDD@@ABAB@BA@@@A@EA@@AAA@@@ABA@AB@@@B@B@B@BB@@@@B@@B@@@B@@BDD@BBB@@@B@@@@AA@@@B@@@@@B@@ABA@@@AB@@@B@B@D@@@@@@@@@@@@@@@@@B@@@@A@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@B@@@B@@@@@BAB@@@B@@@B@@AB@@ABA@@B@@AB@@@@AD@B@@AB@@@BA@A@ABC@A@AB@DAD@B@DBD@B@@B@@@BA@@@@JBB@BB@@B@@@BB@ABB@@A@ABA@@@A@@@@@CAA@@@AB@@@@@@AA@@A@A@@AA@@@@BA@@BAB@@C@CBA@A@AB@@@B@@B@BBB@@B@@@B@@@@@BA@@@AB@@AB@B@@@@CA@@@@@B@D@BAB@@@BBB@@AB@B@B@@BBBDB@@B@@@BAB@BAB@BA@@D@B@BAB@@CDAB@@A@C@C@A@@@@@CJ@BA@ADAB@BAB@BADAB@BA@C@A@@BA@@@@B@@BBB@D@B@BBB@@@@B@@@@@B@@AB@BAB@@@@@B@@@@@B@@@D@BBD@DAB@B@B@@@@BB@@@@@@@BBB@B@@A@@B@@@@A@@B@@AB@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@A@B@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@B@@@@@B@@@@@B@@@@@@@@@@@@@B@@@@A@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@BA@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@BB@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@B@@@@B@@A@@B@@@@@B@@B@@B@@@@B@@@@B@@B@@@@@@D@DAB@@@DAD@@AB@B@D@BAB@B@@ABABAB@BABA@@@A@A@@B@@@BA@AB@@CFABA@AB@@@B@@@B@@AB@@@BAB@@@D@B@B@B@B@@@B@B@@AB@@AB@@@@@BA@@B@B@BA@@BAB@D@@@BA@@@@F@@@@ABA@@@@B@BAB@@ABAB@@AB@@B@@BAD@D@@@B@B@@BBBBB@@B@B@@@B@BCDCDEDBD@B@BBB@@@@@@@@B@D@@@B@@@B@@A@B@@B@@BD@B@@@B@@@BB@@D@@BB@B@@BB@BBD@@BB@@@BBB@@BB@@BD@@BD@@@BBB@@@BAB@B@@BD@@@B@@@BB@@F@B@@BBAB@@@BBB@B@@@@@F@@@@A@@@@@@@@BB@@B@B@@B@@@@B@B@@@@@@BB@@@B@@@B@@@@B@@@@BA@@@B@BB@@@@AB@B@@@@@@@BB@@@@@@@BB@@@@@B@@@@@BBB@@A@@@BB@@@@@BA@@@B@@BAB@BB@@B@B@@@@@@@B@B@@@@@BB@@@@@AB@@@@@B@@@B@@@@@@@B@@@@@@@@BB@@@@@@A@@B@@@@@B@@@@B@@@@B@@@@@@@@B@@B@@B@@B@B@B@B@BBB@B@@@B@F@@@AA@@D@@@B@@@B@@AB@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@@@@@@B@@@@@@@@B@@@@@B@@@@@@@B@@B@@@@B@@@@@@@B@@@@@B@@@BB@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@BB@@@@@@@@B@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@B@@@@B@@@@@@B@@@@B@@@@@@@@B@@@@B@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@B@@B@@@@@@@@@@@@@@@BB@@@@@BB@@@@@B@@A@@B@@B@@@@B@B@@@@@B@BB@@B@BD@@B@@@@@@@BB@@@@@@B@@@@@B@@BBBB@@@@@@DADC@@B@@@B@@@@@B@@@@B@D@@@@@@B@@@BA@AB@B@@AA@A@@@@A@@@@DB@@BA@@@@B@@@@B@@B@B@@A@@@@B@@B@@BB@@@@@@BBFBDBB@B@@@@@BB@@BBBBBB@@B@@@@AB@@@@ABAB@@@B@@@B@@@@@B@@@@@B@@BB@B@B@@API@@@@@@BABB@@B@DCB@B@A@@@@AB@@@B@@@@BB@@@BA@@@AB@@BBA@@B@@A@@@BB@@A@@@AB@@@B@@@B@@@@@B@@@@AB@@@@BB@@A@@B@@@@@B@B@@A@@@BB@@@@A@@@@@A@@@@B@@@@@@@@A@@B@@A@A@@@A@@A@@@@@BA@@B@@@@@@A@@@A@@@@@@@@B@@@@@B@@@AC@@@@@A@@B@@@B@@A@@A@@@@@@A@@DBB@@@@@@C@@BA@@B@@AA@@@@@@AB@BBBA@@@@@@AA@@@@B@B@@@@A@@@A@@BB@@@AAA@@@@B@@@DA@@B@@@AA@@BA@@@@@AA@BAB@@@@AA@@ABA@@@@A@@ABAB@@@AA@@@@B@@AA@A@@@@A@@B@@@B@BA@@AAB@@A@@@A@@A@@@@@BAB@@@B@@AAC@@B@@@@@@A@@@@@A@@@A@@@@@ABBB@@AA@BA@@@@@@@@CC@@@@BA@@BB@@B@@@@A@@@@@@@@D@B@B@@@@@@AB@@@@@@ABC@A@@@AAA@@B@@AB@@@@AB@B@@ADC@A@AA@@@ABA@@A@@B@@@B@B@D@@A@@@@@@@AB@B@DC@@@@B@@BBBBB@@@@B@@@BA@@@ABA@@B@@@@@B@BD@BB@B@@@BA@A@@BAB@@ABC@ADADC@@B@@@@B@@@@@B@@@@BA@AD@@A@@vDF@B@B@B@@A@@B@BB@@B@D@D@@@@BB@@@B@@@B@B@@A@@@C@A@@BA@@@A@@@A@A@A@@B@B@B@@@B@B@B@B@@@@B@@@BB@@@@A@@@C@@@@D@@@BA@@@@BAB@@@B@@AA@@@@@@@BA@@AA@AB@@C@@DADAB@B@B@B@@@B@@@@@BA@@@@B@@@B@@AB@BAB@@@B@BB@@B@@@BB@B@@B@B@@@@A@@@AB@@@@A@AAAAC@@AA@@BA@A@@@@B@DB@@BBD@B@@BB@@BB@@@BAFCDCBA@@BAB@DAB@BA@AA@C@@@@@@@@AB@@ABA@@B@@AB@BABABA@@B@@B@BBB@BB@B@B@D@@@@@B@@AB@@@@A@@@ACAAA@@BA@@@@@@BBB@BB@@@A@@@@@A@@@AB@@A@@@@AAA@@A@A@@BA@AB@@A@@B@BDB@@@BBB@@AB@AA@@@@B@@@BE@@@@BA@@@A@@@AB@@@D@@@@@@@@@AA@@@@@C@@AA@@@A@@@@@@B@@@B@BB@A@@AA@@@@@@B@@@@@B@B@B@@@@@BB@B@@@@@@B@@@@A@@@A@A@A@@@@@@B@DBB@@@@AB@@A@@@AB@@A@@@@@@@A@@B@AAB@@@BA@A@@BBBBDD@@D@B@@@@CBA@@@@@A@@DB@@B@F@@@BABC@A@C@ADC@CBAA@@AA@@A@@B@FBB@BBB@@@B@@@@@@C@@BA@A@AC@A@ABA@@@AA@@AABCA@@A@@@A@A@@AA@@@@@A@@ACA@@AA@@A@@@A@@@@@@@A@@@C@@AA@A@@@ABA@C@A@E@@AA@@@ABA@@B@@@BC@@A@@A@@BG@C@@GAA@@B@B@@C@@@@@A@C@BKBA@@A@EAG@@@A@@BA@@@EC@@@ABA@A@A@@@ABA@@BE@A@@ACB@@A@@AC@@@@@A@@@ABA@@BA@@@@AA@A@A@AAABA@@@A@@@A@@@A@@BCBA@@@CBA@@AC@ABA@@BA@@@A@@BA@AB@@AAABAA@A@@@A@@@A@@@A@@@@@A@@@@BA@@@B@@@@@@@B@@@@@@@@B@@@@@@@@@B@@@@@@@@@@A@A@@@@@@@@@@@AB@@@@@@@@@@AAA@A@@@@@A@@@@@@@A@AA@@@@@@@@BA@A@@@@@@A@@@@@@@@@A@@B@@@@@@@@@@@A@@@@@@@@@@A@@A@@@@AB@@@B@@@@@@@@A@@@A@A@@@C@@@A@@@@@@A@@@A@@AA@@@@@A@@@@A@@@@@A@@B@@A@@@@@A@@@@@@@@@BA@@B@@A@@@@A@@AAA@@A@@@@AB@@@@@B@@@@@@@@@@@@AB@@@@@@@B@@@@A@@@@A@@@@@@@@A@@@A@A@A@A@@@A@@B@@@@@@@B@@@@@@A@@@@@@A@AAEB@@A@@BABA@C@@@A@@A@A@BA@A@@@CA@@CBA@@@AE@@@@A@@@A@@@@B@B@BAAA@A@AB@@A@@A@@@@AA@@AB@@@@@AAAAA@AAAA@@@E@@@@@@@AA@@A@A@@@A@CAC@CAAAA@@@@@@@E@@@@@@A@@@@C@AA@BA@@@AAAA@@A@A@@@@@A@AA@AA@CB@A@@@@BA@@@@A@@@@A@@@@AA@@A@A@A@C@A@AAA@CAC@@@AB@@A@@AA@ABA@@@A@@ACA@@A@A@@@@@@@@AA@@@A@@AA@AAA@@@A@@C@@@@A@AB@@@@A@@@@@C@A@C@@@A@AA@@A@@@@AA@AA@@A@@@@@@A@@A@A@@@@AA@A@@A@@@@A@@@@A@@I@@@A@@A@@AA@@AA@@AA@@A@@A@@@AA@@AAAAAAC@A@A@@@AAA@AEC@A@@D@@@AA@@@@@@@@@AA@@A@@BBB@@@@@@@@A@@A@A@@A@@@@BAB@BA@@@A@@AAAA@A@A@@@A@@A@ABA@CA@@@@@A@@A@@@@BAB@BA@@@@B@BCD@@AA@BABB@CD@@@@AAAAAAA@@B@B@@@@@@FFDB@@@BCDABABAB@@@BB@@@CBC@@@AAA@@@AB@@@@EA@B@@@BDBB@@@@@A@A@@BA@@@@B@@CBCD@@@@@A@@@C@@@ACA@@@@@ABA@ABC@ABABABE@ABA@@@A@@@CBE@A@ABABA@@@A@@@A@@BA@@@@@ABA@A@@@A@@B@@AB@@A@A@@B@BA@@@@BA@@@AB@@@@@@@@A@@@AB@@A@@B@BA@@B@@@@@AA@@@ABA@A@@@A@A@@@@@A@@B@@A@@@@AA@@BA@@@A@@A@@@@@@@@A@A@@@@A@@@@ABA@@AA@AA@@@@A@A@@@@AAA@@@BA@C@@@A@@@A@@@A@@@@AA@@@AAA@A@@@@A@AA@@@@@@@A@@@AA@@AA@@A@@@@@@@A@@@@@@CCAC@@@@AA@@A@@@@@@A@@B@@@@A@@@@C@@@BB@B@@BBBB@B@@@B@@BB@@@@ABEB@@@AA@@@@@AA@AA@@A@A@A@@@A@A@@@@@A@@B@@@@AB@@@@A@@@@@A@@@A@@A@@AB@@@@AA@@A@AB@@AA@@@@@@A@@@AACAA@@@A@@@@AA@A@AAA@@@A@@@@CA@AC@@@AAACAA@A"],encodeOffsets:[[110271,36293]]}},{type:"Feature",id:"621021",properties:{name:"庆城县",cp:[107.881802,36.016299],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@A@@B@@@@@@A@A@A@@BA@@@A@A@A@@BA@@A@@@@@@AB@@A@AB@@A@@BAB@@A@A@@@@B@@EBA@ABA@@@A@@B@@AB@@AAA@@BA@@BABC@@@@@@@@A@BA@@@@@A@@@@@@BA@@@A@@@CB@@C@@@@@A@@B@@@BA@@AA@@BABA@A@@B@@@@A@@@@@A@A@@@@B@@@BA@ABA@@BA@@@@@A@@BA@@@A@@@A@@@A@A@AB@@@BA@@@@@A@@@@BAA@C@@@CCAAAA@@@BAB@@A@BBA@@@@B@@@@@@@BA@@B@@@BA@@B@@A@CAA@@@@@@@@B@B@B@@@B@@A@@@@@@@@AAA@@@@A@A@A@@@@@A@@@@@@@BB@@@BAAA@@@A@@@@@@@@B@@BB@@@D@@@@BB@@@@@@@@C@@@A@@B@@@B@@AB@@@@AF@@A@@@@@BBA@@BA@AA@@A@ACA@@@@BA@@BAB@@@B@BB@BB@@@@@BA@@B@@@B@@@@@B@@AAA@AA@@@@@@AB@@BBDB@B@@@B@@A@@BA@@@@@C@A@A@A@@AAA@A@AA@@@ABABABA@@BA@@@AB@BA@@B@@@@@@D@B@@BABA@CBA@AB@@ABCDEDAB@@A@@AA@@AA@C@AA@@CAA@@@@@@BAB@@BB@@BDBB@B@B@@A@@B@@@B@@A@A@@@@AAA@@A@@@AAA@@@A@ABA@@BA@@@A@@@@@AB@@@@A@@@A@A@A@A@CBCB@@@DA@@BBB@@AB@@@@@@B@@BA@@@A@AB@@@@AB@@C@@@@@@D@@@B@@A@@A@@@A@@A@A@A@A@@@A@A@A@@@@B@B@B@@@B@@AB@@@B@D@@@BA@A@@@A@@@A@@A@@C@C@A@@@AAA@@@@BA@A@A@E@uC@@@BC@@BAB@@@@@A@@@@@A@@A@@@CDCB@BAD@BA@AB@@@BAB@@A@A@@AACA@@@@@A@@@AB@B@@AB@@A@@@@@AAAA@AA@@@@@CDA@A@@B@@@@@@@BC@A@A@@@A@@@@BB@BA@@B@@B@BCD@BA@A@@B@@A@@BA@@@BB@B@@@BAD@B@@@@A@@B@@@@A@A@C@@@@@@@@@@B@@A@@@AA@@AB@@@@DD@@@@@@@@ABB@@BA@AA@B@@@@@B@@@B@@@@@B@@@@A@@@BD@BA@@@A@AB@@@@B@@@@B@@@BA@BB@@ABA@@@A@@@@B@@B@B@@BA@@@@@BB@@A@AB@BB@@@@@AB@BB@@B@@A@ABB@@B@@@@AB@@BB@@A@@@CB@@A@@@@@BB@B@@AA@@@B@@@B@@A@A@@@@@BB@@@@@@ABAAA@@B@@@@B@@BA@@@AB@@@D@@@@A@CA@@@B@@@@B@@@@BA@@@A@@@@B@@@@BD@@A@@@@@A@@@@@@@@@@B@@@B@@@@A@@@AB@@@@B@@@@B@@@B@BA@@@@B@@@@@@A@@@@@@B@@@@@B@@A@@A@@@BA@A@@@@@A@@@@BA@@A@@A@@B@@A@@@@@A@@@A@@@A@@B@@@BA@@A@@@BA@@@AB@AA@@B@@AB@@A@@A@@A@@@A@@B@@B@A@A@CDA@@@AAAB@@@@@@OJ@BA@A@A@@AA@@@@@A@@@@@A@@@A@@@A@AB@B@@A@@B@@A@@@AAAAAA@@AA@@@@A@A@CAEAAA@@@@@@AA@@@AA@@@@@@BA@A@@@@A@@A@@@@@AB@@CA@@@@@B@@B@B@@BA@A@@BAB@@A@@@@@@@@C@A@@A@@@@@A@@@A@@@CDCB@@@@@@AAAA@@@A@@@@@A@@@@A@@A@@@@@@@AC@@A@AA@@A@A@@@@@A@A@@A@@@@AB@@@@A@@@@AA@@@@A@@A@@@@@@@@@@@@@@@AA@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@A@@@@@@A@@@@@@A@@@@A@@@@@@A@@@@@@@@A@@@@@@@@A@@@@@@@@@@A@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@AA@@A@@@@@A@@@@@@@A@@@@@@AA@@@@@@@A@@@@@A@@@@@@@@@@A@@@@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@A@@BA@@@A@@@C@@@BB@@E@A@@@A@A@AAA@A@A@A@@@@AA@@@@A@@@@@@A@@@@@@A@@A@@@@@A@@@@B@@@@A@@A@@@@@@A@@@@@@@A@@@A@@@@@A@@B@@@@AA@@@@A@A@@@@@@@A@A@@@AAA@AB@@@A@@AB@@@@A@@A@@@BA@AA@@@@A@@@@@A@@A@@@@@@AA@@@@@@A@A@@B@@A@@A@A@@AB@@@@@A@@A@@@A@@@A@@A@@@@A@A@@@@@@AA@A@@@AA@@@@@@@@@B@@E@@@@@A@A@AA@@A@AB@AA@E@@@AA@@A@@@C@@AA@A@AB@@A@AA@@C@@AC@@AA@@AA@AA@@A@@AC@AAA@@AA@A@@AC@@@AA@@A@@@A@C@@AA@@@@A@BA@@@A@@@C@A@@@@@@@@@AA@A@AAC@BAB@@BDA@@DAB@B@@BB@B@D@BAD@B@BAB@@AB@@@@@@@B@@@@@@@B@@A@A@@@@@@@@@BBB@@@A@@BAB@@@@@@B@ADCDA@@B@@BBBB@B@B@FAH@@@D@B@B@B@D@D@DAB@B@B@B@BB@BDBH@B@BAD@B@BBB@@@B@@AB@@AA@A@@@A@@@@ABC@A@ECA@A@A@A@ABAB@B@@A@AB@@@@@@@BCB@@@B@@AB@@@B@@BB@D@B@BB@AD@B@D@BAB@@@@A@@@A@@@AB@@A@AB@@ABA@@B@@@D@BA@@@@@K@@B@@@B@@@@A@A@C@@AA@@BAB@@@BB@@@@B@B@@A@@@EDAB@@@AA@@@@A@A@@@AA@@AA@@@@B@D@BAB@B@D@@ABA@ABA@@@@@AA@@ABAA@BA@A@@@@AA@@A@@A@A@@@@@ABA@@@@B@@@@@@@BA@AB@@A@@BA@AB@@@@@@B@B@@BBB@@@@A@AB@@@AA@@B@B@B@D@B@@@B@@BB@B@@B@@B@@DD@@@B@@@BBBBB@B@B@B@B@@@@@@C@GAA@A@A@AAA@@@AD@@@B@DABBB@@@@@@BB@B@@BA@@@B@D@B@@@B@@A@A@AB@@AAA@@@@D@BAB@@@BB@BBBBB@@@@@CBCB@BAB@@C@@BA@@B@@@B@B@BAB@@BB@B@@A@@@@@@@AA@@A@AA@@A@A@@@AA@@@@@AA@@@AAG@A@A@CAA@A@ABCB@B@@C@@@ABB@AB@@@@A@A@A@A@@A@@A@@B@@A@AA@AAA@A@@BA@AA@@@@@@@@@ABA@@@A@@@A@@@@@@@A@@BA@@@A@@AA@@@@@@@@@A@A@A@@@A@@B@@@@@@@@@BA@@@@@@@@B@@AB@@A@@BA@@@@@AB@@@@AB@@@@@@@BA@@@A@@@AB@@A@@B@@@@A@@@A@@@A@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@BA@@@@AA@@@A@@@A@@@@@@@AB@@@@@@@@@B@@A@@@@B@@A@@@@B@@@@@@@@@@@B@@@@@@AB@@@@A@@@AB@@@@A@ABAB@BABAB@@A@@BCBA@@B@DAD@D@@CB@B@B@B@DBF@B@@@@@@@@@@E@A@C@A@CDA@AB@@@AA@@@@@A@@B@@@BA@@AA@C@@@AD@B@@@@@@A@@A@@@@ABA@ABABA@@BAB@BA@@B@@BB@BBBBBDB@@BDFDBBB@DBD@@B@@B@BBB@B@@@B@BBBB@@@@BBBB@@BB@BBDBBBB@@BB@BBB@B@@@B@@@BB@@B@@@BBB@BBB@D@@@@BBA@BB@B@B@@B@@BD@@@B@@@D@BB@@@@@@@B@@@@@@@B@@B@@@@@@B@@@@@@B@@@@B@@@@@@@B@@@@BB@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@B@@@@B@@@@@@@@@B@@@@@@@@@@B@@@@A@BB@B@@@B@@@@@B@@@BA@@B@@@B@@@@@@@@@@B@B@B@B@@@BA@@BA@@BCD@@ABAB@@@B@@@DAB@BBD@B@B@@ABAB@BCBADA@@@@BBD@BBBBD@@B@@@@@@@@@@H@@DD@@@BAD@B@B@DBD@@B@@B@@@B@@@D@B@@@@@@B@@@F@B@BA@@@@@@B@@BBB@B@@DD@@@@@B@@@@@BAB@@AB@@BD@@@B@B@@@BB@@@@B@@@@@@@BAA@@@@@AAAA@@@@@@@A@@@A@@@@A@@A@@@@@@@@@@@A@@@@B@@@BA@@BA@@@@@@B@B@BBBB@ABAB@@@BAB@@AB@@A@E@A@A@@B@B@@@B@@BDBB@B@B@D@@BB@B@@@B@BBBB@@@@B@B@@@AA@@@@D@B@BA@AB@@@BAB@@BFBDA@@D@@BB@@AB@@B@@B@B@@BB@@@B@@@@@B@@@D@@BDB@@BB@@BB@B@@@BB@@BB@@B@@D@@@B@@BB@@@@@B@@@BB@@B@@@B@BBBBB@BBBAB@@@B@D@@@D@@@B@B@B@B@@@@FCBCBC@@@@B@@B@B@@B@@@B@@@@BA@AB@BABADAD@HAB@@A@@@@BFDBBBB@BBBDD@@@@@BB@BBFB@@DBBBD@DB@@B@B@B@@@@@@A@@AA@@@C@ACG@C@@@C@C@A@@@@@@H@B@@BB@D@@@@@@B@@BD@B@@B@@@@@@@BABBB@DD@B@@BD@B@BBB@@@ABA@A@ABC@@@@B@B@B@B@@BBB@BBDB@@B@A@@B@@@D@B@B@@BB@D@B@B@@@@@BBA@@BAB@B@BAB@@@@@BBADB@@@@B@@@AB@@@B@B@B@@@@BB@@DB@@B@AA@A@@DE@C@@B@@@@B@BB@@@BC@@@@@BBB@@@@@@B@@A@@B@@@@@@B@D@@@B@@BB@BB@BA@@@AAC@ABA@@@@@@@A@@@@@AB@@@@@B@@@@@@@BB@@@@@@@A@@@@@@@A@A@@@AB@@A@@@@@A@@@@@@AA@@@@@A@@@A@@B@@@BA@@B@B@@A@@B@@@@@@@@@@B@@@@B@@@@B@@@@@@BB@@@@BB@@@@@@@@B@@@@@@@@A@@@@B@@@@@@@@A@@@@@@B@@@@@@@@@@A@@A@@@@@BA@@@AB@@@BB@@@@@@@@B@@@@@B@@@@@@@BA@@@@B@@@@@@@B@BBB@B@D@B@@@@@@B@@@B@BAB@@AB@@@BA@@B@@@B@@B@@@@@BAB@@@B@BADA@@@@@@B@@AB@@@@@B@@B@@@@B@@@@@DAD@@AB@@@@@B@BA@@B@B@@B@@@@B@BB@@B@@@B@@@@@B@B@@@@@B@D@B@@@@B@@ABAB@BAB@@@@@BBB@B@@@@B@@B@@B@B@@@B@@@@@AB@@@B@@@@@@@B@@@AB@@@B@B@@@@@@@BA@@@@@AB@@@@@B@B@@@B@B@@@B@@@@@B@@@@B@@@@@BB@@@@@@B@@E@@@A@@@@BA@BB@D@@@BBB@B@B@B@B@B@BAB@B@B@@@B@BAB@@@B@@FBBBB@@B@B@@B@@@@@@A@@@A@ABA@A@@@A@@B@@@@@B@@B@@@BBA@@@AB@@@@@@BBB@@@@@@@@BA@@@@@@@B@B@B@@@@B@@@B@B@@A@@@@AA@A@A@@@C@@B@@@@@B@@@@@B@@@B@DB@@B@@@@B@B@@@B@@@@B@@@@@@BA@@B@@@B@@@@@@DA@@@@@@@B@@D@B@@@BABA@@B@@@@@B@@F@@B@B@BAB@BC@@@@@@BB@J@B@@@@B@B@B@HIDA@@B@@@BB@@B@@@@@DABAB@BAB@@@@@B@@@BB@@CB@@@B@@@@B@@@BAB@@AB@@@D@@@@BAB@B@@@@@B@@B@@@@@@@@@@B@B@@B@BA@@B@@B@@B@@AB@@@B@D@D@@ABA@@F@D@B@@B@@@@AB@@@@@B@@BB@@@B@@A@@@BB@@@@B@@@@@@@@B@@@@BB@@@BA@@@@B@@BB@@AB@@@@@@DD@@@@B@@@@@@@BB@BDB@@BA@@BCBABAB@@@@@DBBBBD@@B@@@B@B@B@BB@@@D@@@@A@AAA@C@@@@B@B@@@@BBBF@@@@BAFC@@BB@@@@@B@@@BDB@@B@@@B@BAB@@@FAB@B@@BB@@B@@@@@B@@B@@@BA@@BB@BB@@AF@DABAB@@@D@@@B@@AB@@@B@B@D@@@B@BB@@D@@@@@@ABA@AB@BA@AB@B@BD@B@BA@@B@@@B@@DABA@@@A@@@@@@B@B@B@B@B@@@DC@AB@BC@@DBBA@ABE@@@AB@DABA@@@@A@@@@A@@@A@A@C@@B@@@B@@ABB@@DDB@DBFDB@@@B@@@@BABABABBB@@B@@BB@@BAD@@@BB@@AB@@B@B@B@@@@@@@BB@@D@@AB@@@@FBB@@@B@BBB@DB@@B@@@DABB@@B@@B@@@@F@BBBB@@BAB@@@@@@@@@BAB@@@@@BA@@DDBB@BB@@BB@@@@BED@BA@@B@@B@@B@@BB@AB@B@@@B@@@B@@@B@B@@AB@@@B@@AB@B@@AB@@@BA@@@@BA@@@@@A@@@AB@B@@A@@@@@@@@B@@C@CBC@ABADA@@@A@@@A@@DABABA@@DABA@@AC@A@@AC@@AA@@@@@@B@B@DAB@@AB@@AB@B@@@BA@AB@B@B@@@DBB@BCBA@@@@@ADCBC@@@@BADB@@BAB@B@@@BA@@@@@@@A@@@@B@DBB@D@B@D@DA@@BA@@DAD@@@BAB@@@AAA@A@@@AA@@AA@A@AAA@ABCA@@@A@CAA@A@@@@A@@B@@@@@@@@@@@@@@A@@B@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@B@@@@@B@@A@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@A@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@A@@@@B@@@@@@@BAB@@@@@BAB@@@B@@AB@@AB@@AB@@@BA@@B@@A@@@@@@@A@@@@@@ABA@@@@@A@@@@@@A@@@@@@B@@A@@@@@@@A@@A@@A@@AA@@@@@@@@AA@@@@@A@@@@BA@@@AB@@@@A@@@A@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@A@@@@A@@@@@@@@@A@@CCAA@@A@@@AAC@@@A@AA@@A@@@A@A@AA@@A@@@AA@@A@@@A@@A@AA@@@@A@@F@B@B@@@@A@@@@A@AA@@@AA@@A@@AA@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@B@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@BB@@@@@@@@@@@A@@@AB@@ABA@@@A@@B@@AB@@@@@@@B@@@@@@@@@B@@@@A@@@@BA@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@A@A@@@A@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@A@@@@@@@@AA@A@@@@@A@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@A@@@@B@@@@@@@@@B@@A@@@@@@@@@@BA@@@@@@BABA@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@BA@@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@BA@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@B@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@B@@@@@@@@@@@B@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@B@@@@@@@B@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@B@@@B@@@@@B@@@@@@@@@B@@@@B@@@BB@@@@B@@@@B@@@@B@@@@@@B@@@B@@AB@@@@AB@@@@@@B@@@@@B@@@B@B@@AB@B@@A@AB@@@@@@@B@@@B@@@@@@@B@@@@@@@@@@@B@@@@B@@@@@@A@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@B@@A@@B@@@BA@@B@@AB@@@@@B@@@BA@@@@B@@@@@@@@@@@@B@@@@@B@@@@@BB@@@@B@@@@@@@@B@@@@@@@@@@@BB@@@@@@@@@@@@@@BB@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@D@@@BA@A@A@@@A@@@@@@B@B@BADA@AB@BA@@AA@AB@@@@@BBBBBBB@@@@BA@CD@@ABABAB@@BF@@@@@@@B@@@B@BB@@B@@@@C@@B@@@@@B@@BBD@@@@@B@@@@@B@@@AB@@B@B@F@B@@@B@@A@@@C@A@@B@@AB@BBB@@@@@@@@B@@@DA@@B@@B@@@BA@@BA@@BB@@@B@@@B@B@@@BAB@FB@@BD@@@BA@@B@B@B@@@B@@@@AB@BABAB@FA@@B@B@@@BB@@@@@BB@B@@@@@B@B@@BBABB@@DAB@B@B@@@B@B@BB@BB@@BB@D@DBJBB@B@DD@BB@B@@@@BBB@@@BBB@BBB@@@B@@BB@B@@@B@@@@CBA@A@A@@@CAI@CAA@@@A@C@CBC@@@@B@@BB@BB@@D@@@B@@ABA@AB@@@BB@@@@@@@ABAD@B@@@@BBBBBB@@@BAB@B@BAD@B@B@@B@@B@@@BA@@@A@A@@@@BAB@@AB@@B@@@AB@@B@@@@@BB@@AB@@@D@@B@@@B@@@@@@B@@@@@BBBD@@@@@@B@BB@@@@@B@B@@@@@@@@@B@@@@@@@BB@@@@@@@@@@@@B@@B@@@@@@@@BB@@@@@B@AD@@AB@BA@@BA@A@A@A@A@AAC@A@@@AB@@@BA@A@@B@@A@@@@@AB@@AB@@A@@BABA@AB@@CB@@AB@@@@@B@@@@A@@@@@@@@@@BB@BB@@BBB@@BB@@@@@@BB@@@B@B@B@B@@B@HABABAB@@@@@@@DA@@@@@AA@@AA@A@@@@AA@@@@A@@@@B@@AB@@@@@@@@@@@@@@@@A@@@@@@BA@A@@@@@A@@@@@A@@@@BA@@@@@@@@@@@@B@@@@B@@@@B@@A@@@@@@@@B@@B@@@@@B@@@@@@@@BA@AB@@@@@@@@@@@@B@@@@@BB@@@A@ABA@@B@@@B@@@@@B@@@@@B@@@BB@@B@@@@@@B@@@B@@@B@B@@@@@@@BA@@B@B@@@@@@@@@@@B@BB@@B@@@@@BA@AB@@A@@@@BA@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@BA@@@@@@@A@@@@@@@@AA@@@A@@@@@@@@@A@@B@@@@A@@@@@@@A@@@@B@@A@@@@@@@A@@@@@@@A@@B@@@@@@@@A@@@@B@@@@@@@@@@AB@@@@@@@@@@A@@@@@A@@@@@@A@@A@@@@@@@@@A@@@@@@@@@A@@@@@A@@@@@A@@@@@A@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@BA@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@A@@@@A@@A@@@@@AA@AA@@@@@@@@@@@B@@A@@BA@@@A@@B@@A@@BA@@@@@@B@@A@@BA@@@AB@@@@@@@B@@@@@@@BB@@@@B@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@B@@A@@@@@A@@@@@@@@@@@@@@B@@@@AA@B@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@B@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@B@@@@@@@@@BB@@@@@@@@BA@@@@@@@@B@@@@@BB@@@@@@@@@@@@@@@@@A@@@@@@B@@A@@@@@@@@B@@BB@@A@@@@@@B@@@@@@@@B@@@B@@@@@@@@B@@@@@@@B@@@B@@@@@@@@@B@@@@@@@B@@@B@@@B@@@BB@@@B@@@@A@@@@BA@BB@@@@A@BD@@@@@@@BB@@@B@@@@@@@AB@@@@@@@@B@@B@@@@BA@@@@@B@@@@@BBB@@@@BA@@@A@@B@@@@@BB@@@@BF@@@@@@D@B@@A@@D@@@BA@@AA@@B@@@@@B@@A@@@@B@@@@@B@@@AA@@B@BA@@@@BA@@B@B@@@@A@@@@@@B@@@@@B@@@DB@@BA@@@@AA@A@A@A@@B@@@B@@@B@@A@A@@DA@@BA@@B@@AB@@@@A@@@A@@B@@AB@@A@AD@B@BA@AB@@ABABAB@@A@@BA@@@@DBDA@@B@BBBBBBBBBB@@@DB@@BDDB@@@BADC@@@A@@@A@@BA@ABA@@BC@@@@@A@@B@@ABA@@BAB@B@@AB@F@@@@@BCBABEBA@A@@BABABE@@@ABA@A@@BABAB@@AB@BA@@@A@@@ADE@ABA@@AA@@@ABBB@FAD@D@B@@@BAD@BAD@BA@@@@@B@@B@@@@@AB@@@B@@@@BB@B@@@@@@BB@BBB@@BBB@@B@@@@@@B@@B@@@@@@@@@@@@@@@BB@@@B@@@@@@@@@@@B@@@@@@@@BB@@@@@BB@@@@BB@@@@@@@@@@B@FF@@@@@@@BBB@@@@BB@@@BBB@@@@@B@@@@@@@B@B@@@@@@BB@@B@DABA@AD@D@DBB@B@B@BA@@BCBC@CBC@@@AA@AAAA@@AA@AB@AC@A@ABA@@@@@@@A@A@@B@@BF@B@B@B@D@B@B@@AB@@@B@BBB@F@F@@@B@D@@ABCBC@C@C@A@@@@D@B@BAB@BA@A@@@@BB@@B@BE@ABA@A@CBA@CBA@ABA@A@@@@@@@@ABA@A@IBC@A@A@@@AAAA@@@@@A@C@AAA@@DABA@AB@BABA@AB@@@BABAB@@@D@BA@@B@@A@@B@@A@@@AB@BA@CBA@C@@BABAA@@@AB@@A@@@@@@@@@@@A@@@@A@@A@@@A@@A@@A@A@AAC@AA@@@@A@A@@@@@@@@@@@A@@@@@@@@@A@@@A@@@@B@@@AA@A@@@@@@@A@@BA@@@@@AB@@@@@@A@ABA@@@@@@@@@A@@@@@CD@@@@@@@@A@@@E@AB@@A@@@@@A@@@@@@BA@@@@B@@A@@@@@@@@@A@@@@B@@@@A@@@@@@@A@AB@@AA@@@@A@@@AB@@A@@@A@@@@@@@@@AA@@C@@@AAAA@@@AA@@CCA@@@@@@@@@@AA@@BAB@FAB@@AB@D@@@BA@@@A@@AAAAA@@E@G@@BA@ABABCBA@A@A@@@A@A@@AACC@@@@F@FABAB@@@@@BB@BB@@@@A@AB@@@@@@@B@BDB@B@B@B@@@@@BA@@@@@A@@@AB@AA@@@@@A@A@A@A@@@@@@@A@@@A@@@@A@@AA@@A@@A@@@@A@@AAAAA@CA@AA@ADAF@@A@@@@A@C@A@@@@@@A@AB@@@@@AA@ECAAAA@AAA@A@@@@@A@@@@C@@@@AA@@@@@@A@A@@@@A@A@A@@BC@@B@@@@A@ABA@@@A@C@A@C@A@AA@AAA@AC@AAA@IBC@@@CAA@@A@@@@@@BAB@DABA@ABC@A@A@A@C@CAA@A@@BABA@A@@@CAC@CAAAACA@A@@AA@A@AA@AAAAA@@@BA@@@AAACE@AAAAA@AAAA@@@@@BABA@A@AAC@CAAAA@AA@@@@AB@@@AAAAAAAA@@@@A@@BA@A@@@CA@A@@AB@B@BA@@B@BCD@BA@A@@@A@@AB@@@BC@@@A@@@AA@@@@A@A@@A@C@@@CA@@@@@AD@@A@@@A@A@@AA@@A@@BA@@@@@@@AA@@ABAB@@A@@@@@@@@A@@BE@A@@@A@@AA@@A@@@@@@AB@@@B@@@@ACA@@A@AAAA@@@BA@@@AA@@B@@AB@BA@@@A@@@@C@@@A@@A@@@@A@AA@@@@B@@@@A@@@@@ACC@@@@DA@A@@AA@A@@@CBAB@@ABC@@@AA@AA@@C@A@C@A@EAA@C@@@AAGE@@A@A@@@CBCD@@AA@@A@@@@BA@@@@@@BA@@@AA@AA@AACC@@@A@@B@DC@@@C@A@AB@B@B@B@@AB@@A@@AAAA@@@A@A@ABC@@BCBA@AA@@AAEB@@AB@@@@A@@@@AAA@A@C@A@@@@A@@BCBAB@BA@@@@@ACA@A@@@@@AB@@A@@AA@@@A@A@ABADC@@@@@AC@@@@A@@BA@@B@@@B@@A@A@@BAB@@@B@@@BB@@@@@BB@@B@AFABA@@BCBA@@BA@A@C@@AAAACA@AA@@@@BA@A@@AA@CBA@@@ABABAB@@CAA@@@AA@@@@AAA@A@A@@@A@A@A@@@A@@@A@@@@BA@CAABA@A@@@@BA@ABA@@@A@@BA@ABA@@@A@@@A@A@@@@A@@@@A@ABA@@BA@@AABAA@@@BA@A@ABA@@@A@C@A@AA@@@@AB@@A@A@A@CCAAAAAAAC@@@C@C@AAAAA@AAA@A@@CA@AA@AAAAA@@@AAAAAA@@AA@@@A@A@@@@AA@@@@@A@@@AA@A@A@@AC@@@@@@@@A@@@@A@A@A@@@@A@@AB@@AAA@@@@AA@C@AA@@ABA@@AA@@@@@A@AA@AA@@AA@@@@@@@AA@@@A@@AA@@@ABA@@@ABA@ABCBA@@B@BAB@BA@A@@@A@ACCC@CC@@@ABA@AEACACACAACAEC@@AC@A@@@ABA@@BCABA@ADBBA@AAA@AA@BCB@@AAA@@CA@@@@@AA@BACADGBAFEB@@AB@@@@@BCDB@E@@@AB@B@BCB@BA@CIAEABCC@@BA@A@A@CAA@AC@A"],encodeOffsets:[[110437,36632]]}},{type:"Feature",id:"621023",properties:{name:"华池县",cp:[107.990062,36.461306],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@AA@@@@@@@A@A@@@@@@@A@@@@AA@A@@AA@@@@AA@A@@@@@@EEA@@@@@@@@@@@AA@@@@AA@@@@A@@A@@@@@@A@@@@@@@@@@@A@@@A@@A@@@@@@@@@@@@@@@AA@@@@@@@@AA@AA@@AA@AAA@@@@@@@AAA@@@@@A@@BA@@@@A@@@@A@@@@ABC@ABC@AB@@A@C@C@EBA@AA@B@@BB@@AB@BCF@B@@@B@@ABA@@BA@ABAB@@@BAB@B@@AFABAB@@@BABAFABAD@@@@E@A@@BA@A@AB@@AB@BA@@@@B@@@@AD@@AB@BAB@@@B@@@B@@CDAB@@A@CC@AA@@C@@AAAAAAAAAAA@@@CBCA@@@@AB@@@BA@ABAB@BA@@BABA@C@@B@BA@@BA@@@@B@@@B@@A@@BA@@@AB@@CB@@@B@BA@@@A@@@A@@@@B@B@BBB@@@@AB@@CA@@A@@@@@A@@@@@@@@B@@A@A@@@AB@@@@ABA@@@BB@@A@@@@@A@@@@@@BA@@@@@A@@@BB@@AB@@C@@@@BA@C@@@@@@@AE@@@@AA@@@@A@@@@B@@AB@@@@AA@A@@@@@A@@@@AB@@@@@AA@@@@@@@@@BA@@@@@@A@@@A@@A@@@@@@ACB@@@@@AAB@@A@@B@@@@@@A@@AA@@A@@@A@@@A@@@@@@@A@@@@@@@@@A@@@A@@@@@@@A@@@@@@@@@@A@@@A@@@@@@A@@@@@@@@BA@@AA@@@@@@@@@@BA@@@@@@@@B@@@@@@@@@@@@@@@@AA@@@@A@@@@@@@@@AB@@@@@@@@AA@@@@@@@@A@@@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@A@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@A@B@@B@@A@@@@@@@@@@@@@@@@B@@@@@BA@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@A@@@@@AA@@@@@@A@@@@@@@A@@B@@AB@@@BA@@@@@@@AB@@@BA@@@@B@@AB@@@BA@@@@@@@@@@@B@@BBB@@@@B@@@@B@@B@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@AB@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@B@@@@@B@@@@@B@@@@@B@@@@@@@@@B@@@@@@@@@BB@@@@@@@@B@@@@@B@@@@@@@@A@@B@@@@@@@@A@@@@@@B@@@@@@A@@@@B@@@@@@@B@@@@@@@BA@@@@@@B@@@@@@@B@@A@@@@B@@@@@@@@@B@@BB@@@@@@@@@B@@@@@@AB@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@AB@@@@@BA@@BAB@@@@A@@@AAA@@@@@@@@@@@A@A@@@AB@@@@@@A@A@@@A@@@A@@@@@@@@AA@@A@@@A@@@@@A@@@@@A@@@AB@BAB@@@A@@A@@@@@A@@@@@@@@@@A@@BAB@@@@@@@@@A@@@@@AA@@@@@@@@@@BA@@@@@@A@@A@@@@@@@@@@@@@AB@@@@@B@@@@@B@@@@@BAB@@@@@@@B@@@@@@@@@@@@@@A@@BA@@@@@@B@@B@@B@@B@B@@BB@@B@@@@CB@@@@@@A@ABABGBA@@@@A@A@A@A@@AA@@@@@@AA@@AA@AA@@AAA@@@@@@@@@@@B@@A@@@@@A@@BA@@DA@@BABAB@@@BA@@BA@@B@@@@@BA@@@@BAB@@A@@B@@@BBD@B@B@B@B@BAB@@ABA@@BC@@B@A@@@@AA@@@@@@@@@AA@@@@@@@@@@@@@AA@@@@@@A@@@@@@@@@A@A@@@@@A@@A@A@@@@C@AA@A@@@@@A@@@@A@@@A@@@@C@@BA@@AA@@@@A@@@BA@@A@@@BA@@BA@A@@B@B@@@B@@A@@@AA@@@@A@ABC@A@ABA@A@@AAAAAA@@@@@ABCBA@@@@@@A@@A@@BAB@BA@@@A@@@CA@@AAA@@@A@@D@DAD@B@@@B@DBJ@DB@@B@B@B@DA@@@@@A@@@AAA@@@A@@AA@AAA@A@@AA@A@@A@A@@ACCA@A@IACAC@A@@AA@@AAAA@A@@@A@A@A@CB@@AAAB@AA@A@@@@@A@A@@A@@@@AA@@A@A@@@EBA@ABABA@@B@@A@@@A@A@A@@@AB@@C@@AA@@EBA@A@@@A@A@@@A@@AA@@AB@@AB@@A@@@@AB@@C@@@A@@@@@@A@AAA@@BA@@@@B@D@@@BA@@@A@E@A@A@@@BA@@A@@@@@A@@@@@C@AA@@@A@@@@@AD@@@@@@AA@@A@A@@@A@@@@@@AE@@BABABA@@DCB@@A@@A@AAAAAA@@@@A@@BBB@@ABA@@BCBABA@A@@@@@@@@B@@@B@BAB@@C@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@AA@@@@@@@@@@@@@@AA@@@@@@@@@@A@@@@@@@@@@A@@A@@A@@@@@A@@@@@A@@@@@@@@@@A@@@@@AB@@A@@@@@A@@BA@@@AB@@A@@@@BA@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@B@@@@A@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@A@@@@@@BAB@@@@ABA@@@A@A@@@A@@@@@A@@@@A@@B@@A@@BA@@@A@@@@@@@@A@@A@@@@@@A@@A@@A@@@A@@A@@@@@@@@@A@@@@@A@@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@A@@@@@@@A@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@BA@@@@@@@@@@@A@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@A@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@AB@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@@B@@A@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@ABAB@@@@@@AB@@@@@@@@@@@BA@@@@@@@@@A@@@@@@B@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@B@@@@@B@B@@B@@@@@@B@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@B@@@B@B@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@AB@@@@@B@@A@@@@@@@@@A@@@@@@@A@@BA@@@@B@@AB@BA@@B@@@B@@@@@@@@@@AA@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@BA@@@@@@@A@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@BB@@@BB@@B@@BBB@@@@@@B@@A@A@E@@@@B@@B@@B@BB@@@B@@@BB@@B@@@BBB@B@@@B@@@BBB@@@D@BB@@B@@@BBDD@@@B@@@@@@@@@B@@B@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@B@@@B@@A@@B@@AB@@@@@B@@@@BB@@@@@@@@BB@@@BB@@@@B@@@@@@@BA@@@@@@@@B@@@@B@@@@@B@BA@@@@@@@B@@@@@@@BA@@@AB@@A@@BA@@BA@@BA@@@A@AB@@@@A@AB@@@@@@A@@@@@@B@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@B@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@BA@@@@@A@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@A@@@@B@@@@@@@@@@@@A@@@@B@@B@B@DBB@@@B@AD@BBB@B@BBB@@BB@@B@B@BB@@A@AB@@C@CB@@AB@@CBC@A@C@A@CAA@@@@@@B@@@@@@AB@@A@A@AB@@CAAB@@@@ADCD@B@@@@ABADA@CA@@A@A@A@@BAB@@A@A@@BA@@BA@CBA@A@@@@@@@BB@@BD@@@BBD@@ABCB@@ABABCB@@@B@@@B@@CBAB@BAD@D@DA@@@@@@@@@@BA@A@@B@@@B@@@@AB@@@@AB@@A@@BA@A@@BA@@@A@@BA@A@@@A@@@A@@@A@A@@B@B@@@BBB@BB@BB@@@B@@D@@@BB@B@B@B@BDB@BBB@@@B@@@@@BB@@@BDB@@BABB@@@@BB@@@@D@D@BBB@BB@@@BBB@B@@@@B@@@B@D@D@@@BB@@@BA@@B@@@BB@@@BAB@@@@BBB@B@B@BAB@@@@B@B@@@B@B@BAB@@@BAB@BAB@BCBAB@BA@@B@@@@@BA@@B@B@@@@ABBBAB@@@B@@@BAB@BB@B@B@BB@@B@@B@B@D@D@B@B@D@D@@BB@BBB@@@@BBAB@@@@A@ECA@AA@@A@A@A@@@@A@A@@A@@B@@@B@@@@@@B@@B@@@@@B@@@B@@AB@@@@@B@@@B@@@BA@@B@@@@@@@BB@@B@B@@@B@@@BAB@B@@A@@B@@AB@@A@@B@@CDAB@B@@@FBB@@@@@B@DAB@BADAB@BCD@@@B@@D@@@BB@@@@@BABAD@@ABAB@@A@@@A@C@A@@A@@@A@A@@A@AA@@CBA@@@AA@@A@A@C@@@A@@@AB@B@@@B@@B@@D@@@B@B@B@@@B@@@@@B@@A@@BB@@B@@BD@@@D@@@@DBB@BBB@FAB@@BB@BAAAD@BBB@@@@@FBDFB@BBBBB@@@@@B@@ABA@@FAB@@AB@@A@A@A@@B@BA@@BBFB@BBA@BB@@@A@@B@@@BBB@B@B@@BD@BAB@BA@A@A@@@A@A@@@@B@BB@@B@@@@C@@B@@@B@@BB@BB@BDBB@BD@DBBBB@DAB@@@BB@B@@@@@@AB@@A@@B@@@BAB@@@@@@BBBB@B@H@@BB@B@@AB@@@@@BBBA@@B@B@@@@@BB@D@D@@@BBB@@A@@@A@A@@@@D@B@@@@@B@@BBADB@@@@@A@@D@@@@@@@BB@B@B@@@B@@F@@A@@B@@ED@B@BCB@@@DBD@@B@@@BB@@@B@B@BDDB@@BB@@@D@B@@@D@B@@BD@@B@@BB@@A@@D@B@B@@@BA@A@@@A@AF@B@@@@@@@@B@@@B@@DBDBB@@@@@@BAB@@BB@@AB@@@B@@@@AA@@CCA@@@@@A@BD@B@F@B@@@BABABA@BBA@@B@@@@B@B@D@@@@B@BADA@BJ@B@@@B@@DB@@@@@B@BAB@@@D@D@B@B@@@@A@@B@@AB@@A@CAA@AA@@AAA@@BA@ABA@GBA@@@A@@A@AAE@C@AA@@@AB@BABCBCBABABA@@@C@A@@@A@ABCDA@ABA@A@A@@@@@@@@AAB@@@F@BABAD@@BB@@F@@@@@@@BA@@@@B@@@BD@BBB@@@B@BA@@@@BB@BAB@B@B@BB@@B@BB@D@@DBB@B@B@B@B@B@BABABA@AB@@B@A@@B@@@@@@@@@@BA@@BAD@B@F@B@B@@B@BB@@B@@@BB@@@@AD@@@BA@AB@BA@@@A@@AAA@@@@AB@@@@@@A@A@@@@@@BBBB@A@@@ABA@A@@BA@@B@@C@CB@BA@@@ACAA@@ABA@ADADAB@@C@ABA@@BA@AACAAAAA@@CA@@AA@@A@@@GB@@AD@@A@AB@@A@@B@@@B@DA@@BA@A@@@@A@@@AAA@@A@@@A@ABE@AB@@E@A@A@@@@@A@@B@B@BAB@@@BAD@BABADAD@B@B@@AD@D@B@B@B@@@@@B@B@@AD@@@BA@@B@B@B@@@BB@DABB@@@@@BABAF@BAD@F@@@@FF@@@@@@AB@@A@A@@BAB@@ABABCD@@C@A@A@E@A@@BA@A@@@@@DD@@@@@BA@CBABCD@@@@E@@@@@@A@ABA@C@@@AAAAAA@A@A@@B@@@BABAB@@A@@BAB@@@@@DB@BD@B@@@@@@ABEBABEB@B@@@@@B@B@B@@@B@@@BAB@D@B@D@@@B@@BBFFDD@@@B@@@BB@@A@@@@@@B@@@@@@@@B@@BBBBDB@@@B@B@@@@BBB@@@BB@@@@AB@@@BBB@B@BBBBD@@B@B@@B@B@B@@B@BBBB@@@@@@B@@A@@BB@@D@BBB@@@BD@@BB@D@@@@@@@@BAB@@B@@@@@@@B@@AB@@@B@BB@@BD@B@BB@@@@@@@BA@BB@B@@AB@@ABG@A@@@AB@B@B@BABAB@@@B@@@BBB@B@@AB@@BB@B@B@@@@A@A@A@@@A@@B@@A@@AA@@@AB@@@@ABADA@@B@@AB@BABCB@@AB@@@B@BA@A@AB@@AB@BAB@@CBE@C@A@@@@@@@AB@@AB@BA@BDA@@BABAB@@A@@DABA@@BAB@@A@@@@@@D@B@B@B@BB@B@@@@B@@AB@@@@@@BB@B@@A@ABABA@CBA@@@CA@@@BA@AF@B@BA@@B@@@B@@F@B@@@B@BBBB@@@@@B@B@B@BAB@BA@@BA@C@@AA@@@AA@@A@ABCB@@ABABA@@@AACA@@CAA@A@CBA@A@A@G@A@A@A@@@@@ABA@@B@B@@@BA@@BBB@@@B@@A@@@@@AA@AAA@A@@A@A@@@@@@C@@A@@AA@@C@@AA@@ACAA@@A@AA@@AC@C@ABA@A@AB@@ABA@@@A@AA@@AAACAC@A@@@@A@AAA@AAA@@AACA@@A@ABA@A@A@ABA@@@A@AB@@BBAB@@BB@B@B@BB@@BAD@B@D@BA@AB@@A@@B@@@B@BBBBB@@@BA@@@@BABAB@@AD@B@D@@@D@B@B@@ABABABAB@BAD@BAB@B@@A@@@@A@@A@@@@@AB@@ABABADABA@AB@@AD@B@@@BABAB@@AD@@@@A@C@A@@B@@@@@B@@AD@@ABA@@B@D@BAHBB@B@BBBBBBBBBBB@@B@BA@@B@@@@@B@AB@@@BAB@B@@@BABAB@BA@A@@BABA@ABA@ABAB@@C@AA@@A@@@ABABA@CB@BA@A@ABCD@@C@@BABC@CDCBCB@BB@@BBB@@BB@@BD@B@@DBBB@@@@B@DE@@B@@@@B@BAB@@BBAD@F@B@@B@DAD@@@B@HCB@BBB@B@B@B@DAF@F@JEDABEPaDADA@@B@H@B@@@@@F@D@ZTF@JBL@PAB@B@BA@@FAB@@@BA@@@@@@BAB@@AB@@AB@BA@ABC@AB@B@@@BAD@B@BAB@B@FA@@B@B@@@BAB@@@B@B@BADA@@B@B@@AB@B@B@@@B@@A@@@ABA@@@@@A@AB@@@@B@@@@@B@@@B@@B@@B@@B@B@B@@@BBB@B@@@B@@@B@B@BBB@DB@@B@B@@BBB@@@@D@DB@@BBB@BB@@@@B@BBB@@@@@@@B@@@@@BB@@B@@@@@@@BB@@@@B@BB@@B@@@BBB@@@BB@@BBB@B@@@B@@@@@B@@@@@B@BA@@@ADABA@@`MLKFAFCJOB@B@BBB@B@D@B@DCBABA@@B@@BB@BFB@B@B@D@@@B@@B@B@D@B@@@D@@@@B@B@BAB@DBD@BAB@@@F@B@BAB@@A@A@EB@@AB@@@BB@@@B@@B@@@@@@ABA@@B@@@BB@@B@@@@A@@BA@AB@BA@@B@@A@@@BDEDADABADA@@@ABA@@BA@@@@BFBDBBB@F@BA@ABGBE@@BAB@B@BAB@D@BA@@BA@@BBBAB@@@B@@@BB@@BA@@@@@@@BBB@@@@@@DC@@@@B@@@@@@A@@@AB@@AB@@AD@BA@ABB@@BABA@@B@@@B@D@B@@@@@BBB@D@@@B@BA@@@@@@B@BA@@@@B@@@B@@@BAB@BBB@@DBB@BB@BF@@BBB@B@BBB@@AB@@A@@B@@@@@B@@ABA@@BA@@BA@@BA@@B@@B@@BA@@@@@B@@@@@@D@@@BADE@CBA@A@@@A@@B@@@BAB@@BBA@@@B@@B@@A@@@ABAB@@B@@@@@@@@@@B@@B@@B@@AB@@@@BB@B@@@BA@@@A@@B@@@@@B@@@@ABB@@B@@@@@@@B@@@@@@ADA@@B@@@@@B@@@@C@@BC@@AA@@AAA@@@@@@@BA@@BA@E@AB@B@@@BB@@B@@@@@B@@@@@BB@@@@@@BAB@B@@AB@BB@A@@FMBAB@@@B@B@@@@BB@@@F@DBD@DBB@BC@AB@B@@@B@@@BBB@DB@BB@D@@@@B@BB@@@@@@ABA@@ACAC@C@G@@BCB@BAFEBEBABC@C@C@C@C@@@@@@B@FA@@DA@AD@BA@A@C@@BC@@BADA@B@@BAB@BA@@BBB@@B@@@@B@@A@ABA@@DEDEBGDGBEAE@CBE@C@ACC@A@CAC@A@A@ADC@@@@@ACC@@@A@A@@BA@AAA@@AC@@AA@@BA@@BC@@B@BBBBBD@@B@@@BA@C@@@AB@@A@AB@DFBB@D@@BB@@D@@@BD@BB@@@B@@@BA@CB@@@BBDB@@@DB@B@@@@@DCBC@@B@@@@@BB@@BBF@BDBBBBB@BA@@BA@A@C@C@ABAB@@ABCBA@AD@BAB@B@@@@B@F@BBB@DBD@@CFAD@B@@B@BB@@@@@B@@AD@B@D@B@@B@B@B@@@@B@BB@@@BAB@B@HD@@@BB@@@BAB@B@@@@@@B@B@BB@@@DBBB@@F@B@BA@@B@BAB@DABA@@@A@@@A@@@AACBABAFADAB@BAB@BCBE@GAAE@AA@A@@BCBA@E@C@CAA@@IAAA@AA@@@C@@@@BA@@@@@A@@AA@AB@@A@@@CDAB@@AACCAC@C@CBC@ADA@@DBB@HCB@BC@ABA@CAA@@AAA@C@A@A@AAA@@@@A@@DA@A@E@A@CBA@@DD@@B@@@@@FC@@DBBDBB@B@@DBB@B@BADAB@HAB@BA@AAC@G@EBABAB@BBB@BABA@@B@DDD@@@BA@A@@AA@ADABCBG@@B@HBD@F@DADEBAB@BFFF@FDJBBB@D@@@DEBCB@B@B@BB@B@DBBBB@@@BAHBB@BBBB@DABIBA@@FAF@DC@@BACEAGBUZBTCTIB@FARCBCDW@CLCJ@XFDBDD@@HLHJNZDLFR@BHTHTFJNHNBNCJC@@AA@@AA@@AA@@@@BA@@@@@AA@@@@@A@@@@A@AACAA@@A@A@@AA@@A@@@A@@@A@@A@@@@@A@@@@AB@@A@@AA@@@AA@@AAAAA@ACA@@A@@A@@A@@@@A@@A@@@@A@@B@@@@@B@@AA@AC@@@A@@A@BABCAEFI@EFULKNCBB^BH@@@@AB@@@BBBAFIAE@CDCTSDABBB^HFFBLGFAFADCDEDGDAHBFFBDHDJBJ@@@lC@@P@PEXQJMDK@AAQAEBCBCFAHCF@NSD@JBDADYJM@@@AAG@@BAD@HABA@GBKBK@A@C@AIICE@CBC@@BAD@FBB@FD@@B@L@B@JKHEHGD@HCJAD@J@DAD@BAB@@@@A@@@@@@@@@A@@@AB@@@@A@@B@@A@@BA@@@@@AB@@@@@BAFAF@F@DBDBFFJDF@B@B@B@HGHEB@B@BBDDDDHJFHDFDHFFAHINCL@H@FBB@BBB@B@@@BB@FCBAB@@AHCB@@ABA@@B@B@@AJG@@BABABADGTGNCJAHKJEF@@AAC@AACAE@A@@B@@A@@@A@@@@AAA@@A@A@@A@AAAC@GAE@C@C@A@@@@@AA@@@@A@E@@@@@@@A@@@@EAA@AAA@ABA@@@A@@@ACC@@A@@@@@ABA@A@@AA@@BAAA@A@ACA@AAACEAC@@BG@A@ABAAA@A@GA@@AAAAA@AACBA@G@C@CAC@@AACA@AAA@@@C@@@A@CA@@A@CBCBE@A@A@E@A@@@@AA@A@@AAAC@C@AAAAAAAAA@@@A@AA@@A@@@CBC@A@@A@A@@A@@@AAE@A@C@C@AAA@@A@@@@@@@@@@A@A@@@AC@CAA@@@AAAA@AAA@A@A@ABA@@@AA@A@A@@@A@@A@@@@AB@@A@@@AA@ACC@A@A@A@@AAAA@A@AAA@@A@A@@@@@A@BA@ABABC@ABE@C@IAAAC@@@A@E@@@AA@A@AAAAA@@@C@AA@@AAAA@AAA@A@@@@@@A@AA@BA@@@AB@@A@@@@A@AC@A@@@@@ABAB@@AA@E@A@A@@@@C@@@A@@@@@A@@A@@A@@AA@@@ABGBA@@AA@AAA@@@A@@@A@A@@@A@@@A@E@A@@@AA@@BC@@@A@@A@A@@@@@AAAA@@A@A@@AA@CA@@CAA@C@AC@@A@AAACAAA@A@@@ACA@@A@A@A@@@AA@@AA@@AB@AA@@@ACA@AACAA@@@A@C@C@@@@@C@@A@A@@A@@AA@E@CCCCCACCAA@@@@@A@C@C@@@@AAA@@@@A@AB@@A@@AA@ACA@@@A@@AAAAA@@@C@AECAC@@BA@A@C@A@@CA@A@@@A@A@C@ACA@@@A@A@@BA@@@A@CAA@CAA@@@@A@AAA@@@AA@AA@A@A@@@CCCAA@A@@@@@CDA@G@@@C@@AA@@@ABA@A@A@AAAAA@@@A@EB@@A@A@@@@@AA@C@@@A@@AACA@@ABA@@@@@CA@@AC@AA@AA@C@E@CAA@@A@@AAA@@@AA@@@A@ABCBABAB@B@@@BAD@@@BA@@@AAA@AACCAAA@C@C@AAA@@B@@A@AFADABAB@B@B@B@B@B@B@B@F@B@@A@AB@@A@GBA@CAA@A@@@@BABABCBAD@@@@AD@@A@@@A@EAE@A@A@KF@@@@@B@FB@@@@@@@@BE@@BA@@@@B@BA@A@ABA@ABAB@BA@A@C@A@A@@@C@A@@@@@@B@F@B@@A@A@@BEA@BA@@DAB@DAB@BAB@F@DAB@BAB@@A@CBA@ABA@ABAD@B@B@B@B@B@D@BABAB@FCD@BA@E@A@AAAA@@A@@@A@A@@@A@A@ABEBCBA@@@@B@BBD@B@@ABABA@A@@BCB@BAB@BCB@BA@A@@@A@A@@@A@@AAC@@@@A@@AA@ABABEBCB@@ABCFCB@@A@ABA@A@A@AAA@@@AB@@A@@@@CAACCCE@@AAA@@AAA@@@A@A@@AAAA@@@@@G@@@A@@@AEA@@@@BAAAAEACAAAAA@A@AAE@@@AAACACAAACAAAE@A@A@A@@@@@@@AAA@A@AB@@A@@@A@ACCAA@AA@@A@@CA@C@A@@@A@A@@@A@A@A@C@@@@AA@@@@@@@A@@AA@@@BC@ABAB@@A@C@C@A@I@G@A@A@@@AA@@@A@A@@@A@A@@AB@@A@A@C@EB@@EA@@A@@@AA@@@AA@A@A@AACC@@@@AC@A@A@@AAACA@A@@@A@@@A@A@A@A@CDC@@AAA@@A@AAC@CAEAEAAACA@AAAA@@AAAAAA@A@@@E@E@A@AAA@@@A@@BA@A@C@A@A@A@E@@AA@@@@B@B@@@@@@AB@B@BBDA@@BBB@@BBBBB@@B@@AD@DADAD@@ABA@A@A@CAC@C@@BABCBA@"],encodeOffsets:[[110640,37029]]}},{type:"Feature",id:"621022",properties:{name:"环县",cp:[107.308501,36.568434],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@HBB@BBBB@@@D@D@@@B@@@@@B@B@@@B@BADAB@@@@@BD@D@B@BB@D@@@@@@A@A@E@A@A@@@A@@@A@A@A@AA@C@A@CA@@@A@I@A@EAA@AAAA@C@@@@@@A@@@EA@@A@ABA@@@@A@@@GDCBA@@@@@AA@@AAA@A@@@@D@B@@@D@BAD@@BB@@BDBB@BBBBBBB@@AB@B@D@B"],["@@@@B@DAFADADABABABADBB@D@@@BCBEBC@ABCB@DBDBFDDBF@FBD@BBB@BBDADABABGBG@CBCBAD@DBBB@@DBBDBDBDBD@BBB@BBBB@DDBB@BB@BB@ABEDCBAFEBABAB@B@BA@@B@BBBBB@DBB@B@BB@@D@BABAD@D@DBF@B@B@F@B@BB@@B@@AAAAC@C@AACBGLGBAB@BB@DB@@@@@@A@CAABCBAB@JBF@DABABC@AAAAABGBADEDCFCBAB@BAB@@@@F@B@D@DBDBFBBBB@@CBCBABCBAB@BABDD@@@BDDDDDBBB@@DHDDFDBD@DCFAFADCDAD@@@DBBBB@BAB@B@@D@BBBD@FBD@DD@BB@B@@B@@A@ABA@A@A@AD@BBBBB@@BBBA@ABCDA@AB@BA@@@@@A@@@@BB@B@@@B@A@A@@B@@@@B@B@B@@@B@@@@BB@DADA@A@@B@BB@@DAB@@@BABA@ABA@AB@DADAFCFCD@D@F@F@DA@@@@DADABCBABCDCDA@ABABAD@BAHE@AB@@B@BB@@@BAFCJGHEDCDCB@BA@@D@D@D@BBD@D@B@DBB@B@BAB@JGHEDEHEFEBAB@B@D@BB@BAB@B@B@BBB@@BBDBBBB@BBDBDAD@D@B@@BB@@DABCBCB@D@B@D@D@BB@D@B@F@B@B@B@@@BB@@ABABAFAB@B@B@BCBE@C@A@AAA@CBCBCDCBC@ABABA@@B@BBBB@DBB@DBBB@B@D@BBB@@D@B@BBB@BBBADADADAD@BAD@D@D@B@BAFAB@BAB@BA@A@@BAD@BAB@@ADADADAB@B@BCBAFADAFEDCBADCBABC@A@A@@@A@CBA@AFADAF@B@DAF@DAFAFAF@D@B@F@B@DAB@DA@@DBBB@@B@@BA@CBABA@@@@BAB@B@@@BABE@@BA@AB@BDBB@@BBBB@B@BAFAB@BAD@BABABABABA@@B@@@B@DD@@B@@@B@B@BAB@DAB@@@B@BA@@B@B@B@@@B@BABAB@@A@@@ABA@@@AB@@BB@@@@@@B@B@B@B@B@@@B@BBB@BABAFAF@BABA@@B@BAD@B@B@BDHBDBDB@@B@@@AB@BA@@BAB@@@@@@@@@@@B@@@@@B@B@@AB@@A@@B@DEDABC@@B@@@B@B@BBB@@@@ABADA@@BAHAFA@AB@B@@@B@B@BBBB@@BB@@B@DBB@B@BBB@B@B@F@BABAD@BAD@B@BA@ABA@@BA@@@@B@@@@A@ABAD@@AB@@@BA@A@@@A@@B@@@B@B@@@BB@@@@BBB@BBBBBBBBBBB@@B@B@@B@@@BBD@@BB@@@B@@@B@BB@@B@@@@@B@BA@BH@@@B@BB@@BB@@@BB@@@B@@@B@@@@@@@BB@BB@@@B@@@DB@@@@@@@BB@B@BBD@BBB@B@F@B@B@DBHBB@D@F@FBD@FBB@D@F@B@DBDB@@D@DBB@BBBA@AB@@A@@@@B@@@@A@@@@BA@@B@@A@@B@@@@AB@@A@@@@BA@@B@@@BA@@B@@@@@BA@@BA@@@@BABA@@@@BA@B@@BA@@@@B@B@@@@@@AB@@@@@BA@@@@@@@AB@@A@@@@B@B@@A@@B@@@B@@@@ABABA@@@@BA@@BABA@@B@@@@A@@@@@@BA@@B@@A@@B@@A@AB@@@@@@@B@@@@@B@@@@A@@B@@A@@@@BA@B@@B@@A@@@@B@@@@@B@@@@@@@@A@@@AB@@@@@@@@@@@@@B@B@@A@@@@@@BA@@@@@@@@@AB@@@@@B@@@@A@@@@@@BA@A@@B@@@@@B@@@A@@A@@@AB@@@@@@@@@@@@@B@@@@@@AB@@A@@@@B@@@@A@@@A@@@A@@B@@@BBD@BBBBB@BBDFDB@@@BDBB@D@DAFAJCHAHC@@@@@A@@B@@AB@@AB@@AB@@@BAB@@A@A@A@AAAB@@AB@@A@@@@@A@@@@@A@@B@@A@@@@@A@CBA@ABABA@C@A@@CAAAA@@AAABABABAB@BABABA@@@A@@B@@A@A@@@@@A@@BA@@@A@@@@@AB@@A@@BA@@BA@@@@A@@@AA@@@@@@@A@@@A@@@A@@@AA@@A@@@@AA@@@AAA@@@@AC@A@@@AAA@@@@A@A@@BA@@@@@A@@@A@@@@AA@B@@AB@BAB@B@BAB@@A@A@@@A@A@@@@@@BAB@BA@@@@@@AA@@@AA@@@A@@A@@B@@AB@@@@@A@@AA@@@@A@@@AB@@@BA@@@ABB@@B@@@@A@@@A@@@AA@@A@@@@B@@@B@@A@@BA@@@AA@@A@A@@@@@A@@B@BA@@@@@@@A@@AA@@@A@@@A@@BA@@BA@@@@@@@@@A@@@@A@@A@@BA@@BA@@B@@@@@@A@@@AA@@@@@A@@@@A@A@@@@@@@A@@@A@@B@BA@@@@@@@@A@@@A@@@@@A@@@@@@@@@@A@@@A@@@@BA@@@@@@@@@A@@@@@@@@B@@@@@@@@@@AA@@@@@A@@@@@@A@@@@@@@AB@@A@@@@BA@@B@@A@@@@@@@@@A@@@@@AA@@@@@@@@@@@@@@A@@@@@AB@@@@A@@@@@A@@@@@@BAB@@@@@@AA@@@@@@@@A@@@@@@@@@A@@@@@@B@@AB@@@@A@@@@B@@A@@@@@@@A@@@@@@@@@@@AB@@@@@@@@@@@@@AA@@@@@@@@@@@@B@@@@@@A@@@@@@BB@@@@@@@@B@@@@AA@@@@A@@@@@@@@@@@@@@B@@@B@@@@A@@@@@@@A@@@A@@@A@@@@@A@@@@A@@@@@@@@@@@AB@A@@@@@@@@@@@A@@@A@@@@@@B@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@A@@@A@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@A@@@@B@@@@@@@@@@A@@A@@@@@@@A@@@@@@A@@@@B@@@B@@@@@@A@@@@@@A@@@@@@@@A@@@@@@@@BA@@@@@@B@@@@@B@@@@@@@@@@@@@@A@@@@@A@@@@@A@@@@@@@@B@@@@A@@@@@@@@@@A@@A@@@@A@@@@@AA@@@@B@@@@@BA@@@@@@@@BA@@@@@@@A@@A@@A@@@@A@@@@@@A@@@@BA@@@@@@@@B@@A@@@@@A@@@@@@@@@@@@@@@@A@@@A@@@@@@@A@@A@@@@@@AA@@@@@A@@@@@A@@A@@A@@@@@A@@A@@@@@@@A@@@@@@@@@@A@@@@AA@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@AA@@A@@A@@A@@A@@@A@@AA@@@B@DAD@HADABEBSBAD@D@BB^RDDFBHBFCDAFCZSDEFCHAJA@@AJAJ@HBJ@H@LCFCHCJBJBF@FDJDDBLDZDVGPQDENI@AB@@@ZSDALINGBGAGEGCCG@DEFCIFE@E@CBA@A@A@A@AAA@GDA@@@C@CBA@@@@A@EBCAA@@BA@A@A@@A@@@CFA@@@@@AACA@@@AAC@@AA@@AA@AA@@ADADADCD@BA@AD@@@DCBAB@B@@ADAB@BABA@@B@@@BBD@@@BABAB@BAB@BA@AB@B@@ABABA@A@@@ABA@A@@BAA@@@@@A@@@ABA@@@AAAAAAAAAA@A@AAABG@A@C@AB@BA@@BC@@@A@@@@@AB@D@B@@@@@BC@@BABA@A@@@ABC@@BAB@BABCBABA@@BA@@@@B@@@@B@@B@@@@ABA@ABC@ABABABABA@@@A@A@C@@@C@ABC@@BABA@A@@B@@A@@AAAA@A@A@@@AB@@@BAB@@A@C@ABC@AA@@A@A@AAA@@BAAA@@BAB@@@B@BAB@B@B@BAB@@@DBBB@@BB@BBB@B@B@@B@D@DBBB@BB@@B@B@@AB@BA@@B@BAB@B@DBD@@BBB@@@BBBD@@BB@@@DB@@BB@@@@D@@@@B@B@@@@BBB@BBB@@@@B@@@@A@@AA@AB@@A@@@A@AB@BA@@@@B@B@B@H@B@B@B@DAB@B@DB@@DBBB@@B@BABA@@DABAB@@@BB@@B@@BD@B@@AB@@ABA@A@A@A@A@@@@AAAAA@@@A@E@@@@A@@@AB@@A@ABEB@@A@@DB@@B@DAB@BABAB@@@@AAA@@@@@@BA@@@A@@A@A@@A@A@A@A@C@@@@B@@@BA@AB@BA@CB@@@BABA@AB@ACB@@ABA@@BA@@@@@@B@D@F@DA@@BA@ABA@@BAB@B@@A@A@@BA@@DABA@ABA@@@AB@BCBA@@@@BA@@B@@BB@@@@AB@@@B@B@B@@@@@@A@AAA@@BA@@@AAA@A@@@A@@BABA@A@A@ABA@@B@H@BA@@BA@@@AAAB@@A@@@@@@AAA@C@@AA@@A@A@@BA@@@A@@@@@@@AA@AB@@@@@@@@@CAA@@AC@@A@AAC@@@AA@@@BA@@@@@@@AAAAA@@@@A@A@AA@A@@@ACAA@A@AAA@A@@BA@@@@AA@@A@AA@@@@@A@A@@CAAAAA@@@A@@@@@@A@@@@@@@@BA@@A@@@A@@CCEEAA@@@A@@@C@A@CBA@A@@@A@@@A@A@A@@@@@AFABAFABA@@@@@@@AACA@@C@@@@BA@AB@@@BABA@A@@@AB@B@B@BBBB@B@@@DAB@B@B@@@@F@@@@@DCBADAB@@A@@@@CC@@@@B@B@@AB@F@B@B@D@@@DCBABA@@BA@AB@B@@@BA@@@@@@EE@@@@@EBC@ABEBA@A@@@@AACBA@@A@@@A@A@AB@@A@@BC@@@A@A@@@@@A@A@A@CBC@@@A@ABCBCBA@ABC@A@@BA@A@A@AB@@@@@B@B@F@@@BAF@BAB@@@B@@@BB@B@@@B@@B@B@@AB@@C@A@@@AB@@@BAB@@@BC@@HA@@B@@@BB@@DB@@BBBBDBBBB@@AB@BAD@@@BABCBCB@BA@@BBBD@@B@@ADAD@@@@AB@@AB@B@BA@@B@A@AA@A@@@@B@B@@@@@@@BA@@@@BB@BB@@@B@@ABAB@@A@@BC@@@@AA@@A@@@AAA@@@@A@A@E@ABC@AB@@A@@@@@@@@A@@@@B@AA@@BABABABA@A@A@A@A@A@CA@@@CAAA@@@AAA@A@A@ABA@@A@@B@@A@A@@AA@AAC@@A@@@@@AB@@@@@@E@@@AA@@BCBA@A@E@@BA@B@@@@@@B@B@B@BAB@DCBAB@@@B@D@@@B@BABADADABA@ABA@@B@@B@DBF@B@BB@@@B@HAB@BAB@@AB@BB@@BBB@DBB@@@BA@@@AB@@@@@@A@A@C@C@@BA@A@A@@@@CA@@@A@@@AAIB@BC@A@A@@C@A@A@@@@@@AB@AAB@BABA@A@@@A@E@AACB@@@@@B@DD@@BB@@@@@A@@BA@@AA@@BA@A@@@@A@CACA@@@A@@@A@@@@@@A@E@@B@B@@@BAB@@A@A@C@@@@BA@@AA@@@AC@@@A@C@@@A@C@@AA@@CAACA@A@@@A@@A@@@AC@CA@@A@ADA@C@@FA@@@@BE@@@@A@@@A@AAA@@@@@@C@@@@B@@@@CAAB@AA@@@@@A@C@@@@@@B@B@@@BA@AA@@C@C@A@@A@@@@@A@AB@AA@A@@@@BA@@@AAA@@@G@AAAAA@@@@@@BA@A@@@AB@@@BA@@@@@@@AAA@@A@CBA@AACAC@@AAAACA@@AAA@@@A@@@AD@@@@@@AA@@A@A@@B@B@@@B@B@B@@ABA@AAC@@@A@AAA@A@@@AB@@@A@@AAB@AEAAA@@ABA@@@@B@B@BA@@BA@EB@@AB@BA@@@@@A@AAAAA@CEEA@@@@A@AAC@BBABA@@AA@EBA@AAA@CA@@@@@C@@AC@@@AA@@AB@@@@A@@@@@A@@@A@A@A@@@CA@@@@A@@@ABA@@B@@@D@B@B@@@BB@@B@DA@@BBB@@@@B@B@@@BB@D@B@@@B@@@BABA@@BCBA@A@@@@AA@@C@@@@A@@DC@ABABC@ABA@C@A@@@@AA@E@@@ABADC@@@AB@@@BA@@@AB@@@@ABA@A@@@A@@@A@AA@@A@@@@@@@AB@@A@@@A@@@A@@@@BA@@@A@@@A@@@@@AA@@@@@@@@A@@@AB@@@@B@B@@B@B@B@@@BBB@FDB@@@@@BAAA@@@@AA@AAA@@@C@C@A@A@C@C@A@AA@@@AAA@A@A@@ABA@A@@@A@@BAAABA@@@@@A@AB@@A@@@@@AB@@ABADA@ABA@ABA@A@@BA@A@A@A@@@A@A@@A@ABA@A@A@AA@@@@BA@A@@AA@@A@@@AB@@A@@A@@@C@C@A@@@A@@A@A@AA@@A@@AAA@A@C@C@@A@@A@@A@BA@AA@AC@@A@@A@@@@@A@@AA@ACA@A@A@A@AAA@@C@@@@A@@AAA@@AAA@A@@@AAA@@@AA@@@@AB@@AFC@A@@A@@AA@@AAACC@@AB@@@@A@AB@@@@@@@@A@AB@@AAAAE@@@@@@AA@@@AACB@@A@@@CAA@AAA@@@A@EA@@@@BA@@@CA@@A@@@@@@@A@A@AA@@BA@@A@@BC@AA@@AA@@@AABABABA@A@@A@@@A@ECCAA@CC@@AA@BA@@@A@@@@D@B@B@@@B@@B@@@@@ABCBA@@B@@AF@BABCA@@ADA@@BCD@@A@A@A@A@A@@@@@@@@B@@ABCB@@@A@@@AB@@A@AACA@A@@BABA@@BAB@B@@@@C@@@AAA@@@C@A@A@@@A@@BA@@@C@@@A@ABCBE@@BA@@AAA@@AB@@A@@@@A@@@@@AA@@AA@A@EB@@A@ABA@@@A@@@CA@A@@@A@@@@AA@@EDAB@@@@AEAA@@@@@A@A@@D@B@BBB@@@@@@C@@AAA@A@A@@@A@@@ACAACA@@@@A@ABABAD@@AB@@CA@AAA@@@@@@A@@@@@CC@@@@@@BA@@AA@@@A@@B@@A@@AA@@@@@A@@@@@@A@@@@@AA@@B@@@@A@@AA@@@A@@@@BA@@@@@AA@C@E@@@AB@BC@C@A@@@A@@BA@@@@AA@@@ABA@@@@A@A@@@@@@@@A@@@@A@@@@@ABA@A@@C@@@A@@BA@AB@@A@@@@@@A@@DA@@AA@@A@@@@@A@ABA@ABCB@@@@A@@@AA@@A@@@CBGJA@A@A@@@@@@A@IAA@@@@@@ADA@ABA@A@@@@EA@@@@@A@@@ABAB@@A@C@@@@A@@@@@@CB@@@@@@@A@@@AB@@A@@@@A@@@@@@A@@@A@A@@A@@@CAA@@@A@@@@@A@@@@@A@@@@D@@@B@BBB@@@@@BA@A@@@A@@@@@@A@A@A@@@@@@AB@@@@@@@@AA@A@@@@A@@B@@AB@A@@@AA@@@@@A@@@@B@@@BAB@B@B@@@B@@@@A@@@@A@AA@AAEA@@@A@@BA@A@A@@@A@ABA@A@A@A@A@A@AAA@A@@@CAAB@@A@@B@@@F@@@@A@@@@A@@A@@@@@A@@A@@@@@A@@@A@A@@@A@A@@@@@A@@B@@@@AB@@@@@@A@A@@@A@@B@@@A@@@@@@@A@@BA@@@@A@@@A@A@@@@AA@@@@@@AAA@A@@@@BA@ABABA@@@A@@A@C@A@@@@@A@A@@@@@A@@@A@@@AAA@@@@@@AA@A@@@ABA@@@@@A@@BC@CB@@@@A@@@@@@AA@@@@@A@@BA@@@@@@@CBABA@@@A@AB@@@@A@@@@A@@@AB@@A@@BA@@BA@A@A@@@A@@@@A@C@A@A@AAA@@@@@@@A@@@@@AB@@@@@@A@@@@@A@@@@@@@@@AA@@A@@B@@AB@@@@B@@@@B@@@@@@@@A@@@@@@@@B@@@@@@A@@@@@@B@@@@@@A@@@@@@@@@AA@@@@AA@@@@@@@A@@A@@@@@@A@@@@@@@@A@@@@BA@A@@@AB@@A@@@@B@@@B@@@@BB@@@@@@@B@@@@@BA@@B@@@B@B@@@@@@@B@@@@@@AA@@@@@@A@@@@@A@@B@@@@@B@@@@@@AB@BBD@B@@ABA@@AAA@@@A@@@C@A@@@@A@@@@BA@@@@@@@AA@A@@@@AD@@A@@A@A@@A@@@@DCF@@@BBBA@@@CA@@AA@@@@@A@A@A@@BA@@A@@@@@CAAB@A@@@@BA@A@ABA@AB@AA@@@@A@A@C@A@@AA@A@C@@@A@@@@B@AA@AC@AAA@AA@A@A@A@@@@@AD@B@BAB@B@@AA@A@AAC@@@ACCA@AAAB@@@@@@A@@@@AAC@@@A@@@@C@A@@AA@G@@@@@@@@B@D@D@@@DDH@B@D@@BB@@@B@@@@A@A@A@@@CAC@AACA@@EAAAA@@A@@@@CCAA@AAAAAEC@A@@B@@@BA@GBCBCBA@ABAB@@A@@A@@@A@@@@A@AA@@@@@ADADED@@@@@A@A@A@A@@@C@@@C@A@@BAAA@AAAAA@A@A@@@AA@@A@@@A@@@@AA@@@A@@@CA@@@AA@@AA@@A@A@@AA@@AA@AC@@@C@@@A@@@@@A@@AA@@@A@AA@@@BA@@AA@@@CB@ACAE@@BA@A@@BAB@@A@A@C@@B@@B@@@A@A@@A@AA@A@A@@@AAA@@@C@A@AAAAC@@@A@@@A@AB@B@F@B@@@BA@@BA@A@@BABAA@AA@A@A@A@@@@B@@AB@@A@@@A@@B@@@@@@@@@@@B@@@@B@@B@@@B@@@@@@@B@BB@B@@@@BB@A@@@@@@@A@@A@@A@@@A@A@@AC@@BA@@BA@A@@@@@A@@@@CC@@@AAA@AA@@@@@@@ABA@E@@@A@@@@@@@@A@C@@@A@@@AA@@@AC@C@A@ABC@A@@CC@@@G@@@@@@@@A@@@ACAA@AAC@A@@B@BCDA@ABABA@@@A@AAC@ABA@C@@@A@@BABA@@DC@AB@@AB@@A@@@A@A@A@A@@@@@@@@A@@@A@@@AB@@A@@@@@A@@@A@A@@A@B@@A@@@@@@@@@@@@A@@@@@@@@@A@@A@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@AA@@@@@A@@@@@@@A@@A@@@@@@@@A@@@@A@@@@A@@@@@@@A@@@@@@AAC@@@A@@@C@@AA@@@@A@AAAB@AA@@@@@CAA@AAA@A@@@AA@@A@@@A@@@AAA@AAA@@AAAAAC@AAA@@AAAA@@@@AAAAA@@@A@A@AAA@@@@AC@CAA@AAECAC@@CAAAAA@AAA@@@AB@@ABA@AAAA@@@@BA@@B@@@B@@ABA@ADAD@B@BCB@@AD@@@BA@AAEAEACAA@CAAA@@@@@BAB@B@B@@@BDBBBBBB@@BBB@@DB@@@BAB@D@B@BAB@@@@AF@BAD@B@BA@@D@@@B@@@BB@@@@BA@@@C@A@A@E@A@A@A@A@C@@BA@@@ADAF@B@@A@@@@@@CA@@@@@A@@@@@AA@@@@@AA@@B@@@B@@BB@BBB@B@@AB@@@B@B@@BD@@A@@@@@CACC@@AA@@@@@@A@@@CBAB@@@B@@@F@B@BAB@@A@@@A@AC@A@A@@@@AB@BAB@DABCD@B@B@@@B@BBDBB@BA@@@CA@AC@@@GAC@@@C@AAAA@@@@CBABA@@BA@@B@AA@@@A@@@ABADA@@@A@@@@@AA@@A@EBABA@@@A@AAA@A@@B@@@BA@CC@@A@@@@B@BB@@BBB@@A@CBA@@BABA@@@A@AA@@@@A@@@@BBB@BBD@B@@ABCBABCHABABA@@BC@@@A@@@A@A@A@A@@@AB@@AAAAA@AC@A@AA@@@@@C@AA@@CA@@GA@@CC@@@AAA@@BA@AA@@AA@@A@@@@@CBA@AB@@A@E@AAA@A@@@AA@@@A@@@C@C@@@A@@@AAA@A@C@@@@@@@@D@B@B@BB@@F@BB@BB@@@BABADABABCBCBABA@@@@@A@A@@A@AA@@ACAA@AAA@@@AB@@ABA@@@AB@@A@@@@B@@@B@DA@@BABAB@@@@AB@B@@@B@@AA@@@@A@@AA@ACA@AAA@AAA@AA@AA@@A@@A@@@A@CDA@A@@@A@A@ED@@C@A@A@@@@@A@@A@@@E@A@@@AA@ABA@AD@@A@@@C@C@@@@B@@@@AB@@@@AAAA@A@@@A@@A@A@A@CCAA@@@B@@AB@B@B@@A@A@@@A@AB@@@B@@ABA@@@@@BFDB@@@@@B@@@BA@@@AB@@@B@@@@BBBBB@BBB@BBBBDBBBBDBBDD@@A@@@A@AAGAA@@AA@C@C@@@A@@@A@@@@AAAA@AA@@EAA@@AAC@@A@@@A@@@@B@DAD@B@@@@@B@@BD@@@@AB@@A@A@CAE@A@ABA@AB@@AB@B@B@B@@@B@BCD@D@@@DBB@D@@@B@@C@A@A@A@G@CAA@C@@A@@@@BCBG@C@@@A@@ABCBCBABABA@@BAA@@A@@@@@@@@B@B@@AB@B@@BDBB@@AB@@@B@@AA@@@@A@A@@@@@CAA@A@AAA@C@@@A@CAA@A@AACA@@@BBDBD@B@@@B@@@B@@@D@@@B@D@@@B@B@@@@BDBB@B@BB@B@@B@@BB@B@DDBAB@@CDCBCBCBAB@@BBBFBBBBB@@@@BABA@@B@BA@AB@DAB@B@@BB@@B@B@BB@@BBBB@@@BCB@@@@@@BB@BA@@BB@@B@BBB@@@AB@@ABABA@@@@@@B@@B@B@BB@BBB@@BB@@@BB@BB@@@@@B@@@@@@D@DAB@B@@BFHCDCB@B@DABA@@BAB@B@@@@BAB@@@BBB@B@B@@BD@D@@@@AB@@@@A@@B@D@@A@C@@@A@@F@@A@@@A@AA@@EBC@A@CBABA@@@@BA@@@A@@B@@A@AA@@@@@DA@@BBB@@@@@@B@D@BBB@@@@BBF@DBB@BBB@BB@@@@BA@@@A@E@C@@@AA@@@A@@A@@@@BA@@@A@@@@D@B@H@BAB@@@B@B@@B@@DB@@@@@@B@@ABAB@B@@@BABA@@B@B@BBHAD@@@@A@A@A@A@C@@@A@A@A@A@A@@@A@@D@DBB@BAB@B@B@BAB@B@@@@A@@@@@@@AA@@@@@@AACA@@@@AAAAAA@@@@C@CAA@@@@@@B@B@BA@@BABADABABAB@@AB@@A@A@@B@@AB@@CB@@A@ADAB@@@@@@@C@@AC@@@@@C@A@@BABABC@A@A@C@@AA@@@@C@@AA@@@@A@ABE@E@@@A@@CCAAA@A@C@@A@@A@@@@A@@@@@@@@A@@@@BA@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@A@@@@@@A@A@@AAAC@@@@@@LK@CB@BA@A@@AA@@@@AAA@@@@@@@A@@@@BA@@BA@@@@AA@AA@@@@A@@@A@@@@AAAAA@AA@@A@A@@AE@@@@@A@@@@BA@@@@@A@@@@@@@@B@@A@@A@@@@A@@@@@@@@@A@@@@@@@A@@@A@@@@@A@@@@B@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@A@@B@@@@A@@@@@@@AB@@@@@@@@A@@@@@A@@C@AA@@A@A@@@A@@@@@@@@@@@@@@@AA@@@@A@@@@@@B@@@@@@AA@@@@@@@@@@@B@@AB@@@B@@@@A@@@@@@@@@@@A@@B@@AB@B@@@@@B@B@B@@@B@BA@B@@@@@@@@B@@A@@@@@@@A@@@@@@@@@A@@@@@@@@BB@@@@@AB@@@@@@@@@@@@A@@@@@A@@@@@ABA@@@@@A@@B@@@@@@@@@@@@@@A@A@A@A@@@@@A@@A@@@@A@@@@@@@ABA@@@@@A@@@@@@@A@@B@@@@A@@@@@@@@@AA@@@AA@@@A@@@@B@@@@@B@@@@BB@B@@@@@B@@BB@@@@@B@@@@@@@B@B@@@B@@@B@@@@@@AB@BCB@@@B@@A@@@@AA@AAA@A@A@A@@@@@A@@A@A@A@A@A@@AA@@@A@A@A@@@CA@@A@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@A@AB@@@@@@AAABA@@@@@@@@@AB@@@B@@AB@@@B@@@@@BB@@@@BB@@@@B@@@B@@@@@@@BB@@B@@@@@B@@@@B@@B@@B@@BB@@B@@@B@@@@@@@@@@ABA@@@A@@@@A@@@@A@@@@A@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@A@@@C@ACDAB@B@@@@ABAB@B@@C@@@A@@@@BA@ADAB@@A@ABABABABA@CDCBABABC@@@A@A@@B@@A@C@@@@@@A@@@CAA@C@@@@E@AAC@E@A@@B@D@@@@C@C@A@@@@F@@@BA@@@@@@@CC@AAA@@@@@@@@@BA@@@AAA@@@@@@C@@BA@A@A@A@@BC@@C@CBCBA@A@EA@@@@@@DEDA@@@@@A@C@@@@@@@@@@AAA@@@@AA@AGAE@C@CAA@@@@@@@@A@ABADAB@@@@A@@@@B@@@BA@@@@@A@@AA@@@@@@@A@@B@@@@@B@@A@@@@@@AA@@@@AA@@@CAC@@AA@@@A@@@BA@@@@@A@@@@A@@@AA@@@A@@@A@@@A@@AA@@@@@@@A@@AB@@A@@@@@A@@BA@@BA@A@@@@@AB@@@B@@@@A@@@@@AB@@@B@BAB@BA@@B@@A@AD@@A@@@A@AB@@A@@@AB@@AB@@C@AAA@@A@A@AAE@@@@@A@@@@@AA@@BCBCBADA@@@AB@@A@@@@@@@A@@@AA@@@A@@AC@C@A@A@A@A@@@@@A@@@@@@A@@@@@@@@B@@@@@@@@AB@@@@@@@@@@@@A@@A@@@@@@A@@@@@@B@@@@A@@B@@@B@@@@@@AB@@@@@@A@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@A@@@@@@@@@AD@@A@@@@@@@@@@@AA@B@@A@@@@@@@@@A@@BABA@GFEB@BA@AB@BADA@@B@BA@AB@@A@@@@@A@@@@A@A@@@@@@@@@@A@@@@BA@@@A@@BA@@B@B@BAB@@AB@@@B@@@@A@AA@AAAA@CA@AA@ABA@ABA@EB@BA@A@@BA@A@@AAA@@AA@A@@@A@@@@A@@BABA@A@ABAB@BABA@@B@@@B@@@@AB@AC@@@A@AA@@A@@@AB@@AD@BA@A@@B@D@@@D@BAB@DABA@ADCFCFAB@@@DCH@D@BABAB@BA@ABA@AA@AA@A@@@@A@@B@@@@@@@BA@@@AA@A@@@A@@BA@@@@@@@@B@@@@@@A@@@@A@@@@A@@@A@@@CA@@A@@@A@A@@AA@@AAA@@@AA@@@AAA@@@@@@AA@@@@@@@A@@@@@A@@BA@ABA@@BA@@B@@A@@@@@A@@A@AAE@C@CA@@@@A@@A@@@A@@@@BA@A@A@@@ABA@@@ABAF@@@B@BBF@BABAJ@D@@@DBB@@@BBBB@@@B@@@@BA@AB@@@@A@@@AB@@A@@@A@@A@@AACC@A@A@C@@@AACAE@G@A@@AA@@A@@@A@@@A@@B@@A@AB@@A@@@A@@@A@@@A@@BA@@BA@A@ABABA@A@@@AB@BA@@B@B@BAB@@@@A@A@A@@@@@@A@@A@@A@@@A@@@@@@A@@@AA@@@@A@@@A@@BAB@B@BAFBF@BAF@@@D@B@B@@ABAB@B@BAB@@A@@@A@A@AAA@@AAA@@@A@A@@@AA@@AA@@A@A@A@A@A@A@@@A@@@AB@@@@A@@@@BA@@@@@@AA@@@A@@@A@@@A@@@@@A@@@@@@AB@@@@A@@@@@@@A@@A@@@A@@AC@@@@@AA@@@@B@@@@@BA@@B@@@@@@AB@@A@@BAB@B@@@@AB@@A@@@@@A@@@@@A@@@@@@BAB@@@B@@@BAB@B@@@F@@@B@@@BA@@@A@@@@@@B@@AA@@@@@@AA@@A@A@@A@@@@AA@@@@@@@B@@@@@@A@@B@@@@@@@@A@@@@@@@A@@A@@@@AB@@A@@@@@@@@@@@A@@@@@@@AB@@A@AB@@A@@@A@@@A@A@@@A@@@@@ABA@AB@@A@@@@B@@@@A@@@@@@A@@@@@A@@@@@A@@@@@AA@@@@A@@@@@@@@AA@@@AB@@CBA@@AC@ABCBA@ABA@@@A@@@@@A@@@A@@@A@@AAA@@A@ABA@AA@@@AA@@@A@@AEAAAACACA@@C@@@CB@BC@A@@A@AAA@AA@@A@@A@@AA@@@@BA@@@A@@BAD@@@@@BA@AD@@A@@BA@A@@@EDA@EBA@CDABAB@BABA@CBCB@@A@A@@@@@A@@@A@A@@@@@AAA@@@@A@AAA@AA@@AA@@@@@A@@@@@@@@B@@@BADAB@@A@@@@@A@@@@B@@AB@BAB@@@BA@A@AB@@A@CD@BAB@BA@A@A@A@AB@@CB@BAB@@@B@B@BADA@@B@BABAB@BABADCD@@AB@@@@@B@BA@@@@@@@@@AB@@AB@@@@AB@@@B@B@DAB@BBBBBD@@@D@BBB@@@B@B@@@@@@B@@B@@@@AB@B@B@@AB@@@@BBB@@@@B@@A@A@AB@@ABAB@BADABC@A@@BAFCBC@ABGBCBAD@B@BADCDEBCDB@DAFAD@DBDAH@DGFCB@@@@@BA@@@A@@@AB@BABCDA@@B@@@@@@@@@BBA@@@@B@@B@@@@B@@AB@B@@@@@@B@BAHA@@BAD@@@@B@@@@@@@@@@B@@@@B@@@@@@A@BB@@@@@BB@@B@@D@@DD@D@LBFAB@D@BBd@LANBDADDDDAFAFAD@FAFCBAD@F@DB@BBDCFCBABEBAFBDBDBBADC@EBABCBACCCA@CFGLCBCBA@A@@@AA@@A@A@EAC@AAA@CBA@@@ABAB@@@BBBD@BD@D@FBB@B@FAF@FBB@BB@B@BAB@B@BB@AB@@AAEBA@A@@B@B@B@BABA@@B@@DBFDBDB@D@D@DCDEFA@@@A@A@A@@@AB@@@@@BBFBDBB@@@B@B@BB@@BBBBBDDDDFBFBBBDDBD@@@B@@@@BB@D@D@BBBBD@B@@@BCDAD@DBDBB@@ADEDCBE@EBCBCBC@C@ADIJ@BA@AD@@A@AA@@@@CGAGBA@@@@@A@AB@@@A@@@A@A@@AA@@AA@A@A@A@@@ABADABCBAD@B@B@B@D@BABCBABA@A@A@@@A@@@A@@BAA@@@@AAA@@@CBAB@D@@A@A@AACAAAC@CBCDCDCDKJGHCDAD@@ABABA@CAAACAC@CAC@ABA@ABABCBA@ABA@A@@@A@@B@@BBB@DB@@BBBB@B@B@@B@BB@B@@ABA@@B@B@@@BCBABCBCBABC@@B@@@B@BABABADCFEBCBC@I@GCCA@@A@EBA@@@EBA@CBA@AB@@@B@B@D@D@@@FABCDCF@BA@@DCB@BCD@D@@@B@@@BB@@@@@B@@@B@@BA@@B@BB@@@B@@@@@B@@A@@B@B@@@@ADA@@BCB@DCFABABCBCBABA@@B@@B@@BAB@@@@A@@BABADCDABA@@@BB@@@@B@@B@@BB@@@@B@BAB@BABBDBBBDBF@B@@BDABAB@@@B@@DAHCB@JCFCJGDCBA@ADAD@JAFCD@B@BBD@F@DADABA@@B@B@@BDB@BB@D@B@D@B@@@B@B@@D@B@DDBBB@DCFCDA@EBAB@@AB@BF@H@FBFB@@D@BBD@D@B@B@B@ABA@C@CBAB@B@B@@ABCBA@AB@B@B@DA@CBA@@@@BAB@@CBC@A@E@ABABADAB@B@D@D@@@BB@@D@BBDB@@B@DAN@BAD@@DBDAJAF@BADCFBDAD@D@B@DDFBDBNFD@B@DBB@F@BB@D@LDN@B@@@BD@B@B@@@@B@B@@AF@BADADABABAB@B@B@BBDBF@BAB@BADCHAJ@HBDHDJBDDB@D@@BB@@@@@@A@@B@@@B@@@@@@BB@@@B@@@@@@@BB@@@@@@@@B@@@@@@@@@@@@@BA@@@@@@@@B@@B@@@@@@@@B@@A@@@@@@@@@AB@@@@@@@B@@@@@BA@@@@BC@@@@BA@BB@B@@@B@@B@@@@@@@B@@@@@@@@BB@@@B@@B@DAB@@@BAB@B@@@B@@@@@AB@DABCHCF@BAB@BAF@BA@@BA@@@@@A@@@@@A@@@@B@@@BED@BAB@@@BA@@@@@BB@BDBBBB@B@BAB@@@BBDBDDFDBBB@@@@B@@@F@BBB@@BA@@@AB@BADADA@@B@@B@@BD@D@@@@BB@@B@@AB@@@B@@B@@D@B@@@B@B@B@@@BB@@@B@B@BBB@BB@@@B@FAFAHED@B@@ABBD@DB@B@B@@AD@@@B@@ABBBBB@@BB@@@B@@@B@@@B@@@B@@@@B@B@@@B@DADAD@BAB@B@BB@@BB@DBB@BBBB@B@D@B@BA@A@@@A@A@@BA@@B@@ABA@@BA@@@@BA@@@AB@@@B@@@B@@@B@@@@A@@@@@@@@@@BA@A@AB@@@@ABABA@@FC@CB@@@@@@B@@@BBB@B@B@@@@@@B@@@@@BB@@@@@@@@@@@B@@@@BB@@@@@@@@B@@@@@@A@@B@@ADABABADC@@BA@@BADADCBA@AB@BA@@B@@@@@@@@@B@@@BBBB@@@@B@@@@@DAB@BABAB@@@B@BB@@@@@D@@@BA@@BCB@@C@A@@@AB@BAB@@AB@@A@@@A@@BAB@BA@@@AB@@@B@BBBA@@@@@A@@@@@BF@DB@@BABABAFCD@B@@@@BBB@B@B@B@B@B@@B@@@@@DBB@BBB@@B@@B@@@D@D@B@B@@@@@@@@@@B@@@@B@@@@@@@@@@@@@B@@B@@@@B@@@@@B@B@@@B@B@@@BAB@@@@@@@B@@@@@@@B@@@B@@@@@@@@BB@@@@@@@@@@@@A@@BA@@B@@@@@@@@@B@@B@B@@B@@@@@@AB@@@@@@@@@@A@@@@@@@@BB@@@@@@@BBA@@@@B@B@@@@B@@@@AB@@@B@@@@B@@@@@@@B@@@@@BB@@@BBB@@BBB@D@B@F@B@@AB@@A@@BA@@B@B@@BBB@@@@@B@B@@@B@@B@@@B@B@BA@@BB@@@@BB@@@@@BA@@BA@@@@BA@@@@@@BBB@@BB@@@@@B@@@@@B@@@B@@@@@@B@@BB@@@@@B@@@BA@@@AB@B@B@@@B@@B@@BB@@@@@BA@@@@@@@B@@@@@B@BABCBEBCDABA@@B@@@B@@@B@@A@@B@@@@@B@@BB@@@@@@A@AAA@C@A@A@A@C@A@AB@@@BB@BB@@B@BB@@@D@@@B@@@B@BBD@D@D@BB@@B@@A@@BAB@BAB@@B@@BB@@@@@@B@@@BA@EBEBGBEDA@ED@BA@@@@B@@@@B@@@@@B@@AB@@@@@@@B@@B@@@@@@B@@@@BB@@BB@@@BBB@B@@B@@@@@BABAB@@@@@@@@B@@@BADABAB@@@@@@@@@BB@@@@B@@@@B@B@BCBABAB@@@BABABA@AB@B@@BBDBBB@@B@@@DAB@BB@@@B@@ABABABCBA@ADABABA@ABA@AB@@C@@@AAA@@@A@CA@@AAA@@@@@AA@A@A@@@A@@A@AAA@A@@@@AA@@@@AAA@@AA@@@@AA@@@A@@@@@@@@@@@BAB@B@@@@ABA@@BB@@BCDCBCDAD@@@B@B@@@BAB@@@BB@B@@A@@B@BA@@BAB@@AB@B@@B@@@BAFCD@B@BBB@BB@@BD@B@@@@B@@@@@FADCDABAB@DA@AB@BABA@A@EBAB@@A@EBC@A@@@A@AB@@@DABABCDABCBABC@EBA@A@@@A@@A@A@@@@@@A@@@@@A@@@@BA@@@A@A@@BC@@@C@@BABABCD@@@@@@A@@@A@@A@@@@A@@BCBEB@@AB@@AD@B@@@BB@@B@@@BBD@B@@@@@@@@BDDBB@@B@@@BCB@@ABAB@BABA@@DADABEF@BABABAB@B@B@B@BADABAB@B@B@BBDBDD@DA@@DA@ABA@ABC@ABA@ABADCDABADC@ADAFEB@BAB@B@B@B@@A@@BA@@B@@@B@@A@@@A@@AA@@DCBA@AHC@ADC@@BAB@@A@@A@@AA@AAA@@@@@A@@BABA@A@C@A@AA@@@ADCBABCDEDABAD@B@B@B@BBDBBBB@BBB@BBB@B@B@B@BBB@A@@B@@@@ADABABBB@@AD@D@BABAB@B@B@D@F@B@DB@@BB@BB@BB@@B@@BB@@@@@B@@@@@@BA@@BAB@DABAFADCB@B@BAF@B@B@B@B@@@B@@AB@@@@@B@B@@@BB@@@@BA@@@@BA@@@@B@@@HBND@@DBD@DA@@B@B@@@B@@B@@@@ABABAF@B@@AD@F@@B@@@@BB@BJBB@B@@AFAB@BBHBD@BBB@BB@BB@BBB@B@B@@ABABAB@BAB@@@B@B@@@B@@ABAB@B@BD@B@B@B@@@F@DADADABADABBDBBDDDFF@B@BABGDEDAB@D@D@DABCBCBABABAD@DB@@DABABAB@BAB@D@BB@AB@B@B@B@@ADA@@B@@@B@B@BAB@BBB@B@B@D@DABADAD@BADADCBCFADABC@ABC@A@AD@DCFCBADCDADAFAB@FEFCH@B@DA@AHAFCFAB@@ABAB@@DDBBBB@@D@B@@@BA@@BA@A@@B@@@@BAB@@@BAB@B@@BB@@BBB@B@DA@@"]],encodeOffsets:[[[109531,37111]],[[109178,38026]]]}},{type:"Feature",id:"621024",properties:{name:"合水县",cp:[108.019554,35.819194],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@A@@A@@A@@A@@A@C@@@EC@@A@ABA@@@@A@A@@A@@@A@@B@@@@AA@@@A@@ABAAA@AA@@@@@AA@A@A@AA@B@@@@@@B@@@@BA@A@@@AAAA@@@@A@@@AB@@CA@@@@AB@@AH@BA@I@E@A@EAA@A@ABA@@B@@@BABBD@B@BBB@@@BABAB@B@D@D@@AD@B@@BBBDBBBBA@@B@@ADAB@BA@@B@B@FAB@H@BA@A@CBE@GB@@A@@A@A@C@ABA@A@A@AAA@@@@A@A@ADCB@DAD@@CDA@ADABA@@@C@CAABG@A@ABCBCB@@C@G@A@A@A@EBOBC@A@CBABE@A@@A@@@E@@@AACA@A@A@EA@@@@AAAC@A@@A@@A@@A@@@ABA@@@@C@@@@@@C@@@A@@@A@@@A@A@@@AB@BCBA@@BBF@@@BA@A@CB@@@@AD@@A@A@ABA@@B@@AB@@A@A@A@A@@@A@@BA@@B@@AAABC@EDC@@B@BAB@@@D@@@@A@@@A@AAA@EAA@@@@BB@@BB@AB@DCD@B@D@BADAB@@E@A@@@CA@@@B@@@@AB@D@@AB@@@@@B@@@@AB@@A@@B@B@B@@@B@@@B@@AB@@@B@@@@AB@@AB@BAB@@A@@@@@@B@B@@@@@B@@A@@@@BABAB@@BB@@AB@@A@@@@B@@@@@DA@@D@@@B@@AB@@@@@@@B@@@@ABB@@@@@@@@@ADAB@@AB@@BB@BA@@BA@@@@B@@AB@BAB@FA@@@@@@B@BA@AB@@@BA@@B@BA@@B@@@@B@@@AB@@@B@B@B@@AB@@@B@B@B@@@@A@@@@B@B@@@BBDB@DBB@B@B@@AD@ADFBJB@DABA@ADA@A@@B@@@FCAAD@@@@A@@BA@EFABCHDBABB@@B@@@@DB@@BB@BA@ADB@@BBB@BABCA@BABDB@AB@BA@@B@D@@BD@BFBDDBDBDBFB@BAB@B@@DDD@DD@B@B@@@BABA@ABA@@@ABAD@BAB@B@@AB@B@@BB@@@B@@BB@@@@@@B@@BB@@BBBB@@@@@B@@BB@BA@@BBD@B@@B@@B@BB@@BA@@@B@@B@B@B@@@@@@B@@@@@@D@@BB@B@B@@B@@@B@@@@BB@@@@@B@B@@BB@@BBBBBB@@B@BBBBB@@BDB@@@BBB@BBBBB@B@D@D@@BDBBBBBBDDB@B@B@@@BA@@@@BBB@D@B@@@B@BAB@B@@A@@BBBA@BB@@AB@BAB@@@@@@B@@B@B@@@B@@@B@BAB@@AB@@@B@BAB@@A@@B@B@BADBB@@A@@B@@@B@@@B@B@B@@@B@B@B@BB@@@@BB@@B@DB@@BABABA@@B@DAB@@BB@B@@A@@B@@BDBBBBB@@@D@BAB@@ABAD@@ABEB@B@AA@@A@@@@AA@@A@@@A@AB@@@B@BA@@@A@@@AB@@@B@@D@@B@@@@CDAB@B@B@B@@BB@@@BA@@B@@@@@BDB@B@@@@ABA@ABAD@@@B@@B@D@B@B@BB@@@@@B@@A@@BA@BF@BB@@BABAD@@AD@B@B@B@@BBBB@@@BA@@BA@A@A@A@@B@B@D@@CDA@@@@B@@DDBBB@@BBB@@B@@A@@@@B@@A@@B@@@BB@@DCDA@@B@B@@@HFBB@@D@B@FBB@D@B@D@@@BBB@@B@@AD@BA@AB@D@@@BBB@@@BCB@@@@DD@B@@@@@B@@A@@@@@BBB@@@@@@BB@@@D@@@@@@B@@ABA@@BA@@@BB@@B@@A@@BBBBB@@@DB@B@@A@@@A@@B@@@@B@@@BB@@@B@@@BAF@@@B@@@@@@B@@@BABA@@BB@@@@@@B@@AB@@@BB@@@B@B@@@BC@@B@@@@DB@@D@B@@@@B@B@@B@@B@@@B@@AD@@A@@BB@@@B@B@@ADC@A@AB@@A@ABA@@@BDB@@B@B@@AB@@@@@BBBBBBBB@@A@@B@@B@@BBBBB@DBD@B@BABAB@@@@B@BB@BBBBB@BDFBB@B@@AB@@B@BBBBB@@B@BBB@@@BDBBBBB@DBD@D@@@BABAB@@@BBB@D@D@B@B@BAD@BABCBA@AB@@@@@@@BB@DB@@D@JAB@BBD@@BBB@BBBB@D@B@D@B@@@B@BAB@@@@@@AD@@AB@B@B@@@@@@B@B@@@@B@@B@@D@@@@@@B@@@@@BBB@BBBBBFDB@@B@@@@BAB@@@@@@@@B@D@B@@B@@@BEBCB@@BDBB@BBBB@@@B@@B@@@@BB@@BB@@@@@@B@@@B@@@@@@@B@B@B@B@@@@BBA@@B@@@B@@@@AB@@@@A@A@A@A@ACA@@@@@@@A@@B@B@@A@@AAA@@@@A@ABEBE@@@@@DDBB@@@B@B@@@B@BABADAB@BAB@@@H@FB@BBBB@@@B@@AB@@C@A@@BA@EBA@AB@@BB@@@@@@@@@@DB@DB@@B@@BBBB@@D@@@BB@@@@@@@@B@@@B@@@BA@@B@@@@@BB@@BAB@@@@@@@B@@@@@@A@@B@@@@@@@@@B@@@@A@@B@@A@@@@B@@@@@B@@@BAF@@@B@@@@@@@@@DC@@@@B@@@@@@@@@B@BAB@@@@@@@BA@@@@B@@AB@@@@@@@B@B@@B@@@A@@B@@@B@@@@@@@@@B@@@@@@@@@@@B@B@@@@@BBD@BBB@B@@@@BB@@@B@@@@B@@B@@@@@@@@@@@B@@@BA@@B@ABAB@@@DAB@DABA@@B@@@BA@@@@BA@@@ABC@@@A@ABAB@@A@@BABABA@@BABCB@@BB@B@D@B@@@@BBBB@@B@B@D@JAB@B@BA@@@@@@@@@BAB@BAB@DAB@D@BAB@BAFA@@@AA@@@@@BABA@ABA@C@@@@@@B@D@DADAD@BC@B@BBBB@BB@BB@BDBBBFBFBDBD@BBB@@@BB@BD@DCB@B@B@B@@@B@@@B@B@BDBB@@@B@BBD@@@@DDBBB@B@B@@B@@BB@@B@@@FB@@FAD@B@B@@@BA@@@B@B@@@B@B@@BB@@B@B@H@J@B@D@D@B@@@BABAD@@A@@BB@@@B@@@@@@BB@@@@@D@B@B@B@@@B@B@@@B@DDB@@@BB@@BBBDD@B@B@@@BA@@B@BBB@B@@@@@@@B@B@BBFBBBDBBBDBDBB@@F@BBB@B@BBBBBDBFBBAB@@@@FB@B@@@B@@@H@@@@BBBB@@@B@B@@BB@BB@BB@@DFDDBB@D@@B@@@BA@@B@BBB@B@B@BAB@@@DADEBA@@DAFABABAB@@BB@@@@@BD@BB@@@B@B@@@B@B@@ADA@ABA@ADA@AB@B@BABA@@@AAC@A@A@@B@DAFABAB@B@@@B@B@@@B@@@BBBBB@F@B@@ADC@EBABA@A@C@A@A@A@A@ABCBAB@BAB@DAB@@@BA@ABA@C@EBA@ABA@CBA@CB@@AFB@AB@B@@@@A@E@A@@@@B@D@@@B@B@D@B@B@@ABABAB@BAB@B@@A@A@@B@@AF@@A@@@@@@A@@E@A@@@@LEB@B@F@FBB@@@B@@@BC@@@@BCDABABA@A@@B@B@DBB@HAB@@@BAB@@@@A@E@A@A@A@A@A@A@ABABABCBEB@@@@AB@BBD@D@B@BBDDBBB@BB@@B@@A@@BC@A@@@ABABADABAB@@@B@@B@@BB@BB@@@BB@D@F@DBBB@@BBD@@DB@@@@B@BA@@DBBB@@@B@@@DBB@@@@B@B@@@FAB@@@B@BBBBB@B@B@BA@@B@@BD@@@H@B@DC@@@@B@B@DBDD@@B@B@B@@BBB@@B@BBB@@@@@BB@DBB@D@B@@AB@@@B@B@@DB@B@D@B@B@@@BDB@@@B@D@BAB@@BDFD@B@D@@BBBB@BB@@@B@BDB@@BB@@@BAB@@@@@BB@B@@D@D@B@@@@@B@DBBDDDDD@D@FBB@@@BB@B@@@@D@@@@@D@D@B@@BBBD@BDB@B@@BBA@@BB@@BB@@B@@@B@B@BB@BD@@B@B@BBBDBBB@@@BDD@B@DB@@DBB@@BB@B@@@BBBB@@@@B@B@@@@B@@AD@@BB@@B@F@B@@@B@@@B@B@@@B@@@B@BBB@@BB@HABA@@B@@BB@@@@BB@@@@@B@@@D@@@@@@B@B@FBB@@BABA@@@@B@D@@B@B@@B@@@BA@@B@@ABBB@@@@@@@@BBB@BBBBB@@BBD@@@B@BBBBB@B@@B@@@F@B@@BDBB@J@DAF@BADAB@BABB@@@@@B@B@@@BB@B@BBBBB@@@B@B@BDD@BBB@@B@@@BA@@@@@BB@@@B@B@B@@B@@AB@B@B@BBB@BBBBB@@B@DBD@@B@@@B@B@@@@@@@@B@@@BB@B@D@D@BBF@B@@@BB@B@@@@BAD@D@@@BB@@B@B@@BBBBBBBB@B@DBDBB@@@BBB@@@@@B@F@B@BAFAD@D@BB@@D@B@@@D@@BB@BDBBB@@BD@D@D@HABBD@BBBBB@BB@@H@BBBAB@B@BAH@@BDDFBB@BDB@B@BBBAB@@BB@@@BAB@B@@@@@BD@BD@@B@@@B@BAB@BBB@FB@@@@@B@@@@@@@F@B@@B@@B@@@@@B@D@DBF@HBDBBB@@@@B@BB@BB@@@@@B@@@BA@@@@BBFBD@BBD@BT@PATMV@PDNBDC@@@A@@DIBABADA@@BABABABEBG@C@A@AB@@ABA@@@ACC@AAA@A@CBAB@B@B@BBBBB@BADCBA@EDCBADCDABABABC@@@@CEEQCM@@A@B@CMAU@@@A@@@A@IFSAYAI@A@@@CDWBA@@HU@A@@@@DKHI@CACCCISAE@EHUBCHSJMBCHQHIJKJMLQJKBCZgFIFKFMBGAAECCEGIAACKCE@A@@@@@AB@@@@A@@D@BAB@BABA@@@@@@B@@@@AB@@@@@BA@A@@@@@@MQMOEIEKCKCOAMAMFQBKDSBSIMMI__CEAMBMASASAI@@IGMEMAI@YDMF[BK@K@AAMCKEKIEMEW}YSCGAEICEYYCCAMBGDEHG@@AAEGA@CAAAKAC@E@Q@A@A@@A@@@AA@@AE@C@E@CBQDCBEBIBC@C@EAG@A@C@E@AAA@EAKAIAA@G@CAC@C@I@E@A@C@C@I@E@C@GAE@@@A@EAA@@@@AAC@A@@BA@A@C@C@GAA@CAACC@C@@@A@A@AEKCC@@@C@@C@A@AA@AC@AA@@AAA@@@CA@@@AABA@A@A@@BA@@@AA@@@BA@A@C@A@@@AB@@A@@BA@A@A@A@ABAB@@@@AB@@A@@@@BA@ABABA@A@A@@BA@@@@@@@ABAAA@A@@@@BA@@@C@@@A@ABA@A@C@A@E@ABC@@@A@A@A@CBC@EBCBAB@@A@C@A@@BAB@@A@A@C@A@@@@@A@A@A@@B@@@@A@@@@@A@A@@@A@@B@@A@@BAAABCBAB@@A@@@ABA@@@ABA@ABA@@@AB@B@@AB@@A@AAAA@A@AAC@@AAABABA@A@AB@@A@@B@B@B@@A@A@@@A@@A@@@C@@A@AC@@BA@@@@BAB@@A@A@CHC@@@@@@CAK@C@A@@@@A@@@A@@AAAA@A@A@AAA@@CAAA@A@@@A@A@@@@A@A@A@@@C@CBC@AB@@@D@@@@C@A@ABA@@@@@C@A@A@A@@@@@ABABAD@@CBA@A@AD@@A@E@@@@@CD@@A@AB@@AAA@A@ABCBA@A@@@@@C@@@AB@@AA@@@@@ABC@@AE@@CC@AAC@AAA@@CA@AAAA@AA@A@AA@@AC@AAA@@@@A@A@A@@AAA@@A@@@@B@BA@AB@@A@@AE@AAAAE@@AA@@A@@@@BAB@@@@@@@@@B@@A@@B@B@@@B@@A@@@@@@B@@@@AB@@@@@B@@@@A@A@A@AAECA@ABCAC@CACCA@A@A@A@CA@@ABA@A@@@@@A@@@B@@A@@@A@@@ACA@@@A@C@A@AA@@AAC@@AEA@BEBEA@@@@@GA@@AACAA@@@@AB@@@B@@A@@@@B@BA@AB@@A@@BA@@@A@@@@B@@@@@@@@A@@B@AA@@@@B@@@@A@@@@BA@@AA@@@@BA@@@@@@BA@@@@@@AA@@B@@@B@@@@@@@@AB@@@@@B@@@@AC@B@@@BAB@@@A@@@BA@@@AA@@@@AB@@@@A@@@@@A@@@@@@B@@@@@B@@@@A@@@AB@@A@@B@@@B@@@@A@@B@B@@@@@@AB@@@@@BAB@@@@@@@@A@@@AB@@@@@B@@A@AB@@@@AAABA@@@A@@@@BA@@@@@A@@@@B@@A@@@A@@@@@AAA@AA@@A@A@@AA@@@A@A@@BA@@@AA@@AAA@A@AAA@CA@B@@C@A@@@@@@A@A@G@A@A@G@@LCAE@I@@C@@@@C@AAA@AA@@@@BE@@@A@@AAAC@@BA@A@@@@@AB@@A@@@@@@@A@@@AA@@A@@@@A@@A@@AC@@@A@AAA@C@A@AAC@A@AAA@@@AAA@@@A@A@@@CBCAA@@BAAA@AB@@A@@AAA@@@@A@@@@A@AAA@@AAAAAA@A@@@BA@@@A@@A@@@A@@@A@@@A@A@@@@@AA@@A@A@@@A@@@AAA@@@A@@AAA@AA@AA@@A@@AA@@@A@@AAAA@@@A@@@A@@AAC@@C@@@@@AA@@@@A@A@@@A@@AAA@@A@A@A@A@@@@AAA@@A@C@@@ABAAA@@AAA@@A@A@@@@AA@@@@BA@@@@@@@@A@@@@C@@@@@AA@A@@@@C@A@@@AC@@@@A@@ABA@@A@@BA@@@A@"],encodeOffsets:[[110651,36498]]}},{type:"Feature",id:"621025",properties:{name:"正宁县",cp:[108.359865,35.49178],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@E@@BA@C@ABAF@@@@@B@@@B@DADADEF@B@FA@AHGLMJERA@@BC@ADABAB@D@DABCBEBAAE@G@ADC@ABADC@A@A@CACA@AEAA@CKKKKEKAKBK@CFMDEJOBK@K@UDWHM@A@@HKHUAMAKGIIMD[CIAGKOEM@C@E@AB_BABADC@A@AAAGCCCKY@@CCAAAACAE@A@GAC@AAAC@@ACAC@@@A@CACCAACA@CAEBKAEBCDCDCBAFEFABEAC@@BA@@B@BA@GA@ACACAAAAEAAEAAAA@AACAAAEEA@CACAEAGAE@A@C@C@@@CACACAA@AA@@A@CA@AA@@ACAA@@AA@AAA@A@A@AAC@A@@B@@@@C@@@A@A@@B@A@BA@@@CBA@K@A@EAEACBCAC@GAE@G@GB@@C@G@IBC@CBE@EAG@@@EBA@@@ABA@C@CAGB@@AB@D@BCBC@G@[@@@AB@BBB@BA@A@AAAAAAA@C@E@ABAACAACCAA@OJ@BA@@@DHFJ@BA@E@CB@@AB@D@DCBGCCA@AAAA@ACCAEECAECAAEACAA@AA@AA@ACA@A@CAEAEAGCAACAAACAAAAC@@AC@@C@A@C@@@A@A@@@@@A@A@A@ACKACAK@CDCBADA@A@A@C@IAA@AAA@@AAAAE@ACA@@C@CAC@A@EC@AC@A@CDAD@BAB@BAFCFCFABABCFBF@DDBDBBB@B@B@BA@E@A@A@ABCDAB@BADADIDSHGBQFEEOAE@CBABABC@AA@@A@@AA@CCG@ECYO@@A@@A@@@AAA@AAA@AA@@@A@@@A@@B@@ABABABCBI@CAECA@CA@@@A@@@@AA@AAAAA@@CAA@A@ABA@A@C@A@A@C@C@A@AAA@A@AA@@A@E@C@C@A@C@AEEAGACACCA@AA@@A@@@@@@@@A@A@@@@@@AA@@@AA@@AA@A@AAA@AA@@@@A@A@@@@@@@@@@@@A@@@@@@A@@A@@A@@@A@A@A@A@@@@@A@@@@AA@@@A@@A@@@@@@A@@A@@@@@A@@A@@@@@A@@@A@@@AA@@@@@@A@A@@BA@@AA@@AA@@@@AA@@@@@A@@A@@@@@@@AA@@@A@@@A@@@A@ABA@CAA@CAA@A@@AA@@BA@@@A@@@@@A@AA@@@@C@@@A@C@A@A@@@@@@@@@A@@@@@BA@@@@A@@@A@AA@@C@A@@@A@@@@AA@@@@@A@@@@@ABA@@@@@A@@@@AA@@@@@A@A@@@A@A@@@@@A@AA@@A@@@@@@@A@@@@B@@@@@@A@@A@@@@A@@A@@A@@@A@A@@@A@@@@@A@@@AA@@@@@@@@ABA@@@@@A@@@@A@@@A@@A@@@@@@@AB@@A@@@@@@@A@@A@@A@@@A@@@A@AA@@@@A@@@A@ABA@@@A@@@ABA@@@@@@@@@A@@A@@@@@@@@A@@AA@@@A@@@A@@@@@A@@@AA@@AA@@@@A@@@@@A@@@@@@@@BA@@@@@@@@AADKAIBMD@@BDBDDDDBBBBBBB@@@BBD@DBB@B@BABA@AD@@@@A@@@@BA@A@C@@BE@C@A@AAAD@B@B@@BD@@@BB@B@@@BDB@DB@@BBD@@BBBB@BDB@@@D@@B@@BBB@@@@BB@@@BB@@@@@BB@@BB@@BB@@@B@B@@@B@BB@@@B@@B@@BB@@@BB@@@@@BB@B@@@B@@@@@@B@@@@B@@B@@B@@@D@@@@BBBB@BBBBB@B@B@@BBB@@BB@@BB@@B@B@BBB@BD@@B@@@BBBBB@BDB@BBBBDBBBB@@BB@@B@BB@@BD@@@@BBB@@BBDB@AB@B@BBB@@BD@@BBB@@BBD@@@@@@BB@ABBB@@@B@@@BB@@BB@B@@B@BB@@@D@@@BB@BB@@BBB@BBB@@@@@B@@B@@@BB@@@BAB@@B@BB@@B@@@@@AB@@@B@@@@@BB@BBB@@B@@@BDB@@@@CB@B@@@@D@BBBB@@@@@B@@@@@B@@BBBBB@@@DB@@B@@BB@@B@B@@BB@@@@@BA@@@ABBB@B@BDB@B@@@@@@A@A@@B@BAB@@A@@@@@@B@@D@@@@@B@@B@@@@@B@@B@@@BB@@B@B@B@@@B@@B@B@B@@BB@@@@BA@@@@@@B@@B@@@@B@@@@@@A@@B@@@B@@BB@BAB@@@@CB@@@B@@@BAB@@@@@D@@@B@@A@@D@B@@@@AB@@@@BB@B@@@@@B@@@@A@@@@B@BBB@B@B@@@@@AA@@@@@@D@@@B@@@@D@@B@@@B@@@@@@B@@BB@@@@BAB@@@@@@B@@@@@B@@@@BBBAB@@B@@@@@B@@@@B@BBDBB@@BBBB@D@B@BBB@B@@@@@@@@@@B@@D@B@@B@@@B@AB@@@@@B@@@B@@@B@B@@@@BB@B@B@@@B@@B@@B@@@@@B@@@D@@BB@@@D@@@@@@B@@@@@@@@@@@BB@@@@@@@@A@@B@@@B@B@@@@@@@@@@B@@@@@B@@@@B@@AB@B@B@@@BB@@@@DA@@B@@@@B@@@B@@@AD@B@B@@@@@B@AB@@@@B@@@D@B@BB@@@@@@@BB@@@B@BA@@@@@B@BB@BB@BB@B@@BB@@@BB@@B@@BB@B@@@@@B@@B@@A@@@@@@@@B@@B@@AB@@@@@@@@BB@@B@BB@@@@@@@@@BA@@@@@BB@@@B@B@@@@B@@B@B@BB@@B@@@@BB@@BD@@@@@@B@@B@B@@@B@B@@B@@@@B@@BB@BBBA@BB@@@BB@@@@BA@@@@@@BB@@B@BBBB@@BB@@@@B@@A@@BBB@@BB@B@BBB@@@B@@@@BB@@@DB@@BB@@B@B@BBB@@@B@@B@@BBD@B@B@BB@@DD@@B@@@B@@B@@BB@@BB@@B@@B@@@@B@@@@@BB@@@@B@@@BB@AB@@@BB@@B@BDBB@@@@B@B@@B@@@BB@@@@@@BB@@B@@@B@@B@@B@@B@B@@@@B@@@@B@@@@B@@@@BAB@@BBB@@B@@@@BBBBB@@B@@@@BB@B@@@BB@A@@@@@@B@@@@B@@@BB@@@B@@A@@B@BB@@@@@@AB@@@@@@B@@@B@@B@@B@@B@@@@@@@@@B@@@@B@@B@@@@B@B@@@@@@@@@B@@@BB@@@B@@@BB@@@B@B@BB@BB@@@B@@@@@B@B@@@@@BBBAB@@@@B@B@@BB@@@B@@B@BBB@@@@BDBB@@B@@@@@@@@BBBB@@@B@@B@@@@@BB@@@BB@@@BB@@@@BBBBB@@B@@@@B@@@@BB@BBBBDBB@BBB@BB@@B@@@@@D@@@B@@@@@B@@@B@@@B@@@@@@ABBBA@@B@@@D@@@B@B@B@B@@@@@@@B@B@@@B@@@@@B@B@@@B@@AB@B@@@D@@@B@@@@@BA@@B@@@FBB@BB@@@@B@B@B@FBB@B@D@B@JBDBBB@@@BAD@@CB@B@@A@@BE@@@@A@@@@@@@@@@@A@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@A@@@@@@@@@A@@@A@@@A@@@@@@@@@A@@@@@@B@@A@@@@@@@@@@@A@@@A@@@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@@@@B@@A@@@@@@@A@@@@@@@@@@@BB@B@@@B@@@@@@@@@@@@AB@@@A@BA@@@@@@B@@@@@@@@A@A@@@@@@@@@@@@@@@@B@@A@@@@@A@@@@@@@@B@@@@A@@@@@@B@@@@@@A@@AA@@@@@A@@@@@@@@@A@AB@B@BA@@@@@@@@@@@@B@@B@@@@@@@@@@B@@@@@@@B@@@@@@@@BB@@@@@@@@BB@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@@@BB@@@@@@@@@@B@@@@@@@B@@@@@@@@BB@@@@@@@@@B@@@@@B@@@@@B@@@B@@@@@@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@@@@B@@@@@B@DAB@B@B@@@B@@@@@B@@@BA@@@BB@@@B@@@B@@@@@@@B@B@@AB@@A@@@@@@@A@ABA@AAA@@AA@@AA@@@A@@@@B@@@@C@@@A@AB@B@@@B@@@@BBBD@BB@BBB@@@BBD@B@@@BB@@@B@@@B@@@@ABA@@DA@AB@@@@@BBBBBBBB@@@B@B@B@BAB@B@B@BA@@@@@@@@@AB@B@BB@@B@@B@@BB@@@@B@@AB@B@@@B@D@B@B@BB@@@A@@@AB@@@@@@D@BBB@@BBB@B@@@B@@@@A@@@@AA@@@@B@@@@A@@@@@AAA@@@@D@@A@@@ABA@AB@B@@@@@@@@@@@@@B@@B@@@@@B@@B@@@@@A@@B@@@@BBBA@@@B@@@@@B@@@@@B@@@@DB@@BAB@BBBB@@B@@@@AB@B@@@BAD@B@B@@BBB@@BB@@@@@AB@@@BA@@@@B@@@B@B@BA@@@B@@BAB@@@@A@@B@@@B@D@@@B@@@B@B@@@B@D@B@B@D@B@@@BBB@HB@@D@B@B@@@B@@@DBD@@@@@B@DBB@BBB@B@@@B@@@@@B@@BBDBDBBB@@D@@@B@@CBAB@@@B@@B@@@B@@@@BB@@B@@D@B@@B@@@@@@CBA@@B@@AB@BB@BBB@BB@@@@CBAB@@CA@BA@@A@A@@@@@@A@@@A@@BABA@A@AB@@AB@B@@@BB@@A@@B@@@@@@@@DADBB@@@BB@B@B@@@B@@@B@@B@@@@B@BB@B@@@@@@BA@AB@D@@@@BB@@BF@BBB@BB@@B@D@@ABA@@BBB@DB@@@B@@B@@@B@B@@@BA@@B@@@@@B@B@@@@@BA@@@@@@@B@@B@@@@@B@B@B@@@@@B@B@@@BB@@DBB@B@B@B@@@B@B@@BB@@@F@B@D@B@B@@@@@@@B@BBBB@@B@B@B@@@@@@B@@B@A@@BB@B@B@@@@@DD@@@@BA@@@@B@@B@@B@@@BAB@@A@@B@B@@AB@BABAB@B@DA@@B@B@@@B@BAD@B@B@B@D@DA@@B@BBH@@@@@B@@B@@@@B@@@DC@@@@BB@@@@B@@AB@DA@@B@B@@@B@@@BA@@@@BBB@@@B@@@B@B@@A@@B@BBB@@A@@B@@@@@B@F@B@B@B@D@BAB@@@B@B@@@D@D@@@B@B@@@@@FBB@B@@@B@@@DB@@FAB@BAB@DA@@B@B@DBFA"],encodeOffsets:[[111173,36447]]}},{type:"Feature",id:"621026",properties:{name:"宁县",cp:[107.928371,35.502176],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@ACAAA@@CBABG@C@A@CCACAA@@@A@A@ABAD@BAFABA@A@AAAAACC@@AA@C@E@CBAB@@@DAB@BCB@@CBCBCAA@AAA@E@@@ABCAE@E@C@C@C@C@A@C@CAAAAAEAC@A@@BABABCD@@AB@@@B@B@D@@@BA@ABCAABC@C@C@C@A@@@AB@@@BBB@B@@@BABADA@A@ABCA@@AA@@A@@AA@C@A@@AC@A@C@AB@B@DAB@@A@@@A@C@@@CAA@A@CBABABAB@BCDADA@CBABA@E@AAAAA@A@C@A@ABADADAFADA@ABA@AAAAA@@BA@@B@B@@A@A@CAA@A@@@ABADCDAB@@A@GBA@@@@AAAA@AB@@AAC@ABABABABABA@A@C@AAA@A@A@AB@@A@@B@BAB@@AB@BABA@B@ABBBAB@@@BA@ABA@ABABAB@B@B@BAB@B@@ABA@AB@BB@BDB@@@B@@BDDDFBDB@@B@B@BAD@BAB@@AB@B@@@DA@@BABA@AFABCBA@@@A@@@C@AA@@CAA@A@AACB@@A@AD@@@F@B@@A@ABC@@B@@AB@@B@AB@@A@@@ABAB@BAB@@@BCB@@@@@@@BA@@@@@A@AB@@@@@B@B@@ABA@@BA@C@A@ABABAB@B@@AD@BAB@@@DA@@B@@@B@@B@@@BB@@@@B@@@@BBB@@B@@DB@@B@B@BA@@BA@AD@@@@BBB@@DBB@@BBB@@BAB@@A@@@EBA@@@@B@@@B@DBBB@BBDDBBBBB@@B@B@B@DADADAB@@@@A@E@A@ABA@@@ABA@ABEDC@C@A@C@C@A@A@@BA@BFAB@@BFBB@@DBFB@BBB@BBBBDBB@@D@@BDB@@@@@B@@BB@B@BBB@@@@@B@@BBBD@B@@@B@@@@B@@BA@@B@BB@@B@@A@@BB@@@@B@@@B@@@@@B@@A@@B@@A@@@@B@@@@@B@B@@@B@B@BB@@BBB@@@@B@@B@@FABA@@@@AA@@@A@@@AAAAA@@@AAA@@D@@@@@@B@@A@@@@B@@@@B@@@BB@@@@BDDD@@@@@@@B@@@@@@@BB@@BB@@B@@@B@@@@@@BBB@@@@@@BBB@B@@BB@@@@@B@@@B@@@B@@@DAB@@B@BB@@@@@B@B@@B@@BBB@@AB@B@@B@@@@@@B@B@@@@@@B@@@@B@@AB@@BB@@@@@BA@@@@B@@@@@B@B@@@BAB@B@@BB@@@@A@@@ABA@@@@BA@@B@@@B@@@@@@A@@B@@AB@@@@ABA@@@@B@BA@@BA@@@@B@@@BAB@B@@@@AB@@@B@@@B@@ABAB@B@BAF@D@@@B@@AB@BAFABAB@BAD@BAB@B@@@@DB@B@@@D@@@B@@@@DCDA@@@A@@B@@AB@B@@@@@A@CA@A@@@AFB@@@@BA@@B@BB@@D@DA@@A@@A@@BABABADC@A@@CAEE@@@@@@@A@AB@BBBBBB@@@@DCA@BA@ABB@@DC@A@A@@B@@ABA@A@@B@@@@B@@@@DBB@BAB@@@@B@@@B@BBBBB@@@B@@ABA@AB@@@@@BB@B@@@@B@@@@@@A@AA@@@BB@@B@@@@@@@@BB@@C@@@@BFD@BBB@B@@@B@BBDBBBB@BB@@B@@@BB@@@BB@@BB@@BB@@@BB@@@J@@@@B@@B@@@@@@BB@B@@B@@B@B@@@@B@@@@B@@@BBB@@B@@B@@@BBB@@@D@B@D@@@@@B@@@@@BAB@@@@@@DB@@@B@BBB@@BB@@@B@@B@@@@@@B@B@@@DB@BB@@@B@BAB@@BB@@@BA@@D@DBB@BBB@D@B@B@B@@@BB@@@@@B@@B@@@@@AB@@@@@BDAB@@BBBB@@@@@B@B@@@BBBB@@B@@ABBD@@@@@@B@@@@F@@@@@@@B@BBDBD@DBB@@@B@B@@@BB@@@@@@F@@@B@BB@BBBBB@B@@@@BA@@BB@@@@@BB@@@BAB@B@BB@A@A@A@@B@@@B@@@@@BF@@B@DA@@DB@@B@B@@A@B@BB@@@D@B@BA@AB@@@FABBB@@@@@@@@B@@@@A@@@@@@@A@@@@B@@@B@B@B@B@@@B@@@@@@B@@@@@@B@@A@@@@@@@A@@B@@@@@@@@@@A@@@@@A@@B@@B@@@BB@BB@@@@@@BA@@@AB@@@@@@@@B@@@@@B@@@@AB@@@@@B@@@@@@B@@@@BB@@@B@@@B@@@@B@@@D@@@B@B@@@B@@@@@@@@@@A@@BA@@@@@BB@@@@@@@@@@@@B@@@@@@@@@@@AB@@@@@@@@@B@@@@@@@@BAB@@@@@@@@BBB@@@@@@@B@@@@@B@B@BB@@@@@@@@@@BA@@@@@@@@@@B@B@@@@@@@@@@@@A@@@@@@@@@A@@@@@@A@@@@@@@A@@@B@@A@@B@@@@@B@@@B@@@B@@@B@B@ABBB@BA@@BAB@@@B@@AB@@AB@BBD@@AB@D@@ABAD@@@B@@@B@@@B@@ABBB@B@B@BBB@@@@AB@@AB@B@@@B@@@@BD@@@BA@BD@@@BAF@@AB@B@@@B@BAB@B@@FD@@B@@AB@@@H@FBB@@@ABALD@B@@@@@D@@@@A@AB@HB@@@DAH@@@BB@@@AD@@A@@@AB@B@@BB@@@F@B@DAB@B@@@BBB@@@D@@@B@@@@@@@B@@@BB@@BB@BD@@@B@@@@BB@@@B@B@@@BB@ADBB@@BB@@B@BAB@D@D@FCD@BABB@@@AB@@AB@@@B@B@B@B@@@BA@@@AB@BAB@B@@@BC@@@@DAB@B@@A@@AE@AB@DA@ABA@@B@B@@@B@@@B@@@D@@@@@@@@D@@B@BA@@B@@@@BB@@@@BBDBB@@@@FBB@B@B@BD@B@@@F@@@BB@F@BADAB@D@PAFAB@B@B@H@D@@@DADABAB@H@BADBD@@@B@BABCB@DC@@BC@CDABCB@B@@@@@BB@B@B@BAB@B@D@B@BB@@@HAF@DAB@B@@A@GBA@E@A@AB@@ABABC@@@AB@AAAAACAA@@@ABC@@@C@C@ABABA@A@@AA@A@AACBA@A@@@AB@BAB@B@FBB@F@J@B@@ABG@@BA@@@@DB@@BA@@B@@@@@BBBB@@B@B@@A@@A@@@@@@@@ABBB@B@B@@B@@@@BBB@BBBA@@@B@@BB@@@@@AB@@@B@@@@B@B@@B@BAB@@@FD@@D@B@@@@BB@@@@BB@B@B@@@B@@AB@@@AB@BB@@@@@BD@@B@D@@@@@@BBB@@@@D@@@@@@B@@@@@@B@@A@@B@@B@@B@B@@@BB@BB@BBBA@@D@B@@@BB@B@@B@B@B@B@@@BB@BB@@@B@B@@@@@BB@@@@D@@@BD@BB@@@B@@@B@BB@BB@@@B@@BB@@@BBB@@BBB@BB@@@B@BB@@B@@@B@B@@@BB@@@@B@B@@@B@@@B@@@B@@@@B@@AB@@B@B@BBBB@BB@BBB@@@@@@B@@B@BB@@@BA@@BBBAB@@BBAD@D@@@B@B@@BB@B@@BB@B@BBD@B@B@DBB@B@B@@BD@@@BB@@@@@@BB@@B@@@B@@@@@@@BA@@B@@@@@BAB@@BDBB@@@B@@AF@@@@BBB@BBD@@@@@@DJ@F@DB@KH@B@B@H@B@B@@@@@@@@B@DA@B@@DBB@B@BBB@BB@@B@@AB@@@B@B@@BB@@@B@BB@@BBB@B@@@@@B@@@BA@@@@@@B@@@@AB@@@@@B@@ABBB@B@@A@@B@BA@@@@@A@@B@@@B@@@@@@A@AB@@@@A@@B@@@@A@A@@@@B@@A@@@A@@@@BA@@B@@@B@@A@@@@@A@@@@@@@@B@@@@@B@@A@@B@@B@@B@@AB@@B@@@A@AB@@A@D@@B@@A@@@@@A@@B@@@@@@A@@@A@@@BB@@@@@@AB@@@@@@AB@@@@BB@@AB@@@@@B@@A@@@@@BBA@@@@B@@@@@@A@@@@@@B@@AB@@@BA@@BABA@@@@@@BA@@@A@@B@@B@DBBB@@HB@@@@B@AFAFB@BF@@BD@BB@@B@B@D@B@@DB@B@@@B@@@BA@@@B@@@@@B@B@BA@@DBB@B@B@B@DDDBD@DBBAB@FDBBB@B@B@@@@@@A@@@@BA@@@@@A@@@@B@@@@A@@@A@AB@@@@A@@@@@@@@BA@A@@B@@@BB@@BFBB@BBF@@@BA@@BABA@@@@@@BB@BB@@@B@B@B@@B@BBD@@BB@@B@BBBB@BB@BDB@@BB@BBD@BDD@@BF@@AD@B@@@@BB@@BA@@D@@@@@B@B@DABAB@B@BB@@BAB@@@DC@@@@F@B@@@BCB@B@DA@@BCBABA@@@@B@B@B@D@@@@@B@BAB@D@@@@@@C@@BAD@DAD@@@B@B@B@@@@@@B@B@@@BBBDB@@BB@B@B@BBBBB@@@B@@@B@@B@D@L@DB@@@@@@GD@D@B@BA@AB@@@@AB@@BDB@@@@D@@@BB@@@B@B@@@@A@A@AB@@@BAB@B@BABABB@@BD@B@BBBBBB@@@BA@@@ABA@@B@BAB@BA@@B@BA@@B@@@BADABABB@AB@@@@AB@@@B@B@@@@@B@@@@@@AB@B@B@@@@@B@D@B@B@@@BA@AB@D@B@@@BADAFAD@DAB@B@B@@@D@BAF@B@D@B@B@BAB@@@D@@@B@@A@@B@B@BBBA@@@@@@B@@AB@B@B@BABAB@@A@@B@@@BA@@@@BABAB@B@B@B@@AB@@@BA@@B@D@B@B@@A@@BB@@B@@AB@B@B@BA@B@@DB@@B@BB@@BBD@@BBBB@D@@@@D@@DDFL@B@B@B@@@DDDBB@DBB@H@D@D@BAB@@@BBD@B@@B@FBB@@@F@HBD@F@J@D@D@B@F@J@D@D@DBH@B@JBLBFBB@BBF@D@B@H@FBD@D@JAFADARCDAF@D@F@@BB@@B@@@BB@B@R@F@D@LBBBDBB@FHBBLKPULSBMDQ@OAO@O@A@A@A@@AA@ABAAOAS@CAAA@CAAA@A@C@CBEBCBEDCDCDCFEBCBCAACCAA@@@A@E@EAACECEGIAAC@A@@@AA@A@A@CBCDABADADABA@AAC@CACAAAAEE@A@A@CBABEDC@@DA@AHABCBA@@BE@AACA@@@@@@AAC@A@A@@EICMFS@KHSDK@A@CHG@CDA@EBKBKFCDCDADAD@HAD@HBDABAB@BEDEEBCAA@A@@@CBA@ABA@EB@@CA@@A@@@A@A@EA@@@@A@A@@@C@C@@@A@A@@@A@ABC@A@A@A@E@A@@@@@A@@@@BA@AAA@@@@BA@A@@@A@@@A@AA@@@@AB@@A@@@A@A@@@CBA@@BA@@@@@AA@@@@CD@@A@@@@@@AA@@@@@G@AAA@@@CBC@A@A@A@C@ABA@@@A@A@@@CBA@A@ABABA@@BA@A@@@@BA@AB@@A@@@@AA@@@@@AB@@@@CC@@@@A@A@A@@AB@A@@@@A@@@@A@A@A@@@AAAAA@@@@@@@A@A@C@A@E@@@A@@AA@A@@@A@A@A@A@CA@@AA@@A@A@@@@@A@A@A@@@@@A@@@@A@@@@@@AB@@@@A@A@@@@@A@@@AB@@A@A@@@A@@@@A@@CAA@AA@@AB@BC@A@@@AAA@AAE@@AA@@A@@C@A@@BAB@@@@@@@AAAA@@@@@@AA@@@A@@@A@A@A@@A@@AABC@C@@@@@@A@@@@BA@@A@@@ABA@@BAB@B@BA@AB@@@B@@@@@@@@B@BB@@ADB@@BADA@@@@AAA@AAA@@ABA@@@AB@DA@@@@@@@AA@C@@@@AA@@A@@A@@@A@@@@A@@BADA@@@A@@@CA@AAACAC@AA@@@@@A@@@A@A@AAA@CAA@@@@@C@CA@@A@@@A@A@C@@@GAA@AA@@A@C@A@A@C@A@@@A@A@@@A@@@C@A@@@A@@@@B@@A@AB@@@A@@ABA@A@@@A@@@@@AB@@A@@B@@@@AA@@AA@AA@A@C@AB@@A@A@@B@@A@@@AAAAA@AB@@CA@@@@@A@@@@@A@@@@@A@@ABAA@@@@@AB@@@@@A@@@@A@@@@@AA@@@@@@@@@@@@@A@A@@BAB@B@@@BC@@@@@BB@B@@@@@B@@A@@@@@BB@@@@@B@@A@@@A@A@AA@@AA@A@C@@@@A@@B@@@B@@AAA@A@C@A@@@A@A@@BA@@@@@AA@@@AA@@@AAA@A@@B@@@@@@@@ABA@A@A@ABA@A@A@@@A@AAAAAA@A@@@@BAB@@CB@BA@@@@@A@@@A@@AA@@A@C@AA@@A@AAA@@AACAA@@@@@A@@@ABAB@@@D@@@@@@A@@B@@@B@@BB@@BB@BBB@BAB@@@@@@@B@@@BA@@@A@A@@@@@@@A@@@A@@AA@@B@@A@@@A@@@@@A@@@A@ABA@C@A@@@@@A@@@@@@@@@@@@@A@@@@@@A@@@@@@A@@@@@@@@@@@A@@@A@@@@@A@@@@@A@@@@@@@@AA@@@@@@@@@A@@@@@@@A@@@@@@@@A@@A@@@@@@@@@@@@AA@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@AA@@@@@@@@AA@@@@@@@@@A@@@@@@@A@@@@@@@@A@@@@A@@@@@@@@@@B@@A@ABAB@@@@@@@@@B@@@@@B@@BB@@@@@@@@A@@@@B@@@@@@A@@@@@@B@@@@@B@@@@A@@@@@@@@@@@@@@B@B@@@@@@@@@@A@@@@B@@A@B@@BA@@@@@@@@@@@@@A@@@AAA@@@@@@@@@@B@@@@@@@B@@@@A@@@@@@@@B@@@@@B@@@@@@@@@B@@@@@@@@@B@@@B@@@@@@@@@@@B@@@@A@@@@B@@@@@@@@@B@@@B@@@B@@@@@@@@@B@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@B@@@@@@@@@@@B@@F@@AB@@@@ADA@@BC@A@@AACAIAA@C@A@A@EAA@A@A@@@@@AAA@EA@@A@@@AB@@@@A@@@C@@@A@A@@BA@@@A@A@@@@@A@@@A@A@@@@@@@A@A@A@A@@@C@@@A@@@ABAA@B@@@@A@@@A@@@A@@@@@A@@@C@@@@@A@@@AAA@AAA@CAAAAAA@@A@@A@@@@@@AA@AAAA@@@@AA@@A@@A@@AA@@@@A@@@@A@@AAAA@@@@@@@@@AA@CA@A@@A@AAA@@@@A@@AA@@@A@A@@A@ABAA@@@@A@A@@@@@A@@@A@@AAAA@A@@@A@@A@@@A@@AA@@A@@@@@@@@@A@A@@@@@@AA@@@@@@A@@@@@@@@@AA@@@@AA@@@A@@@@@@@BA@@@@@@AAA@@@@BA@@@A@@A@@@A@@A@@@@@@@@BAA@@A@A@@A@@A@@@AAAA@A@@A@@@AA@AA@AB@@@@@A@@A@@@@@@A@@A@A@@@@AA@@@@A@@@AA@@A@@@@A@@A@@@AA@A@@@@@AAACA@@@AA@@A@@BAA@@A@@@@@AA@@@@A@@@@@@AA@@@AA@@AA@@@AA@@@A@@@CC@@AAA@A@C@AA@@@AA@@@A@AAA@A@@@AA@@CA@@A@@A@@A@@@A@AAA@A@@AA@AA@@@BA@@@@@AA@@AAAAA@@@AA@@@@@@AB@@@@AA@@A@@AABAAA@@AA@@@@@@AA@A@@@A@A@@@@A@@@@C@@AA@@A@@A@@@AAA@A@@@@A@@A@A@@@A@@A@@@@AB@@@@@@@@A@@A@AA@@A@@@@@@A@@BA@@@@A@@@@@@B@@@@AA@@@@@A@A@@AA@@@AA@@A@@AA@A@@AAAA@@A@A@@@@ABA@@@A@@A@@@@@@AAA@C@@@A@@@@@BAA@@@@@A@A@C@@B@@@A@@@A@@A@@@CB@@@@AA@@A@A@A@@BA@@@@@@A@@@@@A@@@@@@@@A@A@@@A@@@@B@@@@@@A@@A@@@@@@@@@@@A@@@@C@@@A@@AC@@@A@@@@@A@@@@AA@@@A@A@A@@A@@A@A@@@A@@@A@@@@@A@@B@A@@@AA@C@@@@A@@@@@@@@A@A@AAA@C@A@AA@AA@CAAAA@@@@@@A@@@@@AA@ABAA@@@@@A@@@@@A@@@@A@AB@@@@AA@@@A@@@@A@@@A@@@@C@@A@@@C@@@@@@@BB@@@@A@A@A@AAA@@@@@@B@@A@@@@@A@A@@A@@A@@B@@A@C@@@@BA@@@C@@@@@A@AB@@A@@@A@@D@@A@ABA@@AA@@@A@@@@B@@@@A@@@@@@AA@@@@@@@AB@@@@AA@@@A@A@AA@@@A@A@A@@@AA@@A@@@@A@@@@@AA@@@@@C@@@@A@@@@B@@@BA@A@AB@B@@@@@@@@ACA@A@AAABA@@B@@A@@@@AA@@@A@AA@@AA@@@CA@@A@AAAA@@@A@@@@@A@@@@AAAAC@@@@@@ADA@@@@CA@A@@@AA@AAA@@A@@@@@A@@BA@@@@A@@@AAA@@@BA@A@@AA@@A@@@@A@@@@AA@AAA@AA@@AAA@@C@@@A@@A@AA@A@@AA@@A@@@A@@AABAA@@A@@@@C@AA@@AA@AC@@AA@AAA@A@@BCAAA@@AA@A@@C@@AA@@A@AA@@AA@AACAAAAAA@ACA@AAAA@@A@@@ACA@AAA@A@@@AA@@AA@@AA@AA@A@A@AAAAA@AA@A@@C@@@A@@@@AA@@@@@@A@@@@A@@@A@A@@A@@@@AA@@A@@AA@@@@A@@AAA@@@A@A@@@A@@AA@@AA@@A@@@@AA@@A@@A@@A@AA@@@AC@@@A@ACA@AA@AC@AA@@CAA@AC@@A@A@@A@@AC@@@A@ABCE@G@A@"],encodeOffsets:[[110529,36114]]}},{type:"Feature",id:"621027",properties:{name:"镇原县",cp:[107.200832,35.677462],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@AA@AAA@GMNB@B@BBDDHE"],["@@@C@ABA@@AAAAAA@AAAAC@@AA@@BC@A@C@@@A@C@@B@B@BB@@BB@@@@B@DAHC@@B@@@@@AB@B@BB@@F@@@B@@@@D@B@BB@BBB@F@B@J@B@@DBB@D@B@@B@B@B@B@@@B@@@B@B@F@B@B@@@@C@A@@A@A@CAC@@@@A@CBABA@@@A@A@@@@@A@@@C@C@@@A@GF@@@B@@@FAF@B@B@@B@@BD@@@@@BB@@@D@B@BADABAB@@@B@D@@@@BD@@@D@@@@@@BABCB@@@DA@@BA@@@AB@B@@@BA@@BABABABCBA@AB@@A@A@A@@@@B@DBD@@@@@BBBBBB@@@@DBBB@@@@@@BB@@@@@@B@@@@@@ABA@A@A@ABA@AAA@C@CB@@@B@B@B@B@B@@@D@B@B@B@B@@@@@BCAG@A@A@AB@BA@A@@@ABABA@@@A@@@@A@@CA@@@@A@A@@BA@A@G@A@C@@B@@@B@@A@@B@@@@B@@BB@@D@F@B@@@B@@A@@A@@AAA@AAA@CAE@A@@A@AAC@A@@@@@@@AA@AB@@C@@@@BBB@@@@AB@@@B@@A@@B@BADAB@D@FA@@BBB@@@B@@@@EB@@@D@B@@@@C@AB@@@@@BA@@@@@CAC@@@A@AAA@A@@BA@A@@A@A@AB@@ABCBA@A@CDGDAE@@@ABA@C@C@@@@A@@@@@A@@AAA@@A@@AA@AAA@@A@A@AA@@@@@@@ABAB@BA@@B@@AA@A@AA@@AB@@AAA@@@@@@DA@A@@AAAA@@AAA@A@@@AA@@@ABA@CBAB@@A@AB@BA@A@@A@AAAAAEAA@@BADADADADC@@BACA@C@AAA@@@AA@A@@A@AAAAC@@@@@A@A@@@C@A@@@C@@@A@@@A@@@AACAC@A@@DBBBB@B@DBB@@@D@B@BBB@B@DB@@@@B@B@@@@@BB@@@A@@BA@@AAAC@@@ABA@@@A@A@@@@@@B@@@BB@AB@BABADADABA@@@B@@@DAHAD@@@@@BD@B@DBH@B@B@B@D@@@@A@@@CAA@C@@@CDC@A@A@@@A@A@ABA@@BAB@BAB@F@DBB@B@@@BA@@@@AC@@@A@@@@@ABC@C@A@@B@@@B@@@BD@BB@FB@@BBB@BB@B@@B@@@B@@@D@D@B@@BB@HBBBB@@@B@@@CCAAACAACAAAAAA@AAA@AAAA@@@@@A@@BA@@B@@A@@@A@@@@CAAE@@@@B@BA@@@A@@BAB@@@B@B@@@@A@ABA@@@A@@BBDDB@B@B@@@@B@@@BBBBB@@@@BA@@@@@A@@D@D@@@B@@@BCB@BAB@@B@@@B@F@@@BB@@@@@B@B@D@@@FCB@B@@@B@B@DCB@@@B@@@@BB@@BBBB@BBB@BBB@BDB@@BB@@@@@BB@@@A@@@ABA@@@@BABA@AB@@C@A@@@A@@B@@@BA@@B@BA@@BA@@B@BBB@DB@BB@@B@BB@B@@@@@B@BADADABABABCBA@A@@AAA@@A@EA@@A@A@A@C@@@@@@D@B@B@BB@@B@@@D@D@@@B@@@B@@B@@@BBB@B@F@BA@@BAB@D@@@@@BB@@BB@@BAB@@BB@B@@DD@@HB@@DB@@BBD@@@@@B@@B@BBDB@BBBB@@BA@@B@B@B@B@@@B@@@D@@AB@BABADGBADABA@@@AAC@AAA@A@@B@@@@@BBB@@@B@BA@AB@DAB@@@AA@AA@@A@A@@B@@@DDB@@A@@@AB@B@BBB@@@B@BAFAB@@@BB@@@@B@@@B@BCBA@@B@@@B@@B@AB@@AB@BADA@@@@BBBBD@@@D@HB@@D@@BDB@@B@@AAAAC@A@A@@@A@ADCBA@CBA@ABA@@@@@B@BBDB@@@B@@@BA@A@A@E@@@A@@BADA@@B@@@@@@@BB@@DDDB@@@@B@@@AC@@@A@A@@BA@@@AAA@AAA@@@A@@@AB@@B@@@@BB@@@@B@@@@@B@@D@@@@B@@@@ABEBC@@B@@AD@B@B@B@B@F@B@B@D@@@B@@A@@A@@A@@@A@@@CB@@A@ABC@ABE@@@@BA@A@A@CBA@A@@CA@@AA@AA@AAAACA@A@@@A@ABA@A@@@@BBDBB@DBFBFBBBB@@A@@BC@@DA@A@ABCBCB@BA@@@A@@@AB@@A@@B@BBB@BABAB@BA@@@@@BB@@@@@@@@ABC@@D@B@@BB@@A@@@AB@@@@@B@@B@@BAB@DCB@D@B@F@@@@@@@@@@@@AAE@C@A@A@ADA@@@CBC@C@AB@DA@AB@@@BABA@ABABAB@@@@@BA@@B@@@@@BA@@@@@@@A@@@@@@@@@@@A@@B@@@@A@@B@@@@A@@@@@@@@BA@@@@@@B@@@B@@@B@@B@@B@@A@@@@@@@@@@@@@A@@@@@@@@@@@@B@B@@@B@@@B@@@@@@AB@@@BA@@B@@@B@@A@@@@@@BA@@@@BA@@@@B@@AB@@@BA@@@A@@@@@@B@@A@@@@@@@@@AB@@@B@B@B@@@@@@@@@B@@BB@@@B@@AB@@@@@@@B@@@B@@@B@BA@@@@@@@@B@@BAB@@@BBB@BBBB@@@@AB@@@@BB@B@B@B@@@@@BAA@BA@@D@@@@ADABAB@B@DBB@B@H@BB@@B@@B@@@@BB@@B@B@@@BBB@@@BB@@@@@@B@@@@AAA@@BA@A@A@A@@@AB@@AD@@@BA@ADADA@@@@A@AAAAA@@A@@BA@A@C@@B@BB@@BAB@B@@@@A@@@A@C@A@@AB@@@AAA@@@@@@AABA@C@A@@BC@@B@BBB@B@B@HBD@@@@@@@@A@A@A@AAAAA@A@@@A@@CC@@@AA@@@@AAA@@@A@@@A@C@A@A@AB@@B@@BAB@@@@@AA@AA@A@@@@@@@BAB@@AB@@@BAB@@A@@@@@@@A@@B@BA@@@@B@B@@@@BB@@B@@B@B@@ABBBA@@BB@@@@B@BAB@BA@@@C@ABA@A@C@A@@B@@BB@@B@@@B@B@@B@@B@@BAFC@@B@@@@A@A@@A@@A@@BA@AB@@BD@B@B@@@@@@A@@@AL@@@@@B@@A@C@@@AB@BA@@BAB@@@BA@@B@@@B@@@@@BA@A@C@ABCA@@A@A@CAA@@@A@@BA@@@A@@DA@A@@@@@@BAB@@@@ABABAB@B@B@B@FDB@D@BA@@@@@B@@@BBB@@BA@@@A@@AA@A@ABC@A@AAGACA@@A@A@A@ABA@C@C@C@A@A@A@C@@BG@E@A@AAAAA@@@AB@DCBCA@@@@@@@BA@AB@@@A@AA@@@@@@@@B@B@@@@A@@@@@@@A@@@@@@BA@@BA@A@ABC@A@C@AAA@@@ABA@CB@AC@@BA@AFCDCDC@A@A@@@A@AA@AAAA@@@A@A@@@CBC@AA@@@BA@@BABA@@BA@A@A@@B@BA@@@@@E@@B@@A@@@CBA@AB@@A@A@AB@@A@@@@BA@@BA@@@A@A@@@A@A@A@A@C@@BA@A@@BA@@@A@@@A@@BAB@BADE@@BAB@@A@@@AB@B@@@B@BA@ABABABA@@@ABA@A@C@ABA@@BC@C@@BA@C@C@@@@A@@@@A@@A@@@@@@AA@@@@A@@@@@AB@@@@A@@A@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@A@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@B@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@B@@@@@@A@@@@@@@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@A@B@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@BA@@@AB@@@@@@AB@@@@AAA@A@@@@@@AA@@@@@A@ABA@CAC@A@C@@@A@@@@@A@@@@BA@ABA@@@A@@@@@A@@A@AAA@C@A@AA@@@A@@B@@AB@D@B@@ABABC@ABA@ABABCB@@ADI@@@@B@D@D@B@@@BADC@@BA@A@A@CB@@ABA@ABA@A@@@AA@ACAA@@@A@ABA@@AA@A@@BA@A@C@A@@@@DB@@@@@ABA@@BA@@B@@A@@@@@A@@@AA@AAA@@@@A@@BAB@B@DAD@@@BA@AB@@A@@B@@BB@B@@@BB@@@@@@BA@@B@DB@@@@B@@@B@BAB@@@AA@BAA@@A@@@AAA@IA@@@@AB@@A@@@@AAC@C@ABC@CBAB@D@BAB@B@@A@@BA@@@ABC@@@@BA@@@AB@BA@@BA@@@A@@@A@@BA@A@@@@@A@@@A@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@B@@@@@@A@@@@@@@@@@@@@@@@@C@A@A@@BA@@B@BA@@@A@@@@@A@@BB@@@@@A@@AA@ACC@AA@@@A@@@@A@@A@@A@A@A@A@@BAB@BA@@B@BB@@FBB@@@B@@ABABA@@CC@C@@@@BABAD@@AB@@A@A@@@A@A@@@AB@AACA@@@C@A@AB@@ABA@@CAE@A@AA@@@@@@B@@ABA@AD@F@BAB@BA@@@@@@C@@A@@@@@A@AB@DA@@A@A@@@A@@AB@@@@@DA@@@A@@@@BB@@@@@@B@@A@@@@@@CACA@@AA@@@@BA@A@@@@A@@@@@@@@@@A@@BA@@BABAB@@@@@@@B@DBFB@@BB@@B@@@@@@@B@@@@@@@B@@@@AB@@@@A@@@@@@BAB@@@@@@@@@@@@@@B@B@@@@BB@@@@@@@B@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@AB@@@@A@@@@@@B@@@@A@@@@@@@@@AB@@@@A@@@@@@@AB@@@@@@A@@@@@@@@B@@A@@@@@@B@@A@@@@@@@@@AB@@@@@@A@@@@B@@A@@@@@A@@B@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@A@@A@@A@@@@@A@@@@A@@A@@@@@@@@BAD@DAB@@@@A@@A@@@@@@@@@@@@AA@@@AA@@@@A@@@@@@@AA@@@@@@@@@@@AA@@@@@A@@AA@@@@@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@@@A@@@AA@@CAA@@@@@A@@@@@A@@@AA@@@@A@@@@A@@@A@A@A@@@A@@@ABA@AB@BA@@B@@AD@FABAB@BA@@@@@@@@@AA@@@@A@@A@@@@AA@AACA@@@@@@@@A@@A@@@@A@@@@@@@@@@@@@A@@@A@@@@@A@@@@AA@@@A@@@@@@@C@A@AA@@A@@@@@@AA@@@@@A@@@@A@@@ABABCBABA@@EAAD@@@@@@B@@@AB@B@@A@@@@@CA@@@@@@@@A@@BABAB@@@BBB@@EB@@@B@@BB@@B@@@@@@BA@@B@B@J@@BB@@@@@BD@@B@B@@C@@@A@@@@@@B@@A@@@@@B@DB@@@@@@A@@@A@E@@@@@@BA@A@AA@@@@AAE@AAA@CAC@@BAD@@CA@BAB@@A@A@@@C@ABA@@@@B@@DB@@@D@@@@A@BBAB@@@B@@B@@@@B@B@@@B@@A@@@@BBD@@@B@@CB@@EB@@A@@B@B@@BB@B@@@B@@@BC@ABA@EBA@A@A@@@AAAA@@@A@@@A@@AACC@@@AAA@@@@@AAA@C@@AAA@EAA@@@@AAAA@A@@@@AAA@@CC@@AB@@C@@@@@@C@@@AA@@@@BA@@@A@A@AA@A@ADE@@@AA@@C@@B@@A@A@@@A@A@@@ABAAA@A@AA@AA@@@@@A@@A@@@@@@@@@AA@A@@AAA@@@AAAA@@AA@@@@A@@@ABCDA@AB@@@@A@@A@@A@A@AAA@@@C@A@A@@@@@@AA@@@ABCBA@ABAB@@@@@BA@AB@@@B@@@@@@@@@@@BBBB@BB@@C@@@@B@@BBBB@@@@ABABABA@@@@@@BBB@BBB@@@BBB@B@BABA@@@@@@@A@@B@@C@A@A@A@AAA@@A@@GB@B@@AB@BA@@@A@ACA@@@A@A@AB@@A@@@@@@BC@A@A@@BCB@BAB@@@BB@@B@B@@BB@@@B@@@@@B@@A@CA@@C@@@A@CBCBA@@@A@@B@BA@@@@@A@@A@A@@@@BA@@@@@@AA@@@@A@C@@BA@AD@@@B@@@@@B@@A@@@A@@@A@@@@@A@A@@@@@@DAB@@A@A@EBA@A@A@A@A@A@AA@CAA@@EBA@AAC@@@@@@B@@@D@@@@ABA@@@@@@BA@@BCB@BAA@A@@AC@@CA@AFAB@@@@A@CAE@A@@AA@@@@AB@@AB@@@@@@AA@@@AAA@@@AA@B@@A@A@@AA@@CAC@C@@@A@A@AB@@AB@BA@@BCBA@CBA@A@A@A@A@A@ABA@ABC@EDCA@@@BA@@@CB@@@AA@@@@@A@@@@@@@@@A@@@@@A@@B@BA@@A@A@@A@A@ACB@AA@@@A@A@@AA@A@@A@C@A@A@@@@B@@@AA@@@A@@@ABABA@@@AA@@AA@@@AA@E@GAA@@@@B@B@@@@A@@@CBA@ED@@@@@B@B@B@B@@A@A@@@@@@B@B@@B@@@@B@@@@@@@B@@@B@@A@@@ABGBE@@@BBA@@@AA@@A@CBCBABCD@@ABA@@@AB@@@BB@@@@D@B@F@@BD@@A@@@A@@@G@A@AAAB@@AB@@@@C@@@@@@@CA@@@@AA@@A@A@A@@@A@A@@@@@A@A@@@AAA@C@@@A@@@@@A@@@A@@@A@@@A@@B@B@@@@C@C@E@@@AB@@ABEBEFAB@@@@@@AA@@@@A@@@E@@@@@DCBA@@@A@C@A@G@C@E@@B@@AB@@@B@FB@@AA@@AA@A@C@@AA@@CC@@@AA@@@@A@@C@ABE@@@@@@@@B@@@@@B@B@B@B@@@B@@E@A@A@A@AACAAAAB@@@@@BA@@B@@BB@@A@@BA@A@@@A@@A@B@@A@@@@@@@@BA@@@BB@@BB@BBB@@BA@@@B@@@@@@ABABABA@A@@ACA@@A@A@@@A@A@A@@@A@C@AA@@A@A@@@@@@@@A@@A@A@@BAA@@@@@@A@A@@BAAA@@@@@A@@@A@@@AA@AA@A@ABCAA@C@@@C@AA@@ABAA@@@@A@@B@@BF@B@DABEDCBAB@B@@A@@AA@@@@A@@@C@@@@EA@@@A@EAAAA@@@ABAB@AA@A@@A@@ACA@@AA@@A@AAA@@@AA@@AA@@@@AAAAB@@CBE@@BAB@DC@AB@DAF@@A@@@@@@A@@@@EAA@A@@A@A@@A@A@@@A@A@A@AAAAA@@AA@AA@AA@@A@CCA@@A@AAA@@@A@@@@@@@BA@@BA@ABA@A@A@ABAB@@CBA@@BA@A@@BA@CBCBA@AB@@A@@@@@AA@B@@@@ABA@CB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@A@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@AB@@@@A@A@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@B@@A@@@@@@@@@A@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@A@@AAAACCAAAA@A@@@A@@@@A@@A@@A@@@@@A@@BAAA@@@@@@@@@AAAACAA@AAA@@@AA@@@@@@@@BC@@@A@@@@BA@A@@@@@@CCA@@A@@@@@@@@@A@@@@@@@@@@@A@@BABA@@@@CAAAABAB@@C@A@ABAB@@A@CB@@A@@@@BABABABGHABABEBA@E@E@A@EBA@@@A@@@@@@A@ABA@A@A@@AAA@@@@C@A@C@@@AAA@@AACA@@@@@@@@@A@@A@@@@B@@@D@@A@CB@B@@@BBD@@@B@@@BB@B@@@@BAB@BA@@@@@AD@@@D@@@B@@@@@@@B@@AFCBABAB@B@@BB@@A@@@A@A@@@A@AB@BAB@B@@@B@@@B@@@BA@@@A@@@A@AB@BA@A@A@@@@BC@@@@B@@ABABA@AB@@AB@@@B@@A@A@@@@BA@@@A@@B@@AB@B@@A@@A@A@B@@A@@BA@A@A@@@@@AB@@@@@@ABA@A@A@@B@B@B@@@@A@@@@AAA@@@@@@@B@BA@AB@@ABABADAB@@@A@@@A@@@@@A@@ABA@A@CBA@@B@@AB@@@B@B@@A@@@@A@@A@@@@@@@@B@@@B@@A@A@@@A@@B@@@@B@@@@B@@A@A@@B@B@B@@@BADA@@@ABA@CB@@A@@@@B@B@@@B@BC@A@@B@@A@AB@@AB@@ABC@@@@B@@AB@B@@@@A@@@@@A@@@@@@BA@A@@@@@@B@@@@A@@BAAA@A@@B@@@@@@B@B@@B@@@BA@@B@@AAA@A@@@A@@B@@@@B@@@@B@@A@@B@@A@@A@@@@A@@B@@@@@@C@@@@B@@@@BB@@@@@BA@@@AB@@A@@@A@@B@@A@@@ACAA@@@@AB@@@@@B@B@B@B@@@BA@ABA@@BAB@@@@A@A@@@BA@@@@@@A@@A@@@@@@A@@@@@@@BB@@@@A@AB@@A@A@A@@@@@BBAB@@@@@B@@CA@@@@@B@@@@@B@@A@@@A@@@@@@BB@@@@BA@@@@@A@@@A@A@@@@B@@@@CBA@@B@@ABAB@B@@@@AB@@AB@@@@A@A@@@ABAAAB@@@@ABCA@@AB@B@@ADA@@@@@@@@B@@BBB@@@B@@@B@@B@B@@CA@B@@@@@@@@A@A@@A@@AB@@AAAA@@@B@@AB@@@@C@@@@@@BB@@@@B@@AB@@CB@BAB@@@@AB@@@B@BA@A@@@AB@@@@B@@@B@@@@BA@@BAB@@@B@BA@A@@@@@AB@@@@@BA@A@@@A@@B@@@B@@B@@BA@@@@B@@@BAD@@@B@@A@A@@@@B@@@BA@@@AB@@AB@@@BA@@BA@@@@@@@AB@@A@@@@B@@@@E@@@@@@BA@@@AB@@@@@BAB@@AB@@CB@@@@A@@B@@DDBB@@@B@@C@@@@@@B@B@BA@@B@@CAA@@@A@@@A@A@@@A@@AAAA@@B@B@@C@@B@@AB@@ABA@@@A@@@@@@B@@ABA@EDA@@@@BA@A@@@AB@@@BC@ABABA@A@AB@@AB@@A@A@@@@BA@@@A@@@ABA@@BA@ABA@@@AB@@A@@@@@ABA@A@A@A@ABEB@BA@@@A@CB@@A@A@@@AB@BA@@@A@@B@@CAC@@@AB@@C@@@A@ABC@@@A@CBA@@@ABABC@@@ABCBA@CBAB@@ABA@ABA@@BEBA@C@A@@@ABADAB@@ABA@A@CBA@CB@@A@@B@@CBC@CB@@A@@@ABEBA@C@ABA@A@A@ABABA@ABA@AB@@AB@@A@@BB@@@@B@@BB@@BFADAHC@E@@ACACD@BBBDDDDB@B@@BABAB@FBF@@@FBFAH@BBBD@B@BED@DBFDDDFBDA@A@C@C@ABBBBBBD@DABCB@BB@@BBF@BA@CBAB@BBD@@CB@BABAB@BBDBBB@AB@@G@ABAFEJA@@BBB@@BB@@@BBB@@@@@@@@@BB@@@@@@B@@@@B@@@@B@@@@BB@@B@@B@@B@@BB@@@@@B@@BB@@@@@B@@@DB@@@BB@@@B@@@@@B@@A@@@@@BB@@@@@@AB@@@@@@@@@@@@@B@@A@@@@@A@@@@@@@@@@@BB@@@@BB@BB@@@@@B@@AB@BABBCDAFEH@D@BBB@BFBB@B@H@DCB@DIDA@@BF@D@BB@@@FAH@FBHBHADAFCDCHAB@BBBB@BBH@HAL@H@FBFBHAH@DDDBBD@@@F@DCFAHCDC@GCIB@FANADB@@@BAB@DBHBHBDDBDADCDEBAFBBFAF@F@BFA@@B@ABABCD@DFD@D@HA@ABEAABD@BB@@ABC@CAA@ABABBDAF@HA@A@CAA@ABAB@D@@BBBB@@D@B@FCHAJ@BBDA@@@@@@BA@@DA@A@@B@@@@@@@D@FDDD@D@BADC@G@EBAB@B@BBDBBB@ADCF@BADADCD@@ABGCEAECCB@@A@@B@@@@AB@@@@@B@@A@@B@@A@@@AB@BA@@BA@@@@B@@@@@B@@@@AB@@@@@@A@@BA@@BAB@@@@AB@@@BA@@@CFAB@@@@@B@@A@@@@@@BAB@@@@BF@@@B@@A@BB@BABBBCB@@@BC@@GAABAAA@BI\\ADC@A@@B@BBB@BDDB@B@D@DEDADCB@BFCF@F@DCBADCBA@AAAEAAC@EBC@EBE@EBCBEBCFAB@DBBF@BBDBFDFB@FADAFABABD@BDAB@BB@FC@@HBB@BABAB@DFDDFDFFHDFBF@DAFAFCDCD@D@@F@D@D@DAD@DB@DA@@BB@DB@@@@@@@@B@@BD@@DDB@B@@AB@BAB@@@BA@A@@@@B@@@@@B@@@@@B@BA@@B@@AB@B@DD@AJFBBBDBBD@DCDABHFJ@DABA@@B@@@@AB@B@B@B@@A@@B@@@@AB@@@B@@@@@@AB@@@BABEEG@GBEACCCE@EACE@MDCAIDEDEH@@AF@FGVBBBADCBBF@@A@CAA@AB@FBBBDAB@BDFCDC@ECC@E@CBEFCD@BD@V@HBFDFDBDB@@BB@HBD@@B@FCBB@@B@BFDF@@HBJD@@HHDH@JAFB@@@@@@@@@@B@@@@B@@@A@@B@@@BB@@B@@@@@B@@B@@@A@A@A@CAA@A@@@@@@B@@ABA@@@AB@@A@A@@@A@@@A@@@A@@@A@A@ABA@@@AB@BA@ABAB@@@@A@@@@@@B@D@B@@@D@@@@@B@@@BBB@B@@@B@@@B@BB@@B@@@DBB@@AB@@@@B@@@D@@B@@B@B@@BB@D@B@B@@BB@@@B@@@@B@@@D@B@@@B@@@D@@@B@B@B@B@D@@@B@B@@@B@@@BB@@B@@@B@@@@@@@@@@@@@B@@@B@@B@@@B@@@@@B@@@@@@BB@@BB@@B@@@@@@@@@@@@A@A@C@@@@@A@@@@@@@@@A@@@@A@@@@@BA@A@@@A@@@@@AB@@@B@@@B@@@@BB@@@@@@@B@@@B@B@BBBBBBB@@B@@@B@@@@@B@@@@B@@@@@@B@@@B@@@@@@@BBB@B@@B@@@BBB@@AB@@AA@@@@@BBB@B@@@DAB@@AB@@B@B@D@B@B@@@B@@A@@@@A@@AA@@@@@D@BAB@@@BBBA@@BB@@@@A@@BA@@@@B@B@B@@@@@B@@@@@@@B@@@@A@@B@@A@@@@B@@@@@BBBBD@D@B@BAD@@@B@@A@@@@@A@@@@A@A@@@@@A@@@@@BA@@B@@@@AB@A@@@@@@@C@A@@@A@@A@@B@DAJ@D@@@BA@A@@@C@A@C@A@A@@BABABABABABAB@@@@A@@@B@@B@@@AB@@@B@@@@@BB@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@BA@@@@@@@D@DAB@B@BB@@B@@@D@DB@@BB@@@@@@@BBB@@@@BB@@@@@@B@@CDCBAB@@A@@@A@@@@A@@A@@A@@CA@AAAAC@CAA@AAA@@@AA@@@A@@@@@A@@@AA@@@@@@@BA@@BA@@B@@A@@@@@A@@B@@@@@BBB@@BD@B@B@B@B@@BB@B@@@@@@@@@B@BB@@B@@@B@@BB@D@@@B@@@B@D@B@@@@@@A@@@A@@@@@AA@@AA@@@@@@@@A@@A@@@@@@@@@@@AACAA@@@AA@@@A@@@AA@C@A@A@E@C@@@@@@@@@@@@A@@@@DAB@B@@@@@@@@@@A@@A@A@@@AA@@@@@@B@@@B@@@BBD@BBB@@@B@@@@A@@@A@AA@B@@A@@@AB@@@B@BA@@B@@@@@@@@AC@@@@A@@A@@@@A@@B@@@@@@@@@BA@@@AB@B@@AB@BBB@B@BBBBD@B@@AD@@A@@@@@BDBB@@BBBDBD@B@@@@@@A@@@A@ABA@@@ABA@@BA@@@AB@BAD@BABABA@@B@@ABAB@@@@@B@@@B@@@@@@ABA@@@A@@@A@@@@@@@AA@@@@@@@@A@@B@@@A@@A@@A@@A@@A@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@B@@@@A@@B@@@@ADAB@@ADA@@B@@@B@@@@@BA@A@AD@@@@A@@BA@@@@@@@@@@AA@@@AA@@@@A@@@@@A@@@@@@@@@@@AB@@@@B@@@@@@@AB@@AB@@@@@@@@@@@BA@ABA@@@AB@BABA@@B@B@B@B@BAD@B@@@B@@@@ADA@@DABA@AB@@AB@BA@AB@B@@@D@@BB@BB@A@@@@AA@@@@@A@A@@@A@@@AB@@A@A@A@AA@@AA@CA@@@BA@@@@@A@@@@@@A@A@AB@@@BA@@@@A@@@@@@A@@@ADAB@@@@A@@@@@@@A@@@@@@D@@@@@@@AB@@@@@@@@@@B@@@B@@@DAFAF@HAD@BABABABAD@@@B@@A@@@@@@@@D@B@@@@CBCF@@@@@@FBB@B@DADAD@@@AD@@@B@B@BAB@@@D@@@@B@BB@@B@@A@@@@@@@@BB@BDD@@@@@@B@@A@@@E@@B@D@D@@@@@@C@AB@F@D@BBF@@@@@@DBB@D@@@B@@@@D@B@@@@AB@B@@@D@BABADADCB@BABABABAB@@@BABCB@@A@@B@@@D@@@@ABABA@@@@@ABADC@B@D@@@B@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@B@@B@@@@@@B@@B@@@B@BA@@@@@@@@@@@A@@@AA@@AA@@@@AA@@@@@@A@@@@@AA@@A@@@@@@@A@@@A@@A@@A@@A@@A@@@@@A@@BA@@@A@@BA@@@@@@@@B@BABB@@@@@@BAB@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@BB@@D@@@B@B@B@@BB@@@B@B@B@B@BB@@@@@B@B@B@B@BBB@@B@@B@@@@A@@DA@ABA@@@@@@@A@@@A@@@A@A@@@@@@@A@@@@AA@@@A@@@@@AAA@@@@@A@@@@@A@@B@@@B@@B@@BB@@@@@@@@B@@@@@@AB@@@@@@@B@@@@@B@BA@@@@@@B@@@@@@BB@@@@@B@B@B@B@@@@@@@@@@@@@@@@AB@@@@@B@BA@@@@B@@@@@B@@@@@@@@@@@@@BA@@@@A@@A@@@@@@B@@@@@@@@@B@@@@@@@B@@@@A@@@@@@A@B@@A@A@@@A@A@A@@@@@ABA@@@AB@@@@@@@@@@@B@@@@@@A@@BA@@@A@@@@@@@@@@BB@@@@@@@A@@@@B@@@@@BB@@@@@@@@@@@@@@B@@@B@B@@@BBD@@@@B@@@@@B@@@@@@A@@B@@@@@@@B@@A@@@@B@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@BA@@@@@@B@@@@@B@@@B@@@@@@@B@@@@@@@@@B@@B@@@@BA@@@@@@@@@@B@@@@AB@@@@@B@@@@BF@@@B@BB@@BBBBB@B@@B@@@B@@@@@BBB@@B@@B@@AB@@A@@B@@@@@@@B@BB@@@@BB@@@BABA@@DKL@@@@@@BDBB@@@B@B@@@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@B@@A@@B@@@@@@@@@@B@@B@@@@BD@NM@A"]],encodeOffsets:[[[109533,37118]],[[109531,37110]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
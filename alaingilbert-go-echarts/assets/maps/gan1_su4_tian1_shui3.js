@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("天水",{type:"FeatureCollection",features:[{type:"Feature",id:"620502",properties:{name:"秦州区",cp:[105.724215,34.580888],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@@AAAAA@A@AAA@ABC@CBA@ABA@@AAAA@AAA@AA@@AA@A@@@CBC@ABA@@@A@@@A@@@A@@@A@@@CCAA@@ABC@A@@@A@I@A@@BADA@@BBB@DB@@@DBDBBBDDBD@BBB@@@@CBCBA@@@A@@@@@AAAA@@A@EFA@ABADAB@BCBC@CBC@C@A@@@AAC@@@@AAA@A@@@A@E@@@@B@DA@@BA@AFC@@@A@ACACA@AAA@A@C@G@C@C@A@@CAA@A@@@@FAD@B@BBHA@A@ABA@AAA@@A@AA@@@@@@@A@A@@@AAA@CBCBA@G@ABA@@BM@ADBBBB@@@@@B@BA@@@CBCB@B@BA@@B@@A@AB@@AB@BA@@B@@@B@D@D@@ABAB@@@H@BAF@B@@@BBBBD@BBB@B@B@B@B@@BBB@B@@B@DBB@@@BBBBD@@DBBB@BB@@B@B@@ABA@@@A@E@@@C@A@@BABCB@@ADAD@B@BAB@BAB@B@F@B@@BB@@FD@BDBB@D@@@@B@@BB@B@@@@@B@@@BA@ABAD@@@B@@BB@@DBBD@@@B@BAB@@@@@B@@@BABCBAB@@@BAB@BBB@B@@BF@B@@@BB@@@@B@@@@@B@B@B@F@@BD@B@B@B@B@B@DAD@@@BA@E@@BC@AB@BCF@BAB@B@B@BB@@BD@B@B@@@@@@A@A@@@C@@B@@@F@B@BBBBB@BD@@AD@BCDABCDABABG@C@A@AB@BAB@D@FAD@B@BABAB@@@B@@@B@@@B@@B@BB@@B@JDBB@@BBD@BB@@@B@B@BA@@@AB@@AB@@@B@@A@@BA@A@AB@@@@@BB@@@@B@@A@CD@@AB@@E@@@ABABCDABAB@@A@@AA@@@@@A@@BGD@BA@@B@@@@BB@@@B@B@@@DBB@@@B@BB@BBBBB@@@BBFB@@B@@DB@@@B@@@B@@AB@@@B@DBB@BBB@@@@BBFBBBD@@HFBDDB@B@@@D@@AB@@@B@BA@AFCDCD@B@@AB@@A@@@A@ECCAECCA@@C@@@@@AEAAAA@AA@C@@@AA@@@@AB@@AB@@AAC@AACA@@@@ABA@ABABAB@@@DABCDABCB@BA@@DABABAB@@ABAB@@@D@@A@ABA@@@AB@@@BAB@@@B@BAB@BAD@@AD@@@BAB@@AB@B@@AD@@BD@D@B@@@B@@CBABAB@@@F@B@@AB@BA@A@AB@@AB@@AB@@A@ABABAB@B@BAB@B@BADA@@BC@AB@@AB@D@@ABABA@@B@@@BBBA@@@@BA@CBA@A@A@@AA@@@AB@B@BA@@B@B@B@B@BABABCBCD@@@B@@AB@BA@A@A@ED@@AB@@AD@@@@E@A@A@A@@@@@ABAD@@AB@@A@@@AB@@CBA@@@ABAB@BA@CBABABA@A@A@A@@B@@ABCDA@A@@@C@A@A@A@@@ED@@ABABA@@@A@@AAA@@CACAAAA@C@@AA@AAAACACBAAA@A@AAA@AA@A@@AA@@@@EBCBCDA@CB@@C@CB@@CDABAB@BAB@@@@A@A@@B@@CDA@A@A@@@@@ABAB@@@@EBADA@CD@BCD@@A@@@A@A@A@@@AA@@AC@A@@A@@A@@A@@@AB@@A@@BA@ABA@@@AD@@A@A@@@ABABA@@@G@ABC@@@CBCBA@A@AB@BA@C@@@A@ADA@AAAA@@AA@@A@@@BA@@DA@@@C@A@@@AB@@@B@@@B@B@FCFABAB@@A@@A@A@CBE@A@ABCAEAAAC@AAA@A@G@@@ABC@@@G@@@@B@@@D@D@@BF@@@@@BAD@BA@E@C@A@A@@B@@AB@@@BCBC@ABA@@@C@A@@AA@A@@AA@@B@@@@@D@@CBABA@E@A@AB@@@@AB@BAF@B@@@D@DBB@@BB@@@B@BAB@@@B@@BD@B@@@B@@AD@B@B@B@DAD@BAB@BA@@B@@@D@D@B@BA@@BA@@B@BAJ@DAB@@BB@@AB@B@BEB@@AFAB@@C@A@AB@BAB@B@@@BEBA@@B@@@@BBB@B@B@B@B@BBB@@B@@@B@B@B@BAB@@AB@B@HA@ABAB@@A@AAA@A@A@C@A@C@A@C@A@A@@@AD@B@D@@A@@@C@@AC@ABAB@D@BAB@@AB@@@D@B@B@@@BBD@BBBD@BBB@D@BBBB@BB@B@@B@FBB@@@BA@ADC@@BE@@@AB@DA@@@AB@@@BAD@B@@BBBBDB@@@BBH@@BBBBDBBBDDBBBB@@DB@@BB@@@D@B@@BB@@BAB@D@HABA@@DBD@@BB@@@B@B@DB@@@A@ABA@@BA@@B@@@BBB@BBB@DBBBD@B@B@BBB@B@BB@@@@@B@B@@@@DB@@@BBB@@@B@@@B@@@BB@@@@@BA@@B@B@B@BA@@B@BBB@BBB@@DDD@BBB@B@@@@@F@BBD@BB@@D@B@BB@D@@B@@B@@@B@@@BDDFB@BDBBBBBDDBFDHD@BDDBBBD@@FFBBBBBBBBFD@@B@B@D@D@D@@BB@HFB@BB@@B@B@BABAB@D@H@B@@@@@DBBBHBD@DBB@H@F@D@@@BB@BBB@BBBBDBF@B@B@@B@DB@@DBB@@B@@DB@@B@@BDDD@BB@DBBB@@B@BDBBCD@@ABA@@@@AC@@@ABAB@BA@@B@B@@@BA@@@@B@AB@B@B@B@@@@@@B@@AB@@AD@B@B@B@B@BB@@B@B@@A@@BA@C@CB@@C@CB@@@@@BAB@B@@BB@@@@B@BB@@@D@@BB@D@B@@@@@@@ABA@BBDBD@F@@@BADA@@@ABA@@@A@@AACAA@AACAAA@@AAAA@@@@@@BAB@DABABADA@@B@@@DBB@@@@@@@@AAAA@@@P@@@B@@A@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@B@AAB@@A@@BAB@DADA@AB@B@@@DFB@@@B@@@@ABAD@@@@@BA@@@AB@BB@@B@DABAF@@@@@@A@CAA@A@@@AB@BCBA@AB@BA@CBC@@@A@@BABBB@BAD@@@FBFDB@BBB@@A@@@@B@@@@@B@@B@@BB@@B@@D@B@BB@@B@@B@@@@@BABC@@@CAA@A@@BA@@B@DB@@DBB@@@@BB@@A@@@@@CBA@@@AB@B@BBB@BBB@@@D@B@DABAB@DAB@B@B@BCB@@@B@@@BB@B@@@@@BAB@BBB@@@@B@@@BABA@A@A@@B@@@DBBBBBB@D@BBB@@@D@@BDB@@D@@@@D@BBB@@@BAACBA@@@@@@B@@@B@@B@@@@@BA@A@@@@BB@BB@B@DB@@B@B@B@@AB@@CB@@@BA@@BA@@BAB@D@B@B@BBBBBB@B@@@B@BA@@B@B@B@@@BB@@BB@B@@@@B@@AD@@BB@B@BBB@BDBBB@@BB@@B@B@@@B@DA@@B@DADAB@@BB@B@@B@@@BAB@D@B@B@B@BB@@BB@@@B@BA@AB@@AB@@@B@B@@@BBB@DBBBD@B@DAB@@@BBBBB@B@B@DBBBB@BAB@B@F@@@BA@@D@@@B@D@@@D@BAF@BBB@D@B@@@FCB@D@B@BA@@@@D@BBB@BAB@B@@@B@BAB@@@B@@@DBBB@@@AB@B@@@@A@@B@@@DFB@@@B@@A@@@A@A@@@AAA@@@@BA@@@BB@B@@@@@BAA@AC@@@@@AB@@@B@B@D@@BB@BA@@FCDABABA@@@@BA@@B@@B@BB@@BB@BA@@@@@@@A@A@@BAB@BADC@@@@@A@A@@AAA@AB@@A@AE@@@@BA@@@@FA@@BABA@@J@@@BABAB@@@@@@@BB@@@@B@B@@@B@@@@A@A@@BA@@BB@@BB@BBB@B@@@BB@B@BA@ABABA@ABA@A@A@A@@@@B@@@B@D@B@B@@@B@@@@A@A@ABABA@@B@B@@@BBFD@@@@BAB@B@D@@@@@BA@AAAB@@ABA@@B@D@@A@@B@@A@A@@@@B@DAB@@AB@B@@@B@B@BBB@B@DA@AD@BBB@B@DB@@B@D@@@FA@@B@BBB@B@DAB@F@@@BA@@BABA@ADA@@D@FAB@@@DBDBB@B@B@FAB@@@DE@@@A@@@AAA@@AAA@@@A@A@A@@@@@@@@A@@@A@ABABA@A@ACC@A@C@A@@@AA@@@AAA@CB@BA@C@CBA@C@@@EB@@@AAE@A@AAA@A@AAACC@A@A@A@E@@@@@AA@@@C@AAA@AAA@@B@@@@BD@B@BA@ABABC@A@@BAA@@C@@A@@@ABE@@@@@AC@@@@@@@@@BA@@BA@@@@@@A@A@@AA@@@BC@AAA@A@A@@@ABA@A@@A@AA@A@@@AA@@AC@A@@AA@@A@@@A@ABA@C@A@ABA@ABAA@@AAA@CAA@@@A@A@A@@@@@A@@BAB@B@DC@A@@@C@C@A@A@A@C@C@@DA@A@ABA@A@A@@AA@A@A@A@A@AB@B@@@DAFA@AB@F@B@D@B@@@B@@EB@ACAA@A@@@A@ADAB@BB@@D@DBB@@@JCB@@AB@@AAA@A@@BA@ABA@A@@@@A@CACA@@@AA@AA@A@@@A@A@A@A@@@A@AB@@AB@@A@@@A@@@@@A@@B@@@BA@@@@B@@@@A@@@@A@@@@AA@A@@AAAA@@A@@@@@@@@B@@A@@B@B@B@@@B@BA@@B@B@@@B@BAB@@@@AB@@@@@@@@@@A@@@@@@@@AA@@@@AA@@@A@@BA@A@@@AAAAA@A@A@@@A@@@@@AB@@@B@B@@@@@@A@@AA@@@A@@@@BA@@@@B@@@B@@A@@@@@@@@@AAAACACCE@G@ABABAB@@ABG@C@@@E@@@A@A@@@@@A@@B@@BB@@@B@@@B@BB@@@@B@DABA@@B@BG@A@ABC@@ACAA@A@A@@B@@AB@F@B@B@@AB@@@@A@G@C@@@@BBFA@@B@BBBBB@@@@@@@BAAA@CACCC@A@@B@@AF@B@F@B@@@B@BB@@BB@@@@HEJGB@@ADAB@@@L@H@B@D@DBD@@B@BBBBDDB@B@BBBB@@BBB@B@B@@@B@@DBB@@@BD@@@BBB@B@DB@@BB@B@@@B@@@BA@ABABA@ADAFCBAD@D@@@DA@@@CB@@CBA@A@A@CB@FG@@@ABA@@@@BAB@@@BA@A@@BA@ADCBC@ABABABA@A@A@ABA@@@A@A@ACA@@@A@A@@@@BAB@@@B@B@DAB@DAB@B@@A@@BA@A@@BA@@B@JAF@@@B@BBBA@@BA@CBA@AB@@@B@B@D@@@B@HCBABA@@@AAA@AA@@AC@A@@AEG@@BABCB@BA@A@AAAEC@C@C@@@@BAB@B@DC@A@AB@@ABABAB@HCB@B@B@BB@@BB@@@@@B@D@BB@@BB@B@BBB@H@@@BBB@@BB@BAB@BA@@FE@A@@@A@AAA@AAAAA@A@AACAEAC@A@CBA@ABABA@@B@DB@@B@DCB@@A@@BBD@BBB@B@B@F@B@B@DBB@L@FDFB@@B@@A@@@A@A@@AC@@@CBA@@CE@A@A@AAAA@@AAA@@@ABA@A@A@AAA@ABC@A@A@A@@@AAA@@AAA@AA@@AA@@BCAA@CAAA@@ABA@CB@BCB@@@@@AC@@@A@A@A@AFA@AB@@CB@@E@C@@@@BA@@B@B@B@BBB@DD@BDB@@@@@A@@@CAA@AAC@A@@AAAAAA@@A@@@E@A@AACC@AAA@@AAA@CAEAA@AAAACAAACAA@AA@@AAAAGKAEACAAAAAAAEAEAAECCCAACACCAAAA@C@@@CACAAA@A@A@@A@AAA@A@AAAAA@C@A@@@ABA@@BCA@@@CAAA@@GC@@CBA@CAECC@AAAA@@@@AA@@@A@A@@AAA@@AAA@@A@EAA@A@AAAAA@A@@@ABA@A@A@AB@@A@A@A@CC@@@@@A@AACA@@@A@CCAA@CA@ACAAA@@AA@@@ABABG@@@CBA@A@@@CAAAA@C@@@ABA@A@@@EDA@A@@@A@C@CA@@@@A@@BA@@BABA@@@@@A@A@@AA@@@@@@B@B@@A@@BA@AB@@@B@DAB@@@B@B@BFD@B@B@@@D@@ADAB@BAD@BADABAD@@@BA@A@A@A@A@@@QAE@CB@AAA@@A@A@EBA@@BA@@@AAAAAA@A@AA@@@A@C@A@A@@@@AA@@@AA@A@@A@A@A@@@ABABABA@@@A@AAAAAAAAAC@A@@@ABA@CBA@A@@AA@@@@A@@@C@ABA@A@@@ABA@A@@@@@AAAA@@ACA@ACA@CE@@@AAA@@A@C@C@A@AA@A@@@A@AA@@AA@C@AAA@@@EBAB@@@@@DABEDABA@A@@B@@@@BD@@@D@BBBBB@B@@ABAB@B@@BBDB@BB@@B@B@BB@BBB@@B@@@B@@@@ABA@@BAB@B@@AB@@A@AB@@AB@B@@@D@@@F@B@@@B@@AB@@CBA@@@AAA@ABA@@@EBA@@@@D@@@@ABA@@@@@@B@@@@A@@BA@C@A@EAA@A@A@@@@BCDABA@CA@@ABA@ABA@ABAAA@AC@@A@@@@@A@@@@@A@A@A@@B@@@BA@@@@@A@@@AAAA@@C@ABA@@@@AAA@ABA@@@@AA@@@@@A@@BA@@@C@@@ABC@@@@C@@A@@AA@@AAC@@B@@@@ABEB@@@@@@@A@@A@A@ABA@AB@AA@@@ABA@@@@AA@CCCAA@@AAAA@@@A@A@ACA@@@A@ABA@@@A@@AAAA@@AC@A@A@@@CAA@@@A@@@A@AAAAA@@A@A@AA@@AA@C@A@AAA@@ACCA@A@@A@@@@@A@A@A@@@ABA@A@@@A@C@@@A@@@A@A@A@AAAAA@@@@A@A@@@@@AA@@AACB@ACAAAA@A@@@@AAEBE@E@C@ACACEA@A@A@@@A@@A@A@C@A"],encodeOffsets:[[108181,34920]]}},{type:"Feature",id:"620521",properties:{name:"清水县",cp:[106.137293,34.749864],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@C@CDABCBABA@@BA@BH@@AD@@AB@@ABCBABA@@BA@@B@B@BA@@BA@@@A@CACAAA@@@@@ABA@@@AA@@@EBC@ABA@GD@B@@ABBBAB@@CBC@A@GF@@@@E@AAA@E@AAA@AAA@CAAA@A@@BA@@@@@A@A@@@@EAA@A@C@A@A@@AA@@@ABA@A@@@AAA@@@A@AB@@A@@AA@@@A@@BAB@BAD@B@BA@AB@@@B@D@B@@@B@@@BA@ABCBGBGDA@AB@BCDAD@E@@A@A@A@AAC@A@@AA@A@CAC@C@A@A@GD@@@@A@AACA@AA@@@AACGCC@@C@AAA@A@@A@AAC@A@AA@@AA@@@A@GBAB@@ABA@@B@@@B@@@F@DAB@@A@ABE@A@CB@@@@@BB@@BDDBB@BBB@@@BAD@BABAB@@@BB@DFBBBD@BB@@BBB@B@@@@AB@@E@A@GD@@C@@@AAC@@AGI@AAA@@@@A@A@AAA@AA@A@@@AA@@A@@ED@@A@@@@A@A@@A@A@A@@B@@AB@B@B@@@@A@G@KDABA@@B@@B@BDBB@@@@@BA@AB@@E@A@@@A@C@C@A@A@AAA@@@C@AAAA@@AAA@A@A@A@@@A@AB@@CB@BA@@BA@@@G@A@E@ABABAA@@@@@AAA@@@@ED@BABABA@A@@@CAACA@A@@@@@@@AB@B@@@BAB@@ABABA@@@BB@D@@@@@@@@H@@BBBD@@@B@@@A@@@@B@@@@BB@@B@@B@@@@@@@B@@@@@B@@ABA@ABAD@@A@@D@B@@BD@@BF@BB@@B@B@@AB@@AD@@@B@B@@@@ADABAD@B@BBB@@@D@BDBBB@@@@@@@@ABC@@BA@@@@@@@D@BBB@@@@@@BAB@@@@BBBBBDBBBB@BB@@@DB@BB@@D@B@B@B@B@D@B@B@DBB@@C@C@A@A@C@CB@@AB@@@@BBDBB@BDBB@@@BAB@@@@BB@@BBBBFD@@@B@@@F@@ADF@BAB@B@@BBB@DBD@BBB@@@@BBBA@@DAB@@AD@@@B@@@@@@@@@@B@@@BB@@@@B@B@B@@B@@@D@D@B@D@DBB@B@DBD@D@B@@@B@@@@BAD@B@B@BDDBB@B@B@B@B@B@BAB@@@@A@A@@B@@B@@@BBBB@BB@@B@B@@@@A@@@@A@@@@@@A@@B@@BB@F@@@BB@@BBBB@B@B@@@BA@@@AB@@BB@B@@@DBD@@@DFDB@@BBB@BB@@BBB@@B@@BB@@D@@B@@@B@@B@B@BB@BD@@@B@BABA@@@ABCBABAB@B@B@B@B@B@@BBBB@BBB@B@B@B@@@B@BA@@DB@BDA@@@CB@@@B@@BBB@B@@@BBB@D@DAB@BAB@BABABA@@@ABAB@B@@@B@@@@@B@@ABAB@@@BAB@B@B@@@@B@BBB@B@BB@@@B@@@@ABA@C@A@AB@@@@@B@BAB@B@@@@BBB@BB@@@@@B@B@B@@BDBBBB@@@B@BBBBBDAB@D@D@@@B@@@B@BC@@B@BAB@BBHBD@BB@B@B@BAB@BABABABA@A@A@A@@@@@ABB@@B@B@@BB@DBBB@BB@B@@@B@B@@CDABA@@@C@@@A@A@@@A@@@@B@B@@@BA@A@@BAB@@@B@@@@B@DB@@@@@B@@A@ABA@@@@B@@BBB@@B@B@D@FBBBBBB@BBBB@@@B@BBB@@@B@@@D@BAB@@@B@@@BC@@B@B@@@BB@@@BB@BB@BB@@BBB@BB@@B@@BBD@@@B@@@BBB@BD@@@B@@BB@BBDB@@BB@B@@@DB@@B@BB@@B@BB@@D@@AD@B@BABBB@BB@@B@BBB@BD@@@@@B@B@@@B@BBB@@BB@@BDA@@BBB@@BB@@@@AB@@@B@@@BA@@@AA@@@@A@A@@@A@@@@BAB@@AB@@A@AB@@@@@BB@@@B@B@B@@@@B@@@@AB@@A@A@@B@@@BBB@B@@@@@BAB@B@@@BBB@DDD@BB@@@FEB@B@@@@@@B@@@BABA@@BB@@BB@BB@B@@@B@BAF@@@B@@B@@@D@B@B@@@@B@@DBB@D@BBHB@@B@@@B@@A@@@A@A@A@@@@B@D@@@B@B@D@FBBBHABBB@@@BB@@BAB@BA@@B@BAB@@@B@BB@@BD@@@@BA@@@ABB@@B@@@D@@@@@@@@@@B@@AB@@@@BB@@@BA@CDA@AB@@@@@@@B@@@@@@@B@@AB@@@BB@@@@@B@F@@@B@@@@B@F@DBB@@@ABB@@B@BB@B@@D@BBB@BBDBD@HD@@B@@@FCBAB@BADABABA@A@A@@@A@C@@@@BA@@@ADC@C@@@@@CB@BABABA@@B@D@BADAB@B@B@B@BBB@@D@BB@BBB@B@BBBB@BB@@DBB@B@B@@@@@B@@@B@@@B@DAD@@@D@@@@AB@B@@AB@@@BAB@@AB@@@BA@@DB@@BB@B@B@B@BAB@@@@B@@BD@@@@BBBAB@HFD@B@B@J@@@@@@BAB@B@@DAB@@A@@@A@@@@@AFABAB@@A@@@A@@@AB@@@B@BB@@@@@@AB@@@@B@B@B@@BHBDBBB@@BA@@@A@@@A@@DB@@B@@CB@B@BB@BD@B@H@B@@@@A@@A@ACA@@A@@AABAAC@C@@AAA@@A@@@@@A@A@CCACG@G@C@AA@@CA@@A@@@@@AB@BC@@@@BA@@B@@@BB@@B@B@B@@@@B@B@@@@@@B@@AB@BB@BB@BBB@B@B@@@B@@@B@D@D@B@D@B@B@D@D@BABBB@BBB@@@@AA@B@@A@@@@@A@@A@AA@A@@DC@@@AAA@@@A@A@@@AA@@A@@@@BA@@AA@@@@@A@@@A@A@@AA@@A@@@A@A@@@AAC@AB@@A@A@AAA@CD@@CBC@@@A@@@A@@AC@AAC@@@A@@AB@@A@AAA@@@A@@@ABC@E@AAA@A@@A@@A@@BA@A@@@@B@B@B@B@@@B@@AA@BAA@@A@@@@B@@@@BB@@@@@BA@@@@@A@@@A@C@A@@@C@@@@B@BADAB@BAB@@@@@@@@D@D@@@BDB@@B@@@BADA@@B@B@@@B@BBBBDBBBBBBB@@B@@@B@@ADA@@@@B@BBB@B@B@BA@@B@D@@@DB@B@@@@BB@@B@DBD@D@B@B@B@DBBBBBDB@@DB@@B@DC@@D@@ABBB@@@DD@@B@DABAB@@@@B@@B@@B@B@BBBB@DBBBBB@B@@B@@@@AB@@AD@@@B@@@@A@C@ABAAA@A@C@A@A@@BA@@@AB@B@BC@@BCBEBC@@DAB@BBD@D@@@BB@BB@@@BAB@@@B@BB@@B@B@B@@BDDBB@@AB@B@@@@BBB@@@BB@D@@@D@B@@@@B@@@D@@AF@B@D@B@D@BBBBB@@B@@@B@B@DBB@@@@@@FB@@B@@BAB@B@D@@ADABC@CBA@@@AAC@CA@@A@@@A@AB@AA@A@@BA@@@BB@@@B@BAB@B@@@@BBBBBB@@B@B@@@B@@@BA@@@@B@B@B@B@BABADCBA@CB@@@B@@@DBBBBBB@BBD@B@BBDBB@B@@@B@@@BA@CBABABCBC@A@AB@@A@AB@@A@A@@@AAECE@@AC@@@@@A@@@CBA@@@GAC@@@AB@@AB@B@BA@@B@B@BBB@BD@@BC@A@A@A@@FE@AAA@@@C@@B@@AB@B@@@B@@BB@B@B@@B@@@BB@@@BBD@B@B@@@B@B@DCB@@AB@BA@@B@D@BBB@B@@BDD@BFABAB@B@B@@@BAD@BAB@@@BBB@@BB@B@BAB@@@BAA@@@CC@@@@@A@@@@DCB@BABA@@D@@@B@@ABA@@@AD@BAB@D@@AB@@AB@BAB@B@B@@AB@@ADA@@DAB@@AB@@AB@BA@@BCBC@@B@FBD@@@D@B@B@@@B@B@D@@@B@@@B@@D@@B@@@@@B@@@BABAB@B@B@B@BAB@B@@@B@B@BB@ADAB@BAB@B@DAB@BA@@BBFBD@B@B@B@D@B@BAF@FA@@B@@ADADC@@B@BA@@B@@@BBBBBBD@B@DBB@B@B@@@DB@@B@BAB@B@BB@B@B@@@@@BAB@D@BB@@@@BB@@@B@F@DAB@@@BBD@DBD@@@D@B@B@B@B@@@B@@@@A@@B@B@B@@BB@D@@@B@B@B@B@@@BA@ABA@E@@@@@AB@B@@A@@AA@@A@A@@@AAA@@@C@CAC@C@CAAAA@@@@A@@@A@@FCBCBA@@B@B@@@BABAB@FAFC@@@@@B@BB@@BB@@@BAD@BA@@@@BA@@BA@@BAB@@@B@D@@@BABCBADA@@@@B@DDBBB@@@D@BAB@@C@ABEBC@@@A@A@ADA@A@@@ABA@ABA@A@A@A@ABA@A@@@A@A@ACC@@AA@@@@B@D@DBBBB@B@@@B@@@B@@A@A@AAAAAAAAC@@A@@AA@@AA@@C@@BA@@BA@@BA@A@A@@@A@A@A@A@@BCB@BABABABAFABABC@A@A@A@A@@@A@C@@@AAE@C@@@@DCB@@A@@@AAA@A@A@@@A@CBE@A@@@A@@@@B@B@B@BABADAB@BA@@@A@A@@@@@A@@@@FAB@@AAGACAGAECEEEEEGCCAGCG@EAGCGAEAECCCAAEEEECEAAACCIACBE@GDEDEDADCB@@@@AB@@A@@B@@@@@@@@@@@B@@@@@@A@@B@@@DA@AB@B@BAB@B@BA@@B@BA@@BA@@B@@AB@@AB@@@@A@@@@B@@@@@B@@A@@@@B@@@@A@@@@BA@@@@@A@@B@@@@A@@@@@@@@@AB@@@@@@@@@@@@A@@A@@@A@@@AA@@@@@@@@@A@@B@@@@A@@@@@@@@@@@@AA@@@@@@A@@@@@@@A@@@A@@@@@@@@@@AA@@A@A@@@@@@BA@A@@@A@@@@B@@@@@@@B@@@@@B@@@@A@@@@@A@@@A@@@@@A@@@@A@ABIDEBC@AB@@GAEAEAE@@B@B@BABA@AAA@A@@@AB@B@B@BA@ABA@ABCDCBABABCDABCDC@ABAACKAC@ABA@CACCACCAAA@CAA@GBA@@BAA@@CAA@@AACAAA@AACI@@A@A@A@AAAA@@EBEB@@CAGCCECCCCACAAEG@@ECICGCGC@@ICGCMEIAECKCAAAAEEIE@EBAB@D@D@DABAH@FADADABA@ABAFAFABA@ABGDE@A@C@AAAA@AACD@@AB@@A@AAA@AB@BAD@BA@@B@@@@A@@A@@AB@@AA@@AA@AAA@@@@CCAA@AAA@@AAAAACAAAA@@@A@@B@BCBABAB@@A@@A@@@A@@@@@ABA@@@@@@@@A@@@ABA@@BABA@A@@@A@@CACAC@@@AB@BBB@B@@@B@@A@@@@B@D@@@BB@AB@@@@A@CCA@@B@@@B@@AF@@@@BB@@@@@BCB@B@@ABA@ABEBABEB@@AB@@ABA@A@@@A@@@AAAA@AA@A@K@AAA@ECA@@@AAAAA@E@AAEA@@A@AB@@CBEBE@C@@@A@A@AAA@C@C@C@AAA@EA@@C@A@A@CBA@GBC@IFA@@B@BAD@F@B@B@D@D@BABABA@ABCBADEF@@@@C@CCC@AAA@AA@@AA@A@AAA@A@@@@@A@@A@C@GBEB@@A@@AA@@@@@AAA@AAC@@BA@@@ABA@ABA@@@ADAD@BADAF@B@@AB@@E@A@@@A@AB@@CACCCCCA@@@@A@EB@@AAA@EGECAAA@@@A@ABAB@B@@A@@DCFCF@@A@E@A@AB@@CA@@A@A@@AA@CAE@C@A@A@@@C@A@@@A@@B@B@B@@@B@BBB@@BBBF@@@BABABAB@@C@AB@@@B@FAF@@A@@@A@CAA@A@@A@@@@CA@@@A@@A@AAAC@@@AB@DC@@@A@A@@AE@A@A@@@@@A@@@@CAGAA@C@A@CB@@E@G@@@AA@@@@AA@AAAGEAA@@AA@AEAA@AAA@AA@@A@AB@@AB@@@B@B@B@@@BBB@@@BBB@BBD@BAB@@@@A@A@A@@AA@AEAAA@A@@AABA@A@C@ABABA@@B@@C@@BA@@@@B@B@D@B@B@B@@@BAB@@@BA@ABCBA@A@AA@@A@@A@@BG@AAA@AA@@AC@@@@A@@BA@AAA@@@@A@@@ADA@AB@BC@@@A@A@AA@@AA@C@A@C@@@A@AAAAAAAACA@C@I@A@CB@@CC@@@@A@@B@@@B@B@@@BAB@BAB@@AB@@@@AB"],encodeOffsets:[[108554,35405]]}},{type:"Feature",id:"620522",properties:{name:"秦安县",cp:[105.674982,34.858916],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAC@CAA@AA@A@ABE@@@C@AB@@@@@B@@@B@@@@@BA@@B@BB@B@D@D@@B@@@@ABA@@@@@@B@@B@DBBD@B@@@B@B@BAD@@CDADA@ABC@A@AAA@@BA@@@@@BBB@BBDD@@@B@B@BAD@@@B@@@B@@@@@BAB@@A@C@CAE@A@A@A@AAA@AAA@A@C@A@@@@A@@AA@@@AAC@@@CAA@@@A@C@A@@AC@@@A@A@AA@GC@@A@A@@BAB@@@BAB@BABADAD@@AB@@@D@BA@C@@BA@A@@BA@ABA@E@A@A@AACBA@@ACAAAA@@@@@@AAA@@@AAA@@A@CBABA@E@EAA@C@@@C@@B@@@D@@ABABA@@BAB@JBB@F@@BB@B@@@B@@AD@@@@@@@@@@FB@BB@@B@@ABAB@@ABA@C@A@GBA@@@A@A@A@A@@@A@AA@@AAA@CAE@A@@@CCAACAAA@AAAAA@A@AA@AAA@A@A@E@A@CA@@A@GA@@@A@@@@B@DBB@@@BAB@BABC@A@A@@@@ABA@A@A@CAA@A@GBE@@@ABA@@B@B@B@BA@CBEDE@A@A@@@@@@B@BAB@B@@ABA@A@A@A@A@EAA@C@AB@@ABABCB@B@@A@@AAAAACACA@@A@CBE@A@A@A@E@A@C@C@ED@@C@CBA@@BA@IFCBIDC@@@A@EAE@A@A@@@CAA@GBC@A@@@A@@D@@A@@B@@@@AFADAD@@BDBBDB@@BBBB@@@B@BA@@B@@@BA@@@CBEBAB@@A@@AAAA@A@@@A@A@A@C@A@@@A@@@A@@@@BAB@@@B@@@@A@EHAB@@@BAD@@@B@@B@@B@BB@@B@@@B@@CD@@A@@@ABAB@@A@@AA@@AA@A@@@A@ABADCB@@ABA@@B@@@@BB@B@B@@@@ABA@C@A@ABADA@@@@@AA@@@@@A@@AB@@@@@BBB@@@B@@B@B@@BB@@B@@@B@B@@@@AAE@AACF@@@B@@@BB@@B@@@B@@@B@@@B@BAB@B@BA@@BB@@BA@@@@@A@ABA@A@A@@B@@@BA@@@A@@@@B@@BBB@@BAB@B@BBB@B@@@@ABAD@B@B@B@@D@@@BB@@@@DB@B@B@@@B@@@B@@B@@@@B@@@B@@@B@@A@@@AA@@C@A@@@A@A@@BCBAB@@@B@@@@B@@@@B@@@@CA@@@@A@@@@@@@@B@@B@@@@BA@@B@@@@BBBB@B@BBB@@@B@BB@@B@@@B@BAB@@@@@@@B@@BBB@BA@BB@BA@@@AB@DEB@@@B@@@@BB@BB@B@@BBB@@@@B@@@@B@@B@@A@@@BB@@@B@B@@@B@@AB@B@@ABA@@@A@AAA@@@AB@@@@@BAA@@@@AAA@ABAB@B@BAB@@A@@@@@@B@@AB@@EB@@A@@BA@AB@@BB@B@B@@@@@B@@BF@@BB@B@@A@@BAFAB@B@DBB@@B@@B@@BBB@@BB@B@@@@@@BIDCB@@@@BD@@@B@@A@@@AB@B@@@BA@A@@@C@A@A@ABAB@@@BA@AB@B@@AB@BA@A@C@ABA@C@C@A@ABA@ABAB@B@@@BB@@@@D@B@@AFAB@@B@@@@@@D@B@B@@B@@A@A@@@BB@@@@@@D@B@B@@B@B@@@@B@@B@@@BB@D@B@@@B@BA@@@@BA@A@A@@@@@@B@B@@B@B@@@@@@@BB@@@@AB@@@@BB@B@@@@@BAD@B@B@B@@@@@@@@@@@BAB@F@B@@@@ABAAA@A@@@A@A@@B@@A@@B@BAB@@@DABA@@B@@@B@@@@@BA@@@A@AAAAA@AA@@A@A@A@CBC@@@ABA@@@@@A@CA@@A@CBAB@@A@@AA@A@EBABAB@@@@A@AB@BA@C@@@@@AAC@@AA@@AA@@B@@A@CBAAA@@@@@AB@@@BB@@DBB@B@D@@@@@@@B@@@B@@A@@B@@@@@@@BA@A@AB@@@@@@AB@@A@@@E@A@AAAA@@C@@@@A@@@@@AAAAAAA@@AA@A@A@@@@B@BA@@BA@ABC@@DC@AA@@A@@@@A@C@AA@@ABA@@@@@A@@@@@@ADA@@@@@A@A@@@@A@@BAB@@@@@@@@A@@@A@@@@A@@@@@A@@AA@AC@AB@@A@CA@@CAAA@@A@AAA@C@@@A@@BA@ABA@@@@B@B@BBB@B@@@D@@DB@B@@@B@@@@@@AA@@A@A@@A@@@@A@AD@@@DA@@@@D@BA@@BAB@@@B@@@@AB@A@@@@@A@@@A@A@C@A@ABABAAA@A@@AA@@@@AB@@A@@@@AB@@A@AB@@A@@@A@@A@A@AA@@A@@BA@AD@@@@CA@@@@A@@@AB@B@B@BBBBD@@@B@@@@A@AB@@A@ABC@@@BDABBB@B@B@BBB@@@@@@@B@B@BBB@@@BBB@B@@@B@@@@AB@B@@BBDB@BBB@BBB@@@H@@A@ABA@AB@BA@@@CAC@ABC@C@A@@BABAB@@@BABA@@BAB@@A@@BA@@@A@@@@B@@@BH@BBBBB@B@DABAB@@A@@B@BAD@BAB@@@BB@BB@@DBDBB@DBBBBB@@D@B@DBB@D@B@B@BA@@D@B@B@DB@D@B@B@@BB@B@@@B@@A@@@@B@@A@@@@@AB@@AB@@@@@BAB@D@BBD@@B@B@@@BBBBBDABABA@@@AB@@ABABAB@B@D@BBB@B@B@@A@A@@@@@A@@@@@AFBB@B@@AB@B@@@A@@B@@@@@BA@@@@@AB@@@B@@@F@B@B@B@B@B@@@B@@@B@@@B@B@BADBB@@@B@@@F@BBB@@B@BB@@@A@@@@@@@@BA@A@@@@B@@A@@B@@AB@@B@@D@B@J@B@@@BBBB@@@B@@AD@B@@BB@@ADBB@@BBBD@@@BB@AB@B@DAB@@@D@@@BABBF@DBB@@BD@@B@DBB@D@@@DB@@DBBBD@B@@@@B@BBB@B@@D@@BB@B@B@@@B@B@B@D@F@BB@@BBB@BBDD@@A@@BA@@B@@@@B@BBB@@BDDBB@@@@@@D@D@B@B@BA@@B@B@BAB@@@@A@A@A@A@@AA@AA@@C@ABABA@@@A@AB@@AB@DAHAB@BA@@@@BABABA@@BABA@A@A@A@@@A@A@A@A@@@@BABAB@@AB@@A@@@E@@@A@@AC@A@@@CB@@@@@@@DBDB@@D@@@B@@@@A@C@@AAB@BA@@B@@BD@BBDFBD@BB@@B@AB@@A@A@ABAA@BA@@@C@@DE@@FC@@@A@@@AA@@@@A@@@@BAD@BAB@B@@@@B@@BBB@BBB@BAB@BA@@BC@@@C@AA@@A@@@A@C@@CC@A@@B@BA@@B@@A@@@@@A@@B@@AB@B@@@BA@A@AB@B@BABAB@@@@@DB@@@AB@@@BE@AB@@@@BB@DD@DB@@B@@ABBB@D@@B@@@B@@B@@@@@@@BA@@BBBBF@BB@@D@DAF@B@@@B@D@@BB@BB@@BBBDBDB@@@@B@BBBD@B@@B@@@@B@@A@@@@BBB@B@B@BBBD@@B@@BB@@AB@@@B@@@BBDBB@@@@BB@B@@@@@@BBB@@@@B@@B@@@B@@B@@BB@B@@@@BBB@BB@@@B@BDDBB@@@BAB@@ABA@A@CBE@@@A@@B@BBB@D@@A@@B@BCB@@CBA@CAA@A@A@@BAD@@@B@BAB@@ABA@@BA@A@A@ABAB@@@B@@@BBDB@@@BBB@B@B@@@BBD@@@@BB@@@@BB@@@BB@@B@D@B@@AB@@A@@BBB@BBDB@@FB@@AB@B@B@B@BCFBD@B@BBB@@BB@@B@B@BBB@@@BBDABBBB@@@BB@B@B@@@DBBB@@B@@B@@@BB@B@@@B@B@BABABAB@@@BCB@@@BA@@@A@@BA@ABA@ABA@A@@@AB@DCDAB@DABADAD@BABCBA@@B@@@B@BBFBD@D@D@BA@@@@@A@@@@@EB@@@DCB@B@B@@@BA@@@@BA@A@@B@@@B@B@B@BAF@BAD@@@B@@@DAB@B@B@FAAC@C@@@ACA@@AA@@AABAAA@@@@A@A@@@A@@@A@@@A@@ABCBA@A@A@C@A@A@A@C@A@CA@@A@@B@B@B@B@@@@A@@@A@@@@B@BAB@@AD@BBB@@@B@@@@@B@@ABE@ABE@ABADADA@@@@@AB@B@@@@A@@BAB@@@@@BB@BBD@@@BB@B@@@B@@@B@B@BA@ABABA@ABABAB@@AB@@@B@B@BB@@@A@@AC@@@A@@@@DA@ABABC@@BADABAD@@@BABABA@@@@@ABA@@@A@AB@@ADBB@@BBA@@BA@@B@@@BA@@B@@@B@B@B@@@B@BCB@@AB@BC@@BA@@@A@AB@@A@@@A@@@@B@@@@A@@@A@@@@@A@@@A@A@@@@@AB@A@@A@AA@@A@@@@@@BA@@@@@A@@@@BC@@@C@C@@@C@AA@A@AAA@BA@A@@@@@A@A@@B@@@@AB@@@D@@@B@@B@@@B@@AB@@BB@DDB@@@D@@@@D@FA@@BA@@@CB@@ABA@A@@BC@A@@@ABE@A@@@@@A@@B@@@BABBB@@@D@@@B@DB@@D@@B@D@BAB@B@D@B@B@DABBB@@@B@FB@@@@@B@DB@@BBD@BAB@F@BAB@@A@A@ADCBA@A@@@A@@@@AA@ACC@@AAA@@AA@@AA@A@@@@A@@@AA@@@@A@@@@BA@A@@@A@ABA@AD@@ADAB@@CBA@ABA@@BA@BB@DBBBBB@BB@B@D@FADAB@B@B@D@B@D@BBB@@BB@BB@@D@@@@BBBBB@@B@B@@@B@B@B@@@@@B@@BB@BBBB@@@BB@@B@@@@@@@@@@@B@@@@@B@B@BB@A@@@BBA@BB@@@BAB@@@@@@BBA@@@@@@BB@@@A@A@CAECA@A@@@E@A@@@@@AB@BBB@BBB@DBB@@BD@B@DB@BB@@BFBB@D@B@B@D@@@@A@A@A@@@@B@@@@@BBBBFB@BBD@@@B@@@A@@BABAB@@@B@@@BB@B@DDB@B@B@B@B@B@@@DA@ABABABA@@CAA@AAA@AAAA@@@@BA@@FAB@B@BBBDBBBBB@B@BAB@DAB@B@@@BBB@@@@@@@BA@A@@BAB@B@@@@@B@DAD@D@B@@@B@B@@DBB@BBB@@@@BA@@B@@@@BB@B@B@B@@@@@@@F@HBB@B@BBB@@@DC@C@@@@D@@@B@@A@A@A@IBA@@@@@AB@@@D@B@B@@@BA@@FCBAHCB@B@FBB@B@D@BADAB@B@DAD@B@D@BBD@@@BBBD@B@D@@CF@B@@@@F@B@BAB@@@B@B@BABA@@@AB@@@@@@A@C@CBC@@@@BAB@@@BB@@@BBD@D@@@B@@CB@@AB@B@@@@ABA@@@@B@@BB@B@@B@BB@@BBBBB@BB@@B@@AB@BAB@@ADBB@B@D@B@B@@@BADCBABAB@@A@@@ABA@@BA@@B@@@@@B@@@@@B@B@@@D@@@B@BBD@BD@BBBBB@@BB@BB@@BBB@@@BB@@BB@@@DAB@B@B@BAB@F@D@@@F@H@@@B@B@@AB@@@@A@@BADCBA@A@@AA@@@@@@@@BA@@@@@AA@@A@@@@@A@@A@@AA@@AB@@AB@B@B@@A@@AAAACAA@@@B@@ADADA@@@A@A@@@AEE@A@@BA@AD@B@DAB@@@@@@@DB@@FB@@@@DAB@DADAB@@@B@BAB@BA@@DEBC@ABA@ABA@ABC@A@@B@@@@BB@ADB@@B@BBBB@D@D@B@B@@@B@@AD@B@BAB@B@B@FBBAD@@@B@@A@@@A@@@ABC@@@@@AB@@AA@@@AA@@@@A@A@A@@@@@A@A@CD@@A@@@AA@A@@@@@A@@FCCC@@@A@@@AB@@AB@B@BBB@@@@A@@@@AC@@@@BA@@@EBABCBA@ABA@A@@B@BBBBBB@BBF@@@BB@@@BC@@B@B@B@@@DCBAB@B@D@DB@@@A@@BC@ABA@ABCB@@A@A@AB@BABA@CBC@C@@BCA@@AAA@ABA@@@ABA@AB@BAB@@@B@F@BB@A@@B@@A@@@A@A@@A@@@E@A@@@@ABA@A@@@@I@A@A@C@GEA@ABAA@@@@AC@@@A@@A@ABA@A@A@A@@AA@@CB@@A@@BA@@BA@A@@BA@@@ABA@@@@@C@@BC@C@A@@@A@@@A@@@@@A@AAA@CA@@AAAAAA@A@AAA@@A@CA@AAA@A@A@A@CBABC@A@@@ABABABA@@D@@@@@DCD@B@@AB@@@@@D@B@@@B@BABABCBABA@ABED@@A@@@GCC@CAAAA@AAC@@@@AAAA@@@AA@B@@AA@C@E@A@@A@@@E@A@@@@@A@@A@@BA@@@A@@@@@@@A@@@@@@BAB@DCB@@A@@AA@@@@BA@@@A@@@@@@@@C@@@A@@@AA@B@@AB@@@@AC@@AAA@@@A@ABA@@@ABA@AB@@AA@@A@AAGBAAEAC@A@A@@@C@A@@@@@@B@B@B@@@BA@@@A@@@GAAAC@A@CA@@@A@@A@A@C@@@A@@@@A@@BE@A@A@@@AAAA@@AA@@AB@BA@A@@@A@@@@A@A@EF@@A@@ACC@CAA@A@@@ABA@A@@@@@AAA@A@@@AB@B@@@BA@@@@@A@@A@A@A@@@A@@A@@@@BAB@@@BA@@BA@A@@B@@@B@B@@@@@BB@@B@@A@@@A@@BA@@@@AA@@AA@AB@AC@@AA@@AA@A@A@@@A@A@@@@ACA@AAA@@@AAA@AAABA@C@@BC@@@AAA@@@AAA@@@CA@@A@A@@AA@AC@AAA@@@A@@ACA@AA@@A@@@C@AA@@@AA@@AAA@AA@@AAAA@@A@@AA@@A@A@@@AD@@A@@@A@ABC@@@A@@@A@AAA@@@A@AA@AAAAAAA@E@C@A@AA@AA@@@A@@B@BAB@@@@A@@@@CAA@@@@@@A@@BA@AB@B@@A@@@A@A@@B@@@B@B@@@D@@@B@BADC@@@A@A@@@AAAA@AA@CAA@@@A@AA@BA@@@@B@B@B@B@BABABA@ABA@A@A@AAAC@GAAAA@ABA@@@ADA@@@A@@@C@C@A@CBAAAA@A@A@@AAAAAC@@@A@A@A@@@@AAA@AA@@@@@ABA@A@A@@@@BAB@D@B@BA@@@@@A@@AAA@A@AAA@@@@@@A@ABA@A@@BABA@@@A@@@@@A@@@ABABA@@B@BABA@ABA@ABA@C@CAA@A@@@AAA@AA@@@A@@D@@CB@ACA@@ABA@@@A@A@A@A@AAA@AA@AA@A@A@A@A@A@ABABAD@B@@ABABA@@@C@@AAAA@A@@@@A@@@AC@@@AA@@@AA@AA@@AAA@AA@@CACE@@C@CA@@A@A@@AA@@B@@AB@@A@A@A@AA@AA@@A@@@EAA@@@AB@@@@@@@@B@@B@@@@@@A@AA@@AAAAA@@A@@@@AB@B@@@@@BA@A@A@A@A@A@AAACC@A@A@ABC@A@@A@@@A@C@C@CAA@A@CAC@A@C@C@@@A@@@@A@A@A@@A@@A@@@A@@@@@@@@A@@@C@@BA@CB@@ABAA@@@@AA"],encodeOffsets:[[108324,35566]]}},{type:"Feature",id:"620523",properties:{name:"甘谷县",cp:[105.340747,34.745486],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@CAAAAA@@@ABC@A@@BA@@B@@CBA@@F@@AD@BCB@@A@@AA@E@AA@A@@AAAAAC@A@AAC@AA@AAC@A@@@A@A@C@@BA@@BA@A@CBABA@E@@@ACAAAEA@A@@AA@@@@C@A@@AA@@A@@@AA@@@A@@@ABAA@@AA@@A@A@@@A@A@@@@BB@@BABAD@B@@@@@BB@A@@@@@A@A@KEAA@@A@@@@@A@@@EA@@A@@@A@@@AB@@CBA@A@@@A@@@@@AB@@ABAB@@A@AAA@AAA@@AA@@A@@A@@@A@ABA@@BA@@B@B@@A@@BA@A@ABCB@@A@@A@@AA@A@@AA@A@@@@@A@@ABCBAB@@A@A@A@C@GAADAB@@A@@BBBBH@@BB@@@@@BABBD@@A@@BABA@@B@@@@BD@@@BA@ABAB@@@D@B@B@B@B@D@@B@@B@@@BA@A@ABA@ABA@@B@B@@A@@B@@BB@@@D@@A@A@@@@B@@DD@@@B@BBB@D@@BBBD@@A@@@@B@@@BBB@BB@@@BBB@BA@@B@@@@B@BA@@B@B@B@B@@A@A@A@@@AB@@AB@@A@@BAB@@A@@@CCA@A@A@@@AAA@A@@@ABAB@BA@A@@BA@CBA@A@ABA@@@@@@B@B@B@@@BA@C@A@@@ABA@A@@@@BAB@BCD@B@@@BAD@D@@@B@@@@B@B@@BBBDFBBB@@@DFDD@B@@A@@@A@@@ABA@@@A@A@@@A@A@@@@@@B@B@BB@DD@BB@@BB@@@B@B@BBDBBBBBBB@@@B@DBD@BB@@BA@A@@@@B@@A@A@@BC@@BC@@@A@AB@@AB@@ABABADABA@AD@@AFBB@@BD@@@B@@@B@@@@A@A@C@ABAB@@AD@BA@C@A@AB@@AB@BBD@@BB@@@B@B@B@BABABA@@B@@C@@@@BABABA@ABA@A@@BAB@@AB@@G@AB@@@@A@@@AB@BABA@@BA@A@A@@@@@A@@A@@@@A@AB@@@B@@@B@@BB@B@B@B@@BB@BDFDF@BBD@B@@ADAFAB@HABAFABAD@B@B@DAH@BADAB@D@@@D@DAD@D@B@D@@@BBBDBB@DBBBNF@@B@BBB@@B@@@@CB@B@B@@B@@B@@@B@F@BABABA@@@BBA@@BC@A@EBA@EB@@A@A@@@A@ABA@AB@@A@A@A@ABA@A@C@CB@@@F@D@BBB@@@BB@B@DBD@@BB@BB@B@@ABA@@@@B@@@@BBBBB@@@BBJJ@BBDB@BBB@B@@@@B@B@B@B@BA@A@@BA@AA@@@B@BBBBBBDDBDBBBB@B@FDBB@@BB@B@@D@B@DDB@B@@@BB@@D@B@BBBBDB@BBD@@@H@@@@B@@@B@@B@@@@FBBBBABBB@D@J@@@F@B@B@B@B@BBBBBD@@@B@@@BA@@@CDA@E@@B@@AB@@@B@BBB@@@B@BA@AB@BABABABA@ABA@A@AAA@@@AAAA@@EC@@A@A@A@A@ABA@A@A@EAC@CAAAEAAAC@@@C@@BA@CDA@AB@@CBABA@AB@@@@@B@BDFBD@DBFBB@B@BA@@BABCBA@@BCB@@@@A@BA@@@A@@@A@A@A@@CAAAA@@@A@@@A@ABCDA@A@A@A@A@@A@@AAA@AA@@AA@CAA@CA@@AB@@@B@@A@@BA@@@A@@B@@@@AGCCCA@@@@B@@AD@@@@A@AAC@A@AB@BA@@B@D@@BD@BA@@@A@@@@BAF@B@@C@@@@@@BA@@BA@@@CB@B@D@BA@@@@A@AA@@@@@@@@B@B@@A@CA@@@BBBB@B@@@@BBHBDBD@@BBBB@@BB@@@@AB@@ED@@@B@B@@A@@BA@AB@@A@AACE@AA@AACECAA@@@C@A@@@A@@@C@@@@B@B@@BD@BBB@BB@@@B@BA@@B@@@@DBF@FAB@B@@ABABABAB@BB@@@D@BB@@BBB@@BBB@BBB@@@B@@@DA@BB@@@@@BA@A@A@A@CBAB@@G@A@@@CBA@ABA@A@A@A@CBA@@BA@@AC@@@A@@@@@A@@A@C@@@A@AA@AA@@A@AA@@C@@@C@AA@@@BAB@BCB@@@BAB@@AB@@@BB@@@@@@B@@B@@@BBB@@@@BB@@BBB@@@@@B@B@@@@@B@@BB@@@@BBBAB@B@@@B@@B@BAB@B@@B@@B@@@BA@BBA@BBB@B@@B@@BD@BB@@@B@@@@@FA@@B@@BB@DB@@B@BAB@BBBAB@@AB@@ABC@@BAA@BA@A@@@A@@A@@ACA@A@@@ABAB@BBB@@@@AB@BA@@D@DDB@BBB@B@BA@@@AAC@A@@@@B@D@DA@@@@DAB@B@BBDBBB@BB@@BBB@@DBB@DBB@@@BBB@BBBBB@@B@@DB@BD@@@BD@@@BB@AB@BBB@@B@@@B@DA@@B@@@B@BA@@@@@ACA@@@@@@BABAB@@B@@@B@@BB@B@BABAB@@@BBB@BB@@B@@B@FA@@B@@B@B@@B@B@BB@@BBBBBBDDBD@@B@BB@@BAB@@@BB@B@B@B@@CBA@CACB@B@@GBA@AB@@@B@F@B@@@BA@AB@B@@B@@B@B@@@@ED@@C@A@C@CBA@@@@BA@@@@@@B@@B@B@@BB@@@B@@BBB@@@BB@B@BB@BD@B@B@@@@@@A@@AA@@@@BA@@BBB@B@@@B@@AB@@@B@BB@@BB@@@B@@@A@@@@@A@@@A@@@A@@BABCD@BC@@BAB@B@B@DBBBDB@@B@@@B@@@B@@ABABA@@BA@AB@@B@@@@BB@@B@DAB@B@B@FBF@B@F@@BB@@@BB@@@@B@@AB@@@BA@@B@BABAB@DA@@B@B@B@B@BBD@DAB@D@B@DBB@@@@CBA@A@@BA@@@ABAB@@@DAB@BA@AD@BA@@BAD@@@B@@BB@B@@B@@BBBBD@BBB@@@@B@@@@CB@@@B@B@@@@BB@@B@B@DBDB@@B@@@BAB@@AFA@@B@B@D@FBD@@@@A@@@A@A@A@@DA@@B@DAD@@@BCBA@ABAFGB@@A@C@CAA@@@AAC@A@AAA@A@ABA@CBAB@D@J@B@B@@@B@@@DABAB@@@BAB@@AB@D@B@@@B@BAB@@@B@B@BDDBDB@B@D@@@@BDBB@B@BB@@BD@HBBBB@@DB@BF@@B@B@@AB@B@@@B@BBDBB@BB@B@@@D@@BBB@@@B@D@B@@@B@B@@@@@B@@BB@BF@B@@@BB@BD@D@B@FBD@B@@@BB@B@BB@@BB@@BDBD@BCBABCB@D@B@D@B@BADAB@@@B@BABAB@@A@A@ABE@@@ABCBCDA@CDABB@@BBB@B@B@B@BBDB@DB@BB@BB@BD@B@DDBB@@@@B@A@BB@@@@BB@@BBBB@@@B@@B@@@@B@@B@B@@@AB@@@@B@@B@@@@BBF@BBD@@@DDBBB@@@@B@@@B@@B@DAD@@@BA@AB@BA@@BA@A@@BABABA@A@@B@BA@@BA@A@A@@BA@@@A@@A@@AA@CBA@A@AAA@@AA@@A@@AA@@A@BA@@D@@@BAB@@@BAD@BA@ABAB@B@B@@@B@@D@@B@B@@@B@BAD@B@@@@@@@@B@B@BBB@BB@BB@@@@@BA@AB@@@B@B@@BDBBDB@BA@@BA@@@@BABCB@@@BA@@@A@@B@BB@@BBBA@@BA@A@@@ABAB@@B@@B@@AD@B@BAB@@@DBD@DBB@@@@B@BBBA@@B@@ABABA@@@@B@BBB@@AFABBB@@@B@B@@AB@@A@@@@C@A@@@A@@A@@@@D@B@DA@A@@B@BA@AB@BAB@DAB@@@B@@BBB@@BD@@@B@@@B@B@@@BBBB@FBB@B@DAB@BAB@B@BA@@B@A@AAAAG@@A@@@A@@B@@@B@@AB@@@BA@AB@BA@A@@BABA@AB@D@D@BAD@DB@@B@@ABAB@BAB@@@@G@@AA@AAA@ACAAA@@@ABA@@@@@A@@@AAA@A@@AA@A@A@A@@@@@@AA@A@A@AAABAAC@@D@BAB@@@BAB@@@@@@A@@ACAA@A@A@ABA@@B@@@@@DB@@@@BCB@@AB@@@BBB@B@@@@B@@@BA@@B@BA@@B@@B@@@BA@@@@BB@@@BBBABAB@B@B@D@B@B@@@B@@@@@BBA@@@@@A@@BA@AB@@A@C@@B@@C@@BCB@@@@@@BB@B@@@BB@@@@@@@A@@@ACA@@@C@@@AAA@A@A@A@@B@BAB@@AB@@@D@B@BBB@@@BBDB@@DBB@@@BAD@@BBB@@@B@@@@@B@@B@@@B@@@@@@@@@BA@AB@@@@@@B@B@@@@CB@B@@@@B@@@@@B@BA@@BBD@B@@@@@@BB@@BCD@@AD@BAB@@ABA@@@@@@B@BBB@@BBBBBB@B@@@@@B@@D@@@BBBBB@F@@@B@@@BA@@@@@@BAB@B@@A@@@@@@@AB@@@@A@@@A@@@@@@@C@AAA@CA@@A@@BA@@@@B@BBDAB@@@@AB@@BB@@BD@BB@@@@D@B@@ABAB@@@@@BABAFAB@B@@BB@@@BADAB@@@DBB@@@@@B@BA@@D@DAB@B@B@@@BBB@BBBBB@@@B@@A@@@@@A@@@AB@BA@C@@BA@A@AB@@@@AB@B@@@B@B@BBBA@@@@@A@EBA@A@@@@@@@@@@@A@A@ABC@A@@@@@AAA@@@@BA@@@@AA@@@@@@A@A@@@@A@A@@@@B@B@B@@A@@B@@A@A@@@A@CAA@@A@@@@A@@A@A@@@@A@A@C@@@@A@@A@@@B@BA@@@@A@A@C@@@@A@@@BABE@@@A@C@@A@@A@@@ABABAB@BAB@D@D@B@BAD@B@B@@ABA@@@ABAB@@A@@BABAB@B@D@@@B@B@@A@@@ABA@@B@@@@A@@AC@@@@DAJC@A@@@@A@A@@AA@AA@@@AA@@@AA@C@ABABE@AB@@@@AAA@@AE@@@A@@@@@A@AAA@@BAB@@AB@@@FA@@BA@@@A@@@@B@@@BA@A@ABABAB@BB@@@@BB@A@@@@BA@@B@BBB@@@B@BA@@@ABA@@@A@@@A@A@@AA@@B@@@@AA@@@@@@A@@A@AA@@@AAAA@@A@@A@@@A@CFA@@B@@ABA@@AABA@AA@@@A@@@@@@BA@A@A@@@AA@@A@A@@AA@A@AAAAA@@@@@AB@@A@@A@@@@A@@@@@@B@@@@@DB@@@@@A@@A@@@@@@A@@BADA@AB@B@@@B@D@@@BB@@B@@@@A@@@A@@@A@@A@@@@A@@@A@@@A@ACA@@@@AA@@C@@@@A@A@ABCBA@@@@@AAA@A@ABA@AA@AA@@@A@@B@@@B@@A@@@AB@B@B@BAB@@@@@B@@AA@@AB@@A@ABA@A@A@@@A@@@A@@@AA@@A@@@A@@DEBBF@BB@@@@@A@A@@@AA@@AA@A@@@@A@@AA@A@@@@BA@@@B@@@@BB@@@@B@BCBAB@D@B@BA@@@@@A@AAA@@@@@AB@BA@@DABCBAB@@@B@B@@BB@@BB@@@BABA@@B@@@DC@@@A@@@AA@@A@AA@@@@A@@BC@A@@BAFG@@AB@@@@@@@@A@@@@@A@@@@B@@A@@@A@@@A@@@@B@@@@@@AB@@@B@@@@A@@@@@A@@AAA@@A@@@A@@@@@AA@@A@@@@@@A@@@@CC@@A@@@AB@A@@A@A@@AA@@@@@A@AB@@@@A@@@@@@@A@@A@@@A@C@@@@@@@@@@BAB@@AA@@@AA@@A@A@A@@AA@@@BC@@@@@AB@@AB@@AAA@@@@@C@@@ACC@A@@A@CAA@@@@A@@@A@@@A@@BAB@@@@A@A@A@E@AAA@A@@B@@AB@@ABAB@DC@@B@@@BA@@@@BA@@@@@@@@@@@AC@A@A@@AA@AE@A@A@@A@A@A@AA@@AA@@@@@B@@A@@@A@@A@@B@@@@@AACC@@@@A@@@@@@@A@AA@AA@A@C@A@A@@ACA@@AA@@@A@A@A@AD@@@@@BB@BBC@AB@@CACAC@A@@@AAC@@CC@@ACBA@A@@@@C@AAC@@@CBA@A@E@A@@@@A@@DC@@@@@@AAA@A@ABA@@@@B@BAD@BA@CDED@@@@@@AA@@B@@A@@BAAABA@@CAAAA@EGAAAAAC@A@AA@@AA@@ABAAAB@@AD@@@@@B@@A@A@C@A@A@CA@AE@AA@A@AA@@@A@@AABAB@BA@A@@AACAA@AAA@@@AA@@@AAAAAAA@@AA@A@@@C@@@A@@@@@AB@@A@@CE@@AAA@@@A@ECCA@@@A@AB@BA@@@@@@A@C@A@@AC@@@A@@A@A@@A@@AA@A@AB@@@@A@@D@DA@@D@DAB@@@BA@A@@@AAA@A@A@A@C@@BA@@BA@@@@@@@@A@A@ABAA@@@@@AB@@A@A@@@ABA@AB@B@@BD@@@@AB@BC@ADCA@A@AA@AA@CAAC@CC@AA@@@CA@@@AA@CA@@CAA@@@@A@AAEACAA@AAA@AAA@@C@E@G@A@CAC@GAAACA@@@@A@G@C@A@ABABA@A@@@AAA@GEA@@AC@C@C@A@A@@@ECAAAAAAAAEE@@ACAACC@AGCECCAACAAAAACA@CEAC@@A@@@A@@@@AC@A@@A@A@CA@@AAC@A@E@@@@@AAA@ACC@CA@AAA@AAA@@@ABA@A@A@@@AB@@@@A@@A@@@A@@@A@@AA@A@@CA@@@@@A@A@@@@AAA@A@AAA@A@C@AACAA@AAA@AA@@A@@@AB@@AB@B@B@@CAA@A@@@A@@AC@CA@@ABGBC@A@AB@@AA@@@A@C@@AA@@CA@@AAAACCAACAAAAA@@AG"],encodeOffsets:[[107751,35402]]}},{type:"Feature",id:"620524",properties:{name:"武山县",cp:[104.890587,34.72139],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@@@@@A@AAA@@AACAAAAC@A@@C@@@A@A@ABA@@BA@@@CAEAGEA@@@A@A@A@A@@@A@C@@@AB@@AB@@@@A@A@C@@@G@@@AB@@AB@@A@@BA@@B@BA@@@AB@@@B@@AB@BA@AACBA@@BA@@BA@A@AB@@@@C@A@A@ABCBEBA@C@A@ABAB@BAB@@A@C@@@AA@AA@A@AAEBAA@@@@AAA@@@@@ABA@AD@@ABC@ABA@AA@@@@AA@ACC@@AC@@ACAA@@AA@AAA@@@@CAA@AA@AAA@AA@CAA@CAA@A@A@A@CB@@@@@B@@@@@BBBDB@B@@BB@BBB@B@@@B@@@@EB@@@@CB@@@B@DBDB@@BBB@BBB@B@B@@@B@B@@@D@BA@@B@@@BAB@@@B@@@D@DBD@BBB@B@DBB@B@D@@BB@BBD@@@BB@@D@@BB@B@BBB@BABAB@BA@@B@@CB@@@@CACAA@AA@@A@A@A@AAAA@@@A@@A@AACA@@@@@AAA@A@@CAE@@AA@A@@A@@AA@A@@@AAA@AAA@AA@C@AAA@A@@@@@@BA@AB@B@@@B@@A@C@ABA@A@A@C@AB@@A@@BAB@@@B@@@@B@@@@@B@@B@@@@@@@BB@@@@@AB@@@@@B@@@@@B@@B@@@BB@@@@@@B@@B@@@@@@@B@@A@@@@@@B@@@@@@@@@BA@@B@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@BB@@@@@@@@@B@@@@@@A@@B@@@@@@@B@@@@@@@B@@@@@@AB@@@@@@@@A@@B@@@@A@@@@@A@@@@B@@@B@@@BA@AB@@@@@B@@DB@@DBBB@@B@B@@@B@@@B@@FADA@@BA@@B@B@B@@BD@BBD@BB@@BBB@@B@@@@@@B@@@@@B@@@@@B@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@AB@@@@A@@BA@@@@@@@@@@@@@AB@@@@@@@@@@@@@@AB@@@@@@@B@@@@A@@@@@@@@@A@@@@@@@A@@@@@@BB@@@@@@B@B@@@@@B@@B@@B@@@@@@@@BB@@@@@@AB@@@@AB@@@@@@@@A@@B@@A@@@@@@B@@@@@B@@@@@B@@@@@B@@B@@B@@@@@@@B@@@@@B@B@B@@AB@@CBA@@@ABA@@@A@C@A@@@CA@CCA@@AA@AAA@@AAA@KCC@AA@ACC@@A@@@CA@@AAABC@E@@@K@@@A@@BCB@BA@@@A@AB@@@BA@AB@B@@@@A@@B@@@@@B@@@BA@@B@BCB@@AB@@@@@BA@A@@BA@@@A@A@@BA@AD@BCBAB@B@B@@AB@BAB@@ABAB@@ABAB@@@B@B@@AB@BAB@BA@@BB@BBBB@B@BBBBDBB@B@D@B@@@B@BB@@@@BDB@@BB@B@@BBB@BB@BBB@B@BAFB@AB@@B@@@AB@BAB@@BB@@@@@B@@@BB@A@@@A@@BB@DBBBBB@@@@D@@@@BB@@B@@BB@@@@BBAB@B@@B@@BA@@B@B@@@BC@A@AB@BA@@B@B@@BB@@B@@BBB@@@BBB@BBBBBBDAD@B@@@BA@A@@@AD@@@B@@@DB@@BBBBB@B@D@B@BDB@@@B@@BB@@@@BB@B@BB@@DBBBB@@@B@@@B@B@@BB@@@@ADBF@@@@A@A@@@@@A@@@A@@@AAA@C@AAC@CA@@A@AAA@@@A@C@@@AA@AC@@@AA@@C@CA@@@@AAA@A@@AA@AAC@CAA@C@@AAA@@A@@@A@@@A@A@@@CA@@CAAAA@A@A@A@AAA@@@A@@@A@A@@@@B@B@@E@A@E@EAABG@CBA@E@AAA@@A@C@AA@GAA@A@A@@@C@EBC@@@@A@@E@A@E@@@@@@@@@C@CBA@@B@@AB@B@BBD@B@FBB@@@@@B@@@@@BB@DD@@B@BBB@@@@B@BAB@BBBBF@B@B@BABAD@B@B@BB@@B@BABAF@@AB@B@@@B@@CBC@A@CBA@@B@B@B@@@BBBBB@@DBB@BBBDB@@BB@@@DB@B@@BBA@A@E@@@AB@B@@@@C@ABCBA@C@ABA@CBABABA@A@@@ABAB@@AAA@@B@@@B@@B@@BBB@BB@BBB@D@@@B@B@@@B@D@BBD@B@@B@@BBDBBB@@B@@BBBBBBFB@BBBB@BBD@BBB@@BB@B@@@@@BABCD@B@@@B@B@BA@@@CDA@@BCDABABABADABABC@A@ABABCBAB@B@@BB@B@@@B@BADAD@@B@@B@B@BBD@B@BAB@BB@@DBBBBBD@@BD@@B@@B@@@BB@BBBB@@BBBBBDB@BB@B@B@@BB@B@@@BB@@B@B@B@@@BBB@@@B@@@BB@@@@@@B@@@@@BAB@B@@@BAB@B@@@BAB@BAB@@@B@@@B@BBB@B@@@BAB@B@B@BB@BB@@@@@B@@@BA@@@@BB@@BB@D@B@@BB@BD@@BB@D@@@@DD@@@B@@BDD@FDFDBD@@@@@F@@@@A@A@AB@@@B@B@@BB@B@@@BBBBB@@@B@@BDDB@B@@B@B@DBD@@@F@D@B@@@DDB@BBB@@@B@B@@ABADAB@@@D@B@@@BBB@@BB@B@D@BBDB@@BAB@DAB@@@B@B@@@@@DA@@BAB@B@BA@@BBB@B@@@BADABAB@BAHCDADABADA@@B@DADABA@@D@BABAB@B@BB@@B@B@@ADAB@@@B@B@B@BB@@@D@BBB@BBB@BB@BBDBB@D@FAB@@@BB@@D@B@@@BB@@BB@@B@BBB@B@D@B@D@F@BABABCB@@A@A@A@@AA@ACC@@@@B@@@@@D@@@B@B@BBBBDBB@BB@@DB@@BB@@DDBDB@@BB@DAD@@AB@D@BBB@B@@@D@@@B@B@B@@@BA@@B@@@D@D@D@BAB@B@@@BBB@B@B@@@D@@@B@@BB@B@B@B@@@@@B@B@B@@AB@AB@@B@@@B@@@BABBDAD@@@@@D@@@B@BA@@B@B@B@@BBAB@@@@@B@DAD@@@B@@AD@DABBB@D@@@B@@A@@DB@@BA@@B@@@B@B@@AB@@@@@B@@B@B@@@@@D@@@B@@B@D@BAP@@@B@@AB@@@B@B@B@F@B@D@@@@@@AB@BBB@@AB@BC@@BAB@B@@@B@@ABA@@BAB@D@FDB@B@@BFBD@@@DAB@@AB@DA@@BAD@BBB@FD@@@D@@@BD@@@@B@B@B@@@BA@AB@@@@@BABA@@BA@@@@B@B@@@@@B@@@BBB@@DBB@DFB@@BB@B@@B@BBB@@A@@B@@@@B@@BB@@B@@BF@DB@@B@@@D@@BDBDDB@B@@@B@B@@ABA@A@EBA@A@@@@B@@@@FD@@@@@BB@@DDBBB@@DB@B@@AB@BA@@BA@CBA@@@ADA@ADABA@A@@@@B@@@BBBBBBBBB@@DAB@DB@@DD@B@B@B@@A@@BAB@@@BB@@D@@@DB@@BB@DBB@DBBB@@DDDDB@@BB@B@@A@@BA@A@@BAD@DABABA@ABA@@@C@A@@BABC@@B@B@B@B@B@B@B@@BB@D@BB@@@@B@B@@BB@BBBD@@@@B@DBDB@B@@B@@B@B@B@@@B@@B@@B@@D@DBD@@BB@BBF@DBB@B@@A@@BA@AB@@A@@@@@A@@@AB@@AA@AG@@@AA@@@AA@A@@@AD@@@B@@@@@B@B@BA@@BA@BB@@@B@@@B@@@@B@@@@B@B@@@B@BB@@@BB@BA@BB@A@B@BB@@B@D@BA@@@@B@B@@AB@@A@@A@@AAC@@@AA@A@AAB@AAB@@A@@@AA@@@@ABA@A@AA@@@A@A@ABAA@@@@AA@@@A@@@@@A@A@@@@AA@AA@@A@@A@AA@@A@@@@A@@@@A@@A@@BA@@BA@A@@DA@ABA@A@@BBD@@@D@@@BBB@@@BBB@@B@B@@@D@BB@@@@@B@@@D@@BB@@AB@DAB@B@B@B@BAB@DA@@B@H@@@BADAB@B@B@B@@A@@@@AAB@@C@@@A@@AA@AAA@AA@AA@@AAC@@@A@@ABABABABA@@@ABA@EAE@C@@A@@@ABA@@@A@@AAA@AAC@@@A@A@@D@@@B@@@B@D@@@B@DBDFBBB@@BDFBBB@@@BAB@@AB@@@@A@A@@FC@@BA@@@@AA@@AAAA@@ACACAG@A@@A@A@AA@A@@DBB@@@@A@A@@@@@@B@@B@B@@B@@A@C@ADA@@B@@AB@@A@@@@D@@@@ABE@A@@B@@@B@@AAC@@@C@AB@@ABAB@D@BBB@@@@@BC@@@A@@B@DDHD@B@@A@@@@B@@AB@@@BA@@@A@@BB@@DBB@DBB@@BBB@BB@@@BB@B@B@B@B@DCBAB@@@B@@@B@BBDB@@@B@B@B@@@B@@ABB@@@@@DA@AB@DABA@AB@@A@AAAAE@CACCE@A@A@@@@BAB@BADA@@BAB@DCB@@AD@@@D@BBFBBBDBD@FBB@B@B@BAB@B@B@B@@@FD@@BBBB@@B@BBB@B@BAB@BABABA@ABAB@@A@A@@AA@A@A@@BA@@@AF@B@DC@@B@@A@@@A@@ACAAAAA@A@A@A@E@@@I@C@A@AAABAAEA@@@@@AA@@@A@@@@@@G@@AC@ACAAAAAA@C@@@AA@@A@A@CCA@C@@@@AAA@@AAECA@A@AACACAACAAAA@A@A@@BBB@@AB@B@@A@A@A@A@A@@A@A@AAA@AC@AIIAA@@A@AAAA@@@@@A@@B@BA@@@AAAA@@AC@CAA@A@@A@@AA@A@C@E@@DAD@B@B@BAB@B@B@@@BAB@BAB@@@B@B@@@FAB@FAB@D@@AB@AA@@B@BABA@A@E@A@@@AA@@@@A@ADA@@@@@AA@AAA@@@MEAACAA@CAAA@A@@@C@A@CBC@C@C@@@CBABC@ABG@C@A@ABCBABEBA@GBABEBC@@@AAC@ACECE@AAA@@@A@A@AAA@@@A@@@A@@BAB@@@@@@BB@@@@@B@B@B@@AB@BA@ABA@@B@@@@@BAH@@@BA@@BA@AB@B@BAB@BABA@A@@D@@@@AB@BABA@A@A@A@A@@AA@@AC@ABA@@BAB@D@B@@ABC@@BABAD@B@B@@@@@@A@@@A@@AC@@AABE@@BCB@BABCBABA@@BA@@BAB@@@D@@AD@@AB@B@@@@A@@B@B@@AA@@AAC@C@A@@AAAAAACAAAA@A@@@A@@AA@@ACCA@@A@A@A@@@@B@B@@@B@B@@@B@BA@@B@@@B@@@@ACCCE@@A@AACEAA@AA@A@@@@@@A@@@CBC@A@@@ADC@ABA@A@@B@B@BA@@B@D@B@@A@@@A@A@A@@@@B@BAB@B@DAB@@AB@B@@ABABA@@B@B@BB@@B@B@B@DD@@B@@@BA@AB@@@BA@@BA@@B@B@B@@@@A@A@A@AB@@A@A@@A@@@ABA@AA@@A@@AAA@A@@@A@@B@@@ACAA@@@CAA@A@A@@CC@@@A@@B@B@@@@C@@AA@@@AB@@@@A@AB@BAB@BAB@B@@A@@@AA@@@@C@A@A@A@A@C@@BABAB@@A@@AC@@@@@AB@BA@AB@@@ACBA@A@@@@AA@@AGAA@AB@@@BABC@@@AA@@A@@@C@@@AAA@@@@@A@@BA@A@@@A@A@@@AA@A@A@C@@@A@C@@@A@A@A@@AA@AA@AA@C@@@AC@AAA@@AA@A@G@A@@@@@ADA@@AA@@@A@A@@A@A@AA@@AA@A@C@@CC@@@@A@AAAA@@@C@@AC@@AI@C@@@@ACAAA@CC@@AA@A@@@@BC@A@A@A@@AAA@EAAAA@AB@@CA@@ABA@CD@AG@A@A@@AAAAAA@@@A@AB@@@@ABC@@AA@AAAA@@@A@@@A@@@A@@A@A@AA@AA@AAAC@@AAAA@C@@@@AAA@A@@A@A@@AA@@@AA@@@CB@@CB@@C@CBAA@@AA@AA@@AA@A@E@@@AA@AAA@A@@C@@AAAAA@ACAAA@@AAA@CA@AA@AAA@@@ACCE@@@C@AA@@A@@A@A@A@C@ABC@ABCBAB@@ABA@@@@AAA@AA@AAE@@@CBA@E@A@C@A@@@@B@B@@ADA@@@@@A@AACA@@ABABAB@@AAA@AD@@A@AA@@C@@@@B@@AB@BAB@@A@@@A@A@@@ABA@@B@@C@@@ABA@@B@B@@ABA@CB@@CA@@@@CBA@@@CC@@CBABABAB@BAB@@AB@@@BAB@@A@A@@@AACCAA@@C@C@@@@BA@@@AAA@@A@@@A@A@@@@A@@@A@A@@@AA@@AA@@AA@@AA@@A@CBC@@BCBABA@@@C@@@ADA@AB@@CA@BAB@@@BA@@@@@@A@@@A@ACA@@C@C@CAA@A@@@@BAB@@AB@@AAA@A@A@ABA@AA"],encodeOffsets:[[107422,35265]]}},{type:"Feature",id:"620503",properties:{name:"麦积区",cp:[105.889556,34.570384],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@A@AA@AA@@@A@C@A@@@AB@BA@CBEB@@AA@AC@EA@@@@A@ABC@ABA@A@A@@@AAABCAC@@@ABAB@@@@@BABABAB@@@D@D@BAB@B@BAD@@AB@@CB@@A@BB@B@B@D@@ABAB@@@@BD@@@D@B@@AB@@@@@BA@@BA@ABAB@@@B@B@BB@@BBB@@@BADBB@@@@B@BBBB@B@BBD@@@@DBB@B@BBDFBDBBB@@B@B@@BD@B@@BBBBB@@@@BBB@BBB@BABA@EJ@BA@@B@@BB@B@@@B@B@@@B@B@B@B@@@@FD@@@B@@@@AB@@ABBDAB@@@B@BA@@BCBCBAB@BA@ABABC@@B@D@B@BAB@B@FB@@DBB@B@B@BAB@@C@A@A@C@@@@B@B@BAB@BA@@@@BB@@B@@@BAB@@@@@DBB@@AB@@@@A@CAA@@AGAA@ADCBA@@@A@A@C@EAA@CB@@EA@@C@GA@@CA@@A@A@@@A@AAAAA@A@@@AA@C@A@AAA@A@AA@@ACAEA@@A@@A@C@AAA@@AAA@AA@@BABCBA@A@@BAD@@A@@@A@@AA@@AC@A@A@@@@BAB@@AB@@A@A@AAEAAACAAA@@CAAACAA@A@@BA@@@CAA@@@AAAAA@AAA@@BA@@@A@@@A@@@A@@@A@C@@@@@@AAC@AAA@@A@AAACA@@@AAACA@@@@@AA@@@A@A@A@A@AB@@AD@@@@AAE@AA@@ACAE@@@A@@B@B@@A@ABAAA@A@@@ABA@@BAB@@@@A@AAC@@@A@@BA@@@@AAC@@AAAA@A@AAAA@AC@@@@A@@C@@AAAA@@A@@@C@@@@AAA@A@A@EEAA@@A@C@EBA@A@ABA@A@A@CA@@GEAA@@@@A@C@A@AAEAEAC@E@A@C@@@EBABA@@BA@ABCB@@@@A@A@C@A@EB@@ABGBCBCBA@A@@@CBA@@@EBEBABA@@B@BA@@@@@CCAAA@AA@@@C@AAA@@AAA@A@@ACC@@C@A@EA@@AA@@AAI@A@A@A@A@CBCBA@@@AAAA@@AB@@ADCFA@IBA@AB@@A@CFABAB@D@@AB@@C@E@@@A@G@C@A@C@AAAA@@@@A@@B@B@B@B@BA@@@AB@@@@A@AB@@AB@@@B@B@@@@BFBD@@@BA@ABA@@@@B@@ABCD@@ABA@A@A@A@A@A@@@ABC@A@A@A@A@@@ABAB@B@B@@@@@BA@@@CAA@CBA@CA@BA@@DAD@@@@CBABA@@@AB@@A@A@EAE@A@EAC@C@ABE@CBA@A@A@@@AAAAA@AA@@A@IAC@E@@@A@AACAE@EAA@A@@@A@AB@@A@A@IE@@C@A@A@AAA@@A@@@A@@A@GAA@EBCBA@C@E@C@AD@@AB@B@@@B@DBBBB@B@BBB@B@BB@B@B@BBBD@D@@@DBBBBDDDBBBDDFDBBBFBFBBBBBBBDBFHLBBBB@@BBB@DBBBDBBBBBB@FBDBB@BB@@BB@BDDBBB@F@@@B@@@BBBBBB@@@BBD@BBB@D@@@B@@@@CA@ACCA@AAA@A@A@@@AB@@@@@D@FA@@DA@@BEB@B@B@B@B@@BD@@@@A@ADA@@DAB@BB@BB@DBBAD@@BB@@BBB@BB@@BB@B@@@B@B@BAD@BBB@B@B@BAB@B@@BB@BB@BB@B@B@BDF@@AB@D@@BD@@@B@B@@@BA@@@EAECK@A@CAA@A@E@A@A@A@AAC@AA@@@BA@CDA@@@CAA@@@ABAB@BAB@D@BBDBFBD@B@BBBBB@BBB@B@B@@@BEF@@ABA@ABA@@AA@AA@@G@A@AAA@A@@AA@@A@C@A@@@@AA@@AAA@A@A@GDA@ABAB@BA@@B@BCDA@A@AB@@@@@D@DFDBB@B@BABA@ADAB@@FH@BB@D@@BB@@BBB@B@@ABABGDA@@@C@A@A@@@A@@BAB@DAB@@ABAAA@@@E@IBA@@@AB@@@BAB@@@BA@A@CBA@CBA@A@@@A@AB@@@@@B@B@@DB@B@B@B@@AB@B@B@BABABAB@BADCD@BAB@@@BAB@@A@AB@@@@AB@B@@EHA@@D@B@BAB@DA@@D@@CB@@C@C@ABEDCB@BABAB@BAB@@A@@@A@A@@AA@@C@AAA@A@@AC@@A@CA@@@A@@@A@AAA@AA@AA@A@ACAACAA@A@AC@CAC@A@G@K@@@A@CB@BA@IHGF@@@@AA@@AAA@@@A@E@A@E@@BA@@@@BDDBD@DBBAB@@@@@@A@AAAAA@@@EBAA@@@@@D@H@B@@A@@BA@A@E@A@@BA@@@@B@BBBBD@@AD@B@BAHA@@@ABCBA@@@@@AAA@@@A@@@A@@AA@@@@B@@@@@B@B@@@F@@@DAH@BA@ABAB@B@HDFBDBDBB@B@@@@@@@@@BA@@@A@@@@@AB@@@@@B@@BB@@@B@@@@A@A@@@A@@B@@@@@B@@@B@BBBBB@B@@@BAB@@@B@@BB@@@@BB@@@@@@@@@B@@@@@@@@A@@B@@A@ABA@@@A@A@@@ABA@@@A@A@A@@@@BA@@@@@@@@@@BB@BB@BB@B@@B@@B@@@@@@B@@A@@@@@AB@@A@@@@B@@@@@B@@@BA@@BA@@B@B@@@B@B@B@B@@@BBBB@@B@@DBDBB@@@@@@BAB@BAB@@@BBB@BA@@BA@ID@@A@CAC@@@AAA@CB@B@B@@@BBBBDA@@FA@@@A@C@A@E@A@@BEBCB@@A@A@@B@B@B@B@BBB@@@B@BAB@B@BCB@@@D@D@B@B@B@D@D@@@BCDA@A@AB@@@B@@@@@B@B@B@@BB@DBB@BB@AB@BAB@B@B@DAB@B@B@@@BB@@BB@D@@BB@@B@@@BBBB@@@@BAB@B@@@B@BBB@BAD@@B@@BB@B@@@@@@@AB@@AB@@@@@@@@D@@B@@@@AF@B@@@BD@@@BB@AB@D@BABAB@@A@AAC@@@@@AB@BBB@BBD@@@B@@B@@@@@F@B@B@BDDBB@B@BBB@B@BBF@B@@FA@@D@B@DAD@B@@ADAB@BB@@B@@B@@@B@D@BDD@B@BABAB@B@B@@@B@@@@@@B@B@B@@@B@BB@@BB@B@@@B@@CF@@A@EBA@A@A@CACA@@A@EBC@@@CB@BABAB@@AB@@E@A@CBA@A@AAA@@@EB@@C@A@@@CAA@A@AAC@@BCBA@A@AAA@A@@@A@A@@BA@CBA@@@@@@B@BA@@@@BC@A@@@AB@BA@BB@BAB@@@@C@A@A@AB@@@@ECAA@@A@A@@@ABAB@B@B@B@@A@@@A@A@C@A@@@A@@@@@@B@B@BAB@BABAB@BABA@A@@A@@@AAA@AAA@@AA@@AB@@@B@B@@A@@@A@A@@@@@AA@@@@@@A@ABAB@@I@@@ABAB@@EB@@@@AB@@@@BFB@@@BAB@BB@@@B@B@@@@CDABA@AB@@@B@B@@@@@@ABA@@AA@@A@AA@@@AB@@@@ABABCBED@@ABA@@AC@A@A@@@A@@B@@@@BDB@AB@@@@A@A@@A@@AB@@@@BB@B@@@B@B@@@BA@@@A@CE@@A@@@@B@@A@A@@B@@AACA@@A@@@A@ABA@@@A@A@ABA@AAC@@@@@ABA@C@A@ED@@A@C@A@AAE@ABC@@@C@A@@@C@@@AB@@E@A@A@ABA@AACAA@A@A@AAAA@@A@CBA@C@AACAA@AA@@A@A@@@A@@BA@@BABA@@@A@@AA@@A@A@A@A@CBA@A@@@AA@A@@AA@CBCBA@@@CBA@@@A@A@@@AA@@AACA@AAA@A@AAA@@BC@@@A@@A@A@@AA@@A@@@A@A@AB@@A@A@@@AAAAAAAA@A@C@A@AB@@AB@@AB@@A@@DA@@BA@A@A@@@CAA@A@@AAA@@@@@BAB@@@@A@@@@A@@@A@@@@B@DABB@A@@AA@A@C@@C@@@CA@AC@@@A@AAC@A@AAAACA@@A@@@@B@BABAB@@A@@@@@AA@ABA@A@@@@@AAA@@A@@@A@ADA@A@A@CBA@ABCBA@C@@@A@AAA@AAA@A@@B@@AB@D@@@@@BA@@A@@A@CA@@CAA@@@AB@@@BBB@D@@ADAB@@@@A@@@@AA@@A@A@CA@@@AA@@@AA@@@@@A@@@@@@BA@AAA@ECEA@@C@ABA@AAAB@@@B@@AD@DABA@@BABADA@@B@@@BBB@D@B@@@@E@ABCBA@@@AAA@@B@@AB@@@@C@AB@B@@A@@@A@CE@@A@A@@BCBCBA@AB@@@BA@BBA@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@BA@@@O@@@B@BB@B@@@@@@A@CA@@A@@@CBABABCBA@AB@@@@@@BBBB@@BBDBBBB@DBBB@@@B@@AB@B@@CBAB@@E@C@CAAA@B@B@@DBFDB@@@B@BB@@DF@@@BA@@B@@@@@B@@@D@@@BBB@@BBBBBB@B@@BB@@B@BBB@DBBB@@@BABA@ABBB@@@B@@BBB@B@@BBFB@@D@B@B@D@B@BA@@@@@C@@BA@BBAB@BB@@BB@@B@BBDBBBBFHB@BBDB@@ABBBAB@@@BA@@@BB@@@@@@FCDCB@@ABC@A@A@@B@BAB@B@BB@@@@@@CD@@@B@@B@F@B@B@DA@@D@BBD@@@@@@BABBD@@DD@@BD@B@@@BBDBD@DA@@BAD@AAA@@@@C@@B@B@B@B@@BB@@DB@BB@B@D@B@B@@BBBB@@@@@@@B@@@@@DDBB@@@@A@@@@BB@@@B@@@@A@@@@BB@@BBB@B@B@@@@B@BBFB@@BB@B@D@@B@@@@@@@@@@AB@@@@AB@@A@@@CDA@AB@BA@@BA@@@@BBB@B@F@B@B@B@@A@AB@@@B@@@B@@@B@@B@DBB@@@@BDD@B@@@D@@@@BB@BA@@BA@@B@@@@AD@@B@@BB@B@B@@@BB@@B@@BA@AB@@@@@@@@@@@D@B@@@BB@@@@@@@B@@@@@BAB@@@@@B@@BB@B@@@@BBA@@B@@@DD@@@@@B@@@@B@@@BB@@@@B@@@B@@@BB@BB@@@@@B@@@@@@A@@BA@@@@@@@A@@B@@@B@@@B@@@@A@@B@@@@@B@@@@@@@@@BA@@B@@@@@@A@@BA@A@@B@@@B@@@B@D@B@B@B@@@B@B@BB@BB@@@BAFADA@@B@@A@@@AB@@A@A@@AAAA@@CAAAAC@@BCBCBE@@@@@AB@@@@CB@@@B@D@HAB@DB@@B@B@F@FBB@@@D@JCDAJEB@@AB@DAD@@@FCD@D@B@F@B@B@B@F@DAB@@@DBDBBBBB@BB@@@@ADABABA@@BAD@B@FBB@B@B@B@B@BA@@@ABA@A@A@@@@B@B@F@FCDAB@@A@A@A@AB@BA@@F@HAB@B@DBB@B@B@BA@@@@@B@BADABA@AB@@A@CAA@@@@@@B@@HBB@@@DBB@F@B@B@B@BBB@@B@BBBBB@BBBDBBBDD@@B@F@DBB@BB@@BBB@@@B@B@B@B@@@B@HAB@D@B@BA@@BABA@@@AA@@AEA@@@@@@@@@@BC@@@A@@@AAA@@@EAA@IBA@AB@BABA@@@C@@@AD@@@D@B@FBF@B@BADAB@@@BB@B@@BB@B@@@@B@BBDB@BB@DABBB@B@F@B@BAB@@AB@B@@AD@B@@A@C@@BA@@BCBCBA@ABA@A@@BA@AB@B@@@HDB@@B@B@B@@BD@@@B@D@B@@BB@D@@BD@B@@BB@@@B@@B@D@B@B@BBB@BBB@B@B@F@DBD@B@@@BA@A@@@@@A@@@A@@BC@A@A@A@@CCAAA@AA@@@@B@@AB@BBB@D@BAB@BCDC@@BC@A@A@A@@@AACCAA@@@@A@@@@B@BA@@@@@AC@C@A@A@@A@AB@@A@@@@@A@@@A@@@@BAD@@@BC@@@E@@@A@@ECAAAA@@AA@@@@BA@A@@AAACA@CAAA@@@@BA@@DAD@B@B@D@D@@@AA@C@A@A@C@A@A@A@A@CA@@ACA@@A@@AAAAAACAAAA@@@@BA@A@@@@A@AAC@@@@@@@B@@AD@BA@@@@@@@@AACA@A@C@@AA@A@ABCBABC@@@@@A@A@@BC@@BA@@@A@AA@@AAE@@AC@@@A@CB@@@BCBAB@BA@@@A@@@@@A@@@@@@@AA@@@AA@@@@@A@@B@@@A@@@C@AA@AG@@@@@@@@@@CAA@@B@BABA@@BA@A@@@ABA@@@@@@B@B@BDDB@@B@B@BABA@AFC@@@@BB@B@@@@BBBABAF@B@H@@@B@@AB@@ADA@@BAB@@@B@B@B@B@BB@@BBBBD@@@B@BBB@B@D@D@B@@@B@F@@@BAB@@A@@@@AAACA@@@@AB@BALCH@B@@@@@@A@ABA@@@AB@B@B@@@@B@B@@B@@@FC@@@BB@@B@@@BBBB@BBB@B@@@@@BB@BHJ@BD@BB@@D@@@HCB@F@@@BA@@@@@AAA@AA@@AACAACEA@@A@@BABA@ABC@A@@AA@AAACC@AA@@A@@@@DAB@F@BAB@@@BA@C@E@@@A@@@AB@BA@@BAHAB@@@B@@BB@@B@BBD@B@BB@B@BBD@@@DDDHBB@@B@@BDBBBB@@@@@HCB@B@D@D@DBB@B@@BB@D@BBB@B@B@@@@FBCDC@ABAB@HCHADABAB@@A@@@A@@@A@C@A@@BAB@@A@ABC@ABA@AB@@@B@@BB@@@BAB@@@B@BB@@B@B@BA@@B@@BB@B@D@B@B@FB@@@@@B@B@@@@AB@@@BBBDBB@BBB@BBF@B@BBF@@@@@HEB@D@DA@@BAAABA@@@AHCB@BAD@FA@@B@@B@@AB@B@@@@BBDBDBB@@@B@@AB@@A@A@AB@@AB@BADABA@@BA@@BC@@AGB@@AB@BADABADCD@F@BA@@@@BA@@BA@ABA@A@@@A@A@@@AB@@@@@DD@@DAB@J@D@B@BDBBBBBB@B@B@@@D@B@DBB@@BBB@B@@@D@@ABAB@BC@@B@@@@@BB@BAB@@@B@@D@@BB@@BBB@BAH@@@BB@@@BBB@B@DABAB@@A@@BA@A@@@A@A@A@C@A@A@@B@@AD@@@@AB@BABAD@B@B@BA@BB@B@BBBFB@@BB@B@B@@@@@BA@AAC@AAA@A@@AA@A@@@A@A@A@@BA@@BAB@@@BBB@BBB@FB@BBB@@BBHFBB@BBB@@@@BB@@H@F@@@DAB@D@B@HBDB@@@@@B@@@@@B@BBF@@@B@B@@CDA@@B@@BDBBB@@@@B@@DB@@@@@BB@B@DBB@@@B@@@BE@E@A@@BAD@@@BABABA@A@@AEAA@@AA@A@A@@@A@A@AB@@@B@D@@@B@B@D@F@DBB@@BB@B@@@DB@@BAB@F@B@@@DEDE@CB@@@@ABABAB@@@B@BBFDFHB@BB@@FAB@@@@@DBDDDDDB@@BAB@@@B@F@@@BA@@@ABEBC@ABCBC@@B@BAB@BA@@B@@AD@BBB@BB@@@@B@@BB@@@FAHAD@B@@@@B@@@@@BBB@B@BBB@@BBB@BBD@DDD@@@@@FEBCDABAB@BABA@A@C@C@A@A@EBC@A@AB@JED@HAB@DAB@B@D@@@FBB@BBD@D@D@B@BBB@B@@@D@F@FADA@@BAB@@@FBBBF@B@BBBB@@B@FDB@BBL@B@B@@BBBBB@@B@@@B@B@BA@@BA@@FABAFABAB@BA@@@ADA@A@@@@AA@@@@BE@@@A@@@AB@DDB@@@@@BAA@@A@@@C@A@@B@@@@A@@@AAA@ABA@@D@DBDB@@@B@@@BABAB@@AB@B@@@B@@@@@@B@BA@@@@B@@@B@@@@BA@ABABADA@@@@B@@BBBBBDBBBB@@BB@BBBDD@@@@BB@BBB@@BB@@BA@@@BB@@@@@@AB@@ABC@ABAB@BBB@@@BA@@DCBBAA@@@A@CAAACBA@ADABA@A@KBAACACACCC@AAC@CCCA@@AAA@@@A@@@@@A@@@@B@@@B@BAB@@@D@B@B@D@DBBB@@B@D@BAB@BAD@DADAFADABAB@@@D@B@@@BBDBDBDDBDB@BBBB@@B@B@D@DABA@A@AAABC@C@A@@B@BAB@DA@@B@F@D@FBBBBBBB@D@B@BBBDD@BBB@BB@D@DBB@DBD@BAB@F@B@D@B@B@DBFDFBD@D@BADAD@@@DCDAB@DB@@BDBFBDBBD@B@DAD@@A@@ACCA@A@CB@@AB@B@B@BB@@@B@D@BB@@BDBDBBAB@BA@@AA@A@A@CB@BAF@FAD@B@DBB@BB@BB@B@BAB@@A@ABC@A@A@ABAB@D@BAB@BA@AB@D@@@B@@AB@BAD@D@D@B@BBBBB@D@B@@A@A@A@A@@CA@@@A@@@A@@@@@AAABC@CBCBA@@B@BAB@B@BBBAB@B@@ADCBAB@@@B@BBB@F@D@DAB@DABADC@A@A@A@@CCAA@A@A@A@AB@DAB@@@BBB@@BBB@B@@@B@BBBBBBBDDD@DBB@DAB@BA@@BA@C@E@AB@@@B@B@@@B@@@B@B@@@DBBBFBB@BA@A@@@@@AA@@AA@A@AAA@@@@AAA@C@@BADABAB@DBD@BBB@BAB@BAB@DABA@@@A@AA@CA@A@@BA@@B@@ADA@@@@@@BBB@B@D@@@HBB@DBB@@@DABABA@A@AA@@AA@A@@@AB@@A@@@@@A@A@@AA@@@@@AA@@CCAA@A@AB@@AB@DBBAB@BAB@@CBABA@AB@BB@@BB@BB@@B@BB@@BB@BBD@@@BABABC@A@CBC@@B@@@B@@B@BB@@@BBB@BA@A@@@AAAAACA@@A@@A@@@A@A@@BABGBGBA@@@AB@D@B@BA@@@A@A@AAA@CAA@A@ABABCBA@C@E@@BABCB@BABA@A@ABA@AB@BBB@BBB@B@B@BABABA@A@@@AAACA@AAA@ABA@AB@BAB@B@B@B@DAB@B@BBD@B@B@BADADCBABCDADCB@BABB@@D@BBBBDAB@B@DBD@BBB@BADABA@A@AA@@AAC@AB@B@B@B@B@@@BBBBB@@@@@DBB@BAB@BA@C@ABCDAHA@@DB@@DFBBBBD@B@B@BABGBABAB@BB@C@EAC@C@ABI@CCGAEECGAIAKEG@CCAEDIFGDCDGDEDIDE@GBKCEACCGACCCEGEGCGAC@@AGAECEAECGEGCCGEECGAECGBC@I@EB@BGDCDCDADEFEDCDC@IBIAGCECECGC@@KCCBMBGBEHETCNCHEFGBIBKBCBKBI@IDEBGDC@GBI@IECGEEA@CGGKGIGIEGEECGAG@E@@AFA@C@AB@@ABBBAAA@@AAA@@@A@@BA@@DAB@@@@@@A@A@@B@B@@@BAB@@A@@AAAC@@@@@AB@@AAG@A@@@C@C@E@@@ABA@@AC@A@A@ABA@ABA@AB@AG@A@@@A@A@A@@@@BA@AA@@A@A@@@@@ABACI@@@ACCCAAA@@AA@@@A@A@@@AA@@@G@C@A@@@@A@@@A@A@@A@CC@A@@CC@@@@@ABA@A@@@AEE@@@C@@AC@A@@@A@@@@BABCBC@@ACBA@AAA@@BA@@@AA@@C@AAEA@@AAA@A@@@AC@C@@@A@AAEC@@ABCBCB@@@@AA@AAAA@AA@@AAA@A@@ACACA@AACAA@@@A@@BA@@@A@@@@@A@@BA@A@A@@AA@@A@CAAAAAC@A@A@A@A@@AA@GAA@A@A@AA@@A@AAC@A@@C@CAACAECA@@@A@@@AAA@A@ABA@@@CAAAA@A@A@A@@BA@@BA@@@C@@@C@A@ABCB@@@@AAA@A@AAA@A@@@@@ABA@@BA@AAC@@@@@@A@@BA@A@A@A@AA@@@A@A@@AA@@@@C@@CA"],encodeOffsets:[[108928,34920]]}},{type:"Feature",id:"620525",properties:{name:"张家川回族自治县",cp:[106.204517,34.988037],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACAE@AA@EB@@@@@B@@@@@B@B@@ABA@CBABABA@A@A@@@@@@B@@@BAF@D@B@@@B@BBB@B@@@BA@CD@@@@@DBF@B@@@D@B@@@B@B@B@BADABEBABABABABA@AD@@@B@B@B@B@@@B@BAB@@AB@@AB@@@DB@@BB@@BB@@@BDBBBBBB@B@B@BA@@@A@@@A@A@AACAC@A@@@@@BB@@DD@B@B@B@@@BAB@B@B@B@BAB@BAB@B@@@BCB@B@B@B@@ADAF@B@DA@ABC@@@A@AACCA@@@@@CBABADAB@@C@A@@@A@AB@@AB@@AB@@@@ABC@AB@@A@@AA@@A@A@@@@EDEBA@ABAB@@A@A@@@ABADED@@@B@@@B@@B@BBDBD@D@DBD@@@B@BB@@B@B@@@BB@@@BA@A@@B@@@@@FAB@BAB@@A@A@A@A@@@C@A@@AA@A@A@@@@B@@A@@@A@A@A@A@C@@@C@CAC@AA@@A@CBE@A@@@A@@A@@A@@A@CBA@A@@@@@A@AAAA@A@ABA@@@CA@@A@A@A@CAA@C@AAAAAA@@A@@@ABA@@@CDCB@BA@@@EBE@ABA@C@A@A@A@C@EAAA@@ABA@CBA@A@ABA@CB@BAAA@A@@@A@A@ABA@A@A@A@ABAB@@A@@@@@A@@@@CA@@@A@@@C@A@A@@@A@A@C@@@C@EAA@@@ADAD@@ABA@@BA@@BA@CB@@CB@BA@@BA@A@A@ABA@@BA@@BC@A@ABC@@B@@AB@BA@@@C@@@ABABA@CD@@@@@B@@@@DD@@B@AB@@A@ABA@A@@AA@AA@@A@ABC@AB@@A@A@A@ABEB@ACC@AA@A@AAC@A@@@ABA@@BA@CDA@A@@@A@A@C@AA@@A@@A@@@AA@A@A@@AA@@@A@A@@BA@@@@D@@BB@BEF@@@B@B@BAD@@ACA@AAA@A@@@ABA@A@@BA@@B@@BD@H@@AB@D@@@B@@@@BD@@DFBF@B@@@B@BA@@B@BA@@B@BADADABAB@DAB@@A@@@A@A@CAAAA@C@AAA@AAAACA@@A@@@A@@DABCDABABA@A@A@A@@@@@AB@@A@@@A@A@@@AAAAAA@@@@@ABA@A@A@@AA@@B@@AB@B@@BBAB@@@B@@@DBD@BB@@B@DAD@BABC@@@C@ABA@AA@@@EA@@@@@@AA@C@A@A@@@AA@AAAAC@A@C@A@E@@BC@@@A@@@@@@A@C@@@CAA@@A@AA@@@@@ABA@@AACC@AA@A@A@@@AAA@@@A@AB@@A@@AAA@@C@C@AAA@CB@@ADAFAD@@ADA@A@@B@@AB@@@B@B@D@BBBAB@B@D@B@@A@@@C@@BA@@B@@A@@@@AAAAACAA@AA@A@A@AA@@@@A@@A@ABCBA@@@CC@@A@AA@BC@@@CDA@@@CA@@CAAAAACAA@A@A@C@C@CAA@@@AA@@@@@ACA@@C@A@@@ABA@A@A@AAA@@@@@CB@BA@@@A@@@AAAAAACAAAAAA@@@A@A@@@CBAB@@A@@@CA@A@@@C@C@@@@@@A@ABA@CBABA@@@@@@D@@@B@D@B@@@B@@@@AB@@@@A@@A@@A@@@@@@BB@ABB@@BA@@@A@A@A@A@@@@@@BAB@@@BB@@@@BBB@B@FAD@B@@@B@@BB@B@BA@@BB@@@D@BBD@@BB@@@B@@@D@DA@@DCB@BBB@B@@@BAD@BB@@B@B@@@B@@@BB@@@B@B@@@B@@@@BB@@AB@@@@@BB@@B@@@B@B@@BB@B@@CD@@@BBBB@@@@B@@@@@BA@B@@B@@A@AAA@AAABC@C@A@A@C@A@C@C@A@@@A@@@A@A@A@AAA@@AAAA@@BA@@@@@@@@A@A@@A@A@A@@@AA@@A@@@AB@@@@ADA@@B@@@@@BB@@DB@@B@D@HDHDB@D@B@B@@@@@BB@BB@@@DBDABBB@@@BB@BDB@@@@B@@A@G@A@C@@AAAA@A@@DA@@@CA@@@B@@@B@@AB@@AACAGA@AA@A@A@@@@@BA@@@@@@AAA@@@A@@B@@@B@@@BA@ABEB@B@@@@@B@@@BA@CBB@F@@@B@@@@B@B@@@BA@@@@BAAE@A@@@A@ABA@@BAB@B@@AB@BBB@BB@AD@@@DAD@DABABA@@B@B@BA@AD@BAB@BAD@@@B@@CAC@A@A@ABCD@@A@A@A@@@AD@@A@@A@@AE@AAAAAAAA@@@@BAB@BABADAB@F@@AB@@@@BD@@@@@B@@A@AAA@A@@BA@@B@@@B@@DDED@@@B@@@@@BBB@@B@@@DCB@B@@@@@B@B@B@@@@@BB@@B@@BA@@B@@@@AD@B@@@B@@@BA@@@C@ABEAA@A@A@ABA@C@@BA@@@A@A@C@C@A@AA@A@AA@BCA@@A@@A@@@@BAD@BAB@BAB@BADCF@@ABA@ABA@@@A@CBCBA@CB@@@@EA@@CA@@@@@@A@CBA@C@@BAB@@@BFF@B@@@B@B@@CBCB@BA@@@B@DBBBBB@@@BA@A@A@@BA@@BB@@BB@@@@B@@@@@BB@@B@@@@AB@@@@@@@@BB@@@BABCDAB@@@B@@A@@BA@A@@@G@E@@@C@E@A@ABA@A@A@CB@@A@@AA@@A@@AA@AA@@AAA@@AAAA@AAC@@@B@D@@@@@B@B@@@@@B@@B@@@@B@@@@@@@B@B@@@@@@A@@B@@@@@@@B@@@@A@@B@@@@@@A@@B@@@@AB@@@@AB@@BBDF@@@B@@@@@B@F@D@D@B@B@B@DAD@D@B@@A@@B@@@B@@@@BBDAH@B@@A@@BB@@BBB@B@@@@@@AB@@AJ@B@@@@@HDD@D@B@@@B@BA@@B@@B@@@@@B@@BB@BBB@@@BBBBD@BDB@BFBHFB@B@@B@@@BA@@B@@@@@B@@B@BCB@B@@@@@@BDDBBB@@BBAB@@@B@B@DB@BBAD@@@BA@AB@@A@C@CBA@@B@@@BCB@BABAD@@AD@@@B@@AB@@@B@@A@A@A@A@@@@B@@@DB@@B@@@B@B@@@B@@@B@@A@C@AAA@A@@A@@@@@@A@@@AAA@@@A@@A@@@AAA@@AA@@@@@AB@@A@@AA@@@AAA@@@AC@A@A@A@@B@@BB@D@FBFDBB@BB@ABB@@@@@B@@ABA@AB@B@B@@B@@@@@BA@@BB@D@B@B@B@B@B@D@B@BBBB@BB@@@B@@B@@@@@BBB@BB@BBB@DBBBBB@@BDBBFB@@DBB@FFB@DD@BBB@@BBB@BB@B@@@B@@BBBB@BDBB@BBBB@ABA@@BA@@B@DAH@BABADAHAB@DA@@B@BA@@B@B@FBB@B@B@DBB@@BD@DBDBDBD@B@FBD@BBB@B@DBDBDD@@DBLFFDB@HBFBD@@@B@@BB@AB@@@BABA@A@BD@@@@@D@B@BB@JFDBFA@@@@@A@A@@BCA@BCAA@AACAA@@AA@A@ABAB@BABAB@B@@ABE@AB@BADG@@B@@@B@@@B@B@BBD@BB@@@B@@AB@DAB@D@@@BAD@@@B@@@BB@@DBB@BBBD@DD@DBBAD@@BBBBB@BBB@BBBB@@BBBB@B@B@BBD@B@DB@@D@B@BBB@@B@B@@@B@DCDAD@D@B@FEB@D@BAB@@@B@B@B@B@@@BABCDED@BAB@BDBBB@BABAB@@BB@BB@@BBD@@@B@@B@@F@D@D@FDB@@@D@B@@BB@BBD@@@BB@@DD@@FD@@@B@B@B@@@@FB@AB@@@BA@@B@@@DBB@B@BABA@@B@BBBBBBB@@B@BBB@@DABAB@B@DBBBB@@@@@@A@@B@BCBAB@@A@A@@@AB@DGDCBADAHAD@B@@@B@@A@@DAB@BABABAB@BADABA@AB@@C@@@@@A@C@G@A@CBCBA@@D@BBB@@@F@B@BBB@@ABC@@@@@AA@@@AA@@@@@A@@@ABA@A@AAA@ACE@ABCBABA@@@@BD@@B@B@B@@B@@BBD@DBDB@BB@B@@@B@DAB@@@B@DBB@@B@B@@B@DBBBB@@B@B@@DB@BDB@@BBB@@AB@B@@@B@@@@@@B@@@@DB@@B@BB@@BBB@@A@@BAB@@@B@BBB@B@B@BDB@@BBB@@B@@@BABA@@@@BBB@B@DAB@@A@A@@@A@AAA@@@A@ABA@@BA@@@AAA@A@A@AAA@@@ABC@ADEBA@C@AB@@@@@BA@@@A@A@@@AB@D@@@@@AAG@A@C@@A@@AA@A@AAAA@@@A@@@BABAFG@@@A@A@@BA@@@@@@@C@A@@@A@A@@@@BA@@@@D@@@B@@@@@D@@AB@D@D@B@HAD@FA@@@@@@AA@AGG@AAA@@AA@@AAA@AA@@A@@AA@@@A@@AA@@AAA@C@@AA@AAA@A@C@A@A@@@A@@B@D@@@BABABA@A@A@A@@B@D@@@BA@@B@D@B@@@@A@A@A@AAA@A@A@@B@@@BBBAB@B@@A@ABA@A@ABABA@@BABABAB@F@B@@@B@BADA@@B@FBBBB@B@BBD@BBB@BBB@B@B@BB@@B@D@@AD@D@B@@@BAB@BABAD@B@DAB@DBFBBB@@B@@@BBB@BBBB@@B@B@@BB@@@@B@@BABAD@@ABA@@BAB@BAB@B@BA@@BA@AB@@@B@@@BABA@@@AB@@A@@BA@ABA@C@@B@@@@@B@@A@@B@@AD@B@BAB@@@@@BABADCB@B@B@B@B@BAAA@AACAEAE@@@@@@@@@@@A@@@@@@@@@@@@@AB@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@A@@A@@@@@@@@@@@@@A@AB@@@@@@@@A@@@@BAB@@@@@@@@AB@@@@@@@@@B@@@@@BA@@B@B@@@@@@@AA@A@AA@@A@@@@@@@@@A@@A@@@@AA@@@A@@A@@@@A@@A@@A@A@@@A@@@A@@A@@@@C@@@A@@@A@@@@@A@@@@@@A@@@@A@@AA@AA@@@@@@@@@AAA@@@@@@@A@@A@@A@@@@@@BA@@@A@@BA@@BA@@@@@@@@@AB@@A@@@AB@@@@AB@BA@@@@@@@A@@@@@A@@@@@@@A@@@@@A@A@@B@@A@@@@@@@A@@B@@A@@@AB@@@@@BI@A@C@G@E@AAKHG@AFEHCNKBGBCBCBEBE@ABG@IBIBIBGDEFEBCFGHCHEHAFCHCJCFCDCDEDMAG@@EIAK@IBAA@C@A@A@@A@ABA@A@CBCBCBA@A@C@AAAA@BADADAB@D@@@HE@E@@BC@A@@@A@AAEBCHCD@BADCDADC@A@C@@@GBA@A@AAAAAAEAA@C@@AAAACACAA@ECCAC@ABCBE@EC@@IC@AB@DEDC@ICACAAACACCI@G@I@E@K@I@IBE@G@CCEA"],encodeOffsets:[[109057,35578]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
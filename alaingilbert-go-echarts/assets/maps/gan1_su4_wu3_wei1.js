@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("武威",{type:"FeatureCollection",features:[{type:"Feature",id:"620602",properties:{name:"凉州区",cp:[102.642184,37.928224],childNum:1},geometry:{type:"Polygon",coordinates:["@@CB@@@A@@@AAAB@AABE@AAABA@AB@BABABA@C@A@@AAAAAA@@@@A@@BEDGDAB@@A@A@E@A@@@@ACC@@A@@@A@@BA@AH@@@B@FAB@@@@CBA@@BAB@@CD@@@@@BABAB@@@@@BBBDBFBB@BB@BDBB@@@@B@@@B@BAD@BAD@@@BA@G@EDA@C@A@A@A@ADA@@@A@AAC@AAA@A@A@AB@@@B@@@@@@@B@@A@@@A@@AA@@BA@C@A@ABA@CD@B@BA@@B@@@@A@@BADCBGF@@@HAB@B@@CFAD@B@BAD@B@@A@AB@BABA@A@@@A@@AA@A@@AA@@A@@@A@@@A@A@@@AAA@A@@@AAC@@A@AAAC@@A@@@A@A@AB@@ABAB@@KJ@B@@@@@@B@BBB@@@BB@B@@DD@@BBB@BDF@BBB@@BA@@BCB@BBDBB@B@@@B@@@@BB@@DB@B@@@D@@ABCBCBCBCBAB@@A@@DAD@BA@ABA@ABCDABABAB@@CBCB@@AA@@A@@@AB@B@B@DAF@@@@AB@BA@@B@B@BAB@B@BB@BBDB@@B@B@@BB@@@@B@B@BB@CJCJAD@@@@A@A@AB@@ADAB@B@BBD@DAB@@@BE@@BC@G@C@C@C@@@ABAF@BA@@BA@@H@BDF@@B@BB@@BB@@D@D@DAB@BAB@BABA@A@A@C@A@AB@BAF@BABC@@BAF@B@@@B@B@@@@@B@BBFA@@BBDBFBB@D@@@BBBBDDFFDBBBDDDDBB@@DBD@B@@BB@B@B@BAB@@@B@@A@@B@BA@@@@@A@A@@@@B@N@BBB@B@B@B@DAB@@A@@BAB@@A@@D@@@BAD@BAD@@@BB@@@D@DBDBBDBB@B@D@@BDBB@BBBBFBBBB@D@@@@B@@A@@@@B@@AB@@@D@@@@@@A@@@@F@@A@ABAA@B@@@@AB@@@@ADAB@@@@@B@@@BBB@B@BA@@B@@@B@@@B@B@@AD@DB@@BH@D@@@BBDB@B@@AB@@AB@B@@@B@B@@BD@BB@B@FCDA@@BBBB@@BBB@B@DBB@D@@B@B@@@BA@@B@@@BBB@@@DDB@B@FA@@BAB@B@@@@BB@B@@@DB@BB@@BB@@ADAB@BABCDAB@BCDA@@@E@@@A@@BABABCF@@A@A@A@@@@BBD@@AB@@A@A@A@@@ABCFA@A@@@AAA@AAE@C@A@@AC@GAA@A@AB@@BBBB@@@B@BAD@D@@ABABCDA@A@@D@BA@BBAB@BAB@BA@CD@@ABABAB@@@D@B@DA@ADA@@BA@A@A@C@C@A@A@ABA@A@@@@AC@A@@@A@@B@@AB@B@B@B@@B@@BAB@@@@AAA@CAAAA@CAA@A@A@@@AB@@AD@@AB@@A@@@@AA@@AA@@@@A@A@A@@AAAA@@@@BCA@@A@A@@@A@@A@CAA@C@AA@@A@A@@@A@AC@@@AAA@@AAA@@@@@ABA@ABAB@@@@EA@AAA@CBA@@@@AAA@CA@@@@@AA@ACAAA@@@C@AAC@OAA@@@EDA@A@@B@@@BB@BDBB@@@B@B@@BBDBBB@B@B@@AB@@@@A@@@@AA@@AA@@@AB@@@B@@@B@@B@DB@@BB@@@@D@B@@@D@DB@BB@@BB@B@@@BB@@@B@@BBBBD@@@@BBB@@ABA@AB@AA@@@A@@@@@@BA@@@AA@BAA@@AB@@@@A@AAABA@@@@@A@@A@@@@AAA@@@A@@@@BAB@@@BBB@B@B@@@B@@@@ABA@@BA@A@A@A@A@@@@B@@@F@@@@D@@B@B@B@@@@B@@@B@BB@@@B@BB@B@B@@B@D@@BBD@BB@DBBABA@ABCB@@AB@B@@AB@B@@@@BB@B@@B@AB@@E@@BA@CDA@ABA@@@A@EAA@@@ACA@@@AAA@AAA@AACA@@@@@BABA@@B@@@BBBAB@@AD@@@BA@@@C@@@CDA@@@A@A@ABABC@@@@@@B@@@BBBB@@B@B@BBB@@@B@B@BB@@BAB@B@@@BBB@@@B@@AB@@@@ABBDA@@@@@A@C@@@@AA@A@AA@@A@A@A@AAA@AAA@A@A@@@AA@@A@A@@AC@@@CCAAEA@@CAECAA@CA@A@ABA@@@@BA@@BB@@BA@AAC@@@CDABA@ABCBAB@@@B@D@@@D@@@B@@AB@@GA@@@@CDAB@@DDDDD@BB@@B@@BDDB@@BDB@BBD@@@@@BAB@@@@@BB@@B@B@@@@@BA@AB@BA@CDABABA@@@AB@@BBBB@@@@BD@@AD@DA@@B@@ABA@A@A@ABA@@BA@C@C@@@@B@@@BA@@@@B@@BBB@@B@B@@BB@B@@@BA@AB@B@D@@@BABAB@@C@A@AAA@CCGEAAAAA@AACA@@A@A@@@@@@@A@@BB@BDBBBBDBFD@BDBDDB@FBF@FBFBDB@@BD@B@B@B@BAB@B@B@BA@@HAB@@@BA@AB@B@B@@@BAB@@A@AB@D@@ABCB@B@@@B@@@DADA@@D@@A@@B@BCB@@ADAB@BB@@BBBBBB@DBB@D@F@@@B@@@BDDB@@AB@B@B@B@BCF@B@B@D@BAB@B@@A@@@ABCB@BA@@BA@CBA@@BA@A@A@@BA@ABC@A@A@CDC@A@AAA@ABA@A@A@A@CBC@E@EBAB@BABAB@B@@@@@BB@@@@@@@AB@@@D@@A@@@A@A@A@ABAB@BCBABA@A@@@AAA@@@A@A@ECA@@@@AAA@@@AAA@AA@@@EAC@A@AAAA@@@@CA@@C@C@@@@AA@AAA@E@AAA@@@@A@@@@CA@@A@@@A@A@AAC@A@@A@@@EAA@@@AAA@A@CAA@@@@CBABCA@@A@@@@@@B@D@@@@A@AB@@ED@BA@@BC@A@AA@@CDA@@@A@AAAAA@@@A@AB@@A@@@A@@@ABAB@@A@@@@@A@@AA@A@@AAA@ACAA@AA@AA@@@@@CACBA@A@A@ABA@A@A@@AA@A@C@A@EAA@A@C@A@A@@AA@ABA@@@A@EA@@A@A@AC@@C@AA@@@@A@A@AB@@ABA@A@A@AA@@AB@@A@A@A@A@A@@@A@A@A@@@A@@AAA@C@@AA@@A@A@A@CBA@E@C@AACBA@A@@ACA@@A@A@C@@@AC@@AA@@AAACACACA@CAA@AA@CAA@@A@@AKBC@@@C@ABA@AB@@A@@@@D@BAD@B@@AB@BABA@@BABA@@@ABA@C@@@GBG@A@A@A@@@A@C@A@A@@AA@@@A@@@A@A@@BA@@B@D@B@BA@@@A@A@E@@@CBABA@A@A@CBC@@BA@@@A@ABABEBA@@B@@@@@B@B@B@@@BCDA@@B@@@B@@BB@@@B@@@BD@B@DDBB@@@B@@BBB@DBDDB@B@B@D@BA@@BBBB@BBDBD@@@@AB@B@@AB@BA@CDABADAD@@AB@B@F@B@@AD@@@DAD@@@DAB@@@B@BB@BDB@@@D@B@BBB@@@@B@F@@@BA@@B@@@@DB@B@@BD@FAB@B@B@D@B@BBB@@@BA@@B@D@BB@AB@F@@BB@B@@@B@@@BA@@BC@CBC@ABCBABA@A@C@A@A@A@EBAB@@@@@D@B@@@B@@@B@D@BABCD@BA@CBABA@@@ABAB@@A@C@A@@@ABA@CDA@CB@BB@@B@B@@ABEDCDABAB@B@@@B@@@B@BBBB@B@@B@@@@AD@@CB@@@@A@AB@@A@A@@@AB@BEA@@ABA@@@A@E@@@A@@BA@AB@B@BB@@BA@@BA@@DAD@@BB@@@BBB@B@@@@@@@B@@DBD@BBB@@B@@DD@@@B@@ABA@ABA@A@@@A@@B@@A@ABAB@@C@@@AAA@CA@@A@A@A@@AA@@@CBABDB@@D@B@@@@BBB@@@@@@BBD@@@@@@BBB@@@@B@B@B@@@BBBB@@@@B@@AB@FA@@BB@B@@B@@@@@B@@@B@D@B@@B@@DB@@BB@@@@B@B@@@B@B@@@BAB@DAB@DB@@BB@BDBBBDB@DBB@@@@B@DBF@B@D@B@B@@@B@@@BBBBBBBBBB@@DB@@@@@B@@@@@BB@@@DBB@B@@@B@@@BA@@@A@A@AB@@@D@@AB@BA@@BA@@@C@AB@BAB@FD@@@@DB@@BDAB@@CB@@@@BB@@@B@@B@@@@@@AB@BABB@@@@@B@@BBB@@BB@@BBAB@DC@@@A@@BABCB@@@@AB@@@BBBBB@@@@BB@@@BB@@B@@@@@BCD@@@B@@B@B@@B@B@@@@@@ABBB@B@BBB@B@BBB@BDDDBDBJ@FAB@B@BBDBF@BBB@B@B@BBBDBBBDFFF@BBB@DBB@JBF@DB@BDBBB@DBJBB@FDH@D@B@BBDBDBB@B@HBBBDBFBBBD@BBBB@@B@B@@B@@L@J@FA@@BB@BB@R@F@B@B@BBBBB@PALB@@@F@HBBF@B@B@@BFAB@B@@@B@@@B@B@@@B@@BDBB@B@B@B@B@B@BB@@B@D@D@B@DBDBH@JBDBB@H@D@JALAJCJCHCFABABCDC@AB@FA@@B@@@CG@@@E@@BC@ADAAA@A@@@A@A@AB@DCDAB@J@TAF@B@JDFBDBB@DBBDBDDFDFHH@DBBBDBBBBJBDBFBDDDBFBBBDBBBD@HFBBDDBFDDBBBB@BBB@@@@@B@@BBD@B@B@@BB@BB@@BBB@D@@@B@@BB@@@@@@@B@@AB@FD@@B@@BB@B@@@BBBBBBBBBB@B@@@B@@@BBBB@@@DD@@B@@BD@@@B@FBDBD@B@BBDBDA@@@@BB@BB@@@B@@@@ABA@@B@@BB@BB@@BAD@B@@@DAB@D@B@@A@@@@@A@@BADA@@@@BC@@@@B@@@@@D@@AJAD@@@BAB@@@D@JAB@D@D@BAB@B@BB@@B@B@@@B@B@B@@@BA@@B@@BB@@@D@F@HAH@B@@BB@@@BABAB@B@@@B@DABAB@BCDAB@B@B@@@BAB@@@DADADAB@BA@@DAB@@A@@B@D@B@B@B@B@B@@@B@@@B@@A@@B@@@B@B@B@B@@@DA@@BBB@BADB@@BAD@B@@@B@BBD@F@D@D@H@HCF@BA@@DAB@D@B@@@B@B@B@B@@@B@@@BD@@B@B@B@B@BB@@@BB@B@@AB@@@@@@BBB@BB@B@B@B@B@B@@ABB@@DB@BBD@@BD@@BBBBBBB@BBBD@BB@DB@@@B@D@@@BB@BB@@@B@@BB@@B@@@@@@B@B@BBB@B@@AB@@@B@@DDB@@B@B@D@@BB@BBBD@B@@@B@BBBB@BBBAB@@CBAB@BBB@@B@F@B@B@BBDB@BBBBB@@B@@@@AAA@E@A@ABA@A@AACAA@A@C@AFE@A@A@AAAAA@C@@@A@@DC@ADAJGB@@@@@BBBDBBDFxBH@@@@AN@DANMDADAN@V@b@b@¬@ARBbAR@R@L@DBB@J@V@\\@®@zAP@^@AF@B@FGNKDCFCFEDCHEXUFCLK^YROHEBADCF@BAD@B@BAD@DAB@D@H@J@D@F@H@F@DBD@FBB@B@@@@@@BFIBCBA@CDEBCBCDC@ABABAFEBABEBABAB@BAB@DABA@@@A@@@AXJDBPFB@DBZJHDJDHBBB@CAEAGAI@I@EAK@MAIAKAEBGBC@ADCBGDGBIBKBKAGAG@@AC@YBO@ABABAB@BABABABADCBEBEBEBE@EBC@C@AB@@AB@@CBK@CBA@A@A@CBE@EBA@CBC@A@GB@@AAAGAUIEAWIEAGCOEYIcOSEGCQG]KWIWIECA@AAA@EAEAA@AAA@ICCCEAIEGCCACAECMGCAECGCCAC@CAECMCaMKEOEGCKCGCKCUIICmSEAeOSG@@@AAACC@AAA@@YAGAA@G@EAG@EA]CKAMAI@IACAOAWCI@CA@C@EBI@G@C@K@ATOHINKEAIECABAPGHCJCB@@@Uu@@K@C@M@ABC@C@A@CBEBC@E@C@I@ABA@AAIAEMAA@@AAC@A@A@E@@@AAAA@@ABA@AACB@A@@AA@@A@C@C@CACA@AA@A@AAA@CBC@AAA@A@EAE@C@AAA@ACAAA@A@@@AAA@@@@AB@@AB@AA@@@AA@@ACAA@A@@@@AAAAA@@AA@@ACAA@AAA@@@AA@@A@@@@@C@@@AA@@AAA@@AC@AAA@@AACAA@@AAB@@@@@A@A@@@@@@@A@@B@@A@@BC@@BA@@@@@CA@@A@@@@AA@@@C@@@@BADC@@@A@AB@@@@A@@@AEAAAC@CAA@A@@@A@@@@A@A@C@A@@A@@@A@@@@@@C@@@A@@AAA@AAA@ACCA@@@A@@AA@A@@AAAC@AAA@@ACA@AAAA@A@@@AA@@AA@CC@@@@CC@AACCC@ACCAACEAAECGIAAACCC@@AA@AACCCAACACC@@@AA@@A@AAA@@AA@@AAAA@@@@A@@@@CCCAA@AAAACAA@@AAAA@@A@CBA@@@@A@A@AACACAAAAA@A@@@AE@AAAA@@@A@@EA@EGA@@A@A@A@A@AAAA@AAA@A@AA@@A@A@@@@CA@AAB@@A@@@A@A@@@A@AAAAAAA@@ACAA@@@A@@CIAA@@@AAAAA@A@E@ABC@C@ADEB@BA@@ACCCEC@A@@GE@@CA@AAA@A@A@A@A@CAC@A@@BC@A@A@ABAB@DAFE@A@@@ACA@CAAAA@A@@A@AA@AACCCCC@@@A@C@A@C@@@@AA@AAAA@AA@@@@A@AA@@AAAAAAAAA@@@A@A@A@@@@BABBBAB@BAB@@@@EB@@AB@BCFABBB@B@BBB@@@@@D@BA@AB@@C@@@CAA@AAA@AA@AEAAAIAECEAECEA@@AAA@@@A@C@C@AAA@AAA@AAC@@@@@@@@B@@@B@@AB@@@@A@@@CBA@"],encodeOffsets:[[105141,38304]]}},{type:"Feature",id:"620621",properties:{name:"民勤县",cp:[103.093791,38.62435],childNum:1},geometry:{type:"Polygon",coordinates:["@@GCYICAA@OECAWI@B@@@B@@ABCBA@ABA@ABABAFABEFABAB@BCDADADCF@DABADEJ@A@@@@A@A@EAC@CAE@G@E@C@I@G@C@A@CBC@ABA@C@ABE@CDABGFQP]ZKLEDWVGFCDEFEDCDMLEHA@E@B]@O@yB­@[@U@I@A@CAK@Q@Q@aBQAB«@a@a@U@M@CBCBMNCBM@@B@@G@wACEAAACAA@@@@A@IHCB@BCD@@@B@@@DBBBB@B@B@BEF@B@D@BBBBD@B@BAB@B@B@FBB@B@@A@@@AAAA@ACAAAA@A@E@A@@@AA@ABADA@@BAAA@AAAAAA@@@A@C@AA@AAA@@@C@A@AA@CC@@@A@@BA@@@AAA@A@A@A@@@@A@@@AA@@@A@@AAA@@A@@@C@A@@CAA@@AACAAA@AAAAAA@@AC@@AC@ACA@@AA@BA@A@A@A@A@A@@AAA@A@@@@A@@BA@A@@A@@AAA@A@A@A@@@AC@@A@@@A@A@A@A@@@A@C@A@CB@@ABE@GD@@@D@DAD@DBBAB@B@B@@A@CB@@@@B@@@@BB@@@@DCF@@BD@B@B@B@F@@BBC@AD@BB@JBF@@ADABAF@@BDBBAFDBABA@ANBFAJCB@B@@@@@@@@@BB@A@BB@@@B@ABHF@@B@@B@BBBDHBBBDBBAB@@@BABABELGN@BHBB@@B@@HBBDDDB@@@A@@B@LBB@@ADGZA@CBADA@@BBB@BA@ABAB@@@D@F@@@@A@@@@@@@BA@@A@@@@@A@@B@@@A@A@@E@IAKA@@@FB@FDD@@BCDEH@D@B@B@B@BGLABCBE@GB@BBR@R@BBDB^@D@HBHAB@DBDBJAFAH@H@BBDDLBLDLFNBJBFDN@J@F@L@D@DEH[DWDi@Q@e@±@¨FF@BA@Zb@P@B]J[nCDADCBABCD@@EFA@ADAF@FAHAB@DADAFAFCJADIL@BABA@GDCBCBIDCBEBABA@@BAB@D@FAF@H@DB@ANAF@BA@ABCDAB@D@BA@@BCDABAAB@AAIH@@A@ADYT@BA@@D@@A@CAC@A@@@A@ADCBEF@@CBA@A@EDAB@@@@BBFDA@ABUPA@AB@BR¢DV@HF\\@DBPLx`VVRB@GZIxMzAN@@[NAB@@FH@@FHKDIDkP¯j{V[JKFGBMwAG@@QDQDeHõfA@fKD@@Id@FA@cDaBqDABUB]DLQBMBK@EA@@@@UH@@ABGJKLABOPSRKJIJKJGJIHGJCBCDMNEDIJGHEFGHCDEDEF@B@BB@DBH@FBNDNDPBNDHBHBJDLDJBLBJBJBJ@JBJBTBNBPBNBTBNBLBLBJ@JBHBH@FB@@B@D@F@HDHBLDLFFBJBLDFDLDLBHDLBNDF@HBJBNBLDLBFBLBLBHBJBDBHBHBF@LDJBF@HBHBHBF@LBRDNBLBFBB@NBNDLDJBJBLBLBFBJBLB@@H@HBHBHDF@JBHBFDFBLFHDFDLFJHDBJFJFFDJFRJJFFDFDFBFBHBJBHBJBPDNBNDFBLBNDF@LDRDLBHBFBHBFBFBHBDAHCJCLEFCJCHCFCFAFCHEHCDAHEHCHCDAFCHCFCFCJELEFALGJEFCFCDCJCFCFADCHEHEBAFAJEFAHCHCFABAD@D@F@FBD@DAJ@FAFAHAF@NALAF@LANAFALANAHAHAJAHAFAJANAF@LCNAH@LCLAFALATANAJCF@LCNAFANALCH@LCNAF@LAJCHAJ@NCFALANAFALANAD@FAFAFAHAFAJCJAD@FAJ@HAHAH@FAFAJ@FAJAH@NCL@JAD@F@JAFAJ@F@JAFAF@F@FADBF@D@BBBBDBFDDFHFDDDDHFFDDBFBFFFDFDDDBBFFDFDDBDDBHFFF@BDDHDHFDD@@DBHFFDFDDBJHFDDBFDHFJFBBDDFDFBFDFBFFBDBDBBBBpDZN^PBBBBrBBBB~hBBæÂ@BB@@DB@@ADDDBHBFDHBHBF@JDFBJBPDH@HBHBH@FBD@FBHBFBFBDBF@HBFDF@F@HBJ@HBNBL@FBJ@JBFBDBJ@FBDBDBFBFDFDFBB@D@HBFBNBPBBBnHF@P¸fÚtĂtDBLDJBFBH@LB@@J@J@NAN@P@N@P@PAN@D@jARA^@FAHAF@TENCNC\\GNCLCNANCPENCLANCNCNCNCLCPE@@BACIEUAMCIAKCICMAICKAKAICICKEICKEIEKEICIEKCGCEHGFGJGHIHGJKFGJIHIHGHIHIJGJKVWJMJGHIHIHGHGJKHIHGHGJIHIJIDEFGBCBEDGLWDKDIFIDIFKDIFKDKDEBE@AACEOCIEKCGEI@EAECG@CBEBIDKBI@KBE@GBKAEAA@EBE@E@E@CCCEMACKSCGIQEKEGAAAAACC@@AWIe}UU{A@@CÅ÷­ÛCGǋĕû¥ÓïA@BCHCDCHGHGDAHCHMDEHGHGDEFEFEHIFEBABCBGDGDEDGDGFGFKDEFGHIDEDCDGDGDEBCDIB@BABCBCDE@CBEDEBIBE@GBGBIBKBI@IBGBKDI@IBCBEBKBIDMBODMBKDIBI@IBEEEGCMECAECMECAGAABA@MEOEKAA@CAICEACAAAC@KEICKCSEKEKCKESGGCC@MEMECCKCMECAICAAA@AAGAIC"],encodeOffsets:[[105840,39e3]]}},{type:"Feature",id:"620622",properties:{name:"古浪县",cp:[102.897533,37.47012],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@@A@C@@@A@C@@AAA@@@@A@AB@@A@@AA@ABC@@@@B@BA@@BA@A@AB@@C@C@A@A@AA@A@A@@@C@A@@@A@A@E@@BA@A@@ACAAAAA@@@@@CB@@EAA@A@A@@@@BABA@@@A@@BCDABABADCBAB@BAB@@FFBBB@DBAD@D@@AB@@A@A@@@A@CAA@@B@@A@AAA@@@AB@@@B@@@B@@E@@@CDAB@@ADAD@B@@A@ABA@CB@@@@AA@A@@A@A@A@AA@AA@@A@@AAAA@@AA@@C@@@A@@@A@A@A@EBCBC@C@ABGAC@ABA@AACB@@CAA@A@A@CBA@A@A@A@ABA@A@A@A@AAA@@@GBABA@ABCB@@@@A@ADCDAB@@AD@B@BABA@C@@B@@A@ADCD@FA@AF@BAF@FAF@D@BB@@@@BAB@@EBA@A@AAA@A@A@ABCBEB@@C@A@A@CAABABAB@B@@A@A@@@@@A@ABEDE@C@A@A@EAA@ABCBAB@BA@CB@@A@A@@@@A@AA@ACA@A@@@E@G@A@@@CBA@@@A@@@CBCBCDABABAB@BAB@@ABC@ABCBA@A@C@A@AAA@@@AA@A@@A@@@ABEDA@CBEBEDABCDADAB@B@@@@ABCD@BABAD@B@BA@AB@B@@A@CBC@A@A@CBA@CBAB@@@BBBBB@D@B@BBBBBBD@BBB@BBB@B@B@@@B@B@@@@BBBBDDBBDBD@@@@@B@BAB@BB@@@B@BBB@B@@@B@DBB@BBB@B@@B@@@@@AB@@A@C@C@C@CB@@ABA@A@A@CAA@ABABA@A@CBA@@@@BA@@@C@@@A@@B@@AD@@A@CB@@A@@DA@ABABAB@@A@CBAB@@CBA@@BADADEF@@@@GHCB@BA@A@A@EA@@A@@BADA@@DAB@@ABA@ABA@@B@@CBCDCDCDABA@A@CAI@CAA@ECA@@@C@@@EAGAG@CAC@G@E@A@@@AAC@AAA@CC@@@@A@@B@A@A@@A@AAAAICCCAA@AAA@@@AB@D@B@BA@@@AA@@@AACACAAAA@AA@A@@C@A@AAAACACA@@ACACA@AA@@A@@AAAA@AAAA@@CAAA@@A@CAEB@AA@A@A@C@ABA@ABA@C@C@CAA@E@A@@B@BAB@@A@C@@@A@@B@B@BA@@@CBA@ABA@@B@B@BA@ABC@A@@@A@@BC@CA@AA@@@@AC@A@@@AAA@A@@@A@@@A@A@A@CBC@E@ABA@ABA@@@A@A@A@A@A@C@A@A@E@A@A@@@ABAAA@AAAAA@ACAAA@@AB@@AB@DA@AB@@@@A@@BC@A@@A@C@G@A@ABABC@AB@@@@AB@B@@AB@@@@AB@BA@A@@BCBA@A@@@A@CAA@A@@@A@A@@BA@CBA@A@AA@@A@C@C@A@AAA@A@@@CBABA@@@A@@AA@@AA@A@AA@@AAC@A@AA@@@A@@BC@@AA@E@A@@@AA@A@@@A@A@A@@@A@A@G@@AA@A@@CAAECAA@@G@A@@@@B@@@D@@@BBBA@@B@@A@ABA@E@A@AAC@@@A@AC@A@A@A@A@@BCB@BCDA@@A@C@@AA@@@@AAA@AA@CA@@@A@E@A@@AA@A@ABABA@@DEBAB@DAB@@@@CBA@@@A@CBCB@@AB@@@BA@@D@B@BAF@B@@@D@DEDA@@BAAA@@@C@@BA@ADABA@@@A@E@@BA@@@ABA@@@@@@AAA@@@AA@@A@A@@@C@@@A@A@C@AD@@ABA@ABAB@@A@G@G@E@A@@BAB@BA@A@@@@@A@AAAC@@A@A@@@CB@@A@@AA@@@A@AB@@A@A@CAA@C@AAE@AAA@AAAA@ACEAEAGA@AACCAAAA@AAC@AA@CBA@AB@@ABABABABCBC@A@ABA@@@AB@@@B@F@B@B@BABABABCD@@CBA@CD@BAD@@@B@@ABA@A@ABE@@@A@@@@B@B@@ABC@@B@@@BADCF@@BB@B@B@D@@@BA@CBE@@B@@B@@B@B@@@DABA@ABA@@BBB@B@B@@@BA@@BBB@D@B@B@B@B@BAB@BCDC@AD@@@BBB@D@@AB@@C@A@C@@@EDA@ABA@A@C@CAIAC@A@CCCACAAAA@AAAAE@C@A@AB@@C@EAE@C@ABCBCF@@@BBB@B@@@B@@FDB@DBDBBBBBDB@BFJDDBBFDDBDBFBD@D@DBDAB@BB@B@@@D@@BF@BBB@B@B@F@BB@BB@@@BFB@@B@B@@@BB@@B@B@@BB@DD@B@@BBBBD@@@D@@B@D@BCBAB@@@@AB@B@@B@@BB@@@BB@@@@BBA@@@@@A@A@A@C@A@A@A@@@AA@@C@C@A@C@@@A@@@A@CDC@A@C@A@A@A@CACAA@CAA@A@CAC@@@@@A@C@@@@AAA@AAACA@@CA@AA@AAE@A@A@GBE@@@ABA@A@A@A@E@A@AB@A@@CAA@AAC@CAC@C@A@@AA@@@ABCDC@A@@@@@AB@@@B@@@@A@@@A@@@C@@@A@AAA@EC@@C@@@A@@BAD@@@BB@@@AD@BA@@B@@A@@@AAA@AACA@AAAA@@@@ACA@AA@@A@@C@C@C@A@@@@BB@BB@@@BAD@@@BAB@@@BA@@BB@@@BBBB@B@DBBBB@B@@@B@@BBB@@@@@@D@BB@BB@@BB@@B@@BBBBB@BB@B@B@@@B@BBBBBBBB@@BBB@@@@@BBB@BB@BBB@@@@@D@B@D@B@@DDDDBD@BBBB@@@@BBBBB@DDB@B@@@BEFCBA@AB@B@B@BAD@@@BBD@D@B@B@B@BBB@BDB@@HF@@@BFDDDBD@@ABA@CF@B@DAD@B@F@BBBBB@B@@BBDJ@@@B@@BBBD@@BBBBBB@B@B@@@B@B@@@BA@BBB@@D@@B@B@@@BBB@B@BBB@BB@B@B@B@B@BB@FHB@@FB@@@B@BB@BBF@@B@B@BBBBBDBD@B@B@B@@B@DAB@@@BBBB@@BBBDBB@BBBDD@D@@B@@@@@BBBB@@BB@@BB@B@BB@@B@@DDDBBBDDBD@BBB@@DDBDBBHJFDBBDFBBDD@BDDBD@BDD@@@@DDB@@BB@@B@@@BBBBBB@BD@@BB@BBDBB@@@BBB@@@B@@DBBDB@BBB@BB@@@B@@@D@@@@B@@@B@@@@B@D@B@B@@B@@@B@B@DBD@BBFB@B@@@B@@A@@B@B@@CDAB@@@@@D@@BB@@@@@BB@@D@@@@AB@@AD@@@BA@@@@B@@@@@@@B@B@@@@BA@BB@DBBB@@BB@BBD@@BB@BB@@B@@@D@@@@@BB@@B@@BB@BBBBD@@BB@@BBBB@B@@B@B@DB@BB@@B@@BBA@@BA@@B@@B@BB@@B@B@BBBDB@BBD@F@FBB@B@BBD@DAB@BBB@B@@BDBDBD@D@B@@@BB@@@BDABBB@BA@@BBBB@@F@B@B@D@BB@@BBFNJBBBB@BAJ@D@F@D@FADAB@D@D@BAN@D@L@@@Vv@@A@IDGDOHABDBJFFBMLGJSP@B@L@D@HAJ@F@DDBJ@XDPBDBJBJ@NBLB^DFBH@FBH@B@HBZB@@BB@BDDBB@B@@THfPFBnTJDVJLDHDLDHDPFLFbNNDFDDBD@DBHDFDDBNHFDDBDBHDJFFBDDJDB@BBB@FBFBB@BBB@FDXJXJ^LRHHDTFdPZJPFHDFBXJFBVJHBBB@A@@@@@C@CBA@ABEB@@A@A@A@@BA@A@ABA@@BA@A@AAA@@@CBG@@@A@@@@@CDMBEBC@C@G@A@A@ABA@G@A@@@@B@BAB@B@D@LCrMQXGREVED@²_jK¦]@@PCJALCJALCJAPCHADCFCHGÌ³RM®rgFEHMBA@A@@BAAAGGIGKK@@OOOKQQAAC@A@CA@AA@AA@AAA@ACAE@@AA@@@CBA@@A@AAA@AA@AA@AAA@ABC@A@@CAEEAC@C@CAAAA@A@A@@A@AAA@@A@@@A@A@AA@@@@BA@@@AA@A@AC@@@@GCA@@@A@AAAA@@A@@AA@@@@@AACBA@@AAA@A@@A@@@A@@@@CC@A@@@@AAA@@A@@AA@CA@BCAA@A@A@A@A@AA@@A@@@C@A@@@CBA@AAA@C@@@@BABABA@@B@B@B@BAB@B@@A@A@@@A@ABA@@AA@AB@@A@A@A@@DGDCBA@@FBB@@A@ABC@C@@B@@@B@@@@ADA@ABA@@@AA@@A@ACC@CAC@C@AAA@@@AAA@C@CAA@AA@CECC@CACAA@A@C@AAA@AAAAAAC@A@CB@BA@@@A@ABA@@@A@A@@@@DC@A@ABC@@BA@A@A@AA@@@BA@AACAAA@A@@@A@AB@@C@GDE@@@CAAA@@@@C@CCAAAA@C@A@@AAAEAC@AAA@AAE@CA@@@GE@@@C@@AAAA@@@A@A@@AAAAAAAA@@BA@A@@@ADABA@ABAA@@AA@AA@A@A@@@@@@BAB@@A@C@@@AB@B@D@B@B@BADA@AB@B@B@@@@AB@@@D@BA@@B@BCDCHCDABABABAB@B@DC@CBAB@BA@A@AACAAEE@C@@@A@@@C@A@@AAA@@A@A@@BA@@DADABA@@@A@A@@@AB@DA@@BA@ADABADC@@AA@ACAA@CE@@ACA@@A@C@AA@@A@AAA@C@@AA@@B@@A@AC@@A@@@A@A@@CCACED@BADAB@B@@BB@@A@@B@@AD@@A@A@@@C@AAEAA@@@AAAA@CB@@ABA@A@@BABADA@@DABA@ABABA@@AA@C@@B@@A@ABA@ABA@@AAA@@A@@@A@@@A@C@@BA@@@@AA@A@A@AA@CAA@@@ABABGHABABA@ABAAA@@@CCCCCCAC@C@CAAEC@CA@EAAAAAAAACAA@C@@@ABBB@D@B@B@@@@ABC@AA@BABCDAFAHAH@B@BAB@BC@A@A@@A@CAACCAACCA@A@@AACA@@A@@@AACAKCA@MFA@A@ECCAA@CBCDEDIFC@CDICEGAE@@BA@@@@AA@@@AAA@@@AB@@AAAA@@AB@@AA@@A@A@@@A@A@AAA@A@GA@AAAA@AAAAA@A@ACA@AAA@EAE@GAA@ACA@@AEACCEAA@A@A@AAAACCC@@ACAAAA@@AA@@A@@A@A@CAAACAI@A@@@A@A@AAABCAM@A@A@C@CAA@@GAAAECAAC@@AA@C@ABA@C@AB@@C@A@AACA@@ACAAA@@@A@C@C@@@@BAB@@@BA@@A@@A@CBA@A@ABA@@@E@AB@@AD@B@@A@G@A@@@@BA@@@A@AB@@A@@AEAAA@AA@@@@@C@G@C@AB@@AAA@@B@@A@@@A@@@A@C@A@@@A@AB@@C@ABA@A@CAA@ABA@@@A@@@A@A@ABA@@@@BA@CBAAC@A@@@A@ABABA@A@@@@BA@@AAAC@A@A@A@@@@@AB@@A@A@AB@@@@A@@@A@@BA@ABC@AB@@AD@@A@CBAB@@@B@@A@@@BB@@@@@BBB@B@@BBA@AB@@AB@B@@@DB@@@BB@@BBBD@@BBBBDDDBB@@B@@B@B@D@@B@@@B@@@@@@ABB@AB@@BB@@BBB@@B@BD@@B@@@B@@@@@B@@@BAB@@AD@@@@@@@B@@@@C@ABA@A@@BA@@BBB@B@BABABA@A@@@AB@@CD@BA@A@A@ABAAAAA@@@A@C@@@A@"],encodeOffsets:[[106002,38086]]}},{type:"Feature",id:"620623",properties:{name:"天祝藏族自治县",cp:[103.141757,36.97174],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@AB@BABC@A@A@AB@@@@CAA@C@@ACAAA@@@AC@A@@@AAC@@A@A@@@AA@@@A@@AA@AA@C@@@@A@@@@@A@@A@A@AA@@@A@@@AAAA@AAA@@A@@@EAA@@BA@@AAA@@@BA@@B@CA@@C@@AA@@@C@EAA@A@@@A@ABA@A@@@A@@@AAA@A@GAA@A@C@@@CBA@A@@@A@AACA@AA@@@ABCBA@ABA@AAAAA@CAAAC@@@C@G@@BAB@@ABCBAB@B@@A@A@@@@B@@A@AB@@A@@B@B@DA@@B@BBBA@@B@@ABABA@@B@B@@ABA@@@AB@@BD@B@@A@ABA@@@@BCB@B@@@B@@BB@@B@@@BB@B@D@H@DA@@B@B@@@@B@@B@B@B@B@B@@BB@BBB@@@BA@A@A@@B@B@@@B@@A@E@A@A@A@@B@@A@@B@BBBBB@@@@@BA@A@A@@B@D@@ABA@@@@@AA@@A@@@A@@BADA@AB@@A@CA@@@@A@ABAAG@@@@@@B@@@BABC@ABA@@@@B@@BD@B@B@D@F@H@B@@@B@BAB@B@DAB@@@@A@@@AAAA@@A@@@A@AD@@@@AA@@@@A@@@CBA@E@AB@@@A@CA@@@C@@@A@A@AACA@AAA@@@@A@A@@@ABA@@@AAA@AA@@AA@AAA@@A@@@EDADCBA@E@A@CB@@A@AA@@AAAAA@CAA@A@AAA@A@@@@B@@@D@B@B@@CBA@E@@BA@A@@B@@AAAAACA@@@@@A@CBA@@@A@EA@@AAAAACA@@@A@ABGBA@A@@BABABA@ABCBC@CB@@AB@@BBBB@B@B@D@B@@BDBB@@B@@@BBDD@BB@BBDB@BBB@@DDBBDBDF@@@@BBB@@BBB@@BB@B@BBB@@B@@@@B@BBB@@@BB@D@B@@@@BAB@@A@EB@@@@@BC@@@@@@@A@@@@@A@@@@BE@@@ABA@@@@@A@A@@@@@@@ABA@@@@@A@@@@@A@@@@@A@@@@@AA@@@@@@A@@@@AA@@@A@@A@@@@@@A@@@@@@AA@@@A@@AA@@@@AA@@@A@@@@@@AA@@@@@AAAACC@@@@AAA@@@@@@@A@AA@@E@@@A@A@@@@@A@@@A@@@A@A@@@@@@@@@@@@ABCB@@@AA@AA@@A@ABA@AA@@@A@A@@@ACA@@@A@A@ABA@@BABAB@@A@AAA@@@CDA@A@C@A@A@AB@@C@@@ABCD@@@E@C@CBC@A@A@A@@@AA@AAA@CAC@A@C@C@I@CAE@A@AB@@A@ABCD@BAB@B@B@DBBBB@DBB@BBD@@@B@@A@ABE@ABC@A@C@AAA@C@C@@@@BA@A@@BABA@A@@@I@C@ABC@A@@AA@@A@A@A@AA@@AA@@@@@ABCBA@IDKBAB@@AAA@@@AB@@A@A@@@A@A@C@A@A@AAA@A@A@EBA@@@CCA@AA@@AA@@CAA@A@ABA@C@C@GB@AA@@@AAA@@@AAA@A@@AG@A@A@C@A@A@A@A@@@@@AB@@@BABAFA@@B@B@BBB@B@B@@ABABAB@@A@@@@@AAACAA@@C@@@A@AA@@@@ABCB@@A@CAAAA@AAC@@@@A@@A@@BA@A@G@@@ABA@@@CB@@@@A@@@@A@@@AA@@@C@@@C@C@@@A@A@A@CCA@EA@@A@A@CA@@EAA@ABC@@B@AAA@@A@AACAG@A@@@C@A@E@@@EB@@A@A@AA@@A@@@A@@@AAA@@@ABC@ABAA@@A@A@ABA@AB@@CCA@@AA@A@AA@AC@A@A@@@A@A@@A@@@A@@@C@A@A@@@A@@@@CA@AAAA@BAA@@@AAAAAACAA@@AA@ABCB@@E@A@A@A@@@A@A@ABABA@@@AAA@@@CBA@ABA@K@@@KDC@A@CACAEAAAAA@A@@@AC@@AA@@@A@AA@@A@@A@A@@@@CE@A@C@AA@@AA@ECCCEA@AA@AAA@@@ECCA@@AA@C@@AC@@@@@AB@@ADA@ABAB@DA@@B@BCBA@A@@AA@A@A@@@@DA@@BADADAB@@A@A@A@AB@DC@A@@@A@@ECA@AA@AAA@@B@@AB@@CBADC@AB@AA@@@@CAA@AACAAACBA@A@A@A@GAA@ACA@@@A@AAAAAAA@BAAA@@@@AABA@CAA@A@@@AAA@@A@@@C@A@A@@AA@@ABA@A@@@AAAA@CA@@A@@@A@@@A@@@@@@A@@AA@BA@AA@@A@CB@@A@@@@A@@@A@@A@A@@@A@@@A@@@A@A@A@@@ABCFCFCDCCA@@@@@A@@@ABAB@@A@A@AAA@AAA@ABA@ABA@@@A@A@@@@A@@E@@@@BA@A@A@A@C@A@@AA@@@@@@AA@@@AAA@@@@@AAA@@@BA@ABA@ABABADABA@AB@BABABABA@@@AHED@@@F@B@@ABAB@BA@@D@BADABA@@@A@@DA@@@ABA@A@A@ADA@A@@@@BA@@@AA@@C@@AB@@A@@@A@@AA@AA@BA@ABC@CB@@AA@@@AAC@A@@@A@@@@A@A@AA@@@@AAAA@@AAA@CC@AAA@@@A@A@A@@B@BAB@DA@@B@B@@@B@BBDBB@BAB@BCBAB@@AB@BABA@C@@@C@ABC@A@AAE@AACA@CCACAAAA@@AAA@AAAAA@A@CBA@A@@@A@AAA@AAA@A@A@EBA@@BA@@BA@A@@B@BAB@B@B@@@BCB@@AB@DABA@@B@AC@A@@AAA@A@@CCA@A@@@C@A@A@EAAAA@A@A@A@AAA@AAAEA@A@AAC@_IYLWRM@ALKEYCKc@gAI@YH{PABYPGDABGXHfJRBDRNh@VD\\PC@C@A@BBBBB@B@@@B@B@BB@B@B@B@BBB@@@BA@ABA@A@@BAB@B@DA@@BA@CBA@A@@@A@A@@@AB@@CHAB@BABA@ABCBABABABAB@B@@AB@@ADAB@@@B@@BB@B@@@BABC@CBABCDCB@BADA@@@ID@@AB@B@D@BAB@BA@@@AAAAAAA@@@AAA@AB@@A@@BAB@DAB@BABABEDCBAD@B@@@BBBBB@@@@ABA@A@KCA@A@@BA@@B@@BB@B@@BB@@AB@@ABCBABCBA@A@ABA@A@@BAB@@@BB@@BB@B@@@@B@@@BCBCBAB@@CD@BAB@DABADABABABA@@@AAAACAA@AAA@A@ABA@@B@@@B@D@@@B@@@B@B@BA@ABC@C@C@C@@BA@AB@@@B@@AB@@AB@@A@@@A@C@ABC@ABA@A@A@A@A@ABCB@B@@AB@@AB@@@@A@A@AAAAAA@@A@A@AB@@ADAB@BAB@BADABA@ABA@A@@@C@@@ABABADABAB@@AD@B@DAB@@@B@@@@@BAB@D@@@BCBCBA@@BA@AB@@AB@B@B@D@@BB@B@@@@DBBD@B@B@BABCF@DA@A@C@A@ABCDC@ABG@C@ABA@CDABC@C@A@E@C@C@ABC@AB@DAD@B@BBDAD@BA@ABC@@@A@AAC@AAA@E@@@A@ABABCBC@EBA@AB@@AB@B@B@BBB@@@D@F@BAB@BA@@@CB@@A@@@@AAA@AA@CAA@A@AB@@C@EA@@A@ABAB@@A@AB@@A@@@ABA@A@@@A@AAAAAAA@A@A@ABCBABCBCD@B@BA@@B@@ABCBCDEB@B@@BBD@DBJBHDDDBB@BBF@FAF@D@@BDBDBB@@DDHFFBDB@@HFBDFDBDDFDBBBB@B@B@BBBBB@@BD@FD@@B@@B@DAB@@@B@@ADABCB@@@BA@@B@BBB@BABADCD@@@BA@@B@@ABAB@@@B@B@FDFBB@DBD@DBB@@@@@B@@A@@BCB@@A@@@ABA@@@ABAB@B@B@B@D@BABBD@B@B@B@BA@AB@BAB@@@@@@@@A@BDDDDD@BB@@@DB@@BBBD@@@@@B@@B@B@D@@@BA@@B@B@@@B@@BB@@@@B@@B@BB@@@@B@@@BBBAB@@@D@@@BAB@@@B@B@B@B@@AB@@@B@@@B@@@B@FADABA@@B@B@D@@BDB@@BBB@B@@@B@B@@@@@@@B@@@@@@@BB@@B@FBNBFBDAD@D@@@B@@@@@BB@B@B@@@BB@DAB@D@D@FBB@DBBB@BFDDF@@@@B@@@BAB@@@FA@@B@@AB@@@@@B@@@@@B@@@B@@@@@FBB@BB@@B@BB@@B@DAB@D@J@@@B@B@B@@@B@BA@@B@B@B@@@B@BB@@BAB@B@B@BA@@B@@@B@D@D@@@BAB@@@B@@@DAB@@@D@B@@@@@@@B@@@@@@@@B@@@BAB@@@BA@@B@@@B@@@B@@@B@D@@@B@B@B@D@@@B@@@@A@@@@BA@@B@B@B@@@BAB@@@BA@A@ABA@@@A@@B@@@@A@@B@@@@A@A@@@@@A@@@@@AB@@@B@BB@@@BB@@@@@B@B@B@@AD@@AB@@A@@@A@@@A@@@ABA@@BA@@@@B@B@@@@@BAB@BABA@ABABABAB@@@@@B@@@@@B@@@@@B@@A@@@A@CBC@ABA@@@@@@@@@A@@@@@@@@BB@@B@@BDBB@B@BAHADADCBAD@@BB@@@B@BABA@ABA@A@A@A@@@@@AA@@@@A@AAC@CAA@C@A@CB@@A@A@C@@@A@A@@BA@AB@DA@@@ABCBCB@@A@@@AB@@A@@@@D@@@@@BA@@@@B@@@@ABCFABA@AD@@@BA@@@@@ABA@@@A@@@A@AB@@@@ABABCDCBCB@BEF@BAB@@BB@@@DABC@CDCB@BA@CDABAB@@@@A@AAA@AA@@A@ABC@@BA@A@ABCBA@A@@BA@CAC@AAC@CA@@A@A@ABA@A@@BA@@@@@A@@@ABEBCBABA@@BAA@@A@@@A@@@@@AB@@A@AB@B@@AB@@@BA@AB@B@@AB@@@DAD@@@B@@@BABA@@B@@A@@@@B@@@B@@@@ABA@@@A@AB@@A@ABA@@@ABA@A@EBC@GBA@A@AB@@@@@@AB@@@B@D@B@D@@@BAB@BA@@BAB@@AD@B@BAB@B@@@B@B@BBB@B@@@@@B@@@@@BA@@@A@@@A@@@A@@@AB@BABA@@@@BA@A@A@@@A@@@AB@@CBEFEDCBA@EDCBCDABABABAB@@AD@DBDB@@BBB@BHHBDB@@B@@@@@@ABA@A@CBA@@@AB@@AB@@@B@@AB@@A@@@A@A@AB@@@@A@@@AB@@A@A@@@A@@B@@A@@@A@A@AAA@@@AB@@AB@@AB@@ABA@A@@@CB@BA@@BA@ABABA@@@A@A@IAAAA@@@A@A@CB@@@@@@A@@BAB@@ABA@A@A@A@EBA@A@@@A@@B@@@B@@ABA@C@CB@@A@@B@@@B@@@BA@@@A@@@ABA@A@A@A@AB@@@BAB@B@@ABA@C@CBA@CBA@@@A@@B@@ADB@@B@B@B@@A@ABA@@BCBABA@A@C@A@@@A@@@A@EFCBCBCBABA@A@C@A@A@@BA@ABA@ABAB@@@B@@@@BB@B@@@@@BABCBC@ABA@C@@@A@@@AB@@@B@B@BBDAB@BAB@BBD@B@BBB@@@DBH@@@B@@ABC@A@C@A@A@C@A@A@C@A@C@A@ABA@C@A@A@C@A@C@@@A@@@AB@@A@@BA@AB@@A@@BA@ABA@@@AB@@AB@@ABA@CB@B@@A@@@AB@@A@@B@@AB@@@@@BA@A@AB@@AB@B@BA@@B@@A@A@EBA@A@CBA@CBC@ABA@C@A@A@ABA@@@A@AB@@A@A@ABA@C@AAA@C@ABA@A@AB@@AB@@A@A@AAA@A@E@A@C@A@@@@BA@@@CBABCBABABCBABAB@@@BBB@BBB@@BB@B@B@@AB@@@B@@@B@@@BA@AD@@@@AB@B@B@BA@@B@@ABCBCD@B@HADABCBCDABIDCBOJSJUJEBCBC@ABCBABABABCAA@CAGAEAA@C@A@CA@@A@@@ABA@@@AB@@A@@B@@@B@F@D@FBDBB@@BBBB@@BBB@B@@@B@@@B@@@B@@@@D@F@FBDDDBB@BBBB@DB@BB@@BB@@@@BAB@@@@A@@B@@A@@@@B@@@@@BB@@B@@@BAB@@@BAB@BAFBDBFDDB@DDDB@BBBBBDDBB@B@@AB@BA@AB@B@BABA@A@ABA@C@AA@BABADADABCBA@CDABA@A@AB@@A@@B@B@BABAB@BAB@B@BA@CD@B@BBB@BBB@DA@@B@@A@@@AAA@AAA@A@AAC@A@AAA@A@@@ABA@@@A@A@@@@BA@@@@BA@A@@@A@A@AA@@AB@@A@@@A@AB@@A@@BA@@@AB@@@B@@@B@@@BAB@@ABA@@@A@@@A@A@@BA@@@@BB@@B@@AB@BBB@@@BBBBDBB@B@@@@@@@@@@A@@@A@@@A@@BCB@@AB@@ADAB@@@B@B@B@B@@@@@@AB@@A@@@AB@@AB@@@@A@@@C@A@A@@A@@A@@@A@@@@@A@@@A@@@@@A@@@@@@@AF@B@BABA@@@AB@BA@A@A@ABA@@@@BAD@D@BA@A@@@@@@@@@@@B@BB@@B@BB@B@B@B@@@BFBDBBBBBBBBBBBDF@BBDBB@@@D@B@B@BBB@@@BA@EHABA@@BAB@B@D@BBD@BFHBF@B@B@D@@@B@B@B@B@B@@BBH@DBDB@@@B@D@BDBDBBAD@@AD@BAD@B@B@DDBBBD@@ABAB@@@D@@@@BB@DBB@@AB@BAB@B@B@@@BBB@@@BB@@@B@D@HBBB@@@@AB@@AD@B@BAD@F@F@D@BBBHHBBB@@B@@@B@@@@@B@@BB@B@B@@DBBB@@BBB@BBBBB@DBB@B@B@@@BBBBBBBB@BB@@B@@DB@D@B@@@@@B@@@B@@BBBB@@BBDBBBB@D@B@B@B@BBB@B@@BBBB@BB@@AD@B@D@@AB@@@B@BABA@BBBBBBBBB@@@D@@BD@@@B@BAB@D@@@@@BDLRLVBB@BFDBDDB@@@B@B@D@B@B@@BBDBBB@@BBB@B@@@BBBD@@BBB@JDB@DBLHJDBBBBBB@@B@BBB@B@DAF@B@@@@@@F@@@@@B@D@D@DBDBB@BBB@@@BBD@L@@BB@B@BB@BA@ABA@@@ABC@A@C@@B@@@BAB@BAD@@@D@LA@BB@@@BB@DBBB@DBB@BDBDBDBB@@BB@@BD@@D@B@B@@@DB@BB@B@DABBD@F@B@DAB@B@B@@@BB@@@DBB@BB@@@B@B@B@@@B@B@B@B@B@@@BA@@BBB@B@B@BA@@BAB@B@@@@@BBD@@@BDB@B@@@FBB@@@B@BAB@@BB@B@D@B@B@FBB@D@B@B@@BB@B@B@BAB@B@B@DADB@@@@B@@BBBB@DB@BBB@BB@B@@BB@@@@@B@@@BABA@@B@@@B@@@BAB@@@B@BBBBB@@@B@DC@@BBB@D@@AB@@AFC@@BAB@@@@@@C@A@@@@B@@@DBBADA@@@@BB@D@BBB@B@@BB@F@@@BB@D@BBB@B@@@B@@@DB@@@@@B@@B@BBF@B@BBB@@B@@D@D@@@DB@@@@BBBBB@D@FB@@B@@BBB@B@@BB@B@@B@FDB@B@@@B@BB@@B@B@BADA@ABABAB@B@B@@@B@@@@C@@BA@@@@@@A@@A@@@@@ABABA@ABAFAF@D@DAB@B@B@B@BAB@BBB@D@DCB@B@D@BAB@@AB@B@B@@AB@DAB@@AB@@ADABA@@B@@@@ABA@A@C@A@ADE@A@A@A@ABA@@CAAC@@A@@@E@C@A@CAA@AAAA@AA@@ABABC@@DA@A@AB@@@@CB@BC@C@@@A@@@ADABA@@@CBAB@@@BA@A@@@A@ABAB@@A@@BA@GB@@A@A@ABA@A@A@A@AAC@@CAEAEAE@EAA@CCCA@AECCAAAAAACA@@AB@@@@@@@B@B@@@DBBBB@BBBBHFDDB@BBB@D@@@BABA@A@@@C@ABAB@@A@@@AAA@@@A@AA@AA@@@A@@B@@A@@@A@@D@D@B@@AB@BAB@B@B@BA@@@AB@@CBC@@AC@@@@AAAA@@BA@@B@BABADCB@@ABAB@@A@@@@@A@AA@@A@@@@BA@A@@@@AC@ACA@AA@CC@AA@@@AAC@CCCC@@BADC@@@@HB@@BA@@@A@@@C@@@C@A@@BADABAB@BADC@@D@BBB@@AA@@AB@@A@@B@BAB@B@@DBBFDDB@@FBBBDD@@D@@BB@B@@@BB@@B@B@B@BBB@BBB@B@B@@@BBB@B@@B@@D@B@@@@@B@ACBA@@@@BA@@@A@@AA@A@@@ABA@AA@@A@A@A@@AA@A@A@AA@AA@A@@@A@@@@D@BABAB@B@@@B@DC@@D@@@B@@A@@BC@@BAAA@A@@@AB@BA@A@@@@DBBBB@BBB@BB@@B@BD@@B@FBB@@@B@BAB@DCB@@AF@@@BAA@@@@AAA@@@@@ABA@@@ABA@@DABAB@BAAA@CAAC@AA@@@C@AA@A@A@@A@A@@AAA@@@A@@@@@@A@A@AC@@@@@@E@@@A@@B@B@B@B@B@@AB@BA@@@@@A@@@A@AAA@A@@BA@A@@B@@@B@BB@@@@@BB@@@@@B@BABBB@@@@@BA@@BB@ABB@@B@@A@@@@B@@@B@@BBAB@BA@@AA@A@@C@AAAA@@@A@@AA@@A@A@@AA@@ACAC@@@A@C@@@@@AA@@CAA@@@@A@@@A@@BA@@B@@BB@@B@@B@@@@@BA@@@A@AAACAAA@@@A@A@@AAACA@@A@@@AB@B@FC@@B@PBD@BBD@@@B@BBBDB@@B@@@@DBB@BB@@@@AB@DBB@BFB@@@@BABAB@BA@@@@B@BB@@BB@B@@BDB@@@B@B@@@BBD@B@DBB@@@@B@@@B@BB@AD@@@@BBBB@@@B@B@B@@B@@BB@@B@@B@@@BA@@BC@@BA@@B@B@B@DBB@BBDBB@BB@@@@BA@AA@@@@A@A@ABA@@@AB@@@B@D@@B@@B@B@BAB@B@D@D@B@B@B@@AB@BCB@@C@A@C@@BABABA@@DCB@@ABA@ABAAAB@@A@CB@B@DCBABA@@@CBC@A@A@@AAAA@@BAB@B@HBD@@BB@D@F@BBB@BB@@B@B@DEBA@@B@B@B@@@BA@@AC@A@@B@B@B@@@DEBABA@AB@@@F@@@B@DC@ABADCBA@ABABC@@AA@@AAA@@C@@@AAA@@@@@ABA@AB@@E@ACA@C@@AA@A@@@AB@@A@@@A@AC@A@CAA@A@AA@@AAAA@@CBEDA@A@@AAC@@@A@A@@@ABA@@BA@@@ACAAA@@C@G@@AA@@CBC@@@A@A@@@A@@@AB@@A@AAA@A@@@A@@@@BABC@@@@BA@@@@@ABBBAB@@@@E@@B@@@@@@@@C@@BA@@@A@@B@@@@A@@C@A@AAEAAAAAA@CA@AC@A@A@CAAAAC@C@C@@AA@@C@ABC@AB@@C@@@@BA@AB@@@BA@CBA@A@A@A@AAM@A@@@@@@B@B@@@@ABA@@@@BA@@@A@ABA@A@A@@AA@C@CA@@AACCCCAACAEECCAAAA@@C@A@EACAAA@@EBAAA@@@@@A@A@@@A@E@AB@@ADABE@ABA@@B@B@D@B@BABABA@ABA@CBC@C@@@AA@@AAA@@@CE@A@GB@@AB@@ABEBA@@D@D@D@H@D@@AF@@A@@BA@CAC@A@ABABC@@BAB@B@@@@@BCDIDIA@@A@A@A@@A@@AA@A@@@CAAAA@@A@ABA@A@A@AB@@ABA@@@@BE@C@A@ABA@@B@@@BB@@DADA@@BABABADCBAB@BAB@@ABC@CB@@@BADADADADABA@@@C@@@ACA@@AA@@@@@A@@@AAAAC@ADA@AB@@AA@AAE@ACA@AA@@CC@@@AAA@@A@AAA@@@@@@@@ALI@@BABA@@BAB@B@@@B@@@BDBBB@@@BD@B@@@BBB@B@@@B@B@@@B@@@BB@@BB@B@@BB@@@B@B@BA@ABAB@@@@ABC@A@ABCDE@@@ABA@G@@HEDABC@AB@@@@@@AB@@A@ADCB@BAB@D@B@@AB@@BB@@@B@@@@A@@@@@@@A@@BAB@B@B@BBD@BBB@@@B@BCB@B@B@D@B@FCH@B@@A@@BC@ABC@A@A@@@A@@A@CA@AAAA@EACAAA@A@@@@BABA@A@@@@DC@@BA@AB@DA@@@@BA@E@A@@BGB@@AB@@@B@@@DD@B@@B@F@B@B@@@BAHCFC@AB@@@@@BBBBBB@@@B@DABABABA@@BABBB@BAFBBA@BB@B@@@B@@DAB@DA@@B@@@@@BA@@@A@@@A@@@@@@D@BBB@BBB@BBD@D@B@@@B@BB@@FBFDFBFDJBBBFB@BBBB@BBB@DB@@D@@@BAB@@A@C@@@@AA@A@AAABADE@ABA@@FA@@@@BA@ABAAABA@A@@@AAAAA@AA@@@AA@@AAA@@A@C@@@@A@AA@@@A@@@AAAAA@C@AAAAA@@A@@AB@@A@@BA@A@@BC@A@@AAA@@A@@B@D@D@D@@@@BB@@BDB@B@@B@BB@BDBBBB@BB@@B@@@@AB@@ABC@@A@@A@@BC@AB@@@D@@@FDB@BBB@@@D@@@B@@@B@@@@@@A@@BA@@@@B@D@DCBA@@B@@BB@D@D@DBD@BBB@DB@@@BBAB@F@B@B@B@B@BA@@F@HAB@B@F@BBB@@BDB@@DBBB@BBB@B@@D@B@@@@@D@DBB@B@DBB@DBDBB@B@B@D@B@D@DCB@@@B@@@D@B@D@D@@@BB@@B@B@B@D@B@B@B@@@@@B@AA@@@@AA@@A@@AA@@@@ABA@@@@BADA@A@C@AC@@@C@AAAA@@@ACCA@@AA@A@@@AA@@A@A@@@EA@A@@AAA@@A@E@A@AAA@AAE@@@C@@@AAAA@CBCAC@C@EACACAECAACCEI@ACAAAAACACAA@EC@@@A@@@AAA@A@@DEDABAD@F@FBD@@@BAB@D@F@BBBBB@BBDBDBDDB@D@JBDBD@B@B@BAB@FC@@D@B@D@@@BA@@@CAA@A@@BCD@DC@ABA@A@A@A@A@A@CAA@AB@@A@@@A@AAA@AB@BAB@BA@C@@@A@AA@@@@AF@DAB@@A@@@C@A@AAA@@DEBC@A@@@AD@BA@@@A@A@@B@@@F@BAB@B@BA@@@A@@BC@ADCB@DA@@DCBABABA@A@A@A@E@A@@BA@@B@BAB@D@DABABABABA@@BAB@DAB@@BBD@BBBBBDDBBB@BHBFDF@BBBBBB@BBF@BBD@B@DBB@B@@@BAB@@@B@@BB@@@DA@@B@B@@@BDBBB@@@@@B@B@@ABA@AB@F@H@H@B@@@BABAB@BA@@BCD@B@B@@@D@@@B@B@@@BB@@B@BB@@@@@@AB@B@@AB@@@F@B@@ABCB@BAB@@@D@@BBAB@@CBCFC@@@A@E@ABA@C@@@AB@@A@@BA@AD@D@B@@AB@D@@A@CBA@ABCF@@ABAB@B@BBB@@@B@F@B@@DBB@@BBB@B@@B@@BD@B@@@CBADA@AD@@@B@B@B@BBDB@@@D@BBB@F@B@BAB@@@@AB@AA@A@@@C@@@A@@B@H@@@BBFDBB@DB@B@@BH@B@B@@@B@B@B@@@B@B@@B@@@B@FBB@@AD@@@B@@BBB@D@BB@@BBB@B@@BB@@BB@@@B@BADA@@B@B@BBB@D@D@B@@@BBB@B@DAB@@AB@B@@@B@B@DBB@@@B@B@DA@AB@B@@ABA@@@@BA@@@ABA@@@@BAD@BABAB@H@D@B@@@@BAD@@@B@@A@@BCBA@@BA@@BB@BBBDB@BBBBB@BBBA@@B@B@F@B@B@D@B@B@B@B@B@@@B@BAB@BAF@D@DAB@B@B@@@B@@@B@B@BB@@B@D@@B@@B@@BDBD@@AB@@@B@D@BAB@@A@A@AB@BAB@DA@@B@@A@A@AB@@@D@B@@@BA@A@AB@F@B@DBD@D@B@BAB@BAD@B@B@B@@BFADBB@@@BBDB@@BBBBB@BB@BB@@@BBB@BDBD@@DBDBBBBBB@D@@@@BBBB@BBDBDBBB@@B@@B@@ABA@C@A@@B@@BB@BBBDDJDBBBBB@@@@B@B@AB@@@@@DDB@BBD@BB@@B@F@H@D@DBH@HBFB@@D@@@B@FDB@DBJ@DBB@B@BADCDCDCDA@@@AB@BAB@BA@@BA@CB@BC@AB@@@FBB@B@B@@ADAHG@@@@FEBCBC@AB@DA@@BADAB@@@BABABAB@@CB@@@DAB@@@BC@@@AB@@@D@@@B@@A@@B@DAB@B@BABAB@DBB@B@B@BA@@DAD@D@D@B@@@BA@@@@A@@@@AAA@AAA@C@A@@@AAA@A@A@@AAA@ABA@@@@@C@CAAACCAAAA@@@@@A@A@@@A@AAA@AAA@AACAAAA@A@A@CAAAA@A@@BADAB@DAB@B@D@DAB@@@@ABAB@@A@ABCBA@ADCBA@@@@@ABABCDCBAFCFADAB@FCBA@@B@@@@BBB@@B@BBB@D@B@B@DABAD@BA@@BA@ABABABADCDADA@@B@@@B@DA@@B@H@F@@@B@B@BDB@@B@B@@B@B@@@DAB@@ABADABAB@FBB@B@D@F@FCBAB@@@@@B@B@@@@ABABABADBB@B@D@@@FADABAB@B@B@BBB@B@FA@@BA@A@@A@@A@CBE@EBE@ABEB@@EDCBCB@@@@AD@B@BA@A@ABC@@BADCBCB@@@@@DABAB@BAHA@@B@BBB@B@B@B@BAB@B@B@B@DAB@B@B@DB@@DABBB@BAD@HBBAD@D@DAFAB@B@B@@@B@@@D@@@BB@@BBBB@@@BB@@BBBB@B@B@@@@BBB@@@@DAB@BAB@@@@ABCBC@@BADC@@F@@@@A@@@A@@BA@@B@BBB@@@@AB@DBB@@@B@B@@@BA@@@CBCCAA@AAEE@@BA@ABADABCBABADC@AB@@@B@BA@A@@B@B@B@FB@@DA@@@@B@BBBBBD@@@BAB@@@F@B@B@@@B@D@@@B@BBBB@B@D@D@@@BAB@B@@AB@@A@A@@D@BAB@@BB@@@BAB@@@@@BB@BD@B@@@D@B@@@BB@@B@@@D@B@@@B@BBBBBAB@B@B@@ADC@@BA@@B@B@BABA@A@AAA@AB@@AB@B@BAD@@@@@@A@@@@@@BC@@BA@A@@@A@@@@@A@@@AC@@A@AA@AA@@AA@@BAA@BA@@@@@@@A@@@AC@A@A@@@@AA@CACCAAAA@@ACAA@@AA@@A@@C@@@ABA@@BAB@AA@@@AAA@A@@@@AA@@B@@@@A@@BADAB@@@BC@@BAD@BAB@@AB@@@B@@@@@BAB@B@@@BA@@@@B@B@B@D@BB@BB@@A@@B@B@BABAB@@@B@D@BBDAB@@A@@B@BAB@B@@@B@@@B@BAB@DBB@B@BAD@@@BAB@@@B@D@B@@@B@@@B@@@@AB@BB@@BAD@H@D@@@@@B@@BBBFB@BB@@@BAB@@@B@@A@@B@H@B@@@@ABC@@BAF@@@B@BAB@B@DAB@@@@BB@@A@@BA@A@@@@BAB@DC@@B@@@BAB@DA@@@A@@@@B@BAB@@EBA@@DCB@AC@A@I@GAA@CA@@G@A@ABCBA@A@A@A@AB@BA@@@A@@@A@A@@AA@@A@@A@@@@C@A@AA@A@@@@BC@@A@@@A@AAAACAAAA@C@A@AA@@@A@A@@@@@C@C@AA@@AA@ABA@cNICKA@AB@@A@A@ACE@@AA@A@CAA@@@A@@@ABA@@A@@ACAA@@AAA@C@A@A@@CCCCAA@AAAIEAAAAACCAMI@A@ABAB@@@D@@@BA@@@@B@@B@@B@B@@AB@@A@AB@@A@@@A@@BABA@@BA@@B@BC@@@@BABA@@BC@@@ABABA@@D@@@AA@ABABA@@BA@AAAACA@@C@CAGBC@A@A@@AAA@CAAA@@@A@AACAA@AA@AAA@@@@A@A@@A@ABA@@AA@@AIBK@A@E@KCC@AACAA@C@IBAAA@@@A@@@A@@@@@@A@@C@@@AAA@AAA@A@AB@@@@@A@AA@@@AA@@@@A@@@AAA@A@@C@@A@@@AA@@A@@@@B@@@@AB@@A@@@A@A@@@@A@@A@@@@@A@@@AA@@A@@@AB@B@@@@@A@@@C@@A@@@@@A@@B@BA@CBABA@A@A@A@@@AB@B@@A@@A@@A@A@@@A@A@A@AB@@A@A@@@A@@@A@@@@BAB@@A@@@@B@@A@@@C@@@ABAB@@AD@@@@A@@@@@A@@A@@@@A@@@A@A@@@A@ABABA@@@@BABABA@AB@@@@ABABA@C@@@@BAB@@A@@@AA@@A@@@@@@BA@@@C@@@@BA@@B@B@BADB@@@@B@@CBABABADABABABE@IBA@IBK@Q@S@G@C@E@E@K@IAE@C@A@C@GAE@CBAAA@@@A@@@A@IACAIA@@OCMCE@SEE@@@@A@C@C@C@C@@AA@AAA@G@C@C@A@AAAAEAA@@@AA@@@A@@@@A@@@@A@A@@@A@@@@ABEBC@A@C@@DAHAB@BA@@@@B@BBBA@@D@B@B@@@@@BA@AB@@A@@B@BD@B@@@D@B@B@@DDBB@@@B@DB@@@BBB@@BB@B@BBDAB@BBF@B@DAD@@AB@@CBC@A@A@AAA@@@@@E@G@E@C@A@ABAB@AAB@@@A@@AAA@ABA@AAA@@AA@@@AB@BABA@@B@@AB@B@@@B@@@DC@@BABA@@@A@A@A@ABABADAHC@@BC@@BA@@B@@AB@BBB@B@@@@FBDBBBBB@BBB@@BBB@@BBBBBB@@B@@@BABA@@D@BBD@B@DBFBD@DBF@FBB@@@@@@BA@ABCD@B@B@@@BDBDBBBB@@@BADAB@D@H@B@@@@B@DBBB@D@B@HD@@BB@@@@@@@BCD@B@@BB@@B@B@@@B@@B@@@D@@@BD@DBB@BDB@@BB@B@DAFABA@@@A@G@A@ABABAB@DAJC@@@@@@BBB@D@@@B@BBBBBB@@B@B@B@@@B@B@BA@@@@BA@ABBB@BA@@@BBBB@BBB@B@B@@@@A@A@@B@@@BA@@@A@ABAB@B@BA@AFEDA@C@ABA@@B@@@@@@A@G@C@A@E@@@ABAB@@@B@B@@@B@BD@B@@B@@A@ABC@E@E@CBEAEBA@A@@B@@@DBBBBBD@BBB@B@BB@@B@@@@@B@BB@@@BB@BA@@BB@@@BB@B@@A@@BCBA@@BADE@@BAD@BAB@@AB@BAB@@@@A@@@A@A@@@A@@@@@@BA@@@@B@B@@@BC@@BA@A@@BBB@@@B@@@BC@A@C@@@ACA@CA@@AAAACA@@A@@BA@A@A@@AAAA@AAA@AA@@A@@A@@@AAAAA@ACA@@AC@@@A@A@@@@AAACEAA@@A@@@EB@@AB@DA@C@AB@@A@@@B@ABABA@@BA@@@A@A@@B@@A@A@A@@@A@@@@BA@@@@@A@CDABCB@@@@AB@@@B@@A@@@A@@@@B@@@B@@ED@@@@A@@A@A@AA@@A@@A@CB@B@@@BCB@BA@A@A@A@ABAB@@@BA@@@AB@AC@@AA@AA@@@@"],encodeOffsets:[[105953,37757]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("张掖",{type:"FeatureCollection",features:[{type:"Feature",id:"620702",properties:{name:"甘州区",cp:[100.415096,38.944662],childNum:1},geometry:{type:"Polygon",coordinates:["@@AACAAAECA@GCAA@@A@@C@@BAAC@A@ABCBA@@@A@ABA@C@GB@B@HADAD@BAB@@A@@@@@@A@@@@@@A@@@@A@@A@@@@@@@A@@A@@@@A@@@@@@A@@A@@@@@@@@AA@@@@@@AA@@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@@@@@@@@@@@A@@@@B@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@B@@B@@@@@@B@@@@B@@B@@@@@@B@@@@B@@@@@@@@@BB@@@@@@B@@B@AB@@@B@@@@AB@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@A@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@@@AA@@@@@@A@@@@@@@A@@@@@A@@@@@@A@@@@@@@@A@@@@@@@A@@@@@@@A@@@@A@@A@@@@@@@A@@@@@@@@@A@@@@@@A@@@@A@@@@@A@@@@@@@@@A@@@@@@@@@@@@AA@@@@@A@@AA@@@@@A@@@@@@@A@@A@@@@AA@A@EACA@@AA@AACAAACAAEGOIEACCGEA@GCAACAAAEECCAACC@A@@@EAACCGGECA@CAA@CAIAAAOIAAA@A@AACACAAACAGEICCCA@AAAACCCAEAGEA@A@AACAAAAAA@CAA@AA@@@A@@@B@@@@@B@@@@@@@@BB@@@BA@@@@@@@@B@@@@@@A@@@@@@@BB@@@@@@B@@B@@@@@@A@@BA@@@@B@@A@@@@@@@A@@@@B@@@@@@@@@@@B@@@@@@B@@B@@@BB@@@@B@@@@A@A@@@@@@@A@@@A@A@@@A@@@@@@@AB@@@@A@@@@@A@@@A@@@@@@B@@@@@@@@@@@@AB@@@@A@@@@@@@A@@@@@@@@BA@@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@A@@@@B@@@@A@@@@B@@@@@@@@@@A@@@A@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@A@@@@B@@A@@@@@@@@B@@@B@@@B@@@@A@@BA@@BA@@BA@@@@@@@@@@@@B@@@@B@@@@B@@@B@B@@@@@B@@B@@B@@@@@@@@@B@@B@@@@@@BB@@@BB@@B@@B@@@B@@@@@@@@@B@@@@@@A@@@@@@@@BA@@@@@@@@@@@@@@@A@@@@@A@@@@@@BA@@@@B@@@@A@@@@@@@A@AAA@@@@@@@A@A@@@@@A@@@@@@@A@@@A@@@A@@@AB@@A@@@@@@@@@@@A@@@A@@@@@@@@@@B@@A@@@@@@@@@@@A@A@@@@@@@AB@@@@@@@@@@@@@@A@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@AB@@A@@@@@A@@@@@@@A@@B@@@@A@@@@@@@@@A@@@@@A@@@@@@BA@A@@B@@@@@@@@A@A@A@EBCB@@@BC@A@@BB@@@@D@@@BA@A@A@ABA@@@@@@B@@@@B@B@@B@@@@@@@@C@AB@@@B@@B@B@@@@B@B@@@@BBB@D@@@BB@@@@AB@@C@A@A@@@A@EA@@@@@@@BA@@B@@@B@BDF@@@BC@CBA@A@@@@@@B@@A@ABA@E@@@A@@@@B@B@@@BBBA@A@CDA@@@@@@BA@@BBB@B@D@@@@BBDB@@@@@BA@A@@@A@@@A@@@@@AB@@@@@B@B@B@@A@@@@@BB@B@@BDBBBBBDBB@BBB@@@B@@@@C@AAA@CBA@A@CDA@@@A@@D@@@B@F@B@@@BABA@@BA@A@A@@A@@IBGDA@C@A@ABA@@BC@ABA@@@@@CAAAA@A@CC@@@@ABAB@@ABC@C@@@EA@@A@AB@@A@@@AA@@A@@B@@AB@B@BCF@@@B@D@@BBBB@B@@@F@F@F@BCF@BAB@@CBA@CBK@E@E@A@CB@@C@E@EBA@ABE@E@ABAAE@A@A@C@E@@@CDGF@@E@A@A@@@@@ABAB@BEBABA@@B@@@@DD@B@B@B@D@BCD@DAB@BB@@DB@@B@B@B@BBD@@DBBB@@@BAB@B@@@BBD@B@B@@@BBFB@DDBBBB@@@B@BCB@B@@ADAF@D@@AD@BEFABABAD@@@D@B@B@@BBA@E@CBE@GB@BC@@BAHAH@B@BAFAD@BAB@BABA@CBA@ADAB@@B@D@BBB@BB@@BBBBB@B@@BDD@@@B@@EHABAB@BA@AB@B@@@D@B@BA@AB@@@@AB@BAB@@@@@B@@@D@@CBA@ABABA@@BAFCDABAB@DAB@D@@@BABAB@@ABA@ABCDABCBA@A@A@CDA@@@A@@B@BA@@@A@@BAB@@@B@@@@@@@@@B@@@@BDBBBDDDB@@DBB@BBF@H@@DP@B@B@BFF@@BF@D@DBB@@BB@D@F@@@BBB@@BB@D@DBBBDDDBBB@@B@BADABABABCBCBA@C@GDA@A@A@MBA@C@A@CDADA@@DED@B@@BD@B@B@B@BAB@BBF@BBBAD@B@B@DABAD@@AFAF@BADABABABADABA@CB@B@@ABB@@@B@@B@@B@@@BBB@@@@B@@BB@@B@DB@@BB@@@B@B@@N@BBTA\\Bh@V@N@X@F@J@\\@D@BBB@F@B@D@D@DBD@DBD@@@EH@@B@@@@B@D@DBD@@@B@B@BBBBD@BBB@BAB@B@@@B@BAB@B@BA@AB@BAB@@@B@@@@CBCBA@@BBDBBBDBB@@@B@@B@@BAA@BA@@@@B@@@@@@AB@@@B@@@B@@@BCD@@@BAB@DAB@B@@@@AB@BABAF@@@BAB@FAF@@AB@BA@A@A@A@@@AAACA@@AA@@@AB@@@DBD@B@BAB@BABABCDCF@BA@@BAB@B@@EF@B@@@BBBDD@@B@@B@B@@@D@D@@AB@@@BBB@FB@@B@@C@@BA@CB@@ABABA@ABA@A@EBA@A@@B@DB@@B@BBBBDDF@BBBAFB@BB@@@B@D@@@BB@@BBB@@@@BB@FB@ABAD@D@B@B@@@B@BB@BB@@DBFBBBDBD@BB@@B@@BBBBBBFBB@BBBB@B@FB@BB@BB@BDBDDDDDB@B@@@BBB@@ABA@@@@B@@BB@@@D@@@@ABAB@BA@@@BB@B@@AB@B@@@B@@@B@B@@BB@@@BA@@BB@@B@@@B@@ABABA@AH@@BB@@@B@@CDAB@B@@@B@BB@BBB@@BB@@B@B@JBB@BDAH@dAJ@@@B@VAL@BA@@BCBADEDCBCBC@@AA@A@@@ABCBCBC@AB@BABCDE@AB@@@HAJ@LAFAB@BAHA@@DCFGDCDADCBADABAFCDAB@JAJ@H@H@B@@@BABAB@BAB@B@D@D@D@B@DADAD@@A@@B@@@B@D@FAF@BA@@BC@@@ABAB@@@BAB@DAB@HBB@B@BAB@@A@@BA@ABA@@@AB@BA@AB@B@BABA@AB@@AB@AA@@AABA@CBABC@@B@B@D@@@B@B@F@DBDDHFFDFDBBB@BB@DHB@@@@BDBBBBBBDF@@B@B@B@B@@@DBAD@@B@@B@@ABAB@BB@@BB@@BDB@B@B@B@BAB@BAD@@AB@@@B@B@@AB@BABAB@@@@BD@@@B@D@BAB@@ADCB@@@@DBB@BB@BD@D@BBD@B@DB@@BBB@BB@B@B@@@BDB@@@@@BABA@@B@@@BA@@B@@@@@BBB@@B@BBBBDBBBBB@@B@@@BB@@AD@@DD@@@@CD@BB@@BBB@BCBA@BBDBB@FD@@DD@@@BA@@BBB@BFD@@BBFBB@B@B@@@CB@@@@@BD@@@@BAD@BBBDBB@@@@B@BB@@@@BB@@B@@BB@DDHBDFLDJBB@B@BBB@D@D@B@B@@@B@BBBBFBDAD@B@B@B@B@@@@@DBD@@@DABBF@BBB@B@D@BFP@@BDBJ@B@D@P@LADB@AD@FAD@D@RCp@PAT@FAD@BBB@@BB@@DA@@@@B@J@H@J@H@FAF@F@H@H@FAF@HAFAD@F@F@F@D@F@JAJALAJCH@JAFAJAJ@JAF@F@D@DBH@HBL@HBH@H@FBJ@HBJBJBLDLBFBHDFBJBJBF@FBDBF@F@H@F@FAĴD@ǋ@E@E@E@ABEBEDCBABC@CAEAGAE@CBCDGDGDEBC@G@E@E@CBGDE@ABCDEDEBCDEBEBC@CACAC@C@CBCDC@CACACGGMKOMMKGEGGCC@A@A@CBM@MA]@OFMDCBADADA@APGAA@A@AAABA@C@@BA@A@@CE@A@C@A@@BEBAB@@ABA@ABE@A@@@AA@@AA@A@@ABA@A@A@@A@C@@@AC@@ABC@CBA@A@@@@@CCAA@@AA@AACA@@CA@@C@ACC@AA@@AE@AA@@@A@AA@C@AAC@A@CAAA@A@A@@@A@AA@@@CAA@A@ACEE@@@AA@CC@AA@A@@AAA@CA@@@AA@@ACAA@ACCAAAACA@AMICAIEAA@AA@@A@A@AAACCAACCA@CCCCAACAAA@@AA@@CCCECAAACAAACACC@@@@A@@AEA@AA@MIA@CCA@CC@AAAACAACB@@@A@@C@AAD@@CACAC@@JCDABAPEFAEIACAAAECE@@@AAC@@AAAA@@@AACACAA@ACAACCEAAAAACCCCCAACGCCCCEGAAECEEA@GCCA@A@CBEBGBEA@CAOIEASKMEOIIEYMCA@@ACA@@ACCEGGECCEE@@@@CC@AA@ACA@CAAAAC@@AEAAA@A@@@ABAB@@@B@@CD@@AAA@CA@@I@K@C@I@EAI@CB@@E@I@AAG@G@Q@OAE@G@C@A@AA@@@AA@BGAC@C@AAI@GACAE@C@@EEACACAAEC"],encodeOffsets:[[102927,39627]]}},{type:"Feature",id:"620723",properties:{name:"临泽县",cp:[100.164283,39.152462],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@E@A@AAC@[@I@E@W@M@U@g@[ASBAAM@@@@A@A@@AA@@CAA@@@AA@@@A@@A@AA@@A@@@@AA@@@A@BA@@@AAA@C@C@A@A@AA@ACAAACACAAAAAAA@C@EAC@EAA@A@A@CAC@@@A@A@@@G@EBA@C@@BCBABA@@BCAA@@@AAC@CAA@AAA@AAA@AAA@AA@ACACBCB@@@@CBAB@@@@A@A@@B@@@D@@@@CBC@A@@B@B@@E@CBC@AACA@@AA@AC@@A@AA@CCA@A@AACBA@ABCBA@GB@@@B@@A@A@ABAB@@A@@B@@@@DB@BB@@@BB@BB@@@@BB@@BB@@BA@@@@@@B@BBBFD@@BDBBBBDBBBBBB@ABGBCB@BAB@@AB@B@B@@@@BB@@DB@B@@@@A@@B@@@@@@BBB@D@BBB@B@@@B@B@@BB@ADCB@B@B@@@@DB@@@BAHBB@@@@D@D@@@DBB@B@B@B@BAB@B@@@@@BBDB@@BBBBB@B@DB@@BB@B@B@@@BA@AB@BABABABA@@@CBA@@@@BBBB@@@DDB@B@DBBBB@BBBBB@D@B@@B@@@BAB@@BB@BB@@@@B@@AB@@ABA@@BA@ABCB@@@BAB@B@B@@A@@@A@CB@@@B@D@B@BA@@BA@@B@B@D@@@BA@@@@BA@CBA@@@C@A@@@CB@@A@@AA@C@A@EAEAAAA@@@@@AA@@A@A@AB@@A@@@@BA@@BAB@@@BA@A@AB@@@BBB@@@BBBAB@@@@ABABA@A@@@A@AB@@@B@@@B@@D@DDB@@BBD@@@B@B@B@@@B@BEDCDA@A@@BA@@@ABAAA@@BAA@B@@A@@B@B@@AAC@@@CBA@C@@@A@@B@DAB@@@@ABA@CBEDC@@B@@AB@@@B@@ABABABCB@@AB@@@@CB@@A@@@@B@B@@@@CB@@C@@@C@A@@@A@@AC@C@@@AB@@@B@@BB@@@@@B@@ABC@@@@BAB@@A@AB@@A@@B@@A@@@A@A@@@@@A@@@A@A@GBCDAD@@@B@BA@CHEDAB@B@B@BA@@B@@CBABABA@@BADA@CBA@@BAB@@@@A@A@A@@@@@A@ABA@ABABA@A@@B@@@@@BB@@@@B@@@BA@@BAB@@A@CBAA@@A@C@A@A@@BEB@@@B@@B@@BBBB@B@@B@@B@AB@BABA@AB@@A@@@A@ABEBA@@@@@A@@AA@AAAA@@@@@@A@A@AB@@A@A@@@AB@BA@A@@@CC@@ABCBA@@B@B@@@@ABA@@@BBPRLLFDJLFFHHJJRRBDDBDFBFDDDF@@@D@B@@@B@B@BB@B@BA@@@@DCBA@@BAB@BABABDDDPRTTFHBB@@@BCB@@@@@BEBCFABCBAD@@A@@BA@@@ABCB@@A@@H@F@BARAH@H@@B@FBH@VBB@LHBBB@D@HBJD@@D@FBB@BB@@A@@@ABE@A@C@CDEFMJIFKHAB@@ADELCH@BEP@D@BE@EBEBEB@@@D@B@@@B@@B@BAB@BAB@F@B@B@FDFBHBHBDBLBN@B@H@@B@HC@M@CBIBKB@B@B@@@@EB@@FJBDBB@BA@@B@F@H@H@B@NBN@L@HDbBVBB@DBL@H@D@BBH@FBF@DBH@D@DDXBR@JBB@BBF@D@HBF@XBL@LBL@D@F@BADAFABCFAD@BABAJ@BAD@D@BBDBDA@BB@@BBJJHF@BDDJHBDHJBBBB@DBDBBDB@@DDBBFFDDFDDD@@DBFDDDBBDDB@BBBB@B@B@B@DAB@BA@BB@B@@BDDBDBDBBBDBD@B@D@B@BBB@DBB@B@@@D@@@B@@@BA@@@@@@@BB@@@D@@@@BB@B@BBBB@@B@BBD@D@@BF@@@B@DBB@D@FB@@@@BB@@@@B@@@B@@BB@DB@@D@DBBBD@BBDDBBDBDBBBDBD@BB@BJDB@BBDBB@L@@@B@DBFBB@BDBBFBJFDB@B@DBF@J@D@FBF@JBD@@@B@ABA@@@@DAPEâWKxBCYp»DEĮoXAELAAãAA@@AA@ABC@EBS@ODo@Q@CBC@EBCA@BC@K@O@C@AAIAC@@EO@A@C@AAA@AAEBA@C@@AC@C@@@@@A@A@A@ABCACAEAA@A@A@@@A@A@C@CAA@A@AAACIEKACCG@CAA@@@AA@@A@@A@@A@A@@A@CAAA@ABC@A@@C@@A@@@@DA@@A@A@A@EAAA@@EC@AAA@AB@@A@@CC@@ECA@CAAAB@DA@AAA@AA@@ADC@@@@CC@@BC@@AA@@A@@@AAAACAAAAAA@@@AA@A@@@@@AB@@A@@@AB@BA@A@@@@CA@A@@@A@AAAA@AA@@CAA@C@AAC@C@@AAAA@CA@@@@DABC@@BA@A@C@A@@AC@@@@BABA@ABA@@@A@A@@BA@@BC@ABA@A@A@A@ACA@AA@@AA@@ABABA@@@AA@@@BCCA@@A@A@A@A@@@CEAAAAAAAC@@@@GA@CAAA@AAECECGECCCAE@A@A@@@C@A@A@@@ADAB@DABBB@@BBA@@BA@@BABABA@A@@BABA@@B@@AB@BAB@@@BA@ABA@A@GAA@CBA@AB@@A@AB@B@@AD@@ABE@EBC@A@@@A@@@@BC@CBCBA@C@C@C@A@A@ABA@ABAB@@A@G@G@I@IBA@CBEDABCBABCDCBCDEHCD@@GBABA@EBKBI@GB@@A@@BCFADABA@@BADADAD@B@@@BBB@@ADADCDCFABAD@@ABK@UBA@@@I@cBG@CB@AAA@I@A@AA@@AA@AAA@@A@A@@@ABADC@@@A@@AA@@BGB@BABA@@@A@@@AA@@AB@@A@@AA@@@A@A@@@A@@@ABA@@@AAA@@B@@ABABA@@@@@C@@AA@@@A@@B@BA@@AA@A@@@ACACCCCCA@AAAA@@AEAA@A@AA@AAAAEAAAA@AA@@@AAC@CAAAEACA@@AAA@@A@A@@@A@A@CBCBAA@@EAA@@@@AA@AA@@A@@@C@A@@AAA@BEAA@ACEACAA@A@AA@@C@AB@B@FAB@B@BAB@BABA@@DAB@@AD@@@@AA@@EAA@A@@BA@@@C@C@@@A@AA@@@CCAA@A@@@AFE@@@ABA@AB@@ADEDCBABA@ABA@A@AAC@C@@BA@@B@@BB@BDBB@@B@B@B@B@@ABA@@BE@EBA@A@@BEBA@ABA@@@@@ABA@CBA@A@@DC@A@@@A@@@A@@BA@@@@@@@A@@B@@ABB@AA@@@@A@@AAACAAAC@AB@DADA@@@@@A@@BA@ABAB@@A@ABA@A@A@@@ABA@AAA@AACAA@A@A@A@@AC@C@C@A@@A@@@FG@@C@CAC@CAC@C@"],encodeOffsets:[[102623,39930]]}},{type:"Feature",id:"620722",properties:{name:"民乐县",cp:[100.812629,38.430347],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAA@CA@@ACAEACAACACAA@EACAAC@A@@AAEAA@CAA@ECA@CAAACCCCAECAE@KBC@E@AAABA@@@ABAB@@ABA@A@EB@BAACDADCBAB@@C@A@A@ADA@@@CB@B@@ADAB@@@DABABA@@BCBAB@BCDCLABCDA@ABC@A@@@A@@BABGFCBEBCB@B@@ABAF@BBD@D@@ABE@@@EB@@@DAD@@@F@@ADEHEHEDGDA@GF@BEDEFCDEFABC@A@AAEA@@A@ABGFEFEBEB@@GFGDA@@@GD@@A@A@A@BBBBBBBBDBB@BBD@BBB@BB@@BB@@@BB@@@DDB@@@BAB@B@@@HFB@FB@@BD@BB@B@BB@@BBBBB@@@@@@@BAFC@ADABAB@@@BC@@BC@ADABADC@@B@DBB@BB@@B@@AB@L@BBB@@@BBB@@@BB@B@@B@@@BB@BB@@BB@D@BBDB@@B@@B@@@B@B@@@B@B@@BB@BBB@BD@@B@@@@BBD@@@B@B@BBB@D@FD@@B@B@BBB@BBBBB@@@DBB@@@@@B@@@@@@B@BB@@@@@D@BBBBB@@BDD@@@B@@@BAB@@@B@@B@@BB@@@@B@@B@BB@@@@@B@FBH@D@BBB@@@B@@@B@@@B@FBH@D@D@B@B@B@@@B@BAD@B@@@BAB@@@@@B@BADAD@B@BA@ADCDAB@B@@B@@BB@@D@BBB@@@BAHAD@B@B@@AB@B@@@D@@ADABAB@@AAA@A@@@AAAA@AA@A@AAC@@@@A@@@@A@A@@A@@CCAAA@@@A@@@AAAA@AA@@@@@@B@@AB@B@@A@@A@@@@A@@@A@@@A@AAC@@E@@AA@@@ACB@@@@ABABAFABAB@@AB@BA@@BCH@B@BAB@@A@C@CAA@@@@A@A@CAA@@A@E@A@AA@@A@@@@AA@@@A@A@CBA@@@@EA@@@@AA@AACEEDA@CBABEDAB@@BBFF@B@@@@ABCBABAB@B@@@D@B@@A@@@A@CB@@AAC@@A@@A@A@ABA@@@ABABAB@B@B@@@@D@B@BBBBB@BBDD@@@B@B@B@B@@ABADA@@@B@BBB@@BA@ABADEFA@ADABABA@@@@@@AAAAC@A@@@EAA@@@@A@KLCD@@@@A@A@C@AAA@A@C@@AA@@ACE@@@@A@AACC@AC@@@@@@AAA@AAAAAA@AC@AA@AAA@@A@@@ACCAA@@AA@A@@@@@@FC@@@@AA@@AA@@CA@@AAACCAA@@A@@AAA@@@@@@@BA@@@A@@@A@@AA@@@ABA@@@@@AAAAA@@@ACC@@@AAC@A@ABA@A@@AGAC@AAA@@A@AC@AACCC@@@AAAAA@@BA@ABABA@C@@AAAA@A@A@A@@BADA@@@A@AA@ACA@CAE@CACA@ACC@CAAAA@@GAA@ABABC@@@A@AA@@@A@A@A@A@C@@AA@C@@@@@C@A@AACAAAAA@@AAA@AA@@A@C@C@CCC@@ACAAAAAAACACACABCAC@G@EEQKAAGC@@@@@@AC@A@GCCCEA@A@GBKDIDEFABC@C@IAGEO@CBABA@C@CAAAAAA@@BA@ADABAB@BADA@CBCDABADCDCBA@GDCDADADGBG@C@A@@B@BBDDFDFBDBD@B@@DB@B@B@D@@AB@@@B@@@B@@DB@B@@ABAF@B@@@B@B@@FDBB@@@@B@B@@@B@@@B@@@D@@@BBBBDBD@B@B@BBB@BB@@B@BBB@B@BBB@DBB@BB@BBFBB@DA@AD@B@BBB@BBB@BBBBDB@@BBBBD@BDBBB@BBB@BBBBB@@FDDBB@B@B@@BBB@@BB@@@B@@@@D@@@FBB@B@@BB@B@@@HAH@D@@BBDBDBB@BBDBB@BBB@@AD@B@BB@B@@@BBBBDDBB@@@B@BB@@BB@BBBB@@BBBDBB@@B@@@@BB@@BD@BBBBDBBB@BAB@@AB@@BD@@BB@B@@@B@@@B@B@@@B@B@@A@@BAB@@@BB@@@DFBDB@@B@B@@@BA@AB@B@B@D@@ADAB@DA@@@BB@@@BABA@@@@B@B@@EFA@@@CB@@ABA@@B@@@B@@@@CBCBCDA@@BC@A@CBC@C@A@A@AB@@@B@B@@@@BB@@@B@@@BA@C@@B@@@B@@A@CAAAA@A@@@@@BABA@@@A@@@AAAA@A@@@A@AD@BABABAB@@@B@@@@A@@B@@@B@B@@@BDF@@A@@BAB@BA@ABE@A@ABCBA@@B@@A@@B@@CBCD@@ABAD@BCBABABA@E@C@@@A@A@@@A@AD@BA@@@ICA@AA@ACC@AA@@@C@A@CACACA@@A@CB@@ABA@@AA@A@ABA@CCA@A@@AA@CA@@A@C@A@A@AB@@ABA@@D@@A@@@C@CBA@A@A@@@CFAB@@@F@B@@AB@@@@@@@B@@@B@@@@@BA@@@@@@B@@AB@@@@@@@B@@@B@@@BB@@B@B@@@BAB@B@@@@A@@B@@@@CBABAB@@@@@@@@ABA@@@@BA@@@A@A@A@ABA@@@@@A@@@@A@A@@@A@@A@@A@@AA@@@@@@@@@@@@@@@@A@@@A@@ACBA@@@CB@@@D@FAB@DA@@@@@@@@AA@A@@@A@@A@@AA@@AA@@@@CDCF@@A@A@A@@@ABCB@@@@A@AAA@AA@@@B@@@B@@FH@B@@@BAB@@@DAD@B@B@B@BAB@B@@@BFDB@BB@@BBBDB@DB@B@@@B@@@BA@@@A@C@E@@AAACEAECC@AA@A@@@A@C@AB@@AB@@CAAAAACCEC@@@AA@A@A@A@@@A@C@@@A@C@CACAA@CBA@A@A@@@A@@@EDEDA@ABCBCB@BABADA@@BAB@F@H@@@BBD@D@DBF@B@B@BA@@BA@AB@B@@BBB@DBB@BBBBDBBBB@B@HFFBDBDDBBBBB@DDJDHFDBBBDBDBBBB@B@BBPJBBJBDBB@DBB@FDHHDDBB@F@@@BDDBBDDFFBBDBBBHDB@HFDDFBPJFHBBBDBBBD@BBB@@DBFBB@B@@B@@B@@@@B@@@@@@@B@@@@BB@@@B@@@@BB@@@@@@@@@@@@@B@@@@@@@@@B@@@@@B@@B@@@@@@@@B@@@@@@@@@B@@@@@@@BB@@@@@@B@@@@@@@B@@@@@@@B@@@@@@B@@@@@@@@B@@@@@B@@@@@@@B@@@@B@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@B@@@@B@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@B@@A@@@A@@B@AA@@@@@@@AA@@@@@@@@A@@@@@@A@@@@A@@@@A@@A@@@@@@@@AA@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@A@@@@@@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@@B@@@@@@@@@@@@BB@@@@@@BB@@@@@@@@@BB@@@@@@@@B@@B@@@@B@@@@@@@BB@@@@@@B@@@@B@@@@@@@@BA@ABC@CBGBA@A@@H@DAB@B@B@@ABAD@B@BBDAB@@@DB@@@BBHDB@FDBBDBBBFDBBBDBDFF@@@DBFBD@HBJ@B@DBDAHB@@B@@BBB@D@H@F@PBR@H@H@BBJ@F@@@DAJ@FBJ@D@L@J@@@DBB@BB@@DC@@@A@@BABA@@B@B@BBBF@@BDBBDBB@BDB@@BDD@@@@FFDDHFFHDD@BB@BD@@DBZNJFPJNFTLFBPJDBB@AFAHAF@D@B\\I`KFAzaDEDCHGFCB@DLAnITAHAPC^E@@BA@@@@DAF@BA@@B@@@B@BBBB@@B@DAD@@AB@BABBB@DB@AFAB@BBBBDBB@D@B@@@BAB@FB@AD@TBB@H@DBNFB@@@B@@@BC@A@@DA@AFEDCBCHK@A@ABC@@@A@C@A@@@AB@@ADAD@BA@@@ABABABC@@@ABA@@@ADABABADE@@@AB@@AD@@ABA@A@AB@@AB@@A@@@ABAB@B@BAD@@@@A@@@AKIAAGA@@AACEAA@@AAA@@AB@@CACBABC@A@@@A@ACAAA@C@@BABA@A@A@A@A@ADA@@@C@@B@@A@A@ADABA@A@ABAB@@@AA@ABC@@@@BA@@B@@@A@BA@@@A@@AA@@@A@@BABC@@@AAAAAAAAA@E@E@A@C@@@A@@@@DAFA@@@@@A@@@C@@@A@@@A@@AA@@AA@@@A@CAAAC@@@A@@BAB@@@B@@A@A@C@A@CBCBI@A@E@AB@DCBAB@@CACBA@A@@AAAE@@@ABA@ABCBAA@@A@AA@@A@@@ADA@A@@@A@@A@@@AA@AB@@@@@A@@AC@@A@@@C@CB@@@@@D@@@@A@AB@B@@@B@@@@A@A@CBC@@BA@@@@B@BAB@@@@@@@DA@A@@@@@@BAB@BA@@@A@@@@BAB@@@DC@A@@B@@A@@@A@@@@BA@@@@@@B@@@BA@@@A@A@@@AB@B@@A@AA@@@BAB@@@@@BA@A@@BA@@@A@@AA@@BA@@@@@AB@@AB@@A@@@@@A@A@@BA@@A@@@B@BA@@@@BA@@@A@@AA@@@@@ABAA@@A@@@@@A@@@@BC@@@AAA@@@@@@@A@A@@AA@@@@@AA@@@@A@@AA@@@AA@@A@A@@A@BA@A@A@@@@@@@A@@BCB@@CBA@E@@B@@ABABADABA@AA@@AAAAAAA@@@@A@A@AAABAAA@A@@AF@BAB@@ABA@@@AAA@AB@DEBA@@DE@@@AA@@@DA@@@@C@@ABA@@@AA@A@@@B@DA@AAA@@@@BA@A@@@ADAB@@A@@@@@AAAAA@@A@BA@@B@BABC@@@AC@@@A@@AB@@A@@@AB@B@@ADC@A@@@@A@ABA@@@A@@@BC@A@A@@A@@@C@@A@@@@@AAAB@FC@@BABA@@BA@@AA@@A@ABC@@@A@@@A@ACBCBC@A@A@AAA@@@@@A@@@@@@@AA@@@@A@@AA@A@@@A@@A@@ABCBA@@@A@@@A@@@AB@@ABA@C@@@@A@@BA@BBABCHED@@@@@A@AA@AB@B@@A@A@@AA@@@@BA@@@A@@AA@@@@@@D@@CD@F@@@@@B@@@B@B@@@B@B@@AB@@@B@@A@@BA@AB@@@B@@A@@A@@@A@@B@A@@@@@@A@@@@@@@A@@@@@A@@AABA@@@@@A@@AC@A@@@@@A@@A@@AB@@@A@AA@@B@@A@@@A@@B@@AA@@@@A@@@@@@@@@@AAB@AA@@@A@@@@@@@A@@@@@A@@@@@@@A@@A@@@@A@@@AA@@A@@@A@@@@A@@@A@AAA@A@ABA@@@AA@@@@@AAA@AAAA@AA@@@@AA@A@CBABC@@AAAA@@AAAB@@A@@@@@A@@@A@@C@@BADC@A@@@@BABA@A@@BA@@@@@A@A@@B@DADAB@BA@@@AB@@A@@DADC@ABA@ABADEBCB@AABA@@BAB@D@FAB@B@BABA@@BADCBA@A@@BC@AAE@CAA@@AAGEAA@@A@BA@@@@CCCA@@B@@A@A@AA@@@AAAB@@AAAA@@@ACG@A@A@A@@B@@AB@@A@A@AA@@A@@BA@AB@@@B@B@@BBB@B@@@@@B@@@BA@@B@@D@B@BB@@@@BAD@@@@C@A@@AAA@AB@@AC@@@ABAB@DA@BBBBB@BBB@@@BAD@B@BBB@@@@B@B@@A@@@A@@DCBABCB@B@@BDC@@AADA@ADE@CDCBCAEC@CAKA@@[@[AG@ECE]GiACGkCKCKMGaEAAAAA@@C@AC@@AA@A@BAAC@CACCK@GAI@O@EABCBCBCBG@@@GB@@C@@@@@AA@@@@"],encodeOffsets:[[103323,39096]]}},{type:"Feature",id:"620724",properties:{name:"高台县",cp:[99.819519,39.378311],childNum:1},geometry:{type:"Polygon",coordinates:["@@SSOQCCACABABA@AB@@ABCD@@@@ABA@A@@A@A@A@@@A@C@@CECCAECECAACQQIIGGEEIKECKKOQAAA@ABCDABC@ABE@C@E@A@A@A@@@C@@BABABAD@@ABA@@BAB@@ABABCDCBA@CBA@C@A@C@A@@@CDA@A@ABA@@@A@@@AA@A@@A@@BA@@BABCBABA@A@ABAAA@A@@@C@@@AB@@A@A@@@@@AA@@A@ABA@A@ECCAA@ABA@@BAAA@@@@@A@@B@DCFADAB@@@DABABABA@AB@@@BA@ABAB@@A@@BA@CBAB@BA@@BA@C@ABA@CBA@A@A@ABABEHABABA@A@@@@DA@@@CBA@@B@@@@C@A@A@C@@@@BA@@D@@ABC@ABA@A@@BC@A@CBA@C@@@@DADABA@@@A@@@AAA@@@A@@@@@@@@B@B@@@BA@C@A@C@A@A@E@A@@@@@AAA@A@G@@@AA@@A@A@@@A@@@A@@@A@@AAAACA@@@gFeDGHJOFeFYCYEAYAWAmAIAuAK@KMLGFABCG[sW]IG][ACAE@CAE@@AEGFaZE@oBSBIY@CRYFGQH{Ue@E@MEwBAAGA@A@@ABA@A@@B@@@B@B@B@H@D@B@@AB@BA@CBA@C@A@@BCD@@CBA@@@A@EFC@CBABA@ABA@AB@BA@C@AB@@A@CBEBEBABA@ABCDEFEDCBCBCDA@@@@BABSxABMHKFSJmDM@A@gGMD@@@@D@@B@@@BAD@@ABA@@BCBA@@B@@ABA@AD@@GDAB@@BBBD@@BB@@@@BBD@DD@@@@@BBBBB@@BBB@BDB@@B@D@D@@B@@@BB@BB@BB@@BBB@BBB@@B@@@BDDB@@@@@BB@B@@@B@BB@@@BDB@@@B@BB@@@@BB@B@@BD@@BB@BBB@@@@@@DD@D@@BB@@BB@@BB@B@@AB@B@@@@@@BBABBB@@@B@@@@A@@B@@@BB@@@@B@@A@@B@@@@B@@BB@@BA@@BABAB@BAB@@@BBD@BA@BBA@@B@B@@@B@@@@@B@@@BBB@@@B@B@@@B@B@DAB@@ABA@CB@@A@@B@BABADAF@D@D@B@B@B@@A@@@ABA@CDADADAD@B@@BBBDBD@B@@A@@@B@FB\\BpBjDtDL@@@@@FTBLLjBHDJ@BDLBLBF@@WfOZCF@BCDGNCFGNQZIRADABCFGPOV@BGLABB@ZOÖ¡yze~SHFÀDBhD²JAV@r@lOAHE\\@BERWKdYABe~ENHG@{EUFTQDAAOmUGqMWJTXEBXYJSRY@UBA@yHIBE@QBkFeTpABABEDSLaPSLC@AB@HBF@BBD@D@@FJBDFJFJBDBBBDBB@BDFHLDFBD@BBB@@@@LHZNJFHDFDNHLH@@@@BAB@BAB@D@D@B@@@B@B@BAD@NB@@BAFADA@@D@H@F@D@D@F@@@B@@A@CBA@ADADCFADABABA@CB@@@B@@BDBBB@@D@D@D@HBD@FBD@BA@@DA@ADA@AB@F@@@BA@@BCDCFIDEHIHIDGFIBABCHIBCB@@@@@NBPDDBB@xNLAFA@@@@FJDJBB@DHRBBBFB@@@BBDBDD@@DD@B@@@D@@@@BB@@@@DC@@B@@@@@B@@BBB@B@BDB@@@B@@@BB@@@@B@@@@@@B@B@@@B@D@@@@B@@@B@@AB@@BB@@@@@B@@@@@BB@B@DAF@DAB@@ADCDEB@@AB@@@BA@@A@@A@A@@DCBE@@@C@@HB@@D@PDJDH@VDfDDBL@DBPBNBB@RHXJFDDBTHPHDBLF^VB@B@L@F@BBD@DBD@F@HAB@F@B@BBB@FBB@B@FAB@B@@@FDF@D@BBBAD@@BFCHALIB@ZCfBFFDDN@pDdCnQI~MKê×\\GYèWÜ£@A@@AC@IAE@E@C@IAE@C@ACAIEEAAAACA@EACAA@@@K@A@CAAAA@IC@AAAC@CAAACACAAACCAAC@AACAC@@@CAA@@AA@@@A@@@@@AA@@@@EAC@A@CAA@@@E@@AC@C@AAA@@@AAAAA@A@@A@@C@@@A@@A@@@@@@AB@@A@@@C@@@A@A@CAA@AAA@C@A@C@CAAACACACAAC@@@AAAB@@ABA@C@A@A@AAAAAA@CCAACCECCA@@CCECCCEEAACC@@CAAAAC@CAAAAGIACIGCC@AGEIIAA@@AAB@ACAC@A@CBC@ABIBA@ABCDEBABEBC@A@E@CAK@KAK@WAE@G@CAE@AAA@IAQCW@C@CAG@CAE@EAG@A@C@GAK@CAAAUCa@G@KAM@M@A@G@G@E@AB@@AAAACEI@@FA@@@@@A@ALAJADAN@D@@G@AG@A@M@KACAGAGAEAECA@A@E@A@ABA@ABA@@@@A@@@A@C@@FAFAFAF@@A@CFO@ADGFKBC@@BALGJENIFEDCD@B@F@BA@@B@@@AAA@EAC@@@ICGAC@A@AAKGA@UAG@EAA@@@@GBGBQ@A@E@GB@@@DABA@@B@@AB@@@BCDABADEFA@A@@@@DA@A@@AAEG"],encodeOffsets:[[102340,40191]]}},{type:"Feature",id:"620721",properties:{name:"肃南裕固族自治县",cp:[99.615601,38.836931],childNum:4},geometry:{type:"MultiPolygon",
+coordinates:[["@@CA@@CEA@@@KAA@I@@@C@A@A@@@C@CB@@E@@@@@GD@@KH@@A@@@A@@B@@A@A@@@A@AB@@IAA@A@@@A@EDGB@@GBGBGAC@@@ABA@CB@@EDABA@CBA@E@BDBDBDBBBBBBBD@@DD@D@D@D@BB@@BBB@BB@BBBBBD@B@B@D@@@@@DBB@@@D@B@B@B@B@@BBB@@@D@BABAB@HB@@BBBB@DDD@BDBDBF@DBB@BDB@@B@B@@CBAB@@@B@B@BBBBB@@@DABAB@BAB@@BBBB@B@@DDBD@BBDB@@@BB@BBDBH@@@BAB@B@BBD@B@@DD@B@@BBBB@B@@@@AB@B@@BB@@@B@@@B@@AB@@@@@@B@BB@@@BB@DBBDBB@@DB@@BB@@BB@@@@ED@@@@@@@BBB@@BBDD@B@@@BB@BBB@@BBDB@BBBB@BBB@B@@@@D@@BDDBBB@@@@@DF@BB@@BD@B@B@BBD@B@B@@@@@DCLKB@@@@@BB@F@@@BBDBB@B@@@@B@BABABCB@FEBCBAB@@AA@AAA@@@B@BCBA@@@A@A@A@A@@CCAAA@AAAAA@C@@@@@@A@ABABABA@@B@BAB@B@@@@BD@BB@@DAB@@@B@@@@A@C@@@ABABADABA@@@@@AEEAA@@BAFCBADAB@FCDFBBB@@B@@B@@F@@B@DAB@B@@@B@@B@@B@@@BBB@F@B@@@BB@D@B@B@@B@DBD@B@@@BA@A@ADG@AB@@ABA@@BABABEBABA@@@@DA@B@@BB@@@FD@BBB@@@B@@@B@@@@@@BB@@@@ABA@@@A@@@@B@@BBBBB@@B@@@B@BBDD@@@BB@B@@@@@@B@@D@BBB@B@@BBBBB@@B@B@BB@@BABABC@@@C@@@ABA@@@A@ABCBG@A@@AA@A@CA@@AA@@@@ABADCBCB@@A@ABCBC@A@A@@@@BA@A@@@ABC@A@A@@@A@A@A@C@CAG@E@A@@@A@@@A@@AA@A@CAG@E@A@@@@AAA@@@@A@@A@@AA@@@@A@@BA@A@@@A@@CC@AA@AAAAC@@@@@A@@A@A@@@@A@@@@@A@CA@@A@AAAAA@AAA@A@@@ECC@A@AAA@A@@@C@AA@@@@@AC@@AAA@AAA@@@A@A@@@A@A@@@AA@@@CAAAC@A@@AA@@AAA@@A@@@@AAA@@A@AA@@A@AAK@A@@BA@@@AAA@CAA@@@CDABCB@BAD@@AD@@A@ABCB@BEDAB@@@@@@A@AAAA@@AAA@A@@AAC@@EAA@GE@@A@A@AB@@A@CC@@A@@A@@AA@@AAA@AAC@AAA@CAAAAAAAAA"],["@@GE}TyfzÕ¢YPA@MD[JuRMBOFOF@@CBABCBABGDGBCBGFCBEDMHCBCBIDCBEBODA@A@CBC@GDEDABCFABCFABABAFAF@DAB@B@BABABIJABCDGHA@ABABA@ADAD@BAD@DAH@D@B@BAB@@@@ABEJEJELELAD@@@BDFBBDFFJR\\IDkTaJA@A@QCA@@AA@_I@@IAeI@@_YGGGCOKAEEECKCKwPAEODBFGBC@ACSFABEB@BKBKBEI@@WD@@PG@@D@@A@@HC@@CK@E@@BDHDHBB@@AF@@@BDFAF@B@BBDBF@DBD@D@BBD@D@DAD@B@BBJ@H@FBDBF@@@BB@BBBBBBDBB@BB@@B@@@FAB@B@D@DBDBD@D@F@DA@@B@F@D@JBB@HBB@B@B@BAF@B@@@FAB@DAD@D@B@BAB@B@BAB@D@B@D@@BB@PAF@FBF@B@BBB@B@B@B@BAD@@@B@DAJ@D@B@B@B@D@B@D@B@B@B@\\AJANA@BM\\ADCFIT@@@^@NN@H@@F@DBF@BBNBF@BBB@D@BBVBN@J@JBF@L@D@FBDBAD@TKbOTKFCBABAofSlERAF@JAzGB@VAZ@TQZIWFAWSXIrNVHPnBBRCSVE|FH@GFMf}BAZLcXFQ@AF[BGP@k@qBU±IgCCA¿"],["@@BA@@DADABCDGBC@@@CAE@K@@AE@A@A@@CAICACEAA@A@A@C@A@E@EBCBCBABADABEBCB@@CBEBCBCBCBG@C@C@@@A@CBABABADAD@@AFADAD@BAB@DAD@BAB@@ADABA@C@E@CBE@CBCDEDCDAB@BAD@B@BDBB@BBB@@B@@ABA@A@A@ABEF@@@@GFGDCBGDC@GBEAGA@@E@E@GAEBC@@BEBEDCDGDEHEDEBA@CBGDEBEDEFCDEDEDIFEDE@CBG@E@G@G@IAI@EBEBGDABCDEFCFCBCFEFEFEBCBE@E@G@E@I@EBE@GBIBGDGDEBGBEBCBIDKDGBGBIBKBKDKDIDGBIDEDIBGFIFIDMHIFIHGDMHIHADCD@@EBEDGDCBGBG@E@EBG@IBI@GDGBCDGDCDCDCDEBCBE@IBGDKDIBIDGDEBIFIFGFEDEHGHEH@FCFCFCDCDEBEDGBE@EBKDEDKFKFGFEFEDCDA@ABC@E@C@ABCDEFABCFCDCBIFCBEDEDC@ABA@ADCBAD@DAFABCHCBABCDEBCBCBEDCBABCBABGJCBCJCFADCFAB@D@B@DBD@D@F@DEJAD@BBFA@@B@DBBDF@@@BABCB@BAB@DAD@DABABA@CBC@GBE@C@@@CAGAE@GAKAGCICKAI@I@EBEDGHEDCFCFCJCLALAFAHAJCJEHCDEFCFCDEDEFEDIDEBKDGBIDCDGDADEJAF@H@HAHAFADCDCHCDCDGDEDEDIHGDIFIFKFKJMFGFIHKJGFA@EFEDGBGBIFEBEDCBIDIFIDIBEBG@GBI@GBKBEDEBCBEDGFCDEFGFIHGFGFEDIFIHGHCDEDEHEDGFGHIHIJGFGDEDGBEBEBCBGDGDIHGFIHKHEDIFGFCBEDCBGFEJEFAD@D@FDDDDBDFDDDHDFFFDFDHDHDHDHFFDFFFF@FCDABC@CBCBE@E@I@EAE@E@GDEBEDEDCDCDAHCFABCDCDC@GBCBEBEBA@GBGBIBC@G@G@@@A@ABEBA@AAIGCACAA@C@C@C@EAABA@ABCBIJA@C@I@I@E@MBC@EBIBCBCBCDEDC@CBE@CBGF@BAB@BCBE@C@C@ABCDEDCBCBEBIDAHAD@DADADCDC@EFABAFABAFAFABCDCBE@CDABABA@CBCBC@EAGAE@C@@DADADEBEBC@C@ECCBADABCBEBMDGBEDCB@DBBFDDB@DABABEDADCDADBBBB@B@@GDC@CAC@@BCBAAC@EBE@IACBCBG@E@EASEEBCBCDCBA@CACAC@CAI@CA@@@CAG@ICEACAAACCCAGCCAAACEAEAGAAA@CAC@AKCICEAEEACGIAECIEI@@CCIEECGECAEAEGCCCGAECCCAG@KAC@EAAA@@ECECQAIAEAGAICCC@CBCHGBCHMDE@E@ACCCAC@ACCEA@Q@K@C@CCEKCIACCCGAC@E@E@CBCBIL@@IHKHEDGDA@IBE@EBE@EBGDCBE@E@G@GAG@EAI@I@G@IAGAI@KAGBI@E@GBEDCBEDCDEDCBEDEDEDCBADCFAD@F@D@DDJBDDHAFABEDA@CBE@EAIAG@E@EBGDADADAD@F@D@DAFCDCFCBEHGFGBE@E@E@CBI@GDEBC@GDC@G@EBE@I@E@EBE@GBE@EBE@G@EAE@IAE@GAEA@@EACECCACACBE@C@CBE@CBE@CBC@EDG@CBE@C@CAGAECGAEAEAGCIACACCCACGICCGGECECEEGECAMOAAEGCEAC@A@EBAB@DAHAF@DABABABA@C@C@I@M@A@CAA@AAA@AAAIGACGCKCEAEAC@AAAAACEAECEACECCEECCA@EAC@CACAAAACCACAAAKAEAC@GEIEA@KKCECEAEEGCCAAC@KAEAE@CAAAAACAECGAC@AACAAAAC@GAA@CA@A@CAI@C@AAAACEGGAAAAA@C@@@A@CBCDEFEFEFCDGNEH@DCFGFEBE@E@EAC@CDCBABEHCDCBKBCBABCDABAD@D@D@D@BADCBKJCFGFEBEBIBE@EBG@KAE@C@EBEBEBEBIDEBABCBADCDEBEAA@@@CDEDKBEBIBGBGBG@EBGBE@CBEDGDGBEBEBEDGBIDEBGFCDEBEFEDEFEDEFGDIFGDEDGDGFGDEBGBEBC@EBCBEBIDGDEBGBCBE@E@E@E@C@E@GBEBEBC@GBE@EBEDIDCDGFCBGBIBI@G@G@G@CBIBEBEFEDADAF@D@DDFDJ@FAFADADADAFCFADCDCBGDGDIBEBEBE@EBCBEDKFCDGBEBEBKBEBEBCBCBGDEDEBGDEDGBEDEDIFGDGDIDIDIBEBGDIBIDCDCBEBEBGBEBIBE@EBEBGBIBEBEBIDEBEDCBCDCFCDADADCDCHCDCFCDEFCBEFCBCDEBCBCBGBGBG@E@C@EDADADC@@AAB@@@BAB@BABAB@B@FAFAF@F@FAJ@D@@@FAB@B@H@D@D@B@BBBDF@@ADADCFEH@BABABGN@BABADCFABKZABGT@BAD@BAFABER@FBDBH@B@FBBDT@D@BBLBR@B@P@DBH@D@@@B@D@B@BAD@B@@C@AB@@AB@@@@AB@B@BABBD@@@F@HB@D@DBBBBB@@B@BBD@BAD@@B@BBB@@@BBDBBBDFDDDFFFFLLDDH@XDN@BBHHLLJH@@JJB@BDBJ@FBF@HBNDT@BAF@B@D@F@X@D@FAH@P@B@B@DBH@BBR@BBB@B@@@@ABABAFAB@B@DBFBB@B@@@BDBBBDB@@@D@@@D@@BBHHBDBBBBBFFLDDBBBB@BBB@@BBB@@BBB@B@BBD@@@BBBBB@D@@AD@@@BBB@B@@BBBD@BBBB@BB@@B@@@@BBBBB@BBB@@BBB@BD@@B@B@BBD@BBDB@@FBB@B@DBBBHBDBHDFBBB@@D@HDB@BBBBB@@DB@BBLJFD@@BHBFBJ@BBDBBDFDDDJBB@BBH@BDP@@B@HDXNDDDBDBDB@BDBBDFHFHBBF@D@BADAB@BAB@@A@@BBDB@@F@D@@BB@B@FBFBH@D@B@@@FAB@B@@BBB@@@@BF@BBDBB@@@F@@BBBB@DBBBJBBBB@@@@BD@D@BBB@@BBDB@@@@BD@D@@BB@@@B@J@D@BBDBBBBHBDBFBD@HBB@@BBD@@BD@@@B@B@@@B@BB@DDBB@@B@@BBBBB@@B@@@@@BADA@AB@BAD@B@D@HAJ@FADA@@BAB@@@B@B@F@DADAD@D@B@B@B@DA@@B@D@D@B@FBB@H@D@D@LCHAD@BAB@FEB@B@@@B@@@B@B@BAB@D@@@B@@BB@@@BBB@DA@@BB@@@B@B@@@@B@B@HAB@FABAB@DABA@@B@@@B@@@@B@B@BB@DBB@B@F@F@DBB@F@DB@@BB@@BBBDBD@@BBDBFD@@DB@@FF@@BBBDDBBDBBB@BBBBBBDD@@BAD@\\@P@DAHEB@HATCLCD@D@FBBAD@FCD@B@L@D@FAFAFADADC@A@ABADCDCRUBEDCHEJITJnXf@BÂAd@T@xAH@@@@@BF@FBB@BDFFFFHNNDBFDJBPDJ@NBN@N@HAJCXKDAD@DDD@B@B@@@BAAAACCCAA@AAE@AA@AA@A@@BAB@B@B@D@DA@@DALABA@@BC@CBCBA@AB@FAPCB@DBD@DBBABAB@BABA@@D@@AB@DADABC@AB@@@DABABAFCFEB@BABAFAFADABABAB@DAD@@AB@DA@@BAB@@@B@@@B@D@@@DBBBB@BB@@B@B@@@@AHB@AH@JCB@DA@@B@DAD@FABBD@B@@ABADCDCBCBABEGGEIIICGIQCKGSCMIQCIQgwpEHAB@B@DBBBBBB@@@@@@ABE@A@A@C@GAE@K@C@CCC@AAACA@@CA@@CCCACAAA@@@A@@@AB@B@BA@@BBB@BBDBDBB@B@BB@@DB@@B@@BBB@@B@@@@@BDBDBBBD@@BB@BAHBB@BAB@@AD@B@D@B@B@@BDB@@@AB@B@BBBBB@BBFBBDF@@BB@B@D@B@BBBB@BDFDBBB@DDBDDBBB@@B@BDBBBD@B@@DBBBDDBB@B@@B@@@LGPGDCDAJE\\O|ajWzcgo@@D@B@D@B@DABABC@@BAA@@ACCCC@@A@K@GBMFC@IM^IDAdDN@@@BBFDBBB@DBDBDBB@B@BBB@DBBBFDB@BDB@B@D@@@@A@@@@AACA@@@A@@@C@A@@@AAACC@AAAA@A@@AA@C@C@A@A@AAAAA@@@@@BABADC@@@@B@@ADABABAB@D@@@BAB@DAB@D@B@FCD@DCB@F@DADAD@H@B@HETODA@@DAPAD@\\@D@FAD@FCB@BA@@D@B@@BF@LBB@D@DAJCHAB@BALGVQ^U@@dS@@BCBGBGHCBA@@NFPDVFDANKBCFCJILKDALIJGHADALC@@F@DBD@@@@B@@@BBA@ACG@CAC@A@AAC@@B@HBLDTIBADAPKJEDCFCTMPHFBB@FBPELCFA@AFIDE@@@@BBBD@B@BDD@@BB@DADBB@@@B@BAD@B@@FAJ@JAF@RAH@ZBP@H@L@H@N@BCDGDIBABEBCBC@@B@B@F@X@D@@@@K@A@@B@H@D@FBB@D@BBD@D@@@FMB@B@@@HCB@FAFC@@BAB@DAFEDABABADGBEDEBCFIBABE@ABABABEDC@ABA@@@@B@@@B@DBBBDDBBFD@@BB@@@BA@@B@@B@@@D@BB@@BB@B@@@@DB@@@@@@@DA@@B@@B@B@@@FA@AFBB@@A@@@@@AA@EAA@@@AA@A@A@@BABADAHCB@B@B@v@RIFCHCJCBA@@@A@I@C@@BC@GAA@C@A@@BG@AACBEAA@A@C@C@EACAE@A@@@A@@BAB@JCDAB@JGFCHEFCLGFCLGBAB@@A@@@@CCA@AACA@ACACAC@@@@@@A@@A@A@CA@A@@A@AA@@@@B@BA@@@A@@AA@@@AB@@@@@A@@AB@@@@@A@@@@A@@A@@@BA@@A@@@B@@AAA@@@@B@@BBBB@@@BA@@@@@@AACCEAA@CAC@CAAA@@DCB@DCB@@@BBBBB@@@D@B@@@BBB@@BBB@@DBBBBB@BBB@D@D@B@@AB@@@BBDBAHC@@BCB@BA@@@AB@DA@AB@BA@@BC@A@@@AC@@@@@NChHB@N@nCTILENGBATwBA@A@@B@DCDADAFCFEDCBAB@BAFAFADAB@@@BAD@B@@ABAB@BAB@BADAD@FEB@@@B@DA@@DC@AB@D@B@DAB@@ABA@@@A@C@G@A@A@A@@@AB@B@BA@@@BHBBBxANFF@f@|VRGEHQZ@DJZTApAF@bYHEBF@@BF@DBFBD^\\JHX^\\tDHBAHENK@LBLBvBJBnBXBZZFZDfEPEIHGfChE@@B@BDBB@BB@@@B@@@B@@@B@B@@@BB@@H@B@B@BB@@@@B@F@B@B@D@B@D@B@@A@@@A@A@@@@@@B@@@B@BB@@B@@@B@BABC@C@@D@B@DAB@D@@AB@B@BAD@BA@@@CB@@A@@D@B@B@D@@@@@@AB@DA@@B@@C@@B@B@BABAFGBABAB@B@B@DAB@BAD@B@@AB@@ABADAB@@AB@@@BABAB@@A@@BAB@BABABA@C@@BABCDE@C@AB@@@@@B@BB@AB@BAB@DBFDB@B@BAB@@@BB@@@@B@B@@@BA@@D@@@B@B@BBBAB@B@BADABA@AB@@AB@@@@BBB@@B@@@B@BAB@B@DC@@B@D@B@D@B@DAB@DADCBABA@@BA@AB@BA@@BCBABA@AD@@@B@B@B@F@D@F@BAD@BADCBAB@@@B@BA@@@@@A@AB@DABA@@DD@@B@B@@ABA@@B@B@@@BAB@B@@@@@@@BBBBB@@BB@@@@@B@FABAB@@@B@@@BAB@BA@ABAA@@@@AA@A@AA@AA@@@@A@@FA@AB@B@D@B@@@BBDAB@@@BA@AB@@A@@@A@@A@@A@@@@@AB@B@BABAB@BAB@@@@@B@B@B@@@@@BA@AB@DAB@BC@AB@BABADA@@@AB@@A@A@ABAFCDGB@@A@A@@BCDCHAB@B@@@B@@@@@B@B@@@B@@@@AB@@@BAB@@@BA@A@@D@BA@@@A@@@@AA@@@A@@BA@@D@D@@BB@@@B@D@@@D@@@DA@@@@@A@A@@B@@@DA@@@@BA@@DABABABA@@@A@@BA@@@AD@FCDAB@BA@@@@BA@C@AB@@@D@B@DA@@D@BB@@@A@AB@@@@ABB@AB@BBBA@@B@@AB@B@DCFC@A@A@@@A@A@A@@AC@AA@CCC@@@@A@@@A@@BAB@@@B@B@BABA@@@@BAAA@A@@AA@A@@BAB@B@@A@@BA@AB@@A@@B@@@BAB@B@@@BB@@@@B@BBFBFBB@D@B@@BB@@@DA@@B@D@@@B@DAB@@A@@B@@A@@@C@A@AB@@AB@@A@A@C@A@@DAB@@@B@@@@A@ABA@A@@DABAB@@AB@BA@@BA@@@A@@A@@AAA@@BA@A@@@AA@C@A@AAAAA@AACAA@A@CC@@A@AA@A@@B@DA@@B@BABABA@ABAB@@A@@@A@AAA@@CAA@A@AAAA@@CAAA@@@@A@A@ABA@A@A@A@CA@@C@C@@@@@AABG@A@@CA@@@@@A@ADABCA@@AA@A@@@A@A@AAC@A@AA@@@@@@@AB@@@@@@ACA@@AA@@@@@A@ABA@@BA@ADAHABAA@AAAACAAAAAAC@@ECAA@A@A@@@@B@@AA@@AA@@A@@A@@AAA@@A@@ACA@@@@@AB@@@BABAB@B@@@@A@@HAB@DABAB@DABBB@B@DDB@@B@BD@@BBB@@DBBBD@DAF@@@@A@AB@D@DA@@@@@C@@@AB@B@@@@@BADA@@@@DADADB@BBBB@BBB@BBB@BBB@DBD@BB@@B@DB@AB@BADA@AD@B@FAH@@@B@B@@@D@DBB@B@B@FBD@FBD@B@BBBBBBBDBDBBBDB@@B@B@B@D@DBBDAB@BABCBABABABC@ABEBE@@BCBA@C@A@ABCAA@AAE@ABA@A@A@A@AAC@@@AFC@CB@BCDCB@D@B@NAB@B@B@HCD@B@DADABABABABC@A@AA@AACCACAA@C@CAA@@AA@A@@@E@CAA@@AA@C@CAE@@EE@A@A@ACO@@@GAE@AAA@CA@CCACAAAC@@@@@A@@@@@@@@@A@@BA@AB@@@B@@A@AB@@@B@DCB@B@B@DABADCBAB@BA@@BABA@A@@@CBA@CBABADCBE@AB@BABAB@DA@@@C@@@A@@@@BA@ABA@@@@BAB@@A@A@C@@@ABAB@@ABABAFG@@@A@@CC@AA@A@AAAA@@AAA@AAC@A@@@BABCB@DAB@BA@ABA@ABCBE@A@ABGBG@AD@@AHAF@DAF@B@AA@@@A@A@C@@BCBABAFE@ABC@@@CBEBC@@@ADA@A@A@@AAAACCA@AE@A@@@A@AAC@A@@@ABA@A@@AACA@@AC@A@A@A@AA@@CA@@ABA@CDC@A@C@A@A@ACC@@@@@AB@BAFA@ABABA@@@@B@B@F@@@HEDC@@F@D@B@B@F@BBBAF@F@BAB@FAF@D@@@DAB@F@F@L@DAB@DA@@BA@ADE@A@E@E@E@@@AAAAA@@@C@A@@DE@A@ABA@@@AB@@@BB@@B@@@BAB@@@FB@@D@D@BA@@BABA@@@@DDB@B@BBDB@@@@B@BAD@@AB@BAB@D@B@HCJA@@@BB@B@B@@AB@BA@A@@@A@E@A@@@CB@@@B@DCB@B@DAB@BBD@@@@@@A@@AA@AAAACAAAAAC@@@AAA@@@@B@@@@A@A@A@@@@BA@@@@B@@@B@@@B@B@@A@@@@CAAA@@@@@C@AAA@AB@@A@@@@B@DCB@B@AA@A@@@A@A@@B@@@F@B@BAB@@@@A@@@@B@B@DAD@@A@@CE@A@A@@@AB@@A@@@@@@FBB@@@B@B@D@@@BA@@@@AA@@C@A@AA@@@@@A@A@@A@A@@@@A@@BAD@@@@@@@@@@AA@A@@@@@@A@@@@B@BAB@B@B@@A@@@C@@A@@AB@D@@A@@DAFAB@B@B@@@@@@@@@@AB@B@@A@@@@B@@@@@B@@@@@@@@@B@@@@@@AB@@@@@@@B@@@@@B@@@BA@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@B@@@@@@@@@@@@@@@BA@@@@@@B@B@@@@@@@@@@@B@@@@A@@@@@@@@B@@@B@@@@@@@@@@@B@@@BA@@B@@@B@@@B@@@@@@@B@@@@@B@B@@@@@@@B@BBB@@@@@@@B@@@@@@A@@B@@A@@@@B@@@@@B@@@@@@@@@@@@@@@B@@A@@@@@@B@@@@@@@@A@@@@@@@@@A@@@AA@@@AA@@A@@A@@@@A@@@@A@@@@@@@@@AA@@@@A@@@@@A@A@@@A@@A@@@@@@A@@@@@@@@@@B@@AB@@AB@@AB@@@@@@A@@@A@@@A@@@@@@B@@@@A@@B@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@@B@@@@@@@@@@@@A@@B@@@@@@A@@B@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@B@@A@@@@@@B@@@@@@@B@@@@@BA@@@@@@@@@@@@@A@@@@B@@@B@@@@@B@@@@@BA@@@@@@B@@@B@B@@@B@@@@@@@B@B@@@@@@A@@A@@A@@@AA@@@@@@@@A@@@@@@@@@@@A@@B@@@@@@@B@@@@A@@B@@AB@@@@@@@@AA@@@@@@@AA@@@@@@B@@@@@@@@A@@@@@@B@@A@@AA@@@@@@@@@A@@@@@A@@BAB@@AB@@A@A@AAE@C@CAC@A@@@G@EBA@AB@BCBA@ADADABAB@FCFC@@B@@@B@B@B@DAB@DBDBD@B@@@D@B@@@B@B@B@B@@B@@FDDDBBBBDB@@BA@@BAD@B@@@B@B@@BDDBFDFBB@BF@D@B@@@B@@A@@@A@@@ACAA@ACAA@@AAA@EC@A@@@ABA@A@A@A@ABC@C@@BA@A@@@AEG@@@A@@@A@@BBB@BBB@@@@@DABA@@B@B@B@@@DEDC@@@@BB@@BB@@@BB@@@B@B@@B@@@@@@B@@CBA@E@C@@DA@@B@DA@BB@@@B@@@@@@@@@@@@@@@@@BB@@@BB@@@@B@@@B@B@@B@@@@@B@BAB@B@B@@@B@@A@@B@BA@@@@@@@@BABADA@@@@@AB@@@@@@ABA@A@@@A@AA@@A@@@A@@@A@@@@@@BA@@@A@@@@B@@A@@@@@A@@@A@@@@@@BA@@@A@E@@BADE@@B@B@B@DAD@@@B@@@@CB@BA@@BAB@B@D@B@@@DBB@@BB@B@DDB@BAB@B@@BB@BA@@DAB@@@DBDBDBB@D@@@B@@BDD@BBBB@JD@@B@@ABCB@@@B@B@@@D@F@B@BABADA@ABCBA@@DCDA@@@AB@@@@AB@DABAB@F@BAB@@ABA@AB@@@CE@A@@@A@A@@@AB@@@@@@A@@BABABA@ABCB@@@B@B@BB@B@@@B@@ABAB@@@@B@B@BBDBB@@@@A@@@AD@B@@A@@@A@@AA@@@@@A@A@@BAB@B@D@D@DAB@D@@AB@DCDADA@@@@@A@@@AB@BA@@DA@@B@FE@@@A@A@@B@BA@A@@AA@@B@@CBABC@@@C@A@ABAB@@A@@@A@AA@ACCE@@A@@A@@BA@AB@@@@A@A@@@A@A@@@A@@@AAA@@AC@@BA@@BA@AAACAAAAAC@@AA@@A@@A@@@AAACAA@@AAAAA@@AA@@A@A@@AACCAAAA@@A@A@@A@ABC@@AA@AAAAC@AAAACAC@AC@G@GB@@A@A@@AA@A@EA@@C@@@@@@A@@AA@@AA@AA@A@A@CAEC@@AAAA@AAA@AAACA@AACAA@AA@ACAA@AAA@AAA@A@ABCB@@CAAAE@AAAA@CAA@AAA@A@AAA@@@AAA@AAA@A@C@CAAAAA@@C@@@A@@@A@@@A@A@@@@@AAEC@@@A@A@@@ABEBA@@@ACA@@@A@@@A@@BA@@@C@A@ACA@@@AACACCECEAC@A@AG@C@A@A@@@A@ABA@C@AB@BAD@B@BA@CBC@A@C@ABABADABADABC@C@ABC@@@A@@AACACA@CA@AAA@A@EBG@@AACACCCA@AAAAAA@EBE@A@C@@@ABCBABC@C@E@A@CAADA@ABABAB@DDFDF@D@B@BABABBFBDBF@D@B@DABABA@A@E@C@A@A@@B@B@BADABA@C@C@A@AAAACACCCAECEAEACAAC@@AAA@C@CBGDABEBCBAF@B@D@BA@EBEBCBC@C@CAG@E@A@CBADABA@A@CAA@A@CBCBCDCBGDGDEDEBCBA@CBCBCBABAB@@BBB@DBDBBBBD@B@BB@BBDADB@BBBADADABCBELCJAFCF@BCDADADCDEDCDCDABCDAD@DAD@B@BAB@BC@CACACAEECECA@AA@CAE@A@CDC@C@AAAAAACCCCACACA@CAAA@@C@A@CDEB@BCBCB@BADBBBBBB@@BD@BBD@BAFAFAD@DAF@HAD@BABADAF@DBDBBBD@DAD@BADADCDAB@DAF@F@DADAD@@CBABCBABEBABE@C@CAA@ABA@ABADCDCBEBABE@CBK@IBI@EB@@CAE@CBC@EBE@EBC@C@A@ABCFCBEFABADAFADCDABCB@@EBGBE@CBC@A@A@CBEBA@EDEHABCDCDABA@CBAAAA@@ICIAI@I@G@ABAFCFGFGBCDEHCFEJADA@ABC@CACCEEGEC@GAECEBMBEACAAE@CAIEIECAAAACCACAECACGACCECCACAA@@@A@AB@BABA@@BA@@B@@AB@BA@@BA@@BA@A@@@AB@@@BA@@B@@@B@BA@@BAB@@@@@BA@@AA@@@@AA@@@@@A@@@AB@@AB@@@B@BBB@@BB@@@B@BBB@B@BA@@BA@@@A@@@AAA@@@A@@@@B@B@BAB@@@@@@A@@A@AAAAA@AAA@AA@A@@@AAA@A@A@@@AAA@@@A@@B@@@B@B@B@@AB@@@B@BBB@B@@@B@B@@@B@B@@@BA@@@@@@@C@@@@BAB@D@B@@@B@@B@@@B@B@@B@@@B@B@@@B@B@B@@A@@@@@A@A@@@A@@@ABA@@BA@@@@@AA@@A@A@@@@B@@@DAB@B@B@@A@@BA@@B@BA@AB@@@B@@@BD@B@@@BB@@@B@B@B@@@BAB@@@B@B@BA@@@@@A@@@@BA@ABAB@@@B@F@B@@@@@B@@A@@AA@@@@BA@@@ABA@@BA@@BA@@@AB@@AB@@@B@@@@B@@@@B@@@B@B@B@B@@@BB@@BBB@@@B@@A@@BA@A@@@A@A@@@@BABA@A@A@AAA@A@@@@BABCBA@ABA@AB@@AB@@@B@@A@@@ABA@@@@B@DABA@@@@BC@@BA@@B@@A@@B@@A@@BA@@@@@AB@AA@A@@@@@A@@BA@@@A@A@A@@@@BAB@@ABABA@A@@B@@AB@@@@@@A@A@@@A@@@AB@BA@@@@BABC@@@A@@AA@@A@CAC@AA@@@A@@@AB@BAB@B@D@FA@@BA@@@@A@CACAAA@@@A@@@@@AB@B@DBB@B@B@@@@AB@BA@AB@@@B@@@B@@BBA@@@A@@@A@AB@BADC@@BAB@B@BA@@D@BAB@DAD@D@D@BAB@B@BAD@B@BAB@@AB@@@B@BB@@B@BA@@@A@A@ABA@@B@BA@@BA@@BAA@@@AAA@@A@@BA@@@@@AAAA@AAA@A@A@AA@@AA@@@@AAAA@@@A@@BA@@@A@@@@@@@@@A@@B@@A@A@A@@BAB@BABA@@@@@C@A@@AA@@AC@AAAAA@A@A@@@AB@@AB@BAB@B@@A@A@A@@@ABAB@@A@A@AAA@A@@@ABA@@@AAA@@@AB@@A@@@A@@@A@@@ABABA@ABAAABA@A@ADA@A@ABA@ABAB@BA@@BA@C@@@E@@"],["@@ABA@CBEDABCBCBGBABADADAFCFABE@CBC@IBCBGDIDGBC@A@I@EBA@ECC@E@IDGDGDEBEBGDEDEDEBCBCBCBIDEBGDA@A@AACCC@E@EBCB@AAACAC@A@GBEBC@EAABC@ABC@@@ABCB@BABAB@B@B@DABABE@A@CAA@C@A@CBC@@BSLtibN\\@NCNAL@DEV[dAJBFFLJHTFBBBBFDHDJFFBFBHDDDDB@@BBB@DBF@F@D@B@BB@DB@BBDBB@D@B@F@D@B@BBBD@@BDBDB@FBL@DB@BB@BBBBD@H@BBBBDB@@L@FBD@FBFDB@F@JBB@@B@B@BABADAD@BBBB@BBB@B@F@D@D@DBDDDDB@BD@@ADB@@BBBED@@@B@DJ@BCB@D@B@B@@@B@@A@@@A@@DAB@@A@@BA@AD@B@FBB@DBBBDBDB@@B@DAB@@AJ@@@@ABA@@@@BAB@B@BBD@B@B@B@B@@BB@DAB@DAB@D@B@FAD@BABABAB@H@B@B@DA@@LAB@BBB@B@B@F@FBB@FBB@F@@@B@@@B@D@D@B@BBBD@@BBFB@@BBB@BBD@@@B@BAD@D@B@BA@@@AB@@AB@BA@@BBB@BBBDBBB@DBDD@@@B@B@BB@DD@B@@BB@B@B@B@DAB@BB@@BBBB@D@DBDBBBDD@@@@FCBAD@DAD@B@BADAB@DABA@@@@BADA@ABA@AB@BADCB@DAF@B@BADABABABADC@@@@@B@B@D@@BBB@@B@@AB@B@@@BHJBB@@BD@@BBBBDBBBBB@BBBB@DBB@B@@BBAJ@DAB@B@F@@@B@BBDB@@H@@@BBBBB@D@DBBBB@@@B@@@@A@EBA@ABABAD@B@DBD@B@B@DAB@BBB@B@BA@@DA@@B@@A@A@A@@BCBABABAB@FAD@B@BAD@BAD@B@@B@AB@@@@A@AA@@@BA@@DAD@@@FAB@D@BADA@@F@@@B@@AB@@CB@DA@AB@B@B@BBB@@@DC@@B@@@BB@@D@D@D@B@B@DB@@HDDDBBDBBB@DB@@@F@B@@B@@@BBBB@BFBBB@@B@@ABBB@@BB@B@@@BBBBD@@B@BB@@@@@BB@B@@@BB@@BB@@@BB@BBB@B@BBB@B@@BBB@B@@B@B@B@DABA@@B@B@F@@@B@@BBBB@@@@@B@B@BA@@D@DBB@B@D@B@B@@BB@BB@BB@F@@@@@BAB@@CB@B@BBB@BAB@B@@@@A@@@AB@@@B@H@BA@@B@@@D@@@BAB@@@B@B@D@B@B@@AB@@C@ABABADC@@B@D@DAD@B@BAFABAB@B@D@DA@@B@FC@A@@DAB@D@BBBB@@@@BABA@@F@FBBAB@@@B@@BB@BB@@@@@@B@BAB@@@B@@@@D@BB@BBB@B@B@HAB@B@@BB@@B@@A@@@@B@@@@DB@@@BBB@@@@@DB@@B@BB@@BB@DBBBB@FABB@@BA@@B@BA@@HAD@B@DA@@B@@ABABA@@B@D@@@B@@@@@BADA@C@@BA@@B@FAB@B@@@@ABAAC@@BA@@@A@AA@@A@A@C@@@@BABE@A@@B@@@B@@C@AB@BAD@@CB@@A@@B@@A@@B@@CAC@@@A@AB@@@@CAA@A@A@A@C@@@A@AB@DCBADABABCB@BA@A@AB@@@BA@C@@BADABABAB@BAB@@A@@B@BB@@@@B@B@BBBA@@BA@@@@B@@@@@B@D@BABB@@BA@@B@@@@@BB@B@B@@@BB@BBBB@@BBB@@@B@@@B@@@B@BAB@@@B@BB@@B@BBBBB@@@B@BA@@B@B@@@@@B@BCB@B@B@@@@@AAA@C@CA@@@A@@F@B@@@BA@@B@B@@BB@BBDBB@BD@@ABAB@@BBDBBBBADA@ABABABAAA@@BAB@B@FAD@BA@@@A@@@@@@@AAA@A@@AA@@BC@CB@@AB@@AA@@A@ABAB@@AB@@@F@B@@@B@BA@@FB@ABA@@B@B@@@BAB@@@@@DA@@BC@@@@@AA@A@AA@A@A@@@A@@@ABABADCFCBA@@@A@AA@@ADAB@DCB@BA@@B@D@B@@@BABA@@B@BADAB@@ADCBA@A@C@A@@@A@@@A@C@@@@BAFAB@B@B@D@B@B@BADABAD@DAD@@AB@@A@@@A@@@AAA@@@EBAA@@A@C@AB@@A@@AA@A@A@C@A@ABA@EAC@@@ACA@@@@@AB@@A@@@E@A@@A@AAA@C@@@A@ACA@@A@A@@BA@C@@BC@C@@BC@@@A@E@ABA@@BCBCBADCB@@ABA@@@ABA@@@@ACAC@AAAAA@@ABC@A@A@A@CCCAA@AA@@@A@@AACCA@C@@A@@@A@@AA@@@A@@@AB@DC@A@@@A@A@A@@@@@AB@FABABAB@@@B@@AD@DAB@B@B@BADA@@F@B@B@@@B@@A@A@C@AB@@AB@B@@@B@@@B@@BB@B@D@B@@@B@B@B@H@HA@@D@B@BA@@B@BA@A@A@AAA@@@KAC@A@@AA@AAAAC@C@C@C@A@@@@@E@@@@A@E@CBA@A@AAA@@@AAAAAAICKGCAA@ICA@AA@@ACAA@@A@A@AA@@AACAAA@@@A@A@C@A@A@@CAACEC@AAAKUKQAC@@@@C@A@ABA@@@C@@AC@@@A@AAAAAAAAB@BA@A@A@@BA@@@C@ABC@@AAA@AA@AA@A@AAA@A@A@C@A@AACAAA@@AAAA@@@A@@@A@@@@@A@CCA@@@AA@@AAAAAAAAA@@A@A@A@CAA@AAAAA@AA@@AACA@@@A@AAA@@@A@@@@@A@@@AA@AAGGAA@A@C@E@EBC@A@ABC@@BA@@@@AAGAC@A@@@A@@A@@AA@A@@@A@ABA@ABA@@AA@CAA@@@@@C@@BABA@@ACAACCA@A@C@ABC@@BC@ABCACA@A@C@A@@CACAG@AA@@@A@A@A@A@A@@@C@A@AAEEG@AAC@A@C@ABA@AB@BAFGB@@A@@AA@A@A@A@C@@AAAC@ACEAAAAAAAAAACAEA@A@@@A@A@AAAA@@@AAA@@@@@A@@@@@@@@B@@BDBDBDBB@@BB@@@@BBBBBB@@BB@BBBB@@@@B@B@@BB@BBB@@@B@@@B@D@@@B@B@@A@@B@@@@@BA@ABA@@@A@A@@@AB@@AB@@AB@@@@AB@@A@@B@BA@@@@B@B@@@@AB@@@B@@AB@@@@@@@B@@@B@@AB@@@B@@@B@@@@@B@@BD@@@BBF@@BD@@@B@D@@@B@@@BA@ADADA@@@A@A@AD@@A@@@C@ABA@ABABA@@@A@@@A@AB@@A@@BABAB@@@BA@@B@@@B@B@BBF@B@@@B@@@@AB@@A@@BA@@DA@ADA@@@@BA@@@C@@@A@A@A@@@A@@B@@A@@@AB@@AB@B@@@BA@@@@@@B@@@B@@ABB@AB@@@@B@@B@@@@@@@BA@E@@@KBC@EBGBGAG@EAC@C@IDGBI@KAEAECEAICC@@@@@@AA@CCAA@@@AA@A@@AA@@@@AAA@@@A@@AA@@AAA@AA@@AAA@@@AAAAA@A@AA@@AAA@@AA@AA@@A@A@@@A@@@A@A@AA@@@@@A@@A@A@ABA@A@A@AAA@@@@@AA@@@A@@@@@@CAA@@AAAA@@A@@A@@AAA@@C@@A@@@AA@A@A@@@AA@@CC@@CC@@AAA@@@A@@@A@@@A@@@@@@A@A@@@A@A@@@@AA@A@@AA@AAA@A@@AA@ABA@@@AA@@@@AA@AA@AA@@AA@A@@A@@@@@@AA@@AA@A@@AC@C@A@@ABABEFC@CACAG@GEMCMCKAM@A@AB@FABCH@JAFBDBJDFABCBEBCBCBGBCBABCFGHEBIDyNIBWFQFWFaJKDKDWHGBK@KFEBAF@H@BDFFFDDDDDDBD@D@@AD@@@B@@A@ABA@@@@BA@AB@@A@@BA@AB@@A@@B@BA@@@AB@@@@AB@B@@A@@B@@A@@@CB@BA@@@ABA@@@A@AB@@A@A@@@A@C@ABA@AB@@@@@@ILILGJCHGLCF@B@D@B@@"]],encodeOffsets:[[[103131,39172]],[[101815,40295]],[[102581,39167]],[[104059,38717]]]}},{type:"Feature",id:"620725",properties:{name:"山丹县",cp:[101.088529,38.784505],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@B@B@B@@ADABC@EBCB@B@@@BBB@FBF@FAFADADADCDC@CBEBABABADABCD@BADABCDABCBABA@A@CAC@A@A@ABABCB@B@B@@ADBB@D@D@BAD@BADADAB@BCDADADABEFCAA@EAECAACCCBC@CBC@C@A@G@ABCBABCBABADCBCFABCBCB@DABADABC@CBEBC@A@ABA@EDABCD@BABADADCDC@AA@@AAE@EACB@@C@ABCBCDCBCBCDCBCBCBGBEDABCDADAD@BADA@E@EB@BADCFCBCBE@C@CBCBEBCBGAC@AA@@@@A@@A@@@A@@@A@@@@@@AAAB@@AB@@A@@@A@@@@@EACAECC@ABABEDC@CBG@A@EACCACAEA@@@@AA@@@A@@@AB@@A@CDED@@ABABEA@@@@A@@@@@@@A@@@@B@@A@@@@@@@@@@@@@AB@@@@A@@@@@@@@@@@@@@BA@@B@@A@@@A@@B@@A@@@@BA@@@@@@@@B@@@@@@A@@@@B@@A@@@@B@@@BAB@@@B@@@B@@@@@BB@@B@@@B@@@BB@@@@B@@@@BB@@@B@@@@@B@B@@@B@@@B@@@B@@A@@BABA@@@@@A@@@@BA@AD@@AB@@@B@B@BA@@B@B@@@B@@@@@@@@BB@@AB@B@@@@A@@B@@@@@@@@@@@B@@@@@B@@@@@@@@@B@@A@@B@@@@BB@@@B@@@@@BBB@@@B@B@@@B@@@@BB@@BB@@B@@B@@@@@BB@@@@@@B@@B@@@@B@@BB@@@BB@B@B@@B@@@@@BBBB@@@@@BB@@@BB@@B@@@@B@@@BB@@@@BBB@BBBB@@@BBD@@@BBB@@B@@@BB@@@BB@@@BB@BBB@@B@@@BBB@@@@A@@B@@@B@@@BBB@@@BB@@@B@@@@@BBB@B@@@@BBAB@@@D@@@B@BA@A@@B@@@BBB@B@B@@@B@@A@@B@@AB@@@B@@@BAB@BA@@BA@BB@BAB@BAB@B@B@@@B@BBB@@@B@B@D@B@@@BBB@@@B@@@B@FB@BDBB@@@B@B@B@@@B@B@@BB@@B@BB@B@@BB@B@DAD@BBD@@FBHF@@BB@@DBBDBBBD@BAB@@ABADA@@DGFIDCBCBABCB@@@F@PBJ@HDLBD@DBDABB@B@@BD@@B@DB@BBBBbFNHDLDLHlBDHjF^FDH@\\B\\@@@LBDBD@BFADCD@DCF@BCBBB@@CD@AA@A@ADABCD@@@B@@@BA@A@@@@@AA@A@ABC@A@@AA@AAAAA@ACBA@AB@B@@BD@@BAB@BB@@@B@D@@C@AB@@@@AAA@C@@@@AB@@A@@@A@@@@@AAA@AA@A@@@A@@BAB@@@BB@@B@B@BA@@BA@@@@B@B@BDH@B@@BBBB@@BABB@@B@@B@B@BA@@@DBDD@@@@ABB@@@BBHFBB@@BB@DBF@BAD@@@BABCDAB@@ABABA@A@EBC@A@AB@@ABBBA@ADCFAB@BAB@BCDCB@@@BA@@B@@ABA@CBCBA@@@@B@B@@@@AB@@@BABAB@@@@@BCDAB@@@DB@@@B@@@@@B@@@BABB@@BBBB@@ADAB@D@BBB@@@@BBB@BB@BBB@B@@@@BB@@B@BAB@B@BBB@@@B@@@@@@B@@@BB@@B@@@B@@B@@@@B@@@@@@@B@@@@@B@@@@@@@B@@BBA@BB@@@@@@@@@@@B@@B@@BA@@@@B@@@BA@@@BBB@@@A@@BB@@@@B@@@@@BBD@@@B@@@@ABBB@@@B@@@@@B@@@@@@@B@@@@B@A@@@@B@@@BB@@@@A@@BAB@@AB@@@@A@@BA@@@A@A@@@A@A@@@A@@@@@EDC@@@C@@@@B@@BB@@@B@@A@@B@@BB@B@@@@ABAB@@B@B@@@@FCDGBAAAB@@AB@@@@@@DAB@BA@@B@@@B@@@B@@ABAD@BB@@@@B@@@BBB@@@B@@B@@B@@@@@@@B@@@@BB@B@B@BADADBDB@@@B@@@D@BAB@@@BB@@AB@@ABAB@@EDA@BB@B@@@@@BD@@@B@@@@B@BAD@@B@@@B@BAB@@@@@@BCD@BA@A@@B@@@BA@@BB@@@D@@B@@ADABA@@@ABB@@@BBBB@B@@@@@BA@CB@B@@@BAB@@@@BB@BCBA@@@B@B@@B@@AB@BD@@@@@CB@@B@@B@@CF@@ABCFA@@BBB@B@@AB@BA@ABE@@BB@B@BBBABBB@B@@@@@BBBBBB@BB@@BABCBABAB@BA@@@@FAB@DA@AD@@@B@@@@@@@B@BABB@@@@B@BB@@B@@BB@@@B@@B@@B@@@@BB@@@B@B@@@@@@BB@B@@AD@@@@@B@@@@@BB@AB@B@@@@BB@@@B@@AB@@@@ABA@@@B@@@AB@@@B@B@@@@@BA@@BA@@B@@@@AB@@BB@@@B@@AB@@@BAB@@@@A@AB@@B@@B@BA@A@@B@@@B@B@@AB@@A@@@@@@@AB@@@@@B@@@BA@@@@BCD@@A@AB@@@@@B@@ABA@AB@@@@@@@BCB@@@@@@A@ABA@@@@@AB@@AD@D@B@B@@A@@@A@A@@B@B@@C@@@@@A@@D@D@@@BD@@BB@@@@@A@@BBB@@B@@@@B@@@BCB@B@@@BB@@B@BB@ABAD@BAB@B@@BFBB@@@BABBD@DA@ABCDA@@B@F@BAJAD@D@B@D@B@BA@@@A@AB@@@B@@BDBB@D@B@@BB@@BB@@@B@@@B@@@D@@@B@@@@EBCB@@@@@B@@@D@B@F@FBBBBBBBB@B@@ADAB@@@B@@BB@@@B@@ABB@@@A@@@AB@@@@AD@BBB@@A@AB@B@BABCB@B@B@BA@@@@D@@CB@B@B@B@B@BABAB@@@DBBDB@B@B@@@BADABBD@DA@@BB@BB@@BBDFBB@@HBBBLJ@B@@@B@@C@ABA@A@AB@B@@@BA@@BA@@B@BAB@BC@@BA@@B@@CFABABCB@B@@AB@B@@ADABAB@B@@ABC@CB@BA@@B@@@B@D@B@@AD@B@BGLADCDEF@BCB@@@BAD@@A@@@A@MECAG@A@SAC@@BEAA@AB@@A@C@A@CAAAAAA@EB@BCAA@AAABA@@BC@CBA@@@AAAAA@@@A@@@ABE@CB@@@@AB@@]FODGBSBmJKBCA@EDGHCDCFybEB_L[JDBHDB@FFFDBBFHDDDDDHBBDDDDBDBBBBDFBDDB@BBBBDBD@B@@BBBB@@BD@B@@DFBFBBBDFJEBOFABCBID@@BDBD@DC@BBD@@@@B@@DABBBDBB@BDDB@DDB@NJB@@BFB@BB@@@@@DDDBBBDBBBDBDFDD@@BB@@BBDBBBDDDDB@DDBBDDBB@B@B@BB@@BBBJFDBNJ@BDBBBBBDD@BBBBD@@BB@@B@@DBB@BB@B@@BDDB@@B@@FFBDB@B@DB@@B@@B@B@@@B@BBBDBB@D@BBD@B@@B@B@@BBF@@BB@@BDD@B@DB@@DB@BD@BBB@@BBDD@@@@B@B@DAD@BA@@BD@@D@B@@@@B@BAB@BB@B@@BB@@B@@@BAF@BAB@BA@ABAF@@@B@D@BDF@@@BAB@@@DABBB@B@BBB@@TKHCNIPGFAH@DD@@FFDB@@FJJPDNFFB@B@REdMbO^KJGBCBCBCF]BcCQEKBE@@P@NDdHbHtJbFnHH@N|ZZJhPLFPHZJXNJDFBD@BAB@AEGSEGAGEMe§D@EBC@@BA@A@AB@HAD@HCFAHCHALBF@F@FANAELAD@BAF@DBDABADIBCBGBG@CDG@EACACAAAACGGGCC@@ECEAIAIAI@EAA@@ABA@CBGBEFGDCBADAFCF@DCDADAFABBF@FBF@HAFAFCHAJAJ@H@D@HAHAFAD@F@HBFBDBD@FALEHEFCFAHAD@DAJALAJCLEPENCHCBABCDCDCJEJ@DAD@H@HAHAFCHAJAPEHAPCLCTEREPCFCB@FEFGBCDEDGLONWNSJMJMB@FCFBD@@@ACA@@AACA@AAK@ECUWKKCA@@QQMMF_DUBMAACAeUIEEAcKA@AAB@Z[@CB@BA@A@AB@BA@@@@BA@@A@BA@ABA@@@@BA@@BADE@@@C@@AA@@@AB@@A@@@C@@@@B@@A@A@@@@B@@@@ABA@AB@@@@A@A@@@@@AB@@@@A@@@A@@@@@@@A@@@A@A@@@A@@@@@A@@AA@AB@@@A@BA@@BA@A@@@A@@@ABA@@@@BA@C@@@@AA@@AA@@@@A@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@@C@@AA@@@@@@@@A@@@@AB@@@@A@@@A@@@A@@A@@@@A@@@@@@@@@A@@@A@@@@@@@A@@@A@A@@@@BA@@A@@AB@@@@A@@@@@@@@@@@@@AA@@@@@@@@AA@@@BA@@@@@A@@@A@@@@@@@A@@A@@@@@@A@@@@@@@AB@@@@@@@B@@@@A@@@@@@@@@@@@B@@A@@@@@A@@B@@A@@@@@@B@@@@@@@@@B@@@@@@@B@@@@A@@@A@@BA@@@@@A@@@@@@@A@@A@@@@A@@@A@AB@@@@A@@@@@AA@@@@A@@@AA@@A@@A@@@@@@@AA@@@@@@A@@B@@A@@A@@A@@@@@@AA@@@@@@@A@@@@@@@A@@@@@A@A@@@A@A@@BA@@@A@@B@@@@@B@@@@@@AB@@A@@@@B@@A@@@@A@@@@A@@@@@AA@@@@A@@@@@@@@@@@AB@@@@A@@@@@@@@@@@@@@@A@A@@@@@@@@@@@@@@BAB@@A@@@@@@@@@@@@@A@@@@@@@@@A@@AA@@@@A@@A@@@@@@@@@A@@B@@@B@@@@@@@B@@@@@B@@B@@@AB@@@@@B@B@@@B@@@@@B@@A@@B@@@@@BA@@B@@@@@B@@@@@@@BBB@@@BB@@@@@@@@B@@@B@@@@@@@@BBABB@@@@@AB@@@@@@@@@BA@@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@A@@@@@@@@@@@A@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@AA@@@@@A@@A@@@ACAC@@AA@AAACEACCECE@AA@@AC@ACA@CCECECAAA@QKCCCAEC@@@@AA@@@A@AA@@@@A@@AA@@@AA@@AA@@A@@@@@@@AA@@AA@@ACA@@AA@@@@AA@@@@@@@A@@@A@AA@@CAA@@@@@@@@AA@@CCAAA@@A@@@@E@@@A@@@CB@@E@CA@@A@A@@@A@@@C@@@@AAA@@@@@C@@@A@@@@A@@@A@@@@@@@C@AB@@@@@@@@C@@@A@@@@@@@A@A@@@ABA@A@@@AB@@C@@B@@A@A@@BA@@@A@@@AB@@ABA@@@@@@@@@A@@@A@@@C@E@@@@@AB@@@@CD@@CB@@C@@@@@E@@@@@@@@@@@@A@@@A@@@@AAAA@@@@@A@@AA@@@@@AA@C@@@AAA@@@C@@@@@C@A@@@AACAA@A@@@@@AA@@@@A@@@@@@@AB@@A@@@@@A@@BA@@@A@@@@@@@@@C@@@@@A@@A@@CA@@A@A@@@E@@@@@A@A@@@A@@@AB@@A@A@@A@@AB@@C@@@@@@@@@@@@AAA@A@@AA@@@@AA@@A@A@@@@@A@@A@@@@@A@@BCB@@@BC@ABA@@@A@@@@BABC@@@@@A@@@AA@@@@AAAE@EAC@A@AA@@@@AAAA@@C@@@A@E@ABCBEDGBEAA@@@AA@B@@@@@AC@@A@@@@@@BABA@AB@BA@@@AB@@@@@@ABA@@@A@@@A@C@@@A@@@@@A@@@@BA@@@@@A@@@A@@@C@A@@@@@A@A@@@A@@@A@@@@@A@AA@@@@A@A@C@@BC@@@E@@BIDGBE@AD@J@A@C@A@EDE@A@@FK@YBKBALAF@D@@@D@B@@@B@B@B@@@B@@@BA@@@@CC@I@AFCHCRGNEPEB@TMJCLSDANCRKBA@CAEIIGGGOACEMAEGEECAEIEKKIEEEECAAGGCCCA@@BA@@A@@AA@@@E@A@@@AA@AAACAA@@@@AA@CA@@A@@AAA@A@@AA@@A@AA@A@@@@@A@@B@@A@@@AA@ACA@@@@AB@BC@@@@@A@@CA@AAA@A@@CC@A@@@A@@BA@@AA@@@C@@AAB@BA@AB@@AA@@AA@@A@@@@@A@@@@@ABA@@DAB@DCDAFCFABBB@@@DAB@B@@@B@@@@@B@@@BA@@B@@@BAB@B@@AB@B@B@@@B@@BB@@@B@B@@@B@@@FCH@D@@@@A@G@K@@BAFCFEBCBCDABC@A@A@@@AB@BAB@DABAB@B@B@D@BAB@BAB@BBB@BBB@B@B@@ADCBC@AB@DADABAJEBCBC@C@A@@FCAA@AA@BC@@ACA@CCCCCAC@C@E@A@A@AAA@AA@ABCBCBA@A@A@AA@IAE@A@ECEAC@EAK@@@CAAAAAG@C@AAAAA@@ACAK@EAA@ACAC@@ACAAA@C@E@A@C@A@CAAAA@@CAAA@C@E@E@CAA@AA@@CACCGCEAEAIEGCECAAAASEIGEKAEBI\\cFU@CBKDM@MM[jasTK@AA@A@A@A@AACC@ABCACAAECCAGEEEGEGCMCGCAA@CAE@CACGCIAGACACCGEACAGAACCAGAC@CDEAACCMEECCE@@A@C@E@A@CBABABCBABADABABA@CBA@ABCBABC@CBE@CBEBEDCBCBC@C@CAA@AB@@AB@BAB@BABA@CBABAB@B@B@B@B@BABA@EBC@E@E@GDCBC@@B@@@BAD@BADA@CBC@C@EAA@CAE@EAEAC@ABCBGDEDEDGDEBC@ABE@EAA@C@C@CBABC@CBE@IBE@E@C@CBABA@ABABABCDABCBA@EBA@ABABABABA@G@C@C@A@@@AB@B@D@B@DABABA@GACAA@A@A@@@@@@B"],encodeOffsets:[[103588,38778]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("潮州",{type:"FeatureCollection",features:[{type:"Feature",id:"445102",properties:{name:"湘桥区",cp:[116.628627,23.674387],childNum:1},geometry:{type:"Polygon",coordinates:["@@AHCFALKECEECCA@@AB@@@N@@DHB@@@DDBBCD@@@@@@BDBDCFADAB@@ADA@AABCB@@@@@A@AA@@ABBDABB@AB@@@@@@A@AACBA@C@CBAFA@@@A@E@A@C@EAC@@@CB@B@BBF@F@@@@@B@@@@@D@F@@@@@@A@A@A@EBIBG@@F@@A@@@E@@L@BF@@CAB@CD@@AB@H@@@@FAB@DAF@D@@@JBF@F@D@DAB@BDPCFEGAA@DCFAACCIC@@ABCDAHBJBHDDBBDBXHH@DAHCFCBADAB@FDBB@@DBBBHBFAJCNCF@DAB@B@H@DA@CDC@@HCDBBB@D@BCD@BFFD@H@HAB@D@B@BABCDAD@F@BBN@B@D@BBFFFBFBVFF@B@FBLD@@B@BBFDFHD@DBDDDDJFFBHFFFBBDDJ@N@J@B@HC@EBEDEAEGMAG@A@A@A@AACCEAA@A@@BAFG@ABCBADCDEDC@ABABAD@DEDEHA@@@@L@B@B@F@DADABCBBB@@@BABCDCBABA@CBEBCBABCBC@ADABCF@DADAF@H@BABABAB@D@D@B@BA@A@ACAEAECEACAEACACCACAAAAA@A@ABGCECCCAE@EBEBCFA@A@AB@DADAD@@@BABCB@BCB@B@@B@@ABB@BABA@@@AA@@@A@AAAA@@@@BA@A@@AAA@@@@@@A@@B@@@BAB@@@BA@A@@@A@@B@BAB@BBB@@@@@@AAA@C@A@AAA@A@@@@A@@AAA@A@@@@@@A@@@A@@BA@@@@BA@@@@@AAAA@A@@A@AAABC@@B@@ABA@CA@@AA@AAAA@A@AAAAA@A@@@ABA@A@@@A@@B@BA@ABG@@AAA@A@A@@@A@A@CAA@A@@CC@A@C@CBAD@@@@C@A@AA@A@CACA@@AAA@@A@@A@@A@C@@BA@@@@AA@A@@@@E@CBAB@BA@@@AB@@@B@@A@@A@@@AA@ABA@ABA@@AAAA@ACA@@@AC@@AA@AA@A@AB@@A@BA@A@@AAAACBA@@@EB@D@BA@@D@BABA@@@@@@@AB@B@D@@@DA@ABADABE@CB@BC@@BBBAB@BAB@B@BABCBC@ABAA@CE@WAA@GC@CBEBE@C@GA@AAEAA@ECCEAAACCJBH@@GJGFC@KDGHGHEFGDABABEDEFAB@BGHKDQ@GB"],encodeOffsets:[[119440,24189]]}},{type:"Feature",id:"445103",properties:{name:"潮安区",cp:[116.678203,23.462613],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAG@CBCBC@ABCBCDABA@CAA@A@CBCBCJCHAF@DEBA@ABED@@ABADABADBF@D@D@H@F@F@F@F@@DFB@BBB@FBDDBB@DADAB@BBBFBF@BAD@@@BBBDBB@@B@BBBABBBB@@@@CDAB@BABADAD@@@@@BB@@@@D@@@BBD@D@BBDABA@@@A@ABA@ADC@@@AB@@EB@BAB@BAB@@AAAB@B@BA@CEAABA@@@@@@BAAAA@@@A@C@C@ABCDADAB@BCBABC@AD@FBB@B@DA@@@ABCAAAC@AB@BBD@D@DBB@B@BAB@@@B@B@@DBDBFB@B@B@D@B@B@D@B@FEBABAFAFCB@D@DBD@DAFCH@BCHA@EACBAD@D@DAD@B@BABC@EACBC@K@GBA@IAC@CDA@A@CCG@EA@DCLAHADA@CAGCA@@B@BBBBDBBAB@BCBC@C@C@GDGBCCAEAC@CA@A@EDCFABEJCJ@FAFCBEBC@E@A@M@KBGBEDCDED@B@DCBADCDCFCDCBC@C@A@ECA@ADGF@F@DBBADAD@BADAD@B@B@BBB@B@@BB@BB@@@@BB@@BB@BABCBA@ABBB@B@B@@BB@@@@@@B@B@B@@@@BBB@DB@@@B@BB@@B@BB@@B@@@@B@@B@@BAB@@BB@@@BB@@@@BB@BDF@BB@DDBDBBB@@BBB@B@B@BBF@BBB@BB@FAB@@@B@B@BB@BDD@@DDBB@BD@BBB@D@BBB@BBB@B@DALBB@BAFC@A@@@@@@@A@AAABA@@B@B@B@DAB@FADABA@@@@BABA@@BC@@DEBA@@DA@ABAB@@@BAB@@AB@@A@@B@B@B@B@@@@@BAD@@@B@@@B@@@@AB@NAB@FAH@F@D@FBBBBD@DBD@@BBDBDB@D@B@N@J@J@LDLDDDFHBBDDBADADADC@CB@DBBBBBFAH@@CF@F@D@BDBFADCBC@AB@DBDBBD@DDB@B@BEHEHAFCDABIHKHMJCFAB@B@FBFFAFAD@D@D@D@DAB@B@BB@D@B@BB@DHBBDD@B@DBBB@BABCBABBB@DBB@DBD@B@@BBDABBBDFBBB@BAD@B@DDDBBBHBFDDDBB@DAB@BA@@BBBADBBB@FBB@JBBBLA@A@CB@BAJ@DADA@@FCDAD@BBHJDBDBFBBB@N@D@J@BAF@B@FB@DDBB@NCHABAD@DBBB@BAFALAFADAD@B@DD@BEDA@C@C@ABBBABADKF@BA@@@@B@@@B@BBHBBJ@F@DBDDBDD@BBB@B@DC@@DAB@@AB@@AB@@@D@B@BDBBBDAL@BDB@B@B@B@B@BAB@B@BBBBBDBBBBBB@B@BBBADABABCDCB@D@J@B@BA@AB@DADAB@@B@@@BBBF@D@DBF@DB@@DA@A@C@E@ABAD@FCBBBBAF@DABCB@@AB@@BBB@BBD@BBAB@D@B@DCFCJ@@BBDCFCBA@@BABA@@BA@@@BBBBBBBDADAFABABA@@@EB@@@BAB@BABCBEBC@CBADC@EAC@CDIDIDEDEFAHAF@FABEBCDEDEBABA@C@AAC@E@CBCBEDE@E@A@CBC@CBEDCBC@C@AA@A@@BAA@AAAA@AAAA@CDADEFADBFDB@@BBABAFABABBB@B@B@@ABCBABAD@BA@@FCDAFAHCDADCDE@CAECEECGAC@I@MDGDGDGBGBEAC@CACA@E@CBEBCBC@AFCDA@CAACCCCCAAC@C@C@A@A@AA@ABA@AB@BAAA@AC@C@C@AACCA@C@@@ABEBCBCBAB@@A@AACOGMKIGAACAAACAAAACBEDEDABADGBEDGFEBCDAACAC@C@@AA@CBADOBCCCCCCAC@EGECAAA@@@KCEAA@E@UEEAEAEEAAC@A@M@AAE@C@CBADABA@C@A@GBG@C@EE@ADC@A@CAACAGD@@CD@DCBG@A@A@CBE@MDIDEBGAAACA@@AAECA@CBABEDGDCBG@WGCAAACCAGAIBGDCBA@@JDDDBBDE@CBBFHDECO@ABA@C@C@EAE@I@@@CBE@CBA@E@@G@A@@BC@@DBA@DE@@A@KF@@@B@@@@EH@JAFAB@B@B@@@@@@@@E@C@@@@@A@@@@@EAE@A@ADA@@D@FBD@B@F@B@@@B@BEDAD@B@DABBB@@@@@@@BAA@BAACBA@@BBB@@@@@A@ADBBB@BC@@BABCDEACAC@@@@@@DCAACC@@A@CG@@@M@@BA@@DBFDDFLFBKDEBGHAR@LCHG@ABAFEFCBABAHCFEHGHGLCD@HEHI@@AGDI@ADALCDCBA@AFABCB@@ADQACC@C@AAEAAA@EAEEAAE@A@A@E@KAE@QDC@ABG@C@ABI@ABGDC@ADC@GBEBIBGBGBCCEAGAEAAAAA@A@AACAE@A@AACI@@@AAAAA@C@ABABCBABC@CAAGCAACACAA@C@CAACAB@@ABAFAD@B@@ADECCCABAB@@A@@AAA@@BA@@@A@@CAA@A@AAAC@AC@CACBC@CDE@AB@@AAA@ACCCA@A@ABC@CBC@AB@AAAAEEDC@@@ABA@AD@DBB@@CFBBBD@@@@DD@BBBCFADBBBB@B@@@B@BADEBA@AB@BAD@BABCDCBADABA@A@CAAAC@C@A@C@ADCBEBCBCBCDEBEBGB"],encodeOffsets:[[119385,24039]]}},{type:"Feature",id:"445122",properties:{name:"饶平县",cp:[117.0039,23.663824],childNum:10},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@@@@@A@@@@@B@@@@@"],["@@@@@@@@B@@@@@@@@@@A@@@@@@AB"],["@@@@@@B@@@AA@@@@@@@B@@A@@@@@@@@@@@B@@@"],["@@@B@@@@B@@@@@BA@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@"],["@@A@@@@B@@B@@@@@@@@@@@@@B@@@@A@@A@@@@@@@@@"],["@@@@B@@A@@@@@@A@@@@@AB@@@@@@@@@@@@@B@@@@@@@@B@@@@A@@"],["@@A@@@@@@B@@B@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@A@@@@@@A@@@@@@B@@@@@@"],["@@@@B@@@@@@A@@@@@@@@@A@@@@@@A@@@@@@B@@A@@@@@@@B@@B"],["@@AB@@@@B@@B@@@@@@@@BB@@@A@@@@@AB@@@@@@@AA@@@@@@@AA@@@@@@B@@@@"],["@@YEYIOAM@SHKDM@Q@SEMEKIEKO_EIGCGAYBOBYB[D]HOHIJKPCDEDGBGA@bBH@TCRINADCD@F@F@DBH@BBH@D@D@B@@ABCBEDCFCDAHBD@B@BA@GDC@EBCECAADC@A@CAGCAAAAA@C@@@@B@BABC@A@CBCBCD@B@F@B@FA@BB@B@DEBABEDC@CAC@CBABA@ABA@A@C@ABCF@BEBE@C@ACCAA@AAA@AA@AAA@A@@@AAA@AADAB@@C@CBCBA@@B@BEBADAF@FBFDDFDHDBAB@B@BBBBBDDDDBFBDBFBFDFBDB@B@BABA@C@C@A@ABABABG@E@CBCBE@ADCB@BADADABADAF@DABABCDADAB@@A@AAADCBCBE@A@A@K@@@@@GBCFCFC@ABAB@BCDCFCDABAD@BEHAB@@@BBBDFBD@B@B@B@BBHHNBFCFAF@FGDA@I@M@I@CCAAEEGEEAIEADCPAB@DBB@@@DBDBDCBADEFCHAFCHABCBCFAFBDBBDBBBDBBBJHNLPHBD@B@BA@ABADADAF@B@@@DDBBD@B@D@DBDB@BB@ABAB@BAB@@B@B@B@D@DBDDBDDDDBB@DCBED@BADADAF@D@FDBDBD@FBHAHAHCHCNCJ@D@HBFDDFBF@DCFCDCBGDEBCBED@@ABC@ABABAD@BA@A@A@AAABEBABAB@AA@ECCAEBCFCB@DBBBBB@BB@BBB@AB@B@@B@DADCDAF@DAD@D@B@FCFAFAD@D@FBD@B@DABABCFCFADAFEBE@GBEBCFCFCJCJ@DBD@FCDAB@DADAFADABA@AB@@A@@F@@ABABEBCBCBAAAAAA@A@@AB@@ABAB@@ABFFFHFHDFBF@F@B@DAFAFBBBDABADCDCDAF@FADADB@@BBBDBBBBB@DDDFBBDB@F@HCDCFCHCJAD@BBBDBFBBB@DBDBFDDFBDBDDFDF@DDF@@BBROB@BFFPBF@H@DEJER@N@TJRDFLP@BBB@BDBBBBBBBB@@D@DBBB@DBB@B@F@D@BBBDDBBBB@@BB@@@@BB@@BB@BDBBFDD@DADADCDAF@FCHAD@@@BHHPLLDBBBFDF@FAFCFE@EBCBGDEFCJ@DDFDB@B@DFBDBHBH@BBB@L@LJDLDB@B@BAB@B@@@B@@B@@BB@@DD@@BB@B@@@BADA@ADCFEHADBFBBDDDBBDDBDBB@D@BDDDDBFBDAFAFCFEDC@@FCBC@ABAD@HADAD@BB@@B@D@D@D@B@B@B@@@B@@A@A@A@ABABAD@BABAB@@AB@BA@A@ABA@ABABABA@ABA@@BABBB@FBB@BABA@@BCDCHAFCDAFCBADCBCBA@C@AAA@CAAC@@AA@AA@@AC@@@AAA@AAAAAAA@@A@CAA@C@AAAAAAAAAAAA@AAC@AAAA@@@A@C@C@@@C@C@AAAAA@@ACAAC@@AAAAAAA@AAAAAAACACAA@AAA@@A@@@AAA@@@A@A@A@@@AA@@@A@AA@@AAAA@A@@AAAA@@A@CACCOAIAA@AAA@@@@@AAAAA@@@A@AAA@A@CBA@ABA@A@ABA@A@C@AAC@A@E@ABC@C@@@C@CBA@C@AAA@C@AB@@ABABAB@BA@AB@BC@A@ABC@A@@BAB@B@D@BBDBB@B@B@BBB@@AB@B@@@@@B@@A@AAA@@AA@@A@@@@C@ABA@C@@@C@AAC@AAA@AA@@AA@A@A@@@C@AAA@AA@@@A@A@@@ABABABA@@B@B@B@@@BABABA@@BA@@BABABABADA@A@A@A@@@CAAAA@A@C@A@A@ABABCB@BADA@@B@@@B@B@BBBBBBBBBBB@D@D@DABADADCFEAEAAAACCCC@C@AAA@EACBC@A@C@CA@@A@EDC@ABAHGFGAEACAAAABCBE@C@CAC@AACCA@C@A@CACACCC@@AAIEIAEBGAAC@CDA@ABADABE@G@@ACAAACBA@ADADAJCHABAB@DGACAA@CBAJABA@AEC@AAGAEDEMBE@E@CACCAADEBCFEPER@BFDDDBFBD@FAB@FCBADAJAFAFAB@@A@A@A@A@@BABAB@BA@@BA@C@ABABABCBCBA@C@CBKBC@ABA@A@ABA@ACCCCECCC@A@A@ABCBCAA@A@@@A@@BCDADCFCDCFCBABA@@BA@A@ABA@CBADCBC@C@ABC@CBC@ABC@C@ABA@ADABCB@@ADI@C@Q@ABE@ABA@A@AAA@A@A@A@A@ABA@AAAAA@A@ABC@ABABA@A@A@@@AAA@A@A@ABABABAAC@A@E@C@CBCBEFCDAF@BADAB@B@BBBB@@B@B@B@B@@@DCBA@CBCDGFEBCDABCBCBABAD@DB@@B@@@BABABADAD@F@F@FBDAD@B@B@BEDIFEBC@A@ABA@A@CBA@@BBFBFD@BDB@@B@D@B@B@BB@@B@@@B@@ABA@@B@BBDB@@BB@@@@BA@@DC@ADCDEFEFCFABAFB@@HABABABA@ABBD@B@@@BBDAF@B@BB@@B@DABABAB@@ABA@ABAAC@@@E@E@E@@B@a{OQ"]],encodeOffsets:[[[119721,24077]],[[119794,24064]],[[119725,24071]],[[119800,24057]],[[119797,24062]],[[119723,24072]],[[119797,24067]],[[119799,24057]],[[119877,24103]],[[119937,24111]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
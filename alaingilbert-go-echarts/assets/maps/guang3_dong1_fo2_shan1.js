@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("佛山",{type:"FeatureCollection",features:[{type:"Feature",id:"440604",properties:{name:"禅城区",cp:[113.122421,23.009551],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAAAAAAABC@@DAD@@@B@BEBA@@@@@ADA@@@@BA@A@@AAB@@ABAB@@@B@BB@@DB@@BB@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@A@@@@@@B@@@@A@@BADBDA@@B@FBBCACACAE@GAAA@A@ABGDA@E@CBC@E@AAA@GCEEAAAAEECA@@C@@@KA@@@@@@A@OCA@E@EBA@ABCDCBE@A@@@@@A@E@K@QFC@@@A@K@A@OAC@C@@AC@@@CA@@@AAA@@@@ABA@@CA@B@BA@@AA@@AA@A@@@@@AB@B@@A@A@@@@A@@@@A@@BA@@B@AB@@B@@@@@@@@A@AA@@@@AB@AA@@@@AABA@BB@@AB@@AB@B@@@@@D@@@@A@A@@@@@A@@@@@@B@@A@A@@@@@AB@@@BA@@@@@A@@BAB@B@B@@B@B@@@@BB@@@AB@@@@@AABAAA@@@@BA@@@@@AA@AA@@A@@A@A@@@@@@@@BA@A@@FM@@@@A@@@@@@@@AC@@@AA@@@@@AA@C@A@@@A@BBA@@@@@AAA@BA@A@@A@ABC@A@AB@@@@C@AA@@@AA@@@@@@@@@@@@@@@@@A@AA@@@@@A@@@@@@@A@@@@@AA@AAA@@A@ABCM@@@@@@@K@E@I@ABEDIFCHGJCFCFAFADAB@@@BAJABAFCJEFCHEHABOF@@CFBLLP@BBB@FDF@DCN@L@N@@@LLGJ@FFBBJDF@J@JAHAHADC@ABDBFDDBD@@B@D@@@FAJAD@DB@BBB@@BBBBBDBD@FAD@B@B@BBBB@BBD@H@@@H@@@B@F@B@FAD@D@HA@BB@@@B@@B@@@H@@@@F@DAD@@@@@B@@@@@B@B@@BB@B@B@B@B@H@@@@@B@@@B@@BDD@B@@@@@@BB@B@@@@@B@@B@@@D@@@BA@@@@B@DA@@B@@@@@B@BB@@@@FB@@@@B@@@@@@@@A@@@@B@@CBCBA@ABCBC@A@@@A@@@@@@@@@@@A@ADC@@B@@@@@@@@@B@@@BBB@@@@@B@B@BA@@B@BA@@@@B@@@BAB@@@@A@@@A@@B@AA@@@@@ACAA@@@@@AA@@AA@@@A@@B@@A@@@@@AB@@@@@BAB@@A@@@@B@@A@@@@@@@@BA@@@C@@BC@A@@@AB@@@B@BAB@@@BAB@BADA@@B@DBBBBBBB@@B@@@BABAAACA@AAAAA@@@C@@AEC@A@I@"],encodeOffsets:[[115860,23565]]}},{type:"Feature",id:"440606",properties:{name:"顺德区",cp:[113.293359,22.80524],childNum:1},geometry:{type:"Polygon",coordinates:["@@CA@@EHC@ABABED@@A@EDKDCBABC@CB@@ABGB@@A@@BA@CD@BA@@@A@@BA@@@AB@BC@CAA@AACAA@E@EDEFKPENAHCX@FA@@BAP@DEZAJAJAFAJABCDCFGNIP@DBJ@DDH@@B@@@@@@DB@BB@@EJA@@B@B@@@@@@@@A@A@EB@@@@A@@@C@@@A@CB@@EDEHC@BB@@BBBB@@ABA@@@@@@@@@@B@@B@@@BB@@@@@@A@A@@@A@AB@@@@A@@BAAA@A@@@CAA@@DABA@@B@B@@@@@BA@@AAB@@@@A@@@AA@@CBABA@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@BB@@A@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@A@@B@@@@@@@B@@@B@@@B@@@@@BAAABB@@BAB@@@@@@@BA@C@A@AB@BA@@@@@@@@B@B@@@B@B@@@B@B@@@@@@@@@B@@A@@@@@AAAD@@@@@@@@@@@@AB@@@@@@@BA@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@B@@@@A@@@@@BB@B@@@BA@AD@@B@@@@BA@BB@@@BB@@B@@BBB@B@@BB@@@@BBB@@BB@D@B@@C@CB@@BB@@B@@@@@@@BA@@D@B@BA@AD@BA@@DB@BB@BD@@@@B@B@BAB@B@@@@@@AB@@@@@@@B@@@BBD@@@@@@@B@@ADB@@D@@BB@BAB@BABB@@D@@@@B@@@@@@@@@BBB@@B@@BA@@B@@@@@@@BA@@@A@@@BBA@ABBBB@@B@@CD@@@@BF@@F@@@@@BB@BBBBBB@BB@@AB@@BB@@@@B@BB@DAB@@@B@@ABDBAB@@A@BBA@@BA@@AA@A@A@@BBBB@@BA@FD@@EDABEB@@G@AD@B@BB@BBB@@B@@@@@B@@@@@@@B@@@@BBB@@@@@@@@@@@@@@@@@B@@B@@BBD@@@@@BAB@D@BAB@@@@BABB@BB@@@@B@AAB@@@B@D@B@@B@@@@BB@@D@@B@@@@@@B@@@@@EN@@@BAB@@@@@@@@@B@BB@@@BBB@@B@@@@AB@@@@BBABBB@@@@A@@B@@AA@@@@@A@AA@A@A@AB@@@B@@@@AB@@A@@B@@@@@B@BA@@@@@@@@B@@@@@B@B@@C@@@@@A@A@@BA@@BA@@AABBB@@@@BBA@@B@@B@@B@B@@@@@@A@@@BAA@@@AB@@@B@@B@@@@@@B@BA@A@@B@@@@@BBB@@BB@@ABA@B@@DB@BA@@@@BB@B@@DB@@D@@BD@D@PBB@L@B@@@D@REL@F@B@@@@@B@F@DADCBAB@FAF@B@PDB@@@@@@@LB@@D@@@DBFFBBBBFFHDB@BBF@D@DAF@B@HCBAB@B@BB@HBFBDBD@ADABABA@AD@D@@B@BD@@A@@BBBBB@@BFB@B@BBB@B@DDDDBFB@BB@BBFBFB@@DCBA@@NAJD@@@@B@D@@AB@B@B@BA@@BA@A@A@A@A@ABA@A@C@A@@DAB@@AD@@AB@AA@@@AB@B@BEA@AB@@BCBA@@BC@A@@@@DBB@DCDBDBBCBAD@BAD@D@@C@EDEB@FEDA@AB@FAFCDC@@@@BABAAGB@@@DDDBB@DCBA@@@@B@DAFAB@@ADAD@BCDCDA@ABABADEBCDG@@ECIAACAAAB@BA@CA@@@@AA@@@@@@A@@@@@@ABG@ABE@K@E@C@CBC@A@CAAC@C@C@AAAA@A@EDGFALGBABAVMBA@CA@CABGDGIEBEA@@CHBBEDC@ABABAJ@@WBI@CBCDCHCLCDAH@FCBAFEB@BBF@DBB@B@@ABEFB@BB@FB@DBBB@D@LDD@BABAB@B@@@D@DBB@B@BAD@DCDAFC@ABABABABAFADA@@@AAC@@CAAAAAIIA@CC@@A@EAAAAAABCDCACAAAEAGACBA@@C@@BIDIDG@ADEBAGGCGAAA@AAC@A@AAA@A@AA@@CAA@AA@@A@@AA@@@@@A@@AA@AA@@AA@@AAA@@@AAA@AAA@AAA@A@@A@@AA@AAA@@@A@AB@@A@@BA@A@@AA@@GAICE@IAEA@@G@C@CAA@IBEBCBM@A@CAGE@@@@EGEE@@ACIACDEFCFAFGJA@GFABIBA@CACA@AECAMAACIGKCGCCAEAACECC@@AAGGGGIA@G@C@A@A@@CCAAEG@EBC@ABA@@C@@@CAC@C@AAA@A@A@A@ABCB@BC@ABAB@@A@ADCBCCB@A@ABACABA@@A@@A@@@@AAA@@@@AA@@@@AAAAABA@@@@@@@@@CAAACDED@BA@AB"],encodeOffsets:[[115871,23224]]}},{type:"Feature",id:"440607",properties:{name:"三水区",cp:[112.896685,23.155931],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AFGJ@HE@A@ADEN@@@@@F@B@@ABAAG@@BCB@@CA@@AAABAB@B@@AC@AADABA@ABA@AEB@A@@DC@ACA@BE@A@AAA@@@D@B@@AD@@@AAC@@@AAB@BACABA@A@A@AA@CAAAC@CAA@@A@AB@@DB@@CAAAAADIE@FIC@@UEANCLIRKPABGJABKJCDABABABA@AD@BAB@DADADAD@DAHADEPADIVIVAVBR@B@@GLEJGFADCFADCLADCR@@@\\@B@BAX@B@HADELABGHABCBABKJGHAD@B@HBLAHAFCH@D@DBD@B@@@D@BA@@@A@C@@@CBC@AB@BBDBD@F@F@BDBD@BBD@BD@BA@A@@@AAAB@@@D@DABDBBBF@F@DBD@BAFA@AECCE@AFGBCAEBCDABBB@FBDBBBB@AB@BBD@BAD@B@D@BBDBB@BADDDBBDA@CBCB@BC@CAAFGBE@AAACAAC@EAE@AF@N@FZ@DBDNADAD@B@@@@@J@FDBBFF@@BBBBB@BBB@DHBDDA@D@DCC@DA@@@@@AF@@FB@DG@AC@BCDC@EDGDGBGGEE@B@B@BBDBBBDBD@@@@@@DBBDDBBDBBJDJJHD@LFLBBBBFDJRJHD@DD@@@@@BBDBFBLDVFLBDLZDVOFWAG`@@W\\SR@@ODABE@E@A@QD@@ECEC@@CA@CBGAIFE@ABAACECC@GBM@IDABA@AB@B@D@D@B@F@H@H@F@B@BBBDD@BAJ@@DDJF@B@B@BBB@@@B@F@F@F@F@B@@@B@BBB@BB@BBBB@@BBB@BB@@BB@B@B@B@B@B@D@B@BABDLB@DHDJB@BBBFF@BBF@HDJBHDBDDD@H@B@BBBBBDJFFHC@BB@AF@J@H@JBFBJDJ@J@HBFABIHGFC@GBCBA@@JAF@FEFAHAHBH@@BHD@F@HCFBHDD@FAFBHDFDB@BB@@B@DBDA@CJABBB@BGBABBDCFCJEHGDAHE@DCDLJ@B@BAB@B@@FD@@FC@ALEBABCBA@ADA@AB@@@@AB@@A@@@AAA@C@AAAACAA@Ce]IS@GLE@@@ABA@C@C@ABCBCBCBCDCBCBC@ADCBAB@B@BADDDBBBB@D@DCDABAD@@BD@BADA@C@ABCACACAAACDABABBBFB@BABA@CAACACABEBAF@D@FAHCFE@@@@BADAFABA@ABADEFAF@FDFF@@BDBBA@@B@@AF@BDDDBF@D@@@@@@CBCAI@@ACA@@ECCCCA@A@CBCACA@GAEAEAC@EBE@AB@F@BDDDDDDDD@D@DDDFBFB@FBD@DDBABA@E@CKC@AA@@A@@@A@ABAB@@AB@@AB@@CBA@CBA@A@ABCBA@@BA@@D@BC@@@ADGD@BA@ABAB@BAAAA@@@@@A@A@A@@@A@@AAA@@@AA@@AA@@@AB@@A@@@@AAA@@@AA@CAA@@A@A@@@ABABCBA@A@@@A@@@@A@C@A@@AACA@@@BA@@B@B@@@@A@@@A@@@AC@CAAA@CDABAB@CCCA@@AADCB@B@@AB@@A@@BA@AB@@A@A@C@C@C@A@A@@@@@A@@BABAB@B@D@B@D@BBB@BAB@B@B@BAB@BAB@BA@@B@BB@@BBB@B@@@BAB@@A@E@CBCBA@CDAB@BFDBFBFFBFB@DFB@BBBBB@@@D@B@D@BABABABA@A@AEGCEECAEECCCCCACCG@@@@AEBAFEDAB@@D@@F@DADABC@C@@EA@AAA@E@@AGAC@E@E@@@AB@B@B@BCBEBA@AA@AADCHGFCF@BADCBC@EAG@ABAB@@ABA@CCAA@AGEGEE@CF@@G@GDCDCBABCDC@@BABA@@@A@A@@@CEAAACACAA@EAE@IBKBBE@C@@@@@A@IDI@@@@@@QAC@GFCBABABA@@BA@@BAB@@A@A@A@A@A@A@@@CAE@C@EAIAC@C@GBCBA@A@C@AB@DABAD@B@D@@AL@B@@ABABABEDAB@GBIIEA@@@@@IAMACAIAEIJCHIAM@A@CEGEEAACACCAACGAAAAACAACK@IAEHK@CEAC@AA@@@@AA@@@@@@@@@@@@AC@@@@GEGA@ACC@@AAAACAAAC@A@A@@FEBC@AACACCAACCBG@CDEBCDE@ADCBCBA@@FE@@DCFCDC@ABCA@A@CDCDAD@@@@A@A@@@@@A@C@A@C@EB@@A@C@@@C@C@C@C@C@CBAAA@C@@ACA@AA@BEBE@GEA@@AEC@EAKDACFGHI@ADEFMDK@@@@@@@CAIAC@CCIDKB@@CAA@CAA@AA@@@@AAA@AHKDIFEBABMAA@CAC@I"],encodeOffsets:[[115589,23563]]}},{type:"Feature",id:"440605",properties:{name:"南海区",cp:[113.143441,23.028956],childNum:1},geometry:{type:"Polygon",coordinates:["@@@I@C@AAE@CAIACC@G@@@BC@C@@@A@@A@@@@A@@@ABABA@A@AA@ABAA@ADAB@@@@@B@@A@@@@@@BC@EBE@@@@FAB@BBD@@@@BBA@@@A@@@C@A@@A@C@@A@@A@@AB@@A@@@A@@@@@@@@@@@@AA@A@@BA@@AA@@CAAA@@@A@@@@B@@@AA@A@@@@@@A@C@A@@@@@@@C@QBAAC@@AEA@AECA@A@AAA@A@E@A@@@@ADC@@@@@AAA@AAA@ABCBCBEDCDAB@DABAF@HC@@B@HEHEDC@@HGDAF@@A@@H@F@JAPBJBN@D@F@HBB@@@BACE@CBEDIBO@ABADBB@BAAAAC@ACBA@ABIB@CBGBA@AAAA@A@@AAAAC@@@A@C@CBEBAJAB@BC@A@@A@AAAAA@AACC@C@C@C@A@AACAA@AC@C@ABC@ABADCACACDA@CA@@@@@BAD@@ABAD@@BAB@AFA@A@@B@@BBA@@BC@@BA@CB@@@B@D@BAB@B@B@B@B@BAB@@ABA@A@A@@BC@A@@@@@ICMB@@ABCD@@EAEAAAA@@AEACACC@C@AAA@A@AEA@AA@AAAA@@@BC@@A@AC@C@@BABABCB@BADEAA@@@CBCAAB@@@B@@A@@@@@@@@B@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@AA@@CA@@AAA@@@A@AB@BA@BB@@@BAB@@@@CB@B@@@@ABAFA@@@C@CB@@ADBBBBBBDBJ@B@D@BF@@@D@@BBBB@BDBBBABAB@@A@@@AAAAAACAA@@@CBABA@AB@@A@ABA@@@A@@B@@@BAD@@@D@@AB@@@@@@@@@BA@@@@@@BA@AB@@@@A@@B@@@@@BA@@@@B@@BB@@BB@@@@B@DB@B@@@@BBA@@@@B@@@B@@A@AB@@A@@@@@ABA@@@ABA@A@@@@@A@AA@@A@@@@@@@@@A@@@CD@B@B@@@@@@@@@@@B@@@BADAD@BABAD@DA@@@@@@B@@@@@@A@@@@@EA@@@@AAA@@@@@A@@@CBA@@@@@AB@@C@@@A@@@@A@@@@@AAA@@@@@@@ACC@AA@@@A@@@@@G@A@A@A@A@A@@AA@A@@@@@A@@@@@C@CBE@@@@@@G@@@AA@@@A@@AGBC@C@EBA@E@A@@@G@@@G@C@AAA@AA@A@A@ABC@EACACAAAA@@AA@ACAC@IBEB@@C@A@@@ACCCAEAC@BCDGBGBIBI@E@ICAAEEI@KH@K@@@M@KDM@CCE@EAA@AKOAKDE@@PEBAFGDGFEDIBEBABI@A@@BABCBEDEDEHIDGJEFCBAJ@F@L@@@@@@@N@H@@@FABAFC@@ECB@@AA@AA@AB@B@B@@BB@@AB@AAB@@@BACABA@@@A@@BA@CAAA@@@@@AA@@BA@@AAA@AAAA@AAA@@@@E@@@AE@@@@DC@@@AA@AABAB@AA@@B@@@B@@A@@@@@@@AB@@AA@@@AA@A@@@@@@@@@A@@C@@@AAABA@ABA@@AC@@@CA@BA@@@@@@@C@AA@@A@@@@@@@A@@B@@@@A@A@ABA@A@@@@@ACA@@ACA@@ABC@@BABA@C@@@AB@@@@@@A@@@AA@@DAD@@@@A@CAA@@AA@A@@A@@AA@A@AA@@@AA@@A@@AAB@@A@@A@@@BCB@@A@@@AAA@@@@B@@@@@@A@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@A@@@@@@BA@@@@@@@@@@@@BCBB@@@@B@@@@A@@@@@@@@@A@A@@@A@A@@@A@A@@@@@@B@@ABAB@D@B@@A@@@@@@BA@AA@BABB@A@@@@@A@@@A@@@A@@@@@@@AB@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@B@@@AA@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@B@BADA@@BB@@B@@@@@BA@BB@@A@@@@@A@AB@BA@CB@DB@@B@B@BB@AB@@@@@BAB@@@B@B@@@@@@@AA@@A@@@@A@@@@@@@@B@BA@@AAAA@@AAD@FGFC@@DAB@@@D@@@B@@@@@FAB@B@@@@@@@@@@A@AB@FI@@AAA@@C@@@@A@@@CG@CAI@CEFGFKHK@ICA@CAICGA@@A@@@@@@@A@M@E@A@Q@QAOAODABEFCDCFKTELKHCB@@[TA@KLIHCBKHICG@@@AA@@A@E@@@AA@@@@A@@A@@@B@@A@@@@B@@A@@A@AB@@A@A@@@@B@@AAAA@@@@@@AAA@@A@A@@@@AA@@@@A@AA@@@@@@@@AA@@B@@@A@@A@@A@@A@@B@@BB@@@B@@@B@@B@@B@@B@A@@BA@AA@BB@@B@@@@A@A@AAAA@@CD@AA@@@AACB@@@@@B@BA@@@A@@@ABAAA@AA@@@@A@@DB@@B@B@@@B@@@@ACA@AA@@CA@@A@ACAA@@A@AB@@@D@@A@@A@A@@@A@ACC@@@A@A@A@A@@A@AA@@@@@CA@@@A@C@CAEAAB@@AB@BA@@@A@@@@B@@ADC@A@ABAA@@@@@@ECA@CB@@AB@BCD@BC@ABDJAB@BBBD@HDFDFFJPHF@@BDCD@BDB@@@D@BBBB@@@@D@BB@DBDBBB@@@@B@B@DCBADAFCB@@@B@B@B@D@F@@@B@@@FAHABAFADA@AB@HE@ABCBGBABADCDCDABAB@JFKPAJAJAD@@@@@@@@@@@@@@CRADEDKLCFEFCDABIJGFEDGJEHABCJABET@HBVVF@@JD@EJFBCBBBB@DA@@CA@@B@BB@DBD@BBDBB@@B@B@BABDBABA@BB@@D@BB@@C@@BA@C@@@B@BBB@F@@ADB@BCD@@@BFA@BAB@BABCBBBD@@BA@A@ABBB@BB@@DA@AD@@BHAB@BA@E@@@@@M@CF@B@BGFI@EH@B@JBD@DBBANABEFCJGL@BBB@B@@B@@BBB@DBB@DA@CLDJ@DBDBJ@D@@@@@@CLENCF@BGJEHBDLCFBD@BF@@FB@HAFAFB@@BDB@BD@B@BBDAD@D@D@D@D@@@D@B@@@FAD@B@D@B@@@@@B@B@@@@@BCDCDCB@B@AD@BCDEDCD@@EF@@ABADCD@BCFADCF@DAHDDBBDDDBBBD@FA@EB@B@D@BBDBBBBB@@DD@BHBHF@@@@BD@@@@@@@@@@@@BB@@@@BBD@FB@DGLBF@JDLBBBDBBBBDHBBDDDBBBFFFH@D@BBNGJIDFJJBDBNBJB@@@@B@JFAJ@HBAFCBABABA@@@ABK@@@C@ABCBA@CBAD@B@B@DAHAD@D@JBFBD@F@DB@@B@B@B@B@B@B@@@BA@AB@@AB@BABADAHED@RB@@@@@@CJ@J@B@@@@@DAFLAJAF@FBB@DBDBBBFB@D@@@B@B@@ABAB@@CDADABCDCD@H@H@@BBB@BBB@DAB@D@@@@@B@@@B@B@D@B@BBBAD@BABAB@B@@AB@D@B@BBD@B@B@BBB@BB@D@B@B@DBB@DAD@BAB@@@@ADAB@BABAB@BAB@D@B@B@@ADABABAD@@@B@@@B@@@BB@B@D@D@@@F@D@@BD@@@B@B@@BB@BBB@@B@@@@@@BB@@DBBBB@D@@@B@D@NAFHBD@@@B@BAB@B@BE@@BBBLNBE@@@@BAT@BDFNLMLGB@FAD@FEBABA@@@C@AA@@A@C@ABC@AB@BABAB@B@D@D@B@B@@AB@@@@CBA@C@ABAB@BAB@D@B@D@BADCBADE@@@CBC@ABE@A@CBE@CBA@C@AAE@C@@@AAEAC@EA@@AC@@A@ABE@@@@@@@@@@BG@EAGFC@AFCBAF@@@@@H@@ABCBAB@BCBABABADADABABCB@@ABABC@@BCBCBC@CAA@A@A@C@A@A@CBE@@@AAAAC@@@AKCGC@@A@AAAA@ACACCACCEAA@@@C@@@G@@@@@@@E@@@A@@@@@@@A@@@@@@DC@@@@FCB@@@D@@@B@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@H@@@@@@@B@@@@@D@@@D@@@B@@@@@@@F@@@@AB@@@@A"],encodeOffsets:[[115930,23697]]}},{type:"Feature",id:"440608",properties:{name:"高明区",cp:[112.892585,22.900139],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@A@@@@@@@AB@@@@@@@@@@@@@@@@B@@@B@@BB@A@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@B@@@@@@B@@@BA@@@@@@@@@@@@@@@@B@@@@A@@A@@@@@@@@A@@@@@@B@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@CACBCFAF@BBDD@@@BDBDBB@BBDBDDBBDDB@BBDDDBFDDBDBFBDBFBHB@CBCBCBC@CBA@ABCB@BABABADCLIBAHIBALOJQDKBMAU@GFSBADIBAFGHIFCHEJIBADCFEDELKFCBCDQ@@@@@@@@@@@@@@BCBIBILOIEA@ABCBCDCDABABAHAD@BGFA@@BCBEBABGBEB@@A@@@E@C@A@A@A@@@A@EDCBABCDA@A@@@@@AACACAA@@A@C@@A@AA@A@C@@CA@ADCAC@@GEIOEEECGCC@AA@ABACIBAD@@ADC@ABA@@DAAAAABA@AB@B@B@BCBAB@@A@C@@@A@A@A@AB@BA@C@A@@@A@@@C@@@@@A@@@C@A@C@A@@EAA@@@C@@@EBAB@@AA@ACCCAAA@@AA@@A@AACBE@CA@@C@C@A@C@@@A@A@@@@AB@@A@@@@@@A@@@@@@@@@@@@@A@CCB@@A@@@C@AAAECA@@AB@@ABA@AB@B@@@DABADADA@@@C@A@@@A@A@A@@A@CA@@AA@@@A@AA@@@@CBAB@@AA@@A@A@@@A@A@AAA@@A@@@AAA@A@@@@BA@C@AA@@@A@@@AACA@AA@@AC@AAA@@AA@@AA@A@@B@B@B@B@@AFA@@@@BA@A@A@@A@@AAA@AAA@A@@BA@@@A@C@A@A@@CA@@@C@A@C@AB@@A@AA@A@@A@@AAA@AA@@@A@@@AC@AA@BC@A@@ACAABCAA@@@ICAAC@AB@@AA@AAAA@CA@A@@@AAAAA@A@A@AA@@@AAAAAC@@A@AA@@@A@@@AA@CAC@EA@@@AAAAAAAA@A@@@@@@A@@@A@AC@@AC@@@@B@@@BC@A@AB@@CB@@CBA@@@@A@@@@AAAAA@@A@CA@A@ABA@@@@@A@@@@@@@@@@@@BABABAB@B@B@@@@CBA@@@ACAAAA@@AA@@@@@B@@@@@BA@@B@@@B@@A@EA@AA@@@@A@@@ACAA@@AA@@@CB@B@@@@@BAB@@A@AB@@ADB@@B@B@BA@@@CB@BBBABAB@@@B@B@B@@@B@@AAA@A@C@A@@@@CBAAC@A@ACAAABCA@@AAAAAAA@@ABA@BB@@@DA@@BA@@DABA@BB@@@@B@@@@@BA@B@@AB@@@@A@A@@@BBB@B@@@B@@A@@@@@AB@BB@@ABC@A@ABB@@@BBB@B@@AB@@@BB@BC@A@@@@BBB@B@@@@B@BB@@@@@B@@B@@@@@@@A@A@@@AA@@@@C@@@@@AB@@AAA@AB@@@A@@D@BA@@C@A@A@A@@B@B@B@B@BB@@D@@DBB@@@@A@@@AA@@@@@BA@B@AB@@A@@@AB@@@@@@@@@@@@@BBBBB@@A@@@@BAD@@B@B@@B@B@@@BBB@@@@BB@B@@@BB@BA@@DB@B@@BB@@BB@@B@@@B@BAB@B@B@@@@CAEACA@@@@@@@@@@AB@@@B@@A@AD@@A@@B@@B@BB@@@B@@C@@@@@@A@@@AABA@@@@@@@@@@@B@@@@DDB@B@@@AB@@@@C@@@@BB@BBD@@@@B@@DB@DA@@@BB@@B@B@B@@@BAD@B@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@ABA@@D@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@CB@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@AAA@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@A@@@@@A@@@@@@@@B@@@@B@@@@B@@@@@BA@@B@@@@@@A@@@@A@@@@@@@A@@@@@@@@@C@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@BA@A@ADAB@BABC@CA@@@A@AA@AD@B@BB@@B@@@B@B@@@B@BA@@A@@A@@B@@@BBB@@@BA@@@@A@@@@@BCD@@A@@@AB@@A@@B@@B@@@@B@@AB@@CA@C@A@A@C@A@@A@@EAAD@BADCB@BA@ABA@CAA@@A@A@AA@A@@A@@A@@@A@@A@A@@B@BADABCBABA@@A@@@A@@ABAAA@CAC@@@@A@@@@@A@A@AA@@@@@B@@BB@B@@BBBB@@@B@@AAAA@AAAA@ABAB@D@@AA@@@@AB@@BBA@@@A@@A@@@BAB@BC@ABAB@@@CB@@@BAB@@ABC@@@AAAB@@@@@@B@@B@B@B@BA@@AA@AA@@@@@B@@@B@@@AA@@E@ABAAA@AAAB@@@@@B@BAB@B@@ABAA@@@@@D@@@D@B@BA@@BBDABA@AAA@A@CAAAC@@@AB@BA@EBA@@@@E@A@AA@@A@@AC@A@@AA@@@AAC@A@@@@A@@@C@@@ABC@A@ABA@A@@@AA@A@@@C@@AA@@ABCB@@A@AAAC@@A@@AAABC@@AA@@AC@@@A@@AA@@AB@@CB@DAD@B@@@BA@A@CAAB@@A@@AA@@AA@@@A@@@@@@@@@@@A@A@@@@A@@ABA@AAAAAAEAAAA@AA@@@@A@@@A@AA@@A@CAA@A@@A@@@ABA@@BABAB@@ADA@@D@@@B@BCD@BA@@@A@A@A@AAAAAA@@@ACAAA@A@AB@@@BA@@@E@AA@@EE@AAC@CAC@C@@@A@@@A@@AAB@BA@A@AAA@AAAA@@AAA@AA@AAA@C@@@A@ACAC@@C@@@AA@AA@A@EA@AAAA@@BA@@@ABCAC@CAAA@@AA@@@@A@AAAAAAA@@@AA@@@@@@@@@@@@A@@B@@ABA@ABA@C@CBAD@BB@@@@@BB@@@@@@@@CB@BBB@BB@@BBB@@@B@BBB@D@B@@AD@@AB@@@D@B@BA@@@@@@@@B@DDB@@@BADA@A@@@@@@B@BA@@@DD@@DABBDBD@BA@@BA@@@@@@@@@@@@@@@@@@FD@@@BB@BB@@@B@@@@B@DA@@DB@B@@AD@BBB@@BB@@BB@D@BDB@B@B@@@@A@A@@AAAA@AB@BA@AB@B@BABBB@BAB@@@@@CA@@@A@A@AD@@@@@BA@@@AB@B@B@@A@@BBB@@@@@BA@@@@@A@@@@BCBCF@BA@@@A@@@@@@B@B@BABA@@@@BABCDA@@@A@AAA@@@@BABCDGDA@C@@@@AAA@@A@AAA@@@A@CAA@A@@@@B@@A@@AAA@@A@A@@BABAD@@CBCBA@AA@AAAAAC@ABA@@AA@AAA@@A@AA@AAA@AA@AA@A@C@C@@AAACAA@@ABADABE@AAA@CAA@@CCCBE@C@CBABBDABC@AB@B@@A@AAA@A@AAA@CAAAA@E@ABA@A@@A@AC@AA@A@@BA@A@A@@AACA@A@A@ACA@CCCAA@ACC@AAA@C@C@ACCCACACCAAAC@CA@C@A@A@A@C@ABCBAAA@C@@BAFABABAB@FBBABA@C@A@@@AFBB@BCF@B@BBF@B@D@BBBDBB@B@B@BB@BBB@BABABBD@BC@A@@BBDA@ADAB@DABABA@E@@B@@ABBB@B@D@B@DCB@D@DCFAHAHAHBDDDCF@D@DBBADABAF@DBDBBBDD@B@BCBAB@DBB@F@FDBBB@FB@@BDBBH@FBDBHDHBHF@@FBFBBDBDDBB@BADADBDBFAF@DCD@B@F@@BFDDBHBDBB@BAFBB@DFB@@DBFBD@D@BBBD@D@BBBBFBD@BF@BBDABABABAD@FBBBBD@BADC@@DADAB@FDDBDBDB@@B@BABC@@BA@CAA@A@@D@@BDC@A@@B@@@BA@@@A@A@@D@B@@BDBAD@B@@@DBBBB@ABAJBBB@@B@@@@@D@D@B@BB@@@B@@B@@AB@BBB@B@BBB@D@BABDD@BFDDBABABAB@@@BF@B@B@BB@BABAB@BFAB@D@@@BB@B@@D@D@@BBADA@CDABAB@BA@A@AA@BED@BAD@D@DA@@@AAA@@BAB@DB@BBB@ABABABDD@FDBHCHEFCNEJ@FAFDBB@D@DHBBAB@DADDBD@BBABCB@DB@DDDJD@ABC@CFBB@DBDBPB@@BB@@@@B@@@@@@@@@AFHFFDBADBFBF@FBB@BBD@BAD@F@BB@FD@DAHBDDD@FDB@@@BD@@BBA@ABA@@BB@@B@@BDB@B@B@@@@B@@B@@A@ABABADA@AAAB@@@@@@@@BBBBBB@@@B@@AB@@@@BBB@@B@BB@@AB@@AB@@@BCBCDA@B@@@B@@@B@BD@BADA@@B@@@B@@FA@@@@@AB@@@@@@@BBB@@@@BB@@@D@@@@@@@@B@B@B@@@@CBA@A@AD@BADABABA@@@@@BB@BB@@@DA@A@A@@BC@A@@@F@@@@DABA@A@B@@@@ED@B@@B@@@@@D@@@@@@@A@@B@@@@B@B@B@@@@A@@FC@@@B@@CBAB@B@@@@D@B@BA@@@@@@@@AB@@ABC@@@ABDB@@B@BA@@@@@@@BA@@@AB@@@B@@BB@AB@@@BD@@@@A@@@@@@A@@@@@@@@@@A@AB@@ACB@AA@@@A@@AB@B@@@@@A@A@A@AA@@AA@@B@BAB@@@ABAAAA@CBABB@BBBDBBBA@BC@@B@@C@@AB@@@@AAAAAA@A@@BB@@B@@AAAA@A@AE@CDABAB@@@B@B@@ABCD@B@DABC@AA@@@@@@@@A@@BB@B@@BCB@@@@B@@@BABDD@DAB@B@@F@BBB@A@A@CDD@@B@@B@@BBBBB@FADBB@FAFBBEB@BADABA@CAAAE@A@@@@DEDEB@@A@A@@BAD@BD@BDBB@@BD@B@B@B@BBDHB@D@DAB@@@B@DDBDB@@B@B@BDFBFAFDDFDBDBFAB@DG@C@@BBD@DCFCB@BDD@F@F@BDBBDFBFBDB@FD@H@@ABEDC@ABAB@BBBGBAD@D@@B@DB@BBDDBDFF@DBD@@FBBAB@@D@BF@B@@@B@HB@@@@B@@@@@@@A@@@@BA@@@@@A@@@@@@@@A@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@BB@@@@@@@@@A@@@@@@@@B@@@B@B@B@@@@@BB@@@@@@@@@@@B@@@@@@A@@@@@@BB@"],["@@@@@@@@@B@@@@@@A@@B@A@@A@@@@@@@@AB@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@B@@@@@@@@@@@B@@@@@@B@@@BA@@@A@@@@B@@@@@@@AA"]],encodeOffsets:[[[115496,23554]],[[115497,23554]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
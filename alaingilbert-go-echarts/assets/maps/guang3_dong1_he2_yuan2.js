@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("河源",{type:"FeatureCollection",features:[{type:"Feature",id:"441602",properties:{name:"源城区",cp:[114.702517,23.733969],childNum:1},geometry:{type:"Polygon",coordinates:["@@EA@ACCC@@@@AAECABECAABEACBC@CBCA@@@ABCCCBABG@AA@@ECADADBBA@@@ACCAAC@C@ED@@ABCBEBCDCB@@@DBDB@D@@HAB@B@@ABABEFA@@@@@@BABA@ADA@CDIBCBA@CBC@@BADA@ABCAAACAADABGBCFEFAD@JBDABA@ABE@AFCDCFEB@BBDBB@BBB@@EHADA@BDEBDDDBBB@@@BBB@H@@BD@D@HEB@DCFCAEHC@AFABABAB@DCDAH@LINADCD@D@FA@CDFDBB@D@@DADAD@DBH@B@DDDDDF@DDDDBBDBBBBBBHDB@FBH@BAF@D@BDH@HDD@DAFBDBBADAFDCFBD@B@@FD@B@FAFEHD@BB@FCBADADABDDFHDB@BDFFBHBD@HDDDADABAFADGFABEDADAFBBB@F@DDDBFBDBBBAB@DDB@DBBD@H@D@BCD@FADADBBCD@JNBB@@B@DCH@BFC@AHFHDB@F@DADAB@BAFBDDDBDDAB@DCDADBBBBFDBBDDB@DDFDAH@HABBFHCBEAAAEBADD@B@DFHCFA@DCDABE@BFDDD@@B@B@BC@ABABBD@BAD@FF@BD@F@BBDD@FA@@D@FB@CBADBD@ADFB@CBABAF@@CACAABCACD@BCBADAB@DA@C@A@ADCBADCJCFGDC@CC@AE@C@EBABAACAACD@@@CCACABABGC@@CAADADCDIDGBEBADBBABAEK@AAAAGAAAEFAAADAB@D@ACFADAFAB@@EHB@@FEF@F@BEFCDCDCACC@CE@@E@IAAC@C@A@@BAGGAAACCCACEAGAEAA@AAACCBCAECICC@@DABGBA@CCACAAECEACGAECI@IAGCCEIAAACEGACAECAACA@@AEGAK@AAIBEBI@M@EBIAK@C@E@O@KGICAGEIAKEEAACAC@GAEDI@ABCA@CAC@ACCAADAA"],encodeOffsets:[[117398,24126]]}},{type:"Feature",id:"441623",properties:{name:"连平县",cp:[114.488556,24.369583],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCCAEAG@EBEDEBE@OCICE@OBA@@@AHAD@DABAB@D@DBFBF@B@DA@@BEBCDEBCDGFEHAB@B@DBD@BABCDCBCFEFMFGAGAEEAC@@@A@C@A@EBCDCDCFEFEDCDCDAFAFEDC@E@G@@@CCE@EBCBCACC@K@IBCBCDEAEAGAEACIAAEECAACAAAAACCAE@EACBAD@FAJAJCFEDC@@@@BA@@B@B@@@FBF@DGDA@@BCBC@ABABGDEFEBCDAD@FAFAH@@BDDDFFJDHBJBJ@JB@@F@HDFFDHBH@FEFGHABCD@D@DBF@DAD@DBFDFDFDF@BADADABAF@FABABEBEBGAG@KDGFCB@@CNEJCDA@CAEIEMCEECEEECEAICGAGCECAAAACAGCAAAA@C@C@@AAAAECCCIGGECAC@A@AAAEACACA@@A@AACCAAA@AAC@ECEIECACBBFADABG@IE@EBCBED@D@DB@@D@@C@A@I@G@C@CAECAC@CAAADADAHCHCBC@CBC@ABGHEDC@C@@C@AA@A@CAAAAA@A@ABCB@@CBAAGCAAC@A@@BABADCBC@CDEBC@ABABABA@@AC@C@ABA@CB@DAB@@AB@B@@@B@BABABAD@@ABA@A@AAA@@A@@AAC@C@A@A@ABAD@FBBAB@B@@A@A@A@@AA@@AC@A@E@K@CBC@ABE@EBA@C@ACCAA@ABABC@C@AAAAAAIGAAEAGBGFADEDCBABCDE@CCAACACCAAA@@EACAGACEAC@CFAF@HBFCDCBCFEDEBCBGHEJAFADCJGHIFIFCFAHBFBFFFDDDD@HAFADCDMFKFI@EB@DBDBBBDADAFGNEPAF@@GJOHED@DBDB@PBP@F@BDADI@O@I@CDBFJFLDBDAFAD@@BJHFHL@@HNFJDJ@FADGHEHAD@DBFDDDHDDBDFDHDDDFHBF@FA@A@GJEHCB@BCBC@Q@OAICGAE@AB@BBD@DBF@D@FAHAH@JDHBJFHAD@BCDCDEDCF@D@FADCFAD@FDDFDFDFFF@F@BBDBDDDBFFHHNHJFBHDHDFJJLDPDHFBFADGDIFELEDKHGFEH@BADADAD@H@JAFCDGDCHBDBBFBHBHBHBBD@BADAFBBDDDFBBB@DBRDHBDDAH@HADAF@B@D@@HBHBLFDBBAFEHBHHFFF@FADE@EBCB@DBBD@BADABCBAFFFHDF@@@@AACAE@A@AB@B@@@FB@@F@B@BA@@BGBABAD@D@DADCBABA@C@CBEDCF@HBD@D@@@B@B@AAAGAGACAE@A@ABCB@BGAE@C@C@ADAXGBABGBEBGAEACAIAG@EAC@A@@BAD@DDDDBBB@B@BCBABCD@D@D@DABABCBABCBCBA@ABADBDDJHHJBBDBF@HBHDFDDD@@DDHBHBDHBHDHFJBDDBFBF@FDFDDAF@D@B@@DBDBDDDHBH@FDHDHFHFBDDFDDD@FEB@HIDIFGHAHAHCJAFBFFB@DBB@FAHAHCHAF@D@DDBDBFBDDJDFFFDFDHJFJHFDBBFDBB@@D@BCBAFCFCB@DBHDFDJDJBFDFBDDDBB@FEFGBA@AACAADIFIFOJSFI@AD@H@H@DAD@FKFKAAB@DADABA@@BEBC@C@A@ABA@A@@CCAACGCEAE@GBG@CAECECCECCACAAAA@A@@ADADCHEFEDGFGBABCB@B@HEJAF@DBBDBABABGDEDCHADAD@@A@@@E@E@AB@DCBABEBCFEFEDABACCECECECGCCCAA@@@CB@@CBABCBEBCBC@CBADCFABA@AAAAACAECA@AA@AACAA@C@CBAFEJED@DADEBA@AACCGGEAAAEFEJGFEDIAGAEEG@E@@@@@A@KAO@CAEEU@ADEFCL@NDJDHB@@FADADCFCDEDCFCD@F@FFDD@B@D@H@D@BCJAD@DAD@D@D@F@BBFDNJPJBFCFGBABO@E@C@ABKBCBABCFAFAF@B@FDDDBF@J@HBFBBJDLBD@D@FCFC@ABADGBKBCDAFAH@DABABCBC@E@E@ABCBAB@DBDB@BBBDBFBJDLFB@DBDDFFF@F@DBDBB@@@@ABC@A@@CC@A@AAGBC@EBA@ABAF@D@B@BABABC@IBIDGFGEECCMEMCMAM@CAC@A@A@EAC@AAEACC@C@A@CBC@C@A@EAC@A@A@E@CAAAACEAAAACICGCGCGCEAAACCAC@A@@@AB@BCDA@ABAFAHAFADEBE@C@GAGCECG@EACIBGFGFEBGACECEAQ@A@AAEEECAAAAAC@AACAAA@AAC@C@EAC@AAAAACAE@A@A@A@@C@C@EDEFCBEBA@ABA@AAGCECCCCAC@CBC@@@EAAEECCAIAMACAAA@@AA@A@EBCBAB@@A@@CCCCCECEACAC@AAAC@@@A@CBABA@ABA@A@CE"],encodeOffsets:[[117469,24695]]}},{type:"Feature",id:"441621",properties:{name:"紫金县",cp:[115.184107,23.635745],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@C@ABCAC@AC@AA@C@AAC@@BCAAA@CA@ACA@A@AAAAACAA@A@CA@C@@AAC@@ABC@CDAA@AC@@@C@ABAB@BAB@B@B@@AB@AABAAA@AAC@@DABA@@@CA@AA@A@AA@CAACC@AAACAAAA@@BA@A@AACAACCCC@A@@CA@@@@A@A@C@CA@B@BADCBCBCAC@C@BDADCD@BCBABBB@BABA@AB@DAAAB@BAB@BABAB@BABC@A@C@CBAB@B@BCBABABC@ABCBAB@D@@@BADA@A@A@C@A@@BABC@C@E@AAABA@BB@BAD@D@BBD@BBBAB@BCB@BABABBBB@BDBB@B@BBBAB@BABBD@@BBBBDBBA@BDBAB@D@DAB@D@B@DADADA@CDBBAB@B@DBDBBAB@@ABC@AAA@A@@@CBABADABBBBF@B@@CBCAAAA@CBCF@DB@@BDJABDDBBFDBB@DB@B@@DDD@DBBCBC@CB@BBBB@ABAD@BAB@BBB@BCBE@ABABA@A@@AAAA@@@EBC@BD@BAFA@@B@BABGBABBDAB@@C@A@CACDABEAEACCABAB@B@BBB@BCB@BA@ACAAAAA@AA@AABAA@AABBDAD@@@F@@@B@DCBA@@AAAAB@DBBA@ABABA@@DC@AB@DBBBBDF@J@BCBA@CBCAC@AFA@A@AB@BAAA@AAEBC@CD@BB@BBB@D@B@DDADA@A@A@@BBBADABBB@BBDCBAB@D@@DB@BAFCBCBEBABBDBDB@B@@B@BDB@@B@DD@BF@DBB@@BC@CBCBC@G@CDABABCBGDA@CA@AE@ABABCAA@ACABC@AAA@AACBAA@AAA@A@ACCC@AAABBB@B@BAB@BA@A@@BC@ADA@@@A@AA@AAA@CAC@A@ABCBA@C@AA@CBA@CBC@A@CD@@@F@@C@ABA@AAAB@@BDABC@@BAFH@DBJHV^TXHJNJFFB@B@HBB@FBD@FBLDDDBHAHAB@@CDGJGJ@@ABCLCHADBJBJDFBDDDHD@@FF@BFFBFAD@@ADEFABCBE@MCCAE@G@GBGDABEDCF@D@LALAF@FCJAD@BCJBF@HBDBDFBLFJBHFDBHJ@L@P@F@DBLAJ@F@NAJAFBJ@BBLFH@BB@BDDBBFBDFHBDBBFJDDBH@JDJBFDHFBFDBBBDDDB@HABA@CD@JDFDDBDABDBBB@FBHBFBBDDDBDBBHHAB@@@B@DBDJBF@@@DFD@BDDDBD@@FF@BB@B@DCD@BADAD@DBB@BCB@@@DDBA@@FDDABABABB@FDBBADCB@FBBFFBFBFB@BFBDBDDBB@BDCBAD@FC@EFDDABAJDFDDEFCBA@C@C@CFDDBBF@@FBFCBABC@ABBF@F@DFF@DADBD@D@BADB@BDDD@F@DCDBBB@@H@HBBFD@D@BABEBAJCJ@JBFDD@DAB@DAF@BCBA@CBABAFCD@HBHB@@@CDCAAEE@E@CB@BBD@DB@CACDABEDFDCB@BBDBBBDAF@@BCBCBBBDB@@@DAB@JDFDBAHFDF@DBBDLDD@FAHHHHH@B@DABAFADA@AACDEDC@CD@FAFBFBD@BAACBGDEBG@E@@FALAD@BEBCHCHBDCF@DEDA@AB@F@N@BADC@EFEDEACAAACHGD@FAD@FED@H@HADCFBBB@@FDFBB@BA@CFAD@DBB@B@BALNBDB@FAFAB@@DADDHBDFBF@F@DBFADFFFD@FD@DHBD@DAB@D@D@FBBFFDB@BDDDDBBDGB@DCD@DCD@BBFDBB@B@BBDFFC@@DAD@FBBFFDDAD@BB@D@DHBDBHHDDDABCDBJ@DBBAFDF@HAFBF@D@@GBCFCB@@@FADCNBB@B@B@HBFFFDBDBBBDFBBD@@FBF@D@@CF@B@@ABA@AH@BAHA@@BC@@DBB@D@DBB@HAHBD@B@FAH@D@D@BCF@DBDDDBD@DADBFDDADCFD@FBBCBAB@DBBB@B@DBBB@BHFBBB@BA@BB@BBBA@BBB@BD@B@FABCBADABA@C@AB@EICA@AAAA@@CEA@CC@@CBC@CCAABAAAAFGDECCBABC@CFAB@BCD@BADCDCAA@C@AF@DBB@B@FAB@B@D@@C@C@CBEAIACEAAEDEDAD@BADBBADABCB@DBDAFCFBAF@BA@BDB@BCB@BBB@D@DBDBFDBF@BBDBABCH@D@FA@AAABC@A@ABGC@@C@EAAAA@C@A@CAAAC@ADC@@B@@ACCAC@@BCBE@EACC@CAACCC@E@A@ABEECAAAC@A@CCCA@AAEACA@@BEBEBCECAAA@AC@ADG@GA@BA@AA@@C@A@CC@EEBA@ABEBADA@CAA@A@GAE@EAEBCDCBABCDCB@BABA@AACAAAABABAAC@@BC@AD@LEFAD@BAAAACAGCCACAAAA@AABAACCAAFE@@@AACBCBCACCBAFADCBAC@A@CBCBCAA@@EA@C@E@EDC@CBAD@@A@CDA@@BCAA@CDCCAC@CCCAAAIA@DCAAAC@CBC@AACCAEBEBEB@B@DADA@DD@D@@AAABADA@GAA@@@@A@@EE@BA@ADCF@F@BBBICEF@BEBA@C@CDCBACAFCCA@CBAAECABCDEAEC@CA@ADA@@BCB@D@BCD@BBB@@@DAD@BE@CHCBA@C@A@A@C@CAG@AFCBAB@@ABAACAGACAI@EDEFCFGLAH@BACAAAAC@AAABA@ABCBAAEAA@E@CBE@EBC@@@CBC@AAAAAACD@@A@ADABAAACECC@ADBD@BA@@BCBAF@D@BB@BD@FAF@DADAD@BCA@CACAC@A@IGA@OCE@KCC@C@CDAAE@AABCDABEAC@ECACCA@CEAA@@@ABA@@DCB@D@BAAAAAAA@@CC@@CACACAA@AAA@A@ABG@A@ABC@GCE@@@AAEAAA@A@ABAD@BBB@@ADE@AAAAAA@AA@CCAAACC@AB@@AAAAACAAA@C@AA@AA@EBEBCB@A@CBE@CDABABA@@BC@EBCFB@BB@B@D@DBBC@AFABBB@J@BABC@A@@FHDEHCDAD@B@BADC@@@CAAB@@A@AB@BCBCCOPBLAAA@AAAAC@C@G@AFCDIBCBCBA@ABE@AA@BAFADAF@@CBCDE@ABABCBAAECEAACEAKI@@GCCAA@A@@BAB@BABBD@BCDBB@@@BA@@D@@DD@@A@CDE@@DBB@D@HBB@FBBBH@DABAAA@@BAB@BB@BBBBE@@BDD@B@B@@@DBBB@BFCBAACACAC@C@A@AA@BABABA@@@@@@DA@CB@DA@@@A@ABAA@D@BABA@AB@AC@AB@D@B@@@B@@ABAB@@ABCBC@A@@BA@ABA@BBEBADAB@@@@A@CAAC@AA@A@C@CBG@C@CAAACAC@AAAACBCBABA@@BB@ABBB@D@BC@CACAEAA@CBCB@@AACAA@A@A@@AAAAAABA@ABACABAA@@AACA@@EBC@AB@BC@A@@@AAA@ACCAA@@AAA@D@@CBABABA@A@A@CBA@@@@@A@A@A@ABCAAACAA@CA@AAAAC@CBADCA@CAC@A@A@A@@A@AAACAA@@AAACB@@@@C@AAAAA@A@A@@AAB@@CBA@A@CACBABA@@@@BABEBAAABC@C@@BA@CBA@ABAABCAACAGAAA@AAAEAA@EAAAC@A@CAA@BACACACC@CAACACBACAA@@@@AAA@AACBC@A@CBECCC@C@AAA@CACCC@ACBABCAC@C@AAAEBA@CCCA@ACC@A@CBCAA@A@AFA@AAABCBADA@ABC@A@ABA@CBGAA@@@ABA@EBCAABA@A@A@CACBEBCBAABA@A@A@A@A@A@AAAC@AABACA@AECAAACAABCBA@ABAAACAAA@A@A@CCA@CAA@A@@AA@@@@A@@@A@@A@@@@AA@@A@@@AB@@@@A@@@A@@A@@A@A@@@A@@@@@A@@@A@@@A@@@A@@@@BABA@@B@@AB@@A@@B@@AB@@ABAB@@@B@B@@BB@B@@@D@@BB@@BD@B@@@@BB@@@@BB@@@B@@@B@@@@@B@@@@@@AA@@A@AAA@@AA@A@AA@BB@AB@@A@@B@@@@A@@B@@AB@B@@@B@@AB@@A@A@A@@@@B@@A@@@@B@B@@@@@B@@@B@B@@@@A@@B@@A@@@@@@@@@A@@@@BA@@@@A@@@@A@@AA@@@@@A@@@@A@@@@@@@B@@@@BB@@B@@@B@A@C@AB@@@@A@@@A@@B@@@@A@@@C@A@@BDB@B@DFFBH@DA@@BADGB@@@BC@A@AD@BAAAAABAAAAACCAAA@@CBA@AACAAACAA@AA@AAAE@C@@@CBA@A@CAB@@C@A@ADEAC@CB@@ABE@AAI@CB@D@BAAC@AA@AC@AAABA@AAAA@AC@C@C@A@@CA@ABA@AAA@ABCAE@A@CDA@A@A@C@@@A@AAAACAA@@CAAAAABAACA@CAAA@CAAA@@EAAC@@@AAACAABCBA@CBCBCAAA@CAA@AACBAAAAABAAA@CAG@AA"],encodeOffsets:[[117637,23799]]}},{type:"Feature",id:"441622",properties:{name:"龙川县",cp:[115.259871,24.100066],childNum:1},geometry:{type:"Polygon",coordinates:["@@EDGDMDMNEFCDGFCDGHAJCD@BCBCBEAEBEBCD@B@BIFCF@B@FGHAFBBBF@D@@EFA@AB@DABEBC@CJ@BCH@H@BB@D@H@RDNFHDDB@@D@DBHFHF@@JBHCBCDBFFJFHDHAB@FADAD@DBBDDDDHBDBB@BBH@DBDDBDDJDHBJ@D@D@DBBBF@F@B@DBH@B@@@DADCDCDCFCFAH@HB@@FBFAH@DCFCLGFEFEFAD@DDBFAH@HAHBJ@BBDFHFJBFDHB@@@@@@@B@BABABAHAHBFDB@@@HLPVLFLAVOFAFCJGJEDCBAD@DCDCDABAHAH@HBBBD@JAHCBADADABGDG@CBCFAHABCBAHED@@@FA@A@CCCAAAA@@FGHCDCB@@@@A@EBGBCBAF@F@FB@@B@D@BB@@B@B@BADABA@CACACAEACAA@@@ABABABADADA@@B@BAB@@A@A@AB@BAB@B@B@BAD@B@BAB@@AB@BADAFADADAB@@@@@BA@@@A@A@C@C@AAC@A@C@ABA@CBABC@ABA@ABA@CAE@@@C@C@AAA@@@A@A@@@A@ABA@A@AABC@AAMCACAAEAK@MCCAGGEAAA@@@A@A@E@A@A@ECCAACEGECCEGAC@A@CDGBEDE@IBEDE@A@ACAAAECE@C@EAA@CAEAA@@A@@BAB@@A@@B@B@B@@@@@BA@@AA@@BCB@@AAAA@CAABEDABA@A@@@@@A@A@C@A@A@@@AA@@BCBG@@ACBABEDC@A@EAA@AAC@CBABGDEBA@ABG@ECGAACC@EBAFCDC@A@C@ABG@A@A@AFEFAL@D@HBJ@FAHAH@FAB@BAB@DC@CBCBCDADDFDJBHAB@BAAE@AACAC@@AA@A@A@ABE@@@AB@@@DBB@B@B@B@@AB@@@BB@@@@@B@F@B@DDBD@D@DABBD@B@B@BCDCBE@GAE@A@A@ABCD@F@FBDBDBDBDABA@CCCCCAAAABCBACECCEAECC@CACEAC@A@EBC@C@AFCFADABC@CBE@KAKEICECCGIAAECA@A@ABA@AAGCA@AAAGACAA@G@ABGAECGAEAGDG@CAE@@@EBCDA@EAEAA@ADCFABADABA@AAAAADC@ADCBCBAAE@ADAHADA@C@C@CACEACA@CBCBAJADCFCBC@A@A@AAABABABABCACACCCAC@CBEFEBCDCBAFAB@D@@@BABC@O@C@ACCA@A@CBE@C@CAC@@E@EA@C@C@CAACAC@CBCF@@AB@@C@A@GAKAK@GEEIACBCDCFA@AAACCECCEAGAEAA@AAACAEAG@ABGBCD@D@B@BCBE@@@AACBAC@CBCBCDC@A@CAA@@D@BC@CDABEBA@CFEFAFCDC@G@AAA@CC@@A@A@@@@ABCACB@B@DABG@@BCBAACACAA@AACBCDCDAB@AEAC@ABADCFADEBCBEBCA@A@A@EBC@C@AA@C@A@AFAH@F@DA@I@GBA@@DCD@D@DADC@CAC@ABADEFE@A@AEECECCAECAC@CBABE@G@C@A@EDADAD@F@F@BABCBCJAJCJABABCCAE@CAAEAE@GDA@EFAHDJBBBBBD@DCBCBC@E@CAA@EEGGACCEFC@@EAC@CBA@@@C@AAAAAACBADABABA@A@@A@A@A@AAAA@A@A@AABA@CBAFGDG@A@CCACAGCECCC@C@E@EAC@CCC@AAE@CAEACAAC@EAC@CAACCE@CHEFEDOBAFCFCFEFGDADABADA@E@C@CBC@EACAC@CAA@CBCDCDABA@C@E@AAE@A@ABABCDAECECBCDABC@CBCBA@AACAADC@C@CAA@CBCBCCAEA@AECECEAAAA@A@C@AAAECE@CC@CBADABE@C@ABAAE@C@@@ECCABC@ABCA@AC@ADEB@@AA@A@CCAA@CAAECAAACBCB@@AAC@GBAB@@ACABE@AAABCB@CGBCCCE@EA@@@AACEAFCBC@AA@A@CA@@@CAADCAC@ABAAC@ACACBABC@A@A@EC@CAA@GBA@CAA@@CAA@@ACAC@@A@C@CBC@ABABCBABCACEAC@C@G@IC@BABCBA@EBABCBCDA@IDGBEB@BCBC@ABAD@B@B@B@B@BBB@FABCBA@A@AACD@BAB@@CB@B@BCBAD@BA@ABABADAFC@@DAB@BC@CDEBAB@D@FBBBB@B@@@BA@C@@BAAC@ABC@CAAAEBA@AB@D@BABABEB@BBBADABC@EB@BCBCBBDBDDBBB@D@BEBCCG@@DED@BBBBB@DCBADA@AAABE@E@A@ABC@ABA@@BBB@BABCDABA@C@@AAA@@CCCA@C@C@A@ACB@@EBC@C@A@AAADCBABAAAAAA@AEAABA@ABCAA@A@CCC@ACCAC@@AAAAAA@@BBBBB@BABC@AAA@AD@D@@A@A@AAA@@AA@ABA@@BC@BB@BAB@@A@A@CBAB@B@BAA@@ABA@AAABAB@B@@AB@@EAC@A@@B@DBB@B@@AD@BDB@B@DBBBABBDBBB@@A@@BAD@@BDDBB@B@@@DD@B@D@B@B@BAAA@A@ADA@C@CAC@AAAAA@ABABADA@CB@@@BCBA@@BADC@AB@D@@@BA@CBCB@@@B@DABA@CACBA@C@@BAB@DBDFFABEH@@@BDBD@FA@@FBFBDABABEDEDCDCDBD@D@DAD@DBBDDFADAD@@EDCBAD@BADEDKF@@ADA@ADADAFABADABABCDADAF@F@DABEBGAGBGBCDADAH@JABA@A@A@CAC@BHBH@D@FCBABCBCBA@@D@B@DBBB@DDDDDD@DA@ABEDEFCFAH@B@D@HBD@BCACACECCAC@CCCAAC@AAI@I@IAICECE@GAEDAB@DAHA@CDA@CD@BBBBDDBDDBDDDBF@HBF@DBBDBDBFDFDBBBB@F@H@H@HDH@FBDBBHDFBHDBBBBDDBBBDB@B@B@BADADAD@DDBBBDBDDBFBH@FBD@DBDBDAD@BABAF@D@DBB@DADADAFA@@DADBBB@@FDFDB@BBB@D@BBBDBBBBDBBDDBBF@BBDBBDDFBF@HBB@F@H@H@L@DHJNHNBHDBDBBDBDBDDBFBDB@@@N@JCFABBF@B@D@BABAB@BCBE@CBEACBIHIHEBC@G@E@EACBABABBBBB@BBBAB@F@D@F@B@D@@@B@B@DADCBA@C@EBCBCDAD@D@BDBBDAD@FAB@DADAD@@@DBD@D@B@BABA@CBA@AB@@@B@BBBDDFDBFFDF@F@F@DBBBBBB@BBBADFDFFFHHDHBJBL@B@D@DBBBDABA@AB@DBD@F@FAD@H@FBDDBF@F@@B@D@F@BBBB@B@HBFDHDFFBBBBCBABCFCD@D@HA@@BEBCB@F@F@B@BABBDB@D@DADAB@B@ABADABABEDEDEFAFCFAHAB@B@B@@@@B@BBDBBBDB@BBBBBDBBBD@BBBDBHBH@DABCFEBCBC@GBCDCD@D@DBDD@D@D@BBBB@FAB@BCBAB@BDBF@LALCDBBBCDABABCBGDKDKFGFCHCB@B@@@HAB@DABIHBDBDF@FABDDFDFDDB@FBBBBBD@DBJ@D@D@BBB@BF@FAJAJ@FBBFH@BDBDFFLFBNFJFFFFB@B@@CFAB@BMFGFA@"],encodeOffsets:[[118028,25217]]}},{type:"Feature",id:"441624",properties:{name:"和平县",cp:[114.938684,24.44218],childNum:1},geometry:{type:"Polygon",coordinates:["@@GBE@EA@@A@G@GBGDEBA@CBC@A@CDADCBAB@@@F@BAHEFEFGFEDC@AAECEAECEAEAIBMFABABCDADBDBDADGF@D@D@B@@BDCFADADAD@F@DABABCBEBG@GBCBCBAB@DADADCDCDC@CBCAA@EHCHAJ@JADABABA@C@E@AB@BAB@FADBH@B@BDD@@@BAD@B@@A@CACAE@E@EECCCAA@KEICEACAAA@ACACAA@ABAD@B@F@FADADABCBG@EBCBADALCHAB@BEDEDC@C@KAICAAAE@G@IAECCECA@E@EBEBADABADAL@B@D@FAPABEHEDIAIOCMAE@A@E@C@CBC@CBCDI@A@C@G@C@ACCEEE@C@EDCDCFEDCDCBEB@@GAICMCK@EDCF@BFVBF@DBP@L@B@@@@@FFHBFBHCJEFIHEFBFBBHFDHBD@BABCFCBC@IFEFAB@D@DBBBD@BBBB@FDDBBBBB@BABEBCDAB@DADADAFADAB@DA@@D@@BBDDHDFDFDFDDDABCBEFEFADAFABCDA@@B@F@F@@@BC@CBGBCDCFAHABABACCAE@IBGFA@A@ADABEHCHEFGFCDCB@BB@B@BBDBDBFDDDDFBF@DAH@HBFDFDHBBDD@@@BAB@B@B@DADAF@@ABCBCBA@BBDFDHDHBJDFFFAD@DBDBBD@D@BABADABCFPBFDFB@D@BBBB@D@FCBCDAB@BBF@BBBBD@B@B@BABABAB@@ADCF@BBDDF@DADAJAB@BBDDDF@D@F@F@F@DB@BDBB@BBDAFAFEFEFCFAD@DBF@D@DBBF@B@B@@D@F@HEFC@@BC@CFCD@B@@@BDBBB@B@B@@@DABCHEDCFCBCFCFA@@DA@@DAB@BBBFFH@@LFHDHBF@FCJCDAB@@CACCGCIACBCDADEBEBEBGBADCDABAB@DBDBFBFDDBFBBHBHBD@D@@D@D@@@@@DBBBBD@F@H@DDFFNBDDF@DAFADABAHBFBHDDDBBDBBADCD@B@BFFFFAF@B@D@F@FDLFFB@LFVAJAD@AC@A@G@GBEB@DCLBJDJFFFDFDHBBF@FBFDFDHDF@@@F@BBDAH@JDHFDBB@BABA@CBCDAH@FBD@FABA@@@ADAD@DDBFFHLFNHFHBDCFADBDFBHF@F@@@F@@BDB@@@B@AA@AB@@A@@BEB@B@BAB@B@@BD@B@B@BBF@F@D@@A@@BAA@@C@A@@BC@@@@@@B@BB@@BB@@@@B@B@D@@@@@BB@@@@B@@A@@@A@A@@@A@@@@BCDABAB@@A@@@@AC@AA@@AB@@A@@BA@@BC@ABABABA@@@@@A@A@@@@@@B@@@@@@BB@@BB@B@@@@BB@@@@@@@@@@@@A@@AC@AA@@A@@@CB@@@@@H@F@@@BA@@@A@@@CBA@A@ABBD@DFD@HA@ADABEDAFBDDBB@@@BB@BB@@@B@@@B@B@@@@A@AA@@@@AA@@AA@@A@@@@@@B@@@@@B@@@B@@@B@@@B@@BBB@@@@@B@@@B@@@@@@@@B@@AB@@@B@@AD@@@@@BB@@@@@@AB@@AB@@@B@@@B@B@B@@@@@@@BBBB@@@@B@B@@@B@@@BA@@@@B@BBDBBDBBB@DFBBH@HAFB@@@@@B@@B@B@BB@@B@@@B@@@@@FBDDJ@VAF@HCF@B@B@FE@@@CAEAABEHG@E@ADEJE@A@ADCFAFAFBDADA@ADCBIHGDCHEDCFENMNCHCFCB@HENE@ABADE@@@AEAEEIEMEEAEKCECA@AEGAA@EBIBI@EAEA@AAC@C@I@CAC@AAAAEAA@CCCECEACEBE@ACACJGBA@CBA@G@@@ADADGHELELCHCDABABADCAACAKDKBE@CA@ABADA@ABA@EAAAAC@C@C@AC@C@CDCDCHAD@DAFADEBA@CAGAGACAAC@AACAAAAA@ACAAACAAAA@@@@@@A@ABABGDEBEFEFCFCBABABCBAA@A@CBCBC@A@ACBA@A@A@E@EDAFA@AB@@G@CDCDEBADAAAAAEEGCECGAA@A@AA@A@E@C@AE@E@CAAC@E@GBC@E@EAC@CBAB@BAACAA@C@C@AAKAICGGGEECECEABAAA@AAAACAE@E@E@ECAEECCCAA@A@A@@BAB@DAB@BA@A@A@CAC@C@@BCBC@CBA@EBCACCA@A@CBCDCDAFAD@B@DABC@C@A@A@@@C@A@E@C@EBAAA@AAAAABABADAFBF@H@D@FAJGJGDAFBDAF@DA@ABABA@A@C@AAEBADE@I@M@@CAEACAACACACCACAAGGMIMCGK@G@G@E@A@GAE@EACCAAAC@AAECAACCAAAAAACAAC@A@AAA@ECEC@@AACACB@@EBCBCBCBA@CAC@E@ABABC@CBCACAC@EAG@EACAACACAACCC@CBCBABA@A@A@ACAACCAAAAGCEAGCAAEAICKAE@A@CB@B@B@HAJAJCD"],encodeOffsets:[[117823,24759]]}},{type:"Feature",id:"441625",properties:{name:"东源县",cp:[114.746344,23.788189],childNum:1},geometry:{type:"Polygon",coordinates:["@@AE@ADC@CDC@CHAACCACCACA@ECAEEAC@C@A@CBC@GA@CECC@EECEEBCAE@E@EAACCGBC@CA@EBEBA@ACKMABA@A@CAC@EB@DABA@EAEC@@AAEACDGBG@C@EFC@EBC@GHBDBBBDCFEF@FCDABM@E@A@@BCBCFE@CDGAGDADAFC@KBEB@@@FAHCFAHBDABC@EAEAEBC@@DCDCFBD@BCBEBABCBA@G@GGGGEBC@KCACCAE@ECBGCACE@IBA@C@@CAAADADA@AE@CBAACAAAA@CDCEAFCBBD@DCAC@AAA@@D@FFFBBCD@D@@GAGAC@EDABAB@DABADE@CBA@CBC@ECIAI@IDABAFABC@C@AEGAG@@@AACACDE@C@CC@ACAABC@C@CACBE@CEE@E@AA@BADABEDEA@@AECAEC@D@D@DABEDCFECICABCBEC@FEDC@ABCD@AAACCCAEA@AEAEAEAAEEAA@CDABCA@EAAABABCBEC@@ABCC@@A@ADA@CAC@CBABC@CDA@A@@AEE@@ACCCCDCDEDAFE@E@EF@@GA@FA@EBCBEBBDC@A@CBBBEBBFBBBHBB@BFLABABCAABAFCHCJCDCBBB@DD@AHABDBDB@D@@DCBBBDABAB@F@DBFD@@DCDEHIDCDABCD@B@B@DCBA@CBABADC@BDADBBBD@DE@ABAB@DEABCC@CAAB@DEAC@@@EBC@AC@A@EACE@@EBC@AACBABAD@@A@A@AC@CCAEF@BADC@CEBGDCEA@C@BCFABBFBDAEGAAGBG@CBCE@CCAACCAAEAACACBCDA@CBACCCACBE@ABABC@C@ECAEGBGD@AEG@CDA@@@AAIMC@ADCACBEBC@ADC@G@C@AA@CCA@CBAAACAEACACCE@A@AABEBCFCBAHEBCBEBABCCCGCC@GAEACE@ACAEGCCBABCBCDA@EAAC@FGBE@E@AEC@@@AACDEECCBABCAEACBC@GCG@ACC@E@ABG@EAA@GCAAAAAAACCACC@CCECCCCA@G@CAC@CBCB@@@CAAECEBCAE@@@ELADDDAHABABCFADBBA@C@EBEDABABG@A@ABABCDEBAAE@CBABADEAEBGBKECAGCEAGAGCA@AACCAACAGAA@A@CBCDA@GHACCB@@EA@B@DBB@DAD@D@B@BAD@BAB@BABCBA@A@@BCCGCI@GDCDBDCLADAFCDAFELABADABK@@DEFA@GMAAAAACAAAC@A@CAA@@@CC@ADABC@CBCD@DABA@CDBBBBFF@D@DAB@BABA@A@G@KBIF@HBBBD@B@@ABABCH@BAJ@B@DFFBDBBBBBBBBBBBD@BBD@B@DABEJE@AJDDCHE@KBAD@B@DADADEJD@@HCLDFDJ@D@J@F@FFDDBDD@@@FBBFDFFD@FBDBDAFDB@@DC@C@EFCDABAB@BBD@F@BFFBHDBD@B@DBBDDDBDBB@D@D@BCBCB@BDDH@BBB@BBBBBDCDDNBFFBD@JBDFCHAFBFCB@HBHBBHDDFEH@HBDBDBBB@BBD@AFCBABCHAF@DDF@B@J@DBAFAFADDKHADAB@DADBDBFFHFBFBDBDDABABCD@D@B@@DBL@JDH@FCDGFCB@BJDL@@BDLBNAFBB@@B@DAHCJCFAFBF@@@BBDAFBDBDB@BBBDBBBBDDFDHBDBDBH@F@FDDBFBHDBBDBFFDHBHDFBDFDFF@BBDCDAFAD@@@FDDH@BADAFGFGFCB@@ABBBBBB@@@@B@@BHHAFFJJFFJDNFFF@@@BEAI@GDED@FDDHD@D@FADEFCD@F@BF@D@B@BDFBBFBFEHCBABAD@DA@AB@HC@CAE@E@@@A@AB@@A@@D@FCDEBIBI@EBCDAFBF@DBBDBBBBBDDBFFBBDJFBHBFBFBDCDAJAL@D@BDADAD@FDF@D@@@H@FCDEFEBCBCDCDEFEFCDCDAD@F@B@D@B@@BDFFHBHBNEFEDEDADCBA@AAC@C@ABAFGHEDCFADCFA@AB@@C@AAEAE@C@CBABA@CBCBG@@B@PAF@JDPDF@FAFCFAH@FBDBDDDFB@B@BAB@BADAB@@@D@BB@BBDBDDFDFDDDD@@@BA@ABAD@F@BBB@@BBDBNBJBDBFDBFFB@@D@DAD@DBDDFDHDBBB@BAB@FADAFEFCD@D@@@@B@B@BBFBDBBBBD@FBD@D@BBB@BBBD@BBDBBBBFDFFBBB@R@FBFDBDAHEFEHAHDJFBH@FDHDHBD@F@FABCBEBGBEBAB@DC@ABA@@B@D@DBBDBBDFDHDHDHDJBBBBDFBBBB@D@F@B@BBD@F@B@DAD@D@B@DDDFBBBD@FBB@B@D@DBN@NBNDNFDDFFB@DBDAD@DCDCBCBC@CBADADAHAH@FADABABA@C@EBCBCBCDEAC@@@A@C@CHEBCACACBCDCBABANEJAFBFBFDFBFDBBD@FCHEFEFEBG@A@E@@BADABCDCB@D@DAB@FAHCHAH@B@@@FBF@HADCBIBI@G@A@ADAB@F@LBJDFBAC@ECG@G@G@G@EAAAAECECCACAAA@CAE@GAECCACCCCAACAA@ADCB@DCB@BG@CBAFCHBF@FDJDJBJ@J@BBD@BBDD@DBDDDDFDBDB@AAC@G@C@ABGDEFEFCBAB@@CCCCCCCA@AA@C@A@CB@DADABADA@E@CAGAGD@DBB@B@B@BA@IBGBCDCHAHAHBFABA@C@EBEBCDCBABABCBABEBCBCB@BC@@LEFCBC@ABCDAFC@@BCBCCEACCAC@CBC@C@CACDCDCFAFABCBEAEA@@EBC@CA@A@@FGBAEEAC@CBA@AD@B@DADBB@BA@C@A@@DADAB@@A@@@CBAD@BC@AB@DA@A@@DAB@BCBABAB@BBBBD@DBD@B@BCB@B@BB@A@A@A@C@ACC@@A@A@CAAC@@BC@AB@@@AACAAAABAA@C@ACA@ABC@@@AAA@C@AB@D@FB@@BA@@@ABABABBB@BA@@BB@A@ABADAB@B@@@BA@AAAD@@AB@BAB@@BB@BBB@B@@@@CBCB@BBD@BA@AAAAA@AB@BBBB@BD@DBBDD@DDB@B@DBBAB@BAFB@BBBBBBBBADABCBBB@D@D@FA@@DA@B@B@D@DDBDD@@BB@BD@B@BADCBA@AAA@AB@BAD@BAB@F@F@BABBB@BCDA@CAAAA@AFC@CH@DDFA@A@CAACAACACDADA@AFAD@BABCAA@AFABABA@A@CBAB@FABBDBD@BAD@BB@AD@B@@A@@@AAAAA@E@CBAFADCD@@ABA@CD@BEBCBABAB@@ABCDA@A@ADA@@BA@ADCBBB@B@DABA@EAA@A@A@A@A@ABCBAD@DA@AFAHAJCB@DCDABAFAB@DABA@AACAAC@CA@C@E@EAA@AFGBAAAAA@CAAA@AA@ACC@@@C@AFAD@F@DAAA@C@@BCBCCAD@DC@@@ACAA@@CC@C@ABCDCA@A@@@AFEDCBCDAAAG@AAAA@CCGAAG@GIECA@ECECAAAAECCCCCCAEAAA@C@A@AGBABG@@BAB@BA@E@@DC@E@EA@@ACEAACAAACECEEGAA@A@A@MACDEB@@A@EDAD@HC@E@EAGBE@ECABCAI@CAADCBCCGGCAGA@C@CAAC@CBECAEEAC@CB@@EDCEAAA@A@CA"],encodeOffsets:[[117957,24310]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
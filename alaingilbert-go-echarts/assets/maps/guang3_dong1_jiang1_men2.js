@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("江门",{type:"FeatureCollection",features:[{type:"Feature",id:"440703",properties:{name:"蓬江区",cp:[113.078521,22.595149],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAEEA@A@@@A@AAA@A@A@A@AB@AAB@@CACCCB@DGF@@@@A@@@@@@B@A@@ACEACACB@BGCFF@DD@@D@@CBGFABCBACA@E@C@CCI@GDEDIACCCIAAA@A@A@@@A@@@MBABE@A@C@A@CACEACCCCCEECCKIC@EAC@ABA@ADABAF@B@@@BAB@DAHB@B@DD@@D@@BBBB@BB@B@@AD@@@B@@@B@@D@@B@BBBB@@@@@BC@C@@@A@@@@BB@@@@@@@@@@@CB@B@BA@@BA@AB@AA@@BA@A@@@@@@BBB@BB@B@@A@@@@@@@CB@@@BA@@@AB@B@@@BA@@@@ACB@B@B@@AB@@BB@BA@@@@B@@D@@@B@@@BA@@B@F@@@B@B@@@@BBBAB@@AB@@@B@@BAB@@B@@@@BB@@AD@@@BC@@B@B@B@@@DA@AB@@A@@@@B@@@@@@@@@@@@BBB@@B@@AB@B@BA@@BA@A@@@@BA@@@@@BB@@@BA@@B@@CB@@@@@@CA@@@@@BBBA@C@AB@@@B@@@@B@ABAB@@A@A@@B@@A@@@A@C@A@@@A@@@A@A@@@ACAA@AA@A@@@AAEA@@@DA@ABAB@@@@@B@BBB@@@@C@C@@@B@@AB@@@@@@@A@A@@@@@@C@@@@AB@BBB@@@B@@@@A@AAA@@AA@@@@@BB@@B@@B@@@@ABA@@A@@E@@@A@@@ABA@A@@@@@AB@@@@@@@@DA@@@@@BA@AF@BA@@BAB@B@@C@A@@@ABA@AA@BAB@@BDBBDB@@@BB@B@@@BB@@DDDBBB@@B@B@@@B@@@@BBB@D@@@BA@@BBB@@@B@BA@D@B@@AD@BBB@F@B@HBBBB@BBB@B@@ABAD@@BB@@@B@@@BBBB@@@@BBD@@@B@@BH@BBB@@@B@B@@@BB@BBB@@B@B@@@B@BB@@@@AB@@BD@@@B@B@@BB@@@B@B@@@D@@B@B@@@@B@B@B@B@B@@B@@D@@D@@@@@@@@@@@BA@@BAB@@@B@BBB@@@BA@@B@@@B@@@AB@@A@@B@B@B@BA@@@C@C@@B@@@B@@A@@@@@@@@AA@A@@B@@A@@@CB@@@B@@ADA@@B@B@D@@@@@B@B@@B@@B@D@B@B@B@@@@A@BBBBB@B@B@@BD@@@DB@@@BB@@@B@BBAB@@@BB@@@@@BA@@BBBB@@B@B@B@@@BABA@@@ADA@@BB@@BB@@B@B@@A@@B@@@@B@@@@@@BA@A@A@ABABA@A@@A@@@BA@@BBB@BB@B@DB@DBB@@@@B@B@@B@BB@B@@@@@@@BB@D@@@BB@BD@DB@@@@@@@B@B@B@B@D@BC@C@@B@@@@@@@@@@BB@@B@B@@@BBB@BB@@@B@@C@@BABB@@BAB@@AB@BBBABCD@@ADA@CD@@BB@B@@BBBBDD@BB@B@@B@@@@@F@@A@@B@@@B@B@@B@BBDD@B@BB@B@@F@B@@@B@B@@@@BB@@BB@@BB@@@@ABABABA@A@CA@@@@@@@@CB@@@B@BAB@BAD@@BB@D@@@B@B@@BDBBD@B@B@BB@@@D@B@@B@@@@D@@B@DB@@BB@@@B@BADA@@B@B@@@@@BBBDF@B@@@@BB@FB@B@BBB@ADD@@BBB@A@@@@D@BB@@@@AB@@@@BD@@@@@@@@@@@@@B@@FJBDLGHEFEJOHMDEDCBABIBEBIBIFY@CBO@AB@@EDWBGFMLOFEFCF@B@DBBBB@DBD@@ABA@@B@@AB@@@B@@ADCB@@AB@@@HABA@@DAD@BADALCFCB@@@FCBABAD@FG@@DBBAB@@AFCDCFEBABCC@A@AA@AC@BCD@BADI@BD@D@@E@G@@AAC@A@A@CBAA@@@@@@@@AECEAG@CCMBC@@@@BACG@ABC@@BA@@@A@A@@@C@@@@BCECB@DC@@@@DC@@B@@@@ABADI@AODG@@@E@KA@@QAG@C@CBE@GACA@@IC@@E@A@CAC@A@A@AAA@@A@ADC@C@C@A@@AE@@AA@@@A@@AA@@AAA@AA@@AA@@A@@@@@EC@@@@AAAAEC@@@AAAACCC@AA@A@A@CAA@"],encodeOffsets:[[115794,23108]]}},{type:"Feature",id:"440704",properties:{name:"江海区",cp:[113.111612,22.560473],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@E@A@IAC@@GCAA@G@CEDA@CCABGGAC@A@@GB@AAA@A@ACBACCBACCBADCB@AAA@EIBCC@@AAEEADECCBEBEBC@CCACAC@@KFCCIKAA@BEFEF@DBF@D@L@FAD@B@D@B@BAF@@@D@@AB@FAF@B@BADEFCBAD@L@B@FBB@@@DB@BBDB@@AD@D@B@D@BBH@BB@@BFFBBB@DBB@B@B@@BDDBDBB@B@@FDBBBB@@@@FD@@@@B@@@BB@@BBB@BB@@BB@@@B@@BB@@BF@@@B@D@DCD@B@BB@BBB@B@D@DBB@F@@@JD@@DBHBF@DAD@H@RB@@LBF@@@H@PCJKDCDABC@AB@BABEBADGDEBCBCBKBC@C@CDSC[AAEGIKACEDAFADCCCCEDGA@E"],encodeOffsets:[[115878,23069]]}},{type:"Feature",id:"440705",properties:{name:"新会区",cp:[113.034187,22.4583],childNum:1},geometry:{type:"Polygon",coordinates:["@@AG@GGGQAAQUwQV[bADEFCH@@@@@@@@BB@@@@@@@@@@@@@B@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@A@@@@@@B@@@@A@@@@@@B@@A@@@@@@@@B@@A@@@@B@@A@@@@@@@@@@@@B@@@@B@@@@@@@@@@@AB@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@AB@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@A@@B@@@@A@@@@@@@@@@@A@@@@@@@@BA@@@@@@@@@@@@B@@@@@@@@@@A@@@@B@@@@@@@@A@@@@@@@@@@@@@@@A@@B@@@@@@@@A@@B@@@@@@@BA@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@BA@@@@@@@AB@@@@A@@@@@@@A@@@@B@@A@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@A@@@@A@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@A@@A@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@A@@@@B@@@@@@@@@@A@@@@@@@CBA@@@@@@@@@@@@@@@AB@@@@@@@@@@A@@@@@@@@@@@@@A@@@@B@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@AB@B@@@@@@@@@@@@@@@B@@A@@@@@@@@B@@@@AB@@@@@@@@@@@B@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@CA@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@B@@@@@@@@@@@@@@@B@@A@@@@@@@@B@@@@@@@@@@@B@@A@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@A@@B@@@@@@A@A@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@B@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@B@@B@@@@@@@@@@@@@B@@@@BB@@BB@@@@@@B@@@@@@@@@@@@@B@@B@@@@B@@@@@@@@@@@@@B@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@A@@@@@@@@@@BB@@@@@@D@@@@@@@@@@@@@@@@@B@@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@B@@@B@@@@@B@@@@@@B@@@BB@@B@@@@@@@@@@@@@B@@B@@@@@@@@B@@@@B@@@@@@@@@@@@@@BB@@@@@@@@AB@@@@@@BDBD@@BB@B@@@@@@@B@@B@@@@B@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@BB@@@@@@@@AB@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@BB@@@@AB@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@B@@@@AB@@@@@BAB@@@@@@@@@@A@@BA@@BA@@@A@@B@@@@BB@@BB@B@@@@B@@@@@@B@@@B@@@@@@@B@@B@@@@@@@@@@@BB@@@@@@@@@@@@B@@@@@@@@B@B@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@AB@@@@@@@@BB@@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@BA@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@B@@@@@@B@BB@@B@@@B@@@@@@B@@@ABB@@@@B@@@@BB@@@@@@@@BB@B@@@@@@@@@@@@@B@@@@@@@@@@@@@BA@@@@A@@A@@@A@@BA@@@@@A@@@@@@@@@@@AB@@@B@@A@@@@@@B@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@B@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@A@A@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@AB@B@@@@@@@@A@@B@@@@@@@@AB@@@@@B@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@AB@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@B@@@@@@@@@B@B@@@@@@@@@@AB@@@@@@@@@B@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@B@@@@@@@B@@@@@@@@@@@@AB@@@@@@@@@@@@@B@@@@@@@@@@AD@@@@AB@@@@@@@@@B@@A@A@ABA@A@A@@@@@@BA@@@AB@@@@@@@B@@@B@@@@@@@@A@@@@@@@@@A@@@@@@B@@@@@@@@A@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@A@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@AB@@@@@@@@@@@B@@@@A@@@@@@@@@@B@@@@@@@B@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@AB@@@@@@@@@@A@@@@@@@@BAB@@@B@@@@@B@@@@@@@@@@@@@B@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@A@@@@B@@AB@@@@@@@B@@@@@@@@@@A@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@AB@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@B@@A@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@D@@@@@@BB@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@AB@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@B@@@@B@@@@@@BB@@@@@@@@@@AB@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@A@@B@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@A@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@A@@@@@@@@B@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@AA@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@A@@@@A@@@@@@@@A@@@@@@@@@@@@A@@A@@@@@@@@@@@@@A@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@A@A@A@@@@B@DB@@B@@CD@@@B@BBD@@@B@@C@@@@BBBBB@B@@ABAB@@@BA@ABABAB@@B@@B@@AB@B@B@BA@@BABB@@@@B@B@@ABA@@@@AA@@AAB@@AB@@ABA@A@@B@BAD@@A@@@@B@BAB@@@@AA@A@@@@BA@AABA@@@@AB@AA@@@@@AAA@@A@@B@@@@@@BB@B@@A@A@@@@@BB@@A@@AC@@B@@BB@@@D@@BABA@@@@@BAB@@ABA@@AAA@@@@A@ABAB@@@@@A@ABAA@@@@BABAB@@A@@A@@@A@@A@@B@B@@A@@BABC@@BAA@@ABAB@@@BC@@@C@@@A@@@CD@@A@@@BA@A@AB@@AB@AAA@@@@@@DAB@@AB@@@B@BB@@B@BA@ABA@A@CD@@@BA@A@AA@@@@ABAB@B@B@BBB@B@@@B@D@@@D@@AB@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@BB@@@@DB@@@@@@A@@B@@@@@BAB@@A@@@A@C@@@@BB@@B@@ABADA@@@@@@B@@B@@@DA@@B@@BA@@@AB@DAB@@@BB@BA@@B@@B@@A@GDHJ@@@BOFAB@BBBDHADIFGD@@@@@@A@BDDBB@AHF@@D@B@@CBAA@AC@EB@B@BD@DF@@BBAD@HA@@@@@@@@BADBB@BA@C@AD@DAB@BBBD@B@@D@F@BAH@B@B@@@D@BAB@@@BA@@@@BAB@@BB@@AB@@@@B@BB@@@@BF@@BDB@DD@@B@@@B@BBB@@@@@@BAAAB@@@D@@B@@A@ABBB@BB@B@BAB@B@@@B@@AB@@A@A@A@ABA@@AABCB@B@@@@@@@@@@@@@B@B@@CDAB@@@@AB@@BB@B@BBB@BB@@@DBB@BA@@@@BA@@@@@@@@@@@@@@BDD@B@@@@@B@@@BD@@B@@@B@B@BB@BB@D@B@@@D@FBBB@@HCBA@@@@@C@@@AD@B@@C@ABA@A@AB@@@B@@ABA@AA@@AB@BAB@D@B@@@BB@@@@BA@@DB@@BD@B@B@B@@@BB@@@D@B@@@B@FBB@B@B@@A@@B@@@B@BC@@@@BB@BBB@@BB@@@@B@FADB@@@@@@@@@@@@BBB@@B@@@BBB@B@D@@B@BB@DBBDBBB@@B@B@BAB@BB@@@@BB@@@@BAD@BC@B@B@@@B@B@@B@B@@@AAB@@@B@B@@B@@B@@@B@B@B@@BD@@@@A@@@@@@BB@@B@BA@@@A@A@@@B@@BB@A@AD@@@@BA@@B@B@@@@@BD@@@A@@@ABA@@@@@@@@@@@BB@@B@@@BBB@@@@@B@@@BB@BBD@@B@BB@@@BD@B@H@@AB@@@BCBABAB@B@@AB@@A@@AA@@@@@A@@DA@@@@@@B@@@BAB@B@@@B@BA@C@@@ABA@@BABAB@B@F@D@@@BBDB@@@B@@BBDA@B@@BB@@B@@@DBDBB@@@B@D@BABBB@BBBG@CBA@A@@@ABEBABCB@BAD@FBD@LJDDFFDDDDBDDFDBB@D@B@F@BANA@@B@@@B@B@B@BBDJDDJBFCHCJ@DDD@F@B@BDDABAHEDA@@@CC@@CEEHD@ADADBFBBD@@@B@A@@@@B@@@@@HE@CDADDDB@@BA@BBAB@B@B@B@BBB@@@B@B@@AA@@AAG@A@C@A@CBC@@CAAAA@@C@@AA@E@A@KBCDAFEBC@A@ABE@EBA@@@C@@BE@A@A@C@ABC@E@K@CAE@CFEFE@ABBJLDDLE@@BDBDDDD@FAFADAFDBCFFBB@@DDJA@FBB@BDABCDABDDABDDA@B@BBB@BHA@@@BBDHHBADDB@FC@D@HBBHD@@BD@J@B@F@@@FHBFCDDDDBCBEFCBDJLFHBBJGRSBANIBALKFIDEBCHK@@@@@ALMBCHIBCFIDOBC@EFKFG@@FGDEFK@E@U@M@C@G@GBGDIDIBISLEBC@CCMGCAC@EBEFG@EAC@ADCDEBCAAE@C@AAAAGIGOCGAA@@@CP@BCJAFABCDCDC@A@C@ACAAC@@@IBC@AACC@I@C@E@@@@B@D@@C@E@@A@AADCDCB@DADCHCHCDCDCDKBCD@FC@@AKCAA@@@AE@C@ABADAAEAIAG@Q@I@@AG@IAMAO@CGICCCC@@@@AG@G@A@G@EACAECMAKAGAKACIKGEAAQGSEICEAEAQQABABEHKCAEFABEECIIACEECECG@G"],encodeOffsets:[[115812,22717]]}},{type:"Feature",id:"440781",properties:{name:"台山市",cp:[112.794065,22.251924],childNum:41},geometry:{type:"MultiPolygon",coordinates:[["@@@BB@@@@@BA@@A@@@@@@@A@"],["@@@BB@@A@@@@@@@AA@@@@B@@@@"],["@@@B@@B@@@B@AA@@A@"],["@@AB@BB@BA@@A@@A"],["@@@@@@@B@@@B@@@A@@B@@A@@@@A@"],["@@@@BA@@@@@@@@@AA@AB@@@@@B@@B@"],["@@@B@@@AB@@@@A@@@@@@@@A@@@@@@B@@@@"],["@@@@@A@@@@A@@@@B@@@@@@A@@B@@@@@@@@@@B@@@@@@@@@@@@@BB@@@A@@@@@@@A@@@@"],["@@BB@@B@@@B@@@@A@@A@@AA@@@@BA@@@@@@@"],["@@@B@@B@@@B@@A@@@@@A@@A@AB@@@@"],["@@@@@@@A@@@AAB@@@B@@AB@@@@@@B@@@BA"],["@@@BA@@@@B@@BB@@@@B@@A@@@AA@@A"],["@@@@@@@B@@BB@@B@@@@A@@AA@@A@"],["@@A@BB@@@@B@@A@@@@@AB@@@@@CA@@@@@B@B"],["@@@BA@@B@@BB@@BAB@@AA@@@AA"],["@@AB@@@@@B@@@@BBB@@A@@@A@@@@AA@@"],["@@B@@@@@B@@A@@@A@@@@@@@@@AA@@@@@AB@@@B@@@B"],["@@A@@B@B@@BBBA@@@@@A@@AA"],["@@@@@@@B@@@@B@@@B@@@@B@@B@@@@@@A@AA@A@A@"],["@@@@BB@@@@@A@@BA@@B@@A@@A@@@A@@@A@@@@B@B"],["@@@@AB@@@B@B@@BB@@BA@@@A@@@AA@@A"],["@@A@@@@BBB@BB@BA@@@AAA@@A@"],["@@B@@@B@@@@@AC@@@@@@AA@@A@@@@B@@@BA@@@@@BB@@B@"],["@@A@@@AB@BB@BBB@B@@ABAA@@AA@A@"],["@@@@CB@B@BB@BBB@BA@@@A@AAAA@"],["@@BE@A@@@@@A@@@@@A@@A@@BA@@B@@A@@B@@B@@B@@A@@BBB@@@@BB@A"],["@@A@CB@B@B@@DBB@B@@@BCAA@AA@"],["@@A@AB@@@B@BBBFBB@@AB@AA@ACAAA"],["@@AB@@@B@BBBB@@BDAB@BA@@@A@@EACA"],["@@AD@B@B@BBBBB@@BABABABA@AAACG@@A@@BAD"],["@@A@A@CBA@A@AAA@@@ABAB@D@B@BDBDBB@B@DA@A@@@C@@@A@@DA@@BA@@@AA@"],["@@EDCB@B@B@@BB@B@DBB@B@BB@B@BABAB@BB@@BB@BD@F@D@BCDABA@@@AAAA@ABC@ABA@C@ACAA@ABC@AAAAAC@A@"],["@@ABA@AB@B@BBB@B@BAB@B@@@BB@D@B@@B@@BBBBBBDDBB@@BA@@@A@C@C@AB@B@B@@@@A@AA@@A@@@ABA@A@AAAA@A@C@A@A@EAAA"],["@@BA@A@E@CCCCCCAGCGCEAG@EBAB@DBBJHPJJDDBF@"],["@@C@@BABABA@C@A@AB@B@D@BBBBB@BAD@BB@D@BAF@DBD@FAFBFADABABAAAACCCA@CAA@ACAAE@CAA@"],["@@DBFBHBDBJDD@TEPADADABC@CCCACGAAAAAACAACACACBKAA@A@@B@B@BEBCAKCC@C@@D@B@D@B@DABA@CAA@AB@BBDHF"],["@@BBBBDBD@BD@BBDB@DAB@BBDD@F@B@B@BF@B@DD@@BB@@BB@DCFBDB@B@DABCB@@@D@BB@@BAAA@CCCAA@@@A@AB@DBB@BAB@@CACAAAC@@@ABABAB@@A@CCAGCAA@AAABA@ABA@CACACCCCAE@C@E@E@ABCDADABC@ABA@AD@BBBBBA@AB@@AD"],["@@CEACCAAB@F@HAFEF@HEHCBABC@AB@B@DFDFDDDF@DBDBBBBBDBH@JBFDJ@D@BADEFKDIAIAIGGIEGACBADCBEACACC@E"],["@@AAAEAC@EAAC@C@EFCBCBEAAAAABAACC@C@E@CDAB@FADC@E@E@G@C@CBADEDGBE@CCAAGEKEKCEACCAE@CBCHARCFADC@CAACACAE@A@GCC@C@CFCDC@E@CACIAACACBGDCD@H@DBDFBF@BBBBCDCDAD@FDL@BHRDJNRBBDBDA@EBABAD@HDLFJHHDDD@D@FADCDKDCDAD@DBFBBBBF@DBDBDDDBD@DAF@BBBBDBF@DBBB@BDF@D@DABABABBDBDDDNHJFHDDBH@DBJBD@B@BABA@ABAD@BBBHBBDDLDJDJFD@B@BABEBCBAD@B@@B@FBBB@DBB@@A@ABABEDC@AAA@ABCDGBCACAEAEAEAAAAA@E@CAA@CCAAAAGAMEEA@@AAAE@C@E@CBA@GBEBCDABADABCFCNALCFABACICEECEAQBKBI@EAAC@C@ADAACCCIECC"],["@@CK@IBEBCHCBC@EBAFAF@DCDCBK@ECEOKYIWMOEEBOHCFADDFNNBFCDC@IIGCE@CDAF@FBTBFFHBF@DABA@EEIGGAIAGAEDCDAHBHPbFHLNDHFJBBFB@@N@J@JBFFDFBFDBBF@FAFCBMLIDA@EDE@E@A@CB@AAAEMAECCMCGAC@EBGJCBE@EAE@GBOL@D@BBDFDFD@F@DEDABAFBDHJNJDDBF@DDDTLNDXHNBLDD@DCF@F@DBFFFD@DADC@CDAFAJDLBHFNBBDBDABABEAEDEDAHAFBDBBFBHFDDBF@HCF@HDFBPJHBJBJAD@JEDCJ@D@DBBBD@N@D@D@BA@C@ACA@CAABCDE@ABCCIACAAC@ACGEACCACAE@CBC@A@A@AA@AAAAAG@E@K@EAE@CCIKGOCC@EAE@AACGGEECGAECKAI@IBCDCHABABC@C@E@CBC@ADABAFGBCACCEICCCAECCCACCGSAI"],["@@gkCCKOU_cqIGOCsI]ABI@eFMDIJur@@gTYHSDSDM@ICMUCQEcCOIkEGEEGA_YGOCIAIBW@GEICMH@BADADCFADDBHA@DCF@DDFAF@FBBBDCDCHAFAD@BFAF@@BABCDBDFDF@DD@D@BDBBBL@CFBDDBB@@D@BDDH@BDCFEFBBBD@HCHADDD@BADAJFHHBBAB@BBJJBDFBABELBBDFBDVP@BDBEDDDBBA@GBEFAHDDHBJDBBBBABCBHFLF@BCBEDKFCFE@EBEBCBEDBFBHD@BDBHAFADFDCF@DB@DBCFE@ABDHBBBDBDBCFFBDB@D@B@DCF@HAD@HBFBB@DFB@BB@@EDA@@BBDABBHBBFBFINFHDB@DBGVCNINCHAF@LAHAHEFEFCF@D@BAD@F@F@B@F@D@B@BB@@DB@@@D@B@D@BBBJ@FDFBJDFDFDDDBDB@BD@BBB@BBBBBB@D@B@BABAD@B@BA@ABC@A@@B@BB@B@@B@@A@C@@@C@A@C@A@A@C@A@AB@BBBABAAA@CAAB@AA@A@A@ABABB@B@@@@@@D@DEF@BA@@DADDBDDBBBDBDAD@B@BABC@@AA@@BAB@BA@A@E@@B@B@@BNABA@A@CAA@@BA@A@@B@@@BBJFDB@@AB@D@BBDD@DCD@B@B@@@@BBBDDBBDBDDDABBF@DAD@DBBBB@D@BA@BBBBD@DAFABAB@B@BADBB@D@DADAD@BA@E@ABADB@@B@@AB@BBB@@@B@@@BABCB@B@DAB@@C@A@CAAC@CCAAAA@A@A@AAAA@@CBABCDAD@FAB@D@BADAB@B@DAB@BDBBDBBBB@DBB@BBDBB@B@BBB@BA@ABBDBAB@BB@DBBADBB@BBB@BADBBBBBD@B@B@BADAB@BCB@BA@CBABAB@DB@AB@B@@AD@BAAABABC@CDABC@AB@@AF@BAD@@AB@B@DABABA@CACAAACACACACCCCACAAA@@@A@C@@@A@ADABA@ABCAA@AA@@@A@A@BC@AAA@AA@AAAA@AAE@C@CBCDAFCDAB@BAB@AAAA@@AC@CAAAC@@@C@C@@@ABA@@BCBC@@BAB@@@BC@CB@BBBBD@DBB@D@D@B@B@@@B@B@@@B@BA@A@AB@B@D@BBBBB@@@B@@B@DA@@@B@DD@BA@AD@AAA@@A@@B@BBB@@@BADCA@@@DCA@CACB@C@@BBB@B@BB@AB@@@BCB@AAD@BCA@@A@@@AA@@AAA@A@@B@BA@@@@BB@B@@A@@@A@@B@@@B@@@@B@@@BBA@@@B@@AB@@@B@@BA@@@@B@@@@@B@BABBB@AB@DB@DD@B@B@BBDABB@@B@@@ABABA@AAA@@A@@A@@@ABBB@@CB@BA@AABAA@@BEBB@ABAAA@ABCBA@@@@AA@AFA@AD@D@B@B@@B@@BAB@DBB@@AA@@@AAA@@@BEA@@BA@@DC@@AAA@C@@BAB@@@@ADCBA@@BA@@@@B@D@FBB@@@B@@@BAB@@@BABAB@B@@A@A@@@ABABABAB@BB@@@@DA@ABA@@DA@@BB@BBAF@BA@@@AB@@@@ABALABAFADCDABBB@@BH@@CBAACBEDGDED@JGLEF@BBF@F@BBBHBNCFAF@FBHHBB@@B@@@@B@B@DBDB@@@@@@D@BB@D@B@@AFAB@D@BB@@@@B@F@@@@BD@BB@BBBB@B@@AB@BABA@@B@@BBB@BBBB@@B@B@B@@@D@@@@@@B@@@@@@@@@B@@@@@@@B@@B@@@@@@@@@@B@@@@B@@@@B@@@@@@@B@@@@A@@@@B@@@@@@@@A@@@@@@B@@@@@@@@@B@@@@@@A@@@@@@@@BA@@@ABAAA@@@AA@@@AA@C@ABC@ABABB@@BBBBB@BBD@B@@@@CBCBAAC@@@A@@@@@A@A@@BA@@@C@@@@A@@A@@ACDA@@@@@B@@BBB@@@@B@@@ABA@@@AA@@BA@@A@@@AB@@BB@@@@AB@@A@@BAA@@AAA@A@@@CB@@@BBBB@@BB@@@AB@@A@C@@B@@BBBB@B@B@@@@C@@@AAA@@@CDA@AB@@CD@@@BBB@B@B@D@BAB@BCB@@BBBB@B@B@BBB@B@@B@@BB@@B@B@D@@@B@B@@@@B@@@B@DB@@FAD@@@@@@B@BB@BBBB@@@B@@@B@@DBB@B@@@B@@@BB@BAB@@@BBBBBBB@A@@@A@@HDB@D@D@BBB@@DDD@BAFBD@@@@BDAD@D@B@FBF@BAD@DCD@D@@B@B@B@BBDBBB@BDB@@@@@@@B@@@@@@@@@@A@@@@B@@@@@@B@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@B@@@@@@@A@B@@B@@@@@@A@@@@A@@A@@@@@@A@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@B@B@@@@@@@@@@@BB@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@B@@@@A@@@@@@@@@@B@@@@A@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@A@@@ABA@@@A@C@A@@@A@@AC@A@@@A@AD@@@@A@A@@@AB@@@B@@@@@A@A@A@ABA@A@@EB@@BB@B@@@BAB@@A@AA@@AB@@A@@@@@@@@@@@@@A@A@ABAB@@BB@@@@AB@@A@@@AB@BBDA@A@@@AB@@@B@@BD@@B@@@BB@B@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@B@BAB@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@BD@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@B@@@@@@@@B@@@@BA@@BAB@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@A@@@CB@@@@@@@@A@@@@@@@A@@A@@@@@@A@@@@@@@@@@@AB@@@@A@AAA@@@C@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@A@@@@@@B@@@@@@@@A@@@@@@@@@@@@A@@@@A@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@B@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@B@@@B@BBB@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@C@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@CBD@@DDB@DDBFDFBBB@B@BBDEHFDBBBDEF@DADBDFD@@@FAD@J@BBD@BBB@J@B@FABA@@AAAAE@@AACFEB@FA@ABA@ADA@@@GCEAA@CBADAD@BD@BBDD@D@BAB@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@BB@@@@@BBB@@@@@@@@@AB@@@@@@@B@@B@@B@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@B@@@@BB@@BB@@@B@@@B@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@BA@@@@@@@@B@@@@@@@@@BA@@B@@@B@@BB@@@@@@@@@B@B@@@@@@@@@@@B@@@@@@@@B@@@@@@B@BB@@@@BB@@@@@@@@@@@@B@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@B@@@@@@B@@@@@@@@@@@@@@@B@@A@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@B@@@@@B@@@@@@@@@@B@@@@@BB@@BB@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@B@@@@AB@@@@@@@@@@@@@@A@@@@@@@@D@@A@@@@@@@BB@@@@@@@B@@@@@@@@@@A@@@@B@@@@@@A@@@@@@@@@BB@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@BB@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@B@@@@@@B@@@@@@@@B@@B@@@@@@@@@@@B@@@@@@@DB@@B@@@@@D@FBB@@@BBBBBBBB@DB@B@B@B@@@@B@@B@BA@@D@B@@@@@BB@DB@@@B@@@B@@@@B@BDBB@@@B@@@@BA@@B@@@B@@@B@@@B@BABAB@@AD@@@B@@@B@@CB@AA@CB@@@DBBBBBB@@@B@B@B@@@@@@@@@B@@@B@@@@DBB@B@@@@D@DBB@B@@@BAB@@B@@BABAB@BAB@BCBABA@@@@@CC@@A@@@A@AB@@AFADAB@B@BDBTDAB@B@@@@@@@@AF@DL@D@B@@@BCB@@@BBEFBBD@BAD@@@BABC@AA@AC@@@@@@@@@AF@B@B@B@BABA@@AAAA@AA@BA@@@ACE@AAA@A@@@@@A@@@ABAD@@@@@B@B@@@@@@A@@A@@@@@@AB@AA@AAA@@B@@A@@AA@A@@A@@@@ABC@A@A@AA@@@@A@@@@BA@AB@BA@ABC@@BA@@AA@A@AA@@A@A@@DA@@@@@@@@BB@BB@@B@@@@A@@@@@@@@@@@BB@@@@F@@@B@@@DB@@AB@BD@@@@@@BB@@D@@@@@@@@@@@@@@@@@@A@@BBB@@@@@@BB@D@D@@@@@@@@@@@@@@@@B@@@@@ADB@@@BB@BA@@BB@@@BBD@B@@@BAB@BB@@D@@B@BB@@@D@@A@@@@B@BBB@B@@AB@@@D@BADB@@@B@B@@AB@@B@B@@@@@DJ@J@@@BB@H@F@BBB@FBBB@@@@@@@@@@@@@@AD@@@@@@B@DBDA@@@@DB@@@@BB@B@BBDB@@@@A@@@A@@BA@@@AAA@@B@BADA@@@@@@@A@@DA@@@B@@@BAB@@@@@B@@ABABAD@@A@@@@B@@B@@@B@@@@@@B@B@@@BD@@@BADB@@@@@BABAB@@BD@BBAB@@B@@@@CHEA@@AA@@C@@BA@@B@@@BD@DBDB@@@B@BB@HHFDBD@@B@DHBD@@@BBDB@BB@B@@ADAB@@BBFHDFBB@B@@BF@F@BADADCHCBADABCH@FDJBDLHNBRCBAHE@@B@@@@@FCDAFC@@FCLEH@DDDBFFBJBDBBHCB@@@@AA@@@ABA@@A@@BA@CBA@@B@@AA@@@CB@@A@@@@A@@@@B@BCBA@@@AA@@A@@D@B@@@B@@@BA@A@@@@@AB@@@@@@@CA@@@@AA@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@BA@@@C@@@C@A@@@AAA@A@A@ABABA@@@@BBB@B@@A@@DCB@B@BAB@@A@AA@@A@A@@BA@@BA@C@@@@B@BBA@@BA@@B@BAB@@B@@@DC@@B@@@D@@@D@@A@@BABA@@BB@AD@BA@AB@@@@A@AB@@@@B@@@BB@@@BABA@A@@B@AB@B@B@@@@BABAB@@@@@BB@BB@BA@@BA@A@@@@ABAB@@@C@@AA@@@AD@@BB@@@AA@@@@B@B@@@@AAA@@@@@@@AB@@@BB@B@@@@BBA@@B@@B@BA@BAB@@@@@BBB@@@@BA@A@A@@B@@@BC@A@AB@B@BA@@BA@@BA@BB@@B@@B@BA@@@A@A@@A@BA@AB@@A@A@ABA@@@AA@@@BABABAB@@A@@BABA@@@AAAAA@A@@D@@@@A@@AC@A@A@@DC@@@AA@@C@A@@B@B@B@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@BB@@@@@@@@@@@@@B@@@@B@@@@@@@@@@B@@@@@@@@@@B@@B@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@AB@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@BA@@@@@@@@@@AA@@@@@@@A@@A@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@BA@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@AA@@@@@@@C@@@@@@@A@@@@@@A@@@@@@@@@@@@@B@@@@A@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@BA@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@BA@@@A@@B@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@A@@@@@@@@@@@@@A@@@@@A@@BA@A@@@@@@B@@@@@@@@@@@BA@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@A@@@@@@@A@@@@@@@@B@@@@@@A@@@@@@@@@@BA@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@B@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@B@@@@@@@@@@A@@@@B@@@@@@@@@B@@@@@@@@@@A@@@A@@@@@@BA@@B@@A@@@@B@B@B@BAB@B@@@@A@@@@@@@@BA@@@@BC@@@@@@@@@@@A@@@@@@@@@@@@BA@@@@@@@@@@@@@A@@@@@@@A@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@A@@@@@@@@BA@@@@@@@@@@@A@A@@@@@@@@@A@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@ABA@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@A@@@@BA@@@@@@@@@AB@@@@@@@@@@ABA@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@B@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@A@@@@B@@@@A@@BA@@@@@@@@@@B@@@@@B@@AB@@@B@@@@B@@B@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@AAA@@@@@@@@AA@@@@@A@@A@BA@@A@@@@@@@@A@@@AA@@A@A@@@@A@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@AB@@A@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@AA@@@@@@@@BA@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@A@A@@@@@@A@@@@@@@@@@@@@AA@@@@@@@@@@A@@@@A@@@@@@@A@@@A@@@@A@@@@@@AAA@@AA@@@@@AB@@@B@@AB@@AB@@@@@@@@@@@BA@A@@@@BA@@@@@A@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@BA@@@@AA@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@BA@@@@@@@@AA@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@A@@A@@@@A@@@@@@@AAA@@ACAC@@@@@@BA@@@@@@@@AA@@@@@@@@@@@@@@@A@@A@@@@@@@@@@AA@@@@@@@@@@@@@A@@@AA@@A@@@@@@@@A@@@@@A@@@A@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@A@@A@@@@@@@A@@@@@@@@@@@@@@@@@C@@@@A@@A@@@@@@@@B@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@A@@@@@@@@@@@@@A@@A@@@@A@@@@@@@@@@@@@A@@@@A@@AA@@A@@A@@@@@@@@@@@@@A@@@@A@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@AB@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@B@B@@@@@@@@AB@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@B@@@@A@@@@@@@@@@@A@@@@@@B@@@@A@@@@@@@@@@@@@@@A@@@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@DB@@@@@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@A@@@@@@@@@@BA@@@@@A@@@@@@B@@@@A@@@@@@@@@@@@@@@ABA@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@A@@B@@@@@@@@@@@@@B@@@@@@@@@@@BA@@@@@@@@@@@@@@B@DA@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@BB@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@B@@@@A@@B@@@@@@@B@@@@@BA@@@@@@B@@A@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@B@@A@@@@@@@AB@@@@@@@@@@AB@@@@@@@@@@@@@@@B@@@@@@@@@@A@@B@@@@@@@@@@@@A@@@@@@@@@@B@@A@@@@@@B@@@@@@@@@@@B@@@@@@AB@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@BA@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@BA@@@@@@@@@@A@@@@@@A@@@@@@@@@@B@@@@A@@B@@@@A@@@@@@B@@@@A@@@@B@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@A@@@@@@@@@@@@AA@@@@@@@@DGFEBC\\aRUSUACCEACAG@SDWRaRUFKBE@G@CCsIkK[GOk{aoSYcYgUOG]Ee@UDOFIHEHW^AFS\\Q\\YpQHC@WDUAE@I@ICECIKis{uaa[["]],encodeOffsets:[[[115741,22297]],[[115522,22227]],[[115385,22150]],[[115563,22261]],[[115385,22148]],[[115057,21983]],[[115742,22296]],[[115745,22293]],[[115369,22236]],[[115060,21975]],[[115749,22356]],[[115462,22200]],[[115460,22198]],[[115533,22192]],[[115469,22264]],[[115381,22223]],[[115531,22181]],[[115382,22148]],[[115279,22107]],[[115286,22130]],[[115465,22266]],[[115349,22109]],[[115531,22185]],[[115584,22135]],[[115283,22200]],[[115740,22321]],[[115381,22164]],[[115446,22117]],[[115344,22110]],[[115384,22155]],[[115379,22130]],[[115361,22114]],[[115385,22219]],[[115525,22099]],[[115291,22105]],[[115575,22129]],[[115134,22184]],[[115739,22373]],[[115329,22136]],[[115508,22144]],[[115413,22358]]]}},{type:"Feature",id:"440783",properties:{name:"开平市",cp:[112.698545,22.376395],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@BB@@BFBB@BB@BA@E@A@IAA@AAC@A@IBC@E@@ECACBC@CFEACAAECFGAC@A@AAAEAECCA@CCA@CC@DA@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@D@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@AA@A@A@@@A@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@A@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@B@@@@@@@@@@B@@@@@@@@@@A@@@@B@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@D@@@B@BBB@@@@@BA@@@@@@@@@@B@@@@@@@@BB@@@@@@@B@@@@@@@@@DA@@B@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@BA@AB@@A@@A@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@AC@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@BA@A@A@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@AAA@@A@@@AC@@@A@@BA@@B@B@AC@ABA@@B@@@BA@@@@AA@@BABAB@B@@@@@@@@@@@@@B@@@BA@@BBB@@@BA@A@@@AAA@@FA@@@BAB@B@B@B@B@@@@@A@@BA@@B@B@@@@@BCB@@@B@D@@BB@@@B@D@B@@@B@BA@@B@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@B@@@@@@A@@@@@@@@B@@@@@@A@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@AA@@@@@@@@@@A@A@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@B@@@@@@@@BB@@@@@@B@@@@A@@@@A@B@@@@@@@A@@@@@@@@@@@@@AA@@@@@@@@@@@@@A@@@@@@@@A@@B@@@@@@@@@@@@A@@@@@@CA@AAACAAAA@A@A@@@@CDC@CBC@AAE@E@A@CBCAC@@@@ACBE@ACC@CA@AAC@C@A@GC@@@B@@@BAAAAAA@A@@BA@AAA@@A@@@A@A@CA@@@A@@@A@@AAAAA@@A@A@@@@C@EB@@CAA@@@A@@@@@@A@A@@@C@A@AA@@AA@@@@AAA@A@A@AAAAA@@DA@ABA@A@C@A@AAA@A@@DC@@BAB@DC@@B@BB@@D@@@@@@A@AAAAA@@@AD@B@@@BA@@A@@AA@AA@A@@DA@@B@B@BB@@BB@AB@@@BA@@@@AA@@BA@@B@@@AB@@BB@@B@BA@@A@@@@@AA@AA@@@@@B@DC@BB@@@@B@@D@@@B@@AB@B@@@@@B@@@D@BBDADA@@@@@AAC@AAAAA@AA@BABAD@BAD@B@@B@@BB@@B@BBBA@@B@@A@@@@@@B@@@@@@@@A@@@@@@@@@A@@@@B@@@@@@@@@@A@@B@@@@@@A@@@@@@@A@@A@@@@@@A@@@@@@@@A@@@@A@@@@@@@A@@@@@@@@@A@@@@C@@@A@A@A@@@AAAAA@AA@@@AB@BA@ABA@@@AAAAAA@@AAC@@@@@E@A@@A@@A@CBABE@@@A@CAAC@@@@@@@CACAA@A@@@@@@AA@GAAG@EBEDEAMAGAAE@E@AAE@KFIHC@CFCHAFBDAB@DG@@AA@AACBCDEBABKBAB@B@@A@@B@@ABE@AB@AAA@@CB@@AB@BCB@@@@AAA@ABABAB@B@@@B@BA@A@ABAB@@A@AB@@A@@@A@EAC@A@@@@@AB@@ABCD@B@@A@AB@@@DBB@BD@@CB@@AB@AF@@B@BB@@B@@BA@CAA@AB@@@AA@A@C@C@@BEB@BBB@@@@ABAD@BBBAB@BAAAF@@BBBA@BABA@@DA@AA@B@@@BB@@@BB@BABAB@B@@@AA@BAAC@A@A@ACCA@@CBAA@AAABA@@@@@A@@@@@AB@@@A@@BA@@@A@@AB@A@@@A@@A@@@A@@@@B@@@BA@A@@A@@B@@A@AB@B@BB@@BB@@B@@@DB@ABC@BDA@A@@BAA@@A@AAA@AD@A@BD@DDB@C@@DBBC@A@@AA@A@AB@@@BB@BBCB@@ACCA@@@B@@C@AA@@@A@AAAAC@A@A@@B@BABA@@@A@A@@@A@A@C@C@A@CAC@AAAAA@@DAD@@A@AB@@ADAD@@AB@B@@@D@D@@BDBB@DBD@@BBBBA@ABA@CBEDCBAD@D@DBF@BBBBBB@@BBB@BADB@B@@@B@@BBBAD@BABCB@B@B@@@D@B@@BBDBDBDDBDBDBDBDBBBD@DABABCBA@A@@BC@ABE@@BA@@BADCB@DADABBBABC@@BA@A@@BCAA@ABAB@DABA@ADA@CBABA@A@C@AAAACAABA@AAA@CAABCAA@@ABACAAA@BABA@AAA@A@CAAAA@CAA@AACAAAACA@CBA@A@CBABC@A@EBC@CBADAB@DB@BB@B@B@BBBBBDDD@BB@D@B@DA@CBA@A@ADAB@@A@@@A@AAA@@BA@@@CAAB@B@FAB@DBB@B@BBB@B@BBBBF@D@DDBBB@B@@@BB@@DBD@BB@@B@B@B@@@BADADAB@B@D@@D@B@@D@D@DAB@DBBB@@BBB@@BABA@BB@@B@@B@FBBB@@BB@@B@ACB@BADADB@DBFBDCBA@ABADA@AA@DAB@BABDHBBBDBDBBDD@B@BAD@B@B@B@BB@BB@B@DD@BB@DBBD@DBB@BBB@B@DAD@B@D@B@BBBBBBDBBD@BB@@DBB@BBB@@DBD@BBDBB@BBBD@D@BBBDBB@FA@BBBBF@BBBB@D@B@BBD@B@B@D@D@F@@B@BCBCDA@ABBBBB@DBD@B@@@B@B@BAB@@BD@B@DABAD@@@A@CA@AB@@@B@@@B@BCAABABAB@DB@DABAD@B@@@@B@D@B@D@@@B@@B@@B@@@@B@BAD@@@BB@BBF@BBB@B@BCF@B@DD@BDAF@D@DADABBDAFAFCDCDC@ABCD@DAD@DAB@BABC@@B@BBBBBB@DD@DB@AB@B@@BB@@ABJD@@@D@BA@@BDA@BBA@@BC@@B@@D@B@B@BBB@@D@@@@AAAAAB@D@B@@BD@DAB@B@BBBBH@D@VGHABB@B@DAB@D@DBD@DABABBNDBBDABA@A@BD@DBBAFABBBBFADC@@DBB@A@AD@@BABDBAB@HCDBB@@AD@@DFEH@BCA@@EBAB@@B@@BCBGBGAA@E@A@C@@AAAAAACA@A@EBA@@@@@@B@B@B@@ABCB@AAAAAA@AB@@GBA@A@A@ABABABABCD@@A@@@@B@@C@AB@BDD@BA@@BA@@BE@C@C@CA@@CAA@@B@BA@@B@BBB@BBD@BBD@@BD@D@D@B@DBB@BB@@BA@C@A@AAA@AD@@A@A@ABA@CBCA@ACB@B@B@BAB@BBBAFAD@FCDADABC@C@A@C@A@ABA@A@@@AD@BADABABAB@@A@AB@BABCDA@@@AB@@A@ADABA@CAA@A@CBABB@ABABAAABBBA@ABAAA@@@ABABA@@BA@CBA@EA@@AA@@@@CBA@A@A@@@A@AA@@@A@@@A@@A@@A@@@AAA@AAA@A@@AA@@@@A@CA@A@@AC@AAC@A@AAC@@CACACAAA@@C@AACA@A@@@EAACCAC@C@C@@AA@ACCACCCCA@AACAAABA@CBABA@C@A@AAACAAAAAAAAAAAAAACAA@AA@AAAA@@A@A@@CEACEC@@@EACA@ACAAEEGEA@@A@AAAACB@BCA@BA@@@A@@@AAA@@AA@@A@A@A@@AA@AA@AAA@A@AA@C@A@@ACCC@A@A@CBA@A@ABCAABADAD@@@BC@C@ABC@A@ABABA@AB@DCB@BCBABA@EBGDABAB@BA@@B@DABA@AAA@@BC@@@CDA@C@@BABABAFADADABA@AB@B@B@F@D@BFD@@BB@BBBBB@FBB@BB@BBB@BDAB@BB@BB@@BF@BBB@DBB@@D@@@@DBDBB@B@DBBBFDHBBBDDH@BABADCDA@BBBF@B@D@BAFAD@B@BBB@BDBB@B@BAB@B@FBBBFDFDBBDD@@D@BAD@D@BBBBDB@BBAD@BAD@B@DBB@DBB@BD@BDB@BBD@B@BBDBD@FAFBBADA@AB@BAF@DA@@B@BB@B@B@BBABCB@DBBBDDFB@@BABA@C@ADBBBB@D@DDBABBFDDD@H@JADAH@DAHC@GCGECCCACB@BABADCDABAFADCDB@@@BBBBDD@D@D@BA@@B@B@B@AA@@@@B@B@B@BBBADAD@BA@AB@@DD@BB@@BBB@A@A@A@@@AB@BABABABABCBABCAE@A@@BC@A@A@CB@D@BBB@@BB@@A@@@@@AB@BB@BAF@DAD@BAFAF@FABAB@DA@BDBBA@A@C@CD@DA@AABA@AA@@@AA@AA@@BA@@B@@ABBB@B@@@BBB@@BB@@BB@B@B@@BC@@BA@@BABA@@BAB@@AB@BB@@@D@AD@BBFBBBB@BBB@@BB@BBBAB@BA@@B@@B@@@BB@@@BB@BBA@@B@BA@A@@@ABBB@B@B@@@BA@@BB@BB@BBBBB@@AB@@AB@@@BBBBBB@BA@@D@@@BBB@B@@@BAB@BBB@@DB@BBBBBD@BB@@B@B@B@B@BABA@@B@@BBB@@B@B@B@BBBB@@B@@AB@BBB@@@B@@B@D@@AB@F@@@B@B@@BABAD@BBBBBBB@BAB@@C@CBABCBBF@DDDBBBBDBFDBBDDBBBBD@@@@B@B@D@DEDABBDBBBDDBBBBBBADADADBBDBBBBBA@CDAD@BAACAAAAA@CB@AAA@ADAD@DABCBC@CDCBAD@BABC@CAAAC@C@AAAA@@A@A@A@ABABA@@@AA@@@@@@@AAEA@@@A@A@A@@@A@AB@DAB@@A@AC@BABA@ABABA@@@A@ABA@@@AAC@A@@BAD@D@B@DCDAF@B@BA@A@ABADC@CDABA@A@@@CBA@C@AD@@BBBB@BAB@D@BBBAD@@@BBBD@BBBBBD@BB@@B@BA@@BABAB@BABD@BB@@B@@ABBBD@B@BBBADB@@BBBBD@BBB@B@@@B@B@@@B@BDBBB@BBB@@@BBF@@@F@BB@@@DB@@@B@BB@@@@BA@@@ACA@A@@AC@@@CBAB@@@BAAA@ABA@@@C@@@@@ABA@AAA@@A@DGDCBEFAD@BBFBFCDAB@D@DB@@@AB@B@BA@@@C@A@@A@@@@A@@@@@AJGBCLD@@B@B@B@B@N@D@B@BBB@DBD@@@BADA@AB@D@@BB@@@BAB@@A@@BAB@B@@AB@@@BA@C@@AA@A@A@@BAD@BAD@DA@@BADADA@@B@@@@B@@@@BAB@@@@@@@@@@AB@B@@@B@BBB@@@B@@@B@BD@@DB@@BB@@A@@@A@@B@@@BAB@B@@@B@@@D@@B@BB@@@B@@CA@AA@@@@B@@@BBB@DA@@@@@@CAA@A@@C@@@@B@B@B@@A@CA@AAA@A@A@@ABA@@B@@A@AB@@@@DA@@@@BDA@@@@BAB@@B@BA@@@F@B@B@@AB@B@BAB@@@B@B@B@@@BA@ABAA@B@@@@@@B@@@@B@@@B@@@@@@BA@@@@@B@B@B@@@BBB@B@BCD@B@B@B@B@@ABAD@@BB@@@B@@@BB@BABBBADB@@B@AB@@@BB@B@B@@@@BFAD@@@@@BBB@@@@@@@AA@ADAB@@@BA@A@@DB@@@@BB@@@C@@BC@@B@@B@@BA@@@@@@@@AAAAD@@@@@J@B@@ABC@C@@B@BAB@@@@@BB@B@@BB@@@ABABA@@@BB@@@@A@AB@B@@@BAA@@@@A@@B@@@FAB@BABABABAB@@@B@@@B@DBBA@AB@B@BB@@@BB@B@@@@@BA@A@@D@BB@@DBB@@@@@@@DA@@B@@@@@BBBBB@BBD@@@F@B@DA@@@@@ADAB@@@@ABA@@DAB@B@B@B@B@B@@@D@BB@@BBBBBB@FBB@B@BABA@@B@@BD@@@@BBB@BBB@@@DBDDB@@@DBBBB@@@D@BABA@A@C@AAAA@AA@@@A@@AC@@@A@ABA@@B@BA@AB@@@AA@C@G@A@@@AB@D@B@DAB@@@DA@@AA@A@@B@BA@@BA@ABC@@@A@C@AAA@C@A@@@A@ABA@@D@B@B@@@@B@@D@B@BC@@D@BA@AAA@C@@B@D@@A@@@@@@@AAAAA@A@@@A@ADC@@B@@@@@BA@A@@BB@@BA@@@AB@DB@A@A@AB@@@BA@@@@@ADC@@AAA@@AB@@ABCAA@@@AB@@A@C@@BA@ABA@AB@@AAAABA@A@AA@@@A@@B@@@@A@@A@AB@@A@@ABC@@@ABA@C@A@C@@AAAA@AA@DCDC@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@A@@A@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@AA@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@AA@@@@@@@@@@@@BA@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@AA@@@@@@A@@@@@@A@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@B@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@AA@@@@@@@@@A@@@@@@@@@@B@@@@AB@@@@@@@B@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@AA@@@@@@@@@@@@@@@@A@@@@@@@A@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@A@@@@@@B@@@@A@@@@@@B@@@@@BA@@@@@@@@@@@AB@@@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@D@@@B@@@@AB@BA@AB@D@@@@@@@B@B@@@@@@@@@@B@@@@@@@@@@@@@B@BB@@@@@@A@@@@@@@@@@@@B@@@@AB@@@@@@@B@BA@@@@@@@@@@B@@@B@@A@@A@@@@@@A@AD@B@BBBB@@B@@A@@B@@@@B@B@@@B@B@B@@@@@BB@@BBBD@@AB@@@@BBB@BB@D@BAD@@@@@@BB@FA@AB@A@BA@@B@@@B@B@@@@A@AFAD@@BBBDA@@@A@CE@BGA@CAACB@@@@@@@HCJEBCCGAA@ABAPE@A@@GIAAACAIEECACCG@KFED@@EDCBED@@@@A@@@GFABQDMAKGACCI@EDGBABCDADGBCBC@A@EAE@@@AAACEEGAA@@BABC@@@AAAA@AC@A@@ACCGA@@@ACECGGA@@A@A@@CACAC@@A@@@AB@@AD@@@BB@@FBDG@@@@@AA@AB@AAC@@BABA@A@@@@CAAB@@C@@A@@@A@A@@@@A@@@A@@@@A@@B@@@BCBABA@@@A@@@@BA@A@@@A@@CB@@@B@@@@@@CBABA@@@BB@B@@AB@@@B@@@B@@A@AC@A@AAA@@@@CA@@@@CBCAA@@@@@@@BC@@@@@@@@@@@@@@AAEAA@AAE@G@A@@A@@@ICI@@@@A@A@@@BA@@@A@A@@CAABC@@@A@@BA@A@AAA@@@@@@BC@@@A@@A@AC@@@AAA@AB@@A@C@AA@@A@@AB@@AAA@@A@BC@@@@A@@@@@@@@@@@@@@@@@@C@CAA@@@@@@AA@AB@@@@@@@@@@@@@@@@@@@@CA@@A@@@@C@@ABA@@CA@@A@@@E@@@@@AA@@@@@@@@@@B@@@@@@AA@@AAA@@@@@@@@CB@@@B@BB@@B@BBB@@AB@@AD@BABA@@BAB@@@@@B@@B@@B@B@BAD@B@@B@@@@BBB@@@BA@@@BB@BBBA@@B@@@@B@@@@B@@@@A@A@@@@@C@AB@B@@@B@@@@@BBB@BDF@B@@ABB@@BBBBB@@ABABA@A@A@E@@B@@@@@@@@BDB@@BADAB@@C@ABC@AAFEAA@@A@AD@@A@C@K@@CBE@@@@@@@@@ABASCCA@A@ABABCBE@@BAB@@@B@@@DD@@@@B@BADA@ABA@ABABA@AA@@@BA@A@@@AAA@C@C@@A@A@CA@@@@@A@@@A@@@@@@@@@A@A@A@@AAAAAA@C@@DAB@@BDA@@@A@@@A@@BC@@BABA@A@A@@@A@@@A@@@AB@@A@@A@@@A@CA@A@A@@A@@@A@@@A@@CAA@@@@A@C@@@ABA@@@@A@@A@A@A@A@@CAAAAAAAA@@A@EAC@@@@@A@@@CA@@@@@@A@@@@@@@@@@@A@@@@A@@@@@@A@@@@@@@@A@@A@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@AA@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@A@@A@@@@@@@@@B@@@@A@@@@@@B@@@@@@@@A@@@@@@@A@@A@@@@@@@BC@@@@@@@@@@B@@@@@@@@@@@@A@@B@@A@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@AA@@AA@@@@A@@@@@@@@@@@@A@@@@@A@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@BA@@@@@@@@@@@@@@@A@@@@@@@@AA@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@A@@@@@@@@@@@@@AA@@@@AAA@@@@@@@@A@@@@@@A@@@@@@@@@@@A@A@@@@@@@@@A@@AA@@@A@@@AB@@@@@@@@A@@@@@@@@@AB@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@A@@AA@@AA@@@@@A@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@AA@@@@A@@@@@@BA@@@@@@@@A@AA@@@@A@@A@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@ABA@A@CCCAAC@@ABCBCDAB@FBHD@@B@BCB@BAB@@EFADE"],encodeOffsets:[[115291,22777]]}},{type:"Feature",id:"440784",properties:{name:"鹤山市",cp:[112.964252,22.76545],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@A@@BAA@@@CAABAAABA@@A@@@A@@AA@@BCBA@@@A@A@A@ADC@A@AAA@A@@@A@A@A@@@@AB@@@@@@@A@@@A@@A@@@@@@@@ABBBAB@@A@@@A@A@A@@BA@A@ABA@@@A@A@E@@ABA@@@BA@A@@B@AC@@@@CB@@@@BAB@@@@AB@BA@@@B@BBB@BDBB@@@@A@A@A@@D@@@@BBB@D@@@@B@@CAA@A@@@A@@B@@BDB@@@A@@AAA@@@@C@@@A@@@ABA@A@@@AB@@@B@@@AA@@CA@@ACA@@@A@@@A@AAA@@@A@A@@B@@@@@@@@A@AB@@@@@A@@A@@@CBCBAB@@CBC@ABC@AB@@@B@BBB@@@DAB@@A@@BA@A@AB@@@BA@AB@@A@@AC@A@@BCBAB@@C@CAA@AAA@C@M@A@A@A@A@@@KCADIH@B@@@@@B@@B@@@@B@D@@ABA@A@@B@@CAC@A@CBEDEAAAC@EBAFCDCHB@@@BB@BAB@B@@@@@D@@AB@BBBAB@@A@AB@D@@BD@@@BDB@B@@AB@@@@@B@BA@@BA@@D@@@BB@@B@@@BAB@@@B@B@BAB@@@@BB@BA@ABA@@B@@@BADCBABA@A@@B@@@B@@DA@@B@@BAB@@@@@B@@@@@@ABAB@@C@AB@B@@A@AB@BA@AB@B@@@BBB@@BBB@@B@DDFB@ABAB@@@B@BAB@DAD@@ABA@@A@@A@@B@BA@@B@BA@A@@A@BAB@B@@B@BD@DABCBABC@ABABABCBAD@DB@@D@BD@AHB@@DAD@@C@A@@BADABADABA@C@A@@@BB@B@BCBA@@DCDDD@@BB@DBB@BAFCBAB@BB@DBBB@BD@D@B@B@@BBBB@BBB@@B@BB@BBB@@BB@BAD@BBBB@BBBB@DADA@@BCBA@AB@B@@@BB@BB@@@@A@@B@B@DBB@@@B@BBB@@@BB@B@@D@B@HCDCBA@A@@B@BBB@@@B@DCBA@A@@B@BA@A@A@A@@@@B@@@B@@ADEDA@A@@B@@@@@B@@A@@@@AA@AB@@@@A@ABA@@B@@A@@@@BCB@B@@@B@@D@@@@BA@AAABA@A@ABAB@@ABAB@BB@BB@B@@@@@@A@ACA@A@CAA@@AA@@AA@ABC@@@ACA@@CBA@@@@@@A@@AAA@@A@@EC@@@@@@@@@@@@@@@@@@AB@@ABC@CAAACB@@CC@@B@@A@A@@@@B@B@BC@A@@CA@C@A@@@@@@B@@A@A@C@@BA@@BC@@@A@CAA@A@A@@AA@AA@@AAA@ADA@@@@@@@@AA@@@@A@@ABCDAD@B@BAB@BA@@@AB@@@@@@@@@@@@@BB@@B@BBBBBBB@@@@@BB@@BBDBD@DBBA@@B@@AB@BB@BFBB@B@@BBB@@D@@@BDBDB@@@D@B@BBB@@BBB@BB@BB@BBB@B@BABA@BB@@@B@@@B@@@DBD@DBD@BFF@@BBF@@@B@@A@@BAB@B@BBBD@@B@BBBB@B@B@B@B@@ABC@ADA@@@C@@@CB@BA@ABAB@@AB@B@@@BB@B@DBB@@@BBB@@@B@@@@@BBB@BBFBBBBBBBB@BA@@@B@@B@B@@@@@@@@@@@B@@@B@@BB@@BB@@@BADBB@B@@A@@@ABC@CDA@@BA@@BB@@@B@@BD@@BB@@ADBB@BB@@@BDBBB@@@DABA@@BB@@@D@@@BBB@@B@B@BAB@D@BA@@D@@@B@@@@@@BBD@B@@BB@@@BBD@@@BB@@B@B@F@@B@FAB@@ABA@@D@BBDBB@B@BBB@BAAC@AB@@A@A@C@@@C@@@@BBBA@@@ABA@A@A@@@@BABBB@BBBAF@@@BB@@A@@@A@@@@@B@@BBB@@ABA@A@A@@@@A@@@@A@BB@B@@AD@BA@AB@@A@@D@@BABAD@@ABA@A@@@BB@@@B@AA@@BA@@@@BB@@@CBABAB@BB@BBBBB@@@A@@AAAA@@@AAA@@@A@@@@BBB@B@@@@@B@@@@@BD@DBBAB@BB@@@B@@@ABABADCBABA@@@@B@BB@@@B@@@@BB@B@@B@B@BB@DBB@BAB@@ADABC@ABCFB@@@BB@D@B@B@D@B@@DA@@BA@@@@@@AA@@@@BA@@B@@@BC@AD@@@@B@@@@@AB@@A@AA@@A@@@@BB@@@ABA@@@A@A@@@A@@@AAA@C@@BBBB@@@B@@DADABA@CB@B@BAB@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@D@@@@@@@@@B@@@@@@@B@@B@@@@@@@@AB@@A@@@@@A@@A@@@@@@A@@@@@@B@@@@@B@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@B@@B@@B@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@DA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@CB@BAB@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@ABC@A@@@A@A@AA@@A@@CBA@@CA@@@@@AC@AAA@@@@@D@@A@@B@@@ACA@C@@A@@@@@@@@@@@B@BA@B@@@B@@@@D@@@@A@@AAA@@@@AB@@@BCB@@@@A@@BA@@@@@@@@@@DBFBDB@@@@@A@ABA@A@A@@@AA@@AA@@AA@A@@CB@@AAA@@A@A@@A@@A@AA@@A@A@@@@A@AC@AB@@@@@BA@AAAA@@@@@@@@@@@@A@@B@@@BA@@B@AAB@@@@B@@B@@@B@@A@CA@@@CA@@A@A@A@A@AB@B@B@D@@@ABC@@@@B@@BAB@BB@@BA@@@@D@@@@@BB@@B@B@@@@@@@A@@@@A@@@@AAA@@@@@@AAA@A@@B@D@@AAA@@A@@BA@A@AA@@A@BAB@D@BA@@AAA@@B@@@@@BA@@@A@A@AA@@B@B@@@@@BA@@@AAB@@@@A@@@@@AAB@BA@CB@@AB@@C@@AAB@BA@@BBBBBB@BB@ADBBDB@B@BBDAB@D@@B@D@B@B@BB@@@A@@@A@A@A@@BABAAA@ADA@@B@@A@A@AA@BC@@BAB@@@BA@A@@@@@ADA@@B@@BB@DB@B@@@B@@B@@BFBB@@@@A@@@AB@@A@@@@@A@@@@BB@@BBBBBD@@B@DA@@@@@A@ABABABA@A@@@@@@@@@@B@@@@@B@BAB@B@@D@BB@BBBB@@@@@B@@B@DA@@DA@@BAB@D@@A@@@A@@D@@BD@@B@B@@@B@@@@B@B@BBBBBB@B@@FBD@DBB@@B@@@B@@BBB@@@BDBBBB@@B@@B@B@BBBBB@B@@@BDBB@BB@BBB@@BAD@BBJD@@B@DBBADB@BB@D@@ABBD@@B@@@B@@BBB@BB@@@BB@B@@B@BA@@B@D@B@D@@DB@@@B@B@D@B@@AB@@@BBB@BBB@BB@@@@B@BAB@@@@EB@BA@A@A@A@@@@BBB@@BB@@BB@BBD@@BBB@BD@B@@@B@@BBD@B@@A@@B@B@BB@@B@@@BB@B@B@B@@@B@BB@@BA@AB@D@@B@@B@B@@BB@@DBB@@@@B@B@B@@@B@D@@CBCBABCB@@A@A@@BAB@BA@@BB@FDBB@B@D@@@BA@DDB@@@@@@@@@@@@@B@@@@@@@@BA@@B@@B@B@@@D@B@D@D@@@DBF@DABBB@@@BB@@BBDBDD@BBB@@BAFA@@D@@@B@FB@@@B@D@B@D@@@B@@@@@D@@@B@@@B@DABA@@B@B@B@B@@@D@BA@ABADA@A@A@@BABBBBBB@FD@@@@@@BBBAB@D@BC@@@A@@B@@@B@@ABA@@BAFBDBD@B@@@B@@D@@@@BBB@@@@B@B@B@B@@DD@B@B@@@B@BB@@@@C@@BAB@@@BBBDB@@@DB@@BBB@BD@@@@@A@@@A@AA@@CB@@@@@BBB@BBBA@@B@@@B@@A@A@@@@DABB@@B@@BDC@@BBBBB@B@@@@@@AA@@ABBB@@AB@A@@@@AA@@@@A@@@A@@AA@@@AB@@@@BB@@@@B@@@AB@@B@@@@@@B@@B@B@@B@@B@@B@B@@@BB@B@@@@B@BB@BA@@@@@@B@BA@@B@BB@@@@A@@B@@@@A@@@BB@@@@@BB@@F@B@@@BB@@H@JDLGDAJGLKB@\\S@@DALGFKLSDEDCFEBAPCPBRBR@B@F@N@B@@@@@@@B@@@HBJDDBB@JDL@ACEI@@@A@@@@@@@@@@@@AC@@@@BA@@@@AAC@@@@@@BAA@AC@BCA@AAA@A@@EAA@@@@@ACEAA@A@@@@@A@AB@BC@A@A@@AA@@CAA@@@@C@@A@@@@A@C@@AAA@A@C@AAAC@@@A@A@@@CAA@@BC@ABA@A@A@@DA@@@@@@@@DBB@B@BABABA@@@@AA@@AA@@AA@@@@@A@A@@@A@EA@A@@A@ACCAAA@@@@A@A@@@AB@@@@E@@@@@AA@A@@ACCAAAA@@@AAA@@DCB@BC@@DCBAAA@ABA@@BA@AA@BA@AD@@@@A@@AAA@AA@@A@A@@@AA@@@@@@@@@@@AD@D@@A@C@A@A@A@A@@@@@@CAC@@AAA@@C@A@@A@@@@@@@AAAA@@@@A@A@@A@CAA@@C@AAAA@AA@@AB@@B@@@@BABAB@B@B@BAB@@@@@@@A@@A@@@@BA@A@@@AA@@AA@@CB@B@@ABAB@@A@A@A@@@AAAA@@AB@@@@A@@A@@BAAAA@@@A@@A@@CA@@C@@AA@A@A@AAAAB@@@@@@A@A@A@C@AA@@@@A@A@@@@@C@A@AB@BC@@@A@@DA@@B@@@@AB@B@@B@@@@@@B@@@@A@@@AD@D@@@B@@A@A@A@AB@@@BA@@A@@@A@@@AB@@A@AAA@@@A@AB@@AB@@@@@@@@@@C@@@@CA@@@@A@A@A@A@A@@A@A@@@@C@@@A@A@@AA@@@A@A@@AC@@BA@@@@AAA@@@A@A@@@AA@AAA@@A@A@@@A@AAG@@AA@@@C@AA@@@@AAAA@@A@@@A@@AC@AB@BA@A@AAA@AAGAA@E@A@AAC@@BA@C@B@@A@A@@AA@AB@@A@@@CAA@A@@A@@@A@A@@@AACACC@@AA@@A@A@@A@@CAAAAC@@BA@ABBB@BA@@B@D@@@@ABA@AB@@ABEB@@A@@@@CB@@@@@@@@BA@@@@B@B@BA@@B@@@F@@@@BB@BA@@@@@AA@@@AA@@@@B@@BB@BBB@@@@@@A@@AA@ABA@@@@@D@@@@B@B@@@@@@@A@@BA@@@D@D@@@@@AA@A@A@@@@BABAB@@C@@FBBB@@B@B@@BBBBD@@B@B@@@B@@@B@D@B@@@B@@@@AB@B@@@BABAA@@@@@@A@@BAD@B@AA@A@@@@DB@@@@@@DA@@@AB@@A@@AA@@@@B@@A@@B@B@@AB@@A@ABA@@@AA@AA@@@@@@@@@@@@@A@@B@@@BAB@@C@@@A@A@AD@@A@@BC@@AA@@@@@AA@AB@@@A@@BA@@BAAA@A@@A@A@@@E@A@@@AB@@A@@@C@@@@A@@B@@AAA@@BA@@@A@ADA@B@@B@@A@@@ABA@@B@@A@@DA@@@@@@B@@@@AAAA@AA@@@@@@@BAB@@BBA@@BAB@@ABA@A@@D@@@@@@@@@@AA@@@@@B@@@DAD@@@@A@AA@A@AC@@@@A@@@A@@BC@@@AAAA@AA@AC@@@CCA@A@AAA@AAABC@A@@@A@CACA@@A@@@AA@@@ACBAA@@@A@@CAAA@@C@E@A@A@ABAB@@AB@B@@@DABA@@@A@A@AB@@A@@@@@@@CB@@@B@@@@BB@@@BA@@BA@A@ABABAD@@A@@BG@A@C@@A@@AAA@@@AC@AAA@@A@@@@@A@AA@@A@@@AA@@@@@@@@@@B@BA@@B@@@C@@A@@@@@A@AB@@A@@C@@B@BAA@@@A@@@B@B@@ABA@@@AA@@@@@@@B@@C@@AA@A@A@@@A@@@@AA@A@@@A@BB@@A@A@@@@A@A@@@A@AADC@AB@@@@AA@@@@AAA@ABA@A@@@AACAAA@CAAA@@@@C@AAA@A@@@AA@AA@@@@@@@@@@@@CAEBA@@@@@AA@@AA@AAA@@@@ADA@@@A@@@@BA@A@A@EAA@@@A@C@@@A@@A@@@A@A@AAC@@CA@@AB@@@@AA@@A@C@A@ABA@@BB@@BAB@BA@@@A@@B@BAB@B@DA@C@@B@@@D@@@@ABGD@@AAEAC@@@A@C@A@@AAAA@A@@@A@@@AC@@A@@@@@A@C@AC@@@@@@@@@@@@@@AB@@@@ABA@CA@@A@@AAA@A@AAA@@BA@@@@BADC@@@A@A@@@@@@@@@@@@@ADABA@BB@BAB@B@B@@@BA@@@A@@@ABA@A@AAAA@AA@B@BA@@@@C@@BABB@A@@@@A@AAA@@@A@@@CCA@AC@@AE@@@@AAA@@@@@BA@@AA@@BA@A@@B@@A@@BA@A@C@@@A@ABG@A@E@CA@C@AA@ABA@CBCD@B@@AAABC@A@@@@@@B@@GBCAA@@CEC@@@A@A@@@A@@@ABB@A@@BEBA@@A@@@@C@ABC@A@@AAA@A@@A@@BC@AA@AA@@A@@@@@A@A@A@@@A@A@@A@@@@BA@@@AAAAA@C@@B@B@@@@B@@@@BA@@@A@@@@@@@@@@@ABA@@@@@@@A@@B@@A@@@@@@@@@@@@@@B@@@@A@@A@A@@@@@@@@@@@@@A@@@@@@@@A@A@@@@@@@C@A@@BABA@@B@@A@@@C@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@@@@A@@B@@@@@@@@@@AB@@@@A@@@@@@@@B@@A@@@@@@@@B@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@B@@@@@@@B@@@@@@@@@@@@@@B@@B@@@@@@@@B@@@@@@@@B@@@@@@@@@@A@@@@@@@A@@B@@A@@@@@@@@@@@@B@@@@@@@@BB@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@A@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@BB@@@@@@@@B@@@@B@@B@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@AB@@@@@@@@@@@@BB@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@BB@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@CDCDB@@BBBBB@@@D@B@DAB@B@@AD@BB@@@BAB@@@@B@@A@@@@B@@BBB@B@BABB@BA@@BAB@BAB@@@D@BA@@B@@BBAD@BA@@BB@BB@@CD@B@@@@AB@@A@@B@B@BCAA@@B@@AB@@AA@@@BAB@@@@A@@@CD@B@B@@@BBBBB@B@@@@@@@BC@A@@@@DBB@BABC@@@ADA@C@@@@A@@A@A@C@@@AB@B@B@@@B@DBB@B@D@B@@AD@BAB@@ABA@@@@BBB@@CB@@A@CBA@C@A@@B@@@B@H@DBB@@A@@BABA@@@AB@B@B@@BD@@@B@@BBB@BB@B@D@BABABC@@@A@AACA@@A@CCCA@@A@AAA@AA@@@@AC@@@AB@BA@A@AAA@EAAAAAA@@AAC@@@A@A@A@A@A@A@CB@@AB@B@@A@CB@B@@@@CBA@E@@@C@AAA@AAAA@@@@A@@@CB@@@@@@A@CA@@AAC@@@@BAB@@@@A@A@@A@@AAA@A@@BABCAA@@@A@@@A@ABABABABA@EB@@A@@@@B@@B@AB@@A@A@@B@B@@A@@A@@ABAB@B@@AA@@@AAA@@@@A@ABA@@@@DAD@BA@I@@@@@C@BBBB@@@@@@@@AB@@@AA@@@AD@@@D@@AA@@@@CA@@@BAB@@A@CB@BBB@@@@@@A@AA@@@@C@EB@A@@A@A@"],encodeOffsets:[[115311,23145]]}},{type:"Feature",id:"440785",properties:{name:"恩平市",cp:[112.305145,22.183206],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAGBAAC@AB@FC@@AAA@CEA@EAGAC@GBE@CDA@C@A@ACEEADAB@BA@@B@BADA@@@A@@@@A@@A@BAAAA@@@ADA@@D@@@DAF@@@BBFBBB@@@BBA@@B@@A@@BA@AB@@AB@B@B@B@B@B@@BB@B@BAF@BABCBAD@DA@AB@BA@AD@@@DC@CDABA@ABA@@DA@@@AD@B@BA@AD@DB@@BCDCBA@CBCDEBCDEBA@@AA@@@@BABCB@AA@AACAC@E@E@CAC@CBA@A@C@A@@AA@@BAB@B@@A@A@@@A@@B@DA@BFB@@DBBABA@AA@@ED@B@B@BA@A@@@AB@BAB@@AAA@A@C@ABABCBABA@ABABA@CAC@ABA@A@A@A@A@A@ABAD@BA@@@A@@BA@A@A@EAA@CB@@AB@BB@@BCB@BBBB@DBB@BABB@B@BA@ABA@C@@@CB@A@AAAAAA@A@@B@BAFADBB@B@D@B@BABAD@D@B@BB@ABABA@BBAB@BB@@D@B@@@@@@B@BAB@B@@ABAB@BBBB@BBB@BBBBBBBBB@BBBBB@B@DCBA@AAA@A@A@A@ABAB@DBBB@BBB@BB@BAB@BA@E@@@@@@@A@@A@AA@ABABC@ABABC@A@C@CACAAAA@ABA@AACA@@A@A@ABG@EBC@A@A@@BCBAD@BADA@@B@DA@ADA@AB@DCB@DABA@C@E@ABABA@CBCFCFABABCACCAACA@@C@C@@@AD@@@BABA@A@CAA@AAA@A@ABBBABA@C@C@E@A@AB@DBB@BBDAB@D@DAD@BADC@A@CDABCDABA@ADCBABA@CDAB@@AD@DBBADBD@BABAAABC@C@E@E@A@ABA@A@CACAA@BC@CA@CBC@@BAAA@EC@@C@A@AA@A@C@A@AA@C@AAAAC@CAC@AAC@GAC@EACACAEAC@AAA@CAC@A@@B@BBB@BA@@BABABADABA@ABABAB@BD@BBBBBBD@D@@@D@BBBBBBDBDB@B@BBBBBB@BB@BBB@DBBBB@BAB@DABADCBAB@D@DADCBCBAB@BCD@@AD@@BB@DAF@@BDBBDBBB@D@BAB@B@DBBDDDBFBDDBBBB@HAH@D@D@@@BAB@BC@ABABABA@C@ABCBCB@B@BAD@B@DBFB@BB@B@D@BCDEDCBA@CBC@A@AAC@ABAD@BABBB@BABABA@C@C@EBABAD@@A@C@AAABAB@B@BAF@DBBBB@DBBDFBBBDB@BBBAFAD@@BBBBBD@B@LCBAB@D@B@DABAB@B@@B@BD@DBB@DBDDDDDFDFBBBBB@BBDBBBBDDFDHDFBFBB@@AD@BA@ABABCBABBB@BDHFH@BBD@DABABA@AD@DBBFHBDBHDHD@@B@BBB@@B@BDDB@FBDBBB@D@DBD@D@D@DA@AB@BBB@DBBADADAD@@A@A@@D@BDFDBDDDB@B@FBB@@E@CAC@A@C@CBC@C@AACB@BABBD@BFBH@BBDBB@DB@DBDABABC@C@CAC@C@C@@@@B@D@FBH@FB@BBDBDB@@B@B@D@B@BBB@B@BABA@EB@DAB@@ABB@@BDFBBBBBBBBBBB@@BBD@B@B@B@DBBBBDBBBDBD@D@@B@B@DBBDAFCDA@@@C@CDC@C@CBCD@BBFADADCBADAB@@B@DAFAFAFBBB@DBBB@FDD@D@F@DEBA@C@BD@B@D@BD@BADB@@BBB@D@BB@DADABB@BBBABCD@F@BADCDAD@DBFBFBDDBBDDFBD@DBFADAD@DBD@DBB@@BAD@FD@B@DC@@D@@AB@BBB@BA@C@AB@@ABABAHCFAB@BADA@ADA@CBAB@BABAB@D@BAD@D@@A@@BCBCBADBBAB@B@DAB@B@D@DD@BB@D@B@@B@BBB@BBBB@@BB@B@B@@@BB@@BB@B@@@B@@ABB@ADA@BDBB@B@BB@HFFFBBBDB@BD@F@@FDBDDF@@@B@BB@BB@BBBB@DBBBBBBBBBBBBBBBBDBBB@D@B@BADAB@BABBBD@BDBDDBDDD@BBB@@@D@DBDDDBB@F@@@BDBBBD@@@BBDBDBDB@@BD@B@BBD@BBD@@@BDBB@@@@@BB@@@BBB@BBB@B@@@BB@@@@B@@@B@@BBB@@@B@B@B@DA@@@@BB@@FBB@DAB@@AB@BABA@@B@BBBAB@AABABBBABAA@BADAB@B@DBB@BABCB@@@BA@@B@DCBA@ABAB@@@BABABABC@ABC@@B@B@BAB@D@B@D@D@BABCDC@EBCBEAA@ABA@A@A@ADA@BDBDAB@BAB@B@@@BCB@BBB@D@B@@AA@@AAA@C@A@C@CAC@@AC@AAC@AAA@A@AB@@A@AB@DB@@DBD@D@F@@AB@@AB@@ACC@ABAD@@@@A@@B@@@DCBABABABAB@B@B@HA@@BAB@BBBB@BDABA@@@A@A@A@@@@B@FAB@B@BDBBBB@BD@B@F@B@HBHADA@AA@@@BAFA@@DB@AFGCE@@BC@@AADC@GBACABA@AC@@B@BAA@CD@BCAEAABABEAA@CACB@B@BAACCAAMBABA@CAC@C@CBA@C@AAAGBUHC@G@AAAAA@A@CBC@@AA@C@A@BBBB@B@@C@@@AA@A@A@A@CA@@@AD@@AB@ACB@AB@@A@C@@ICBA@@AA@@@ABAA@@CCCA@AAAA@A@AD@BA@ABA@CBC@CDCBAD@DCDCBEBEACBABC@C@CBEACC@@C@ADE@A@AAA@AAE@AAA@@C@ABA@@@@@@AA@@@@A@@@C@A@C@A@@A@C@ABCBA@@CBABABADB@A@A@@@A@@BAB@@D@B@@BCBA@C@AAC@@BA@A@A@A@@@AAC@CAAAABAB@DCDA@A@AE@C@C@A@A@C@AAA@C@A@AA@AAEAA@AEBA@CAAA@A@CACAAA@CAAAC@CA@@AA@AAA@CA@@AACCAAAAAAAA@C@A@C@CBA@A@AAA@CAC@AA@CAAC@@C@AAAA@@A@A@A@ABC@A@ACCAAACACAACGBA@ABA@CBBB@BCBAB@DAACAE@CCACBABA@BDA@@@AA@@AAEAA@@@@AA@@AABAB@@AA@AA@AA@CBA@C@C@CA@C@@@@C@ABABCBC@A@@@A@A@AA@@AAC@CA@@A@@@AAACA@C@CAEAA@A@AAA@A@AAA@CC@CBCBC@A@CAABA@A@ABEBCBC@AAAAB@@A@CAAAA@CBC@CAEBACCACACCAACAA@@@@@A@ADC@CCCAAC@A@@BA@ECAI@A@@@AB@B@@AB@DBB@B@BAAM@@@A@AF@B@B@@ABA@AB@@BD@BA@A@ABCACACAACCCABC@CB@@AFE@C@C@@@@A@A@BABAB@B@B@@BBADBB@BBBAAA@ABAB@D@B@B@D@B@D@@@D@B@@@@AA@A@@A@AB@D@BAB@@A@ABCBA@A@A@CAAAAAAA@AAC@@ACACACCCECEAICE@EAIAAC@A@C@@@A@@CA@@A@A@C@E@A@E@EBC@A@CDEFEFEBGBG@KBEDGJMDMHUCAA@GCMEEJEA"],encodeOffsets:[[115077,22458]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
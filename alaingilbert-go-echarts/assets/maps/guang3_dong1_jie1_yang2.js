@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("揭阳",{type:"FeatureCollection",features:[{type:"Feature",id:"445202",properties:{name:"榕城区",cp:[116.367012,23.525382],childNum:1},geometry:{type:"Polygon",coordinates:["@@EBI@ABCAC@OAO@MAM@A@E@E@EBCB@@AD@@AD@B@D@@DF@B@@DFFF@BDBBDB@FFFFB@BDDF@@BDDD@BDHBB@BBD@@@B@F@D@D@D@@CFCBADEBCBC@E@GAA@GAEAA@CAECA@AA@@A@@@AA@@A@CC@@@@EACAGGAA@@EG@@@@CGEEIEEACAABC@CBCDCFAFADAH@DAF@B@@@H@DBH@L@D@@@@@F@DCB@@C@CAAA@@CCCG@@AECCC@E@C@C@A@A@CBCAE@E@A@C@AABABA@AAAAAABABC@A@@A@ABAAC@ACACAACAAAEAMCAAA@@E@AAA@AA@CAA@GCC@A@@AA@@@E@CAC@A@@@ABABABAD@FC@AAACBEDABC@AA@@AAA@A@@AAAA@A@A@ABA@A@@A@@AACAE@EBGBGDCDCFADEBA@AB@@KD@BGBCBIBAF@@CLEB@BABCDAHFH@@HD@BDBD@BB@@FB@HCHEFCDGHAD@BCFAFCH@B@BA@CF@BGHBBJFDBFDF@BADAHADAFAD@F@DBD@@@D@HFD@@BF@HAB@@BABBB@DB@BB@BBB@@@BB@@AB@DB@@B@BABFA@BBBBB@BHBD@B@@BB@@BF@D@BAD@@@BAD@@CDABEBABCB@@@BABA@AF@@AJCDHAD@D@DB@@DBDDBDBDBFFD@BL@D@JCDAFAHCNE@@J@FBDBHBFAFEBEBK@CA@CC@@CCBA@GB@DCDABBFBDBF@B@BA@@F@@@FA@@HCD@B@BADAD@@@FCFCFEB@@@@@BAHEFCBIRA@@B@D@F@H@FD@BB@BHFHDBJJB@DBF@FAB@@@B@NEHEB@D@B@@@@B@NB@@BD@H@D@D@B@BB@BBBDFBDDFDBB@DBP@DDJBBCDCBAD@D@B@@@B@BBAB@@@@@@ABBBDFB@@A@ABABB@@BA@ABA@AFA@@BA@@D@BCB@BAB@@@B@BAAC@A@CAC@A@@@C@@A@@A@@@@BCBCBA@ABADC@@@@AAAAABAAA@@@AAACAA@@C@ABE@EAAA@ABABC@CAACCEAA@AAA@CE@@@E@E@E@E@G@C@CAEBCBABCBA@@FCBAB@FA@CBEDGDIDADAB@B@DBB@BADCDABAD@DADAH@DBHAFAFADCDADAFADABCD@B@D@D@BBDBB@B@BABCDADCBA@ABC@ABAB@FABC@A@A@@@AAAAABCDEAA@ACC@@@@ACAADE@@AA@CC@A@AAA@ABABCDA@CBEACB@DCBEBCDAFABABCBABA@CBCDCFA@ABCBADAB@D@H@@GDA@A@CE@AAE@@@@ACBE@@@C@EFEDBBBBADABA@A@A@GB@@C@C@ABC@C@EAACAA@@@AA@@CBEAACAC@@D@BAAEABABABAC@CACBAJAFA@@B@CGA@KDA@A@A@@CBADA@ACEEGCICE@O@C@@MRABIZCJ@@ANGLIL@@IHKHGFA@KDCB"],encodeOffsets:[[119318,24001]]}},{type:"Feature",id:"445203",properties:{name:"揭东区",cp:[116.412015,23.566126],childNum:1},geometry:{type:"Polygon",coordinates:["@@DCBI@@BEB@BA@A@@DABAFABADC@@BC@A@@BC@A@CAE@@AA@@@AACAGA@AAAAB@AEABA@@@CAA@@BA@@A@@AA@AAAA@@CAABA@AA@GBE@@AC@GEC@@@C@CAE@C@EBCBGBCBABE@ECCAIEAAEEEEEEAAC@EAA@KAKBE@A@G@AACBC@EBIBCBEBEBEBCBA@EBE@EAEAGCKEEAEAECCC@@AAA@A@G@EAGJEH@@@@A@EDGDC@C@G@EBCBADEBC@G@A@KJA@@EBABCE@AABABABAAA@CACCB@F@BABDB@DCFBBBFBFA@@@AAAEACCBA@AD@DABAB@EAACBCBD@@B@DAFCD@@A@@@DC@C@ECAABADB@@DADA@ABAEDEDEEBCFCF@AA@AACBA@BBBB@@DBB@@B@B@BBB@@ADAB@@AAA@@@@BAH@@BBBD@BBBB@@@BDDB@@@BBD@BBDDBBDBBB@BD@F@DBFBBDB@D@BCDAB@B@BBBB@@BBDA@@BABBDBBB@FBBBBD@BAFEFEH@BA@ABDB@DB@BBB@@@B@@AB@@@@@B@@@BBB@BB@BB@B@B@D@BBB@@@B@@@BC@ABABAB@B@DAB@F@D@@ABADC@@B@@BBBJBF@F@B@@@BADABB@@@@@B@BDBDFBBDDFB@@@B@@DB@B@F@DBDBBBBB@BBB@B@BAD@@AB@@@@BB@BD@B@@BB@BA@@@AB@B@B@F@BAB@@AB@@A@@@A@@AEAA@A@AB@B@BABAD@BABBB@BBDBDB@@B@@A@ABA@@@ABA@@B@B@@@B@@@@BAB@BA@@BBBB@BBDBB@B@B@FAD@BADA@@@AB@@BB@@B@BBB@B@B@D@@@B@B@B@BAB@B@B@B@BBB@@B@@BB@B@@BB@@FD@@BAB@@A@@BB@@AB@@B@@B@BBB@@BB@@@@BB@@@@BB@BB@BDB@B@BB@BB@@@BB@DBB@@@B@B@B@B@BAD@F@BA@@B@BB@@BB@B@B@@A@BB@@@@@BB@B@@@@@BB@@@BB@@BB@@BB@B@@DBB@BBB@@@D@D@B@D@BA@@@@@@@EAA@@C@AAA@AAA@@AA@AA@@BAB@BAB@B@B@@BBBBBFD@BD@FDBBDB@BBBBBB@DBDD@@@@@@AB@@@@A@BBBBB@@BB@DBFBBBB@FDBBDBFBBB@B@B@B@B@D@B@BAB@@@B@@@BHDF@BBFBBB@@D@FBB@D@@AB@@CB@@@B@B@DA@@B@D@@@B@@@BD@@B@@@BDB@@BB@@DBB@@@B@B@@@@@@BB@@B@PBD@B@@B@B@@@B@B@BB@BAFAD@DAD@D@DBB@B@B@BC@@DA@@@A@@@@CA@@@AAA@@@C@@@ABA@@BA@@@A@A@A@@@@DABA@@BA@@B@@@B@@@@@A@@A@@@@@AB@@@B@@@B@BAB@@A@@B@B@@@@@@A@A@CBA@@@@@AB@@AB@@ABABA@@D@@@B@@BB@B@@@BA@A@C@ABABAB@@@BC@@BAB@B@BA@ABCBC@ABCBCAA@C@EHEBCB@FDB@D@D@DADCDEDCBCDA@C@AFCDCFCHALAN@B@F@D@FADABE@EDIFIBADEFCB@B@@DBDBFDDHAHCD@D@D@DA@ABAAAACAA@A@AB@HDDBB@BCBGDK@CFBH@DDB@B@DCD@JBB@HAL@D@DAFBD@BA@A@ABC@C@CBCDAFBB@DG@ADGBE@CAC@C@CDABEBEBAFA@E@A@C@A@A@C@A@AEACACA@@@A@A@@BA@A@AAA@C@CAC@ABAD@BBDBBA@@B@@C@AAA@EBCD@BADA@ABAIACCO@CAA@CACEACCEAA@AAAA@C@C@G@C@@AA@@M@A@@A@C@A@GFMFA@@@A@EBE@CAA@IICAEGAGA@@AECG@E@C@A@@@QBAJEDGFAB@@@@A@EFEDED@@C@CBABA@C@GD@@EB@@E@@@ABA@E@CAEAAACBCDA@@HABDD@@DDB@@DALAFEFEBGACAEAI@@@MFGDEBCBIDC@K@@AECAEACACCCCA@@CAC@C@GB"],encodeOffsets:[[119118,24150]]}},{type:"Feature",id:"445222",properties:{name:"揭西县",cp:[115.841837,23.431294],childNum:1},geometry:{type:"Polygon",coordinates:["@@BEAEACAACBCCAACCCEAG@E@CBE@CCCE@IBIDEBCBCDADABCFEFEDGDC@E@EACAEBCDAFADCBAB@@GLILIFIJEJC@CDAFCBCDCBCF@DADADEFADCBGBE@EACAI@G@IEGACAC@EBCBCCGIGEG@KBI@C@E@@@@@EAIAEG@KECECGACAI@GB@@CBAF@H@H@HCD@F@B@DDBBBCDGD@D@BDDBB@B@BAD@D@BBB@@DBBBFBDFBBBD@BABWL[NKJGJAJAJCDEDGDEBIDCBCDCH@HEHEFCFABC@ABAAAAAAAB@BADRFHFDLDLAF@FA@ABAFADBFFFB@DBHBHD@BBDB@DBDADAB@@B@D@D@F@DADAD@D@@@BH@FBBBBAB@D@BFD@BAD@D@@BBDBFDBDBDFBDBFFDFDDBB@B@FBDBBBBBB@B@BA@ABADCFCBGDG@@@ADEDIHGHEDGDGDAJCFCF@F@BBD@DB@F@D@D@DBBD@@@JAH@B@DBBBDBDCFAFCHADBBDJ@J@DCDEHCFADFBDDDDD@BABCDAB@DBBB@@BABADAB@BBDBBDD@@D@B@FADABB@BDFBFBBDBBB@BA@EBABAB@B@B@DDFB@BABBBBBDBF@F@DDD@BB@@B@BAA@BA@CD@BBBBBAB@@CBABE@EDADABADAB@BBBD@F@D@BBDBBB@BBDADADA@C@ABA@ADADAHBFBDFB@BBB@F@B@BD@B@DB@BBB@B@BBBBBDF@DBDBBBDBBB@B@B@D@B@B@@B@BAD@BFJD@HJD@D@D@DBBBD@BCBCBADAF@LDBAB@@A@ABCDGACBCFCDGBADAFCDCFAJCF@DC@ABAHAB@BABC@CBCDAF@BBDD@B@B@B@D@BB@FDF@DBFBDADADAD@FDBBFFVLLFLDDBBDDDBB@DADABBBJFFBFBDFBBFFHHDBBBBDB@BBDABAD@D@D@F@D@F@B@D@B@DAHGDA@ABCBABC@CACCEAACA@A@@@ABAB@FABADABA@AB@@A@A@@B@D@D@B@DAB@@@BBDB@@DBFBD@B@DAFCDCBA@@D@B@BABAB@B@BA@BBB@B@B@B@@AB@@@B@@D@D@BAB@D@D@D@B@DABAB@@C@ABA@A@@@CB@HCB@B@BCBABADA@AB@A@@AAA@@@AB@@AB@@@@BBBB@B@B@F@D@@@BA@ABABAB@@@B@BBB@DD@@BB@BB@@B@@@@B@@@@@BABAB@@@BC@@@AB@@@@@B@@A@@@A@ABA@A@@BABAB@@AFGFEBE@AACAAEAA@AAACBA@AB@AC@AA@AA@A@ABADC@A@CCAAAAE@C@EACA@AAACCAAC@AAC@A@@CAAC@@A@AA@AACAA@@BG@A@@B@BB@@BABC@@AA@A@A@AA@CA@@AAAAB@DABBB@@BDEDEFACFCFBFBAB@BC@CA@BCBADB@F@DCD@@B@@@DCBE@C@AC@DADABB@FBABA@CBCB@DABDBFBB@@B@AEAEAADE@CCABA@A@EDABD@DBBABABABBBF@ADAB@FB@LIB@H@D@FABCDAFAH@D@D@HCFCB@@@@@FGHIECACACFIFGDCLEDCDAFEAICIAG@CIACAC@MAQECE@A@AFGHEBC@A@AAAC@EAA@EAG@AAAAAE@A@C@ABC@C@EB@BABABCAACAAAA@@@@@@@AA@AACAAA@CBA@EFC@CBCBABA@A@E@GCACAEAAEC@K@CCEEEAA@C@CACCAGGAACBCFADADABCBCBA@A@A@@AAIAACEAC@A@E@A@CAAAAAAEAEBEBGAIECCACCAGCCACAA@G@EB@@CBCBEDABABC@CAE@G@CBCBCBCDCBC@C@CBEDCBC@CC@EAGEGGEEAECE@IBC@IBA@GFEH@F@B@@AHKFEFEFECEEG@EB@BC@CBEBCCEGAACEDCDGCIGEGEE@KDIDEAEAEACA@C@A@E@E@E@CACAAGAEEAC"],encodeOffsets:[[118667,23900]]}},{type:"Feature",id:"445224",properties:{name:"惠来县",cp:[116.29515,23.033266],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@@@@B@@@@@@BA@@@@@A@@@@A@@@@B"],["@@AB@@@@@@B@@@@@B@@@B@@@BA@@@@A@@@A@@@A@@@@@"],["@@@@@@@@@@BA@@@A@@B@@@@@BA@@@@BA@@@@@@@@A@@@@@A@@@@@@BA@@B@@A@@@@B@@@B"],["@@GCQIQIGC@@AVAB@@ABA@AAE@EAA@A@EBCBABABCDE@GBIAGCGAICGIGGGCAC@C@ABADCBEA@@A@ABA@@@ABA@@BA@A@A@@@@@AAA@@AA@@AA@AA@@A@@AA@A@A@@@CA@G@EBCAA@C@A@CBA@ABC@CBA@ABC@AB@@@BBDADCDGBCDC@@AAAIGCCAAE@K@KAE@EDEHEBCB@@IBGBAB@FAFAHGH@@@@CBEBC@I@CB@@EBCBA@I@A@CBC@CDEBEFA@ABA@@@CDAB@B@BADABA@A@C@@@CDA@CBE@CCCCCAA@A@ABABADAB@DBB@@@B@@@@CD@@CBC@C@@AAA@EACACAAECEAA@A@A@AACAEEGC@AABEHCD@FDD@D@@@DABABA@ACAAA@A@A@CBABADAD@DB@@A@ABB@D@@B@B@DADBDDB@BBCFBB@@ABAB@@@BBB@@@B@@A@AD@D@@HA@H@@@BBB@BA@A@C@C@@AAADA@@AAAA@@@A@AAEAAC@DC@ACAAA@IAAEBADA@AD@D@BA@@BCAA@A@A@C@CAA@C@A@C@AB@@@@@@@BABA@ABB@@B@BB@D@F@B@A@ABAB@BB@@B@BBDB@@B@D@DA@AB@B@BAB@B@@A@@BA@AB@B@@A@@@A@@B@B@@BB@B@@@BAB@BB@D@B@@B@@BBD@B@B@@B@B@BA@A@@DA@@BA@@@@BFHDHDFHDBDFL@FABA@EBA@@E@AA@EA@CCGEHAEEKA@CACBEHEFEBIH@BAF@DBBDB@ABCBA@CBBB@BDABBD@@BB@BB@F@@@DD@BB@BAD@BABABAD@B@@@DCDABB@@@BDDBBBF@D@@AB@BBB@D@@BB@@B@B@@BDB@@B@@@FDBDDD@@ABA@ABAB@@BBBBAB@@EFEDA@@AABC@CDABA@@F@DA@A@CAC@C@CBEBCB@BAJBFDHDD@DABA@A@E@EFEHGFEFADBF@HDHD@NEL@FAHADAD@JADCBA@@NAPEJAH@HDJHDD@@BBAHCH@DBBBBHBHDJCD@BED@BBFBDBFBFBBAB@DEBEB@AE@CBC@EB@DAHAL@BBFBHDBFBDBDDDHHAF@DED@BAF@JBHBFDDBHBFFBD@BBD@DDFBCFG@G@AD@HDL@FDFDFHBFBDDDDD@FCJCHAJAHELEDAFCDAD@FAFAHEHEFEFCJEJED@F@BBBBD@BB@B@B@D@DBB@DBB@DAD@DAD@BBDD@DABCHCHAD@FBD@FAB@BBJBL@D@D@@@@D@D@D@FBD@BDBB@D@FCDE@EBCBGDGBEBCJCJCFAB@@C@C@A@C@ADCF@H@DADCD@D@@@H@FAB@@ABAFEBA@CBCBADAB@AC@A@ABCBABBD@BBB@@A@@@A@A@ABABB@@BB@@BBBCFA@@HCD@DA@@DABADABBB@@BB@FC@@@AAA@@@AB@B@B@@@B@BB@@@@BC@@@AAA@@A@@B@@@@A@@A@@@AAAB@@ABA@AB@AA@@@ABABAF@B@@@FA@AF@B@@D@BBBBB@BB@B@B@B@B@D@BBBAD@B@BA@@BA@AB@B@FDD@B@DA@@B@@@@AAC@@A@@A@@BA@@B@B@DAB@@A@@@@@A@@BA@@DCBADAB@@@@AAC@AB@BA@ABCBA@A@C@@@ABC@C@AB@BEBABA@@@@@AA@AAAA@A@ABABA@@B@BB@@B@@@@A@@B@B@F@@@B@@DBBBAB@B@B@@@AA@@A@@@@AB@B@B@BAB@B@BBB@B@BABABABABA@@@@AA@A@A@@B@@@B@B@B@@@@A@@B@@@B@B@B@@@@@@AA@A@AA@@ABA@AB@@AA@@@A@@EABADAB@D@B@B@DA@A@@AADCDBB@D@D@@BB@@@BB@@BBD@D@DB@BBBBDDBHDBBBDLFD@BBHBDDDD@BD@B@DABBBFBBBBD@@BBBDDBDAFBD@BFDJDDB@B@BADEDBDB@B@FADAD@B@PDDB@BBH@@D@D@D@DDDD@@BBD@B@@@B@D@BAB@@@B@@@@@@@B@@@B@@@@@B@@@@@@B@@@@B@@@BABABBD@@@DAB@B@BC@AB@BBDA@@BBBBB@@ABAB@@@@@@@@B@B@@@B@@@@B@FA@A@C@ABAB@BC@@B@B@BBBBB@BABABBD@BB@@BBFBD@B@B@BBBB@@@@B@DA@@B@BBBBB@FBRHDCFEJKBCB@BEBC@E@CAEK[CU@GBE@ABCBCBA@C@E@C@E@A@ABA@@BA@E@ABAB@BABADCDAHCBABA@CA@EAGAAAECCAAABCBEACQCAACEAACBABABC@@@A@AACAIIEICMAYCOGMGMGIIIECEAMAG@I@EBGDIFEBABGBG@E@QAGASEEAMEWCQCYIMCI@G@QFQFABaNGDGBABEDKHGHEFABIDGBE@EAGAMESGGAEAUIQIAAMG"]],encodeOffsets:[[[119261,23474]],[[119244,23482]],[[119360,23597]],[[119043,23479]]]}},{type:"Feature",id:"445281",properties:{name:"普宁市",cp:[116.165777,23.297493],childNum:1},geometry:{type:"Polygon",coordinates:["@@BBBFBDHDF@B@B@BADADAD@FEB@DAB@BBBD@BBB@@@@@@B@BBDBBBADABABA@@F@DAD@B@D@BBFBBBBH@FBB@FBD@BB@B@BADGFEH@B@BDFRFNBD@DBJB@DBHDJBJEFCBCDKFCDEHEJBDBDFDFBH@B@B@BB@@DDFDFBFBLFHDFBFBF@FAB@DAFAFAFADAJAFAD@DABBH@B@F@LALBB@FBD@BBFFFFFFHG@ADEB@@A@ADGBEDE@ABCHGDCFEDG@GEA@@AAC@CA@AGC@@EGBGDCBA@AFADK@@BEJADAHA@ALC@@BAB@FABCDECCAC@A@E@CBCBG@E@C@ABADEBED@@BHBBBD@AEBE@@B@D@B@@@DCDA@@@ABC@ABADADA@ADB@@BDB@BAD@B@@C@ABABA@A@@AAA@ABCBA@A@ABACAGBCBG@EBCDABC@@@CAA@@AG@CDGAE@@@@@C@C@@@@@A@@@A@@@A@AAA@@@@@@@A@AB@@@@A@@@ABA@ABABEDC@C@ACAACA@AC@A@C@A@AAA@AE@CBC@E@A@E@G@C@CACAC@C@AA@@AAAA@C@ABEDAFCBADC@@FEBGAA@AAGAE@I@@ABABABCBC@CAECGAAACAAA@@HE@ABA@@@A@CAC@@A@AAA@CACAAACAACDGBC@@@A@A@AAAA@ACCCCE@@@ABA@CAI@@CCCEAE@ABG@CAEEA@@KI@AC@CEAAC@CB@@G@C@CBA@ECCEAEACAABC@C@C@@@C@E@@BABCB@B@B@@DBB@@D@@ABA@A@ACECCAACBECCACACAAA@C@ABABAB@@A@AA@A@@AACAAFAB@DBB@BBBA@A@AAAA@B@B@@@BB@B@B@@@BBBBBBA@AAA@C@@AC@C@@A@@@AB@@C@A@A@CA@BA@A@A@BC@@@E@C@CBABA@A@A@A@AACAEBAB@@ABA@A@@CACAA@ACAAACCEAEEBG@@@C@C@CDCBG@E@CD@B@D@B@D@DA@EBIDIDADAFCHAHAD@FCFEDC@A@CA@AAC@E@C@C@C@@C@C@K@IAAAA@EBC@EAC@GBGDADCBC@AC@ABC@CBC@CAA@CAA@C@C@A@AAAC@AAAAE@C@IFIFEDEFGFGFEBEBC@CBEDCBKFGFIBGBIDEDC@CCCCEAGACECE@ECK@GBCH@H@DEEACCC@AAC@EAAEAGCCAEAG@IBE@AFC@CBEGGCCACACAEGCEAAAK@GBCBA@@FAD@DBFA@AFCFA@ABEAEACAEAAAC@AFC@IDGCGAAAAA@CDGBGAA@@CCIGGCG@IBOFMB@@ABCDIBC@CBGBEBK@MFC@A@CBC@C@ABAF@FEHEFEBEBCBABA@EEEEIEIAI@GBKAGCECAEGICEACAECAIBKFGHGJ@DBD@H@FEDABEBEBEBCAC@IDGDIBGBCBCFA@A@CDAFADADEHEFAFAFCB@@CBC@E@GAI@EBEDEDAFCBA@IFKFEDGDCBADA@ABCB@BA@@BDDDDDDFD@B@DCBAD@FDFBFDDBBDBDAFEDGFIDEDC@@B@BD@D@D@F@F@FDDBDBDADCFCDEJCH@FBHDDFDDDDDBBB@DBB@DAFAF@FBDBFBLAL@HBFDB@D@F@HAD@D@FBF@FBBBFDBBBDFBFB@@@BADCBCDCDAD@BBB@B@BGFEFGFA@EDCBGAE@EBAFBB@BBD@DCFAFBBBFAB@B@D@FDDDDBD@DAD@D@DBDD@F@FBFBBDBFBBBDBBD@@@BADABCBEDCFADBFBF@D@HCFCFEDEBABCDCDAFAJCJAF@DD@DAF@D@FBHDFDDBBDDDABBBDBFAFBDFFHBBBBD@D@F@F@F@B@DDBFBFBFBJCLCF@HFHFDJCHCDDFBBFHDDFADAD@@AFAH@FFFDFEFELEBG@@@A@EFGHEB@JAD@JAF@FDFBHFFHBH@FDDD@DAFCDAD@D@DADCDADADAH@F@DBD@BABAFCDADA@@FAH@B@DBDBHDDBBDDDJFHBFAFAFBBBBBBB@D@B@F@BBDDFBBBJ@BB@B@B@DADABABCBCDEDABBHHDBBD@D@DBBFFDF@D@LFD"],encodeOffsets:[[118909,23980]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
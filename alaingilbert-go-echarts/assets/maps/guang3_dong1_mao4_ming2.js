@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("茂名",{type:"FeatureCollection",features:[{type:"Feature",id:"440902",properties:{name:"茂南区",cp:[110.918026,21.641337],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@C@EBADEDCDC@@@@FEDABA@CBA@C@AAA@AACCA@AEC@AA@AAACCAEEKGIECAQCA@@@@@ABCBAA@@@@@A@A@@C@AA@@A@@A@A@@@AA@A@@@AA@@AAAAAGAA@A@AB@@ABADE@@JGDCBED@D@@K@A@C@C@@@A@G@C@E@UB@@@AA@ABA@@@AFABCBA@@@ABC@K@A@ABA@@@AB@D@@BDB@DBBBBB@B@D@B@@ABA@CACAACG@@CGAECECEACA@AEAEA@@@AGACAG@AAG@G@@@EBCBA@ADADABC@C@E@@AEAEAACAC@C@A@A@ABAB@D@B@F@BAB@@ABC@E@A@A@@B@B@@@@@D@BABA@@BC@AAA@AAAAA@A@C@C@A@A@@@A@@@C@EAAA@@CAA@CAA@C@ABC@C@A@CAEEECAA@A@A@AB@B@F@B@BB@@@@BAD@@AB@@AAAAAA@@A@@@A@@BA@@D@BBD@B@@@D@D@BAB@DABA@ADCDCBABABA@ABA@CBA@C@A@C@@@A@A@@AA@@ACC@ACA@A@C@@@CBA@C@A@@@C@AAC@AAE@C@C@C@ABA@A@@@CB@@AB@@A@A@A@@@AAA@@@@AA@@@@@@AAECE@AAA@@CAAA@@A@@A@@BCBCA@@@CAAA@@DA@@DAC@A@@BA@AAACCCAA@@@ACC@@@@@@@A@@@@@@@@A@@@@@AB@A@@@@@@@A@@B@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@CA@@@@@@@@@@@@@A@@@@@AB@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@AB@@@@@B@@BB@@@@AB@@AB@@@@AA@@@@@@AA@@@@AA@@ACAAA@AAB@@@BC@AB@@ABAACA@A@AD@B@FCBC@CBA@FFBBFBB@@@BA@@@@@@B@@B@@@AB@@D@@@@@@A@A@BBDBB@B@@BBBB@@B@@@@@@@B@@EB@B@BB@@@D@BB@B@@A@A@AB@@AB@@ABAB@B@BA@AB@@@@DFDB@B@@ABA@EF@BBBBB@B@@AB@@@@@@@@@@A@CA@@BAB@@A@@AA@AAB@D@@CBAB@@EDABCDAB@@AB@@A@@@AB@@@@AB@@C@@@A@@@@B@@AD@BA@A@A@@@@CA@ABCA@BA@B@BB@@@BCB@@AA@@AB@B@HAAA@@BBBB@HDBBAHDBDB@@ABADAB@@A@@BABCBA@AB@B@@@@@@@@@@@B@D@BBB@B@B@BAD@B@@A@@@A@AD@B@@ABCA@@G@CACBAA@@A@A@C@@@@B@@A@@ACA@@@AA@@@@@A@@B@B@@@B@@@BD@@@@@@BA@@BB@@B@@@DAB@@A@@@BB@B@@@BA@@@A@@@@@BB@B@BAB@@B@BDBBDFA@@@@@BB@BAA@@A@@@@B@B@@B@B@@@BBA@@@@AA@AB@@@@BB@BAB@@@@A@@B@@@B@BBD@BBD@B@B@@@@@@@@@@DB@B@@@D@B@@@B@B@@@@@@@@@B@D@@DB@@@BBB@B@@@@@@@B@@A@BAA@@@@@@@@A@@ACA@AA@@A@@@A@B@@BBB@B@@@BDB@@C@@@A@@@@@B@@AAA@@EBAA@A@@@@A@@@AB@B@@@BA@@@@@@A@@A@A@@BA@@@@@@@A@@@BB@@BB@@@@@@@@@@@@@B@@@@@@@@A@ABA@@BA@A@@@@@@@BA@@A@@@@@A@A@@@CB@@AB@@@B@@ABCBAD@@@@A@@@@B@@ABAB@BA@A@@@@@@@A@@AC@CB@@A@C@@@@@B@@@@A@@A@@BA@@@@A@C@@@C@AAA@@@AAA@@AC@@@@A@@@@@@@@@@@@@@AA@AB@B@AA@@@@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@A@@AEABB@@ADABABCBBB@BA@ABAD@H@DFBAFBBD@HJ@NDDCDFF@B@D@@CB@@@F@BA@@@DHBDBHBD@F@RBB@J@B@DADAB@B@BBBB@@@DBB@HAB@BBBBB@BBB@D@D@D@FAB@D@@@DB@@@@B@@B@@@B@@A@ABAB@@@BAB@BAB@B@@AB@B@BA@@BA@@@ABAB@@AB@BABA@@B@BAB@@@BAB@B@D@B@BBB@DBB@BBB@B@BBB@B@D@DAB@B@BABAB@BAB@D@@@B@B@@BB@@B@BBB@DBB@D@D@BADAFADADADAD@DAD@D@D@D@J@D@B@@@@@B@BBDBB@@BBB@D@D@F@@@@@@@DAD@BAB@B@BBBB@DB@@@BBDAD@BAB@BAB@D@BABBB@D@BB@BBDDDDDBD@DDDDBBBDBBB@DBB@B@B@BA@ABABABABABAB@@@@AD@@@B@BAB@DAB@BABCBABCBCBABAB@BABAD@D@FAF@BABABABCBA@ABCBC@ABAB@@A@@D@B@B@H@F@FBFBF@FBF@B@B@B@BAB@BABABABCBCBE@EBE@A@ABABABADADAD@DBD@DBD@DBB@F@@A@@B@@@BA@@AA@AAA@A@C@A@@@CBA@@BABAB@BAD@D@L@J@F@F@"],encodeOffsets:[[113630,22277]]}},{type:"Feature",id:"440904",properties:{name:"电白区",cp:[111.013556,21.514163],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@@@@B@@@@@@@@@@B@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@AA@@@@@@@A@@@@@@B@@"],["@@@@@@@B@@B@@@@A@@B@@@B@@A@@@BB@@@@@@@@@@A@@AA@@@@@@AA@@@@A@@@@B@@@@@@A@@@@B@@@B"],["@@@BBB@@DB@@D@@B@@B@BB@@B@@@B@@A@@@@@A@@@AA@@@@@A@@@AA@AA@@AAB@@@ACA@@@A@@@@AA@@A@@B@B@@@D"],["@@AB@@AD@@@@A@@@AB@BBD@BAB@B@BB@@@B@DB@@BBBBDD@BB@B@B@BBBB@@BB@BB@BA@@AA@ACA@C@@BC@A@A@A@@AA@AAA@@A@A@@BAB@AAA@@AA@A@@AA@@AE@@AA"],["@@CB@@CB@@BBDB@@B@ADAD@@@BB@@@BBDB@@BB@BDFBF@B@@@@B@@B@@B@BB@@B@B@B@@@BA@@DA@@B@B@BAD@D@D@F@BBD@BBD@@@B@D@B@DA@@D@B@B@BDD@BD@@BB@@@B@B@@@D@B@DAB@DAB@BABABABCDCD@BABCBA@ABC@C@@@A@C@AAC@@@AB@@@B@@@BB@BBBB@BA@@BC@AB@@@@AAA@E@A@A@@B@B@BBBFDFFDBB@D@D@BAD@B@DBB@DB@@BBFBD@@@B@@@B@B@D@D@B@B@BBBBB@BBD@@AB@BA@A@C@@@@@A@AB@B@F@D@BA@@BA@A@E@A@CBABAB@B@D@D@DBBBBFBF@@@F@DADCBCB@BABAD@F@@@HBH@BBHBDBH@@B@BFBFB@BDDFDFBFDH@@DHBBBD@DAB@BA@C@A@A@AAAA@CCA@AC@A@@B@@AB@B@B@LAD@B@@ABADEB@B@@AB@BBB@@A@@V@F@D@H@B@@@D@D@B@LC@C@AFCDIH@@CFAB@BA@@B@BBBBHBBBB@@BB@@B@B@@B@@@B@BB@@@BBD@@@@B@B@@@@BBDABA@@@@B@RDDBJFLHFFDBBDBBB@@BFD@BDBBD@BBB@B@DAB@DABCBEF@@@@CDCDCFAB@F@D@B@B@B@BB@@BBBFDFFFFB@BBBBDBHDBBD@F@B@D@@@D@B@D@B@BAB@DABADADEBADA@ADABABCBA@A@A@G@ABA@AB@B@D@@@BBBBDBDFBFDHBBDAHDCLDDB@JGFADBBBFHFG@ADDAD@JH@@ANLHNNDFEJA@@FIBAH@DBF@NH@JLL@BBHABCDEDCDAJKPC@ARABI@CHGLC@ADHN@DDLPLDHHHJHB@FABCJCDDTCFBLAFEFACMLEDB@ACG@CFED@DDDABABCF@DEDB@DLABDFADE@GDBBBHCACF@B@@@@@BADAAIBADHBAD@@@@@@@FB@DJ@@H@@CBIFC@CEE@BHCDBBABABIECAADC@@DFBDBFHADCDEDDBD@FJ@HADEDADLF@@HAFAHDBABA@@BB@@CBAB@@@B@@@@@@B@BAF@BDAFND@B@@AHDVIFFDHNBFDNAJDF@PABAFHDBHHNLJFDABBFCACB@DBHBBJKFA@@B@FB@BPHDFFCB@DHHFLEHBB@FLLJFBHCBGFI@CEAAE@CDABG@CEA@K@GBGHCDQB@FC@ACECEEI@IDABAACACDKDABBDA@AAADCJAFAFCBCACHALHDBBAFGFE@EAAPMFA@CJEDBN@JFRABEBGDABE@E@AD@JCLBR@LCBBNB@EA@BGPADABAHCBGBEAAAADABA@CBE@@@GAAACBCDCCC@G@@BAB@BE@@ACCCA@CAA@AC@AC@AAAAA@A@@BAAAA@@CBA@A@A@@@A@@C@A@C@ACACAAA@CACA@@A@A@@@@AAA@@CA@AA@A@AA@A@AA@AACC@AAA@@@@AAAA@@@AAA@C@ACABAA@@@@BAB@@C@@BAB@BCB@B@BA@ADABC@@@@BC@@@@BC@A@@@@BABA@@AA@AAC@@AACA@@A@@C@@@A@@B@@A@@@@@A@@@A@@@@@@@A@@A@@@@AA@@A@@@A@@@@@AB@@A@@@@@@ACAA@@@A@@@@@A@@B@B@B@@@B@@@B@@@@@@@BA@@@@BA@@@A@@@@@A@@@A@@@@@A@@BA@@@@B@@@BBB@@@B@@AB@BAB@B@@@D@BB@@BBB@B@@@B@BA@@BA@A@@BA@A@@@AB@@A@@B@@@@B@@B@@@@@B@BA@@B@@@@B@@@B@@@BB@@@@@B@@@BABAB@@AB@@@B@B@@BB@BAB@BABA@@BA@@B@B@@@B@@@B@@@B@@@@@B@@@@A@@BA@@@AA@B@@A@@BCBAB@@A@@BGB@@AA@@A@@@A@A@@@A@A@@BA@@B@@A@A@@@A@AB@@ABA@A@@AA@ACAA@AAAA@A@@@CAC@A@AA@@AA@AA@@A@@A@AA@@@@A@@@A@ABA@@B@@A@@B@@A@@@AA@@AAAA@AA@@@@AA@AC@@@@A@@@A@@A@AA@AAA@A@A@@@A@A@A@A@C@A@A@A@AA@AA@@A@A@A@A@@A@@@A@@@A@@@A@@@A@@@A@AA@@A@@@A@A@A@A@@@@@A@A@A@A@@@@BAB@BA@ABA@@@AAA@@AA@A@A@@@A@ABA@ADC@@@@B@B@D@@@B@@@B@@ABA@A@A@@@C@A@@AAAAA@AACAAA@@AACA@AAC@A@A@A@ABABADABADA@A@@@@AA@C@A@@@EA@@AC@@A@CACBEDCD@B@DDB@BDAB@BB@D@@@B@@@@A@A@AAAA@@C@AAA@AB@@@F@FBHHF@B@B@@@BA@@@ADEBELcH[BEBK@ECCACGKC@E@GAcIgIUESGGCGICCSSEEQSCCOKAACACAECAAMKECEAEAECIEEECAE@C@C@EEYWCCEAE@E@C@C@A@A@KGE@A@A@AB@B@BBP@F@B@D@D@B@B@DADELGN@B@B@@BBB@B@FARGRGD@F@BBDDBB@HAH@F@BBDNVDDFBBB@D@B@DCBEDEBIBKAIAQAGAOACCGEGEEAECI@OAI@K@IAGAEAEAiKKAG@IBM@Q@GAUAOCOCE@EBA@ABE@C@G@WCWAOCOAECSEOGKC@AOGiWKGMGICAAUIGRCJAB@@A@C@@@A@@@@BAF@B@@BD@@@BAB@B@B@@@B@@@BAB@@@@A@A@AB@@@@@@@F@B@BBB@D@B@BA@@@@@A@@A@@@A@@@@A@AB@@@@@B@B@@@@BD@BBB@@@@@@A@@@A@@@AAAA@A@@@A@A@A@ABADBBA@@@@@AAC@AAA@A@@C@ADAB@BBB@@BB@@@@AB@BA@AB@@@B@@@BB@B@@BBD@@@@@@A@AAAAAA@@AAAA@CA@@@C@C@CA@@A@@@@@@@@@A@A@@@AAA@A@EFA@@@A@@B@@@@A@@BA@@BCB@@@@@@CA@@@@@AA@AC@@@A@@BABA@@@AA@C@ABA@CB@@AA@@CA@@AA@@AB@B@BAA@@BC@@@@AA@@A@A@@@A@@AA@@@@@@B@B@@@@AA@A@AB@@@@AA@@@@AAB@C@@@@@@@@@@@A@@@@A@AB@@@AAC@@AC@@A@A@@A@@@A@@ACCBA@@D@BAB@@EAA@@BAD@B@BB@BB@@CD@@CBE@CF@@AB@@@@@@A@@@A@C@@@@@@B@@@BA@@@@B@@DBB@@@BAB@B@@BB@BB@@B@BBBBBD@@@B@@@D@BB@@BB@@@BA@A@A@@A@@A@A@@DB@B@F@@@@B@@@@B@@BD@@@F@@A@AAA@CD@@@@AB@@ABCB@@CB@@AD@@AB@@A@@@CAACABA@@@@@@@@BA@@@@@@@@@A@@@@@AA@@@@A@@@@@A@@@@@@B@@@@@BA@@@@@@@A@@@@@@@@BA@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@B@@@BB@@B@@B@@BBBB@B@@B@@@BB@@@@@@@@@@@@@B@@@B@B@@@@@B@@B@@B@@A@@@A@@@@@A@@@@@@@@B@@@@AB@@@@@@@@@@@@@B@@@@@BDD@@@@B@@B@@@B@@@@@B@@@@@B@@A@@@@@A@@@@@@@@B@@@@BB@@@@@@@@@BB@@@@@@B@@@BAB@BA@@@AACBAAA@@B@DAB@@AB@@@@BBBB@B@@@@"]],encodeOffsets:[[[114058,21964]],[[113891,21919]],[[114049,21951]],[[113854,21884]],[[113532,22022]]]}},{type:"Feature",id:"440981",properties:{name:"高州市",cp:[110.853299,21.918203],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@A@ABABC@C@A@IGB@@GBA@@BB@@@@@@B@@@@@@@@A@@@@@A@@@@@@@@@A@@@A@@@AB@@@@A@@@@A@@@@@@@@@@A@@@@@@@@@@@@@CBABCAADAA@A@@@@A@@@@@ABAD@@@B@@@@B@BB@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@ABCD@@@@@@AA@@@@@@AB@B@@@@@@@@@B@@@@@@@@@@@@@@AD@AA@@@A@@@A@@@@@@@BB@@B@@@@B@@ABA@@A@@@@@@AB@BA@@@@@@@@@A@@BB@@B@@BBCFA@EH@BAB@BA@@B@@A@AA@@ACACA@@AC@CACBC@ABCA@AAACC@AAC@AA@A@A@GBABCBCDE@@B@@@@A@@@BB@@@@@@@@@@BA@@@BBA@@@@@B@@B@A@@@@@A@@B@@@@@@@BA@@@AD@@@@AB@@@B@@@@@@A@@@@@BBB@@B@B@@@@@@AB@@@BA@@@@@@B@@B@@B@@@@@@@B@@BB@BA@@@@@AA@B@@BD@@@@B@@@@B@@@@@@@@BBAD@B@@A@A@@@A@@@A@A@@@@@A@@@ABA@@@@@@@BA@@C@AC@@AB@@@@CB@@A@@@@@AAA@A@@@AB@@@BAA@@AA@@@BA@@@@@@@AA@@@@AB@@AACAA@A@@B@B@@@BA@@@@@C@@@@B@@@B@F@B@BA@A@@@@BD@@@BB@@BBBBB@@@@@@@B@@@B@@D@@@B@B@DA@@B@BBB@B@@@@@A@AB@@@@@@B@BBAB@B@@D@@BB@@B@@@@@@B@D@BB@@B@@@@A@A@AA@@@@@B@@@DBB@D@BA@@@@B@@@BA@A@@@BBBBB@@B@B@@@@AD@@@@@B@@@@@@@B@@@@@@@B@BD@@BA@@@BBB@@@B@B@@ABDB@A@@B@BAB@B@@@@@@@@@@B@@@@@B@@A@@@A@@B@FB@@@B@D@BCBBB@@@@@@@@@@@@B@@@@BBB@BB@@@BBB@@@@B@@AB@@@@A@A@@@FFBFCB@BAAA@AAC@@@A@ABAB@@@@@@@@@BBB@@@B@@A@CDBB@@@@AB@B@B@@@@ABC@@B@@@@A@@@@@@@AB@@@@@@@@A@@BA@@B@@B@@B@BBD@B@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@BBBA@@AA@@@BBB@@@@B@B@@@@AB@B@@@B@@B@@@@@@A@@@@@BBB@@AB@@@AA@@@@@B@@@@@B@@@A@@AA@@@@@@@@@@BAB@@@@@@B@@@@@@@@BB@@@B@B@@A@@@A@@@@@@@B@B@@@DA@@A@@@A@@AB@@@@A@B@@A@@A@@@@B@@AD@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@B@@@BA@@@AA@B@@@@B@@B@@@@A@@BBA@B@@@B@@@@@@BA@AAABA@@@BB@@@@B@BAB@@@@@B@@@@D@@@@@A@@A@@@@@@@@B@B@@@CA@@@@@A@@@A@@B@@@D@@B@@@@@ABA@@@@B@B@@@@@@@BB@B@B@BB@@@@B@@@D@B@@@@A@@BAD@@@@@@B@@ABA@@@@@CB@@@B@@AAAB@@@@B@@@B@@BA@@@A@A@BD@@@BA@@@A@B@AB@@AD@@@BBB@B@@AB@@A@@B@@AB@B@@E@@@@@@@@@A@@@@@AF@@@B@@@@@@@@B@@B@@@@@@@@@@@@@@A@@@@@BB@@BA@@@B@@BB@@BB@@AD@@A@B@@B@@BBA@B@BB@@B@@@@@@B@@@@@@@@@@@@B@@D@@CBA@BA@@@@BAA@@@AB@B@B@@@@@@@@@@@@BB@@@@@@A@@D@@@B@@@@@B@D@@@B@@BB@@@B@@AB@@AB@@BD@@A@@DBBAB@@@@@@@@@B@@BB@BAD@@@B@@@B@@A@A@A@@B@B@BAD@@ABABCBC@A@A@C@@@AB@B@D@B@@@BA@AB@@@@@BAB@@C@AA@@C@@BA@@@C@A@A@C@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@A@AB@@@@@@@@@@@@@@@B@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@CB@@@@@@@@@@@@@@@@@B@@@D@@@B@@@@@@@@@@@@@B@B@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@BB@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@B@@@@@@@@B@@@@B@@@@@@@@@@@B@@@B@@@@@@B@@B@@@@@@@B@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@B@@@@@B@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@B@@@B@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@B@@B@@@@@@B@@@@@@@B@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BAB@B@DBB@@@BAB@BAD@@@B@BA@@@A@@B@@@B@@@@@B@@AB@BB@@@B@DBB@@@B@B@BBBB@@BB@@ABBA@@BA@@B@BBABAB@@A@@B@@@@B@@@BA@A@@@A@@BB@ABAA@BA@C@@BB@AB@A@@A@@@BDDJ@@@HBD@BFBDD@D@@@BDDDDBB@@@BCB@BAB@@A@@@CBC@@@CBAD@BA@@B@@BB@B@@BB@BB@@BB@BBH@DD@BAB@F@DAD@BDBD@DBDB@ABABC@ABABADBDDBDDDD@D@B@DBBBDBBB@BBB@B@DABAB@DBDBFBFADADC@A@EDCFC@E@CBCDC@A@CBE@C@EBG@CB@B@B@BBD@D@BAB@D@BBBBBBB@@@B@BB@B@@@B@@B@@AB@DA@BB@@BBHF@@@B@@AB@B@BDD@B@DD@F@F@B@DBB@BBABB@BDBBDBBBD@F@D@FCB@D@FCBCBCBAFAB@B@B@BBBBBBDFDDBB@B@DAB@B@DABABABCB@BAB@DABCB@BAHEDABEJADCB@@CBEF@@CBCDBB@DAD@B@B@DA@A@AAETAH@B@BCBA@@B@HEFCHA@@BCDC@K@ABEF@@@BADAB@DAB@BCFAF@B@BBD@FBFDDDD@B@BBBBD@@@@FDDBJFFAF@HBLDB@D@FBDBHLFF@DCFCB@@BJHFNFDAD@FBDB@BBB@B@B@BBDBBDB@BBB@BBB@B@BBD@@DDDBD@BABA@A@AD@BADAB@DEDCDC@AF@J@@AFAHC@@BCDAD@@@B@DBFBDAF@BBB@BBF@JDD@BBD@DCFCDEDEDAD@FAD@BA@AAACAACAEAA@AAA@ACCCCAAAACGCEAACACCEECEBGBE@OHCPGBCBCHCF@B@DDFFFDFBD@D@FCFE@ABABAD@F@B@HD@DBFFDH@DAFABA@ABAAAAACC@ADAD@NELGB@D@DAFA@@DCBADC@@B@@A@@B@@@@@BA@@@@BAJGB@H@FF@@BBEP@BBBDBJ@D@FAHIHCHDDJBLBF@BBBBBB@D@DADAHAHBBBDBFBDBFFDJ@@DFDDBDFDHDHAHAP@D@FBDDBDDBDBFAHCDCDANAH@B@B@@@B@BA@@D@BBB@BBBB@@BDBBBB@BBBBBDBB@D@BB@BBB@@BBBB@@BBB@B@@@B@B@@BB@B@B@BBBB@@@B@BABBBB@DBB@BB@BBB@B@@B@BB@BB@BB@@@BBB@DA@@BBB@@@BAB@@B@@B@@BABABABA@@D@@@BA@BB@BA@ABA@A@A@AB@DBB@@@BA@@BA@ABA@A@@BA@@@C@A@A@@B@DAB@B@@AB@@AB@B@@AB@A@@AB@BC@@BC@AAA@ABAB@@BB@BBBBB@D@BBB@B@B@B@BB@@B@BABABB@BBB@B@BB@B@@@B@@AB@BB@@BAB@DBBBB@DBB@BBB@B@DBB@B@B@@@@BAB@@@BBBB@@B@@B@B@@A@@BA@@BAB@@ABA@@B@@ABAB@B@@BB@B@BBBABBB@@BBBBBB@@@B@DF@BB@B@BAB@B@B@B@@@B@B@@@D@BBBDBBB@@@@BD@@@@ABAB@D@BABBHBB@DBB@BBB@B@B@BBB@B@@@BB@@@BBBBB@BBBB@BCDA@C@A@A@A@@B@BAB@BBB@B@@@B@B@B@B@BABBBB@@B@BBBBD@@@B@@BB@BAD@@@DAB@BB@BDB@@B@B@@BB@@BBB@BDB@@@@@BB@B@AB@B@BA@@@@BBDBB@BDDB@BBBBB@@BBB@@BB@@BA@A@AB@@ABAD@BAB@DB@@@B@BBBB@@D@BBB@BBAB@D@B@D@B@B@@@BAAA@@BA@C@ABCB@@ADADABC@@B@@ABA@@@E@@@AB@BADBD@@BD@B@B@BA@A@AB@BBBB@BABA@@BBB@B@@BBBBBAB@B@B@BDB@D@B@B@B@B@@BBBB@@BAB@BBBBBBB@B@DAB@BBBDBBB@@@BBB@@D@@@@BBBBBBB@DBBBBDBFDDBD@D@DBB@BBDABBD@D@B@D@B@B@BBBBBBB@D@BADCB@BC@AB@BADC@C@@@AB@BA@ABAB@B@@@BABABAB@DA@@BA@@B@BABBDAB@B@B@B@@@D@@@BAB@B@@ABABABA@A@A@@@ABABABA@ABA@C@@BA@@@AA@A@A@AA@@BAB@DA@A@ABABA@AAC@A@@@@@AAAA@AA@@@AA@@A@A@A@AB@B@BABAB@@@@ADABABABAB@@ABAB@B@@@AA@@BA@AAAAA@@A@@B@@ABC@@BCAA@AA@@@AAA@@AB@AAAAA@@A@A@A@C@CCCCA@@@AAA@@C@AAAAA@A@@AC@C@@ACAAAAAB@ACAA@@@@A@AAAAAAC@A@AB@@ABCB@@CBCBA@A@AA@CA@A@CBADADA@@BABA@@@CA@CCA@AC@AB@@A@AAAAAAAA@CACBA@A@A@@@AA@AA@CAA@C@@A@A@@A@A@A@@@CCAA@A@A@ABA@A@CBA@ABAB@D@@ABA@ABC@E@C@AAECC@@BC@AA@AA@A@A@ADCBA@A@@DC@@FABA@C@A@A@A@@BAA@@AB@@AAA@A@AAACA@A@@A@ABAB@@A@@A@@@ABAAAAAEACAA@C@AA@AB@@A@A@@@C@AAABAB@BAB@@ACAAAA@@AAAA@C@A@AA@@@@@AAA@A@AAAA@AEAC@A@AB@BAD@B@BA@AAAA@AB@@A@A@C@CBA@A@A@A@@@A@A@@AB@BA@C@AAC@@AAA@BA@AA@AA@A@@CAA@@A@@AA@A@@BAB@AA@AB@@A@A@@A@@AAAAAAAAAABAAC@C@AAAABA@@A@@AA@AAB@@AB@AA@@@@@A@AAA@A@@@A@AB@BADA@A@A@AAEB@BABABAB@@A@ABABADAF@BAD@B@BA@AB@BABA@@AC@@CAAC@ABC@A@A@A@C@AB@AC@@IE@AAAAAC@CAAACAA@EA@AACACA@AC@A@A@A@C@CGDEAKIEKA@GAKFGECGA@EDCEOG@AEAA@@@EBILAAAG@CDADBAEBAECKIGMAGGCBEBA@OCEBICMAEGMECJECUBG@@@AMCBEACE@ABA@@@@@@@@A@@BADA@@AA@@ABABGCEBGB@@KEBCFCBC@GEIC@CAFCDCBCEGCAEA@CD@BCDBJFBABAAADCAGF@DFD@JEDA@@@GI@@CEA@@@@@@C@ABCGABBJCBAB@@@@A@E@BDGDAACA@HCFEBACKB@CCACFE@ADABCBCCC@EF@DDH@BCAKFDNEBEFKBEASDCCIDADEBA@IGGGCGOKCK@CGMBCD@HKDGJ@BABQD@LOBIDCFCDCBAAG@AKK@IMGE@CAG@ABEJ@@IBEFMCGMMK@BG@@IBCCC@BEHEGAACAEBIHA@CCDKGCCBAACGAECECAAAAA@@C@A@A@@BAB@B@H@B@BABADABCB@BCBABCFCBABCBA@ABA@C@A@C@@@C@A@E@C@AAGCCAAAAAA@EEEEECAA@AA@@A@AE@E@I@K@C@C@ABA@ABAB@@AB@D@@@B@D@BBB@BBB@@AB@@A@@@@BE@A@CAC@CAC@CAC@CBCBABABAB@B@BAF@FAFADADABABABA@ABA@A@A@E@EAE@EAEAE@G@A@A@C@@@@BA@AB@BADAD@BABADABABABE@EBC@C@ABABA@ABABADADABADABA@CBA@ABA@@@C@@B@@A@ABABABABAB@BABA@A@A@CAA@AAACAACCCCC@CACCCCAAA@@A@CAABA@A@CBA@ABA@ABCAC@A@@CAA@AA@A@ABA@ABC@C@@@@@@@E@C@CAA@AA@CAAAA@@@@@A@C@I@C@C@C@C@CBC@CBCBCBEBCBABC@C@A@CAA@AAA@@@AA@@@A@A@@@CBA@ABABA@A@ABA@C@C@AAA@A@AAA@AAA@CAA@A@A@C@ABA@A@@BA@A@AB@BA@ABA@@BABA@@B@@AB@@A@ABA@@@ABA@ABA@A@@BABAB@@@@A@@@AA@@@@@CA@@C@A@EBC@C@C@A@AAA@AAAAA@GBA@CA@@A@"],encodeOffsets:[[113413,22242]]}},{type:"Feature",id:"440982",properties:{name:"化州市",cp:[110.639565,21.66463],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@AB@B@@@@@@AH@@@@B@@@@@BBBBAB@BA@@@D@@@FA@@@BD@@@@@B@@@AC@A@A@AACC@A@CA@@@@@@@@@C"],["@@@@A@@AAB@@AAA@AA@@AB@@@@@@A@@@@@@@@A@@AA@@@@AB@@AAA@@@@@ABA@@@@@@A@AA@@@A@@@@BBB@@@@ABAA@@@@@A@@@@@@@@@@@@@@@@C@AB@@@@@@AB@@@@@@@@@@A@@B@@@@@@ABABAB@B@@@@@@@BBA@BB@ABAB@B@@B@@BB@@@@@@@@@@ABA@@@@@@@@@@@@@@D@B@@A@@@@B@@A@@BB@@@BBA@@@AA@B@@A@@@BB@@BB@B@BADA@@B@D@B@@@ADAD@@@@@A@@@@A@AB@B@@@@@B@@@@@@@BBBA@A@@B@B@@@@BD@@@BB@DABA@@@@@AC@@@AB@@@@@A@A@@@A@@BABA@@BA@@B@BA@@@AD@@A@@B@@@@A@@AA@@@@@AB@@@@BBB@A@@@@@ADA@@@@@B@@B@@@@@@@@@@B@@@@@@@@B@@@BA@@@@@@A@@@@@@A@@@@@@@@AA@B@@@@C@@@AB@@AC@A"],["@@B@B@@AB@@@@@B@@B@@A@BB@BB@B@@@@AAA@@A@@A@A@@B@@@@@@@@B@@B@BAB@B@@AB@BA@@@@@@@EB@B@BA@@B@@A@@DAF@B@B@@A@A@AAA@@ACAA@@A@@@A@A@CB@@@@A@ABA@A@@@CAG@A@@@@@A@A@CA@@BA@@A@@@C@@@AAA@A@@@@B@@@@D@@B@@@@BB@LD@@DDB@B@@@DAD@@@BA@@@A@AA@@@A@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@B@@BD@@B@@BB@@@@ABA@AB@@@@@@@@@@@@@@@@@@@BB"],["@@AB@@C@@@AB@B@@BA@@B@@@@@@B@@@@BA@@@@B@@@@BEBA@A@@@@@@@@BB@B@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@DB@B@@@@CB@@@@@@@@@@@@@@@@@@B@B@@@@@BD@BA@@@@@@@@@@@@@@@@@@@@@@@BB@A@@@@B@@@@@@@@B@@A@@BB@@@BD@@A@AB@@@BB@BB@@@@@@BB@@@@@@@@@@B@@ABA@@B@BBB@@@B@BB@BBBB@@@B@BA@A@AA@AACB@CAB@@@@AA@@@@@AB@@A@@AA@@AB@@A@@@@@BC@@@@BA@@@A@@@AA@@@@@A@@@@A@@@@@@@C@@@A@A@@@A@C@@@C@CBA@@BC@@@CB@B@B@B@@BABB@B@@@A@@A@@@@@AB@B@@@@B@@@B@@@@BA@@@@B@BAAAEEEAEGCGCAEIEAEC@D@B@FBB@@@@ABA@@B@B@@BD@@@@@B@BAD@@@@@@@@@B@@BD@@@@@@@@B@@@B@@@@@@BA@@B@B@@@@BA@ABA@@@@BBBBD@B@@@@@@BB@@B@@@@A@@@A@@@@@@@@@AA@@BB@@@@@B@@C@A@A@@@@@A@@@@@B@@@BB@@@@ABAB@@@@@@@@BA@@@@B@@@@B@@@B@@@@BA@@AA@@@AB@@A@@B@B@@B@@@@@@@@B@@@ABAD@B@@@@@@@@"],["@@ABA@@BBBA@ABCHA@@@A@@@CDCBA@@@@@@B@@A@@@@@@@@BB@@B@@A@@B@@@@@@AAA@@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@A@@A@@@@A@@@@@@@@BA@@@@@@B@@@@@@@@@@ABC@@@@@A@@@@@AACB@@@@@@@@A@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@A@@@C@@@A@@@@@@@AB@@@@@@A@@@AB@@E@@@@@@@@BA@@@@@@BA@@@@@@@@@@B@@@@@@@@BB@@@@@B@@@@B@@@@BBD@BBB@@@@@B@@B@@@@B@@@@B@@@@D@@@@@B@@@B@@@B@@@B@@@@@@@BB@@@@@@@@@@@@@@@@B@@@@@@ABA@@BBB@@@B@@@@@@@B@@@@@@AB@@@@@B@@AB@@@@@B@@A@@@@B@@@@@B@@@B@@@@@D@@@@@@B@@@@@B@@@@@@@B@B@@@B@@@B@@@@A@@B@@@B@@A@@@@BB@@B@@BB@@@@@@@@B@@@@@@@B@@ABCB@@A@AB@@@@A@AAA@@@@@A@@@@@@B@@BDBB@@@@@B@@CD@@AD@@ABA@@B@@@@@B@@AB@@@@@@@B@@A@@@@B@@@@@@@@@@AB@@@B@@AB@@@@@@BB@@@@A@@@@B@@A@@@@@@@@B@@@@@@@@@@@B@@@@@@@@BB@B@B@@@@@@@@@@ADA@@BA@@@@@@@@@@B@@@@@BB@@B@@@@BB@@@BD@B@@@@@@B@@BB@B@@@@@B@B@@ADBD@@@D@@@BA@@B@@@BCD@@@@@B@@@@@B@@@@@@B@BB@@@@B@@@@@@@B@@@B@@@@@@@A@@B@@@@@@@BAB@B@@@@B@@BB@@B@@@B@@@B@@A@@B@@@@A@A@AB@@@@AA@B@@@@@@@@@B@@@@@@@@BD@@B@@@B@@@B@@@@@@@B@D@@BB@@@@@@@@L@D@@@@@B@@@@@@@@AB@@@@A@A@@@@@@@A@@B@@@@@B@@A@@@@B@@@@@@@@A@@@@@@BA@@@A@@@@BAB@BBD@B@@@@@@@@AB@@BB@@@@B@@@@@B@@@@@B@@@@BDADCB@@A@@@@@@@@BB@@@@@@@@@@DD@@B@@@@@@@B@D@@@@B@B@@A@@@@@@@A@@@ABA@@B@@@@BB@@@@FB@@@@BB@@@@@@@B@@@@@@@B@BAB@@@@@@@B@@@@A@@@@@@@A@@@@BA@@@@@@@@@@B@@@@@@@BBF@B@B@B@@@B@@@B@@B@@B@@@F@D@@@@A@@BAD@BA@@@@B@@@@@@@BA@@@@BA@ABA@@@@@AAA@@@A@A@@@A@@@A@@@A@@BA@@@CDA@AD@@@@AB@@A@@@A@@@@@@@@BA@@BAD@@@BAB@@A@@@A@@@@A@@A@@AA@@@A@@@AB@@@@@@A@@B@B@BA@@@@@@@@@BB@@@@@BB@@@@B@@@@@@@@A@@@@@@BA@@@A@C@AB@@A@@@@BA@@@@@@@@@@@@B@@@@@B@B@@@DAD@@@B@D@D@@@BB@BBB@BB@BB@@@@B@BBAB@B@@BBBBB@@@B@BB@AB@@@B@BAD@@@B@BBBBD@@@@@@B@@B@@@@B@@@B@@@B@@A@@@AAA@@B@@CBAB@B@@@@B@@@D@@@B@@AB@@@B@@BB@B@@@@@@BB@@@@BFBD@@@BB@@B@@BD@@@@B@@@D@@@BA@A@@DA@A@AB@BC@@@@@@DC@@@@@A@@@@B@@A@C@@@@@@@A@E@ADA@@@@D@B@B@@@@@BABC@@@@B@BA@@B@B@@A@@BABCB@BA@@@ABA@@@ADAD@B@B@@@B@BB@@B@@@@BB@@@BBB@B@DBB@BBB@@B@@@B@B@D@@AF@@@@AB@@@@@@@B@@@@@@@@B@@@B@B@@@B@@@BB@@BB@D@FB@BB@@@@@@B@BA@@B@BAB@B@BBBDB@@@@@@@BB@@@@B@@BB@@@@@BF@B@BBD@D@@AD@@AB@BA@@@@@@BB@@BBB@@BD@@B@BB@@@@B@@A@@B@@@@@BB@@BB@@@ADAB@@@@@B@@@B@HAB@F@FC@@FAB@@AB@@@BCD@FC@@@AB@@@@A@A@E@@BA@@@@@@B@@@@@BA@@@@@@B@@@@@BBB@@@@BB@@AB@B@BABA@@@A@@@A@@AA@@@@@A@@BAB@@@@ABA@CDEB@@@@@@ABAB@@@BAB@@AB@B@@AB@@@B@BA@AJG@@@@BA@@BABAB@@A@@@@@@DAHGJE@@@AAECA@@@@A@@@@BA@@AA@@@@@@@@@@A@@@@@AB@@A@@@@BA@@@@@@@@BADAB@D@F@@@@@B@@@@@B@D@@@D@@@@@@@B@@@@@@@DCD@JDB@@B@@B@@@BB@@@@B@@@@@BB@@@@BB@@@@@@B@@BB@@@@@B@B@@@@@B@@@B@@@@@BB@@@@JDBDB@BDAD@J@@@@@@@@@B@@DFBB@@@@B@@@@@@BB@@@@@@@@@@B@@B@@@BB@@@@@HDD@@BB@@@@B@@B@@@@BB@@@@B@@B@@DBDD@@B@@@@@@@@BB@@@@@@@@@@@@B@DDDBBHD@@@@@@@B@@B@@@@@@B@@@@@@B@@B@@B@@@@@@@B@BB@@@@BA@@B@@@B@BA@@@@@@BA@@B@@@BA@@@@REJE@@B@@AB@B@@AB@B@@@@@H@B@B@@@NAD@@@@@BA@@@@B@@@@@@@B@@@@@B@@@@A@@@@BA@@@@@A@@@@@@@AA@@@A@@A@@A@@A@@@@A@@@@A@@@AA@@@@@@A@@@@A@@A@K@@@A@A@@@@@A@@@@@A@@A@@@@@@@@@BA@A@@DEHCBAL@@BB@B@BB@@B@@@@@@@B@@@H@DABAFC@ABABABCHD@@@@B@@@@@@@B@@@B@HBDBBBDFB@@B@@@@@@B@@@HB@@DB@@@@@DAB@B@@@@@@@@@@@B@@B@@@@@@@@@BB@@@@@BB@@@DBB@@@@@B@BAD@BAB@@@@@@@@@B@@@@@B@@@@@@@BBDBBD@D@@@@BBHB@@@@B@@@@@B@@A@@@@B@@@@@@@B@@@B@BBB@B@@C@A@ABC@CAADCDA@@FEDA@@DABCFIBAFCBG@ADABA@CBA@ADABABABA@C@ABA@C@AAACCCEAAAAAAA@A@A@EBABADADEDC@A@EDC@E@C@AACAAAACA@BAAAA@CAA@E@E@C@@C@ACC@A@ABA@@@A@@GEAA@@AAB@@CBA@@@AA@@@A@A@@A@A@@AAAAAA@A@CBA@A@CAC@A@A@ADAH@FAD@F@DAB@D@DCDAF@D@DEFCB@D@BCBCAEAEAC@CBABA@C@AAA@AAACAAACAA@C@C@CCACCCCAABAB@BADAB@BCACAC@CA@ABC@C@EBA@ACCG@AAA@@AA@@AAA@@@AAA@@@AB@@ABCDA@@D@DA@@B@@@BA@ADA@A@@AACCCC@A@@@CCCEA@AAC@G@@CIAC@@B@@@@BBAA@@AD@B@@ABBBAA@@AB@@@B@B@@A@@@A@@A@@@@BA@ABAB@A@AB@@AB@AA@BA@@AA@AA@A@A@A@@AA@C@A@@AAA@@BA@@@@@A@@@A@@@@B@@ABA@@@C@ABA@AB@@A@CAA@A@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@A@@@@@@@A@@@@A@@@@A@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@A@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@A@@@@@A@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@A@@@@@@@AA@@@@@@@@A@@@A@@@@@@@@@@@A@@A@@@@@@@@@@A@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@AA@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@A@A@@@@@@@@@@@@@A@@@C@@@A@@@@@@@@@@@@@@@@DA@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@A@@@@@@@@@@@@@@BAB@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@D@B@B@D@@@B@@AD@@@BBD@@@BA@A@@@@BAB@@A@@@A@C@ABA@@D@B@B@D@DABABA@@BC@A@A@AB@B@B@@@@A@@@A@@BC@AAA@@@A@@@@@@@@BAAA@CB@@@AC@@BA@@BA@@@A@@AA@@@A@@@C@A@@@@@A@@@CB@@@@@@@AA@@@@@@@@@@@@@A@ABA@@B@AB@@@@ABB@DA@@@CA@@@@@@@@@@@@@@A@@@@A@@@AAA@B@AA@@@AA@B@@@BC@@AA@@AA@@@A@@AB@@AA@@@@B@@@@@@@@@@@@@@@@AA@@@@@@@@@@A@@BE@@@@B@@@@@@@@@F@@@@ABA@@@AB@@@BA@@@AAA@A@@BC@@BAA@B@@@B@@A@@ACB@B@@@B@@AA@@@A@@@@@BABB@@@A@@DA@@@@B@BA@@@A@@@@C@AB@@@B@@A@C@@@A@@@@@AAA@A@A@@A@@@@@@@A@A@@B@BA@@@@A@@@@C@@@AB@@@B@@@@@B@@D@@@A@A@@@@@@B@@@@B@@@@@C@@A@@@@@A@ABA@@@@@AA@@B@BABBB@@A@@@@A@@@A@B@AA@@@B@@A@@@@A@@A@B@@B@@AB@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@ABC@@@A@@B@@@@BA@B@@@@@BA@@@B@@@BB@@C@@@A@A@@@@@@@B@@@BA@A@@@A@@A@@@@@@A@@@@@@@BA@A@@@@@@@@B@@BB@@@A@@@@@A@@@@@B@@B@@BA@@AA@A@@@@@B@@@@A@@@@A@@@ABA@@@@@A@A@@A@AA@@B@@BB@AA@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@AAC@A@AA@@@@AB@@AB@@@@@@@@@BA@@@@@@B@@@@@@AD@BA@@@@@A@ABA@@@@AADCB@@@@A@@AA@A@@@@@@@@BABAB@@@D@BBB@BB@ADAAEEE@@B@B@@@@@BA@@@A@@A@AA@@A@@AAA@A@@A@@@@@@@@@@@@@AADA@A@C@A@@EAA@@@@B@@@BA@@@@@A@@@@@@@@@@@@ABA@A@AB@A@AC@BA@A@@@A@AA@@B@@AC@@A@A@@@@@@@A@@@@@@@A@@@@BC@@@@@A@AA@AAAA@@B@B@@A@@@A@@B@@A@CAA@C@@@A@@@@BBB@B@@@@@@AA@@A@C@A@@@@A@@@AA@@@CA@A@AB@A@A@@@@A@@B@B@@@@@AAA@A@AB@@C@A@A@@@CA@@@A@@@@@@@A@AAAA@@AA@@C@@A@@B@B@@A@A@E@A@@@A@@D@@@@@B@@A@@@A@AB@B@DBBB@@BA@@@@BB@@@@@@B@@A@@BB@@BB@A@@BA@@B@B@BB@@@@B@@@DA@@@@BA@@BDD@@@AB@@@@@@B@BA@@B@@@@@B@B@@@B@@@B@B@@@@ABCAA@@@@@@@@@A@@A@@@@@AC@@@ABB@@@@B@@AAA@@@A@@@@@@@AA@@@@A@@@@B@@A@@BA@@@@@@@A@AA@AA@@@@B@@@@@@@@A@@BA@@@@BC@@B@@A@@@@@@@AB@@@@@B@A@@@@A@@@@AB@A@@AB@@@@@@@@@@AA@@B@@@@@@AF@DCDABAHAB@B@B@@BBD@BDDBB@BDBBAD@DADBD@@BB@BDBD@@BBB@@@@AB@@ABA@AFGB@DEAA@@@AA@@AB@@@@@@@@@B@@ABA@@@@@@@BB@BA@@@A@@A@@@AA@@@@@@B@@@B@@@B@@BBC@@@@@@@@@@@@@@@A@@@@@@@@@ABA@@@@@@BB@@@@@@DCBAA@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@AAA@@@@@@A@@BCBA@@@@B@@@@@@BBBBCDBBADA@@@@@@@@@@@@B@@@@@@@@@@@@B@@B@@@@@BA@@B@@@B@@@@@@@@@B@@@@@B@@@@@@@@@@A@@@@A@@AB@HA@@HAAA@Q@EACAGACCG@@B@@A@E@@DA@@@C@AEEDCCC@MGIC@AABEEA@C@GBCBAB@@AAADABABABC@@AAAC@AAA@@ACGCEC@@AAB@@@BA@A@@A@@@A@@@@A@@B@@@B@B@@@@@B@A@AA@@@@BA@@AAA@@A@CA@@@AA@@BA@@B@@A@@@A@A@@BA@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@AB@B@@AB@BA@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@C@@@@@A@@@@BABA@@@@@@@@B@BA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@C@A@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@AA@A@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@@@@@@@A@@@@@@@@@@@@@AACAA@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@CA@CA@@@@@@@A@@@C@@@@@@CAE@AAA@@A@AAE@A@@@@@@@@@CAA@@@@BC@@DC@E@A@A@@CCAA@@@A@@@I@@B@@@@@@@@@B@@@BB@@@@@@B@@@@@@A@@@@B@@AA@AAC@@C@@@@BAD@D@@A@@@@@A@@@A@@BCBA@@BB@@B@@AAA@@@A@@B@@@BA@@@@CA@@A@EACA@@BA@@@@@A@A@@@@@@@@@@@@@@@@@@A@@A@@BA@AB@@AA@A@CACDEDGBEA@@GC@ACACC@@ECCCECCAA@A@A@E@CBCBGFCBCBA@G@@@AA@@@@@@@@AA@C@CBA@@AA@A@@@AB@B@@@B@@CB@B@@@@@@B@BB@@AB@@@B@@ABA@@BA@@@A@@BA@AAAA@@A@A@AB@@A@B@@BB@@B@@ABABABABAAA@@BABAAC@@A@CB@@AA@@@@@@@@@C@@@@@A@A@@@@A@@@@@@@@@@B@@@@ABA@AAABA@A@@@CA@A@BA@@BAB@@BBB@F@@@@@ABEDA@CBA@A@AB@@AA@@BA@@A@@B@@@B@B@@A@A@@@@A@AB@@AA@@AA@BABC@@@AA@@A@@@@BB@@B@@@BA@@C@AA@@A@@B@BA@@DAB@@@@@@C@AA@@@@A@@A@@@@@@@@A@@@@@A@@@@BBBAB@B@BBD@L@B@@AAA@@A@@@A@@ACA@CB@@BB@@A@@@AC@BA@BB@@@@@@@@C@@A@@AAAB@@AA@BAB@@@@AA@@@A@@AEB@B@@BB@@@A@@AAA@A@A@@BA@A@@@@@@@@@A@A@@@@@@@A@A@@@A@A@A@A@@@A@@C@AA@@A@ABC@@B@ABC@@@@A@@DA@@BCAC@@@AA@@@A@@A@@@@BA@ABA@@BAA@@@@A@A@AACBAA@AA@A@AB@@AB@@ABA@A@@B@@@BBBB@B@@B@@ABADAD@BA@AB@BA@@@ABA@@@@B@@@BA@@BA@CAA@@@ABAB@@@B@D@@@@@BA@@@AA@@A@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@@BA@@B@@A@AA@@@@@@@@CA@@@@@@@@@@A@@@AA@CA@AAA@AAA@ABAB@@AAAACAAB@@AA@@DA@A@@@@@@A@@@@@@@CBA@@@@BC@@@@@@@@@@@@@@@@@@@A@@@@@AB@D@BBB@BBH@@@B@B@@A@A@@@AD@@@B@D@@@@A@A@C@A@@@@@@@A@@@@@@@@@A@@@A@A@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@A@AAA@@A@@BAB@@@@CAAAAA@@@@@@@A@C@C@AC@A@AB@@AA@@@A@@CE@A@@A@@@A@BB@B@@@@@@A@@@@C@A@@A@A@@@AA@@@AAA@A@@AAA@@@AC@A@@BAFC@A@@@@@@@@@@@@@@@@AC@@CA@@DE@CBA@@@A@@AC@C@@CA@@@@@@@@AAA@CB@@CB@@@B@@A@AB@@@B@BA@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@BB@@A@CB@AAAA@@AA@@@A@ABC@A@@BABA@@AA@@@A@@@@BBB@@@B@@@BC@@@@@@@@@DB@@B@A@AB@B@@BA@@BB@@AB@@A@@D@@A@A@A@A@A@E@ABA@@@A@@BA@@B@@@BB@B@@B@B@BBBBBD@B@@B@@A@AB@@@B@@AABA@@@@@@A@AB@B@B@D@@@@C@@@@@AC@@@@@@BD@BB@@@@B@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@B@B@BABABA@A@A@A@@@BBBA@@@@AB@BADABAB@@A@EA@@BA@A@@A@@@@@@@@@@@@@@@@B@@@BA@A@A@@A@A@A@@AB@B@@A@@@A@@AA@C@@B@@@@@AA@@@@@ABBBA@@@@AA@@@@@@BBDABABAB@BA@@@A@@@@@@B@@@@@@B@@BBB@@BB@BABCAAA@@@@@B@DB@@B@@@B@@B@@B@@@B@@@@@@B@BBB@@B@B@B@@B@@@B@@BA@@AA@@B@@@@@B@@BB@BA@@@@B@B@@A@@@@BB@B@@B@@@BCA@@@@@@@BBB@@D@@BB@A@A@A@@@A@BA@@AAA@AA@@@BB@@B@@@B@@A@CAC@@@@@B@@BB@B@@B@@CAA@@@@BB@BBB@@@@BA@@B@@B@@@BA@@@@AB@BABA@@@@@@BA@@@@@AA@A@@A@@@@@AB@@@@@@@@AA@@@@@@@@@@AA@@@C@AAA@@@@@@@BA@@@@AAA@@@@A@@@@@AA@@A@@BBB@@@@@@@B@@@B@@@@@@@@@@@@@@@@@@@@AB@BA@@@@@BC@@@@@@@@@@@@@A@@@AA@@B@@@@@@@@@B@@A@@A@@@@@@@A@@@@@@@@A@@@@@@D@BBB@BC@@@@B@@A@A@@@@@@@ABA@@@AAAB@@@AAA@@A@ABA@@@@AB@B@A@@ACA@B@B@BA@@@@@@@@@B@@@BB@B@@A@@@AAA@@@@A@A@@BAA@@@@BA@AB@@ABB@ABA@@A@@AB@B@@@@@@A@AA@@A@@@A@@@A@@@@@@@@B@@@B@@C@AC@AA@A@@@@@@@@@@@@@@@ABAB@@@@A@A@@B@B@BA@@@BA@@@@@@A@AB@@@@@AB@@AB@A@@@A@AB@@@AB@BAB@@@@A@@ABABA@@@AAA@@@@@AB@@B@@BB@@@@@@B@@AA@@@@AD@@@@DA@@@BA@ABABA@B@@@@B@@@B@@@B@B@@B@BA@@@@@@BB@@@@CBAB@@AA@@AA@@ABA@A@@@BBB@B@@@@BB@B@B@BABA@AD@@@@@AB@B@BB@@B@@@@@@@@ABDB@@BAB@@@@BA@CD@@A@@@@@CB@@@@@@@@@BAB@@@@B@@@@@@@@@@@B@@A@@B@B@@@@A@@@@@B@@B@@@@@B@@BA@@@@@@@AA@@@@ABA@@@@B@@BAB@@@@B@D@@@@AB@@B@B@@@B@@@BB@@BB@@@A@@@A@@@@B@B@@@@@@B@@@@@@@@B@@@B@@@@@@@@@@@B@BA@@@@@B@@@B@@BA@@@@B@BB@@AA@@BA@B@@@@@BA@@B@@@@@@@@@@B@B@B@@@@B@@@D@BE@A@AD@@@B@@@D@BAD@@@@A@@A@@A@AB@@AB@BA@A@A@@@A@A@@BAB@B@@A@@@AA@@A@@@C@@@A@@A@CA@@BBB@@A@@@A@@@BA@A@@@@A@@B@B@@@B@@AAA@@@@A@AD@B@@@@A@@A@@@@A@A@@BA@A@@@@AA@@@@@A@@@A@AAA@AA@@@A@@@ABC@A@@@@@D@@@D@@@AB@@A@ABAA@@ABA@AB@@@BAB@@@@ABBB@@D@@B@B@@@@@@AB@D@B@@A@@@A@@B@@AB@@@B@@D@BBB@BBB@@B@B@@@@@@@@@@@@@@A@A@@B@@@B@@@@@B@@@@@B@@@B@BBB@@@D@B@@BB@@@@@@A@@@@@AB@@@@@@@A@@@@@@@@@@@@@@AB@@AB@@AA@AAA@@AA@A@@@@@@ABBBBB@@@@@@AB@@@@@D@@@BBDAB@@@@@@@@@@@@CD@@@BB@BBBB@@@@@B@@@@@@@@@@A@@@@@@@A@@@A@@B@@@@@@@@@@@@BB@BB@ABBB@@ABC@ABA@AA@@@@@@@@@@@@@A@A@@@@A@A@@@AAB@@AB@DB@@B@@@BC@@@@@@@@@@@@@@CACA@@@AA@C@ABA@@AA@@@@D@@@@BA@@DB@@B@@@@B@@@@ABAAA@A@@@@B@@B@@@BB@@@@C@@DAB@@@@@@@@@@@@@@@@ABAB@@@@@@@@ABA@AB@B@B@@@@@@A@@@D@@@BABB@@BC@A@@@@@@@@@@@ABA@@@@@A@@@@@@@@@@@@B@@@@@BB@@@@@@@@@@@@@@@@@@@@B@@@@@@@BA@A@AB@B@B@@BB@@B@@@@@@A@@@@B@BAD@B@BA@@@@@@@@@@@@@@B@@@@@@@@A@@@AAA@@@@B@@@@@@@@@@@@@BC@@BA@AB@@A@C@@B@@@@@BB@B@FC@AB@@B@@@@@@B@BAB@AA@@BAB@BAB@@ABA@B@ADAB@B@@@@@@@@@@@@@@@@BBD@@A@@@@B@DB@@@BBB@D@@@BB@D@F@@@@A@AAAA@AA@A@@@@@AB@@A@@@@@A@@@@@AA@A@@@@AB@BABCBA@ABA@@B@@A@@@@@AA@@AA@AB@@A@@A@@@@BA@C@A@@@A@@@@B@B@@@@@@@B@@@@@B@@@@@@@@BBB@B@B@BB@@@@BB@@ABAAA@@BA@@@A@A@A@CDAB@@DB@@ABAD@D@@@BB@DD@@B@BBB@BA@@@@@@@@B@@@B@B@B@@@@@BB@@@BBBB@BBBA@@@@@@@B@@@@BAB@B@@@@B@@@@@@@@@@@@@@D@B@B@@B@@DBB@@@@A@@@A@@@@B@@BB@FB@DAB@B@B@B@@ABC@AD@@BDB@BB@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@AA@A@@@@B@@@@BB@@@@A@@@ACCB@@ABAD@@A@@@@@BA@@@AA@@AA@@@@@@@@@@@@@@@@A@A@@A@@@AA@@@A@@BA@A@@@@@@@@@@@@@@@@@@@@@@@@@A@AA@A@@ABA@A@@AA@@@AACA@A@A@AB@@@B@BBB@@@@@@@@@@@@@@@@@@@@AD@BAB@@A@C@A@AA@@@ACA@A@A@A@AA@@@A@CBA@@B@@@B@@ABA@C@@@@BB@@BA@AB@B@DAAAA@@A@@@@B@B@@@@@AA@AAA@@BCA@@@B@@@@@@@@@@@@@@@@BBD@B@@@A@ABA@@@@@BB@@@@@@@@@@@@@@@B@@@D@@@BABADAB@@AB@BA@ABABA@@B@@A@AAA@@@AB@@@@@B@@@B@@B@B@@@A@@@@@@B@B@B@BA@@ABAAAAA@BA@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@AA@A@@AB@B@@A@@B@@BD@BB@@@@@@@@@@BB@@@BB@@@@@@@@@@@@@@A@@@@BA@@@@B@@A@A@A@@@@BABA@@B@D@@@@@AB@BA@@@@@@AB@BABBB@@DA@@B@DA@AA@@@D@@@ABAB@BAB@@@@DB@@@B@BBBB@@@@A@@@A@@@A@@BA@@@@@@@@@@B@@@@DBB@@@B@@@@@@@@@@B@@BD@@@D@@@B@@@BD@@@B@BAB@@@B@@AB@B@@@@BB@@@@@DBB@@@@@D@@AB@@AFFDFBFJDBDHFHFBFFBBABA@DB@@@B@BAB@B@@AD@@@@@@@@@@@@@@@@B@B@@@@@@@@B@@@@@@@@@@@@@B@B@@@@@@@@@BB@B@@BABA@@@@@@@@@@BB@@@@@@A@@@@BF@@@B@@A@A@@@@BB@@@@B@@A@@B@@A@@B@BA@@@@@@@A@AB@@A@@@A@A@@DDABBB@@B@B@@B@@BBB@@@@@@@@@BA@@B@B@BBB@@@@CAA@@@@@@B@B@@B@B@@@@B@@CBAB@@@@A@@@ABAB@@@@A@BCA@EB@@C@@@BDBH@D@@@@@@@@@@@@AB@@A@@@A@E@@@A@AAACBC@@BC@@A@A@A@@@@@ACA@A@CAA@ABAB@B@@@@CBE@C@A@C@@@CB@@@B@DBF@BB@@BBA@@@@BABA@@B@@BB@B@@@DCB@D@F@BB@@@D@B@@@@E@@@@@BB@@BB@B@@@@@@@@@@@@C@@@EB@@AB@B@@A@AAAAA@@A@A@@GCCAAAAG@A@CAA@@@@@@@@@@@@A@@B@@@BABABA@@B@@@@A@AB@@@D@B@@@@@B@@@@@B@@@@BD@@@@@B@@@@@@@@@@@B@@AB@@@F@B@B@@@@@@@@@@@@@@@@@@@@@D@BB@@B@@@@@@@@@B@@@@@@@@@B@B@@@B@@@@@@@@B@@@@B@@A@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@BH@B@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@CB@@@@BBBB@BB@@@@@BA@@@B@B@@@@@B@B@@B@@@B@@B@B@@@@BB@@B@@@BA@@@@@@@@B@@B@@@@@@@B@@AB@B@@B@BB@BA@@@A@BB@BBD@@BDADAD@B@B@@@@AB@@@@B@@@BDB@@B@@B@BA@@@@D@@@BHD@@@B@BA@@@@@@@@@@@@@@@@@@@@@@@@AG@@@@@A@@@@@@@@@@B@@@DB@@@@@@@@@@@@@@@@@@@@@@@B@@@@B@@BB@@@BA@@BA@@@@@@@@@@@@@@@@@@@@@ABA@AB@BABA@@@@@AAB@BA@@@@@@A@AB@@A@@@A@AAABA@B@@@B@@B@B@@@@@@@@@@BB@B@@BB@@@B@B@@@@A@A@@@A@@@A@@AA@AAAAA@A@AB@B@AD@@B@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@B@B@@@@@@BB@BD@B@@@HB@@BA@A@@BBBBBBDD@@B@BA@BB@BB@BBB@@BB@BB@@B@B@@AB@@@@BB@D@B@@A@@@@A@A@A@@@DA@@AA@@@@@@@@@@B@B@B@@@@AB@B@@ABB@@DA@@@@@A@@@@@AA@@@@A@CB@@@B@@DA@@BA@@@B@@@B@@B@@B@@CA@@@@@B@B@@B@B@B@@A@@@A@@@@@@B@@@@@@@@@@@AD@@@@@@@@@BA@@@@@A@A@AB@@@@B@B@@@@@A@A@@@@B@@@B@@@@@@@@@@A@@A@@@B@@@BBA@@@@@@@@@@@@@BBB@@B@@@@BA@@@AD@BADA@@BA@B@BBBB@@BBBB@@AB@@@BBFBB@B@BA@ABA@@@@B@@@B@@B@B@B@@B@@B@B@B@DA@@@@@@AB@@B@B@@@B@BE@A@@BBAB@@@@@@B@B@@ABA@@@@@BAB@@A@@B@@@@@@@@@@@@DB@@BA@ABA@BABAB@B@@@@B@@@B@@A@@@A@@BA@@@B@@ABB@@BA@@B@@B@D@BA@@@AAA@A@@BB@BDB@@BA@A@@@A@@@B@B@BA@A@@@@@@@@B@@@@@@@A@@@@A@@@AB@@@BB@B@B@@AB@D@@A@@@@@BA@@@AB@@BB@@@@B@@@@@A@@@@@@B@@@@@B@@A@@BD@@@C@A@AB@@A@A@@B@B@AA@BAA@@A@@A@@@@@@B@@A@@A@@B@@@A@@@A@@@@B@@A@BA@@@AB@BA@@@AAA@@AA@@AB@@BB@@ADA@@@@A@@A@@@@@ADA@@BB@@B@AB@BA@@AB@@@B@@@@@@BAB@@@AB@@A@@@@@@BBB@@B@@AB@BABB@@@@A@@@@@A@@@@B@@D@BB@B@@@@@A@A@@@@B@@BB@@@@@@@@@@@@AB@@@@A@@B@@A@@@AD@@BA@A@@@@@@BB@B@@@@@D@@BA@@AA@@@A@@B@@@B@@@@B@@B@@B@B@B@@@@@AB@@C@@@A@@@@@@B@@@BA@@B@@@B@@B@@ABB@@AB@@@AA@@@@B@@@@@BB@@@BA@@@@BC@@B@@CB@BA@@@BA@@@@BB@@B@@@@A@@@@A@@@@@@A@@BB@CBDB@@@@@BA@BB@@@@@@B@@A@@@BAB@@@@@@BBB@BA@@@@ABB@@@@@B@@@AB@BA@@@BA@@A@@AA@@@AB@@BB@@BA@@@@AB@@AB@@A@AB@BB@@@B@B@B@@@@ADA@A@@@A@B@B@@@@@@@B@@@@@B@@AA@@@@AB@BAB@@BB@@BAB@@@BA@A@A@A@@B@@C@@@D@@@@@B@D@@@@@@@@@@@BAA@BA@@@@B@@DA@@BA@A@@@@@ABB@@B@@AA@@A@@@@D@@BA@@B@BB@@B@@BABA@BCB@@@BB@@B@@B@@C@AB@@ABBA@@BA@@B@@@@BBBABA@C@@B@BBB@@@B@@@B@DB@@FD@@@BB@@B@@AB@@@BB@@@@@@@A@A@@@@B@@@A@@@@A@@@A@@@B@@BA@@@@@@@@AABAB@@@C@@@@A@@@@B@@@@@@@@A@A@@BB@@A@B@@A@@@A@@@A@@@@@@@@@@@@B@BA@@@@@@AA@A@@@@B@@@@A@AB@@@@BB@@@@A@C@@A@A@@@@@@A@@B@@C@@A@A@A@@AB@@@B@@@@@@A@A@@@A@@BAB@@@B@BAB@@ABA@@B@BAB@@E@C@C@C@C@@@ABABGEBA@AA@EAC@@B"]],encodeOffsets:[[[113136,21962]],[[113167,21991]],[[113370,22035]],[[113022,22289]],[[113041,22478]]]}},{type:"Feature",id:"440983",properties:{name:"信宜市",cp:[110.947043,22.354385],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AB@@@@A@@@@BA@@@CDABCD@@EBCBC@A@KHMFC@CB@BDDBBBBAB@BABEBCBG@ECAE@CGCA@E@C@ABAB@BEFEDC@C@EAECEECCA@E@GDADADOHGD@PAFAHDFFFDDDBBBDFDHBBBBDDDD@BBB@BBBBFBDDBBB@BABC@EBC@CBCFCFEDCDDDBDDD@D@DABEFYB@XCJABINCBADCDABA@C@CBABCBABA@@D@B@D@BAB@DBDBD@B@DGNCFAFAFABID@@GBCDC@CAAAACAEC@A@ABABAD@BCBCDED@BADAD@HBHBBDBAJ@F@FEF@@CHAB@FB@@BDBBFDDBDBF@BAV@B@N@DADCDCDABABI@C@GBA@ABAD@NABCBCDADA@@BAFCL@BB@@BDDDBD@B@FADAFCFGHGDAFAFCFAH@B@B@H@F@FBFBH@FBBBJP@@@F@@GNEJAB@BDHAB@D@DBDBBDBBDBD@B@D@D@BDFDDD@DBFAH@@@BBB@AD@@CHCF@BADAB@BCD@D@BDHDF@D@B@BABCBCBEDCF@@@B@@BBHBPDFL@DAHAHADAF@DDDBA@@DAD@D@BB@BBDBBF@HC@ABABEDC@@DCFAFCFCBC@CDMFGB@DBD@DB@@@D@B@B@D@DBHFFDDHDBBF@BBD@FBFBBB@BHBDBJ@D@FADAFAH@DBDDDDB@DADAB@DBFADAJCJ@JBJHLJ@@A@BHBFAFCDAF@F@B@@BFB@B@HAH@@@D@FAFADBH@FAD@FCHAH@H@D@JDB@FBHDFFBBBDBB@DBB@BBD@HADCF@BBBD@J@BBF@BBFDFBF@D@F@B@DA@GB@FCBAB@DCD@@BBD@BDDDBFBB@DBBB@B@BBD@BD@B@DBB@BB@@DBBBD@BBD@BBD@BABC@ABAB@DABAB@DBBB@BBBD@B@D@D@D@DBDAD@B@BBBBBBBDB@@B@B@B@@@B@B@@BB@DAB@B@@@B@@B@BB@BB@D@D@B@BAB@BBD@BBBBBBBB@B@BBB@B@BBDBBDDBDB@BB@@BD@DBBBB@@D@@B@B@B@D@@D@F@B@D@B@B@BBF@B@@@B@@BAB@DBDBDDB@BD@B@BAB@D@B@D@B@@ABADB@@B@BA@@@C@@AABAB@@A@AB@BABABCD@@ABABABAB@DABA@A@@AA@ABC@A@C@A@@@A@CA@AAAACCEEA@@A@@@ABABA@A@A@A@@BABA@ABG@CBADA@CBCAA@A@@BA@@B@BCDA@@DA@ABC@CB@@C@CAA@A@C@@@AD@B@DAD@B@FCBABAB@F@B@BADEDCDCBCBADEDAB@B@DAD@D@B@DAB@@BBBB@B@B@B@BBBB@@BAB@BB@@@B@BB@B@DADC@ABB@A@@BA@AB@@AB@DA@A@A@A@CBABAD@F@BAB@BABC@@BA@A@C@A@AB@B@B@DAD@@@B@D@B@D@BAB@FAB@B@D@@BBBDBBA@@BAB@B@D@@BBDBBBBBBD@B@B@DBDBD@B@DBB@DBD@@AD@D@D@D@DAB@B@@ADAF@B@B@@A@A@@BAB@B@B@@AB@BCB@B@B@B@BADA@@BA@A@C@@@ABA@@A@@AA@@AA@BABC@ADADADA@AB@FCDADCFABADAD@DA@@@A@A@A@A@AAAA@AAAAC@C@C@A@C@CA@@ABA@A@AAA@AAA@C@C@A@C@CBA@CA@CAA@CAA@AAC@C@CAA@AA@@AAA@CACAC@AAAA@@@CA@AAAAAA@CA@A@C@AACAAAA@AACAA@CACBC@C@AB@BC@A@AB@BABABBDB@DBB@B@BBBB@BBB@B@@CBABCBBBBBBBD@D@DBBBBB@B@D@@@B@B@DBB@D@BBB@DDBB@@BBBAB@DAB@D@@@BDBB@DDB@@BB@@BAB@D@D@B@B@BBBBFD@DB@BBB@B@BA@A@ABAB@B@BA@ABCBA@AB@@CBABA@ABAB@@@BBBB@@BADABBD@B@BD@BB@DB@@FDHBHBB@@ADA@ABADA@BB@BCB@BABBB@BBDBBBB@@@B@BABB@@BBB@B@B@B@B@@AB@BB@@BBBB@@D@@AB@@AB@@@B@B@FADA@@@@BCAA@A@CBAAAAA@EAE@CAC@C@@AAAA@AACAAAACA@@AEBABCBAB@B@BC@AC@BA@AB@BCAC@@@CBABA@C@@AC@A@A@ABABA@@AABA@AB@@A@AB@BADAB@B@DBB@BCAAAA@E@AAA@C@CA@AAAA@CBC@ADAB@@CBAD@B@BABB@@@BD@B@B@BAB@BB@BB@B@@AB@B@D@BB@BAB@BB@@BB@BDB@BHBB@BBDBBDAD@F@H@DBDBB@B@BCF@D@D@BDBD@DDFDB@B@D@@BBB@BB@BBBAD@@A@C@AB@DAB@D@BCB@DBB@B@BABBD@DBBB@BB@BAB@@ABA@@D@@ABCAABABBBAB@DB@BBBBBB@BB@B@B@B@@DBB@BD@BB@FBBDBDBB@D@DCBABA@ADAB@@@BAB@BABA@@B@B@D@BABABAB@B@BC@AB@BABAB@BAD@@ABABA@A@A@@DA@AD@D@BAB@DBBBBBBBB@BD@BBBB@B@@B@D@@B@@BBBB@BBBB@B@BBBDBDBBBAB@B@@AB@B@D@B@B@B@B@BBD@B@D@@A@@BBBBBD@BBB@BBD@HBBBB@B@B@@BAD@BA@@@@@@B@BDBBBBBBDDBBBDB@BBBAB@@BBDBFBB@DBBBDBDB@BD@D@DBDBBBFBB@B@BABAB@DBD@D@DABABAB@BA@ABC@A@A@AAA@A@AAA@A@A@AB@BBBBBBB@BADABAA@A@@A@@@A@AB@@A@@AA@A@A@AB@BA@A@AA@AAAAB@D@@@B@D@@@@AAE@C@CAC@CA@EA@AA@BAB@@CBA@CBA@ABA@@@AB@BBBBAB@B@BDDBB@DBB@B@B@BD@BAD@DC@@@@AA@ABABABABAD@BADB@ABAB@D@B@BB@@BA@CB@B@BBB@B@D@BBD@BB@BB@BAD@B@D@B@BBB@B@BBD@@@BABA@ABABA@@BAB@BA@@@CAA@A@ABABA@CAA@A@A@AB@BC@@AC@A@CB@BA@C@CBABA@A@EAECC@AAA@A@CAA@@AAA@CE@@A@CAAA@A@A@ACACAAAAAAA@@@CAC@@AAA@ACCAA@AAAABAAAA@AAACA@AAAAA@AE@@A@A@AA@AAA@AAA@AAAAACCCCAAAACAAAAA@A@ABAAAA@@@CAEACAACCAAAAC@AAA@AA@CB@BABA@A@C@A@A@@@@C@AA@A@ACCECG@C@EBAAAA@@AAAAACAA@A@AAC@CBCBC@E@CBCBA@C@ABA@ABA@AAA@C@A@AA@AA@CC@A@@AAA@E@CC@@CAAA@@ABCBA@ABC@C@A@@@AAGE@@A@AAA@A@AAAA@AACABA@CBC@ABC@AB@B@BAB@BC@A@CE@AAA@C@@AA@CAG@AACGEAAC@CC@@CCEAEA@@ACAC@CCAA@C@AAABA@ADA@C@A@CAC@@A@AA@CBA@A@A@CAAAAAA@BABABA@A@@@@@@@ACAAA@A@@AAAC@CAACG@@@AAAA@A@A@EA@@@ABAB@DAB@@@B@@@BA@@B@@ABA@AB@B@@@BAA@@AA@A@CCAC@E@@BA@CAAAA@C@EA@@ABAACA@@@@A@AB@BBBA@A@A@A@@AC@ACAA@A@AACBA@@BA@@@A@@@ABCAA@A@@@ABA@GDGBA@@@@@AAAAA@C@A@CAA@A@A@CCA@AAAC@AAA@A@A@AC@CA@CA@A@AAAAA@A@@BA@@A@@@AA@AACCAAAA@@EAGA@@GBA@@A@A@A@@@AAAAAABAAA@A@AB@BA@ABABABCB@B@@A@ABABA@A@@B@B@B@BB@@B@@BBB@BB@B@@@@@BBD@BABAB@B@BCBA@AB@@BBB@B@B@@B@@AB@@@DAB@BABABAB@B@@@B@BABABAB@BA@A@AB@@C@@@A@A@A@A@CBAAABA@@@AB@@CBA@ABABAB@@A@A@AB@BABA@@B@@@DCDABA@@BADA@CDABC@A@AAAAAAA@A@C@A@C@C@AACBAAA@CAC@C@CAECCAAAAA@CAAAAAA@A@@C@@@AA@A@@AACAAA@ABA@C@AAAAAAA@ABA@AA@AA@AA@A@A@A@C@A@ACA@A@A@ABAAAA@@@AAA@AB@BA@AAAAAA@@B@BABA@A@C@@AC@CAABA@@B@@@F@@AB@BA@@@ADCBCB@BA@AD@B@DAB@@BBAB@@A@A@C@A@C@A@AB@AAA@A@CA@AA@A@A@@CAA@ABC@AB@BA@@B@BAB@@AA@@AA@AA@AAAAA@CC@AAAAC@A@@B@@A@ABAA@A@@A@@@@CA@AAA@AA@@AA@A@@@CA@AAAA@CB@@C@ABA@@AA@@@C@AAAAA@@@AAAAABA@A@A@A@@@A@A@AAA@ABA@@@@B@B@B@DCBADA@AA@AAAAA@A@@AA@@A@A@AAA@A@A@AAA@CAA@GAAAABC@A@AB@B@@C@@A@@A@AAACAAC@@@A@A@@@A@A@A@A@ABA@A@@ACEA@@@A@AAAA@AA@AAABAAA@A@@AA@A@AB@BA@@@AB@BA@AB@@AB@@@BA@A@@@@AA@AA@A@@BA@A@@A@A@A@CAA@A@AAA@CAA@AACAA@AB@@AAA@@BA@@@A@A@@A@AAA@AAAABABA@@@AAA@A@A@A@AAC@A@AAAAA@@AA@AB@BBB@BAD@@ADA@@BB@A@@BA@A@@BA@@BA@A@CBA@@@@B@B@D@@AB@@@BAB@BAB@@AB@@A@CAA@@B@B@BAB@BABA@@AAB@@C@@@ABABABAB@@@AA@@@BA@A@@AA@AB@@CAA@A@@AAA@@AAAA@@@@AAA@AAAA@CAA@AABA@A@A@@AAAAA@A@A@@AA@A@@@A@A@AA@@AAAA@@AA@AAAC@A@CAAAAA@AAAAAAC@@AAAAA@AAC@@@ABA@@@A@A@G@MBCBCDGDEBCACAACCCEAC@O@GBGBGCECACCCCE@@CIEECAEACAAAGAGBCBCBC@A@AAAA@AAEAKCIGCGDGJEBC@I@CAAA@AFOAA@@EEG@A@IHAB@@@@"],encodeOffsets:[[113537,22723]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("梅州",{type:"FeatureCollection",features:[{type:"Feature",id:"441402",properties:{name:"梅江区",cp:[116.116695,24.31049],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AAAEGEG@@OJKJMJGBCBGD@@A@GDABIDG@E@GDA@KBQBQLMROHQBMC@@EBENAHCNBLBDBBVJDBBBB@DBFDDDBD@@AB@BAD@FABBB@@BBBBB@D@B@@BHFFHCFCHADCD@B@@C@@BEBA@A@CBABCBABAD@@ABAB@@AH@F@HBFBDBBBDBBFHFD@@DBBB@BDBFFBB@BDDBHB@@BDH@D@H@B@D@BA@A@@@AACA@@@@@@@@AACACAA@A@E@@@A@@@AAAAC@G@C@@@@@@@A@@@@@AA@@A@@A@@GBGDABGB@B@@@@ABCBAB@@A@@@@BCDEHCFEDEDC@@BEJCHIFEBICKGICE@@@@@C@IDEBC@EDCFCFCDGFEDCD@F@@BDBD@BHDFBDFBN@N@B@JEFAHAD@B@BNTFPBPDHB@FCB@FCDADADAD@D@@@JFFDBBDDBDBDDFHNDDF@H@NCHEHMHIJMBAFEB@@AB@B@B@@@@BAB@B@@BB@BB@B@@@DA@@BCB@BCBABA@C@A@ABABC@@BC@@JIHGJGBAFCHI@@BEAQ@A@GEO@KBEDEBCBALEB@H@D@RBRDHH@RDJLB\\ENEDEBBFDFFBHDBD@HCHEFIBCPGLEF@DDBBBAD@BHHB@AAEBCDCSKEECGFADBFFHBJ@DC@GHKFCLGLGNI@EBCHCNGFBDBDA@AAIBCHGHCBGFEF@FBX@FAD@B@DBF@HEHKHAAAAA@A@ABE@A@A@A@AAAA@A@CBA@AACAAABADA@@BABCBC@@AA@@AAC@CA@BA@CF@BA@A@AACCACACAACAAAAAAC@CACAC@C@ABEBCBEBCDCBAB@BABABC@CACACC@ECE@EACAGECGEIAGBEDCACCCECEC@@@AAABA@ADAD@@A@A@A@@C@C@C@A@CD@@A@AEEGCEIIC@AAKAEEECEK@MBKBMACCAA@CBCBABCDCBC@A@CCACCCAAA@ADADAH@D@F@BABEBG@C@E@GDEFCBABEBGBE@C@CA"],encodeOffsets:[[118990,24713]]}},{type:"Feature",id:"441422",properties:{name:"大埔县",cp:[116.695195,24.347782],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@BCBC@EBEDCDCBCBAACAABEBE@C@A@EAECEEGEGEEEDCDGDGHA@CBAB@DCDA@CDA@A@AAA@CDADABA@A@@AA@@@EBABABC@ABCB@BABAD@@CBGBEDA@ABAFBD@BBD@BABADAD@B@BABABA@CBEBCDEFEDCDCBGDEB@D@BABABEBC@CFEDABCBABA@@@A@C@C@EFCFADAF@F@D@BA@A@A@AA@AA@C@CBCDABABEBEBC@CBABADEBC@AD@BBDBBADADEDCDGBEBAAAACAA@A@@BC@CFEBEDEFEHCB@BAF@H@BABABABEDAD@B@B@BCD@D@DBD@BABA@AB@B@B@FABABCDCBABAB@F@FEDCFEBEBADAF@DBBBB@@@BA@ABCAA@EAE@ABA@CBCBA@A@AACAAACCC@AAE@CBADGHEFMFA@A@AACCACAA@@CACBEBEBGBGBCBCBCDABGFEDABEDEBABC@AAAAACAAA@@@C@CCACBC@A@ACCAACACAAAACACBG@G@A@BA@AAAA@A@@@@A@A@ABA@ABA@@@C@ABCB@@ABEAEBCBC@A@EAC@ABADKFIHCBCBE@E@ADABABA@ABCDADAFADAF@B@DBDBD@DBDBBBBDBBBBDBDDDBBB@B@@ADEB@@ADBD@BB@@BB@@ADADAB@@CBABBBDBBBB@DAB@B@BB@B@B@B@BAF@B@BBBBBHDJ@NBB@BDBDBDAD@@@BDBDBDBFBHDJBDBBB@@B@BF@@BFDBBDDFDFBB@D@BBBDFBBDBFBDBBABADAB@F@B@@BFBBDBFBDBDRRDDBD@BADCFAHAD@D@@@BBBBDDBDHDHBDBBDDBB@@@B@@@D@BE@CBCDCDABAD@B@DAB@BA@CBABABCHAFADBDDDFBB@@B@B@BAB@D@B@DAD@@ABABCB@@@@@B@BBB@BBD@@@D@FBF@@@@DJDH@DBDBBBBDBFBBB@D@B@BBBB@BBD@BADAD@D@@BBBBBBBDBD@FBD@DB@BBBBDBD@DBFDBBBBBD@RBR@BBDBDBBBBB@BBBBDBB@@BD@D@DABADAF@FDDFDBBBDABAF@BBBB@D@HADBF@FDDBBDFBDBF@@@FADABADCD@B@BBBB@B@FAHBD@F@DADBDBFBDBBDBDBBDDD@BBDBBD@BFDFBFBDCBEB@B@BABA@GBEDCDAFAD@B@FBDBDDBBBBDBFB@BB@BBDBHDFDDDDDBB@@BABCDABABABAFAH@B@@BDBB@BBBBBB@B@BBB@D@@B@@BB@BD@DBD@BBD@@BB@@B@B@@@B@B@B@B@BBBBBBBB@BBB@BBB@DBD@BBB@BBB@@@DDBFDDBD@BAB@@BB@B@@BDBBBBBBB@D@BAD@@A@@B@@ADADABABABAD@B@B@BBB@B@BABAB@@ABA@ABAB@@@BA@@D@B@B@B@B@@A@@B@B@@@@BBFBD@BBBB@D@B@@@BBBBBBB@B@B@B@@@BBB@B@BABBB@B@@DB@@BBBD@HBB@DBHBH@J@B@H@HAJD@@DAFAFCF@DBBBBB@D@DBBDDF@D@B@DB@@B@B@BAB@BAB@@@B@@@DBB@DBBBD@B@D@F@D@B@B@BAB@@ABA@@@@@ADEDCDCBADA@@B@B@@@RTFFDBDDLLBDBBB@BBB@@@B@B@B@F@DAD@DAB@D@B@BBD@@@B@BAB@@@BCDCBCBCBABCBABABA@A@A@A@ABA@ABA@AB@BA@A@@@A@@@AAA@@@AAC@A@A@A@@AA@@AA@@C@AAA@AAAA@AA@AAA@@@A@A@AAAA@AAA@C@A@A@CBC@A@ABCAAAA@AAAA@AAA@@ACAA@CAAA@AAA@@@A@AAAAAAA@AAAAC@ACCCCQOMOAAAEFGFKDK@@A@@AC@AAA@AAAAA@CAACAA@A@C@A@CBA@A@ABABABAB@@ABABAD@BCDCDADCN@B@B@@A@@@A@AAA@C@A@C@ABABEDEBCDEDCBADCBCDABCBADCBADABAB@DADAB@DAB@BAB@BAB@DA@@@A@@@AEAAC@AAABA@@B@@@DBHDFDDDBBBBDBDBDD@@B@@@BABADEDCBABCJIDABCBADE@CDEBABCDABA@ABABA@A@AAA@AAAA@@AAAA@AAAA@@A@@AA@A@ABC@A@C@ABA@A@@@@@AA@ABA@A@ABA@@@A@@@@@AA@AA@@A@AA@@@@@A@@@A@@@A@ABABABA@CBABC@ABCBABABAHCFCB@DEHKDIBAFA@@D@@CBEAA@GBCBABCBCBBDBFDFBF@@CBCBCDCDAFEBAFCBCBABC@CBABCBA@@@@@AACGGCGAK@EDCLGBAHE@A@C@A@C@A@A@A@A@ABA@ABAB@BAB@BABAB@BA@AB@@ABAAAGIAA@CAA@A@A@A@A@A@A@ABA@A@A@A@@@A@A@CBA@G@G@ADCDAB@B@JDHBBAD@D@BAB@BA@@@@BA@A@@@A@AAAAA@@@C@@@AB@B@B@@@BAAA@A@A@C@@@AC@@AAA@ABC@@AEBC@A@A@A@@AC@A@AAA@A@A@AB@@CBABA@ABA@@@A@A@C@C@CAC@@@A@@@@A@A@A@@@@AAC@AAA@A@EED@@CDEFEDEBCBEACACCACC@A@CACAACCACCAAAEBCFGDEBCB@BC@A@@@AAA@@CC@@AA@@@AA@@@A@A@ABA@A@KCIC@K@KAA@AAGAGACCEA@A@ECCCI@EDCFAHAD@FEFEDEBE@ECAACAKKGOAG@@C@GBEDE@CBCDCBCBC@ECAAACA@@AA@@A@@A@@AA@AACAACAAC@E@A@A@CAA@AA@C@CA@AAAAAACA@AAA@AKOCEIQ@S@MFQFI@C@GAEEOAEA@QPAA@@CE@CCECEACACCEECCACAA@AAAEACAAC@IBGDEDCDGDE@A@ACEACC@CAAAACAAA@A"],encodeOffsets:[[119502,24628]]}},{type:"Feature",id:"441403",properties:{name:"梅县区",cp:[116.081656,24.265926],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@CACAEAGAAACCGCAAC@@A@@IACACDADAB@DADADABCB@FAFAH@FABAF@BB@BBD@BBBBB@D@D@BBDDAFAB@@@B@DBBB@B@@B@D@D@BBBB@B@B@BDBBBBB@BBDBFDFDDB@@@BAB@BAB@@A@AAA@A@C@AAABABAB@BBBAB@BABCAAACACEEAA@CBABA@CBA@CAC@CBEBEBADABCBCDCB@D@@DBDF@DAFAB@@AB@D@D@D@FADCFCHAFCDEBE@EBKDED@@ADCBE@EBCBADADAFCFABA@A@CEAAEAG@GAA@CBCFEDEFADB@A@AHAD@B@DBDDBF@D@@BABAD@@BB@@D@F@D@BDBBBBF@FADA@@DAJABA@@@A@AAE@A@@D@@@B@BDBDDBB@J@B@FBH@F@@B@BABABABAD@B@@@BCBABA@AB@D@BEDCBCB@@CDCFC@CBABADBDBBBDAB@DAB@B@BABBD@FDFDDF@F@B@@BBBADCD@DB@B@B@BDDDDDFFBBDBD@FCFCJBFDBDABBBDBB@@DEBEDADCFEB@BAB@B@BBD@DBBFBF@H@H@D@BB@D@@A@ABED@@EDADAJAFCJ@@A@AD@@AACCAAEBEBGBCDCF@@AHAFBDB@FABBDBBD@FADCF@BADCBAB@B@@@DBB@B@B@DCB@@CD@B@B@BBBBB@BAFAFAHAFABEDABGBCAGCA@A@C@A@ABADA@@BA@EJEFC@@@A@@B@D@BAB@@@@A@@@A@AE@@AA@AA@@@C@@BC@EFCDEBGDA@A@A@@@IBC@C@ABABAD@BBBBFAFCJCDGDABAD@@C@AB@B@@@BDBBBDH@HCFCFAB@@GH@@EBCB@B@DBF@FEDAFAHEDABADBF@BABABGAGAI@AB@DAFADABC@CA@@CAABCBA@CBABC@E@CAAAC@C@A@GFCFAB@D@D@B@BA@EB@@@@GLCJA@ABE@EBEBCD@B@BDBBBBBBDBB@BABADC@CDAB@DDTBJ@N@BHBFBBD@D@F@JBFFDDF@FBH@DAB@@@@@DBBDBDBDDNRDH@JF@FDFDF@F@BADABABAFCF@FBDFB@DDHDF@HAB@HA@@F@B@DFBBJJ@BBBD@DABCBCBCDCBA@@HDHBJ@BBDDBDNAVCD@DA@@BABC@C@CBEBCDEDGBC@@BEAGBCFAFCTKFCHCBCAGCI@CBADABCDABAAE@CDADADCDC@AFAHAFAJCB@BBABADAF@DDDBBBB@H@J@FAH@HBDBBDAD@NEREJCDBB@D@BADAFDDD@F@BCHAB@BE@C@CBABAF@FAHAJAFAH@F@FAF@DABABABCDAB@@@BB@@@B@D@@@B@B@@BB@@B@D@@B@@@B@B@B@B@DAFB@@HAHANCFBFFFH@DBDDFD@F@F@FADADABADAFAJBFAHADADAHCFAD@FAFCHCHGBCBADE@C@EACAA@AAA@CB@B@B@FAFAFEFE@ABCBC@C@C@E@C@EBEFGFEBAD@FBFDBB@@@BFBFHB@@D@DADADCF@D@FDDBD@B@DBDBDDJB@F@F@HAF@F@DDFFDDFB@B@DCDCDCDCFCDAD@D@D@BBBFBHBD@DBBB@DADADABAD@DADADBD@BDBBADBBBBB@@D@F@D@D@B@BB@D@DBDBD@D@F@B@BBBBBCPALAB@B@F@F@D@D@FAF@D@D@BB@F@D@HDDBB@BAD@DCBCBCDC@ABAB@B@DBDBDADAD@DBDBBBH@B@@BBB@BB@@@BADAB@B@D@@@FDDDDDD@B@DCBEF@D@@@@B@F@B@@B@BA@ABA@A@@FBD@BC@CACBEDCF@F@BBDBB@D@D@FABAB@D@D@BDBFBFDFDDFD@B@BDBBB@DBB@BAB@BDDDBBB@BAD@D@D@BHTAB@BABABADAD@D@D@LADEBSCICEDEFDRBBDBBBBBAB@BBB@BDBDDFBDBDDDBFBHDDBBBDBDB@JALBDRDDBDBADBDBFCHC@AB@D@BBBDDDD@B@DABAD@BBD@BABCBCB@DBDDDFFBD@D@BA@A@A@A@@@@BBB@B@D@@@BDAD@FBDDFBDBBD@@@BBB@DF@DB@F@B@B@DDBF@HBFBBDBB@@ABAB@DAD@D@BCBABAB@D@BBBFFFDR@@@B@BABAB@@AB@BB@@@BBD@BB@@@BB@@B@B@B@@BCBABADEFCF@B@BHDBB@BAB@@@BFBB@HAJ@F@FDFDD@BCBC@A@A@ABC@ABC@@@ABABCBADADCB@FEFEDC@@@A@@@ABA@@BC@A@@C@A@AACC@A@AJG@AC@CA@@A@@A@AAA@ABA@ABABAB@BAD@B@D@D@BA@@@@B@@A@A@A@ABABABBB@D@@@BAB@BA@@@@BCBCBMDKHEJAJ@RFJFB@B@B@BAB@BAB@B@B@@@B@BBB@@BB@@BB@@@B@B@@@BA@@BABAB@@ABA@A@ABA@ABCBCB@BCDCBADCBABA@ABA@AB@@C@A@A@A@AAA@AAC@A@A@A@ABC@C@E@EBCBCBABCBABABABABA@A@@BA@A@@@A@AB@BA@@B@B@BA@@@A@AB@@CA@@C@@AAAAA@AA@CAA@C@A@A@A@A@A@@@AAA@@@@AA@AAA@A@A@ABA@AAA@@@AA@AA@@@AA@FIBABADABAB@DAB@DABABADCBA@@BA@@B@B@B@B@DBDBDBDBDBDBDBD@B@DBD@F@AAAC@@@ABGBEBABABADCBA@AA@CACCCCCEAGAC@AAAA@AEACAACAACAC@E@ABCBEDCFCHAB@BA@A@AFADAACAECEAEC@AAAC@ACCACCACAAAACAEACBC@C@EACBG@E@AAAAAA@C@CDABCBEB@@E@CAEAACCAECE@CAGBC@A@AA@ABEBAACAAECCC@EBEBCBA@C@CAC@@AAACAA@AAAAACACAAAQ@QAC@AAAACAAE@CACACAA@ACAC@EAC@CAAAAAAA@AC@C@CBABC@AAA@AA@A@A@CAAEACAAAAAAC@CCGCI@@@@AE@E@C@@AC@AAA@A@A@@@@DABABA@@BC@C@A@CBA@A@A@AA@EACCACBCBEDGBABADAB@@ABA@C@ABCBADCDCDAF@@A@C@@@A@@AACCAAACCGCGCAACAA@A@@@CBCBGDEBC@AACCCQQACACAEACEA@AA@E@A@CBABABCAEACAAACEAA@A@CAACECEACCAAE@@AEA@@@AACAIAGCEACACACA@A@@BCACACACA@MAI@GCGBGLGFE@CAA@C@EBW@EAE@EFAHGDGHADBJ@BCBCAEAMHGDAD@FMJKHKHEDGL@HCDI@GAEECAEBDHFFTLCDADBF@BGAAGC@ABAACCE@KFOHADEJGFGDC@CAAGEEECAACFMF[FKACI@QGGQCQAC@G@A@KFABADCFAF@LFP@H@BBRAF@@GJEDABIHGHIJ@@AD@@ADAB@B@B@DABABADA@AD@@CB@@A@A@@AAA@@@ABA@A@@A@A@A@@BA@EFABINGJGNGFMDG@E@CCGMCEACACCCAAECIE@@C@C@CBCBCBEDA@EDA@CGAOEOMS@A@ABCBGFE@I@A@MAMCEEAGC@AACAC@@@EDCFCHEDCDEDEFCD@FAJCD@@@@@F@JDLHJDFAJEDGFI@AD@FCFCDEFGDC@A@@B@@@BADABA@@@@@AHABAHCHA@@@BB@@@BB@@@@B@@@@@@@D@H@D@BBBB@@B@@@F@B@B@DBDBBB@@@@@@@@DBBB@@B@B@@A@C@A@G@CCG@AA@AGCC@AAAEECA@AAACA@@ECEGAAACAAACAE@G@EBG@@BABA@@BCBADABADAB@B@FA@AD@@@@ADCBCDGDEEGGE@AA@C@A@AAAA@@AABA@EBC@ABA@@ACCCECCAA@AACAUIAAACAKDMBGFMFA@@NDRAPGNQRKRALAB@HCF@H@JCBAHCB@@@HCDAHANILIPI@@CC@C@AD@F@FAHCFCDA@EAEAC@C@E@EEAC@CAIF@FE@E@EGCBEBC@A@AA@A@A@ABC@G@EAEAEAAAAAGAEAA@C@A@CBCBC@@@AAC@A@@AAA@@AA@@A@A@A@@@A@CAA@@ACAAACAA@C@@@A@AAA@BA@ABA@A@CB@@AACAAA@@AAC@ACA@EACBE@A@C@@CBABCBC@CACCAGEKGCCEAEGQICCECIGBCGCEAE@C@CAAA@GBGBEBC@AA@AA@A@EBEBCBCBBD@D@B@D@DCAACAAA@GAAE@IAECA@@AA@@A@ABC@ACAACCEEECACCEGGCEECCAAK@K@IA"],encodeOffsets:[[118829,24498]]}},{type:"Feature",id:"441423",properties:{name:"丰顺县",cp:[116.181691,23.739343],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@A@@AAAA@AA@@A@@@@@A@@BA@@@A@AAA@@CCAAB@@@BAB@B@B@@@BA@@@@@A@@B@@AD@@A@ABAB@@@@A@@@@@@AA@@AAA@@CCA@AAA@@@A@ABAB@BAB@@C@E@A@A@A@AA@A@@A@@BA@@B@@BB@BB@A@@BCBABABADA@A@GDA@@D@@@BAB@B@DA@ABCBA@C@C@C@A@ABC@C@@@@A@@BA@@@A@A@AAA@AABA@A@ABABA@C@@@ABCDEDCBA@C@EACA@@CAAA@@A@CBA@C@C@A@@@@B@BA@@BABCBABEBA@AB@B@@@BDBBBDFBD@DADABAD@BCBGHCBA@C@A@E@C@E@C@C@C@ABCBAAA@ACAACAGGEEAACEEAEAIEAABABC@CAACCACCAKCKEUKEEAAECC@CBCBCBEACAE@ECA@@A@C@A@A@ACCAAE@CBAD@DADABA@GBAB@BCDE@IDEBCDEDCBABCHEDADBDCHAD@B@BA@ABKCE@@BBDDFDHDDDF@B@B@@B@BADABAD@BB@DBBBDD@DB@BB@@FADBFBBDDHDDBFDFBFBHBJDDBBD@B@@ADAF@D@D@B@BBDBBBBBB@D@FBFDFBBDBFDHFBBNNJHFH@D@B@D@D@D@DBFBDBBBBD@FBDBFBDBFDDDBFB@FBBB@@BFBDFBF@BDBBBBBBB@@BDD@D@BDDD@DADAFAHA@@@@D@DBBDBF@F@HBDDDFBFBDAF@@@BBB@BBBBBH@DBD@DB@BAD@D@D@B@B@BBABABBD@BAJ@BABEDABCDAJABHFFBHBD@BBB@@@BBAB@BEDGHEBBDDFBFDFDBDB@BD@B@BD@B@D@DA@IFC@@@C@@D@DDBBBHBDBHFDBBB@BCBEBCBCBABBDABCBBHBJHBFBDBDBH@JBL@L@BBDDFFHDFHDDDBFFDFBDDB@BAD@B@BB@@BB@FDJBF@BB@HBBDBBBCDC@A@C@C@AAADADAF@F@BBBB@@BADAFAH@HBBDBD@F@FBHDADJHFDDDRJFHFBDDLHHFDBBD@DADADAB@DD@B@F@DAFBB@BDD@BB@@BBDBBB@@DAB@B@BAB@@ABB@B@B@@@DBBBDBBBD@@BB@D@B@@@B@B@BB@@BB@BB@@@BBD@B@@ADAD@D@B@DBBBFBHBBBBBFBF@F@HAD@B@B@BBBB@D@FADAFHF@F@@EJEDBD@FB@F@F@DBDBF@FCBEDGDEBE@C@@B@DDDFHFHBB@BB@DBD@F@HAFABADAFEHCF@D@H@FABA@A@E@CBGBCBCB@BBDDBDDDB@D@DADCBADADAB@DBBDANAL@NFLFDFFLBBBD@JJDFFHBFB@@@DCB@D@D@D@@@@B@B@BC@CB@BABBB@B@@FDFDDDBDCDAFBHFJDHHFDBFBF@FDD@BDBD@DF@F@DADAJGLEBCBAD@FBB@D@DAFAFBBA@@DABAD@@@B@BAB@BAB@B@@@@@@BBBBBB@@A@B@HAHBDBDBBDBDBBBDD@B@BADBDDDD@@@B@BBBDBBBBD@BAFAFCBAFCHEBADCDADAHAHAFAFADADB@@BBBDDDBBB@B@NEFEHGBCDAF@BBD@DDBBDBBBB@B@DADAB@BAF@FBB@DBBAB@@A@@AAAA@CBEBCFAFADEFC@E@EBABADADCBABA@E@A@ABAB@BA@AAC@C@CDC@A@A@ABCFCBABABA@A@GBE@ADAFGFEFCFADED@@AB@B@DBBBBBFAHADCFCBCBCAAAC@ABCD@FABCBADAD@FAFABABADCDAD@B@@BBBB@B@B@@A@C@EBEBCDEFED@D@B@@@B@BADABAFCDED@FABABA@A@CFAHCDADCFCFEDCFADAB@BABA@A@ABCBCBA@AAC@AACBEBAB@FCHADA@@BCBA@ADABAD@BABAFA@@B@@BB@B@BABCDCB@BBB@B@DCB@DC@CBADAB@HGHCAA@@DIDE@C@A@CBAAAC@AAA@AA@@BA@@DABA@CBEAAAAEDC@AB@B@F@D@BCB@@CAE@CAC@E@AA@A@@@AA@CBCBA@@BABA@I@C@A@CDADABCBABAAA@A@AAAACAAAAA@A@ABA@A@A@A@A@ACA@ABKACAAACA@C@@@A@@BA@@BA@CB@@CDA@A@AAC@ACCCCAE@I@AAAG@A@A@@@A@@B@@ALEBCBAAABAD@D@B@FC@ACCA@C@CBEBKBEBABA@AA@CBCBADG@MAACCA@@E@ABE@A@I@C@MAAEACACAGIAAC@CBED@@CBCBI@ABA@@D@BKBAAIAA@EAA@AABCAA@AB@@ABA@CAACCECGAAACACCA@C@ABA@AACEAABAAC@AA@C@CAA@CAA@AAABADABA@AA@C@ACCAACGA@@A@A@CAAA@A@CBC@C@C@C@EBEBAE@E@ABADENILGJGBADCBEFGFG@A@ACA@CACCACAA@@BADCDEBCA@A@C@EDE@@BGAEAAAA@CDAD@BCBCBCCAACGACECCCK@K@I@I@M@A@CCACAAA@@AC@CACAAEAC@E@G@EBA@MBA@@B@@A@@@A@@@C@AB@@@@A@A@A@A@@@@BA@@BA@AB@@A@AB@BCB@@ABCF@@AD@@ABAB@@@@ABCBEBA@CBA@A@A@@@ABBB@B@B@@@@@@@BEDABA@KACBA@A@AAA@AAC@A@AAC@@AAACC@@CC@AAAA@A@@@A@EBA@@AAA@AAE@A@A@AAA@AA@AAACCCA@@ACE@AAA@@@@AA@@A@@AA@AB@@@AA@@@@@@AA@@A@AA@@A@A@@CAA@AA@@@@@A@A@A@@@@A@@AA@A@A@AA@BABADABA@@AA@@A@@A@@AAA@@@AAA@A@AABA@A@AB@@AD@@A@ABAB@B@D@BAB@@A@A@@AA@@@C@@@ABAB@BA@@BA@@B@@@@AB@D@B@B@@@@A@A@@@@BA@ABA@@@A@@@A@@B@@@@@BB@@@@@A@@@A@@@AB@@ABCB@@@@@B@B@B@@AB@@AB@B@@@D@@BB@B@@DB@@@@@B@@CB@@ADA@A@A@CAC@C@CBC@EBABA@@A@A@A@@@A@AA@C@OAA@@@AA@@@@@@@A@A@@AA@CA@@AA@AC@@A@@@AC@@A@@@C@A@@@CBA@A@@@A@@DA@@BC@A@EAC@@@AAEAAAE@GC@A@@@A@@BA@A@A@C@A@A@A@AAAEACAAAECA@AAEACAA@@AA@AAAAB@@@@@BA@@@@@@CCCAA@AAAA@ACAAAECC@@AECAAAA@AA@A@A@ABA@AB@@BBB@@BB@BBB@BBD@@@BB@F@@@@@@ABC@A@C@C@@@A@AAA@CA@@@AAA@@AA@@AA@@A@@A@@@@@AAA@@@@A@@A@BA@A@A@@AA@@A@AB@@A@EBC@A@A@A@A@A@@AA@CAA@@A@@AAAA@A@ACA@@AAA@@@@AA@@@@AA@@AA@A@AA@@@BA@@AA@@@BA@AB@@EC@@AA@@@AAA@@@AA@AAA@A@A@A@ABA@A@A@@@C@A@A@A@AAA@@@AA@@BA@@B@BC@ABC@E@A@AAAAC@AAAAA@@ABA@AB@@@@@A@@@A@AB@BA@@B@BAB@@@@AA@ACAC@AAABA@ABCBA@A@ABAB@B@FB@BB@@@B@@@BA@@BA@A@E@A@ABA@@B@@AAA@@@AACA@@A@@A@@BC@ABA@A@AAA@AAAAAC@C@E@ACA@@@A@@EACCAACECA@A@A@@@@AACBAB@@A@E@E@IAAA@AA@@@CDAB@BC@E@A@CBA@A@ABAB@BAD@@A@@@A@AAC@"],encodeOffsets:[[118916,24191]]}},{type:"Feature",id:"441424",properties:{name:"五华县",cp:[115.775788,23.932409],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@IE@C@C@C@AC@A@C@C@C@@ACGCAAAC@EA@@ACBABCBABAB@BA@A@CAACAAC@E@@@A@CB@@EBEAA@ACCEC@E@ACAC@AACAA@@@A@A@CAC@ABC@A@ABEAC@C@C@ECABG@KBEHEDCF@FBJBDBHBDAB@BA@ABED@BBH@D@D@B@B@DABGD@DAFC@CB@@A@AAC@ADC@A@AD@@CB@BDBD@BFCFADDBBFAB@DDBEDDBABCD@D@DABAFE@DFAJAAE@E@CD@BABF@@FB@@@@@BB@HCBABBB@BC@C@@CCBCBA@A@AFAFBFDDBBD@DAD@BBDB@CJBBBDBDDD@DBCD@DBBAD@@CB@D@BC@AB@DCD@F@F@DFB@@BBADAD@D@BBDDABCBEDABDADADBD@B@@EFBBDDBBBA@BBBBBBDDDBHBDBBABC@EBKFC@@BAD@@BDABABBBBBBD@BABABA@CDADABCDADBF@FBF@H@BBB@DCBABAF@BABFFD@@D@B@DB@@BABB@@HCH@BBDB@BBFDADAFAF@@DBFBBBB@DD@D@BBDBBFDAF@B@B@FDDBDDBD@BD@FAFAD@@BDDD@BA@@@CD@BBDBB@D@B@DBBBB@F@DD@AH@B@BADBB@BEBC@G@ADABAC@AAEECCACAC@A@AAA@ADA@ACB@@ABEEAEDCBCAA@ADCBABCAABC@CBCFBFFBBDBJAF@D@D@DC@A@A@EBA@A@CAE@@B@DBBCDCDABC@ADA@EB@DADABDDCFEHBBBBBADB@DAD@DD@@DFB@DB@BB@BDBFJA@@B@DABCBABADEBA@C@@AAA@AABAAA@@AABA@AAGE@AAACAA@A@AA@CBADAAA@EECCDCBECCACBC@CACCCAE@ADC@C@G@EBA@C@GAGBA@CAC@A@CA@@AD@@@B@B@DBBFBDBDDDDFDBBBBFDFDB@FDHJH@BBDH@DBBBBH@BBCBADCDEF@B@@@BDBDCBAD@D@@DB@DB@B@@CDC@DBADAD@@@DBBCBE@C@EB@B@D@@DD@BBBB@BB@DBBBBABEH@BBB@F@F@DDBD@BBBDJDH@D@D@FBBDADABADAB@BAD@D@D@BD@DB@BB@DB@@BB@DAB@HBB@DFDB@B@D@BADADB@BBDAB@BBDCDBB@D@@DBB@B@@BADEDFBBD@B@@FBF@DDADDHA@ADBB@BAFDB@BA@AB@HBD@BA@ADBDBBFDBB@DBBDDB@B@@BA@CF@BBDB@AD@BADDBFD@@D@F@BBBAD@F@BABCDAD@@DDFBFBBD@B@B@BBFBFDFD@BFBDBADAD@DBB@D@DCDBBBD@BABAD@DADCBADFDFDCBADAB@B@BBF@B@F@DABCBCDAD@DBB@DBDBD@FAD@D@D@FCBABCBCBEHEFEDEDABCPEFGF@DDFBDDBD@FBD@BBBDBF@DBF@BDD@DBD@F@F@DDDFDHDDBDB@D@BCHEHAB@DABBBB@B@B@BB@B@B@B@BB@B@BABABCDABBBBBBD@@@B@DAD@FB@@EDDFBDHHFFB@DBF@D@DADA@CACAAAACIBGFEB@HCF@FBBB@DBFDDBABADIBIDIDABA@A@E@EBCBCFCB@D@H@F@BADAD@DBBFDDDFFF@B@BEFCFAB@BBD@DCDCBC@C@CD@@AB@H@JCBE@G@EB@B@B@DBBD@D@FAB@B@B@ADAFADCFEBCDAB@BBDBFA@CBCDADBD@BBBBDBDABAD@@AHCBA@A@BDAD@B@@B@B@@@DDB@BBH@D@DCBEFEDEB@FABADCD@@A@CB@DBB@D@DCDADAD@BAB@B@BB@D@BDBF@BADC@ABAF@F@D@DABCD@DBDDBBDBD@F@DDDFBBDBDB@@B@B@DAFADADCBCDCHCDADAFEDE@CAA@@AAE@AABCFEDE@ADCBAAA@ACA@AAC@E@ABABCBAA@@EACAA@CBCBCDELGFAJAHAFABCBE@CBA@AB@@@DBD@BABABA@CBAB@B@FBD@D@@@BAAA@ADG@@@ABCBAH@LBD@FBBDDFBBDBBA@@DADE@@@ABADEFCD@DBBABAACAA@C@ABGDCF@DBDBBBDBD@@A@C@C@A@C@AAACA@C@ACA@A@C@A@@B@@ABCBCD@B@DBDDB@BBF@BA@A@CACAC@C@ABAB@DA@CACBEDCBADAFAD@B@@ABA@CBCBAFADAB@DBBDBBB@D@DA@@B@B@F@FBFADC@ABCBABAHGBEBG@C@ADCDCB@BDDFBBFDHDDB@DCD@DDBDBDDFBFBJADABCBCDAF@D@@@FCHCDCDCFAFADCDCBCBAJGFEFCDAB@FAHADBBBFDB@@AB@ACACAC@E@E@ABGBABADAFA@A@@@@BA@C@ADCDCDABABA@C@C@C@AAE@AAAC@@A@@BADCD@B@D@B@@ADAB@@C@CAA@ADADAFAD@DADAD@BCBABGAEAECAACC@AA@@B@BCBCBEDCBAB@F@FBD@DABCBCAG@EAABEBADGBG@CBC@ABAD@DBFDDBAD@DD@FBF@D@BABAD@B@FAFCHADADADABCBABCDAB@DADAD@F@BABA@CBCBA@@@ABCBCBAD@FAFBFBDBBBH@@@H@B@BADCDCBABABIDCBAFCBA@ABI@AACBABAAAA@A@C@C@C@ABA@@CAC@CAGAAAAA@AA@@E@CBEAEACCAC@G@EAEACCAC@@@@@GBEBCBCBC@CC@A@CCC@AA@AAAAAAACE@EAACAE@@AAEAA@AECCECCAEACAEAC@AAAAACAE@C@C@C@C@A@CEGIGMMAAGEECCAAACEAE@E@CAAAAAAAC@A@A@C@CBEBC@@@AACCAICGAEAEAECCAGCCCAAAEBC@EA@@ACAC@ACAA@CAAC@ABCBABA@@@@A@ACECCCGCEAC@ACBABADADC@AACAC@C@C@GIC@EI@ABC@A@AA@A@C@A@A@A@AAACAAAC@CCEAAAA@A@AAA@ACAA@C@@A@A@EAA@AEAACAEBGBCBCB@BAD@B@BCBCAC@AAACAAAC@E@C@AA@ABABCBABCFCF@BADA@@BAAAAA@ADCB@@ABB@A@AA@@ACC@C@EAEACAAAAABA@CE@C@A@ABABAFAB@@AAACAAAAECE@AAACBEBA@C@@@CCAAAC@ABABCBA@AA@AA@CBADCBA@ACCCCACCEEBGDCFCDI@I@ACCAGBEDEBCDCAAACAA@G@IB@@C@AA@C@C@C@ECAC@AAE@E@EDIDCBCHCHGFGHCJCF@B@@CHAHEDCDAB@BABA@A@AAAACAEAA@A@CAECECAEACCECACAAEAC@AC@C@ABC@AEC@A@ABAAEAG@@A@@@CBCBC@C@E@C@C@AA@CBCBCAA@AC@AGCGACAA@EEAEBCBEBAB@@EBECKCKGEQEG@A@A@A@A@A@@@CAAC@A@CDA@CAAE@CACGCGEE@AA@CAAAEAA@A@GCCCAGAAAACACACAC@E@C@IBCBA@EBOCCACC@@"],encodeOffsets:[[118305,23995]]}},{type:"Feature",id:"441426",properties:{name:"平远县",cp:[115.891638,24.567261],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@GBE@GCCCA@CEEAE@EDABABCBABE@E@ECECE@E@E@GDGJ@@ABBP@JAB@BCBCBC@GAEBCBABGDGDEFCHAD@BA@@BGDI@GCCAC@AB@D@DDDDD@DAD@@ABCDCFCBCFCDEBGHGHBBB@D@L@RFNHJH@@DDRLTJRBLCHCFAB@DBFBH@DCB@DCH@NBLD@@B@B@@@B@B@BA@@B@@@B@B@BB@BB@BB@@BB@B@B@@@BAB@BABA@ABA@A@ABAB@BAB@B@BBD@B@DABABCBABAB@B@@KHIHEH@DC@AAA@@BA@@BAB@B@BABABABABABABABABABAB@B@BA@ABCBA@ABAB@B@B@BAB@@ABAB@BA@A@A@A@@@A@@@AAA@@@A@AB@@AB@@@B@B@BBB@BBD@DBD@D@B@BAB@DABABAB@@ABAB@DAB@D@@@B@B@BA@@BA@ABC@K@A@A@@BA@ABA@@@EBCDA@@BBBL@JDDDFDDBJ@BA@@B@B@@A@@B@@@D@F@DAB@DBBB@BADAD@BDBDDB@D@BBDB@@@BABAB@BAB@BADB@DBDD@FADGDCBCAGEA@A@@AA@A@@@A@A@@BA@A@@BA@@@A@C@AAA@A@@BA@@BA@@BAB@@ABABA@A@A@A@A@AB@@A@@B@@@@@BBB@BBB@B@@@BBD@BBB@B@BBBAB@BAB@BCDEBCDCDCBABCBA@AD@B@D@D@B@D@D@B@@BBBBBB@BB@@@BBB@BB@@BB@@@B@B@B@B@B@BBB@B@@DBJHHFBD@DGJ@@@DABADABAB@B@@ABABA@ABAB@B@@B@@BBBBBDBBBBBBBBDBD@B@D@DAD@D@B@B@@@BBBDBDDBBBD@HAF@DBFBDB@DAFAB@B@BBBBBBB@@B@BA@KPAB@B@BBB@BBBB@@BB@BBDBABG@EDCDBFFBL@FEDCB@B@B@D@B@B@D@BBBB@D@DAD@F@BAB@D@B@D@D@BBB@BBB@BBBBB@D@B@D@B@DBBBDBDB@@@BBB@BB@DBBBD@@BBBBBBDBDBB@DAHAHAHAJC@ABAB@DAB@BABA@CBCBADCB@@@B@BAD@B@F@DA@A@EAAAAAAAA@A@CBABABA@@@@AEEIAE@ADAD@HDHFJ@J@J@BBLBNHJHDDBDAFEFCB@@CBC@ABE@EDEDADABABADAF@F@F@H@D@FAF@DAD@BAD@BAB@@@BA@@B@@@B@BBBB@@BBBD@B@B@@ABABCD@BBBBBB@B@@D@DBBAFCBCBAB@FE@ABA@A@ABA@A@@B@@@B@BBB@@@D@B@B@D@DADADADAB@B@B@B@D@HAH@B@B@B@@@B@B@B@B@@A@@B@@@@@BC@@@A@@B@@B@@B@@@@B@@@@BAB@B@@@@@B@@@@B@@@@BB@@@@B@@@BA@@@@@@B@@@B@@@@@BA@@BA@@B@@@@@@A@@@@@AB@@@B@B@@@BA@@@@@@@@BB@@@@BA@@B@@B@@@B@@@BB@@B@@@@@A@@@AB@@@@@@@BA@B@@B@@A@@@@BB@@B@BBB@B@B@@@B@B@@@@@@@@A@A@A@@@@BE@@@AB@@ABA@ABC@CBA@AB@@@@@B@B@B@D@DADABCD@D@D@DBB@@@DDDBDDDDB@@BBBB@B@B@BAB@DABABAFEHIHEBAHEDEBG@C@CB@BA@@@AAA@@@@A@AA@@@A@@B@BABB@@B@@B@@B@@BB@@@BB@@B@@AB@@AAA@A@AA@@A@@@ABA@AB@B@@A@@BA@A@AB@@@B@@@B@BAB@BAB@B@B@D@@@B@@@@@@AB@@A@@B@@@@ABA@A@@BA@CBA@A@@B@B@BB@B@BBB@@@@D@@@B@@@DAD@@@D@D@B@@@@@B@@@DEBE@E@CAA@@BA@@DA@C@CAAAAE@EBCBCA@A@E@EBA@A@E@CACEAAA@ABA@AB@DAHCJEDE@CAACCG@E@AA@CBEFCJCD@H@BA@@B@B@DCDMBABABALEFEDA@@DC@AD@@@DA@ABG@@@ABC@ABE@C@@@C@ABAC@@@A@CACCACEGAA@AGEACBEDC@@@AA@CAAAAAAA@@@AB@@@@A@@@@@@EACDEFE@EAAE@GDGFEDGCIACEGEECBAFCFA@E@CA@CAC@EACACACCEAAACEEEAE@A@AB@DCD@@CAC@A@AA@@KK@E@E@CACBGBE@C@CDEBEFEBC@C@CAECEAAAACCAACCCA@@@@AAAC@@@AA@A@A@A@AAAAA@ACACCEACBC@@BCBI@G@CBCBCDCAC@C@CDAFEDCBABC@@@@@@BAD@@@B@BA@A@A@AAABA@@BCFEDEBCBCBCBCAAA@EEAAGAOCIGCGAE@@AA@@A@AA@A@A@@@A@@@AB@B@B@@@B@@CFIDEFEDGAGAI@I@CDAF@CEAC@CEGEEEAMDGBGB@@EACBA@A@A@A@@@A@@@@C@AA@@AA@A@@@C@A@@@A@@A@@BADCBABABA@CBE@E@EBGBEBIBG@EBEBADAD@F@@ABADG@A@ECCECCBABC@A@CAIDQFMFC@CBAAAC@GBG@E@I@GAAAACC@CBEBCBAAAA@IDEBGBEB@BCDCDCBCB@DBFABCBADCBAB@DDJBHADGDEDSLEDEBADBHAF@@ADCHCFADAF@D@DADAB@@CBC@UDMBACCCAAI@GAGC@@ABCDADADADCBC@AA@AIIAACEA@E@@@GB"],encodeOffsets:[[118641,25062]]}},{type:"Feature",id:"441427",properties:{name:"蕉岭县",cp:[116.171355,24.658699],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@@@@BBBBBDBDBBADADB@@@BBF@B@DCB@B@BDD@DAF@B@BDD@B@@@@@DABA@@B@B@@@D@DAB@D@D@D@DAD@BABABAD@D@BB@@J@DBD@FHJLB@BBBBBB@@B@DD@BDBDDFF@@FDBDFHDBJDHABEBE@CDG@AJMB@@AD@D@D@BAB@BA@ABABC@ABC@@B@BAD@BABA@ABA@ABC@E@E@C@A@A@@BABAB@BAD@BA@@BAB@BBBBB@BBDBD@BBD@D@B@D@BAB@BAB@@@BA@A@A@C@A@A@@@@AAA@A@AAA@AAAAAAACA@AA@@AAAAACAAACAAAA@C@A@@@CPIFCD@D@B@D@B@B@BBFBBBB@BBBBDBB@DBBAD@D@D@D@F@D@F@B@D@D@DBF@FBFBFBB@BBBDBBB@@BB@@@@BDBDBDBDBBDDBBD@@TJJH@@@C@C@A@@BA@@BB@@BD@BBBB@@BB@B@B@@@B@B@B@BBBBB@BDBD@DBD@D@B@D@D@BBD@BBB@D@B@D@B@@@BA@@B@B@@HFDD@@@B@@DB@BHJDBDBFCBAB@D@B@B@@@BB@BAD@D@BB@@@B@@@BBBBB@D@FBF@@@F@B@BAB@B@B@@@B@@A@@@A@@@A@@AAA@A@A@AA@@AABABABAB@BA@CAA@AAA@A@A@A@ABCDCBAFA@@B@B@B@DBB@@@B@@@BA@@@@AC@A@ABA@@@@B@@@B@B@B@@@B@BA@ABA@@BA@AB@@@BA@@@A@@@A@AA@AAAA@@A@AAC@AAA@AACAA@CAA@CAAAA@@@AA@@A@@AA@A@A@@@@@A@A@A@AAA@@AA@C@AAA@AAA@AAA@AAAA@AAA@A@ABA@A@AAC@A@AAA@CAA@ABA@A@AB@BA@@BA@@@@DADC@C@AACBADCGBA@EA@A@@BA@AAAGC@A@ADEFEBCBADA@AA@A@A@@@AA@@A@@AAC@A@@AAA@@BA@ABABA@@@Q@ECEEAA@A@CBABADA@A@CBC@CBABA@@AAACEAGAE@CA@C@A@A@ECAE@@CAA@A@@ACCAEACCEAC@CB@A@@@C@AAA@A@@B@B@B@B@@A@CACEECCAC@CDADABA@AAC@ABCBA@C@ACCCCAA@A@CBAD@DGAEACBCCACAQCACBK@ICACAAACAGCEACACCCAEACCCA@AAA@ABAAAAACAAACQFEFCJDTDFABC@K@C@CBCBCBABA@ABAGS@A@C@CBC@AAACACC@ABA@AAA@CAACA@A@AECCCCEAEAEACC@C@A@ABEBC@C@A@CAAAE@E@CDAFBD@DADC@EA@@@BAB@BABA@@@@A@E@A@@C@E@AFCDA@C@CCCCEC@@C@A@A@CBAB@@A@@AAA@AA@G@AACACAC@CBCBCACAA@A@AB@BCDADADCDC@ABA@CAGCC@E@A@@A@C@CBE@E@C@C@E@E@ABABKDOAAAA@A@A@E@CACAC@C@CAAA@C@C@E@C@@@AAAACAABCA@AACBCBC@CBCBABCBC@CAACAC@GAEAAA@A@C@CBCDCDEDCDCDC@C@AEACCEECCE@E@GBE@E@A@CIACAC@C@AACCC@E@CDEBCBC@C@CA@EGEA@A@@AAECEAC@ABEFEHAF@F@D@F@D@DADAD@BEFEFEBEBA@A@A@@DBB@BBBBD@F@DCFABADGHGDEDEBC@EBGDCBCBGBEBIAEBCBABCBCBEBE@E@C@E@CB@D@JBJBHCHEFCFEJ@DA@@@A@A@A@@B@@@B@@@B@BBBB@@@BB@@BFDHJHPDHBBBFFB@BBADADADADCFEFAD@@ABBB@B@B@BABA@@@C@AB@@@@@@ADABCDEFCB@D@DBDCDADAD@D@HAJAD@@ADBDDFBDBDB@BBBBB@B@B@B@@B@@BDBB@@@@DBDDBBDDBBBBDFBF@D@DADEFAFCF@D@DAFAHBD@D@F@FLL@@BBB@D@DB@@DC@CBAB@F@FBFFBDBBDFBDBDBD@FBD@DDBF@B@DEBEDAFFFHBDDJCHEFCH@HBFFBF@FEDCFB@@@@@@@B@@A@@B@@BBBBBBDBB@@B@@CDAFBDHF@BBBFHBDDDDBB@@@D@AB@B@D@@@DAF@BAD@B@@AH@BCB@@C@@BCD@@CBEFKFABABABCNCDA@A@@@ABG@C@IDEDAF@DBBF@H@DDBB@DCFIFGDCBA@@BAB@BBBFBBD@D@F@BAB@F@F@BDBDAFAF@BBBB@D@DBA@@"],encodeOffsets:[[118877,25435]]}},{type:"Feature",id:"441481",properties:{name:"兴宁市",cp:[115.731167,24.136708],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@D@JEB@@C@C@AACA@C@@ACACACEAECEACFAHGFC@ABAAA@@A@AAC@GAEAGEABCDCDABA@G@@@G@AACAEAEAEBC@ABADAD@B@@ABAD@DABABE@C@CBCBA@CBADABADCBCBCBGBEDEBA@C@ABABC@E@EAC@@CBCCAECCAC@AB@BAD@DAHCHABAFBB@FBHADADCBC@EAE@A@ABCDAFADADA@@@BBD@BDDBBFBFAHABADC@CBCBC@EBCBCB@BBB@D@DA@CB@BA@C@A@C@CDAB@@@BD@BB@BBF@B@D@D@DABABCBCDCD@B@DAB@@@@@BEBCBABABAH@B@F@FBDBDBDA@@BA@ECAACAGBEBA@CBEDEFIHABADCDCDEBEBCDCDGDED@@C@E@CBADADCBIBEAEACCCACA@CDC@CCAGCECAACEACA@CDCD@B@DAHAFGHABABAD@BCDEBEAE@A@A@@@CBC@A@AAACCAA@CBEBABAD@DAB@BA@C@EBCBABCDAFBD@DCBA@AB@B@DBDBD@D@BABE@AAA@CCCAA@C@ADAD@BA@@@@B@D@BDB@B@DDBBB@B@D@B@D@D@BC@CAAACACAE@CDAH@B@DBBBDABABCAC@EDCFAB@B@@CFCB@@ABCAAACEACEAC@KAG@ABAD@B@@CH@BBBAB@@C@C@EAA@A@AB@DABABABC@CA@@A@@BAB@DAFADEBGBIBEBKHCFADAD@DBBBD@FB@ABADAB@B@FBD@BDB@BBBABCD@BCFEFADBBF@BB@@BB@DCFEFCBCBGDCDADCDCBEBCBA@A@@@CACAAACECCE@C@CAAACCCAC@ADCBC@E@E@AB@BCDABE@CA@A@CAAA@A@ABABBD@B@@AFADA@C@C@ADAH@BBHBFBDBBB@FBHBFBDDDFBDBBB@DEDCDAJBFF@HBLBL@H@B@DA@@BE@AD@DBDBDDBD@D@B@@F@FD@DBD@F@DAB@B@DD@B@D@PADAB@@C@A@EBABCDADEFAF@DBDDDBDBDADABABABBB@B@B@BADEDCDIBABAD@DDBFBBD@D@D@DCBGBCB@BBFABADCD@BCDBBBB@BABCBABEBCD@BBBBF@FCBAD@F@@BF@DCHBHBFDHBFAH@B@HBBBDBHBBB@HDBBB@BAB@B@FDBBHJDDDFFJBL@LAF@DADCBEBED@B@DAD@F@BBDDFDBD@FDFBDDDFABADBBBBDDDD@DABCBCACACAEAE@C@AD@B@B@BBF@HAFCDADA@A@C@AACBC@C@CA@C@A@E@A@@@@AA@@A@@BA@A@A@A@CA@@A@@B@@AF@B@B@BBB@@BDBD@BBFABA@GBIAECCCCBADAD@DCDA@ABA@EBG@GBEBI@GAC@K@EBEF@B@B@BAH@B@D@BCDEDAB@FDDBBDH@FAH@BABCFAHAB@DBD@BBB@F@BCDAFABBD@@AHAD@@BB@@B@B@D@B@B@@@@@B@B@BAFCBADBB@BB@BA@AD@@BB@@AB@@@@A@A@A@@@@BA@AB@@@BB@FBDBB@FBD@F@FDBBDB@B@BCFAF@JCFAFCH@D@BBDFHDDHFDFBBDD@F@B@B@F@B@B@@BBFBHHDBNDL@FBBBBDNDBBD@BA@@BCBABA@ADABABABCBA@CBABA@A@A@@@@@GBEFEJCP@F@F@D@HDFDFHDF@@B@BBB@BAD@B@DABABABAD@B@@@B@B@B@B@B@@@BAB@B@BA@ABA@ABA@@@A@@BABAB@BAD@BBB@DBBBD@D@D@D@BADABADABA@ABAB@DAB@B@FAD@B@B@BAB@BABABCBABABCBCBA@ABA@ABA@A@AB@@AB@BA@AB@BC@C@CBC@A@A@A@C@A@@@AMGCC@IFIDGDA@@@A@A@AB@BA@AB@@@B@B@B@B@B@B@D@BBB@BAB@BAB@D@D@@@B@D@D@B@BAD@@AB@@AB@@AB@BAB@@@D@B@B@@@BB@@BBB@B@B@B@B@B@B@B@BB@@HGHGFADCDEDADEDCBA@@BC@CCCCC@C@CBAD@DBHDJ@HC@AB@@ABCDGFEHCHCBADAFAHBD@DADA@ABA@IAOBA@@HIHCF@F@@ICGMQCCCACAAA@C@@@@BA@CAG@ECEECAE@I@E@CACEAGA@A@MAICS@CBADCD@BCBA@AAAACAAAACA@A@ADCFAFAF@BAB@DIHK@@@@FAB@@A@A@C@CBADEHEB@D@D@BBDBF@D@BADAB@DABADB@@DBD@BABCBE@CBAJ@HBHBBABA@AAEBCBAFCBGBEFC@EAE@C@ADAFA@@HG@@BADEDE@GCGAACA@A@@@ABAD@@@BCBAHCDCDIBEAEAA@ABCBABAD@D@JA@@B@B@B@HCFADCFED@@AD@@@B@@BBB@@BFB@@@B@@@@@BA@A@C@AB@@@D@FEFIB@@AB@BCBAB@D@B@B@HDDBHABAFCBABEBGBEBE@AAAAA@A@A@ADC@@DA@C@A@AAA@C@@@ABADABC@ADEBC@EACCAAAEBA@ACBEBG@@DEDCHAFAFABBDDBB@@BCB@@@DIBEBIBCFC@@FCBAB@@@@CAAC@G@G@E@EAAA@CAC@A@ABA@AFADEBCFCFA@CA@CAAABAACECIAEDEDC@CAAAEECCCCACA@A@A@@CDCBCAA@AA@E@E@CCCE@EACBA@A@ABA@CBAACAAACBCBADAD@DEDC@@DADAFC@A@CBAB@BADA@A@@@ABCBABABA@A@AE@G@EAA@I@A@CAACACA@@@C@@@@BBF@B@B@@ABIBCB@@CBEBE@AAAAACC@E@C@@@AA@@BCBA@AC@E@CAAC@C@ABCBGB@A@BCFEFCDEDAB@HBH@FBBBDFB@B@BADEBEBCBCDAFAF@DABC@@FCLCFAF@FADCBEDGDEBC@E@C@C@CBA@@BABE@CCECA@@@CDADCDABABCFAFADAD@DBB@DAB@BADAB@FBDFDBBBDBBA@ABAAA@ABABABABBD@B@B@BBB@@@BA@ABA@A@@CAECECCAAAA@AAAAACA@A@A@AA@A@C@C@AA@A@AA@C@A@@BABECCAAC@C@A@AAAAC@AAA@@ABEBA@EBGBE@EDABABCBC@CBABCDCDBJB@@@BD@BBHDDDBBAIAGDABAACBADADAFADA@AAACAGECAGAAACA@C@CD@"],encodeOffsets:[[118810,24462]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("清远",{type:"FeatureCollection",features:[{type:"Feature",id:"441802",properties:{name:"清城区",cp:[113.062692,23.697899],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@@ACAC@@@C@E@CACC@ABE@@@AB@AAAC@@EEECE@EBCFAB@BABEBCBAB@@@@EFGDEBC@E@ABAFDBDBBB@DABABA@AEAAABCBBDBBBDBDAD@B@DCBABC@@AC@ABCBCDC@A@AACACCABA@A@ABCD@BADADCDADADADAD@B@D@DAB@B@@KF@HJTf^@DBBBDBB@B@DBB@B@@@BA@@B@@LBPRRJJFNBJBFNBP@J@BA@DVDNDLDPFPL\\@FFLDLDF@DHLDHPLB@@B\\TB@BB@B@BABABC@ABA@@@@BAB@B@@@@BB@B@@@B@B@@A@EB@B@@BB@D@@ABABBB@BBBABAD@B@BFHF@@ABCB@EELANAB@BBFHDH@B@BBDFHBHBDBFCT@J@F@FJBLBZDTHRFT@L@F@F@DBF@FPHJP@ED[\\A@kZaNFP@@DPRVVTPNDP@JDL@@DBD@B@@@PDBAD@@EL@LBNDFDD@FCF@FARIPDRJHBPGP@NFFDLMFGGK@A@CBABAB@BAD@D@D@B@B@D@D@B@B@B@B@BA@@B@B@@AB@B@BBDABBD@D@D@B@@@PGZM@@@CBABCBABCD@HBD@H@BCDADAF@@BDDDF@@B@BAB@DABAB@B@B@B@B@B@BBB@@BB@@BBBBBDBBBDDD@BBB@D@D@D@BAD@B@B@B@B@B@B@B@D@@@B@BAB@B@B@B@B@B@BBB@B@D@BAB@BANKDKBADC@C@@@AAAKO@AMKEKCCAAAAAC@@@KAACCC@G@@@@@@AAA@CAC@CAA@AAC@A@AAC@A@C@AAA@CA@@AAA@A@AAC@A@AA@A@EC@A@A@CA@AAACBCBI@AACEGACGA@A@@@A@@@A@@B@DADA@@L@@@FCF@HF@@B@B@B@@AB@D@D@D@D@NBF@BCFEB@B@D@B@B@@@BAB@B@B@D@BADA@@F@P@P@ACAGAE@E@G@@@A@A@@@A@AA@CEE@EBAAAAAAAC@AAC@CAE@AAECCAEACAA@@@A@@@A@A@A@AAAACAAAA@A@@@AAA@@@C@A@AAA@@AAA@A@A@A@C@AAA@A@AA@AA@@C@EACAAA@AAA@A@A@A@A@A@@C@ABC@C@A@A@A@AAAA@CAA@@@CBG@EAA@C@A@CBA@ABC@CBC@A@CAA@C@C@C@A@AAA@@@@A@@@A@AB@DAB@DAD@BAB@B@@A@@@AA@@AAA@AA@@AAEAC@EAE@AAAACAAA@AAAAA@AACAAAAA@@@A@A@@@AA@@BA@AFADADCAEACAAA@@AA@AAAA@CAA@A@C@A@C@AAA@AAA@CCAACA@@KJCAHOAA@ADE@A@C@C@@ACA@ABCFE@CBEDCBE@AA@E@IE@A@@AC@AAA@CBC@A@CACCCACACCCA@@@ABEAGAEAGBABABABADADABCBAAA@AA@@@AAACHEDC@ECEGBACGGA@AACAA@AIEACCFGBA@AAACASAKAGA@D@J@BCEBKCDGGEBA@EA@A@A@CCAECID@BEBBDKBE@KDGBCAEEGCEJAAA@AABAFGCEMGCBGACCC@@@CBG@GGCBGBECGGAGG@@@@AA@ABA@@AA@A@@@A@@AA@@@A@@@AAAA@AAA@@@AACGGEA@@@@EA@@A@A@KHG@AC@AQAABCDOG@ADAAA@AI@@AFEAAABEF@@GAAAE@E@GBABCBAD@ACACACACBA@GB"],encodeOffsets:[[115693,24029]]}},{type:"Feature",id:"441803",properties:{name:"清新区",cp:[113.017747,23.734677],childNum:1},geometry:{type:"Polygon",coordinates:["@@IEQIOQKAA@@BCB@BABADABKF@BED@@EC@@@ABA@A@AKIDC@CGFCBGHIFEDCDAAABAHA@AAIB@DCBCAA@@@AAA@ECGCEAEBC@GCEAGDE@C@AG@@AGBGBGFE@EGBEBEBGEG@MBGACFABABCDBB@B@B@D@D@BAD@DADAB@BAB@B@B@B@@DBFAD@AD@B@BAB@F@H@@DF@D@@AB@@@@A@A@A@A@A@EAE@@@M@EDABC@CDAFBDBBADCD@@C@C@C@C@C@C@A@C@A@@@@B@@@BABEDCD@F@DBHAHBHBDBBDDFBFB@@FBJFB@@@B@DBB@BBDBBBDDB@BD@BBB@BDJ@D@BABCD@@@H@H@B@B@BADA@ABA@@BEBCBCBCBA@A@A@A@AAAECAC@CDC@ABC@A@C@CCACCE@@E@ADAD@@BDBDBD@@BHB@BD@DABEAA@AACAA@AAAAAACC@@AAC@AB@BAD@DABC@A@A@@BABA@@@ADEBADDDDDDFFJBB@DBD@B@@@@@@CBAAG@AAICAB@BAD@DD@DDFFDF@FDDHBDDDFBFBBFFBDBDBD@HBDFBBD@DA@GBABEDG@CAC@CAOCICE@E@ADAD@FCFEDAD@H@BBDHHBF@JBH@DCDCB@DBDFNBDCBK@QAEBEHGDABEBG@AAICE@G@EB@FJJ@@BB@@BBB@DBB@BBB@BBBBB@FFBDADADA@@@AB@D@@@B@B@B@B@D@BABABBB@@BDBBBBB@@@@BDBDBBB@B@DADA@ABC@ADAB@BBBB@D@@@@B@D@@BBBB@B@B@BB@@@DB@B@D@@BB@@BBD@BBBB@B@F@@BBFB@BB@@DGB@B@@AB@@CB@B@BDBDBBBBBBBBB@B@DBDBDAB@B@BEF@@@@CBADEDADA@@D@@BFBD@FAHADABA@C@A@ABA@CBADCDEBCFCBC@G@CAE@CGA@CAAACAAAA@C@A@A@A@CBA@ABABC@A@C@A@C@@@A@E@EDCDCBEDCBEBEBGAKDEBGBAB@@ADCDCDCBABCBA@CB@@@@BB@@@BB@@BBB@B@BA@@B@BADABAD@DCFAD@FAFAH@B@DBDADBFAB@B@D@BAH@FBFBF@B@BBBBD@B@BBB@B@D@B@B@D@BBDDDBBBBDBB@D@NTDFFHFDLBDB@BBBBBB@B@BBBB@@BB@DBB@B@BBDAB@BADAB@@ABA@A@A@A@AAABA@@BAB@B@@BB@BBB@B@@@BBBAB@B@@@B@B@B@BBB@BBDBBDBBDDFBDDDBDDBBDBD@@B@@B@@@D@BAB@D@B@@ABBB@B@@@D@D@D@B@@AJBF@@BF@BDBJF@@BB@B@BBB@F@HAFAF@B@DAB@D@B@D@D@BAF@DAD@FAB@DAB@BAB@DAB@DA@@B@@@B@DBBBDBD@BDDBBBB@BB@@BB@@@B@@@B@B@B@@@B@BBBBBBDBBBDDDBDBD@B@BBB@B@B@D@B@D@B@B@B@@AB@@ABABABABABADABA@@BABA@@DBBHBDDBD@LGBABB@@B@@AD@@ADAB@DCFEFCDCDADADADAB@B@D@BBB@BBBBBB@@BBB@@BBB@D@BBD@DBD@DBD@F@F@D@FBD@B@DBB@B@BBB@BB@@@B@D@DABADAFAB@F@D@DBB@B@BBB@BB@@@@@B@B@D@BAB@BEBAB@B@BBBDBDBBABABAB@BAD@B@B@D@B@BBD@B@B@FBFJ@B@BABE@EAGAC@CB@DBBDB@BB@FBBD@D@HCP@FBD@DABCBADAD@D@BBBD@@@BABBB@@B@@@BA@@D@@@B@@@@ABA@AB@@ADAB@@AB@@@@@BBBB@BBD@@AB@BABCBCBE@A@@B@BBB@BBB@B@@@B@BABCBABCDCDEBCDC@CBCBE@A@ABA@@@ABA@@BAD@BAB@DAB@D@@AB@@@B@@ABAB@@A@ABA@A@A@A@ABABABAB@BAF@D@FAH@F@F@D@B@B@BADCDCDCBE@@BA@A@@AAAA@AA@AA@A@A@A@A@@BA@ADAB@BABA@AAA@A@@@AACAC@CACACACACAA@C@CAC@AC@C@E@C@EBC@C@C@A@A@AAAABABCBABCBABCBABC@AAA@@AAA@@AA@A@A@AAA@@ACAAC@CAA@C@A@A@A@C@AACBC@A@A@@@@BAD@D@BAD@BABABABAB@B@@AB@@@BA@@@AAA@A@@AA@A@@BA@@@AB@B@B@BAD@BADA@AB@@A@A@@@AA@@@AAAC@A@A@A@@@A@ABABABCBA@EBC@E@C@A@A@A@AAA@A@ABA@ABABABABAB@BAD@B@D@D@DBDBD@FBDBDBD@DBD@BADABABA@AAE@@@A@AAABCD@FABABABADAB@BAD@@A@@B@@A@A@ABA@A@@BA@@B@@@B@@@BBB@BBBBBB@@B@@@B@B@B@@@B@BA@ABA@A@A@A@A@A@A@A@ABA@@BA@@B@B@D@BBD@@@B@@@BBB@B@B@B@@AB@@@@A@@@A@@@A@AAAAAAAAAA@A@AAAA@A@AAA@A@A@AB@@AB@@AB@@AB@@@@@BA@@@AB@AAACAAAACAACAAACAA@A@ABABCBCBCBABCBCBCBC@@BAB@@@BAB@B@BBD@BBDBBBB@BBB@BB@@BBB@BB@@B@BB@@B@BA@A@ABA@A@A@ABA@AB@BA@@BBB@@@BBB@@DDBBBBBBBBDDBDBBBDBD@FBFBF@F@FBB@BAB@B@@AB@@@BA@@BA@A@@@A@AAC@A@A@C@A@A@A@C@ABADCDADCDABCBABCBCBC@C@ABCBE@A@A@CAA@@@A@A@@AAAAAAAA@C@A@A@A@@JEAE@@CAAAAA@ABCNGBE@I@IAG@@GCA@@A@ABAHCHEDEJGDAFHHHBB@@B@@@BBB@@AB@B@LCDBBB@@D@LGFGHEFCDCFEDCHEFCJ@@@B@NADADCFEFAF@DADE@G@M@CFCF@D@D@H@FC@AAGAEDADAACCAAAAA@A@COC@@A@C@CA@@CK@ICOOMUSQUCO@@EObMlYB@\\[FCO@GIEOE@CAE@E@K@S@QESGYCKAIA@E@E@IDSAEACAGEGAC@A@ACGEGAAA@MBKBFFA@AD@BE@EG@A@ABCBAAA@AAABABA@@@CAA@@@AFAB@@@@A@A@@@AAA@@@@@ABA@A@@B@BAD@BABA@A@AAAA@[S@AA@OKCGGK@CCECKEK@EK[EOCOCKCMCUB@@A@IAOEMIAMA"],encodeOffsets:[[115653,24138]]}},{type:"Feature",id:"441821",properties:{name:"佛冈县",cp:[113.531607,23.879192],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAECBE@CEEAAICAAAAAABEDCBA@@@@@@@@AAQK@ACCAAA@CEAA@C@AA@@@AB@BABA@A@ABA@ABGD@B@JC@C@E@@B@@A@ABABABCBABA@@@ICAHDD@@BB@B@@@BABABCBC@@H@BIJEBA@A@C@A@C@@@CBIAGEJ@DCAAAAEAECCAEACCCACAA@A@AAABA@@@A@EACCCAG@EDG@CAGAC@GFA@A@A@EBCB@@EEGBGFAB@@GDBD@H@@ABADCB@BABDF@@CDEBABEBA@A@A@GDABCDA@EBBBAFA@EDJB@DCBABA@E@A@ECA@EC@@@@A@ABAB@BAB@@A@@@A@A@AAAAA@AAC@C@A@C@@@@@@@@@E@E@CAEBA@AB@BAB@B@@@@GDGAIB@@C@A@A@@@A@ABA@ABO@O@E@@@CBABC@A@A@A@AB@@A@A@C@A@A@EFADE@MAC@C@C@C@A@@BA@A@A@@@GEE@ED@@K@@@CBCBA@@@@B@@@B@@@BHBBDFHBD@BAJADBDBBB@@D@B@BFDB@B@@B@BBD@B@BBB@BB@@DBB@B@D@BBD@B@BBD@BBB@DBD@DBB@B@@@@H@D@DDBB@L@@BDBBBBDDFLNL@BLPBB@B@@@DCDABCLMLFFDFFJFBFBENBJFHDBJ@@JIFKFIBCD@DDFPJJFFF@JPFNDPAJBJ@FABBBJDBD@F@FDDADEB@F@D@NAL@JFHHBFFBBBBDCb`KL@PCLAHAHBFBLHBBBB@@DBBB@BBDBB@B@BBB@D@BB@@@B@B@B@@@D@BAB@D@BAB@B@BBB@B@BBB@BAB@DAB@BA@@B@@@B@BBBDBD@B@DB@@DABAFADAFCDABABCBABA@ABA@ABABABABAB@B@B@@@BBBFD@B@BAD@B@B@BBBB@@BBFBHBDDBDDDD@FBF@FCHBF@F@D@BDBB@B@DBB@ABAB@D@B@@ABBB@B@@CF@L@@DH@DHDFBJ@@@JAFEBE@GB@@@B@D@B@B@D@FBBHDF@DHBH@FB@D@D@D@DFFFFPDHDD@D@FG@GBAB@BAFDDDBH@@BD@DBFBHBFBHBF@DBDBF@@@B@@BB@@BBB@@BBA@@BA@ABA@C@CBABCBCBCBC@ABC@ABABA@@BAB@D@B@B@D@DAB@BAAG@C@@BA@CFCB@BABBBBDBD@BAFIDCFCBA@@RIJCHAD@BDDFBDB@J@BAB@D@BBB@BBBBB@@BB@D@@@B@BAD@B@B@B@B@BBB@@BBB@@@HBDFBDBB@FABADGFGNQDCBABAF@BAB@@CBA@ABAAC@A@A@AJQDG@E@C@A@@@A@@@@@AA@@@@AAA@AA@@@A@AA@AA@AAA@AAA@CCAEACACAC@AAAAAAAAA@@AAA@AAAAAA@ACCACAAIAECCCB@BCDADEBCDAHABCBCAAACAABC@@B@@AF@FADCAC@@@@@ADC@@BC@A@@BADADBDBD@@@BAFA@@DDDBDCF@B@B@B@B@DA@CBCBAD@BA@A@@@@@A@@@@@I@CDCBCB@EELA@@FCF@DADADCBCB@@GBADBB@BC@E@A@A@@@E@GAA@CDC@G@@E@C@GBGA@AAECACACA@G@AEM@AEKEG@@C@AAAAA@CICAACEGACE@@@@AGCC@@@@ACDCDCBA@ECECE@CBADC@A@@EEAA@BHCFC@A@ACKHACCCAEHCFKDGAKCCAABEDIBCDA@CCCE@CAECGEGBADADEBE@A@ADEFE@EO@I@@EAABABQBGA@@CBCD@BE@CBEAAA@B@BCBIA@AEACACC@@A@@A@@ACAE@CEA@@AEACBC@AKIMKGBA@@CF@DCCCGCBEHKD@BABAB@@AB@BA@@@ABABC@ABA@A@ABCBE@AFCDA@ACIGKHIBABA@AC@EE@G@CBC@CCAA@AA@IDEF@FCBCB@CEC@AB@@C@A@A@A@"],encodeOffsets:[[116356,24272]]}},{type:"Feature",id:"441825",properties:{name:"连山壮族瑶族自治县",cp:[112.093617,24.570491],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CACCEAAC@A@IBGDEBCBC@CCGECICIAMAMBG@GBC@A@EAAAAC@AA@@@A@ABCBAB@D@D@DADAFCBABABABEFCDCFAFAF@DADCFCBCBCBCBCDCDEDCDEBGBC@A@EACAC@C@@DAFAB@DABBDF@HBBBDBDFDF@DBD@BBB@BDD@@BBB@DBFAH@DEDEBCDAF@B@FBD@BBDDDFBF@H@HAF@DBDBBB@B@D@B@DADB@@FBDDFF@DBBFDHFF@FBD@D@DBDDBB@DAF@DBF@BFFDDDBHDHF@DBD@BBD@@BFDDDDFBDDDDBDAD@D@D@DBB@DBF@B@D@DABBH@BBDAH@HAH@@AHAD@DA@C@A@ABGBEBEFABABAD@F@B@D@DBD@DBDABABAD@DA@ADCFCFABABAD@DABABEBABADBF@BA@ADCBEDGDGBG@EBC@ABAB@B@B@BADADADEBCDC@ABCBAB@BADBBBDDB@D@BAB@DAB@DBBBDBFDFDDBDDBDBD@D@BBBDFDB@B@BBABADABADAD@FBF@HBF@DAB@DABBDBF@B@BCJEJCHEFGBA@A@@@AA@A@@A@@BADABCBAB@B@@AB@BA@C@A@A@@BAB@@@B@BBB@B@B@DABA@@BAB@B@@@B@B@@AB@@A@AA@@AB@@@B@B@B@BAB@@A@@@A@A@A@ABC@A@ABABAB@BA@CBA@A@@BA@AB@BABAB@B@B@B@B@B@BAB@@A@@@AA@@@A@AB@A@@A@AA@AB@@@BA@A@A@@AA@A@A@A@ABABAB@@@B@@BB@N@JAFCDENCFEJ@BBDFHFLF@L@FBFDHDB@@BADADCDADADAD@B@D@DBBABA@@BC@G@AB@BBDFBBD@DADABCD@BAHBBHFJFHHBBDHBFBNHTHF@@PFP@H@FDHDPDLAHCJDFDHHFDFDFDBB@DDHBF@FBFAH@J@B@DBDDBDBDBBDDDFFFDBBDBBBBBDBD@BBADADBFBDJDFBHBLBH@FBFDDDDDFFFFBDABAD@DBDBBFBF@HDHBDBDB@@DBDDDBDDBDBBB@B@F@F@FBFBDDBAFCFEFCBAFBBDDFDBDBBB@BB@@DCBB@B@B@BAF@DABABA@@AAC@EACIEGACAIBCBCHCFCFEHGBCD@DCBABABAD@D@DAD@DEBEBCBC@CBABA@CAACACCCACECECGAGAABABEBCFGDCD@JBNFFAFCHEJIDABAD@D@H@F@HCJGHGFGDC@E@G@@B@FCFCDAD@BABCDEDGFGBC@E@E@CEAEBAD@BEBK@IAIEMQGIC@EDADEHEDCBA@AAGC@AA@@CA@IMEI@ABC@AAEAAECE@E@E@E@EACACC@C@CEEEEGACCACCA@@CAC@@@CAM@GBGBKDC@E@ECEKCMAA@@IaCK@ECEAC@EA@AADGAABOACBABA@E@ADCDEBABC@CACCABC@ABA@@BC@A@A@C@CEAAAAA@A@ANCB@BA@@ACACAAAAA@CBAD@DCDABCBCACAA@C@C@C@E@CCCECEAG@A@@C@CBG@EBIBC@CDGB@BEBIBI@C@@@AAAC@@@C@G@ABABADCB@DAF@DADAB@@@BABC@@@A@@AC@AB@@AB@B@@@@@@A@@@ABA@AB@FADAB@B@@@@@LEBA@CAAGC@ADCFCBADEDCDAJABADGDGBC@E@A@ABADAB@B@@C@EAAAAAA@AAG@G@G@A@@@EBAFAFAHCDCHG@A@A@ACAC@I@@@CC@EBGDC@ABCD@F@HDH@DADIPKLEDE@CCEACAC@APG@A@ACEU@CEAGFINOFAHCH@DBF@HBBDB@HNJN@BBBFFHFDFDDBBDBD@D@D@DADEFEBGDA@CHGHEBCFAB@BABCBCBE@CBIBG@CBCBA@@DAD@D@F@DAF@DAB@FE@@DC@G@CBEDADEDCBE@C@@AC@C@G@GBEBCBKBIBG@ECCCCCGAG@IBG@I@G@CAC@AAGEICGCCAAC@ABCBCDCBCBC@CAE@CAA@E@C@C@A@AAA@@E@EBGBGAC@CCEECCCECCGCEA@@CAACCECE@E@CAC@AACCEAGAC@C@C@C@AA@CBC@@ACACAACAEAG@CBGBEDA@@DAD@FAD@BCDGDGDC@CBEBCDCFADAFAF@FADCDCBEBEAE@A@A@ABAB@FCD@BEFCDCBCDADCFADAD@DABCDC@CBE@CBCBEBC@A@CACAAACGACAEACCAE@CAAA@CAGAE@@@E@G@C@EAC"],encodeOffsets:[[114739,24804]]}},{type:"Feature",id:"441823",properties:{name:"阳山县",cp:[112.641363,24.465359],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@BABAB@BABAB@DAB@D@BAB@B@D@B@B@B@B@B@BAB@B@@BB@B@@BB@@@BBB@B@BAB@B@B@B@B@B@B@DBBBB@B@BBB@B@BA@@B@DA@@B@B@B@B@@@B@@@BB@@B@@@BB@@B@@BB@BBB@B@B@B@B@DBD@F@D@F@B@B@@AB@D@DAB@D@B@B@B@B@B@B@@@@@BB@@@BB@@B@@BB@BBB@BBB@DBBBBBBB@BBDBDBDBDBDBBBD@DBB@@@BBB@B@@BBB@@BB@@BBB@@@BBBAB@F@FAFAF@D@DAB@DBB@DBB@DB@@B@B@B@B@@AB@@@BA@@@@@A@A@@BA@@B@BAB@BBB@BBB@BB@@BBB@B@BBB@B@BBBBD@BBB@B@@@B@@@DABABADAB@@@B@BABBD@BAB@DBFDB@@@B@@AFB@@B@@@B@@BDBB@BB@B@BB@@BB@@@BA@@BB@@B@@@BABA@ABA@@@ABA@@@AB@BAD@BA@ABABA@C@AAC@AAC@A@A@A@A@A@A@AB@@AB@BAB@B@BA@@BA@C@A@C@A@C@A@C@CBCBA@CDABABABAD@BABABC@A@A@C@C@CAC@CAC@CAA@AA@@AA@@A@@@@A@AAC@A@C@A@C@C@C@C@A@CA@@AAAA@@EECCCCCCA@@AA@@@A@@@@B@@A@AAA@A@@A@AA@@A@A@AAA@@AAA@AAA@ECEAEAEAGAECECCC@A@@@A@@@@@A@@@@@AB@@@B@@AB@@AB@@AA@@A@@BA@ABA@ABABCBCBA@A@@@A@A@@@AAA@@AA@AA@@AAAAAAAA@AA@@A@@AA@@@@AB@@A@@B@@A@@@@BA@@@A@@@A@@@@BAA@@A@@A@ACACAC@CACACACAAAAAA@AAA@A@AA@@A@AA@@AA@A@A@A@A@AA@@AA@@AA@A@A@A@C@CBCBC@ABABABA@@@AB@@A@@@A@A@AAAAA@AAA@A@@AA@@AA@ACAC@A@ACBC@A@AB@@A@@@A@@AAA@A@CAC@C@C@C@C@CBC@C@A@A@A@A@A@AA@@A@AAAA@@@A@@@@@A@@@A@@@AB@@AB@@ABA@A@A@A@A@C@A@C@CBA@ABC@ABABA@ABA@A@A@@@C@A@A@AAABA@A@ABA@ABABABC@ABABA@A@A@@@A@A@A@@@AAAA@@AA@EACAEACAAAAAAAAAAA@AA@@A@ABA@ABA@CBA@AB@@A@A@A@@AA@A@A@@@@@A@@@AACEEMSGE@CBQ@MBCDCD@FDFDFBD@BA@C@CB@DBDDB@FBH@FBH@B@DCBABAD@B@DBH@F@BA@@@@B@B@@@B@BAB@B@@AB@B@B@@@BBB@B@BAB@FCFADABABABABACSAC@C@EBABADBD@DABABA@CBA@ABA@ABABABA@@BABA@A@A@A@CAAAACAAAAAAA@@AA@@A@A@ABA@C@ABCBABA@@@A@A@@@A@@@@AAA@C@C@C@A@A@A@A@AAAAA@C@AA@@A@A@A@AB@@A@A@@BA@AAAAAAAAAAA@C@A@ABA@ADADE@CAO@E@@BA@@BA@A@A@@@AAAA@AA@@AA@AAA@AA@A@AAA@A@C@ABA@ABABABA@ABAB@@G@GAEACACC@CAAC@A@CBCBED@@AB@@@B@@A@A@A@AAC@@@BA@CAAAACBA@ABCBA@AAC@AAAAC@EACCACCGECEC@A@AAA@AAACAAAAAAA@CAA@CA@@A@@@A@AAA@ACECCA@@E@CDC@@BA@AB@@AB@@@BA@@@A@A@A@CBCAA@C@A@@@A@@A@@A@@A@@@A@@BAACAGCMMAE@ABADCBCDCBABCBAB@D@B@D@@@B@@@BB@@B@B@@@B@@A@@B@@AB@@AB@B@BAB@B@@@BAB@@@DCDCDABCBE@EAABEBC@CACKIGCAAAC@@CCAACCAA@@AAA@A@@AA@A@ABA@ABA@ABCBA@ABAAA@AAC@AAAAA@A@@AA@@BA@AAA@A@AA@@AA@@A@AAA@A@AAA@A@AB@@AAABEBC@A@AA@A@E@C@CA@AAA@EDEFEDABA@A@ECACECGCGAE@GAAACE@@A@AAA@AA@A@@AA@AACAAC@A@CB@BA@C@@@AAC@AAA@AAAAAA@ABA@CBABCDCC@AAA@AA@A@@@@BA@@BABC@@BAB@BA@@@@@A@@@CB@@A@@@AA@AAAB@@C@AA@A@CBCBCDABA@CAC@EDO@G@CACEAA@@ACAAA@CDAD@HBFBF@BA@A@AEIEAA@A@C@AAA@C@A@A@C@ABA@ABABABCACAAA@A@ABAFA@ABA@A@C@A@A@@@@AAA@AAA@A@CAC@E@A@EBCBABCBC@A@@@A@@AAA@A@AAA@C@AAC@E@C@E@EAC@CAC@CAC@A@CAA@AA@AA@@AAAAAAA@AAC@A@A@CBCBCBCBCDEDEFCDA@CB@BC@@BA@@@AAABKHC@CAACAGCA@@ABAB@@ABCBABABABABAB@BA@@BA@A@A@C@A@C@A@A@A@AAA@C@CACACCAACAAAAAAAA@@@A@A@A@@@A@@@A@@AA@@AAAAACC@AACACAA@C@A@@@AB@@CBA@CBA@ABA@CBA@EBC@CBE@A@C@C@A@CBA@C@ABEBE@G@EAA@A@AAA@@IECA@AAE@@AEBI@@@A@C@C@C@@@AAABA@@@A@CBA@A@C@@@AA@@@ACACCAACCCACCEACCAAAAC@AAA@A@A@A@A@@@ABAAA@A@@@AAA@AAA@@@ABA@AB@BABBB@B@B@B@BA@@BABC@ABAAC@A@AAA@CAA@@AAAAA@A@AAAA@ACAKAECEGCEMSC@A@CAAAAACCAC@A@C@A@A@C@AAA@A@AACAA@A@AAEAE@EBG@A@C@ABAAEBCAC@C@ABGBE@EBCDE@CBCBABC@A@AB@@A@AAA@AA@@A@@AA@@A@@@CCE@C@ABADAD@BBF@DABC@ABA@A@A@A@A@A@AAA@ACA@@@@AAACAC@C@E@A@EA@@A@@@A@A@@@A@@@CBA@CBEBEBC@C@CBAB@BAD@BA@@B@D@B@B@B@BBDA@AFED@B@DB@@@@F@BA@A@CBAB@D@DBB@BB@B@FDDBBB@BB@BBBB@@@B@@BBA@@BAB@BA@AB@B@BAB@B@B@D@BAB@B@BAB@BA@@BAB@BA@@BAD@BAD@D@D@F@B@B@@@@A@A@KJAFAB@FBBBHBH@FBB@DADAB@DCB@@EAGBADAD@BBBBDDBBD@BADGTCLABCBG@C@ABA@EDCDCBC@C@CAECEECAC@AB@B@FBBDD@B@B@@C@EAGCC@CBABA@E@C@C@EDADADAJ@LBDDDDBDF@D@DADABCDADADBB@@B@D@DB@DADADADAD@BBD@@BBDBD@BDBB@JBL@BBDBBBBBB@BFBBD@D@FAD@D@B@@B@B@DCB@B@@BBD@DBDBFBHBHBL@N@DAFCHAPCVCPAL@BAFADCBCBA@A@E@E@KCMAABAB@D@FADAFCFEFBBBL@HARARDPDLDHBBB@BB@DBDDDDDFBDBDB@B@DABAB@B@DBFBH@@BHBHFBHADABGBCFSDGBAHADEDEJKFEDCD@B@DBBDHRADEHMLMNADADABADBBBBFBH@HBBBDBHDDBF@DBD@BB@B@D@DAJAJ@H@FGBE@C@AC@C@C@AA@AAA@C@AB@D@BABCBABADBD@DFJ@B@FAD@DAN@BCBA@A@AAAC@EBEDGBE@A@CA@AAA@C@C@AAAA@CFI@ABC@ABAAAA@A@ABAD@JABA@C@A@A@ABCD@@EFGHCB@@A@CCCCC@A@A@ABABCBADAB@@@@@@@BAHAHAB@BAB@DDBHDDDDFRZFJ@BCDABCFABCBC@EASGCACC@A@E@CAACAEA@BC@C@A@EAEACACBABAB@B@BA@@BCFABEBIBABADAB@B@@@DBBBB@B@B@D@BDDBDDDBD@DABADCBA@AACAE@ABCBAB@DBDFHFHDDFFAB@DABCDABAB@B@BBDBF@H@FABABCB@BAH@BBBBBB@DFB@@B@BADABABAF@DBB@BBDDFBFDFBHBFFN@H@DBDBBDJCBCB@B@DDF@@DF@B@@@D@D@DBDDAB@FAHAB@@@B@BBBFBDLNHPBDBF@BBBD@B@FAF@BBB@@D@BDBDBFBDD@@FBFDD@F@B@DBF@DAD@DCBAD@BBDBNAL@T@HBDBDDBDBD@B@BDBBBB@@@BAB@B@B@B@B@B@B@BBB@D@B@B@@AB@@@BABAB@BA@@B@BBB@@B@@@B@@BB@@B@BBB@@@B@@@BAB@@AB@B@B@B@B@BB@@BBB@B@@@B@B@@@B@@@@AB@BADABABAB@@@@@B@B@@BB@B@BDDBBBDDBBDBBBDBBBB@BB@BB@@@@BB@@BBB@@@@@BA@AB@B@BABBB@BBBDBBBDBDDBBDDBDBDBDDBBDBD@B@@BB@B@@@B@B@BBD@DBDBDBBBDDBBDDBB@DBD@B@B@@AD@@@@@B@B@@@B@@@@@DBDBBBDBD@BBD@DBB@D@B@B@BAB@BABA@A@ABA@@BABADAB@BAB@@@B@D@BBB@BBB@BBBBD@@BB@@@BBBDBB@DBDAB@B@DAB@DADAF@D@F@FAFBF@BBDBBBDBBB@BBBBFBHBFBFDFDHBHDFFBBBBBBBBBB@B@@BB@FBF@FBFBJBHDHBJB@@BB@BB@@B@@@B@B@@B@@@@@B@@@BAB@D@B@D@@@BA@@BABABABABABAB@@ADAB@D@BBBBDBBDDDB@BB@BBB@@BBB@B@B@B@B@B@BBDBBDBD@BBB@D@B@HBF@FBFBD@F@B@FB@@BBB@BB@@BBB@B@@@B@B@BAD@DAD@F@B@B@D@B@D@B@B@B@B@B@B@BB@@BB@@BB@B@B@B@B@B@B@D@BBBBB@BBBB@BB@B@@@B@B@B@BA@AB@BA@@BAB@@@B@@@B@@BB@@B@@BB@B@BBB@DB@A"],encodeOffsets:[[115488,25495]]}},{type:"Feature",id:"441826",properties:{name:"连南瑶族自治县",cp:[112.287012,24.726017],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBE@CBE@C@C@CB@@ABAD@DAHAJ@DAFADADABA@EBADGFGH@DCBAHEFCFCBC@C@C@CAAACCCEGEEEAA@AIMGMA@ACGAE@CAG@GDEBMPEJBHDFV@DF@B@BOH@BBDBDDF@DCFKFOLCJCBG@GCE@C@AD@BCDAH@FDD@@J@D@DB@B@B@BGHCDGDEBEBAB@F@@@B@H@HBH@BBBBBBB@F@DA@A@CBAB@B@B@FADCHCHABIBCBCDCFABEDCD@BHDBB@DABKF@@@@A@A@CBEBA@@BAB@B@@@B@@@@A@A@@BA@@BBD@@@B@@ADAB@@A@CBCBE@CBA@CDABAB@B@H@D@@BDBB@@D@J@JAFA@AHADCD@JAFAH@DAD@@@@BBHDFDFDDF@D@D@D@B@DBDBDABADC@CBCDAB@BBBBBDBD@@ABA@MD@B@BBBBBFB@D@D@B@BAD@@AB@BADDBBD@DADABCFCD@B@FABABBDAPBBCHBBB@@FBDDF@FDLJb@@BBDNFLFDF@D@LCHAHAN@DB@@D@DB@@DBBDDDHBFFFF@D@DDDDBFBF@F@F@F@FDBBBF@BAD@BFJJNB@@DB@@BHDBBB@DAFCFGBCFCD@HJNRJFJBL@FA@ABCFAFB@D@F@FADEHCHCFADABC@CBEDEDA@@@@H@FCDEHGHIHGDE@G@C@C@ABCBIJGFEDEBMEIAC@CDEHADAFABBBBHDHDFDFDBDDDBBB@DABAB@DADADAFCFC@CBC@C@ABABABCDC@ADGHEFEDGDADADBJBDFHDJFBD@BB@@ABABCBE@ABA@A@A@BBB@B@DBBFBDAD@BBBB@@@DDBBAB@BCBABCBABBDBD@BBB@B@BAFAF@B@B@@BBBB@DBFAB@DBDBF@FBFBDBF@B@BBBBB@FBD@D@BBDAD@BDABCDCB@@AB@BBBBDDBBBFBDBBF@DALGBABAB@DBDAFAFCBADAFEHEFAHCDADADCDABCB@BCHIHGFAHCHGJGBA@CACAC@EDGBCJMFE@@BCBECCCACCCACC@CACBC@CB@F@H@FBD@BCAC@EACAA@@CCA@I@CAGECEDELCFOBKBI@K@A@ALAF@JAFCHCBCDCBABEBEBIBGACAAEIEEACDAFADCBEAE@CDGFE@A@@BA@E@C@EBCDCBCFADAF@@ABA@ACECCCCCCBG@ABEBEBE@A@E@ADEDEH@DAFDJHDBF@F@DABA@C@CCA@EA@@EAAAAC@C@AA@C@@AA@EBGBEFCFABA@A@ADAF@DA@C@GAGAE@A@@@ABCBCDCB@BBBLBBD@BABEDCBAB@D@FBBBB@FDFBD@B@BA@A@C@E@@ACACACCEBEBAFAFDLH@BDBF@B@B@AC@C@C@C@@@ACE@@CE@C@ADADACIAAAC@C@GEMAEAGCEAECEAC@AAA@CBEBABABC@A@AA@CEA@AAAA@ABG@ADABABA@E@GAEAC@A@ABABADCBA@CBAEECCEGEGAC@CBADABAF@DBBBB@DABCBA@CACCCACCC@A@C@A@AAAAA@C@@@ABABCBAJAFABADE@AB@@A@ABABADADBFBFBB@D@D@@AFBDBBB@D@F@BDDDBTHFBD@DABADEBADC@AEIQYCECCGCCA@CBA@ABABGBG@A@@@@@@BABCDABABAB@B@D@DDDDB@@@DAHGFE@@DCBAB@B@D@B@BA@IBCBAB@B@BBAB@BAD@BEJ@DBBBBD@D@B@BBB@@D@BAFCHAF@FBDBBB@B@DA@ABM@CBC@E@AEI@CACBCBADABA@A@CBAD@B@BBB@@B@D@DBDD@F@HA@E@GBIBI@C@C@AAAC@CAE@CAGCCAAAGAG@EAAAAABCBABCBCNMNKFGBCGQACCAA@C@CDEFILCFCFGBABCHETADAHCBGBEAAGAG@@AGAE@C@ABABA@C@ACACAEACCCCAC@CAAA@AACGCKCOBQBQ@GAKAAAFCDC@CAABCBEDEFEFCF@F@D@B@D@@A@@@CBE@EBGDCFAFAHAD@DADCDA@C@EAGBEBABA@@BA@SBKDOREJA@A@ADADADCDCDABCDC@A@GAGAC@CBEFEHEBGDA@A@CAEKAIBKJQFeB[AEEAGCKEMAE@GDQD@@@DAFCDCFCBAF@D@HCD@@EFA@"],encodeOffsets:[[114939,24934]]}},{type:"Feature",id:"441881",properties:{name:"英德市",cp:[113.401701,24.206986],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@C@GAC@ADABADAB@D@@YNOH@@A@C@C@C@AACBAAA@A@@BA@A@@@ABA@A@A@A@C@C@A@A@C@C@C@ABA@ABAB@D@BHLEHKNECMEO@OHGAQIOCQJEBE@EDC@ECMCKAK@@FC@AB@D@BBBBBDBBDCBCBBFBH@BEDG@C@C@E@ED@D@N@HCFCBE@EBEFCDCBMBA@@@I@EDGFCDEFCDEDGFEHKHC@@@AACAKDA@A@@BA@AA@@A@@@AAGGEGCBIHCFGFGDAB@B@BB@HD@@BH@J@JAFMHAD@BBBBBDB@@BFIF@@@B@B@B@DBBBBBBBB@@@B@B@@BB@D@B@BAFAD@B@DADADADABADCBCDCBCDAB@B@D@B@B@B@D@B@BBD@B@B@@@BAB@@AB@@A@@BA@A@ABA@EAE@E@EAEAC@CAAACACAACAAAAAACA@CA@AA@@A@AA@@ABA@@BAB@B@B@BAB@B@BABA@@@AAA@@@AAA@AA@@AAA@AAA@AACAAAC@AAA@A@AB@@A@AB@@ADADADADABADADADAB@B@BBBBDBBBDDBBBBBBDBBA@@B@@AB@@@@A@@BA@@BA@@BA@@B@B@BBB@B@BBBBBB@B@BBBBBBBB@B@B@@@B@@@B@@A@@BA@A@A@A@AA@@A@@@C@AAC@A@A@@@AB@@AB@B@B@B@B@B@B@B@BAB@BABA@@@A@A@A@@@A@@@AAAAAAA@AA@@A@@@A@@@AB@@@BAB@B@B@BA@@@@BC@ABA@CBABABABEBC@ADBB@B@B@@BF@BABABCBABC@CAC@CACAEAC@CACAC@C@A@C@ABA@ABABABAB@BAB@B@BBB@B@B@B@B@D@FAD@FABADABAB@B@B@@@B@B@BBDBB@@B@@B@@@B@BA@@BCBABC@ABA@A@A@@B@@AB@@@BBB@@@BBB@B@@AB@@A@@BA@A@ABABABABC@ABC@C@AB@@@@@B@BADBD@B@D@B@B@B@DBB@DBDDB@BB@BBB@B@B@@BB@BB@@BB@BADABADABADABADABBBBBB@B@D@D@D@FAD@F@D@D@@BBD@D@DBBBDBDBDBD@DBDBD@B@@@BBB@BABABA@CB@BAB@@@B@B@B@BBBB@@BBBBB@@@BAB@@AFCDCDCDABA@A@C@E@E@G@EBC@E@ABA@ABABAB@B@B@B@BAB@B@BA@AB@BA@@@A@@BC@A@CBA@ABC@AB@@AB@B@@AB@B@BAFAD@DCDADCFCDADABADABA@@@A@A@AAA@AAA@@@@BAFADADABA@@BCDADAB@DAB@BBBBBBBB@BBD@BB@@D@B@@ADAB@D@BBBD@BBB@@@BBBB@BBB@@@DFBBHBF@HBHDFDBDFDB@B@BAFCFEFCB@BBB@@D@D@F@BBBB@D@FABABB@@BAB@B@BBB@B@BBB@@@BB@@BBB@B@BBB@@AB@@BB@B@BBBBD@BBB@BBBAB@DABAB@BAB@BAB@B@@BB@B@BB@@BBDDBBDD@@BDBBHDLJBD@DADAFBB@FAFADCBCDCD@@A@AB@@A@A@ABA@A@@BA@@BA@@@@BA@@@A@A@@@AA@@A@@@C@A@C@A@ABADABCDADCDAB@BBFNNHDDBBB@AB@@@B@@@@BB@@@@B@@@B@DBBAD@D@B@B@B@@AB@@A@@BA@@BAB@@CD@D@FB@DDDF@BBB@B@B@@@BB@@DBB@DBBBBBBDBBB@BBB@B@BFDFDDHBDDDFBD@BBBBD@BBB@DABAB@DABBBB@DAB@@D@BBB@B@B@@@@A@@BA@@FCDADAB@D@BB@DDDDBFBHBH@@@BABAB@BABABAB@BAD@B@B@BBB@B@@BBB@BBB@@BBB@BB@B@@@B@BAB@@AB@@@FBP@DCFCB@BAB@B@B@DBBBBBBBBBB@BAB@@@B@BA@@B@B@B@BB@@B@DBBBB@B@B@B@B@B@D@D@DBB@B@@B@@@B@B@@@B@BADABAD@B@BAB@B@@@BB@@BBBBBBDBBB@@BA@@BABC@ABABAF@DBD@DBB@FE@AFEDE@G@CBABABABADADCBADABABADABABAB@DA@@B@@@B@@ABA@A@ABC@CBCBAAC@A@A@@BA@@@@@C@A@ABAB@B@BA@A@ABADAFCB@BABABA@@BABABAB@B@@@BABABA@@BA@CBBB@DBDADCBAPGHAHBHBHDDBB@@@BA@@B@B@@AB@B@BB@B@@@B@D@@BDBDDF@BBDBBBD@@@B@@@B@B@@@B@B@BBBBBBB@@BBB@BBB@BBB@BBD@DBBBD@D@DCFCB@@@NKFEJMFAFAH@FABA@AB@D@B@@AAEACBEBADCB@DBFFBJ@DBBD@FADADAF@D@FBFFFBF@JEDCBAD@JADBFBDFBHHHFL@FFBB@DB@BBAD@F@DBDDBBBFBBB@B@@A@ABC@AB@B@FBDBDD@B@@BDBBBBB@@B@@B@@@@@B@@AB@@A@@@C@C@A@C@AAC@ABA@ABABCBAB@BA@@BA@@@A@AB@@C@A@ABABABABAD@@AB@@AB@B@@AB@@@@C@A@C@A@ABA@ABA@@BAB@DADADAFAD@DAD@D@DA@AB@@A@@BAB@@AB@B@B@B@BBBBBBBBBBDBB@D@DBB@B@B@B@BBB@D@BBDDBBDDBBB@JB@@B@B@DAFBZ@FDHDHFPPHLBBDBD@DA@CAG@A@A@@@AB@F@HAFBJBF@HAHAD@B@DBD@F@B@BA@C@ECCCCAE@CHI@A@ABCBAB@BAB@B@BAD@B@D@DADADAFABAD@B@D@BBBBBBDBD@DBD@D@F@DBD@FBDBDDDDDDBDBDBFBDDB@BB@DABCFCFCN@BBFDJPBDB@B@B@DCDCBCDC@A@@BA@A@ABAB@@AB@@@@@B@@@@@B@@BBB@BBBBB@BB@@BB@BBB@B@@BB@@@BB@@@D@D@B@D@BBB@B@B@B@@BB@BB@@@B@@@B@@@BB@BBD@BBD@DBB@DBBBB@@BBBBB@@@BA@@B@BB@@BBDBD@HABADAB@F@D@F@D@D@B@DBB@@@FGBC@C@A@AB@@@BAB@BBBB@B@D@H@FBDBDFBF@HAFADE@CACCEAA@A@A@AB@@CBADA@AB@BB@@FBBBBABA@AAE@ABAB@F@D@H@B@B@B@DDD@B@B@DCBAB@BAD@DAB@BCBCAABABAFCFCHEFCBADABADAB@B@BBB@B@B@@AD@BAB@BABAB@BABAB@@A@@BA@A@AB@BABAB@@@B@B@BA@@BA@@@A@AAA@A@CBE@CBABCBA@ABAA@HEJGX]FGBAB@F@DAD@BDFDAFB@BJ@TBFFDH@V@@@B@F@F@J@HABAB@@AB@@AB@@@B@@@B@@A@ABC@C@C@C@C@ABC@ABAD@BAD@@@BAD@B@BABCBAD@B@B@D@BB@@B@@@BAB@BA@@BA@@BABC@@BAB@B@BAB@DABAB@B@F@BC@C@E@ABCAE@A@@@ABA@AAA@@@AA@@@AAAC@@AA@C@CBA@ABCD@DCBCBABCBA@@@@BCBA@A@C@A@C@@BAD@DA@@BAA@AE@CBAAA@AB@DEBAFAFAB@@@DADAB@B@FBD@DABCDADGFI@A@@BCBE@@@A@C@C@AAC@@AA@@@AA@AAA@@A@A@@AABA@E@C@ABGBE@C@CAC@A@AACACH@@@@AD@B@@A@A@AAAEC@@IAA@@AAA@C@C@A@AB@@A@AB@DABAB@B@BABAB@BABA@@BA@ABAB@@@B@@@B@BB@@BDDDDBJCFCDA@C@A@A@CAA@AAA@@@A@A@A@@@@@AB@BAB@B@B@B@BDBFD@F@@@HCFCB@@C@E@EAGB@@AB@@AB@B@@AB@@@@CAAAA@AA@@A@@BA@AB@@AB@@A@C@AB@BA@A@CFEBAJCHADAD@@AB@@AD@B@D@D@@A@C@E@ABA@@BBJDD@@@BA@A@A@@AE@CAACAA@@@C@EBE@E@C@CCEAC@A@A@ABBB@BABEBCAC@AA@@A@AAA@AAA@@ACCAA@@CCCCAACAAA@@CAGCEAGAGC@AA@AA@A@@CAAACA@CFG@EBGBCF@D@@@BADCBA@C@AAC@ACAACA@@AA@E@I@E@CGAI@@A@AAA@A@CACACACACA@AAC@A@CBA@CBABABABAB@@CAACCC@AE@E@G@EDAF@@@BB@@B@BA@@B@@A@A@@@C@@EAGC@A@A@E@AACC@CACBA@CA@CFK@ECAAAE@C@C@CAAAAA@ACAA@AAAAA@A@A@A@@@ABCBA@CAAAAC@A@@CEACCCAEAAAAA@AAAA@AAA@A@A@CBC@E@@AACACAA@CDADABABC@E@CAKICAC@ABADCB@BE@EB@@B@@BBB@B@@B@@B@@@@@B@@@B@D@FCHIR@B@B@BBDAB@BAB@DA@ABE@ABABCDMREHCHABEBA@CAEAAC@G@@AA@AA@AAA@A@A@A@C@ABA@@@C@A@@AA@AAAAA@AAC@A@ABI@A@ACCEACC@GBIDQJ@@ABEDCDEJABC@CAAAAAABA@ED@DAB@@@DBHABA@CBC@A@A@C@A@AB@@ABAB@BAD@BADADADADAB@D@DAB@BAB@@AB@AA@AA@@AA@@@A@@AEAC@CAEAGAEAGAE@CAC@@AGCCECABA@AB@HEHC@C@GCOCEEEE@C@C@C@CEAG@GA@CCEAGEAC@A@A@C@A@@@A@@HAFEFIB@@I@EAGC@CCG@@@KDE@@@AAABA@@@A@CBABAA@CAA@A@CA@A@C@EAEDG@EAE@ECCCCCAACAGAE@AA@AA@A@A@ABC@A@AECAA@A@@@A@ABABABABABAB@BAB@BADABABADCBEBCBEBA@CA@@C@AACACAAA@@@A@@@ABA@CBA@ABA@AAA@A@AAA@A@ABC@A@ABC@@@A@A@A@@@A@@A@CAA@A@AAAAC@AAACA@@AAAAKGEAGAGBKBODK@_LDaACAAEAAEGGIEK@MBC@E@A@CFCBECE@C@CAAIAAEBI@IAOBMCOE@IEEIEOICE@CDCJALEJE@II@CAEGAIFMEAEAEICEEEABA@ABC@A@A@AAA@A@A@A@A@A@ABA@@@C@A@A@A@A@A@A@A@C@ABC@C@C@A@AAC@CCAACAAAAA@AA@@AA@AAA@A@A@A@A@A@ABCBA@ABA@@@CECC@AE@CBCBAD"],encodeOffsets:[[115990,24420]]}},{type:"Feature",id:"441882",properties:{name:"连州市",cp:[112.377361,24.780966],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CBA@A@E@CA@AKGECEBABAFDFBDBDBD@@@F@D@BABA@C@EAECA@AAEAC@A@ABCDAFABC@AAAKAAA@CDADAD@B@@@BBFBH@H@DCBE@CB@B@BABEBEDAFAH@FBB@@@DBBD@D@BBBB@FB@@FDB@D@DABCBE@E@CAIGECCBG@CFCF@B@F@BAFAFAF@BAHDDDDDDDF@BAB@BE@CBEBADCDAD@F@D@FAB@@@BEFCH@DBFAFCDEBCBBDFFFJBBBDAHAJAFAFABCDADGDEDIBE@KB@B@B@LAJALEPKDCFDFHFDBJ@B@DD@@BBBD@FBDADC@EAG@E@A@@DADBD@DDDDBDDDBDDAFAD@@EFINADCH@FBDBD@DABIHGHGDEBGHGJADA@ADCBCDCBCBGDEBGFEFCBABEDEBCBCAA@ABABKHCBE@AAACAEAACCAAAAA@@BA@CDADCB@BABA@E@EBA@A@EBCDCBA@E@CACB@@ADABCBCBC@A@ADADADADED@@GDCBA@@DCDAF@DADCBEB@DBBBDBF@DAF@F@D@H@H@BBBHBFDFBDBBD@@@BB@@BBDDDBDDDDDDFDFBBBDBBBDFH@BB@@B@@@BA@ABGJ@BA@@B@@@B@B@B@BBBBBBBBB@B@@B@@B@B@@@B@BAB@D@B@B@@@B@BA@@BHPB@@BB@@BB@@@B@BBB@BB@BBB@B@@BB@B@DBB@D@F@B@B@@@B@D@@BBDHBDBHBDBDBBBDDD@B@@BB@@@DAB@D@D@DBF@D@F@@@B@BABAB@BA@@B@@@BB@@BBBDB@BBBBBBBBB@BBBBB@B@@@BBBAB@BAB@B@BBB@BBB@@@BBBBB@D@B@B@B@DBB@@BBBB@BBDBDBB@B@@@B@BADADABAB@@AB@BA@@B@@@@@BBBB@BBB@DBB@DBD@@@B@B@DAL@J@D@R@TBN@HAFABCHK@CB@@@BAB@BA@A@A@A@A@C@C@C@C@A@@@AB@LDPF@AB@@@B@@@BA@@B@B@BBB@BBB@F@@@BABA@@@ABA@AB@BAD@B@D@VCFB@@B@@@BB@@B@@B@@@BAB@B@@AB@@BB@@BBBDBBBB@BBBBBBD@@B@@BB@B@B@BAB@B@DAB@B@F@D@FBDBDBBBDBBDB@DBB@BBFFBBDBDBDBDBDBB@DBD@@@B@BAB@DABA@ABABA@ABEBC@C@ABABA@@BAB@BABABAD@BABA@ABABA@AB@BAB@@AD@@@BAB@@A@A@AA@@AAAAAAAAA@AAA@A@@B@@A@ABABABA@ABADADADCD@BAD@D@B@D@D@D@@@DBBDDBBDB@@BBBBBBDDDBDDDBDBDBFBD@DAD@DBD@BBB@BDBJJJHB@@@B@BAB@B@@ADA@ABABCBCBCBC@A@C@A@CAA@CAC@C@ABABADA@CBCBA@@D@@@FAD@F@D@D@D@B@DBDAF@BAFABABABABAB@@ABB@@B@@@B@BBB@@@B@B@B@D@BAB@BABA@@BC@EBAB@@@JAJBD@F@FCBAN@H@NCJCJEFANCH@@@J@@@DBFAF@F@DBBBDBD@D@DAB@D@HBBBFDF@LFD@DBJ@F@DFDFDBD@FAHAB@DABCDABAB@BAF@FBFAB@DADADC@ADEDEBG@A@C@CBAF@D@@@FAFCHIBAPKDEB@BEBCBCJCNED@BADE@AF_BS@A@GGmJQJKBADAFABAD@BC@E@CBADAD@D@@C@CBCBE@A@A@ABA@A@@@ABA@@@A@@@AA@@@AA@@AA@A@A@@@A@A@AAA@CBA@A@A@A@A@A@@@A@@@A@A@A@@@ABA@AB@@A@@@@B@BAB@BAB@B@B@B@D@B@BAD@B@BABAB@BAB@BA@@B@B@@@B@B@B@B@BAD@B@BAB@BABABAB@BABA@ABA@CBA@C@C@C@C@A@@@A@AAA@AAA@@@AACBA@@@CBA@ABA@ABA@ABA@A@@@A@A@@@AACACAAACAA@AAA@A@A@BCAA@AAA@A@@AA@@@AA@@@A@@@A@@BA@AB@@ABAB@@A@A@A@A@@@AAAA@AA@AAAAA@A@C@A@A@A@A@A@AAA@@AA@@AAA@A@A@A@A@A@C@A@C@A@A@E@C@CBC@ABA@A@@@A@A@AA@@AAA@AA@@EAA@E@C@EAEAE@GAA@C@A@AAC@CAAAAC@A@A@A@A@A@AAA@AA@AAA@@ACACCAAACAA@A@CBABC@@BABABABABABA@AB@@A@@@C@A@CBA@A@@@A@@@@@AA@A@@@A@@@AAA@@AA@AICGAGAIAE@EAE@EAA@@@AAAAAAAAAAAEEGCGAECECEAGAEAAAAAA@AAACAAAC@AAEBE@E@E@CBEBC@CBA@C@ABAAC@CAAACAA@@A@@AC@AAAAA@AAA@AAC@A@@@A@ABA@CBABAB@@AB@B@BABABA@ABA@A@C@A@CAC@AAC@CAAACACA@@@@A@@@A@A@@@@@C@@BA@A@C@CAA@CAACCAACAAACAC@CAC@A@A@A@@@AAA@@@AACACCAACACACCCAACCCAAACAAA@AAABA@A@ABAB@@A@@@@AA@AA@@A@@A@@AAAA@AACAAACAAACCAACAACA@A@@AA@A@@@@@A@ABABCBABA@@B@@A@@@A@A@@@A@A@AA@@AAA@A@A@A@A@@BA@AB@@A@@@A@AAA@@@AA@@@A@@@AA@AAA@@@ABA@ABAB@@A@@BA@A@C@A@AAA@A@A@A@A@A@A@AB@@A@AACA@A@AACACCCCAGAS@K@MBCAAAC@ABCDC@CBE@CAA@E@C@ECEA@@CCEACACA@A@CA@AAE@EBA@C@AA@AAEACGOKMACAEAAA@@@A@GBEB"],encodeOffsets:[[115095,25210]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("汕尾",{type:"FeatureCollection",features:[{type:"Feature",id:"441502",properties:{name:"城区",cp:[115.365058,22.779207],childNum:13},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@A@@@BB@@@@@A@@@@@@"],["@@@@@@B@@B@@@A@@@@@@@@@@A@@@@@@@"],["@@@@@B@@@@@@@@@@@BB@@@@A@@@@@@@@@@B@@@@A@@@@A@AA@@@B@@@@@@"],["@@@@@BB@@@B@@@@A@@@@@@@@@AA@A@@B@@@@"],["@@B@@@B@@A@@@@@@A@A@@@@B@@"],["@@@@BA@@@@@@A@@@@A@@A@@@A@@@@@@B@@@@BBB@"],["@@A@@@@@@B@@B@@@@@@@@@B@@@@@@A@@@@@@@A@@@@@@AA@@@@A@@@@B@@B@@B@@"],["@@@@@B@@B@D@B@@@@A@@B@@@@AAAA@A@A@A@AB@@@B@@B@@@"],["@@@@@BBBB@B@@@B@F@@AB@@AA@@AC@A@@@A@A@A@@@A@@B"],["@@@@@B@@@@BB@@@@B@@@BAB@@A@A@@@@@A@@@@@@A@@@@@@@A@@@A@@@A@@@A@@AA@ABA@@@@@BB@@@@B@@@B@@B@@@@B@"],["@@@@@@@BB@B@@BB@BB@B@@B@@@B@@A@@@@AE@@@C@AA@@@@AA@@@AB@@@@AB@@@@AB@@@@@B"],["@@@@@BBBBDDBB@B@D@BAB@@ABI@AA@@@@@CB@@@@EA@@@@AB@@@@C@ABA@@@@B"],["@@@@E@A@A@ABABAHAD@BA@CBC@C@CACAICA@E@EDGFABEBGDCBEBADCFCDABCBC@E@GAC@C@CBCBCBMLCBGDCBEDCDC@A@QCQAG@E@C@AAEAEEEGCAAAA@K@WBM@K@C@G@MCE@A@ABCTABC@CBCBE@EBA@ABADADADABEBGDEDCD@BAFAD@BEFCFAF@F@DBD@DBDDDBDBFAL@HCRAHAD@FCDCDIDMFODQ@I@G@aAYCKAOA_@EACACACACBCBAD@F@LBF@DDJBF@BDFDDHBNHJDHDDBBDFDFFB@BDVPBB@@BBHJJZJJNHB@PDJFDHDBDD@FBH@J@F@JAD@BAF@@@BBBFBHDBBB@BF@BBDBBB@@@FEDC@@@@@@@@@@BA@ADABA@@DAB@@@@@BC@C@CBC@A@C@A@A@C@A@C@@@ABC@ABA@ABA@ABABADCD@BADAD@B@@@BABC@ABABABC@ABCBABABAB@DAD@F@@@B@D@D@DABABADADADAF@D@D@D@B@DBBD@@BBBDBB@DBBBB@@DBD@D@B@@CAC@C@A@A@A@CBABCBADABABAB@@@@AD@B@BAB@B@@@@AB@@A@ABABADE@ABC@CBC@@@@BABA@@B@@@BB@@B@@ADAB@@ABA@@@A@@B@B@@@BA@AB@@@@ABA@@@@@ADADB@@@@BABA@AB@D@DCBA@@@E@A@A@@@AFAB@@@@CB@@@B@@AD@B@BBD@B@BDDBJFB@B@D@DBBAB@@@B@DBFBBAFA@@BBD@@A@@@@ACA@@A@@B@DC@ABC@A@@B@DGBABAB@@@B@BB@BBBFBB@B@B@@BB@DDDDBDBBB@B@B@FBLDL@HBPAJ@jCEOEIGKIGKKGEMGEEGKAE@EBCBCFCF@J@HBDBDBDBB@BABAD@BBB@BBD@BADCFEJEB@F@DDFDFDNHD@B@B@BABABAAC@GACBC@KAC@CACC@ACE@C@GAE@AACCGMCCGKMMIMEI@C@@@GBA@@BA@@B@BABCBABABAB@B@BALEDA@A@A@AAAAC@AA@CAC@@AAA@A@ABABA@ABA@A@AA@CGC@A@C@AB@@A@CJA@"]],encodeOffsets:[[[118318,23198]],[[118199,23210]],[[118201,23206]],[[118350,23224]],[[118399,23252]],[[118261,23179]],[[118258,23180]],[[118346,23193]],[[118250,23170]],[[118203,23201]],[[118392,23248]],[[117951,23284]],[[118340,23201]]]}},{type:"Feature",id:"441523",properties:{name:"陆河县",cp:[115.660143,23.301616],childNum:1},geometry:{type:"Polygon",coordinates:["@@EBEBI@G@E@C@CDGHABE@CBEDEBODCBAB@@EFCFA@C@ABCASMIEAB@F@FBF@HC@A@C@E@C@A@@CCEEEACCAAC@ABC@E@C@@CAAA@C@C@AAAA@CCA@ABA@A@C@E@ABCBEDA@C@A@ABCBEAA@@A@@A@IHKHEBCACAGKAAIGI@EFKBE@C@GAA@@@@DABADAB@D@B@BDBDDBBBDADAFAJAHAD@BBDBBBBDBBBDD@B@D@DAF@B@D@DBFAB@@ADAB@B@B@BBBBB@DDF@BFDFF@F@DCDCFCBCBE@A@ABAB@BAB@DCDCBCBC@CBCBA@A@C@C@C@ABC@ABEAA@I@@@EAC@A@CAAACAEAAAA@A@ABADA@@BAFABADCBE@ADA@CD@@A@ADA@A@CB@FA@A@@@@BB@@BAB@D@@@BABC@A@A@@AA@ADAF@FBBB@@B@DBBDBBBBB@BA@@BDDBBDB@DBBB@BBBB@BCF@BA@AAC@AB@B@BBBFBBB@@F@HDD@BAB@H@BAB@B@BBB@DBDBDB@@DD@@BBBBBBABC@A@CD@@AB@B@@BBDFB@DDDB@FBDAFCBADBBF@BBDCD@D@LDF@PDB@JHB@D@DBDBB@ADC@CBCBE@EBC@@AAAC@E@ABAD@@ABC@CA@BDDDFBBABCB@B@BC@BDBBBB@BAD@D@@AD@FAF@D@FBBBFABAD@BABBB@BBDBBDBFDD@D@D@FBBAB@D@BAD@DBB@B@@@B@DBBBD@DB@B@FFDDD@BBBAF@FA@@D@B@@@FBDDBBB@D@BABA@ABABADABBD@@FBD@BBDBDH@BD@D@D@B@D@@B@D@D@DJF@@@@DDDBPDFAB@DAJAD@F@D@DBDBDBBBBBBHDDHDB@B@FBBBDBB@@BFFDHDHDBF@BB@DCB@D@BBDDB@@B@B@B@B@B@H@BC@ABABBBBBBBAD@BADEFEFG@GDGDCDAJCFAHCFCDCBIBIHILI\\MXKBA@AACAACEEAAACA@@AA@A@CBC@A@AAACC@A@CHCDCAACA@C@A@EDC@G@G@GBEDA@@HAJ@DBHBFDFD@LFHJBFB@@@@F@D@J@LAH@HFHJDDDAFAD@DBHBJFH@J@DBFBF@HADABCFEBCBC@CDEDADCDABEDCD@FIJIJEJKHKC@AAACAAAEACEAEAE@C@AC@C@CBC@CACCCCC@E@C@ABAAEAABEDE@CAC@AAABEFAF@HBDAFCB@HEFEHE@A@AAA@ABCDCDCDABC@A@@EAEAACAAECAAEAE@EAC@C@GBE@C@A@ECGAK@KBEACAEAE@EBCBA@CAA@AACCCCECCCAG@EDGFIDCDEBCACACCC@E@E@E@C@CACA@@@CDCFEJCHEFCBCAAACCAECE@EBCDA@C@AECCCCCCC@AB@@ADABAB@BCDAHCFCLEJEB@DABEFCFCFAJ@HBF@D@DA@@CGEGEI@IFEFEHIBIDEAG@CCECBABEBGJIJMHABMCIEKCE@CBA@C@MBC@A@AAE@CCICA@E@I@C@E@AAMCIDE@MBEB"],encodeOffsets:[[118450,23679]]}},{type:"Feature",id:"441581",properties:{name:"陆丰市",cp:[115.652151,22.919228],childNum:8},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@B@@@@@@@@@@@@@@@A@@@"],["@@@@@@BB@@@A@@@@@@@@@@@@@@A@@@@@"],["@@BB@@@@@@@A@@@@@@@@@@@A@@@@@@@@A@@B@@@@@@"],["@@@B@@B@@@@@@@@A@@@@@@A@@@@@@@"],["@@@@@@B@@@@@B@@@A@@A@@@@A@@B@@@@"],["@@@@BB@@@@B@@@@A@@@@@@AA@@@@@@AB@@A@@@@@@@B@@@@@"],["@@@B@@@@@@BA@@@A@@@@A@@@@B"],["@@IGKEIAMBMDIFGFCJCRBRBND^@PCPELEJKJSRIHONGFaHYBs@KBCBADCHANCHCDGBKBWBU@SCWGGTEDIB[@C@E@C@AACACAAA@@AAAACEA@@CEIEMACAACCECEAE@C@CAG@I@A@A@A@EDCBA@CBEFEL@@GTCB@BEDGFEHAFCHEHGHKHGDEDCFAB@@@B@B@B@BBDBD@B@BABADABED@@ABA@AACAAAA@C@C@C@C@ABADABAB@BAH@D@FBFBDDHFJ@BBDFFDFFFDBFDDBBFDFBFBPBJ@FAFBBFHDDFDDBFDBHADCFAJBFBJ@JAFCFBFBFBHBBBBB@BF@BBDBD@FBHFBH@DDBHAHAJA@CDGJGHCLCDAD@BAD@JBDHF@FADC@E@G@E@A@CAA@ABAB@B@BAB@BABABC@@B@D@DBB@BB@HBD@F@LAFEJ@JHBBHLDBDBFALGJGB@@@@BB@FBDABAB@D@B@FCDABAF@D@B@B@BAB@DDB@BB@B@D@DBBDB@@@D@FAD@BBDDBBDFFDF@DB@D@F@D@B@D@@GAE@E@EBAJFTNDBBAD@B@DEFE@@BADAPCFAFCDAF@BAHGDCD@F@H@J@FAFAFANAF@JCNDBBF@D@J@F@B@JDDDF@BBB@D@NAD@B@DAF@LDJFNDBANGJIHIFABADADF@DBHCFAJGJEFEF@JFJFHDHDABEBEFEFGBCBCBEDCB@B@DEDAHAJAHCJCD@DBFAFAFABAFC@E@GAC@CHIHGLEJADBBFBDDFHJBFFDHDLBHAJ@JBJFFFFFB@BADAFAFAFEFG@EBEBAD@D@DAB@CG@GAEBCFEHEFGFEF@B@B@BA@CCCCGAEBI@ADAFADAD@D@DBB@B@@C@EB@BADCD@BA@BB@FCFE@@BAAAAA@@BABAB@BA@@CCACEC@@A@@@CA@AA@A@@@AA@@@CAA@ABA@@@CAEAACC@A@@AACBCD@@A@C@ABABABC@ABA@@ACC@@E@A@@AAA@@ACBAACA@AA@DABAD@BCAAA@CBE@AJGFAFEFGDADBB@FLBFFGDH@DFBB@@B@FB@FAB@BA@EEKACGCCECGEG@A@@B@@AB@@CB@B@@A@A@AA@A@C@AA@@@AA@C@A@@ABA@A@@@AAA@@@A@AB@@@B@@@@ABAB@@AB@@@@ABA@A@ABAB@@C@C@AA@AC@A@AA@@ABABAB@A@E@C@A@@A@AA@BAB@BA@A@@@@@@BAD@B@D@B@DBD@B@B@B@DB@AB@@A@CBCB@BCFABB@JBBDB@BCDD@BBBF@B@B@@BBBB@@CBBB@BD@D@B@B@@AAA@A@@@GGB@@@CBCB@@@@A@@AA@A@@BABA@@AADEAAA@CCCACBA@A@@@@CAA@B@BA@@CBCBCBADAB@B@B@BBBDB@BABA@C@@@CCC@EDCFGBA@BHDFFDBBBB@B@B@FBFDBBBDBD@FBB@BD@D@DA@@DC@@@@@A@@AA@CBABCBABAB@B@DBDDDDF@DAB@DC@@D@B@B@BABC@A@ABADC@@B@BAB@FEFADCD@DAB@J@B@DAFA@@DAJ@D@FADA@@@@HGBGBE@EBAHAJA@@DAFAFGFCF@LBL@F@BBDDJHBB@BD@DCHADCBCAC@A@@BAD@BAB@DAD@BAB@DAB@D@B@DBFAH@B@@D@@@B@BBB@@@BB@@BBB@@BB@@BB@B@@@@@B@BAB@@AB@B@@AB@B@BB@AFCDAB@B@DBDHDHHHJJDHBHDJBHAF@DCBABADAFAB@B@FBF@BBB@BA@@BABUUWCGCAECCACACAKQEKCCCCAAC@CAAACCEGGIACCAEAECCACACCEGCAACCAI@A@CCAAACAACCECMCQCKGMKECCACAE@EBEBEBEFGLENEFCFEDEBMBO@K@ICMCaOQGEAG@K@EAEAIC[UUYECECEAC@E@A@ABCBCFCBCDA@C@I@EACAUMOKAAAAC@UBKAMAUKSQSWOSOMEG"]],encodeOffsets:[[[118615,23269]],[[118615,23268]],[[118614,23269]],[[118896,23362]],[[118691,23333]],[[118891,23360]],[[118753,23339]],[[118615,23285]]]}},{type:"Feature",id:"441521",properties:{name:"海丰县",cp:[115.323436,22.966585],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@DBJ@B@@AB@B@B@BBBB@@BD@B@B@B@DABA@A@A@@@AA@@@CAA@@AA@@ACK@AAA@@A@AB@@@B"],["@@@C@A@AB@BABAREB@@AB@@A@C@A@A@@BADABA@@@A@A@@@AC@CA@AA@@A@AA@@AACAAC@A@A@CAAD@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@B@@@@@@@@B@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@B@@BD@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@B@B@@@@@@@@@@AB@@@@@@@@@@@@@@@@A@@@@@@@@@@BAB@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@ADEF@@@@@B@@@@@@@@@@@@@@@B@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@BH@@@@@@B@@@@@@@@@@@@@@@@B@@@B@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@AB@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@B@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@BA@@B@@AB@@@BCFAB@@BHBBBB@B@@AB@@@@@D@@@BBBDB@B@@@B@@BB@@@B@B@B@@@@@BA@MC@ACBC@@BAB@@CBA@AA@@A@CBABA@ABABCFCB@B@B@@@BBD@@@B@@ABA@@@AA@@A@AB@BCB@@ABCAA@@@A@A@C@ABA@AB@D@@ABAAA@A@AB@B@D@B@@@BC@A@@DBB@B@@@BBB@@C@@BA@ABA@AB@@@@@D@B@BBB@@@@BAB@@@BBBBBB@@@B@B@@CD@B@B@@@BBBAB@BA@@@@BB@DF@@@@@@@@@@AB@@@@@@@@@@@@@@@D@B@@@B@@@BA@@BA@@@CDA@AB@BA@@@A@AB@B@@CC@@@@@@EA@A@@EB@@AB@D@B@@A@@BCB@@AD@B@B@B@@BD@@@B@@BB@BDD@DADAB@BC@A@@@A@@DAB@B@B@B@@DDBBD@@@FCB@@@BBB@BBDC@@BB@BBB@BA@@B@BBB@B@@ABA@C@A@@BCD@DA@@B@B@@@BABABA@AB@@A@CB@@@B@@@BAB@@@F@B@@BF@B@@A@@@ABAB@@AB@B@@ABA@A@A@AB@BB@BBBDDBBBBBB@BBBB@B@BD@BF@@@@D@DAH@D@D@F@D@@BBB@B@@@B@@DBB@@BDBBD@@D@B@BAB@D@B@DAD@@@B@BB@B@B@@HFDBBBBB@D@@BB@B@@B@@@B@D@D@BADABA@AB@@@DBD@D@@@@BBBB@@@D@BB@@DF@B@B@@@@BF@B@@AB@BB@@BBBDB@@BBBBB@@ABA@@B@BB@B@@@BB@@@HBFAF@@@BBBBBBBBBB@@DB@@B@B@BBBBFB@@BADBB@BD@@AB@@DBB@BBB@BB@@@@BADABAB@@@B@BB@B@@@B@BBB@BBBBD@@@BBBBBB@@B@BBB@DB@@B@@B@BB@@BB@@@B@BABBHB@B@@@BA@@@AB@FDBBBBBAB@B@D@BB@@B@AB@B@@A@@B@B@@DB@BB@BB@@@B@BBBDBB@@@B@@@BBBBBAB@@AB@BCDAB@@AB@BAB@BA@@@ABABA@@BABABB@B@@@@@B@B@@BB@BDBB@DBBB@B@B@BBB@@BBB@@D@BAB@@@BB@BAD@DA@@@A@CDADCFAB@DABAB@D@BAB@@ABA@ADA@@B@B@B@DA@AJEDA@ABAD@BD@B@@@FBBCB@DDFHFBDFDHDB@@D@DDB@DAB@B@FBFHBBFBBHFDDBBB@@@BFHBHBF@BDB@@BB@@@J@HCB@DB@DHDB@DDD@@AB@BBB@DB@B@BDDDAH@FBF@FBBDBBH@FDBB@DDDBB@DFJDBDDDBDFHDDBB@@BAB@DCBAFDBF@HBDAH@BB@AD@DBFBDBH@@DADDDBDABCDAF@@DBAD@BEFBDADABABAFC@AFBB@D@DDBDD@@AB@DCD@JA@BDDH@HAFB@D@DB@@B@D@BB@@DBHBDADCDAF@D@F@DDFHFADADADABBAHDBBBBFDBB@H@DBABAD@BDDBBAB@D@DB@B@FFAH@B@FDBD@DB@@B@DDB@DA@B@BF@DBF@DHDBB@D@H@HB@@@DFDDADDB@@BBD@FBDDDD@BAD@DABAB@D@BBDAB@DFADBBAFAJB@DBB@FF@D@BD@DAD@B@DABBB@B@DAB@DADAB@B@BCB@@@DCB@BCF@DABCBABE@AB@BCBAB@B@BBFBDBBBDBB@D@FB@@J@B@FBBAD@BAD@D@D@B@B@DADAD@DADADC@CBA@ABABAB@F@DADADEDC@C@EEEEC@ACE@CAAAA@A@A@ABABC@@BAAE@C@C@ABE@C@C@ACCAACAAAAAAC@ABCBGBIBEBCACAACCCA@A@A@CBABCBA@C@@@AAA@C@C@AD@BABA@ABA@A@ABABAB@DBB@F@H@F@D@BC@EGEAC@IBC@ABCDCDKHGHIDCB@BIBGAGCCG@EAAG@EACAC@AAEA@AAAAAGAEAEDEBE@IAIAEBIDEBCAGECCAECCCEGAABE@EAIAOAECEAECAECCAEECEEEAC@AEICGACAE@E@CBG@ABABABCBAD@D@D@D@B@BBDBBBB@BA@@FCBABCBA@A@AACAC@A@A@A@A@@BADEFCHCLGHGFGDGBEFGHEFC@ADAHS@@FKFEDAB@DAFCB@B@B@J@H@DBD@F@FBFDDDBBBDFNFJ@DB@DFBBBB@@BBDBDBBBD@F@D@\\@JAFCHS]OeSIGOMEEAEAQAWiDI@OBGAK@KCEAA@A@A@AAACCCCCA@@AA@A@A@EAAA@AAAA@@@A@ABABCHA@@@@BAD@BCDA@@@@BB@BD@@@@@BC@AA@@EBABEACAA@@@A@ABCAC@A@A@IECAACA@C@AAA@C@@BA@@@A@@D@@A@EB@B@@@B@B@F@@ABCDC@A@@BABAB@@@@CACB@B@@@@AB@B@@A@@BAB@@A@A@@@@B@@AB@BA@CB@BA@@@AA@@A@@@ABAB@@@@AD@DAD@BCFABAB@B@BA@@B@@A@A@ABA@C@@B@@A@ABABCBABADAB@D@B@B@B@DBD@DA@C@C@CA@@AAAA@CAAACAA@@ACCAA@C@C@C@E@CBCBCBABABCBC@C@A@@@E@C@CBA@ABABABAD@BADABAB@BADAB@@A@C@CBABC@CDABAB@BAB@BAB@BAD@B@@@D@B@D@B@B@D@BAD@D@DAD@@@@A@CB@@ABCB@BAB@@@@@@@@@@CDEF@@A@AAAC@AAEA@AAGCEAAA@A@@BE@ABC@I@E@IAG@ECCCACGIEOCA@MGIIIYGIAA@@AAUOACA@EEECCBIBOHOBC@MB_A[EKCEACGCK@ECK@CBCDADCHCDEBG@E@EACAAAACCE@E@I@E@CBABADAD@BCBIFEDABABCHCJAFADEBI@IAMAC@ME[I@@MCSA]AI@SEKCECACCA@AAABI@E@AAAEC@@@A@@@A@@@CAC@@BC@C@@@A@@AA@A@@@A@E@A@AA@@AA@@@A@A@AB@@AA@@A@A@A@A@A@@A@@ACA@@@A@A@@@ABAB@@@@A@AAAAAAACA@ECAAACAE@@@A"]],encodeOffsets:[[[117871,23318]],[[117783,23277]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
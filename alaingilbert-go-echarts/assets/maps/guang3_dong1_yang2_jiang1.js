@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("阳江",{type:"FeatureCollection",features:[{type:"Feature",id:"441702",properties:{name:"江城区",cp:[111.955058,21.861786],childNum:11},geometry:{type:"MultiPolygon",coordinates:[["@@@B@@@@@@B@@@@@@@@A@@A@@@@@@@"],["@@AB@@A@@@@B@@B@@@B@@@@@@@@AB@B@@@B@@A@@@A@@A@@@A@AB"],["@@A@@@@@@B@@B@A@BB@B@@@@B@@AB@@B@@B@@@@AB@@A@@A@@@@@A@AAA@@@@@"],["@@@BB@BBB@B@@@B@@@AC@@C@AA@@@@A@@@ABB@"],["@@@B@@@@BB@@@B@@B@@@@@@B@@@@B@@@BA@@@AAA@@@A@A@@@@A@@B@@@@A@@A@@@BA@"],["@@@BBBBB@A@@DA@C@@AAA@A@@BAB@@"],["@@BB@@@BB@DAB@B@@@B@@A@@@AA@EAA@CB@B"],["@@@B@@@BBBD@@B@B@BA@@B@@@B@DBB@BB@@@B@BABC@A@A@@@AB@@A@A@@@AB@B@B@@A@AAAAAA@A@@BA@A@@@AAA@A@A@AB"],["@@@@@B@BBB@B@@AB@@A@@B@D@BB@@BB@DAB@@@BB@@@DB@BBD@BAB@BC@A@A@AA@AAA@@CA@AA@@AA@AA@A@A@A@AAA@"],["@@ABBD@BB@AB@B@D@BBBBBB@B@@@@A@@@A@@BBBBBBB@B@@@BA@@B@@@@A@@@A@AAA@C@A@@D@@@@A@@BABBBBBBBBB@BA@AB@@BB@DBB@BA@CAACCC@CB@@A@@A@@CAA@A@@AA@G@A@AAA@A@ABA@ABAB"],["@@DBDDBBHBDBBDBCD@BBBFDABC@ABABC@ALFHDB@FDBDC@HDFCBBBDBBHJB@HBFAFDHF@@@@LFDD@@FDADBJFDBDFADBBDBF@BBD@B@BBBBBB@@BBDB@@B@BD@DADABADBF@F@DABAB@@CCC@A@ECC@EAA@C@AAA@ABCA@ECBEAE@C@AACD@BA@A@@@E@CA@ABEBAC@@@C@AACC@C@@AACFA@AAA@A@CC@ICCACCAC@ACA@AF@DBBCFGAC@A@C@AAA@@GE@AA@@@@@@@@@@@CCAAA@@@AA@@@@@@@AA@@@@ACA@@@A@@A@BABA@@@AB@@@DABA@@BA@@@A@@@@@A@@@A@@@@@A@@AA@@@AA@AAA@@A@C@@@A@@A@@@@A@@@@@@@A@@AAB@@AA@@A@@@ABB@@BAA@@AB@@@@ABAB@BC@KBABBBAAC@AA@@A@CB@DABAB@DA@@DC@@DCLIFGBC@@BE@ABECMAI@A@CB@BCDADAFEHIBC@EAEAEAA@ADGSIs[_SGEugCGCICUB_@EHIPKLIHEDAF@DBHDLBL@F@HAJEHEDAF@D@FADCBCBC@C@A@CBABCBAACACMMEGIECAC@@@EDABA@C@QGeUCAE@GAABA@CBA@A@GCE@AAA@A@A@@B@B@DBFA@ABGAOEKCICCAOEQKEC]SCCSQMQCAAAA@C@CBCDEB@BAB@BBDAB@@ABE@C@CACAIEMEECA@C@ABA@C@GCCAC@EDABABAD@D@@@DBDBBBDBBB@B@B@B@@@@@BB@D@B@BBBD@DBB@D@DAB@B@B@BB@B@DCBCDA@ABC@A@@BA@@B@B@BAFCDILIJFlDl@JBH@DBJBfBNCHAHIL@BEHCHGHAF@BCJ@BIXEN@HAF@N@@DHBHHLDHFL@BDBBD@DDF@@AB@B@BB@BBBBBBBB@F@BD@HLBF@BADG@@@ABC@C@CHBFBDBBBBBF@@A@@@EDCBAFABAD@FC@C@CAC@ABAH@DEDAFED@@ABE@EAGACBABCDGDCDEA@FCD@DAAECC@AF@F@BBBBBB@@DEBAA@@@@@@A@@B@BADADCBGLEJAJABCBA@@D@FA@@@@@A@CAG@ABGE@BCBED@D@DBBB@BB@BABDF@F@DDDBBBAHC@FCBABBBDH@BJEBEB@B@BB@BAAABCF@@CBA@AB@B@D@BABDDHAHDBB@DDD@@F@L@HADDDDD@@@FBBBHBD@H@BCF@LA@B@@DBD@DBFBFDDBDBEDAB@@FDBBDB@ABABABADAHCBAB@FAPAJAD@HA@@JAJADADAHCFCF@J@BAJ@FAD@BADC@ABA@@DCDCLKDAFAF@HAFC@@FAF@LCHAFADABABEBEBEBC"]],encodeOffsets:[[[114802,22046]],[[114700,22170]],[[114665,22174]],[[114625,22113]],[[114706,22135]],[[114855,22121]],[[114806,22071]],[[114832,22121]],[[114809,22153]],[[114874,22064]],[[114613,22427]]]}},{type:"Feature",id:"441704",properties:{name:"阳东区",cp:[112.006363,21.868337],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@ABAB@@@B@@AB@B@BB@@@@@B@B@@@B@B@@@@@BA@AAA@@@A@@@@@A@@@AA@A@"],["@@D@B@@B@B@D@BBBB@D@@@FDB@BB@AD@DAB@@DADB@DBDBB@B@BAB@F@F@D@D@BABBBA@AACBCAA@CBC@@BADCB@BADABCB@BADCBADCB@D@BC@ABC@C@CBAAC@AAA@CBAB@F@D@D@B@BAAABAB@B@BBB@DBB@B@BA@A@@BC@@D@D@@@DBBBDDDBBABADEDEDAB@BABAF@D@B@BA@CDA@CBAB@BCB@@C@AB@BC@ABCDA@AB@B@D@FAH@BAB@B@@@DBBBB@BAB@BBDBDBD@B@D@BABAD@BABAB@@B@BB@@@@@@@F@B@@ABA@AAAA@AAA@AA@CBABAB@B@B@B@BBB@DA@C@AAAAA@AAAAAAAAA@AAA@AAAAAA@AB@BA@A@ABA@@@@@@@@A@CA@@ABAAAB@BABAA@@A@A@CBCBA@A@A@C@AAABCBE@A@AB@B@BBBB@B@BDA@@D@B@BAB@@A@AAAABA@CAA@AA@ADA@AA@@ABA@@DAB@FBB@B@B@@AB@@@B@@ABCBAB@B@B@B@B@B@BAD@DBB@BABAB@BADABABAD@B@B@BB@@BA@ABA@@B@B@@A@A@AFC@@BBB@BAAA@CA@AEB@@C@AB@@@B@B@@@@ABA@AB@@BB@D@B@B@DAD@DBF@F@D@DBBBB@@BDABA@A@@B@@BB@FADCFADCDAB@DADC@AA@@CBCB@@A@ABC@@B@@CB@BAB@BADCD@@C@@BCB@@ABAB@@CBCDABA@ABE@A@AAA@@@A@A@A@A@ABA@@BAB@@AB@@@@AB@AA@@A@AAAE@A@@BE@C@@@CB@BC@@B@BBABB@@@@B@@B@@@B@BC@A@AB@@ABAACACAACGBAF@DECAA@@CDEECBCBEAGACC@AGAEFCDAFAFAF@DELEFCDA@AKEGEDABAAAAAICGACCBGFEHAB@AACCFCCA@AUOACCEAAFKBAEAACIIAAA@ABGAEGBIBC@ACCBCDG@GACAAFEDEACG@CC@A@CA@CAACDEK@AACA@A@CCCE@ECACDCBA@AE@EB@ABCBEDGDCACAA@EBECE@CDE@CGBCABCDEBCBC@ACACAECIAG@S@C@CBIAMKG@CDADGB[FE@ABCH@D@D@BBBFF@@@B@DIRADC@ABICMAE@ABADEJCDC@C@IAE@CBADEJAR@JBFFHBH@B@D@HAJAHELEFIHIDO@aAeGUkMGAA@YGuSCH@BBBBFBF@FADGJEFCBCBADA@@D@BBJDNAF@BAF@@ADEHKJCD@@CD@@CBA@ABCBA@@D@BB@@BBDABAAAB@LADA@AB@B@@A@@BB@AB@@AA@B@@@BB@@BA@BB@@@B@@@@@@@B@@B@@@@B@@@D@BB@BBB@@B@@BB@@@B@@@@@B@@@B@@@@@B@@AB@@ABCB@@A@@B@@ABABB@@@@B@@DB@B@@B@@B@@@@@@BB@@B@BBDD@@@@@@@@@@B@@BHF@@BB@B@D@BBDEHADCAE@@BDB@BBDDDDBJDD@@D@BBB@BEBBD@BD@D@BD@B@D@@BDFABAB@@D@F@@@BABC@BD@B@DBFAFFDB@AD@BBB@B@DBB@FDD@F@BDD@DA@ABCBE@E@CAABCBCBC@@A@AA@AC@AA@AAAA@A@AAC@AAEACCAEBACECAIBCEC@@CCKE@@@@GEECEBGAA@GIAAACAAEDGCD@ACECA@GCKE@BADAB@BADCBAEAAC@ADACCAGAAACCCAADAFAFAFABCBEBGBKDE@EB@@EDGBE@EBCBKLCDCD@@AB@BCDABC@EBI@ABI@E@EDGDCBCBIBIB@@GBC@IBOBEBA@ABGDCBABABAB@BDAEHAFCL@F@F@DAFEDKBEBABCDCFCFCDCDCBCDC@@BB@@B@B@@BB@B@@@BABBB@@B@@@BBBB@@B@@A@A@A@AB@B@@AB@D@@@BA@@AAB@@AA@AA@@B@D@B@BB@BB@B@B@B@@AB@@BADAB@DAB@BBBBDDB@B@@BB@BB@B@@@@@B@BBBAD@B@B@BB@BA@ABA@@BBBBBD@B@B@D@@BABCDAB@@ABCA@@@@@@@A@@AB@@AA@AA@A@A@ABAB@@A@AB@BA@A@@B@@BB@BBBBBBB@BA@@BDB@BB@@BBD@@@@B@B@BB@AD@B@DCDA@A@@@C@A@CBAA@AA@AA@BCBABAB@BA@AB@BAB@@@FDB@B@BB@BBBBD@@BBB@@@BB@@B@B@@@D@@BBBBBDBBBF@@@B@@BBB@@BBB@BBB@BB@@BB@@BBBBB@BDBB@@@@@@@B@D@DABDBB@BB@B@@DBB@BBB@B@DDD@DDBDBBBBBBBBBD@B@DBBDB@BBDBB@BBBDBDDDDBBBBB@@BDD@BBD@BABADBB@B@BAD@@@@@B@@BB@B@BBB@@@BCB@@AB@BBB@B@BA@A@C@@D@DBDBBD@D@B@B@BB@BB@BBBBBBBB@@BBDB@BDBDB@BDBD@BB@BBDBBBBBDDBBB@BBBDFDBBBBBBB@B@B@B@@DADABB@B@@BBBBB@@D@DBBBBBBD@@@BDBB@BDAB@BAB@BAB@@AD@@@B@B@@@BBB@BBBB@BBBB@@B@B@B@BB@AB@@@@BBAD@BB@DBD@@B@BAD@@BD@B@B@B@D@B@B@DA@ABAB@DAFABABC@@B@B@BBBBBBBADBD@DBBBDBDABAD@BAD@DBBBDABADAD@FADBB@BBB@@@B@B@BAD@BCD@@BBBBBB@B@BDB@BBF@@@BBABA@@BA@ABA@ABA@@B@BABAD@B@DAB@D@D@B@@@DAD@DBDDFBBBD@D@B@B@DAFADADADCHED@BBBABABBD@B@@@BCBAFAD@D@B@BABA@AAABA@ABCBAD@BBB@D@DAB@DAFCDC@A@C@AAAA@AE@C@ABC@A@ADADABAD@B@BABABAD@@ABA@A@@@C@CBG@GAAAACCEACACCAA@C@ABA@A@CAACAAAAC@@BE@CAA@@BC@@DC@ABADADABC@C@CBADABCBA@CBA@AAAAA@CAA@AAAA@AAAA@A@ACACAAAAAAAC@@@C@C@AAAAAAC@@ABABABAB@BABCBABA@AB@@AAA@A@AB@D@DBB@BBD@FBDBDBFBD@HBD@BBD@DBD@BBBB"]],encodeOffsets:[[[114922,22241]],[[114760,22656]]]}},{type:"Feature",id:"441721",properties:{name:"阳西县",cp:[111.61766,21.752771],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@@@@BB@@@B@@@@BB@B@B@B@@A@@@ACA@@A@A@@@A@A@@@@B"],["@@B@@@B@@@D@@BB@B@@A@@@A@@A@@AA@@@AA@@A@@@A@@B@B@@A@@@@B@@"],["@@@@@B@@@@BBBBABB@@B@@B@@@@@B@BAB@@A@@@AA@@A@@@@AA@@@@@BA@@A@@@AA@@@@@A@AB"],["@@DBB@@@DAB@@C@A@@BA@AAAA@@@A@CDA@@@@@AAA@ABAB@BBBB@@@BB@@"],["@@GFGBHLBDDD@FALAFG\\KdAFCF@B@@AB@@A@A@E@GGEAE@@@A@@BBB@B@DB@BB@B@B@B@@A@@@C@A@@ABAACA@CCA@C@CDAFBD@D@BD@@BB@@F@@@B@DBB@@@@@BCBABCBABAB@B@B@B@BBD@BDBBB@@BBDBBBB@BBBB@@@B@D@@@B@BAB@BA@@@A@@@C@A@A@@@@@CD@BAB@B@B@@@B@BBB@@BB@B@@AB@BABA@AB@@@@@B@B@B@B@@@@@B@B@B@B@@@BB@@B@B@@@B@@@B@@@B@@@B@@@BB@B@B@B@@@BBB@@B@B@B@B@D@B@B@B@B@@@B@BBB@BBBB@@@@B@@@B@@D@@BBB@@@@BBB@BB@BB@@B@@@BA@@@@BA@@@AB@B@B@@@B@@B@@B@BB@@@BBB@@BB@@B@BBD@D@@@BBBBBB@DB@BBB@@@BAB@BA@@B@B@@@B@BA@@@AB@@@B@B@@@B@B@@@BB@@BA@AH@@@BA@ABAD@@@BA@B@@B@@AB@@@B@@A@@@@@A@@@A@@@A@@@A@A@@@AB@@ABABA@ABA@@AA@A@@@A@@BA@ABAB@@A@@@@@A@@A@@@A@@@A@@A@@@ABA@@@@@A@@@@A@@A@@@@BA@@B@@@BAB@@@BAB@@ABA@@@A@A@AA@@AAC@@@A@A@ABA@@BA@@@A@AA@@A@@@@@AB@@@B@@@@@B@@@B@@@@@B@@AB@@@@AB@@@@@@A@@@A@@@A@A@A@@@@B@@@@@B@@BBBD@@@@@@@BA@@B@@@@@B@@@BB@@B@@B@@@@B@@@@@@@B@@@B@@@@@BA@@@@B@@@DB@@@DBBB@@BD@BBB@@ABAB@@@@@BAD@@@@AD@@@@ADCB@BABA@A@ADA@AB@@@DA@AB@@@@BBBABDD@B@BB@@B@BB@B@@B@BBD@BD@BBBB@B@@B@BBBB@@DB@BB@@@@@B@BB@BD@DBBDBDB@B@D@B@DB@@@B@B@B@DA@@BBBB@AB@B@BBBBD@@BBDB@DBB@DDBD@@AFA@AB@@@HDDCDADBDBB@H@@AF@DABFDBDADDBBDFBFBD@@A@A@AJ@DALBD@BA@AB@@@@@BBBBB@B@DBBBD@H@@BDBBD@DDDD@@D@BBBD@BAFAFBHBBBHBF@BDDBB@B@@BD@B@B@B@DBB@B@@@@@BBDBD@DDFFDDD@JC@CDAF@HAHAB@DBD@DAB@DDHBD@DAF@D@BA@BBBBBD@@@BBJBB@F@FB@D@BBB@DA@AFABC@@B@FDADB@BB@DBB@DABD@B@@BBDABB@BDBDBBBADBBB@D@FDDBDAHBDBF@H@F@FA@AB@@AF@F@B@FC@@D@D@B@F@@@DC@@AA@AB@@@DAJCDCD@DCBADADADBB@BBHED@BAFBD@D@FA@DAH@B@BBBAF@BBD@DD@B@BCBCDCDADDBDFDDBDADAD@@@@E@AD@D@BDBADBF@@@BD@BJ@FCDA@AHFBAH@DBB@@@@@B@@E@CB@DABABIFIHKDABCBC@A@AB@@@@@@@BBFA@CA@AAAA@A@EBED@FDBB@CDC@EFBDCHCDCBADAHBFBF@BA@@FCBEFC@CBGBAD@DBD@D@@EBCBABEDAFC@@B@@@AEAAAAACAEDGD@D@BA@@H@BC@AAEGKC@@A@EAAAAAAAAA@@A@ABA@@CE@CACCA@AEKCGGKAGCG@@@MBE@GFMJW@ADI@ABEHGDGFG@AJKBGDGAMAeAI@CAG@ICkEkCBMFMDQDSBS@O@A@WAQEEAsUCAYMKKGEIQAECG@G@C@CBA@CB_DE@AEKAAE@@AA@A@AAA@CAA@E@A@CAGEEEAAAA@A@C@AA@@AC@C@A@CBAB@B@@BDBB@BCHCBABA@A@CAA@EBMDSFE@@@A@C@C@C@CAKEOEUIIECAKICCEAAA@@A@A@C@@@A@AACAA@AAC@A@CBC@ADCB@DAB@DBD@B@BBBAD@@CBA@E@K@GAC@CAKC]SECAAA@CAKAIAE@C@A@CBC@@BCDIFGFED"]],encodeOffsets:[[[114377,22061]],[[114372,22058]],[[114316,22024]],[[114143,21998]],[[114136,22028]]]}},{type:"Feature",id:"441781",properties:{name:"阳春市",cp:[111.791587,22.17041],childNum:1},geometry:{type:"Polygon",coordinates:["@@MAAAKDQ@KAIDC@@B@FAFCBAHAFQBIEM@CAIF@DEBONBB@FEFEHABCAKGGBBDADEDEBIBCDBB@BCBAACBCLBDBDABCB@JFJDFDF@BEDA@CRGDAH@H@LFB@DAHCB@DBFFB@DEJAH@D@D@B@B@BBDB@BDBD@BFBB@DBBBDBD@BBBB@BJF@@BDA@@B@D@B@B@BAD@BBDDB@@BD@@ABABA@@BABA@C@ABE@CBABAB@B@BA@ABABABA@BF@B@B@BCBABA@@B@B@@@BBB@B@B@@@@BBA@@BA@BBB@@BB@@@ABBBBBD@D@BBBABBBBBBBB@BB@@@@B@BA@@BBBA@AB@@@BBB@@@BB@DB@@@BBBB@@BABB@BB@@BD@B@DABA@@BB@B@@@B@B@B@B@DAD@B@B@@@BAB@BB@BABA@C@ABA@@B@BBDBFB@BB@B@BBB@B@@@@BBB@D@B@BB@BB@BBDB@BA@ABA@ABBB@B@D@@@B@BA@@BBBD@B@DBFBBBBBAB@B@@@BB@@@BABAB@@@@BDBBB@B@BBB@BA@@BB@AB@@@B@B@B@DABEB@@CD@@@BABCD@B@B@BBBB@@BAD@@DDBF@B@D@FAD@BAB@BC@A@AB@BAB@D@BAB@B@B@BBBDD@@B@B@B@@@@B@BD@B@DBB@@BBB@@B@B@B@DADBB@BBBBBB@B@BA@@BBDB@DDB@@D@@ABAB@@CBCBAB@D@BDBB@@B@BABAD@DA@AD@BA@@B@BBDBBBB@B@B@@B@DB@BBABBBBBD@@@DBD@@@BBBBB@B@DB@BB@@B@DDDDD@B@B@B@@@BBBB@BBA@@BB@B@@BBB@DB@AD@BA@@@AB@@@BBBB@BAB@@BB@@BBBABBBB@B@@@B@B@BB@HA@@HBFB@@BBBBDDBBB@@B@@@BB@@AB@B@BBBBB@B@@DDBD@@B@B@BBB@BBDBBB@DDB@B@B@DBB@D@B@BBBB@@@@B@HAHCB@BA@@B@B@DBBA@@B@@@B@@AB@DABBB@B@DB@BBD@@@B@B@BABAAA@@B@B@@B@BDAB@BB@@F@DBBBB@DAB@@@FBDDDB@B@@BB@AB@@A@A@@BAB@BA@@@AB@@A@@@A@CBA@AB@B@@FBB@B@B@BB@B@@DHBB@DBDBB@@@BBBDB@B@@@@@@@BABABABB@BBBBDBB@B@B@DAB@@B@BD@DBB@D@B@BCB@BABBD@B@DB@DBDBD@@FBFBDD@@DDD@BBHFBD@BBH@DBB@@@DBB@BDFB@D@@ABA@A@ABAD@BAD@DAB@BABD@BBBBBB@B@BBB@@@HFBB@@B@D@D@BAB@DABA@@BBDB@@DDF@B@BB@@@BDDB@@BBBB@D@B@BBB@BAB@BAD@B@DADAF@D@DADAD@BBB@B@DBBBBB@BB@BBAB@F@DDHDFBDB@B@@B@D@@B@B@D@B@B@BA@ADAB@@BBB@BBDBBDBBDDBFBDB@@B@BBAB@B@BBBBBDBBBBBDDDDBBBB@BBB@BBB@BBBB@B@@@BFB@BBBBB@BDBBB@BBABBBBBB@DBBDB@BB@@BD@D@@BBBBBBDBDB@B@B@BBBDBB@@@DFB@BB@@BB@D@BBB@BDDBF@F@BABAB@D@DABA@@D@BBD@@D@FBFAF@BBDBBBFD@DDD@DDBBDBBBDHDBB@DBDB@BAB@@B@D@BBB@BBF@@DFBDBBBBBBAB@D@BBB@FA@@D@DBD@D@DABABDBBBDBDBAD@DBDBBBDBB@BBB@FBBBBB@ADDFBBDBBD@BDBB@BBDB@@BB@@D@B@@BB@FBFDFB@@BAB@@@BBBB@@BBB@BB@DAB@BBB@BB@B@B@@BBBB@BBBBB@BBB@B@DBBBBBB@B@@@@DBFBFDHB@@@D@BB@BBB@B@BD@B@BB@B@BBB@BB@BBBBBBBBBBBD@BBBF@FBDBDBBD@@DD@D@BCBABAD@B@B@BC@ABAB@@@BBB@B@B@@@BA@BB@BBB@BBBB@@B@@A@@@@BBBBB@@B@B@BB@DB@DD@@BDBBB@@B@B@BA@A@@@@B@BBB@BB@BBAB@@ABBB@ABBBBBB@B@@DBB@B@BADABBB@BBBD@DBF@BBBD@D@DA@AAC@C@C@C@AB@B@DBBABA@CB@B@B@B@@A@AD@@C@ABA@AB@B@B@BAAAAEBABABADCDABBF@BBB@B@BAB@AABC@A@ABE@A@@@@AA@A@A@@BA@A@A@ABAAAAAAA@@@CA@@A@CBABA@@AAABC@AAA@A@E@C@@@C@@@AA@A@A@A@AA@A@AAB@B@@AA@AAA@@AB@B@@ABCBAB@@AD@BC@C@A@ABCAA@CAAAA@ACBA@ABABE@ABABAB@BA@C@CAAA@ABCAAA@C@A@C@AB@BCBC@@ACA@CAAC@EAAA@AAA@A@ABABAD@@AF@B@BAB@BBDBBA@C@A@ACABA@ABA@ADAD@D@B@@@@@ABBBD@D@@A@AAABCB@@AB@D@B@BBD@@BD@B@BADABC@ABAA@BC@@DA@@B@B@BADADAD@@BB@@BB@@BAF@DB@BABABCFAB@B@D@B@BBBBB@BC@ADCBADABBBBBABABABB@B@B@B@BBBB@@BAB@B@BBB@B@BB@B@BBBBDBBBBB@B@B@BBDBBFBB@B@BAB@B@BB@B@B@B@@DBB@BB@BABBBDBDBBBBBBB@BBB@D@B@BBBBBBB@DDBBBBB@B@@BBBBD@@@@@BBDBB@BBD@@@@BDBBDDDDDBB@AB@DCBA@ADABAD@@A@A@C@ABADABGBA@CD@B@BA@@@CBAB@@A@ABA@@@A@C@@B@BAB@BABA@A@ADABC@CA@BABABABBB@DAFADAD@BA@@@@AA@A@ABC@A@A@E@CAAAAAAAAAA@C@ACCECEACAAAA@CBA@A@A@C@@@@AAA@A@EAAAAAA@AAC@AAAAA@CBA@A@ABABAAC@ABCBABADABA@A@C@@BABA@AAA@A@AB@BAB@@AB@@A@@BC@A@ABCB@DABA@@AC@AAAACAA@ABABABCBABCB@D@B@@A@A@A@@@AB@@@B@@ABABA@@@@B@BABADAB@@D@BB@B@D@FA@@AC@A@C@AD@DABBB@@C@@AA@CAA@ABA@CAA@C@@B@B@B@B@D@B@DCFAF@B@BAB@DABAD@@ABA@AFCBABCBAB@B@B@B@@C@A@CB@DABABADA@@B@B@DBB@DDBADA@C@C@@@@B@BCB@BABABA@C@ABA@CD@BABB@@BA@@@A@ABC@@@A@A@A@ABCBCBCBAB@B@FCFAJAD@D@F@FAB@DAF@D@BA@ABABABBB@D@D@BABAFA@@JAFB@@BADABD@BBBBDBBBBDBBBB@@AB@@ABADA@CDADAF@B@BA@@@CDABA@AB@B@DABA@AAE@A@CBCAA@C@@@AD@B@BC@@AA@C@A@AC@C@CAAACAAAAA@C@A@A@AAC@AA@AAAAAAAAAACE@AA@BA@@BA@CFAB@BA@A@AAA@A@A@C@A@AA@ACAC@AEAG@EAC@A@@@@@@D@DBD@D@DADABCBCAA@@CAAAC@AAGAEC@AAABA@BD@B@DAD@D@D@BBD@D@FA@EAA@A@CCACECACC@@@@B@BC@CBCBABCAA@AAA@@BCBC@C@C@CAC@A@AAAC@ECAACA@@@AA@A@AC@CGAGACEGAA@CBCB@BABA@CAC@AEGCG@AAABADABABAB@@ABC@@AAAECECGCEACAACAAAA@AAAACECECCCCCAA@CAC@@A@AA@A@ABCBA@C@A@ABKDA@C@AAAA@AC@EBABAAA@ACAACEAA@CAAAA@CBE@A@AAAC@GFCDCBCBEBCBA@A@C@C@AAEACCCAC@CB@@A@C@C@A@CBA@C@ABABA@@@AB@BAB@BAB@@ABAB@A@@AE@ACA@A@AAAAAAA@@DC@ABC@A@A@A@@AA@AAABC@EBCBCBAACAA@CBC@ABCBAACACAA@CACBCAAAAAA@A@A@AD@BABABE@CBABAB@@C@A@A@C@A@A@AAC@@BC@A@AC@CAA@@ABCAA@@@@BAA@@A@A@A@AA@AA@AAAAAA@AA@@A@A@@@C@@BA@ABA@ABA@CB@AAAAC@@C@AAAAAA@C@CA@AAAA@@@AAACBCB@@@A@A@AAAAAAACACEAA@AAACAACAAAAAC@AAAC@CA@ACACA@ACAAA@@AAAAAAAAA@@AAAA@A@C@C@AAAC@C@CD@B@B@@A@AAA@ABA@@DA@A@@AA@A@AAA@@@A@@@@BC@A@AAABCBA@AAC@ACC@AA@AAAACCCCCAAA@AAAAC@ACAAA@C@AACAAAAAAAAACCCC@CCA@A@AAA@CA@@@AAAA@CABA@C@C@A@@@@@@AAACA@AAAA@@AA@@AAA@AAA@AA@@AA@AA@@@E@AACAAAAA@AC@@@A@A@@@AA@@A@AA@@ACAA@AAAA@A@EC@@A@ABA@@BABA@ABABADB@@BBBB@AB@D@B@D@@@BCBCDA@C@@BAAA@A@@@@@AC@AA@@ACA@AB@@AAAAAAA@AAA@@@AB@B@@ABAB@@@BABAB@B@B@@BBB@@BA@@@B@@@@@@DBBA@@BADCBA@AC@A@A@C@AAAA@AB@BAB@@AAAA@A@C@ABAAA@@@@@A@A@@AAA@@@ACAACAA@ABA@CBABC@AA@@BA@A@A@A@@AAAA@C@A@@@BBB@@BA@BB@@AB@@C@A@@BA@A@@B@B@B@BA@@@AAAA@@A@@@AABA@A@@@AAA@@@A@AA@@AD@DCDADCDCDEDEDCBAFALAFCBE@C@E@EDKBEFGCBCAAAEC@@BAFCCACAECEACAC@CA@@@AKBE@ADG@C@GAAAEA@@C@CCCCGBK@E@@@CC@CAAGCGBCCBA@A@C@ABAB@DA@@DEBABB@AAAA@A@AFIF@ACGAABADA@EGDABAACC@C@ECEBA@AAAA@AA@C@CI@@AAC@@E@CAABACC@C@@B@F@@C@CBCBCAECACCCCBCDADADA@C@@CAC@ABEAA@A@ABG@CEBC@C@EAABC@GFAAA@CACBCBABCDC@CDIDCB@@A@@BBB@@CD@@E@A@C@C@@@EDA@E@E@@BA@@BEBE@G@E@CAGACBCAECC@A@AABCAACACA@AAACBAA@@@AACCBA@CAA@@ACACB@E@AD@BABEB@@CAA@A@CEAE@A@IAAA@@C@AAAA@AABC@E@CBC@GACCA@CBC@CAA@GBGBE@CB@DIDC@CCEECCC@CAAA@@@@A@A@CAA@A@A@C@@AA@A@CAACE@GAAAGAEAEBABC@AA@A@CC@CC@CACCA@AG@C@AACAA@A@AAAA@@@@A@@BABC@KACBI@@B@B@BC@EAEAACCABCACECCBBBBBAFAHGDABCBOBAHB@@F"],encodeOffsets:[[114077,22387]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("湛江",{type:"FeatureCollection",features:[{type:"Feature",id:"440802",properties:{name:"赤坎区",cp:[110.365899,21.266119],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@@@AA@@A@@B@@A@A@@@A@A@@@@@A@@@A@A@@@A@@@@BA@@@A@@@@@A@@@A@@@@@A@@B@@A@@@@@@@A@@@A@@@@@@BABAICECA@A@A@CB@@@@@@@@@@@@@@@@@@C@@@A@@@@@@@@@@@ECCA@@ABC@@@AA@A@@@A@@AA@@@A@@A@@@@@@@@@@A@@@AC@@@@@@A@@@@@@@@A@@@@@@@@@AA@@@@@@@@@@@@@@@@A@@@@@A@@@@@AAA@@@@@@@@@A@A@@@@B@@A@AB@@@B@B@@@@C@@BCAAB@@AB@@A@@@@@@@@@AB@@@@@@EH@@@B@@A@@@CC@@@AAAABAA@A@@A@@A@@@@A@@@@@A@@A@@@@@@AA@@AA@@A@@@A@@@A@C@A@IA@BA@A@@@AA@@AB@B@@AB@BA@A@@@B@@@@AA@@@@@A@@AA@@@@AA@@@@D@@A@A@@A@@AB@@A@@BAB@@B@@@@BBBBFDDDF@@@BB@@@@B@@BDBDAD@@BB@DB@BB@BA@AA@BC@ABBB@@@@DD@@@@@B@@@@@BA@@@@@A@AD@@A@CB@@@@@@@@AB@B@BAAAAA@@@CH@@@@@@@BAB@@@@@B@@@@@@@@@@@@BB@@@@BB@@@@@@@@@@@B@@@B@@ABBBABBD@@@@@B@@B@@@@@BD@@BB@@A@@BB@@@@@@B@@@B@@@@A@A@A@@@@@A@A@@@@BA@@@@A@@A@@BA@C@@BB@AB@@@@AA@@@@EB@@@@@@A@@@AB@@BD@AB@DB@B@@BB@@@B@@BB@@@@BB@@@@DB@BB@@@B@BB@@B@B@@BBAB@BB@@@@B@@@BB@@B@@A@@B@@@@@@@@@@@BA@@@A@@DDB@@@BB@AD@B@B@@@@@DD@B@B@@AB@@BD@BB@B@B@@@BB@@B@BAB@@B@@@@B@@BA@@@@BB@B@B@@@@@@@@@B@DA@A@@@@@@B@BBFC@@FCDEDCNOB@@@RVFC@B@@B@@@@BB@@B@@BAB@B@@@B@@@B@B@@@@@B@B@@@BBB@B@@@B@B@@BB@B@@@BBB@BB@@@@BA@@@A@@@@@A@@@@@A@@B@@A@@@@@@@A@@@A@@B@@A@@@A@@@@@A@@BA@@@@@A@@@@@A@@@@@A@@@@@@@A@@B@@@@A@@@@@A@@@@@A@@@@@A@A@@@@@@BA@@@A@A@A@@@A@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@AA@@@A@@@@@A@@@@@A@@@@@A@@A@@A@@@A@@@@@A@@@@A@@A@@@A@@@@@AA@@A@@@@@AA@@A@@AA@@@@@A@@@A@@@A@A@@@@@@@A@@@A"],encodeOffsets:[[113070,21777]]}},{type:"Feature",id:"440803",properties:{name:"霞山区",cp:[110.397656,21.192457],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@JDFDH@N@J@FABA@CAICEEGAAEBCBA@GAK@EBEDAFAFBBDD"],["@@C@ECGGKOMF@@B@@D@B@@@@@@@F@D@BA@AA@BAB@@@D@@@BABABCB@B@@@@@@@@@B@@A@@@@@@@@BABABAAA@@BA@@B@B@B@@ABA@@@@@@@@@@@@@AB@BAB@B@BBB@@@B@B@B@BBDCDABA@A@C@@BA@@B@B@@@BC@CBA@@B@@A@AB@@AB@@A@A@@B@@A@@BCB@@@@A@@BAB@B@@@@@D@B@B@@AB@F@@@BBDBB@DBDB@@BBFBB@B@@@@BB@DBB@B@B@B@@@@BBB@BB@BBBD@BAB@BA@@B@@B@@AB@@@A@@@@@BBB@B@@B@@B@B@@@B@B@BAD@BBB@@BB@FB@@BB@@@@B@@B@@BDA@@@BA@@@ABBBB@@B@@B@@@B@@BB@@@BA@@@@B@@BB@A@@B@@@B@@@BB@@A@@BA@@BB@@@@BB@BBBB@@AJBB@D@B@@@B@@@B@@@BB@@BB@@@@@@@BB@@@@@B@@@@@@BB@@@@BBBBABB@B@@DD@@B@@@@A@@FG@@@@@@BA@@@@@@@@B@@@BA@@BADB@AD@@@@@@A@A@@BAB@@@@A@@B@B@@@@@@@@@B@BB@@@@B@@@@@B@@@@@@@@@@@@@@@@@BB@@@@@@@@B@@@@@@@@@@B@@@@D@@B@@@B@@@@@@@@B@@@@B@@BB@@@B@@@BBB@@D@BA@@DBFD@@@@@@@@@@B@@@D@@@@@@@@@@@@@@@@@@@DAB@B@B@FDJDFEFA@@@@DABABEBCBA@CBE@C@E@A@AACAE@CAGACCC@AAAAEAEAC@A@EC@ACAAAICICSGWGYGSCCEGKIGAE@GFEFID"]],encodeOffsets:[[[113071,21668]],[[113026,21641]]]}},{type:"Feature",id:"440804",properties:{name:"坡头区",cp:[110.455332,21.244721],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@MCMCEBIDKFIFMDGDEHEH@FCFOBM@CBABAF@HAFAFHDP@DDF@JAJBPN@B@D@HDFHBD@F@DAD@FAHFJJHHHBFAHEFE\\YFEZHPDVJf@P@FC@EFCPBFBHBTTJDNBJ@DBDABC@EAEEW_[KUKUk»CEEEEA{IIBEBEFGPCHDFFJ@FAFCDCAGCC@EDOfAJEFKD"],["@@GDEDIBG@A@IAGGMIO@SI@@EI@IEGEAGCM@G@IDOLA@@F@BBDBFBFBB@BDDBDBH@DBFBD@B@B@F@DAF@DABADAFABCB@@@@EBEFABAB@@@@@@@B@@@B@@@@@@@BA@@@@B@@@@@B@@@B@@@@@B@@AB@@@@@B@@@B@B@@@B@@@@@B@B@@@B@BA@@@@BB@@B@@@@@B@B@@@B@@@@@@@B@B@@@B@@@B@@@@BB@@@BB@@B@@@@@BB@@B@@@@@B@@@BB@@@@@@B@@@@@B@@@BB@@@@B@@@@@B@@@@@B@@@@@B@@BB@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@B@@@B@B@B@@AB@@@@@@@B@B@@@@@B@@@@@B@@@@@B@@A@@@@B@@@@@@@B@@@@@B@@@@@B@@@@AB@@@B@@@@@B@@@BA@@@@B@@@B@@@@@@@BA@@@@B@@@@@B@@@@@B@@AB@@@@@@@B@@@D@@@@@B@@@B@B@@@B@B@B@@@B@@@B@@@D@@@B@B@@@B@@@B@@@B@B@B@B@B@B@@@B@@@B@@@@@B@BB@@B@B@@@B@B@@@B@@@B@B@B@@@B@BAB@@@B@B@@@@@B@@@@AB@@@B@@@@AB@@@BA@@@@B@@@@@@AB@@@@AB@B@@AB@@@@@BA@@@@B@@@@AB@@@@@@A@@B@@@@@@A@@B@@AB@@@@A@@BA@AB@@AB@@@@@@A@@@@BA@AB@@@BA@@@@@AB@@@@A@@B@@@@AB@@@@@@@BA@@@@BA@@B@@AB@@@@@B@@AB@B@@@BA@@B@@AB@B@@@@@@AB@@@@@@@B@@@@@B@@@@@@AB@@@@@B@@@@@B@@@B@@@BA@@B@@@B@@@@@@@B@@@@@@@@AB@@@@@@@B@@@@A@HBB@B@@B@B@BB@@@@B@@@B@@@@@B@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@BB@@@@@@A@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@B@B@@@@@@@@@@@@@@@@BB@@@B@@@@@@@@@@@@@@B@@@@@@@@B@@@@D@@@DA@@D@@@@@@D@@@A@@BA@@@@BB@@BB@@@@@@@@@A@@B@DAB@BBB@@BBB@@@@@B@B@@BBBABBBA@@B@BAD@@@B@ABBB@@AB@@@@B@@BADABA@@BA@AB@B@B@@B@@@@BA@AB@@AFAA@B@@@@B@@@BB@@@D@@C@@@@BBB@@D@BB@@@@@@BAB@B@@ABA@@@A@@BAD@@@@@@@AA@A@@@@@@AAB@BD@@BAB@B@@@@CB@@@@@@DB@@@@C@AB@@B@B@BB@@@@AB@AA@@AAAAB@@@B@@A@@AA@ABA@@BA@@@@@@@@@@@@@@B@@@B@@BA@@@B@@BB@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@D@B@@AB@B@@ABA@@B@B@@B@@A@@BAA@B@@B@BA@@B@@@@BA@AB@@AAAB@@B@@@@D@@A@BB@B@@B@@@@B@@B@@@A@@B@@@@@@BB@B@@AB@@AB@@AB@B@@BFBBDD@BABBB@B@B@@@@@BB@@@@@@@@D@@@@@ABAB@@@B@@@AB@B@@BABA@@B@BA@@@@@A@@@A@@B@@@BA@@@AB@@A@@ABA@@BA@AA@@A@@@@AB@B@@@@A@@BAB@@@BAB@BA@A@@ABA@@A@@CA@@@@BB@BA@@@A@A@@A@@@A@A@AB@@B@@@BB@AA@@BA@@@@@@@@@@@@@@B@B@@@B@@@@B@B@@@@@@@@@@@@@@@@@@@B@@B@BB@A@@@@@@@@@A@@@AB@BA@@BA@@A@@BA@@@@@@AB@@A@@@@@@@@@@@AB@B@@@@B@B@@@@@@@@AB@@@D@@@@@@@B@@@@@D@@@@@@@@@@@@BB@B@@@B@B@@BBBB@@B@@@@@A@@@@A@@@C@ABA@@@@@@B@B@B@@BB@B@@@BABA@AB@@@@@@@B@@@@A@@@@@@@@@@B@@@@@BBBA@@@@BA@@BBDD@A@@B@B@B@@@B@@AB@BCB@@@@@@@@A@@B@B@B@@@BB@B@@@BA@@@@@@@@@B@BA@A@@@BB@@B@@ABABA@CB@@A@@@@@BA@@A@@BA@@BB@DABB@@BB@@B@B@@CB@B@AA@@@@@@@@@C@@B@B@@ABBDA@A@@D@@ABA@A@@@@@@@@@@D@@@B@B@@A@@AA@@@ABA@A@A@@B@@@AABAAA@A@@BAB@@AB@B@@@@A@A@@@A@@@@@@@@@@@@@@DA@@@@@@@AB@@@B@B@DB@@CA@@DCB@BA@@@@@EB@@@@@@A@@@@B@@@@@BB@@B@B@BB@B@@BB@AB@@@BABA@@BA@@@@@A@@@@B@@@@A@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@A@A@@B@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@AA@@@@A@@@@@@A@@@A@@@@BC@@@@@@B@@@@@@A@@@@@@@AAA@@BA@A@@@A@@@@@A@@@@@@B@@@@@@@@AB@@A@@@@A@@A@@@@@@@@@@@AB@@@@@@@B@@A@@@@@@A@@A@A@@@@B@@@@AB@@@@A@@@@BC@@@@@A@@BA@@BA@@B@@@@@B@@@@@@@@@@@B@@A@A@@@@B@@@@@@@@@B@@@BA@@@@@AB@@@@@@@@@B@@@@@@@@B@@@@@@@@BB@@@A@@B@@@@@@@@@@AB@@@BB@@@@@@@@@@B@@@@B@@@BB@@@@B@@@@B@@@B@@@@@B@@@@@@BB@@@@@@@B@@@@A@@@@@@@@BA@@@@A@@@@AA@@@@@@@A@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@A@@@@AA@@A@@A@@@@@@@@@@@@B@@A@@DC@@B@@@@@B@@@@@@@@@@@BA@@BA@@@@BA@@@@BA@@BA@@@A@@@@B@AA@@@@A@@@@@@A@@A@@@@@@AB@@@@@B@@@@@@A@@@@A@@@@@@A@@@@@@@A@A@@A@@@A@@@@@@@@@AA@@BAA@@@AA@A@@@@@A@A@@@@AA@@@@@@@@A@@@@BA@@@@@@A@@AA@@AA@@@@@@AA@A@A@@B@@@@A@@@@@A@@@@@@B@@CB@B@@@@@AA@@@@@@@@A@@@@@A@@@@@@@@@@A@@B@@@B@@@@@@A@@A@@AA@@A@@@@@@A@@@A@@B@@A@@@C@@@@@A@@@@@@@@A@@ABA@@@@@AA@@@@AA@@@@@@@AB@@@@@@AA@@A@@@@@@@@@@A@@@@@@@@@@@@@BAB@B@A@@@@@@@@@@@@AA@@@BAB@BACA@@@@A@A@@@A@@@@@AA@@A@@@AB@B@@A@@@@@@@@@@@@@@@A@@B@@@B@B@@A@@B@@@@AB@A@@A@CCAA@@@@BD@@@B@@CC@@@@A@@A@AA@@@A@@AC@@@FAB@@@@@@@@@@@@@AE@AA@ACA@@AA@AAC@ABA@AA@@@@@C@A@@@C@A@@@@A@@@@@@@A@@@@A@@@AB@@@B@@@@@@@@@@@@@@@@AAC@A@@AACA@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@AA@@@@@@@@@@@@@@@@@@@A@@@BA@@D@BAD@@BB@@D@@@@BA@A@@BA@@B@@B@@DADADC@@@@@@@@B@@@FB@@@@@@@@DB@@@@@@@@@@@@@A@@BA@@@@@@B@BBBB@A@A@A@ADAB@FC@@FCFCDABAB@@DFADAB@@@@@B@DADC@A@AB@BA@@@@@@@@@@@@@@BB@@LECA@@@@BABA@@B@@@@@BB@BBB@B@@@@B@BE@@@@A@@A@@BAB@A@@CDAFCDABB@@@@BA@A@ABADAB@@BBB@@@@@@@@F@@@@@D@B@BBB@@BB@@B@B@BAB@@BBBBBBDB@B@DD@B@@BBB@@BD@B@B@@@@BB@@@AB@DA@@@B@@@BB@B@@@@A@AB@@@BBBBAB@@BB@@B@@@@B@B@@B@@@@BBA@@B@B@@@@@@@@@DADB@@@@B@B@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@B@@@@@@@@GBEBIBGDG@G@GBKBA@E@I@E@E@G@O@CQMGEKCoAYAwCOCEAUEMAI@KDKFIF"]],encodeOffsets:[[[113155,21651]],[[113166,21722]]]}},{type:"Feature",id:"440811",properties:{name:"麻章区",cp:[110.334387,21.263442],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@HF\\JHBF@HADCFIDGAEACCCE@sHC@CBAD@BDD"],["@@S@[BWFOJGNEXALAF@DAPHLLJJHPPNTT\\JHPBPA\\K\\GPEJGDK@KAMEOIIGKM_KYGKKIUCEA"],["@@F@jFL@LAfONGJIDG@GAIQoUCKCYA[DoAQCKGGKEOAKBKFILGXANALELMRABMTIPKHKDS@cGIBQHKBM@_IO@WBQBO@EAIC]QKEWMMEKCQEmKcCYAQEeQMCKDEJANBNFVHXLPbPXTZ\\TPRFJJL`DHVfXbTDZCLSP@TB\\HdTPDPBN@PELEHIPiFGHCL@VDJDD@P@T@PPJFFD"],["@@QQAMOFEDADCBE@C@@@A@@@ABADBD@BBD@D@@@BADABAB@B@H@B@BA@@@@AAABCAA@@@@A@@BAB@BABABABA@A@AAC@AAC@C@A@A@CDAB@B@B@@AD@BABAB@@@@A@@@AAAAAA@@@@AB@DA@@@CBA@A@A@AB@@DDABABBDBBB@@@BBABBBBD@@BB@@@BB@DB@B@@@@A@@@AB@@B@@DD@@BBBB@@BBBBBBB@B@@@@@@@@@B@@DB@B@@@BBBDH@BBB@BD@@@@D@@A@AAA@@@C@@BBBA@A@@A@@A@@B@@@@@@@BAB@@@@@@@BAB@BD@@@@ABAB@B@@@B@B@B@@BA@AA@@AB@@A@@B@@AB@@@B@AB@@B@@A@@@A@@BA@@BB@B@@@DB@@@@AAA@C@@@C@@B@@@@B@@@@DA@@@@@@D@B@@@B@B@@@BBB@@A@@BA@DB@@@B@@BD@@@@@@ABA@A@@BA@@AAB@B@@AB@@A@AJ@B@BBBAB@@A@@B@BABB@@B@@@@@@BBB@B@@BB@B@@DAB@@ABBD@B@@@B@B@@@B@@DBABB@@@@@AB@@B@BB@B@@BB@B@D@B@@AB@@B@@@@C@ABA@AAA@@@A@@DA@@B@@@@B@B@@B@@@B@@BB@@D@BB@@@D@B@D@B@D@@@BA@@@D@BD@@A@A@A@@BBB@@A@@B@@@@@@@@@B@BB@A@@@A@@B@B@@B@B@@@BBBB@@@BB@DBBBB@@A@@BDDBB@@BBBB@ADADAFADABC@@BADCFGBCDA@C@CBADAB@D@D@BBDBDBB@DAB@BA@@@@@@@@BABCBA@@D@FCB@@D@B@F@B@B@BBDA@A@@B@@BBAB@@A@@@@A@@A@@@@@@@@@@@@@@@BB@@A@@@A@@@@BA@@@A@@@@B@B@B@@@@@@@B@@B@@B@@@@@@@@B@@@B@@@@@@@@@@@@@@@@@@@@@D@B@@@@@@@B@BBB@B@@@@@B@B@BB@@@@B@FAB@@A@@BB@@@BB@@B@@@B@@A@@DAB@@B@@@B@@DAB@@@@@@@B@DA@@BA@C@A@@BB@AB@@@AA@@A@A@@A@A@@B@@@B@B@BB@D@D@B@@@B@@@@B@B@@@@@BB@@D@@@@B@@@B@@B@@BA@@BC@@@@@@@@DA@@@@@@CC@A@A@@A@@@@A@A@@B@@@@B@@B@BC@@@A@@B@B@B@@A@@@@AA@@@CAA@@C@@A@@@@@@A@@@@@@D@B@@@D@@ABAAAAB@@ABA@@@C@@@AB@@A@@@@B@BBBA@A@@B@@@B@BAB@@@@BAB@@B@@@@@@B@B@B@@@BB@B@@@@BBD@@A@@B@@@@@@B@@@@B@B@BA@A@AA@@ABAB@@CB@@@BBB@@AB@@AB@@ABAB@@@@@@@@@@@@@@@BB@@B@@A@A@A@@B@B@B@BB@B@@@@@A@A@@A@A@A@@BCA@AA@BCBAB@B@B@B@B@AA@@ABCGA@@A@@D@@@@@B@B@@B@@@@AB@@@BBBD@@@BB@@@@@@@@AB@@AB@@@DBB@B@@@BABB@@CBAA@@A@A@@B@@@B@@@@B@BD@B@B@@AA@@@A@@@@AAAA@@@@AD@@BB@@@@A@@@A@@B@@@@BDBAB@@A@@@@B@@B@@@@@BA@@B@@BBB@@D@@@DAB@@A@@@@@@AC@@AB@@@BA@AACAA@@A@@AAA@@@ABABABA@@AA@@A@@@@@@@@@@@@@@@ABBBAB@@A@@@C@A@A@@@A@@@@@@@@B@B@@A@@@A@@B@@B@BBBB@@@@@BA@AD@BB@@@BBB@@@B@@ABA@@B@BB@@@B@@@B@@@@@@BBDBD@@@@@BAB@BA@@@A@@@@@@@@@@@B@@B@BB@@D@@@B@@A@A@@@A@AA@CBA@CA@@@AB@BA@ADB@B@BB@B@@@@AA@@@@A@A@@@AA@AC@@A@@C@C@@@@@A@CB@@@@@@@@@@@@A@B@B@@@@@DB@B@BA@@@@@@A@@@@AB@@B@@@B@@BB@@@@@BBD@@@B@B@@AB@@@DADB@@@@D@@@BABAB@@@@AB@@ABA@@@AB@D@@ABBD@B@@@@@@AB@F@@@@ABA@@AB@@A@@AAA@@@AB@BB@@BAB@@@@A@@AA@A@A@@AB@@@@@BB@@BA@A@A@@A@@@AB@@@@BAA@BA@@@@@@@B@@@@@A@@B@@@B@@@@A@@A@A@@@@@@@@A@@@BA@@BABAB@@BA@A@@@@BA@A@AB@ACA@BA@AA@@A@@AAB@B@@AD@@@@A@@@@A@AA@@@@@@@@@D@@@@A@@@@@@B@@@@@@@@A@@@C@A@@@@@@B@B@B@@AC@@@@A@@@@@@BBB@B@@@@AA@@@@@AA@@AA@@BA@@BA@A@@@A@@@@@@@@@@@@B@@A@@@A@@@@A@@@@A@@B@@@@A@AAABBBBBB@B@@A@@@@@@@B@@BBB@@@B@@@BBA@@B@B@@A@@@A@@@B@@@@C@@@@A@@@@AA@@@@@@A@@@@@B@@@BAB@@@B@@@@@@AAA@A@A@@@@BAB@@@BA@@@@@A@@@A@@@@@A@@@@A@@A@@@@B@B@@A@@A@AA@ABA@CFAB@@B@@@@@@@B@@@@@@@BB@@A@ADA@@@A@@@@A@A@@@AB@@@@@@CDA@@@AA@ABA@@B@@A@A@@@AAAA@A@@@@@@@@A@C@A@A@@B@B@BA@@@@B@BB@@@@BAB@@@@DD@@BB@@B@B@B@@B@B@@B@DB@BD@@@B@@B@@DDC@A@AD@B@@DBD@@@FDDF@BB@B@@BA@@DB@B@@@B@@@A@@B@@A@@B@B@@BBB@@@A@AAAA@A@@@A@@AB@@@D@@BB@@@@B@BB@@ABB@@B@@@B@BA@@@C@A@@@A@@A@AB@@A@@AA@A@@A@@@@A@A@AAAA@@A@@@@BA@@@@A@@@@AC@@@@@@B@DA@@@ABA@@@@@@BABA@@@@@@@@@@@@B@@D@@@@@B@BB@@BB@B@@@@@@@@@@BAB@@@@@@DB@@@@@@B@@@@@A@@A@@B@@BB@BA@AAA@@BA@@@@BA@@@@@ABA@@B@@@@BB@@AB@@B@B@BA@B@@@@@B@@AD@@@@DB@DDB@@B@@@B@BBAB@@B@@BB@@@BBBB@BBB@@@BBJBD@@@@@@@@B@B@BBAB@B@@B@@@@B@@@@@@@@@@@@@@@@@@@AB@@@B@@@BA@@@AAA@@DA@@@@@B@@@@@@B@@@B@@B@@@A@@@AB@@@@@@@@B@BA@@B@@@B@B@@BBBBB@@@BAAA@@BA@A@@@@B@@BB@B@@ABB@@@BBBB@@BB@@BB@B@@@B@@BA@AB@@@@BB@@B@@@@BABBD@B@@@@@@@@@@@@BDA@@B@B@BB@@A@@@A@@@@@@BDA@@@ABA@A@A@A@@C@A@A@@@@@@A@@@@@@@@@AAA@@@@@C@@D@@@@@@@@@@@@@@A@A@@@CBA@C@ED@@A@@D@@@@B@@B@@@@@@@@@B@@@@@B@@@B@@@@@@@@@BA@A@@B@B@B@@@@@@F@@@@@B@DB@@@A@A@@@@@A@@B@@@@@@@B@@ABA@@BA@@BAD@@@@A@@@@B@F@B@@B@@@@A@@BBB@@D@DBB@DDAB@B@@D@@@@B@B@@@D@@A@@@@@A@@B@@@D@B@BB@@D@@BB@@@@ABDF@DBB@B@@A@@BD@@@@@BA@B@@@@@@@BB@@@BA@@B@BBB@B@@@@AB@D@@@@B@@DBB@BB@@B@B@B@@@@BBABABA@@@@AAAB@AA@@@A@A@@@@@@B@@@@A@C@@A@C@@A@@@@@@A@@@@@@@@@@A@B@DAD@@B@@@B@@@@@@@@@@B@@@@B@AB@AA@@@@@A@@@@@@@@@@@@@@@@B@@@BD@@@B@@B@B@@@@@BA@@@@@A@@@@B@@@@BB@@@@@B@@@@@@C@@BAAC@@B@@@@@@@@@DAB@@@@@@A@@@@A@@@@@B@BAB@@@@@@@@@@@@@@D@B@BB@@@@AB@@@@@@B@BBBB@B@D@@BBD@@@@@A@ACAB@B@@@B@B@@ABBBABBBB@@B@@@ABA@A@BB@D@@B@@B@B@B@@B@B@@@AAB@B@B@@@B@B@DB@B@@B@@@@@@@@@BBBDDF@@@@@BB@B@B@@@B@BB@@@@@D@@@@@@AB@BBB@@@@@@AAAB@@@BBB@B@B@@@@@B@B@AA@@@@@@B@@@BBB@@@@BDD@B@@G@A@AAA@@B@BA@B@B@@B@B@@@@CDABCBA@A@@@@@@@@@@@@@@@@@@@@@@@@@B@B@@@BB@@@@A@@@@@@@@@A@@@@B@@A@A@@@@@@@@@@@@@@B@B@BEBAD@D@@@B@@@@@B@B@B@@CBCB@DB@ABABCB@@AB@@@B@@A@@@@AC@@BA@@B@@@@@@@FA@@@@BB@@@@BAA@@AD@B@@AB@@@@B@@B@@A@@B@B@@@@@B@B@B@@@@@@A@@@A@@@@A@@AB@@@AA@@AC@@@@A@@@@A@@@@ACBAAABA@@@A@@@A@@@@@@@@@@AA@@@@AA@@@@@@@@@@@@@A@@@@BA@A@@@@@@DG@@B@BBBB@A@ABA@@@@@@@@DAB@@@BCB@@@@@B@@A@@@@@A@@@@CC@@@@AABAD@@ABBB@@AAAA@@CAA@@BCACAC@@@A@@A@@A@@CECCAEAA@A@@A@@@BA@AB@@@BA@@@BB@B@@@@C@@B@@B@@B@@BB@@@@@B@@B@@A@@@B@B@@ABA@@@ABA@@BB@@B@AA@AAA@@@@AA@@AB@@@BA@@A@@@A@@@AB@A@@AA@@@@@AB@@A@@AA@@@A@@@@AA@AABA@@B@@A@@CB@AA@@@@A@@A@@AA@@EAA@@AA@ABC@A@A@A@@@A@AA@@@@AAA@A@@@@@B@@BA@@@AA@@@ABA@ABC@AA@AAAA@AA@@@@@A@A@AAA@CAA@@@@@AAAAE@AA@AC@CAAAC@A@@@EBA@@@A@A@C@@@@@ABA@AB@@@@@DA@AB@@@@AB@B@@@BA@@BAB@@@@AB@DAD@@A@@@A@AB@@AD@B@B@BADCAC@A@A@A@A@@AA@A@ABA@ABA@@@@@@@@@@@@B@BA@@@A@A@AB@@AB@BBBABA@A@@@@@@B@@@@A@@@@@@@@@ADABABA@A@@@C@@BA@ABBB@@A@C@E@@@@@@@A@CA@@@NECKGEMI]IWBGAEAG@[KA@OOAGAEG@EGQMGGIIOQKKCK@MDMCIKIOCGJMVGNCHBZCDE@CAEGIS@CCQBGBIHW@@EK]Y"]],encodeOffsets:[[[113045,21611]],[[113253,21358]],[[113147,21582]],[[112835,21495]]]}},{type:"Feature",id:"440825",properties:{name:"徐闻县",cp:[110.176749,20.325489],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@BDHHNPLNDFFJJbBFDLBBB@D@DGDM@KAICEEGKIGKQYIIGAEBCD"],["@@EJ@HCD@FDBDDF@B@@BADAD@DHLHFNBF@POFIH[@IAEECEAC@CBAFAFADABCAAA@EACEAI@IBCB"],["@@@DAAADBB@D@@B@@FB@BBADB@BA@@BABB@@A@ABB@@@@@@@@@@BBBB@B@@@@BB@@BA@A@@@A@@BD@DADA@EL@DAAF@BLAHABBF@@BE@@@DDD@BALF@DDDBB@@CE@A@AFGD@DF@@@B@@@B@B@@@@@BD@B@BBBA@ABDBBAB@@@D@@@@BB@BA@@@D@DCBAA@@@CDAABADC@G@AAEDADBBB@BBDDDB@@BBB@B@DB@@D@@ABAB@@@BBD@@@@DBABBD@@FBBBBB@BBB@B@@B@BB@BA@BB@@BBB@@BB@B@@AA@AAA@@@B@BBD@B@ACDBBBB@@B@BDB@AB@BB@@B@@@BB@@B@@BBA@@BA@A@AAAB@BDABB@DB@@@@DB@@B@BA@A@@BABAB@@B@@@B@@@@DBBBDBBBFDBBBDDDB@@B@B@@JFB@DFBDDDB@@DB@@@@B@@BBB@@BB@ABAD@@@DBBDD@B@DCF@JBDDHDJBFBFBL@DHPLJ^BpETMTIJAT@`BPHRHPBJOPcP]DMBEHaBcDOF_BEVwXwDEJMbqDGDIBQA[ImACEIMI_SCAGIOSKMOQEKIU_m_qa[UKWCQ@ODODIAOGw{SIWEcC[@GEAKECE@GLGHOFODG@gCS@Q@GAOQKIUQMEaKmCSBG@SBIBEDKPSV_bABCBGBeAA@CiCWAIACCeoeWWGQAOFEDABGD@BEH@DAJ@LHrDVBHBN@dCbYnSPk^KNIPARBNDHDLLDLBPHDBBDDHFJHN@H^CVCTArCB@VA\\@VAPBR@HBD@@BBDBDBF@BDFDDDB@@B@BABABB@@BBB@B@B@D@BBBBB@@ABABB@@BB@@B@BAD@@AB@@@BBBADB@ABA@@DA@AB@B@BAF@@@@BB@DA@@B@B@@A@@@@BAB@BE@AA@@A@A@@BA@@@A@A@ADBBB@BBBBB@@@@B@@@@B@@@BD@D@BB@B@@B@DB@DA@AFBBBBBBB@BBBBLB@@D@@@ABBBDADBB@@BDBB@B@@BABCBBBAB@D@B@@@B@B@BBA@@DBFBB@B@@BAB@@CBA@A@@D@BA@@@@DE@C@AB@@@B@@@@GBCBCA@DABAA@@E@@@@BD@@@BB@@B@BA@@@BD@@AB@BBABB@@@B@@B@BB@D@@F@@BJBJA@AD@@A@E@BB@B@@BA@@@B@BCA@@CACA@@BDDBDB@B@BB@D@B@@@BJ@@KBG@ABABEDA@@BBD@@B@B@BA@DAB@@BB@@B@BB@@AF@BDBF@@@B@BBBA@A@@@@JCHDB@B@BC@@BEFFDDJFDDD@D@B@@@@B@@CB@@BBBD@F@BBFABIBIBAAE@EB@BDFCFCD@@@DHHHFB@HBFDFFDBD@BAF@@ADCBC@@@BDBB@B@@A@@@@A@@@A@@@A@@@@A@A@@@@@@A@@@@A@@@ADA@K@@BABABA@A@ACA@AAGDBB@B@@CACHCB@@BBDNAHABDBDNAB@@@@@D@JAHA@A@CBAF@@BBBD@@@B@BD@BAFBB@@@F@BBDBAB@B@@@@BDD@@@ABAB@BBDD@@ACB@@A@@D@@@@A@@@@BA@CB@FBBB@BA@A@A@A@ABABB@@AB@@B@BB@@A@AB@B@@BBBDAB@BD@@A@E@G@@FD@B@D@@F@@@@@@J@"]],encodeOffsets:[[[113210,20816]],[[113056,21175]],[[112851,21046]]]}},{type:"Feature",id:"440823",properties:{name:"遂溪县",cp:[110.250123,21.377246],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@@_@]@HrF`BPDTE`ATEh@RHPHNTV`bZZPPtpB@VTFJFHBT@vA`AVA`AN@PAN@TGLBLFHJJLHB@NBN@B@lC@@b@X@tHNFRVNVFJFHFNFPRhf@LBL@D@L@BA@A@@FA@@@AB@B@@@@A@@@@@E@@B@BA@@A@@@DCB@@@B@@@@A@@@@@AD@BAD@BBB@BBB@B@H@D@@@@@@@@@@@B@B@@@B@B@A@@AA@@@@@B@DB@@A@@@@B@@B@@ABB@@@B@@@@@@@@@@DBB@@@@A@A@C@@BA@@BBB@D@@B@@@@BB@@B@@B@@F@@@@B@BD@DAF@@AD@BAB@F@D@BBD@DDB@@ABBB@@@BAB@@@BB@@B@@@@@DBBB@BB@@@B@@@@A@A@AD@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@AFB@BBB@@BBB@FB@@D@BBBAB@@BB@@AB@B@@@@AD@@A@@@AB@@@B@B@@@@@@AB@@@@@@B@@@B@B@B@@A@@B@@@@B@@@@@@BA@BD@@@@BDB@@@BB@@BB@@@@B@@BD@B@BA@@@AD@@@B@@ABA@@@AD@@@B@@@@BB@BB@BBBBB@B@@FC@@@@@@D@@A@@B@@@BAB@@AB@@@@@@@BBBB@@B@@@@@@@@@@@@@@@BA@A@@B@@@@@B@BA@A@@B@@@@@@@@@@@@@@@@BD@@@B@@@@@@@@@@@@A@@@@B@@@@@B@@@@@@A@@@@@@@@@B@@@@@B@@@@BB@BBB@@B@BB@@@A@@@@B@@@DB@@@@@@@@@@B@@@@B@A@@B@@@B@@@BB@@BB@@BB@@DD@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@A@@B@B@@@BA@A@@@@BA@@B@BBA@B@B@BAB@A@@AB@@AB@B@B@@C@@@ABAB@@AB@@B@@@@D@@AA@AA@@BAD@@@@@@@@@B@B@@AB@AA@@BC@@@A@@B@@@@@BAA@@A@AB@@@@B@@@@@@@AA@@@@@@@BA@@@A@@B@@AA@@@@@@A@AD@@@@@@@@BB@@@@@@B@@D@@B@@@FA@ABBB@@B@@@@B@@B@B@@A@@BA@B@@@@B@@@@@@@@AB@AB@@@@@@@B@@B@D@@@@C@C@A@@@A@A@@@@B@@A@@@@@@@AA@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@BAB@B@@@AA@@B@@@BAB@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@BB@@B@@@@@@@@@BB@@B@@AB@@@@@@@AB@@@@BBB@@@B@@@@B@@BB@@@@@B@@@@AD@@AF@B@@@@@B@@@@BB@@@@@@@@@@@B@@@@B@@AD@@@@@@@A@@B@@@@D@@BBD@BFNDN@@@@B@@@@@B@@@@@@@@@B@@@@@@@B@@AB@@@@@@@@@B@BA@@@@@@@@@@B@@D@@@@BBD@@B@@@B@BBB@@@@D@@B@@@@@@@@@BD@@@B@@@@@BA@BB@@@@@B@@@B@@@@@BB@@B@@A@@@A@@CE@@@A@@@ADA@@@C@A@A@@AA@C@@BA@@@@D@B@@@@@DA@@@@@AB@@@B@@@DB@@BA@@@A@@@@BAB@DAPEBEA@@E@@@ABABA@@@@DAFAD@@@@@@DCFAD@B@@@DAB@@CH@@AB@@@B@@BF@B@@@@CB@@@DBD@@@B@@AB@@AB@B@@@@@@@B@@@@@B@B@B@B@B@B@@@@AB@@@@A@@A@@A@@@@B@B@B@@A@A@@@@@C@BBA@@B@BC@BBIB@@KHCDB@@BBBB@B@@@BB@DB@@@@@@@@@@D@DAA@B@@@@B@@@@B@B@@@@A@@@@@@B@@@B@@@@@@@@AFA@@@A@A@@@@F@@BD@D@@@@BEBA@A@@B@B@BB@@@BA@A@@BA@@@@BFA@@@B@B@@DD@B@@A@C@AB@@AB@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@B@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@@@@@@BBBBB@@@@@@@@@@@@@@@DB@@@@B@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@@@@@@@DB@@D@@@@@@@@AB@@@B@@AB@FADCB@@@BA@@B@@@B@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@B@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@BB@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@A@@@@BBB@@B@@B@@@DB@BB@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@AB@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@AA@@@A@A@@BA@@@@BBB@@B@@BB@@D@@@@@BA@@BBB@@@@BD@B@@@@@@@@@@@@@B@@@B@@@@@@I@@@@DA@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@DA@@BB@@B@@@@BBB@@B@@@D@B@BB@@B@@@BA@@@B@@@@B@@@D@B@BAAA@@@AB@@A@@@@@@@AB@@A@A@AAAA@@AB@A@A@AAA@A@A@@@@AB@@@B@B@B@@@B@@BBC@@@@@@@@AC@@AAA@A@@@A@C@A@@@@AAA@@@AA@A@@A@AA@BA@ABBB@BBB@B@B@@@DBB@@B@@@@B@@@@@BBABB@@@@ABA@A@@@@A@@@@@@AB@@@@@@@@@B@B@BC@C@ABA@A@@@@@A@@@BA@@@@@A@@@BAB@@@@@B@@@@A@@A@@@A@@@@@@A@@@AB@@@@A@@@@@@BB@B@@@@BA@A@@@@B@@BB@B@@@@@BBBB@@BB@B@@@BBBB@@@B@@A@@@A@A@A@@@@@AB@@ABAB@BC@@D@@@BA@@BA@@D@D@B@@@@A@AA@@@@AB@@@B@@@@A@@@@A@@@AA@@@AA@@@@@BA@@@@@A@@A@BABB@ABBB@BA@AB@BA@@B@B@BB@@@@@AAABA@@@@@@@@@A@A@@@A@@AA@A@@@AA@@A@@@@@@@@@@@A@@B@@@@@@@@ADB@AB@BA@@B@@@@@@@@@@@@@@@@@D@@@B@@@@@@@@A@@@@@@@@BA@@@@@@@@@@DA@@@@@@@@BA@@@@@@@@D@@@B@@AB@@@BCBA@@@@BAH@JDF@F@FAFBDDHDHBJAH@BCBC@OBIBADEBCHGHIB@@@@@@A@@@@@@BA@@@@@@@@@A@@@@@@@A@@@AB@@A@@@A@@@A@@@@@A@@@@BA@@@@@@@A@@@@@A@@@@@@BA@@@@@@@ABA@@@AB@@A@@@ABA@@@A@@@@BA@@@AB@@A@@B@@A@@@@@@BA@@@@@AB@@@@@BA@@@@B@@A@@BAB@@A@@B@@@@@@@BA@@BAB@@AB@@@@@BA@@@AB@@@@@@@@AB@@@@@@@BA@@@@@A@@B@@A@@@@BA@@@ABA@@@@BA@@@@@@@A@@B@@A@@BA@@@@@A@@BA@@@@@A@@@@@A@A@@BA@A@A@@@A@A@A@@@A@@@A@A@@@A@AA@@A@A@@@@@A@@@A@@@A@A@A@A@A@A@@@A@@@A@@@A@A@@@C@@@A@@@A@@@A@A@A@@@A@A@@@A@@@@@C@@@A@@AAA@AA@@A@A@@AA@A@@@A@A@AA@@A@A@@@@@A@A@@@A@@@A@A@AB@@@AA@@A@@A@@@@AEDQU@@A@MPCDCFED@@EDAAA@@@@@@@@BCBA@@@@@@@@@A@A@A@@A@@B@@AA@@@@@@AA@ABA@@@AA@@A@A@A@@AAC@@BA@@@A@ACC@@@@A@A@C@@BAA@@A@CC@@@B@@AB@@@@@@@@@@A@@@@BA@@@AA@@A@@@@@AAA@AB@AA@A@@@AAA@@@A@@ACA@@@@AA@@@@AA@@@A@@AA@@@ACAA@@B@@@@@BA@@@A@@BA@ADAB@BCAA@AD@DA@A@A@@@@@A@@@C@C@ABAFA@A@@@@@@@@@@@@@@@@B@BA@@@@@@B@@@@@@@@@B@@A@@A@@@A@A@@@@@@@@@@@@@@@@@@@@@@@@@BABADCB@D@@A@A@@@@A@AABA@@@BB@B@B@HA@C@AC@@@@AA@A@@@A@@@@B@@B@A@A@@@@@A@AAA@A@@BABB@@@@@@AA@ABA@@@@@@@C@@@@AAA@@@A@A@A@@A@@@@CEACAA@@@@@@@@A@@@@ACAA@A@@@A@A@A@BB@@A@A@@@@A@A@AA@@@@CAAB@B@BA@@A@@@AAAAABAA@BA@A@@@A@A@DB@B@B@@@@AC@AC@A@A@AA@A@A@@@@A@@B@@A@@A@A@C@@@@@@@@@@@@A@ABA@@@@@B@@@@@@B@@@@A@CB@@@@@@@@A@@@BDAB@@@D@@@@A@@@@@A@@A@@A@@@@@@B@@@@AB@@@@A@A@@@@A@@AC@@A@@@@@@@@@@@@@@@@@@B@@@@BBA@@B@A@@A@@@@@@@@@@@@A@@@AC@CBA@B@@@@@@@@@@@@B@@@@B@@@@D@BD@B@@@@@@A@@@@B@B@@@B@@BBABB@@@@ABABAB@A@@A@A@A@@@AAA@CA@@@A@@C@A@@B@@A@A@AAA@@@AB@@A@@A@@@@@@@AAB@@@@C@@AB@@@@AAA@CCEBA@@@@AA@@@CA@@A@A@C@@@AB@@@@@B@@@@C@@@A@A@@C@@@@ABACCA@CAC@@@AA@AB@@@@@@AA@E@A@@@@@@B@@C@AB@@AB@@AB@BA@@@@@@@A@@@@B@@@@@B@B@@CAA@@@@@E@@@@@@@@A@A@AB@B@@A@@@@@@@@@A@@@A@@@@@A@@@@@@@@@AA@@@@@@CB@@@FCD@B@DA@@B@B@@@@@@@@@@@@@@@@CD@@@@@B@BB@@@@@@@@B@@@@@@@@B@B@DB@B@B@B@BA@@B@AC@@@@@@@B@@@BA@@A@A@AB@AC@@@@@@@@@@@@@AACBA@A@@A@@@AA@@@@BAB@@AA@@@A@A@@AA@@AA@AA@A@@AA@BA@A@@AA@@@@@@BAB@@BBAB@@A@AAAA@@@A@A@@@AB@@A@A@@@@@@A@@B@@@B@@@AA@@@A@@@@@@@@A@@@@CB@@BB@B@@AB@@A@@@A@@B@@@@@@@@@@@@@@@@@@@A@@A@@@@ABAAAA@A@@@@@@@@@ACAI@A@@AA@AAAAA@@A@@AA@@@BAAAA@@@A@@@CA@CCA@@@@BC@@@A@@@@@AABA@A@@@BA@@AA@@@@@AB@BA@@@@B@@A@@B@@AB@BBB@@AAA@@@AB@@@@B@@@@@A@@@@A@@C@@@@A@AB@@@@@@@@@@@AAA@@AAA@@@@@C@@@@A@@@@@@@@@@B@BA@A@@@@B@BA@@B@@C@A@@@@D@@B@@B@@@@@AB@@@@@BB@BB@B@B@B@@B@@@@BBB@@@BA@@B@BB@@@B@D@@@B@@A@A@@@AA@BA@@AAA@@@@@AA@@@C@@BA@@@B@@@BBBBBB@@@A@AA@@@A@AB@@@@AB@@@A@@@A@A@@CB@@AA@A@@ACEEC@@C@CA@@@ABCB@D@CC@@@AA@@@C@@ACAA@@@@A@AA@A@A@@@AA@@CC@@@@BA@A@@A@@A@A@@B@@A@A@AB@B@D@B@@@@@@@@@@BBBBB@@B@B@@@@AB@BAB@@B@@CB@D@@@@A@@B@@@B@B@@B@@@B@BCB@@@AA@@@@@@A@@@@@@@A@@@BADEB@BAB@@B@BB@@@@A@A@@B@@@@B@@B@@@@@B@@@B@@@@@B@@A@@BA@A@@B@B@B@BB@@@@@@@A@@BA@A@@@A@@@@@B@@@@B@@B@@B@@@@@@D@@A@@@B@@@B@@@@A@AB@AA@@A@@@A@AA@@@A@@@@@@@BA@A@AAAABABBB@@@@@@AB@@@@@@B@@B@@@B@@@@A@@@@@@@@@@B@@@B@B@@AB@@AB@@BB@@B@@@@BB@@@@@AAA@A@@@@B@@@@@BD@@@A@A@A@@@@B@D@@@B@@@@@@@@@@A@@@@B@@@@@@C@@@@@@@@BBB@@@@@@B@@C@@BA@A@BB@@@BB@@BABB@BDA@@B@BAB@@@@@BAB@@BABA@AB@@A@@@B@@@@@@B@B@@@@B@@A@@@A@@@@B@@@@@A@@@@@@ABB@AB@@@@BA@@B@@@@B@BAB@@AA@@@@A@@BB@B@B@@BB@@@@@BA@AA@@ABA@@B@BB@@@BA@@BB@BA@@@@@EBA@@@@@@@AACBA@@@CBA@@B@BA@@BA@@@@BABA@A@@@C@@A@BC@C@@BA@@@A@A@@AC@A@@@@AA@@@A@@@AA@@B@@B@@@@@@@ABA@A@@C@@@@@A@A@B@@@@@@@@@@A@@D@B@@@@@D@DB@@@BDB@@B@@@B@B@@B@@B@@A@A@@A@ACA@BABA@@B@@DBB@DAB@@B@B@@@B@BA@@@C@@@AAA@@@@A@@@@@@@@@@@B@@ABA@AB@@@@C@CAAA@@@@@@@A@@@A@@AAA@@@AB@BA@@@A@AA@@A@@ABCB@@A@@@@AAAAA@@@@AB@@@B@@@@A@A@@@@@@B@@@B@B@D@@@B@@@BAAABA@@@@@@@@@@@@@@B@@@BB@@ABABAB@B@@BB@BB@@@BBBD@BAB@@A@@BD@@B@@@@B@@@BA@C@@@CA@AA@@@AB@@A@@@@@AA@@@@@@BA@ABAC@@@@@AB@@@B@@@@@AA@@BC@@@@BBBB@@@@@B@@BB@@@A@AACA@@@@@@A@@@AB@B@@@BBDA@@AAAB@@A@A@CA@@A@@BA@@B@@@@@@A@@A@@ACAA@@A@@B@@A@@@@A@A@@@@@CB@@@HBAD@BB@@B@A@A@A@ABADA@ABBB@AD@@@B@B@BB@B@@@@@A@A@@A@A@A@AB@B@B@@@@AA@@A@@@@@@@@@@@@@@BABA@@BA@@BA@@AA@A@@DA@@BABA@@BBB@B@@A@A@A@@A@@@@@@@@AB@@@AC@A@@A@A@@A@@@A@A@A@@@@A@@@BA@A@@A@ABA@@@A@@@@BABAAA@@@@@@BA@@B@@@D@@AB@BA@BBBBBA@@@C@@@A@C@@@@B@@@@@@@@BD@@@BB@D@@BB@@@@@BA@A@A@@@@B@@ADA@@@@A@@A@@@@B@B@@B@@@@B@BDD@@@@@@CB@@@@@@@@AD@@AB@@@AA@@@A@@@@@@CA@@A@@@@@A@A@@A@@@A@C@C@A@@A@A@A@@@AB@B@@@@B@BB@@B@@BAA@@AB@D@B@@AB@@C@A@@@@@@BA@CA@@@A@@@BA@CB@@@@A@@@AA@@A@@AA@@@BA@EBA@@@@@AAA@A@@@@@A@A@AAA@@@@@@@A@C@@@@@@@@@@@@@@@@@@@@@A@@@A@@@@@@@@@@AA@@@@A@@@@@@@A@A@A@@B@@@B@@A@@B@@@B@@@AA@@@@@@@@@@@@@@B@@@@B@@B@@@BAAA@@@AB@B@AC@A@A@A@E@A@CA@EDC@@@ABADAB@@@@@@@@ABA@CBA@CACAAAC@C@A@CBAB@D@DCBADEHCDAB@@ADCBEBCBCB@BAAAA@@AACC@AB@@@AAAA@CAA@@A@AA@A@@@A@AA@A@@@@B@@@BAAA@@@@@@@@@A@@@@BA@AA@@@B@B@BC@@A@C@@AB@@C@A@C@A@C@@@A@@A@CA@@AA@@@A@@@@A@A@@A@@@CB@@@B@@BB@BAB@B@D@@A@@@BA@@@A@C@AAA@@@AAAA@@@BA@@@@A@BACA@@@A@@@A@A@@@AACBA@@BA@CA@A@@AA@A@AA@@@@@@@AA@BA@A@AB@@@BAAA@A@ABIB@@@BA@@@ABA@BB@@AB@B@BA@@@@@@AC@@@A@@CAB@@AB@@@AA@A@@@A@A@@@A@C@@@@B@@C@@A@@@@@@AD@@@D@B@BB@@@@CA@@A@A@@AB@@AB@@@B@@@@AA@@B@A@@BA@@@AB@@@BA@@BBB@@AA@A@A@@@A@A@AB@B@@C@@ABA@A@@@@@@BA@A@@@@@@@AB@@@@BB@B@AA@AD@@@B@BBB@@@@C@@C@@AAA@ACGAA@A@@@ACA@@@A@@@@@@@@@AAAAAAA@AA@AA@AC@@CA@@@BA@@B@@@@@@ACAA@@A@@AA@@ACAABAAA@@A@AAACBABACC@@A@@@@@@B@B@B@@AB@@C@A@@BA@@B@B@BCD@BB@@@B@BB@BBD@D@B@@@@AB@@AB@@@BA@@@EDA@A@@@ABA@ABA@A@A@@@A@@@@AQA@@@A@@A@AB@BA@AAAB@@AAAA@A@@CA@@ABABCB@@A@@AA@@@AAABA@@@@@@A@@@A@@@@A@@@AA@AA@@@@B@@C@@A@@C@AAC@@A@@@@E@A@A@@@@DA@@@AB@BA@@A@@A@@@@@@@@@AA@@@BAD@@@@A@A@A@@@@@@BCJAFBD@@B@B@@AB@@D@@B@B@B@@B@B@BBB@@@@BBB@@@@B@@A@AA@@@@CD@@AD@@@@AB@@@@@PABBD@B@@@@@@@@@@@D@@@@@@@@D@B@B@B@@@D@@@B@@B@F@BA@@BABB@@B@@@@@@@B@BD@DHBDBB@B@@@@B@@BBBB@B@BB@@@@@@@BB@@@@A@@@@B@@@@@@BAB@@@BB@@@BB@D@@@BBBGXA@@@A@A@@@@@@B@B@BBB@BBBBB@@@@BBA@@B@BB@@B@@@B@@ABC@@B@@@@A@@@AAC@@@@@@@@BC@@@@@@@@@@@@B@B@@GD@@AA@A@@B@BA@@@@@@@@@@C@AAA@@@@A@@@B@@A@@@ABB@@@BB@@BB@B@@@@@@@@@@AB@A@@@AAAA@@@@@@AAB@@@@CFADAB@B@@@@@@@BB@B@@@@@@@@@HE@BB@BB@@@@@@@@@@@@@@@@@@DA@@@@@@BD@D@@@DB@@B@B@@@B@@@@@@@@@@@@A@@BA@@@@@A@C@ABA@@@@A@@AB@@BD@BAAA@@B@B@B@B@@@A@@C@CD@@@B@@A@AB@@@BA@@B@@@BA@@BAAAB@@@B@@@D@@@@C@C@A@ACA@BJB@BD@B@@A@@@A@CD@B@@ABC@A@@@AA@@A@@@@@AB@@@A@AE@AB@A@A@@@@A@A@@@@@@@@@@B@B@@C@@AA@@A@@@@@@@@A@@@@@@@@B@B@@@C@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@AB@@C@AB@@@@AA@@BA@@@@@@A@@@@@AB@@A@ABBDGBA@@@BF@@@@D@@@@@D@@@@B@B@@@BAB@@@@@BAB@@C@@@A@@@@@A@@A@@A@E@C@@@@@@@@@@@EA@@@BC@BAAAAB@@@@AB@@CC@@@A@@A@@A@@@AA@A@A@C@AB@@@@@@@@A@A@AA@B@AA@A@A@A@@AAABAA@@@@@A@@@@AB@BBB@@@@CA@@@@BA@@A@@B@B@@AA@@@A@@AABA@AA@@A@@@AA@BA@A@A@@@C@@@@@@@@@@@@@@A@@@@@@@@@@EA@@@@@BCBA@ABA@AB@@@@@@@@BDABAA@@@B@@@B@@A@@@@A@@@B@@AB@@A@@@A@@@@A@@@@A@@@@B@@A@@@@@@@A@@@@BC@ABA@@@@B@@ABA@@@@@@@C@CBAB@@@@EEA@A@EA@@A@A@CAE@A@A@CACB@@@BA@@@AAA@AABGBC@A@C@@A@@AA@@@@@@@@@@@@A@@A@@@@@@@AA@@@@CC@ACCA@@@A@A@@BCB@@@@@@CAAA@AB@AA@@ACB@@AA@@A@@@A@@@A@@DC@A@ABA@@@A@@C@@B@@AC@@@@ACCG@@@@B@IGAI@@@CC@A@A@A@@AAAA@A@@AB@B@B@DCAA@@BA@@BA@@EK@CAAA@@B@BIB@@BD@AA@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@AA@@@@@@@@@@@@@@@A@@@@@@@@@@@A@A@A@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@E@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@C@@@A@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@A@C@@@A@@@AB@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@AB@B@@@B@@@@@BB@@BB@ADA@AAA@A@@A@@@AC@@@@@@@@@AA@@@@@A@AAACAA@@@@CAC@@B@@CC@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@AA@@@@@@@@A@@@@A@A@@B@@@A@GGB@@@@@@AA@@@@ABABA@@BABA@AA@B@@C@A@@@A@@A@CBAAA@@@@@@@BABC@A@@B@@@BB@@D@BA@@DE@A@@@A@@@AB@ACBAB@@C@ABAB@BEB@BA@@@@@C@@B@@@@@@@B@@A@@@@@@@@@@AC@@@@@@@@@@C@@A@A@A@A@@@@@A@@AA@@@@AAC@@A@@@@CAA@@A@@@@@@@@@@@@@@A@ABC@@@@@@@@@@@A@@@@B@@@BCBB@@@ABABA@ABBBB@BB@@B@@@@@BB@@BB@@@B@B@@AA@@C@A@@@@@@@@@@@@@C@A@@@AB@@@B@@@@AA@@A@@B@B@BA@@@@@@B@@@@CDA@A@A@AAA@@A@A@@@AAA@A@@@AA@A@@B@@BD@BA@BB@@BD@B@B@B@@@@@B@@A@@B@@ADABAB@@@@@@@@@@@@@B@@@@@@@@BBBA@@@B@B@@@B@@A@BDIBEA@@@@@B@@B@BFA@AC@@A@@@@AC@C@@BAB@@@@@AC@A@@A@A@@@A@@B@B@@@@AA@@A@AA@AC@@A@@@AC@@A@AA@BA@GGCEA@@@A@@AA@A@@@@A@A@@AA@@@@A@E@@AB@@@AAAA@A@@@@@@@@@@@@@@AB@@@@@AC@A@A@@@AE@@@@AAB@AA@AAAB@BA@CA@@@@B@@AA@@@@@@AA@@@A@@@AABAA@CAA@@AB@@@@A@@@@@@@@A@@A@B@@A@ABE@@C@@@@A@@@AAAA@@B@@OCBA@C@C@@C@CAA@AB@@@@A@C@AAAAGC@@@AAACBAAAA@@ACCBBB@@@@@@BBAB@@A@@@@B@@@BB@@D@BA@A@@@ABA@BB@@ABA@@AA@@CC@@HA@@@A@A@@@@B@@@B@BA@@@A@@@A@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@A@@B@@@@@B@@@@@@A@@@A@A@A@A@@@A@@BA@@@@AA@AA@@@@A@AB@@C@@@@@A@@@@@A@@@A@@A@@A@A@@@@@A@AB@@AB@@AB@@@BA@@@@@A@@@@@A@@@@@AA@@A@@A@@AAAAA@AA@@AA@@AB@@A@CA@@@@CA@@A@CA@AA@@A@C@A@@A@AA@@A@AAAAA@A@@BA@@B@B@@@BABAB@B@F@DAD@@"],encodeOffsets:[[112329,21514]]}},{type:"Feature",id:"440882",properties:{name:"雷州市",cp:[110.096586,20.914178],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@UBA@qDSBUD]DF`C^ELCHGHWHWHQDQDMFIFEFGHGFAFM\\GZ@DAP@FBLBb@PEPIVEPGJKTKHSFM@kIEBCFCRANMvCVCvAfERCNAFYKXKRMXGRO^EJ[xGZGZC`BtFz@VFfFp^@`@@@BA@@BC@C@E@ABABA@A@@@A@AB@@AB@B@BBBBB@@@BBB@@@@B@D@BB@@BDBB@@@DB@@@@DBB@@@BA@@BB@@BBB@BBBB@@@BB@@@BB@@@@B@@@@@B@@@@@B@@A@@BA@@BA@@BAB@@@@@B@B@@@@BB@@@B@@@@@B@@@@@D@@@BAB@@@@@BBB@@B@@B@@AB@@@B@B@B@B@@@B@@@@@@@@A@@@@@AB@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@B@@@B@@@B@@A@A@@@A@@B@B@@@B@@GD@@DB@@BB@BA@@AAB@BA@@B@B@@A@CA@@A@@@A@@B@@@BAAA@@@@@@AADABD@@BBBBDABB@B@@HDBBBBD@B@@@@@BAB@DBD@@@@D@DABPD@@@AB@BB@B@@@B@@D@@@AF@B@BA@B@@@@B@@@@@@B@@@@@BA@@BB@DBBBA@B@@@B@@BB@@@@@@BB@@@A@@B@@DABA@BB@BBBA@BB@@@@BF@@B@B@D@@B@@@@BA@@@@@@@@@@@@@@@BBBBB@@A@@BF@B@@@@@BB@@@B@B@@B@B@@BB@@@B@DFHHB@@ABBB@@@BD@@B@@@BDB@@B@BB@@B@@A@A@@@@B@@@B@BB@D@@B@@@@BA@AD@D@@B@@B@@@BDB@AEA@@@@A@@@@FBJAACB@@@@A@@@A@A@@ABAA@@@@@@@@@A@@@@@@@@@@@@BABABC@@@AB@@@@A@@@@@A@A@AAC@@AAB@@AAC@@@AB@B@@B@@@BBB@B@@@B@BB@BBB@B@B@DC@@@@@A@@@@B@@A@A@AB@@@BB@@@@@A@@BA@@B@D@@@@@@@@@@@@@B@D@@@BB@@@A@A@@AA@@AA@@@@A@@@AAA@AABAB@BABA@@A@DA@A@@@A@@B@@@@@@@@@@@D@BAB@@@@@@@@@@@@@@@@BB@DB@@@@@BD@BB@@@@BB@@@B@@@@@B@B@B@BD@@@@@@@@@@@BD@@@@@@@@@@@BA@@@@@@@A@@@@D@@@@ABA@AFA@AB@B@DA@ABBDA@@B@@@B@@@BCF@@ABC@@@AA@@A@@@@BADAB@@@@@@B@BBDAB@@@@B@@@B@DA@B@@BABAB@@ABAB@B@@B@@B@@@@A@HHB@@@A@@@@B@B@@B@@@@@@@@@BB@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@DD@@@AD@DB@@@@BBBDBBB@@@@@B@@B@@@@@@@@BD@@B@@@@BBB@BCB@BAA@@AA@@@@A@@@A@A@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@BA@@B@@@B@@@D@B@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@B@@@D@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@F@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@B@B@B@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@B@@@@@@@@B@@@@@@@@@@@@@B@@@@@B@@BAC@@JA@A@AB@BB@DFL@@AB@@AB@@BBCDA@A@A@@BB@B@BB@BB@B@B@D@@D@@BJJHA@@@@@DHBD@@@@BD@@@AD@@@@B@@AB@B@BCD@@@B@@@B@@@BB@@BA@BD@@BBA@@BBBDB@@@@@@DA@AB@B@@@B@DD@BDD@@@@BB@@@@@@B@@@@B@@@@@@@@@@B@@BB@@@@D@BADAHBBB@BB@@B@@A@@DADBB@B@F@DBB@B@@@FBB@B@FF@@@@BADAD@@@@@@@B@BA@@@A@@B@BAD@@A@@B@@@@@@@B@@@@A@@B@@@@@@B@@B@@@B@@@BA@@@A@@@B@@B@@@@A@@@A@@BBBAAC@@@@@@@@BAB@BAB@DA@A@@@@FB@@@@@@@@@@@B@@@@@@@@@@@@D@@@B@B@B@@ABB@@B@@@BBB@BA@BB@@@B@@BA@A@@@@BB@@A@@B@@D@@A@AAA@@B@@B@@@@@B@ABBB@BB@B@B@B@@B@ABBB@B@@@@@@@@@BAD@B@B@B@@B@@@BB@@@@B@@DD@@BA@@@@BABBABD@@A@@FB@@@@@@@@@@D@F@B@@@@BB@@@@@B@@@D@@@BA@A@@@@BA@A@@@A@A@@C@@@@@C@@@@@AE@@B@HAACBAB@@@BA@@@@B@@@@@@@AB@@BB@@@@BAD@@@BA@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@D@@@A@A@@@@@@B@@@@@@@@@@BB@@BD@@@@A@A@@@@@@@@B@B@@@@@@B@BBAF@@B@B@@BA@@@@B@@@BB@@B@D@BA@@@ADCB@@@B@@@@AACA@AIB@BDB@D@D@@@@@@C@@@A@@BABB@AB@@A@@@AB@@A@@BAB@@@@A@@DCD@@@@B@@@A@A@A@AB@BB@AAC@@BA@@@B@@B@BAD@B@@@@@B@@AB@@@@@@@@@@@@@@A@@@A@AA@@C@@@CAC@@@@@@CB@@@@@@@@@@@@@@@@@@AAA@@AGF@@@@@@@@A@A@@A@@@@@@@ABABCDE@@@@BA@B@@@@B@BB@B@@@BBA@@@@@@@@@@@AAA@@AA@@A@BA@@B@@@@A@@@B@@B@BBD@@@@@@@@@@@ABA@@@@BBB@@HC@@@A@A@@@@@@@@@@D@@A@@@@@@D@BB@@B@@@@@@AD@BA@@@A@@@AA@@A@AB@AA@@@@AAAA@AAA@A@A@A@@@@B@B@@@B@HWAA@A@@@CAA@@A@@A@@BA@A@@@@A@@@@@@B@@A@@A@@@@@@AAA@A@AA@AA@@@@@@AAAACCGC@@A@A@@@@@@@AA@BA@AB@@A@E@AA@@@C@@@A@A@A@C@@@@@@@@@@C@@@@@@@@@@@AACBA@O@@@@BA@@@@BC@@DC@@@@BBB@@@@A@@A@AA@@@@AA@A@A@AA@A@A@@@@CA@@BA@A@@@ACBEDI@A@@@@B@B@B@@@@@BC@A@@BB@@@@@@@@B@@@@BB@@ABA@@B@@C@@B@B@F@@@@@@BD@BBD@@@@BD@@@@A@@B@@BBB@@B@@@@@@B@@@B@@@@B@BABB@@B@@BB@@@DABABA@@DB@@@BBBBB@@BABBB@@ABAB@@@@B@@RB@B@@B@@@B@B@B@BAB@BA@@B@B@FC@@B@@A@@BA@@BA@@@@@A@CAC@AAAA@@@A@@ADC@A@A@AB@@AB@D@@@BA@@@A@A@A@@@@B@BAB@B@B@DA@@B@@CBA@@@@BBBBBB@@B@@@@@BABA@ABC@@@A@ABADCB@B@D@D@BBD@BBB@B@BABABA@ABA@AB@@@@@BBADBB@B@@B@@A@A@G@ABABABC@A@@@CAC@AACBCBA@@B@@@D@F@DABCFCPEA[@AAOEY@C@SBKBO@OBCLYDEDE\\ePORAR@L@PGDAXQFCREFATLLDNBR@d@H@L@hKHALCPG^SJELEHEHYHUFSBKD[@G@g@QCUMcMioF]AKIGO@CAKAEAECICGAC@IDE@C@ACCAA@C@@BCBAA@@AA@AA@@@A@@A@@CA@CCACCEA@IE@@@A@AA@CCACAAECAACAAACA@@@@@A@@@AA@ABAB@@@BABA@@@CA@@@@CAA@BAACA@BB@B@BAB@@AB@AA@@@AA@@A@@@AAA@@BCA@A@AA@AACABDA@C@AAA@@@B@BBB@@BA@A@@AA@AA@@AAB@@AAAA@@@@AAA@AAAAAEA@@ACBACA@@@@AC@A@@BABA@@@CA@@C@AAA@AA@CCAC@AAACACBBF@B@HCDABBBDC@@B@ABCDC@@@B@@AAA@@@@@C@@BAAAAC@BABAAA@C@@A@@@@@A@A@@@A@@CEC@EH@B@BDF@@AACC@CKEABC@CC@@F@@AE@AAGBKB@ABECBK@@FCBCBC@@AB@@@B@B@@AA@@A@@A@A@AA@A@@@@@@@@A@BAB@@@AAAB@@ABA@BCAAA@@EA@@@@CAABCBB@CI@@@@@@@@EC@A@C@@EH@F@B@@@ACA@CBAA@AA@A@@B@BA@@A@AA@@BA@BABAB@B@B@B@@AAAEAA@@DAB@@@@@B@@C@@@@BA@BD@@CCAAA@AB@B@@CC@A@@A@A@ABAC@A@E@@AABE@AACA@@@C@AA@AE@AB@D@BGBIBC@@@@@A@MBACACGBMBAC@AA@GDBD@DA@A@CABH@BDB@B@BABABAB@@@LCB@B@@@B@@B@@@@@@@@B@B@@B@@@B@@@B@@@@@@BA@A@CA@A@@ADCD@BE@ABC@CAEEECGAA@GEGG@C@@DCDECE@AFAF@BBJAJABAAE@A@EACAA@@DA@@@A@@A@C@C@CCIECCEEAF@@ADA@A@GCID@@@@@BABAAA@@@E@CA@ABE@@AAA@@@AA@@BA@CABA@A@@@AC@AB@FCBABAH@LA@@AI@@A@C@A@@A@ACACAAC@@DBDB@@DB@A@A@@AB@@@AAAF@B@@@BCB@AIAI@@@EC@A@@A@AA@@@A@BAAAA@@BC@@A@@ABA@@@AA@@C@@A@@F@@@BBBA@CDBDAHA@@@@@A@@BAD@F@@C@@B@@A@CB@B@DA@@BA@AA@A@EACA@@AB@A@A@A@@@A@CBAAADABA@AA@A@CA@AA@CACBAABA@@C@@@KAAAAAA@AAAAAABEB@@CCAA@@@@AAAC@C@@A@@@A@@A@@@@@AAAA@AAACA@B@B@B@@AB@@@B@BB@@BAFA@AB@@@@@BA@A@@@CBA@@A@@E@ABA@A@@BCB@@AB@BCAABAA@@A@@BC@ABA@@@AA@@AAAB@BA@AAAAC@A@A@A@AA@@AAABABA@@@CACCCE@AAEACAC@AC@GAQ@OAUB[@"],encodeOffsets:[[112607,20914]]}},{type:"Feature",id:"440883",properties:{name:"吴川市",cp:[110.778411,21.441808],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@CJ@B@@@BHDBSA@@BA@A@"],["@@@@@ADIAA@@@@@@@@@@@@@@@@@@A@@BAB@B@@A@@AA@@@AC@@@A@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@B@@BBB@@@B@@A@@BC@C@@@ACA@CC@@KAA@@@@@AC@@@@@@A@@B@B@BB@@D@@@B@@@AB@@DBB@B@@@@@B@H@DB@@B@B@BAB@@@@@DAB@B@@@B@@@BBBD@@BB@B@B@BA@A@E@CB@@@BA@@@ABA@A@@F@@@@@@ABA@@BA@A@ABA@@@@A@@@@@@A@@@@B@BB@@@BB@B@@A@A@@AAAB@@@@AA@@@@@AT@@FBHAFCDCDBB@B@@BA@@BAB@@@BB@@@@@@@@@@@@@@@@@@@@B@B@@@@AB@@DBFBB@@@DB@@@@AB@@A@@@@B@@BB@BA@@@AA@@ABAD@@@B@@@B@@@@@BC@C@AB@@@@@DD@BBB@@BA@@@@@@B@@@@A@@@@@@@AA@@A@@@@@@@@@A@@@@J@@@B@@BBDD@@@B@B@FCD@@AD@@@@BB@D@@@@@@@@@BBF@B@BB@BBF@DB@@@@@@@D@@@B@@@@@@DBB@@D@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@B@DBBB@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@B@B@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@B@D@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@ABA@@@@@@@@@ABAB@@@@@B@@@@@D@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@ABA@@BA@A@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@AB@@@B@B@@@BA@@@AB@@BB@@B@@D@BB@BB@@AB@@@@BBB@A@@@@@A@A@@@A@@@@B@@B@@@B@@@@BAB@@A@BB@@FDHDBD@@BB@BBDFB@BB@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@@B@@B@ABAB@@B@@@@@@@@@@@@B@@@@@BD@@BB@B@@BB@B@D@@@D@B@@B@@AB@@@@B@@A@@@@@D@B@@@DAD@@BB@@@@@@@B@B@@ABABA@@@A@@B@@@@@BCDABA@@@A@@BA@@DA@@B@B@@@@@B@@@AB@@@@@@B@B@@AB@BAB@@@@@@@@@@A@@@@@@@@@@@@AA@@AA@@B@@@@@@@B@@AB@B@@@@B@@@@B@@A@@@ABA@@B@@@@@@BBBFA@@BB@BA@@@@@B@@@D@@@CA@A@@@AAA@AA@B@@@B@@@BBB@BD@@@B@@@@@@B@ABB@@@@A@@@@@@@AAA@A@@CA@@@C@A@@@@@@@@@A@A@@@A@C@@@ACA@@@@@@@@@@@A@AAC@AAC@A@A@@@AB@@@@@BA@AAA@@@@BAB@@B@@B@AA@@A@A@@@@A@A@@B@@@BB@AAA@@@@B@CEAAACA@@@BA@A@AAA@@@@B@@@B@@A@@@AAA@@B@@@BA@C@@@AA@@AB@@A@@@@C@@A@@@A@@@A@AB@@@@@B@@B@@DB@BB@@@@A@@D@B@B@@@BBDADBH@@@DBBA@@@ABCB@@@B@@@@ABC@A@A@AAA@A@C@A@@@@@@@@@@@ABAB@DABA@AB@@@BABCBA@@CACABGAAGCA@AA@AB@BB@G@ABA@@BB@@DA@A@@AAA@B@@ADBBAB@@D@@B@B@B@@ABC@@@A@@B@@@D@@@BA@@@@BA@@B@@@BA@@BADCBAFC@@BADA@@@CBA@BBB@@@BA@AB@@DBB@@@@@@@@@@@BA@@@AAAAA@AFEB@BA@@@ACACE@@@@BAB@@A@ABABA@@BA@@BAB@B@@@@AAAC@@@A@@A@AFA@@@A@@@@@@@AA@AA@AA@A@CAAAB@B@@@@@@@@CA@@B@@@AA@@@@@@@AB@@A@EAAAEEB@DAD@DA@E@ABCB@B@BDAB@BA@@BAD@@A@BBB@BBBD@@BB@@@@BB@@@@@@BB@@@@BA@@BA@@@@AA@@@A@@@@BA@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@BA@@@@B@@@@@@@@@@@@@B@@D@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@A@@@@B@@@@@@@BBA@@@@B@@@@@@@@@@B@@@@@@DD@B@@BBDDBDBBB@@AB@D@@@@@@AAAAA@@@@BA@@BA@C@AB@BBDABB@@B@@ABA@A@@@A@@@@A@@A@@@@@@@@AA@@@@@A@@@@@@B@@@@@B@@@@A@@@@@A@@@@@A@@@AA@@@@@CC@A@@@@@A@@@@@@@@@@@@BA@@@@@A@@@@@@B@@@@@B@@@B@@@@AA@@@@A@@@@@A@A@@@A@@@@@@@@@@@@AA@@A@@@@AAAAA@@@AA@@@AA@@A@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@B@@A@@@@@@B@@@@@@@B@@A@@@@@A@@@@B@@@@@B@@@@@BB@@@@B@@@@@@@@@B@@A@@@@@@B@BABDDB@@B@@@BA@@BC@@DA@@DABA@@BA@@@@DCB@BBB@@@@E@@AC@@@A@@A@@@@@@E@ACA@@@B@BB@@@@B@BAB@@A@@AA@@A@C@@@A@@ACAAAAA@@@AAA@@AA@A@AB@@A@CA@@@A@@B@@A@@@A@@@@D@B@@@B@@@@@@@BA@@DEF@DA@@DC@@AAA@@A@ABC@AB@FB@@BA@A@CB@DABD@@@B@@@BB@B@@@BD@@BD@B@@BAB@@@@@@B@@@@@@@@@@@DBA@B@@B@@B@@A@@B@BBB@@@@@A@A@@@@B@@BB@@@B@B@@@BB@@@@AD@@BB@A@ABA@@BB@@DB@@BB@@DAB@BAD@B@@B@@ABAB@@@B@@BDB@@B@@@@DB@@@@@@DA@AB@@AB@@@@@@AB@@@B@FEB@B@BB@@B@B@@@@@@@@@B@@@DBD@D@@@B@@DBBBB@@BBBBBBB@@@@@@@AC@AA@A@@A@@@A@@BAB@@ABA@@@@AA@@AA@ABABCD@@@@BBB@BBD@B@@@@ABCAAB@B@B@B@B@@@BBBBB@@B@@@B@@@@@@@AA@AAC@@@@@A@A@@@@BAB@@@@@@B@@@BB@@@@@B@@A@A@CAA@A@A@E@@@@@@BAB@B@@@@@BA@A@@@A@@@A@ABA@A@@AC@@@ABE@A@@B@@@D@B@@@BADIHQueGCcSkMgCQ@DFaDE@WCaOIGMKGEIEEEECWYaeU_OUKQOSky]Y@D@P@H@F@F@J@FABAL@H@HCHAHAJAF@H@@@@@@A@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@A@A@@@@@CACB@@@@@@@@A@A@@@AB@A@@A@@@@A@A@@A@@@AA@@BAAAAA@@A@@B@B@@A@A@@A@@@A@@CBA@@B@@AA@@@@@A@AAC@@AA@AA@C@@C@ACAAAAAAA@@BA@A@A@AA@@AA@AAA@C@@@@@E@@@@@@@@@AA@AA@CBAB@B@BAB@@@@AACBEDCB@DB@A@AB@@@BB@@@@@AFA@@@@@@AAA@AAA@@@@A@@@ABAB@@@@DBKF@@AA@@@@@@@@@@@@@@ABA@@B@BCDCBA@@@@@A@CBEB@CA@ABCBEDED@@EDA@CB@B@B@B@BAAAAA@@@@@@@AB@@@B@@@@@@@@@@@@CA@@@@@@@@EA@@A@@@@@@@@@CDCBCB@@@AA@@@AB@@@BAB@@@@@CA@@AC@ABC@@@AB@@B@@@@@@@@@@@@@@@@@@@B@@BB@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@DBBB@@@BBD@B@@@@@@@@@@@@@@A@@@A@@B@@@B@@B@@@@@@@B@@@@@@B@D@@@B@D@@@@BBB@BAD@BBB@@BB@BDB@@BBF@@@@@@@@@@@@A@EB@@D@@BB@@@B@@B@BB@@@@@DD@@@A@@AC@@@@BBDDB@@@@BBA@@@@@AB@@@@A@A@@@AB@@@@@@@@@@@@@@@B@@@@ABA@@B@@@BB@@@@B@@@B@B@@@@@DBABA@AB@@B@@B@@@@@@@@@@B@A@A@AB@@@@@@@@@@@@B@@@@@@@@@@@@BB@@B@@@@A@@B@@@@@@BB@@@@BB@@@@B@BA@@@B@@@@@@B@@@@@D@@@B@@@@AB@@@B@@@@@@@@BB@@BB@@@@B@@@@A@@@A@@@@B@@@@@@@@B@@@@@B@@@@@@@@@BB@@@@A@A@@DA@@@@@@@@B@@@@@B@@A@@@@B@BBB@@@@@@BB@@BB@@@B@@@@B@@A@@B@@@@@@@@@BB@@@@@B@B@@@@@BBB@@B@AB@@BB@@@@@@@@B@@@B@@@@B@B@@@@@@@B@@@@B@@@@@@B@@@@A@@@@@A@@B@@@@B@@@@B@@@@B@@@@@BBA@@@@@@B@@AB@@AB@@@@AB@@@@AB@@AB@@@@@@@@@@A@@@@@A@@@CD@@@BA@@@@@@@@@@@@@@BB@@@BB@@@@@B@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@B@@@@@@@B@@B@@B@@@@@AB@@@@@@@@@B@@A@@@@@@@A@@A@@@@A@@@@@A@@@A@@@@@@A@@A@@A@@@A@@A@@@@@@@@@@@AA@@A@@B@@@@@@@@A@@@@B@@AA@@@@@@@@@A@@@@@@A@@@@@@@@@A@@B@@@@AB@@A@@@@@@@@@A@@@@@@B@BA@@@@@@@@@@@A@@@@@A@@@AB@@AB@@@B@@@@AD@@@@@B@@A@@B@@A@@@@@@B@BB@@@@@@@@BA@@@@@@@A@@B@@@@@@@@@@@BB@@@@@@BA@@B@@@@@@A@@@@@@@@B@@@@@B@@@BAB@@BB@B@@@@@@@B@@@@A@@@@@@@AD@@@@@B@@@B@@@@B@@@@@BB@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@A@@@@B@B@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@B@@A@@@@@@B@@@@AB@@ABAB@@A@@BAA@@@AAAA@A@@@AA@@@@A@@@@@@B@@@@A@@F@@@@ABA@CD@@DB@@CAA@A@@@A@@B@@@@@@CB@@@@@@@@@@@@@@@B@@@B@B@@A@A@@BA@AB@@@BBBABBB@@A@@@@B@BAB@B@@BB@@@BA@A@@@C@@@@@@@@@@@@BAB@BC@@@@BCBAA@BA@A@@@@D@@@@@@@@BBA@A@@DA@A@@@AA@@AACBA@@AB@@AB@@@AB@@@@B@@@DAB@BABA@@@AA@@A@@@BABA@@@@@@@@@B@@A@@@AAA@@A@A@A@@@@B@@@@@@A@ADA@@BA@@@A@A@A@@@@BDBAD@@@D@@@@@@@@DBB@D@BD@B@B@BBD@@A@@@@@C@@A@@EB@@@@@B@BA@@@AD@B@@B@B@@A@BB@@D@@CB@@@BB@D@@@@@BA@ABA@@@@AD@@F@BB@@@D@@BB@@BB@BA@@B@@@@@@@@B@B@@B@@A@@@@@@B@@@B@@@@@@B@@@@@@@@@BA@@@@@@BAD@@@@@@@@@@@@@@@@@@B@@@@BBBA@@@@AA@A@@B@@@B@@B@B@@@@B@BA@@@@B@BB@@BA@@@@BB@@@B@@@@@@B@@@@@@@BA@@BBB@BB@@BA@BB@@@@BBD@@BA@@D@@@@@@ABB@@@@@@@@@B@@@@B@@@@@@@AB@@A@@@@@@@@@@A@@@@@@@@A@@@@A@@@@CB@B@@@@@BAA@A@@A@@B@@@@BB@@@B@@A@@@@BC@@B@@ABA@@@AB@@ABAB@@@B@@@B@B@@@@BA@@D@@B@@@@AB@@@@@@B@BAF@B@B@B@B@B@@@@CB@@@BA@@AA@@AB@@@ABAB@A@@@CA@@@@@@@@D@@A@@@A@@AA@A@@B@@@B@@BB@BA@AB@D@BAB@@@B@@BB@BB@BDAB@@@AA@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@A@A@@BAB@@@@A@@DA@@DAB@BB@@@@@@@@DB@@@DBD@@@B@@AB@DCF@@DB@@BD@@@@@@@@@@@@@@@@@BEDAB@@@BBD@@B@BB@@@BBB@B@@BB@@B@B@@@@B@D@@B@@@@@@@@AAAB@@@B@@@@BDF@@@B@@BB@@BAB@D@@B@D@D@B@@@@@@BBBBDB@@@@BA@AB@@@BB@B@BBB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BAB@B@@@B@@@@@@@@@B@@@@@@@B@D@B@B@@@@@@C@A@@BC@@B@B@@@@A@A@@AG@AAA@A@CBA@@@@B@@@@@@@@@@@@@@@@@@@D@@A@@B@DA@@@@@@B@@@@@@@@BCB@@BB@@BADBBBBB@@BABAB@BBB@BBB@@DBB@@B@@@@@@@@@@@DB@@@@@@@@BBB@@@@AB@@A@@@@@@@@@@@@@A@@@@@@@@A@@A@@@@B@@@BB@@B@@A@@@@@C@A@@BABA@@B@DBB@@AB@@A@@@A@@B@BA@@B@@ABAB@@ABCBCBA@@@AA@A@AA@A@@@AB@B@BA@@BA@@BAB@B@@BBBDABBB@B@@@@@BB@AB@BAB@@A@@B@@@@B@@BB@@D@DB@AB@@CB@@@@@AD@B@AD@BAB@@@BBD@@@@B@@@B@B@B@B@@@B@B@@@@@@@B@B@@@@@@@@@BAB@@@B@BBB@BB@@@A@@AA@A@BF@@@B@@BB@@@@BA@ABB@@BABB@@@BD@@@@@@@@@AAB@@ABD@@B@@@AA@@DAB@BD@@@B@@@BB@BB@@@A@KAC@A@ABAAA@A@@B@@@@@B@@@@@@@@@@BB@@@@@BBD@@@@@@@BA@CB@@A@AB@@@BBD@@@AB@@A@@@AA@@@@@BB@@B@@ADABB@@BB@@BA@@B@B@@B@B@@@@A@A@@@AB@@@AB@@BB@@BAB@B@DAB@FCBA@@@@E@A@AA@@BA@AB@@A@BDB@@B@B@BABBB@BA@@@@@A@@@@@@@@B@@@@@@B@B@@@@@D@@@@@@@@BB@@DAB@@@BDABAB@@BBABABABAB@BA@@@AA@@@A@BA@@B@B@BB@BB@BAB@@@B@@AB@@AB@BA@@@A@@BA@@A@A@@@@A@A@@DA@@@A@A@@B@@@BBB@@AB@D@DBB@@@@@@@@BB@@H@B@DADAHEDADAF@B@B@B@DBFDDDFD@@DDDB"]],encodeOffsets:[[[113370,22035]],[[113368,22041]]]}},{type:"Feature",id:"440881",properties:{name:"廉江市",cp:[110.286208,21.6097],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@@@BABA@@BABA@A@ABABA@@@@@AA@@@@@@@@@@D@@@@@@AA@A@@A@@@@A@@@@@@@@@@@A@@@@B@@@@CB@@@@AB@@@@A@@B@@@@@@A@@@@B@@BB@@@@@@B@@B@@@@B@BBBD@@@@@@B@@@@B@@B@@@A@@@@B@@@@A@@@@B@@A@@@@@B@@BDB@@@@B@B@B@DBBBDB@B@@@@@B@@@@@@BBD@B@@B@AD@FBB@@BABHFBABA@@D@D@D@D@F@@@BA@A@AB@BA@@BA@A@A@@BA@AB@@@B@B@@@@@@@@A@@BA@@@B@B@BD@@@@AB@@@@@@@@B@BD@B@@@@@AA@@@@BAB@@@@@@A@@B@B@@B@@@@B@@A@A@@@@@@@@@@B@@@B@@@B@@@@A@BA@@AB@B@@@@@@@@@@A@@B@@@@@@D@@BABA@B@@@@@@B@@AA@@@B@@@B@@@@@@B@@@A@@B@B@@@@@@@A@@A@@BA@@@AA@@A@@EC@@CAA@@@A@@@A@AAA@@@@DABABAA@@@@@AB@@AB@AA@BA@@B@DA@@@@AA@@A@@DA@AABAB@@@AA@@A@AB@@AC@@@@@@BB@@BA@@@AA@B@@@@@BAB@@CB@@@A@@B@@ABB@A@@@@@@@@@@@C@A@@@@@C@@@DA@@@@B@B@BAB@@A@AB@@AA@@BA@ABA@@@@BB@@@A@@@@@A@@@@@@@A@A@B@@@BCB@B@@A@A@A@@@A@@ABAB@@@BA@@BA@@@@AB@@AA@@BA@@B@@BB@@@AB@@B@@ABA@@A@@@@@A@BA@@@@ABA@AA@@@@@@BA@A@@@BA@@@@@@@AAB@@A@@@@CADAA@@AB@@@@@@@@B@@B@@@@@@AA@@A@@B@@A@@ABA@@DA@@@AD@@@@AB@@A@@A@@@@@A@@B@@B@@BA@@AA@BA@@@@A@@@AB@@A@@@A@@@@B@@@D@@@BA@@@@A@A@A@@@@AA@@@@@@A@@@AB@@@B@@BB@@AC@@@@@A@A@@A@@@@B@B@@AC@@B@@@BA@@@@B@@A@@B@@@@@@@@@@A@@AA@@@@@@B@B@@@@@AAAC@@@@A@@B@@@@@B@@@@@AAABA@@BA@@@AA@A@@@@B@@@BA@@A@AB@@@@@@@A@@BA@@ABA@@B@AA@@AB@BC@@@@B@@@@B@@B@BC@@AA@@BA@@BB@@BB@B@@ABA@@B@@ABB@@@@A@@B@@@B@@@A@@@@BB@@@@A@@@@B@@@@BB@ABB@@B@A@AB@B@@@BAB@D@@@C@@AB@@@@A@@@@@A@@@@B@@@@@A@@@@@AA@@BA@@B@@A@@@@@BC@A@@BA@A@A@@A@@BA@@B@@@@@@B@@@@@@@A@@@@@@B@B@@A@A@A@@@B@@@BAB@@CA@AAA@@@BBB@B@@ABC@A@@@@AB@@AA@BA@@@A@@AB@@@B@@@BA@@@A@@@@@@ABABA@AAB@BAB@@CA@@@@@@@@@@@@@AB@@@BA@A@@@@AB@BA@A@@@@@@@BAAA@@@BAFA@@@A@A@@@BA@@@@@@CBB@@B@DA@AA@B@D@@BD@B@@ABA@@@A@@@@@A@@BCB@B@@@BB@BB@@@DBBA@@@A@@@@@@AAB@@@B@@CD@B"],["@@@@@@BAB@B@@@B@@@BAB@@@@@@@B@@A@AB@@@@AB@@@@A@@A@@A@@B@B@@@@A@@AE@@@@@B@@@@A@@A@@@@@@@@B@BA@AA@A@@A@@@@@@@@@A@A@@@@@@@@@@@@@A@@@@@@A@A@@@@@@@@@@@@@@@@@BC@@@ABA@A@A@@CA@@@@AB@@@@@A@@@A@@A@A@@B@@@@@BB@@@A@A@BA@AA@A@A@A@@D@@AD@@AB@D@D@@@D@B@@@B@B@@@D@@@@@@@B@@B@@@@@B@@B@@@B@@AB@@@@AD@@@@B@@@BA@@BB@@@BA@@B@@@@BB"],["@@@@@BFD@DB@BBB@D@BAFCBC@CBABC@C@EBCDEDI@C@EA@ACBA@A@CDAH@@@BABABBBABC@A@CBABDBA@ADCB@BBJ@@D@B@B@@@BA@@B@B@@@BBB@B@B@@BD@@BDBBBBD@BA@A@C@@DAD@@@AAFALBDB@ADCBB@BB@F@DCBADBB@@@BB@@@@@BBAB@@@B@@@BD@D@D@BB@B@@AFDD@B@@@BD@B@@AB@BB@B@B@B@B@BA@A@@@AB@HAD@BBB@BBBDDFBB@BBB@BB@@@B@@ABA@@B@AAACD@BAB@@@BBB@@@B@@AAABABABA@@BB@@BBB@@@BB@B@@@B@AB@BAB@@@B@BAD@B@D@B@BBBBBBB@@BBB@B@BBBB@@@BB@@@B@@@@B@@AB@@BBBBA@BBBABBB@BA@@BB@@B@@B@@B@AB@@@B@@B@@BB@@@B@@@@A@@@@@@@A@A@@B@@@DA@A@@@@@@@@@@A@@A@@@@@AB@@@@A@@A@@@@A@@B@@@BB@BD@@@BD@B@@@B@@@B@@@@@@B@B@@@@@BA@@B@@B@BB@@@B@@@B@@@@@@AB@@A@@@ABE@@BA@A@@A@@@A@@AB@@@@@@A@@B@@@B@DBD@@@D@@@B@@@BB@@BB@@@@@BB@B@@BBBBB@@@@@@@BB@@@@@B@B@@@@@@@@B@@@BB@@@@@@@@@B@BB@@@B@B@@@@@B@BA@@F@@@B@@@@@B@@@@@BB@@@B@B@B@@AB@@@B@@@@@@@B@@@B@@@B@D@@@@AB@@@@@@@B@@@@@@BB@@@B@BAB@@@@@B@@BB@@@@@B@@A@@@@@@@@@A@@@@B@D@@@BB@@@@B@@B@@@@@@@B@BB@BB@@@@B@@BB@@@@@@BB@@B@@@BA@@@@@@B@@@B@@@B@@@B@B@B@@B@@@@@@@@B@@@@A@@@@@@B@@@@@@@@@AB@@B@@B@@B@@@@@B@@A@@@@@@@@@B@@@@@B@@@@@@B@@@@B@@A@@@@@A@@@@B@@@@@@BB@@@BA@@@AB@@A@@@@@A@A@@BA@@@@B@@@@@B@B@@@@@@@BB@@@@@@@AD@@@@@@A@@@@@@B@B@@@@@BA@@@A@@@@@AB@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@B@@@@@B@@@BB@@BB@B@@@B@@@B@@@B@@B@@DB@@@@B@@A@@@@@A@@A@@@@@@@A@@A@ABAB@@@B@B@@@@B@B@@@@BB@B@BB@@DD@@@B@@@@@@@BA@@@@B@@@@@B@@@@@@AB@@@B@@@@@B@@@FBB@BA@@B@@@B@BB@@B@@@F@BA@@@@@AB@@AD@B@@@@@@BB@@B@@@@BA@@@@@A@AB@@@@B@@B@@@B@@@@@B@@AB@@B@BD@BB@@@@BB@@@@@@B@B@@@@A@@B@@@@@@BB@@B@@B@@B@@@@@@@@@@@@B@@A@A@@@@@A@@B@@@@ABA@@@@B@@@B@@@B@@BBDBBBBBB@@BAB@@@@@B@@BB@@@@@@@@B@@BB@@@@@@B@@@@@BB@@@@@B@@@BB@@@@@@@B@BA@@D@D@@@@@@@@@B@@BD@@BB@BBB@B@@@BBB@@@BB@@@@@@@B@@BDB@@@@A@AB@@AB@@A@@@A@A@A@@A@BA@@@@@A@@@@B@@@B@@@B@@@@B@@B@@B@@@B@BB@@D@@@B@@BB@@BD@BBBB@BB@@@@@@@@@@B@@B@BB@@B@@BB@BBB@@@BB@@B@@@@@B@@@@@BB@B@BB@@@@B@@@@@@FD@@DB@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@B@B@@@@@@@BB@@BB@BB@@@@@@@@B@B@@@@@@@@@BB@@@@@@@@@B@@@@@B@@@B@@@B@@@@BB@@B@@@@@@@B@BBBA@@B@@@B@@@@@@A@@B@@B@@@@@B@@@B@@@DBB@@@@@B@@@@@@@B@@A@@B@@@@A@@B@@@@@@BB@@@BB@@B@BB@B@@@AB@@@BAB@@@B@B@B@@@@A@@@@@@B@@A@@@@@A@@@A@A@@@@@@BA@@B@@@D@@@B@@@DB@@@AB@@@@@@@@AB@@@BBB@@@BA@@@@@@@@@@A@@@@A@@A@B@@A@@@@B@BB@@B@@@@@BA@@@@@A@@@A@@B@@@@@B@@B@@B@@@B@@@@@B@BA@@@@B@@@@@@B@@B@@BB@@@B@@@B@@@@@B@@@BA@@B@BA@@B@@@B@@@@@B@@@@@@@B@@A@@@@BA@@BA@@BA@@B@@@B@@@@A@@@@@A@@@@@@B@@@@@BA@AB@@@@@BA@A@@B@@A@@BAB@@A@@D@@@B@@@@@B@@BB@@@@@A@@B@@@@@B@@@@BA@@B@@@B@@B@@@B@@@@@@@@B@@AB@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@@@B@@B@@B@AB@@@@@B@BAB@@@@@@@@@@B@@@@@@B@@@@@@@@@@A@ABA@@@@@@@@B@@B@@@@AB@B@@B@@BB@B@@BB@@@@@B@@@@@B@B@@@BA@@@@@B@@@B@@@@B@@BB@@@BA@@@@B@@@@B@@@@@@@B@@@B@B@@@@@@@BB@@@@@B@@@@@B@@@B@@@B@@@@@@@@BBB@@@@@B@@@@@@A@@@@@@@@@@B@B@@@B@@B@@@@@DB@@@B@@@@@B@@@@@@@@@B@@B@@@@B@@BB@BBB@@@B@@@B@@@@B@B@B@@@@B@@@@@@@@@BB@@@B@@@@@@@@@@@@@@@@A@@@A@@@A@@B@@@BA@@@@@A@@@@@A@@@AA@@@@AAA@@@@@@B@@A@@DA@@B@@@@@BA@@@A@@A@@AA@@@@@BA@@@A@@@@@@B@@@B@@@@@B@@@@@B@@@D@B@@@@@@@@B@@@BAB@@@B@@@B@B@BB@AB@@@B@@@@A@@@@BB@@@@D@@BB@@@BB@@@B@@@@B@@B@@B@@@BB@@@B@B@@@B@@B@@@B@@@@@B@@@B@@D@B@@@@B@@@@@@@BA@@BBB@@@B@@@@B@BB@@B@@B@BAD@B@@B@@@B@@@B@BBB@@@@@B@B@@@@@B@B@@@@AB@@@@AB@B@B@@@BA@@@@@@AAAA@@@@@AAC@@@@@@@@@AD@@@@AB@@@B@B@@@BA@A@@B@@A@@@C@@@@@A@@B@@@@@BA@C@@@A@@@A@@@@FA@@@B@@@@@B@B@BBB@@@B@@@@@B@@@@@BBB@@@@BB@@B@@@@@BB@@B@@BBBBB@BBB@@@@B@@@B@@@@A@@B@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@B@@@@@DA@@B@@ABA@@B@@@BB@@B@@AB@@@@@B@@BB@@@BB@BB@@@@@B@@A@@B@@A@@BB@@B@@@B@@B@@B@@@BA@B@@@@@B@@@B@@B@@@D@B@B@B@@@@@BB@@@@BABA@@@@@C@AB@@A@@@@@@B@@@@@@@@@BB@@@@B@@@@B@@BB@@@@@@B@B@@@B@B@D@BA@@@@@ABDB@@B@@A@@@AAA@BB@@BB@@@B@@@@@@B@@@@@@@@@B@@A@@@@B@@@B@@@@AA@@B@AA@@@@@@@@AB@@@@@B@@@BBB@@@@@@A@@@A@@@@@A@@@AB@@@@B@@B@@@@@B@@@@@@@DA@@@@BA@B@@@@B@B@@@@@B@@AB@@@@AAA@@@AA@@A@A@A@@@@@AB@@@B@@@@@@@@@@@@@@@@@B@@@@@@@BA@@@AB@@@@@@@B@@B@@BA@A@@@@@@B@@@@@B@@AB@@@B@@A@@@@B@B@@@@@@B@@B@@@@@@@@@@A@@@@@@@@B@@BB@@@B@@@@@@@B@@A@@@@BB@@@@@@@@@@@A@A@@B@@@@AB@@@B@@AB@B@@@B@B@B@@BB@@@@@B@@@B@@@B@B@@@B@@@B@@B@@B@@AB@@@B@@@@BB@@@BB@@B@B@@@B@@@@@B@@@B@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@B@@BA@@@@@@@@@@D@@@@@@B@@@@@BD@@B@@BBAD@@B@DDBBB@BBBB@@@@BBB@@BB@@BB@@@@@DB@@@@@ABA@@@@B@B@@@BA@AFBBA@@B@@@BBB@@@@D@@BBBD@B@@A@@B@@@@@BBBB@@@B@@@@B@BA@AB@@BBBB@D@@BDB@BB@@@B@BA@@B@D@@@B@@@@@@@@B@@@@@@B@@@BFCFCDA@@@CDALCLCDC@@HKBADCBA@AB@BA@@D@@@@@@@BB@ABAB@BA@@B@FABABAB@B@DBD@H@BB@@DBB@BADCBAB@B@BB@BB@@B@@B@D@B@BAB@@@BBBBB@@@B@BC@@@@DBB@B@B@B@B@B@BD@@BAB@B@DD@@B@BA@AB@D@BA@@B@@AB@BC@ABC@@B@@A@@A@@@AA@A@@@@@@@@@@@@AB@A@@@A@@@BB@@@@@@@@@@@@A@@@A@@B@B@@@@@A@A@@@@@BAB@B@@@@@B@@A@@@@@@@@BC@@@@@@@@@@A@@@@@@@@B@@@BA@A@A@@@@A@A@@@@DB@@@AA@@@@A@@@A@@AB@@CB@@@A@@DAB@@@@@BB@@@@B@@@@@B@@CA@BA@@@ABA@@@@@A@A@A@@@@@@@@B@@BB@@C@@@B@B@B@@B@@@@A@CAA@@@@BA@@@A@AA@@AAA@@AA@AAAA@@AABA@@@CCAAAAAA@@@BAB@@GA@@A@C@@AAA@@@@@@@A@A@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@A@@@BCA@A@@B@BBBBB@BBB@@@B@@@B@@@B@B@@A@A@@@A@@AA@A@@A@@@@@@@@A@A@@@@A@@@AABBB@B@B@@@BA@@B@B@@@@B@@ABA@B@@@@ABABA@@BAB@B@@@@@@@@@@@@@@@@@@@@AB@@AB@@A@@AA@@@@@@A@@@@@@@@@@@@@@@@@@@@@@CA@@A@@@@@@@@@@@@B@@@@BH@@@@@@@@@@@@@@@@@@@@@@@@ABA@@@C@AG@@C@@@@@ABA@@@@AA@AC@@A@@@@@BA@@@@@A@ABCBCAC@@AC@AAAB@@@B@@AAAA@@@@ABA@@@A@@@@@@@AA@@@@@@@@@AB@@A@@@AA@@@@@A@AA@@@A@@@@A@A@@@@@A@A@@AB@@@@A@@AAAAA@@@@DA@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@AAG@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@B@@@@A@@A@@@@@@@@@@A@@@A@A@@@@@@@@@A@@@@@@@@@AA@@A@C@@@@@@@@@@@@@@@@@@@@@A@A@E@@BA@@@A@@@@@@@@@@@A@@@@AC@@@@@A@@@@@A@@@@@A@C@@BAB@@@@@@AB@BABA@A@@@AB@@@@@@@@@@@@@BB@D@BBHBBDBHD@@@B@BB@BBBBB@@@@ABA@@FA@@D@@@@@@@@@@@@@@AAA@@AA@@@@F@@@@@@A@C@@AAE@C@A@CD@@A@A@@AA@@@ABAB@@@@AB@AA@@AAE@C@A@@DA@@D@B@D@F@DA@@@@@ABABAB@DBB@B@BD@@@@B@B@B@@@AD@@ADBDBBB@@@F@B@@@B@@@BA@@@@@@@@@@@@@CAGAC@@D@@@FAB@ADB@@@@@BABA@@B@@@@@BADA@@@A@@A@A@@@@A@A@@@@B@DB@@@@AA@A@A@AB@@A@@@@@@@@AA@AA@@@ABA@@@A@AA@AAAA@@@A@AAA@@@AB@BA@@@@@@@@@@@AA@@@@@@AAA@@A@@BAB@@@AC@@A@@AB@@@@A@@@@@@A@@@@@@BAA@@@@@@@@@@@@@@@@@@@@@@B@@AAC@@@@A@A@@@@@@@@@@@@@@@@@@@DA@@@@@ACA@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@A@A@@A@@@@@@B@B@FA@A@@A@@@@@AB@@@@@A@@@@A@@@AB@@@ABA@@D@@@BA@@@@@@@@@ABCBA@@A@@@@@@@@@@AA@A@@@@BA@@B@@BB@@AB@@@@@A@@@A@@A@@@@@AB@@@@@@@@BABA@@@@AA@@A@@@@@B@@@@@B@B@D@@@@A@@@@AA@@BB@@@@@@@@B@@@B@@@@@@AA@@A@@@@A@C@AAAA@@@@AB@BAB@@@@@A@AB@@A@@@@A@@@A@@@@@@@@@AC@@@A@@@@@@@@BC@A@A@@@@AC@@@A@AB@BA@@@@AA@E@A@CBE@@BA@@@C@@@@AA@C@@@@AA@@@@@ABA@@@A@@BA@A@A@@AC@@A@@@C@@@C@@AA@@@@@@@@@@@@A@@AA@C@@A@@@@@@@@@@@AB@@@B@@@B@@@B@@A@AA@A@A@@CA@@@@BA@ABABA@@C@@@B@@BCBA@@@CB@@AABA@ABA@@@@@@ABA@@B@@@@@C@AB@BA@A@@B@B@B@@@@A@@B@@A@@B@@@@@@@@@@@@@@@AA@@A@@A@@@@@@@@A@@AAC@@@AB@@@@ABA@@@BBB@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@B@@ABBBBAB@BB@@A@A@A@A@@@@B@@@A@A@@@@A@@@A@@@@BA@@B@BBB@@@@AB@BABAB@@ABA@@BABCBA@A@@@C@@@A@@@@@@@@@@@@@@AA@@@@B@BAB@@@A@C@AA@@@@@@@@@@@@@@@@@A@@DB@AB@BBB@@B@@@@@A@A@@B@@@BBBB@C@ABAB@@AA@@A@@D@B@BA@@@A@@@AB@DAB@@@B@@B@B@B@BDB@B@@BBB@D@B@@@BA@ABC@@@@@@@@@@@@@@@@@@@@AA@A@A@@BAB@B@B@BD@B@@BB@@@BAB@BB@B@@B@B@@@@@@@@@@@@@@@@@@@@@@@@@BAB@@@B@@BB@@B@@@@B@B@@@@@@@@@@@@@@B@@BB@@B@@AB@@@@B@@@BCBA@@DABD@@B@@@@@AA@@@@@A@@B@B@@B@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@AAA@AC@@BCD@BA@@@A@A@ABA@CEAA@@AA@@@@@@B@@@B@@A@CA@@@AA@A@C@@@@@@@@@@@@@@@@A@@A@A@AB@@@@@A@@@@@@ABAAA@AA@A@@AA@@@@A@A@A@@@A@@@@@@@@@ABA@AAA@@@CCA@@A@@@CBCBA@@CA@@BADCB@B@B@@@B@@AB@BBBA@@AA@@@@AAA@A@A@AA@@@@@@@@@A@@@@@A@@@@@@@A@A@@B@@@B@D@B@@A@@B@@@@BA@@BBB@@BB@@@@B@@@@AB@BAB@DABA@ABA@@@@@BBB@@@@B@@@@@B@@@BA@@@@B@B@@BBBBBB@@@@@@E@CAA@@C@A@AA@@A@@C@A@@B@@@AC@A@@@@@@@@@@@@@@A@A@CB@B@AAB@BA@ABA@AB@@BBA@ABA@@@@@@@@AA@@BEDA@A@@A@@@@@AD@B@@@BAB@@AD@@A@@@@@@@@@@@@@A@@B@BB@@B@@@@@@@@@@A@@@@@@@@@@@@B@@A@ABC@A@A@@B@@@@@@@@AA@@AA@A@A@@B@BAB@@@@@@A@@@@@@@@@@@@@@@@@@@@@AA@@@@A@@@@@@@@@@@@@@B@@@@AB@B@@@@@@@@@@@BAD@@AAAB@@C@@@B@@@@@@@@A@ABAB@BA@@@@@@@@BABA@@@@@@@@@@@@@@@@BA@CD@@@@@AA@@A@@@@A@@B@B@BBBA@@@@@A@@A@@@CA@@AB@@@@@C@@B@@BB@BAD@B@@B@@DBDB@@@@@@@@@@@@@@AD@@A@@@CAA@@BA@BB@@B@B@@@@@@B@B@@@@@@@@@@@@BBB@BAD@BA@@AABAA@@AAA@@@@@@@@@@@@@AB@@@B@@@@@@@B@@@@@@@@@@@@A@@@@AAAAA@@A@@DC@@@@@@@@@@@@BAAC@A@@@C@@@@BA@@@@@@AAAABA@@@@@@@BBB@@BB@BBB@@BA@@BA@@@@@@@@@@@@@@@B@@@@@@BA@@@@B@@@@@@@AA@@@A@C@@AA@A@A@@@A@@@@@A@@@@@A@@@AB@B@@@@@@@@@@@@@@@@A@AA@AAA@AAC@@@@A@@BA@@@AB@@@B@@@@A@CBA@@@@@@@A@AC@@@AABA@@@@BA@A@@BAB@BA@@BBBAB@@@BA@@C@@@C@@@@@B@D@BA@@B@@@B@@BBB@B@B@@@B@@@@BB@@@@@BAB@@@B@B@@B@@@@B@@A@C@@B@B@@B@BB@@@A@@@A@AB@@@@@@BAB@@B@@@B@@@AA@AB@@D@BB@@@D@@@B@@@BB@@B@@@@ABA@AB@B@@@B@B@B@@ABA@@BAB@@@@BB@@@@@BC@A@C@@@A@@BCB@F@@A@C@@@A@@A@A@A@@@@@@@@@@@@AB@@A@@@@@AAB@@BB@@AAA@@@@@AB@@@A@@@A@@@@ABA@@@@@@@@@@@A@@@A@@@@@@@@@@A@@@@A@A@@@@@@B@@@B@@AA@@AA@@A@@@A@A@@@BA@@@@@C@A@@A@AB@@@A@@B@BA@@@@BB@@@@@@B@@AA@@@@@A@@@@A@@@@@B@@A@A@@@@BA@@@@@@@@@@@A@@@@@BA@A@@@@@@@@DA@@@@B@@@DCB@@A@@A@AB@@CABA@@@@@@@@@AA@@A@ABA@@@@C@@BABABA@A@A@@A@@A@A@AA@@B@B@BA@@BB@@BB@@BADA@@@@AA@@@@@@ABA@@@@A@A@@@A@@@A@@A@B@BABAB@@A@@CB@@@@BC@@@@BB@@@A@@@@A@@AA@@@BA@@@@B@BB@@B@BABA@@@B@@A@ABA@@B@@BAB@@@B@A@@BA@@B@@@@BAB@@@@@@@AB@@B@@A@A@AB@B@@@@@BABA@@@@@@@@@@@@@@B@B@@BBDD@@@@A@@@A@@@@@@B@@@B@@@B@@@BBB@@@@@@@@ABA@@@BB@BAA@BA@@BAB@@A@@B@AB@@@B@B@@B@BB@@B@@@@AAA@@A@@@@@@@@@B@@A@A@ADB@BB@A@A@@B@@B@BAB@@@BB@B@@BABB@@B@BA@@@@@@B@B@@@@A@@D@@AAA@A@C@@@@B@@@@@@@@@@B@@@@@@@BB@@@@A@@@@@@@@@AB@@B@@@B@@@@@@@@@@@@AD@@@@B@@ABA@@@@@@@@@@@@@@@@@@@@@A@@@A@@@@@@AA@AB@@@BB@@@@B@@@@@BB@B@@B@@A@@@@@@BB@B@D@@BB@@@@@@@@@@BB@@@@@@@@BA@@@@B@@@@BBB@@@@B@@A@@@@B@BA@ABA@@@@AB@@A@@@@AB@@A@@A@AAA@@A@@B@DB@@@AA@A@@AA@@@@@D@DBB@@@@A@@@AA@@A@@BBB@BB@@ABB@@@B@B@B@A@@AC@@@AA@A@@@@@@DB@A@@@AA@A@@A@@B@@@@A@A@@B@@AAA@@@A@@@@@AB@@BB@@AA@@@A@@@@A@A@AA@AAA@@@@@@@@A@@@AA@@@@A@@@AA@@C@A@@@@BBDBBA@AAA@@AA@AA@@@@@@@@A@@@@B@@@B@@ABABABAAC@A@@@@B@@B@@B@AABA@@@@B@@B@@@@@AD@B@@BB@@@B@@@@ABA@@@B@B@BB@B@B@@A@@@A@@@@@@@@@@@@@@B@@@@BAB@@FBB@@@BABABC@ABA@@@@ABAA@@B@B@B@B@BABA@A@A@A@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@A@@A@@AAC@@@@@@BD@@@@D@@@@@@C@A@ABAB@@@@@@@ABBB@@@A@@BAB@@@@AA@C@AAAA@A@A@AA@A@@A@@@AB@@AB@@@@@CBA@@A@@AC@@@@@A@AB@B@AA@A@@@@@@@A@@@@@ABAB@@@@@@B@@@@BCBC@@A@C@A@@@CBABA@A@@AA@@A@@@BA@B@@B@@AB@A@@AA@@@BA@@@@@@BA@C@@@@@@@@@@@@@@@AB@B@@@@@@@@A@@AA@@@@ABABAA@@AAB@A@@@@@@@ABABABA@@@@@@@AB@@@A@@@@@@@@A@@@A@@@@B@@@@AA@A@@@@@@@@@@AB@@AAA@@AA@@@C@@AAE@@@BC@@@@AB@BAB@@@@C@A@@A@@DA@@@CA@@A@BA@A@@@@ABC@@B@@A@A@@C@@@B@@ABAAAAA@@@@A@@@@@BG@@@@@@@ABA@@BCCACCAA@@AB@@@@ABAA@@@@A@@@@@@@@@@@@B@@A@@@@@@@A@@BABAB@@A@A@@AA@A@A@@@@@@@AB@B@D@@@B@@B@@@@@A@@@AAAA@@@A@A@@@AAA@@@@@@@@@@@@@C@@@@@A@@@@@@@C@@BA@@@@@@@@@A@A@@A@A@@B@@@@@@@@@@@BA@@B@@@@B@@AB@@@AB@@ABA@@B@@@B@@@@@@@@@BAAA@@@@A@@@@@@@@@@@@@@@@@@@A@A@@A@@@A@A@@@@@@@@@@@@@@@AB@@BBA@@A@@@AA@@B@B@B@@@BB@B@@@B@@AAA@@@@DB@@@BB@BA@@@BABA@AB@@A@AB@@@B@@A@A@@B@@B@@@BBB@@AB@BA@@@BA@@B@@AB@@A@@@@B@@@B@@@@ABA@@@ABAB@@@ABA@@A@@@A@AB@B@@@@@C@@@@@@A@@A@@@@@A@AAABA@ACCAAAE@@@ABA@@BA@@BA@@@AAA@@@@@@@AB@@@A@@@@A@@A@@@@AAAB@@@@C@@A@@@BABB@@BAB@@A@@A@@@ABA@@@@ABB@AB@@@@AA@A@@@AB@BA@A@@BA@C@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@A@A@@AA@@@B@@AA@@@A@@@@@@@@@@@@@@@AB@@AB@BBB@@@BA@@@A@BBBB@@BBA@@B@@A@@A@A@AA@@B@D@@A@@C@@@@A@@D@@A@A@AB@@ACA@BB@@@@@@@BBB@@@@@@C@AB@@@B@@AB@BA@A@AB@@@@@@AAC@@@AA@A@@D@@@@C@@AA@@A@@@@@@ABBBE@@BAB@@A@@A@@@@A@ABAB@@AB@BABC@AA@@@@@BA@@AABAA@@@C@ABA@@@ABAAABAA@@@A@A@@@@AA@AA@AAA@CBA@@@@B@@@@@@@@AA@@AA@@@@AB@@@B@@@C@@@@C@@@CB@@C@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@A@@AA@@@@@@@@@@@@@@@@@A@A@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@B@@@@A@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@A@@@@@A@@@A@@A@@A@A@AA@A@GAGJGHADCFABAJ@PADADG@IBGAGCCCEAEBE@E@ICG@AB@@@@ABAD@@A@@BA@@@C@@@@@@@@@AB@@@@@@@@CB@@@@@@@@@@AB@@@@@@@@@B@@@@@@A@@@C@@@@@@@@@@@@@@@@@A@@@ABA@@BCA@B@@@@@@A@@@@B@@@@@@@@@@@BB@@B@@@BBB@@@B@@@B@B@@@@@@@@ABBB@B@@@@AAA@A@@@ABA@@BABA@AA@BAAABB@@@@B@@@@AB@@@@B@@B@@BB@@B@@@@@@B@@A@@@A@@B@@B@@B@B@@A@C@C@@@AB@@AB@@C@@@ADA@AB@BA@@B@@@@@B@B@B@@@BA@@@A@AA@A@@@AAA@@AAAA@@@@A@A@@AA@@@@@@BAB@@@@@AAA@@@@@@@B@@A@@B@@@B@@@@B@@@B@@@@B@@A@@@@@A@AB@@B@@@@@B@@A@@@B@@@@@BAB@B@DADA@A@@@@@@@@@A@@B@@@@B@@@@@@BAB@B@@A@BAAA@@@@A@@@@@@AA@CA@@A@A@A@AAA@AA@BABB@@B@BB@B@@B@@BB@B@@B@D@B@@@B@B@BB@@BD@@@@@@@@AD@AA@@@A@A@A@@@A@@B@@B@B@B@BBB@B@A@@BB@BB@B@B@BA@@B@@@@@@@BA@@B@@BBABA@C@@@A@@@@@@A@@AB@@A@@@AAA@C@@@A@@@AA@A@@A@@@AA@@CB@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@CB@@@@@J@@@@A@@@A@@@@@@@@@@@@@A@C@@A@@A@AA@@AB@@@@C@@@AA@@@AA@AA@@@@AB@@@B@B@@BB@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@ACA@@A@@@@AA@AA@@@@@B@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@AA@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@A@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@A@@@@@@@A@@@A@@@AB@@A@CDEBA@@BA@@@A@@B@@@@@@C@@@CA@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@A@@@@@CA@@@@@@@@@@@@@@A@AAAA@@@@@@@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@@@@AB@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@BA@@BAD@B@@@@ACC@@@A@A@@EB@A@@B@@AB@B@@A@@AAA@A@@@@BABAF@@@@@CAC@@@E@@B@B@@@B@BE@@@@@@@@@A@@@A@@@@B@@@@@@A@A@@A@@@@@@ABB@C@C@@@@@@@@A@@CAA@@A@A@AA@AA@DCLG@@JAAAD@@A@AB@AAD@@@@@B@B@@@@A@A@A@@B@@@@BB@@@@@BA@@@@@A@A@A@A@A@A@@@@@A@@@@@@@ABA@@BA@@@A@@AC@C@@DA@@@@@AAE@@@A@@BA@@DG@@BA@C@@@ABCDE@C@@@@C@EBCB@@@@ABAB@B@@@FB@AFOFCBA@AB@@@@@B@@AB@@CA@@A@@@A@@B@@@@CB@@@@A@C@@@@@AB@@@DBB@@@B@B@D@@CB@B@@@B@@DF@@@B@@@BA@@@AA@@@@A@@@A@@@@@A@@AAB@@@@A@@@C@@A@@@@@@@@@AC@@@@@AA@A@A@@@AC@AA@@@@@CA@@@@@@@@@@@ABA@@@@@@@@@A@@BA@@@@@@@A@@@@@@@@@A@@@@@A@@@@@CMEM@AAC@AC@@@@@@AB@@@@@@@C@@BA@@@@@@A@@@@@@@@@@AA@@@@@A@@@@@ABE@@BC@@@@@A@@@@AA@@@A@@A@@@A@AA@@@@BA@@@@@@A@@BA@@@AA@@@@@@@@A@@@AA@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@AB@@A@@@BB@@A@A@AB@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@B@@@@@@@BA@@@@@@B@B@@@B@D@D@@C@A@@@@A@@@@@@BAA@@B@@@@@@A@@@@@@AAB@@@BA@A@@@@A@@A@@@AABAB@@E@@@AC@@@@A@@@@A@@A@@@@@@C@@B@B@@@@B@@BA@@@@B@@AB@@@@@@B@@B@@@@@@@A@@A@@B@BB@AB@@@@A@@@@B@@AD@@BBA@@BA@A@@@@@@@@@C@AB@@BBB@@BC@@@@@@AA@@BA@AB@B@@@DA@A@A@@BA@@BB@A@ABA@A@B@AAA@@@AB@@@@@BAB@@A@A@@@@B@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@CC@@AA@@AA@@AA@@A@@@A@@@@B@A@@A@@@@@@@@@@@CA@@A@@@@@@B@@AAA@@@AA@AAA@@@@@A@@@@@A@@@@@@@@@B@@@@A@@@@@A@@@@@@B@@@@@@@@@@A@@@C@@A@@@@@@@@@@@@@@A@@@@BABA@@@@@A@@@@BAB@@@@@@@@@@@@@@A@@@AAAA@@@@@@A@@BA@AB@@A@@@@BC@@@@@@@ED@@@AAAAA@AAAA@@A@@A@@@C@@B@@AB@BA@@@C@@B@@ABA@C@@AA@@@@@AA@@AA@@A@AC@@@@ACB@@A@@@@A@@@@@@AB@@@@A@A@A@@@A@@@@A@@B@@@@A@A@@@A@@B@@@BC@@B@@A@A@@BA@@AA@ABAAC@@@EAA@AA@@AA@AEA@BA@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@C@@B@B@B@@A@@@A@@AAACA@@@@A@@@AA@@A@AB@@A@AA@BA@CCC@AAC@E@A@ABC@@BE@CBC@@A@A@@E@@@@AA@@@AA@@@@@AC@A@AA@@AB@@@D@B@B@@A@CA@@@@@@@@@@@A@@AA@BA@@@@A@@B@@@CAA@@@@@B@@BB@A@A@@@A@A@@@@@@@@@@@C@G@A@A@AAA@AAC@ABC@@B@@@@@B@@A@@@A@CD@@B@@@ABA@@@@F@@@@@B@@A@A@@B@@EB@@@BABK@C@K@KAe@ELCHKDYB@]DS@WHOH[H@H@D@B@F@DBD@DDH@L@H@D@FABGLCBEFEFCFCL@DABBH@JBD@B@D@DEFMRGNCDCDAD@BBF@D@BABAD@H@BBBB@@BBBABADABA@ABB@BB@BBB@BAB@BADA@AFA@@@A@@@@BA@@@@BA@@B@@BB@BBB@B@BA@A@@@B@B@AB@BEBABBDBDBB@BB@@B@@@B@BA@CD@BAD@D@@D@@@B@@BAB@B@B@BBB@@@@@BB@BBB@DB@@@BB@BB@BAF@B@BB@BBBD@BBBBB@BBB@B@BAB@BBBB@BDB@BDBB@BBD@BBBBD@@@B@B@BBF@@@BA@A@BB@@@B@@@B"]],encodeOffsets:[[[113038,22453]],[[113031,22307]],[[112401,22188]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("中山",{type:"FeatureCollection",features:[{type:"Feature",id:"442000",properties:{name:"中山市",cp:[113.39277,22.517585],childNum:1},geometry:{type:"Polygon",coordinates:["@@FCDABABA@AC@GAEACAA@ICCACA@@EA@@A@ACEGEA@@KCCAICEAOE@@CCAAA@G@GBIBCFCDEFOTCDINEFA@A@CAAAA@ADAB@B@@A@EDAAC@ABCBA@SJMJCBCD@@EFCHCDCJCJABCJAFAFADKXITCLAFAF@@@B@@@J@RGLEJMLEH@@@@KN@@QVCDABKLGJEHCBSLAJCJCJAH@H@H@D@N@V@FELCFEH@@EHEL@FADCPEJADGJADKN@B@@@@GLADCFEJKLABMJABQTIHD\\CT@D@DADALADADCFCHABAFABA@@BADCBCDIL@BCJAB@B@@A@@@CD@@@@CDA@FDAD@@@@@D@@@B@B@@AB@@AD@BDHAB@@@@ADDN@DBHDFBF@@@@@@@@BBDAB@B@D@BB@@@H@FC@C@@ACJABC@ADD@@BBBB@D@ADABEFBBDB@@@@@@@@B@BABBBB@@@@BB@@@@BB@B@@B@@@@BB@BABDBAB@A@DDDABCB@@@BABAD@@ADABAB@B@B@B@BBD@D@DB@@D@@@AB@BAD@FFHBBDD@@@B@B@D@HJBHHHHBB@@DDDFBBBFDDDHHLDJBBBNFD@BDBDBB@JABAHEB@HIBEDEFEDCJBBD@@FFFH@@@@HFDBB@N@DAFAJAB@DBD@H@@@FBJBF@JDHB@@BB@@@BAB@@@BA@@B@B@@BB@BBB@@@BB@B@BBB@BBB@BB@@B@BB@@BB@@BBB@@BB@@@@@B@@BB@@@BBB@DB@@BBB@B@BBB@D@BBB@BBDHHHHCHGDAJEDCTK@@@@JEHC@AFCB@DANGJCLCFAL@F@H@DANADAfK@@EM@@@@AANKBA@@HELGBARMNIFABAJELGHELGNIVOAQEAC@C@@A@@@AHMJOFIDELQ@AFKDABCHKFG@AFFHEFFFCBBDEJGDAFCFAB@L@DAPIJELKfVuDKTAjATBoGk}ki][I@IBU@A@ODAAODABA@CAA@CAACCAABABC@AAAAAC@AA@CACBEFCDC@ECAACACAGAACBM@GCAC@CCECAE@CBEBCDCDC@@AEBEACAC@A@AD@BABA@EB@@AAA@@@A@EAA@@AAAA@A@CBC@@@AA@@@A@@CBEB@FADAAC@CBAB@DABABAAABCDABA@AACCAOGE@C@@BA@@@A@CBAAA@BA@A@@@@AA@@AAA@A@@@A@AA@@A@A@@B@@@AA@@BCCAACCBA@@B@DCDAAA@@@@BA@@BAB@@BBABBBCBA@@B@JIAAJIBC@@AADC@@@AB@@AB@BA@A@A@@BABABC@@DB@@CE@@B@@@BACADEDABCDB@BB@BBBAFG@@@ABABC@@@@@C@A@@@A@C@@@@@A@@AA@A@@@@@A@CGDAC@ACCEAA@A@A@AA@@C@AAA@A@A@A@@AA@A@@@AAABA@CACAA@CCC@@EBC@A"],encodeOffsets:[[116204,22767]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,5 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("百色",{type:"FeatureCollection",features:[{type:"Feature",id:"451002",properties:{name:"右江区",cp:[106.618225,23.90097],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BABEFABMJGBCHCJALBJHNHN@@JLHPFNBFFNFRFP@T@PBPDRDHFL@L@RELILIRAJADAT@NCPAPCN@JDHDFHHRNDBLBPDNALFPHDD@N@HOPCJININHBJBJAL@JFBL@BCFEFKDIBK@MDKFKJCF@@@B@@@B@BB@@@BB@@@BB@@BA@@@A@@BA@@B@B@D@BAD@@@BA@ABAB@BABCBABAB@@AB@@ABAB@B@@@B@@A@A@C@A@@B@@AFCJ@NCNCJCJEH@@JH@@@B@B@B@BBBBB@B@BBBAD@BBBBB@@BB@B@@@B@@@@@B@@B@B@BBB@@B@@@B@@@BABABBBB@@B@BBBAB@B@D@@BB@B@@BBBB@B@B@@@D@@AB@BB@@BBBABA@@B@@AB@@B@BB@BB@D@B@BB@A@@DABC@@D@@BB@@@B@B@B@BA@@B@@@DA@@@BB@B@@@@B@@@BBD@BBB@B@@BB@B@@@@BB@@@@@BBB@@BBBB@@@@BD@@@@BBB@B@@@B@@@B@@@AB@@@B@D@@A@@@@B@B@@@@AB@@A@@@ABA@@@@@AD@@A@@B@B@B@B@@@B@B@B@B@B@@B@BB@@B@@@B@BAB@B@DCDABAB@@@B@@B@@AD@@@B@@BB@B@@BBB@@B@@B@@@@@BABAB@DBB@F@@@@@BBB@BB@B@B@B@BB@@B@@B@@BBB@@BD@B@@@B@@AB@@AB@@@BBB@BBB@@B@BBD@B@@@D@BBB@@@B@@BBBBBBB@@B@@@DD@D@@BD@BCB@B@@@@@BB@BB@@BBB@BB@@@BBBBB@@@BB@BBDBBBBB@@BB@@AB@B@@@B@@@D@@@@@BD@DBBD@BB@@@@@@@@@B@@@@@B@B@@BA@@@@@@@D@D@@@@@@B@BA@@B@@BB@@@BB@@@BB@@B@BBB@@@B@B@B@B@B@BABBBA@@B@B@B@B@@@B@B@BAB@@@@@BBB@@@BB@@@@D@@@B@@@B@DAB@@@B@@BB@@BBB@@D@@@DC@@B@@@BB@@D@@@B@@A@@@ABA@@BABAB@@AB@D@DBB@@B@@@BBBB@@B@B@B@@BD@B@@BB@B@@B@@@@BD@@B@@@DB@@@@@AB@@A@@BBD@BBB@@BD@@@@@B@B@D@B@B@B@BAB@B@@DD@BBBB@BD@@@B@@BB@DBBBD@@@BABAB@B@@ABABC@@@@D@B@BA@@@@@A@AA@@ABAB@B@B@@A@@@A@A@A@@@@D@@A@AB@@AAA@AB@@@@@B@@@@@B@BAB@B@@@B@B@B@B@@A@A@@@ABA@@@C@C@@@AB@B@B@@BB@@B@BB@@ABBD@B@B@@CD@@@B@@A@A@A@AD@@@@B@@DBBB@BBB@@@B@@B@BBBB@BB@@@@ABA@@D@B@B@@B@D@B@B@D@B@BBBA@@B@BB@@BB@@@@BB@@BDBB@BB@BB@@B@B@B@B@@@B@@B@@@B@@@@BBBBBBBB@@BABA@@BBB@B@BBBB@@BD@D@@@BB@BB@B@@@@CB@@@B@@B@BB@@D@B@BBAB@@AB@@@B@@@@@@@B@@BB@B@@@BBBBB@@BBB@BB@BDBBB@BB@@BB@@@BBB@B@DB@BB@B@BB@A@@@AB@@@BA@@B@@ABC@ABA@B@@BBDB@@B@B@BBB@B@@@@@@AB@BA@@BA@@B@@@@ABA@AB@@@@A@@B@@A@@@@@@@@@@@@BA@@@AB@@@@A@C@@BA@@DADA@@BA@@B@BAB@@@BABBB@@@BDBBB@@BB@B@BB@@BBBBB@B@@@@B@BAB@@@@BB@B@B@B@@@@@BA@ABA@@BA@A@@B@D@@BDDB@BB@BB@@@B@@@@BB@@@BBB@BB@BB@BAB@B@@@B@BAB@B@@@@@@BB@B@@@B@D@@@B@BB@@@B@D@@@B@@AB@BABA@@BA@ADC@@B@D@B@DB@@@@@@B@@D@@@BB@BB@@BBB@BB@B@BBB@@@B@BBB@@ABAB@@@@@B@B@@@B@@BBBB@@BB@BD@B@BB@@BB@@BBB@BB@BB@@B@@@B@@@B@B@BD@@AB@@AB@BA@ABAB@@A@@BA@ABA@@FCBAB@BA@A@@@@@AD@@A@@@@BA@@B@@A@A@ABA@A@@B@AAB@AABA@AA@@A@@@AAC@@B@@AB@@@@@@@B@@A@@@@B@@@@A@@BAB@@@BA@@@@@@BA@@B@@@@CBA@A@@@@@A@A@AA@B@B@BA@@@@@AACAA@@@@B@@AAA@A@@A@@@@AB@@AB@@BB@@@@@B@B@DB@AB@@@BBB@@A@@BA@@B@@AB@B@BABA@A@@BAB@@A@@BA@@@AB@@B@@BAB@B@@A@A@@BA@A@A@@@AB@@@@A@@@@@@@@@AA@BA@AB@@@@AB@@A@@@A@@BA@@A@BA@AB@@@B@BAB@@@@A@@BAB@@AAAA@@AAA@A@@@AAA@A@@AA@@@@@ACA@@BA@ABA@A@@BA@@AAAE@@@ABABA@@@A@C@@@AA@@AACBA@A@@@CA@@A@A@@@@@ABA@@@@@A@A@@DA@AB@@@@C@@BA@A@@@@@AA@AA@@@A@AAAAA@@@A@AAA@A@AAA@A@@@A@CAC@@BA@@B@@@BA@@BAB@B@D@B@@@BABAB@BA@A@@B@@A@@@ADA@@@@B@@A@@BA@@B@B@BA@@B@BABA@AB@@A@@@AB@B@@@@AB@@@@A@@@A@@BABA@@B@@ABA@A@@@@@@B@BB@@B@@BB@@@BB@@@@BB@@DB@@B@BB@@B@@A@@@@BA@@BA@@B@B@B@@AB@@@B@B@@A@A@@@@@A@A@@@@@A@A@@@@@@B@@@BABA@C@ABA@AB@BA@@BB@A@@B@B@@@B@@B@@BB@@B@@@@B@@B@BBB@@@BA@@@AD@BA@@DA@@B@B@@AB@@@@@D@@AB@@AB@B@B@@@BAB@DADA@AB@@@B@@@BA@@BB@@@@B@@AB@@@BABA@@BABABA@ABC@@@A@@BA@C@A@A@@@@@ABBB@B@@@BAB@B@@A@@BAB@@@D@@B@@BB@@@@B@BB@@B@B@@@B@B@@@B@@BBAB@@BB@@@B@@@BB@@@B@DBB@@@@@@DB@@@B@@BB@BB@@@BB@@BDDBABBD@BA@@B@@BB@@@B@BBB@@@BB@@@B@B@D@B@@@B@DAB@D@B@DA@@B@DB@@DBB@B@@@B@@@B@BBB@@@D@B@B@@@DBB@@@BB@@@BBB@B@@DBB@@@@DB@@BB@@@@BDBB@D@@BH@BBD@B@B@BDB@BDB@B@B@B@BABBD@@@BA@@BBB@@@BBD@@@@BBB@B@BA@@@@B@@@@A@@@@@@B@@@@@B@@@@@@@BB@@@@@BB@@B@@@BBB@@@B@@@@B@B@@@B@@@B@B@@@BB@@@@B@@B@@@@@B@D@@@@@B@@@B@B@@BB@@@@@B@@@@A@@@@@@@@@@B@@B@@@@@@@B@@@BB@ABBBA@B@B@@B@@@B@B@@@@@B@@B@@BB@B@B@FDB@B@@B@@BB@@BDDCB@@@BA@@@AB@@@@AB@@@D@B@BA@@B@@@BAB@B@B@B@DB@@B@@@BB@B@BBB@B@@@B@B@B@@@B@B@D@B@@@B@@@B@BBBB@DBBB@@AB@B@BAB@@BBBB@BAB@BA@@B@D@@@B@@@@B@B@DD@@B@@B@B@BB@BBB@@@B@BA@@A@@@BABABABAB@@AB@@C@@D@@@B@BBD@BBB@@B@@@B@@@D@@AB@B@@B@@@B@BB@@@BA@AB@D@@B@DB@@B@@@BABA@@BA@@@@BAB@D@@@B@@@@@@AA@@A@@@@AA@@@A@@@A@@@AA@AA@@@@@@@@B@@@@@BABA@@A@A@@@@@@A@@B@@@@@@A@@@@@@@@@A@@BAB@@A@B@@BB@@@@BBDB@B@@B@@@DB@@B@@@@AA@AEBA@A@A@A@BB@B@@@@BB@B@B@@@@@@BBA@@D@@@@BB@D@@B@@D@@@D@@BB@@BB@@@@A@@@ADC@AB@@A@CBAD@@@BAB@@A@AAC@C@@@@B@BBBBBB@@B@B@@BBAB@@B@@B@@BBBB@@@B@@B@@@@B@@@@@DB@@DBBB@@@A@@@@B@@@BA@@B@@@B@@BB@@@@@B@B@@@BAB@@@B@@D@B@@DDBBB@@BB@BDBBB@AB@BB@B@@@@@BB@@A@@B@@@@@@B@ABBB@@@@BA@@B@@@BA@@@@B@@@B@B@@@@@B@@@@@B@B@@@B@BAB@B@B@B@@AB@@@D@B@@AB@BB@@B@@B@ABAB@@A@ABA@@B@@A@BDAB@B@B@@BB@B@@@B@BBB@@@@BFABBDA@BB@@@B@BBB@@@@@@A@A@@@A@@B@@@@@@A@@@@@@@AB@@@A@@@@AA@@A@@A@@@AA@@@@A@@@@A@@A@@@@@@AA@@@@@@@@@@A@@A@@@@@A@@@@@@@@@A@@@@@@A@@@@@A@@A@@@@A@A@@BABA@@@A@@@A@@@@A@@A@@@@@A@@@@@A@A@@@AAA@A@A@A@@@A@@B@@@@@BB@@@A@@@@B@@AB@@AD@B@@@DA@BB@@@B@@A@AB@B@@AAAB@@A@@@AB@B@B@@@BC@A@@@A@A@@@AAA@@@@B@B@BA@@@@B@@@B@@@AA@@BA@@@A@@@AA@@AA@@@A@@A@@AAB@B@B@@@BA@@B@@@B@A@@AAA@@@AB@@A@A@@B@@ABBBA@@@ACABA@@B@BAB@@@B@B@@@B@@@@@@@@A@@@A@@@ABAB@@@B@@@@@@@@@BB@@@@@@B@@@@A@A@A@@@@@A@@@@A@@A@@@@A@@@@A@@A@@@@@AAB@@@D@B@B@@@BA@@AA@@AAA@@AA@@AAA@A@@AAAA@A@AAA@@@@A@BA@@@@@@B@@A@@AAB@@@@@@A@@A@@@@A@AA@@@C@@A@ABA@@@@@@AA@C@@@A@@@@@@@ABA@A@A@@@A@AA@@@A@@AA@@@@@A@@@A@A@@@ABAAA@@@BA@@@@AC@ACCAA@@B@B@@AB@@@BA@@@A@A@@B@@@BAB@BAB@BA@@@@B@@@BAB@B@@AB@BC@@B@@A@ABA@@@@B@BC@@@A@A@A@@@@A@@@@A@@@A@A@CBAB@B@@@BB@@D@@@@@@@AA@A@@@AA@@AB@@A@@@CAA@A@@AA@@@A@@@AAA@A@@AA@AA@@ABA@AA@AA@@@A@@CA@A@@@C@ABC@@CA@A@@@AAA@@@AA@BA@AB@B@@@B@@@@@B@B@@AF@@@BAB@@@@@@A@@@ABC@@@@AA@@CAAAAAAAA@@A@@@AA@A@AAA@BA@@@@BADA@ABABAAC@A@A@AAAAA@AA@@@@@AA@@@@B@@AB@B@B@BA@A@@@@@AB@@@@A@@B@BA@@B@@@@@@AA@BA@A@@@@BADA@@@AA@BA@A@@@ABAA@@@@AB@@@BABA@@BA@@B@@AB@@@B@@A@@BBB@@AB@BB@@@A@@BAB@B@BABB@AB@@@B@BAB@@A@ABC@@BA@@BA@@B@@@BCB@BB@@B@B@B@@@BA@A@A@AB@@@BA@AB@AA@A@@@A@A@@@A@A@@@A@@AA@@@@@A@@AA@AAA@A@A@@@A@@B@@@B@BADC@@B@@@AA@AB@BCBA@@@C@A@@@ABA@@@A@AAA@@@AAA@A@A@AA@@A@AA@@@AA@@A@AA@AA@BA@A@A@@@CAA@C@@BA@@AE@AAA@ABC@@AA@A@C@AAAACBA@@@@B@@AB@@A@ABE@@BABA@@BA@AA@@@AA@A@@@A@@AC@@@@@A@A@@CA@A@@BAAAAC@@@@@A@AA@@@AB@B@D@@A@@@@@@@AE@@@AB@@@BA@AB@@@AA@AA@A@A@@A@A@CAAB@AAB@B@BA@@AA@@@A@@BA@@B@@A@@@@AA@@A@@@A@@@A@@@@CAA@@@@@@B@B@@A@A@@@AA@@AAA@A@@@A@A@@BAAA@@A@A@A@@AAB@@@@AA@@A@@BA@@@@B@BA@A@@@A@A@@A@AA@@A@BA@@@AA@@A@A@@BAA@@@@@@A@@B@@A@@BA@@BA@@@@@AB@@@B@@AB@@BB@@@@A@@@BB@@AB@@@BB@@@@@A@ACA@@A@@@A@C@@A@ABCBA@@BA@@@@@A@@AA@@BA@@B@@A@@@AA@AA@@BA@ABCBCB@@@B@B@@A@@BABA@@@@@A@A@@@C@A@@@A@A@@BA@A@@BABA@A@@@BDA@@BA@AB@B@@ABA@CB@@A@@@@@AB@@A@@BC@A@ABC@@@A@A@@@AAAA@@A@A@C@@@AA@@A@ABAAABCA@@A@@@AAAA@@A@A@@@@@@A@@@@BC@@BC@@AA@@AB@@@@@A@@C@AA@@@A@@AA@A@@@A@A@@A@A@@@AA@A@@A@@@@B@@@B@B@@A@@@@A@AAA@@AAA@@@@@A@A@A@AA@@A@@@A@@BA@@@A@@@AB@@@B@BAB@BCB@@@BABAB@@AB@B@@AB@@@@@BA@@BA@C@A@@@@AAC@@@A@A@@AA@AAABA@@AC@C@@@ABA@A@@AAA@@AA@@@@@@AAA@@@ADA@@@A@@@ABA@@BA@@@@B@B@@@B@BA@@@A@@@AA@@@@AAA@A@AAA@ABA@@@@BAB@@AB@A@@@@CA@@@A@@AA@@@A@C@@AAA@@A@@@AB@@AB@@EAA@AA@@A@A@AA@@A@A@@A@AB@@@@ABC@@@@BABC@@@ABA@@@ABABA@@BA@A@@B@@AAA@@A@@CB@@A@@@@@A@@B@@@BA@@@@@A@@@AB@@@@A@@@@@@B@@A@@@@@@@@@AB@@@@A@@@AA@@A@@@@B@@AB@@A@@@@BA@A@@@@B@@A@@@@A@@@B@AAB@@@@@B@@AB@@AAA@CACAC@A@A@C@C@E@C@C@AAAAEAG@AAC@@@AAA@C@@AAAA@ACA@@AAABA@A@CBCBI@A@A@CBABEF@B@B@@@BBD@B@BBDBH@D@B@DAD@@A@@B@@C@A@C@CAA@CAAAGAIECCAAA@AAC@E@C@A@A@@BABA@@@C@E@CBCBC@A@E@E@@@CAC@AACAA@AAEAAAA@GAA@@@A@ABEF@@AB@@ENCFADCDABA@ABEBC@A@A@AAA@C@A@CBABMHCBA@CBC@ABCBAB@@ABA@C@E@C@@@CE@@@AB@AA@@A@A@AA@@A@@@@@A@@@AACAA@CA@A@@A@@AAAAA@@@@A@A@@BA@@@A@AA@@ABA@A@@AA@ACBAA@@A@AAAA@A@@@AAA@@BADA@@@@A@@@AAA@@A@@@AA@@AAA@EAA@AACAAA@@@A@@@A@C@@@ABE@A@A@AAC@@AC@@AA@@A@AB@@@@@B@BA@@@@@AA@@@A@@@@@A@@C@AAA@@@A@@BAB@@A@@@@@AA@AA@@@BA@C@C@A@@@@AA@@C@@@A@@@@AB@@ABADA@@AA@A@@DCB@A@AA@@ABC@@B@@ABAB@BA@AB@A@@AA@@@A@@@A@A@@AAA@@@ABA@C@A@@@@BA@@B@B@@@BA@@D@@@BAB@@@B@@A@@@E@A@@@A@A@A@C@@@@@A@ABA@C@@E@A@AA@@AA@@A@@AAA@@AA@@@@@A@@@@@@A@AB@@AA@@C@@@@B@B@@@BC@A@@@@A@@BC@@@@@@A@@@@@@BAB@B@D@BAB@@AB@DCB@B@@@@B@BB@@BB@@@B@@@@@BAA@@A@A@@@@BA@@B@@@B@AG@@@A@@DCB@@A@AA@@A@A@@AA@@A@@@@B@@ABA@AB@@@BABABC@A@ABAAAB@@C@AAAA@@AA@@@ACAC@A@A@@@A@A@@@C@ABAAA@@@ABA@AAA@@A@@AAAA@@A@AAC@C@@@AB@@C@AAAA@AA@@A@@@@A@C@A@@A@@@AA@@A@@CAAA@@@AA@A@AA@@ABA@@@AA@@A@CABA@@AA@C@AA@@@CA@@A@AA@@AAA@@@ABA@@@@@@@@AAA@@AB@B@@@B@BA@@@@@A@AACA@@A@@@ABA@AB@@@@A@AD@B@BA@@@C@@@@B@@@@@@@B@@B@BBD@D@@@@@@BB@@@AB@@@BBD@B@@A@A@@@A@A@@@CBAAA@A@A@@AA@@AAA@@CB@@CA@@AA@BAA@@CDA@@@@@@AA@@@@@@AA@@@@B@@A@@A@@AA@@CA@A@@@@A@@BA@C@@@@BA@A@@@@AA@@@ABA@AB@@AD@@A@@AA@@@@A@@@AAA@@@B@@AADCAAB@@A@A@A@A@A@@@AAAA@AAA@AA@@AA@@A@@@@BAB@@AAA@A@A@@AA@@@A@@A@@CA@@@AA@AA@@A@A@@@AA@@AA@@A@@AA@@@@@BA@@@@@A@@CABA@@@AA@A@@A@A@@AA@@AA@@@AC@@AAA@@@@@A@@@AAA@@@ADAB@@C@@@@A@@@AB@@CD@@AAA@A@AAC@A@AA@@AA@@@@@AA@AAA@@@A@A@A@@A@A@@@@A@A@@@@BABABABA@ABA@CAAA@@CB@@AB@@@@CB@@@@@@AB@@AB@@@@@DA@@@A@C@@@AA@@@@C@A@@B@@A@@A@@A@@D@@@D@B@@@@@B@B@B@B@B@@@B@@A@A@CAA@A@@BA@@AC@C@@C@@@@@@BA@@A@AA@@CB@BC@@@A@@@AA@@@A@@AAEB@AAA@@@@A@A@@@A@@AEAA@AA@@@A@@@AAA@@A@@@A@A@C@@@IE@@AAA@@@C@@@A@A@@@@BAB@@ABADA@@@E@AB@@ABA@A@@@AAA@AAA@A@@@@A@A@@AA@@AAA@@@AAAB@@@@A@@@AB@@@@@BAB@BA@@B@@CBA@A@@@@A@@@@AA@AAA@@E@@@@@A@@@A@C@@@EBA@@@C@A@A@@@A@CB@@ABA@AAABAAABA@@@A@AA@@A@A@@@A@@@ABA@A@A@@@AA@A@@@@A@AB@BA@ABA@A@AA@@AB@@@@A@@@@A@AA@CAA@A@@@@A@@A@AAAA@A@AA@@A@@@C@@@A@@BCB@@A@@@A@AAA@A@@@A@@@A@AB@@@BC@@@A@@@@AA@@CAA@AA@A@@@@@@A@@@@A@@@@A@@A@@@@@AB@@A@A@@@AB@AABA@@A@@A@@@A@A@AA@@AB@BA@@B@@@@AB@A@@@@@AA@A@@@@@A@C@C@A@A@@AA@@A@@@A@@@A@AAAA@@@AA@@A@@A@A@A@A@@@A@@@@@A@@@A@A@@@@B@B@@@@@BAB@B@B@@A@A@A@@BA@A@ABA@@BA@A@@@@@A@AACB@@AA@@@@A@A@@@A@@@A@@ACBABABAACBA@ABC@A@A@ABC@@@C@@B@@@@AB@@ABA@@@CB@@@@@@BA@CBA@A@A@CA@@A@AAAAAAAAC@@E@@AA@@AAE@@CAAA@@C@A@@@@EBAACBA@A@A@@A@@A@@@@BABADCB@@@B@@BB@@@@C@A@AB@@A@@@A@CBA@@BC@A@A@C@A@@@ADABABA@@@BB@B@@AB@DA@@BA@A@@BB@@@A@@BAB@B@@@B@BA@@@@BBBAB@@@BC@BB@DAB@B@@@@@BA@AA@@AAA@@A@AA@@AAAAA@A@@@@@A@AA@@@@AA@@@A@C@@B@@C@@@@B@@A@@@AAA@A@ABA@@@@AA@AAA@AA@@@A@A@C@C@@@C@@@@B@BABA@@@@B@@@@CBA@@B@@ABADABA@@B@@A@A@AB@@AB@@@B@@@BB@@BA@@B@@ED@BA@@BA@@BA@CBABA@A@A@@@ABA@@B@BA@@@AB@@@BA@ABABA@A@ABA@@B@@A@ABA@AB@BAAA@"],encodeOffsets:[[108688,24140]]}},{type:"Feature",id:"451022",properties:{name:"田东县",cp:[107.12608,23.597194],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@AAC@AC@AA@CA@@@@@AAA@@C@A@ABA@@@ABCBCBAB@@AA@@A@A@C@@@ABA@A@@CCA@@ABA@A@@A@@@A@@@@CAA@AAA@E@@@AAC@@@AB@DAB@@A@@BBDB@@@B@@@B@B@@B@B@BBB@BAB@@BD@@ABCBA@ABA@@@A@@B@@@B@@A@A@@BA@@@A@A@@@ABBB@@BB@@@@BB@@@@BBDF@@@@B@@@@@@BB@@A@@@@@@@@BB@@@AB@@@AB@@@B@@@@B@@@B@@B@D@@A@@BC@@@@@AB@@A@AA@@@AAAA@A@ABCCABA@AAAA@@@BA@ABBBA@@@@B@@AA@@AA@@@BA@CB@@@AA@@@@@@BA@@@@B@@A@@BAB@@A@@BA@@@AAAA@@A@AB@B@@AB@@@@AB@@A@@@@@A@@@@@A@@@@B@@CA@A@A@@@C@@AA@@@AAA@@A@@@ABA@A@@@@@@D@B@@@@CB@@A@A@@@@@@B@@AD@@BD@DA@@B@B@@B@BB@BB@@@@@ABA@@@@@@B@DAB@@@B@@BB@B@@B@B@B@BB@BBB@@ABAD@B@@A@@@CBC@@@@@ABAB@@@B@@A@@@ABA@@@A@A@@BA@@BA@A@@BA@C@A@@B@@ABABABA@A@CBC@A@A@@@A@A@A@@BABABA@@@ABA@C@EA@@@BAB@BA@A@@@AB@@@B@BBDAB@D@B@BCB@@@B@@AB@@@DABAD@BBB@@A@@B@@@B@DBB@BA@AB@@A@@@@@@BCB@BAB@@CBA@@BABABA@@BA@CBA@ABC@C@@@@@@@CHCD@B@B@BAB@BBAB@BBDBDD@BB@ABBB@@@B@@@B@@A@BD@@@BA@@B@@@B@B@B@@@B@@AB@BA@@BABBB@@AFAB@@@B@@BB@B@@AB@B@@@BB@BB@@@BAB@BABAB@BABAB@@E@C@@@@B@B@BBBBB@B@BA@A@AB@@@BA@A@@BAB@B@@@B@BC@AAA@@@A@A@A@@@A@@@A@A@@@CAAA@@CB@@@B@@@@@B@DAB@@@@A@@@A@@D@BA@A@AB@@@@@BB@@B@@B@B@BBB@B@@@B@B@@@B@BB@BB@D@@@BB@B@@@D@@ABBB@@@B@@BB@@@B@@@B@D@@@B@D@BBB@B@B@B@B@B@B@@@B@B@B@@@B@@B@@@D@@@B@@BB@@@@BAB@@A@AB@@ABA@@@@B@@@BBB@@B@@@B@@@@B@D@@ABA@CBA@@B@B@@A@A@@@AB@B@B@@BB@@@@@BAB@@AB@B@B@BDBDBB@BBB@@@@@AB@@@B@@BB@@BDB@@@@@BAB@@@@@@B@@BBBBB@@@DD@@BB@@@BD@@@BBB@@B@D@@@@@@A@@B@B@@@@@B@@B@B@@B@@@B@@@BB@@BBBD@AB@BAB@@A@@@AB@B@B@D@@BBA@@DA@@@CAA@@@AB@BDBBB@@@BB@@@@@BB@@@@BBBBBA@A@@F@@@B@@BB@@@BBDBB@@@BB@@FBB@@@B@B@@@@@BD@D@D@BC@ABB@@@DB@@BB@@B@@@@BB@@BAB@B@@ABA@@BA@ABBDB@@@@D@DBB@@@B@B@B@B@B@B@@B@@@B@@B@@@BA@A@@@A@@@A@@@@AA@AA@@AAAAA@@@A@ABA@@@GB@@@@AA@A@@@@A@@@CBA@@BB@@@@D@B@B@BB@@@D@@@@@BBB@BB@@@@B@BB@B@@DD@@B@B@@@BBBA@@D@@@B@@@B@@@B@@AB@@@BB@@B@@@@CB@B@B@B@@@BBDB@@@B@B@@@@BBB@@@BBDB@@BBBB@B@B@@@BB@@@@BABB@B@B@@DB@@BDB@@BBBBBB@BBD@@@@BB@@BB@@BB@@@B@@@@@@BB@@@@B@@A@ABA@@DA@@BAB@B@BA@@F@BA@@BABADADA@@@@B@BA@@B@@A@@D@BBB@@@BBD@B@@B@@@@B@B@BAB@@ABA@BBD@@DB@@@BBBB@@A@@@BB@BAB@B@@B@@BBA@@@@@ABA@A@A@@BA@@@@@@BA@ABCDCDAD@@CDA@ABKHCBC@@@@B@@BBBBBBABBBB@B@D@B@@@DD@@BB@B@@@@B@@@B@D@@@@B@@B@DD@BB@@BBD@@@BB@@B@B@@BB@@BB@B@B@@@BB@@BBD@B@B@B@B@@AB@@ABAB@@AB@BCB@B@@@B@@@BBBBDBB@@@@@BB@AB@@BBBB@BB@@B@@@@B@@@@@@@B@@@@@@@@@@@BB@@@@@BB@@BB@@@D@BAB@B@@ABABA@@B@@@@AB@@B@@@BB@@A@@@@@B@@@B@@B@@@@@@@@@B@B@@@@B@@@@@BAH@B@@A@BB@BBB@@BD@@AB@@B@@@B@BB@@@B@@BB@@B@@BB@@@@@@@BB@@BB@@D@@@B@BB@@B@@B@@@@@B@@@@@@@@@@BB@@@B@@BBB@@@BB@@@B@@A@@@@@@BB@A@@B@B@@@BA@@B@@@@@B@@@@@@BB@@A@@@@B@@@@BB@@B@B@@@@B@B@B@@@B@B@@BBD@@@@B@B@B@@B@@@@B@B@@@@@BB@@@@@D@@@@BBB@B@D@@BBB@@BB@@@@B@@@BBBBB@@B@AB@BA@@@@BA@@@@B@B@@B@B@@@BB@@B@@@@BB@BB@@B@BB@B@@ABA@@@@@@BA@@B@@@@AB@@@B@@@B@@B@@B@@@@BBA@@@@D@BB@@B@B@B@@@B@B@B@B@B@B@@@BBBBB@@@BBB@B@@B@@@@@@BAB@B@BABC@@B@@@@@@@BA@@B@@@BAB@B@B@B@B@@@@@@@BDB@@DB@BDB@@B@B@B@@B@@@@C@A@@@AB@BBB@@@AB@BBB@@ABB@BBBBBB@B@@@BBD@B@B@@@@B@BB@@@D@@@@B@@@B@B@@AB@@AB@B@B@@@B@B@@@BBB@@@B@@@@@BA@@@E@@B@@@B@@@B@@@@@D@@@B@B@@@@@@ABABA@@@ABA@AB@@@@ABAB@BA@A@@BAB@@@@@@A@A@ABA@@@@AA@C@A@@A@C@A@@@@A@@@@BA@A@AB@@AB@@@BA@AB@@A@@@BBBD@@@@ABA@@@AB@@A@A@@@@BAB@BAD@BAB@BA@A@A@A@@B@@@BABED@@@BB@B@@@@B@@@B@@@B@B@@@BAB@B@B@B@@@B@@BB@@@B@@A@@B@@@BA@@B@@@BD@BBB@BBDDBB@B@@AD@@@@BB@BB@@@B@B@BBB@B@B@@BBBBB@@BB@@@@B@BBB@@@D@@@B@B@@@@BAB@BBB@@@@@@DA@@B@DB@@B@@BB@B@BB@@@@BBB@@@B@BA@AB@@@B@@AB@BBB@@@B@@@@BB@@@BD@@B@BB@@BA@@BA@@DABA@@BAB@@@@AB@D@@@BB@AB@@A@A@A@@BABBB@B@@BB@@@@@@@@@B@@@BB@@B@BBB@@@BBB@@@D@@@DBB@B@B@@B@B@@BB@BB@BA@@B@B@@@D@B@B@@@BBB@@@@BB@@@B@@B@@BA@B@@B@B@@@@@@B@@@B@@@@@B@@@BA@@BAAA@@@A@@D@B@DAB@D@@@@@@@@B@@@BBB@DB@@@@@@@B@@B@@@@@@@@BB@@@@@@@@BBBB@@B@@AD@BBBBB@@@BA@@B@BA@BD@@AD@@@B@@@@AB@BA@ABA@C@@BEBC@@BA@A@@BA@@@ABAB@@@BDBFFB@DBBBBBB@B@BBBDBB@B@@@@AB@@@B@@@@@B@BA@@BA@A@@BC@@@@@@D@@BBB@BB@@B@@@B@@@B@@@@@@BA@@@@BA@BBABABA@@BABC@@@ABE@@BA@@BBBB@BBB@BB@@@B@@@@B@@@BB@@BB@BB@@D@BB@@BB@@B@BB@@@D@@@@B@@@@@B@@@@BB@@@@@BB@@@B@@B@@@BADBD@B@@@@@@ABA@@@@B@B@@@@AB@@BB@B@@@BBB@B@@BB@@@BBA@A@@BAB@@@@AB@@@AA@@A@@A@A@A@@BA@A@A@@@ABA@C@@B@@@B@B@B@@ABA@@@A@@@@B@@@D@BA@@BA@A@@@AD@D@DABBB@BBB@BBB@B@B@BBBB@@BAB@@BBAB@@A@AB@BA@A@@B@B@@AB@BB@AB@BAB@@@BA@A@AB@BAB@B@@@@@BAB@@@BA@A@@@A@@@A@@DC@ABA@@@AB@DB@@B@B@BABBB@@@B@@@BBBBFBD@@B@@@B@@B@D@BBB@B@@@@ABA@@B@@ABA@@@@@@A@@AAA@A@AF@B@D@BAB@@@B@B@D@BAD@@@@@BBB@B@@AB@BABAB@B@@@DB@@@B@@B@BBB@BAB@B@@B@@B@@@B@BAB@@A@@B@@B@BB@@BB@@B@B@B@@@B@@@BBD@@BB@@BB@B@BB@BB@BB@@B@B@B@@BB@DBB@B@@@BDB@B@@@BBB@@B@@@@@B@@@@@B@B@BAB@@A@@B@@@B@B@B@B@@@@BDDB@B@B@@@BAB@@@BI@@@@CA@@@CA@@@AAA@@E@@AAA@@A@@AABA@@BA@A@@B@@@@CBABC@@BA@@BADA@A@@B@B@B@@AB@@@AA@@@A@@@@B@BB@@B@DA@@@AAA@@@A@@@AB@@AB@BA@@BA@@@CBABA@A@@@AA@@@AA@A@@B@@C@@@@@ABA@@@AA@AC@A@A@@BA@@BA@A@A@A@@@A@AAA@@BABABAB@DE@@BABA@AB@BA@@@A@AAAAABA@A@@D@@@@A@@BBFB@@D@@@@@BB@@@BBB@@B@B@@BDBB@B@BA@@BABA@@@A@ABCB@@A@A@A@AB@@AB@@A@@DAD@@@BB@BBBD@BBBA@@B@DCBA@@D@@@BAD@B@D@BC@A@@@A@AB@@@@@BB@@BBB@@@BB@@B@@@B@@BB@B@@BBB@B@B@@@BB@D@@@@@B@@@@@A@@@@B@@B@@@@@AB@@@@@@D@@@@@@BA@@BABADB@FBBA@@B@@@BBB@@@DAB@@@@@@B@@BA@@@@B@BA@A@@@AA@@A@A@@B@FBB@B@@@@@AA@AAA@@AA@@@AB@@@@AB@BA@AB@AC@@BA@@@ABA@@B@@@B@@@BABAB@@ABA@A@E@AAC@@@@@A@AAA@@@AA@@A@A@@BC@@@@@AA@A@BA@@BA@@@A@@@@AA@A@A@@BAB@@@AA@@@AB@BABC@@B@@@B@B@B@BBB@@BBBD@@@B@BAB@BAB@@@BB@@BA@A@@@@@AB@B@@A@AB@@@BA@@@A@@@A@A@@@ABAB@B@@AFC@@BAB@BA@@AAA@B@BA@ABABCBABA@AB@@@@AA@@A@A@@@A@A@@@A@@BAB@@AB@B@B@@A@AB@@ABC@AAA@@ABA@A@@B@@A@@@A@@B@@@@AA@@AA@CB@BAB@@@@A@AB@BA@A@@A@C@@@CBA@A@@@A@A@@ACA@@@@@@@@@@A@A@CAA@AA@@AA@@@@@BA@@@A@A@A@A@@@A@CB@@A@@@A@A@A@@@@B@BABCB@@A@@B@@@BDBBB@@A@ABA@E@GB@@AA@@ABA@A@@AA@@@A@A@@@A@A@AA@@@@A@@@CA@@@A@@@ACAAA@@AA@C@@@@BCB@D@@@B@@A@@DC@CBA@@@AB@BC@AA@AAA@@@A@A@@@CCAA@@BAA@@@@CAA@A@@BA@@AA@@BAB@BA@@@@@A@@@A@@@A@A@@@@CEA@@A@BA@AAA@AA@@@@BCBC@@@A@@@A@@@A@@@A@AAA@A@A@AA@AA@A@@AA@@@@@@@ABA@@@A@@BAD@B@@AB@DEBA@ABAB@BC@A@@@@@AAC@@AAB@B@@C@@@AAA@@AC@@@A@AA@A@@@@C@C@@@ABA@@@AAA@@BA@A@@A@A@AAA@A@@@C@@@A@@ACA@@@AB@@A@@@AAC@C@@A@@AHI@@BA@ABABCB@D@BABA@AB@B@@@BAB@BA@@D@B@B@B@@@B@B@BBB@@@D@BAB@@@@@@ABA@@@@B@BB@@D@@@BAD@B@B@B@FA@@B@@@@@@AA@@@@A@@@A@@@AA@@@A@@@@C@A@@@AA@@@C@@A@@A@A@@@@@@A@@BA@@@AA@@@BEAAAA@A@A@@BA@@FA@@BABB@@BAB@DAB@@@@A@@BA@A@@@@@AA@@ABAB@@A@@@A@A@@BA@@BA@@@@@AB@B@@@@AB@@@B@B@@@B@B@@@@@B@@ABA@A@A@@B@@@BAD@B@@@BA@@D@@A@@@@B@@@@@@@@AB@FC@A@@@@@AABA@@@@@A@@@A@@@ABAA@B@AA@@@A@A@A@@@A@@BA@A@A@A@A@A@AAA@A@ABA@@@A@ABA@A@A@AB@@A@ABC@@@AB@@AA@@@@A@AA@@@@AC@AA@@@@@@@AA@@A@@A@@A@@@@A@@ACAA@@@@BA@CA@@@AAA@@BC@@@A@@BA@@@@BA@@@AA@@A@A@A@AAA@@BABABA@@@@@A@E@@@@@AA@@@BA@AA@@CAA@A@@AA@AA@@CBA@A@@@@@A@@@@@@@@@@CAAAA@@AAAA@A@@AA@@A@@@A@@@@A@@@A@@@A@A@@A@@@@@BADA@ADC@@A@@A@@@ABA@@A@@@@A@@@AB@BC@AB@DCBABC@@@AB@@ADAB@@A@@BAB@BAB@@AB@@@B@@@@@@@B@BA@@DA@@@@@AB@BABAB@@@B@@@BBD@BB@@@BBBBB@BBB@@BB@@@@B@@@@@BA@@B@D@B@BBBA@@@@B@@@BB@@@@BAB@@@@BB@@@B@@@@AB@B@@@@@@A@@@A@@@AB@@@B@B@B@@@@@@A@@@A@@ACBAAC@@@@CA@@BABA@@B@DAF@B@BBBBDBD@@@@@BABADA@ABC@@@A@@B@B@B@BA@@@A@C@C@A@A@CBAAE@@AC@A@@AAC@@A@@@@BA@A@A@ABA@A@A@@@A@A@@AA@@@@A@C@A@@@@@AB@@A@A@A@@AAA@@A@A@@A@@@AB@@ADAB@B@AC@@BA@@BA@@F@@@BD@@B@BBD@AC@@@@@ABA@AAA@A@@BAB@@@DA@@BAB@BA@AB@@A@A@A@@AAA@A@AB@@AAA@AA@@GEBA@@BABA@AAA@CCA@@DCB@B@B@BCB@@@@ABA@@BABA@@B@@ABB@@@@BAB@@@B@B@@@BA@@@A@@@@CA@@A@@@@@AAACEE@E@AAA@A@A@A@@@AAA@A@@A@AAC@AAA@AAA@@A@A@CA@@AAAEEB@@@BAB@BA@AB@B@B@FCBA@@AA@A@ABCAA@ABA@ABA@A@A@A@@@A@@CAA@@@@A@ABABABCBABADEB@DABA@@@@@AAA@@@@BA@@@CBG@A@@C@E@BAB@@@@A@A@@B@B@BAB@B@BA@AB@BA@@C@@@A@A@AAA@A@@@AB@@CBC@@@ABAB@@BB@@ABAB@@ABA@@@ABA@@BC@ABAB@@BB@B@@AB@@@BABEBAA@@AAAAAACCA@A@AAAACC@AAAAA@@AA@AAAB@BA@@B@@@AA@A@@AA@@@@AAB@@AB@BA@ABA@@BABA@@@C@A@A@@@A@@@@AAA@A@A@A@@@AA@AAAAAACA@A@@@@@@BAA@@@AAAA@@@A@@B@@@BAB@@ABCD@@@BA@@@A@A@@@AC@@C@@@ABA@@@A@ABAA@@AA@@A@A@CA@A@AA@@@A@CAABA@A@AA@AAA@@A@@@A@A@@A@@A@@@AA@A@C@@@A@A@@A@@A@@@@@@A@@@@A@@@@@@A@@@@@A@@@A@@@@@@A@@A@@@@@@@@@@@@A@@CB@@@A@@@@@@@BA@@@A@@@@@@@EC@@AA@A@A@@BC@A@@D@@A@@@A@@B@B@@@@ABA@@B@B@BC@A@A@A@@AAAA@A@ABC@ABA@A@A@@@ADC@AA@A@@AA@BA@A@AAAA@@AACA@@@CC@@AAC@AA@@AAA@A@@AC@A@AAB@@CFC@AA@@CA@AAA@A@A@CAA@@@@ABA@@@AA@@@@A@A@@@C@@@AAAA@CAAA@@A@@@@AA@A@A@@BA@@@AAE@@@AA@@@C@@AC@@AC@CA@AA@AA@A@A@A@@AAB@@A@CAA@A@CA@@A@@@AB@DBBA@@@A@@B@@@B@B@D@@A@AB@@AA@A@@BA@@@@C@@@AAA@AAA@@@@AAAAAAA@@@@C@@@AB@@@@A@@AAA@@A@A@A@@@@B@@A@@@AB@@A@@@AAAA@@@@A@@BAA@BC@@@AAA@A@@@A@@AAA@@A@A@A@@@@ABCA@A@AA@C@@@@A@CAA@@@@@ABA@@AA@AA@A@C@@@AA@BA@A@CB@@A@@A@AA@@A@@@A@@A@@@AA@CEC@@CA@@@A@A@AAA@@@A@AA@@AA@@A@A@AB@@C@@@A@@@@BAF@@A@@@A@AAA@AAA@@@AA@@@AA@@@AA@AA@A@@@A@@@A@@@A@@BA@@@AAA@A@ABA@C@A@@B@B@BA@@@A@ABA@@BA@@@@@@@BD@@@B@@@D@BB@@B@B@B@BA@@DABA@A@C@A@@@CA@@AAA@@BA@@@A@@@A@A@@BA@C@A@AAAAA@AACBAA@@@@AA@@@@@A@AA@@A@@@A@A@@@A@@AA@@A@@AAAAAC@AA@@B@@A@@C@AA@@AC@@AA@AA@A@@@A@@@A@C@A@@@@@A@AAA@@@@@AA@A@@@A@A@@AAA@AA@@AA@@@@A@A@A@@@@@@A@@A@AAA@A@A@A@@@CBA@@@A@A@A@AA@@A@AA@@@A@C@@A@AAA@@@@A@AA@@A@A@@@AB@AC@A@AA@AA@@AAC@@AAACAAAA@A@AAA@@@EAAAA@ABAD@@@@AB@@@B@@AAA@AB@@CAEAA@AA@@A@A@@@AA@@A@@BA@AAA@A@@A@@A@@@A@@@C@@@CAA@@ACB"],encodeOffsets:[[109657,23839]]}},{type:"Feature",id:"451021",properties:{name:"田阳县",cp:[106.915496,23.735692],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@B@BA@@BAB@@@BA@A@@A@@AA@@@C@@@A@@@@A@@@A@A@A@@@A@A@A@A@B@BABC@ABA@@AA@@AA@@AA@CBABA@@BA@A@CBA@CAA@ABA@EB@@@@@D@BA@EBC@ECE@ABGDA@A@GACBC@@@AC@@A@C@EACB@@C@A@@BA@@AAAA@A@A@A@A@@B@@A@AB@@A@A@@@A@@@@@A@@AA@A@@@CB@@E@@@AAC@@BA@A@ABA@@AAA@@@A@@@A@@@AA@@@A@ABA@EBC@ABA@ABAB@@A@@@@A@@AA@AA@@@A@A@C@A@@@AAC@C@@@A@@@@A@@A@AGA@@@A@A@C@A@AB@@A@@@A@AA@AA@AA@@A@A@@@@@ABAB@@A@@@A@A@@@A@@BAB@@@@A@@@A@A@A@@B@@@@AB@@@@@@A@@@A@@AA@A@A@A@A@@AAA@AA@@@AAAAA@A@@@A@A@A@A@A@C@@@@B@B@B@@@BA@A@A@A@ABC@CBABA@ABAB@@@@A@A@A@A@C@@@@@ABC@A@ABA@@BAA@@@A@@A@@AA@A@@A@@@@@@C@A@@@ABA@A@@@CBAB@@AB@@A@@@@BB@BB@BD@@B@@@B@@@@A@A@A@A@CDCD@B@BC@@@ABABA@A@A@AB@@AB@@CDA@ABAB@B@@@B@@CB@@@BA@ABAB@@@BA@ADBBA@@B@B@BABA@@@ADA@@@A@@@A@EA@@AAA@@AE@G@A@A@A@@@AC@AA@AAA@@@@A@@@CBABC@A@@AA@@ACA@@@AA@BA@AAA@@A@@@ABAAA@@@A@ABA@@B@A@@AA@A@@AC@@@C@@@A@A@CAAAA@A@@@AA@AB@@ABAB@B@BAB@@@BB@BB@BA@BB@BAB@@@BA@@@AB@BA@@@A@A@@BA@ACAABCA@BA@@C@@@C@AA@AA@@A@C@E@AAC@A@A@CB@@A@A@A@ABC@A@@@AA@@A@@B@@AAA@@@A@A@A@@@CBA@AB@@A@ABAB@@@B@@@@@@C@AA@@ABA@ABA@ABA@C@@@CB@@A@@@A@A@A@@BA@@@@D@@@B@@@BA@@@C@C@@BA@@@AB@B@@@@@@C@A@AAA@CAAAA@A@A@ADA@A@@@AB@@KB@@ABA@@@AB@@A@A@C@AAA@AA@@@BAB@@@@A@@@CAA@AAA@AAA@@A@@A@@ACBA@A@@@A@@@@DAB@B@@C@G@AAA@A@A@A@@BA@@@AB@BA@A@A@@@@BA@A@@@A@C@@@CA@@A@C@AA@@EA@@C@@@CB@@A@C@AAA@EA@@@@AA@@A@@@A@A@@@@@AACAAB@@C@A@@@AA@@ABAAA@@@AA@@A@A@@C@C@A@C@C@@@@A@@@A@@@A@@@A@C@@A@ACCCCCAAB@@ADA@@@@B@@@B@B@@AB@@AA@@@BA@@@@AA@@@A@@B@BA@@@@@A@@@A@ABA@@@@A@@CB@@C@ABA@@@@@@@A@@BAB@B@@BBB@DDB@BBDBB@@@@@BBB@@@B@@BB@@BAB@@@BABCBA@@@ABBB@B@@@@CB@@A@@@A@@BBBBB@@A@A@@@@BAB@BDBBAB@BAF@B@B@@FBBAB@BABABCB@B@@B@BBB@BB@B@BABA@CB@@ABAB@B@B@@@BB@@@@@@BB@AB@@@B@B@@F@B@@@BAB@BBD@ABA@CDADEBAB@@BBABEHEBADA@ABC@ABA@C@C@A@@@C@C@@@@@ADA@A@@BAB@@A@C@@@@@A@@@A@@@AAA@A@@@@B@@AB@@A@CB@@ABAA@@A@AAA@@@@B@@@B@@A@@@A@@BC@C@A@A@AA@ACA@@A@@@A@@BABCB@@@B@@A@AC@@@@A@@@@@@B@@@BA@A@@DAB@@@BBBBB@@BBB@AB@D@B@BABADA@@B@@@B@@@B@@BBA@@@AB@@@B@BB@@BBB@@@@BBB@@@@@BAB@@AB@@@@BBB@B@@AFAD@@@@DAD@@@BB@B@@@B@@ABADB@B@B@BBB@B@@BB@@@@BA@CB@@@B@@BBBBB@B@@@B@DB@@@@@B@@AB@@@@A@ABABA@A@A@@@@BA@@@A@AAA@@@A@AA@AAA@AA@A@A@A@C@GB@@AAA@A@@@BC@AA@@@EACB@@CBA@@BCDCA@@A@@@@B@@AB@@@B@@BBB@DBBBBBB@@BB@BB@@BBBBBB@@ABBB@B@@D@BBB@B@BBD@B@@@BAD@@@B@@@FBB@@@@@B@D@@BB@@BFDBB@BBB@B@B@B@B@DBB@@BB@@BD@@@@ABC@CBA@A@@A@@ABA@@@ABA@A@CBA@@@@AA@@ABA@@FCBA@@BA@A@A@@BC@@@@AA@@AA@@A@AA@@@@@AB@@A@@@A@@CA@@C@A@@@@@@@A@A@@BA@C@AB@@@@A@A@@BA@@B@B@B@@@B@@@BA@@BA@A@@D@@@@@BAB@@AB@@AA@@A@@@@B@@@@BB@B@@@@BB@AB@D@B@@BAD@F@@@@BBB@BB@@BB@BB@@@A@@B@BB@B@B@@@B@BBB@@B@@@@BB@@BBB@D@BBB@B@BB@@DC@@BA@@B@@@@@@DA@CB@B@@BB@B@@@B@@@@BB@BD@@B@@BB@@BB@@@B@BB@B@@B@@ABDB@@@B@@@@AB@@@@B@@BB@@@BB@@BB@@B@B@@@BBB@@B@@DB@@@BB@@@B@@BB@B@B@BB@@BA@A@@B@@@BB@@BBB@BBB@BB@B@@@B@B@B@B@BA@BBCDBB@@@A@@BB@B@@@B@@B@@BB@@@BC@@BAB@BA@@B@@B@@B@B@@A@@D@B@@AB@@@@@@BDB@@BB@@@BB@@@@A@@B@@B@@@@B@@B@@@@B@DC@@BB@ABB@@DB@@DA@@BB@BB@@BB@B@B@BBDA@@B@B@@@B@B@@@@AAC@A@@BA@@A@@A@@@@C@C@AAA@@@@A@@@@@@@A@@D@@@B@@A@ABCB@@@@@BAB@BA@@B@@@DBBBB@@@@@B@@A@A@@@ABA@@BB@B@@@@@@B@BA@@B@BB@@BBB@@@DB@@B@@B@DBB@@ABDBB@@@BB@@B@BA@@BBB@B@@B@@BBDB@@@BB@@B@@@BB@D@B@@@@@@BB@@BBBBBD@@@BA@@D@D@BBB@@@BBBB@@@BB@BBB@BA@@B@BBBAD@@@B@B@@@B@B@D@DB@B@@BB@@BBBBD@@@BABBBAB@D@BABA@A@@BAB@BA@@@A@@B@@@BB@@@B@BB@@B@BA@CD@@@B@@BHA@@@A@@@AB@@@@@B@BB@AB@@@@A@@@A@@AA@@A@A@@A@A@CDA@@BA@ABC@A@A@AB@@@@@@@B@@@@D@@AB@@@@@@BAD@@A@A@@@@@@DB@@BA@@B@B@@@@B@@@@@B@@BB@BB@@@BB@@BB@@B@B@FD@B@BAB@@@@@D@B@B@B@@@B@F@@@B@@@@A@@BA@A@@@CB@@A@@@A@AB@@A@@B@D@B@BA@@B@BB@@@B@B@@@B@@BB@@@BBAB@@ABABA@@@AD@BA@@BBB@A@CD@@@BBB@@CBAB@BA@@B@@B@@@D@@@BB@@@@@B@D@DAB@@B@@BBB@@@@B@@@BA@AB@@@B@BBD@@@@B@@@@@B@@BB@@@@B@@A@A@@@@BAB@@@BB@@BD@@BD@B@B@BAF@B@@@D@B@@@B@@BBDBBBB@FBB@BB@@BB@@B@@@BB@B@@@B@@B@BC@AB@BB@@B@B@BB@B@BB@ABBDB@@BB@B@BA@@BBB@@@B@@AB@B@@@@@BBBB@BB@@@@BDBB@DBBB@@B@@@@@B@@@BBB@B@@@BBA@@B@@DF@@D@F@D@B@BA@@BADABAD@DAB@DANGBADAB@D@B@BBB@B@D@FABAB@BADCBCDEFM@@BA@@FEBAB@@@B@HBB@BBFBBBB@DBBBD@DB@@F@F@B@D@DADAF@D@@@B@BA@AB@B@D@F@D@BBB@BBDDJFHBBBDBB@DBD@B@D@@@@AB@@@BC@C@A@CAGAC@A@AAC@A@@@A@AFEBADAB@B@J@DADAB@B@BABB@@DB@BBBBB@@@DBB@B@@BD@BBHBFBB@B@D@D@F@D@D@B@BBDBD@DBB@BA@@BA@@@@@A@BBA@@@B@@@@@@BA@@@@@@BAB@@@@@BA@@BA@@@@@@BB@@B@@@B@@A@@B@@@@@@@@@BA@@@@@@@@B@@A@@B@@@B@@@@AB@@A@@@@B@@@@@BA@@DB@@@BB@BA@@@@BAB@@ABAB@B@@AB@B@@ADAB@@@@AD@B@@A@@B@BB@B@@@BBB@B@@@BBB@FB@@BA@@BA@@B@@@BB@BD@B@@@B@@BB@@@B@@D@@B@A@@BA@AB@@@@AB@BBB@B@BBB@B@@B@@B@@@B@@ABA@@@A@A@@@@@AB@@AB@B@@@B@@CB@B@@BB@B@@@@B@@BB@BB@@@BAB@B@@@DBD@@ABBB@BBB@@@B@B@@BD@B@@B@D@B@@AB@@A@@@@BA@@@ABA@@BABA@A@@DA@ABA@A@A@@BA@@B@@@B@@AB@@@B@@@BBB@B@B@@@@@B@BB@@BB@B@B@@B@@@@A@A@@@A@@B@@@@BBB@@B@B@@@@B@B@@@BBB@@@B@@BBD@@@@B@@@@BA@@BB@@AD@@AD@@@@@B@@@@B@B@@@BBBB@@B@@@DBBABBBAB@@@BB@@D@B@B@@@BBBB@@B@B@@@D@BAB@D@@AB@@@BA@@@@B@@@DAB@BA@@@ABAB@@AB@AC@@B@B@BA@AB@B@@AB@B@@@B@D@@@B@B@@@@@B@BA@AB@@@@A@A@@DADABAB@@AB@@BBB@@B@@@@AB@@AB@@BB@@@@@B@@AB@DABAB@@@@D@B@@@BB@BDB@@@@@A@@A@@BA@@AA@@B@@@@@AA@@BA@@@A@@BA@@@@B@@AB@@AB@@@@AB@@@@@@@BB@AB@B@@@BB@@B@@A@BB@@B@BB@B@@@B@B@@A@A@@B@@AB@@@BB@@@@BA@BB@B@B@@@BBAB@@@B@B@@@BBB@BB@@B@@@B@BA@A@@@@@@@BB@D@@B@@@B@@@B@@@BB@@@@@BA@@@AB@@@B@@BB@@ABA@A@BBA@BB@D@B@BB@B@B@@BBB@@A@@BAB@@A@@B@@BF@@@@@@B@@@@C@ABA@@B@@B@B@@@@BDBBAB@@@BDB@@@B@B@@@@BD@@@B@@@BBB@@B@@BAB@@ABAB@@AF@B@BA@@BA@@@@@ABBDBB@B@D@BBB@@AD@BBB@BBF@@AB@@@DBB@D@@@B@BABB@@BBBB@@@BB@@B@@B@BB@@B@B@BBB@B@@BB@B@B@@AB@B@@@B@D@@ABADA@@BBB@@A@@@CDABA@@@A@@@@B@@@B@BBB@BBB@@@B@@@@BB@@@B@@@B@B@@@B@B@@@BBBA@@BAB@@A@@B@B@BAB@@A@A@A@@@AAA@AD@@A@@@AB@@AB@@AD@B@BA@ABA@@@A@@BAAABA@A@AB@@@B@@AAA@@BA@AA@@@BA@@@A@@BA@@@AB@@ABAB@@A@@B@@B@AB@B@@@BABB@@B@@CBAB@@@@@BABB@@B@@@@A@@@ABA@@@@B@@A@@B@@@@@BABA@A@A@@BA@@@@@BB@@@@B@@BBBBB@B@B@BBDABAB@BCBAB@@@@ABB@BBB@B@@B@@@BB@BBBBBBDB@@BB@@@@AD@B@@@B@@@@A@AB@@E@@BA@A@@@@@A@@@A@A@@BABB@@B@@BB@B@@@BDB@@AD@B@D@@@BDB@@@B@@BBB@@BAB@BB@@BBB@@@BBB@B@@@B@@BB@@@BBB@D@@@BA@@BB@@B@@@BBB@@@@@@C@@@AA@@A@A@AB@D@B@B@@@B@@B@@@@@@B@B@B@@ADA@@@@@AB@B@BA@@@ADA@@BA@A@AB@@A@@@@@ABA@ABA@AB@@A@@@@B@B@@AB@@A@@BA@A@@@BBDD@BBD@@@@AB@@B@BBBA@@B@B@@@B@@@@@B@@BB@@@B@@B@B@@@B@BAB@B@@@@@@@B@@@DBB@@@@@@AB@B@BD@@@B@@B@B@@B@@@@B@@@@A@BB@@@BA@@@@@@@ABB@@@@@BB@B@BBBBB@@@@@A@AB@@@BA@AB@B@@@@AB@@@B@BAB@BA@@B@B@B@B@@@@@BABABADA@A@@@@BA@@@A@A@AB@BAB@@AB@B@DBB@B@B@D@B@B@@@B@@BDB@@BA@A@@BDB@@BB@@B@@@@@@B@@@B@@@BB@@D@@BB@BA@@@ABA@@B@@@BBBBB@@@@@BBBB@@@B@BB@BB@@@BBD@B@@@@B@D@BA@AB@B@@@FA@@B@@@B@B@BA@A@@@A@A@@@@@@@A@@B@BA@@B@@@BA@AB@@A@@@@A@@@@@@@A@CAAA@@@@@C@@A@A@A@@@@BC@@@ABA@@AAA@@A@A@A@AA@A@AE@@@CA@@AABA@A@ABA@@@A@C@@BAD@@BB@@AB@B@@AB@@@BA@AD@@A@A@A@AA@@A@@@AB@@AB@BABA@@BBB@@@B@@@B@@@B@BBB@@@B@B@B@@@@A@@BADAB@BCBCDCDE@@@@BB@@B@@B@@B@@@BA@ABAB@@@B@@AB@B@B@D@@@@A@@B@@@BA@@AA@ABCB@@ABA@@@@@A@AB@@@BA@@B@@A@@@A@ABAB@@ABA@A@@@AB@@BBAB@@@B@@@B@@@B@@@@A@@BA@@BA@@BA@@@@B@B@B@@AA@@A@@BCBABCB@@A@A@@@@B@@A@@B@@AB@@BB@@@AA@@BA@A@@BA@@BA@BBA@BB@@@@B@@@@@BB@@BB@BBB@BA@@B@@BB@B@B@DBBB@@B@@AB@@@B@B@BBB@@B@@B@B@@BB@@@DDB@@B@@BB@B@@BBB@@BBBB@BBBBBB@@@B@BB@@B@@@@@B@BB@@BB@@B@@@@@B@@A@@@@BB@@@@@@@BB@@@@@@@@BB@@@@B@@@BB@BA@@B@B@B@@@B@@@@@B@@BB@@BA@@@@BA@@B@@ADCD@B@B@@AD@@@BAB@B@BAB@DAB@B@DAB@D@D@BAB@B@B@B@BBB@B@F@@@DC@@@@@AD@@@@BBA@@B@@@@BBA@@@@B@@@@@@BD@B@B@@@@AB@@@@@B@BBB@B@@BA@@B@B@@@B@@A@AB@@@@@@B@DA@@@A@@B@@@@@BB@@B@@BB@@B@@@@@@@@@A@@@A@@@A@@@A@@@@@AB@@@@@@A@@@@@@@@@BB@@@@A@@B@@@@@B@@@@@@@B@@@@A@@@@@BB@@@@@B@B@@@B@@@B@A@A@@@@AB@@BB@@@@A@@@@@A@@BA@@@@@AB@B@@@@B@@@@@@BAB@@@@@BA@@AA@@@A@@@@B@BBB@B@B@@@AC@@@A@ABA@A@@@@AAB@AAA@@A@A@@AAA@@AB@@A@ABA@@@@B@@@B@@B@@BA@@BAB@@A@@@A@AB@BA@@BA@A@@@@E@@@@A@A@AA@@ABA@C@@AA@@BA@AB@@@A@@@AA@@@A@A@A@AA@@A@@@@AA@AA@@@B@@@BBB@B@@@B@@@@@B@BB@@D@AA@@@AAA@A@@@AAA@@BA@@@@@A@A@@B@BA@@@@@@@AACBC@A@@@AA@@@A@@A@@@@AA@@@@@A@@@@@A@@C@@@A@@A@AA@@AA@@A@CA@@AAA@@AA@@A@@@@@@A@@AAA@AAA@AA@AB@@AF@BA@@D@BA@AB@BABAAAB@@A@@B@@A@@@@A@@@A@@@A@@@AAA@AA@@@C@@@@D@@AB@B@@AB@@A@A@@@@@A@@BA@@@@@AAAACAAA@A@AAAACAA@EECA@A@@BABA@@B@@AB@B@@AD@FA@AD@B@BAB@@ABA@@@@@A@@BC@@ACB@@A@AB@@A@@AAAA@ABC@@@AA@AA@A@@@@@@A@@A@@@@@@A@@@@A@@@@@@CAA@AA@@A@@@@@@@@@@CBA@C@A@CB@@@B@BB@AB@@A@@@A@@@@@A@@@A@@@@A@A@@@@AAB@@@AA@@@A@@A@@A@AA@@A@A@C@@@A@A@@@ABA@@AAA@@@A@AA@A@A@CA@@C@@@A@AA@@A@AAA@@@AA@@A@@@@@@@@@A@@AA@A@AAAB@@@B@B@BA@@BAA@@C@A@@B@@A@AB@@ABCB@@AB@@AB@@AAA@@@AC@@A@@A@@A@@@A@AAA@@BA@@@A@@BABA@@@A@AA@@@@AAA@A@@AA@@@CAA@@@CB@@@@@@AA@ABA@A@@A@A@@@C@@@A@AAA@@@@@AA@@AAAA@AA@A@A@AAA@A@@@A@@AAA@@@@BC@@@AAACCAAA@AAC@@A@@@AB@@A@@@AB@@@@A@@AA@@@A@@@A@A@ABA@A@@@A@A@@@A@@@A@@A@A@@A@@FCBA@A@@@AB@B@B@B@@ABA@ABC@ABA@A@@B@B@@@BA@@B@BA@@@@ACAA@@B@@@BAB@@A@@BA@@BAB@B@@A@@B@@@@@@B@D@BB@D@B@@B@@B@BAB@B@@@@@@@BA@AB@B@@ABABA@@@@BAB@BA@@B@BABA@@@@@@@A@A@@@C@@@@@A@@@A@@@AF@@@B@@A@@@@@A@@AA@A@@@A@A@@@A@ABA@@BA@@@A@A@@@A@@C@@@A@@A@A@@A@A@C@AA@@A@A@AAAA@AAA@BA@AAA@@B@@AA@ABA@@B@D@@@@@@AA@A@A@@@CA@ACA@@CA@A@@@@@@@A@A@A@ABA@A@@@AB@@A@@@@@@@AD@BA@A@ABA@A@@@@A@@@@AAA@A@@AAAA@A@@@A@A@A@A@A@A@@@A@A@AA@@A@C@@B@AA@@@@@AA@@@@A@@@A@@BA@@@@@AB@@A@@@@B@BA@@@AAAA@@@AAA@@A@@A@@@AA@@A@A@@@@A@A@@B@@A@@B@@ABAA@@@AAAA@A@@@A@@A@@AA@AA@@@C@AAA@A@@C@@@@@A@@A@@@@@A@A@@A@@@@A@A@A@@C@AA@@@A@A@@@A@A@A@@A@A@@@AA@@@@@A@@B@@@AA@@@@@@@A@@@@@AB@@A@@@A@AB@A@@A@@@@B@@@@A@@AA@@A@AA@@@A@@AA@@@@@@@@@@@A@@@@@AA@@@AAA@@@C@@@AA@@AA@@@@@@A@@AA@@@AA@@@A@@AAA@@@A@@@BA@@AC@@AA@AAAB@@@@ABG@A@@@@@A@@A@A@@@@@@@@@A@@@@A@@@A@@@@@BA@@A@@@AA@@B@@A@@@ABAB@BA@A@ABC@@@A@@AA@@A@@@@AA@@@@@@@@@@A@@@@@@@A@@@@@@AA@@AAAAA@@BAA@@A@@@@AAACAA@A@@@A@@@ADA@ABA@@BABA@@BA@@@A@A@A@AAC@AA@@A@@@A@AAA@@AA@@@A@AA@@A@@AC@AA@@ACCA@@@@A@@C@A@@@A@@@@@@AAA@@CC@@A@C@A@A@AABAAAAAAA@@@A@@D@DALGBAB@DC@@BCDCDCBAB@@A@@@@B@@AB@B@B@BA@@@@B@AA@@@AA@A@ABA@@A@@@BA@AA@A@@CA@@AC@AAB@BA@ABA@A@@@@@@AA@C@AA@@A@AAC@@@@BA@@@ABA@@@@@CBCBABAB@@ABE@@@ABA@A@AB@@CB@@AB@B@BA@@@@@AA@@@@@@@A@@AA@@AA@@AA@@@@AC@AAAAAAA@@CA@AA@@CA@A@A@BA@A@@A@@A@@@A@AAAAA@@CAAA@@A@AA@@@@@A@A@@CAAA@@A@A@A@A@@D@@A@@@AA@@A@@BA@@@A@@@A@@@C@@@ABAA@@A@A@@@CC@@@AAAA@@@@@AAA@AA@@@@C@@@A@@A@A@A@C@@A@@AB@DA@@B@@@@@@BBB@@@@HA@@B@BAB@@@B@BBBB@@BBB@@B@@B@@@B@@@B@B@@A@@@AA@@@A@@@@A@A@A@A@A@A@@AA@C@C@@A@ACBAB@@AB@BA@@@ABA@AA@@A@@A@@@AA@@CA@@A@BAD@@A@C@CAC@@@@A@A@@@A@EA@@AA@@A@CAAA@@A@@AA@@@E@@@@BABAAAA@@@@AA@@@@A@@A@@AACA@ABA@@B@DB@@B@@CB@AA@@@C@A@ABA@@B@@@BA@ABAC@AA@AA@@A@@@A@@@AA@A@@@@A@@@@@A@AB@@@@@@@@C@AA@AA@@C@@A@@AA@@CC@@A@AAAA@@@A@@@@A@AB@@@@A@AC@@AA@@@A@@BA@@@@A@AAA@CACA@A@A@ABA@@BA@A@@@@AA@@@A@ABA@@B@B@@@@A@AB@DAB@BA@@@C@A@@A@@@A@@@AA@A"],encodeOffsets:[[109475,24062]]}},{type:"Feature",id:"451023",properties:{name:"平果县",cp:[107.589809,23.329376],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@AAA@@@@@A@@A@@A@@BA@@B@@@@A@@@@B@BA@@AC@@AA@@AA@@@@AC@@@@A@BA@AA@A@@A@@@A@A@@B@@ABA@@@@@@@AB@@@@@BBBABB@A@A@AB@@@B@AA@@@@BA@ABA@AAA@@@A@AB@BA@@@A@@@CBA@@@@@A@@AA@@ACA@@AAABA@A@AA@@A@AA@@@@@@AB@@@B@B@@A@@@AB@@@@AB@@AB@@ABBDA@@BE@@@@D@@@B@BA@@B@B@BB@@B@BBB@@@@@B@@@D@DDB@B@@BB@@@B@BA@A@@@A@A@@@@BAB@B@B@@A@ABA@@@AB@B@@@@@BAB@@@@B@@@@@BB@@@@B@@B@@AB@@@D@B@B@@@B@@@@AB@@@B@@@B@DB@A@@B@BA@@@E@@B@@@B@@@B@@A@@AA@@@A@@@A@@B@@AB@@BB@@@BA@@B@@@B@BB@@B@B@BB@@@B@B@@DDBBB@@@B@BAB@@ADA@@B@D@@@BAB@@AB@@A@@@@@@@@@B@AB@@A@@B@@B@@BA@A@@@@B@@@BB@@@B@@B@@@B@@@@BB@@@B@@@@@B@B@@@@@BABBB@B@@@B@@AB@@@@@@AB@@@BA@@B@@BB@BBB@B@@BB@B@@B@@@B@@BD@@@BB@@BB@@AB@@@@@B@@@B@BABABA@@D@BA@@@BB@@BB@BBD@BB@@DB@@BB@@B@BBBBB@BBB@@@AB@@BB@@DF@@B@@@B@@@@@BAB@@A@@B@@@BAB@B@@@B@B@@@DBBBBB@@@A@@A@B@@AB@@@BABA@AB@@@A@@@A@@A@@AAAAEA@A@A@@BA@@B@BA@ABABA@E@@BA@@@AA@@@@BC@@@A@@BAB@BA@@BAA@@A@A@BC@A@A@@@AA@@@@AA@AA@@A@@@ABA@@@@@A@@B@@@@@AA@@A@@AACAA@@AAA@@AA@@B@@@@@@A@@@@A@@AA@@A@@@@A@AABA@@BA@@D@@A@@AA@ABA@AB@@@B@@@@C@A@AAACAAA@@@@@@A@CCA@AC@@@@AC@@@ACAAA@@@A@BB@@@@A@@B@@B@@BA@@@@@A@@@@@A@@@A@@A@@AA@A@A@@A@@@@AAAA@A@@AAAAA@CAA@A@@AA@A@AAA@A@AAAAC@@A@@A@@AA@@@A@CA@AA@CA@@@A@C@ABCAA@ABA@C@ADC@@@@@A@ABA@A@E@@CAAAE@CB@ACDACA@BEBED@BADBDBPD@ABCCCACAA@C@GABBB@BA@A@A@@@@@A@A@@A@@C@@A@@A@@@ABAB@AABAA@@@@@A@@A@@A@@@@A@@@A@@@@@@@@B@@@@@@@@A@@@@@A@@A@B@B@B@B@@@B@@@@@B@@@@@@@B@@@B@@BB@@B@@@@@@@B@@DA@@B@@A@@B@@A@@@@@A@@AA@@@A@@@A@A@@@@@@@A@@@A@@@A@A@@@@@A@@CC@A@CAC@@B@B@D@@@@AAA@@@@@AB@BA@AAAB@@@B@@A@C@@B@BC@@AA@A@@AA@@@AA@@A@A@@@@@A@@@A@A@@A@@A@C@A@A@@@@D@B@B@@AB@@A@@@AB@@CA@A@@C@@@@@BA@A@@@@A@@A@@@@@A@@@A@AAB@@@@ABA@@@A@A@@@@@C@A@@@AA@@@A@A@@AA@@A@A@CA@@@@AA@@C@@@A@@AA@ABCA@A@AAC@@AA@A@ABA@@@@@A@@BA@@@A@@B@@A@AB@@A@A@@@AAAA@@@@@AA@AAA@@B@@@@@B@@@B@@@@@@AB@@A@@@@@@@AB@@@@@A@B@@AA@@@@A@@@AA@@AAA@BB@AB@B@@@@A@@A@A@@A@A@@AA@@A@@@AA@@@@A@@A@@B@@@@A@@A@A@@@BAAA@@@A@B@@AA@@@@BA@@BA@AA@ABA@@A@@@@@AB@A@@DA@@@@A@@@A@AA@@A@@@BBA@@@@@@AA@@@@@A@@@AB@BA@@@@CA@AB@@@A@@@A@@@@A@@@@BA@@@AACAA@@@A@@A@@@@@@@B@@@@A@@@@@A@@A@@@@A@@B@@@BB@A@@@A@@@@A@A@@@A@@A@ABA@A@@A@@@C@A@AB@B@@@@@@A@@A@AA@@B@@A@@A@@@BA@@B@@B@@BA@@@@BB@@BAB@@@@A@@@@@@A@@@BA@@AA@A@@A@A@@@@@A@@@@A@@@@@@@A@@A@@@@B@@A@@@AB@B@@@D@@@@A@@@AAA@@A@@AA@AAA@@@A@BA@@@AB@@A@@@@A@A@AAA@EA@@@@@AB@@A@A@A@A@@@@@A@@BE@A@ABCAC@C@@@A@@@A@A@AA@@@B@@@BA@@@@AA@ABA@@@@@A@@@ABC@C@AAA@@@EA@@CA@@@@A@@@@@@AAAA@AACAA@AAA@A@G@C@A@@@BB@BBD@B@D@BA@ABCBEBA@EACBCBE@CBG@ABA@A@A@CBGDEBE@A@@@A@CBE@A@@AA@@AA@C@@@CD@@A@@@AAA@CAA@AAAB@@@D@BA@A@@@@@AA@AA@ABAD@@AB@@@@@@@A@A@A@@@A@@@@AAA@AA@ABA@@@AB@@A@@B@A@AA@@BA@@@@A@AA@@CA@@@@A@@@AB@@AA@@A@@AA@@BA@A@@@BE@@B@BA@@@@@AB@@@@@BA@@@@@AB@BB@AB@@@@ABA@@AA@@BA@A@@@@@A@@@AA@@@@A@@@@@AB@@AA@@@AABA@@@@@@@@@A@@@@@@A@@A@@A@@A@@@@@@@@@A@@AA@@@@A@@A@@@@BABA@@BA@@BAB@@@@ABAB@@ADA@@@@@A@@@@BA@@B@@@@A@@B@@@@@@CA@@A@A@@@A@A@C@AA@CAA@@AAAA@@@B@B@B@B@@A@A@AB@B@BA@@@AAA@AA@AAA@@@@@CA@@CA@@A@AA@@ABA@CB@BGB@B@@AAA@@@@@@@A@@@@@A@AAEE@@BABA@@BA@ABA@@D@@@B@@@DA@@@@B@@@@@@A@@A@@@AA@@@AA@@@A@@B@@@C@@AC@@B@@A@C@@CAA@@A@@@A@A@@@@@A@AAAAB@@@@A@@@@BA@ABA@A@@B@@ABA@@@@@C@@A@@AA@@@@A@@BAAA@@@@@A@A@@@@@C@@@A@@BA@@@@AA@@@AB@A@@A@A@AB@@A@ABC@@@A@C@A@@D@BA@@@@@@BCAA@@@A@AA@B@BAB@@@@A@A@A@@B@@@BA@@@BDB@A@AB@@AB@@A@AB@BA@AAA@@@AB@@@BA@AA@BAB@@A@AAA@@B@@A@A@@AA@ABABA@@@A@@@A@A@@@@D@@@B@@@BBB@@AB@D@@E@@BA@C@@B@@AA@@@@@ABA@CA@@ABA@AA@A@A@A@@@A@@@A@@BAB@@CD@@@@@@AA@@A@B@@A@A@@@A@@A@A@A@A@ABA@AAAAA@@AB@BABA@@BAA@@@C@AB@@A@@@ABA@A@@@CBABBB@BA@@B@@@B@B@@BA@@B@@@D@@@BDBB@@AB@BCD@@BBBB@B@@BB@@@B@@@@@B@@DB@@D@@@@@BB@@B@@@BB@B@@@@@BB@@BAB@@B@A@@@@BA@@@ABA@@@A@AB@@A@A@CB@@@@A@A@@AC@AAA@CA@@AA@@A@@@A@BBA@@@BBABC@@@A@CB@@@A@@A@@@@A@A@A@@@A@AA@AB@@A@C@@BA@@@B@@@@B@@B@@@@@@@@B@@@@A@@B@@AB@@@@AB@@@@@@@B@@A@@@@@AB@@@@A@@@A@@@A@@B@@AA@@@BA@@BA@@@@@@B@@A@@@@@AAA@@AA@AA@@A@@@@BA@@BAB@@AAE@A@ABC@@A@@@AAA@@AAA@@@@@@@A@@@A@A@A@@@@@AA@@A@A@@@A@AAEAAA@AA@@AA@AACA@@@@@@@CAA@@A@C@@@@@A@@@A@A@A@@@@B@@AB@B@@AB@@@@A@@@@A@AAA@B@@@@A@@@A@@@A@A@@@@BAA@@A@@B@AA@@@A@A@@B@@@@C@AB@@@B@@ABA@A@@@@DAB@B@@BB@@@BAD@@DB@BB@@@B@@@BB@@@@B@@BB@@@BB@@@B@B@@A@@B@B@@BB@@BB@@@@A@A@@BA@A@A@@@ABA@A@@B@@AAA@@A@@A@@@@A@@C@@@@@CB@@@@AA@@A@A@A@@@AB@B@@@B@BB@@B@B@BB@@B@@ABAB@BBB@B@B@BAB@@A@A@@@A@A@A@@D@@ABA@@@A@@B@B@@AB@@ABA@A@@B@B@@AB@@@D@@AB@@BBA@@B@B@@@B@B@@B@@@BBBB@@@B@@@BABCA@B@BABA@BBB@@@B@@@B@@B@B@BB@@@B@@@@D@@@B@B@BBB@@@B@@@B@B@@AB@@@BABA@@B@B@@@B@@A@@@A@ABA@@A@@@AA@A@@A@BA@@BA@@@ABABA@@DAB@@B@@BB@A@BB@@@@@@@B@@C@@BA@@@A@@@A@@BAB@@@@A@@BA@ABCAA@A@@@@@A@CACA@@A@@A@AA@@@A@A@@@A@@BB@B@AB@@@BAB@@@B@B@@A@@@CDA@@AAAA@A@AA@@CA@AA@@AA@@@@@A@A@AB@@A@AB@@A@@B@BA@C@@@@BABB@BBBB@@@@ABB@@@DBB@B@DBB@@@BA@BB@B@B@B@@BBBB@@DBD@@BD@@@D@@BB@@F@BB@@B@@AB@B@B@@B@@B@@@BBDBB@BB@B@@@D@@@B@B@@B@@B@@AB@B@@B@DBB@B@B@BBB@@DB@@BED@DA@BBB@D@@BB@B@BB@@BBD@BB@@DD@@B@BD@BB@BB@B@BABB@@BB@B@@BCD@B@@@B@BAB@BAD@B@BBBBB@@@B@B@BADA@A@@@AB@B@@A@A@@@@B@@@BC@@@@BAD@@@B@BBB@@FD@@@@@@B@@@B@@A@@@@@@@B@@DA@@@B@@@@@@@@@@B@@@@B@@@@B@@@B@@@@@B@@@@@@@@B@@B@@@@@@@@BB@@@@B@B@@@D@BBB@@B@@@@BB@B@@@B@@@BB@BBBB@B@BADBB@@@B@@B@BDBB@B@@@BB@@BBBAB@@@B@BA@@D@@@BD@@B@B@@@B@@A@@DCBA@@BA@A@@@AB@@@B@BB@B@@BB@A@@@@B@B@BDBBBB@BBB@@B@B@B@B@BB@@@@@B@@@B@B@D@@ABAB@@AB@BABA@@BA@BB@@@@BB@@@BBB@@A@@@ABA@BB@BBB@@BBBB@BDDBBBBB@B@DDBBBBBB@@BBFABA@A@@BA@@@AAA@@BABAD@@AB@BA@@B@BA@@BABA@@AA@@BABA@@D@DA@@BA@@B@B@BBB@B@@@D@@@ABA@@BABA@A@ABA@A@@@@B@B@@A@ABF@D@@@@BAH@D@@AB@@@@BB@B@@@@ABCBA@CFABABADABAB@B@B@@B@DB@@@B@@@B@B@BAB@BAB@BBBAD@B@BBB@@ABEDA@A@A@@BABA@AB@@A@FFBB@BB@@D@B@BB@BBB@BBD@BBB@@@@BBB@B@@@B@B@BBB@B@FFFBDBB@@@@B@@@DB@@@@@B@@AB@@A@A@@@A@AB@@@@AA@BA@@@ABAB@@AB@B@@A@ADA@A@A@CD@@DB@DBB@BABAB@@ABHF@@BBB@BB@@BAB@B@BB@@@B@B@BA@@BABA@AB@@CB@@A@AB@@@BBB@BAB@B@@@@BDC@AAA@@@AC@@E@@@AB@@AB@@BDA@A@CB@BA@@B@@@BB@B@@@BB@BB@B@B@@@BA@@@@B@D@B@@@@@BB@@@B@B@@@B@BAB@B@B@BAB@@@@@BD@BB@@@BBD@@BFAB@D@B@B@D@D@B@@ABA@A@A@@@@B@@AD@BCBABAB@@@@C@CAAAAAA@E@CBA@@@ABAB@@DB@@@@BDABBD@@@B@@@B@@@@A@A@A@@@A@@B@@@B@@@B@@@@A@A@@B@@A@@@A@@A@@A@AB@@@@AA@@A@@@@@ABAAA@C@A@@@AB@@@@A@@@@@AA@@AA@AAAAA@A@@AAC@AA@@A@@@A@ABABA@@B@@@@CB@@ABA@@@@@@@A@@@A@@BA@ABA@AB@@@BA@CB@BA@@B@@ADABCDA@@BADA@@B@@@B@@B@@@AB@B@@@BB@@@CD@BCBAB@@@@B@@@@B@B@@@B@@@B@@B@@@B@@@BB@@@BBBBB@@BBBB@D@@@@@@@@B@@@@@B@B@DA@@BBB@@BB@B@DB@@BBB@@A@@BB@@@@F@B@@@@@B@BABA@AB@BBB@B@B@@@BB@@B@@A@@B@@AB@@@D@@AB@BB@@B@@DAB@@@@BBBD@@@B@@B@@@@BB@@@BB@@@@@@B@@BBD@@@@BBB@@@@@BB@@BA@@D@BAB@@@BAB@B@B@BAB@@@B@BAB@B@BBB@B@B@B@B@B@@AB@@@B@B@B@@@B@@B@ABBBA@@B@@@B@@@@@B@BA@B@@@@@BEDA@@B@@@@@@A@@@@@@BC@@@AB@@A@C@AB@@A@@@@B@BAB@BA@@@@@A@A@@@A@A@@@A@@B@@A@A@@B@@@@AB@@AB@@@B@B@@@BA@AB@BB@@B@@@@@BAB@@@B@@A@CBA@AB@@AAAB@@EB@@AB@@@B@BBBBBAF@@B@@B@@AB@@@B@@@@B@B@@@@BD@@@B@@B@@@B@D@@B@@@B@@B@@@B@@@B@@B@@B@@@@A@@@EBA@A@A@C@AB@@C@@@AAA@@@@@AB@B@@@@A@ABC@@@A@AAA@A@@@A@A@A@C@@@ABA@AB@@A@A@@BABABC@A@ADAB@BAB@@GJ@BB@@@@DBD@B@@@BA@@B@@DB@BB@@@D@@@B@B@BBB@B@@@@BAB@@BB@B@@AB@B@@@D@D@@B@B@@B@B@@BD@@BB@B@@@DA@A@BB@@BD@B@@@@@BADA@AB@BABCFA@@BA@C@AB@@@B@@AB@B@@@@@@BB@@@BBBB@@B@B@BBB@B@B@@@B@@@B@@@B@@ADAD@@@@BBB@BBB@@A@BB@DF@@@@@B@B@@@B@@@B@@@@ABA@AB@@BB@@AB@@@BBB@D@@B@AB@@BBDD@@B@B@@@B@BBB@@BADA@@B@@AB@DCD@@@BA@@@C@A@AD@@@@@DBB@@BBDB@B@@@B@@DB@@B@@@@@BBB@B@@@B@B@@@B@@BB@B@BA@@BB@@HAF@B@BAB@@@AACA@A@@@AB@@@DABA@A@A@@B@B@B@@@B@@@DAB@@@B@B@B@B@@@B@@A@@@@BB@@BBB@DBB@B@@@@@@@@@@@DB@BB@B@@@B@B@DA@@D@B@@@@BABA@@B@B@@A@ABA@@DBB@@BB@@@@@AB@@@B@@@@AB@B@BAB@@@AC@AB@B@BBBB@@@@B@D@BA@BBAB@@@@A@A@@B@B@@@B@BA@@@BB@@ADA@@@BB@@@BBBB@@B@@@@@B@@A@@@@@@DB@@BABBB@@@@BA@@B@@@D@@@DA@B@DB@@@BBDDB@BBB@B@@@@B@B@@@@@@B@B@@BB@B@B@@DBB@BB@B@D@@@B@@@B@@B@BBFA@BB@@@D@B@B@@@B@@B@@BBDB@@BBBB@@A@A@@@A@@B@@@@@BBB@@A@AB@@@B@@BFB@@@BB@BAB@B@B@@@B@B@@@@B@@BB@@AB@@AB@B@B@@AB@@@BABA@A@@@ABA@@BA@@B@B@@@BA@@@@B@DBDD@B@@A@AB@@DD@D@@@@DB@B@BB@@@@BAB@BB@@B@BB@@@B@@@B@@@@BB@BBBB@@B@D@@@@@B@BBB@DBB@@@B@@@BB@@@@BB@B@@@@B@@@B@@@@EB@@A@@@A@@D@@A@@@BD@@@BAB@@A@ABA@@BA@@BB@BBB@@DB@@FBB@DA@A@@@ADAB@@AB@@@@@@A@@B@B@@A@@BA@BD@@@@@BB@@BA@@@@@A@@@AAA@AB@@A@@AA@@A@@@A@@@@@AA@@AA@@@AB@@AB@@@@@B@@AA@@AA@A@@@@@AA@@@@CAC@A@@AA@A@A@AA@A@@AAAA@@A@@@A@AA@@CA@@@A@@@A@@B@@A@A@@BA@@BA@@@A@A@@@AB@@@@A@A@@@@@AB@BA@ABABABA@@B@BAB@BA@@B@@@B@BA@@AA@@BA@@B@@A@@@@@AB@@@@@B@B@B@DBDB@BB@@@BA@@B@@@BAB@B@@@@A@@@@@AB@B@B@B@B@B@@BBBB@@@BA@@BADA@@B@@ABC@@BE@@@C@@@@AAAAA@A@@AA@@AB@@A@@BAB@@ABC@A@@BA@AB@@@B@@A@@@A@@AA@@AA@@AA@@@@AC@A@@@@AAAAAA@@B@@AA@@A@A@@@@BABA@@@@BAB@@@B@@@B@@@B@B@BAB@BA@ABA@@B@@AD@@AB@@ABAB@BA@@@@BAB@BABBB@@@B@B@B@B@BA@@B@@AB@@@BBB@@BD@@@@AB@@AA@@A@C@CBADA@@BBB@@@B@BA@ADAB@@@@BB@@@DA@@B@B@@@BADAAA@@@@@A@@BA@BD@@@B@@@B@@@@@BBB@B@@@BA@@@@B@@@BB@@D@B@D@BAB@@@DAB@@@BAB@@@D@B@@BB@@@@B@B@@BBBA@@BB@@BBD@@@B@@@@B@D@BB@@@@@B@@@@@D@@BBB@@BBBB@B@BBBB@B@@@@BB@@BD@@@@B@@@BB@B@@@@B@BB@B@@@B@B@@@B@@@@BBD@@@BBBB@@BD@@@@B@B@@@BAB@@@BB@BB@@@@@@B@@@B@BA@A@@@A@@AA@ABA@@BA@@@A@@AA@@@A@@@AD@BABBB@@@BB@BDB@@BB@@@BB@B@B@BB@AB@DABA@A@@@A@@@A@@@A@@@A@@@A@A@A@@@@D@B@B@@BB@@@DB@B@@@B@BB@BB@@BA@@B@@@BB@@B@B@BAB@@AB@BAD@@@BB@@BBBABBB@B@@B@BB@@@B@D@D@DBB@B@@@BBD@B@BADA@@DBB@@BB@B@BAB@@AF@@A@@@@BA@@D@@@@@BA@@@A@AAAAAA@EA@@A@@B@@ABAAA@@AA@C@A@@@CAA@@@@C@A@@AC@@BA@@@A@@@@BA@@@AB@@A@AB@@@BABA@A@@B@BB@@B@BA@A@A@@AA@@BAB@@AB@@C@@B@BA@@@@@ABA@ABA@@AA@@AA@@B@B@@AB@@A@A@AB@@@@@BAB@@@BDB@@@B@@@B@BA@@B@B@B@B@@@@@BB@@@BBA@@B@@@@@BA@@BB@@BB@@BD@@BBA@@D@@B@@@B@@@D@@@B@B@DBBB@@B@BBB@@AB@B@@@B@B@B@@BB@@A@@B@AAAA@A@@@AA@@A@@BAB@@A@AB@@@@CB@@@B@@@B@BB@@DB@@B@@BB@B@B@@@BB@A@@B@@@B@@A@AB@BA@AB@B@B@@ABA@@B@B@@@B@@@B@@A@@BAB@A@@AA@@@A@AA@@@@BA@AB@@AB@B@@@BABA@@A@A@AA@@@@@@BA@@BA@@ACAA@A@@BAB@AA@ABA@@AAA@DC@@@AB@@@@@@A@C@@B@BAB@@ABBB@@@BA@@@@D@D@@AB@BA@@@AA@@EB@@@B@B@@@BB@@BC@AB@@@@@BA@C@@AAA@@AA@AA@A@ACC@A@@B@@@@A@@@A@@@C@@@A@@BABA@@B@@AAA@A@@AA@@C@@@@A@@A@@@AA@AAAAAAAAA@A@ABA@@BAB@@A@@BC@@@A@AACAC@@@AACA@A@A@A@@@@@AAA@@A@@AA@@@@AAA@@@@@A@A@@@CA@@AAA@@A@@@ABABA@@ACA@@@C@AA@@A@A@@AA@@AA@A@@A@@@@A@A@@B@B@B@@@B@@AB@@AB@@@@AAA@@@@B@@@@@@AB@B@@AAAAA@@@A@AB@B@@@B@@@@@BA@@@@B@@@@@@CA@@AA@@ABAD@@@@A@@A@@A@@AAA@AAAA@@@A@@@A@@B@B@@BDBBB@@B@@ABABAB@@@@A@@AA@C@@@@A@@@@AAAAA@C@@AA@A@@@@ACA@@A@AC@@@A@AAAA@@@@A@A@@@AAAAAB@@A@@@A@@@AA@@AA@@@AAA@A@A@A@A@ADA@@A@@AABC@@A@A@@@@AAAAAAAC@@AAA@@@ACAAB@@A@AAA@A@AAC@@A@@A@AAC@A@AA@@AAC@AA@@@@BAAAAAAC@@AAA@@AAA@@CB@B@BAB@BA@A@@@@AA@@@AAAAA@@A@A@@BA@@A@AABA@@@A@@@A@A@@BA@@BA@@@A@@@@@ABA@@@A@@@A@@@A@@A@AA@@@AAAA@@C@@AA@@A@@@AA@A@@@@BA@@@A@@B@@@@A@@B@BA@@@A@@BAB@BA@@@@BA@@@AB@@@@ABA@@@@@A@@A@A@@@A@@@A@@A@@C@CB@@@A@AAA@@BA@@@@AA@A@@@@@@@A@@@@@A@@A@BA@@@@AC@A@AB@BA@@B@@@@AB@@A@@@AB@@@@CA@AA@@BC@@BADC@ABA@@BAA@@AB@@@@@@@B@@@@@@@B@@@@A@A@@@AB@@A@A@@@@@@@A@@BA@A@@@@AA@AA@@A@@@@BA@@@A@@BA@@@AB@BA@@A@@AB@@@B@@@AABA@A@@@C@@@A@@@AB@BA@@@ABA@AB@@@@@B@@AB@B@@@@AB@@@BA@@BA@@BA@@B@@B@@BAB@BABABABABA@@@AA@@ABA@ABA@A@@@A@A@@@A@A@AA@@A@A@A@@@@@A@@@A@A@@A@A@A@CA@@@AAABCB@@A@@@@E@@@@A@A@@@A@@@A@@@AA@@ABA@@B@BAB@@A@@@A@AB@@@"],encodeOffsets:[[110321,24062]]}},{type:"Feature",id:"451026",properties:{name:"那坡县",cp:[105.83253,23.387441],childNum:1},geometry:{type:"Polygon",coordinates:["@@@HBBBDB@A@@@@B@B@@@DDBBBB@DBA@ABAB@@@@A@AB@@@BA@@B@@AB@@@B@@@B@@@B@@@@A@@B@@@@@@AB@@@BA@@BABA@@BA@AB@B@@ABAB@@AB@@ABA@@B@FCJEP@LBHHHNDPBLFPFFHBLDPHPHJFFHHLPDJBBB@BBBB@@BFB@DBF@D@D@HBB@LAB@B@BB@B@@@BA@ABAB@D@B@@DBBD@BBB@@B@BADAD@FDDDFHJFDBFAD@DDDBB@BB@B@F@FB@FBF@B@HBD@D@BAD@BEBAJE@ABCAAEI@C@C@ADCHCDAD@BBFHBBBAB@FCF@DBBBD@BBBB@DAB@DEFCDA@ABADEBGJEDE@CAE@CBABAB@D@DBB@BDBF@HCD@B@D@DBBBDFDBBBBBBBAD@BABCDCBE@EBGBC@CBAB@B@D@F@DEDEFA@A@A@@AA@CBADIDEFKFIBEAC@A@MF@@BBHBFBJBJ@LAHALADABBBBFHBD@@DAB@FAB@D@DC@@JCDADAJAHDJHHFNLNLJFLHJHHJJLFJFFDDBJFFNFNDNDP@P@NBJ@HFDHBL@H@N@F@HBJDJJHFFDBTJRHPFPBP@D@JBL@LALEHGFIBKDIFEHEH@DBDDFDLHJBL@JIPILEJCDAJ@LAPCLALGFEDCBG@ECIIGCICE@EJKFAF@D@LHJHJL@DBBBB@BB@BBFLFDLHJHHBJDNBNBJBJDFFBDBDBJBBJED@DG@@CAAA@@@@AA@A@@D@@@BCB@BCBA@A@@B@BCB@@CB@@ABC@A@@@@D@@@BA@@B@BA@A@@@@@A@A@A@@@@BCBA@@B@@BB@@@@BBB@@@@BAB@BADB@@@@BB@@@@BB@@@@B@B@@A@@BA@A@@@@AAAAA@@A@BA@A@AA@@@AA@@A@@B@@A@@ACACBAB@@@BA@A@@A@@@AA@@@A@@A@AA@@AA@@@@BA@@AA@A@@@A@A@A@@A@@A@@@AAA@@AAAA@@@@AA@BA@@@AA@@A@CA@A@@A@@@A@@A@@A@@AA@@@BC@@ACA@A@@C@@AA@@@AB@A@@A@B@@A@A@@@AA@AB@@A@@AA@A@A@@BABB@A@@B@@@B@@A@@@@CC@@@@B@@AB@@@DBBABA@@@@@@BA@@B@@@BAB@@@DA@@B@A@A@@A@@C@@@AAA@AAAA@@@AB@@@@A@A@AA@ACAAA@A@@AB@@A@A@A@@@@D@B@BABBBA@@@A@AB@@@@BB@D@@@@@@A@@@A@A@@@@BAA@@@@ABAAA@@@@@A@@BAA@@A@@@C@E@@@AA@AA@@AAAABAA@@A@ABA@@@@@AA@@AB@@@@AB@@@BA@@AA@A@@@A@A@@@A@A@A@@@AB@@@@CB@@@AA@A@@@@DAD@B@@@@A@@@@@@AA@AA@@A@A@@@@@@BA@@@A@@@AAE@@AA@AA@BA@A@AA@A@@@@A@@A@@@A@@AA@A@@AA@AA@@@A@ABA@A@@AA@@AAC@@@A@@@A@A@AB@@C@AAAAA@@AA@BA@A@A@@@@A@@@AA@AA@@@CA@AA@@AAA@@@@A@@AAAA@A@@BA@A@@@A@@@AB@AA@CA@@@A@A@@@AAA@@C@A@@AABC@@@A@@BA@A@@@@@@C@@@AAA@AC@@@AAA@@A@@B@@A@@@@AAA@@@@@AB@@A@@@A@A@@@@ADA@@@AA@AB@@AA@@@ABAD@BA@AB@@@AA@@CA@@AA@@B@@C@AB@@@AA@@@@@A@AA@ACAA@@A@@@A@A@@@A@@@@@@A@@@AAA@CAA@A@@A@@A@@@ABA@@CAA@@@AA@@EAA@C@@@A@@@ABAB@@C@@@A@@@AB@@A@@@@@AA@A@@C@@@ABA@A@@@A@AB@@C@A@CBA@@AA@@@A@@@@AA@AA@A@@@A@@@AAC@@B@@A@@B@B@B@BA@@B@@AAA@@@AB@@@B@B@@@BAB@B@B@@@D@BAB@@@BABA@@AA@@@@A@@@@@A@ABA@A@AAC@@@@B@@AB@@@BA@@@C@@AAA@@@A@@AABAAA@AAA@@ABAB@@ABA@A@A@A@A@A@AA@@@@A@@@AA@@@@@AB@B@@@@A@A@@@A@@@A@AAA@@@@A@@@AB@BA@@@A@A@A@@AC@@@A@@@AAA@@@A@@@BA@@B@@ABAB@@@@CAA@CA@AABA@A@A@@A@ACCBA@ABA@@@A@@@A@AA@@A@A@ABA@AAAH@@A@@BAAC@A@@@CBA@A@A@@A@@AAAA@@AA@@AA@@@@AAA@@AB@@A@@D@B@@@B@@@AAA@A@@A@A@@@ABAB@B@@@B@@@BA@@BAB@@@@A@@AAAA@@A@@@AA@A@@A@BABC@@B@BA@@@@@@@@AA@@@@B@@A@@@@@@@@BA@@A@@@@@@A@@@@AA@@A@@AB@@AB@@A@@@AB@@AA@@@@@@@@A@@B@@@@C@@@@AA@@A@@@@A@@@@A@A@@AB@@@BA@@@B@BBA@@B@BA@@BBB@D@BAD@BABABAB@@@FB@@@BB@@@B@DA@@@A@@@AB@@@@AB@@A@@@@@A@@@@B@@A@A@@A@@AA@@@@A@A@@@@ABA@@@CB@@A@@@@A@A@@@A@@A@@A@@@A@AC@AACA@@@@@@@@D@BA@@B@@@B@@@@@AA@@@A@A@AA@AAAA@AA@@@@@@AB@@@@A@@BA@@@AAA@ABA@C@A@@@AB@AA@A@A@@@@B@BA@@@A@A@@BC@@B@BADAB@@A@A@A@@BA@@@A@@@ABAB@AAAA@A@@@@@A@@@A@@@@@A@A@@@AAA@A@A@@@A@@@@AA@@@C@AB@A@@@ACC@AA@@A@A@A@A@@@@@AA@@AA@@A@@AA@@@@ABC@@@AAA@@@C@@@A@AAA@A@@@@AA@@@@@A@A@@A@@AA@@@@@BA@A@@B@@AB@BA@A@A@CAA@@@@@A@@BA@A@@@@@A@A@A@A@@@@@AAA@@B@BA@@B@@@@@B@@@BA@@B@BA@@@@B@@ABBBA@@B@@@@AAA@ABA@CA@A@AAAAA@@AAA@@@A@A@A@@@@@A@AA@@A@@@A@A@@@A@A@CA@A@AA@@A@@AA@@AB@DAB@B@D@@A@@@@@@AA@@@AABA@A@@@A@AACACAAA@AA@@AC@CBA@A@@@AA@@@@AA@A@ADA@A@AB@B@@A@@B@@AB@@AB@@@D@@@BA@@@@AC@@BA@A@A@A@@@A@ABAB@B@@A@@@A@A@A@@A@@A@A@@DAB@BA@A@ABCBA@AB@@A@BBA@@BA@A@@@@BADADA@AB@BABA@@@@@@B@BBDBB@@AB@B@@@BCBC@@BC@C@@@A@@BC@@@@B@BAB@B@@@FBD@BBBBB@@B@@BB@B@@DBB@BAB@B@B@B@B@DF@BB@B@B@B@B@B@BBB@@@@AB@@@B@B@FC@A@AB@@AF@@@BABAD@@@@@@A@AA@@@@AB@@@@A@@B@BABAB@@A@@@@A@AA@AA@@@CE@A@@@@BA@@@A@@@@@@AAA@@@@@@@DA@A@A@A@C@@@A@@@A@@A@@A@@@AC@@AKBEEEAGEA@GGAABADCHKBK@K@KCECCGGCECA@@A@@@A@A@@@@A@AA@A@A@EBIDEFCBKBGBCBEBG@E@A@ACBIAEAAEAIAOAIBGBGDCBCD@D@@C@IEKEGAIBIAC@EAAACEAC@IPIAGGAQ@IBK^EJEHSHCDBXIFGL@@KHAT@@@FCJKDKDI@SBMDMNEJABGHEFCDCDIHMDIHIFABIAC@MAMBKHCT@NINEFCBE@@@MGM@MDSB[HUJQLQFC@I@EICCKKKCOFIDSFIBMCGCGCI@GAEBGDEHELENBNFJBLHL@FGR@HDLBTAJ@N@BARCRGPCBEFADU@"],encodeOffsets:[[108076,23741]]}},{type:"Feature",id:"451024",properties:{name:"德保县",cp:[106.615373,23.32345],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CCA@A@@AA@@A@A@@A@A@@A@AA@@AA@AAAAAA@@@A@@A@@@AA@@A@@AC@AA@A@@A@A@A@AA@AAABA@@@@@A@@B@@A@@B@BEAA@A@C@EAAAAA@@AA@@@A@@CC@@ABA@@@@@@@@AA@@AA@AA@@@A@AA@@@A@ABC@@@@B@@@@@@BB@DB@AFB@@@BBB@@B@@@@ABA@@B@@BBBD@DBB@BAB@BA@@@@@CD@@ABA@@@@@AAC@AAA@@@CAA@A@AAAAA@AAA@AAE@A@AB@@A@@@C@@AA@@@AAABA@A@BBA@BB@@@B@B@@ABB@@B@@@@AB@B@@A@@BA@AB@@@B@@AB@D@BAB@BABABBBCBCD@@@DBDBB@B@BBDABAB@@ADADBBABAD@BA@@@@@A@@A@@ABAACB@@@@A@@B@@@@AB@@C@EB@BA@A@@@A@C@@B@@A@@@AAAA@@A@A@@@A@@@@@@BA@@BEB@@AB@B@B@@@B@@BB@@A@ABAB@BA@@BA@A@@BAB@D@@@@B@@@@BBB@@BD@B@@@B@B@@@BB@@@@@DB@B@@@B@BAB@B@@E@@@A@A@@@AB@@A@EBAB@B@F@BAB@B@@@BB@AB@B@@AB@B@@ABAB@BABA@A@A@@B@@ABABA@@@A@@BA@@BA@@@BB@@BB@@@BAB@@ABB@@@D@B@@@@B@@AB@BA@B@@BDB@@BBBAB@B@B@BAB@D@BA@@@@B@@B@@@B@B@D@B@BBB@BB@@@@@@@@ABAB@BABB@@B@BBB@BB@@B@@@B@BB@@@@BB@B@@@B@@B@B@B@@@@B@B@@@@B@@@B@F@@B@@B@@B@@D@@B@@B@@B@@@D@BA@@B@BDBBB@@DAB@@@BBB@B@@@@B@@AB@@BB@@@@@BABA@@@@@A@AA@@A@A@A@@@A@A@@@A@@B@B@BAB@D@BA@@D@@A@@D@BAB@@A@A@@@@@@B@@A@@BCBAB@@C@A@CA@@A@AB@@EAABA@@B@@E@@@A@A@AB@AA@A@C@AA@@A@C@CB@@AB@BABAB@@BBAB@@@BA@A@A@@@@@A@A@A@@@@@@@CAAAA@@C@@@@AA@@@AC@A@A@A@@BA@@@A@@BA@ABCB@@@B@@A@@BAB@@@@@@BB@@@BBB@@BB@@@@@BB@@@@@BBAB@@@B@@B@BB@B@@@BBBB@@BA@@@C@@@A@A@AB@BABAB@@@B@BB@@B@@C@@@ABAB@@@@BB@B@BAB@@ABABA@@BB@@B@B@@@@@@@BA@CBADA@ABC@@@@@A@AB@A@@A@@A@A@@@@CBABA@CDA@@@@@@B@@@@BB@@@D@B@B@BBBA@@B@@@@@B@@@B@@@DAB@@@B@@BB@@A@@B@BBB@@@@BD@B@@@@A@A@ABAB@@AB@@A@@BA@@DA@@BADBB@@@B@B@B@DB@B@DDB@@@@B@B@@@@@B@@@B@@BB@@@@@B@@@B@B@@@BAD@DA@AB@@A@AB@@@@CCA@@@ABAB@B@@@BAB@@A@CB@@@B@@@@A@A@@B@@AB@@@@A@AB@@@@@BB@@@@B@@@@@@@@A@@@@BA@@BA@@BA@@B@@@BA@A@ABA@@@A@AB@@@@A@A@@@AA@@A@A@@@A@@@AB@@A@@@AAAA@@@@BA@@@@AA@@@@BA@@@@AA@@A@@AA@@@BA@@@A@AB@@AA@@@@@A@@@@A@AAA@@@A@@A@@@@A@@@@@AB@@A@@@A@@A@@@@AAA@AA@@@A@A@@AAAA@@@@@A@C@AB@A@A@@@@@@BA@@@ACA@@A@@BA@@@@@CAA@AAA@A@A@@@@@ABABA@A@C@A@@@A@A@@@CBA@@BA@A@@BCA@@A@@A@A@@A@A@ABA@AB@@A@@AA@A@CAA@A@@@@A@ABA@@@A@@CA@@ABA@AB@B@@AAC@A@@@@@C@AD@B@@@B@@A@A@A@@@A@@B@B@BCAA@@B@@AB@@@@A@@B@@@B@@@@A@@@@A@@AAA@@@AAAAA@A@@@A@CAABA@@@A@@@@@A@AB@@@@AB@BB@@@BB@@@B@@@BABABABA@AAA@@@AA@B@@CB@@A@@B@@A@@BAB@@@@@B@@AB@DA@@BAB@B@@@BB@@B@@@@BB@@@B@B@B@@BB@@@B@B@BABAB@@A@@@AB@@@BA@AB@B@B@@@@B@@BABBB@@B@@BBB@@@BA@A@@@@B@DBB@@@B@BBB@B@@B@@B@@B@B@@@BA@@@@@A@AA@@@@A@@@@DABAB@@@B@@B@@@@@D@BBBBB@@@@B@@B@@AB@@BBBBABBBAB@@@@BB@@@B@@@B@@@BB@@@AB@@@B@@AB@@@B@@@BA@@B@@@B@B@@@@@BBB@@@B@@@@@BA@@@B@@B@@@@@B@@@BA@CBA@@BAB@@@BA@BBA@AB@B@@AA@@C@AA@@@AA@@@A@ABAB@@@@@B@@@@A@@@@@AB@@A@@@@@@@A@A@@@@@@B@BA@@@@@@B@@AB@@A@AB@@@@@@AB@@@B@@A@A@@@@@@B@B@@A@@@C@@B@@@@@B@BA@@@A@@@@D@@@B@@AAA@@B@@ABB@@B@@@@AB@@@B@BBB@B@@A@A@B@@BA@@@@BA@A@ABA@ABA@@@@@ABA@@@AB@D@@@@A@@@A@A@@@A@A@@@AC@A@@A@@A@AA@AA@@CA@A@A@@@@A@@BA@@@@BA@@@@BA@@@@@@D@@@@@BBB@@@B@@@BA@@BBB@@@@B@@@@B@B@@ABA@@@@BBB@@@B@B@@A@@BA@@@AB@@@AA@@A@@A@@@@@@@AA@@A@AA@@AAAAA@@@@@A@@@AA@A@@@CA@@@@@@AA@AAAAC@@A@@BA@A@@@A@@CA@@@@@AA@A@@@AAA@@AABA@AB@@A@@A@@CA@@BA@@A@AA@@@@AAA@@@AB@@A@CAAAA@AAE@A@@A@@@@AA@@AB@@@@@B@B@B@@@BA@@@A@@B@@A@@@AA@@AA@@ABA@AB@@@@BB@BA@A@@@@@@@AB@@A@@@@BB@@@D@B@B@@@@B@@@@ABAB@@@@A@ABA@AB@B@BAB@@C@@@@AA@@@@@A@@@AB@@@B@BAB@@@B@BBB@@@@@@C@CAA@A@@B@B@B@@@@CBA@A@@@@BBB@B@@B@@B@@A@@B@@@B@BA@@@AA@@@@A@AAA@@BA@AA@@@@AA@AA@@@A@@@@@A@A@@AA@@@A@@BA@A@A@@A@@A@AB@AA@@@AA@A@@A@@@@@AA@@@A@AAEAA@@A@A@@BA@@@@@CA@@@@@@AAA@ABA@AB@BC@AB@@@B@B@@A@@BA@A@@B@@AB@B@@@B@@@D@B@@@@BB@D@@@@BA@@B@@@BB@@@@@@@@AB@@@@@B@@BB@@@B@@A@C@@BBD@@A@AB@B@@CB@@ABB@@BABA@A@A@AAABA@@@ACA@@A@@ABA@@@A@@@AB@@CB@B@@A@@@A@AAA@@A@B@@A@A@@@A@@@@B@@@@BB@@@BB@@@@BA@@@A@@@A@@@AB@@@@C@C@@@AD@B@@ABAA@@@A@@AA@@@@@@A@AA@@@@A@AB@@A@CD@BA@A@AB@@A@@A@A@@A@A@@@A@@@@@AB@@CBA@@@A@@@@@A@@B@@@@A@A@@@AB@@@B@@@@AB@@CBA@@BA@@@A@@@ABAB@@BB@BA@ABA@@BA@@@A@@@@AAAA@@@A@A@ABA@@B@@CB@@A@@B@@A@@@AB@@AB@@@B@@@B@@CBAAABA@@BAB@@@B@BABA@@BA@@@@BA@CBAB@@@@A@@@A@@B@BA@@@@B@@B@@BBB@@BB@@@@@BA@@B@@A@AB@@BB@@@BA@@@ABA@@@C@@@@@A@@B@@@B@@A@A@C@@@@B@@@B@@@BB@@B@@AB@@@B@BBB@@D@@@@BB@B@@B@@B@ABB@@B@@B@@BB@@@B@B@B@@B@@@B@@A@@@AB@@AB@@A@AAA@@@@@@B@BA@@B@@@@AB@BC@@@@B@BB@@@D@@@@B@@@B@@AB@D@@@@B@@@DA@@DA@@BABBBA@@B@B@@@@@D@@ABBB@@@@AB@@BBAB@@@B@B@BB@@BBB@B@B@BB@@@B@BAB@B@B@BA@@B@B@D@@A@@@@B@@AB@B@@B@B@B@@@@B@@BB@DBB@B@BB@@@BB@@@@BB@@BB@BB@@BBB@@B@@@B@@@AB@B@@B@BB@@B@@@ABBB@D@BB@@@B@@@B@@BB@B@B@@BB@@@@B@BA@@@AB@BADA@@BA@@@A@AB@@@BBB@BBB@@@@AB@B@BBB@B@@@BA@@@@@@DA@@@@D@B@@BB@@@@BBB@@@@@B@B@BBBB@@BBB@@@BB@BB@@BAB@@@@A@A@CBA@@AAD@@A@ABAA@@@@ABA@@@A@A@AB@@@B@@AA@@@BAB@@CBA@@BA@A@@A@@ABABCB@B@@@B@D@B@BAD@@AB@D@B@@@BA@@B@B@D@@A@@AA@@@A@CDABAB@@@@@BB@@@@B@BABBDAB@F@@B@D@@@BBDB@@BF@BB@@BF@@@BDBBBBBB@B@BB@@D@B@BAB@DAB@@@@@@DA@@B@BA@@BA@@@@@AD@@@D@BAB@B@D@BAB@DABBBABADA@BB@@@B@@@B@B@@@@@BB@@DABBB@@@@@B@B@@AB@BAB@B@@AB@B@B@@@@A@ABA@A@@@@@A@A@@B@B@@@B@@@@@B@@@B@B@B@B@@@@BB@@B@@BBBBB@@@B@@@B@@@BB@@@B@B@D@D@B@@@@@BBB@@@@B@A@@B@@A@@@ABA@@BB@@B@B@B@@@BB@@@ABBBA@@B@@@B@BA@@B@@@@@BB@@@@@@B@@B@@@@@@@@BBBB@DB@@BB@@@@@B@@AD@@A@@B@B@@@B@@@BBB@B@B@@@BA@AD@@@B@@@D@@@BB@@B@BBBBBB@@@@B@@B@B@DBB@@B@B@@B@@@@@BA@@BBB@B@BAB@@ABAB@@@@@@BBB@@B@B@B@BA@@B@@@B@B@@@BBB@@@B@BABBBABBB@BA@@DAB@@@B@B@D@@@B@FA@@D@B@@@B@@@@@F@@@BB@BBB@@@@@B@@B@B@DA@@@AB@@ABA@A@@@@BA@@B@@@@@BABB@@B@BB@@BB@@@B@B@@B@B@BBB@BB@@B@B@BA@@BAF@@@B@BCBA@@BA@A@@B@B@@@D@@@B@BB@@JF@@D@B@B@@@B@@@BB@B@@@B@@BBB@FB@BB@@@B@B@@@@@BB@BFABB@@@B@@BB@@B@@@D@@ADA@@BBB@@@AB@@@@@@@DD@D@@BB@@AB@B@DBB@B@@@@A@@@A@A@A@A@A@@@@@A@C@@@CB@@@@BB@@@@AB@D@@@@@BB@@D@B@@@B@@C@@@@BA@@BA@@@@@@DA@@@@BA@@DA@@BBDBB@BAB@BABABA@A@@B@B@@@@@B@@@A@@AAA@@AAA@AA@@@@@EBC@AA@C@A@@BAA@@@@@AAA@@@@@A@@B@@@BB@@BA@@BA@A@@@@@CB@B@@AB@@A@@@A@@@A@A@AB@@AB@B@@@@@BAD@B@@AB@B@@@@@@@B@D@@@DB@@@B@@@BA@@B@@@@BBB@@@BB@@BB@@@@AD@@@B@BAB@@ABED@@AB@BB@@B@@B@DAB@B@BA@@B@BA@@@BB@B@DAD@BA@@@@AC@@AA@@AA@C@A@A@A@AAA@AAAEC@AA@@AC@A@@@@@A@EA@@A@@@C@AB@@A@C@AAA@A@AAC@@@@AAABA@@AAAAAA@@AAA@@AA@AAAACAA@AA@@@A@@BA@@@A@@B@@@DBDC@AB@DA@@DAFB@@B@@BAD@@B@B@BB@@HAD@B@B@B@B@@BBB@BBBB@@@B@BBB@@@B@@A@@B@B@B@BABAB@@@@@BA@@@A@@@@CAA@@@A@A@AAAA@@@A@@DAB@@A@@A@@AA@A@AAA@A@A@BCBA@@@A@@@AAA@@C@CB@@@@BCBE@@@AAA@A@@A@@BA@AB@@@@A@AA@@@@AA@AA@@A@A@@BA@@B@AA@@@A@@@A@@@AB@BCBA@A@A@CBAA@AA@@AAAA@A@@BA@CB@B@@A@@@A@@@@B@@@@@BDB@@@@A@@DABA@AB@@@B@@@DB@BBBB@B@D@D@@AB@@@B@@@@A@@@A@@B@BBB@@@BBBA@@DAB@@@BA@@@A@@B@B@BB@@B@@@B@@@@@D@B@@@BA@AB@B@BC@@@@D@D@@@B@D@D@B@BAD@BAB@BCFAFGBAAA@@BAFABCDCB@BAC@AAA@AB@@A@E@@@@A@A@@BAA@@A@@@@A@@A@@@A@ABABA@@DAB@BA@A@AAAA@AAA@@@@ADABABA@ABAAA@EA@A@E@ABA@ABCA@ABA@A@@B@B@@@AAAA@AB@@@B@@@DA@@@@@AAABA@@B@DABA@A@@BA@AA@@AA@@@A@AA@@@@A@CAAAA@CCA@AA@@@ABA@AB@@@@@@@B@BAD@@@DA@@@B@@B@BAB@@@B@@@@@B@@A@AB@@@B@@B@@B@@A@@BB@@BA@@@A@A@@@A@@B@BC@@BADBDDDD@B@BD@B@@@B@@@B@@@B@@@@@@D@D@B@D@DB@B@@@BB@@B@BBBA@@BB@@B@D@@@BADBBB@@@@B@B@@@B@@@BB@@@@FBB@BBD@B@@@DA@@D@@@FB@@BBD@B@@@DB@@D@B@@@B@B@@A@@B@B@B@@ABA@@B@@AB@B@B@B@BBH@D@@@@ABA@C@@B@@@B@B@DA@BB@@@@BB@BBB@BBB@DB@@B@@@@@BA@A@@BBB@BBD@B@B@@@BA@@B@BA@@LA@@BA@@B@B@BCB@B@B@BBDBB@BBB@D@@@@@@@@ABA@@B@@AD@D@@@B@@A@@@A@@@C@@B@@AB@B@B@@@B@@@DA@@D@B@BAB@BAB@BA@@BBD@@@@@@@@A@@BABAB@@@BAB@DA@@B@B@B@@@B@BB@@@AB@@@BB@@B@D@BAB@B@B@@@DAB@B@D@BBF@D@B@@@BBB@@B@D@@@DB@@ADBBADB@BAB@@@B@B@@ABA@@B@@AB@@A@ABA@@AABA@@AAA@@A@ABA@A@AB@BA@@BBB@@B@B@BBDBB@B@@@D@@@D@@BB@B@@BB@A@@@AB@B@B@@BBAB@B@@@BB@BBB@@ABB@@B@BD@@BB@@@BADAB@D@@@B@@B@BBB@@BBD@@B@B@B@H@F@@BB@BB@@FBB@@@B@@@B@BC@@B@BA@A@A@AB@AABCB@@A@@BABAB@@A@@DA@@@A@@@ABABAB@DC@@BA@@BAB@B@B@BABA@@D@@A@ADCDCB@B@B@B@@@@@@A@@@AC@@AAAA@@A@@B@@@BA@@BADA@@B@B@BA@@B@D@@@@@@@@BB@B@@BB@@@@B@@BB@AB@BAB@D@BA@@@@D@B@B@B@B@@@@@BABAB@BADAD@BAB@B@B@B@@A@@@A@A@A@@D@B@B@B@B@B@@@B@B@BBBB@@B@@BBB@BB@B@B@B@B@@BB@@@B@@@@@@@BA@@@@@AB@B@B@@@B@@@@@BA@AB@@@B@B@@@B@@@BABA@@@@B@B@@@BBB@@BBBB@@@B@@@BAB@D@B@B@@@B@BHB@@@@B@@B@@@D@D@BB@@B@D@B@B@@@B@@BBB@@@B@@B@@@BABAB@BAD@FAB@BAB@@@B@@B@@@B@@@B@@BB@BB@BAB@B@@AD@BB@@F@@@DA@@B@B@@BB@@@@@B@@@B@B@@@BAB@@@@AB@B@B@B@B@BB@BB@@AB@D@@@DAFBD@B@@@BD@@D@DAHBB@B@HCBAF@FDD@FAB@@A@C@@@@FAB@BAB@DBB@DAB@B@@AB@BADAB@@BB@@BB@@BB@BAD@BA@A@A@A@AAA@A@C@A@@@C@A@@@A@@AA@@@A@@AABA@@@C@@@AAA@@C@A@@AAAA@@@A@A@@@A@A@AAA@A@@@@AA@@A@@@@BAB@B@@A@CB@@@B@@@@@BA@C@A@@@@@A@@DA@@BBDB@@B@B@@@B@@@B@B@B@@@B@BBD@@A@A@@@ABA@AB@B@@A@@BAB@B@@A@AAAAA@A@A@A@@D@F@@@BABA@ABABA@ABA@A@@AAA@@A@@@ABA@@@AAA@@@A@@BABE@@AABA@AB@@ABA@@@A@@@A@A@A@@@AB@@A@@ACB@@@@A@@@A@@AABAA@@ACCCAAAA@AB@ABA@A@A@ADCDG@@@@@@D@D@BAB@DAB@@AB@BABA@AB@DA@@BA@ADA@A@@@@B@@@BAB@@AAA@C@A@@@AB@@@AA@ABCBA@C@@BA@@@A@@DA@A@A@CBAAC@A@A@@BA@@B@B@@ABA@A@@FBD@B@BA@@B@BABA@AB@B@B@@@B@B@D@DAB@B@BABABA@@@AB@D@B@@AB@B@@AB@@AB@B@@@B@BA@@B@@@@A@@BABA@@@@D@DA@@B@@@@ABCBA@@AA@AAAA@A@A@@@@AAA@@@A@@BA@C@A@@@@B@BA@@@@A@@AAAA@@@@A@AB@@CAC@@BC@@@A@@@@B@B@@@DA@@@@@A@C@@@AAAA@AAAC@@BA@@@AA@@@@@A@@@A@A@@@AB@@@@A@A@@BA@@@A@A@@@A@@@AAA@@@@B@@@@AB@@@AA@A@A@@@@@A@A@AA@@@A@@@@CBA@AB@@@BBB@@E@@@@@ABA@@@@AA@@AA@@@A@@@A@@@A@@@A@A@A@@@@@A@@B@@CA@@C@@BA@@D@@@B@@ABABAA@@@AAAA@@AC@@AA@ABA@@B@@AD@@@B@@A@@@A@@ACAAA@@@@BABA@ABA@@BCD@B@@AB@@A@@AAA@A@AA@@@@@A@@@A@@A@A@A@AA@@A@A@@BA@@@@A@AA@@@A@@@@@@CA@@AAA@@AAAA@@@A@ABA@@BA@@B@@@B@BA@A@AB@DB@@@@BAA@@A@@@AAA@@A@@AA@AA@@A@@BA@@@AA@@AAA@@AC@@AA@@A@@A@@@@@ABA@AA@@@@@A@@AA@A@AA@@A@@@A@A@@A@A@@@@B@@@@@BA@@@A@@A@@@A@@@A@A@@B@@A@A@@B@BA@@B@@@B@@@@A@@@@BA@AAA@@DA@@@@B@BA@@@@@A@@@A@@A@@@AAA@@@A@@@AAA@@@@A@@@@@AB@@@BC@A@@B@@AB@@@@A@@@A@@A@A@A@A@@@@A@@@@@A@@A@A@AA@@@@AAA@A@@@@AA@@A@@@AA@@@@A@@@A@@AA@@BA@A@@@A@AA@A@A@AAAA@@@A@A@@C@@@@A@@AA@@@@A@@@A@A@C@@B@@ABAA@BA@A@@@@@@@CB@AAB@B@@@BA@@BAB@@@@A@@AA@@@A@@@@@AA@@A@@A@@A@AB@@A@A@@@A@@@A@@AA@@AB@@A@@@@EB@@AA@A@@AAA@@A@AAAA@@AAAAA@@A@@@AC@@@A@@A@@AAA@@A@@@AA@@@AA@@A@@AB@@A@A@@A@@AAAAAAEAA@@@C@A@@@A@@@A@@AAAA@@AAAA@AA@@@@AA@@A@@AA@@@A@@@A@@A@AA@@@A@@@ABCB@@ABAAA@AA@AA@@@@A@@@@ADABA@@@A@ABA@@AA@@AAAA@A@@B@B@@A@@B@@AB@@ABA@AAA@@@@@AABAA@@A@A@@AA@@@AA@@A@@A@@@AA@A@@A@@@A@@@@AA@AAA@AA@@@@@@BA@@@@@A@@@ACC@@AAAA@@@@@@A@@BAB@BA@@@C@@@AB@@A@AB@@A@@@AA@@AA@@@@AB@@A@@B@@A@A@@A@@AAAA@@A@@@@@A@@AA@A@A@A@AAA@@@AA@A@@@@@@A@A@A@@AA@@@@@AA@@@@@A@@B@B@@@B@@AB@DB@BB@B@@AB@D@@@B@B@@AAA@@@A@AFA@@DABAD@@AACAADC@@@A@AB@@A@A@AB@BAB@@A@A@@AA@@A@@@AA@AAA@@@A@@A@@@@A@@A@@A@@AAAAAA@@ACA@CA@@BAB@@AA@AA@B@@@@C@AA@@A@@@A@A@@@@@@A@@@@AA@@A@@@@@A@A@@@AA@A@@A@@@@@@@@A@A@@@@AA@@AA@AB@@A@AB@@@@A@AB@@A@A@AA@@@@A@A@@@@B@@AAA@@AAAA@A@A@AA@@AB@@ABBB@@@BAB@B@B@BABBFCB@@@BA@@@AAA@@@@BABA@@@@@@@@@AAA@CA@@AA@@@ABA@@AA@@@@A@@AA@@AA@@A@@@A@@AA@@@@A@A@@AAAAA@@AA@@@BC@A@@@ABA@@@@@ACAB@@A@@BAA@@A@@@@@@@A@ABABABA@@BB@@B@BAB@B@@@DA@@B@B@@@BA@A@@BBB@@@B@BB@@@@BA@@@AB@@A@A@@@A@@@C@@@@BA@@@@B@@A@AB@A@@@@A@AA@@@A@@@AA@@AB@@A@@AA@@@@@C@A@@@@@@@ACA@AA@@AB@@@@ABAA@@AA@AAAA@AAA@A@@AA@A@@@A@@@A@A@@BCA@@A@@@A@A@@@A@A@ABA@@@CAA@@@AA@@@AA@@@@@BA@AAA@AA@AAA@@@A@@@@@AA@@@@A@@AA@@AA@A@A@@@"],encodeOffsets:[[109300,23600]]}},{type:"Feature",id:"451027",properties:{name:"凌云县",cp:[106.56131,24.347557],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@A@@AA@A@A@@AA@@@AB@@AACBACAAC@@AA@@A@@AAA@@@A@@CA@@@@@@AA@C@A@@AA@@A@@@A@@AA@AB@AA@@@A@A@@@A@A@@@AAA@@@@@AA@@@AC@@@A@AB@@@BA@A@AB@@A@A@AA@B@@@@@B@B@DAB@@@B@@AD@BABABAB@@ABAB@@A@@BA@@@@@AA@@AB@@A@@@A@@BCBCBA@AB@@A@A@A@@BA@@BC@@@@@A@@BA@A@@@CB@@ABC@@B@@AB@@A@AAA@@@@A@@A@@@AA@@@AA@@@A@A@@@@BAA@@ABA@@BAB@B@DABAB@@A@@@@@@@@B@B@@@@@B@B@@@@@B@BA@A@@@A@@BA@A@A@@@AB@@AB@@@@@BA@@@AAA@@@CA@@AA@@@@AA@@A@@AA@@@AAA@@@@@@@@BAB@BA@@@ABAB@@@B@@@B@@A@@B@@A@A@@B@@@BA@@BABABA@@@ABA@A@@@AB@@@BA@@@@@CB@B@@@BA@@@@BABA@ABAB@@A@C@A@A@AB@@AB@@A@@@AB@@BD@D@B@@@BBB@B@BBB@B@B@@BBBB@B@B@@BBB@@B@@@@@BAB@@@D@@A@@BCB@@@B@B@@@@AB@B@@@@@B@BB@@D@@@BABBD@BB@@B@@@D@B@@ABAB@B@@BFBB@@AB@@@BAB@BAB@@DB@B@@@@BB@@@BBB@B@@@BBB@BB@BB@BA@AB@@@B@@A@ABA@@@A@@BABB@@@AB@@@B@@@BA@@B@@A@@BABB@@B@@@@@@@@@B@@A@@B@@@B@BAB@@@B@BA@A@AB@@@AA@@B@@AB@@@BA@AB@@@BABABA@A@@BA@@@AB@@@BA@AA@@A@@BCAA@A@@@@@A@@AA@@BA@@B@@B@@@@BBB@BA@@@@@BBBD@B@@@@ABA@A@B@@B@B@B@@@@@BAB@D@@A@@@AB@@@@@@AB@@A@AB@@@B@@A@@@@@@BA@@@@@@@A@@BA@@@BD@B@@@BB@@BABBBA@BBA@@@@BAB@B@B@BA@@@AB@@@@@BC@@B@@@@@BABA@ABED@@AB@BAB@@@BA@AB@BABA@@BA@@BC@@BA@AB@@@B@B@B@@@BB@@B@B@@A@@B@@@@A@A@@AAB@@@@@BAA@B@A@@ABA@@@A@@@@B@B@B@BBB@@@BADBBAB@@@B@B@@ABAB@@AB@@AB@@ABA@@B@BBB@B@@@BB@B@@@@@@@A@ABAB@@@B@BA@@B@@ABBD@B@@@@@@B@BB@BB@BB@@@B@@@@@B@@@@@BB@@@@B@@@B@B@@@B@@B@@@@B@@@B@B@@@@B@B@@@BAB@DAD@@A@@@BDADC@A@@BA@@@A@@B@@@@B@@B@B@B@@BB@@B@@BB@@B@B@BB@@B@@@BBBB@@@BB@@B@B@B@BBBBB@@BBB@@@@BAB@BA@AD@@@DB@@B@@@B@B@@@B@B@B@BBB@B@@B@@B@BA@@@@@ADA@@B@@A@@@CDCAA@A@@@AB@@@@CBABAB@@AB@@@B@DAB@BA@ABA@AB@@A@@D@@@@ABAD@@AB@@@B@@@BB@A@CBC@EBA@A@A@@BA@@BA@C@@@@@@B@@BDAB@@@BAB@D@BABAB@D@B@BA@AD@@@DBB@BAB@BDDBB@@AB@@A@@BAB@B@@ABB@@@B@BA@AB@B@@@@@BB@@@@D@@AB@@@B@@@@A@@B@@@@@DC@@BB@@@@B@@@@@B@@@BD@@@@@B@@AB@B@B@@BB@@AB@@@B@@B@@BB@B@@@@@@@D@@@B@B@@B@BBB@B@@@@@BDB@BB@@B@@@B@@@B@B@@BB@@@@@B@B@B@@@DBB@B@BAB@B@@AB@@@B@@@@@@@BB@@DB@BDBBBB@B@BB@BA@@B@BB@BBF@B@@@BA@@B@BA@@@@BA@@@@BBB@DBB@BCAA@A@AB@@ABA@@B@@A@A@AB@BA@C@ABA@CBA@CAAA@B@@A@AB@@ABA@ABA@AB@@AB@@@@@B@B@B@@BB@@@BB@@B@B@@@@@B@B@@@BB@B@@B@@BBBD@@B@BDB@@B@BBBB@BBB@D@B@@@BBDBB@@@DBBBDD@@AB@D@D@D@B@BAB@B@@@DAD@@@@A@@@AB@@@B@@BB@B@BAB@BA@@@@B@@@@@B@B@@@BA@ADABAB@@@@@BB@BBBBDBB@@BBB@B@@@BBB@BB@@D@BBB@B@BABABBB@@D@B@B@@B@BB@@@BB@A@@B@@@B@DBF@@BD@@@BBB@@A@@B@@A@A@A@@@@DA@@DA@@DABA@@@@B@BA@@BAB@@A@@BA@@B@B@@@B@@@@ABABA@AB@D@B@B@BA@@@@@A@A@@D@B@DA@@B@@@B@BB@@DB@@BBBABABBB@BAB@DBBBDD@@D@BAD@@AB@@AB@BABA@@BAB@D@F@D@B@B@@@B@@BB@@@@@@@B@@@B@@@@@@@B@B@@@BBA@@@B@@@@@@B@@B@@@@@B@@B@@B@@@B@@@B@B@@B@B@BB@FD@@@B@@@B@B@@@@@@@B@D@B@D@B@D@@@B@B@@@B@BB@@@@B@B@@BB@@D@BD@@@@A@A@@@BB@@@B@@@BB@BBB@@@BB@@A@@BA@@BABCB@@A@@DA@CB@@A@A@@@@BBBB@@BA@@@@B@@@BB@@@@BB@@BB@@@@B@@@@@AB@@@@@BBA@BB@@B@@@@B@@@@@@@@B@@BB@@@B@B@B@B@B@BB@@B@B@@@B@BB@B@@@BB@@A@@@A@A@A@C@A@A@@@AB@BA@A@@DABB@@B@@@@@@A@@@ABABAD@BAB@@@BA@CDA@A@@@A@@@A@@@@B@@@@@B@@@B@@@@@B@B@@@B@B@B@@BDBDB@@BB@@D@@@@BB@A@@B@B@B@B@@@BB@B@@@@AB@BA@@D@@A@@BA@A@A@@@@BC@@@@AAA@@A@@AA@@@@BA@ABADC@@@@@A@AB@@@B@@@BA@@@A@@DB@@BAB@B@@@BBB@BB@@B@@A@AB@@@BA@ABA@@BAB@B@BAD@B@@AB@@AB@@A@@BBB@B@D@@@@B@B@B@@@@B@@@@@B@@@@BA@@@B@@@@@@@BBA@@@@B@B@@@@B@D@B@D@@@BADB@A@@@C@@@@@C@@@ABA@@@@B@B@@@@@BB@@@@B@B@@@B@B@@@@BB@B@@@@BB@BBBB@@@@D@@@B@BAAB@@BD@@@D@@@B@@B@BB@@D@BB@D@@A@BDB@@B@@@@@BB@BB@@BBABAB@B@B@@@B@@@@D@@BBB@BBB@@@B@B@@@@B@@@BA@@B@@@@BB@@@@@B@@@@@BD@@BAB@D@B@B@@@D@B@@@B@B@B@B@DBBBB@@B@@D@B@DB@@@@B@B@D@@@@B@BB@B@B@BB@B@@B@@@BABC@@D@BAB@@C@@@@@A@E@A@ABA@@@@BABB@B@@BA@@@ABA@AB@@@B@@ADB@@@@@BB@BB@@B@@BBBD@BB@B@@@B@@D@B@BB@@BBBB@@B@@B@@BBB@BB@@BAB@@BB@B@@B@@B@@@AD@@@B@@A@BB@@@@BB@@BB@@B@B@B@@@B@B@D@@BD@BBB@@@@BB@@BAB@@ABAAA@CBC@@BAB@B@@@BB@BBB@B@B@B@@A@@B@BCB@@@DBB@B@@@BB@@AB@B@@@B@@@@BB@@B@B@BA@@B@B@@AB@B@BAB@@@B@B@@@BA@@B@BAB@@@@@@BBB@@@@B@@@B@@B@@B@B@@@B@@@@@BA@A@@AA@@@CB@AABA@@B@@@BB@@@@B@B@@@BAB@@@BB@@BAB@@@@@@C@@B@@@@AD@@@B@@AA@@AA@@A@@@@@C@@@A@@BAA@@A@AAA@@@@@A@A@@B@BA@AAC@@AAB@BA@@@A@@B@@BB@@BD@BA@@@@@A@@@@A@@A@@@A@@@@@AB@@@B@@@B@@AB@@@FA@@B@B@@@AA@A@A@@A@@A@@AAA@@A@AAAE@@AAA@@@AAA@@AA@@@@@AB@@A@@AA@@AA@@@A@@B@B@B@@@B@@ADA@@@A@@@A@@@AB@A@AC@@@AA@CC@@A@@@AA@@@A@A@@@AA@AA@@@ABAB@B@BAB@@@@ABA@AB@@@BA@@@@@AAAA@@@AA@@@@AA@@A@@@A@A@A@A@A@A@@@@@AA@ABAB@@A@@@@@AA@@AAAAA@@@A@@@@@A@ABAAA@@BAB@B@BB@@BBB@@A@ABA@A@A@AA@@A@@BABA@@@AA@@@@@@@@ABA@@@@@AA@BA@A@@BA@@@@@A@ABA@@BAB@B@D@@@@A@@BA@@@AB@@BB@B@B@@@@BBBB@@AB@@AB@B@DBB@B@B@@@@B@@BA@@B@@@@B@B@@BB@@BB@@B@@@J@B@BA@@B@@@B@@A@@DAB@@A@AA@B@@@@A@@@AA@@@@A@A@@@A@@A@@A@@A@A@@AB@@AA@@@@@A@@AA@@@@@BA@A@@@A@@B@BA@@B@@@@AAABAB@@@B@BA@@BA@AB@@@BC@@@@@@BB@@BAB@D@BAB@@@@@@AA@@@@A@A@@B@B@@@BA@@@AB@B@B@@@@A@A@A@@@A@@CA@AAAAA@@A@A@AA@BA@@A@@@@A@@@@@AA@@@A@@@@AAA@@@A@A@@A@@@A@@@A@@@A@A@A@ABA@@@@B@AA@@AA@@AA@@@A@@AAA@A@@@AA@A@@BA@@@A@@B@B@@@BC@CB@@@B@@@BA@A@@B@B@B@@@@@DA@A@A@@BA@@BA@@BAB@@@B@@@B@B@@@DBB@@A@@BA@@@@B@B@@B@@@@@@@@@@B@@@BA@@B@@A@@BA@@@@B@@@@@BAB@@@@ABC@@AA@@@@B@@A@@@A@@@@@A@@B@BA@@@AB@BAB@@A@@B@@ABCB@BABAB@B@@ABAB@@@@@@A@@@@@@@A@AAA@A@@B@AAAA@A@@C@@@AA@@@A@@AA@AB@@A@@BA@A@@@@@AB@BABBB@B@@ABABAB@@BD@BA@@BAB@@@@@AA@A@@AA@@A@@A@@@@BA@@B@BBBE@ABAB@@@@@B@BA@@B@B@@@FA@@F@B@BB@@BA@@@ABA@@BBB@B@@@BCB@@BB@@BBD@@@@@@ABA@@@@B@B@@B@@@@@@BBB@@BB@@B@@@BBBB@@@BAB@@@BBB@B@@BBB@@@B@B@@AA@@@@@@A@@@A@A@A@@BA@@A@@A@@@A@@BAA@@A@@BA@@@@AA@@B@BCB@@C@@A@@@@A@AB@@@DBB@@@B@@@DCB@@ABA@@AAA@@@@A@@A@@A@AA@@@AA@@BA@@BA@@@@@A@@BBD@B@B@@@BBB@@@BA@@@A@@@AB@BA@@BABA@@C@A@A@@BA@@AEA@@C@@@@AA@@AAADAAAA@EAE@@AB@@@BA@A@@CC@AAA@A@@B@@@@A@@@@@@AAA@CBC@@@@A@@@@AA@A@AB@BA@CBA@@BA@@@@@@DA@@D@D@BBBABA@@@A@A@@A@@A@@@@@@BA@@@A@@D@@AB@@@B@B@@@@C@@B@@A@@@A@@AA@@@A@@@@CAA@@AA@@A@@AA@@B@@@B@AC@@@A@@@C@@@AAA@@@A@@@@C@@A@@@@B@@@BA@@@@@A@A@@@@A@@@@@@@@B@@@@@@A@@@AAA@@@AAA@A@@@@A@@@A@A@@@@A@@@A@AB@@@@A@@@A@A@@AA@AAAA@@@A@A@AB@@@AA@A@AB@@@@@BBBBB@BA@@BAB@B@@@B@B@BABA@@@@AAAA@@@A@@@@BA@@A@@ABA@ABA@@@@@ABA@A@A@@BA@@@@B@B@@@BABABA@@@A@@@AAA@@B@@@B@DBB@B@@@@AB@@@BAB@@@@@@A@A@A@@@A@AB@@@@@B@@@@A@@@A@@@@AA@A@@@@@AA@AAA@@@@AB@@A@C@@A@@@@AAA@@AA@@A@A@A@A@@@A@AA@A@@B@@ABA@@@A@@@@B@@@B@B@@@B@@@B@DA@@@AB@@@DAB@@@BADBB@BBB@B@D@@@@@BA@@@A@@B@BA@@@A@@@AB@@@B@@@@@BA@AB@BA@@AAAC@@AA@@@A@A@@@A@@@A@@@A@AA@AAAC@@@@@AA@ACA@@@@A@@@AA@@A@@@A@@@CA@CA@AAA@@A@@@@AB@@A@A@A@A@@@AB@@@ACAA@@@A@@BABA@@BA@@CA@@A@@BAAA@AAA@AAA@@@@A@AAA@@A@AAA@A@C@A@A@A@@A@A@A@@@@AAA@A@AA@@CA@@A@CA@@A@@@CA@ACAA@@@CCAA@AA@A@@@@@A@A@@@AA@@A@A@A@A@@AA@@A@@A@AAA@@@A@@ACBAAEB@A@@A@AAA@@@A@A@@AA@A@A@CB@A@BA@@@AB@B@BA@AB@B@AA@@@AAA@@BA@C@@@A@@BA@A@A@A@ABA@@@A@A@@@@@A@@@@@A@A@@@A@@@@@AB@@A@@@AB@@@@AABAA@@@@@@@@AB@@@AA@@@@A@A@@ABAA@AAACA@@AA@AACC@@@A@CA@@@A@AB@@A@A@@@@@A@@AA@@@A@@@AB@@A@@@@@@B@@AACA@@CA@@@@A@@@@@@AA@@@A@AA@AA@@@@AA@AB@AA@A@@@AAAAAAA@@@@@@DBD@B@BA@AB@@C@AB@D@BA@@BCD@B@@@B@@A@@AA@@AC@@@C@@@@AC@A@@A@@C@@@AB@A@@@@A@A@A@@A@@A@A@@A@B@B@BABBFB@@B@@A@@@CA@@A@@@@ACAAA@@@@AA@@@A@BA@AB@@@B@@@@@@@@@B@@@@A@@@@B@@@@B@B@@@ABAB@@@@A@@@@@@@@@BBB@@B@@@B@@@B@@BB@@@@@BB@@B@@@@A@@@C@A@AB@@@@AB@@ABAB@@A@@@CAA@@@@CBAB@@A@@AAA@@@A@@@@ABA@@@C@@@A@@@AA@AAC@AAA@@@C@@@@DA@@BA@ABABABAB@@B@@@ABA@@@A@AAA@@A@A@AA@@@CCA@A@@@@@@A@@@C@AB@@ABA@AAAAA@@BA@A@ABA@@AACAA@AA@A@A@@@A@@@A@C@A@A@@@A@A@A@@@AAA@A@AAA@@A@@@CAA@A@A@A@AB@@A@@@ABA@C@@@A@@B@@A@@B@@AB@@A@CDAC@@AA@@@AA@A@ECA@A@A@@AA@@@@A@@@@@A@A@@@AA@A@B@AABAA@@A@@@A@@@@@@@AA@@@@@@@@@@@@B@@A@@@@@A@@AA@A@@@A@@@@@C@A@@@@@A@@@@A@@A@@A@@@A@A@@@A@@@A@A@@A@@@A@AA@@A@@@AA@@@@A@@A@@@@@@@A@@@@@A@@@@B@@@@@@A@@B@@A@AAA@A@@C@AA@@A@AA@@AB@@C@AAABA@A@A@A@ACA@ACA@A@C@AAG@@AC@A@CA@A@@A@@AA@@C@@A@CA@@@AAA@A@@AA@@A@CA@@A@A@C@@@A@AAA@@@A@@@A@A@CA@@CAA@@@CBA@C@A@CBA@@@A@C@A@A@@@"],encodeOffsets:[[109161,24696]]}},{type:"Feature",id:"451028",properties:{name:"乐业县",cp:[106.556519,24.776827],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@B@@@DAB@@CB@@@DABA@AB@@AB@@@AA@@A@AAA@@A@@@A@@AA@@B@B@@@@@ACC@@@AA@@@A@A@@A@@A@A@@@A@A@@@C@A@C@A@C@A@@@@@@@A@A@@@A@@ECA@@A@A@AA@A@@@A@@@A@@@@AA@@@@@A@@@@A@@@@A@@@B@AA@@A@A@@@@@@@A@@@A@@@@@@@A@@AA@@@A@A@C@E@C@A@AB@@ABABA@@BA@@BC@ABC@@@CCAACAA@ABA@AAABABAA@@CA@@AAA@@@A@@@CBA@C@@@@B@B@@@@ABA@A@C@A@@BABAB@B@@A@@@A@A@@@AB@@@BA@AB@@ABA@@@@@ABCB@@CB@@CB@@@@@B@B@BA@@@@BA@AA@@C@@AE@CAA@@@A@@@@BAA@@A@@A@AA@A@C@@@AA@@AB@@CB@@A@CA@@A@ABABAA@@ABA@@@BD@@@BDB@BBBBBBB@@A@@BAD@BA@AAAA@A@@AAAA@A@@A@AA@BA@ABA@AD@D@B@@A@A@@A@@@ABCAC@A@@@@@A@@@A@@@@A@A@@BA@A@ABC@AB@@A@CBAB@BABA@AB@@CB@BAD@BA@@BCD@@ABABABA@@@@@A@A@A@@@A@ABA@A@A@A@@BABCA@BC@@BCA@@A@@B@@C@A@@@CAABA@@@@B@@@BB@BB@@@@ABA@@BA@@BCBBBB@@BBB@@@@B@B@@@BD@@@@@BA@CB@B@@B@BB@@@@@BB@@@@@@BBB@BB@BB@@@B@BC@AAABA@A@@@@C@@A@C@CAA@A@A@C@A@A@@B@@BBDF@@@@BD@@BBBDBD@@@BBB@B@@BB@@B@ABAB@B@@@@AB@@CD@@AB@@A@AB@@A@@@@@AB@@AB@@@@@@@BA@@@@@@BA@@@AB@@@@C@AB@B@@A@A@@B@@A@AB@@ABA@@BA@@BA@@B@@ABA@AB@BAB@@@B@@AB@B@@A@@A@B@@@@A@AB@@@BA@@B@B@@CB@@@@AB@@@@@B@@A@@BA@@@A@A@@BA@ABA@@B@@@@ABABAB@B@@A@@@@@C@@@@B@@@B@@@@A@A@@@AB@@@@A@@@@@AB@@@@@BA@@B@@@@@B@@@BA@@@@@A@@B@@@@@B@@AB@@@B@@@@A@@@@BAB@@@@@BB@@@@@@B@B@B@BBB@B@@@B@B@@@BA@AB@@@B@@@@BB@@@@A@A@@B@@@@@B@BA@@B@@@D@@@B@@AB@@@@A@@B@@@BBB@@@@@@A@AB@@@@B@@BB@@@@@@BA@@B@@@@B@@@@B@@@BA@@B@@AB@@@@A@C@A@A@@BAB@B@DAB@B@DB@@@@@@BBBB@BBBD@@@B@@@B@@@B@B@@@@BB@@@@@@@B@B@@DBD@@@@@@@A@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@A@@B@@@@@@A@@@@@@@@@@@@@A@@B@@@B@@AB@@@BA@@@@BA@@@@BA@@@@@@@AB@@@@A@@@@@AB@@@@@@@@A@@@@B@@@@A@@@@@@@@B@@@@@@A@@@@@@@@@@@A@@@@@@@AB@@A@@@A@@@A@@@@BA@@@@@A@@B@@@@@@@B@@A@@@@@AB@@@@@@@B@@A@@@@B@@@@@@AB@@AB@@@@AB@@@@AB@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@AA@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@AA@@@@@@@A@@A@@@@@@A@@A@A@@@@AA@@@@@A@@@@@A@@@@@@AA@@@@A@@@@@@@A@@@@@A@@@AB@@@@A@@B@@A@@@@@A@@@@@@@@@A@@B@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@A@@B@@@@@@A@@@@@@@@@A@@@@B@@A@A@@@@@@@@@AB@@@@@@@@A@@@@BA@@@@@A@@@@@@@@@@B@@@@@B@@@@A@@@@@@@@@@B@@A@@B@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@BA@@@@@@@@@@BA@@@A@@@@@@@@@AB@@@@@@A@@@@@@@A@@B@@@@@@A@@@@@@@@@A@@@A@@BA@A@A@@@A@A@@BA@@@A@@@@@@BA@@@A@@@@@@@@@AB@@@@@@A@@@@@@@@@A@@@@@A@@@@BA@@@@BA@@B@@@@@B@@@@A@@@@BA@@@@@@@@@A@@@@@@@@B@@@@@@A@@@@B@@@@A@@@@@@@@@@@@@@BA@@@@@@@A@@@@@@@A@@@@@@@@@@@@B@@@@@@@B@@@@@B@@@BA@@B@@@B@@A@@@@@@B@@A@@@A@@@A@A@@@A@@@A@@@A@@@@@A@A@@@AB@@@@A@@@@@@@A@BB@@BDBD@B@BCHAD@D@DBBFBHBBF@DDDDDBHBL@DBF@F@FAFBF@FDDDBBD@BBFAD@HCJAB@DCDADCDABAF@DDF@NCHCHABADEDEBCDCD@DADEFEFIP@@EFAHAFAFCDEHMFEDEHCF@D@@FFJJD@B@@@B@B@@@@@B@@@@@B@B@@AB@HAJAJCJGFAJ@HDBDDHDHFFFFDBFDDHBDDDFDL@NCHCLGHC@@HAFAJBDBFFFDFBN@PBD@HCHAJAHAJ@F@FBHFFFBDB@HDNDJDHAPBFBD@J@HDJDHDBDBBB@BBB@B@LDJDHBDBD@R@F@F@HJDDB@B@D@B@BDB@F@NDB@NDB@DAFDFFDJ@DBDDFD@DBHFHDDBD@NBNBD@JFB@DBF@B@HDH@HBHBHBHBB@FD@BBB@B@@BBBD@BBDBDFFFJB@@@@@@BB@@@@@B@@BB@B@BB@@DB@@@@@@B@@BB@@@B@@BB@D@FBB@BBBBBB@@B@@BB@@@B@@@@@B@BB@A@@B@@BB@B@B@B@B@@@B@BAB@@@B@@@B@B@@@B@B@@@@@B@@@B@@A@@BABAFC@A@AA@BC@CFGD@BADAB@BE@A@@BABAD@@ABC@@DEB@BABCB@BBD@BC@@@ABA@ABA@AB@@@FBBBBBFDD@B@DAB@BBD@@BB@@BBBAB@@@D@BAD@B@BB@@DBBDCF@D@B@DCBAD@H@J@JBJBFDB@FEF@BABAH@BA@AD@@BDD@B@@BB@@BBB@@B@@@BBD@B@@DBBB@@DDBBBBAD@@AD@BF@DFJDB@@ABABABABBDDFDED@B@@@B@B@BBDD@@@BABBD@DDD@D@BBDAB@DB@B@BD@BAD@B@B@BBAB@@@@BBFAFABBDBDAB@@@DABB@@B@B@D@BADABA@@@A@A@AD@@ABA@A@ABA@AB@@ABABABBBA@A@AAA@@ECA@GCAACCDCIE@A@C@CBCB@BADEFCFC@AB@BEBA@@BAB@BAAAB@@A@A@ADAAA@A@AD@BAAA@@@ABA@CBABCAA@@AABC@A@@@AC@ABA@@A@@AA@@@A@B@A@@CA@BA@AAABABAB@@@B@BABA@C@ADABA@C@CA@A@@C@A@CE@@AA@@EA@@CF@@@@CBCAC@@@@C@A@AAAAC@@@CBC@CBAAAAA@ACC@A@E@A@@DCF@DABABCB@BI@CFCDCBA@A@@B@@ADA@@BAB@@@B@@@FCGACEDCAAA@ACBADEB@DC@AB@FADAD@BAFCB@DE@CBA@@AA@A@A@ADE@AB@@@B@BAA@BCDAAC@ADEBAF@B@@ELMF@DABBF@B@@A@ADCDA@@F@B@B@@@B@@@D@@A@@@@@@BA@@BA@A@@@@BAAA@@@@AAA@BCH@DABA@@BAAA@AAA@A@@@C@AB@BBBBD@B@B@FAB@BABCBABAD@B@@A@@@ADA@@DC@AAA@CAA@A@@AA@@BAB@@@D@B@@AB@BA@@B@@CDCB@BC@ABA@ADC@CB@@AFA@CDCB@BCDCAA@ABCDIAA@GC@ABABC@@@@BEB@AA@ADAB@@@BA@@B@@ABADA@EC@ACAAAA@I@AABC@ABACAA@AED@BC@AA@C@EAA@CDABCBA@CCCA@@@@AABABABA@AE@CAA@EAAAA@BA@E@AA@AAA@A@@@@@@A@@BAB@@@@AB@@@@@@AB@@@BABA@@@@@A@A@A@A@@@@@A@@@@A@A@A@@@A@C@@AAA@@@@@@AA@A@@@@@@A@A@A@@@AB@@AA@@B@@AACBABA@@CC@A@AA@@AA@@A@A@@@CAC@@@A@A@@@AB@@AAA@A@@@AAA@A@@@A@@@@@AAA@@@@A@@@@@@@A@ABA@@@A@@@A@@@@@A@@@A@@@AB@@@@AB@AAB@@C@A@@@A@@BA@A@AAAB@@AAABA@@A@@ADA@@B@@ABAB@@@@A@@@@BAB@BA@@AA@@@A@@@@BA@@@@@ABA@@BA@AB@@CD@BA@A@AB@@@B@@@BA@@@A@@@@@CD@@A@A@CB@@A@AB@@A@A@A@@@A@@@@@A@@@A@BBA@@B@@AB@@@BA@A@A@A@@@A@ABABA@ABA@@@@@@@@@@A@@B@@A@@B@@ABADA@@@@@@@A@@@@@@B@@@@@@A@@AAAB@@A@AACA@B@@@@AB@@AB@@A@@@CA@BA@C@@@AA@@@BA@AB@@AB@@A@@BA@@BA@@@A@A@A@A@A@@@AA@@@@CA@@@AA@@AAB@@AA@@AAC@A@A@A@@AA@@@A@@@AA@@@@A@@@A@@@AB@@A@A@@A@@ABAA@AA@@@AA@@AA@BAA@@A@AA@@@@@AAA@@AA@@@A@@A@A@@AA@@AAA@@A@@A@@A@AA@@@A@A@@B@@A@A@A@A@@@ABA@@@CB@@AB@@@@ABAAA@A@@@A@@BA@@@@@A@@AA@A@@B@@A@@@AB@@A@@B@@@B@@@@@@AB@@AB@B@@BBAB@@@@A@@BC@A@@BA@@@AB@BAB@BABAB@@@@@@@BBB@@@B@@@BA@@B@@AB@@@@@@ABA@A@AB@@A@@@@B@@A@@@@@AB@@ABAB@@@B@@@BA@@BAB@B@BA@@B@@@B@@@BAB@@A@@@A@@@ABA@@B@B@@@B@@ABB@AB@B@BA@@BA@@BABADA@@@A@@BAB@B@@@@@DBB@@@@@B@@@D@B@BAB@@AB@@@BA@@@AB@@@DA@@@@BA@CA@DC@@B@@@B@HAB@@@@@BA@@D@B@B@B@B@DA@BB@@@BBB@@@@@DABA@BB@B@@@DD@@B@@@B@BAB@@@A@AC@A@@A@AA@@@AB@B@@AB@@ABA@@@A@@@@@@B@@@B@@A@AB@BABAB@B@@@@ADA@A@@B@@@B@B@@@@A@@BCBABADABA@@BA@@B@@@B@@B@@B@B@@@AB@@B@@@B@B@@@B@BA@AB@AA@@@AAAA@@AA@@AAA@C@@@@@A@@B@AAAA@@@ABA@A@AB@B@B@BB@AB@@AB@@A@A@@B@B@B@D@B@B@BAB@@BD@@@DB@@BBB@D@B@BA@@BA@@@@B@FB@@B@@@@@@A@@B@@AB@@A@@BA@A@@@@B@@AA@@@@AA@A@@@@A@@AABA@A@@AC@@@@@A@@@A@@@@A@AAA@@A@@AA@@@@@AA@@@@A@ACAC@@@@@@AA@@@A@AA@@B@BA@@AC@@AA@@AAA@AAC@@BA@A@AA@@@@AA@@A@@ACA@@@@A@@@A@C@@@A@@BA@A@A@@@AA@A@@@@@AA@@@@@AA@BABA@AB@@@BA@@BC@A@A@@BA@AA@@A@A@@AA@A@@A@@A@A@A@A@AA@@CAA@CA@@@@A@@A@@A@A@A@@AAAA@@@@@C@A@A@@@AA@@@@AAA@@@AAA@A@A@@@@A@@AAAAA@@@AAA@A@AB@@AB@@ABA@CA@@A@@@CD@@@AA@A@AA@B@@A@A@@BAB@@AB@@A@A@@@A@@@@AB@@AAAA@A@A@A@@@@AC@AA@@B@@@@@@@A@@@AA@@AA@@@@BABA@@@AC@@@A@A@@@AA@@@@A@@@A@@A@@@@@@BAB@B@@AB@B@@@B@@@@@BA@@A@@AA@A@AACA@AAA@@@@BA@@@@A@@AA@@A@AA@AA@@A@A@C@A@@@A@@@A@CB@@@A@@AA@AA@@A@A@@@EA@@A@A@@AA@A@A@@A@@@@A@ABA@@AA@A@A@@@AAA@A@@A@@A@A@@@@BAB@BA@@B@@@BB@@@B@@BBB@@B@@@@B@BBB@@@@ABC@@BB@@B@B@@@B@@@B@@BB@B@@@B@@@BB@@BB@@BB@@@BB@B@B@B@@@B@@@@@BA@@@AB@@@@A@@BC@@A@@@@AA@A@@AA@AB@@AAA@AA@@@AA@@@AAAA@@AAA@C@@@@A@@@AAAC@AA@AA@@@AAA@@A@@@@CA@BA@@@A@@BAB@B@@AB@@@AAAAB@@@@AB@B@B@F@B@@@@@DA@ABC@@@ADAB@@A@@@@AAAA@A@A@@A@A@@C@A@A@@@@@CAA@C@@@@AA@AACAA@A@A@A@@@A@C@@@A@A@C@A@AB@@AC@@@@A@@@@@A@@A@@A@@@AB@@A@@@@@@A@A@@AA@AAA@AC@@@@@@A@@@A@ABABAAA@@AAA@@A@@@@@AA@ACB@@@@CAAC@@@AAA@@@@A@@@C@@AC@@BAABA@@@C@@@@@AAAAA@@A@@A@A@@A@@A@A@@@A@A@@@@@AA@@@@A@A@@@@@AB@B@@@D@@@@@D@@@BCAAB@@C@A@C@A@@@@@@A@A@@B@AA@@@@@@A@@@B@@A@@A@@@@@A@@@@@@A@A@A@@C@A@A@AA@@@BA@@BA@@BA@C@ABA@A@AB@@AB@BAB@@A@@B@BA@@@AAA@AA@@A@A@AB@@CA@@@B@@AB@@A@@@A@@B@B@@@@CDAB@BAB@@@@BB@@@BB@BB@@@@AD@@@@@B@BAB@@@BC@@@ABA@@B@@A@A@@A@@@A@A@A@AB@A@@A@@C@@@AA@@CACA@AA@A@A@@@A@A@@@@@A@@@A@@@@@A@@@@@@B@@@B@@@BCB@DAB@@A@ABC@ABAB@B@@@B@@@@A@@@AACB@@@BABA@@B@@@B@B@D@B@B@B@@@BA@@A@@@AAAA@@@A@A@@@AAA@A@A@A@A@@@A@@AA@@@@@@@@@@A@@A@@@AAB@AA@@@@A@@B@@@@@A@@A@@AA@@A@@A@@A@@@A@@B@@AA@AA@A@@"],encodeOffsets:[[109076,25179]]}},{type:"Feature",id:"451030",properties:{name:"西林县",cp:[105.093825,24.489823],childNum:1},geometry:{type:"Polygon",coordinates:["@@J@DAB@HAD@N@J@FAHEHGDEFEHA\\CFAFADABEBGBGBE@@JKTSLQFEBCDEBABAJCNAZA@@\\AbE@@^GLG@GGGAE@EFENI\\I@@NARBLFFJDNBPDJLLNHHDFBZFL@PDLBHHHDLAL@FBPDRFBB@@@@@A@@@A@A@AA@AACCAAAAA@AAA@AA@@CCA@CA@@CACAAA@@@@AA@@B@@@BAB@BA@@@A@@D@BBF@B@BAB@B@@CBA@@@@A@A@@@A@BA@@A@@@AAA@A@@A@A@@@@A@A@@BA@@@@A@@@@@A@@C@@@BA@@@A@A@@@@@A@@B@@B@@@@@@@@@@BA@A@@@@@@B@@@@@@ABA@@@@@@@@A@@@@@@A@A@@B@@@@@@A@A@@@A@AA@@AA@AB@@@@@@@AA@C@@AA@@@@AB@@A@@B@@@@@@@@A@@@@AA@@@@@@AA@@@A@A@@@@@@AA@@@AA@@@@@@ABBB@@AA@@A@@@@B@B@@@@AAB@@@AB@@AA@A@@@@A@@B@@@@@@@@CBA@@@A@@BA@@A@@A@@@@BA@CB@A@@AB@@A@A@@@A@A@@@A@A@@@@A@@@@@@@@A@@@@@ABAA@@B@@@@AA@@B@@@@A@@@A@@@C@@@A@@@A@@B@@@@@B@@@@@AA@@@@@@BA@@B@@@@A@BBA@@@@@@B@@A@@@@@@@A@@@@@@@AACB@@A@@@@B@B@D@@@@@DAFEBAB@@A@AB@BB@@@@@@@@@B@@@@D@BBD@BB@@@BB@@BB@@@BBBB@BBF@@AB@@@@@@B@DABAB@@@B@@@B@@@B@@A@@AABA@@@C@@AA@@@A@@@A@A@A@@@@@A@@@A@@@A@A@A@@@A@@@ABA@A@@@AB@BABA@@@@@ABAAA@@@@AA@@@A@@AAA@BABABA@A@A@@@@B@@@@A@@@A@@@A@@@AAA@AA@@A@@@ABA@AB@FAB@DA@@@@B@@@B@F@BAB@BB@A@@BABABA@@@B@@BBB@B@B@B@@@BB@@BABB@AB@@@BBB@B@@@@AB@BAB@@AB@@@B@@@B@BA@A@@@B@@BBBBB@BBDB@@B@@@BB@@@B@B@@B@D@D@B@@@B@B@D@B@@@@@@B@BB@@B@@@@@BDD@@@B@B@@B@@B@BB@BBA@BBBBABB@@@BB@@BB@B@@BBB@@@B@@BDB@@@D@BB@@B@D@@@BD@@@BBB@@BD@B@@@B@B@BAB@@A@A@@BABC@@@A@AB@BA@AF@DAB@DAF@DA@@BA@@@@@DBBBB@@@@BDABBBA@@@@BFB@BBB@BB@@@D@@@D@B@B@@@@@BA@@B@D@B@B@BAB@@@B@@@@B@@B@@B@@@@B@@@D@@@BA@BB@@@B@@@B@@@B@DAB@@A@@@A@A@AB@@CB@DADABA@@B@@@@@B@@@D@@@B@D@BAB@BAB@B@BA@@DBBBB@BB@@BB@D@@@@BBBA@@B@@@B@@@BAD@D@B@DA@BB@@@@@B@B@BBB@B@B@BBHA@@BBB@BA@AD@@BBABA@@B@B@@@BBB@BB@D@@B@@BBBBBB@BBB@BA@B@@A@@BB@@@@@AB@@B@B@@@@@@BA@@@@B@@BB@@@@@@@B@@A@@@B@@@@B@@@@@@@B@@@@B@AB@@@@@B@@@B@@D@@@BBB@@@B@@@@@BB@@B@@@B@@@BBB@BBB@@@@@BB@@BB@@B@B@@@@@B@BB@@@@BBFF@@@BBBB@D@B@@@B@@@@@BA@A@@BA@@BAAA@@@@@@@@@A@A@@@A@@@A@@@@@A@@@A@@@@AABA@@B@@A@@@A@@B@@@@A@@@@@A@A@@B@@@@@B@@A@A@ABC@@@@@A@@@AB@@@@A@@@A@ADA@@@A@AB@@@B@@@@ABA@@@@@A@@@A@A@@@@@@BA@A@@@AB@@@AAB@@ABA@@@A@@@A@A@@B@@A@@@@B@@@B@@A@@B@@@BA@@@@BAA@@@BA@@@@BA@@@@B@@A@@B@@@@A@@B@@@@@@@@ABABBB@@@BB@@@@BB@BBBA@B@@B@DB@@BA@@B@@@@B@@B@@@B@B@B@@@@B@@@@B@@B@@@B@@B@@B@@@@@B@@@BBB@@@B@F@@@B@BB@BJBB@BAB@B@BA@@B@@@BBB@@@@AB@B@@@@@BAA@BA@C@@@A@C@@@A@@D@B@@AB@@@DA@@B@@@B@@AB@@@B@@A@@B@@@B@@@BA@@DA@A@@@@@A@C@A@@@AAA@@AA@AC@@@AAA@AAAAA@@@@@@AAAAAB@A@@A@AA@@@AA@A@A@@@@@A@AA@@@@@@A@A@@B@B@HDD@D@DBB@B@BBFB@CAA@A@C@A@AA@@@@ABA@@BAB@BA@@@@BAB@@@BA@@B@B@B@@@BABA@@B@DAB@B@@@BBBBBB@BBBDF@@DB@@BA@@B@@@DB@@BB@B@BB@@@B@B@B@AABA@@@ABA@AA@AA@A@@DBB@@@BAB@B@B@@@B@@CB@B@@@@@@B@@@D@DBB@B@@@B@B@@B@DBD@B@B@A@@BDABADBD@D@BB@@B@@@@@D@D@@@BC@CAA@C@@BAB@@@@CB@B@DBB@@@BA@@@@BAD@DA@@@@AA@@@ADA@@B@@@@@@@@AAA@A@@A@AAA@A@A@@@@@AAAA@@@@AC@AB@B@@A@AA@@@AAA@A@@@A@@AAEAEAA@@A@@A@@@@@A@ACA@@@A@AB@BA@A@A@E@A@AB@@A@AB@@@@A@ADC@@B@B@@@BABA@CD@BA@@@@BA@@AA@@@@@@@A@A@A@@A@AC@@AA@@@@@ABEB@D@BA@A@@@ABAA@@C@AAA@@AA@A@ABAB@@@@@B@@@B@@BB@B@@BB@@BB@@AB@DE@@B@@BB@BAB@LI@@B@@@BABBB@@@D@HAB@BALAB@B@@@@@@B@DBB@@F@B@BA@ADAFABABCBADADABADABA@@B@BA@AB@BABCBA@@F@D@B@@@BBFABBB@B@B@BA@@@A@A@@@A@AA@BCDABA@ABAACB@@C@@B@@@BABA@A@AB@@@BA@@DC@ABABCBA@@BA@@B@@A@@DB@@@BB@BBB@@BBBB@BB@@BBBBB@@@B@@@@@B@@@@@@A@@@@BB@@B@B@B@@@@AB@B@@ADABA@@@@BADABADAB@@A@@BA@@CA@@@@@@@A@@D@B@D@F@F@@@BBB@@@@@@@BAD@BBBBBBDBDB@@@@B@@@B@DB@AB@@@B@B@HD@@@AB@@AAA@AB@BAB@@AB@@@BB@B@@@@BA@@B@@@@BBB@B@@@B@@BABAB@@@B@@A@@@AB@@CFC@AB@@@F@D@B@DAB@B@B@D@B@@@BBBBD@B@B@@@@B@@B@B@DBB@DB@@B@@@@@BBB@@@B@B@@AB@@A@A@@@@B@@BB@BBB@D@@BB@DA@BB@@AB@@@B@@@B@D@B@@@@A@@B@B@BAB@@@BA@@B@@AB@@@B@BBH@BBBAD@@@DAB@@@BAD@@@BC@@@@D@@@@@BB@AD@F@BAB@BAB@D@BA@A@A@A@@@AF@BAFAB@BAB@D@BB@@B@@B@@@BB@@@B@@@B@B@@BB@@@@B@BB@@BBB@BBB@B@@B@BB@@@@BBB@BAB@@A@@B@@@B@BB@@DBB@D@@@@@@A@@AA@A@@@AB@@@BAB@@AB@B@@A@@@@B@@@B@@@B@B@B@D@D@BAB@@@D@@@@C@@@@@@@A@A@@B@@@BAB@@AB@B@DABABAB@B@B@@@B@@@@@BABA@A@@@A@@B@@@B@@A@@B@BA@A@@FABAB@@AD@@AD@@@B@@AB@@AD@BABA@@@A@@@AB@@A@@@A@AA@AAAA@@A@@AB@BA@@B@@@B@FADA@@BAB@BA@@B@B@H@B@B@BA@@B@B@B@DAB@B@B@D@BAD@@@B@B@@@@@B@B@FAB@DABA@@B@DA@AB@@@AAA@AA@@AA@@@@AC@CCA@C@@@A@@@A@@@A@@AB@@ACA@AA@@AA@A@AA@@A@A@AA@BABAB@B@AAAAA@A@E@C@A@CBCB@@A@A@ABE@@@ABA@CBCBA@@@A@@@A@A@CBA@CD@@ABA@A@A@@A@C@AAE@@B@@@@AA@CBAAA@@@A@A@A@@@AB@@A@@@A@AA@@@CA@@AAAC@AACAAA@@@BABABADABAB@@A@@AAAAA@A@A@@A@A@A@@C@G@A@C@A@AAA@@BABAB@@@@A@@@@AC@@C@@A@@@AAAAA@@@AABA@A@@@ABAB@@A@AB@BA@@@@@AC@@@@@@BA@@@@A@@@@A@@@@@@AA@@@A@@@@@A@@@BA@@@@AA@@@@@@A@@@@@@A@A@@@@@@@@AB@A@@AA@@@B@@A@@@@@A@@@@@A@@A@@@@@@@A@@@@A@@AA@@@A@@@@@A@@A@B@@AA@@@@A@@@@@A@@@@@A@@@@@@@@@@B@@@B@@AA@@A@@@@@@B@BA@BBABB@A@@B@@@@AB@@@@@@@B@@@@@B@@A@@@@B@BA@@@@@@@@@A@@@@@@@B@BB@@ABA@ABABABA@ABBB@B@@@B@BB@@DD@@B@BB@@BB@@B@@@@@@@@A@A@@B@@@@A@@@@@A@@@A@@BA@A@A@@@A@A@AB@BA@A@A@A@A@A@@B@@@@@BB@B@@B@BBB@B@B@@BBB@@@@DBB@BA@@B@FAB@B@B@D@D@@@DAB@D@@@BB@@@H@@B@BBB@BAB@B@BAB@B@B@B@BAB@BCB@BA@@B@B@@@B@@@BA@A@@BA@@@CAA@@AA@@@ABAAA@A@@BA@@AA@@CACA@C@CAAAAA@@A@A@@@A@@B@@@@@@A@AAC@AAAEAA@AAA@@AAA@A@@AAA@@@@@AA@@@A@@AAA@@@AA@A@@@@AA@@@A@A@@AAAE@@@@@AB@@ABA@AB@@@AAA@@A@A@@AAA@AAAA@AA@@@@A@A@@BA@C@@@A@@@A@A@@@AA@@C@A@CAAAAB@@AB@@A@@@AA@@AAA@@AA@@ACA@@@@A@EAC@A@@AA@AA@@A@AB@@A@C@@BA@ABA@A@AA@@AA@@@@A@A@@AAA@@A@@@A@A@@@A@@A@AC@@@AAAAA@CA@@AAAA@A@AA@A@C@C@@A@@@A@AAA@A@A@A@@@A@AAA@AAA@AAAAAAA@AA@@A@A@@@A@A@@@ABABABABA@AB@@C@@@ABA@A@A@A@A@A@A@@AA@AA@A@C@A@@@CBA@ADC@@BA@A@A@@AA@@AAAAA@@AAAA@AAAAAA@@AA@AA@@@@A@@A@@A@@@@@A@@AA@AAC@A@@@@@A@A@A@@@@AA@@@@@A@C@A@@@C@A@@@AB@@A@@@A@A@A@A@A@GAA@AAA@@@A@A@AAACCCEAA@AA@@A@A@E@C@C@A@@@AA@AA@AAC@@@@@@@AAA@@@@@@AA@@BA@AFIBCBA@@@E@E@C@AAAE@C@E@A@CCCEAAAACACBA@EBI@EDABABE@C@CBABCDEBEDE@E@EAEBCBED@@G@KBMBODODEFGFEJCJENAHCBC@CAE@ABC@CDC@EHADAFABC@C@EAGBINE@G@E@GD@JCDIFC@@AABAAAACB@B@DABCDAD@D@BADILEFA@C@A@ICIEG@E@CBCBCACECECICEC@CBEBG@C@@A@MFCBABAD@DA@ABEDADCF@DADA@C@C@AC@ECEKACA@CAE@EAA@G@CBGB@AAACACACBCBABADEHCBGDKBIBEFCDAFAFCDCBGBGFEDGHGHIFCLGVCFCDIDEFADBD@D@@@BCD@B@DB@BBBB@FADA@C@C@ADBFFHBF@D@DBDBAD@BCDABB@D@BD@BADBDABADABBBDBBH@BABABC@G@EDADDDDBBD@H@FBFBDD@L@JBBD@DB@@BBBD@JB@BBBB@B@HDLBH@H@DABCBC@CEABADADBBBF@BABABA@A@E@CAE@A@CCCAAACBCBEHKZ@JCNAHEDCDCBG@IAG@C@CB@B@DBBB@@@BB@BBFCDEFILGJCBG@C@AB@D@@JND@FAH@F@BB@DAHEDCFBHJLFHHFHDD@DFBBDDDF@@@L@BAHEHILIF@@MFKHKHMLKHEDGFMBGAUCMCIBIHGLAJBJFNFN@L@BAB@BA@A@@@@@A@@@A@@@@@@@A@@@@@@BA@@@@@@@@@A@@@@@AB@@A@@@@@@@@B@@A@@@@@@@A@@@@@AB@@@@@BA@@@@@@@A@@@@@A@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@BA@@@@@@@@A@@AB@@@@@@@@@@@B@@@@@@A@@BA@@@@@A@@@@@@@@@@@@BA@@@@@@@@@A@@@@@@BA@@B@@A@@@A@@@@@@@AB@@AB@@A@@@@@@@A@@@@B@@@@A@@@@A@@@@A@@@@B@@A@@@@@@@@@@@@@@@@@@@@B@@@@@BA@@@@@A@@@@@@A@@A@@@@@@@A@@@@A@@@@@@@@A@@@@@@B@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@AB@@@@A@@@@@@@@@@@@B@@@@@@A@@A@@@@@@@@A@@@@@BB@@@@AB@@@@@A@@@@A@@@@@@BA@@BA@@@@@A@@@@@@@@@AF@D@DADA@EBM@IAOAUAEAMEKEGICGGGMGMEK@@@QAK@OCOCQBIDIJEHEDABIHIDIBMBOFIBC@QBUHOHG@IBIDIBIAQCOAM@KAQBQBEDIHCB@@AFADABABCBABE@GAGAC@AACA@EB[AECACBGBGFA@CBCBCBCBEDC@IEC@E@AAA@ACAECKAAAAA@AA@@AE@ACCAA@C@EBMBMFQDOFODI@ABQBADKBUBKAKEKEGKEEAG@MDIJKFIJILIJKLEHIDOLKHA@IBEBC@CBA@IDEDIBC@C@@@@@ADBBFLDFFLDBNFBB@BCDCHCTEBA@A@AD@D@BABE@C@GAC@A@E@A@@B@@A@ABC@A@C@C@A@CACAAACAA@AAA@CBA@AD@BAB@BAD@B@B@D@BAD@B@BAD@BAB@@AB@D@BABA@DB@BB@@BBD@BAB@DAD@@@DABABABADAB@D@B@D@B@BBD@BAB@@@BCBA@C@A@CBEB@BABABABABAFA@BB@@AD@@ABCBAB@BBB@B@DADADBBAD@@CDCB@B@B@@@BBDD@D@D@@B@BBB@DBD@D@BABAB@@CBC@CBABAF@@ABABCDAB@BAB@BA@@@A@@CAAAAA@A@C@AB@BABA@@BC@A@AB@@@BBDABAD@DA@@DAD@D@DBB@@AB@B@BAB@BAB@D@D@D@BADABADABCBAB@BAD@D@D@B@D@BAB@@@B@DABABA@AB@F@D@DBB@BBD@BADBBAD@F@B@BBD@D@D@D@DBD@D@B@DCDCBC@CBCDABC@CBCBAB@B@DAFABC@E@EBEA@EAECGGAEAGAA@BDCBABC@EBEBADBHB@B@F@HCHADADBFBFFBHDDFBHBD@D@B@DBBBBDDDBDDFBDDFBF@BBDBHBF@D@D@@@D@BBD@DDBDBBDBFFFBFBF@@@F@DBBBDBBBF@D@D@DBB@BBBDBFBFBB@"],encodeOffsets:[[107039,25325]]}},{type:"Feature",id:"451031",properties:{name:"隆林各族自治县",cp:[105.34404,24.770896],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@HFDDHHFDBBB@BBD@B@BBBBBDDBB@BAD@BB@@B@BD@BBD@BBBBBBB@B@BBFBDDBBBB@BBDAB@BBD@B@B@B@B@FCBADABCB@B@D@@BFFHHBDB@DFDJBD@BFDBDB@@@BB@BBBF@FDFDJFDBDBF@F@D@FBB@D@D@B@DA@ABABAAA@CAA@A@@@A@E@ABAD@LAD@HBD@B@BABABCBC@A@AAEAA@A@C@@BAB@D@BADAD@D@FDDBBBB@B@B@BALCBCDEBE@AAAECA@ACAC@G@EBCFGDEDEDAH@J@H@B@DAB@D@JAHABAL@PAF@F@J@N@B@FBD@DBBDDFDDFDDBFBHDDBH@D@FDHDH@DAD@F@DAHBHAH@D@@ABABADAF@F@NCFABBJEF@PCFEFADABADCD@DCFEBGDG@A@A@@@@@CBA@CBE@EBCBE@CBCBCBG@ABC@E@@AC@A@CDABCHCDC@AB@@A@AAA@@@ABCHIDAFAFAFAFCDADEDCDEBEBE@EB@@IAOBABC@ABCDEFAFARCFA@@FAJCLAB@HBB@JAFADAL@D@H@HAD@H@F@B@F@HBFFDDHDJDLBFABAHAHAFG@EBE@C@ABC@@@A@@BA@ABADCBC@@BAB@B@BAJEFAJARAPCL@NBFBLBJBB@@C@C@A@A@A@A@A@A@@@@AAAB@A@@@@AA@@GC@@C@@@AAA@A@@A@@@@@AB@@AB@@@BA@@@A@A@A@AAA@@@CBC@@AC@@ACA@A@AAA@@@@A@A@A@@@ABA@@@A@@@@@A@@@@@A@AB@@@BAB@BADA@CBA@@@C@@BABA@A@@@A@A@C@@@AB@@A@ABCAA@A@@BAB@BA@AB@BAD@B@B@DC@@@@@AA@@A@@AA@@@ABA@@BAB@@AB@B@BAB@FA@@@@@@C@A@@AA@ACA@@@AA@A@@@A@@@@@@@AB@@@@A@@@@@A@A@AAA@AAAAA@@AA@@B@@C@@AA@A@@@@DC@@@C@A@AAA@A@@BC@A@@@@AAA@AAAACA@@A@BA@A@@@AA@@@@@B@@A@A@@@@BA@@@A@@BA@A@@@CB@@@@AAA@@B@@@@@B@@@@@BA@@@A@@@AAA@@@A@A@@@@AA@AA@@@@ACA@@AA@BAA@A@@AA@@AAAAA@@@@ACA@@A@AAAA@@@AA@@A@AA@@ACA@@B@@A@AB@@ABA@A@AB@@A@AB@@ABA@AA@@A@AAAA@A@@@@@@CBC@CB@@A@@BABA@AB@@@A@AC@@BA@A@@@@ACACA@A@AC@@@@@@@A@AA@@A@A@@@A@A@@B@B@BA@@@@B@B@@@B@DADA@A@@@ABA@AAA@@@@@A@@@AB@@@BC@AAA@ACC@@@@@AA@AAA@@A@C@AA@@@AA@@A@@@@@ACAA@@@@@AA@@@AAA@AAAAA@@AA@@A@@AA@@@A@A@@@AEA@AA@@@AA@@@A@@ABA@A@AB@@A@CA@@A@AA@@@C@AAA@A@AA@A@A@AAA@AA@@@@@@A@@@AB@@AAAA@@A@A@@A@@AA@A@@FCBCB@B@@@B@BBB@B@B@@@@@@A@@BAA@@@@A@A@@DA@A@@@@C@@@AA@@AA@@A@BC@@@AC@AAAAAA@@@A@@@AA@A@C@AACA@@A@@@ABA@@A@@@@@A@@@@A@AAAAAAA@AAAAC@B@@AA@@@@@A@ABA@ABA@@@@BA@A@@@A@A@@@A@A@@@AAAA@@A@A@@@AA@A@@A@A@A@A@@@A@AA@AAA@@@@AA@@AA@@AAAC@@@E@A@AACAA@@@AB@BAB@BABA@@@AB@@A@@BC@@@@@AA@GA@B@@A@A@ABAA@@A@@@A@@@@@A@@A@A@AB@@@@AA@@@@C@@@ABC@@@@@A@@@A@AAA@@A@AAA@AAA@@@A@@@E@@@@BA@A@CB@@ACAAACAA@@AA@A@A@A@A@@@@BA@@@A@ABA@A@@@A@AA@@@@A@@BC@@@E@@@@@AB@@@@AB@BA@@D@BCBAB@B@B@B@B@B@@BB@@BB@@@B@@@B@B@@BB@@B@@@BB@AB@B@B@@@@B@B@B@@@BAB@@@B@B@@@BA@@B@@@B@BBB@@ABA@@B@@AB@@@BAB@@@B@@@DABA@@@@BA@@BBB@@@@@@@B@@@B@@BB@@@@@BB@@@B@B@@@B@@B@@@@@BB@@A@@BB@@@@@@@BB@@@@@B@@B@@AB@@@@@AB@@@@B@B@@@AB@@@@B@@@@B@B@@@ABA@@B@@@@@@B@@BB@@@@@@@BB@@B@@@@B@B@@@@A@@B@BB@BBB@@@@@@B@B@B@@A@@B@AA@@BABA@@B@BBB@B@B@@@B@@BBB@@B@BA@@D@DA@@BAA@@@BABABA@@B@@@B@@@BA@@@@C@AA@@@@A@@CA@@C@A@A@@@A@@@@@AA@@A@A@@AA@@@@BA@@@A@A@ABCA@@C@AAAB@@@@A@@@@@@@A@A@@@@@A@@@@@@@AA@@@@@B@@@@C@@AA@@CA@@AA@AAAAAA@@@AA@AA@@AB@@@BAB@@ABA@@@A@@AAAAA@@AAAAAA@AA@@A@@A@C@A@@AAAA@@AAA@@@A@@AA@AB@@A@AA@@A@@@A@A@A@A@A@@@A@C@@A@@@AAAAA@A@C@A@AB@@A@@@@A@ABGF@@A@A@A@@@A@@@ABAA@@A@C@AA@@AA@@E@EBA@@AA@AAGC@A@A@@@AAA@@@AA@AAACA@AC@@@@AAA@AA@C@@AA@@A@@@@@AA@@@A@@A@A@A@@AABABAB@@@@A@@@A@A@A@ABABCBA@A@@BA@AB@@A@@@@B@B@@@@@@@D@@C@@@A@ABC@C@A@A@A@@@A@@@A@@@@@@BA@A@@BA@AB@@A@@B@@@BBB@@@B@@@@C@A@CA@@AAA@@@A@@@@BA@ABA@AA@@@@AAA@@@@AAA@AAA@AA@@A@A@@A@@AA@A@@@A@@@A@@A@@@AA@@@AAC@A@ABA@EBABE@@B@@@B@B@BABC@A@ABA@ABE@C@@BAA@@@@C@@@@@AD@@C@AB@@A@CB@@C@ABAAG@AAA@@@A@@BA@@@AB@@A@ABA@A@@@@B@@A@C@A@@@A@@@A@@BA@@ACBA@@AC@A@AAA@@AA@@@@@@B@BA@@BA@A@@@A@AA@@@@A@@@CAA@CAA@A@@@@A@@A@A@C@AAAA@@A@C@A@A@A@CBA@C@E@@@A@@BED@DA@@B@@@BA@@@A@ABAB@@@A@@@AAA@A@@A@@@AB@@@@@AAA@@A@@BA@ABA@@BBB@BA@@B@@GCA@A@@@A@@BCAA@@@A@@@@@CACAAAAAAAC@AB@@@@@@A@AA@@E@E@C@A@C@@@@B@@@@@@DB@@AB@@@BA@CBABCBAB@@@@ABCB@BA@A@@B@@A@A@A@@@AA@@@@@B@@@@A@@@@@A@@@A@AAAA@@AAA@AA@AA@AAA@@A@@CA@@@BA@@@AB@@ABADAB@BCD@@AB@@A@@B@BABAB@@A@@@@DA@BDAB@BABCBADB@@B@B@@@B@B@@ABA@A@A@AAEBAA@@A@C@E@@@ABADABA@@BABA@@@ABCBABCBCBABADABEBCB@BABA@E@@@AA@C@A@@@@A@A@KBABA@GBC@@@A@AAAB@@A@@@KJA@ABA@@AA@@@CFA@@BA@@AA@@AA@A@@AA@@@A@@@@@A@AB@B@BBB@@BB@B@DB@AB@B@@@BABC@A@AF@B@@@@BB@@BDB@@@@B@B@B@@@@@@BB@@AB@@@@ABC@@DABAB@@A@A@@@CD@B@B@@A@@B@BA@@B@B@F@B@BABA@@B@B@@DB@B@B@@@@@BB@@@BBBFBF@BB@@@B@B@BB@@B@@B@BA@A@@BBD@@@@BBBB@@@@B@B@B@BBB@@@@BBB@B@@@@@@A@@@CB@B@@BB@@@@CBC@AB@@@@AB@@A@CAA@A@@D@@A@AB@@@DBB@DAD@@C@C@@@@@A@@@AAC@C@CAABCB@AB@A@A@C@CAA@@@@A@A@@@AAA@C@C@@@A@@@@A@A@@DA@@@A@A@A@AB@@A@CA@@@BBBB@@BAB@B@@ABBBA@A@A@@@A@@A@AAA@@CA@@A@@@AB@@CA@@CEAA@AAAAAAA@@A@A@CBA@@@ABAB@@A@A@A@@@AB@@A@AB@@@@ABA@AB@@AB@B@@B@@B@B@D@BBB@DEAAAA@A@CAC@C@GCA@A@@@@B@B@@@@B@@B@B@@@@@B@BBB@@B@@B@BB@A@BBBB@B@@@@B@BBBBB@BB@@D@@BBB@@BB@B@@@B@D@B@@@@@BCB@@AB@@A@@@A@@@@BA@@@A@@BA@@@A@@@CB@@A@@BA@C@@@@B@@@D@B@@@DABB@AB@@@@A@A@@B@@A@AA@@A@@@ABA@A@ABA@IA@AAAA@@@E@A@@@A@AA@@A@@@@@A@@@@AA@@@A@@@@A@@A@@@@@@A@A@A@@@AA@@@@@@AB@@AA@@C@AA@B@AA@AAA@@@@AA@@A@AAAB@B@@@@@@A@@@@B@@A@@@@BA@@@@@AB@@@@AB@@B@AB@@@@AB@@A@@@@BA@@@A@@@@@@BA@@@@B@B@@@B@@AB@BA@BB@@A@@B@@@BAB@@@@@@@B@B@@@B@@@@AB@B@@A@@@A@@B@B@@CB@B@B@@@B@@A@@B@@@B@@@@AD@B@B@BA@@@@@A@@@@B@B@@@@@B@@A@@@@B@@@BA@@@ABBB@@@@@B@@@B@@@@@B@@@B@@@B@B@@@@@@@@BBAB@@AB@@@BAB@@@@A@@@A@C@A@AA@A@@EEAA@@@@AAA@@@@@A@A@@@AA@@AA@@@@A@AAA@AA@@A@@@A@@@AA@@@@A@@@A@AA@@C@@@@A@@@A@@@@BAA@@@@@@A@@@@@@@A@@A@@@B@@@@A@@@@@@AA@@@A@@B@@A@@@@A@A@@@BA@@@@A@@AB@@@@AABA@AAA@AAAA@AA@@@@CAAA@AA@@A@A@@@ABAB@AC@@BABA@AA@@GBAAA@A@A@AAA@A@@@@@A@@ACBA@C@C@AB@@A@@@A@@@ABAA@@@@@CAA@@AAA@AACA@@ABA@A@ABA@ABC@A@@@C@@@A@@@@@A@@@ABCBCBA@@DA@@B@B@B@@@BA@CBA@@@A@@@A@@@A@@AAB@@C@@@A@@@@@@AA@@@@A@@A@@@A@ABA@A@C@A@@@AB@@@@A@A@C@@@C@@@A@@AAA@AEA@A@@B@AABAAC@@@@AAAA@C@@@@AB@@CBE@CBA@CBE@@BABA@@B@B@@ADAB@@@B@BA@ABA@A@@@A@C@@AA@AA@@C@@A@@@C@AA@@A@C@@CA@AA@@@A@AA@@@AAA@@AA@@A@BAAAAAB@AAA@@A@AA@@@@A@A@@CC@A@@@@@AA@@A@A@@@@A@C@A@A@@@A@C@C@A@@@@A@A@@AA@@A@@@CAAAA@AAAA@@@A@@@BABA@@@A@@@A@@BA@ABA@@B@@A@A@AA@@A@@BAAAB@@AA@@A@A@A@A@AA@@@A@@ABABAB@@@BAAA@ABE@A@@@A@@@@@CBA@EBA@@BAB@B@@@BB@@BBB@B@@@B@@@B@@@B@@A@@@@@@B@BABABABB@BB@@@B@@BB@@@@BBAB@B@@@@ABABA@@B@@@BAB@B@@@B@@@B@B@B@@@B@@@B@@@@@B@B@B@@@B@@BB@@@D@@ABBB@@@BA@@@A@@@A@@@A@ABCBA@@@@@@@BA@@AE@AAAAA@@A@@AA@@A@@AAC@AAC@@@@@@A@@@@@@@@AAA@@B@BA@ABEFCB@@@@C@A@A@@@@@@BA@BD@B@@@@@@@B@@@@@@@BA@@@@@@@AB@A@B@@A@@@AB@@@@@@BB@@@@A@@@@@A@@@@B@@@B@@@D@@@B@@@B@@A@@@BB@@@@@AB@AB@B@@@@@B@@@@@@B@@@@@@B@B@@@B@B@@@B@BA@@BB@A@@DAB@@@@@BB@@@AB@@@B@@AB@D@@@@@@A@@@@B@@B@B@@BA@@B@@BA@B@@A@A@@@@@@BB@@BA@AA@B@@@@B@@B@@BB@@@@@@@B@B@@BB@@@@@@BB@@@@@B@@@@@@A@@@@BA@@B@@B@@BD@B@@B@@@@@@BAB@@BB@@B@B@@@B@B@@@@A@@@@B@B@@@@B@@@@@@@@@AB@B@@@@A@@@@@@@@BAB@@@@@@@@@@@AA@@@@B@@@@@B@B@@AB@@D@@@@B@@@@@B@@B@@AB@B@@@@@@B@BB@B@BB@@B@@@ABB@@@B@B@@@@@AB@DA@A@ABA@E@AAC@@@@B@@ABA@AB@@A@@@BB@@@@BBDBDB@@DBB@DD@@BBB@BBB@BBBBDDBBB@@B@B@B@@@B@@@@BBDDBDNTHLFHDP@XHTRRTHHFDDDBLFVFZD`HFB^F@@L@lJbPdPVBLBBHAHEVCJCF@DBJFJLLBFBHDNBL@F@HCDABCJBHBFRNRJNH@@PNDPBHBD@P@@FDHE@@@@@A@@@@@@@@@@@@@@@@B@@@B@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@@@B@@B@@@@@@@@BB@@@@@@@@@@@B@@B@@B@@@@B@@@@@@@@@@@B@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@@B@@B@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@AB@@@@@@@B@@@B@@@@@@@@A@@@@@@@@@@@A@@@@@@A@@A@@@@@"],["@@AA@@@@@@A@@@@A@@@@AA@@@@@@AA@@@@A@@A@@A@@@@@@@@AA@@@@@@@@@@@@@A@@@@AA@@@@@@@@@@@A@@@@@@AA@@@@@@@@@@@@A@@@@@@A@@A@@@@AA@@@@@@AA@@@@@AA@GFfT@@@@"]],encodeOffsets:[[[107619,25527]],[[107619,25527]]]}},{type:"Feature",id:"451029",properties:{name:"田林县",cp:[106.228538,24.294487],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@@ADA@AAC@@@CCC@@A@@@AAAAAA@AA@@@A@AAC@@@A@C@AAA@@@AA@AAA@A@@BA@@BA@@@A@@@AAC@@AA@AA@@@@AA@@A@A@A@AAAA@AA@@@@E@A@CAA@ABAB@@@@A@@@@AA@AA@@@AAA@@@A@@BC@@@AA@@@A@ABCBCDA@A@ABA@@@A@@@AAA@@@@A@A@A@A@A@@@A@A@A@AB@@@BC@@@@B@BA@@B@@@BA@@@@@A@A@@B@@@@C@A@@BA@@A@@@A@@@A@A@AA@@@@AC@@@@AAAA@@AA@A@@@@AA@@@@@AAA@@@AAA@AAC@A@@@A@@A@A@@A@@CB@@A@@@ABA@A@A@@@A@@AC@@@ADCB@@@BAAA@C@A@@AAAA@@@BA@@@AB@BAAA@AA@@ABA@@@C@@@A@AAAAA@@@AAA@@@C@ABAAA@A@AA@AABABA@A@@@A@@@AA@AAA@A@@@@A@@@@@A@@@AAA@@AAAA@ABCAA@A@AAAAA@A@A@A@A@@IG@@EFIDGHGJAD@@ABA@A@@B@@@B@@@BABAB@BABADA@@@AB@@A@@@@@ABAD@@@BA@@@@BAB@@ABA@A@A@A@A@A@@@A@@AA@@@A@@BA@ABABA@ABA@@@@B@@BBBBBD@@@B@@BBB@B@D@BA@BB@B@@B@B@B@@@B@DHZ@DAFADGHCDCBE@@@GEA@E@ED@FEHIFMHCDAF@@AB@@@B@@@B@BAB@@@BBF@F@BAD@B@B@B@BB@@RCP@@CJAB@@@@A@@@A@@BA@@@A@@@@@A@@@A@@@A@@BA@@@@BA@@@A@@B@@A@@@A@@BA@@@A@@@A@@B@@A@@B@@@BA@@@@B@@ABA@C@A@@@@@A@@@@@@@@@@B@@@BAB@@C@C@CCCAE@EAABCDABADAD@BAD@BABCDCBABABAB@B@B@BBBDBD@B@FBB@BB@@A@ABG@GAIEMCOCC@CEICMC@@EBEBG@KHMFIFEBQ@CAE@GEIIEGGK@@CKBIBK@@BKBIAEFE@CCGAE@EBA@AEKAIEGECGACBCDEDEAC@EFADG@KBCBEFEFEAGB@@CA@EBE@CEICGAGCECCEAICC@CBIDC@EAGCKBEAOGE@GFEDCFBHBHDFFD@@FFJJDJELKHIFMDKBIBO@EEA@KEIEIEKEM@IDGDOBMDIBGAKGEKCIKGEGA@@AIGEAGAEBI@EACGEAEBCDEJCHABCRBHBFBHADGTERAR@J@H@AA@A@@@CBCB@@@B@DDJ@@@DCD@B@D@B@BA@@B@BBB@@@B@B@B@BABCDA@ABA@@BA@A@A@@@@BABAD@@A@@@A@A@@AA@@@A@A@@BA@@@A@@BA@A@C@@@A@A@ABA@A@CBA@A@A@A@AA@@@AA@@A@AA@@@AAA@@@A@@AAAAC@@@@A@A@E@A@@@CBA@ABA@@@A@A@A@E@A@@@A@@@A@@@AAA@@D@D@BBBB@B@BB@BB@@DBBBB@B@@BDB@@@@B@@@B@B@@@BB@BB@@@B@B@@B@@BB@@B@BAB@BAB@@@D@BA@@B@BB@@BBB@@@BBD@F@B@@B@BD@@BB@@BB@BB@@B@@@BA@@BA@BBBB@D@B@DB@@B@@@B@B@@@B@@@DAB@@@B@B@@B@@BBBBBB@BB@@@B@BB@BB@@A@@BAB@BA@@B@@@@BFBB@@@B@B@@BB@@@@@BBB@@B@BB@@@B@@BB@@@@B@BB@@@BBB@BB@BBB@FBBB@BBD@B@B@@@@A@@@@B@@@B@BB@BBBB@D@DDBDB@@BB@@AB@@@BBBAB@B@@BB@@BB@D@@AB@@@BAB@@A@@@A@A@@@ABA@ADA@ABA@A@A@A@ABA@A@ABA@AAA@@@@G@@AA@@C@A@CB@@C@C@A@A@A@EBA@@@ABA@CA@@@@AA@AA@A@A@AAA@@@@AAA@@@@A@@@@B@B@B@B@BABA@@B@B@B@@@B@BAB@@@B@@@B@@@@@B@@A@@@@B@B@@@@@@A@@@AA@@AAA@@@CC@@AAA@@@A@A@AA@BABABAB@BAB@BA@@A@A@@@@@@@B@@@@@@@@ABA@@@@@@BA@@@@@A@@@@@@@A@@B@@A@@@@BAAAB@AABA@@@@@@@@BB@@BA@@@A@@@@@@@@@@@@B@@@@@B@@@@@B@@B@@BA@B@@@@B@@@@@B@@BB@@@B@@B@@@@@@@B@@@@B@@@@@B@@@@@BA@@@B@@BB@A@@B@@@@@@B@B@@@@@@@@B@@@@B@@B@@B@@A@@@B@@@@@B@@BB@@@@@@@B@@B@@@@@AB@@@@@@BD@@@@B@@ABAB@@@BABA@@B@B@BA@B@@BBBB@B@@@BD@@@BD@@@@@B@@A@ABAB@@BB@B@B@D@B@H@DB@B@B@@@@B@BBBBB@BB@@@BABABCBABA@A@@BBDBBBD@BB@BB@@D@@BBB@@@B@@@BA@@B@B@B@@@B@BBDAB@@B@@A@@@BF@B@D@BB@B@B@BA@@DCB@DAB@B@@@B@@@B@DADAB@BA@@F@BAB@B@@@DADAB@D@F@B@B@BBBBA@A@ABABB@@B@B@BB@@B@BBB@@BBB@BD@@BA@@@B@@@B@@@B@@@DDB@DBD@@@@BB@@BBB@BB@@A@@BCBA@@@ABCBA@EBA@A@@@@@A@A@@@C@ABC@A@A@A@CBA@A@A@@@ABA@A@G@A@A@@@ABA@AB@@CBEBA@@@A@@@ABA@@BB@@@BBBBB@@B@B@@@BA@@B@@@B@@ABABC@@BA@@BA@@@C@@BC@@BA@ABEB@@@BABA@@@@BA@@@A@@@@B@@@BBA@BB@B@B@@@@B@@BB@@@@B@@@BB@@@DBBB@BB@@@@BDB@BDBBB@@B@@BB@B@@@B@BHDBBB@@BB@FAF@@@BB@@BBD@B@@@BBBA@@B@@@B@B@B@@@HEBAB@@@@@@BA@@B@B@D@BBBBB@B@@@BD@B@@@B@B@B@B@B@@@B@@@BBB@@@BAB@@BB@@@B@BB@@BBBB@@@B@D@BB@@@BBB@BBBB@BB@BBBB@@@B@@AB@BA@AB@@A@@BB@@BBB@@B@BBBB@BBB@@DB@@BB@@@D@@A@@@@@B@@B@@@@@@@B@@@@@B@B@@@@@@@B@@A@BB@B@DB@AD@B@B@B@@AB@@@@BB@@@B@BB@@B@@@@@B@@@B@B@DB@@DB@@@@@BBD@@@@@AB@@A@@@A@@@ABABAB@@B@AB@@CBC@@@ABA@@@AA@AA@@@A@A@A@AAA@@@ABAB@@BBA@@@@BA@A@A@@@@@@@AA@AAAA@@@@B@@A@A@@@@@@AA@@A@@@@@@AA@@@A@@@@A@@@AB@B@@@A@A@@A@@@@@BA@@A@A@@@@@BA@@@@A@@BA@@@@A@@@@AA@@@@@@A@@AB@@@AA@@@@A@@@@A@@@A@A@@AA@@@@A@@AA@@@A@@@@@@@A@AA@@AB@@@@ABCB@@A@@@A@AB@@A@@BA@@@AB@BA@AAA@@@A@@@AB@@A@A@@@A@AB@@A@A@A@@@@@@A@ABAA@@A@@@AA@@AA@A@@@A@@@A@@AA@@AA@A@A@A@A@A@ABADC@@@ABA@@B@@A@@B@@@@@F@@AD@@@B@@B@@B@B@@@BAB@B@B@@AB@@@@@B@B@B@BBB@@BBBDBBBD@@DAB@B@@A@@F@@@B@@@B@BBB@BBB@@@BB@B@B@@@B@@@@AD@B@@@D@@B@@B@@A@@B@B@BB@@@@@B@@@B@@@BBBAB@B@@@@AHBB@@B@@@@AD@@@BA@@B@@ABABA@ABA@@B@@BBBD@B@B@F@@BDBB@@BB@@BB@@@@BB@BBBB@@@B@B@B@B@@@@BBB@@B@B@@@BBBB@@B@B@@@B@B@@@B@B@@A@@B@BAB@BAB@@@@@B@@BA@D@BBBBB@BBBBBBB@@@@@@B@@@@@BB@BA@@B@@@DBBBD@B@B@@B@@@B@@BBBBBBD@@B@@ADB@@@BB@@BB@@D@@@@@@BCB@@@B@B@@B@AB@@@B@@@@A@A@A@AAA@@@A@A@ADED@@@BBB@@@BB@B@@@BBBB@@BA@@B@@@@@@@BBB@BBB@B@B@@B@BBB@B@D@@BBB@@@DBB@@@BAB@B@BA@@@B@@BB@@B@@BFB@B@@@B@B@@BB@@@BB@@BB@BBBBB@BB@@B@@B@@@@BBBD@@@@B@@@BB@@B@@B@D@BB@BBB@@B@@@@DD@BBB@BAD@@A@@B@@@B@@@@BB@BAB@B@@@BCBCBA@@@A@A@@@@@ABA@A@@@@B@B@@@B@BB@@B@B@@@@@@BDB@B@BDBD@@@@@BAB@@BDB@@@A@@BABAB@@@BA@@DAD@D@@@@B@B@BB@B@BB@@BAB@BA@@B@BA@@B@BAB@BA@@B@BA@@@DB@BB@@B@BB@@B@@BBBBB@@@DB@B@@B@BBBB@@BB@@@BBB@ABB@@DB@B@@B@@BBB@@@@@B@B@@BB@B@@@B@@AB@@@@A@@@@@A@@@BB@B@@A@@D@@@BAB@@@B@@AB@@@@@B@BA@@@@@B@@B@@@BABB@@@DBBBBBB@BB@@@@@BAD@@@BBB@B@B@D@@CD@@@@@BBB@@@DA@@@BB@@BBBB@BBB@B@B@B@@@@@B@@A@@B@@@@@@@B@@@BBB@@B@BDB@@BB@D@@@@@@@EBA@ABA@A@@BA@AB@@AB@B@@BB@@@BB@@B@@@@CDA@A@C@ABA@@BABA@AB@@@BBBAD@B@BA@@B@@@D@B@B@@@BABAB@@@D@@AB@DCBABA@AB@@A@@B@B@@@@@B@@@@@B@@AB@B@@@B@B@B@@B@BBB@B@BD@@BD@@AD@D@@BB@B@B@B@B@@AB@@A@@BA@@B@@@@@BB@B@BB@@D@@@HD@@BB@@@@@BBABB@@@@@B@B@B@B@B@B@D@D@@JADANCTCHCFANKHCFEFAFGBAFEFCFEHCJAFAFCFADAB@B@BCDEDEDI@CBADAHCDADAHCFCNEJEHADAB@DAB@F@D@J@J@JBB@D@LFLJJDF@DBBBFBFDDDDFBB@FDDBDD@H@H@BBD@B@@@D@@@BAD@@AB@BCB@@@@AB@BC@@@@DAF@LBNBF@L@JARBHBD@DBHAFA@AAEBCDCBABE@GBKDE@AFIBG@EDCLKBA@CHC@@BAB@@@B@@@BABABAF@HEHIB@BADADEDG@AAA@CB@@CD@DAFCJELGHEFCFAFAD@B@BBDDBH@DBBBBFFBFDDBBBDFDBFDJDLDH@HAD@DDFNJBDF@PLFDF@NHLFB@H@FBB@D@DAHDNHFBFDFJDLDBDBD@D@FDHBFDBDBDBB@F@JDFBH@D@BBDFHHF@@B@@@B@B@BADABAHCFAF@HBDDBDBDBDBHDHBFBDDFDDBBB@@@@@@@B@@@@@BA@@B@B@@@@@B@@@B@@@B@@@B@B@@@B@@@B@@@@A@@@@B@@@@A@@@AB@@A@@@A@@@@@A@@@@@@@A@@@@@@@@@@B@@@@@@@B@@@@@@@B@@A@@@@@@@@@@@@B@@@@@@A@@B@@@@@@@@A@@@@@@B@@@@@@@@@B@@A@@B@@@@@@A@@@@@AB@@A@@B@@A@@B@@@@@B@@@@@@@@@B@@@@@@@BA@@@@@@@@B@@@B@@A@@@@B@@@B@@AB@B@@@B@B@B@@AB@@@B@@@@@@@@@B@@@@@@@@AB@@@@@@@B@@@@@@@BA@@@@@@@@B@@@B@@A@@@@@@@@B@@A@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@AB@@@@A@@@@@@@@B@@@@@@A@@@@@A@@@@@@@@B@@@@@B@@A@@B@@@@@@@@@BA@@@@@@@@B@B@@@@A@@B@@@@@@@@@B@@@@@@@@AB@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@AB@@@@@@@@@B@@@@@B@@@@AB@@@@@BA@@B@@@@@B@@@@@@@B@@@@@BB@@@@@@@B@@@@@B@@@@BB@@@@@B@BB@@@@@@@@BB@@@@@@@B@@B@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@B@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@BA@@@@BA@@@@BA@@BA@@@@@@@A@@B@@@@A@@@@@@BA@@@@B@@@@A@@@@@@@AB@@@@@B@@A@@B@@@B@@@B@@@BA@@@@@@B@@@@@@@@@@@B@@@@@@@@A@@@@@@B@@@@@@A@@B@@@@@@@@@BA@@@@B@@@@@BA@@@@@@B@@A@@B@@A@@B@@A@@BA@@@A@@@AB@@@@@@@@@@@@@B@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@B@@@@@@@C@CA@@@A@A@@@@@@AA@@@@@A@A@@@A@@@A@@ACAAA@AA@A@@@@A@@C@ABA@C@ABA@AB@B@D@B@@@@@BA@@@AB@@A@@@A@@A@@@@@@AB@@A@@@@A@@AA@@@@@BAB@@@@@@@AA@A@@@AB@@@@@BA@@@A@@@C@@@AB@@A@A@@@@@AB@B@@@@@AA@@@@@A@@BAB@@A@@@A@A@@@AAA@A@A@A@A@@@@A@@A@@@@BA@A@@B@@@@@@A@@BA@@@A@@@@@AB@@@@@B@@A@@@A@@@@@AB@@A@@@@BA@@@@B@@@@@BA@@B@B@@@@@@A@@@A@@D@@@@@B@@@@ABABABA@@@@@AB@BAB@@AB@B@@@B@@AB@@@@A@@@@BA@@@@DA@@@A@AB@@A@@BAB@@@@@@A@BB@@@@ABA@@@A@@BA@ABAB@BA@@@AB@@AB@@AB@BA@@BAB@@@@AB@B@@@@ABAD@@@@@BA@@B@@A@@@@B@@A@@@@@@BA@@BA@@@@B@@@BAB@@@BA@@DC@@BA@@@@@ABABAA@@@AA@@@AAA@A@@ACACAA@@AC@@@@CEAA@@@AB@B@D@B@B@B@DBD@B@@@@D@@B@B@BABBD@@A@A@@AAA@@AAA@A@@@@A@@A@@@@AAA@@@@ADAB@@A@@@@AC@@A@A@@@@@AA@AA@AADA@AB@@AB@BA@@@@AAA@@A@@@A@@B@BADB@@B@D@@@@AB@@@DB@AD@@ADBBA@AB@B@B@B@BAB@@@B@B@B@@@@@B@BABABA@@DC@AB@@ABC@ADA@@BAB@BA@ABADAB@@@BAD@BAB@B@@AB@B@@@@@@B@@@B@@@@@BBDAD@B@@@BB@B@@@@A@CBCB@BAB@@ABBB@@@@BBBBB@@@BBBBBB@@ABC@AB@@@AAAAAA@ACA@A@@AC@@B@BA@@BBBABAB@@@DBB@@@DA@@BA@@BABA@A@AAA@A@CA@@AAA@A@@@AAA@AA@CAAAAAA@@A@@@@BABABCB@@A@@@A@A@@@@@A@@B@@ABA@A@AAA@@@A@@BA@@B@@@@@BC@C@@@ABA@A@A@C@C@CBA@@CCAACA@@A@CAAA@@A@C@A@AAA@AA@A@AA@ACA@@@ACAA@@@AA@A@@A@@@A@A@@@@@A@AA@@A@@AA@@@A@A@A@@@@BA@@BAB@BAB@BA@@BAB@@@@ABBDBB@DAB@BAD@B@@ABAB@B@@@@AB@BA@@BAB@B@DB@AAA@CAA@A@@B@@A@@B@@A@AB@@A@@@AAE@AAAA@@@ABA@@A@AAAAAACA@@CA@@A@@@@@@@A@@BA@@@ABA@A@AAA@C@@@A@A@A@@@@AA@@@A@A@@@A@@@AA@@ACA@A@@@@@AAA@A@AA@A@@@C@@@@@@@A@A@@AA@@@@A@@BA@@AA@@@A@ABA@@@A@@@@AC@@A@@@@@A@@@@@AA@@CD@@@@A@@@@B@@A@@@A@@BC@@@@@AA@@@@A@A@@BABA@@@A@BA@@@ABA@AB@@@BA@@AACC@ABA@AAA@C@@BCB@@A@A@CBABA@A@CBA@A@@BAAC@@@A@@@@D@B@@AB@@AB@B@B@FAD@DAB@A@@A@@@A@@BA@@BCBA@@@@@CB@@@BAB@BAB@@ABA@C@A@@BA@@BABADA@@@@BA@@B@B@DBDC@@B@@@@AB@BC@@@@B@@A@AA@@@@AAA@A@A@A@A@@@A@A@@@AA@@C@@BCB@@ABA@A@@A@AA@@AAAA@A@A@A@AA@@A@@AAAA@@A@@@AAA@A@@@AA@@@AA@@AA@A@A@@@@A@@A@@@@B@@AB@@@BCDCB@A@@@BC@CBA@AB@@A@A@@@@@@A@A@@@A@@A@@@@A@@@A@A@@@A@@A@@A@@@@@A@@@@@A@@AAA@@AAAA@@@@@@@@AACBA@@@AB@@A@A@@BABAB@@@@@@@A@A@@A@@@AAA@A@AB@BA@@BA@@BA@@BABA@@@A@A@@BAAABC@A@@AA@A@A@A@A@@B@@@B@BA@@@B@ABB@A@@@@BA@BB@B@@@@@@AB@@@@A@AA@@A@@@A@A@A@@BAB@@A@A@A@A@@@A@@@AA@@AAAA@AA@@AA@@AAA@C@@AAA@@AAAA@@@A@@@A@A@@@@BABA@@AA@A@A@@AA@A@AAAA@AA@@AAA@@A@@@CA@@@@@@@CAA@C@A@@@CD@BAB@@ABABA@@BA@@@C@A@@@A@@A@A@@@C@A@@@AAA@@@@@@@ABA@A@A@@@ABA@AAAA@@AAA@A@@AA@@@@@A@@AAA@@ACAAC@@@C@AB@B@@AB@BAB@@A@@@@@A@A@AAA@@@@BA@A@A@@A@A@AAAA@@AAA@A@@AA@AAAC@@A@AAAB@@A@ABA@@@AB@@CBCB@@AB@@@D@B@@A@@B@@AB@@@@@@@@@@@@@BA@@@@B@@A@@BAB@B@@A@@@AB@@ABA@@B@@@@A@A@AAA@A@@@CAAA@@@AAB@BAD@BA@@@AB@@A@@B@@@BAAA@A@@ACAA@A@AA@@A@@AA@@AAACA@AAAA@AA@@AAAA@A@@@AAA@@@A@@@@@@@A@@BA@@BAAAA@C@@@AAA@@@@A@@DA@@@@@AAAA@@A@@@CAC@@AAAAA@A@AA@@ABAB@@AAAAAAAA@@@@@A@@@AA@@@A@A@A@A@@@AAA@@AAAAC@@AA@@@@AA@@AAA@@@ABAAA@C@A@A@C@A@@@@A@A@CB@BA@@@@AAA@AA@A@AA@@@A@AAA@AA@CA@@@@@BCB@B@B@@@@A@@DC@@@A@AACBA@@AAA@@@AA@@@A@ABA@@D@D@@@B@BA@@B@B@@@@A@A@A@A@@@ABA@A@A@@@@@A@@@@BAB@BB@@BAB@@@@C@@B@B@B@@@B@@@@A@ABABA@@BBB@@@@@B@@A@A@C@@D@BABA@@@ABABA@A@@ACAA@CAA@@@A@@ACA@AA@ACC@@@ABA@A@A@A@A@C@A@A@@@@AC@@AA@AAC@AB@@@BA@@@@A@@C@@@AC@@A@@A@@@@AAA@@@AAC@@@A@A@AA@AA@A@@@AA@CAC@A@@BA@ABAB@@AB@B@@@BA@@@C@@@AA@@A@@@CD@@C@@@AA@AA@@AA@@@A@CBA@@@A@@@C@@@@@AA@@A@AA@@@@A@ABA@A@@@A@A@A@A@@@ABAAABA@A@A@A@A@@@A@AAA@@@AA@@A@@A@@AA@@@AB@@A@A@@@@C@C@@@@@@@B@@AA@A@@@@@A@@@@@@@@@A@@AACCAC@@A@@@@@C@@@A@@@ABA@@AA@@AAAACAAAA@@A@@AAAA@A@@AAA@AA@@AAA@@A"],encodeOffsets:[[108765,24655]]}},{type:"Feature",id:"451081",properties:{name:"靖西市",cp:[106.417805,23.134117],childNum:1},geometry:{type:"Polygon",coordinates:["@@GPEPIP@BCFQJKCKEUEIAODKH}hE@C@IEIICGAACM@YCGAAMGMGSBGCGGE@MDGDGC@@CMKKOASFC@G@IG@@IMO@_D_BECAMAIEQKCM@MDUPK@EAECKCKFOFKBWAEBGBSLWVAJFHNJDJ@@@HKNOPMPEJMNKNKNOTQT@@IFEBIBK@WBI@IBKDMHIBA@OAM@OCQAK@KGE@SLMNGFKAAAMGEGMAKAOBA@ODO@KDA@@BD@@B@@@BB@@@@B@@@B@@@D@B@B@BCB@@@@@@B@BB@@@@@@@B@@AB@@@@@BDF@@B@@BBBB@@@@@@BA@ABABA@@@@B@@A@@B@@B@@B@B@@@@C@ABAB@@E@@BA@@B@BEDA@A@@@A@@B@@A@AAA@A@A@A@A@A@@ACEA@A@A@A@A@ABA@CA@@@AAA@@@AA@AAAAC@EA@@A@A@ABA@@@@@AD@@@B@@@DAD@@ADAD@@A@A@@BA@CAAAA@@@@@@@ABABA@@BCBCBAB@@@@@BAB@@AB@A@BA@@BABAD@B@BABA@CB@@@B@BB@@@@B@B@B@@@BA@A@AB@B@B@@@B@B@BAB@@BD@@@@AB@@C@@@A@@BA@@BA@@@@BA@A@@B@BCB@B@BBB@@@@BB@@B@B@DAD@@BB@@BBBDBDBBBB@@@B@B@BA@B@@BB@@@@@@@BC@A@A@CBA@@BB@@BB@@@BBB@B@@D@B@B@@@B@B@@@BB@@B@B@@@@@B@B@B@@BB@BB@BBBBB@B@@DAB@BBB@B@@A@@@ABAA@BA@@@@@ABA@@@AB@@A@@@@@A@@@ABA@@@BB@B@@@@@B@B@B@B@@@@@BAB@@@B@@@@BB@D@B@BABA@@BA@@@@BAB@@@@B@@BB@@@@B@B@@@@BB@@@@@BBB@B@B@@@D@@BB@B@@AD@B@@B@@BB@@@BB@@BB@@@@B@B@B@B@@@BBD@BD@@B@A@@B@D@@BB@@@@@B@@@B@BBB@B@@@B@B@@@@@B@@@B@@@@@BBBBBA@AB@B@@@B@@AB@@@B@B@BA@CBABA@@@AD@@@B@B@@ABA@@@@@@B@BBBA@@B@@@B@DAB@BBB@B@@AB@@@B@@A@@B@@@@B@@BBBBBB@@B@B@B@@BB@@@@A@@@A@@@ABC@@@@@@@@@DBBBD@@B@B@@@BB@@@@B@@@B@B@@B@@@DA@@B@BA@@@@@B@B@@B@@BB@@@@B@BA@@@@@@B@@@@@BA@@B@@A@@B@@@B@@CBA@@@A@@A@@EA@@A@ABABABC@ABC@A@AA@@ABA@@@AB@A@A@@AB@@A@@BB@B@@@@@@B@@B@@@BB@@@@@D@@A@@@@B@@@@@@B@@BA@@B@@@BA@@BA@@BB@@@BB@@@@@B@@@@B@@@AB@@@@@@@@@BA@@@@@BB@@@@@@@@ABA@@@ADABB@@@@BBB@@B@@@BBBB@@@B@@A@AB@@AB@@A@@@A@A@AB@B@@@B@BB@B@BB@@A@@@A@C@@@@BA@@BB@BB@@@@BB@@BB@@BBBB@@@BB@B@B@DA@@B@D@BB@AB@@@BGBBB@BAB@B@@@BBB@@@B@@@B@BAB@DABDB@@@@B@BABBBB@@DBB@D@@A@AB@BA@@@AB@@B@@@B@BB@@B@@@D@@BB@B@B@@@B@@ABA@@B@@@@@BB@B@B@@@B@@@B@B@@A@A@@B@@@@BB@@B@@@@@BBB@B@B@B@B@B@BA@@BABA@@BB@BBBABBB@@@B@@BB@BD@@@B@@A@@BA@@@A@@D@BBB@B@BAB@@@@@B@@@@@BB@@ABAB@@A@ABC@@@A@A@A@AB@@A@A@@@A@@B@@BB@BA@@@ABA@A@A@@@@BA@@@BD@B@@@B@@@BBBB@@B@@B@@@B@@BB@DAB@D@@@BAB@@@B@B@BA@@D@@@@BBB@@@@B@@@BA@@B@@@D@@@BABA@@B@@@D@B@FB@@BB@@B@DB@@AB@B@@@BB@@@@BBB@DBB@B@@@B@@@@B@@@B@B@@@B@@@BBBDB@@B@B@@@@BB@@A@@B@DA@@@BB@@DB@@BB@@A@@BABC@AB@B@@BB@@BAB@@B@@CB@B@@B@B@@@B@@@BA@@@@B@BB@@@@@BA@@@@BB@BB@@D@@BBB@B@@@D@@@@B@B@@AB@@@D@BA@BB@D@@@BB@B@@@B@B@@DBB@@BBA@@B@@@B@B@@AB@B@BB@BB@@@@@BB@BB@@BDB@@B@@BBB@@B@@@@@@B@BABB@@BB@BBBBD@@@BAB@B@@@B@@@D@BB@@BB@@@BAB@B@B@@BBB@@BB@B@@BB@@@B@@@@B@@B@B@@B@BABB@@BBB@@BF@B@@@B@@AB@@@@@@@B@BB@@BBB@@@@@@@B@@A@C@CB@@@@@BBB@@A@@D@@A@@B@@@B@B@B@@@B@B@@@BBB@@AB@@A@@B@@A@@BB@@B@@@@AB@B@BB@ABBBBB@@BBB@@B@@@F@D@@@BB@AB@@@B@@@@BBAB@B@@B@AB@@@@@B@B@@@B@@@@C@A@@A@@A@@B@B@@ABAAABA@C@@@@@@B@B@BA@@BB@B@BBBDB@@B@B@B@@A@@B@@BBBBB@BB@@D@@@@BB@B@A@@@CB@@A@AB@@A@@@AB@@@@@@ABABCA@@A@@BA@@@@@DD@@@@@BA@@@A@@@@BAAAB@@@B@BBB@@@BA@@BBB@@B@B@@@@A@BB@A@@B@@BB@@@DB@B@BD@@AD@@B@@BB@@@@BB@@@B@@@@BDBB@@@BB@@B@@ABB@@@@BBBB@@BB@B@@@BB@@@@B@B@B@@@BBB@@AB@@@@BB@@BBB@@@@B@@BB@@B@@@@BAB@@A@ABBDBD@@@BA@@@@BB@@B@@BBB@B@@A@BB@BBBB@@@@@BAB@@@BA@A@@@@@AA@@@@AA@@@@CAABA@AB@@@@AA@A@@A@@AA@@@ABAD@@@@@B@B@B@@@@@BABA@@@AB@@C@@@@@@BAD@BA@@DA@ADA@@@@BABADA@AD@@C@@@@BBB@@@@BBDB@@CHC@IFHNDJFLFFNHN@NLBBDJFNHPHJJDPFHDDBDFAHB@BB@ABAB@BAB@@@@AB@BAB@B@BABAB@@A@@BA@@B@@A@AB@BA@@B@B@B@BADAB@@AB@@AB@@AFC@@@AB@@AA@@A@@@A@@BA@@BAB@B@@@@AB@BABCBA@@@AB@DA@@@@@A@@B@BA@A@A@@D@@@D@D@B@B@@@B@@@BAB@@@B@@AB@BC@ABA@@B@@A@A@@A@@AA@A@A@@AA@@@A@@@A@@A@CAABA@@A@@@AAA@@@@ABA@@A@@@A@@@AA@AA@@AAA@@AA@@@@AA@@A@@A@AAA@CAA@@@A@@A@A@A@@@@ABA@@@A@@B@@@@C@A@AB@@A@A@ABA@A@A@@AAA@A@A@AA@@AAA@A@@@A@AB@AA@@B@@A@AA@BC@@@@@A@A@@@ABAAAB@@CB@@CB@@A@@@@@@CBA@@@A@@@A@@C@@@A@@A@A@@D@@ABA@@@@@AB@@A@A@@@@B@BBB@@@BA@@BA@@B@@@@A@@@AA@A@A@@@A@@AA@@@@AA@BAA@@@@AA@A@@A@@C@@@AA@A@A@@BA@@@AA@@A@@@A@@@A@@D@B@B@@@@A@@@AB@@@@@D@@@B@BA@@B@@A@@AA@@BAB@@@@AB@@A@@@@AA@@AA@AA@@@@A@@B@@A@AB@@@B@@@@@BADAB@@A@@B@@AB@BA@A@A@@BA@AB@BABBDA@@@A@@@A@@BA@@BA@@B@@@@AB@@@DA@@@AB@BAB@B@@@B@BB@B@@B@@@B@@AB@BAB@@AAA@@BABA@@B@@@B@@AB@DA@@BA@@@@@A@@BA@@B@B@@@@@@AB@@@@@B@@@B@DA@@BA@@@@B@@@B@B@@@@B@BB@@@BAB@B@@ADCB@@@BAB@@@@@BBB@@@@@@@BB@@@B@@BBBA@@@ABC@@D@D@@@@@BA@@B@@@B@@@B@@A@@A@@A@@AA@@@@@A@@B@@@B@B@@@@A@BB@BBB@@@B@@@@ADA@@BA@@B@@@B@BA@@@BB@BD@@B@BABBB@B@B@BA@AA@BA@@DA@@@ABAB@@@AC@AD@B@@@@A@@AA@@@A@@@@BA@@@@@@@@AA@@A@@@AB@@@@@CAA@@@@@A@C@@@A@@@ABA@@@AB@B@@AB@@@@A@A@@BAD@@ABAB@BAB@BB@@@@@@DB@@@@B@@AB@B@@@BBBF@B@B@@BB@@@@B@@@@BBB@@B@@BBAB@@@@BB@B@B@@AB@@@B@@BB@B@@@@@B@@@B@@BBB@@@@BBB@@AB@BBB@@@@@BB@@B@@A@A@@@AB@@@@AA@@@@AAA@A@@B@B@DA@@@@@A@A@AB@B@DBD@@@@@@@AA@A@A@@BA@A@A@@BA@@B@@@@@B@@B@@D@@@BA@A@ABAB@BAB@@@@@BABA@@@@@A@@A@A@C@@@A@@A@@B@@@BA@@@@@@B@B@@AAA@@@@BAB@BA@@BB@@BB@@B@@@@AB@@@B@@A@@@A@A@A@@@@BA@@BB@@@@@BB@F@BBB@BBDBB@@@BA@@B@BB@@@@BBB@@@AB@@DB@@@BB@@@BAB@BA@BB@BB@@B@B@@B@@@@DB@@@B@@@BAB@@@BD@BBBBB@@B@@@@B@@D@@@BBB@@B@@@@@B@BBBB@@BBB@@@BB@@@@@@B@@@@BB@@B@@BA@@B@@AB@@@@A@A@@AA@A@@B@BA@@@A@A@@A@@@@@AA@AB@@A@@@A@@AA@A@@@@@C@@@@B@@A@@B@@A@@B@@AB@@@@@@B@BDB@@BBB@@B@BB@@@@BBD@@B@B@@@B@B@@@B@@@@@@CBA@@B@BA@@@@B@BAB@BAB@B@@A@@B@@AA@B@B@@@@AAA@A@A@@BA@@@@@AA@BA@@@AB@BB@@@A@@@C@@B@@@B@@A@A@@@@@AD@@@B@@@@A@A@@@@B@B@@@@A@@BA@@@@@@BAB@@@BA@@@A@@@@B@@A@A@@@@B@B@@@@@@@B@@@BA@@@@B@@@@@@A@@@@BABAB@@@B@@B@@BBD@@@BB@@@ABAB@AAB@@A@@BA@AB@DAB@@A@@@A@@@@@AA@@@B@@A@@@@@A@@AA@A@@@@@A@A@@@AB@@A@@@A@@BA@@@A@@BA@@A@@A@@@A@@@A@@AA@@@@BAAABAAAAA@@BA@@@AA@@@@@AAAA@A@C@@@@@AA@@@A@ABCB@@@@@B@@B@@B@B@@@@AB@@A@A@@@@AA@@@@AAA@A@A@@AA@C@A@@B@B@@A@@AA@AA@@@AABA@AA@@@@@@A@ABAB@@A@@BA@@B@@@BABA@A@A@A@@AA@@@A@A@A@@AA@@@@@AA@@A@@@ABA@AB@@CBA@@@A@@@@BA@AB@@@@AB@@@DA@@@ABB@@B@BBB@BABABA@A@@@A@@AA@@A@@ABA@@@@BAB@@@@@B@@@B@BADBB@@@B@B@BBBB@@B@BB@@@B@@B@@@@@@A@@@AB@@@@@BA@@@AB@DB@A@A@AB@@@B@B@B@@@@A@@@ABCD@@@@@B@D@BB@@@ABAB@BA@@DB@@@B@@AB@B@B@@B@B@DBB@B@@BB@@@BAB@BAB@B@@@@B@BB@@@DB@AB@B@@AB@DA@@B@B@@@B@D@B@B@BABA@@@@B@B@B@BBB@DB@@@@B@@AB@@@DB@B@@AB@@@@@@@B@BBAD@B@@@@@B@BB@BB@B@@@B@@BBB@B@@B@@@@B@@@BA@@B@@@@@B@@B@@@@B@@BB@B@B@@B@@@@@B@@BA@@B@B@@AB@@B@@BB@@@BB@@@@AB@@@@BB@@@@AB@@@@BBBB@@B@@@BA@@B@@@B@B@@@BB@@B@B@@@@@BAB@@@B@BAB@B@@A@@@AB@@AB@@AB@@A@@B@@@@@@@@@@A@@A@@A@@@@BAB@@@@@BA@@@AB@B@@@@@@A@@DAB@@@BA@A@@@ABABA@@B@DD@@@@BAB@@@BAB@@CBC@A@@@A@A@@@A@@@@AA@@@A@@@A@@@@@A@A@@A@CCA@A@@C@A@A@A@@AABC@AB@@CB@@AB@@@BA@@BABAB@B@@@@@@AAC@@@@AA@A@AB@@@AA@@@A@@BA@C@@@A@@@A@@@@@AB@AA@A@A@A@C@@AA@@@@@A@@@@B@DCB@BADA@@@@@B@BB@@@@BBAB@@@@@D@BAB@BCDAB@@A@@@@@@@A@AA@@AB@BABA@@BA@A@AAA@@@@BABA@@D@@@@AA@@A@A@@BABA@ABAB@B@@@D@@@B@@AA@AA@A@@@AAAA@@@@A@@BAAA@@@@A@@A@@@@AA@@AA@A@@AA@@@@@@BA@AB@@@@A@@DABAB@@AB@@@B@@AB@B@B@D@@B@@BB@@@@@DB@BBDB@@@@@@B@B@B@@@@@B@B@B@@A@@BAAA@@BABA@ABA@@DAD@B@@@BBD@B@B@@BBAB@B@@@F@@@@AB@BAFB@@BAB@@@DBB@D@@@BADA@AB@@@@A@@@@B@B@@@BA@A@CB@@@@CB@@A@CBA@A@A@AB@@@B@B@@@B@B@B@@@BBB@@@@@B@BA@A@@@@AA@@BA@@@A@@A@A@AA@@A@CB@@AACA@A@AB@@A@C@@@AA@@@@AC@@@@AA@@@@AE@A@@@A@@@@@@A@A@@A@A@A@@@@A@@@AAA@@@@AAA@@@A@@@AAA@AAA@@@AAABA@AB@B@@@@@@A@@AAA@A@A@C@A@A@@@AA@@@@@ABC@A@ABA@A@A@ABAA@@CA@AA@B@@ABA@@@A@@A@C@@@A@BA@@BA@A@@AA@@AA@@B@@AB@@AB@@@B@BABA@@@AB@B@B@BA@ABABA@@@ABA@@@ABAA@@A@@@ABA@A@E@ABAFAB@@@BA@@B@B@@@F@@@@ABA@A@A@@@ACA@@@@A@@A@@@A@A@@@AAC@@AA@A@@A@@@@@@CBA@AB@B@@AB@@ABABAB@@@AA@@@A@@@A@ABA@@FA@AB@@A@@@@B@@@B@B@@@BBBB@@B@@@@AD@B@@@B@B@@AFAD@@@BA@@@@@AB@@@@@DABBBA@@@BB@@@@@B@@ABCBAAABCBC@@BABAAC@A@AAAAC@C@@DCDAAABABA@ABA@A@CBA@@@A@@BAB@@AB@@@@ABA@@@@@AA@BA@@@A@A@@AAB@AAB@B@BABB@@B@@BD@@@B@@@BAB@F@BBB@BBB@BBBBB@B@DB@@B@BBD@BB@@@@B@BA@@DC@@@@B@@ABA@AAA@CACAA@@@AB@BA@@@@@AA@AA@@A@BEA@@CAA@@@@@@@A@@D@BAB@@@B@@B@B@@BBB@@BB@@B@@@@@@B@BA@@DD@@@B@@BB@@BBBBFBD@B@B@FB@A@AB@@@@AB@@@@@B@BABBB@@B@B@B@BB@B@@BBD@@@BB@@B@@@BB@@@B@BBBB@BBB@@BBB@@@@B@BB@B@@@BB@@@BDB@D@@B@B@B@@BB@@BB@@@@@BB@@@@B@@@B@BBB@@BBB@BAB@@@@B@@B@@BB@@B@DB@@B@BAB@B@@@B@B@@@B@@@DB@AB@B@@@B@@@B@B@@BB@B@BBB@BB@BBB@@BBBA@@@@BA@@BBB@BD@@@@@@B@D@@@@@B@@BB@@@BA@@BB@@B@@@B@@B@B@@B@A@@B@BA@@@@@AB@@@@@D@@@B@@@B@BA@@B@@AB@@B@D@B@@B@BB@@@AB@@@B@@B@@B@@@@BB@@@B@@@@@@@@@@BB@@@@B@@@@@@@@B@@@BA@BB@@B@@BB@@B@@B@@@@@@A@@@@BA@@B@B@@@DB@@B@@@B@@@@@B@@@@@B@@A@A@@@@@@@C@@@AB@B@@@@@@AB@@AB@@@BB@@BAB@@AB@@A@@DA@@@A@@D@@@B@B@@A@@@@@A@AA@@@@A@@CC@@@@A@@A@@@@AA@@@@@AAA@@@A@AA@@@@AB@BABA@@@@A@@@CCAA@AA@@@A@@@@@@@A@CC@@AA@@A@@@AA@@A@@A@@@A@@CA@@@@@@ABAA@@BABA@@@@A@@A@@A@@A@@AA@@A@@@@@@@BC@@@A@A@ABA@A@@@AAC@@@ACB@@AA@@@A@@B@BA@@B@@A@@BA@@BBB@B@@AB@BBBA@@BAB@A@B@@A@@@@@@@@@CC@@@AA@@A@AA@@AA@@@@B@BCBABA@@AA@A@AA@A@A@CBAB@@A@@@@BA@AAA@AA@@A@@@@A@A@@AA@@A@@@A@@@@AA@@A@@A@@@AACCA@@@@AA@@@@AA@CA@A@@@@A@AA@@A@AA@@A@A@A@@AA@@@AA@@A@@AA@BAFEDA@AAA@@@@@@A@@@AA@@@AA@@A@@A@AA@@@@CAA@A@A@@AA@AA@@B@B@@A@@@@@AA@A@@@CEAAACAA@@@AA@AA@@AAA@A@@@@AB@@AA@@A@@AA@@@@@A@@@A@@@A@@@@AA@A@A@@A@@@A@@B@@AA@@@@AACA@AA@@BA@@@A@@@@BA@AB@BAA@B@@@@A@@BA@@@A@@@AAAAA@@@@A@@A@@@CA@@@@AA@@ABA@AAA@@ACAAA@@@A@A@@A@@A@@B@@@@C@@@@@A@@A@@AA@@@@A@A@AB@@@BA@A@@@AB@@AAA@@@CB@@A@A@@AAA@@@C@@@AAAA@@@AAB@@A@@@@@AA@@C@C@AA@A@@@@A@@@@A@@AA@@@@A@AAAA@AA@@@@@B@@AA@@@@A@@AA@@A@@@@@A@@@@@@@A@@@@@@@AA@@A@@@A@@A@@@@A@ABAAAA@B@@A@@B@@AA@@A@AA@AA@@@@@AA@@A@CCAA@@BA@@@ABA@A@@@@@@AA@A@@@@@A@@@AA@@@B@@@AC@@@A@@@@@AA@@BAAA@@@A@AAAAA@@@A@@A@@A@@@@A@@@A@A@@@@@AAAA@@A@A@@BA@@@@EAAADCB@@AAA@@AAAAB@AAB@@@DA@AB@@C@GBMEGOKEEGDEFEHEBGDEDCBEAA@@D@FBF@DDDEDMJE@CF@@"],encodeOffsets:[[109179,23448]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
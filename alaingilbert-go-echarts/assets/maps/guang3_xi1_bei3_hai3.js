@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("北海",{type:"FeatureCollection",features:[{type:"Feature",id:"450502",properties:{name:"海城区",cp:[109.117209,21.475004],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@GLAH@DBHDBDBNGFEDEBE@CCCEEE@ID"],["@@CACCA@CBEDIBCFGHGBEAECCGDGHG@A@E@@CC@@G@IFMN@N@RALCN@HHHFL^PXJTDHCNELQ@EBMCMGQ@AEGEQCC"],["@@EAEA@@A@C@C@GDKDCBA@CDIIA@A@@@ADA@@@A@@@A@@AA@AB@B@@@@@B@@CBA@@@@@@B@@@@EBABA@@AA@ABE@CBEB@@WJBBBFJLVVZNHDHBPDtLJBHBHBNHNHHFFHDDDHBDBFBJ@@@P@BAJRdD@B@@AB@@ABABB@@@@@@@@@B@@@@@@@@ABBDB@@@@@BABAB@DA@@DAJC@@@@@@@@B@@F@@@B@B@@@D@@@@DDBBNL@@BB@DBD@BBB@D@BBB@DBD@DBBHBBD@ABABA@@BA@@@@BC@AB_@W@CBCFOBE@ADIBC@A@CEi@CAAS]WcACAAGEKG@AA@GA@@@@A@A@@E@E@E@@BBBBBD@BBABAB@B@@@BA@@B@@ABA@C@A@@@AA@@@@@AA@@@AA@@AA@@@@@A@@@@@@@AB@@GCCA@@@AB@@AA@@A@ABA@@A@@@@@@A@AA@@@ABA@@@@@@@@@AB@@@@@@A@@@AA@@@A@@GTAHA@@D[GA@IAICUECAGAA@AAGA"]],encodeOffsets:[[[111832,21403]],[[111752,21522]],[[111727,21961]]]}},{type:"Feature",id:"450503",properties:{name:"银海区",cp:[109.139862,21.449308],childNum:1},geometry:{type:"Polygon",coordinates:["@@EDQLSNEDEBEBMFSDGBG@KAKEMBEFELCV@PBLBFXI@@FADAF@BAB@@BB@BAFA@@@@@A@@@@B@DA@@@A@@@@@ABAB@@BB@@@B@@@B@BC@@B@B@JJDCB@DALCHCD@D@B@@@FBFBHBBBB@HBDBVFJDJBB@\\H@CB@BGHS@@@B@@BB@@B@@@@@@@BA@@@@@@@@B@BA@@B@@B@B@@@@B@@@AB@B@BB@@BA@@B@@DBHD@@BA@@@@@@B@@@@@B@@BB@@B@@BB@@@@B@@B@@@B@DAB@BA@@@AB@@A@A@ABAB@AACAAAA@@@F@F@FB@B@@@@@HBB@@BLHHFBBBDXdT^BB@DFj@D@BADCJ@BAFEPAD@D@XA`@BAD@@@@AB@@ABAB@B@@FCFFBFBDAF@@JDD@@@H@B@BBDAB@@B@@@@B@@@@@BFBB@DD@B@F@HD@@@AB@DA@A@@@A@@BC@@@@DBBBDB@@DD@@FAB@B@DABCBA@@@@B@@E@@B@@CBCBABC@@@CBA@@@AB@BA@C@@@ABB@A@@B@BA@@CE@@F@B@D@B@@BBB@@BB@B@BAB@@B@J@B@@@@DBBBB@@@BADAFAD@BAB@@ADCDAB@@BB@B@@@BDBBB@@B@B@@B@@DD@@B@BD@@@@B@B@@@@@BB@BB@@BB@DBFH@@@@@D@@@B@@@BDLNTPH@@@B@@@@@@BB@B@B@BD@@@BB@@@B@B@@BAB@B@BBD@@@BBB@B@BB@B@BBBB@B@@BB@@@@B@D@FA@@@HBFB@A@@@@@ABB@A@@BB@@A@@BB@BB@A@AAADBFBB@DBD@BBB@BBDBDDBB@DAB@@DDDB@@DCBABCHE@@B@@@B@@BBFF@@@BCB@DABABABABABA@@BAAC@AAA@A@CBCBC@ABA@ABEBA@@@@@CBA@ABAB@@ABA@A@AD@@@@@@A@CA@A@ABA@@@@@@@@AA@@@@@@@@A@@@@@@B@A@@@@A@@@@@A@@@@@@A@@A@@@@@A@@@@@@@A@@A@@A@@@@@A@@@AA@@@@@@A@@@@@C@@@@@@@@@@@A@@@@@@@A@@@@@@@@B@@A@@@A@@@A@@BA@@A@@AB@@@@A@@@@@A@AB@@@@A@A@@B@@@@AA@@@@@DAB@BAF@BA@@@C@C@@AA@@@AB@@@@@@@DABA@ABBB@B@@@HB@A@@@EAACACAA@A@@@C@A@@@AA@@@EAAEI@AAE@A@@BC@ABAAA@@AA@A@A@@BABADE@AB@@@@@BCBA@@@@@@@A@@B@@@@@@@B@@@@@@A@@B@@@@A@@@AA@B@@@@@@@@@@@@A@@@@@@B@@A@@@@@@B@A@BA@@@@@@B@AA@AB@@@@@@@@@@ABB@AA@@@@A@@@@B@@@@@@A@@@A@@B@@A@A@@@A@@A@AAAB@@I@@@@C@@@@@AAAB@BADA@A@A@@@C@A@C@@B@BA@@@@ACD@@@B@@@B@@@B@B@@A@@A@@@@@@A@@@@B@@AAA@@@@@A@@@A@@AA@@BA@@@B@@@@@@BAA@@A@@@@@@@A@@@@@AA@@@@@@A@@@A@@@@BCA@@@@ABA@@@A@@@AA@@@@@@@@A@@@A@A@@@AA@BA@@BAB@@A@A@@@@@@@A@@@C@A@CBA@@@AA@@A@CAA@@BC@AAA@@C@@A@A@A@A@A@AA@AACAA@@@CBA@@@A@ABA@E@@@A@@D@BA@I@@@E@EBS@C@A@@A@@AAB@@@AA@@@A@@A@ABB@@BC@@@@C@@AEAAA@A@@A@@AAAAGB@AAA@B@AA@A@@@@@@A@@@@A@A@@@@@@D@B@@A@AB@@@@AAA@@@@@@@A@@@@@AACC@A@@@CBA@A@@AC@A@@@A@A@ABAB@BCBA@A@@@A@@@ABABABE@CAAACEGCE@@@A@[OAM@OC}KKAMBSAUACEEGSQcOCASGSGKCoIG@U@QF_VGF"],encodeOffsets:[[111739,21917]]}},{type:"Feature",id:"450512",properties:{name:"铁山港区",cp:[109.42158,21.529127],childNum:1},geometry:{type:"Polygon",coordinates:["@@_GMUEMCeKMAcCMAkAuAAE@@\\@B@@DFFHBDBB@DAFABAB@B@@@B@@@BABADA@AB@B@B@B@@@BBD@@@BAB@D@@@BDDBB@@@@B@@@@@@@B@BB@@@@BAB@@@@A@C@@@@B@B@@@@@@B@@@@B@B@@B@ABB@BHABBBB@@@BB@B@BBBF@@@D@@D@@AA@BAB@@@@B@@BB@@A@BB@@@BB@D@T@FAF@@@J@B@@A@CB@@@F@B@BAB@@@B@DA@@B@DBBBB@@B@B@B@B@B@BD@@@BB@BAD@@BB@D@BB@@B@@AB@D@B@D@@@B@@@@@@@B@BA@AB@@ABB@@B@@@B@B@@@B@@@@@@B@@B@@@B@@AB@B@@B@AD@@@@@B@@@B@@@@B@@B@@@@@B@@@@@@@BB@AB@@@@@@@A@@AB@@BB@@@B@@@B@@@@BB@BA@@@@@@B@@@@B@@@@BA@A@@@A@@@A@@@C@BD@@@@ABA@@@@D@B@D@@@B@BCBABA@BB@B@@@@@D@@J@@@BABBB@@@@B@@@B@BA@@@@B@@@B@@@@A@@@@@@B@@B@@BAA@B@@@@@@@@A@@BBBA@@@@@@@ABB@A@@@@@@@@BA@@@@@@@@B@@@@@@@@@@A@B@@B@@@B@@A@@@@B@@@@A@@@@@@@A@@@@B@@@@@@ABAD@@@@A@@BCFABAB@@@B@BBB@@BBAB@BAD@@@BBF@BFJBB@F@@BB@@B@D@@@B@B@DBDBBB@F@@@BGA@@A@A@AA@BABCB@@@@@@A@@B@@BB@@@D@D@@ABE@ABA@CB@@@@B@@B@@A@@@@B@B@@A@@B@B@@@@@B@@A@@BB@@@AB@@@B@@@B@@@BA@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@D@@@@@B@@@@B@@B@@@B@@@@@BB@@@@B@@@@@@@B@@@@@BB@@@@@@@@B@@@@@B@@B@A@@@@@@@@B@@@@@@B@@B@@@@@@B@BAB@B@@D@B@@@B@@B@@@B@AB@B@AFDHL@@B@@@@@B@@@@@B@BA@BB@BAB@@A@@@CAE@@@AB@DA@@BABA@ABA@A@@@@B@B@D@@@BDAB@@@D@@B@@@BA@B@@@@AB@BA@@BADA@A@@@A@A@@@AB@@@@A@@@@B@BABA@AB@@@@@B@@B@@@BF@B@BBB@BH@@@B@@@B@B@@@@@@@BBBBD@BBBBBB@@B@DCBABCDCB@DCB@@BBB@BD@BBBBB@@ABB@@@@B@@BB@BBBBBBBBBBB@DB@@DC@@BA@AD@B@BBBABBD@D@BAB@B@BBBAD@F@D@BA@EBCBAF@@DADABBBADHGDABCB@FGHEF@B@F@F@FE@@BA@AB@BAB@BAD@EDA@@DA@AB@B@@A@AB@BA@@BCDA@@@A@ABA@ABA@A@A@A@A@A@ADEBABADGBABABABAB@BABABCDApC]@CLOAOHELICIIYOeC@CBUCEAKECI@KAWCW@CAoSaOEcOSC"],encodeOffsets:[[112161,21980]]}},{type:"Feature",id:"450521",properties:{name:"合浦县",cp:[109.207335,21.660935],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@HBJDDHD@@@@BGCGAEAEEA@@@@AD"],["@@CFGTGXKTEJEFMLUPMBPfJZDJKJGFBPKP@DD^oCBADABABA@ABABABABCHABABCF@B@B@B@B@B@BAB@BAB@B@B@@CBAD@@ABA@@B@BA@A@@BCB@@CBFC@ABA@ABA@@BAB@@EFE@E@A@E@GFEHA@ADCBGHBCAABABC@CE@ABAD@FABC@E@C@ABAAA@A@ABC@C@AAABAAA@C@@BAB@@CD@@CAA@AAAAAAAAAAA@@AA@@@@@AA@BA@AAAAC@@AAA@AA@CDA@CDADABCDA@@@AAAAAAC@AAAA@@@@@@A@A@@@A@@@G@@AAA@A@AAE@@A@@@@A@@@@BAB@BA@A@A@@B@@@@@BA@@B@B@@@B@B@BC@AB@@ABA@@@@@AAB@@A@@@@C@@BAAC@@C@A@A@@@@@@BAB@BABAB@@CBA@@B@@BF@D@@@BA@ABA@@AABA@@@@@A@@@@@A@@@GKEC@B@ABAA@@@A@@@@A@@C@@B@BAB@BA@AB@BAB@D@@@@ABAF@BAB@BADAD@D@BBB@BBDAB@@ABABABABABCBA@AD@@E@AE@AA@@@A@@@GFADABCD@@CACC@@BA@CAACCCAAAA@AAC@CAA@EACABB@B@BAAA@@AB@@@AA@@@BAA@B@@@@@BEAGA@@B@@E@C@A@@A@@AA@A@AA@A@AAAA@A@AA@@C@AAA@A@AB@@@A@A@@AA@@C@@A@A@AAA@@@@@@@A@@OGMSCK@A@@@A@@@C@@@@EGCAA@@AA@@AAA@@@@A@A@@@@@ACA@@@CC@@@AA@A@@@AACA@A@@@AAA@@BADCBC@@BA@ABCBEBC@A@@AAAA@C@@A@I@A@@@BA@A@AAA@@AA@AA@C@A@E@@@DF@@ABA@@@@BAA@B@@@DABA@@B@@AB@D@@ADABAD@DA@@@@FA@@@@@ABADCBA@A@EB@@CC@@CAAACA@@@@AD@@@B@@@BCBA@@B@@GCE@A@C@@CAAAE@@@@A@@@@@@AA@CBAAA@G@@@C@IC@@BEACAEEEED@@ACGAAA@CAC@CAA@A@CAA@AAC@CAA@@MKAACC@@@@@C@@@A@A@@@EA@@@@@@@@@IDCB@@CBA@ABAB@@@@A@ACBA@@@@@@@@@A@@@@@@@@AAAB@BA@@BA@C@QcAJADAFAF@BGJGFMFQDIAGCIAIDKDEDCH@DC@[DcDkBcDM@oDYAY@yDOF]HEDULGHCDILKLCFCFKE@@AHCNCJGLCDKR@B@BKDCDAD@@@B@@A@AHALAFGJBDBFBDBFAD@BADBFDFBBBHBHABD@BB@BAB@DAFBJBBFLDFDD@D@BBBB@@@FD@@JBHBDADBB@DAB@@AA@@@@@HEBAHEB@DDHDNHDBFFDFD@FBFAD@DBD@NJFBH@DF@BBB@@B@FCBAB@B@@B@DAD@B@@DA@D@D@DBFJD@D@BCB@@BBFBDBB@@CBBBA@DB@AB@BABD@@BBDBA@ABB@@@BB@ABB@B@@FB@@B@B@@@@CB@@ABA@ABD@D@D@@FA@@@A@@@AB@@@BB@@BC@@@@BD@@@@B@@FD@BCB@BBBB@@@ABCBAAA@ABAAAAA@@AAAEFB@BD@B@@A@@BDBABAB@@@B@BAB@@@B@BBBB@BAB@B@B@DDBBBBB@B@@BB@B@@@B@@@BAB@@BAB@@AB@@@@@@@B@BD@BADBBA@ECC@AA@@@AB@AA@@@AAAAC@@ABCBABEBABCFBAD@BABBBF@@@@D@FDBBB@FA@@@ABB@B@D@@@D@BBA@A@BB@@B@ABD@BDBA@CB@B@@BF@@@@BB@DD@A@AB@B@@B@BB@@BBBB@B@B@B@D@B@@@@BD@B@@ABABBBAB@B@B@D@B@BEBAB@D@D@@ABBBDAB@@BB@@@@BA@@B@@B@@@B@@@B@BBB@B@BFD@B@@@@@B@@B@@@@BA@BBDBHEBAD@B@D@DAF@F@DBD@BABAD@BBBBBCBABBBD@@@B@@@@@@D@@@@BB@@@B@DBDDBBAB@B@@@@BB@ABDB@ACD@D@B@BABA@@B@@@@AB@B@DBADCF@B@B@@B@@B@@@B@@DBB@@@BB@B@@@@@@BBB@@@B@@@@@BDB@BB@@@@@B@@@@A@@@@B@@@@@B@@B@@@BB@@@@@BAB@B@@DH@@@@B@@@B@@@@@B@@@B@@@B@@@B@@@@B@@B@@@@AB@@@@@B@@@B@@@@C@@@ABA@@B@@AAA@C@@@A@@@@@@@@BBB@@@DA@@B@@@@@@A@@@@@A@@@@BAB@D@B@@@@@@@@A@@@@BAB@@@B@BB@@@@B@@@@@@A@A@A@A@@B@B@@@@@@AB@BE@@BA@A@@B@BC@ADC@@B@D@@@@@@@@B@@@@B@B@@@@C@@B@B@B@B@@B@@@D@@ABBB@@@@B@B@B@@@B@@@B@@B@@B@BBBB@@FB@@@@DBHMBCBAD@HND@BA@A@A@AB@B@B@@@@@B@BAB@B@@AAAB@@AB@@BB@@A@AB@@@@@BAB@@A@@AABA@AB@@@B@B@BAB@BB@@BADA@@@@@@B@@@@@BBA@@@BBBB@@@@DABB@BB@@@B@@ABB@BAD@B@BB@B@@@B@@@@@@B@@@BBABB@BHAAD@BB@@@B@FABA@@@@@A@@B@@@B@@@@B@@B@@@B@@B@@BBB@@B@B@@B@@AB@@@@@@A@ABAB@@@@B@@@@DA@B@DB@@AB@AA@AB@@A@@A@A@@@AA@A@@@A@@BA@@FB@@@B@BB@BB@BD@@@B@@@@@@@@BBB@BB@BD@@A@@B@B@@AB@@@@A@AAA@AA@@A@A@ABABBB@BD@@@@AB@BA@@BBBBB@@@BBB@B@@@BA@AAA@AB@@@BAB@@@BBADB@@B@AB@B@@AB@@@B@@DC@@D@@@BA@DB@A@@B@@ABB@@ABB@BB@@B@@B@@@@BA@@@@@A@AA@A@@A@@@@@AB@@A@@@AB@D@@@BABB@B@@@BB@DA@@DBBBBBB@@BBABB@@@@@ABAD@B@@B@@@@@@ABAB@BB@@D@@@B@@ABBB@B@@@B@@@B@@AB@@A@C@@@@B@B@@@B@@BBBF@@A@@BA@@B@D@@@B@B@@@BB@BB@ADC@@B@DA@CB@@@B@BB@@@B@@@B@@A@@DBBD@@ADABABBB@BAB@BB@@@@B@@@@@@@AB@@@B@@@@AB@@@BCB@B@D@@@@CFIDA@@@CB@J@@@@BFLDPB@@B@@@LAJF@@DA@AHKB@A@@@BABGHB@@@@D@@@@@F@LC@@@B@A@@V@@@BA@A@AB@BB@@B@B@@B@@@BB@@@B@B@@B@@@BBB@B@@@B@@B@B@@@B@BBB@D@@@BA@@@ABAB@@@@A@@B@@@B@BB@@B@@@@A@@@A@A@@@@BABA@@@@BAB@@A@@@@B@B@BABABA@@D@@@B@D@@A@@@AAA@@@@@ADAB@B@ABB@@@@BBBABB@@B@BH@FBHAHBHCHCCEAA@AB@@BJ@@A@@BB@B@@@@AB@@@B@B@@@@@B@B@@@D@@DA@@@@@B@B@@@@BB@@A@@@AB@@BDA@@B@@@BB@@@A@@BABB@@B@B@@BB@@@@BBB@B@@@@@B@BABA@@B@BB@@@@@@B@@@BA@@BA@@@@B@@@@BB@BA@@BA@@B@@@BBBB@A@@BA@@@@@AB@@B@@B@@@B@@@B@@CAB@@@A@@B@@ABB@@B@@@@@@@@@@@@BB@BB@@@@BB@@@B@@@@@BAB@@BB@@B@BAB@@@@BB@B@B@@@@B@B@@@B@@@BBDBB@@@@BC@@BBBBB@B@@@B@@@B@B@B@@CD@@BB@@@@@@AB@B@@A@@BADA@@@A@@@A@@A@@C@A@@A@@A@A@@@ADAB@A@@@A@@@@@@A@@@@@ABAB@AAAABA@@BA@@AA@@BADABA@@FBB@@AAA@@@@JENGPDFJ@HBFBD@@@@FBB@B@B@HEDGAEBCBEBEHGDAB@@@FAD@F@B@B@BENCLGDFFADHFLBD@FCDB@B@@@@@B@B@@@@AB@@BB@@BB@@BB@@B@BA@@BBABDA@@BB@@B@@B@BB@@AD@@B@@@@B@@@@BB@@BB@ABA@A@@@@@AB@B@B@B@@@BA@@@A@@B@@@BB@AB@@@AC@@B@@@@@BBB@@@BB@@@@@B@@B@B@@@DA@@@AB@@AB@@AB@@@BBB@@@FBBB@@@ABABA@BB@B@BBB@B@BBB@B@B@BB@@@@@BBB@B@@B@@@B@@B@@@@@@@B@@B@B@@@B@@B@B@@@BB@B@@BB@@B@@A@@B@@@@ABC@CA@@@@@@@DBBA@@@BBHAFAJDDB@@BCD@D@@@@C@@@AR@@@BA@A@@BC@@BAB@@@B@FA@@@ABABABA@ABA@@B@@A@@@@J@@L@@@@BB@@BBB@@BFB@@@@B@@BB@BBB@B@@@@@BC@@B@@AB@B@B@B@F@BA@@B@@@B@BBB@@@HB@KDA@@BA@AB@@@BA@ABCD@@BB@@BB@@BD@B@JD@@B@@@BA@ABAD@D@FBBBBABBDDDBF@FDFB@@@AB@@@@A@E@@@AB@@AB@@BB@B@@AB@B@@AB@@ABAAAAA@E@C@IAEFICICA@A@ABAAA@@A@@AIEDAJMBCBAB@@AB@@A@@BA@@@@@A@A@A@A@A@@A@@A@@BA@@BA@@AA@AAA@@@A@@AA@A@@AA@@@@C@@AA@@A@A@A@@@A@A@@@@B@BAAABA@A@@AA@@@@@@C@@@@CCAA@@@@A@AB@@A@A@A@@@A@A@C@@@A@@A@BA@A@@AA@@BA@@BABBBABB@@BAB@@@BB@@BA@@BABA@@@@AA@A@@@AA@IQ@@@A@@@@AA@A@A@@BA@C@@C@A@@@@@@@A@A@A@@A@@@AAAAAACA@AA@@@@@A@@AA@@A@B@BA@@AA@A@ABA@@B@@@@A@A@@@@@@A@A@@@@AAA@@BBBMJKCS@AA@AI@C@@AABAAA@AAA@ABA@@B@@@D@@@B@AAB@B@@@B@@@@@@@AA@@@A@A@@@A@A@A@ABA@@@ABA@A@A@A@A@AAA@@AAACBA@A@E@@@A@AAA@@AA@A@AA@AA@@AA@AA@@AA@@AAC@C@ABEBABCDEBCBA@AAC@A@ABA@A@ABADC@AB@BALE@@BC@@@@@@BBAB@@B@@B@@A@@D@@@AB@@@B@@@B@@@@BBB@@@@BAD@@@B@@@@BBB@@@B@DB@@@@@@@@B@@B@@@B@D@B@D@BA@A@@@A@@B@B@@@B@B@@A@A@@HC@@@@BA@@@A@CFCDD@BBBB@@@@@B@@@DABA@@@@B@AABA@@@A@A@AH@HLBFHB@B@@@@@B@@@@@@@B@@AHDBBB@C@ADCBA@@@@BA@@BBA@@@@@@@B@@@@A@@B@@BB@@A@@@A@@AAB@B@@A@@AE@A@A@A@@ACAA@AAC@AAAACA@ACA@AA@ABA@A@AAA@AAAAA@AACAAA@@A@ABE@AAAA@@A@@CAA@AAA@@A@@@@AA@A@A@ABA@AA@@@C@@@@CBC@ADCB@@A@A@@@AA@@AAAACACBAFA@ABAA@A@@@B@B@@A@AAA@AAA@@@AB@@A@@B@@A@@B@@@B@BEB@BC@ABA@AAA@AAAA@BAB@BABCBAAA@AA@AA@A@GBCBA@A@CAE@ABCDCDCHMNQFE@C@C@AAC@IAGBA@CDKDEFEFEDAHKBA@E@C@G@KCG@CAC@C@E@A@C@GEBM@YCEAS@EBO@cFA@aHIDcPeXQTEP"]],encodeOffsets:[[[112202,21970]],[[112284,22018]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("崇左",{type:"FeatureCollection",features:[{type:"Feature",id:"451402",properties:{name:"江州区",cp:[107.353437,22.405325],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AB@@A@ABCBAAAACBA@@FAB@@BBAB@BA@ADA@C@@BA@@@AA@@A@ACAACA@ACAACAA@@AAEAA@C@@@@BAB@@A@ABAA@@AA@A@@@@AA@@A@@@A@A@A@A@@@A@A@A@@BA@A@AAA@@@@@A@A@AAA@@@AC@@AA@AA@A@@@ABA@CBC@A@C@ABA@A@@B@BBD@B@D@B@B@B@BB@BD@@@BCBABBBA@@F@@@@@@BB@@@@@B@@BB@@@@@B@B@@A@@@@@@BA@@@@BA@@BA@A@@@A@@@@B@BABADA@@@CBAB@@@@A@A@@@@@ABABCBAB@@@BA@@BA@@@@@A@BA@ABABAB@@@@AA@@ABAG@AB@BABA@@@@@A@@BA@@B@@A@A@A@@B@@@@A@@@A@@@ABCBAB@@@@@D@@AB@@AAA@AA@@@AA@@@@A@@@AA@A@@@C@A@A@ABA@AAA@AB@BCBCF@@BBAB@@A@@BA@@@A@AE@AAAC@GBKAIAM@G@CHBB@BBDCDBB@B@B@@B@@B@@B@BB@@@@ADB@@BB@@@B@BA@@B@@B@@@@@B@B@@B@@@@BBB@DABDFABAB@BAB@BA@BBADB@AB@B@@A@@@@@BB@B@BA@@@@@@B@@@@@@A@@@@@AB@@BB@@@@@@B@@B@@A@@@@@@@@@AB@@BB@B@@@BB@@B@@B@@@@BBB@BB@BADA@@B@@A@@B@B@DBD@B@@@@@@B@@@BA@@BB@@@B@BB@BB@BBB@@@@B@BBB@@@@AB@DA@@@AB@@A@@@AB@@A@@@BB@@@BA@@@@B@B@@B@@@AB@B@@@B@D@BAB@@@B@@B@@@@B@@@BB@@BB@B@D@B@@@@B@B@@@@BBB@@@@BB@BA@@@BB@BB@BA@@@@BB@@BBB@@@BB@@@@@@BB@BA@@@@@@@AB@@@@@@@@@B@@@@@@@@@B@@B@@BB@BB@@BB@B@B@@@@BA@@B@@@BB@@@BB@@@@@B@@@B@@BB@@@@@B@@A@@@AA@@@B@@@@@B@@@@@B@@@B@B@@B@B@@@@@@B@B@@@B@@B@B@@@@B@@ABCD@BAB@@@B@@@B@BAB@@@@B@B@@ABB@@@BB@@@B@B@@B@@@@@@@BAA@B@@A@A@AB@@@@@@A@@@@@A@@@@D@B@@@@@@A@@@A@@@@@@@@B@@B@@@@@A@@@A@A@A@@B@BA@@@@@A@@@@@@@@B@@@@@B@@AAA@@C@@@@A@A@A@ADA@@B@@A@A@C@AA@@AAA@EACCBCAAGCCACAICCAA@@B@@CBGF@@B@@B@BAB@BAD@D@D@BADADADABADIDEBEBE@A@C@G@AAA@@@CCA@@A@@ACAA@AAC@@AAA@A@ABA@ADAB@D@B@B@H@B@BA@@@C@A@ACA@CA@@C@E@@BGD@@A@@@@A@@DEBA@A@@B@@@BABA@ABA@A@@A@A@A@@AA@AACAAA@@AA@A@@@@AA@A@A@@A@@A@@BA@AB@@@BA@@BA@ABA@@@A@AAAA@@@@@@AA@@A@@A@@@A@@AA@@@@@@@A@@@A@@@A@A@A@@@A@@@AB@@@@A@@BA@AD@B@B@B@D@B@BAB@@@BAB@@@B@@B@BB@@@B@@BB@@B@@BB@BBA@@BAB@B@DAD@P@D@B@BBD@BBDDDBBDF@@BBAB@BABCD@B@B@D@BBD@@BD@B@@@@@B@@C@A@@@@B@@@B@B@@@BB@FBDBDBBB@@BB@@@B@@BB@@DBB@D@DA@@D@B@@@@@BB@DB@BBBB@@@BBB@@@B@BBB@BBBD@B@B@B@B@D@@BB@@BBBBBB@BBBBBB@BB@AB@F@BBB@BAB@BCD@B@B@B@@BBHDBBB@@DBD@B@@@BA@@BA@A@GAC@EAAB@@A@@DBB@BDFBH@B@D@B@@E@A@AAA@@A@@AA@@AA@@A@@@@@@@AB@AA@A@AB@@@@@@A@AB@@@AAB@AAB@@A@@A@@ABA@@@AAA@@@A@@B@@AAA@E@@@AA@@A@@@@@@B@@A@@B@@@@AB@@@B@@A@@@AA@AA@C@@@A@@BA@@@@B@B@@@B@@A@A@A@@@AAA@@@@@@AAABA@@@@@CA@@@A@@@CA@@BE@@A@AA@A@@C@@@ABA@AAA@A@@@@BA@AB@@@BA@@@@B@B@@@@@B@@@@A@@BAB@@AB@@@@@B@BA@@B@B@@A@AB@BB@@B@@A@@@@BAAAB@@A@@@@@@@ABAB@BABAB@B@@@BB@@@BB@@AB@@A@A@@@@BA@AB@@AB@@AB@@BBABBBBB@@AB@@B@@B@@AB@B@B@@ABA@@@@B@@BB@D@@@@@@@@@@@BBB@B@@BB@@B@BB@@@@B@@@@BBBBF@@BB@B@B@B@BABBBC@@BA@A@A@AAA@@@A@@@@DA@@@@BAB@@A@@BB@BB@B@@@B@B@B@B@B@@@B@@BBB@@B@B@BAD@BA@BBAB@@AB@@CD@@CB@@@B@BA@@BA@@BABBBAB@B@@@@AB@@ABAB@@@B@B@@@B@@A@AB@@@@@@AA@@A@@B@@BB@@@@@B@@A@@@C@EBA@@@@AA@@@@A@@@@@@A@@@C@A@A@@@A@@@AB@BAB@D@B@B@@B@B@@@B@@@B@BA@AB@@@@@B@B@B@@@@@B@B@@@B@DA@BB@@@B@B@@@@BB@BBDB@@@@BA@@B@B@BB@@DBBB@@@BBB@@@@@@BB@@@D@@@@BBB@B@@BA@@D@@B@@B@@A@@@@@@@@@@B@B@@@@@@B@ABAB@B@@DB@B@@@D@@@@@BA@@@AB@@@B@BBB@@B@@BB@@@BBB@@@BA@@@BBB@@BAB@@@@B@@@@@B@BB@B@B@@@@B@@BB@B@@@@@B@@@BB@@@B@@BB@@BB@@@@BB@@@@@@AB@@@@@@A@AB@@@@@B@BB@@@@FB@BB@B@@@@@@B@@@BB@@@B@@@@@B@BB@@BB@B@@B@D@DAB@B@BBB@B@@@@@@@@@B@@B@@@DB@@@@@@@DBDD@@AB@@@DBB@@@B@@DDB@@@D@@@B@@@B@D@BB@@DAD@@ABB@@@@@BB@B@@@D@@@AC@ABABA@ABB@@B@B@@B@@@@@@@B@@@@B@@@A@@BB@@@@@B@@B@@A@B@@BB@B@@@BABB@@B@DFBBB@@@B@@BBBAB@@AB@@@BA@A@@@@B@B@B@@@BA@A@@@@BAB@B@@B@@B@@B@@BB@@@B@@@@B@@@@B@@@B@B@@DB@@B@@@BA@@@BB@BB@@@@B@D@B@@B@@@@B@B@B@D@@@B@@@D@B@BA@@B@@@DAB@@@DB@DA@@B@B@@@B@D@@@@BB@BB@@@B@@DBDAB@B@@ABAAA@@@AA@@@@A@C@@BAB@@ABABA@@@A@@AC@@@@BABAB@@@B@@@BB@B@@BBB@@@B@@@@BBB@@B@@@B@@@@BB@@B@@B@FBB@@@@@@DB@@B@@B@@BB@@@BAB@B@B@B@@@B@@@BBBBBBBAB@B@@@@@B@B@@BB@@@@@B@@ABBB@B@@@B@B@@@@B@@B@@BBBDBB@BB@@BAB@D@@@@@@A@@BA@@BA@@AA@@A@@@AA@@@@@C@@@@@A@@@@@A@A@@@@@@@A@@@A@@@A@@@@@A@@@A@@@A@@@@A@CAA@A@A@EAA@@A@@@@A@@@@B@@@D@B@@@B@@AD@@A@@@C@AA@A@@AA@A@@@@@AB@@C@@@@@AAA@@@@A@@A@@@A@@@A@A@@AAA@@AA@@@A@AA@@A@@BA@@A@@A@A@@AA@A@AC@@@A@@@AAABC@EB@DADC@ABA@A@C@ABA@A@CAA@A@@@@A@A@@BAB@@A@@A@@@@CA@@A@@@BAB@@AB@AC@A@@@@@AAA@@@ABABA@A@@B@BA@@@A@A@@BABCAA@A@@BC@ABABA@A@@@A@@BC@AA@AC@A@@@ABC@@@CAC@A@@BAB@DC@@@AAC@@BABA@A@A@@B@BAD@@@B@@ABABCBA@A@@BA@AAC@@@ABA@A@@BAB@@@D@B@@@BAB@@@B@DA@A@@B@@@@@BA@@B@@A@@@@@AB@B@B@B@B@@@B@@@BA@@BAB@@@@@BB@@@@@@B@BA@@B@@A@@BBB@@@@BB@@@@B@@DB@@B@@AB@@A@@B@@@B@@@B@@@B@@B@@B@@@@@B@@@@A@@B@B@@BB@@@@@B@@@DBB@B@@@@@B@B@@B@B@@@@BB@@BB@A@AB@B@@B@@B@B@@@@E@A@@BA@@@@@A@ABA@@@A@AA@AA@@@@@A@@@AAAB@A@@A@@AA@AB@B@@AB@@A@@A@@AB@@@@@@ADBBD@B@@DB@@B@@@B@@BBB@B@@@B@@@@@BB@@@BB@@@B@@CD@B@@@BBBBD@@BBB@BB@AB@@A@A@@@@@@BAB@@@BAB@@B@BB@@B@@@B@B@@@@BDB@@B@BBD@B@D@B@@@@B@B@@BBB@B@@BB@BBBBB@@D@@B@BA@@BABA@@@A@AA@@AAA@@AA@@@A@@B@@@DB@@B@@@B@@@@@@DB@@BB@@@@@@@B@B@BBB@B@@B@@@B@@B@B@@@B@@@BA@AB@@AB@@A@@@A@@BA@AB@B@@A@@@A@ABA@@@A@AB@@@B@@A@AB@@@@@@A@@@CBA@@AA@@@A@@@@@@A@@@@AA@@AB@A@@A@@@@@AB@@BD@@@@@B@@C@@@@BC@@@A@@B@B@B@@@@@B@@A@BD@@@BB@@@@B@B@@BB@B@@BB@B@@BBB@BBBA@@@@BB@@B@B@@BAB@@BB@@@B@D@@@B@@BB@@@@@BAB@@@B@B@@@@@B@@@@@BBB@@@@@B@B@ACBAA@@A@A@AB@ACB@BA@@@AD@@BBBB@@BB@B@B@B@@BB@D@@AB@@BD@@BBD@BDB@@BBB@BA@@@ABA@@@@BAB@@ABABA@A@@@AAAA@@C@@@@B@B@B@BA@A@@BBB@@ABC@A@A@@A@A@@@@AAA@@@A@@BAB@BBFAB@@AB@@@@A@@@CD@@@@BBB@B@B@D@@@B@@@D@@BAB@B@@@B@@@B@@@@BB@@@B@@@@@BABA@ABA@@@@@@DAD@@@BAB@B@@BB@BB@@@B@@B@@FB@@B@B@B@B@@BAB@BBB@BB@@@BBBB@B@@D@@@BB@BB@@@B@B@@B@@@BBB@BBBB@@@DB@@A@@BA@@@DBDDD@DB@@DBBB@AB@@BB@@@BB@@B@@@B@@@@@DAB@B@@BB@@B@@B@@@@BA@@B@@@@@BDDB@@@B@@@@BB@@B@@@@BBBA@@BB@@B@@@@@BB@@BB@@@B@@@@@BBB@@D@BBB@B@@BB@@@BAB@B@BB@@B@BABA@@B@B@@AD@B@BA@@BA@ABBD@@@B@@@@B@@@@@@@B@@@@BB@@B@B@@@@@@B@BB@@B@@BB@@B@@BB@@@BBABABA@@BBB@@@@@BBB@@BB@@@@B@@@@B@@@@@@@DAD@D@B@@E@A@ED@BC@CBABCDABCB@@@@ABAB@@@B@@@DBB@@BBB@B@B@@@D@FBDB@@@B@@BB@@B@BAB@B@@B@@@DAD@BA@BBBB@B@DBB@@B@B@B@@@BA@@BA@C@AB@@@BABB@@@ABA@A@AD@B@B@BAB@B@@@B@@AB@@BB@@AB@@A@@B@B@@@@BA@AB@B@B@BA@A@A@@D@D@@BB@B@@@B@@@BB@BBAFBB@B@B@BAB@@BBBD@BF@@BBB@BDBD@@B@@B@@DA@ABABBB@BAB@@@BBDDBB@BD@BB@BBBD@@@DAB@@@BABABA@C@A@CBA@@DB@@DCBA@@B@BAB@@CA@BABAB@BB@B@@BBB@BBDD@B@@DD@@BB@DB@FBB@DB@@BBADBD@@ADB@@@BBBB@B@@BBB@@BBBBABB@BBBBB@DED@@ABA@A@ABGDA@AB@D@BBBBDAFABA@@BAFGD@@BB@B@BBD@D@@@B@BBB@B@B@B@B@B@DBB@BBBB@BABAD@B@BA@ABABADA@BDCB@BAFBB@BBAD@BBB@DBD@B@BAD@B@BBB@B@FC@@DA@@@@BA@@B@@A@AB@@AB@@@@A@@@@B@BB@@B@@@@@BB@@B@B@@@@@B@B@B@@@@@B@B@@@BB@@@BB@@@@@DBHB@@@@BB@@@B@@B@BBB@B@@B@@@BB@@@B@@@@@BA@@@B@@BA@@B@BB@@B@BBBAD@BB@@@BA@BB@BB@@BB@@@B@@@@@BB@A@@BB@@@AD@@@@BB@@AB@B@@@B@@AB@B@D@BB@ABB@BB@D@BA@@@@B@@B@AB@B@@@BB@@@@BA@@@B@@B@@@B@D@@@B@B@B@@@BB@@@BA@BBBB@@DDABAB@BA@@@@B@@A@AB@@@BA@DD@@DB@B@@@B@B@BCBAD@@@B@BBD@BA@@@@@ABA@@@@B@B@@@@BA@@B@BBBBB@BB@@BAB@B@@@B@B@@@@@F@B@@@B@B@BB@@@BB@@@@BB@@@BB@@BA@B@AB@@BB@BBBB@@@@B@BB@@B@B@@@B@@@B@B@@@D@@BB@B@@@BBD@@BB@@B@@@BBB@@BB@@B@@@B@@@@@B@@@@@BBBAD@@@@@@@BA@BBA@@B@@@B@BBB@BA@@B@B@B@B@D@@@@A@@B@@@@@B@BAB@BBB@D@B@B@B@ABB@@@B@B@D@@@BA@AB@@@@AB@B@@A@AAA@A@@@@@A@@@@@@@A@A@AB@@@@@@@@A@@@@B@@@@A@A@@@@B@@@@A@@B@@@@@BA@@@@BA@@@@@C@AB@@A@A@AB@@A@@BA@@@A@@@@@A@@@A@CB@@ABA@@@@@A@@@C@@@AAAB@@A@BBA@@D@B@@@BAB@@A@@@ABA@@@@@C@@BA@CB@@AB@@AD@@BB@B@@BBA@BH@@@D@@B@@@BB@DB@@@@BB@B@@@@B@B@BA@@BB@@B@B@B@@@B@FA@AB@@AB@DA@A@@B@@C@@FCBA@@@@@CA@@@BA@A@A@A@AB@@C@A@@@A@AB@BA@ABA@@BA@@@A@@@A@A@AB@A@AACC@A@@BA@A@@AA@@@@@@A@A@@AA@@A@@AACA@@AA@AB@@AB@AA@A@@AAAA@A@@@A@C@@@AB@BA@AAA@@A@@CA@@@AAA@A@BE@@B@@AB@AA@@@A@@BAB@@@DAB@@@B@@AB@@ABA@@@@B@B@@@B@@@@@@AB@@A@A@A@@@@@C@@@@@AB@@A@@BA@@@A@@BAB@@AB@@CB@@A@@@@@AA@@A@@@@@A@@@A@@@@AA@@@AA@@A@@B@@A@@@@@A@A@A@A@@@@@@B@@@@@@A@A@@@@BB@@BA@AB@@@B@@@A@@AB@B@@@@@B@@@@@@A@@@A@@B@B@@A@@@@@@@A@A@@DA@@DCD@BA@@B@D@@ABC@@@@@@AAAA@A@A@@DAB@@AB@@@BA@@@@@AA@@@@@@AA@@@@@A@@A@A@@@ABA@A@@B@B@@@@AB@@@@A@A@A@@B@@@@A@@A@AA@@B@@@@A@@@A@@@A@A@@@AB@@@@A@@BABA@A@@B@DBB@B@BBHO@AAA@A@A@AAAA@@C@@AB@AA@AC@@@A@@@@B@@@BA@@B@BA@@B@@A@BBA@AB@BAB@B@B@@@BBD@B@B@B@BADA@@B@@@@CB@B@@@B@@@BA@AB@BABBB@BBB@@@B@B@@AB@@@B@B@@AB@B@B@@ABA@BB@B@@AB@@AB@ACB@B@@@BA@A@@BAB@BB@A@A@@@A@@AA@@@A@C@@BA@@@ADAA@@ABAB@@@@A@AB@BA@AB@@@@AAA@A@@BA@AB@@C@@@@A@C@A@ABC@@@AA@AA@@@AB@B@B@@@@@@A@A@@@@@AB@@C@@AA@@@@@@@A@@@AACA@@AB@@@B@@A@@@A@AAAA@@ABA@@@AB@@@B@@CAAA@@A@@AA@A@A@AAADABA@A@AA@@@@AAAB@BA@ABA@AAA@@@A@AB@CA@AC@@@A@@AAABAC@@@A@@@@@AAAA@@AA@AAAA@@@@AA@@@A@@B@B@D@B@DABADA@ABABC@A@@@A@A@AAA@@C@AAA@C@ABCACA@A@A@ABABA@@AABA@@@@BABA@A@@AA@@@@@@@AA@@@@AA@AB@AA@@AA@@@@CAC@@AAC@C@@@@@ABA@A@@B@@AAA@@@AAA@@@@@@AA@@@@@AAAA@AA@AAA@CAAB@B@B@@A@@AACAA@@@C@AA@AA@@@A@CA@AAA@@A@A@@AA@AAAA@@@AC@@AAAB@@A@@A@A@@@@@A@@AA@@@@AAA@@A@A@@BA@AFAB@@@BB@@B@@B@@@@BBB@BA@@B@@AB@@@@@AA@ABCB@@BB@@A@G@A@@@@@A@@@A@@B@@@B@@A@@@@B@BB@@DA@AB@BA@A@@@AA@AABA@C@@@@A@EAC@AA@AGAEBB@@B@@@BA@A@@AA@@@AA@@@@ABAAA@@AA@@@AB@@A@A@@ABA@AA@@AA@@A@@ABC@@@ABA@@BABA@@@@A@@@A@@@@AB@AA@@AA@@AACA@@A@@@ABC@@@AAAA@@@ABAB@@A@@@AB@A@@AA@A@@AA@AAAB@AC@@AAC@@A@@@@AA@@@AA@@BA@@B@B@@A@A@@@A@@@A@A@@@@BAB@@AA@A@@C@@@AB@AC@@@ABAA@@ADB@BB@B@BAD@D@ACA@@A@@@AB@B@B@B@BA@A@@BABA@A@@@ABB@@FBH@BBBDBBBB@@ABBB@B@BB@@@BA@@BAB@@@@@B@B@@@B@BA@A@AA@@A@A@A@AAAC@@@A@@@@@@@AA@@AA@GE@ACAAB@AA@A@BA@@AAA@AA@AA@@@A@@@ABAB@@E@A@@B@C@@AAA@@@@AAB@BA@ABA@@@@@AB@@@B@@@@A@@AA@@@A@A@AAAAA@@A@@AA@AA@@@@EA@A@CCA@@BAB@@AD@@@BA@C@C@A@BA@A@ACCA@AAAAA@AG@A@ADCBABAB@FCBA@@BA@ABA@CBA@A@A@AC@A@EB@BABA@AB@@A@A@@@@BAAA@@@A@AA@@CAC@@AA@@AA@A@@AA@C@A@AA@@A@EA@AA@ACAAA@AAAAAAA@@A@@CA@AA@ABAB@BEB@BAACBC@@CBA@A@@KMAA@AAAB@BAB@B@BAFC@@B@@@BBBB@@BA@@@AAA@ACAA@CAA@A@AAA@@A@@@@@A@AB@@AB@@A@AAC@@@@EAAA@AAAAA@@A@@@@@A@@BAD@BADABCDEHCB@B@B@@A@@@@@A@CK@AACACA@@CBEBABCDCHEFABABA@C@AAC@AAACACAE@@@@A@@@AB@@@AA@AAA@@@@@A@@B@@B@@@@@@B@@@@@BA@A@A@AB@@@BABAB@BB@@B@@@@C@@@A@A@AB@@@@AB@@B@@@AB@AAA@@@A@@@B@AB@@A@@@@AAA@@@@BA@@BB@@@@B@@AAA@@BABC@@@AB@@@@A@A@@B@@@@A@@@A@@B@@A@@BA@@DBB@BABABA@@D@@@BA@@@B@@@BA@A@@@@B@@@@B@DAB@@A@@@@AAAA@@BA@@@@B@@@@BB@@@@@@A@A@@@@@@BBBA@@@A@@A@@AB@@@D@@@B@B@@A@@BADBB@B@@@DABAB@B@@@B@@@@AB@B@@@B@DC@@@AB@@@@D@@B@B@B@BBB@B@@@B@@AB@@@B@@@@A@CBA@@B@@D@@B@BA@@B@@@BB@@@@@BB@@A@C@A@A@@DAB@@B@B@@BB@@@@B@@A@A@AB@@B@@@BB@B@@@BA@@AA@@@@@ABABABA@@@@B@@@DA@@@A@@@AB@@@@A@@A@@A@@@@B@@A@@@@@A@@BA@@B@B@B@@A@A@@@A@@@@@AA@@@@A@A@A@A@A@@@A@@B@B@@@B@BA@@@@BBB@@BB@@ABAB@BA@A@@B@@BBA@@BAB@@@@B@BB@@@B@@A@A@@B@B@@A@@D@BB@@B@@@B@BABA@@B@@BB@B@D@B@BB@@B@@@B@@ABC@A@@AA@@@@B@BCBA@EAI@@@AB@DEBE@AAE@ABG@CCIACA@@ABA@ADGB@BA@CBAAA@CAAAA@A@AAABE@CAA@@@A@A@A@A@@@@BC@@@A@@C@CBA@@@A@C@C@@@A@GACAA@C@BB@@AB@@AB@@CAA@@@AA@@A@@BA@@@@@@BEAA@A@@@AAA@A@CCAAACCE@ABABABA@@@A@AB@BABA@@@BBABBDA@A@@@ACCAC@@BCAABA@A@@@@@CA@D@BADBB@B@A@BA@@DABAB@@ADA@AB@AC@@BA@AAAA@A@CBDG@@BA@A@@B@@A@@@@@@@@A@@@AACB@@@@@A@@A@@@@AB@@A@@AAA@@@AA@@@C@@A@A@@@AB@@@@C@C@@@AD@B@B@BCBED@BBBC@AAAAA@@CA@AB@@ECA@A@A@@C@@@A@@@@A@A@@@E@AACBA@AA@AAACBA@A@C@A@A@A@@C@ABA@AAA@AAAA@@@@AAA@@AA@@@@@BA@AA@A@@@AAACCCAIC@@CAG@AA@@B@B@@@BAA@@@@A@@BA@@@AA@@@@A@@B@@A@@A@A@@@@A@@@@A@B@@AB@@@@@@BB@@@@@B@@A@@A@AA@@B@@@@@B@AA@@@@AA@@@@@A@A@@@AA@@@@ADE@@@AA@A@@@@A@@B@B@@@BAB@@@@@BA@@@A@@B@DC@@@AB@@@@@@@@@B@@@AA@@BA@@B@@AA@@@@@A@@AAA@CDAFE@ABCBA@ABA@ABAB@BAB@BA@@BD@BHBD@@@B@BADIBA@@ACA@@@@AAACA@@BCAAA@@@@AAAACAAA@@@AA@@A@AA@@@AAAAAAAAA@@AC@@AAAA@AAA@AA@AC@@@A@@@C@@BA@AA@@AAAAA@C@A@@@@AA@@@@@C@@@A@@@A@A@C@AAA@AAA@AA@@@@AA@@@A@CB@@AA@@AA@@@@A@A@@@CB@@A@A@A@A@@AABA@A@AA@@A@@BAB@@@@AA@@EACBC@CBA@A@A@A@CC@@AA@@AAA@@@AC@@@@@@C@A@@@CDAB@@@@A@AAA@@@E@@B@B@@@BA@@BAF@@@BBB@@BB@B@BB@@B@@DDB@@B@B@@B@BBB@@BAB@B@B@@A@@BAB@@@@AB@@@B@B@@A@@B@@@B@BADBBBBDBB@@DCBFDB@@BAB@DBBBB@@AB@@@@@B@@@B@B@B@BB@@BA@@@A@A@@AA@@@@@@B@B@B@B@B@B@@@@@@@B@@@@A@@@@@A@@@@@AAA@A@@A@@A@@B@@@B@@@@@@A@A@@@@BA@A@@A@A@@@@A@@A@@B@B@B@AABA@@@AA@BCAA@A@A@@@AA@@@@A@@@@AAA@@ABA@AAA@A@@@@@A@A@@AA@@@@ABCBCDGBC@ECG@I@EBEDCBAD@BABA@A@AAA@@@ABAACAA@EAAB@B@@AB@AAAA@E@E@I@AACAA@A@@A@@AA@B@@@@A@@A@@A@@@@@AA@@AB@AA@A@AB@@@BA@A@@@A@@@@@A@@@A@@@@A@@BA@@@@@CA@A@@@@@A@@@A@@@@@A@@@@@AA@@@@@@@@AA@A@A@@@AAAB@@A@@A@@@AA@@@@@A@@@@@@@AA@@@@@BC@A@@@@@@@A@@@A@@A@@@@@@AA@A@A@A@A@@B@@A@@@@@A@@@A@@@@@A@@@@@A@@@@A@@@A@@BA@@@A@@@AA@@@@@@@A@A@@@A@@BA@@AA@"],encodeOffsets:[[109978,22707]]}},{type:"Feature",id:"451421",properties:{name:"扶绥县",cp:[107.904186,22.635012],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@AB@@AA@@AAAEA@A@A@A@C@@@A@@@@@A@@@A@AA@@@@@AA@C@@AA@@@A@@AAAAAA@A@A@GBC@@@A@ABAB@@AD@@@@ABABEDCB@@@BA@A@A@ABI@@BBDABCDE@BDBB@B@@B@BBAD@@DBBB@B@@B@BD@@ABCJABA@@@C@GA@AAC@@ABA@ABA@AB@BAB@BABAD@BEFCB@DBB@BB@@@@@B@@BA@@@AB@@BB@@A@@@@@@@@@A@@B@@CDA@@@@B@@AB@@@@A@AB@@A@A@@@@B@@B@B@@B@@CF@B@@B@@B@@@B@B@@@@BB@@@@BBA@@@@@A@@@BBB@@@@BA@@@@@A@@A@@@@A@@BA@B@@@@@@B@@B@B@@@@BA@@@@B@@B@@B@@AB@@@B@@B@AB@@A@A@@@BBH@DB@@JDDBDDBB@B@@@BBBB@@A@@@@BB@@BB@B@@B@BB@BBB@BAB@B@DB@B@B@D@B@B@DABB@BBBB@DABBF@@@B@B@@@@@@B@@@DB@B@B@FD@@BAB@@DB@BBBBD@AA@AFCDA@A@A@ABC@@D@D@@@@@BA@@B@B@@@@D@@BB@@B@BB@@@BA@@B@@B@@@@B@@@@DABB@@B@@@@@@@@@@BA@@@@BAB@@CHDAB@B@BB@BAB@@BDA@@BCB@BA@ABCB@@ABB@A@A@CAABC@B@@D@@@@@BABBBAD@@BDDD@B@@@BCBAAAB@A@@ABABA@@B@B@@ABABAB@BDFBDBBDDB@B@BB@@B@B@FB@A@@@@B@@AB@@@BB@@B@DB@@BA@@BA@@AAD@B@DBHBB@@@D@D@B@@@B@DAD@@@@B@@AD@@@@@B@B@B@B@@BB@DAFBB@B@BBBBB@DBBAB@DABA@CH@BAB@BB@BDDJ@DAH@BBF@BAFCFA@@B@@BJ@FABADA@@@@@BB@@@BAD@BA@@@A@@@AAA@C@A@A@@AA@@@ABABA@@@A@@@AAC@@@@BA@A@@@@B@BA@@@A@@A@A@@A@AB@@AB@AA@@@@BABA@AB@BA@@AA@AA@@@@ABA@@@A@A@@@@B@@@B@B@B@B@B@@B@@B@@@@@B@@@B@BA@A@A@@@AB@@@B@@@@@BA@@@@@@BB@@@@B@@A@@B@@@B@@CB@@A@@@@@ABABAB@B@@@@BB@@AB@@A@A@@A@@@AA@@B@B@BA@@@@@AA@@@A@AA@CB@@@B@B@D@BA@@A@@@@AA@@A@@@ABA@@@@CA@@@AB@D@B@@A@@@A@@BA@@@A@A@AAA@A@A@@@@C@@A@@B@@CDA@@@A@A@@B@@A@@@A@A@ABCB@@A@A@CAAB@@@BA@A@@@C@@@A@@BB@@@@B@@ABAA@@@@@@@B@B@@@@A@@A@@A@@@@@AB@@BBBB@@@@@BA@CBA@@@@@@A@@B@B@@A@@@A@@AB@@C@@@ABABABA@CA@@AB@@@BA@@@@B@@@B@@A@@@@B@B@@A@@B@@ADAB@@BB@BA@@@@@AA@@AB@@@@BB@B@@B@@@BAA@@@B@@@B@BBA@@B@@@AA@@B@@A@@B@B@B@@@D@@A@@@AAA@ABAB@@A@@B@B@BAB@@@@A@@@@@@@@AA@@@@B@@@@BB@BBB@@A@@B@@@B@@F@DBDBBB@BBD@B@DABABEBGFCDADABAF@DB@BDBD@BDLB@@@@@B@@@@A@ADAFGDCBABC@ABC@AB@@@@@B@@@BBBB@BBBFB@@@@BD@B@BA@@BA@@B@B@@@@@BB@BBB@B@DBB@DB@BBB@B@@AB@@AAAA@@A@@@EDABA@A@ABA@BB@BBBLN@@@BAB@DD@DABB@AFA@ABABAB@@BDB@@@BB@BBBBBBB@BBBDB@@BFBB@@@BBB@D@B@@BB@B@@BB@@BD@DB@@BBB@@@B@BB@A@@B@B@@@BAB@BA@AFAB@D@@B@B@BAB@DAB@BAB@@ABEDA@ABABCD@B@BBHB@BBBBB@DD@B@BABB@D@D@B@@A@@BC@@BA@AB@DDB@B@@F@@B@@BBB@@@BB@BBBBB@B@@@B@@BB@@@@@@A@@BA@@@@B@BAB@@ABA@B@@B@BB@@@D@AB@F@@@BABA@@B@@@B@@BBBB@BB@@ABB@B@@BBADB@BHFB@@BB@@B@@@@@@@B@@BDBBB@B@B@@@BBB@B@@A@A@@@A@A@@@@BA@AB@@A@@AAA@A@AA@BA@AACAAA@AAG@EA@BA@@B@B@BA@AB@B@@A@A@A@ABA@@B@@@DB@B@CBC@A@AAAA@BC@@BBBA@@D@@BBA@@D@@@@BBB@@BA@A@@B@B@@@B@@@B@B@@@@A@AB@@AB@@B@@BB@@@@@BD@BB@@BDA@BB@BBB@@@BBB@@@BBA@@B@@@BABA@@B@BB@B@@AD@B@@@BB@BD@BB@@BB@@BBA@@@@@B@@@B@@B@BA@AB@BA@@D@BA@@@BB@@BB@@BAB@BB@B@@@BA@@B@@BB@BBBA@@@@BB@@B@@BB@B@@A@@@AA@FAHB@BBBD@FBB@@@@@@DABBBB@@B@@@BABA@@BCB@@AAA@@@@@@BA@@@A@@@@B@@@B@@@@@B@H@BA@@AA@AD@BBB@@@@A@@BA@@@ABA@AA@@@@@AA@@@AA@@A@EB@BAB@@@B@BB@BB@@@@BB@@@B@@@@@B@BB@@@BABB@@BD@@B@BB@BBB@@@B@BB@BBB@@D@B@@BBB@@B@D@@BBBD@BB@@@@A@ABADBB@BBB@@BBBBB@@@@B@@B@@@@B@BB@@B@BB@@@AB@B@BA@@@@D@D@BB@@BD@D@@B@@BB@@BBAB@@B@@B@@B@@@@@@BB@@@BABAB@@@@ABBB@@ABAB@B@B@BDBDBBAD@B@BBD@@@BB@B@B@B@@@BADAB@BCBABCBA@C@A@A@@@@B@@BB@@@@BBBBB@@BB@BB@B@@@@@B@@BDBABB@@@B@@BDB@@DBAB@@@B@BBB@BAB@@ABABB@@@@BBB@B@BABCBBB@B@B@@BB@@@BBDB@@@A@@BA@@B@BA@@BBBBB@@@B@@@@A@@BA@@DBBB@@B@@@@@@@B@@BD@@@BA@@@@B@B@@@@@@@@A@ABA@@B@@BBB@@D@BAB@D@B@@@@@@DA@@BAB@@@BBB@B@@A@@BABA@@B@B@@A@AB@BB@CB@B@@AB@@@D@B@@BB@@@B@@@B@BAAA@AB@@@BAB@@A@A@BDA@@BA@@BA@A@@AAB@BA@A@A@@BA@A@@@A@@BA@A@@@A@AAA@AAABA@@BAB@@A@@@A@A@@D@@A@@@CBABA@A@A@C@AA@@A@A@A@ABA@@BAB@A@BA@@@ABA@@@AB@@A@@@@@@B@@BDB@@BBA@@@DB@BB@B@B@BBB@BGPAAA@A@CAA@@@@BABAB@@@B@@A@@B@@@B@B@@@B@@@B@@A@@@BBB@@@@B@@A@@@@B@B@B@@A@@B@@A@A@@@@BAB@B@@@B@BB@@@@@B@@B@@@@B@@B@@@@AB@@A@@BA@CB@@@B@BBBBB@@@@@@AD@BC@A@@@ABC@CD@@CB@@@B@B@@@@@@@BA@A@@@@B@@@B@@@@A@@@@@A@A@@BB@@@A@@@A@@BAB@@AA@@@@@B@B@@@@A@@@@@@@@B@B@B@B@@@@@BA@@@@BB@@B@@BB@@@@@B@@@B@@@@@BB@@B@@@@@BA@@DA@@BA@AB@@@B@@AB@@@BA@@B@@@@@D@@@@@B@B@BA@@B@@@@A@@@A@A@@@@@AB@BA@@BA@@@A@CB@@A@AB@@@B@@BBA@@BA@@@AFB@B@BB@@B@@DB@@@BB@BABA@@B@@@D@B@@@BBBBB@@@BBBA@@BA@@BBB@@DBBB@@@BB@@BB@B@@@@@@@BB@@@BAB@@@BDDBBB@A@@B@B@B@@@B@@AB@@AB@BABA@@B@B@@@B@DA@@B@B@B@BAB@@B@@D@@@@ABED@@@DA@@@@BCBA@@BA@@BEBA@@BBB@@@B@B@B@@BD@BB@B@BBB@@@BBB@@B@@@B@BB@@@B@BBBB@DA@@@A@A@@BC@@@@BA@@@@@BBAB@@A@@BABA@@@B@@DA@CB@@AB@BA@A@AB@@@BAB@B@BAB@@A@A@@@CB@@@D@BAB@BB@@@A@A@A@ABA@A@@@A@A@A@@B@@AB@DAD@@@@@D@@ABABABA@@@@B@@@B@B@@BB@BAB@@A@EBA@@@AA@AA@CAAB@@@B@F@B@@@AA@@@A@@@A@@@CB@@A@C@AB@@@BAB@@@BBB@@A@AB@@A@B@@B@@@BA@A@A@AB@B@@@B@@EB@@A@@@ABA@@@A@A@@@@@@@A@AB@@DH@D@BB@@@@BB@@BB@B@DBDB@@@AB@@@@AB@B@@@B@BA@@B@B@B@@AB@D@B@@@@@B@@AB@@@B@@@B@@@BB@BB@@@@@BAB@@@B@B@@@BA@@@@D@B@DB@@B@@@D@@@B@B@DBB@@@B@@@BBB@D@@@B@@@BA@AB@@A@@B@@A@@@ADA@@BAF@D@D@@@BBBBB@BAB@@@@BBB@BD@@@@BB@BBBB@@@B@B@@B@@BB@@BB@@BBB@B@@@@@@@A@@B@@A@@@@BA@@@@B@@@B@@@BB@A@@B@@@@BBA@A@@@@@@BABA@@@@B@@@B@@A@@@A@@@A@A@@B@@@BABA@@B@B@@@B@@BB@B@@@@@B@@@BA@ABAAAB@@ABA@@B@BB@B@@@@@B@@@B@@B@B@@@AB@@@B@@@@@B@@@@@BBBBD@@D@D@@AB@BAB@DE@AB@@ABA@AB@BC@ABA@@@@@C@A@@AA@ABA@A@A@AA@BA@ABA@AB@@@B@B@@@BA@@@@B@BA@@B@BABABA@@@@@A@@BA@AB@@A@A@A@AB@@@B@@@BA@@@@B@B@@@B@@@BA@@@AB@@@@A@A@C@AB@@ABA@@@AB@BABA@ABA@@BA@@@@BA@@BABA@@@@B@B@@@@AB@B@DABAAA@@@C@@@@@A@@BAB@BA@@BAD@@@BA@ABB@@@@BAB@B@DAB@D@@@BBB@@@B@B@BBB@BADC@@BAB@B@B@BAB@BABABBB@B@B@B@B@B@BA@@@AB@@@@AB@@@B@@@@@@@@@@@BA@@@B@ABB@@@@@@B@@@@@@@@B@@BA@@@@@@B@BB@@@@@@@@B@@@@@@B@@@@@@@@B@@@@@B@B@@B@@B@BBBBD@B@@AD@BBB@DCD@@@@CB@BADA@AB@B@@AB@BA@@BAB@B@B@BBB@BA@@@AB@B@BA@AB@@A@@@@@@@AB@@C@CB@@@ACB@@A@@@AB@@A@@BA@CBAB@@@BAB@@A@@B@B@@BD@B@B@B@F@B@JBDAN@BBD@FBB@B@B@B@D@@AB@@@@AF@B@BANAB@B@@AFD@@@BB@@BB@D@B@B@DA@@BC@@B@@@@ABAP@@@D@D@D@@@B@B@B@HGD@@A@@FE@E@A@@@E@A@@@ABAJBBE@@BC@AB@@A@@AA@A@C@@@A@A@@@@AC@CBAA@A@@C@A@@AA@AEM@@A@A@@A@@BC@@DA@@BA@@@AB@@A@A@@DAB@B@D@BBD@HD@@DAB@@@B@BABBD@BAD@B@BEDCB@FBF@DADAJ@DAD@B@@B@BD@BBD@@@@@@C@ABADG@A@C@C@AAACAEAE@EBC@AA@G@A@@BAB@@AD@@AB@ACCCAAD@BC@A@@BA@@@C@CD@B@BAAAAACAAACA@A@C@AAA@A@A@AA@BA@AAAAAA@CA@A@@@A@A@CAA@@@@AA@@@A@AA@CA@@ABA@AAA@AA@@AB@@@BAA@@@AA@A@EA@A@A@ABC@C@A@A@@@@@A@AB@@AB@BB@@B@@@BA@@@@@C@@@C@A@A@@A@A@AB@@@BAB@@@@AC@ABA@E@C@AAA@CBC@CB@B@B@@@BABABCFC@AB@@ABB@@DB@BD@B@BABABAB@@@B@DC@A@CFC@@@AB@@AACBE@@@@BAD@D@B@B@BABA@@@ADAB@B@@ADC@A@@BAF@BBB@BAB@BBBBBDAB@B@@@@F@F@DBBB@B@BB@FBJ@DDBBBBBDBBBF@BB@@BA@@B@BBBAD@D@DABABBB@BA@@BBBBB@B@@@B@BBAB@DDB@B@@D@@@@BB@BBBF@@@B@@ABE@@@@@EBABA@ABA@CAEBCDCBC@ACCCA@A@C@ABA@EDADABCBADAD@AEBAF@BADAAC@A@A@A@@CCAA@AB@@CBECCAB@CCCAA@@CABAAGE@AGC@AEA@AA@AAC@@AA@@ADCAA@@@AAAAA@ABBBABC@ABCB@BAACAA@@ACAA@@AAA@@C@AA@AA@CB@D@B@B@BA@CACAA@CACBA@CCCAACA@@AA@CAEBAC@@CAECE@@@AA@C@AAAA@@@A@@@@@A@@@CBABABAB@@@@A@ABA@A@E@A@@@@CA@CC@@ABA@AA@A@@@A@@@A@@@A@@@@A@A@@@@A@@@@AABAA@@ABAAA@@A@A@@A@@@A@@@A@A@@A@@A@@@AA@@@@AB@@AB@@AA@A@@@@@A@AA@@BA@AAC@@BEAAAAA@@@@@@@B@@A@AB@@@@A@@D@B@@A@A@AD@B@@@B@@EB@@@@A@ABAAA@A@ABA@@B@BA@@B@B@BAD@@A@A@@@AAA@@A@AA@@BAH@BA@@@@@ABA@ABAB@@ABAB@AA@@BAAA@A@C@@A@A@@@CA@AAA@A@C@C@@BAAAAAA@A@@C@@BAAA@@C@@@CA@@@A@A@A@AAA@@A@@@@@AA@A@@BAA@A@AA@@AA@B@@AB@@A@AAAA@@@AAA@@@CAA@AB@AAAC@@ABAA@@AAA@@@AB@AA@AAA@@ABA@A@AAA@A@AA@@@@AB@BB@@@@B@@@BB@@BA@@AA@@BAB@DA@@BABCB@@A@@@@BABB@@B@B@@@BB@@@@@BBA@@BB@B@@B@@@BA@@B@B@@@BBB@B@@BBB@@BA@@@C@@AABA@@@A@@@@AA@@@@@A@@@AAAB@@@@ABA@@@@@A@@BAB@B@@A@@@A@C@@@@B@@@@@B@@@@@@A@@@@@A@@BAB@BBBB@BB@FA@@@ABA@@B@@@B@@AF@HBF@DBB@DBDBB@@@AAEEGAHAD@D@DADAB@B@B@D@BAB@B@BAB@@ABB@A@@@A@@B@AA@@A@BC@C@@A@@A@A@@@@B@B@BABA@@@@B@BCB@@A@AB@@BB@@ADB@@@@B@@@FDBBB@BBBAB@@BB@@BFA@A@@F@B@@ABBDA@ACCAA@ABABA@@B@B@@A@A@A@C@AAAAACBAA@@@A@A@A@A@CA@AA@@AA@A@AB@@ABC@AB@@@AA@EA@@A@A@A@AB@@@AA@ABCB@@A@C@A@@A@@A@A@@A@AAB@@A@@F@BAB@@AAC@CB@B@BB@@D@B@D@B@@D@DDBFCB@ACD@BC@ADADABAD@BCB@DA@A@ABA@A@A@A@@@A@A@EA@AB@BA@CBAA@G@@@AAA@A@@AAAA@@AAA@@CC@AAC@@A@E@@A@A@A@@AA@A@@@C@@AC@@BCAA@A@@@@@AEA@@@@D@@AA@@AA@A@A@@A@CAA@@AAAA@CAAAAB@AA@@@@A@AA@AA@@@A@@@AB@@@BAB@@@@@@@@A@@@@@A@@@AA@AB@@ABA@@@A@A@@@@DICAB@DAB@BC@C@@BAA@@A@CAC@@@A@@@AABA@A@@@A@A@@ACAAA@@@C@@@AB@AA@@@@A@@@@AAAC@@AA@@@@@A@A@A@ACA@ACAA@AA@AACC@AAECAA@@A@ADAB@@A@AB@@A@CBA@CA@@A@A@@@CBC@@@@AA@ABA@@@CB@A@@@A@A@@A@@AA@AA@@A@C@@A@A@@@A@@@A@@A@A@A@A@@@@A@@AAC@A@A@@@AA@@AA@AAA@@A@@@AACAAA@@@C@@AAAA@@AAD@@AB@@@@@A@A@@@@A@@BA@@@@@@A@A@@@@@@@BA@@@A@@@@@A@@@A@@@@@@B@@BB@@@B@A@@A@A@@@@D@B@@@@A@A@@@@AAAA@@@@B@BA@@@@@@@ABABA@@@@@@@A@@@@@A@@A@A@@@C@A@AA@@@@@AA@@@@AA@@@AAA@@@A@@@AA@B@@@B@@@@@@A@@A@@@AA@@@AA@@A@AA@@AA@AA@ABA@AACA@@@@AAA@@A@BA@@@@B@A@@@A@@@@AA@@@ACC@@@AAAAA@AA@@AA@@AA@AA@@@AG@A@@A@@AC@A@@A@@@A@@A@@@@@ABC@ABA@A@@@ABAB@@AB@@A@ABA@@@A@ABA@@@CB@@A@A@@BAB@@A@@D@@BB@BA@@D@D@@@KAM@C@CB@BA@@B@BCBA@CA@BA@ABA@A@ABA@AAGCGAAAA@B@@AA@@@AA@@@@@AA@BAAC@@@@AACA@C@@B@@A@ABA@@@@AA@@@C@A@@@@AACAEC@ABAAABA@A@@@A@A@@@CAA@@@A@A@@AA@@BA@A@@@AA@@A@@E@AA@@@AC@@AEAA@A@@@A@@@@@A@@@@@A@AAA@@@@@BB@@@B@B@@AB@@@@@BB@@B@@@@@B@B@B@@A@AB@@A@A@AAABCBA@@B@@@@C@@@A@AB@A@@@@ACA@@@AA@AEA@@AA@AACAAA@C@@@A@C@C@C@@@A@AAA@A@@@A@A@A@@BA@A@ABA@@@@@A@A@@B@@AB@@CBC@@@CAA@AAABA@A@A@A@A@E@A@A@C@C@CB@BGBAHAA@BA@@@A@A@CAA@A@@ACAAAGAC@@AAA@A@AACA@@AA@AAA@@@A@ABC@@@@AAAA@@@C@A@AAC@A@A@@@CBA@GBEAA@A@A@CBC@@@A@A@A@GC@@CBE@AAAA@@A@A@A@AC@@@@CB@BCB@@C@C@@AA@@@@@A@CAAAA@A@@@AB@AAA@@A@@A@@A@AC@A@ABC@A@AAACAAAACA@A@ABA@ABC@ABA@ABA@ABA@A@@@A@I@C@EAA@C@@@C@CAC@EAGCE@E@GCG@CCECABA@A@CBC@@@A@AAA@@AA@AB@B@BABCB@BCACBABCAA@ABAAABA@C@A@CBABAB@@ABCBAA"],encodeOffsets:[[110217,22724]]}},{type:"Feature",id:"451423",properties:{name:"龙州县",cp:[106.854482,22.342778],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAA@@@A@@@CC@A@@@A@AA@@AEACC@AAAAECEABCAABE@ECACA@CAA@E@@ABAA@ADA@A@A@A@@BCAA@@@@@AB@@ABABAAAAABC@A@@@ABAB@@ABAB@BAB@@A@A@@@A@@@A@AAA@@@AAAAA@A@@BAB@B@@@DDD@B@@ABA@@@AAEBAAA@CC@@A@ADA@@BA@@BGCAB@B@BAF@@@D@BD@@D@@B@BBB@B@BB@@BAB@@@@BB@B@B@@@@AB@BAB@@@BD@@BBBB@BD@@DB@B@@BBDAB@@@@BB@D@B@@@B@B@@ABBBAB@B@@E@AA@@C@@@A@A@AAA@CBA@AA@AADA@AB@DC@A@A@CBCCEA@@A@CBA@AAAAACCBA@CDAB@B@@@D@@AB@@C@@BAB@B@BABA@ACA@CAACAAAB@@@@AAA@A@C@@BABABAFAD@BABCBA@C@EB@@A@@B@B@@ABAB@@@BBBAB@B@@@@B@BB@BB@@@@B@@BBB@@@@B@@A@@B@@AB@@AA@B@@ABB@@B@B@BBB@BBB@@B@@@@B@B@@@B@@AB@@AB@@@@ABABADABEB@@@BAB@@A@@B@BBB@BABA@@BAB@@@B@@@BB@@BBBAB@@@B@@@B@D@B@@AB@@@B@@@@@BA@A@ABA@A@A@I@@BA@ABAB@@ADC@@DA@@BA@AB@@A@A@A@@ACD@@@BA@@@A@@@A@AAA@@@@B@B@@@@@@@BBB@@@@AB@BABA@ABA@@@AAA@@@ABABA@AD@@AB@@C@C@@@A@A@@@A@@@AA@@@@A@@@A@@@AB@@@@CB@@@B@@AB@D@@@BB@@BBB@@@D@D@B@B@@ABAB@@@@@B@@BD@B@B@@@B@B@@@B@BA@@B@B@@@B@@@B@@@BA@@@A@A@@@A@@@@B@BA@AB@@@@@@@B@@AD@BAD@DAB@F@@BDBBBD@@@@@B@@@@A@@@A@A@@@ABA@@@A@@@A@@@@BA@@@@@@B@@A@@@@B@@A@AB@@@@A@@@A@@@A@@@A@A@A@@@@@A@@@AA@@A@@@A@A@@@A@@@AB@@A@@@A@@@@AA@@AA@@@@@@@A@@@A@@@@@@@A@@A@@A@@@A@@@@@A@@AA@@@AA@@A@@@A@@@@@A@@@@@AA@@A@@@@@A@@@A@@BA@@@@@@B@B@@@B@@@@@@A@@@@BA@@B@@AB@@@@@@A@@@A@@@@A@@AA@@@@A@@@@@A@@@A@A@@@A@A@@@@A@@@@A@@@@@A@@@A@@@@@A@@@A@@@@@A@@@@@A@@B@@A@@@@@A@@@@B@@A@@@@@A@@@@@@BA@@@@@A@AB@@@@A@@B@@@@@@A@@@@BAA@@A@@@AA@AA@@@@@A@@@@@@A@@@@A@@@A@A@@@A@A@@B@@A@@B@BAB@B@@@B@@A@C@A@@@@A@@AACC@@@@@@CBCBCDCBCBABA@A@@B@@@@B@@@@BB@@B@BBB@B@BBD@@@B@@@DAB@B@B@B@@@@@B@@B@@@@@@@BB@@@@B@@B@@BAB@B@B@BA@B@@@@B@@@@B@@@@@BB@@@@B@@@B@@BBB@D@BBB@B@B@@BB@@BB@@BHD@B@@B@@B@B@B@@AB@@@@@@A@@@A@@@@@@@A@@@@B@@AB@@@@B@@@B@@@BB@@B@@B@@@BB@@B@B@@@B@@@B@@@@@B@@@@@@@B@@BB@@@@@B@@@@A@@B@@A@@@@@A@@@A@@B@@A@@@@@CB@@A@A@@@@@AB@@@@AB@BAB@@@@A@@B@@A@@B@@A@@@@B@@@@A@@@@B@@@@@@@B@@@BA@@BA@@B@@AB@@@B@BA@@BAB@@@BAB@@AB@@@B@B@@@B@@@@AB@@A@@B@@A@@@AB@@@@@@BB@@@@B@B@@@@@BB@@@@@B@BBB@BB@@BB@@B@@@@@B@@@@@@@@@@A@AB@@@@@@@@@@@@@@@D@B@@@B@@@@@@@@AB@@@@@@@@@@BB@@BB@@@@@BB@@@@B@@@@@@@BA@@@@@@B@@@@@@@@AB@@@@@@A@BB@@A@@B@@@@AB@@@@AB@@@@@@AAA@C@@@@@@B@B@@@B@@@B@@@@@@@BB@@BB@@@@@@@@B@@@@@B@BAB@@@@@@A@@@@@A@@B@@A@@B@@@@@@B@@B@@@@B@@BB@@B@@@@@@@@AB@@@@@B@@@D@@@@BB@@BB@@@@@@B@@A@@B@@A@@B@@@@@@B@@B@@B@@B@@B@@BD@BB@@B@@@B@@@@B@@@B@@@@@@@BB@@@BB@@B@@B@@@@@@@B@@@B@@@@@@A@@@AA@@A@@@A@A@@@@BABAB@@@B@@@@@@@B@@@@D@@@BB@@B@@@@B@@@@@B@@@B@@@@@BB@@BB@@BB@@@B@@A@@B@@@B@B@B@@A@@@AB@@@B@@@@@B@BB@@B@B@B@@AB@B@@@B@@@B@@@BB@@B@@@@@B@@@BAB@BAB@@@@@B@B@B@B@B@B@B@B@BBD@B@B@B@B@D@D@D@@@@@@@B@@BBBBDFB@@@@B@@@@@B@@@BBB@B@@BB@B@B@@BBBB@B@DABBB@D@F@B@@@@BB@@AB@@@B@B@@@@@BB@@@B@B@@@B@@@B@B@B@BB@@B@@@BB@@@B@@@B@B@@@B@@@@@@@BB@@B@BBB@BBB@B@@BB@BAB@@AD@DAD@B@B@@@B@@@B@@@B@@FBFDBBB@@B@B@B@@AD@B@@ABC@@@A@@B@@@B@B@BBBBBBB@@@BA@@@A@BB@B@@@BBB@@BB@BBBBD@BB@@@@@@@BA@@B@@@B@@@B@@@B@B@@@B@@BB@@@@@@@B@@A@@B@@B@@@@@B@BB@@@@@@@@@@@@@BA@@@@B@@@@@@@BA@@BA@@@@@@BBB@B@@@B@@@@@B@BA@@B@@A@@@A@@@@@@@@@@BA@@@@@A@@@@@AA@@@@A@@@A@@AA@@BABA@@@A@@@A@A@@@@@@B@@@B@@AB@@@BA@@B@@@@A@A@@@A@@@@@AB@@@@@D@@@BAB@@@B@@@BABC@@@A@ABA@@@A@@@AB@B@@@B@B@@@B@@@@AB@@@@@@@B@BB@@@@B@@AB@@@@@@@@@B@B@D@@@@@B@B@@@@@B@BB@BB@@@@@@@B@@@B@B@BBB@@BB@@@B@BA@@B@@@@@@@@@@@B@@B@BB@@@@BB@@@B@@@B@B@@@BA@@BA@@B@B@B@B@@@@BB@@B@@BB@@@@B@B@B@B@@@@@@@BB@B@@@@@@B@@@B@@AB@@@B@B@BA@@BA@@B@@@BA@@B@@@BAB@BA@@D@BA@@@@@@@B@@@@@BB@BBB@DB@@BB@@B@DDB@BBBB@@B@B@@BB@@@@B@@@@@@@@A@@BA@@@@@@B@@@@@@@BB@BB@@BBBB@@B@B@B@@@@@@@@@@@@@@@@B@@@@@@@B@@@B@@@@@@@@@BA@@@@@@@@BB@@@BB@@@@@@@B@@B@@@B@@@@@@@@BA@@@ABA@AB@@AB@@A@@@@@@@@B@@@@@@@@AB@@A@@@A@@@@@@@@B@B@@@@@@@@@@@@A@@@AAAA@@@@A@@@@@AB@@@@AB@@@B@@@@@B@@A@@@@@@B@@@@@BA@@B@@@@@@@B@@@@B@@B@@B@@@@BD@B@@@BB@@@@B@@@B@@A@@B@@B@@@@@@@@@B@@@B@@@@@@@@@@@BB@@@@B@B@@@B@@@BB@@@@B@@@B@@BB@@@@BB@@BB@@BB@@@B@B@@AB@@@BA@AB@@@@AB@@A@A@AB@@@@@@@B@@@@@@@BB@@@@@@B@@@@@@@B@@@@B@BB@@@@@@@@@@@@@B@B@B@@@@@@B@B@@B@@B@BB@@BB@@B@@BB@@@@@@@@B@@@@@@@B@@@@@@@@@BAB@@AB@B@@BB@@@@@@@@B@@@@@@@B@B@B@@@@@@B@@BB@B@@@D@@@@BB@@@@@B@@@@@@@B@@@@@B@@@@BBB@B@@BBB@@@D@@@B@@B@@@@@@@B@D@B@B@B@@@BB@@@@@@@B@@B@BB@@B@B@B@BA@@@@@@BBBBB@@B@@@@@B@@@@@DBB@@@B@@@B@@B@@@BB@@@@@@@@@@AB@@@B@@@@BB@@@B@@@@A@@BC@@BAB@@@@A@@@@BB@@@@@A@@BAAA@@@@B@@@@@@@@@B@@@@AB@@@@@@@@@DBB@B@BB@@@@@@@@@F@@@@@B@DBB@@@@@@@BBBB@@@@@@@@@@B@@@B@@@@B@@@@@@BB@@B@@@@@@B@@A@AB@@@@@@@@BBDBD@@B@@@@@@B@H@D@@@@@B@@@BB@@B@@@A@@B@@@BA@@@@@@@AB@@A@@BA@@@@@A@ABA@AB@@@BA@@@@@@@A@@@@B@@@@@B@@@@@@A@@B@@A@AAA@@BA@@@@@@@@@BB@@@@@B@@@@AB@B@@@@@@@@B@@@@B@@@@@@@@@B@B@BAB@@@BA@AB@BA@@@@@@@@@@B@@@D@@@@B@B@@@B@@@@@@@@@@@@@@BB@BDB@@@@@B@@@@@@@B@@B@@@B@@@@@@@BA@ABABA@@@AB@@@@@@@@BBB@@BBBB@@@@@@@B@B@@@BBD@DCB@@@B@@@@@DA@@@A@@BEB@@BB@@@BB@@@@D@@BB@B@B@@@BD@@DA@@@@D@@AB@BCB@@AB@@A@@BA@@@A@AAA@@AA@@G@A@@A@@@ABA@AB@@@@C@@@@BAB@@AB@@A@@@AB@B@B@@ABBD@BAB@@@@@DDDB@@DEBCDCFCDKJEHA@@@@JAFI@EBGBC@A@@@A@@@A@@BC@@BA@@@CBA@@@@BABAB@@A@AD@F@B@@@B@D@@@B@@@B@B@@@@BB@BAB@@A@A@@AAAA@@AB@@C@@AA@@A@@@A@@B@@A@AB@BC@@BAB@B@B@@@B@BAB@@@B@@@BA@A@@B@@@@@DABAB@@BB@B@@@B@BA@@DAB@@@B@ACC@@ABAAABGBA@CC@FEB@@BFCD@@B@BB@BBBBDBDCFADCBCD@BAAABAA@DED@BBB@DA@CDAB@BA@ADA@@BABA@AA@@ABA@AAA@AA@@@AA@@A@BCBC@BD@B@@@B@@@B@B@B@@ABBBAB@DBDA@B@BBB@@@BB@B@@@BAB@@A@@@A@CA@@@@C@CBADCB@B@@BBBBB@@B@DDB@BABDB@D@@@BBBBBBD@DBFDB@@@DBB@B@BAB@@@@C@A@@A@AA@@A@@A@@@@@@@A@@@@BA@@@A@A@A@@@A@@B@D@B@@A@@@AB@B@B@@@B@DB@@BA@@B@@@BBBB@@@@B@@@@B@@@BB@B@B@DA@@BAAA@@@A@@BAB@B@@@@A@@DB@@BABA@A@@@AB@B@@AB@@@B@B@@@B@@@B@@@@A@@B@@@B@B@@A@@B@D@B@@A@@@@@@ACBC@@B@DB@@B@BA@AF@@AD@B@B@@@DC@@@ABACA@AC@A@A@AA@AA@AAA@@A@E@@ACEA@AAADADABCB@BAB@AE@AB@BE@@B@@@B@@AB@BAB@@@B@@@BA@A@@@@@A@@@A@@@A@@BA@@BA@AB@BB@AB@@A@@@A@@B@@@@A@@@AAAB@@@@A@ABA@@B@BBBD@@@@DADA@@D@@@@@BBB@@@DA@BB@@@B@@@@B@@BABADEBC@ACAB@@C@AAABA@@BA@A@A@A@@@A@E@C@AAADA@@D@@@BB@@@BFBB@@@BBHBBADCDCB@B@@@B@@@B@@BL@@AD@BB@A@ADCBCAA@CBAB@BCBAAC@AACACBA@C@CBE@AH@DD@BDABCD@@BFABBBBD@DDD@D@@CDBF@BB@CB@DBBBB@BADBBC@ADAB@@D@@B@B@DA@@B@BA@@@BB@B@B@@@BA@A@@@@AAB@AAB@@@BAB@B@@@@CAA@@@A@@B@BA@@@A@ABA@@@AA@@@BA@@AAAABAAA@@BA@@BA@@BAB@@A@@B@B@@@BA@@AA@@A@@A@@@ABABA@ABABA@@@@@@B@@@BABB@A@@B@@@@AA@@ABAB@@@@A@AB@@A@A@@@@BA@@BA@AB@@@@@@A@@@@@A@A@@B@@A@@BAB@@A@@B@B@BBB@BA@@D@@@@BBBB@@@AF@@DB@@B@@@B@@D@@@@ABBB@B@@@@B@BB@@B@B@B@@@@A@@@A@A@@B@@AB@@@D@B@@BBB@@B@@@@A@@BA@@@@@AB@@@@A@@@@B@@@BB@@F@B@BB@@@AB@@@B@BB@@B@BA@@@BB@@@BA@BBA@B@@BAB@@@@@@@BAB@B@@BBA@@@@@@B@@@BB@@BB@@@BB@BBB@F@@@@A@C@AAGCE@AAA@CB@@@BAFBD@HBB@B@@AB@@A@@@AAC@CA@AAGCAA@@@A@A@ADC@ABA@AAA@A@EBAA@@AAAAAAAA@AAAA@AA@@AC@A@A@A@A@C@AA@AAA@A@A@@AA@A@@AAAAA@@CAA@@@@A@C@@@CBC@A@CA@@AA@@@A@@AA@@AACACAEAA@@A@@@A@A@@@A@@B@D@@@@A@@@@@AAC@@AC@A@C@A@ADCBA@ABAAA@@CEAACCACCBA@@@@B@@AB@@A@A@@@@@CB@@@@AAC@@@A@A@A@A@@@@@AA@A@@@@C@@@C@@@C@A@@@@@@@@A@@A@A@A@@AA@A@@A@@CBA@A@@@@@A@A@@BA@@B@@AB@@@@AAA@A@@AA@@@@@AA@@A@@@A@A@@@AD@@AB@@@BAB@BAD@@@@@B@BA@@AA@@@A@C@A@A@A@AAA@@@@@@A@A@@@A@@A@C@@BA@AA@B@@@B@@A@AB@@A@@@A@A@@@@@A@@@@@A@A@BA@@@@@A@A@@@A@@@A@@@CA@@A@A@A@@A@AB@@@BABAB@AA@@A@@@@AA@@AA@AAAACBAE@A@AIA@A@AAB@@@B@@A@@B@@A@@BA@@A@AA@@@A@AA@A@A@A@A@CAA@@EAA@@A@@AAA@@A@@@@@A@@A@@A@@@@@A@@B@@@@@@A@A@A@A@AACA@@A@@@@@AA@@@A@@B@@@BAAA@@A@@AA@@B@AA@A@@@AA@@@A@@@AA@@@@A@@BA@ABA@AAA@AA@AAB@BC@@@A@AAAB@BA@AB@B@@A@@B@BD@@B@BABA@@@A@@BBB@@@@@B@@ABA@@@@DABC@@@A@A@@@A@@@A@A@@B@@@B@BA@A@@@@@@B@@B@@B@BA@@@A@@@@@AB@@A@A@@@A@A@@B@B@B@@A@@@@@A@A@@@AAA@@B@@AA@@@@A@@@A@@B@@AB@BA@@@A@AB@@A@@AAAA@@@A@@A@@@@A@@@@@A@A@@A@@@@A@@A@A@@@A@@@@@@@@@A@@B@@A@@AEA@@@A@@A@A@@@@@AAAAC@AAAB@A@@A@BA@@AA@@@@A@@@AAC@@AA@@AA@@@@A@@@@@@@AA@@A@A@@@AA@@@A@A@AA@B@@A@@BA@@@A@@@A@@AA@@@@@ABA@A@@@@A@A@@A@@@@@A@@B@@@@AA@@C@@@@AA@@A@A@@A@@@A@@@A@A@AA@@@@A@A@@@A@@@A@@@AA@@A@A@@@C@@@@A@@A@@@A@@@A@A@@@@@A@AA@A@@@@A@A@@B@@@@AA@@A@@@@B@@@@ADABABABA@GBABA@AB@@AB@B@BA@@B@@BB@BA@@BB@@BBA@@@@B@@@@@@B@BAD@@BB@@D@@B@@@B@@A@ABA@A@A@@@A@@@@B@@AA@@AB@@@BA@@@ABA@@@A@@@@B@@@BA@@@A@C@@@A@@@@@@A@@A@AA@A@A@@@A@@@C@@D@@@B@@@B@BA@@B@@@@A@@B@B@@A@@@@CC@@A@BA@AAABA@@@@BA@@@A@@@@@AB@B@@@@A@A@A@@@AA@@@@AAABC@@@@@@@A@@BA@@@A@@@AB@AC@@@A@@B@@A@@@A@AA@@@AA@@@A@@AA@@@@CA@@AA@@A@@@AAA@@A@@@@@AA@@A@A@A@AA@AA@@C@A@A@@@@@@A@AACAA@AAAAC@@EAA@@A@AA@AA@@@AA@AAC@A@@@AA@@@A@@@@A@AAA@@ABA@AB@@@@AAA@A@@@@A@@@@@@A@@@@CA@@A@CACA@@A@@@C@AAA@@AA@@@EBC@@@A@AAA@@BAB@@A@AAA@@@A@@A@@AAAB@@AA@AAA@A@@@@EB@@@@A@GC@@@@@ABA@@AA@@A@@@A@A@@@@A@A@@A@A@CC@@@@CA@@@A@@BA@@@ABA@A@AB@@AB@@@A@@AC@A@@AAAAA@@BABA@@@AAC@@BA@A@@A@@@AA@@A@AA@@AA@AAA@@AA@@@A@@@A@@A@@@C@@@@@@AA@@@@@@@A@@@AA@@AAA@@AA@A@@@AAA@A@@AA@AA@@AA@AA@@@@@@@A@@@@B@BA@AB@@BBAB@@CBA@@@@@A@BB@@B@@@@B@@@BAB@B@@@B@@A@@B@B@@A@@@AA@@@@A@@@A@@@A@@@@A@@@@@@@AA@@@@AA@@@A@AA@@@@AB@@A@ABA@A@@@@@AA@@ABA@@@@@AA@@@@A@@@A@@@AACB@@@@"],encodeOffsets:[[109430,22700]]}},{type:"Feature",id:"451422",properties:{name:"宁明县",cp:[107.076456,22.140192],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AAA@CA@AA@@@AAAA@@@@@AAAAAA@@A@@CAA@A@@A@AA@@A@AA@@@@C@@AAAA@@AA@A@A@@@AA@@AA@@@AAAAAA@AA@A@AA@@AAAA@AA@AAA@@@AB@@@@@@A@A@@@A@@@AAA@AA@A@@BAB@@@BA@@B@B@BABA@@BA@ABC@A@AB@@AA@@AA@@A@@@AA@@@A@@@@@A@@@C@@AA@@AA@@@A@AAA@AAA@A@A@AA@@CAC@@AECABADIHO@QGYI]OCA][_]EOSWGAMBKDMDAH@D@p@PBV@@AN@DMZWbU`U\\ELIPINIHCBC@GAOMQCSCEAGCKMCMGGKGKBQDSHMBOFMHCBCHAF@LCLCDADGFMHMDQBKFIDKHGJIFA@@BIHIJMJKJMHGBGBILGJIDC@K@OBQ@SBOBODKDKHQFKPCJ@H@LDRNRLJB@LHRJLLPJJHDLB@@P@NCPILIH@BMFKFIJIHA@@BKDSBG@IFE@GEEEKSKIMGKCI@IBKDMHMFEFEHENAL@RAJCFEBEDC@DDBB@@@B@@ABA@A@@@AB@@@B@B@B@@@@@@@@@BA@@@@@@B@@@@@@B@@@B@D@DADBB@BBDBD@@@DDDABABAB@BCB@B@@@@AB@@A@@BA@AB@@@B@BA@B@@B@B@@BBBB@@@B@D@BAB@@B@@AB@BB@@@@BB@B@@@D@BA@@BB@@B@ABBB@D@B@BBBB@BAB@@@BB@@BBB@BBBABB@@@@@B@B@BA@@@@@@BBB@B@@@@@B@@@@BBB@FBB@B@@@@@B@@B@B@@A@@DBBBB@B@@@B@@@BBB@@@@AB@B@@@BABABA@@@@BAB@AAB@@A@@@@D@@@B@@A@@@@B@B@@@@@@AB@@@B@@@@ABAB@B@@ABC@@@A@ABA@@B@@@BA@@B@B@B@B@@@BB@@@@B@B@@@@@B@@@B@@B@@@BBBB@@B@@@DBB@@@B@@@B@@A@@@@B@B@@B@BB@@@@@B@@@B@@C@@B@@@@BB@@@ABB@B@@@@@BAB@@@@@B@@@BA@@@@B@@BB@@@@B@@B@B@@@@@BB@@@BBA@@B@@@BB@@B@@@DBBD@@@B@@B@@@B@@B@@@@B@@@B@B@@BBB@BABAB@@@DBB@@@@A@@B@B@@BB@@@B@B@BBB@@BBB@@BABAB@B@@AA@@AC@A@@@AAA@@@@@JAB@@ABA@A@A@A@A@AB@D@BBB@DABAB@B@DAB@BAD@@@D@B@@@BBB@BAB@B@@BB@BABA@@@AB@@A@@BA@@B@@@@A@A@A@A@@AA@@A@BABA@@@@BA@A@A@AA@@A@@@A@@B@@AB@@@@AA@@A@A@@@@@A@@A@@AAAB@@@@A@@A@@@@A@@@@@@B@B@@A@@@@BA@A@A@@@@@@A@@@@@@A@@AA@CB@B@@@@@BBB@B@BAB@@A@@D@@@@@B@@ABAAAB@B@@@@@BD@B@@@B@@@@B@F@@BBB@B@@B@B@@@@A@@@CDA@@BBB@@B@BBBB@F@B@BBB@@@B@B@@@@@@A@@@A@A@@@@BABBB@@AB@BC@AAABA@ABA@@B@BAB@B@B@B@B@B@DABDD@BBB@@D@@B@BB@@D@@BBAB@@BB@B@@DF@B@@B@BB@@BBB@@BBBB@B@B@BBD@@BB@DBDBD@B@@FD@@BAB@BDBB@@B@BBB@BB@@@BB@B@B@BBB@@@@AB@@A@BB@B@@ADA@@B@@@B@@@D@B@BA@@B@B@@@BBB@BA@@BC@ABC@@B@DA@@BCBCB@AA@@BA@A@A@@@@@B@@@@@@BBB@B@@A@@BAB@BB@BBB@@@@@@B@@BB@@B@D@BBB@@B@@BBB@@B@BB@FB@@BDBB@BBBBD@B@B@@@@B@B@D@@@BBB@@B@B@B@BB@@B@@@@@BB@BB@@B@@@BB@@DB@@@@BB@@@B@@BB@@B@@B@B@@@BA@@@@B@@BDA@@B@@@B@@AB@@@B@@@@@@ADBB@B@@B@@B@@@B@B@B@@A@A@@B@@@@@B@@AB@@@@ABBB@BABB@@@DD@@@@@BA@A@@@@B@@A@@@ABA@@@A@@@C@@@@D@@@B@@@B@BBBB@@@@B@@@@B@@@D@B@@@B@@A@@@A@@B@@@B@BA@@B@@A@@BA@@BB@@@A@@B@@@B@B@B@BAB@@@@A@@@AC@@@AA@@BC@A@A@@@@A@@@@@AB@AA@@AB@@AAA@@@AB@@A@ABA@@BAB@BAHAB@BABABABC@@@@@A@@B@@@BB@@@@@AB@B@@@@@@BBBB@@@@@B@B@@@B@@@B@@@@B@@D@@@B@B@@@BB@@B@@@B@@@B@B@@@@@BBB@B@@@B@@@B@@@@B@BB@@B@@D@@@BB@@@@@AB@@@@@B@@@@B@B@@B@B@BA@@@@B@@BB@@@B@@@B@@AB@@@@ABBB@B@@@B@@B@@@B@BB@@B@@@@@@@B@@B@@BB@@BD@BB@@B@@@@@BB@@ABB@@@@BBABBD@BBBB@@@@B@B@@@@B@@FB@BB@@@@AB@@@@@@@@@B@@@B@B@@@@B@@B@@@@B@B@@@@@B@@B@@@@B@@BBBB@@@BA@@B@B@@ABA@@BA@@@@B@@@B@@B@@BA@@@BB@B@@@B@B@@@@@BA@A@A@@@@B@B@@@B@BA@@B@@@@@B@@ABA@@@@AA@@@@@@@@BABA@@@A@@@@B@B@@@B@@@B@B@@ADCB@@@@AB@BA@@@@@A@AA@@@B@@ABABA@@@ACA@@@@BA@A@@BABA@BB@B@B@@ADA@BBB@@BBB@BAB@BAB@@@B@@B@@B@@@B@@BB@@B@B@@B@AB@@BB@@@BBAB@@A@@@@B@@BB@@@@B@@@DBBBB@B@B@B@@@@@@@@AB@@@@@B@@@@BB@@@@@B@@@BB@BB@@@BB@FB@DBB@B@B@B@B@@B@B@@BBB@@@AB@@@BA@@@@BA@@@A@BBB@B@BJB@F@ABBDBB@BBB@@BB@@@@@BB@@BBABA@A@@BAB@@@@B@B@BB@@D@@@B@@@B@@@B@B@@@@ABB@B@@@@@B@@@@@B@B@@@B@@@BAB@@@@A@@@ABBB@@AD@B@@@@B@@@B@B@@@@B@BBB@B@B@D@B@@@B@@BB@@A@A@@@@BC@ABA@A@@BA@@BC@@B@B@@@B@@@BB@@@@B@@BB@B@BB@@@@BA@@@AB@@AB@B@@@@@B@B@DA@@@BB@B@@BB@B@B@@@@B@@@@@@B@D@@@D@@@D@@@@@@BBB@@@@B@B@B@B@@@D@BB@@@@DA@@@@B@B@@@BA@@@A@@B@DA@AAC@A@A@C@OBC@C@ABA@AB@AAA@@AA@@@AA@@@A@@AAA@@@@A@@BA@A@@BA@A@A@C@A@A@ABCB@@AB@@@@@BA@@B@@@B@B@B@@@B@@@B@@@@@@@B@@BB@@@B@@@@BB@@B@@@@B@BB@B@B@@AB@BAB@@AB@@A@@BAB@@@BB@@@@B@BBB@@@@@BBB@@BBDBBBB@@BB@B@B@@@@BAB@BABAB@@A@@@@BABCF@@@B@@B@@@HC@AF@D@@@DBB@BDB@D@@@B@@A@A@G@A@A@CBABCB@BAB@B@BB@@BD@BBBBD@@@BB@DD@@B@BBH@D@B@F@FAFAJCBCBABCBCBC@A@C@CBC@ABA@A@AA@@@HEDA@@@AB@DBJDDBDBHDBBADDDFBB@BB@@BBD@B@B@@@@AB@BCB@B@B@@@@@@DB@BB@@@A@@@@@A@@@@@@B@@@@@B@@A@AB@B@B@@@B@@@@@A@@@@A@@@@@@B@@@B@@@@@@@@A@C@@B@@@@@B@@@@@@@BAB@B@@@@ABB@A@@@@@@@AA@A@@@A@@A@@AA@BA@A@@@@@BA@A@A@@@A@@BA@ADCBA@@@A@@A@A@@@@A@@@A@A@@@@A@A@@@@A@A@@@A@@@@@A@@@@@A@@BB@@B@@@@A@@@@AA@@@A@@@A@@@@AA@@A@@A@@@AB@@A@@A@A@A@@AA@@AAA@@@AA@@@@@@@@@A@@@@@@@@@A@@B@@@@@@ABA@@A@@@@A@@A@@AA@AA@@A@@B@@AAAA@@A@@ABA@@A@@A@AA@@@@@A@A@@A@C@A@A@@AA@@A@@@A@@A@@@@A@@BA@A@C@A@@@ABA@@A@@@@A@A@@B@@A@@AA@@B@@@BA@@B@@@BA@@B@@CBA@@@@AA@A@A@@A@AAA@@AAAA@@@A@@AB@@A@@@A@@@@A@C@CAA@A@@@@BA@@@CBABA@@AAA@A@@A@@@@AA@@A@@@AAA@@BA@@@@@@@@B@@@@AA@@@@@@@AA@@BA@@@@B@@@@@@@@A@@@@B@@A@AAA@@@@B@@@@ABAA@BCAAB@@ABA@ABABACEBA@CAA@A@@A@@@@A@A@@@@@AA@@@ABA@@@A@@AA@BC@@@@AAA@@@@AA@@@@A@AAADCAC@AAADGH@N@JBLBHAD@BB@BBFB@@@B@@AB@@@BAAA@@DEDA@ABAB@BBB@BAB@B@D@@@B@B@@B@@@B@@B@@B@@BBB@BB@@BA@@@C@@@@BADABA@@B@@@B@@@@@@AB@B@B@@@@AB@@AB@@@@@B@BA@ABAH@AB@BB@@B@@A@ABAB@BABB@@@@@B@@AB@@A@@BADABABA@@@@B@B@@@@@BADA@@B@BCBA@A@A@@B@@@B@B@@AB@@A@@B@@A@@@@B@@@@A@A@@@@AA@@@A@@@@AA@@@@@@@EB@AABADA@A@@ACA@@A@A@A@A@C@AAC@A@AB@B@BAD@B@D@DAB@BA@@B@B@@BBB@@BD@@B@BBB@B@@@@@B@BBB@B@@AB@B@B@@@B@B@B@B@@@B@@@BB@@@@@BBB@@BBBAB@@@BA@A@@D@B@FBBB@@BBBDDB@BDBBBBDB@@@BB@@B@@AD@B@BCB@@ABAAA@@BA@EB@DABBBBDABAB@@@BA@@B@@BB@@AB@@@B@B@@@@@@@B@@B@@@B@@AB@@@B@@@B@@B@@@@@B@@@@@B@@@B@@@@@B@@@@AB@B@B@B@B@@B@@@@B@@@@B@@@B@@@@@@@BAD@@@@B@@B@@@@@@@B@@@@BB@@B@@@@BA@BB@B@@@B@BBB@@@@@@@@BB@@@@B@@@@@B@@@B@@@@@B@B@@D@@@@AB@@@B@@B@@@B@@@@@B@@@B@B@@A@@BAB@B@@BBA@@BB@@@@B@@@@BB@@@@@@ABB@@@BB@B@DBBBJ@F@F@B@BB@BBA@@@ABAFBB@DBBBBA@@B@BBB@B@BA@ABCDAFCFAJ@H@FDD@HADCDABA@@@@BB@@@B@B@@@@@BBB@BAB@BB@BB@@@@@B@@B@@B@@@B@BBBADB@@B@@ABBBA@A@A@@@@BB@@@@@@B@BB@B@@A@@B@B@@@@@@@@A@@@AB@@@@BB@B@BB@@@@B@@@@@B@@@@@@A@@@@@@@A@A@A@A@A@A@@@@B@@BB@B@@@B@@AA@@A@A@A@A@@@A@@@@BA@@AAAA@CBA@AA@ECDA@CA@CAAAAABC@A@A@@@AB@@@@A@A@@BA@@@@BA@AB@@@@A@ABA@AA@AAA@@@@A@AA@CC@@@AA@@A@AAA@@AA@A@@BE@AB@@A@@@A@AF@@@B@BBB@@@@@BADC@@B@D@@@@@@@BD@@B@BB@@BB@@DDB@B@B@B@DAD@DAFB@@BB@@@@BA@AB@@@BBB@B@BA@BB@B@B@B@@@DA@@B@B@@@@@BB@@BB@@DAB@@@B@@B@@B@@BBB@BBB@B@D@B@B@@@B@@@D@@@@BB@@@@@B@DBBBB@BB@@BAB@@@D@@@B@@BDB@@BBB@BBBBB@@BD@@BBBBBBBB@B@@BBB@@@BB@@B@BBF@DCBAAC@AJ@BAB@B@B@@A@@DAFCBABA@@@@BC@@BABAB@@@D@HAB@B@B@BBBB@BB@@@B@@BD@B@@B@@@@BBB@@@B@@@@@B@@@D@B@B@B@DCAA@A@A@@BA@AB@@@AA@AB@BC@AB@@AA@@A@@@ABAA@BA@@@ABAAA@@BA@A@@BA@A@@@AB@@AA@@A@C@@@@B@@AAAB@BA@AA@@@BA@@@@BC@@@@@AB@@@@@@A@ABA@@@A@@@A@A@ABAB@@A@A@ABA@A@A@A@@AA@A@@@ADA@A@@@A@@@@B@BA@@BA@@B@B@@AB@@AB@@@AAB@BB@@@A@@@A@@B@@A@@@@@@@@@AB@BB@@@@B@@B@@@A@@@@BA@@@@@@DD@@BBDBD@FA@@BABA@@BGAA@@@@A@@A@A@ACEG@C@@@A@AA@@@@@AA@@@@@@A@@@@@A@@A@@@@@@@@@@A@@@@@@@@@A@@@@@@@AB@@@@A@A@@@@BAB@BC@@BE@@@A@C@AAA@A@@@@FEDCFEFC@@B@@@B@FDB@@BDA@@BA@@BAAAAACCCAACACACCI@@AAAAAA@@A@A@GBC@A@@@CKACDADA@@@AA@AAA@C@EFA@A@A@@@@@AA@C@CBABADCDGDCAE@A@@AA@AA@A@CB@BAB@D@B@B@@A@CA@@A@A@EAC@@@CACAAA@AFEFE@A@@CCGACAA@@@@BC@C@@@AACE@A@A@ABE@AAA@@CCA@CACAA@@A@@@ADE@@@AAAA@A@A@A@CAA@AAA@@A@@CAA@@@@@AAAABC@E@CAA@@A@@@CD@@@@CA@A@A@A@A@AAA@@A@AACACAAAA@AC@@AEAAA@@@C@@AC@A@@@@A@@BCDADABA@@@@@AAAA@A@CAA@GAA@BA@A@@FABCBA@@@AA@CACCA@AA@@AC@ABCBABA@@@A@C@CBADAFCB@BAACAAK@@@ABCBAAA@AGAC@A@AA@@AC@@BED@B@@CBA@A@A@@@@AAA@A@ABCBA@@BC@A@@A@@AEE@@AA@@AC@@@ABA@@BABAB@@ABAB@@A@@@@@AEE@@@AA@@A@@A@ABECA@AAAA@@@C@AA@@AA@@ACE@A@CAAAC@A@@@AB@@ADE@@@@BB@@@@B@B@@@B@@@@@FA@@@@@@@@BA@@@@BA@A@@B@@@@@@@@@B@@@@@@@@@@@@AB@@AB@B@@@@@@@@A@@BA@@@@@C@@@@@@@@BA@@@@BA@@A@@@@AB@@@@A@@AA@@A@@@@@B@B@BA@@BAB@BA@@@@D@@@BA@AB@@A@AAA@A@@AAA@@@BA@A@@@@@AB@AABA@A@@@ABA@@BA@@@@BA@@@A@@@A@A@AAA@@AAA@AAAACA@AA@ACEA@@AAAC@A@@B@B@E@AAA@AA@@@A@A@A@@A@@AA@@A@@BA@@@@@AB@B@@A@A@A@A@@A@A@@A@@@A@@A@A@@A@@@ABAAA@AA@@AAAAA@@@ABA@@AA@C@A@@BABA@@D@@@D@@@D@B@B@BA@@@A@A@@B@BABAB@@AB@@@@AB@@@D@@@B@B@@ADA@A@@@A@@@@AAAA@A@AAC@@A@A@AA@@AA@@A@@@AA@@A@AA@@@AACACA@@A@AA@AAA@@@@@@@@A@AAA@A@@AAA@@CABAA@@A@@A@A@@@A@@@@@CB@BE@@BA@@@A@@@A@@B@@A@@B@@E@A@@BA@A@@@AAA@@AA@@@ABCDABCB@@A@@A@@@@@@A@A@@B@AAB@@@B@@A@@AA@@BC@C@CB@@A@A@@@@@AC@@@@AA@@@A@@@A@CA@@@@@@@AA@A@A@AA@@AB@@@@AA@@CB@@@@AACA@@@@AC@A@@@CBABA@@A@A@@AA@@AAAAA@@A@A@"],encodeOffsets:[[110117,22230]]}},{type:"Feature",id:"451481",properties:{name:"凭祥市",cp:[106.766293,22.094484],childNum:1},geometry:{type:"Polygon",coordinates:["@@BHADABIBAJANCFAFDJJJDFBBLJLH@@HJFJ@TCPARBD@BNLBHDHBJAH@FCRCFCFCFOREPETCNABAB@@@B@@@@DBBB@B@@B@B@BB@@B@@B@@@B@B@@@@BD@B@B@B@B@BBD@@BBDBB@B@DAB@@BB@@@@@@B@@@B@@@@@@@@@B@@@@@B@@@@AB@@@@@BA@AB@B@@A@CB@@@@AA@@@A@@A@@@A@A@@@@@A@@@AA@@@@A@A@@@@@AB@@@@@B@B@@A@@@@@A@@@C@@@@@A@@@A@@@@BAD@@@@A@@@@BA@@B@@A@A@A@@BA@A@@@@B@@@B@B@BBB@@@@A@@BA@@@A@@B@@@D@BA@CBABABAD@B@@B@BBD@DB@BBBDH@@@@@BBB@BBF@D@B@@CD@@@@@B@@BAD@BA@@B@B@B@BA@@B@DBFBDBB@DDBDBDAH@B@DBB@@D@B@@@@B@D@@@BA@@@A@A@@BA@@B@@@D@@ADAB@@@B@@@B@BAB@@@@C@@@@@@@@B@@BB@BBB@D@@AB@@@BA@A@AB@@@@BB@D@B@@ABABAD@B@DBF@BABABAB@@ABAB@BAB@B@B@@@@@@@BB@@D@@B@BB@BB@BBB@@B@@B@BBB@@@B@@@B@@A@@DC@BB@B@B@@@BAB@@AB@@CD@BC@@BABAB@@AJ@B@B@B@BAB@B@@A@@@@@A@@BA@@@A@C@A@@@A@@BAAA@AA@@A@@@A@@BA@AB@BA@AAA@A@AB@@@BA@A@@FABABCBABA@@@@BA@@BA@@@A@@@A@A@@A@@@AA@AAA@A@A@AA@BA@@@ABB@@BA@@@AB@@@@A@@A@AA@@@A@@A@@AAAA@@@@@@ABAAA@A@@BABA@@@A@AB@@@FAD@B@DABA@ABCBEBABA@AD@B@B@BB@@@@BABBBDDBB@BDB@BA@A@ABA@AD@@@BA@@@C@@@ABADCB@DABDBBBBB@DAB@@@FBDDDAB@B@D@@CBAB@BC@BBBB@DAB@BBB@B@@@D@@@BBF@@@@ABAAABA@@@A@A@@@A@CAA@@@@BAAC@AA@A@@CC@@AAAAA@@AC@@A@ABA@@B@@A@A@A@@A@@A@AB@@AAA@A@AAA@@@@CC@@A@C@@BE@A@ABAHD@AB@@AB@BCB@@@DDB@BBFABB@@B@BA@@@ACC@C@@@ABA@AB@B@BBBB@@B@BBB@@@B@@@B@B@@@BA@ABABA@@BABA@@B@D@BABBBBBABA@@BA@@@@B@DB@AB@B@B@B@BCB@AB@BF@B@DBB@BDFDF@BADBBADFBFBB@BDDFB@BB@@B@B@@@BDD@@B@@@B@BB@@@@@@DABB@@B@@@B@@@@@BB@@@@B@BA@@BB@@@@B@B@BAB@@@BA@@@@BBB@@@B@@B@@B@@B@@@@@@@B@@B@@@B@@@B@@@@@BB@@B@@@@A@AB@@@@A@@@ABA@A@@@A@@A@@@AAB@@@@@B@DA@@BAAA@@BAB@@A@A@@B@@@@@@@B@@BBB@@BBB@@BB@B@BB@@B@B@@BB@BB@@BB@@B@@@@@@@B@@B@@@@D@@@B@@@@B@@@B@@BB@@BB@BBB@@BBB@@@BB@@B@@@@BAB@@BD@B@@ABAB@@BBBB@BB@D@@BB@@@A@@BA@@B@BAB@B@@AB@@@B@@DB@@@@DDB@B@@@@B@B@@B@B@@@B@@@BB@@AB@B@@@@HDB@@@@@FA@@@@@BBB@BBB@@BABB@@@BB@@@B@BBB@@@BA@AB@BBB@@@D@FA@@B@@BB@BBD@@@B@@@DBDBB@@@DB@@@@@B@@@@B@B@B@@AB@@BDADA@AB@@C@AD@BAD@@AB@@AAA@A@@@A@AB@@A@A@C@@@A@@@AB@BC@@@AAAB@@@BA@@@@AA@A@A@AAA@@A@@AAA@A@AA@CA@ABA@AC@@EA@C@CACAA@@AC@AAA@A@A@AA@AA@AA@@AAA@@@@ACE@@@AAA@@BAAA@@@CA@@A@AC@@@AA@ACCBA@C@A@A@A@A@ABA@A@AB@BAB@BABBD@@ABA@@AABA@A@@B@B@@@B@@@@@@@@A@A@@AA@A@A@EAAAAA@@@AA@AB@DC@@B@@@@@@A@AA@A@AA@@@E@A@@A@@@A@C@@A@@@@@ABABBBA@@@A@@@@@CB@@@BA@A@AAA@A@@@@@ADAB@@BB@@@@@@@@B@@@@B@B@B@@A@@B@@@@A@A@@@@B@@@@@@BB@@@@@BABB@@@BB@@@@@B@B@@@BB@@@@BA@@@AB@@@B@@@BBB@B@B@@A@@B@BA@A@BB@@BB@B@B@B@@@@@@AB@@AB@@@BA@@B@BA@AAA@@@ABA@AAA@A@@@A@C@@BC@ABA@C@ABABA@CAA@A@CBAB@B@B@B@B@BA@@BA@I@@@@BB@B@@@BBD@@BB@@@ABABA@AA@AA@@AA@A@A@A@@AA@@@A@AB@@@@@AA@C@@BABA@AAA@AA@A@@@A@@@@@@AA@@@A@@@@A@@ACCA@@A@@@AA@@A@@@AB@A@@AA@@@@A@A@@@@A@@A@@AA@@@@@AB@@A@@@@@A@AB@@@@A@A@BA@@A@@A@@A@@@@DA@@@A@@@@@A@@A@AA@A@@@@@@BA@@@A@@@A@CA@@A@@@AAAA@@A@@@@A@@@A@@@@@A@A@@A@@A@@@A@A@A@AB@@A@@@AB@BAB@@@D@BA@@@ABABA@@@@@A@@BA@@@@@@@A@A@@B@@@@A@@@C@@B@@@BA@BBA@A@@B@BABA@A@@@ABA@@@@AA@A@@@A@@@AAAAA@CB@@@@A@AA@@@@@A@A@EAA@AA@@@@@A@@@@@AAA@A@@@@B@@A@A@A@@@@AAABAAA@AA@@AA@@A@ABA@AA@A@A@CAABAA@@@AA@@ABC@@@A@A@@A@@A@@ABA@@@AA@ABC@A@@@A@AA@AA@A@@@@AABA@@@A@@BAB@@@BA@@B@@A@A@ADA@ABABCBCC@@C@CAAAA@CACBC@A@@@A@@@@@@@@A@@@@B@@A@@@@@@@@@A@A@A@@BA@@B@B@BA@@@A@@AACCABGBK@SFODODSJODI@MASC@@MIKCCA]BOFGFCFILEN@BCLIFIBKBQDUBGBIAIAAACCCIAGGWEGEIAACAACMGCAKGAAEGCAEAA@@BABA@A@AFCF@D"],encodeOffsets:[[109253,22497]]}},{type:"Feature",id:"451425",properties:{name:"天等县",cp:[107.143432,23.081394],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@C@A@@AABA@A@@B@B@@@B@B@@@BA@@DA@@AA@A@@@@BA@@BA@A@@B@@@@AB@@A@@@A@A@A@@@C@A@C@A@ADC@@AA@@A@AAA@@AA@@@@AA@@A@@@AAA@@A@@AAAAAA@@AAA@@@ABA@@@@A@CBADC@@BCBGD@BCBC@A@ICA@CFC@@@A@@@@B@BA@A@AAA@@@A@A@A@E@@AA@BABAAC@@@A@AA@AACBA@@@AA@@C@CBADA@@AA@A@@@@A@A@A@ACBA@@@AAACC@A@AACA@ABADABABA@ACAC@AA@AAA@A@@AA@ACC@A@CDAB@B@DA@A@CE@E@A@C@AD@BCBA@@@@DAD@B@@A@@BA@@@AA@@A@A@@@AB@@C@A@@@@@@AAA@CCAAAE@ABCAA@CAABC@ABAABABAAAAEC@@@@CA@A@AAC@CBABABA@@ACAA@GE@A@CA@CBA@@DA@CAA@ACAACBA@ABC@@@@BAFCA@FGB@@AA@ABCAAECA@@AC@EB@B@@@@EDGB@@A@@@ABA@ABC@ABA@@B@BBBAB@B@B@@A@@@@@A@A@@BAACBA@@@A@ABA@A@@@ABA@AB@@A@@@C@AD@@@B@@ADAD@@@BBB@@@BB@@D@B@@@FCBA@@@AA@@C@@BABA@@@@@@B@B@@B@@B@B@@B@BB@@B@@@@BA@@BAB@@@B@BAB@@AB@BC@@BA@@BABA@@@A@@B@@B@@@BBBBB@@DB@AB@B@@A@AB@@@@ABBB@@@BABA@@@@@AA@@A@@@A@@@EF@AC@@A@@AAC@@@A@ABA@@B@@AB@@B@BB@@@@@B@B@@DDB@BDBB@@@B@@@@A@@BAB@@BB@@AB@@ADA@AAA@CB@@A@AA@@A@AC@@@@A@@AABA@@@@@AAAAA@GA@@AHBB@FAB@BBB@DIA@BAF@BABDBAB@D@B@B@D@FHD@DAF@BB@@B@@BB@@B@@@D@@@B@DB@@DB@@DBBB@BBBB@@BB@@BBB@D@B@@B@B@@BB@JCB@@@@@ABB@@@@B@@B@B@@@B@@@B@@@@BB@@@@@@BBABB@@@@@B@@@B@@B@@BB@@@B@@@BBB@B@@@@@@@@BDB@@B@@@B@@@@@AFBBAH@@@@B@@BB@B@@@B@@@B@B@BBB@@A@@BA@@@AB@B@@A@@B@BB@@BBB@@@@@B@@BB@DBBBBB@B@@@B@@@B@B@BAB@B@@@B@@BB@@@BADBDGBCDB@D@F@D@@DD@BDCDCBCBABADCBEACCA@A@BBA@@F@BDDABBBA@@B@BB@@B@@@@BBB@@@B@@@BB@@A@A@@BA@@@A@@B@B@@@@@BA@@@@@@@@BAB@@AB@@A@@@@@A@@@ABA@C@A@A@@AABECAD@BBBADCJHDBDCFAD@BAB@@A@AA@@A@AB@@BBDDB@@BAB@@AB@B@@@@AB@@A@AB@@ABAB@B@B@@@@AB@B@@BB@@@@@@@BA@@@A@@B@@B@BB@@@@@B@BAD@B@@@@A@A@A@A@@@@@@@A@AB@@@@A@@@@@A@@A@@@@@AB@@@@A@@@A@@@@A@BA@@A@A@@@AAAA@@A@@@@@A@@@A@@BA@ABA@@@@BA@AD@@@@@@BB@@B@@@@B@@@@B@B@@@@@@B@@@@@@@BB@@BB@B@@@B@@BB@BB@@@BA@@B@@@@@@BB@@@@B@@@@@@BA@@@@@@@@@@BBB@@@@@B@@@B@B@B@B@@@B@@@@BB@@@B@@BB@@BB@@BBB@@@@@@BA@@@@D@@@D@@ABAB@B@D@BAB@@A@@BA@@AA@@@AB@AAA@@@@AAA@A@@A@@A@@AA@A@@@AAA@AAAA@@A@AAAAACCC@AA@@AA@@A@@@@AAABAA@@A@@A@@@AC@@@AAA@@@C@@@@B@@CB@@@BA@@BA@AB@@AA@@A@@BA@@B@@@@A@A@@B@@@D@@@@@@@B@BA@@@@@A@@@@@A@@@A@@@CA@@A@A@@@AB@@@@@B@@@@A@@@@AA@@AA@@@AAB@@A@@@A@@@@@@A@@@@@AA@@@@@@@@@@@A@@AA@@@@@AA@@@@A@@BA@@A@@A@AA@C@A@@@AAA@@@A@AA@@@BAB@@A@A@@@CB@@A@A@ABA@@@AA@@ABABAB@B@B@@@@@@@BB@AB@@@BA@DB@B@@@@AB@B@@@BAD@@B@@BB@BBBB@@@B@B@@B@@BB@@@B@@@BB@@BB@@@B@@B@@BB@BA@@B@@BB@@DBB@B@@@@@@@@ABAB@@@@BB@B@@AB@@A@EDAAABA@A@A@AB@@A@AA@BA@@BB@@B@B@BBBBB@@BB@BA@@@@@@B@B@@B@@B@B@BA@@B@B@@A@@B@BA@@BBB@@BB@@@@@B@B@@@@@@B@@@@BBB@@B@B@@@@@B@@@BB@@@@@B@@@@B@B@@@B@@@BBD@@@@@@ABBB@@BA@AB@@DBB@BD@@BBBBBB@@@BB@@@@B@@B@@@@B@@BB@BBB@@B@@@BB@@@B@BA@ABA@@B@B@BA@@B@B@@CDBBBD@BC@ABCB@@EB@B@B@@BB@BA@A@@@C@A@@BA@A@@ACAA@@BA@@@A@A@@@@B@@@@BB@@@@B@@BB@B@B@@@@@@@@BBB@@B@BBB@B@B@B@@BB@@@@@B@@@BBBB@@@BB@B@@@@AB@@@BA@@@@BB@@BB@@B@@@BAB@@@BA@@D@@@B@@ABA@AB@@@@@@@BBBB@@DD@B@@@B@@@@AB@@@@@@BBB@BBB@@B@@B@@@B@@@@BBB@@B@@@@BB@@B@@BB@@@B@BB@ABBB@@@@B@BBB@BA@@BA@@@AB@@@@A@AB@B@BB@BB@@BB@BAB@@@B@BABC@@@@@B@@B@@BBBB@BBBA@@DABA@@B@@@B@@B@BB@@@B@@@B@@BB@@@BB@@@@BBB@BB@BB@BB@BB@@@B@@@B@D@@@B@FBBBBBBB@@@BB@B@@@BA@@@BB@@B@@BB@@B@@@BB@BB@@@@B@@BD@@B@@@BBBB@BB@BB@B@BB@BB@@@BBB@@FA@@@@@BA@@BB@@BB@@@B@@@B@B@@@BAB@@@@BB@@@BB@@@@B@@@B@@BB@@@@@BA@AB@@A@@@ABA@BDA@@@@@@B@B@@ABBBA@@@AD@B@B@@@B@@@@@BB@@@B@@D@@@@B@B@@BBBBB@B@B@@B@B@@@BAB@@BB@@@B@@@B@@B@@B@@@BB@@B@@B@B@BB@@@@BBB@B@@@@B@@@@@B@@B@B@B@B@@@@B@@@B@@A@@BA@@@@BAD@@A@@B@@@@@B@@B@BB@@B@@@B@BB@@B@@@@B@@@B@@@@ABA@@@@@CB@@BB@BAB@@@@@B@@A@@@A@@@ABA@@@@B@BA@@@@B@B@@@B@@@BB@@@B@@A@@@@@A@@B@B@@@@B@B@@@BB@@B@BBB@@@B@@@@BBB@BA@@@@B@@@@BB@@BD@@BB@BB@@BB@@B@@AB@@@BBB@@BB@@@BB@B@@@BB@AB@@@@CAA@@B@BABA@@@A@@@AB@@AB@B@B@@BBBB@@BB@BB@@D@@@@B@@@B@@B@B@@B@@AB@B@@@BBB@B@B@@@@B@@@B@@@@BBB@B@BB@@@BA@@BA@C@AD@@AB@BABAB@@@@BBDB@BB@@@B@@@@A@@BC@@@AB@BAB@@BD@@BB@BB@B@@BBBABA@@@A@@@CB@@AD@@@DB@@@AB@@@@@B@B@B@@@B@@@B@@@B@@@B@@BB@@B@@B@BA@@@@F@@@AA@A@@BAB@DA@@@@@B@@BBB@B@@@@@B@B@B@@B@@BA@@@@@A@@B@BB@@B@@@B@B@@@B@@AB@B@@@@@BA@@B@B@@@B@@@@@B@@B@@AB@@BDBBB@BB@B@@B@B@BA@@B@@@BB@B@@BB@@@D@@@B@BDB@@@A@@B@@@@@B@@@@@B@@@BA@@@@BA@@@ABAB@@@BBBB@@B@@AB@@@BA@AB@@@@A@@B@@A@@@@B@@B@@DAB@@A@@BB@@BB@@@A@@B@AABAB@@A@@BBBBB@BADDBAB@B@BB@B@@BBB@@@BA@@@@D@@AB@@@@C@AA@@@A@@@@@@A@@BA@@A@@B@@AA@@@@@@@@@BA@@A@@@@A@@@@@CEAA@@@@AA@@@@AA@@AABA@@B@B@@@B@@AB@B@@@@A@@@AB@@@B@BAB@DABA@@AC@@BA@AAA@A@A@AA@A@@@A@@@A@AC@AB@@@BA@CBA@@D@BB@@F@B@BBB@DB@@@@@B@@@BB@B@BA@@DB@DB@B@BA@@D@B@B@@@BB@@BADADABA@@B@BAB@D@@@BB@B@@@@DBB@@BBDD@BBD@DA@BB@DB@@D@@@B@@@B@@@@BB@B@BBB@@AB@@@BB@@B@B@@@BBB@FBDB@@BAB@BB@@@A@@BA@@@@BCBAB@BBFB@@B@BBB@B@BBDBBB@BD@BB@@BBB@@B@BBDA@@B@@@B@BB@@B@B@@B@BBB@@@@D@B@@BBB@@@BBB@B@B@@@B@DA@@B@B@B@B@BBB@@@@B@@@@B@B@B@@@@@BB@@BBB@BB@@@B@B@@@BBB@@@@B@BBB@@@@@B@D@B@@@B@@@B@B@@BBB@@BD@@BBD@@@@BA@@@BBD@BBBB@BB@@@BB@@@B@@@B@B@@@BB@@B@B@@@@BB@@@@BBDABBB@BBBBB@D@B@@AB@B@@@B@@@B@@AB@BB@@DB@@B@D@B@B@BA@CB@@A@A@A@AA@@A@C@@@A@@AC@@@@@@B@@AB@BAB@@@B@@A@A@AB@D@B@BAB@B@BB@@B@@AB@@@B@@@B@@@B@B@@BBB@@B@@B@@BB@@B@BBB@BBB@@@B@@@BE@A@@B@@@D@@@BAB@B@@@BB@@BBB@@@B@BBB@B@@@B@@DD@DFB@@B@@@BB@@@B@@@BBB@@@@BA@@D@BABB@@B@@@D@BBBB@@BB@BA@@@@B@DBB@@@@@@DBBB@B@AD@B@@B@B@B@@@BB@BB@@@B@B@BB@@D@@ABB@AB@@@@@BBBB@@B@@@BA@@B@@@@A@@B@B@B@@@BB@BB@@@@@BA@@D@@@@@BBBBBB@B@@B@BBB@BB@@D@@@@@AB@@@BBB@@BAB@@@@C@A@A@@@AB@@@B@AA@CBA@@BA@@@@AAAAA@BA@A@@D@B@@A@AB@@@BAB@@@BAB@B@@@@@B@@BB@@BDB@@BBB@B@BB@BB@DC@@B@@@@A@A@@BA@A@@BAA@A@@AB@@@B@B@@@B@@B@BB@@@DBDBB@@@@@B@B@DBBAB@@AB@@@@@BA@AB@@@B@@@B@@AD@@@@A@@@@@@AAB@A@@AA@@@BA@CB@BABA@@B@@AB@@A@BB@B@@B@@@BB@BAB@@@B@@@@A@@@A@AB@BA@A@@BA@@@A@A@@@A@@AA@A@A@A@@@C@@A@@@A@@A@A@AA@@@A@@@A@AAB@BA@A@ADBBA@A@@@A@@AAAA@@A@@@@A@A@@@A@AB@AA@@BA@@@C@@BA@@@A@AB@B@BA@@BA@@@A@AB@@@B@BA@@@CB@B@B@@@B@B@@@BA@A@A@AAA@ABABA@@@AA@@A@A@AA@@A@A@@@ABA@@B@B@B@@@BB@@@@DA@@@@D@@@@B@@B@@@@BB@BB@@@AB@B@BA@@B@B@B@@AB@B@@@@@AA@@AA@@@A@AB@@@@A@A@@AA@@A@@AA@@@@@AA@@A@@@A@@ACA@@BC@A@@AA@@@ABA@C@@B@B@BA@@@A@@BAD@@@@@@AB@@@@A@@DC@@@A@AAAA@@AABA@@@A@AA@@AAA@A@AA@A@A@AB@@A@AA@AAAA@AB@BA@A@A@@A@AA@@@A@@@AB@@A@A@@@G@C@@A@@@ACA@@@@@A@@@AA@A@@A@@@A@C@@@AA@A@A@C@@DA@@B@BA@A@@A@@A@@@A@A@@@@BA@A@@AA@@AA@@@AA@@ACA@@AB@@@B@@@BA@BB@B@BCAEBAAC@@A@@@@A@C@@@@@AA@A@@@C@@C@CA@A@@C@@@A@AA@@B@@A@@B@@CBAB@@AB@@@B@B@@A@@@A@@@A@A@A@@@@@A@@AA@@@@@CA@@AAA@ACA@ADEBCBADAB@@ABA@@@A@@@C@A@@A@A@AAA@C@C@@@@@BA@@BA@A@@B@B@@@B@BC@@@BB@B@@@B@@@B@@A@@B@@AB@@@@@@@@A@@BA@AB@@@A@ACA@CA@BA@AB@@C@@@A@AA@@A@A@CBAA@B@BA@AA@@A@A@AAA@A@A@@A@GA@@A@@@A@AA@@A@@@A@@A@@@A@A@@@A@@@@@AC@@@A@@A@@A@A@A@@@@B@B@D@B@@AB@@@ACAA@@B@@AB@B@B@@@B@@@D@D@AA@AA@@C@@@@BA@@D@@@B@@@B@BAD@B@B@BB@A@A@@@A@@@ABA@C@@@@@A@@BB@@@@B@BAB@F@@@@ABABA@@BABAD@@A@@@A@@@AA@AA@@@@@AAA@A@@BAB@B@B@@B@BB@@@@@@A@AB@@@BAD@DA@@B@@B@@DB@@@AB@@@@A@A@@@AB@B@BB@@B@@@B@BA@@B@B@BA@B@@B@B@BBBA@@B@D@@@BA@A@@@@@@AAA@@AAAA@A@@AA@CEA@A@A@@@A@A@@A@AAA@@@CCA@@@@AAA@A@AD@@C@AA@@A@@AA@@@AA@@@AA@@@ABEDA@AC@@A@CA@@CBA@@AA@@@@B@B@@@B@D@@A@CB@@C@@@A@@A@AAA@@@AA@@A@@A@C@AA@@AA@@@@@@@@@A@@AA@@AA@A@@AA@@A@@A@AAA@@@@A@A@A@@@A@A@ABA@@@@@A@@@CBA@@B@B@@A@@AA@A@@B@@@B@@G@@@A@A@@A@@BC@A@@A@AAA@AA@@AA@@A@A@A@@@CC@A@@A@A@@A@@@AA@@A@@@@A@A@ABA@@BA@ABA@@@A@CB@@A@@@EBA@AAA@@@@@@A@AA@A@@A@@@A@@@@A@C@@AA@@@CBA@AC@@ACCAAA@A@CB@@@BBJA@ABA@@@@B@BCB@BCCAA@@AABA@AAA@ABC@A@C@AAAA@@AAAACBCA@@AAA@C@@@@A@AA@@ACA@@A@A@A@A@@@@ABA@AA@AAAA@@BA@@B@@AB@@AB@@@@@@@AAAA@@@A@A@@A@EAA@A@AA@A@A@G@@BBB@DBBA@@BBB@DA@A@@BAB@@@BABC@@@ABADAB@@@@C@@BAB@@@BBB@@BB@@AB@B@@@@@BBBA@@B@@@@CB@AA@AB@BA@CA@A@AC@@@AA@A@@AA@AA@AC@A@AA@@@A@@@A@@BAAACACBCB@@A@AA@A@@CAAE@@BA@A@@@AA@@@@@@AA@@BABA@AAABA@@@AB@A@@AAA@@AAB@AA@@BA@@@EB@@ABA@@@@@@B@B@BA@A@@@A@@BA@@B@@@@AAA@@@A@A@@@AB@@A@@@@B@BBB@@@@@@AB@@@@@B@@A@@@@B@B@@@BA@@@AB@@C@@@A@@@A@A@@B@@AA@A@A@@A@A@@@A@@@@@@@@A@A@@@@A@CA@@AA@@@@A@A@@@CAAAAA@A@@AA@@AB@@@@AB@BA@@@AAAA@@@AC@A@A@A@@@ABAAC@A@CAAB@@A@A@A@A@ABC@A@A@@@A@@@AA@@AAA@@@A@A@A@@@@@AA@A@A@AA@@AA@A@CA@@@@BA@@AA@A@A@@@@A@@@@@A@@A@@A@@A@@A@@@@@@A@AAA@BA@AA@@ABA@@@@@ABC@A@@@@@@@AD@@@@ABA@@@ABA@@@A@@@AA@BA@@A@A@@@@A@@@A@@@@A@A@@@AA@@@ABA@@@BC@A@A@@A@@C@AA@AA@@@@A@A@@@AAA@@@@@@A@@BAAA@AB@@@@@AA@@@AB@@AB@@@@@@A@@B@B@BB@@D@@ABBD@B@@BB@BAB@@@@@B@B@B@BA@@B@@BBA@B@@B@@A@AB@AC@C@@@ABE@@AA@AAA@@BA@AB@@@@AA@BA@@@AE@A@@A@@@AAAA@@BAA@@AA@@@A@@@@@A@@C@@@AA@A@@@@@@A@A@CAC@A@@@C@@AAAA@@@AB@@A@AD@@ABBDAD@@A@@@C@AA@B@@ABCA@@@@AB@@A@A@@@A@A@A@A@AAAA@AA@AA@A@@@A@AAC@@@@A@A@AA@@A@@A@@A@A@A@AAA@A@@BA@@@ABA@@@AA@A@A@@@AB@B@BAB@@@AC@@B@B@@A@C@A@@BA@AA@@@@A@ABA@@B@@@B@DAB@DABA@A@AA@ABAC@@@@A@A@@@A@A@AB@@@BA@ABA@C@@BA@@B@BA@A@@@A@@@A@C@ABAB@@@@BB@BA@ABA@@BAAC@@@A@@A@@A@@AA@@B@@CAABA@A@@@A@ABA@@@AA@@@@C@AC@A@@C@AA@@BA@@@@CA@@A@@@A@A@A@AAB@@E@@DAB@B@@AAA@@@ACA@@AB@@AB@@CAA@CAA@A@A@A@A@CA"],encodeOffsets:[[109621,23455]]}},{type:"Feature",id:"451424",properties:{name:"大新县",cp:[107.200654,22.829287],childNum:1},geometry:{type:"Polygon",coordinates:["@@BA@@B@@@B@B@D@@@B@@@@@@@@B@@B@@B@@B@FAD@@@B@@@@A@@@@AA@@@AB@@@BB@@@@@@BAB@@@@A@@@A@A@@BABA@@BA@@@@@ABAAABA@AB@@AB@@A@A@@DA@@DC@@BA@@BAAAB@@ABC@A@A@AA@AA@@@A@@@A@A@A@A@A@@@AAAA@@AB@@@BA@A@@B@@C@@B@@@B@BBB@B@B@@AD@AABA@A@A@A@AAA@@AEAA@A@@A@@@@@AAA@@@AA@@@AAA@A@@@@@@@@A@A@AB@@A@BBA@BB@@@@@BAB@@A@A@A@@A@@ABA@@@CBA@A@@@@CA@CB@BADCAABA@AACAA@@DAAE@CA@DC@C@CCC@AAAAEB@AC@ADCB@ACCG@@BAF@D@DABBDBD@BBDABADA@AB@DBBADCD@B@BAAC@@BK@@AA@@@A@@@A@A@CDCDABGAAA@@A@EA@A@@AA@@C@@@CBBB@B@D@F@B@@@B@B@BAB@@ABBB@B@DA@DB@BADCFABAB@@@A@@A@@@A@@ACB@@A@AA@@@@C@@@CBCB@@@@ACAAA@@@AB@B@B@@A@BB@B@@@B@@A@@@@B@@@BA@@BAAA@@BAB@@AB@@@B@@@B@@@B@@@@@BAB@@A@@@A@ABA@@BA@@@A@@@AFA@@BBFA@ABA@ADCBCBBB@BFBBD@@@F@BB@BBB@@BBBB@B@D@@BDBAB@B@@CD@@A@A@C@@BE@@BABA@@@CAA@@@ADBD@@@@@@@BA@C@A@@@@BA@A@@@A@@@@B@@A@@@A@@@A@A@@@A@@BA@A@@B@@@BABAB@@CA@@@B@@A@A@AB@@@B@@BBAB@@CBA@A@A@@A@@@A@@A@@@@@AAAA@@A@@@AB@@CAA@@@A@A@A@@B@@@BA@C@A@@@@B@@@B@B@B@@AB@@@@@B@@@@@@@BB@@@BBB@@@@B@D@@A@ABA@A@CA@@A@ECCAC@AAAAAA@@C@A@ACABA@CCA@@@AAAA@AA@A@CDAB@D@D@@B@@D@B@@@BA@AB@@A@A@@A@@AA@A@ACBCAA@ABAA@BA@A@A@@@A@@@A@C@@AADADB@@@BB@@B@@BBB@BAB@BB@@BABAB@@CB@BABA@CB@DCBA@AAC@CFB@ABBBABC@ADCDEBCDCAAAAAA@@A@AC@ED@AA@EFD@@DABAHBBAB@BD@BDA@@@A@CB@@ABA@@@A@A@@AA@ABCB@@@@A@@@@BAB@@A@@@A@ABA@@@A@A@A@AB@@ADA@@B@BA@@@@B@@@BB@@BD@@@BA@@BBBB@@@B@BA@ABA@@A@@A@A@@@A@@@C@A@@@A@E@C@@B@BA@ABAB@@@@AB@D@@AB@@AD@@@B@@@B@@@BADAH@FEJIB@@@@GBIFCLEDCDADCF@@CACC@@@@A@ABC@AA@BA@A@A@@B@@@BA@@BA@AB@@@@@D@@A@@BAB@B@@@BB@H@@@BB@@BB@B@B@@AB@@@BA@@BA@ADA@@BC@@@@@CB@@AC@@A@A@A@@AC@@@@@AA@@A@@AA@AF@@@B@@CB@@@@A@@@A@CDDBFBBDFD@@BFB@@@@@@@BAB@BB@@B@@@@@@@@@@@@B@B@@@B@@@@@@@@@B@@@B@@@@@@B@@@BB@@@@@@@@@BCD@BA@ABA@@@A@@@@BB@@@@BB@@@@@@@B@DAB@DA@@B@@@@@@@B@@@@@@B@@@B@@@B@@A@@B@@@@@B@@B@@@B@@@B@@@@BB@@@B@@@@@BA@@BAB@B@@@@@BB@@@@@@@@B@@@BAB@B@DBB@@@@@@@BB@B@@@@B@B@@A@@@@@@@@B@@@@@B@B@@@B@@B@@B@@@@@@@B@@@@B@@@@@BB@@@@@@@@@DB@BB@@BBBBB@@@@@@@BA@@@@@AB@@@B@@@@@@@B@@@B@@BDBB@B@@@@@@@B@@@@@@@BB@@@B@@BB@@@B@@B@@B@@@@@@B@@@@BB@@@@@@BB@B@@B@BB@@@@@BAB@B@@@B@@@@B@@@BBB@@@@@@BD@B@@@@B@@ABA@@@@B@@@@@@@B@@A@A@A@@@@@@B@@@B@@@@A@A@A@@@@@@BA@@BA@@B@@@@@B@B@@@@@BA@A@@AA@@@AA@@@@AB@@@@@@ABBB@@@BA@@@A@@@@@BBABA@@BA@@BA@@@G@A@A@A@CB@@C@C@@AA@@@@@A@@AA@@@A@A@@@A@A@@@A@@AAA@@ACA@@@A@A@@@C@@@A@@@A@A@A@A@@@@@CA@@A@@@@@ABA@ABAB@@@@A@@@AA@@A@@@@@AB@@@@A@@@A@@@A@@AA@@AA@@A@A@@CA@@AAA@@@@@A@@@@@AB@@@B@@BB@BBB@BBD@B@B@B@BBB@D@@@B@@@@A@CBA@CBABA@@@ABA@@@A@A@@@CB@@A@@@A@@@@B@@@@@B@B@@@BA@@@@@ABCBA@GD@@AB@@@B@@@B@B@@@@A@@B@@A@ABA@@@A@@BAB@@@BA@@@CBA@ABA@ABCBCD@@A@AB@BA@@@A@@@A@@@A@@@AB@@@@@B@@@@@@ABEDCFEBCBABADAB@BBBJDDDBD@DAJMHOFIBKBMBS@GAAAAA@@A@@BCB@@A@BBA@BBBB@@BB@BA@CDBBFB@@@@AB@@@B@BB@BB@B@@@@@B@B@@@B@@B@@@@BB@@@B@BBBBB@@@B@BB@AB@@B@@@@@B@@BD@@A@@@B@@B@@@B@@@@@BBB@@@@@@@BAB@B@@AB@@BBDDB@@@BB@@@@B@@BBBB@@@BB@@@AB@@@@ABBBBBAB@@@@@@BB@@@B@@@BB@@@@@@B@@@@@@@B@@@@@B@@@BB@@@B@@B@@BA@@@@@B@@BBBBBB@@@@@BB@@@B@@B@@@@@@BBBD@D@@@BB@@@@B@@@BA@B@@BBBB@@D@@@B@BB@@@B@BA@@D@@BB@BA@@B@@@BAB@@A@@B@B@B@@B@@BB@@@@B@@@@@D@@A@@@@BB@@@@B@B@@BBDB@BB@BBB@BA@@BB@@@@DB@@B@@@@B@@B@BBBB@@B@@@B@@AB@@@@@@ABB@ABAB@@A@@B@@@B@@AB@@BDBBB@@@@BB@@@AB@@@B@@@@B@BBB@@@@@B@@@B@@@B@@@@BB@@@BB@@BA@@B@@B@B@BB@@BBB@@B@@BBBDBBDF@@B@B@@B@@@@@BA@A@@@BBB@@BB@B@B@DB@@@@BBB@@@@BB@@B@@BB@@B@@@@@@@BB@BCBEFABB@@BB@@@BB@@B@@BB@B@B@@@BBB@@@BBB@@@@@@BDBB@@B@@B@@B@@B@DDBB@@B@@@@BB@@B@@B@@@B@@@BB@@@B@B@@B@@@BBB@BBB@@A@@B@@@BADAB@B@B@@B@BBB@@ABABADA@@@@@BB@@BBB@@@BB@@D@@D@@@@@@@@@BA@B@A@AB@@ABAAA@@BA@A@AA@@AB@@@BA@@@ABA@@@@B@@BB@@DA@B@@BD@B@@@BAB@B@B@B@@AD@@@@@@B@@@BB@@@BB@@@@BB@@@@@ABAB@@BBBA@@@@@@DB@@@B@@@BB@@@BB@@B@@@BB@@DDB@@@@@@@B@@@B@@BBBDD@@B@@@@@ABABA@@B@@B@@B@B@@BB@B@@@@BB@@@@@BB@@@@@DD@@@B@@B@@B@B@@@@@BA@BB@@D@@B@@@BB@@@BBBABB@@@@@BB@@BB@@BDDBDBBBBB@@@BBBBB@BB@@B@B@@BB@@@@BB@B@BB@@@@BB@BBA@@B@@BB@@AB@@@BA@A@C@ABABA@@@C@@@C@@B@@A@@@@A@AA@@AA@@AA@@@A@@AA@@@@@A@@@A@A@A@A@@@A@@@@AA@A@@@@@@@@B@@A@@@@A@@@@@AA@@@@@@@AB@@A@@AAA@@AA@@@A@A@@AA@@A@@@@@@@A@@@@A@A@@@@@@A@@A@@@AA@@@@@@BCB@@A@@B@BAB@@AB@@@B@@@@@B@@@BBBB@@B@B@@@ABB@@@@@@B@@@B@@A@@B@@@@@BB@@@@@B@@@@@BAB@@@@@@@B@B@B@B@@@@@@ABC@A@A@@@@AAA@@@@AB@@@B@@A@@@@@@AA@@@ABA@@@@@A@ABABA@@BAB@@@BA@@@@@ABA@@BA@AA@CCAA@@BAB@@@BBB@@@BA@ABCDEACGCDIBCAA@ABCFDBA@BB@B@D@B@BA@@B@@@@@B@@@BA@@BA@A@@@@@@B@@A@@@@@A@AB@@@B@@AB@B@@@AA@@A@@@A@AA@@@@@AA@@A@AB@AABACC@A@EB@AAB@B@DDFBDABCBADADADCACC@@CC@E@C@A@DCHAACBC@A@@AA@@@A@@@ABA@A@A@A@@@A@@@AAAAACAA@@AA@@@@@A@AA@@AAA@@@@BA@A@@B@@AB@@@BA@AAA@A@@@A@@@A@A@@AA@@@@@BGAABE@@@@A@@@A@@@CA@A@@@@@@A@A@AA@@A@@@A@@AA@@@@A@@@A@@@@AAAB@A@@@@A@@A@@A@@@A@@@A@A@@@@A@@A@BA@@@@A@IDA@@AA@A@@@@A@CAA@AA@@AA@AA@AAACA@@CA@@CAA@@@C@@@A@@@AA@@@AA@@ABE@CGC@E@C@A@A@CBACABA@ABE@AJB@CAA@ABA@EAABG@@HBB@BBBB@@@@B@BA@BB@@@@@BDB@@@BBB@@@DAB@BBB@BC@@BA@@AA@@BA@AB@@@@@@A@@AAACA@CC@@@A@A@@@@AAA@@@BA@@@AB@BAB@@@D@BB@@@BD@@BFE@@B@@@B@@@BB@@@@B@BA@A@@AABA@@@@BAB@@@@ABAA@@CA@AAAA@@A@@@@AB@@@B@BA@AB@@AD@@ABA@@BA@A@A@@BA@AB@@A@@A@@@AAA@@@@A@AA@@@@A@A@@@@B@BA@AD@@@BB@@B@DA@E@@@A@CA@@A@@AA@A@@BCBC@@@A@@BCD@@@B@@@BAB@BA@@B@B@BAB@@@B@DABB@AB@B@@@@@B@@@@A@ABAAA@A@AB@BAD@BAB@BA@@B@@@HAFC@@@@@AFAD@@BB@FDBBAD@BBB@@HA@EDBBE@A@@D@BAB@DABBBDB@DBB@@CB@DAB@@D@BHFB@DB@BB@BABADAD@BBB@B@@D@@D@BFBBABABBBBAD@BADBB@DBBAF@BBDB@DBB@B@@@@B@D@@@BA@@B@B@@@BB@@B@@AB@@@@ABC@C@@B@DA@ABCD@B@F@F@@D@BCBA@A@CB@D@BDD@BBB@@@BBB@BBBD@DB@BABABCBAB@BDBBBB@D@BDBB@@B@DA@B@B@B@B@@B@B@@BB@BCDAD@@@BB@@B@DABBB@@B@B@@BDABABB@@BF@B@B@B@@@B@BBB@B@@A@A@@B@@@D@DEB@JDB@D@DA@AHCDA@AD@BCDAB@@@@@AB@B@@BB@BB@BBBB@BB@@@BB@B@@@BB@@B@@B@@BB@BBB@@@BB@@CD@B@B@D@B@D@@@B@B@B@@@BA@@B@@A@@@@BAB@@AB@@@@@BBB@@CB@@AB@@A@A@@@A@A@@@@BABBB@@@B@D@B@@DBB@B@B@B@B@DBB@DB@@BA@@BA@@DB@B@@BB@BA@A@CB@@@FA@BBB@B@B@@@B@@@DB@@@@AB@@BBD@@@@BBDD@@@@@BB@@B@BAB@@@B@B@BADB@@@AB@@BB@@@@BB@@@D@BB@AB@BAB@@AAA@@@@BABAD@B@@@B@@@B@B@@A@AB@@AD@B@BAB@@A@@BAB@B@@@B@B@@@@@BDBAB@@B@BABCBA@CBA@@@A@@@AB@B@B@@B@@BAB@@@B@D@BA@A@@@BD@@A@ABA@A@@B@@@B@BBB@@B@BA@@B@@AB@B@BBB@B@B@@@@BB@@@BBB@B@@@@@BD@B@B@@@BBBB@@BBBBBB@B@B@B@@@B@B@@@BA@@@@DBBA@@@ABBD@@@B@@@BCACBA@@BCB@@@BA@@B@BB@BD@@@B@D@DBB@B@@@@@@@@BBB@@D@@@@B@@@@@B@@BB@@BB@AB@BB@B@@@BB@F@@B@@ABB@@B@@A@@BAB@@BB@BBB@@AF@B@@@DBDA@@B@BA@@@@AAB@AA@@@ABA@A@A@@@@@A@ABA@@AA@C@AA@BC@@@AAA@A@@@@B@@@@A@@BA@@B@@BB@@@@A@@BBBAB@@@B@@@@B@BB@@B@B@@@@@BBB@@B@DB@@@@B@BAD@@B@BA@@B@@B@@@B@B@@B@@@B@@@@@@B@BB@@ABB@@B@@@B@BA@@B@BA@@@@BC@@@@@@B@D@BA@@@@B@BA@@BBB@@ABB@B@B@@@@B@@@@BB@@@@BB@@@@@B@@@@@@B@BBB@@AB@@@@DBB@B@@BB@@B@B@BBB@@@@B@B@B@@@B@BB@@BB@@B@@@B@B@D@BAB@B@B@B@@@BADBB@D@BBBA@@B@B@B@D@@B@@BBBB@@B@@ABA@@@@BA@@BB@@@BBBBBDB@@B@B@@@@@BB@@DBB@@@@@@B@B@@@@@@B@@@B@B@@@@B@BBB@@@AB@B@@@B@@@D@@@BA@@B@@A@@@A@A@@B@@@@A@@@@BA@@@@@@AA@A@A@@B@@@BA@@B@B@@@B@BB@@@@@AB@@AB@@@B@B@@A@A@A@@@@B@BA@@FA@@B@@AB@@BBA@BB@BB@@@BBA@@B@BABBB@BA@AB@@B@@@@B@@B@@@BAB@@BFDB@@@BBBB@@@DADADBBBAB@@@B@@@B@@BBB@D@@BBBB@@BB@B@@B@@BDB@B@@DABA@@BBBA@@D@@A@@@ABAA@@@@A@A@@BA@@AA@AA@@A@AB@@@D@@A@CBAB@B@@ADAB@@A@AB@@@BCBA@AA@@ABCAA@AA@@@H@B@BBBB@B@FBB@@@@B@B@@BBBB@@@@@@A@@BA@@BA@@@AB@@BBBBB@@BAB@B@@B@B@B@B@@@DB@BB@@B@B@@D@B@BB@@DBDABBBB@@BBBBD@B@D@BAB@BBB@BA@BB@DBADA@ADA@@@@@AB@BIBAA@@A@@D@BBBDBBD@@BDB@DA@@B@@BD@B@@@@@@B@@@BB@B@@B@B@@@@B@BBB@FA@@B@@@DAB@@@B@BAB@@AB@BAB@B@@@@@@BB@@B@@@BB@B@@@@BDD@@B@B@B@@@BB@@BBB@BBB@@@@BAD@@@BB@B@@@H@@@@A@@@AB@B@@BB@@@@A@AB@DA@@B@@@@@B@BAB@B@@@B@B@B@@@@@BB@B@BB@@@BB@@@BBB@@BB@@@B@@@@@@@@BB@@BBD@B@@@@BB@@B@@BB@B@BB@@@D@@@DAB@@@@C@A@@@A@A@@@@BEAABCACCCFC@C@AC@CAAACIIDA@AC@CAEA@@CCC@A@ADADCB@B@B@DC@A@@B@@CD@BBB@DA@A@CDADBF@B@BAAABAAABCFBBAF@@AFA@@BB@B@BCFDBB@FB@BBD@DDBBAD@BBFDBFD@F@B@@@D@B@@CB@BEBABADBBB@BBBB@BBB@BBDBBDB@BBD@B@@@BC@@DA@@BADAD@B@@A@@@@B@@B@@B@BA@@B@B@@@@@B@@A@@BBBAB@@@D@@@@B@BBBB@@@@@@B@@BB@B@@B@@@D@@@BBBBD@@@@@@A@@BABAB@B@@AB@@A@@BABA@A@@@@@@B@B@B@@BB@D@BAB@@@DAA@CC@@BEAAJBBBB@BADAAABA@@@ABA@@BABA@@B@B@@BB@B@B@D@@ABABAAA@@@A@AA@@AB@@ADAB@BCAA@AA@@@@AAAABCAAK@@BCBAB@BAB@B@D@@ABAAA@ABA@@@A@AB@DA@@@AB@@A@@AE@ABA@@BA@@BA@@DBB@D@@@@@B@@A@A@A@A@A@@A@AAAA@@@@B@@AB@@@D@@@@A@@@@B@@AB@B@@A@@@C@A@@B@@@@A@@@AA@@@@AA@@A@@@AB@@@@A@@@@AA@@A@@@@@@A@@@@@AA@@A@@AA@@@@@ABAB@@@B@@A@@BA@@@@ACAC@A@@@ABA@@AAAA@C@@@C@@@@AAAAACB@B@@A@@@A@@BA@@@CBABA@@B@@@BAA@@@BABC@@AA@AA@@AA@A@A@@@AA@@C@@@A@@A@AA@@AA@@@@@AA@@A@CA@@@@@@@A@AA@@@C@ABA@A@A@@@A@@@AA@@A@@@@AAA@@@C@ABA@@@@B@@@AA@@@A@@@@BA@@@CBA@A@@BA@@AA@@@A@A@@@A@@@@BA@@@@B@@@DB@AB@@@BCB@@@@C@@@A@@@@@@A@@@@A@@@@@@@@A@@@@A@@B@B@@@@@@@@AB@BAA@@A@ABAAA@A@@@A@@BA@@AA@@@@@C@A@C@@@A@@A@BA@A@A@A@A@C@@BAB@@@@A@@@A@@@@@A@AAAAA@A@AD@B@@@D@B@B@@A@A@@@A@A@@BADC@@@A@A@@@A@@@AB@@CDA@A@AAA@@AA@@@AA@A@AAA@C@ABA@C@CAA@AAC@AB@AAABE@ADAACB@BCBABAB@@A@ABCBA@AAAAAA@CAA@A@A@A@A@A@AAA@@@C@C@AAA@A@@AC@EHAB@@ABEBCBAAAAC@A@@BCBAH@B@BAB@BC@CFA@AAAAA@BAAAAA@@AA@AA@A@AA@A@@CA@BC@CAAB@AA@@CAA@ECAA@@AC@@CA@C@AC@AAA@AA@A@@ABABA@ADB@@BA@A@AB@DA@CA@@CB@DAB@D@B@BABA@A@@BA@C@@ACAAA@@AACA@CAAC@A@@BA@AAABABAB@@CA@@@@AC@CA@AAA@AE@@AACAA@@BA@A@A@AAABEAAA@@A@@@A@@@AAA@@@C@CB@B@B@@A@A@ABAB@@A@@A@A@@@@BA@@BA@@AA@@BA@@@A@A@ABA@A@C@@B@BAB@B@@AAAB@@A@@B@DAB@@AB@@A@A@A@@@AA@C@AAAAAB@@ABC@C@@@AA@A@ABA@@@AA@@@A@@CAEAC@@@A@A@A@AA@@AA@C@@@A@@BABA@@@@DABADCBADAD@@AFCB@F@@@@A@CBC@C@@@@@@@A@@A@@@@@AA@@AA@A@@@@AA@AB@BABAAA@@A@@AA@@@AA@@@AA@@A@A@@@@A@A@@@AA@@@@@A@@@@@@@A@@A@@@C@AA@BAB@@ABA@C@@BA@A@@@ABABA@@@AAA@A@AB@@A@@AA@A@AAC@@@AA@A@@@@@A@@AA@@AA@@@@A@@@AA@@ABAA@@@@@AA@@A@@A@@@A@CC@A@@@@@AB@@A@@A@@@@AA@@AA@A@CB@@@@A@@@A@@@AA@@A@@AA@@BAACA@@CAC@CCCA@@B@@AB@@@CA@@A@AA@AAA@A@@@AA@A@@@A@@AAA@@C@@@@AAAAA@@A@@AAA@ABA@AA@A@A@A@@@EA@@@AA@@@A@@AAA@@@ABA@A@@BC@C@@@@B@BAB@BA@A@@@@@A@@AA@@@@@A@@@A@@@ABA@AC@@@A@@@C@A@A@A@AA@@@@DC@@B@@@@@BA@@BAAE@ABA@AB@@@B@BB@@@@@B@BB@B@D@BA@@AA@AB@B@@A@A@A@A@@D@@@BBBB@@B@B@BABA@@BA@A@@B@BA@@B@@AAA@AA@ACC@AA@@AC@@BA@@@CAA@@@A@A@AAA@@AAAA@@BC@@B@@ADA@BBAB@B@@@BBDA@B@A@A@@@@AA@A@@@@@A@@@@@A@A@@BA@A@@@@AA@@@A@@@C@A@@AA@@BA@AA@A@@@AA@@@@ABAAA@AA@@@AAA@@@AAA@@@A@A@@A@@A@@ACB@@@@A@@@@@A@A@AB@@@D@@A@@D@@@@A@@@@AC@@BA@@@@B@@@@BBA@@BB@@@@@B@@@@B@@@B@@BB@DA@@B@@@@@@@BAB@@@@A@@BAB@@@B@BAB@@@B@@@@ABAB@@AB@@@B@@@BA@@BAB@@A@@@A@@@A@AA@@@A@@@@AAA@A@A@A@@@@@@AA@@CA@@@@@@@A@@@AA@@C@@@AB@@@B@@BB@BB@@BBB@@@B@BA@AB@@A@AC@@@AAAA@AAA@@@AAA@AA@A@@@@@@A@C@AAC@A@AA@AC@@@@@A@A@@@AA@@A@AA@AB@@A@AB@@@@@@@B@BA@@BAAA@AA@@ACAA@A@@@ADC@@@A@@AA@@A@@A@@@@@A@@@AAA@AA@@@A@@@CA@@@AACCA@B@@@@A@@BB@@@@BA@@BA@A@@BBB@@@BB@A@BB@B@@@B@@@@BBB@@B@B@@AB@B@B@@@@AB@@@B@F@@A@A@@@@AA@A@@B@BAA@@AA@@@@@A@AA@A@@@@@A@A@CA@@A@@@@@A@@AA@A@@@@B@@A@@@@@A@@@@AA@@@A@@@A@@@A@@@@BA@@BA@@@CA@@@A@@A@@A@@A@AA@@@BA@@@ABA@@@@@@@AA@@@@A@AB@@AB@@A@@@A@A@A@A@A@@B@@@@@BA@@@AB@@@@A@@@@BCBA@@@A@AB@@A@C@@@A@AB@@@BAB@B@@BD@BAB@@@BABADAB@BA@@@C@ABA@@@@B@BABAB@@BD@B@@CDA@AB@@@BBD@D@@AD@B@@@BBDB@@BAD@@@B@@@BABAB@BAD@@@BBBADAB@@@B@B@@ABA@@@@BABAB@B@@BB@B@@@@@BBDA@@BA@AB@@B@@@DB@@@@@BB@@@BA@AB@B@@@@@@BBB@D@BAB@B@D@BAB@BCDCBA@@FADBB@B@@@B@@BDB@B@@BB@B@@@@BB@@AB@@@BBB@@@B@@BB@BB@@@B@B@@@B@@@BB@@@@@BB@B@@@@@DA@@B@@@@@BBB@@@BBBD@@@B@@@BC@@@A@@@A@C@@@A@@B@@@@@@BB@FBB@B@B@DBB@@@@@@B@@@B@@@B@@@@@B@@@B@@@B@@@@@@@B@B@@@@@B@@@@@D@@@@BB@@B@@@BB@@AB@@AB@@@B@@@@C@A@AB@@AAA@CAAA@AA@@@@A@@A@A@@@A@A@AA@BA@@@@@A@@AA@A@@@@@A@A@ABAAAAAA@@A@@@A@A@A@A@AB@@A@@AA@@@@AA@@C@@@@A@EAA@@@@AA@@A@@A@@@A@@@AA@A@@A@@@A@AA@@@AAA@@A@@@A@ABAB@@@@BD@@@B@@ABAB@BA@AB@@@D@B@@B@@B@@BBAB@BA@A@CBCA@@@A@@AAA@@A@@C@A@@@A@A@@@CBA@@C@@BA@C@@@AB@@A@A@C@@@A@@@C@A@A@A@@A@@@@A@C@A@@A@@AAA@@B@@A@@@AA@@CA@A@@@A@@@@@@A@@A@@@A@@AA@@@@AA@@@@ABA@A@@B@B@@A@@@A@A@A@@B@B@@A@@BA@@BAAA@AA@@@A@AACEA@@@AAAB@@A@A@@AA@B@@@@AA@@@@@A@@AB@@@A@@@@@@A@@@@@@@AA@A@@@AA@BABAB@BBD@@C@@@A@A@@A@@@@AA@BC@CB@@AAC@A@@@A@@@C@@@A@CC@@@A@@AA@C@@BA@@CCCA@@@@@@A@@C@@@AA@@@@@@@@@A@A@AAA@A@CBC@A@@@@AAA@@AAA@@@@@A@@@A@@A@@@A@@@@A@A@@AEA@@@@AAA@@@@@A@@B@B@@@@A@@B@@@@A@@A@@A@@AA@@AA@@@A@@A@@@A@@@@@AAA@@@A@@A@A@A@@A@A@@@@@A@@A@AB@@AA@A@@AB@@A@AA@@A@@AA@@@AA@A@A@@BA@@B@@A@@@@@C@@@ACA@@@ABABAA@@@@@@@@A@A@@@@@@@@B@@@@AA@@@@CB@@AA@A@AA@@@@@C@@AA@@@@@@AA@A@@AACA@@AAA@A@@@AB@@@@CAAAA@@A@@A@A@@@A@@ACBA@@@A@A@@@@@A@A@A@@@@@A@@BABA@@@A@@@A@A@@@@A@A@CBA@A"],encodeOffsets:[[109673,23093]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("防城港",{type:"FeatureCollection",features:[{type:"Feature",id:"450602",properties:{name:"港口区",cp:[108.380143,21.643383],childNum:1},geometry:{type:"Polygon",coordinates:["@@IAoEgEkDI@SDª@@B@@@@B@DB@AB@D@@@BADA@A@A@@@@@A@@LNC@@BHBJBF@@BB@BBB@B@B@@B@@B@A@@B@@@@@@@@@@@B@B@B@@@B@@BB@BB@@@@@@B@@B@@@B@@BB@B@@BB@@@B@@@B@@A@@@AA@@A@@@A@@BBB@B@@@@@BB@@B@B@B@BBB@F@L@B@BBDBD@DFHFHBBDJBB@B@FADAD@BGFABGFEDEFCDEHADAB@B@@@B@BBB@@BD@@DJBBDDBB@@BA@A@ABE@@BB@@B@B@BB@@BB@@B@BB@AHD@DB@B@@CB@AD@@B@B@@@A@BB@BB@BB@@BAAA@AB@A@BA@B@A@ABC@AB@B@@@@DBB@BB@B@ABBBB@@BBAB@@@@B@@B@@B@B@BDC@ABAB@BABABA@ADB@B@@@BD@BBBB@@@ABAB@BBB@@@B@BA@@@A@@B@@ABABA@A@@BBB@BAB@@CB@@@@BDABA@@B@@@B@@BABA@@BD@B@@@B@@B@@B@@@@D@@@@BAB@@@@BB@@ABBB@BADCB@@AB@ABB@@AB@BB@B@B@BD@@@B@@@B@@@@@@@@B@@AB@@@@ABB@@B@@@B@@@@@@@@BA@BB@@@@BAB@@A@@B@@AB@B@@@BA@@B@@B@B@@@@@@@@B@@@@@@@B@@@@@B@@@@BBA@@B@@@BBBBA@@B@BA@BB@@@DB@@B@@@@B@@BD@@@@@B@@@@@B@@@@@@B@@A@@@AB@@B@@@BB@@@@B@@BA@@BA@A@@B@@@@AB@@@@@@@BA@@@AB@@@B@ADAB@@B@@@B@BBCD@BADBBBB@@BABA@A@@BB@@@@@B@BBCB@B@@@B@@B@B@B@ABBBA@@BBABB@@B@@@@B@@@B@@B@@@@B@@B@B@@BBBAB@B@@B@BDA@@@B@@@@B@@@@B@@@BB@BA@AA@@AB@B@@@@@@B@@ABF@B@@@BA@@AC@@@@BA@AD@BABBB@BAB@B@BC@ABB@@B@@@@BBCB@B@@BB@@BB@@AB@BB@@@@DA@C@D@@DABABAB@DBB@@@DCLGB@B@D@BAB@@ABG@@@@DCB@@@@A@@DCDCDCBCB@@@@@B@@@B@B@BAB@B@B@BBB@DB@@B@BB@@B@@@@@@@@@@B@@@@A@BBB@@@FPFBLF@C@CDAB@@A@@BB@@@AB@@@B@@A@@DAB@B@@AB@DADADABAB@BADA@ABA@@DA@KN@@@BA@@@@@@B@@@@AA@@A@@EI@AFABADCBAB@BABC@A@@BA@A@@@@@@AB@@@@@@A@@A@@BAAA@@BAACAAAEAA@C@ABC@@@A@CF@CCWYA@YiMMGGEEECACAA@AAE@GAEAICC@@ECECCC@CACAECCmWCC@CBE@GAE@@A@CEKGOGYIA@WEO@"],encodeOffsets:[[111049,22057]]}},{type:"Feature",id:"450681",properties:{name:"东兴市",cp:[107.971828,21.547821],childNum:1},geometry:{type:"Polygon",coordinates:["@@gPUFEBGHCDEJCHEDIDMB@@GBGAIAG@A@CAABA@A@ABABAAC@AAC@@@A@AAE@E@A@A@ECA@C@@@A@C@CB@@AAA@@CAA@AA@AA@@A@ABA@A@@@A@@@AA@AAAAA@@A@@@A@@@A@ABA@A@@@C@AAC@A@ABCB@@AFELAFAHCFAFAFAD@B@BBB@B@@@B@@ABCBCBCBCDCBCBADCDCD@D@D@B@@AB@@C@A@AAA@EC@@A@@@AB@@A@ABA@@@A@A@@@ABABA@ABA@A@@@A@@@@A@@@A@A@A@@AAAB@@A@AB@@AB@@BB@@@@@B@B@B@@@BABA@AB@@A@A@AB@@A@A@@BAB@@@B@@B@BB@@BB@@@@@BAB@@@B@@@B@@ABA@EACB@@A@@BA@@B@@AB@@@BBB@@BBB@D@B@B@@BB@@B@B@@@B@@@BABA@@@A@@@ABA@@@@B@B@@B@@BBB@@BB@B@@AB@@CBABABA@A@@@BBDBB@B@BBB@@B@BHB@BDBD@D@B@@@D@D@@@@BBBDD@DBB@BB@@B@B@BA@@B@@BB@BB@@B@@FHBB@B@BAB@@A@AB@B@@@B@DA@@@BAAC@@@@ABABCD@@B@B@D@BB@D@BAB@B@@AD@@@BB@DBDBB@D@F@@@ADAD@@@B@@@B@@BD@@@DD@ABABAB@B@@@B@@ABDFBBB@D@@@@BB@BA@@B@D@@@@@BB@AB@BBDADBBD@BBDB@@BB@B@@B@@@B@@@BA@@DCB@@AB@B@B@B@@A@A@A@C@AB@@@@@B@B@@@B@@@@@@@B@@@BB@ABBBB@@@B@@@@B@B@@BB@BB@@@BB@@BB@@B@BAF@BAB@BAB@B@B@B@D@DB@ABB@AJ@BAH@JBL@@@@CBABA@@FAD@DBB@B@BDB@D@B@F@B@@@@B@A@@@A@EBABC@@B@B@B@B@BBBA@@@@BA@@BA@@B@B@@@@A@@@ABABB@AB@AA@@@AB@@@@@@A@@BBB@@@@A@@@BBABBDCB@@@B@@@BA@AB@@A@AB@B@B@B@@@BAB@@@B@B@@AB@DABA@@BA@AB@@@BABA@A@@@ABABABABA@@BAB@@AB@@@@A@ABAAABCB@@ABAB@B@@AF@B@B@@@F@@@BBB@D@@C@@DABA@@B@B@@AB@BA@@BA@A@@@A@A@@B@BAB@@AB@@A@@B@@A@BBA@@BBB@@@B@B@B@BA@AAAB@B@@@B@@@BB@B@BB@@B@B@@B@@@@BB@@@B@BB@@@B@@B@@@@@B@@@BB@@@@B@@B@@B@@@@@BB@@B@B@BBB@@@BA@@@CBA@@@A@@@AA@@@@A@@@@@A@@B@BABA@@B@B@@B@@BABBDA@ABA@A@AB@@A@@B@B@@@@AB@@@BAB@@AAA@AB@BAB@@ADBB@BBBB@@@BBABB@@B@@A@@B@B@@BB@B@@BB@@BBB@@@@@B@@@@D@BBB@@B@@@BB@BAB@@@B@@BBA@@B@@B@@@B@B@@@BD@@@CB@BAB@B@DBBAB@B@BB@B@@B@@@B@BA@@B@BBB@@@@@BA@A@@BA@A@@B@@@@@@@BA@@BAB@B@B@B@@BB@@@@@BB@B@D@D@@@B@@@@BB@@@B@@@BBDB@B@@B@D@@@@@@@BB@AB@@BBBB@@BD@DB@@@BDBDB@@@@B@@BB@@BB@B@BBB@BBDABBBD@B@@BBBA@BB@D@F@@B@@D@@@@@BADB@@B@@@BB@ABBB@@@@B@@B@@@@@BBB@B@@B@@BBD@@@@BB@B@BBB@@@@ABABAB@@A@@BCB@@ABE@AAA@@@ABB@AB@@@B@B@@B@@B@@@@BB@@A@BBAB@@@@@B@B@@A@C@@BA@@BA@A@@B@@AAA@A@@@A@@@A@A@A@AB@BA@@@A@ADABABA@@@A@@AAAAA@@AAAA@@@@A@@@A@A@@BA@A@A@@@AAA@@@@@AAA@CAACAAC@AAAAA@@@A@C@ABC@A@@@A@@@ABEBC@ABCBCBAAA@A@A@C@@DCB@@A@ABA@@@AECAA@A@@DAB@FGBEBAAABA@ABCBC@CDGBCE@QESEAAKGGIAACIEKCCOGYESGIIAA@A@ABABCFCFADAhMTKJGDI@IEEOIoM{E_@SFABWHCB"],encodeOffsets:[[110688,22031]]}},{type:"Feature",id:"450603",properties:{name:"防城区",cp:[108.353499,21.769211],childNum:1},geometry:{type:"Polygon",coordinates:["@@ECEGCCGEgMAI@I@EFCH@JDHHJTTVZFJBFDHJRJPBTBD@F@DCPAFEJKNIFMBC@ADCH@DADAD@BABBBABAFEHA@CB@@@BBBFD@B@@AB@B@BA@CD@@@D@B@BBBABADAD@BADAF@B@@@B@@@BAD@B@D@B@@BBBB@BBDDBBB@DBB@B@@@@BB@B@@@B@BAB@@@B@B@@@B@@B@BB@BB@BBBB@@@B@@ABABCB@B@B@@ABA@@B@B@B@B@@@B@@@BBB@BA@@@@BAB@@AB@@@D@BA@A@@@@@A@AB@A@BA@@A@@A@@@@AA@A@@@A@@BAA@B@@BB@BAF@BA@AD@@@BA@ABAB@B@@A@AAA@A@@A@@C@AA@@@AA@A@AA@@@@A@@@@A@@A@AA@BAA@@A@@@CAAB@@@@C@@@@AE@C@A@@AABAA@@@AACAACBAAA@AAA@A@@AA@@AA@@@@@CACA@A@@CAC@@AA@AA@AA@@BAA@@@@@@C@A@@@@ACAAA@@A@@@A@@A@@A@@@C@C@A@A@@A@@@@AA@@@A@A@ABA@AB@@A@@@@@@@AB@B@@AB@B@@A@@@@AA@A@AB@@A@A@@@AA@A@@A@ABAAA@C@ABA@ADA@@C@@A@@@A@A@@@AA@@@AB@AA@@@A@ABA@@A@@@AA@AAC@@@@@@A@@@@AA@AA@@AA@A@@AA@A@@@@BA@@@AAAB@A@@AAAAA@CA@BA@ABA@@BBB@BA@AB@@A@@B@@A@A@@@@BA@@B@BAB@BCBAAAB@@@AA@A@@@ABABA@@@@B@@@@@B@@B@@B@@@B@@AB@D@@AB@@A@AAA@A@@@AA@@@@A@@@@AA@@@@@AA@@A@@@@@A@@@@A@@AAA@@@A@@A@@A@@@@A@AA@@A@AAA@@A@@@A@A@BB@BABA@A@A@@@A@AA@@AB@A@BA@@@@BA@@BA@ABA@@@@B@B@@@BAB@@ABA@@BA@A@@@ABCB@@@DC@A@AA@@E@@@A@A@E@@BA@A@AB@BA@ADBBAB@B@B@@A@@BA@AB@@ABABABAB@B@@@BABAB@@A@@BAB@@ABCBA@@BA@A@@@A@AB@@A@A@A@A@@B@BA@@BAB@@A@@@A@CDAAAB@A@@@B@@A@AA@@@B@@@@A@@B@@BBA@@BAAAB@B@@@B@@A@A@@@AB@@AB@@@@ABAAA@A@A@A@@@ADAB@F@B@@@B@A@@A@E@A@C@A@ACA@A@CAC@EB@@ABAB@D@@K@IAG@ABI@@BAA@BCAC@A@A@A@A@ABA@ABE@ABA@@@AA@@AA@@A@@AAA@@@A@A@@A@@@A@AABAA@@A@@@A@@@@@@@A@@@A@A@@@@BAD@B@B@B@@@@A@A@ABA@@DA@CB@@A@@@A@@@AA@A@@AA@AC@AACCACBAAA@@BAA@@@@C@A@@@ABA@@A@@C@A@AACEBA@@@A@@@ABABABAC@@C@@AC@@@A@@@A@@BCBC@@E@C@A@CACAA@@A@@BC@@@ABA@A@CAAC@A@A@@@DCBABA@@@@BDAB@@B@@C@A@@@ABAB@@@BA@A@AAAEG@@@AA@@AAA@@@AB@@A@A@AA@@AAA@CCCAA@A@@C@C@@@A@C@C@CA@AGA@A@AA@AAA@A@CAAAA@@@A@A@@BAB@@@B@@BD@B@B@B@B@B@BA@ABC@A@ABA@@@AB@@@BBB@B@BA@@@@BCBA@ABAAA@C@EB@@@B@@@@@D@@@BA@ABA@A@@@ACA@@AAAC@CAC@AAA@@A@@@C@A@CAA@@AAA@A@AB@@AB@@ABA@@@A@@A@@A@ABA@AB@BAFABEF@@CB@@A@A@A@A@A@@BCBABA@A@GBC@A@ABAAA@AB@@A@@@@A@AAA@@AAC@AAC@A@A@ABA@A@A@A@@@AAA@A@ABA@@BA@A@@@AAA@AA@@@A@C@A@AA@@@CAA@CBAAA@A@@AA@B@@A@@@A@@A@A@AAC@A@@@@AC@@@C@A@AAA@A@A@C@A@ABA@A@AACAC@EAA@@AA@A@@ACCCAAAAA@AA@AAC@AAC@A@CAA@A@A@@@AA@@AC@@A@A@@A@@AA@@CACA@ACACAC@A@@AA@AAA@@AA@A@A@CA@@A@@@@BA@A@@@A@ACA@A@A@@@A@@AAA@AA@@@A@A@C@AAA@A@A@A@@@A@A@ABA@A@CBEDCBC@ABA@@@AAA@A@CCCAECECC@EAGAE@@@GAG@SAC@A@A@A@A@AACCAAA@AAE@A@E@A@A@A@ABCB@BA@EFGHCDCDABA@AB@@@BA@@BA@A@A@C@A@A@A@A@@@AA@@AA@AAA@A@@A@AA@@@@CBC@C@A@@@@@A@@@A@AAAA@AAAAA@@AA@AA@@AAAAAA@@A@A@AA@A@@A@@@@@A@AAAAEA@AA@CAA@@A@@@ABA@AB@@@@ABAD@@A@@@A@AA@@A@A@@@@A@@@AA@A@@AC@G@A@A@@BCB@@CB@@CB@@IBEBEBA@A@@@@@A@CA@@C@A@A@A@A@A@ABC@@@ABA@@@AB@@A@@@@@A@@@A@@@@@@@A@@@@@@@A@A@@@@@@@B@FDB@BBDDB@@@B@BB@@@BBB@B@@AB@@@BB@BDBB@BBD@BCFEFABCDEFAD@HANALALEFFD@BD@DB@@BBB@B@B@BBB@BBB@@@B@@BB@@BD@@@B@@@@@B@@@B@@B@@@BB@@BB@@BA@@B@BAD@BAB@@ABABA@A@@@AB@@A@AB@@@BBBB@BB@@B@@@B@B@@@@@@@BA@@B@BBB@@BBBBB@@BBB@B@@BBBBBBB@@B@@BB@@B@@@B@BBB@@BBBB@@@D@@B@@B@BB@@B@BB@B@DB@@@BB@BBBB@B@@@@BBBB@@B@@BDBB@BBB@B@@@BBBB@BB@@BB@B@@@ABAB@D@@@BBD@@@@DBBB@@@@DA@@BB@@@@BA@@BBB@B@B@@B@@@@@@DBB@@@B@@@B@@B@@D@@B@@DBD@BAB@B@@ABBDB@@BB@@BBB@@BD@@@B@B@@@B@@@BAB@@B@@DBNBB@@BB@@@B@B@@B@@B@DDB@B@BBBBB@BDA@BBD@D@BBBBDB@ABBB@@AB@D@@BB@@BD@BB@B@BD@@BFBDBB@@@@B@B@BBBBABBB@BBD@D@D@BB@@DCD@@ABABAB@HG@A@@B@DC@A@@@A@@DBD@DBFABBBBB@B@B@@@HD@@BBB@FFBBBB@AB@D@@@FDBBB@B@DA@@BABBDAD@BBB@D@D@B@BBB@FBBDB@F@B@DDFBBB@B@BBDBBBB@BCHC@CD@BA@@@CD@B@@@B@D@@BB@@@BB@B@@FA@@@BB@BB@@@B@B@BBBBDBB@@@@B@@@B@@@BBB@@@@B@B@@@B@BDBAB@DC@@BBB@B@DB@@B@B@DC@BBABB@@@B@BFBD@@BBDBABBB@@@BBB@B@@@BBDADB@@@B@@B@@DBAB@@B@@DBAD@B@B@BDB@@@@@DB@BBBA@@DABBB@@BD@BA@@B@@BFAB@DA@@DAB@DABBDAD@BB@@BBB@B@F@@AB@@B@@B@@@@BB@FDBB@@B@B@B@@ABA@@@@@AB@@@BAFADAD@@@B@D@DABADBBAB@B@@B@B@@@BB@BBDA@BB@@AD@BABBDBB@B@BBB@@BDAFB@BB@B@@BB@@BF@BDB@B@DFBB@@B@@@@@@BB@DB@@B@BBBBB@@BB@DA@@@BB@H@@@BAB@B@@@BAB@B@@@B@@@B@BB@BB@@@B@JFBBB@@@B@BABBB@D@@BBBAB@BB@AD@BAF@BCD@B@D@@@B@BADAF@DAB@B@DA@@B@B@@@@@B@@@BB@@B@@BAD@D@FA@@BB@@F@B@BB@@D@@BB@BB@B@BB@BB@@BBB@@@@BB@B@@@B@B@@@@BBBB@B@@BB@BAD@@B@@@@BBB@@@D@FDB@BBBAB@@@B@@@@BB@B@@@BBH@@@B@@@@BB@@@BBB@B@FAB@B@@@B@@@DBBABBB@BAB@D@BA@@BBD@B@BBBADBB@B@@BBD@@BD@BB@@@BD@BB@@A@@B@BBB@@ABBB@@@B@@@@@@@B@B@B@@@@@B@@BB@@BB@B@@BB@@@BBB@B@@@BAB@@@B@@@@B@@B@@@@@B@B@@@@@B@@@@A@@B@@A@@@@B@BA@@B@@@@@@@BB@@@@@@DABA@@BB@@D@@@ABB@@BBBB@@@B@D@@@BBB@@@D@@BBBD@BABB@DB@@BBBB@@D@ADBBAB@D@DB@ABBB@@@FDB@B@D@B@BB@@DC@@B@BB@@BABBBBBBDBB@@B@@B@BB@ABBB@@@D@F@DF@@B@BBBB@@@BB@BB@BB@BBB@@D@BBBB@@@B@@BB@B@@B@BB@@BBBB@@@BBB@@@BBBB@@DAD@@D@@@D@@BBBD@BBABBBADB@BB@@BB@D@@BBB@B@@D@@BB@B@@@BA@BB@BB@@BBB@@@DD@@@D@@@@B@@BBB@ABBD@@B@@BB@@@BBBBBB@@ABBBB@@@B@@BB@@@BBBB@DBB@@@B@@@B@B@BABBBABBDB@@DDB@DAB@B@@BBB@@DD@@BBB@@BBB@@BB@BB@AD@B@@B@@BB@@B@@@@BBB@@BBB@@@B@@BB@@B@@BB@@@@B@@BBB@BB@B@BBD@BD@AA@@@A@@BA@@BABAB@DAB@@AAA@@@@B@@A@A@@@A@@@AB@@@F@B@B@@@BAD@@A@@@A@@@@B@@@@BBBBB@@@@B@BBDB@@@BAB@@@B@@@@@B@@@@@BB@B@@BBB@@@B@B@B@@@B@BB@@BBBBB@@@B@B@@@@@BB@B@@@BDB@B@BA@A@@BAB@@A@A@@@A@A@@@A@A@@BA@@@ABA@@@A@AA@BA@A@AB@@@FBB@@A@ABA@A@@A@A@@@@@@AB@B@B@@@BC@AB@B@@A@@BA@@B@D@BBB@@@B@@A@@@@B@B@@AB@DA@A@A@A@@DA@AB@@ABB@@BA@@B@@@@A@@@@BA@BB@@@@@@@B@@@@BBB@@B@@BBA@@BBB@@@B@B@@@BA@@B@@@@A@@AA@@@@BA@@BABAB@@@BAB@@A@@@ABA@@@@@A@@@@@A@@AA@A@AA@AAAAAAB@@AB@BA@A@@@AB@BA@@@A@A@@BA@@@AA@@A@@BAD@B@@ABD@@B@B@@@D@B@BA@@BCB@@@@AAA@AAC@CA@@A@ABABC@@AAAB@C@AB@B@FBBA@ABA@CBADAFABA@BHAD@F@B@DGB@@BBABAHA@A@@@A@@@AB@@C@A@AB@@@DABA@@B@@@B@@B@BBBB@@BB@@@AB@@F@BAB@BA@B@@BB@@BD@D@@DF@@@B@@BABCD@B@B@@@B@B@@@B@@@BAD@BAB@@@B@@B@B@@BB@@B@B@@B@@B@B@@BB@@B@B@@BD@@D@B@B@BAB@@@B@BB@@BAB@B@@A@@B@@@@BB@@BDB@BD@B@B@@@B@BA@@B@@@BBDB@@BAB@@@@AB@@@@@B@DA@AB@B@@@BB@@B@@@FB@BB@D@B@@AB@@@BA@@D@@@@BB@@ABAB@B@@@B@@@@@B@@BB@B@@@@@B@DF@BBB@BB@@BDB@@B@@BBBB@@@B@BA@@B@B@@@@ABABA@ABAB@@@@@B@@A@A@@@AB@@@B@BA@@@AB@BC@@B@B@@A@ABAB@BA@@B@B@BAB@DBD@B@B@D@@@B@@@@@BB@@B@A@BB@@@@@@@BB@@B@@B@@@B@@@@@B@@@@@B@B@BB@@B@ABB@@BB@@B@@B@BBB@@@@ABA@AB@@@BAB@@@@AAA@@@@B@@A@@B@@@@@B@@@@AB@@@AA@CA@B@@@BA@@@@B@@@D@@BB@@BB@@BBBB@@@@@@@B@@@B@@AB@BA@@BA@@BAA@@A@@@AB@BAB@@@@A@@AA@@@A@@B@BABABABA@A@AB@B@@ABA@@DA@ABA@ABABC@AB@@A@C@A@AAAAA@CBE@@GICECCAA@ADCBA@ABCBEBCBEBADADABADCFGBABABABEBABAPCBADCFENKDCBAB@FEFEBE@AAGB@AEBA@ABABC@E@A@A@ABEBCBAB@@ABA@@@A@AAE@ABA@E@A@AAA@EA@A@GCA@AAAA@@AA@@C@@@AAAE@@AE@CBEBK@MAAAG@A@A@@A@ABC@A@A@KHCD@@A@CAA@ABABCB@@@C@DCB@@@@AAA@@BA@@AA@@AA@A@AD@A@@A@@@AA@BADA@A@ABA@AAABC@@BAB@@@@BD@@AB@@A@E@BA@@@A@@@@A@A@@BB@@BABA@@A@@@A@@A@@@@@@A@@CB@A@AA@A@ABAA@@@A@AA@@@@@@AA@@@A@@@@@@AA@BAAA@@ABAA@B@A@A@AA@@@A@A@AD@A@A@@@@AA@@@BABAB@@AAAABC@ADCAAA@@@A@@@BABCA@@@A@@B@@AB@@@@@@A@@B@@A@@@@BAB@@AB@@@A@@A@@A@@@AA@@B@@@BA@@@@@@@@A@@@@@A@@@@AC@@@A@@A@@@CA@@A@@AABA@@@ABAA@A@@@AB@AA@@@@@A@@@@@A@@@@@@@A@@@@@@A@A@@@@AB@@A@@@ABA@@@AB@@@BA@A@@A@@AAB@@@@@@@@@A@@@AA@BA@@@@BA@@@A@@@@@@A@@@A@@@C@@A@A@AAAA@@BA@BAA@@BA@CDABA@AA@BA@@A@@A@AB@@@@@C@@A@@@@AA@@@A@C@@AB@BA@AA@@@A@@@ABCB@A@@A@@DA@ABA@AA@@@BABAB@BA@@@@B@@ABA@@@A@AAA@AB@B@@AAAAC@@A@@@ACA@BABABABA@AB@BCD@A@A@AA@@@@A@@A@AB@AA@AABAA@A@@AAA@C@@A@A@@BAD@B@B@AABB@A@@BBBAB@@AAA@@AAAB@@@A@A@@@BCA@@DA@A@@CGC@BAAA@@@AA@@AAA@A@@@AA@@AF@B@BAB@@AACCAACI@@AC@@AA@A@A@@@ABABCFGDCFEFCHEBAHE@ABCBC@E@AAACIAAEGEG@CACAC@A@A@K@EAA@A@A@A@AA@@A@@@@@AAA@AB@@@B@@@BB@@B@@@@A@@@A@@AA@@@AAA@@@A@@@AA@@@@@@@AAA@@AA@@@A@A@A@@@@@@@@@@@A@@@@B@AA@@@@A@AAA@AAA@@AEAIAG@@MD@KB@@@@@B@B@B@BC@A@@@CBAA@@C@A@@A@@@©oJQAOEWGeMSGIC"],encodeOffsets:[[110876,22038]]}},{type:"Feature",id:"450621",properties:{name:"上思县",cp:[107.983627,22.153671],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AA@@AA@@CAAA@BA@A@ABC@CA@@@B@BA@@D@DAD@@BB@@@BA@@@A@BBA@@@@B@B@@@@B@@@@BA@ADCBAD@B@@BB@@BB@B@@@BAB@@@B@FA@@@@BA@@@@B@@@B@@AB@@AFA@@D@@@@@B@@@B@BB@@@BBBA@DB@BB@@@BBB@B@B@@@@@@B@BBB@@B@BB@BDBD@B@@BBB@@@BB@@B@@@BB@@BBB@B@@@BD@B@BBBBB@@@@@B@@@BCB@BA@A@@@C@@@A@@B@@A@@BA@ABABA@@@@B@B@@ABA@A@C@@@C@@@C@@@ABAB@@@B@DBB@@AB@B@@BBBB@BB@@BBBAB@B@@@BB@B@@@@B@@@BB@B@@@@B@B@B@BA@A@@B@@@@AB@@@BB@@BB@@@@B@B@B@@BBB@BBF@A@A@@@@BBDBB@@FBBDB@@BDBBBBBB@BB@@BB@B@B@B@@@B@@AB@@@@AB@@AB@B@@@BABBBA@@B@@@@@BAB@@B@BB@@@BBB@B@BA@@BAB@@C@@@@@ABA@AB@@ABA@A@@@@@B@@@BB@@@B@@A@@B@@B@@@AB@@@@AB@@@@@@@@@D@@@@AB@@@B@@@@@@A@A@@BA@@B@@@@@@@@@@A@@@@@@@@@A@@@@BAB@@@@AB@@@@@@@@EB@@@@A@@@A@A@@@@@AA@@@@CF@BA@@B@@@BBDBB@D@BDF@BB@@BB@@B@D@@BBBBB@FDBAB@@@@BB@@B@@FF@B@@@@@BA@AB@BA@ABAB@@AB@B@@BD@@BB@@FF@BB@@@@BAD@@ABAD@B@BBB@B@@B@B@B@DA@@@AFC@AD@@BB@@B@BBDBHB@BBDABA@@L@BBBDABA@EDCBAB@D@D@B@@ABABAD@BBD@@BBB@DDDBB@@B@@ABADEB@@@BABB@HBB@DBB@B@BB@B@@@@ABCBCBAD@@@B@@B@D@@BD@@@B@BBBF@@BDB@BBDBDBBBB@@@BB@B@B@B@B@BDB@@@@DC@@B@@@BB@D@FADBBBB@@@@B@DB@@@BB@BBB@DBB@B@B@B@BB@B@@CF@B@@@BB@DBDBB@DD@@BB@BAF@B@B@BDFBB@@D@D@@A@@B@DBHBDD@@@BEFEF@BBBDBDB@@D@FBB@B@@@DBB@@@@A@A@CBA@ADAB@B@@BBB@@@BBFCDCHCDABAB@D@DBB@@@@B@B@B@FED@B@BBB@@B@@CBCBBDDL@@B@D@HAB@B@@@BBBBBB@@DJBDBDBDDBDDBBBBAB@@AB@@CB@AA@ECA@@@A@@@EDEFCDEF@@@@@BBB@B@D@B@@AF@@ADA@AB@@@@@B@B@@A@@B@@@@@@@B@@@@@@@@@B@@@@@@@@B@@@@B@@@@@B@@@@B@@B@@@@BBB@@@D@H@DF@B@B@BB@@@@@BBAH@@ABAB@@EBC@CAAA@@CC@@@@@@AB@@@@@B@@@AA@@@@@AAA@@B@@@@@@@@@BA@@@@B@@@B@@AAA@BB@@A@@BA@@BA@A@@@AB@@ABA@@@@@@B@@@BCB@B@@@BBB@@@B@B@BAB@B@B@BA@AB@B@B@B@@@B@@AB@B@B@@@@A@@B@@@@AD@@@@AB@@B@@BABA@BB@BA@@@@@@D@BB@@BA@@B@@@BAB@@@BAB@@BBAB@B@@ABB@AB@B@@@BB@@BA@@BADA@@BBB@@A@@BAB@@@B@BBBCDB@BFBB@@BB@@BA@@B@@@BBDABA@@BABADAB@D@B@BABBBAB@DBBADADB@ADABA@A@ABAB@@BB@BBB@@@D@DAB@B@BAFDDDH@HDF@F@HDFBD@DBD@@@D@B@FBD@J@B@@@B@B@BAB@BAB@BAD@BAB@BAB@B@BDBBDBBB@B@BAD@B@BBDB@@@@BB@@@BB@BBA@@B@B@BBDBB@@@@@B@@BD@D@@@DA@ADA@@@@BDB@B@B@@@BBBBF@DA@@HDB@B@B@@@D@DAB@B@B@FBHAB@DA@@B@B@D@BBB@D@@@B@BB@B@@D@BAB@@@B@BBB@@BB@BD@B@BBB@BD@HBBBDB@BB@B@DBB@B@@@B@@ABBBGHA@ADAD@D@B@B@F@B@B@B@B@B@BABBB@DB@@D@DA@@BA@@@AB@B@@@@@B@BAB@B@@AB@B@B@@@B@B@BBB@@@D@D@D@B@@@D@B@BBBD@BBB@@FB@BBB@@B@BD@@@@@BBAB@@@D@@@@@@AB@DABABBB@B@@@BAB@@@@A@A@A@@@@@AA@@A@@@@BA@@@A@A@@AA@@@@B@BBB@@@@@B@@@@@B@@@B@B@FB@BD@@B@@BBF@@@@BB@@B@@@BAB@@BB@@@B@B@@BB@D@@@B@B@@@BABBBAB@BFDDBBB@@@@@B@D@@BB@@@@AB@B@BA@@@@DDBBB@@@@BDABB@@B@@@@BB@@B@@BA@B@BBHBHDBBB@BAB@B@BAB@@ADBB@DA@A@AB@@ADAD@N@LB@@C@C@@@ABA@@AC@@@@BA@AB@@@B@BA@@D@@AB@B@B@@AB@B@BA@@BA@AB@B@@@BAB@BAD@B@@@@@BB@@@B@@@@BBD@@@BB@H@@B@@BBB@@BB@@BB@@BBBBB@B@@DD@B@@BB@@@@@B@@@B@A@@B@@A@BB@BB@@@@DBBBB@BAB@@BBB@@BBB@@@BB@@B@@B@@@BB@@@@@@@@A@@@ABB@@B@@@B@BB@@B@@B@@B@@B@@@@BBB@D@@@B@B@@@@B@@@@@B@@@@@@ABAB@B@@@@@@ABA@@@@@BBBB@@@@@B@B@@A@C@@@@@@B@BB@A@@@A@@AA@@@@@@@@B@@@B@@@@@B@@AB@@@@@@B@B@@@@@@@AB@@@B@@B@B@@@@@A@@BC@BB@@BBBB@@@D@@BBDBBB@@B@@@BB@BBB@@BB@@B@B@D@BB@@@B@@B@B@B@B@@@@B@@@B@@@B@BD@B@@@BBB@@BB@@@@B@B@@@BDA@@B@BAB@@B@@D@DA@@B@B@@@DBB@DAB@@@BAB@@@BABCB@@@BBFDBBD@BD@BBBB@DB@BDB@B@B@B@B@@@@BB@@BDBB@@@@@B@@B@@BBA@@D@@@B@BBBD@@@B@B@@@BABBB@@B@@@D@DBB@@@BB@AD@D@@ABA@CBAJD@C@@B@B@@@B@BA@@BAB@@B@@@B@@@@@B@@@@@@A@AB@@A@@B@@@B@@BBB@@B@B@@B@@BBABBDBB@BB@BB@DBB@@@@B@BBB@@BB@@@C@@B@BF@@@@B@B@DB@AD@@BD@@@B@B@B@@@@A@@B@@@B@BC@A@C@A@@@AA@@@A@@A@A@@B@B@@A@@@A@@@@@ABABA@@@A@AB@@ABBB@B@B@D@@@B@B@@@@BB@@@D@@@@@@A@A@@@@D@BAB@@A@@@@B@B@@@BA@ABA@@@AB@@A@AB@B@@A@@@@@@B@@@BB@@B@B@@A@@B@DADABAB@@A@@@A@A@AAA@@AC@@@A@@A@@@BA@@@AAA@A@@BAAABAB@BAB@@A@@AA@A@AA@@@@C@C@@@AB@@ABA@@@@ACAC@AA@@@@@B@B@BA@@@A@A@ABA@A@@@@@@BAB@@@B@B@D@@@B@B@AACA@@AA@@BA@@B@@@@@B@B@BA@AB@@C@A@@BA@@BC@@@AB@@AAABA@@@@B@@@@@D@B@@@@B@@B@B@BBBABBDAB@B@@@B@DA@@@A@@@@BAB@@@BA@@B@@@D@@@BA@@@A@A@@BCBAB@B@@@BA@@@ABA@@B@B@D@@BB@@AB@BBB@@@@@@AB@@ABABABA@@@BBB@@BB@@BB@BB@@@@B@@B@@BBDBBB@@BB@@@BB@B@@B@@@@BB@@B@@B@@@@BDB@B@@DB@@B@@ABABA@AB@@@DADA@BB@@BB@@DBBDBB@B@@@BA@@@AB@@A@@@AB@@@@A@@B@@@@BB@@@@@@@BBBB@@@ABAB@@@BAB@@@B@@AB@BA@ABA@AA@AA@@@AA@@@@A@@B@@A@@@ACE@ABAAA@@BA@@A@@A@AB@AA@@@ABA@ADA@C@@@AD@B@@B@A@@B@B@@@BAB@BA@AB@B@BA@@@@BA@A@@CACA@@@AA@@@BA@@@AB@@A@@AA@ABA@ABA@@@A@A@AB@BC@@B@BA@@BAB@BA@@@A@A@@B@@@B@@B@D@@BB@@@@BA@@@@B@BED@BA@@@@@C@A@A@@@@@ABA@A@@B@@@BBBB@@@BD@@@BBB@B@@@@A@A@A@@@@@@D@BCB@BAB@@A@@@@BA@@BB@@B@BABB@@BA@@@A@AAA@A@@BA@A@A@C@A@@@A@@@@A@@@@@A@@A@@@@@@A@@BAA@@@@@@@@E@@@@@@@@@@AA@@@@AA@@@A@@@@AA@@@@@A@@@AA@@A@@@@AA@AA@@@@A@@AA@@@A@@@A@A@AA@@@@A@@@A@A@@@@@AA@@A@@@@@@@A@@A@@@A@@@AAC@@@@A@A@AAA@A@ABA@AAA@@@AA@@@@A@@@@A@@@@ABA@A@@@AA@@A@@@AAA@AAA@AC@@A@@@A@@@@@@@AA@@AA@@@@B@@A@@@@AAAAAA@@@@@A@@@A@@A@A@@@A@@@ABA@AAA@AAA@AA@@@AA@A@@@A@A@A@@@A@@AA@@A@@AC@A@@B@@AB@@@B@@@@@@@BA@A@@@A@@A@@@CCA@@@A@@@CC@@A@@@AA@@A@A@@@AA@@@@A@@@@@A@@@A@@B@@@@A@A@@@AAA@@@@AAA@@@@@A@AA@@@@A@@@A@C@@AA@A@@AA@@@AA@@A@@@A@@@AA@A@@@@@C@@AAAAA@@@@BA@@@@@ABA@@@C@A@@BAB@@AB@BAB@@@B@@@@@BABA@A@@@@AAA@@AB@@ABA@@B@B@@ABB@@B@@A@@@A@A@A@A@@BBB@@@@A@@@A@@@@BA@@@@@AAA@C@@@A@A@@A@@@@A@A@AA@@@BADA@@@@B@B@@@B@@A@@B@B@@@B@BAB@@@B@@@B@B@@@B@B@B@@A@@B@@@@@B@@@B@@@@AAABC@C@AAA@@A@@@@A@@@A@@@@AAAA@@@A@AA@@ACAAA@@C@A@@@@B@@A@CBA@A@@@@B@@AB@@B@@B@@CBAB@@@@@@A@@AAAAE@A@@@@B@@@@@BB@AB@@@@@B@@@BB@@@AA@@A@A@CAAB@@@@AB@@CA@@@@A@@@A@@AA@AB@B@BABA@@BA@A@@AA@A@AA@@@AAA@@@A@@@AA@A@@@C@@@A@@@A@@BAB@BA@@@@B@@@B@@A@@BA@A@@@A@@@A@@@@@A@CB@@@B@@@BA@@B@@@B@BAB@@@@@B@BAB@@@BA@A@@F@@@B@BCB@@@@@B@B@@@@@@@B@B@@@BAA@@@@AAA@AAAAA@@A@A@AAA@@@A@@@AAA@@AA@@A@@AAA@BACA@@A@@@AA@@@@@C@@CA@@@ACAA@AE@A@@@A@@@@@A@@@@A@A@@@@@@A@AB@@A@ABA@AB@@A@CB@B@@ABA@A@@@@@@AA@@@@A@@AB@@A@AA@AA@@@@@A@A@AA@@@@A@@@@@@@A@@AA@@A@@@A@@@@A@@@A@@@AA@@@@@AA@@@@@AA@A@A@AC@@A@A@@A@@@@@A@A@@AAAA@AA@@A@A@@@A@A@A@@AA@AA@A@@A@@@@@A@@@@@A@@BA@A@@CAAAA@@@@@AAAA@A@@A@@@@@@B@@@BC@AB@@A@A@E@@@A@@B@@@B@@@B@BA@@@@@BB@BA@CBA@ABAB@@AB@@@B@@BBC@@AAC@A@AAAA@AA@@@A@@A@@AA@@@AA@@@A@@AA@AA@AA@@@@@AA@@AA@@@@ABCA@@AAA@@AA@AA@AA@@CC@@AA@AA@A@CBA@CC@@CAAAABAAABA@A@@@A@@@A@CAA@AA@A@@AA@@@A@@AAAA@BA@AAAA@A@@AA@@@AC@AA@BAA@AA@@@@@@C@@CC@@A@AA@@AAA@@AAB@@A@A@@AC@@@@AAA@AC@A@@AA@@ACAABAAAB@AACAA@@@C@@@CC@CB@@AAAA@@A@AA@@A@AA@AA@@A@AA@A@@AA@@@A@AA@A@CA@AAA@@AAAA@@A@@AAAAA@@@CEE@C@@@A@AA@BAAA@@@@AA@CAAAAAAAAB@@AAA@@@CD@@AAA@C@A@A@EC@@A@AA@BCAC@A@ABCA@B@CA@AA@AA@@CAAABC@AA@AC@@@A@AA@@C@A@@@A@AA@AA@BA@@C@@@AA@@ABCB@@@@@@AA@@@@@@A@@@ABA@@@@@@BA@@@@B@@A@@@@@A@A@@@@@A@@@@A@@A@@@A@AB@@A@A@AA@@A@@AA@A@@AA@@AA@@@@@A@A@A@@@@@@@A@@@A@AA@BA@AAA@@@@BA@@AAC@@A@@AAC@@AC@AA@A@CAABAAA@C@AA@@ABC@A@ABA@AAABCA@@A@@@A@A@EBA@A@AA@@A@@A@@A@@@G@AA@@A@A@@A@@A@@@A@ABAAA@ECC@@@A@AA@@@@@AC@ABA@@AA@A@AA@A@@A@A@@@A@A@@A@@A@AA@@AAA@@A@AAAA@@AC@@@AAA@E@@@AA@@EBC@C@AB@@@AA@@A@@@A@@@@@A@AB@@C@ABA@CBEBC@A@A@@@C@ADC@ABE@ABCA@@ABAAA@AC@A@AAABA@@@A@AAIEA@@@A@@AAAA@@@A@@@A@A@AB@@A@A@AB@@G@A@@A@@CBA@@AA@AAAAA@@@CAA@@A@@@@A@@@AACEA@A@ACE@@AA@@AA@A@@AEACB@AA@AAA@A@CAAAABC@@BA@@ACBAAA@@A@@@A@AA@A@ABCAABCBC@A@@@C@CBEBAB@@A@@B@@@@AB@BA@A@A@@@AAECA@@A@@A@@@@AA@@BE@A@A@AA@@AAC@CBAACBA@CB@@CBA@EB@AA@@@ABC@@AA@AACB@@ABAAA@@C@@@@CA@A@A@ABCCA@@@AA@AB@CA@@@@A@@CACBAA@@A@A@AA@@A@AAABAC@AC@EA@A@A@@AAAB@ACDA@A@@@CAA@A@AA@@CDA@ABACA@@@A@A@@@@@AA@A@@@A@@@A@@A@CAAAAAA@A@@@A@@AAA@@B@@EA@A@@A@@AA@@@C@A@@@ADC@@B@@ADCD@DG@AAAAAAC@A@AAAEACCA@E@A@ACEAA@AAA@C@C@A@AAC@CBAAAB@@CBA@A@AAEC@@C@A@@BAAAAEEA@AA@@GC@@A@A@A@AAAAEBCAC@CA@@@B@@@BCDA@@@@BGHA@ABAB@BC@CD@@AAC@C@C@AAA@AAABAA@A@A@A@@A@CAEA@AC@@A@AAAC@@AA@@AC@A@@BA@AA@BCAAAAAC@C@AAB@ACA@AAAAA@A@CCA@@@@AA@A@@@A@@AA@MACA@@@AA@AB@@A@@@A@A@@@C@@A"],encodeOffsets:[[110154,22250]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
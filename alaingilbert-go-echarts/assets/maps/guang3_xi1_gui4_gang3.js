@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("贵港",{type:"FeatureCollection",features:[{type:"Feature",id:"450802",properties:{name:"港北区",cp:[109.57224,23.11153],childNum:1},geometry:{type:"Polygon",coordinates:["@@EGA@AAAA@E@@A@AA@@@A@AB@@A@A@@BA@CA@AB@AAA@@G@@@@@@BBDC@A@@@@AB@@A@@AA@@@@A@@@@B@@A@@@@@@B@@@@A@@@@A@@B@@@AA@@A@A@@@@A@@AB@@@@@A@@@@@A@@A@@@A@@B@@@@@@@@@@@A@@@A@@A@@@@B@@@AA@@@@@@@@@@@@@@@@@@AAB@A@@@@@@@BA@@@@AB@@@A@@@@@A@@@BA@@@@A@@@AB@@@@@A@@@@A@@@A@@B@AABBA@@@@@@@A@@A@@@@@A@@A@B@@@@@@@@A@@@@B@@@A@@@@A@B@@A@@@@@@A@@@@A@@@@@@@@@@@@A@@H@B@@C@GB@@@@ABC@@@A@@@A@EBABA@@BC@A@E@E@G@A@AB@@@A@@A@A@@@@@@DA@ABAA@@AFAAA@@@C@@@@@@BA@A@@@@@B@@@ABYCAA@@AAKK@@@BA@@@AB@BAAA@ED@@@@@BB@DB@@CB@@AD@@@@BB@@AD@@A@A@@B@@ADA@@BA@@B@@@@@DAB@@BB@@B@DBB@@@@@@@@B@@@D@BB@BB@BB@@BBA@@BA@A@@B@@@B@BB@BB@D@BA@@@@@@BC@@@AAE@@@AB@@@B@@@BB@@@BBB@@@B@@@@AB@B@B@@A@AB@@@DBB@@ABFABBD@D@@D@B@@E@@D@BFBD@@@BD@D@@B@@@@B@BA@@BA@CDCB@@AB@@@BD@B@DB@@BBC@C@GA@@A@C@AB@BB@@B@B@@@B@@@@A@@@@@ABBB@@B@@@@B@D@@ABA@@@@B@@@@A@@@@@@BA@@@BB@@@@B@@@@B@@BB@@A@@@@@@B@@@@@@@@@B@@@@@@@@@B@@A@@@FB@A@@@@A@@@@AB@B@@AD@B@@@@BA@@@B@@B@@@BA@@@JB@@@BD@BB@B@@A@AAA@AB@@@@CA@@@B@@C@@B@B@BA@@@A@@B@@A@@@A@@@BB@B@@B@@@BB@@@@@B@@H@@B@D@BA@@B@@@B@@CD@@AD@BA@BB@BBB@BBBBD@B@B@BBB@@@B@BB@BD@@@B@BB@BBBB@BBB@@@BB@BB@@CBAAA@A@@@CBABAB@B@@@@BB@@AB@@@@@@AB@@@@@@@B@@@@AA@A@@A@@B@@@BB@A@A@@A@@@BA@@A@A@@@@AB@BA@@@@AA@@@AA@@@@@A@@@@@BA@@BC@A@C@@B@@@B@@BBB@@BB@B@B@@@@BB@@BA@@@@@@@@BB@@BA@A@A@@@@@A@A@@@@@AB@@@B@@A@@@@A@@BA@@A@@AA@@A@@A@@@@B@@@A@@@A@@@@A@@B@@@A@@@@@@@@@A@@BB@@@@BAB@@@AABA@A@@AB@@CA@@A@A@A@A@@@AB@@@B@B@D@@AB@@A@A@A@@BA@@@AB@@AB@@AB@@A@@@AA@@A@A@@@A@A@@BA@E@@B@@@@@@@@AB@@A@@@@@A@@DBB@B@@@@@B@@A@@B@@@BA@@@@B@@BB@@BB@BB@@B@@BBBB@@@BBB@@@B@@@B@@@B@@@BB@@DBB@@@@AB@BB@ABBB@@@B@@@BB@@@B@@B@@@BBBBB@@@B@@BB@@BB@@BB@@@@@@B@B@@@@@B@@B@@B@@B@B@BCBA@@@@B@BAB@B@@AB@@A@@A@@@@ABA@AB@@@AA@@@@@@B@@AA@@@@@BB@DDB@BBD@D@B@BB@@B@@AB@BB@@BADB@@B@D@@@@A@ABCAABABA@A@@@@@@B@@B@BD@@BB@BB@@BA@BB@@@B@BA@B@@B@@ABB@@FB@B@B@B@@B@@@@@@A@@B@@BBB@@@AB@@BA@A@@B@BA@@@ABD@@@B@B@BABA@@BA@@BBB@@@BB@A@@@@BB@@BB@@B@B@@@@@B@BBB@@@BAB@@@@@BD@@@BB@B@B@BA@@B@BB@@DAB@@@DB@@BBB@@@@B@@BB@BB@@@@@B@@B@@@@AB@@BBBBB@@@@B@@@B@@@@A@@@@@AB@@@B@@@B@@A@@@A@@@@@@@@BB@@@@B@@@@@@C@A@@B@@@@@@B@D@@B@@@B@B@B@B@B@@@BA@@B@@@@ADB@@BABAB@@B@@B@B@DBBA@@BABA@@B@@B@@B@@@@@@@BB@BBB@@@@B@@@B@@@@B@@C@@@@B@B@BAB@@AB@@@BBB@@@A@@BAB@BABAB@BA@@@A@@@@BAB@BD@BB@@BB@@BB@@@@@@BB@@B@@@@@BBB@@BBB@@@BB@@@B@B@ABBB@@B@@B@BBB@@@@BB@@@@B@@@B@@@B@B@@F@@B@@@@@D@@@BB@BBB@B@B@@@BB@@@@@@@BBB@B@@@@BB@@B@BB@B@BBBBBB@@B@BB@@B@@B@@AB@@@@@@@BB@@@@@B@@@@B@BB@@DB@@@BB@@@B@@B@BA@BB@@@@@B@@BBA@@B@@@@@BB@@B@@DB@@D@@@BBB@@AB@@@B@@@@@DA@ABA@@BB@@@BB@@B@@@B@@@@@BBB@BB@BBB@@AB@B@@@BBB@D@B@BB@@@B@@@BBBB@B@B@@B@@B@@BB@@AB@BB@@@@BB@@@ADA@ABAB@@@@BBB@BBB@BB@@@@@BB@BBB@@@B@BB@@B@@BB@@@BBB@BABB@@B@B@@@B@@A@@@AB@@A@@@@@A@A@@AAA@B@@@BA@A@BB@@A@@@B@@BB@@B@BB@@@@BB@@A@@@@B@@B@@@@@@B@@B@@@@@B@@@@@B@@B@@@@@B@@@@BBB@B@@@@BB@@@BBB@@@@BBB@@@@B@@@@@BBB@@B@@@@A@@@@B@@@B@@@BBB@@@@@B@@@BB@@BAB@@@@@@BB@@B@@B@@B@@@@B@@@@BB@@B@@B@@@@@@BB@@B@@@B@@@@@@BB@B@@@@B@DB@@@BB@@BB@@@@B@@@@B@@@@B@B@@@@@BBB@BD@BB@@@AB@B@@@@BB@@B@@@@B@@DB@@@B@B@B@@@@@B@@@@@B@@@BBB@B@@B@@BBB@@BB@@B@@B@@B@B@B@@B@B@@BB@B@@@@@B@@BB@B@@B@BB@B@@@B@@BB@@BB@@B@BBDABBB@@BD@BDB@BB@@@B@@B@BB@@B@B@@@@@B@BABC@ABA@@B@@@B@BA@@DC@@BA@@@@BBB@D@@B@@BAB@@CB@BCB@@A@@@AB@@@BBB@@ABA@A@A@@@A@@@@@A@A@A@AB@@@BA@@@@@AB@@@@AB@@A@AF@B@@ABA@BBBBAB@BABBB@@@B@BBBA@@B@B@B@@@BABDB@@@BABA@A@CB@@@BBBBB@B@B@BABA@AB@@@D@B@FA@AB@D@@AB@@@B@B@@@BADA@@B@@@@ACA@@@AACB@@C@A@@AC@@@@@ABA@A@@DA@@BABCB@@A@ABA@A@@B@B@BBB@@@BA@@B@@ABA@@@A@@@@BB@@@@D@B@B@BA@@B@@C@A@AAC@A@@@@@B@@A@@@@A@A@ABAB@@@@@@CB@@A@A@@@A@@B@@ADABABABABABB@@@B@BBAB@B@BA@BB@AB@B@B@BB@BBBB@@BB@@B@@B@@BB@@@@BADA@@@A@@@ABA@A@ABA@@@A@@@@@@@@B@@E@@B@B@@@@A@@@A@@@ABABA@CD@BA@@BA@@B@@@BA@A@@B@BAB@B@BABABAB@@@@A@@@@@ABADCB@@A@ABA@A@AB@B@@A@@@C@@AC@A@AAC@CDAD@B@@@@B@@@@@B@@DA@@B@@A@@@A@@B@@@@@B@@@@B@@@B@@B@@@BA@@BBB@B@BB@@B@@@BABA@A@@B@@@BB@@B@BA@@@AB@@@B@B@@@@@@A@A@A@ADA@@@A@@AA@@@A@@BA@@AA@@A@A@AAA@@A@@@A@@DCFG@@B@@@B@@@@@@A@@@@B@@@@@BA@@A@@A@@@@B@B@@@@@@A@AB@@AA@AA@@A@@@@AB@A@A@@@@@@@@AB@@@@AA@AB@@@@@A@A@@@@@@@@A@@BAA@EIWAG@@@@B@@A@@@@BA@@BA@@CGHA@C@GAKI@@AC@QA@@@@BA@@@AA@@@BAA@@A@@@A@@A@@@@A@@B@@AA@@@@@@@@@B@@@@AA@@A@@F@DG@@BA@@@@BB@@B@@@@AB@A@@@@@PC@@@A@@@A@@A@E@ACA@ABA@@@A@AACB@@AB@@E@@BA_BKA@BA@@@A@@@@@A@C@@@@B@B@D@FBH@@@@@BC@C@ABA@AAC@G@CBA@@A@@AB@@@@A@@BAA@B@@A@@@@DABAB@B@B@BAB@@@BA@@@A@A@@@AACACA@A@@@@A@@@@@@A@@@@A@AA@@@@@A@A@@AA@@AA@A@A@@@@@@@A@@@A@@A@AC@@@@@@AA@@@@@AA@@@A@@@ABA@@AA@@@@B@BCB@@@@A@@@@BABA@@@AB@B@@AB@@@BA@ABAB@@@BB@@B@@@B@@ABA@A@@B@BA@ABAB@@AB@AABA@@@@BA@@B@D@B@B@B@@@BA@@BA@A@@B@@@D@AC@C@@@A@@@A@A@@@A@A@@BAF@DAB@D@@A@@BAB@BA@@B@@A@AL@@@@CDCBADCDEBA@ABABC@A@CAA@A@AAAA@@ACAE@AA@BAAMDMBIBI@A@C@A@CAECAACEEIACCECG@@A@@ACCAA@@A@AAE@@@IASA@@K@E@MBKBOFEB@@CB@@AD@@AB@DAD@D@DALAB@DAB@BABCHABAB@BC@@@@@MDABC@C@C@C@@@C@AAAACAAAAAACCGAA@AAAA@AACA@@A@A@ABQHIDEBA@C@ABAAI@C@C@GBC@A@A@I@C@@@CA@AA@@AACACAC@G@AACACC@AAE@I@EAA@@@@@AAAAA@CEAAAAA@CAABA@C@ABA@@@ABA@ABABEDEDCB@@A@A@ABA@A@A@A@A@@@A@EAAACA@@AAA@AAAACAA@CCCAAAAAACAAAC@CAA@C@AAC@A@@@ACC@@EECAAACDFF@@A@AA@@@@@@A@@AAB@BA@A@AB@@@@"],encodeOffsets:[[112202,23612]]}},{type:"Feature",id:"450803",properties:{name:"港南区",cp:[109.599556,23.075573],childNum:1},geometry:{type:"Polygon",coordinates:["@@CA@@@@@AA@AAAB@@@@A@A@@BA@ABA@A@AB@@A@@@@@ABA@@@@A@@@@@B@@AB@A@@@@@@A@@AB@@@@@@@A@@@A@@@@@AA@@A@@A@@BABA@A@@@A@@@AA@E@@@A@@ACB@AC@A@@@AB@AA@A@@AA@A@@@A@A@AA@@AA@@A@AA@@A@BAA@@AA@@@A@@@@@@@@@AD@B@@@B@@CDAB@B@@@BABA@@@A@A@CB@AA@A@@B@B@BB@@B@@@B@@@BBB@@@@@@@BA@@BA@@@A@@B@@BBB@@BB@@@D@BB@@BBB@BB@@BBBBABA@@B@@CB@@@@@B@@@D@@@B@BCD@B@@ABA@@@@B@@A@@BA@@BABAD@@@BA@A@@@A@A@@@A@@@@@@B@B@DBB@@@@@B@@A@@B@@@@@BA@@@@@A@@@@@AD@BA@@BAB@BA@@BA@A@@@AB@@ABA@@@A@A@A@AA@AAAAA@@CA@BABA@@@@B@@@B@B@@AB@@A@@BA@@@A@@B@@BBB@DBB@@BBB@@@DB@@B@@A@@DABAB@@@@AB@@AB@@@B@BABCBABA@@B@@@B@@AB@@A@@D@F@@@BDDBB@@DDABADED@BA@@B@BB@@D@B@@ABAD@@@@A@@@A@AB@AAACAA@ABA@C@A@AFABABAB@B@@@B@@BD@B@@ABC@CDCCC@A@A@A@@@AA@B@@@BA@AB@@A@A@A@A@@BA@AAABAAA@A@@A@@@AA@@@A@@@C@A@A@AAAA@@A@A@A@@@A@@@@B@B@@A@@@A@A@AB@AA@A@@@A@ABA@A@BA@AAAA@C@A@A@@@A@@@A@@@A@@B@@@B@BB@@BABBB@BB@@@BA@@B@@B@@@B@@@B@@BB@B@@@DABA@@DABAB@@@BBB@@BBB@B@@BBB@B@B@@BBAB@B@@@B@@BBBB@B@@BBBBB@@B@BA@@BAB@BAB@BBB@@@@@B@@BBB@BBD@BAB@B@DA@AB@@C@@B@BAB@BBDB@@BB@@@B@BB@B@BBA@CDA@@BAD@B@BA@A@A@@BEDAB@BA@AB@@AAA@ABBD@@DBBBDDDBDDJFFFFDHDBBHDBBDFBB@D@@BHDDDDDDBB@@BBB@BBDBB@B@B@@@BBB@@@BB@@BB@@BD@B@D@BAD@B@B@BBD@DBB@D@BBD@JBH@JA@AH@BAB@B@D@F@D@D@BBD@B@BBDBD@@BBBBB@@@D@F@BAHAFCBABAB@B@FADABADE@A@@@@@AAA@@BA@@@A@A@@BA@ABAB@@AB@D@@@@@B@BBDBDBFBPDFBD@HBF@J@B@FBBBB@BB@@AD@DABCFCDEDKHGFC@GDMDIBEBE@EBCBIDABA@ED@B@@AD@B@DBBDFDDFFDFBDBBHHDDBB@@BB@B@B@@@BADABABC@C@CBCAGACACAE@IAGAA@A@CBA@ABABAD@B@BBFBBBB@@BDBBBB@DBD@B@B@B@@CDABC@G@A@CB@@C@AB@@A@AB@D@@BB@BBB@BB@BBBBB@FBDBDBB@BBB@DFBD@B@@@F@@CD@B@@GFA@CDCD@B@B@B@BBB@BFDFB@@BDDBFF@@DD@B@@@BBD@B@DBB@DBDBBBDBBBBDBDDB@DBBBBBB@BB@@DBBBFBB@@@B@B@B@B@B@BAB@B@@@DAFCFCBABAB@BA@@B@BAD@B@BADBB@BBBBDFB@BBBB@@@@B@FBJ@F@BBD@BDBD@B@HBDBDBD@BB@@BDB@@D@J@B@B@D@HAD@D@J@BBBAD@B@FAJCRGBAB@B@@@DBBBB@BB@BBBDHBDBBBBDBBBBBD@@@D@D@D@D@BANC@@@@D@@ABABADGBA@ABA@CBABK@C@CBC@CBA@@BC@@DA@@FAPELANAF@L@@@TBJB@@F@BBB@@@BBDD@BB@@@DHDFBDFJDFBBFDDBB@D@B@J@JANANCBB@AAEAAAA@ADA@A@@@@BA@@B@@@BBBDBB@D@@@@BBB@BA@@@@@@CEAA@A@A@@@A@@@@@@B@FF@@@@B@BB@AD@@@@A@@@@@AE@A@@AA@@AAA@A@@@A@@BA@@B@D@B@B@B@@BB@B@@@B@@A@@@A@@@@CAA@A@A@@@AA@A@@@A@@@@B@BAB@B@B@D@@@@AB@FCBADA@@BA@@@AA@CA@AAC@AAE@A@@@A@@BC@@AC@A@@AC@AAA@@@AAA@@@ABA@@BCB@@@@AB@@C@@@ABA@@@@@@BA@@@A@@@@@@FE@@B@@@BA@@@A@@BABA@A@@B@@@@@BA@@@@@@@@@@@AA@@@@@@@@@@@B@AA@@@@A@@@AA@B@@AAA@@@A@@@@B@@AAAA@@@@@@B@B@@@@@@A@@A@@@A@@@@@A@@A@@B@@@@A@@@@AC@@@@@B@@@@A@@A@@B@@A@@@@@@@@@@@AB@@@@AA@@@@A@@@A@@@@@@A@@AA@@@@@@C@@@A@@@AA@@@AAA@@B@BA@AB@B@@@@BB@@@@AAAB@@@@@B@@A@@@AA@@@A@@B@@A@@AA@@@A@AB@@@B@@@DCBA@@@C@@@@@AA@A@@@@AA@A@@B@@@@AAAC@@AB@@A@A@@@@@@@A@@BA@@A@@@@@@A@@@@B@@@@@@B@@@@@BB@@@B@@@@@B@A@@AA@@@@@@@B@@@@@B@BBA@B@@@@AB@@@AA@@AA@@@@@@@A@A@@@@@C@@B@@@@AA@A@@@@@@@@@AA@@@A@@@@@@AB@B@@@@@@BB@@@@@@A@@@AA@@@@@@@@A@@@@@@A@@@AB@@AAA@@@@@@@B@B@@@@A@@@@A@@@@@@@A@@A@@@@A@AAA@AAEB@@AC@@AA@@@@A@@A@@@A@@@BAA@@A@AA@AA@AA@AA@@@@B@@A@@@B@@A@@BA@@@@@AA@A@@AAA@@@@@BA@@@@@A@@@@@@A@@@@B@@@@@@A@@A@@AA@@@A@@A@@@AA@@A@BA@@@A@@A@@@@A@@@@@A@@@@B@A@@@@@@@@@A@@@@A@@@@B@@@BA@@@A@@@A@@A@@A@@AA@A@A@@@@A@AA@@@AB@@@B@@@@ABA@@@@@A@@B@@A@@B@@@@A@@@A@AA@BA@@@A@A@@@@AAA@@@@A@@@A@@@@@@A@@@@@AAB@@@@@BA@@@@@@@@AC@@@@BA@@@@@@A@@@@@@@AB@@@@A@@@AA@@@@@B@@@AAB@@@@@@ABB@A@@@@@A@@@@@@@@@AA@@A@@@A@@@@@AA@@A@@@A@@BB@AB@A@@AB@AA@@@@@@@@@A@@@@D@@@B@@@BB@@@@@A@@BA@@A@@@BA@@@@@B@@@BB@@@@@BAA@@@@@BAB@@AB@B@@AB@@@B@@@@@B@@@@@BB@@B@@@@@@BA@@@AB@@@B@@B@BB@@B@@@B@@@B@B@@@@A@@@@AA@@@@A@@@@@@@@A@@@@@@AA@@B@@ABA@@@A@@B@@AA@@@@@@@@@BA@B@@B@AAB@@@@@@@@A@@B@@@@@@@B@@@@A@@@A@@BB@@@A@@@@@AB@@A@@AA@@@@AA@@B@@@@@@A@@B@@A@@BA@@@@@@B@@@@A@@BA@@@@B@@@BA@@@@A@@A@@@A@@@@@AB@B@@@@@@@B@@A@@@@@A@A@@B@@@@AB@@@@A@@@@@A@@@@BA@A@@A@@@@A@@B@@@BAB@@@BB@B@B@@B@@@@@BB@@@@@@DB@A@@B@@A@@AA@@@@@A@@@A@@A@@@AAA@@@A@@A@@@AB@@@@@B@@@@@@@@A@A@@B@@@@AA@BAA@AABA@@@A@@@AA@@A@@@@A@@@@@@@B@@@@@B@@@B@@@A@BA@@BABC@@BCB@@@D@BA@@B@B@@@B@BA@@D@BAB@@@BA@@B@@A@A@@B@@A@A@@A@@AA@@BA@@A@@@@CA@@A@@@@@A@A@@@AB@@@BA@@@@@@@C@A@AB@@@@A@@BA@@@@@BB@@A@@@@@@@@A@@AB@AA@@B@@@@A@@@A@@@@B@@CA@@@@@@BB@@@@@@A@@B@@@@@@AA@@@@AA@@@A@@@B@@@@@@@A@@@AA@@A@@A@@@A@@@@C@@@A@@@@@@A@@A@@@BA@@AA@B@@A@@@@@A@@@@@@@@D@@@@@@A@@AB@A@@@@@@@@BA@@@B@@B@@A@@BA@@B@@@BAB@BC@@BAB@@@@@BA@AB@@@@A@A@C@@@@@@@A@@AA@@@A@@@@B@@A@@@A@@@@@@B@B@B@@@BAB@@A@@B@B@BB@@ABBB@@BA@@@BBBA@@@A@A@@@@@A@@@AB@@@@ABA@@@@BBB@@@@B@B@@@@BBB@@BB@@BA@@@@BA@@@@@A@@@AA@@@@@BA@@B@@@BAB@BA@@@@B@@@@A@A@@@AA@@AB@@@@A@@@@B@B@@@@@@A@@@@B@@B@BB@@@@ABB@@AB@@@@B@@@@A@@B@@@@A@@BB@@@A@@BA@@@@AAAAB@AAAA@@A@CA@@@BA@@@@@@@ABA@@@@@AB@@@BA@@BA@@AAB@@A@@A@BA@@BA@@@@AA@@@@@A@@BA@A@@A@@A@AB@@@@@@@B@@B@@@ABA@A@@@@@A@A@@BA@@@A@@@B@@B@@@@BB@@@B@@@B@@@B@B@@@@@@A@@@AB@@@B@@A@@@@A@@@@@@A@@@BB@@AB@@@@A@@@@@A@@@@@@@@@@A@@@@A@@@A@@AA@@@@@AB@@A@@@@A@@B@@A@@@@C@C@A@@@@B@@@BA@@@A@@@@@A@@@@@@@A@A@@@@AB@@@@@AA@@AB@@@A@@A@@@A@@A@@@@ABA@@@@AC@@A@A@ABA@@BCBC@@AA@@@B@@AA@@AB@@@A@@@@@A@@AAB@@A@@@A@@BA@@@@@A@@@AAA@@@A@@@A@ABA@@A@@@@@A@A@@@@A@AB@@AAA@@@@@@BA@@@B@@B@@@@A@@@@AA@@@BA@@AA@@@A@@@A@AAA@@AA@@@CBAA@@@@@A@@@@B@A@@@@A@@AA@@@@@A@@@A@@C@@@@@@@AAB@@@BAD@B@B@@A@@@@BA@@AA@@A@@@A@@AA@A@BAA@@@@AA@AB@@@@@@AA@@@@@@@@@@A@@B@@A@@@@B@@@B@@@B@@@B@@@@A@C@@@@B@@@BA@AB@@@@@@@B@@A@@@@A@AA@@B@@A@BB@@@@@B@@@@AB@@@@A@@@AB@@ABAAA@@@A@@@@@AA@@@@@@A@A@@@@@@@@A@@A@@@@@A@AA@@@@@@AB@@@@@@@@@@@@@A@@@@@@@@@@A@@@A@BBA@B@A@@@@@@A@@@@A@@@@AA@@@AB@@@B@@@B@@@@A@A@@@A@AD@B@@A@@BAAA@A@@A@@A@@@@AB@@ADC@@AA@@@A@@@AB@@@AA@@AAA@@@@@A@A@@@@A@@A@@@AA@@@AA@@@@@@@AA@@@@@@A@@@@@A@@@@@@@AA@@@A@@@@@A@@A@BA@A@@B@@AAA@@BAA@@ABAB@@@@@@A@@@@@AB@@A@A@C@@BAB@@@@@@@@@@@@@@A@A@BBA@@@@@AAA@@@@BA@@@C@A@@BA@@BB@@BAB@@A@AA@@@@AB@@@BC@@@@@@AA@@@A@@B@@@@CA@@C@@C@@@AA@E@@BA@@@A@A@A@CA@BA@@BA@ABA@ABAB@@C@A@@AA@AAAAA@AAA@A@@@CA@@E@@@@@A@@@@@@@@B@@@B@BAB@@AB@@A@@@@B@B@@AA@B@@A@@@@@A@@@@B@@AB@@@B@B@B@@@@@B@B@BA@@BB@@DAD@@@BA@ABA@@@C@@@CBABA@A@@@@BAB@@@B@BAB@BA@@@A@@@ACC@@@AB@@@@A@@A@A@@@@@@AA@@AAA@AAA@A@A@AA@@AA@@@AA@A@@@@A@@@C@A@C@@AA@A@@@CA@@CA@@A@C@A@ABCAA@ABAA@@@@@AA@AA@AA@@A@@@A@@@@AA@@@@AA@@A@A@A@@@@@@B@@A@A@@B@@@DA@@BA@@@A@AB@@AB@@A@@@C@@@ABA@AAA@@AA@AAABC@@@C@A@@@@A@AAAAAAA@@@ACAA@@@@A@@AA@AB@@@AA@A@A@@@AB@@A@@@@AA@@@@AA@@@@AA@A@A@@A@A@@AA@@@@@@AA@@A@@@ADE@@BA@AA@BA@@DAB@BABA@@@@B@@A@A@@@@AA@@BA@@@@AAA@@@A@A@@@A@@A@BAA@@BAA@@A@AA@@@@@AA@@A@@@ABA@A@ABA@@@AA@B@AA@@@@@A@@A@@A@@@@@AB@A@BAA@@@@@AA@@@A@@@@@A@@@A@@A@@@B@@A@@@A@@@@@A@@@@@A@@AA@A@A@@@A@@@A@@@AAC@@@@@@@@AABGE@AAACAC@@AA@@AAAA@@BA@AAA@@@A@@A@AA@@AAABAA@@A@@A@A@AABA@@CC@@A@CC@@@CA@@@AA@@@A"],encodeOffsets:[[112298,23210]]}},{type:"Feature",id:"450804",properties:{name:"覃塘区",cp:[109.452662,23.127149],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@C@@@C@@@@B@@A@@BA@A@AA@@@@AB@@A@@@A@@@ABA@A@@B@@A@@@A@@@AAA@@BA@ABAB@BA@A@@@A@A@@@@B@BA@@@AB@BA@@@A@AB@@A@A@@@AAA@AACBA@@@@@@AA@AA@@A@@@A@@@A@@A@A@@@@ABAF@@AB@@@@A@C@@@A@@@A@A@@@@@@B@B@BBB@BBB@BABBB@@@@ABBBA@AD@@C@CAC@G@@@ABA@@@@@C@@B@@A@EB@@A@A@@@@@A@C@A@ABABA@@@CBA@@B@BA@@@@@@B@@@@@BA@@@@@ED@@AD@B@@BBBB@@D@@BB@@@A@@B@B@@@BA@@B@@@B@@A@@B@B@@A@A@AB@@@@CBA@AB@@A@@B@@@DAB@@BBABAB@@AA@BA@@@C@@@A@@@A@@BAB@B@@A@@@ABABB@@B@@@B@@ABAB@BA@@B@B@@@B@BA@@B@@@@BB@D@@BB@B@@@B@BAB@@@@ABA@A@ABA@@@A@A@AD@@ABABAB@D@B@@AB@BA@@BA@@@A@ABCA@B@@@BA@@B@@ABABA@A@A@@@AB@@@D@@A@A@@@@BA@@@@@ABA@@@A@A@@@A@AB@@AB@@A@@@ABA@@@A@AAAA@BAB@B@@@BAB@BA@@BA@@BB@@@@@AB@B@@B@@BBBB@B@@@@B@B@@BB@BBBBBB@@BBBBB@B@@BBB@@@B@@A@@@@A@@@A@@A@@@@@A@@@@@@@B@@BB@@B@@AB@@@BB@@@A@@@@A@@@@@@@@A@@B@@@A@@ABB@@@@@@@@@AB@@@@B@@BB@A@@@@AAB@@@@A@@A@@@@@@@@@@@B@@@@@@@@B@@@@@BB@@AB@BA@@@@A@B@@A@@@@@@@@A@AB@@@@@@@AA@@@@@B@@@@@B@@@@@@A@@@AA@@A@@@@@@B@@@A@BB@B@@@@B@@@B@@A@@A@@AB@@B@@@AB@@@@@@@@B@BABAB@B@@@@A@@@@@@@@@@B@B@B@@@@@A@@AA@@@B@@@B@BA@@@BAAAA@A@@B@@@@AB@@@@B@@@@@B@@@@BB@@@DB@@@@@B@@@B@B@@B@B@B@@BB@@@FBBB@@A@A@C@@@AB@@BB@@@@CB@@@B@@@B@@B@BA@BB@B@B@DBDB@@BA@@B@@@@@B@B@@@B@AB@B@BA@@B@@@B@@@BABA@@@A@@@A@A@@@A@BB@B@@@B@@@@@D@@@@BD@B@DBAB@@@@@@A@@B@@@@AB@@@BAAB@BB@@B@B@BB@@BBBBB@@@@BBB@@BB@@@@@@@@B@B@@@B@@@@@BA@@@@B@@ABA@@@A@@BA@@@@@ABA@ABA@@@@BA@@BA@A@@BA@@BA@@@@BAB@@@@AB@@A@BB@BA@@B@D@@@BA@@B@@A@@@@@A@@@AB@@A@@@A@@B@@@BAB@@B@A@@B@B@@ABB@@@AB@B@BA@@@C@@@A@A@@AAA@@@@AAB@@@AAA@@@A@A@A@A@AAA@@B@@A@@@A@@@A@@@A@@@@@A@AB@@@BA@A@@@A@@@AB@B@@A@A@@@A@@@AB@@A@@@@B@@@B@@A@ABA@A@@@A@@@A@@@A@A@@B@@A@AB@AAB@AA@@A@@A@AAAAA@A@@AB@@@AA@BC@CBA@A@A@@@@BA@ABA@@@A@@AAA@@C@AA@@@@@BA@@@A@@@C@A@A@A@@@AAAAC@@@@@A@@@@@@B@@AB@@@@@B@@@BABABABAB@@D@@@DBBB@@@@B@@BBBA@B@@@@@@@@@@@@B@@@B@@CBA@AB@@@@AB@@@B@@AB@D@@@@A@@@ABAB@@@BBBBBB@@B@@BBBB@BB@@BAB@FA@@B@@CB@B@@@B@@@B@@ABAB@@@BAB@B@@@B@@@B@B@@@@@BAB@BGA@AAA@@AB@@@@@B@@@@@B@B@@@D@B@DBB@B@@@@@DBD@BBB@BAB@D@BBB@DBBB@@@@B@@@@@@@@@@BBAB@B@@B@@@@B@@@B@@@@@@@@BA@@B@@B@B@B@@BD@@@@@@C@@@BB@@@@A@@@@@@DBB@@@@@@AB@@@@@BBB@B@@@@B@B@@@@B@@@@AB@BA@BB@@@@B@@@BBBB@@B@@@BB@@D@@B@@@DBBB@@@@BA@@BA@B@@B@@@@@@@@BB@@@@@@@B@@AAAB@@@B@@@AB@B@@@@@@@@@AB@@@@B@@@@@@B@@@BA@@@@AA@A@@@A@@@@@@BB@A@B@B@@@@BB@@@BB@@@B@@@@B@@@@@B@@@B@AD@@BB@@@BA@@@A@@BAB@BA@AA@@A@@B@@@JADBD@B@J@@A@@@C@C@@BA@A@@@@BA@ABA@@BCB@@@@A@A@@@AA@BABAB@@C@A@A@@@@@A@@B@@@@@B@BBBAB@@B@B@BABAB@@B@B@B@@@BDB@@@BBD@B@@@BA@A@A@@BAB@BABABABA@@@@B@B@@A@@@@B@@@D@B@DBBA@@@@BAA@@AAA@@@ABAB@@@@A@@B@@@@A@@@A@ABA@@BA@A@EB@B@@A@ABA@@@@B@@A@@B@@@@@B@@@@B@@B@@B@@A@@BA@@BA@@BB@@@B@@@@B@@A@@DA@@@@B@D@B@BAB@@@BBBBB@D@B@@@@D@@@BA@@@A@@@@@A@@@DBB@B@B@B@BB@@BBD@@BC@AAA@ABAB@BB@@@BB@@@@B@B@BB@@BBB@BB@@@@A@A@A@@@@BA@AAA@AB@BA@@@A@@BA@A@@@AB@AA@@@@AABA@@@C@@BA@A@@@A@@BA@@@@@@@AD@@@@BB@@@@AB@BAB@@@@ABA@@DB@BB@@ADA@@@@B@@@@BBB@@@@@ABA@@@A@A@AAABA@ABA@@B@D@@@BA@@AA@A@@B@@@B@@BB@@BB@@@B@@@BB@@@B@B@DB@BB@BBBB@BB@DB@@@B@@AB@B@@@@A@@@A@C@C@@B@@B@@@@@BB@BDB@@@@ABABAB@@AA@@@@ABA@@B@@@@@DBB@B@@@@BBB@B@D@B@B@@@@@B@@B@BB@@BB@@BB@@BB@@@BBB@F@D@B@@F@BD@B@B@B@B@BAB@F@BHB@B@BABBBBB@@BBB@B@@D@B@@@@@@A@A@@B@@@@@@@B@@@B@@A@@DB@@BA@A@@B@BBB@B@@@BABA@@B@@@@@BBDD@@@BA@@B@@BB@@BB@D@B@@BB@B@@BB@D@@B@B@@ABA@@B@D@@@@B@BB@A@@BB@@@@@@B@@@@@@AB@@@@@BA@ABABBD@B@F@@BBD@@BBDBBDDDB@@D@@AFBF@B@BAB@DFA@@B@@BFADBDBB@B@@B@B@BFB@B@B@BA@@BBB@B@@@B@BB@@B@B@@@@@DBB@BBB@@A@@BA@@@@@@B@@@@B@@A@AB@@A@A@A@AA@@A@@A@@A@@B@@A@FFBB@@B@HF@@AF@@DBB@@@@B@BDD@D@B@@@@@BB@@@BA@@@C@@BA@@BA@@@@B@@B@BBB@BB@@@B@B@@AB@@@B@@B@@D@@@@CB@BA@AB@@@@@BA@@B@BA@@B@@@BA@@B@@@@BB@@@BB@@AB@@BB@B@BB@@BB@BBBAD@@@BB@B@ABC@@BA@@@@@@B@BBB@@BB@B@@@@@@A@ADA@AAA@@DAD@@AB@CAB@@@B@DCBB@@DAAA@A@@@@@AB@B@@A@@@@B@@A@@B@@AC@@CB@A@@AA@@A@@@A@@@A@ACC@@@CAA@AB@@AB@@@@@@CF@@AAC@@D@DBDABA@@AA@AB@@@DAB@BBB@@BB@B@B@D@BB@@BBDA@BB@B@B@BBD@BABBB@@BFA@A@@@ABA@@AA@AB@@@@ABADCB@@A@@BABC@@B@B@BBBBB@BAB@@AB@@@BBBAB@DA@AAA@@@@DAB@@A@ABA@@BA@ABAB@@@B@BB@@BB@@B@B@BDBDB@BBBDBD@B@BBB@@@BBBBB@BB@@B@BD@BBB@B@BBDA@@DBD@@BDB@@BA@A@@B@B@@BB@BBB@B@B@B@BA@@B@@@@BBB@@DAB@@@@AB@@AB@B@AABAB@B@@@BBBBB@BBDB@@BA@@@@@A@A@A@A@@BA@@@AB@@@@A@@@@@A@@@ABA@@@@@ACA@A@@@@@AA@@AB@BC@@BA@AB@@@@@BB@@BB@@@@@@@A@@@AA@@@A@@AA@@@BAB@B@@@AAA@@@@@@AB@@AD@@@@B@@B@@A@@B@FA@@@A@A@@@A@C@A@@BC@@B@@@DC@@BAF@@@@AAAAC@@@AAA@C@@AA@AB@B@DCB@BA@@BAB@@BB@B@BA@@B@@@B@DA@@@@@A@A@C@@@A@@@@@A@@@@@ABABA@B@@A@@@BB@@BCB@@B@@BA@@@AB@B@BA@AB@B@B@@@@ABA@@@AB@@@BA@A@@B@@@B@@A@@@@BA@@@@@AB@@B@@BABA@AB@@@@A@@@AB@B@B@BA@@B@@@@A@@BA@A@@@A@AA@@AA@@@BA@@DA@@@@B@@@@@@@A@@A@@@A@AAA@AAA@@C@@@@@A@@@@EA@A@@@A@@@A@@@@@AA@@@@AA@A@AA@@@AABAA@A@@@A@@A@@AA@AA@AA@@@@A@@@AA@@@@@@AA@@AA@@AAC@@ABA@A@@B@@@B@@ABABA@ABA@AB@@@A@AA@@A@@BA@ABA@A@@@@@@DA@@@@@@A@@@A@@A@AAA@@A@@@@@@@AA@@@@AB@BA@AB@AA@C@A@AA@@@BABA@AA@BC@@@@@AB@@A@@@A@A@A@A@A@@@AC@A@@@@@@@@AB@D@@@@@@@@A@@A@@A@@@@@@B@@@B@@@@A@@@A@@BA@@@@B@@@@@@A@@@A@@A@AAAA@@BA@@@@@AA@@@@@A@@AAA@@@A@@A@AA@@CA@@A@CB@@AAA@@@ABA@A@A@@A@@AC@@@@A@AB@@A@AAA@@@@@A@A@@@AA@@AA@@@@@BAA@@A@AA@@AB@@ABABA@A@@@C@BA@@B@@A@AB@B@@AA@@B@@AA@AA@@@@B@@@@A@@@@A@A@AEA@@AA@BA@@@@AABA@@@A@@AAB@@AAA@@AC@@A@AA@@@@@@@@BABABBBAD@B@B@@C@A@@@CAAB@@AAA@@BA@@@AAA@C@C@AAA@CCA@@A@@@@BB@@@A@@@@B@@B@@BAB@BA@@@@@BB@@@BA@@@ABA@A@A@@B@DA@A@A@AA@@@@AA@@@@@A@A@@@@@@@AA@@AA@@AA@@@A@@AAAA@A@@@AA@@@A@@A@@@A@@AABAA@@ABA@@@@AA@CA@@A@@@A@@@A@@@A@@AA@A@@AAAA@@@AA@@AAA@@AA@@@A@@B@@A@@@AB@@@@A@@@@@AAA@CB@@@@@B@@@BA@@@@@@@@@AF@B@@AB@B@@@B@B@@@BB@@B@@@BA@@BA@@BA@@B@@AB@B@B@@@BA@@@C@A@A@@BA@@B@B@B@B@@@DB@@BA@@@BABBB@@A@AB@@@@AA@@@B@@@@@@@@@B@@@AB@@@@@@B@@@B@@@A@@B@@@@BB@@BB@@@AB@@@B@@B@@@@A@@BA@@@@B@B@@@@@B@B@B@@AA@@A@@@@@@B@@AA@@A@@A@A@A@@AA@AA@@@A@@@AD@B@D@@AB@@A@@@@@B@@@@BB@@B@@B@@B@@ABA@@@@@B@BB@@A@@@BB@B@A@@A@@@AB@@@@BBB@@@@@A@@@@@@BA@@@@@@BA@@AA@@@@@ABABADA@@B@B@BBDA@@AAA@@A@@AA@AAAAAA@@A@A@@ACA@@A@A@@AA@A@A@AACAA@AAA@AAAB@@ABC@@DC@@@A@@@AB@@A@C@AG@@@@A@@@@AA@@A@@@@AAA@@B@@@B@@@@AB@@@B@@A@A@AD@@@@A@@DB@@@@BAB@BBB@@@@AAAC@@A@@IA@@B@@A@@@AA@@@B@@A@@A@C@@BA@A@@B@@B@@@@@@BEA@@B@@@@A@@@@@@@@@A@@@@@@@@@A@@@@B@@@AA@@@A@@A@@@@@AA@@B@@A@@@@B@@@@@@A@@B@BA@@@C@A@@A@@@AABA@@@@B@@@@@@A@@@A@AA@@ABAD@B@@@HBD@D@AA@@CAA@C@@A@@BA@@DADCB@@AB@@A@A@@A@@@@CAC@@C@EA@A@CF@@@@A@CC@C@AAEBBA@@AA@C@@BAB@@@@A@ABA@@@@@A@@AA@A@@AA@@A@@@A@@B@@BF@B@@AD@@@@@@ABC@A@@AAAA@@@A@@@@BAB@@AB@AA@@AAAA@@A@C@@@A@@@@@@A@CAA@@@AA@@BA@C@@@@@AB@@AB@BC@@@AB@B@@@BC@@AA@@@@BC@@DA@@CAA@@A@@@@FCB@BB@ABA@@B@@A@@LLBB@@BBZDBA@@A@@@@@B@B@@A@@@@D@@@B@BBBE@@BBBAB@@C@@@@B@B@@@@B@@BAB@H@F@F@B@D@@AB@BAFAB@@@B@@@D@BA@@@@HAD@@@@A@GB@@@@@@@@@@@@@@B@@B@@@@@@@@BA@B@@@@@@B@@@A@@B@@@@@@@@@@A@BB@@@@@B@@@@B@@@@@@ABBA@B@AB@@@B@@@@@@B@@@@BA@@B@@@@@AB@@B@@@@@B@@@A@@B@@B@@A@@@@@@@BBA@B@@@@@@@@@@@@@@@@B@@B@@@A@@B@@@@B@@@B@@@@@@@@@@@AB@@@B@@@@B@@@@@B@@@@BA@@@B@@B@B@@@BB@@A@@@@B@@B@@@@@@A@@@@B@@@@A@@B@@@@@BB@@@BA@@B@@B@D@AC@A@@@@H@@@BB@BBAB@@DAB@@@B@BA@@B@B@@BBB@@@@FBBBBB@FH@@@@BAB@B@@ABA@BB@@@@@@@BBB@@@EEDCBBAC@@EAEC@AAA@A@A@A@ADCDCB@HE@@@ADC@@@E@@@AACCEA@AAA@CACAEAA@AAAAA@@AAA@AAA@@@CBAB@@@BAD@@@DAB@H@D@BADC@@@A@A@AAC@CAAAAAC@@AAAAAE@A@ABCBABAB@DAB@B@HBJBF@DBDBHBDBDAD@D@BABABC@A@@@A@AAA@@AACCGGAAACCEEECCCEAA@C@ABC@@@AFCB@BAJCDAFAF@FAJANCHCD@HELGFCDCDEBA@CBC@@AAA@AAEAA@I@E@GAC@EAOCEACACAAAA@@@@@C@A@@BA@AB@BAB@@@B@B@@AB@@BB@B@@@@@BCFABCBEBA@A@ABABEDGBABE@C@@@A@AAAA@@ACAC@A@AAC@A@C@C@E@C@ABA@ABGB@@IAG@IAC@A@CAA@CAC@A@A@ABC@A@C@AAC@@AA@@AA@@A@AA@@A@A@A@CAAAA@AA@@AACCCCCCAG@@@CAACEAAGCAAGCECEEIECCCACCAACA@@ACA@CAGCC@ICA@IBC@E@MBEAA@A@@@AA@@ABA@BD@@ABB@@@AB@@ABAD@@@@B@@@@@@@@@@B@@@@@@@@BA@@@@@@@B@@@@A@@B@@@A@@A@@B@@@@ABB@@BA@@@A@@@@B@@@@@@B@AB@@A@@@@@A@@@@B@@@B@@@@AB@B@@@B@@AB@B@@@@A@@B@@AA@@A@@A@@@@A@@@A@@@A@@@AB@@A@ADAB@@AB@@A@A@ABA@A@A@@B@@BB@DBD@B@@B@@@@BB@@BB@@BA@A@A@ABA@AB@@A@@BBB@B@@@BA@A@@@@@@B@BDDB@@B@AB@@@BAB@@BB@B@@@@@BB@@@@@@@@BBBA@B@@B@B@B@B@BB@@@B@B@@B@@BB@BB@@@@BB@@D@@AB@@@B@@@@AB@B@@BBBB@@@BBB@B@BBBB@@@B@BA@A@A@@@@B@B@@@BBB@@@B@B@BAB@@BB@B@@@BA@A@AB@BABAAA@A@ABA@ABGDA@CBA@CBCDAB"],encodeOffsets:[[112088,23452]]}},{type:"Feature",id:"450821",properties:{name:"平南县",cp:[110.392311,23.539264],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@A@@@@BA@@@@@AAC@@@@BA@@@A@@@@@@@@FAB@DA@@AA@@BA@@@A@@@@@@AB@@AB@@@A@@@@@@A@A@@@@@AAB@@@BA@@@@D@@@@@@A@@D@@@@@@A@@@@BA@@@A@@@@@A@A@@B@@@@B@@@@BA@@ACB@BA@@@BB@@@@AAA@AB@@@@@BA@@@AA@@@B@@@B@@@@@@A@@A@A@@@A@@@ABC@A@@@C@@@@ABA@A@@DAB@@ACA@@@@@AB@@@BCBA@@@AA@BCAAAA@@AA@@@A@A@@A@@@@@AA@@BA@@@A@@B@@A@@@@BA@@@A@A@A@A@@@AA@@@@@A@@@A@@@@@AB@B@@AB@@A@@AAA@@@@B@@AB@@A@A@@@@@@A@@@ABA@@@AB@@CA@A@@A@@BAB@@@@A@@A@@@@@A@@AAAA@@@A@@@@@@A@AB@@AA@A@@@@@@@@@AB@@@@A@@AA@@BA@@A@AA@@@@@@@A@@AA@@A@@@AB@@@@A@A@@@A@@AA@@@@@@A@@@@@A@@@@@AA@A@@@@BA@@@@BA@@@@@AB@@@BA@@@AB@B@@A@@B@@@@AA@A@@BCDA@AB@@AAA@A@@C@A@@@@ABA@@B@BA@A@@@A@@BA@@@A@@B@@A@@@A@@@AA@A@@@A@ACA@@@A@A@@@@@CBABC@@@FB@@@@@B@@@BA@AAA@@@@@@B@@@ABB@@B@@@@B@@A@A@@@A@BD@@C@AA@A@A@AA@@A@@B@@@@A@@@A@@@ABAB@@A@@@@AA@@A@ABABA@@@A@@@AC@@@@AA@AAA@@GC@A@@@@@AD@BA@@@A@@AA@A@@@@B@@@D@@@B@@A@CAA@A@@A@@@@@@A@@@@@AA@@@@@@A@@AA@@A@@@AA@@@@A@@@AA@@A@@A@@@A@@AA@@@@AB@@AA@A@AA@@A@@A@@@@@B@@@@@BCBA@@@@AAAB@AA@@@@BA@AB@@@@AB@@A@@A@@@A@@BAA@@@@AA@@BA@@@A@@@A@@AA@@BA@ABA@@A@@@@A@AB@@A@A@ABAACB@@A@@B@@A@@@A@@B@@AB@B@@A@@A@@A@AAA@@B@@@BAB@@@@A@@@@@A@A@@@@@AAB@@A@A@@A@A@@@@A@A@A@A@@@A@@@@@@@A@@@@@A@@@@A@A@@@AAA@@@A@@@A@A@@@@@A@@A@@A@@BA@@A@@A@@B@@ABA@@B@@A@@A@@@A@@@@C@@@@@@A@@AA@@@A@@@A@@@@A@@@A@@@@@AABAAA@@C@@@@@AACB@@AAA@@AA@@@@@@AB@@@@AB@@@A@@A@@@@@@A@AB@@@@@@@@A@@@@A@@@@@@BA@@@A@@A@@@A@@@@@@A@@A@@@@@@A@@@@@@@A@@D@@@B@@AAA@@@@AB@@@@A@@AA@B@@A@@@@@@@@@@@A@@@A@@A@A@@BA@@BAB@@@B@@@@@AA@@@A@@@@B@@@@@@@@AB@@@@@@@A@@@@A@@@@@A@@BAAB@@AA@@@A@@@@@A@@@A@@@@B@@@@@B@B@@ABAB@@@@@@@B@@@B@@@@B@B@@@@B@@@@@@@BABA@@BB@@@@@@@B@@@B@@@@@@ABA@@@@B@@@AB@@B@B@@B@@@@@D@@ABC@@@C@ABCDCB@B@BA@@@@B@@CA@@A@A@@A@@A@AB@@@@A@@@@@A@@@A@@@@@@@@AAA@@A@@@@@A@@@@A@@@@@@A@@@AAA@B@@@AA@@@A@@AC@@A@@@@A@@B@@@@@@@@@@AAAA@@@AAC@A@@@A@@@A@@@A@@AA@@A@@AA@@@@A@AA@@@@ABAA@B@@A@@@@CA@@@@@A@@BA@@@@@@@@AA@AAA@@@@@A@@B@@@B@@@B@@@@@@@A@@@A@@@@A@@AAB@@@@A@ACAA@@A@@@@AA@@BA@@B@@@BA@@BA@@@@@A@BAA@@A@@@@AB@A@@A@@@A@@@@AAB@@C@@@AD@@AB@@@@A@@@@BA@@B@@A@@@AAA@@@@A@@A@AA@AA@@@A@@@A@@AA@@B@BA@ABB@AB@@@B@@D@@@BB@@A@@@A@@@@@@@AB@@@B@@@B@@@@@@@BAB@@@B@@@@ABAAABA@@@B@@B@@@B@@@@BB@@@@ABAB@@BB@@AB@@BB@B@B@@@@B@@@@BBBBBB@@B@@@@@BB@@B@BB@@@D@@@DB@B@B@B@BBB@@B@@@BB@@@@@@BB@B@@BB@@@@@@BB@@@@A@@B@@B@BB@@@@@BA@@BABAB@B@@@@B@@B@@@@BB@@@BB@@@@B@@@@@B@@@BAB@BA@@@C@@@A@@@AB@@@B@@A@@@@B@B@@A@@B@@B@@@ABBBA@@@A@@@@@AA@@@BA@@@@@ABA@@A@@@B@B@@BBABBB@@@B@BB@BBA@@B@@@@C@CA@@A@@@A@@BA@@@A@@@AB@B@@C@@@@@@@AAA@@@A@@@@B@@A@@@A@AB@AA@AAA@@@AB@@A@@B@@A@A@@@AA@@AA@@A@@B@@ABA@@@AA@@@@@@C@A@AB@B@BC@@@AA@@A@A@@@A@@@@B@@@@@BB@@@B@B@@B@@@@A@@B@@@BB@@@B@@@@@@B@@AB@@C@AAC@@@@@A@@@@BA@CBA@AB@@@@@@A@@@@@A@A@@@A@@@AB@@B@@D@@@@A@@B@@A@A@C@@@ABA@A@C@@@@B@@@BA@@B@B@BAB@@CB@@ABAB@B@@@B@@@B@@@@AB@@@@A@@B@@AB@@@@@B@@A@@B@@B@@@@@B@@B@@AD@@AD@@A@AB@@D@@B@BA@@@A@@BA@@@@B@BA@@B@@BBB@@ABBB@@@@@B@@@@BB@A@@@AB@@@@@B@BA@A@@@@@DBB@@@BB@@B@@B@B@@@BB@@@@@BA@@@@BB@B@@@B@@@BB@@@@@BB@B@@B@DB@@B@@@B@@@BA@@@BBBCB@@AD@@@B@B@@@B@B@@@B@@B@@B@@@B@@BB@@BB@@@@BB@BABBB@@@@B@@B@B@@BB@@@@@BB@AB@@B@@@@BB@@@@@@B@@@@@B@@@@B@@@B@B@@B@@AB@B@B@@BB@B@@B@@@@@BAB@@@@@@BBA@@B@@B@@BBB@B@@@@B@B@BB@BB@B@@@@@BB@@@AD@@@B@B@BAB@@@@A@@@@@@B@@A@@@@B@@A@@@@B@BA@@B@@@B@B@@A@@BB@@B@@@BB@@@ABBB@BAB@BAB@FBBA@@B@@ABAB@@@@BBB@@AB@@BBB@@@B@@B@B@BBB@@BB@B@B@BA@@B@@@DBBB@@@B@BABB@@@@B@BB@@@AB@BB@@BB@@@@@@BB@BB@@@B@B@BB@@B@@@@A@AB@@AB@BB@@@@D@B@BB@BB@BBB@@ADA@A@@@AB@D@B@@A@@B@B@@AB@@A@@BB@@B@BB@@BB@@@B@B@@B@B@@B@@@B@@A@B@@B@@@@@B@@@B@@@B@B@BB@@B@B@@@BB@@B@@@@@B@@@BA@@B@@@B@BB@@@AB@@A@BB@@@B@B@BA@@@@B@@@@@@B@B@B@@BB@@@B@@B@@@@B@B@B@@A@@@@@ABABAB@@@B@@@@A@@B@BBB@AB@B@@@BB@@@B@@@@@B@@@@BB@@B@@@@@@B@@AB@@@@@@@BABABABADAB@@@@@@@@B@B@@@B@B@@B@B@B@@@@B@@@@@BB@B@B@@BB@@AD@@@@CA@@@@@AA@@@@C@@@A@@A@@@A@@@@AA@@@@@@BA@@@CBCBAB@@@@A@@B@@AD@BAB@@@B@@@@@@@B@@@@AB@B@@@B@B@@@@A@@@AB@@AB@@@B@@@@@B@@@B@@@@B@BB@@B@BBBB@@@B@DD@B@@@B@BB@@B@@@D@B@@@BBB@@@@D@@@@@B@B@BAB@@@D@@AB@@DB@@B@@@B@@@B@@@@@@B@B@@@@@B@@BB@@@@@BB@BAB@@BA@BBABB@@BBBB@B@@BBB@BBB@@@B@@@B@B@@@@@B@BAB@@AB@@AB@B@@@@@@AB@@@BAB@@@BBDB@@@BB@@B@@B@B@BABBB@@AB@@@B@@@BBB@@@BA@@BA@@BA@@@BB@B@B@DBB@BABBB@@@@BA@@B@@@@@B@B@@BD@@@B@B@@@@@B@@@@@@DB@@@@B@@AB@B@B@B@B@@@B@BBBB@DB@@BD@B@B@@BBB@B@@@@@B@@@BBDDBB@@@B@D@@BB@BBBBBBBBBB@BBBB@@@HABAB@@@D@D@B@@@B@@B@@B@@@D@@@@A@@BAB@@@BAD@B@@@@BB@@B@B@@B@B@@@@@BA@@@A@@B@B@B@@@B@@AB@D@B@@A@@@A@A@@@@@A@@@@BA@@@@@@@@B@B@@@@@BA@@@@@@@@@AB@@ACAAAAC@@@A@A@@@EDAB@@@B@@A@@@@B@@@@AA@@@B@@@@@@@@@@A@BB@@B@@@@@@@B@@B@@AB@@@@@BA@A@@@@B@B@B@@@B@@@@B@@@@@@@@@@B@@@@@B@@@B@@@B@@@@C@AB@@@DCF@BED@@DF@DBD@BABABA@ABA@ABA@@B@B@B@B@BBB@BBDBB@B@BA@@@A@A@AACAA@@@@@C@AB@AAA@@A@ABAB@BA@@@@@ABCB@@@BA@@@@@@@@BB@@@@@B@@@@BA@@@B@@B@@A@@@@@@@@@ABB@@@@B@@@@B@@@@@@B@@@@@@AA@B@@@@@@@@@B@@@@@@@B@@@@B@@@@@@@B@B@@@AB@@B@@B@@@@@@BB@@@@@B@@A@@@@B@@@@B@B@@B@@@@@@A@@@@@BB@@@@A@@@@@@B@@@B@@@@@BBB@D@B@@@D@@@BABA@A@EBC@EBAAA@A@EAA@A@@@C@ABE@GBCBC@ABAB@BAB@B@B@BBB@@BB@DBFBB@B@D@D@B@D@DADADABCBKDA@E@EBEAA@I@IAE@A@EBEB@BAB@@@@@BADB@@B@@BBBBB@B@D@BB@@@@AD@@@B@BB@@@BA@@B@D@@B@@@B@BABADAB@@BB@@BBBBBD@@@@AB@@A@AAAAA@@@@@@B@B@B@@CD@@AHAF@B@B@B@@@@@BA@@@@@AA@@@@AB@@@BB@@B@@@@@@A@@@@@@@@B@@@@A@A@@@BA@@@A@@@@A@@@@B@@@@A@@@@A@@@@A@@@@B@@@@A@@@@@@@@AAB@@@@@@B@@@AB@@@@@B@@AB@@@BA@@B@BAB@B@BAF@BA@@@CB@@AB@@@BAAA@@@ABA@@@@@C@@@GA@@A@AB@@@@A@AB@@AD@B@@@B@@@BB@@B@@@BBB@BBB@B@B@D@B@@BB@D@BBB@@BB@DBB@@B@@@@B@@@B@@@BBB@DB@@@BBDB@@@B@@AB@@@B@BBB@B@@@B@BA@@B@@@B@@BDCBAB@B@BA@AB@BBB@@B@BDB@@B@B@B@B@BBB@@@B@@BB@@@B@@ABABAB@@BBB@@BB@@@@B@@@@B@@@B@@@@B@@@B@@@B@BBB@@A@@@BB@@@B@B@B@@ABA@@B@BA@@B@@B@@B@@AB@B@@@@@BBB@@A@@@CAAAA@A@AA@@@BA@ABA@A@AAA@@AA@@@CAAAA@A@@@A@E@AA@@A@@@@@A@@@@@A@A@AA@@AA@AAA@A@A@@@A@@@@@@@A@C@A@@@CAA@A@@@ABA@A@@B@BA@@B@@A@@BA@@@A@AA@@@@A@@A@@@@A@@@A@AA@@AA@ABAAAAA@@@@B@@C@@@EBA@A@@BA@@@A@@AA@@@@A@AA@A@A@@A@A@@AE@@@A@A@A@ABCA@@@@@AA@@A@@C@@A@A@@@A@@@CA@@A@@AA@@@@A@@@AA@EBA@@@A@@B@@A@@@A@@@AAC@A@@@A@@B@@ABAB@@C@ABA@@BA@BB@BBBB@@B@D@@@BB@@@BB@@B@@@BB@@@@B@@B@@BD@@@BDD@B@B@@@D@@@B@@@B@BBB@@AB@B@B@@ABC@A@A@@@@@@B@BA@AD@@@B@@AD@@@B@@@BB@@@@BC@@@@B@@@@AB@@A@@@BB@B@B@@A@@BA@@BA@BB@@AB@BB@@@@@@BB@@B@@B@@B@@@@@B@B@@@B@@@B@B@@@BA@AB@@@@AB@@@BAB@@A@@B@@A@A@A@@BA@@@AB@@@@@A@@AA@@AAAAACA@@@C@@AA@@@@BC@A@C@@@C@A@E@@@ABA@@B@@BB@B@@A@@BA@@BA@@@@DAAAAA@CAC@A@@@A@@B@BA@AB@@A@@B@@@BA@AB@@A@@@A@@@A@@@A@ABA@A@AD@@A@CB@@A@A@ABA@@@CBA@@B@@A@A@AA@@ABA@@B@@A@@@AA@@AB@BA@@@BBA@B@@DAD@B@@@B@@CB@B@@@B@@@BA@@BAB@BAB@@A@@@@@AB@@@@A@ABAB@@@@A@@BA@@@@BA@@@AB@@A@AB@@@B@@AB@BAB@@AB@@@@@B@@@@@B@@@B@@@BA@AD@BCBAB@B@@AB@@@@@B@@ABBBA@@@@B@@@@@@@BBB@B@@AB@@@B@B@@@@@B@@AB@BAD@@@B@@@@@@@BB@@@@B@B@@B@@@@B@BBB@@@@BB@@B@@@B@@@@@FBB@@@@B@@B@@B@@@B@@BB@@@@@B@@@BBB@@BB@@@@@B@B@@B@AB@B@@@BBB@@@@B@@B@@B@@@@@B@@@BA@BB@@@B@@@@@@B@@B@@B@@@@B@@B@B@B@@@@B@@@B@@B@@@@@@@@B@@@@BA@@@BB@BB@@@@B@@@BB@@B@@B@@@BB@@B@BB@@@@B@@BB@@@BB@B@@@@@BB@@@@@@@@@@BB@@B@@@@BB@@@@ABB@@B@@B@BB@@B@@@B@@@B@B@@B@@@BA@BB@B@B@@B@B@@@B@B@B@@BB@BBBB@@BBBBB@@B@B@@B@BB@@@B@@B@B@B@@BB@B@B@B@@B@@@@B@@@@BB@B@@@BD@@DB@B@@BB@B@@@@@B@@B@@B@@@B@@B@@B@B@BDD@@BB@@@D@@@B@BDBBD@@@@@AB@BA@@@AB@@@@@B@@@@@DC@@@ABABA@@BAB@@@@AB@B@B@@@BA@@@@BA@@BBD@@@D@@BB@@@B@@@B@B@@A@@B@@ABA@AB@@A@ABA@@BABA@ABCDC@@BABAB@@AB@@@B@B@@BB@@@BAB@@@B@B@@@@@B@BAB@@@@@@@BB@@@@@@@B@@BB@B@@@@@@@B@B@@BB@@B@@@@B@@B@DB@B@@@@@@D@@BB@@B@B@@B@@@@B@@@B@@@B@@A@@@B@BBB@@BB@AB@@BF@@AB@@@@@B@B@@@BA@A@@B@B@@BB@@AB@@@@AB@@@@@BB@@B@@@B@@BBBB@@ABBB@@@@A@BB@@@B@B@@@B@@AB@@@@@BA@@@@@@BB@@@@@@B@@@@@B@BB@@@@B@BBB@@AB@@B@@BD@BBB@B@@B@@B@B@BBB@@@@@BA@ABA@A@@BA@@B@@AB@B@@AB@@A@@D@@@@A@@B@@@B@@@@@@@B@B@@@@AB@@A@@B@@A@@@@B@@@B@@@@@@@B@@AB@@@@@@A@@B@@A@A@@@@B@@AD@B@@A@@@@@@BA@@@@@@BA@AB@BAB@@@@A@@BA@@B@B@@@B@D@@BB@@DB@B@@BB@D@B@B@BBBB@@B@BB@@B@@@BB@@@@@@@@B@@ABC@@@A@@BA@@@@@A@@BA@A@@@ABB@AB@@@@A@A@@BA@@@A@@@A@ABA@@@AB@@A@@@@B@@A@@@A@@@@BA@AB@A@@AB@@@BA@@@A@@B@@@BA@A@@BA@@A@@@B@@ADA@@BABA@@BA@@@@@@BA@C@@BA@ABA@A@@@@@A@@@AB@@A@@@C@@@@@@FBBB@@BA@@BBB@@BB@@@A@AB@B@BABB@@@@B@BB@@@BB@@B@@B@@BBD@@B@@@@B@@B@B@@@BA@@B@@A@@B@@@D@@@BAB@@@B@@ABAB@B@B@@@B@@B@@B@@@@@D@@BB@@BBB@@@B@@B@B@@@@BB@@@@@B@@@@@BB@BBD@@@@B@@B@@@@@@@BB@@B@B@B@@BB@@BBB@@@@@B@@@BB@@@@B@@@BB@@B@@BB@@A@@@BB@B@B@@@@@BBB@B@@@B@B@@@@ADABABABBBAB@@B@@BABA@@B@@@B@@AB@@C@@@A@@@@@@@A@@@A@@@A@A@AA@@A@A@A@AA@@@BBB@B@@@B@@@@@BB@@@@B@@@B@B@@AB@BA@@@@BBB@@B@B@B@BD@B@@@@@B@@@@@@@@CD@@AB@BB@@@AB@B@@BBBB@@@@@BA@@@@B@@AB@@@@AB@B@@ABB@@B@B@@@@AB@@@BAB@@@D@@A@@@@@@B@@@@@BB@@@BB@BB@@@@B@@@BB@@B@@B@@DC@@@@@@@A@@A@@@@@A@@@AA@@@@A@@A@@@A@@@A@@AAB@@A@@@@@@@@@AABBAB@@AB@@@@@B@@@@@BBB@@@@BB@BB@@BB@@@@@BB@@@B@@BB@@@B@B@@@@B@@@B@@BB@DB@@@BA@B@BBB@@@@@B@D@@@@@BB@B@@@@@B@B@@A@@D@@@@@B@B@B@B@B@BAB@@@@BB@B@@BB@@@B@BBBB@@@@D@B@@@B@@@@@B@@@@BB@@AB@@B@@BAB@@@B@B@B@@@B@@BB@@@B@@@DB@@B@@@D@B@@@B@@@@@B@@BB@@B@@BB@@@@BBBBB@B@BB@BBB@BA@@BAB@@@B@BB@BB@@BB@@@@BB@@@B@@@BB@@BB@@B@@B@@B@BABBB@@@BB@@B@@BB@@@B@@@@@B@@@B@@@@@@@BBB@@@BB@@B@@@@BB@B@@@B@@@@@@@@A@@B@@@B@@@D@@@B@@@@@B@@BB@BB@@@@B@@B@@BAB@@A@@@@BA@@@@BB@@@@B@@@@@B@@A@@@AD@BBB@@@B@D@@@@@B@@@BA@@B@B@@B@@@@BB@B@@B@@BBD@@B@@BB@B@@@BBB@@AB@@@B@B@@@B@D@@@@@@ABB@@DBB@B@BB@@BA@ABABA@@@A@@BBB@@@@@B@@@B@B@@@B@@C@A@A@A@@A@@A@@@@AA@@@A@AAA@ABAB@BABA@AB@@C@@B@@@@@@A@A@A@@BA@AA@B@@A@A@@A@@A@@@@@C@@@C@@@@@@A@AA@@AA@@@@@A@A@@@A@@@A@@@@@A@@AA@@@@A@AA@@@A@A@@@@@A@A@A@A@AAA@@@@@A@@@@@@@@@@BA@AAA@@BA@@BA@@@A@@@A@@@ABA@AA@@A@@@@@A@AA@@A@A@@B@@@BA@AA@@AB@@@AAAA@A@@@ABA@A@@@AAAB@@@@A@A@@@@@A@@@@@AB@@@AA@@B@@A@@@A@@@@@@@@@A@@A@@AA@@@A@A@@@AA@@A@@A@@AA@A@@AA@@A@@@AA@@@AA@@A@@@A@A@A@@@@@A@@@@A@@AC@@AA@@@A@@@AAA@@A@@A@@AABAA@@@@@@C@@@@A@@AA@@AAA@@AA@@A@@AA@@@@@AA@AA@BA@@@A@@@AA@@AA@@A@A@@@CA@@@BA@A@@@@@A@@A@@@A@@@AA@@AA@@A@@@@A@@A@@@A@@@@BA@@@A@@@@@A@@@@@CB@BA@AB@@A@@@A@@@@@A@@@@@A@@@@@A@@@A@@@A@A@@@@A@@@@A@C@A@@AA@A@@@@@A@@CAA@@A@@B@@A@A@A@@@@@AB@@@@AB@@@@A@@AA@@@A@@@A@@@A@@@A@@@A@@AA@ABA@A@A@ABA@@B@@@@A@@@@BADC@ABA@@B@@@B@@B@B@B@@B@@B@@@@BBBB@@B@@@@A@@@@@@D@@B@@B@@@@@B@@@BAB@@@@@AA@@@@@A@@@AAAB@@A@@@@@@B@@@@AB@@@B@D@BBB@B@B@B@@@BB@@@@BB@@@@B@@@B@BA@@@@B@@@BB@@@@BB@@@@B@@ABA@AB@DA@@B@@@@@@@@A@@@@DC@@B@@A@@@@B@DAB@@A@@@@B@@@@BB@BB@@B@B@B@@@B@@@@@@AA@@A@A@@@@B@@A@@@A@@AA@@@@@@BAB@BCA@@A@@AB@B@@A@AC@@BA@@A@@B@@@@A@@A@@@@@@@@@@AA@@AA@@@@B@@ABAB@@AB@@@@@B@BB@@B@B@@B@@@@B@@@BBB@B@@@B@@@BB@@B@@@B@B@@@B@@@@@BB@AB@@B@@@@@@B@B@B@B@@BBBB@D@@@BABBB@BA@@B@@BBB@@B@B@@@B@BB@@BA@AB@@AB@@A@@@@@A@@@@@ABA@@@A@@@A@@B@@A@@B@D@@@B@@@@A@@@@@A@@BA@@@A@A@@BA@ABE@@@@@@B@@@@A@A@@@A@@@A@@@@BA@@@@@@B@@A@BD@@A@@B@@@@@@BBBA@@B@@@B@@B@@@@@@BBBA@@BBBB@@@@@@B@B@@@@B@@BB@@@B@@@B@@AD@BA@@@A@@@@@@@A@@@AB@@A@@B@BD@@B@@B@B@@@@@@@BB@@@@B@@@@BB@@B@@@B@@A@@B@@@@AB@@@BBBA@@@@@A@@@@BA@@@AB@@@@@@@@AB@@@BA@@@AB@BAB@@AA@@@AB@@@A@@@A@@@@@@@@@@A@@@@A@@@@@@@A@BAA@@@@@@BA@@@@A@@@@@@@BAB@@@@@A@@@@@A@@@@@@AA@@@@@@@@@@@A@@@@A@@@@@AA@@A@@@@BAAA@@@A@@@AA@@@@@@A@@@AA@@@@@@@@A@@@@BABA@@@@@A@@@A@@@@@A@@@AB@@@B@@AB@@@D@BA@@B@@@@A@@B@@A@@@@BA@@@A@@@AB@@@@AB@@AB@@@BA@@@@@@@A@@AA@@@@@A@@BA@@@AB@BAB@@@B@@AB@@@AA@@BAB@@A@@@@BAB@@@@@BB@@B@@@B@BBBB@@@@BBB@@@B@@@B@@@@A@@@A@@B@@@@A@@@CBA@@A@@AB@@@@A@@@@B@@@B@@@@@@@BBB@B@BBB@@@B@B@@@@@@@@@@AB@@@@@@@BB@@B@@AB@BBBBB@B@@@@BB@@B@@@B@@@@@@@@DAB@BBB@@@DDBB@B@B@@@B@@DBB@BB@@B@@@@BB@@@@BB@@@BBBB@@@BCAAAA@@@@@A@@B@@BBDBBB@@AB@@@B@B@@@@B@BB@@BB@@B@@@B@@BB@BA@@@@B@@@@@B@@@B@@A@A@@@@B@@AB@BA@@B@@AB@@A@@@A@@BAA@@AA@@AB@BAB@BA@@BA@@B@@@B@BA@AB@@@@@@@@@@@B@B@@A@@@@@@BA@@B@B@@A@AAC@@@@@AB@@AB@@A@@@@@@@A@@@@@@@AA@@AB@BA@@@AA@@A@A@A@@@@AABA@A@AB@B@@@BBB@@BD@@@@@B@@@@@BAB@@@@@B@@@AA@A@@@@@@AA@@@AAA@A@@AA@@@A@@B@B@@@@@B@@@AA@A@@@AA@@AA@@AA@AA@@@@AAA@@@A@A@@@ACAA@A@@A@ABA@@@@@A@A@@@@A@EAE@A@A@CAA@A@@@@@@AA@@@@@A@@@A@@B@@A@@AAAA@@@@AA@@@@AA@@AA@@A@@@A@A@@@@A@@@@A@@@A@@@@A@@@@@AA@@@@A@@@@@@B@BA@C@@@@@A@A@@@@@A@@@@A@@@@@@@AAA@@@AAA@@A@@@AA@@@@A@@@@@@@A@A@@@A@@@@@AA@@A@@@A@@@@@@@A@@@A@AB@@A@@AA@@AA@A@@A@@A@@@AAAB@@@@A@@BA@@B@@@B@@@@C@@B@@@@@@C@@@AB@A@@A@@@A@A@@A@@@BA@A@@@C@AB@@@@A@A@@BB@@B@DB@@BAB@@AB@@@B@@BBB@@@@D@@B@@BB@@A@@@BB@@BB@@BA@@@@BBB@@@DA@@B@@@B@@A@@@@@AAA@@@@@A@@BA@A@@@A@@@@@A@@@@@@@A@@@@@@@A@@A@@A@@@@@A@@A@@@@A@A@A@@@@A@@@@A@@@@B@@A@@@@@A@@@AA@@@@AAA@@AA@A@@AA@@@AB@A@BA@AB@@A@AB@@@D@@@@A@@BA@A@@@AA@@@@@@AA@@A@@A@@@@@AB@@A@@@A@AA@@AAA@@@@@@@@A@@@AA@A@@@AA@@@A@@A@@@A@AB@AABAA@A@@A@A@@@@@@B@@@@A@@@A@@@A@@BA@@@@B@@A@@A@AA@A@@@A@A@@B@@AA@A@@@A@@AC@@B@@@@AB@@AA@BA@@AA@A@@A@@A@@@A@A@@B@BA@@@@@A@A@@@A@@BA@@BAB@@A@@@A@@@AB@@A@@@@BA@@@BB@B@B@@AB@BA@A@@B@DA@@@@@A@@@@@A@@B@B@B@@B@@B@@@B@@@BBBAB@@@BABA@@@AB@BA@@@B@@BAB@@@B@BA@@B@@AB@B@B@@@@A@A@@BA@@AAAA@@BABABA@@B@@BB@@@B@@AB@@@@@B@B@BA@A@ABA@A@CB@@@BA@@@A@A@A@@@A@@@A@@@A@@@@@A@@BCB@@@BA@AA@@AA@AA@@@@A@@A@@@AB@@@@A@A@A@@AA@@@@A@@A@AA@A@@@@A@@AAB@CA@A@@C@@AA@@@@A@@@A@AA@@A@@@A@@@@@AB@B@@@B@B@@BB@B@@@@@B@B@@@B@@@@@B@@@@BB@@@BAB@B@B@@@B@@@B@@@@@B@@AB@@A@AB@@@@A@@@A@@BABA@@BABA@ABABAB@@@@C@@@@@A@@B@@AA@@@AA@@@@ABA@@AB@@@BAB@@@@@AA@@@AA@@@@AA@A@AA@@@@BC@@BA@@@AAA@ABA@@B@@@B@@ABB@@B@@B@B@@@@@@BA@@BABA@@DAB@@@B@@ABA@@B@@ABA@A@@@A@@B@BA@A@@BA@A@AB@@A@A@@AAAAA@A@A@@AA@@@@AA@@@@A@@@AAAB@@A@@BA@@@ABEB@@A@A@AB@BA@AB@@ABAACAA@@B@AA@C@@BA@A@@AA@@@A@@@@BA@AAA@A@@@A@@@A@@@@BC@A@AAA@@BCA@@A@@B@@A@E@@@ABA@C@@AA@@A@@A@@@A@A@AAA@@B@@A@@BAB@B@BA@@B@B@B@@ABA@@BA@ABA@ABA@A@A@@AA@E@@@A@@@A@A@@AA@CA@@A@@A@AA@@@A@@@A@@B@B@@@BBBA@@@A@@@A@@BC@@@AA@@@@@AC@@A@@@AAAA@AA@@@A@@BA@CA@A@@BA@@AA@@@ABA@@@AA@CEA@@@EAA@A@A@ABCDAB@B@B@@@B@B@BABAB@@C@E@@@A@AAGCECAAGCGE@@@@BG@AAA@ABA@@@@@B@@B@@@@A@AB@@A@A@A@@@ABAAAA@@A@@A@@AAAB@@A@@A@@A@@AAA@@@@@BA@@@@@A@A@@B@@@@@BB@B@AAA@@@@@@@A@@B@B@@@@A@A@@@A@@AA@@@@@A@@BA@@@@@@@@@A@BA@@A@A@@BA@B@@DD@@@@@BB@@A@@@@@@@@@@AAAAAA@@@@AA@A@AAA@@@AAB@@@A@@AA@@A@AA@@@AA@@@A@@@A@@EB@@@BA@A@@@AAA@A@@@AA@@A@@@A@@@A@@AA@A@@@AB@@@B@B@@A@@@AB@@AB@@@B@@@@@@@@AA@AAAA@@@@A@@@A@A@@@@@BB@@D@@@@@@@A@@@A@@@AAA@@@A@@@A@@@@@BA@@B@@@AA@@@@@@@@B@@@@@@@B@@@@@B@@@@@A@@B@@@@B@@@@@@@@@B@@@B@@@@@B@@@B@@@AA@@@AB@@A@@B@@A@@@A@@@A@@B@@A@@D@@@@@@AAA@@A@@@@@AB@@A@@@A@@@A@@A@@@AA@@@@AA@@@A@@@A@A@@AA@@@A@@@A@A@@@A@AAA@AA@@BA@@AABA@@@A@@@@@A@AB@@@B@@BB@BB@@@AA@@@AA@CBA@AB@@BBB@@@@B@@BD@@@@@@@@BBAB@@@B@@@@@@BB@@@B@B@BBB@@@BA@@BA@@AA@@@@B@@@BA@@BABABA@@@A@@@A@@@@B@@A@@B@@AB@@@@C@A@@@AAAAA@A@@A@A@AA@@@@A@A@A@DEBA@@@@DA@@BEB@AA@@B@D@B@@@BC@@@AA@AA@A@@@A@@AAACB@@ABA@@@@AC@@BA@@@@B@B@B@@BB@@BD@@A@@B@B@@A@@B@@@@@@BB@@@@@B@BB@@@@BA@@BB@@@@B@@@BBBB@@B@@BB@@@BB@@B@@@B@B@B@B@@@B@@@@@@A@@B@@@@CBAB@B@@ABAB@B@@@@@B@@@D@B@B@@A@@@@BCB@@AB@B@BA@@@AA@@A@@B@BABA@@B@@A@A@@B@@@BB@@B@BB@@BA@@B@BABAB@B@@AB@BA@@@@B@@@B@B@B@BA@@BB@@B@DA@@BA@@D@@@B@D@BAB@@A@@A@@@@@AEAAAACC@@AAA@@A@@@@@AB@@A@@@CBA@@@A@AB@BABA@A@@@@@AB@BA@@@A@AAABA@A@A@@AA@A@@@A@A@@BAA@@A@A@@@@@B@@A@@@BA@@@A@@@@@A@@@@@@@@@@@A@A@@@@AAB@A@@A@@@@A@@@@@@B@AA@@@@@@@@BAB@@ABAAAB@@@AABA@@BCB@@@@@@@A@@@@@@@AB@@AB@A@@@AA@A@@@@@AA@AA@@@A@@@ABA@A@@A@@AA@@A@@@A@@@ABA@@@A@A@@@A@@DC@@@A@@BA@@@@@A@@A@@@A@@@@@A@@@AA@@A@@@AA@@@C@@@@CB@@@@A@A@AA@@C@@@A@@@A@A@@@@BC@@@@C@@@A@@@A@A@@@AA@A@@@ABA@@@@A@@BA@@@@@@@@AAA@@@@@A@@BA@@@@AA@@@BA@@@@@@@A@@A@@@AB@A@@C@@@@@@A@@A@A@@@@@BA@A@@B@BA@@@@@@@AA@@@A@@@@@@@@AA@@BA@@AA@@A@@@AABAA@@ABCBA@@@AAA@@A@@ABA@ACBA@@@@@AA@@A@@@@A@@@AAAA@@@AAAA@@@@@BA@A@@@@@@A@A@A@@AAA@@CA@@@@@@AB@AA@BAA@@@A@@@AA@@AA@@@A@@ACAAA@@A@@A@A@@B@@@@B@@@BB@@@@@BCB@@@BA@@@AB@@@B@@@@AB@B@@AB@@@@@A@@@@@BA@@@@B@@A@@A@@@B@@AA@@@BB@@@@@A@A@@@AB@@@B@@A@@B@@@@B@@B@@ABAB@@AB@B@B@@AAA@@BA@@BA@@@@AAC@@@@AAA@@@@AA@A@@@@@A@@@@A@@A@@@@A@AA@@A@@AA@AA@@@@A@@@@@AA@CA@@@ABA@@@@@A@AA@@@AA@@A@@A@@@@A@@@A@@@AAA@@A@@A@A@@@A@@AC@@@A@AA@@ABAAB@@A@@@AA@@@AB@B@@A@@@A@@@@@@A@@@A@@B@@AB@@AA@@A@@A@@@@CB@@@B@@A@@A@@A@@@A@@@AA@DAAA@A@@@AA@@ABADAA@AA@ABA@@@AB@@A@@"],encodeOffsets:[[113200,23738]]}},{type:"Feature",id:"450881",properties:{name:"桂平市",cp:[110.079379,23.394325],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@@BB@@B@@@@@B@@BB@@@B@@BB@@@@@BA@B@@B@@@B@@BB@@@@BB@@B@@@@@B@B@B@BBB@@@BB@@B@@@@@@@B@BBB@@B@@BB@@@@B@@BB@@@@@B@@@B@@@@AB@BA@@@B@@@BD@@@@@BB@@@@BA@B@@B@B@B@B@@@B@@@@AB@@B@A@@B@BBB@@B@@BBBB@@B@B@@@@@B@B@BBBC@ABA@@@A@@@@@A@A@@BA@@@AAA@A@@@@@@@A@@B@AABA@@@A@@@@BA@@@ABADA@ABCB@@A@@@A@@@A@@@@@A@@@@BA@@BA@ABA@@@A@@BAB@@@BA@@B@@A@@B@@AB@@@@@BABAB@@@@@BA@@@@B@@@BA@ABA@AB@@A@@@A@@@ADA@@@A@@@ABC@CA@B@AA@@B@@ABA@@BB@@B@@@@AB@BA@@BBB@B@@@@AB@DA@@B@BAB@B@@@DAB@@@BCB@B@BAB@@@B@@@B@@@B@@@BAB@@A@@@AB@@@BAB@@A@AB@@@B@D@DBB@BBBB@C@@B@@AB@@AB@@@@AAA@@@AB@BA@@F@B@D@@@D@B@D@@A@@B@@BD@@@B@BDBBBB@@BB@@@B@@@@BA@@B@@AB@B@B@@@@AB@@@BA@A@@BA@@@@BAB@@A@@@A@A@@@A@@@A@A@@@@@AA@@@@AA@@A@@@@A@@ABA@@AAB@@AB@@AB@@@@A@AAA@@B@@@BA@@@@@A@@D@@A@@A@@A@@@A@@BC@@@A@@BCB@@A@A@@@@B@B@D@BA@@@A@ABA@@AA@A@A@@@A@@@C@@@A@ACC@A@@AC@@@AA@@@@@AA@@A@@@AA@@A@@A@@@C@AA@AA@AAAB@@AB@BAD@@@BABA@@@AB@@@B@D@BB@@B@@@B@@@@AB@@@B@FAB@@B@@@B@@B@@BB@@@DB@@B@@@B@B@@@@DB@@@BB@@@@B@AD@B@B@B@B@@BF@@@B@BB@B@B@@B@B@@B@@BB@@@B@@AB@B@FA@@D@@@@A@@B@BBBBBAB@@BB@@B@B@@@B@@B@@@@B@@B@@B@B@@AB@@@BA@@@ABA@@@@BAB@B@@@BBB@D@@@B@D@B@@@@@@@B@@@B@BBB@BBB@@BBB@B@@@@@B@@@@@B@@@BBF@B@@@B@B@BBDB@@B@@BB@BBB@B@BAB@@A@@BBB@B@BBDB@@B@@@AA@A@@@@@ABA@@@AA@@@@AB@@A@AB@BA@@@A@A@A@@AA@@B@@@AA@A@A@@@A@@@A@@A@@@A@@@@@@A@@A@@AA@AA@@BABABA@@@A@@AA@@@A@@AA@A@A@A@A@AA@ACA@@@AA@ABAB@@A@ABADAAC@@@A@@@AB@@A@A@@@AAA@A@A@@BA@@@A@@CAAA@@A@@CAA@A@@@A@@@A@@A@@@AA@CAA@@AA@A@CAA@@@A@C@A@AAA@AAA@A@@@AA@@A@@@A@@@ABC@@BAB@@@@@BAB@@@HB@@D@@@@@B@BA@@B@BB@A@@BA@@DA@@B@@ABE@A@ABA@A@AB@@A@@BA@@@A@@@@BA@@A@@@@@@@BA@B@@@@@@B@@@@@@A@@B@@@@@@B@@B@@@@@@A@@B@@@@@@B@@AB@@B@B@@@@@@A@@@@@@B@@@@@@@@AA@@A@@BA@@@@BB@@@@B@@A@@@@@A@A@ABEBG@@DC@@@A@A@A@@@@B@BBBBB@@@BA@@@@ACAAAA@@AA@@BABCBA@A@A@@@AC@A@@@AB@@A@@A@A@@BC@@@@AAC@A@A@AAAA@@@AA@BC@A@@@@BA@AFAFAB@F@JBJ@B@FBFAF@B@LCDABABCBC@C@C@A@C@C@AAAAE@CAA@@AA@A@A@ABA@ABABAD@DAHAF@BAD@@@B@B@FBB@B@BBFAD@FAB@B@BA@A@@@C@@@A@CAA@A@@@@@A@@@A@@@@B@@@@@AA@@@@B@@@@@@@@AA@A@@@@@@A@@B@@@@A@@@@AA@@@@@@@AA@@@BA@@A@A@@@@@@@A@@@@@@A@@@@@@@A@@@@@@@@@ABB@@@@@@@A@@@@A@@@@@@A@@A@BA@@@@@@@@B@@@@AA@@@B@@A@@A@@@@@A@@A@@@@@@B@@A@@DABA@@@@B@@ABABAB@@@BB@BBAD@@@@@B@DBBBB@B@@@B@@A@AAAAC@AAA@A@A@A@A@AB@BAB@BAB@BABA@AAC@CCE@@FC@ADE@C@@BAD@@@@@@A@@@A@@@A@@@@@A@@@@@@@@A@@@@@@A@@@A@A@A@@B@B@@A@@@@BA@@@AA@@@@@@@A@@@AAB@@@@@@@@@@@@A@@BB@@@@@A@@B@@@@A@@BAFC@@B@B@@@D@BBBBBD@@BA@@@@@@@@B@@A@@@@@A@A@@@@@@B@@A@@B@@@@@B@B@@@B@@@@A@CBA@@@A@@@A@A@AB@@@B@@A@@@@@A@AA@A@@@AA@@@@@ABC@A@@BA@AB@@@@@@C@@@AA@@@@A@@@A@C@C@@BABA@G@@AAAAA@AAAAAAAA@AAA@@@C@A@@AACCAA@@A@@@@@A@A@AA@@@A@AAC@@CAA@AA@A@A@@@A@A@A@ABA@@@A@@A@@C@@@@A@@@@@A@A@@@C@@AA@A@@@@@A@@@AB@@@@AABA@AAA@C@A@AAA@@B@@AB@@AB@@A@@AA@A@@@A@@BA@@AABA@A@A@AA@@@AA@@A@AC@A@@BA@A@@BA@@@@@@@ABA@@BA@@BA@A@A@@@@@A@A@@@A@@AA@AAA@AA@A@AA@AA@BAAAB@@AA@ABA@@A@@@@AA@@@A@@@@@A@A@@@@A@@@A@@@A@@@CA@@BA@@@C@@BA@A@A@A@@@@@C@@A@AA@@A@C@@@A@@@AAA@@@A@C@@C@A@@AAAAA@@@AAA@@@@@@A@@@A@@@@@A@@BA@@BA@@B@@@@@@A@A@@@ABA@@@@@A@@@@@@@A@@BA@ABC@@@AB@@@@@BADADA@@B@@A@@@@B@@B@@B@@@B@@@@B@@@D@@B@@B@@@@DB@@@@BC@@AA@@@A@AAA@@@@A@@@@@@A@A@AA@A@@@A@A@@@@@@@@@BABCBABABA@A@@@@@@BA@@@A@@@@A@@@AA@@@@@A@@@@@A@@AA@@A@A@@BAA@A@AB@@@@@@A@@BABABA@@@@B@@@@A@A@A@@A@@@@A@@AA@@@A@A@A@@@@A@@@@@ABA@A@@@A@@A@BA@@B@@AAA@@@A@@@AB@@A@@@@@A@@@AA@@A@A@@@AAA@A@@@A@@@A@@@@@A@@@@A@BA@@@A@@@@A@AA@A@@@A@@AA@@A@AA@@AB@@@BA@@@A@AB@@@@A@CBA@@B@B@BC@@AA@AAAA@@A@A@C@@A@@ABA@@BAB@@@@@@AA@@A@A@A@@AAA@@A@@@@A@@AA@@ABA@@A@@A@A@@A@BA@A@A@@AACA@@A@@@ABA@A@A@@AA@AAA@A@@@@A@@AA@AA@@BA@AA@@@@BABA@@@AB@AA@EBA@ABA@AAABA@@A@@A@@@AA@@AB@@@@A@A@@@AB@@A@A@@B@@@@A@@B@@@@A@@@@B@@@@@BA@A@A@A@@BC@@A@@A@@@@@AAAA@@A@A@A@@A@A@AA@@@AA@@@AB@A@@@@A@AB@@@@A@@@@AAA@@@A@ABA@@@AA@A@@@A@@@@@@A@@@@@A@@@@A@@A@@A@@@BAA@@A@@@@AA@@@A@AA@@@@@AABA@AAA@@@@AA@@AA@@@A@@@AA@@@@A@@@A@A@@@A@A@@BC@@DAAA@A@@AB@@A@@@A@@@CAA@@@@AAA@@@@A@@A@@@A@@@AAA@@@@AB@@@@A@@A@@@A@AA@@@AA@@A@CA@@@@B@B@@A@A@@@@BA@@B@A@@A@@A@@@@@A@AA@BA@AA@@@AB@@A@A@@B@@AB@@@B@@A@AC@@@BAB@@@BC@@BC@@@AA@@@@@A@@@@AB@@@@A@@@@BA@@@AB@@@@@BA@@@@@A@@@A@@@ABABA@@DA@@BA@A@A@AB@@A@@@A@@D@B@B@BA@@D@B@B@@@@AB@@@@@@CA@@@BA@@B@@@B@B@@@@@B@@@@@@@BAB@DAB@@A@@B@@@@@D@BBD@@@BA@@@A@@@@A@@@A@@A@@@AB@@@@@@AA@A@@@A@@A@@@@@A@@B@@@B@B@@@BB@@D@@A@ABAB@D@@@@@@@BB@@B@BA@@@AB@@@BB@@BB@@B@B@@@@AB@@@BA@@B@BBB@@BBAB@@@B@@@@A@@B@@@B@BB@@@@@@D@@@@ABA@@B@@@B@@AB@@@B@@@DBD@@@@@@AB@AAA@@A@A@@AABAAA@@@A@A@@@BB@BA@@@@B@@A@@BB@@@@B@@@B@AABA@@A@@@@AB@@@@A@A@@B@@@@A@@BA@@B@@@D@@@B@@ABA@A@@@A@@@@@A@@A@@A@@AA@@@@@AA@@@@@@ABABA@AB@@A@@@@AAA@@@@AB@@@@@AA@@@@@@AA@@@AAA@@@@@@AA@@A@@@AA@A@A@A@ACA@@C@@@A@@A@AA@@A@@@@@AA@AAAA@A@@A@@@@@@A@AAA@@BA@@AA@@BABA@@@@AA@@@@@A@@@AA@@@B@BABBBA@@@@@A@@BA@A@@@@@@@A@@@A@@BAA@@@ABA@@@@@A@@A@@A@A@A@@@A@A@@AA@A@@@@@AA@A@@@@@@BAB@@@B@@@@@@AA@@@@@@AB@A@@A@AAA@@BACA@AA@A@@A@@A@@@B@@@@@AAA@@@@A@@@A@@B@BA@@@@A@@A@@AAB@@A@A@@@@@AA@A@@@A@A@@@@@AA@@@A@AA@@A@@@@@AB@@@@A@AA@@@@@B@@BB@@BB@@@@@AA@@@@@@AA@@DA@A@ABAB@B@@@@@@@AA@@@@@A@@A@@@A@@BA@@A@@AABAA@@@A@@B@BA@@@A@@@@@@B@@@AAAA@A@AA@AA@@AA@A@@DA@@B@@@@@B@B@@@@AB@@@@B@@B@D@@@@@BA@A@A@A@@@@@@BB@@BBD@@A@@@A@@@@@@BB@@BA@@@C@A@@@A@@A@@A@@A@@@A@@AC@ABA@A@@@@AA@@@B@@A@@@@B@@A@@ABA@@AA@@@@ACA@@@@@@AA@AAA@@@@A@@@@@A@@@A@@AABA@A@@@C@AAA@@@ACA@@@@@A@AA@@@A@@@@@@AB@B@D@B@@@@@BBB@BCB@@@B@@@@@B@B@@@@@B@AA@@@A@ABAA@@AAA@A@A@A@A@@BC@A@@BA@A@AC@@A@@@@@@AA@@@AB@@@@C@AAA@@A@@@@@AA@@@A@@A@@AA@@@AA@@@@AA@A@@A@@@@A@A@@A@@A@@@@AB@@@AA@AAA@@@A@@@@@A@@@A@@@@A@@DE@A@@@A@@@A@A@@@AAAAC@A@@@A@@@AA@ACAAAA@@A@AAC@AB@AA@@@C@@@AA@@@A@@@AAA@@A@AA@@ABA@AA@@@@@@BA@@@A@@@@@@AAC@AAA@@A@@@A@@B@@@@A@A@A@@@@B@@@B@@@@AA@A@C@@@AB@@A@AB@@@A@@@@AA@@BA@@AA@@@A@A@@@@AA@A@ABA@@@A@@@@A@@@@@@A@A@@B@@AD@@@B@BA@@B@@AF@B@DA@A@@@@BA@@@@A@@@BABA@@@A@@A@@@@@@@@A@@@ADCB@@@B@@@@@@@AC@@@@A@@@@A@@B@@A@A@@AA@@AB@@@@@@@B@@@BA@@@@BAA@@AAAC@@BAB@@A@ABA@A@@@@A@@A@@@BA@AB@@@@@@@@A@@@A@A@A@@@@@@A@@A@A@@AAA@AB@@AB@@A@@B@@@@ABA@ABA@ABAA@BABA@@BA@@@A@@@@@@@A@@@@@AB@@@@A@@@A@@B@@@B@BC@@@A@A@@@A@@@@@@A@@A@@@@@@@@@@@@@A@@A@@AA@@A@@@@@@A@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@AA@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@A@@@@@@@ABABA@A@@ACB@@A@AB@@A@@@AB@@A@@A@@AB@@A@@B@@A@CBA@AA@@@@AA@@A@A@@@A@A@@@@@A@AA@@@@@@CCA@@@A@@@@@AAA@AA@@AAA@A@AAA@@@@@@@A@@@CB@@@@@BBB@B@@@D@DBB@@B@BB@@@B@BB@@@B@@@@@BBB@@@BBB@@@@@@DCB@B@@@BAB@@@B@@ADAB@@AB@@@B@@@B@@@BA@AB@@@B@@ABCB@@@BAB@B@@@@A@A@CAA@@@@@A@EBC@A@A@CAA@A@@BA@@@A@AB@@@@AB@@CB@@@B@@AB@B@@A@C@AB@@A@@AA@@@@BAB@@AA@@@@AAAB@AAB@@@@A@@AA@A@A@@@@@A@A@@@ABA@@@C@@@A@@BA@A@@BA@@@@@A@@@A@@@A@@A@@A@@@@@@A@@A@@AA@@A@@A@A@A@@@@@@@CA@@@BA@ABAA@@AB@B@@AD@@C@@@@@@AB@@@@@A@@@A@@@BA@@B@@A@@@@AA@@A@C@AA@@ABA@@@AAA@@AA@@@@@AABAA@@@@AAA@@A@@@A@@@A@AB@@@A@@@@AB@@@A@@@@@A@@@@@@@@@@AB@@@@@@@A@@@A@@A@A@@@@@@@@ACAA@A@@@@BAA@@AA@AAA@@AA@@A@A@@A@@A@A@@AAAA@@BA@A@@@@BA@@@@B@@A@@@@@A@@@@@A@A@AB@@@@BB@A@B@@A@@@AB@@@@B@@@BA@@BB@@@@@B@@@BA@@@CA@A@B@BBB@@A@@@@BA@@AA@@A@A@AA@ABAD@@@B@@@B@B@@@B@@@D@@BB@B@B@@BBB@@@@B@@@B@@B@@B@@@B@@BD@@@B@@@@ABCBA@A@@@A@@BA@A@@@CDC@A@A@@@CB@B@@@B@@A@AB@B@@@BBB@@BB@@B@BD@@AD@@AA@@CD@@@BA@@B@BA@@B@@@BA@@B@@@B@@@@CB@B@@@B@@@@@@BA@@B@@B@@A@@@AD@@@B@@@@ABABA@@B@B@@A@A@@BA@@BBB@@@@B@@@@@BAB@@@@B@B@B@BAB@B@@@@B@BA@@B@@B@@BA@@@ABAB@@@B@@@BBBB@@@BA@@@A@A@A@@@AB@@@@AA@@@B@@ABA@A@AB@BA@BB@@B@B@@@@@@B@@ABCBA@@@@D@@@@@@@@A@A@@AA@@@A@@B@@@B@@BAB@@@B@@B@@@@A@@@AA@@AB@@@@@BB@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@B@@BA@@@@@@@B@@@@@@@@C@@@A@@A@@@@A@B@@BC@A@@@@A@A@@ABA@@@@AA@@A@@@A@@@A@@DA@@@@@B@B@@B@@B@@@@D@@@@@@AA@@A@A@@@A@@AA@A@@@@A@@AA@@A@@@@@A@@@A@AA@A@@A@@@A@AAA@@@AA@@@@@A@@@CDA@@@A@@@BB@@B@A@@@@@@B@@@@@B@@@@@@@@A@@@@A@AA@@A@@@A@@@A@@BAA@BA@@A@@AA@@@AA@@@@@B@@@@A@@@AA@@@A@A@@@@AB@@@BA@@@@@@B@@C@@@@@A@@@A@@@@@@BB@@@@@@B@@A@@@A@CAA@ACCB@B@@@@A@@@@BA@@B@@A@@B@@@B@B@@@BB@A@A@A@@@@@AB@@@BA@A@A@@BA@@@ABA@@@A@BB@@ADA@@AA@AAA@@@CDA@@@AA@A@@AA@@@AA@@AAB@@AB@@@@A@@B@@@BA@A@A@CBA@@B@@@BA@A@@@AA@@@B@@@B@BBB@BB@@@BB@@@BAB@@@BBBAB@@@DAB@@A@C@A@@@@@A@@B@@AB@@@@B@@B@@@@A@@@A@A@@A@@@B@B@B@@@@BB@@@B@@A@@@@@@@@A@@@@@B@B@@@B@@B@@@@@@B@@@@@@AA@@@@A@@B@@@@ABA@@@BB@@A@BBAB@B@@@@A@@@@@@A@@@A@A@A@@A@@@@B@@@BA@A@@@AB@@A@@@BB@@ABAB@@AB@@@@AB@B@@@@AB@AA@@B@B@@@@@B@@@@@@@B@@@@@@@@@BB@@@@B@@@@@BA@@@@@A@@@A@@@A@@B@A@@@@@@A@@AA@@@@@A@@@@@@@BA@@@@A@A@@@@BA@@A@A@@@@A@@BA@@B@@@@@BA@A@@@B@@@@B@@@@@@A@@B@@@B@@@@AA@@A@@B@BA@@@@BB@@@@BA@@@A@@@A@@@A@@AA@@@@@@B@@@B@@AB@@A@@@B@@@@@@@@@@@@B@@AAA@@@@B@@C@@B@@A@@A@@AB@@@@@@@@@@@AA@@@@@@@A@@@@BA@@@A@@@A@@@@B@@B@B@@AB@@@@@A@@B@@@@@B@@@@@@@@@@A@B@@B@@@@A@@A@@@A@@A@@B@@@@@A@@A@A@@B@@@@@@@@B@@@AB@@B@@@@@@BB@@@@@@@@@A@@@@A@@A@@B@@A@@B@@@@@B@@A@@@BA@@@A@@@@@@@@A@@@@B@AA@A@AA@@AB@@@BB@@@@A@@BB@@@B@@B@@B@@@@@@A@@@@BA@@A@@BA@@@@A@@@A@@@@@@B@@AD@@A@@AAB@B@@@@@@AB@@@@AB@@BCAA@@@@A@@@@@@@@B@@@@@@AA@AA@@@@@@@ABA@@@A@@B@B@B@@@@A@@@@@A@@@@@A@@A@@AB@AAB@@A@AB@@@@@A@@A@@@A@@@@@B@@@@@@@@@AA@@@@BA@@@A@@@AA@@@@B@B@@@@@@@@A@@@B@@B@@@@@BA@B@@@@B@@@@A@@A@@@@A@@@A@@@@A@@@A@@B@@@@@@@@AAB@B@@@@@@@@@BA@@BB@@@@@@B@@@@A@AA@@@A@AA@@@A@@A@@AA@@@@A@@@@B@@A@A@@@@@@@@B@@@B@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@A@@A@A@@A@@B@@@@@@@A@@@@@@@AA@@@@A@@B@@@B@A@@@A@@@@AA@@B@@@@@@@@B@@B@@@@@BB@@@@@A@@@@B@@AAA@@@A@@@A@@@A@@@@@@@A@AB@B@@A@@B@@A@@A@@@@A@@@BB@@@@A@@@@AA@@@@@@BB@@@@@@BA@@@@@@B@A@@AA@@@@@@AB@@A@@A@@@A@@@@@@B@@AA@@@@@A@@@AB@@A@@@@@AB@@@A@@A@@A@@B@@@@@B@@@@@@@@@AAA@@B@D@B@@AA@@@@A@A@A@@@@B@A@@A@@@@@@@AB@@B@@B@B@BA@A@@@@@@A@@@@A@@@@@A@@@@@@B@@@A@@@A@@@@A@A@@@@@@B@@@@AB@@@@A@@@@BABAB@@@B@@AB@@A@@@EF@@@@@@@B@@AB@@@@@@AB@B@@@DA@@B@@A@AD@@AB@B@@BB@B@@BB@BBD@@@BBD@@AD@@@B@@@BBF@BBD@BDBB@@B@@AB@@CBABEDA@@B@@C@A@A@A@ABA@@@@@@B@@@BBB@@B@B@B@DB@@@@@B@@@BA@@@A@A@@AA@A@A@C@A@@@AB@@@B@@@BBB@BB@@BB@F@@B@@@@@B@@C@@BAAA@@@@@EEA@@@@@@@@B@@@B@BBBDF@@@@@@ABA@AA@@@@@CAAACAA@@A@@@AB@@@@@BCB@BBBBBBFBBF@DB@BB@BB@B@BBB@D@BADAB@BABCFCDABCD@D@@K@@B@BA@@@ABA@AB@@@BC@A@CBE@AB@@@B@B@@@B@B@@@B@@@DBDC@@@A@@@@BAB@@AB@@A@A@A@C@A@@@AB@@@@ABBBA@@BA@AB@BABA@@@@BAB@BA@@@A@@@AA@@A@AB@BAB@@A@@BA@A@@B@@ABAB@@@@@B@@A@ADA@@@@@BB@@AB@B@@@B@@BB@@@@B@@B@@@@D@@B@BB@@@B@@@@@@@B@B@B@@BB@@BB@B@@@@@B@@B@B@@B@@@@@@@@B@@B@B@BDBD@B@@@B@B@@AB@@A@ABA@A@A@ABCB@@@@@BA@B@AB@@@B@@A@@BB@@@AB@D@HBD@BAB@B@DAD@@@@G@EAC@A@A@@@@@@D@B@@@@@B@@ABB@ALB`@AF@@@BA@@DABBB@@@B@BAB@BDF@B@@@@B@@@B@@OD@@@@B@A@@B@@A@@@AA@@@@AB@@CHE@@@@BB@@B@@A@@@@@@@@@B@@BA@@@@B@@B@@@@B@@@BB@AB@@B@@B@@AB@@@@RBD@@BJ@BL@H@DGBDH@@AB@@AB@@@@@BA@@@@@BHJX@FBB@AB@@@@@@@@@@B@B@@@@BAB@@B@@A@@B@@@@@@B@B@A@@B@@B@@@BBB@@BA@@B@B@@@@A@A@@@@@@BB@@@AB@@@@A@@@@@@B@@@@A@@@A@@@EHCD@@@B@@@BB@BBB@B@@@BB@@AB@@@B@@BB@@@B@@CB@B@B@B@B@@@@A@A@@@A@@B@@ABA@@@AA@@A@@@@BABAB@@A@@@AAA@A@AA@@AB@@A@@@@A@@@A@@A@@@@@A@@@@B@@@BA@@@CB@@@A@@@@@A@@A@C@CB@DBD@B@BBD@@@D@@@BA@A@@B@BAB@B@BA@CDAB@B@@@@@B@@A@ABABABA@A@ABA@@@@BAB@@A@@@AB@@ABC@@DABAB@B@@@B@@@B@@A@A@@@@FA@@@@@@@@@@B@@AB@B@BAB@B@@@B@@CBAB@@@@AA@@@AA@@@AA@@AAAAA@@A@A@ABAA@@AABA@A@AB@A@A@@AAABABABABCB@BA@@@@B@@@B@BA@@D@@@@A@AB@B@B@B@@B@@@@A@@@@@BBD@B@B@DA@@@ABA@A@C@@@@@AA@@@@@B@@AB@BA@@@AB@@A@AAA@A@@@@BAB@B@BA@ADAB@@CB@@@BAB@B@@@@BD@@@B@DA@BD@B@@DB@B@@A@@@CBAB@@A@A@@@A@@BC@A@@BEBA@C@@@A@@BABABA@A@A@AAAA@@A@@D@BABAB@@A@ACAB@@A@A@A@@BBB@BCBAB@@@@@DAB@@AB@@@BB@@@B@@@@@B@BA@@B@B@@@BA@@B@BB@@A@@@@@@@@@@B@@@@B@D@@@B@@@JAB@B@DBB@B@@@B@@BF@B@BB@@@BBDDD@@BB@F@B@@A@@@AB@@@B@@BB@@@B@D@@@B@@BB@@@BA@@@ABB@@BA@@B@@@@AA@@A@@B@@@B@@BDBB@B@@@BAB@B@@@@@BA@AB@@@B@@@@@B@@BB@AB@@@B@@BBD@@BB@@@DBDAB@@@B@@@@B@@@B@@B@@BB@@@BB@@@B@B@@@@BB@@B@B@@BB@B@BB@@B@@B@@BB@@@BAB@@@AA@@@AB@AA@@@@BA@@@@@C@A@@B@@@BA@@BAHA@ABA@@@@BB@B@BBF@BB@B@@@@G@@H@DBB@HAB@D@D@BB@@DBFDDDDDBDBB@@@B@BBB@D@DBD@H@BBB@@@@@@DBB@DAB@BBDBBBB@B@B@FAB@B@DCB@B@D@DBB@DAFAH@DADAD@H@B@B@DAHABADCJEB@@ABABC@ABE@A@@@@@C@A@CBA@C@GBEBCBADCBCJE@@BB@B@@BB@@AB@@@B@B@B@@@DB@B@B@@B@BAB@@B@@@@AB@@@@B@@@BB@@@B@@@@@BB@BA@@@@@@BAB@@@@@@B@B@@@BA@@@@B@B@BB@@B@@@B@@@A@AB@BB@B@@B@@@@B@@@@AB@@@@@BB@@A@@BBB@@@B@@@BBB@@BB@@BBB@@@B@@@@@@B@@@@@@B@@@@@@@B@@D@B@@B@@B@@B@@@@@B@A@@B@@BB@A@@@@@BB@BB@@B@BB@@@B@@B@@@B@@@BA@@@B@@@@@BABAB@@AD@@@B@B@@@B@BAB@B@BDB@@@B@@BB@@@B@BAB@@AB@B@@@B@@BB@BBB@@@@BDBB@@@BB@@B@@@BB@@B@B@@BB@@A@@B@@@BA@BD@B@@B@BB@@@DABB@@BB@BB@BAB@@@BA@@B@BA@@@ABB@@BB@@B@@@B@@B@@B@@@B@@@@AB@@AD@@D@AB@@BB@B@@@B@@@@ABA@A@@@A@@@CBAB@@A@A@@B@@A@@D@@BB@@B@@@BB@@@B@B@B@@BB@@@BA@@B@@B@BB@B@@@BBB@@A@@B@@AB@@@BA@@B@@@B@@@@B@@@BB@@@BB@@@@@BBB@@B@@@@B@@@AB@FB@@@BB@@B@@B@BB@@BBB@@B@BD@@B@@B@@@BB@BB@@BB@@@@B@BB@@@B@B@@DD@BBB@B@@BBB@@@BB@@BB@@B@BB@@@@@B@@BA@@@@@B@@AB@B@@@B@B@B@BB@@B@B@B@@AB@@@@A@@A@@@@@@@@@BAB@@@D@@@B@@@B@@@B@@BBAB@@@@@B@B@@@B@@@B@@@B@@@B@B@@B@@BB@@BBBBBBB@BB@@BBB@B@@@B@@BD@@@BB@@@BDB@@BB@AB@@BBA@BB@BAB@@@@@B@@@BA@BBAB@@@@@B@@AB@@@BBD@BA@CB@@@BA@@D@B@@AB@B@@@B@@@B@B@B@B@@@B@B@B@BB@BB@B@@@B@@A@BBA@@BABB@@B@@@@@B@@@@A@BBA@BB@@@@BD@@@@@B@@@@@B@@A@@@@@@BB@BB@@BB@BAB@@@@@B@@B@@@@B@@@@@@@B@@@@@@@@B@@B@@BB@@@@B@@@@B@@A@@@@@@BB@@B@B@@B@@@B@BB@@BAB@@@BB@@B@B@@@@@BB@@BA@@BB@@B@@@BB@@@A@@@@@@B@@@@@@@BAB@@A@@BBBA@BB@@@B@@@@@@@BBBAB@@@@B@@@B@@@@@BB@@B@A@@@B@@@B@B@@@B@@@@@BB@@@@B@@@@@@@@@B@@B@@BB@@@@B@@@B@@@BA@@B@@BB@B@B@@@BBB@@A@@@@@BB@@@B@B@BB@@@B@@BAB@@@@@BAB@@@@ABA@@@ABAA@@A@@@@B@@A@A@@@@B@B@@@@@@@@@BB@@B@@@B@@@B@B@@B@D@@@@B@@B@BBB@@@@@BB@@@@@DBBB@@@@@BB@BBBB@@BB@@@B@B@B@D@DABBBB@@@@B@@AB@@B@@BB@@BBB@@@@@BBAB@B@@B@@@BB@@BBABB@@B@@@@BB@@B@@BBB@B@@@@AB@@@@BBB@B@@B@@@@@B@@@B@B@@BBA@B@@B@@@BB@@@@B@@@B@B@@BB@@@BB@B@@@@BB@@B@@B@@@@BB@@D@B@@@BBB@@@@@B@@AB@@@B@@@B@BB@@@A@@BB@@BAD@@B@@B@@@@B@@AB@BB@@@@@AB@@@D@B@BAB@@@D@B@D@B@@B@@@@@@B@@@BBB@BBB@@@BB@AB@BA@AB@@@BA@@B@@BB@@ABB@AB@@@B@@@BB@@DA@B"],encodeOffsets:[[112668,24349]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,4 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("桂林",{type:"FeatureCollection",features:[{type:"Feature",id:"450302",properties:{name:"秀峰区",cp:[110.264183,25.273625],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@ACEGCB@A@@@B@@@@A@ABAB@@@@A@@@@@A@EBA@AAA@A@@B@@A@C@@@@@A@AA@@@@@@@@@@EAEAB@DAB@B@AA@@@@@@@A@@@@@@@@@@A@@A@@@@@@A@@@@@@@A@@@@@@A@@@@A@@@AA@@@@@@A@@@@@@AB@@@@AA@A@A@@@A@@@BA@@@@@@B@AA@@@A@@A@A@E@@@C@@@A@A@A@A@A@@E@@@@A@@@@@@@@@@E@AB@B@B@@@B@@B@@@@@B@B@@@@@@@B@@A@@@@@AB@@@@B@@@B@@@@B@@B@@@B@@A@@@@B@@B@A@AB@H@@@@E@@A@@@@@@@@@@BC@BA@@AAB@B@@@@BB@@@@@@@B@@A@@D@@@@A@@@A@AB@@@B@@@@@@A@@A@B@@A@@@AA@@A@B@@A@@@@BAA@@@@@A@@AA@BA@@@@@A@@@@AA@A@BAA@@AA@A@AA@@A@@@@@A@@@@A@@@@@@@BA@@@C@@@BA@@A@@@@CB@@@B@@@@A@@@CB@@@BABB@B@@@@@BBA@@@@@@BB@@@@A@C@@@@B@@A@BB@B@BB@B@BGDBBABA@@A@CBAAAAA@AA@AA@@@A@@AA@@AA@@@@@@@AB@@CAAA@@@@@B@@@B@B@@A@AB@@A@@@@@@@@@A@@@A@@@@@A@@AC@CA@@C@@AA@@@A@A@@@AA@@@@AA@@CA@@@A@CBABA@@@@A@@A@@A@@@A@A@@@AB@@AB@@CF@B@B@@A@@B@@@@BBDB@BBB@@A@CD@@@B@D@B@@@@DD@@@B@@ABC@@@@@@C@AA@@@@@AA@BA@@@BDAB@B@@@B@@A@@BC@@@ABAB@@BB@@@B@B@B@@@@DD@@@BB@B@A@@BB@@B@B@@BB@BDBB@@BBBBB@B@B@@@B@@BB@@@@@D@@AB@@BB@@B@@BB@BBABBBBBBB@@@@@BFCB@BBBABB@@@D@@@BABDB@@B@BBB@@@D@@@BB@@CB@BA@A@A@AAA@AAA@@BDD@B@@@BA@@BD@@BB@@BAB@@@@BA@@BBB@BBB@BBBBDD@BBB@B@@A@A@@BA@A@@BA@@B@@B@@BB@@@B@B@AAB@@@@@@BB@B@@A@@@@@@@AB@@@@B@@@@@@BA@@@@@B@@@@B@@@@@B@@@@@@@@@ABA@A@@@@B@@A@@@@@@BB@@@@@ABA@BB@@BBBB@@A@@B@@@B@B@B@@BB@B@@AD@@@@BBBD@@FA@@@BBBB@BBB@B@@@@@B@BBBBADAB@B@@A@@@ABBB@@@@@B@@@B@@A@@BA@@@A@B@@@@B@@@@@B@@BA@@@@@@BB@B@@@@BB@A@@AA@@B@B@@@B@@AB@@ABB@B@A@@@@@@@@B@@@@@@@B@A@@A@@B@@@@@@@B@@@@ABB@@@A@@A@@@B@@A@@@@@@@@@@BA@@B@@@@@B@@@B@AAB@B@BBBAB@B@BAB@@@@BA@@@B@B@@@@@@@@@@@B@@B@@@AB@@@@AAA@@BBB@@A@A@AF@BB@A@@@@@@CA@@@C@@B@@@D@@@@B@@@B@B@@BB@@@@@B@@@@B@@@@@B@B@B@@@AK@AAAC@@A@A@@CA@@@@ACB@@C@@@BA@@BAA@@A@@@A@A@@@ABA@@@@@@CB@@A@@A@A@A@@@@@@B@@@@A@@@@@@A@@@A@@B@AA@@@@@@AABA@@@@B@@@@@@@B@@@B@DA@@@A@@@@A@@@@@@@@@@A@@@@A@@AA@@@BD@@A@@@A@@C@@A@@@A@@A@@@BBA@@@A@@@@@@@AABAA@BA@@@@BA@A@@@@@AA@@AC@@@@AA@@@A@@AA@@B@B@B@@@D@@@AC@@A@A@@@@@@@A@@@A@@AD@BA@@AC@@A@A@@@A@@@@@@AB@@@@AAA@@BA@@B@B@BB@@B@@@B@DADAB@@@AA@@@@@AD@B@B@B@@@DC@AA@@@@@@A@@@@@ABB@@DB@@BA@@@A@@@@B@A@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@B@@@B@@B@B@BBBHA@@BA@@B@@@@@D@@ACC@AAC@@@@"],encodeOffsets:[[112951,25884]]}},{type:"Feature",id:"450305",properties:{name:"七星区",cp:[110.317826,25.252701],childNum:1},geometry:{type:"Polygon",coordinates:["@@BEFAAEDCCC@AA@CCCBGDABA@IJEACAIJ@@GHAB@BGF@A@@AB@B@BB@A@AAKMC@BDABAA@@@BA@A@@A@AA@A@A@A@@A@CA@@AWP@@ABABCBABAB@@AB@@ABEBAB@AA@@AA@A@@@A@@BA@@@@@@D@@@@@BAB@@ABABCDA@@B@@AD@@A@@BB@FDDBBH@D@B@BAH@@@@@BDH@@FHBD@@@@@@BD@BDD@B@F@FADAD@DBD@@@@DB@BJFDBBBB@FF@A@BBAB@@@@A@@DAAA@@@A@A@AA@@A@B@AA@@ABA@AB@AC@@@@BA@@@BB@@@BB@A@A@@@AB@@@@@BB@@@AB@@@@@@@@A@@B@B@B@@@B@@A@@BAB@@@@@B@@@@B@@@@D@@@@A@@B@BAB@DCHBCB@B@BD@@BB@HDBBHDHAHA@CD@@@B@@@ABDDFAHDDBFBBBBAB@@DD@@@F@DBBAFGFCHE@I@CAA@@AEDEBAFCD@@A@AACA@CAG@A@A@BA@@BG@GBB@A@AIA@AACBAACGCBCA@BCJG@CBCQE@@BEDEFGBA@AE@AABCAA@@AB@@@BAD@BBADB@B@BAA@BABABA@AA@A@ACACD@BA@@@C@@@@A@@@@@@@@AA@@@@"],encodeOffsets:[[113026,25832]]}},{type:"Feature",id:"450303",properties:{name:"叠彩区",cp:[110.301723,25.314],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@A@A@AA@@@A@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@B@A@@@@@@B@@AB@@CA@@AA@B@@@@@B@@@@B@@BCD@@A@A@A@C@@B@@@@BB@@A@CBCBA@@@A@@@AAA@A@@@AB@@BB@B@@A@@B@@@@B@@@B@B@@@BD@@ABC@@BB@@@B@@@@@@@B@B@@@BD@@C@@@A@A@A@@@BB@@@B@@BB@@@@BD@@BB@@@@B@B@@A@@B@@ABBBA@B@@@@@@@B@@AB@A@@@BB@@@B@@@@DB@@@B@@@AC@@B@@BB@@@@@@B@@@@@@@@B@@@@@@B@@CBA@@@A@@@@@@@A@@@@@ABBB@@@@@@BBA@@@@B@@@B@@@@B@@@@@@A@@@@B@B@B@@@@BA@@D@@@@B@BA@@B@B@@@B@@@BB@AB@@A@@@DA@BD@@@@DB@@@B@BD@BB@BBL@@B@@AD@BD@@B@@@@@@@@BD@@@@BB@@BB@@BBB@@@A@BB@@@@@@@BA@BB@@@B@@A@@B@B@BAB@BB@@BFB@@@BB@@@A@@@@B@@B@@@ABBB@@B@@@B@@BAA@BA@BB@@D@B@B@@@@@D@D@BDDB@BBHDDBBBJBDBB@@@B@@A@AB@@ABA@@@@B@BA@BA@@BB@B@@@B@@@BB@AB@@AA@@CA@@@BAB@BB@B@@@@BBB@@BBB@@@BAA@@ADB@@@D@@@@A@@A@@AB@@@@@@AA@@A@@@@@@@@BA@@@@B@@A@@B@@AB@B@@@B@@BB@B@BB@A@@@AA@@@CAEA@A@@@@@@@@C@A@@@@@BAB@@A@@A@@B@A@A@@BB@@BA@@@@@A@@B@@A@@@@A@@@@@@AB@@@BB@BB@@A@@A@@@@@@@@A@@@@B@@A@@@@BB@A@@@@@@@@@@@@@@B@@@@A@@@@B@@@@@A@@A@@@@BA@@@@A@@@@@AA@@@DA@@@@@A@AA@@@@AA@@@@AA@A@@B@B@@@B@@@@@@@B@@@@AA@@AA@B@B@BA@BBABBB@BA@@B@B@@A@@B@@@B@@@BA@BB@@@B@BABA@AB@B@BAB@@@DA@@B@@@@AB@BA@@@@@A@A@@BA@AA@AAAAAA@@@@AA@AA@@AA@AA@A@A@A@AAA@@AC@C@A@A@A@@@A@@B@@@B@@@@A@@BA@@B@@A@@C@@CA@ABAAEACAGCEBCCBA@@A@@@C@@DGBGBGCAAGCA@@AC@@A@ADAGACDA@ABA@@@@B@@C@@@@@@A@@A@@@@@A@AB@@@BA@@@A@A@A@@@@B@@@@@@A@@B@@AA@@@@A@@B@@@B@BAA@@A@@A@@AB@@@@BDA@@BAB@BB@@B@A@BB@@B@B@B@@BBCB@@@B@@A@AB@A@BEEA@AACAIE@ACA@@@@AC@CBCBC@E@EC@@@@@A@@@AB@@GB"],encodeOffsets:[[112946,25892]]}},{type:"Feature",id:"450304",properties:{name:"象山区",cp:[110.281082,25.261686],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CAGCAECA@@AB@@@BC@@@AB@DCBABA@@BA@A@@@@@C@@@@B@@AB@@@B@B@@BB@@BBAFABA@@BA@@BABADABABA@@AA@AA@@A@@@A@@AA@@BA@@BA@@BA@@@A@@AA@BA@@A@@AAA@@AB@@@@AA@@@AA@AA@@C@@B@D@@A@@@A@@@@@@BA@@AC@A@@@@@A@A@@@AA@AAAA@@@AA@@@BA@@A@@A@@BA@@@A@@@@BADA@@@@@BBBB@D@BA@@BD@ABAB@DD@BBBBB@BD@@C@ED@AS@GAC@CBCA@A@@CA@CHGDCCABC@ABACA@AAA@BA@A@A@@@ACA@AC@DCA@@AAC@@@C@CAAB@BAB@AADABCBADD@AB@DABBAB@BDABEBBABDDDBBAAA@ACCCEB@@@BA@@A@@@CCCEBADBEICCDCAGCACBAAAC@AA@E@EDC@A@@AAACAGAGBAAA@@A@AGC@BC@AA@B@BA@A@A@GA@@@@BB@B@@AB@@@@@B@@B@@BA@@@A@@@@@A@@@@BB@@@@@A@@B@@@@@@@@@BB@@@@BB@@@@@@@@B@@@@A@@A@@@@@B@@@B@@@B@@B@@BC@@@@@@@@@@B@@B@@@@BB@@@@B@@A@@BB@@B@A@@B@@@@@AB@@@@@@B@@@BCB@BB@@@@@BB@@@@@B@B@@BAB@@@@@@ABAB@@A@@@@@AB@B@@@BA@@BCDABAB@@A@@@@B@D@@@@AA@@A@A@A@@@CD@AA@@@AB@B@@AB@@B@@@BBBAB@@@AA@@@@@A@@@@B@B@@@@B@@B@AD@@@@AB@@BBB@B@@@@@ABA@A@A@@@AB@BA@@@A@A@AB@@ADAB@@AA@@A@A@AAA@E@@D@DC@AA@B@@AB@@ABABA@@BABAD@@ABA@CAA@@B@B@FBB@B@@@BCB@@A@@@@@BA@@@AAGAG@@@BAB@B@BAH@@ADAFBDDBADBBABD@@AB@@@B@@BAB@@DBBFDAB@@@@B@B@B@@@B@@A@A@@DAB@BAB@@CB@BA@@@B@BB@B@@A@@BBBBD@@BBCBBD@@B@B@@@B@@@@BB@@@@@ABAB@D@B@@DB@@BB@@@@BB@@B@B@@@B@@BD@@@DBD@@BB@@@@@B@@@B@@@@@@@@@B@@@BAB@@@@A@A@@@A@@@@BBDB@@BA@@@@@@B@@BB@@BB@@@B@@BBBB@BBBBDAB@@@ABABCAAHA@A@@A@AAAB@@@@A@@D@B@@@@@AA@@@@@@AB@A@@@@@AAAAB@@A@@D@@@B@@A@@@A@@D@@B@@@AB@@D@@@B@@A@@@@@@@B@@B@@@@@B@@@BBB@B@@BB@ABB@B@@B@@B@@@@@B@@ABB@@@B@@@@BB@A@@B@@@@A@BB@@B@@@BA@B@@@@B@@@@A@@@A@@B@B@@@B@@C@@@@BA@@@@@@@A@@A@@A@A@BB@@ABD@@A@@@@@@@@B@@@@F@@G@A@@B@B@AA@@@@@@BA@@@A@@@@A@@A@@@A@@@@@BA@@@@B@@@@A@@@@@@@A@A@@@@@AA@@@A@A@A@@B@F@@@@@@@@B@@@@@@FB@B@B@B@B@@@D@@@F@B@B@@@@B@@BBA@@@@@@@AB@@B@@@B@B@B@@B@@A@@B@@@@B@@@@@@@BB@@B@@@@@@B@@@@B@@@@@@@B@@@@@@@@BB@@@@@@@@@@@@B@@@@@@BBA@A@CBA@FBFB@@@@@@@@@@BBB@@@@@D@B@@@@AB@B@BBB@FAB@@@@@B@@@@@BABAB@@@@@@A@@@BDA@@CG@A@@@@BG@A@A"],encodeOffsets:[[112946,25867]]}},{type:"Feature",id:"450311",properties:{name:"雁山区",cp:[110.28669,25.101934],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAAF@AACA@CB@DAB@@GRBHCHAB@@AF@FABADCD@@AAEB@AACIC@BADBBC@A@@ACCCCCC@CEBAJQAIDAB@BB@CHABAFF@ABEDEB@DCF@DBFAFAD@BADEBCBABBBABA@A@@@@BABC@CB@D@DD@H@F@FBBB@B@B@@@D@@BFBDDFAB@@@@ABCBIJGD@B@D@@BDFDHD@@@@@@A@@BA@ED@BB@ABB@@B@@BBB@@@B@@@BB@@@@@BBB@@B@@B@@B@B@@AB@@@@B@@BB@@A@@@ABA@@@AB@@@@@@B@B@@ABB@@@@@B@@D@@@@@@@B@@A@@B@@@BBB@AB@B@@@@@@HBB@B@B@@A@ABBD@@AHD@B@BB@BBHAHBDBBB@BB@D@FCF@B@@BBDBBDADBBHCDDDFJCAABDFDD@@B@@@AB@@A@DFDD@BBBABCACCBAAAAFCB@ABAAACBA@@BCCABADCBBBA@ABA@BB@D@D@@BD@BB@CDD@@BDB@B@@@B@BABB@BBB@BDBAD@BADDHCDGB@@DB@B@AD@DBD@HBTC@@F@DC@@AAAAA@ACCA@AB@BAC@@ABC@A@AA@A@@@@CBAB@@@@@B@@AB@@@BB@@@AB@@B@@B@@BBBBB@@B@@@B@B@@@@@BBD@@AB@@@@@@@B@@@BC@A@@@@DB@@BBB@@B@@B@@A@@BB@BB@@@BB@@ABB@@@B@@AB@@AB@@AB@@BB@@@B@@@BB@@BBB@@@@XO@BB@@D@BB@B@B@B@@B@BB@B@@A@@BBBAACD@LNBBB@A@@A@ABA@@@BHE@ABAHG@@JIDBFBJIB@BAHCCEDAFBDC@CFCFEDE@A@@EDAA@@DA@ADACA@@C@A@@A@A@ACA@EBC@@BCJIAACE@C@GECABA@IMGCBACC@BACC@FCF@AACEBA@CLAB@BAD@@A@C@IBCBGBAFEJCBED@DB@@D@DB@B@B@D@BF@HA@@BBA@AB@DCFCFCDJ@FB@FEBCBCACCA@ADDBBB@D@@@DA@@BBBDBB@B@DADA@CBCBEDCDABABBBAB@B@DDDBDA@@JAF@F@BA@E@E@C@GCGCMCEAC@@HABA@AD@BC@CFAB@BBDBABD@D@@@BEDAAA@AD@BAD@BEDADA@ABABA@@BCFAB@@CDAFAF@@ED@B@@A@A@AAAAEFA@@DABBDAD@@AB@BCB@@CDAF@@ABAAAE@AACA@A@A@@AAAA@C@@EA@C@AACA@A@@@AA@ABA@A@@AEEK@A@@@AA@IDA@BDGJA@@DA@CEG@EHSL@@GGC@AE@EAACEBCCCIFC@@CCA@GIECBAG@@BC@A@GII@IACACG@AFEHEAICGCG@@F@FG@GIDCICAB@@@@MBABCBG@CEIBI@GDGBE@@AEA@ADC@AFADA@D@DD@FAFEBGEACCIE@@G@CFEB@B@@@BCHBBH@@B@BADCAE@"],encodeOffsets:[[112979,25609]]}},{type:"Feature",id:"450321",properties:{name:"阳朔县",cp:[110.496593,24.77848],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@AB@@A@@@A@@@ABCC@@A@@@ABA@AA@@AAA@A@@AAAAAA@A@C@ACA@@AACA@@DA@@BABA@@@A@A@ABA@A@ABABA@@@ADA@@@A@AB@BAB@@A@AB@BC@A@A@A@@EBADAAA@A@AA@@AC@A@A@A@C@AA@AAA@@A@C@ABA@C@@@AB@@A@ABA@A@A@@BABABAB@B@BBB@@A@ABAAAB@AA@@@A@ABA@ABA@A@AB@@A@@BA@@BA@@B@@@BA@@D@@A@AB@@AA@@AA@@@AABIG@CLIA@C@@BE@KBADKGEDA@IFIFGDCACI@@ABAB@@AB@@A@@@@B@@AB@BA@@B@@@@A@@B@BAAA@@A@C@AA@AA@@@@AA@@@@A@@CA@@@A@@@A@A@ABA@@@A@A@ABAA@@ABA@@@C@ABCB@DFFBABBAD@FBHBBBDBD@FA@@BBB@DABBDCJABAB@DAD@@AFEDA@A@CDC@ABAACBABKCCAABADCDEDE@C@CDEBABA@A@@CCAA@A@@G@AE@CAA@ECEBE@@BA@@B@B@@CB@BA@CB@CA@@BABAHCD@BAA@BABABCAA@A@BC@@A@@C@@@@@A@A@@@AB@AA@BA@@AA@@B@@BD@@A@@AE@@@@BCBBD@BBFA@A@A@ABEB@BBB@@AD@@CD@DAB@B@BA@ACA@A@AAAAA@@@AAA@A@ABBBBDABC@A@@A@@C@@B@@@@@@B@@@ABBB@@AB@@B@@AB@@@@@@B@@A@@BABC@A@C@CACAAAC@A@A@CAA@ADA@A@@DGHA@A@AAABA@A@@BABADBB@B@B@@@BB@ABB@@BBBBB@BB@@@B@@@@B@BBB@B@B@BBB@BB@DDBB@@@BD@AB@BBD@DA@ABEB@@CH@BAB@BB@@DBB@@@F@@C@A@@@AB@@A@BB@DAB@B@DBB@DA@@D@BBD@@A@ADAD@DBDAB@@@B@@@BADCD@@@BBBBBDDBBB@BB@@B@@@B@@@DB@@B@@BB@B@B@@BB@@BB@@@B@@@B@@@B@@BD@B@D@B@D@B@@@BBB@B@@B@A@AB@@AB@@CD@@@@AB@B@BAB@B@B@B@@N@JDCBAD@BBDABB@ABBB@BBB@@@B@@AB@@@D@@@@B@@@DBDB@@B@HADFH@@BAFBBDB@FBDDBCFCBB@BB@@DDFDBBB@@EDEBC@E@ADAD@B@F@@@@B@D@D@@@@B@B@B@BAB@B@B@B@@@@BBB@@@ABAB@@@@BB@@B@BA@@B@@@B@@@@@BB@BA@@BB@@@B@@@BBB@B@BAB@B@@@BB@@@@B@@@BA@@BA@@@@B@D@BBDC@@A@CAA@BA@@@@BABBB@@@@@@BFABBB@B@BBB@@@D@@B@B@@E@A@@B@@@@ABA@@@BB@B@BB@@@BA@@@@B@@@@BBB@BB@@@B@BAD@BBB@@@B@B@@@@BB@@BF@@B@@@BBDAD@@A@@A@AA@@@ADCB@B@BAD@@@B@@AA@@A@@@@@@B@@@@@@@@ABA@@@AB@@@@@@B@@BBB@@@@@@ABA@@BA@A@@BB@B@@B@B@@@BA@AD@@@@ABABA@@D@@A@@@@B@@D@B@@B@@C@BD@@@BA@@@A@A@@@@B@@@B@@@@@B@@@B@@@B@@@@AB@@@BA@@B@@@BA@@BA@AD@BADAA@@@@A@@B@@AB@B@BD@@B@@@B@@@BA@@@A@A@ABAB@@@@@@@BA@@@@B@@@@@@@@ABC@@BA@@@A@@@BA@@A@A@@@@B@@@@AB@@@@@BBA@B@@@@AB@@@@A@A@@@@B@@@@@BB@@BDB@B@BB@@@B@B@B@@@B@@@@@B@@@@AB@BA@@@@B@@@BBBB@@@BA@AA@B@@@@@B@BB@@@B@BBB@BB@@@B@@@B@@@@@@B@@@B@@@@A@@BB@B@@@@@@BA@@DABBB@DB@@B@@@D@B@BA@@DBB@B@@@AB@@@BB@B@B@@AB@@@B@@@@AB@B@@@@@BBA@@B@@AB@@AB@@@@GH@B@D@B@@@D@@JFDDFBAHEFEBC@@C@CCBEB@BCD@BFB@BF@HAHCJ@JADFH@DABANA@@@@BAJDCDHJH@@E@EH@HDJDFBFGBEH@BDBD@JJJ@H@BAD@@BHDAJF@HDB@DD@JEDDADDFBB@FBFD@HH@@TKFGH@DFB@@CB@HIACB@JCB@@B@@@BFLBF@@B@B@@@B@B@B@@@B@B@B@@@BBDADD@@F@BA@@B@@BBB@B@@BB@@B@@@BA@AB@B@B@B@B@B@BAB@@@B@@BB@B@BAB@FBBA@@BB@@D@@@BC@@@AA@A@@A@@@A@@@A@AAC@@@A@@B@B@@BB@@@B@BABBD@@@AA@@@AB@B@B@BABABC@@@A@A@AA@@@AA@@@AB@FGDC@@B@F@B@@@DBD@B@B@@B@@BH@@BB@BAB@@A@@@AB@@BBAB@@CD@BA@@BH@@AB@D@DBB@BF@B@BBBD@BBBBBBB@B@BBBBB@DFB@D@D@B@D@@ABBBABBB@BBDBD@BBB@BBD@DBBBD@B@BAD@B@B@BAB@B@D@@@B@B@B@BB@@DB@@@BAB@AB@B@B@B@B@@@B@B@BAD@@@@@D@@CDGDIBEBEAEFGDEFELEAA@EECAABCACBCDB@E@C@G@A@CB@@CD@HCB@@AB@@AA@EI@E@EAEDC@CBC@AHAHG@CBAAEBACCBG@ABC@GACBADE@A@C@@D@C@@C@ABA@@DAFAB@BA@AD@BA@@@@BA@@B@@ABABA@@@@@A@@AA@ABA@AB@@A@A@A@@@A@@B@@A@A@@@@@@@A@@@@BABA@A@@@A@@@ABA@C@@@AB@DAB@@@B@@@BAB@@@@A@@@A@@@@BA@A@@AABABABA@AA@@ABA@@@AAA@@@A@@@ABA@@AA@A@A@A@A@@A@@@@A@CB@AA@C@C@ABA@@@CBA@@@A@@BC@@BE@A@CAC@@@CA@@@@@A@@B@A@A@@A@@@@BA@@B@@@@A@A@@@@@@B@@A@A@A@A@@@CBA@A@C@C@@@@C@@@A@@@AA@@ACACABEB@ACCC@AAAAABA@A@C@@BA@C@@@AB@@A@@@@@@BA@A@@BAB@@A@@ACDC@A@@@@@@BA@@AA@@@A@@AA@@@A@AAA@C@@ABA@@@@B@@AAAE@@@AAA@A@@A@@@@@@@A@@@@@@A@@A@@@@A@@B@AC@A@@BA@@BCB@@@@@@@@A@@AAB@@@B@@@AA@@@@DC@@A@@@A@@@BA@AB@@ADABC@AAAAACA@@CAACEAEA@@C@A@CBADA@A@AAA@AC@@BCD@BA@C@@@AF@@A@ABA@@B@HAB@BADBB@DBD@DA@@@ADABAA@BA@@@@@A@@@A@@@@@A@A@@@A@@@A@@A@@@@A@CAA@AA@CCECAA@@A@@@AAAAGA@AAA@@E@ABAAAC@A@AAA@AA@C@A@A@@A@AB@AABA@@@A@@@@C@C@A@AACAA@@BA@ABCBC@E@@AACA@@@AACA@@ABC@CJ@AA@C@AAEAAA@@@C@A@GA@A@CAC@BABA@AA@A@CBA@AAA@@AABADCAC@@@BADEDG@C@S@AACBADABABAA@B@BADAHC@ADCAE@CFGBG@A@C@ACC@A@C@E@A@C@@@@DC@AA@BC@ABA@AB@@A@@@CF@B@@DF@HA@@@C@AEAEECE@@C@CCBAACACECCCCCE@AAEAA@AA@BA@CCEBCC@@A@@@A@AAAAA@ECEA@@CFA@EDAFEAABA@GCAB@@IAEFADABABA@AAAB@B@@A@A@A@A@A@@BA@AACBA@A@C@@@@B@@AB@B@BB@B@@B@@@@@B@BAB@@@BAB@@A@@D@@A@AB@B@@@B@@@@@@A@A@@@@@ABA@@@"],encodeOffsets:[[113174,25257]]}},{type:"Feature",id:"450323",properties:{name:"灵川县",cp:[110.319897,25.394781],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@BE@CB@DA@ADA@@BC@CBAACB@@EBBFBB@B@B@BA@C@@FE@EBCB@DA@EBAD@@ABAB@BCBCBAFC@ABC@@BBBCBAF@@C@C@BACAAAA@EB@DADC@@BABGB@@BDDFDNDH@H@D@F@FABE@E@IB@@CBCACCA@A@ABAAABCBCDAFAD@DCBCBA@A@CAAA@AB@@C@@@CAACABCB@DDDBDAFA@EEAI@DCDEDE@CBAB@AA@@GBE@@A@C@A@ACAC@@@CAC@AFIDEFABAHAD@J@D@BC@ABA@KB@DABDFBBE@EDD@BD@ADDABHDJNB@BAFD@H@DDFBBIJAD@@AD@FDB@B@B@BB@D@@@DBCB@BCB@@BBFC@@@BCFEFED@DCDEACBDFDADDB@@BDDCDBFEBAF@@@@BB@@@@@@@@@B@@D@@@B@@ADCDB@B@BBBB@BABA@ABB@A@ACAAB@ABC@A@@BA@@BBADBBF@@BABEHCFAF@@RFAD@DIHADB@ADHDBDABBD@BJB@B@BAA@HAH@@ABB@B@H@DBB@BD@B@BC@EDABCFBF@@BB@D@JGFEDEHABCAE@@BA@@@AB@@@B@@A@@@A@@@@B@@@B@B@B@DBD@@BB@B@B@B@BBBB@@BB@@BBB@@@@BBBBBBB@@BAB@@@B@B@@@@ABA@@B@@A@@@CB@@A@ABA@A@@BABABA@@@A@@AAB@@A@@@A@@@@BA@A@@@ABA@AAAB@AABA@A@B@@BB@@B@@A@@@@@@@A@@@A@A@@@@BBB@@@@BB@@@@BBB@@@@@B@@C@@BB@@@@B@@@@@AB@@@@@BB@@@@@A@@@@@@B@@A@@@@@@@@@@@@@@@@BAA@@@@@BA@@@@@@B@@@@@@B@@@@BA@@AAA@@A@@B@@@@B@@@@@@BA@@@@B@@@@AB@@AA@@@B@B@AB@@@@BA@AB@@@@B@D@@@@@@@@@@BFBDB@@B@@B@@@BAAA@A@@AA@@@A@A@@BA@@@@BA@@@@@AB@@@@@@@@@BB@@B@@@@A@@BB@@@@B@@C@@@A@BC@@BB@A@@AA@AA@AA@@@@@AAAA@AB@@B@@DB@@BA@@BAA@@A@@@A@A@@AB@@AABA@@@@@AB@BA@@B@BA@@@A@CAIAAACAGCAAA@CC@A@C@C@@@@@A@A@CA@@AABB@AB@@@A@@@AA@AA@B@@@AA@@@@@@B@@AA@@A@AE@@AAA@ABA@A@@@@BA@@@A@@AAB@@@@@@A@@A@B@@AA@AA@@AA@@A@@C@@A@@@@@@A@@@ACC@@BA@@@@@A@A@A@@@@@A@@@@@@A@@@@AA@@@A@A@@@A@@C@@@A@@@@D@@DB@@@@@@@BAAE@@B@B@BA@AA@@BB@B@@A@@B@@@AA@@@@@@@@@@@A@A@@@B@@A@@A@ABA@A@ABAAA@A@BBA@@@A@@@@@A@@@AB@@@@@@@@@@@BA@@@B@@@@B@@AA@B@@A@@@@@@@A@@@@BB@A@@@@@@@A@@@@@@@@@@B@AAA@BA@@BA@@@A@A@@@BB@@@BAA@@@@@AAA@@@@@@AB@@@A@@@@@A@@A@B@@@B@@AB@@@@A@@@A@@@@AABA@@B@@@@ABABCAAAAA@@@@@A@A@AAA@AA@A@@EB@@ACAA@@@@BC@@@AAA@@@A@A@A@@@AB@@@AAAA@@AAB@BA@@@@A@@A@@@@B@@@@A@@B@B@BA@@@@@@@@A@@@@@A@@@@@@A@@@@AB@@@@@@@A@@A@@B@@@@@@@BA@A@@A@@@@A@BBA@A@@@A@@AA@@@@AB@@AB@B@@AB@B@@@@AAA@ACCAAAAA@AAA@AA@@AB@@@@BA@AA@@AC@@AAA@@A@C@GB@B@BADA@@@A@AB@B@BAB@@B@@B@BB@@@@BA@ADABA@A@A@A@A@A@CBC@@@AB@@@BA@BB@BAB@B@@@@BDB@B@@B@@@B@DBB@B@@@B@@@@@BBB@@@B@@@@B@@B@@@@@@@@@B@@@BA@@@@@@BB@B@A@@@@BA@EC@@@@AA@@ABA@AB@@@@@BA@@@@@@@ABA@@@A@@A@@A@A@@@@B@D@@BA@BB@B@@B@@@@B@AB@@A@AB@@@B@B@@@@@BA@@B@@@B@B@@@BA@@@A@@@@@@BA@@BA@@@A@A@@@AAA@A@A@@@@@A@@AA@@@A@@@AB@AA@AA@@@B@@@BAB@BAD@@@@A@A@@@@@@B@B@B@D@B@B@BAB@@BB@B@@@@AB@BC@@B@@@@CDA@@BA@@@CAA@A@A@A@A@@@@@@BBBB@BD@@BB@@@BBB@BBB@BB@B@A@A@@B@@@B@D@@@BABA@@BB@@@AB@@CB@@@B@@@B@@@BD@@@B@@B@@B@@@@@@AB@@@@@@@B@@@@AB@B@@@@@@@@AB@AA@@@@BA@@@AB@D@@@BB@@B@B@@@DAB@BB@BBD@BB@DDBB@@@B@@C@A@A@A@@@A@@BB@@@B@@@CB@@A@AB@@@@@@@@B@A@@B@@@@A@@@@@@@BB@@AB@@B@A@@BA@@A@@@@@A@@@A@@C@@@@BCB@@A@@BB@@B@@@@A@@@@@@@@@@AABA@A@AA@@@@@B@@@@A@@AA@@@A@@@@@A@AA@@@B@@EB@@ABA@ABA@A@EG@@CAA@@BAAA@@@@A@A@@A@@@A@A@@@AA@BA@A@@@A@@@AA@AAA@AABA@@@A@AAA@@@ABA@AC@@A@AD@@BB@BBB@@B@B@D@@@@BAB@B@@@D@D@@AB@B@@@B@D@@@BB@B@@B@B@@B@@@@BB@@@AB@@@@A@@@@@@B@@@BAB@B@BA@A@C@A@@@@@A@@@A@AAA@A@AA@@A@@@A@A@CBABBB@B@@B@@BAB@@A@ABCB@@A@AB@B@BABA@@@A@@BA@@@C@A@CA@@AB@@CA@A@@CAA@A@AA@@A@@@@@A@@B@B@@@B@@@B@@@BA@@@@B@BAB@@@@@BBB@@@BAB@@@@@@@BABB@@BBF@@AB@@BD@@@B@@B@@B@B@@@B@B@@@@@@BB@@B@@@BB@B@BB@@B@@@B@D@@BDBB@B@@AB@BB@@@@BB@@B@D@BB@@D@B@@AB@BBB@B@D@@@B@@@B@@AB@B@@A@@BBB@@@B@B@BBBBB@@BB@B@BB@@B@@@BA@AB@BABB@@@@@@BA@@@@@@B@@@@AB@@@B@@@D@B@BA@@@@B@@@B@BB@@@B@@B@@@@@BA@@B@B@B@@@BB@A@@BB@@B@@@B@@@@@B@@@BA@AB@@@@AB@@A@AB@@@@@BA@@B@@@@@B@@@BB@@BBB@@@B@BB@@B@@@@@BA@@B@@@BB@BB@@@@@B@@@@@@B@BB@@B@@B@@@@@BAB@@@B@B@@@B@@@B@B@@A@@B@BBB@@@B@BAB@BA@@B@@ABABA@@BA@@@AB@@AB@B@@AB@@@BAB@@@@B@DBB@@@@@B@B@FBB@B@@B@@B@@BB@@@@@BBBDB@@@B@B@@@@B@@D@@@@B@B@@BBBBBB@BB@B@B@@BB@@BBBB@@BB@BB@@@BD@DB@BB@@B@@@B@BA@B@@BAB@BAB@@C@ABABA@@@A@@BA@@BABABBB@B@B@B@B@@AB@BA@@@A@A@AB@@AAA@@@AA@@A@@AAAAAAAA@CBA@ABABA@ABA@@@@B@DBB@@@B@B@@BB@B@@BB@@@@@D@@BB@@B@@B@@@BA@@AA@ABA@A@A@@BA@AB@@@@ABA@AAC@@@A@A@@@A@@B@@@BB@@B@@BD@@@@@@@B@D@BA@ADABCD@@A@C@A@AB@@A@@@@@@B@B@DB@@B@@@BB@AB@@@B@@@B@@AF@B@B@B@@A@@@@@@BB@@B@@@B@@BB@@BB@B@@B@B@D@BBBA@@B@B@@@@@B@@B@@B@@@BDBBBB@BB@@@@BBB@B@B@@B@@@BBBB@@BBA@B@B@@@BB@@@@@@B@@@B@BABBB@@@B@B@B@@@@@B@B@BB@@@@B@BB@@@BB@@@B@B@BB@@@@@@@BB@AB@BD@@DA@@B@@BB@@@BB@@B@@BB@BDF@B@@ABBB@@@BB@@B@B@BFD@@@@@B@@@@BD@@@@@@B@@@@@B@B@@@BAB@@@@AB@BA@@@@B@B@BA@BD@BBB@@@BB@@B@BBBBB@BD@@D@B@@AB@B@B@@@@@B@FBBB@@D@B@@B@@BBB@BD@BBD@B@B@B@BBBDD@BBB@DDB@BDDB@DB@@BD@@BB@DBBA@@DBBA@@@@B@@@BBBBBBBBB@@@B@B@@@B@DDD@BABA@BDAB@@@B@BBB@BBD@BBBBBBB@BABBD@@@B@B@BB@@B@B@DBBBB@DBB@BBBAB@DBFBBBDB@@BBB@BBD@BB@BBBBDAB@D@FBB@@@B@F@B@BABB@@B@BBB@BBBADBDB@@BABAB@@ABADABB@AD@BA@AD@@ABABAD@B@BB@@DDD@BABB@CAC@ACCBCBABAACAEB@BA@@BADA@AA@A@CA@ECA@ED@B@DABABAB@@EBAD@BCB@@A@@@@@ABA@A@CB@@CBABBBA@@B@@@B@@@@ABE@A@A@A@@@A@@@A@@@@@A@@@ABA@C@A@A@@@@@@@A@@@ABAA@@A@@@A@A@C@A@E@ABABC@A@AA@@@A@@@@A@A@@@AAABA@@B@@@BADA@@@@AE@A@@BA@A@A@A@A@@@A@@@@@@@ABAAA@@@@AAB@@A@@@A@@@A@A@@@A@A@@B@@@@@@ABAB@@@@@BAB@BCBAB@@@BA@@@AB@@A@@@A@@@A@@@A@@@A@@BABA@@BA@@@AB@BC@@A@@@@A@@@AAA@@BAAE@ABA@A@AB@@A@A@@AA@@@@AAA@AA@AAAA@@A@AA@@@@@A@A@@@AA@A@AA@A@@@@A@@@A@A@@@ABA@@B@B@B@@@BA@@B@B@@@@AB@@@@@BA@A@AAA@AB@A@BA@@BBB@B@B@@@B@B@@BB@BA@BBA@ABABABA@AB@@@BA@@BCB@@CB@@@@AF@B@B@BA@@B@BB@@@@B@B@D@@@@@D@@@BA@@@@@A@@AA@A@@@@@A@@@A@@BA@A@AB@B@@AB@@@@AB@@A@A@@BA@@B@B@B@B@@BB@@@BB@BD@@@@AB@BCB@@@BA@@@A@@BA@@@CBA@@@AB@@@BB@@@@BAB@@@@A@@AA@@BA@A@@@AAA@@BA@@A@A@@@AAA@@@C@@AAA@@BA@A@@@A@A@@AA@@@AB@@@AC@@A@@A@@A@@@@A@A@@@ADAB@D@BBBC@A@AB@D@@AB@@@BB@@BABBB@@@@@@AB@BA@@DABA@@@@@AD@D@F@B@D@B@@BDFABA@CDCBCB@BAA@BBBABD@DBBB@BABAD@BBBB@BDFBBBDBBBDB@ABAB@D@@@B@D@BBBBDBD@D@B@BADC@ABABABA@A@@DC@@BA@A@@BABA@AB@BCBA@@B@B@FABA@@BA@A@@B@@@BA@@BA@@BB@@DC@@@@BCBA@@@@DA@BB@D@@@@A@A@ABAAA@ABC@A@@BAB@B@@@DCBA@@@A@@AA@A@@@A@@BADABABCB@@AD@B@@DB@@@B@B@BAAA@AA@C@@A@C@A@C@C@AB@BAACDA@A@A@CD@B@BABCA@@A@A@ABA@CB@@A@A@AB@B@BA@ABADA@BDABA@A@@AA@@@A@AAAAA@G@C@A@AAAC@ACAAA@CABA@CD@B@@A@ABA@A@@@AB@BABBB@@B@AB@BAB@BABBBABBB@@AB@B@BH@@@BB@BD@B@BBBD@@B@B@@BFBB@BBBB@@B@B@@AB@@@@B@@BB@@@@B@A@@B@@A@@B@AB@@@B@@BBF@DB@@D@@BBB@@B@B@B@B@BABBB@BDB@@@BABBBA@@BB@@D@@@BA@@@AB@B@@AB@B@@@@@BA@@@@B@@@B@@ABA@@@A@AA@@@B@AA@A@@@@B@@@DB@@@@B@B@@A@@@@B@@B@@@@BB@@BBBBBDB@@B@@@@BBB@@BBB@@B@@BB@@B@@B@B@@@B@AA@@@@A@@A@AB@@AA@AEA@@AACBA@AAAAA@A@A@C@A@@AA@@@A@@@@@AA@@A@@AB@@@AA@@@@AA@@@AA@@AA@AA@@@@ACA@@ADA@A@@A@@@A@A@@A@@@BA@@AAB@@AAAA@@@AAA@A@A@AAA@AA@@@C@AB@AABC@AB@B@@A@@B@@AD@BAB@B@BA@@B@BA@@B@B@BC@@A@@A@AAEAA@@CAAC@@A@A@@CCAAE@CAACACAA@@ABA@A@@BAB@D@B@B@@@DA@@@@B@@A@@@@@AB@B@B@@@@@B@BA@@@A@@@AA@@@A@@@@@@A@@@A@@A@@@@A@@@@BC@A@A@@A@A@@@@A@ABABA@@@@BA@@@A@AB@B@@AA@@@AA@ABA@@@AB@@@@AB@B@@ABA@@B@D@@@DB@@B@@@BAD@B@@@@@BB@B@@@@@ABAB@@@B@B@@@BA@@B@@@B@@@@A@@@A@A@@@@@@@AA@@@A@@@A@@C@@@ABC@AD@@BB@@@BA@AB@BAAA@@BCB@@ABABAD@B@B@@A@@@E@@AAB@BAD@B@DBBA@@B@@A@A@@B@BABA@ABA@A@A@G@ABCAA@CAAAE@@@@AC@@@A@A@AA@@ABA@@@C@AAC@A@AB@@@@AB@B@@AB@DA@@BC@@B@@AB@B@B@B@B@BA@@BB@AD@@BBB@@B@@@D@B@@@@@BDBBBB@BB@BAB@FAB@@@B@B@B@B@@@BBB@BAB@@DB@@B@@BB@BB@B@@@@BD@@@@B@BB@@@@BBCB@@@B@B@B@@@@@@@B@@@BAB@@@@@@A@@@@B@@BBA@@@@@B@@@@B@@@B@@BA@AB@B@@@B@@B@B@@@B@@@BABB@@B@B@F@FBB@DABBB@@@FA@BDA@@BA@@@A@A@A@AAADAB@A@@BD@B@B@B@BA@@B@B@@@D@@@B@B@@@BA@BB@D@@@@AAA@A@C@ADA@A@ADDBBBB@BDB@@B@@B@@@A@A@AB@B@BAB@BA@A@A@@ACB@BBBA@@BAB@D@@B@@BB@D@BD@B@B@BA@B@DDDD@B@@A@A@@D@B@DBB@@AB@@BB@ABBBBABAB@B@@AD@@AB@B@@ABBB@@@B@@CB@@@@@BA@@B@@A@@BA@@B@AA@@@@AA@@@AB@AAB@AA@@B@@A@@@@B@@@@@AA@A@@A@@@@@@@@@@A@@@A@@@@BAD@@@@A@@B@@AB@@@B@@@B@BAA@@ABA@@@A@A@@B@@@B@@@@BDB@@@@BA@@B@BB@@BAB@B@B@B@B@@@D@@BB@@@B@@@B@BB@@@@BAB@@CACC@@C@@AE@@CADA@CB@DADBDA@BB@FBF@BAD@BAF@@ADBDAH@BBF@F@@@A@A@A@@@@@@A@@@@AA@@@A@A@A@AB@@A@@@ABCA@A@A@@AA@@@A@@@@@A@AAA@@BA@A@A@@@A@@AA@A@@B@@A@A@@ABA@AB@@@@A@AB@@@@@BAA@@@@A@@@A@A@A@@BC@CB@@A@ABA@AAGA@EAAAA@A@CCAA@AAA@AAABABCACB@BAACB@BBBA@CDC@A@A@@BA@A@ABA@C@A@ABAB@@@@AB@@A@AA@A@A@A@@@CAAAA@@@AA@BAA@BAAA@A@@@A@@BA@@AA@@@AB@AA@AAA@A@@@A@AA@@@ABA@AB@@A@@@A@@BAB@@A@AA@AA@@A@@@@@@AA@@AA@@@@AB@@@B@@@B@B@@A@AA@@A@AAA@@@AA@@A@@B@@@BA@@@@@A@@B@@@@@@@@AB@@A@@BA@@@A@@B@@@@@D@@@@AB@@A@@@A@A@@@@@@@A@@A@@@@@A@A@@A@AA@B@@A@@@@B@@@@@BA@AB@A@@AA@AAA@@@@@AA@@@A@@@A@A@A@@@@@@B@@@@A@@@A@@B@@A@@@A@@A@@@@@@ABC@A@A@@@@@@@A@@@@@@AAA@@A@@@@@A@@@A@@@A@A@@@@AA@@@A@@@@B@@A@@@A@AA@@AAA@@@@B@B@BA@@@AB@@A@@B@@AB@BA@@BA@@BBB@BABABAB@B@B@@@BAB@@@@@BAB@B@D@@@@@@A@@@A@AB@@AB@BAB@@A@@B@@@BA@@BABAB@@@DC@A@@B@@@@BB@@@B@@@@A@@B@B@@@BA@@@A@@@@@A@A@C@@BA@@@AB@BC@@@@@@BABABA@@@@ACB@BCBAB@BA@@BC@@DA@AB@@@DAB@@@@@@ABA@@@ABA@@BA@@BCB@@@@AB@A@@A@A@@@A@AAA@@@@AACB@@A@A@@@A@A@A@@AA@C@AEA@CAAAA@@@@AA@@A@@@@C@AAAB@@@@AB@@@B@@@@@@@BA@@@@@ABAB@@A@ABA@@BA@ABABAB@@A@@@A@@@@BCBA@A@@@AAA@@@AA@@A@AB@@A@A@@@@A@A@@ACBG@A@@@@@BA@@BA@AB@@AB@@@B@@@@AB@@@@@@@D@@@@@B@@@@@B@@@BABA@@@@B@@@@AB@@@@@BBB@@@@@@@@@B@@@BA@@@@B@BA@@BA@A@AA@@@@@AAA@@@A@@@@A@@@A@@@@B@@A@AB@@A@A@@@A@@@@BA@@@@BA@A@@@@A@@A@AAA@AAAAA@@AA@A@BAA@@A@@A@A@AA@@A@A@@@A@@AA@@@@A@@A@B@AA@@A@@@@B@@@@A@@AABA@A@@@@@@@A@@@A@C@A@@@A@A@A@A@AAC@@AA@@A@@A@A@@@@BA@@@@@A@@@@B@@@@@@ABAB@@CBEAAB@B@B@@@BA@A@A@A@@@@BA@AB@@@@ABA@@@A@@@AB@@C@@@A@CA@@@@A@A@A@@@A@A@@@A@A@ACA@CA@@@@AAAB@AA@@@@AAA@AA@@@@ABA@@@C@@@@@AA@@@AA@ABAAAB@@EBABCDADGCIBI@C@@@ACCAC@ACCAC@E@CCAE@GBCBCDABCAE@CAC@AECGCGAEAA@A@@@@C@ABA@A@@@A@A@A@A@A@@BBA@A@@CA@@AAA@A@A@@@C@A@A@ABA@A@C@ABA@C@AACAC@AAA@AAC@CAAAA@AAABAA@BC@A@C@C@A@CEA@AAAAA@A@AAAAAAC@AA@A@AAEA@CAC@A@@BG@@AB@@ADC@@BAAA@@BA@@B@@@BA@AAA@@AG@@@AA@A@C@CA@@A@E@A@@@CDEHA@@B@@BB@@B@@B@B@B@@ADABABA@A@A@@B@@BB@@C@AAABA@@@A@@AA@A@@@@B@@BD@B@B@@@B@@@BB@B@@B@@AD@@C@@@AA@@ABEAA@ABA@A@@AA@@@A@ABA@A@A@A@A@A@@BAB@@A@@@AA@@@AAA@AA@@@ABE@@@CCCBAA@@A@A@A@@@A@A@A@@@A@A@@BAB@BBB@@B@B@BD@B@DFB@@@DBBBB@@@B@BDBBBF@BB"],encodeOffsets:[[113145,25670]]}},{type:"Feature",id:"450312",properties:{name:"临桂区",cp:[110.212463,25.238628],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BABAB@B@@@B@@A@@@A@@@AC@@A@ABA@@@AB@@@@@BBBC@ABCB@@AB@@A@@@AB@@A@@BA@@@@@A@@@A@@@A@@@@@A@@@A@@B@B@@@B@@A@@ACD@@@@AA@C@@@@A@@B@@@@CB@BABA@@@@BCB@@A@@@A@AA@A@@AB@B@@AB@BA@@@@@@AA@AA@@@@@@@BA@@B@BA@@@@@@@@@A@@@@B@@@BB@@@A@@BC@A@ADABC@@B@@B@BB@@@BCAC@A@@@AE@@AA@@A@@A@A@@@A@AAC@ABA@@@A@@AAA@A@@A@@@@@AB@@A@@A@AAA@@B@BA@@@@@AB@F@@@@A@AC@@@A@AAA@A@AAEB@A@@@@A@AAAB@@@@ABB@DBB@@@CDAAC@A@@@@@AB@@AB@@A@@@@@AA@@A@A@ABA@A@AA@@A@@@A@@AB@@AAA@@@@A@@@A@@@ABA@@@AA@@@@BABA@@A@AA@@@@@A@A@ABA@A@A@A@A@@C@C@A@@@@@@E@ABCBCF@D@FAFC@@AACACE@CA@@AAAEDADCCEAA@ACEAAB@@EGBC@G@AA@AC@C@@@A@@C@@@A@@BA@@@A@AAA@AA@BAACBAAC@ABCD@I@MA@A@A@A@ABA@A@@B@@C@@DA@@BA@@B@B@AA@A@AA@@A@C@A@C@A@C@@AA@@@A@@@A@@@A@@AA@@AA@A@A@@AA@@@CA@@A@@@A@@@AAA@AACCAAAA@A@@A@@@@BA@@BA@A@@B@@@BAA@AA@@@A@@@A@@BAB@@@@@@@BA@@@A@@@@@A@C@@B@@@BA@@BA@AB@@AB@@@@@@@D@@@@A@@@AB@@@@ABA@AB@B@@A@ABC@@BA@AB@B@B@@@B@B@BA@A@@B@@CF@@A@@B@@A@@BA@@@@B@@@BAB@B@@AB@B@@@@@B@B@@@B@@@B@@@B@@@B@B@@@BB@B@@@@B@@@B@@@B@@B@@B@@@@@BBD@B@B@B@B@@A@@@@@@B@@BB@B@@@@@B@@BB@B@@@BBB@B@@@BB@@@@@AB@@@BABAB@@@B@BA@AD@B@B@@@B@@@B@BAB@B@@ADA@@BA@@B@B@@BB@@A@@BA@AB@@@B@B@@@FBB@@@BBBAB@B@B@@B@B@@B@@@@@@AB@B@@A@@B@@@@@@A@AB@@ADAB@@@B@B@@@B@B@@A@@@@@@B@B@@ABB@@@@@@BB@@@@@AB@@@B@B@BCBABABABABA@@B@@B@B@B@@@FAB@@@DB@BB@@BB@@@@@@B@BABA@@BA@@@@D@@C@ABAA@B@@@BB@@@B@@BB@BB@@@@@@BB@@@@@B@@@BB@@@ABBB@B@@B@@@@BB@@@@B@@A@BDAB@B@@BB@B@@BBBD@@@B@B@BB@@@AB@BED@@A@@@ABA@@BA@C@AB@@ADABA@@@@@ABABA@AB@BA@@BAB@BA@@@DBB@@@@BAB@BCB@@@BA@@B@@A@@@A@@BB@@B@@AB@@@D@@AB@@@BA@@@@B@@@BCBA@@BA@AB@B@@@@BBB@@@@@@DA@@B@B@@CB@@ABABABA@AB@B@@@B@@@B@@@@@BAAA@A@@@CB@A@@@@@@@@C@@@@BC@@@@B@@A@A@A@@@A@C@@@@@@@@@A@@@@BAB@@@B@BA@@BA@@@BB@@A@@BAB@@@@@@@@B@@@B@@@@B@B@@@@@@@@B@@B@@@@@B@@@BB@@BBA@@B@B@@@BF@@BA@@BB@BB@@@@AB@BA@@@A@@BA@@BA@@BA@@@B@@B@@BB@D@@@@@BB@@AB@@@@A@@BC@@@@@@@BB@B@BABAB@BA@@B@@CB@@@B@B@@@BA@A@CB@B@BAB@@AB@B@B@@@B@BABA@A@@@A@AA@@@C@@@A@@A@@BA@AB@@A@@B@B@B@B@B@@A@@@A@A@E@@@ECA@E@ABA@@B@B@@@@B@@BB@@B@D@BAB@@ABA@ABA@AAA@@@CA@BA@AD@@BB@@@B@B@B@@ABAD@@ABA@@@A@A@A@@@CDCBAB@@A@A@@@@@@@@BBB@@@B@@BBAB@B@B@@@BAB@@@B@@AB@BABBBB@@@@@@@@@@B@@@@@BB@@B@@@@@@@AA@@@A@@@B@@B@BDBA@BB@@AB@@B@@@BAB@@@@@BD@@@@B@BB@@@B@BB@@BBB@@@@AB@@@@@B@BA@@@AB@B@@@@@@AB@@A@@B@@@BAB@@@BB@@@A@@@@@AB@BA@@BA@@B@@@B@@@@@@AB@@CB@@A@A@@@A@@@A@A@AB@BABA@A@@BA@ABAB@@@BAA@@AB@B@B@@AB@D@@AA@@@BA@@@@B@@@@AAA@@@@BAB@A@@A@@@A@@@CBA@@A@@AB@B@@A@@B@AB@@AA@@AAA@@@B@@@@A@@@B@@@A@@A@@A@@@@@A@@AA@@B@@@@@@@@@A@@@A@@@@A@@@@@@A@@@@A@@@A@@@@@BA@@@@@A@@@@@AA@AB@@@A@@@@A@@@AA@@@@@@@@@A@@@@@@A@A@@AA@@@@@@@@@@BA@@B@B@@BBA@ABCBA@@@@@@B@@A@A@AB@@@@@A@@@@@A@@AB@@@@@@@B@@@AAB@@@@@BABA@A@CACB@A@@A@@@@B@B@@@@@@@@@B@@A@A@@@@@AA@A@@@@AA@@@@@@@@D@@@@AA@@@@@BAA@@@BAA@@A@A@@@AA@A@AB@@A@@@@@A@@@@A@@@@AAA@EB@@@A@A@A@@@A@@@@C@A@A@@@@A@@AA@@@@@AAB@@AA@BA@@@@@A@@B@@@@AB@@@A@@@@@@@A@@AB@@@@A@@@@@@AA@A@@@@@@@@@A@@@@@@@BAA@@@@A@@A@@@A@@@B@@A@@A@BAA@A@@@@@BA@@A@@@AA@@@@@A@@A@@B@@@@A@@BBB@BABA@@AA@CA@B@@A@@@@@@B@@A@@A@@@@A@@@ADAB@@A@@@@B@@@B@@A@@@@@A@A@@@A@A@@@@A@@A@@@@@@@@AA@@@A@@@@@AA@@A@@B@@@@@@AA@@@@@@@@A@@@A@@@@@AA@@@@@@A@@@@@A@A@@@@@A@@@@A@BAA@@@@B@@@BA@@BA@@@A@@AAC@A@AAA@CAA@AAAAA@A@@@A@A@@@ABC@@@C@CDA@A@@@C@@@A@@BAB@@A@@B@B@BABAB@B@@@B@@BB@@BDB@@B@B@BB@@B@@@@@DAB@@ABAB@@A@@@@@ABA@@B@@AB@@A@@@A@@B@@@BA@A@@B@@CB@BA@CB@@A@@@A@@B@@ABA@@@@B@B@BA@@B@@@@AB@@AB@@@@ABA@@@@B@@@B@@CD@@@@ABABAB@@@BEFABA@A@A@@@C@AB@@A@@B@@@@A@@@AB@@A@C@ABC@@@@AEAA@@@@AA@@@CCA@@@A@A@AA@@ABC@EBA@ABEBC@A@A@C@CBABCAAB@@C@@B@D@B@B@@@B@BBB@B@@@BBB@BB@@BBB@@AB@@@BAB@@@@@BA@@@@B@@B@@B@B@BAD@BA@@BAB@DFBB@D@BB@@BD@@@BA@@B@B@@@B@B@B@@@BBBBDBB@B@D@DDD@@B@B@@@B@@@BBB@@@D@@@B@B@@B@@@BB@B@B@B@BBB@BD@@A@@D@BC@ADA@ABAB@B@@ABD@@B@BBB@B@BBDAB@BBBA@A@ABA@@@@@@B@@@BA@@BA@A@ABBB@B@@@@@B@F@B@B@DA@AB@@@BA@A@@@AA@@CA@@AAABCCA@A@@@A@A@@@AACBCB@AAAC@A@@@AB@@ABAB@@A@@B@@@@@@A@@@@BA@@BAB@B@BAB@@@B@@@@@B@@@BABC@@@CB@@@@@BBB@B@@BB@@BB@BB@@BA@@@@BBBA@@BD@@@BBB@@BB@@@BB@B@B@B@@@B@@@B@@@BD@@BB@B@@@@@@B@@@@@B@@@B@B@B@@BB@@BD@@BB@B@@@BB@A@@B@BB@AB@@@DB@@@@@AB@B@B@@AB@D@BAB@BABAD@BA@A@@@@@@AA@AB@B@@@@@D@@@@B@BB@@FDDB@BB@@@B@@@@B@@A@@BA@A@C@A@A@A@A@ABA@@@BB@@@@@@AB@@@@BB@B@@ABA@@@@BBB@@@@@B@BABAB@@ABB@BA@@D@@@BBB@@B@B@@@@@@ABC@A@ABC@@BA@@DA@@B@B@@@@@@H@@@B@D@B@BAB@BADADABCDABAD@D@B@D@@@BB@B@B@D@@BD@BAD@BBBBB@B@BAB@BCDA@AB@B@B@B@@@DA@@B@B@@@BA@@BAB@@BBBB@BBBBB@B@@AB@BB@@BAB@@BB@@BB@BB@@@B@@BB@BBB@BABB@@@FAB@B@BA@@B@B@@@BBBABBB@BBBBB@B@@BAB@DA@ABA@@AABA@A@@@CB@@BBB@BB@@BABBBA@@BCBABB@B@@B@@@B@BA@@D@@@BAB@@A@@BABCD@@AB@@A@@B@@A@@@ABA@A@AB@@AB@@A@@AA@@@@@AB@@A@@@AAA@A@A@ABA@@BAA@@@BA@@AABA@ABA@@@AB@@ABA@AB@@A@C@A@ABAAA@@@C@A@A@A@C@AC@E@@AA@CA@@AA@@C@@BAAA@AA@AA@BAAAA@@C@AAA@@@A@ABA@A@ABCBA@AAC@@@A@A@@AA@@@AAABA@@@CA@@@BA@@@AA@@AA@@A@@@A@@@BA@AB@BA@@AC@@@@A@@AA@AACAC@ABABA@@B@@B@D@DB@B@@@@@D@BA@BBB@@B@@@BCB@BF@BB@@A@@@C@@@A@A@A@AAC@@A@@@B@@@B@B@@@@B@BB@A@@B@@ADB@@B@@@@@@BA@ABA@A@B@AB@@A@@@@B@@B@@B@@A@@@A@A@@@BB@@@@@BAB@BD@@BBB@B@@C@@@A@@@A@C@@@A@AAAAI@@@ABA@A@ABA@@BABA@A@C@C@CBA@@AAAAA@@A@@A@@AB@@@D@@@B@BA@A@@@A@@B@B@@@@BB@@@@AD@@BB@@@@ABB@@@@B@B@@BBB@@BA@@B@@@B@@AB@@AB@@BBB@@@@@B@@@@@@@@@@@BDB@BB@B@@@B@@@@@B@B@B@@A@A@AB@@BBA@B@@@BBA@@@@B@@@@@B@@@@@@@B@B@@B@@BB@@@B@@B@@BB@BD@@@B@ABB@@B@@@BB@A@BBBB@B@BB@@@BD@@@@@B@@@BB@A@BB@B@@B@@B@@@B@@B@@BBABAB@@BB@BC@@@ADAB@@C@@@A@@@@B@@@B@DA@A@@@A@@B@@A@@BA@@B@@@DB@@BBD@B@DD@DBB@BAB@HBD@BDAB@DADAB@BA@CD@D@DBDBBAB@BABBBABABBDADBB@B@BABAB@B@@BFDB@@@BBBB@BBB@@BB@B@@B@@BB@@@DD@@B@@@@@BBB@@@BB@@@A@BBB@@BB@@@BABAB@B@@A@ABA@@@A@@@AB@@A@@@A@@B@@A@@@ABAB@BAB@@BB@BA@@@@BA@@@@@ABA@A@A@@@@B@@@B@B@@@B@B@@ABAD@@@BCBCD@@@B@@BB@B@B@D@@@B@B@B@@@B@D@B@B@BB@@@B@@@BB@BBBB@BD@BBBBDB@D@BBBBDFDDB@BABAD@@BBDBBBBBDBBD@DHBDB@AD@@AFBDHFFADA@@DBD@@BB@DADBHADAD@B@@EDCB@BAAABCAABABCB@ACBADA@@B@@BFBFCHAFABAHBFAD@D@D@DAB@B@@@BB@@BBB@AB@@@@A@@B@@@@AB@B@@AB@BAAA@@@@BA@CB@B@@BB@B@@@BBBDDB@BBBB@@@@@B@B@B@@@@A@@B@BAB@@@B@@A@@BB@@@@@A@@@@B@B@B@@BB@@BB@@AB@B@@BB@B@DBD@@@@A@@@@@ABBB@BBD@BB@@BB@@@@B@B@@@B@BB@@@@@BBB@@@BBB@@@BB@B@@@B@@BBB@@BB@@BBB@@BD@@BBBB@B@B@B@@AD@D@@@@@B@@A@ABA@@@AB@B@@@@@@@@@@C@@B@@AB@@A@@@A@@B@@@B@@A@@@@@BB@B@@B@@B@@ABB@@B@@@@@B@@A@@@AB@@A@A@A@@@AB@@@AAB@@AB@@@B@@C@@@@B@@A@@@ABAB@@A@@D@@@D@@@@@B@@BB@@@B@@@B@@@@@@@BABA@@B@@ABA@@BBBAB@BB@@B@B@@@B@BB@@@@@@BB@@@B@B@B@B@@@@BB@DBB@BBB@@B@@B@@@DB@@B@@@B@@B@@B@BB@@BB@AB@@@D@@@@@B@B@B@DCBA@@@AB@@@B@@AB@BBB@BBB@B@DB@@BBBBB@BBBB@@@@BDB@B@B@B@BA@@@AB@@@@@B@B@@AA@@A@@AC@ABCDADA@BB@BB@B@@BB@@@BB@@@@B@BB@@@DDBD@B@@B@D@@@@@BA@@@@BB@@@BB@@BBBA@BB@@B@B@B@@@B@@@D@D@@@B@@@@AB@DE@A@@@@B@@@B@B@@@@@B@@B@@B@@AB@@CB@@@@A@ABA@A@A@@B@B@@@B@@ABBB@@@@A@@DA@@B@@@BAB@@B@@B@@BB@@@B@DA@@D@B@B@@@B@B@B@@@B@B@B@BBB@B@DBB@B@@@B@@BBBB@@BB@@B@@D@@BB@@BBB@@BB@@@BB@@@BB@B@B@B@BBBBB@@@B@@B@BBBB@@B@@A@@AC@@@A@AA@@A@@@AD@BA@@@@B@BADADA@@@@B@@@BA@A@@@ABA@@B@BAB@@A@@@@BAB@@A@@B@@@B@@@B@@@B@BABA@@B@@AD@D@@@B@@@@B@@@@@B@B@@@B@@A@@B@@@B@BBB@@B@BBB@@BB@@@BB@@DBB@BB@@@A@A@@BA@@B@D@@@B@@@BA@@@@@@A@@@A@@@@A@A@@FADAB@@B@AB@@@@@D@BA@ABA@@@@B@@@@@@ABA@@@@BA@AB@@@B@B@@@@A@@BABA@@AA@@@A@@@A@@B@@@@A@@@AB@AA@@AAAA@@A@@@@A@AAA@A@@A@@AAAAAAC@@A@@@@AA@@@@@A@A@@@ABAAC@A@A@@@@AAA@@AA@@@A@@@AA@@A@@@@B@@@@A@A@ABE@@@A@@@A@@BAA@@A@@@AA@@C@A@A@@@@B@@@BAB@D@B@@@DCBABCB@@A@C@A@@@@@@AC@@@AA@@A@@@AB@@@B@B@@@D@BBB@BA@@@@BAB@@AB@B@B@BAB@@BB@@A@@@AA@@@AA@@@C@@@@AA@@@AAA@@@A@A@@AA@C@A@@B@BAB@BABAB@DAB@BBBBBB@BB@@@BB@@B@BB@@BAB@B@@@B@@ABA@@@A@A@A@AAABABA@AB@@AB@@@B@BABAD@@@BA@ABA@AA@B@@A@A@@@AA@@ACAC@@A@@AAA@@AA@AA@AA@@AA@A@A@@AAAAAAA@@@A@A@@C@@@@A@@A@A@@@A@ACAA@@@@A@@AA@@@@AA@A@EAA@A@@@@@A@CAA@@@@@BA@A@@BA@@@ABA@@BA@@B@@AB@BABA@@@AB@@ABA@A@A@@AA@A@AB@@@@A@A@@@A@@@A@A@@BA@A@@@@@AA@@@AAA@@@@@@@@A@@@@AAA@@A@@@AB@@A@@@@@AA@@A@A@@AA@AA@@A@@@A@@@@@AB@@A@@@@BAB@@@BA@@@@BAB@@A@@@A@@@@@A@@@AA@@AB@A@@A@@@A@A@AB@@A@@@@@AA@@@A@@A@A@@@A@@B@@A@A@C@@@A@@BA@@@@@A@@@@B@@A@@@@A@BA@ABAB@@A@@@AA@@A@AAA@@AAAA@A@A@A@@AA@AB@@@@ABA@@@A@@@A@@@C@AAA@ABA@@@A@CA@@A@C@AA@@A@@A@@ABA@@@AAAAC@@@C@A@@@AA@@A@AAA@@A@@@AA@@@@@@@A@A@@@A@AA@@@@A@@AC@@BA@@AE@AA@BA@A@@@@@@BA@A@@AA@A@@@@BA@A@A@@B@@A@@@A@@@A@@@A@AB@@@@@B@@@BBB@B@DB@@@BDB@@BA@@DBB@D@@@B@@AB@@@B@BA@A@ABAB@@@DABAB@@@BA@AA@@@@AAABADAB@B@@@B@@@BBB@B@BBB@@@B@@@@@B@D@B@B@@A@ABA@A@@@A@@@@B@@@@@BA@@A@@A@@A@@@@A@AA@A@@A@@@C@A@@@ABA@@@C@C@@@ABA@A@@C@A@A@@@AA@AAA@@BCB@@@BDB@BA@@B@BBB@@@B@BA@BBB@BBB@@B@@@B@B@@ABB@@B@B@@@B@@@@B@B@@B@BB@AB@DB@@FHB@B@BAB@BA@@FA@@@A@@BBB@@@@@B@@@B@@BB@@@@@@A@@@@BBB@B@BA@B@@@@@@@@B@@@@@@AA@@AB@@@DA@A@@D@@@@B@@@B@@@@@BB@@AB@A@@@BA@@AA@@@@@@B@@@@@@AB@A@@@@@@@@@BAB@@@DA@@A@@@A@@AB@@@B@B@B@D@@@@A@@AACCA@@AAC@AAAA@CB@@A@A@@@AA@@C@A@@B@@AB@@@@BBA@@B@@@@@@A@A@@B@@A@@@@@@@A@@B@@@@A@@@@AA@@@C@@A@@@A@@@A@@DA@@BA@@A@@AB@BA@A@@@C@A@@@AB@B@A@A@@AAA@AAA@A@@AA@@ACA@AA@A@@@@B@B@B@B@B@DB@@B@@AB@DC@@@@@AD@@ABA@@@@@AAA@@BA@A@A@A@C@A@A@A@@@@B@B@@@@@BC@ABA@A@@@A@@BBB@@BBA@@B@@@B@@BB@@@@@B@B@B@BB@@B@B@@@B@@AB@@A@@@@B@@@B@@A@@@A@A@@@AB@@A@@@@@A@A@@BAB@@@BAA@@@@@@AA@A@@AAB@@@C@A@@B@B@@@@BB@@@B@BA@@@@@@B@@A@@@@BAB@BA@@BB@@@@FDB@@A@@B@A@A@@A@@@@B@@A@@@A@@@@@@@@@AA@@@@@@A@@AA@A@@@@@A@@@AAA@C@A@@@AA@A@AC@@@@@ABA@AAAB@@A@@BA@@D@DAB@B@B@B@B@B@BABCB@@A@@A@@A@AA@@@BA@A@ABAB@@@B@BC@A@AHAD@B@@@BBB@@A@@@ACC@AB@BBB@BBB@B@B@@ADA@@AA@@C@@@A@AAA@@@CABA@A@@@C@@AAABAAA@ED@A@@@@AAAAAABAAAA@@AA@@@AA@@BA@@@C@@@@AA@@@A@@@A@AAAAA@AA@CA@AAA@@@A@AA@@AB@A@A@@A@@CC@@@@@A@A@A@@AA@@BABA@@D@@AB@@@@A@@@ABAAC@@B@@ABB@@@@B@@B@D@@@@D@BA@@@A@@CC@@@@@A@C@A@@DCB@@@AA@ACAAA@@@@@AB@@@@A@ADE@@BA@@BA@@@@ACDAAA@@ACAA@AB@@@@AAAA@@@B@@ADA@@BA@ABA@CB@B@@@@A@@@A@A@A@@A@CBAECA@@BA@AA@@@A@@BC@BAAABCCAACBEBC@@BG@A@ABA@A@@BHBH@B@@AB@@@@B@@@DA@A@@@AAA@E@A@AB@DBB@BA@@BCBA@AB@BABA@@BA@@@ABBD@@C@CF@B@BBB@B@@@BB@@BABC@@BAB@B@@@B@@ABA@@B@B@B@BA@@@@A@A@AA@@BA@@@@BCA@@@@A@@A@A@@@@@@B@@@@BB@@A@ABAA@@A@@@BA@@@ABA@@B@@BDC@@B@B@B@@@BB@@@@@C@A@@B@@@BABADC@AB@@A@@@ABA@@@@B@@@BABA@@@@@@BA@AA@A@@@@@A@@A@@@@AAA@AD@@A@@@@@@@BA@@@@A@@@@B@AA@@AB@@@@A@@A@@A@@A@@@@A@@@@@@@@D@@AA@@@@A@@@A@@@A@@@@@BB@@@@@@A@@@@@@A@@A@@A@@A@@@@@@@@@AB@@@@@A@@A@@B@@@@@B@@@B@@AA@@@@A@@@@BA@@@AAA@@@@@@@ABAA@AA@@A@@@@BA@@@@@@@C@@@@A@@@@AA@BA@A@@@@@@@BA@@B@BA@@B@@@AA@@@A@@A@@BA@A@@@@AA@@@AA@A@@@@AA@@A@@@A@AA@@@AA@BAA@@AFCB@@AB@@@@@@@GCECAC@@@C@AHCJIDABA@@@@BACEACAE@@@C@@@A@AAAEAE@G@C@@C@CDAD@BA@A@@B@B@BAAABADAFABC@ABCBEAE@CDE@CFAFCBAE@BEBADGA@@ABAJCRBBIFA@DDDDDDD@BB@D@AABC@AJDBD@BFABB@@DCBCBA@EBE@@BADGAGHQ@@BA@CDAB@BD@BBEDBF@DBBC@A@AG@AADG@A@@@AFADEH@@C@@@A@C@AHG@@@@BA@@BA@@@AB@AA@@@@A@A@@B@@A@@@A@@BA@A@A@@A@@BA@@A@A@CA@@ABA@C@@@A@@@CAA@AACB@@AB@@@@@@@AAA@@@B@@A@@@A@@@@@@@@A@@@A@@AAA@AAA@@@A@@A@A@@@@@ABBB@@A@@AAAA@@A@@@@@ABA@@B@@A@@@@@A@@@A@A@A@@@A@@A@ACA@AA@@A@@@@@A@@B@B@@@@@BA@@@@@AAB@A@@@@BA@@@@@A@@B@B@@@AB@@B@@@B@@AD@BA@@@@@@@@@A@@B@@A@@@@"],encodeOffsets:[[112965,25560]]}},{type:"Feature",id:"450324",properties:{name:"全州县",cp:[111.072946,25.928387],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@HAD@D@BB@DADBJDJFNDJDHBD@FABEF@BABDD@FAD@FCDCBAHCFCFCB@BBBD@VDHDBDFZBD@F@L@FBDBBBBAFALCHCD@DDDDFLDHDLBH@BBFBB@B@D@BADEBAFABA@C@E@CDCFEFEJCXEACIOAG@C@ABCBAJCD@H@HBJ@FADABADCBADAHBFBD@BAAGCG@A@A@@@AB@B@DBB@B@DADABAB@B@B@BBBDBBDBDBB@BA@@@C@CBABAB@FBB@@ABCBAB@B@BBBBBBB@DABAFCFEDCDCDAFAD@D@BADAB@B@D@B@B@@DBBBBB@B@D@BBD@B@D@DADBD@B@@@D@HADA@@DAD@D@B@FBJ@R@DBNDD@B@D@DAB@BAFAD@D@J@FAFBN@FBD@DAB@BABC@CAG@C@ABCAECGCEAEACDCHGHGB@FCB@D@DFBBB@B@BC@EBC@CDAB@DBB@HHDBB@D@HCFCD@BBBF@BBBPD@@F@FDD@D@HA@@H@D@DA@CBEDEDCBCBABCFE@A@CAKBCDABA@E@EAEAC@@@CBCAC@CCAEAOC@@EAE@E@CAACACBC@A@CDE@C@CA@@AEAACAEBA@CFCBCBAB@B@BA@AB@ACA@CCACAEBE@CACCCEEEG@C@A@AA@ACAC@EBEDKBE@ADC@@BAFCBC@E@IDCAEAA@A@C@A@A@A@A@CECIECCAC@@@A@AB@FDDBD@@@B@AA@CCCEAECMIEGGI@@BCBAHEBA@CBALGDAFAB@BABABABAAA@AA@C@G@C@ABG@A@AEAAAAGCE@C@CA@@C@ACCA@CAA@GAEACECEGAA@@AECACAEACA@AAA@ABCBA@@@A@CCCCCAA@ABABA@A@C@AAACGAEAEAA@A@E@A@CCE@ACCCAECAC@@AG@I@CEGCEA@@ADALBJDB@BCBEAIBGBAJCH@D@DADA@AACEEAABEFEBAB@LIFADIAAC@G@CAACA@BCBEFEDCLGBABAJEBADEDE@A@KBGBEBCDCB@PGH@B@HAB@R@B@HEHCDAB@B@FLBFBJBNBFDDJH@BTJDDBBJDFBB@@FBDBDFBJDJDF@B@B@LSBCDEDADAD@BBBB@BBHBDBDDDHDHBB@B@@@BA@ABAAE@E@C@AHEDC@ABAAAACAA@A@@B@@@D@LBXDHBDAHKFED@DDLLHDFBJCH@N@HBFDD@FABCACGGAC@IDCF@HDHDHBXGPCBAAEIME@CACAEAGBG@EAECKKOA_ACC@ACCCKCQ@EDCLEDEBCBMBQ@@BIBIBIBK@KCCECGAI@GDOAKKKSIOCAME@@OCMA@@CCCACAE@CBC@A@SEKC@@IAG@EBCBEDE@IAGCGMGCCAAA@AACBQ@CC@A@C@@AA@A@A@CAAACC@@@BA@AAA@A@A@AAEA@AAAAAA@@AA@AAA@@A@A@@A@ABCA@@EDABAD@BB@BB@@@B@BA@@BA@@AAAA@@@AB@@AB@@ABCBC@@@A@@B@@@@@BAD@@@@@ACB@AABBB@@DB@@@AD@B@H@@@@BB@BA@@@AB@@@@AB@@@B@BBBBD@B@BBD@@BA@@@@DAB@B@BBBBDBBBBCD@@DFAB@BE@AACF@BC@A@@@A@@DBD@BA@A@A@@@C@@BGA@AACE@A@AAA@A@AHAB@@A@@BAAABA@CBA@AA@CADCC@B@@@BA@AAB@@AAACB@DEAAAD@ACABA@@B@BAB@DA@@BA@@D@B@B@@ABBBA@@BB@@BCBEDEBGF@@C@CBC@E@@AAAAC@A@CBABADA@AAA@AA@@@A@@C@@B@@A@@AA@@@AA@A@@@@A@@BA@@@@@AAAA@A@@@A@@@@B@B@@B@@AB@@@@BA@@@@@@@@@@@@B@@B@@B@@@@@@A@@@@@@@@@@@@@AA@@@A@ACA@@A@B@@B@B@D@BABA@@BA@@@A@A@CC@A@@@@AA@@A@@@@@A@@A@@AA@AA@@@CB@BCBADG@CDEBABAA@@@DA@C@EACGAACACA@EBAAACC@@CACA@@@CACAA@CA@AC@CAC@@AC@@@@@A@@@A@@BAAGAE@CB@@@BA@B@@B@BA@AACC@@AACAAB@@A@A@@@C@@AED@@@@@@C@C@CA@AAAAA@@ABA@@AA@@@A@@B@@@BAB@BA@@B@B@BCB@B@@A@ABA@@A@A@@@A@A@@@A@@A@@AA@AB@A@@AAB@@CABBB@@B@@E@A@@@DBA@@BA@@BE@C@C@EAA@A@@A@@A@AA@@@ACA@A@AA@AB@@@BABB@AD@B@BA@@@A@ABA@A@A@A@A@@@AB@@@AA@B@AA@AA@A@@@@@CB@@@@A@A@@@AA@@A@@@@@@@@AA@@AA@@@@@A@@@AB@@@@AB@@A@@@@B@@BB@@@BA@@@AB@@@BA@@@A@@@@@@@BA@A@@AA@@@@A@@@@@A@@@AAA@@@@@BAF@BB@@AC@A@AA@A@@@A@@@A@@@@A@@@A@A@@@A@@BA@A@@BB@@B@B@@@B@@A@@@@@A@@@@@@@@B@@A@@@@B@@@C@@A@@@@@@AA@A@AAA@@BC@A@@A@@ABA@@A@@A@AA@@@A@A@@@@@A@@B@@A@@@AA@@BA@@C@@@G@A@@A@A@@BABC@@B@B@@C@C@@D@B@@C@@DAB@B@BB@@B@@ADAB@@@AA@@@@@A@ABA@AA@@A@@A@A@A@A@C@@@AA@@CAAAA@@A@AA@A@A@A@ABA@A@@BABC@AB@@A@A@A@A@@@AAAC@A@@AAA@AA@@B@AAB@@@@AA@CAB@@A@@@@AAAAA@AA@@A@A@@@A@CAAAA@A@A@A@AAA@A@@B@@@B@BDBB@@@@@B@B@@@B@DBBB@@@@@@A@@BAB@BA@CAA@@A@@C@A@@@A@A@A@C@@@A@@@A@@@@@A@@@@@A@A@C@CAA@@@C@@@CA@@CAA@@@A@@@C@@@@@A@A@@@A@@@AAA@@@A@AA@@@@A@@@A@A@@@A@@@A@@@A@A@C@A@A@ABE@@@A@A@E@@@AA@@C@@AA@A@A@AAC@@A@BA@AA@@@@AAA@@A@@@@AAAA@BA@@@A@A@@@A@@@@@A@@AA@AAA@@AC@A@A@CB@@A@@@A@A@@@A@CAA@@@@@CC@A@@AA@@@AAA@AAAA@@@AAA@@AA@@AA@@@CA@AAAAAAA@CBA@@@A@CB@B@@@B@BA@@@A@@@A@@@C@A@@@A@@@@BAB@@A@A@@BA@@@C@A@@@A@@BA@@@CB@@@BAB@@@BA@@@@@AAA@CA@@ABA@A@@@@@A@@@@BA@@B@@@@@@A@@@ADC@@@A@@@A@@@@AA@@AA@A@@AA@@C@A@@@AACCA@@@@@A@@@@A@@AA@@@AA@@@AA@@AA@AA@@@@@@@@A@@B@@A@@A@@ABA@@B@@ABABA@C@@@AACBA@AAA@AB@BA@@@A@@@C@@@@@A@@@@CAA@A@A@A@@@C@@@A@@AA@@A@@A@AAA@@@@@@A@@AAA@@@@@A@AA@@@AAC@A@@CA@@@@@A@A@@@AA@AAA@@@AAA@@AA@@@@CA@@@A@A@A@C@@@@BA@A@@@@B@@C@@@E@A@C@A@@B@@@@@@ABC@@@ABABCB@@A@ABA@AAA@AA@@AA@@AAA@EA@@C@@@@@AA@@AA@@AAAAA@@@AA@@AA@@A@A@@A@@AB@@@@CB@@ABA@ABAAA@AAC@E@CBAAABA@AB@@BB@BBBA@ABBBAB@@A@A@C@A@@AABABCB@@ABA@CD@B@B@@@BA@BDB@ABA@@@@BEB@B@B@B@BA@@@A@ABC@EDC@A@CB@BA@ABCBE@AACCAB@BC@A@C@C@EBA@AA@@A@@AB@AAA@@A@@A@E@@@AB@BA@A@@@@BBBB@@@@BBB@@@DA@@@@BABBB@@@B@D@@A@ABAB@@@@BB@@@B@B@@@B@B@B@@A@@B@@@B@BA@@@@@@BAB@D@@@B@@@B@@@B@BB@@@B@@BBAB@@BBB@@@@@@B@@@B@@B@@ADAD@B@@@@ABAA@B@@AD@DA@@B@B@B@B@@@BCB@@@B@B@B@@B@@B@@@B@B@D@@BB@@BB@B@@@@@B@@@B@@AB@@@B@@@B@@@BBB@@AB@@@@@BB@@@BB@B@@@@B@@D@@B@@B@@@B@@@BB@@@BB@@@B@@BBAB@@@@@@@BA@ADA@A@@BAB@@@@B@@BBD@@@BDB@@@@DBB@D@@@@D@@@B@@AB@@@B@@@B@@@@@F@@@BBBB@@@@B@@BDBBDFB@@@@B@@@BA@@B@B@B@@@BB@@@@B@@BBDDB@@@@B@B@@B@@BB@@B@@B@@@@D@@BB@BB@@BBB@@@@B@@B@@BB@@@@BB@@@@@BDB@@AD@@@B@@BB@@@B@@@@@@@BB@@@B@BB@@B@BBBB@@BD@@@B@@@BABBBBBBB@@A@@BB@@@AB@@@@A@@D@@@@BBA@@B@@@BBB@@AB@B@@CB@B@B@@@BA@@@@@BB@@AB@D@@@@@@@BBBB@@@ABB@@@@B@B@@B@@@BBB@@@BB@@@@@@BB@@AB@@@B@@@@A@@@A@A@@@ABA@@@@@@@A@@@@BA@@BCBA@@BI@A@A@@AA@@@CB@@@BA@ABA@AA@@A@@@AAC@@BAB@@@@AB@@A@ABA@@@AB@@A@A@@@@AA@C@A@@@@B@@BB@@@B@@@@@BA@AB@@@@A@CA@@AA@AC@@@A@@B@@@D@@A@@@@@@@A@@@@BA@CB@B@@@@@B@@@AABA@@@@B@B@BAB@@BBA@@@@B@@@@@@CBA@GDAB@@CCEBA@A@A@AB@B@@@@@@AB@DBB@BBF@@DD@@BBB@BBB@RCBA@@B@D@DBF@JBD@@@BB@@@B@@@@@AA@@B@B@@ABB@@@@BB@@B@@B@@B@@AB@@@B@@AB@@A@@@AB@BAB@@A@@@@@A@@BB@BB@@AB@B@AA@CB@@BB@@@@BB@@CDA@@@AB@@A@@BA@A@@@A@@@AAAAA@ABA@AB@B@B@@CDABAB@B@@C@A@A@AA@@A@@@A@@BCGA@ABED@B@B@@@B@@@B@@@@A@@@@BA@BB@B@@@@@@B@A@@@@@@@A@@@@@@A@@AA@@@@@@B@@@@@@@@@@A@@A@@@AACAA@A@@@@AA@AB@@@AA@@AAAA@@@@A@@A@A@@@@AB@@A@@@@@A@BAA@BAA@@@@@AA@AAA@@AA@A@@@@@CD@BA@@@@@@@A@@@@@A@C@AA@A@A@@B@@A@@A@@@@A@@@@B@@@B@B@@@@@B@@@@@@@@@@@@AA@B@AA@@B@@AB@D@@@@@@@@CAAAA@CAA@AA@ACAAA@C@@@@ABA@@@A@A@@BA@@@AA@@B@@A@ABA@ABA@@@B@@@A@A@@BA@A@@@@B@@A@@@A@@@CB@B@@A@CA@@A@@B@@A@@@@B@@@A@@A@@@ABAB@@C@A@AA@AA@AAAA@@@@A@AA@@@AA@A@@@A@AA@@@@@A@@A@@A@A@@@A@A@A@AA@@AE@C@A@@BA@AA@@AA@@A@A@@A@@A@A@@@@@E@@@C@@@A@@@A@AB@@A@AB@@A@A@@@A@@BA@@BAB@BABABC@@@A@@@A@@@CAA@@@AAC@@@@@A@AB@@@@@BABABADAB@@AB@B@@AB@@A@@@AB@@A@@B@@AB@@AB@@@BB@BB@B@@BD@B@D@B@@@@@B@D@@A@@@ABA@@@@B@@A@@@A@@@AB@@@@@@@BA@@B@@@@@B@B@B@BBB@BA@@BBB@BA@@BA@@B@@ABAB@@A@A@@@@@AB@BAB@@AB@@@@@@AD@@A@A@@@A@@@@@A@@BA@AA@@A@@B@@@B@@@B@BB@@@@@@B@@B@@B@@DB@BBB@@@BB@B@@@@@@B@@BB@@@@@@@B@@@BBB@@@B@@@@@B@@@B@@B@@@AB@@@B@@@D@@@B@@@B@B@@A@@BA@@B@BB@@B@B@@@@A@@BA@@B@B@B@@A@AB@@@B@@A@@B@@@B@@@@AB@B@BA@@@@@@B@BDB@@DBB@B@@@@BBB@@BD@B@@@@BBBD@@B@@B@BB@@B@B@@AB@BA@@B@B@@@B@@@@B@@@@BB@@BBD@D@@@@DBAB@B@DB@@B@B@BB@@@B@@@BBB@@BBBB@BB@@BB@@@@@BB@@B@B@@BBB@@@@BB@@@B@@@@BB@@@B@@@B@B@@@@B@@@B@@@B@@@B@@B@@B@@BB@@@BBB@@@@@@@BB@@@B@BB@@B@B@@@BB@BB@B@BBBBBBB@BB@@@BBBB@@B@BB@@@@@BB@BA@@BBB@@AB@B@@BB@@@@BB@@@@B@B@BB@@BB@B@@BDB@@B@@BB@B@B@@B@@B@@B@BBBB@@BAB@@B@@BB@@@@B@@B@@B@BB@@@B@@B@@@B@@BD@B@@@D@@@BB@@@BB@@@@BB@@@@@B@@@@B@@B@@@@@@BB@@@@@@BB@@@@@BB@B@@@B@B@@@DA@@@@@@B@B@@@@A@@B@@@BAB@@@@@BB@@@@B@@@B@@@@@B@@@B@B@@@@@B@@@@ABBBAB@B@@@B@@@B@DB@@B@@@@BB@@@BB@@BB@@BB@B@B@@@BB@B@@B@@B@B@@@B@@ABAB@B@@@B@@@@BB@B@@B@@@B@BB@@B@@BB@@@@@@@BABB@@B@@@B@@A@@@@B@B@@@B@@@@@B@@@@AB@@BBA@@BBBAB@@B@@@B@@@B@@B@B@BB@B@@@@BB@BB@BB@@B@BB@B@@B@BB@@@@BB@@BB@@@BB@@BBBBB@B@BABBB@B@@@@AB@@@@@@A@AB@@BBA@@@AB@@@B@B@@A@@@@B@B@B@B@BB@@BA@@@@@AB@B@@AB@@AA@BA@@BA@@@A@A@@BA@@@@@A@@@A@A@A@BB@@@B@@@B@@@B@DA@@B@@ABBB@B@B@B@@A@AB@@@B@@@B@@BB@@@@BB@B@B@B@B@BBB@@BB@@B@B@@@B@B@BBBB@@B@@@B@B@@B@B@B@@BB@@BB@B@BB@B@@BDBB@@@BBB@@@BB@B@B@BB@BB@@@B@@D@@@@B@BB@@@@B@@@BB@@BBD@@B@@@@B@B@@@@@@@BB@@B@BB@B@@@BB@@@B@BBBA@@@@B@@A@@BA@@@@@@B@@@@B@@BB@D@@@@BB@@B@BA@@BBBAB@@B@@B@@@BB@@@B@@@BB@@A@@B@@@@B@BBB@@B@@@@@@BBBB@@@DB@@@@B@BB@ABB@@B@@@B@@@B@@@@@B@B@@BB@BA@@DB@B@BBD@BB@@@@B@BB@@@@@@@@@@@BBB@@A@@B@@@@@B@B@@BB@@BB@@B@@@@BB@@B@@B@@@@@@BB@@B@B@@B@D@@BB@B@@@B@B@@@@@@B@@@@@B@@@BBB@@B@@@BF@@BB@B@D@@B@@@B@@@DB@@B@B@@@@@@BB@B@BBBB@D@@BB@@@BBBBD@@@@@BB@A@@BAB@BB@@BB@@@@@BB@B@B@@A@@B@B@@B@BB@@BBDB@@@BB@@@B@B@@@BADB@AB@BBBBBBFD@B@@@BB@@@@B@B@@@B@@@B@@@@@@AB@@@B@@D@@@DAB@@BBB@@BAB@@BB@AB@@BBB@BBBB@@@@@BAB@B@BBB@@@B@BBB@@BBB@DBB@@D@B@@@DBB@B@@@@@B@B@@@BD@B@@@B@@BBB@@@BB@DB@BBB@DB@@B@BB@@@@@BB@B@@@@BB@FBD@@@@BBA@@B@B@@@BBB@@@@AB@BB@@@@B@@B@@@@@B@@BB@@@@@BB@AB@@@@@B@@@@B@@B@B@BA@@BB@B@@B@@B@BDB@@BB@@@@@@A@@@@@@B@@A@@@@@BB@@@@@B@@@BAAA@@BA@@@@B@B@B@@@B@@AB@@BBBB@@@@B@@@BB@@BB@@@@BD@@B@@@@BB@@D@@@BAD@@BB@@BB@@@B@@@B@@BB@B@@A@@@BBB@B@@BB@B@@@B@BB@@@BB@BB@@@@B@@BB@ADBBBBBB@@@C@@B@@BB@@@B@@@BBB@BBDB@@@@@B@@BBB@@BB@@@B@AB@@@@@B@@BBD@@B@@B@@@B@@@@B@B@B@@@B@@BDB@@B"],encodeOffsets:[[113584,26886]]}},{type:"Feature",id:"450325",properties:{name:"兴安县",cp:[110.67167,25.611704],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@@@@@A@@AA@@@A@@BC@@CA@A@@@@AA@@@@AA@@@AA@@@@@AA@AA@@AAA@@@C@@A@@@@AA@@AA@@@@A@A@@A@CCAA@@@A@@A@@A@@@A@A@AB@@A@@@A@@A@CEAAAC@@@A@@A@AA@A@@@E@@@@@A@@@A@@BA@@@A@@@C@@C@A@CA@@@@CA@A@@AC@AA@@@@@BA@AB@B@BCB@@A@@@@@@BAAA@@@A@@AA@@A@@A@@@A@@@AA@@@@CA@@@@@@AAA@@A@@A@@@@BA@@AA@A@@@A@@@A@@BA@@@A@@@A@@@@@AAA@@AA@@@C@A@A@@@AA@@@@A@A@A@@DA@A@@@A@A@A@AB@@CBC@@@ABBBA@@@@@ABCBC@@@AA@@@A@@@@@@@AA@AA@AB@AA@@@A@@A@A@@@A@@@A@@@CBA@A@@@@B@@A@A@@@AB@@@@A@A@A@@@A@A@@AA@@@@BABAB@@@@C@A@@AABA@A@@B@@C@@AA@A@@A@AA@A@@B@B@@ABA@@F@B@@@@BB@BBA@@BB@@@BBB@FAD@D@B@D@@ABADDBBF@DABAB@@ADAB@D@FCD@BAB@@@B@@A@A@A@AFA@A@@B@BAA@ACB@@A@@@A@ADCB@BA@@DABABA@BB@D@B@B@@@BAAABAB@AA@AAA@@BAB@BABBDAF@D@BBB@BBBAB@BA@@DA@@@@BA@@@BB@B@@@BB@@BB@@B@BBBB@@BB@@BB@@@@D@@@FBB@BB@@BB@@BBB@BBB@BAB@@@DABABA@@D@BA@@@@@@@AB@D@B@F@@@D@@@@A@@B@B@@A@@D@B@B@B@@A@@@A@A@@BA@@AABABA@@AA@A@AB@@@@C@@A@AA@A@ABAAC@@BA@A@ACA@AA@@@ABA@@@@@A@AA@@A@@BA@@@A@AAAA@@C@AAC@A@@@@AA@AA@AA@@@@AA@@A@A@@A@@AA@@AA@@A@@BA@AAAAC@A@C@@@A@C@A@@@A@@@@BA@A@AB@@@@CAAA@CA@AA@A@@AC@@AA@A@A@C@@@@@A@AAA@AA@@@AIC@AAABA@@A@@@A@@@AA@AAA@@@AA@@A@@@@@A@A@@@C@A@@A@@C@@A@@@@AAC@@DA@@@A@AFE@@@AB@@@@A@A@@@@@AAAAC@@A@@A@AAA@AAA@@@A@A@A@@@C@A@@@@@AB@@A@@A@BA@A@A@C@A@@@@@A@A@@@@@@@ABA@@@A@@@@@A@A@A@A@A@@@ABA@@@A@A@@@A@ABA@@@A@AA@@A@A@@@@A@AAA@@@A@C@@AA@@@A@A@AA@@A@@@A@A@@ACAADA@C@E@@@C@AAABABGLA@CA@BCB@@ABCFA@@@AAGAECICE@CCEEEEG@S@EIC@AIGGACCEACACC@CCCAC@A@A@@@IAABE@C@@AAA@@@GAEAEBEACCEC@GBAC@G@AA@E@E@AAG@CBCA@BE@ABC@ABE@EAA@@ACBCACBA@@DCBDB@@BF@@@DD@BD@DA@AB@@@@AAA@@@A@@@A@@AC@@@A@A@A@A@A@AB@@AAA@@@AB@@@@CA@A@@A@@@A@@@@B@B@@AB@BB@ABA@@@A@@@A@@BA@@@@B@@C@AB@@@@@B@@@B@@@@@@@@B@@@@BBB@@@@A@@@@@@BA@@@BBA@BBA@@B@@BB@@@@BBA@@@AB@@@BA@@@AB@@@@A@@DA@@@A@AA@BA@A@@BC@@BA@A@ABABAABAA@@AA@@BA@CAA@C@@@@B@BA@C@CC@C@AABA@A@C@@A@CAA@@@AC@A@AB@@ABAAA@BD@@@B@BABA@ABA@A@@B@B@B@@@AA@@@CA@AAAAACC@B@BCB@B@D@BBB@B@@C@A@@AAB@@A@A@@@C@@@A@A@@@ABA@A@A@C@@AB@A@CBBB@B@B@B@B@@AB@@CB@AEB@@A@AACBA@EAE@A@A@@@AAAB@@A@@@A@A@@@@A@@@ABAB@@AA@@@A@@@@@@A@@@@AB@AA@@@@@@B@@@@A@AB@@A@@@@@@@A@A@A@@@A@AD@A@@A@@A@A@@C@@A@@A@A@@AAA@@@AA@@CA@ABA@AA@@A@A@A@A@@@A@EBA@ABA@@AAAAAAC@@@@A@C@@@A@@@AA@AC@@BAA@@ABA@A@A@A@A@@BA@@@AD@@CBA@@BA@A@@B@@A@@B@BBD@B@D@@AB@BB@@B@B@B@@BD@@@@BFBB@DBBAD@B@H@B@BAB@BABABA@@@@B@BA@@@ABCAA@C@ABA@BB@@@F@@@BA@A@C@ABAB@BA@AD@@BBABA@@BAB@@A@@AC@@BAD@B@@@DB@@@B@@@B@@B@@@@@@@B@B@@@B@@A@@@A@@@AB@@A@A@@@A@AB@B@@@@@AAA@@@@A@C@AB@@A@@@CA@@C@A@@@AB@BA@A@@B@@A@@B@@AB@BBB@@B@@BA@A@@B@B@@AB@@@@ABAB@B@B@@B@B@@@@B@BAD@@@@@B@@B@@@@B@@@B@@@@B@@@B@@B@@@B@@ABA@@@@@A@A@A@@B@@@@@BA@@@@@CB@@A@A@C@A@AB@@@BAB@BB@DBDBBB@DBFDB@DB@B@@@BDDB@@BBBF@B@BB@@@ADA@A@@@ABA@@@ABA@A@ABC@@BA@@@@BA@A@@BADBBA@@B@D@@BBB@BBB@B@B@BB@@B@BB@BA@BB@@AB@@B@@@@B@B@@@BB@B@BC@@DB@B@@B@@BBB@@BB@@B@@B@@B@@B@@BA@@@BB@@B@@@@@B@@BB@@@B@D@B@BBBBB@BABBD@BB@BFB@@BA@@B@BB@@@@@BBA@@@A@A@@@@AA@@AA@@@AA@AA@AA@@@@@AA@ACAAAA@@AA@@@@@AA@@@@@@BA@A@@@@@CA@@A@@@@@@BBBA@@@B@@B@B@@AB@BA@@@A@@@@@AB@@@@A@A@@BA@A@@B@@AB@@C@@@AA@@ABAAAB@@A@ACA@AAABA@A@A@A@@@AA@AC@@@CAE@AA@@@A@@BAA@@@@BA@@@@B@A@@A@@AA@@@@@BA@@@A@AA@AA@AAAAE@@@A@AC@AA@A@AACA@@A@@AGA@A@@BA@AAABAAABA@ABA@@B@AA@AAABA@@B@@@BAB@B@BA@C@@DABDBB@BBBDD@BB@B@B@D@HBBBB@B@B@@BB@@@BABCB@ACBAB@BABA@A@@B@B@BA@@DAB@B@B@BB@ADABA@C@@D@B@BCBBDABA@@B@D@D@B@D@BD@B@@BBBABA@A@@@A@@CA@C@@BA@ADABCBAB@@@B@@@BBB@@@B@@ABCD@@A@A@AB@@@BAD@BBBAB@B@B@B@@C@A@@ACB@@@@ABAD@@@@CD@@AA@@AB@@AB@@A@@@@BAB@@ABEBA@A@@@ABADA@@BABAB@@@BAB@@CD@@@BABABAB@BCDABA@C@C@CAAAAAC@A@@@C@A@AB@BCAAACAAAEAACA@AA@ABCBA@AAACAC@BAAA@ABB@ADADADCB@BACE@AA@C@A@E@C@C@@B@@@@ABCB@@ABA@@B@@@@A@AAAB@@AA@@A@@BC@A@@B@BADAAC@A@CB@B@@@B@B@@B@@@@BB@@@BD@@A@@B@@BB@@@B@B@@@BAB@@BB@BD@@@B@BB@@B@B@@@AB@@BB@B@@@BAB@@BB@@@B@@A@AB@@@@AA@@A@@B@@AB@D@@AB@@@B@@AB@@A@ADA@@B@@C@@AAA@@A@@AA@A@A@A@@@AB@@@B@BA@@B@@A@@BA@A@@B@BAB@@@B@@@B@@@@@BBB@@@B@@@@AB@@C@@@@@C@A@A@@@@@AAA@@@ABA@A@E@@B@@A@@DA@AD@@AB@@A@@BABABAB@BAB@AABA@@AA@A@@@A@A@A@AA@@ABB@A@@BBB@B@BAB@@@@A@@B@@A@A@@@AB@@A@A@A@@@AB@B@@@B@B@@@B@@B@B@@B@BBB@@B@B@@@@@B@@B@BB@BB@BBBB@BB@@@@BB@@@B@BA@@B@BAB@BBFAB@@BB@B@@@B@@B@@@ADA@@B@@AB@@ABAB@@@B@@@B@@@B@@@B@@@BA@@B@@AB@@A@ABADA@AB@@@@A@AB@B@@@@A@@@@B@B@@@B@B@@@B@@@BA@BB@@@@BBAB@B@@@@@@@B@@@B@B@B@BAB@@@BBF@@@@CBAB@@A@@@ABBB@B@@@B@B@@B@@@B@@B@BADAB@B@F@B@D@B@B@@@BB@AB@B@@@B@@@@@@@B@B@DAB@B@@@B@@@@@B@@@B@@@B@B@BAF@B@@A@@@A@@@ABAAAB@DA@@D@BAB@B@@@@@BA@ADC@AB@FA@ABABCBA@C@@FDB@FDBB@B@@BCBAB@@ABA@BFBDABABADDD@BBD@DAAABC@CC@@AAA@C@ABAB@BC@@BABC@@BAACBAB@BA@ABABBB@@@B@@BB@@@@@@@@BB@A@@BAB@B@@AB@B@D@B@B@BB@@B@DAD@BB@@BBBABABABCBBDAB@B@D@@@JBDHB@BBB@@BBBAB@H@BBBB@BBABBBB@BDCB@B@DB@BDABBF@@@B@BDDB@@BBBBFD@B@BAB@D@D@B@B@ABB@@DB@@B@DBB@B@@BB@D@@@BFB@DB@BB@@@B@@@B@@D@BADAB@JFD@B@@@B@BAB@B@BABADBB@@@B@B@DAB@B@BBFBB@DBD@DBB@@@B@B@B@BAD@DABB@@BADA@@BA@A@@DG@AACBACAAA@A@CAA@ABABAAAA@@ACABAA@AA@AAABA@AA@A@AC@@CCE@AC@ACAAAC@@A@AA@@A@AAA@@@ADAAAB@@ABAB@@A@A@AD@@AD@BAAA@AEGA@AEAABAD@BAA@@A@@AAB@BA@@@AAA@ABA@@@ABA@A@@FAB@D@BAB@B@@AB@D@D@B@@B@@@BB@BBBB@B@BBBB@D@BBBB@@BDBB@B@@@BBB@@@B@B@@@B@@@B@@@BDDBDB@BB@@@B@@@@@@DB@@FBB@DBB@B@B@@@@@D@@@@B@BBB@B@@BB@@@BBB@@ABA@@@@BCF@@@B@BAF@BA@@D@BABCB@@AB@@@@@B@@AB@BDD@@@B@@BB@B@@BBBD@@@BB@BBDB@BB@B@@@@BBBABBBBBB@@BBB@@BDDB@B@@@B@@@@AB@B@@BD@FBB@B@BBB@B@@BB@BBDB@@BB@BB@@BBB@@@B@B@@@B@B@B@@@BB@@@@B@@@B@A@BB@B@@BB@D@BB@BDB@@@@B@@@D@@B@AB@@@B@@@@B@AF@B@BBB@@@BBBB@@BB@@BA@@B@@@DB@@@B@@BB@@DBB@@B@@@B@@BBA@AB@BB@@@BBA@B@@B@@BB@BBB@@@BBBBB@@B@@B@@@@@@AB@@B@BB@@B@@@B@@@@@BB@@BBBB@BA@B@@@BBBB@@@BB@@BB@@BB@B@@B@B@@@B@@BB@B@@@B@B@@@B@@@B@@BB@D@B@@@BB@@@@@BB@@@@@B@@@B@@B@@B@BA@A@@AA@@BA@A@@AAAA@@@A@AB@BC@@@A@@B@@@BC@@@@BA@@B@@ABAB@BABAB@BA@AB@@@B@@ABA@@BABAB@B@@A@@@A@@AC@A@A@@BA@@@AB@BA@@@@@A@A@ABA@@@@B@BA@@@A@CB@@A@A@@B@@@B@@AB@@ABA@@BC@@@@BA@@@@@@B@BBB@B@B@B@@A@@B@B@@@BA@@B@B@@A@@BA@@BAB@@A@@@@B@@AB@@@B@@@@@B@@@DA@@B@@@@A@ABABAB@BA@@B@@@@A@A@A@@@@@A@@@@AA@@@@D@B@B@@@BABBBA@@@@@@B@B@@@@AA@@A@@@@@A@@@A@@@@@@@AB@@A@@AA@A@@@C@A@A@@@A@@A@@@@AAAB@@@B@@A@@B@@@AA@@@@@ABA@@@@B@B@@@B@B@@AB@@@@@@@B@@@@@D@B@BB@@@@B@D@@BBB@@@@B@B@@BB@@B@@B@@A@@BA@@@@@A@A@@B@@@@A@AB@@AB@@A@@B@@@B@@@BB@ABB@ABB@@@@B@@@BBB@@BB@@B@@BB@@B@@@B@@@B@B@@@B@@BBBB@@@B@B@@@B@B@@@D@@@@@B@@@@@B@B@@@B@B@@B@@BA@@B@@@@A@@DA@@D@B@B@B@@@BB@@@@@B@@DA@@BBB@BAB@B@B@@BB@@@@A@@@@B@@A@@@A@A@@B@@@@A@@@A@@BA@@@AA@@AD@BA@@@@@@@ABA@AB@@@@AA@@@A@@@AA@AA@@A@@@@A@@AA@@@@@AA@@@A@@B@@AB@@A@@BA@A@@DAA@@AA@@@@@A@@@A@BA@@@A@A@@@AA@@AA@@ABAA@BA@@@AAA@@BA@@@A@AA@@AAABA@@AA@@AA@@BA@@@@AA@@@A@@AA@@A@@@@AAA@@@A@@AA@@@AB@@@@A@@AA@@AA@A@@@A@@@A@AB@@A@@@A@AB@@A@@BC@@B@BB@BBD@@@@B@@@B@DBDB@@B@D@@@BB@@BA@@@@@@B@@@@A@ADAB@@ADAB@BB@@BB@@@@BBB@BBB@@B@@B@@@B@@@BBBB@@@@B@BB@@B@@B@@@@DB@B@@FB@B@@B@@BBB@@@B@@@A@@@AB@@@BBB@@AB@@@@@B@@@B@B@@@BC@@@@@@BA@@BA@ABA@@@@B@B@@@BABA@@@AB@@AB@@AAA@AB@@AAA@A@A@A@A@@@@@AB@@A@@@@@@BA@@B@@@B@@@@A@@B@BA@@B@@@@C@A@@@@@A@C@AAC@@@AAAA@@A@@BA@@BA@@@AB@@@BA@@B@@@BA@@@ABA@@BABCBABA@A@@@@BAB@@@@@D@BB@@B@DB@@B@@@B@@@D@BABA@ABA@AB@@AB@@@B@B@@@BAB@@@BAB@@@B@@@D@@@F@@@@@B@B@@@@BB@B@@@BB@@BBB@@AB@D@F@@BB@@B@B@B@B@@@B@BB@@@@B@@@@BBB@@@B@B@@B@@BBB@@@@@BBBBB@@BBBB@D@@@BABA@@B@@@@B@@@A@@B@@@@AB@@@DBB@@@@ADA@@B@@@B@@@@A@@B@B@@AB@B@@@A@@@B@BAB@BAB@@@@AB@@B@@AB@@@B@B@@AB@B@@D@B@BBBDB@@BBB@DBBBB@D@@@@@@C@A@@BA@@@BBA@B@@B@@@@@@@@@@A@@@@@A@A@@@A@@@@@@B@@B@@@@BA@@@@B@BBBD@B@@@@@B@@@@@@@B@@ADC@@@@B@B@@BB@BBB@@B@@@@BB@ABB@A@B@@@@@BA@@B@@B@B@@@@B@@B@BB@BB@@B@@BAB@@B@@B@B@DBBB@@B@@@@B@@@@@@@@A@@@@@@@BB@@@B@@@@B@@@@@@@B@A@@@@@@@@AAAB@@A@@B@@@@@@A@@@A@@@A@AFCBAB@DH@AB@@@B@@@BBB@B@D@@@@ABABADC@@@A@ABAB@BAB@BBBB@@B@@@B@B@@AB@@@BA@@B@DC@@AA@@@@AA@@DAB@@B@ABA@@AAA@@AB@@@@@B@@@BA@ABA@@B@@@BA@@@A@@BA@@@AA@@@@AA@@A@@A@BA@@@A@AB@@B@@@@@A@@AA@@C@IAE@CAC@A@@@ABQDA@AAA@AA@@CC@@AE@AAA@CBA@@@@@@@ABAB@B@B@FADD@@BAHCB@DA@@@@@@@A@@B@AA@@BA@A@A@A@@B@BA@B@@@A@@@@@ADAB@@A@@B@@@@@@@B@@@@C@@@AB@@@D@@BBB@@DBB@@@@@BAB@@A@@@@@A@@AA@@@A@@B@D@B@@B@@B@B@@@BA@@B@BAB@@@BA@@@@BA@AD@BB@@B@@@BBB@BAB@@A@@DA@@B@@BB@B@J@@AB@DA@AB@@A@@B@@@@@@@B@BA@@B@B@@@B@@@@@@A@@BA@@AA@@@@@@AA@@A@AA@@A@@@@A@A@@A@BA@@A@AA@A@@@@@@@CBA@@AA@@@@B@@A@@@A@ADA@@@ABA@@AA@A@@@AB@AA@@@@@CB@@@@@BA@@A@@AB@@@AAAAAABA@A@@@A@@AC@@AAAAA@@@AAA@@@A@"],encodeOffsets:[[113498,26275]]}},{type:"Feature",id:"450326",properties:{name:"永福县",cp:[109.983076,24.979855],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AG@AB@AABAA@@@@AB@@@@A@A@A@@@ADA@B@A@A@@@A@@BC@A@@@A@AB@@@@A@@@@@A@ABA@A@@BA@@BABA@@@A@@BA@A@AAA@A@CAA@A@A@@BCA@@@AA@@A@@BC@@AA@@B@@@@@B@@A@AAAC@@@@@A@A@A@@AAA@@@A@@@A@@BAB@@@B@@AB@@@@@B@@ABA@@@A@A@AA@@AA@@@@@@@BA@@@BB@BA@AB@@@@@@AB@@A@@@A@@@@@@@ABA@@@A@ABABAA@@@A@@CC@A@@C@A@AA@B@@@@@@AA@@A@AA@@A@@@@CA@@@@AA@@@A@A@AB@@@@@B@@@@A@AA@@AAA@@B@@AB@B@B@@@B@@B@@BBDA@@@AB@@C@@@A@@@A@@B@@@@@BA@ABBB@@@BBB@@@B@@A@@@A@@B@@A@@@A@@@@@A@@@@@A@@@@@AB@@@@@@AB@@@BB@@@@B@@AB@@@@B@@@AB@B@@@@B@@@@B@@A@@@@B@@ABA@@BA@@AA@@@A@@BA@@BB@@B@@@@BA@@B@@B@@AB@@@@@B@@@@@@@BA@@@@@AA@@@BA@AA@@@@@@A@@@@BABABA@@B@@@B@@@B@@@@C@A@A@@@A@@AB@AA@@A@@@@@@@@AA@A@@@@ADA@@@@AAA@B@@AA@@AAA@AB@AA@@@@@@A@@A@A@@@ABB@@BA@AA@@@@BA@A@@AA@@A@BA@AB@@EB@B@@A@AB@AAB@@@@@A@A@@AE@AAC@AA@ABA@AAA@A@@@@@AAA@@@EAAAAA@@ACABC@AAA@E@@BA@@BA@AB@@@@AD@@@BBB@D@BADAB@BABCBA@AB@@A@@@A@C@A@AA@@A@AAA@@AAA@@AB@@A@ABC@A@CDA@A@A@@@@B@B@@C@@@A@@@A@@@A@@@@B@B@B@@A@@@BB@@AB@@@B@@@D@@AB@@AB@@@B@@@B@@@D@@@B@B@@B@@BAB@@@BAB@BDDDB@@@BAB@DABA@@B@@@@@B@@AB@@CB@@AAA@@@A@A@AAA@@@A@@@C@A@@A@@B@B@DCB@@@A@@A@@@C@AA@@A@@@A@@@AA@@@AA@BAA@@@@@@A@@@@@@BAB@@@AA@@@@@A@@B@@AB@@A@@@AA@AC@@@A@@@AA@@A@@BA@@AABA@ABABABB@BB@DBBA@BDAB@BA@ABABCBA@AD@@BF@BA@ABA@C@C@CAC@A@A@A@@B@B@BBDA@BD@DBB@B@B@B@B@DB@@BB@@BABBD@BB@B@@DBB@BAD@D@@B@B@D@@B@@@@@B@@B@@B@@@@@B@@A@@@@B@@BB@B@@@B@BA@@B@@@B@@@B@@@@@@@B@B@BAB@@@@BB@@AB@@A@@@@@@@A@AD@@ADA@@B@@AB@B@@@@A@@A@@@@@BAB@BBB@B@B@@@B@B@B@@A@A@@AA@@A@AA@@@CA@@A@@@@@@A@@A@BA@A@@A@@@A@AAAAA@@A@@@A@@@AB@@@@@BA@@@@@@@AA@@@@@@AB@@C@@A@A@@@A@AB@A@@A@@@@@A@@@@@@@@A@@AA@BA@AB@@C@A@A@AB@@G@@@AA@@A@@AA@@@AAAAAC@@@@AA@@A@@BC@@@CB@BA@@B@@@B@BBB@@@@@@@@@BABA@@B@@AA@@@B@@@AA@@A@@A@@@@@@@A@@@@B@@@@@BAA@@@B@@A@@@@@@@B@@B@@@@A@@@@@A@@B@@AA@@@@A@@B@@@AA@@B@F@BAD@@A@@@@B@@CA@BBBBABB@@@D@@BDB@@@C@@B@@A@A@@@@@@@@B@@B@@@@@B@@@@@@B@@A@@B@@BBB@B@@B@@B@@BB@@@@@BBBB@@BB@@ABB@@BA@@@A@@@AB@@ABAD@@BB@BA@@D@BAB@B@B@B@@@B@B@BA@@@@D@BC@A@A@@@@@A@@@@B@@@@@@@@@@A@A@@@@@@B@@AA@@@BA@@@@@@@@AA@AB@@@@@@AB@BA@A@@BA@@@@@@@@@@@@A@@B@@A@@CB@@@A@A@@@@@@@A@@BA@@A@A@@@@@BB@@A@@@A@@@@@@A@@@@A@@AA@@@@@AB@A@@@@A@@AA@AB@@@@@A@@@A@@A@@AA@@@@@@BA@@@@@@@@B@@@@@@@B@@@AA@@@@@AA@@@@@@A@A@@@A@@@A@@@A@AAA@@@@@CB@BA@AB@@AB@@@BAB@@@@@@@BAB@BA@ABA@@BC@ADC@@B@B@B@B@D@B@B@B@@@BA@@B@B@@A@@ACA@@@AA@@@A@A@@BA@@@@CAA@@@@@@@BA@A@@@A@ABA@@@A@@CC@AA@@A@AB@@A@A@ADC@@C@AAC@@@AA@@A@A@@@@@@@EA@@A@@AA@AEA@ACBCBC@@B@@A@A@@BA@ABA@@@A@@@A@@@ABB@AB@@@@@BE@@@A@@@A@@@A@@@C@@@A@A@C@A@AB@@@@A@@AA@@@@B@@AA@@@B@@AB@@AA@@@@@@A@A@@@BBA@@@@@@AA@@@@@@BAA@@@@@@C@@@@A@@A@@@@@@A@@A@A@A@@@ABAA@B@@@@A@@@@@CBAB@BAB@@@DA@A@A@@@@A@@@@@@@A@AAA@@ABAB@@AAAA@@@@A@@@A@A@@@@BBBA@A@@@CDA@A@A@ABAA@@AA@@@@ABA@ABA@A@@@BB@B@DA@A@@@@@ABBFBBAB@D@@A@@@@@A@C@A@BE@@CCABAAC@CBC@A@A@@BCB@AA@@B@B@BABD@@BBB@@B@@B@@A@C@A@A@C@C@C@ABABABABCDAB@@@AA@A@@AA@A@AB@@A@AA@@CA@@@@A@AB@B@@@B@@C@@BC@A@C@C@A@A@E@@BC@A@A@A@A@ABA@@BCFA@@@A@CAC@E@@C@@@A@ADA@@B@@AB@DABA@@@@@C@A@A@@AA@A@@AA@C@A@A@@A@@@AA@@@@AA@AAAA@A@@BABABA@ABGBAAA@C@C@@@@@A@@@AA@AC@@A@@AC@@A@@A@@A@AB@B@@A@A@ABAB@@A@A@A@@A@@A@A@ABA@CA@@ABAB@@@BB@B@@B@BB@ABBD@@@@A@A@@B@BDBAB@BAB@D@B@@B@D@@D@B@B@DADBBD@@DBD@@B@DBBB@BCD@BICCDDFBDB@BBDBBDDB@BABCFGA@@AD@B@@@BABBAB@B@@@BAB@@@BBB@BBBB@BAD@@@@@BA@@B@@AL@F@H@BAB@@B@@B@@AB@@@@@@@@B@B@@@@@@@@B@@@B@BA@@@@@A@A@@B@B@@ABABC@@@@@A@@@@@@B@@@B@@ABB@BDA@@@C@@B@@@@BB@@AB@B@BBD@BAB@DAB@@BDBB@@D@@@BB@BB@B@@@@A@@B@@@@A@AF@@B@@@@B@@BB@BAB@@@@DA@AD@B@B@@@@@BA@A@@@@AA@@@@@@@@B@@@@A@@B@BA@@@A@@@@@@@C@@@@BA@A@@@@@@DBB@BB@@B@@AB@@@@BB@B@@@B@BAAABCDABABADDF@BB@BB@B@@A@AD@@@B@@@B@@BB@B@B@@BD@B@BBB@B@@@@B@@@B@@@@@@@B@BA@@@B@DB@@ABCB@@BBDBBB@@AD@B@BB@BA@@B@BAB@@BB@@@B@@BB@AB@DADAB@@ABABADA@@F@BBB@B@@BB@@@@BBB@BA@CB@@A@ABA@A@A@A@BD@BB@@B@@AB@@@BB@BB@@B@BB@@@BBB@B@@@@A@@@A@AB@@@BAB@@@B@@@B@B@D@@@F@B@@@B@@@B@@@B@B@@@B@@@B@@BB@BA@A@A@C@@@CA@AA@C@@@A@@@@B@BBB@BB@@BA@@B@@@@BB@@@AB@@@@@@B@@@B@@BBA@@BABB@@BB@B@@@@B@@@@@B@@B@BB@@BBB@BBB@B@@B@@@D@@@BAB@B@@@BA@BBA@@B@B@@BBB@@B@@@BB@@B@@@BB@@B@D@D@B@B@@@B@@@BBB@B@B@B@@@@BB@@@@@@@@@BAB@@BB@BAD@@BBB@@@B@@BBBBB@B@@B@B@@B@@B@@@BB@@@B@BA@@@DB@BB@@B@@@@@B@@@D@B@@@D@@@B@@@B@@@B@@@@@@@B@B@BBB@B@@@B@@@@AB@@A@@B@@@@@B@@AB@@@@AB@@@BABABA@@BA@@@CBA@AB@BAB@BA@@@AD@@AB@@AB@@@BAB@B@@AB@@C@@@AB@@@B@B@B@@ABAB@B@@@B@@BFDBBB@BB@@B@@@BB@@@@B@@@BB@@BB@@BBB@@B@@BBBB@@B@B@@@BA@@B@B@@@B@@@D@@@BBBBD@B@@@BB@A@@@@BA@AB@D@BAB@BABBBA@EDBB@BDD@BBBBBDB@D@@@@B@DA@@B@@@B@BAD@@AD@BB@@B@BBBBB@@@B@B@@@B@@@B@B@BB@BBA@BBB@A@@DBBB@@@@BBB@ABBB@@BBB@@BBBB@@BBBB@@@@BB@BBBB@DBBB@B@BDB@BB@BBB@B@@@@@BDB@@@BDD@B@@@BBB@BB@@BD@BBB@@B@@B@BBB@BF@@@@BB@@BBABB@@B@@BBB@B@@BDB@@@@BB@@A@@D@@@BBDBB@BBFA@BB@B@B@B@BBD@@A@ABA@@@AD@@BB@BBB@@@B@@@@B@@A@@@AB@@@@@BBBB@B@B@D@@@@B@BB@@B@@BBB@@@B@@@B@@@@BB@B@@@@@B@@B@@@@BBB@@ABB@@@@B@@@@BB@B@@BB@@B@@@BB@@B@@B@B@@B@@@@@@B@@AAAB@@@@@@B@@BB@BFBB@@@BBBABB@A@BB@B@@B@BBB@@@@@@@AD@B@@@@@B@@@BBB@@@BBB@B@@@D@@BB@@BB@@@ABBB@@@BB@@@@@B@@BBBBB@@B@@@B@@@@@B@@AB@B@@@@@B@@@@@B@B@@@BB@B@@B@@@BB@@BB@@B@@@B@@@B@@@@AB@@@@@@@@@@@B@@@@@B@@@@@BA@@@@@A@@@AB@@@BBB@BA@BB@B@@@@@B@B@@@B@@B@@B@B@B@@@BBDAB@B@B@@@@@B@BABB@@D@BB@@@@B@@@@B@@BBB@B@B@B@BC@@BCB@B@B@BA@@BABBDCD@DB@BFB@@B@B@BAFBBBBCBBDB@@BAB@@BB@@@@BA@@@B@@ABA@A@@@@@BBBBABAB@B@BBBB@@BD@@@B@B@@@@@B@B@B@@C@@AA@@DA@@BA@AB@B@D@@@B@@@@@AD@@A@A@@@BB@B@@@@BB@@ABB@@BB@@@@@@@@B@@@@@BA@@@A@@@A@A@@@A@A@@BB@@@@@B@@@CD@@A@@@ABA@@BA@A@@@@B@@ABBB@@@B@@@@BB@@@BB@@AB@@B@@@BB@@@@@@B@@B@@@@@@DB@@A@@@@DBA@B@@@AB@@BB@B@@B@@@BBB@@@@@@B@@@B@@AB@@@@@B@BA@@B@B@@B@@@@@@B@@@@BB@@B@@@@@BBB@@@@BAB@@@D@@AB@B@B@BAB@@AB@@A@@@A@@@ABA@@@@BA@A@@@@@@BA@@@A@@AA@@@A@A@AA@@A@AB@@@B@B@@@B@BB@BB@@@BB@@@AA@@B@@@@@BA@@@A@@@@@A@@@@B@@@@A@@@@B@@BBB@ABBBB@@B@@@BADBB@B@BB@@BA@@B@@@@DBD@@@B@@@@@B@BA@B@@BA@@@@BB@@@BBBB@BB@DAD@D@B@B@BA@AB@BAB@B@BA@@J@BBBBB@@@D@B@@@B@@@D@@@@AAA@AC@@ABA@A@@@@AA@@B@B@@@B@@@@AA@@@@A@@B@@@BAA@B@B@BAB@@A@@@@A@@@CA@BA@@@@BAAA@@@@@@A@A@@@A@@@BD@BBB@B@B@@@D@@@B@@@AAE@@ADA@A@@@AA@AAB@@A@C@@@@@ACAC@A@@@@AB@BABAD@DBBBB@@BB@@@@@BD@@ABA@@BAB@@B@@@B@@@BB@@BB@@B@@A@@DB@@B@BABB@@B@@BB@B@@@D@BBB@DABAB@B@BAB@@@B@BBD@@@BBBB@ABBB@@BBBAB@@@DB@@BB@@DBB@@@FBDD@B@B@B@D@@@B@BBBAB@D@B@@@BAB@BA@@BA@@B@BAB@BA@BB@@A@@BB@AB@BAB@B@B@BB@@B@@@BA@@@@B@@BB@@@BA@@BAB@B@BA@@B@@@@AB@@@BA@@DCBA@AB@@@BA@A@@@CB@@A@A@@@AA@A@BADA@AB@AABA@AA@@AAA@AA@@D@@@BABBB@@AB@BCBA@AB@@@AAAAA@AAABAAA@A@@@A@AB@@A@ABA@E@@AAABA@AAA@@AA@@@A@@AAA@@AA@@BA@AA@@ABA@@@AAAAA@AAAAA@@BA@AB@@A@@@A@AB@@C@@@A@A@ABAB@DC@ABA@A@AAAAA@ABC@AAC@@@C@A@AAA@@C@A@C@C@ABCBADCBCBABA@ABA@C@A@@@G@@@@@@@@A@AB@@CB@@AD@BAB@D@BA@@@@@@@A@AA@AA@@C@@@ABA@BA@@BABA@A@A@@@@AA@A@@B@BA@@@AAA@@@@BA@@@@@@AA@@B@BAB@B@B@B@D@B@B@@AB@@@@A@@A@@@A@@ACAEC@@AAA@@@@@@C@@@@@ABAB@@B@@@@B@B@@ABCBA@ABA@A@CBA@@@A@ABA@@@@A@@C@@BAA@@A@AB@A@@A@@@AAA@@AC@@AA@@@A@A@A@@@A@@@@@A@@@@A@A@@AC@@A@@@A@@@A@@@A@A@AAA@@A@@AA@AA@@C@@AB@AA@A@@B@@AA@@AAA@@AA@@@AAA@A@@@@DA@@D@BA@A@@@A@@@@@A@@BA@A@ABA@AB@@A@@B@@@@@@@@AB@@@BABA@@BA@@B@D@BB@BDADABB@@B@B@@@B@B@DDBABB@@DB@@BB@@B@B@@A@@BAB@@C@A@A@E@A@@@@@AAABAB@B@@AB@@A@@@A@@@@B@BAB@B@AA@ABAAC@A@AAA@A@AC@BA@@@ABABAB@BCD@@A@CB@@@ACA@AAA@A@A@A@@A@@@AA@A@@@C@@@A@AA@@A@@@A@A@@@CC@C@C@AAAACAA@A@@@A@A@A@@@A@AB@@A@@AC@@AAC@A@EA@CBA@AB@@ABC@A@A@AA@@@@A@@B@@A@@@@BA@A@@BA@@AA@AA@@AAA@A@@@AAA@A@A@@@A@A@C@AD@@@BADBBADAD@B@B@D@FABAB@FAD@BA@@BBB@B@@@B@DD@@B@@B@@B@FB@B@@D@BAD@B@@@BA@@B@@@@@@AB@@@BAD@@@B@B@B@BAFE@A@@BABABA@@@@DC@@@A@@@A@@B@BA@@@@BA@@BA@@@@@AB@@A@A@A@@B@BA@@@AB@@@B@@@DAB@@ADA@@@AB@B@@A@@@AB@@@B@@@BA@@@AB@BA@@@@B@@@BABA@@BA@C@@@@@AA@@A@A@AA@AC@@AA@@@A@@@ABABA@A@A@AB@@@BA@AB@@@D@@@B@B@DCD@@@D@BA@@B@B@@@B@B@BBBBB@DBB@BBB@D@BB@@@B@@AB@@AB@@A@@@@@BB@A@B@@B@@@@@B@B@@@@@B@@@@@@@BB@@@@B@@@B@@@@@@@@@BB@@@@@@@AB@@@BB@@@@B@@@B@@B@@@@@@B@@@@B@@B@B@@@B@B@@@@@B@@@@A@@@A@@B@@@BABC@@B@@@@@@BB@@@@A@@@@B@@@@ADBB@@BB@BA@AAA@AB@@@@@@AB@@@@B@@@@BB@@B@@@AB@@@@B@B@ABB@@@@BA@@@B@@@B@@@@B@@B@AB@@@@@@B@@@@@@@@@B@B@@B@@@@B@@@@@BA@@@B@@@@@@@B@@BA@@@@@AB@@@@@B@@ABB@@BA@B@@@@BB@@@B@@B@B@D@@@@@@B@@@B@B@B@@FAB@BB@@@@@B@@B@@@@@B@@@BAB@B@@B@@@B@BB@AB@@B@AB@@@@B@@B@@C@@@@@@@@@BB@@@@@BBB@@@@B@B@@@@A@@@@@@@@@A@A@@B@@@@BDADBB@B@BA@A@@@@BA@B@@@A@@@@@@BA@@@B@@@@@B@@@@BAB@B@@@@A@@@@B@DABAB@AA@@@A@AB@@A@@@@@@@@B@@BB@B@@@@@@@@B@@@@@@@@BB@@B@@@@@@B@@BAB@@B@@@@@B@@@@AB@@@@B@@@B@@@@@@B@@@@B@@@@@@B@@@B@@@@@@@@@AB@@BB@@@@@B@@@@BB@@@A@@@B@@@@@@A@@BB@BB@@BA@@B@AB@@@@ABA@@@BB@DA@@B@@@B@@@@BBA@A@@B@BB@@@@@A@@B@@A@@BB@@@CBA@@@A@ABA@@BB@A@@BABAB@@AB@B@BA@ABAB@B@@@B@@@B@B@@@DA@@BA@@@@@@@A@@@AB@@AB@@ABA@@@@B@@@A@@A@@BA@A@@@AB@@@BA@@@@@@@ABA@@B@@A@A@@@@BA@@@@AA@AA@@A@A@@AAA@@@@@AC@@@@A@AB@@A@@@BA@@AAB@CA@A@AA@@@B@@@B@@B@@@@@@@AA@@A@@@@@A@@@@@@@@A@AABA@ABA@@@A@@BA@A@@@A@ABAAA@@@A@@AA@A@@@@@@B@B@@@BADADC@@B@B@B@@@B@BA@@BCBA@@@A@A@A@@AA@@BCB@@ADB@@B@BBB@BAB@BA@@BA@A@C@AA@@AA@@@@@@A@AB@BAF@B@FD@@F@B@B@@@B@@@@A@A@A@A@AB@@@BAB@@AB@@@@B@@@D@@BBB@@@B@B@BA@A@A@@@A@ABA@@BA@A@ADAB@B@@A@@@A@A@@DA@@@AB@@ABABA@A@AAA@@@@@@D@@AB@@@@@BA@@AA@@@@C@A@@AA@@@@A@@AB@@AB@@AB@@@B@@ABA@@B@@A@@AAA@@AB@@AE@@A@A@@@AB@AA@@A@@@A@@@@@AA@@@@@@@@@@A@A@@A@@@A@@@@@@@@@BA@AB@@@AA@@B@@AB@@A@A@@BA@A@@B@@@@@@@@@D@B@@@B@B@B@@@@A@@D@@A@@D@@@@@@@@@@BDA@@B@B@BB@A@@@@@A@@@A@@@ABAB@BABABA@@DA@@@A@AB@@C@@@@A@AA@@@@@ABAB@@AB@DA@A@@@A@@B@@A@@BA@@@C@@BA@@@AA@@AB@@@B@@@@AB@@A@@DA@ABA@A@@A@CA@@B@@ABA@AB@@ABAB@BABA@@@@B@BABC@@BAD@B@@AB@BA@@B@@@FC@ABA@@A@@A@A@A@@ACAA@@@AAA@@@ABAACB@@@@A@@A@@A@@A@@@@AAABA@@A@@A@@@A@@@@AA@@@@@@AAA@@AA@@@A@@A@@@ABBBAD@@@@C@@B@@AB@BA@A@A@@@@A@@AA@@ACA@@A@EB@@A@A@A@@@@AB@BABABABADA@A@A@A@@BA@@@@A@@A@@@@A@BA@@@A@A@@@@B@@@@A@A@@@A@A@@BABC@@BAB@@@@@@@@AB@@@@ABA@@@@@@@AA@A@@@@A@ABAAA@A@@AA@E@@@A@A@@BAB@@AB@@@AA@@@A@AB@@AB@BC@@@ABA@A@A@@@A@@@A@ABCB@@A@A@@BABA@A@@BA@@@@A@@A@@@AAA@A@@@AAA@@@A@@@@@AAA@@@A@@@@B@@@@A@A@A@AAC@A@@@@@AA@@@@A@@@A@@@A@@A@A@@A@@@A@A@@@A@@@A@@@A@@@A@A@@@@@ABA@@@ABA@A@@@A@@B@@AB@@@@AB@@@DE@@@AB@B@@A@A@A@@@A@ABAB@@AD@BAB@@@@ABAB@BA@@@@BA@@B@@@@@@C@@@@@@BA@@BAB@@AB@@A@@@AD@B@@@@@B@@@B@@A@@@@@@BA@AB@@@B@@@B@@BBB@A@@@AB@B@@AB@@A@@B@@@@@DCBC@A@@@A@@BAAC@CBCBCB@@@AC@A@CB@@CAA@C@ABA@CAAB@@@BA@@B@D@@@@E@@AA@CA@@ABA@ADG@@FABAB@@CAC@ABAC@@A@@AACCA@@AAA@A@A@AAA@A@A@@A@@@A@@AAAAA@AA@BAA@@A@@@A@AAA@@A@@@A@C@A@A@@@C@A@A@CAA@AACAA@@@ABA@AB@@ABA@A@@B@@AB@@@@C@@BCB@BA@@@A@ABA@@@AAAACA@A@AB@BAB@BC@A@@BA@@@A@A@@@AAA@ACA@AA@AAA@@AAACA@AA@@AC@@@A@A@@@A@A@A@A@@BAAA@CAA@@@@@@AA@C@A@A@@A@@@AA@@CA@A@A@@@CAA@@B@@AB@@ABA@A@@AAAAA@@@A@AA@@@CAAA@@AA@B@@AB@A@@@A@@A@@@@AAA@@@AAA@@@@@A@C@AA@@AAA@@@A@ABA@@@A@@@A@@BABAAA@@@AB@@@AA@A@@BAA@@@AA@@BA@@@@@AA@@A@@@A@@@AB@@ABABAAA@@AA@@CEA@A@A@@@A@AB@@ABA@@@A@AC@@@ACEA@@@C@"],encodeOffsets:[[112778,25285]]}},{type:"Feature",id:"450327",properties:{name:"灌阳县",cp:[111.160851,25.489383],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@@@A@@@A@@@@@A@@AA@A@A@AAA@AC@@CAA@@AA@@A@@A@@@AAA@@AA@@C@@@@AA@@AA@A@@A@@@AA@A@@@@AAA@@@A@@AC@@@AA@@A@@@@@AB@@@@AA@@@AAAAA@AA@@A@A@@@A@@@AA@@@A@AA@C@A@@@A@@@A@A@@@AB@@@A@@CBA@AA@@AAC@A@@A@A@@@A@AAAAA@@@@@AA@AAAA@AAAAAAAAAA@@A@@@A@CB@BA@A@A@@BA@@@A@@AA@CAA@C@A@C@A@AAG@CB@@AC@@A@A@CAAAA@A@@@A@@@CA@@@@A@@@@B@@@B@B@BA@@@@BABAB@@@B@@AB@@@BCFA@AB@@A@@@@@@BB@B@@B@@AB@@CB@B@@A@@BA@AAA@EAAAA@@@AA@@AB@@AB@@@@AAE@@@@@AB@@A@@@A@ABA@AAC@AB@BA@@BCDAB@@@B@@@B@@@@ABAB@@@@ABAAA@G@@@@@AAA@A@@@@@@B@@@@@B@B@@@B@@@@ABA@@BA@A@@@ABA@@BABA@@@AAA@@@@@A@A@A@A@A@@@@@C@@@@@A@@@@B@@AB@@@BA@@B@@@@@@A@@@@B@@@@AB@@A@@@@BB@@B@@BB@B@BB@@B@BA@A@@@A@@@A@@B@@B@@BB@@B@@@@B@@@BBB@@B@BA@AB@@@B@@@BA@@BAB@B@@BBB@@@B@B@BBB@@BBA@@B@@BB@@AB@@@B@B@BB@ABB@ABB@@B@BBDB@@B@B@B@B@@B@BA@@B@@A@AB@B@B@DAB@B@BAB@@@B@BCD@DABAAA@BDABA@BDADABBB@BBB@BBBDDB@B@BBFBB@BH@BAB@B@BA@@DAD@@@B@B@B@@@B@@B@AB@@@@A@@B@B@@A@@BAB@BB@B@@@@AB@B@@BB@@@B@B@B@@AB@BBB@@@@@B@@@B@@BB@B@B@AD@B@@@BA@@B@B@B@B@@BB@@@@@B@@@@B@B@B@@@B@@B@HBDHAD@DFBDAFBFBF@H@@BB@BD@F@BAJB@@B@B@D@DBDDD@BDBDDFBDHHBJD@FJT@H@FFFFDDF@JDFDHBBB@@B@DEBA@@DA@ADBB@HKBABABBD@@@F@D@B@BCDB@BB@B@@@B@@@BBB@B@@@B@@BD@B@@@B@BBB@@@@@@B@BB@@B@B@@AB@B@B@@@B@B@@AB@B@@@B@B@B@B@B@@@@@B@@AB@B@@@@@@@B@B@@@@@B@D@B@BABB@@@@BA@@B@@@@@B@D@@@B@B@B@@BB@BBB@B@BB@@@BDBB@B@@@@@B@B@@A@@B@@EF@B@B@@CB@@BD@B@@B@@@@DB@@@@B@D@@@B@B@@@@@BB@@B@@BB@BBB@@B@@@B@@@ABBB@BJD@B@@BBB@BBB@@@@@D@B@B@B@@BD@@BB@B@@BDBB@BB@D@@A@@B@BAB@@@@@B@@@B@D@B@@@D@BBDBB@BAB@@@BB@@BB@@BB@@@@B@BB@@B@@B@@BBBB@@B@@B@D@BBD@@@BBBBB@@@B@@AB@@@BBB@@@@@B@BA@@B@@BDB@B@BAB@@BDAB@B@BBBB@@@@D@@A@@B@BBB@@ABABBB@@AB@@@B@B@@@B@@B@@D@@B@@BB@BB@@B@BBB@@B@@@B@B@@@@DB@@@BBD@B@@BBB@@@@@B@BB@@@B@@@@B@BBB@@@@BB@@BB@@@D@@@B@B@B@B@DB@@@@@B@@@@@D@@@B@@ABA@@BBB@BABBD@B@@@DABAB@BA@@@AB@BB@@@@BA@@@@B@@@@@@B@@BBB@@BB@@B@@B@@BB@@@B@@B@@@@@B@DDBB@@B@D@@@BB@@@BBB@@BB@@@@@B@@@B@@CD@B@@@B@@@@A@@@AB@@@@@B@@@@@BAB@BB@@DBB@B@@@@AB@@A@AB@@A@@D@@AB@@@B@@@B@D@@AB@@@B@BA@AB@@@@@B@@@B@D@@@B@@@B@@ABA@@@A@A@@D@B@@AB@DBBBBBB@BDB@@B@@BB@@BB@BB@@B@BB@BBB@B@@BB@@@BDD@@@@B@DBB@@@B@B@@@B@@@DAB@B@D@@BB@BBB@@BB@@@@@B@@@B@B@@@B@@ABBBB@@@@@BB@BB@@@@BBB@@A@BD@BBB@B@B@@BD@@@BB@@F@B@B@@@F@BAB@B@D@B@B@@@B@@@B@@@B@B@@@B@@@@@BBB@@@B@BB@@B@@@B@B@@@@@D@@@B@@@B@DB@@DB@@D@@@B@DBD@B@B@@@@@B@@@@@B@@@B@@@D@B@B@B@@@B@D@@@@BB@DBB@@ABA@AB@@@@@@@AACAA@@@A@A@@@@@A@CA@A@A@@@AB@B@BBB@B@B@B@BBDBB@@@B@B@@@BBB@BBBB@@@@@BA@DBB@@B@@A@BBA@@@BBB@BB@@@BBDBB@@B@B@B@B@@@BAD@BA@AB@B@BAB@B@B@B@@B@BB@BBDB@@BB@@D@B@B@B@B@@@@BB@@BAB@B@B@@@@BB@@A@CB@BA@@@AAA@A@CB@@@DA@C@@@@D@DA@A@@@ADAB@@@B@BB@H@@@D@@@AB@@BB@@B@@@@AB@@@@@B@B@@@B@@B@BB@@@AB@BB@@@@BAD@@BB@B@BBB@@@@@@@BD@@@A@@@@@@BA@@@@@@@@@@B@@@@@BA@@@A@A@@@AA@@@BAB@@@B@@@B@B@@@B@@B@@@B@@@B@B@@B@BBD@@AAE@AB@@@@B@BB@@B@@@@@B@@@@@BB@@@BAB@@@@@@B@@@B@@A@@BA@@B@@A@@AA@@@A@@B@@@BA@@@@BA@@B@@@@@B@@BB@@B@@@@@@B@@@BB@@B@B@@@@@DA@@@@B@B@@BBBA@B@@B@@BA@@B@B@B@B@B@BAB@@@B@@A@ABCA@BA@A@@BAB@@B@BDB@B@@BBB@@@@BB@B@FBD@D@F@@AB@@AB@CA@@B@F@@@@AA@AADB@@BA@BB@A@@BBB@@@BB@@@B@B@@@B@B@@@AB@B@BA@A@ADA@A@@@ABA@AB@@A@@@@B@@BB@@AB@BB@BBBBB@@D@D@D@@@@C@BF@@@D@@@B@BA@BBBD@BD@BD@BABA@@@@AAB@@A@@DBFBHAB@@@B@@@B@@@@BD@@BD@DBDB@@DBBBD@D@@DBDB@@DDBBAB@FDBDBBBDHFBD@B@@C@@BBBAFADCH@BCDA@ADA@@B@@BBB@@@BB@@@@@B@@@BB@@@@@BDDB@B@@@B@@AB@BA@A@C@A@AA@B@@@DB@B@B@@BB@@@@@@@@@@@@B@@@@@@@@AA@@@@A@@@@@@@@@@B@@A@@A@@BA@@@@A@A@@B@@@B@B@BB@B@@@@AB@@@B@@B@B@@B@@BB@@@BA@@@@DB@@@B@@BBB@BCBABAB@D@BBDBB@BF@D@DAD@@@HEFAFCDA@AA@@AB@AABA@@@A@A@CB@@AB@@CBA@A@AB@BABDC@BBFB@CDABB@BA@BBB@@A@@@ADDBC@DBBB@DAB@BABB@AB@@@BABGB@B@BBB@F@BD@BHB@AD@@@B@B@B@@AAC@CB@@@B@D@@ADEBBF@@ABACE@@DCAAAAACAA@A@ABA@C@@B@@AC@AAA@C@AAAAA@@@A@@B@@A@@B@@ABA@@A@@G@A@C@@B@@CA@@AABA@BDA@B@@@@BC@A@@@@@AB@@@D@DABA@@BA@@BA@@B@BB@BB@@AB@@A@A@@AAA@@ABCBAFC@@DBBAB@@@@B@BB@BBB@@BB@BBBB@BFBBBB@B@B@BBB@@A@@DDBBDBB@B@B@@BD@B@D@BQ@IDEFK@@@IAKE]AI@CBI@ADILQHGBALGLGJKFIBE@CEGGECOAAGWGQAEAO@I@CBGDIBEBK@GAI@@BCA@EYAA@@@A@A@@@AA@@@@A@@A@@@@A@@@@@AB@@@@A@@AC@@A@@A@@@@@AB@@@@@AA@@@@A@@@A@@@@@A@@@@@@A@@@@A@@A@@@@@A@@BA@@@@@AA@@@@@@A@@A@@@@A@@@@@A@@@@A@@@@BA@@@CGIICECGCEAG@IDG@C@@AIACGIGOGGCAG@CAACAIAAA@IFE@E@CAAA@IIOAE@K@I@AKCGCKGACACACCGCAMCSKKICAA@GBSBUDA@A@AAEGACIGCE@@CE@C@C@AAAIEAC@AM]EGIKSWGEWKCCACA@A@EBA@CAQKEB@@@@A@@A@@A@CCA@@A@@AAA@@@@A@@AB@@@AA@AAB@A@@@AA@@@C@@CC@A@@BAA@AA@@AA@@BA@@@ACA@@@@AA@@@@A@@@AB@@@A@BA@@@A@@@ABA@A@@@A@A@@@A@A@@@@@C@@@@@@@AA@@A@@@A@@@AA@@A@A@@A@@AAA@@@@AABAA@AA@A@AAAA@@AAA@ABA@@@A@A@EB@ACBA@@@@AAA@@AAA@@A@@A@@AA@A@A@@@C@A@A@A@AAC@C@@@ABCAA@@@ACAA@AAAAAA@AA@@AA@AA@@A@@@A@ABCA@CA@@@AAAAB@@@BC@A@@@A@@B@AA@A@C@@@ABA@A@C@AAA@AAC@AAA@A@AA@AA@A@AAA@C@@@AA@@@@A@@@A@@A@@C@@@A@@B@@A@@BAAEB@@C@A@A@@BA@A@A@@BAA@@A@C@A@A@EAAAAACAC@@@@AEE@AA@AAA@AA@AAAA@CC@@CACAA@A@A@A@A@AACBAB@@@BEBC@@@@@@@A@@BCB@F@B@DDDBDDBBBA@@BDF@@@@A@@@C@CAC@A@@@AAEAABA@AAA@AAAB@AC@AA@@A@@@AAA@AA@@@BA@@@A@@BABA@C@C@A@C@AA@@C@AAA@@AA@A@@@"],encodeOffsets:[[113549,25840]]}},{type:"Feature",id:"450328",properties:{name:"龙胜各族自治县",cp:[110.011238,25.797931],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@HAFIHIHEHCH@B@J@DBB@DDJDBBL@FAD@BABCDCDCBCJKBEBABAFAFAFAD@DDHFHDN@JAL@L@BBD@D@DDD@F@JAH@DAD@@ACEACAGAC@G@G@EBCBEDE@CBCBA@ABA@@B@@B@A@@@A@AAC@A@@@@AB@BA@A@@@CC@@@A@@@A@AAA@@CC@A@A@A@AAAAA@A@@CCAA@A@A@AAAACAA@ACACEAA@A@AB@B@@A@AA@CA@@@A@@@AACCCAC@CAA@GAQCMIMCK@ABEBCBCJENAHAJEHKDIBEFE@@FBHHHNHHF@FAB@TIJGBAJGHADA@@B@@B@@@@@A@@B@@@@@B@@@B@B@@@@@B@@@@@@@@@@@BB@@@@@@B@@@B@DABBDDLFFJDLBHBDBH@BHPJNHHJHL@HCHGDGBKDKFELGB@LEJCHCLGB@JMDKDIDO@C@IEKAOAIAE@MFEJEDAD@D@B@B@NBLBPDFBAAAABABAACB@@A@ABABC@ADA@AAAAA@@DCB@BABAB@BADAB@D@BCFC@CACCACGCEACAC@ACA@C@@CE@EAC@A@CAAAA@A@@DAD@@CDC@ABA@ADCBACEA@C@A@AA@@@A@A@A@@AA@AFAD@BBDDD@DAD@DAB@BBBDB@@@BBBBBBDBB@BBFH@BB@B@B@BAD@BCDABABAB@DA@@DAB@B@@AB@D@B@BAB@DABA@AB@BA@ABAB@B@@@BABABABAB@@@BA@@@@@AA@@A@A@@ACACBAAAA@@A@AA@@A@A@A@@@A@AAA@CBA@C@ADAB@@A@ABAB@B@DABA@A@CAA@C@AA@A@@C@A@@BA@@AACBC@ABA@A@A@@@A@CAC@CAA@EAAAA@A@CBA@A@@@A@CAABABA@A@ABA@@@A@C@IEA@CBABC@@@@A@@@A@@AAA@@CEA@A@@@CAA@@@AAA@C@AA@@CA@BAA@A@C@C@A@ABA@C@AEAA@AA@CC@A@A@@AEBAACA@@C@ADAACA@AABAAAA@AA@A@GBAAA@AA@AAA@CGIA@@C@A@A@CBAAADABABABAA@@AAC@CBA@@@AAA@A@C@A@A@@BA@A@AB@@@BAA@@@@@@@@AA@@@A@@AA@@CACAABAAA@AAA@@@AAABA@E@A@@@A@EAC@A@CBAAAAA@@AAC@AAA@AA@ACAAAE@CBAAA@AAA@CAAAA@C@A@AA@@A@A@A@@ACBA@AAAAAAA@AAC@AAA@A@A@@BAACB@BA@ACC@C@A@@@A@A@@AAAAAAAA@A@@@A@@B@AA@CB@AA@CAA@@AC@@CAA@CC@ACA@CAA@ACCAA@A@A@A@AAC@AACA@AA@@@AA@C@@@AAEAA@@@@@A@A@A@@BA@C@@@ACA@AAAAA@@@AA@@A@AAC@@AABA@A@@@@@ABA@@B@@A@AB@@A@A@@@@@A@@@@@@@AC@@@@@A@@@@EC@A@A@AA@@A@@AABA@@@ACE@AAA@@@AA@@A@@AA@@@AB@@CC@@ABAA@@A@@@@@@AAA@A@@@A@@A@@AAA@@@@@AAA@A@@@@@A@A@A@@@A@AAABA@@@A@@@@@@@AA@@A@A@@B@@@B@@A@@@@B@@@B@@BB@@ABAB@@@B@@A@A@@@A@@BAB@@@@AB@B@@@@A@@@@@AB@BABC@@@@@A@@B@AA@CBEB@@@B@B@@B@@@B@@@@@@@AB@@A@@@C@A@@@AB@@@B@B@@AAA@CA@@AA@@A@@AA@AAA@@@AA@A@A@@@AB@@@@A@@@A@A@@@@@A@@A@@@C@C@@BA@@@ABABA@@@A@@@A@@@A@@@@BA@AB@@@@@BA@ABA@@@AB@B@@@BAB@@A@@@@@CBCBABA@@@@@ABC@@B@@@BB@@B@B@@BD@@@BA@@@AAAAA@@@@A@@AAAA@A@A@A@AAA@@A@@A@@AA@@AA@AA@@AC@@@@AA@@AA@AA@AA@@@A@A@CAA@A@AAA@A@A@@@A@A@A@@@A@A@C@@@CBA@@@A@@AA@@@@AA@AB@@A@@@CB@@@B@@A@AA@BA@@@A@A@@@@B@BAB@B@B@@A@@DA@@BA@@@@AA@@@@@A@A@@@A@@@@@@BCFA@@B@@A@@@C@C@@@A@@@A@A@A@@@AAB@AA@AA@@A@@AA@@@@AB@@@@C@A@@@@AACCC@@A@@A@A@@A@@A@@AA@@@AAAA@@ACBCBAD@BBD@@@BB@@BA@A@@@@@A@@B@@ABA@A@A@A@AC@@@@AAAAA@AAAA@@CAA@A@AAA@AAA@@BA@@@A@@B@@ABCDA@A@A@@@@@C@@@A@@BAA@@AAA@@@@AA@@@A@@@CA@@A@@@@AA@AAA@CAA@@A@@A@A@A@A@@@A@@A@@@@A@@A@A@@@A@AA@@ABAAA@AB@BA@@@AB@BA@A@@@@@@@A@@@A@@AA@@@A@@@@@C@@@CB@@@BABA@@B@@@@A@@D@@@@A@@BA@@BA@B@@BA@@B@B@B@@@BA@@B@@@@A@@@@@AA@BA@@@AA@@@@AAA@@@@B@@@@A@@@AB@@@B@@@BA@@@AD@@@@@@@@@@@@ABA@@B@BAB@@@@A@@@@@CBC@@@A@A@AAAAA@@AC@@AA@AA@@AA@AA@@@A@@@AAA@@A@AA@@A@AA@@@@A@@A@A@@@A@A@@A@@AA@@AAC@AAABA@@@@B@@@@@AC@C@AAA@@@ABA@@AA@@AA@@@A@A@A@@B@@@@@A@@AB@@@@A@@BA@A@AB@@@@@@A@A@A@@@@AAAAA@CCAA@A@@@AAA@@@ADAB@@A@@B@BB@ABA@@@ABA@@@@@AB@@@@@BAA@AA@@AA@@A@A@CBC@C@C@EBGAABEBGBEDEA@AA@@@CBABBDA@ADABBBADBBABA@CD@FA@C@CBGBCACBA@@AC@CA@@CBEBGEACBE@@BCA@ACCGC@AAACAAAAAC@AC@ABABA@CCCEAAAAC@A@ACAA@AACA@AA@AAA@@A@@@A@@A@A@A@C@A@@@A@A@A@@@C@A@AAA@@@A@@DCDA@A@@BCBA@@@A@A@@@A@A@@@A@@B@B@B@BA@@@@B@@A@@B@@AAA@@BA@ABABA@@B@@@@AB@@@B@@@BA@@B@@@B@BAB@@@@ABABA@A@@AA@@AA@A@B@@AA@@A@AA@@@@A@@@CC@@A@@AA@@@@AAA@@AA@AAAAA@@A@EC@AA@A@ABABA@A@CACBAAABABAAABA@ABCACAC@C@@DABA@CBCBA@CB@AAC@GBA@AAA@CCCA@C@AA@@CA@@A@@@AB@@@BA@@@@B@@@BCBA@@@A@@@@@@B@@@DA@CB@B@@ADA@@AA@ABAB@AA@@B@AAB@BA@@@AB@BA@@BA@BD@@@BA@BB@B@B@B@@BAB@B@B@@BB@@@A@@BB@B@@@@@B@BBBB@B@@BDC@@BB@@@AB@BAB@@AB@B@DAB@@@BB@B@@BB@@A@BADAFADAH@BAB@B@@@B@@AB@B@DAB@@C@ABAAA@A@C@ABC@@BA@CBA@AF@@@@A@@BA@@@A@A@@@CB@@@@A@@@@B@B@@@@@@@@A@A@@@@@ABAB@@A@@@@AA@@B@@A@@BA@A@A@@@@@A@@@@@@@AB@@@@BB@@@BA@@@@B@@@B@@BB@@@B@@@B@@@BB@@@@@@B@BB@@BB@@BB@@@@@@@AB@@AB@@@BA@@@@@@B@B@@@B@B@@@B@@@D@@B@@B@@@B@@@@@@@B@@@@@BAB@@A@@@@B@@@@A@BBA@@@@@AB@@BB@@A@@@@B@@DD@B@@B@B@@@BB@@@@@BB@@BB@@@@BB@@B@B@@@B@@BBD@@@BB@BB@@BB@BB@BBBB@@B@@@BBBAB@@B@@B@@B@@B@@@@BB@@BB@@@B@@B@@@@B@@@BB@BB@BB@@B@@DBBAB@B@B@B@@@BBF@B@B@@BD@BAB@BB@AB@BB@@@BDFBBBBABA@BBBD@F@BBFBBBB@BAB@BBBB@@BBBD@B@BB@BB@@@DBB@BAB@BDADBB@DDDBBB@B@BBB@BBBB@@DD@B@BA@@BA@@BAB@@A@ABA@@B@BADABADA@AACB@@@BE@AB@BB@@BBDABA@A@AA@@A@A@@@ABA@C@CBA@ABED@@AF@BBBBB@@AB@BADA@@B@BA@BBBB@@@@@BBBB@@@B@@BBBB@@@@B@B@BBB@B@B@B@@@B@BBB@@@B@@@@BBA@B@@B@@DB@@BB@BA@@BBBB@BB@@@@@B@BB@B@B@@@B@@@BB@D@B@@@B@B@B@@@DB@BB@B@@@B@@BB@@@BB@@B@@@B@B@@AAC@@@ABA@@@A@A@@@@B@@@@B@@B@@@@@B@@@BA@ABBB@BA@A@ABEBID@DCBCDCBAB@HBD@D@DBBAD@BA@@BABCB@B@B@BA@A@AB@BE@CBAB@HAB@@@D@B@D@BAB@BCB@@A@@@C@@AEAABC@CBA@A@EDABA@A@CBCB@B@BEBCDC@CBE@CDEB@@A@@B@@@B@B@B@BA@BDB@@BB@BBA@@B@@AB@BB@@@BB@B@B@B@BBB@@@B@BBD@B@@B@AB@B@BBB@B@@AB@B@DBB@BBFBB@B@BBB@DBBBB@BBB@B@B@B@B@BA@BBDFBBB@BBD@BBB@BBBBBBB@DD@@@@BBDBB@@BDBBB@BB@AD@BB@@B@@BBB@BBB@@@B@B@@@B@@@BADAD@JGJI@@LBFBRHD@NFJFDDBHDPJTDFDHBHBJCNENEJEFABINAJCH@LADBJ@FAF@BIFKFEBC@KJCF@F@LFJFJFTLRBFHLHFFDLBHAB@LGPGNEF@JAPCPD@@JFBBBDHPFRDJ@@BDDR@J@J@@DHDHDJFDHFD@DDBF@LAJDRDPBJ@BBHDR@BJJFJJDLFLDLJDBHFBDNLFH@BHHFHFDFF"],encodeOffsets:[[112606,26825]]}},{type:"Feature",id:"450329",properties:{name:"资源县",cp:[110.6527,26.042443],childNum:1},geometry:{type:"Polygon",coordinates:["@@FBBAHAD@B@B@B@BB@@@BABBB@@@B@@B@@@BA@AB@@@@@B@BD@@@B@@@B@@@BBA@@BA@@AA@A@@@A@@BAB@B@B@BDBBF@PFJFHJDFDFDLDNDHBJHJDLFNFJ@DDHFFBF@HEFCJFJHFFDFHFFBFDDDF@H@NHJJLLJJJNNNHBBNHRP@BHHHLFJHJHFHBHFNHLHDFHHFFFDH@HGDKFEHCHBLFFFHHJJJJ@BFJHH@BFJHPDDHJHFDDFPBJBLAR@RDJBD@JDJBJBJDFJBJBJBFDFDFBLFBHDJHJHPLJJJNJJ@FADEDMBMJEJERCNDRFPJLAPCRELCDAJIDIFIHEF@NFHLJNFJHB@@JBHGHQBOEGIKIIKK@GJGJGBAJGJGFCLANB@@PFNHLBR@P@DBPAHAJAJ@L@D@JBJFHFLJD@NBXBZDJDLBVBH@D@@ABGDEJIBAACCEAA@A@AA@AC@@@A@@@A@A@A@@A@@@A@@@@AC@AA@@@A@@@@BAA@@@A@@AA@AA@@@A@@@@CAAAA@AA@@A@@@A@@AA@@@@D@@AAAAAABCA@@AA@@@@@AAA@@A@@AAA@@@A@A@@AA@A@AA@@B@@@@AAA@@@A@@@A@@AA@@AA@@BC@A@@@CA@@A@@A@@@AC@@@@AA@@AA@@A@@@@@AAAA@@BA@@@A@@@A@A@A@@B@@AB@BB@A@@@A@@@@AA@@@@B@@@@A@@@@B@@@@@@@AA@@CA@A@AA@@@@AAA@@ABA@A@@@@A@@A@@@@@A@@BAA@@@@A@@AA@@@@@A@@@@A@@A@@ABA@@@@AA@A@@@A@AB@AA@@@@AC@EAA@@@@@AAA@@@@A@@A@AA@@CAA@ACAA@@A@@AA@AA@@@A@C@@A@@@A@A@@@@@AAA@C@@@A@CA@CAA@AA@@AA@A@A@@AA@A@ABA@A@@@@AAAAA@AA@@BAA@@AA@AB@@AA@AA@CB@@C@@@@A@@BA@@@@@@@A@@@A@@@A@A@@A@@A@@@AECAAAAAAA@@BCAAB@@A@A@@@A@@A@@CAAA@@AAA@@@@A@AB@@@@A@AAA@@@@A@@AA@@ABA@AB@A@@A@@@@ACAA@A@@AA@@@CAAAAA@A@@A@@@@A@A@@@CA@@A@@@A@@@@C@AAA@@AE@@A@@@AA@A@@@A@@@@@A@@@@A@A@@@A@A@@AC@A@@@@A@AA@@A@@@@A@@@@AA@@A@@A@@@AA@@AA@@@A@A@@@@@AB@@@AA@A@@@@@@@@@@AAA@@@@@AAC@AAA@A@@CB@@AAA@@@A@A@@@@@A@@@A@@@AA@BAA@@A@A@@A@@C@@AAAA@@@@@@@AA@AAA@@@@@@AB@@@AA@@A@@@A@@A@@@AA@@@BAAA@AB@@A@AA@@A@@C@A@@AA@@@@@@A@@@@B@@AB@@@@A@@B@AA@A@A@@AA@@A@A@@A@AA@@A@@@@@@@A@A@@A@@@AC@AA@@A@@@A@@A@@A@A@@C@@@@A@@AAA@@A@A@AAA@@A@AA@@A@CA@AA@A@@A@AAA@@AA@@@A@A@AA@A@@@A@@@AAAAA@A@@@A@A@@@AA@@AA@A@A@A@A@AAA@@@@AA@@@A@@@A@@BAB@@@@A@A@AAABA@@@AB@@C@A@@@A@@@A@@AAB@B@B@@@B@@@@@B@@AB@B@@@B@@AB@@ABB@@BA@@@ABA@@@@B@@AA@@A@A@A@A@A@@B@@@@A@A@@BA@@B@AA@@BAB@@@@@@@BA@@@@@AAABA@A@AAAAA@AA@@A@@AA@@AA@@@@AAA@@@@AAAA@@@AAA@@AAA@@@@@AAAA@A@@@@A@@@A@@@AA@ABAA@@AB@AA@@B@@A@@@@@A@@@A@A@@@@@@BA@@@A@@@AAAB@@@@@@A@@AA@@@AAA@@@A@@@@AAA@@@@@A@@@ABABA@@@A@@@A@AA@@@@AAA@@A@A@A@@AA@@AA@@A@@AA@@@@@AA@@C@A@@@A@@@ABAAABA@@@@@A@@@@@A@A@@@A@@@@@A@@@A@@A@@A@@@@BA@A@@@AB@@@@@@A@A@@@@B@@C@@@A@A@@@AAA@@@@A@@A@@@@A@@A@@@@A@@@@A@@A@@@@@A@@A@@A@@A@@AA@@C@@@A@C@@AA@@@A@@@@A@@AAA@@@@AA@@@@@AA@@@AA@AB@@AAAAA@@@@AA@@@@A@AAA@@@AA@AC@@@AAA@@AAA@A@@@@@AA@@@@AA@@@ABA@@AA@AB@@AAA@@@@A@@A@AA@AA@A@@AAA@AAAAAAA@A@@AAA@@A@A@@@AAA@@@A@@A@@@@@@AA@A@@AA@@@AA@@@@A@@@A@@@A@@@A@@A@A@@@A@@@A@@A@@A@@@A@@A@@A@AA@@@A@AA@@A@@@@AA@@AAA@AA@AA@AA@@A@@@A@@A@A@AA@@C@ABACA@@@@@CAC@AA@@A@@A@@@@@@A@@@A@AB@@ABA@@@A@AA@@A@AA@@@ACAA@@@@@AAC@@AA@A@@A@A@CA@@CA@A@A@@@@B@@A@ABA@@@@@A@@@AB@@@@A@@BAB@@@@A@A@AB@@AB@@@@@@A@AA@@A@AB@@AB@@@@A@A@@@A@@@C@@@A@@BA@@A@@@@A@@@A@@@@@A@@AA@A@@@A@@@@@@AA@@@A@@@@A@A@@A@@AA@ACA@@@AA@@@@A@@@@A@@A@A@A@@AA@AA@@@@AEA@@@ACA@@@@@AA@@@AAA@@@@@AAAA@@A@@@A@@@@AA@AAA@AA@@@@AA@@AAA@CB@BA@CB@B@B@@A@@@@@@@AB@@AA@@C@A@@@CACAA@@@A@@@@@AC@AAAA@@@AD@@@BA@@B@B@@@BA@@B@B@@@B@@@BBB@@BB@@@B@@A@@B@@BB@@@B@@BB@B@@B@@@BB@@@B@@BB@@@@AB@@BB@@BB@@ABBB@BB@@B@B@@AB@@BB@B@@ABB@AB@BB@@BB@@B@@@B@B@@ABB@@@B@@@@@B@@BB@CB@@@BAB@@@BA@@BA@@@@B@@BB@@@@B@@BB@@@@@@BB@@BBB@@B@@@B@@B@@A@@BAB@B@@@@@@ABC@@BB@@B@@AB@@@B@@@B@@A@@@@B@B@@@BA@@@@@@B@@A@@AA@A@A@ABA@AA@@CB@@@A@@@@AA@@A@A@A@C@@@CB@@@B@@A@@@AB@@@AA@A@@@A@A@@@@@A@@@@@C@@@A@A@@@A@A@@@A@AA@AA@@@A@A@@@A@@@A@@@AA@@@AA@@AA@AA@@A@@@@@AA@BAA@BAA@@A@@@A@@@@BA@@BA@@B@B@@A@@@@B@B@@@@AB@@@BA@@@@AA@@AA@A@@@@@AA@AC@A@@@@@AAA@C@@@@@A@@@@@@@A@@BA@A@@@A@A@@@@@AB@B@@@@BB@@A@@@@BA@@@A@BB@B@@B@@@@B@@@B@B@D@@@BBB@@@BA@@B@@@@@@@B@@@B@@@@@BB@@B@@A@A@@@@@@@ABAAAB@@A@A@C@@@@@BB@@@@@B@@@@@B@B@B@@A@@@ABA@ABAB@B@B@@A@@@CB@@A@@@@@A@@@A@@BA@@@@@@BA@AB@@AB@@@BA@A@@@AB@@A@A@@@@BA@A@A@A@AAA@@@@@@@AB@@@@AD@@AB@BA@@BA@@@A@@@@B@BA@@D@B@@ABA@@@@@AB@B@B@B@@@@ABA@@B@@AB@@@B@BBD@@@B@@@BA@A@ABAB@@AB@BA@@@A@@BABA@ABABA@AB@BA@@@AB@@@@AD@@A@@@@B@@ADA@@B@B@@BBBB@@@BAB@@BB@@@BABA@@@@AA@@@A@@@@@A@@A@@@@AA@@A@C@A@@AA@@@A@@@A@A@@@A@A@@AA@@@A@A@@@@AAA@@AA@@AA@@A@AA@A@@@AABA@AA@AA@@A@@@@@A@@@AA@@A@AA@@B@@@@A@@@@AA@AAAA@@A@AAA@@AA@@@@AAB@A@@AAA@@BAB@AA@@@A@@@AA@CA@@AA@@@A@@C@@@AB@@AA@@AA@AA@A@@AA@A@ABEA@@@@@@A@@BAA@@@@C@@@A@@A@ACA@@A@CAA@@@AAAB@A@@@A@@@@@AA@@A@A@A@@@A@A@@@A@AA@@AAA@@AA@AC@AAA@@@AAA@A@AAA@EAC@@@ABA@@@@@A@@@ACAAC@@AA@AA@AAAABAAA@A@@A@A@@ACAAAA@@A@@ACAA@@@AAA@@@A@@CC@ABA@@@A@@@@BA@@DABA@A@CB@@ABE@A@A@@DE@A@@B@BA@@AA@A@@AA@@@AAA@A@A@@C@@@@@A@A@A@CAA@EA@@CA@@@@@@@A@@AAA@ACCC@A@@@A@@@A@@@A@A@@AA@A@@@AAAAC@@AAAAC@A@AA@A@AAAAAA@@A@@@AA@C@C@A@@BA@A@ABC@A@EB@@@BAB@B@@AB@BBB@B@@ABA@BB@@@BB@ABC@ABBBBFB@FH@BBBABC@@BC@@B@B@BA@AB@BA@BBCB@B@@BB@B@BB@@B@BD@BBDB@BBDF@DD@@BDB@B@@BABBB@BBBB@ABDB@BB@BBABAB@BBB@D@BBBDBABBD@BCH@@@BAB@@CBAB@@@@AB@@@B@DB@B@@B@DBB@D@BABCBA@A@AB@B@BA@CB@B@DAB@DBB@B@B@@@B@B@BB@@B@BB@BBABBDBD@@@B@BB@@B@@@@AB@@A@ABABABAB@@A@A@AB@BABA@@BABCBA@ABA@C@A@@BA@A@CB@@CBA@ABABCBADC@ABA@A@A@@AEGAAA@CAAAAAAA@@A@ACAAA@CBC@CBC@CCAAC@EB@BBB@@@B@B@B@@BBB@D@B@DFABCD@BAB@BCD@DC@CB@@@BBBBB@D@BBD@FDF@@@DDB@BBDBDDFDHDBBD@DEDADC@A@CBABA@ABABA@CD@@BBBB@BCB@BADAB@B@BA@BDABABBBBB"],encodeOffsets:[[112932,26590]]}},{type:"Feature",id:"450331",properties:{name:"荔浦县",cp:[110.395104,24.488342],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAAAABABAAC@ACA@AAABC@ADACCAA@@A@AAA@EG@A@@AC@@BBBB@@DCAA@A@A@AB@BA@ACB@ACAABA@AC@@@AAA@EAA@AA@AA@@AAABAACACCAAAC@ACA@@@@@AAAA@@ACBAAAAAAAAA@@A@@@@@@@@A@@@A@@B@@@BA@A@@AA@@AA@@@ABA@@B@@A@@B@@AA@@A@@AA@A@@@AAAA@@BA@A@@BA@BBABBBA@ABAB@BA@BB@B@@@@AB@@AA@DBDABBD@BABBBABC@@D@@AAA@CAC@@B@B@BC@ABAAA@C@ABA@A@ABA@BAAAA@A@@BC@C@AAA@C@ABA@@BA@@BCACBABA@A@ADAF@@@@ACAB@CEBCE@@AAA@@B@DBBBF@D@DAD@BBF@BBBABAACBA@C@ABCA@@A@ABBD@DDBGB@DA@@BBB@BBB@B@@@B@@@B@BA@@BABBBB@B@BAD@@@D@@B@B@BB@B@CBBDDB@DB@CB@DC@@@BBBF@D@B@DBB@@E@AC@AABE@@@A@CCEBACABAA@CCACA@@C@@BE@A@E@CAA@A@A@BABAA@A@@AA@AB@BAB@D@D@BD@BABBB@DA@BBBC@EDABA@CDCADC@C@CA@A@CDAAAC@AAAA@AABCCEAA@CA@CBA@ABA@@@EHEAA@@@@AAA@@A@C@AAA@@@A@@@@A@AAFB@ABBBA@@@AB@@ABB@ABBB@@BBA@BBA@@B@@B@BBCBDBB@@B@BBB@D@B@B@D@@CB@BC@A@ABCBAAA@@@C@@B@BBBAD@@@@@BDBABABEDB@B@@@B@B@BBBAB@DB@DBBBB@DBBDADFB@BDBB@BBBB@BD@BB@ABADABC@A@AAC@CBAC@BA@A@CBA@A@A@A@@@@@@@BFBBBBB@DBBBB@D@BD@@DBB@@BADADABCAA@ABBBAB@D@B@B@B@DAB@BB@ABDB@B@B@DBBABBB@DDBDBB@BB@DABBD@DCACAAAGAA@C@C@E@@BC@@ACAA@A@@AAA@ACAE@@ACAA@ACC@AAE@ABCB@BABCB@B@DCAABCAA@@AA@A@@CCAAA@A@@@AAA@ACABBA@@B@BB@@BAB@BC@ABA@ABA@@@C@@@CB@DA@@BA@@BA@ABA@@DA@AAA@CB@AC@@@ABE@C@AB@@@@B@BB@@@@ADA@AD@DA@@B@@@F@@@BB@DDB@@@@@B@@@@B@@@B@@B@@@B@@B@@@B@@BA@@BBBA@BB@@@@BB@@@@B@@@@@B@@B@B@@BBBBD@B@@@@BB@B@@@BA@BB@@@@@@@@@@@B@@@@@BAB@B@@@B@@AB@@@B@B@@@B@B@@@BB@@B@@B@@B@@DB@@@B@@@@B@@@@BA@@@@BA@@B@@ABAB@@@BBBB@@B@@@BB@@@B@@B@@AB@BAF@@@@BA@@BB@@@B@BB@@@BB@@A@@@@B@@@BB@@B@DA@AD@BA@@@A@@@A@A@@BD@@BA@AB@B@BDBB@DAB@D@@@CB@@F@B@B@@BC@@DDBB@@B@BF@BAD@DBB@BDBDB@B@D@BDB@D@@BDB@DBBD@@BB@BFB@DD@D@BAB@D@BA@CBBBBBAF@BC@@BA@AB@B@BABABDH@BA@AD@DAB@B@D@B@DBBBDAB@BBDA@BDEB@DAB@DA@AD@@CD@@@BBB@BD@CF@DB@@DAB@@@B@BEBCB@F@DBFDBFBH@DCJDDDF@BB@@B@@BBDABBDA@ABDBA@AB@@AB@B@@@B@B@BAD@@A@AD@B@@AB@@G@@@@@AA@@A@@AAA@AC@A@@AA@@A@AE@@@A@CAA@ABAB@@@@@@@B@@A@@B@@@B@@AAA@@BAB@@GA@@@@@@A@@A@@@@AB@BA@@BAB@@ABB@@B@@@@@@BB@@B@AD@@@B@BBB@D@BBB@B@BAB@@@B@@ABAB@@AB@@@BAB@B@B@@@@@B@@A@@B@B@@@BAD@@@B@@@B@B@ACB@B@@@B@B@B@@A@@B@@B@ABBBA@@BBHB@D@@@B@DF@B@@BDB@@@B@BA@@BAB@@@B@B@B@DF@@BB@@BBABAB@BA@@B@@@B@@@BB@@B@@@@AB@@BB@@B@AB@@@BBB@@A@@B@@BBABAB@@@B@@@B@@AB@B@B@@BB@BB@@B@D@B@@@@BB@B@@BB@B@@B@@@@B@@@BBA@@@ABB@@BBDB@@B@@B@B@@BBBB@BB@B@BA@@BA@@@AB@DB@@B@B@B@@DB@@B@@@BB@B@D@B@@B@@@@B@DBB@BB@AB@B@B@B@@@B@B@@@D@@BB@@BDBBB@BB@BBB@@BDB@BBB@B@@@B@B@@AB@@@BADA@ABA@@B@BDBBBBB@@B@BAB@@@B@@ADA@AD@@@@@BA@@@AB@B@BA@@BAB@BA@@B@DBBBB@DBB@B@D@@@B@B@D@B@@@B@@@BCBA@AB@B@BABBB@B@HG@CB@B@BCB@DBB@B@D@BBDBDBD@B@D@BA@AB@@@@A@@@@A@@BA@@@BA@@AABA@@A@@@@@@@@AD@@@@BB@D@BAACAABAB@B@BB@@B@BBBBB@B@BDB@@A@ABA@CDC@@BC@@AA@AFABAB@B@B@AE@AACDA@A@@F@@BB@@@AC@@@AB@@BB@@ABBA@@B@@@B@B@@@@@DB@@@ADB@B@DBBABA@ABB@ADCBGBA@AB@@DDAB@@ADA@@@A@AB@@AF@FAFDB@DBF@@B@HB@B@DB@DB@B@BAFADCD@F@FCDCBCBADBLDBADABBBAD@DCB@B@FCBE@@BC@CBABADIACBA@CAA@AB@@EACACAAAG@EBCAAABEE@CDABAD@@@B@BA@@BBBAB@B@@@B@BAB@B@@@B@@@B@@DB@@@@@BB@@@@BBB@@B@D@BB@BB@A@AB@@@@@@AB@@ABA@@@A@@B@@@BA@@BABA@@DJDBHCJEJEB@FCLHBCLAF@@AD@B@KJ@DJHBA@B@@BB@@BB@@BAB@@@@CB@@A@@@AB@@AB@@AB@@@BAB@B@BAB@BAB@@@B@@BBABBBAB@@@AA@A@ABABABA@AB@B@B@BAB@@@BA@@D@B@BAD@B@@@BB@BBBD@B@B@B@D@@BB@@B@BBBCBAB@FB@B@B@D@@ABAB@@@BA@ABAB@@@B@BC@@B@BABAB@B@BAB@B@@@B@BA@AB@@CB@BD@BB@BDD@B@B@BBBB@BB@B@BB@@BBB@BA@@B@@@DDBA@@B@@@B@@@BA@@@@@@B@BA@@@@B@B@@@@@@@@A@@@ABAB@@@@CB@@@BA@A@@BA@A@A@@@@@AA@A@@A@ABA@@@A@@D@B@B@DABBB@@AB@B@B@B@B@@@@ABABBB@BABABCFEJB@@BAHDB@BAFBBEFCB@DE@@FBFDB@BBBBB@@@B@@@@A@A@@AAAAA@B@@ABA@A@@AC@@AAB@@ABA@AB@@A@@AA@A@@AA@C@@C@AA@@@@B@@ABAB@@@@A@A@A@AA@@A@@@@CBA@ABA@CAAAA@CE@AA@@@BCBA@@BE@AB@@ABC@A@@AAA@@A@@@@AAA@@@@@A@A@A@AA@@AA@@@@@A@@@@@AA@A@@@@A@AAA@A@A@ABA@CBA@@B@B@@@BA@A@@B@B@B@@AD@BA@@@A@@@A@AA@BAA@AAA@CCAECABAACAAAC@AAACA@CB@BADADC@@DCBBDABBDA@AFA@@B@@@@@BABABAB@D@BCCADABA@CE@A@CAC@BADCD@@AAGB@CCBABA@A@A@CD@EG@@DEACBAB@BADC@@@@AA@ACCAA@A@@BA@@@@BAAABCD@@A@AAAAA@A@AAA@ACABC@AD@B@DC@CDCBABADCC@BC@ABIBA@C@EAC@AAABAAC@EAABAAC@A@C@AA@@A@@@@@@@@B@BAD@B@B@B@B@BBB@BA@@@A@@B@B@@@B@@@B@@@B@B@@@DBB@BB@A@@@A@@BA@@B@B@@@B@@BBD@B@@@BDDBB@@@B@@B@@@@@DA@ABBBABABCB@@A@ABA@A@@D@BAB@HEBBB@@BB@D@@@BCB@B@B@B@@AB@D@B@B@BBB@B@B@B@@ABA@@@ABE@A@AB@D@BAB@@AB@@AB@@A@@ABAA@B@@C@AACAB@@AB@AA@@@AAABC@@AA@@@A@@BC@A@CA@AA@A@A@ABAB@BAB@BAB@BABA@A@AAAA@AAAA@CAA@ECGBCAA@CACBEBA@E@C@A@AAC@AD@DBBBD@@BB@DBDCBA@AH@BDBAHCD@HBDAB@B@DAHA@@@CAADC@E@ABA@A@A@ABC@C@C@ABA@A@CAA@@CBA@AB@AAAA@EBA@ABCACB@BADCB@BABA@@BED@B@DABCAC@AAC@CBEBC@@@C@ABAB@DABA@ABCBEBCD@DAD@@ABCFCHAB@D@@@DAB@DADCBA@CDCBABGBA@ABCBA@A@CBABCBA@ACE@@CCB@ACBA@A@C@A@@A@ACBADA@A@@@CBA@A@CABA@A@@@CAAAE@ABAACC@@C@@@A@AAA@@AAB@@ABA@CAAACAAA@@A@A@ADCA@ABAAAAA@@A@AA@A@CAABCAA@AAGB@@E@EHEAA@GBCJCF@BAFBABHDBABBDAJEDBB@D@D@FBD@HCACBAAABACA@GACAC@AABG@ABCA@CBA@@@AICA@ACABA@CAACAAAEC@AACCC@AA@AA@A@A@CD@AEACCBCBCACAAAA@A@A@@CBABA@CACCA@ACEB@BAAABA@AC@@CCBAACDA@ACCB@BIBCBA@@HC@ABC@CFDFCDC@C@ABA@CAA@A@A@@A@@@AAAB@@AAA@@@@AAAA@@AA@A@@A@@AA@BA@@@A@ABA@@@@BCB@BA@@@@BB@BBB@B@BB@@AB@BA@AB@B@B@@ABA@CB@@A@@@ABABAA@@A@ACAA@A@C@ABEAC@C@BE@@BABABA@@BA@A@@@A@CA@AAA@@A@A@AA@C@@BAAABAA@B@@@CAABA@C@A@@A@A@AAABA@@@C@@@A@@BA@@BA@@B@@@B@@@@A@A@@BA@@@@B@@CBABB@@@@@BA@@@@@BAB@B@@A@@@@@@@B@@@@B@@A@@@@@@B@@B@@@@B@@A@@@@BB@@@@@@@@@EBABA@@@A@@@@@@@A@@@@B@@@@A@A@A@@@@@@@@BB@@@A@@@@@@@@@A@@@@@@A@@@@@AA@@BA@@@@@A@A@@@@@@BB@BBB@@A@@@B@@@@@@@@B@@@@@@@A@@@@B@@@@@AA@@@@@AA@@A@@@@@@@@BBB@@B@@@A@@@@@@B@@A@@@@A@A@@@AA@@@@B@@@@@@@B@B@@AA@B@BB@@@BB@B@@@@@B@AAA@@@@@@@AA@@@@A@@@AA@@B@@@B@@BB@@@BCBABAAABA@A@@@A@@@A@AA@@B@@ECA@@A@C@ACA@A@AAAA@CA@AACCC@AC@A@A@@GBDBEAC@ABCB@@A@@BABBBA@ABAA@DC@AACAAAA@"],encodeOffsets:[[113034,24911]]}},{type:"Feature",id:"450330",properties:{name:"平乐县",cp:[110.643305,24.633362],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@@CA@@ABA@A@@@AB@BA@@@A@@@A@A@AA@C@@BA@@AC@@@A@@@AA@@AB@AA@A@ABC@A@@AA@@@AA@@BAB@@A@AB@DAB@DA@@@@@A@@AACAA@AA@@A@A@A@@@A@C@A@CA@@@@@ACEBAAA@@BA@A@A@@@@@A@A@@AAAACA@A@A@@ACA@@@@AA@A@@AB@@@ACA@@AA@@AAC@AA@A@AA@@@A@@A@@@@CEA@@A@@A@ACA@@BAA@AA@@A@AA@@AA@BA@@@@DA@@B@B@@ABAF@DA@@B@FAB@@BBBD@@BDAB@BA@@@@B@AABBBAB@@BD@B@B@BDB@@@@@B@@A@@BA@@BA@CBA@@BAB@@@B@B@@@B@D@B@B@B@@@B@BAB@@BB@BB@BB@B@@B@@B@B@@@@A@A@@B@@A@@@A@ABA@AD@BADA@A@A@AB@@AB@@@@AB@@C@@BAAA@@BA@@@@B@B@B@BA@ABA@B@@@@B@B@@B@B@ABABA@@@@BB@@@B@@BB@@AB@@@@@@B@B@B@@@B@@@B@@@@@B@@@B@@@@@@A@BB@@@@@@AB@@@@BBAB@@B@@@B@@@@BA@@B@B@@CB@B@@A@A@@BB@B@A@@B@@BBBB@@@@A@@@A@@@@@@BAB@@@@@@B@@@@B@@BB@BB@@@BAB@B@@@@B@BB@A@A@AB@@@B@BA@@BB@BB@@D@@@@B@@@@@BABAA@@@BC@ABA@AB@B@@@B@B@B@@@B@@B@@@B@@@B@@BA@@@@BA@@@@BABAB@D@D@B@@@B@@@@@@CD@@A@@@ABA@@BAFA@A@@@@@A@@@AA@@@@AA@@A@@BABAB@B@@A@@B@@AB@@@@@@AA@@@@A@@@AA@@AA@@@@@@@A@A@@A@ABAA@BC@@@@@@AA@@@A@@@@@A@@@@@@@A@@A@@A@@@A@@AA@A@@AA@@@B@AA@@@@A@A@@@A@@@@AA@@@@@AA@@@@@@A@@@A@A@@@@@A@@@@@A@@@@AA@@@@AAA@AAAA@@A@AAAA@@@AA@@A@@AAA@@@A@AAA@A@@@A@@@@AA@@@AA@ABAA@@@A@@@AA@@A@@AA@@@AB@@@@AA@@@AAAA@@@@A@@@A@@A@@AA@@AAA@@@@A@@@ABA@@@@BA@AA@@A@A@@AABA@@CABA@@DE@@BA@@BA@AAA@@BABA@@@C@@@@@AAA@A@@BA@@@AB@@ABA@C@@@ABA@@BA@AA@@@A@A@AAAA@@A@A@@AA@@@A@AA@@A@@AA@AAA@@A@@AAA@AB@@A@CBC@A@A@ABA@@@@@A@A@ABAB@@A@ABAD@@@@@@AA@BA@@B@@@B@@@B@D@@ABAB@B@@G@A@A@E@@@E@A@C@CAA@A@A@CAACBA@AFABA@@AACBA@@@A@CD@@@@A@C@ABA@ABC@@@@@AB@@E@@BABA@ABA@AB@B@@@@AB@@A@CBA@AB@@BB@@@B@BAB@@C@@BAAA@@A@@A@@@A@ABA@A@AB@@ABC@@BA@A@AAABAA@@A@@B@@@@BB@D@BAB@B@D@DAD@B@B@BAB@B@FCDBB@D@@GBCBA@A@CBGAC@GDABACG@@BABCDCAA@@AC@AACAC@@BBD@B@B@D@FABAFBD@DBBADDH@FBB@DBBBBB@BB@B@BABABA@ABA@ABA@AB@B@B@BBBB@@D@BAD@@@B@@BB@@ADBB@B@@BBA@@BA@DBBBD@@@@ABBBA@@@BA@@BA@@BA@ABC@A@@B@BAF@B@@AB@BA@A@A@A@AAA@A@C@A@@BA@A@A@A@AD@@C@A@@AA@AAGFA@ABC@@@@BAB@B@BA@ADABABAA@BCB@@@@A@@@@A@@AACC@A@@@AAC@AA@@@A@A@@@AB@@@B@@@BAAA@CA@@A@A@@@A@@@A@@@A@A@@@@B@@ABA@AAA@A@A@A@C@ABA@@@@@@@@@@BB@@B@D@BBDABBB@FBDABBB@BBD@F@DABAJ@BADD@CDABABCD@DCDA@C@@BADDB@BBB@B@BBBBB@B@BC@ADBBAB@@@@AB@@@BBBDD@BBB@@@@CDABA@ABBDCF@@FHC@@D@B@BABABDDA@BH@BC@CDABD@DBB@F@@DABCBDBADC@A@ABABAB@@@@A@@@EB@BCBAACBAACD@@CDCBABA@@DDBBB@BBDBBBDABDBBFDDB@BBB@ABB@@B@B@@@B@@ABC@@BA@A@A@@@@BAB@@A@A@@@AB@DAB@B@B@BBB@B@B@@B@B@@B@@@@@B@@@@BB@@BBB@B@B@@@@@B@BB@@@@@BB@BB@@@BAD@BA@@BAF@@ABAD@@B@@BDFB@BBDBB@BAB@DA@@@@@BB@@B@B@B@B@@A@AB@BA@@@@@BBD@@@@DBB@@@BBB@@@BA@@BAB@BA@BB@@BD@@@BAB@BA@B@BBBB@@@B@B@@@@DDFADDB@@ABBB@FBBBF@DDDDFDBDBDABDDD@@@DFFFFB@B@D@@GBE@@CA@E@@D@@@BA@@BAB@BADB@@BCD@@@@@D@B@F@D@BDD@B@D@BAHEH@DBFCD@BGDCBABA@B@ABABCBABBD@B@T@DCHCFAB@@D@DBBCBA@BB@BBB@DAB@B@@BABABD@DBB@B@@H@B@D@@BBFBBBD@B@@BDID@BA@@DBBB@@B@BD@BF@D@DABAB@@AB@DBBBB@D@D@@@@@@B@@ABBBA@@B@BB@B@D@B@@BBB@B@BBDBBBAF@@@BB@BHBBBBB@@B@@@BBFDDDB@@BBB@D@B@@B@@@@B@@@B@@@B@B@@@@@B@@@B@@@@ABB@ABCB@B@@CBC@CAA@CAABA@GBA@@@AB@B@BE@@B@@@DABC@AD@@BDB@BBB@B@BCDAB@D@@@FBFBBDDB@@DBBBBB@BADCB@BA@@BAB@@B@@@B@@@CD@@@@BB@@A@@@A@BB@@@B@@@@@@A@AD@@AB@@@BBDA@@@@B@@B@@@@B@@@@B@@@@@@@B@@@@BBB@B@@BFBB@@@A@@B@BA@@@DBB@B@B@@BB@@@B@@BB@@AB@@@@@@@BCDBD@@@BA@AB@@@BAB@@@@@@@BA@@B@@@DAB@@@D@BABBBBB@BDDBDA@AFDBDB@BB@@B@@@B@@@D@@D@D@B@B@DA@@B@B@B@B@@@@A@@@@B@B@@@@@@AB@@A@@B@@@@B@B@AB@@@@@B@@D@@BD@D@BAF@@AD@@@B@@AB@D@@AB@B@D@DBBA@@D@B@@B@@@@B@B@BDC@@DAB@BABA@AB@DA@@B@BAB@@A@@B@@@@@@@DAD@BABAFAD@@ABAB@B@BA@@@ABCB@@AD@@@D@B@BABA@A@@@@B@@@@ABABG@@@AB@BAB@DABADCBCDAH@DCD@DAD@FCBA@AB@@A@CAAB@@A@@AA@ADGB@JDBBD@HE@AB@BABAB@BBBBBB@DBBBDB@DAB@@ADADBBA@@BA@C@C@AC@A@@AA@A@@@A@EA@A@@BCBAB@@C@AAA@CBACA@C@A@@FABABA@ACAA@@ADEA@CAA@@CA@@ABABABA@BBABADAB@@@DA@@B@D@DC@@@@@@AAAAEOA@@ABAB@B@DAD@B@BBD@B@B@BC@@BA@@@@@A@@@@BAA@BA@@BADCFB@@@B@B@@@@BAB@@@@C@@BABA@A@A@@D@BMAC@AAABCBAD@HDDBBDBDFLBBBB@BBBA@@@B@@@@@@@B@@@@@BB@@B@@@@@@A@A@@@A@@@@@@B@@@B@@@@@@AAA@@BA@@@@B@BAB@B@B@@@B@@@B@@AA@BAA@@@A@AB@@@A@ABA@@@@B@B@@@B@@A@@@@@@B@B@@@B@B@@A@@@@BA@@@@@A@AB@@@@AA@@A@@AAA@@ABC@@@@B@@A@@BAAA@AA@@A@@@A@@C@@AB@@@@AB@@@@@@AB@BA@@B@@ABAB@B@B@B@@A@@@A@@B@BABC@A@A@@B@@@@A@A@@DAB@@A@@@A@@@A@AA@BA@ACBAA@A@C@A@ABADADCBCDADA@C@ABE@@B@B@BAFCBA@AD@@ABA@@@@@EB@B@@@B@@@BAB@BA@A@AAC@AB@B@B@B@BABA@A@AAA@CA@@@A@@AAA@ABAB@@CBA@C@AB@@C@@@ABA@EBCFEDAB@BAB@@@@AA@C@AC@@@ABA@ABAB@D@BAB@B@B@B@B@D@B@B@B@B@@CDA@ABABBBADK@ABCBA@A@C@@@@FC@@B@BABBB@B@@@D@D@B@D@@AD@HBFBBBFDB@BAD@@@@@BADGDE@CBC@AHCDEBA@CBA@EFAFAB@@@BBFA@B@D@BJBJBBDJBD@HBB@@@BA@A@AAA@@@@@A@C@A@A@@B@B@@AB@@@@@B@@AB@@@B@B@@@DABB@AB@BBBA@@B@@@@@B@B@@A@@@@@AB@BA@@@@@A@@AA@A@@A@@ACA@@@@A@@A@@AA@@@AA@@A@@A@@AAA@A@@A@@@A@CBA@@A@@@AA@AA@@A@@A@@A@@A@@A@AA@@@@@A@@@A@@@AB@AA@AA@ECA@CA@A@C@CACFABCFAAE@ADADABEDACA@ABC@CD@B@B@DAFABAHABBFABA@ADAFC@@B@@ACA@A@A@@@ABAAA@@@CA@@A@@BA@A@A@ABAAABA@@BA@A@ABA@A@@AAB@ACB@@@DABA@@@ABA@@B@@AD@@C@@B@@@D@D@@@@AD@DEB@BA@A@@AA@@A@@A@@B@@@B@@@@A@@@@@A@@D@BAB@@@BA@@@@@@@@BE@A@AB@@ABAB@@A@@@@D@B@@AB@@@@@@CACAAC@C@@@AAABCD@@A@AA@@BC@@@ACA@@@A@@@AA@A@@@A@@B@@A@E@@AA@BA@CCABA@@BAD@BC@@@CB@DAB@@@B@@C@A@CBA@A@@BCBAF@@CAAC@BC@AE@A@BABCDC@@AA@ABA@AAAC@AB@BG@@A@@AA@@@AAAA@C@AAA@ABADC@@B@@@AA@@AC@@@@AAA@AAA@@@@A@@A@@@A@@AC@A@A@ABA@@@@BAAA@@BAAA@@@@B@DBB@@A@@BA@@@A@@@C@A@AAADA@AB@@A@@@@AAAAAACC@AAAAAA@@@@A@@AAA@@A@A@@AA@@@@AA@@@@AAAAA@@A@@@@CA@@AA@@@@AA@A@@@AAAE@AACA@CAAAABA@@AA@A@@@AAAAA@A@ABAAA@A@AA@@CAA@@@A@A@AAC@@AAA@A@A@A@@AB@ACC@AAEAGBGDC@GFCA@ACAA@CA@ABCFAB@BCBA@@BA@@BAB@@ABAFA@@F@BABBFAD@@ABADCB@HAB@FAH@B@B@BABB@@DA@@B@D@@@B@BB@AB@B@@@D@B@@@BB@@@@@AB@BAB@BA@@B@@@@A@@@A@@BA@B@@B@@@@@BC@@@@@AB@@@AA@AA@@A@@@@@@@A@C@@BC@@BA@AAA@A@C@@AAABAA@@@@AAA@A@C@AB@@A@@@A@@AAAA@@A@A@A@@AC@A@C@@@A@@A@@A@@@AA@@@@A@@AA@CAAAB@@@C@@C@@CAC@@C@ABCAA@AA@@@@ABA@C@A@@AA@@AA@CA@@AAAA@@CC@@@AA@AAA@@@@A@AA@AAA@@A@AAA@@A@A@@@A@@BCB@@AACB@@AAA@A@@B@@A@AB@@A@@@@BA@@@AB@@@AA@@@A@@B@D@@AB@@BBADAB@@@B@B@B@@@BBB@BBB@B@B@@@BA@@@@B@@@DA@@B@B@@A@@B@@@BA@@B@@AB@@@D@@ABA@@B@BA@@BB@@B@@@B@@A@@@CB@@A@A@@BABA@@@AB@B@@@@@@@@A@@A@A@@@A@AAA@AAB@@A@@@AAA@@A@A@@CC@AAAAA@@AA@ACC"],encodeOffsets:[[113595,24905]]}},{type:"Feature",id:"450332",properties:{name:"恭城瑶族自治县",cp:[110.828409,24.831682],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BA@A@@@A@A@@@@@@@@BA@@@A@A@@@@@AB@B@B@@BAB@@@B@ABB@@BA@@@A@@@A@A@A@ABA@@@@@AB@@BB@B@@@@A@@@A@@@@@@@@B@@@B@B@@@@A@@@AA@@@@A@@@@@@@A@@@B@AA@AAAAAEKACACCAGCC@ABADBB@BBDANC@@@@B@BABAB@@@D@@A@AB@@@@@A@A@@EACDAB@@ABB@AB@@@@@B@@@@AB@@ADA@A@C@AAA@C@CBA@A@AB@BB@FPBBBB@@@@@@CDC@A@@@CB@@A@CBABAB@AABABAB@BB@@DB@DBB@CF@BB@DB@BABABEB@@@B@DDBAB@DBB@B@DA@ABAD@@@BFBB@@@B@B@@BB@D@@B@D@DAB@@ABCACB@BA@CBA@ACAA@CAAAAAAA@ABABA@@BGFC@AAICA@CH@BBB@@@BA@BB@D@BA@@BABEDC@CBC@CDG@CBADCDABCBA@ABA@@B@@AHAB@B@@A@@@@@@BABABA@C@@@C@@BA@AD@B@@ABA@A@AB@BC@EBABABC@CB@@@@@@A@@@@BA@ABA@@@CBA@@BABABA@CB@@CD@BBB@@AB@B@@@B@@BB@B@@AB@BB@@BABABABBB@@@BAB@@@@@B@@@B@@A@AB@@A@@@A@CBA@@B@@@DAB@B@@@B@@@BABAB@@@@@B@@@@@@@B@BA@@@@B@@@B@B@BA@@BAB@BBB@@@B@@@@ABAB@BA@@@AB@@@@ABC@@BABA@EBCB@@AB@B@DD@C@@@@D@BCFABBD@HAD@BAHDDABBFAB@DGHGB@BAD@DCDBF@F@FFJB@@BA@@BA@GDC@@DA@@D@B@H@D@FCAADBDADBBFD@FBBKFEFCFEHBFAFAFCJCH@DC@@BBBBFDHDHBFD@DBF@DBBADCDAHAF@DB@D@FBDDD@BBDDD@B@@@DAJDJCHCBADAB@FA@BBAB@BBB@@B@@B@@@@@D@@AB@B@@B@@BBB@B@@B@@BBABB@@@@DBB@BDB@B@@@B@B@@@B@B@B@@@@@DBB@@@D@@@BA@@B@@@B@BA@@@@BAB@@A@@B@B@B@B@@A@@@A@ABAFBDA@@BABA@@@@@@@A@@B@@@@@B@@A@@B@B@@@@BB@@BD@BBB@B@B@B@@@B@D@B@@@B@@@@@@@B@B@BA@BB@@@@@@A@@B@@@BBA@B@@@@B@@B@@BB@@@B@B@@@BBB@B@@@@BB@ABB@B@@BB@BBBBB@BBB@@@@B@@B@B@@A@@B@@A@@B@@@B@B@@@BAB@@@@A@@B@@@B@@@@@@B@@BB@B@@@@BBB@B@@AB@@A@A@@B@@A@@@A@@@@@@@@AA@A@@@@BA@@@@@A@@B@BA@A@@@A@@@@@A@@@@@C@@@@@@BA@@@@@A@@BA@@BAB@@AB@@A@@@@@BAHBD@@@B@B@@B@B@@@BAB@@@BB@@B@BB@@B@B@DA@A@@B@@@B@@@BABABAB@@AB@BAB@@@BABA@@@@B@@A@@@@@@@A@@BA@@@@BABBD@@@@@@BB@@B@@B@BBDBB@BFD@B@@BB@B@B@@@B@B@@@DABB@@@@BB@B@B@@@B@BB@A@@B@@A@AD@@AB@@AB@B@@AB@B@@@@A@CB@@A@@BCB@@AD@@ABA@ABADA@BD@@@@ABABAB@@@@@@ADA@@B@@AB@@@D@B@B@@@@@B@@AB@@A@A@@@@B@@A@@@A@@A@@A@@@@BCD@@A@ABAB@@AB@@A@@@@BA@ABA@@BA@@B@B@@@B@@@@C@A@A@AB@@@@A@AB@@A@A@A@ABABABA@AA@@AB@@ABA@@BA@@@@BA@@B@@ABA@A@@@@@BB@BB@@B@B@@@BA@@@@@@B@@BB@@@@@B@B@@@B@@@B@@@@@BB@BB@@@@@@@B@@@@@@@B@BAD@B@@@@B@@@@B@@@BA@@@@B@@@B@@A@@@@@@@@B@B@B@@@B@@BB@@@@B@BBB@@BB@A@@BAB@@@@A@@@@@@BA@B@@B@BB@B@@@@@B@@@@B@@@@@@@B@B@@@BA@@B@@B@B@B@B@B@@@@@B@BB@@B@BA@AB@BA@@B@B@@AB@BA@@@@@A@@@A@A@@@@@A@@@@B@B@BB@@@@H@B@BBBA@@@@BABA@@@@@A@@@A@@BADC@AB@@ABAD@BBB@BAB@@@B@@@BA@@@@F@BB@@@@BA@@BA@@BB@@B@BBFBB@BBB@@AB@@@@ADA@@BA@@@AA@A@@A@@@@B@@@BAB@DE@A@@BA@@@A@@BABA@A@@B@@A@A@A@@@A@@B@@@@@DB@@B@@@B@B@BBDBB@B@@@BD@@DAH@BBB@D@B@D@B@DBB@@BB@@@B@@AB@B@B@@ADAB@@@B@@@BBBBBBBBBBB@BB@BBB@@@@B@BBBBB@@@B@B@@@@BBD@BB@@BAB@DB@@@A@@B@@@B@B@@@B@@@B@DBBB@@@B@@B@@@B@@@B@BB@@BBBBB@B@@BB@@@@BA@@@@B@@@BB@@D@@BB@@@B@BB@@@@@BBB@@B@@@@BBB@@BB@@@@@DB@@BB@BB@@B@@@@BB@@BB@DB@@BDB@BBB@B@B@@BB@@@@@B@@@B@@@B@@@@@@@B@B@@BB@BBD@@@BBD@B@D@D@B@BA@AB@@@B@@A@@BBB@BB@@B@@@BBD@@BBABBB@BBB@BAFBBB@@B@D@DBD@@@B@@@@@CE@AB@AACAACCC@C@A@EDA@AB@@@@@@@D@FA@A@@BADABBB@B@B@B@B@DBDB@@DDB@BB@BBBB@BBB@@BFF@B@@D@DBBBBBFBB@B@D@B@@@BB@AB@B@B@@AB@B@D@@@FABB@AB@@@@AB@@@D@@@@BB@@@B@@@@@BB@@D@B@BBB@B@@BBBB@B@BBD@BBB@BBD@B@B@BA@@D@B@B@@B@AB@@@B@D@@A@@BABB@B@@DBB@AD@B@B@@@BB@@BBB@@BBB@BBBB@BBBBD@@B@DBBA@@D@D@BBB@B@B@D@@@B@B@B@@BB@@@@BB@BB@@BB@B@@B@DA@BFAB@B@@@B@BAB@BB@@BBBBB@B@@BBBBA@B@@B@BB@@@BB@B@@@BB@@B@@@B@@@BB@@@@@@D@@@@@B@B@@@B@B@@@B@B@BA@@B@@@B@@A@B@@BA@@B@@@@@BB@@@@DB@B@@AB@@BB@@BBB@AB@@@BDD@@@D@@BB@@B@A@BBB@@B@@BA@@@B@@B@BB@@@BB@DDB@@@@BB@@@@@FA@GN]BG@GAG@CNUBC@G@EEECG@EBEAEAAEEKCOCEACCGCECGACAACGGECAEACA@AAOBE@GACEAGAACAA@IBI@ECGC[KWGCECA@ABGDE@CACCCE@ACCEMU@AACACAA@C@CBA@AAEGIAA@EBCHCBC@A@@EIAAMKKMKK@ABAFAB@@CCEEGAAGKEKAKAG@CHQ@ADMACAE@C@ADKBCBKBC\\WBA@ABCAICEGEAABA@ADEDANAHAD@FEFE@A@A@CEKCO@GBIB@@A@AB@@@H@B@BAAAEK@@K@A@A@@@EGCG@A@@@AB@D@B@DDJFBFDBBBBB@@FD@@B@@@BAHIH@D@HBBBFJBDJBPBB@DAJCNAJ@JFNFTFL@HDJDDBHAH@BCAA@A@AB@BEB@@ABC@AFEBA@A@CBABC@ABEB@DCBEB@B@BABA@AB@@@BC@A@CBA@AFED@BAB@FC@@B@BAB@FBB@BB@AB@D@DBBB@@BBBBBBBB@BB@@DB@B@@@FDHCFAD@JDLABA@@AA@@@@AA@@AA@@@ABA@A@@BA@A@A@@BA@A@@@ABA@A@A@@BA@@@A@@BA@A@@@@BAB@@@@A@@BA@@@@@A@@@ABAA@@A@@@AB@@@@A@AAA@@AAB@@AAA@@@@@A@A@@AA@@AA@@BA@AAA@@B@@A@@@A@@@@@@@A@A@@AA@@AA@@@AA@@A@A@@@AA@@A@@@@@A@@@AA@@ABAEA@@A@AA@@@A@CA@@A@@AA@@@A@AA@@A@@@A@@AABA@@@A@@@A@@@A@AA@@@@A@@@AB@A@BAA@@AB@@@@AA@@@@@@A@@@@@A@ADEBCDCBCBABADB@E@A@CBADE@EBG@AAA@AA@@AAAACBA@C@@@EA@BABA@AB@@AAEBC@@BABA@A@AAC@@CA@@A@A@@@CAEBCBIDC@AB@@A@AC@ACA@A@CAA@C@AA@CCCE@CECAACA@EAA@CA@BAAA@AACAGKE@AAA@AA@BCGDCBCCCBABAAA@GBCACCE@A@CAACAC@EBA@ABCDABCAEDCAIC@A@A@CBCAEAC@CBA@ABCB@@@AA@@AA@@@BA@A@@A@@@A@@A@@@A@@AA@CA@@@@A@AA@@@@A@A@@@@A@@@AA@@A@@@A@AA@@@@A@@@A@@@@AAA@AA@@@@A@@A@@ABA@@B@@@@A@@@AA@CCA@@CE@@A@@AAAA@AA@A@A@AAA@AAC@@@CA@@@@CCA@A@A@@BA@@@A@@DGBCBA@A@@@EC@@CBA@A@CCE@A@@@AA@@AC@@AA@AAA@@AAAABABEB@@AA@AA@ACC@@BA@CG@@@AC@E@A@@AACCBE@AA@CAA@EB@GAE@A@CCAAAA@A@AAEBA@A@CAA@GB@A@@@A@A@ABAACBA@@A@GAC@IAACIAIA@A@C@AEBAA@@A@EBEB@FAB@DABCFGD@BAD@DCFCHAB@@@@C@ABA@ECAAEAGAC@@BC@A@C@C@@@A@A@AAABA@@@ED@@@@@D@BABAD@BCLABAAAB@BCB@DA@A@A@A@C@A@A@A@A@A@ABC@A@AB@BAB@B@@BDD@B@@B@@A@ABA@CBEFAD@FAB@B@@@DA@@B@DAB@DA@AB@BBB@BB@@@B@@DBB@B@BABABA@A@A@A@@BBD@B@BABA@AB@@A@@@A@A@@F@@@@AB@BC@@BABEDABA@A@@@AF@B@DCBCBADCDCBAB@B@B@D@BBBDA@BABB@@B@B@@@B@@@BA@CB@@@B@B@@A@@@@B@BADABA@@@@B@@@BA@A@A@A@AB@BA@@@ABA@@B@@@@A@@B@@A@@BD@@@@B@@@BB@@BBBAB@@@BA@@@@@AD@BB@BB@@@BB@@B@@A@@B@B@@@@AB@@@@"],encodeOffsets:[[113501,25360]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,5 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("河池",{type:"FeatureCollection",features:[{type:"Feature",id:"451202",properties:{name:"金城江区",cp:[108.037276,24.689703],childNum:1},geometry:{type:"Polygon",coordinates:["@@CGACC@E@CAAACE@CCCCBCA@CACAABA@A@AAA@C@AB@@@DEBADBB@BAB@BC@ACACAABCCCA@E@@BCB@@CC@CAAA@@@@A@@@CB@@@B@B@@@B@@A@@B@@@AA@CAA@@@A@@@A@CC@@AAA@@AA@A@A@@@CAAAA@A@@AA@@A@AA@@AAB@@C@@AAA@A@@C@AB@AA@CB@BA@@B@@@B@@AB@@@@@BBB@B@B@@@@@BA@@B@@ADAB@BA@@@@B@B@@@BB@@B@@A@CB@@AAC@@@ABA@AB@@A@A@AB@@@B@BB@@BA@@@C@@@@B@@@B@B@@@B@@AB@@DD@@@B@@@B@@@BAB@B@B@@@@AB@B@@A@B@@BB@@D@@AD@@A@AB@@@@CABA@A@@CCC@@@AACAA@C@@@ABA@CA@@@AAC@@ABCB@@@AA@@AA@@@AB@@C@A@A@A@C@A@A@A@CDCDADA@ADAD@@BB@@BB@@@B@@BB@BBB@@@BB@B@B@@B@B@DB@@B@B@@@BAB@@DH@@A@AB@@A@@B@D@B@@@BBB@BAB@@@B@@AB@@@DAB@BA@@@A@A@A@@@@@AB@@@@BBB@@B@@AB@D@B@B@@A@A@@BA@A@ABA@A@@B@@AB@@BB@@DB@@A@@@@B@BA@A@AB@@AB@@@@@@B@DB@@B@BD@@@BBBB@B@@@BB@@@@AB@@ABB@BB@D@@@@@@A@ABAAA@C@@@@B@@@BA@AB@@AB@@@B@@@@BBB@B@@@@@@@ABA@@@@B@@@BAB@@@@@B@@@@BD@@BBBBB@@@B@@B@@@@@B@B@@@B@BA@CD@B@@ABA@AB@@@B@BAB@@AB@@A@@@A@AB@B@@A@@BAB@@DB@@B@@@B@B@@B@@@B@B@@BB@@@B@@BBBB@BAB@@A@ABA@@@@BA@@@@@CA@@AAC@A@A@AA@AA@AB@@@BA@AA@B@@A@@@@@ABAB@BCD@@@@@BB@@@@@@B@@@B@B@BA@@@C@@@@B@B@@@@B@@B@@@@@@A@@@BB@B@@AB@B@B@B@@@@@B@@A@AB@@@@BBB@@@BBBBB@BD@B@BA@@DDBBB@BD@B@@BAB@BBD@@A@A@A@EB@B@B@@@B@BA@@BABB@BB@B@@BBBB@BBB@@A@CB@@@@@@BBFBBB@B@@@B@@A@@@AB@@BBB@@@ABCF@AA@@@A@AAA@@A@@A@@ACC@@C@@BA@AB@@@B@B@@CAC@@@@BAB@@BB@BA@@B@BBB@@ABBB@@A@@AA@A@@@@B@@@B@@@@A@@B@@AB@@DDAB@@@BB@@@BB@@BB@@@B@BA@A@@B@@A@@AA@@@A@@@C@CA@@AAAA@@CA@@A@@BA@@B@B@@A@ABA@@@BB@BA@@@ABA@@B@@@BDBBB@@@B@@@@A@AB@@@B@@C@@BC@@BA@@@@B@BB@@BABAB@@C@@@@@ABA@@@@@A@@@AB@@A@@AE@@@@@AB@BA@@BA@ABA@ABA@@@ADB@@B@BABABA@@@AB@B@B@B@@ABA@@B@@DB@@@@AB@B@BCB@B@@@@BD@@ABA@@@A@AB@@@@A@A@@@A@A@@@C@@@@@E@AB@@ABABA@@@ABA@A@@@@BA@ABABA@@@@B@B@@ABAB@@@BBBBBBD@@@BB@B@@@@B@@@B@@ABA@A@@@A@A@A@@@AA@@ABA@@@DD@B@B@B@BB@B@@@B@@BB@B@@@BB@@BB@@@B@B@@@@A@AB@@@B@@AB@@A@@BA@@B@@@@@B@B@@@B@@@BBB@B@B@@@BBBBB@@@BBB@BB@B@@BB@@BBB@@B@@@BD@B@B@BAB@@@BA@@BBB@B@@@BA@@B@@BB@@BB@BB@B@@BD@@@B@BDB@@BB@@@@B@@@@BB@@BB@@@@B@D@B@DBDB@@DBBB@B@@BD@@BB@@ABADAB@B@@BB@B@@@@@BA@@@@@AB@@@@A@A@@@@@A@A@@B@@ABABAB@@EDCB@@A@@B@D@B@BABBB@@D@@@@@@BB@@@BBD@@@@@@BBB@@@F@BBD@@BB@@@B@@@@A@AB@BA@A@@BA@@BAB@@ADA@@@@B@@AB@@AB@DAB@DAB@@CFAB@@@@@B@BA@@D@@@@@BA@@BAB@@@B@@@B@B@B@B@B@@AB@@@B@D@BAB@@B@BBB@BB@@B@@BA@@BBB@BB@B@B@BB@@D@@@@@B@@B@@@B@B@BA@BBAB@B@BBD@BB@@@BBABB@@B@@B@B@@AB@BAB@@@@AB@@@B@@@B@BBBBBBBB@BB@@@@@B@DA@@BB@B@BBB@D@@BB@B@@B@BA@A@@BAB@@@B@A@@B@BA@BBAD@@@@BA@@@@BB@@@@@B@B@@B@BA@@B@@@B@BB@@B@B@@@@@BA@@BC@@B@B@BABA@@B@@@@AB@@A@@@@B@@@B@BB@@@@@@BA@@@AB@@A@@@@@A@@@@BA@AAA@@@AB@@A@A@@@A@@@A@AAAAA@@CC@A@@AA@C@@@@@A@@AAAA@@@@@AB@@@BA@@@@@AB@@@@@@AB@@@@A@@B@@@B@@AAA@@@A@@@@@@B@@AB@@A@@@A@@@@B@@@@@@AA@@@BC@ABC@@BC@AB@@ABA@@B@@@@A@@@A@@B@@@BA@AB@@@@A@A@@@@@@B@@A@@@A@@B@@@@@@AA@ACAA@@@@@ABB@@B@@@@@B@@@@@@AB@BABA@@@A@@@@B@@@@@@A@@A@@@@@@AB@BA@@B@@@B@BB@@@AB@@@@@@@B@B@@@@BB@@@@@DB@BB@@@BA@B@@@@AB@BB@@@@@@BB@@@B@@@B@@B@@@@@@@BA@B@@@@@BBA@@B@@@@@@BB@@B@@@B@BA@@@@@@BBB@B@B@B@@@@@BBBD@@B@B@@@@BAB@@A@AD@@F@BBB@FD@@BB@@B@@@B@B@@@@A@@BABA@@BBB@@BDD@BBBBB@@@@B@BA@@B@@@DAB@BB@@@@B@@@B@B@DA@BB@@AB@@@BA@@FBD@B@BB@@B@@B@@BBB@BA@BBA@@B@BAB@@@AAB@BABAB@@@@BBADB@AB@@@BAB@@@B@B@DB@BB@@BB@@B@BB@@B@B@@@B@@B@D@B@@@B@@@@BA@AB@B@@@@@BA@AB@@@BA@@@AB@@@BB@@BB@AD@B@@AB@BA@ABB@@B@BABABA@@BB@BB@B@@@B@@@@@@@B@@A@@B@@ABA@@B@@A@A@@@ABABA@@@@@@B@DAB@@ABABAB@@@B@@@B@@BB@@B@B@@B@@@BBB@@@@BB@@@@@B@@@@@B@B@@@B@@@D@@BBB@@BF@B@BB@@@B@B@BB@B@@BB@@@@BBBBB@B@BA@ABA@AB@@A@ABA@A@@@AA@@@BA@@@AA@@A@ADA@@@AB@B@@ABABAB@@@B@@CBCB@BABAB@BA@AD@@@B@B@@@BB@@@BB@@@@AB@@@@ABAB@@A@AD@@AB@BAB@@@BA@AAAB@@ABAB@@AB@@ABAB@@CB@@ABA@@B@@@@BBBBDBBBBBBBBB@@@DAB@@B@@@BB@BB@DBB@B@FBB@BB@BBBBB@B@@BBBAF@DA@@BA@A@@@@B@B@B@BBB@@@FCB@BAB@B@B@B@FAB@@@BA@@@@BBD@B@DBHD@BB@BABBD@BBB@BBBFBBBA@@B@@@@@BBBB@B@@@B@B@@A@@BA@@BA@@BBB@@BBBBD@BB@@D@D@B@@@DBBD@BBB@@B@BBB@@@BB@BBBB@@@@BDBDBBBBB@BBB@@@B@@B@FB@@BCBA@AB@BBB@@BBB@@B@@@BA@@B@DBBDBA@@BA@A@ABC@@B@@AB@B@DD@AB@B@@@D@@@B@@@@B@@BBBBD@@@BBB@@@@@B@@AB@FC@@B@B@@@BBBBDB@@BB@@B@@@@@B@@BBA@@BA@@@AAA@A@@BA@@@A@@BA@AB@@A@A@@B@@A@@@C@@@@AA@@BABAB@BBBB@@B@BA@BB@@B@@BBB@BBB@B@D@B@B@BBBBFA@A@CDCB@AABE@EBC@AD@BABABA@@AABCB@BAD@BADAB@DBB@FCD@@AB@@@@BBFBF@FBD@D@DAB@D@B@BBDBDBDDH@BFFFHFFBA@A@@@C@A@AB@@CDC@@DABABA@A@A@ABCBADAB@B@BA@@BABCAA@AA@@@CAA@C@A@A@A@@@A@@@CDC@AB@@A@@@@@AA@@@AA@@@ABAA@@A@@@CA@A@@BA@ABA@@B@@@@ADAD@@@B@@@B@BC@@@@AC@A@A@A@@@A@A@A@@EA@A@@AABABAB@B@@@B@D@@BBA@@@@BA@@B@@AB@BAB@BB@@@B@@D@B@@@@BB@B@@@B@BB@@A@@B@@@DA@@@@B@@@@BB@BD@@@ABBB@@B@BBBABBB@BBBB@@B@B@@@B@DC@@@@@AAA@@@@@AACAAA@AA@ABABACCA@A@@@AA@AAAC@@@BA@A@@@ACAAAA@AA@A@@AA@A@@B@@AB@@@D@@BB@B@B@@BBB@BBBB@@@B@B@B@@A@@@@@A@@@@A@@A@@@A@@@@@AAA@C@@@ACAAA@ABA@CBA@@AA@@AC@@@@@ABABA@@B@@@B@@@BBB@B@BD@@B@@@@@BB@A@@B@DABA@A@AA@A@@AAA@@A@A@A@@@C@@@A@@@@@AA@@@@AAA@ABA@ABA@A@@@@A@@@AA@@AA@A@@AAAAA@@@A@@@@@@@AB@BAD@@@DB@AB@@@AA@@@@BA@@BA@@@@AADC@@@A@@B@@@DB@B@@BB@@@@BAD@@@@AB@BABB@@B@@@BAD@B@@@@@@B@@@@FBBBB@B@B@@BB@@@@BB@@@B@BB@B@@B@@AB@DA@@B@B@BABA@@@A@A@A@@@AB@BA@ABAB@@@BCB@BC@C@A@ABABA@AAABAH@F@HBB@B@D@@AAC@@@@@A@@@A@@BABABAB@B@BAB@B@@A@A@@@A@@@@BB@@BB@@@@BA@@@CB@@A@@@@@@B@@@DAB@@A@ABCBCBCBC@@@A@@BAB@BAB@B@B@@@@@BA@CB@@A@A@@A@@@BA@@A@AA@AA@@AA@A@@BAA@@B@@A@A@@B@@@B@FABA@@BA@AA@ACAAA@BAB@BAD@B@B@B@BAB@@ABAB@B@BDB@BBB@B@BABA@@@@@BB@@@BBBB@BB@BB@@@BB@DB@@BBB@BBBBB@@@@B@@@BBBBBBB@B@@@B@BBBB@BBDBB@B@@@B@BBB@B@BBB@B@@@@@@BB@@AD@@@@BBBBDBBB@@@B@@@B@B@B@@@B@FC@@@@B@B@@@B@BABC@@@@BACAAAA@BABAB@@A@ACA@@DC@ADA@@B@@@@A@ABA@@B@BBD@B@BA@@D@@@@@@BB@@BB@@@@@B@@@B@@B@B@@@@B@B@B@B@B@@@@CBA@@B@B@BA@@BA@@DA@@D@@@B@BD@B@@BBB@@@B@BBB@B@B@B@B@B@B@B@BAB@D@@A@@@AB@@A@@BA@@@C@@BABAA@B@@AA@BA@@BAB@FCD@D@B@@@B@B@BB@@@@@BB@B@@BBB@@B@@D@B@@@BB@@AFBB@@@@@BB@@BBB@B@BB@@AB@@B@@B@@DD@DB@@BBB@BB@B@BBBBBBABA@AB@@@B@BBB@@B@@B@B@@BB@B@@@B@@@B@B@@B@B@B@BB@BBB@@@@B@@@B@@@@AD@B@@@B@@AB@@AB@@@B@BB@@BBF@@@@@@AB@DABAB@@@BABC@@@@@@@A@@@A@@B@@@BA@@AA@@@@AAAA@@CC@@@A@@@A@@AA@AA@@A@@AC@@@A@@@@B@B@B@BABB@@@A@@@C@@@A@@@@@ABA@A@A@A@A@AAC@@@AACAA@A@A@A@@B@@AB@@A@@BA@@@@D@B@@ABABB@@FC@@@@BBBB@@BAB@@@BB@@@B@@B@@B@@B@B@DA@@B@BBBBB@BB@@B@B@DAB@D@B@D@B@BBF@@@BABAB@@@FDB@@@D@B@BAB@@@FA@A@@@AB@@C@@CA@@CA@A@@@A@AA@ABCB@@@AAAA@@A@@@ABABA@@@A@A@AAA@A@@BABA@A@@@ABC@@@ABA@AA@@@AA@@@A@@@A@AA@AA@@@C@A@@DCB@BABA@@@@@A@ABABE@A@EBA@AAAA@AA@@A@@@AAAA@@@@@@@A@@B@@@@A@A@@A@@@AA@A@A@@@@@A@A@A@ABAB@F@BAB@D@@@@AAA@A@AAA@@@@@A@@A@A@A@C@@@AAA@A@A@A@AB@@ABA@@BC@A@A@AB@@@A@@@AAA@@AA@A@@@A@A@A@@@AAA@A@@A@A@@@A@@AC@@@AB@@CD@@A@@@A@AA@@@AA@@AAA@AAAC@A@ECA@@A@@CE@@AACA@A@@@@@@BABA@I@ABABAFCBABABA@@DABADGBAFE@A@@FG@ABA@C@AACAC@ABC@CBG@ABABADAHCDCDEB@DABAB@@ABA@@DAB@B@@@@A@@BA@@@ABA@@@ABABA@@ACA@AA@@BCB@@@BA@A@A@@BAB@B@B@@AB@@AAA@@@AA@@@A@A@@@A@AC@AAC@AAC@CAC@CBAAA@@@@@A@@@AAA@A@C@@AA@A@@@A@A@@@A@CAACA@@AAA@@AA@C@A@ABAAA@AAA@@A@@@CBA@@@@BA@AABA@@@CA@B@@@@@@A@@@@@A@@@@@@@BA@B@A@@@@@@A@B@@@@@@@@@@A@@@@A@@@@@A@@AB@@A@@@@DB@@@@B@@@@@@@@C@A@AAA@@AAAC@@@C@CA@ABA@@@@@ABA@A@A@A@CAAAA@A@CB@@A@A@A@A@@@AB@@CC@@AC@@A@A@E@A@E@@BABABA@@@A@@@@@C@E@CB@@@FAB@@A@@@@@CAA@A@@@A@@BA@@@@B@@@@ABABCD@@ABCBABEBA@AB@@AB@B@B@@AB@@@@A@A@ADAB@@A@ABA@A@AB@@@@@A@@AC@@A@@@@@C@BAAA@A@E@A@CAA@AABA@A@A@@@AB@BA@@@A@@@A@@@ABAAA@AAAA@@@AA@@A@@CC@@A@CB@BA@@@AAAA@@@A@@@AA@@AA@AABAA@@@A@ABA@@BCB@B@@@BA@@@C@@@A@@@AA@@@@A@@@@BA@@BA@FB@B@@@B@BBB@@@B@@@D@@@BA@@@@BA@@@A@ABAB@@ADA@CD@BA@@B@@C@ABA@A@@BA@AA@BAB@@CB@@AA@AACAAA@AAEC@@A@CA@@AAA@C@C@AA@@BDBD@BAFA@BB@BA@@B@@CBA@ABA@AA@@@A@@A@AA@@@@@ACA@@AB@@A@@BA@@@C@@@@@@@@B@B@BA@@@A@A@AAA@A@@AA@@@@@@BAD@@AB@@AD@@@@@@A@A@@BA@@@@@@@A@AAAAA@A@AB@@@BC@@B@B@@A@A@@@A@@@ABCB@@AA@@IA@@A@@@AAAA@BA@@@@@@BA@A@A@CB@@@@@AA@AA@@@@AD@@@@A@AB@@B@A@BDB@BDABABCBABC@AAAABCCC@AE@CBCDACA@C@CA@B@@AB@@A@@BA@AB@@EBA@ABCBAAA@@@C@ABAAA@CC@@CAA@A@A@AA@@A@@@@@A@AAC@C@A@A@@@AB@B@@A@ABA@A@CBADA@@@AAC@@@A@@C@C@@BA@A@ABA@A@@@@@@@AB@BAB@BAB@@@@AA@A@A@@A@@@A@@@CBA@@B@B@BAB@B@B@@A@A@@@A@@B@BA@@@A@@@A@@@@@AA@A@@AAA@A@@AA@A@AB@AABABABC@@BA@@@@@@AAAA@@C@A@E@AAC@@C@A@@DABA@@@AB@BA@AB@@@@@@AAAA@@A@@@A@A@ABC@AEA@@BEAAA@@CC@AA@CBCB@@C@@DC@ABA@@@AB@BA@A@AAC@@A@@A@A@A@ACCAA@A@@@@A@EBA@C@@BA@AB@@A@@AA@@@@BAB@@@@C@CAAA@@A@A@@BA@A@@B@AA@@A@@A@AA@@ABABA@@AA@@@A@A@AAA@@@AA@B@@ABC@CB@DA@AAAAA@AAAA@@AAB@@@@@BAA@AA@@AAAAEAAAACCAC@A@@AA@@@@@A@@A@@@@A@@@A@CAAAAC@@BAA@@@@ABAB@@C@C@@@CBAACAC@ABCB@AC@ABABA@ABABABC@@BAB@BCB@AECA@AB@BAD@BABA@@@CBAB@BCAA@ABAB@DA@@@CAC@AFEBCB@B@@ADABABA@A@A@@A@AACA@AA@@@ABA@@A@@AA@@A@A@@@@@@@ABA@ED@BABAB@BA@@@@@@@@@@@A@@@@@AABA@A@@@AA@@@@A@@A@AA@@@AAA@@AA@@AA@A@A@A@CDC@AECECC@CABEAAAA@@DA@ADA@AAACACBABA@@AAAAAC@C@@B@CBC@AEAAA@AAACAADA@C@AB@@ABBDBD@DADA@ADCDAD@B@DBHA@CD@@@BB@@DCD@DC@ABADE@CAE@A@@@AAA@CA@AA@EAA@@@@@A@AAA@AAA@C@@@AD@@@B@@@BABA@@@@BBD@@ABAB@@@@A@A@@@@D@BB@@BBB@BB@@B@BC@A@ABC@@@CACAAAA@AA@@@@@A@@@@BA@ACA@AA@@@@@A@AA@@AB@AA@"],encodeOffsets:[[110375,24997]]}},{type:"Feature",id:"451221",properties:{name:"南丹县",cp:[107.541244,24.975631],childNum:1},geometry:{type:"Polygon",coordinates:["@@JBJAHCBEAEEGGKEGAQBIBMBKBOHMDEFIJKNKHCJEFGDG@I@MAC@CAEBGFCFCLFHNNPRVNNLJBFDFLDLAJGHOBIAKEMAGACAKBGFM@@JILMLELGHMDIBGCI@CEKGGCEAACAC@@AABAA@@@@@@@AA@C@A@@A@AAAAAAAA@AA@AA@BA@AB@@ABA@CBAB@D@@BDBDDBBB@DDB@B@B@BA@A@A@AB@@AB@@@B@BAB@B@DAB@B@D@BABABBF@DBDBB@DBBB@BBF@BB@B@D@@BBBFBBBD@B@B@BA@AD@DA@@BABAD@HABADA@CBABADCB@AABC@C@C@C@@BEB@BCBCHEFADAFAFAFCFCBABADCHGB@DABABA@EAC@A@C@AA@@A@CCG@A@A@CBC@ABG@G@C@CBCBA@ABAFEBA@A@A@CACAA@A@A@ABA@A@A@@BA@@B@B@B@B@@@D@B@B@B@@@BABA@ADG@ABABA@ABG@@@A@A@@@C@AB@@CBA@@BAB@BADA@ABABA@AAA@AAAAA@AAA@AAA@C@C@M@CBC@A@ABGBEBABCB@DAD@@AD@@A@@@A@@AAAAAA@@ACA@AA@@AAA@@@AA@C@A@ABC@AA@@A@@AAAAC@AAAAA@@ACEA@GIEEEGEE@ACGACACAC@A@A@CBA@C@CAC@EAEAE@A@@A@@BC@EDA@CAA@CBABC@ABA@ADBB@@ABABABC@@BAD@FAFBBA@CD@D@BEBAAAAA@A@C@A@A@AAA@AA@@@AA@@AABA@@@AAAAA@ABAB@@BB@@@@@D@@@BA@@@@B@BA@@BAB@@@B@@AB@@@BBB@B@@AB@@AB@AA@@@@@A@@@AA@@CAAAAA@@A@A@@@EDA@@BA@@@@@A@AA@@C@AAAA@@@A@@A@@@C@@@A@A@@BCCA@A@@BA@@@AD@B@BAB@@ABACCAA@@@AB@@A@@@AA@AA@AAA@@BABAD@@EAA@@@@A@@AA@AAAAACACA@A@@A@AA@AAA@@A@AAA@@@AA@AACCA@@A@C@C@@@AAC@AAAA@@AA@AB@@AB@@AB@@@@A@A@@@AAAAA@@@@A@@@ABAAAEAAA@AAC@AAABA@@AGCCAA@C@AA@@@@AB@@A@EBA@A@A@A@ABA@ED@@A@AAA@A@A@@@@@@BAB@@CBE@ABAA@@@AAAAA@AAAA@EAA@A@CAA@@AAA@@A@@@BA@C@@AAAAAAAACAAAAA@@@@@AB@BA@@DA@@BABA@@BA@@BABA@@BABBB@@A@@BA@ABA@@BCB@@@BABA@@@@BA@@@@AA@@A@@A@@@A@A@@BCB@@ABABA@ADADA@@@A@@BABABA@@@ABA@@B@BCB@@@BB@@B@@A@@BB@@B@B@BAB@@@BAB@BAB@@A@AAAAA@A@@A@@AA@A@@A@A@A@@AAA@E@@AA@AA@@@C@@@A@@@A@A@@@@@A@@@@AA@@@@AA@A@@@AA@A@@@AA@@@A@@@A@@BABABA@@BA@C@A@@@@B@BABA@@B@B@@@@AB@BA@@@AB@@@@A@@@@@@@A@@@AAAA@@AB@BABA@A@AA@BAB@@ABA@@@ABCA@@AA@@A@@BA@@B@@A@@BAB@@A@@@@@ABAB@@A@@A@@@A@C@A@@@@A@@@A@AA@@A@AA@@AA@@ACAA@A@@@A@AB@@A@@BCAAB@A@@A@ABABA@BB@@A@ABA@@@AB@AABA@AA@@@AA@@@AAA@C@EA@@AB@@A@@BA@@ACBA@A@@@A@@@@@AAA@CB@@A@@@ABA@@@@@AAAA@ACC@AA@AA@@ABAB@@@B@@A@A@@@A@@@AA@@ECA@AAE@@@BCB@@@BA@A@@A@A@@@ACAA@@@@A@A@A@A@AA@@@@@@ABA@@@A@@@AA@@@@@@@AB@AA@@@@A@B@@A@@@@@@@AA@@@A@@@A@@A@@@@A@@ABA@@@@@AAB@@A@@ACA@@@@A@@A@@A@A@@@@@@@A@@B@@AAA@@@A@@@ABA@@B@@@@B@@@@B@@@@A@@@@@@B@@ABABA@@B@@@@A@@@@@A@@@AA@B@@@@BBBDB@@B@@@@A@@@@B@@@BA@@@@@@@@B@B@@A@@BAB@@A@@@@B@@@B@@A@@@AB@BA@@BAD@@AD@BAD@@B@@B@@@@A@@@@@@B@@@BA@@BA@@@@@@@@B@@BB@BA@@@A@@@@B@@A@@B@@@@A@@B@@@@AB@@A@@B@@@@BBBB@@@B@@@@@DBB@@@BDD@@BBBB@B@B@@@B@@@B@BA@@B@@BB@BAB@@@@@B@@@@@BA@@B@@AB@@@@@@AAA@@@A@@@@@@BA@@B@@A@@@ABABA@A@@@AD@@AB@@@@A@A@@@AAA@@@A@@@ABA@@@@A@A@@@@AA@@@@AB@@@@BCAAB@@A@AB@A@@@A@AB@@@BABA@@@@AAA@@@CAA@A@AAA@@CBA@@@@@A@@AAAAAAAAAA@@@A@@@A@@B@@A@ABA@@BA@A@@@@AA@BAAA@@A@@AAC@A@ABAAAB@@A@A@A@@@AA@@@@@C@@@AAA@A@A@@AAA@AB@@AA@@@AAA@AAA@@@BA@A@C@A@@BA@@@A@A@A@A@A@@@A@@BA@AB@@A@@@@@CB@@A@A@@@@BADE@@BA@CBA@CBA@@BA@@@A@@B@BC@@BA@AB@@AB@B@@ABAB@@@@@@A@@AA@@AC@A@E@@AA@A@@@@C@AA@@A@@A@@@@C@@@AABA@A@A@C@AB@@@DAFC@@BABABA@@@AB@B@@@@@B@B@@@@@BA@@@@B@@A@@@@@AAA@@@ABABCBA@@AA@@AC@@@AAACA@@CACAA@C@A@@@@@AA@@AA@@@@@A@@A@@AA@ACA@@@C@@AA@A@@@@B@@@@@BAB@@@@@B@@@@@@A@@B@@A@AB@@A@@@CA@@ABC@C@@@A@@@@@CB@@@BA@@B@@@B@@A@@B@@@BAB@B@@AB@@CB@@AB@@@@AA@BA@CB@@@B@@BB@@@@@BA@@@ABCDABAB@@@@@BABED@@@@@B@@@@A@BBA@@@@B@@AB@@@B@@@BABAB@@ABABA@AB@@@BA@ABADA@@BA@@B@@@@A@@ACA@@@@A@AA@@C@@@A@A@AB@@ABA@A@@@A@@@@A@@E@A@CA@@@@A@AA@A@AAAA@A@C@AACA@@@BABA@CB@@ABA@A@ABAB@@A@@B@@@@C@@@CB@@AB@B@@@D@@@B@@@B@BB@@B@@AB@@@AA@@@@@@BA@ABCDAB@@@@A@A@@@CA@@A@ABA@@@CAA@@@C@@BABAB@@@@@BABABA@@@@BAB@@A@@AA@@@A@@@E@@@@ABA@A@@@@@@AAA@A@A@@AAA@@@@@@A@CBC@@BC@CB@@AA@A@A@@AA@@A@@@@@@A@A@@BA@A@@BCC@A@A@AA@@AB@@@@@@A@AB@@@@CAA@@B@@@@@@A@@@@@A@@@A@@@A@@AAA@@AAA@@@A@@A@@AA@ABAAAAA@A@@AA@@@@@@BAB@BA@@B@@A@@@AB@AA@A@@B@@A@@@@AABA@@A@A@A@A@C@@@AAA@AA@@AA@@AACA@@A@@@@@A@@A@@@A@@@@@A@@BA@@@A@@AA@@@A@AA@BC@ABA@@BA@A@@BAAA@@A@@@A@AA@AA@@AA@A@AB@@C@A@C@GB@@ABA@A@AA@@AA@@@AA@C@@@@@AA@AA@A@ABA@ABABA@C@A@ABAA@@CB@BBBABABABA@CBADCD@@A@@@AAA@A@@@ADAB@BA@A@@@A@@B@@@@@@@BA@@BAB@@AB@@@BA@@B@@@BA@@@A@@@@@@B@@@BA@CD@@@@@BA@@BBDDBB@@BABBB@FBB@@A@@B@@@BCD@BED@BC@@B@B@@@B@@@@BB@DBB@@BDBBFD@BBB@B@@BBB@@B@@AFCD@@CDCFABAB@@ADAB@@@B@DAFCFFBBBB@@BBB@BBB@D@@B@@B@@@BB@@B@BBD@B@BB@BBB@@@AB@B@BAB@@A@@BBB@B@@ABAF@@ABA@CBABDD@@@BBDAHAD@B@@A@ABAB@BAB@B@@CDABEHCBAB@@@@ABABCBAB@@_T@B@BAB@BA@A@@D@B@BADABA@ABABCBCBA@@BABA@A@A@@@A@A@ABA@A@ABEFA@@@A@A@@@ABA@A@@B@BABAD@@BBAB@@B@@BADBD@H@BDDEH@D@@BB@@B@@BA@B@@B@B@B@@@@B@B@@@@@@A@A@A@@BA@ADC@@@@B@@A@@DBB@B@D@@@B@BBBA@@DA@@@AB@BA@@B@D@@B@@@BABAD@@AFAB@@BB@BBBDAB@BA@@B@B@@@@AB@BAB@@@B@BA@A@@B@@AB@@@@@BBBBBBB@B@D@B@B@BABAB@BAB@B@B@B@B@B@@@B@F@@@@@BBFDDBADEDBD@@ABA@CFABAJDD@DAD@DCB@B@@@@@@@@DD@@BB@B@@BBABBB@B@BBBB@@BB@@BB@DF@BB@@@@@@BAB@BA@@@AB@@@BA@AD@@A@@B@B@B@@AB@@@BABA@@B@@@@AB@BA@@B@DA@@@@BA@@@@B@@A@@B@D@B@@@BA@@B@B@B@BABAD@@A@A@@B@@@@A@A@@BA@@B@@BBBB@B@@AB@@A@@B@@@@@BB@B@BAB@@D@@A@@B@B@@ABAB@D@@A@@@@B@@@BA@@BB@@@@@AB@@AB@B@@BB@B@@@@ABAF@@BB@@AB@@@BA@@@CBA@@BAB@@BD@B@@DB@@BB@B@B@B@@ABAB@@@@@B@B@BAB@@BBCB@BCB@@AB@@AB@@@B@@AB@D@B@@A@@BDD@BBB@@@B@@@B@B@D@HE@@AA@CBEF@DCBA@CD@D@B@@BB@B@BBBDBCB@BAD@@C@CCA@AAA@ABAAACAA@@CA@@A@A@A@@AA@@@A@@B@@@@A@CDC@AB@B@D@@AD@B@BA@@B@@@@@B@B@BB@@B@@@B@@@B@B@@@B@BB@B@AB@@AB@@ABADA@A@A@C@ABA@CB@@ADBBAB@DCBAB@B@@ABCBCB@BAB@FGFEJ@B@DACA@A@CA@@AACAC@C@CAECCA@@C@ED@BABA@@@FBBDBBBDBBABB@AB@BA@AF@BBBAB@BCBAB@D@@ABABBB@BF@FD@BDBDBD@@@@DABB@@DA@@@BBB@D@DADB@@B@DC@BBBAB@BAD@BABBBCBGBE@@@CHGFABADA@A@AB@@@BABA@AB@BFDD@B@DB@@B@B@DBB@B@B@D@B@DDFA@BAD@B@@BFBB@B@BBBAB@D@@IJ@@CFC@GC@@ECCBA@EBADC@CDEBEAA@CAGCC@QHA@CA@@@@ED@DA@AA@@ABE@@@A@CAC@@B@@BB@@A@EDAD@@BB@B@BADABAFB@@BC@@DA@ABBF@@A@ADBBA@A@AB@@BDCA@BABABC@BDA@A@ABGA@@CDA@C@IF@HCDAB@BAB@DA@@D@BBJAF@@BBABA@@BB@AFA@@BBBBBA@@@@BAB@@@@@@@A@@A@@AAAC@A@A@@AABA@A@@@A@A@@BA@@B@@@BAB@@@BA@@BA@@B@@@B@@@BCLBB@@DD@@@B@B@B@B@@BB@DBB@BB@ADA@@B@BDBDHAD@@BBB@@@@@DABAB@BB@@BA@E@A@@BA@@B@B@@AB@BB@BB@@@@@@B@@@B@@@B@BB@@BB@B@B@@F@BBB@@@B@@@B@DBB@@@@@B@DBB@@@B@@@B@BAB@B@@@@B@B@@B@B@@CB@BB@@@BB@B@@@B@@B@A@@B@@A@@@AB@@@DA@@B@@ABBB@@A@C@@BA@@@C@A@@B@@@@@@@DB@@BADB@BB@@@BB@EFAB@@@BB@@@B@B@BBB@@BB@BB@@BD@BB@BBADABF@@B@BB@ABABA@@B@@@@@BBBD@BBBB@D@@@B@@@@@B@@@@@BA@AB@BB@@B@B@@B@B@BB@@@@B@B@@@BB@@@AB@@A@@@@@@@@@@B@@@@@DBD@B@B@BBD@BAB@B@B@@BH@@@BBB@@@B@@@B@@@AB@B@@@B@@@D@@@B@BBB@B@@ABAB@B@DBBBBA@EBA@EAC@E@@@C@@@AB@@@@CBA@A@ABAAA@@BCBABABCBGEAACBA@@@ABA@@ADGBAA@@@A@@@CACA@@A@A@AA@AA@@@IDA@ABAAC@@@@EC@AC@C@@ACCAABABCBA@AB@BAB@@@@@@@B@B@@@@@BB@@BBB@@BBA@BB@BA@BBA@IAE@@B@DABAHBDCFCB@FAD@@EBIC@@CACBCDAB@@DB@DBBBLAB@DBP@BGF@A@@@BA@A@@@CBA@@@A@@AAB@AA@@@@A@@A@ABA@@@A@IBABA@C@@@CB@@CA@@AB@@A@A@@@@@AB@@ABA@@@ABAB@@ABA@ABA@A@@BABCB@@AAAA@@A@@@ABABA@@@@@A@@@A@@B@BA@A@@@@@@B@@A@ABA@@@AB@@@BABCB@@@BAB@B@@@B@@B@@@@B@B@@@BA@CB@@@BAB@B@B@@@B@@EBGDABB@DBDB@B@@BBBB@BBB@B@B@B@BAB@BA@@B@@BBBBDBB@@@BB@@B@B@DBF@B@BBBB@@DBF@B@BBB@B@DBD@BAB@FBD@B@B@B@B@D@BBFBBBDBD@BBFBFFDBBD@BDF@D@F@DA@AB@@ABBB@@@B@@EBC@A@A@@@AB@BB@DB@B@@@BCDCF@D@BBB@BBBBB@BAD@BB@@DAB@D@BAB@B@@RFNH@@LFNBNCH@TER@XDHBPDLABBB@BB@@BBDAB@B@BABB@@DBDD@BD@B@D@DBDAD@B@DBJDBBB@@D@B@B@DBB@B@D@D@BHFJHHLLHLBRAREFGHO@OEQAGAEAIDQFCFERGTEPANIJEPM@@BCBCDABABCB@@A@@BABB@@B@@@B@D@B@BA@A@A@@@A@A@@BAB@@AB@BABC@@CG@ABABABA@ABAD@B@BBDDB@DDB@BAB@BAB@@@BA@@BC@ABAB@B@DBB@BA@@BA@ABCA@C@@A@@C@A@C@AA@ABCBA@A@AAAAA@ABABAB@DAB@@ABA@A@C@@B@B@B@@@B@DABB@ACACA@@CC@AB@@@@ABA@A@C@ABAB@FA@@B@@AAAAAA@@AA@@@CA@A@C@ABE@ADAAA@A@@ACCAC@A@AA@@@C@A@A@C@A@A@AAAAA@@C@A@A@A@A@ACA@A@A@@@EFA@@@C@@@AA@@@A@@AA@@A@@A@@A@@@@AA@@@@A@A@@@@@AB@B@@A@@B@BA@@@@@C@A@@BA@A@C@@@AB@@A@A@AA@BC@A@@BABAB@B@BC@ABA@A@@DAB@B@BB@@BBBBB@D@D@BAD@@@@AB@@@B@B@B@@@B@B@@@BB@@B@@@B@@@B@@@BA@@B@@@B@B@@BB@@@FCB@BABABAB@BA@ABC@@B@BA@@B@B@@AB@@BB@@@BB@@@@B@@@B@D@@@@B@@@B@@BBBBB@@B@@@BB@BA@@B@@B@@@@ABA@@@A@@@@B@@B@@@AB@@@@BDD@DFDDBBJDBAJCHIHGHIJEHCD@F@JBH@HEAKIKKKCKCMAOBIJOFIFGBCFKLS@GBGBM@EBCBGHEDAHBLBTNJFLFDBJ@LAFMHQBOEQISGOK[CI@ABADCDC@C@@@@@A@@A@@AA@AB@@AA@A@@@ABAAAB@A@AABCB@@A@A@@DBBAB@BA@@B@BAN@LBFCHAF@FB@H@FBD@@B@D@B@@@DA@@BABA@AACBCACB@@CBAD@BBB@@@@B@@@B@D@B@DB@BBB@DA@@BBADAD@D@BBB@@BBBB@BB@BBDE@@BABAB@B@B@BAD@BBDBDB@DA@CB@B@@DDBB@DBBBBBBD@BDFDH@BB@@@BB@@BBB@BBBBBBBBBJBDBB@@@DBB@BD@BBB@@DBBB@@FAFABBDADAFG@@@ADAB@B@D@DB@B@@@@B@CDADABAB@DADABBBBBB@BAB@FADCDCBABA@@DDBB@@B@AB@BAB@BBD@B@BB@BBF@BB@B@BAD@BBD@D@@DFDHJRDJFJ@BHNPHFBL@L@"],encodeOffsets:[[110239,25923]]}},{type:"Feature",id:"451222",properties:{name:"天峨县",cp:[107.173802,24.999108],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BA@E@AACBE@KN@FA@E@ABCF@BBDCBADB@ABA@@@A@@BCF@B@B@BBB@@AB@DCFA@EDABC@CBEBA@@BCDA@CFABBDB@BBCDDFHBED@@A@@@A@AB@@CB@BA@@@@BABCDED@DAJA@ADABCBE@CD@@@B@F@BDD@BBBBBAB@DAD@D@@BDBB@B@B@D@@D@DBDA@@@@DE@@FB@@BB@@DFB@D@@@@BDBD@B@BABCD@B@BA@A@A@@BABABABBB@@ADB@@@B@A@B@@BB@@@BB@BAD@@B@@@BADBB@@BBADAB@DAB@B@@BBABC@@B@BBBCB@B@B@BA@BBABA@AB@@ABAFA@@BEDEDCFABA@AD@D@D@BJFCDDDBBHDB@FD@@BB@B@BABAAABAB@BA@@BAB@B@BAB@BC@@B@B@B@@ABCBABC@A@A@@@AACB@@A@CBCAAAEBEBAA@@@@BAAAA@A@C@ABC@@A@ACAA@CBAAC@C@CCC@AAAB@@C@AC@A@A@A@@@AFCECCCAAABABAB@BA@ICCEE@@ABC@@BCAAAACC@@AACA@@@AAC@A@@@AA@AA@@AA@@@ACC@AC@@BABG@ABABE@EFA@ECIAIAI@G@C@ABCDA@C@E@CDAA@CA@@A@ABC@A@C@@BAAA@AA@@AC@AAA@CBA@C@ECAAAAEA@@A@@BAB@BAB@B@@ADC@AAA@ADABA@CF@@AD@BC@ABAB@@@BAFA@CBABC@EH@DADB@@B@BEDABAB@@@B@@B@@@B@F@B@B@@@B@BBB@@@B@B@BB@@F@FDFHDF@HDNFFDADBFBFDBFHFHBHFH@FBBDBD@B@@DBDDFBBDBFBF@HANCJBHFHFFFDLDJ@FBLBHDFBNHFBB@D@HBJAD@F@JCJGFGFELGF@B@B@B@D@DBLJBBHFH@D@BBHFDFDBFDJDD@BABBDABADAFEBCHALGH@B@L@LBPFLBB@BBD@@@DBBBB@BB@@B@B@@BBA@@B@@@@@B@B@H@FDD@HBHFFBBBD@HAB@FBFAF@FCPCJ@D@@@D@DBBBDBBBB@BBBDDDBD@DBD@B@D@D@FAB@D@@BB@DADBD@D@HBH@DBDBD@DDDBDBBDDBDDDDDB@FDFB@BFBF@HBD@H@JAHAFAH@FAJEDARIDC@@BABA@AB@@@BBDBBBDBB@BBB@DBD@BBB@B@B@D@@@B@@@B@BBBB@@@B@@@B@@@@ABADCB@@CDABABABAB@BA@@B@D@BBB@BBBBBBBBBDBB@DDD@BBBB@@DBB@B@B@B@DBBBBBB@@BBB@@@@B@@DAD@H@BADADAD@B@D@BBBDB@@@B@B@BA@A@ABAB@B@FAFA@@B@D@DBF@B@BDHBD@D@D@DAD@BBF@B@DBB@BBDBD@D@D@@AFAB@DAB@BCBC@CBA@@@A@C@A@C@C@C@@@C@A@@BC@A@@BA@ABAD@B@DABAB@BAB@BAD@@CB@DA@@D@B@B@DBB@B@D@B@B@B@BAB@BAB@B@B@@AD@D@B@B@D@B@B@DABABA@A@EBA@AB@BAB@B@BB@@B@D@B@@@BBB@D@B@D@B@DAB@BAB@@@BABABAB@@@B@@@BA@@B@BBB@BBBD@@BBBB@BB@@DBDADBB@B@BDBBB@@BB@@@D@B@BAD@@@@AB@BADAD@@AB@B@@@B@BBBFBBAB@B@B@@@BBD@B@B@DDBBBBFBB@B@@@B@DBB@DBD@@@B@@@D@DBB@B@@@BBAHCFA@@@A@@@A@ABA@A@@DAB@@A@@@A@A@@A@@@@A@@@ABA@A@@DABA@A@@BA@@B@BAB@@@@A@@@@B@B@@A@AB@@@B@@@@@B@BABA@@B@@@BBBB@@DABA@AB@B@BAB@BA@@BABA@@B@BA@@BA@@@@B@B@@@BA@@DB@@DA@@D@B@BAJAB@@@B@BAB@@@@B@@B@@BBA@BB@@@B@DA@@B@B@@A@@@BHE@AAO@CBAAKAA@CCA@@BADCDADB@@JDFA@@BC@EDADEACBGBA@C@AF@JBB@AAB@@AAAB@AA@@AA@AA@@A@@@@@A@A@@@@@@BA@ABAB@DABABADBBD@@@DBDD@@F@@D@BBBAB@JC@@B@@BBBB@B@@@DBDB@@B@@@B@ABCH@BB@BA@@B@DABBHFDABABADA@AB@BBBAB@B@DA@@@@BA@@D@@@F@D@FBB@FAB@AAAA@C@ABABA@@@AAA@A@A@@@C@@@A@@@ABA@@A@@@A@@@A@AA@@G@@AA@A@A@ABC@AAA@A@C@CA@@@@A@@@@@@@@@@@@BA@@B@@AA@@A@A@@@@@AAA@A@@@@A@AA@@ABAB@@A@@@@@A@@@@@A@@@CAAAAC@AA@A@@@@@AB@BABAA@@A@AE@BABCAAA@@AAC@@AAA@@AA@AAA@A@@@A@@A@@BAFEA@@A@@AAA@BC@AA@@C@@@@@@@AB@D@@@B@@AD@B@@@AABA@@@AB@@C@@BA@@B@@@@AB@A@@@@A@@@AAA@@A@@ADA@@@A@AA@A@@@@@@ABA@A@A@@@A@@AA@C@A@@@@AA@C@A@@@A@@AA@A@EA@A@A@@AA@@A@A@@@A@@@A@@@@A@@AAAA@@BA@A@@@AB@@@B@FAB@@AAA@ABCB@@@@A@AA@@BCCGCA@A@AB@BCA@@AAA@CAA@@@A@A@A@A@@CC@@AADK@A@@@A@@@AB@@AB@@A@@BA@A@@@AB@@AB@B@@@B@B@BA@BB@B@D@BB@BB@@@@B@@@@@@BA@A@@B@AAAA@AB@BEA@@AB@BAAA@@BEAI@A@CB@@CBA@ABADC@GJED@B@DC@@HBBAB@B@ACD@BABA@ADBAC@@BAB@B@AABCB@@@AEBAB@@CD@@AA@BEBABC@A@AAA@@BCFCB@@@AA@@@AD@DBB@@@F@BA@@BBB@@CFC@@@@DBB@RGD@HDDBB@FBFADCD@BCFAB@DAFD@@HDD@DE@@JI@@@CBAAA@A@AAAAE@@@ABC@AEBCCA@C@A@A@A@CAA@A@@@CAA@C@EC@ABAB@BA@A@@BAB@B@BCBAHEDG@@F@HADAAABA@ABC@ABAAA@ACDA@@@CACBC@A@AA@@B@@CA@BA@C@@C@CACA@AECE@@AAABABA@@@CBADA@ABAAA@ABEB@@ABAA@BAAAACAAACEA@@B@BA@AFCD@@@DBFDDBD@D@DBBB@@DBB@B@BD@C@AFIHE@EBA@ADADABA@@@ABADA@CBAAABC@@DAB@BAD@B@B@B@BCBA@@BA@@BAA@A@@A@A@@@A@A@@@A@@@AA@@A@A@A@@@@@AB@@A@ABC@@@C@ABAD@DCB@@@@@@AB@@@B@@BB@B@B@@@DB@@BBBDBBBAB@BBB@DDD@@@BC@ADACAAA@A@AAA@@@A@CDCB@DA@CFEDAB@@BF@@G@C@A@A@@@A@@AA@ACC@AB@@@@A@CBA@@@A@@BA@@BA@@DA@ADAAA@@BA@A@A@A@@@@BABA@@@A@A@AAA@@CA@@@AAC@@BA@AB@DA@@B@@A@@BA@@AA@@BEBA@@@@@AAA@@@ABA@@BA@@@@A@@AB@@A@@@A@@B@@@@CBABA@@@A@AB@@@@CA@ABA@A@@A@@@@@AB@@@BA@@@AAAAA@@@AB@@AB@B@@@@@@AB@B@@@BCBA@A@A@A@AB@@A@@@A@C@AB@@@@A@@B@@A@@B@@C@AB@@ABA@@@@@AB@BA@A@@BA@@@A@A@AB@@@BCB@@A@@BA@@B@@ABA@A@@@@A@@ACEA@@AA@@AA@AA@A@AAABAAA@@@AAA@@CC@@@@@@@@@ADA@CBC@CCCBIBADEB@BA@@ACFCBCCAECAA@@@@E@A@@@A@A@A@A@A@A@ABA@ABABA@A@C@A@A@AAAAAA@@@@A@@BA@@@@BABA@@@A@ABA@@B@@A@A@@@ABA@CBAA@AAA@@BABE@@BCBA@A@@@AC@A@@@ABA@@B@@CB@@ABAAA@@@C@A@A@CA@@@BA@@@@@CD@BAB@@@B@B@B@@@@A@A@@@@@@A@A@AA@B@@AA@@@AA@@@CFGCC@A@GACBC@AA@@@BAAA@@BCBA@A@AB@B@BA@@B@B@@@B@FEBAB@B@BAB@B@@@B@B@B@BA@AB@DADABABAB@BABC@A@A@CB@B@@ABA@A@A`S@@CA@@AAA@CACA@A@@CCAC@A@ACAGAABC@EAGAAHBDA@AAAAC@C@@AAA@B@BA@AEGAABCAC@@@ECAEEB@@AB@BA@EAABKCAAA@@ACABAAAAAC@A@A@ABC@CBC@A@@BID@DA@@BABBD@@C@AD@B@@@@ABEBCAI@AA@C@@EE@@@A@ACAC@@A@@EA@@ABA@EBCACAA@AA@ABA@A@@B@@AAA@@@AA@@BC@AB@@@A@@@CA@A@@@AB@@A@A@@@C@A@EBA@@BA@C@@AA@A@AA@AAACACCA@AA@CC@A@C@ABE@C@A@A@@BCB@@A@A@A@CAABA@@@E@ABABEAC@@@CA@@ABA@G@C@@@A@ACAAABC@ABA@CBCA@@ABA@CA@A@@AA@A@AAAA@@A@@CACAABAABABA@@AAA@AC@@CAC@AC@@ACDCDCB@B@B@@A@A@@A@AA@@BC@@@@A@@AAAA@AAAA@@@ABCB@@@BAA@AAA@@@A@@@A@A@A@@@@CDA@@AAGEKBCDA@BCAAA@@AA@CCAAA@CCA@ABA@ABA@GC@@GCCBAD@D@@@DAB@B@D@B@B@D@DCB@B@BAB@DABCD@@BBCFADEA@A@A@AAAA@ED@B@B@@ABBBA@ADCBBB@DBBFB@@A@@BBBBD@BCD@@A@@BAB@@@B@B@@@B@BA@@BA@@B@@C@AAG@CB@D@@A@KA@@C@A@A@AA@CBAAABCBABA@@CAAC@AA@@CA@BCAABA@CAG@EDCBA@@AAC@AA@A@ABBDA@AACCBCCCAA@M@AAA@C@AAEA@AA@CB@@CACBAA@A@EAEB@BG@E@CDCFCDE@CBC@@D@B@@@F@DCBGB@B@@AB@DE@@@AB@B@BA@A@CDE@ADGB@BE@AACBAAA@@@ACAA@C@A@@@BDADABC@@BBDABABCBABADADECC@C@A@A@AACAC@@@A@A@A@EAAAAA@B@@BBA@AJ@@A@@DAB@@A@A@AA@@CB@@ABA@@BCACDCAA@CDA@@B@DCD@DA@@B@BA@CB@BKJ@DAHAB@DA@C@CDCC@AIE@AA@@AGE@@BA@ABA@AA@BCDCB@AE@@IFCBACCE@ABA@ABAACBC@@@@B@@@BABA@@@AB@@A@@AA@AB@@@@@BABB@@DB@@BB@A@@B@@@@A@@BA@@@A@@@A@@AA@@@CB@@CB@@AB@@C@A@AB@@AB@@A@C@@AA@@@A@@@C@A@AB@@A@@A@@ABA@@@CDC@@@ABA@A@@@ABA@A@@@ABA@A@@@A@@A@@A@@A@A@@@A@AAAAA@EA@A@A@@AACAAA@AA@A@@@AA@A@@@AB@BAB@@@BCB@@AB@@ABA@A@@@@AAA@A@AA@@A@@@@@B@BCB@DA@@@@BADABC@@AC@@@@BA@@@ABA@A@AAAB@@@@ADA@AB@@@@@@A@@AC@A@@B@@AB@@A@@AAA@A@A@A@AAA@@AA@@@@AA@CA@AA@@@@A@@@@@@B@@AA@@@B@@A@A@@@@A@@A@@@AB@@@@A@@A@A@@@@@@A@@@BB@@@@AA@@@@@B@@A@@@@@@@A@@BB@A@@@A@@B@@@B@@@A@BA@@B@@AA@@@B@@A@@@@@@@@@A@@@@@@@@@@B@BAB@DC@C@@CC@@AA@AA@A@A@@@AA@@CAAAA@AAC@A@A@A@@@A@@@ACAAA@AC@@@AAACA@A@AA@AA@@AAA@CA@BAA@@@@A@@@@B@@AB@BAB@BA@AD@@ABC@@@@@A@A@A@A@@@A@A@@@C@@BC@@B@@@@@B@@A@@B@@A@A@A@A@A@ABA@@@A@CB@B@@A@@DA@@@A@@B@B@B@@@@ABA@ABAB@@@B@@@DA@BBA@@@@D@@AB@@@B@BA@@@ABCBA@@@BBA@AB@@CAA@A@A@A@A@C@A@A@CAA@AB@BCB@@A@ABA@AB@@ABABA@@B@@A@@@A@@@@AA@A@AA@@ABA@@B@B@@@@@BAB@@@@A@AA@BAAA@AA@@ABAB@@BBAB@@@D@B@@@@A@@B@@@@A@A@@@A@@BAB@@AB@B@@AB@@@BA@@@A@@@ACA@@@@AA@@@A@@@C@AB@@A@@@@BAB@@AB@BAB@@A@@@A@AA@@AB@@@BAB@B@@@@@@D@DB@B@B@@B@@B@@@@BB@@@BB@BBB@@@@@@B@@@B@@@@BD@@@BABBB@@@B@@B@B@@B@@B@@BA@@@@@@BAB@@@BA@@BA@@@@B@@@@A@@@EAA@@@@@AB@@ABA@C@A@AA@@CA@@C@@AA@ABA@A@C@A@A@A@@@@B@BA@@BA@@BAAA@A@A@@B@BAB@B@@BBBBA@@@@B@@@@@DBB@BB@@BB@BB@B@@BBA@@BABA@@@A@A@@@A@@@BA@@A@A@@@@AA@@@A@@@AB@@ABCBABABAD@@@B@@A@A@@@A@@@@BCB@B@@A@A@ABABA@@B@BA@@@A@@@@@@@@B@@AB@BA@@BA@A@@B@@BBB@@@@BBDB@@@A@ABA@@@A@@@CC@@A@A@@AABCB@@@@A@AA@@A@@ACBA@A@A@A@C@@@AB@@@@A@GBA@@@A@@@CDB@@DAB@@@@CB@@A@@B@@AB@@A@@BA@ABA@C@@@A@@@@@A@CA@@@@A@A@AB@@@B@@CBABAB@@AB@@ABA@A@@BAA@BA@@@A@A@@@AB@B@@@B@@@BA@AB@@A@@@A@@@ABA@A@AB@@AB@@A@@@A@AB@BA@@B@@@@@BA@@@@@@BA@@B@BAB@B@@@@A@@BA@@@AB@@A@@@A@AA@@@@@@A@ABABA@ABA@@B@@AB@@@BAD@@@B@@A@AB@AA@A@@BA@@B@@@@A@@@A@@@@BA@@B@@@BA@@@@BBB@@@B@@@@AB@@@B@@@BBBAB@B@@A@@BA@@D@@AB@B@@@B@B@B@BA@@@@B@B@@BBB@@@@BB@@@BB@BB@@BB@B@@@@B@@BB@@BB@B@@@@BBB@@@BB@ABB@@BB@@B@@BBBBA@@@BB@B@@@BA@@B@@@B@@@@@BB@@B@@@B@@BB@B@B@D@BB@@BB@@BA@BB@@B@@DB@@@@BB@@B@B@B@B@B@@@B@@AB@@AB@@@BA@@BAB@@A@@BB@@D@B@@ADB@@B@@@BA@@BA@@@@@ADBBBB@@@BABB@@@B@@@@A@@@@@@@@B@@@@@@CBAB@BA@@@@BA@@@@B@@@@@@@@B@BAB@BABAB@@@B@B@B@B@@A@@BA@@@AB@AAB@@@B@@@@@B@@@B@B@B@@@BAB@@@DAB@B@@@DC@@@@B@@@B@@A@@@A@@BAB@B@@ADC@@BAB@@AB@BA@@@@B@@A@@B@@@B@@BB@@ABA@A@@B@@@@@BABA@@@AB@BC@@@BB@BABB@@BABBB@B@@AB@@@B@D@@@BA@BBA@@@@BA@@B@@@B@@@@@B@@@B@@@B@BAB@@@@@@@B@@@@@BB@B@@@@@B@@@BBB@B@@@BBB@BA@@B@@@B@B@@BD@D@@@B@BB@@BB@@B@BDD@@ABABBD@BA@@@BB@@BA@@B@B@B@@@@@@@@BBB@@@@@@BB@BD@B@@@B@B@B@@@@@@B@@@@@B@B@B@B@@@@ABAB@@A@@B@@@@A@@B@@A@AB@@@B@@@@B@B@BBB@@B@FABB@BBFBB@DBF@@BABABABBB@@@@DBDDB@DABADCB@FBD@B@@BADC@BFB@DBAB@BADBBJ@B@BBDB@BFDB@BCBA@@@AB@@A@@BABCB@@BFA@A@@D@BABAD@@HBBCJAD@BBBCDADA@CD@DEB@BA@@DCD@BAB@BADA@CD@DA@@@ABA@@BA@C@@@A@AB@@BB@@@BBB@DBB@BCD@@CB@B@@@BA@C@ABABADABA@EBA@A@C@AAAAA@@B@D@@@BBB@BBBAB@@ABCBG@ADB@BB@@@@BBAB@@@@@BAB@@AB@@@@@@@BC@@@A@@@A@A@E@@@CBCD@B"],encodeOffsets:[[109352,25523]]}},{type:"Feature",id:"451223",properties:{name:"凤山县",cp:[107.04219,24.546876],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@A@A@C@@@AA@AA@@@@A@AB@@@AA@A@@@A@A@A@@@CB@@AA@@A@@B@BA@@@@AA@@AAAA@@@A@ADA@A@A@@@@B@BAB@@@@@CC@@B@@@@@CC@A@@AA@@@@A@A@AAA@AACA@@AAA@@@A@AA@@C@AA@@@ABA@@@ABA@@AA@@AA@@@@@AAA@@@AA@@@A@AA@@A@@@@@ABA@@@A@@BA@A@@@@@A@@@A@@@ABE@A@@@ABA@@BAB@@@@A@@@A@@B@@@B@@ABA@@@@B@@AB@@@@C@A@A@AAA@CAAB@@A@CB@@A@@B@@CBA@@@A@@@A@A@@@A@@@@@@B@@AB@BA@@@@BBBB@@@B@B@B@B@@@BB@@BB@B@@B@@@@D@BA@@B@@B@BBB@@B@@@@@BBB@@@@@B@@@B@@A@@@@@A@@B@B@@@B@B@B@@@@A@AB@@A@@B@@A@A@CAA@@@A@@@BB@B@@@B@@ABABAB@@A@A@@@@@AB@@@B@BAB@B@@@@AB@BAB@BB@@@AB@@@@@B@@BBBB@@@@ABABA@A@@@A@A@AB@@ABA@AAAA@@@@A@@B@BBB@@A@@B@B@B@@BBBBB@@BB@B@@@B@@@@@BAB@@@B@@@@@@B@B@@@B@@B@B@BB@@B@BB@@B@@@@@@@@A@@@@@@B@@@@@@B@B@@@@AB@@A@@@@@@BD@@@@@@B@@BB@B@@@D@@@B@@BDA@@@A@@@BB@@@BB@@BB@DB@@@@@B@@BB@@@B@@@BA@@@@D@@A@A@@@A@@BC@@@@B@@AB@@@@@@@BB@@@@B@B@@ABABAAC@C@@@CB@@@@@@AB@@AB@DABA@@B@BBB@@@@@B@@D@DAB@BB@@@@@@@B@@A@@@@BBB@BDD@@@BAB@@A@@BF@FBB@BBCBBB@BB@@B@@D@@@FB@BB@@AB@B@D@@@ABAB@@ABA@@B@@@B@@AB@@A@AA@@A@A@C@AA@@@B@@@@AB@@AB@@BB@@B@@B@BB@@@@B@@B@BB@@AB@BA@CD@@A@@@A@CA@@A@@B@B@@B@@@@DA@ADA@@@BB@@@@AB@@@BB@AB@@@B@@@BB@@@AB@@@B@B@B@@@B@@@@B@@BA@A@@@A@AA@@@AAA@A@@BA@A@@AAAA@@A@@@AA@@AA@A@@@@A@@@@A@A@@B@BA@@@@@@AC@AA@@AA@AD@@A@A@AA@@AB@B@@AB@@AAA@E@@@EB@@A@A@@@ABA@@@@@A@AB@BAFAAA@@@AB@@@@@BB@@@BB@@@BBB@@@@A@AB@@ABC@@AA@ABAB@BA@A@AAABA@@B@@@@@BAB@@@BA@@BBB@@@B@@BB@@D@@@@BBBBBA@@@@BBB@B@B@@@@@@@B@@@@A@AB@BA@A@ABABA@AD@BA@@@@BA@ABA@@B@@ABA@@@@B@@@@@B@@@BA@@@@@BB@@AD@B@@A@AB@@@@A@@@@@AB@@@BA@@@AB@@A@@@@@@@@@@@@AA@A@@@@@AB@@@BA@CA@@A@A@@@A@@@A@AB@@AB@@AB@@@B@BCB@@@@A@A@A@@@@BAB@@A@@@A@@DAD@@A@A@@@@B@@AB@@@BBB@@B@B@BB@@@B@@BB@@BB@@BBA@@@@@AB@B@B@B@B@@@B@@@B@@@BB@B@@@B@BB@@@@@B@@BB@@@@B@@@@@@BB@@ABBB@B@@@BBBB@BDB@@@B@@@B@B@B@@A@A@A@@B@@AB@@A@A@@@@B@B@@B@@B@@@@A@ABC@A@AB@@AA@@@@@@AD@@A@@BAB@@ABA@@@A@ABBB@B@@A@@@ABA@@@@B@@@BAB@@@@B@@BB@@@@@B@@BA@@BB@B@@@@BB@@@@B@@@B@B@@B@@B@@@B@@A@B@@B@BA@CB@@@BA@@@A@@@ABA@I@@@A@@@AA@@AA@@@A@A@@A@@@AB@@@A@@A@A@A@CAA@A@@BA@@BA@AA@A@@A@A@A@@AA@@B@@AB@@@B@@C@A@A@AB@@AB@B@B@@@@AB@@@BABB@@B@@@@AB@B@@@@@@B@@B@@ABAB@@@BB@@B@B@BAB@B@BA@AA@@AAA@A@AB@@BBAB@B@B@@@@@B@@BBBB@BB@@B@@@@@BA@AB@BBB@@@@B@B@B@B@B@B@@@B@@@BB@@@@BB@@B@BB@B@@@@AB@@A@@BAB@B@@A@ABA@A@AB@B@@BBB@@B@@@B@B@@BB@@B@@@DDB@@B@@BDB@A@@B@@@B@@@B@@CB@BA@@@A@A@A@@@@B@@BB@@BB@@@BA@@B@@@@BB@@BB@B@@BB@BF@BB@B@BB@BB@@@BB@@@@B@BBB@@A@A@@@EB@@A@@BA@@@A@@@A@@B@@@@@B@@@BB@@@@@@B@@@@ABC@@AA@@AA@@@@B@@ABA@BB@@BD@BABA@@@@B@B@@@@BB@B@BB@AB@@@B@@@D@@@@@BB@@BB@@BA@@@C@@B@@A@@@@D@@@@A@AB@@AA@@A@AB@@A@A@@@@@AA@@A@@@ABBBA@@D@@BB@@@BAB@@@@A@@@A@A@@@@AA@@@A@@@@@AA@A@@@@A@ABA@@@AB@@A@A@@@A@ABA@A@@BA@A@@@ABA@A@@@AA@@@@@A@@@ABA@@AA@@A@A@CA@@A@ADA@@@@BA@A@A@A@AAA@@A@@@ABA@AD@DAB@BBBA@@BA@AA@@A@@A@AAC@@AC@A@A@@@A@A@A@@@AA@@AA@@@@AA@B@@AB@@@@A@@@A@A@A@A@@A@@AA@@AA@@AA@@A@@@A@A@@AA@@@A@@@A@A@@@AA@@AD@B@@A@AAA@@@@@@AA@AA@@@A@@AA@@A@@@ABA@AB@@@@@B@BB@@@@BBB@B@@@B@BBB@@AB@B@B@@B@@@@B@BBB@@@B@BB@@F@@@B@BB@@BBB@@@B@@DAB@@@B@@@B@D@B@B@@@BBB@@B@BB@@BB@@@@@AB@@@@BB@BDBBBB@B@@BB@@@AB@@@@A@@@A@A@@BA@A@AB@@@@@@@BB@@@B@@@@@BB@@B@B@@@D@@B@@ABAB@@@@BB@@BB@@B@@@@@@@A@@@BBD@@B@@B@B@B@B@BB@BA@@B@@B@@@B@B@@@BA@@BA@AB@B@@@@ABBB@B@@B@@DC@@B@@@DBB@BA@@BA@@BAB@B@BB@@B@BBBB@@@B@@B@B@B@BB@@B@BB@@@@BB@@B@B@D@@@@@B@BB@BB@B@B@@@@BB@@@@@DBB@DB@@BBB@B@B@B@@@@BB@B@@BB@B@@@BBB@@AB@B@D@@AB@@A@@BAB@BA@ABB@@@@B@@B@@@@@BBB@@B@B@B@@AB@@@D@B@@@B@@@@@DB@BB@@@BB@@@@BBB@B@@AD@BBB@BB@@BB@@BD@@ABA@@@BBB@@@B@@B@@@ABA@A@@BA@@BBB@@@B@@@BA@ABA@@BA@A@@B@@@BAD@@@B@@@B@@B@@B@BD@@B@@@B@@A@@BA@@@ABA@@BA@AB@@@B@B@@@@@@AB@@@@@@A@C@@BAAA@@BABA@@BBB@BB@ABBB@@@@@BA@A@@@@@A@AB@BA@@BBB@B@@B@@B@@@B@@@@AB@BABA@@BAB@BAB@@@DA@ABAB@DBB@B@D@B@B@B@B@B@DB@@BAB@AA@@B@DABA@@B@@A@A@@BA@@@C@@B@AAB@@C@@@A@@BABAB@BA@@@@@A@A@AB@@@B@@CB@@@@ADAB@@@B@BAB@B@B@B@B@@@@AB@@@@A@@@@@AD@@AD@@@B@B@@@B@B@B@B@@@@@D@BA@@BCB@@ABA@ABA@@@A@@B@@@@@BB@ADBB@BB@@BBB@@B@BDBBB@B@@BDB@BBBD@@B@@@B@B@B@D@BBB@BBDB@@BB@@B@B@B@@BBB@@DD@@@DCDA@ABA@@@@@@@@@@@@B@@@@@@@@@BA@@@B@@BA@@@ABB@@@A@@@A@@@@B@@@BAA@@@B@@@@@@@BA@@@@@B@@B@@A@@A@@@B@@@@B@B@@@@B@@A@@B@@@BB@@@@@@B@BA@@@B@@BA@@@@@@@@B@@B@@BDBB@@B@@B@@BB@BBB@B@B@B@BB@@@BA@@BA@@@@BBD@@@B@@@@A@@BCB@B@@A@BB@B@BAB@B@@AB@@@@BD@@ADCBAB@@@@CBA@ADA@@@@@B@@@BBB@B@BB@@@@@BAB@BA@@BA@AD@@A@ABA@@B@@@BBB@@B@B@@BBBDBBB@@@B@BFBB@BBBBB@@@B@B@@@@BB@@@@B@@@BAB@B@@@BAB@B@@@BAB@B@@CD@D@@AB@BB@@@@BA@@B@B@D@@@B@@BB@@@D@BA@@BA@@B@B@DA@@BA@@DA@@D@@BB@@@B@@@B@@AB@@@B@@A@@@@BAA@@CA@@AAAB@@@@A@@BBB@@@BA@@B@@ABAB@@A@@@@@ADBDAB@BAB@BDFBDDAJE@@BFA@CDADB@@BAB@BAB@@HF@BB@@BJF@BDDDCD@B@@CBABG@CLI@ADAB@@A@AB@@CDC@C@AB@DCB@DBDCDB@AB@BA@@DA@@BBB@B@@@BA@CB@@@BIB@AA@@@ABBBBFBB@B@B@@@D@DBBBB@B@D@D@FDBCBCBADABABAAC@AD@BABCAC@@B@D@B@DB@B@@BBABBD@BAFA@CH@BCF@D@BABA@A@@B@@CFA@@BA@A@AHCDE@@@A@C@@@AD@DCFEDCD@D@FAHA@BF@F@BBBDADB@@DAB@@BFBBBD@B@BBN@B@DBDDDABD@BCBAA@B@BBBD@BB@@ABCD@FBH@DABBBADB@@DB@@BBDDB@@ABABADBBAB@DBBB@B@D@@@LBB@@@@CDAH@BBD@@@@AB@@AB@@A@A@@@A@A@@BA@AB@@@DC@AACAA@AB@@@EAAA@CAADABCB@AABA@@@A@AFCB@BB@B@B@BFBBCDEAA@@DCBA@CBA@A@ADA@C@C@A@A@C@ABA@C@@@CBCDAHD@@HDB@BAB@BAB@DDB@BBDDB@@BB@BBADB@DCLAA@ACAA@@@ABAD@@A@A@AB@AABCBABAD@B@DA@@BAB@DA@CCCBABAF@@A@A@@EAA@ACAAA@BAB@@EA@@AB@BB@@B@BB@@D@B@@@BB@BBBB@B@D@@BDDBAB@BB@B@@BB@B@@BBB@@@B@BB@AB@B@BA@BDDB@BBB@BC@A@CB@@ABA@BF@DBB@BB@BB@BBD@B@AD@@BB@BDBB@B@@BBBB@BB@@BBBBDBB@B@@BB@D@F@B@B@F@BA@@B@@@DA@CB@FAB@@B@@BBB@B@B@BDB@B@@BB@B@@A@@DABAB@B@B@B@BBB@@@DBB@@BBBBAB@@@BABBB@B@@BFBB@FC@@BAAC@@@@@A@A@AA@@CBE@ABA@@CAAA@A@CAABABAB@BABB@@B@BBB@@@FBB@@@DBBAD@D@B@D@@@@AA@@CA@BAA@@A@@@@AA@@@AB@@AA@@@B@@A@@@@@@@@@A@@@@BA@@@@@@@@@A@@@@@@BA@@@AA@@@B@@@B@B@@@BA@@B@B@@@@@@@BA@ABA@@@ABC@@@ADCAAA@@A@@@A@A@@AAAAA@@A@@A@@@@AA@@@@A@A@@@@A@@AA@AA@C@A@A@A@AB@@@BA@@B@@A@@@@@CA@AA@@A@@AAA@A@A@A@@@@@@@A@@@A@@AA@AB@@A@A@A@@B@BA@@B@@AA@BA@AA@BA@@@A@@@A@@@A@@B@@@@@DABA@@@@@A@@@@AC@A@@@A@@B@@@@@@@@A@@B@@AB@@A@@@ABA@@BC@@AAAC@@@A@A@@AABA@@AAB@@C@AAABA@@AA@@@@B@@@BC@@AA@@@@@A@@BAB@@A@AAA@@@@@A@AB@@ACC@A@@AA@@@A@AA@@@@AAA@@@A@@@A@@@@@@@A@@@A@@@@AAB@@A@@@A@@@AA@AA@@@A@@@AB@@AB@DCD@@AD@@A@@@@@A@A@@@A@@@@BCB@BA@@@AB@B@@AB@@A@@B@BA@@B@@@D@BA@@BA@A@C@@@ABABCB@@@B@@A@AB@@@@A@@@A@@@@@A@@BA@@@@BA@ABA@@@@@@BA@@@A@@B@@@@@B@@@B@@AB@@@@@@AB@@@@A@@@@@@@A@@BA@@@@B@@@@@@@BA@@AA@@@A@A@@BA@A@AB@@@BA@@@A@ABA@@@AB@@@@@@@BA@@@@@AB@@ABA@AB@@A@@@@@@B@@ABEB@@@@ABA@@@A@@AACA@@@@AAA@A@@@A@AA@@@@B@@ABA@@@@BAD@@A@@@A@@BAB@@A@B@@DA@A@@@ABAAABA@@@@@AB@AA@@@@@AB@@A@@@A@@BAAA@@@@@@BA@@@@@A@A@@@A@A@@A@AA@@AAAACAAA@@A@@BEB@@@@A@@@A@A@C@@@@@A@@BAB@@A@ABA@@@ABA@@AA@AAAA@@A@A@A@@@C@AA@@A@@@A@A@A@ABA@@@A@A@@@@@AAAAA@AAA@A@A@A@A@A@@A@@A@@AA@AB@@A@@AA@A@A@A@@@@AA@A@@@A@AA@@AB@@A@@@@BA@A@AA@@@@A@@BA@A@A@@@A@A@@@@AA@A@A@AAA@A@@@A@@@@AAA@@@@A@A@A@@BAAA@CAA@CAAAA@A@@@@@@A@@@@AA@@@A@A@@@@AA@AA@@@A@@@A@A@@A@@BA@A@@A@@@A@AAA@@@@@@A@@@AA@@AA@A@C@@@@@ABC@A@@@@AAA@@@@AA@AAAA@A@@A@A@@ACAA@@AAAA@@@BA@AAAA@@CA@@@A@@@A@@@ACA@@BA@@BBB@BBD@@B@B@@@BB@@BBA@@BA@@BA@@B@B@@A@@@A@@AA@@AAAAA@A@A@A@@A@@@A@@BAB@@A@@AACA@@@@A@AAC@@AC@CC@@@CB@@AA@A@@@A@@@A@AA@A@@A@A@A@@@C@A@A@A@A@@@A@@@@AA@@@@@BC@@@A@@A@@@A@@@A@A@CB@@@@@B@@@B@@@AA@ABA@A@@A@@A@AA@AA@@A@@@@A@@@@@@A@@@ABAA@AAA@A@@AA@@@@@A@AAA@A@A@@@ABA@AAA@@@@@@A@@AA@@@@A@@@@A@A@@AA@AAA@@AACC@ACA@AA@AA@@@@@AB@@A@C@@BA@@@@@@BBD@@@B@@@B@@@B@BA@@@A@@@AA@@@@AB@BA@@@@BA@@@AB@@@B@BBB@@B@@BB@B@@@@B@@@DBB@B@@@BA@@@@@@@A@@@A@@@A@@@A@@A@@@@ABAAA@@@@AA@@AAA@@AA@@@A@AA@@BA@A@@@@AAAACAA@@AA@@@@@A@@@@@A@CA@@@AA@AA@@A@@A@@BA@A@A@@CA@@AA@@A@@@@AAA@@AA@@@AC@@@A@A@@@@@A@@A@A@@@@B@BA@@@AB@@@@@AABA@@@@@A@A@@B@@@B@@A@@B@@@B@@@@@B@@B@@BAB@@@@@@A@@BB@@BA@@B@@@B@BB@@@BBBADB@B@B@B@BB@@@@@@B@B@@@FBB@@BB@B@@ADBB@B@@AD@@@@@@ABA@@B@@A@@A@AAAE@@@A@A@AA@@C@@@A@A@@@C@A@AA@@AA@A@@@A@AA@@ACAABA@@@CD@BB@@@@B@@B@@B@@B@@@BBB@BB@@@BC@@@A@@@AA@@@AAA@AAAC@@@A@@BA@ABA@A@AA@@AA@@AABA@@BA@AAAB@@AA@@CB@@@AA@@A@@@AA@@A@@@A@@@@D@@@@@B@@@B@@@D@@A@@@@@AA@@AC@@@A@@@A@@@@ABAA@@@@@@AAAA@@@@@C@@@@B@B@@EBA@A@@B@@A@A@A@@BAB@@@B@DA@@@@@AB@@@@@@@@A@@@@A@@AA@@AA@@@A@@@AA@A@@@ACAA@CA@A@A@@@ABCDAB@@@B@B@@@B@B@@DBB@@@@BAB@B@@A@A@@@CBA@@AA@@@C@A@AAA@@@@@A@CBC@@AA@@@@AA@@AA@AB@@A@@A@@@A@AA@@@@CA@AA@AC@AAC@AA@@A@@@C@AAA@AA@@C@A@A@AA@@@@BC@A@AB@@A@@BA@AA@@A@A@A@@BA@@@A@ABA@C@AAA@A@@@A@@A@@AC@@AAA@@AA@@@AA@@@@A@@@AAAAA@@@@A@CAC@@A@@A@@@@@A@AB@B@BA@@@AB@BAB@@@B@B@D@@A@@@C@AAA@A@@@A@@@ABA@C@AA@@@@CA@@A@@@@@A@A@AA@@@@C@A@A@A@@@ABC@ABABAB@@A@A@@@AAA@@A@@AA@A@@A@@AAB@@CB@AA@AA@@@CA@@AAAAA@@AAAAA@@@@A@@@AAA@@AC@A@@@ABC@@BC@A@@@A@@@@@ABAB@BA@@@@@AA@@@AA@AC@@A@@@@A@@AAAA@@A@A@A@@@@A@A@@@A@@A@@@@A@@AA@@@@AA@AA@@A@@BA@@@C@@@A@@@A@@@@@@@A@@@AAAA@@@@A@@@@@A@@@@@ABA@AAA@@@A@A@@@AA@AAA@AAA@@@A@A@A"],["@@A@EBI@AACAC@ADAB@HDFFDDBD@FAACBADAFC@C@A@AAA"]],encodeOffsets:[[[109530,24879]],[[109716,24949]]]}},{type:"Feature",id:"451226",properties:{name:"环江毛南族自治县",cp:[108.258028,24.825664],childNum:1},geometry:{type:"Polygon",coordinates:["@@BBHHB@B@@@DB@ADADCB@BHABAB@DBBDBB@@@DBF@@@F@D@DDBD@@JCF@DBDB@@HAHGBCAC@GBGFEHIJCBAB@BCHEHAFCFCDCDCD@@BDBDBBA@@HGFCBA@@DEHMBGBGFABE@CBEFG@CAC@ABCDMDGDGNEFAD@DF@DBDBFBDDBFBJDLBJBJB@@HBDFB@D@FAFAD@FBH@DABEDEJAHCFEDEBE@IBADAFCDC@AA@@AAC@CAC@@AA@EBCB@D@HDD@DBDBF@BEDADA@@B@B@FEHADADA@A@@@A@CBCFADABEBCFAB@B@BGDEDADCBE@CACCCBABCDABAJAFAHAH@FCBE@GFGDA@CDCBA@CCCAA@E@CAAAA@CDABA@@ACBA@@B@BADBBCDEFEDCFCD@BBBGDGBCFCFADAB@DBBB@B@AJCHCFEJEFEBEEEDGAGDAFIBGCGBCDADACECE@AAE@@@@B@@A@@AA@A@A@@BA@A@@@@BA@@@@B@@@@A@@@@@A@@BA@@@ABA@@BA@ABA@@BA@@B@@A@@@A@@A@@AAAAA@A@@@@@A@@@A@A@A@@@ABA@A@CAABA@A@A@A@A@C@@@ABABABC@ABBB@BA@@@@@A@@B@@@BABA@@BABA@@BADAB@BBB@BBFA@@@@BAB@@@BAB@B@@@@@B@@@BB@@B@@@B@@@BA@A@A@@AAB@@A@CBA@@@A@@BAB@@@F@DEFAD@@@B@B@B@BA@@DABABABABCD@@A@@@@B@@ABA@@B@BAB@@@D@@@@@B@@@@@DA@ABA@@AA@@AEEG@C@@@A@@@C@@@@A@BA@@@@@A@@@C@@@AEGCCEA@FAHBFADECEBE@@@@BA@C@@@A@@@@A@@@A@A@@@AAA@AAA@A@@@AA@@@B@@AA@@@@AC@DGA@@A@B@A@@A@@@@@BAAA@@CA@@AE@AA@B@@@@@B@@B@@@@B@@AAA@A@@B@BC@@@@@A@@@@A@A@@@A@DECC@AB@@@@A@AD@@AE@@@@BE@GBA@AGBGACCC@EDGDEBCD@DBHFHB@EHCFEBCH@@DFBFBFEF@@@AA@@C@C@@BA@A@@A@@A@@A@@@C@@@@AAAC@@AA@@AEAAA@@@AA@@@@C@@BA@A@@@A@AA@AB@@AB@@A@C@A@A@A@AB@@AAA@@@C@ABC@A@GBA@A@@@@A@C@@@A@@@AA@@CA@@@AAA@A@@@C@AA@@A@ABA@A@@A@A@A@AAA@CBA@ABABCBA@AB@@C@ABA@@BA@@BA@A@CBA@C@C@A@AB@BADABA@@DA@@BBDC@@AE@A@@@A@@@AAAA@@AA@@AAA@AA@AA@AA@@BA@@@AA@ACAA@AAA@@BA@@@AAA@AAA@@@A@@@@ABA@AA@@C@@@A@A@@@A@A@@@A@A@A@AA@@E@@@AAA@ABA@@ACA@@@AAAA@@@@A@@AAAAE@CA@@A@@@ACA@CA@ABAB@@A@AAC@AAAAAACB@D@DABADA@@@C@@FG@@@@@C@ACC@AAA@A@G@A@E@AA@@ABA@A@@BABAB@BBDABA@CBA@A@@@C@@@AA@CAAA@@AAC@ABA@@BABA@AAC@AA@ACAC@A@A@CAAAA@ADCDC@ABAD@BAA@BCACAABC@E@E@EAC@AAA@AAAB@@A@A@@@C@AAC@CBCBC@@@CBA@@@@B@BBBBBB@@@ABA@A@@BBB@B@BE@AAAAA@A@C@AAA@A@ABA@A@C@A@C@ABC@CBC@@ACACA@AE@CA@@AAA@A@@@@ABAAA@C@A@A@@A@@@ABC@GB@BABA@A@@@@AA@@A@A@@@CBABA@@B@BA@A@ABA@@@C@CB@@@D@B@B@B@DA@C@@BA@@AA@@@A@@@A@A@CAA@A@A@A@ADE@@AA@AB@@AAA@AA@CA@AAA@@@A@A@AA@A@A@A@A@@A@@@A@AA@A@@@@AAAAAAA@@AA@ACA@@@@@ABA@@BE@@B@@AB@BBD@B@BA@@B@@ABAB@B@B@@@@@@A@AB@BC@AEA@AE@C@A@AAC@A@@AAA@@E@A@A@AA@@A@A@@A@@AC@@AA@@@@@@@AB@@A@CAAAABA@AAA@ABAA@@@AA@@CB@@@@A@@@A@@A@@AA@@A@AA@@@AB@@ABABA@A@@B@BA@@@ACA@ABC@CBAD@BC@A@@BABBDBB@B@@@@ABA@@DABC@@AAAA@ABAB@@A@A@AAA@@@E@C@@@A@@@@@AA@CAA@@ABCBA@AABAA@@A@E@CBA@AA@AA@EKAAA@AAA@@BCBABA@@@C@@@A@@B@@@@@BA@@@C@A@@@AB@@A@@@A@A@@@@@@@A@@BA@@@@@AB@@ABA@A@A@CBCB@@A@@AA@@@CCACAA@AAA@@A@A@A@@BA@@@AB@@ABA@A@ADA@C@@@@@C@@@@@@@A@AAA@AA@AAABA@@@C@A@A@A@C@ABA@A@A@@@A@@@@@ADAB@@@@AB@@AA@@@@ADABA@@@AB@@ABABA@A@@AA@AA@@@@A@@@@B@@ABAB@@A@A@AA@B@@ABA@@@@B@@A@@BA@@DAB@@@@ABC@@@A@@AAAA@@AA@ACA@@AAA@AA@BA@ABAAA@@@A@A@@AAAA@AE@@@@B@B@B@@@BA@@@AAAAAA@@AB@B@@@BA@ABA@A@C@@@AB@@A@@CAAA@@B@@A@@@ABA@@@A@@B@@@BA@@@A@@BAB@@A@@AA@A@AC@ABCAA@A@@@A@A@BCBAB@@AB@@@AE@AA@AA@AAAA@@A@@AA@@A@AA@ACBA@AA@@ECECBC@A@AAACAECAAEAAAA@AACCA@A@CC@@A@@@A@AA@ACAA@AC@E@@@@AAA@B@@AAA@A@@B@BA@@BAAABA@@@@A@AA@@C@A@A@C@ABA@@@AA@@AA@@@AA@@AA@AAA@@@A@@BA@CB@B@DABAB@B@@EF@BCA@@A@AAAA@@A@@AA@@AA@@@A@@@A@C@A@AB@@AAA@AB@BC@A@C@A@@@C@A@C@ABA@ABA@@@@@A@CA@A@A@ACC@@@@AAA@A@A@A@ABA@AB@@@B@BA@@B@@ABB@@@@B@@@@@BABEBABA@AB@BAD@@AB@B@@AD@@A@@@A@AAA@C@@@BA@@@A@@AA@@AA@@AAAA@@AD@@A@A@@@A@@@@@A@C@A@@@@AA@A@A@A@AC@AA@AA@@A@@AA@A@CB@@A@C@@@@@@@@A@@@C@@@@@@@@A@AAABAA@@A@CB@@A@A@A@@@ABA@@@@B@@@BCBAB@@@BA@A@@@@@A@A@A@@@A@A@ABA@A@@@A@ABAB@@A@@@A@@BC@A@A@CB@@A@A@A@C@@@ABAB@@ABAB@@A@A@@BA@@@A@A@@@@@ABA@@BA@@B@@@BEB@@A@ABA@C@@@A@EC@@A@ABAB@@E@AAA@C@A@C@A@CBA@A@@@AAA@AAAAA@@@CBA@A@@@@AA@@@@A@@AA@@A@AB@@AAAA@@@@ED@@AAAB@BA@C@@@@@AB@@@BA@@BA@@@@B@B@BBBBD@B@@BD@B@B@B@B@BAB@B@@@@@B@@@D@@@B@@AAABA@A@A@@@@@@B@@BD@@@BB@@BBB@@@B@@@B@@DD@@BBBB@@@@BB@@AB@@A@@@@B@@@B@@@@@@ADAB@@A@ABCBA@@B@@@@E@AA@@AAA@@@A@@BA@@BA@@@A@C@@B@@A@@@A@@@@@AA@AAAA@A@A@@@@A@A@@@A@@@AAA@@@A@AA@@@AA@A@A@@BAB@BAAAAAAAA@A@@AAA@AA@@CCC@@@AA@@@BA@@AAA@A@AA@@AA@@@@A@EA@BA@@A@@@A@CA@@@AA@AA@A@@A@@@@AAA@A@@@A@C@C@EDA@AB@@ABB@@BA@B@ABAB@@@D@@AB@@@BA@@B@@@BC@A@ABA@A@A@A@A@A@A@A@AAA@@@A@AA@@@AACA@@@C@@@CB@@AB@@ABA@A@@@AB@D@@A@A@A@A@A@@@@@@A@AA@@@A@@@@@A@@AA@@A@@@@C@@@ABA@C@AAA@@@AB@B@B@@A@@@CB@BCD@@DB@B@BA@ABABB@BBDBAB@@@@ADABA@@@A@A@@@@@EDA@@@A@A@A@@@A@@@A@AAACAA@A@@C@@BA@@A@@@@A@A@AAA@A@AAA@@@A@A@CAAAA@AA@A@A@@@AAAAAAA@A@@@A@@A@AAAAA@AA@@CAA@@A@@AAA@@AAAAA@@A@@A@@@@ABABA@A@AAA@ACA@A@AB@BA@ABA@A@A@C@ABA@ABB@BBBDB@@BAB@@ABEBA@@@A@@@@B@BA@@@BB@AB@B@@BB@@BBBB@@@AB@@B@@@@B@BA@@DAB@@@@A@A@A@ABA@AB@@@B@@ADADADAD@B@BA@CB@@A@@@@@@@@BA@@D@@AB@@@@AA@@AA@@@@@B@@@B@BA@A@ABA@A@ABABAB@@@B@@@B@@@@BD@BC@A@A@GAE@G@ABBB@BABAB@B@B@DADA@AD@@A@AB@BABA@@B@@@B@B@B@@ABABA@A@@@CBA@@BA@@@@AAAA@@@A@@A@@A@@AA@A@A@AAEA@@@@@A@@@@A@C@AB@@A@@@AAABA@@B@@C@AB@@@@AA@@@ACA@@A@@@@B@@CDBB@@@@AB@@AB@@@@BB@@A@@BCA@@C@ABA@@B@@@@@@@B@@BBBB@BB@B@@BB@@B@@@B@@B@B@BAB@BAB@BB@@@@BB@@@@B@@@D@@@B@B@B@@@BB@BB@B@@B@BABCBA@@@@BAA@@@@A@@@ACA@A@AA@@A@@@A@@@ABAB@B@@@@BD@@BB@@AB@DAB@BBBDB@B@@@DBB@B@@@@@B@@@BB@@@@@@B@@@@@BA@A@A@@@A@AA@AAA@AA@A@A@@AC@@@A@@BA@@@@BBB@@@BBBB@BBDB@B@@@BAB@@D@BB@BBB@@B@B@DDABAB@BBBB@BBBD@B@@@@BB@B@@@@CDA@@@A@A@@@AAAAA@AAABAAA@@@AABA@@C@@AAA@@@@@A@@B@@C@@@AB@@@AAA@@@A@A@@A@@A@C@@@@A@@AAA@ABA@@BA@@@AB@@@@AB@AC@A@@@A@A@ABABBB@@@BFB@@@B@B@B@@@B@B@BBD@@@@ADA@@@A@@@C@CB@B@@A@@@AB@BAB@@@BDB@@B@@@BBBA@@B@@B@@BB@@@@B@@@BAD@DC@@B@@@B@B@B@D@B@DB@@B@@BBBADAB@@ABA@A@CBABAD@B@B@BABABCB@@CD@DA@@B@B@D@@@BABHJB@DF@BB@BBBBD@BBBB@@@BB@@BAD@B@B@DBB@@B@BB@@BBB@BD@@BBBBBB@@@B@@@BC@@BC@CBA@ADABAFAH@B@BAD@D@N@D@DBB@BBB@BBBBB@BBB@BABAB@BCBABA@AB@@AB@DA@@B@D@@@B@B@@AH@BABAB@BCH@BABAB@@A@A@A@C@@@A@A@A@A@@@AB@@@B@BAB@B@B@BBBBD@D@B@BABEFAB@BABAD@D@D@HAH@BAD@D@B@BDH@D@BB@@B@D@BBD@FABABCBA@GHCDABABEDEDEBEBCBEBGFADADA@AF@@@D@D@DADBB@@D@BFDBD@DDD@JDHBJFFDDDBFBF@DBDFFDCD@D@@@L@D@BBDDD@FABB@B@B@DDBB@B@B@DDBBD@B@FBB@DB@BBBDBB@D@F@DAFA@@BBBDBBB@HDBDBBDDBDB@DD@@BBFBBBDB@@PDNBHBLFNFNFPHFFDFF@NC^DB@@@DBD@DBFBF@BCJBN@DBFAJ@JAHCD@D@FADAD@LDXJTLBFB@DBF@BB@BHTDRJBBBD@HDBDADBL@J@JBJDFFDFH@DCFGJENANFNFF@@JFLLDP@NELKHIDCJ@DFBLD@B@BDDJHBHBHBHBF@DABA@AD@FBBBBABAFCBGB@BGL@@FDPDHADALCFDFBFABAFAF@B@BDBH@AD@B@B@B@BD@BDFBHADEBED@FBDFDBB@@CBCBEBAFEHIBCDEBCACECAABCDCHCJ@DD@BDDDD@HDHFHDHDF@FBDFDBFBFEFCDADCF@FAB@BB@BB@D@DCD@DBDC@@HQBG@CAE@EBCD@FBJBF@JED@HBDD@BB@ADBB@JADABGBEFIFABA@AB@@@@@@BB@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@B@@@@@@@BB@@@@@@@@@@@B@@B@@@@B@@@@@@@@@@@B@@B@@@@B@@@@@B@@@B@@@@@B@@@@BBD@B@HJNHLFLBJ@LFFDHLJJFFDBJNHTDJED@@BD@B"],encodeOffsets:[[110903,26134]]}},{type:"Feature",id:"451225",properties:{name:"罗城仫佬族自治县",cp:[108.904706,24.777411],childNum:1},geometry:{type:"Polygon",coordinates:["@@CC@AAA@A@C@ABA@A@@ACABCA@BEBA@@@AAEBA@CAAAC@ABCD@B@@C@CBAAAFA@AB@@BBAD@BAD@BABCCC@ABA@AAA@A@AACAA@AAAAC@C@C@GBC@@B@BABA@@BAD@B@DBD@D@@@B@@@B@@AB@BB@@@@BCD@@ABAD@D@@AB@@@@DD@B@@@D@@@B@B@B@B@B@BBB@B@@@B@BCD@D@BBB@@ABA@@@ABC@@@@AA@AAAB@@@@@B@B@D@DBDABBFBB@BDD@@BF@DBDB@BD@@AD@@A@@@ABABC@AB@@ABAB@D@BAF@FBD@BBDBBBB@@BB@@ABAB@@@B@BA@@B@@@@CAC@@BABAB@BAD@FBB@BAB@@A@CAA@CBAB@@@BBF@BABBBAB@D@B@@@@AAAA@AAAACGAA@A@A@A@@AA@A@C@AAA@@@A@ACCC@AAACAAA@AAAAA@AAA@AEACAAA@A@@CBA@A@ABE@@BABABAB@BBB@DA@A@A@A@ABABA@A@ABCAA@@@BA@@@A@AAC@A@A@@@AAAA@CAA@ABAB@@C@A@A@@@EAAA@A@C@CA@@AA@@CAAC@@AAC@@@AAACCCAAAAAAA@@A@@AA@@@@AGDABA@ABC@ABAB@@@D@@@@@BA@A@AB@@@B@@BB@B@D@BBD@@@B@@A@@@@@AB@@BB@@@B@@@BCB@@C@CAABA@@B@@A@@B@@AB@@CA@BA@@@@BBB@@@B@B@@@@@B@B@B@B@B@BA@@@AB@B@@A@A@ABEB@@AA@@AB@B@@@@@@A@AA@@@@C@A@A@A@CB@@A@@@A@@BAB@BA@@@BB@@BDA@@@CD@B@@@BAB@B@@AB@@ABCB@@@@@BBBBB@BBB@BB@B@@BB@@@B@@B@@BB@@DBB@B@@B@@@BBB@D@@AB@@A@@@A@A@@@@BA@@@A@@BA@@@BBBB@@HBBB@@@B@@C@A@@B@@@B@B@@AD@B@D@BBB@B@B@BBBA@A@A@@@A@A@A@AA@@A@@BAB@B@BBBAD@BA@A@@@CA@@ABA@@@A@A@A@@@C@AAC@AA@@AD@@AB@@AB@@C@@@AB@@@B@B@@AB@@BDAB@BA@@B@@BB@BDB@BAD@@@B@@ABEBA@ABA@AB@@ADB@BB@@BB@@A@ABA@@@AD@@A@@@@F@B@B@B@D@@@BD@@B@@BBABA@A@@@@@@B@B@@BD@@A@@@BB@BD@@@@BA@@B@@@BB@@BB@@BA@@B@@@@@BAB@B@@@B@@BB@@@@@@AB@BABAD@BB@DBB@@B@@@@@B@@@D@F@@BB@B@B@BA@AB@BBBBB@@ADCB@@AB@B@@A@A@CAAAAB@@@BADC@AB@DAD@BDB@B@@ABA@@@@BABAB@BA@@B@@BBB@@@BB@@@BB@@@B@@@@@DA@@BB@@B@AB@BBB@BABBBBB@D@BA@@B@@@@@@BB@@BD@@@BB@B@@@BBB@B@F@@@BB@BB@D@BBB@D@F@@BFB@BADA@@B@B@@@@A@A@A@AB@BA@@@ABA@C@AAA@@BA@@@AF@@AB@B@@@@DB@BBB@@BBBBBB@B@@B@B@@B@B@@@BB@B@B@B@B@@B@B@B@@BB@BDBB@@BBB@BA@@BBB@@CF@B@B@B@BBB@D@B@B@@@B@@BB@@AB@@@DCBA@A@A@C@@@A@@D@D@@AB@B@BABA@@@ABAB@D@@@B@BB@@B@@B@B@BA@AHAD@BA@@B@@@@B@B@DBBAB@B@@B@B@BB@@DBF@@BDBDB@BD@DAD@BAD@B@D@B@B@BAB@B@BBD@B@B@BBBBF@@A@AAA@AB@B@BA@@A@AAAA@A@A@@B@DA@@D@DADAD@BBD@@@B@B@@@BABBB@BBD@FBF@F@D@BADBDB@ABB@ABCBAD@DCBCB@BBDBB@B@D@DB@BBBD@BBB@BA@AB@BAD@BB@@BBDBB@@B@@@D@@@BAB@DABCBAAA@ABAB@@@BAB@BB@@B@F@B@H@BBB@BDD@B@D@@@@EH@@@D@@CBABCBC@A@BDBBBB@BBD@B@BA@AB@BDBB@BD@@B@@@DBF@BBBB@@@B@@B@BB@B@@DB@BB@BAB@BB@@F@@@BBB@B@B@@@B@B@@@B@B@@@D@@@BBB@BA@@@@@B@@BB@BBB@B@@AB@@BB@BBBBDB@@B@@AB@@BBB@@BBBB@BB@@BB@@BBBB@@B@@@B@F@@BD@AC@AB@@CB@BABC@ABAB@D@D@B@DAB@B@@AB@@AB@BAD@@@BAB@DABABAB@DAB@BBB@B@B@@@@BAB@B@BB@@B@D@@@BBB@B@@DB@@BB@@B@@@D@B@@@@@@BAB@H@BAD@B@D@@BB@BA@@B@B@B@B@D@BA@@BA@@BBBB@@@B@B@@AD@@@@@BB@@B@BBBF@@BB@@BDBB@@@@@D@@@BB@@@@BB@B@@AD@D@@@BBB@D@B@@@B@D@B@B@B@AA@AA@@@@A@@@AB@B@DCB@B@BBB@B@@B@@@B@@@BB@B@@BDB@B@B@@@@B@D@@@BBB@B@@BBBB@@@DBB@BB@BBB@B@B@BABABBD@@A@@DB@@D@@@B@@AB@@@BBB@@BB@@@@B@@@@B@@B@BA@@@ABABA@@BAB@BAB@@@BA@AB@@BDB@@@@DAB@@@B@@@B@@@B@B@@AB@@B@@@BB@B@BBB@BB@@B@@@DB@@B@@@@@@@BB@@BB@@B@@@B@@BB@BBHAFDBADAH@B@@@@@@CB@@A@@AA@A@A@@B@AA@C@@@AB@@A@@BAAAB@@A@@B@BAB@B@D@@@B@B@B@B@BAD@@@DABAB@BAFFAHBJDJ@BAB@@B@BB@@@B@@B@@B@@JFFFFHBJBHDJFBDBJBDD@@BA@@@CF@DADEBEBEFCDEFCBDD@DABABAFBBBDFFFF@DADFBFHDH@JBFADA@IBIBEEEG@CCACDA@@BB@@B@B@DADA@ABA@A@@BA@@B@B@B@DBF@@@BAF@DHD@BB@@@@BB@@BAB@@@BABA@@D@@@@@B@B@@@@@B@@A@A@ADA@@@@@@B@@CB@@@@AB@B@@@D@B@D@BABBBAB@B@D@@@@@HFBDBB@@@B@@BBB@@DBBBDB@@@B@@B@BB@@@@B@@BB@B@@@@BB@@@@@B@@@@@BB@A@AB@@@B@@BD@@BB@@B@@@B@@B@@@@BB@B@@BB@@@@@BBB@BB@@@@B@B@@@BB@@B@@@@@B@@BB@@@B@@B@@B@@@@@B@B@@BB@@BDB@BBBB@BBB@B@@@BB@B@@B@@BB@@@@B@@@BA@@@@BAB@@@BAB@@@@AB@@AB@@A@@@A@@B@B@@A@@BA@@BAB@BAHBFDFDJBJBDFBDBHFHFHDDFFH@J@DFDB@@H@FEDCBE@EFEDADCH@F@HGDEFBLCJEB@BA@KAG@EB@CGCEAE@EFCACHCBEDCDABCBECE@EBCBA@@@A@@@@@A@AAAAA@@@A@C@@A@@A@@B@B@@@@AB@@A@@B@@@@A@A@@B@@A@@B@@A@@@@B@AA@@@A@AAA@@@A@C@A@@@A@A@@@A@@A@A@@A@AA@@@@AAA@@AAC@@@AA@AA@@AA@@AB@@AB@BA@@@@@@@@B@@AB@@@DE@AA@@AAA@@BAAA@A@@@AA@@@@AA@A@@@@@@A@AAAA@AA@AAA@@AAAA@@AA@@A@A@A@@AA@B@@@@@@C@@@@AA@@@A@AB@@ABAA@BA@@A@@@A@@AA@BA@@@ADMBG@AB@@AB@@@@A@@@A@AAAAACAA@CA@@A@@@A@A@@B@@@BA@A@A@AAAA@@A@@@A@@@@@@A@@@A@@A@A@A@@@@C@A@ABA@A@@@@C@A@@ABC@@BA@A@@A@BA@@A@A@@A@@A@AA@@@@@@@C@ABA@@@@AAA@@@A@A@AAA@A@A@@@AA@A@@@@@A@@@A@@A@@@A@AB@@@@@A@A@@AA@AA@AA@A@@AA@@BA@A@@A@@@ABCBAAA@@@@@A@AB@@ABA@@@@AA@@@@AA@@@A@AAA@@AA@A@@AA@@@@C@CA@@A@@AAAA@BA@A@AD@@A@@AC@C@@@@@@AB@@A@C@@@@@@AA@@@@A@EBA@@@@@AA@A@@CA@A@A@@BA@@@A@@AA@@@AA@CDA@@@@@A@@CAA@A@@@@@A@AA@@@AAKC@@A@A@A@A@@A@@AA@@AA@@A@@AAA@A@C@@@AAAA@@@A@@@AC@@@@AA@@A@A@AA@AAAAA@@@@@A@@@@AA@@BABA@@BABA@@A@@@EC@EDE@@@A@@@@@C@AAA@AHADC@CBABAHCFBDABCA@AA@ABAJCDBB@DABAD@F@@@DCB@FBD@VDPBD@FBDCNAL@@ADCD@B@DDDDD@DAHADBBD@@@B@B@B@B@BB@@BB@B@@@B@BAD@B@@FADAFBBBF@FAFFHADFBBBJ@HATBJB@@BACCDCJADC@CB@@A@@A@@A@C@@@@ACFAHAFCDC@@F@@E@GB@BADAD@BCBCHG@C@C@AB@F@B@@AAC@@AACEB@@AAAAA@C@@G@C@ABC@C@A@A@BAAAA@AA@@A@AA@ABABAACAAA@BEAA@A@AB@@A@A@ABCAABA@AA@@AB@@@@@@A@@@A@@@@@CAAB@BCB@DADADA@@@@AEEEEGACAEAC@C@A@C@A@C@ABAD@F@LDF@FBFCDCBA@CBC@IAE@CAA@AAICGGIIGIEIEEA@AA@@@A@@BBDB@@B@@A@A@AAAAA@A@@B@@ABCA@@A@@@A@@@A@@B@@@@A@@BA@@B@@A@A@@@A@@A@@A@@@@BA@@BB@@BA@@B@BBBABA@@@AB@@@B@@@BA@C@AAACAA@AC@AAAA@A@@EA@DEH@BBB@@BDBDABAB@DCBEFA@BBBB@B@BBBBB@DBB@B@B@@@B@@@BAB@@A@@@A@A@ABCC@@A@@BA@AA@@AAE@C@C@ICKAC@AB@@A@AAEEA@ECAAA@AABA@@@AC@A@ABE@A@@F@B@@AB@@@B@@@@C@ABA@A@A@A@A@C@AA@A@AAA@AA@@AAAAAAA@A@@@ABAAAA@AAA@@C@AA@@A@A@C@A@C@@@@CA@AA@A@A@CA@@CCAA@@@ABA@AB@@@@AEA@ACACA@A@@@A@@BABA@A@ACAACC@C@A@A@A@AAA@@AAE@@AAAAA@@A@A@A@A@@A@@C@AAC@AAA@A@@AAAAAC@A@AABAACBAAAB@@@@C@A@A@A@A@@@CAA@AA@@A@A@C@C@@@AAA@AB@B@@A@@BA@A@A@@@@@@@@@@A@A@@AAB@@A@CB@BA@AB@@A@A@@@AA@@@A@ABA@@@ABC@A@A@AB@BCB@@@A@AAC@@ACA@BAFABABAD@D@@AD@B@DAJAECC@CA@AAACAA@A@EB@@CAA@GBABE@@DA@CDEBA@A@AAACAA@CCCCABEB@@CD@BA@ACAE@@@BABAAC@ABBFAB@DADA@AFCDADADCB@BC@@BEBA@A@A@CEC@CDCACBC@EDABCH@BCDCDCHAFADAFC@@@@A@AA@@@@AA@@@AAA@A@A@@AAA@@@AB@@A@CA@AAA@@@@A@ACAAA@@A@@@@@@@A@@@A@@@AAA@A@@BC@ADE@@@AAC@@@@AA@ACAA@A@A@@B@@A@A@@@A@CBECA@CA@AA@CBAB@@ABA@@@AB@@@@AAA@@@A@AAA@@@AA@@AAA@@@A@AAAA@@A@CA@@@A@@@AA@@ACACA@@A@@@AAA@@@AAAAA@@@AAA@CAEAAA@@AAAAA@@@AAA@AA@AA@A@OIA@ECIAECCAADAFC@A@AAABABAD@BADABCBCCIDAAAAABA@ABGCC@AAE@ABABCAA@C@A@AACCECADCDC@A@A@ABA@CBA@A@@@CAADABAAABCBC@CAC@@@ACABC@C@AD@@AB@@ABBDAB@B@B@D@@@D@B@B@@@B@@DBD@@D@@ADAB@BCB@BA@AB@@ADA@@B@AAB@@AB@@ABA@CD@@@AA@ACEAGDABAB@BAAC@@AAA@@A@A@@@AEAAC@C@A@@AAC@AAACCA@C@AAC@EA@AACAA@A@EAAA@AA"],encodeOffsets:[[111356,25223]]}},{type:"Feature",id:"451224",properties:{name:"东兰县",cp:[107.374293,24.510842],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@HB@BADABKJMHIHEHABAD@@G@ECECECIAGAAAECAAACISAC@AB@ACAEAAACAAEEECC@@@C@@BA@@B@BADBDBDDJ@B@B@D@BBNBHDJBHBBBF@D@DELCD@BADAD@DAF@B@@@@AAA@@@A@@AC@@@ABA@@@@A@@AAA@@@AB@@A@ABA@C@ABAA@@A@A@@BAB@@@@@AA@C@ABA@A@@B@@@B@B@@B@B@B@B@BB@@AB@BBB@@B@@D@BA@AB@@A@A@@BA@ABAB@@BB@@AB@@@BA@@B@@C@CC@@AA@A@@A@C@@@AD@@ABA@@@ABA@C@AA@A@@@A@@B@@A@@AAA@@@C@@@C@CABAAAAA@@A@A@@@@AA@@@A@CB@@@@@AAA@@A@A@A@@B@@@@C@A@ABA@@B@BA@@@BBB@@@B@@@B@B@BB@BB@@@BBBBBA@@BBD@BD@@A@@B@@AF@@@@AB@B@@@B@@@@AB@@@@DB@@B@BB@@@@@@D@B@B@@@@BB@B@B@@@B@@@DB@BB@@BA@@B@@@@DBBBBB@@@BB@@@B@B@@@BB@@@B@@@@ABCB@@B@BB@@BDBD@@AB@@C@A@@@AAA@AAA@@@A@E@AB@@@BA@BB@@@@A@@BA@@@A@@@CA@@@@@B@@@@@B@BA@@@A@AA@@@@AAAAAAA@@@A@@@ABADAB@@@@@BABA@@@@BABA@C@AB@@A@A@@B@@ABAB@@@@C@@BA@A@@@@@A@A@A@@BA@@@ABA@@BB@@@@BA@@@A@@BCBA@A@A@A@@@@@@@@B@BBDDBBBA@ABA@ABAB@@A@@BA@A@@B@@@B@BB@@B@@BBBBB@AB@@AB@@@@BB@@@BA@@BD@@BB@D@BB@@B@@@BAB@BAB@@@@BB@@@BB@@@B@@AB@@CA@@CBA@@BC@A@C@@AA@A@AA@@@BABA@@@@@ABHFB@B@@@B@@@B@@@@BBBBD@@B@B@@@@AB@@A@AFA@@@@B@F@@B@B@@@B@@BBD@DBBB@@BB@BB@DBB@@BB@A@@@AB@BA@A@ABA@AA@@AAA@A@A@@@CB@BA@AA@@@B@@A@ABA@@@A@AB@@@B@BB@B@@@BB@BBBBB@BBB@B@@DB@B@@@@BB@@B@B@@@B@B@B@@@@A@@@AAA@@@@BCB@@A@@BAB@BBB@B@@@@BB@BBBBA@@B@@BBB@BBBB@@B@B@@@BBBB@B@B@@B@@B@@@BBBCBA@A@@@A@CDA@ABCBCFA@CFAB@@@B@@A@B@@BB@@@D@B@BA@BB@BB@B@B@@B@@@@BBB@B@@BBBB@@BB@@BB@@D@@@BBBBD@@@DBF@B@BDB@@@@@BD@B@DB@@BB@@@D@@BDB@@B@BBDBAB@@@@BB@BBB@BB@@@BB@@@BBB@@BB@@BA@@@B@@@@BB@@AB@@@@B@@@@B@B@B@@AB@@@@BB@@@@@@A@@BB@BB@BB@@@@A@@@@@@B@@@@@B@@@@@@BBA@@@ABA@@@A@@@B@@@B@@@@@@@@B@@B@@BBB@@@BBB@D@B@D@@@@BB@@@@B@@@B@@BB@@BBBBB@CB@@@BBBAD@@@B@B@B@@BB@B@@@@B@@A@@BB@@@@@BB@@@B@@@@@BA@@BA@@B@B@@@BB@@@@B@B@@@@DB@@@@@@ABAB@B@AA@AB@BA@@@AAC@@@@@@BADABBD@B@@@B@D@@@BA@@@AB@BAB@BB@@BA@@BB@@B@@@B@@@B@DCB@@ABDABB@@@DB@@@@B@B@B@B@BBB@@@@A@A@@@AB@@AB@B@@AB@BAB@@ABB@@BA@@D@@@B@@B@B@@B@BBBA@@DBB@@BA@@@BB@B@@@@AB@BAB@B@B@@A@AB@@@B@D@BB@@@@@@@BB@@A@AB@@@@A@@@@@BBB@BB@@@@D@@@B@@B@@@B@B@BDBBB@@B@@@BBB@B@B@@@@@DBBBBBB@@@B@BB@@BD@@DBB@BBBBBD@@@@B@BA@@@@D@@@BABA@AA@@A@A@@B@@AD@@AD@@@@A@@BAB@@@@@@A@@AACA@A@@@AB@BA@@B@@AAA@@B@BAB@@@@A@AB@@AB@@@DB@@BBBB@@DBD@@@B@@@B@@BB@@@@AB@B@@A@A@@AA@@AA@@A@@A@@BACC@@BA@@@AB@@@@@@A@@@A@@B@B@@BB@@@AABA@@AA@A@AB@@AAA@@BA@A@@D@DB@@@A@A@@BAB@@AD@@@DD@BB@@@@BB@BBB@@@B@@BDEBA@@A@AA@@BA@@B@@@@A@@@AAAEAAA@@@@@@DAB@@@AA@AAAAA@@@AAAA@BA@AB@@A@A@@@A@AFAB@B@B@@@AC@ABA@AA@C@@AAACA@CB@@A@AACA@AAAA@@A@AA@@@@BAB@@@@A@@@@@A@A@ABA@@@AAA@@B@@@@@@@@AA@@@@@@A@A@@D@@@B@@A@A@A@@@A@@@@A@@A@@@@DC@ABABA@@@@B@@@@ABBB@@A@@BAB@@BBBB@B@D@BB@@DB@@@@B@@A@@B@BAB@@@BA@AAAAA@@@A@@AA@@@A@A@@@AA@A@@@A@@@CA@@BA@AB@@@@ABAB@@@B@@@BA@@BA@A@A@@BAB@BA@@@ADCB@@A@A@@@A@A@@@@@AA@@@A@AAAA@@AC@@@@@A@@@@BA@A@@@A@@B@BA@@@@@@A@A@AA@@@@@A@@BA@@BAB@@A@@@A@@D@B@BBBAB@@@@@@@@CAAA@BA@@BA@@@@AA@@A@A@AA@A@@ACA@@@CAA@@@@@@@BA@@BAB@B@@A@A@@B@@@CA@@AA@@BA@@@AB@B@BAB@B@@AB@B@@@@A@A@CBA@@@AA@AA@@@@BA@@@@B@B@B@@@B@@ABA@C@@BA@@@A@@BA@AAA@A@@@A@C@AB@@@BAB@@@CG@@BA@A@@@A@AA@@C@A@AA@A@A@@A@@AA@AAA@@@A@@AA@@AA@@BCBCB@BCDCDCB@B@B@D@B@B@B@D@@@BA@@B@@BB@@B@@DABA@@BD@B@@DBB@BA@@D@B@DBBB@@D@DD@@@BABDB@@@@BAB@@@BC@@@CA@@AA@B@@@@ABA@@@@@A@ABA@A@@@A@@@A@@CC@@BA@@@A@@@A@A@@@A@@D@@@B@@AA@@A@A@@BAB@B@@@BAB@BA@@D@BB@@DAB@@@@AA@@A@@@A@A@@B@@ABABC@@@AB@@A@@@@@A@AAA@A@@@@BA@@@A@@@AB@@ADA@AAA@@CC@A@A@A@@@ABA@@@A@@AAA@@@AA@@C@A@@@CB@AC@A@@CA@@A@AAAA@A@A@ABABA@@@C@@AA@@@@A@@E@AA@@@@@AB@@@@@BAA@AC@@CBA@@BAB@@A@@@AB@B@@AD@@A@@A@@AAACAA@ABABA@@@ACAA@@@@@@ABA@A@@AA@AE@A@AAA@@@A@@AA@@@CAA@ABA@A@@AA@@BA@A@AB@@A@C@@@@@@ADA@@AAAA@@A@A@@@A@CA@@@@A@@AA@AAA@AAA@ABAB@@@B@@AA@@A@@@@B@@ABBB@B@B@@@BB@@@@@@B@D@B@@@@@BAB@BB@@B@B@BC@AB@@@@A@@@A@A@@@A@@@A@A@AB@B@@A@C@A@A@@@C@@@@@AAA@@@@@AC@@AA@@A@A@@A@AB@AA@@A@@@AA@@@AA@@@@AB@BA@@@A@@@ABA@@BA@ADA@@@@AABA@A@@B@@@B@BB@@DB@@@@B@@@@@@A@A@@@@AA@A@@@@B@B@@AB@@C@@@A@@A@@AA@AA@AA@@AA@A@@AB@@@@A@@AAAAA@@@AACB@BA@A@@@@BA@@@A@A@@@@BEBA@ACAA@A@@@@@AB@@A@@A@@@BA@AAA@@@C@A@@@A@@@AB@@@BA@@B@@A@@@@CA@@@@A@A@@@AA@@@A@@@AB@@A@@@AB@@CB@@A@@@@A@A@A@@@@A@@@@@AB@BA@@A@@A@A@A@@A@@@@A@AAA@@@A@@@A@@@A@@ABAA@@AA@AA@AA@@A@A@A@AA@@BE@EAC@CBAA@C@A@@AA@@@@@CBCAA@@@C@ABABA@@DAB@@@B@@BB@B@@AB@@BB@D@@@@@DA@@DAB@@@B@B@B@@@B@B@@@BA@@BA@@@@B@@AD@B@@@@A@@@A@C@@B@@AB@@@@A@@@@@A@@BAB@B@@@B@@BBDB@@D@B@@@DB@@@@@B@@CB@B@@AB@BD@@B@@@D@B@@@@ABA@@@DD@B@B@@@B@@@B@BA@@@@BAD@@AB@B@@A@AA@@AA@@A@CB@BA@@B@@@B@@BB@@@@A@@BA@@@@B@B@@B@@@B@@B@@AFBD@@"],["@@A@A@@@A@@@@B@@AB@@@B@BA@A@@B@@@BCBA@@BA@AA@@AA@@@@BCA@@AA@C@CAA@@@AB@BA@@@@@AACA@@@@A@@AA@ABA@A@@@BD@B@@@D@BAB@@@@A@A@@@A@AA@@@C@@AAA@AA@@AB@@A@@@@@AA@@AAB@@@BA@ABA@@A@@@B@@ABA@ABAB@B@BA@@B@@ADA@@B@BA@@@A@@@ACAAA@A@A@@B@DA@ABA@AAA@@@A@@@A@@B@BBB@@B@@@B@@B@BBB@B@BCB@B@B@B@DCD@@@B@@@A@@A@A@@@CBAB@@@B@B@@@DA@@@BBBB@B@B@@@BA@@BA@A@@@A@AAAB@@A@@BAA@@A@@A@A@@@A@@AA@@AAABA@A@A@@A@A@AA@@@@A@@A@A@A@@B@D@@@@AB@@@BAD@@@B@B@@A@@AA@A@@@@C@@AA@@@@A@@@ACB@@CAA@@@ABA@@@@BA@A@A@A@@@AB@@@BBB@@AB@@@BABBB@BBBD@@B@@@@A@ABC@A@CA@@A@AB@B@@@DB@@@BB@@@B@@BBB@@B@@@@@B@@A@ABA@@@A@@BABA@@@A@CA@A@A@@@A@@G@A@A@AB@AA@@@@AA@AA@@A@@@@@AACAAA@@@@@@@AB@@ABA@A@@AAA@@A@@@@D@@A@@BA@AAACAA@@@AB@@@@@BA@@@@BB@@B@@@BAB@B@@BB@BA@ABC@AB@@@@A@GC@@A@C@@@@@@A@A@@@AB@DA@BB@B@@@@A@A@@A@@AA@@A@ADA@@@A@AB@B@B@@@@A@@AA@@B@@A@A@A@@@@B@DADD@@DABAA@@AA@@@@C@@B@B@@@@A@@@A@@B@@@B@@BB@@@@@@B@@@@B@@@B@@@B@BA@@A@@@BA@A@@AA@@B@@A@A@A@@@@AA@@@A@AAA@@@@AA@@AA@@A@@AAAAA@@@@A@@@CB@@EAAAA@@@@@AA@A@@A@@AA@A@C@CB@@@@@B@B@@@@A@@@A@@@@AA@@BA@@AA@CAA@@@@@@@AAA@@B@@A@@D@@@@@BD@DDB@@@BB@@B@@@@AB@B@BB@@B@@@@BCB@@AB@@@B@@@@@B@@CD@@AAAB@@A@@B@BA@@@A@A@@B@@@@BBBB@B@@@B@@@@@@@BA@@@@B@@@BAB@@BBBB@@@@@@@B@@A@@@@B@@@B@@@B@@A@@@A@@A@@A@AB@@A@@BAB@A@@@AA@@AA@A@@@@BABA@C@@@A@@@@AA@AB@B@B@B@@AD@@A@AAAA@@A@@@@@A@@@ABA@@BA@@@BD@B@@@B@B@@BB@@B@@AB@BA@@@@@AB@B@BA@@@@@@BBBB@@@@B@DA@ABBB@@B@@BB@@@@B@B@@@@@@@BB@@BB@@BB@@BB@@AB@B@@@@ABA@ABA@A@@B@@@BB@@@@B@@@@CBA@A@@@AB@@AB@@A@AA@A@@A@A@A@A@@@A@@AA@@@@@A@A@@@@@AA@@@AAAA@@A@@A@A@A@@B@@@BA@@@@B@@ABA@@@A@A@A@@@AA@@B@@AA@AA@A@@@A@@@@A@@@A@A@A@BC@@AA@@BCBA@@@@@@@A@@D@@ABAB@@@@AAA@@@AB@@@@A@AAABAB@BA@@CC@@@@@@DCB@@@AC@A@@A@@@A@ABAB@B@@@@@BBBABAB@@@@@@A@@@A@@@A@@@@B@@@@AB@@ABABAA@AAA@@@A@@B@@AD@@@B@@@@AB@FC@@AA@@@@A@@AAA@@A@@@A@ABAB@@A@CBABAB@BC@@@A@CA@@CB@@CBA@@BA@@BA@@BBB@@@DABAB@@BB@BB@@BB@B@@@B@@B@A@A@ADABA@@B@B@@B@B@@@@BBAB@B@F@@@B@B@@@@@BA@A@@B@@@B@@@B@@@BA@@@A@AA@@A@AA@AA@ABC@@B@BA@AB@B@@AA@@@@AAA@A@@@C@A@A@AB@@@B@@@BB@@@BB@@ABABA@@@@@@A@@A@@@@@AB@B@DB@@BA@@@C@CB@AAB@@@@@@A@A@A@@@A@@@AA@A@@@A@A@@@C@A@C@A@@A@@AAAA@@@@AA@AB@@C@@AA@@@A@AA@@BA@@@AA@@AA@AA@A@A@@BA@@AA@@@@A@CAA@A@@A@@@AAAAAAA@A@@BAB@@@@CBA@@@A@A@AB@BA@@@AAA@A@@AA@AB@BABA@@D@B@@@BAB@@A@@A@@A@@@@@CBABABAB@@AB@@A@@@@CA@AA@@@A@@AA@AAA@@@AA@@@@@A@@@A@@@A@A@AA@@AACA@@@AACAA@@@A@@@A@@@A@A@AA@A@@@BAB@B@@A@AAA@A@@BA@A@AB@@@BA@@B@@A@@BAB@@@@@@AA@@A@C@@@EB@@A@AA@@CB@@A@A@A@CB@A@@AA@A@AA@@@A@A@@@@@@B@@AB@@@B@B@@@B@@CBAB@@B@B@@B@@B@@BBB@B@@BB@@B@@@@@@BA@AB@@@B@@@D@@@@BD@@@BABBB@@A@CB@@CD@@@@A@A@AA@@@@@A@@A@AAC@@AA@@@A@AAA@@@CB@@A@@CC@E@@AA@@A@@AC@@A@@@AA@@@@A@@@A@A@@@CCA@@A@@@@AB@@@B@B@@ABA@ABABAB@@A@@AA@AAAC@AA@CA@@AAAAC@AAA@AC@@A@@@AA@@@A@@AA@AA@A@ABA@A@@@A@GBA@@@A@ABAB@BCDA@@@A@A@A@@@@BA@A@A@@B@@@@@@BDB@B@@@@@ABA@A@A@C@@@A@@@@AAAABA@@@AA@@A@@@@BA@A@@BAB@@@@BD@D@B@B@BA@@@@@AD@@@@BB@@@B@@A@@@A@@@AD@@A@@AA@@BA@@@A@A@A@AA@@A@@@A@ABA@AA@@@B@D@@@B@DB@A@@@A@A@@BA@@B@@DB@B@@B@B@BB@@BB@B@@AB@BA@@@AB@@@@@BBB@@@@D@BB@@BB@BAB@B@B@B@@ADABADAB@D@BAB@BCD@@A@@@BBBBB@@@AB@@@B@@A@CAA@@@@@@BA@AB@@BD@@B@@BBAFBDA@@B@B@B@@DB@AB@@AB@@A@@@A@A@A@A@A@@@AAA@@@A@A@A@@@@BAB@B@@BBBBDB@BB@BB@@@@BD@BB@@@@B@@BB@@@B@B@@@@CB@BA@@@A@@AA@A@A@A@A@C@@@C@@@@@@@A@@B@@@BAA@BA@@B@@BB@@@@ABA@@@@B@@@B@B@@BAB@@@BBD@@BB@@B@@D@@B@@@B@B@@@@@BA@AB@@@@@BA@A@ABCAA@AAA@A@A@@@AAA@@@@B@DABA@A@@@AAC@@@AAA@AA@A@@A@@@A@@@ABA@@@CC@@@@@@ABA@@@AB@@B@@B@BBBBBB@@@@B@@A@@B@@@@DB@@B@B@@@B@@BD@BB@D@@B@D@DBDBAB@BA@@B@@BBB@@B@B@B@@A@CAA@@@ABAB@@@BB@BBBBB@DBBBB@@B@@@@@@AD@@@@@@@B@@@BE@A@AAA@@@@A@@@AA@CA@AA@@@@@A@@@AAA@@@A@AB@@@@ADDD@@B@@@@BAB@@AB@@AAA@A@A@@B@@AAA@@AAA@@@@C@@@ADA@@@@BB@@B@@@@DBBB@@B@BB@BB@@@@@D@BBBB@@B@B@B@BDB@@@ABA@@B@B@@@@@BA@A@A@@@AA@@A@A@A@@@AA@@@A@A@@A@@@A@@@@@A@@@A@A@@@A@E@CAA@@@@@@ABA@A@@@@@ACA@@AAAA@@A@A@@AAAAAC@@BCA@B@@A@@@A@A@@@@@A@@@A@@@@@A@@@ABA@@@@@AAA@A@AAA@@@@@A@@@@@@@@@AA@@A@AAA@A@A@AA@@A@@@A@AAA@A@A@@@A@@@AB@@@@C@A@@@@A@@AA@@AAA@AB@B@@AB@@A@@@AB@@A@A@@@C@@@A@@@BB@@DB@BA@@BB@@@@@@@BAB@@@@B@BBB@@@@@@BBBB@BA@@@A@@@@@A@@@@A@@AAAAA@A@@@AB@@A@@@A@@@C@@@@BA@@@CB@@A@@AA@A@@@@AA@@@AA@@A@ABA@@@@@A@@@A@AAA@@@@@A@@AA@A@A@A@A@A@@@@@@@A@AAA@@A@@C@A@A@A@ABABA@@@@B@B@@B@BB@B@B@DEDCBABBD@@B@@B@@@@BBBBB@@B@@@@@@@BA@A@@@@B@@DB@@@@@B@BA@A@@B@@A@BD@BAB@BA@BBBB@@BB@@BBA@@BA@@BA@CBAB@@@B@B@@A@A@@AA@A@A@A@@@A@@B@@@DB@@B@B@B@@BFBBB@@@@BA@@@AB@B@@@@C@@BA@A@CA@BA@A@@AA@EA@@A@A@@@@@@@AAA@A@A@A@BCAA@A@@AAA@@@A@@@AB@@AA@@@B@@@@A@AB@@@AA@@@@@A@@@A@@@@BA@@@A@@@@B@B@@@@ABBB@@@@A@@B@@ABA@@@@@@B@BB@@@@@B@B@@@D@@B@@BB@@BB@B@@B@@@BB@@DB@@@B@BAB@@@BB@@@BBB@@B@@DBB@@@@@B@@@@@B@@BB@DBBBBB@@@@@BAB@@BBB@@@B@@BB@BB@@BB@@@@BBAB@B@@B@@@@B@@@B@@@B@@@B@@@@@@AB@@A@A@CA@@A@@@@@@AAA@@@AA@AAA@@@A@@B@@AB@@@@ABA@@B@@B@@B@@@B@@ABA@@@A@@@A@@@C@AA@@@@@@AB@@@D@BA@@B@@@@BBB@@BDB@BDDBB@@BB@BBB@@@B@B@@B@@@@@BB@@@B@@@@B@BBB@BA@@B@B@B@BBB@@@@@B@@BB@B@BBB@AB@B@@@B@@@@B@@@@@@BB@@BBBB@@@@BB@B@BAB@@B@@@A@@@A@@@@DAB@B@@@B@@@B@@@@B@@AD@@@@B@@B@@B@@@B@B@B@B@D@@@B@B@B@@@@BBBB@@@B@@@B@B@@BA@@D@@DDD@@BD@BBB@@@@@DBBB@@@BA@AB@@@B@@@BB@B@B@B@BBBB@@BB@@@B@@@BA@A@@@AB@@AB@@AB@AA@@A@@@A@AC@AAA@AA@@AB@@DB@B@@@B@@@B@@DB@@BBBBB@@A@@BBBB@@BBBD@@@B@BB@B@BB@BBB@@@@BB@B@@B@D@BA@@@@D@B@B@@BB@@B@@@B@@@@B@BBB@@@B@@@@BAB@@@BB@B@@@B@@@B@@BBB@@@@@B@B@@BB@@@@@B@@@@B@B@BBDBB@DBB@BB@AB@B@B@@@@@BB@B@@B@@@B@B@BBB@B@B@@B@@B@B@@@B@B@B@@AB@@@@@BBB@B@@A@@B@@@BA@@BBB@@@B@B@@B@@B@B@B@B@@BB@@@BAB@@BB@@@@BB@B@B@B@B@B@BBB@BBBB@@@@B@B@@@B@BAB@B@B@@@B@@@BBD@@@B@B@B@@@BBBBB@@BB@BA@@B@BAB@@@BA@AB@@@@@D@B@B@@@B@@@@@FA@AB@@@BBDBBBBB@@BBB@@@@B@B@@@B@B@@@@AB@@@@@@BBAB@@@B@@@BA@@B@@@@BBA@@B@@@@ABBBAB@B@@@BCB@@@A@BA@AB@@@B@@@BC@AB@@@@AB@BA@@@@@BBB@@@B@B@BB@@@@DBBB@@@B@@AB@B@@A@AF@BA@@@@@@@@BA@@BAB@BA@@B@@@@AB@@@@@@A@@B@@AB@B@B@@AB@@A@@B@BAB@@@B@B@@BB@@AB@@@@@@A@@@@@AB@@@B@@@@@@@B@@A@@B@@@@A@@BA@@@A@@@@@A@@@@B@@AB@@@@@@AB@BAB@@@@AB@@@B@@@@@B@@@B@@A@@B@BA@@@A@ADAB@B@@@D@BAB@@ABC@@@A@@@ABA@@@@BA@@BA@A@@B@@ABA@AD@@@@@B@@@B@B@@@@@BC@@BC@CDA@@BA@@B@@@B@@BBB@@B@@@B@@@BA@BB@@@@@B@@@B@@@@@@@B@@@B@@BB@B@@B@@B@B@@BB@@@BDD@BA@@B@B@@@@BB@B@BA@AB@@@B@@@@BB@@AD@@A@@@@@BB@@ABBB@B@DA@BB@@ABBB@@@B@B@@BDBB@@AD@@AB@B@@@BA@@BA@@@@B@@@@@@A@@@@B@@@BBD@@@@@B@@@@ABCB@@@@A@@@@B@@@B@@@B@@ABB@@BABB@@BA@@@ABA@@@@B@B@BA@@BBB@@@B@@@B@@@@@@@B@B@BBB@BB@@@BBB@@D@@@@@BA@@@AB@@A@@B@B@B@B@DBBB@@BB@@@@@@B@B@@B@@B@@B@@@@BB@BBBB@@@B@B@@@BB@BBCD@B@@AD@B@@AB@BAB@@@@@@A@A@@@AB@@A@A@@@A@@@B@@B@@AB@@@@@@@B@@@@@@@@AB@@@@@B@@@@@@@@@BA@@@B@@BA@@B@@BB@@@@@BB@ABB@@DB@@B@@C@A@C@C@ABCA@@A@EA@@A@AAA@@@AAABA@ABABBB@D@BBBDB@@AB@BAF@DB@@B@B@B@@@@BDAB@@EDA@EA@AA@A@AAAB@@A@ABAA@AA@CA@@A@AAA@A@A@A@ABCB@@@BA@A@@AA@A@ACA@A@A@AA@@@AA@EBA@@DCB@@A@@@ABE@A@A@E@C@A@@AA@A@CAAAAA@@AAA@AA@AA@A@CA@AAA@@BCA@C@AAA@@AAAA@CAE@@AAB@BA@@D@BADA@AAA@CC@AABA@A@@BAAA@@@A@AA@@@AAA@@@AAAA@ABCC@AC@A@A@AA@AAA@@A@C@@@AAA@@@AAA@@BB@@FA@ABB@BBBDB@FB@@@B@BE@ABABDD@DCBA@AB@@CBA@C@ABABADBBA@@B@B@BC@AB@B@@BBBDB@HFBB@@CB@D@@B@B@B@@@B@@@B@BBB@AB@@A@AD@B@@BBBBB@BB@BB@@@@@AD@@BBB@@@@B@BA@A@A@CDCDBD@@BDD@DB@@BDB@BB@@ABABBBBADBDB@@@BB@BB@B@BBB@@@BDBB@BA@@DBDAB@BAD@BABBBDB@@@D@H@B@BA@@DB@@D@FBBABAF@@@B@BADBB@B@B@@@DA@AB@B@D@F@BAD@B@D@@DBBB@DDDBBB@BBBB@B@@BD@B@@AB@FAB@D@@@B@B@@@BA@@B@B@@D@@@B@@BAD@@AB@@B@@BB@BA@@@@BAB@BBBB@DBDBFAB@BA@@FB@@@BD@DB@B@B@@FF@@@DBBJ@DBFABA@@@@@ABCD@@@ACBA@AB@@CJC@AB@D@DAD@BAB@B@D@BBBBABDB@BB@BBLDBAFBB@@ABA@@FABFFD@@D@DBBAHBBFB@@A@ABB@BD@D@BBBBB@ACBGHBFBD@BAHBDB@B@BBDDD@@@BDBDBB@BB@@DBBADABABA@@@@BADAFGBADC@@@ABA@ABABAB@@@@ABCBGAC@A@@CCBADAB@BA@@BEBA@@@AAA@AB@@@BA@A@ABA@@A@AAA@@A@AAC@A@AA@@A@@@AA@@@@CAA@AAA@AA@AAEADEBE@C@A@@BABC@@BABADEDC@@DCBE@@@AA@AA@@@AAA@AECAAAC@@AA@CAA@@@@@A@@@A@AD@@AFC@ADC@A@@@AB@@@AA@EAABA@AA@CAAC@AB@@A@@@@DCB@@A@@@A@@@@B@@@B@@A@@@AB@@A@@BA@@BA@AB@@A@@@@@@@AB@@@B@B@@ABABC@@B@B@BB@@B@@@DCBCDAB@BABABAAA@ADA@@BBBAB@D@B@BABAB@BAB@B@@BBB@@@@D@B@@B@@BB@@BBB@B@BA@@HAD@B@D@@@BAB@B@@BB@@BBBB@@@B@@@BBAB@@@BAB@@AB@BADB@@B@B@@BB@@@B@@AB@@@B@@@@@B@@@BB@@@@@B@@@DBBB@@BB@@BBB@BB@@D@B@B@B@B@@@ABBB@@@@@BA@@@@BBBA@@B@@@BA@@@ABA@AB@@@@@@BB@@@BBBBBAB@BBB@@@BB@@@B@BB@@BB@BB@@@B@@@B@@@@@B@@@@@@@@AB@DB@@@@BAB@@@@@@@BA@@BBB@B@D@AD@@@BAB@@@B@B@@@@B@@@BB@@@B@BBB@@DAD@@AD@DAB@@@@@@@BB@BB@B@B@BB@@@@@@@BAB@B@@F@@@B@@@B@@BB@@@BA@A@@B@BABA@A@@@@BABA@AD@@@B@DB@@B@BAB@@@DB@@B@B@@@@@BADCBAB@@A@@@@B@@B@@BA@@@AA@@A@A@@@A@@@C@@@ABA@@DA@@D@@@@@@AB@@@BABAB@B@BA@@DAB@BA@A@@DBBBD@B@B@BB@B@BBBB@@@@@DBB@F@@@@B@@B@@@B@B@BA@@BAB@B@@@D@@@BBB@@@@@DB@BB@@@@@@AB@@AB@BCBAB@@A@@BAB@BABA@@BABA@A@@@A@@BA@@@A@@B@AAB@@@@@@A@@@@FCBA@A@@@@BABADCBA@@B@@A@@@@AA@@@A@@DAB@@ABB@@@@BA@@DA@@BA@@@ABA@A@@@AB@@@@A@@@AB@@A@@DA@@@@B@@@D@D@BA@@DB@@B@@@BAB@@@@AB@@@@@@@@A@@@@BA@A@@@@@A@@@AAA@@AA@@@AB@@A@@@AAA@AB@@A@@BA@A@A@AAC@@A@@@AA@AA@@AA@A@@AAA@A@@AAAA@A@@@A@AAA@A@@@A@@@A@A@@@@@AB@@AB@@@BA@@@A@@BAB@@@@@@A@A@@AA@@AA@@A@A@@AA@@@A@A@@A@A@A@ACC@@B@BA@@BB@@B@B@B@@@B@B@BA@@@A@@@A@@A@A@@A@@ACAAAA@A@@BABA@@@A@A@@B@BABAB@@A@@B@B@BA@@B@BABA@@BAF@@@@@D@@@B@B@@@B@B@@@@@BAB@@@B@BA@@AC@@@@@ADA@A@ABA@@@@CA@@@AB@BA@@@A@A@ABA@@B@BABA@A@AA@BC@@B@BAB@BAB@@AB@@ABA@@@@F@@BB@@@BA@@B@ACAAAAA@CA@@AC@@AAA@@@A@AAAACA@@@@A@A@A@AA@@A@@@AACA@A@A@A@@@AA@@@C@@@@@AAA@AA@@@@B@@@@@BAB@@@AA@@@@@@A@@A@C@A@@BAB@@@@A@ABA@ABA@@@@@AAA@@B@@AA@CA@@ABAAA@@@@A@AA@@@C@@@AB@@AA@BA@ABA@@BA@A@@BA@@B@@@B@B@@A@AAA@A@A@A@@@@@CA@@A@BAAC@BA@CDA@@@A@@@A@@@AA@@AB@@AAA@ABA@@B@@AB@@C@A@@@A@C@AACBAB@@@@@@BD@B@@ABA@@BBBA@A@AB@B@@@@A@@C@@A@A@@@@@AA@@A@A@@@AB@@AB@@@@A@@@A@@A@@@@AA@@@BA@@@@@@AAA@@@A@A@A@@BCAA@A@@DAA@AAAA@@AA@@@A@@@A@@A@@A@@C@A@C@A@AA@@A@AA@@@AA@@@@@@@@@@A@@@A@@@B@@AB@B@@AB@A@@@@A@@@@@A@@@@@@@@B@@A@@AAAA@@AB@@@@@@@AA@@@@BA@@@A@A@A@@A@@@@@BA@@AA@@@@@A@@AB@@AA@@AA@A@@AA@@A@@AAA@AAA@@@@BACAAAA@@@CA@AC@@@A@@AA@@C@AAC@@@@A@ACA@E@CA@@C@AAAA@@C@@@AA@@AA@@AAAA@@@AAA@A@@A@@@@A@AAAA@@AABA@C@@@A@@AA@B@@@@A@@BADEB@DEDABAB@DCB@@@B@B@DAAA@A@@@AA@@@@A@AAAAA@@A@A@@@AAAAA@AA@@@AB@AAAAA@@A@@A@A@AAA@AB@@@BA@AD@@@@BB@B@@@B@@A@A@A@@@A@A@@@AA@@@@@ACA@@@AAA@AAAAA@AAA@@A@A@@A@A@@BAB@@@B@BAB@@@@A@@BBB@@ADA@@B@B@B@BB@@BBB@BAB@B@@ABA@@B@A@@AA@CAA@@AAA@@AACAC@AA@@@A@@@A@AE@A@@@@@EB@B@BA@@B@@A@A@@@ACAA@A@@A@@@A@@@A@A@GEBA@@@@B@BA@A@@BBB@B@@BD@B@D@@AB@DA@@DB@@BA@@@A@@AA@@A@@A@@A@ABA@AB@@A@@@AAC@A@@AC@@AB@@A@@AA@@@@BA@@BAA@AAAA@@@AA@@A@A@@@AB@B@@AB@@@BABAB@BAB@AACAAC@A@A@@@@@@B@B@B@B@DA@AB@@@B@@A@@A@@AB@BA@@B@@AB@B@B@@@@@B@B@@AD@@@@@BABA@@@AB@B@@@BAD@B@BA@A@@B@BA@A@@@@BABCBA@@B@@@B@BBBBBB@@@@BBB@@@B@@A@A@@@@@A@@@@DB@@B@@@B@@AB@@@@@AAB@@A@@BAF@B@@@B@BBB@BB@@B@D@@@BA@@ACAC@@AAA@@@DABA@@@@@A@@AA@@A@A@@@A@@A@@AAAACA@@@@@AB@@AA@@ACA@@A@@@A@A@A@@A@@A@A@C@@@@@@@AAA@@@CA@@@@BA@@@@@A@@@ABA@@@@BE@@@AB@@@ACC@AA@@ABAAAA@@A@@AAAA@A@@@A@@@A@AA@@B@@A@AB@BAB@D@@@@@@AB@B@B@@@BB@B@@@@DAB@@@B@@B@@B@B@@@BBBBABDBD@@@D@@@B@BB@@@BA@@@@B@@@BBBD@B@BA@@B@BA@@BC@@D@B@@@@BBB@@DDD@@@@AB@@A@@BA@@AA@@BABAB@@AB@B@@@BAB@@A@CA@@@AA@ABA@@AAA@A@A@A@@@@A@A@@@AB@B@BAD@B@@B@@@@BA@AB@B@@@BBBAD@B@BAB@@@BA@@B@BB@@@B@@B@BA@@D@@BB@@@B@BB@@@@@ABE@CBCBC@ADCFK@C@CAEAAAGCIAGAM@A@C@A@ACIACACBC@A@AB@@AD@@@D@FDFFBBBDBBBFBD@AB@BAB@B@B@DFB@LABABAB@HCDAD@@@BAB@@B@BBDBBB@DA@CBADAFA@AACDADBDDBH@FDDL@BBB@@@ACBE@@@AA@@@A@@@@A@A@@B@@AB@@@@@AA@@@A@@@AB@@ADAB@@@BB@@BBB@@@@ABA@@BC@A@@B@@A@A@@@A@@@A@ACC@@@@"]],encodeOffsets:[[[110170,24911]],[[110177,24887]]]}},{type:"Feature",id:"451227",properties:{name:"巴马瑶族自治县",cp:[107.258588,24.142298],childNum:1},geometry:{type:"Polygon",coordinates:["@@FA@@@A@A@@B@BABAB@B@B@D@@@@BB@BBB@@@@@@@B@B@B@B@B@B@@BB@@@@@B@BBB@@@B@@@@@B@BAB@@@BB@@B@@B@@B@B@@BB@@@DA@@B@@A@@D@@@B@@@B@@@BA@@B@B@BBBB@@@B@@B@@@@@B@@@B@@AAAAA@@@@@@AA@A@A@@A@AB@@@@@@A@@AB@@ACA@@AA@@B@@@D@@@B@B@@@BA@@B@@@BA@@@ABAB@BB@@BB@@@B@@B@D@@@@@BA@@B@@@B@B@B@BBB@@@B@@@BBB@B@B@BBB@@@BB@@@@@@@@B@@@@@B@BBB@B@BB@@@@B@BA@@B@@@@@B@@@B@@@@@B@B@@@B@@@@ADB@AD@BBBB@BB@B@@@BBBB@@DB@B@@@@@BAB@B@@@@B@DBF@B@@@B@B@@@B@@@@@B@@@B@@@@B@B@@BB@@B@B@B@@@BB@@B@B@B@@A@@@@@A@AB@BA@@A@ACA@A@A@@@AAAAC@@@@@A@@AAAA@@@AACA@@@@@AA@@A@@B@BC@@D@@@@@BB@BB@BB@@@AB@B@B@BB@@BA@@BA@A@@A@@@CCBC@@@@BAB@@@B@BB@@B@@@@@B@@BDBB@@B@@@B@@B@BBB@F@@A@@@A@@@@@@BC@@@@@@@AA@AACAA@AAAAA@@A@@BABA@@B@DBB@@@@A@A@AA@AA@@@AB@@ABACACAC@A@@@@CAAC@@AA@@@A@A@@@CA@@@@@AB@@@@A@@A@AAAA@A@AA@@@BA@@B@BA@@@@@@DD@@B@BA@@B@@@B@@@@BBBB@BB@@D@BB@@B@B@BA@C@A@@B@BB@@B@B@B@BBB@DBBAB@B@@A@@@@BAB@@A@@@@@A@A@@@AC@@@@AA@@AC@AA@@A@AB@@@A@A@@@A@@B@BA@@@@AA@@@AB@@ABB@A@@@AB@@@@@@@D@@@D@B@B@B@B@B@@BB@@@B@@ADA@@@@@A@A@@AA@@@A@@A@@AAC@@@@AAA@@ACAAAAA@@@ABA@A@@B@B@B@@@B@BB@@B@B@B@B@B@@@B@@@BA@@BAA@@CA@A@A@@@CBEAAB@AA@@@AC@@BAB@@A@@@@B@DBB@@@@A@@BA@@A@AAAA@@B@@@DC@ABA@A@CBABCBABC@@@A@A@ABA@AAA@@AAC@@@@@AA@A@@@@BA@@B@@ABA@@@AAA@@AAA@A@@@@ACA@@@AB@@AB@B@@@B@A@@C@A@@@C@A@@BBB@BAB@@@B@@@BBB@B@B@@@B@@AB@@BB@@@BC@@B@@@B@@@@A@@AA@@@@BC@@@@B@@A@A@A@CAC@@@@BA@AB@B@@A@@B@@@BB@@B@BABB@B@@B@@@D@B@B@B@BA@@@@A@A@AC@@@@@@@AB@B@B@@A@@B@B@B@@@B@DC@ABABAB@@@B@HAB@@@B@B@BAB@B@@BBB@@@B@@BB@@B@@@BDB@BBD@BBBB@@DBB@@BBDBBB@@BB@@@BABABAB@BA@@@A@A@@BA@@@@@BB@DD@@B@B@@@B@@@@@BB@@B@@@BD@@@BB@@BF@D@@DB@@@DA@@B@BBB@@@B@@BD@BBB@@@@B@@@@BBB@B@@@@@DC@@DAB@@@AABA@A@@AC@@@@@C@@@A@@BAB@@A@@@@A@@@AA@@@AAA@AA@@@@AA@A@@@BADA@@@A@@@A@A@@BA@@@A@@@@B@B@@@B@@B@BBB@@@BDAB@B@B@@@DA@@BBB@@@FA@@D@B@@@BB@@@@@@BA@AB@@@@AB@@A@@BAB@B@@AB@B@BBB@@@@ABA@A@@@BBBB@B@@@B@@@B@@@B@DBBB@@B@BD@BB@@B@B@B@@@B@@@B@@@@BB@@B@BBB@@BB@@@B@@BDB@@@@@BA@@BA@ABABAB@D@@@@@BB@@@@BA@AB@@A@C@@@ABABA@@BBB@@@BBB@B@@ABA@@B@B@B@@AB@D@@A@AB@@@BBBBBBB@B@@@BB@B@DBB@@@@@BB@@AB@@@B@BBBB@@BB@@B@@AB@@BBB@@@B@@BD@@@BAB@@B@@B@BB@BB@@@@B@D@B@D@@@B@B@@@BBB@@B@@@B@B@B@@@@@@@BA@BDAD@@@B@@AA@@C@ABA@@@@B@@@@B@@@@B@BABA@@AA@@A@@A@@@A@@BAB@B@D@@@B@B@BB@@@@BB@@@ABAB@@A@AD@BAB@@BBBB@@@BBB@@@B@@A@@@A@@@A@@@AB@B@@A@@@@@A@A@@@E@ABAAA@@@@@A@AA@A@@@ABCB@B@B@B@AA@@@A@A@@AA@@AAA@@BABA@C@@AA@AB@@AB@@AB@DA@@DA@@DBB@@@D@@ABABADAB@@@BABAB@@@B@@@BB@BB@@@@@BB@@EDA@@B@@A@@@C@@BA@@@@B@@BB@BBBBABA@@BA@@@@@A@@B@@@B@@@B@@@@@@@BABAAA@A@@@@@ABABAB@@@B@@@@BBD@@A@CD@@@@@@DD@@ABA@ABBB@B@B@@A@@B@@BB@B@@A@AB@BC@@@@B@@@@@@ABAD@@BB@@ADB@B@B@@@B@@@@@@B@@@BBBB@@BA@@@BB@@B@B@B@@@B@BA@@@A@@B@@A@@@AB@B@B@@@@BB@BB@B@@BB@@@@B@B@@@@@B@@BB@@@B@B@B@B@@@@BBBB@@@BA@@BA@@B@B@DA@@@@@A@@A@@A@@@AB@B@BAB@BA@@@@@ABA@@AA@@AA@@AA@@AA@@@@@@A@A@@@@@AA@@@AA@AA@BCBA@@@@@AAAA@@@@@@ABA@A@@B@@@@ABA@@BA@@@AA@@@A@A@@@AAC@@B@@AB@BA@@B@@@@@B@@@BBBBB@@@BC@@@A@A@ABAB@@B@@B@@@D@B@BA@A@@B@B@@BB@@B@@@BBA@AB@@@BAB@@@@BB@@@B@@@@A@@@A@@@A@@B@@@@A@@@@@@AAAA@@BA@A@@@A@@B@@A@@@@@@@A@@@AAAAA@@@@@AB@B@@@B@@A@AB@@@BABB@@DC@@@A@@@@@A@@BA@@DA@A@@A@@@AAA@A@@B@@A@@@AA@@A@CCC@@A@@@@@CB@@@@AB@BB@@@@@@B@DBB@@BB@@AB@@B@@B@@@B@@@@@@A@A@@@@DAD@A@CE@@BC@@BAD@B@B@BA@@@@AA@@AAA@A@A@BC@@@A@ACA@@A@A@@AA@@@B@BCBA@@@AB@B@@AB@@@BA@@AAAA@AA@A@@@@@ACAA@@@A@A@@@AEC@A@@BAB@@@BAEAA@C@A@@@BAB@@A@@BAAC@@BA@@@ABCA@@A@@EA@@@@AA@ABA@A@@A@@@EBA@A@@@AA@@BA@C@@@A@A@@A@AAAAAA@A@@AACAA@A@@@CCAA@A@AACA@@@AAC@@AEA@@A@ABA@A@@@AB@@ABABCBAB@BA@@@@@@@A@EAABA@A@@@C@@@@A@@A@A@@@@CAA@@A@@@@@A@@B@@A@AB@@AA@@@@BCAA@@AAAA@@AAA@A@A@AACEAAAA@AAC@@AA@@C@A@@@@A@@AAABA@AB@@CD@@@@AB@BABCB@@AD@@A@@BAAA@@@@@AB@@A@@B@@AAC@A@A@@@@@AAAA@@@@AAA@A@@@@@@BAB@@AB@@A@@@CB@A@@@@@AA@A@A@@@@A@@@@BABC@A@@@@@AAA@@@A@A@@AA@@B@@A@@@A@@@@@AAA@A@@@AAA@C@@C@A@@@ABA@@@AA@@@@@@BA@@@A@@@@@@A@@@@A@@@@BA@@@@A@@@@@AB@AAA@BA@AA@@AB@BA@@@@A@@CA@A@@@@@AEA@@A@CCA@AAA@AAC@A@A@@@A@@B@@@@A@@@A@ABC@@B@@A@A@C@@@A@@B@@AB@@AB@B@BCB@@@B@@BDBDB@BB@@@BB@BB@B@@AB@@A@@D@@A@@B@@ABAB@@AB@@@@@B@@@@A@AB@B@@@BAB@DA@@D@B@BAB@B@B@@@B@BA@@BA@@BA@@B@B@@@@C@@@@@A@@@C@A@AA@@AA@@@@AA@@@A@@A@A@@@A@@@C@AA@@A@@A@@CBA@@A@@A@AA@@AAA@@@A@@@A@@@@@AA@@ABA@@@@@@@@A@@A@@@@A@@AB@@@@@@@@A@@A@@A@@@@@@AAA@@@@@A@A@@@@AADA@@@A@@@A@@A@AA@@AA@@A@AA@A@@@ACAAA@@@A@@A@@@A@A@A@@@@A@AB@@ACA@@@@AAAA@@@@@@@@BA@@@@@@B@@AA@BA@@BB@AB@@@@@@@@@BA@@B@@@@A@@@A@@@@@A@@@@DAB@@@BAB@@A@A@@@@BAB@@A@@@@B@BBB@@@B@B@BAB@@@BA@A@A@C@A@AA@@@AAA@@ABADAB@@@B@BA@@@A@ACA@@@AB@@A@A@@B@B@@@BAB@@A@@B@@@B@@@B@@@A@@A@@@@@@B@@@@A@@BA@@@A@@A@@A@A@@@A@@@@BA@@B@@@B@@@@AB@@@@@AAA@AA@@@A@@@A@C@@@A@A@@@A@@A@@A@@BB@@@A@@B@@@@A@@B@@A@@@AB@@AB@@@A@@A@@A@A@AAA@C@@C@@@A@@@ABA@@BA@@@A@@@A@@@ABAB@@AB@@A@A@CBCB@@AA@ACAA@@BAAABC@A@@DA@A@C@@@@@@BA@@AA@@@@B@@@@A@@@@AAB@@@@@@@B@@@@@@A@@B@@@@@@@BAA@BA@@@A@@@A@@@@@@@AB@@@@@@A@@BB@AB@@@BA@@A@@A@@B@@A@@@@@AA@@AB@AAB@@@BB@@@A@@@A@@@AD@B@@@@@@A@@@@A@C@A@@@@A@@@@BA@@BABA@@BABABA@@BABABADAD@B@@@@@@CB@@ABABABA@@B@BA@A@A@@BAAA@A@ACC@@@AB@@ABA@@@AB@B@@@AA@@@AA@@A@A@@@A@@@CBA@@BA@@@A@A@ABABABAB@BABAB@B@@CBBBDB@@@BAB@B@@BB@@BDBB@BBBA@@DBB@B@H@@@BABABAB@AA@@@A@A@A@A@@BABA@@@AB@BA@@@AB@@@BA@@BBD@BB@@@A@@BA@ABA@ABAD@BA@C@@BABAB@B@@@@ABA@@AAB@@A@A@@B@@A@A@@AA@@A@C@@@@@@AAA@@@@@A@AD@B@@@@GBG@@@@@@B@@A@AAA@@AA@@D@B@BABA@@BDBBDB@B@@BC@A@A@@BA@@@@@AB@D@F@DBB@FBB@BABBD@B@@@B@@@@AAAAAAC@A@A@A@@BA@@@@@@@AB@@@@A@@@@@A@@B@BA@@@A@A@@CA@A@AA@@AAAAA@A@AA@@AAA@AB@@AA@BA@@BA@ABA@@@@BA@A@AB@@A@@@A@A@AA@@@AAA@@AA@@AAA@@A@AB@@CA@@AA@@A@@@A@A@@A@@BA@@BA@@BA@@A@BA@@AA@@BABAB@@A@@B@B@@@B@B@@AB@@@@ABAAA@@AA@A@@@AB@@A@@@AB@B@@@BA@@@AD@@@@@@A@A@@@A@ABA@AAA@@A@AE@@@A@@BAB@@@AA@A@@@@@AB@@@B@B@@@AAAA@@CAAA@@@AA@@@A@A@C@@@A@@AEBAAA@@@@A@@@A@@@C@AAAA@CA@@@A@AAA@@@A@A@@@@A@A@@@@@@AAA@ACAAC@A@@CAA@B@@C@@@C@@@AB@@A@@A@AAAB@@CA@@@@@@@BA@@@@@A@@@AAAA@@A@@A@@CB@BA@@A@@ABA@@@A@A@@@@B@B@@A@AB@AABC@A@@@@@AAAAA@A@@BBD@@A@@@BB@BAD@BA@@B@BA@A@A@@BA@AB@@@B@@@B@B@@@B@BB@@B@@A@@BABABADAB@BABA@B@BB@@ABA@AB@@ED@BA@A@AB@B@@@B@B@@@B@@AB@@A@@B@BA@A@@B@@@@@BAB@@AA@@A@ABA@ABA@@@C@AA@AA@AAA@A@A@@@A@@@ADABA@@B@@BB@@A@AB@@@B@BBB@@@@@B@@AB@@ABB@B@@B@@@@AD@@@B@BB@@B@@BB@B@B@@@@BD@B@F@BAB@BA@ABAB@@A@@@A@@@AB@B@@AB@@BDA@@BABA@@B@@A@@B@@BB@@BB@BBB@@@@A@A@EAA@@@@B@BB@@B@@@BABA@@@@@AB@@@A@@@@A@CB@@A@AA@@A@@@ABEAA@BCBA@AB@@A@@@@C@@@@@@@BA@@@@A@@@@A@@B@@@@@A@@@@@C@A@@A@@@A@AAA@AA@A@@AA@@@A@@@AA@@A@AA@@AA@@@@A@@B@B@@@BADC@A@C@AB@@C@@@ABCDA@@@ABAAC@AA@AAA@@C@CB@@@BA@@BA@@B@B@B@BA@AD@B@B@@ABAB@@ABA@A@CA@AA@A@@@AA@A@@AA@@@@C@@@EAAA@@@B@@C@@@@BABBBBB@B@B@@ABA@@BABAB@@CFA@ABABAB@@BB@B@B@@@B@B@BAB@@AB@@@B@BBDB@@B@@AB@B@@@@@DA@@@@BBB@@B@@B@@@BABAB@D@@AB@@ABA@@BA@@B@@@B@@BB@B@@CBA@@@AAA@@@@@@B@@BB@@A@@BA@A@A@@@@BCBAB@@AB@@ADAB@D@@A@@@@BAB@@ABBB@@@BB@BB@@@FB@BB@@B@@D@@DB@@@@AJ@@A@AB@@A@A@A@CC@A@@A@A@A@A@@@A@@@@BA@ABA@A@@@@@A@@@@@A@@@AA@A@@@ACA@A@@@AAA@CAA@@@A@A@AA@@AAAA@@A@AAA@@AA@@AC@A@@@A@@@A@A@AA@@AA@@A@AA@@@@BA@ABA@@@A@@@@AA@A@ABA@AAA@@@@A@@CA@@A@A@ABABA@@BA@A@AA@@@@C@ABC@A@A@@@A@ABC@A@E@@B@BBB@BB@@@@@@@AB@BA@@@AB@B@@A@A@AAC@A@@@@A@@@AC@EAAAAA@@A@@@A@AAABA@A@@@CAA@@B@@AB@BCD@@@B@@@B@@@BAB@@A@AB@@@@A@A@ABA@@B@BAB@@A@ABA@@BAAA@@BA@A@@@@BABA@@B@BA@AB@AA@AB@@AAAAA@A@A@AAA@AAA@AACBC@C@@B@@@BAB@@ABC@@@A@@@@@@B@@AB@BA@A@A@@@A@@@@DAB@B@@@B@BAB@@@B@B@BB@@@BB@@A@@B@@A@AB@@@BAB@A@@C@@@AAA@@@@@A@@@A@A@AA@@A@@@B@@BBB@@@@@BB@@B@B@B@B@@BB@@B@@@A@@BAB@@BB@@@DAB@BB@@B@B@B@@F@@@@@@BAB@@ABA@@B@B@@@BA@AB@@AB@@@AA@@@A@@@@@AB@B@BA@@BB@BB@@@B@BB@BBA@BB@@@@@BAB@B@B@@BD@@A@A@A@AAA@@@@@@B@@BB@@AB@@@@A@AB@@@@@@@A@@A@A@@B@@@@AB@@@B@@@@@B@@A@@AA@@B@@B@B@A@@@A@@@A@A@@@@@A@@A@@@@@B@@A@@@@@@@A@@@@@A@@@@B@@A@@A@@@@@@@@@B@@@@A@@B@@@@@B@@@B@@@B@@@B@@@@@@@@@AA@@AA@@@AA@@@@A@@@@B@@CBA@@@@@@@BAB@@@@A@@@A@AB@@AA@A@AAA@@@@@A@@B@@A@A@C@@A@@@@A@@@@@AB@A@@A@@@AB@A@@C@@B@@@@CD@@E@A@A@AAA@A@A@A@ABC@C@A@CBA@A@CBA@ABA@A@AB@@C@@BA@A@C@CD@BA@@@AB@@@@AB@@AA@@@A@@@@@A@@@A@A@AB@@AAA@@A@@@@@AA@@@@@@@@AA@@@@@@A@@A@@B@@@@A@@@@@AA@@AA@@A@A@@@@@AA@@A@A@@AAAAAAA@AA@AA@AA@@@AAA@@@AA@CC@@A@@AA@A@@@@AA@AAA@A@@@A@@BA@@@AACAA@A@A@@AA@@@ABA@AAA@@AA@@A@@@@@A@@A@@AAB@AAB@@AB@@@BAB@@BB@@A@@AA@@BA@@@@BA@@@@@@B@BA@ADABAD@@@BB@@BA@A@A@@@@@AB@@AB@@AB@@@B@@A@@@A@@@A@@@A@AB@AA@@B@@@BAB@BA@AB@B@B@@@BA@@@AB@@A@@B@B@@@@AB@BA@AD@BBB@@AB@@A@@@@B@@C@A@A@A@@BA@@@A@AB@BAB@@A@@@@AA@@@AA@@@@CFCDADADA@CBAB@@@B@@A@A@A@@@A@AAA@@@A@@@A@@@A@AA@@ABABA@@BA@@B@@@BB@@B@B@B@BC@@BAB@@A@@BA@A@@BA@@AC@AB@@@D@B@@AB@B@BABBB@@DB@@F@@B@BBBB@B@B@@@BB@BB@BA@@D@@A@@B@B@B@@@@D@@@@BBDBB@@@@@@@B@@@@@@BA@@BAB@@A@@@ABA@@@@B@@@@@@@B@B@@@BABA@A@@@A@@@EB@@A@A@@BABC@A@@@@@@AAC@A@@AAA@@A@A@@AAAA@@@@A@AAAA@@A@@@AB@B@@ABA@@AC@@@AA@@A@@@A@@@@@@@@AA@@AA@AC@@@BAB@@CA@AA@@@A@A@C@A@A@A@CAA@A@@BA@ABA@@B@B@B@@AB@@@@@BCBABABAB@@@@A@A@A@A@@@ABA@ABA@@@A@@B@@A@A@@BAB@@A@@B@B@@@@@@@BBB@BB@@BB@BB@@BB@@AB@@A@A@C@@@A@BB@@@@B@@@@B@@B@@@@@@BB@@@@@@B@@@@@B@B@B@@A@@@@@@@AA@@@@@@@@A@@@A@AB@B@@@B@@@B@@@@@@A@@@A@A@@@A@ABA@@@ABDB@B@@ABAA@BA@@@@B@BA@@B@@BB@BB@A@@@A@@@AB@@A@A@A@BB@@@BB@@@B@@BB@@B@@@B@@AB@@BB@@A@@@A@@@@@ABA@A@@@@@BB@B@@@B@B@@@BAD@@A@A@A@@B@@@BA@BB@BA@A@@B@BA@@@A@@ACB@@A@C@@BA@@BA@@@@@@B@@AA@@@@A@@@@B@@@B@B@BBB@B@@@B@B@@@@@B@@@@@BB@@@@@@B@@@B@@ABAB@@@B@B@@B@@@@B@@@@@B@@@@B@@@@@@@@@B@@@@@B@@@@B@@@@@@@@B@@B@@@@B@@@@B@@B@@@@@BB@@B@@@@BB@@B@@B@@@A@@B@@@@@@@B@@@@A@@@@B@@@B@B@@@@@@@BB@@BB@B@B@B@@@BB@@B@B@@@@@B@B@@BBBDD@@B@DB@BDB@@B@@@DBB@@@DB@@BBB@B@BB@@@@@B@B@BB@B@B@D@B@B@BBB@@@BB@B@B@@B@BBB@BBB@BB@AB@@@DB@@AB@@ABAB@@@B@@BBBD@@A@@B@@@B@B@B@BA@@B@@B@@@BB@BDBB@@D@@@B@@@BB@@B@@@B@@B@BDB@@B@@@@BDBBB@@B@B@@@B@@@B@@@B@B@@BB@@BDBBB@F@BA@@B@@@B@@@@@B@B@@AB@@@B@BA@@@@B@@@BBB@@@B@@B@@BB@B@@@@BB@@BB@@AB@B@@AB@B@@BBD@@@BBB@@@B@BB@@DBBBB@BBB@B@@@@@BB@@@BDD@@@@A@@@DD@@@@A@ABA@@@@@@B@BCB@B@B@@BBBB@@BB@@@@ABA@@@@BB@@BA@@D@@@B@B@B@@@BBB@@A@@B@B@@B@@BBB@@D@B@B@@B@B@B@@BB@BBB@BBB@@B@B@@@B@BBB@BA@@@@B@@@@@B@@@@@BBBB@@B@@@@@@@B@@@B@@@D@@@B@@AB@@@BBB@@B@@B@@BB@@@@@@BB@@@B@B@@@@@@B@B@BB@BB@BB@@@@@@BD@@BBB@@B@@B@@@@ABA@AB@B@@@@@B@@@BAD@@AD@B@@@BBD@@BB@B@@@B@@B@BBBB@@BBBB@BB@@D@@BBB@@BDA@@BA@BB@@@@BBB@@@BB@BB@@B@B@@@BABABAD@BA@@B@B@B@D@@@@@BBB@B@@@@@B@@@DB@@@@BBD@B@BA@@B@@@B@B@BBD@@@B@@@@C@A@A@@BA@ABA@@B@@A@ABAB@@@@@B@@@@BD@DBB@@@@@BBBB@B@@@B@@B@@B@@BB@@BB@BD@@BB@@@@B@@@BBB@B@DAB@B@B@@AB@@@B@B@BB@@BAB@@@BA@@B@BAD@@@@BBB@B@D@@@BBB@BBD@@@B@@@BBD@BBD@@BBBB@@D@@B@@B@B@@@BB@@@BAB@@BB@@B@@B@@BD@DAB@@@@@B@BBB@D@@@B@@BB@DA@@B@B@@@@ABA@A@@A@CA@@@A@A@@@A@A@@BADCBA@@B@B@B@@DBBBD@@B@B@@B@@@B@@BB@@BB@@@B@@B@@@@@@@@@BA@@@@B@@C@A@@BA@AB@B@B@@@@AB@B@FA@@@A@A@@D@@@@@B@BB@B@@@@B@AB@B@@B@@@B@@@D@@BB@@B@@@@@BC@@@A@@@A@@@@@C@@@@@@B@@@BB@@B@@@BB@@B@@DA@@BB@@BABBB@@AB@BA@BB@@BB@@B@BAB@BAB@@@B@@BDBBB@BB@@B@@B@@@B@@AD@@A@@AAA@A@@@AA@@@@AA@@@@@AAC@@D@@ABBBBD@@BBB@@@B@B@@BB@@B@B@D@@@BB@@@B@B@B@B@@BB@B@@@@A@A@@BADAB@@AB@@AB@AA@@AA@@AAAAB@@ABA@AACB@@@@AB@B@@A@A@@@@CA@@@A@@B@B@@A@@@@@@@AA@AAAA@@@@@AA@@@EBC@CAECCE@GBABCD@DBBBJ@"],encodeOffsets:[[109712,24950]]}},{type:"Feature",id:"451228",properties:{name:"都安瑶族自治县",cp:[108.105311,23.932675],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@ABA@@@A@@@A@AB@B@@BBA@@@A@ABA@@A@AA@@@@@A@@@AC@@A@A@AB@D@@@BC@AA@A@@AA@A@AA@ABA@@@A@AA@A@A@@A@@AAAA@@AA@AA@A@A@B@@A@AE@@@AAA@@@@BABA@A@@@CA@@@A@AB@BAB@@AA@@AAAA@C@@@@A@@DA@@@A@AA@@@AAAAA@BC@@@@D@B@@@@@@@BA@@@AA@@@AAC@@ACAAA@A@ABA@@BA@A@@BA@C@@DBDBB@BC@@B@B@B@D@D@DADAAAAA@AC@AA@A@C@@ACAAAAAAA@AAAAA@C@@AABAACA@A@A@@@@@CC@AAA@A@A@@AAAA@@A@@ACB@@A@A@A@@AA@@@A@A@A@@@AA@AAB@BA@@AC@@@@AA@@BAB@@@@@AAAAA@A@A@@@A@@B@@@BAB@@A@AB@@@B@@@B@B@@@BA@@@C@@B@@@@BB@@BBDDAB@@A@@@AA@AA@A@A@AB@@@BBB@B@DA@@@A@@@A@@BCB@@@A@@AA@@B@@@ACA@@@@@A@@@AA@AA@@@@AA@CA@A@A@@B@@@D@@@@A@@BA@@AA@@A@@@@AA@@@AA@A@A@@CDABA@C@AB@B@B@@@DB@@@B@@@B@@BBBA@@B@DA@A@A@A@A@A@@@@@@A@@AC@@AA@@@A@A@@@A@@AA@AA@A@@BABCBA@A@@BAAAAC@@BCB@D@BAB@D@@D@BBBBBDABAB@B@BAB@@A@AAA@CD@@A@AA@@@BC@@BB@@BBB@@BB@B@B@@A@A@A@@B@BAB@@BF@BBB@@@B@B@BB@@BAB@BABBB@D@@ABAF@B@BABA@A@@@@A@A@AA@AAABA@@BC@A@@BABAACD@@@@@BABAB@DAB@@AB@D@B@B@@@BCH@@CAGCAAECAAAAECGGCC@AACCECGAE@C@EFEJI@AAECECAGAC@E@GBABCBEHENCFEHABABGDEDADABCBA@@@IBE@CCACACAABA@ACCGIEC@ACEAC@CAA@A@GAE@EAACA@AKCAACC@AAAAECGCECAGCE@AAA@EBGDEBIAABGBIFABA@EAECGCCA@AAAEA@@CD@DB@A@@BA@@BABBBAFAB@DEH@BA@CB@B@BAD@B@BCBCBBBBD@D@@AB@@@@AB@@@B@B@@AB@@@@A@@@@@K@@AACACC@@CEAC@C@AF@FABABBBB@BB@DBD@BE@C@@CAAEAOAA@E@ABCB@DGBCAC@A@ACACE@A@@@A@@AAAA@@@@@CCAAA@@@C@@@ABA@@@A@@@AA@@A@@@@A@@@@CAC@AB@BBDA@AFABA@AACA@CE@AAEAABEB@BG@CBCBCF@DEDFB@FFD@@@@@BBBDB@DDB@DDBBFDBD@DAB@B@FABDBBD@BBDD@B@BBBFABBFB@B@BCDDDB@BB@BBJHABDBB@DDBBDB@BAB@FADBFAD@DDDB@@@B@@A@@@AAA@ABAA@@A@@@A@@@@@A@@@A@@@AB@BB@@@B@@B@@@B@@@BB@@@BBBB@@@BABA@@@ABCAA@@@@AA@@@CAE@A@EB@DC@E@CB@BCDCBCBE@CB@BCBABFBBDBB@@ABCBAB@@CAC@C@AAAA@CAAC@C@C@ABA@@D@BDBB@@D@BAB@FAJEBCA@@C@@@CC@ACC@ECAGCGCADEB@B@BCB@BC@C@AAAAKCC@ABABCD@A@AE@EAECADCAABAB@@E@ABAB@F@@F@BBBBABC@AFCBECAFE@AB@@C@@BBDB@DBB@BB@DB@DBBBB@@@D@DB@B@DCB@BABA@CAC@C@CB@D@D@DCAABAA@FAB@BBBD@DD@@B@BADAB@FDDB@@BBFFD@FDFBDB@D@BB@BDFB@CBADB@F@D@@DBBAD@@FB@DBB@BA@@@@@@B@BBB@B@BB@@D@@@BB@@@@A@A@@BA@@@@B@@ABA@@B@@@@AB@@@BA@AB@BA@@B@@BBA@@BAB@@@@ABCAA@@@@@@AA@A@AB@@A@@@ABAB@@@@B@@B@B@@@@@@@B@B@@@BA@AB@@AB@BB@BB@BB@@@BB@@B@B@BA@@@@DB@@BBB@@BB@@@@@@BBB@@B@BABB@@@B@B@@@@AB@B@BCAEAABCDDDBBABA@CACAAB@F@BBBABAAC@CCAAABADABC@CBCEECACC@EAABGF@@A@ACCAA@EDCBCAAB@BC@@B@BB@@BC@C@ABCAAA@@AACAC@@AG@@BC@AB@F@BBB@@@FB@B@DBB@@DABCBABABBBBB@DABBD@BCBA@CAAAAACA@A@ACCCAAA@@@BABCD@AABA@EBCDA@CBABABADA@@BDBBAD@DB@BABABABAAEAADA@@BA@CAABCACFBBB@B@@BD@DBD@@B@@BB@BA@@@ABAAA@@@@@@BCBAB@@@B@@@@B@@@BDA@@B@B@@@@@BA@AAA@@@ABA@@@ABA@BB@@CB@@AB@@AAA@AB@@C@@AA@@@AB@BAAC@@A@@C@C@@@@@AD@D@@@@@BBB@@@BBB@@A@BB@B@@@@EA@BA@@@@B@B@BA@@B@@BB@BB@B@@BB@@@BB@@@@ABA@@B@@BBBD@B@BBB@B@@DBB@@@B@@B@DA@A@@@@B@B@@A@@B@B@@BB@@@B@@AB@@BBDB@@D@B@@@B@@@BB@B@@@@BB@B@@@BBD@@BB@@@@AB@B@@A@@BB@@@B@@BBBDB@B@@B@B@BA@@B@@B@BBB@@@BAB@@@@BB@B@B@@B@D@@@B@BB@B@@@@B@@@BBB@BBD@@B@@@@B@A@ADB@@B@BBBDBADBBA@@B@@AB@@BDB@@B@@@D@@@B@@B@BBB@@@@B@@@B@@@B@@@BB@BB@@A@@@CBA@@@@@AB@@A@A@A@AAA@@@A@@@@A@@A@C@@@@AAA@@C@@AA@AAC@@@A@CB@@@@A@A@A@A@A@ABA@@@AAA@A@A@A@@@@BBB@@A@@DAD@B@BA@@BA@@@@@@B@@A@@BABC@CDDDD@DBBDD@BBBDBBFDBBC@EAAB@@@DABA@ABA@ACC@A@@B@B@BAB@B@BBB@B@@A@AB@B@@B@BDDDD@@BADB@D@BD@@ABCBCBBDBDB@AF@@DDCDCBAB@B@BCBAAAD@BBB@@ADB@JDD@BD@B@@@B@@@@B@@B@@@B@@@BABA@@@@B@@B@@@B@B@@BBBBB@@@BA@ABAB@B@@@B@@D@BBBB@B@@@@AB@@C@ABA@@@@BBB@@@@AB@@@B@@@@CBCBAD@B@BBB@BABAD@DBBB@DB@@ABADBBDB@BA@@DAB@@@B@@B@@BB@@BB@@BD@B@DDDFB@@B@DCBABEB@FAB@B@@@BADABHFDBDBDD@D@BABCAABEFE@@DA@ABA@ABA@I@CBEBBBDBBB@BE@CFBBB@BBADCD@BBDBBDDADBDD@BB@BCB@DBBD@BBD@BB@D@DBB@@@BA@EB@BCDA@CBACA@ADCD@@BBDBD@@DA@AD@@@FDBDDBADBDB@BADA@ABA@CAABCF@@A@@B@DBB@B@BABBBBD@DDBDADD@DDFBBDBF@D@BDDHB@@BBA@@BBB@@@@@B@@BDB@BAB@@@@@B@@@@BBB@BBDBDB@@D@BAB@D@@A@AA@@AAA@AA@@A@C@@B@B@@@@@BABA@@AC@A@@B@BA@A@@@A@@BC@@D@B@BBB@BBB@@@@@B@FBB@@BDBB@BB@@B@F@DBF@BCBAD@@CDC@CA@@A@@DCB@AG@C@ABCDCBCB@BC@CACACBA@@BAD@B@BCDBBB@BBBFB@BAD@D@AD@D@BBBB@BB@BADADBBB@BCB@BCB@@BBBBAFDBD@FDFD@BCD@D@B@B@BBB@@BB@@BB@B@@BBB@@@@B@@B@@B@@@BABBB@@@@B@@@@@@@@@@@B@@ABABA@AFCB@BA@@@@@@B@B@@@BB@@@BB@BA@@B@@BDBBBB@@@@B@BABABCB@BA@A@ADEF@BBD@D@@CBA@AB@BBBADA@AB@D@@ABABC@ABA@@BDBBFA@ADA@AB@@ADABAB@BABAB@BBDA@AD@BBDBDAB@D@@@D@DA@AB@B@@B@AB@@BDBBDBB@@@B@@@@@@BB@@@@@B@@BB@D@DBBDBBFBBBBB@@BBB@AB@@@@A@BB@@BBBBB@BBBBB@@CDAD@BA@@@ABB@@B@BBB@B@@@B@@BB@BABA@@BBB@@@@BB@@B@AB@B@@AB@B@@@BBDBD@@@@@BA@A@@B@@BB@@@BAB@@AD@B@FAB@@@@@@BBBDD@B@B@B@BB@@@BD@B@BABA@@B@@AB@BCD@@@DA@AD@DBBD@@DB@BBAF@@FB@BAD@B@B@B@@@BB@BB@B@@@@A@@BABA@@B@@ABCB@@@B@DD@BBF@B@D@@@BBBB@@@@@@AB@@ADABABBBA@@B@BBB@@@BBB@BB@B@@B@@@@@B@@@B@@ABA@@@@B@@@B@BA@A@A@ABA@A@@@AB@D@@@B@@@BB@B@B@@B@@A@ABA@ABA@@B@@@@@@@BAB@B@BAB@@@D@DB@@@D@BB@@B@BCDAB@B@BAB@@@@ABA@@B@B@D@D@BBB@@@@@B@@@BBB@B@B@DB@@DDB@BBBAD@@@B@BBDABAB@FA@@BAB@@AB@@@BA@@@ADBD@B@BDDCDAF@@BDDADBBBBD@BADABABAACA@ACB@A@@@BAB@@@@@BC@@@@BBB@@B@@@@DAB@B@B@@A@@@@B@@ABBBB@@B@@@JB@@BB@@DABA@@B@@@B@B@@@@A@AD@@A@@BAB@B@BBBBB@@@@@@@B@@AB@B@@@@@@@BC@@BA@@BC@A@@@@B@@BB@B@BBB@B@@@B@@A@A@A@@@@@@D@@@B@@AB@@@BA@@DB@B@@@@BBB@@@@B@@BBB@BAB@DA@@@AB@@AAAB@BE@AACACA@@AA@A@@A@@A@@ACA@@AA@AAA@@@@C@@@AA@@@A@A@@@A@A@@D@B@BA@@BA@@@@B@@@D@@@@A@AB@AABA@@BAB@B@@@@A@AB@@@@@BABB@C@CH@@CDCD@FCDAAACADEF@BAB@DBDBDAB@DABA@E@ABADAAABA@CCABGEAECGE@CBCBCBA@AAAGACABA@CAAAC@@@ABADA@C@AACAACE@@@@@ABABC@ADADCBCDCBCBABABA@C@@@@BA@@@@DABABCBEBBD@B@@BJED@D@B@BADABADCCA@ABCB@DCJBDA@A@CBABCAACCAADAAAECC@AABCBA@CDA@@DADAD@HABAAEFAF@DCFABCFAB@BCB@FAB@BAD@DBD@DDDBFBB@@CFAH@DBBBB@D@DAB@FABABAHA@AF@B@@BDBFB@@D@@DDBB@BD@@BD@D@DBBGBCA@BDBABC@CDEB@DCBABC@@DABBD@@A@@BBDDDBDBDBD@D@BABC@E@ABAB@DD@BBBAB@BBBBF@H@HCAGDEBCFEHC@ABCBA@ADEBAB@@@BCB@FBB@@AD@B@DB@AFA@@BC@A@@BABAD@DBB@DABBB@@@D@BA@ABC@@@@@AD@DADDBB@BBFBB@@@@FDBB@B@B@@@BD@@B@DABBBBBDBJ@@CBE@@@AFADCDED@BABEDA@ADABBBDFB@@BBF@@@@ABA@ABB@@BAB@BA@@@ABA@@@CA@@AB@BAH@D@DDA@AB@BA@@B@BBDAD@@BAB@DA@@B@BABB@AD@DC@@DCCCBABA@ABE@ABC@@DC@@DC@CF@@AB@DAB@@@@C@@@@@A@A@@A@@@AAA@A@A@A@@BA@@@@A@CAA@@@@@A@@@@@A@@@A@@@@A@AA@@@A@@AA@@@ABA@@@@BA@AB@@@B@B@@@BB@B@B@@@BB@@@@@BA@ABA@A@@D@B@@AB@B@BAB@DB@@@B@@B@@@@AD@@@@A@A@@BA@@A@CAA@@@ACACAE@AA@AACAAA@@BA@@BA@@@A@@@@@@AAAA@A@@@@BAB@@@BAB@@ABA@@@@BBB@BB@B@B@@D@@B@@B@@@B@B@BB@@B@@@B@B@BBB@@@B@@@@@BA@@@@B@@@B@@A@@BBDB@@B@@ABAB@B@FB@@@A@@@A@C@@@A@@AA@@@A@@@@@C@@@AD@FB@@@@BA@@BA@@B@B@@@BA@@B@@A@@BA@AB@B@@@BA@@B@B@@@@@BB@B@B@@BBDB@@@A@@B@FCBADA@@@B@@BB@@@@D@B@@BB@B@BB@AB@B@@@@@@A@@@A@@ACAA@@@A@A@A@@A@C@@@@A@AA@A@AAAB@AAAA@@A@A@@@AA@@A@@@C@ABA@@@@B@@A@A@@DA@@@AB@B@@@@@AA@A@@AA@AAB@@@@@@A@@@C@@@@B@@@B@@@@A@CBAA@@AA@@@A@@A@@@@A@@@A@A@@@CAC@@AAA@@@BA@@@ABABA@@@@B@D@DBDABABAD@B@@@B@B@BADDBBB@B@BA@B@@@@D@B@B@D@B@@@B@@@@@@BH@DB@A@@@@B@B@@AB@@B@@@@B@@@BABA@@DB@B@B@@@BA@B@@BDB@@A@@BA@AB@BBBB@@D@@AB@@A@@B@D@B@D@B@@@@D@BABA@AFAFBDBB@@@BA@A@@@C@@D@BADA@A@ABC@AB@BA@A@@@C@AAABGDCDAB@B@@@D@DBB@D@@@F@@CBBB@HCHABCDEBG@C@@@A@@@@@ADC@AD@BCBCFAB@@CBC@EDA@CHEBABC@A@ECE@CB@BAAA@CAAAA@CACCCAA@CD@B@BGCCEA@A@CAABAB@AEBABADABCDA@A@CAAAAAADAD@DBBADABAAA@CAAAAA@CBKBC@ACBABC@ACAAABCCE@AAA@@ACCGA@EG@A@C@A@@G@DCBAB@@@@@@A@A@A@A@A@AA@@@BA@@@ABC@@B@@A@A@@BA@@@AA@@A@@BA@A@@BA@@BA@ABA@A@@@AB@B@@A@A@AAC@AA@@ABA@@BAB@BA@@B@BABC@@BA@@@A@@B@@@D@B@@@BC@@@A@@@@AA@@A@@@BA@@@A@@@AB@@@D@BAB@B@@@BA@A@@@@@@@ADA@@B@@BB@BAB@@@B@BA@B@AB@@A@@@@@AA@@AA@@@A@A@@AABA@AAA@@@C@@@A@A@@@GAA@AAEA@CD@BCBCBCAE@ADECAAA@ADAFI@CBAB@B@BI@A@AFABAB@F@@A@EBA@CAC@CDABABABA@A@@@A@A@A@@AA@@BC@AB@@@@A@A@A@@CAA@@A@@BC@@@@@A@@BA@A@A@@DA@AAA@@A@@AAAAA@@@AEA@A@B@@@@@B@@@@ABA@A@@@A@@@@@@@@BA@@@@B@B@@@@@B@@@@@B@@@@A@@B@@@B@@AAC@A@AA@@A@A@@A@AA@AAA@AA@BA@A@A@AA@@B@@@@@A@@@A@AA@@@@B@@@@@@@@@@AB@@@@@@C@A@@@@@@A@A@@@@@@A@@@@A@AC@@BAABA@CBC@CAEACACCAACACEA@E@CBABCD@BBD@FAFCFEBIFA@EBC@EBCBA@A@CAA@@@E@C@CA@@G@C@I@ICAAAAGCAAB@IEEAECICE@EAGECCGGCCCE@@@AD@FBD@B@@@@CBE@AAA@C@CAAAA@AB@BAAAAABAAACA@@E@ABABAAAAC@@AAA@@@@@AB@@A@@AC@ACA@@@@BA@A@A@@AA@@A@@A@@@@@A@A@A@@@@AABA@@B@@@BA@@D@BB@@@BBB@@@B@@@@B@B@@@@A@A@A@CB@@@B@DA@A@A@@AA@A@A@AB@@@D@@A@@AA@@@A@A@@@@AAB@@A@AA@@@AC@@@@@AB@B@@A@@@A@@@AB@@@BCAA@@AAA@@AB@BACAAA@A@@@@@A@A@@DEA@@ADA@@@AA@@AAC@A@@B@B@@A@@B@@A@@@AAAAAA@A@AAA@A@A@A@AB@@@@A@AAAA@@A@AAA@@AA@A@@A@@@@A@@ACAA@@@@BAB@@@@@B@@AB@@@B@@@@AB@@@@A@@AAAA@@@@AA@A@AAA@A@C@A@AB@@AAA@A@A@@@@A@C@A@ABA@A@@AAA@A@@AC@C@AA@@@AC@@@ACA@A@@@AA@@@AA@@@A@A@"],encodeOffsets:[[110914,24472]]}},{type:"Feature",id:"451229",properties:{name:"大化瑶族自治县",cp:[107.998149,23.736457],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@AA@A@@A@@A@AA@AA@AAA@@@@@AA@@A@@@@A@A@A@A@@@A@@@@@@BA@@@A@@@A@A@@@@B@@AB@@@@@BB@@BBB@@@@@BAB@@@B@@@@@@@B@BA@@B@@@B@B@@A@@@@@@@A@@@@@@@A@@BB@AB@@AB@BCDAB@@AD@@BBB@@D@@A@@B@@@BA@@B@@A@@@ABA@@B@BBD@@@@ABB@@@@B@@@@@B@@@@@@@BBB@@@@AB@@BB@B@B@@DAD@@@@BB@@@B@@@B@B@@@@B@@@@AB@B@@A@@B@@AB@@@@ABA@AB@@@B@@ABA@@@@B@@A@@@@B@@AB@@@@@BBB@@B@@@BB@@@DB@BB@B@@BBB@@@@B@@@B@@@B@@AB@B@@@@@B@@AB@@AB@@@B@B@@@B@@ABBBB@@@AB@@@B@BB@BBBB@@B@@B@@B@B@@ABA@A@ADA@@BB@BB@BB@@BDBBBBAB@@@@BBD@BB@@BBB@D@BBB@@@@BD@BBB@B@BBB@@@BADB@B@@BB@BD@BBBBBB@B@@B@B@@@ADBB@@@BB@BCB@B@B@B@B@BB@@B@@BB@@B@@@B@@@BA@BBBB@B@@@B@B@@B@BB@B@B@@BDB@@@DB@B@@B@B@@BD@B@BBBB@@@@@B@@D@B@@BB@@@@@BABABA@@@AA@AAAC@@@A@AB@@@B@@@B@BB@BBB@BB@@@@BB@@@@@BCBA@@BB@@DB@@@@@@@A@@B@@A@@@@@A@@@ABAB@@@B@BBBB@@@ABA@@@@@@@A@@B@BB@@@@BA@@BA@@@A@@@A@A@AB@B@@@@@@BB@B@@BB@@BB@B@@@BBD@@@B@BD@@ABAB@B@@@BB@BB@@DB@@B@B@@@@@B@BB@@@@BB@@@BB@BB@@@@B@B@B@B@BD@B@@BDBD@B@B@@AD@@@BA@AB@@AB@B@BBBBBBBBB@BBB@@B@@@@B@@D@@@BB@@@BBB@BA@@@ABAB@@@B@@@D@@@B@@@B@@A@@@@BDD@B@BBBB@@BB@BB@@@DAB@@@@A@@BAD@@AA@@A@A@@@A@@FB@@B@@ABA@@BC@C@@@@@AB@@A@AA@BA@ABA@@@@D@B@@@@A@@B@@CDB@BB@@AB@BBBA@AB@@@BBBBD@@AB@@AB@@@@@@BBB@B@@@ABAB@@A@A@@BA@@BAB@@@@BBB@@@B@@BB@A@AB@@@BA@@@A@@@A@A@@@AB@BA@A@A@@BABA@@B@BA@@@A@@@@BAA@@A@A@A@@AA@@@CA@@AAA@@@A@@@A@@D@@A@@B@BA@AB@@@BB@@B@@@BBBBBA@@@@BA@@AA@A@A@@@A@A@@BA@AAA@@@AACAA@A@@@C@@@A@@@A@@@@CB@AA@@AC@@AA@@AA@@AB@@@@A@@@AB@A@@AA@@@@A@A@A@A@@@ABA@@@A@@@A@AC@@A@AB@@@@A@@B@B@BA@@BA@A@@@BB@@BB@@AB@BAB@B@@@@ABA@@@@DA@@BA@AB@@BB@@@B@BABA@@@AAA@@@@BABAB@@A@@B@BA@@B@@AB@@@@@B@@AB@@BD@@@B@D@@B@DB@@B@D@B@@BB@BBBA@@@AB@@@FBB@BBBB@B@B@@AB@@@@C@@@AB@@@@@BE@@BA@ABA@A@@AA@CA@@CBABA@C@AA@@A@A@CAC@C@A@@@A@@A@AA@A@AAABAA@@AA@@C@ABA@@BA@ABA@A@@@AA@@A@@@AB@@AAA@@A@A@@@ACA@@A@@AA@A@C@@@@@@B@B@B@@@B@@@B@@@B@@@B@@@BABCBA@@BAAA@A@A@@A@@AA@@CA@AAA@@A@AAABC@@B@@@B@@BB@@@B@@AB@@AB@BBB@@@B@@@BABA@@@A@@@@@@@AAA@@A@@BA@A@@@A@A@@C@@AA@AA@A@@AC@A@@A@@@A@A@@@A@A@@A@A@@A@A@@A@@@A@@C@@AA@@A@@A@A@AA@A@AAAAA@@AA@AC@@@@@A@@@@@A@@A@C@A@@A@@@C@AA@@AA@@ABAA@@@A@A@@A@@AA@C@@@A@AB@@A@CB@@A@ABC@A@C@@@AA@@A@@@@@AB@@A@A@AA@@@@A@@@A@@@C@@AAB@@@B@@@@BBCBAB@@A@A@@@CB@@A@@A@@A@CB@BABA@@@A@AA@@CBAB@D@D@BB@@BA@@B@@C@@AA@AA@@A@@BA@@@ABA@A@A@A@@@A@AAABA@AB@@@@ABA@AB@BA@@BC@@BA@@@AB@BABA@ABA@A@@@A@@@A@@@A@AB@@@@ABAB@@@@@B@BB@@BA@@@BBBBBB@@@@@BBD@@@@BB@@BB@@BB@@@B@@@BA@@@A@@BAB@@@BAD@BA@AB@@@BA@@BB@@BB@B@BBBB@@@@@D@@AF@@AD@BA@@@CBAB@@AB@@A@AA@AA@A@A@A@A@A@@B@@@@@B@@A@A@AB@@A@@@AB@@A@@ACACAA@A@A@@@@@A@@B@@@@@BA@@@AB@@BB@@ABA@@@A@@@ABA@ABA@@@ABABAB@BABA@@B@@@@@B@B@@A@@B@@@B@B@@AB@@AB@@@B@BA@@@@B@@@B@@DB@@BBB@@@B@@@BBBB@@@BBBB@B@B@@BB@D@DB@@@@BB@@@@B@B@@BB@@BA@@@@@A@@BA@@B@@BB@@BB@@@@B@@@B@@@BB@@@BA@@BBB@B@@@B@@@@AB@@AA@@@@C@@AAB@@@BA@A@@@@B@@@@A@@BA@CB@B@@@BCBA@EA@@CA@@AA@AAA@@AB@@AB@B@BA@AB@@C@@A@@@BC@@@@B@@@BA@@F@@A@@@A@@@@@@AAA@@@@AA@@A@@@A@CAA@AAA@@@@@C@A@@@AAAAA@@A@@A@@@A@@@A@@A@AA@@ABA@A@@A@@A@ACA@@@@@CCC@@BAB@@@@ACCCAA@@@@@AB@@A@A@@@AB@@AB@B@@@BABAB@@A@@BA@A@A@@BA@@BA@@AA@@@@@C@@B@@AB@@A@A@@B@@@BA@@B@@@BBB@@BBAB@B@@A@@BA@A@@@A@A@@@@BA@ABAB@@BB@@ABB@@@AB@@AB@@AB@@@BB@B@@@B@@@BB@@DB@@BAB@@@BB@BB@@BB@BB@@B@@B@B@B@@@BA@@B@BAB@@@@AB@BAB@@ABB@@BA@@BBB@BB@@B@BBBBB@BB@@B@BDB@@@B@B@@ABA@@@@B@@@@@B@@A@@B@@@@@@AB@@@B@B@BBDBBBB@B@@A@@@A@C@AAABA@EAA@CAE@C@A@@B@@@@AB@@@B@BAD@@@ACAAAAC@@ABABA@C@@@BB@@BB@B@BA@@@@@@@AH@H@@A@C@@B@B@@@@BB@B@@@@D@B@@@BB@@@B@BA@@@@B@BA@BB@@AB@B@@A@A@ABAB@@@DABC@AB@BAB@BAB@@@B@@AAC@AA@@AB@@A@@B@@ABA@@B@@ABAB@@@B@B@B@B@@BBA@ABABAB@@G@A@A@CA@@ABAAA@CA@AA@@AA@A@AB@@A@ACAA@DA@A@ABABA@ABABAB@B@B@B@@AB@@AB@D@@@B@@@B@BB@@B@@BB@@A@A@@B@@AB@BA@@B@@DD@B@BBBAB@@@B@BABA@@@ABABAB@BA@@D@@@@A@C@CBABABAB@@ABABAB@@ABAB@@AB@@@@@B@@B@D@B@@@@@@B@@@@A@C@@B@@@B@@@B@@AA@@A@BBA@@BB@@B@@@@@BA@@@@BB@@@AB@@A@@BAA@@@B@@@@A@@B@@@@@@@B@@@B@@ABB@AB@@@@@@A@@@@B@@@@A@@@@@@@A@BB@@@@@B@@A@@@@@BB@@AB@@@@@@@D@BCB@@@BADBBAB@@BBBDB@@BA@AD@D@B@BA@@BA@AB@B@@@B@@@B@@AB@@AB@B@@@B@@@DD@B@BBB@B@@@@BB@@@A@@BA@@B@@@BA@@@@B@@A@@@@B@@AA@@@BB@@@@B@@@B@B@@@D@B@@@B@@BBB@BB@@@@A@@B@@A@@@A@@@AB@@@@@B@@@B@BB@@@@B@@AB@@@B@@A@@@@@@@@BB@@@A@@@A@@@A@@@@BA@AB@@A@A@@@@B@BA@@B@@DB@B@B@@ABA@@@A@CBAB@BB@BB@@B@@B@B@D@B@BAB@@A@ABA@A@@@A@AAA@@@@@@BA@AB@@@@@B@BA@AB@@A@CB@@@@@B@@@@@B@@@B@@A@@@AB@@@@@@@@A@@BAA@@ABB@@BA@@@@@@@AB@@@@@@@@BBBB@@@@DB@BA@@B@B@@B@B@B@@@B@@@@B@@BBDB@B@@@BBBB@@@BB@@BBB@@@@B@@@B@@CBBB@@@@@B@B@@@@BB@B@@@@B@@@@BB@@@@@@@@@BA@@@B@@B@@@@B@@@@@@B@BA@@BB@@@@B@@@B@@@B@BB@@BBB@@@@BB@DA@@@BB@@@BBD@@@B@@@B@B@@@@B@@BB@@@@BB@@BBB@D@@@B@@@@@D@@@@@@A@AB@@AB@@AB@@A@A@@@A@ABA@A@A@CB@@CBA@A@@@ABAB@@@@@@A@@@@BA@@BABA@@@AB@@@@CB@@@BA@@@AAAA@@A@@AAA@ACAC@@@A@@DA@A@ABA@@BA@@@AB@@@D@B@B@@@@AD@BAB@@@B@@@@@@AB@@@B@B@D@BBB@BBB@DDB@@@FB@B@@@@@BDB@@@B@@B@@ABA@@BBB@@ABB@BBA@@@@B@@@@@AB@@@@@B@@B@@@@@@@@B@@AB@@@@@@BB@@B@BA@@B@D@@@@DBB@B@@@BBB@B@@@@@B@@@BA@@@BB@@@B@B@@BB@B@@@@@BADAB@@@@@B@@B@B@B@@B@@@@@BDA@@B@@@BA@@BA@A@@@@B@B@BB@@@@BBBB@@@@B@B@D@BB@@@AB@@@BA@@@@B@BB@AB@@@BC@@DABA@ABA@@@@DC@@BAB@BABB@@@B@@B@D@@@BB@@BD@BBBBBDFBBB@B@B@BB@@BBBB@@BBAD@@@@BB@@BAB@@@@AB@@@@@B@@@BB@D@@B@B@@@@B@@D@@@B@B@BAFBB@@@@@@@B@@ABADABABA@@BA@@B@B@BAB@@@FB@BD@BB@@B@BD@B@BBBDD@@B@B@DBBB@@@BBBBBBBB@@@@B@B@@@DAB@@BB@@B@B@FA@@B@@@@BAB@BBB@@@@FB@@@BB@AD@B@@AB@@BDAB@@@BA@AB@@B@D@B@FBAB@@A@AB@@@BFD@B@@@B@B@@BBBD@@@@B@B@@BBBBB@@AB@@A@@BA@A@@B@@ABADA@@@B@@BB@B@@@DB@B@B@@ADB@B@B@BB@@BB@@@@ABA@A@C@AB@@AD@@DFB@B@B@@BB@@@@BBB@@@@B@BBFB@@DA@@B@@@@@BBBB@BB@@@BB@@BB@@@@BB@B@B@@BB@@@@@B@B@BA@@@BB@@@BAB@@B@@@ABA@@@A@@@A@@@@@@A@@@@A@@AA@@@A@@@@B@@@B@@A@A@@@@D@@B@@BB@ABCB@@CCCBA@@@@@@B@B@BA@@@BB@@@B@@A@A@A@@B@B@@CB@B@BB@@BB@@@@B@B@@A@A@@ACBA@@B@@@B@B@@@@D@B@@@HDB@@@@@BAD@BAB@@AAA@@@ABA@A@@@AA@@A@@B@@A@@@@BA@@B@DBBB@BAB@@@BC@@@@@@BB@BB@@@BAB@BA@@B@@@@@@BBDBBB@@@@B@@@BBB@@B@@B@@BBAB@B@H@@@@B@@@B@BDBB@@@B@BA@AB@@@B@BAB@@@@A@@@@@AA@AA@@@A@@AA@@A@@C@@@ABAB@@@DBB@D@BAB@@@@@@AC@AA@AAABA@A@@BA@@AA@A@@BA@@B@B@B@B@@A@@B@BA@@B@DB@@DA@B@@@B@@B@@BD@@@@@@BBB@@@BA@A@@@C@AB@@A@@B@@C@A@@@@B@B@BB@@@@@BBB@B@@@@B@BABBB@BB@@BB@@@B@B@@@@BB@AB@@@BA@BB@B@B@@@BAB@@AB@@A@A@A@AA@A@@CB@@A@A@@@A@AB@D@@@B@BB@@@A@@@C@CDA@A@A@A@ADA@A@AAA@@@@A@@@AA@AAA@@@@B@@@B@@BB@BAB@BCBA@@@@B@BBBDB@B@@@B@@ABA@@@CB@BA@@@ABA@A@AB@BAB@BA@@@B@@@AB@BAB@@A@BB@@BB@@@@B@@@BA@@BBB@BB@@@D@@BBB@@@B@B@@@@@BA@A@C@@@AAC@@B@B@BAB@@BB@@@@@DBBB@@@@B@@ABA@@B@DBD@B@@BB@AD@@@@BB@@BBB@@AB@DA@A@@@AB@B@@A@A@@BA@@@A@@B@@@B@B@@@B@BA@@@@@A@C@@@AC@@ABA@@@ADA@@@A@@@@CA@@A@C@@@CAAA@@@A@@@ABA@AB@@@@@B@@@@@BA@@@AD@B@@@B@@@@@@ABC@@@A@@B@@AB@@A@@@A@A@@@A@A@A@@BA@CB@@C@@@@@CAA@@BA@@@AAA@@@A@@BA@CB@BABAD@@@B@DBDA@@@@B@@BB@D@B@AB@DBD@FAF@@BBB@B@B@@@BBB@@BBB@@BBBA@@@B@@@B@@@B@@BB@B@B@@B@@@@B@B@BB@@@ABA@@B@@@@@B@@B@B@B@@@@@@BA@@DA@@B@@@BA@@B@@@B@@BB@@B@B@@@@@DB@@@@@BA@@@AB@@A@@B@@@B@@@B@D@@BB@BAB@@B@@@@BA@@B@@@@@BBBBDB@FA@A@@B@B@@@B@@A@@B@B@@ADABB@@B@BBBB@@@B@@A@@BB@B@@BB@@BBBB@@BB@@@@B@@@DA@@BA@A@@@@@@BBB@@@@@B@B@@@@A@@@@@CA@@AAA@@@A@@@@BABBB@@@@CB@BAB@@AB@B@@@B@@ABA@@B@@B@@B@@BB@@B@@@BBA@@B@BB@B@@@BB@@BD@@@@B@BB@@@@D@@@B@B@D@B@@@@ABAB@B@@@B@@@B@B@@@B@@@@@BAD@@A@A@AA@@ABA@A@@@@@A@C@A@@@@A@@A@@@A@AAABA@@@A@@B@@@BB@@@A@@BABAB@BBB@BBB@@BB@@@@@DBB@@@B@B@@@BBBB@@CB@B@@@@D@B@@@BAB@B@@AB@@BB@B@BAB@DB@@B@@BB@@@B@BBB@F@@BBB@@@BAB@B@@@@B@DB@B@@ABAB@BBBBDBB@@@BB@@@BC@@@ABA@@B@@@BA@AB@DA@@BDB@AB@@@@A@@B@@@@BBF@@@@B@@B@@BD@@@B@BABAB@B@B@BB@B@BB@@DB@D@@BDA@@B@D@@@BB@@B@BB@@@B@@AB@B@@@B@B@BDD@@BB@BB@@BBAD@@@@BBB@BD@@@BA@BB@@B@BB@@BB@B@BBDB@@B@B@B@@BB@BB@@DDB@@@B@@@B@DBB@@B@@@AB@@@@A@@@A@A@@DA@@B@@@DCBCB@BDDAB@DC@AFAB@@A@@AA@C@CAAC@AAC@AA@CDA@AAAC@ACBCCCAAAC@ADCBCAAA@AADEF@@AAACAAAFADAJ@B@BAB@BAB@@CF@FEBADBBA@A@CCCCACAGEBABC@A@@@ABA@EFABADA@C@AA@CECCA@C@@AA@@AA@@AA@@@@A@@BA@CB@@ACAAABCBA@@CAA@AA@CBCBA@AAA@A@ABCDADA@@@@@A@@BA@@@@AA@A@@B@BAD@@@BA@@@@@AAAAAC@@@@A@@@ABABAB@@A@@AAAA@AA@A@@@A@@@@A@@B@BA@A@@@A@@@AA@@@@@@A@@@AACC@ICA@BC@@AA@ABCBBDA@A@ABADADCCC@@BEA@ACACDADABA@@ACC@A@BC@AC@CCACA@@@@ABAB@@@@AAA@A@ABA@A@A@AB@D@BDB@BAB@BA@C@@BAFBD@AAECAAACAAC@ACCAC@CCDCD@BA@AB@@@@A@@@@B@@AB@@A@ABC@CB@@@AA@A@@B@B@B@B@BB@@B@BAB@B@B@B@B@@@@@DAB@@@D@BBB@@BD@@@BB@B@@D@B@@@@B@@B@@@B@BBB@B@B@@@BA@@@@B@DA@@B@@@AAA@@A@@@A@@@A@@@A@@A@AAA@@@@A@@@C@@@AA@AC@@BA@@@AB@AABCCAAA@A@AA@BCB@A@@@@@@AC@AAA@AA@@A@@@@@@AAAA@@@C@A@@@@A@AAA@@@@BA@A@@AA@A@AA@@@ABA@A@@@@ACAAA@AA@@@A@@AB@@@@ABA@@@@AA@@AC@A@@@AAA@@@@@AAA@@A@@@A@C@@@CAAA@@BA@@@A@@AA@@@A@AB@@@@A@A@@B@B@@C@AA@@@A@CA@@@AAA@A@AACAA@@@AB@BA@@@@AA@@A@@AA@A@@AAA@@@AB@@A@A@A@@B@@AFB@@@@@AAAB@@@AA@A@@AA@A@@@@@CBC@@@@D@D@@@@BD@BB@ABA@@B@@BD@@@BAB@BB@@BA@@DA@@AAB@BA@@B@BA@@B@BBB@@A@@@@@A@AB@AC@@A@@@@@@A@@BADA@A@@@@B@BBBA@@B@@AAA@@@AC@CAC@@AA@A@AADEDBBADBB@@AB@BCFBBBBABABA@ACAC@ABCA@AB@BCBABADAB@DCFAB@BA@BDCBA@A@@BBDBDD@B@BDBBBBBDBB@DA@AACBA@CAAAABABADABA@CA@CAA@A@@E@@AA@A@EBAD@@AH@@BD@DBBB@@BBDBBAD@D@@AA@@A@AD@@ABADBDAFCB@DBBDB@@@HEBAFBD@BDFDDFDAD@BABCBABBDDD@BBBAAA@A@EBADBDBB@BAAACCDCBAFBDB@A@ABA@@@@@A@A@@AAABA@@@AA@A@@@@A@@AA@AA@@CA@@@@ABA@A@@@AA@@A@@AAAA@@ABA@@BAB@@A@@@A@A@@@@@@@A@AA@@@@@BABA@@B@@@BAB@B@@B@@@@B@DBBA@@@@BA@AB@AA@@@AB@@ABAB@@A@@BA@@@@@AB@BA@@@A@@B@@AB@B@@@@@AA@@C@@@AAA@A@AAA@@@@@@@ABA@CAA@@EC@ABCA@@@C@ECAAB@DEAACA@@A@CCAEAECC@EEAA@@CAECA@CBABA@@@CCC@AA@ABA@EBBBADB@C@C@CDAD@D@DBB@BA@ADA@C@ACAC@@@A@AACAA@@CAAA@CAA@AC@AD@@@BAF@BEFDDABED@BAAAAAE@@@@EBABAF@@@BABADBBCFDFBF@@B@BDCBABAD@LDBBBBD@D@@ADA@A@AFABCHDHDDB@FDD@BDD@@D@@@DBFABI@EBA@A@CA@CA@A@CB@BAD@D@D@BB@DBBBBD@D@DB@@BADABA@@AAACEABADA@ADAF@DADADC@ADAF@D@@CFAB@F@DB@@B@@B@@B@DBBA@@B@BA@A@@AAAA@@A@@A@@@A@@@AA@@@A@@ABA@@B@@@B@@@@@B@@@B@@@BBBAB@BB@@B@@@@A@@CACCC@EBCAEBA@ABA@ACCA@CAAACGBAI@AAAA@CCDC@A@AEAAAEBAA@A@ACCAAC@AAACEBA@A@CBC@CAAECA@CCA@CCAAA@A@@@@EC@EEAFC@CDEDADAH@@AFABAFBBBF@@DDBBBB@BABEB@AC@ABAD@DB@@@@@B@@B@@@BB@@B@@@B@BA@@D@@@B@BBDD@@@@B@BB@BB@@@B@F@BDBDB@D@DBHA@CDABAF@B@PBFBBB@DD@F@@AAC@CAAA@AABABA@EBED@D@FB@DD@BDBD@BL@@@@@B@@@@@BA@@@A@A@@BA@@@@BA@@@CACAADADA@A@ABC@A@ADAB@@AFG@CBABEAABA@AB@@AB@A@@CDC@@B@CCAAAAAC@@A@AACAA@A@C@A@A@AAA@AAAAAACCAC@A@A@AAA@A@A@C@C@@@AA@@AA@AC@@@@BA@@@AA@@@AA@@AA@@B@@CBADA@AB@BABA@A@E@A@@@A@A@@BA@CB@@A@@@A@C@C@A@@AA@AF@@AB@@@BA@A@@@A@@AA@@B@@@@ADD@@BABA@@@A@@B@@A@@B@@B@@@D@@@@BBB@B@@A@@A@AA@ABA@@A@@A@@BA@@BBBA@@@@@A@@@A@@@A@@@@BBB@B@@@BABA@AA@@A@@@@AA@@AAAB@A@@@AAA@@B@@A@@@@AAA@AA@A@@@@@AB@BA@@@AA@AA@@AACAACA@@A@@AA@A@A@@@AAA@@@@AAA@@AA@@@@@@@A@@@A@@AA@@@@@A@@AB@@ABAC@A@@@AA@AA@@A@A@A@@@A@@@A@@BA@A@@A@A@A@A@BA@@@A@A@@BABA@A@@@@AAA@A@A@@AA@@@@@C@@AA@@@@@@AA@@@@B@AAB@@@A@@A@@@@@A@@BAA@@@@@@@@@@@@A@@@@@@@@@@@A@B@@@A@C@A@@@@B@@@@A@@@@B@@BB@@A@@B@@@AAA@A@A@@@A@@@A@@@@@A@@@A@@@@@@@@@AA@@@@@A@@@@@@@@@@@@AA@@@BA@ABA@@@@A@A@CA@A@@@C@@BA@@@@@@@A@@@A@@@A@A@@B@@@@AAA@@@@C@@A@@AAA@@A@@@A@@BA@@@@@C@@@@@@B@B@@@B@BAAA@A@AAC@@B@BA@A@@@AAA@@AB@@CA@@@@AAAAAAA@@A@@@@A@@@A@@A@@@@AA@@@ACA@@E@AAA@A@AAA@@@A@@AC@A@CA@@@B@BA@@@A@ABABA@A@@B@@@BA@ABB@BDBB@@A@ABABABA@@@AB@B@B@B@B@BABAB@@A@@@@@@B@BA@@BA@A@@@ABA@AB@@A@@@A@@BA@@B@B@@A@ABA@@@@BA@AB@@CD@@A@@@@@@BA@@BA@@@AA@ACAAA@@@A@AAAB@@ABAB@@@@@BA@C@@@AA@EA@DABA@AAA@A@AAC@@AA@@AA@BA@AB@BA@ABAAAC@@@A@A@A@@A@@ABA@@@A@@@A@@BA@@@A@A@@@C@@BA@A@C@A@@AAA@AC@A@@AC@CCB@@@D@@@B@DA@C@@@A@@@@DEB@AAA@@AA@@A@@AA@AAAA@BAA@A@@ACA@@A@ABABAAA@ABA@@@@@A@@@A@@@@AA@@@AA@ABA@ABA@@@AA@A@@@@A@@@A@@@@BA@@AAAA@A@@A@A@A@AA@@@AAA@@A@@AA@@@ABAA@@@@A@ABC@AABA@C@@@@@A@@@@C@@@A@@A@@AA@@AA@BA@@@@BA@@@A@AAA@AB@@AB@@EBEB@@A@@@A@@@@@@AA@A@@@@AA@@AA@@A@A@@AA@@A@@AAA@@A@AA@@@@@@CDCB@@BB@@B@BB@BDBAD@@ABA@@@BDAB@@AB@@A@CAABADA@@@@BA@@AACEAA@@@AAA@C@@@AA@AAA@AACA@A@A@ABAFB@BB@B@@AB@@@B@@C@@@AAAAA@@BAAA@AA@@@@AAA@@BCB@@A@@A@A@A@ABA@@@@@AAA@A@@@@@@@A@AEGA@EC@BA@AAAA@@@A@@@@CE@@A@A@@AA@@A@@AC@@@AAA@@A@BAB@@C@AAC@AAABA@@BAB@@@@A@A@@AAA@@AA@@@@@A@@B@@A@@@A@AAA@@@@BA@AB@@A@@@AA@@C@@@@@A@@@A@ABA@@@ABA@@@A@ABA@@@A@@@AB@@A@@@AB@BA@@@A@E@A@C@ABA@@AC@A@AA@@AAA@@A@CC@AECDA@CC@ACA@@A@A@ABAB@B@BA@@B@BA@@A@@AAA@@@AAA@@@@BAA@A@@@A@@A@@@AA@@A@@@"],["@@A@@BBDJTBDBBFDBBHBJBFDFDFDH@@@BCBAFGJGNGLIBABC@AGAA@AAK@CC@EAGCCCACBBD@BEBCBAB@DCBA@AAAC@A@AA@AB@@C@CBGDA@ABABKBA@CEA@A@A@ABA@@B"]],encodeOffsets:[[[110316,24129]],[[110116,24909]]]}},{type:"Feature",id:"451281",properties:{name:"宜州市",cp:[108.636414,24.485214],childNum:1},geometry:{type:"Polygon",coordinates:["@@AABCC@BCDA@ABC@AABACAABEC@AAA@AAAABCBAD@@AA@@CAABAAC@ACA@CAADC@C@A@CAAC@BADEDACC@BAAC@CAEAA@CCAAA@@@ABCD@@@@@@CBA@@B@B@@A@A@AB@BC@A@AB@@C@@@@@ABAB@B@@AB@@E@A@A@A@CDAD@@AAAB@@A@AAA@AAA@A@AAAA@BC@A@C@A@AD@@@BADAB@D@BAB@B@B@BA@@BB@AB@BBB@B@DBD@D@@@@@BA@CBA@C@A@@BAB@@@@AB@@BB@B@@ADAB@B@@@@ABA@@B@BA@@@@BB@@@@B@@AB@@BB@@B@DA@BB@B@DA@@@@B@@@@@@B@B@D@D@BAB@B@@B@@@B@@B@@@BBB@B@@@@AB@@A@A@AB@@AB@@@@@B@@A@@@A@@BAB@@CBC@@B@@@B@@BB@@@B@@AB@@@BBF@@@@A@A@@@C@@@AB@@@B@@AB@B@B@B@@@D@B@B@BABA@@B@@BB@B@@A@@B@@A@A@CAACA@A@A@@BA@A@@@@@@B@BB@@B@@@F@BAD@@A@ABA@AB@BA@AAA@@@CDA@@BA@@@E@AA@@@B@@@B@@@B@D@@ABAB@@AA@@C@@@A@A@ABCBABAAA@@A@CAA@A@AC@@ACIAAC@CAABIBCDAB@BGDADC@CAECA@@CGACB@B@BA@@B@B@BABABA@CB@BAB@BBB@BBBBB@@A@@D@BA@@BA@A@@@C@AAAA@CAA@@B@@@AA@CAC@@AAA@A@A@A@@@AAA@AE@@@@A@CEA@AAAABA@CCCB@AAAA@@@@C@@C@ABC@@B@@@@@B@@A@@@A@ACA@AAAC@@AA@@A@A@@CG@@@A@@@AA@ABAC@A@@@A@A@@@AB@DB@@@@B@@C@@@AB@B@@AB@BA@@B@@@@@@@BA@A@@B@@@@@@@@ABB@A@@@@@A@@BBBA@@BA@A@A@CA@BABA@AB@AAA@A@@@AA@@B@@A@AA@@@A@A@AAA@A@@@AB@AA@AA@C@@@@@A@A@AAA@@@@@@CAAAA@@A@@AA@@AA@@@AC@A@ACA@CBA@A@BA@@AA@AA@ABAAAAA@CAA@@AA@CAA@A@@@@BC@@@AA@@ABA@AB@@@@A@@@A@AAA@@AA@A@@@A@@@AD@@AB@@AB@BABA@@AAAAA@A@A@@A@CBA@@@AA@@@A@A@A@@A@EB@@AAE@@@@C@A@@BA@@@C@A@@BA@A@@@A@@@A@@@ALEB@@ABC@@AA@@B@J@B@BAFA@AACA@@A@ABA@A@@B@B@B@@@@ABA@@@@@@CAA@C@A@@@A@@@@ABA@A@@BA@@AAAA@@AA@@CC@@@A@@@AAAAA@AA@@A@AB@@AB@@@BA@@@AB@BA@A@@@@AA@@@@FABA@BB@B@B@D@@@BABAFAB@BA@@B@B@AA@CAA@@C@@@@@@@A@@@AAA@@@@@@@@A@@@A@@AA@AA@@@@AAC@@@@A@@A@@@A@@@@AC@@@A@@@A@CA@@@AC@A@@@AAA@AA@C@@@AA@@@A@@BA@@@@@@AA@@@@@A@A@@B@@A@@@@@A@AA@@@@A@A@@@A@@A@@@@A@@@AA@@A@A@@@A@@BA@A@@@ABAB@@@@A@@AA@A@@@AA@AA@@A@A@AA@@@@AC@AA@DEB@@@@A@AB@@ABA@@BAB@@@@A@AB@@CAC@A@@BAD@@@@A@ABA@AA@AA@@@AA@@BA@@@A@AB@@A@AA@A@@@AAC@@@C@@AAAA@AACA@@@A@@BE@A@@@AB@@A@@@A@@@@@AB@BA@AAA@@AA@CACAB@@A@A@@BA@@B@B@@AACB@A@@AA@@@AA@AA@AA@A@@A@@@C@CB@@@@@@AB@@CBA@@BC@@@A@@@@@CCA@CBABAB@@AB@@@BA@@@@AAA@@A@A@A@A@E@AAEAA@A@@@@@ACA@@A@@CA@@@@C@@@A@BB@@@B@DA@A@@BA@@@@DA@AA@@A@@@@AAB@A@@@@@@BA@@@AABAA@F@@@@@BA@@@@@ABAA@@A@@AAB@@@@@@@@A@@@A@@BA@@B@BAB@B@B@@E@A@A@@@AC@A@A@@@A@@@AAAAAAA@@@A@AA@@@@@@C@@B@@@CEAC@@@A@@@A@C@A@A@A@AD@B@@@@@BB@@@B@B@B@@@B@BB@@@B@B@B@@@BABA@@AA@@@A@@@A@@@AB@@@B@@@AA@@@AA@@@A@A@@@AAA@A@@ACBA@@@@@@AAAAA@@@@@AB@B@@@@A@@ACAA@A@CBE@@@A@@BABA@@@AA@@@@AA@@@A@@@@ACA@@@@@A@AA@@AA@@A@AAA@@AA@@@AA@@@AADAB@@@B@@@BA@@D@@@@@B@@AA@@A@@AA@@@@@ABA@@@@B@B@@AB@@@AA@AAA@A@@@AAC@@@@@ABA@ABA@@B@B@DA@@DC@@BA@@@A@@AA@@@AAA@A@C@CBA@@@@@ABAA@BC@C@A@@BA@A@@@A@ABA@@A@@@A@@AC@EA@@@@@AB@@AAA@AA@AA@@A@BAA@@@A@@@@AAA@@AA@@C@@@C@@@C@@@A@CBA@@@@@A@AA@BA@AB@@AB@B@D@@A@C@A@A@@@A@ABAB@BA@EB@A@A@@@C@A@A@A@@@A@AB@BAB@@@B@BA@A@@@AA@@AAA@C@@@@BA@@B@BAB@@ABA@@@A@@@CA@@A@@@A@AB@@A@@@A@@AA@A@@BA@@@A@@@ABA@@@@AA@@@AA@@AA@@@C@A@AA@@AA@@AA@A@@AA@@CA@@C@E@A@A@A@ABA@@@AA@@@ACAA@A@@@A@A@AAAB@@AB@@AB@@AB@BA@AA@ACA@@A@CD@@@@CA@AA@@@ABCB@@C@@@C@A@@@@@@D@@AB@@@BA@CB@@@@ABAB@@A@C@@@ABA@@B@@@@BBB@BB@BCBA@AF@BA@B@@BA@@B@B@B@BFB@B@@BBBB@BB@@@BB@BCB@@@B@BAB@@@B@@@@AD@@@BB@DB@@@B@B@B@@A@@BAD@@BB@@@B@B@B@@@BABABABCB@DBD@DAB@F@BE@A@ABEB@B@BAJA@A@AB@DEJCB@BBBDBCF@BBFADADADC@@DFBBBB@HB@@B@B@@@D@@@B@BBB@BA@BB@B@@@B@@BB@@B@@@@@BA@@B@AABA@@@A@ABA@@AA@@@CB@B@@@@@@@BAB@@A@A@ABC@@@A@@B@@@B@@AB@@B@@@BB@@@@@B@@AD@@A@C@@@A@@@@B@@AB@@ADABA@@@ABA@AB@@AB@BB@@BBD@B@B@BA@A@@B@@@BAB@BAB@@AB@@@BAB@@@BB@@B@@AB@@@B@BA@@@AD@B@@AB@@B@@B@B@B@B@B@B@@@@A@ABCDH@@@@B@D@BFHB@DHBD@@BB@BDFADBBDB@BADABBDD@LADAB@BBBB@DBBABCBABCAC@CBBBBBBB@D@BCBADCBABABBFA@ABBB@D@BFBDDAHA@C@@DBBDDBD@DBBBB@DBBABA@@DDF@F@BADABGF@DCB@FAD@DA@EBADADC@@BCD@B@@@@@B@@@DAHCFADGBGDA@AA@DE@@@C@A@CAC@@@A@A@CBCDAHBB@B@D@@@BABA@@BAD@B@BCBABC@@@@D@@@BAB@@A@CAEAEB@BABABC@@@@@@A@C@A@C@AB@@@BA@@@CA@AA@ABAB@@AB@@@CA@AA@B@@A@@@A@ACA@@ABAB@@A@@@@@@AA@@BA@A@@@@@@BCAG@@A@@@@A@@@A@C@A@A@C@@@@@@AABA@A@AACCABA@A@@@A@C@ABABCBCAC@A@@@@@ABAB@B@@AB@@B@BB@@BD@D@@@B@B@@@B@@B@@@@B@@BB@@BBDAB@@@@@@A@@@A@@D@@@B@@@@@@@BA@BBB@@@BBB@@@@A@A@@B@@CB@@@B@BA@@@@@AB@B@D@@@BB@@B@@@B@BB@BB@BBABBB@B@@B@B@@D@B@@@@B@B@B@@BBBD@@@B@@@B@@@@A@A@@BAAA@A@@AA@C@@@@@AA@@@A@@CBABEDA@@@@B@@CAAA@@@A@AAA@@@@A@A@@@AB@@A@A@@BAB@@@BA@@@AB@@A@A@@@AB@@AB@@@@EAC@@B@@@D@@@@@B@@BB@@@B@@@D@B@@@B@@EAA@A@AB@BA@@@CAAA@@@BA@@@A@@@@@AB@@@@A@@@A@AAA@A@@@A@@@AAA@A@@@A@@@@AC@@@@A@AAAA@AA@@@@AB@BA@AB@@A@AB@@@@@BBBBB@@@@@@@B@@AB@@AB@@BBDBBBB@@BBFBDBD@@B@DBB@@@AB@@@B@B@@C@@B@@A@@@@A@@CAA@ABA@A@@BA@C@@@@BAB@BAB@@@@A@@A@@@A@AAA@@A@A@@@A@@BAB@@@@AB@B@@BB@@@B@@BBB@@@@@@B@@@B@@@@@B@@@@BB@D@B@@B@@AB@B@B@B@BB@@B@@@@B@B@@@@@D@@A@CBA@@BE@@DCD@@CD@@AD@BAF@BABABDDCD@@CDC@@BAAABA@@@CBA@AB@@BCAC@A@AB@@ABAB@CCC@G@ABA@@BB@@D@@AB@B@@ABA@AB@@AA@BAB@B@@E@AA@@EAACAACB@BCBAFABC@CFCDEB@B@@AF@DI@CAAAAABA@C@AC@@A@@@A@AAAEC@@@@AAAE@AAACCCBC@@B@@@@AD@BABC@@@A@AACBA@CAC@ABAB@@@BAD@@EB@BCAA@C@@BA@EAA@AD@@A@ABCF@BABAD@BGDEFADCFBHGDG@E@AAAAA@ABAAC@@CBABAF@D@BA@A@CACACACCCAC@AB@@@ACBA@CD@BADA@CFADCD@BACA@ADBHAAA@C@CAC@@ACA@CA@CC@@@EACA@AA@E@@BGBABABEBA@CBC@A@AACAG@EB@DA@EACACCC@CAC@ABA@EBA@ADA@EBADEBCDE@EBBFABGBC@CBCB@@CB@DABADBBD@FDBBCBBBDDBBADAB@D@BCBIACDA@AD@BDBCDABCBABA@C@C@IF@AA@C@AAAFADABCB@@@@AB@@@@@DABABABADCDADCDCB@BADAB@B@@@@DFBBBD@B@DCBAB@B@@BDBB@DABDBHBBB@BABADAD@DHFFDFBAHDB@DABBBCBAB@B@FABCBA@CBCACAA@ABE@CFDBBBCBEDC@CD@DG@@D@DAAAB@@@@A@@B@B@@A@A@AB@@ABBBA@@B@B@@C@@@A@@@@@AB@@ABA@C@@@@B@B@@@B@B@@BB@@D@@@@@BB@BBB@@DB@BB@@@@BB@B@@BB@@@BBD@D@B@BB@@DBB@@@FDBBB@BBBD@BBB@@DA@@BA@ABBB@@AB@B@BAD@@@@AB@@ADCB@BC@@BABAB@@@B@@A@@B@@A@@@C@@@A@@AA@A@A@@@AEAB@@AB@@A@@B@@@@@BB@@B@@@D@@@B@@A@@@ADA@AB@BAB@@@B@ABBBB@@BB@@B@@@B@@BBBB@@B@@ADAB@@@DD@@@BB@@B@@BBBBB@BBBA@@B@@@B@@@B@@ABA@@B@B@B@BA@BBB@D@B@F@BBBABD@@@@@B@@@BD@@@B@@@@BAB@B@BAB@@@BABCB@B@@@@@BA@@@A@ABA@@BAB@FABADABA@@DCBABA@@@@@A@@B@@AB@@@B@B@DB@@@@B@@@BA@E@@DAF@D@@@@@B@@@B@BABA@AF@B@F@B@B@@@BD@@DD@@BA@@B@B@B@B@@@DAB@B@BBDBB@B@B@B@BA@@@@B@BAB@@D@D@@BDBB@@BB@B@B@D@@@@@@A@@@@@CA@@@@@BA@@BB@@@@@B@@@@@@B@@@@@@@@A@B@@@@@@@@B@AAB@@@@@@B@@@@@B@@@@@@@@ADB@@B@BA@BAB@@@@AB@D@@@BB@BBB@BBBAB@D@B@@BB@BB@@DBBB@D@B@@@B@B@@@BBB@@@D@BBB@B@@@B@@@@BBAB@DBD@DBD@BBD@BBDB@@@B@B@@@B@@B@@BB@BA@@BA@A@A@AB@@@B@BAB@@A@AD@@BBB@BD@@ABAB@B@@AB@B@@AB@@@B@@A@A@CB@@AB@BA@ABCBA@CFCDGDCBABAB@BAH@DAD@BBDBD@B@DAB@BEH@@@BEFABCHABCB@@ABABABEDABAB@B@JABAB@@@@@@@BDBBB@@DF@@@BB@FDB@D@BB@BBB@BB@@B@@BBB@@@B@@@DC@@BA@@D@@BB@@@B@B@@@@BBB@B@@@B@B@B@@@BBB@@BB@B@@@B@@BAB@B@D@@AB@BA@@BAB@B@B@B@BB@@D@B@B@B@@@@B@@@@BB@B@BBB@B@@C@A@ABE@A@AB@B@B@B@B@@@@@B@BBB@@B@@@@B@B@@A@@@@B@@@@@@BBBB@@B@@@BBB@BB@BAB@F@BAFAB@B@B@@@@ABABA@CD@@@B@D@@BBB@@B@B@@@B@@BB@@B@@BAB@B@@AD@B@@@BABAB@@@BBB@B@B@B@@ABAB@B@@@BB@BB@B@@DABAB@@B@B@@@BDB@@DB@@@D@AB@BA@@@@B@B@@@B@@AB@B@@@BABA@@BABA@@D@B@B@B@@@DAB@B@D@@AB@@@B@@@BABAB@@@B@B@BAB@B@@@B@B@B@@@@@B@B@@A@@BADA@A@@@A@@B@BA@@B@B@B@@@DAB@@@BBBABBB@@@@@@@@@@D@@@B@@@@@@D@B@@@DAB@B@@BB@@@BBB@@BBDB@B@B@B@@B@@B@D@B@@@@@B@@@B@B@@@BC@@BBBB@@BB@@BB@@@B@@AB@@D@B@BBB@@@B@@@BC@@@ABA@@BC@ABAB@BAFABA@A@@@@@A@@A@BA@@@AB@@A@A@@BAB@BAB@B@B@B@BB@@@@DD@B@B@BDBB@@@@@B@BAB@BAD@B@D@@@B@D@B@D@@ABAB@BB@@BAB@D@B@@@B@@@B@@BB@@BB@@@BBBBB@@@DB@AFE@@@ABABA@C@ADAB@@AB@@@B@BBB@@BB@@B@@BB@@BB@@B@BAD@B@B@D@@@BBB@@@@@ABBBAB@@ABA@@@@BBB@BA@B@BB@@@@@FBDB@DB@BBBB@@@B@@@DDB@B@DDBBB@BBFBBBFDDBBB@B@BADFDFD@@BBB@DA@BBBB@@@BB@@@BB@BB@BBBB@@BBF@@A@@BA@ABADB@B@@@B@B@DBBAD@@B@BBB@@@BA@AB@@@B@@AB@@A@@@@B@@AB@B@@@BA@@@BBDB@@@BA@@B@@@D@BAB@BAB@@A@A@@BB@BBBB@B@@AB@@A@A@A@@@@@BFB@BB@BB@B@@@B@BBBAB@@ABBB@BB@@DB@BBB@@BBBB@@@B@@AD@B@@A@CB@@AB@@@BA@@@@@AB@BA@B@@B@B@BA@AB@BA@@@@@@B@@B@@BBB@@@BABAB@BA@@B@@ABCB@B@@B@@BA@@B@@A@CB@B@@@@@B@@@B@BAB@B@D@B@B@B@D@@ABBB@BBBB@BBB@@@@@@@D@@@@@D@B@BCB@B@BA@@BA@@B@@AB@B@B@@@BB@BBBBDDD@@B@@BB@@@DADAB@B@B@BA@@BA@@@@B@@AB@@@@@@@B@B@@@B@@@BA@@B@D@@@B@@A@@@@@AB@@@D@@@B@BADA@AB@BBB@BBFLB@@BBBB@DAF@B@@@BBBABA@@@@@@AA@@@A@@@ABA@A@@@@@AB@@AA@@AA@@A@@@AB@@A@@C@@AAA@@B@@@AC@@@A@A@@@@B@B@BAAA@@@AC@@A@@@C@A@A@A@E@@B@@@BC@@B@BAB@@@AA@@AAA@BC@@BAB@BAB@FABA@@@A@@BC@ACA@AAA@@@AB@@ABAAC@@BA@@@A@A@@BA@@D@@@BA@@BA@@BC@@BBD@BBD@@@B@B@B@@@B@BA@@DB@@B@B@@ABCAA@A@ABA@AB@@@BBB@B@B@@@B@B@B@AA@A@A@AAA@A@C@ABC@@@A@A@@@AB@D@@@@A@@AAGA@@AAAA@@B@@AB@@@B@@A@@B@B@@@B@@@BA@@@CAA@A@@@AA@A@CA@@AA@@@AA@@@A@@AA@A@@AAA@AAAAA@A@@@@DABA@@BA@@@ABA@A@@@ADC@@B@AC@@AA@@B@@ABA@AB@@@B@@@DAB@B@B@D@@@@@BBB@@@@@@@@ABA@@BB@@FABAB@B@@@@ABA@@B@@A@A@A@A@A@A@@@@@A@A@@AA@A@@B@@ADB@@BA@@@AB@@@@AB@BADBD@@@DA@A@@@A@@AA@@BA@@@@B@@@@A@@AC@A@C@AAA@@@A@@BAB@B@@A@@@@@C@@BABAD@BAB@BAHC@B@@B@@BB@@@BBBBBBDBDDBB@B@@BD@BD@BB@DB@@BB@@D@D@BBBFB@@B@B@D@@@BABAB@DBB@BB@B@@@B@BBD@B@B@@AB@@B@DBBAB@B@BABAB@B@B@B@@CAA@ABABABA@AF@BAB@B@DA@@@BBBDBFB@BBB@BBBBB@BBBDBBB@BDDBDB@@@B@BBD@B@B@@BB@B@B@B@HBBDBB@BBBBB@@@@@A@CBAAABA@AAE@A@@BADAB@DBB@@@BA@AAA@EBC@ABABA@AD@DB@@@@@AB@@A@A@@BABA@@AA@@AAAAAC@AAC@EBE@A@CBABA@@BAD@BABA@@B@@@BC@@ACA@AC@CAE@@CC@AAAAEBAAC@C@C@A@A@@@@BABBB@@B@@D@BA@@B@BA@@AA@A@CDC@A@A@@@AAA@A@A@A@A@A@A@@@C@@@ACC@@@@BA@@@CBCBA@@DC@A@@A@@ABA@@@A@@@A@@@CAC@C@ABC@AB@BA@A@AD@HAD@D@D@BBBBB@DBBBB@B@BBB@BAD@DDBA@ABC@ABCAA@@BAB@BEBBDAD@@@@ADCBAD@BBDBB@FABB@@B@FA@ADBBABD@@@BAB@B@D@BBB@BDDBBB@BB@F@BBBBD@BFBD@BBD@B@DDBB@BBD@BB@D@D@BBBF@@B@B@@@BB@BD@BB@ABABAHCFBBDB@@B@@DCB@BA@@BA@@BA@B@AB@BC@@BAB@@ADA@ABABC@@@CC@CA@@@A@@@A@A@C@@@C@A@ABAACBA@@BA@@BCD@D@BABD@@D@DBD@DABABBBABCDB@@B@B@DAB@BAB@B@D@DCBCFDDDBBB@D@B@DBBABAF@BBD@HDBAB@BABBBBJCDDDABABC@ABCBABABBB@D@BEBCDBFDJBFDB@PJBAFA@@BA@@@A@A@@@@A@@@@@A@@AA@BA@@A@@ACA@@@A@@@A@A@AAA@AB@B@B@@@B@@@B@@@@@B@B@B@B@BAB@@A@A@EBA@@@AD@@@BA@CBC@C@@AAAAEA@@CAA@A@@@@A@A@@A@CA@@CAA@AAAAAA@@@@AA@@CA@@A@@AA@AACAA@A@@@ABE@CA@@AAC@GCCAA@ABA@AA@A@@AB@@A@@B@@A@A@A@AAA@@@A@AA@@AAABC@@@A@@AAAABABA@A@@ABAACCCCA@@BA@AAAAACA@A@AB@@ABAB@BCBC@ABA@@@AA@A@@A@AA@A@CAA@@A@@A@@ACA@ADC@@CC@AA@@AB@A@@AA@AA@@@A@AFAJ@JAD@FCD@B@BEBAB@AC@AA@@EBGFC@ADHB@@B@@BBBBD@D@BB@DB@BABBBB@BDAF@BAD@BCB@BAAA@C@@DBD@B@DCBB@@@BD@B@@@B@@C@AAAA@A@A@A@@@A@AA@@AAA@AA@@@AB@@@BA@@@@@A@@@@A@@A@@@@@A@AB@D@BBDA@@B@DA@ABCDABADBB@D@D@NDDAB@BABA@ABCBABABAFKDCBAFAB@BABA@E@C@@@CB@@AB@@AB@D@B@B@DAF@D@D@D@D@FB@@BBDFBB@@@B@B@@@B@@B@@B@@B@@BAB@BA@AB@@BB@@B@@@B@@@@B@@B@@@D@BB@@@AB@B@@@B@BBD@@@BA@@B@@@D@@@D@B@B@DBB@DBH@B@B@B@B@@A@@BA@CBC@@@@DAD@D@D@BBB@B@D@J@B@B@B@B@BAB@@@BA@ABADABA@@B@@@FDDB@@@@@ABABABA@CA@A@ACBA@AB@@A@A@C@ABAB@@@@BB@BABAFAD@DA@@B@D@B@@AAABABA@AA@A@@@AAAA@A@@@AA@AAAAAE@A@A@A@@AA@@AA@A@EC@A@ABCDCAA@A@C@A@@AAA@@A@ABC@A@@C@@@AAC@AAA@@BA@AA@AAA@ABAEC@C@@A@CAACAAAA@C@@@AA@@@A@ABA@@@EBBFC@@@AAA@AB@@ABBDAD@@@BACC@AB@@AAAAEF@@C@@AA@@A@ACA@AAA@AA@AACA@A@ABA@@AAA@@@AAA@ABA@A@A@ABCACCB@AAA@ABA@ABAAAAAA@ADA@CBDBABA@C@A@@ACDCB@D@JC@A@ABABCACBAA@ABAA@@A@AA@C@ACA@AA@@@A@@@CAAA@EACBCBAD@@C@GBC@CAE@CA@AAAC@A@@@AAAA@@AA@@ABA@@@@@AB@B@B@@A@CBA@@@A@BA@@@AA@@A@@@@@A@AA@BAA@@A@@@A@@@ABA@@@@@A@A@@B@@@@@@AA@@@C@@AA@A@A@A@@@A@@A@@AAAA@@@@BABA@C@A@A@AA@BA@AA@@ABGB@B@BAB@BABCBA@@@A@CGC@AAAAC@A@A@@AA@A@A@@BABCBABCB@@@B@@@BABC@@@A@@@@CA@@A@@@@@@@@A@C@@A@A@A@@@A@AA@@@@@A@@DA@@@@@C@A@@AA@@@@AA@@CA"],encodeOffsets:[[111538,24892]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
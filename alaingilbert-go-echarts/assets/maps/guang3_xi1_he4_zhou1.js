@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("贺州",{type:"FeatureCollection",features:[{type:"Feature",id:"451102",properties:{name:"八步区",cp:[111.552095,24.411805],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AAA@AAACCA@ABAC@C@A@ACGBA@AAA@AC@AAAAC@C@@@C@C@@CB@@AB@@A@@@@@ABAAEC@CBEACFC@@@A@C@EAC@KCEEIE@CEDC@CBAAED@DEJAAC@KFAAC@@G@ACCAE@GBADABA@ADE@CBECCDCACCEEEA@AACGCAEDCDCA@HE@CAE@E@ABCBEAACAG@ADAAGEGA@EGCIBE@CBCAABCDCD@FCDC@EAGCA@ABCBA@CEE@GDCF@DD@ECCACBABAAAAA@ABC@I@ACAGCG@@A@CACACCIDG@GCCGFGH@@AAAAGHA@GGAA@CC@CCEBABCBCBGCAACB@BCBCAABBDC@AD@@CAA@@@@CEBADECG@AD@BEBABABAD@@C@CAEB@@ACCDE@ACADA@@DE@CBA@AAEFA@DBCDCD@@@DC@@@AJCBEAA@A@A@@@A@ABE@CAA@A@@AAA@A@A@ACC@@@CBG@A@@A@AA@E@EEA@B@@@@@B@@@@@@A@@@@@@@@@A@@@@@@@@@@BB@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@A@@@@@@@@@A@@@@@@B@@@@A@@@@@@@@@@@@@@@A@@A@@@B@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@B@@@@@@@@A@@@A@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@@@@@@BA@@@@@@@@@@@@@ABADABABABCBABA@ABA@EAEDAAA@AAA@@@@B@@BB@B@@AB@@@BB@@@BB@@@BAB@B@@@@@BA@@@@B@@@@@B@@@@@@@B@B@@BB@BAB@B@@A@@B@D@BAB@B@@@@@@AD@D@@A@BB@@@B@@@@B@@@@@B@B@@B@B@@BB@@@B@B@@@@@@@B@@BB@@@@@@@BBB@@B@@@@B@B@@B@@BBBBBABABAB@BAB@@AB@BA@BB@BBBA@@BA@A@@B@@AD@D@BBB@@@DABA@@@A@@BB@AB@B@BAB@FADAB@BAB@@@@DD@BBDBDBBBBABBD@BBBBDAD@BABADBDAF@@A@A@AB@@BD@@AB@@@BABA@@B@D@@@@@@@B@BAB@@A@CB@@A@@@AD@@ADA@CD@DABADAB@@ABEFCBA@CDCBCBC@ABCBA@CBAAC@GDC@G@A@AAAAA@A@BB@D@B@BDBBDD@@BB@AF@BABCACD@B@BABA@ABA@AB@@@BCDCA@@A@AAAD@BC@ABABCA@BC@@@AB@@AD@B@@@@A@A@C@ACC@E@A@A@AAAAAABAA@AAC@@BEAABEB@@A@AB@@@BC@A@A@@BAB@@@B@@BBA@@BD@BD@B@BABCB@BABAB@@BBB@AD@@B@BBAB@@@B@B@BABAB@@ABC@@@@BBBA@@DB@@@@@@BB@@B@@ABAB@@AD@@AB@@@BAB@B@@@D@@@BA@ABAB@BDF@@AD@@@BD@BBDB@@B@@B@@BB@B@@ABBBBBBB@B@BBABBB@B@B@@BFBB@B@@@B@@B@@BA@@@@@@@BBBD@BB@DBBB@@DB@@@BB@@BB@@@BBB@@BBB@DBB@BB@B@@@@B@@@BD@@@@B@@BBBAB@@@@BBB@@BB@@BBB@@@BB@@@B@B@@@DF@@@@B@@@BAB@B@@@B@@@BB@@@@BB@B@BABAD@BA@A@@BA@@B@@ABA@@@CB@@C@ABA@@B@BA@@BC@@@EB@BBB@B@D@DB@B@BD@@B@@BBABB@@BB@B@B@@A@@BA@C@@BA@AD@BAB@@AB@@BB@BB@@@BB@BBBB@@DBB@@@B@BAB@BA@@B@D@B@BBDAB@@@@A@ABABCA@BA@EC@@A@A@AA@A@ACAC@CBA@A@AA@@C@@@A@ABA@A@@@@BC@AB@B@BA@@@ABA@AB@@A@@DADCBC@AAGBCBCA@HA@@DADBBABAAC@A@C@GFABAB@D@B@D@@@@BB@@@@BB@@BB@@@B@@@@DF@@B@@BD@@B@@B@@B@@@@BDBB@@B@@@BBB@@B@@B@@@B@@@B@@B@@B@@B@@B@BA@A@@@ABADABA@@BA@@B@@A@@@A@AB@B@@AB@DD@B@@@BBB@B@@B@B@@BB@@@@B@@B@@@@BBAB@D@@@@AB@@@B@D@@B@@DA@@@D@@@D@@BD@BA@AB@@A@A@@@C@A@@@AB@@@@@B@@@@@@AB@@@B@B@@@B@@A@BB@@@@@@B@@A@@@C@@BAB@B@@@@@B@@@B@B@@BBA@@@BB@@@B@@A@@@@B@@@@@@BBD@BBB@A@@@@BA@@@A@@B@B@@@@@@@@@@B@@@@@@@AB@@@@@@AB@@@@@@D@@@@@B@BBB@@@B@@@@@D@@@@AFB@B@@@B@@@@AB@@@@@BAB@@@@@BA@@@A@@@@@AB@@A@@@A@@B@@AB@@@@@D@@@@@B@@@B@@@@@B@B@@A@@@@B@@@B@@AB@@@B@@@@@B@@BB@@@@@B@@@@B@B@@BB@B@@@BB@@@@@@@@AAC@@@@B@B@B@@D@B@BB@@B@@ABBB@@B@B@B@@BBB@@BAB@@@BB@AD@@B@@@@@@BBA@CD@D@BA@ABBD@@ABAB@@A@@B@@A@AD@@@B@@@B@@@B@B@@@@@@@BA@@@@BA@@@A@@@@@@B@@@@@@@BA@A@@@@@A@@@A@@@@@@@@@A@@B@@A@ABA@@B@@@B@@@B@@BB@B@@BB@BB@@@BB@@@B@@B@@B@@@B@@@@@BB@@@@@B@BB@@B@@B@B@@@@@B@@@B@@@BBB@@BB@@A@ABCB@@@@AB@@@@B@@@@B@@@@B@@BAB@AA@@B@@B@@@@@@B@@@AB@@@@@CFCB@@A@A@A@@BADA@A@@BBBBBB@B@BB@@@D@JABAFCF@@@D@B@@@F@B@B@A@AD@@@@A@@@A@@@@B@@@BB@@@AB@@@A@@B@@@@A@@B@@BD@BAD@@BB@B@BB@BB@B@BA@@D@@B@B@DD@@BDBBBB@@BB@@AB@@ABABA@@@A@AB@@@@B@@B@@@@@BB@@@@@@BB@@B@@@BB@@@@@@@@A@@@BB@@@@BA@@@B@@@@@B@@@@B@@B@@@@B@@@B@@BA@@A@B@@@@@BA@@@@@@@@BAACACAAA@@A@ABA@@B@@@@AB@@B@B@@BBBBBD@BB@B@@AB@@B@@B@B@BBB@BBJB@@AD@@@BABBDAF@D@@ADA@ABCBCB@B@@@@@BBDDFB@@@BDBB@D@BHBBB@BDDBD@@@D@B@@ABAACB@@A@ABC@C@A@A@AE@AC@@@AACAAB@AA@@@CAGBCF@D@F@@@B@BCBADA@@BABAD@@@D@@@@@B@@A@@BBB@@@B@@B@@B@@B@@@B@AB@B@@@@@BB@B@@@@B@@B@@@B@@@@@B@@B@@@B@@@@@BBB@@BB@@@@@@@B@@B@@@@D@@@@@@AB@D@BDF@B@@A@@@AB@@CA@@A@AAC@@@AAAAGC@@A@A@A@ABA@A@C@ABCBCB@BCDCB@@@AAA@@@@@A@@@@A@@AA@@A@@BAA@ACA@A@A@A@A@@@A@A@ABA@@A@BAA@@@@AA@@@BA@@@@@@@A@@B@@@@@@ABED@@@@A@@BA@B@A@@@@A@@C@@@@@@ACBEBE@CBCBB@@B@B@@@B@@@@@@@@ABA@@@A@AB@B@@@@AB@@B@@@@@@@@BA@B@ABB@AB@@AA@@@@@@@B@@@@@@@@A@@@B@@BA@B@@BA@A@A@AAA@CCEACAABA@@@CBABABA@EJA@EDABAD@BAF@B@HAH@DA@AFAB@BB@@@@@@@B@B@@B@@B@@@@@@@BA@@B@@A@@@@BA@B@@@@B@@@@A@@A@@@B@@@@@@AA@@@@AA@B@AAB@BA@@B@B@@A@A@@@@@@@A@@B@BA@A@@@C@A@A@A@@B@@@B@B@@A@@@@B@B@B@@A@@@A@AA@@A@@@A@@@@AADA@BBB@B@@BABB@AB@@B@@@@@@B@H@FA@@H@@@C@BBB@@AF@@@B@@@@@B@@FJ@@@@@@@A@@BA@CDABBB@@@@@@B@@B@ABBB@@@ABABCD@B@@@B@@@@@B@@BB@@@BB@@AB@@@B@@@@@@@@@@BBAB@B@@@@@@A@@@C@@@@B@@@@@@@@@@@@@@ABB@@B@@@BA@@@ABA@@BBBBB@@@BA@@@@@A@@B@D@@@B@@@@ABC@A@A@@@A@AC@@A@@D@@@@@@@B@@@@@@@B@@@@@@@F@@BA@C@@BF@B@@@B@B@A@@AC@@AH@D@@BBD@@DBBB@BBBBDDBB@BABA@C@@@@B@B@BAB@F@ABA@A@ABABBBB@@BBBB@BB@BAB@BB@D@B@@DADABADABAB@@E@@BA@ABAB@BBBB@@B@B@@B@B@BD@D@@@B@B@B@@B@DBB@BBB@BBBDDBBB@BBDDDBB@BDD@BDD@BB@BD@@@@@BBBB@B@B@BAD@FBB@BBBBB@BAD@BAB@@@B@D@BA@@BAB@@AB@BA@A@BBB@@BA@@@@BBBB@@B@D@B@F@@@DCDCB@B@BABA@@@@BDB@F@B@@@BAB@B@FBB@@CDBB@BABABCB@BBBB@BDB@BAB@D@B@DBBBBBBBB@D@D@B@B@@BB@@@DA@AD@D@DFFDBDD@DBFDBBDDB@J@F@BC@ADC@A@A@AA@@@B@B@@@BAB@@BBCDA@A@CAGDAB@@ADC@CAA@A@A@ED@BA@@ACCA@@A@C@@@A@A@A@@A@C@@BC@AB@B@@@@B@BB@BBFBF@DACAABB@FBB@@@D@@@BBD@B@@@B@BBBBDBB@B@B@@CB@BA@@BA@ABAB@@@B@BA@ABAB@AA@A@A@AAA@@@C@A@C@@@EAA@CDA@A@@C@@B@B@@@BA@@@@@CCACBCA@@C@C@@DA@@D@DCB@BA@A@ABAB@@CBA@CBIDAB@@@BBB@@ABABABC@@AA@@@@D@BAAA@CAA@@@@BFBBB@@D@DFA@@@@B@B@@@B@B@DABABCBA@AB@ACCAD@@@BE@C@@B@@AB@@BB@HBBBD@@@BBBABB@@B@DDBD@@@@BB@@@@@@BAB@@AB@B@@@B@@AB@@@B@BBBBB@B@@@B@B@B@B@BA@AB@LA@CF@@@B@@A@ECA@U\\BDDRNfFPDJHHHDJBHCTIHCHAPCRAFBFFBF@FBLAJCLCF@DAF@HBLFJTV^jJHLJHJFBFBFDH@DCDA@@D@DBDBBDBFHCBCDAHAJ@LALAHCB@H@LB^HHDDFFFHBJ@HADC@@JKHGLAPCLANBJFNDFBF@L@HAJCFCF@F@F@H@BGAI@EBCFAFAXHD@BBH@JDJ@J@LCTGJAJ@HBD@FBDDHLDHHBN@DAFGHCNCJ@H@LCLAVM@ADCFCDEFCFAFCECGGECICGDKBOCGCECG@O@OE@@GEGSAMAECGAAGGIEGEAABG@ADCBABC@CACEAAC@ABAH@D@@AB@BAAA@C@C@ABCBCBCDCBCBC@AA@GCECEAK@E@EKEGAC@AFIDEFMDCBE@I@MAA@@@A@@BABABAB@B@B@B@@BB@B@B@@A@@BAB@@B@BB@A@@B@B@@BB@@B@@@BA@A@A@A@A@A@ABABA@ABAB@@AB@B@DAB@@ABABABAB@D@BAB@B@B@@@B@@@BA@A@A@A@A@@BA@@BBB@@@BA@@@A@A@@@ABA@AB@BA@C@A@AAA@A@A@@BA@AB@B@D@B@@ABA@@@ABADABABC@AB@@@@BBB@@B@B@HAFEDGFIDI@A@AAEACBA@CBA@CAE@GAE@EBCBCBABCBAAAA@A@ECACAAC@C@CACAACCCCEAEACAA@CBA@CBA@A@CCAACAABC@ABADABAD@DCFABCBCBC@A@A@ABABAD@FAH@HAHCFCDABCB@@AAEBCBAFABABA@CBCBABADEDEBCB@@CBCBABAAC@CAC@C@C@A@EBCBABAFEFAHABAB@D@B@@CBCBG@@BG@GBGAC@AAGBA@C@C@AAE@CAA@C@C@CBCACCCCCEACCCCAE@@AC@AAC@CGEGCCACCEE@AAE@CBE@CAACCCAC@C@EAE@GEECAA@CEECCEA@@CACBA@C@A@A@AAAC@CBE@G@GAECECCAAC@EAA@E@CBADCFCFG@EBCAA@AA@@CC@AAA@AAC@CCECECAAAGAE@ACBA@CBABE@CACAAAAA@C@ADCBCDC@CBA@A@AAAACAC@@CAE@CAAACCAEAE@G@E@IBIBEDGDA@ABA@ABE@CA@C@@@C@C@CAEAE@G@GAO@ABC@C@C@CACACACAM@C@ABADADEDCFABAB@DADCBC@C@E@CCICIAEAA@C@C@CAAACAEAEBC@EAI@G@EBCBGBG@ABABEB@BADADA@CBA@C@CAAACACBADCBCFCDCBCBEACBADGFIBEBEBEDADADADBDADABAB@BCBC@E@E@KBK@CAG@CBCDCDCLOBA@A@@@C@ABAFCFEBC@A@C@ACCA@EAEEAE"],encodeOffsets:[[114618,24549]]}},{type:"Feature",id:"451103",properties:{name:"平桂区",cp:[111.479923,24.453845],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@C@A@A@C@AD@@ABABABABAB@@A@A@CDC@CAABC@A@EDCAA@ABC@@BABAB@BC@@BA@ABA@A@A@A@ABC@CBAA@BA@ABAD@@A@@@ABA@A@@@ABEBABABAAGDC@@@AB@BABA@@@CB@@A@A@@@AD@@A@@BBB@@DBBD@B@@@@@BA@@BDBDDBB@B@BADCB@@A@CAE@ABCA@@@B@@AB@BB@BBBB@@@@A@A@@BA@A@C@A@C@@@CBAB@@A@A@AAC@AB@@CBA@A@BBADADAD@BABBDBJ@F@@@B@@BHH@DB@BBBDDBABBCD@BDADAFACAA@@AF@DABB@B@BADCBAD@BCB@BDBBBA@EFA@ABBBCBDD@@DA@@B@@BBBHBB@BCA@FC@@@@DAF@BDB@@BBD@DBB@DBBDBB@B@D@DCDDD@@FBB@BA@AB@B@@A@AAABA@ACAAAAA@AD@BA@CDADCDABC@@AEAA@ABABAAA@@DA@A@E@CD@BAB@@A@@@@B@BAB@@B@AB@@@@@BAB@@@BA@CF@BB@AB@@@BA@@@@BAB@BAB@@@BABA@ABC@@B@@DD@BBBBFB@@@BBBADFDBB@DBDBDBBBBBDB@BBBDJABAFCFB@CHADBD@DBB@BBBA@@BABC@A@AAAA@AAAA@@@C@A@CB@D@B@@@DCBAFB@DDABBDBB@DAB@B@BAD@B@DAD@BABADBDBDBFAB@BBD@D@DBB@BBNDCPAJBHFB@B@FA@@LC@AFOHMHMDADAHEJDBAFADBFCFBD@@BB@@@B@@@B@@@@@@@@@@BB@@@@@@@@@B@@@BB@B@@@@@BB@@AB@B@@ABA@@@@DFHCDB@@@B@@B@@B@@@B@@CB@BHH@@@@BB@@AB@B@@B@AD@B@@@@B@@@XKHF@@B@BA@AB@D@@@@A@@@@@@@@B@@@@@@B@A@B@@BB@@@@@@B@@@B@@@@D@@@@DB@BHDBDFFB@JDJFB@D@BDABDHDFB@FBH@D@FBDAFAF@BBB@B@D@B@@BBABEHEBABADABABCBGFEACBC@@B@@@D@DCDABAFCH@B@DB@DBD@B@DJD@BBBB@DBD@D@FEFCDEDCVLV[B@FDB@@@@A@@DEB@@KBAB@@A@A@A@A@A@@@AAAAA@A@A@@BA@@@A@@@ABA@@BA@A@@@@A@@A@@C@CA@C@AA@BAAA@A@@ACAA@GAA@@BA@@@AD@F@@A@@BCDD@BBAB@DABABA@C@A@A@@@A@A@@B@CEC@@@AAEA@A@@B@DBB@BB@A@C@@B@@BD@BABABA@@AA@A@@BAJCDAB@DA@@BABAB@B@@ADA@C@CB@@CD@D@@@DBDADB@D@@@@AB@@A@A@@@@DB@B@DCB@FB@@D@B@D@@@B@BBB@B@B@@BBABAB@@A@A@@BABAB@@AB@@ADA@@@A@AAAACAA@A@A@@@AAC@A@@@C@@AA@EAABBBD@CAEAE@AAAA@@@@@@ABAD@@AD@B@@@@B@B@B@@@D@BB@DD@BB@@AFCB@B@B@DBD@BC@@BAHCDBB@B@DCAA@@BA@A@@@A@A@@BBB@B@D@BCD@@A@E@ICAACCAAE@CCCCAEE@C@CBCB@@C@@AA@@@A@A@C@CAAAAAAAA@C@A@CBA@ACA@AAAAAA@ADABABA@CA@DA@EAA@A@AB@@A@E@A@AC@@@@ABABA@A@CDCD@@E@A@C@A@@@AAAA@@@@AB@@AA@A@BABA@@BA@AB@@ABC@A@@@A@ABC@ABA@AAAAA@EAC@ABA@A@A@AA@A@@@@ACA@@ACC@ACC@AAACCAC@AAACAACAAA@AAA@CAA@@@@A@A@A@@@CACA@A@@@@A@AA@AA@ABABAB@@AF@@@BABABCBABC@CA@C@A@@ABA@AAAA@AA@AA@AABABAB@B@BAE@A@ABA@A@@@@@@DABABA@CAACAA@AAACA@@AC@AC@G@@BD@@BB@A@A@@@A@E@@AD@B@@AE@@@@@@@A@@@@@@@A@@@@@@@C@@@@BD@@B@B@@@B@BAD@B@@A@@@C@A@@@@B@@@@AB@@A@AAAA@@AB@B@@AB@@A@@@AA@B@@@@@@@@@@@@A@@@@@@D@@@B@@@@A@A@AB@A@@@@@@@@A@@@A@@BA@@A@@AA@@@A@@@@@A@@@ADCBABA@@A@AA@B@AA@@@@@@@AABADCB@@AB@@@@@@@I@@EA@@@@@A@@@E@@BA@AAD@@@G@@@EBG@A@@@@@@@@AA@@BAAAB@@@AAA@ACBBB@@@@@B@@@BB@@B@B@@@BA@A@A@@@@@@BA@A@@@A@@@@B@B@B@D@@@BABA@@@@B@@@@@@@B@BA@A@@@ABA@BBA@B@@B@@B@@B@@@@A@@@B@@@@B@@A@@@@@@AAB@@@@@BA@@@AB@@@@@@A@@@@AA@A@@@@@@@A@@ABABEB@@CBG@G@ABE@ABCBAFCB@FIB@BABADA@@B@BADBFBDDB@BBB@B@B@@AA@B@@AA@@@B@@@@@@@@@@A@@@@@@BB@@BAA@BAA@B@@A@@@@@@A@@@BA@@@@@ABAB@@@B@BA@@@@@@@@@A@@@A@AA@DADAF@FADA@B@@@@D@@@@B@@B@A@B@@AB@@@@@FCBA@@@@@@@AB@@@@@@@B@@A@@BB@@@@BB@A@BB@BAB@B@@@B@B@B@B@B@BDB@AB@@@BB@@BB@@@@@@B@@@@BB@B@@DADC@ADADABAD@B@B@BAB@B@B@@@HDBBBB@@D@BBB@@@DB@@BA@@B@@@@ACE@A@CBA@@@@@@@C@@A@@@@A@@@@@@AA@@AA@A@@@@@A@@@AA@@@@@A@@@A@@@@A@@A@A@@A@@@@@ABAA@@@A@@@@AA@@@@A@@AA@AB@@@@A@@@@@C@@BCBA@AB@BCDA@A@A@@@E@CDEHADB@@B@@BBADBBB@@D@@BBFB@B@D@D@BAB@@@DABBBA@@@A@C@@ACCC@AAAGA@A@CAAAC@@A@CEAC@A@@@@@ADADABAB@BC@@@CBEACBA@A@@BC@@IAAAA@AAA@A@@@@AA@@BA@A@@AACAAAA@@@A@AA@@B@@A@@@AB@B@BB@BBBDBDAB@@@@@@@@AB@@@@A@B@@@AB@@@A@@@A@@A@@@@A@@A@@@@@A@@@B@@A@@A@@A@@@B@@@@@@A@@A@@@AA@@A@@@@A@@A@@@@@AA@@@@@BAB@@@B@BABA@@BA@@AA@@AAAAAC@@CCA@A@@@@CB@@A@AAAA@@A@AAA@@BC@AAC@@@AB@@@@@@AB@@@A@@B@@AA@@A@@@@@@B@@@B@@C@@B@B@A@A@E@@@A@C@@DEBEBA@I@C@@AAA@A@AAAA@AB@B@BC@AB@B@B@@@DADE@@@@A@@B@@@A@@@@A@@@@AB@@BBA@AA@@@@@@A@@A@@@@@BA@@@@DABAB@@@AA@@AA@A@@@A@@@A@@@@@A@AA@@@AAA@@@@@A@@A@@@@@A@@@AA@@@@A@@AA@@A@@AAA@@@AAA@@@A@@@A@@@AB@BAB@@@@AB@@@@@@@@@B@@@B@@@@@B@B@@A@@@@@@@A@@@@B@@@B@@A@@B@@A@@@@@@@A@A@@@A@@@A@@BCB@@@@AB@@@BABA@@ACBAB@@A@CDCB@AA@@@@@@@AC@@BAA@@A@AB@@AA@AA@A@A@@@AABA@@@AA@@A@A@CA@A@A@@@@@BD@B@@@@@@A@@A@@@AAA@@@A@A@@A@@@@@A@@AA@@@@@A@@@A@@BA@@@A@@@@@@BA@A@@@@@A@@@A@@@@@C@@@@@A@@BA@@@@B@@@BA@@B@@@@@B@@AB@@@@A@AB@@@@A@@B@@A@@@A@A@BE@@@@@C@@@@@A@@AA@A@A@@@@@C@@@@A@@B@@@@A@@B@@@@@@@A@@@@@@@@A@A@@@@B@@AB@@@@@BAA@AAC@A@@@@A@@@@@@BA@@@A@@A@@AB@AA@A@@@A@@@@@A@A@AB@@@D@@@BA@@@@@@@AAB@@@@A@@@A@A@@BA@@@@@@@A@@@@BA@@B@D@@@B@B@@@BAB@@AAC@@@C@@@C@@CB@@@AC@A@@@A@@B@@C@A@AB@A@@A@@@@A@@A@@AA@A@@@@AAA@A@@@ACCA@@BA@A@@B@B@@@BA@@@AB@@ABCBAB@B@@@BABA@@@@AA@@@@AA@@@A@@@A@@@@AA@AA@@A@@@AAAC@@@@@AA@@@@AC@@AA@@@CE@@@@@A@@AA@@AA@@@@AA@@@@AA@@@A@@CBA@AAEAA@@@@@A@@@@AAAA@A@AAA@ACAAAA@@BA@A@@CCA@CCA@@A@@@@@CAABA@@@CBAAA@CBA@CAABAD@B@B@D@@@D@BC@CAABAAA@A@AE@@AA@A@@@A@ABAB@A@B@@A@@@A@@@A@A@A@C@AAA@ACA@BA@AAABAAA@A@AA@BCA@AC@AC@@AA@BABABA@@@A@@@AB@@A@C@@@A@ABA@@BAB@@@BA@@@A@A@@@A@@@A@@BA@@AAA@@@@@AAC@E@@AAA@A@AAA@@AA@@AAA@ACA@AAA@A@A@CAA@C@@@ABC@AA@@ABA@@@ABC@ABABA@ABA@A@@BA@@BAC@@@ABAAAAAAA@@A@C@@A@@CBABA@AA@@AAACC@@A@@ACEA@@A@@A@@AA@AAAA@@BAA@@A@@@ABABA@@@A@A@@@A@AB@@A@A@A@@@@@A@CB@@@@A@AA@AA@@@@@AAA@A@A@@A@A@@BCA@@A@@@AB@@ABEA@@ABAAA@A@A@@@A@@@A@A@ABA@@@@@CBADA@AB@B@DA@@AA@AA@@AAA@@@@AA@A@@AA@@@A@@A@@A@@@@@A@@@@@AA@@@@A@ABAA@@@@A@@@@@@@@AA@A@AA@@@@A@@@@AC@@@@AA@@@@@A@A@@@@A@A@@AA@@A@@@A@AA@A@@@AA@@@@@A@@@AB@@A@@@A@@AA@AB@@@BAAGBCDABA@C@A@EB@@@DAA@@A@@@A@@@@B@@A@A@@@A@@AA@@@A@@@@@AA@@@@AB@@AA@@@@AA@B@AA@@@@HG@@B@@A@@@@BE@A@A@ABA@@BA@@A@@@BC@@BABABA@@B@@@B@@A@A@@@@BA@@@@@A@@@@@A@@@@AA@@@AAA@@@AA@A@@@@@BA@@@A@@@A@@@@@@@A@A@A@A@@BA@BB@B@@AB@@@B@@A@@@@@A@A@@@CAA@@@@BA@@BC@@@ABA@@A@@@AC@@@@A@@@@A@@A@@@@A@@@@A@@@@@@A@@A@@@@A@A@@A@@@A@@@A@@A@@A@@AAA@A@@@A@AA@@@@@BC@@A@BAA@AC@AA@@@A@@@A@AA@@A@@@@@C@@A@A@A@@@A@@@A@A@A@@A@@@A@@@A@AA@AAA@@BA@@@C@A@@@@BA@@BAB@@AA@@@A@C@AAA@@@@AAA@@A@@AA@@@@A@@A@@@@@@@@@@@A@@@AA@@A@AAA@@@@@AA@@@@@AAAA@@AC@@@A@E@@@@@AAA@A@GC@@@AAAA@@@A@AAA@AA@@@A@A@@B@@A@@@@AA@@@@BA@ABA@@@AB@@ABA@A@@BA@AB@@AD@@A@@AA@AACCA@@C@AAA@@@CDA@A@C@A@A@E@@BA@A@A@CB@@A@A@@@A@@@A@@@@AAAAA@AA@@@AAA@A@@@AA@@@A@A@@A@AAA@@@A@A@AA@@@@A@A@ABA@@@@@C@@@A@@B@B@B@BAA@@A@A@@AA@@@@@C@A@@@@@AAA@@@@@@@AB@@@@A@@@A@@B@BABABCAA@@@A@AAA@@@A@@@A@@BB@@@@B@@@@@@BBA@@B@B@@@@@B@D@B@@A@@BA@@@@@A@A@@@@@A@@BA@A@A@@@@@@B@B@B@@CB@@@@@BA@@BA@A@@@@@A@A@@@@B@AA@A@@@ABA@@@@@A@@@@BA@@@AA@@A@CAAB@AC@@@A@@@A@@@A@@@ABC@@B@BA@AB@@@@A@@B@@A@A@@BA@@@@B@@AA@@A@@@AA@@A@@@@@@@A@@B@@AA@@A@@B@@AA@@AC@A@@@@A@@AA@@@@B@@A@@@A@@@A@@@@@@@@@A@A@AA@@A@@@BA@@@@A@A@@A@@@@AA@@AA@@A@A@A@BB@@B@@B@B@@@B@@BB@@@@@B@@B@BB@@@@@@AB@BA@A@@B@BABBB@@A@AB@B@@@@A@ABC@ABBB@@@BBB@DCDABA@ADC@A@CB@F@D@B@BB@@B@BBBADABA@A@ABABCFA@CDAB@DCFA@CBAB@D@DED@DCB@@@@B@BA@@@@@@B@@B@BBB@BB@@@@B@@BB@@@DB@@D@@@BBB@@@@B@@@@BB@@BB@B@@@@D@BB@@@B@B@B@@@B@B@B@@@B@@@BB@@B@@@@B@@@@@B@@B@BBB@@@B@@@B@@@@@@BB@@@@B@@@@BB@@@B@@B@@@@B@@@B@@@@B@@B@@BB@@B@@@@BB@@@BB@@@@@@BB@@B@@@@@@BBB@@@@@B@@@B@@@B@@@B@@@@@B@@@B@@BBA@@@@@BD@@A@@B@@@B@@A@@@@BA@BBBB@B@B@B@B@@@BA@@B@@@@C@AB@@@@@@AB@@A@@@@B@@@@A@@B@@@@B@BB@@@@@BA@@B@@A@@@@B@B@@@@@B@B@@B@@B@@@BB@@B@B@BB@@B@B@@@@@B@BBB@B@@BB@B@B@BAB@BDB@@@@@@@@@@BAB@@@@BB@@@@@B@@B@@BB@@A@@@@@@@@B@B@B@@@@@@B@@@@B@@B@@@@@@BBBB@@@@@@@@@A@@BB@@@@ABB@@@@@@B@@B@@@@@@@@@D@@@B@@@BB@@@BBA@@BB@@@B@BD@@@@BB@@AD@@@B@@A@@@@BA@@B@@@BBB@B@B@@@@@B@@BB@@@B@@@BB@B@@@@BB@@@B@BB@@BB@@BB@@@@@@@B@B@@B@@BB@@B@BB@@B@@AB@@BB@B@@@BBBB@@@@B@@BB@@@BB@@BBB@BAB@@@@A@@@@B@@@B@B@BB@@@@@@B@@@BB@@B@@B@@@@BAB@@@B@@@B@@@B@@@@B@BB@@@@@@@D@@BB@B@@AB@@@B@@BB@B@@B@B@@@B@BA@@@@@AB@@@@B@@B@@@B@B@D@@AB@@@B@@@B@@@@@B@@@BAB@@@BB@@DA@@BBB@@A@@B@B@@@BBB@@@D@@@BB@@@@B@B@@BDB@@@@BD@B@@B@@B@B@@@@@@AB@@@@AD@@@@@@B@@B@B@@@@AB@BA@@B@B@@@@@B@@DB@@@BB@@@@@@@@@@@@B@@BB@@@B@B@@@@@BB@B@@@@@A@@B@@B@B@AD@B@@@B@D@B@@@@ADB@@B@B@@BB@B@@@@@B@@@@@B@B@B@@@B@@@B@BBB@@@@@B@@@B@@@BDB@@@D@@@B@B@@@BB@@B@@@B@@@B@BAB@@AF@BABA@@B@B@@BF@B@B@@AB@@B@BB@@@D@@@BA@ADA@A@A@@@@@@BAB@@A@E@@@@@@BA@@B@@A@@BA@CA@@C@A@@@ABADAB@@@@@@A@@@@@@B@@@B@BAB@@@BB@@@B@@@@@ADB@@B@@A@@@@@@@A@@@A@@@A@@@A@@@@B@@AB@B@B@BA@@@CB@@A@A@A@@BAB@BA@@@@BB@@@@@B@BABB@@@@@@@@@BB@@B@@BB@@BB@@@A@BBB@@BBBB@@@@B@B@@@@BB@AB@@B@@BBBB@BBB@B@@AB@B@@BBB@@DDBB@@B@@B@BB@@@@@B@@B@@AB@@BB@@BB@@@BB@B@BA@@D@@BB@@@@BA@@@BB@@@@BD@@@@@@BB@@BB@@@BB@@@B@@@@BA@@BBB@@BB@@@@@B@@A@@B@@@@@B@B@B@@AB@@@B@B@B@@A@@B@@@@@@@B@@@@B@@@@@@@@@@B@@@@@B@@B@@@@@@@B@@@@@BB@@@@@B@@@BB@@B@@AB@@BB@@@@@B@@BB@@@@@@A@@@@@@@@B@@B@@B@B@@@@@@B@@@@@@@@BA@BB@B@@BB@@@B@B@@@@B@@@@@B@BB@@BA@@B@@B@@@@@B@@@@B@@@@B@@@B@BA@@@@B@@@@@@@B@@@B@@@@@B@@@B@@@BAAAB@@C@A@@@@@@@ABA@@BAB@B@@A@@@A@@@@B@B@@@B@@ADA@AB@@@B@@AB@@A@A@@B@B@B@@A@@BB@A@@B@@@BA@@@@@B@B@@@BB@BB@B@@@A@@BA@@@AB@@@@@@@BAB@@@A@@@B@@@@B@@@@A@@B@@@@@@@DD@@@B@@BB@@@BDBB@@F@@ABA@A@AB@@@@@BB@@@@@@BB@@B@B@@@@@B@@AB@@ABA@@B@@@@@BB@@BB@BDD@BBBBBB@@@@B@B@DABABA@AB@BAB@@@AABA@AA@@A@@@A@A@AB@@@@A@@@@@A@AB@AABADABAB@BC@@@A@A@CB@B@@B@@B@@@B@@@AA@A@@B@BB@@D@@AB@BB@BBDB@BBBABBB@@@BABA@@BC@CB@B@@A@AAA@ABA@A@@@A@AB@B@BAB@B@@BBB@@@BB@B@BABADB@@H@@@BB@@D@D@B@D@B@DDBDBBB@BBF@B@B@DBB@B@BBBBB@BBBBB@@DA@@D@@BB@B@DBDBBBBD@BFDAB@B@@@@@@B@BB@@@@B@B@@@@CB@@ABA@@@@@@B@@@@@BDB@BBB@@@@@@B@B@BA@@BBB@@@@A@@@ABC@BDB@@ABB@@B@B@@@B@@B@@B@@@@@@@B@@@@@@BB@@@@@@A@EB@@@B@B@@A@@B@@B@@BB@@B@@@@@B@@@@@B@@@@@B@@@BB@@B@@BB@@B@@@@B@ABB@@A@@B@@@@@@AB@@@B@B@@@B@B@@@@@BB@@@@@BA@@@@BBB@@BB@@ABB@AB@@@@B@@B@B@@@@B@BA@@B@BB@@BBB@@@B@B@@B@@@@@@B@@@@@B@@A@@D@@@B@DB@@@@@@BA@@@@B@@@BA@@B@@@@@B@@@B@@@@@B@B@B@@BB@@@@@B@BB@ABB@@@@B@@B@@BB@@@BBAB@@@@@B@@BB@@BBB@@B@B@@@@@B@B@@A@@BAB@B@@@B@@@B@BBBBD@@@BBBBB@B@@CDBDCDAB"],encodeOffsets:[[114107,24993]]}},{type:"Feature",id:"451122",properties:{name:"钟山县",cp:[111.303009,24.525957],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@B@BA@@DA@@@@@A@A@@A@@A@A@@@@A@A@CBAEC@AAAAAC@C@AAA@@@CB@@CA@AAAAA@AAAAA@A@CAA@A@E@AAA@AAACCCA@C@A@C@C@@@AA@@G@@@CAABABA@A@@A@@AA@AA@A@ABA@A@@B@B@@@BAB@BBB@B@BA@A@@DAD@@ABAB@@A@AAABAAA@AC@AAAA@@BC@@@AAA@@@@BBB@@A@@@A@@@@AA@A@@D@B@B@@ADA@ABCBABBBA@@B@B@@@@@B@@A@@B@B@B@@@BB@@BABBB@@A@ABA@@BABABCBA@A@@@@@AAAAAAC@ACA@@AA@AAEAAAAAECA@@@CA@@A@@@A@AAABA@A@@@A@AAA@@A@@AA@@EA@DABAD@BADCB@DBBD@ABA@A@@BADCBCDCAEB@B@@ABABA@CA@C@@ACCAEAABAD@DBDBBAD@@A@EA@@@@AA@BA@@@A@A@@@@A@@C@A@A@ABA@A@EAGBC@I@EACCAAAAAB@B@@@B@BA@@BA@@@@B@@AB@@@@AB@@@B@@@@@B@@@BGF@DABABABC@@BCDABA@AAG@@AC@A@AA@@A@E@A@ABEAC@@BE@A@C@CBE@EABA@ACEA@ABC@CD@AE@C@ABCA@@@AC@A@AAAAA@@@@C@@A@@AAA@@CCAA@AAA@@AAAA@@A@@@A@AB@A@@@@AAA@@BE@A@@@C@A@A@@AABA@A@C@C@@@@@@A@@@AB@@A@@@AAABA@@@@@@@A@A@@@ABA@A@A@@@A@A@@@@@A@@@@@A@C@A@@@@@A@@@@AA@AA@@A@@@ACCAD@DABCAE@ABG@C@EAC@C@EFC@AD@@@@@BBDBD@DAFBDAB@BAF@D@@AFFBDBAB@@BDB@AB@BC@@@ABA@CA@@G@GAG@C@C@C@@CCCA@@BA@@FA@@@@@A@@@AB@@@@ABAA@@A@@@A@@@@@AB@@@A@@A@A@@@@@A@A@@@AA@@@AA@@C@GCCCAC@AB@CE@@AA@@@ABA@A@AD@DCAAB@@C@CCAB@BABEAABICADA@C@C@@B@@@@@D@BBB@BAB@@AD@@@D@B@@@@@@@BB@@BBB@@A@@B@@@@AD@@@@A@@@@AAB@@@B@@@B@@A@@@ABA@ABA@@B@@@@AA@@A@C@@@A@A@@BAAA@@@C@A@@@CB@@AAABA@A@G@EBA@GBA@CDAB@BC@EBAAABE@@@EBAB@BA@AB@@AB@@ABADA@EBAD@BDBB@DB@BDBHED@HCHAFBBBD@BDA@@BB@B@B@B@BB@@BD@B@B@B@@BB@DB@@B@BBBAB@B@BBBBB@B@@@BBB@@ABBBBB@DDBBBF@BB@B@@@BBB@@@@BB@@DB@@@@@BB@BBBB@@@@BB@@@@BB@@@B@BB@BB@@@B@@B@BBBB@BDDBBBBBB@@@@@BA@@BCBBB@B@B@D@@@B@@AB@@@BA@CAA@@@@@BBAB@@BBAB@@@@AB@B@B@BBD@@@B@@@BB@@@@@BB@BBB@B@@D@@BB@@B@@@AD@BCBAB@BBD@B@BB@B@@BB@@@BH@@ABAD@BB@BAB@BBB@@CDADABB@F@@BADD@BB@DE@ABAD@@@BAB@D@B@DA@@@A@CBA@@D@@ADC@AB@@ABDB@DABB@@BF@B@@@@AB@@@B@B@@B@@@B@@DB@B@@AD@@BBB@@@DCBABB@@D@D@BBBD@D@@@@A@@BA@C@@@@@@BA@AB@BA@@B@BAF@@@@@@@@AB@@A@ABC@@@@B@@@@@B@@A@@@A@@@@BB@@@BB@@@BABA@CFC@@B@@C@C@@@A@@@@DC@@BA@@@AB@B@@ABCB@@A@BDA@BB@@@BAB@B@BAB@@ABBBAB@B@B@BAB@@@BB@@D@@BBAB@B@@@B@BDB@BA@@@EDCB@BABEBAAGBABEBCBA@A@C@@DAD@BDBCBAFCBCB@BBFEBADEBBD@D@D@BDBB@FDB@@BBBA@@B@@@B@@@B@@@@BBB@@@@BB@@@@BB@@@BBB@@B@@@BB@DAB@@@B@@@@BBB@BB@@@@BB@@B@@BB@@@BB@@@@@DB@BB@@@@BBB@@@B@@@@ABA@@B@@@@@BA@A@@@@@A@@@ABAAA@@BAACB@@A@A@@@A@@BA@@@@@A@@BA@A@@@@B@B@D@B@@@@BB@B@BAB@@@@ABBDAB@B@B@B@@@BHAB@DBB@B@FABBB@B@BBDB@D@BBF@HFAB@DBB@@BAFDDBB@@@B@FBD@@H@@DAB@@DD@BBBB@@BA@AFABBBBB@@BB@BBB@@BD@@BB@@B@F@DDB@B@DA@@FD@@B@B@DAHA@CB@@@B@@AB@B@B@DD@@@@DB@@D@BBB@BBB@B@B@@BBBBB@@@BF@@DB@DDB@@B@@@B@@A@@@AB@BB@@@@B@@B@@BBB@B@@B@@@B@@@@@BBB@@@B@@@BB@@B@@@@@@B@B@@B@@B@B@@B@@DBB@@@B@@@BB@@@B@@@@BAB@@B@@BB@@B@@DABAB@DAD@FBDBDAB@B@D@BJCDBFADCBAD@BAB@FBDBDDBB@F@DDDBHAB@BBBADADDDAHCADB@@BBB@BLFBHBD@BBBABB@@DBB@FDBBBFD@DDFDDB@@B@DBB@D@BDB@BBDB@@@BAD@JCDAFADB@@B@B@@@B@@@B@@@BA@@BABAB@D@@@@@AI@C@GAGGIBADEBEDE@C@EBG@CBCDABC@@BA@A@@@@@@@A@@B@@AD@@AAABABA@@A@@@@A@@B@B@DBB@@@B@BBDBBAB@B@B@@A@@@@DA@@@BBA@AB@@@BABABE@CDADE@AB@@@BA@@BA@C@@ACB@@A@A@@@@@C@@B@D@@@@@@BBBD@B@B@@@@AB@@@BADI@EBEFCDAFCF@@ABCHEDICACE@CECBEBAHARCPCPAJGL@LDBHHH@@BB@BDBD@F@DBBA@CBADCDCDCJB@CB@F@BABAD@@@BBDBB@B@@B@BDABA@ABAFBB@@A@C@CAC@ABAAEACACBCBA@ABC@C@ABC@A@ABA@CAAACBACCA@BEDA@C@@@A@CDAB@D@@@B@BB@BBBBBB@D@BA@AB@AA@AAA@CACBCDGA@DEBEBACIAA@ACAAAAACACACAA@CACEABAA@@A@AEAA@ACC@@@AD@BAB@BA@A@@BA@ABA@A@@B@@A@@BAA@@ADEB@@A@@BA@A@@@@BAA@@@BA@A@A@@B@@@BA@ADCF@B@B@@CB@BBBABAB@FB@BD@BADCBCDCB@@ABCB@BBBBBDB@BABBB@@@@ABAB@@AAA@EC@CCCDC@A@A@CAAA@CAA@CAC@AA@ACE@CB@@@@EDB@ADA@GAAA@AA@@@CB@@CCDAAABAB@FEB@AACA@ADA@ABCDABC@A@AAACBE@@BB@DBEBCBCB@ADCAAABCCAA@ACAG@AG@@@A@@@EAIACBA@ABCBCBCAAB@B@DA@@BAD@BBB@B@@@BADA@@D@B@D@B@B@@AB@B@@@@@AAAAA@@ABA@@@A@@DBBAF@DBB@@@DABC@A@AAACCCA@AB@@A@@@@@AACCA@@AA@AB@@@BC@@B@B@@@DA@@B@BA@ABA@@D@HCBBBABAFABA@@B@B@BA@@B@@@BCBAB@@ABBDAD@BAB@B@B@B@BAB@@AD@@ABABA@AD@BAB@DBFCB@D@BADBD@DCB@B@@@BABABABABA@@BCD@B@B@D@@@@@BADCACDC@@@AAAAA@A@@ACAA@A@A@@@A@@@ABA@AB@@@@A@A@@@@@A@AA@AA@@AA@@@A@@@@BAAA@@A@@AA@@@@A@@A@BAA@@A@A@@@@AA@@@A@A@A@@@@@A@@@A@@@@@AB@@A@@@A@@B@@A@@@@A@@C@A@@@CB@@@@A@@@@@A@@@@A@@@@A@A@@AA@AA@@A@AB@@A@A@@A@A@@@@A@@A@@BAA@BA@@AA@AA@@@@AB@@@@A@@A@@@@@A@A@@@A@A@@BA@@@@@@@AB@@@AA@B@A@@A@@@AA@@@AA@@A@@@A@@@@@A@@@@@A@@@@@AA@@AA@@@@AB@@@@A@A@@FAB@@@@@@@AA@@@@@@@A@@@@@@@AA@@@@A@@@A@AA@BA@@CA@AAD@B@@@B@@A@AA@@ABA@A@@@@@@@AA@ACA@A@@@@@A"],encodeOffsets:[[114081,24903]]}},{type:"Feature",id:"451121",properties:{name:"昭平县",cp:[110.811325,24.169385],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AA@@CB@AAAA@BE@@A@@@CA@@CBAAA@AAIDABA@A@C@@BA@CBA@E@@ACAAAC@CBAAAB@BABCFA@A@ABA@A@CFGBADCAA@C@C@ABAAA@AACA@@@@@@A@@B@A@@A@A@@@A@@@@A@@@A@@@@@@AA@@@@@@@@@AA@A@@A@@A@@@@@@A@@@A@@@AA@@AA@@A@@BA@@@@@@@A@@@A@@@AB@BA@@@@B@@BB@@@B@@AB@@BB@@@BB@@@@BAB@@BBA@@BB@@B@@@@A@@@@B@@@@@@@BA@@@@BAA@B@@A@@@@B@@A@@BA@@@A@A@@@@@CB@@A@@@@A@@@AAA@@A@A@A@@AA@A@@AA@A@AA@@@@AA@AA@A@@@BAA@@@@A@AA@@@A@@@A@@BA@@@A@@@@@@@A@@@AB@@@@@@AB@BA@@@@@@B@@@B@@@B@B@@AB@@@@@B@@BB@B@@@B@@ABCD@@@@@@AB@B@B@@@@@B@@AB@@A@@B@@@B@@AB@@@B@@@@@@A@@@@@@B@@A@@@A@@@@@@@AA@@A@@@@A@@@@A@A@@BA@@A@@AB@@@@A@AB@@@@@B@@@@A@@@A@@@ABAB@BA@@B@B@@@@@B@B@@@B@@A@A@@@@@AB@@@@@B@BAB@B@@@B@@A@@B@@A@@B@@AB@@@BAB@@@D@@B@@@@B@@@B@@B@@B@@A@@B@@@@@@ABB@@@@B@@@B@@@B@@BB@@A@@@@BA@@@@@@@ABBB@@@BB@@@AB@@B@@B@@BDBBA@@BA@@@@B@B@BBB@@@@BB@@@@B@@BB@B@@B@@@@BB@@@@@@B@@@@@B@@B@@@@A@@B@@B@AB@@AB@@@@BB@@AD@@@BB@AB@@@BB@@@AB@@@B@@B@@BAB@@@@@@A@@BA@B@@BA@@@A@@@A@@@@@A@@@@@@B@@A@AB@@@B@@AB@@@@@BA@@@A@@@@@A@@@AB@@@@@BA@@B@@@@@@A@ABA@A@@@ABA@@@ADA@@@BB@@@@@@ABA@@@@@A@@@@B@@A@@BBB@@@B@@BB@B@@@BBBAB@@@@@@BB@@B@B@@BB@@@BB@@B@@@B@@BB@B@B@@BB@@BB@BB@@BD@@@@@D@B@@BB@BBBD@@@@@BB@@@@@B@@AB@@@@@B@@A@@@AB@@@B@@@@AB@B@@@B@BBBB@@B@BA@@@@B@@B@@B@B@@@@A@@B@@@@BB@@@B@B@@@B@@AB@@A@@B@@@@@B@@@B@@@B@BA@@BA@@@@B@@A@A@C@@@A@ABC@A@A@@A@@@@AA@@@@A@AA@@A@A@@@A@@@A@@A@@A@ABA@@AAA@@AA@A@@A@AAAA@@A@A@@@@@A@AA@@A@@@AB@@AAA@@@A@@B@@@@@BA@@AA@@B@BA@@B@@@B@BB@@@@BC@BBA@@@AB@@@@@BAB@B@@AA@@@BAB@@@B@@@BB@@B@@@BB@@B@@BBAB@@@@@B@D@@@B@@@@BBA@A@AAA@@@AAA@@AA@A@@@@B@B@BA@AB@@A@@@@BA@ABA@A@AB@B@@BB@@@B@B@@D@@@B@@@@BD@@BB@B@BB@@B@DB@@BB@BB@@@B@BAB@BA@@B@@@@BB@@@ABBB@B@@BB@@@@@B@@@@DB@B@@B@@@@@BB@B@@B@@@@@@B@@@BB@B@@@@B@@@BB@@@BB@BB@@@@BB@@BB@@B@@BB@@@@B@BB@@@@@@B@B@@@AD@@@B@@@@@B@B@@@B@@A@@B@BA@@BAB@@@@AB@@@BA@@@@B@@@@@BB@AB@@@@@B@@@B@@A@@@A@AB@@@BA@@B@@A@@@@BA@A@@@@@@@A@@@@B@@@B@@@@@@A@AD@D@@@@@B@BA@@BB@B@@B@@@B@@@@@@A@@BA@@@A@@@@B@@@AA@@@@@A@@@@AAB@@@@A@@@AA@@A@C@@@A@@@@@A@@@A@@@A@A@@@A@@@A@@@@B@@A@@@@@A@@@@@@@ABBBA@@@A@@@A@@@@@AA@B@@A@@A@B@@A@@@AB@@@@@B@@@@AB@@A@@@@BAB@@@B@@ABA@A@@B@BA@A@@@A@A@@@AD@@@@@B@@@B@B@BA@@@A@@@A@AB@@A@@BA@@@@@A@@@@@@@A@@B@@@@@BBB@@AD@@@BA@@@@@@@AB@@ABA@@@@@@BA@@B@@AB@A@BABA@CB@@@BC@@B@@@B@@AB@@A@@@@@@@@BA@@@@B@@@B@@A@A@@@@B@@A@@@A@@@A@@@A@@B@@AB@@@B@@ABA@@@@B@@A@@B@@@BB@A@@@@@@BA@@@A@@@BB@@@B@BAB@@A@@@@BA@ABA@@@@B@@A@@@@@A@@@A@@B@@A@@@A@@@@@@@A@@@AAA@@@A@@@@@A@A@@@A@@A@@@@@@@@ACA@@@@@@@AB@@@B@@@@@@@@A@@BA@@@A@@B@@@@A@@@ABA@@@@@CA@@@@A@CA@@@@A@@@A@@@A@A@A@@@A@@@@@A@B@AA@@BA@@A@@A@@@@@A@@@@AA@@@@@@AB@@@BB@@@@B@@@@AB@B@@A@B@@@CBA@@@A@@@ABAA@@A@A@@@@@A@@BA@@@@@AB@BA@AA@@@@@AABABA@@@AAA@@AAAAAAAA@@@@@AA@@@@A@A@A@@@AB@@@@A@@BA@BBAD@F@BA@@@A@@@@@A@@@@@@B@@@B@@A@@@@@@BBBB@BB@@@BB@@D@@@@B@@B@@@@@B@@@@@B@@@B@@B@BB@@@D@@BB@@@@B@@@B@B@@@@BC@@B@@A@@@@@A@@@@@BB@B@B@@@B@@@@B@D@@@BBB@@B@@C@@B@@@B@BA@@B@@BBBB@@@@@B@@AB@B@@B@@BABDD@B@@A@@@@BB@@@B@@@@B@@BBB@BB@@@B@@@@BB@@@BAB@@@@@B@BA@@B@@@@@B@@@@@B@@BBBB@A@@B@@A@A@@@@@@BBB@@@@BB@@A@B@@B@@@@@BBB@@BB@@@B@@BB@@@B@@B@@@@B@B@@@B@BAB@@@BA@@@@@BB@@B@@B@@B@@A@@@@B@B@@A@AB@@@BA@@@@BC@@@@BA@@@@B@@@@A@BB@@A@@B@@A@BB@@C@@@A@A@A@ABC@A@C@AB@@A@A@@@A@A@@@A@@BAA@@A@@@A@@@AB@@@@@B@B@@@B@BA@AA@@A@A@A@@@A@@@AB@@A@@@@@@@@B@@BB@BA@@@C@@@@AA@@@C@A@AB@B@@A@@@@A@@A@A@@B@@A@@B@@A@@@E@@@@@AB@BA@@B@@@@ABA@A@@BA@@B@@A@@@A@@@A@@@@@A@@@AAA@@@C@@@@@A@@@@B@B@@BB@@B@BB@@@@@BBBA@@BA@@BABAB@B@BABA@@B@@CB@@A@ABABA@@@@B@@@BAB@@@@A@@@AD@@@BA@ABA@@BBB@B@@B@BBB@BB@@BB@@@B@@B@@@@@@B@@@@@@D@@@@@@@@D@@BB@@AB@@@@B@@BB@@@BBBBBB@B@@@B@B@@@B@@@@AB@@@@@@@@@B@@@B@@@B@BBB@@@@@B@@@B@BB@@@@@@B@BB@@@@BB@@@@B@@@BBA@B@@BB@D@@@@@@@@@BB@@@@BBB@@@@B@AB@@@B@@@BAB@@@B@@@@@B@@@@@@@@A@@B@@AB@BAB@@BBA@@@@@A@@B@B@B@@A@@D@@AB@BB@@@@@A@AB@@@@ABB@@@B@@@@B@@BB@B@@@B@@@BBA@@BB@@B@B@@@B@@@@@@@B@B@BA@@@B@BB@@@@@@@@B@B@B@B@@AB@@A@@D@@AB@B@B@@@@BB@@@A@@@@B@@BA@BB@AB@@B@@BB@ABB@ABBBA@BB@B@@@@B@@@BB@@@B@@@BB@@BA@@@B@B@@BB@@@B@@AB@@@@@B@@@@@BAB@B@@BB@B@@A@@@BB@@@@@@@@DA@@B@@@@@B@B@@ABB@@@@@B@@B@ABB@@@@@BB@@BB@@@@@B@@@@@B@@@B@@@@BB@@@@@B@@@@A@@@@B@@@B@@BB@@A@@B@@@BB@B@@B@@B@@@BB@@BB@@@@@@@B@@@B@@@@@@DBB@@@@@@@@B@B@@ABA@AB@@A@@@@B@@@B@@@@@@@B@@A@@B@@A@@B@@A@A@@@AB@@@@A@@@A@A@@@A@@@ABA@A@@B@B@B@@@BA@AB@@@B@@@@C@@@@B@@AAA@@A@@@@A@@AAB@A@B@@C@@@A@@B@@A@@AC@@@A@@@A@A@BB@@AB@@@BAB@@AB@@A@@B@@@@@BA@@@@@BBBBB@B@@BBB@@@BBBBB@@B@@B@@@@@@@@A@@BA@@@@AA@A@@@@@AA@AA@A@A@A@@AA@@AA@@BA@@B@@@@@B@BA@@BA@AB@@@@A@@@A@@BBBA@@BC@@@@@@B@@@@BBA@@BAB@@B@@B@@@BBB@B@@@@A@ABA@A@@@ABA@@AAAA@@BAAA@@@@@AB@@B@AB@@A@@@@@A@@@ABA@@AAB@@A@@@@@@BA@@@@@A@@@@@ABA@ABA@A@@@@@@@A@@@A@@AA@A@@BAAA@@@AB@@A@@BAB@@@@A@A@@BA@A@@BA@@@AB@@C@@@@ACAA@@@A@@AA@@@A@@@@B@@@@@@AB@@A@@@@B@@@B@AAB@@@B@@@B@BBB@@@B@B@@A@@B@@@@CB@@@@AB@@@BA@A@@@@@AD@@@DA@@B@@@@@@A@@@@@@BAD@@B@@@B@@B@@B@@@@B@@@@@BD@@@@@@@@@@B@@B@@@@B@@@@@@@BA@ABABA@@@@@AB@AA@@@A@AB@@A@A@A@AA@@A@@@@@@@@@@@A@@BA@ABA@AB@@A@@@AACA@@@@@@@@BB@@@BABBD@@@BB@@@@@ABC@@@A@@B@@@B@@@@A@AD@@@@B@@BB@@@@B@@A@@B@@@@@BB@@@@BB@@BA@@@@@BB@@@@B@@@@B@@@@@@@B@@@@@@@BAD@@@@@@AB@@@@@B@@B@@@BB@A@B@@@BB@@B@@BB@@@@BD@B@BA@@BB@BB@@@B@@BB@B@@AB@@AB@B@B@@@@B@@B@@B@@@@BB@B@@@B@B@@@@B@B@B@@B@@B@BA@@B@D@@B@@B@@AB@@BB@@B@@@BBB@BBB@BBBDB@@BB@@BB@@@@@@BA@@B@@@B@@@@A@AB@B@@@DA@@B@@A@@B@@@@A@@@@@A@C@AB@@A@@B@@@@@@A@@@@@C@A@@@A@@B@@ABA@A@A@ABA@A@AA@@A@AAAAAA@@ABA@@@A@@@AAA@@@AA@@@@@@A@@@@@@@@BA@@@A@@@@@A@@@@@@@A@AB@@A@@@A@@@@A@@CB@@A@C@A@A@@@@B@B@@@@@BA@@@@B@@BB@BB@@@ABB@@B@@@@@B@B@B@@B@AB@B@@@@@@BB@@@BBBBB@BA@@B@B@@BFADBB@@@B@@@@@@ABA@@B@@@BABABA@A@@AA@@@@BABB@AD@BB@@@A@AB@@@@@B@B@B@@A@@@A@@BA@@@HABBB@DBBADBB@B@@B@BB@BBBBBAB@CD@B@B@BB@BBBDBB@DAB@BA@BB@@BB@B@B@@@DD@BDAB@BBFBB@D@@@BABDBB@B@DA@@B@B@BCDA@B@BB@D@B@B@DA@BDA@DF@BDB@DABADAB@B@DABAB@HABADADCB@DABC@CBA@C@@@CBADGDEBA@@BC@CDCFADABAB@BA@CBABAD@@@D@FADAD@BBD@DBBA@C@AFC@AB@BA@ADABC@ADADBBAB@FAB@BB@BBAB@DA@@@AB@@@BBBABBB@B@@AD@BA@@BAB@B@BAB@@@B@@@@BB@BB@AD@@@BA@A@A@@AA@@BAB@DAB@@@BA@@@@@ABAB@BAB@BA@AF@@@BA@@@@D@BAB@BAD@B@@@@@DCB@@@B@DABB@@ABEB@BABBDDBB@B@B@DBD@B@F@@@F@B@B@H@@@@ABABA@@@C@A@@@A@@@AB@@ABB@@@@@@BCBAB@@@BABAB@B@@@@@B@BAB@B@D@DAB@@@BAB@BB@@@BB@BBB@@BB@@@BBB@@@B@@BB@B@@@BBBBB@B@@@B@@BAB@@AB@B@@@DAB@BA@@B@@AB@@@BBB@B@@@@@D@@ABAB@@BB@BAB@@AB@@CF@@ABDB@@ABBB@@@B@BB@@BAB@@@@AB@B@@@B@@B@BB@@BB@@@BB@@@B@@@@@BBBB@@B@@B@@A@@B@@BB@@@BB@@B@@@B@@BBBAB@@B@@BB@@@@@B@@@BBB@B@B@@BB@BB@@@BB@@B@BB@B@BB@BB@BBB@B@@B@@B@@B@@@@@B@@@@@B@B@@@B@@@@@@@BB@@@@B@@B@@B@@@B@B@@@@@BBA@@@B@@BB@B@@BB@@@B@@@@BB@@@@@@@B@@@@@B@@@B@@B@@@@D@@ABBBAB@@@@B@B@@@@@@BB@@BB@@B@@@@@BB@@@@@@BA@@@AB@@@@ABABA@AB@@@BB@@@@BB@@B@@@@@B@B@BE@AB@BA@@B@@@DC@@@@@@@A@@@A@C@CBABA@A@@B@@A@@B@@AA@@@A@@@A@@@@A@@@A@A@A@@@ABAB@BAD@@A@@BBBA@A@@@@@A@@C@@@AAA@@AB@@A@A@@BAB@B@A@@A@A@@A@A@AB@@A@@AAA@@@A@@A@@@@@@@BA@A@@@@B@@@B@@@@@AAAA@@@AB@A@A@@AB@B@@@@ADA@@@A@AB@@A@@A@@@A@@@BAAA@@@@BA@@@@@@AAB@@@@@@@@A@@@A@@@@@A@@@A@@@A@A@A@@@@A@@BA@@AA@@@A@@A@@B@BABAA@A@@@@A@A@@A@B@BAB@@A@A@A@A@@B@@AB@BB@AD@@@BA@@@@BA@@BAB@B@B@BC@ABCB@BAB@@@B@@@@AB@B@@@@@@A@AA@@@@AAAA@@AAA@@BA@A@A@@@A@A@A@C@A@@@A@A@@BA@AB@DAB@@AB@@AB@@@@A@@@@CA@A@A@AAC@@BAAABA@B@A@@B@@ABAAC@@ACAA@@BA@E@AB@@CBEBA@@@A@AB@@C@@B@@ABB@@BBB@@@BBB@AB@@DB@B@BB@@@FB@D@@B@@@@B@@BBB@B@@BBD@BB@@BB@BD@@A@@BB@B@@B@@B@BD@@@B@BDBBBBB@@@B@B@@@@@B@BAB@@BBABDF@B@@@@DBB@D@B@@@B@B@B@@@BBB@DBBB@@@B@@@@CBA@CBA@@B@BA@AB@@BB@@B@@BB@D@BAB@B@@BBA@@BB@@B@@@D@@BB@@AD@B@@B@B@B@@@B@@ABA@@B@@@BAB@BB@@D@@BBDD@BBB@@BBBB@BDD@@@B@BB@BB@@B@@@BA@BBB@B@B@@@B@BB@@@@@@@@@@ABA@@B@BA@AB@B@@@DA@@B@@@@A@@@AA@@AB@@A@AB@BA@@@C@@BA@@@AB@@A@@@AB@@@@A@AB@@C@@@A@@B@@A@@@A@AAA@AAA@A@@@A@A@A@@BABCAA@@BA@@@C@AB@@@B@@B@@BA@@B@@A@@B@@@BAB@@@@AB@B@BB@@DABB@@DA@AB@@@B@B@@@BB@B@BB@BBB@@B@B@@B@BBB@@B@@DD@@BBBB@@DBB@@BB@@BB@D@B@BA@@@@BBB@DBBAD@@@BD@DD@@@@D@@BABB@DBB@@@B@@B@@B@@@BB@@@@B@@@D@BBD@@@B@B@BB@BB@BB@@@B@@@BAD@B@B@BB@@@@BBBABB@@@AD@D@B@BCJDBAFBBA@ABADDD@@@BADB@CBCB@B@BA@@B@@BF@@DBAD@DBDD@H@DB@@B@@BB@@B@B@@@@@B@B@@@@B@@BA@@@@B@@@B@@@BBBA@@@@BA@@B@@@@@B@@EB@@AB@DD@DD@D@D@H@HBH@@@DBB@BA@@D@@ABAA@AC@@BACAEABE@@@CBE@ABAACBE@CACAC@A@@@@BCD@FED@D@FBD@H@BAF@DBBA@CBCDD@B@@@BB@@BBB@@@@@B@@@@@B@D@B@@@@@B@@@@@B@B@@@B@BAB@B@@@B@B@@@@@@ABBB@B@@@BA@@B@@@B@@@@D@D@B@B@BA@BB@B@D@@@B@F@@AB@BB@@@@@BBAB@@@B@@@BBBB@@BB@BBBDD@@BB@BB@@@@D@@B@BBBBB@D@@B@@DBBAD@F@@BDCD@BAB@DF@BABFBF@DAD@B@F@@AD@FBBAB@F@B@@@BBB@D@@BH@BBB@BADC@AD@BABABA@CHE@A@@@A@@@@@A@@BA@@@@BA@@@A@@B@@AB@@A@A@@@ABABBBBDDFBJ@D@HAFBB@B@BAB@B@D@@@@B@@B@B@@@B@@ABB@@@@FBB@@@BCAAAC@CBCBAFBDBBD@@@DDBB@BABA@@@AFADBDCDABC@AB@B@BAC@AA@CDABC@ABCBA@CFB@@BB@@@BB@BBB@@@B@B@BABBB@@@B@@@DB@@B@FDBBBBFBBBB@A@@A@@@@@AB@BA@@BA@@@A@@@@@A@AA@@A@@@@A@@A@@@@BAB@B@BA@@@EA@CA@A@@AA@@@A@@CC@@@@@@A@@@@B@@A@@@@@@A@@@B@@BA@A@@@@@@BA@@B@@AB@@@A@A@@AAA@@A@A@@@@@B@@A@@@AB@A@@AB@@@@A@A@AB@B@@@BA@@@A@@BAB@BC@@@A@@@A@A@@B@@@B@@@@ABA@AB@BA@@@@@@B@D@@@BABB@A@@@A@@@A@@@@@A@@@A@@@@@@@A@@B@@A@A@@@A@@A@@@@@@A@@@@@AA@@@AB@@AAA@@@@@A@@@@@@A@A@@AA@@@AAAB@@A@@@@@@A@@@@@@@@A@AA@@@@A@@@@@@B@@@@@@@AA@@@A@@@@AA@@BA@@@AA@@A@@@A@@@@AA@@@@A@@@@@@@A@@@@A@@@@@A@@@@@@@@A@@@@@@A@@@@@@@AB@@@@A@A@A@@BA@@@A@A@A@@@@@AB@@@@A@@@@AA@@AA@AB@@A@@A@@@A@@A@@AA@@AA@@@@@@AC@@@@AA@@B@@A@@A@@AC@@@ABA@A@@A@@AA@@AA@@BA@@@AA@@@@@A@@A@AA@@@AACC@@AA@AA@A@@BA@A@AAA@AA@AA@@@BAA@@A@@A@A@@@@@AAAA@@AA@A@B@@AA@@AA@@@AA@@A@@@@@@@@AAABA@@@@@A@@A@@B@@ABA@AB@B@B@@@DA@@B@@A@A@ABA@@@A@@B@@@B@@@B@@@B@@@@@@@B@@@@AA@BC@@@@A@@@A@@A@@BA@A@A@@@A@@@@B@@@@@@@BABCBA@@B@D@@@DBB@@AB@@@@AB@@A@@@@F@B@@@BA@A@@@@B@B@B@BCB@@A@@@C@@AAA@@@BA@@@A@AAE@@@A@AB@BA@ABE@@BA@A@A@@@A@@@AA@@A@@@A@A@@@C@@CA@A@@@A@@@A@@@@AA@A@A@@@A@@@A@A@A@@@@@A@@@@@AAA@@@A@AA@BC@@@@@A@C@A@@@ABCA@A@@@@AB@@@@@A@A@@A@@@@@A@A@@AA@@@A@@@@@@@@@@A@@A@@CA@@@A@@@@@A@AB@@ABA@@@@@A@AA@@@@@@@BC@@@@BA@@@@@@@A@AA@@@@AAC@@@@CA@AA@A@@@@@AA@@C@@@A@AA@@A@A@@@@BA@AA@@CB@@AA@@A@AB@@A@@@@@A@@@A@@@A@@BC@A@A@@@A@@@@A@@A@@B@@@@ABA@@@A@A@@@@AAA@@@A@@BA@@@AAA@@@C@@@@@@AAA@@@@@@A@@@A@@@A@@BA@A@@A@@@@AA@@A@@@A@@@@A@@A@A@A@@@A@@B@@@@@BA@AAA@AA@@A@@AA@@@A@@A@AA@A@@@AAA@@BA@@@AA@@A@AA@@AA@@@@A@A@@@@@@AA@@AA@@AAA@@@A@@A@@A@A@@A@@@A@@AA@@@A@@@@@A@AAA@A@@@AB@@A@@B@@@@A@@BC@@AA@@@@ACA@@@A@@AB@AA@@A@@A@@@A@@@C@@@@@@@@@AA@@@@@@@AA@B@@A@@AB@@@@@@@@@A@AA@A@@@@A@@@@A@@A@@@@@@@@A@A@A@@@@@@B@@@AA@@@AA@@@@@A@@A@@A@AB@@@@@@@@@@CA@ABA@A@A@AAA@@@AAA@A@A@@@@@A@AA@@A@A@AA@@A@@@AA@@@@A@A@@@@@A@A@@B@@@@AB@@A@@@@AAA@@@@@@AB@@@@@@A@@B@@@BA@@@@@@BAD@@@@@@AB@@A@@@A@A@A@AAAAAB@@A@@B@@@@A@@@AB@@@AC@@@@B@AA@@@A@@@A@@@@@A@@@A@@@A@@@A@@@@AA@A@@@@A@@@AA@@@@@@AA@@A@@A@@A@@@AA@@@AA@@@@@@A@@@A@@A@@@@A@@AA@@@@@A@@A@@A@@@@A@@@A@@@A@AAA@@@@A@@@@@A@@A@@@AA@@A@@@A@A@A@@@A@A@A@@@A@@A@C@@A@A@@AA@@A@@A@@@@@AA@A@@@CA@@C@@AA@@@A@@A@@AAA@A@AA@@@@@@@ABA@@@@@E@A@EAC@C@ABABC@C@C@AAAAA@C@C@ABE@ABEB@BEB@@ACCAA@AAC@A@A@AACA@@@A@A@@@A@@@A@A@@AA@@@A@A@@@@@A@@AA@@@AAC@A@@@@@A@@A@@A@@AA@AAAAA@AA@AA@@AAAA@@@A@@@A@@@A@@AA@@@@@A@@@AAA@@A@@AA@@@@@@@A@AAAA@C@AAACCAC@CEA@A@@ACAC@CAA@@AC@BE@@CCA@@C@A@CA@@A@A@G@CA@AA@@AA@A@A@A@A@A@A@@@@ABABCACBA@A@EB@AC@C@CC@ACA@CA@I@A@ACAAABE@CAEEC@ECCCIA@CCAAACCAACC@CBGAK@GBEAA@ABA@AC@A@@@A@@@AAA@C@@@@CE@CAAAAA@@@@@A@@A@@AAA@A@@AA@A@A@@@AA@@@AAAA@@@CB@@@AA@@AAAA@AA@BA@@AA@ABA@@BA@AB@@A@@@C@@@@B@@AB@@@BA@@DA@@@CA@BA@ABA@AB@AA@@BA@A@@A@@A@@@@B@AAB@@@EAA@@DA@@BA@CAE@A@ABCAG@CBE@CBCBAFCDADABA@@@@@A@@@@BAA@@A@A@@@@AA@A@@@@@AA@@A@@AAA@@@@AA@@@A@AA@@AA@A@A@@@AAA@@AA@@BA@@@@AA@@@@@A@@@A@@@AAA@@A@@@AB@BA@AB@@@@A@@ABA@A@@@A@AA@AAA@@@@@@@A@A@@@@BA@@@A@@@ABA@@@@@A@@@A@@@@BB@@@@BA@@@BB@@A@C@@@@BA@A@@B@BA@A@@@AB@@A@A@A@@B@@@@A@@@A@@@@B@BB@A@@@AB@@A@A@AA@AAA@AA@@@A@@AA@@AA@AA@A@@@AAA@@B@@AB@@@@CA@@@AA@A@@@@BA@@@@BA@A@@AAA@@@@@@CB@@@@A@ABAA@@A@@A@A@@A@@@@BA@@BA@ACA@@@A@@@AA@AA@A@@@A@A@AB@AAB@@@BA@@B@@@B@@ABA@@BABA@@@@@@@ABA@A@@@A@@A@@AA@A@@@A@A@A@AB@B@@AB@@@@A@@@A@A@A@@AA@@@A@A@@@A@@@AB@@@B@@@@A@@@@A@@AB@@@AAB@@A@@A"],encodeOffsets:[[113626,24295]]}},{type:"Feature",id:"451123",properties:{name:"富川瑶族自治县",cp:[111.27745,24.814443],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@AC@EAEDCAEBABICGFCBCBGNGNEP@BKD@@EBA@A@GEIAOBCDAM@AAA@BA@EAAB@BABCB@A@AA@A@CAAA@@C@ABABE@A@@DIACDCDCDAB@DABCAE@C@CA@AAA@@GGAGKCK@IHOBODQDGBABAFFD@DDFDBCJGFAD@BE@EDCBEDAF@FCJAB@@A@@B@@A@A@C@AA@A@@@@C@A@@@@D@@@@@B@BA@BD@@@DAB@@AB@@A@@BCFCB@DAFABAB@@A@@BAB@A@@CB@@@@@BA@A@A@ABCAAAA@@@A@CAA@A@@@@B@@B@@@ABABBB@BC@@BA@@@@B@@@@@@@BAB@@ADCBAD@DAH@F@DCFAFCFABHJBH@H@DBJ@@@@C@A@ABAB@@AB@@A@@@A@DB@@BD@B@BABAB@@ADBF@BA@@BABABB@@F@@@DABBDBB@BB@@BBB@BAH@FCFAB@D@B@FCAABABADCDADCF@B@B@@@@@B@@@@B@@B@@A@@BB@ABB@A@@B@@@B@@B@@B@B@@@B@@@B@@ABBB@@@B@@@BB@@B@B@@BB@@@BB@@D@B@@BBB@@@FBAB@BB@@B@@@B@@@@@BB@@B@@@B@BB@@B@@BB@@BB@@@B@B@@@@@@@B@@@BA@@@BB@BAB@@BB@@BB@@@B@B@@@@BB@BA@BB@@BB@B@B@@A@@B@@@BB@AB@B@@@B@@@@AB@@@B@@A@AB@@@@@BAB@@@B@@AB@@@B@BAB@B@@@BAB@@@B@BAB@@@BAB@B@@BB@@BB@@@@BB@@ABKBICC@EBGDEC@@A@A@@CA@@AAAAAAAAA@@AACAC@A@@BAAA@EAA@ABA@@@EDA@ABC@EF@BAB@D@BAD@@A@@BABABA@A@AFCDA@AF@BADAB@D@BABEF@BAD@BA@AFA@@B@BBBADB@FJ@LAR@LAHAJBDBDDFB@JrBD@BG^@DAHALDDBBD@PDNDB@d@JDHBFFBD^VRHB@dPF@FD@DLRHDJFHFBF@BB@@JBHHHDDFHRLHFB@FFDBR@HF@BDB@HBDEFBDFBRLDBFCFCDCFABCBABI@E@CFKDEBCNEPEJGFCFIRAPCNAD@P@LDNLBJBJBFBFDFDBVDB@PCBAHEBG@ODIJIJCJ@PBHBJAFGCIACSaEKEEAM@IAMDKFGDCHCFCFCLCPCLAP@JADC@@BE@IBAAQACMSCAEAQEQGIGCO@E@C@OHOBAHKLKLAD@HEDEFAHAD@DAAG@G@ECICGAAIA@@GACCCGACEEAEBEFGJAHE@@DIAIGMGGEI@KFMFGHIRWFEFKFM@@BGBM@EAIAEAECG@@GKAI@GAGAECCACEGAEBA@A@ABC@CBA@CBA@ECEACCA@@CA@@CEACAE@CA@@AGECEAEEGKKACEKA@AC@CBCFEFAF@DBDBD@D@BCBEBM@E@CBAD@RBF@DBBDBHBD@@LAR@JCBAB@HCFADADA@AA@C@A@A@AAE@EBCBEAC@G@EAA@CECGBAACC@A@IEICA@EEACGC@ACA@@@@@C@@A@@@A@@@@@@@AA@@@A@B@A@@@@A@@@@@@@@@@B@@C@A@@BABA@@@GEWL@@A@@@@@@ABCA@@@@ABA@@AA@@@@GG@ADA@@@A@@@AA@@@@A@@CAGDCE@@@@AB@BA@A@@BA@@A@@@@@AAA@@A@@@@@@@@@A@@A@@@@@@@@A@@@A@@@A@"],encodeOffsets:[[114078,25216]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
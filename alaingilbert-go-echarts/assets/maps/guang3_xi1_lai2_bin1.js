@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("来宾",{type:"FeatureCollection",features:[{type:"Feature",id:"451321",properties:{name:"忻城县",cp:[108.665666,24.066234],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@A@@AA@@A@A@A@@B@@AA@@ABA@@@ADA@AB@@@@AA@@AA@@@AA@@A@AAA@@@AAA@@@@C@A@@@@@@A@C@ABA@@@@@@@AA@@@A@@@AAA@@@@A@@@AB@B@@AB@@AB@@AA@@A@A@@@@A@@@CA@@@A@@@@AAAAAB@@A@@AAA@@@@AB@@A@A@@A@@A@AA@@@A@CACBA@@@@@C@A@@EBA@@A@@@@AA@A@@@@@@A@@AAB@@AAA@@@@@BA@@@@@A@AAA@A@@C@A@@BA@@@@BA@@BC@@BAAA@AA@AAA@@CC@@@@AB@@A@A@A@@B@@ADB@@B@@@B@@@@@B@@BB@@@B@@@B@@@B@BAB@B@@AB@@A@@A@BA@@B@@A@@AA@@@A@@@A@@@@B@@@@@B@B@B@BA@@B@BBB@BB@@B@B@BBB@B@BA@@@@@@@B@@BD@B@@B@@B@@B@@@@@B@BAB@@@BDB@@BB@@B@@@A@AB@@@B@@@BBBAB@@A@CB@@@@A@@@@@@DA@AB@B@BBB@B@BABAA@@@@ABA@A@A@AA@@@@A@C@ABA@@@@BA@@BAD@@BB@@@D@@@@AB@@@DA@@@ABA@@@@AA@@@A@AA@@AB@@A@@B@BB@BBAB@B@D@@@BA@@@AB@@A@@B@@@@C@@@@@BBAB@@A@@DBB@@BB@BB@D@@@B@@BB@DAD@B@B@BB@@DD@@B@BB@@@@ADA@@@A@A@A@AB@@@@B@BB@BBB@@BB@@@@A@AB@@@B@BB@@@BB@B@@@B@BB@@B@@@@@@AB@@@@@@AB@@@AAAA@@@A@@@@@ABA@@B@@A@AD@@A@@@@BCC@@ABABAB@@@@@B@B@@ABA@A@A@A@@B@@@DBB@BAB@@B@@BB@B@B@BB@B@BAB@BC@AA@@AA@@A@A@@BABB@BD@@@B@@A@AB@@ABADAAC@@@A@A@ADAA@@@CAA@C@A@@CA@@A@@@A@AB@@AA@AA@A@@B@@@BB@@BB@BB@B@@@@B@@@@BABAB@@AAC@@@AD@@A@A@AACA@@A@ABAA@@A@A@A@@B@BAAAB@BBBBBBB@@@B@B@@BD@@BB@BB@B@@D@BFD@@@BCB@BA@ABA@A@A@BBABAAA@C@@@CA@A@@AA@AB@BA@@AAA@@ABA@A@@CAA@A@C@A@A@A@@@A@@@ABA@@B@@AB@@@@DBBB@BBDB@@B@BB@B@@B@@BB@@@BA@@@@B@B@@ABA@@B@B@@@@A@A@CBA@@@A@@@CBBBBB@@@@AB@B@@B@@@B@@B@@AB@BBB@@@@B@BB@@@@@BD@@BB@D@@@BBBB@B@@@BB@B@BBB@@@B@@@BBBB@@ABB@@@@@BA@@@A@@@@D@B@@@BBBB@@DB@@B@B@@B@@@B@@BB@@BAB@B@B@@@@@B@@@B@BA@@@@@B@@BB@@DB@@BB@@@BDB@@@@@@@B@BABCBC@ABA@@@AB@B@BABABA@@@@@@B@@@B@@BBBB@@B@DBBA@@B@BB@@@B@@ADBBB@@@BDBBB@@D@@AD@B@@ABAB@BBBB@B@B@BBB@@@BB@@@B@BBB@B@B@@@B@@B@@BED@@@B@B@B@BB@@BD@@BB@B@B@@@BAD@@BB@@@@A@@@@B@@@@@B@BB@@@@@B@@BD@D@@@@@BA@A@A@A@@@A@A@A@@B@@ABA@@@A@@@A@@@CB@@AA@BCA@@@@@@A@AB@A@@C@@@@B@BA@AAA@@AC@A@A@@@@@A@A@A@@@A@@@BD@@@B@@B@B@@@B@BBBB@@A@@@@B@B@D@@B@@@@@@B@@@@B@BD@@@@BBB@BB@@@@AB@@ABA@@@ABA@@@@@AB@B@@A@@AA@@@@@CB@BA@@BAA@B@B@@@@A@A@@@EA@@AA@@ABAA@@@@A@EAA@A@C@A@A@A@A@A@@@@@@@@@BB@B@@@@@@@B@@A@@B@@AB@@@DB@@BB@B@@@BB@AB@B@@@@BBB@@@@@B@B@B@BBF@@B@@@@BB@@BD@B@@BB@@BBB@@BD@@@D@@BB@B@@BDBB@@@DBB@@@@A@@B@B@@BBB@@B@@@@@@C@A@@@@@A@@@@B@A@@A@@BA@@B@@BBA@@@A@@@@@A@@@@@@B@@AB@@@@@BB@@@@B@@@@ABABBB@BBB@@B@@@DDB@BBB@@@BA@@BBBB@B@B@BB@@@@B@BB@BB@BB@@@@B@B@@AB@@B@BAB@B@@@DB@BB@BBBB@@AB@@@BB@B@@@BABB@@AB@BB@@@B@@BB@B@@@BAB@B@BCB@@@@@@A@A@@@AB@BAD@BA@BB@@B@@B@@ADADA@@B@@@FJBBB@@@FF@@BB@B@@@BD@@@B@B@B@D@@@B@BB@@DAB@@B@@@@@B@BAB@BAB@BA@@B@BA@@B@@A@@B@@ADED@@@@CFCFEFAHABAB@@BDB@@@@@@B@@@@B@B@@@@@@@@B@D@@@@A@@B@@@@@@@@A@@@@@BBB@@@B@@@@@A@@@BBB@B@B@@ABBB@BBB@@B@BB@B@@@BBB@D@BB@@@A@@@AB@@@@@@A@@@@@A@@@@@A@A@@B@@A@@@@@@B@@@B@B@BA@@@@@A@@@@@A@A@A@AB@@AA@B@@ABEB@DA@AAAA@AA@@@@@AB@BA@@D@B@@@BABA@@@@DAB@@A@@BA@@@C@@@@B@D@@@D@@@DABA@@B@@BDB@@@@DCB@@@DB@BBBB@@ABA@@BA@@BA@@BABBB@B@@@B@B@DB@B@@BB@@B@BAB@B@B@F@D@@@DB@@BB@@@BBB@@BB@@BBB@D@@@B@@BB@@B@@BB@@@@AB@B@@@B@@AB@@@BBB@@@B@@@BA@@B@B@@@BB@@D@@@B@@AB@BA@ABA@@@AB@@@@@DBB@BB@@B@@@BABA@@@A@ABA@@B@B@@@B@B@B@D@@@B@BFAB@@ABABAB@@@B@B@D@B@@@@C@ABA@@BAB@@ABBB@@@@@B@DAB@@@D@@@D@@@D@@@BB@@BB@B@@B@@@B@ABB@@@BBB@@BBB@BA@@B@@@@FBD@@BB@@@B@@@AB@B@B@@@BAB@@@B@DADB@AB@B@@@@AB@D@D@BBB@B@@BB@@@B@@AB@@CD@@CBA@A@@@AB@BAB@B@@@@BD@B@@@BBB@BBB@@A@@BA@A@@@@@AB@B@@@@BB@@@BB@@BA@@@@@C@@@AB@@A@@@A@CBBB@@B@@B@@BB@@BB@B@BB@@BB@@B@B@@@@DB@B@@B@@@B@@B@@B@@B@@ABAB@@@B@@AF@D@BBBBD@@@B@@A@A@@B@@@@BBBB@B@@@@B@DA@BB@B@BB@@B@B@@@B@@B@@BB@@A@@@A@@B@@@B@@@B@@BB@@ABAB@@A@A@A@@@A@@A@A@@@A@A@A@@AA@@@@A@C@@B@B@B@B@D@B@@@B@@BDDF@@A@@@@D@@@@B@@B@B@@BBBBBB@B@@@B@@@B@BBD@@B@B@F@@@@A@ABA@A@AB@@AB@@@B@@@@@@@@@BA@BB@@@BBBA@@@@B@@A@@@@@EBBBA@B@@AB@@@@@@@BBA@B@@B@@@BBB@@C@@B@@AB@B@@C@A@@AAB@@@D@@@@@DB@@@BB@BD@@@@B@B@FBBBF@B@B@B@B@@@BB@B@@B@@A@@BA@@BABADAB@DD@@@@B@@@D@@AB@DA@@BA@@@@@@DAD@@@B@@@@BBBB@@BBB@@B@@BB@A@BD@BA@A@@@AB@@@B@BA@DBDBB@@BB@BBB@@ABA@@@@B@@@B@@@BA@@B@F@@AB@@@B@BD@BBBBB@@@D@@BD@B@@@BBBB@@@BAB@@@B@@AB@@B@@BBB@@BAB@B@B@@C@AB@@@BBD@DA@@B@B@@A@AB@@AB@BA@@B@B@@A@CFB@@BBD@@@@BBB@B@@@BBB@@B@@@BBB@@@B@@A@AB@B@@@BAB@@@B@@@B@BB@@B@@@B@@B@@@@B@@@B@B@@B@@B@B@@@@@BA@@@@B@B@@@@BB@@@@@@AB@@@B@@BB@@D@B@@BBB@B@@@BBD@@B@@D@B@@@B@@BD@@@@@B@@@BB@@@@@BD@B@@B@@BBB@@@B@@@B@@@@@@B@BB@@B@@@@@@@D@@@BB@DBBA@A@@@ABA@EBABAB@@C@A@A@A@@AABEB@@@@BB@@@@@BABA@@B@@AB@@A@@BA@@B@BB@@BBBBB@B@@@B@@DD@@BB@@BBBB@@AB@@@BAB@B@@B@@@B@D@B@DB@@@@@@AB@B@@A@A@A@@@@BAB@B@BB@BD@BEBABA@I@A@@@BB@@AD@BA@KF@B@@@B@@@B@@@BAB@@@B@D@@AB@@@B@D@@F@BB@@FAB@@@@B@B@B@@BB@@B@DAB@@@@B@BBBBB@BB@BA@ABA@@BA@@BC@@B@@@B@B@@BB@BBB@@@B@@@@@BAB@BA@@BB@@D@@A@@B@B@DBB@@BB@DBB@BBBBBAB@@BBB@@ABB@B@DAB@BDB@D@@B@@BB@@BB@@@BB@BBDB@@@@@@BB@B@B@B@@@@@DBBB@@BBA@@B@B@BBB@B@@@B@@B@BA@@@BB@@B@B@BBA@@BABABB@@D@B@BAB@@ABAA@@@B@@@@@BAA@B@@@@@@A@@@@BAB@@@@@@A@@@ABA@@BA@A@@B@@@DA@@@@@CAA@@B@@@B@B@@@BBDBAB@@B@@@B@@DH@@@B@BB@@BD@BB@BDB@B@B@@@BA@@@@@A@@@AD@B@DD@@@@@BBBBA@DD@DABBBBBB@DFB@@@@@BFB@BB@@B@B@B@B@BB@@BD@DBB@@A@@@BB@DBBBBD@@@B@B@@AB@@A@CB@@@AAAA@AAA@ABA@ADAB@BABA@A@A@AB@@A@ADAHB@DB@FDDBD@BCHC@ABADCJABADBD@BBDJ@BD@@B@BBB@D@BB@BBBADABAB@B@@@D@@@BB@@BABA@@@C@A@@@A@@@A@@BBF@@@B@@AB@DC@@B@BBB@@ABAB@BAB@@@BC@A@E@@@AA@@A@A@@@@B@B@@AB@B@B@BDDBB@B@@@@AB@@@@AAA@@@AB@BA@A@A@A@C@@@A@A@ABA@@@A@@BA@@D@@@B@B@@@@@AE@A@@BA@@@A@@AA@@@A@@@AD@DA@@BA@AB@@@B@@@@A@@@@BA@@BAB@B@@@BA@@@@@AAA@A@@@AA@@@A@@@@ABA@A@C@C@A@A@@@@A@@@@@CBA@A@@ACBA@@@AA@@BA@@@A@@A@@A@@B@@A@AB@BA@@@@@ABABC@@@AAA@@BA@@@@BA@AB@D@B@DAB@@A@@@@@CAC@C@AAA@ABAA@@AB@@A@A@ABA@A@CBABC@A@@BCB@D@B@D@@ABBBBB@B@BBB@BBB@@@BABB@@BCDCB@B@B@F@@@BA@@@ABABA@@@@D@@@BAB@D@@ABAB@B@@@@A@AB@DA@@@@@@DCBA@@B@BBDDB@FBDBD@BB@A@@@@@C@ADDDBD@B@FCBAACAC@ABCBADCBABABADGD@F@BC@C@CCAAE@C@EGAAAAEDCCEBCBCBC@ABCDC@C@A@A@@@@AA@@A@A@@A@A@@BCAA@@@A@@@@B@@@@@BA@@@@@B@@B@@@@BB@@@@A@A@@@@@A@A@@AAC@B@@A@C@@@AA@AA@@A@AAA@@A@A@@@@@@A@@A@@DA@AB@B@AA@A@C@A@@D@B@@@BA@A@ABC@A@AB@B@BBB@BB@BBAB@@AB@@@@@F@D@@@@B@@@@B@D@B@B@BA@@BABA@@B@@AB@B@@@AA@@@AB@BA@@@@BA@A@@AA@@AA@@@@AA@AAA@AB@@@AA@@@@A@@@@A@@@A@@@ABA@@@@@A@@BA@@@@@A@AB@B@@A@@AA@@@A@@@A@@B@@@B@@@@@AA@@AB@AA@@@BA@@B@@@@@@@@A@@A@@@BA@@@@B@@@@@@A@@@@B@@A@@@@BA@AB@BABA@@B@@C@A@@A@AA@@@@@@BA@@BA@A@@BABABA@@@A@@@@@@@A@@@ABA@@@AB@BA@B@@BB@@@@B@B@@@B@B@@A@@D@BBBBDB@@@B@B@@@@@B@@@@B@D@@@FBBBB@BB@B@@@B@BBB@@@@DAB@D@BA@@@A@ABA@@B@B@@AD@BAB@B@BAB@@@B@@A@@B@B@@BDB@@D@@@BAD@BA@BB@@AD@@BB@BBD@@@B@DA@A@@BA@@BAB@@A@A@CD@BEBCBC@@BAJDB@BCD@@@BA@@@AB@B@BCBABB@@BABA@@@A@@A@A@@A@@BA@A@A@@@@BA@@@@@A@@@A@@AA@@@@A@@@@@@A@@B@@@AA@@@@@@@@@A@@@@A@@@BABB@A@@@@@@B@@@BAB@D@B@B@B@D@D@B@@BB@BB@BB@@BBA@@BABDB@B@@@@AB@@AB@@@@@@@AAAAA@GE@AB@F@B@@@BB@@@BBB@@@@B@@@BBBBBB@@D@BCBADABA@@BBB@BA@@B@D@@@@B@B@BB@@@B@B@@@@@@@@AB@@A@@@@B@B@BA@C@AB@@@DAB@@@@A@A@@AABC@@CCAACCAA@@AA@@@@AAAA@@@AAAA@CC@A@@A@@@CB@@@@A@A@@AAA@@AAC@C@@AA@@@A@@@@@A@@@A@@BA@A@@@A@AAA@AA@AEAA@A@A@AAA@@A@@@AAAAAA@@A@@@A@AA@CAA@@@@A@@CCAA@AAA@A@@@A@ABA@AA@@@A@A@@@@A@A@@BADABA@@D@@A@@@ABC@C@ACAAAAACCA@A@AA@@A@@@AB@@@A@@@@A@AAA@A@A@AA@@AC@@A@@@@A@BCICBGGECAA@@BCFCLBBCC@HGFCAECABAGGDE@@CCCCCADGEI@KAC@GCGHGFEDIAECCAAA@@@A@A@A@A@@DA@C@@@AAAAEACCDIACBGCCBI@@GIA@OIEKEG@@AA@AA@CAC@E@CBABA@@@A@@AGACAC@A@AAC@G@@@@@@F@BGA@AA@@@ABEB@@ABACAAAA@ACAA@AA@@DCBAACAA@@AA@@A@@@C@@@@B@@A@@B@B@@@B@B@@A@@@ABABABAB@@A@AB@BAB@@AB@B@@A@A@@@@B@@@@@B@BC@A@A@ABC@@@A@A@EAE@AAA@A@A@A@C@ABABC@@@AA@@@@@@C@@@ABA@@@@@AB@@@@AB@@@@B@@BB@AB@@CBA@AB@@AB@@A@@B@@AB@B@@@B@@@BAB@@AB@@@BB@@DBB@@@@@DBB@@@@A@A@AB@B@@C@EBA@A@C@@@AAA@@@BA@AA@@AC@A@AA@@@@B@@A@@@@@CAA@@@@@AB@@A@@@AAAAA@@B@@AA@A@@CA@@@A@@@@AA@@@@B@@@@AAC@@AA@@@A@A@ABA@@@@@A@@A@@AC@A@@@@A@A@C@A@@@A@A@AA@A@@@AA@@A@@A@@@@@DA@@@@@@@A@@@@BAB@@A@@BAAA@@@A@A@@B@BAB@B@BA@@AA@@BA@@AA@A@A@@B@B@@@@@B@@@AA@@A@@A@@@ABAB@BA@@AA@@AA@AA@@A@ABA@@CCA@@@C@A@AAA@A@A@@BA@A@C@@@AAC@AA@@A@@@@@@A@A@@@CBA@AB@@A@@A@@@@A@@B@@AA@@@A@@@@@A@@@@A@AAA@A@AAA@@@@ABAA@@A@@A@@A@@@@A@@AACA@A@AAAAA@@@A@@B@@AB@B@B@@A@@AA@@A@@@A@@AA@AA@A@@B@B@@A@@@@@AAACA@@@AAA@@@@AA@@AA@@@BA@A@AB@@A@ABA@@@AAA@@@B@@@@AA@@@@@BA@@BA@@@A@@@A@@@A@@B@BB@A@@BAAA@A@@B@@AB@@B@@A@@B@@B@@@B@@A@@BAB@@@BA@@@@B@@@@A@@AC@CAAKBKBOAE@CDKAMCA@G@Q@MBODC@ABABEBABA@ABCB@BADABAFAHAF@D@BAJAHAD@D@BA@CBEBA@C@AAEACAGEAABEBA@C@AACCABCDADACEHAA@@C@CAA@AACBA@CBC@A@C@@AC@A@@BAAA@C@A@A@@AA@@@@BA@EAGCACA@@@@A@AAEC@@@@A@@@A@@B@@A@CC@@AC@@AA@@A@AA@@A@A@AA@A@AAA@@@@AAA@@@C@@A@@@AAC@@@@A@@AA@@@AB@@AB@@@@AA@@A@A@AA@@@@ABAB@@A@C@@@A@@@A@E@@@AAA@A@A@@@AAA@@@ADA@@@@@A@@B@BB@@B@@@BA@A@@@AB@@AA@@A@@@A@@AABA@@AA@@AA@@@A@A@A@AC@@A@@B@AAC@@@A@@@@AC@@@A@@@AB@B@@@@@@@@A@@@AA@A@@@@AA@@@@A@@@@B@@A@@AAA@C@CA@@AC@@A@A@A@A@@BA@@@A@A@@@@AA@@@A@@@AA@@@AA@AAAA@BAAAB@@A@A@C@@@@@ABA@AAA@A@ABCAA@ABAB@@A@@AA@A@@@EAA@AA@A@@B@@AB@@@B@@@BA@AA@@A@@AA@AAA@@@@@A@@AAA@A@@@@@CAA@AC@A@@@@@@C@@@AB@@A@@AA@A@@@A@@@A@@@C@AAB@@@@@@@A@@@@A@@@@@@@@A@@@@@@@A@@@B@@@@@@@BA@BBA@@@@@@A@@@AA@@@@@@@AA@B@AA@@A@@@@@@@@@A@@@@@@@@@@B@@@@AB@@A@@@A@A@@@A@@@@@B@@@B@@A@@B@@@@AA@@@BA@@A@@@@@A@@A@@ABABA@@@@A@@B@@A@@B@@@@@B@BA@@@@A@@@@@A@@@A@A@@@A@@@A@@@@BA@@@A@A@@B@@@BAAA@@@@@BC@A@@BABA@A@C@@@@A@AAC@A@@@A@@@AA@@A@ABCA@@@B@BA@@@@@C@C@BA@A@@A@@AAA@@@A@@C@@@A@AA@AAAC@AA@@BA@AA@@AA@AA@@ABAAAA@@@A@A@C@@@AAA@@AA@@ACAAB@BA@CBA@A@A@@EC@AABA@A@"],encodeOffsets:[[111166,24275]]}},{type:"Feature",id:"451322",properties:{name:"象州县",cp:[109.705065,23.973793],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAA@@CC@AAA@AACAE@A@A@C@@@A@AB@@ABA@A@A@A@C@@@A@AB@BA@@BAD@@A@AB@@@DABABADADCBA@@@@@@A@GEACA@CC@ACEAAACGECCCACAA@G@A@IDIFABGFIDABCBCACBCHABAJAF@@BHBJLT@B@B@DBFBBABBD@BAB@B@BABAF@BAF@B@B@F@B@@ABCBCDA@ABE@AAA@ABCBGFCBA@C@@BBB@B@BA@@BA@AA@HABBBA@@DAB@@@B@B@B@@AB@BB@@B@B@@@B@BAD@@@B@@ADCAAB@B@BA@A@A@A@@@CDABA@@B@B@BA@ABGB@@ABAB@@A@@B@BA@@D@@A@A@C@@B@BA@CB@B@B@@@B@@@@@@A@@@@B@B@B@@@B@@@@@B@@@BBB@@BD@@@@AB@@@B@@B@@AF@@@@@@@AA@@@@@@@@BA@@@A@@BB@@@@@B@@@@@@@@@B@@@@BB@@B@@@B@BB@@BC@@@A@B@@@BB@@@B@@@@@@@@@@A@@@@B@@@@@B@@@@@@@AB@@@@@B@B@@@@@BB@@A@@B@@@@BBD@B@B@@BB@BBB@BBB@BCF@BBD@B@BAD@@@BA@@@@@@@BBB@B@BB@@B@@@B@@ABB@@B@@@@@B@@AB@B@BB@@@@@@B@@BB@@@B@B@@BB@@B@@@@@BA@@DB@@A@@@@BB@A@@B@@@@@@@B@@@@@BBB@@B@@DB@@@@@@B@@@@@BB@@B@@B@B@@@@@BB@@@A@@@@@@@A@@@AB@@B@@@@@B@@A@B@@B@@@@@@@@@@BA@@B@@@BA@@B@@@AA@ABABA@@@@B@@BB@@@@ABA@@@AA@@@@A@@B@@@@@B@@B@B@BABA@@BB@@@BBB@B@@BBBAB@@@B@B@BABBDC@ABA@@@ABAB@@@DAB@@@@@@BBBB@@@BB@@@ABAB@@@@@BD@@BA@B@BAB@@@B@@@@@B@B@D@@@@@BBAD@@@DBDF@@ABAD@B@B@@@@B@@@B@@@@@@@@@@BB@AB@@@B@@B@@@BB@B@@B@BA@@B@@BBB@@@B@@@BDD@BABA@AB@@ABAB@@C@@@@B@@AB@BA@@@@B@@BB@B@@A@@BA@@@@BAB@@C@AAA@AA@@@AA@A@@@A@@@AB@BD@@@AB@@B@@@BDB@@B@BB@@@ABAB@@@B@@@B@@@B@@@@@B@@@BB@@BD@@@BB@@@BB@@@@@@B@@@BAB@@@BB@B@@B@@BB@@@@AB@@@B@B@F@B@B@@AB@BA@@B@@B@@@B@B@@@@B@B@BB@@BDB@@@BB@@@@B@@B@@@BD@@@B@@@@@B@B@B@@BB@@B@@BAB@@BBBB@BBAB@@@@A@@B@BB@@B@@B@@B@@B@@B@B@@@D@@@BBBDB@@@B@@AB@@@B@@@BBB@D@B@D@B@@@BA@D@@@@@BA@A@@@C@A@A@@B@@L@F@DD@@B@@@BAB@B@@@B@BBBAB@@@B@@@@BB@@B@@@BB@@@B@BBB@@BDDB@@@@AB@B@BABA@@D@B@@@BADA@@B@BB@AD@@AB@B@@BBBB@@@@A@@@@B@@A@@@A@@B@@@B@@B@@@@BA@@D@@@@BB@@@@@A@@BBB@@A@@B@B@@B@B@BB@@@@@B@B@@BBBABB@@B@@BB@@AB@B@@BB@DA@B@@@@@BB@@B@@@BB@B@D@A@@@DJ@B@@@B@B@B@@@@@@B@BB@BBB@@BBDF@@@BB@@@@BBA@@@@BB@@@@@B@@@@A@@@@B@@@@B@@@@@B@AB@B@@@B@@@BA@@@BBA@BBA@BB@B@BB@B@@BB@@B@@A@A@BD@@BB@@ABB@@@@@BBB@@BD@@@@@@@@BBA@BB@A@@B@@@@B@@@@@@@@BB@@@@BB@B@B@DBA@B@@B@@@B@@@BA@@B@B@@BBB@@BBBA@@@@@C@@@@BA@@@DB@@@@@@ABA@@B@@A@@@BBBB@@A@@B@@@D@@B@B@@B@B@BABABAB@B@@BB@@@@B@@BB@@@DDAB@@BB@@@@B@@BB@B@B@B@@@B@B@BB@@@@A@@@@B@@A@@B@AA@@B@@@@BBDA@AB@@@@@B@B@@BBAB@@@BAB@B@B@@A@@B@@@@@@@@@B@@B@@@AB@@@@@@@B@@@@@@@B@@B@@B@@@@AB@@BBA@@B@@B@@@@B@@B@@@@B@BBB@B@@@B@@@@@BB@@B@BBB@@A@@BB@A@@B@@@BA@@@@DAB@@@BBB@@B@AB@B@@@@B@@@@B@@@@@BD@@B@BABABAB@BA@B@B@@B@@@@B@@@B@@@@B@BBB@B@@@@BBB@@@BB@@@@BB@@@DBB@DC@@B@B@B@@B@@B@@@B@@@BB@@B@@@B@@@DBB@@A@@B@@@BB@@D@@AB@B@B@@@B@@@BA@AB@@ABAB@@@F@@@@BD@@BB@@ABA@@@AD@@BAB@D@@@B@B@B@B@@@F@B@NAB@F@BBH@H@JB@@BDFBBBBHF\\RFDDFHLB@@@DFB@BDDAHA@@BABA@ABAB@BAB@@B@@BAD@@@@@BBB@BBD@D@B@BBB@B@B@D@BBB@@B@@BB@@@B@D@@@@BB@BAD@BB@@@@B@B@BBBB@DB@@@BB@BB@@B@B@@@D@D@B@B@BBBB@@BBB@B@@@B@@B@@@BBB@B@@@@@@A@@B@@@B@@@@@B@@@@@B@BBA@B@B@@B@@@@B@@@@BB@@@B@@ABADB@@BA@@BB@@@A@@@A@BBAB@B@@@BB@BB@@D@BAB@B@BBB@B@BAD@@A@A@@DA@A@@BABCBA@A@@B@B@@D@BB@DDDDFDB@B@B@B@BBB@BABA@@B@@@BAB@B@BBBBD@BBB@@@D@@@DBB@@@@BB@@@@@BBBB@@@B@B@@@@AB@@A@@@@@@@@@@D@@@B@BA@@@AF@B@B@@B@BBB@@@@@D@@@@@B@B@BB@@F@B@@@B@@@B@@@BAB@BAB@@@@@BD@B@@B@B@B@B@@@B@B@@@DA@@@AD@@@BBB@@BB@@AB@@@B@@A@@BA@@@@@@B@@B@B@DBB@@@@AB@@B@@BAB@B@@@@BBBBB@@B@B@B@@@BB@@BB@B@DB@@B@@@@B@@@@@@BB@@@@@B@@@@@B@@BB@@@@B@@@@@B@B@@@BAB@@@@@@@@@@B@BAB@@@@@B@BDB@@BBB@@@@@B@@AA@A@@@@AB@@A@@@@@A@A@@@@@@@@B@@@B@@@@@BB@@B@@@B@@B@@@@B@@BB@@@B@@B@@BBB@BAB@B@BC@A@AB@@AB@B@DA@@B@B@BB@@B@@A@@B@@A@@@@BA@@@A@@A@@@@@@A@@A@@@@@BA@A@@@@B@@@BAB@@@@@@A@@@@@@AA@@@A@@BA@@B@@ABA@@AAB@@A@@@@BC@@@A@@B@@@BE@ABCA@@@C@CA@@A@@@CB@ACA@@A@ADA@@@A@E@AA@B@@@@C@A@@@A@C@@@C@@BA@A@@BA@@@AA@@@@@@BA@ABA@@@C@@@A@@@@A@@@A@@AB@@A@A@@@AAA@CA@@@@@@@@BA@@@@@@@BA@@@AB@@@@@BAAAB@@@@@BC@A@A@A@@B@@@@@AA@@A@@A@A@A@@@@@A@@@@BA@AAA@@@@@@@A@@BC@A@@@@B@@@BAB@DAB@B@BAB@F@@@BB@ABC@@BA@@@ABA@A@@@A@AA@@ABAAA@@BA@C@A@@@A@A@@@AB@AABC@A@A@AB@BA@@B@@@@CBA@A@CBC@@BA@C@@AB@@@BA@@B@BAB@@AB@@@@AA@@@A@@B@@ABCBAAA@@@A@@A@@@@BA@@@@@A@@@@@@@ABBDAD@@@B@B@@A@@B@@@BABB@@BA@@@@B@@A@@@A@@@@A@@A@A@A@@@@@@A@A@@BAB@@A@@@@AAA@A@BA@A@@@AA@@AB@@AAAB@@A@ABA@A@A@@ACB@@A@@@A@@@A@@@@DAB@@E@@@A@@@@B@@A@@@@B@@@@@@@@A@@B@@@@@@B@A@@@@@@BA@@A@@@A@@@@@AAACCBA@AA@@@@@B@@@@BB@@@@@@@B@@@@@B@@@BA@@@@AA@AA@@ABA@@@@@@@A@@@@A@@@AA@AAAA@@AB@@@@A@AA@@A@@@A@@@ABAB@@A@@@@@@C@@@A@@@@@@@@@@AABA@@BA@AB@BA@@@@AA@AA@A@@@@A@AA@A@@AA@@A@@AA@C@A@A@@@@@A@@@@@@DD@@@BB@BB@@BAD@DBB@DBB@@A@AB@@@@A@@BABBDBB@B@@@@@@A@@BAB@B@@@@@D@@B@@@@B@@AD@@@BA@@@@@@@@ABA@@@@@AA@@@B@@AAA@@AA@@B@@@@A@@A@@@@A@@@A@@A@@@@B@@@@@@AB@A@B@@A@@B@B@A@@@@A@@A@@A@@@@@@BA@@@A@@@@@@B@@@@@@AB@@@BB@@@A@@@@@A@@@@@@@ABABABA@ABAB@@A@A@@B@@@@@@@@AB@@@@@B@BAB@BBB@@@BAB@@@B@B@@@@@BAB@@BB@@@@@@AB@D@@AB@BBB@BBB@@@@B@@@@@BB@F@@@B@B@B@@@@@B@@@B@@BDBD@@AB@@@B@BAB@B@DA@@B@F@BBB@@@BA@A@@@BBBB@@@B@@@D@B@BB@@@@@BB@@@AB@@@B@@BA@ABAB@@@BABB@@B@@@BB@@@@BB@@B@BA@@B@@@@@BA@A@@BB@@@A@@@@@A@A@@@@BAD@B@@@@A@A@@BA@@DCB@@@BA@A@@BAB@@@BA@@@BB@@B@@BA@@@BB@BBB@B@B@@@B@@@B@B@@BB@B@B@BBBBB@@@BB@@@@B@B@@B@@@@D@B@BA@AF@B@@A@@@@A@C@A@@ABABAB@@@B@B@@@@@BAB@@AB@@@@A@@B@@@@@B@@@@@@@@@BB@@@@B@B@B@@ABA@AB@@@@A@@B@BA@@@AB@@@B@DCBA@@@AB@@A@AAA@@AG@@AA@@@@A@@BA@@AA@@ADC@CDCBA@@@A@@@ABA@ABA@AB@@A@A@@@AB@@C@A@A@AAA@AAA@ABA@@@A@AB@@@AABA@@@A@@A@AB@@CACC@ABA@ABC@@@A@@BC@@@@DABABA@@B@B@B@DA@@A@A@@@AA@A@@BABBB@D@@A@A@@B@@@B@BAD@@AB@BAHC@@A@@C@AB@@ABAB@BAF@AA@A@ACC@@ABC@A@ABC@@@A@A@A@A@@@C@AB@@A@BA@ABABE@@@A@A@A@AAE@AC@A@@@@B@@AB@@ADA@@A@@@AAAA@@AAA@@A@A@CBA@CC@AAA@@A@AA@@@AAABA@AAAAA@A@A@@A@A@ABA@ABABC@ABCBABA@A@A@CAA@@AA@@AAAAAA@@@ACA@AA@A@@AA@@@A@@AA@@@C@A@@@A@ABA@@BA@A@A@A@AA@AA@@@@BA@EA@@AA@CBCB@BAB@BABA@@@CAAACC@@@A@A@BB@BBDE@A@AD@@@DDB@B@B@@A@AACA@A@AAA@A@@@@@AA@B@@A@@A@@@@A@@AA@@@AC@ABAACBC@A@A@A@A@@AA@@@AAAA@@A@@@ABA@AAA@AA@@A@@AAA@CCB@@@B@@@BAB@B@B@B@B@BA@AC@A@AAA@@A@@@@AAAB@@C@AA@@@A@A@@@C@@BAB@DAAA@C@CB@@A@A@AB@@ABA@A@A@@AAA@A@EBAB@BABCBA@A@AB@@A@AA@@B@@@BA@AB@@A@@DABA@A@@AA@@@C@A@@A@CDE@ABC@@BABADE@A@@AA@@AB@@A@@B@@A@@@A@@@@@A@@@A@A@@@@AA@@@@@A@@@@@ABA@C@AB@B@BA@@@A@A@@@AAA@@@A@A@@@@BA@@@A@@A@@@BA@@A@@@A@@A@@@@@@AAA@@@BA@@AA@A@@@@A@@@@@AAA@A@A@@@@B@@A@A@AB@@A@A@@@A@@B@B@BA@@@A@A@@AA@@AB@B@@@@@AAA@AB@@A@@@@A@@EC@ABA@@@AB@@@@@A@CBA@C@C@A@ABAD@@A@@@@A@AAA@AA@AA@@@@AA@AA@@AC@@@A@AA@@A@DA@@B@BABBBAB@B@DA@@B@BA@AAC@C@@AA@A@@BA@@@A@@@A@@@A@@@ABA@A@@AC@@@AA@@AAA@@CB@@@@A@@BC@A@@BA@AB@@AB@@@B@BA@@BA@ABA@ABCBCDA@A@@@@B@@B@@@@@A@@@A@@AA@AA@@ABA@@@AA@@AB@@A@AB@BA@@@A@@AAA@CBEA@A@A@@@A@AC@A@CAAAA@@A@@AACA@@ACA@@AAA@@AA@A@AB@@A@ABA@@A@@A@AAA@CB@@CBC@C@A@ABAAA@EB@@ABAB@B@B@BA@ABADA@AB@D@BAB@@AA@@AA@C@@AAA@@A@@E@@@A@@@AAC@C@A@@@@A@AAAA@@FAD@DAB@@AB@@@@A@A@@@A@A@A@A@@@A@@A@AA@AAAA@AA@A@CAAACA@@@A@@ACA@@AAA@C@AB@AABA@@@A@A@A@A@A@@BA@C@C@A@C@@CC@ABC@AAA@C@@@ABA@A@A@C@@BCCCAC@A@A@C@@@C@@@A@A@A@C@@DA@B@@DABA@A@@B@BAB@B@B@B@@A@A@A@@@@@A@@@A@@@@@A@@@@@@@A@@@A@@@A@A@A@@@AA@@@@AA@@@@A@AC@A@@@AA@@AC@@ACA@BCBCBA@A@@B@@A@A@@@A@A@A@AA@@@AACAACBAAA@@@AD@@C@ABAAABA@@@A@@AC@A@@@A@A@@@@@CAAAA@A@E@@@E@AAA@G@A@AAEAA@@AAFK@CBABEAA@A@ABA@@BA@AA@@@A@AC@AAA@@A@A@AB@BABC@AA@AAAA@@@CCA@A@A@A@@AB@DAJE@@BAB@@@C@@@CAAA@@ABA@A@C@A@@@@BA@@B@B@@A@ABA@A@AB@@AB@B@@A@@B@@AD@@AB@@C@A@CAA@A@EAA@C@CB@@@@@BC@@B@B@@@@@B@BABB@@@@B@@ABB@@B@@@D@@@B@@@B@@CB@@@A@B@@A@@D@@@B@@@@@BBB@@@@BBA@@B@@BB@@AB@@AB@@AB@B@@@@@@AB@B@@@B@B@B@@@@@@@@@B@@@@ABA@@@A@AB@@@@AB@@AB@@@BAD@@A@CBAB@@A@A@CBA@@BA@C@@B@@A@@@AB@@A@@@@@AB@@AA@@@@@BA@A@@@A@@@A@GAAAA@@@A@CBAAA@AAA@@BA@ABAB@@EA@BA@@@A@ECCAE@@BAB@@A@@@AAAAAAC@EBC@A@ABCAA@C@A@CA@@AB@@@B@@@BCD@B@BAB@@@D@@@D@B@DBDFDBB@BB@@BBBBB@B@@@B@@@B@BAB@@@BA@@BA@AB@B@@@BBB@B@BB@@B@@CBA@AB@BA@A@A@AB@@@@AB@DAB@@ADAB@@@@@B@@@B@@B@@@@B@@AD@B@@ABA@@@@BA@AAA@A@@@CD@@A@@B@B@B@BAB@DAB@BAB@B@BAD@@D@DBB@BBB@@B@@BB@BB@ABA@@B@BA@A@A@@@AAA@AAA@C@C@@@A@AB@@@BAB@BE@A@AD@@ABA@@@@B@B@B@B@B@@@BABA@ADC@@BA@@@A@A@C@@@A@@B@BA@A@@@@BA@C@@BA@@@@B@B@DBB@BBD@@@BB@@@B@B@B@B@B@@@@@D@@@B@B@@@B@B@@@BB@AD@DA@@@A@@BA@@D@@AF@D@DABBB@BAB@@@@@B@@@BAB@@@D@B@@@D@@BB@B@@@@@@@@@@B@@AB@B@BAB@@@B@@@B@@@B@@@B@@ABC@ABA@A@A@A@ABA@AB@BA@AB@@ABCA@@C@A@A@A@A@CDAD@@@B@BAB@B@B@BAB@B@@@B@@@BBD@BBB@B@B@B@BA@EDEFCBCBCBABA@C@@@A@A@EACAGAKGKGCCEAKCCAMCCACACAECECAAC@GAGAEBC@C@E@@@C@@@A@CAEACACCA@AAAACAAAAA@AAA@AACCCAACAAAECAAAAACAACGAE@A@@@AAMAECC@A@CCG@AAA@AAACACAEG"],encodeOffsets:[[112159,24408]]}},{type:"Feature",id:"451302",properties:{name:"兴宾区",cp:[109.183333,23.72892],childNum:1},geometry:{type:"Polygon",coordinates:["@@AC@AAA@@A@@@@@@@@BA@@@@@@A@@@A@@A@@B@@@@A@@@B@AA@@@B@AA@B@@@A@@@@A@@@@@@@@A@@BBB@@AA@@@@@@@A@@A@@@@@@@AA@@@A@@@@@AA@@G@CAC@AC@CB@@@@AB@@A@@@BB@@AB@@@B@@AB@@A@@A@@@@@BAAABA@A@@@@@@A@@A@@A@BA@@AA@A@C@ABA@A@A@@@@B@BA@@BABB@AD@@@@@A@@@@A@@@@@ABAA@@@@@@@A@@@BAB@@@B@@@@AB@@AB@@@BA@@@@@@@A@@@@@@BA@@B@@@B@@A@CB@@CDABCHABABA@@@A@ADAFA@@B@@@@B@BB@@BF@B@BAB@B@@@B@@@@@AA@AB@BBBA@@B@@@@B@@@@A@AB@@@@@BBB@@@@D@@@@B@A@BA@ABA@@@@@B@@@B@@B@@@@AB@@B@@@@@@B@@@@B@@@@@@B@@@A@@BB@@@@B@@@@@B@@AB@B@B@@@@@@@B@@@@B@@B@@@@@B@@@B@@@@@A@@B@@@@@B@BB@B@@@AB@@@B@@@B@BBBB@@AB@BB@@@B@@B@@@@A@@ACAA@@BA@A@@@A@@@@@A@A@@@A@@@@@@@A@@B@@@@@@A@@@A@@@@@A@@@C@A@@@A@BBA@A@@@A@@C@@AA@A@A@A@@BAB@@A@@@AB@DAA@@@AAB@@@BAA@@A@@A@@A@@@@@@A@@@@@A@A@@A@@CCA@A@@@AC@@BA@@AAA@CA@A@A@A@@A@@D@B@@@BCB@@@A@@@@A@@@@A@@@@@@CA@@@@A@@@@@A@@CA@@@@@A@AB@AA@A@@@GAAAAB@BAB@@@B@D@@@BA@@@@@@@@@AB@B@B@B@B@BAB@@A@@BBBA@A@@DB@FD@@@BB@@BA@@B@D@@@@@@ABA@@B@@A@AB@H@@A@@@@@BB@BB@@@@@BB@B@@@@@@CD@@AA@AA@@@AAA@A@A@@AA@CBC@@@A@A@A@@@A@AAA@@@AB@@@@ACA@@@A@@BABABB@@BA@@@@AA@@B@@A@@@@BB@@@@BABCB@@CBCB@@GDA@@@CB@@CBCA@BA@EBA@C@ADA@EDCAACECA@@DCDC@A@CAA@@@AAAAAA@B@@@B@@@@@@@B@@A@@AA@C@@@C@IB@BA@ACDC@AAAA@AACB@EDE@G@ACBILCDM@@CBCCEC@AD@DG@G@A@@@ABAB@BA@@B@@A@@@@@@@AB@@@BB@@BA@@@@@@@@@A@@@A@@@@B@@@@@@@B@@ABA@B@@BAB@B@D@@C@@BABAB@@BB@@@DABAD@BCDBB@B@B@@A@A@@@ABABABAA@B@@ABA@@@@C@@A@@@@AA@A@@B@@@B@@@B@BABBBB@@B@@BABBBBB@@@@@@B@@A@A@@B@B@@@@BBB@@@B@@@@B@@@@AB@B@B@@A@@A@@@@@B@BB@@B@@B@B@@@B@@B@B@@A@C@@B@BA@@@AB@@BBB@AB@B@@BB@@@@DB@@@BAB@@A@ABA@@B@@@B@B@B@@@@ABA@@B@BA@@BA@@@@@@@@@BB@@A@@@@@AB@BC@@@AB@@A@A@C@@ACA@@ABABA@C@ABE@A@@@@B@@BB@@A@@DBBAD@@ABA@@@AB@@AAGDAAA@C@EAAB@DA@ABCAC@A@@BABADBB@DADA@AB@DD@DDA@@@AB@@AB@@A@@@AB@BB@BBBB@@B@C@@B@@AB@@AB@@@@BB@BABB@AB@@@@AA@BAACA@@A@@@A@AC@@A@AB@@C@AB@@@@A@@@A@AB@@CB@@AB@@@@AB@@@@@B@@C@@@@@AA@@ABA@@B@BBB@@CB@@@@A@@@@@CB@@A@AB@@@B@DB@ABB@@@@DBB@@@@C@@B@@AB@@A@@@C@A@@@@B@@BB@@@B@@@@@@A@@B@@A@@A@@@AA@CB@@ABABA@@@AB@@AB@@AF@@@@@BBB@BBB@@@BA@@BA@@@BB@BBBBB@@@@B@@@@@@@@B@@DB@B@@BBAD@B@B@@@@A@@BA@BB@B@@BB@@A@ABABA@AB@BABAD@@@B@@@@@D@@@D@BA@@@@BAB@B@@A@C@@@A@@@@@@@@BAB@@B@AB@B@@@@AB@@@@B@@@@BA@@@B@@B@@@B@@@B@@@B@@@@@BBBAD@B@FCHBD@B@BBBABABBD@BADAB@B@@EBA@A@@@@@BB@B@@@@AB@B@B@@@BB@@BB@@@A@AB@@@@@DAB@B@B@@@@A@AAAB@@@A@@ADAB@@@@@@BBB@@@B@@@@B@@BB@B@@@BA@A@ABCBCB@@@@ABAD@BA@@@@DB@@@B@@@BA@@BBD@B@B@@B@@@B@@A@A@A@C@@@ABA@AB@@@@@B@@ABA@A@ABA@@B@@@@AB@@@B@@BB@@A@@BA@ADCB@B@@A@@B@@@BD@B@B@@B@@@@@B@@@BA@@@ABB@@@BBBBB@B@@BA@@@@BBDB@B@@@B@BBB@B@@@@@@B@B@B@@DBB@@@AB@B@B@@C@@@CB@@@BAB@@ABA@EB@BAB@BABE@A@CD@BBBBH@@A@A@@BBB@@@B@BA@@BB@@BBB@BA@@@A@@@@@@B@@@@B@BB@@@BBB@@BB@B@@A@@@AB@B@@A@@BC@A@CB@@@B@B@BBB@B@@A@ABABBDBB@BB@@DABABCFDBBB@@@BB@@@B@B@@@@B@@A@@B@@DBB@BAB@DA@@B@D@@BB@B@@BB@@BB@@B@D@@BB@@@@@D@BB@BF@BB@@BBB@@@BBB@@@@@@D@DF@@@BBBB@BD@@@DCBA@AD@BAB@@AAAAA@CBABCBCDA@@DABC@C@AAA@A@C@@BA@AB@@B@@B@@ADBD@@@@BBB@B@B@BBBD@@BBBB@@B@@@BB@@BB@@AB@@ABAAA@ABAB@BA@@@@B@B@@BB@@@@BDA@@B@BAB@@@@@BB@@@@BA@@B@@@B@@@@@@@@B@B@BB@@DD@BA@AB@BAB@D@D@@@B@@@@@@CBA@@@@DA@ADA@@BA@CBABADC@AAC@A@E@A@AAA@CB@@EA@@@BA@C@A@AD@@ADC@A@AD@BA@BD@B@B@@@D@@CB@DAB@FCAACA@A@EAGFAD@@FDBBB@@@@@DBDBBH@FAB@@@@BB@@@B@B@DBBAB@@@BBD@@@D@BAD@DABBD@BBB@D@DB@GBDFCBCBADDBBD@B@DABAFBBHFDBFBBBD@B@FADAB@@A@CBCBGBI@A@CBEBGBEBABC@ADABAB@BAFABABAD@PCNAR@H@B@NDLBDCF@PBLALAB@H@PBHANAB@DADAB@BABCBA@@AA@@@AA@AE@AECAAAAA@ICIECCCIAI@IFKJGB@JGF@NBDAJCHGFCBE@I@@GCG@MBMDIAACACAEA@@C@EAG@I@A@A@C@A@E@A@C@CBC@@BABABA@@DCDAB@DANENCBAJANG@AB@DA@ABABA@A@A@A@A@AAAGK@G@A@A@@BCBA@@JELGBCBA@C@@@@B@BBB@@@@@B@B@BBB@@@B@BDD@@BBBB@@BB@BBD@DB@BBB@B@@@BBB@@@@@B@DB@@BB@BBB@B@@@DBB@@BFD@@@BB@B@BBBB@@FDBBAFBDHD@@B@@BBBB@@DBB@@B@@@BDB@BB@@@@@@HAB@@@B@BBD@BBBB@@@@B@@BBB@B@@@BAD@B@B@@@@BBB@B@B@B@B@BA@@B@B@B@B@B@@@B@@ABA@@B@@@@@@D@BD@B@@BBBFBFC@CBAD@DDHEHB@JHDJB@CL@DFJD@@H@@@JC@@DBBBB@@@@@A@AB@BA@@BBD@BBBB@BBBB@@B@DB@@ANDB@@B@B@BBB@@@KHAFB@@DDB@JCBABC@ABA@ABCB@@A@ABA@@D@@A@AAA@AAADABA@@@EBA@@AA@@AAAAAA@A@A@EBE@ABA@CBCBCBAB@@A@@F@B@B@BB@@BBBBBBB@B@BAF@@@@C@A@ABA@@BAB@@AB@@B@@BB@@B@@A@ADCB@F@@@@DBB@@@@B@@@@A@@@ABABAB@@@B@BBB@BBD@F@@DD@@@BBB@BAB@@@@A@A@@B@@@@A@AB@@BD@@@B@B@@BD@ABAB@FB@@@BA@@BA@@@BB@@@B@B@B@BA@BF@@A@A@@BA@@BBB@D@D@@B@@@@@B@@@B@@@@@B@J@HCDAB@B@B@B@@@BD@BBB@BB@@@BBB@BB@@AB@HBHHL@BBD@@@BA@A@@@CB@@CLB@BJ@B@B@BAB@@CBCBGFCDABA@ABAD@B@B@BBBDBBBBBBDBDDB@@@B@@BFC@@@CAAAEGA@CAID@@FHFLPJB@HJ@@AJDDAHBDCJDDFBBBBB@@D@B@@CB@B@B@B@@@B@BBDDBFCJEFGHDH@HBD@LFJCHDBDDDD@@CFHHABDBBFEDGHD@ADKAEDAD@@BBFDHHDADJ@A@B@@B@@@BD@@BBB@B@B@BBB@@@@@@B@@BA@@B@@@BBB@B@DDBBBBDB@B@DAD@B@@@BC@@@ABCBAB@@@B@B@@B@B@@@B@@BAB@B@B@@@BBB@BBBDD@@@B@@B@DBB@@B@B@@@BB@BBBB@B@@@BB@BBB@B@B@FB@BBBB@BBB@@@B@B@@AB@@@B@@@@@B@@@B@@BD@D@BB@@BB@BB@B@@@@@DA@@B@@@@AA@F@BAD@@@BA@A@@@@@@@@B@@@A@@A@A@@AA@@B@B@@@@B@@B@B@@@D@B@BABABADAB@B@@@B@BDDDB@@BB@@BBA@@B@B@BB@BB@@A@ABCB@@@@@BB@@@@@BB@@@@@AB@@BBAD@@B@BAB@@ABAB@BAFAB@BC@@@A@@BA@@@@@ABAA@BA@A@ABAFAAA@AA@BAAA@@BC@@BA@@@ACCB@@C@@@A@AAA@E@@B@@@DAD@BA@@AAAA@@@A@A@A@@@ADAF@BA@@@BDAB@B@BB@DBBBBB@@BB@B@B@BAD@B@@@DAHA@@B@B@@@B@@@BABA@AB@@@BAB@@AB@B@@A@@B@@@@ABAB@BABA@A@@@@@@@AB@@@BA@BB@DB@B@B@@@BD@B@@BB@@BBBB@@BB@B@D@B@@A@ABABADBB@B@B@BDBB@@@BB@B@@@@@BC@@@@FBB@B@B@DB@D@B@B@B@@@D@@@DA@@BAB@BBB@@@D@BAD@B@@@B@@@BBDAB@@A@ABA@AB@B@BBBBB@B@BB@@BB@B@B@@B@B@@B@B@DBD@@AB@B@BB@@B@@@@@B@B@@ABB@@DA@@BB@B@@B@B@DC@A@@B@B@@AH@B@F@@A@@B@@@B@B@B@B@B@@A@@BEBAB@FAB@@@B@B@B@BAB@@@B@@CD@@@B@@@@@D@@DB@@@@@B@@@B@B@B@@AB@@@@A@@@ABA@AH@BBFBD@DAAC@ADAB@F@B@@ABA@A@C@@@@BEECCABA@A@A@@@BD@B@BC@@@CAACA@@@BA@@A@A@A@C@@AA@@AC@@@A@A@C@@@ABCAEAABADA@@@@BAF@@ABBBABABA@@@BB@B@B@BAB@@@AC@@DA@@D@D@@B@@B@@AB@@@@@@@BA@@B@@@@@@@BA@@@@@A@AD@BAB@@@@A@BB@@CB@@CAA@@@AB@BA@A@ABA@@@@@AAAA@@A@@ACDAAAAADAB@@A@@BABABBB@@CE@B@AA@@BA@@A@@A@A@@@@BA@@B@B@B@@@B@@@BA@@D@B@FC@@@A@A@@HCB@B@FACCACB@@A@@BC@ABA@A@AA@@@AA@AA@BAA@AAA@@@@AB@D@@CBAB@@BBDB@BBF@@AAA@AAAB@BA@@@A@@BAB@AA@AA@B@@@@@BA@A@A@A@A@C@AD@@AB@@@BB@@@BBA@@B@@A@@BA@@B@BAB@AA@@A@A@A@AA@AAAA@@@@A@A@@B@@@B@AA@BAA@B@A@A@@@@BA@@ACBAB@@@B@BABB@@@B@@DB@@B@@A@ABBB@@@B@B@@@B@@@B@B@@@B@@B@B@@AB@B@B@@BA@BB@B@D@@@B@B@B@B@@BD@@@@A@@BB@@B@@@@@BAB@BAD@B@@B@BB@BADA@A@@@A@@@@BA@@BAB@B@D@BA@@B@@@B@B@@@B@@AB@@DB@@ABB@@DABA@@B@@B@@@AB@@@B@@@@@B@@@@@B@@@@@B@B@DB@@D@@@B@A@B@BBB@@@B@@@B@@@@@@@@A@@@A@ADAB@@A@AD@B@B@@@@CB@@A@AB@@@BABA@@HABAB@@A@A@AB@BADC@@B@B@B@B@@A@ABADBBC@@@A@@BC@A@A@@@A@AA@@ABA@@@A@A@A@@BA@CB@AABA@GBBB@@AB@@A@AAA@AD@B@DAHEDABAB@BBF@BAB@DCDABA@@@A@E@A@ABE@ABEBA@A@ABA@AACBAAAAE@C@A@AKSAIAG@@BEBIBADGDADBDABAJCHEBAJEJCB@H@B@DBDBDDHFBDBBDF@BDDB@BDHFB@@@F@H@D@B@FAD@D@FA@@B@BAB@@@DADABAB@BAHCBABADABADEDGDIBC@@BEBC@EBIBGDEBI@AEECAA@AAABC@CBABA@A@A@@AAAEE@CEGCCAAAA@AA@@AAA@@AA@@@ACCAA@A@AACA@@A@@CAACAA@@CAAAACAA@AAA@@@A@@AA@@AC@A@ABCBCBCHCHCD@FAD@FBDBD@BBHBJBFBH@B@@BB@@EB@@AAABE@@@AA@AA@C@C@CCC@CACBC@EBC@@A@@@@CA@BA@A@A@@@A@@@@@@@A@@BAFAB@@@BABAB@B@B@BBB@@ABA@A@ABA@C@@@A@@@AA@@AAA@C@@A@AAAA@@AA@@AA@@@@BC@@A@@@A@A@@A@@@A@AA@@AA@@@C@@@@@@A@A@@AAAAC@@@@AAA@AA@@@@A@@A@AAA@@@AA@AAA@AAA@A@C@A@AAA@CAAACA@EC@@A@@@AAAAA@@A@@@A@@AGAE@@AAAA@@C@@@AA@@@A@A@@@A@AA@CC@@@A@A@A@ACC@@AB@@A@A@C@A@A@ABAAAA@AAC@A@AA@@@A@@@A@@@EAA@AAA@C@CAEA@@@@@A@@BC@@@AA@CACA@@@@@@AC@A@A@EC@AA@@CB@@@@@A@AC@AAAC@@BC@AAA@CCG@ABEA@@A@@ABA@AACEAA@@@@AA@A@@@A@A@@A@A@A@E@A@A@CA@@@@C@@@ABCDCDA@@BA@@B@B@B@DAB@BA@@AC@A@@@AA@C@@A@A@@@@A@@@@BA@@@AEA@@@@@AA@@@@A@@@C@@@@@@@@AC@@@A@C@ABCBA@@B@B@D@@@@@@AA@BC@A@@@A@@@AAA@A@@BA@@AA@A@@@CBC@@@A@@@A@C@A@@@AAA@ABAAA@@@@@@@A@AAABAAAACACA@@A@ABCBC@A@A@@@AA@@@@@A@I@A@AC@@BABC@AA@@C@A@@@A@C@ABA@A@@BA@@@C@@@A@@AA@@@A@AB@FC@@BAB@FAF@@@@@BA@@@A@A@@@A@ACCAACCA@@AA@@AAA@@@AA@CCAAA@@CA@@AACCAAC@@@@AAEEAA@AAABAAA@AAACACEACAA@A@@@A@@B@B@DD@@B@BBBBB@@@BAB@@@@@@AB@@CBABA@A@ABA@A@@@A@@BAB@@AB@@@B@@@@ABABABA@@B@B@BA@A@@B@B@@@@A@A@A@@D@@ABA@@BA@@B@B@BA@@B@B@@A@@BA@@@A@A@@@A@AA@A@@@@@@A@@@C@@AAAACAAAA@AAAA@@A@A@ABBBA@A@@BA@@B@@A@CB@@AA@A@@A@@@ABA@A@A@A@AAA@@AA@A@@@@BAB@@CA@AC@CA@@CBAAA@A@AAC@@A@AA@@AAAAA@A@@AA@A@AACACAAA@ACACA@A@@@AA@@AAA@@@A@AB@BAB@@AB@B@BA@CB@@@@BB@BCBA@ABAA@@A@@BA@ABA@AAAAA@A@@@ADAB@@@BA@CDAB@B@@A@@BBB@@AB@B@@@BEB@AA@AAABC@AAA@A@A@@ACBAA@@AAC@A@A@A@@AA@AAA@CB@@A@@BBB@@ABCBCAC@@@BD@BE@@D@@@@A@@BA@@BBB@D@@DD@B@B@@@B@@@BB@@BB@A@@DD@@BA@@@@BA@@@@@@BA@A@@B@@@@@BBBCB@@AACDA@@@A@DBA@@BC@CB@@BB@BCB@B@B@@@@A@A@@AA@AAA@@@@@@@AB@@AD@B@AAA@@C@ABAAA@@AA@@A@AAA@@BA@@AA@@A@@A@@A@@@AB@@A@@@ABA@@@AB@@@@A@@BABA@@D@@C@@@@AA@@@A@@BA@A@@@A@@AAA@A@AA@@@@@AB@@AB@@@D@@AB@@A@@A@@@@@A@CCC@A@A@@A@CA@@BE@@GEA@@@AAEEB@@@@AB@@@@BB@@@BBB@B@B@@@BAB@@@@A@@A@@@@@@@AB@@@BA@AAA@CA@@@@A@A@@@AAA@@@A@A@AA@@ABA@A@A@AEA@A@@@@AAAACBCAE@@@AB@CEA@ABA@E@EA@BC@@@CACCAAAC@AC@AA@@@E@AACBABAB@@A@@@@BA@@@@@@@A@@@@A@@AB@A@@A@A@@C@A@@@AB@BA@A@@@@CAA@@@AAA@@@A@CAA@@AA@@@AB@@A@@CCAA@@@@A@@@ABAB@@A@A@AAA@@@@BAB@@CA@@@BA@@@A@@@@@@@A@@@@B@B@@@@A@C@@@@AAA@AA@AAAAABA@A@AGE@A@ABA@A@A@A@C@@A@EA@C@E@A@AA@@A@@AA@@AA@@AA@@A@AA@@@@@A@A@C@A@A@AA@@@@@AAA@C@@@@@AB@BA@@@@BB@@BABABA@@@@CA@AAA@@@@A@@@@AD@D@B@@@B@@@@@@ABA@@@A@@CAA@@AAAAAA@@ACAA@A@@@A@@A@@@A@@AA@@AA@@@A@@@AB@B@@BB@@A@@@C@AB@BAB@BABBB@B@@@B@BA@@@@A@AA@@@@@A@@B@BC@@AAA@@CCDABA@@@C@A@@@ABA@A@@AAA@@AA@@@A@@@@@@AA@@@@@@@@@@AA@@@B@@@@@@A@@A@@B@@@@AA@@@A@@@A@@@@A@@@@@A@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@A@C@A@A@@A@C@A@EA@@A@@AB@@@DCB@BAB@FCDEB@D@@@BA@@B@@@@A@@@AAA@@A@ECA@@@CAAAC@CDCB@@A@GFEDABA@CAAA@@BA@AC@ABCDA@ADA@@BA@@@@@A@@@AA@BA@BD@@A@@BA@@@@@@@A@AB@@@@AB@@@B@@@BBB@@@@BBABAB@@@AC@@@C@CBC@A@@@@@A@@@@BAB@@AB@@@B@@A@ACAB@A@A@@@@A@@@A@@A@@AB@@@@A@@B@@AA@@@B@@A@@@AB@@A@@B@B@@BB@@AB@@@B@BB@BCB@@@@@@B@BABCF@D"],encodeOffsets:[[111763,23863]]}},{type:"Feature",id:"451323",properties:{name:"武宣县",cp:[109.663206,23.59411],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@@ABA@A@A@@B@@@B@@A@@BABAB@@@AA@@B@@@@AB@@@@@BA@@@A@@@@BAB@@A@@B@@AB@B@@A@A@A@@BABA@A@@B@@AB@@@AA@AD@@AA@@B@@@@AABAB@B@@@@@B@@@@@B@@@D@B@B@@@@CBA@@@A@@@ABA@A@@AA@AB@@ABA@CDA@A@@BBB@@@DBB@B@@BDBB@B@@E@AB@@CD@@A@@@AD@@@B@D@B@@@B@B@@EBA@@@@BA@@@@A@@C@@BA@@B@@@@B@BB@@A@A@AB@@B@@BB@@@B@@B@@@B@@@@@@AA@@AA@@@@A@@BAB@@ADA@@BB@@B@@@@@BDB@B@@@@AB@B@@@B@@@@@B@@A@@B@@AB@@@B@B@B@B@@@@AB@@BBBB@@@D@@@B@@@@B@B@@B@B@@@B@B@@AB@@@BA@A@@@ABA@A@@@AB@@AB@BC@@@@B@B@B@@A@A@@@@BABA@A@@@ABABAB@B@@A@@@A@@BA@AB@@@B@@@BAB@B@BABAB@DA@@B@@@@A@AB@@A@AAAAA@@@CCA@A@@@@B@@@BBBBDDFDBBB@BBBABBB@BBBFFBB@@@@BDDBBD@BB@@DB@BBDDB@@B@@BB@BB@@BB@DDBBDD@B@B@@@B@B@@AB@@@@E@EBA@AB@@EDA@@B@B@@BB@@@B@@@D@@AB@@@BAB@B@D@B@@@B@DB@@BADAB@@BDB@J@B@@@@@B@@B@@@B@BADAD@B@BB@BDBDBBABBB@B@B@@@@@@BBAB@BBB@B@@@B@D@B@@@B@@AD@D@@@BBB@@AB@@@BBB@B@@@B@@@BADB@@B@@@@C@A@A@@@ABAD@B@D@B@@BD@@@@@@@@@D@@@B@@B@@B@@@@FB@B@@AB@@@@@B@@B@B@@@@DBB@@B@D@@BB@@ABA@C@A@A@AB@@AB@DCDCBA@@D@@@@@DBB@B@F@B@B@B@@@@B@B@@@BBB@@@@BBDFBBB@BA@@@BB@AF@BDH@DBB@BAD@@BDBBD@@B@B@@@@DA@@BBD@@F@B@BBD@@@@@@DBDBB@@B@@AD@@@B@@@@FBDBD@B@BBB@FB@@B@@@B@@@B@@B@BBD@BBBBBBAB@B@D@B@B@@@BA@@DD@B@B@B@B@@DDB@@B@B@@@B@B@@BB@@D@@@BBBB@@BFBH@@@B@@@BB@BBBB@@B@@@FDB@BDBB@DBB@B@B@D@BBB@BBB@BBB@@B@BBB@@@@B@@B@@BBB@B@@D@BBBB@@@B@B@@@@D@@@B@@BB@@B@B@@@BB@B@@@B@@@AD@@@@BB@@BB@@BBBBB@@@@DBB@BB@@B@@@B@@@DAB@B@BAB@BA@AAA@A@A@ABAB@@A@EBAB@@@B@@@@@@@B@@@B@BABB@@D@@B@@@AD@FADBD@DDD@D@D@DBBB@@B@@AFBB@BA@@FA@@AA@G@EAIAGAAAC@CAEAC@EBC@GDGDADADAD@B@BBD@@BB@@@B@@BB@BBBBDBBDB@@BBBDDB@@@BB@BD@B@BBBDD@B@@BB@@BB@BB@@BBBBBDDFH@DFFBB@BB@B@B@BADAD@BABBB@DBFF@BAJCFAHAJ@FADAF@@ADCJCHCFABCBABABGDABA@ABCBCB@@A@ABA@@@EBC@C@EBA@C@G@E@@@@@ABCDCBABABCB@@A@@B@BC@AB@@ABA@@B@B@@@D@B@B@BAB@BA@@B@B@@@D@B@BBFBD@BBB@BDD@@BBBBFHDBDBBB@BBB@BDH@D@BDDBFBN@B@@@BBFDHBBBDBBBBFDBBDBBBDDBD@BBB@BBBBBDBBBBBB@DDDBFBDBB@@@D@@@F@D@D@FAHBHBD@BBFDFDDBDBDBNDDBLDFBDDLHLHHBDBFBB@B@@@D@B@BADADADAFEFCB@@A@A@A@AAA@AAC@A@@@A@@@ABA@A@A@ABA@A@A@@BCDCB@B@B@B@D@@@DBBA@@BAB@@ABAB@BAB@B@B@B@BAD@BA@@@A@@@A@@@A@@@A@@BA@A@ABA@@@A@@@@@@@@A@A@@AC@@@A@C@@@A@AB@@A@@@@@A@ABA@AACBC@E@@BC@@@AB@@@B@@CBC@@BAA@@A@A@@@A@A@@@C@@@@@A@A@A@A@A@@@A@@A@@AC@AAA@C@A@A@@B@@AD@B@@A@@B@B@@A@AB@@@D@B@B@@@B@@AD@BCB@BA@A@@@A@A@A@A@A@@B@BA@@BCB@F@@ABA@A@@BAB@@@D@D@B@BBB@BB@@B@B@B@@A@AB@BAA@@AAA@@@AA@AAA@CAC@@@BC@A@ABA@ABA@CBA@A@A@A@AB@@@DC@@B@B@BBB@@A@@B@BA@@@ABC@@@A@@A@@@@A@@@A@@@@BABC@@BA@CBA@@@@BAB@B@B@@ABAB@DA@@@AA@@A@AAA@A@@@ABAB@@AB@@A@@BA@A@A@@@A@@@AAAAA@AA@@AAAECAC@C@A@C@@@C@@BA@A@ADC@A@@@A@@BA@@DBB@D@B@DBBAB@D@FAD@BBBBBB@@B@@@BA@AF@DBFDB@@@B@@AFB@@BABAB@@AB@BBB@BBDAB@@@B@BBHBB@@@B@@@B@B@@A@@@@BB@@BA@@@@B@@@BA@@B@@@@AD@B@@AB@DAB@B@@@BADAB@@@BC@A@@BA@@BA@@@@BAB@@@B@BA@@@@@A@@@@@@@@@A@A@A@@@ABA@@@@@@@ABA@@BA@@BA@@AA@@@AB@AA@@@@AA@A@@@@@A@@@CB@@@@A@B@@DA@@@A@@@A@@@C@@@AA@BA@@@A@@A@BA@A@A@@@@@A@AD@@A@@@@DAD@B@FBB@B@DBB@D@@@BA@@BC@@@AB@@@@ABA@@BAB@B@BAB@@@@A@AB@@A@@B@D@B@B@BA@@BBDB@@D@@@@@B@DAB@BAB@BA@@BAB@B@B@B@B@B@@@@@B@@AAA@@BA@ABA@@BC@CBAAA@@@A@@@AA@CCAAABEAA@CAC@BA@@@A@@@AA@@@DABCAAB@AABC@ABA@@@C@@FC@@BB@@B@B@B@BB@@BAB@@@@@B@@@B@B@B@@@DA@A@@@AB@BA@@@A@ABA@@B@@@BA@@BB@@DBB@B@BBB@B@B@DBBDABBBABBB@@@DB@@B@@AB@@@@A@BHB@@@ABBBA@B@@B@@@B@@A@@@@D@B@@@B@@@@@B@BB@@@BB@@@@BB@B@@D@FAB@B@@A@@B@@@@@BA@@@AB@D@BAB@@@B@D@B@B@B@@B@@F@F@@@@B@BAB@@@BBB@@BB@@BA@@@B@D@@AD@@B@@@@B@@B@DBBBB@DBBAB@@B@@B@@@@@BAB@BA@@@@@@B@@AB@B@BA@A@A@@@A@@@AD@@AB@@A@@@A@A@@@AB@@@AA@A@A@@@A@@@ABA@@A@@A@ABA@@BAB@@@@@@A@@A@@A@@B@@@@AB@@AB@B@@ABABAB@@A@A@@BA@@@@@ABA@AAA@@@@@ABA@@BA@@A@@@BA@@@ABA@@@AA@@A@@@@B@@@@A@@A@@@@@AA@@@AA@@@@@@@@@@A@@@@@@@A@@A@@@@A@@@@BA@AAA@@AAA@@A@@@@B@@@@A@@@@@A@@@@AC@@@@AAB@AAB@@@@@@A@@@@@AA@BA@AB@AAB@@@@A@@@AAAA@@A@A@A@A@@@A@A@A@A@@@A@@@ABA@@@A@CB@@A@@DAB@@AAC@A@@BA@@@A@@@@BAAAB@@A@@@A@@@@BA@AAAB@AA@@BAA@@AA@AC@@A@@A@@AC@@@A@@@AAA@AA@@AAAAAAA@AA@@AA@@@@A@A@@@A@@@A@@@A@@@A@A@@@@BAAA@@@A@@@A@@@A@@@C@@BA@A@@@@@@@@@BB@@@@@BA@@@A@A@AA@@A@A@A@A@@@ABA@@@A@@@@AB@@@A@@@@AAA@@@AA@@AA@@A@AA@@@AAA@ACC@@@A@A@@AAA@@@@@AA@@AAA@@A@@@AA@@@ACA@@@AA@AA@@A@AA@@@AA@@A@@EBA@@A@@@@@@AA@AA@@@@A@@A@@AA@@A@@@@@@A@@@AB@@A@@BA@@@AB@@@AA@A@@@AAAA@@@@AB@@A@@AA@@@A@A@A@@AA@@A@@@AA@@@CB@@@@AB@B@@@BADA@@B@@@B@B@BA@@@@@A@@@AAA@@BAC@@@BC@@BA@@@@@A@@@AA@@@@A@@@AA@@AA@BA@@B@@A@AB@@A@@BA@AAAA@@AA@BA@C@@AAA@@@@AACB@@A@@@AB@@@AA@@@A@AA@@A@@@AA@@A@@AAAC@@@@AA@AAA@@@A@@@ABA@@BA@A@A@@AA@@@A@@CA@A@ABA@A@A@@@A@A@@BC@@BABA@A@@@@@A@@AB@@A@@@A@@@@A@@AAA@@@AAA@@A@@@@@BAA@@@AB@A@@@@@A@@@@AA@@@@A@CA@@@@@@@A@@@@@@@@A@@@@A@@@A@AA@@AA@@AA@A@@@A@@AA@AB@@@AA@@@@A@@B@@A@@@@@@AA@A@@ABAB@@@A@@@A@@@AAA@A@@@@@AB@@A@A@@@@@@@BA@A@@@@B@@AAA@@@@A@@@A@@A@@@A@@A@@B@@A@@@BA@A@AA@A@A@@C@@@A@A@A@@BA@@AA@@@AAA@@IFADCDABADAF@H@DAB@D@B@D@@@@@BAF@BADAB@BA@IFCDABGBCBA@A@G@C@CBCBG@EBCBA@CAC@A@A@CDA@A@EBA@A@A@AACAAAA@CBA@CA@@@@@@AA@A@GAC@C@CAA@A@A@@AAACCCCCECCA@@AAC@C@A@GBA@CAG@@@@H@@A@A@@AAE@A@AAA@@@@AB@BGBAB@@AB@@A@@@@B@D@@@@AB@@@@BBA@@B@@BB@@A@AB@@A@@AA@@@@AA@@A@AAA@@@A@AA@@A@@A@A@@@A@@A@@AA@@@AA@@@A@@@@@@A@@BAAC@C@@AA@@AC@AA@@@A@@BAA@@@A@@@@@A@@BAB@@A@@@@@ABA@A@@@AAAAC@@@A@@@AB@@@BB@@@@@AB@@AA@BA@@B@@A@@AA@@@A@@@C@A@@AA@@@A@@BA@@B@@@@A@EAA@@CCAC@A@@AAA@E@@AA@@@A@A@CAA@A@IB@@A@@@C@A@@@@@@A@@@@@@@@B@@@AAA@@@AB@@A@A@@@ABA@@@@@A@@@A@@A@@BA@@BA@C@@@@BADA@AAA@A@@ABAAA@@@A@AAABA@ABAA@AAB@BA@E@@B@BA@@B@@A@@B@@@@AB@@A@@B@B@B@B@@@@@B@@@B@BAB@BA@AA@@A@@B@@@BA@ADA@@DA@AB@@@AC@A@AA@@@@AB@@CD@@ABA@@@A@@@AB@BADABA@@@@@A@A@@@AAA@@@@A@@AAA@ACC@@AA@AACBAAA@@@AA@@AA@@@A@@@AAAA@@@@AAA@@@A@@@@@AAA@@@A@AA@A@A@@@@AA@@@AA@@AA@AA@@@@AAA@A@@@A@@@@@A@@@@@A@A@A@@CA@@@A@@A@@@AA@@@@@ABA@@A@@AACA@AA@@@@A@A@@@@@@A@@A@@@@@AA@@AA@@A@@C@A@@A@A@@A@@@@A@@@A@@@AA@@@@@@@AA@@@AA@@@@@A@@A@@@@AA@@@AA@@@@@@BA@AA@@A@@@A@@@@AA@A@@@A@@@A@@B@@@@@@AA@AA@@@@A@@@@@AA@A@@A@AA@@A@@A@@A@A@AA@@@@@A@@@@@AA@@@@@A@@@@@A@@@@A@@@@A@@@@A@@B@@@AA@@@@AAA@@@AA@@@A@@@BA@@A@BAB@@A@B@BB@@@B@B@@@@@BA@@B@@@BA@@@A@A@@@AAABA@AA@@A@@AA@@@AAA@@@A@AAA@@A@@@@AAA@AAA@AA@@@@BABAB@BC@@A@@A@@A@@ABA@@AA@@@AA@@@@A@AAAAA@@A@@@A@@A@A@CAA@A@@@ABA@@AA@AAAA@AA@@@@A@@@A@@@AA@@A@@AB@BAB@@C@@@@@A@@BA@@AA@A@@@CA@@CA@@@AA@@@@A@@@AB@AA@@@@@A@@AABA@@@@A@@AA@@A@@CA@@A@A@@A@@@@@A@@A@@@@@@BA@@@AA@@@AAA@@@AAAAAAA@A@@A@AA@@A@@A@A@AAA@@@@@CB@@AB@@B@@BB@@B@B@@@BAB@@@B@@"],encodeOffsets:[[112169,23868]]}},{type:"Feature",id:"451381",properties:{name:"合山市",cp:[108.886082,23.806535],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACBEAAEC@@AAAAA@A@@A@@EC@AA@CA@@A@A@AAA@@AA@@C@A@@@@AA@A@@@AAA@ACAC@AAA@@AA@AA@AC@ACA@@@A@AAA@A@@@@@A@AAA@@@@@@DABADKHIF@@ABAD@@@B@B@HHLBB@B@B@B@B@BABAB@BCBA@@BMHIBABMDMFCBA@CBCD@@ABABAB@@AD@D@D@B@F@B@D@B@B@JBH@F@DB@BFBDBDJBNCNAH@HD@@@JAFEDGHIDCBMAE@IHA@IHEL@JBJDJDDJFJDB@BBBBFD@BBFB@@B@@BB@@ABADABA@CBCBA@MBGBOAG@A@BB@DBD@@@B@@A@@@@@AB@@A@AB@@@BA@@@A@@@@AB@@@@AA@@BA@@@@BBBAB@@@BAAA@@@@B@@@B@@@B@@AB@@AB@@@@B@@B@@A@@@B@BB@@B@BAB@@@BAB@B@@A@@BB@@BB@@@@BB@B@@DBBB@B@@@@@BA@A@@@@BBBB@@BB@@@B@@@BB@@@BA@A@A@@BA@@@@B@@BBBB@B@BDBBB@@@B@@B@@@@BB@@@BBBA@@@@BB@B@BBB@B@B@@B@@@@@B@@@B@@BA@@@@B@@B@@@@BA@@BAB@D@@@B@B@@@@B@@@BBD@BB@@D@B@B@@AB@B@B@BBB@D@@@B@DD@@AB@B@BB@@BBB@@BB@@ABA@AB@B@@@BB@@@BB@@A@@@@@A@A@@@@B@BBB@@AB@@BB@@ABA@A@ABA@@@@B@B@@BBAB@@@BA@AB@@@@@B@@@@@@CB@@@@B@@@@BB@@B@@@BBBB@B@@@B@D@B@B@@@@@@BBD@@@BB@@@@@B@BAB@B@@@B@@BD@BB@@@@@A@@B@@B@@B@@@B@@DB@B@@BA@@@BBBB@B@@@BA@@B@@@@BB@D@@@@@BA@@@@@BBB@D@@BB@@BAB@@B@BB@@D@B@B@FAD@@@@ABAB@B@@@@@AA@C@@@@AA@CA@@A@@BA@@BA@A@@@A@@@ABA@@@AB@@@BA@@BAB@DA@@BAA@@AA@@@@@BA@@@@BA@@@@B@BA@@D@@@@@@@BB@@D@BABAD@B@B@B@B@BBF@FBB@B@@@D@BAB@B@D@@A@A@@@@@A@@B@B@@@@ABA@@BA@ABAB@@@BABABABA@@B@@@@A@A@@@A@AB@@@@A@@D@@@B@@@BB@@BBBDABCD@@BBB@DB@BBBBBBDBA@@FABA@@B@@BHB@A@E@@@@H@D@BBB@D@DBHB@BB@@@B@BADAF@D@DBB@@BBBJCDBB@FHBBDB@@D@AE@@@A@@CAACACAAAACAAA@A@A@ABCBAB@BADCHEDADA@@BA@A@A@AAIA@DK@@DA@@B@B@@A@@AC@AGKAG@GBA@@AAA@AA@@A@@AAA@AAC@@A@A@A@A@CBGDI@A@@@@@A@@@A@@@@@A@@@@C@CAA@AB@@AB@B@@@AEB@@A@A@A@A@@AA@@B@@AB@@A@@EAA@AB@BAC@@@A@A@@AC@@BAB@@@@@@AB@B@@@@@BA@AAA@A@@CC@@@EAC@AAA@A@A@@BABABA@@B@@@@@@A@@A@CA@@@@@EDABCB@@@@AA@@AA@@@BA@@BA@AB@BAB@D@@@@@BE@A@AAAAAAA@AA@@A@A@A@EB@@@BADADADAB@BAF@FAB@B@B@BBBB@BB@@BB@FA@@B@BABCBBB@BBB@@@@CB@BAB@@@DABAB@BAD@BADA@ICA@CA@BELG@@A@AAA@A@@@CABM@@CAA@@@AAAAA@AA@AAC@AB@@ABAB@@@@@A@AACA@@ID@@G@@@ICCEK@@DIAGC@IGAGFCCC@AB@DEDEAAA@AA@C@@A@C@@@@A@@@AB@BA@@@A@A@A@A@A@@@ABA@A@A@A@A@AA@@@@@A@ABC@A@@@AAA@AA@@@@@AAAAC@AAA@@@A@GB@@@@@@AAA@AC@@A@@@AA@CA@AA@AA@@@GC"],encodeOffsets:[[111563,24264]]}},{type:"Feature",id:"451324",properties:{name:"金秀瑶族自治县",cp:[110.189462,24.130374],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AA@AB@@@@A@A@@@A@AB@@A@AAA@ABA@@@@@A@@B@@@@@@A@@@@B@BA@AB@BABABAB@B@@@@@@@B@@@@@B@@AA@@A@@B@@@@A@@@@@@@@B@@AB@@@@@@@BB@@@@B@@B@A@A@@@@BA@B@A@@B@@@@A@@@@@@BB@@@B@@@@@@BB@@@@@@AB@@BB@BB@@@A@@BB@@@@B@BA@@@@@@@@AB@@C@@BA@@@@@@AC@@@@@A@A@AB@@@B@@@@A@A@CAAAAB@@@B@@A@@B@BA@CAA@CAC@AB@@AAA@@A@@CC@@@@@@@B@@@@@B@B@DBB@@@BB@@BB@B@@B@B@@B@B@@BBB@@@@ABA@@BAB@@ABBB@@@@@@@@@@@B@@@D@@@@B@@@BABA@@B@@@B@@@BBB@@@@@BA@@BBBBB@@B@@@B@@B@@@@@@@B@BA@@BBB@@B@@B@@A@@@A@@@@@A@@@@@@AA@@@@@A@@@@BBB@DABDBB@@@@B@@@B@@@AB@@@@@@@B@A@@@@A@@@@B@@@@@@A@@@@@@BA@@@@@@B@@@FA@CB@@@@@B@@@B@@@BA@BD@@@B@BAB@B@BA@BB@BA@@BB@@B@@@BABB@B@BB@@@@@BA@AB@@@B@B@@@@B@B@B@@@@B@@B@@@B@@@@A@@B@@AA@BA@A@@@AB@@@@A@A@@BCACBA@@@@@@B@@@@@B@@A@@B@@@@B@@BBABAD@BA@@@@B@@BB@@@@BA@@BA@A@AB@@A@@BA@@@DAB@@AD@D@BAB@D@@A@@@ABA@@B@B@BADBBA@@B@@@B@B@@@B@DAB@@BBAB@BB@@B@B@@@BAB@B@@AB@@AD@B@@@AB@@@BAB@@AD@B@BAB@D@F@FB@@B@BBD@@@F@@@B@@@B@@B@@BB@@B@@@B@@@BBB@@@DA@@BB@B@@@BADB@@BAB@DB@@BD@B@DBBB@@@@@B@B@D@B@B@@@B@B@B@@@@DAB@B@@@@@B@@B@@@BAB@@B@B@FAD@D@B@BBB@B@B@BA@@@@D@D@B@@BB@@D@@@@B@B@B@B@@@BA@AB@D@D@@@@@BADA@A@ABABAB@BAB@@AB@BBB@@@@BAB@@BB@BD@BD@@DABADA@@B@@A@@B@@BBB@B@@B@BBBB@B@B@@B@@A@@B@@@B@@@@@BBBBBBBB@B@@BDB@@@BBBDB@@BBD@BDD@BB@@B@@BB@@@@BBB@@@@@BAB@B@BDB@@@B@BB@@@B@B@@@@@BA@AB@@@@AB@@@B@@@B@@AB@B@B@@A@@@A@ABA@@@@BAB@BA@@BA@@@@B@@@B@@@B@@@BB@@B@BA@@B@BA@@BC@A@@@A@@@A@@A@@@AA@@@AA@A@@@@@A@@AA@B@@BB@@@B@@@B@B@@@@@BA@@@ABA@@@BBBB@@@@@D@@@B@BA@@B@@@BBB@@AD@BADAB@@@BB@@@@BA@@B@@@B@@ADAB@@@@@B@@@@@B@@@B@B@@@BBBBDBB@@B@@@B@@@@B@@B@B@B@@@B@@BB@B@@BBDBB@@A@@@@@@B@@BB@@@@@BA@@@@@@BB@DB@@BBD@@@@B@@@@@B@@ABBBA@BB@@@B@@@@B@@BD@@BB@DD@@AB@@@B@@@BB@@@@@D@@BBB@@@BB@@@B@BBB@@BBB@@@BBD@@@@@@@BAB@@A@@@BBABB@@@@@B@@@A@@B@B@@@@@BB@@@@@ABA@@@@B@@@B@@@B@@@@BB@@@BA@@@AB@BBBA@@B@@@B@@@@@B@@A@A@@BBB@B@@BB@@@@@@BBBB@@AB@B@D@B@@@BBB@@@B@BB@@B@@@@@B@BDD@BBBB@@@@BA@@B@@@B@@@@B@@BBB@@@@B@@B@@@@BB@B@@BB@@BB@B@@@BB@B@@@B@@BB@@@@B@@B@BB@@AB@B@@@B@@A@@BA@@BB@@@BB@BB@@@@B@@D@B@@B@@B@B@@@DB@@@@@B@@@B@BBB@BB@BBB@@@AB@BABA@AB@@@BA@@BBB@BBB@@AD@BA@@B@@@DAD@@@BAB@@@@BB@B@BB@BB@DB@@@BBBBB@B@B@F@B@@@A@@A@AB@@AB@B@@AB@@AB@@@@@B@@ABAB@BABA@A@@B@@@DBB@@BBB@B@@B@@B@@BB@@D@B@B@@@B@B@@A@@B@B@@@B@@@BA@@B@B@@ADA@@BB@@B@B@@@B@@@@BB@B@@@@E@@@AB@@CBCB@BC@@@@AAA@@@@@BAD@F@BA@@D@@BDAB@BBB@@CB@BAB@@AB@@AB@@CDA@@D@@@B@BAB@BAD@@ABA@AA@@A@AB@AADB@BBB@B@@@BBBDB@DB@B@@BB@DBBADB@C@ADABA@ADABAF@BBD@BDB@DB@BF@DB@BBB@BB@B@DB@BD@@AF@D@D@B@HBBBDBDB@CACBA@CAAA@CACA@CAABAAA@C@A@ACABAA@@ABA@C@A@A@A@CBAAABAB@DBBABCBC@AA@CA@@ACC@A@AACAA@AAAAAE@@@@@@B@B@B@B@DAB@B@@ABDDAD@BBB@D@BABCBAA@@AACA@AA@AAAACA@CECBAA@CAAAA@CCAA@ABAAA@A@@@A@A@FCBABACA@A@@@@BCAA@A@AD@@@B@BBDABAB@D@@ADA@@@C@A@A@CAA@A@AA@CADAAAA@@@@AB@AAB@AA@@AABAA@BA@@BA@@B@AABAA@BE@B@B@@B@@@B@BBD@B@@@BB@B@@B@FBFG@@B@BAB@DAB@@DBBDFADBBB@BB@BBDBBDCB@B@@D@DCDDBDCB@BAFCD@AA@ACBA@AAABC@@A@C@CBA@ABAB@@BB@B@ABABB@B@B@DBF@B@F@@AD@@@DBDB@DBBBABDFADDB@@@F@BA@BBDF@@@AA@C@A@CAEAA@@D@@CDAA@@CCAACDAA@A@@A@A@AC@@@C@ABA@A@AABA@AB@@A@A@@@A@@@AAA@AAA@AB@@CHACA@CACBAB@@@DBBAD@B@DABBBAAA@AAE@ABC@C@CAEAA@C@AB@BB@@DFFA@DBABD@@@@BEBCB@B@BADADB@AB@@AB@BAD@B@BBD@D@@AB@B@BBABB@BAB@B@BAD@B@BBBAD@@A@A@AD@DBB@BB@@@CD@BAAABA@AACBAAC@CBB@@BA@@@@@AAAB@@ABABAB@AABAAAB@@AB@B@@AB@BB@@@@B@B@FBB@HDB@DBB@DBB@D@@@BBB@B@BB@A@@@A@@@A@@@@@AB@@@@@B@@@@B@@BB@@@@@@@A@@@@@@@@BB@@@@B@@@@@BB@@BA@@@@B@@@@BB@@@BA@@@AB@@@D@B@@@BC@@@@B@@@BA@@@@@A@@@@@@@@B@@A@@BA@@B@@BBBB@DA@@A@@A@@@ABA@@@@A@@@@A@@BA@A@@@@AA@@@@@@BA@@B@@@AA@@B@@A@@@A@@BABA@@AAA@C@ABC@A@ABC@A@@@A@@@@@BA@A@A@@@@B@@@BC@A@A@@BAAC@@@A@A@@@A@A@A@AAA@@@E@AAA@@@A@@@AA@@AA@@@@AA@CA@@@A@A@@BA@ABA@@B@@A@A@@@@A@A@@@@@A@@A@AAC@AB@@@@@@@AC@@AE@A@@B@BA@@@BB@@@@B@@@BBB@@@BB@@@B@B@@@@@@B@B@@B@@B@@B@B@B@@AB@@@@@@A@A@@B@@@@@B@B@B@B@D@@@BA@@DE@@AA@@@A@AB@@ABA@@B@@@BA@@BA@BB@@@@@B@B@@@@B@@B@@@B@BB@@@@@BBBDB@@@@B@BB@@DBB@@@B@@@B@B@@@BBBBBAB@@@B@@@@@BAB@@AB@BABA@AB@@@@AD@B@@@A@@@A@@@@ABAB@@AB@B@@@BA@@BA@A@@@A@@BA@A@A@A@A@@@A@@@A@ABAB@@AB@@@B@@@B@@@BB@A@@B@@@B@B@@@B@BA@A@@B@B@@A@@@@@@B@BB@@@@B@@@B@@@B@B@@@B@@@@@BA@@@@BA@@@@BA@@@@BA@@BA@B@@@B@@B@@@B@@@BABCBA@@@@B@@@BABA@AB@@A@@@@B@@BDA@A@@B@@A@@B@@@@@BAAACEAAAAECIE@A@A@A@@@A@A@A@@@A@@@A@@BA@@@AA@@@A@A@A@A@A@@@A@A@@@@BA@AB@@@@@B@@A@@@AB@@@@@@AB@@@@AA@@@AAA@@AA@@@A@@AA@@AA@@B@B@BA@@B@@@@AA@AAAA@A@@@@AAA@CBC@@@A@@@A@@@A@@AAAA@A@@AB@BC@A@AB@AA@@@AABEBA@AB@A@C@@A@@@AA@AA@@@AA@@C@@ABA@@BABABABA@@@D@B@@@F@@B@B@@@B@@B@@B@B@@ABA@BB@B@@@AABA@A@@@@@@B@@@B@B@@@DC@@@D@@@B@B@B@B@BB@@@B@B@D@@@B@@A@A@@@AAA@@@A@@@@BAB@@@B@BA@@@@B@B@@AB@@B@@@@B@@@@@BAB@@@B@@@@@@@B@B@B@B@@B@@B@BD@@B@@@B@B@B@BB@ABA@@@A@@@AB@@B@@BBB@@AA@@@@A@A@@@@@A@A@@@@@@B@@@@ABA@@@A@ADA@@@AB@@ABA@@@AB@@@@A@@@@@A@A@AA@A@@A@@A@@AA@@@@@@A@A@AA@@AA@ABAA@@A@A@@A@@@@A@@@@@A@A@@@@@@@A@A@@@@@@AA@@@A@@D@@A@@@@@A@@@@@A@CAA@@A@@A@@BA@@@@@A@@B@@A@A@@@AB@@@@A@A@@AA@AAABAAABC@@@AAA@@@@BA@A@@@A@@@@@A@@@@BC@@@A@A@A@@@A@@@@A@@AAB@@AB@@C@ABAA@ACBA@@@A@AAA@@@@@@BA@@@@@AA@@AAAA@@AA@A@@@AA@@@@@@AAA@@@AC@@AA@@@B@@@B@@@@@B@B@@@@A@@@A@@@@B@@BD@@ABB@@B@BA@@BBA@@@@BA@AB@@@@@@@BB@@@@@@@@BB@@B@@@@@@BA@@BA@@BA@@@A@@AA@AB@@A@A@A@CA@@A@@@@B@@@@@@B@@@B@@B@@@@B@@BB@@@AB@@@A@@A@BBA@@D@@@@@AB@@B@B@@@B@@AB@@@@@A@@@@A@@@@B@@@@@@@BBB@@@@A@A@@@@@@BA@@@AB@B@@A@@@@BA@@BA@@BAB@B@@AAA@@@A@@@C@AAA@@A@@@C@@A@AA@@@A@A@AA@@@@@A@@@A@A@@@A@A@@@A@@@AB@@@@A@A@@A@@@A@@@EA@@@@A@@@@@@@@@AAB@A@@@@@A@@A@@A@@@A@@@@@AAA@@@B@@@A@@@@@@@A@@A@@@A@@@@@@A@@@C@@@B@@AA@@@@@AABAA@@A@@@@AAA@@@A@@@AB@@@BA@@@A@A@A@@@@@@@@B@@@@@B@@@@@B@@@@@B@@@B@@@B@@@@@@@@@B@@@B@@A@@BA@AB@@A@@@ABA@ABA@@@@B@@@B@@A@ABAA@@A@@@@@@@AC@AAA@@@@AA@A@A@AB@@A@@BA@@@@@A@@AA@C@@@@AB@@@@ABAB@B@@@@AB@A@@@A@@A@@@@A@@@@@A@@@@@A@@@@@@@@AAB@@ABAB@BA@AB@@@@@A@@@@AA@@@@AA@@AA@@@@AC@@A@@C@@A@CA@AAA@@@@@A@@A@@@@@A@@A@@AA@@AA@@AA@@@AAA@@@@AA@A@A@AA@@AB@@A@BB@B@B@BBBBB@BB@B@@B@@@BB@@@ABA@@B@@@@@@BB@@AB@@@@@@@@B@@@@B@@@B@@@BB@@@A@@@B@B@@B@@@@@BABBB@@@@@B@@@@@ABA@B@@@@@@@B@@@@@B@@@@@@@@@BB@@@@@@@AAA@@@AA@AA@@@C@A@A@@@A@@@@@A@@AA@@@A@@BA@@B@@@B@@@B@B@@AB@@@@@B@BA@@BAB@@AB@@A@A@@B@@AB@@AB@AA@@@@@AB@@ABC@@B@BBD@@B@@@BB@BB@@@B@@@@B@AB@@BB@BB@@B@@AB@@@@@B@@@BA@@@AB@@@BAB@@B@BBB@B@@B@@DB@@BA@@@@@A@A@@DA@@BB@@BB@D@@@B@@@BB@B@@@B@@@@@CA@A@@A@BA@@@@BB@BB@D@@@B@@@B@BABA@@B@B@@A@@B@@@B@@A@A@A@AA@@@BAAA@@@A@@AA@AA@AAAA@@A@@@AA@@@AAB@@AA@AA@@@A@@@AAA@A@CA@@AA@B@@@@C@A@@@A@A@@@A@@BA@AA@@A@A@@AA@@@AC@AA@@@@AAA@@@@@AA@A@@@AB@@A@@@@@C@A@@@A@AAC@@B@@@BA@@@@@A@@@@@AA@@@@AB@@@@@BA@AB@@@AA@@@@@AAA@@AA@@@@@A@@@C@@@A@@@A@@@@B@@@@@@A@@@A@A@@A@@A@@@AA@@A@AA@@@@@@A@@@A@@@@@A@@@A@@AA@@@AA@@A@AAABA@@@@AA@@@AA@@AA@@A@@@A@@A@@A@@AA@@AAAA@@@A@AB@@ABA@AAA@@A@AAAAA@A@@A@@AA@@@AA@@@A@@@@@A@@@A@C@@@AA@@C@@@A@@AA@@@A@@@A@A@A@@BA@AA@@@BA@@AA@@@@@A@@@@@A@@@A@C@@A@AA@A@A@@AA@@@AAA@@@@BA@A@A@A@A@A@A@@@@@CB@@@@A@A@@@@@AAA@@@@C@A@@@@@A@AAA@B@@A@@CAA@@AA@@@A@@@@@@A@A@@AA@@@A@@AA@@@@A@@AA@@AAA@@@@AA@A@@@@@A@@@@BA@@BAAABB@@@@@@@@B@@@BA@BB@@@B@@@B@@@@B@@B@@B@@@B@@@@@BB@@@@@@@D@@CA@@@@AA@@A@@@A@@A@@AAA@@A@@A@@@@@A@@@@B@@@@C@@BA@A@@BA@@@@@A@AA@BA@@@ABA@@@@BA@@@A@@B@@A@@@@AAAA@@@ABA@@A@@ABA@@DC@@@@@@@@@A@@@@@AACA@A@A@@@AA@A@@B@@ABA@@@A@A@@@A@@A@@A@@@@@A@@@AAA@A@@BBB@B@B@@@BBB@B@@@B@@@B@@@@@@@B@@@D@@@BA@@@A@@@AB@BA@AA@@@BAAABABABABC@@@@@A@A@@@AAA@A@@@@@A@AAA@@B@@@AA@@@AA@@A@@@A@@A@@A@@@A@@@@AA@AA@@AA@A@A@@@AA@@@@@@A@@@@A@@C@AAA@@A@@@@@A@@@@AA@@@@@A@AA@@@A@AA@@AA@@@C@@@@@AA@@@@A@@@A@ABABA@@@A@@BA@A@@@C@@@AB@@@@AB@@A@@@A@AA@@@@@@AC@AA@@@AA@@@AA@@A@@A@A@@A@BA@A@ABAB@@@A@@AA@AA@@AB@@AAEA@@@@@@@D@@@BA@@B@@@B@@@@@BAB@BAB@@@DAB@@@@@@AB@@ABAB@@CB@BA@@@B@@@AB@@@BAB@@A@@@@B@@AB@@A@@BB@A@@BAB@@@@@B@@@BA@@@@@@BA@@B@@AB@B@B@@@B@@AB@@@B@B@@A@@BAA@B@@@BAB@@@B@@@@AB@@@B@@AD@BA@@@@@@@@@AA@@A@@@AAA@@@AAAAA@A@C@A@@AA@A@@CA@@AC@A@@@A@A@@@AB@@@B@@A@ABA@@BAB@@@@@@AB@@@@@@@BA@C@@BA@@@@@@B@BA@@@@B@@@@A@@BA@@@@@@@A@@@A@@@@@@BA@@@@BA@@B@@A@A@@@@@@@A@@@A@@@@B@@C@@@@BA@@BA@A@@BA@@@AB@@@BAB@BAB@@@@A@AAA@A@@@@AA@A@AAC@@AA@@@BA@@AA@A@A@@A@@A@A@@@@@A@@@@A@@A@@@@B@@A@@@@BA@@@A@@@A@A@@AAB@@@@@AABA@@AAAA@@@A@@@AA@@A@@@@BA@@@@BA@@AA@@@A@AB@B@@A@@@A@A@@@@BA@@AE@@BAA@@AA@AAA@@@B@@@@A@@@A@@@A@@A@@@@A@AA@@AC@@@@@@@@ACAA@@@@A@@A@@@AA@@@A@A@@@@@@@AAA@@@A@@@@@@AA@@@@@@A@ABA@@@@@A@A@@@A@AB@@A@@AA@A@@@A@@BA@ABAB@@CDAD@BABAB@@AB@B@BA@@BAB@BA@@@@BA@A@@@A@@@A@@AC@@@C@AA@@AB@@@@AB@@A@A@A@@B@@A@AB@@ABAB@B@@CD@@@@A@@@@@A@@B@@ABA@@B@@@@ACCA@A@A@@@C@@AA@@CC@A@A@AA@@@@A@@@AA@@@@A@@@@@AAA@@@ACA@@AC@@A@A@@A@@A@@@@@@AA@A@A@A@@AA@A@A@@@@A@@AAA@@@@A@AA@AAAA@@AAAAA@@AA@A@A@@@A@A@@@@A@AAAB@@A@@@AA@A@@@A@@@A@@@AAA@@@@AA@BA@@@@AA@@@@@AA@@A@@@@@@@@A@@A@@@@@AAA@@A@@AA@@@@@AAA@@@AA@@A@@@@AA@@A@@@A@@A@@AAA@@B@@A@@A@@@@@@@@@@AA@@@A@@@@@@A@A@AA@@@@@@AA@@@@A@@@@A@@@A@@AAB@@A@@@@@A@@@@AA@@@@@AA@A@@@ABAA@@@@A@A@@@@AA@@AA@A@@@A@@@@AA@@@A@@@AA@@@@A@@A@EA@@@@A@@@A@@@AA@@@@AA@A@A@@A@@@@A@A@@A@@A@@@@@@@A@@AAA@A@@@@@A@A@@@AAAA@@@AA@AAA@@@@B@AA@@B@@A@@@A@A@A@A@@@@AAB@@@@AA@@@@C@@A@@@A@@ABA@@B@@A@@@A@@@@@A@@AA@@@@@AA@@@AA@AAA@@@@@@@A@@@AA@@A@AAA@A@A@@@@@A@@@AA@@@@AA@@@A@@@AA@B@@A@@@@AA@@@A@@AA@@@A@@@@@AA@@A@@@@@@@ACB@@AA@@A@@@A@@BAA@BA@@AA@@@AB@@A@@BABA@@BAA@@A@AAA@AA@@A@@@@@@@@AA@C@A@C@@@A@ABA@C@@@A@@B@@@@AAA@@BA@@@@@@AA@@@BC@AA@@AB@@@A@@A@A@@@A@@BA@@@A@@@@AA@A@@@A@CA@@A@@A@@@@AA@@A@@A@A@@A@@AA@@@A@A@@@A@@A@@A@@@AA@B@AA@@@A@A@@@A@@@@@AA@A@AA@@@@BA@@@@@AAA@AA@@@AA@@@@@AA@BAAA@@AA@@A@@@@ABAAA@@@@A@AA@@AA@@@AA@@BA@@@@@AAAACBC@A@A@A@@@A@@AA@AA@AAA@@@@A@AA@C@@@@AA@@@@A@AAA@@@@A@@C@A@@@@A@A@@@A@@@AA@@A@@@@@@@@@A@A@@B@B@@@@A@@B@@@BBBA@@B@BA@@@@BA@A@@@@BA@AA@@@A@@A@A@A@@AA@@@@B@@@AA@A@@@A@AAA@@@AB@@A@@@A@@@A@@A@@AA@@@@A@@@@@@@@@A@@A@@A@@@@@A@@@A@A@@@A@@@B@AA@@A@@@@@A@@@A@@A@ABAA@@@@@@A@@@A@@AABAA@@@BA@AB@@@@@@A@@@@@@@@B@@AA@@A@@@AA@@AB@@AA@@@@A@A@@@AA@@A@AB@@AAA@@@A@@@@A@@AB@B@@AB@@B@@@AB@@AB@B@@@@BB@BAB@B@@@@AB@@@B@BA@ABAB@BA@A@@BA@@B@@A@@@@BB@@@@B@@@@A@AB@@AB@B@BB@@@AB@B@@@B@@@B@BBB@@A@@B@@@B@B@@@BA@@BC@@B@@@B@@@B@BABA@A@@BA@@@@@@@ABA@AB@@@@A@@@@AA@ABCAA@AACAA@@@@A@@A@@@BC@@@C@A@@AB@@AA@@AA@A@@BA@A@A@@E@E@@@@AA@A@A@C@A@@@A@ABC@A@@B@@AB@@@@A@@@@BA@A@EBC@@@@AAA@@@@AA@@A@@A@A@@@@@A@@@A@C@@B@@@@A@@@AA@B@AABA@@A@AGB@@@@@BA@@@AA@@C@@AABAAABAACCAA@A@A@AAA@A@CA@@AA@@AB@@A@@@AB@B@B@@ABA@@B@@@BCB@@A@A@A@@@A@@@@@A@AB@@AAA@A@A@@@AA@@ED@@@D@@AB@BADBBA@BBADCB@@B@@B@@@B@@ABD@DBB@FBBABBDDB@@B@@@B@@BBAB@DAD@@AB@BAB@@BB@BA@@@@@A@A@A@A@A@A@AB@@ABA@ABA@CBA@@@A@AB@@IFCBA@@BB@B@B@B@DD@@B@BB@BBBD@BA@ABAB@B@@@BB@BBDB@@@B@@BAB@@AB@B@BBBAFAB@DELBB@@BBBF@B@B@HBB@B@F@@@F@BBBBB@D@@@@@B@B@@@BBD@@@B@@ABBBAB@B@DC@@B@@BBABBDDBBB@@B@@B@B@B@B@@@B@BA@@@@BABADADB@BD@@BD@@BB@@B@D@@B@B@@B@@B@@B@@B@@@B@B@B@@@B@@@B@@@@@@@B@@@@@B@@@B@@@@@B@B@BA@A@A@A@ABA@@@@BABCB@@@ACB@@@D@B@B@B@@@D@@@D@B@BBDDDAD@@@D@B@BAB@B@@@DBB@BAD@BDD@@@D@B@D@DAB@@@B@B@B@B@B@@ABBBA@@B@DBB@BB@BD@@@B@@DBBBDBB@B@@BBBBBB@@B@BB@@@B@B@B@B@@@B@B@@@@@BA@@BA@CBC@EB@BB@B@B@@B@D@D@BB@@B@@@F@@@@BB@BB@@@DBB@@BB@@BA@A@CBAB@BCBAB@@A@A@ABABA@@FAB@BBBAB@D@D@DA@@DAB@BBB@@@@BB@BAB@@@BAB@B@@BB@BB@@DB@BB@BD@BB@@@BBBB@D@BBDB@@@B@B@B@AF@DBB@BB@@@B@@ABAB@@@BA@@BB@@B@BA@@BBB@@BB@@@B@@@@@A@@@@A@@B@B@DCDABAB@BAB@@AB@@A@A@@BA@@BAB@@AB@D@@AB@@@@@DA@@BB@BB@@B@@BD@@@BAB@B@@@B@@@B@@@B@@AB@@@BBB@@@DBD@BABA@@@CBA@A@ABAAABA@@@CBB@@@BBB@@@D@@BB@@BBB@@@@BBB@@BBB@B@B@@B@@@BCBAB@D@D@B@DAB@@@@@A@@B@@AB@BFD@@@B@@B@@@BAB@BB@@@@A@A@@BB@@BB@B@@@B@@A@A@AB@@@B@B@@@BAB@B@@@@A@@B@B@B@BB@@@@B@@@@@@BBB@@AB@@B@BB@@@@@@@BB@@@B@@@AB@@B@@@@B@@AB@@@@@B@B@@BB@B@@@B@B@@ABA@A@@B@DAB@B@@@@@B@@@@BB@@@@@B@B@@@B@@@@@B@@@BA@@@@BA@@BB@@BB@F@BCBA@AD@BAF@DCB@@@@B@D@@BB@@@BABCB@@@BA@@BAB@@A@@@BBB@@@BAB@B@DABA@ABAFAB@B@BB@@@B@BAB@BA@@B@B@BA@@D@DBBCBA@AB@@@D@@@B@B@@BBD@@@BABB@@@@@BB@BBB@D@@BABA@A@A@A@A@AB@@A@@@A@DDB@BB@@@BB@@BBB@BAB@B@@@BB@BB@B@@BB@@@B@B@B@BADBDAB@BBD@@B@@BB@@@@@@BB@@@@ABB@@@@B@B@BBB@B@BD@B@BA@A@A@CC@@C@@B@BCFAAA@@A@B@B@@DDBBDB@@B@BA@ABA@ADADAB@@BB@@FAB@@@@BBB@@B@B@B@BAB@@AB@B@B@@@B@D@@BB@@@B@@BB@@@BBBB@BD@@B@BBBB@BB@@BB@DBB@B@B@BADABAD@BABAB@BAB@B@@@@B@BBBBB@BABBB@B@@BBB@@@BB@BDDB@DAB@B@@@BB@BB@BB@B@@@BB@BC@@BA@@@A@@B@D@@BBF@B@B@B@B@@AFAB@BABB@@@BAD@@@B@B@B@B@@@D@BAB@D@BA@@DD@B@BBBE@ABA@AB@BA@@B@DB@@@GDABA@@BC@ABA@@@A@@@@B@BC@A@AAAB@@@BBB@@B@B@@@CBA@A@A@@@ABABCB@@@@AD@@@B@@AD@BAB@BDDDB@@BAB@@@@B@@ABBB@@A@@B@B@@AB@BBB@BBB@B@B@B@DA@@B@@@B@BA@@BAB@BAB@B@@@B@@ABCD@DCD@BB@@BB@@AB@@@@@BB@@BH@@BB@B@BA@@B@@ABCDA@@@A@@B@@ABA@@@@B@@A@@BAB@BA@A@A@@@@@AA@@@@@@@@A@@@@@A@@@@B@@A@@BA@AB@@@@A@A@@@A@ABAB@BB@D@B@@@@@@BA@E@@BABA@C@@@@@@AA@A@@@@@AA@@A@AAAAA@A@A@@AA@A@@@A@@@A@A@A@AAA@@A@@AB@@@AA@@A@@AB@@A@AB@@@BAB@@A@CD@@AB@@@B@B@@A@C@AB@@@@@B@B@@@@@B@@AA@@@BAB@@@@A@@@ABA@@@AA@@@@AA@@A@@@AAAB@@A@AB@BAB@@@A@@BA@@A@@A@@@@AAA@C@@@A@@@A@AA@A@@@BAB@@A@AAE@A@@@CBA@A@ABA@@@A@@BC@CA@AA@@@A@@@@@A@A@A@@@E@A@@A@@@@@A@@A@AAA@AAA@@BC@A@@B@@@@"],encodeOffsets:[[112580,24723]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("柳州",{type:"FeatureCollection",features:[{type:"Feature",id:"450202",properties:{name:"城中区",cp:[109.4273,24.366],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@CCC@CA@@A@A@C@@@@@@@A@EBCBABAB@@@@AB@BCJJBB@B@@@@B@@B@B@@@B@F@@@B@@@BB@@B@B@JB@@AD@@@@@B@B@FAD@@BH@B@F@F@@BF@B@B@D@@BH@D@BBH@D@B@F@FAB@DAB@B@D@D@F@BBD@@@@@BB@DDBDLHFBFBZ@HAD@LALBFBDBJHDFBDDDH\\BDJLTFH@F@@@@@@@B@HA@@DAFCDGDC@E@A@AAEAACEC@ECGC@@GEEI@CEG@@@AAG@C@CBK@E@GBK@C@AAE@EEIAGACGQEGGIGKEGKOAAGFA@CBQ@CN@BAJ@@A@@@A@G@A@KA@@M@A@CAC@@@@@C@A@@A@@BA@AAC@@@ACA@@@A@ACC@@"],encodeOffsets:[[112048,24895]]}},{type:"Feature",id:"450204",properties:{name:"柳南区",cp:[109.385518,24.336229],childNum:1},geometry:{type:"Polygon",coordinates:["@@@E@@AA@CDA@A@@@AAAAADA@AA@@AAABAD@@A@A@@AABAD@BCBABAJCDA@ADCB@BCAA@A@A@AA@AGA@CAEC@@@AAA@C@A@AC@EA@@@DC@@@GB@B@B@@@@@B@@@@A@@@ADABC@A@A@@B@BB@@@@@@BB@@@@@B@@@@B@BB@@BA@CACC@@@B@B@@AAA@AA@AAAA@@@A@@@@AA@@AAA@@A@@A@@@@A@@A@@A@@@CC@AA@C@@@@@@@DB@B@@BBBB@BB@@B@@@@B@ABB@A@BB@BA@@@@B@@@@@B@B@@@@AB@@@B@D@B@@@BB@D@@D@@@B@@@@CBCDABABCBABCAEAA@@@@@B@BC@@BEA@E@@A@@@AF@@AA@@@@A@A@A@@@CC@@@A@@@ABG@@AEBCB@@DJBB@BBDBB@@@@@@E@A@@@A@@AKCEACAA@CCCAC@A@CA@@@@@DA@C@@B@@@@A@@@@BA@@@@@B@@@@B@@ABA@@AA@@@@B@@@@@BAB@@A@A@@AA@@@A@@@A@@@@@@@A@@BB@@@CB@@CBA@C@AB@B@@A@EBA@CB@B@@A@A@@A@@@BAB@DA@@B@BAB@@@BAB@B@@@B@D@J@D@@@DAD@BAF@B@@@B@B@B@B@@BBCBA@C@@@AAA@A@@@CB@BAB@@A@EBCDBBABA@CACD@@A@AA@AC@A@AACDA@C@AAE@ABA@ADA@@@@@@A@@AAB@@@@C@@AA@@@AA@A@@@AA@AA@@@CAA@AA@ACAB@@AAA@AAC@BA@@@AA@AB@@@BAA@AAAAG@GAAD@BAB@B@B@B@@@B@BADABAD@B@D@FABABCF@@@@A@@BABABB@@BB@@B@@@@@@@@@B@@@DBB@B@@@@AD@@@B@@@@@B@BAB@B@@AB@@@@@@BFAD@B@@AB@@@@AB@@@BB@BBBBB@@A@@B@B@B@B@F@BB@B@@@DB@@BD@@BBD@@@@@BB@DDAB@@BBBB@@@@@BCFAB@@@B@@@B@@@@AB@B@@B@@@BBA@@@@@@B@@B@BF@@AB@@@@AB@@@@@@@@A@@@@@B@@@DB@BB@@B@@B@DBB@@@@@@@B@BAB@B@B@B@@@BA@@@@@@B@@@@@@@B@@@@@BBB@@@BBBBBBBBBBB@B@@A@@@@@@@AB@B@@AB@@@@@@@B@BA@@@A@@B@@@B@B@BB@@B@BAB@D@@@@BB@@B@@@@D@HB@@BBBAB@@BB@@@B@@@B@@@@@D@B@B@@@B@BBBBB@DB@@@@B@BB@@@@@@@@B@@A@@@@@A@@@@D@@@AIBGBA@ADAFELAPAFBB@DBB@DBFCFCB@BABAHCJCDA\\INELCLEDAFCHC@@BG@@@@BC@C@C@E@@@@@CACBC@@AC@A@A@A@C@@DI@ABA@@@@BABADAFAB@@C@@@AAA@A@@CB@@@@@A@@@A@A@@@A@@B@@@@A@@@@@A@@@@@A@@AAB@BA@@@@@AAAA@@@@@@@@@@@A@@A@@A@A@"],encodeOffsets:[[112031,24875]]}},{type:"Feature",id:"450205",properties:{name:"柳北区",cp:[109.402049,24.362691],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@A@@@E@A@@@A@A@@@@A@@A@A@IA@@@D@B@B@BBD@@ADBD@D@@@@@F@D@DAD@@@@AH@@GDEDCBKFKDMF[JCBIDGDABABA@EDEDCAA@CAA@EAOBKBEFCB@BABAHBJ@@@@BDBD@B@@@@BB@BDB@@BDBHBBDFBH@DDF@D@@@@B@@L@LAFAB@D@@EbAHA@D@BB@A@B@@@@BB@@BB@@D@@@@BB@@B@@B@@B@@B@@BB@B@@BB@B@B@D@F@@@@@@D@B@@@BB@@BB@@@B@@@@DD@@BBBABB@A@C@@BB@AB@@B@AB@@A@A@@@ADA@@BA@@@C@@B@B@B@BED@@AB@@@@@B@@A@@BA@@@@B@@BB@BBB@BAD@@@@@B@B@D@@A@ABA@@@@BBDA@ABA@@B@@E@@@ADA@E@CDEBI@AHA@A@CDADA@@@CDA@@B@BAB@@A@AA@@AB@@@@@BA@@@@@A@@B@B@@A@@@AAABA@@B@@@@A@@BA@A@@@@B@@@B@BAB@@@B@DA@@BBBA@AB@@AB@@A@@B@B@B@B@@@@@B@@B@@@@B@@A@@@B@@B@@BB@@@BJBFBDBDDDFBD@@BJELAHEFEB@@BDB@@BDB@@BD@@D@B@BBD@BBB@@@DAD@B@B@DAFAB@B@BAB@B@@@@@HJ@@D@B@DAD@FCBB@@@ABAF@DC@AD@BBFCB@BA@@F@DCLIB@@@B@@ABADADA@@@AHC@AAA@AB@@@AABAB@@BB@@A@@B@@CDA@DB@@C@@BA@@@@@AA@@@ADA@EDAC@A@AA@@@@BBB@B@@@@@B@@A@@BA@A@@A@@@A@@@@A@@@AA@AEABA@@B@@B@BB@@AAC@@@AAC@@@ABABC@@B@BEDB@A@@@@B@@A@@@@@@A@@@B@@A@A@@@A@C@A@@@@AB@@AAB@@@A@@@@A@AAB@BAB@@BABB@@BB@@A@@A@@@BA@B@BB@B@@@@@@@@@@@@A@@B@@B@@@AB@@@@A@@A@CAAA@@@@ABCDAB@BAD@@AA@@@@@@CDA@BBAD@FBD@BAB@@B@@@B@@BBD@AB@@A@@@D@B@@@@BA@@@@@B@@@@B@@B@@@@@@B@BAB@@@B@@@B@@@@BBB@DA@@DD@BB@@@BAB@BBBB@@B@BA@@FBB@D@B@@@@A@@B@@BBBB@@@@@B@BA@@@@BC@A@@@@A@A@@@@A@@@@@@AB@@@A@@@@@@B@@@B@@A@@@@@@@@B@BBB@@@@@@@@BB@@A@A@@B@@@@A@AB@D@@@B@B@@@@AB@ACBG@A@CACPEACB@CEJ@DBD@BB@@B@F@B@@@DC@AB@B@@@@BB@@@B@@@@@DA@@@A@@A@@@@A@AA@ECAAAB@B@@AD@@B@@@@@@@BAB@@B@B@@@@@@@@A@@@@@@@A@@@@@A@A@@AA@@@A@@A@@A@@AA@@B@@@B@A@@@BAA@@@A@@@@AB@@@@ABA@@@@AC@@B@A@@AA@@E@AB@ACB@A@@A@@@A@@@@@@A@BA@@AAB@@A@@B@@@BCA@A@@BA@@B@@@BA@AA@A@@AB@@@B@@@BAB@@@B@B@@@AAB@B@@@@@B@@@B@BB@@BAA@@@@C@@@@BC@@A@AA@@@A@A@@BBB@BA@@@BB@@AA@BAA@@@@@@B@@AA@@@BA@@@@@@A@A@@@A@@@@@A@@@@B@@@@ABA@@A@B@CA@@@@@@@A@@@A@@@@@AA@@@@@BA@@A@B@@A@@@@@A@@@@@A@@@A@@@ABB@@BDB@B@B@FA@@B@@A@@@@@@BA@@@@B@@@AA@@B@@@BBB@B@@@@A@@@@@A@@BA@@DABA@@B@BABAAABA@@BA@@@@B@@A@@AA@@@AAAAC@@@ABA@B@@B@@@B@DB@BBB@@@ABAA@@AAA@@@@BBB@@@@@B@@@@@B@@BB@@@@ABA@@A@@A@B@@@@@BAA@@AC@@B@BB@@B@B@D@B@@@@@@DBBF@DAB@BADC@@BCF@@@BBBFB@B@BBBBB@B@@BBB@@B@BB@BDBB@@BB@@BBA@@@@BAB@@@B@BA@AB@AA@@@@@@@CA@BABABA@@@A@@@A@A@@@A@C@@BCBC@A@ABADB@@AA@ABB@@@@AC@C@@@A@@B@@@@@ABBD@@BA@@@A@@@@@@B@@AA@@@@@B@@B@@@@@B@AB@@A@@@BB@@@@@B@@@@BBA@@BB@BBB@@B@BB@@@A@@@@@@B@@@@ABABBBA@@BA@@@@@@@A@@@A@@@BA@A@@AB@@A@A@A@@@@B@@@B@@@B@@@@AA@@AA@@A@@@A@AB@@@@AA@AC@@E@G@SEIKACG[CCACCEIGCAEAKAKBC@GBY@EAEAKGACCCA@@A@@@@AC@A@E@C@C@ABA@CBA@E@E@A@CAG@A@CAG@@@C@A@AAE@@@E@E@AAG@@BC@E@A@A@@@@BC@@IAA@A@@@"],encodeOffsets:[[112041,24905]]}},{type:"Feature",id:"450203",properties:{name:"鱼峰区",cp:[109.452442,24.318516],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@GIAAAACAAAAEA@@A@C@AAE@AABA@A@AB@@@CA@A@AAA@A@ABA@@@@AAC@@@A@A@@AAAAAA@@A@@A@AA@AA@@AAA@A@AACA@@AAA@@E@A@A@AE@@DABAB@B@@CBAAA@A@@@@@A@C@@A@@@AB@BCB@BA@@@A@@@@@@@@@CECA@ABC@EB@@@@@AAAEBA@CBA@C@A@CDABCAED@@@D@B@@GCA@ABCB@B@BA@A@@@@@@B@@@@AB@B@@@B@@B@@B@@@BB@@BB@@@BBBB@B@@@@BBB@@BB@BB@BABCB@@AAAB@B@@@BADBD@@@B@@@@A@FBD@@B@B@DBB@B@@FDDBB@BHB@@B@B@BBBADA@CD@BCBIDABABADC@ABBB@@@B@BC@ABBB@BB@@BCBBBBB@B@@@BCB@DBB@@@FB@B@@@@BB@@@@@@@@@@@B@BB@B@@@@ABA@BB@@@B@@@@@B@@@@@B@@A@@@@B@@@B@B@@@B@@@@DA@@@BBB@B@@@D@@@@@@D@B@B@@@DBD@DD@@BB@@DD@B@B@@DB@B@@BD@BAB@@@BB@D@@@@@D@DBB@N@@@LBB@H@B@@@B@@@BI@ADMR@DAB@HEBBLPFHHLHJFHHRBDBHFJ@FBF@B@DAL@H@FAL@D@DBH@B@@FH@DFJHF@@HDFDD@DFBBBF@B@B@FCDCHEDCB@@GBA@@@@@BDB@@B@@A@@B@B@@@BB@@BB@@B@@A@@@A@@@A@@@@@@B@B@BA@@BB@B@@A@@@B@@@B@@B@@AB@@@@B@@AB@@@@B@B@@@@@@BA@A@CA@@@@ABBB@BBB@@A@B@@@B@@B@A@@@@BA@@A@@BA@@A@@@A@A@@@@B@DB@AB@@A@@@@@@@BB@@@BDBB@@@AB@B@@@@BB@BADB@@@ABAB@B@@@BCDABAB@@@DB@@BA@AB@BAB@B@@BBB@AB@@@AB@@@B@B@@B@BB@@BB@B@@@@@B@B@@@BABB@@@B@@@@@A@@@@B@@@B@BFD@B@@B@B@@@@@A@@B@@@@DB@@@@BA@@@@B@@@ABA@@@@BA@@B@B@B@@@B@@@@@B@@BB@@@BB@BFB@@B@@@BB@@BBB@@B@@BB@BBBBBB@@BBDBB@@BB@BB@@DB@@@@@B@B@B@B@B@@B@BBB@@@@@BBA@@BBB@@@BDA@@B@@BB@B@BAB@@BB@B@@@@@@@@B@@B@@B@BB@@BB@@BB@B@@D@B@@@B@@CDA@A@@@@@ABCB@@A@@@AA@@@B@@@@AB@@@@@@@AA@@@A@@@@@AA@@A@@AAB@@@B@@A@@@@B@B@@BB@@AB@@A@@@@@@@@BABA@A@@@EDCBB@@D@BB@BBA@BB@BBD@BBB@B@BBBC@ABAB@B@B@ABD@@@@B@BB@@B@B@@D@@AB@B@B@BB@BB@@B@D@D@@@BB@@@AB@B@HCB@@@DBB@@@@@B@@@BBD@BBB@BBBBBBBBB@@B@@B@DE@AB@@@B@@@@BB@@@B@@@BD@ABB@@@@@B@@@BB@@@@B@@@@AB@@@BA@BBBBB@BD@@B@@BBBBDBBDB@B@@AB@@@BBB@@@D@@@D@DCBB@@B@BB@@BD@B@BCDDF@FDBBDBBBBBFDH@HAAABABADCBBDDFCBC@AB@B@B@B@DADCDC@ADDBA@@BCBEBAB@D@DA@@DABA@AEC@AAAAA@A@@@AA@@AAA@C@A@AA@@@AA@A@AAA@C@ADCBA@A@A@A@@@A@@@AA@@@AAAAA@@@@@BC@@BA@@@@@ADCBA@@@@BCB@B@@AB@B@@@@@@C@@@A@@A@A@@@A@@@@B@@A@@AAA@@B@@A@@@@@@@@BA@@D@@@BAAA@@@A@AD@@ABA@@@@@A@@@ABA@@BA@@@@B@B@B@@B@@@BB@@BBA@@@CBA@A@@@@@A@@@A@@@A@@@@@A@@@@A@@AA@@@ABA@BA@@@A@@AAA@@AB@BA@@@A@@@@@AAA@A@@@A@@@AB@A@@CA@AAAAA@@@A@@@@@A@AACC@A@A@A@CAACC@@@@A@@@A@A@@AA@CC@AA@A@@A@@@AAA@AA@@@ACAA@A@@@A@@A@@@AA@@AA@@@A@@@A@@B@@@@@BA@A@A@@B@B@@AB@B@@AB@@@@A@@@@A@@@A@@@@AA@AA@A@@AA@A@@@@BABC@@A@@@A@@A@@AA@A@@@A@A@A@@@A@@@A@C@@D@@@@AB@@@@AAAB@@@@A@@@@B@BAB@BABADAB@@@BABA@@B@@@BA@@@@@B@@BB@@B@@@@@@@@@BA@@@A@@@A@@@@@B@@@@@AB@@@@@@@@@@@@A@@@@@@B@@@@@@B@A@@B@@@@@@@B@@B@@@B@@@@B@BA@@B@@@@@@@@@@B@@@@@B@@D@B@@@@@@@BB@@B@@@@A@A@E@@B@@@BB@BB@@@@@BA@A@@B@B@B@@BBA@@BA@@B@B@B@B@@AB@BB@@@@@A@@@@@A@@@@A@@@AA@@BA@@@@D@@@@A@AB@@A@A@@@@B@@@@AAA@@@@AA@@B@@@A@@@@AAAB@@@B@@BB@@@@@@@@@@@BA@@B@@A@@B@@B@@@@@@@BAB@@B@@@@@@@@A@@@ABB@@@B@@B@@@@@B@BABA@ABA@@@@@@@@@AA@@@@@AB@@AA@@@@@@ABACB@@@B@@A@@D@@@@@@@A@@A@@@CA@@A@@@@D@@@@@@@BB@@B@@@@@BA@@@@BB@@@CAA@@B@@@B@@@B@@@@@@@@A@@@@B@@A@@@@@@A@@@A@@@@@BA@@@@@@B@@@B@DA@@AAAC@A@ABABEBC@@AAAAAA@@AA@A@AA@@A@@@@@CA@@A@@A@E@@@@B@@@@A@AA@@A@@@@A@@A@@BA@@B@@A@AB@@A@AB@@AA@BA@@@A@@@@@A@@@@@AA@@A@A@@@@A@@@CA@@@@A@@A@A@@@@@@@@A@A@@@AAB@@CA@@@@A@AB@@@AA@@@@AAA@@@@@@@@@A@@@@@@AA@@@A@@BA@@@@A@A@C@A@@BA@@@@@A@A@C@@A@@@@B@@@AA@@B@DA@@B@@@@AB@@@@@@A@ABA@AAA@@@@@@AAA@A@A@@@@@AAA@A@CAAAC@A@@@C@@A@@@@AA@AA@@A@@A@@@AAA@A@ABA@AAA@@AA@A@@@@A@@A@@@@@@@@A@@@A@A@AB@@A@AB@BAB@@@@A@C@@A@@@B@@@B@@@@@B@@@BBB@@@DA@@AC@AAA@A@@BAB@BBB@@@@@B@B@@AAA@@A@@@@@@@@@@@@@@A@@AA@@@@@@AA@@@B@@@@A@@@@A@@A@@@@BAB@@@A@@@@@A@@AA@@AAA@AAAA@BA@@@A@A@@@AAA@@A@@@A@A@@A@A@@B@B@@@@BB@@@@BB@@BBBDB@@@@BBFB@@@AB@@@@@@AAA@C@AAAA@AGD@@ABABA@@@A@@@@B@B@BA@@BA@@B@@AB@A@@@@@@A@@B@@@A@BAA@@AAA@@B@@@@A@@AA@@@AA@AA@@@@@@@@A@@@A@@A@@@A@AB@B@@@@E@AB@@@@A@@@@@@@AA@@EAA@@@A@@@@BA@AB@@@BA@A@@@@@A@A@A@@@AB@AA@@@A@@@@@AB@AAB@@A@@@BB@@@B@BAB@BCB@BCB@@@ACA@@A@ABAA@@@@@B@@A@@@@@A@A@A@@@@@A@@@@@@@A@@@AB@@@@ABABA@@@A@@@@B@BAB@@@@@@@B@@A@@@@@A@@B@@AAABA@@BA@@@@@@AA@@@@@A@@@A@@BA@@@ABABA@ABAB@@A@@@ECA@@AC@@A@@AAA@@AA@AA@@AA@@AA@@@@AAA@@A@@AAA@@@AA@@@@@@B@AABA@@@A@@@@@A@A@@@@@@C@A@A@@@BC@E@@CA@@A@AAA@@A@@AA@@@@@A@@@AAA@@@AA@@A@@AC@@@@BAB@@A@@B@BA@@BA@@@A@@BA@AB@@@@@B@B@DA@@BA@@@A@@@A@@@A@@BA@ABC@C@EBC@@@@@AA@@@@AA@@C@AAA@A@@@A@@A@@A@@A@C@@@@A@A@AA@@@AAAAAA@@A@@AA@@A@@A@@ACCACA@A@EBA@A@A@C@ABC@@B@B@@@@@BB@@B@BABA@C@CB@@AA@A@CBA@A@AA@@A@@@@@@@@AA@@@AAA@@A@CCAAAAAC@AA@@A@@@AA@C@A@@@AB@@A@@@A@ABAA@BAA@A@@@@@AA@A@@@@A@A@AA@@@A@@@AAA@@@"],encodeOffsets:[[112118,24838]]}},{type:"Feature",id:"450221",properties:{name:"柳江区",cp:[109.32638,24.254891],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@A@@@A@@@@@A@@@A@A@AA@@@A@AAA@B@A@@@C@@@CAA@A@@@@@A@@@@@A@@@@@A@@@A@@B@@@AA@@@ABCB@@AA@BA@@CA@@BA@@@A@A@@@A@@@ABC@A@A@AB@@AB@@@@@B@@@BCBABA@@A@AA@C@ABA@AB@@@@A@@@AA@@@B@@C@@AA@A@A@A@@@C@A@A@@AAB@@@A@ABA@@@A@AA@@@A@A@@@A@@@A@A@@@A@AA@B@BA@@@CA@@@A@@AAABA@@@A@ABBD@@AB@@@@@B@B@ABB@ABBA@@@A@@@@B@B@@B@BB@BBBB@B@B@@@BBA@ABA@@@AB@@@BA@@@AB@A@@AA@@A@@BC@@B@D@B@B@B@BAB@@@@A@B@@BBBA@AB@@@B@@ABA@BB@BBB@BE@AAA@AC@AA@AB@DC@A@@B@@B@BBB@ABB@@BBB@@B@@B@BAB@BAD@@@BA@BDDDEBA@A@GD@@@B@B@@EDA@C@@@AB@@A@@@A@A@A@@@AB@@@@@B@BB@@@AB@@BBA@F@@DA@AAABAB@@@BA@CBBBBBCBBD@@@BB@BB@B@@@@AB@B@BABA@@B@@BB@DA@@DA@@A@B@@A@ABC@@B@B@@@@AB@@@@@@A@@@AB@@@@@@A@@BA@@@@AC@C@@@CB@@BD@@A@ABA@A@A@@A@@ABABABAA@BE@AB@@@@CBABBBBFAD@B@@@D@B@B@@BD@@BB@@@D@B@B@BB@@A@@DBBB@D@@ADA@C@@A@@@B@BABDBFDAF@@@@@D@BAB@BA@E@A@CB@BBDCBC@EAAAG@@BAB@B@@@B@@A@@BA@A@A@@@A@@@@@A@@CC@@@@@A@@@C@@DA@@@A@ABA@A@A@@@A@EBA@ABAF@@@BA@A@A@A@A@@@A@@@@BE@A@G@@BA@A@@@@BCDA@A@@@@AAA@@CB@@AA@BA@A@@@@@A@@@AAA@A@@BC@CAA@A@@@@A@AA@A@A@@AA@@A@AAAAA@A@ABAB@BAB@@@BAAC@A@@@A@@@ABC@A@C@@AA@ABA@AB@@C@@@C@@@A@A@A@CCAA@A@A@EA@@@@AD@@@@A@A@@A@@AAACA@A@A@CAABAB@B@BA@C@A@A@@AA@AA@AA@@AA@C@@A@@@A@ACAA@@AAB@@A@@B@@@@@@@BABABA@AB@B@@A@@@@BA@A@@BA@AB@@A@@BABAB@@A@@@A@A@@@GBCB@@A@C@ABA@A@A@@AA@AAAA@CAAA@A@CB@A@@ABE@CB@B@@@B@B@B@@BBBB@@ABC@CB@@A@@@@FBB@B@B@@@DA@DD@B@@AB@@AD@@BBABB@@BBBEBAB@B@BABB@AB@B@@@@AB@@@B@@ADA@EBABA@AB@BA@ABA@@@BCAA@@BA@@@@A@@A@@@@AA@@@@A@AD@B@BA@@AAAA@A@@@AB@AA@@AA@CCACA@@@A@A@CBABABABA@C@@@A@A@@@@A@@A@A@@@BB@@@B@BB@@@A@@@@@@@@@@BAB@@C@ABE@B@@B@@@@@BDDB@BB@B@@BBBB@@@@BB@@BBDDBBDD@@ADBB@@@B@B@@A@CB@@A@@B@DABA@A@@@@@@BA@@B@@@@@@A@A@@@A@@A@A@A@@C@A@@@ABA@AA@@ABCBABADC@@@AAAAAA@@A@@@@@AA@A@@AA@@A@E@A@@BHFB@BBBB@@@@@@A@@BA@@B@@A@A@ACAB@@AB@AA@@AAAA@@AA@C@C@A@A@A@C@A@AB@@A@@@@@@@@BAAAB@@B@@@@@@B@@@@@@@@BB@@A@@@@B@@@@B@@@@@BB@@@B@@@B@@@@AB@@@@@B@BAB@@@BB@B@@@@B@@ABAB@@AAABADA@A@@B@@AB@@C@ADA@ICAB@@ADADAFC@@D@B@BA@AB@@AB@@@BCBA@@@C@AAA@@AC@@BA@@AABC@AB@@C@@@CA@AA@A@@@@BA@@@A@ABA@A@ABC@@BA@A@@@AB@B@B@@ABC@A@CB@@@@AA@A@A@@@AAAA@AAEA@@C@A@@@@@@A@@@@@A@A@@CAAAAAC@@@@BA@A@@@A@A@@@@@AA@@@AABA@@B@@AB@B@@@B@@@@@@@B@@ABABAB@@@BAB@@AB@@@@@@BBB@@@@B@DA@@@ABABA@@BAB@@@@@BA@@@@@@B@@@@A@@@@@AB@@B@@@@B@@@@@@A@@@AB@@B@@BBA@@BB@@@@A@@@A@@@@B@@@B@@BB@@@BA@A@@B@B@@@@AB@@@B@@@@AB@B@@@B@@@B@@B@@@@@BB@@A@@BBB@BBB@@@@BB@@BB@@@BAB@@@@ABA@@B@@BB@@A@A@@BA@@@ABAB@@ABA@A@C@A@@@@@@A@@C@E@@@@@A@@BA@AB@AAAA@AAA@A@@B@BAD@B@BAB@@A@C@@@@B@D@BBBA@A@@BCB@@@BB@@@@@@@@B@BB@BBB@@@BBB@@B@@@D@BA@D@BB@@@B@B@@@@@B@B@@A@@A@@A@@@@A@@@@AB@@@@A@@@@@@B@@BBAD@@@B@BB@B@@@BB@@@@@B@B@DCDAD@BADADADDFCDBFBBHB@F@DBFDB@D@DADE@C@CHABABABCDABAD@BBDBDABEDA@C@CACC@B@D@@@@DDCBCFABD@BB@D@B@DCDBB@DDB@BBDABBB@DB@@BC@ABADBBBBB@BBD@AFBBBDBA@BAD@BCBADD@ADBBDB@@BB@@@@BB@@@B@D@@@@CB@@@B@@@@BBB@@@B@B@B@@@@D@B@@@@@@B@@@DB@@@@@B@@ADAB@@A@@@A@ADABADAB@@@B@BBB@@@B@BBDBB@BHD@D@B@@ABADABA@ABA@A@AH@BB@@BABB@@B@B@B@DABAB@@@@BBBB@@@BB@@@B@B@B@B@@BD@@@B@@B@@@@A@@@@B@B@@@@AB@B@@@B@@@BB@ABB@@B@B@@@@@BB@@B@@ABB@@@B@DAB@@@@A@ABA@@@@B@BA@@BB@@BBBB@@B@D@BB@BDBF@DBD@HAD@@@BCDADAFBB@BB@D@@@B@@BBB@BDD@B@@B@BB@AB@BBBDADBBABAB@D@@I@CDADC@BB@D@B@BACADAB@BCB@BBBBAB@BAB@BBB@BDABDAD@B@B@BAB@BBB@B@@BB@BB@BAB@B@BD@BBBB@BBB@BDB@@@BB@@@DE@BFBB@BA@@BDDAB@@C@CBAA@BA@@BBB@B@@EDAA@F@@AB@B@B@@BB@@D@B@BBDBBB@D@BD@D@BFBAB@BBB@@BAB@@BB@BBD@B@@@DB@D@BAB@@@BB@BB@D@B@B@DBDCBAB@D@@F@BBB@@BB@@@B@B@BBFBBBBB@@B@@@BBBBB@@B@B@@BABABBB@BA@C@A@@@CBC@EBABABA@@A@@A@AB@B@D@B@BA@@BABBDB@B@@DABABAB@B@@BBBFDDD@H@JCB@BAHCLBbJDBD@B@DC@CAEBEBE@@@A@ABA@ADAD@HBHAFBBBHJFDH@JDBAB@B@D@D@D@BBB@FDDBB@B@DBDBFHBDB@BBD@BBBBBB@D@DAD@BBBDDBD@DBBB@BAB@BCDA@A@ABABCBAB@BBBA@ABA@AB@BA@@BAB@B@BAB@BA@@BABA@@B@BA@AAAAAAAA@AA@C@C@A@@BAB@@AAA@@@ABA@A@AB@DA@A@ABABABA@@B@AA@@@A@@@@B@@A@@@ADADAB@BA@@AAB@A@@AB@@@@DB@@@@@@@D@@@B@@@BB@@B@@@B@BAB@@AF@DAB@DA@ABA@AAA@@@@A@BA@@@@@@AA@A@@A@@@AA@@@@@A@@AABAA@@@@A@A@@@A@@@AB@@@@@@@B@@@@A@@@@@@@A@@BA@@B@@@@@@@@BB@B@@@@@BA@B@@@@@@@AB@@A@@@@@@@A@A@AB@D@@@@@B@@@@@@AB@@@@@@@@@@A@@@@A@@@@AA@@@@A@@PENF@@@B@@ABBB@@BB@@@@@@@@BB@@@B@@@@B@@@@B@@DAFABB@BD@D@@A@@@A@A@@DCBAB@@CFCA@A@A@@AB@@@@@AA@B@@@@A@@@A@@@@@@@@@@@A@@AB@A@@@@@@@@@A@@@@@@@@AB@@@@@@A@@B@@B@@@@@@BB@@@@B@@@@@@@@@DBB@@@@AB@@@BB@@H@@@BBB@BBDA@@F@@@@@@@F@@@@ADC@A@ABBHBBB@@BBD@DFBBBBBBDDBD@F@BBB@@DBABAB@@B@DB@@BA@B@@B@@A@@@@@@BBB@@DB@B@@@DB@@BBB@FB@@@@DBA@DB@@@AB@@ABB@@@@@A@@@AB@DBB@@@A@BGBADBB@@@B@@@@@BB@@@@@@@B@@@B@@@@@BB@B@@@@@@A@@BBA@@@@B@BBB@A@@BBB@B@@A@AB@BABAB@BGFa@@@CBABE@K@KA@@@@@@CCE@CAGCEAAAGAC@@CA@AAA@@@@@AACAC@@@@@@C@@@@@@B@@@@@BA@@@@@@@@@AAA@@@@@CAA@AAAAA@@@A@A@C@@@@@A@@@A@@@A@@AA@ABAA@@GAC@@@@@@AA@@A@@C@A@ABA@@@AAA@A@@@A@@@@B@@ABA@@@@@@@A@@BA@A@@B@@@@@@@BA@A@AAAAAAAAAA@@A@AA@@@@A@@@@@@@A@@@@@@@AB@@A@A@A@A@ABA@@@@@@@A@CAA@@@@AA@@ACA@@A@@@@@B@@@@@@@@@BA@@@@BA@@AEA@@@@A@@@@B@AA@@A@@@@ABA@@@@@A@@@A@@BADE@A@@@@AAAA@@BACCA@@A@@@@AC@AC@@AA@@C@@@AAAE@A@A@A@A@@@@BA@AAAAA@@A@@BA@@@@BA@@@ABCAE@@@@@@BA@@@ABA@A@A@@@@@A@@BC@@@@@AAA@C@@@A@@@@@@@@@AA@@AA@BABA@AB@@@@@DEBABA@E@C@ABCBABC@A@A@@@A@A@ABA@ABCHBH@BBBBB@AB@@A@@BBB@@B@@ABD@BBB@BA@DB@BBBB@DB@@B@@BBB@@B@B@@B@@BB@@@D@@A@BB@@@B@@@@B@BCB@BAF@BBD@B@DCBBB@D@@BBBB@@@DCDBB@BAAADCFAB@@@BA@ADA@@B@B@BB@@D@B@DAAA@@@A@A@A@A@@@ABE@ABC@C@@@C@I@C@A@@@ABA@A@@BA@A@AB@@CBA@A@@@BB@B@@@@ADAB@FAB@@@@ABAD@B@DA@@DA@@A@@AB@@@@@@@B@@@B@@@B@@BB@B@@@BA@A@@@@@A@@B@@BB@BA@@@A@@A@@@@@B@@A@@B@@@@@@AD@B@@C@@@@DBB@D@DBDDB@DBFBLD@BB@@@B@F@@@@@@@AAAC@AAACI@@DAFA@BH@BA@@B@@@D@@D@@@B@B@B@@B@@BE@@B@@@BF@B@AF@@ADA@@@@@B@FBDBBADABABADCDA@@@@@A@@@CC@A@@A@@@A@C@A@@BA@@@@@A@A@@@@@A@@B@@AAAB@A@BAA@@@@@@AA@@AAAAA@@@ACA@@@@@@D@B@@BDD@@B@@@@BB@@@@@@BB@@@BB@BB@@B@@B@@@B@BB@BBBB@BB@@@A@A@@DDDBB@@AA@@A@A@@A@@@@@A@@A@@@@A@@A@AB@B@D@BABC@@B@@@@@@A@@@@@A@AHA@@D@@C@@B@@@@@@A@@ACBC@A@@@ABABB@@DABA@AAAA@@AA@AA@@@@@AAAAA@@A@@AA@@A@@@AA@@@@A@@@ABA@@@@@A@@@@B@B@@A@ADABAB@HD@@@A@C@@FCDBBADCB@D@B@DAB@FABB@B@@@@FAD@BAB@FD@D@@@@@@@@@B@@ABA@ADA@@B@@@BD@B@@@@@B@B@BBDA@@@ABABA@CF@@B@B@B@FB@BB@@DBBBB@B@BB@@BBB@@B@BB@@@BBBBBB@@@B@B@@BD@B@@B@BAB@B@BBB@B@@D@@BAB@B@BA@BBF@B@D@BB@BFBBDBBBBBHJ@@@B@@B@BB@@B@@@B@@B@B@B@@B@B@@B@@@@@BBB@ABBBAB@@@B@@@BA@@B@D@B@@B@@@BB@@BBDBBBBDDB@@@BB@B@@BB@@@@@@@@@BB@@B@BAB@D@BBB@@DAD@B@BA@A@AA@@A@@@@@A@A@AC@AA@@@AB@@@B@@A@@B@@AAA@@AA@A@A@@@A@@@@@ABC@@@BB@@B@@@BB@B@B@@@B@@A@@@A@@@@@AAA@@@A@AD@B@B@@BB@DBBBB@BABAB@BABABA@ABABADA@@BABAAA@@BA@@BA@A@AB@@CB@@@@@@A@@@@@AB@@@@A@@@@B@@@BB@@@@@AB@BA@@@A@@BABAB@B@@@BAB@BCB@@@@ABABA@@B@BB@@@@B@B@@B@@BDA@@@AB@@@@B@BB@BB@@B@@FB@@D@BBD@@@DBB@B@AA@@BC@@BA@@BABABDDDBBDDD@B@@@D@@@B@BAB@BABCBABABAHGDADAF@BBBB@D@FAHAH@D@D@DBD@BBBDBHBD@D@FAFBB@DBD@D@NED@BADADAD@D@F@DBD@BBHHB@DBDBDBF@D@DAZMHCFALCb@J@F@DAB@BADADABABAFABA@ADCDEBA@ABE@ABE@EACGIGKCEEC[QGEAAAACE@AA@@I@GAG@A@EBA@M@A@E@@@A@A@A@A@@@CBA@AC@@B@@AB@BA@@AC@@A@@E@@@A@AB@BA@@BAB@@A@@@A@A@A@@BC@@@AA@@A@@@@BA@CA@@A@@@A@@@AA@@A@@@A@@@@AA@A@A@@@CDA@CA@@A@@A@@A@@A@@AA@A@@A@A@AAA@@@@@@A@@@A@@A@@@@A@AABA@ABABABA@@@AC@@@@A@@@@@@A@@A@A@@B@AA@AA@@A@CB@@@@AB@@A@@@@BAA@@@BA@AAA@@@AA@@@@A@@@A@A@AAA@@@@@@AA@@@@@@AA@@@AB@AA@@B@@A@@@@AA@@@@@@@A@@@@@@@A@@B@@@AA@@@@@@@@@A@@@@BA@A@A@AB@@A@AB@AA@A@@@@@A@@BCBAA@@@@@AB@@B@AB@@@@A@@B@@@@@AAA@A@@@A@A@A@A@@AA@@@@@AA@@BACC@@A@@AA@@@@@AA@@@ABABABA@A@A@AA@A@@@@C@@@AB@@@AAAA@@B@@@@AB@BA@@@@@@CA@@B@@A@@D@@@@@B@AA@AA@AA@@@A@AB@@A@@@A@@@AA@B@CAA@A@A@@A@@A@@A@@@@@@A@@@@@@AB@A@@AAB@A@@@@@@C@@AA@AA@@@@A@BA@@AA@@ACB@B@@@@AA@@AA@A@@A@AAAB@AAB@AA@@B@@A@@@A@@@ABAA@@@@@A@@@@@@A@@B@@@@@@A@@@@AA@@@@AB@A@@A@@A@@CEAA@@AA@AAAA@@@@@@@@A@A@A@@@ACI@@B@C@A@A@@A@@@AA@@A@@@@@ACBA@@AA@A@@BA@@AA@@@AAABAA@@@A@A@@@@AAA@A@@@@A@AB@@@AA@AB@@@@@A@@A@@C@@@AB@@@@@AA@@@A@@@@B@@@BA@@@@@@B@@A@AA@AA@A@@BC@@BAAA@@@CBAB@@A@C@@@ABABA@A@@B@@A@CC@AA@AAA@@@A@@A@@@AA@@A@@A@@@A@ABAAA@@@A@A@AB@@A@@@CCE@K@@@@AB@B@D@@@B@B@@A@@@@@CAB@@A@C@A@C@A@AA@@A@@@A@@BA@@@A@ACAA@@C@@@A@A@@@@AA@@@@AA@@@AAA@@@@B@@A@AB@AAAAA@@BA@AA@@@AA@@@A@A@A@@@@@A@@AC@@A@@@@A@@A@@A@@CA@AA@@A@A@A@@A@A@@@A@@@@AB@@ABA@@@A@A@E@A@A@@BA@@@@AA@@@AA@A@@A@@BA@A@@@A@@@@A@@A@@AA@@C@@AA@@A@@@A@@@@@A@@@A@@@A@@BABA@@A@@A@AA@AC@@A@@@BA@@C@@ABA@@B@@@B@B@@B@@BBB@BBD@@@BA@A@@B@@AB@@@@AAA@@@A@@B@@ABA@@@A@@D@@@BABA@@BAB@BA@ACC@A@@@A@@AA@AA@@@ABA@@@@AAA@@A@@@@A@@BAA@@A@@@@@@@@A@@@A@@@@@@A@ABCBA@@CECA@@C@AB@A@@@@@C@A@A@@@@@A@@BA@A@AAB@@AC@@@@A@AB@B@@AA@@A@AA@A@@@@A@CB@@A@AB@B@@AB@BCDAAABA@A@@@A@ABAA@@@AAA@A@@AA@@ABABA@A@@@@A@@@@@AB@@@@@BB@@B@BA@@@@AA@@@A@@B@BABAB@@B@@@AB@@A@@@AB@@A@@@@@@@@A@@@@A@BA@@@@@A@@@BA@@B@@@@@@@B@@@A@@A@@@@@A@AA@@@AA@@@@A@@@@@@@CA@@@AA@AA@@@@A@@@@@@@A@@@@BAA@@@@@BA@@CB@@A@@@@@AA@@AA@A@@@A@@AA@@@@@@@AAA@A@@BA@@@@@A@@@AA@BA@@@A@@@AAA@A@AA@@@@@@B@@A@@BC@A@AAC@ADE@AAA@AAA@AAA@@@A@AAC@A@@A@@@@BA@@A@@@@@A@A@@@@BA@@@@@@A@@@@@A@@@@@@B@@@@@@@@A@@@A@@A@@@A@B@@AD@@AAA@@@A@@@AA@@@@@A@@@@@@@@@A@@@@AA@@@B@@AB@@@@@@@@BB@@@@@@E@@BA@@@@A@@BA@@@@AC"],encodeOffsets:[[112056,24489]]}},{type:"Feature",id:"450222",properties:{name:"柳城县",cp:[109.24473,24.651518],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@CA@AA@@@@@@@BA@@@@AAB@@CAA@@@BABACA@@AA@A@EACCCAAAAAACEC@AA@@AAGAAA@B@BCD@B@@E@@@@@@@E@@@CBAAA@AA@@G@@@AA@@A@@B@@A@CA@@@@@@@@A@@@@@AA@@@@@@@AA@@@@B@@@@A@@B@@@@@@B@@@@@@@@@B@A@@BB@@@@@@@@@@@B@@@B@@@@@@ABB@@@@A@@BB@B@B@ED@DA@ABCD@@@B@B@@@BC@C@@AAAEBCB@@@A@@A@@@@@@A@@AA@@@@@@@@AA@@AABA@@@A@@MEOF@@@B@@B@@B@@B@@@@@@B@@@@@@@@A@@B@@@@A@@@@@C@A@@B@B@B@@@@@@@BA@@B@@@@@@@AAB@@@@A@A@@A@@@@@@A@@@AB@@@B@@@@@@@B@@A@@@@@@@A@@B@@@B@@@B@B@@B@ABBB@@@B@@@@BB@@B@@@@BBB@@@@@@ABB@@@@@BB@BAB@BCBA@CBE@@BA@ABA@@@A@@@AA@@A@@@C@@@@@@@A@@C@@A@@BB@A@BB@@ABA@CBCB@B@@@BA@@@@@@B@@BBA@@@ABABAB@B@BCBA@@B@BAB@B@@BB@BA@AB@@@B@D@DBBB@BBBBBB@BABA@@@ABAB@@ABA@ABA@A@AB@@ABA@@BAB@BABAAA@ABADAB@B@BCBADA@ABA@AA@CACCCAA@ABC@C@CAAAAAAC@AAA@ACEGCACAA@A@CAECA@AAC@C@C@A@A@ABICG@ECGIAAEAGBGAC@CB@BAB@B@B@@AFAFBF@DCDA@C@CAaIKAGDABA@IDG@C@CCAEAA@@@@@@CAEC@@A@@@ABCBAB@BAB@@A@ABA@A@A@A@I@C@A@A@AAC@C@C@CB@@@@AD@DAB@@@BA@A@A@A@G@CAA@CAA@A@C@@@C@@@A@@@AB@@C@AAA@@@A@A@@B@@AAC@@@A@@@@A@@A@@@A@@@AA@@BAB@@ABA@AA@@@@AA@@@@A@@@A@A@@AACEAA@@EAC@C@C@C@E@CBA@A@C@A@@BA@@BA@@D@@@D@FABABA@EBABCDELABABABAD@BABABA@CBMCC@C@A@CAABCBAD@BCBA@@@CBAAC@A@@B@B@@@@@BB@@@@@@B@@@@AB@@A@@B@@BBB@BB@@BBB@@@B@B@B@B@BB@B@DA@@@A@C@@A@@AACDA@C@CA@@@DBBABA@ADC@ABE@CB@AAAAAABA@@CAAC@C@AAAA@@@AA@CG@BEDAH@FB@@BBDA@ABAFA@C@EDC@IBI@EB@B@B@@BBB@@BB@A@@BB@@BDD@@CD@BDB@BB@@@@BB@DBB@B@@B@BB@B@@B@@AB@BADADA@AB@BA@@B@BDBBBBB@BAB@@DBDDBDAB@BB@B@BABABB@BB@@@D@BABB@@BBB@@@B@BBB@B@B@@@@AB@@@BA@@@BBBB@BAB@DBHDD@BB@@DBF@BA@@B@B@DBBBB@@BB@@@DB@@BB@@@@BBBBBBB@DB@@DBB@@@@B@B@@B@B@DB@@FBBBBB@@@DAD@DAB@@C@@B@@AB@F@B@BA@ABA@A@A@A@@@@@A@@@A@@@A@A@A@@BBB@B@B@B@@@B@@DB@BB@@@ABB@@BB@@@@@B@@@@@@B@B@@AB@@EBABB@B@@BBBB@BB@@B@BBBB@@BBFBDBB@BB@@B@BBBB@@B@BB@@B@@@DBDB@BB@@B@@@B@@DBB@@@BBBBB@@@B@BB@@BB@@B@BBB@@@B@BB@@@@BA@@B@BA@@BADAB@@BDBB@FDDAB@@@B@B@@@@AB@B@B@DB@BBB@@@@BD@B@@CF@BAD@@@BBB@B@@@B@@@B@@@@@@@BB@BBBDB@@@@@BB@BDBB@@@BA@@B@BB@@@B@BBB@B@@BB@@@@BBB@@@@@EDCBEBGBCDCDADG@ADCB@FADBDCD@DFD@D@B@BABAF@@ADA@CDCBCBED@BCBCBA@EBAA@BBDABAB@@F@DB@BABC@@DA@AFDBDD@DBBBDBBB@B@FADCB@@CF@BAHAB@DB@@FAB@B@DBBB@BDBD@FDIBCBA@C@@BC@C@ABABEBABB@BD@@BD@B@B@@DA@ABAB@B@D@BA@@B@BAB@@@B@@B@@@B@BA@@BABA@@D@BA@BB@@@B@B@@@@@@@@B@B@B@@AB@@@@ABAB@BB@@D@D@B@B@@@BBB@DB@@B@B@B@B@D@@@@@BABBDABBBA@B@BBDBBBB@@@BBB@BBD@B@DB@@@@B@B@B@BB@BBBB@@BF@BB@BBB@B@B@D@D@BDDB@B@BABAB@@@B@@@BDBDB@BFB@B@@A@@BAB@B@@BBDD@@DBB@B@B@@BDB@@@@@D@B@D@B@BB@@B@DB@BBB@BBAB@B@@@BBBBBBB@BB@@BBB@B@BBBD@B@B@B@B@B@BAD@@@@@@A@@BA@@@A@EB@F@BAB@D@@B@@ABBBB@BBFDB@FFBBB@@@BAD@LBJDD@D@F@BB@@BBB@@AB@@@DDBAB@B@@@B@@@BA@A@@@A@@@A@AAA@CAAAA@A@AAAAAB@FEDA@CBABAACAC@@AA@AFG@CFB@@@BBBBBD@@BBBBDBBD@B@@A@@@A@@BA@@B@BAAA@A@AB@@AA@@AB@@A@@B@@@@BB@@@B@B@@@@AB@@AB@@@@@@AB@@@B@@@B@@@DBBA@@@AB@B@BBBBB@B@@@@AA@AC@AB@@@B@@BFBJFJFJHHJDHBJF@D@B@F@B@L@FDF@JBF@FBHDH@H@HBTAFAB@BAB@D@BABA@A@@@A@@@GBA@@BAB@B@@A@@ECAABABABC@@BABD@@DBB@@@B@B@B@@CBAF@@A@@AAA@BCAC@A@G@@@@@BB@B@@BB@B@@@BA@@B@BA@@@@B@@A@@@@@AB@@@B@AAAA@A@@BAA@@A@@AA@A@@@A@@BA@@BA@@B@DBBB@@@B@@B@@A@@B@BAB@@BF@B@B@DABAD@DBB@B@B@@@B@B@@@B@F@BBJ@@ADCBAD@B@AA@@AA@A@CAAACBA@CCCAAB@BAB@B@B@@@B@@@B@@@BB@@D@BAB@@@B@BAB@@@D@BA@BJB@CAADA@CDCA@D@J@^AL@BBFB@BD@@BDBB@BD@BB@FB@@B@@BB@@BDDBADBBBBBB@@@BDD@ADD@DBDFB@BBDBDDD@BBBDBDBDD@@BADB@BBBDDBBD@B@BB@B@B@@BD@@D@@B@BDBB@@@BB@@@B@@@B@BC@A@@@@DCA@@A@@@@@AB@BA@@@A@AC@@CAAAA@AACA@AA@A@C@CAA@@@CB@BAH@B@FBBA@@@@B@@@BA@A@AB@@A@@@AB@BAAA@@B@BAA@@A@@A@BAA@@@@@A@@@@@@A@@@A@@@@@A@@D@@AB@@A@@@A@ADC@@@@B@@AB@@@BC@@@G@@@@AA@@DA@B@@@@@@B@@B@@@@BBBAB@@@B@@B@@B@B@@@@@B@@ABCD@DDBDB@@AB@@ABAB@B@BA@CBAB@@@DAL@BB@@@BFDB@BADCBB@BDBB@B@BBBBF@@@B@BA@@BBD@BA@@AAB@@AD@B@@D@B@@D@B@DA@@B@BAB@BBB@BAB@@BD@B@@BA@HDB@BA@@@@DBD@@BD@D@DBDBBBD@@BB@BBB@B@BB@BA@@BAB@BDB@@BBB@BBD@B@BADC@A@ABABAB@D@@BB@BED@BCBC@ECC@C@ABAAABACA@ACABEB@@EB@BBB@@DBABA@BDAFBAA@AB@@CBA@@BC@AB@AECAA@A@BC@CKCCBAD@@AB@@A@@A@@A@AB@@AA@AA@A@A@A@CA@A@EB@@@BADC@AEC@AA@AAGAA@C@A@@A@CBAB@@@B@@@AA@AC@@AA@CD@@A@AB@@AAA@@@A@@@A@@@A@AAAA@@AAB@B@@@BA@@@@B@BA@AB@@@B@BB@@B@BCBE@A@@@ABAACA@@@B@@AB@AA@@@AA@@@AAA@CA@@@@@A@@A@@AA@AAC@@A@@@A@AAA@ABABA@@@AB@IGEAAAA@AA@@@CCA@@@AB@@@@A@@B@BA@@@ABA@@C@@AA@@A@@ABCBA@@A@@@@@A@A@A@CAACA@A@@A@BA@A@AB@DAB@DADEBA@ABAD@@@@@@@BA@@DABCDA@AB@@@BB@@BABA@@BA@@BA@A@@@A@AA@BABB@@BA@CBABAD@DB@A@@@@B@@@@@@AB@@D@@BA@@@A@@@@@@@@BB@@@@B@@AB@@BB@BABA@@B@B@B@AB@B@BBAD@B@@@D@B@BB@@BBD@B@B@@BB@B@@@B@@ABAD@@@@@F@F@B@@@B@B@BAD@@@BA@@@@B@@AB@@@@A@@@@@ABAB@@@B@D@@ABA@ABA@@@@B@AA@@A@@AA@@@B@@@AA@@@@@@@@A@@AA@@@@@@@@AA@@@B@@A@@@@@A@@A@@@@A@@@@@A@@@A@@D@@AA@@@A@@ABAA@@@AB@@@@@@A@@A@@@@@AB@@@BADBB@@@B@@@B@B@@@@B@@B@@@@@@@@BB@@BB@@@B@@AB@BA@AF@BAB@B@@@BBB@D@DA@AB@@BBBFB@@BC@@@A@ABEB@@ABCA@AA@@@@@@@AD@@A@ABA@@@@DC@AAA@A@A@A@@A@@A@@BA@A@@@@B@B@@AB@@A@@B@B@@ABA@AD@@@BB@BB@B@@@B@@@@A@@@@B@BA@@@AA@@@@@B@@A@@@@@ABB@@D@DABBB@DA@@@AA@@A@AA@DA@C@C@@@C@@AA@A@@BA@@@ACAAAACAA@AA@@@ACA@AAAAB@@A@@BA@@@@@A@@A@@A@@@A@@@@AA@BAC@@A@@@A@@A@@A@@@A@@BCFA@@@@AA@AAAAAAAAA@AAC@AA@@A@@@@@A@CA@@A@GDA@A@@B@@AA@@C@C@A@@@AAA@@A@A@ABA@@@CA@A@@@AAA@@@@@AC@B@A@ABABAD@AA@A@AAA@AAC@AAAB@AAA@@A@CA@DAFC@@B@B@BA@A@@@@@@B@@@BA@@AA@@@A@A@@B@@@@A@@BA@BB@@@BB@@@@B@@@B@@B@@@@@@BA@@@@@A@@BB@@B@@@DABA@@@@B@B@DC@@@A@@@A@CA@A@@AA@@AA@@A@AA@@@@A@@@@@@A@A@@AA@ABA@A@@AA@@@CB@A@@AA@AB@AA@@@@A@AAA@@@@A@A@A@A@A@@@@CA@@AAA@@AA@CAAA@@AAAAAAA@@AA@@@AA@AA@@A@@@AA@AEA@@A@@AA@@@A@@@@@A@@@A@A@AB@@A@@B@BA@@A@@@@@AB@@@@CA@@@@@AB@@@@@A@A@@@@AEC@A@A@@@A@@B@@@@@A@@@A@BA@A@@@A@A@@@@@AAA@@AAA@@@@A@A@@BA@@A@@BAA@AA@A@ABA@@BAB@@CA@@A@ABCBAD@@A@A@AB@B@@CAABA@@A@@A@A@@B@@AAAC@@A@@A@@@@@@@BA@@BCAA@@@@@@B@B@@@BB@@AB@@@AB@@@@@B@AA@@@A@B@@@AA@AAABA@@@@DBB@B@@A@@@@A@A@@@@@BA@@@A@@A@@BA@@@@@AB@@ABAAAB@B@@A@@@@@@@@B@@AAA@@@AA@AAA@@AB@A@@A@@@@@A@@A@@@BA@@B@A@@@@@AA@@@@@B@@BA@@@@@@@@B@@AB@@ACBA@@@@A@@@@B@@@DBD@@@@AA@BBB@@CAAB@B@BADAD@@@D@B@@@B@B@@@B@@ABABABB@@D@@@@@@BBA@@BABA@@@A@AB@@@@AB@AA@@AA@CA@AAAA@@@AA@AA@A@AAAAA@A@AEAA@@E@AD@@CDABA@CBE@AA@C@@@@A@C@A@A@@@AAA@@@BD@@BB@A@@@@@A@BB@@@AB@B@@A@@AA@@@@@A@@@@@A@AA@@@@BB@BB@AB@B@@AA@ACAA@@@A@@@@AAB@B@@BDBB@B@@BB@@@BA@@@@@AB@@ABBBABABA@@@ABCB@@AB@@@B@@@@@B@@A@A@AA@@A@@@BB@@A@@@@@AB@@@@@@@BA@@@EBA@A@A@AC@@AA@B@@@B@@@B@@@@@B@@@@@BA@B@@@AB@@@@B@@B@@@@@B@@@B@@@@@@DBA@B@@@AB@B@@A@@@@@@B@@@@@B@@@B@B@@@@B@@A@@BB@@@A@@@@B@ABB@@BA@@A@@ABA@AA@@@B@B@@BBB@@@AD@@@@@D@@B@AB@@AAA@@@A@@@@@A@A@BB@@A@A@@@A@AB@@A@@@A@@BB@B@@BAB@@A@@@AB@@@BDB@A@@@AB@@@BA@BB@@A@B@@@@B@@@B@@@@BDA@BBAF@@@BB@@@B@AD@@B@@B@BA@@@@BA@@@@@B@@BB@A@@@B@A@@@AB@@BB@@@@BB@@@B@@BB@B@@@@@B@@@@@@@B@@@@@@@@@@A@AA@AB@@@@@@A@@@BC@@@ABABBFDB@@B@B@@B@@@@B@@CB@@@@A@@@A@@A@@A@A@@BCD@@A@E@A@@@AAC@CAI@DFA@BDOFBD@D@BAHBDA@@B@@A@A@@@C@A@@B@B@@A@@@@B@BA@@A@@@@@@A@AAA@@@@@@@@@@BA@@@A@@@@@@@@B@@BA@@@@@@@B@@B@B@@@@@@BAD@@@@ABA@@@@@A@AA@AA@@@@B@@A@C@A@EA@@ABA@@@AAAAA@AB@@A@@ACC@@CBA@AA@@@@@A@@@A@@BA@A@A@@@@A@@@@A@@A@@@@@B@@A@@A@C@@@B@@@BAC@AA@@@A@@@AA@ABC@EAC@AB@ACB@D@@@@B@@BC@ABA@CBAD@B@@B@BB@D@BB@@@@@BA@@A@@@@AB@@@@@@@@@@@@@@AAAA@B@@A@@@BB@@@AA@@AAAB@@BA@ABA@B@B@@B@@@A@BB@@BA@@@@@B@D@B@@@B@BA@@@B@@@@@@@@BA@@@@@@BCAAFA@@@ADAB@B@@BD@B@@BD@BA@@A@AA@@@ABFB@BBB@@B@@@@@@B@@@BB@B@@AB@@@@A@@@@@AAA@A@@B@@B@BBDFCB@BC@@B@@B@@@@AB@@@DA@@CCB@DA@@@@BA@@AA@ABBB@@A@@BBB@BGD@B@@CBCBAB@BA@@@A@KJCDE@@@ABA@EDAAC@@BCDE@AB@B@@AAEDC@CBA@C@@@GI@@@@A@A@ABA@A@EBCBA@A@C@CB@@A@AAC@AAA@C@@@AC@@CA@AA@AC@@FAFEBGFKAI@@ACCECCCAEAIA@A@@AA@@@AA@@@B@@@@A@@A@@@@A@@@@@A@A@A@AB@@@BA@@BAB@AA@AB@@C@A@@BA@A@A@@@A@@B@B@@AB@@@@@@AB@BABB@@B@@@@A@AB@@@@@B@@A@@@@BA@@BBB@@@BA@A@AB@DC@@B@BCDCB@B@BGJ@FADCF@B@BC@@F@@@@AB@BAB@AC@A@@B@BAB@@@@C@A@A@@@@BC@AAA@AAA@@@A@@B@@AB@@@@A@@@@BA@@FC@A@A@A@AD@@@B@@AB@BC@@B@B@@@BAA@@@BAA@@AD@B@A@BAAA@AC@@C@@A@@@A@@AA@@A@@@A@C@@@@E@C@A@A@A@@AA@A@@AA@@@@AA@@@@AA@@A@@C@@@AA@@AA@@@@@A@BAAC@ABABA@@B@BA@A@AA@@@BAA@A@A@@B@AA@@@B@@@@A@A@@A@@@@@A@@@A@@@@@@AA@@@@A@@@A@CAABAHB@@@A@CAA@@B@@@B@@@@AA@BA@@B@@CAB@CA@@@@EAA@AA@@CA@@A@"],encodeOffsets:[[111909,25037]]}},{type:"Feature",id:"450224",properties:{name:"融安县",cp:[109.397538,25.224549],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@ABA@GCB@@AA@C@@AA@ABA@AAA@ABA@@@CBA@C@@@@A@CA@C@@BA@BB@@ABC@AA@@ABA@@@E@AAAAA@A@CA@AAACDABA@EC@A@@AAK@CB@@A@AB@DABA@A@AB@BA@@BA@ACCCC@AD@BA@@@@@A@A@@@@AA@@@A@ABAA@@@@@AA@@@@@@@@ACB@@BB@@@@@H@@AD@@A@@BA@@@@@CD@B@B@@@BA@@BC@@@@B@@@@@B@@@B@@@@B@@@@@B@ABB@@@@BB@ABA@@@BBABA@@B@@@BA@@B@BAB@@A@@@@@ABEAA@G@ABA@@D@@BB@D@D@BBBB@BD@BBBBB@DD@@B@B@@ABA@@B@@@@@BB@CD@@@@@BADA@@@A@@@A@@A@@AAACA@@@@CC@@AA@A@A@@A@AACCAACAAA@BC@AC@ACACACAAC@CCCAAAA@CECAC@BCC@AC@@A@AAAACAABCC@AA@@AA@@@EAA@@AACA@CA@AC@@AEAAAK@]BI@C@B@CD@DCBBB@DIA@AABC@@@A@ABA@@@A@ABC@@@AA@@A@@@A@@@A@A@A@ABA@BBDD@DABBDBB@D@BBB@@BBA@C@ABCD@BI@AAE@A@@@A@A@@@A@A@A@CAC@ABCBA@A@E@@AA@ABA@@@@BA@@@@A@@AACAA@@@AB@@AB@@@B@@@BBB@@@BB@AB@@@BBBBBA@@@A@@B@@@@@BA@@@@@ABA@@@AB@@A@A@@AA@A@@A@@@@@H@BBDADB@BB@@@BE@AB@DA@A@A@@@A@CA@@ACAB@@ADABABBBFD@@@BA@A@AB@@AB@H@@@B@@@BABABC@A@ABF@@DAFBJB@BFAD@FDFDFFDBBBBABBBBD@B@@AB@BABBB@@B@BFAFBBDBDDBDHDBDCBKTDDDB@@D@D@B@@DBB@BB@B@CBB@BBBBAF@H@B@@A@A@A@E@@B@@ADABA@C@@D@@@@H^@B@JBH@FBP@P@F@DDBDBN@LALBH@DBFBBB@L@J@@BD@BHBABCB@DBBB@DBHFBD@@DDB@A@B@HDFDD@BBD@DD@DA@@HABDFF@HB@F@DBBADFDDDBBC@A@EBADCD@BAB@F@DBB@BBFHD@BA@BFF@DFBFFF@BBBBH@@@BAFBFDB@F@DAFCBBBDD@FDH@DEDCDCDEBG@IFEDCH@@FHB@FDJDLBNBJBB@BDB@@F@B@BA@@DA@ABA@@B@BABB@ADAA@B@@BDB@ADCB@@BB@B@@@BD@BDA@A@BBAB@BF@@BEBCFA@@BA@ABAAA@ADA@CBA@EJCB@DBB@BBDABBDA@@@@FBBADDB@BBDD@BAB@B@B@BBB@@BB@B@@@BBB@@BBBB@@@@BB@@@D@A@@@BBD@@BBA@@BBBBDBC@BBBHGFCCCD@DC@AFBBKHA@KB@AE@AACECB@BCBGACBBB@BAFCDAB@BCDB@EHCBCACBA@ABE@CB@@@@BFBHBD@@B@F@FFTDHDFBDCD@FBD@F@BBB@BB@@BFGDFHBHBB@BHFB@DFJ@LBJH@F@@DJ@@@@@@BBA@DB@B@BD@BFFDB@DDBFHHBBBBF@@B@BBFDBDDLF@BAFDJBBGBGJBFIBCCCBCEGAAFMHMNAHCBBBA@@@@@FDBBNJHFH@CZCF@HAFAJCHFD@BFDBBFFL@HARBJC@DHDH@PA@GH@@BHF@DBDJDJCL@HCFA@A@A@EBEHA@ADC@ABO@@@@FDFFFA@BBDB@B@BABBFEB@F@BA@@D@BBDABDD@@BB@D@D@BBBBB@BBDB@BBFBBBBCDBBFD@BAB@@A@AB@B@BAB@BBBBB@DDBBB@BD@@@BB@DBBABBB@B@B@BBDBBDBDDABABABABDBBBBBD@BABBBABDBBDBDBB@@B@BEH@BA@@B@DAB@BBBDBBBDDDBFDA@@B@BDD@BBB@FFDBBBDB@@D@DDB@DAB@D@BBDD@@BBBFDD@BD@BBBB@BBBDABBBABBB@@@BB@B@@AB@@@D@DBD@B@D@F@@@FBD@DAD@@BB@B@@@BAB@@@B@BBB@B@@BBDDD@BBB@@@BB@DBB@@BD@BBBBB@BH@D@H@@BBBFDADA@A@ABABABABAB@@B@BB@B@@@B@@AB@@@@@@DBBDB@DBBDBB@D@@BD@BBBBB@BDBBBBB@D@B@BBBBDDDDFBBDDBBD@B@B@BBD@BBD@D@BBDB@ABBDBBBB@BBB@BF@FJBDDFFDBBBA@C@A@AACHA@@BABABGB@B@@B@@BB@BB@@@BB@@BB@AB@B@@AFD@@BB@A@ABBBBBBBB@@DB@ADBBB@BDBD@DB@BBFDA@A@E@C@@@AB@D@B@B@B@B@DBDBBBBBB@B@@BDBBAB@DBD@B@B@BBD@D@D@@ADCBBDAD@D@B@BB@B@@@BB@@D@BBBB@DD@@B@@BD@@BBBBABDB@B@BBB@@B@A@@@@@@@A@@@AA@@@@C@@@A@@@A@A@@@A@A@@@@B@@A@@BA@@BA@@@@@@A@@AA@@AA@@A@A@@@@A@@A@@@A@@@A@@AA@@@A@@@A@@B@@A@@AA@@@@BA@@@@@@B@@@@@B@B@B@@AB@@@@AB@@B@@B@@@BABA@@@@B@B@@@@@@@@@@A@A@@B@@@@@DA@@B@B@@@B@@AB@@@@@BEB@DAB@@AD@BAD@B@B@BBBAD@@@BA@C@ABA@@@A@@@ABA@ABGBCBEBC@A@BA@@AA@A@@A@@BA@C@ABA@@BA@ABA@@A@@AD@AC@@@AAAAAA@@@@@A@A@@AB@@@A@@AA@A@A@AB@@@A@A@AAAB@@A@@ACB@@AB@@ABA@@B@@ABA@B@A@@@A@@@AA@@@@AAAB@A@@A@@@A@@@@AC@@A@@A@AAAAAB@A@@A@@@AA@BAA@@@C@@AAA@@@AA@@@A@@AA@@@@A@A@@@@@@@A@@@@@A@@B@AA@@A@B@AA@@BAB@B@@@@A@A@A@@@@@A@@@AAAA@AC@@@@@@@@@@A@@@@@A@AA@@BA@@BA@@@A@@@AB@@AA@AA@@@A@A@@A@BA@@@@AA@@BC@@@@AA@@@@@A@AB@@@B@B@@A@A@@@C@@BAB@@AA@B@@A@A@@@@@A@@AC@C@@A@@@AB@@AAA@A@CAAB@@A@@@AAAA@BAA@AA@@@@@@B@@A@@@@@@B@@@@@B@@AB@@@@A@@@BB@@A@@A@@AAA@@A@A@@@A@A@@BAB@@@BBB@B@@@B@@BB@@@B@@A@@@@B@B@@A@@B@BA@@B@@@B@@@BA@@BA@A@A@ABA@@@C@@BA@A@@A@AA@@@@A@@@AA@@@@@A@@@@A@@@@A@AB@@A@A@@@@BA@@@A@@@A@@@@A@AA@@A@@@@AAA@@BA@@A@B@CA@@@@@BA@@C@@@@A@@@@A@@@@A@@A@@@AA@@BA@@A@@AA@@@@@A@@AABA@@@A@@B@B@@AB@BA@@B@@@DC@@A@@@@@A@@AB@B@@@B@B@@@B@@@B@@A@@@@@A@@@@@@A@@AA@BA@@AA@@@@@AAA@@B@B@@@BC@@@@A@@@C@A@A@@BAB@@CB@@BB@@@DA@A@A@@@@@A@A@@@C@@AA@AA@A@ABABAAAAA@@@@B@B@BA@@@A@@AB@@@@AA@@BA@AA@ACDAAAAABE@A@A@AA@AEA@@CDCACBA@AB@@A@A@ADA@AD@@A@A@A@AAA@AA@@@@@@A@@A@@A@CA@BA@A@A@@@@@A@ABAAC@A@@@A@A@AA@@@@A@@@A@A@@@@@AAAB@@AAA@A@@BA@@B@@@@@B@@A@@@@@A@@@@@A@@@@@@@@@@BA@@@@@A@@@A@@@AA@@AA@@A@@@AA@A@@A@@@A@A@@@@@A@@@@@ABA@@@A@@@@@A@@@AA@AAAA@@@A@@@@AA@@A@AA@B@@AA@@AA@@@C@@@AAA@A@@AA@A@@@A@@@@@ABC@@@@@@A@AAA@@@@AAAB@A@BAAA@A@@AAAEA@@AA@@@@@@@BABB@@@A@@@@A@@@@A@AA@@@AA@@A@@@AA@@@AAA@@@@@A@@A@BA@@AA@A@@A@@@@A@@@@@AAA@@@@@A@@@A@@AA@AA@@@AAA@@@@@@C@A@AAAAA@@@@A@@B@@@BA@@@@@@A@@AA@AAA@@BC@@B@BAB@@@AC@A@A@A@AAAB@AE@AAAAC@A@@@CB@@@AA@@@@CA@AA@A@AA@@@AA@BAAA@@AA@@@@AEA@AAA@@@@AA@AAC@@AA@@AAA@A@@@ACC@A@@CA@A@@@@@AAA@AAAA@ACA@A@AA@CAAAAA@@A@@A@AA@AA@AA@AA@AA@@AABAA@AA@@@@AACA@@@BAA@AAB@AAAA@A@@@A@@@A@A@@@A@AAAAA@@@AAC@@BC@ABA@@@A@@@CBA@@@@@@CCAAAAA@ACC@AAAFCB@AABA@ABA@A@CBAB@@A@@B@A@@A@@@AACAA@A@@@C@@@A@@@A@AB@@A@@@A@AA@AA@AA@@@AA@AA@@AA@@A@@@A@@A@@A@@@AA@@AAACAAE@@@A@@@ABABA@@@A@A@A@@BA@@D@@@BA@@@ABA@A@@BA@@BA@@BC@@B@@ABA@ABAB@DA@@B@@AB@BABA@A@@BA@@@@BA@@@A@@@@@AB@@@BA@@@@@A@@@AAA@A@A@A@@@@@@@A@@@A@@@A@@@C@@@A@C@@@A@@@@@AA@@ACA@@B@@A@A@@AA@@A@@@@AA@A@@@@AAAAA@AA@@@A@AA@@BC@AAA@@BA@A@@@@@@@@AA@@@@@A@A@AAA@A@@@A@@@A@A@C@C@AA@@A@@@AA@@A@@@AA@AA@@@A@AB@AAB@@A@@@ABA@A@@@C@@@AA@A@AAA@AA@@AAA@@@@A@@@@@A@@A@A@@AA@BA@AB@AA@@@A@@@A@@@@A@@B@@AA@@@@@AB@@AA@@AAA@A@A@@B@@@D@B@@BDB@@D@B@B@B@@AAA@@@A@@@A@@@A@A@@@A@@@A@@@A@E@@@C@A@A@@@A@@BA@A@@BAB@@@B@@@@@@AAA@A@@AAA@@@AAA@@A@@BA@@@AA@@AACB@B@B@B@BAB@@@DAB@@AAA@A@@A@@AA@A@AAE@@@CBABAB@BA@CBCBA@@BAA@@@A@@AA@@BA@A@AB@@AAAA@C@@BA@AAAC@AA@AD@BA@@C@A@@ABA@@@@@@@A@@@A@@@@@@AAA@A@AAC@@@A@AAA@@@A@@@A@@BCB@@@@AAAA@@ACEBCBABADCBABB@A@A@@@AAA@@@@BA@@@AA@@AAA@C@@@@B@B@@A@@D@@@@@@@B@@@B@@A@AB@@@@@@A@@@@@@B@@B@@B@B@@A@@@@@A@ABCB@@C@@A@ABA@@AA@@@@@@AE@@B@B@@A@@@@B@@A@A@@AAA@@C@@@AAAC@@BA@CBA@AAC@A@ABA@@AA@@@@@AD@@@B@ACA@BA@@@A@@@A@@@@B@@@@@D@BABA@@@A@AB@B@@@@@B@@A@A@@@A@@@@@@A@A@@@@@@@@@BA@@@AA@@@BA@A@G@EBK@@@AB@@A@@@@BC@AAAAAA@AA@@A@AB@@A@A@ABBA@A@@@ABC@@HBDEBA@ACAACCAAAA@ACCEDCJD@ADC@AAACAA@@@AC@CC@AABC@C@A@A@CC@A@@@@A@CBA@ABACA@A@AB@B@@@@@ACBAA@@A@AA@A@@A@@BA@@@AA@A@@@AAC@AAAA@@A@@B@BA@A@@@AB@@A@@A@AAA@AA@@AAC@@AAC@CB@@A@@A@@AB@@@@@@@BBB@@A@@B@@@@A@@@@AA@A@A@CA@@@@@@AAA@A@@@A@@@A@@B@@@BA@A@AA@@C@A@AAAAAAC@ABA@C@A@CCCACACGCG@@AC@A@E@A@ABA@ACC@A@ABEACAAA@@CAA@ABCBA@@BA@@@ACA@C@CBA@CBA@A@@@@@A@@@A@A@@@ABA@@BABA@C@AAA@AA@@BA@ABAB@B@@@@ABCB@@CAA@A@A@AA@@@@A@A@@A@@AA@AA@AAEA@@@A@A@@@AAA@A@A@@AA@@AACBA@EB@@AB@BA@A@A@@@A@A@@@CA@@ADC@AFA@@AC@A@ABAB@B@BCDABA@C@AAA@AA@@CA@ABA@AB@@AAAA@A@AAA@@AC@AACACAC@C@@AC@CA"],encodeOffsets:[[112116,25350]]}},{type:"Feature",id:"450223",properties:{name:"鹿寨县",cp:[109.750638,24.472897],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@@BA@A@@@AA@@@@@A@A@CB@@@@@A@A@A@@@A@A@C@A@A@@@@AACAA@C@@AA@@CBA@AA@BC@A@@@AAA@@CB@@A@AA@@A@@@A@@@AA@@@AA@@@A@@@E@@@C@AAA@@@EAE@C@A@ABA@C@@BA@AB@@A@@B@@AA@@E@A@ABA@A@CBA@AB@@A@@@@@@BAD@@@B@@@@@@BB@BAB@@@@@B@@@@@B@B@BB@@@BB@@@@A@@@@B@B@BAD@@@@A@BBAB@@@@A@@B@@AB@@@@@@B@@A@@@@@@B@@DBB@B@@@B@BA@@BB@@@B@@@@@@B@@@D@@AB@BAB@@@@@@BB@@B@@AB@B@@AD@@@D@B@@@B@D@@@@@@ABBF@B@@@B@BCB@@@DB@BDA@@B@@@DBD@@@B@AD@BAF@@A@@@@B@@AD@@@@@BA@BB@@AB@BA@@@AB@@@B@@BB@@@@@@@B@@@@A@AB@@A@@@@@@BAB@@@@B@@@@B@@@@B@@@@B@@AB@@@@@BA@@@@BA@@@AAA@A@@@CBA@A@@BA@@B@BADA@A@ABA@AA@@@AA@@@A@@AA@@@@@@AA@@@A@@@AA@@@@A@@@A@@@@@@@@@@B@B@@@@@BA@@B@@B@B@@BA@@@@@A@AA@@CA@A@A@@@@BA@A@A@@@@@@@@A@AB@@A@A@@@@@A@@@@@AA@@@A@@@@@A@@@@AA@@@@@@@A@@A@@@CAA@A@@AA@@A@@@A@A@AA@AAAA@@@@@ABA@AA@@@BA@@@@AA@C@A@AA@@@@@@@AB@@@BA@@@A@@BA@@AA@AA@@C@@B@@CB@@A@A@@@A@A@A@A@@@@AAC@@@@A@ABA@AB@@A@@@A@@@A@E@@@AAA@A@@@@@C@@@@@A@AAA@@@@A@ABE@@B@@A@A@@@C@@@@@@@@B@@@BA@@@@@A@A@@AAAA@@@@A@@A@@A@CA@@C@@@A@AAC@AAAAA@A@AB@@A@@@ABABA@AAA@A@A@A@ECCCCCA@@A@CA@A@@@@BABADAB@@@BCB@@@B@BC@ABA@A@AAA@A@ABC@@@AAA@@A@@@ABAAAB@@@B@@@A@@AB@@AA@BCBA@@@A@@AA@@@@@A@@A@@@@A@AAB@A@A@@@@@A@@@@@A@@@AB@@@@@@@@AAA@A@@@AA@@@A@A@AA@@AAAAA@A@C@C@@@A@A@@@AAA@@A@@CAA@AA@A@A@A@@A@@ABC@AAA@@@@@C@A@@AA@@@AA@AAC@A@A@A@AAA@C@C@AAA@AA@@@@C@AB@@@AA@ABA@AB@BABAB@@GBCBACA@CE@@A@HJBD@FAF@BAF@BABCFCD@BABEBABABCBCBABA@CBE@I@a@KDEBGDYNCBC@E@CACACAA@GGAAC@CAE@C@C@CBCBABC@MFC@C@CAA@EAEBC@C@GACAAA@AAC@C@C@CBGBG@E@CAAAAE@CBCBGHABABABADABA@ABA@@@C@@@A@C@CCAACCACABAB@@AB@@AD@@BBA@A@CA@@C@AAC@@@EA@@@AA@@AAAA@@@@@BA@@B@AC@@@AA@A@@@@@AAA@@@ABAB@B@@A@ADA@AB@@A@A@ABAB@@@B@@ABA@@B@@@@AA@@A@@@@@@B@@A@@B@@@@@B@@@@A@@DA@@B@BAB@@AB@@BBABAB@@CBABAB@BABABABA@ABABA@AACAA@@AA@A@C@@B@B@@BB@B@@@@@B@@@BA@@@A@A@A@@A@@@AA@@A@@AD@B@@@@@B@@@B@BBB@@BB@BA@@@@BA@@@A@@B@@BBD@@BD@BAD@B@B@B@FAB@B@BDDD@BB@@@@BB@@BB@@@BBBBBB@@B@@B@B@B@@D@B@@@@BB@@@@B@@@BBB@B@DB@@B@@B@@B@@@@AD@F@DAD@BAB@@@B@@@B@@@B@@AB@@CBA@A@@@@@A@@BAB@@@B@@AB@@ABA@@@@BA@AB@@@@BD@@@BB@@B@@BB@B@@@B@@@@BB@@@BB@BBB@@@DB@@@FAD@@B@B@D@@@@@@@@B@B@@@@@B@@ABBBA@@@@@@@BB@@B@BB@@@BB@BB@@@@BB@@BB@@BBB@@BB@BB@@@BD@@BB@FD@@B@@@BABAB@BABA@@B@@AB@@@B@@@@@B@@B@@@@B@@AB@BABB@@@AB@@@@@B@@@@A@@@@@@BA@A@A@@B@@@B@BABA@@@@BA@@B@@@@@@@B@@@@@B@B@B@@@@@B@@@@A@@@@BBBAB@@@DB@B@@DA@ADA@ABA@A@A@@AA@@B@@@BA@BBA@@@@B@@@B@@BBA@@B@B@B@@@@@B@B@@A@@BAB@@A@@B@@@B@FB@@BB@@@@@@B@@@@@BAF@@@@@@ABAB@@@B@@@@B@@@B@@@@@@B@@BBB@@B@@BB@@@@@@AB@BB@@BB@A@B@@@AB@@@@@@@@BBA@@@AB@@AB@@A@A@A@@B@@@B@BABA@@HC@BBBBBD@B@BB@@@@@@BA@@A@AE@A@@A@ACAA@@AA@@@@AA@@@@@A@AB@B@@@@B@B@@@BB@BB@@B@B@@@B@@ABBBBB@BB@@BB@@@B@@@@@B@@BA@A@@B@@@@B@@B@@@@@@A@@BB@@@@@@BB@@@B@@@@@@@@@@@@B@@@BB@BA@A@@@@@A@AAA@AB@@@BBB@BBD@@CB@@A@AA@@A@@@@@A@@@A@@@B@@@@D@B@@A@ABA@@B@BA@@B@B@B@@@B@@@@@@B@@@@B@@B@B@@BB@BBB@BAB@B@BB@@B@@@@BB@@BBB@@@@@BD@@@B@D@BBDBB@B@BB@@@@B@B@B@BB@@@@@@BB@BAB@B@B@@@@A@@B@@A@@@CBA@@@BB@@A@@@@@@BD@B@B@@@@@B@@AB@D@B@B@@@@@AB@@@B@@BB@@@@@@@B@@@@@@@@BB@B@@B@@B@@BAB@@@@@DB@@BA@B@@@B@B@@@@@@B@B@@@@B@@B@@D@@@B@@B@B@@@BB@@@@B@@@@@B@@@B@@ABB@@BAB@@@BAB@@@@AB@@AB@@@@B@@B@@@BBB@@@@@@A@@F@B@@@@BB@@D@@@@@BB@@B@BBB@@BBBBBB@@ADAFAB@B@BBDBB@@CBA@@@A@@@@@@@AB@@@@B@@@B@@@@@@@@BA@@@@@@B@@@@@@A@@@A@@@A@@@BB@D@@AA@@@@AB@@@@A@@@AA@@@@@@C@@@@@@BB@@D@@@BB@@@@@@@C@@@@BA@@@A@BDBA@@@@@@BB@@BA@@@@B@@B@@@@@@@@AB@BABABA@@@@@A@@@@A@@AA@B@@@B@@@@@@A@@@BA@A@@@@@@@AA@@@@BA@@@AB@@@@@@@@@@A@@AA@@@A@BB@B@@B@@@A@@@BB@@@@BB@B@@A@@@@@@B@BA@@B@B@@C@@@@@AB@@BB@@B@@@@@@B@@@@@B@@@@AAA@@BA@A@A@A@@@AB@@AB@AA@A@A@@@@BAB@@@@A@@AAA@@A@@@@F@B@B@@A@@@AA@@@@@@A@C@@@@A@@@@@A@@@@@@@@A@@@ABA@@@@@@A@@@AA@@@@@@@A@@@@B@A@@@@A@@@@@@@@B@@@@@@@@@@A@@B@@@@@A@@@@@B@@@B@@AB@@@@@@@@A@@@AA@@@A@@@@AB@@A@@@ABAB@@A@CBABABA@ABA@@@@@@B@@A@BB@B@@A@@B@@C@@@@D@B@@@B@@@B@B@B@@@BBB@@@BB@@@B@@@ADAB@@@@@BBB@@@BBBB@@B@@B@@@B@@@@@@B@@A@@BA@A@@BA@A@@@@B@BAB@@@@A@@@@B@@@B@@BB@@BB@@B@@@@B@@@BBBBD@@B@@BBB@B@@@BB@B@@BDDB@@BB@B@@@B@@@@@DDBB@D@B@B@BDDBBB@@@@@B@@@B@BBBBB@@DB@A@@B@@@B@@@BBB@B@@@@@B@@ABA@@BB@BB@@@B@@ABB@BA@@B@@BB@@@@@@B@@@@@B@@@B@@@B@@@@@BAB@D@@AB@AA@@A@@@AA@A@A@@@@@AB@@AB@B@@@B@@@@AB@BC@@B@B@@BBAB@@C@@@AB@@@@@@@@@BA@@@BB@BB@@@@A@@B@@@B@B@@@@B@@@D@@@@A@A@@BA@A@AD@@@@ABCD@B@@@@AB@@AD@@@@B@BBBB@@B@@B@@@B@@@B@B@BABCD@B@DBB@B@BBB@@B@@B@B@DBB@BB@@B@@@BBBBB@BFD@BABCB@@CBC@A@ABAFAD@@ABCC@BCDCDCBA@A@A@A@@BADEDCCAACDABABBBGBG@ECAAAACAAAECE@CCADA@C@@@CBA@AACBC@@@AA@B@@@@@BA@@@@@B@@B@@ABA@@@@@@B@@A@@@A@A@@AAA@@C@@BAB@BA@A@@@@BA@@BA@A@@@@@@BAB@@@BB@@@@B@B@BBB@BCD@@@@AB@B@BC@@B@@@@@@BBB@AD@BA@AF@B@B@@AD@@EAAA@AA@@BCBC@A@AA@@A@A@ABE@@BABA@@BA@@@A@@AA@@A@@@@@@A@@@@A@@A@A@@@A@@@A@CAAB@@A@@B@@@@@BB@@@@@@@BA@@B@AB@BB@@@B@@BC@@@@B@@@B@@@@@B@@B@@@@B@@@@@BA@@@B@@B@@@@@@B@@BB@@@@@@@@@BB@@A@@@B@@BB@@@BBA@@@@@AB@BAB@BC@A@@@A@AB@B@@@@@B@@A@@BA@@@@@AB@@C@ABA@A@@@A@E@E@@@@@C@AB@BA@@@A@A@@AA@A@C@AA@@AAA@C@@@A@C@AB@A@ABAA@A@A@@@ABABA@@AA@@BA@@@@@AA@@@@@@@@@B@@AB@@@CA@@B@@@@A@@@@@@BCAC@ABAB@DAB@@AAABB@@B@B@@@BAB@@AB@@ABAB@@AA@@A@@BCBADCB@@AB@@@@@@C@AB@BABCFCBA@CBA@@B@BABB@@@@BDBBB@D@B@B@B@@@@@BB@DABA@@@BB@@BD@@@AB@B@@ABA@@@@B@@A@@B@@DB@D@@BBB@BBFBJHA@@B@@ABAB@BBB@B@B@@@BD@BBB@@BB@@@@B@@@@DBB@BB@@B@@B@@BBA@@BA@@@B@BDAB@B@@@BAFADA@@@AAA@@@A@@BABA@@@@@AB@@A@A@BB@@BBBBB@@@B@@@B@@@B@BB@@BAB@@@DCB@@BD@@BBB@@A@@@A@AB@D@BB@D@B@HBBBB@@BFD@BCDAB@@A@@F@BDBB@B@B@B@@BBB@@BAB@@@@BB@@@BA@@BCDALD@DADB@B@DBBFA@@BAD@@AB@DA@@BBBEACB@AABAB@CA@AAA@@FA@AFDB@BDBABBBAB@B@DDD@FAD@@DB@@B@B@@@B@B@B@@ABA@@FAB@DABB@@BB@@@B@BBB@B@@@B@B@@FBBBB@@BBB@@@BB@B@@@@@BBB@B@B@DB@@DABA@@@@@ABABAB@@AB@@BBB@B@DABAB@@AB@B@@@B@B@@@B@@@@@BAB@DAB@D@DDB@B@@AB@@ABAD@BBB@DB@BBBDAF@B@BDD@BAB@B@B@F@BBD@@DHDHDBDBDDB@D@B@BAD@BBBBBBB@D@@@BBB@B@@A@@@AB@@@B@@@B@B@BB@@@@@@DBB@B@B@@B@@B@@@@@@AB@@@AA@A@@@@@@BA@@@BB@@@DAD@BB@@BD@BB@@BBB@B@BB@@@BA@@B@B@@A@AB@@@BBBBD@BB@@B@B@@B@@BA@@DBB@BAB@B@@@@BB@B@B@@@BABAB@B@@@@ABAB@@@@BB@@@BD@@@BD@@BBB@@B@@@@@D@D@B@BBHABAB@BABA@AB@B@BB@BBB@@@@BB@@B@@@@B@B@DBB@@@BBB@@@B@B@D@@@@ABCBA@@BA@@@CB@B@B@@@DF@D@DBB@@@B@DE@AB@BAB@B@B@B@D@@AF@B@B@D@D@B@D@@AD@@@@A@@@ABAB@@@@@DB@@BBB@@@BAB@B@@BB@B@@B@@BADCBABABABAD@D@D@B@B@D@B@@@@AA@@@AA@AC@BA@A@A@AB@@BDA@AB@B@D@DAD@BBBADD@@AFB@D@B@@@@@B@@@@CBAAAAEBA@@@@B@B@@C@AAA@@B@B@BAB@BA@@@@BB@@BBBAB@B@B@DC@@B@B@AA@A@@B@B@@@B@@@@@BBBB@@BABA@@BB@B@B@@@@@@@B@@B@B@B@@C@@BA@ABADA@@@@B@@@@@@ABBBA@@B@B@B@@@@B@@@@B@@@@B@@D@@@@@@@BB@A@@@@B@@B@@@@B@AA@@B@B@@@@@@@BB@@BA@@@A@@BB@@@A@@B@@BB@@@@@BAB@D@B@B@@@D@@@B@@@B@@@B@@@F@@A@@@@BAA@BA@@B@@@B@@@B@BAB@@AB@B@@@@AD@DADA@BFB@BBB@@@BB@@F@@@@@@@B@BB@@B@@BD@B@DD@BCB@B@@@BAB@@@BBD@@DB@@@B@BAB@@@B@B@@A@@@@@@BB@D@@B@@AB@B@@@B@@B@@DB@BB@@@@A@AB@@A@@@A@A@A@C@A@A@A@AD@BCD@@AB@BAB@@ABA@A@@@@@@BA@A@@BA@@BAB@@ADA@@@@B@BBB@@@B@@@B@@@B@B@@@@@@@BB@@@@B@@B@@@A@@@@@@@A@@@@@@B@@A@@@@B@@BB@@@@B@@@B@@@@BAB@@BB@@@@@@BBA@@@@B@@BB@@@@@@B@@@@B@@@B@@@AA@@@@B@B@@@AB@@@B@@@@@@@B@B@@DA@@@BA@@@@B@@@@@@@@@@B@@AB@B@@ABA@@@@@@BAB@@B@@@@@@B@@A@@BB@@@A@@@@B@B@@@@@@@@@@@@A@@B@@@@@B@B@D@@A@C@@B@@A@A@A@@@A@A@ABA@A@CB@@AAA@@BCBA@@BA@@B@@@B@@AA@BA@@AA@@AAAA@@@@A@@AA@@@@AA@A@AA@@@AB@@@@A@@@@A@@@@@A@@@@A@@@@@@B@B@@@@AD@@@A@AC@@@C@@AAABAA@ADB@@@A@@B@@@BC@A@E@AB@@B@@@AB@@@@@BB@@@AB@@@@@B@@@@@@AA@@@@@@@B@@@@A@@BB@A@@@@@A@@B@@@@@@@B@@@@BB@@B@@@A@@BB@@@AB@BA@A@@@@@@@@AA@A@A@@@AB@@ADA@@D@@AB@@@BB@@@@BDBBBB@@B@@BB@@@BB@@H@@@BAB@B@D@@@BAB@@ABB@@@B@@@@@@B@@@@@B@@@@BBAB@@@B@B@@@@DA@@B@@@@B@@B@@@@@@AB@@@@A@@B@@@B@@@BB@BBBBB@@@B@@@@BABB@@@@B@@@@B@@@DB@@B@@B@BB@@BB@B@@@@A@A@A@@@A@AAA@ABA@A@@@@@BB@@@@@@ABA@@@AB@BC@@BCB@@@@@@@B@@@BA@@AA@@@@BA@A@A@A@@@@@@@A@@@A@@@AB@@A@A@@@AAA@@@A@@B@@@@A@@@@@AA@@@@A@@@@@AC@A@A@@@@CBC@AAA@CA@A@@AACBA@AA@@AA@@C@A@A@A@AAA@CACB@AC@A@A@AB@B@B@D@DBD@D@B@BAB@@AAE@@BCB@DABABAB@@ABAACB@AA@CAAA@BABABAB@BA@BB@@AB@@@BB@@B@@@D@@BBB@@B@@@BA@@@AB@@@@@B@@B@@BA@A@@@@B@@@@@@@BB@ABB@@B@@B@@@B@@@BB@@B@D@@@BB@@@A@CDA@A@@@@BB@D@@@B@@@B@BBB@B@@@B@BB@@DA@@BA@@@A@@@@@AB@BA@CBA@A@@CACC@ABA@A@@BA@AA@@@@A@A@@@C@@@A@@@A@@BA@@BA@@@C@@@A@@BA@@AA@@B@@@@A@A@A@@B@@@B@@@B@@@D@@@@A@A@@B@B@B@DCB@D@BAB@@@BA@@BB@BB@BBB@@@BBB@D@B@@@B@@@BAB@DABA@ABABC@A@CAA@A@@BC@@@@BAB@@AB@@AF@B@BBD@BABD@@BBBBFB@@B@BB@@@@B@B@BBB@BAB@@BBD@BBF@@@B@B@@@@BA@BBAB@@@@AFA@@BAB@@A@BB@@BB@B@@A@@B@@BAB@@AA@B@@@B@BB@@@@@@@BBA@@BBB@BB@@BA@B@BB@@@@CB@B@@B@B@@B@@@@@@B@@@BBA@@BB@@@B@B@D@@@@@@A@@@A@@@AB@BABA@A@@B@@@@@@@BBB@@A@@BB@@@@B@@A@@@@@@@A@@@@BA@@@AA@@@AB@@@@@AA@@AB@@AB@@@B@@BB@@AB@BA@@@A@@B@@@@A@@A@@@@@@ABA@@A@@@@@BA@@@A@@A@@A@@BA@@@@@@BA@@@@B@@@@@B@@@@@B@@@B@@@@AB@@@B@@@@A@@AA@A@@AABAB@@A@@@@@AB@@@B@@@D@@@BA@@B@AC@AA@@@@A@@@A@ABA@@@AB@BB@@BBB@@@@@@A@@@@BAB@B@@@B@@B@@B@@D@@B@@@BBB@@@BB@@@@@@@ABBB@D@@@@BDD@@@B@@BBBABAB@@@B@BA@@@@@@B@@@B@@@BA@@@@@@BAB@@AAA@@B@@A@@@@@@BB@@BBB@B@@@B@BA@@@A@@@@BA@@@A@@BA@AB@@@B@@@B@BB@@@B@B@B@@@@BDBBB@@@@A@@@@@AB@@BD@@AB@@@@@@AA@BA@@BA@AB@@ABA@@@@@BB@@@@@@@HB@@BA@AB@BB@@@A@@@AB@@@@A@@@@@@BABAB@DBB@@@F@@B@BB@@BB@D@@BBB@BB@@@BB@@@@H@@@BA@@@ABCB@@@BC@A@A@A@@@ABA@@BAB@CABAB@ACBAAC@AA@@@AAE@CCICCDG@EACAAE@C@EDAFA@A@A@@BA@CA@@CDEC@@AAA@A@@DC@@BCB@@CBA@CFAACB@AC@ABAACAA@C@A@C@ABA@CBCB@@ACGBABA@A@ABAB@@AD@@ABEAAAADAB@@A@CBA@A@CCCA@AEA@@AC@AA@CCA@AC@A@ACC@A@A@ACACA@CAC@ABE@@A@AA@CA@CD@@AA@A@E@@@DA@@C@A@CBA@CA@A@ABAB@@AC@@AB@B@@@B@@@B@@ABCB@@C@AA@@A@@@A@@B@@@AA@@A@@A@A@@AA@@AB@@@@BE@ABA@@@AA@@@A@@A@@@AA@AA@A@@BABA@@@AB@@A@@B@@A@@A@@@@@@A@@CA@@@AA@@@@AA@@A@@@A@A@@@A@A@@BA@@@A@@@ABA@A@@@@@A@@@@@@@@@@AAB@@A@@@AAA@@@@@AACAA@AA@A@@@@A@@@@@A@@A@@A@@A@@AABAA@@AB@@@A@@@AA@@@A@@@@A@@@A@@A@@@@@A@CCA@@AA@A@@A@@A@@@A@A@@@AA@@CB@BA@A@@@AB@@A@@@A@A@@@@BA@A@@@A@A@C@@@AA@@@AA@@@@AAA@AA@CA@@A@@@@BABABA@AB@BA@@@@@A@@BA@@BA@A@@BA@@B@BB@@@A@E@A@A@A@AAAA@@A@@CAAA@@A@AAA@@@@BA@A@@BC@C@@@AB@@ABC@@AA@AAA@AB@@A@@BAB@BA@ABA@@A@AAA@@AAA@A@A@@@A@@@@CA@@A@A@@@@AA@C@@@@A@@A@@AAA@@A@@AB@@AB@@@@A@@@ABA@@AAA@@@@A@@A@@AA@@@A@A@@A@@@AAA@@AA@@@AAA@@@@@AA@@@@@AA@AA@@@@@@A@@@AB@@A@@A@AA@ACC@A@A@@@@@AA@@A@A@@AA@A@@@A@C@ABA@@AAAA@@@@@@AA@@@AAA@AB@B@@@@A@@@@@A@@@AB@AA@ABA@@B@@A@@AA@@@@@A@@@A@@@A@@B@BA@@@@A@@A@@@@@A@AB@@@A@@@@@A@BAAA@@B@@@BA@A@@@@@@AC@A@@AA@AA@AAA@@@A@@A@@AA@AC@@@@@A@@A@@@A@@BA@@CCA@@AC@@AA@@@@@@A@@AAB@AABA@@@A@@@@@A@@C@AA@@CAA@@A@@@@B@@A@@@@AA@@@A@@@@B@@@AAAC@AA@A@@AA@@@A@A@A@@@@A@@A@@@A@@@AAACAA@A@@@A@A@@@A@@@@@A@@@@BABC@@@A@@@AB@@A@@A@@A@@BABC@ABC@@AA@A@@@AB@@A@A@@@C@@@@AAAA@@B@BA@@B@@A@@@@@A@A@@@A@@AA@@@ABB@@@B@@@@@BBB@@B@@B@@@BB@@@B@@@B@D@@AB@@A@AB@@A@AA@@A@@@A@@@A@@@A@@B@@AB@@ABA@A@@B@BAB@@@B@@@@A@ABA@@@A@@@A@@BA@@@@BAB@@A@@@@@A@A@@AAA@@@A@ACA@A@AB@@@@A@AA@@@@AA@@@AA@@ACC@AAC@AA@ACAA@@A@AC@@@AAAAAAAAA@@@@A@@@A@@@@BA@@@@A@AAAAAA@@@@AAA@AA@@@@BA@@@CBABCB@@ACC@@AAA@@BA@A@@A@AAA@@BA@ABA@ABAB@B@BCBAB@@@@C@C@A@@BAB@@A@A@A@A@@@@@@CA@@AA@C@C@@@@@ABA@A@A@AAA@C@C@EBA@"],encodeOffsets:[[112603,24843]]}},{type:"Feature",id:"450225",properties:{name:"融水苗族自治县",cp:[109.256334,25.065934],childNum:1},geometry:{type:"Polygon",coordinates:["@@@H@LAF@FBDBDBBBB@DBBBDCFCR@BDDADAAG@AB@@@DABCD@@ABAFAD@D@BFDFDCB@DFFBDADAF@BAD@BBB@DBBC@EBEB@BDDDBBBC@CBCF@D@D@B@DA@C@A@C@C@C@O@A@ADA@C@@BAHAB@BABAHBBDBB@DD@DBFBB@@JB@BDHEFADBF@J@@ADBFHJBFBJHLBHBDBBHILMFEJCF@BBAFAF@DCDAHC@GAC@CBB@BB@@AB@@@@AF@BA@@BA@ABA@CB@@AAA@@@A@@B@@ADAB@B@@@B@@@@FBJAHBD@@DABAFDFBBFBHHDBAB@@AB@D@DDFDDDBHDDDDBDBFAFA@@B@B@BB@D@DBBFADAFCDBDHBBB@BADCBADADCLGJCH@@DAD@NAHCD@@GBGDONABEBCDK@AB@DBFDFFDBDADCD@DA@ADAHDDDBD@FB@@ABE@ABABCHDBBDDDF@@@HBFAFCLAB@BBBB@@@DA@@DBB@@AB@BB@@BF@B@@DABABABBBBB@B@@ABA@A@C@C@AB@BBBBBAD@BDDBB@B@@BBBHBFHA@@RFD@DCHADBF@HFDDFFBDABDBH@JBT@FBDBF@FAH@D@DBDABADABC@@@AE@@ABA@@DBLALAHABCA@CA@@@A@@@AB@B@BABA@@B@@@@AA@@A@A@A@@A@AAA@A@@BABA@@@A@A@@A@A@@@A@ABA@AB@@@DBB@@A@A@A@A@A@AB@BA@@@A@@A@A@A@@@A@@@AE@AB@DADAFADABAHEDCAA@ADCDCCAAC@ABABCBA@ABAAAEAABC@A@A@AAA@CAAC@C@C@AAEAC@CACEC@ABAF@FADB@@@@BA@A@@B@BA@A@@BA@@@@@AB@AA@AB@@@@@B@@AB@@@BB@@B@@@@@@@@AB@@@@@AA@@@@B@@@@@@A@@B@AAB@@A@@@A@A@A@AB@DCBAFABCDEDCBABBB@DH@@DL@F@@BBB@DBBFBB@F@BB@BBBABADCF@B@DB@@J@RB@@B@B@BBB@@@BA@@@@B@@@@@@F@@@@A@@@A@@@@B@@C@A@@@@@@B@B@@BBB@B@B@D@@@BAD@@@@@BB@@BBB@B@BBB@B@BB@@@@@ADCBADAF@B@FBFBF@HB@@B@B@@ABAAKCG@AFCF@BAD@JBFDFBHAFBFDD@LHBDFF@@DBB@BCHAFBFDJBB@FADCFEHCDAHADEBAB@BA@E@IBC@AB@JDJ@F@F@HFFCBG@@HGHAFGFENCHGFA@@FDBDHFDB@@BBHFHF@@BB@@@@BAB@@@A@AA@@@@D@BABA@@@@@@@@AAA@@@@@BA@@B@DBJFB@DHB@PRHAFAH@B@BDBF@B@FDB@@@@B@B@B@@@BBBBBB@B@@@@A@C@ABGBIBEDEHCFC@EDEDOBCDEFAF@@@DAT@BAPCF@HBD@DAB@DD@D@DHAHADBBA@EAE@AD@BDDCBEDGBCD@D@BBDDDFDF@@@DBAD@B@H@DDF@DB@@HBBJFBF@B@DDBBBBBBD@FBFBFJFFJDDD@BBDBDBDB@DAB@BAFCPBFDFHLFAF@HBJBFDFABAJ@D@DPDBBABBB@@A@ABA@A@AB@DA@@DAB@@@BAB@BA@@BAB@@AB@B@BA@@BAB@@AB@D@B@DAD@B@B@BB@DAB@DBBDBDBBBBBDBD@D@DABAD@BB@DBBBBB@D@BCBEBA@@AC@ACCCACAACCAAAB@BCD@FA@AAAC@AAC@AACA@ABCB@A@B@FBB@D@D@FBBBBBBBBA@ABAB@B@BACC@C@A@ABA@@BC@AA@@ADAAA@@ACCG@CB@BADCB@BADAB@BA@A@A@A@@@EBCDABA@@@ABADC@A@A@A@A@CBABADB@@B@B@BA@@FCBGB@B@@BB@DA@ABAB@BA@@BADA@@@@B@@B@BBBBB@@DA@BDABB@ABAHGBE@ABABABADAB@@@D@BBB@B@@@AB@B@@@B@B@BB@BBBB@@AB@BBD@@@B@@ADABEBC@CBCBABABAAA@ABBDAB@FBDBDBBDADD@F@BBD@FADABADADCAA@CBB@BBBDDBD@BCDCDAB@DAB@F@HBLBH@BFD@@C@EH@DCFBDBFDFBFAHAFADCBE@E@EDAF@BDBFFBD@BEDCFADAHAFA@ACAACBCCE@AA@@@EGECCAAAECCABA@@BCDCBAAACAECECACDADCB@BA@AB@@@B@FBB@B@BBD@L@BAD@D@D@DB@CF@B@BBBBBB@BBBBABAD@@@@AA@AC@CBE@A@A@@@A@@@ABA@@@ABA@ABAB@BC@A@@F@B@B@B@BB@@B@BA@AB@B@BBBBB@BB@@@B@@@BBA@@@B@B@@@@@B@@AB@B@@@B@@@@@BAD@@@BB@@@AB@B@@B@B@@B@BA@@@BBB@@A@@D@@@D@@@B@@ADA@@B@B@@AB@@@DAFABCDAACBABABBBAB@@@@@@AA@@AA@CE@EBAFADCDEBCEBAAC@@AB@F@D@DABBFE@CFEFE@A@@@@HAR@@ADBB@B@HFBBDFD@DDABBBBFBDBFL@AABADADAFBDAB@BBB@BABABAB@BABAB@B@BABAFBB@D@BA@ABCDADAF@BAACACBABAFGFADG@ACBAACA@C@EAC@CBA@AB@DCFC@C@A@CEGEAAEACFGBCDADCBACCACCBEBA@@ADCCAEABCD@BGBEIQISCY@A@ACOFAF@FCCACCBC@GDAB@D@DADABBD@BA@GBC@EACCAEBC@A@ABAAABCCACC@@CA@@C@@ACC@BAEA@CCBDE@CEC@AE@A@ACBAAEADABCEDEBIBEACABC@ABCBAAAAAAC@@AAB@ABABEEEB@DCDABABGDC@ADA@ACCBCDC@GAA@BEBGDK@IDICAC@CGE@AG@@HOBG@GC@CIDQAGBK@EEAAEC@AECDGBIBE@GDEDYG@GEMIAAEC@@@@B@AADABGNMNGBEHBDFDADDJAAEHIHAAACIBE@AKECCCAAE@A@AE@AAAAGGAECCA@ECAEC@@A@ACAB@AA@@@@@@CI@@@EIGKAI@CEA@GE@AAAAGEGHCAE@@AAA@AAE@C@EAC@CDEAGCSCEEE@A@@@ACAGAE@@@@DAF@BAB@DADBDAFGA@DC@ABADCBE@AAADAHBDA@ADADFBBF@@BLAB@LGAABED@@CDCDDHEAGAAD@CAAAAA@@AB@AC@AA@@B@C@@@A@@A@@A@AA@AA@AA@@A@A@@AA@AAA@A@A@ABC@AC@ACABCAA@E@@B@ACBAAC@AAA@CDAFIB@DAB@BCB@BBBAB@@AB@DEFA@AE@@ABAAAB@B@ACC@@A@@@AAA@@DABCA@AC@@@ABBBCA@BA@A@AB@BAB@@CB@@A@A@EA@ACA@IAMAKAICECA@EG@@DGFCJEH@FADCDCFC@CCG@ECCAADABE@C@ECAAEBE@A@@AGAA@AEEAECEE@AEB@@AGCAE@AAA@C@EBA@ADCBCFAB@D@AACCECBCAA@C@EGAE@CEBA@GB@@CCCC@AAC@ECGCA@B@A@CC@@ACGECAA@AA@CDABAGA@AAC@@@I@KAAEACAG@KAKBM@CACA@C@E@OAO@EAG@I@AG]@@@@@CD@B@BABC@@@AF@B@B@B@@@@A@GBEAAAAA@DAA@A@@AAA@CA@C@C@@@CACCLSDAACGCACCCCAAABEAEA@@@AABA@ABA@@@AACAABAAAAAECCECE@EBCAEA@AIBE@CE@A@EBSBGAG@G@GCEAE@IAE@ECK@A@E@A@C@E@@BBB@DBF@JAD@DABCDEDEAE@KCE@C@AB@B@D@B@D@B@DBDBFBDFHFFBF@@@@CBCBCBA@ADA@BB@D@@@@@B@@@B@@@@A@@BB@@BABBBAD@B@B@BA@@B@BBBAFB@BBBDABAB@BBBB@@@BBB@BBABB@B@D@D@BAD@H@@@@DBBBB@BA@DFBB@@BD@BA@E@A@@B@D@DGHADADC@CBABA@@H@FE@@@CDEDGBEBBD@@@@@D@BB@@@@BA@@DCDIBCDDDAB@@IASAGBI@AAEABCEGBE@EAEAABEBC@EA@C@ABA@@@A@A@@AA@@A@A@A@A@A@@ACCAGBCBC@CCCCA@C@CD@BK@MBCDEAC@OAUCC@EAA@CD@@E@C@ABCBA@CAIDAB@BBBB@ADCBEAGDABAB@DCDGB@BBB@B@D@@@@@B@@CF@FFD@@B@@@ABAB@@ABAB@@BB@@@@@B@@@@BBBB@BBBB@B@@@BB@@@@BD@@B@@@B@BB@B@@@D@BBB@BB@@@BB@@BB@@@BB@B@B@B@@@LDBB@@B@@B@B@@@@@BBB@DB@@@@@B@DCB@@B@@BB@@@B@@AB@@@B@BDB@@@BBB@@@@B@FAB@@@@@BB@@@@@@@D@BA@@B@@@@D@D@@BB@@@BCB@B@@ABBBB@@@BB@@D@D@@B@@BB@B@@BB@BBB@@@B@@B@@B@@B@@B@BA@@BAB@@@@@B@BBDABA@@B@@@@BAB@@BB@@@BBBB@@BBB@@@B@B@@@@BAB@@@B@@@@B@@@B@@@@@BBB@@B@B@B@BBB@B@@@B@BB@@@@AB@B@D@@@@@@BBB@@@@BB@B@@@ABB@@@@BAB@@AD@BB@D@@@@@@BAB@B@B@D@@B@B@B@@@@B@@@B@@@@B@@@B@@@BBBBB@B@B@@A@@@AB@B@@@B@@@DBB@DBBBBB@B@B@@@B@@A@@BA@@BAHCN@B@@ABB@@BB@@@B@@@ABB@AB@BA@@B@B@@BB@@@@@D@@@@A@B@@BB@B@B@@@BB@@BBBB@@BB@BBBB@BB@B@B@@@@B@B@@B@@B@@B@@@BBBAB@@BB@BB@@BCF@@A@@BA@@@@@@@@@ABA@@BA@@BB@@BB@@BBB@@D@BB@@BB@B@@B@@B@BB@B@@@@B@@@B@B@@@B@D@B@@BB@B@B@@BBA@@@@@@BA@@@@BA@@@@B@B@@A@@@@BA@@B@@A@A@@@@BB@@@@D@B@@BBBB@B@B@@@@@B@@ABAD@FDFAFADCBCDAFGDBDED@FBFDFDHA@@FBH@LABA@IFKDEACFGHE@G@CDCBEF@FAFCDEFG@@@CACEI@G@EECCEGEGAGACCEIAIAECECGAABA@AB@@AB@@@BA@A@@@@B@@@BA@@BA@@B@@A@AB@@A@AB@@@@AB@@A@@@@@AA@@@AA@A@@A@@@AAA@AAAAAA@AC@@AA@@@A@A@@@@@AA@@@@A@@AA@@@A@@@@@AA@@A@@@A@A@@A@@AAA@A@@@@AA@@@AAA@@@@@AA@@@A@@@AA@@AC@@@A@@BAB@A@@A@@@@@A@@@@AA@@@@@AAA@@@A@@A@@A@AA@@@A@ACAA@CA@AA@@@A@@AAACGE@@@@C@A@A@ABAAABC@A@C@@@A@A@@B@@A@@DA@@@@@@@CB@B@B@BA@@@@@A@A@@@@@C@@@ABAB@@A@AB@@AA@@@@AAC@CGE@AB@@E@CAA@A@A@@@AB@@@BAB@BCBCBA@A@@@AA@@CBBDDDH@FFAFAJ@JCBEBIAG@GCAECECBE@EECEAAEAABABCBC@ACEDCFEDAFAFCFCBE@@D@@AB@@CCIACAEACIAGAIEGEEIE@@@AA@@@@A@@AAA@@@BA@ACIAIBGEEABA@ABCB@@C@ABA@A@A@A@@@C@A@A@ABA@@@@BA@BBAB@@@BA@@B@@@DBBA@@@@B@BBB@@@BA@@D@@@@A@G@CBABECGBAAA@@AA@@@A@@@AA@@AA@@@@@@A@@@CA@@A@@@AAA@AAA@A@@A@@@AA@@BA@A@@@A@@@A@@@A@CB@@@@CA@AA@@BAB@@A@ABA@AB@@ABAB@B@@ABA@@@@A@@A@@@@@AA@@AA@A@@BA@@@A@@@CA@@CB@@@ACBABA@A@A@AAA@AAAA@CA@@A@AA@AA@A@AA@@C@A@@@@@@A@ACA@AA@A@@A@@@A@@@AA@A@AAA@A@CDA@A@@B@@@B@@B@@BBBA@A@A@C@A@@@A@C@A@@@E@EFEAEA@CG@ADEFGD@FGAGECAC@ADCFCH@FDDBDAHBHB@HAF@@A@@F@@BC@@B@B@@A@@BDDCFB@@@B@B@@@@@@B@@@@ADA@@@@BBB@BA@@@@@@AA@@@@@A@B@@BBF@@DB@@BBAB@@@@B@@@@B@A@BB@CHD@@B@@B@@BA@@@B@@B@@@BBB@BBB@B@@@B@B@@@B@@B@@@D@B@@A@@F@FAFDBCAEBG@EFBDDFH@B@@@D@@@B@@@@ABB@@@@@@D@@@B@@@DFHBF@@BB@@AB@BCB@@@@A@@@@@C@@@A@ABA@@@AB@BA@@@@@@BC@ADABABABCB@@ABA@A@A@@@C@EBCFE@@@A@AB@@@B@@AB@D@BA@BB@@@B@BAB@@A@@@A@@@AA@@A@@@@@A@A@AB@@A@AB@@@@EBAAA@AAA@CBAB@@ABAB@@ABAB@@A@@@@B@@@@ABA@AA@BADABAB@B@@@D@B@B@B@BABBB@D@BAB@B@@@B@B@B@@@B@@@@@BBBBB@BB@@@@B@@@BA@@@AB@@AB@BAB@@AB@B@@AB@@@B@@@@@B@@A@@@@@AB@@@@@BAB@@@B@BBB@@@BA@@@@@BF@BDFDFCBCBADDHAHEJCBBHCHFFAFEFIFEFGDID@B"],encodeOffsets:[[111233,25917]]}},{type:"Feature",id:"450226",properties:{name:"三江侗族自治县",cp:[109.607675,25.783198],childNum:1},geometry:{type:"Polygon",coordinates:["@@HAHCLGDGFIFCJEBCBEAEFEJC@@LAJ@LDHBDDFNDNDBHHRDL@TAD@J@L@TFFDFDJJHJNLHDFBRDJCLGDG@M@A@A@E@KBEFEF@DAH@B@F@D@FBDBD@D@DADCBADCD@D@D@DA@A@CAI@C@@D@FADBD@DBBBBBBDAB@B@D@BBDDBDDD@D@BADEBCBCDAD@D@DABAB@@A@C@C@ADC@AFC@CACIK@CBCACAAEECAAECEACAACA@A@I@AAACBC@CBG@CDCDC@A@AC@EBC@GEECC@ABA@C@CAAAAE@EC@A@A@@@CACAGAA@CFCBCBEBAFCFADABABA@AAA@AAA@GB@AA@AA@CDEBC@CCCEAABALIDAAAC@AABGA@@A@AH@D@@@HDB@H@D@LFFDD@NEFANDHBBBL@HBHBD@H@D@B@LBD@DBB@B@@AAA@CAC@GAG@AA@@@A@A@@@A@AAA@AA@@@AA@@ABCA@@AAACA@AA@CAAA@@@@CCA@AAAAAAA@AAC@AAA@AACEAAB@@A@A@A@A@AAA@AAAAA@CAA@A@AAAAE@AAA@C@ABA@@@AAA@A@ABAA@@@@AAC@A@A@@AA@A@A@A@AAA@@A@@ABA@@@AB@AAA@@AA@ACB@@A@A@A@A@@@AB@@@FADCF@DAD@DCFA@A@ADADAB@B@BAFCB@B@DAD@BAFB@BD@@@B@@@DA@ABA@A@C@A@C@@BA@GBADAF@@ABAB@B@@A@A@ADABA@AB@@ABCAA@C@CAC@GBADADCDA@CJCFABAB@B@@AAABAB@@A@@@A@@@@@AA@@@@@@A@@B@B@@@B@BA@@D@BB@@@A@A@@@AA@@A@@AA@@@A@@@AAAA@@C@@@A@A@A@@@A@CAA@@A@@@A@A@A@@A@A@@@@AAA@AA@AB@@AAA@@CA@@@AA@B@AA@@@@@A@@AA@A@A@@@A@A@AAA@A@A@A@@A@AA@AA@@@A@AA@A@@@@AAAAB@@A@AB@BC@ABA@@AAAA@ABE@@FCBAB@DAD@B@BA@@B@B@@@BBB@B@BAAC@AA@@ABAF@@A@@DABBB@BCBABC@A@AB@BAB@@@BA@AB@@AB@@A@ACC@@AAAAA@AAA@A@AACC@CAABCACA@ABA@CA@@A@@AAAA@C@AA@AA@AA@ABA@AAAAAAE@A@EACAAB@BAAAAACE@A@@AAA@@BAAA@ABC@@AA@A@E@AA@@A@A@A@A@ABCA@@@AA@@AAAA@@A@@@A@@A@@@@A@@AA@@AA@@@@@AA@@@@AA@@@BAAA@A@@@AA@AA@AAAA@@AA@@AAA@@C@AA@@@A@@@A@AA@@A@@A@@AA@@A@@@@AA@@A@A@@@@ACC@@@A@@B@@@AA@@BA@@@@B@AAB@@@@@@A@@B@@@BA@A@@@@@AA@AAA@A@ACABAA@AC@@AA@@@CCA@AA@A@CA@@A@@@AAAA@C@C@CBAACD@BC@C@C@AAA@A@C@CAA@ABCA@AA@A@AAAACACAA@A@A@A@C@A@@B@@@D@F@BCBAE@ACAC@CA@AAACA@BCA@@AAAAAAAA@B@BAA@@EC@BA@A@@BAA@@AA@@A@@AAA@@@AA@A@AHABAB@@GBBD@B@B@DABAAECCEACEIE@@AAA@AAAAAACBAA@AC@A@CAC@AAC@A@A@AACCAACEACCCCAAAAA@C@A@AAAAACA@AAAAC@@AC@A@CAAA@CCAAA@C@@@@A@@BA@@@A@A@@A@AA@ABABABAB@B@BCBCBAEAA@@@G@CAGA@AAAAC@@AA@CAA@@A@@AA@ACCAC@AA@A@AAA@@@A@AB@@A@A@@AC@CBC@EA@@E@C@A@C@CAC@@@A@@BA@A@@A@@AABAAABAACAAA@AA@AACC@ECAA@AC@AC@A@CBA@CCA@C@CA@ACAAEC@EAA@ACC@A@AB@ECCACCAACAAA@ABA@C@AB@@AFG@A@AA@CACAAAACABAAABC@AAAACABABABABACCCAAAAC@A@A@AAABAAA@CAA@@C@@AAACA@CAAAA@ABA@A@ABAB@@@BA@AECAADCAAAAAE@ACAAAA@AAAAC@C@A@@AC@ACCBAAC@@@ABE@A@EFAAABA@A@AC@AEBEEEC@@@@AP@BCD@BGBAF@F@BBB@HCDADDD@BCB@BCDAHABCBCDA@FFAFAB@BBA@BD@BBBBBBDABAD@BABDAFAJCFDFBABCBFABBDB@F@@BFD@DCFDA@DFBABD@BD@@@DB@@DD@BDDDBABBBAB@D@FADBBD@FAD@HABC@AACBCBC@A@CB@HADDDDBEDE@EBDP@B@BDZJTJRAFAHC@ADFBDBCD@BB@FADABDDDABCDCBADEHBDBFFBFH@D@B@DEDCDA@@BAB@DBD@F@DDBBBDA@BCHEBEHABABBDBDABE@CBCBAD@BABC@A@EAABABA@A@ABABA@ABABABA@AAA@CBEACBCBABBBK@AEACAEAABACCC@CEAAGEA@A@CA@BQ@GB@@@@@BEFEF@DEFAACBC@E@A@@BD@BBFAADCFCDEBAB@FDFB@@BB@@B@@@@A@ABAAABABBDCBADEBCB@@A@@BA@A@@@CB@BA@@@C@@@C@@@@BA@AA@@B@@A@AA@A@@@@ABA@@A@@A@@BC@A@@@@@A@@@ABA@@@A@@@@@A@A@@AB@A@@@A@@AAA@AAAAA@A@@BABA@@@AAA@A@A@E@@@@BADA@AB@BAB@B@@AB@B@@@B@@@B@BAF@DBDB@@B@@C@ABABAA@AAAAAAAA@E@@DCAC@C@C@ABK@C@AAA@A@EAA@@@A@@BABA@CDCBBDFDFDDBBBABCDAD@@ABDBFDBBDBFDFH@@B@@BDFADBDDB@BEBGBCBEBCDAFC@EAAEACE@CB@F@FAFCDEBGBEBEAECCAEACDG@@F@DC@AEG@KAGAE@A@CBA@CBCDADC@CAACAAA@DAB@DBBCBCBABC@EAC@A@ECCCBAAACAC@EBAACBAB@BBBABADADAD@FABABC@@@A@@AC@ABA@@AAAAA@@A@A@A@@@ABA@@A@A@AAC@@@A@CBABABAB@BAFGHAB@BAACB@ACB@@AAAA@A@AA@@@@@CBAB@@ABA@AB@BCBA@@AA@A@AHED@@ABA@A@@@CAABAB@D@B@B@B@BCDAB@B@@ABCBAD@F@@@B@B@BABA@CBABA@CDABA@@DDHBD@@BBCB@BB@@BAD@@AB@B@B@DDDABA@A@AB@BABAAAAAAEAC@C@A@EAA@B@A@AD@BDBBBD@BBD@BB@BEBC@ADA@BBDBBDDBDBDD@BBD@@ABAFADC@A@AAAA@CAAC@ABCBC@C@CAAAAACACAAA@CBA@CAAA@A@C@CBA@C@A@@BA@AB@@ABA@A@@BA@AB@@ABA@AB@@A@CB@@CBA@@B@BAB@B@BA@AAABCACOC@I@ABEBECIAGAE@EB@@B@OLGBIDGBEB@@ABA@B@@B@@@@@@@@@@A@@@@@@@AB@@@@@@@@B@@@@@@@@@B@@@@@B@@@@B@@@@@@@@A@@@@@@@AB@@@@@@@@B@@@@@@@@BA@@@@@@@@@@BB@@@@@B@EBE@I@C@AA@GAGCCACCCEAE@@@E@EF@H@BCA@CAAKHIDGDEBABEACABC@A@A@@BEBC@C@@CAEAS@ECAC@CAIAGA@ACCCEEECEECACAGACCAA@EA@@BA@@@A@AA@@@@@@BA@@@@AA@@@@@@BA@@@@@@@A@@CA@@AA@@@@A@@@@@CC@A@A@@@AA@@BCAA@CB@@A@@D@@@@A@ABA@@@@AA@@BAB@@A@@@AB@@A@A@A@A@@@@@A@@BABA@CACAAA@AA@AACAAAE@EBKDMDCF@@AB@@DJAD@@AFAFAJ@JBJ@BDDB@B@@BF@HEFDFDAD@JBBJFFD@@@@HDL@DDDBB@BDDDD@LCFCAC@C@@BAB@H@J@JDBB@DGFABBDDDDBD@DCJ@FBHD@@@@AJDDLNFHB@B@D@B@DCDAB@B@AD@B@@B@@@ADEHEHCHABAB@@HDFFBBD@DAFCDABBDBBADC@@BDBD@FBD@BDBDBFALDB@BBB@B@DAFC@@DAF@FB@@D@@@B@B@B@FCDCDADANCBAB@BADA@@DAB@FADAB@@@BBAD@D@B@DBBBFJLBB@@B@PAD@B@@B@DCDAF@FDFD@DCDAH@HBFBFDB@D@F@B@F@DAD@FCB@BABCFGDCFC@E@GACDEAABCAE@AGGACCCECCG@ABEBEDABCHIDCDCFED@D@HFDLBBBBALBBB@D@B@FBDDDBBABCFAD@DBDBDDBDFFFJBD@BE@GBEBED@DBFDDBB@BCBIDGBGDAD@@@BHFBDDDDBD@D@BAFAJAJCBAB@FGFCFCFEBEACAGAABEACACEC@A@CBCDAFABAAC@@BCBABAAC@E@ABA@CCKAABAD@BADAFAB@B@D@J@LBFAB@JEBAHCHEF@HBBEBKBAF@D@FAFAD@B@F@HEB@D@H@FFF@DCHAHBFDBBBBDBH@FDEJ@JHBL@D@F@D@J@F@JABB@DAH@DBH@D@DADADAFABGDIFA@@D@FDDFDDD@FABCDED@B@DBDBBDBHF@BBBBH@FBF@B@B@BAFAH@J@@AD@BBNDD@BBB@@AH@BBBHD@@JBJ@JCJBHFBDDHDF@BBFDFFFJLJLFJBF@J@BANBJFFBBB@HBLDNFDDJHJJFJBBDHFL@BB@@@@D@JCLADEHCDADODMBIFAFBBDDFDLHDBLDRJLFLFRJFBBBHDJHBBJLDJBRDLDDFD"],encodeOffsets:[[112111,26655]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
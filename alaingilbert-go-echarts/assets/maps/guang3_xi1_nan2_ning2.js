@@ -0,0 +1,5 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("南宁",{type:"FeatureCollection",features:[{type:"Feature",id:"450102",properties:{name:"兴宁区",cp:[108.368871,22.854021],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@@@@@@@C@@@@@@@A@@@AB@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@BC@@B@@@@@@BBAB@@@BAB@B@@@DA@B@BB@@B@BB@@@@DBDBAD@@@@DBFDHB@@DB@B@@B@BD@BB@@DAB@@@BAA@B@@@@@@@@AD@B@@@D@@@@AD@D@@@D@@@BAB@B@@B@AB@@B@@@@@@@@@BAB@@@B@@@B@@@@@B@@@@@@@@BA@B@@BA@@@B@@@@B@B@B@@B@@B@ABBA@@@@@B@ABB@A@AAA@@@AA@@@A@A@AA@@@A@@B@@B@@B@@@@@@@@@B@@@@B@@BB@@@B@BB@@B@@@@@B@AB@B@B@B@B@@AB@D@@@@A@@@@@@BA@@@@@@@B@@@@@@B@@@@A@@@A@@@@@@@@B@@A@@@@@@@@B@@B@@@@@B@@@@@@@@B@@@@BA@B@@A@@B@@B@@@BB@@@@B@@@@@FAA@@AA@@@BAAC@@FD@@DD@BDF@D@BDHAF@@@B@BBBBB@@BD@BDDBBBB@@BB@ABA@ABAB@@@@B@@CD@D@@B@@@B@@@AD@@DBD@@@@B@@A@@BCD@DBB@@@B@@@B@B@@@D@B@B@BAB@D@@@HA@@B@@@BBBADBB@@@D@BBDBDBD@@@B@@@B@@@DAB@@@BAB@@@B@BAD@@BB@@@B@@ABA@@@@B@@@B@@@@@B@@@B@@@@BB@@AB@B@@@B@@@@@B@@AD@@BB@@@B@@@BB@@BBB@@@B@BAB@BDAD@@DBBB@BBD@B@D@@@D@@@BB@@@B@D@B@@BF@@BJF@@@D@B@B@BBB@@@@@@@@BB@B@BBD@@@B@@BB@B@D@@@BB@@BB@@BB@@@BD@@AB@@@B@B@@AB@B@B@BAB@@A@@@AB@@@B@@@DA@@F@BBBAD@DBDFBB@@D@HDBAB@BBDF@ADC@@@@DAB@D@@BDABBB@BA@@DA@@HB@BDB@BBBBBBBBB@@@BB@BB@BB@@B@@@BAB@B@B@B@BCBBBCB@B@D@B@BBB@DD@DBFAFBD@BADBBBFBDBDBBD@BDBBB@@DB@BB@@@DBB@BDF@D@B@BD@@BBB@BB@@FE@@FADABA@@D@@A@ABA@@BCB@B@D@@@B@B@DDD@@A@ABCBC@@DEAA@@FA@@@@BABAFCBBDAD@DA@A@ABAB@BBB@D@DA@A@AF@@AB@BAB@@@B@DADABABA@@B@B@@BBBDBBA@BB@D@D@@FBHB@HA@DFF@FAHB@B@ADDDBBDHDCFDDEBBF@FBLAHHTH@BADAJBB@@DDAD@DCFB@@D@DCB@BFLCHBBDVFLBDABAD@BCFBDADB@@@@CB@B@B@BA@@D@BAB@@@B@B@@@B@B@D@@@BABB@@D@BA@@@BDA@@@@@@B@@@@B@@B@@BB@BB@A@@B@BA@@@@BA@@@B@ABA@@B@B@B@B@@@BA@A@@B@B@BA@@BA@@BAB@@AB@@@BABBB@B@BB@@@@B@@@@@@@B@@@@A@@B@@@B@B@@@B@@@BB@@B@@@B@@@B@B@B@@@@AB@@@B@@AB@@A@AB@BA@@B@@@@A@AB@BA@@B@@@BB@B@B@BB@BAB@@A@@@AB@B@B@@@BA@@B@@@BB@@@@@BB@@@B@@B@@@B@@BB@B@@@B@BDB@B@@B@@@BBB@BABB@ABA@ABA@@@AAA@A@@@A@@BAB@@AB@D@@AB@B@@BB@@@BABABAF@@A@A@@A@@CB@BAB@B@@@B@B@B@@@@@B@@AB@B@@A@C@@@@B@@A@A@@@@@@@AAAA@@@@AB@@ABAAA@@AA@@@@@AAA@@@A@@@@@@@A@@@@A@@A@@@@@A@@BA@@B@@A@A@@AA@@@@B@@@@ADA@A@AAA@A@@BA@@B@DC@@BA@@BA@@@AB@B@@@B@@@D@@@B@@@BA@ABA@@@AD@BA@A@@@A@@@@BAD@@A@@@ABA@ABC@@B@@@BBB@HCBB@@@A@@@@B@BCDA@@B@BAB@DABBBA@@BA@@BA@AB@@A@@AABC@A@ABAA@@@@@B@@@@AA@@@D@@@@A@@@AAA@@@@BA@@B@@@BB@AB@@@@@D@@@@DBBBB@@@B@@@B@@ABB@@@BA@@B@@A@@@@B@B@@@@A@A@@@AB@@ACA@@@A@@A@@AB@@A@A@@AA@@@A@@B@@A@@@@BAA@@@@@@@A@@@@@A@@@@ACA@@A@@@A@A@AA@@@A@@@A@AAC@@@A@@@@BA@@@@A@A@@@@@@ABA@@@A@@@@E@AA@@@@AAA@@@@BA@A@AB@@AB@@A@@B@@@@A@@@A@A@A@@@@@@BA@@B@@@@CBA@@@@A@@BA@@@A@A@@AA@@A@@@A@@@A@@@@@@AAA@AA@@AA@A@@AC@AA@AA@@@A@AB@@AB@@AB@@A@AB@@A@AB@@AB@@@B@@A@@BA@@BA@@A@@ABA@A@@@@AB@@@@AA@A@@A@A@@G@AB@@@@A@A@@@A@AA@@@A@@@A@A@A@@BA@@A@@@@A@@@@BA@@@@A@AA@A@@BA@@@C@C@CA@@A@@@@AA@BA@@B@A@@AA@@@@A@@@@@AB@@@@A@@@@@B@@@AA@@@A@@A@@ABAA@@AA@@@AAAA@@@AA@@@A@A@@@@@A@@@A@@@AAB@AA@EA@CABCBA@@B@@@BAB@@A@C@AA@AA@AABAABA@AACAABA@AB@AABA@KAAC@AA@@AAAB@@CDAB@@CC@A@AAA@A@A@CAACAA@CCAA@@ABC@AB@BCF@@C@ECA@BC@@BA@CAA@A@@@ADA@AAAEE@@A@A@AAB@@CBA@@C@CAABEACB@A@@BAACCAC@ACAC@@@BE@AADC@ABAB@@@@A@ECAAACB@BC@AA@@A@A@AB@AC@@@A@AC@AC@@AA@AAC@@BAA@BAAAD@@A@A@A@@@ABACAAACACA@A@A@@@AAAA@AAA@@A@AB@B@BC@ABAB@@ABA@A@A@C@ABAAECAE@AC@ACAABAEA@BA@@AAAE@@AAABAAC@AADGF@AACBCACBC@@@@AACAAC@@AC@@AACAAB@@C@@CA@CB@@@BA@A@BE@@A@EDAD@DAB@@A@A@AA@A@AA@A@AB@B@@A@@B@A@@@A@@@ABA@@@A@@@@A@@@AAAA@@A@A@@BA@@BA@@@AA@@@@A@@B@@AAA@@AA@AA@@C@@BC@A@AB@@BD@@@B@@@@@BAAAB@AA@CAA@EC@@@@@A@@@@@@A@A@@@A@A@@@A@AB@@A@AB@A@@AAAAA@@BA@@AA@@@@BA@AB@@@@BA@@@@@@@AA@@BA@@@@@@@@@@A@@@@A@@@ABA@A@ABC@@B@@@@A@@@@AAB@AA@@@@BAA@@@AA@@@@@@AA@@@A@@@A@@@@@@@A@@@@CA@@A@A@@AAA@@@@AAA@A@@@A@C@@CB@@AA@@A@@@@@A@@@A@@@@AA@@@@@A@@BA@ABA@ED@@A@@B@@@B@@A@@B@AA@@@AA@@A@ABABA@AB@@@BBD@@A@AB@@CAABA@@@@ACA@@A@@A@@@@AA@@AA@@A@@@@@@AA@@@@BCBAD@@@@ABAAA@A@@C@A@@A@@@A@AB@@AB@B@@@B@@@B@B@BA@@@@@@@@AA@@AAA@@@@A@@A@@@@@@@@A@@@@@AB@BB@@@@@@B@@@B@@A@@@A@@@@@A@@@A@@@@B@@B@@BA@@@@@@AA@@@AA@BA@@A@@@A@@@@@@A@@B@@A@@BA@@@ABA@@A@@@@@A@@@AA@@@@@@A@A@@@@@@A@@@A@@@B@@@@@AB@@A@AA@B@@@@B@@@@@@B@@@@@B@@A@@@@@@B@@@BA@A@@BA@A@@@@D@@A@@BCC@@@@@@@@@B@@@BA@@B@@@@A@@B@@@@@B@@AA@@@BA@A@@@@@@@@@@@AAA@@B@@A@A@@@A@AAA@@@A@@@@B@@A@B@@B@@@@@@AF@@AAC@CA@@@ACA@@CAA@@A@@@@@@@@@@@@@A@@@@@@@@A@@AADCC@@@@@@AA@A@@AACAA@@AA@CE@AAC@@@@A@CA@@CAAA@@C@@A@AA@@@AAAC@AA@C@@@EA@AA@CA@@AAICEA@@@@AA@@A@AACA@@CAA@@@A@A@@@@@@AA@@AB@A@@@@@@@@@@@@AE@@@@BDBA@CCA@ACA@@@CA@AEC@@AAA@@@A@A@@@CA@@@@@A@@@@BAEA@A@A@@@A@@@@A@@A"],encodeOffsets:[[110923,23361]]}},{type:"Feature",id:"450105",properties:{name:"江南区",cp:[108.273133,22.78136],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@@A@@@A@@@AA@@A@@@AABAAABC@AB@@BB@@B@@@@B@@AB@@@B@@@@ADB@AFCB@AABA@ABA@@@@BB@A@@B@@AA@@A@@B@@@B@@CAA@A@AAA@@AB@@AAB@BEB@@ABABAB@@A@ABA@@BA@@AA@ADA@@@@@AB@@@B@@A@@@@@A@@@@B@@@@A@@@@@@B@B@@@A@@A@@@A@@@@@A@@@A@@@@@AB@@B@@@@@AA@@@@AA@AB@@@A@@@@@@@A@@@AA@@BA@@@AA@@B@@A@@AAA@@@@A@AAABAA@@@@@AB@AA@@@B@@CA@@@BA@BBA@@AAA@A@@A@@@@BAAAA@@A@@@A@@AA@B@B@@A@@@@@@A@@A@A@@B@@A@C@CC@@@AB@@AA@@AA@BABCBAB@AAA@A@BA@@@@@AB@@@@@B@@@@@AA@@A@A@@@@@BA@@@@A@@A@@B@DA@@@@@@A@@@@A@@C@@@@A@A@AB@@@@AA@@AB@@@B@@@@@@A@@@@A@A@@@@@@B@@@@A@@A@@A@@@A@@@@A@@@A@@@@AB@@@AA@@@@@@A@@C@@@@@B@@BA@@@AB@@A@@@BA@@@@A@A@@@@@A@@@@@A@@@@BA@A@@A@@@@B@A@@@A@AB@@@A@@@A@@@@@@A@@@@@@B@@@AA@AB@@B@@@AB@@AAA@@AB@@@A@@@A@@A@@@@@BA@@B@@@@@@A@@@BA@@A@A@@@@A@@A@@@@A@@B@@BB@@A@A@@@@@@A@A@@@@@ABCB@BC@@@BD@@BB@B@B@B@@@BA@ABCBCBA@@@@BA@A@@@AA@@A@@@@@@@@BA@A@@B@BA@@B@@AB@BAB@@A@A@@@@@@BA@ABC@@@@@@B@B@@@@C@@@A@@A@@A@A@@@C@A@A@A@AA@BA@@B@B@@ABAB@B@@@@@B@@@BA@A@@@@B@BB@@@B@@B@@@B@D@BADA@@@A@@@@B@@A@@BB@B@B@@@@F@BD@BBD@@DB@BB@@BBBB@@@BBB@B@@@HBBDAB@@ABAB@@F@B@B@@@B@B@BAB@B@BCBA@ADC@ABCBCB@BADC@BDA@EDCA@C@CA@C@A@C@@@AAA@A@@DBD@BA@ABE@@@@BA@BBB@@@@B@BB@@@@B@D@BA@AD@BBB@@A@@B@B@B@BB@@FBB@@A@@BAD@BA@@B@BBB@@BBB@@D@B@B@B@B@@BBDABBBB@B@D@B@B@BA@A@@@ABAB@BBBDD@BCBAA@BA@E@@@@BEB@AA@@AA@ABAAA@AAEC@@A@@@@@CA@BA@@AA@@B@BA@ADA@@@@@ABABA@A@@@@@@B@BB@@@@DADB@@@BBA@@@@B@@@BAA@BA@ABA@A@ABC@A@A@A@CBCBC@C@GBHBFFBB@@A@CACAA@CAE@GAE@@BA@@@A@@@AB@B@@EBA@@AAAAAA@AB@@@B@@@@@B@@@@A@@@@@A@@@@@@D@B@@@BA@A@AB@@@B@@@@AB@B@@A@BB@B@@@B@@@@BB@@@@@B@@ABBB@@@D@@AB@@AA@AA@A@AA@@A@A@@@AB@@A@@@@AAA@@AB@A@@@@AA@@A@A@@@AAAB@@@@@BA@ADAB@@CBA@AB@@BB@@AB@@AA@@A@@@@@AAA@@B@@B@@B@BBB@B@BAB@BB@BBB@@BBA@@B@BB@@BBBA@@BDBBA@@BBB@D@@BB@B@@BBBBB@@@BA@@@ABB@@BBB@B@AB@@@BBB@@@@B@@@BB@B@B@B@B@@DB@@D@@@BBAB@@@DB@B@BBBBAB@@@D@D@BBB@BDB@@B@B@@@@D@BBBAB@@BBA@AB@BA@AB@BAB@B@@@@ABG@AB@@BBB@@@BB@B@@@B@BC@ABA@A@@@ABA@@@AB@B@BBBAB@B@B@@A@@FA@@@A@C@@B@B@BA@C@@@@B@@A@@B@BA@@@@@@@B@BBBBAF@@BD@BAB@@BBB@@@@@B@B@@BA@@BA@@B@@B@@B@@@BB@@@@B@B@@@B@@@BB@B@@@BBAB@BB@ABBB@@@@@B@@B@B@@@@@@B@@@B@@@B@@@BBBB@BA@@DDB@@D@@B@F@B@B@BAB@@@@@BABABADA@@B@@@@@B@@@B@BB@B@DBB@@F@FDDB@@BDFADBB@@BB@BDDBDDB@DADBB@DBDBB@@A@A@A@CDAB@@BBBD@@@BB@BB@DB@BB@DBBB@ADABAD@BAAABAB@BBBB@@B@DBBC@@BB@@BD@BBBB@BFD@BHF@BHABDB@@BBDD@DBADDAF@DA@@BBBDD@@@B@B@BBDCBABE@ABBFC@CBABADCBCB@FAB@B@D@BDBDD@BADCDADBF@DAB@BABAB@F@@@@AF@BA@@@E@AA@AAA@@@@@CA@A@CCA@AB@A@A@@@AAAAA@AB@@AAABABA@C@CBCAA@A@AB@@AA@@AAEAAACAAAACCI@EAA@@A@AAACAE@E@@@@@@ABAACAAAAA@ABA@AAE@AB@@@BCD@BA@A@CB@B@@ABABA@A@C@C@AB@@@@AFBD@BA@@B@@ED@D@BCDA@@@A@ABABABA@C@@ACA@@AA@BA@@BEDADABAB@@A@A@A@@DAD@DBB@B@D@FAB@BBD@@@@BA@A@@BAB@B@@@@B@B@D@@@D@@@@AB@@A@@@AAA@@BA@@B@B@@@@@B@B@DAD@B@B@BFBB@B@@B@@BB@A@@BA@@BBB@BBB@BA@@DBB@@B@B@@BB@@@@BB@D@B@B@@@BDBB@BBBB@BABB@@B@B@BBB@B@D@BDBBBDBBBBBABA@C@@D@D@@AB@@@BADC@BBDDBDA@@BC@@BA@AB@@@B@HBBD@FAF@FBDBBB@B@D@D@BCHAB@B@D@@@BDFAHAFCD@DDBDBHDDDADA@@@OD@B@BBBNFCLDFLHN@LD@@BB@AB@LF@JGHK@EMGCGF@B@@@@@B@B@BJHDBDBBBTVFDBBF@@@BAFEDO@@DM@GDIDEJD@@DBHBH@@ABCFGBAPDDDXTF@D@D@BAESKM@@IMIM@E@CBINIA@faNIBAPKPKFATCNBB@D@VNLLLNBB@NBPEJ@@ABGNABIP@@@@@@@@ADBBDDL@B@@@N@DB@@NB@@JBHBXFLBD@J@@@FA@B@AF@@@@@LAHEB@@@HG@@HELI@AB@@@DCDABA@@BA@@BABAB@B@JE@@DBFB@@DB@@BBBABDBB@@B@BDDD@@B@HBD@@@B@@AB@@@BABA@ABA@C@@BA@A@A@G@@@ABA@CBCB@@AB@B@B@B@DBDB@@D@@@B@D@D@@@B@D@DABA@@@ABCAAAC@@AA@@AAA@A@CAA@GA@@AAEE@C@@@@AE@AA@C@C@A@@@A@@@BDB@@BABA@AACA@@AA@@ACA@@C@@AC@@AAAA@@@@A@@A@@B@@A@A@@@A@@@A@AB@@@@AB@B@@A@@@@@A@@AA@@AC@@A@AA@@@AB@@@@@@@@AB@@@@A@@@AAA@A@@A@@@@A@A@@B@BA@A@@@@A@@AAAB@@AB@@@AA@C@@@@B@@A@@AAB@AABAA@@@@@@ABA@@B@@ABA@@B@@@BA@@B@B@@A@AB@@A@@@@BA@ABAA@@AA@@A@A@EBAACAEB@@ACC@AAAB@BC@ABB@AB@@AB@BA@@@@@A@A@@B@B@BA@@@@@A@@@@DABB@@@@B@B@@A@@B@@A@@AA@@@@@@BAB@@A@@@A@@@@B@@@@@BA@@@AB@@@B@B@@A@@@@AA@@@AA@@@@@@@@@A@@@A@AA@BA@AB@@AB@@C@A@@@AA@@AAA@@ABA@A@AEAA@AC@AAAA@@AAB@@C@B@@A@@AABA@@@@BA@ABAA@@A@@@@@AA@@@A@AB@AA@BA@@@@B@@@@AB@@A@@B@@A@@DAB@BA@@A@@A@@AAB@BC@@@@@A@@@A@AA@AA@AAA@@@@AABAA@A@@@AA@@CE@A@@@A@@@A@@@A@@B@@A@C@A@ABA@@AAACAA@AA@@@AA@A@@BCBA@@AC@@AA@@A@@@BA@@@C@@@@@@AA@@@@BA@AAA@@@AAA@@A@CBA@@@@BA@A@@@A@AAB@AA@@BA@A@@@ACA@@@AA@@AAABAAACAAAAACA@@A@@@C@@A@@B@@AB@@@BA@AB@@CA@AA@A@AA@@@@A@@@A@@@A@@@A@@@A@AA@A@ABA@A@A@@AA@A@A@A@@BA@A@A@@@@@EAA@@@AB@CBCDC@@@ABA@@DA@AB@@AB@B@B@B@@@B@@A@@B@@A@@B@DAB@B@BBD@@AFEBC@ABA@@DC@ABA@@B@@@BA@AAA@@A@CK@@AA@@AAA@A@BBA@AA@@@A@A@@ABA@AA@BA@CD@BA@A@A@@@@@@ABA@A@@AA@@BACAACB@@ABA@@@AA@@A@A@@@@@A@@@ABA@@AA@@BA@@B@@@@A@A@@B@@AB@BA@@@A@ACCAC@@@AB@@CA@@@AADA@AACE@ACBABEBGACE@EDCBGCIIACGKC@ECAAA@C@AB@DCDC@IBCBA@CDC@@@@@ACBAB@@AAA@@AABA@@A@A@@AA@A@A@@AA@AAAAEDCBCD@@ABCBCDAB@@@BABA@AA@@AAAAA@@A@ABADA@@B@B@DC@A@@B@@@B@@@BAA@B@@@@A@@B@@@@AD@@AAAA@@@@AE@@@BB@@A@A@@@A@@AB@@A@A@@@@A@@@@B@@@@A@AA@@A@@@@AA@@@@A@AA@BA@AB@@@@A@@@A@@@B@AA@@AA@A@@B@@A@AA@A@@A@@@@A@@A@@@A@@@@@A@A@@AA@@@@@@A@@AA@@A@BA@A@@@@A@@@BA@A@@@@@AA@@A@@@ABB@A@@AA@ABA@@@@A@@AA@@@@@@AEBCA@A@@AAA@A@A@A@A@BC@E@@@ACAA@@@AAABC@@A@@AA@@A@A@@@@AA@A@@C@@AA@A@AAAAAA@AA@CAA@CAC@C@C@ACCC@BA@C@ACCCAA@CA"],encodeOffsets:[[110760,22960]]}},{type:"Feature",id:"450103",properties:{name:"青秀区",cp:[108.494024,22.785879],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@B@@A@@B@@@@A@@AA@@BAB@@@@A@@AAA@@AA@@AA@AA@@B@@AAA@AA@@@A@@@@A@A@AA@@@@A@@@A@@@A@@@@A@@@@A@@B@@@B@@@@AA@@@@@A@@@A@AA@@AA@A@@B@@ABB@BBB@A@A@@@AA@@@A@@@@@@CA@@@@@@@A@A@@AA@@AAA@B@@@@@@AB@@A@A@@@A@@@@@AA@@@@A@A@@@@B@@GA@@BA@A@@BA@@BA@@A@A@A@BA@@@AA@@AA@@@A@CB@@@@AAA@@@A@@@A@AA@@A@@AAA@@@@AB@BBB@@@@@B@BAB@BA@A@@@A@@A@@@@@A@@C@@A@@@BA@B@@B@@B@A@AB@@@@A@@AAB@B@@@@BB@@BB@@A@A@AB@B@@A@@AA@@@AA@BAB@B@B@@A@@@AA@AAA@A@A@@AA@@@@ABAA@@E@@A@@@@@@@@@@@A@@AAA@@A@@@@@@@AAA@@@A@@@@A@@@@@AAA@A@A@@@A@@@@@@@A@@B@@@@AB@@A@A@AB@BAB@@@@B@@@@B@@@@@BB@@@@B@@BB@B@@@B@B@@@B@@AAA@A@@@@B@@@B@@@@@B@BA@AAA@@@@B@B@@AA@@AB@@@@A@AAA@@@A@@BA@@B@@AB@@A@@@@@AB@@A@@BCBA@@@@@A@@@A@@@AA@@A@@B@@A@@@@@A@@@@@AAA@@@@@@@A@A@@@@A@A@@@@@@A@@@ABA@@@BB@@@@A@@@A@A@A@@@@BA@A@@@@@@@@@@@@@AB@@@@A@@@AF@@BD@@A@@@@A@@@@@@AB@@@@A@@@A@@@@BB@@B@@AB@@@@@B@@@@C@@@@@ABB@@BB@B@@@BBABAB@@AD@@@@AB@@@B@B@@A@@B@@@@B@@B@@@@A@@@@BA@@@@AA@@@@@@B@B@@@@@B@@@@@@@B@@AB@B@@B@@B@@@B@@@@@@@@@B@@@@@B@@B@@@@@@@@@BB@B@B@B@BA@@@@B@@@@@B@@@@@@@@A@A@@@@@BBA@@@@@A@@@@B@BBF@D@@@@@@ABA@AA@A@@CA@@@B@@AA@@@A@@ABA@@@@A@@@AB@@@B@AA@@@@A@@@@@A@@@AA@AB@@@AA@@A@@B@@@BC@@AA@@AA@A@@B@@A@AB@@@BB@@@@BA@@@@@AB@@@@@@@B@@B@@B@@@@@@ABB@@@@@@B@@@@BB@@@@@@AB@@@@@BAB@B@@@B@@AB@@@@@@@B@@BB@BA@@@BBC@@B@@ABA@@B@B@@@@@B@@@@B@BB@@@@@B@@@@B@@@@BD@@B@@@@BBB@AD@@@BAD@@@BA@AB@B@B@BAB@@@@@BBD@B@B@BAB@@@B@@@@@BB@AB@@@@A@@@@D@@@@@B@@@@@BA@@@A@@@@BA@BB@@AB@B@B@@F@@BB@AB@@AB@B@@BB@@@@BB@@@B@@@B@@@BA@@@@@C@AB@@A@@BABA@@@A@A@@F@@@B@B@@C@AB@BB@@B@@@@C@A@E@C@ADA@ADAB@@AB@@@B@B@@@@CD@@@B@@@B@D@@A@@@CCCCAAA@AAEAA@GACAGCA@AACCAAAAGEGCAAA@EA@@EAA@AAA@AC@@CEAEAAAA@@A@A@A@ABAB@BAB@B@B@BBD@BBD@BB@@BBD@@@BAB@@A@E@C@A@C@AAA@@@A@A@@@@@AAAAA@AAACAC@@@@@@@@@A@A@A@A@CBA@ABC@ABG@G@@@C@@@A@C@A@AAC@@@@@ACA@A@@A@AAA@C@A@@@@@ABA@@@@@@BABAB@@@@AD@@@B@@@B@@@F@B@D@BBD@@@@@BBFBBBD@D@DAB@B@BABABEDCBABADCF@BAB@DAFAFABA@@@ABA@C@A@CAAAA@@AEMAAAEAAEC@@EE@@CAEAAAA@C@C@C@@@A@GBC@A@A@C@AAA@AC@@@C@@@G@E@ACCAA@A@C@CB@DA@@EB@ECCCCEAE@A@E@CBCFCDCFCFGFGBEBCB@@CBCBCBAB@BABAD@@A@CBC@C@C@AAC@AACAKGKGCACEA@CAAAA@EAC@CAABA@A@CBA@ABABAB@B@@A@@BBF@@@@@DFFBB@@HBB@DBB@B@BB@@BB@@BDBBAD@B@@ABCBC@A@@@C@C@A@@@C@@@CACAA@A@A@A@@BA@AD@DAB@B@@@H@B@BAB@@@DAB@BABAB@@A@@BBD@@B@@@@@@@D@@@@@@@B@@@@@@BB@@@@@@B@@@B@BFBAB@@@@@B@@@@DB@@B@B@@@B@BB@@FD@BDB@@B@BDB@DDB@CA@A@@F@@B@@@@@@@@@@B@A@@BB@@B@@@@B@B@@@B@DB@@DBBBB@@@BB@@@@FBJDBB@@DBB@@BFB@@D@B@@BBDBB@@B@@B@BD@@@BBDB@@DBB@@@@@BD@BDFB@@BB@DBBB@@@BBB@@@@@@DDBC@BB@@@@@@@@@@B@@@@@@@@@@@@@BB@DB@@DB@B@@DBD@BB@@BE@@@@@@@AA@B@@@@A@@B@@@B@BBB@@@B@B@@@@AB@BB@@@@@@@@@@B@B@@A@@BB@@@A@@@@@AB@@@@@@AB@@A@@@A@@@@@@@@DD@AB@@@@C@@B@B@@AB@B@@A@@@A@@@@B@@@@A@@@@@A@@@@A@@@@@@ABBB@@@BA@@@@A@@@B@@@B@@@@@@@@B@B@@@@B@@B@@@B@@@@@BB@BA@@B@@AB@@@@AB@@@@@@@@B@@@BB@@ABB@@B@@B@@@@B@@AA@@@@A@@B@@@B@@@@@B@@@B@@@@A@@@A@@@@A@@ABA@@@@B@@@@@@@@@@BB@@@@@BB@BB@@B@@@@@@B@@A@A@A@@@A@@@ABA@@BAB@@@B@@@@B@DB@B@BBBA@@@@BCDA@A@@B@@B@@@@B@@@BB@@BB@@@@@BB@@@DB@B@@B@BADB@@BAB@@@AC@A@@BAB@BABAB@@@BB@@B@@B@AB@@@@A@@@AB@@@FCB@BAB@@AB@@@@@B@@B@@B@@@B@@@@@B@@@BB@@DA@@@D@B@@@BBB@B@@B@BB@@@B@BB@@D@@B@@@@@@@B@@@B@@@B@@B@@@@B@@B@@BB@A@@B@@BBA@B@@B@@@@@@AD@BAB@B@BA@@B@@@@@@B@@@@@@@@B@@AB@@B@@@@@@AB@@@@BAB@@A@@B@@BB@@AB@BBBB@@@BBAB@@@BAB@@@B@B@@@B@B@@@@@@@@B@@@@FDB@DBB@@BBABB@A@@@@@A@@AC@@BAB@D@@AD@@@BBB@@BB@BB@@@AB@@@@@BB@@B@@AB@@AB@B@@@BBBB@@B@@@@@@B@@AB@B@@@B@@@B@AB@@@@ABAB@B@@B@BBBB@B@@@BA@CBCFCB@@@AFB@B@@A@@DAB@@DD@@@BADBBB@@BD@@BDDBBB@@@@ADBDADBD@BHEBC@BBDABBB@BF@BB@BB@@AFBABBBBDD@@BBFFDBBBAD@B@B@B@BA@@BABAD@@A@ABAB@@@BB@BBBBB@@B@B@B@BDBDBBBDBA@@B@B@B@@@BCBB@ABB@AD@BBB@@BD@@BBDB@@@D@@BBAB@B@@@BBD@@ADABBDB@F@B@@A@AB@BCDBBF@@A@@BDBDD@DBBDAB@@@BDAFBBADBD@@@AB@DA@BBB@B@@@FFBB@BCB@B@@@BBB@DAB@@ADB@FDD@@@DE@ABAD@BA@@BBDDB@DBBB@D@B@BBB@B@BDD@@BADC@@BABB@@BBD@BB@LABBBA@@BABBBBD@BABBBBA@BBBB@@B@D@BA@AB@@A@@@ABADDBB@@FBBA@BB@@B@@@B@@@@@B@B@@@B@@B@@BBBB@@B@@BB@AB@BB@@@@B@@BB@@A@@@@@B@@@@@BA@@@@B@@@@@BB@@@B@AB@@ABB@@@@@BB@@D@D@D@@AB@@@BBBB@@@@@AB@@@@@B@@B@@@AB@@@B@B@B@@@B@@BBB@@@B@B@@@@@BAH@@@@B@BB@B@@B@@A@@B@@B@B@BA@@@BB@@AB@@AB@@@@A@@BA@@BAB@@@BAB@@@BA@@BA@@BAB@@@B@@BBBD@@BB@B@@BB@@BBB@B@@@@B@@@B@@@B@@@BB@@@B@B@@AB@@@B@@B@DA@@@@@AB@@A@@@@B@B@B@@@B@@@@@@AB@@@BA@@BAB@B@@A@@B@BB@@@@BBF@@@@@@B@@AB@B@@@@B@B@@@B@@@B@@@B@@@@@B@@@B@@@B@@@@A@A@A@AA@@@ACBA@AA@@@BA@AAA@A@@@AAC@@@AA@@ABA@@DA@A@AB@@@BAB@BA@@@ABC@ABA@@A@@@@@A@@@BA@AB@@AB@@@B@B@@@B@@@@A@@BBBA@B@A@@B@BABBB@@@BAB@@@B@B@@BB@@@@BBB@B@@BB@BB@@B@@B@BAB@@@B@@@@@@@B@@BD@@@D@@@B@@B@@BBB@@@AB@BB@@B@BB@@BB@@@@BD@B@AABA@@B@B@@@@AB@@A@@@@BA@@@AB@AA@@BA@@B@@@@E@@@@A@@@AA@@AA@A@@@C@AB@BAB@B@BA@A@@@A@A@@AA@A@AAA@@@A@A@@@AAA@AAA@@@A@A@@A@AA@A@A@@@ABA@@@A@AA@@A@A@@BA@A@A@A@A@A@AAA@@@AA@@ABA@ABAA@@A@A@@@AB@BAB@B@@A@@@@@@@A@@@@@@@AA@@@@ABA@@ACB@B@@@@A@@@@@A@@@A@A@@@@@AB@@A@@B@@@@@@A@@B@BB@@@A@@@@BA@@@AA@@@@@@@@A@@@A@B@@A@@@@AAB@@@@@A@@@@A@@@@@AA@AB@@@A@@AB@@@@@@A@@B@BA@@@@@A@@@@A@@A@@@A@A@@@@A@@@@AA@ABABA@CD@A@EA@CBE@A@A@AB@BAB@@A@@AA@C@BAA@@CBAA@@@@A@A@@@A@@@A@@@AAA@@BA@A@@A@@A@@@@B@@@@A@@@@@A@@@@@@@A@@@@A@@A@@@@B@@A@@@@@ABA@@@A@@@A@@@A@@@AB@@A@@@A@@@A@@@A@@@@AA@BA@AA@@@A@@BA@ABC@@B@BBHABAFIDAB@BBBA@@@@@A@AA@BA@@@@@A@@@AAAA@BAAA@A@@AAB@@AA@@@@@@A@A@@@A@@@AA@A@ABA@CE@@@AA@@AB@@AB@B@@@@A@A@@@@@@B@@A@@@@BA@@@A@@@@@A@@@AB@AA@A@@AA@AA@@AAAA@@A@AA@@ADA@C@@BA@@B@B@@ABA@@B@B@@@D@BA@@A@@A@@@ABAB@@@@AB@@A@@@@B@AAA@A@A@B@@AA@DA@@@A@@BABA@@BA@@@@@AA@AA@A@@AA@A@@@C@AACA@@AAA@A@@BA@@@AAA@@@C@@@A@A@@B@@@@@AA@@@@@@@AB@@A@@@@@@AA@A@@B@@@BB@A@@AABA@@@@BA@A@@@AB@@AACA@@A@AB@B@@ABA@@@A@@B@@@DA@@@@@A@@@ABA@@B@@A@@@@B@@@B@@@B@@A@@B@BA@@@@@@@ABB@@@AB@@BBA@@@@@A@@B@@@B@@@AA@@@@BA@AB@@@@BB@AB@A@B@@B@@@@@@A@@B@@@@AB@@@@@@@@@@@@A@@@@B@@@AAA@BAB@@@BB@@BA@@@@@@@AA@A@@A@@D@@@B@AAA@@@@@A@@CB@@@AA@@B@@ACA@@BA@A@@@@BB@@BAAA@A@@B@AA@@A@BA@@@A@@@A@AA@@@AA@@A@@DBBC@A@@CAGA@C@AAAC@A@A@CACAC@C@A@ACA@@A@@A@C@A@@@@EBAAC@AA@@@A@C@A@C@AA@ABA@@A@AAABA@A@C@ABA@@@AA@A@@A@A@AB@@@B@@A@@@AECAA@A@AA@A@@A@@@A@@@@AA@@@A@@DA@@@A@@AA@A@@@AB@B@BA@@@A@@@@@@A@CAA@@BA@A@@@A@@@@@@@@BA@@@A@@@@@BB@BBB@@@@AB@@@BA@@B@@@B@@@@@B@@@BA@@@@@@@A@@@@@A@A@@@@BB@@B@BB@@@@B@B@B@BAA@@@@AB@@@@@B@@@@A@@@@@ABA@@B@@@BA@@B@BB@@B@@@@@B@@AB@@@@BB@B@@AB@B@@@B@@@B@@BB@@A@@BB@@B@@@B@@@AA@@@AB@@@@AA@@@C@@@A@@A@@@@@@@@B@@A@@@A@CB@@@@@B@@@B@AA@@@A@@@A@@@@@@B@@@@A@@@@@A@@@@B@@@@A@A@A@A@A@@@@A@A@@@@AAA@@@@@@B@@@@A@AA@B@B@@A@@B@B@BB@@@@@ABB@@BD@A@@B@@@B@@A@@@@@@AC@@@@B@@@B@@AB@@BC@@AA@@@BA@@@@@AB@BBB@B@BAB@B@BAB@@A@AAA@@@@A@@@A@@AB@@A@@AA@@@@BA@@@AAA@@BA@@@ABA@ABA@@@@@BA@@AA@@@@A@@BA@@A@@B@A@BAA@@@@@@@@ABA@AB@@A@@@A@@CB@@@A@@A@@BA@@BA@@A@@@A@ABAAA@@@@@A@AB@@@B@@@@@@AAA@@AB@A@AA@@AAAAA@@@@@ACB@ABAAA@@@@@A@@@@A@AAAB@@@AAA@@@AB@@A@@A@CA@B@BA@@BAA@@@@@A@@BA@@A@@@@@A@@@A@A@@@@@AA@B@@@@@B@@A@A@@A@@"],encodeOffsets:[[111347,23242]]}},{type:"Feature",id:"450108",properties:{name:"良庆区",cp:[108.39301,22.752997],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@AB@BAB@@@@@BA@@B@BA@A@@@AB@@A@@@AB@BA@@@AB@B@@@@B@ABBBA@BB@@@BA@@@@@@DBB@@A@@BA@BB@BB@@@@@@BABA@@@@B@B@@B@@@@BBB@B@@@B@@A@@B@@@@@@A@@B@@BBB@@@BD@@BB@@@B@@@@@BA@A@@B@@A@@B@B@@@B@@@B@BA@@@@BB@@@@@BBBB@@BD@@@DB@BD@@BB@@DBBBB@BA@AA@AC@@B@@@B@D@D@B@B@@@@ABABABAB@DAB@B@BADBD@FBB@BBDBB@DFDBLHLHDBBBD@BBD@D@D@DAB@@@BCBA@ABADADADA@@DAFAHAHEDEDEDCDEDAF@B@F@FBDDDD@FFA@@@GB@@@BA@BD@@ABAB@AAAAA@@@BAC@AA@@@@A@AA@AB@AA@AAA@@BABCD@B@@AB@B@B@BA@@@@@@CB@A@A@@B@@A@@@@BAB@@@B@DA@@@A@@@@C@@A@@@@A@@@@@@@@A@@@@@@@@@@@A@@B@@@@@A@@@@@@AB@@A@A@A@@AB@@@@BC@AB@@@BA@@@ABB@AB@@@B@@@@@BA@@@@B@BA@@@@AA@A@@@AA@A@AAA@GEA@A@A@@A@@@C@A@AB@@A@C@A@@@@@A@@B@B@@@@AB@@A@@A@C@A@@@@AA@@@@A@@@ABAAA@@@AB@@C@@@@AA@@@@@@@@B@AA@BA@@@@AB@@@@@BA@A@@@@B@@B@@@@BA@@@@@@B@D@B@@A@@@A@@@@BB@@@@BC@@A@@@A@AACA@AC@@AAA@A@@JEFAB@@@BA@C@A@@@AEAA@A@A@C@A@@AA@A@CB@@@B@D@B@@ABA@@AA@@ABC@@ACB@@A@ABA@A@A@A@A@@BC@A@AA@@A@A@@BA@@B@B@BB@AB@@C@@B@B@B@@@@A@A@CAA@A@@DB@@B@@@@A@A@@A@A@AA@@B@@@D@@A@A@@@BB@@@B@@@@A@A@@@@@@B@@@B@@@@ADA@@B@B@@ABA@@@@@A@A@@@@AAAA@@A@@A@@@A@AA@AA@@@AA@@A@@AA@@B@BA@@@A@A@AA@@ABC@@@AA@A@@@@@@A@@@@A@@@A@@AA@@A@@@@@@@@@@BA@@@@B@@AB@@A@A@A@@@C@AA@BA@@B@@A@@A@@AA@@@A@@AB@@@@@AA@AA@@@A@@AA@CA@@@A@@A@@AA@A@@A@@BC@@@@@@BA@ABA@@AA@@@ABA@@@@B@@@BB@@@@@A@@@@BA@@BAB@BA@ABA@@AA@@@@@A@ABADC@@@A@A@@@@@AAAC@AA@@AAA@@@@A@@A@A@@@A@@@AAA@A@A@@@@A@ABA@A@@@A@AA@@@@CB@AA@AA@A@@@AB@@A@@@@@@@@C@@@A@@A@@@A@@@A@AA@@@@AB@@A@@@@@@@@B@@CB@@@ABCA@C@BA@@@A@A@@@AB@@@BBBA@@@A@@BAA@@@@C@A@@BA@B@@@@D@@@@AB@@@@@C@@@@@@A@@A@A@AA@@BA@A@@@@@A@@BB@@@ABA@@@A@@@A@AA@A@@@AA@@@@B@@A@@@@@AB@@A@@BA@@D@@AB@B@@AA@@@BA@@@C@@BAB@B@@ABA@@A@@AA@@ABA@AAA@A@@@@@ABA@AAAB@@@B@AA@@@@A@@A@@@@B@BA@@@A@AAA@@BA@@BB@@@@B@@@@C@@BAB@@@@AA@@@BA@ABAB@BB@@BA@@@A@@@A@@B@@@@@@B@@@@B@@@@@@@BAAA@ABA@@@A@@BC@@AB@@A@A@@@BAA@@@@@@@@@@@A@@@@@@@A@@@BA@@AA@@@@@A@@@@AB@@@@@A@@@@@@AA@@@@A@@@@A@@@@@@AD@A@@@@@A@@AB@@AA@@@A@@@@@A@A@@@AA@@@BA@ABABA@A@@BA@@DA@AAA@@@@@@B@@@B@B@BA@@@@@AB@@AB@@@@@AA@@@@BAAA@@@@A@@@@A@@@@CA@C@@B@@@@A@@@@@@C@@@@A@@BA@@@@@@AA@@@@@A@@@AAC@@BA@@@A@A@@A@CD@@@@@@@ABA@@@@@A@@ABA@@@@A@@BA@@@@@A@@@@A@@@@A@@AAB@@@@@B@@@@@BA@@BA@A@@BA@@A@@AB@@@B@@A@@BA@A@@@A@A@A@@BA@@@@B@@@B@B@@@@@BB@@@B@@@@@@@@@@BA@@@@@A@@@@@@BBB@AA@A@@@@@@@A@@@AC@@@@@B@@@@@AA@@@@@A@@AA@B@@A@@A@@@@@@@@@@@A@@@@A@@@@@@@@AA@@@@B@@@CB@@A@@AA@@A@@AAAB@AA@@@@BAA@@@AAAA@@B@BAA@A@DAAA@AAA@@BABAB@@@B@@@DA@AA@@@@@@A@@@A@ABA@@@@D@@@@@@A@@A@@@@@@A@AAA@AACAA@@D@@@B@@BB@@@@@BA@AA@B@BBB@B@@ABA@ABBB@@@@@@B@@@@BB@@@BD@@@DB@@B@@@@A@@AA@A@BB@@@BB@AB@BB@@@BB@@BB@@B@@B@BA@BBAB@@@B@@@A@@AB@@@@@BABB@@@@BA@@B@@@@@B@@@@BB@@@@B@@A@@B@@DB@@BB@BBB@BBB@@@B@@EA@@@@ABAB@@@@AAAB@@@@@BB@B@@@BB@BB@@@@@@B@B@@@BA@A@@BCB@@@@B@BB@@B@@@B@AB@BB@D@@@@@@@@BB@@BDBB@B@@A@@@@@A@@@@@A@@@@D@@@@@B@@BBB@@@B@@A@A@BB@@B@@BBBB@@@BB@@@A@@@@B@@@B@@@A@@A@@@A@@B@B@BB@AB@B@@A@AA@@@A@@A@@@@BAB@B@B@BAB@@@@@@@BA@@B@@@BA@@@ABA@@A@@@@A@@B@@@BAAC@@B@@AB@@@@@B@@@BAB@B@B@@BBBB@B@BA@B@@B@@@B@@A@@B@@@B@BA@B@@@@B@@@@@BB@@BA@@B@@@B@@AB@@@@B@BB@B@@@BAB@@ABB@@@@@@@B@BB@B@@@@@@BB@BA@@B@@@B@@@@BA@@B@@@B@D@@@@AD@@BB@@@@B@@BB@@@@AB@@@@@@B@@B@@@@@BB@B@@B@@@@@@@@@B@@@AA@@B@@@@B@BB@@B@@B@@@BB@@@A@@AA@@@@B@@A@@B@BA@@B@@@@@B@@@@AA@@@@ADE@@B@B@@A@@@@B@D@@@@@BA@@@AA@@A@@DB@@B@@@AB@B@@B@BA@ABA@@BA@@@@@@@@B@BABB@AB@B@@A@@B@@A@@@AB@B@@@B@@@@B@@@@@@B@B@@AB@@A@@BAE@@@AC@A@@@@ABA@@@A@AA@@A@AB@AC@@A@A@@@@@A@@@@@A@@AC@@A@@@BA@@@@@AB@A@BA@@@B@@@@@ABA@A@@@@AA@@@AA@@A@@AA@@A@@AAA@@AA@@AA@AAA@@AAA@AA@AAA@@@@AA@@BA@@@@BA@@B@B@BABA@A@AB@@@@@B@BAB@D@BA@@BBB@@@@@@@BA@AAA@@@@@@DBB@@@@A@@@@@@AA@@@AB@@@AA@@@ABA@A@A@ADA@ABAB@@ABA@@A@@A@@@A@ABAA@@@AAA@A@CAABA@@@AB@@A@A@@@AAAC@@@@@AC@@AA@@@A@A@@@@@@@B@@@@AA@@@A@@@B@@@AA@@@A@@A@@@@@A@@@@@@A@@@@@@@@@@BA@@BA@@@ABAA@ACAC@@BAAA@@A@@@AA@@B@AABA@@AA@@A@A@@@A@CA@@@BA@@@@ABA@A@@@A@@@@B@@CB@@A@@AA@AA@@@@@@A@@BA@AB@@@AA@CA@@@@AB@@@B@B@B@@AB@B@BAB@@@BABA@@@@@@@AAA@@AA@@A@@ABA@@@@AA@AA@@@AAAAA@@CAA@ABA@@@A@AB@@AB@@A@@@A@@@@@AA@A@@C@@CAA@AA@@AA@@AA@A@A@A@CCA@CAAA@@@@@AE@AAA@A@@@@AA@@@@AA@@@@A@@A@@AA@@AAAA@@@CB@@@BA@@@@@A@A@@@A@@@@@@A@CA@@C@@AA@@@A@AAA@A@@BABA@A@@BA@A@A@C@@@A@EB@AA@@AB@@AA@@A@@@@@A@A@@@A@@@A@@@@@A@@@A@@A@AAAAAAA@@AA@@A@A@A@@@A@@@A@@@@@A@@A@@@@AA@@@@A@@@A@@AA@@@@@AB@B@@AB@B@@@@@@A@@@@@A@@@AA@@A@A@AA@@AA@@@@@@@@A@@AA@@@A@@AA@@@@AA@A@@@@A@@AAB@@AAA@@@A@C@AB@@A@@B@@AB@@@@@@A@A@A@A@@@A@A@A@@@A@@@A@@@A@@@@@@@A@@@AA@@AAAA@@@A@@@BA@A@@@@@AB@@@@A@@B@@A@@@A@@B@@C@A@@@@@A@AA@BAB@@@@A@@@@@@BABA@@@@@@BA@A@A@@@@@@B@@@B@@A@A@@@@@AB@B@@A@@@@@AA@@A@@@A@@@@@@@A@@@@BA@@@ADAB@@A@@BA@AAAA@@A@@@@@A@A@@@@@A@@@A@@@AB@@ABA@AB@@@@@B@B@@@@@@A@@@A@@B@@A@@BAB@@@B@@@BC@@@@@@@@B@BA@@B@@@@@B@B@@@@BBA@@B@@@@@B@B@@ABA@@@@AA@@@C@@B@@A@A@A@A@A@AAAAA@CA@A@A@@AAAA@@@A@@@@@@BAAA@@@@A@@AAB@@AB@@@@@@A@@@A@@@A@A@@@A@A@@@@B@@A@@@AAA@@@@@A@@AA@A@@A@@A@A@AA@@AA@@A@AA@@A@@A@@AAA@A@@A@@@@A@@A@@A@AA@@A@@A@@AA@@@@@A@@A@@@A@@@A@AA@@@AAC@AA@@@A@A@@@C@A@A@@@@@AB@@E@A@@@A@@@A@@@A@AB@@A@ABA@@B@@A@@@@@AA@AA@AAE@A@A@@@C@A@C@A@A@A@@A@@AA@@C@@AA@CBA@@@CAAA@@@@A@A@AA@@A@CA@@@@@A@@AA@@@@AAA@@@A@@@AAA@AA@AA@A@AA@A@@AAAAAC@@A@@CAB@@A@@@A@@@AB@@A@@AA@@@A@@@@@AABA@@@@@A@@BA@@AA@AA@@AA@@A@A@@@@@A@@@C@@AA@@EAA@AA@@@AA@AB@@AAA@AA@@A@@@AAA@@@A@AAAA@@A@AAGABB@@B@@BDBA@@BA@AB@@AB@@@B@@A@@@@@BB@AB@@@@@@@@BB@@@@@B@@@@@@B@@@B@D@B@BAB@@@BBB@B@B@@AB@@AAABA@@@AA@@AB@@@@@BA@ABA@ADC@@@@@@@@B@B@B@@A@A@AA@@C@@A@@AAAA@@A@@@@BAB@B@@@@@B@B@D@@@@ABC@AFA@@@@@AB@@@@AA@@@C@AA@@@A@@@@B@B@@ABA@AB@@ABA@@@ADA@@B@B@B@@AB@BAB@BBB@@@BA@@B@@AB@@B@@B@@DBDB@@@BAB@@@@ABA@A@@BABA@AB@@A@A@@@@B@AA@C@@B@@@DCB@BAB@B@@BBA@@B@BB@@@AB@@BBAB@BDF@B@@@BA@@@@B@@B@@B@@BBB@@BAB@BABA@@BA@@@A@AB@@A@AB@B@@AAAA@@@@@@A@@A@@A@@@@B@@A@@B@@@BA@@B@@AB@@A@A@CAAA@CA@@AA@@ACBCB@@A@@BABAB@BA@@@CA@@@ACA@A@@A@@@@AA@@A@@A@@@@AAA@@A@@AA@AAAC@AA@@@@A@@A@@AAA@@AA@@AA@A@@ABABAB@BA@@B@@@@A@AAA@@BA@@AC@A@A@@@AB@B@@AB@@A@A@ABAD@@@B@B@@AB@@C@@@A@@@AB@@A@AB@@@@@B@@CBA@@@A@A@CBAAABAAA@A@@@@A@@A@C@@@@@A@@@@@ABBB@BA@@B@@AD@@AB@@@B@DA@@BABA@A@@@@@A@@@AB@@BB@@DBBBA@A@@@C@A@A@@@A@AB@@@@@@@BAB@B@B@B@@ABA@A@@@@@B@@BBDBD@@@@AB@BA@@B@@@D@D@@B@@B@BBB@@@BA@ABA@ABBBAB@@@BBB@B@@AB@@B@@@@BD@@ADABA@@@@B@B@@@@@@@@B@BA@@AA@@@@B@@B@@@@B@@B@B@@@AB@@B@@@@@@@@AB@@A@@@@@BB@@@B@@@A@@BB@BB@@BA@@A@@@BAB@@B@@@A@@@@B@@@@@@@@B@@@B@@BAB@@@B@A@@@@@@BB@B@@A@@B@@@@@B@@@@@B@B@@@@@AB@@B@@@BA@@B@@AA@@@@@D@@@@B@@@@B@@B@@BA@@@@@B@@@B@@B@@@B@@@@BB@@@@@@A@@@@B@B@@@@@@B@@@@A@@@A@@BB@@B@@A@@B@B@B@@D@@@@B@@B@@@@@@@CBA@@@@BB@@@@@AB@@@@B@B@@@BB@@@@A@@@@@A@@B@@@@ABB@B@BBA@ABADABB@@BB@@BA@@B@@DDD@B@@@@AB@B@@@@B@@@@B@@@@A@ABB@@@B@@@BB@BBAB@@@@@BB@B@BB@@AB@AAB@@B@@DA@@@B@@BBA@@@@B@ABBB@B@B@@B@BB@@@BA@@@BB@@B@@AB@@B@@@B@@@@@@@B@@BAB@@B@@B@@B@@@@@AA@@B@@@@@B@@@B@@@@@B@@@BB@@@A@A@@@@@@@@B@@A@@@@@@B@@@@@BA@@@A@@B@@@@CB@BBB@@AB@@AB@B@BA@ABAB@BA@AFA@BB@@BA@@BB@B@BBB@DA@@@A@@@@BB@@BA@@@@BAA@@@@AB@BABBBA@ED@BCA@B@@A@@@A@@BA@@@@@@AA@@AA@@BADBBABBB@@B@@@BB@@B@@@B@@@@B@BDBB@DBDD@B@DABD@DD@B@D@DBD@DBB@DBBB@BBBB@B@BBB@@@DB@B@@B@@B@B@@@BB@@@BD@BABB@@B@DB@B@@@FADB@B@B@B@B@BB@@@BDBFA@B@@@@B@@BB@@@@@AB@BBB@@@BAA@B@@@BB@@B@@@@@BAB@@B@@@@@@BABB@@@BB@@@B@@@@B@@BB@B@@@@@B@@@B@@@@B@@B@@@@BBBB@@@@AB@B@@BB@@B@A@@@B@@@B@@A@@BABB@@B@B@@B@@B@@B@@@BBB@@@@@@A@@B@@@@@@B@BA@@BB@@@B@B@@@AA@@F@@B@@B@BB@BC@@B@@A@@@@B@@A@B@AB@@A@@@A@@@@BCDA@A@@@CBAB@B@BB@BBBB@@BBB@BA@A@@BADCDABA@@DCDAFCBBBBB@@BB@B@B@@BB@B@@@ABBB@@BB@BA@ABBD@@@@D@DCB@DAJAD@DC@CBAD@B@BBFDD@HLBDJJHDDAFCF@BDAHAFABBDF@BD@BCBBB@@B@@DA@@B@@BDDD@B@B@@ABA@@BA@@@@B@B@@A@@@AB@@BB@@AB@B@@@B@@@@@B@BB@@B@@AB@BA@BDDBAB@@BB@@@BAB@B@@@@B@B@B@@ADCB@@ABBB@BA@@@B@B@@BBB@AAB@B@BB@@BB@@DLB@@@BB@BAB@@A@@@AB@BCD@@AB@BADEF@BC@AAA@A@CBA@@@@BA@@@@BA@@@A@A@A@A@@BA@@BCB@@AB@B@@CDAD@DBA@@B@FB@@@@B@B@B@@AB@B@B@B@@BB@B@B@BAB@B@@B@B@@@B@@@B@@@B@@@B@@B@@B@BBBB@@DA@@BAB@@A@@BA@@@@BD@@@B@@@DBBBBBDBBBABBB@BB@@B@@DB@B@@@BAB@@BBA@BBB@@@B@B@@A@@B@DAB@@@BB@B@@BB@BAB@@@@BB@@@@@@@D@@AB@@B@@@BB@@BD@@ABAD@@@BBB@@B@@BBBBDBB@@AB@B@B@D@BA@@@@B@@@B@@@B@@@BDF@@BB@@B@B@ABBB@@@@BB@BBBB@@B@B@@@B@@@@ADA@BB@@@BB@@@ABA@CB@@@BA@@@@BA@@B@@A@@@@@ABB@@BBAB@@@B@@B@@@@@BB@AB@BAB@@@@ABBB@@@BA@D@@@BA@BB@BB@BBDB@FB@B@BAB@BB@BB@@BB@@B@D@@@BA@@BAB@@ABBB@@@B@@@@@@@@@B@@B@@BB@@@@@BA@A@@@A@@B@@AB@@@@A@@@@@@B@@@BA@AB@@@@@@BB@@@BA@@@@BA@A@@@@@AACB@@@@@B@@@@ABA@A@@@@B@B@@@@ABA@@BA@@BAA@BADA@BB@BDD@BA@BFBDAB@F@B@BB@@B"],encodeOffsets:[[110923,23267]]}},{type:"Feature",id:"450109",properties:{name:"邕宁区",cp:[108.487368,22.75839],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@AAA@@@AA@AA@@@@@A@@B@B@@@@A@A@@D@@@@A@@@@AA@@@AAAA@@A@@BA@A@@B@@A@C@A@@DE@A@C@@@AA@@@@ABAAA@A@@@@B@@BBA@@@ABAB@@@B@@@@A@@AA@@@AB@@BB@B@@@@@@AA@@ABB@@@@AA@A@C@A@@A@@B@@AB@@A@@@A@@A@@AAAB@@A@A@@@@BA@C@A@@@A@@A@@@@ABAA@ADA@@@A@@@@CB@@@A@@@A@@@@@@A@A@@@@B@@ABA@A@A@AB@B@B@B@@@BBB@@BB@@AB@@@BAB@@B@@BA@@@ABA@@BA@A@AB@@A@@@AAA@A@CA@AAC@@AA@A@@AA@@AA@@A@@@A@@ABAB@@AA@@A@A@@@AB@B@AA@@@@@C@@A@ABAA@@@@@AA@A@A@A@@@@AAA@@BA@A@@@A@AA@BA@A@AAC@@@@@@@@@A@@@A@@@@CAC@@ACB@@A@AA@@A@C@@@CA@@@BA@A@A@@@A@@B@@BBA@ADAB@B@B@BA@@@AB@@BBAB@BA@@BA@@@@@AD@B@B@@@@@@A@A@@B@@AB@@A@A@A@A@@BA@@@A@A@@@@@AB@@@B@@ABA@@BABCD@@@@BB@@@BB@@B@@A@@B@@C@@@C@@@@A@@BC@@@@@@AAA@@A@@A@@@A@@AA@AA@A@@ACDADABA@A@@@ACA@A@A@@@@A@A@@ACA@AA@@@AAAA@@@@A@@AC@C@@@A@@@AB@@A@@A@@@CBC@@@C@C@@A@A@A@@A@@@A@@AACC@@@AAA@@@A@AAC@@A@@@@@AAA@@AA@BA@A@@@@@@@A@AA@ABA@@@@@@@AAA@BBA@@@@A@@@BAA@@A@@@@A@@@BA@@BA@A@@@A@ABA@AB@@@BB@@BA@@B@B@B@@@@A@A@@@@B@@AB@B@@A@@B@@A@@@A@AB@DAB@BABAB@AA@AA@@CAAAA@AAAACC@@G@AB@@@BA@@BA@A@@@@@@@@A@@A@@@A@A@@B@@@BA@@@@BA@A@@BAAABA@@@@@@@@@AB@@AB@BABA@@@@ABA@@A@@@CA@@@BB@@B@@AB@@@@C@A@@@@@@BA@A@@@CF@B@@B@@B@@A@@@@@A@@@ABA@@@@BA@@@@@BB@@@B@@AA@@A@@@@AA@@A@A@@A@@@BB@@A@@@@@@@@@A@@@@AAA@@@@A@@@@A@@@@A@@B@@A@@AA@@@@@AA@@BC@@@@@C@A@@@AB@@A@@A@@@A@@@ABA@@A@@@@A@A@@A@A@@@@@@AA@BA@AB@@A@A@@A@A@@A@@BA@@@A@@@AB@@AA@@@@A@@@@@@AABA@@@A@@@@BA@@@A@@@@AABA@A@AA@AA@A@A@AB@@A@@@@@A@@BA@@@BDAB@@A@@@@B@@B@@@AB@B@@AB@@A@@@AB@@@@@@A@ABA@A@A@AB@@@@@BB@@@B@@B@BA@A@@BAAA@A@@@@B@@@BB@@@A@@@A@@@@@@B@@AA@@A@AA@AA@@@AAB@B@@@@A@@AA@AA@@@@@C@@@@@@B@@@@@B@@@@@BA@A@CA@AA@@A@@@@@@C@A@@ABAA@@@A@@@AAA@@@@@DA@AB@B@@A@@@A@A@@@@A@@AAA@@A@A@@A@@@@BABB@@@@BABA@@@@FB@@@A@@AA@AAA@AAA@@CA@@@AB@@@@A@@A@@A@@A@@@@@A@@@AB@@@@AAAB@@@@A@@BB@@@A@@@A@AB@AABA@@@@AA@@AA@@A@@AAA@@BAA@@A@@A@BBB@@@B@@A@@@CA@@C@@A@@AA@@@@@A@@@@A@AA@BAB@BA@A@AAA@B@@BAB@@@@A@@AA@@@C@@@BBBD@BBB@B@B@@@@B@@@@B@@@@C@@@@@AB@B@B@@@B@@@@B@@BCB@@A@@@A@ABAB@@BB@BBBCBB@B@ABA@@@BBBB@@B@AB@@@@BBA@BB@BB@@@BB@@@BA@@D@@@A@@B@@B@@@@@@B@@@@@@B@@@@@@@@@@@BB@@@@ABB@@@B@@@@BB@@@@A@@@@@D@@B@@@B@@@@@@@BBBA@AA@@@@@@@B@@@@AB@@@@@@@@@@@A@@AA@@@@A@A@@@A@@@@@AB@@@B@B@B@@@BAB@@@BA@@@A@@BB@@@AB@@@BAB@@AB@@@@A@@@@@A@BB@@@B@@B@@@@@@B@@@@AB@@@B@@B@BA@@@B@@@@AB@B@@@@@@DCB@@@@B@B@@AB@@BD@B@@@B@@@@BB@@@@@@AB@@@B@@D@@@@@@@@B@@A@@@@DDB@@@@@B@@B@@@@@BBAB@@@@BB@@@@A@@BA@@B@@@@ABA@A@@@A@@@@@@@BB@BCB@@AB@@@BABAB@BAB@@B@@B@@@B@B@@@@@B@@BB@@BA@@@B@@@@@BBC@@@@@@@B@@B@@@@@BB@@@@@@@B@@@@BA@@@@@B@@@@BB@@AB@@B@@@@@@@B@@@@@@@@@@@B@AB@@B@B@@@BA@@AD@@@B@@AB@BBBAB@@@@@@A@@@@@@A@@@@A@@@@B@@@B@@AB@@AAA@AB@BAB@@B@@B@@A@AB@@@D@@A@@@@@AA@@AB@@BB@B@B@@ABA@@@@@@BB@@@@@BBA@@@A@BB@BAB@B@@@@@BBB@BAB@BB@@BB@@@AB@BA@A@AB@@@D@@AB@@B@@BA@A@@BC@@@AB@@@BA@@B@@@@@BA@@@@@BB@@B@B@@B@B@@@B@@AB@B@@AA@@@B@@@@@BAB@@BBB@B@@@@B@@@@D@@@@@A@@B@@C@@@@@@AAB@@@B@D@@B@AB@@@B@@ABAA@@A@@B@@@B@B@@ABD@B@AD@B@@DA@@@A@@@@@@B@@@BA@@@@BBB@@@B@@@B@@@@B@@@D@@@@@@B@@@BA@@B@B@@BBBA@@D@@B@@B@B@@@BAB@B@B@@B@B@B@BB@@B@@@B@B@@@@B@@B@BB@@BBD@BB@B@@@@@B@B@@CDAB@B@B@@@@BB@@AB@BABA@AB@@AB@@@@@B@@@@AA@@A@@@@@AB@B@@BB@@AB@BAB@@@@@@AD@@@BB@B@@BB@@@@B@@DBB@@BB@@@B@@BBB@@@@A@@BB@@@B@@BB@@@@BA@@@ABB@@B@D@@@B@B@BA@@BA@@@@@AB@@@@@@@@@@@BB@@BB@@@B@@@@@@B@@@@B@B@@B@@AD@BB@@B@B@B@@ABA@@@BB@@@BB@@B@@BBB@@B@B@@@BB@@@BBBB@@@@@B@B@@@@AB@BA@A@@@CB@B@@A@@@A@@@@@@@@B@B@@A@@@A@@A@@@B@BC@@@A@@@BBB@B@@@@B@B@@A@@@CA@@@BBB@D@B@B@@A@A@A@@@@DA@@BAAA@A@@@AB@@@B@BB@@B@BAD@@@B@B@B@BAB@B@BA@BD@@AD@BB@@BB@BA@@@A@C@A@@DAB@B@@BB@D@B@B@B@FB@B@@@B@DAB@@A@EBIF@@@BBB@BD@@BDBBBB@@@B@@@AD@@@@AA@@@@@B@@@BA@C@A@@@@@@@AB@@@@@AA@@@@@@BAB@@@@A@@B@@B@@ABBA@@@@@@@@@BB@@@@@DA@@B@@BBAB@B@@@B@@B@@B@@B@D@B@@@@BA@@B@@A@A@@@@B@@@@@B@D@BA@@B@B@D@@@BB@B@B@HFB@BBB@B@@B@@@BBB@@@@ABA@@@@@AB@@@@A@@@A@@BAA@B@@AB@@A@@BAD@@@@BA@@@B@B@BA@@B@@@@B@@@@@A@@@@B@@@@@@@@@@@B@@@@@@B@@@@@@BD@@@@@@B@@CBA@@@A@AB@@@@@BA@@@@B@BDA@@@@@@ABA@A@A@@BA@C@ADAB@@BB@BBBA@@BBBB@@@@@BBD@AB@@B@BBBBA@AB@BC@@AAB@@A@@H@@@@CBA@@D@D@BBBDD@B@F@H@@@D@@BDB@BBD@B@B@D@HAB@@@D@D@D@B@BBFBDB@@FF@@FDBBBFBBFN@BB@BBDBB@D@B@BA@@B@BABEBE@CBA@ADEBCBADAFCBABA@A@ABA@C@CACAAAE@A@@@@AC@A@C@A@E@@@A@@@A@@BC@@@@BABA@A@@@@B@BA@@@@B@D@B@BBB@@@@BDB@B@@@@BD@B@B@D@B@@@D@@@HAH@BAD@BAB@D@B@B@B@B@@@@@@@@BDBDBBB@BBBB@@@@B@B@@@B@BBD@B@D@F@B@@@BA@A@@AC@AA@@AAC@AAC@A@A@ABA@ABABAB@B@B@@@BBBBBFDF@@BDB@BBB@FB@@FBB@BBHDHFBBBBDDBBB@HDDBHBB@FBBBB@BBDDDD@@B@@@@C@A@@@A@@DC@@@@@A@A@@BA@@BABCB@BCD@F@B@D@@@@@@AA@@ABAD@@@@A@A@@@EB@B@@@B@BA@AB@@@BAD@@@@@B@@A@@@A@@@A@@AA@@@@AA@@@ABA@@BAA@@AE@@@@A@ABA@@AAB@@A@@B@@@B@@A@@@@@A@@@@@C@@B@@@@@BAA@@A@@@@@A@@BA@A@A@AAC@A@@@@BA@A@A@ABAB@@A@@BC@A@@BCA@AA@@@@@AC@@A@@A@@@@@@A@@@@AAA@@@@@@A@@@@@A@AB@BA@@@AD@AA@@B@@AAA@@@A@@@@@@BA@@@A@@@ABA@A@@@@BA@@@@@@AA@@@@@A@@@@A@BA@@@@@@@AA@@@@A@@@@@@BA@@@@B@@A@@A@@A@@BAB@@@@AB@B@@BB@@BD@@A@@@AB@@@BB@@A@@BBB@@B@@@@@B@@@@@BBA@@@A@@B@@@B@@B@BA@@@B@@BB@@@A@@DB@@@BBBB@BA@@@@@@@CAE@A@A@@B@@@@@B@AA@@@@B@B@@@@@@@@@@A@@@@@A@@B@@A@A@A@AAA@@@@@@@@A@@@@A@@@@@A@@@@@@@@@A@@@AA@@@@ABA@@@A@@@@@@@A@@@@@A@A@@@@B@@B@@B@@A@@B@@@@@@AA@@@@@@AB@@@@A@A@@BA@@@@BC@@BABAAA@@A@A@@AA@BA@@@@D@@@@@@A@@@@BA@@@AA@@A@@B@@@B@@@@@BA@@@@@@@B@@B@@@AC@@BE@@B@@@@@BA@@@@@@@@@@@@B@B@@A@@B@B@B@@@B@@@@@AA@@B@BA@@B@@@@@@@@B@B@@B@B@@@@@@@B@BB@@@@B@@@@@B@@@@AB@@@BB@@B@@@B@@@@@B@DA@AB@@@BA@@@@B@@@BA@@@AB@@AB@@@B@BBB@@@@@BA@@BB@@@A@A@@B@BBB@@A@A@@@@@A@@@A@@B@B@BB@@@A@@@A@A@@@AAA@@@A@@A@@A@@@@@A@@A@@@@@BA@ABAB@B@@@BA@@@@@AB@@@@@@@B@@@B@B@B@BB@@@@B@@@@@@B@@BB@B@@@@@@@BB@BB@@@B@@@@@@@@@@@BF@@@BBBA@@@@BB@@@B@BBB@BBB@@B@@@@A@ABA@ABB@@B@@BB@@@@ABAB@B@@@AA@@AA@@@@@ABA@BB@@@@@BAB@A@@@@AA@B@@A@@@BD@@@@B@@@@@BB@@@B@B@@ABA@A@A@@@@AA@ABA@@@@BB@BB@@@BBB@@@B@@@B@BB@@@@DAB@@@B@@BB@@B@@ABB@B@B@@@AB@@AB@@@BAB@@HB@@@A@@B@B@@@@@BB@@@@B@@@B@B@@@BA@@@@@@@ABB@BB@@BB@B@@@@@@@B@@D@@@@B@@@B@@B@@@B@BAA@AAA@BA@@@@BBB@@BBB@@@B@@@@@B@@B@@A@@@A@@@@B@@B@@@@@@B@@@B@@@B@@B@@B@B@B@@B@@@B@@BBB@BA@@@BBB@@BB@@BB@BB@@@B@@A@AB@@BB@@@B@@A@@@@BA@@@@B@@@BB@B@@@@A@@@@@ABB@@@@B@B@@@B@@@@@B@@@AB@@@B@@@@BB@AB@@A@ADBB@@@@BA@@B@@BB@B@@BABBB@@@@@@B@@@@BBAB@BDA@B@@@@BBBB@BB@@B@BBA@@BB@B@@@@A@@@A@@B@B@@@@BBAB@B@B@@@BB@@AB@@AB@@@@B@@DA@@@B@@@BA@@BAB@B@@@@@@B@ABB@A@@@@BB@@AB@@@@@BB@@AB@@@@B@BAB@BA@@B@@AB@BB@@B@@A@@B@@BB@@@BA@@@B@@@B@@B@BBB@@@BA@A@ABA@A@AAA@ABA@@@@B@@A@@BB@@AD@@BA@@@A@@@A@@D@@B@@@@B@@@@A@@@AB@C@@AA@BA@@@@A@@A@A@AB@@@@A@ABBB@@@@@@A@@@@B@BB@@@@@B@B@@B@B@B@B@B@@@@@@A@@B@@@@@B@@@@@@A@@@@B@@@B@@@B@@B@A@@@A@@@@DAB@@@B@@@@A@@@@@@B@@@@B@@@D@@BB@@@@BA@@B@@B@@@A@@@AA@@AB@@@AA@@@A@@@A@@@ABA@@@AAA@@@@BA@@@A@@@@@AA@@A@AB@@A@@@AB@BA@@@@B@@@@@@A@@@@BA@@@@BB@A@A@A@A@@A@@A@AA@@A@@B@B@@@@@B@@@@@@@B@@A@@@A@@@@@A@@@AB@@A@@BA@@@@AA@AAA@@@@B@@@B@@A@@@@@@BC@@@@@AB@@B@@BABA@@@@@A@@A@@@A@A@@@A@@@A@AA@@@A@@BAAA@@A@C@@@A@@BB@AB@@ABAB@@@AA@@@@A@@AA@@@@BA@AB@AAAA@@@A@@@@@A@ABCBA@AB@@A@@@@A@ABA@@@AB@A@A@@B@@@BA@A@A@A@@@@AAA@AAA@@@BA@A@C@@B@@@@@AA@AA@@A@@D@@BB@@@BA@AFA@@@A@@@A@@D@@@@BBB@@BABBB@@ABBB@B@BAB@@@@@@@@A@@BA@@@AB@@AB@ACDA@@@@@AAC@@AABC@@@A@A@@A@@@A@@A@A@@@C@@@AA@@@@C@A@@@C@@DC@A@C@@B@B@@@AC@AB@@@BB@@B@@ABA@@@@AAA@@@@@@@@AB@@@B@B@BA@@@@BA@@@AACA@@A@A@@@@BB@@@A@@B@@@@A@@BAB@@BD@@@B@B@B@@@@A@AA@@@BA@@@@@A@@@AAA@@@@@A@AB@BA@@@@DB@@@A@A@@BB@@BB@B@B@@ABA@@@@B@B@@@@@B@B@@AB@@BB@@@B@@B@@@@@BBB@@A@@@@B@@B@@BA@@@@@A@@BA@@B@@@B@@AB@@A@@@AA@@@@@@A@@BA@@BAAAB@@AA@AA@@ABA@@B@@@@A@@@CC@@@A@A@A@A@A@AB@BC@@@@BB@@B@B@@@@@@AB@@@B@B@@A@@B@@A@AB@B@B@@@BBB@@B@@B@@@BBB@@A@A@@BA@@@A@@BABABCB@@C@@BA@@BA@@AA@@B@@A@A@@AAAA@A@@BA@@@A@A@@@@A@@AA@@AAA@@A@@@BB@@@BA@A@@@@AA@@ABAA@@@@A@@@AA@AB@@AAAAAA@@BA@@A@@A@AA@@@A@BA@@@AC@@@@@E@@A@@@A@A@@AA@@@@AACAA@@A@@A@@@A@@@@@A@@@A@@@@@@@@@@A@A@A@AA@AAB@@A@@AA@BA@A@@@A@@B@@@@@B@@AB@@@A@@CA@@@BCB@A@@@A@@AA@@A@ABA@@AA@A@@@AA@@@A@@A@@@@AA@A@@@@ABAA@@@AA@@@A@@A@AA@A@@@AA@AB@@@@A@A@A@@@@@A@A@A@@@A@A@@@@B@BA@@AA@@A@@A@@@@BA@AB@@@@C@@@@A@@A@@@@@AB@@AA@@AB@@CA@AA@@BA@@AA@@@@B@@A@@B@BA@A@@BAA@@@@@A@@ACA@@@A@@A@@@@B@BA@@@@@A@@@C@@@AA@@A@@AA@@A@@@AAAAAAA@BA@@@A@@@ABAC@@D@@AB@@@ACB@@AB@@@@A@AA@A@A@AA@@A@@AC@@A@@AAA@@AA@AA@@B@@@B@B@@@@A@@@@AC@@@A@A@@@@@@@C@A@@BA@BB@@@@@B@@A@@@A@AAC@@@@@@@A@@@@@@@@@AB@@@AAAB@BA@@@@AA@A@@B@@AA@@A@@@@@@@@A@@@@AB@@A@@@AA@@@AA@C@A@@@@A@A@ABB@@B@B@@@@A@AB@@A@@@@@@A@@AA@A@@BA@A@@@A@@@AA@A@@A@@@A@C@@AA@@CDAAAA@@A@AAA@@B@@AB@@A@@@@C@@@@AB@@ABAD@@@@AA@A@A@@@@A@A@@B@@@BA@@AC@@@AA@@@B@B@@AB@@@@@@@@A@@@A@@@CACB"],encodeOffsets:[[111323,23029]]}},{type:"Feature",id:"450107",properties:{name:"西乡塘区",cp:[108.313494,22.833928],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@AA@A@APC@@B@BCCCGCCACA@CDCBEBGCE@A@@C@AAC@@A@AA@C@CBI@CBCBE@EAA@CDAFA@C@ABC@AAABA@@@A@CB@@GCC@AAC@A@A@CB@@@B@BA@@B@@AB@@CB@@AD@@@BB@B@@@FN@BBB@@@B@DB@B@AB@DBD@@@@@B@B@@@D@BBB@@@BA@@BAD@@AFIAAB@B@@@B@F@@@B@FEF@@@BC@GHA@A@A@@@C@C@C@@@O@AB@B@@A@@@AD@@CBA@A@C@A@@AA@@A@@EC@BA@A@MBABA@E@@B@@A@@BC@A@A@A@A@EAC@AAM@CBIAA@E@A@A@A@C@@AA@A@@@@BA@AB@@A@AB@DAB@@@BA@@B@@@BA@BD@@A@@D@DA@@B@@@@@@@BA@@BABA@A@@B@@ABA@AAA@A@A@AB@@ABA@@BA@A@@BCBABA@@D@@C@CDA@AAC@@BA@C@AAAAA@@@@AA@A@@@@@A@@@@@@@@@@A@@@@A@@@@@@@@@AAA@@@@@@@AB@@@A@@@@@@A@@@@@@@AA@B@A@@AB@@@@@@@@@@A@@@A@@B@@A@@B@@ABA@A@A@A@A@A@AAABABA@ABA@A@A@AB@@CDABA@AAA@A@@@A@AA@@C@A@CBA@A@AB@@@@AA@BAB@@C@AB@@ABA@AB@@@B@@@@@D@@BBABCBA@A@@B@@A@A@@@@@ABAB@@AB@@@@AB@@AB@BABABA@@B@@AB@BA@@B@D@B@B@@A@@B@@AB@@A@@@A@A@@@@@AB@@A@@@A@@B@B@B@BA@@BAB@@@B@@@@ABBADD@@@@B@@DB@@@B@B@BBD@B@@@BB@@B@@@@BBB@BB@@BBBFB@@B@B@@@DBB@@B@B@BHBB@@@@BB@@BD@B@@@BBB@@@D@D@D@B@BBB@@@B@B@B@D@B@@@BB@@@BB@BB@B@@@BB@@@B@B@B@DAF@F@DB@@@BBB@@@@B@@B@B@@@@AB@@ABAA@@@BA@@@AB@BBB@BAB@BB@@BB@B@BB@@AB@@DD@B@@B@@@B@@A@@BAAB@@ABB@@B@@@@@B@@B@B@@@B@@@BA@@B@@@@AB@@@B@AA@@A@@@B@@@AA@AAA@@B@@@@@B@B@DAB@@@B@B@B@@@BBB@@@BA@@@AB@@@@A@@A@@@@@A@@@@@@A@@@@A@@A@@@@BBD@@@@@BAB@@@@ABB@@@@@@B@@B@@BB@B@@@@BB@@B@@@@@@@B@@@@A@@@B@@B@B@@@B@@@A@@@A@@@@B@@BB@@@@@B@@B@@@@@@@BA@@@@B@@A@ADCBCAA@@@@AA@@@A@@FA@A@@@C@@B@BAB@@@@AB@@@@@B@@A@@@A@AB@@AD@@@BA@@@A@AB@@@BBBBB@B@BD@B@B@@@B@BAB@@@@@BB@@@@@@@B@@@DBB@@B@B@B@BBFBBB@DBF@B@@BDBB@@@FB@@BBBD@@@B@@BBB@@@@BDB@@D@@BBBB@B@B@@AB@@BB@BB@@B@@@@B@@@DBB@D@@BB@@@@BBDAF@@@B@B@@@BBB@@DB@@BBD@@B@@AB@B@@BB@@@B@B@@@B@@AB@@B@@B@@D@@B@BB@@@BBD@D@@@BBB@B@BABBB@@@D@@DB@BB@DB@@ADBB@B@A@@@BB@F@H@@@B@@B@@B@@@BB@@@@BBBBBB@@BB@BB@@@BB@BBD@B@@@@AB@@@@B@@BB@@@@@@BB@@@B@B@@@@B@B@@B@@@B@B@@@@@@B@@B@@@B@BBB@DBB@@@@B@@BB@B@@@B@@@@B@@@@@B@B@@@D@DAD@@@LVDL@@B@B@@BBA@@@B@@B@BB@B@@BB@@@@@B@@@@@AB@B@@@@@@@@@@B@@@@BB@@BB@@@@B@@@B@@@@BDB@@BA@@@@BB@B@@@BBB@@B@BA@@BBBBB@@@B@AA@@@A@A@A@@@ABBDDB@B@@B@@@B@@@@@B@@@B@@@BA@@@@@ABBBAD@B@@BB@@BB@@@DF@DABBB@B@BBB@D@B@B@FB@BBBB@@@BAD@@@B@B@@@B@D@B@@B@@@DADADB@BBD@BBB@@@BBB@@D@B@@AB@@@FB@B@@@B@@@B@@AB@DBB@@@B@B@B@B@D@@ABBB@@@@DB@@B@@@B@B@@B@B@BDB@D@B@@@BB@@B@B@@AD@@@B@@@B@BA@@B@@AB@B@B@DB@BBB@AB@@ABA@ABACAA@AA@@CBAA@A@AC@BAAA@A@AB@EAAC@A@A@@@AB@D@B@D@@@BAB@@BB@BA@@BA@@@AB@D@@AB@B@@A@@BAB@B@@A@A@@AC@@BA@@@AA@DABA@AB@BBB@B@@@BA@AB@B@B@DD@ABA@@@ABAB@@@B@B@B@ACB@AA@@A@@@A@@AA@@@@A@@@AAAC@@@@C@@@A@@@A@ABAB@@@BBB@DB@@@@B@B@BBA@@B@@@B@@BB@@BA@@D@@@B@@@B@@@BBB@@@B@B@@@B@BA@@B@@BB@@@@DB@@@BD@@@BDB@@BB@@BAB@@@B@B@BB@@@@B@@ABB@@BB@@B@@A@@B@@@@@@@B@BBB@BB@ABBB@BAB@DABB@AB@@A@@B@B@B@@AB@D@@@BA@AA@BAB@@@BB@@D@@A@C@@@@@A@AA@A@@@C@@@@A@@C@@@A@@B@@AAAA@@@A@@@@BA@A@@@ABADCBA@@@@@A@A@@B@BA@@BB@A@ABA@CAA@AAC@C@AA@@@@AB@B@B@D@@A@AB@BA@@@BB@B@BA@@A@@A@@@AB@@EBA@C@@AAA@@@@ABAB@F@@CB@B@DABC@ABAB@B@AA@AC@@AA@@@@@AA@@A@AA@@@@@AA@@@A@A@@@A@A@@@@@@@@@AA@@@@@@@@@@@AD@@A@A@A@AA@@@AAACAAA@A@@ACA@AAA@@BA@@B@BA@@@CAA@A@ABA@A@A@A@AA@@AA@@ABAA@AA@@BA@@@AB@@A@@@A@AAAAA@A@AAA@A@E@A@A@AB@BAB@BAB@BC@ABA@@@@B@@CB@BC@AC@A@A@@AAAC@EA@A@@BCBABA@A@A@A@ABAB@D@@ABA@A@ABA@@AAAACAIEA@AAAAEEA@BAB@JCBAB@@AD@D@DB@AFBB@DBDAB@BBDBBBDBJBBBBBDB@B@BA@@BABAF@BB@BBBB@BB@DB@BA@@BB@BBBBBAB@B@D@B@BBBB@BF@DBDBD@B@B@BAB@BABABADAB@D@@@B@@BB@B@@@@B@DBBBBBADBB@B@BABABAB@BA@@B@B@J@@@F@BAB@B@BD@B@B@BAB@BBB@@BDBDBBB@BBB@BB@BD@@BDB@B@@@B@B@B@BBBBBB@B@BAB@BBBBHBBBDABD@@BBHHB@@@DBhNDBDBB@RDD@@BHAHFF@DB@@@@@@FILABBB@B@@ABA@A@A@@AA@AA@@A@@@A@@AA@@BC@@@A@@@@@A@@@ABA@@AA@@@@@A@@@A@@@@@A@@@A@@B@BA@@@A@@AA@@BC@A@A@@BA@A@@BA@C@@@A@@@A@@ACACAC@A@C@@AABCAA@A@@@AB@@G@@@CBA@A@A@A@C@@@A@A@@@A@@AA@CDC@AB@@@@A@@C@CA@@BC@@A@@@A@@@@CDC@@@A@@A@AB@BAB@BAA@@AAAACC@AAC@@AAAA@A@A@@BECG@A@CCE@ACC@@EC@@BDAB@@B@@BB@EB@@@@A@@@@@AA@@A@@@@AB@@@@AAB@@@@@A@@@@@@A@@@@@A@@@@A@@@@@@B@@@@A@@@@@@B@@@B@@@@@@A@@@@A@@@@@@@B@@A@@@@B@@@@@@CBA@@@A@A@A@ABAA@@@@@A@@@AAA@@@A@@AA@@@@@@A@@@@@@@@@AA@@@@AB@@@B@@B@B@B@@BB@@B@BBB@A@BAA@@@@@B@AA@B@AA@@@@A@A@A@@A@@@B@@AA@B@@A@@@@@@A@@@@@A@@@A@@@A@AB@@@@@@@@A@@@BAA@@@@ABA@A@@@C@@@CBC@@@@@C@@@ABC@@@@@@@@@ABB@A@@BA@CA@@AACA@@@@ACA@@GAECCA@@@@BCCACA@@@@AAA@@@AAA@B@@C@@@ABA@A@@BAAA@@@@@@@AD@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@BA@@@@ACA@@@C@GAA@@@CCACA@@@AAACABAA@@CA@@EACA@@IFA@A@ABAB@@AB@@ABCBCD@@A@@BKJGF@@GH@@A@GFKB@@@@E@@B@AEB@@I@C@KAWEGAIA@@MA@@CAM@@@A@K@CCAABC@@@@@@@@JOBAHMBA@@FIAO@MAAKMKKUMC@A@MASDEBOLOLABMJebB@MJAJ@D@FJNJN@@LNFTABC@C@E@WSCCOCABEHAD@BG@GACA@@ICCFCJ@HCN@@CPEFAB@@E@AAECSUAACACAIG@A@A@A@@@@@AHEHDFNL@HG@IKEA@@BAA@@KCM@KGCEDKME"],["@@AACA@@AA@@A@@@@B@@A@AA@@A@@BA@B@@B@@@@@B@@@@A@A@CAA@A@@@AA@@A@A@@@@AA@@@B@@A@A@@@@A@BC@@BA@@@@AAA@@A@@AA@@C@@@A@@@ABAA@@@@A@@@A@@@@@@@A@@DCDADAB@@AD@@@BA@@AA@AA@CA@@A@@@A@A@@A@@@AB@@@@AD@@@F@BB@@B@@ABA@A@A@A@@B@@@BB@B@@BB@@D@B@BAB@@@B@@B@D@B@@@@AB@@AB@D@B@BABB@@@@@@@BCBA@A@@BABAB@B@@@BB@@@B@@@D@D@B@@@@@@@@@@BA@ABC@@@A@A@A@@@@@@B@@@@BBDB@BB@BCD@@@@@@BA@@B@@BBD@B@@B@BA@@B@@@B@@FD@DB@@@@BD@D@D@BB@A@AB@BA@@BA@@B@@AB@BABAB@@@FB@@B@@@B@@AB@@@B@BBB@@A@@@@@A@@@AB@B@@@B@BAB@@@B@B@BA@@@A@@A@CAA@A@C@B@BE@A@A@CB@@@B@CECC@@BAB@@A@@AA@A@AA@AA@@@AA@"]],encodeOffsets:[[[110622,23361]],[[110600,23630]]]}},{type:"Feature",id:"450110",properties:{name:"武鸣区",cp:[108.27467,23.158595],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAAAAA@C@A@A@ABAAAAA@@AB@@ACAA@@AAAAAA@@ABEBA@AB@@A@ACAAAAAIACAAACAAAA@CBCAA@EA@BCAC@C@@BA@ABIDA@ABB@FFBBBBB@JFDBBBBB@@AB@B@BAB@BC@A@AB@B@B@B@BABABAD@@@BFBD@BB@BB@B@D@@BADA@@DA@@@@@AB@BADA@ABA@ABA@@B@B@B@F@BBB@B@BBBBB@B@B@@@BA@@B@@AB@@BBB@AB@BB@@BB@@B@B@B@BAB@B@BBB@D@@ABA@@@AB@@BB@BDB@BB@B@BBBDBB@@B@@B@B@B@BC@@B@@@@@@@@@@BB@@@@@@@@B@B@@@B@B@@@B@@B@@@@BBB@@@BB@@@@B@@BD@@BBBA@A@AB@BADCBA@A@@DE@A@AB@B@@B@BB@@@DAB@FA@@B@@@BB@@@ABA@A@@A@@ABA@@B@BC@A@A@A@@B@@B@@B@DBD@BBB@DAB@B@BAA@@ABA@@@@B@B@@@@ABCDAB@B@@@BAB@@@@@B@@BBBB@@@AB@@@D@@@@B@@D@@@B@B@@B@B@@@@@D@BC@@@AA@@A@ABB@@BAB@@C@A@@BA@A@A@@@@BA@@BAACBA@ABA@AA@BAAA@AAA@@@@@@@A@@@@BA@@@AA@@AA@BA@@@@@AAA@A@@@A@AB@@AA@@CA@A@@AC@@A@@C@@A@@AA@@@ABA@@@A@A@@@A@AA@@A@@@A@@@C@@@AB@@AA@@@A@@@AB@AAA@A@@@@@CAA@AA@@A@AB@B@B@@@B@@@D@@D@BB@B@@@B@@B@@BB@@@B@@@BBA@BDA@A@A@@@A@AB@B@@AB@BCCA@A@A@@BAB@@A@A@AAA@@BABCBB@@B@@AB@@BD@@@B@BA@A@AB@@@BA@A@@BC@A@@B@@AB@@ABA@@AA@AB@@C@A@C@A@@B@@@B@BBDFBA@@B@BBBABD@@B@BBBDA@@BBB@DBAB@BAB@BA@@BCBABC@@BABABAB@BABAB@@C@A@EDEBEDDB@@AB@BA@AFAB@BCBAD@B@BAB@@AB@B@@BD@@BB@@A@@@ABC@@D@D@B@BBD@B@@@BADB@@@@B@@@@BB@@@B@B@B@@@@@B@@@@@@AAA@@BA@@@@@@B@B@BB@@B@B@@@@@@@B@@@@@BA@@@@@A@A@@@A@@BA@A@@B@@A@@B@@@B@@B@BB@@@@@B@@@@@@A@AAA@AAAA@@A@CB@@A@AAA@AB@@@B@D@B@B@BA@@@A@ACA@AB@@@BB@BB@@AB@@ABAAA@@A@@@C@A@AA@EFABAAA@@ABABAAA@A@CCA@@ADABA@AA@@AC@@BA@@@A@@A@A@@DA@ABE@CAA@BA@@DA@@CACBAAAAAA@AAAC@EBC@C@@@@D@B@B@@@@@D@@@BADCD@B@B@@AF@@@DBB@DA@@BA@@@A@@@@BAB@B@B@B@@BB@BBB@@ABAACB@B@@CB@@A@ABA@@@@@@AA@@AA@@B@@@@AB@BABA@@@BB@BBAB@BB@@B@BBB@A@@B@@@@ABA@@@AB@B@@AB@@AAA@A@@@AA@@A@@A@@@AAAA@A@CBABC@@AAACAA@A@@A@@@AA@@@@BA@AAAA@BCA@B@AABC@@@A@A@ABBBB@@ADB@@D@@BBDB@B@B@@DB@@BCF@@A@A@AA@@CDEA@BABAB@BEDAB@@ABA@A@CC@AABCA@AECEFBD@B@@@DAB@@ABDD@B@@A@@@A@@@A@AA@@AD@B@@ABDB@@AD@@A@@@@@AAA@@@CDA@AAABA@BB@BA@DD@A@@DBB@@BB@BBD@@B@BE@A@A@A@@BA@@B@B@B@BCA@@C@AB@BCBA@A@AB@BA@C@AA@@@A@CCA@@A@@@A@@@@B@BA@A@@@A@@@AA@CACA@@AA@GB@A@@A@AA@@ACCA@A@ABA@AA@@@C@@AABAA@@CC@@CA@@AA@@@ACA@AAAA@A@@@@@A@@@@BAB@BC@CBBFB@BB@@@B@BABABFDB@B@@@AD@@@BABAB@@@BA@@BA@@AC@CA@AEAAAA@CCC@@BA@ABA@@AA@A@@@A@@@ABA@A@@@BDA@@@A@E@AB@BB@AB@D@@@DBBBB@@BDBB@BABBDDHHDBADBBBD@DDCD@B@B@B@BB@@BB@D@D@FB@BBBB@BADBB@@BB@ABBDA@CBAAC@AAA@GAE@KCA@A@@AA@AAA@EDABDH@@ABBB@D@@AB@DBBAB@@@B@B@@B@@ABBB@@@B@@@BBBB@@D@@@@@DF@@@BA@@@@BB@@@B@D@BA@D@BBB@B@BAB@@CB@@@B@BABA@A@@@B@A@@BABA@AAAAAB@@@@@BA@@@@BA@@BA@@B@@BB@@A@@B@@D@BABDBB@@BB@@@B@@@B@@AB@@A@A@@@A@A@@@@B@B@B@@@BA@A@A@CB@@BB@B@BB@BB@BD@@@@@B@@B@BDB@BBB@@@B@@B@B@@AB@@@AD@BB@B@@B@@@B@BBDAF@@@BAB@@AEA@AFA@E@A@@@AA@@A@@BABBB@BBBA@A@@@@@@@A@@A@A@@@@A@@@A@@BAAA@A@@@A@@@A@A@@BAA@@@@@@@A@@@@AA@@BA@@BA@@@A@A@A@@A@@ABA@@@@@A@@@@@@@@AA@@A@@@BA@@@@A@A@A@@@@@@@A@@@@@A@@@BB@@A@@BB@@B@@@B@@@@@@@B@D@B@B@B@@@B@@@@@@A@AB@B@@@H@DBBBDDDAD@BOCCACAABC@AFAFB@BDDC@BDAF@BBDB@@@F@BAB@B@B@@@@CD@B@DAB@BBBAD@B@D@B@@DBB@@BDBB@@@B@@BB@@@@BD@BBBBB@B@BBB@B@@BB@B@DBB@BBBB@@@BBBBB@@@@@BB@B@B@@BB@@@@B@@@B@@@@@B@@@@AB@@@AA@@@@B@@A@@A@B@@BBDB@B@@BD@@@@BDB@DDB@@@@@@@BBDBBB@B@B@D@@A@@@A@@BAB@BBB@@@BC@@@AB@@ABBBB@@@@@@BB@@BB@@@@@@B@@@@A@@@BB@@BB@BB@DBBB@@@BB@@B@@@@@AB@@@@@B@BA@@B@@@BBB@@B@@B@@B@@@B@BADB@B@@@BB@AB@@ABA@AB@@@D@@A@@B@@B@@AB@@@FABAB@BABA@@@AB@@@B@BFBBBBB@@@BB@@@B@@@A@@BABAB@@A@@BA@B@@@@B@@AAAACA@@A@A@@@A@A@AB@@A@@@@BA@AB@@@@@BBD@@@@BDB@BBB@@B@@DBDDBBBBD@LND@BDB@JHBBB@BBBDDBBFB@LHDDHBBADGBAD@BCBAB@DEFCB@D@D@@@B@BCBCFABEDAD@DBBDBBBBB@BBDBDC@ABA@@BB@@@AB@@@@B@B@@B@B@@@BA@@@A@@BC@@@@B@@@@@@AB@@@B@@@@@@@BA@@@B@@B@@@@@@@B@@@B@@@@@B@BA@@@B@@@@@@@@@A@@B@@@@@B@@A@@@A@@@@BA@BD@@BB@BAD@@AB@BA@BBB@@DADBBCD@@CF@B@@ABAB@BA@A@@BAB@B@B@BA@BD@B@DA@@DBD@@A@ADA@@@C@AC@AB@A@@@AAABAA@@CB@@@BB@A@AB@@A@@A@A@@@@A@@@CA@A@@@A@@@A@@@AAA@@@A@A@@B@@@@@@A@@@@A@AA@AB@@@A@@AAB@B@@ABA@CBC@@ACA@@A@@AC@AA@@A@@@A@@AAA@@AAAB@DDB@@@@BB@@@@BB@@@@@BA@@@A@@@@B@@A@@B@B@@@@@B@@AB@@@B@@B@@B@@@@@B@@@@BB@@@@@@@B@@@@B@@A@@@@BA@@B@@@@@B@B@@@@B@B@BAB@B@@A@@DB@@@@@@B@DA@@BA@@@CBAB@BBBA@@B@@@@AB@B@BAB@BA@@B@@@B@@A@@BC@@@@B@BC@@@BDA@@@@BA@A@AB@BBBFB@BEB@D@@@@A@@B@@A@@FABADDF@BB@BD@F@B@@@B@B@@@B@@A@ABA@@@A@A@@AA@@BA@@B@B@@@@@BB@@B@B@@B@BA@AFBDADBBAJ@BF@BAB@DB@BBBADADA@@@BDABDD@D@@BB@B@D@BAB@BA@@DAB@@A@GJFB@DC@@@ABBB@B@@AB@B@BB@@B@BEDA@BD@B@D@B@BDDCFBDDABAF@BA@@BCDABADAB@BAB@BAF@JIFCB@@@BBBBBADHB@@AB@@AB@B@@BDADDBB@@@@@A@EFA@ABCB@BBABBB@ABB@@BBBAB@DBFCB@BBBAD@BBBD@BD@DDBBB@@B@DB@B@BB@A@BDBBAB@B@BB@BF@HADDB@BFFB@B@BB@DDBB@@@BBDF@B@HAJABAB@@@@@@@B@B@@@B@BB@@B@@@B@@BBA@@B@BAB@@@@AD@B@B@BBBB@BBB@@BB@BBBBBDDDCDJCFG@BB@BB@@FBDDDB@D@BBBAB@BAB@DAF@HDHD@@ACBC@EDBD@DBBBABBBHF@@BB@A@ABB@BB@@AB@BBBA@@@@@BB@BB@@A@@BB@@BBB@@B@DD@AAAB@BAB@FBBBAADACA@A@AAAB@@AA@ACB@@BBA@@@AB@B@B@A@BA@@B@@A@@BDDBDBDB@ABA@A@@@A@A@@JHFBDDDB@@@DBBJJB@B@HDBDABDDB@@ADE@@FFB@@@@@@BA@CB@DB@@B@DDDFDHEDBDB@JBB@BB@B@B@BA@@DB@BB@B@B@B@BBBBBAB@B@DABBBF@D@BBB@BBAFB@@BAD@F@@BDC@C@@DADBBDB@DB@A@A@AB@BB@@@ABAB@@ABAD@@@B@B@DABB@BB@@B@BB@@AB@B@B@@@B@BA@@BBBBBB@@FFPE@@DDBA@A@@B@@@BA@@BAH@@ACAD@FEBA@A@A@@D@@@LEDABABAAGA@@CHECIFETOB@@AJEAEBCB@@@@@@A@@@@BC@@A@@@@A@A@@AAB@@AB@@ABA@@@A@AB@@@@@B@@@@AB@@@B@@AB@@A@@@A@@B@@A@@BAB@B@B@@@@@@BB@@B@@B@@@BBBA@@B@@@HELFJAHBLBFCACBABAFC@AAABAAAAA@@AAAAAA@@@A@@@@@A@@@BBAFG@ACC@CB@B@AA@A@@BCB@B@@A@@B@BA@CB@BADA@A@@@C@@B@@@B@@C@@B@@@BC@@@@@@B@@A@@B@DAB@@@B@@A@@@AB@B@@@@@BA@@B@B@@@B@B@@@@A@@B@@AB@@@B@@@@AB@@@BA@A@@B@@A@@@CA@@@IE@@CA@@AA@@@@@A@@A@@@@A@@EGAA@@@A@@A@@@A@@@A@@@A@A@@CDA@@@A@ABA@@@@@@@A@@@@BAB@@@@A@ABAAA@G@@DAHGAEDE@@H@B@@@B@@@B@BA@@B@B@B@@A@@B@DED@BC@@B@B@B@B@DBB@B@B@B@@@B@BB@@FAB@@@B@B@B@B@BBHB@AA@@A@@BA@@A@@@@A@AA@@ABA@A@A@@A@@A@@B@FEA@BA@@@A@A@@AAAA@@@@@@BA@@@A@@@AB@DEBBDA@A@CA@BANCB@@ED@A@BA@A@A@C@@@A@@@A@@@A@@@A@@@@@A@@@AB@@AB@@A@@@A@AA@@A@@@A@A@@@AAA@A@@@@@A@@@AA@@@@A@@@@B@@@@AB@@@BA@@B@@@BA@A@@BA@@@@AA@@@E@@@@BB@@@@B@@@@@BBBB@@@AB@@B@@BA@@B@B@@BB@@@BB@B@@@@BB@B@@BBB@@@B@@@BA@@@@B@@@@@B@B@@@BABB@@B@B@@@B@B@@AB@@A@A@A@@@@BA@ABA@A@@BB@@BA@A@ABAA@BA@@B@@@B@@@BA@A@@@@A@@AA@@A@@@@@A@@@A@A@A@@@@@A@@@@A@@A@@@A@@@@A@@@BAA@@A@@@@@AA@@AB@@A@A@A@@B@@A@@@A@@B@@@BA@AB@@@@@@A@@BA@@BAB@@@B@BABA@A@@DAAC@C@@BA@A@@DAH@@@@@BA@@@@AC@AA@@AAAAA@AAA@AA@CA@@A@@AB@@@AACCBA@A@@@A@@@AB@@A@AAA@AAA@A@@A@@A@@AC@AB@@@CA@AAA@A@@AA@@BAAAAAAAA@A@@@AAA@@@AAA@@@BA@@@A@@B@@C@@@ABAA@@@@A@A@A@@@C@A@A@CA@@ABA@A@@@A@@BA@@@@B@@A@@@@@@B@AA@@@@B@@@@BBA@@@@@@@@BA@@BA@@A@BA@@@A@AB@@@@@@@B@@@@@@@B@@@AA@@@A@@@@B@@A@@@A@@@A@A@A@AB@AA@@BA@@BA@A@@AAAA@@@@C@AA@@C@@A@@A@@@A@AABA@CAA@@@@BAEACA@@CBA@@@A@@A@AAA@@AA@@CA@@@AA@AAAA@@A@AA@@@A@A@@@A@@@AAA@@@@AA@@@A@@@@A@@AA@@@AAACA@CA@@@A@@@A@@@@AA@@B@@AA@@C@@AAAA@@CEAE@@@AEC@A@@AGACA@ACA@CCA@AA@A@@@@AAAAA@@C@ADA@@@@BA@@B@@@BB@@B@@@BA@@B@B@@AB@D@@@@A@@B@@ABBDB@@BAB@@@@@BAB@BA@@@AB@@AB@@@@@JCFBFADIHAJBLFDBFEBGF@LMFAHJFABAB@@A@A@ABCACDE@ABCBAACEKCUAADGEK@ADA@C@CA@DE@CBCCC@@AABIBC@ASGGGKBEAE@AACFECCDCGAACCBCA@A@BG@EEE@CGBA@AG@EC@C@A@@AABCAAA@AA@A@@@ABABCBCBA@@@A@ABA@@BE@@B@BCBC@A@AAA@AB@B@BCBC@CBAAEDABAB@@@@EB@@BBCF@@ADAD@B@BC@CCA@A@@@C@A@A@AD@@AB@B@BC@@@ABCBEB@@EF@@AAA@AA@@ACA@C@E@ACA@CA@@A@@ACA@@AACA@AACCACAEAAACAABC@EAEBCAC@@CAA@A@A@C@ADAAADA@A@A@A@ABA@A@@@AA@@AAAA@@A@@AAAAAAAA@ACA@AGA@@CB@@ABA@AACB@AC@A@CB@@@@CD@BCEAAA@ABGCC@@@AACECAC@ABAAE@@@CB@@A@@@A@@B@@@BA@ABA@A@A@@BA@A@@@A@@BC@@A@@AA@@AA@@AA@@C@A@A@@AA@@@C@AAA@A@@A@@@@@@A@AAA@A@C@@@E@AI@@AE@@@A@C@A@@AA@@C@@@C@A@C@AAA@AA@CC@CBA@A@AAKBEJ@@@@@@CAE@GEGB@AC@QCA@CACAgMCA@@A@GGAA@@ACCBAAGAAAAAA@ABA@A@AAAA@A@A@A@A@@@ACA@AC@@AAAA@AAA@AAACAC@@AA@ABA@A@A@AACA@A@ABE@@@I@A@A@@@ABA@ABABABA@A@CAABAAAA@C@A@@A@A@@AA@@@C@A@CBABABABA@ABA@A@C@CACAE@"],encodeOffsets:[[110810,23484]]}},{type:"Feature",id:"450125",properties:{name:"上林县",cp:[108.602846,23.431908],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BA@ABA@AA@BA@A@AAA@@@A@@@A@@D@BA@@BAB@@@@C@@@A@ACAACAA@@A@@AA@@@A@A@AAAA@A@C@A@@AA@@AA@@CA@@@@@A@A@CAAA@A@A@C@A@AB@@A@ABC@C@@@AAA@@AA@@@A@A@A@@AA@ABCBA@A@A@A@@@@@A@@@ABCDA@@BEBAHAB@DABA@@@@@@@ABA@ABA@@@A@A@@@AAA@CACCA@AA@A@A@@A@AA@@A@AB@BGG@@@A@ABA@AA@@AAA@@AA@@A@@AA@A@ABA@@@A@ABA@@@@@@BA@AAA@@@A@@@A@@@A@@@AB@@CB@@@B@@@@A@@B@@A@AB@@@@AA@@@@A@@@A@@@@@AB@@@@CBA@@@@@@@@AA@@@@B@B@B@B@@@B@@@BA@@@@@@B@@BB@@A@@@@@@@A@@@@@@@A@@B@B@@ABB@@@AB@@AB@@@@@@@@AB@A@@A@@@@@BBA@@@@@@@@BA@@@@@AB@@@B@@@BAB@BB@@D@B@B@D@@@B@B@B@@B@AB@B@@@DA@@@@B@@AB@@B@BB@@B@BB@@B@B@BBBBBBAB@@BB@@@BBB@BDB@@A@@BBD@@@BB@@@@BBB@BBB@B@BA@@B@@@B@@@BABDDBB@@A@@BB@@@DBB@@BBB@BBBBB@BB@@BBD@@@@AB@@@@G@CB@@@BAB@@@DBDCB@@@BABABA@@@A@AB@@AB@@@B@@@@A@@BAB@@A@@@@B@@@BA@@@@B@B@BA@@BB@@B@@@@@BB@AB@@B@@@@@@B@@@BB@@@@@@B@@@@@B@B@B@@@B@@@@@BB@@BB@@@@@@BAB@@A@@@A@@@ABB@AB@B@BAB@@AA@@@BAB@BAB@@@@@B@B@BA@@BA@A@@@A@A@@@AAAB@@A@A@@@@@A@@@@@AB@@A@@@A@AA@@@AAA@@@@@AAA@@A@@AA@A@@@AB@@@AA@@B@@AAAA@@@@A@@@@@AA@@@@@F@@BB@@@@AB@@@BAB@@A@@@AB@@A@@B@@A@@@@@@B@@B@@B@@@B@@@@@BBB@B@@@B@B@@@BB@@B@B@@@BA@@BA@@B@@@B@@@@@B@@@B@@@B@@@B@@@D@B@BABB@C@@FA@MDABB@@D@BCBAACFA@@B@@@B@@AB@@@@@@BBBB@@@B@B@@ABB@EFA@@@@BB@@@@B@BAB@BB@@B@B@@B@@@AB@@@BB@@BGAAAA@A@A@A@@@A@EB@@AAA@@@A@A@A@A@CAA@A@A@A@@@ADC@CFA@@@@BA@A@A@@@ABA@@@A@@@A@G@@@CFBFGHCB@@@HBBAB@B@B@@A@AB@@@@@B@@@@@@AB@B@B@@CB@DB@B@@@B@@@B@@@B@@@@B@@BBFH@@@B@@B@@@@B@@@@BB@@DB@@JF@@B@@D@@@BA@@@@BAB@@A@@B@@A@@@A@@BA@@@@B@@A@A@@@A@A@@@AB@@@@A@A@@B@@@BA@@@A@CBA@@@@BA@@@@@@@AD@@A@@@@DA@@@A@@@@D@@@BCBABA@@DABA@@@@BA@A@AD@@@BBBA@A@@DDD@BEHAB@A@@@B@@@@@B@@BBBBBB@@BBBBABBB@BEDABABBDEDKAGAIBKEGF@@A@@@ABAA@@A@@@@AA@@A@@@@A@A@A@AB@@@BA@@@@B@@@BA@@BA@@@A@@B@@A@@@@@A@@B@B@@AB@BA@@BA@BB@@@B@B@@B@@@AD@@@@@B@@@@A@ADBFIF@BA@SPEFDJGF@DB@BHABBA@B@@@@@B@@@BA@@@@@@B@B@B@@BB@B@BB@@@@B@@AB@@@B@BA@A@@@@B@@@B@B@@A@@@AB@@BBA@@@@@AB@@AA@@A@@@AB@@C@CBA@@BE@@B@@@@A@@BAA@@@@@@A@@@A@@B@@@BAB@@@BA@@@@B@@@@A@@B@@@B@@@@AB@@BB@B@BAB@@@B@BB@AB@@@@@B@@@@AB@@@BA@@BAB@BBD@BBBAB@BB@B@B@B@B@@BBB@B@B@BA@B@B@@B@B@B@B@@@B@@@BBDBBBBBB@@BDB@@BBBB@FF@B@@B@@@@@D@@BBB@@BAB@@@@B@@BB@ABB@@@BBB@@ABAB@@@B@@AB@@A@A@@B@@@@@B@@AB@@@B@D@B@@@B@BAB@B@@A@@B@BA@@AA@AAA@@AAB@@AB@@@@A@A@A@A@A@@@@BA@@@@@@@@BB@@B@@@B@BAB@@A@AB@B@@@@@D@@@@BB@@BBB@@@ABB@BBB@BA@@ADAD@BB@B@B@B@@BBBB@@B@B@B@B@@@BAF@B@BBB@@ADB@B@@@@@@@@B@B@@@B@@B@BBBAB@@@@BB@@B@@BB@@BB@@BB@@@BB@@B@B@DB@@@@BB@B@@@@@B@@BBAA@B@@A@@B@@@B@B@B@@@B@@ABCB@B@B@B@BBDB@@@@B@B@B@B@B@@@@@BB@@B@B@@@@AB@@@D@B@@@BA@C@@B@B@@AB@@@BB@@@BB@B@B@@@BA@@F@@@@@B@@@@DB@@@D@B@B@@@BA@@@C@@D@D@B@B@BA@@B@B@@@B@@@@@@B@@@B@@@@B@B@D@@@B@AD@B@B@B@@@B@@B@@@@D@B@@@@AB@@B@@@@BB@B@@@@@@BD@@B@BBB@@AB@B@@@BB@B@B@BABB@B@@@@BB@@B@B@@B@@@@BBBB@B@@B@B@B@BB@@@A@@@@BB@@BB@@@@@BAB@@B@@@BDB@B@@B@@@@B@D@@BB@@B@B@@BA@@B@B@@@B@@A@AAA@A@A@AA@@AAA@A@AB@@A@A@A@A@@@@@A@@B@@@B@@@B@@BB@@@@AB@@A@BB@@@BA@@@ABA@A@A@@@A@@@A@@AA@@@A@@@@@A@@@AA@BC@@@AB@B@B@@@BA@@@@DD@@BB@BBBB@BB@AD@@AB@@A@@B@@AB@D@@@@BBB@B@B@@@@AB@@@@B@BB@@BA@BB@@@@@@@@BBB@@@@@BB@FA@@@B@D@@@@ABBD@D@B@@BBB@@@@BB@B@@@BA@@@@BB@BB@@@BABBBB@@@@@B@@DB@@B@@@@@@B@BB@@BA@@BA@@BA@A@@B@@@B@@B@BB@@B@@@B@@B@@@@@@AB@B@D@B@@@@B@D@@@@@BB@B@@BB@B@BB@@B@@BB@@BB@@@@BAB@BC@@B@BA@@BB@@@AB@B@B@@@BB@@@BB@B@B@BA@BFD@@@B@BAB@DABA@BBBD@@BB@@BB@B@@@D@B@B@@BBBBBA@@BBB@@BB@@BAB@@BBD@BB@BBBB@@@D@@@@B@@BB@BB@@@@BABD@D@@@@@B@@A@A@@DBBAB@@@BB@@B@@@B@D@BBB@@@@@@D@BABAB@@@BAD@@@@B@BB@A@@@AB@B@@@B@@A@@B@@@B@@@B@B@@@B@@@@@B@@@@@ABA@@@@@A@@@@BA@@@@B@@B@BABA@@@BB@@@@@B@@@AB@@B@@B@@A@@@@BA@@@A@@@@@B@@@B@B@@@B@@@BA@@@@@A@@@@@@@@B@@@@@@@@@B@@@BBA@B@@B@@@@@@BB@@B@@@@@@@AB@AAB@@@@@@A@@@B@@@@@@@B@@@@@@@@@@B@@B@@@@@@@A@BBD@@@B@@@B@@@B@B@@BB@@@BA@@D@@@@@@@@BBDB@DB@@@@B@B@BB@@@B@@@@BB@BBB@@@BB@@BAB@@A@@@A@@BA@@@@BBBB@FB@@B@B@@BB@@@BABAB@DBBAB@B@BBB@BA@@@@D@B@B@@@BABB@ABBBBB@@B@@BB@@B@@@B@@B@@B@B@@@B@@AB@B@B@B@@@BD@@DBD@B@BB@@@BA@@@@@@B@@B@@B@@B@B@@B@@@B@@@@@@A@A@@B@@@B@@BD@@@@@B@@BD@B@AB@@@BDB@B@B@@@B@@BB@@BB@BA@BB@@@B@@@BB@@BA@@B@B@@A@@@AA@@A@AB@@@@@B@BC@@B@BB@@B@B@B@BB@@F@B@@@B@@@D@B@@@BABA@@@@BBB@B@@@BB@@@@BA@@BA@@B@@BB@@@@@BD@B@@@BD@@@B@BB@@@@BB@B@BBBB@B@@@BBB@@@BB@@BD@@DDB@@@@AB@@@B@@@BCHEFBB@B@BDDB@EBA@CDA@@@C@@@A@AACB@@ABCB@D@BC@@BCB@D@B@@A@@FB@@DAB@BBB@F@B@D@BBD@BCBADAB@@AB@BCB@@C@@B@DA@@@@@@@A@@@C@CBA@ABAB@@ACC@@AAA@A@@@@@@@@@@A@@@AB@@A@@A@@A@@@@BA@A@AB@AC@@@@AA@@@A@A@@B@@ABABAB@BBBA@@BA@@AA@@AA@@A@@@AAAA@@ACAAA@A@A@AA@@@@ACBC@@@AA@@@BAB@@AD@B@B@BBD@D@BA@CB@DCDABADAB@BBBB@@BA@ABCB@DA@C@@ACA@AA@A@@CEC@@@@@@@AA@A@@AA@AA@@AAEA@@A@C@@@@AA@@@C@AA@@AA@@AA@A@@AC@A@@@CBA@ABA@CA@@@AB@@@@A@@A@A@@@A@@A@@AACADEBABA@CA@@AAAACBABAB@@@@AAA@A@A@A@@DAB@D@@AB@@@@ABA@@B@@@@AAA@@AA@A@@AAA@@@@@@A@@@@B@@@B@@AAA@AA@@AB@@A@A@@AA@AB@B@@@AGAA@ADCB@F@BA@ABA@AFAB@BA@@BA@A@@DA@@D@@@@A@ABA@@A@CA@@@A@A@A@@@@A@A@AAA@@@A@A@AC@A@@B@@AA@A@AAAA@@A@BA@@B@@A@@@A@@@@@AA@A@C@@A@@@AB@@@@ADABCB@@AB@@@AA@@@A@@BA@@@@@AB@BAB@B@BA@@@A@@@@BAB@BA@@D@B@B@B@@@@A@@@AA@A@C@AA@@AB@@A@@@A@@C@@B@@ABCBA@@@@DADABAB@B@@A@@@AAA@@@A@@A@@@A@AA@@@@@@BABC@@@B@@BABBB@@@@@@A@ABA@C@@@@BAB@@@A@@AA@@A@@@A@ABA@@@@@AAA@@@@B@B@FA@@@ABABC@AACBABAAA@A@AACDG@E@ABCAA@A@@@@@A@@@A@@@A@@@AA@@@B@@A@@A@@@@@BA@@@@@ABAA@@@BA@A@@@@@@B@@@D@B@@@@ABA@A@@B@@A@C@@@C@@@@@A@@BCBA@ABAB@BABAB@@@AA@@@AAAB@@AB@@@@@@A@ABCAA@@@ACA@@@A@@@@@@A@@@@@AAAA@AAA@@B@@AB@@A@@AA@AAA@A@@@@BE@@BA@@BA@@B@BABA@@DAB@@B@@@BB@@@@AB@@@@@@@@A@@AA@@@A@@B@D@@@B@@@BA@@@AD@@@@@AA@C@@A@BAA@@C@A@@BAB@@@DA@@@@B@@@@@DA@@AA@A@AB@BA@@BB@@@@D@@@@A@@@@BA@@@@BA@@DA@@BAB@@@B@@@@@BAD@@@BAB@@@BDB@@@B@@@DBBB@ABB@@@@BAA@BA@AAA@@@@BA@@BA@@@AD@A@@@AAAAA@@ABA@@B@@@BA@@BA@@B@CCC@@CBAB@BC@CAABCBA@@AB@@@@@AAACC@@@@BA@A@@AC@@@@@AA@A@@AA@@@A@A@A@@@@AA@@C@@@@BA@CCCACACAAA@C@@A@AF@B@BACACAA@BGBABA@@B@@@B@BB@@BBBAB@@@@A@@@@AAAA@@@AAA@AB@@@BA@@BA@ABA@@BA@@BABA@AAA@@@@AC@@AAA@@@@B@BA@CB@@AB@@@@@@A@@A@A@@@A@A@@AA@@A@@BABCBAB@@AAA@A@AAA@@@@@@ABA@C@@AA@@@BA@@@@BA@@@@@AA@A@@@ABAA@@@@@A@@@@@@@A@BBAB@@@@A@@A@A@@A@A@@@AAB@@A@A@@@@@@A@A@@A@A@@DC@@AA@A@@AA@@@A@@@A@@@@C@@@@@@@@C@@@@B@@@@A@@B@@AB@@@@@@AAA@A@AA@@AC@A@@@@A@@@A@@@A@@@A@@@AA@A@@@A@@@@@AD@B@@@@A@@@@@@@@A@@AAA@@@A@A@@@@@@@@@@AB@@A@A@A@@@@A@@@ABAA@@@@A@@A@@A@@@@@AB@@@@AABA@@A@@@@@A@@@CA@@@@@AB@@ABA@@AA@@A@AB@@AB@@@@A@@A@@@@@AA@@@@@AB@@@@A@@A@@CA@@A@@@A@CBA@A@@@@A@@@@@A@@@@@A@@A@@@AB@@@@A@@@@A@@DAB@@@B@B@@@@AAA@@@@A@A@@@@@@@@AB@B@@A@@BA@@AA@@@AA@@B@@A@@BA@@A@@A@@@A@@@A@@@A@AB@@C@A@@@A@@A@A@@@A@@@@@A@@@AE@ADCA@@CA@@A@@AA@@@A@@@A@A@@@AB@@@@@BDBCDG@MCA@@@@AA@A@@@A@@BA@@B@@@B@BA@A@A@@A@A@C@@AA@@AABA@@@A@@@@AA@@C@A@ABA@@A@@@AA@AB@@CA@A@@BA@@A@AA@@A@A@@A@C@@@A@@@@B@@@BA@A@@@A@A@@A@@ABA@@@A@A@@BAB@@AA@@A@AAAEBC@C@A@@@A@AB@@A@A@A@@AAAAAAA@@A@@@A@@A@AAA@C@A@AA@@A@ABABAB@@A@@@A@@@A@AAABA@@A@@A@AA@A@AAA@@@@@@A@@A@A@@@@AA@@@A@AA@@@A@@@A@@@ABA@CBA@@DA@@B@B@B@@A@@BABAAA@@@C@@@A@AB@@@@A@@@@@C@@@AB@@@@@B@@@@A@E@AB@@@@@@A@@AC@A@@AAA@A@A@@@A@@AA@@AA@AAA@@@A@@@AA@@A@@AAA@AAAA@C@@AA@@AAA@A@A@ABA@A@@@AA@AAA@@A@@A@@BA@AAAA@A@@@AC@@AC@@@@AAA@@CA@@A@CAA@@AA@@AAAAAA@AA"],encodeOffsets:[[111299,23790]]}},{type:"Feature",id:"450124",properties:{name:"马山县",cp:[108.177019,23.708321],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@FC@A@AA@@A@ABA@@@AAABA@@D@@CEAHIB@@@BA@CB@@ABA@A@C@AAA@@@CCCBAAC@@B@BCBCAA@ACAA@ABE@@ABIAABCACBEB@@A@AA@A@@@AA@@@@A@A@@@AB@@BB@@@B@B@@AB@B@BA@@@A@A@@@A@E@C@@AAAE@CCABEB@@@BA@@@@B@@C@A@AFA@AEAAA@@B@BAB@@@@CB@A@@ADA@@@@@AD@@@BA@@@A@@@ABA@ABA@A@@B@@A@@@ABAAA@AB@D@@AB@@CBA@@@@@@@CA@@@BA@A@ABA@A@@@@@@A@A@@@@@AB@@A@@B@@@@A@@A@@@@@@@A@@A@@A@@@@@A@@@@AA@@@A@@BA@@@@@A@A@@@@BA@@@@@@B@@AB@@@@A@@A@@A@@A@@A@CCA@BB@BB@BB@@@B@@@BB@@BBD@@@BB@BD@@AD@DAB@BA@A@BB@@@B@@BAB@@B@B@@B@@@@@@@@AB@B@@@B@BB@@B@@@B@@@B@B@@D@@@B@@B@B@@@@BA@@B@BAA@@A@@DB@ABBB@B@@@BBAD@@B@D@@CB@B@BC@CA@@CBA@C@@AABA@A@A@AB@@@BABA@AB@BA@E@@DC@ADCACB@@AA@AABA@@BC@ABA@@AC@@AAB@@@@@B@@@BA@@@@@A@@@@B@@@@@@@@@A@@ABA@@@@@A@@@A@@@@@@@A@@@@A@@AB@@@@@@A@@@A@@B@@@@A@@@@@AD@@@B@@AB@@A@A@@@@A@A@@A@@B@@AA@@AB@BCDCAAAA@AAAAACCAC@CBAFEBADADA@@@C@C@A@EDCFA@ABADC@ABCHABGACCKGA@AECAACAAA@AAIGA@ACC@KMC@AAAACCCA@@@AA@AAA@AC@@@@AC@A@@@@A@@@A@@@CE@@AA@@BA@@A@AAA@AAAAA@@@AA@@CA@@AAC@AAA@@AA@@A@@ABC@@@ABABABA@@@A@@@@@A@@BA@@AA@@A@@AC@@@A@@@AA@A@@AA@A@AAA@@AA@@@AB@@A@@B@@@@A@@BA@@@A@A@AAAB@@@@A@A@@@@@A@@@A@@A@@A@@@A@@@@A@@AA@@A@@@@@@BAB@@@AA@@@@BA@@B@A@@@@@@@@@BA@@BA@AB@@C@A@@@CB@BA@ABA@@@A@AACC@@@A@A@@AAA@A@@@AAA@@@A@@@AB@@A@@AA@@BA@@@@B@@@B@@BB@@@BA@@@A@@@A@@@@F@@ABA@@@@BCAA@@@A@@@A@@B@@A@@@A@A@C@@@A@@BA@@@@A@@A@@A@@@@@A@@A@AB@@@@A@A@@B@@AB@B@BA@A@A@AB@@@@@B@B@@@BABA@@@A@@AA@A@CCC@@@A@@@@@A@AAA@@@AAA@A@@@ABA@@@C@@@@@AF@@CBAA@BA@@BA@@B@@A@@B@@@BA@A@@@A@@B@@@@B@@B@BB@@B@BABBB@BB@BD@@BB@@@B@@@@AB@D@@@B@@ABA@@B@B@@BB@BAB@BAB@@@@BBA@@@A@@B@B@BAAABAA@@@@A@@B@@@@@@AB@BA@@@@B@B@@@BB@B@@BABB@@@@@BD@@@@BB@@BB@@BD@@ABA@@@@@@B@@A@@@AB@@@BB@@@@B@@@@BB@B@@A@@B@B@@@BA@ABA@@@AB@BB@@B@@@B@@@B@@@B@B@@F@@@@@@BA@ADBB@B@@DBB@B@B@@@@B@B@@@B@@@@@B@B@BB@@B@B@B@@@B@B@@@BAB@BAB@BB@@B@@ABABABABABA@AB@@@AA@@@AB@@AB@@AB@@A@@B@@A@A@@BA@@@@@A@@BAB@B@@ABA@@B@@@B@@@D@@@BABBB@@A@@@A@@BB@@@ABA@@B@@ABB@B@@@B@@@B@@A@@@@B@@@B@B@B@B@@@@@@BB@@B@@@@BB@BBBB@@B@BB@@@@BBB@@B@@@BB@@B@@@@B@@@BBB@A@@B@BB@@B@BB@@@BB@@A@AB@@A@ABABAB@B@@@DB@BDDB@DCBFD@@D@BB@BB@@BBB@D@@BB@BAD@B@F@B@@@B@@ABA@@B@@@BA@@B@@@B@BAB@@@B@BA@@B@BAB@@@B@@@@@D@@@BB@@B@@@BAB@@A@@B@BBB@@@B@@@@AB@@@@@B@@BB@BB@@@B@B@@A@AB@@ABBB@BBD@B@DA@ABB@@@BB@B@@BD@@@BB@@BB@B@@@DF@@@@@B@@BBBBB@@AFDB@FH@B@B@@@@@@@BBB@B@@@@AB@B@B@B@BB@@@DA@AB@BB@@@@BBB@BB@AB@BBBB@@D@@@@A@@BA@@@AAAA@BEBAB@B@B@BD@BBB@BBB@@D@B@BB@@B@FBBD@BB@@A@@B@BCBADBB@@@BA@@BAAC@@B@BA@@BCCA@AAAA@@@AA@@DADC@@@@@@BBB@@@BB@BB@@@BB@@@B@BB@@BB@@B@@B@B@@B@@@@B@@@B@@@FAFA@@BA@@BAB@BBB@@@B@@A@@B@@ABB@@BB@@@BB@@@D@@@@@@B@@@@@DABBBD@BAB@@@@@BBBA@@B@@BB@@@BB@B@@BBB@B@B@@@@BBBBB@@AB@@@@@B@@@B@@B@B@@B@@AB@BAB@BBB@@B@@B@@B@@@B@@@@@B@BAB@BBBABAB@@@DB@BB@B@ABB@BB@BBB@@@BB@@BB@BBA@CF@@@@@B@@@DCBA@@@C@@@A@DDD@@BB@D@@BBB@BB@D@B@B@@AD@@@B@B@@@B@@AB@@@B@@@B@BA@@@BB@B@B@@@D@BBAB@BABA@@BABB@@BB@@BD@BBB@B@BBB@BA@CFBB@@B@@@DAB@@@@A@AB@BA@BB@B@B@@BBDB@BBB@@B@@AB@@A@@@@B@@@DC@@BAB@@A@@B@BAB@@@@A@AB@@AB@@@B@@@BAB@BA@@B@B@@AB@@A@A@@@@B@@@BABA@A@A@A@A@ABA@@B@BABABAB@@@AAACA@BAB@@A@@@AB@B@BABAB@@@B@@A@A@@DBB@D@@BB@@@B@BBB@B@BBF@@@DB@B@@BB@@@@@BB@@@B@@@@@@BB@BBBBBB@@@@DB@@BA@@BB@B@@@BABA@@@BD@B@BBBABA@@@A@A@@@@@@@@D@@@@AB@@@B@@@BB@BB@@@BD@@@@@BB@B@@A@@@@B@B@@@B@@@B@@@@@@AB@@@D@@@BDBB@B@@@@@AB@BAB@@B@@B@@@@@@@@@@B@@@@@B@@B@@@@@@@@@B@@@B@@@@@B@@@B@@@B@BBB@B@@@AB@@@AA@@@A@@B@@@@@@A@@B@D@B@@@A@B@@@@@@@@@@@B@@@@@@@@@@@@@BB@AB@@@@@B@@@@B@@BA@B@A@@B@@B@@@@B@@BD@@@@@B@@BB@B@B@BB@@@@@BABAB@@@B@B@@ABB@B@B@B@@@@BAB@@@B@@@B@@@B@B@BB@@BBB@@B@D@AB@BA@@BB@@@@@B@@BB@@@B@@@@@@@B@@BB@BB@@@@BB@B@@@B@BBB@@@BB@BDDBBB@@BBB@@B@@ABA@@B@@@@@BBBB@BB@@@@@BA@@@BB@B@@@BBABB@@BB@@@@@BB@@BABAB@@A@A@AA@@@@@B@@@B@@@B@@@@ABAA@@AB@@@BB@@@AB@BBBB@@@@BA@A@AA@@@@@C@@@AA@@@@BA@@@@B@@ABAB@@CC@B@@A@@@BB@@@B@@@BAB@@A@@BE@@BBB@@@B@D@D@B@@@BA@@DAB@@@B@B@@@B@F@BABABA@@BCBAB@DA@@@BB@@BB@@B@@B@@AB@@@@BDB@@BB@@B@@@D@D@B@BBB@B@B@BBDDDBBBBBBB@BBB@B@D@B@B@DBBBB@@@BDBBBBDDA@FBBB@BDBHDFDFBB@BAJEHABAJBFAHCFAB@BBF@HDDBDFDHBFBB@BDDBBLD@BDBBB@FBF@H@BBB@DBDDF@BFDHJDD@BABBBBDBDDDF@JA@@B@DABABCFCHCBABAFGDEFMFGDABAHAF@D@HBDBDFBF@BIJEF@F@DBFDHDFBD@BDDHHFDBBBBFDBBHDDB@@DG@A@@@A@A@CBA@@BA@CBABA@A@@@@DCBBBA@AB@D@@AB@BABBB@@B@B@B@@B@B@BA@A@ABEBA@@@CAABA@ABA@AA@@A@A@A@@AA@AAE@@BA@A@AB@B@B@@@@A@AAA@@AA@AA@@AD@@A@@BBB@@@DCB@BBB@@@BA@A@ABABAACAAAAC@@@@CBA@A@CDA@AD@BBBB@AB@B@DABA@AB@B@@BBB@@@B@@@B@B@@BB@@BD@@@B@@@@B@B@B@B@B@B@@C@AB@AA@AA@@@A@@@A@@C@@@A@ABAD@B@BADC@@@B@BBB@@B@@B@@B@@@BB@@AB@@@B@@C@@@A@@@@B@BDBB@@B@@B@@BBB@@B@@@@@B@BD@@A@@@BB@@@B@@DA@AB@@@B@@@B@@C@AAA@A@@BAB@B@B@@BBB@@B@@@BACCAA@@AA@@@@@AD@@@B@@A@@@A@A@@@A@@BAB@@@BA@A@@@AB@@@B@B@B@BBBB@@@@A@AB@@BB@@@@BD@@ABA@BBB@@B@@@B@B@B@@BB@@@B@B@BA@BD@@@BB@BB@BB@B@B@BBD@@D@@@@@B@BDBBBBA@BD@B@BBBBB@BBBBBBBD@@@D@BBBD@@BBBBBCBCBC@C@A@A@A@@@ADA@CACA@@@DAB@@@BAB@@AB@B@BBBDB@BD@BB@@B@@B@@AB@@@@@@A@C@@@@@ADB@BBBB@@B@@B@B@@CB@@@B@@D@B@BB@BB@@BA@ABA@@B@B@@DB@@B@B@BA@A@@B@BB@@F@@B@BA@B@B@B@@BBB@@BBBB@@@BB@B@B@@B@B@@AB@BBBB@B@@BB@B@@BAD@@C@A@@B@B@BD@@B@@@B@@@@BBB@@@AB@B@B@@AB@AA@A@@B@B@@@B@@AB@BB@B@B@@@B@@B@@BB@@B@B@BD@@D@@B@@BBD@D@@BB@B@BB@@@BAB@B@B@D@B@@B@B@B@BB@@BAB@D@B@B@BBB@B@@B@@B@BB@BB@@@@@BA@@@@@A@@BA@@@A@@@@BA@A@@B@DB@BB@@@@@@BB@B@@BB@BBB@@@BBBBB@@@@@BAB@B@B@B@BBB@B@BBBB@B@@@BA@@@@BA@A@@@@BBD@BB@@B@@CB@BB@CF@@@B@B@@@@@BBBDBABA@@BB@BB@@BBAD@@A@@B@@@B@@@BA@A@@B@@@@BD@@B@@B@BA@BB@@@@@B@B@@BB@@@BC@@@A@@B@B@BBB@@@B@BCBA@@@A@@D@B@B@B@@A@A@@@@@@A@@AA@A@@AAC@@@AB@@A@@@ABBB@@@@@B@B@B@@@@@BB@@@BB@@@B@BAB@@@@DB@BBD@@@BA@@B@@@@BB@BD@BBBBBABAF@@@DBBBABBBBBABA@@BBBBB@D@DBB@BAF@D@@A@C@EAC@@B@@DFDDHHDDHFFBF@JDFDFBJFA@BBHDBBBBJDJ@D@H@@@DBD@F@@@B@DBB@B@DAFAD@FAB@JEFADEBE@EAC@ADCBADAF@B@DFDBBBDDDBFBDBD@DAB@BABABGFEDEDE@@@@FCBC@@@AB@@@@AB@@A@AB@@ABA@ABA@A@A@@@@@AA@CB@@AAA@@@C@A@A@A@@@C@@A@@@AAA@@EE@@A@AAEI@@A@@@CBCB@BA@@@@AA@@AABC@ABA@@B@@@B@B@@@@A@ADA@A@AB@@A@A@@AA@@@A@@ABA@@AAAB@@A@A@@A@@BA@@AAAAA@@ACA@@A@A@ABA@@@BA@@@A@A@@A@@AAAA@@A@A@@A@@A@A@AAAAA@@AB@@A@AAA@CC@@A@@@AA@AAABABA@@@@@A@@A@@A@@@@BA@@@A@@@@B@@@@@B@@@B@AA@@@AB@@AB@@@@B@A@@B@@@@@B@D@@@@@@@@AA@AA@@@A@AB@@@@@AA@C@@AAAC@@@AAA@@@C@@AC@@AA@AA@@AA@C@@AA@@A@@A@@@AE@A@A@A@A@@@@AA@A@@A@A@@BAA@@A@A@@AA@@C@@BA@@@AB@@@@A@@@@@@@AAA@@@@@@@@B@B@B@B@B@D@B@B@FBB@@@@@BBBA@@BB@@FB@@B@B@@@@@@A@ABB@AB@@ADA@@@@B@@BB@@@@ABA@@@@B@BA@@B@BA@@BA@@@@AAA@AA@@@@ACA@@@@@@A@@@@A@@@@C@A@A@@B@@@AAAAA@@@A@A@@@@A@@AC@@B@@@B@B@B@@@@@B@B@D@@BB@BBB@@A@A@@D@@@@BBAB@@@@@@@DB@ABB@@DA@@B@@@B@@@B@BA@@@AB@B@B@@@B@B@B@B@@A@@@@@CAC@@@A@@@@AAA@@@@@A@@@@@@B@@A@@AC@AB@@A@A@A@@AC@@AA@@A@A@A@A@@FC@AA@@@@A@@@A@AAA@A@A@@AA@@A@AAA@A@A@AA@ABABA@@@ABC@@@CA@AAAC@@A@AABC@@@A@@AAA@@@ABCAA@@@AAAA@@@A@@@A@@@@B@BABA@A@ABA@@B@BAD@DABA@A@A@@@@@@CA@A@@AA@@CA@@AA@@@A@@@@ABA@@@A@@@@@A@A@A@AB@@AA@@@A@@@AA@A@@@CA@@AAAA@@A@C@@@@@@B@@AB@@@@A@BA@@AAAA@@A@@@A@AAA@A@@A@@@AAAAA@@C@A@@AC@@A@@@@AAA@@@@@AA@ABA@@@AA@@@A@@@@ABA@@@@AAAADA@@B@@@B@DAB@B@@@@@@A@AB@BA@@@A@A@@B@@A@@AA@@@AA@A@@A@AA@AC@AAACA@@@@BA@@@AB@BA@@B@@@B@B@B@D@B@B@DB@@@BAB@BB@BB@@ABA@@BBB@@@BDB@@D@B@BBBAAAB@B@B@BAB@@ADA@A@@EC@A@CA@A@@AAA@@AC@@@A@A@@AAAAAA@ABABB@A@AB@B@B@@@BBBAB@@@DBBBB@B@@@BC@@D@BB@@BABA@A@@A@@@@@@AAAA@@AA@@A@@@AB@B@@BBB@@BAB@@@B@@@DB@@@B@DBB@D@@BBBCB@B@@@D@BBBCBA@@BAB@@@@A@@ACA@BA@AB@B@@@BB@@BBD@@ABA@A@AAAA@A@A@@AA@@@BA@AAA@C@@@AB@B@B@B@BA@@@A@A@@@@BABABA@@DD@A@@B@@@BCB@@@@AB@BA@@@@B@@@B@BB@B@@BA@@@@@@BA@@@@@@@AA@@A@A@@@AAA@@A@@A@A@@BAB@@@@@AA@@AA@AAAA@@@@@BAB@B@B@@@B@BC@@@@AAA@@@CC@@AAA@A@C@CBA@@AA@@@C@A@@AAA@@AA@CB@@@BAAA@@@@D@@@@@@AB@@@BA@@B@@A@@@C@ABAAAA@@A@AB@@@BA@@BBB@@@B@@B@@B@BA@@B@@C@@BA@@@@@C@@AA@@BC@AB@@A@@B@BAD@B@@@@@BBB@B@B@BA@@@@BBBA@A@AAA@A@ABAB@@C@@@@B@@@@@DAB@@@BAAA@A@@@A@@BAB@@@A@@@AA@@CA@A@@BA@A@A@@@@@AA@@@@AA@C@@AA@@@@@A@A@@@AB@@@A@AA@@AC@A@@@@@@AA@A@AC@@A@@@BA@A@@@@AA@@AA@@@@@B@@AAA@A@A@@@@AAAAA@@@@@AA@A@@@AA@@@@@AAAABA@A@A@@A@@@ABA@@AA@A@AC@@A@@@@A@A@@A@@A@@@BA@@@@@A@C@@A@@@@A@@@A@A@ABCA@@@C@A@A@@@@@@A@@@A@@@@A@@@A@A@@@ABA@A@C@C@@@@D@@AB@@A@A@C@@@A@@C@@A@@@@@E@@@AB@@A@A@A@@A@@AA@@A@@BA@A@@@@DAB@@A@C@@@A@@B@@A@A@@@AA@@@@A@A@A@A@A@@@@@CAAAA@A@A@A@AD@BA@@@A@A@A@@@A@@@@BA@B@AB@AA@@@@@A@A@@A@@A@@C@A@AA@@A@@AA@@AA@@AA@@@AA@@A@@A@ABAAA@@@@A@@@A@A@@@@@@A@A@BC@@AA@A@ABE@A@@@A@A@A@AA@AA@AA@A@A@A@@ABCBC@@ABA@AAA@BA@@A@AA@@AA@@@@@C@@@@@ABAB@@@BA@A@A@@@AA@@A@@@@@@B@@A@@B@B@B@B@B@@@@@BA@@BA@BB@BBB@@BB@@A@AB@@@@ABA@A@A@@@A@C@A@@BA@@@A@@@@@AB@B@@@BA@@@A@@BABA@@AA@A@@AA@BAA@@@A@@A@AB@@AA@AC@@@@@A@@@@AEEA@AA@AA@AC@@AAAAAAAC@A@@@A@@@A@A@A@AA@A@B@@A@A@AAA@AA@A@A@A@A@@ABAAA@AAC@ABA@AB@@A@@BA@@@@@A@@@@BAA@@A@A@@BA@A@AAA@@BA@@@@@A@@@AB@@@@@@A@@B@@A@@BA@A@@@AB@@@B@@@@@@@BB@AB@@@@@@AF@@AB@DAD@@@BA@@B@@@BB@@BA@@@@B@AA@@BA@@B@@@@A@A@@@A@@B@B@@A@A@@BA@@@A@@A@@A@AAA@@@A@A@A@@@@B@@A@@@A@@@@@AABABCBKF@@C@@@@B@BABEFC@DB@BG@AB@@AB@@A@@@@BABCC@@OFEE@@AAAAAA@@ABA@@@A@A@A@@BA@@A@AA@@AAACBA@A@@@C@AB@BA@AB@B@@AAA@@B@B@BCAA@ACCACB@@@DCD@AE@C@AB@@EAAB@AAA@A@CAEAACBA@A@ABAAAAA@A@A@A@@ACA@@ABA@A@A@@AAA@ICACAGFECCC@C@AA@@CDAB@@A@@@@A@EE@@CF@BA@CCBAACGCA@A@IIAA@C@@CACCEAIG@@@B@B@@@BAB@BCACACAAC@@@BA@@@ABB@A@A@A@@B@@AB@AA@BDB@@BA@BB@B@BDBCBBBAAEAA@ABA@BB@BCCA@@@AA@AA@@AB@@@AAA@@A@@@@ABAAA@@BA@@AAA@B@BAA@@GEAABAAACAC@CA@FADBD@@GCGCE@CBA@ABA@ABAAC@A@CCAC@EA@@AAAH@DECICDCCAAAA@AAA@@AA@AAAAAA@A@C@@B@@A@ABA@@@AB@AA@@@A@@@AAA@@@A@A@@@@@@@A@ABIBGBA@E@AC@A@@AACCA@@A@AEAAEA@CCGBE@@AAAA@A@ABCA@A@BAAA@A@@C@AA@AACCC@@AACAAC@ABAAA@EDCAA@ABAA@@AA@BAABAAAA@AD@BEB@F@B@@@@AACCCB@AA@A@@BA@@BA@CGABAAAA@@A@EDIJE@ABA@ABA@CBABCBAD@@ABE@ABCBACDECC@A@A@C@AACB@"],encodeOffsets:[[110664,24020]]}},{type:"Feature",id:"450126",properties:{name:"宾阳县",cp:[108.810326,23.217786],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CBA@@BAB@AC@AA@@AA@@A@@@A@ABA@A@@@A@A@A@A@@@@B@B@@@@A@@@@@ABA@@@@B@@A@A@@ABA@@@@A@@@@@@BAA@AA@@A@@BA@@@@AB@@A@@@A@ACA@@@BA@@@@AB@B@@@B@@A@@@@@A@@AA@@@@@@@ABA@@B@@A@@@A@@B@BABA@@@A@@@@A@@@A@A@@@@ABA@@@@@@@A@A@A@@@ABA@A@@@@@@@A@@B@@@A@BA@@B@@@B@@ABA@@@@@@A@@@@A@@@@@AA@@A@A@@CAA@AA@@@@B@@A@@@AA@@B@@CAA@A@@B@@@@@A@@A@ABB@@@@@@B@@A@@@@AA@@BA@@@CB@B@A@@B@@A@@@AA@A@@@BA@@@@A@A@AB@@@@A@@@A@A@@@@@@B@@A@@@@BA@@@AA@@A@@@@@@AA@@@C@@@@@A@AB@@@@A@@A@@@@@A@@@@A@A@@@@A@@@@A@@@AA@@@B@@@@@@@BA@@@@@@@@B@@@@A@AA@@A@@@A@@@A@@@AB@@B@ABA@@@@@@@@AAAA@@@@B@@@@@AA@@B@@@B@@A@A@E@@@@@@BAA@AB@BA@CBC@AACABEADC@AGAAA@@C@@@@@@@CB@AE@A@AB@@@@ABA@C@GD@@ABA@@BA@@BADADA@@@@@A@@@@@AB@@@@@BA@@@A@@B@@@B@@AB@B@@@@ABA@@@A@A@@@@B@@A@@@@BBB@@ABB@@BAB@@@B@B@B@@@B@@@@A@ABA@@@@@A@@@@C@@@@A@@@@B@@A@@@A@A@@@AB@@@AA@BA@A@A@@@A@@@A@@A@A@AAA@@A@@@@@A@@@@CA@@A@@@B@@AB@B@@@AA@@C@AB@@@B@BAB@@BB@B@B@@@@@@@@@BA@@BA@@@AA@@@@AA@@@@A@@@@@AB@@A@@@AB@@AC@@@A@A@@@A@@@@@A@A@@AA@@B@@A@A@@@AA@AA@AA@AA@@@@@A@@@@@AAA@@AA@@A@@A@@@@@A@@A@@@A@@AA@A@A@@A@@BA@@@@AA@@BABAB@AA@@BAB@@@@A@@B@@@@@@@B@@@BC@AA@CAAAAB@@BB@BA@CACACCABA@@B@BABA@@@A@@A@A@@@@ABA@A@@ACB@@A@A@A@@BA@@AA@@@BCAA@@@AA@@AA@@@@@A@AAB@@A@CABAAA@@@ABAA@@A@@BA@@@@B@@@@@BCB@A@AA@@@A@@@@B@@AB@B@@A@@@C@@@A@@@@A@A@ABACA@BCF@@@@@@A@@@AB@AAA@A@@@A@@A@@@A@@DA@@AA@@@AA@@A@@@@B@B@@AB@BAB@@A@@AA@@@A@A@@@CB@B@@@BAB@B@B@@AB@B@B@@ABAB@@BB@B@@@B@B@@AB@@@@A@@@A@@@@BAD@B@@B@@@B@BBDA@@@B@B@B@@@@B@B@@BB@@@@B@B@@C@A@@@ADBB@@B@B@@@@B@@BBABB@AB@BABAB@@@@A@A@@AA@A@@B@@AAA@@@@@A@@B@@@B@BBB@@@@@BAAA@@@@A@@@AA@A@@@@@A@@@@@AB@B@@@BA@@D@@@@@@@@AB@@ABBB@@B@@BB@@@@BB@BB@BAB@BA@@@A@@BBB@@@B@BA@@BA@@@AAAB@@A@@B@@@BB@@@@DB@@BA@@B@B@BAB@B@@AB@B@BAA@@A@A@@@@A@AA@@@@@AAA@A@BB@BA@A@C@@@CC@A@A@@@@@@AA@@AA@AB@@A@@@A@@AAAA@@AB@@AAAB@A@A@AA@@AABA@AA@@BA@AA@AA@A@@@A@@C@@BA@A@AA@B@@A@C@@BAA@@A@@@@@A@A@@AA@A@@@AA@@AB@@@@@BA@@@A@A@@@A@AB@@A@@@A@@@AB@@BB@@BB@@@BBB@B@@A@A@@@AB@@@BBD@@@BB@AB@@@@A@@@@@@@@A@@@BAA@B@@A@@@@@@@@@@B@@@BA@A@CBA@AB@@@@@@CA@@C@A@@@A@@@AB@@BBA@@B@@AB@@@@@BA@@B@@A@A@@@ABBBA@C@@A@@A@@AA@@A@AA@@ABA@@A@AA@@@AA@@@C@@@C@@AA@@@@@@@A@@@A@ABA@@@@AA@@AAA@@@AAA@A@@A@@AA@A@@@A@AB@@A@AAABA@@@@B@AABAA@@@B@@A@@@A@A@@@A@@BA@@BAB@@B@@@@@B@@@AB@BAD@B@@ABA@AB@@A@@B@BCB@@AB@BB@@B@@BD@B@@@BBB@BAB@@B@@BABBD@@B@@B@B@B@B@@A@@@A@@@A@@@@@A@@@A@@@A@@@AB@@@@@B@@BD@B@B@@@B@@BBB@B@@@B@@@DB@B@@B@@@@@B@@@@@@@B@AB@@@@@BA@@@@B@@BB@@@B@BA@@BB@@@@B@@DB@BA@@B@@@B@B@@A@A@@@@@@BA@@@AB@@A@BA@@@A@@@A@@AAAA@C@@C@@@@@A@@BAA@@A@@@AB@@@@BB@B@@@B@@C@@@B@@B@@A@@@@@B@AB@B@BADBB@@@BA@@BAB@@AB@@ABAACBA@ABA@@@CBADA@@@@@@B@@AAGDA@AA@@A@@@AD@BAB@B@@@BC@AB@@@@@B@@@BABC@@B@@AB@BAB@@A@@@C@@@A@@@A@A@@B@@AB@@AB@@CDA@@@AB@@@BBB@B@BCB@B@@A@@@@@BB@@@B@BA@@@AB@@@B@@@@@BB@@@@@@B@@@@@@@B@@BB@B@@@@BB@@BBAB@BA@@B@@B@BB@B@@@@@@@B@BA@@@@@@D@BA@A@@BA@@@@@A@A@A@@@A@A@ABA@@DB@@@@B@BE@ABABAB@@A@@AA@A@@BC@A@@BA@AB@@@B@@@BBB@B@@AB@BAB@BAAABA@AA@@A@@@@ACA@A@A@@@AAA@@@A@@@AA@@@A@@A@@@@AA@@A@@@AB@@A@A@A@@B@@@BA@ABA@@A@A@AAA@@A@@@ABA@@B@B@@A@@@ABA@@B@BA@@BA@@@A@@B@@A@A@A@@@A@@@A@@@AA@@A@@@A@A@@@A@@@@B@@A@@@@@@@A@@@@@AAA@A@AAAB@@A@@BA@AB@@AB@@ABA@A@@@@BAB@@A@A@A@A@@@AB@B@A@@AB@@@@ABA@@@@BAAA@@AA@@@@A@@A@@@@@@@CB@A@@ABC@@@AAAB@@C@A@A@@@A@A@@@A@ABC@@@ABA@A@A@@D@@A@@B@B@BA@ABEBGIEBKNE@AHEFCAKEIAGBCJEBEAID@@@@A@@BA@@B@@ABA@AB@@@@A@AB@@CAAA@BA@@@@B@@C@A@@BA@A@@@AB@@A@@@AA@@A@@@AB@@@@CB@B@DB@BBBB@@@@@BBBB@DDB@BDB@BDBH@@@BFD@B@@BFDF@@BBBB@@@DB@@BA@@@BB@@@@@B@@@B@@DBB@BDBB@@B@@BB@@@@@@B@@BB@@@@BB@B@@@B@@@B@B@@BBB@@@BBBBB@@B@@DB@@BB@@BB@B@BB@@@B@DA@@DBFBAB@@@@BB@DABBBB@@@B@@@@BD@@@BBD@@@@@BBBB@@@BAB@@AB@@BBA@@@B@@B@@@@@@B@DA@@@@BA@@@@B@@@B@@@@@BB@@@@BAB@@@@AB@@@@@@A@@DA@@BA@@B@@@B@@@B@@@B@BBB@@A@@@@B@BAB@@@B@BAB@B@@BB@@@BB@@BB@BB@@BAB@B@B@@HH@ABAB@@@BBB@@@@B@BBBB@DDDBB@BB@@B@B@@@B@BAB@BA@@@@@@B@BA@CBABGFA@AB@DCBA@@B@@@@@B@B@B@B@DABAB@@BB@B@B@@@B@@BB@BB@@D@D@BAB@@@BAB@D@B@B@B@BB@D@B@B@@@@DB@@BB@@BB@@@B@D@BBBBBB@B@@@B@@BB@@@BBBDDB@B@B@@@D@@A@AB@@ABC@@@@B@@@B@@BB@B@BABB@@BAB@BAB@@BBB@BBBB@BB@@BB@DBB@@@DB@@BB@B@@D@@BD@@B@@@BBBBBB@@AB@@@@BB@BBB@@B@@@BAB@B@B@BBB@BB@@BD@B@BB@BBB@BB@@@BB@@B@@@B@BBB@@BB@@BB@@@B@B@B@BB@@@BBD@@@B@@@@A@@B@F@B@@A@@@@@A@@B@@@D@@@@@B@@A@@B@B@@@D@@BBABAB@@@BA@A@A@@@CB@@AB@DAB@B@@@B@@@B@@BBB@@@B@@B@@B@B@@@@B@@@@B@BBB@B@@B@BB@@@ABBB@B@B@@@B@@@BA@ABAB@B@BB@@B@B@DBB@B@BB@@@B@@@BBBBBB@BB@B@B@@@BAB@@@B@D@D@FABB@B@BB@@BA@AB@@@B@B@@AB@BB@@@@B@B@@@BAB@@A@@@@@@B@@@D@BB@B@@@BBB@@@AB@@@BDB@@BAB@@B@@@BB@BAB@D@@@BB@@@@@B@@ABBB@@BB@@@D@B@BB@B@B@@A@A@@@AB@@AB@@@B@B@@B@@B@NDH@DCCA@A@@@@BA@@B@B@@@B@@@B@@BB@@@DB@@DBBCF@@B@@@B@@@@@B@@@B@BB@@@B@D@@@BAB@@@B@@@B@@@B@@@@BB@@AB@@@@AB@@B@@BB@@AB@@@BA@A@@B@@@@@@B@B@@@@@BB@B@@A@A@@@A@CB@@@B@@B@@@@@BA@@B@@@@B@@@@@B@@@@@B@@B@B@DAB@@@B@@@DB@@@BB@@@@@BA@@@@B@@B@@@@@BB@@@@@BA@@BAB@@@BB@@AB@BA@@B@@@@DB@@B@@@@@B@@@ABBB@@@@BA@@@@B@@@@BB@@@@@BBBA@@B@@@@@@B@B@BA@@B@@@@@@@@B@B@@@B@BB@@@B@@@@@@B@@@@@@ABC@@@@B@@@B@B@@B@@@B@@@B@@@B@@@B@@B@D@@BB@@B@BBB@B@@@@A@@BA@@@@B@@A@@@@@@D@@@@@@D@@@@@@B@@@B@@BB@@@BBB@@CD@@@B@BB@B@@@@@@@@B@BA@BB@@B@B@@@@B@BB@@@@@BAAAB@@@@@@@B@@@@@B@AB@B@@@BBB@@@@B@@A@@B@@A@@BB@@@DAB@B@@@@B@BBB@B@BB@@BADABA@AB@@@BB@@@B@B@@@B@BB@@@@@@@BA@@DAB@@A@A@@B@BB@@BD@@@@BB@BABAB@@AB@@AB@BAB@@AB@@A@@BBB@B@@BBBB@@@@@B@@A@ABAA@@AAA@@@A@@@ABABAHB@DBDBABA@E@@B@BD@B@BBBDBDDD@DAB@@@@@DB@@B@@B@B@B@@@B@@BB@B@@B@@@@BD@@@BAB@@@@DDBB@B@@@@BA@@@AB@D@DBBAB@@CBAFBD@B@BBHCBB@@BA@@B@BA@@BCAA@CB@@@AA@@@A@@B@F@BAD@B@BABA@@DB@BD@B@B@@@BA@@D@@ABA@@@@B@@@AA@@@@@@@@B@@AB@@A@AB@BA@@@@@A@A@A@@@AB@BAB@@@BA@A@@CA@@@@AA@@@ABAA@AA@@BA@@B@@A@AD@B@@@@A@AA@@@A@A@@@@AA@@A@A@@@@@BB@@@@A@ABA@@@@@A@@A@@@A@AA@@@@@A@AB@B@@@@A@@@@A@AAAAAB@@@AA@AABA@A@A@@@A@@@AB@B@@B@@B@@@@D@@B@BA@@@ABBBABABA@@B@B@@@@A@AAADC@ABCBA@C@@AA@@BABA@AD@@@@C@ABA@AA@B@BA@@@A@@@@@@@A@@B@@@B@@@@@@@@@B@@AA@@A@@BA@@@@@@B@@@@AB@@ABABA@@B@H@H@@CBCD@DFAD@DN@DCJKDA@B@HCF@FDABBB@BB@BCDBDB@@AJAD@@@D@B@@BB@@@@A@@@@@@@A@@@ABBBBBB@@B@DBB@D@DC@CB@FDBDDBFCB@BCD@B@FAB@@ADBDA@@DA@@B@HC@@DADA@@DABA@A@@A@@A@@B@@@@AB@@B@@B@@AA@BABA@AB@@@B@BD@@@@BA@@B@BBB@@@B@B@B@@@D@DAB@@BB@B@B@BB@@B@@BBB@@DC@@@@@@@AAA@@@@A@@AAA@@@@B@@@@GBAB@@@@AB@BA@@@@@@@C@AB@@AA@@A@@ECA@@CB@B@AA@AB@@@BA@A@A@A@A@ABA@@@@@@@@B@@A@@@C@A@@BA@ABABBHB@@B@B@@BBAB@@@@@B@@DB@@@@@B@@@@@DB@@@@@@@B@@B@@@@@@B@@DA@A@@@A@CB@@@@B@B@BDBB@BB@@AB@@BD@@B@B@DD@@@BB@B@@@@@B@@@@@@@@BB@@@@BB@AB@@A@BB@@B@CBA@@B@@@BA@AB@@@B@B@BBB@@@DB@@@B@B@AAB@@@B@D@@@B@@@@@B@@@B@@@@@@@@AB@@@@@@@B@@@B@B@@@@@B@@@B@B@@AB@DB@BB@@@@@@AA@@@A@@ABA@@AAAAA@@@A@@@A@@B@@@AAAA@@@@@A@@@@B@@@@@A@@@A@@@@@AA@@@@@@A@@@@@@@A@ABA@@@A@@@@@A@@A@@AB@@@A@@@@@@@@A@@A@@@@@@@@AA@@B@@A@@@@A@@@A@@@@AB@BABB@A@@@@@@C@@A@AA@@@@A@@B@B@@A@@@@@@AB@AA@ABAB@@B@@@@@A@@@ABA@A@AAE@@AAA@@@@@@AB@BEBCB@@@B@BABADGBADC@@DAB@@@@A@@@AB@@A@@@@B@@@@@@@B@@A@@BA@@BA@@@@@A@@BA@A@@@B@@@@@@BBBA@@@@B@@@@@@B@@@@BCA@BA@AB@@A@A@@B@B@B@BAD@B@B@@BB@@A@BB@@@@B@@@@B@B@BABB@A@@@@@BB@@@BA@@@A@@BA@@AA@@B@@@BA@@@@DAD@@BBD@D@HB@@B@@@@@B@@BB@@@@@@B@@@@B@@@@@@BB@@AA@AB@@@@@@@@@@B@@B@@@A@B@@B@A@@BBA@@@B@@@@@@AB@@@@B@@@B@@@@B@@A@@@@@@B@@@BB@BBD@CDEBA@A@A@@@@A@ADA@@A@A@@BA@@AA@@@A@AB@@@BA@@B@@@@A@@BB@@@AB@@@@@BA@@@BB@@@B@@@@@@B@BBABDB@@@@A@@BA@@BA@A@@B@@@@@B@D@DAD@@@D@@B@@BABAAA@@@@AA@A@@@A@@BA@@@@BAB@@@@@@@B@@AB@@@ACB@@ABB@@B@@@@@B@@AB@BCB@DCBAD@@BAB@@BBDBB@BAFCHEB@@@DADCD@BBDB@@B@FDB@@@BB@B@@@B@@A@@@AB@@C@A@CFEDA@ABA@CD@@A@@BB@@@FBB@D@B@@@@B@B@D@B@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@B@@@@@B@@B@@@B@@@B@@B@@A@@@@BB@@@@@@@@A@@BB@@@@@@@@@@BB@@@@@@@B@@BB@@BB@BB@B@BA@@B@D@@@B@BADCB@BA@@@@BA@ABA@@@@AA@@@@BC@@@@@@B@@AB@@@B@B@@AD@@@B@BA@B@@B@@BBA@@B@B@@AB@@@B@@ABAB@BBB@@A@@B@B@B@@@@@AAA@AA@@AAA@A@@@@@AA@@A@@ABABAB@BBD@@AC@AA@@AAA@A@A@A@CA@@B@@@@@B@@@B@@A@@@C@@A@C@A@AAAA@@A@ABA@C@A@@@@@CB@@@BA@@@@@@A@@AA@@AB@@AB@@@BA@@@@AA@@@@@@A@@@@@AB@@@@A@@B@BAB@@@@AFAB@B@@AB@BAB@@@B@@@@@@AB@@@@@BABA@@B@BB@@BB@A@@B@AA@C@A@C@@@A@@B@@@@A@A@@B@BABABA@ABA@AB@B@B@@A@@@AAC@A@@CA@A@@@A@A@AA@ABABA@A@@@BAAA@A@A@@@@@AB@@@@@B@B@D@@@BABA@ABB@@B@B@@@@@DA@AB@BAB@@A@@B@B@@AD@D@@@B@@@@I@AACBC@I@@@AB@@@BBB@@ABA@AB@@@B@@A@@AA@@BCA@@@A@@@@@A@@@@@@A@@AA@@A@@A@@A@A@B@A@@A@@@@B@@@B@B@@B@@B@@A@@@A@@@@A@@@@@BA@@@@@@@@A@A@@B@@@A@@BABB@@@A@@@@@@AA@@@@@@@@@AA@B@@AB@@A@@A@AA@C@@@AC@@@AA@@A@@@AAAA@@A@@@@@AAB@@ABA@@@@@A@@A@A@@@@@@AAA@A@@@@BA@@@@@@AA@C@@@@B@@@@@AA@@D@@@@@@@AC@@@A@A@AA@@@AB@@@@@@@@@A@@@A@@A@@@@ABAAA@@@@@@@@@@@A@@A@AA@CAA@A@CBA@AAA@AAC@C@@@@@AAA@C@A@C@@@A@A@@@@@A@@@@BA@@BB@BHB@ABA@A@@@@@A@A@@@A@@@ABA@A@@BABA@@@A@@A@@A@@AAA@A@AAAA@@@AA@AA@A@A@A@AA@@@@@AA@@@@@@@@A@@@@@@AA@@@@@A@@@AA@@A@@@A@AAA@AA@@@@A@@@A@@@A@@AA@@A@@@A@@A@@AA@@A@AA@@A@AA@AAA@A@@@@@CB@AA@A@C@CBA@AAA@@AA@@AA@@@@A@@A@@@A@A@AAA@C@@@A@A@AAA@A@@@@A@AAA@AAC@@B@AA@@A@@A@@@AAA@AA@@A@AB@@@B@@AD@BABA@@@A@A@@BA@@@A@@@@@AAA@AA@AA@A@@CAAA@@BA@@@A@@@A@@AA@@AA@@@A@@@@@A@@A@@@BA@@@@@C@A@@@A@A@A@@@@A@ABA@A@AAC@@@@@@BCBA@A@@@A@ABA@A@@@C@@BAB@@@@A@@BA@@BA@@@A@@BAAA@@@A@@@AB@@AA@@A@@@AAA@A@AAA@AAAA@A@A@A@@@@@A@@A@@@@@A@@@A@@@@BA@@@@A@@@AA@@@A@@@AB@AA@@AA@@AA@CAA@AC@@@AA@AB@@@@A@@@@@@A@@@@A@@A@@ABA@A@@BA@@A@@AAB@@A@@B@B@ACA@@AAAA@A@A@@A@@AAA@@A@ABAA@@C@ABAB@BA@A@A@AB@BA@@@ABAB@BA@@@@BAB@D@BABA@@B@@A@A@AA@@@@A@@A@AA@@A@@A@@@AA@@A@@@A@@AA@@A@@@@AAA@@A@AA@@C@A@AA@@ABAA@A@@@AA@@AA@@@@AB@@A@C@@AB@@@A@@@C@A@@@@@ACC@@@@AC@AA@@A@@@A@@@@@AA@@AA@@@A@A@@@@@BA@AA@@@AA@@CAAA@@@AAA@@BA@@@A@@A@@@A@AAA@@@A@@@C@AA@@A@A@@@@BCA@@A@@A@@A@@@@@AA@@A@@@AA@@AA@@AAA@@B@@ABA@A@A@AB@@@@C@@@AD@@@B@@A@AAA@@@AB@@A@A@@A@@AAAAA@@@A@@@A@AAA@"],encodeOffsets:[[111693,23538]]}},{type:"Feature",id:"450123",properties:{name:"隆安县",cp:[107.696153,23.166028],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@A@A@AACBE@@C@@AD@DEBCFCACDEFABB@@B@@BBB@@B@BBB@@@BA@AB@@DDDFA@@@A@@D@B@BAFA@D@B@B@DBB@@@@B@@ABA@A@@@A@ABA@@@A@A@@B@@@B@@@@@BA@AAA@@@A@@BA@@@A@@@EA@@A@ABABA@@BA@@@AB@@ABA@@B@BAAC@C@C@@A@@A@@CEC@@@A@@@AB@@A@AA@C@AA@@@AB@@A@@@@C@ADA@@ACAAA@@@@EAC@A@@D@@A@ABA@@@C@CACAA@@@@@@@A@@@AB@A@BA@@@@@@BCB@B@B@BC@AAA@A@@@A@A@@DABABEDABABABCA@@@@CA@AC@A@EAA@AA@CAA@AB@AAA@A@@@@BAB@@@@@@A@@@@@@B@B@@@@@@A@@B@@@B@@B@@B@B@@@B@@@@@@@B@@@BAF@@@BADB@@BC@C@A@@@A@@@A@AAAA@@AAAA@@@@A@@@A@@@@@@ACD@@@B@BA@C@A@@A@@A@AAA@@AA@@@A@C@CAA@@@A@A@@@C@@@AA@A@A@A@@C@AAA@C@@@@BAB@@A@@@@@@A@E@A@@@@@AA@@BA@@@@@@D@B@B@B@@A@@B@@@@AA@@AAAB@BAB@@@BAB@@@@A@@@@@@@@@@A@@A@@@@@@@@@A@@@ABB@@B@@@@B@@@A@@@@B@@@@A@CAC@@@@@@BBB@@@@AB@@@B@BB@FBB@@@@BA@@B@@@D@B@BC@A@@@A@A@AA@DD@@@@@@AB@@B@@D@@@@@B@@BB@@@B@@@@@B@@@@@B@@A@@BD@B@B@@@B@@BBB@@@AD@BA@@B@BCB@@A@@AAA@@AAA@A@@AAB@@AB@@A@@A@@A@@AA@@A@@@@A@@@A@ABA@A@@AA@@@A@@@A@@@ADC@@@CB@@@A@@AA@@A@A@AA@@CA@AA@@BA@@@@BABAB@@@B@BAB@@B@B@D@A@@BA@@B@@@@@BBB@@ABA@@@BD@@D@BBDBB@BB@@BB@@@@DA@@@@B@BB@B@@@B@@AB@BA@CA@@A@@@@B@B@B@B@@A@@BA@@@AB@@BBB@@B@BBB@BBB@@@B@@AD@@AB@@ADB@@B@@AB@@BB@@@BB@@@AB@B@@A@BB@BB@B@@A@@@BB@@BB@BB@A@BB@@B@B@@BAF@@AB@@@B@@B@B@@B@BADB@@@@@@@@D@@@B@@@BA@D@@BAB@BAB@B@@A@A@AB@@@B@@@B@@BBDDFBB@@B@@@B@@BB@@B@@@B@@@B@BA@@BABADAB@@@BB@@@BBB@B@B@FAB@@@@BB@@B@@ABAB@B@@@BBBA@@B@@CB@BB@@B@@@B@@A@@@@@B@B@BB@BDB@B@@BB@@DB@@DD@@BBBA@BD@@@B@@BAB@B@BDBBD@@BBB@@@@BHAB@@BB@BD@DBB@@B@@@B@B@@A@A@@B@@@B@@@DB@D@B@@BBD@B@@ABAB@B@DA@ABAD@@@DB@A@A@A@AB@@AB@B@B@F@@A@AC@AAA@@AA@CA@@@BCCB@@AAAB@BABBB@DC@@B@BB@@@@B@@@BC@@CABA@@@ABC@@BBB@@@B@@@B@@@@ACCBA@@BA@C@@@AACFEFD@BDBBA@BDDB@B@BA@@BAFC@ABABA@AFBDC@@BBB@B@@@DE@AA@@CA@A@A@AC@AC@@@CA@BA@AABAB@B@@@D@BA@B@ADB@ABBBBB@@A@@B@@B@@@BB@B@DBBB@BD@BADAB@B@BB@B@@@BB@@@BB@@B@B@BB@@BA@@@ABA@@B@BA@@@@@AB@A@AAA@@@AAA@AB@AAA@@B@BA@ABA@@@@@AB@@BB@@B@@@@B@BAB@@@DA@@@ADABBBA@@AA@AAA@@@A@A@ABA@A@@B@@@B@@AB@@CAA@C@@BE@@@A@ADCBC@A@@@C@@@@@A@A@C@@D@D@FAD@BB@BBBBBBBDADB@@CB@@ABB@DBF@BAB@@CB@B@@@@B@@AB@@BD@@BBB@BABC@@DB@D@BBBABAB@BB@BBBAFEB@@B@B@D@@@BB@BBBA@@BA@@AAA@@A@@BAB@BDB@@@B@@A@A@A@C@A@@BAB@BBB@@@DAB@@@BBBBB@BBB@@@@@@@@A@@@@AAA@@@@A@@@AB@@@@AB@B@@AB@@@B@B@@@@@B@@A@@@@@A@@@@@@@A@AA@@A@A@A@@@@B@@AB@BB@@@@@@@A@@@@@A@A@A@@AA@@@@@A@@A@BC@A@@@AAC@A@A@C@CD@BA@@B@@@AA@@AC@@@ABA@@BA@A@ABCDA@ABABEB@@ABA@@CAFCFAFCB@D@@@BABA@ABABABA@AD@BADA@A@BAA@ACAA@A@A@@BA@@@ABA@@@A@@@C@@BA@A@@@AA@@A@C@A@ACA@A@@@@A@A@@@AA@@C@@A@AA@BC@A@A@A@A@@@A@CAA@@BA@@@A@@@A@A@@E@@BA@@@A@CA@AA@@A@AAC@AAA@BCBC@C@@@AA@C@A@@@A@A@@@C@AB@@A@AA@AEAA@A@C@A@AA"],["@@BBBAFEDCDBDEFA@CBCAA@@AA@@@ABCAABA@@@@B@@A@@@A@@@A@@@@@A@@@AA@A@CCAA@B@@@B@B@B@@BBA@@@A@AAAA@@ABA@@@AA@A@@@AAA@@@@AB@@CA@A@@A@@@A@@@@@AA@@AA@@@@@A@@@@@@@@A@A@@B@@@@@A@@@@AA@@@AAAA@@@@A@@AB@AA@A@@@CKKU@@C@CBC@@@A@A@@@@@A@@@@@@A@@@AAA@@@A@@A@CAA@AAA@@@A@@@@A@@@@A@A@@@A@@@@A@A@@A@A@@@A@@A@@@@A@@AA@@@@@BA@@@@@AAC@AAA@@A@@AAA@@AAAAAA@@@@AA@@A@@@@AA@@@G@E@A@@A@@@B@AAABC@@CAA@@ACA@@@C@@AABA@A@AAA@A@@@CAC@A@@AAA@@@@CA@@@@AA@@BA@@@A@A@@@A@@AA@A@@BA@@@AC@AA@@CA@AA@@A@A@@@E@CBAA@@@@AA@@@CAA@C@@@A@@A@@@AAA@@AA@@BA@A@A@AA@AC@@@CA@A@@A@AA@@@A@@ACAA@@EA@@A@CA@AA@E@CAA@AAAE@A@A@A@AA@CA@@A@@@@@@@A@@A@@@@BA@A@A@@@A@AACA@A@AAAA@@A@@B@B@@AB@@C@@BA@@B@B@@@BA@@@@@A@@B@@A@ABA@@@@D@@@BEB@@@B@@BB@@@@BBADCD@B@BA@@@@@AB@@@@@@A@@@@A@@@@AA@@@A@@B@@@B@@@A@@@A@A@@@@A@@@B@@A@@@@@@@A@@@AA@@@@@AAA@@@AA@@@@@@@AA@B@@A@AB@@@@C@AA@@@@@BB@@@@@@B@@@@B@@@@@B@@@@B@@A@@B@@AB@@A@AA@@A@A@A@@@A@CBA@A@@@@@A@@@BB@BBB@@A@@@B@@@BBA@@@A@@B@@A@@@AB@@A@@@A@A@@@@A@@@@@AA@BA@@BAAB@@@BA@@@A@@@@ACC@@BA@@AAA@A@@AA@@ABA@AAA@ABA@@B@@A@@BBBA@@BA@@@@@A@AA@@@@@AA@A@@CAE@E@CBA@A@A@@@A@@A@@@AAAA@@A@@AA@@A@C@A@A@A@@@A@AAA@C@C@C@@@A@AA@@A@C@@AA@@A@@A@GA@A@A@AA@CA@@A@A@@@EAAA@AA@@AAA@A@@A@@@AA@@A@C@AAA@A@@@A@@CA@@@@@CCABABABA@@@ABA@@@@@AB@@A@A@@@A@@BAB@BABB@@B@B@BAB@BBB@@@B@D@@@@AB@BADA@@BAB@BA@@BCFA@ABA@@BC@C@@@ACAA@A@@@@@A@@@@@A@@BA@@A@A@@@@A@@@A@@@@@AAAA@@@AB@BA@BBAB@BAB@@A@@@@@A@A@@AA@@@A@A@@@ABAB@@A@@@@B@B@@@B@@@BA@@@A@@@@@ABAB@@@@@@@BAB@A@@A@@@@BAA@@A@@@A@@@@@AB@@@@@BA@@@@B@@@@@@@AAA@AA@@AA@@AA@@@@A@A@@AAAAA@@A@@C@@AAA@A@@A@ABA@AAAA@@C@C@E@AB@@CB@B@@@BA@@@@BA@@BAB@@A@@@C@A@AA@@A@@@A@CAA@A@@@C@@@A@@@CAA@C@@@@@AB@@A@A@@@A@AB@@@@A@@AAA@@A@@@A@@@A@@BA@@@@@A@C@A@@BA@A@A@@@ABA@@@A@A@@B@@A@@B@@CACAA@A@@AA@@A@@A@@A@CCG@@BAB@@@@@@@B@B@@@B@BA@@B@@@FA@@@A@@@ABAB@B@B@@A@@@AA@B@@@BAB@@@AA@A@@BA@A@@BAD@B@@@DA@@B@@@B@@@B@@B@@@A@E@A@@BADBB@@BBB@@B@FAB@@@BA@AAA@@@A@A@@@A@@B@BABABA@@@C@@@@BC@CBA@@@AB@B@B@@@B@B@BAB@B@B@@@A@@ABA@A@C@@DA@@B@B@@@BA@A@ABA@A@@BAB@B@@ABA@@DAB@@CA@@@B@BA@AB@@@BAAA@@@@B@@A@@D@@AB@B@@@B@@CAAAAA@@@A@@A@A@@@AA@AA@@A@AAA@A@@AAC@@@A@A@A@@AA@A@@A@A@A@@@AA@@ABA@A@@@@@@AAA@@@@CAA@@A@@@AC@@@G@@AAB@AA@A@@AC@@BA@@BA@@DAB@@@D@@@@AB@B@@@BA@AB@@A@C@@@AB@A@BA@BB@B@@@D@@@B@@@@AB@BA@@D@B@@@B@@@@@B@@AB@@@BA@@B@B@BA@@B@D@@@@AB@@@@AB@@@@A@@@@B@@A@@@@@@B@B@@A@@@@@@B@@@@@@A@@B@B@B@@@@@@@B@@@@@BBB@B@BA@A@@B@@A@@BAB@@C@A@A@@@A@BAA@A@A@C@A@AAA@ABA@@@@@A@@@@B@@C@A@A@A@A@@@ABA@AAA@@@A@@@AB@AAB@@@@@@C@ABAA@@@@A@@@@@A@@@A@@@AA@@AA@A@@@AA@@AC@AA@@A@A@@AC@@@A@A@@@A@@@A@A@@@AAA@@@@@AAAAA@@AA@@B@AAB@@AA@@A@@A@@A@@A@@AAA@A@@@A@E@@@@@A@A@@@A@A@AB@@AAA@AAAAA@@@AB@@@@@A@A@@B@BA@@@@B@@AAC@A@A@@BCDA@A@A@A@@@ACA@@CCB@@A@@BAB@@@@A@@B@@ABABACC@@AAAAB@@A@@AA@@A@A@A@@@C@A@@@A@@@@A@@AB@@@@AA@@A@A@@B@AA@@@@@ABC@A@@AAA@BAAC@A@A@@BA@@@A@A@@BA@@A@@C@@B@@AA@@@BAA@@@@A@@@A@@AA@@AAAB@@A@@AAC@ABAAA@@@AAA@@@AB@@@A@@AB@@@@A@@@A@@A@@@AA@A@AAA@@@@A@@AA@@@@GACA@@@@A@@A@@AA@@A@A@@@@@A@A@A@@@@@A@A@@@AA@@@@A@@@AAA@@@@@@B@@A@@BA@@B@BA@@@AB@@@@CB@@ED@B@@BB@@BB@B@BCB@DA@@B@@@B@@@B@B@@CDAB@@@B@B@@@B@BA@A@C@@@A@C@@B@BBBBB@B@B@@@@@B@@@B@@A@AB@@@D@B@B@B@BABB@@@@B@@@D@B@D@@@@BB@@AB@@@B@@@BBBAB@B@BB@ABA@@B@@@@@@A@A@@@@B@@B@@@@@@@@@@B@@B@@@@@@@@B@@@D@@A@AD@@A@@BCA@@A@@@@@AB@@@@@B@@@B@B@@BB@@AB@@@BAB@D@@AB@@@@@B@@BB@@A@@@@@AB@B@D@@BB@B@@B@@@BB@@B@@@B@B@B@BAD@@@B@@B@B@@@@@@DBB@@@BB@@@@B@@BB@@B@BB@@@D@@@BB@@B@B@B@@BB@@BBB@@ADAB@@B@AB@@A@@@ABAB@D@@AB@@@B@@@BA@A@BDBBBB@@@@@D@@@DBBBB@@AB@B@@@BBDBD@@@@AB@@@BA@@@A@AB@B@@@@BB@@@BB@@B@@@@@B@@@@B@@@BB@@@@@B@@A@@B@@@BB@@B@@B@@B@@@B@@A@@@@B@D@@@BA@A@@BA@@@@@@B@@C@@@A@@BA@@@@@BBBBB@@@@B@B@B@B@BA@@@@@C@A@CA@@AB@@AB@@AB@BBF@@@BA@@B@@CBA@@B@B@@AB@BBBAB@BC@A@A@ABA@ABAD@@BLDBBABB@B@@B@@BBBADA@CB@BA@@BB@@B@B@@BBABAB@BC@A@A@A@@AA@A@@@ABAB@@AB@B@@ABBBCBABA@AAIABBAF@@DDB@CB@@A@ABC@A@@AA@A@A@@@@@@@@BABAB@@@BA@@BA@A@ABAB@@@B@@@@C@AAAA@@C@@@A@@@@AAA@@@A@@@@A@AA@A@A@@C@@@A@ABAA@@@BA@@@@@A@A@@@ABA@@@@AA@@@@@@BA@C@CBAB@@CB@@AD@@A@C@AAA@ACCAAAA@AAA@AA@AAACAABABAFA@@DA@C@@@A@E@C@AEECAAC@ABCA@CAC@AEAA@CADE@A@AAA@@EB@BE@ABEAADBBABBBABA@E@CACB@D@BCBA@AAC@@DA@@@@BCDA@A@A@CDCB@B@BDD@DB@BF@DBDB@JCDJBBDBD@@B@DEDDDBDADBBAF@@@@@@B@@BB@DA@@DBB@@@BDB@FCBA@@B@@B@@BB@@B@@BB@@@BBD@@@BCB@B@BB@@@@DB@D@@BB@B@BB@B@@@B@B@B@DFB@@BB@B@BB@BB@BB@@@@@@@BAB@@C@A@@@ABAAA@A@@@@AABA@A@@@ABA@@@A@@@AAA@A@@B@@@B@B@@A@@B@@CA@@@AA@@@CBC@AB@@A@@B@B@@@@A@@A@AA@A@A@AB@@@BBB@B@@@@BBB@@B@@@B@@@BC@ABAB@@ABAB@B@@E@A@ABA@@@@@AA@@@B@@@@@DAB@B@@@B@@@B@BAAA@A@C@ABA@@@A@@@C@@@AB@@@@@DB@@BBBC@C@@@A@@@A@A@A@@BA@@@BBBD@@A@@BA@C@A@A@@@@@@B@B@BB@@@@B@@BD@@@@B@@@B@B@@@B@@@@B@@@BB@@B@B@@@BB@@H@BB@B@B@BBB@B@@@BBB@@A@ABBDAB@B@@@BBB@@@D@@@BAB@@ADBB@BDB@@@A@@BAB@@@B@@@@@@A@@BA@@@@BA@@@A@@@A@A@@A@@ADA@@@A@A@@@@BAB@@AB@@@@D@D@B@BBB@B@@@@B@D@@@B@@AB@BA@CBABADCF@BDB@BBB@BB@@D@@@@BB@@@B@@@@@B@B@B@@@B@@@BA@A@@@A@@BA@AB@DA@@@@BA@@@BBB@@@D@@@@BDBD@@@@D@@@BBB@@@@D@B@@@@@@BD@BBFADB@A@AAAB@@A@@@A@@BA@@DB@BB@@B@@BB@@BB@@@BAB@@@@@B@B@@@BB@@@@BABA@@@CB@@@D@B@BBB@@D@B@@@B@@@@BBB@@B@@@@@B@BD@@B@@@@D@H@@@B@BA@@B@@@B@@BBB@@@@B@BABA@@BBBBBB@@B@BA@@B@B@BBBB@B@BB@@BBB@@@B@BA@BB@BB@B@B@@CD@@@B@@B@@@B@@B@AB@@@BB@A@@B@B@@@B@@@B@@@@@@ABB@B@B@@B@@@@@BA@@@ABA@@@A@@B@B@B@@@B@@@@@D@B@@@BB@D@@@@@@DBBBB@@BB@@BBBFBBBB@@@B@B@@@BB@@@@B@B@B@@@B@@@@@@@B@BB@@BB@B@@@BD@BAB@F@BB@@BA@AB@@A@@B@@@BBB@@BB@BB@@@@B@@@@A@@@@B@@AB@@A@@BB@@@AB@@@B@@@B@@@@@BA@@@@B@@@@A@@@@@@BA@@@@BA@@@AB@@@@@@A@@@@@@A@@@@A@@A@@@B@@AD@B@@@BAB@@B@B@@@B@B@B@@B@@@@B@@DAB@@@D@BAAA@@B@AAB@@@B@@@BB@@DBB@BBD@@BB@B@@@@@DAB@B@@@BAB@@@B@BA@@B@@A@@B@A@@@BA@AA@@A@@@@@AAA@@A@@@AA@@@@C@@@CA@@@A@@@@@A@@AA@@@AAAAA@@DC@ABA@@AAAC@@C@@@A@@@AB@@@A@A@@@AB@@AAABADA@@B@B@BA@@B@@@BAD@@@B@AB@@ABABA@@BB@BBBBB@BAB@B@B@B@@@@B@@@B@BA@B@@@BB@@@@@@DC@@BA@AB@@@B@@@B@B@B@B@@BAB@BB@@DAB@B@@@@BB@@@AD@B@@AF@@@@CBA@@AA@A@@@A@@@C@@B@B@@@B@@@B@BABAB@@BB@B@@@@AB@BBB@@@BA@ABBB@@A@@BA@@B@BBB@@ABAB@@@BA@@BAB@A@AC@@B@@A@@@AB@B@B@@@@@BA@A@ABBB@@@B@DB@A@@@@B@@A@CB@D@B@@@D@BAB@@@BAB@B@@@@BBA@@B@@B@@B@@AB@@@D@@@@@B@B@@@@@B@BB@AB@@@@@BB@@@BD@@@@@B@BA@@@AB@B@BAB@@A@@B@@@@@BABB@B@B@@@@@B@B@@@BB@DB@@@D@BA@@@BD@@@D@@@AB@@@B@@B@@BB@@B@@@@B@@@@A@@@@@CB@@A@@@C@@@AB@BAB@@ABAB@@FFBBB@@@@@B@@@@@@@B@BB@@@AHA@ADAB@BA@@BBB@@@DB@@DB@@@@B@BBB@@BBB@B@@ABA@A@@B@B@BA@A@A@A@@@B@BB@BB@DBB@@B@D@B@B@@@B@BB@@D@@@@A@@@@B@@A@@@AB@@@@@B@@@@CB@BA@AB@B@@A@AB@@AB@@ABAB@@@@@BB@@@@@BB@@@B@@@@@@@@@BB@@@@BB@@@@@@@@B@@@@@@@@ABBB@@B@@BA@@B@@@@@B@@B@@B@@@B@@@@@BAB@@BB@@AB@B@@A@@BAAA@@B@@@@AB@@@@A@@B@@@@ABA@@@AF@@B@B@@AB@@BB@@@BB@@BA@@B@@@@@DB@@BBB@@@@@AB@@BBB@A@@@@BA@@B@@AB@BBBB@BB@@@@@B@@@B@B@B@@@@@@BA@@BCBAB@@BBB@@@@B@B@@A@C@@BABBB@DBB@BB@@B@@@DC@@D@B@@BB@@BB@F@DAB@@@B@F@FAHCDAB@B@B@BAH@DAF@DADAFBB@FADABAB@@A@C@AAC@AAA@@B@D@H@B@B@BBB@DBBBB@BB@B@@@@B@@@@@DB@@FB@@B@BBD@D@BA@@B@@@@@B@BAB@@B@@B@@A@@@A@@BBB@B@@@B@@@D@D@DBBAB@F@@AB@@@@@B@B@B@B@@@BA@@@@B@@FBB@B@B@B@@B@@@BA@@B@@A@B@@BB@BBB@@@BB@BB@@B@@@@@@C@@@ABA@@B@@@@A@@B@@@@B@@@@@@@B@@B@@@@@B@B@@@@BBB@@AB@@B@@@@@@@@B@@A@AB@@AA@@@@AB@@@AA@@@AB@@B@@@@BA@@@BBB@@@@B@@@@A@A@@B@B@D@@@BB@B@BAB@@@@B@@@B@B@@B@@@B@A@@A@@@AB@@@@@@BB@@@@@B@@@@@@A@@@@@@@BB@@@B@DBBB@@B@@A@@B@@@@@@B@@@B@@BAB@@D@@B@@ABA@@B@@@@@B@@B@@@@B@AA@@B@@@BBB@@@B@@@@@CB@@@BBA@@@@B@@@AB@BBBB@@AB@@A@@B@@BA@B@@@B@BB@A@@@B@BB@@@@@@AB@@@@B@@B@@B@@@BB@@BB@B@@@@B@BB@@@@@@ABAA@@ABB@BB@@B@@@B@@B@@BA@B@@@@@A@@B@@@@@@@BA@@B@@@@A@@@A@@@@@A@@@BB@BBB@@@@B@BB@B@@@B@BA@@B@BA@@@@B@@AB@@@B@@@@AB@B@BBB@@BD@B@BDBBAB@@BB@@@D@@@BB@@@@DBB@B@@@BB@@@B@B@@BB@@B@D@@@@@B@B@@@B@BA@@@@BA@B@B@@@B@@@@@BB@@@@@@BAB@@@@D@@@@BDB@@BA@@B@@@BA@@@A@A@C@@B@B@D@B@@@@BB@B@@@B@@@@@B@B@@@BB@@B@@BB@B@@BD@@A@AD@B@@@@A@@BABBB@@ABA@@@@B@BB@@@@@C@A@AD@DBB@D@@DB@@@@@B@B@@@B@@@B@@@@@@@B@B@@@B@@@B@@BB@@@@@B@@@@AB@@@@AB@@CA@@@@@@@A@@@AA@@@A@@@A@@@@@@@A@@@@@A@@@A@A@A@A@BB@@@@@B@@@@@@@@@@A@@@@@@B@@@B@@@@@@BB@@@@B@@@@BBBA@BBABA@@B@@@@BD@@@@BB@B@@@@@B@B@B@@AAABA@@@ABAB@@@@@@@@A@@@A@A@A@C@A@@@@@@@A@@@AA@@AB@@@AA@@B@@@@@B@@@@@@@B@B@B@@@@@AB@@B@@@BB@@@@@@@@@B@@@@AB@BB@@@@B@B@B@@AB@@AB@@BB@@@@@B@@@@@@BB@AB@B@@@B@@@B@B@BB@AB@@@B@@@@@@B@BB@@@@@@@B@B@AA@AAABA@AB@@@BB@@B@F@B@BEB@BF@@BA@A@@BEAC@A@A@@@AA@A@@ABC@@A@@BA@A@@@@A@@AA@ACA@A@AA@@@@@C@@AAAA@@A@AAA@@DAB@B@B@@A@@@A@A@A@@B@B@@@B@B@@@BA@@@A@@@A@@AA@@AAACABC@@@@AB@@@AA@@@AB@@AB@@A@@B@@A@@@@BABBBBB@BA@AB@A@@@B@B@BA@A@A@@DA@@BA@A@AAA@A@CABC@A@@@A@@A@@B@@A@@CE@@@@C@@@AAAA@@A@@@A@AA@BA@@@@A@A@@BAAA@CBA@@@CAABA@@CGBAFCB@BBB@@BB@B@LDF@HBB@BBD@BBDAB@ACBAA@@AA@CAABA@AA@AEAC@C@A@@AA@@A@A@A@ADCCCC@AACAABGCCGACBA@AAAAC@@AAAA@C@@@CBAA@@ABAF@B@@@B@AC@@B@B@BA@@B@@@B@B@@BB@BAB@@AD@DDB@BBFB@BDBD@@BB@@AB@@A@@BABA@A@@BC@@A@A@ECBABA@A@A@@AAA@AEDAD@@ABA@A@@B@B@@@@A@@@AA@@ADA@@@AB@AA@A@@@ABABA@@@AA@@A@@A@EBA@A@A@AA@@A@@A@@BABCBA@AB@@A@A@@@A@@@AA@@AA@@@A@@@AACEAC@AA@@@A@@@A@@B@B@BA@A@ABA@AB@@@CAB@@A@@@C@@@@@@@@@CAABA@@@@A@AA@@@A@@BE@AB@@@A@AA@@A@BAAA@@AA@@A@@@BA@A@@AAAB@@@@ABA@@A@@A@@AA@@BA@@@AA@BC@@BA@@BC@@@A@@AA@AAA@A@AA@AA@@BA@@B@@AB@@@@A@A@A@A@@B@@@DBB@@ABA@@@A@@@AAAA@@@@@CB@@@@AA@@AAA@CAAAC@@@AC@@B@BA@@AA@A@@@@@AB@@AB@C@A@A@@@BA@A@A@@BABA@A@@B@@AB@@BDB@@BBB@B@@@BB@@@B@@DA@@D@BC@@B@@@B@@@B@@BB@B@BAB@@@B@@@@@@BB@@BB@@@@BB@@@BA@@BA@BB@B@BB@@BB@BB@@@DA@A@AB@@ABC@@A@AA@@@A@@@A@AAC@@@BA@@@@@A@@@@@A@@@A@@AA@@@@@C@@@@AA@@B@@@@C@@CBBB@B@@@B@D@@A@A@C@@@AB@@A@@A@EAA@@A@A@@BA@@@@AA@A@@@@D@DBB@@@@@@A@@B@@@A@@@@@@AA@BA@@B@@@@@@@@@B@@@@B@@@@@@@@B@@@@@BA@A@@BA@ABABB@@BB@@@@@AB@@@@A@A@A@C@@@@B@@AB@@B@@@@@B@F@B@@@@B@@@BA@A@@D@B@BBD@@@@B@B@BBB@@D@@@B@B@@@B@DBD@B@@@B@@BB@BBB@@@@BB@D@B@@A@A@@DC@B@@@@B@@@B@@@@@BBBB@@BBBBB@@@B@@@B@D@D@@AA@BC@A@@BE@A@@@A@@@@@@@A@@@A@AA@@@@A@@@AB@@@@@@@@A@A@@@@B@@@@@@@BA@A@@B@B@BBA@@BBB@DBBB@FBB@D@@BDB@@@@DBBABABAFCBABA@CB@B@@@B@B@BBD@@A@A@ADA@A@@@@B@@A@BBA@@B@@@@@@@B@DBDBD@@@B@BAB@@@@CB@D@FB@A@@@@B@B@B@@@D@BAB@@A@@@@@@@@A@C@C@@@A@@@A@@A@@@ABABA@AB@B@DA@A@@@@@@AAABA@C@A@@BA@@B@@A@C@A@@@@A@@BA@A@A@CA@@AA@A@@A@@@AB@B@B@B@BA@@@AA@@A@E@@BC@@@@BA@@B@@@@B@B@@@BB@@DBBB@@BB@@A@@BC@@BABCDC@CB@@@@@@@B@@@B@@@@@BBBA@@B@@@D@@@BB@@@BB@BB@@@@AB@@ADB@@@@@@B@BA@@@B@@B@@B@B@@@BB@@B@B@DBB@B@@@@@@A@@@@@AA@B@@AB@@@BBB@@@@A@@B@@@BB@@DB"]],encodeOffsets:[[[110622,23619]],[[110599,23629]]]}},{type:"Feature",id:"450127",properties:{name:"横县",cp:[109.261384,22.679931],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@AA@@B@@@@ABAD@BBH@B@BAB@@@@ABA@@B@@AB@@A@CF@@AB@B@@@@@B@@@BA@@@A@@@@B@@@B@@@BA@A@ABABA@@A@@C@@@A@AA@@@@A@@BAB@@@@A@CAA@A@@@@@A@@A@A@@A@A@@AAA@@@AA@AA@BA@ABAB@@AB@@B@@B@@@@@@@@@B@D@B@@A@@@@B@@@@@@@@ABB@@@@@@B@@@B@@B@@@B@@@@@@BB@@B@@@@@BAB@@@@@@AAA@@@@@@A@@@AA@@AABAAA@@AB@AA@@@@A@A@@AAB@@A@@@@@A@@@@B@B@@A@@@A@@@@@@B@@BB@@A@@B@@@@@BA@@@AAA@@@@A@A@@@@A@A@AA@@@@@@A@@BA@@A@@A@@@A@@B@@AB@@@@AA@@@@@@@A@@@@A@@@A@@@BA@@A@@@@@@A@@A@@@@@@A@@@@@@AAA@@@@@A@@A@@A@@@AB@D@B@B@@A@A@@@@AA@A@A@@@@AA@@@A@@AA@@@@@A@@@A@@@@@@@@B@@@B@@B@@@B@@@@B@@@B@@A@@@@@A@@@@@@AA@@@@@A@@@@@B@@@AB@B@@A@AA@@AAA@@B@@@B@BBB@BB@B@@BB@@@B@@@B@@@@B@@@@@@B@@@@@B@AB@BB@@BDA@B@B@@@B@@@B@@@B@AB@@B@@@@@@@B@@@@@@@@B@@@@A@@BB@@@@BB@@@@B@B@@BC@@@@@@@@BBB@@@B@@BBAD@@@@@@ABA@@@AB@@AB@@@BAB@BABA@ABCAAB@@AAAACAACABABA@@@AB@@A@AA@@A@@@@B@@@@@@BB@@@@@@ABABA@A@@@@@@A@@@@B@@@@@B@@A@@@@@@@AA@A@CA@AA@@@A@@@@@ADAB@@AB@B@@@B@@@B@B@@@@@B@@AB@@@B@B@@@@CA@@AAAA@@AAAA@@A@AAAA@A@@@AAA@AAA@@BA@@@AAABA@A@@@AD@D@BBB@BA@A@@BB@@BB@@@@B@@@BA@@@@@AAA@ABA@@@@@@@AB@@A@@@A@@@@BA@@@A@@BAB@BBBA@@BBD@@ABAAABA@A@@AA@@@AB@@@@@@A@A@@A@@@@@@@A@@@@@AAA@@BA@A@BAA@@@AA@@A@@@@@@@A@A@@@AB@@A@@@A@AA@@@@@@A@@@@A@@@@AAA@@@CBA@@@@@AA@@@@@B@@A@@@@@A@CA@AAA@@AAAB@@@A@A@@@A@@@@C@AB@@@@A@A@A@@@@@AB@B@@AB@@AAA@@@AA@@A@@@BB@BA@@@AB@B@@@BAAA@@@A@@@@@AB@AABA@A@C@@@@AA@@AA@AC@@A@AB@@CBABAAA@AA@AA@@@A@CA@@@BC@AA@B@A@@@@@A@@@@A@C@C@A@A@A@@CA@@AABAB@@A@AB@@A@@@C@@@C@ABABA@@@AB@@AB@@AB@BADA@@BCD@@A@AA@@@@BA@@@@A@@@AAAAAAA@@@@@@BABC@A@@B@@A@@@A@@BA@@@AA@@@@A@@@A@@BA@@@AA@@AB@A@@@@A@A@A@@@@@A@@@A@@@A@A@A@@BAB@@@AA@AAA@@A@@@@A@AA@@ABA@@AA@B@@A@A@@@@A@B@@@@A@@@AA@@AA@B@@A@@@@@@B@@A@@AA@@@@A@@@ACB@AAA@@@@@A@@B@@AAA@@@A@A@A@@@A@@@A@@AA@C@@@A@@B@@@BA@@B@@@@A@@A@A@@A@@@ABAA@@@B@@@@@@A@@@@@AB@@@B@@C@A@C@A@A@@@@@A@A@@@A@@A@@AB@@AB@@@@@@A@AAABA@@@@B@@@BB@A@@BA@@B@@@B@@@BB@@@@@@B@@@@@B@@AB@@BB@@@@ABB@@B@@@B@BBB@BA@@B@@AB@@A@@@A@@@@A@A@AA@@@@@A@@@@@@@@@@BBB@@A@C@@BA@AB@@@B@@@@A@A@A@@BBB@@A@@B@B@@@@@B@B@@@B@@A@@B@@A@@@A@A@@B@@C@@@AA@BA@@@A@@@AA@@@AA@@BA@@B@BA@A@@B@BA@@@A@@A@@@A@@AB@@@B@B@@@@A@@@@@@AA@@@@A@@BA@@@A@@@@AA@@@A@@@AAA@A@@@@AA@@AA@AA@@@ABA@@@ABCCAA@C@A@A@@@A@@A@@@@@AA@@@@@@AA@@@@AA@A@@AA@@@A@@@@@A@@@@@@A@@@@@@@A@@@A@A@@@@@@@A@AA@@A@@@@BA@A@@@@@ABABA@@@A@@B@@A@@@@@@BA@@@AD@@AAA@@B@@B@A@@@A@@@@@@@A@A@@@@@A@@@@A@@A@@@@@@@A@@@AA@@@@@@@BA@@BCB@@AA@@@@A@A@A@@@A@@ABAA@@@A@@@@AAA@@AA@@A@@@@@@A@@A@@A@@@@@@@A@@A@@@A@@@@@@@@A@@AB@@@A@@@@@@A@C@@A@@@A@@@@AAAA@@@@A@@@A@@@@BA@@BAB@@@@@BA@@A@@A@@@@B@@@@AA@@@@AB@@A@A@@@BAAA@@AA@@A@@AA@A@A@@@CA@@@@A@@@@@AA@@AB@@A@@@A@AAA@@@@AA@@@A@@@@@@BA@@@A@A@AA@@@B@@A@@@AB@@@A@@A@@BA@@@A@@@@AA@@@A@@BA@C@@@@@A@A@@@A@@B@@A@@AA@A@A@@@A@@B@B@@@@AD@@B@@@@@@B@@B@@@@BB@@@AB@@A@@@A@@@AB@B@@A@@@A@@@@@A@@@@@@@A@ABA@A@@@@BA@@@A@@@@BA@@A@@ABAB@@@@@BAA@@A@@B@@@@@B@B@@BB@B@@@@@@@B@BA@@@A@EB@@@BCD@B@@@BA@@A@@A@@@@B@@A@@@A@@@@@@B@@@@BB@@@@@B@@A@@@A@@@A@@@AB@@AB@@BB@@@B@B@@@BF@@@@@D@@B@@ABB@@@B@@B@BB@@@AB@@BBBBBB@@BAB@@B@@@B@@B@AB@BB@@B@@B@B@@A@@AA@@B@@@BB@BB@@BB@@@@@@B@B@@AB@@@BBBBB@@@B@BA@@@BB@@AB@@AB@@@DA@ADABAB@@@B@@AB@@@B@BA@AA@@A@@@@AA@AA@@A@A@A@@B@BA@@@@BA@A@@@A@@B@@@@A@A@@@AA@@@@ADA@@B@B@B@B@B@B@@DD@@B@@@@@@AB@BA@@BBB@@BA@BBAB@@AB@@@B@@@@B@@B@@@BA@@BA@@@A@@@AB@@@B@@@@AB@@@AA@@@@@@BA@AA@@@@A@@@@A@@AA@@BA@@@A@A@@@@@A@A@@B@BA@@@A@AAA@@AA@@@B@B@@CA@@@@ABA@@B@B@@@@BB@B@@@B@@@@AB@@B@@B@B@@A@A@A@@@C@@AA@AB@@@B@@A@@@@B@@AA@@@@@B@BB@BD@B@@AB@@@@ABA@A@@@A@@B@@@@@@B@BB@@@@AB@BA@@@AA@@A@@BBD@@A@A@@@@D@BCD@@@D@@@B@D@@B@@B@@@D@@@B@BB@@@B@@@@B@B@@ADBB@@BD@B@@@@CBBDA@@BA@@B@@AB@@@B@@@@@@A@ABA@A@AA@BA@AAAB@@AA@A@@C@@@@B@@@B@@EB@BAB@@A@@AC@@@@BB@@BBB@@@@A@@@@D@BAB@@B@BBB@BB@@@@@B@B@BAB@@A@@@@B@BBA@@B@BAB@@@@@@BA@@BABAD@B@B@@@@@B@@BBBBA@@BAB@@@@BB@@@B@@B@@B@@BABA@@BAA@@AB@@@D@B@@@BBAB@@@B@@BBB@@@B@@@B@B@@@B@@@@B@@@@ABAB@@@AA@@B@@@@ADB@@@B@B@@AB@DBB@@@@@@@@B@@ABA@A@@B@@@BBB@@@B@@CB@@@B@@BB@@@@@B@@@BB@B@@B@BBBFD@B@@@BA@@@A@@B@B@BB@B@@B@@AB@B@D@BABBB@B@BB@BAB@@B@D@B@D@B@@BBD@BBFA@@@@@B@D@BB@@@DB@B@B@DBDBD@D@B@BBDBBD@B@BH@DB@D@AA@CB@@@BB@@B@@B@B@@@B@@ABB@@@BBA@@@@BBBAB@@AA@@@@@BAB@@DB@BA@@@BB@@A@@DB@@@@@B@BBA@@@C@@@@BB@B@@B@@@@@@AB@@AA@@A@ABB@BB@@A@@@@@@B@@@@@@@@@@A@@B@@A@@@@B@@@@A@@@@A@BBAA@@A@@A@@BAB@@@@BB@@A@@@A@@@@B@@@@AB@AA@@B@@AA@B@@@@@@ABA@@@@BA@@@A@@@A@@@@@@BA@@@AB@B@@@B@@@@CB@@A@@@@B@@AB@BA@A@@B@BB@BD@BA@@B@@@BAB@@@@ABBB@@@BAA@@A@@@@BBB@@@@@@@BA@@B@@@@@@BB@@@@A@@@@B@B@@@D@@BB@B@@AB@@@BBB@BB@BD@B@D@@@BBB@@@BBBB@@B@@@@AB@@ABAB@@@B@@CBB@@BA@B@B@B@BBA@@@@@@BA@@B@@A@AB@B@@@BB@@@ABC@@@A@A@@@AB@BA@A@@@AB@@@DCB@BB@@B@BB@BB@BB@@BBB@@@BBBA@@B@@@B@@@@@B@@AB@@@@@BA@@@@@@@@B@B@@A@A@@BA@@BB@@B@@DFB@BAB@B@@B@@@B@@@B@B@@@@B@@BA@BB@@@BBBABB@BB@B@@@B@@@@ABB@@B@B@@@@ABAAA@CBEJABGBAAA@@@AD@BAB@@@B@@BBB@@ABB@@@@@B@@@B@@@B@@@BA@@B@@@B@@@B@@@B@@AB@B@@@@@BA@@@@@@BB@@@@@@B@@@@@@@B@@@@@B@@A@@@@@@BB@@@@BAB@@BB@B@@@B@@@B@@@B@B@@B@AB@DB@ABD@B@@BB@@@BA@ABAB@B@F@DAB@@F@BDCB@BABAB@@B@@B@@@@@@B@B@@@BB@@@@@@B@@@@ABA@@@@B@@@@A@@BB@@@A@@BBB@@@@B@@@@@@B@@@@BA@B@@B@@@@A@B@@@B@@@@@@B@@B@@AB@@@@@B@@AAA@@@@B@@@@A@@@@BA@@B@@@@@B@B@@@B@@@@@B@@A@A@BD@@AB@B@@B@@B@@@@@@@B@@@@@@@BA@A@ABA@@B@@@B@BB@AB@BAB@BB@@B@@BB@B@B@B@B@B@BAB@@@B@BB@@B@B@@AB@B@@@B@BBBB@@@@B@B@@BB@BBB@B@@@B@B@@BB@B@BBB@@@B@B@@@BABA@A@ABA@@B@D@@@BBB@@BB@@B@@@@@@FB@D@@@DB@@@@@@BAB@DAB@B@@A@@@A@@@@@@@@B@@@@ABB@A@@@B@@@@@@B@@@@@BAA@@A@@AC@A@@BA@@B@B@@@@AAA@A@@AA@@AA@@BA@@B@@@B@@@BAB@@@B@B@@@B@@A@@@@BA@@BB@@B@B@@BB@B@@@@@B@@@BB@AD@B@@@@ABBB@B@@AD@@@@B@@@BBBB@@BAB@@BBB@BA@BB@@B@B@BBABB@@BA@@BBBB@@@B@@@BA@@BBB@@BB@@@@@@@B@BDD@@D@B@B@@AAAB@B@BB@@@@B@@B@B@@B@B@@@BB@A@ABA@@@ABA@A@A@AB@@AA@@C@@A@@A@@@AB@@@BABB@@B@@AB@@A@A@@AA@AB@@@B@@ABA@AAAA@@A@@A@@AA@@@AABA@@BA@@@@@@@@@CB@@A@@@ABA@@@@B@@@@@B@B@@B@@@B@@B@BB@A@@@@AA@A@A@@@AB@@@@@B@BB@@@AB@B@@BB@B@@@@@BABA@ABAA@BAAA@@@A@@A@A@@@AABC@@B@D@@@@A@A@@A@@AA@A@@@@@@A@A@A@@CBAAA@@@A@@@@ABC@A@@B@@@B@@@@@BA@@@A@A@@@AAA@@BABA@@@A@ABA@@@A@ABA@A@@@ADA@@B@B@@@B@@BB@@@BA@ABA@@@A@A@@B@@@BB@@B@@BB@@CB@@@B@@@@B@@@BBB@BBA@@B@@@@@@@DE@ADBAB@B@B@B@@B@@@D@@@B@@@@ABA@@@A@@B@@@B@@B@BDA@A@@@@@A@@B@@AB@@@BBBA@@B@BBBA@D@BA@BBB@@@@@B@@BB@@B@@BBAD@@B@@BB@@AB@B@B@@@DA@BB@B@BA@@@@@B@BB@@@B@BA@A@AB@BADDDBDBB@@AAA@@BABBDBB@@BAD@@A@@@@@@@A@@@@B@@A@AB@@BBA@ABAB@@BB@@@@AB@@@BB@B@B@@BB@@@B@@@@B@@@@@BB@@@BB@@BB@B@@@@@B@@@@BBB@@BBBB@@B@@@B@BA@@@BB@@@B@B@@@@@B@@@B@B@@BD@@BA@@B@@@BA@@@@B@@@@@BB@@@@BB@@B@@AB@@A@@@@@@@@@A@AAA@@BA@A@A@@BAD@@@BB@@A@A@@BA@@@B@@@DB@@@@@B@@@@@BB@BBB@B@@@@B@@@B@@@B@BABB@@B@@BA@@B@B@@@B@@@@A@@B@@@@@@D@@B@@@@@B@BAB@@@@@@A@@@A@A@A@@BA@AA@BA@@AA@A@@B@@@@A@@B@B@@@B@BA@@@@@ABA@@@A@@@AB@@@B@@A@@@@BA@@@@B@@@@@B@BCBC@AB@@AB@BA@@HCD@B@BA@@@@BAB@F@@BDA@@@@@@D@@@BBHB@BCDFBBABD@BAD@DABA@@BBB@A@@@@F@B@B@@@@A@@@AB@@B@@@@@A@@B@BB@B@@@@@@B@BAA@@@BA@@B@@@B@@@B@@@BBB@@@@@@A@@@@@@B@@A@@@@@@@A@@BB@@B@@@@@@B@@B@B@@@@@@B@@@@@BB@@@@@BAB@@@@@D@@@B@@B@@@@B@@@BB@@B@@A@@B@@@@A@@@@B@B@@@B@@@@@BAB@B@@@@@AB@@B@B@@B@@@BA@@@@B@ADA@@B@@AB@@B@@B@@@@A@@@@A@BAB@@@@B@@@@@AB@B@DB@@@AB@@B@@@BA@@@@@BBB@DB@@@B@BB@@B@@@@@B@@B@@@@@@@AB@BA@@@A@@@ABB@@@A@@@@B@@@@@@@BAB@B@@@B@B@B@@@@@@AB@B@@B@B@@@B@@@@@@B@@ABABA@@@@B@@@BA@@@AB@B@@@@@@BB@@@B@@@@@BA@@@A@A@@B@@B@@A@@DB@B@B@@@BA@@B@@B@@AB@@@BBB@AB@@@@@@@B@@B@BA@@@B@BA@@@@@AB@B@@@@@B@@A@A@@@@@@B@B@B@B@@@BAB@B@B@@@BB@@BB@@BBDA@AB@@AB@DB@BBB@@@B@@@B@BBBB@@@BB@B@@@BA@@B@BBB@@@@A@@BC@@D@@@@@BAB@B@B@BA@@@AB@BB@@BB@@BB@@B@@@BB@@@@B@@@@BB@@@DB@A@@B@B@@@BBD@@@B@@@B@BBB@@@B@@@@B@@AB@@BB@B@@BBDB@@BB@@B@@BAB@@@@B@B@@@B@@BB@@B@@@@@B@@@BB@@BBD@@@@DD@B@@@@@B@D@@@B@@BA@@@D@BA@@B@@B@@BB@@B@@@BBBBA@@BBB@D@@@BBB@@@BB@B@@B@@@BB@@@B@@@BB@@B@@@BB@@@BBB@@@@B@@B@@B@B@BA@@@ABABC@A@AB@@@@ABA@AB@B@@ABA@@B@B@BABA@AB@B@DB@AB@B@BB@BB@@@BB@B@B@BB@BB@BDA@A@@@@BA@BB@@@BB@@AB@B@BA@@@BB@@@@@@B@@@@B@@@@@BAB@@B@@BDB@DBB@@BB@@BB@@BBA@@B@@@B@@B@@@B@@B@@A@@B@@@B@@@@@B@@@@B@@@@@B@B@BBBBBB@BBB@B@BB@@B@@@BB@@BA@@B@@@B@BB@AB@@@B@@AB@@AB@@@@@BA@AD@@@B@B@BAB@@@B@B@DA@A@@@@D@BBB@B@BAB@@@@@@B@B@B@@@B@D@@@@AB@@B@@@@B@@@@@B@@BB@@BB@@@B@@@B@@AB@@BBDB@@@BBBB@@BBB@B@@@@@B@@AB@@@B@B@@ABABC@@BA@@@A@@B@BB@@BBB@B@@@BB@@@BBA@@@BD@BBB@B@B@@B@B@BBB@B@@@D@B@BBB@B@@@B@@@@B@@B@@BB@@BB@BBB@DAD@B@B@@BDA@@@@B@B@BBB@@B@BB@@B@BB@@BB@@@@B@@@BB@@BB@@@B@@@B@@@@@BBB@BBB@@@B@@@BB@@B@@@@@B@@B@@@@B@@@@@@@@@BB@@@@B@@B@B@B@BBBB@@B@@BBBBB@B@BB@@@BB@@A@@@ADA@@@AB@@EBA@AA@@AAAAA@@@AA@AAAA@A@@BABA@@B@@@@@@CBA@@@A@@BA@@@@BAB@DA@@@A@@@A@@@@@@@@@@A@B@AA@AA@@@@@AACA@@C@@@BABABABA@A@@@A@@@@BA@@@A@@@@B@@@@@D@BBBB@@B@B@B@D@@@B@@@B@@A@@@@BBD@@@BB@BB@@@B@BAB@@A@@B@B@B@DAD@@ABB@@A@@BB@B@BBBBB@@@@BB@@BBA@BBAB@@@@AB@B@@@B@@@B@@@B@B@BAB@@@@A@@@A@@B@@@BA@@B@@@B@B@@@@ABA@@B@@@B@B@@A@@BAB@@@@@B@@@B@@@B@@@B@@@@AB@BBB@B@B@B@@@B@BB@@A@BB@@@@BB@BB@B@@@D@@@B@@A@ABA@@A@BA@@@A@AB@A@@@BA@A@@@AB@@@B@@@BA@@B@@@@@B@@@@AB@@A@@@C@AB@@AAAB@@@BA@@@@BA@A@@B@@AB@@AB@B@@AB@@A@@B@BAB@BA@@@@B@@AB@@@B@BA@@@A@@B@@A@@@@@A@@@A@A@@@@@@A@@AA@AA@@@@AAAA@AA@@A@A@AA@@ABAAB@@A@@B@@A@@@@B@@@@A@AB@C@AAC@@@@@C@@@@@A@@@AAAB@@@B@B@@@B@@@B@BA@A@@@A@@@AB@@A@ABAA@@@A@A@@@@@A@@@AB@@CACAA@A@A@@AABA@@@@A@@@A@@DA@@@@AA@@BA@@D@B@B@@@AAEA@@A@@AA@A@A@@@@A@A@@@A@@@@CA@@A@@A@@A@@@@@A@@@@@BA@@@@@AB@B@BBAB@@B@@A@A@@@A@@BB@@@B@@@@@A@A@A@@@@@@@@B@@@@@@ABABA@A@A@@@@@@A@@B@@@AA@@BB@@@@BA@@@A@@@@@@AAAB@@@A@@@@@@@@BB@@B@@@B@@@@A@@@@@A@@@@@B@@B@@@@@@BAB@@@@@@@@@@BA@B@@@@@ABA@@BA@@A@@@@@A@@@@@@A@@@@@@@@@@@B@@@@B@@A@BB@@@@@BAA@@@A@@A@@B@@@@@@@@AA@BB@@@A@@@@B@@@@@@B@@@@@@B@@AA@@A@@BA@@@AA@@@A@@@@@@@B@@@@@BB@@@B@@@@@@BA@@@A@AA@@@AAAAA@AA@AAAA@AAA@@@A@A@@A@A@AA@AA@@@@ABA@@@@A@@AB@@AB@@ABA@A@@@ABA@ABBBBB@@@B@BA@@B@@@BA@@BAB@@@B@B@@@B@BA@@@@B@@A@@B@B@@@@C@@BA@@B@B@B@BABA@@@AB@@A@@@ADBBAB@@@B@@AB@@ABA@@@A@CBABABA@@BCB@B@@@B@BAB@B@BA@@@@BA@A@A@@@AAA@@@CAA@@@@@AB@@A@A@@@A@AB@@ABABA@@@A@@@AA@BABA@@B@@@@ABA@AB@@@B@@@D@@@B@@ABB@@BABAAA@@BA@C@@@AB@@@BAB@DA@@@@BAB@B@@@@A@AB@@@@A@@@AB@@A@@@A@AB@@@A@@AC@@@AAAA@@@ABC@@FC@@@@B@@A@@@@@A@@@@B@@A@AB@DA@@B@BABAB@D@B@@@@@B@B@@@FAB@@@@AD@@@@@B@BA@@H@D@DBD@@@BCB@AABA@@@@AABA@AAA@AAA@A@A@A@@@@B@B@@@B@@@D@B@@@@@BA@@BEBA@@@@@B@BB@@@B@@@B@@@BBB@@B@@@@B@DABBB@BB@@B@B@@@BAB@@@B@@ABA@@B@@A@A@@B@B@@@B@B@@ABABAB@@AB@BB@@B@@@B@@@@AB@B@BA@@B@@@B@@@BA@@@@BBB@B@@AB@@@@A@@D@@@D@@@@@@@BADCDAB@DAB@HCBAB@BAB@B@BBBA@ABAB@B@@A@@@AAA@@BA@A@A@A@@AA@A@@@A@A@@B@B@B@@A@A@@AAAAA@A@AA@@A@AA@AA@A@@B@@A@@@A@@BC@@@AA@@@@AAA@@AA@@@@A@A@@AAA@A@A@A@@@@AABAA@@@@@@@@AA@@@@A@A@@AA@AB@@A@@B@AA@CC@A@A@@@@B@B@@A@@@AAA@AB@@@BAB@BAB@B@B@@AA@@AA@@A@@A@@@@AAC@CAA@@@AB@B@B@BAB@B@@@BA@@BABCB@@@BA@@B@@@B@@@B@@@@@@BB@@@BB@@@AB@@@@@@ABA@@@A@@@ABA@@@@@A@@@A@@B@@@@@B@@@BAA@@@@@@@@A@@B@@@B@@AA@BA@@@@@AB@@@@B@@@AB@@@@@@A@@@@@@AB@@@@@@@@@A@@@@@@@@A@@@@@BCBA@@BA@@A@BA@@ACB@BA@@BB@@B@B@FBNAF@D@JAB@JDD@HDDBB@BAB@BB@@BAB@@ABAFC@AB@B@B@@A@ABC@AB@DCB@AAA@A@@A@A@@AA@@CAAAA@ABA@@@@DA@@BCBA@A@ABC@AAA@AA@@@A@@@@AA@ABA@ABA@AB@@A@AA@AAAA@@@AAAAA@@@A@@@ABAAA@@@A@AAA@AA@A@AA@@AA@A@@BABA@CB@BA@C@@@AAA@@@A@@@A@@@AA@@@AB@@A@@AAABA@AA@@A@A@@@AB@@@B@@@B@@@B@B@D@B@BB@BABB@B@BAB@@@B@B@@BBAB@B@@@B@@@@A@A@@B@@@B@B@B@@@BBBBB@B@D@@@B@@@B@@B@@@BB@B@BBBABBB@@AB@B@B@B@@@BAB@@A@@@ABB@@B@B@B@D@DDDCD@BA@@@AAC@@@A@@@ABABABABEB@D@B@BAB@DBBB@BBAB@@@B@@@@@BCBA@@@A@CA@@A@AB@@AFCBCBACC@@AACC@A@@@E@CB@@@BA@@@A@@@AB@BADABA@A@A@@BA@@BA@@@@BABA@CB@@@@AA@@C@@AA@AA@CAA@AA@@@AB@@@B@@AB@@@BA@@@A@A@@@A@@B@BA@ADB@@BBBB@BBBB@B@B@@@B@BA@@BA@@B@B@@AB@@ABA@AB@@ABC@@@@B@@@@@B@@A@@@@@AB@@@@A@@@@AA@C@A@A@@@@B@@@B@B@@@B@B@@A@@BCBA@AB@@AB@@@@A@@B@BA@@@ADC@A@A@@@C@@@A@@@@DA@@@AB@BAAAAA@@AAA@AA@@AAC@@@A@@AA@AA@@ABAAAB@@A@@BA@AAA@A@@@AB@@@@AD@@A@@BA@@BA@A@AB@@CA@@A@A@@@A@A@@@A@AB@@A@AB@@A@@@A@@BA@A@@@A@A@@A@A@@A@@AA@@@ACA@A@@A@AB@@A@A@@A@AA@@AA@A@@C@A@AA@AAA@@AA@@@A@A@C@@@A@A@@B@CE@@A@AB@@AA@@@@@AA@@BA@@@@BA@AB@AAA@@@BED@@A@@A@@@@@A@@@A@@A@B@@A@@@@A@AA@B@A@@BA@AA@@@A@@@@@AA@B@B@@@A@A@@@@AB@@@A@@@@@A@@@A@@ABA@@A@@AA@BA@@@@@@A@@@@A@@@@@@@@@A@@B@@A@A@A@A@AA@@A@C@A@A@@@AAAAA@AAAAA@C@AAA@CA@@AA@BAAA@@BCA@A@AAAAC@A@@AB@@CBA@ABA@AB@BA@ABA@A@@B@@@BA@A@AB@@A@AA@BAB@@A@A@@@@@AB@@@BA@AB@@A@BB@@A@A@@@@@A@@@@@AB@BA@@@AA@@@@@AAABAAAA@CAA@AACAAAA@@A@@A@A@@BA@@A@ABCA@A@AAA@@@@@@A@@@@BA@@@@@AA@@@A@@ACAAA@A@@ABAA@@CAA@@AAAEBABABA@A@@A@A@AA@@@@B@B@B@@A@A@@@AA@@C@ABACAC@@@A@@@@A@A@A@@@@AB@@@BAB@BABA@@C@A@@CAC@ABEBAAA@@@CA@A@AA@BA@@@@@A@@@@@@B@@@@@@AAAA@@@@@@AB@@@@AB@@@@AB@@@B@B@B@@@@A@@@@B@BA@@BB@@B@B@@B@@B@B@@B@@@@B@@@B@@@B@B@B@@BD@BB@@BA@A@@BA@@@@AA@@@@@AAA@@@@@A@@B@@A@@@A@@@A@@@@@A@@@@ACB@B@DCA@@A@@@C@@@@AA@@AA@@@A@@@A@A@AC@@ABAB@@AD@@@@AB@@AB@@@@@AA@@AA@@BA@A@AA@@@A@A@@AA@AAA@@AA@@A@@A@AC@ABA@@@@DABA@@BA@@B@@AB@@A@A@@@A@A@@@@@@AA@AB@@A@@BBB@B@@@BA@AB@B@@A@A@A@@BA@@@@@A@@@A@@@@@EA@@@B@@AAA@@A@AA@AA@@@A@@A@@@@@@DA@@@AABA@@@@AA@A@A@@@AA@@@@AA@A@BAA@AA@@@@A@@@A@@@A@AA@@A@@@A@@@A@BB@@A@@BA@@@@@@@A@@@@@@B@BA@AC@@@@@AA@@@@AA@@@A@@BA@@BA@@B@@A@@@A@@A@A@A@A@@AA@@A@@@AB@@CB@@@AAC@@@@@@BA@@@AC@@@A@@@@@@@@@@@@A@@@@@@@AA@@A@@A@A@AA@B@B@BAA@@@@AABC@@@@AA@@@@@C@@AA@@@AA@@@AB@@AAAA@A@A@@@@@AA@@@ABA@A@@@A@@AAB@@A@@@@AA@@A@@@A@@AA@A@@B@@C@@@A@@AAA@@@AA@A@@@@@A@A@@@A@A@AA@A@A@A@AB@@@CA@@A@@AA@A@@CA@@@@@AA@@@@@A@CAA@A@@A@@@C@A@@@A@@@@@A@@@@@A@@@A@@@@CAA@@@ACA@BAB@@AAAA@CA@@ABAAAB@AA@A@@B@AA@AA@@@@@AA@@@A@@@BA@@AABAAA@A@@@ABAA@AAA@@@@C@A@@@ABCBABAB@A@@A@C@A@A@@AA@@B@B@BA@@@A@AA@@AA@AA@@@@BA@@@A@@@@B@@@@@BAB@@@D@B@@@BA@@@@@@@@B@@A@@@AB@@@AA@@B@B@B@@@@@@A@@@@@ABADAAAB@B@@ABBB@BA@@@@BAB@BBBA@A@@@@BA@@@@BABA@@@A@@@AB@B@@A@@B@B@@CA@AA@A@@@A@@AA@A@@@A@AA@@A@A@@@A@@@AAB@@AA@@A@@@A@A@@@A@A@@@@A@@@AA@@@A@@@A@@@A@@@AA@@A@@@ABAB@@A@@@ABA@@@A@CB@A@@AA@@@A@@A@@@ACA@@@AAA@A@@A@@AA@@AACE@@A@@AA@A@@@@AA@AAAA@@AAA@A@@@AAA@@@@@@A@@CC@AA@A@@A@@A@AAAAA@AAAA@@"],encodeOffsets:[[111888,23025]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("钦州",{type:"FeatureCollection",features:[{type:"Feature",id:"450702",properties:{name:"钦南区",cp:[108.657209,21.938859],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@AA@@@@@@@B@@@@B@"],["@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@B@@@@@"],["@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@B@@@@@@@BB@@@@@@@@@@@@@A@@@@@@"],["@@@@@@@@@@@@@@@B@@B@@@@@B@@@@B@@BB@@B@@@@A@@AA@@@@@@@@A@@@@A@@A@@@A@"],["@@@@A@@@@AAA@A@@@@@@@@@@A@@@@@@BB@@@@B@@@@@@@B@@B@@@@@@BB@@@@B@@@@@B@@@@@@@@@A@@@A@@@@@A"],["@@UAQEQOE@@D@B@@AD@B@DBBBFBBBDAB@@BBAB@@@BB@@@@@@@BA@@@@@@@BAB@@@BADABA@ABCDABEB@BFJ@@@BB@@B@@A@@@@@@@AB@@M@@LCB@@AB@BCBABA@ABCBCBCBA@@BA@A@CB@@@BA@@@A@@B@@AA@@@BA@CB@D@DKEEAEO@@A@AAB@@@@@@A@@@@@@@@A@@@AAA@@@CAA@AAA@A@A@ABA@A@@@A@@@@@A@ADCDCDCD@@@B@@A@CD@@@@AH@B@@@B@BBHBB@NALAF@DBF@@BFBB@@D@@@BB@@BBBBB@HDB@B@@FBB@B@B@FAB@BBF@B@B@@AB@BA@ABADAF@B@B@B@FADAB@BABBFA@BH@BAFEFEFA@ABCDMLEFCDABODABABAFABABABEHCDABCBCBABAFADAFAD@BABCD@BBBDDDFHJ@@AF@DBBBB@B@B@D@BA@@BADAB@BAB@BCB@@AB@BA@A@@B@BABABABABA@@@@B@@BB@@@B@@A@ABA@@B@@@BB@AB@@AB@@ABA@@BA@@@A@@@@@@@A@AA@AA@@AA@@AC@@@A@@@@@AB@@A@B@@DBB@@A@@B@@A@@@@@A@@@@BA@@@@@BB@B@@A@AB@@A@@BAB@B@@A@AAA@@@@AA@@AA@BAA@@@AAA@A@@@@@A@@@@@A@@@A@@@@AA@@A@@@@@@AAB@A@@@AA@@@@A@@@C@D@A@@@@D@@@B@@@B@@@B@@@@@@BB@@@@@BB@@@AB@@@B@@@D@@@B@@@@@@BB@@@@B@BB@B@B@@ABAB@@@BA@@@@@AB@@AB@@AB@@A@ABABC@CFABBBBBDD@BBBABAB@BBDAB@@ABA@AB@@@@A@@B@BB@@@ADB@@BAB@B@@@BABDB@BDDBDA@@@@B@@@B@@@@@@B@@@B@B@@@@@B@@B@@BB@@AB@@AB@@@BABA@@@@B@@DD@@B@BB@A@@@@@A@A@A@@@@B@B@@@B@B@BB@@DBD@@@BB@@B@B@@ABB@@@B@@AB@@AB@@@@DB@B@@BB@@@@DD@@@@B@B@B@BB@@@@BB@@@B@@D@@BB@BBB@BBB@@B@@@@B@@BA@@B@@@B@@AD@@@B@B@BDB@@B@@@@@@BB@@BB@@BB@BB@@BB@@BABB@@B@B@BBB@@BBB@@@B@D@B@B@B@@@@BB@@A@@D@B@BA@ADA@C@A@A@@@@B@@AD@@@@ABA@@@@B@@CBA@A@A@@@AB@@@@@BA@A@AB@@@@@BA@@B@@@B@@@BBBAB@B@@BBB@@@@@BB@B@D@B@@@@@DCBBB@B@@BD@@A@@B@BBB@B@@@@BB@B@B@@@@BB@B@@@@B@@@@@B@@BB@BA@B@@B@B@DAB@BBBBB@BBB@@BB@@@@@D@@@BB@@@@B@@@BA@@B@@@@@B@@@B@DABAB@@AB@@@B@DA@@@@BB@@@@B@DA@@@@B@@@BBB@B@@@@@BB@@B@B@BB@B@@B@@@B@@B@@@D@@B@@B@@BB@ADB@ABBB@@@B@@@B@@@B@BA@@B@@BB@@@BB@B@@BBDA@B@BBB@B@BBB@@@@BBB@@@BBB@BBB@B@BDBB@@B@BBB@@@BBBBB@B@DA@@B@@@@ABADA@@BB@@@B@@@@B@@BB@B@@@@BB@@@@@B@@B@A@@BB@@@@@@@B@@@@AB@@@@@B@@B@@@@@B@@@@AB@@BB@@@@B@@@@@B@@@B@@A@@@@BB@B@DD@@@@@A@A@@@@@@AA@AA@@A@A@A@ABA@@@C@@@A@ABAB@@AB@B@@@B@BADADABABAD@BADABAB@@AA@@CA@B@@AB@@@FA@@B@@B@@B@@@@B@@@@B@@@@@@B@@A@B@@@B@@@@B@@@@B@@@@@@@@@@B@@@B@@A@@@@BB@@@@@B@@@B@@@@A@@@AAA@@@A@A@@@A@@@@@A@@@A@@AA@A@@@AACBAB@DGBA@@BA@@AA@@AAA@A@A@AA@@@@@C@@BAJG@A@A@@@@@AA@AAAAA@E@AA@@A@@A@A@@@ABA@@@@B@B@B@B@BA@@BA@@BA@@@BB@B@@@BB@@B@@B@@@BB@B@@@@@@@AB@BA@@BB@@BB@@@@@BBB@@@BB@@@@@B@@@@@@@B@@@@BB@@@B@BB@BB@@BBBB@@@BB@@B@@@@DD@@BB@@@@@@BB@@@@BBB@@B@@@@B@@BB@@BB@@@@@@BB@BBBB@@BB@@@BB@BB@@B@@B@@@@@@@BB@@@@@@@B@@BBB@@B@@BB@BB@BBBB@@B@@B@@@B@@@@B@@@@@@B@@A@C@@B@@AA@@A@@BA@A@@@@B@@A@A@@@A@@@A@@B@@@@ABAAAB@@@BAAA@@BA@@@@@A@A@@@AAA@@@A@@@@@AAA@@B@@A@A@@@AA@@AA@@@CAA@A@@@AB@@A@AB@BAB@@@BA@BB@@A@@@@@A@@A@@@A@@@AAA@@BA@@@@@A@A@A@AAA@@AA@@@@BA@@@A@@A@@A@@A@@@AA@@@@BA@A@@@@B@B@@A@@A@@AB@@@@@BA@@@@BAB@@@BBBA@@@@B@@@B@@@A@@@BA@@@A@@@@BAB@@@@@@A@@@@B@@@@A@@@AA@@@BAB@@@BB@A@@@@@@BA@@@@@A@@A@BA@@@@@A@@@@BABABAB@@A@@@@@AB@B@B@@AB@@@@@@@B@@@@@B@@B@@B@@AB@@@@@BB@B@@B@@AB@@A@@B@AA@A@@B@@A@@@@@@B@B@@A@@BC@@BC@@B@@A@@@A@ABA@@@@@A@@@A@@@A@@AC@AA@E@G@IA@@@@AAA@C@AAA@C@GCA@OCAI@A@@B@@@DB@A@@@@B@@@@A@C@@@CA@@@BA@@@@BA@@B@@@B@@A@@AA@A@@@@@AD@@@@AB@@@@@@A@@@A@@@@B@@@DAB@HA@@F@@@D@B@DA@@D@@@F@DAB@F@FC@@B@FABALCLAHBB@@@B@@@@B@B@J@B@@@D@FX@@@@@@@@B@@@B@@@@@@@B@@GFABCD@@@@@@@@@BCBBBDFBDCDCBEFABCBA@A@BB@@@D@B@@A@A@@BA@@B@@@BBBBB@B@@@@AB@@@B@@@BDBBBDDDBBDDBBBBBDB@B@@A@@@AAA@A@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@B@@B@@@@AB@@@B@@@@@@BA@@@@B@@A@@@B@@B@@@B@@A@@@@BA@@@@@AA@@@B@@@@@B@A@@@B@@@@@@B@@@@@@@@@@B@@A@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@B@@@B@@@@@@@BB@@A@@@B@@ABBB@BB@@B@@@A@@BA@@@AB@@@@B@@@@@@@@@@B@@BA@@A@BAB@BA@@B@@@@ABB@@B@B@@B@@@@BA@@B@@@@B@B@B@BAB@@@@B@@A@@B@@@@B@@@AB@B@@A@@BB@@@B@@@@@@@B@@@BA@@@B@@BB@B@@B@@@B@B@BC@@@@@@@B@BB@@B@BA@@@@@@B@@B@@@BBBB@B@@@@@@B@@@@@BD@@@@@B@@@@@B@@AB@@@@@BA@A@@@@@@@@@@@BB@@@@ABA@@@@BB@@BA@@B@@@@BB@@BB@@@@B@@@B@@BA@AB@@AB@@@@@B@@@B@BBD@@BBB@@@B@@@B@@B@@B@@B@B@@@@BBB@@@@B@@@@B@B@B@@A@@@@B@@@B@@BB@B@@@B@@@@@BB@@B@@@@AB@@B@@B@@@@@@@BB@@A@@BA@@B@B@@@B@@AB@@@@A@@@@@@@C@@@@B@@@B@@A@@@@@AA@B@@A@@B@@A@@@@@B@@B@@BB@@@B@@@B@@@B@@@@@@@B@@B@@@@@@@AB@@@@@@BB@@@@@@@@@B@@@@@@@@ABA@@@B@BB@@@@BA@BBAB@@@@@B@@@BB@A@@BA@@@@@B@@B@@A@@B@@@BB@@@@@@@@A@@@A@@@BB@BB@@@B@A@@B@@@B@@A@@B@BB@BB@@B@@ABAB@@@BAB@@@BAD@@@@BB@@BBB@@B@B@@@@@BAB@@@@@@@@@BA@AB@@B@@DCBB@@@AB@@@@@BB@B@DEH@@@@BB@B@@BBB@B@@@D@DA@@@@BA@A@CAC@C@@BAB@@@B@@@BBDDB@@BD@DBB@BBDFB@B@DBDBBBDB@@@AB@@@@@@@B@@@B@@@@@B@@A@@B@@@@AA@@@@@A@@A@@@AB@@A@@@@@A@@C@@@ABA@@@@@@AA@@@@@@A@@@A@@@@B@@@@@@@BA@@BAB@@A@@@@A@@A@@B@@@BA@@@AA@@@A@A@@@@@A@@A@@@@@BA@@@A@@@@@@@A@@BA@@@@@@@@A@@B@@A@@B@@AB@A@BAA@@@B@@A@@B@@AB@@AA@@AA@@@@AB@@@A@A@A@AAA@@@A@@@@@@A@@B@@A@@A@@@@AB@@@@A@A@@@A@@@@A@A@AAA@@@@A@A@@@AAA@@@A@@AB@AA@A@AA@@A@@@AA@@@@@@@AB@@@@A@@@A@@BA@@B@@@@A@@AA@@@@BAB@B@B@@AB@@BBB@@@BB@@B@@B@@@@@@@@B@@@@@B@@B@@B@@@@@D@@@@@@BB@@@@@B@@@@@BB@@@@B@@@@@BB@@@@BA@B@@@@B@@@@B@@@BBB@@BBB@@@B@@B@BBB@@BB@@B@@B@B@@@@BB@B@@B@B@@BB@@BBB@BB@B@@BBB@@BBBDB@@B@BBD@BB@@B@@ABC@@B@@@B@@@@@@BAB@@@@B@@@@@@@@A@@@AB@@B@@@@@B@@@@@@@B@@@@B@@@@B@@@@B@AC@@@@B@@@@B@@B@AB@B@@@@A@A@@@B@BB@@@@B@@@BB@@B@@@@@@AABAA@@@@@@@@A@@A@@BA@@BA@@@BB@A@@@@BB@@@@@@@@@@A@@@@B@@@@@@@@B@@@@@B@@@@BA@@@@@@@D@@A@@@BB@@@AB@@C@@@@@@@BB@@@@@B@@B@BBBDA@BB@B@B@@@@@@AA@A@A@@@AA@@A@@@A@@AA@@A@@B@@@@AB@@@@@BB@@@@@@@@@A@@B@@@@@@B@@@BA@@@B@@@@@B@@A@@@@B@@BB@@B@B@@B@@B@@@@@B@@@@DB@BBBB@@B@@@BB@@BBB@@@@B@BBBBBA@@B@@BB@@@B@B@@BB@@@BBD@B@@@@@B@@@B@@@B@DB@AB@B@@B@@@@B@@@BBB@B@@@B@@BBA@@@B@@B@B@@BB@B@@BB@@AB@@@B@@A@@AA@@@@@A@@BA@@DA@@@A@A@@@AB@@@B@@@B@B@@@BA@CBA@@@E@@@@@AAA@@@@@A@@@@A@@@@@AA@@@@@A@@@@@@B@@@B@@AB@B@@@B@@@B@B@@B@@B@B@@@B@@AB@B@@@D@@@@BBB@@B@@B@@@@BBD@@BB@@@B@@@D@@@B@@BB@@AB@@@@@B@@@B@B@@A@@B@BB@@@@BB@@B@D@@@@BB@@@@@@@@@B@BBB@BBB@@@B@B@B@BB@@@@B@@@B@@@@AB@B@@@B@@@B@BBB@@A@@@@BAB@@ABA@@A@@A@@@@@A@@@@@@@@@@B@@@@AB@@@@@B@@AB@BB@A@@BB@@@@@@BB@@@@B@@B@@B@B@@@@@BB@@BA@@BB@@@@@BB@@@@@D@@@@AB@B@@@B@@@@@B@@@@B@@DJBB@@B@@@BBBB@@B@@B@B@BB@BB@@@AB@BC@@@A@@@A@A@A@@A@@AB@@A@@@@@ABA@A@@@@B@@B@@@@BA@@@@@BB@@A@@B@BAB@@@F@@@@B@@BBBBB@@@@@@@B@@@B@@@BA@@@@@@@AA@@A@A@@@@BA@@@@@A@AA@@A@@@A@@@@@A@@B@B@B@@BB@@BB@BB@B@@@ABA@@D@@@@@@B@@B@@B@@@B@B@@B@@@@@B@@@@@@@BABA@@@@@@B@B@@@B@@@@B@DAB@B@@@@BB@@@@@@@B@BB@@@B@@@@@@BB@@@B@@A@@B@@@B@@B@@@@BBB@@@BB@@@@@B@@@B@@BB@@@@@BA@@B@@B@BD@@B@@@BAB@BAB@@@@BB@@@B@@@BB@@@@BAAAB@@A@@@@@A@@@@B@B@@@@@B@B@@@B@@AB@@A@ABA@@@A@@BA@A@A@A@@@A@A@@A@@@@@AA@@@A@@AA@@C@@@@@A@@@@@@@@AA@@AA@@AAA@@@B@BA@@@@@A@@C@@@@A@@A@@B@@AB@@@@A@@@AB@BA@@@CAAB@@@BA@@@@AAB@@@BA@@BC@A@A@@BABA@@B@B@BAB@@A@@BAB@@@BB@@B@B@B@@@@@@BB@@A@@B@BB@@@A@@@AB@BABAB@@@@@B@BAB@@@B@@@@@@B@@B@@@@@@@B@@@@@@@B@@ABA@AB@@BB@B@@@@AB@@@@@@@@BBB@@@@B@@@@@@@@BB@B@@@B@B@@@B@B@@BBA@@@@@BB@BB@@@@B@@@@BBB@@@A@@@AD@@@@B@@@@@BAB@B@@@B@B@B@@BB@AB@B@B@B@@@@AA@@@A@@@@A@@@A@@BA@@@AAA@@A@@@@AB@@@A@@AA@@@@@AB@AA@@@@@B@@A@@@@@A@@A@@@@@A@@@A@@B@@@@A@@@@A@@@A@@BAAABA@@AA@@@@@B@@A@@@@@@@@AA@@@BCB@@@@AA@DA@BBB@@AB@@@@@@@@@@B@B@@A@@BABA@@A@@AB@@@@C@@@A@AC@AA@@AA@@A@@@CBAB@A@@AACB@AA@B@AA@BAA@@BA@@@@@A@@@@DA@@B@@@@A@@@AAAAA@@@AAC@A@@@@A@@A@@@A@@@@DA@@@AB@AEBCA@@@CCAA@A@@A@@BAABA@@BA@@AA@@AA@@A@@AA@@@@@D@@@@A@@@@A@A@B@@A@@B@@CBA@@AA@@@@BA@BBB@@@@@@BAB@B@B@@B@@@ABA@@B@B@@@B@@@AA@@BABA@@@BA@@BB@@@B@B@B@@AB@@@B@BA@@@@@@@@@@A@@AB@@@B@BBBA@AB@@@B@@@@@@@AA@@B@@@@AB@AA@@B@@@A@AA@@@@@A@A@A@A@@@AB@@@@ABA@A@@@@B@@@@@B@BA@@@A@@@@BA@@BB@B@BD@@@@@@A@A@@BA@@@A@AB@@A@@@A@@@A@@@AB@@C@AB@@@@A@@B@B@@@B@@BAB@@@BAB@@@B@@@B@@B@@@BC@@@A@@BB@B@B@@@B@@@B@B@B@@@B@BB@@B@@@@@B@@@@@@@@@BB@@@@AB@@CBA@A@@BA@A@@@A@@@A@@BA@@@@@AAA@@@@@A@@@AA@D@@@B@D@@BAB@B@@@B@@B@@BA@@@A@@B@@B@B@@@BB@@@BB@B@BAB@@@@A@@D@@BBB@B@@BB@@B@@BF@AA@A@A@@@A@A@@@A@C@BB@B@B@B@@@@@@A@@@@@@@CB@@@AA@@@@@A@A@A@@@A@AB@@@B@@@BA@@@@B@@B@@B@@AB@B@@@@@@@@A@A@@A@@@@A@@A@@@@@@AA@@@A@@@AC@@@A@@@A@@@A@A@@B@@ABA@A@@DA@A@@@@AA@A@@BA@@@AB@@A@A@CBA@@B@@@@A@A@@@A@AB@@A@ABAB@@A@@AAB@BA@A@A@@BAB@B@@@B@@CBAB@@A@AD@B@BAB@@@@@@ADA@A@@B@BAB@@A@@B@@@@A@@B@@@BB@@B@B@@@@A@@@C@A@AB@@@@A@@BA@A@@@@BC@A@@@@@ABA@@@A@@@A@CACB@@AA@@@@AE@@A@AA@B@@AA@@A@AB@BB@@BABA@@@AB@AA@@BA@@@A@@BA@A@@@A@@@AD@BA@BBB@BADB@@@@@@@BB@@B@@@@@BA@AD@AD@@@BAB@@BB@DB@@@B@@B@BB@@CB@@AA@@A@@@AB@B@BA@@B@@A@A@@@A@@BA@@@@B@@BB@BBB@@AB@@AB@@A@@BA@@@@B@@@BABCB@B@@@@@@A@@BAAA@@@@B@@A@AB@@@BADA@@@A@A@@@ADA@A@@DAF@@@@@@@@A@@@ABABAB@@AB@@@BA@A@@@@AA@@@AB@@A@A@@AA@@@@BABA@A@A@@BABCBA@A@@AC@A@AAC@@@ABE@@BCDA@AB@@A@@@C@@@A@AAA@@BABAA@CA@@@@EA@@AAAAA@@@@AA@@@A@@@A@A@A@@@@@AABA@@@C@@@AA@A@A@A@@@@D@@A@A@@@@@@A@@@@@@C@A@@@CD@BADA@@@@BAB@@AFA@@B@@@@A@A@@@@B@B@B@B@@@@A@@@@@AAA@@@A@AB@@@@@B@@@@@@A@C@A@AB@@@@@B@@@@@B@@@@A@@@CB@@A@AA@@@@@@@@@B@@@DBB@BA@@@AB@B@@@D@@A@@@A@@@@@A@@B@@A@@@@A@@A@@@A@@@A@@@A@@@@@A@@@A@@@@@CG@@@ABA@A@@@@AA@@A@@@@A@@@@@A@@B@@@@@@A@@@@AAA@AC@@@@A@@@A@AA@@@@@@@AAA@@A@CA@@@A@@@AA@@@@A@ADEBCCAA@A@@B@@A@@@ABABA@C@C@BDA@AC@BAA@@@@@ABAAACCCAA@@@A@@A@@C@@@@@@@@A@@ACAAABADAAAAC@ABABC@CAE@E@CBC@A@C@ABGFCAAAB@@A@@A@@@@A@@@@@AEC@A@AAA@A@A@@@A@@@AA@@@AB@@@@AA@@BAACAA@BC@C@A@ABAFA@C@A@A@A@ABAAAB@BA@C@@A@@A@C@A@A@A@A@AA@AA@@A@AA@A@@B@BCCA@@A@@E@@AA@A@@DABACC@BAA@@@AAB@B@AAC@@@C@A@A@BA@@B@@EAACA@E@C@@E@AABA@ABCEAADABAFABAD@BD@BBBB@@B@@BBA@@B@@BDD@FABCAABC@@A@A@@@@@@BA@@BA@AA@AB@@A@@@A@A@@AA@A@AAAACCA@A@A@ABA@AA@A@A@@BA@A@A@@BABACA@AB@@@@AACA@FEBB@BB@BBBBBAB@BBDABA@@A@AA@ADA@AEC@@@A@@C@@A@@D@@AA@@A@@BA@@B@@@B@@EC@C@C@BAB@BA@@DA@@@@@A@AA@@EA@A@BAA@@A@@AA@BABAC@AC@BA@ABAA@@CABAA@DA@CAEAAA@@DA@A@CICAE@C@C@CCB@@@ABC@C@AA@A@ABEDA@@@AA@ACEG@EAMIC@CAC@EBEAC@CEEECAMGGCCCA@GFABGF@@@@B@@BA@CBA@CACBGAIA@@EC@@A@AA@A@CCCCEEKAAAIBE@CBA@AAAC@BAAGAGAACEAEBC@ABCAEACAEACHIBEBKBGB@@@@A@@BCDCLC@A@ALQDCHKDIDMBG@@OGG@EAE@C@ECAAACM@A@KGCACBMLCDIHGDEBUBMAGAECCAMEACAACGCCC@W@@@C@CAGC@I@@@A@@CAA@@AA@@@@A@A@@A@@@A@@@AB@B@@A@@BB@@B@B@@AD@BAB@BCBABAB@BABABED@B@@@@@B@@@B@B@B@@@BAB@@B@@@@BB@CF@@@B@@B@BB@B@BQTUJKFE@AAAA@E@IBEFMDGBIAGEOGIMMEEYGE@E@CBAD@D@DBBNJRHJFHJJJBD@D@DAFCHCFCHG^CHEFEDG@GACGAGBMAGCEG@IBIJ[bOVMLIFKBS@"]],encodeOffsets:[[[111308,22132]],[[111354,22116]],[[111356,22108]],[[111301,22127]],[[111321,22162]],[[111218,22202]]]}},{type:"Feature",id:"450703",properties:{name:"钦北区",cp:[108.44911,22.132761],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@I@A@A@@A@@@A@GAKBKDABEBA@@@EDE@A@CBE@@@C@@@CBA@C@@@E@@@GBA@CB@@A@@@@@@B@@@B@@@@A@@B@@C@@B@@@@@BBB@@@BA@@@A@@@AB@@@@AB@@B@@D@@@D@B@@A@@@@@@BCA@@A@@@@BBJPDB@HDD@B@BBD@B@BB@@@@JBH@F@B@@BBD@@@B@@@B@@@B@@@@AB@B@B@@@BA@@@AD@@AD@@@BA@A@@@@@@@@BA@@@@BBBA@@@@BA@@BA@@@@AAA@@@@A@@BA@@@@AA@@@@@A@@@@@@@A@@BA@A@A@@B@@@@@BA@ABABAB@@@@@B@@@@ABB@@@@B@@@@AB@@@@@@@BAA@@A@AB@@B@@B@@@B@@A@@@@@@B@@@@A@AB@@@@@B@@AB@@B@@@A@@@A@@@@@ABAA@@A@AB@@@@AB@@@@A@@BB@@@@BA@A@@@@@@BAB@@@@BB@@B@@@@BB@@@@B@@AB@@@@BB@@BB@B@B@B@B@@@@AB@@BB@B@@@B@@@BB@@@@@B@@@AAB@@A@@BA@ABAB@@@BA@@B@B@DB@@B@@BB@@B@@@B@BA@@@BB@B@@@@@B@@BB@B@@@B@B@@@@AB@@BBAB@@A@BBAB@B@@A@@@@B@@@B@@@B@BA@@@@@@BAB@@@BB@@BA@@@@D@BA@@@@@@@@A@@A@@@A@@@@AA@AA@AAAA@@AA@@@AA@AA@@@@@@@A@@A@@@@@@@AA@@@AAA@@A@@AA@@AAAAA@@A@@@@A@@AA@@AA@@@@@@AA@AA@@@@AA@@@@@@AA@@CC@@@@@AA@@A@@AAAA@@AAA@@A@A@@AA@@@@@A@@@@@@@A@@@@AA@@A@AA@@@@A@@AA@@AB@@ABA@@@@@@A@A@@A@@@AA@@@AA@@A@A@@A@@AB@@AB@@ABA@A@A@A@@@@@AB@B@@@B@BB@@@BBF@B@BBBBB@@B@@@@@B@BIHAB@@@D@@@@BBB@B@B@BB@@BB@@AB@@ABCHA@ABBD@B@@@BBB@@@B@@@B@@@@@B@@@B@B@@BB@B@@@B@@A@@@A@@@@@A@@A@@B@@@@A@@@A@@@@@@@@A@@@@@@A@@A@@@A@B@@@@A@@@@A@@@@@@A@@A@@@@AA@@@EB@@A@@BA@B@@DB@@BA@ABCBABC@ABABCBCBABA@@@A@A@@BA@AB@B@B@@@D@@AB@B@B@B@BB@@BBB@@@@@@@B@B@@@@CCA@A@@A@@B@@@@A@@@A@@@@@A@@A@@AA@@B@@A@@@@@A@@@@A@@@@BA@@@@@A@@@@@@AA@@@B@AA@@@@@A@@A@@A@A@@AA@@@@@@A@@AA@@CBAB@B@@A@@@CBA@A@AAAA@@A@AAA@@@AAACA@A@AAA@AA@@A@AA@@@@AA@A@AAA@A@ACBAA@@@AAA@@A@@AA@@@ABA@@@A@@@A@@@A@AA@BCA@BAA@@@AA@@@@C@@@AA@@@A@@@@AAAA@A@@@AA@@@@A@A@AA@@A@@@@@CBA@@@@@AA@@@@CBA@@@A@@BA@ABCBA@@@A@@@@@A@@@AB@@A@@@@@AA@@C@@@@@A@@AA@AAA@AAAAA@CBA@A@@@@AABA@@AA@@@@@A@@@@@@AAA@@@@@A@AAA@@@@@AAA@A@AB@@@AC@@@AAADA@C@@@@@A@C@AAA@@@@A@AA@@@ABAAA@A@@@A@@@AB@@A@@@@BAB@B@@A@@@@BA@@B@B@B@DA@@@A@@B@BA@@@@BC@@@A@@B@B@D@B@BCB@@A@A@CB@@@AA@@@@@A@A@A@C@A@@AA@AA@AAA@A@@@AAAB@@AA@@AAA@@AA@@AA@@A@@@@A@@@CA@A@A@A@@BC@@@A@@@AB@@AA@@@@@@AA@AAA@AAA@@AC@@@@A@@AA@@@@AAA@A@A@@@@@CC@@@@AA@@@ACA@@@@BA@@BA@@@A@@AA@BA@A@@@AA@@C@CA@@AAA@A@@@A@A@@@@@@B@B@B@@@@@BAAA@@@CC@@@A@@B@BA@A@@BA@@BA@@AA@@@AA@@@@@A@A@@@A@@@@@@@@A@@@A@@B@ACCC@ACABA@A@@@ABA@AA@BC@@A@@A@AB@@@@@BAB@BA@@BAAC@ABABAAA@ACCAAAABADED@BABAB@@@BA@@BA@@BA@@@@B@@A@@BABA@@@A@AAAA@@@@@AA@@@@@@@A@@@C@@@A@@BA@@A@@A@@@@AA@@@@@@@A@@@A@@@A@@@C@@B@C@A@A@C@CAA@ABA@A@@@ABA@AB@B@BA@A@@@ADA@@B@@ABA@@@A@@B@@@B@BA@@@@@A@AB@BABAB@B@@A@A@@@ABA@@@A@AA@AA@@@CA@AA@@AAA@ACEA@@@@@A@A@@AA@@@@@A@@@A@A@AB@BA@@A@@C@@@AB@@A@@BA@C@A@@AEA@@A@@@AA@@A@A@@BCBA@@@@@A@@B@@A@AB@@CAAA@@A@@@ABA@@@A@A@C@@ACA@AA@@A@@A@@@@BA@A@AB@@AAA@@@A@ABA@A@C@@@AC@@@A@AA@@AA@A@@@@AA@A@@@AA@@@A@AA@@@A@ABC@AB@@A@@@A@A@@@A@A@C@ADAB@@@A@@CE@@@CAC@@AA@@@AABA@ABE@@@BA@@A@@AA@AA@A@AA@@@A@@@ABCB@@A@@B@B@DA@@B@@@B@@@BGBABAB@AA@CHA@E@C@GB@AABEBCBAB@DAB@BABEAA@A@@B@DBABB@@ADAB@B@BB@@DBD@BBB@B@@A@AD@@ABA@C@@@A@A@@@AC@BA@C@AB@@@BB@@B@@AB@@@B@B@@ABA@@B@@@BABA@@BA@BBBBBBB@@B@BBB@@@B@@@@@B@@@@AB@B@@@BA@AB@@A@ABAB@@AB@@@@BB@@@B@@A@@@AB@@A@A@@@A@AA@@AB@AA@@@AA@A@@A@@@@@@@A@@AAB@@@@@B@@A@@@AB@@AA@BA@@BCB@@@B@B@BCBA@@BA@A@@@@@@BA@@@A@AAC@A@@@AB@@@BA@A@@BAD@@A@A@A@@B@@@@B@B@@@@BAB@B@BA@EA@@A@@B@BABB@@B@B@@AB@B@@AB@@@B@B@@@B@B@@@B@BA@AB@@@BABB@@B@@@@BB@@@@B@@B@@@B@@@B@@B@@@B@@@BB@@@B@@@@@@@B@@B@@B@B@B@@@@BBB@@B@BA@@BB@@@@@BB@@@@@@@BAB@BA@A@@D@BA@@BAB@B@BA@@B@B@@@@B@B@@@@@@B@@@@@B@@@BBFB@DB@B@@DB@@@D@@@@BB@@B@@@DBABB@BB@@@BB@@BB@BB@@B@@@B@BBB@B@@@BBBB@BBB@B@@B@AB@@A@A@@@@@@@A@A@@@@@A@ADA@@@E@@@@BAB@@A@ABA@@@@@A@ABA@@@A@@@AB@@A@@@A@@D@B@@@@@B@@@B@@@BAB@@@BA@@@A@@@@@ABA@AB@@@B@@@B@@@D@@@BBB@@B@@@B@BB@@B@@B@BBB@@@BAB@@ABABA@A@@BBB@@@B@@@B@@B@@DA@@B@@A@BB@D@B@BB@@B@@AA@@A@@@@@A@@BAA@@@@A@@@@@@BBFBB@BB@@@@@@@BADA@@@AA@@@BA@@@A@@B@B@DAB@@@@A@@B@D@@@BBDB@BB@@B@B@@BBBB@@@@@B@@@B@@B@@@BB@B@DADBB@B@@A@@@A@@@@@A@@@@BA@A@A@@@A@A@@@A@@@A@ABA@@@A@A@@@@BA@@@A@A@@@@@CBAB@@B@@B@B@B@@B@@@@B@B@@@DBB@B@@@@AB@@@@@B@@@B@@A@AA@@@B@B@B@B@@@BA@@@AA@BA@A@@@AB@BA@@BB@BB@@@@@BABAB@@@@A@@@A@ABA@@BA@AB@@@B@D@@AB@B@@@@AB@@@@BBBB@BD@@@@@B@B@@B@@@B@@@BB@@B@@BB@@@BBB@@@D@B@@@B@@B@@B@B@@@@BB@B@@B@BB@@B@B@@@@@@AB@@@B@@@@@B@@@@@BB@@B@B@@@BB@@B@@@DD@@B@@@B@DD@@B@@@@B@@@BAB@@@@@@A@@@A@@BA@@@@BBD@@@BB@@BB@@@B@B@B@@@B@B@@B@@BBB@BBB@BBB@BA@@B@@@B@B@@@@B@@@B@@@@BBBBBB@@@@@BA@@@@@BB@@BB@@@@@@B@@@B@@@BDB@BBB@BB@@B@@@BB@@B@B@BA@@@@@B@@B@@@@@BB@@B@BBB@BAB@B@BBB@B@@@@@BD@B@@@B@@@BB@@@@@@@B@@@BB@@@@B@B@@@B@@@@@BBB@B@@@B@@@B@@BB@@@@@BBB@@B@@B@@@BB@@B@@@@@B@@B@@B@@@B@@B@@B@@B@@@@@@@@F@@@@@B@@@@A@@BA@@BAB@@AB@CA@AA@@@AAHBBBB@@@BBBBB@@@B@BB@@B@@@BBB@BB@@BAB@@B@@BBB@FB@@BB@@@D@@@B@@@@@B@BB@@BB@@BBB@@AB@@@B@@@@ABBB@@@@B@@@B@@BB@@@BA@@B@@@B@@@BA@DB@@@BDBBBB@@@BBBB@B@@BBBB@BB@@B@@@B@BB@@@@BB@@@B@@@@DBB@@@BBB@B@@@@@BBDB@@B@DAB@@BD@@@BB@@@BB@B@B@D@B@D@@@B@B@F@BBB@@BBB@@@@B@@@@AB@BAB@@@BAB@@@B@@@B@@@B@F@@@BA@@@@B@B@D@@@B@B@@@B@@BBD@B@@BBB@@@B@@@B@@@@B@@@@BB@@@BB@@@BBB@@@@BB@@@@@@BB@B@BB@@@BB@@@BBB@@@BB@@BBB@B@@@@BB@B@@BB@@@@@B@BB@@B@@@@A@@B@B@@@B@B@@@B@@@B@@@@@@@BA@@BA@BB@@@@@BBAB@@@@@@@B@@BBBB@@@B@BDBB@BBBBB@B@B@B@B@@@@AD@@@B@@B@@B@BA@@@A@A@@@@@AB@AA@@@@@A@A@@@@@AB@@A@A@@@@@@D@@A@@@A@@BA@AB@@@@AB@@@B@@@@@@@@A@A@@@@BAB@BA@@BA@@B@@@B@@@@@B@B@@@@@B@@@BBBBB@@AB@@@BABC@@B@@A@@B@@@@@@@B@@@B@@@BB@@@@B@@@@ABA@@@@B@B@@@@A@@@A@@@@B@B@B@@A@@@@B@BA@A@@@@B@@@@@BA@ABBB@@@@@B@D@@@@AB@@@B@@@@AB@@@@@BA@@@@B@B@@A@@@B@@BBBB@@BB@@B@@@@@@@B@@@B@@@B@@@B@B@B@@@B@B@B@B@@@@@@@BA@@@AB@@@BAD@B@@@B@BB@@BA@BB@@@@@@BBB@@@@BB@@@B@@BB@@@B@@@@@@B@@BB@@B@B@BB@@B@@@B@@@@@B@@@@A@A@@BA@A@@B@@@@BB@@@B@@@B@@B@@B@@B@@@@B@@@@@B@@@B@@@B@B@BB@@BB@BBBBBBB@@@@B@@@B@@@@@B@@@B@@@B@B@@@@@BB@@BA@@BB@@BFAB@@@D@B@B@B@@AB@B@BA@AB@B@BBB@@@B@@BD@@@DBB@@@@@@@@B@@@B@B@@@@AB@@A@@D@@BBBB@@BB@@@BB@@@@@BB@@@@BB@@@@@BBB@BBF@@@@B@BB@DDB@D@B@B@BBB@@BB@@BBB@DB@@@DB@B@@B@@@@@B@@@BA@@BA@@B@B@@AB@BBB@DB@BBBB@@B@@BBB@@@@AB@BB@@@BB@@BB@B@@@@@@ABAB@@A@ABA@A@@BA@A@A@@@A@@B@@B@@DBB@@A@@BAB@@@B@@@@B@@BBB@@@BA@@DA@@@@@@B@@@BAB@B@@B@@A@@DBB@@@B@B@@@BB@@ABBBA@@@BB@@B@@@BBAB@@BDBDB@AB@B@@AB@@AB@@@@@@@@@@@B@@@@B@@@@@B@@@@B@@BB@@A@@@B@@@B@@B@@A@@@@@@@B@B@@@B@@BD@@B@@@@BDBB@@B@B@@@BA@@B@BADBB@B@BB@@B@AB@B@B@@@BB@@@AB@BA@AB@BCB@B@B@BAB@B@@BB@@A@@B@@BB@@@@@@@B@@A@CA@@@@@@BB@BAB@@@@@@A@AA@@ABC@A@ABA@@@@@A@@B@BABABA@A@@@AB@@@@AB@@BB@@@@BB@BBBB@BB@@BB@BBB@@BB@@BB@BB@@@BB@@@BB@@B@@BB@@@@@BAB@B@@@@@A@@ABB@A@@B@@@@AB@@B@@@BD@@@B@@@@@B@@@@@B@BD@@BBAB@@@BBB@@@B@BA@@@@@BBD@@F@H@@@DDBBBBB@BBDB@@BBB@@BBABA@ABA@CBAB@@@B@@@@AB@@@@ABA@@@A@@B@B@@@@@@A@A@AB@@AA@@A@@BAB@BAB@@@B@B@@AB@@A@@@B@@B@@@BB@A@@@B@@B@AAB@BB@@@@@@B@BAB@@B@B@@@@@@@BABB@@BB@BB@@@@B@@@BD@B@B@@BB@B@@DDBB@@@B@@@BB@B@B@@@@D@D@@AD@D@@@BB@@@BA@@B@@@D@D@@BB@@@@@BBBB@@B@@BDB@BB@B@@@@@@B@BDB@B@@@BABCBCBBD@@@BBBB@@BB@@@B@@@@BB@BB@@@@@@AD@@@B@@D@@@D@@@@AB@@@@AA@@A@@AA@@@@DCBA@AB@BA@@@A@@BA@@@@B@B@@@B@@AB@B@B@B@@@BA@@@AB@B@@@@@@@@A@ABC@@@@B@@AB@@ABAAA@@BA@@B@@A@A@ABABCB@AA@@@AB@@@B@B@B@@A@@DB@@D@B@@@BBB@@@DA@BD@DB@@@@@B@@@B@@@@@@@@BD@B@BABB@@B@B@@@BAB@@BB@B@@B@B@B@B@@B@@@@BBBAB@@@@D@@@@BBA@A@@B@@@B@BB@@BA@AB@BB@@@B@@@BB@@BB@@@BBB@@BD@BDBB@B@BB@@B@@@BAB@B@@AB@BA@@B@@AA@@@BA@A@@BA@@AA@@AA@AA@@A@C@A@@@A@C@@@@AA@A@A@@@@A@A@A@AB@@@@AA@@@A@A@A@@@AB@@@B@@ADA@A@AAA@@A@@A@A@A@@@AB@@@@A@@CA@A@@@@B@@@BA@@A@@A@@@@@@B@BAB@@@B@BABA@@BABCA@A@ACB@@A@AB@B@B@@@BAB@@AB@@@B@B@BA@AB@@@@AB@BABA@@@A@AB@@@@@B@@@@@B@@@B@@@@@DB@@@B@B@@@D@@BB@@B@B@@@@@B@AA@@@AB@@A@@B@@A@A@A@@A@@@A@A@E@A@AAA@@@@AAA@@@A@@A@@@@A@B@A@@@A@@A@CDA@A@@@@A@@@@A@@@BC@@@@B@@@B@@A@@@@B@B@@B@@@@B@@@@@BB@@@AB@@@@@@@B@@@B@@@@@@A@A@@@@A@@@A@@A@@@@A@AA@@A@@AB@BA@AB@@@@@B@B@@@@B@@B@B@@@B@BBBA@@B@@@@@@@AA@@BA@@@AA@@@BA@@@@@@@@@@@A@@BAB@@@@A@@B@@@@@B@@@B@@@@B@@@@@@B@@A@@D@BA@@B@@@BA@@@@@AB@@BFAB@AA@A@@BA@A@@A@@AB@BAB@@ABA@AB@BA@@@@B@AA@@@A@@@@@A@@@AB@BABA@ABAAAA@@@A@@@@@@A@A@@@@BA@@B@B@B@B@@AB@BA@@BADA@ABA@ABA@@@@@@@@@A@@@@B@@A@@B@@@B@@BB@@@@@B@@@B@B@@@B@@@@@@A@A@@@@@ADC@@BA@@B@@@B@@@D@@@BA@@BAB@BABAB@BA@CB@@@BA@@BA@@BB@BBBB@@B@BB@@@DBBB@BB@B@@BB@@BDB@@BBBAB@@@@@@C@@@@AA@A@@BA@@AA@A@@@@B@@ADA@@@@@ABA@@B@@@@@B@@B@AB@@C@@@@B@B@@@B@@@@@B@B@@@@@@@@@B@@@B@B@@CBCAC@@@AB@@@FABB@B@BBBB@DBD@DD@DF@BBFABB@@B@@@@@B@BA@@@@B@BABABA@@@A@@@@@@AA@@@@@@@A@@@ABA@@@@B@B@B@@@B@AA@@AA@@A@BA@@@B@@B@BBB@@@B@@@BB@@@@@@BA@AB@@AB@B@@@@@@@@AAA@@@A@@@@@A@@@@@ABAB@B@BAB@@CBAAA@AA@@@B@@A@@@A@@A@B@@A@A@@B@@A@@A@@AA@BA@@@@@A@@CAA@AB@@A@@AACA@@@@@@@@@@@@A@AB@@@AA@@AABA@@@@BAB@@@@AB@@@BAAA@@@AA@BA@@BAB@@@BA@@A@A@BABAA@B@@@@@@@@@B@@A@@B@@@B@@@@A@@@A@@@@@@@AB@@AB@AA@@@@A@A@@@@@@AA@@A@@B@AA@@@@@@A@C@@BA@@A@@@A@@@@@A@@A@AAA@@AA@@@@@BAB@@@@@@A@@@A@@@@@A@@B@@@@B@@B@@@BA@@@@BA@@@@BA@A@@@A@@@@@A@@B@@@B@BC@@@A@ABA@@B@@@@@B@B@BBDD@@D@DA@@B@@@@BB@@@@AB@@@@@@@@B@B@@@B@@A@@B@BB@@@@BBB@@B@BA@@D@@@BA@@@ABAB@@@@@BAB@@@B@@@@@@@@@AD@@@B@@B@@B@@@BB@@B@@@AB@B@@@@AB@B@B@B@BA@@@@@@F@@ABA@@@ABA@@@A@ABE@@@@@@@AB@@A@@AA@AB@@@BAB@B@@@B@BD@@BB@B@@@@@BB@@@B@@B@@@@@B@@B@@@@A@A@@@@@@B@@@@AA@@A@@B@@A@@@@B@@@BA@@B@BA@@BABA@@B@@A@@B@@AB@BA@@@ABA@A@@@AA@@ACAA@A@CB@@CB@@A@@@@A@@@@@ABA@@ACCABA@@BA@A@@BBB@@B@@@@@@BA@@AA@@BA@@@@@@DB@@@@@B@@@A@@@B@@BB@@@@@ABA@@@@@@AA@@@@@AB@B@@B@A@@@@@A@@@AB@@AAA@AA@@@@@@@A@@@@A@@@A@AA@@A@A@@AA@A@A@@@@@@@@@A@@BA@@@@@A@@AAAA@A@A@@@@A@A@A@@@@@A@A@@@A@A@@@A@A@AAA@AA@@@@BC@@AA@@@BA@@@AA@@A@@@A@@@@@@@@A@@@@A@@@A@@@A@@@A@@@@@A@CA@A@A@A@@@@@AAA@@@A@AB@BA@@@AA@@@@@AABAB@@@@@BA@@@AA@@A@AAA@@@@@@@@A@@A@@@@@@@A@@@A@A@@@BB@BA@@@@@@A@A@@A@@@@@ABB@AA@@A@@AA@@B@@@AA@@B@@ABAA@@@A@@@AB@@CC@@AA@@@@@AA@@@@AA@@@@@A@B@BAB@@@@@B@B@@A@@@@@A@@@A@@@@BAB@@@@@@A@@BAB@BABA@AB@DBB@@@@@B@BA@@@@@B@@A@BB@@@@BA@@@@BB@@@AB@@BB@@B@@@@@@@@B@@@@B@B@@@@BAB@@@@@@BB@@@@@@@B@@@@@@@@B@@B@@@@A@@BAB@@@B@@AB@@@@@BB@@@@@A@@B@@@B@BA@@@@@AB@@@@@B@BBB@BAB@@@BA@@B@@@@@BBB@B@@AB@B@B@@A@@B@@@@AB@@@B@@@BAB@@@B@B@@ABA@BB@@AB@@@BAB@B@@@B@@AB@F@@@@ABA@@@A@@B@B@@@B@@@B@@AB@@ABA@@@AAAA@@A@@A@A@@@AB@@A@ABA@A@@@@@A@@@@@A@@@AC@@@C@@@@ABA@@@A@@@ABA@AA@@@@A@C@@A@@AAA@@BCAA@@@@A@@@A@A@@@CAA@@@A@@@AA@@A@@A@A@ABAAC@AAAA@CBA@@BA@A@A@ABAAABCA@DE@@@A@AAA@@@@B@DA@@@A@A@AA@@@AAAA@@A@@@AACI@@@A@@A@@@@@A@@@A@A@@B@@C@@@@@A@@A@@@@AA@@AB@@AA@@@@A@A@@@@AA@@@@@AA@@@@@@AA@@@BAAA@@BA@@@@@A@@B@@A@@@@@@@@@@@@B@@@@@BB@@@AB@BA@AB@@@@@BA@AAA@@@A@@@A@A@@B@@A@@@A@@@@@AAA@A@A@@@A@AAA@AAA@@@@@@@@@A@@A@@C@A@@@AA@@@@AAA@@@@BA@A@@@A@@@@@A@@BA@@AA@@@C@@@A@@@A@@AC@AA@@@@@AA@@@AA@A@@C@@@A@A@@BA@@@A@A@@@@AA@A@@@A@@@A@A@@BA@@@A@@@@@@@@B@@@@BB@@@@B@@@@@@B@@@@BB@B@@@@@F@@AB@DAB@@A@A@@@A@@@A@@B@@@B@B@@CB@@AB@@@B@@@@BB@@@BA@@@A@@BA@@AA@A@@AA@A@@@@A@@AB@AA@@@A@A@AA@@A@@@@@@AA@A@@BCAA@@@A@@@A@@@@@A@C@AA@@A@@AA@A@@@A@@AA@@@ABAAAAA@@@@@AA@AA@@A@@@AA@AA@ACA@@@@@A@@@@@AA@@@@A@AA@@AA@@@@@@BA@@@@@A@@@B@@A@@@A@@@@A@@@@B@@@@@@@@AA@@@@A@@B@@A@@@@BB@@BB@@@B@@@BB@@B@B@B@@B@@@@A@A@A@@ACBAA@A@AA@@@@@A@@A@@@@@@@DA@@B@@AA@@B@@@@C@@@@@@AB@@@@@A@@@@@A@@@@@@A@@@@@@B@@@@@@@@A@@A@@B@A@@A@@AB@@AB@@@BB@@@@@@@@@BBBA@B@@@@A@@@AA@@A@@@@@AAA@@@B@B@@@@@@ABAA@@@@A@@A@@@@@BDA@@@@@@A@@A@@@@@@A@@@@@@@A@@@@@AA@@B@@@B@@@@@@A@@@@@BA@A@@@@A@@@A@@@AD@BA@@@AAC@AAA@@@CAAA@AA@AA@@@AAAA@AA@@AA@@@A@AA@A@@A@@A@A@@@@AA@@AA@AAA@@@@A@@AA@AA@AA@@A@@@@@@A@@A@B@@A@@A@@A@@@@@A@@A@@A@@@@@A@@@@AA@@@@@@@C@@@@@AA@@@@A@@@@@A@@@@@@A@@@@AA@@A@@AA@AA@@BA@A@A@AB@@@@BB@@@B@@A@@@AB@@@B@@@B@@A@@B@@@@@@BB@@B@@@BBB@B@@BBA@@@B@@BB@B@@@B@B@@B@BBB@B@@@@@@B@@@B@B@@A@@B@@B@@@@BA@@@@B@@@@B@@@B@BBB@B@B@@@A@@B@@B@@BB@@BA@@BA@@@@BA@@@B@ABB@A@@BA@@@@BA@@@@B@@@@@@B@@AB@@@@@@@B@@@B@@A@@@@@BB@@@@@B@B@@@B@@B@@AB@@A@@@@BB@@@@@@BA@AB@@AB@@@@@@A@@@@@@B@@@B@@@@B@@B@@@@B@BA@@D@@@@B@@@@@BA@@B@@@BB@@@@@B@@B@@A@@@@BA@@@@@A@@@A@@@@@@@A@@B@@CAAACACAA@A@CEAAA@CAC@@AA@CCAA@@A@@@A@AB@@@DBD@D@BAB@@@@CBC@@@A@A@AA@@@AAA@@@@FG@C@AAA@@@@A@@B@@AACD@@@AA@@BAB@@@@@@@@A@AB@@@@A@A@@@AA@AA@@A@@C@AB@@A@AB@@A@AB@BA@@@AAA@@A@AB@@@@A@@@AB@A@@@A@@AAA@@B@@@B@@@@@@@@@AA@@A@@@@BA@@@@A@@@@AB@@@BAA@@A@@@@@A@AB@AAB@@@@AAA@@@B@BA@@@@@@@@@A@@@@@@@@AA@@@@@@BA@@@@@@A@@@@A@@@@@@@A@@@A@@@A@@AA@@@AA@@@@@B@@@@AB@@@@ABB@@@@B@@@@A@@@A@@D@@@@@@@B@@@@@BA@@@A@@@A@AB@@AB@@@AA@@@@@@A@@@@AA@@B@@A@@@AA@@@@A@@@A@A@@AA@@@A@@@@@@BA@A@A@@@@@@A@@A@AA@@@@@A@AA@@@@AA@@@A@@@A@AA@@AC@A@A@@@A@@@@BA@@BAB@@AA@@@A@@@@@AA@@AA@@@@@AB@@AA@@A@@B@BA@@@@AA@@@@@@@@@@B@B@@A@@@@BA@@@A@@@@@A@@@@AC@@@@A@@@@@@@@AAAAA@@A@@@@A@@@@B@@A@AA@@A@A@@@@@@ADA@A@@@A@@@@AAA@@@A@@AB@@A@@@@@@@A@@@A@@AB@@@@ABA@@A@@@@@@AB@@@@A@@A@ABA@A@A@@@@@@AB@@A@@A@@@@A@AA@BA@@@@@AB@@ABA@A@BB@@AA@@@@@@@@@@@@A@@A@@B@@AB@@@B@@@AA@@AAABA@@@A@@@BA@@A@@@@@@@A@@@A@@@@@@@@@@B@@@@@@B@@@@@@@@@@B@@@@A@@@@@@@@A@@@@@@@@A@@@B@A@@@@@A@@BB@@@@B@@A@@B@@@@A@@@AA@@@B@@@@A@@B@@A@@@@A@@@A@@B@@A@@@@A@@@@@@@@B@@@@@@A@@@@@@@@@@@@B@B@BB@@B@@@@ACAAAAACAACCACCAACA@A@@@A@@BA@@@@@AAAAA@A@@@AB@@AB@B@@@@A@C@@AAB@B@DABAFEDADCACCEAADA@A@@@@@@@@DCBAHE@@@A@@@@@@@A@@@A@@@@@@W@@E@C"],encodeOffsets:[[111268,22498]]}},{type:"Feature",id:"450721",properties:{name:"灵山县",cp:[109.291006,22.416536],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@ABCBABA@@D@BA@A@@B@@AA@@AABA@A@A@AAA@@@@AA@@ABABA@@@@@@@@BAAA@@@ABAB@D@B@DABADADADA@A@AA@A@A@A@@ABABABCFC@CA@@AC@ACAAAC@A@A@@@@@@@A@@A@DCAA@@BC@A@AAA@A@AB@BAD@B@B@@@DD@BB@B@@@@@BC@@AA@AEA@@BA@@BB@@@@@A@@@@A@@@@@@@A@A@@A@A@@BAB@@@B@@A@@BAAA@AAE@A@@BAB@@@DBB@B@@@@AACBAAA@ABABA@CB@HC@@BA@@AA@A@@FA@@BAAA@@AA@A@@BC@C@A@C@@BABAB@FBBA@@@@@EAAAAAAGBAA@A@ABCDAF@BA@@AAA@A@CCAC@AA@GL@BCB@@IEKB@@A@@@OAKCAE@@@@@IDA@@B@JCDE@@@@@C@ADA@A@@BA@@@@@A@@BA@@@@@@A@@@@@AAA@ABA@AAABCB@BC@AA@CB@@@@A@@@A@@AAA@@@A@@DCBA@@@CD@BAAA@@A@@@A@A@@@C@AB@@AB@@@AEAA@@@A@@@A@A@@D@B@@@BA@@@A@@@A@@@AAABA@@@A@@@CA@@ABABA@@@@@@@AA@C@AB@B@@@@AAAB@AA@AAAACA@@CBA@@A@@@AAAAB@@C@A@@B@@@BA@@B@@@@@BB@B@@B@B@@@@AB@@@@@AA@@@AAA@BA@@AA@BA@@@@BCAB@@A@@@CD@@CA@@@A@@BA@A@@B@AA@BCAA@@A@AB@@A@@BBB@BAB@@A@A@AA@@A@AAAA@@ABA@@B@@C@@AAABABAB@B@@@BBB@BBB@@@@@BA@@@A@AB@@@ACA@@AAA@A@@@@@@A@@@C@@AAAA@@A@A@@EA@@AB@@@B@@@BBB@@B@B@@@@BA@@BBBA@@BA@@C@ACB@@@@@A@@A@AB@B@B@@@@A@@BA@@@@A@AA@AA@@@AA@@@A@@@@A@@A@@@A@@@@B@@@@ABEBA@@@A@@ABCGB@AAAAB@A@@@A@@@@A@@@A@A@@A@ABC@AAA@BA@@@A@@AAACB@@@@AAAA@@B@AA@@@@A@@@@@@@CBAB@@AAA@ABA@A@@@A@@BABBB@@@BA@AB@@@@A@@B@BA@@AA@@BA@BB@BA@A@ABA@@@@@A@A@A@@B@B@BABC@GMC@ABADGNB@ABAB@@BB@B@B@@@D@@@BA@@BCBAD@@AF@B@@BD@B@BBD@@@BABADAB@@@B@BABAB@@@@BB@@@B@BA@@B@@BB@@@@@BAB@@A@@BA@ABAB@B@@@B@@@@@@E@CB@@@BCB@B@@@B@B@@CBAB@@A@@B@BA@@@@@BBAB@@@B@@@@A@A@ADAB@@A@@@@@AB@@@BA@@BA@@BA@AAA@@AA@@@@@AB@@@B@@@B@BA@@@ABA@A@@B@@@BB@@BA@@DA@@A@AA@@@A@@CAA@@BA@A@@BCC@@BAB@@@@A@@@AA@@@@@@A@BC@AAA@AABC@@B@@@B@@@BAB@@@B@@AB@@BBA@@B@@ABAB@@AAA@@B@BB@@BA@B@@B@BF@@B@@B@@BA@BD@D@B@@@B@@AB@B@@@@@BAD@@@@@BAB@@@B@@A@@B@B@D@@@B@@A@A@@@AA@@A@@@@B@@A@@@@BA@ABA@@@@BCB@B@@@@A@ABA@C@@B@BA@AB@DA@@@A@A@AB@@@B@BABA@BB@@@BA@AB@B@BA@@B@B@@@B@B@@A@@@AB@D@B@BA@@B@@AB@@@BBB@@@@@BCB@@@BAB@BA@@@@B@B@@@B@@@B@@BD@@B@@@B@@B@@@@B@@@@B@@B@@@@B@B@@@@@@A@A@@BA@@@@AA@@@@@AB@@A@@@A@@B@B@@@B@B@B@@@@BB@@A@@D@@@@@@@B@@@@A@A@A@A@@A@D@B@@@B@B@@@B@BBBE@@AA@@@AA@@@AAA@AC@@@@B@@A@ABA@A@@A@@AA@@A@A@@@@AB@@@B@@AA@@@@A@@@ABA@AC@A@@@C@B@@B@@@B@@@@BB@B@@@@AB@@@B@@@B@@@BAB@@@BAB@DA@@B@@A@@A@@@@@@@@@A@@@@@AA@@A@A@@@A@A@A@@@A@@@A@AAA@@@B@@AD@@A@@@@A@@@A@@BA@A@@BA@AA@@@A@A@@@@B@@A@@B@DA@@B@@@B@@@B@@@BA@@B@B@@AB@@@B@B@@@@C@@A@AAA@@AB@@@@@B@@ABA@@@@@A@@@@@@BAB@B@@A@@B@@@B@B@B@B@@@@BBB@@@A@@@BBA@@B@@A@@@BB@@@@@@A@@@A@@BABAAA@@@A@@BB@@@@@@@@@@@ABA@@@A@@BA@A@A@@@A@@AB@@A@@ABAB@@BB@@A@@@A@A@@@AB@B@@@AA@A@A@AB@@@@@@AA@AAB@@@@BB@@AB@DA@@@@BA@B@B@@@@@@B@@C@@@@@B@@BB@@@BB@@BB@@AB@@ABBB@AB@@@@BBBDD@@B@ADBFA@@B@@CB@@@@@B@@@BB@@@@@@BBD@B@@BBBB@B@@@B@@A@@@CB@@@@@B@@@@AB@@BB@ABBA@B@@BDABB@@@BBADA@@B@@@BB@@BBD@@B@B@@@D@@A@@BB@@@ABAB@@@BA@A@@@@@@@@@@@BA@@AA@ACBB@@B@@A@AD@@B@@B@@@@@@B@@@@A@@@@BB@@ABBBAB@@@B@@@B@@B@@@@@@AB@@@B@@@@@B@@@@B@@@@@BA@@@@@B@@BBA@@@@B@@BB@@@A@@B@@B@@@BB@B@@AB@@@B@@@B@@B@@@B@@B@@A@A@A@A@@BAA@@@A@A@A@@@ABA@A@@@@@A@@C@@B@@@B@@AA@A@@A@@@@@AAA@@A@@@@AB@AA@A@@@A@A@@@A@A@@A@@@@@@A@@@@@AA@A@@@@@@A@@B@@A@A@@AA@@BAB@BA@@@@@@@A@@@@@@@A@@@@A@@@@A@@@A@ABA@@@@@A@ABABA@@B@@@B@@AAA@@@@BA@@A@@@@A@A@A@@@AA@@A@AB@@@BA@ABA@A@@@ABAB@@@B@BAD@@AB@@A@BB@@@@AB@@A@BB@D@@ABA@@B@@@B@@A@@BA@@@@BB@@@@@@DB@@@@@B@@A@A@@BB@BB@@BB@@B@@@@@@B@@@@@D@@@BB@@@B@@BB@@@@B@@@@B@B@@@B@B@BAB@@@B@@AB@B@BA@@BA@@@A@A@@@@@A@A@@@@@@B@@@@@BA@BBAB@@@@AA@@A@@@A@@A@@A@ABA@AB@@A@@@ACA@@@@AB@@A@@@@AA@@@A@@@A@@@@AA@@A@AA@@@@@AA@@@A@@@@BA@@@A@@A@@@@A@@@A@@A@A@@@@@@AA@@@@@ABA@C@A@@A@@@A@A@@@@@@@ABAB@@@@@@A@@@@@A@@@@A@A@@@AA@@@@A@@@@C@@@AB@B@@@AAAA@@AA@@AA@A@A@@@@B@@@@@B@@@BB@@B@B@@@@AB@@@@@B@BB@@B@@@@@@AB@@A@@@A@@@@@@@A@AAAA@@@A@@E@@@A@ABA@@@@BA@@A@@@@AB@@@@@AA@@@@@@BAB@B@@@@@BA@@BB@@@@B@B@B@@@B@@ADA@@B@@AAA@@@CBA@@@@@BB@B@B@@CFB@ADBBAB@B@B@BAB@@AB@DBBBBD@BBBAB@B@@@@BB@@B@@@B@@BB@D@@@B@B@@@B@@B@DB@AB@BB@@@BD@B@@@@@BBB@BA@@B@@@B@BA@@@@@D@@BD@@B@@@@@B@@@@@B@B@BAB@@@BA@@B@B@@@@BB@BB@B@@AB@BA@@BA@@@A@@@A@A@@@@B@@AB@B@@E@A@@BA@@@A@A@AB@@A@@BA@@AAB@BA@A@@@A@AB@@A@@@A@@B@@A@@@@BA@A@A@@BA@A@AA@@@@A@@@AB@@A@ABA@AAA@@@@@A@@B@@@@ABA@@@A@@@@B@@@@AA@@@@A@@BA@@@A@AB@@@B@@A@@@@A@@@@@@A@@@@@@@A@@A@@@@A@AB@@@@@A@A@@A@@@@@@@@@@AA@@AA@@B@@AA@@@@AB@@@@AAB@@@@A@@@@ABA@@@@@A@CAA@@BABABA@AB@@@B@@@@A@AB@@@@@B@@@B@@@@@BA@A@@@@@A@ABA@B@@@@@B@@B@@B@@B@@@@BB@@DD@@BA@@B@@@B@AB@BA@@@BB@@A@@@BB@@@BB@@BAA@B@@@@@BB@B@@@@@@@ABA@@A@@@B@B@@@B@@@@@@@BB@@@@@@@@@BB@B@BB@@B@@AB@@@@A@ABBB@@@@B@@B@@ABA@@B@B@@BB@B@@@@@B@B@BDBB@@@@@B@@@B@@@B@@@B@@@@@@B@@@@@@B@@@B@@@BB@@B@@A@@BB@@AD@@@@BBB@BBB@B@@@B@B@@@B@B@@@@@B@B@B@@@@@@B@BBBBB@@@B@@@@AB@@@B@@@@@@@@@B@BBB@@@B@BB@@B@B@@@B@@B@@@@@@@B@@BBB@BA@@B@@@B@@@@@B@AA@A@@B@@@@BB@@@@@@AB@B@@@@AA@@@A@@@B@@@A@@@@CA@@@@@@AB@@BB@@AB@@@@@@@AA@AA@@@BAB@@ABDBBD@@AB@B@@@@@B@@B@@@DA@@DAB@B@DB@BB@@B@@@BAB@B@@ABA@@BA@@@@BA@@@ABAB@@ABA@@@AB@@A@@@@@@BA@@@@BB@@B@@A@@@@@@@@B@B@@A@@@@A@@@@@AA@@@A@@A@@@@@AAA@@ACA@@@A@A@AB@@A@@BBB@@@BA@@B@@@@@@AF@B@B@@AB@B@@AB@BE@@@@@@@ABA@A@A@A@@B@@A@A@@B@@@AA@@A@@@AA@@@@A@@BC@@@@@@@@A@@@A@AB@@@@A@AB@B@@AB@@C@@@ABA@@@AA@A@@A@@A@AB@@@@A@@@A@A@@@@@@A@@B@@A@@A@@A@@@CBC@@@CCAAA@A@@@@@A@@@AB@B@B@@ADA@@@A@@@@B@@@@@B@@@BAB@@@@AB@@@@AB@@A@@@@A@@A@@@@B@@@@@B@@@B@@@@A@AB@@@@B@@BB@BBB@@@@B@@@@@B@@@BB@@AD@B@@@@@@@BBA@@@@BB@@B@@@@B@B@@@@@BBA@@BA@@B@@@@@@@B@@@B@@A@@@A@@@@BA@@@@@@@@@A@B@ABABB@B@@@AB@@A@AB@@ABB@@B@@BBAB@@A@@B@@A@AB@@@@ABBB@@BB@@A@@B@B@@@@@@@@@@B@BD@BB@@@BAB@DB@@@B@@@@ABB@@BB@@@@BA@@@@B@BA@B@@@@B@@@BA@@@B@@BBBAB@DA@ABA@A@AB@B@@@@@B@@@@@B@@BB@B@@@@@@A@A@@BA@@BAB@@@@@@AA@@A@@@A@AAA@@@@A@@ABB@@@BB@@BBA@@@A@A@A@@@@@AB@B@@@B@@@@@@BB@@@@@@@B@@ABABABA@@@@@ABA@@@@@A@@@AAEBAAE@@CCCC@CAA@AA@A@AAAEB@@A@@B@@BDAD@DA@A@@@A@@@@@@@@@A@A@@@@@A@@@A@A@@@@@@DA@@B@AA@@@@@A@@@AB@B@@@@CB@BA@@@@@@BBB@@AB@@@BBB@@@@@D@@@@A@ABAA@@CA@AA@@AA@A@@AAACA@@A@@A@AA@AA@AAA@@AB@@AB@@A@@DABA@ABABA@AB@@AB@@C@@@A@@@A@@@AB@@CD@B@@@@@B@B@@@@A@@@A@A@@@A@@@@@A@@AA@@@A@@@@BA@@@@@@B@@@@@@@@AB@BAB@BCBAB@@ABA@@BA@A@A@A@@@AB@@@@@B@B@@@@B@@@B@BBAB@BABABA@@B@@@B@@@@@B@@BBA@@@@@ABA@@BAB@BA@ABA@@BB@@@@BAB@@@BBBA@EB@AA@@B@@@@AB@@A@@@ABC@@@@BA@@@@@@@@A@@A@@@A@@@@@A@@@@B@@A@AB@@@B@@@@@@@@@@AB@@B@@B@@AB@@BB@@@@@@A@@@ABAAA@@@A@A@@@@A@@A@A@@@@@A@@BABA@@BB@@@BBB@@@@@@BB@@@B@@@@@@B@B@@@@A@@@A@@@@@@@A@@B@@AA@@@@A@@@@@@AA@A@@@@@@BA@@@A@@@@@AD@@B@@@@@@B@@B@B@DCB@@@@B@@@B@A@B@@B@@@@B@@BB@B@@B@BBB@F@B@B@@@B@@@@B@B@BA@@@@BA@@B@@BBA@@@@@A@A@@@AA@@@C@@@A@A@@CA@@@@A@@@A@@@@@A@@@@@A@@B@B@@ABABA@@B@@A@@BABA@A@@@A@@BA@AB@@A@A@A@@B@BA@BDB@B@ADAB@@ABABA@@@A@ABA@@@@@@@@BB@@@AB@@A@@@@@@BDB@@@B@@A@@B@@@B@B@BB@@@BB@B@BCB@BA@@@A@@B@@@B@B@B@@BB@@@@BAB@B@B@@@@@@B@BBB@@@@@D@B@@@B@D@BB@@@@A@A@ABAB@B@B@BA@@@A@@B@B@@@@@@@@B@@@B@@DA@@@@@B@@CB@BBBBA@@@@@BB@@@B@D@B@@A@@B@B@@@BABB@@@BB@@@B@@@BA@@@AB@@@@B@D@BBB@@@@AA@BB@@B@@@@A@A@@AA@@B@@BB@@@B@@A@@@A@AB@B@@AB@AA@@@@@@BBBAB@B@@B@@B@@@D@BCF@@@B@D@BA@@@@BAB@@@BB@BB@B@@BB@@@@@B@@C@@@@B@B@@A@A@@@@B@@@@BBB@@B@@BB@BDADB@@B@@@B@@@@@@@@@BA@@@A@A@@BB@@D@@BB@@A@@@AB@B@@@@@@B@BBB@@@@BCBA@@BA@@@@@D@@B@@@BA@@@AB@BBB@@@BBBBDC@@BB@@@D@B@@@BB@B@@B@@@B@@@BAB@@@BBB@@@B@@@@B@@@BAB@@@@@@A@AA@BAB@B@@@@@@B@DBB@@B@@B@@@BA@@B@@B@@@@@@@@@@BB@@BA@@@@BBB@@@@ABA@BB@B@@BA@@@@@@@@B@@@@@@@D@BBB@@@B@@@@A@@@@AAB@@AB@D@@@@@@@B@B@@@D@@B@@B@@@@@@A@A@@@AB@@BBB@@BB@BB@@@BD@@@BB@@B@B@BBBB@@@@@BA@@DA@B@@BA@@@CD@AB@B@@@B@@ABB@BBBBBB@@B@@@BB@@@BB@@B@@@D@@@B@@@@ABA@@@@@@BB@@@B@BD@@@B@@@@BB@AB@B@@A@AB@@@@A@@B@@BB@@AB@@BDB@@BA@@BB@@BA@@@@B@@@@B@@D@@@@@BAB@@A@@B@@@@BB@@BB@@A@A@@B@B@@@B@B@B@@@@@B@B@B@@@@@BAB@@B@@@BBBB@@@@B@@BB@@B@AB@B@@B@B@@B@@B@@@@B@@BB@@B@B@@BB@BAB@@@BB@@@B@@@BDA@A@@DB@@@B@@BA@@@A@@@@@AB@@@B@B@@ABB@@@BA@BBB@@B@B@B@B@@@@@@@@B@@@B@@@@@B@@@B@@@@BB@DBBB@@BA@@BA@@B@@@B@@@B@@@@A@@@@AA@@@@@A@@@@B@@@B@@@@A@@B@@@@BB@@A@@@ADC@A@@FAB@@@B@@A@A@@@@@@@AAA@@@A@A@@@@@AB@@@BB@A@@@@BABA@@@BB@@A@@B@@@B@@A@@B@B@BAB@@@@@@@B@@@@@B@@@B@@@@ABA@@B@@@B@@@BA@@A@@A@@A@@@@A@@@@A@@@BC@@@@@A@AB@@@B@B@B@@BB@@@@AB@@@B@B@@@@@D@B@@AB@@@B@@B@@B@@@B@@AB@@@@B@@BA@@B@@@@A@@BBB@B@@@B@@A@@@@B@@@B@@B@@B@BBB@@@B@@@BA@@BB@@@@B@@@@@DB@@B@B@B@@BB@@@BB@@BBAB@@B@B@@@BA@@@@BB@@@@@A@@B@@@@BB@@A@@@@BA@AB@@A@@B@@@B@@@@@BBBB@@@@@B@@@BD@B@@@@@@@@B@@BA@@@B@@@@@@B@@@B@@@@B@@@@@@@BB@@@@B@@@@B@@@BB@@BB@B@@B@@@B@AB@BB@@@B@B@B@@@@@BB@@DA@AB@@A@@@@@@BB@@B@@@@@@@B@@@@B@@B@@@@@B@B@@@@@@@B@@@B@A@@@@AB@BB@@BC@@B@@A@@@@B@@@@AB@@@B@BABA@@@@B@B@@A@@B@@@BBB@@@@@@@B@B@@@B@@@@@@@B@@@@@@@@B@@@@@B@@BB@@@BBB@@@@BB@@@@@@BB@@@@B@@@@B@@@B@B@DBBDDBA@@B@BA@@B@@BBB@@BB@@@@@BBB@B@@@B@@BB@@@@@B@@AB@@@B@@B@@B@@@@B@@@@@@A@A@@BA@@@B@@@BB@@@B@@A@AB@B@@A@AB@@AB@@B@@BB@@B@@@B@@ABB@@D@@@@AB@B@@@B@@@@AB@@@@A@@@A@A@@@@@A@AB@@@AA@AB@B@B@@@@@@A@@BAB@@AD@B@@@AA@A@@@@@@@@B@@@@@B@@B@B@B@@B@@@B@@@BA@@@AB@@AAA@A@A@@@AA@BA@@@@AA@@BA@@@A@@@@@A@@@@A@@A@@@A@@@AB@@AB@A@@A@@@A@@B@BABBB@@@@@@@BA@@BA@@@BB@@@B@B@@@@@B@B@D@B@D@@@@A@@BA@@@@B@@@@@@@@A@@BBBA@@B@@@@B@BB@@@@@@AB@@A@@@AB@@@D@B@@BB@@@B@@@B@B@B@@@B@BB@@@AB@@@@@B@BBA@BD@@B@@@@@BB@@@BA@@@@@@@@BA@B@@BB@@B@@@B@@A@B@@@@@@B@BA@B@@BB@BA@@BBB@@@@@@BB@BBB@@B@@BA@AB@B@B@@@B@@@B@@@@@B@B@B@@@@@@BBA@@BB@@B@@AB@@@B@@@@@BB@@B@@AB@@@B@@@@AB@D@BA@A@@@@B@BBBBBB@@B@@@@@AB@@@@BBB@@@DC@AB@BC@ABA@@BA@@BA@@B@BABAD@@@D@@@B@@@BAB@@@BABA@BB@@DB@B@B@D@D@B@@@@@@B@@@@@B@ABBD@@A@@DBB@@@B@@BBBB@BBBADA@@BAB@@@BDB@@BB@@B@@D@B@B@BA@BBA@@@@B@@@B@BB@A@@@ABA@@B@@AAA@@B@@@BB@@B@BB@@BA@@@ABA@@@@B@B@B@@@@@BAD@@@@@@B@@@B@B@@BABB@@BB@BDBB@@@@@B@@@@A@@@@BB@@@@B@DA@@B@BB@@@@@B@@B@@@@@@@BBB@@@B@@@BA@@B@B@@@@@@@B@@@BB@@B@ABB@B@@AB@BB@@@@B@@@@@@@B@@@@B@B@@@@A@@B@@BB@@@BABBBAB@BC@AA@@ABAAA@AB@@@B@@AB@@@@@B@@@BA@@B@@@@@@AB@BBB@B@@@@AB@@A@@@@@AA@@AA@@@BABA@AAC@C@@B@@@BABBB@B@@AB@@BB@BBB@B@@@BBBBBB@@@BBBB@@BBBB@@DB@@@@@A@A@@BA@@@A@@@@@A@A@@@A@@@ABA@@BABC@@@@B@@@B@@BDBB@B@@B@@@@@@@BA@@@@@A@@@@@@B@@@@B@B@BABA@@@@@@AA@@@@@@@A@@B@@@BBB@@@BA@@B@BABABDDBBBBB@@BADBBAB@BA@ABA@A@@BA@@BA@@B@BA@@@@@@BCAA@@@A@@AA@A@@@@@@D@@AA@A@@@@@AA@@@@AA@@@B@@A@@@@@@@@@@A@@@@@@@AA@@B@A@@@A@@@A@@@A@ACB@AA@@ABAA@@@@@A@@@@@@@@A@@A@@@A@@@A@@AA@A@@AAA@A@A@@@AB@BB@@BBB@@@@ABA@@A@@@@@B@@@@@B@@B@@@@B@@@@@B@@@@A@@@A@@A@@@A@@@@A@@@A@@@@@@B@@@B@@@@@B@@BB@@@B@@B@@B@B@B@@B@@B@B@@@@A@A@CBA@@B@@@@BB@@@@@B@BB@@@@@@@B@@@@B@@@@B@@@@B@@@AB@@B@@@B@@@@@@B@@@@@@BB@@@@BA@@@AB@@@B@@@@BB@@AB@@@@@@@BBB@B@@@@@@B@B@@B@BB@@B@@A@@@@@AB@@@AA@@@A@@@@B@@@B@@@@A@A@@B@@@@@B@@@BA@BB@B@@@@@BBA@@BB@BBBA@BB@@B@@@B@@@@B@BB@@@@@@BA@A@@@@@AA@@A@@@@A@@@A@@@@A@@@A@@@@A@BA@@@@@@@@@A@@B@@@@A@C@A@@@@@@@@@AA@@@BA@@BABAB@@ABBB@@B@@BB@BB@B@@@@B@BB@@@@@B@B@DBB@@@@@BA@AB@@@@@BBB@@@D@@@@BB@BABAB@B@@A@@@A@@@A@@B@@@B@@A@@@A@@@@@ABA@@DEB@@@BA@@@AB@BA@@@@BA@A@AAG@ABCBA@@@@@AB@@B@@BBBBB@BBBBB@@@@BB@B@@BDD@@@B@@@@B@BB@@B@B@BB@@BBBBB@@B@@B@B@@BB@@@DFBB@@BB@@@BB@B@BB@@B@BD@@B@@@@B@@BB@@@BDAB@@@B@BA@@B@@@BABA@@B@@@BB@@B@@@B@@@B@@@B@@B@@@B@@B@B@@@B@B@@@B@@@BB@@BA@B@@@B@@@B@BB@@B@B@@@BBB@@@B@@@BBBB@@DA@A@@@@BA@A@@B@@@B@@ABAB@@@@AB@@@@@BABAAA@AB@@@@ABA@AA@BA@A@BBCBAB@B@@@@@B@@@@A@A@A@@@BB@@A@@B@@@BA@@@@@@@@@AB@@A@C@@@A@AB@@@@A@@@@@@B@@AB@@@@BBB@@BB@@B@B@@ABA@A@@@BB@@@B@B@D@BB@A@ABABAD@B@@@B@D@@B@BBB@AB@B@@@BBBABBB@@AB@@B@@@B@@B@@@@BBB@@B@AB@B@@BBABBBA@@DBB@BB@BA@ABB@BD@@B@DB@@@@@B@@@B@@@@@B@@@@@B@@@B@D@@@BB@B@DBB@@@@@B@@B@@@@DB@@@BBB@@@BB@@D@@BAB@B@B@B@@B@B@B@@@B@B@@@@@BBB@@B@BB@@@B@@@DA@@@@BBB@@@B@@@BB@@B@@B@@@BA@BB@@@B@B@BA@@B@@B@@@@@B@BBBBB@@BA@@B@@B@@BB@@@D@@@@BB@@@@ADBB@@@@BB@A@A@ABBB@B@@@@BB@@B@@@@@@@B@@@@@@@@@@B@@@D@@B@@AB@@@@@@BD@B@@DA@@BA@@B@@@BB@@@B@B@B@BB@@@B@@@@AB@@AB@@AB@@@B@@B@@B@@B@@@@BDB@@A@A@@@@B@@@@@@@B@@AB@@@AAB@@@B@@@B@@@BBB@@@B@@@B@@@@@BBB@ABB@B@@B@@B@@B@@@B@BBB@@@@ABBB@@B@@C@@@@B@@@@B@@BBB@@B@BB@BB@@@A@@FB@@@@B@@@B@@@@@B@@AB@B@B@@@@ABAB@@A@@@AAA@AB@@@BAB@@B@@@@B@B@@@B@B@@@BA@@@AB@@AB@BA@C@@B@BAD@@B@BB@@@BB@@BB@BBB@@@B@B@@BBB@B@@AB@@BB@@B@@@@BA@@BA@@@@BC@@BABA@@BDB@B@@@B@@@B@@BB@@B@@D@@@B@@@DB@C@ADA@B@@B@@@@@B@@@B@@@B@@@@AB@@@@@B@BB@@@@B@@B@@B@@AB@B@@AA@@AAC@@@A@A@A@@@A@@@A@@A@@@@A@AA@@@@A@AA@@AB@@A@A@@B@@@@@@A@A@A@@BA@@@@BA@@@@BA@@@@@@BBBB@@@@@@@A@@@@B@@@@@B@@ABBB@B@@D@@BBABAF@BBD@DB@D@@@ABABA@AB@@A@@B@@B@B@B@@@@@@B@@BDBDBAD@@@BB@@B@B@@@@A@A@A@@B@@B@B@BB@B@BABAFABB@BB@DB@@BBBA@@@BBBDB@BB@@@B@@B@@@@AB@@@@@B@@@@B@BBB@B@AD@B@BB@@AB@B@@@@BB@BBDBBBB@DBB@BBABBB@B@@@@BB@@B@@ABA@@@@B@@@@@B@B@@@AAB@@@BAB@@A@@BA@@@@B@B@@@BA@ABBB@@@BAB@B@@A@@@AB@B@BAB@@ABAB@BAB@DA@@BA@@@@BA@@DABA@A@@A@@A@@@C@A@A@A@@@@@A@AA@@A@@@@@AA@BAA@@@AA@A@@@A@A@@A@AA@@A@@A@@@@@@@A@@@C@ABA@EA@@C@AA@@BA@A@AB@@A@A@@BA@@@A@@@@@A@@@@@@@AB@@A@@@AA@@@@A@AB@@A@@@@A@AB@@@@@@A@@B@@@@@BA@@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@AB@@AB@@A@A@@BA@@B@F@@@@A@@CA@@BA@@D@B@B@@A@@B@@B@@B@B@@@B@BABA@@BABCF@@A@EDA@@B@@@D@@@@AB@B@@@AB@@B@BABBB@BABAB@DBB@DABBDA@ABAD@@@BAD@DGBABA@ABA@@AA@CABA@@@@B@@A@@AAAAC@AC@@CAA@@B@BAAADAD@F@DB@@F@B@BBF@ACHCBA@CC@AC@@D@@A@@B@@@@@B@@AB@BBB@@@BAB@@@B@@@B@@@B@@@@@@A@@B@A@@A@AAA@@@@A@@A@@AAABAA@@A@@AA@A@AA@@@@A@@BAAA@@ABA@A@@AA@@AAA@A@@@CA@@AA@@AB@@CC@BAAAA@@A@@@AAA@@@AA@@@AA@A@A@@AB@@A@A@@AA@@@AA@@CAA@AAABA@A@@@A@A@@A@AA@A@@@A@AA@@@AC@AACC@AAC@AAA@@A@@@A@A@@@A@A@@@@@@AA@AAA@@@AA@@A@BA@@@A@@B@AA@@@@@@@AAA@@BABCAAA@BA@@@A@A@@C@@AAA@CA@@@C@A@@B@@AA@@@BE@@AABA@A@A@A@@@A@@@@BA@@@@BC@@@A@@@A@A@@A@A@@A@@A@@@@@A@@@A@A@@@@@@@@A@@A@@@@AA@@A@@@@A@@@AA@@A@@@@A@@@@@AA@@A@A@@@A@@@@AA@@@@@A@@@@BA@@@@B@@A@@A@@@BAAA@@@A@@CB@@C@@@A@C@AA@@@@A@@BA@@@A@@@A@@@@@A@@@@@CA@@@A@AA@@C@@@CA@AA@A@AAA@B@A@@ACAACA@CA@@A@@AA@@@@@@A@AA@CB@BABB@ABAB@BA@GCC@CB@@ABCAA@@CCA@@ABEDBDCB@@A@@B@@ACCA@@A@@B@@A@A@@@@@ABA@@@A@C@@@A@A@AA@@B@@ABAABA@ABA@A@@@A@@B@A@@ADBAABCCEBABABBB@@@ACBA@@A@@B@B@@A@@@A@@@B@@A@@A@C@AC@EAAA@BDABG@CBA@@AEDADA@@@ABA@CDEBABEAGG@AA@ABA@@@A@ABAB@@@@CC@A@@@AAAAAA@@@AC@@@AAABAAABAAABAAAAEB@@ABABB@@@BBBBA@AAAAAB@BAB@@A@A@AC@@A@EA@@CA@CEABCABC@@A@A@@A@@AABA@@AAC@@@A@@@@@A@@@@@A@@@A@@BA@@@@@A@@@AB@@@@A@@AA@@AAB@AABA@@@A@A@@@A@@@AB@B@@@@A@@@@@C@@@A@@@@@AAAA@@@AAA@@BAAAAAB@@AA@@AA@A@@A@@@ABAAA@@@@@BAB@@@BA@@A@@@B@@@@@AA@@@@@@@A@@A@@@AB@@@BA@@AA@AA@@A@@@@AA@@AA@@BACBAB@@A@@@AB@@@@AA@@B@AAA@@AB@@A@@@A@@@@AAB@BA@A@@BA@@@@@A@A@@@A@@BA@C@@@@@@@AA@@@A@AA@@@@@C@@AA@@ADAB@A@@@@@@@A@@@@@@A@@@@@A@@AA@@A@@B@@@BA@@@@B@@@@AA@BAA@B@AAA@@@@@@B@@@@A@@@@@A@@@@@@@@A@AA@@@@A@@A@@AAAA@@A@AB@@BBBB@DB@@BA@@@@BAAAA@A@@A@A@@@A@@@@A@@@@AA@@AB@@A@@@A@AA@@@@@CBA@@@@@A@ACAA@@A@@D@@A@A@@@A@A@@@@AACAC@@@A@A@@AC@AA@@@AAA@C@@@@@@@@A@A@@BA@@@@@A@@A@@@@@AB@B@@A@@@@@AA@@@B@@CDADAB@@@@AB@@@@@A@ABAAA@@AB@CA@@@@@A@@BA@@@A@@A@@@A@@@@@A@A@@@@@@A@A@@@@A@@BA@@A@A@@A@@A@@@@A@@A@A@@AAA@@@@C@AA@@C@@AB@AA@AADAC@A@AA@@@A@A@@@@AA@@AA@AA@@B@@@BA@@@B@@@@@@BBB@@@@BB@@A@@@A@@AADA@@B@@@BABA@@@B@@@AB@@@@@@AA@@@@@AA@@@@@@@@A@@A@@@A@@B@@@@AA@AA@@@@B@@@BA@@@BBB@A@@@@@AA@@A@@A@@@@@A@@A@@@@@@BA@@AAA@@@@@@B@@@BA@@@A@AA@@A@A@A@A@@@@A@@@@AAB@@@@@B@BA@@A@AA@@A@@A@@A@@BC@A@@@@A@@DA@@@@BABAA@@AA@@@AA@@B@@A@AA@@@A@@@@@@@A@AA@AB@@@@AB@@@AA@@AA@@A@A@A@@@@BA@@BA@@@@A@@@A@@@@@@@@AA@@@A@@A@@@ABAAAA@@A@@@A@AB@@@@A@@AA@@AB@@@BA@@@A@A@@@@@AB@@@BBBBBB@A@A@AB@B@@BB@@CBA@@@A@@A@A@@@C@@@A@@@A@A@@@@A@@@AC@@A@@@AA@@@@@A@A@A@@@@@A@AA@@A@@BAB@@CB@@@@AA@@@A@@@@@A@A@@@@A@A@A@@A@@@A@@@A@@B@@AB@AA@@@AD@@@@@@A@@@AAA@@B@B@@@@@@@@@@BB@B@B@B@@@@B@B@@@B@BBBABB@B@AA@A@@BB@@@AB@@A@@AC@@@A@A@@A@AA@@CA@@@A@@@@@A@@AA@@@A@@A@@@A@@@@B@@CDA@A@@@A@@A@@@@@@@@@A@AAA@A@A@@A@A@@ACA@A@AA@@A@A@A@@A@@@CB@@AA@AA@@@A@@@@@@@A@@@@@AB@@@BABAA@AA@DCBAA@AAA@@A@@@BA@AC@@A@@@@@A@A@AAEC@@@@BA@@B@@@@@@A@@BA@@B@@@AA@@A@AA@@A@@@@@A@@BC@@AA@@BAB@@@@A@@AA@A@@@AA@@@AAA@@AB@@@@@B@BA@@@@@@@AA@@@A@@@@BA@@B@@@@@@AAA@A@@B@@@@@BAAA@@@A@@@@A@AA@@@A@@@AB@@@B@@A@@@A@A@ABABAB@@AA@AA@@BA@@B@B@@AB@@@AA@CAA@@@@AA@@A@CB@@A@AA@AAC@E@@@A@@A@@@@BA@AD@@@DA@@@@@A@AC@AAA@@A@EDCDA@@@AAA@AA@@A@E@@@AAA@@@@AA@AEAA@ABIB@@@@AB@@@BB@@B@@BBBB@B@@@B@D@FBD@@@BABC@AA@AAA@@ABC@ABAB@@@B@BBB@BA@@@ABAAC@C@AAABG@G@@HAB@DDB@D@BABABABCBGDEHC@@@CBCBABCBA@A@E@CCC@A@@HCBADA@A@@C@@@C@ABAA@@@ABC@CBAB@AAAACCAAE@@@AA@@FCBA@AA@ACCEEAAA@ABADEBAAA@AAA@C@A@@@A@@D@BA@@@@AAA@@@A@AA@@A@AA@@@A@@@CBC@@@AABABA@A@AAA@BA@A@ABAAA@AAA@AAAA@A@A@@@A@@ABABA@@B@B@@A@@@AAAA@@@@A@@@AB@@@BAB@@@BA@@@A@AA@@C@A@A@A"],encodeOffsets:[[111888,22549]]}},{type:"Feature",id:"450722",properties:{name:"浦北县",cp:[109.556953,22.271651],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@A@AB@BA@@@A@A@ABAAB@AA@ABAA@A@@A@@A@A@A@@@@D@BA@BB@@A@@B@@@B@BA@@@ABC@BBA@AB@B@@ABA@A@@BABCAA@A@@@AB@@@BA@A@@@AB@@@BCJC@@@@B@@ADA@A@ABABA@C@A@@@A@A@AB@@@@ABAB@@CB@B@@@BDB@B@B@D@B@@HD@B@@ABAB@@ABA@@BA@@@CBCB@B@@AB@@ABAB@BBB@@A@@B@@AB@@@@@B@@@@AB@BA@AB@@AA@AA@@@@AA@A@@@@A@@@A@@A@@@@A@@@@B@@A@@@@@@B@@@B@@@@@@A@@AA@AC@@@@A@A@@@@@@AA@@BA@@@AB@@@@AA@@@A@@AB@@AAA@@ACB@@ABABAAAAAAAC@@A@@A@@@CB@BA@@AA@@@A@EBABCBCB@@@BA@@@AB@@ABAA@@@AAA@AA@A@@A@ABCAA@@@A@A@C@A@@B@A@@AA@@@A@A@@@AAA@@@A@@@A@B@@A@AAAA@@BAB@AA@@@@BA@A@@@AA@@@@@B@@A@A@@B@@CA@@A@@@A@@A@ABA@@B@@A@A@@AA@@@@@@@AA@@A@@@C@@@@@@B@@AB@@A@@@AA@@@A@A@C@@@@BA@@BA@@@ABA@@@@BA@A@@@@B@@@@AB@@ABAA@@AA@@ABA@CB@@A@A@@@@A@@BA@@BB@@B@@A@A@@@@BA@@@@AC@AAAAC@@AB@@@@@A@@@A@@A@@A@@@@A@@@@@@@@AAA@A@A@@AA@@@@B@@A@@@@AA@A@@@@@A@A@@BA@@B@@@@@@@@AB@@@B@BA@@@@B@@@@A@@B@@A@@@@@@@A@AA@@ABA@@B@@@D@@@@@B@@@BA@@@A@BBA@@@@@@@A@A@A@@B@BA@@@@@A@@@@BA@@B@@@@@@AB@@@@@@@@@@@@@A@@AA@@@@@@@@@@A@@A@@@@B@@@AA@@@@@@@AA@@@@@@AA@@AB@@@@@@@BA@@@A@@B@@@@A@@@@B@@@@AB@@@@A@@@@BA@@@@@@@@@@@A@@@@@@@@@@@AB@@@@@@@B@@@@A@@@@B@@@@AB@A@@@@@B@@A@@B@@@@@B@@@@@@ABA@@BA@@@@BA@A@@@@@@@@@A@@@@@@B@@@@@@AB@@@@@@@@@@@@AB@@@@A@C@A@@@@@@@@B@@@@@@@@@@A@@BA@@B@@@BA@@@@@@@A@@@A@@@@@@@A@A@A@@@AA@@A@@@AA@@@@@@A@A@@@A@@@@@A@@@@AA@@@A@@@@@AA@@@@@@@@@@@@@@@AA@@@A@@@@@@@@A@@@BAA@@@@A@AAAC@@@@CA@@@@A@@@AB@@A@A@A@@@@@A@@@@A@@@@@@@@AA@@A@@@@A@@@@AA@@@@A@@@@@@@A@A@@@AB@@A@@@@@A@@A@@@@AA@@@@A@@@A@@@@@A@@@@A@@@@@@A@@A@@A@@AA@@@@@@@@@A@@@@B@@@@@@@@@@A@@@A@@@AB@@@@@@AA@@@BAA@B@@@@@@@@@B@@@@A@A@@@A@@B@@A@@@@@AA@@@@@AA@@A@@@@BC@@BA@@A@@@@A@@AA@@@@@@@A@A@A@A@@@@@A@AB@@@@@B@@@B@B@B@@AB@@@BA@@@@@@A@A@@@@@AA@@A@@@A@A@@@@@@@A@@@AAA@@@@@@A@@@@A@@C@@@AA@@@@BA@@AAB@A@@@@A@@@@@@A@@A@A@@@AA@@@@@@B@B@B@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@A@@@@A@@A@@@@@@@A@@@@@@@@BB@@@@B@@@@@B@@@@@@B@A@@@@@@@@B@@@@@BAA@@@@@@@A@@@AAA@A@@@@@A@@AA@@@A@@A@@@@@@@A@@B@@@@@@A@AAA@@@A@@@AB@@@AA@@@A@@@@@AA@@@@@@@BA@@@@@@@A@@B@@@@@@@@A@@AA@@@@@@@@AA@@BAA@@@A@@@@@@A@@AA@@@B@@@@A@@@@@@AA@B@@@@@@A@@@@@@@@@A@@B@@@@@BA@@@@@@@@@A@@@@@@BA@@B@@@@@@@B@@@BA@@@A@@@A@@B@@AB@@@@@@A@@@A@@@@@C@@A@@A@A@@@A@@@@A@A@@A@@@@@@@@AA@@@@A@@A@@@@@@AA@@@A@@@@A@@A@@@AA@@A@@@@@A@@@@@A@@B@@@@AB@@@@@B@@@@@@A@@BA@@@@@AB@@@@A@@@@@@@AB@@A@@B@@@@@BA@@@@@A@@A@@A@@@@A@@A@@@@@@@@ABA@@@@@A@@@@B@@@@A@AB@@@A@@B@@@@AA@@@@@A@@@@@A@@@A@@@@@A@@@BA@@@A@AB@@A@@@A@@@@@@@A@@BA@@BA@@BA@@@@@@@@B@@A@@BAB@@@BA@@@@@A@@@@A@A@A@@@@@@CAA@@@@A@@@@A@@@@B@@@@@@@BA@@@@A@@A@@A@@@A@@@@@@A@@@@@@@A@@@@@A@@A@@@A@@@@A@@@A@@@@BA@@B@@@@A@@A@@@@A@@B@@A@@@@@A@@A@AA@A@A@A@@@AA@@A@AA@@@A@@@@@@A@@@@C@@A@@A@@@AAA@@@@A@@@@@A@@@AA@@@ABA@AA@BA@A@@@A@@@@DA@@@@@@@@@@@@@A@@@@@@@AB@@@@@BAA@@@@@AA@@@A@A@@@AA@A@A@@@ABA@@B@@A@@A@A@@@AABA@@D@@@B@AC@C@CA@@AA@@ABC@A@@@A@AA@@A@A@@B@@A@@A@@@A@AA@@@A@@@AB@@A@A@@ACAAA@A@@@AA@@@@B@BA@@@AB@@A@@@@AA@@@@@AA@@@@@A@A@@@@A@@@@@A@@@@A@@@@A@@@ABA@@DA@@@@@AAA@@A@A@A@@A@@BA@@@@@A@@@A@@BACA@@@A@@BC@@BA@CBA@@BABA@AD@@AB@BEB@@A@@A@ACAAAAAAACAAAA@@CAA@@@@A@A@@@@DA@AB@@A@@@AA@@AA@A@CBA@@@BKHCACEEFADADAFCBAB@BABIA@BCHG@ABA@@BAB@B@BA@GJBDCJB@@BGU]NOH@FQFQ@ABC@A@EBC@AB@FKBSB@@ABE@A@C@A@E@ABA@A@@@A@@A@@AAA@@@A@A@ABCBC@A@A@A@AAA@@AA@@A@ABE@A@AB@@CFCDI@ECAE@@@ABABEBABC@@BCBE@CBCBABABAF@DAB@B@FAB@BAB@DA@ADE@@@@@A@@AA@A@@@@@AA@CC@AA@@AA@@A@A@ABGAKAEB@B@@B@@@@B@BBB@@BDBB@@@@B@B@@@@@@@BA@@@BBB@@@@B@B@BBB@@B@@@DC@@@A@A@A@C@@BA@@@ABA@AAE@A@@@AB@@A@A@AA@@@@C@A@@@AQ@@B@@@D@@C@C@AD@@CAICEBGBAA@@B@AA@C@@@@@@DBD@BA@@@@@AB@@@@AA@@AA@A@@A@@@A@AA@@@A@A@@@@A@@@@@@@AA@@@A@@@@AAA@A@@@@AAA@A@A@AAA@A@AAA@A@@AABAB@B@@AAEA@@A@AA@@A@@BA@@BA@@B@@CB@@A@A@@@@A@@@@AA@@A@AA@@@@A@@@BD@@A@@BAA@@A@@@@B@@AB@@A@A@A@A@@B@@@@@BAB@BAA@@AA@@@@@A@@A@@@BC@@AAA@@@@AA@@AB@ACAB@AB@@A@AA@@AA@@AA@@AA@@B@@A@A@@@@@A@A@DC@EACEKCGEBCEKHMDAFA@A@E@C@EB@@A@CBGHAFAFADBFCHGFA@A@A@EA@@@@ACAE@GEIOCMHIF@@@@BB@BA@EA@@ABCBAB@@BB@@AB@@ABBBBBA@AB@B@@@@@B@@@@B@@@B@A@CB@B@@@B@BB@@@@B@DB@@@@B@@@B@@CBAB@@@BA@A@@B@@@@A@@AC@@DA@A@A@@@A@@@A@A@AAAA@@AD@@@@AAAC@A@@@A@@@A@A@@A@A@A@@A@@A@ABA@@@AA@@BA@A@@@@@A@@AA@@@@AAA@@A@@@@@@@@@@A@@@AA@BA@@@@B@@BA@DA@@@A@@@A@@@@AA@@B@@@@AB@@@BAAAA@@A@@@AB@@ABA@@A@@A@@@@@AB@@AB@@A@@@@@@@AAA@@@ABABA@@@@@A@A@AA@@@@AA@@@A@AA@BA@AB@@@A@@A@@@AB@AC@@BA@@B@@@AA@@@@@A@A@@@@CB@@@C@@@A@A@@@@@A@A@@BA@@@@@AAA@@@BI@@AA@@BBBDFGDGDGAGBEAG@@A@AA@BAAA@A@@A@BAA@A@CB@B@@@@BB@B@@@BC@A@@@C@@@ABABABA@A@@@@@@BA@AB@@@@ABAB@@@@@B@B@@@B@@A@@@AAA@@@A@@@@B@@A@AB@B@@AB@@C@A@AAA@@@A@A@@@@A@@@AAA@A@@@AA@A@@@A@@A@@@AA@A@@@AAA@@B@BAB@@U@@@@B@A@@KDE@@@@@C@@@@@GAAHAB@@B@@BBDDDB@B@BB@@ABE@CBAD@B@BBBHABBBBBB@F@@@@ABEAA@ABAB@@@D@B@DAD@@@BBB@@BBAB@@EB@@@BBB@@AB@@GDA@@DABAB@BBBABBD@B@@A@A@CA@@A@AB@@@BBF@BBBAB@@@BA@@@A@AB@@@B@BB@B@@@@@@@B@@@@@@B@@@@AA@@AB@@FB@BBB@@AD@@@@A@A@@ACC@@A@A@C@ABA@@B@BBB@B@BAD@@BBCDB@@@@B@@@@@@@B@BBDBBBDD@@BB@@DEDADABAB@BB@B@B@B@@B@BCBCBCBABCBA@C@A@AB@B@@BBAB@@@@@@@@ABAB@BB@@B@@B@BBB@B@B@BA@BB@@BA@@@@BABC@@@ABABAD@B@B@B@B@DB@@B@B@@AB@@A@AB@@A@@B@@@B@@B@BB@B@@@BA@A@@@ABAB@BB@@@B@B@B@BB@BBB@BBBAB@B@BABB@BBB@B@BABA@B@@AD@D@@@B@@BBB@@@BBB@@@B@BB@@@@ABC@@@@B@@@B@DBB@BBBABCFAB@BBBFBDFBDB@@BABED@@BB@@F@BBDDBBBBA@AB@DAD@B@@BBBAD@@@D@@@@BCBABGD@@@BDD@D@F@BABADABAD@D@@GDCFAHADABABABC@A@CCA@GB@@@HAHBB@B@DBDAB@B@@ABA@AAA@@@A@AB@BAD@BB@BBB@@BADAB@@C@EAC@A@@@A@A@AA@AA@@@AA@@A@@B@@A@AJ@BBBBFB@@B@@B@BB@@F@B@@@BBB@BB@@B@DCFCB@@@BB@BBDB@@@@@B@@C@@BCB@@A@@B@@@@B@@@FBD@BBBB@@@DAB@@@BB@@@@BB@DBB@@A@@BA@A@@@AB@@BBB@@BA@ABAB@B@B@B@@@BA@@@A@@B@@@B@@BBB@@@@@@B@@BBAB@@@@A@@@@BBB@B@@@@A@@@AB@@@@@B@@BB@@@@@@B@@A@A@@@@BA@@BB@B@@BB@@B@B@@BB@@@@@BA@AB@@BD@@AB@@@@@B@@@BBB@@@BB@@A@@@AB@@@B@@@@A@@@AB@@@@FDBBB@B@@@@@B@@@BDB@@A@@@BB@BBB@ABCDB@@BBBBA@A@@BA@@@@B@@@@@@@B@@@B@@BBB@@DA@@B@@@@B@B@BB@@B@BDB@BB@B@@@@B@BBB@B@B@@@@@@@@@BB@@@B@B@DC@@@A@@B@@@B@@@@B@@BB@@@B@@@@@B@@DB@@BBB@@@@B@B@@BD@@@BA@@B@@AA@@@BBBA@A@BAAA@A@A@@@A@A@@A@A@A@A@@A@@@@@@@@A@A@@@BB@B@@@B@@@@C@@B@@BBA@@BA@@@@B@@@B@@@BB@B@B@@@@@@B@B@@@@@B@@BB@@@@DA@@BA@AB@@@BBB@@@@@B@B@B@@@@@B@@B@@@BD@@B@@@B@@B@B@@@B@@@D@@@B@B@@@@B@@AB@DA@@AA@A@@B@B@BAAAAAA@@A@@B@@@@@B@B@@AB@@A@@BB@@BB@@@@@BAB@@@B@@@BBBBBA@@B@@@@B@@BB@@@@@@@@@B@@@B@@B@@AB@@A@@B@B@B@@@BB@@BB@@A@@B@@A@@BBBB@@@@@@@B@@@B@@B@BA@@@BB@@B@@BB@ABAB@@@@CB@@@B@@B@D@@AB@@@@BB@@@BBB@@@ABA@@@@@A@BB@@@@@B@@B@B@B@B@@@BBB@@@B@@A@@@A@@@@B@BB@@AB@@@@@@@BB@@@@@B@@@@BB@@B@@@@@BAA@A@@AB@@A@@@@@BBB@@B@@A@@@@B@@@BB@@@@@@@@@BB@@@@B@@B@@@@A@@B@@@A@@ABAB@@A@@@CBBB@@@B@@@BA@@A@@A@AA@@@@@@@A@@AB@@A@@@BBB@@BB@@B@@B@B@@@B@@B@BBDBC@BBBA@@BD@@@BBD@@@@@BB@BB@B@@@@B@@B@@@@BB@B@@@AB@@@B@@B@B@@@@@@@@B@B@@@@@B@@@BB@@@B@@AB@@@@@B@@DBA@@BBAB@B@B@@@@BA@@@@BABCDC@@@A@@BB@@@@B@@@@ABA@@@@B@@@@B@@@@AB@@@B@B@@@@@@D@B@BB@@B@@BBD@@@B@B@@BDBD@B@@B@B@@@B@B@@@@CB@@@BBBDB@@@@@B@DA@@@@B@@B@B@@@BA@@BB@@B@@B@@@@@@B@@@B@BB@B@BBAB@@@@AB@@CAA@AA@AA@@B@BB@BB@BB@@@@B@@B@@B@B@@@@@@B@@@@@B@@@@@@A@@@@B@BBA@B@ABB@@B@@A@@@@@AB@@A@@@@BB@@BB@@@@@B@@@@@@@@B@@@@@@@BBABC@@BB@@@D@@@@BBB@@@B@@B@@@@@@@DAB@@@B@@@B@B@@@@AB@@@BABA@BB@@@@@B@@@BA@@BB@BBA@@@BB@@@@BA@@B@@@BADAAB@@BB@@BB@@@@@BB@@BBB@@AB@@A@@B@@@BB@@@@@@@B@@B@@@@@A@@@BB@@A@@BA@A@@@@BBAB@B@@@BB@B@@BB@@BA@BBBBAB@@BB@B@@BBBB@@@@B@@@D@@@@@B@@@@@@ABA@@B@@@B@B@@@B@BA@BBA@BB@@BB@@@@@BA@@B@@@@@B@@AB@@@B@@@@@B@@@@@B@@@D@BB@@ABBB@@@BB@B@@@ADDBBADFB@@DB@@F@BD@@B@B@BA@ABA@BBBB@BABAA@A@@AAAB@BA@BFBBABBBABBBABBB@B@@BD@@B@BBBB@B@@@BDD@@@@BABAB@@@B@BAB@@BHHFBBAFADCB@BA@@B@BCFC@BB@DAH@BAACB@BB@FBDD@B@@@@BA@@@B@@@B@@@@A@AB@@@ABBD@@A@AAABABDFADBBCA@BB@A@@@@B@@@BAB@BABBBBA@@@AB@@B@B@B@@@D@B@@AB@B@@@@@B@BA@@@@BB@DD@BA@@@@BA@CDCAAF@BB@DD@@BBAD@BA@@DDD@HABA@AB@BAAABA@@DBBB@@@@@@@BB@@@BB@@DDBBBBD@@@B@ABB@B@BBBB@@D@@@DB@@B@B@@DB@@@@B@@@@@B@@@B@@@B@@AB@@@@@BBD@B@@@D@@@DA@@@B@@BBAB@@B@@@@BA@@@@@AB@@@@@B@@@@BB@@@@@B@@@B@BB@@B@@@@@B@@B@@@BB@@B@@@@@@BB@@B@@B@@@@B@@@@@@B@B@@@B@@@@@B@@@@BB@B@@@@B@B@@@B@@AD@@@@AB@@@@@B@@@B@B@BABBB@@AF@@B@@BA@@@@B@D@@DBB@BB@@@DB@B@@@B@@ABBDBBA@AB@BB@@@@@@B@@B@AB@@@B@@A@BB@@B@@BB@BBB@@@@@@@B@B@@@B@B@@@BB@BBD@BBD@BD@BBD@@B@@B@B@@@BBBB@@@@B@B@@@BABBB@BBB@DB@@B@@BB@@@B@BA@@BB@B@B@@B@@BB@@B@BB@@B@@@BBBB@ADD@@BA@@BB@@DB@@B@B@BB@@BB@@@BAB@BB@BB@AB@@@@@BBB@B@@BB@@@BBBABB@@@BB@@@@@BB@B@BB@A@@@@B@@@@A@@@A@@@A@@@A@AB@@A@AAA@@BA@@@@@A@@@@BC@@@BDD@@DABGDBDE@AAA@E@@@CAE@C@CBBBABA@@@BB@DD@@BBDBB@BB@@@@A@@B@BA@DBB@@AB@BABABCHC@AB@@C@AB@BCBAACBA@CAA@ABABA@AAABA@@@BA@@A@A@@B@@C@@@A@@@CB@F@BE@ADAB@@ABABA@@@A@A@@@@AA@@@@BA@A@C@@@AB@@DB@@@B@@E@A@@@AB@@@B@BA@@BA@@B@B@@@@@@@@@B@@@@@@@@@B@@@@@@@@AB@@@@A@@@@B@@@@A@@B@B@@B@@@BAB@@@@@BB@@B@@@BA@@@@@@B@@@@@B@@@B@@AB@B@@@BAB@B@@AB@@B@DB@@FAB@B@D@@@B@@@@@@@BB@@@BBB@@@@B@B@@@BBB@@B@ABB@@B@@@@@BB@@B@B@@@@@B@B@B@D@@@BB@@@@BABCB@@AB@@@@ABBA@@@BBDBB@B@BDB@AB@BB@ABB@@DBB@BBD@B@BBBBB@BBBB@@B@B@D@B@@@BBB@B@B@B@@@@AB@@@@@@@@@B@@@@@@B@@@@B@@ABB@@@BB@BA@@@B@@@B@@@@@B@@BA@@@@@B@B@@@A@ABB@@@@B@@@B@@BAB@@@B@ABBB@@@@@@BA@B@@@@B@@@B@@@@@BB@@@FC@A@@BB@BBAB@@A@@B@@AB@@B@@@@BB@@BAB@@@DFA@@@@B@B@@@D@B@B@@BB@@BB@BBBB@D@@@@BBB@@BBB@@@@B@BA@@B@BB@B@BD@@B@@BB@@@@B@BB@B@@@B@B@@AB@@@B@@@BAB@@@BAB@@@B@B@@@B@B@@@DB@@BAB@B@@AB@@AB@@@BC@@@@BA@@B@B@BBB@@AB@@@BABBBA@AB@@@B@@AB@@A@@@@@@AA@A@@@A@@@AA@@A@A@AB@B@@BDAB@B@@@B@BA@A@@@ABADC@@@A@@@ABC@@@@@@@@B@@@B@@BB@ABB@@@BBB@@@BB@@BBB@B@@@B@B@@BB@B@@BBA@@B@D@@BDA@BB@@@F@B@@B@@@B@@@BABAB@@@BB@@@BB@@@@B@@@B@@@@@@@A@@BB@@@@@@@@BBA@@@A@@@@@B@@B@BA@@@@B@@@BAB@B@BAB@@AB@B@@@@@BA@AECBA@C@A@A@AAA@A@A@@B@BAB@BBB@@@B@@@@@@AB@@@@A@AB@@@BAA@@A@@@@@@@@A@AA@A@A@A@@AA@B@@@B@@@B@BA@A@@@A@A@@AA@@AAA@@BC@@CC@AB@BC@@DADAD@D@B@B@@ACAAAAECA@ABC@ACA@AFIJC@@@@@@B@@A@@@AA@@@GAA@@AAA@A@A@@BA@@F@BAB@@AB@AC@A@@@A@@@@DA@@B@B@@@@BBB@B@@B@B@@@BA@@@ABA@@@@B@BBBBD@B@@AB@@@@ABA@@@@B@@@@@BC@AB@@@@A@@@@@ADABA@@B@B@@@B@@B@@@@B@BAB@@@@@AB@B@@B@@@@@BAB@@@@@B@@@@@@@BABAB@@CB@@@BAB@@AB@@@@A@@@A@@BA@ABA@@@@@@@A@@@@@@@AB@@@@@BBBBB@B@B@@@B@@@@@@@B@BA@@@A@@BB@AB@@@@A@A@A@AB@@CAA@AB@BAAEB@CEAA@A@AAA@A@AB@B@@@BB@@@@@@BA@@BAB@@BB@@B@@@BB@@@DABA@@BA@@@@BA@@B@@@B@@A@@@@BA@@BB@@B@B@@@B@B@@@B@@@B@@@D@@@@BB@BA@@@BB@BB@@@B@B@@B@@B@@B@@@B@@@@BB@@@@B@@DB@BB@@ABABAB@@AB@@A@A@@@@BAB@@@B@B@@AB@@@@@B@@BB@BB@@@@A@A@@BA@BB@@AB@B@@@B@@BB@@@BB@@@@@@B@A@@@B@@A@@@@@BA@@A@AA@@@@@@@BB@@B@BB@@@@@@B@B@ABBA@@@@@@@@@@B@@B@@B@B@AB@ABB@@BB@@BB@@@A@@@AA@@@@AA@@A@@@@@@B@@@BB@@@@@@@@BA@@BB@@@B@@B@@A@@@@@@A@@A@@@@@@@@A@@@A@@@@@AA@@@@@B@@@A@@@@@A@@@@BB@@B@@@BBB@@@BADBB@@A@@@@B@@@D@@@@B@@@B@B@@@@@B@@AB@@@B@BAB@@@B@@@@@@BB@B@@B@@@BBB@@@@@BB@BB@A@@@@@@BAB@@@B@BAB@@@B@@@B@@@B@@@@@B@B@B@@@B@@@@A@@@@@A@@@@@@BAA@@@BA@@@@BB@@BB@@B@BB@@@@B@@@B@@@B@@@@AB@@A@@B@B@B@B@@A@@DB@@B@@ABB@@BA@@B@@@B@@@B@@A@@DA@@B@B@B@@BBABBB@@@B@@@BA@@B@@ABC@A@@@@D@BA@@B@B@@@BA@@B@@@B@@@BAB@@@@@B@@@D@B@B@BAD@@@B@@@@@B@@@B@B@@@@A@A@@B@B@@A@@BC@@@C@A@@@AB@B@@A@@@@BA@@B@@@@AB@@@B@B@B@B@@@@AB@AABA@@B@BAB@BA@AA@@@A@@@@@@A@A@A@@@AA@@@@A@@@A@@@A@@@@@@A@@@A@@A@@@AB@@A@@BAB@@AAA@@@@AA@@@@@AB@@A@@BAB@@@@@@A@A@@B@@A@@@A@A@@BAB@@AB@@@@@BA@@@AB@@@@A@A@@@@A@@A@ABA@@A@A@@AA@@@@ABA@A@@@@@@B@@@@A@@@ABAB@DA@@B@B@@B@@B@@@BA@@@@@@@A@@@@AAA@@CACBC@A@@A@AA@@A@AA@@@BCBA@@AA@@@A@A@A@@@AA@@@AA@@@@A@@@A@C@AB@@A@@@@@A@AAA@@@@@ACA@@@AAAA@@AA@@@C@@@@AA@@A@AAA@A@CA@@ACA@@AA@@BC@@@@AAAAA@@@ABC@A@@@A@@@CEA@@@A@@@AB@AB@@@@A@@@BDA@@@@@@BAAA@@@@BAAAAA@@AAA@AA@@@@@@@@@@AA@@@@@@@AA@@AAA@@A@@@A@BABA@@@AA@@A@@@AA@@@@A@A@@@@@@@A@@@@BA@@B@@AB@@@@A@@A@A@@@@A@@A@@@AAA@AB@@AB@@A@ABA@@@A@@@@@A@A@@@@A@@@A@@@A@A@@B@@@B@@AB@@@@@@A@@@@AA@@A@@@@A@@@@@A@@A@@@@A@AA@@@@@@@A@@@A@@A@@A@@A@@@A@AA@@@AA@@@@@@AA@@@@@@@A@A@@@AA@@A@@BAB@BA@@@A@ABAAA@@B@@AB@@A@@B@BA@@BB@@B@@@@@B@@@B@@A@@@@@@@AB@@B@@@AB@@@B@@@@A@@B@@@@@D@@@B@@@@@@A@A@@B@@A@@@@BA@@@@@@@ADAAA@@B@@AB@@@@AB@@BB@B@B@B@BA@@B@@@@B@@B@@@BA@@B@@@B@@@BAB@@@B@@A@A@@B@@@@@@@B@@@B@@@@@BA@@B@B@@@@@@AB@@@BA@@@AB@@@@AB@@AB@@@BA@AB@AA@@A@A@A@@@@AA@BAAABAA@@@BA@A@CB@@AB@BABABA@@@@@A@@@A@@BA@@BA@@B@@A@@@AB@@A@@BA@AB@B@@@@A@@@A@@AA@@A@@@A@@@BA@AA@AAAAA@@@@@@@AAC@AB@@@AA@@@@A@@BAB@BA@@BA@@@@B@@@BC@@AA@@@A@A@@CA@AABA@@@A@A@AAA@CAAABCA@@@@@A@A@@@ABCAAAB@@A@A@@AAA@A@A@AA@@@@@@BA@A@@@ABCA@BCBBB@@@@AB@D@@@@B@@B@@A@@B@B@@@@@BAB@@AB@@@D@BA@@ACB@AA@AA@@@@CB@AA@AAAC@C@@@A@@A@@A@@A@A@AC@AA@@BA@CBA@ABA@AA@@@@AB@B@@AB@@@AA@@@A@@B@@A@A@@AABAA@A@ABA@A@@@CB@A@@A@A@AAA@@@A@C@@AABAA@@@@@ABA@@@ACA@@A@A@A@@@A@@@@A@AA@@@@@A@AAA@@B@@A@C@AB@@A@@@@AA@@AA@@@A@@@@@AAAB@@@AA@@@ABAB@@@@C@@BAB@@A@@BA@@@@BA@A@@@A@@@@@@@A@A@A@A@@@ABAB@@B@@B@@BBA@AB@B@@@B@@BBED@BA@AB"],encodeOffsets:[[112428,22861]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("梧州",{type:"FeatureCollection",features:[{type:"Feature",id:"450403",properties:{name:"万秀区",cp:[111.320518,23.472991],childNum:1},geometry:{type:"Polygon",coordinates:["@@CA@CIAGBALE@CABEI@CAE@EAAAABCAAFEBC@@D@@@@@@@@A@A@@@@@EF@@@@FLDL@@BBBBDFDD@ARJ@DB@L@B@H@@H@D@DABA@@B@DA@@D@BAB@@AB@@B@@@DA@@BB@BDDABCB@@@B@D@@@@@B@BA@@B@@A@@BA@@BA@@@@@ABDD@@@B@@AB@@@B@@@@@B@@@B@@@B@@A@@@@B@@ABIC@@@@C@CD@@CCk\\yhAB@@ID@D@BALBF@@FFBF@@@@BB@@BBFARBB@@BB@@BB@B@@@B@BBB@A@@B@BAB@BAB@@@@BB@@@B@B@BBB@@B@@@@@BB@@@@@BB@@FDHB@@@H@@@BB@@BA@@@@@@@@B@@BB@@@A@@@@B@@@@A@@@@@BBA@@@@@@B@@AB@@@@@B@@@@B@@@@@@@@B@@@A@@CB@BAB@B@@A@@BA@@@@AA@@@@B@@@@A@@B@B@@@@@BB@@@@@@@@B@@@BA@@BB@@@AB@@@BB@@@@B@@BB@@CB@@@@AB@@@D@@A@@@@B@@@BA@@@AB@AE@@@@@@BB@@@@@B@@@@@@@A@@@@@@@@B@@AC@@@@@@@B@@@D@@@@@B@@@@BB@@A@@@@@ABA@@@@@A@AA@@A@@@@@@@@A@A@@AA@@A@@@ABB@@@@@@@A@AB@@@@@@@@@@@@@C@@@@A@@BA@AA@@@@A@@@@A@@AAA@AA@@A@@@A@@BA@BB@@@@@@@@B@@BB@B@B@B@@@AB@@A@C@@@A@A@@@A@A@A@@@@@A@@@A@@@@@A@@@A@@@@@A@@@@@@BA@@@AD@@@@@BA@@@A@@@A@@@@@AB@@@@@@@B@@A@@BABDD@@@BDB@@BB@@@@@@@BAHB@BBB@@@@BB@BB@@@@AB@@@BA@@@A@@@@BBB@@@B@@@B@@@BB@ABB@@B@@A@@@BB@@AB@BB@BBBB@@B@@@@BBDBBB@@B@@@@@@@BBB@@@B@BA@@@@B@@AB@D@DCBBB@@@@@@@B@@@@B@@@@@@B@BB@@BA@@@@B@@@B@B@@@@BB@@BBBBDBB@@@AD@@B@@BABAB@@@B@@@@A@BB@@@@BB@@@B@@@B@B@@A@@B@@@@B@@@ABA@A@@@ABABA@@@@BA@@@A@@B@@@D@B@B@BBBB@@@@B@B@@@B@@@BB@BB@@@@BBB@BB@@B@BB@@@@@B@@@B@@@@@@@B@B@B@@@BB@@BB@@B@@@B@BB@@BB@@B@@@@B@@@B@BBB@B@B@@@B@@BB@@@@@@@B@@@B@B@B@B@B@@@B@@@BB@@B@@@@@@@BB@B@@@@@B@@B@@@BB@@A@@B@@BB@@BB@@@B@@B@B@@@@@B@@@BB@A@BB@@@B@@@@B@BBB@@@B@@B@@@B@@@@@@@B@B@@A@@B@B@B@@@B@B@D@@@DB@@B@@@B@@BB@BB@@BB@@B@B@B@@@B@@@B@B@@ABA@A@@B@BA@@BA@@@@@A@@BA@@@@B@AABAB@BB@@B@@BB@@@DB@@@ABB@@F@@@@@B@@AB@BAB@BAB@B@@@BBDABB@@@A@@@ADBB@@BB@@B@@A@@B@BDD@BBB@@@BB@B@B@B@BA@B@@B@@@B@@B@@B@B@B@B@B@@@@@BA@@@A@@@@@A@A@@@ABA@A@@@AA@@@@AB@@AAA@@@@B@@AB@F@@@BA@@@@@@B@@@B@@@@@B@@AB@@A@@@AA@@A@A@@@A@@@@AAB@AA@@AB@@@AA@@@@@A@@@@@BABA@@@@BA@@B@@@@B@@BA@@B@B@BA@A@@BA@@@@@@B@@@@@BA@BB@@@BA@@B@@@BA@@B@@@B@AA@@BA@@BCDA@AACBABCAC@A@AA@BC@A@AB@@A@ABAAAA@@A@@@A@A@@@@@@CA@@A@A@AB@@CAA@A@A@A@@A@@@@A@@@A@@@A@A@@@CBAA@@C@A@@A@@C@@@BA@@@A@A@C@@@A@A@@@@@A@ABADC@A@ABA@@@A@@AA@@@ABA@@@A@@B@BA@@BC@AAC@A@AA@@A@@@@@A@@@@B@@ADE@@@A@C@@BC@A@A@AB@@A@A@@@@AAAABA@A@A@@@@@A@@@AB@@@@A@@BA@@@A@@@@BA@AB@@@@A@@A@AC@@AAA@@@AA@@@A@@@AAAA@@A@@AAB@@A@@AA@@@@@A@@BA@@@@AA@@@@@AB@@@BBB@@ABA@@B@@A@A@A@A@@BA@@@A@@@A@@BAB@@@@AAA@@BA@@@A@@A@AA@@BA@@B@@A@A@A@@A@@AA@A@@@@A@@@A@@CC@@@@ACB@@@@A@@@@AA@C@@B@@C@@B@DC@@@AA@@A@ABC@AB@A@A@@ABAEIA@CA@@AABCAA@A@@AA@C@A@@A@ACAA@A@@ABA@AB@@ECAA@@F@B@BA@@@BB@B@BA@AB@@@@B@B@@B@DA@@AADC@@B@B@B@@@B@AB@@@@@B@BA@@BB@@BB@B@@A@@@@AA@@@A@@B@@AB@@@@B@@@B@@B@@@B@@@@@@A@@A@AA@A@@@@DA@@B@@@B@BBBBF@B@FAB@@BABBB@@BB@@B@@A@@B@B@B@B@@@@@B@D@@@@@BB@@BAB@BA@@@@@@BBB@B@BABBB@@@B@@@B@@@BABB@AB@@C@@BABE@ABA@ABA@CB@B@@@BCDCB@@@@@@@B@B@D@@@B@@BDAB@BAB@@@B@BB@ABBB@B@@AB@@@@A@@@@@ABAB@@@B@B@@@@B@@D@@B@@B@@@B@B@@@B@B@@@@@@B@@BBBD@B@B@B@@BBB@@B@@@@BDBBD@BA@@BB@@BA@@BDB@B@BB@B@BB@@AD@@BB@@@@@B@@B@B@@BD@@B@@@@@B@@B@@BB@BB@@BB@@@B@@@B@B@@@BB@B@B@@B@BB@@@B@BD@@@B@@ADABB@ABBB@@@B@D@@BB@DC@AD@BBB@BBBABBB@DA@@D@B@B@@BDB@DBBB@B@B@DBBA@@B@@@BABA@@AC@GAE@ECGBKBICEGECI@CCEEEAACC@KCCC@AGE@IDIOCCBEGGEGIAGI@EAKM@ODG@UBAC@E@ACABCDGEAAEACCE@AFM@CEKC@AMBG@@CC@MGG@@A@A@@B@@AB@@ABA@AB@@C@A@AAA@A@@@AB@BC@@@AD@@ABCBA@@@@@@B@BABADA@@@@@CBAA@@A@AAA@@BA@AB@@CBA@A@AACDA@A@AACAABAA@@A@A@A@A@@@A@A@@CKBABA@ABA@AAA@AAA@A@A@A@EB@B@BC@AD@@C@AA@B@B@B@B@B@BA@@B@@A@@BA@@BCB@D@@A@"],encodeOffsets:[[113976,24001]]}},{type:"Feature",id:"450405",properties:{name:"长洲区",cp:[111.274673,23.485944],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@BA@@BA@A@CB@@C@AB@BA@C@C@GG@A@K@A@@CQI@BCCCEAAAA@@CKEK@@@@EGCEKIGGCAIECAIAMAGAKAeEQDCBUDGBIBIBC@GHABHJHBB@B@B@B@DA@DDBCBAB@D@@@B@BB@B@@DB@BBBBB@@BBBB@@AD@@BBBD@@BBBB@@BB@@DBB@@BB@BAD@DBB@B@@@B@BB@@B@@DBB@@@B@@BB@@@B@B@@@B@@@@B@@BB@@BBDB@@BD@@@@@B@@BB@BBBB@ABBD@BBB@@ABCBAB@DCBCBC@@DABA@AAA@ABABAAABAB@B@B@B@B@@CD@@A@ACAAAACCGACBE@CAABCAADCBGAGBA@ABCAA@EBA@CD@DABBD@DAB@@ABBHA@@BAB@@@@CB@@AB@@EBA@@B@@ABAB@@ABA@@@@@@BABE@CCAAAAAACC@@@@ABABAB@@AB@@AB@BA@@BABABA@AAABA@@BCD@BA@ADAB@@ABC@A@@@A@A@AA@CEAA@@@@@@@AB@BCB@@@BB@@B@@A@@B@B@@@B@BABA@A@@@@B@BA@@B@@@B@@A@@@@BA@A@@@A@@B@@@B@@@B@@@@@B@@@@@@@B@B@@@BBB@DABC@ABCBBB@BB@CBAACA@@A@AA@BA@A@@AC@A@AB@B@@@BCB@FA@@@AB@@CBA@AB@B@@AB@BAB@BAB@BABA@A@@B@BAB@@@@CBA@A@@@@@A@@B@@@B@B@BA@@B@BA@@BC@@AA@A@A@A@A@@@AAAD@B@B@BA@@@@B@BB@@BAB@B@B@B@BAB@@@D@B@DAD@B@@ABCB@D@BADB@BD@@BBBD@BABA@A@@BAB@@CAA@AA@@@DA@@FAD@DAB@BBB@B@@DAB@BAB@BAB@D@@@B@B@BAB@@@BA@@B@BA@@@DBDABBD@D@BBB@BAB@BF@BB@AD@B@BBB@B@B@BA@BB@@@B@BD@@A@@@FB@B@@@B@B@BBA@@B@B@BA@AB@@A@AB@@A@A@CB@BA@@BBB@@@D@@AB@B@BBB@B@BAB@B@B@@BB@BB@@BAB@@@@@BB@D@BB@BB@B@B@BBBAB@B@@BBB@@BBBBB@@B@@AB@@@DAF@@@B@@A@@@A@AB@B@@AB@@@B@@@@@@B@@D@BB@AB@@ABEA@@ABA@@@@BABD@BB@BBB@@@BAB@AADCB@B@D@B@BA@A@A@AACJKB@@@@ADBB@D@B@CAC@AAB@@@B@@@DBBAAAAA@AA@BABABADBB@BBBBDDB@D@BFB@ABABCDCFAHAH@JB@B@@BBDB@@@B@B@@B@B@@B@BBBDBAD@@BBB@@@@B@@@B@@@BA@@BB@@BBB@BBBB@@DDD@BABBB@@@@@FADCB@FBDB@B@BBDD@DBB@BDDDB@D@DDDCB@B@BAB@@AF@BAFCD@FBN@H@DCDBFA@@AADA@C@CBA@@@A@@B@@A@A@@AA@A@@@@@@@AA@AAAC@A@@A@@@AAAAA@@ABA@@AA@@B@@@@AA@BAA@@A@@@A@@@A@@AA@A@@B@@@B@@A@@BA@@@@AAA@@A@@A@AAA@BG@A@@@@@@AA@@CA@A@@CCBA@AB@@@@A@@@@@@BA@@@@B@@@B@@@B@@A@@@@BC@@B@@A@@@@B@@@@@B@@@B@@@@@B@@@B@@@@@B@B@B@@@B@B@@@D@B@@@BA@@A@A@A@A@@AA@@@@@@@@@AAB@@AB@@@B@@@BBB@BB@@@B@@B@@@@@BBB@@AB@@@@@@D@@@@@@@@@@@@BAB@@@@@@@A@BA@@B@@@BB@@@B@B@@@@@@B@@@BBB@@@@@B@BA@@@@B@@@AA@@@@@A@@@@@C@@@A@@@@@@BD@@@A@@@@@@B@@@@@@@A@@@@@A@@A@@@@F@@BBA@@B@@A@@@A@@B@@@@C@@BA@@@@DA@@AA@@@A@@A@@A@@BA@@A@@AB@@A@@@A@@@@@@A@@A@@@@@A@AB@@@@@@A@@B@@B@@B@@AB@@@@ABA@ADA@@@B@@@A@@@@@@A@@@@@@A@@@@BA@@@A@@@@B@AA@@@@B@@@@@@A@@B@@@A@@AA@@@@@@@@@AB@@AA@@G@@@A@CG@EA@@A@@@@AA@@@@A@@@AA@A@A@A@@AA@@@@BA@ABA@A@AB@A@AAA@@@A@A@@AA@@AA@QAEBAA@@AA@@@@AEEE@@AEBK@A@CJC@@BAzgl[DD@@DCD@@@@@JDBA@@@A@@B@@@@A@@@A@@@A@@@@@A@@BA@@@A@@CCBA@@@@B@@AB@@AB@@@@AB@@A@A@@@@@C@A@@DABACC@AAA@@CB"],encodeOffsets:[[113960,24048]]}},{type:"Feature",id:"450406",properties:{name:"龙圩区",cp:[111.246606,23.404772],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@A@A@A@@@@B@@AB@B@@@@@B@@@B@@AB@@A@@@@B@@AB@@@BA@@@@B@BBBBBB@BBBB@@BB@@@BB@BBA@@B@DB@@BB@@@@@@@@BADAB@B@@AB@@@@@D@@A@AB@@A@@BABAB@BAB@@@@@@AB@@A@EB@B@B@B@@C@@@C@@@@@@B@B@@@@@@AB@@AB@@@@AB@@AB@@A@@@@@AA@@@@AB@BA@@@@@@A@A@@A@@@A@@ACB@@AAA@@A@@@AB@@A@A@A@@AA@A@AA@@A@@C@A@@AAA@@A@@@@@@B@@@@@@@B@@@@BB@@@B@@@@@B@@@@@@@@@B@@@@@@@@@BA@@@@B@B@@A@@AABAA@@@@AA@AA@@DA@@BCA@@C@@BA@@B@BAB@@A@@B@@AAA@@AA@A@@AAA@BA@@B@B@@A@AC@@AAAA@@@@A@C@@AA@A@A@@BA@@BCD@B@@ABABCB@@BD@BCB@@BD@BBD@BBBBD@BB@@@@BAB@BDFB@@B@DABA@C@@@AB@B@@ABABBB@BC@AD@B@B@@AB@@@DBB@@@B@B@@BBAB@@A@@BABA@A@@@A@@B@AA@@@@BA@@@@@@@A@@@A@@@A@@@A@@@@@@@@@BA@@@@@@@A@@A@A@@@@@@@@BABB@AB@BA@@@A@@@@A@@@A@@@@A@@@AB@@@@@A@@@@@@@@@A@@AB@@@BABA@@@AA@@A@A@A@A@@A@@AAA@@AA@@@A@A@@@@@CB@@A@@BABCB@B@@ABAB@@A@@B@BA@@@C@@AA@AA@@ACCAC@@@ABCBA@@BA@@BB@@BBB@B@@@BB@@@@B@@@BAB@BCB@@A@@@@BAD@@@@BBB@@@@@B@@@@@B@@@B@@@D@B@BA@@BBB@B@BB@@@B@@@@B@@D@B@BA@@B@@@B@BA@@B@@@@BB@B@@@BB@B@@B@@B@@@B@@@@@B@@@@D@@@BBBA@BBAB@@@@A@@@@BA@@@@B@@A@@@@AA@@@AB@@@B@@@@A@A@@B@@A@@BA@@BABA@@@@@A@@BAA@@@@ABA@A@@@ABA@@@@BCB@@@@@@ABA@C@ABA@CC@@A@AA@@AAA@@@@D@@@B@BB@BB@BBB@BABA@AB@B@BA@@BA@@BAB@@@B@BA@@BA@@D@@@D@B@BB@B@@@@BAB@BA@@@C@@@ACCB@@A@@@@@ACAABCCA@AAAADA@E@ADGBCDE@C@A@AA@@@A@A@@@AA@@A@@@A@A@A@A@@@AG@A@A@AB@BABABA@A@@@AA@@A@AAA@A@@@A@A@@BA@A@@@AB@@A@@@AB@BA@@@@@C@A@A@@BA@@BA@CBAA@AA@@A@@A@A@@A@@@@AAAA@@AA@BAB@@@B@@@BABA@@BA@A@@A@@A@A@@@@@A@@A@@B@@@@A@C@@@A@C@@@A@AAA@@AAA@@@A@@BCAA@ABC@C@@@ABCFA@@@@@@BA@@@@@@B@@@D@@B@@B@@BB@@AD@BA@@@ABA@@B@@BD@BB@@D@@BB@B@B@@@BA@@@@@AB@B@B@B@@A@@B@@AB@@@B@@@B@@@@@BABBB@@@BAB@@@B@@@@DD@@BB@@@B@B@@B@@B@B@@B@@B@@@DB@@@BB@BB@@BB@@B@B@B@BA@@BBD@@@B@@A@@B@BAB@B@B@BA@@B@BB@BB@B@@@B@@@@BB@BDB@B@@BDD@BADA@BB@@@@DBB@@BB@@@B@@B@@@DABBB@DB@B@@@D@B@F@@AB@BAD@BAF@B@@@@DBBBB@@BBBAB@B@DAB@BB@BB@@AB@@BB@@@@BBDB@@B@@@@D@@@B@@@BB@BBDBB@@BBB@@B@@@B@@@D@BABD@@C@ABAD@BA@ABAB@@@@@B@@@DAB@@CB@@CB@BB@@B@@@@@BA@A@A@@@@B@@@@BD@B@@BB@BB@AB@B@@@@BB@@B@B@B@B@@BB@@@@@@B@@@B@B@BB@@@@B@@@B@@AB@B@@@BA@@@@@@@AB@B@@@@@@A@A@AB@@@BA@@@@B@@@B@B@@AB@@@@@B@B@@@@@B@@AB@@@B@@@B@@BB@BAB@@@@@@BB@B@@@@ABA@@@@B@BBD@F@@ABB@@BBB@DB@@B@@B@@B@B@B@BBB@@B@@B@@AB@@@BADAD@D@@AB@@@BA@@D@@B@AB@@@@A@ADA@AB@@@@@B@B@@BB@@@BBD@BABA@AD@@BD@B@B@@@BAB@@@B@@@BB@@B@@AB@@@B@@@BB@@B@@@B@B@B@@B@@@@BBBA@BB@B@ABBB@BD@@B@B@@@BB@@@B@@BB@@B@@@B@BA@@BBBB@@@B@@DBB@@@B@B@DD@@@@@@BA@@B@BA@@@A@A@@BC@@BBB@B@@@B@B@@@B@FA@@@A@@@@B@B@BB@@@@B@B@@@B@B@B@B@@@@A@@@A@@B@BAB@@@B@BB@BB@@@@@@@B@@@@@@B@B@BBB@B@B@@BB@@@B@@@B@B@@@@@BAB@@BB@@B@AB@B@@CDA@BB@@@BABA@@BD@D@@B@@@@@@AB@@@BA@@B@@@BA@@A@@A@@@C@AAA@@@BB@B@@@@@BBD@@ABAD@BA@@@A@AA@@A@ABA@ABBBB@@@@BAD@@@BB@@@@@@AB@@@@BAB@BAB@B@BB@@AB@@@BB@@AB@@@B@@A@@@@B@AA@BB@@@BA@@@A@@B@@C@@BAB@D@@A@@@@DBFAB@B@@A@@BA@@B@BB@@B@FVCDARCfFLBHBNBJBDBJFDBHHLJDFFHFE@@@@B@B@@@@@@@@@@CD@FABEDBBABBFBF@DBJ@AFDBF@BKHAJB@DDBB@@@@CDA@AB@@AB@@@@AB@@A@A@A@A@A@ABBD@@@BCD@@A@AFAB@B@B@B@BBB@BBB@BAB@BALA@DB@B@@@B@B@B@B@@@BBBADBBBB@B@DCBBB@B@DA@@BAB@@AB@BBB@@@BBDA@@@@B@BCBA@A@A@@@@B@DABA@@BC@@D@@ABA@@B@B@BBB@D@@@BAB@BA@@BA@@@AB@B@BCEG@E@@DCB@HGDEAGAQACIEEAEEAAAKCG@CACIIA@AECEAG@GAAAIBK@AFGLEHCDABC@ECIGEEAMEGMCCG@@GD@@@B@BACG@@C@EMBMB@BB@DD@@@ACD@DBFFPI@AB@DA@OAG@CAECC@GC@A@@@@CBEDCFCDEDCJMCC@GB@FCLADC@CCCAEACAO@O@K@M@@@KJAB@@@DFJGDK@@CCKGAAG@G@KI@@UEBCTABI@EAE@A@A@@B@BC@ABBB@B@B@@AAE@@ADBB@@C@C@@C@ABDBABACAAA@AEB@@BADABCA@@GA@A@AEBE@@AO@OBKDGDAHEHGHEHGJAB@BAFBD@HBF@FAHDBGB@B@@@HDAFNFBAAGCAAIEABICCBCFCFEAMDC@CGEAAGBIIGEEMF@EEG@CBAE@AE@M@AGEAAAAACAJK@@@CBAB@@ABAFAB@FC@CACEA@ACE@AA@@A@@AAC@C@CAAAAAA@C@AACCA@AAA@C@A@@C@A@@@AAACAA@ABA@ABA@C@A@A@@@A@@AA@@@@A@@@@"],encodeOffsets:[[114036,23519]]}},{type:"Feature",id:"450421",properties:{name:"苍梧县",cp:[111.544007,23.845097],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AK@A@IGA@ACEA@ACDEBACI@GGECCCK@@BIA@OFEADMEAAEB@BEBKAKBGBCAC@ACCFGACAABCBE@GA@O@ECACBAFA@IHC@@B@LCJ@HDBABGCAACDCHPB@B@DGBHHCL@HAD@BAEE@CCMDIBCEAIAEACBE@CCCAEBGIAEEG@@DA@IIGEBEBA@@A@@AGKKCABAAGCA@K@IDC@A@AAG@AEAEAC@OFKF@@AEC@ECADA@C@ABBHMESME@ACE@ABEA@FHDIFE@CAICOMAGCBIDKEUCEDAECBAFEFC@BEICEDCAMA@@@I@IAK@AEC@A@ADEBE@GFGBK@I@@@ECGFEBGAGAAACMCABAB@@A@@@ABCAA@A@A@AA@CCA@AA@A@C@@@CBA@AAABAAA@AAC@@BCDA@@AC@A@@@A@AA@BAACB@BA@@@C@@A@A@@AAA@@@@A@AAA@@A@A@@@A@@@A@@AA@@AAA@@@AA@@@@@A@@@AC@@@A@AA@@@@@A@@AC@@BA@@A@AAAA@A@AC@@AB@@AA@@ABC@AAAC@@@@@AA@AA@@@A@A@AACAA@@@A@@@@A@A@@@A@A@@@A@@@@AC@@@@A@@A@A@@@A@AB@B@@@@@B@@A@@BA@A@AA@BAAA@@@A@ABA@CB@AA@@@C@A@A@@@@@@@A@CDAD@@A@A@@DAB@BAB@BAFAB@@@DA@@BAAAB@@A@@@A@@@A@AAABA@A@AA@@@@@@ABA@AB@@AA@@@@C@A@@@@@A@A@A@A@@@@BA@@@AA@@AABA@AA@EBA@E@AAAAA@@@A@@@CB@@@@@BBBB@@@@B@@@@A@@@A@@@@A@@@A@@A@@BA@@@@B@@BB@@@@@BA@A@@AA@@AB@@A@A@@@@BAA@@@A@A@A@@@CDBB@@CBA@@@@A@A@@A@@BABA@A@@A@@ABA@E@@@BBFD@@BAB@BA@@@BBBBDB@@@@B@DBB@@@BBBADBB@@DBB@FJAB@BB@B@A@@BAD@B@BB@@B@@CDA@@@@DA@@@@DBB@@@@@B@@A@BD@@@@DD@@@B@@@B@@B@B@@BB@@@@B@B@BA@@@AB@@BBB@@@@B@@AB@@BB@B@@A@AB@@@B@@@B@@AB@@@B@B@B@BA@@@AB@BA@AA@@A@@B@@@@BB@@@@AB@@@B@@@@BB@@@BA@BB@@@BB@BB@B@@@B@@BB@@B@BB@@BDB@@@@B@@A@@BAB@@@@@B@@AB@@@B@@A@@B@@@B@@@@@B@BABBBBB@@@@@B@BA@@B@B@BAD@@@D@B@@CF@BA@@@@@@B@@@@@BB@@B@BBD@BAD@@ABA@@@@B@@AB@B@@BB@@@B@@AB@B@BCDAB@B@B@@@@@B@B@@@D@B@B@@AB@@D@@@@BB@D@@@BBDA@@B@B@@@B@@@B@@@@@@BB@B@B@B@DB@@BAB@B@@@DB@@@@@@@B@B@@@BB@BBAB@B@BA@@B@BADB@@B@BBDADABBD@BCBAD@@AB@@BBA@@@A@@@AB@@A@@@AB@@A@@AAB@@@@A@@@@@@@AB@@@BABA@A@@@AB@@@A@@A@@@AB@@@@ABAB@@@@B@@@@@B@@B@@BA@@BBA@BB@@@@@B@@@B@BB@@B@@@BA@@BA@@@@@A@@@A@@@@@@@AB@@E@A@@BA@@@@@BB@BA@@B@@B@@B@@@BAB@B@@@B@B@@@@@B@@AB@@@@A@A@A@A@A@@@@AA@@@A@@@@AABA@A@A@A@@A@@AA@ACC@A@AB@@@@AA@@AA@CA@B@@@B@@AACBAA@@A@A@ABA@ABA@@BA@@@@@E@@@AA@B@@CA@@A@@AA@@@AAA@ABBBA@@@@@AB@@@B@@@@AB@@ABA@@@@BAB@BA@A@@@A@@@A@A@A@@@AA@@AAA@@AA@@@A@@@CA@@C@A@A@@@A@A@A@@@@BA@A@@@@@@@A@@@A@@@@A@@AA@A@A@@A@@@A@@A@BAA@@A@@@@@A@A@@@@A@@AA@@AA@@@AB@@@AA@@A@@@@A@@@@@AAA@@@@@@A@@@AA@@A@@@A@A@A@A@A@@@A@@@@@@@A@@AA@@@A@A@A@AAA@@@A@@@@@@AA@@AA@@A@A@@@AA@@AA@@A@@@A@A@A@@@@@@@A@@@A@@@@AAA@@@AAA@AA@@@@AAA@@A@@@A@@@A@A@@A@AA@A@A@A@C@@@AB@@@B@@A@@B@BABA@@B@B@BA@@A@@@@@@AB@@@@A@A@@@A@@AA@@@@AAB@@@@@@A@@BABA@AA@@@BC@@A@CAAAAA@@AA@@@@@A@A@@@A@@B@@AA@@A@A@@@@A@@@@@@A@@@@EBCACDG@M@EAC@EDABE@@BA@ABA@A@CDCCC@A@CCACA@CAC@AC@A@ACAEAA@CDEB@@@@A@AAABC@CC@@AAAAA@AA@@AA@@AB@@A@@@A@@@@@AA@AC@ABACAAA@@@@A@AA@A@@@A@AC@AA@A@@IBGBGDEDCBABAA@AEC@A@CCAAAAA@CAABABABB@@BBBBBABCA@@A@@@A@BBD@DBA@C@A@CA@B@@A@ILBD@B@B@BABA@C@A@A@CDBBA@AB@@A@AAA@@AACAB@@@@AB@BB@AF@BA@@BAAC@@@@A@@@@A@@@A@@BA@A@@B@B@@@BA@@@E@CB@@A@@BA@@@AAAA@AA@AA@@@ABAAA@A@A@AAAA@@A@CAA@@@@A@AB@@AAA@@AA@A@A@ABA@A@AAA@A@@BC@@@A@AA@@ABA@@D@B@BA@@B@BA@@BABA@A@@@AB@A@A@A@@@AEA@@B@@@ACA@@@A@@AABA@A@A@AAA@C@@BAAE@@ABA@AAA@A@CACBAAC@C@@ABA@@@AB@@A@ABA@A@@@C@A@ABA@ABA@CB@@@AAA@ABA@CBC@EB@@C@@BBB@DB@@BA@AB@B@BA@AACAA@@ACA@BC@A@CDABA@@@ABC@C@A@C@@BA@A@A@A@ABA@AA@@A@A@@B@@A@A@ABCBB@@B@B@B@B@B@@BD@@AB@@A@AB@@A@A@A@@@AB@@@@@B@B@DA@@@@BA@A@AB@B@BA@ABA@ABA@ABA@@@ABAB@DA@@BA@@B@@EDA@A@@@ABAB@D@@BB@B@@ABBB@@@DBBBDAA@@AAADABAD@BA@CAA@A@@@A@A@@@@@@@A@@@@@A@@@A@@@AB@@@B@B@@A@@B@@@@A@@@AB@@A@A@@B@B@BA@A@A@@@A@AB@@@@AA@@A@@DA@ABA@@@@@@B@FB@DBBB@B@@@B@D@BA@@BABCB@@ADC@AB@BABBB@BABA@AB@@ABA@@BA@@BABABA@@@@DDBBBBBBDDF@BA@A@@@@B@BA@@BABA@@@AB@FA@@BA@@DA@@@@BA@AB@AGBA@@BA@CACBA@CDCB@FAB@DBBAB@HAHBDABCDBBADBF@DAHBDDBBBBBDB@@@DC@@@A@A@A@ABABABBBABAB@BBB@BA@CD@DADA@CBADABA@@AA@AACBAA@AA@AAA@@@A@@@@AC@@CAAA@@AA@@@A@@A@@@A@A@@@A@@AA@@@A@CA@@@AA@@A@A@@@AAA@CBC@AAA@@AA@CA@@AA@AA@AC@AA@AC@@BA@AA@AA@AAAAA@@CA@A@@A@A@@@CBADACA@CCBA@A@A@A@GAGIEBMHA@OD@@IBCBGDQNYLA@EAKAGCCA@@AAMCOHG@SBGAA@@A@@CAB@KMGEKEA@@@EACAMAMAM@@@CBIDI@KBG@C@GACCA@@BABABA@A@@@@@@@B@@AA@@@@@AB@@@@@@@BA@AB@@@@AB@@AB@@@@A@@@AB@B@B@@@@A@@@@BA@AF@@@@@@@BAB@@@BA@C@A@A@@BAB@@A@@@A@@BABAB@@@@@DCB@@A@@B@@@B@@BB@@@@@BA@@B@@@B@@@@@B@BB@@B@@@BBBBB@@@@AB@@@B@@@D@@AB@@B@@B@@@BA@@@AB@@AB@B@@@BA@@@@D@@@B@@A@@@@B@@C@@@@B@@A@@@AB@@@BC@A@@@@B@@C@@BA@@@@@@@A@@@@BA@@@@@C@CAC@CAA@ADEBBB@J@@@DBBBB@@@B@BD@DFBDD@FB@DBB@F@B@BBB@BC@AD@BBB@B@B@BA@BDABA@EBC@@B@@A@C@@AAA@ACCAABCBA@CA@@@C@AC@AKC@@@CA@@AA@@@@@@A@C@A@AAA@AA@@AAABA@@@@@@@A@AA@BA@@@CA@@A@@@A@@@@@A@@@@@@@A@AAA@@@AAA@AAAAA@A@@@A@ABA@@@@ECCA@A@A@C@@@ABA@A@@AAIF@@AAC@A@CC@CA@A@AB@BA@@@@@@B@@AB@@A@@@A@@B@@@BBB@@BB@@C@@@A@A@@BA@A@AB@@@@@BB@@@@BA@@@@B@@F@@BDAB@BDBB@@@B@BB@@BB@@BB@B@B@@BB@@@AB@@@BA@CFA@ABAAABA@A@AD@@AB@B@@@B@D@@@@A@@@@@A@ABA@A@@@A@A@@@@@@@@B@B@B@@@@AB@@@B@@@B@B@@A@A@A@A@@B@@ABA@@BA@@@A@BB@B@@@BA@@B@@@B@@@BA@@BAB@B@@A@AAA@A@@@A@A@AB@@@@A@@B@@BB@@C@ABA@A@@@AD@@@@@BA@@B@BAB@@@B@@@BBB@@AB@BBBFB@B@@F@BBFDB@ABB@JFBHBFBBHDD@B@@@BB@@@@ADAD@@@BCD@@@@A@@B@@EB@B@@CFADABA@@@A@BB@@ABBDB@BBB@@@BB@@BFBBB@@@DBB@@@@@D@@B@BB@@BB@@FB@AD@H@HHL@B@DFBFDDDBBA@BB@B@@@@@B@@@@BB@@B@@@@@@B@@@@@B@@@@B@@@B@@B@@@@A@@B@@@@B@B@B@@@BA@@B@@A@@B@B@@@@@@BBAB@BA@@B@@@B@@A@@BB@@D@B@BB@@B@@B@@@BBB@@B@@@@@@B@@BB@@@@@@B@@BB@@@B@@@B@@@@BB@@B@@@@@B@@BBBB@@B@@@BB@@BB@@@@@@B@BB@@@@@@B@B@@BB@@@BBB@@B@@B@@@@BB@@@@B@@BB@@@B@BBB@@@@B@@BBBB@@@B@@B@@B@@BB@@@@@@@@BB@@B@B@AB@B@@@@BB@B@BB@@@BB@A@@B@@B@BB@@BB@@@B@@BB@@@@B@@@B@@@@@@@B@@AB@@@BB@@BB@@B@@@B@@@@@@@@BB@@@@BBB@@@@@@@@B@@B@@@@B@@@B@@@@@@B@@@B@BB@A@@@@B@@@@B@BD@BBBAB@B@D@DBBCDABEH@D@BAB@B@BEBADABA@BBAB@DBDBBBD@@@FAB@DAB@@@D@BABCBBJ@BBBAB@DB@@D@@@BF@@ABBBBA@@DB@@BB@@BB@@@BA@B@@BA@@@B@@B@@@@@@A@@BA@@B@@@B@B@@@B@@BB@B@B@@@B@@@@@BA@@@ABAB@B@B@@@B@B@@BB@@@@B@@@BAB@B@@@@@@ABAB@@AB@BA@@@A@@@AB@@A@BBA@@B@B@B@@@BBBB@@B@@@B@@DB@BAB@@AB@@@@@BB@B@@@@BB@AB@B@B@@A@@D@@@@B@BB@@@BAB@@@@AB@@@@@BBB@@B@@D@@A@@BA@@@BB@B@@@BBBB@@BB@@BB@@@B@@BBB@BBBB@B@@@BA@@B@A@@A@A@@B@@@B@@@@@@AB@B@B@@@BA@@B@B@@A@AB@B@@A@@D@B@@@AA@@B@@A@@A@@A@@B@@@B@@@@@B@BA@@B@@@B@@A@@B@B@@@@@@@B@BBB@@B@B@@@BAB@BB@@@@@BAB@@ABA@@B@@@BB@B@@@B@@@B@@@@BB@@@@AB@@BB@@BB@B@@@B@BBB@@BBB@@@B@@B@@B@BB@@@BB@@B@@@@@BBB@@@@@B@BB@AB@@@@@B@@@@ABCBCBEDABAD@DAF@DBHAD@B@BBF@DAB@@CB@@BB@F@@BA@B@A@@B@@@@BB@B@@AB@@BBAB@BAB@@ADB@@B@@CB@@A@@BA@@@A@@D@@@B@@@BAB@@AB@BAB@@BB@@ABBB@BB@BB@@B@@DA@@B@BB@B@@BB@@B@B@B@@BB@B@BB@@@BB@@@@@B@BBBB@DDF@@@@@DBB@B@@@B@@@BBDB@BAB@FBHAL@HBDAD@BDDBBDBBDDB@DJDD@FFDBF@DAFBBDB@B@B@JDBB@BDD@@D@DBDA@@F@BABBDADAB@B@@B@B@B@B@B@B@B@@BB@@BDBH@B@B@@@DBB@D@@@DB@DF@@ABD@@BB@DBDBD@@@BFB@DBDDDBB@B@DBBBBB@@@@@@@B@@BB@@@BB@B@@@B@@@@BB@@@B@@@B@@@B@@BBBB@@BBB@@BBBBB@BBB@@@BB@@@@B@@@@@BBD@B@@BB@@@B@@@@@B@B@@BB@@@B@B@@@B@@@B@B@@DBBBB@B@D@BBB@DBBD@@FA@AFABAF@BAD@D@B@BBBBD@D@D@BABAD@D@FBB@F@DA@CFC@C@CBADAB@DE@CBADCB@DEBABAB@B@BABCAA@A@AA@@A@A@C@EDAB@D@BCB@BADC@CAA@A@@AABAD@BAB@@@@@@ABAB@@@AABA@A@AB@B@@ABA@@@@@@AAA@@@@A@@@@AA@@@A@@@A@AA@@@AAB@B@B@@@BB@@BB@@@@@BB@B@@@@@AB@@B@@@BBB@B@@@@@@@@@B@@@B@@@B@@@@A@@B@@BB@@@@@@BBD@@BB@@@AB@@@BB@@@AB@@@@@@@B@@@BB@@B@@@BB@@@A@@B@@AB@B@@@@AB@@@@@BAB@@A@AD@BA@@B@@@B@@@B@@@D@@BBADBB@@@BB@@B@@A@@B@@@@@B@BA@@B@B@@B@A@@B@B@@@@@B@B@@AB@@A@@@@DA@@@A@A@A@@@@B@B@B@@AB@@@@@B@B@@@@@B@@AB@@@@A@C@A@@@@@A@AB@AA@@@@@@@A@@A@@AB@@@B@@@B@BBB@@@B@DBBABA@A@AB@@@B@@@@@BA@@@@@@B@BB@@@@B@D@@@@@B@@BB@B@@@BB@A@A@A@AB@@@D@@@@@B@BAB@B@@@@@BBB@B@@@B@BBB@@@@B@B@@BB@@B@B@BB@@B@@BBBBB@B@@B@@@B@@@B@B@@@DAB@B@B@@AF@B@B@D@B@B@DC@@B@BBD@@@DBBD@BBB@@@BC@@BA@@BAB@@@BAB@BA@@B@@AB@BAB@@@@BB@@@@@BA@@@@B@B@@BBB@BBB@@@B@BB@B@@HDB@B@BB@@@@F@B@@@D@@BB@BB@B@@@@BB@@@@B@BBB@@@BB@@B@@@@@@@@@@@B@@@@B@@B@@BB@@@BB@B@@B@BBD@B@@@B@@BA@AB@@AB@@@@@B@D@@AB@@BB@BBBB@@@B@@@B@@@@B@B@B@@@B@@@B@B@BD@@@@@B@@@BBB@@@B@@@B@@BBDB@ABB@@@AD@@@@B@@B@B@@@BBB@BB@@@@BB@@@B@@@B@@@@B@B@@B@@@@B@@@@B@@@@@@B@@B@@@@B@@B@@@@@BD@@B@@@AB@B@@AD@@AB@@@@BB@D@@@B@B@@@@@BA@@@A@@BA@A@@AAB@@@B@B@B@B@@@@@@@B@@@B@@AB@@@@B@B@@B@@BB@B@@BB@@@@@B@@@@@B@@@@AB@@@@@B@BA@@@A@@@ABABAB@@AD@@B@@@AB@@AB@B@B@BAF@@@@@BA@@@GH@@@@BBA@B@@B@@B@@BA@@B@@B@@B@@@@@B@@BB@@@B@@@B@BA@@@@@@B@@@BB@CB@@A@@F@B@DABCBADBHAB@@A@@BBB@@@B@@@BA@@B@@@B@@@@BB@@B@B@@B@B@@@BB@@BB@B@@@@@@B@B@@@@BB@@@@BD@@@@@B@@B@@B@BBB@@@@@@@@@B@@B@AB@B@B@@B@@B@@@@@B@@@@@BB@@@@B@@BB@@@BBB@@@@BB@BB@@BBB@@CBA@A@@BCBAB@D@@@@AB@B@B@B@@@B@@@B@BBBAB@BB@AF@BA@@B@@@BB@AD@@@B@BB@B@B@BB@@@@B@@BBBB@@@@@DAB@@@@@B@B@B@@@BAB@@@B@B@@@B@BABA@@B@@@BB@AB@BB@BBB@@@BB@@@FBBD@@@BD@BDBB@@BBB@BADA@@@BD@B@@@BBBBBBAB@B@@BD@AB@@AB@B@BAB@BABAD@BA@@B@BA@@BBD@BA@@D@B@DBB@B@B@BBB@BDB@BB@@BB@@BB@B@BBB@BF@D@BB@@@@B@BB@@AB@@@B@@@B@@@B@B@@AB@@A@AB@@AB@B@B@@@D@BA@@B@@@B@@ABABABB@@BD@@BBDB@ADB@@B@BBBABBB@BABB@BDB@BBD@B@B@B@@@B@@@B@@@@A@BBABAB@@@B@B@@BF@@B@BBBABBB@DADC@@@C@A@A@C@ABBB@DAB@DBBAB@D@@ABBB@D@@@@@BB@DDB@DD@@@BAB@@BBBBBDB@BBB@B@BB@B@@B@@@@@B@FBBBB@DA@@@B@@BB@C@A@CBABAHED@B@D@BBBAAABC@CB@@GDBDAHABBD@DABC@CB@@@BAB@BA@@B@@A@ABAD@@A@@B@B@BAB@@@D@@@BBB@B@DAD@DB@B@BBBB@B@@@FDB@@ADBBABAB@@@@@BAAC@A@A@C@AB@@ABA@A@A@@AA@CA@AA@AAA@@A@@AAA@@BA@@BA@ABCB@@AD@B@@AB@@@@A@AAA@@AAAB@AA@@@ACA@A@@C@C@AAA@AFA@@D@@AB@@A@AB@BAD@@@DA@@B@BA@@@AB@@AB@B@@ABCBA@A@AAA@@@@AA@@A@@@A@A@AB@@A@@@@@CE@@A@A@@@A@@A@@AA@AA@@AA@AA@@@@BAAA@AA@@@@@AC@@A@@@@@@AAAA@CAA@AA@@AA@A@@AA@@AA@@A@@CA@AA@CAAC@AA@A@@@@@@AB@@@AA@@@A@A@EA@AA@A@A@AAAB@A@AAAAAAABA@@@AAA@@@AA@@@CAAAC@@A@@BC@@CE@ABABAB@@A@@@C@@@ABA@A@@BA@@BC@@BABA@@@AA@@A@@@@A@@CB@AA@A@@D@BA@@BABA@A@A@A@@BAAAA@@@BCA@AA@@BABA@ADABA@A@AACC@@AB@AA@@@A@@BA@AB@B@D@@A@@BAB@@@FABAFB@AD@BBB@ABBBBBBBB@B@F@D@BDD@B@B@@@@@@ABC@@BA@@D@@ADBBABAD@@ABCBBB@@@DBDC@A@@BAB@BAB@BA@A@ADCDBBA@ABEA@@AC@ACCA@A@A@CAAB@B@BBBBB@H@D@HCD@BBDAB@DABAD@DADADCB@DAFEBA@@BABCBA@CDCB@BC@@BC@@B@@@DAB@@@BA@A@A@@@@@@@C@AB@BA@A@@BA@@AC@@BAB@B@@@BEACBCBA@ABCACAA@AACBAAAAAACAC@ACC@@@@BA@ABABC@EBA@A@ABAA@@AB@@@B@BA@C@@AA@A@CBC@@@AB@B@@AB@AA@AAAB@@ABA@@BA@ABABABAAAAA@AA@@@@A@A@@A@@@AA@A@@@@@@AA@@@A@@@@@@@A@A@@AA@@@A@AA@A@@@@@A@@@@@@A@@AAB@@@@CBC@@@@@@@ABA@A@C@AB@@@@ABA@AAA@@@A@A@@@@@@@A@@@@@A@@B@@A@@@@@ABA@A@@AA@@A@@A@@BA@@@AAA@@@A@@"],encodeOffsets:[[114335,24406]]}},{type:"Feature",id:"450481",properties:{name:"岑溪市",cp:[110.994913,22.91835],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@AA@ADEBC@GAC@AAA@CAAACAAEEGCEAA@ICC@G@G@GBEDC@EBG@CAEBEBC@@@G@GBA@@@CB@@@B@BA@@@A@@@@@A@AB@@C@AB@@A@AB@@@B@@@BAB@@@@A@A@@@@B@@@@AB@B@B@BAB@@BDB@@B@B@@BB@BB@@@@@@BDD@@@D@BBB@DAB@B@@AB@@@@BB@@A@@@AB@@A@@@A@@B@@A@@B@B@@@@AB@B@B@BAB@@@BA@A@@D@B@B@@AB@@A@AB@B@B@BA@@B@BA@@B@@@@A@@@A@@BA@AA@@AB@BAB@@A@@@@@@@@B@@@B@@ABADABAD@BAB@@B@@@AB@B@D@@A@AB@@ADA@@B@@A@@@A@A@@@A@CF@@A@@BAB@B@B@B@@@B@@@BA@@B@B@@A@ABAD@B@@@@AB@@A@A@A@@@A@@AC@@@A@@@AA@@@@@@A@@@A@@B@@A@A@AA@@CB@BA@@@ABA@ABA@@B@@@@A@AB@@@@@B@B@B@D@@@@A@A@CAAA@@AB@@@@A@AB@@@B@BA@A@@@@A@@C@AB@A@@C@A@@@A@@@CB@@A@@@A@A@@@AAAA@@@CA@AB@@A@A@A@A@A@@@AA@@@@@@A@AB@@A@@@@@@@@@@@BB@@@B@@BB@@B@B@@B@B@@CD@D@@A@@@@@@B@@@B@@@@A@A@A@AB@@A@A@@@@D@B@@@B@@BB@@B@@@BB@@@@BB@@@@AB@@@@@BBB@B@@@@@B@@BB@@BB@@BBA@@@@B@B@@@BA@@B@B@@AB@@@@A@@B@@@@A@@@@@ABA@@AA@A@@BAA@BB@A@@@ED@@@@@BA@@@@@@@@@ABA@@@BBABBB@@@@AB@@B@@BABA@@B@@AB@@@@ABBD@@@D@@A@BBBB@BB@@BB@BB@@@BABB@@B@BB@@B@@@@B@CD@@BDBB@BA@@BB@@BBD@@@@B@BB@@@B@B@@@BBB@@@B@@@@@B@B@@@@@B@@BB@B@@B@@B@@B@@B@@@B@@@B@@BB@@@@AB@@@A@@A@@@@@@@@@@B@@@BA@@@@@@BBBA@@@@@@@@BB@AB@@B@B@B@BABF@@B@@B@@AB@B@@ABB@@BB@@@@BF@@@BB@@A@@B@@@B@@@@@B@@@BB@@BBB@@B@@@@B@BABB@@@@B@@B@AAB@@@@A@@@@@@BB@@@BA@@BA@@B@@B@@BAB@D@B@BB@@B@@@D@B@B@D@B@@@@BB@@BB@BB@@@@@BB@BBBA@@B@@A@AD@@@@AB@@AB@@@D@B@B@BAB@@@B@@@@@@EB@@ABA@@@A@A@A@C@@@A@A@AAA@A@ABA@@@A@@AAA@@@A@@@A@@A@@@@A@@AA@@A@AA@@@A@@AAAA@@AA@@@@@A@A@@@AAAA@@@C@AA@@@B@@@@@BAAA@@@@BAB@@AAAB@@C@@B@B@@@B@B@@@@A@@@A@AA@@A@@@@@@@A@@B@@@@AD@@@@AB@@BB@BA@@@@B@@ADA@@BA@@A@@AB@@A@A@@@A@@@@AA@@@@BA@A@AB@B@@@@@DB@BBA@BB@BA@A@A@A@@@A@A@@@@@A@@BAB@B@@@BBB@B@@BB@B@B@@@BA@@@@B@@@@A@BB@@@B@@@@AB@@@BA@@@AB@@AB@@@@@B@@ABB@AB@@@BA@@@A@A@A@AB@@@B@@@B@@@B@BBB@B@@B@@B@B@@BBA@@@A@@B@@@@BB@@B@AB@@BB@@AD@@CAA@C@@@AB@B@BB@@@@BA@@BB@@@@BDB@@B@B@B@@@B@BBB@@@BB@@BD@BAB@@AB@@@B@@@BB@@@@BA@B@@BAD@@@B@@@@@@AB@@A@@ACBA@A@A@@B@@@B@BBB@BB@@B@B@BB@@BA@@BB@@@B@@@DB@@BBB@@@@B@@@B@@@@@@BBBDB@@@BD@@BB@@@@BB@BA@@BB@@B@@BB@BB@BB@@AB@@BB@@B@B@@@@@BB@@@BB@@@B@@@B@@@B@B@BB@@@@@BB@@@BAB@@@@@B@@AB@B@@@B@@@@@B@BB@@BB@@@@D@B@@@DA@@B@BB@@@BB@@@BB@@AB@@@@@B@B@@A@@@@B@@@@@B@@@B@@@B@@@B@@A@CB@@A@@B@@A@@BAB@@@BA@AB@@A@@@CA@@@@@@A@ABA@@B@@@B@@A@A@@@@B@@@BB@BB@D@@@@@B@@@B@@@B@@A@A@@B@@@@@B@B@@@@@BA@@@A@@@@@AB@@@BBB@@@B@@@@@B@B@BB@@@BBBB@@@B@@@BBB@@BB@@BB@@@BBB@@@B@B@B@@@@@BA@@B@@A@@B@@AB@BA@@@@@@BA@@B@@@@BB@@DB@@BBBB@B@@B@@BB@@D@D@B@BBBAB@@@@@@@BA@@@@BA@A@@BCBA@@B@B@@@BC@A@@BAB@@ABAB@@A@@@BB@B@B@@A@A@@B@B@@@B@@DBBB@@B@@@@B@BBBBB@B@@@@C@A@@@AB@@A@@D@B@@B@@B@@@@AB@@@@@@@@@@A@@@A@@@BB@@@B@@@B@@@B@@@A@@AA@@A@@@@BCB@BA@@@@B@@DBBBB@BB@@@@@BD@@@B@B@@@@@B@@@B@@@B@@@@@BA@@@@@@BBB@@@@@@A@@B@B@B@@A@A@@DB@@@B@BB@@@B@@B@@AB@@AD@@@BA@@@A@@@@@@@BB@@@@@@ABABA@@BEB@@CB@@@B@@D@@@DB@@@BB@@@B@B@D@DB@@DBB@AB@@BBB@@@@@ABAB@@A@@B@@A@@B@@@@@BA@@@@@@B@@@B@@@B@@@@BBBBD@BBBB@@@@B@B@@@B@B@@@B@@@BBBA@@B@@@@@@@@@@@B@@BB@@B@@B@B@B@@B@B@@@@@@BB@@@@@B@@BD@B@BBBBB@@@@@@@BBB@B@@@B@@AB@@@@BB@@@BBB@@@BB@DAB@B@@ABBB@@@B@@@@@B@@@@BA@@@@@@BB@@@BA@@@@B@@@@@@@B@@@ABB@@@A@@@@@A@@@BB@@B@@@B@@@@@AB@@@@@@@@B@@@B@@AB@@@AA@@@A@@B@DBB@BAB@@@B@@@@@BBAB@B@@@FB@@B@@A@@BBB@@@B@FBA@@B@@BBA@@B@BA@@BA@@@@@AB@@BB@@@@B@@B@@@BA@BBB@@@@B@@@@B@@BBB@BD@@@@B@@AB@B@B@B@D@@@B@B@BB@@@@B@B@B@BB@@@BB@B@B@@@@AB@@A@AD@@@@@B@@@BA@@DAB@@@@CA@@AACA@BC@@@A@@@A@@B@BA@@@A@@@A@A@A@@@ADAB@BB@BBB@@@@BADA@@B@@@BA@@BABAB@BAB@@AB@@AB@@@@@B@B@@BB@@B@@@B@@BBA@@DA@@@@B@BBB@B@@@@B@@B@@@@@B@B@@@B@@@@ABB@AB@@@@ABAB@@@@@B@BABAB@@@BBB@@@@@B@F@@ABABAB@BAB@BBB@B@@A@@@ABC@@@A@ABC@AB@@@B@BA@@B@D@@@@BBDB@B@@@B@@BBB@BBAB@@AD@BA@CB@@@@@B@@@D@@@B@@BBB@BB@BBB@@BBB@@BB@@B@@DB@@@@BB@D@BB@@BB@BBD@B@@@BB@B@B@D@B@B@@BBAB@BB@FBB@BB@@DA@@BAB@@@B@BA@AB@B@B@@@B@D@D@@@BB@@@B@@@@@BB@BBB@B@@@@@B@@B@@AD@@B@@BD@B@@@BBBB@@BA@@@@B@@A@@@@B@BAB@@AB@@@BAB@@AB@B@@@B@@@B@B@B@B@@B@@BB@@@B@@B@D@@@BBBBB@@@B@@@B@@@B@@BB@@@BBBB@@BBBBB@@@@B@BA@@@A@@@ADAB@@A@A@A@A@@@@@@BB@@@@@F@DBB@B@@@@@BBB@@BB@@B@@BABBB@B@F@B@@@@A@@B@@AB@BAD@@B@@B@@@@B@@@BBAB@FADA@@@AB@BA@BB@@@BA@@@@B@B@@@B@@A@@@@B@@@B@@BBBBB@@B@@@D@D@B@@@@A@AB@@C@A@AC@@A@@@A@@@ABEAAB@@A@@BAAAACAA@@@AB@@@@@B@B@BA@A@@@A@@@@@A@@@A@AB@@AB@AACC@@AA@@@A@@A@CA@AA@@A@@BABA@@B@BAB@@@B@BAB@@A@@@AA@@@AABAB@@A@@BBB@@@@ABA@A@@@A@@@ABAB@B@@AB@@@@ADABA@@B@@@B@B@@@DCBB@@DA@@BAD@DB@@B@BA@@B@@@@@@@A@@@@@@@@B@@@DBB@@BC@A@@B@@@@@B@@@BC@@B@@@BA@@BAB@@@B@B@B@B@@B@BBB@@B@BA@@@BB@@@@@DBB@B@BBB@@BB@@@D@BAB@@@B@B@D@B@@@BBD@B@B@B@BBDB@@B@@AB@B@@@@@BB@@B@@@@BB@@BB@@@@BB@@@@B@@@@DFBBBD@@@@BB@B@@DD@@@@BD@@BBB@B@@@B@B@@@@@BB@@B@@@B@@@BA@@B@@ABA@@BA@@BA@A@@BA@A@@@ADBD@@@B@B@@@@@CCAA@@@@@@@A@A@AB@@A@@BA@@BCB@B@@@BA@@BB@@B@B@BB@@B@B@B@B@@@@@@@DB@@@AD@B@B@@@BA@AB@@@@@@AB@B@@@D@@@B@B@B@@AB@@@@@D@B@B@B@B@B@BB@BB@B@B@@AB@@B@@B@D@@@BB@B@@BA@@@@BB@@B@B@B@@@@A@@BA@A@@AABA@A@@@@@A@@@A@@BA@@@AB@@@@A@A@ABA@@@@B@@A@@@A@AAA@@@CA@@AAC@@@AB@BA@@B@@ABC@@AA@@@AA@@@@C@@@A@@@@B@@A@@@@B@DEBA@@D@D@BAB@DB@AB@@@B@BB@@BB@B@B@@@D@B@@@D@B@@A@@@@BB@@@@@B@B@@@@BB@B@@AB@BA@A@@@A@@BA@ABB@@BBBB@@@@@BB@B@@@@BB@@BBBDAB@@AB@@AB@B@D@@@@@B@@ABA@@B@@@BA@@B@B@@AB@B@@@B@B@BBB@@@BB@@B@B@BABA@ABAB@B@H@@B@@@B@B@B@B@@@BB@@B@@@B@B@@BBB@D@F@DCHABCF@B@BCBB@BDBADBBBD@@@@B@@@DABD@@D@@@B@@ABA@A@@A@A@@A@A@C@@@CB@@AB@@A@A@@BA@AB@@AB@@A@ABAB@BA@AAA@AAAA@@A@A@@@C@@B@BB@@BBB@@@DDB@BAD@B@BA@@@@@@DA@A@@B@BA@@B@B@BA@@@@BB@AB@@@@@B@BA@AB@@AB@@@@AB@B@@@@@@A@@BA@@B@@B@@B@@@@A@@B@@A@@B@@@@@BAAAB@AA@A@@@C@@A@@@@@A@@@A@@@@AA@A@@A@@@AAA@@@@@AB@@A@A@@@AB@@A@A@CA@@@@@@A@@AAA@A@AA@@ABA@C@@@A@@@A@@@@@A@@@@@A@AA@@@@BC@A@@B@@@DA@ABA@A@@@A@@A@@A@@@AAA@AA@@AB@@AB@DABA@@D@DBBD@@BBB@@BD@@@B@@A@AB@@@BABA@@@ADABA@AB@@@DA@@@@B@B@@@B@@BB@BB@@@BB@B@B@B@@@BB@@B@BA@A@@BA@@@B@@@@@@@@@B@@@@BA@@B@@@@@@B@@@B@@B@@@B@@ABAA@BA@A@@@@@@B@B@@@@B@@@@@@AB@@@@@@@@B@@@B@@@B@@@B@@@@@@@B@@A@@B@@B@AB@@@B@B@BA@AB@@@BAAA@@@A@A@@AA@C@@BA@@@A@ABCD@@AAABABA@@@ABA@@D@B@BA@C@AA@CE@ABA@A@@A@@AACAA@AAC@AAC@@DA@AAC@@DABABA@@@ADC@AB@@AB@B@B@@BD@B@@@@@BBBB@@BDB@@@@A@AB@@ABB@BB@B@@BB@BB@@@AB@@@BA@A@AB@@AD@@@DB@AB@@CB@@BBB@@@@BBBA@BB@@@@A@A@@B@@A@@@@@@@@@A@@@@@@@@@A@@@@@A@@AA@@@@@A@@@@@@@A@@@@B@@@BB@BB@D@@@@BB@@B@BBB@@@B@B@BA@@B@@@BB@BB@@DA@BB@@@B@@@@B@B@@@@B@@ABA@@@@BB@@@@B@@@BA@@BA@@@@BA@@BA@@@@@@@A@A@@@@D@@@D@@@@A@A@AFAB@@@BA@@@@@@BA@ABABA@AB@@@BAB@@@@C@@@@BA@@@ABABC@A@@@@@@A@@AA@@C@AB@AAA@@A@@AA@@AAAAA@AAAA@A@A@@B@@A@@BA@@@A@@B@@@BA@@@A@@@A@@@@@ABA@@@A@@B@B@B@D@@@@@@@B@@@BA@A@A@ABABA@AB@@A@A@@@A@E@ABABA@AC@CAAA@ABA@CBAB@D@BA@AD@@ABA@ABA@A@AAA@ABABA@@@C@C@CAAA@AAAA@CAC@C@CA@CAAAA@@AAA@@@AC@AA@ABA@AA@A@AC@@@C@E@C@CBCAC@AACEAAACACABA@A@@@AAAA@AABCAACAAACBA@@@AA@AAA@A@CCCA@A@A@EACAAAABA@A@A@@@A@@A@@@ABA@ABAAA@A@ACAAA@A@AAA@AA@AAAAC@CBA@A@AA@AAA@ABA@ACAAACAABA@C@A@BA@AB@BA@A@AAAAA@A@AA@A@AA@A@@AAAA@AA@CAA@A@CAABC@@A@@AABA@AD@B@B@@@@A@@AAAE@A@@@AAAAAA@AA@A@A@@B@B@BABC@A@@@C@AAA@AAAAA@@C@A@@BA@C@ECCAA@A@AAAAA@@C@EAE@A@AA@AAA@CB@BCDCBA@ABA@A@A@A@@AAC@A@@@A@AAA@AC@CA@@EAAACAC@CAA@@A@A@AEC@AAC@A@ABABA@A@@AAA@@@A@BA@@@A@@A@A@AAAAA@@A@A@A@A@A@ACCCACCCACACAAC@@A@C@A@A@C@@@@BAB@@CAA@@ACBA@ABAAAA@A@A@A@A@C@@AC@AAAC@CAC@@AA@@BBBABAAA@ACA@C@A@CA@@ABABA@A@@AAA@@C@C@AB@DABABA@A@A@AAA@C@ABQA@D@BEAA@@A@A@AA@AB@@@B@B@BA@AA@CAAAA@@AAAB@@@@@@@@AAA@A@A@CBABAB@B@BABA@@BAA@AAA@A@A@AA@A@ABA@@B@BABA@C@A@@@@@ABA@A@@@@@A@@@A@@BAB@@ABA@AAA@BCBA@@A@C@AAC@A@AD@BABA@@AAA@AAA@@@ACA@@CBABABA@CAAC@A@C@A@A@@@@AAA@A@C@@AA@@AA@A@@AA@A@C@A@ABA@C@C@A@ABA@@AAAA@A@AB@@E@E@A@ABC@@AA@@AA@@AAA@@@AB@B@B@@ABC@A@ADA@AB@B@DAD@@@DABAB@BCBABAD@BABADABABA@AAA@A@AB@@C@CBABADABABA@A@A@@@C@A@A@AC@@@AAAA@CAC@@AAA@ACCCAAAC@A@AAA@A@AAAAAAAAAC@AAA@ABA@C@C@A@@AAAA@@@@A@@@ABA@CAA@@@A@A@@@A@A@AA@ACAAAAAAA@C@CBCAC@C@C@A@C@AA@AAACAA@ABCBA@AB@BADABC@AAC@AAC@AACA@@AAA@CAA@C@@AAC@A@AAACAA@EACACC@AAC@AC@CDA@ABEDA@@HCBA@E@C@E@EAECAAE@AAI@"],encodeOffsets:[[113625,23180]]}},{type:"Feature",id:"450423",properties:{name:"蒙山县",cp:[110.525003,24.19357],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@B@B@@@@B@@B@@@@ABAB@D@@@B@@B@@D@@@B@@AAA@@@A@@@@@@B@@@BA@@B@@@B@B@B@@@BBB@@A@A@@@A@A@@@@BA@@B@@@B@@@BB@AB@@@B@B@@@B@B@@@BAD@B@D@BAB@B@B@@@D@@@AAB@@@@AB@@@AAB@@@@@@A@@B@@A@@D@@A@@B@@A@@BAB@@@@A@A@@B@@@@AA@@@@AA@@A@@@@AB@@A@ABA@@@A@A@@@@@@AA@@@A@@AA@@@A@@AA@AA@@@@A@@@@A@BA@@A@@A@AA@@@@@@@B@BA@@@@BAAAA@@@A@@@@@A@@@@@AB@@A@A@@@@BA@A@@AA@@@@@A@@AAA@AA@@@A@@A@@@A@@A@@B@@@@ACCBA@AA@@@@ABA@@@A@@@@AAAA@@@AB@@A@A@@@AD@@@@AA@AA@@C@A@@@@@@A@@@A@AAA@@@@B@@@@@B@@@@AD@@A@@A@A@@@A@@@@@AA@@@C@@AAA@@@@A@@@A@@@@@A@@@@@AA@@@@@@CA@@A@@AAA@AA@A@@@@B@@@@A@@@A@@@@B@@@@@B@@@B@@A@EBCAAB@@AB@@@@@BA@@B@B@B@@@@@BB@@@@B@BBBBBB@BB@BB@@B@BABA@B@@@@BBB@@ABA@@@@B@@AB@@@@@B@B@@@BBBA@@B@@@B@DA@@A@B@@@@ABA@@@@@A@@A@@A@@BA@@@@@@BB@@@@@B@@@@@BB@@@AB@@BBA@B@@@@@B@@@B@B@B@@@B@@@B@@@@@DBB@@@@@DB@@@@B@BA@@B@@@@@@AB@@@B@@AB@@@@@@@@@@A@@BA@@@@@@@AA@AA@AA@@A@@@A@@A@@A@@@@A@@@@AA@AA@@A@@A@@AAB@@AA@AAA@@AA@@A@@A@@@@@A@@@AA@@@@@@@A@@A@@@A@@@A@@@@AA@@A@@BA@@@A@@AA@@AAA@@@@A@@@AAA@@AA@AA@@@@ABA@@@@AAAA@@@@@A@@@@@@@@B@@A@@@AB@@A@@@@@A@@@AA@A@AC@AAA@AA@@@@A@A@@@@@@@C@@@@A@@A@@@@AA@@AAA@@@AA@@A@A@@@AA@@A@@@A@@AA@@@A@@@AA@@@ACA@@@A@@@A@@C@@@@@A@@@@A@@@@@A@@@@@@@A@@@A@A@@BA@A@@@AA@A@@AA@@A@@@@AA@@AB@@@@A@@@CA@@@B@@@B@@A@A@@BA@ABA@A@AB@@A@@@A@@@@BA@@BA@A@@AA@ADA@A@A@A@@@@@A@@@@@ABAA@@@BA@A@@@A@AA@@A@@@A@A@@@@@ABA@A@AA@@A@C@AA@@A@@@@CAA@@A@@A@A@@@@@AA@@AADA@A@CB@@A@@@AA@AA@@@A@AA@@A@A@A@A@@BA@A@AAA@A@@@A@@@@@A@@BA@A@@@ADCB@A@@@ABA@ABCA@@A@@ABA@@BAB@B@@AB@@@AA@@@AA@A@@@@AC@A@@@@@@@A@@BA@@A@@@@AA@@A@@@@@AA@@@@@AB@@AA@@@A@@@A@@AA@@@@@A@CCAA@AA@@A@@@A@ACA@AA@A@@A@@A@@@A@@A@@BAA@@@B@B@@@@A@@B@@A@@BA@A@@@@@@BA@@@C@@@AAAB@@A@@@@AA@@@@@@@A@@@@@A@@@@@A@@BAAA@A@@@@B@@A@@@@D@@@@A@@@@BAB@@@B@@@@A@@@@@@@AA@B@@@B@@A@@B@@@@@@A@@BB@@B@B@B@@A@@@@BAB@@@B@@@@@@AB@@AB@@@@AB@@@BA@@BAA@@@@@@@@A@@@@@AA@@@@ABA@@AA@@@A@@@@@@B@@@@@@A@@BA@A@@B@B@@@B@B@@@@AB@@@@A@ABA@A@@@@B@@AB@@@@AB@@@@ABA@A@@B@@@@A@@@@B@@A@@@@@@B@@A@B@AB@B@@@B@@@@@@@BB@@@BB@B@@@B@@@@@@AB@@BBBB@@@B@BB@@@AB@@@BB@@@@BB@@BA@@BB@@@@BB@@BB@@B@@@B@B@@@@@B@@BB@@@B@B@@A@@@A@AB@@AB@@@@CB@B@@AB@@@BA@AA@@@B@B@@@B@@@@@@ABA@@@@B@@@B@BA@@BA@@BB@@@@B@@@@B@@BB@@B@@@BA@@@@@AB@@@@@BA@@BA@@@BB@@@B@@A@ACA@@@@B@B@@ABA@@@A@@@@@@D@B@@@B@@A@@B@@A@@@A@A@@@@@ABB@@BA@@@@@@B@@@@@@AB@@@@@@@B@@@@@@AB@@@@@B@@BBBB@@@@@BAB@B@BA@@BCBBB@@BB@BB@AB@B@B@B@@@B@@@@@B@@ABA@@@@@AB@@@@AB@B@@@@@B@@@BA@@B@@@@A@@BA@@@@B@@A@@@@B@@@B@@A@@B@B@@@BA@@@@B@@@@A@@BA@@B@@@B@@A@BBAB@@@@@@@BABA@@@@@AB@@@B@B@B@@@B@@@@@BA@@@C@@@@BA@AB@@@@@BA@@@@B@BBBBB@B@@ABA@@B@@AB@@@BB@@@@@AB@B@B@B@@@@A@@@ABCBA@@@A@A@AB@@ABA@@@@B@@@@@@AB@@@BB@@@@BB@@@@B@@@BAB@@@@@B@B@@@B@@@@@B@@@B@BB@@@@B@@@@@B@@@B@@@BAB@@@B@@@B@@@B@@A@@@A@A@@@A@@@@@@@@@A@@@A@@@@@A@@A@@@@A@@@@@AB@@@@@@AA@A@@@@@@A@@A@@@@A@@A@@@@@BAB@@A@AB@@@@A@AB@@AA@B@AA@@@@AA@@@@@@@A@@@@@@@AAA@@@A@@@A@@@A@@@A@AB@A@@ABA@@@AB@@ABC@AA@@@BAB@@@B@@@BAB@@@B@@@@@BA@@B@@BB@@AD@BABAB@@A@@@@@A@@@A@@@A@@@A@A@A@AA@@@A@@A@AA@@C@@@A@@C@@@C@A@@@AAA@A@@@AA@AAAAAAACEG@AAAA@A@A@@AA@@AA@@@@AA@@@AAA@@@@@@@AA@@@@E@A@@@@@@@@AEA@@@B@@AB@AA@@@A@@@@A@@A@C@@@@@@@@AA@@@@@AA@@A@@A@A@@DABABA@AE@A@AAC@CAAA@@A@A@EA@@A@A@A@@AA@A@C@CBA@AB@@AA@BA@A@@@@@A@@ACBC@CDA@@@A@@BA@@@CB@@ABA@@@C@A@@@@B@BA@B@@BAB@@@B@B@B@@@@A@@@@DA@@BABABA@AB@BBB@B@B@@BB@@@@@BB@@@@B@B@B@DA@@@A@A@@@A@@@@@A@AA@@@B@@ABAB@BABB@@@B@@BBB@@BA@B@@BB@@B@@@BBBBB@@BBB@@@B@@BBAB@@B@@B@B@B@BA@@@A@@@@BA@A@@@ABABA@@@A@@@C@A@@AAA@@@@ABB@@@@BDB@@@@A@@@A@A@@A@@@A@@@CAA@@AA@@CB@@@B@B@@@@AB@@CA@@@AA@A@AAA@@@BA@A@@BA@@B@@A@@@A@@@@BA@@@AA@@AAA@@BAA@@@@@@A@@AAA@@A@@@AC@AAA@@@AD@BA@@B@@@@BBA@@BA@@BA@@@@B@BA@@BA@AB@@ABA@@@@@A@@BA@A@@@A@@@A@@@AB@@@B@@BB@@@B@@@@@B@@@B@B@D@@BBB@@B@@BB@B@@@@@@AA@@@@@@@@A@@@@@A@@@@@@@A@B@BA@@@@A@@@@@A@AAAB@@@@A@@@@A@A@@@B@@AA@@A@@@A@@@@@@A@@@@@@A@@BA@@A@@@@A@@@AB@B@@AA@@A@@@@AAAA@AAAAA@A@A@@A@BA@@BB@@B@B@BBB@@@@BB@B@@BB@@BB@@BB@@@BB@@@@@B@@@@B@@@@BB@BDBB@@@@DB@@@BD@@@@BB@@BB@@@@BB@@@@@B@@@@BAB@@ABABA@@BA@B@@@@@@B@@@@@B@@@@@B@@@@@@BB@@@B@A@@B@@A@A@AB@B@@A@@B@@D@B@@BB@@@@@B@@AB@@@BAB@B@B@@B@@B@BBD@@B@@@@@@@BB@AB@B@BA@@@A@@@@@AB@BAB@B@@@BA@@BAB@@@BA@@@A@@@@@@@@@A@@@A@@@A@@@@@A@@@@@A@@@@@A@@@@@@@@@@B@B@B@@AB@@A@@B@@@B@@BB@B@@B@@@BBBA@B@@@@BB@@@A@@@D@@@B@@@@B@@@B@@@@B@@@@@@@B@@@A@@BB@B@@@@@B@@@BB@@@@B@@@@@BBA@B@@@@@@@@@B@@B@@F@@@B@@@BB@B@@@@@BA@@B@@@B@B@@@B@B@@@B@@@@@B@@B@B@B@@BBB@@@@D@@@BB@BBD@@@B@@@B@BB@@@ABA@AB@@AB@@A@@B@@@@ABA@@B@@A@@@@B@B@@@@@AA@A@@@@@@@A@@B@@@@@@B@@@@BA@@@A@@@A@AA@@B@@@@@CB@AAB@@@@B@@BA@@A@@AA@@@@@@AA@@@A@@@@@@@@A@@B@@@DBB@B@B@@@BAB@@BB@@@B@@AB@@AB@@A@@@@A@@@AA@@@@@@@@AA@@@@@@A@@BAB@@@@AB@AB@@A@AA@BA@@AC@@@A@@B@@@B@@@@@@A@A@@@@@A@@@A@@BB@@BD@@B@BB@@@@@@BB@@B@B@@BB@BB@BB@@B@@@@AB@@@@@@BB@B@B@@ABBDB@AB@B@DA@@BA@BB@@@B@@B@@@B@B@B@@@D@@A@@B@@@@@B@@@B@B@@A@@B@BB@@D@BABBBABBB@@BB@B@@@@@BA@@B@B@@@@AB@@@@@B@@AB@@@@BB@DBB@@@@@B@@@@@B@@@@CB@@@B@@B@@@@B@B@@@@@@@B@B@@@@@B@@@@@@BB@B@@@BBBAB@@BB@@B@B@B@@@@B@@BB@@@@BB@B@@B@B@B@@@@@B@@A@@B@@AB@BA@@B@@CB@B@B@@AB@B@@A@@@@@@@@B@B@@@@@B@B@@B@@BA@AA@@@AA@@B@@@B@@AB@BAAA@A@A@@@A@@@ACA@@@@AA@A@A@A@@@@@@@A@@@A@AB@@@@A@@@@@@AA@@BA@A@@@@@ABA@@@A@AB@@@@@B@@BB@B@@@B@BA@@@C@A@A@@@A@@A@A@A@A@A@@@C@@CD@@A@A@@@A@@@@@@@@BABBB@@A@A@@AAB@BA@A@@@@AA@@@A@A@@@@E@@@A@C@@ABABABAB@@AB@BD@@@BB@@B@@BBB@@B@@@@D@BBAB@FABA@B@@BBA@@B@BADA@@BB@B@BB@BB@@@B@@@B@@@D@DAB@BB@@@@@BBBBBB@@B@@A@@@ABA@A@@@BB@@BB@@@B@@BB@@BB@B@@BB@@@@BA@@@@@@BA@@B@@@@A@@@@BAB@@A@@B@B@@@B@B@B@B@B@@@B@@B@@AB@@@B@@@B@@@B@B@B@@@B@B@BJFFDBBBBDFBBAB@@@@A@@@@BA@@@@BCB@AA@@@@@@BA@@BABAB@@A@@@@@ABADAB@@A@@@A@@@@A@@@AAB@@AB@@@@AB@@@@AB@@@@AB@@@@A@@@A@A@@@A@@@A@@@@@AAA@@@@@@@@BA@A@@@@BABA@@@A@A@@@A@@@@BAA@@A@@@A@@@A@@BA@AB@B@B@@@B@@@B@B@B@BAB@@@B@@@BAB@@AB@@A@A@@BA@AB@B@@B@@@B@@@A@C@@B@@A@@BABABA@@BA@AB@@@@A@@@A@ABAAAA@@A@A@@@A@@@A@CA@@AAA@@@@@CAAA@A@@@@AAA@@@A@@@@A@@A@A@@@@@A@@AAB@@AB@@A@@@AB@BA@@B@B@@BB@@CF@@AB@@C@A@A@A@A@@@@@A@@@@B@B@@@@A@@BA@A@A@@@@AA@@@@A@A@@@@A@A@@@A@@A@@AA@A@@@A@@A@@A@@ABA@@@@BBF@@BD@@@@@@A@@BBD@B@BB@@@@@B@B@@@@@@B@BA@@@AB@BAB@@@B@B@@DBB@@B@@B@@BB@@B@@@B@@BB@B@F@@BB@B@B@B@B@@@B@B@@BDAB@@@B@BAD@@A@@@@@@B@BAB@@@@B@@@B@D@BAB@D@BAD@B@BB@@ABAB@@@B@@@BA@@@BB@@A@@@AB@@@@@@BB@@@@@BAB@@@B@@B@@@@@AB@B@@@BB@@@CBA@AA@AA@@@AB@@@BA@@@@@@@@@@B@@@@AB@@A@@@@@AD@@A@C@@@A@@B@@AB@@A@@A@@A@@@@@AB@@AA@@@@A@@@@@AA@@@@@@@@@B@@@@@@AA@@@A@@A@@@@@A@@B@@@@@B@@@B@@@BAAA@A@AA@@C@A@CAA@CAA@GCA@EAA@A@@@@@@B@@@BBB@@@BB@@BA@@@@BA@@@AB@B@@BB@@BB@@@BAB@@A@@@@B@@@B@@@@@@B@@@BBBBBBBBABBD@@BBBB@@@@B@BDD@BBDBBDBDABBB@BB@@BBBB@FBB@BB@@D@@BABBBBDA@BDB@@ABAB@B@B@DB@CA@AA@AD@@BB@H@@FBB@B@BB@DBBDBCD@BABBB@BDD@BBBABABBFBB@BBDBBBD@@CBBBAB@AABA@AB@@@DABAD@FBCAHA@@@B@BBDD@DDBBB@@DBBBBB@B@BDD@B@@@DB@FA@@@BBB@@@B@@@B@B@BABBBADA@A@@AA@@@A@@@AB@@B@@@B@@B@@B@@@@@@BB@B@A@@@@@AAA@@A@@A@ABB@@@A@A@@@@@@@A@@B@@B@@@B@B@@B@@@@A@@@@B@@@A@@@AA@A@@@@@@B@@@BB@@@@B@@B@@@@@A@@B@@@@@@@A@@@@@@@@@@A@@@BA@AAA@@A@@@@B@B@@@@@B@@AB@@B@@@@@B@@@@B@@@@@@@@@B@@@A@@A@@@@@@B@B@B@@@@@@A@@B@@@@@@@B@@@B@BAFA@@@@@@@@A@@A@@B@@@@A@@A@@@@A@@@@B@@@@A@@A@@@@@@@B@@@@ABA@A@@@@AB@@@@A@BADA@@@A@@B@@AB@B@@@@@@A@@@AB@@AB@@AB@@@D@@@B@BABBB@B@@@@B@DABBB@D@@@ABBBABB@AD@B@@B@B@BB@BBB@@D@B@@@BAB@@ABABAB@@AFD@D@FBBAD@B@B@DB@B@BB@ABAB@B@@@BA@@DAB@BA@A@A@@BABA@@BA@@A@AAA@AAA@@@@ABA@AD@@@@AB@B@B@@ABB@@BB@@@@BBB@@BBBB@@@@BB@BA@BB@B@@@BB@B@B@DBB@BAD@D@DCCEDED@BAD@@GB@DAJA@ADABDB@DCBBDA@DD@@BABBBABA@DF@BDBBD@DABAB@DB@B@B@BBDBDBDADABDBFC@@D@B@BBBB@@BDDBD@BFDBBDBBB@DABDB@BDBBJ@@B@DAB@AD@BAHBBD@DBHBB@BDBABBDADB@GAC@E@C@CAAFCBIACBACAAGABBE@ADEDIHAB@FBFGF@B@@AB@@@B@@@@A@A@A@@@@BAB@@@A@@ABCA@BA@A@@B@@BB@B@BABA@A@@@AB@BA@@@@@@@A@@AABCAE@@@A@AB@@AAAAA@A@@AA@@@@@@@ABAA@@@@A@A@A@@@@@AA@BA@@A@@AAA@@@A@@B@@A@@@@@A@A@@B@B@D@B@@@DA@@@B@@B@@@B@@@BAB@@@@@@@B@@@@@B@@@B@@A@@@@@@B@@@@@@@BB@@B@BB@@B@@@B@BA@@BBBBBBB@@@BBB@B@BAB@B@B@BA@@@AB@@@B@D@@@@@B@@@@@@@@AB@@@BAD@B@@@@@B@@@@@@AB@@@@AB@@C@@@ABAB@@@@@@A@@@AB@@A@@@@A@@AA@@AA@ACAAA@AAA@AA@@A@@@AA@@BA@@@AA@@@@C@AB@@A@AA@@@@A@A@A@@A@A@@@A@A@@A@@A@@@@AA@@@@@@A@ABA@@BA@@@AAA@@@A@@AAA@@AB@@A@AAC@@@@AA@@@AA@@A@@@A@BAA@@A@@@@A@@@@BA@@@@@@BC@A@@@@@@@A@@@@@@@A@@A@@@@@AA@@@@B@@AA@@A@@A@@A@@@@@AB@@@@A@@A@@AA@@@@@BCB@@@@@@A@@@AB@@@D@BA@@@@A@@A@@ACBA@A@@AA@@@@@@@@DBBB@@B@@@BAB@BAB@@AB@@@@@@@@@@@B@@@BBB@B@B@@@BAB@@@B@@BBA@@@@B@BABAB@@A@@@@@@@A@@A@@@@A@@@@@@@@C@@A@@@@@A@@A@@@@AA@@@A@@@BC@A@@@@B@@@@@@@@AB@@C@@BC@@@@B@B@@A@@BA@@@@DA@@@@@AB@@@@A@A@@AA@A@A@@@A@@BA@B@A@@@A@@B@@@BA@@@@@@@A@@B@@@B@@BB@@@B@DB@B@@D@@@BA@@B@@AB@B@@AB@B@@@@@BA@AB@@@BA@@B@BB@AB@B@@BB@@@B@@@@@@@B@B@BAB@BA@@@@B@@@@@B@@A@@@@B@@@BA@BB@BA@@B@@@@@B@@@BAA@@@BA@@@@B@BB@AB@BB@BB@BA@@B@B@BAB@@@@@@AAA@A@@@AA@@@BA@AB@AA@@@@@A@@@@D@@AB@AA@AB@@@B@@@@@BAB@@AB@@A@A@@@@@AB@@AB@@BB@@BB@B@B@B@@BBB@@@@B@B@@B@@B@@AB@@@@@@@@@@AA@@@AAAA@A@@AA@AA@A@AAAA@@@@B@@A@@@@@AB@@@BA@@BA@A@@BA@@AAB@B@@@B@@@D@@BB@@@@AB@@@D@@@@A@BBA@BB@@@@@@BB@BB@@@A@@D@@@@@@A@@BAB@@A@@@A@A@AB@B@BA@@B@@@B@B@@@B@@@@@BA@@B@B@@@@AB@@@@AB@@@@A@@@@@@@A@@@A@@B@@@BAB@BA@@@A@A@@@@@@A@CA@@@@@@@A@@@A@@@@@@AA@@AA@@A@@@@AA@A@@A@@@AB@@@AA@@@A@@@A@@B@@@@@@A@@@@AA@@@@@A@@@A@@@@@A@@@@AA@@AA@@@@A@BAA@@@@A@@@@AA@BA@A@@@@@A@@@CB@@@@@@@@AA@@B@@@@AAA@@@ABA@A@@@@@A@@@@BA@@@A@@AA@@@A@A@@AB@@AA@@A@@@A@@A@@@A@@A@A@@AABAA@BAA@BAA@@@AA@@BAAB@@AA@@@@@@B@@AA@@@@@A@ABA@@@CB@@@BA@@@A@A@A@A@@@@@@A@@A@A@@ABA@A@@@@@@@A@@@A@A@@@AA@@AB@A@@@A@@@AAA@@@A@@A@@@A@BA@@@@BAB@@@@@A@@ABA@@@CB@@@@A@A@AB@@@@@B@AA@@BA@ABA@@@AB@@@@@@@@@@A@@@@@A@@BA@A@@@A@@BAA@@@@@AA@A@@A@@A@@@@@@@@@CAA@@@AAB@A@@@A@@A@@A@@A@@A@A@@@@A@@A@A@@@A@@@@AA@A@A@@@A@@@A@@@@@@@@BA@@@@@A@@@A@A@@@AAAAAAA@@A@@AA@@@@@BA@@AA@@@C@@@@@@C@@@@@@@@A@@@@A@@@@A@@AA@@AAA@AAA@@@@AAA@AB@BAB@@A@@BC@@B@@@@@BA@A@@@A@@B@BABAB@@@DA@@@AB@BA@A@ABABA@AB@@AB@AA@A@@@@AAA@@@AA@@@A@A@@B@@@@@D@@@B@BB@@B@@@@@B@@@B@@@B@@@@AB@@AB@B@BA@@@@@AB@@ABA@@@@F@@@B@@@@AB@@@@A"],encodeOffsets:[[113365,24607]]}},{type:"Feature",id:"450422",properties:{name:"藤县",cp:[110.914849,23.374983],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@C@@@@A@@DA@@FA@AB@BABA@@@@@@AA@@@@@@B@@@B@@A@@BC@@BA@@@AA@@@A@@A@A@@CA@@@B@BA@A@A@@@@B@@@@A@AA@@@@@@AB@@@@A@@@A@@@A@@@@@A@A@@@C@@A@@@@AAA@AACA@@@A@A@@@AAAA@@@AA@@@AABAA@@@@@C@@C@A@@A@@AA@AA@A@AC@@A@@@AA@A@@A@BA@@AA@@AA@@A@@@A@@@A@@@A@A@@@@@A@A@A@@BA@@@A@AB@@AAA@@@AB@@@@BB@@@BB@@@AD@B@B@@BB@B@@@@@B@B@@@@@@@BAB@BAB@B@B@@@B@@A@AA@BA@AA@@@A@@A@@B@@@B@@@@C@@@C@A@@@A@AB@@@@AA@@@@@@AB@@A@AB@A@@A@A@@@A@@@A@ABAD@@A@@@@@@@A@A@@BA@@B@BAB@@ABA@@BA@@BABAB@@AB@B@BBD@BB@@BDDBBBDBB@B@@AD@@AB@@C@A@AACAA@AAA@A@@@CDCBAB@@@@AB@B@BBB@@@B@@ABAB@B@@A@@@@B@@BB@@@@AB@@A@@@@@@@@D@@@@@B@D@@@@B@B@@@ABAB@@@@@@@B@@@@@B@@@@A@@B@B@@@B@@@@@B@@@BAB@BA@AA@@A@A@A@AA@@@@A@@@A@@B@B@AA@@@A@@@AA@@@@A@@@ABAB@@ABA@@BA@@@C@A@AA@@AA@BA@@@@@AA@BA@CB@@AB@@@@@B@B@B@@A@@@C@A@@@AB@@@@A@@AA@AAA@A@@@A@A@@@A@@@AA@@@@@@C@A@AB@@@A@@@A@A@A@@C@AA@@AB@@A@A@@@CA@@A@A@@@A@@ACC@@@A@AA@B@@@B@BA@@DB@@ACAA@@@AA@@A@@@@BAB@D@@AB@B@BA@@@A@@@@AA@@A@@BA@A@@@@@@BA@@@AB@@ACB@@AAAB@@A@A@A@ABA@@CCAB@B@@G@@@AA@@@A@AA@A@AA@AEA@BA@A@@@@@AB@@@DAB@B@B@B@@A@A@A@A@@@AB@BAD@@@BAB@BA@@B@@A@@@A@@@@@ABAB@B@BABA@A@@AA@@@@@AAA@@@AB@@CDA@@AA@A@@A@@@C@AA@AA@@@BA@@B@D@B@@A@A@A@@@A@AB@@GA@@AB@@@@@D@B@@ABA@@@@@BB@B@@@BB@BB@B@B@B@@@@AB@@@BA@@B@@A@@@A@@@@@A@@@@AABBB@B@@@@@B@BA@@BA@@@@@BB@BB@@@BA@@@ABA@@@@B@@@B@@@@@B@@B@@@B@B@B@B@@AB@@@@@BA@@@@B@@AB@@BB@@@@B@@@@B@B@@BB@@BBBBADB@@B@@ABAD@@A@@B@@@@DB@BA@CB@@@BAB@B@@D@@@B@D@BA@@B@@@B@B@@@@B@@@@A@@@A@@@B@@B@@AB@@@@A@@BBB@B@@A@@A@@ABA@BD@@AB@@@@@A@@A@@@@B@B@@@@@B@@AB@@@@@B@@@@C@@@@B@@@@C@@@@@AB@@A@BB@@@@B@B@@@@@@@@B@@BA@@BA@@@@@@@B@@AB@@BB@@CBA@EB@@@@@@@@@B@@AB@@@@BD@B@@@@AB@@@@@B@@@BA@@B@@AB@BBBB@CBAB@BB@@B@@@BBBCBB@@B@@@B@@@BB@@@@BA@@@A@@D@@B@@@@BB@@BA@@BA@@@@B@@@B@@@@B@@@B@@@@ABA@@B@@B@@@BA@BBBA@@BBB@@@D@@BB@@@B@B@@@@BB@BB@@B@@@B@@@@@@BB@@@BB@@B@@B@B@@@@AB@B@@DBB@@B@@@@@B@@B@@BBB@@@BB@@B@B@@B@@@@B@@B@@@@@B@B@@B@@B@BB@@@@BD@B@@B@@AB@@AB@BB@@@A@ABA@@BABA@@@AA@@@@@@AB@@@@A@@BA@@B@B@@@@@A@@A@@BB@@@A@@@BB@@@@A@@B@@A@@@@@B@@@@BA@@@ABA@@@@@A@@BA@@B@@A@@DA@A@@@@AA@@A@@@@@@AB@B@@@@BB@BBBD@@@B@@BB@@BB@@B@@@B@ABB@@BBA@@@@@@DB@@BB@BB@B@B@@@@@@@@BAB@@@@B@BB@B@@BBBB@@B@@@@@@BB@@B@@@@ABBDB@BA@@@BB@BB@@B@DABA@@BBBA@B@@@BB@@BB@@AB@@B@@@@@@B@@@B@@B@@@@@@ABA@@@@BAB@@@@B@B@@@@B@@@@D@@@@BBA@@B@@@@B@@@@@@AB@@B@@B@@B@@AB@@@@@B@BB@@@@@@@@AB@@@B@@B@BA@@B@B@@B@@@B@B@@@B@@@D@@D@@A@@B@B@@@B@@@D@@@BBB@B@@@@@DA@@@@@D@@BB@@B@@@BB@@B@@@@@B@@@B@@@@B@@@@AB@@@B@@CD@@@B@@@B@B@@AB@B@@@B@@@BB@@BB@@@@BAB@B@@@B@@BBB@@B@@@@@BBB@@B@A@@BA@@B@@@@@@@B@@@@@@DA@AB@BA@B@@BABBBA@@BA@A@@@@@@B@@B@A@@@@B@@@@@@BB@A@BB@@@@@B@B@@@@@@@@@@@B@@@@@B@@AB@@B@@@@A@@@@@B@BB@AB@@@B@B@@@BBB@@@B@BABBB@B@B@@ABA@@B@@@@@BABABA@@B@B@@AB@D@@@BA@@B@@@@@BB@BB@@DDBBBBBF@@@@B@@@@BA@ABC@A@@@C@@@AB@@CBA@@@AA@@ABA@A@A@@@A@@@@@ABA@@BA@A@ABABA@@@AB@@AAA@@@AA@@A@@@@B@BA@@@ABABA@@@@BB@@B@@ABA@A@@BA@AD@@@@@BA@A@C@@@A@@@@@A@A@AB@BA@A@AB@D@@A@@@@B@@@@A@@@A@A@A@A@@@A@@@AA@@A@@AA@@@AAAA@@A@@@@@AA@@AB@@@@AAA@@@@@A@@A@@@@A@@@@BA@A@@@@BC@@AA@@AA@A@A@@@@@AB@@BD@@@@AB@BA@BDBB@@@B@@@BBBB@@B@@AD@@A@C@A@@@BBA@AF@@CB@@@@ABCFB@B@B@@@@@BBB@B@@@@BBBBB@B@@@B@D@@A@@BA@@@@BA@@@@@@B@@@B@@ABABAB@@AB@@A@@@@@BB@@AB@@AB@@A@AAA@A@@@A@@A@@@@A@@@A@AB@A@@@@@@C@@AA@@@@@AA@AA@@BAB@DBB@@B@@B@@AAA@@AA@@@A@@B@B@@@@@B@@ABBB@@AB@@BBB@BBB@@@B@B@@@B@@@B@@BB@B@@@B@@@B@@B@@B@@B@@@BB@@@B@@@B@@@BA@@@@B@@@BB@B@@@@C@@@@BA@@@@B@@@B@@@BA@@@@BA@@B@@BB@@A@@@A@@@@@A@@@A@@@@@@@@@A@@@@@@AB@@@@@A@@@@@A@@@@@@@A@@@@@@@@@BB@@A@@@AB@@@@B@@@B@@@B@BB@@B@@@B@@@@@@@C@@@AA@@@@B@B@@@B@@@@@BBBBB@@B@@@@@@A@@@A@@BA@@B@@@BA@A@@@A@@B@@@BBB@@@B@@@B@@@BB@@B@@@BBB@B@@@BAB@@A@@FB@@@B@@@B@@B@@BBB@@@BB@@@B@@BA@B@@BB@B@BBB@@@@BBBBBB@@@@@@@@@@@BA@@A@@@@CC@@@AAB@@@B@BB@@A@B@@@@@@@@AB@@@B@@@@BB@@@B@@@B@B@@A@A@@@@B@@@@@@BB@B@AAA@@@@A@@@@B@B@@@@AB@@@@B@BB@@@BB@@@@BA@BB@BB@@@@BB@BBAB@B@@@B@B@BA@@B@B@@A@@@@A@@@@AB@BBB@BAH@@@@HFHDBBFDHDBBB@@@F@D@@@BABA@A@A@A@@@A@ABADCBAB@B@B@FB@@B@DFB@@B@@AB@B@@BB@@AB@@@BDBB@@AB@@@B@@BBBBB@@B@@@BD@@@@B@@B@@AD@@@B@@@B@@ABAA@@A@A@@@@B@@@B@@BBB@@@@BB@@DBB@@@B@B@@@B@@@FBB@@@B@BAB@BAB@BAB@@AB@BA@A@A@@@ABA@A@AB@@@BA@@@BB@B@B@B@@@BB@@@BB@@@DAB@B@@@F@BA@@@@BB@AD@@BB@B@BAD@@@@@B@@@B@@@BBB@BAB@@@@@B@@BB@@@BAB@@@DBBA@@@BBBDAB@BA@@BABA@@B@B@BA@AF@B@@AB@@@BA@BB@B@@@B@@B@@B@@B@@BB@B@B@BBBB@@@B@BA@@B@BAB@@@BABA@@@@B@@@BAB@BA@@@AB@BA@@@A@CB@@ABAB@@AB@@@@@@@A@AA@@@AA@BA@@@A@@@AB@BBB@B@@AB@@AD@@@@BBB@B@@B@@B@@B@@BB@@@@A@AB@@A@@BB@BA@@@@BB@@B@@BA@@@@B@@@@@D@@A@ABAB@BABAB@@ABAB@@@B@@@B@@A@@B@BA@@BA@@@@@A@@@A@@@A@A@A@AB@@A@@A@@A@@@@@A@@@A@A@@@@@A@A@@AA@A@@@A@A@@B@@@@@B@@@BB@@B@B@@@B@@B@@BD@@@@BDBA@BB@@@B@@B@B@@B@BB@@@@@BB@@@B@B@B@@A@@B@@@BB@@@@@BBB@@BB@@BAB@@A@AD@@@B@@@@@B@@@B@@@B@@@B@B@B@@AB@@A@@D@BAB@B@BABA@A@@@@@A@@BA@@@A@@AA@@@ABABAB@@BBBB@@AB@@@B@B@@A@A@A@@BA@@@ABA@@@A@AB@@@A@@ABA@@B@@ABAB@@A@ABAA@@A@@@A@@@@AA@A@A@@@@B@@@@@B@@@@CBA@@@@BABA@@BA@A@A@@A@@AB@@@@@BA@@B@@@B@@@BA@AB@@AB@@@B@@@B@B@@@@ABA@@@@B@B@@@BB@@@@BBB@@ABB@@BA@@B@@A@@@BD@@@B@@@BBB@@@AB@B@@@B@B@@B@BB@@@@A@@B@@AB@@@B@@@B@@@@@@A@@@@B@B@@@@BBBBA@BBAB@@@B@@@@B@@BB@@B@B@@B@@@B@@@@@@B@BB@@BBB@@@B@@@BA@@@@B@@@@BB@@B@@@@B@@B@@@BAB@@@B@@C@@@A@@B@BA@@BABB@A@@B@@BB@@@BBB@@BB@B@@B@@B@@@B@@@@@BA@@@@@@B@@B@@@@@@B@B@B@@B@@@@B@@@@@BB@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@BAB@@@B@@@@BB@B@@@@@BA@@@A@@@CB@@A@AA@@@@AB@@AA@@AA@@B@@@AA@@@AC@@@@@AA@AA@@@A@@BA@AB@@CAA@@@AA@@@B@B@@A@@B@D@@@BAB@@B@@@@B@B@@@BB@A@@B@@@D@@@@A@@@@D@@A@@@A@@@AB@@@B@@A@BB@B@@@BB@@@@BBB@@BB@@@BA@@B@B@@@B@@@@@@@B@@@BB@@B@@@@@B@@@B@B@@@@@@@B@@B@@B@@@BB@BB@@B@BB@@@@@@B@@@@@@B@@@@@B@B@@@@@DABA@@@@@@@@B@@B@@B@@@@@B@@B@@@B@@@@@@B@@B@B@@@B@@@BB@@BB@@@@BB@@@@BBBB@@@BA@@@@B@@@B@@@@BB@@@@@@@BBB@D@B@BBF@F@B@@B@B@@@@@B@BAB@@@@BBBBD@@B@B@@@B@BB@@@@BBB@@BB@@BB@@B@@@BBB@@A@@@@@A@A@@@@B@@BB@@@BBB@B@@BB@@@@@@@BBB@@A@@@@@A@AB@@@@A@@@@@C@@AA@AA@@A@A@@B@BABBB@@@@@B@B@BB@@B@@ABA@@BB@@B@@@@@@@B@@@@@@@BA@@BA@@B@@@@BD@B@BA@A@@@AB@@@@@@@BA@A@@@@@@@@@@@A@@BABA@@@A@@@AB@@ABA@ABA@@BB@@BB@AB@@@B@@@BA@@BA@@@ABA@@BA@@@@@@B@BA@@@A@@@@@A@@@@@ABA@@AA@@@A@@@AA@@AAA@@@@@@A@A@@BA@@AACAAA@@@AB@@@@@B@BBDB@A@@AAAA@@A@@A@@A@@A@@A@@@AAA@CA@@@A@@@A@AAACC@@A@AAA@CB@@@@@@@@@A@@@AA@@A@@A@A@AAAAA@@BA@@@AA@@@@@@A@@B@@@@@@@@A@A@@@A@AAA@A@AA@@@@@@A@@@A@@@@@@B@@A@@BB@@@AB@D@@@B@@A@@@@B@@@B@@A@@@A@@@A@AA@@@@AAAAA@@@A@@@AA@@@@A@AB@@@@@BA@AB@@BB@@A@@BA@@@A@ABA@@B@@AB@@@B@@@@BB@@@B@@@@@@AB@@A@@BA@@B@@A@@B@@@B@@AB@@@@@BA@@@@B@@A@@@ABC@@@A@@BA@@@A@@B@@@B@@@@@B@@@B@@@@ABAB@@@@@B@@@@@@B@@B@@@B@@@@B@@B@@@B@@BBAB@@@@@BB@@B@@@@@B@@B@@@@@@@@@@@B@@B@@@@B@@@@@B@@@@@A@AB@@@@@@B@@@@@AB@@@@@@BB@A@B@@@@@@@B@@B@@@@@@@@@@@@B@@@B@@BA@@B@@BA@ABA@@B@@AB@@A@@B@@@@@@A@@B@@AB@@@@@B@@@@ABAA@@A@@B@@A@@@@BA@@@A@@@AA@@@@@A@@A@@A@@@@@@@A@AA@@@ACA@@@@BA@@B@@@B@@@@@@@B@@ABC@@BA@@@A@@@A@@AA@@@@@@A@A@@@@A@AA@AB@AA@A@@@@A@@@@A@@@AB@AA@A@@@@A@@@@BC@@A@BA@@@@@@@AB@@@@@B@@@B@@@B@B@@A@@@@@@@AF@BAB@@@B@B@@AB@@@B@@@@@B@@A@@@C@A@@@@BA@@@@B@@@B@@AB@B@@@@@B@@@@@BA@@BA@@BAB@@AAA@@@A@A@@@AA@AA@@@ABA@AAAB@@C@A@AA@AA@A@A@A@@@@@@@@AA@@BAA@@@@A@@@A@A@@@A@@@AA@@A@@@A@A@AA@@A@@@@@@AA@A@@@AAA@@@@@A@@BA@AB@BA@@@@@BB@@BB@@@@@@@@@@@BB@@@@@@AB@@@AB@@BDB@@@@ABA@@@BB@ADA@AB@@@@@@BB@@@B@@@BA@@@@@@B@BB@@B@@@@A@@@A@A@A@@@AAA@@A@@A@@@@@@BA@CBA@@@@@@BA@@@CD@@@@@B@@@@@@A@@@CBA@@BAB@BA@@@@@AA@@@@AA@@A@@@@@ABA@@@A@@@@@AA@@@@AA@@A@A@A@A@AAC@A@@@A@@B@@A@@@@@@@@BA@BB@B@@@B@@@@BB@@@@A@AB@@A@@@@@A@@@@AC@@@@@@@@B@@A@@@AAAA@@@@@AA@@@@A@A@AA@@@A@@@AB@BCDAB@@@@@B@@A@@@AB@B@B@BAB@BBB@@@B@@@B@@@B@@@B@@@B@@BB@@@B@@A@@B@@A@@B@@@@@B@B@BA@@@@BB@DB@@@B@@@@@BBB@@@B@D@B@@B@@@@@@B@B@@@B@@@B@@@@@B@@@@@B@@@@@B@@@BA@@BABA@@D@@@@@B@@@@@B@@AB@@@@@B@@@BB@@@@@@BB@@BB@@B@@@B@@@BB@@@@@B@B@@A@@DB@@B@B@@@BB@@BB@@B@@@B@@ABBB@@B@@@@BB@@@BB@@BB@BB@@BB@@@B@@D@@@@@@@BBBA@BB@@@@BB@BB@@B@@@B@@BD@@BB@@@@@@B@@@@@B@B@B@@@BB@@B@@BB@@B@@@BB@@@BBB@@@BB@@@BB@@B@B@@@B@@BB@@@@B@@@@@@@@@B@@@BA@@@BB@@A@@B@@@@@B@@@@@B@B@@A@BB@B@@@BAB@B@@@BBBBB@@A@@BB@@BAB@@A@@@@B@BB@@B@B@@@@@BB@@BAB@B@@@B@@@B@@AB@@AB@@BB@BAB@@@@@@@@@@@B@@@@BB@B@B@B@B@B@@@@@B@B@@BBB@@@@@BB@@@B@@@@@B@@@B@@@B@B@@@@BB@@BBB@@@@@@@@D@@@D@@@@@BB@@@@@B@D@@@B@BA@@DA@@B@@AB@@@B@DCD@DA@BB@@@@@B@B@@ABB@@BAB@DAD@B@B@@BB@B@B@@@FBB@B@@@BBDBD@BBB@F@@BABABCB@@@B@BB@@@BB@@@@B@@B@@@@@@D@B@@@@B@@B@@@B@@BBA@@@A@@FB@B@@@@@@B@F@@@@@BB@@@@@@B@BB@@B@@B@@B@@BB@@BB@B@B@BB@BFHBDBBBBBBB@@B@@@BBB@B@@@B@D@@@DB@@@D@@@BBB@@@@B@@BBB@B@B@@@B@@@B@@@B@@@@@B@@@BABA@ABC@@AA@@@AB@@A@@@@@A@@BA@A@@@A@@BA@A@@BBD@BA@@BA@@B@BA@@@BBAB@@@B@@@B@@@B@@@B@BB@@@@@@B@@@@@@@B@@B@@B@@B@ABB@@BAB@@@@@BAB@@@BA@A@@@@@BB@@@@@@BB@@@@@@@@BBB@@@@@@BA@@@@B@@@@@@BB@@@@@B@@@B@@@@@@@@@B@@@B@B@@@B@@@@A@@@A@@@A@@BA@A@@@A@@@A@@@@@A@@A@@A@A@@@A@@@@@A@@@A@A@@@@BA@A@@@A@@A@@A@@A@@A@@BA@@@@@@@A@@B@BA@@BAB@B@@@B@DABA@@B@@@@@@A@A@ABA@@@@A@@A@@BA@@@AB@BA@@@AAAAA@A@A@@B@@A@@@@BAB@@A@@D@@@B@@A@@@@@A@@@A@A@A@@BA@@@@B@BA@A@@@@@@BAAAB@@@@A@@@AB@@AB@@@@@@A@@B@@A@@@A@AB@@@@A@@@A@@B@@@@A@@B@@AB@@@@@@AB@@A@@@A@@@@@ABA@@@@BA@@@@B@BA@@@A@@@@@A@@@A@A@ABAA@@AAA@@AADA@AB@@A@ABA@A@@@@AAAA@@@A@@@@BA@@@@@@@A@@@@@@BA@@@@@@@A@@@@B@@AA@BA@@@@B@B@@@B@@@@AB@@@@A@@@A@C@@@@B@@@B@BA@@@A@A@@B@BDB@@@@A@@AA@@B@@AB@@A@@@@BA@@@@B@@A@@@AA@@AA@@@@@@A@@A@@AB@@AB@@A@A@@@A@@B@BA@@@@@@@A@@@A@A@@BBB@@A@@BA@@@ADA@@@@BA@@BAB@@@B@@@@A@A@@AA@@@A@@@@@A@A@@@AA@@AA@@A@@A@@AB@@AA@@A@@A@@A@@BA@@A@@A@A@@AAAA@@BA@@@@@@@A@@@AAA@@A@@A@@@@@@@A@@@ABAA@B@@@@A@@@@B@@@@A@@B@@@@@@AB@B@BA@@@@BA@@@@BA@@@A@@B@B@BAB@@@@@BA@@@@@A@A@@@A@AB@B@@AB@@@@@@@@A@@@@B@@@B@@BB@BA@@@@BB@@@@B@@@@@@@@@BB@AB@@A@@BA@@@@BA@@BA@@@@@@@A@@BA@A@@B@@@@A@A@AA@@AB@@@@@@@@AB@@@@A@@@ABB@@@@@@B@@@@@@A@@BA@A@@B@@@@@@C@@B@@@@A@@B@B@BB@AB@@@@@B@@@@@B@@@@@@@B@@B@@B@@@BABB@@D@@@B@@A@@@@B@B@@AB@@@@AB@@@@@@A@A@@BB@AB@@@@B@@@BB@@@@BBBB@BDB@@@B@@@BBB@DB@D@B@@@@BB@@@B@@@B@@BB@@BA@@@@B@@B@@@@@BB@@B@@B@@@AB@@@B@@@@@@@BBD@@@@@BBB@@B@@B@@BA@@@ABA@AB@BA@@@BB@AD@BAB@B@@@BDABC@@B@B@@AB@@@@@B@@@B@B@BBB@B@@AB@B@B@B@@@BBB@@@B@@BBB@@B@@@DAB@B@BC@BB@@B@@@@@B@BB@@@BB@D@@B@@@BBD@B@@@BBB@B@BA@@@@B@B@@@B@@@BBB@@@B@B@@A@@BBBA@@@@B@@@@@B@B@B@B@BCB@@BB@B@@AB@@A@@B@@@B@@@BAB@B@BAB@@AB@B@@@@A@@@A@@DB@@B@@@@@BA@@BB@@@@@BB@@BB@B@@B@@@BAB@@@B@B@@@B@@@@@@@B@@@@@B@@B@@@@@D@@@@B@@@B@@DB@B@@BB@@B@@@B@@BB@@@B@@@BB@@B@B@@@BB@@B@BB@@BB@@@@@BB@@@@@@D@@@@@@@B@B@@B@@BBB@BBDB@B@@B@@@B@@@@@BA@@B@@@BA@@@@@@@@@@B@@@@BBBB@@@@AB@B@@B@@BBB@@BB@B@@@B@@B@BB@@BB@@@B@@AB@@@BB@@B@@B@@@B@@@B@@@@B@@@@@@BB@@B@@@@@B@@@@BB@@BB@BBB@@BA@BB@@@BB@@@BBB@@B@@B@@@@@@BB@@@@B@@@BB@@BBBB@@BB@BD@@@@@@@@@BB@@@B@B@@@@@B@@@B@BB@@B@@@@@@@B@@@B@@@@AB@@@B@@@@@B@@@@A@@B@BAB@@A@@B@@@BA@A@A@@AA@@B@@@B@@A@@@@B@A@@A@@@AB@@@@A@@B@BA@@@A@@BA@@@AB@@@B@@@B@@@B@@@@A@@B@B@@@@A@@@A@@B@@A@@@@@@B@@@BA@@@A@@@AD@@A@@DAB@BA@A@BBA@@@AB@@A@@@@B@BA@@BA@@@@@@B@@A@@BC@@AA@A@@@@@AB@@@@@@@B@@@@@B@@AB@@@BAB@@@B@@@B@@A@A@A@@@A@@@@BC@@B@B@@@B@B@@A@AB@B@BA@@@A@@BA@A@@B@@@BA@@@@@A@@@@BA@@B@@@@A@BB@@@@ABB@@@@B@@@B@@@B@AABA@@@@@@B@@@@@B@@@@A@@B@@@B@@@B@B@@@B@@@B@@@@@B@@@D@B@@@BB@@B@@@@@BA@B@@B@@@@@B@@B@@@A@@B@@@B@@AB@@@@@@@@A@@@AA@A@@AB@@A@A@@@@@CBCB@@@@@@@@A@@@A@@B@@@@@@@B@B@@A@@B@@@@AB@@A@@BAB@@@B@@@@A@@@A@@@@BAA@@A@@@@@A@@B@@A@@BA@@@@BA@AB@@A@AB@@@@A@@@A@A@@@@@A@@BC@@A@A@@@@@@@AAA@@@@@AA@@@AA@@AA@@A@@A@@AAA@@A@@A@@@A@@A@A@@A@@@A@@@@A@@@@AAA@@@@A@@@@ACA@@@@@A@@@@AA@@@AAABA@@A@@A@@A@@@ABA@ABA@@@A@@AAA@@CAA@@@AAA@A@@AC@@A@@A@@@C@@@@A@A@@AA@@@ABAB@B@BAB@@A@@B@@@BAB@@A@A@A@@B@B@@BB@BB@@B@BBB@B@AA@@@@@A@@@C@A@@@@BAAA@@@AA@@A@@@AA@@A@@@A@@BA@A@@BB@@@ABA@A@@@@BA@@B@AAD@@A@@A@@A@A@@@AB@@A@AB@@BB@@A@@@@@AB@@@B@BB@@BA@@B@@@BBB@@@@@B@B@@@BBBBB@@@@BBB@@BB@BB@BAB@@@@BB@@@B@@@B@B@@@BBB@@@@@BB@@@@@BB@B@D@BAB@@@D@B@B@@@@A@@B@@AB@@A@A@@@A@@@A@@@@@AB@@@BA@@@A@@@A@A@@AA@@@@@AB@@@@@@A@AA@@@@A@@B@@A@AA@AA@A@A@@@ABA@@@@@A@@BA@@B@@@@A@@@@BA@@@A@@@@AA@@@@C@AA@AAA@@@A@C@@@@AC@@AAA@@AA@@AA@A@A@@AA@@@A@@@AA@@A@@AA@A@@@AA@@@@@@BAAA@A@@@AAA@@@A@@AA@AB@@@@A@@B@@@@@B@BA@@@@@@AA@@B@BC@@B@BA@@B@B@BAB@@@@@@@@AB@@A@@@@BA@@B@@@@@B@@@B@@A@@@@BA@@@A@@BAB@@@@A@@@@B@@@@@B@@@B@@@B@@AA@B@@AB@@@@@@@BA@AA@@@@A@@BA@@A@@A@@BAA@@A@@BC@@AA@@@@BA@@BAA@@@@AB@@@@@@AA@@@@@A@@@@@@@AA@@@@@AA@A@@AA@@@@@AA@@@@AA@A@A@A@@B@@AB@AAAC@@@AA@@@BA@@A@@A@@AABA@@@@@@B@@A@@B@@@AA@@@A@@@A@@@A@@A@BA@@@@@@@AB@@@@AA@@@@A@@@A@@A@@@@C@@BA@A@@BA@@@AB@@@@AB@@@@A@@@ABA@A@A@@@@BA@@@@B@B@@@@A@@@A@A@@@@@A@AB@@ABABA@@B@@@B@@@@@@A@@@@BAB@@@@@BA@@@BB@@AB@B@@@@@@B@@B@@@BB@@@@@@B@@@B@@@@A@@@@B@@@@@@@@A@@BA@@@A@@@AB@@@BA@@@A@@@@@A@ABA@@@@@@DCBA@@@A@@@AAA@@@A@@@@BA@@@A@A@@@A@@@A@@@@B@@ABA@@@@@@BA@@B@@@@@@@B@@@B@@AB@@@B@@@B@@B@B@@B@AB@@B@B@@BBB@@@@BBB@B@@BB@B@@BB@B@B@@@BB@B@@@B@@B@@@DA@@@@B@B@@@B@@AB@@@@A@@B@@@@ABB@A@@B@@A@@@@@@@A@@B@@@@@@AA@@AB@AA@@BA@A@@A@@A@@AA@@BA@@@A@@AA@@@A@@B@@ABA@@AA@@@A@@AA@@AAA@@@@AB@A@@A@@AAA@A@@A@@A@A@@B@A@AA@@A@@@@@@A@@AA@@@@AA@@@A@AA@AA@@@@@AA@A@A@@AA@@@A@@A@@A@@A@@@@AA@AA@@A@@AA@A@@@@@@@AAA@@@@@@@AA@@AA@@A@@@AAAA@@@A@@@@@AA@@A@@A@@@A@@@A@@AA@@@@@@@AA@@@A@@@@A@@@AA@A@@@AA@@@AAA@C@@@AA@@@BA@@@A@@@ABA@AB@A@@@@A@A@@@@BA@@@AB@@A@A@A@@@@@@AB@@@@@@AA@@@A@@@@@@A@@@@@A@@@@A@@@AA@@@@@A@@@@@AAAB@AACCECEA@C@AGK@G@GBCA@@EA@@AA@@A@AC@@@@@A@CA@@A@AAAE@@AA@@A@AAA@ACBA@@AAB@@@B@BABCDE@@@AFA@@@AB@@@@@DC@A@@BCBC@@@@AA@@A@C@GCAAAEAGIEA@BAA@ECAAE@@@@AEAAA@ABA@@AA@A@@@A@@BA@A@AB@@A@@@@BC@@B@B@BAD@@@AA@@@AB@@@@@BAB@B@@@B@B@BBB@@@@ABA@AB@@A@@@A@@@AB@@A@@@AAAB@@@B@@AB@BA@@@AB@B@B@B@@@@A@A@@@A@@BA@@@@@A@A@A@@@@@@B@B@@@B@B@BAB@@@@@B@@@@@@C@A@@@ABA@@BCB@B@BABBBAB@DEB@@A@@BA@@A@@AA@A@A@@AA@@AA@@A@A@@AAACA@CB@AE@@@@A@@B@@A@@A@@A@@@@BAB@B@@AB@B@@@D@@@AA@@AA@A@@@AB@@@B@@@BA@@@A@@@@B@@ABAB@B@@DDDB@D@BB@@JEBB@@@BAB@B@@@D@B@BDBFD@@@@AB@B@B@@@BBBBB@BBB@B@@BB@B@B@@@@@@@B@@@@@B@@@BB@@D@@ABB@@B@B@@@@@@ABBB@BB@@BBB@B@B@D@B@@@@B@@BB@@D@@LD@BBDD@@@B@@DABADBBDD@BBB@BD@B@@@@AD@FAB@BAACB@@A@A@AAA@ABCD@@AAA@A@A@EAA@CEAC@ACCEC@@A@A@@AAAA@C@@@IAAFABCB@DBD@DBD@@@@@B@@A@@B@@@@@@@B@@AD@@@@A@@B@D@@A@@BA@@B@@@@A@@D@@@@A@@B@@@@A@@@C@@B@@A@@@ABA@@BA@@B@@A@@@AA@@@BA@@@C@@@A@@BA@@@@AAAA@A@@@AA@@A@A@@@@@A@@@AB@@A@@@@AA@@@A@@@AB@@@DA@C@@@@BABA@AB@@@B@@@BA@AB@B@D@B@@A@@BA@A@@@@@@BEB@@A@@B@@@@@@A@ABA@@B@@@@@BA@@BA@@@@BAB@@A@@@@@@BA@@@@B@@BA@@@@@@@B@B@BABA@AB@DDHBD@H@LAJ@JCDA@@N@NBNBDBFB@@B@LFHFLNA@DB@@@BB@HBTAH@PGNDBB@@DBHDLBFBB@ZKRMHCDAJA@@PCB@NGFABAHGD@JAJAHA@E@AA@@A@AB@@AB@@@@ABAAE@C@@B@@@@CBA@AD@@@@AB@@@B@@A@@AAB@@B@A@@B@@@@A@@BA@@AA@@A@@BA@@A@ABA@ABA@A@@A@@B@@@@A@@A@@BC@A@@A@AABAB@BAB@@@BBB@@@B@@ABCBA@@AC@A@@@@@AAA@@B@BBD@@@B@@@@BB@@A@@@AB@@A@@BA@@@@@@@AC@C@@AB@BA@A@@AAB@DC@@@ABAA@@@AA@@BA@A@@@@@A@A@@@A@@AA@@@A@AAA@A@A@A@@@@A@@@@@@@A@@AAAA@@@A@ABA@@@@B@@@B@@A@A@A@A@@@A@A@@@@@AAA@A@@@@@@B@@EBA@@@A@A@@@A@A@AA@@AD@@@B@B@@ABA@@@AB@@@@@@CCA@A@@@A@CA@@@A@@AAAA@@ABA@@@A@@@AA@@@A@@AA@@A@A@@@ACA@AA@B@AAAB@AA@A@@A@@@@A@A@A@@@AA@@A@@@A@@BA@@@AA@@A@@@A@@BA@A@@@A@AAC@@BCB@BA@AAC@A@@AA@@@A@A@@@@BAB@BCB@@@@@BAA@@@@CB@@A@@BA@@@CBCBC@A@@BA@@@AA@@@AA@A@A@A@AA@@@@AA@@CAA@AA@BA@@@EAC@A@A@@B@BA@@@@@AAA@@@@@@BA@AAA@@@A@@@A@@BA@@@A@@@@@A@A@@@@BA@@@A@A@@@A@@B@@A@@BAB@B@@@@@@@@ABA@@@@@@B@@A@@@ABA@@@A@@@A@@A@@A@A@A@@@A@@@@A@@AA@A@A@A@@@AA@@@@@ABAA@@AAA@@@AAC@@@@@A@@B@B@B@@A@@@@@AA@@ADA@@DA@@BA@C@@@A@@@@BABAB@@ABCBAD@@@ACABC@@@A@@@A@@@AA@AA@CAAAA@@A@@@A@@@C@@A@@@CAAA@@@@AA@@BA@@AAA@@ABA@C@ABAAA@AA@AACA@@@@@ABE@ABC@ABA@@@E@A@C@@@ACAA@AACB@@A@@@@A@@AA@@AA@C@@A@@ACBABC@AC@@@ACA@AAA@@@@@A@@@AAAA@@A@AB@@A@A@ABA@A@AB@@@@A@@AC@AB@@A@A@A@AA@@AA@@AAA@@A@@C@@@AA@@@@A@AA@@@@A@A@@AA@@CC@@A@A@A@@@AA@@@@AB@@@AAA@@C@A@@@@AA@@BA@A@A@@AAAA@A@A@A@A@C@@@@@A@@BA@A@A@@@C@@@A@A@@B@@@@A@@BAB@@A@A@C@@B@@CA@@@@@@A@A@A@A@@@AAA@A@@@AA@@AB@@A@A@AD@@AB@@@BA@@B@B@B@@@@@@BBDD@@@@A@A@@@C@CA@B@@@BAB@@@BAB@@AB@@AB@BA@@@AB@@A@@@A@@@AA@@@@A@A@@@A@A@AA@@AC@@@@CC@@@AAA@@@@ACAACE@@@@@A@@A@@A@@A@@AA@@A@@A@@@AA@@@@A@A@@BA@@@CAAAA@A@A@C@AA@@A@C@A@A@@@A@ABC@@@A@@AA@AAA@A@CA@@@@A@@A@@ABA@@@AA@A@AA@A@A@A@@@A@AB@@AB@@A@@@AD@@A@@@@@A@@@@BAD@@AA@C@@@A@@@@@@B@@@@@@@A@@@ABA@@@CAC@AB@@CB@@AACD@@A@A@@@A@@@ABCB@B@@A@@BA@A@AB@B@@@B@@@BAB@B@@A@AA@@@BA@ABBB@@B@@B@@@BA@ABA@@@A@ABA@@@ABAB@@@BB@@BDBB@@@@B@@BB@@DDBBA@@BA@@B@B@@@B@@@@@B@@@BABA@A@@@@@A@@B@@BBBDBBAB@@@BA@BBAF@B@@@B@@@BD@@B@B@DA@@B@B@@A@C@C@@@A@@@AAAA@AA@@@A@@@@@@BA@@@A@A@@@@@AB@@A@@AABA@@B@@CBEBA@AB@A@@@A@@A@@@@AC@ABA@@BA@@@@B@@A@E@A@A@AAAB@@@AA@@AA@AA@@@@A@A@CAE@@@@@A@@A@@@@B@B@B@B@@@BABC@@B@@@B@@A@A@@A@AAAA@@AAAA@@A@@AA@@@A@@@A@@@A@AAAA@@C@A@@@@A@@AA@@@AA@A@A@A@@@A@@@A@A@@BA@AB@@A@@BA@ABA@@@@@@BA@@@@@AB@@AAAA@@A@C@@AA@@@BC@@@AA@@@@@A@A@AAA@@A@@@@@A@@AA@@C@C@A@@@A@A@A@@BABA@@@A@AB@@CB@@AAA@EAA@@ABAAA@@@A@A@C@A@AAA@@A@C@AAA@@AA@@A@CAA@@@@CA@@@AA@@AA@AA@@AA@AAAA@AA@@@A@@@C@@@A@@@@DAB@@ABC@@BAAAA@AA@@@A@@@ACAAA@@@@@C@AB@@A@A@@BAD@BAB@@@D@BA@@B@@@@AAA@ABA@ABABABA@@@E@A@@@@AA@A@@BABA@A@A@@@@BABA@@@@BAA@BA@@@@@A@@@A@A@@@@@AA@@@@@@AAA@A@A@@B@@CB@AA@@@A@@@AA@@AA@A@@@@@A@@BA@@BA@ABA@ABAB@@AB@@A@@@CBAB@@@@AA@AAAA@CB@B@@@B@B@B@@@B@@ABA@@@@B@@@B@@ADB@BD@BB@@D@@A@CB@@AB@@A@@@@@C@@B@BA@@B@@A@A@A@@A@@AAA@A@A@@@@@AAA@A@@@C@A@A@A@A@@BA@@@@@ACA@AA@@@A@@A@@@@@AA@AAB@@A@@@@A@@A@@AA@@B@@@@AB@@ABA@@@AB@AA@@@AB@E@A@@AA@AB@@@@AA@@E@@@ABAAA@@@@A@@@A@ABA@CAA@@@@B@@BB@@A@@BA@@@A@@@@@@@@@BA@@@@A@@@A@@@AA@@B@@@@@B@@@A@BA@@A@@@@@@@A@@@@@AB@@A@@A@@@@B@@A@@A@@@@@A@@@A@AA@BA@A@CBA@@A@@AA@A@@AA@@@@BA@@@A@@@AAA@A@@@@@@AAAA@A@AAC@@@A@@@@AA@@@@@@@A@AA@A@A@@@@AA@@AA@@@@@@@@@@@A@@@ABAA@@A@@@A@A@@@A@A@@@@@AAAAC@AAAA@@@@@A@@@A@@@A@@@@B@@A@@@@@AB@@@@AB@@@BABA@@@@A@AA@@BAA@CA@@CAC@A@A@@@A@@A@@CA"],encodeOffsets:[[113411,23628]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("玉林",{type:"FeatureCollection",features:[{type:"Feature",id:"450902",properties:{name:"玉州区",cp:[110.151153,22.628087],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@@AA@A@@DE@A@@@A@@@@BA@@@@C@@A@@AAA@@A@@A@@@@@@@@ADA@C@AB@A@A@@@AA@@@@AA@@A@@@@@AB@@AAAB@@A@@@AB@@@@@AAB@@@@@@@@@@A@@B@B@@A@@@@@AAA@@@A@@@@@@@AA@@A@@@BA@@AA@@@@A@@@@AA@@A@A@@B@@@@@@AB@@C@@@@DA@@BBBA@AA@@@@A@@@@@A@@A@@@@AA@@@@A@@@@@@A@@AA@AA@@@@@@@@A@@A@@A@@@AA@@@BAD@@@B@@A@AB@@A@A@@@A@A@@B@@@BA@A@@@@@@ACB@A@@A@@@@@A@@D@B@@AB@@A@@BA@ABA@@@CAA@A@@B@@A@@@@@@@@AB@@@@A@@@@@@BAA@@BA@@@A@@@@@A@@@@@@CB@@@B@@A@@@@@@AA@@A@AB@@@A@@@AAB@BA@AB@A@@BA@A@@@@@AAB@@AAAB@@A@@@C@@@@@A@@@@A@@@@A@@@@@@@A@@@ABA@@@@BA@A@@@@@@AAA@@A@@@A@@@@@@A@@@@BA@@@A@@@A@CA@@@AAB@@A@@BA@ACABA@@@A@A@@AA@C@@AA@@@@B@B@@A@A@@@@A@@@A@@@@@@@ABABA@A@@@@@@A@@AAA@@ACACA@@ACCE@@@ADIBK@@B@@@@@@@BA@@D@@BB@B@@@B@@@B@@@@@@@@ABA@A@@BA@@@A@@@@@@AAA@A@@ACC@BA@@B@@@@AAA@@A@@A@@@@@@@@@AB@@A@@@@A@@A@@@A@@A@@@@B@@AB@@@AA@@@@@@@@AA@@@@A@@@AA@@@@A@@@@@AA@@AB@@@AA@A@@B@@A@ABABCDEDAB@BA@A@@AA@AD@@@BB@ABAB@@A@@B@@@BAB@B@@@B@@@@BBB@AB@@AB@@@A@@@B@@@@@B@@@@A@@@@BA@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@A@@@@A@@A@@B@@B@@@@BB@A@@@@@@@BBA@@@@BA@@@@@@BB@@@B@@@@@BDA@@@@B@@@@AB@@@@ABB@@B@@@AA@@B@@@B@@AA@@@BABA@B@@@@@@BA@@@A@@@B@@@@AA@@B@@@@@@@B@@A@@@@@A@A@@@@D@@A@@@A@@@@B@@@@@@A@@@@@@@@@@@AA@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@A@@@B@@@A@@@A@@@A@@ABACC@BB@A@@@@@@@@@@@@@@@@@@B@@@AB@AB@@@@@BA@@A@@@@@@@A@@A@@@@@@@A@@B@A@BA@@@@@@@@@@B@@@@@BB@@@@@@B@@@@@A@@@@AB@@@@A@@A@@@@@@A@@@@@@BAA@@A@@@@AA@@@B@@A@@A@@@@@@@BA@@A@@@@@@@A@@@@@@A@@@@A@@@@A@@@B@AA@@A@@@@@@@@BA@@AA@@@A@@AB@A@@@A@@@@A@@@@@A@@@@@A@@@@B@@@@@@A@@@@AA@@@@@AD@B@@@@@@@@@@@B@@@@ABB@@@@B@@A@@@@B@@@@@B@@@@@@B@@@@B@@@@AA@@@B@@@@@@@@@@A@@BA@@@@B@@ABA@@@@@@@A@@@@@@@@@@B@@@DA@@B@BA@@BA@@@@@A@@@A@AB@@@B@@@@@@@BAAA@@B@@B@@BA@@@BB@@A@@@@@@@BB@@AA@@@B@@@@@@@@@B@@@@@B@@ABDB@@@BB@@B@@@B@@@@@@@@BA@B@@@B@@BBA@@B@@B@@BC@@@A@@@@B@B@B@@@BA@@@E@BB@@B@@B@@CBCB@B@@B@@@@B@@AB@@@@@@@D@@BBB@@BDBA@@@@@@B@@@@@@@@@@@@@@@B@A@@@B@@@@@@@@@@@@@B@@@@A@@@@A@@@B@@A@@@@A@@AB@@@BAB@@@@AB@@@@@BA@@B@@@B@@@@B@@@@B@@@@AB@@@@@@A@@@@B@@@@A@@@@@@@A@@@@@@@@@A@@@@@A@@@@@AAA@@@@@@@AA@@@@@@@AA@C@MEABEC@B@@A@@@E@CBEDABB@@B@@@@ABABAAAD@BA@@@@@@@AA@A@@A@@@A@@A@BAA@B@@@@@@A@@@@@@@@@ABA@@B@@@@A@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@@A@@@@@A@@@@@@@A@A@@BAB@@A@@@A@@@C@@@AA@BC@@AA@@@A@BBA@@@@A@@A@@@C@@@@A@@AA@@A@@A@@ABA@@@@@@@@@AB@@@B@@@@A@@A@@A@A@AA@@@@A@A@@@@@A@@@A@@@AA@A@@A@@BA@@@@BAB@B@@A@A@@@A@@@@@@@AA@@B@@@AA@AA@@B@@@@@@@@@@@B@BA@@A@B@@AAA@ABA@@@@A@@AAA@@B@@@@AA@@@AA@@A@@@@@@@@@@A@@@@BB@@@@@A@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@A@@B@@A@@BA@AB@@@@B@@@A@B@@@@BB@@BA@@@BBA@@B@B@BA@@B@@@BB@@@B@@@@@@BB@@@@@BB@@@B@B@@@@@BA@@B@@ABBBA@@@@@A@@@@BA@@B@@A@A@@@@B@@@@@@A@A@@B@@@@@B@BB@@BB@@@@@B@BBB@@B@@@@BB@@A@@@BB@@B@@@B@@@B@@AB@@@B@@@@@@@BA@BB@@ABA@BB@AB@@@B@@B@B@@B@@BB@B@BB@BB@@@B@@D@@B@@BB@BBB@B@@@@@@@@@BB@BB@@B@@BB@@@B@@@B@@B@@@B@@@B@@@B@@D@@@@@@B@@@B@@BA@@@@B@@@@A@@@@B@@@B@BB@@BB@@B@BB@@@BB@BB@BBB@B@@BB@@BBBB@@@@B@@@@@B@B@@@@AB@@@@B@B@@@@@D@B@B@B@B@@BF@@A@@BAB@@@@BB@@@BA@@B@@@@A@@BAB@@A@@AC@@B@B@@AA@@@A@@AC@@AA@@@@A@@@@B@BB@@@@D@@BD@@@BA@@@A@@@A@@@@@@@AB@@@@@BA@@@BBB@B@@@BB@@B@@@@B@B@B@@A@AB@@BB@@AB@B@@@B@@@B@@@BA@@B@@B@BB@@@@@BB@@@BB@@@@B@@@@@B@@@@B@B@BB@BBB@@B@@@BB@BB@@@BB@@@B@@@BB@@B@B@@B@@B@@@D@@@@B@BB@@@@@BA@@@@@B@@@B@BBB@BB@@@@BB@@A@@B@BB@@B@@@B@@@@@BB@@@@@BB@B@@B@@BABAB@@A@@B@@CBA@@@@@BB@B@@@@@D@BBB@B@B@@A@DBB@BB@@@B@@@BB@@@@@BBB@@@D@@AB@@AD@@AB@@@@@BB@@@@BA@@BAB@@@@@@BB@@BB@@B@BA@@B@BAD@B@@@@AB@@@B@@@B@@@B@@BB@BBD@BBA@@B@@@@BBB@@BB@@BB@@@@BBAB@@@D@@@@@BBBB@@B@@@@@@BB@@@B@BBB@@@@@BA@@@@@@B@@@@@@AA@B@@@B@BA@@BA@@BAB@@@@@@A@@B@@BB@@@BBA@@B@@@@@@A@@@A@@@@@@BA@@BA@@@BB@BA@@@BBB@@@@B@@A@@@@@A@@@@@@B@B@@@BB@@@AB@@@B@@@@@BBAB@@B@B@@@@@@A@B@A@@@@@@B@@@@B@@@@@AB@@@B@BAA@B@@BB@@B@@B@@AA@B@@@@@@@@@B@BA@AB@@@@@B@@@@BB@@@@@BAB@@B@@@@D@B@@@DB@@B@@BB@@B@@B@@BB@@@B@@B@@BBB@@A@@@A@@B@@@BA@@@@@@@@BB@@@B@@@B@@B@@DD@@@B@@@D@BB@@@A@@B@@@B@@AB@@@B@@@@@B@@B@@@B@B@@@@@B@BBB@@@BBB@@BB@@BF@@@@DBBA@ABAB@@@B@@@BB@@B@BD@BB@@@@@@BAB@@A@AAA@A@@@@BAB@B@BB@@@@@BB@@B@BB@@@@@BABAB@BBB@B@@@@@B@@@@@BA@@F@B@@@@@@@@A@@@@AA@@B@BA@@A@@AA@@@BAAABA@@@A@@@A@A@@@@AAAA@@AA@@@@AB@B@BA@@B@B@@@B@@A@@@@@@B@@@@A@@BA@ABA@@@@A@@@AB@@C@@BABA@AB@@A@A@AB@@@BA@A@@B@@BBB@@@A@@@@@AA@BAAA@@@@AA@@@A@AAA@@@A@@BABA@@@@@AB@B@B@@@BAB@@@@A@A@@@A@@BA@@BA@@@A@@@AA@@A@@@A@A@@@A@@@@@AAA@A@@@A@@AA@A@@@AA@@@AA@@A@@@@A@@@@@@CC@@@@A@A@@A@A@@AAB@@A@@AA@@@@AA@@@A@@BA@@A@@A@@@@@@@A@@A@@AA@@@A@@AC@@AA@@A@@A@@ACA@@@@@A@AB@@A@@@B@BB@BB@@@AB@@A@A@@BABA@A@@A@@A@@AAA@@@A@A@@@@@@@@@BAB@@@@@@A@@@@@A@@B@B@BA@AB@@@B@@B@@B@@AA@@@@@BAB@@@@@B@@@@@A@@@@A@@@A@@@A@@@ABA@A@@B@@@@@B@@@DA@A@@B@@C@@B@@A@@@@@@@@@AB@BB@@@A@@@@B@@@@@@@@@A@AA@@@@@@@@@@@AB@@A@@@@@@B@@A@A@A@A@@B@@@@@B@B@@@BC@AA@@A@@@@@@@@@@@@AAAAA@@ABA@@A@@@BA@@@A@@AA@@B@@A@A@@B@@@@@B@@AB@@A@@@@@@BA@@@@@@B@@BB@@@@@@@@@@@BA@@@@BA@@@A@@@A@A@A@@@@@@B@@BBA@@@@@@B@@A@AB@B@B@@@BAB@B@@@@@@@BD@@B@@@@B@@@BABA@AB@@@@@B@@A@@@AB@@BBB@@@@BB@@BB@@B@@B@@BBDB@@BB@@@B@@@B@BB@@B@@@@@B@@@BB@B@@@@@BB@B@@ABA@@@B@@@B@@BB@@B@@@B@B@@@BABAB@@A@@@@B@@@B@@AB@@@@@@A@@@A@@B@@ABAAAB@AAB@@@@@B@@@@@@B@B@@@@@@B@@B@@@@@B@@BBBB@@@@@B@@@B@B@@@@B@@@BB@@B@B@@@BBB@@B@@@AB@@@B@@@B@@@AAB@D@@@B@FA@@@@@@B@@@@B@@B@@@B@@@@@B@@BBA@@B@B@@BB@@@@AB@@@@@@@BA@@B@@@B@@A@@@@@@@EAAB@@A@@BA@@BB@AB@@@D@BA@@BBB@@@@@@AB@@@BA@ABA@@B@@ABAB@B@@@@B@@B@B@BA@@B@@@AC@@@@@A@@D@@A@@@A@@@A@@@@@@BA@@@AA@@@@@@@@@BA@@BB@@B@@@@@B@@@@@B@@@B@@@D@@A@@BB@@B@B@@@B@@BB@@@@@@A@@@AAA@A@AA@@A@@@AA@@@A@@AAA@AAA@@DA@AB@@@BA@@B@@BB@@@B@@B@D@@@@@@@@B@@@@@@@@@@@@@@A@AB@@@B@B@@@B@B@@@B@@@@B@BA@@B@BBBB@@BBA@@BA@@@@BA@@@@B@@@B@B@@@@@@BB@@B@B@@@D@B@@B@B@BBAB@@A@@@@B@B@@@@B@@@@B@@BBB@BB@@BB@@@B@@BB@@B@@AB@BA@@@A@@BC@@@@B@BAB@@BB@@@@BB@@BB@B@@@B@@@B@@B@@BA@@B@@@@A@A@@B@@@B@@@@@@@@AA@@@@@BA@@@A@@@@BA@@@@B@@B@@@@@@B@B@@@AC@@@@@AAC@A@AA@@A@A@A@@B@@A@@@@BBB@@@B@@A@@@C@C@@BA@BBA@@BAB@B@@@B@@@@BB@B@@@@@B@B@B@@AB@@@BA@A@A@@B@B@@@AB@@BD@@B@@@B@BB@@@@@AA@@A@@@@@@@A@@@@@@B@@@@A@A@@AA@@@@BAA@@@A@@@@AA@@@@AA@@AAA@A@@A@@AA@@B@@A@AA@@A@@@AA@C@AA@A@@@@@@@A@@@@A@AA@@@@@A@@@@@@@B@@A@@@@AA@A@@AA@@B@@A@@@@@A@@@@BA@@@ACB@A@@@A@@@@B@@@@@@@B@@@@BB@@@B@@@DABA@A@@@@@AB@@@@@@@@A@@@A@@A@@A@@@@AAAA@AB@@AAA@A@@@AA@@@@A@@@@@@BABA@@@A@AA@@@@A@@@@@A@@@AA@@A@@AB@@@@A@@@ABA@@@A@@@A@@@@CA@@@AA@@@@@@A@@@@@@A@@@A@@BA@A@@BAA@@AA@@@CAAACACC@@@@A@A@@AA@@@A@@ABA@@@A@@@@A@@@A@@@A@@@AB@@@@A@@A@AB@AA@BA@AB@@A@ABAB@@@@@@@BAB@@@B@@@B@BA@@@A@ABABA@@@@@A@@B@@@@A@@@@B@@A@@@@@A@@B@@@@A@@@@@@@A@@@A@A@@@A@@@@@A@@@@B@BAAAB@@@B@@@@A@@@A@@A@@@@CAA@@@@A@@@A@@B@BA@@DAB@@A@A@@B@@@BA@@A@@AA@AA@A@@A@@@@@A@C@@@@A@@"],encodeOffsets:[[112903,23161]]}},{type:"Feature",id:"450903",properties:{name:"福绵区",cp:[110.059439,22.585556],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@@@A@@@@@@@ABA@@@A@A@A@A@A@A@@AA@@@AB@@@@AA@@@@@@@B@@C@A@A@@@C@AB@@@@AA@@@A@@@@AA@@@@BAAA@@@A@@BAB@@A@@A@@A@A@@@@@AA@@A@@AA@@A@@AAA@@@A@A@@AC@@AA@A@A@@@A@@A@AA@@A@@@AC@@A@@AA@@AACA@@AA@@@A@CA@@A@@AAAA@C@@@A@AA@BA@@@@@AB@B@B@B@D@B@@@BABA@@@@@ABA@@A@@@AC@@@A@@@@BA@@B@@@BA@@BA@@@@@A@@@@@A@@@AB@@@@BBA@@BA@@@A@A@A@@@@BAAABAA@A@@A@@@A@@@@@A@A@@BC@A@@@A@@A@@@B@BA@@B@@@B@@@@AB@@A@@@@@@B@BA@@@A@@@AA@CA@AAA@@BA@A@@@@B@@@BB@@@@BBB@@@@@@A@@@ABA@@@AB@@@@@BA@@@A@@@A@A@AB@@A@@A@@BA@@@@B@@@@A@@B@@@@@BA@@@C@A@C@A@@@@@AA@@@@AAB@@CC@@@@A@A@@@@@@@@B@@BB@@AB@@@@@BB@AD@@@B@@@@@@A@@@@BA@A@A@@B@@@B@@A@@@@B@@A@@@@@@@AB@@A@@@AA@@@@A@@@A@@@A@@@@BA@@@@@A@A@@@A@A@@@A@@BC@@@ABAAAB@AABA@@@AA@@@@AB@@ABA@@@AA@@AB@@@AAB@AA@@AABAAA@AB@@@@A@@B@@@@A@A@@@@BDB@B@D@D@HABBBABC@A@E@ABA@A@ABA@@BA@A@@@@B@@@B@B@@@@@BA@AB@@@B@@A@A@A@ABAB@@A@@@A@@@C@A@@@ABA@@AA@AA@@A@A@@A@@A@A@@AA@A@CB@@A@@@ABABCD@@A@@@@A@AA@@@@@A@A@@@AA@@AB@@A@@@@@@A@@@A@A@AAA@@BA@@A@@A@A@A@@A@A@CBA@ABA@AB@@@@ABC@@@A@@@A@@@A@A@@@A@@@@BA@@@A@A@@@A@@@@B@@AA@@ABA@A@AB@@A@@@A@ABA@@@A@A@ABA@A@@@@@AB@B@@ABA@@@AD@@C@ABA@@BAB@@@@@@@@BB@BB@B@@@@B@@@@B@BBB@@@B@@@@D@@DB@@B@@@@BA@@@A@@@AB@@A@@B@@BB@@@BA@A@@DA@@BA@@DCB@@@@@BBB@@B@@B@@@B@@@B@@@@@BBB@B@@C@@@A@A@A@ABA@@D@@@@@BB@@@AB@B@@@@BBB@B@@B@@@BB@@B@BB@@@B@@B@@@@B@@@@@@B@@@@BA@@@@BB@@@@@@@@@@@BB@@B@@@BB@@B@@@@B@@B@B@@@B@@B@@@@@B@@@@@@@B@@@@@@@BB@@@@@A@@@@B@@@@@@@@@@@B@@B@@A@@@@B@@AB@@@@A@@BA@ABCB@@CDA@@@A@A@@@@@A@A@@@ABA@@@AB@@A@@BA@A@@@A@A@@@A@A@CBA@ABC@@@@@@BAB@@@@@A@@@AAA@@A@A@A@@@@@@@@@BB@BA@CD@BAAA@CB@B@BADAAA@AB@@@@BB@B@@@B@B@@BD@BBB@@@BDB@BB@@BA@@@ABA@A@@B@@A@CA@@AA@AA@AA@@@@@@A@@@@A@@A@A@@@A@@A@@A@A@A@A@@@A@AB@@@@AA@@@@AB@@A@@@A@@A@@@@A@@@@@@@@@@A@@@@B@@@@@BA@@@@@A@@AA@@AA@AAB@@A@@@@@@B@@A@@@@@A@@@@@A@@A@@@@@@@AA@@@A@@@@@@@A@AB@@A@CB@BA@@@A@A@A@@@A@@AA@@@A@@@AB@@A@@@CA@@A@@B@B@B@B@@@@@@A@@@@A@@AB@@A@AB@B@@@B@@A@@BA@@@A@@@A@@BA@@AABAB@@@@@BBB@@@@@B@@@B@BB@@B@@A@@B@@@B@@@@CB@@A@@@@B@@@@A@A@@@@@A@@@AB@@@@BBAB@@@@@@CB@A@@@B@@A@@BBA@@B@@@B@DF@@B@@@B@D@BA@@B@BBBB@@@@AD@@BB@@DB@BB@@D@BBB@B@BB@@B@@D@@@B@@BB@BB@B@@DB@B@@@@BB@B@B@@@@@BA@@B@D@B@@@B@@B@@B@@BB@@B@B@B@@@B@@BB@DA@A@@BBB@@@BBB@@@@BADBD@DB@BB@@@@@B@@@@@@AB@@A@@@@AA@A@@@CBA@AB@B@@@B@@A@@@@@@@@BAB@B@@B@@BB@B@@@AB@B@BB@@@@@@B@B@@A@@B@@AB@@@@A@@BA@AB@@@B@B@@@BA@@@@B@B@@@@A@AB@@@BA@@B@@@@BB@@@@BB@BA@AB@@@BA@@B@@@BB@@@B@@@@@@@@B@@@B@@@B@@B@@B@@@B@B@B@@@@B@@@B@@BABA@ABA@@@ABBBA@@B@@A@A@A@A@@@A@@B@@A@@@AB@@@@@BA@A@@B@@@B@D@@AD@@@BA@A@@@@BDD@@BB@B@B@@BDA@A@@@@@BB@B@B@B@@@@CB@@CB@B@BA@@@A@A@AB@@ABAAA@CB@@ABA@@@@@AB@@AB@@AB@@AB@@C@AB@@ABA@@@@BA@@@@@A@@@@@ABA@A@A@@@A@@B@B@@AB@@BBA@B@@B@@@@@@ABA@@B@@B@B@B@@BBB@BAB@D@@@B@B@@@@BB@BB@B@@@@BBB@@@B@@B@@@@@@D@@B@@@B@BB@@@@B@B@@@@BB@@B@@@@@@D@B@@@BA@@B@B@@ABAB@@A@@@@B@@@@@@@@@@B@@BA@@@B@BBB@BB@@@@AB@@BB@@@@B@@@B@@BB@@@@BB@@@B@B@@A@@@BB@@B@@B@@@@B@@@@@@B@@B@@@@@@A@@@@B@@@BB@AB@B@B@@@BB@@B@@@@A@@B@B@B@@@B@@@BB@@B@B@@B@@@@@A@@@@A@BAA@@@AA@@A@@@@@AA@@B@@@@@@A@@@@DB@@BBB@@B@@B@BBB@@@@@BA@AB@B@DB@@@B@@B@@@@@AAA@@A@@B@@AA@@A@A@@B@@@@AB@@A@A@@@@@@@@BC@@@@B@@@@@@@B@BB@@B@B@@BB@B@@B@BBB@@@B@@B@@@B@@@B@B@@@@@@AAA@@@@B@@@BA@@AA@A@@B@@@B@@@@BB@@B@@B@@B@@BB@@BB@@@BBABBBB@A@@@@B@B@@@@@B@@@BAB@@A@@AA@@@ADA@@@AB@B@B@@BB@B@@BB@@@B@@BB@@@@@B@B@@AB@B@@AA@B@@A@@@BB@B@B@BB@@@@B@@@@BAB@BB@@@@@B@@@BB@@@@@AAA@@@@B@@BB@@B@B@@@@B@@@@@@AAA@@@@B@@@@@B@@@@A@@A@@@@@@@@A@@@AA@@@@AB@BBB@@@AB@@@B@@B@@@B@BA@@AA@@A@AA@@B@@A@@@@@@BB@BBB@@@A@AB@ABBB@@B@@AB@@@@@B@@@AA@@B@@BB@@A@@B@B@@@B@@B@@@@@@B@@@@@@@@@BA@@@@BA@@@@B@B@BA@@@@@@@ABB@@@@@B@@@B@@@@B@DA@@B@@A@@@@AA@@B@@@BB@@@BB@@@@@B@@@@@B@@AB@@@@AA@@AB@@B@@@@@@@@@BB@@AB@B@@B@@@@@B@@BA@@@@BB@@B@@@AB@@B@@@B@@@@@@B@@BBB@@@BB@@BA@@@@BA@B@@@BB@B@@BB@@@B@@@@A@@A@@AA@@@B@@@@A@@@@@@@BB@@B@@B@@@@@@@@@B@@B@@@@BB@B@@@B@@@BAB@@A@@B@@@@@@B@B@@@B@@BA@@@@@BB@@@@BAB@@@@B@@@@@@@B@@@BB@@@@@BB@@@@@BB@@B@@@@@@@@@BB@@@@@@@BA@@B@BA@@B@@@@@@@B@@AB@B@@@B@@A@@@A@@@A@@@@B@DB@@BA@@BB@@@@@B@@BB@@B@@@@A@@B@@@B@B@B@@BB@@@BA@A@@AAFA@@@@@DB@@@@@@@@B@@@@B@B@@A@@@@B@@@@@@@@@@@@BB@@AB@@@B@BAB@@@@@@@A@@A@@@@A@@@A@@A@@@AB@@A@A@AB@AA@@B@@AA@@A@@A@B@@@A@@@@@BAB@@AB@@@@AB@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@B@@@@@A@@A@@B@@@@@@@@@@@@BB@@B@@BB@@@@@AB@BB@@@B@@B@BAB@BB@@@A@BB@@A@A@@@@@@@@@@@AB@@BBB@@A@@@BB@@@@@@B@@@B@B@@@@ABA@A@@B@@AB@@@@BBB@@B@@@B@@@@@B@B@@@@@BBB@B@@@@BB@@@@@@A@@BA@@@@@@@@B@BA@@@BB@@@BB@@@B@@D@@@B@@@@B@@B@AAB@@@B@@BD@@ABB@@D@@@B@@@B@@@BA@AB@B@@@@@@@B@@@@@B@@@@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@B@@@@@@AB@BA@@@@@@@@B@@@@@@@@ABB@A@BB@@@B@@@@BBB@@@@@@B@@ABCBBBABA@@@@@AA@BAFCDAF@@@B@@@@AFDBANFD@B@@B@@@@@@BB@@@@@@B@BB@@@@B@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@A@@B@@@@@@@BA@@@@@A@@A@@@@@@A@@@AB@@A@@@@BA@@@@BA@A@@BA@@@B@@B@@@@A@@@B@@B@@@@@@A@@@@@@@@@@@@@A@@@B@A@@@@@@@@@@@@@@@A@@@@B@CA@AA@AA@@@C@@@@@@BA@@@A@@A@@@@ADADA@@@AA@@@AAF@@@B@@A@@@A@A@A@@B@@@D@@AA@@@@AB@AA@@@A@@@AAB@@@@@@@@@A@@@AA@@A@@CABA@@@A@@@@@A@@@@@@@@@A@@BB@@AA@@@@@@B@@@AA@@B@@AA@@@@AB@BB@A@@@@@@@A@@BAB@@@B@@@@@B@@AB@@A@AB@@C@@@A@@@@@@@@B@@@@@@@B@BA@@@A@@B@@AB@@@@@@@@@@@@A@@BB@@@@@A@@A@@@@@@@@A@@@@@A@@B@@@@A@@A@BA@@@@@A@@@@@@@@@@@ABC@@@@B@@B@@B@@@@@@@@A@@B@@@@@B@@@@@B@@@@@@B@@@BBA@@@B@@BB@@AB@@@@@@@@@BB@@B@A@@@B@@B@@@@@@B@@@@B@@@@@@@B@@@AB@@@@@@B@@@@BA@@@B@@B@@B@@@BB@A@@@@B@@@@@@@@BB@@@@@BA@@@@@B@@@@@A@@@@A@@A@@@@@A@@@@@@@@B@@A@B@AB@@@@@@@B@@@@B@@@@@@@BB@@A@@@@BAA@@B@@@A@@@@@@@@@@@@@@@@B@A@@ADDAB@BB@@@B@@@B@@@A@@@B@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@BB@@@@@@@@@@B@@@@@@@@A@@B@@@B@@@@C@@B@B@@@@@B@@@@A@@@@@@@AB@@B@@A@@@B@@@B@@A@@@@A@B@BA@A@@BB@@@A@@@AB@@B@@@AA@BA@@@@BA@@@@@A@@B@AC@@@@A@@@A@@A@@@@B@@A@@B@AA@@@@@@B@A@@A@@A@@@@AB@@@@B@@B@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@@A@@B@@@@@@A@@@@@A@@@B@@BA@@BAA@AA@@@@@A@@@ABA@A@@@AB@@@BABAA@@A@@BCAAAAAAACA@AA@@A@ABA@@@CCAACAC@GBCBC@CAAA@AAA@AA@AA@@BA@A@AB@@@@@B@@@BAB@@@B@@@B@@@B@@A@@BA@@BA@A@@BA@@@ABBB@BB@AB@@A@@D@BA@@BA@A@@@CBA@@DABB@@FA@@@@AA@@BA@@@AA@@A@@@@B@@BB@@@@@@A@@CA@A@@BAB@@@BD@@BA@AAA@AB@@A@@AA@@@@@@B@@A@@C@@A@@B@BA@@BC@AA@@A@A@@B@@B@@BAAA@A@@C@@@@AB@D@@@@A@@@A@@B@@@B@AA@@CAAA@A@@@A@@A@@@A@@@A@@A@@@@ABA@@@A@CAA@@AABAA@@@AAC@@@@AAA@BAA@@@@AAAAA@@@AB@@A@@EA@@AA@@@ABA@@@@A@@AA@@A@@@@A@@A@A@@AAA@@AAA@A@@@AB@B@@@@ABA@ADA@@B@B@@@BAB@B@@@BBBAB@@@AABA@@@A@@@@@ABAB@@AB@@@@A@@@@B@@@@A@A@A@@@@@@B@@A@@@ABA@@D@@AB@@A@A@A@CB@@A@A@A@@B@B@@@@ABADA@@BA@@@@@@B@BA@@B@@AB@@@CA@A@A@@AA@@@@@A@@B@BAA@BA@@@@@@@AB@@A@@B@@@@@B@@@@@@@@ABA@@@@AA@@@A@@AA@B@@A@@@@@@A@A@A@@AAB@@@B@@@@@@A@@B@B@@A@@A@@@BA@@B@@@B@@@AA@@@A@@@AB@B@@@@@BAB@B@@@@@@A@@B@A@@A@@@@BA@@@A@@@A@@BAB@@AB@@@@@@A@@A@@A@AA@@@@ABA@@@A@A@@@A@A@@@@@AA@A@@A@@@AA@@@@A@@@@@@AA@@@AA@@@@A@@@A@@A@@A@@@A@@@AAA@@@AA@@@@@AA@@@@@@@AA@@A@@@ACA@AA@@@A@@AAAA@@A@@@@@A@@AA@@AAAAA@A@@@@@AA@@@@@@@@A@@A@@AA@@@A@AAA@@@@@A@@AA@@A@A@@@A@A@A@@@"],encodeOffsets:[[112791,22891]]}},{type:"Feature",id:"450921",properties:{name:"容县",cp:[110.558074,22.857839],childNum:1},geometry:{type:"Polygon",coordinates:["@@FIHM@@@E@@IOAAEAG@EAEAE@G@A@A@G@EBEDEBCBGHEHEDCBEBA@C@CACC@AA@@@C@AB@@AB@@A@@@A@@A@@CA@@A@A@A@C@C@AAA@@A@@AA@@A@A@@AA@@A@@@@@A@@A@@A@@AA@@A@C@@@A@@AAA@A@A@@AAA@EA@AC@@@A@@@A@A@@@A@A@ABA@@BB@@@BBB@B@@B@B@B@B@BE@@BA@@@ABA@AA@@AAABA@@@ABA@AD@@AB@BBB@B@@@B@@BB@B@B@@DB@B@@BB@B@@BB@B@@@@@@@@CB@@ABA@A@CBA@@BA@@B@@AB@@BB@@@B@B@@@@@B@@@B@@@BA@@@@B@@@B@B@@A@@@@BABBB@@@B@B@B@@B@@@@BBB@ABBB@B@@BB@@@B@AB@@BB@@@B@BBB@B@BB@B@@B@B@@@BA@@@@@AB@B@@@BBB@B@@@B@@@@@@BB@BA@@BB@@B@@AB@@@@@@@B@B@B@@BB@B@@AD@BA@@@@@@B@@@BB@@B@B@@@B@@B@@B@@@@AB@BAB@@@@@BA@@B@@BB@@AB@B@BAB@@A@A@@@A@@BAA@@A@@A@@A@@@CA@BC@A@@@ABA@@A@@@B@@@@@@@@@B@B@@@B@@@@@B@@@BB@@B@@@@B@B@B@B@@@@B@@@B@@@BABABAB@@A@A@@@A@@@A@A@@@AA@@A@A@ABAAA@@@A@A@@@@BA@ABABA@@@AA@BA@@@AB@@A@AB@@@@A@@B@@BB@@@B@@@B@B@B@@@B@@@@@B@@@B@@@@AB@@AB@BB@@BA@@@@BA@AB@@AB@@A@@BA@@BA@A@@B@B@@A@@B@B@BA@@@@B@@@@A@AAA@@A@@@@@AC@@@A@@@CBA@A@A@@@A@ABA@@@@@@D@@@BA@ABA@@@@@A@A@@BA@@@AB@B@@AD@@@B@@@@ABA@A@@B@@AB@BBB@@A@@B@@@D@@B@@@@B@@@@@BA@@AA@AB@@A@@@A@A@A@CBA@A@@@@B@@E@@@A@@@@@A@@@A@@@@@@@A@@@A@@B@@@B@BC@AAC@AA@@A@ABABA@@@@BA@B@A@BB@B@@@@@B@@@@@BBBA@@BAB@@@B@@AB@B@@@@AB@BA@AB@@@@@B@B@@@B@@@@BB@@@@@B@D@BA@@@AB@B@@@@AB@B@@BD@@A@@BBB@BA@@B@@AAA@@@@@@BAB@@A@A@@@A@@@A@@@@@@A@@@@@@ABAA@@AB@@AAA@AB@@AB@@B@@DB@@B@@AB@@CBAB@BCF@@B@@BB@ADB@@@@@AB@@AB@B@@@@@@B@@@@B@BB@@@BBB@@B@@@BAB@B@BAB@B@@B@@@B@BA@@B@B@@@@BAB@@@@@B@@@B@BABB@@@@@BBB@@@@@@B@BB@@B@@B@B@@BD@@BB@B@@B@@@@B@@@B@@@@BBB@@ADAB@B@BBBABA@@B@B@@@B@@@B@@ABB@@B@@@@ABA@@@C@A@@BA@@B@@@B@@AB@B@@C@@@@B@@@@@@@BB@@B@B@B@@B@@@@B@@B@D@@@BB@@@@@BDBABAB@@A@A@CBCBA@@@AA@@@BA@@@ADACA@@@@B@@@@@A@B@BAD@@@AABA@@AA@ABAAA@@BA@@@@@@BB@BBB@@AB@@A@@AB@B@@@@@@@@B@@BA@@AAB@@B@@BAB@@A@BB@@@BAAAB@@A@@@@BB@@@@BA@@BA@@@@AA@AA@@AA@A@@@ABA@CAA@@@B@@A@@@@BB@@@C@@@@AAA@@@@AB@BDB@B@B@@@@AB@@ABA@A@A@A@@BAAA@A@@@@A@@@@BA@AB@B@@A@A@@@@@AA@@@@B@B@@AA@BA@A@ABC@BBA@@@B@BBBB@@@B@@BB@@@@BB@BBDBB@B@B@@DD@@A@@@C@A@@@AA@@A@@@ABA@@@A@@@@AAA@@@@ABA@@B@@@@BBBB@B@B@@BB@B@B@B@@@D@B@B@BBB@B@@@@@B@B@B@@A@@@@BA@@@A@@@C@@@AB@@A@@BA@@AA@@@@@A@@BA@@@AA@@@@AAA@A@@AA@A@@AABA@@@@AB@A@@A@@@B@@A@@@A@@@B@@BA@B@B@@B@@BBABA@@@A@A@@BA@@@@BB@@B@@A@@@@BA@@BA@@@AB@@@@@BABA@BBA@@BAB@BCBA@@B@@@B@BA@@@AA@@@@@@@@@B@@@@@B@@A@@B@@@@@B@@@@AB@B@@@@@@AB@@@@A@@@@B@@@@@B@@BB@@@@@@AB@@@BA@@BB@@@BBBABBAB@@@@EB@BA@@@ABA@C@@@@@BB@B@@@@BB@B@@@BDD@@@@A@A@@@@@B@@BB@@BAB@@@B@BBB@BA@ABA@@@@@AB@@@@@@@B@@@B@@@BB@@D@@@@BB@@BB@@@@BBBBBB@@AD@@B@@B@@B@@B@@B@@B@BB@@@@@B@BB@BB@@@@BBBBB@BB@@B@@@BBBB@@B@@@@@B@@@B@@@@AB@@BBB@@@BBB@B@BBBB@BB@B@@@BBBB@@BABBB@@B@@BB@@BBA@B@@BA@@D@@BB@@@B@@@B@@ABAB@D@B@BCB@B@BAB@@CB@@@B@@@B@@@BAB@B@@A@@B@BABB@AB@@A@@@@B@@@BC@@F@D@D@@@@ABEB@@A@@@E@A@A@A@A@@@A@CB@BAB@B@B@B@B@B@@AA@@A@A@A@C@@@AB@BAB@B@B@BAB@BA@AB@@@B@@AB@B@B@@A@A@@@A@@@@A@@A@AB@B@@@B@B@@@BA@@BAB@@@@A@@@A@@@A@C@@BAB@@A@A@@@A@@B@@@B@@@@@B@@@BA@A@A@A@A@BB@@@BA@A@A@@BA@@@A@A@EB@@@AA@A@AB@@@AA@@A@@@@AA@@@@@A@@@A@@@A@A@@@A@@A@@@@A@@@A@AA@BAA@AA@AA@@@A@A@@@A@@@AB@@@AA@@@@@@A@@@C@A@@@A@@AA@@AA@@@A@@A@@@ABAA@@AAA@@@A@@AA@@@@@@A@AA@A@@@@@AA@@@@AAAA@@@@@@A@@B@@A@@@A@@@@@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@AA@@@@@@@@@@A@@@@@@@AA@@@@@@@@@@@@@@@@@@A@A@@@@@@@@@@@@@A@@@A@@@@@@@@@@@A@@@@@@@@A@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@A@@@@@@B@@@@@@A@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@AB@@@@@@A@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@AA@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@A@@@@A@@@@A@@A@@@@@@@@@@@@@AA@@@@@@@@@@A@@@@A@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@B@@A@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@AA@@@@@AA@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@@@@AB@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@AA@@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@B@@@@A@@@@@@@@@@@@@A@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@A@@@@@@@A@@B@@@@@@A@@@@@@@@BA@@@@@AB@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@A@@A@@@@@@@@@@@@@@AB@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@AB@@@@@@@@A@@@@@@@AB@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@B@@@AA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@AA@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@A@@@@@@@A@@A@@@@@@@@@@AB@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@@@@@@@@B@@@B@B@@ADA@@@A@@@@B@@@B@@A@@B@@@@@B@@@@@@@B@@AB@@ABABB@AB@BAB@BAB@B@@A@@@@BA@@BA@@BBB@BB@B@@@@B@@@@B@B@B@@@B@@@@@@@@@BAB@@A@@@BB@@@@@@BAB@B@BA@AB@@BDBB@@BB@A@@B@@A@@@A@@@@@@BA@@BB@@@B@BA@@@@B@@B@@@@@BD@@@@@@A@@@A@CD@B@@@B@@@@@@B@@@@B@@ABAB@@B@@@@@AB@@@@@BCBA@E@@BA@@@ABA@@@C@@BA@@@@B@B@@@@B@@@@@@B@@AB@B@BBB@@@@@B@B@@BB@@AB@@BB@@@@@B@@BAB@@@BA@@D@B@B@@B@@A@@@A@@@@@@B@B@B@@A@@@@B@@@BB@BBD@BB@@@@@@@B@@AB@@@@@@BBB@BA@@BBB@@@BB@B@@@B@@BB@@D@@@B@@BBAD@BBB@@@BBBBBDB@@B@@@B@@@BBDBB@B@@@B@B@@@B@@@BCF@@@B@@B@@@B@@@@@B@@@B@BBB@@B@@BA@@@@@BB@@@@B@B@@B@@@@BBB@@@@BB@@B@@BB@@@@B@@BB@@@@B@@@BB@B@D@@A@@B@@BB@@@@@@@BD@@B@BAB@@@BAD@@@B@B@B@BBB@BB@AB@B@B@@BBA@@@@@A@A@@@@@A@@@A@ADA@AA@@@@A@C@A@A@@B@@@@B@@@B@@B@B@@@@DB@B@@BB@B@D@@@BABBB@@@B@@@B@@@@@B@@B@BBB@@B@@@@B@BD@@@@BB@@AB@BB@@@@A@@B@@@@A@@BB@@@@@BAB@BBD@@@B@@@BB@@@@BB@@@B@D@@@B@@AA@@A@@@@B@@@B@@@AC@AA@@A@@@@B@B@B@B@@A@@@@@C@AA@@@@@BA@@@@@A@A@@@@@AB@@CB@B@@BB@@BBBB@B@BB@B@@@A@@@@B@@@B@@A@AB@@@B@ABBB@@@BB@@AB@@@B@@@@B@@@@BB@@@@@@A@A@AB@B@BCB@@BB@@@@@@BB@@@@A@@AA@@AA@@@@@B@@B@B@@A@@B@@@@@BB@@B@B@@BB@@@@B@B@@@B@B@@B@@@@@B@BA@BB@@@BB@@@@@ABA@@@@B@@@B@@B@BB@@@@A@@@B@@@@BB@B@@BDBAB@@BB@B@BB@A@@B@@@@B@@B@@@@A@@@A@AB@@@@@@@BBB@@@@B@B@@BB@B@@@B@B@B@@@@BB@@@@@B@BA@@B@@@@@@@B@@@B@@@AA@@C@@@@A@@BAA@BAB@@A@AB@@BB@@@B@@@B@@BBBB@@@@B@@B@BB@@B@@@@AB@@A@@B@@@@@BA@@BC@@D@@@BA@B@@B@@@B@@@@BBA@@@@@BB@ABB@@@@@B@@AB@@A@@@AB@@AB@@B@@B@@@BBBDB@@@@@BA@BB@@@@@@B@@@B@@@@@@@A@@@A@@@AB@@@@@B@BBB@@B@@@@@@B@@AB@@@@@B@@D@@B@@@@ABBBA@@@@BBB@@@@@BB@@@BB@@BB@@@B@@@B@@@B@D@BB@@B@BB@B@@@@@@@@A@@@@B@@B@@@BD@@@B@@BB@@A@B@BB@@B@@@@@@@@B@@B@@@@@B@@@BB@B@@@@@@B@@@B@@@@@B@@@@@BAB@@@@BB@B@@@DB@@@A@@B@@A@ADADCBAD@@@D@BA@@@C@@@@@AA@A@@@BA@@A@@@@@AB@B@@@@A@@@A@@@@@@@A@@AB@BA@A@@@@@@@A@@A@A@@@@@@A@@@A@@@@@@BABA@@@A@A@@@@@A@@B@@@B@@@@@B@@@B@@BA@BB@AB@@@@@B@@@@@@B@@@@@@BA@@@@@@@@@A@@B@@@B@@B@@@@@A@@BA@AB@@AB@B@@@@B@@@B@@@@@@@@@@@BA@B@@BB@@@@@BA@@@@BB@BA@@@C@@@@B@@@@@@@B@@@@B@@@@B@@@@B@@@B@@@@B@@AB@@@@@@@B@@B@@@@@@@@@BAB@@@@@@@@BB@@@A@@B@@A@@B@@@@B@@BB@BB@@DABB@@@@D@@@BBABBB@@@@B@@@B@@@@@@B@@@B@@BB@@@B@@@@D@@@@@@B@@@BB@@@@AB@BA@@@AB@@@@BB@@AB@@@@BB@@@@@B@B@@@B@@@B@BB@@B@B@@@@@@B@@@@@B@@@@@@@B@@@B@B@B@B@@B@B@@@@B@BA@BB@@@@B@B@@@@@B@@@@@BA@A@@@AB@BBB@@@@BB@@@@ABA@@@AB@@@B@@@@AB@@@DABBBAB@B@@@BAB@@@@@@BB@BAB@@AB@@BB@@@B@@@B@@AB@@B@@B@AB@@@B@@@BB@@@BA@@@@BAB@@A@@B@@BBABB@@@@ABAD@@@@A@@@@@B@@@@BB@@B@BBB@@BA@@@@BB@@@B@@@BB@@@BB@@B@@@@@BB@@B@@@BB@@@B@@@@B@@B@@@@@B@@@@B@@@@BBB@D@BA@@@C@@@A@@@@@@BBB@@@B@@ABC@@B@@@@@BHD@@BB@BBB@@@@BD@@B@@@B@BABAB@@@BB@@@@@BA@AB@B@@@B@@@B@@A@@@@BB@@B@B@BBBD@@@ACB@@@B@B@@@@A@@A@@@AA@B@@@A@@@@B@BBB@@A@@@A@@@@EA@@D@BADA@@@@B@B@@@B@BDB@@@B@B@@B@@@B@@@BA@@@@B@@AB@@@B@@@BABA@@@AB@B@@B@D@@@@BBB@BA@@BCBAD@@@BBB@@@@@AB@@@@ABA@@B@@A@@BA@@@@B@@A@@B@@A@@B@B@@B@@@@@B@@@@@B@@@@B@@BB@@@B@B@@@@@BA@@B@@@BB@@@B@@@@@@BBB@@@AB@@BB@@@B@@A@@B@@@@@@@@@BBB@@BAB@@@@@@@@B@@BBBB@@@B@@@@@BB@@@@@BA@AB@@@@BDB@@BA@@B@BA@@B@@@@AAABA@B@@B@@@@@B@@@B@@@@AB@@A@@BA@@@@@A@A@AAAA@@A@@@AAA@@@@B@BA@@@A@C@@@@BA@@HB@@BAB@@@B@B@B@@@@A@C@AB@@A@@BBB@@B@D@@@BB@B@@BB@DC@@BA@@B@BB@@@@B@@BB@B@BA@A@ABABA@@@@B@@@B@@@@AB@@ABA@A@@BC@ABA@@B@B@B@B@@@@A@A@ABA@C@@BA@@@@B@B@@AFB@BBBB@B@@B@B@@BB@@H@@@@ABADD@@AB@B@B@B@BA@BB@BA@BD@@BA@@B@@A@@@@B@B@@AB@@@BB@@@@@B@@ABA@A@@BC@A@AB@@@@@BB@@B@@BBBD@@CA@@ABA@@@A@B@@B@B@@DD@BB@@@B@B@@@DB@@B@B@@@BA@@BBD@@@@B@B@B@@@B@@BAB@D@@@@@@@BB@@B@@@B@B@@@B@B@BBB@@BB@@@@@BA@@B@D@@@B@@@@A@A@A@@@@BA@@DAB@@ABB@@@@B@@ABB@@BBB@D@@@B@@AB@BA@@BABA@@B@@@@@BB@@B@@@B@@B@A@AB@@@B@@@@@BBB@B@B@@@BBB@@ABA@A@@@A@@@@@A@@@A@AB@@@@@@A@@@@@A@@@@@@BABA@@A@A@@@@@@C@A@@@@@C@@@@@@B@@@BA@@@@AA@@@A@@B@@@@ABABA@@@A@@AA@A@ABA@@@@BADADC@@B@B@BBB@DBBBB@D@@@BA@@BC@@@AAAACAACC@AA@@AAC@A@ABA@@BABA@AB@@AB@BA@@BA@A@AB@@AB@B@@@@@@@B@@@BCBAB@@@B@@@B@B@@@@BBAB@@@BA@@@@@@BB@@@@BAB@@@B@D@@@D@@@@@@A@@@AB@@@@B@@BBB@@ABBB@@@@A@@@A@ABA@ABA@A@@@@@@@A@A@@@@@AAA@@@A@ABC@@A@@A@@AA@@@@BA@@B@BB@@BAB@@@B@@AB@B@B@@@@@B@B@@@B@@@B@@@B@@@BB@@BB@@ABB@@@@BBB@@B@@@BDB@B@@BBB@@@BB@D@@@@D@@@@BBBA@B@@BB@@B@BB@B@@@B@@B@@ADA@A@@B@@@BB@@@B@@@A@@@A@@@A@@AA@@B@@@B@@@@@@@@@@@BA@@@@@AA@@@@A@CB@@@BA@@B@D@@@@@@AAAAA@A@A@@A@@@AACA@@@A@@@A@AB@B@@@@A@AAA@@B@@@BABA@@BA@AB@D@@A@@@A@AB@DA@AB@B@@A@@B@@A@@@@@@BAAA@A@A@C@CA@@AA@@@@AAAAA@@CA@@AA@@@@@AB@@A@@@@B@@ABA@@@AB@@@@AB@@A@@@@@A@A@A@@AA@A@@AA@@AA@@AA@A@@@A@@AAAA@@A@@A@A@A@@@@@A@@AA@A@@BA@@@@B@@@B@@A@@@@@A@A@@@@@AB@B@@@@A@@@A@@@A@@@@@CAAA@@A@@@A@@B@B@@@@A@@@AB@BAB@@@@@@@DB@@B@@@BAB@@A@@BA@AB@@@@AB@@@DAB@@@@A@@@A@@@A@@@A@@@@@A@@B@@@@A@A@@@@BA@@AA@@A@@A@@AAA@@@CB@@A@C@@@@@AA@@AAA@@@@@A@@@A@A@@BA@@@@@A@AB@@A@@A@@@@AAA@A@@@A@@@A@@@A@@A@@AA@@@@A@A@@@AA@@BA@@AAA@@AAA@@@AA@@AB@@AAA@@@@AA@@AC@@A@ACAA@@@@@@@A@@@A@@A@AA@@CA@@A@@@A@@AB@@AA@@A@A@AA@@AAA@A@A@@@AB@B@B@DA@BB@@@BA@@@@@@@A@@BC@AA@B@@A@@A@@A@@@A@@BA@@BA@AAC@@AA@@A@AAA@@@A@A@A@@@CA@A@@A@@AB@@A@@A@@A@ABA@@D@B@DB@@BC@@AA@@BAA@@@AA@@@@@AB@@@B@AA@@@A@AA@@@@AAA@A@A@@@A@@@A@@BAB@B@B@@@B@@A@@BAA@BA@@@A@@@@BA@@BA@@B@@A@@BA@@@@@A@@AAB@@@@@@A@@B@@A@@@A@AAA@@@AAA@A@@@ABA@AB@@@@@B@B@@@B@B@B@B@@AAAB@AAA@@C@@@@@ABAB@B@@A@@B@@B@@B@@@B@B@@@BA@@@BB@@AB@BC@@@A@@B@@AAA@@BA@@@@BC@@@@@AB@@@@@@@B@@@BBB@@@B@@@@@@A@A@@@A@AD@@@BABB@@BA@A@@B@BB@A@@@@@A@@BBD@@@B@BB@B@@@B@B@@@@BB@@BBBB@@@B@@BBB@@@BB@@@B@@B@@@@B@@@B@@BB@BB@@@B@BAB@B@BBB@B@@@D@B@B@B@@@B@BA@@FA@@@@@@@A@@BA@A@A@A@C@@BA@@BA@@@@BCB@@@@AB@AA@AAA@@@@A@@AAA@@AA@@@@@A@C@A@A@C@@@AA@@A@A@CBA@AA@@@@AB@@AB@@A@@AA@@@@@@@B@@A@BBA@@@@A@@A@BA@A@A@@A@@@AA@AA@@A@@@A@@@@@A@@@AB@@@AA@@E@@A@@A@@AA@BA@@@ABA@@@AA@@@AEABA@A@A@@@BAA@@A@@@@@@B@AA@A@@@@B@@A@@@A@@@@@@@@B@@@@B@@BA@@@@AA@@@A@@@A@@@AA@@@@AA@@@@AAA@@@A@@@@@A@A@@@@@A@@AA@A@@@A@A@@AAA@@@@@AC@AA@@AB@@AAAAC@@DCA@@@@@@AA@@A@AA@BA@A@@AAA@@AA@@AAAAAB@@@@C@@ACBA@@@@BA@@@AB@BA@AA@@@BA@@@@AABAAA@@B@BA@@@@@@@@B@@A@@@@FC@@B@A@@ABB@AB@B@@BB@BA@@@@B@@@@@@AB@@@@@BA@@@A@AB@@A@@@A@A@@B@AA@@AA@@AA@@@A@@@@@AAA@A@@@@BA@@@@AA@@@@AA@@A@@@AA@@@A@@@A@C@@B@B@@@BAB@B@B@@@@@@A@@@A@@@@B@@@@CDC@@@A@AA@A@@@AA@@@A@@AA@@@@@@@@@@B@@@BAB@@@@@@@BB@@B@B@B@B@B@@@BAB@@D@@BBBB@@B@B@@@B@@@DA@@B@@@B@D@@@@BBAD@@@@B@@B@B@@A@A@@BAB@@@@@BA@@BBDBB@B@@@@@@C@A@A@A@@@@BAB@@@@@@AB@BAB@BA@@B@@ADA@@BBB@B@@@@AB@@@B@@@@@@@BB@@B@@@D@@BB@@@B@B@B@@@BA@@@@@ABCBAB@@@@A@AB@@A@@@A@@@A@A@ABA@AB@@@DEB@@@B@B@@@B@@@@AB@BC@@BAB@@@@C@ABA@@A@@@BA@ABCBABCBA@@@A@@@A@@@@@@B@@@BA@ABA@@BBB@@AB@@@B@@@@@@AB@@A@AB@@A@A@ABAB@@@BA@@@A@A@CB@B@@A@@BA@A@A@ABA@@@@@A@AB@@@@AB@@@B@@@BA@@B@@@AA@@@@BA@@@ABA@CAA@A@C@@CC@A@@@@A@@AAA@@@A@AA@AC@@BA@A@A@ABA@@@@@A@@B@B@@@@@BA@A@@@A@@BAB@@@BAD@@@BAB@@@@@B@@@B@@A@A@@DA@@A@AE@@@A@EBEDCBEAEAGB@@@KIIGIAI@IDCBEBCAA@CBCBA@CCCCCAG@EBCBEBC@I@CAGA@AAAEAEAC@AAE@AAGCCCEEAG@C@C@A@A@C@@CAC@CAA@EHCN@DADEDEDEBCD@@CDAFAB@BGDE@AAAC@AAAC@C@CB@@ABCC@CBEBCBGBG@CEKOCGAAA@@@A@@DEFCDADABA@A@A@CCECG@A@CDC@ABABC@ADEDG@@BCA@AA@@G@EBCAC@CCCE@A@C@C@AACACCAAAAC@C@CBACG@ABA"],encodeOffsets:[[113399,23028]]}},{type:"Feature",id:"450922",properties:{name:"陆川县",cp:[110.264052,22.321048],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@B@@AA@@AA@@@@A@@@@BA@@@@@AAA@@@AC@@AA@AA@@A@@@@B@@ABA@@BC@@@CAC@A@AAE@@@@A@@AA@@@@@AA@@@@@@A@ACAAA@A@ABA@@@ABA@@@@@@@AAA@@E@CAA@@AA@@A@@@A@A@@@A@@@@@@@@@@A@@@@@@BA@@@@BE@@@C@A@A@@@AA@AAA@CAA@A@AA@@A@@A@@A@@@AAA@@@A@A@C@CB@B@@AB@B@@ABA@ADAB@@@BA@A@@@ABA@@@@@ADAB@@@@A@A@C@@@@@CB@B@F@B@@@@@@@D@BA@@@@@@B@@@@CD@@@@@@ADA@@B@BCB@@@BAB@@C@@@A@@@@@AC@@@AA@@A@@ACAE@@@@AA@@@@@@@AAA@@@A@@BA@@@A@@@C@@@A@@A@A@AB@DA@@@BB@B@@@BA@@@A@@@A@@@@@@AA@@@@@@@ACAA@A@A@@BC@A@A@@BAA@@A@A@@AAAA@AA@A@AB@A@A@@A@@AAAA@AAA@@A@@@C@C@A@@BC@C@@@A@A@@@@@A@@@@@@@@@@B@@A@@B@@@BAD@B@@@B@@A@@@@B@@@@@@@@@@A@@A@@A@@@@AA@@@@@@@@B@@A@A@AB@@@@@@@BA@@B@@@B@@BB@@@@B@@B@@@B@@@BA@A@@BC@AB@@A@@@@@@B@@@B@@@BA@@@@BCB@DC@@B@@AB@@@B@@@B@@@B@B@@@B@BB@@@@B@BAB@@A@@B@@A@@@@@@@A@@B@@ABC@AB@@@@@@C@E@@@AA@@@@A@@@A@@@A@A@AAE@A@@@@@@@A@@@@@@@@B@@A@@B@@@@@@@B@@@@@@A@@@@@@BA@A@A@@@@@@@A@@@@@@AA@@@@EA@@@@AA@@@@@AB@BA@@B@@@@@@@B@@@@A@A@@C@A@@@@@@@A@@@CC@@@@@@@@@@AA@@@@@@@@@BA@CDCB@A@@A@@@@@A@@@@@A@@@@@AA@@BA@@@@@@@@@AAC@ABA@A@@B@@@B@@A@@@@B@@@@@@@@@@A@@B@@@@A@@@@@AB@@@@@@@B@B@@@@@BA@@@@@@@@@A@@@@@C@K@@@@@@A@@AC@A@@@@@@@A@@@A@@@A@@@AC@@@@@@@@@A@@@@@@@@@ABB@@@@BAB@B@@@@@@AB@@@@A@@@A@@@AA@@AA@@@@@@ABA@A@@@@@@@AB@@@@@@@A@@@A@@@@@@@A@@@@@AAA@@@@@@@@A@@@@@A@@@@DC@A@@@AB@@A@@@C@@ACBC@@@A@A@@@@@AAA@@@A@@@@A@C@@A@@AA@@@@@AA@@A@@@@@A@@@@@@@@B@@AB@BC@@@@@@@@@@@A@AAA@@@@@@@@@A@@@@@@@@@@@A@@@@@@B@@@@A@@B@@@@@AA@@@@@@BA@@@A@@BA@@@@@@@@@@@A@@B@@@@A@@@@@@BA@@@A@@@@@AB@BA@@BC@@DC@@@A@@@@AAAC@@@A@@@@B@@@@@B@BBB@@@@@BAB@@@DABA@@@A@@@@@@@A@@@@@@A@@AA@@@AA@@@@@BA@@@A@@@@B@@A@@@A@@@A@A@@@@@@@A@@@@@A@@@@@@@@C@@@@@A@@@A@@@@@A@@B@@@@A@@@@BA@@@A@@@@BA@@@@@@@A@@@@@@@A@@AA@AB@BA@@@@@@@A@@@@@@@@@@@@@@A@@A@@@@@@@A@@@A@@@A@@@A@@@@@C@@A@@@@@@A@@A@@@@A@@@@AA@AAC@A@@A@@@@@@A@@@@AA@@@@@@@@@A@@@@@@@@B@@A@@@@B@@A@@@@@@F@@@BA@@B@@@@@@@BA@@@@@@B@@@D@@@B@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@B@@@@@@@@@DABB@@@@B@@@@@D@BA@@@@@@@@@@@A@@@@B@@A@@@@@@B@@@@@@BB@@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@B@BB@@@@@@@AB@@@@AA@@A@@@@@@B@@@@A@@@@B@DADC@@B@@@B@DGBAB@AA@AB@BA@AA@C@AA@@@@@@@A@@@@@ACAAACAA@A@A@@@@@CA@AA@@@@@B@@@@A@@B@@@@@@A@@B@@@A@@@@A@@A@@@@@@@ACAAA@@@@@@AA@@@@@@@AA@@@A@@B@@@@@@@@AB@@@@@BA@@@@DA@@@@@A@@B@@@@@@@@@@@B@@@@@@BB@B@@B@@@@C@@@@@@@@@@@BB@@@@B@BABAB@B@BA@AB@BA@A@@@ADC@@@A@@BA@B@@@@B@@@B@AA@C@@AAA@@A@@@ADA@AB@@@@@B@@@B@BA@@@AAC@@@C@ABBB@@C@AA@A@A@A@@@@AA@A@A@@@@A@@@A@@B@BAB@@A@AAAAE@A@@BA@@AAAA@@AAAAA@@@ABC@A@@BABA@@@CCA@A@AB@@ACA@A@A@A@A@A@CA@@@@ADA@@@A@AAAA@@A@ABA@C@A@@@@AA@@AAAA@A@ABCDABA@CA@@AAG@C@CAA@A@ABABEBA@@@ABA@AB@BAA@@@@@@C@@@ABA@@BABCDABGL@@CDKDKDCB@D@@CBEDEDA@A@A@A@AB@@A@@@A@A@@AAAA@@@A@C@A@A@ABA@EDADA@CD@@CD@@CDA@AD@BA@AB@@A@AB@@ABE@A@ABE@A@E@A@CDA@ABABA@ABC@CA@@E@ABC@@BEDABA@@@A@ICA@CAA@@@AB@@@B@BA@@BAB@@AB@BA@@@AB@@@@CBCBADABABAD@BAB@@@B@@AB@B@B@@@@@D@B@D@B@@@B@BABBD@BAB@BABABAF@B@B@@BDBB@B@@@B@@ABAB@D@@@@A@@@@@A@@@A@BB@@@BA@@@A@ABA@A@@@A@@B@@BB@B@@@@@@@B@B@BB@@@@@BA@@@@B@@B@B@@@BB@@@@BAB@@@B@@B@@B@@@@ABA@@B@B@B@@B@@@AB@BB@@B@@B@@@BB@B@@ABB@A@A@@@AB@@A@A@@@@B@@ADA@AB@AC@@@AD@@@@AB@@@@AD@@@B@@A@A@@@B@BB@@ABB@@B@@A@@B@@BBA@BD@@@@AB@@@BAB@@B@@@D@@@@AB@DB@@@B@@@B@@@B@BB@B@@B@@@@BB@@@@@B@@@B@@A@@@@B@@BB@@@B@@B@B@@@@@@B@BBB@B@@BBB@@@AD@B@@AB@@A@@B@B@@ABA@AB@@@@A@A@@B@B@B@@@@A@BBAB@B@@C@@@ABA@ABA@@BAB@@A@@@A@@B@@@@A@@@A@@@C@A@@@A@A@A@C@CDA@@B@@C@C@@@AB@@@@@@@B@@@D@@@BAB@@@B@@@@@B@@B@@@B@@BB@@@@B@B@@@B@@@B@@BF@@@@BB@@A@@BAB@@A@A@@B@@ABA@@@A@@@AB@@@@@B@@A@B@@BB@@BB@@B@@BB@@@B@B@@@BBB@@@@B@BB@@B@@@B@@BB@@@BB@@BB@@@@B@@BB@@B@@@@AB@@@@A@@@@B@BB@@B@B@BB@A@@B@@AB@@@@@D@@@@A@@B@B@@@B@@AB@@A@@B@@BB@@BB@B@B@BA@@B@@AA@@AB@@@@@D@@@@BB@A@@BB@B@@@@@B@@@@@@@B@@AB@B@@@@B@@@BB@@@@DD@@@@@B@@A@@BA@@BA@@@@@CA@@A@@@@@A@ABA@@@A@A@@@A@@@A@@@@@A@@A@@@@A@@@@@@@@A@@AA@@BA@@CA@@A@@@@@A@@@@@@@A@A@@@@@@@A@@@A@@@A@AC@@@@CDABA@@@@B@@@@@B@@B@@@@BA@BB@B@B@@@@@DA@@B@BB@BB@@@@@B@B@BA@@B@@@@@BA@@BAB@@@@AA@@CD@@A@@B@@@DBB@B@@@BA@@@AB@@@@AB@@A@@@@BB@@@@B@B@@@@AA@@A@ABA@@@@BA@@BA@@@@BA@@@@@@B@B@@@B@@@B@@@BBB@@@BB@B@@B@@B@@B@@BBA@@B@@BB@@@B@@@@@B@@@@@@@B@@B@@@B@@B@B@B@@AB@@@BB@@@@B@@AB@@@BB@@BA@@@@BABA@A@@@@@@@AB@@AA@@@AA@@BA@A@@@@BA@A@A@@A@@AB@@A@@B@@@@@@A@@B@@@@@B@@@@@@A@@@AB@@@@A@@@@@@@@@@B@@AB@@@@@@@B@@@B@B@@@BAB@@@@@BA@@@@@ABABB@@@@BBB@B@@@B@BBB@@@@@DAB@@@B@B@@@@@BA@@B@@@@A@@@@B@@A@@@A@@B@@A@A@@@@@@@C@@@@@@@A@@@@@@@A@@@@AAA@A@AAC@@C@A@A@@@@A@@C@@@A@@B@B@B@@AB@@@BA@@@@@@B@@@@AB@@@BA@@@@B@@BBB@B@@@BB@@@@B@@@B@@BB@@@@B@@@@@B@@@BB@BBB@BB@@B@B@@@BB@@B@@@BB@@B@@@BA@@BA@@@A@@@A@@B@@@B@B@B@@@B@@@B@B@@@@B@@@@@@BB@@@@@@BBDA@BBA@B@@@BB@@@@@@B@@@@@@@B@@A@AD@@@BBB@@B@@B@@@@B@BB@@B@@B@@B@@@BB@A@@BB@B@ABB@@DABB@@@@@D@@B@@B@@BB@@@B@B@@@B@@@B@@@BB@@B@@B@@@B@@@B@BAB@B@@@@AB@BB@@B@B@BBB@@A@@@A@@BAB@@AB@BDBB@@BB@@A@BB@B@B@B@@@@BB@B@@@B@@B@@B@@A@@B@@@@@B@@B@@BDBB@@BB@@B@@B@@@B@@@B@@B@@BBB@@BDB@@@BB@@B@@@@@@B@BA@@@@B@@@B@@@B@BB@@B@BBBB@@@B@@B@B@@BB@@@B@@A@@@AB@B@@@B@@@BBB@B@@@@B@@@@BA@@BAB@@@@BADBBBBBB@@@@B@@BBBAB@@@@D@@@@@@@BADB@@@@@BB@B@@@B@@@BB@@BB@@@BBB@B@@@@@BB@@BBB@@@BB@@@@BB@BB@@@BB@@@B@@B@@@@BBB@@@@@@BAB@B@D@@CB@@@BA@@B@@@@AB@B@@@@A@@@AA@B@@A@@BA@@@A@AA@@@BABADABABAB@BA@ADA@@@ABA@@@C@@@@@A@@B@@@@@@@BAAAB@@ABAB@@C@@AA@@@A@C@ABA@@@AB@@@B@@C@@@@BB@BB@BAAC@@AAB@@@@A@@@@@A@@@@@A@@BA@@BB@@BBB@@@B@@@BB@@B@@@B@@@DA@@B@@AB@B@@@@AB@@@@@@@B@@BB@@@@@B@@@B@B@@@BB@@@@@@B@@A@@@@@AB@@@@AD@BABABABA@@B@@@BB@@@@@@@@@BB@@@@B@B@BBBB@@BB@@@B@@@@@BB@BB@BB@@@B@@BDB@B@@@BB@@B@@@@@@BB@@@@B@@B@@BB@B@@@B@@@BB@@@@B@@@B@@B@@B@@BB@@@@@@@B@@B@@B@@@BB@B@@B@@@@@B@B@@@B@B@@AB@B@@B@@B@BB@@@@B@@@@A@@BA@AB@@@B@@@B@@AB@@@@@BB@A@@@@B@@@@A@A@AB@@@@A@A@@B@@@B@@BB@@A@@@A@@@AB@@B@@@@BA@A@@@@B@@@@A@@@A@BB@@@B@B@B@@@@B@@@@ABB@@@B@@BB@@@@AB@B@@@@@@A@@@@@A@@@@BA@@B@@@@@@ABB@ABA@@@@B@@@@BB@@@B@BDB@@A@@BA@@@ABA@@@@@@@AB@@CBAB@B@@A@A@@@@B@B@BA@@D@B@B@BA@@BC@@@AB@B@@@BA@@@@@@@@B@B@B@@A@@@@@@B@@A@@BA@AB@B@@@@@B@@ABBB@@A@ABAA@@A@A@AB@@A@A@@@CB@BAB@B@@A@A@@B@@@BBB@BB@BB@@@B@BB@@@@@@BB@@BB@@@@@AB@B@@BB@@FB@@@BA@@B@@BBBB@B@@B@ABB@BB@@@@BD@B@@BBBA@BB@DBB@@@B@BA@@@@@BB@@@B@@@B@@@@B@@@BBBDB@@BBA@@@A@@@@B@@@B@@C@A@@B@@D@@@@BBBAB@@@AA@@@@B@BB@@BAD@@ABA@@@@BD@@@@BA@@@@@@@BB@@@BA@@BBB@BAB@@AC@@A@AB@@@BDB@@@B@@@@A@@AA@@@@@@BB@@B@@AB@@BB@@@@EB@@AACB@@AB@D@@@BAB@@ABC@@@@BA@@BAAA@AA@B@@AB@@@BAB@@AB@@@BA@@@A@@@A@@@A@AB@@A@@@@@A@@B@BAB@@BBB@@BBB@BBBDBD@DAHAD@DBBBDD@@B@BAB@@@BBB@BDBBBBBBB@@BB@B@@ABAFCDCBABAB@@@@AB@B@@B@@BA@@BB@@@@B@@@@@BB@@B@@@@@BB@@@@@@@@BB@@A@@BA@@@@@@BB@@@B@@@@B@@B@@@BA@@@@@@@@B@@@@BB@BB@@@@@AB@@ADD@BB@B@BB@@@@@@@B@@AB@@@BAB@B@@@@@@A@@@A@@@A@A@@AC@@@AB@@@@@@A@@@ALCJ@B@@DFBD@@DBDB@BB@BB@@@B@@@@B@B@BABA@@@@@@B@@@B@@@@@@B@BA@A@@@@@BB@@@DBB@@@B@B@@ABDB@BAB@@@BA@BB@@B@@D@B@@@B@@AB@@@@@B@@@@B@@@B@@@BB@B@@@@B@B@@A@@B@BA@@B@@@@@@@B@@@@@@B@@B@@@@@D@@@B@@@BABB@@BA@B@@@@@BAB@@@BBAB@@ABA@B@@@B@@BAB@@@BB@@@@@@@BA@@@A@@D@@@@B@@@@@B@@@B@@AB@AB@@@@@@@B@@A@@B@@@@@@B@@@@AB@B@DB@@B@BAB@@AB@@@BA@@@A@CB@@@@@B@@@@BDA@B@@@@B@B@@A@@@AB@B@@@B@B@@@BAB@@@@A@@BC@A@@BB@@B@@@@BB@@@@@@@@@BBB@@BB@@@@@@@@B@@B@@B@@B@@@@B@@@@@B@@B@@BABAA@@CB@@@@@DA@@B@@@@A@@@@B@BB@@B@@B@@@@@BB@@AB@@B@@@BB@@@@@@B@@@B@BB@@@@B@@@@A@AB@@@@@@@@@@@BA@B@@@@BA@@@A@@@@@@@@@@@A@@@@@@@AA@B@@@@AB@@@@A@@A@@@@@BA@@B@@@B@@@B@@@@B@@@B@@BAB@@@@@@@@C@@@@@@AA@@@A@@@@B@@A@@B@@B@@@@@@BA@@BA@A@@@A@@@@@@@A@A@@@@@@@A@@@AB@@@@@@@@@@A@@@@@@@AB@@@@B@@@@@@B@@@B@@@B@A@@@@A@@@@B@@BB@@@@BBAB@@@@@B@@BB@@@@@@A@@@@@@@@B@@@@@@B@@BA@@@@B@@@@@D@@@@@B@A@BA@@@@@@B@@BB@@@@A@@@@BA@@BA@@@@@AA@@@E@@@@@BA@AB@DAD@@@@@@E@AD@@@BB@@B@BB@@B@@AB@@@B@@@@@B@@B@@@BA@@@@@B@@@B@@@BA@@@A@BBABA@@@@@A@@BA@@@@@@@@@B@@@@@@BBB@A@@B@@@BA@@BA@@B@B@@BB@@@@@@B@@@@@@@@@@@BB@B@@B@B@@A@B@@B@@@@ABA@A@AB@@@@AB@B@BADA@AB@BADA@@BA@@@@A@@ABA@@B@@@@@B@B@BA@@BA@@@@@@@A@@@A@@@A@@@@B@B@B@@@B@D@@@@A@@B@@AB@@AB@@A@@B@@@B@@C@@A@AB@@A@AAAA@@@@BAAABA@@A@@A@@BA@@@@AAA@AA@A@@A@@@@@AA@AA@@@BA@@AA@C@@@@B@@@B@@ABA@@@@BABAB@@AB@B@@@BA@@B@@@B@B@@@B@@@B@BA@@BBB@@@@@@@B@B@@AB@@@@A@@@A@@BAAA@A@@@A@AB@@@BAD@@@BA@@A@@AA@@CAA@@BABAB@@@@@@A@A@@BA@A@@BA@@@@@A@@B@@@BA@A@AB@@@B@B@@@B@@@B@@AB@@ABA@@BA@@@@@AAA@A@@@A@@AAA@@A@@@@@A@@@@@AA@@@@A@@B@B@B@B@@@@@@A@@@@@AA@@@A@@@A@@AB@@@@A@@B@@@@@BA@A@A@@@@A@@A@@@AA@@@C@@@@@@@C@@@A@@@A@@AA@@@@@@A@@BAA@@A@A@@@@B@AC@@A@AA@@AAA@@A@@@@A@AA@@A@@@@A@@C@@AA@@@@@A@@@@@@AAAA@@@BA@@@A@@@@@AC@@CA@@A@@@AA@@A@@A@@A@@A@@A@A@@@A@A@@@@BA@@@@B@B@BABB@@BA@@@@@BB@B@BABB@@@@B@@AB@@@@A@@B@@@@A@@@@@@@C@@BA@@@@BA@@@@@@@AB@A@@@@@@A@A@A@@A@@@A@@AA@@@A@@@A@@A@@A@@A@@@@@A@@AC@@@@B@@A@@@@@@AA@@A@BA@@@A@@@AB@@@AAA@B@AA@@@@A@@@CAB@@AB@@@B@BA@BB@@A@@B@B@@@@A@AB@@@@@@A@@BAB@@@B@@A@A@@B@@@B@@A@@B@@B@@B@@@BB@@@@@@B@@BBB@@B@B@BBB@B@B@BB@@BA@@B@@@@@B@@@@@B@@@@@@@BB@@@@B@@@@B@@@@B@@BB@@@B@@@B@@@BAD@@@@@@@@@@A@A@@@A@@BA@@A@@A@@@A@@@@@A@@@@@@BA@@BA@@BA@@@@@@BA@@@A@@@@@A@@@AB@@A@@@AB@@@@A@@A@@ABA@@@@B@@A@@@AB@@AB@@AA@@A@@A@A@@@@A@@@A@@@@AA@@@@A@@@@@@@A@@@BBA@BBA@@@@@@@@BA@@@@@B@AA@@B@@@@A@@A@@@@@@@@@@B@@ABB@A@@@@@@@@@@@AA@@@A@A@@@@A@@CA@@@A@@A@@@@@@@A@A@@@@B@@@B@@@DA@@AAA@@@@A@@@@@AA@AA@@A@@@@AA@A@@A@@B@@@BA@@@ABAD@@@@A@@@@B@@A@@@@@AB@A@@@@AC@@@A@@@A@@A@A@A@@BA@@@@@AA@@@BA@@@@A@@@@@A@ABA@@@A@@@A@@@@BCA@@AA@@@@@@A@AA@@@@@A@AA@@@B@@@@@@A@AB@@A@C@@AA@@@C@@@@@AA@@@@A@A@@A@@@A@@@@@@@@AB@@@@@BAB@@AB@@@@@@A@@@A@@@AA@A@@@@@@AA@AA@@AA@@AA@@@@A@@@@@@@@AB@@@@A@@@@A@B@@A@@BABCB@@@@A@AB@@@B@@@@@BB@@@@BBAB@@BBB@@A@@@BBB@@BB@@@B@@@B@@B@@@B@@@@@B@@@BAB@@@DDBBB@BA@@BADABAB@@@B@@@@@@@@@B@@@@@@@@@B@@@B@@AA@B@@@@@B@@@B@@AB@B@@@BA@@B@@AB@@@B@@@D@BCB@BA@@B@@@BA@A@AB@@A@@A@@AB@@@B@@ABA@A@@@A@@@A@@@@BA@A@@B@@ABBB@@@B@@@B@BBBA@@BBBB@@@AB@@BB@BBDB@@@@@@B@B@B@B@BBB@B@DB@AB@BBB@B@@@B@B@@A@@BA@@B@BB@@@C@@@@@A@@B@B@@@@@@A@ABC@@AAB@@@@@@@@AB@B@@@@A@@@@BC@@@@@@AA@@@@BA@AA@@C@@@@@A@@@AAA@@@@@A@AA@@@@AB@A@@A@@A@@@AA@@AA@@@@@A@@@@A@@A@A@@@@AA@@@@@A@@@@@@@A@@@@@A@A@@@@@A@@@AB@@@B@@AB@@@B@B@B@@@AA@@A@@A@@A@@@@A@@@AA@@@A@@@@A@@A@@A@@@@A@@A@@@A@@@AA@@AA@@BA@@@A@@AA@@@CA@BA@@@@@A@@BA@@@@@A@A@@@@@@@A@@@@@A@@@AA@A@@AA@@@A@A@@A@B@@A@@BAA@@@@@@@@A@@B@@@@@@A@@BA@@@@@AA@@@BA@@@A@@@@B@BA@@@A@@@ADEBA@@AAAA@AA@AA@ABA@A@@@A@@@@@@A@@A@@@AA@@A@@AA@@A@@A@A@@@AA@@A@A@@B@@A@@@@@A@@A@@AAACA@@@AAA@@@@@C@A@A@@A@@A@A@@@@@A@@@ABA@@BA@@@A@A@A@A@@AA@A@ABAAA@@A@@AA@A@@@AA@@AA@@A@@@A@C@@A@@@@A@AAA@@@A@AB@ACAABA@@@AAA@@@@@@@@AA@@A@AA@@A@@@A@@@AB@@A@A@A@@B@AA@@BA@@B@BC@@B@B@@@BB@@B@@B@@BBB@B@B@B@BAD@@@@@@BB@@B@@BBBCB@@AB@@@@@B@B@@@BAB@@@D@@@@@BCB@@@B@@B@@B@@@BB@@BAB@BA@A@A@@@A@A@@@A@A@@@@@@B@@@DCB@BA@@@A"],encodeOffsets:[[113069,22742]]}},{type:"Feature",id:"450923",properties:{name:"博白县",cp:[109.975985,22.273048],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@A@@@@A@@@@@@@@@@A@@@C@AB@@A@A@@AAA@AC@@@CAAAA@@BAB@@A@A@@A@@@A@AA@A@@@@@AB@@@@AACAA@@@C@@A@AA@@A@@@ABEA@BAB@@A@A@@@@@AB@B@@@@CA@@@@A@@AA@@AA@AA@@@@AAAAA@AACCA@@@BCAA@@@AC@@A@@@@@A@@@@C@@@@@@@@@@@AB@@@AA@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@A@@@A@@@@@A@@@A@A@@@AA@@A@@A@@A@@@A@@BA@@@@AA@@@A@@@A@A@@@A@@@A@@@@@A@@AA@A@A@@@A@A@@BA@@@A@@B@@A@@@@B@B@@@@@@@@@@AA@@@@@BA@@@@@@@A@@@A@@AA@@@@@@@@@@B@@@@@@@@A@@@@A@@@@A@A@@@@@@BA@@@A@@BA@@@@@A@@@@@@@@BAB@@@AA@@@@@@@A@@B@@AB@@@@@@A@@@@@@@@@@@@@@@@@A@@@A@@B@@@@@B@B@BB@@B@@BB@B@@A@@BA@@@@@A@A@@@@@@AAB@@@@CB@@@@@@A@@@@@A@@@@A@@A@@B@@@B@@@@@B@@@B@@@@A@AA@@A@@@@@A@@B@@@@@@B@@B@AB@@B@@A@@@A@@@@@@BA@@@@@@@@@A@@@@@@@@A@@AA@@AAB@BB@@B@@@@AA@AC@B@@@@ABC@A@A@@@A@A@@@@@@@AA@@@A@@A@@@@@AA@@@@@@@@A@@@@@@@@BA@@B@D@@@@ABAB@@@@AA@@@@A@A@A@C@@@A@@@@A@@@A@@@@@AAB@@A@@@@AA@@@A@@@AA@@@BA@@@@BA@@@@@A@@AAA@@A@@AA@@@@@A@@BA@@@AA@@A@@@AB@BA@@@CB@@@@A@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@B@@A@@@A@@@@@AA@AAAAA@AA@@@AA@@@@A@@@AA@@@@AA@A@@@@@A@@@@@A@@AA@A@A@A@@@@@A@@EB@@@@@B@@@B@@@DAB@@@@A@@@@B@@@@@D@@@BA@@@@BAB@@A@A@@@A@@B@@C@@B@@@@@@@@BD@B@@@@BBBB@@@@@@AB@@A@A@A@@B@@A@@B@@A@A@@@@@A@A@@@@@A@AAA@@@A@@@A@@@@ABC@A@AA@@@AAA@@@@@@A@@AA@AB@@A@@@@@@@A@@A@C@@@@A@@@A@@@@@A@@@AA@@@A@A@@@A@@A@@@AA@@@@A@@A@@@A@@A@@AA@@@C@@A@@A@@B@@@@@@A@@BAA@@A@A@A@@@A@@BA@A@@@A@@@@@@A@C@@@A@@@@@A@@@@@A@@@A@@@@@@@@B@@AB@@@@B@@BB@@@@B@@AB@@@@A@@@CB@@@BA@@@@@@@BB@B@@B@@B@@@B@@@@@B@@@@AB@@A@@@@B@@@B@@@B@@@@@@@@@@@@@@A@@@A@@A@@@@@@@@@A@@A@A@A@@@@@@A@@@A@@AA@AAA@@@A@@A@@@@A@@@@@@@@@A@@@@@A@@CA@@@@A@@@@A@@@A@A@@@@@@@@B@@@@@@@@A@@@@AA@A@@@@@@A@@@A@@@A@@@@@A@@@@@A@@A@@@@@@@A@A@@@A@@@@@@@A@@A@@@@@AB@@A@@AA@@@@@@A@@@A@@@@AB@@A@A@@@@@A@@@@@A@@AA@A@@AA@@@@ABA@@@@@AA@@@@@@@@@AB@B@B@@@@@@@@A@@@@@@@@A@@@@@@@@A@ABA@@@@@A@@B@AA@@@@A@@@@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@BA@@@@@@@@@@A@@@AA@@@A@@@AB@@@@@A@@@@@AB@@@@@A@@AA@@@@@A@@@C@@@@BA@AB@@@BA@@@@BAB@@@@A@@BAB@@@@A@@@@@@@@B@@@@@B@@A@@@A@@@AB@@AB@@AB@@@@@BA@@@@@@@A@@@@@A@@@A@@@ABA@@@AB@@A@@@@@A@@@A@@@A@@AA@@@@A@@@@A@@@@@ABA@@@@@A@@@A@@@@AA@@@@@A@@@@B@@@B@@@@AB@@@@A@@@AAA@@@@@@BA@B@@@@B@@B@@@@@@@@@@@AB@@A@AA@@A@@B@@@@@@A@@B@@CA@@A@@@C@@@A@@@AB@@@@@@@B@B@@@B@@@@@BA@@@@@@@@B@@A@A@A@@@A@AB@@A@@B@@@AAAA@@@AA@@A@@A@@@@A@@@@B@@A@@@@BA@@@@@@@A@@@@@A@CA@@A@@@A@@@@@A@@@@AB@@@@@@@@A@@@AB@AA@A@A@@@@@@@AA@@A@@A@@@A@@@A@@@@@A@@@@@@@@@A@@A@@@@@@@@@A@A@@@@@@A@@AAA@@AA@@@@@@A@A@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@CA@@EC@@@@@@@A@@A@@A@AAA@@@@A@@@@@A@@@AA@@A@AAA@@AA@@@AAA@@@@A@@@@@@@@A@@AAAAAC@@AA@@AA@@@C@@@AAA@@@A@@@@AA@@@@@@A@@@A@@@A@@B@@@@@B@@A@BB@B@B@@@B@@@BA@@BAB@@@@@CA@AA@@@@@@@A@@A@@AA@A@@@AAA@AAA@@AC@@@A@@@@@@@@@C@CB@@A@A@@@@@@AA@@A@@@@@A@@A@@@@@A@@@@A@@AA@@@@@@@@@AA@@@A@@@@BA@AA@AAAACAAA@@@A@@@A@@@A@@B@BA@@@@@AB@@@@@B@B@@@@A@@@@@@@@@@A@@@@AA@@@AA@@@@@@@AB@@@@@@A@A@@@@A@@A@@A@@AACA@@@BA@@@A@@@@@A@@@AA@@@@@BAB@@@@@B@@A@@A@@@AA@@@@@@@ABC@@BA@@@@B@@A@E@@@AA@@A@A@@@AB@@AAA@E@@@A@@@@@A@@BA@@@@@@@A@@@@@A@@B@@A@@@@@@@A@@CC@@AAA@A@@A@@A@A@@@@@@A@A@@BABAB@@@@B@@@@@@@BB@@@@@B@@@@A@@A@@CA@@@@A@@@A@@@A@@@AAA@@AA@@A@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@A@@@@BA@@@@B@@@B@@A@@@@@A@A@@@@B@@@@@@@BC@@@@@@A@@A@@@@@@@A@A@@@@@A@@B@@AB@B@@@@@B@@@BA@@B@@A@@AA@@@@@@@A@@B@@@@@B@@@@A@@A@@@@@@@@A@@@@@A@@@@@@@@@BA@@@@@A@@@@AA@@@BA@@@@@@@@A@@@@@@@@B@@A@@@@@@@@@@AA@A@A@@@A@@@A@@@A@@@@@@@AB@@A@@@AA@@@@@@AA@@@A@@A@@AAAA@@@@@@@A@@@@A@@A@@A@@@C@A@@B@@@@@@@@@B@@@@A@@@@AA@@@A@@@@BA@A@A@@AA@@@@@@@A@@@@@@BA@@@@@C@A@@@A@@@A@@@@@@@A@@BA@@@A@A@A@@AA@@@@A@@@@@A@@@E@@@ABA@@@@@A@A@@@A@@A@A@@@@@@@@AA@@A@@@@@@@@@@A@A@@@@AA@@@@@@A@AAAA@@@AAA@@@@A@@AA@@A@@@A@@@C@@AC@C@A@@@AB@@@@@@@BA@@@B@@@BB@B@@AF@BA@@B@@@BA@@@@@@@A@@@A@@AAA@@@@AB@@A@@@@@A@A@@@@A@@@A@@@A@C@@A@@ACA@@A@@@AB@@@@@@BB@@@@@BA@@@@B@@@@B@@@@@@@@B@B@@C@@@AB@B@@@@@@@B@@@@@@A@@AA@@@AA@@@A@@B@AA@@@@AA@@AB@@AAABAAA@AABAA@AA@@BA@@@@@@A@@AA@@A@AA@A@AAA@AA@AAAAAAA@C@A@C@ABA@@@A@ABA@@B@A@@@AAA@@A@AA@@AA@@ABABABBB@BA@@@A@AA@@A@ABC@BDBBA@@@AB@BA@@@A@@AAA@AAACEACAAA@AAC@GBA@@B@@@BABA@A@A@A@A@@ABA@@@AAC@@A@C@EC@BA@A@@A@C@CAC@@A@@@A@AB@A@@@@AA@@A@CAABCDE@A@@AAACD@BCAKAEBBB@@C@CB@@@D@BABC@AAAAAC@@AC@@@A@AAA@A@@@A@AB@@A@@@A@A@CI@AAA@CD@BABACAB@D@BADABAAABAB@@G@CB@D@BABBDB@@F@DCJCFAD@F@DADAB@DADEDABC@A@AAA@@CEC@A@@A@@AA@@@@B@@A@@@@@@@B@AA@@AB@@@@ABCD@B@DAACABG@@@A@@@@@@@A@@@@@AGAAEGKG@@B@B@B@@ABBBA@@@@@ABCB@@A@@@@@A@AA@ACCED@D@B@@AB@@@@GD@@@B@BA@A@@@A@A@@@@B@@@BABC@A@C@A@@@A@@@@A@@@@@@A@@C@A@@AA@A@@A@@@C@AB@@@@AA@A@@A@@@A@@@A@@B@@@CB@@@@AA@@@BAAA@@@@@@AD@@KFABA@@BCDAB@B@BAB@B@BBD@BABADCFADABAF@B@DBD@BB@@BB@@BBB@@BBB@@B@BBB@@BB@B@B@@@F@BABBDBB@@BB@B@B@B@B@BAB@B@@AB@B@B@B@B@@@B@B@@BB@@@@@@A@@@A@A@BBA@@@C@@@A@@@AB@BBB@BBBABBB@@@DBJB@@BDTILANAA@@BB@B@@B@B@@@@@@@@B@B@@A@@@AB@B@BBB@@ABA@B@@@BB@@@B@@@@BBB@BDBBBB@B@@@BB@B@B@@@@@@@B@D@@@@DAB@@@B@BBB@@@@@B@@JRB@@B@@@BBB@@@@ABAB@@AB@@AA@@A@AB@@AAABAAAB@@AB@@BB@@@BABB@@@@B@@@D@B@B@@@B@B@BA@@B@B@@B@DB@D@@D@@@@@@@BB@@@BABBBABA@@@@@@B@B@@@B@B@BB@@BD@@@@@BB@@@BBB@@@B@@BB@BBB@@AB@@AB@@@BB@@@@B@B@B@B@B@@@@AB@@@BA@@BA@ABADINCBJF@BB@@@BBAB@B@BDBDJEJBF@J@D@FBBBBAB@BA@@BA@A@@BA@A@@AA@@BA@@B@@@F@B@@A@@B@@EAECE@CACCAAABAAEAC@C@AB@BAB@@A@@@ICA@C@@AA@@AA@@AC@AD@BAB@@A@@BAB@@CB@LGA@@A@AAA@@@A@@@ABE@A@A@A@A@@BA@@@AD@@@@A@A@AAA@@AA@@@@@EA@AA@AA@@AA@@@@@KI@@@@@@BA@@@AB@BABABAB@B@@EBA@@@A@AB@@AD@@@BAB@@@B@@@B@D@@B@@B@B@BA@@B@@@BBF@BAB@B@@AB@@@D@B@B@B@@CD@@A@@@AA@A@A@A@@A@AA@@B@@A@@@@@@@A@A@@A@CA@AA@AAA@@@@@@AA@A@BFBLAH@B@B@BB@@BB@@BDDB@@B@@@@@BBB@@@B@@@@CF@BCBA@ABA@EBA@A@CBE@ABABABAD@DAFAD@@ADABAFAB@B@@BFFDJ@DCDE@@BAB@F@BAB@@@BB@@BB@B@B@B@BADAD@B@B@B@@BB@BB@@@@B@@@BAB@B@F@B@D@BAF@BA@ATELA@@BAD@F@BAD@BERERG@MPV^AH@@IACDIA@HABA@A@AB@@AB@BGHADB@AJABA@ABEDCBCBEBFFBDGDAL@@B@DAB@B@@BB@@B@@@BA@@BCB@@@@@B@B@@B@DB@@BBBBBDBBBBBBBDB@@@@BA@AFA@@BC@@BABAB@@AB@DAB@@AD@@@B@@DBAB@@@B@@@B@@@@AB@@@BB@B@B@@@BB@B@@@@CB@@AB@B@@@B@@B@@@@@@B@@@@@B@@B@B@@@@@B@@B@@@@BB@@@@@BA@@B@@ABA@@@@@BB@@B@B@BBBD@@@B@BA@@B@@@B@@BBB@@@B@@@@BA@@@@B@BB@@B@B@@@BAD@BB@@BB@@D@DBDA@@@C@@@ABBB@@B@B@@@@BA@@@AB@B@@@B@BBB@@B@B@@@B@@B@@@@BB@A@@@@BA@@@@@@B@@@@@@@@@@@@@B@@C@@B@@@B@B@@ABBB@BA@@B@@B@@@B@@@@@B@@B@BB@@B@@@@BD@@@@@@B@@@@B@@@B@@B@BB@@B@@@B@B@BBBB@@@@B@@@@@BA@@@@B@@B@@@@B@@A@@@AB@@@@@B@@@B@@B@@@B@@@@B@@@@@B@@@@@@@B@@@@B@@@B@@@@BB@@@@@AB@@@@@@A@@@@@@B@@B@@@@@BB@D@@@@B@B@B@@@@@@B@@@@AB@@A@AB@@@BA@@@@@@@@@AB@@AB@@AB@@@B@@@@@@@B@@@BA@@B@B@@AB@@B@@@@@B@@@B@@@@@B@@@@@B@@B@@A@@@@B@@BAB@@@@@@A@@B@@@@@B@BA@@@@@@@@@BB@@@@@@BB@@@@B@@@@AB@@@@A@@@@BA@@B@@@@@@@B@@A@@B@@@@AB@@@B@@@@A@@@@@A@@B@@A@@@@B@@@@@B@@@@@BB@@B@@@BB@@@@@@B@@BB@@@@@@@BB@@@@@BB@@@@@@@@@BB@B@@@@@@B@@@B@BB@@@@D@@@@@B@@@B@@@@A@@BA@@@@B@@@B@@AB@@A@@@@@@@A@@@AB@@@@@@@B@@@@@@@@AB@@@@A@@@@B@@@@@@@@@B@@@@A@B@@B@@@@B@@@@@@A@@BB@@@B@@@@B@@@B@AB@@BB@@@@@@@@BB@@@B@@@@@@A@@@@B@@@@@@AB@@@@@@B@@B@@@@@B@@BB@@A@@B@@@B@@BB@B@B@@@@A@@@@B@@@@@@@BB@@@B@@BB@@@@@B@B@BB@@B@@@@@@@B@AB@@@@A@@@@@@@@@@B@A@@@@A@@@@@A@@@@@AA@@@@@@@@@B@@@@@@@BB@@@@@@B@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@A@A@A@@@@@@@BB@@B@B@@@@B@@@@B@@@@@@BBA@BB@@A@@B@@B@@@DB@@@@@@B@@@@B@BB@@B@@@@@@@B@B@@@B@@@BB@@@@B@B@@@@@@@AB@@A@@BA@A@A@@@A@@@@@A@@B@B@@@@@B@B@B@B@@@@@@BB@@@B@@B@@@AB@@AD@@@@@BB@@B@@@@BB@@@@B@@@@AB@@@B@B@@@@@@A@@@@@@@@@ABB@A@@BB@@@@@@BA@@B@@@B@@@@@@@@@@@@A@@B@@@@@@@@@B@@BB@@@@BB@@@@@@@@B@@B@@@@@B@@@B@@@@@BB@@@@@BB@@@@@B@@@BA@@B@B@@@@@@@B@@@@@BB@@@@@B@@B@@@BB@@@@@@@@@B@@B@@@@@B@B@B@@@BA@@B@@@@@DB@@@@BDBBB@@@@@BB@A@@@B@@@@@@B@@@B@@B@@@@@@@@@@@@@@BB@@@@B@@@B@@B@@B@@@@@B@@@B@B@@@@@@@BB@@B@@@BB@@B@B@B@@@@@@@B@@@B@@@@@@@B@@A@@@AB@@AB@@@@@@@@@@@@A@@@@@@B@D@B@@@@@BA@@@@@@@@@@@@BA@@@@@@@A@@@@B@@@@@@@@@B@B@@A@@B@@AB@BA@@@@@@@A@@@@@AB@@@@A@@@@@BBA@@@@@A@@B@@@@@@A@@@@@@BA@@@@@@@@@@B@@@@@@@@@@@B@@A@@B@@@@@BA@@@@@A@@B@@@@@@AB@@@B@@A@@@@@@BA@@BB@@@@@@BB@@@@@@B@@B@@@A@@B@@@@B@@@@@@@@B@@BB@@@@@@@@@@@@@A@@B@@@@A@@@AB@@@@@B@@@@ABA@@@@B@B@B@@@@@@AB@A@B@@AB@@A@@@@@C@@@A@@@AB@BB@@B@B@@@@@@@BA@@@@B@@A@@@@@ABA@@@A@@B@@@@@@A@@@AB@@@B@B@@@@@BBB@@@@@BA@@@@@BB@@@B@BBB@B@@@@@@B@@@@@@BB@@@@B@@@B@@@@BA@@BDBB@BBD@@@@AB@@@@@B@BA@@@AA@@AB@@@B@@B@B@@@DAB@BA@@BB@@BBBA@@BA@@@@@A@@B@B@@A@@B@BA@@B@@AB@@A@@D@B@B@@@B@@B@@@BA@@BA@@@@@@@@D@@@BB@@B@@@@@@BB@@@B@BA@@@AB@B@BB@@@B@@@DB@@@AB@B@@@@A@@@@BB@@B@B@@A@@B@@BBA@AB@BB@B@BA@B@@@B@@@B@BB@@B@B@@@B@@BB@A@@@@B@D@B@B@@BBAD@B@BB@B@@BBB@B@@BBBA@@BA@@B@@A@@DADABAFAB@@@B@@BB@@ADA@@B@@@@BD@BBBBBBABAB@BA@BD@@BB@BA@@BB@@@B@@B@@A@@B@@AB@@BB@@@@@@@B@B@@D@@BBB@@@B@@@@A@@@A@@@@@@@@BA@@@@@@B@@B@@@@B@@@B@@B@B@@B@@B@@BBB@@BAB@@ABA@@@@@A@@@@BA@@@AB@@@AA@ABABA@@BA@@@ADADA@@B@@AB@BA@@BABA@@@AGC@@@A@C@A@ACA@A@@@ADA@@BABA@@@@BAB@B@@@B@D@B@BABAB@B@BC@@@A@@D@DI@A@@BA@@B@B@@A@@BA@@B@B@DBBA@AB@B@BA@@@ABAB@AAD@BA@@B@@A@A@@@AB@@@AAB@@A@C@@B@B@B@@@@BB@B@AB@BBBA@BBBAB@B@@@B@@ABAB@D@BAB@@AFCAA@@@A@@@ABAB@AA@@@AA@@@BABA@@B@B@B@B@@@@@@@B@@@B@B@@A@@B@@AB@@@BA@AD@@@@@BABA@@B@@B@@BABB@@@@B@@@B@@BB@@B@@B@@@BAD@B@@@@AB@BBB@@@@@B@@B@B@@B@@@B@B@B@@@DB@B@@AB@B@@@@BBBA@BD@B@@@B@BBB@B@@@@BDA@@B@B@BAB@B@ABBB@@@B@BA@@@@B@@BB@@A@@BA@A@@B@@B@@BAB@BAB@DAB@@BBD@@B@B@BB@@@@BB@@@D@D@BB@BBBA@@D@@B@@BBBA@BD@@ABC@@@A@@BA@AB@@@@A@A@@@@BA@@@@A@@C@A@@B@@A@AAADB@AD@B@@@BAB@@@@@@BBB@B@B@BB@@@B@BA@BBDBBA@@B@B@@@@@B@ADBBDBB@BBB@B@@@B@BA@BDB@@@B@B@@BB@@AD@@A@@@@@AB@@ABA@AB@@@B@@B@@B@@BAD@BB@@@@@@B@BBBBB@@BAB@@B@@@B@@@BB@@@B@@@B@@A@A@@BAB@@@BA@@B@@@BA@@@AB@@AB@@@B@@@B@@@@ABABABA@@BA@@D@BAB@@B@ABBBABB@@B@@B@B@B@@@BBA@@BAB@@A@@BA@@B@@A@@B@@AB@@A@@B@@@@A@A@@@AB@@@@A@@@A@@@@@@@A@@@@B@BA@@@A@AB@@A@@@A@@@AB@@A@@@@A@@A@@@ABA@A@A@A@@AA@@B@@A@@BA@@@BBCB@B@@@@@@AB@@@@@BA@@@@B@B@@@@A@@@C@@@@@A@@@@B@@A@@@A@@B@@@AA@@B@@@@@@@BA@@@A@@@@@A@@@AA@@ABA@@@@BA@@BA@@@BBAB@B@B@@ABA@AB@@@BB@@B@@@B@B@@@@@@BB@@@@@@BB@@B@@B@B@@@BB@@@@BB@@@B@@@@@@@B@@B@B@@B@@@@B@@@@@B@@B@@@BB@@@@@B@@@@A@@BA@@@A@@@@B@B@@@B@@@B@@B@B@@@@@B@@@B@BAB@@@BA@@BAB@BB@@B@@@@B@@B@B@@@@B@@A@@BA@@@AB@@@@@B@@@@@@@B@B@@B@@B@@@BB@@B@@ABABB@@@B@@@BB@BB@@B@@@@@@BB@@@@@@@@@@BBB@BB@@BBBB@@B@@@@@B@B@@@@@@A@@B@@@DA@@@@@A@@@AB@@@@AA@@A@A@@@A@@@@AA@A@@@@BABA@BB@@AB@@@B@@@B@@AB@@@@A@@@ABAB@@@BA@@@B@@B@@@@@@@@A@A@A@AB@@@DB@@B@@@BA@@B@@@B@@BB@@@B@B@B@@@B@@ADAB@@@BAB@@@@@@@B@@@B@@B@@@@@@@BB@@@@@B@@@@@B@@@@A@@@@B@@@BA@BBB@@BB@@@B@@@@AB@@@@A@@@@@@B@@@@@@@@B@@@@@@BB@@@B@@@BA@@@@BB@@@@BAB@@@B@B@B@B@@@@BB@@@B@B@@@@B@@B@@@@@@@BBB@@BBB@@DBB@@@@AB@B@BA@@B@@AA@@ACA@A@@AA@AAC@@@A@A@@@AAA@@@@BAB@BBBC@A@ADAB@BB@ADCB@@AAA@@@@@@@@B@B@B@@@BB@B@@@B@@@@BA@A@@@@D@BAB@DAB@B@@@B@B@@@B@B@@AB@@@BA@@B@BA@@B@B@@@@@B@B@@@B@DC@@DABAB@@AB@@@@@BA@@@A@@B@@@@AA@@@@@@@@@@@A@@@@@@B@@@@AA@@@@@@A@@@@@@@A@@@@@A@@@@A@@@A@AA@@@@@@AA@@A@@@AA@@A@@@@@@@@@@AA@@@@AB@@@@@A@@@@A@@@@@@AA@@@A@@A@AA@@A@@@AA@A@AA@@@@@ABA@@A@@A@@@@@CB@BAB@B@B@@@D@@@@AAA@A@@@@@A@@@A@@@AA@@@AA@A@@@@DA@CB@@AB@@CB@B@@A@@AA@@@AB@@@BA@@B@@@B@@A@@A@@@CA@@@C@@A@@@A@AAA@@@@@@A@@A@A@@AAA@@@@@@@@BA@AB@BAD@@@BC@@B@BA@@@ABA@@@@B@B@BAB@B@@@B@BAB@@@B@@@BAB@B@BA@@BB@@@A@@B@@@B@B@@@B@@A@@B@@@B@B@@@B@@@B@@@D@BA@@@@BAB@BAB@DAB@B@@@@B@B@BB@@@AB@@BB@B@B@B@@@B@@@@B@@@BA@@BB@@B@B@@@@@B@@B@B@@B@@@DCBABA@@B@@@DAB@B@@BB@B@@@@BB@B@@@BBB@@BB@BA@@B@D@@@B@@@B@@@BABAB@B@B@@@@A@@BAB@@A@@@@@A@A@@@A@@B@B@@AB@BAB@B@BAF@B@D@BAAABA@G@C@C@ACA@A@@B@B@@@@@@AB@@@@@BAB@BBBA@BB@@BBA@B@@BA@@BB@@B@BA@@BA@@@@BB@@B@BA@BBABBBA@@D@@AB@@@B@B@@@B@B@@@@@B@@A@@B@@@B@@@B@@@@@BB@@B@@@BA@@@@@@B@@@@A@@B@@@@A@@@AB@B@B@@A@@B@@@@@@@@A@@BCA@@A@@@@BA@@AA@@@A@@@@@@B@B@@@@@DD@@BA@B@@B@@B@@@@@B@D@B@D@@AB@@@@A@@@@B@@A@@@@@AB@@@BB@@@BAB@B@@@B@@@B@@A@@@@BA@@B@BA@@B@@@@@@@AA@A@@A@@A@@@A@@B@B@@AB@BBB@@DBB@@B@@@B@@A@A@@@@B@@@BA@@@@@A@@@AB@@A@A@@@BB@@@B@D@@AB@B@@@@@B@@@B@@@@BBBBABB@A@@B@B@B@@@B@@AB@AA@@@@BA@@B@@@@@B@@@@@B@@AB@@A@@@AB@@A@@B@@@D@@B@@@BB@BA@@@@B@BA@A@@@A@C@A@A@ABA@@@@B@@ABBB@@@D@B@BB@BB@@@DBB@@@B@@BB@BD@BB@@BB@@@BD@@B@@@BBB@@@@B@@@B@BBB@@BD@@@B@B@@BB@BB@@@BB@@@BB@@B@@@@@B@BB@@@@BA@AB@@@B@@BBAB@@@@BB@@@@@B@@BB@@@@BAD@@@B@B@D@@@@A@@@@@@BB@@@@BA@@B@@BB@B@B@B@B@B@@@B@BA@@@@@@B@@@B@B@@@B@B@B@@@B@B@@@BB@@@B@@@@BB@B@B@@BB@AB@BABABA@ABC@@@@BA@@@@B@@@@A@@@@A@@A@@@A@A@@@A@@@@AA@@@A@@@@@@BA@@@@@ABA@@@AB@@C@@@A@@@AA@@A@@@A@@AA@AA@@AB@@AB@@@@@B@@@@@B@@@@@BA@BD@BB@AAAA@@A@@D@@@@A@@BA@@B@BAD@B@@@B@@BD@@@BABA@@BABB@A@@@@@@@AB@@@@@D@@@B@BA@@B@BCB@@ABABABABCBA@A@@BBB@@@B@@AB@@@@ABB@@B@@@@@@ABA@@@@@AB@@A@@DA@@@C@ABA@A@@@@A@AA@@@@@AA@@@A@@A@@AAA@@A@@A@@A@@AA@AA@@A@@@@@AAA@A@@AA@@AA@@A@@@A@A@@A@@@@CAAB@@@@@@C@@@@@BAAA@AA@@@@@AAAAAABC@A@@A@AB@@AB@@@@@A@@A@A@AA@@A@@@A@A@@B@@@BA@@@A@@AA@A@@@@A@@AAAAA@@@AAA@@@A@@@A@@@@@ABA@@@@@@@@AA@@A@@CA@AA@AA@@@AA@@@A@@@A@@@@AA@@AA@CA@AA@@@@A@@@@@AB@@@@AA@@@@A@@@AAA@@@@@A@A@AAAB@@@AA@@AAACA@@BA@AB@@@B@@@BA@AAA@A@@@AAA@@B@@A@A@ABA@@@A@@@A@@@@AA@@A@@@A@@@A@@@A@A@@AA@@@AA@@@@C@@@@AACB@@AA@B@AAA@@@BAA@@A@@@@AA@@@AAA@@@@@@AA@@@AA@A@@BCB@@@@A@@@@@@@A@@@@A@@A@@@AAB@ACBAA@@@@@@AA@@@@@@@A@@A@A@@@A@@@A@A@A@@@A@@@@B@@@B@@AB@@AB@@A@@@AA@@A@@@AA@@A@A@@@AAA@AAA@@A@@@A@@@@@A@@A@@AA@@@A@@@@@AA@@A@A@AA@@@A@@B@@A@@BA@@@@@A@@@@B@@A@@BA@@@A@A@AB@@@D@@@@B@@B@B@D@@@BD@B@BBB@B@@B@@@@@@@B@@@@@@@D@@@@@@@B@B@@@@AB@@@B@@@@A@@B@@@@@@AB@@A@@@@@A@A@@BA@C@@@@AA@A@A@@@AAA@A@@A@BABA@@@@B@@A@@@@BA@A@@@A@A@@@A@@@@@@BA@@@A@@@@@@@@B@@@@@BA@@B@@@@@@@@A@@@@@AB@@@@@@@@AB@@@BA@@@BB@B@B@@A@@B@B@@AB@@B@@BB@@BA@@@@@@B@B@BA@A@@B@@AA@@A@@BA@@@A@@A@@A@@BA@@@A@A@AA@@@A@@@@A@@@@@@@A@@@@@A@@AA@@@AB@AA@@@AA@@@@AA@A@@A@@AA@A@@@A@@@A@@@A@A@@@@B@@A@@B@@AB@@A@@B@BAB@@@BB@@@@@A@A@@A@@A@@B@@@BA@@@@BA@@B@@A@@@AAA@C@@@AB@@@DC@@BB@@@@BA@AB@@A@@@@@AB@@A@A@A@@@@AAA@@A@AB@@C@@@@@A@AAAB@@A@@A@@@@A@@@@@A@@B@BADC@@@@BDB@@@B@@@B@@@@@@@B@B@@@@@@@B@@@@@B@@@DB@@AB@@BB@@@B@@@@@@B@@@@@@BB@@@@@B@@@B@@@B@B@@@B@BAB@@@@@B@@@DB@@@@B@@AB@@AB@@@@A@@@@CC@@@@AA@@A@@@@@@ABA@@@A@@@@@@@A@@@@@AAA@@@BAA@@@@@C@@@@BA@@BB@@@AB@@A@A@AAA@@AA@@@AB@@@BA@@@A@@@A@AB@@@@@@C@@@@BA@@@AB@A@@A@A@AA@@A@A@@B@@@@@BA@@@@@AA@@AA@@@@@AA@@AA@@A@@AA@@@A@@@AAA@@@@@AA@A@@@A@A@@AA@@@AA@@AA@@AA@B@@@@A@@@@BA@@B@@@B@BA@@@AB@B@@@BA@AB@@@AA@@@@AE@@@A@@@A@@@A@A@@A@@AA@@@A@@@@A@@@@@A@@BA@A@@@C@@@A@@@@@@BA@@D@D@@@@AB@DCD@B@B@B@@@B@D@@@B@@@B@@@@@@AB@@@B@@@BA@AB@BAB@BA@@D@@@@ABAAAB@@@@@@A@A@AB@B@@@@@BAB@BA@@@A@AB@@@BA@@@ABC@@A@AA@@@AAA@A@A@@@@A@A@@@@A@@AA@@@A@@B@@@@A@@@A@@@@AA@@@@@AA@A@@A@A@@@A@@@A@@CAA@@B@@C@@@A@@@BA@A@@BA@@@@ACB@AA@@@AB@@@@AA@BA@@AAA@@@B@B@@@@A@@BC@@@@BA@@@@BC@@D@@BBAB@BC@@@A@@B@B@@@BA@@B@B@A@BA@@@AAA@@A@@@@AA@@ABA@@A@@@@A@A@AB@BA@@@@@AA@@@@A@@BA@A@@A@@A@@@A@AA@@@@@AB@@@@A@@A@A@A@@@@@@@AAA@@@AB@@@B@B@BAB@@@B@@A@@AAB@@@B@@@@@B@@@@@@CBABA@@@A@@@AAAAC@@@A@ABEBABA@ABA@AACBA@A@A@@@A@C@A@C@@@@@A@ABA@@@A@@BA@ABCBABABCDADA@@@@BA@@B@@ABA@@BA@AB@@A@A@@BA@@B@DBB@JDB@@@B@BAFC@AD@BAF@@@DBD@BAB@BABAB@DCB@F@B@F@BAB@F@BA@@BAB@@@BAB@@ABCB@DC@@DC@@DCB@BCFCB@BAB@B@D@B@@@B@BB@BB@B@@@B@@@BAB@B@B@B@"],encodeOffsets:[[112938,22445]]}},{type:"Feature",id:"450924",properties:{name:"兴业县",cp:[109.875304,22.736421],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AABC@A@@AA@A@@@AA@@A@@B@@@@@B@@@A@@@@@B@@B@@@@@B@@@B@@@@@@B@@@@B@@@@B@@@@AA@@@A@@AB@@A@@@@@@A@@A@@@A@@@@B@@@BB@@@@BB@@B@@@A@@AA@@AA@BAA@@B@A@AA@@@@A@@@@@@@@B@AA@B@A@A@@@@A@@A@AA@@A@@@@@@BBB@@@AB@@@@B@@@@A@@@B@A@@@@A@@A@@AA@@@A@@@ABA@@AAB@@AB@B@@@@@AAA@@AA@@@@@A@@BA@A@@@A@AB@@@@@B@BA@@BA@ABAB@BA@@ACA@@@A@@A@@A@@A@@@A@@@@AA@@@@A@A@@AAA@@A@@ABA@@A@@C@@@A@@@A@@@A@A@@@A@A@@@AA@@AB@@@@@BA@@@A@@@AB@@@@AB@@ABCB@@A@@A@@@AA@@AA@AB@@AB@@@@@@AA@@A@A@@B@BBB@B@BBBDFA@BFABA@@BBB@DA@@B@B@B@B@@A@@BAA@@@B@@ABA@@@@@@@A@@@A@A@A@AAAA@@@@A@@B@@@@@@@B@@@@@@AB@BAB@@@B@@@B@@A@@BA@AB@@A@@DA@ABAB@@@@@@A@@@@@A@AB@@@@A@@@@ABA@@@@A@ABA@@@@@@AA@@@A@A@@@ABCB@B@@@@@B@@A@@BAD@@@@A@@@@@AB@B@@A@@BA@C@AAAAA@@@@@AB@B@@AB@@A@A@@@@AAA@A@@A@A@@@CB@@@@@B@@@BBDA@@BA@ABE@@@AB@@@B@BBB@BB@HB@@B@@B@@@BA@@@@@@@IDEJ@BDB@BAD@BDBBFBBDB@BA@A@C@C@CBCB@@ADA@@BDD@@AD@@BB@BB@@BB@B@@@B@B@@A@A@@@A@@@ABB@@@B@B@BBBB@@@@@@@@@@BB@AB@@A@@B@B@@A@@B@@@@A@@@A@AAA@ABA@@@@B@BBB@B@B@B@DABFD@BAB@@@@@@@@BABBB@@B@@@@DB@B@@BB@@B@@D@@DDB@@@DD@@ABBBB@B@@@@BB@ABBB@BB@@B@BB@@@B@BBB@@AB@BB@BB@@BD@DBBB@BHFBA@B@@@@@@D@BB@@B@@@B@@@B@B@B@@BB@@@@@B@@@@@B@@@B@@@@A@@@BB@@@B@@@@@B@@@B@@B@@@@BB@A@BBA@@@@B@@@@BB@@@@@B@@B@ABB@@B@BAB@B@BA@@B@@@BB@@@@B@@B@BB@AB@@BB@A@BB@@@B@B@@@B@BB@@@@AB@@BB@@@@@B@BA@@@@@ABABA@CB@@ABB@@BAB@@CF@B@@@BB@@B@@@@B@@BB@B@@@@B@BBB@@@@BB@@@@BB@@@@@BA@@B@@@B@BBB@@A@@BBB@@@B@@B@DB@B@@BBBBBB@B@B@@B@D@@@D@BABBB@@BB@BBB@BA@@D@@@B@@@BA@@BAB@@@B@@AB@@C@@@AB@B@@@@A@@@@B@B@B@@@BB@@@@BB@@@@@B@@@BB@@BBBB@@B@@@@BBBAB@DBBAB@D@B@@@DB@@DB@@B@B@@BD@B@D@@@B@@@@@@BBB@@B@@BB@@B@B@BBB@BBB@BB@@B@@@@B@B@@@@B@@A@@B@@DD@B@@@B@@ABA@ABA@@@A@AB@@@@@BABAB@D@@@D@@AB@BAB@@C@CB@@AA@@ABA@A@@@@@A@A@A@@@A@@BA@@@@@@B@@@@@BA@B@@BA@A@@@@@@BA@@BA@ABA@@@A@@@@@@@@@@B@@@@@FB@@D@@@BBB@BBBBB@BBB@@@B@DA@AB@BAB@BAB@@ABB@@D@B@B@B@@AB@@@FBB@@D@@@@DB@@D@@A@@@@B@@BB@@@@@@AD@@A@@B@@B@@B@BA@AB@@AA@@AB@@@B@D@@AB@@@@BB@B@@@@AB@A@B@B@@@@@@@@@@@@A@AB@@@D@B@BA@@B@@@@@B@@@@A@AB@BB@AB@@BB@BA@@@@BABB@@@@B@@@@@B@@BB@@@@@@B@@@@@B@@@@@@@BB@@@@@@B@@B@@BB@@B@@@@B@@B@B@@@@@B@BB@@BB@@A@@B@@@B@@BB@@CD@BA@@B@@B@@@@BB@B@BB@AB@@@@ABCB@@@B@B@@@@@@A@@@A@@BA@@B@@B@@B@@@@@@B@@@@B@A@B@AAB@@@B@@@@@@@@@@@@B@@@@@@@@@@@@BA@@@@@@BBB@@@@@B@@@@B@@@@@@B@B@@@@@@@BB@@@@B@@@B@BBBA@@BA@@B@@@@@BA@@@@@A@@@@AAB@@@@AB@@B@B@@B@@@@A@@@@@@BAB@@A@@@A@@D@B@@@@@@A@@@A@@@A@@@A@@B@@@@AB@@@@@@@@@@@BB@@@@@@BAB@@B@@B@ABB@B@@BB@@@B@@@BB@@AB@@@@@BA@A@C@AB@@A@BB@@@@@@D@@@@B@@@B@@@@BB@@@B@@B@A@@@@@@B@@@@BBDA@@B@@BB@BBB@@@B@@@B@@BB@@A@@BB@@@@@B@@A@@@@A@@AB@@@@@@BB@BA@@B@B@@B@B@@@@@B@@@AB@B@B@@@B@@BB@B@@@B@@@@AB@@@B@@@BA@BB@@@B@@@@@B@@BA@@BB@@@A@@BB@@ADAD@@AB@B@B@BD@@B@@B@BA@@@@@BB@@@B@@@@B@@BA@@BB@@@@A@@B@@B@B@@@@@@@B@@@@@B@@@B@@A@@@A@@B@D@D@@@@@@BA@@@@B@@B@@@BA@@@@B@@BB@@@B@@@@@@B@@@@@@@@B@@@@@B@@@@@BA@@AA@@B@@@@@@@@B@@B@@@@A@@BA@@B@@@@@@@@A@A@@@A@@@A@@AA@@@@@AA@@@B@@@B@@AB@B@@@@@B@B@BA@@A@@@@A@@@@@@BAB@@@@BB@B@@AB@@@@@B@@B@@B@@AB@@A@BB@@@BA@BB@@AB@@A@@BA@@@@B@BA@@@@@@B@@A@@DBB@@@BBBBA@BBBB@@@@AB@@@B@@AA@@@B@@A@@@@B@@A@@@@@BA@@AA@B@@A@@A@AA@@@@@@B@@@@A@A@@@@@@B@@A@@BB@@B@@@B@B@@A@@@@@ABA@AB@@A@@@AB@@@@B@@B@@@B@@@@AB@@@@AB@@AA@@AA@A@@A@A@@@@@AA@A@@B@BA@@@@BA@@B@@@@@B@B@@@B@AA@A@@AB@@AABA@@AAA@A@@@@BA@AB@@A@A@A@@@@@@@@B@@@BA@@@@@@B@@BB@@@B@@@@@@@D@B@B@@A@@BAB@@@@A@AB@@ADA@AB@@A@@@AB@@@BA@@@@A@@AB@@@@@@@@@BBA@@@B@@@@C@@@@@@@@@@B@@@@@BA@B@@BB@@A@@@BB@@@@@@@@B@@@D@@B@@@B@@@@BB@@B@@@B@@@@@@@A@@@B@@BB@@@@BB@@@@@@@AB@@@@@@@AA@@@@@@DB@@@A@@B@@@B@@@@@@AB@@BBA@@@B@@@@@@B@@@AA@@@@B@@AB@@@@@BAB@D@@@@@@@B@@A@@BA@@B@B@@@@@B@@@DB@@@@@BB@@AB@@BB@@@@B@BA@@@@B@BA@@@AB@@A@ABC@@@ABA@@@A@A@@@ABC@@@A@AD@@ADAB@@ABB@@@A@@@A@@@@@A@@@@@@@B@@@@@@BB@@B@@@B@@ABBBB@ABB@@B@@A@@@@B@B@@@@@@A@@@@@A@@B@@@BB@@@B@BB@@B@@@@B@@@B@@@@BB@@@BA@@@@BCA@@@@@@AA@@@@A@@@@A@AAA@@A@AB@@A@@@@B@@B@@@@BAB@@@@@B@@@@@B@@A@@B@@A@@@@B@B@@@@@BA@@@@@@@A@A@@B@@@@@B@@@BB@@@@@AB@@A@@@@@AB@@@B@@A@@@@@@@AB@@@BA@@@@B@@@@A@@@BB@@@@BB@@@BA@@B@@@@@B@@AA@@@B@@@B@@A@@@@@@@A@@@@B@@@@@@A@BBA@@@@AAB@@@@@@@@B@@BA@@@@B@@AB@BA@@@BB@@@@@@@B@@@@@@B@@@@@BB@@@@@B@@A@A@@@A@@@A@@@AAA@@@@A@@BA@@B@@A@@@@A@@@AA@@@@A@@@@@A@@@A@@BA@A@@BA@AB@@@@B@AB@@@@A@@A@@@A@@@@AB@@B@@@AB@@@B@@@@AA@@A@@@C@@@@@@B@@@@@@@@BBA@@BB@A@@BAA@@@B@@@BB@@B@@@@@B@@@BB@@B@@@@@@@@@B@@@@@BAA@B@@@@A@BB@@A@@@@@B@@B@@@B@@A@@B@@@@@@@B@@@@B@@A@@D@@B@@@@@@B@@A@@@@BA@B@@@@@B@@@@B@@@B@@@@@BB@B@@B@B@@@B@@ABBB@@@B@@@@@@AB@@@@AB@@@@@B@BA@@@@@A@@BA@@B@@B@B@@B@B@B@@BB@@@@BB@@@B@@@B@@A@@@A@@B@@@@@@B@@@@@@@@@B@A@@B@@@@@B@@@@@@BB@@@B@@A@BB@@B@@@BB@@@B@@BB@@@@B@@@@A@@@@@@B@@@@B@@@@@B@@A@@@@BB@BB@B@@B@@@@AB@@@BA@@@B@@@@A@@B@@BBBB@@BBBB@@@BB@A@@@B@@@BB@@@@@BB@@BD@@FABBB@BBB@@@@@@BB@@@@@@@B@@@@@@B@@A@A@@@@@@@B@BB@@BA@@B@@@@@@@@B@@@@@@B@@B@@@B@@@@A@@A@@@@A@A@@B@@@@B@@@B@@B@@@@@@@@@BBB@@@@@AD@@@@@B@B@@@@@@@B@@BB@@B@@BA@@@@@AAB@A@A@@@@@A@@@@@@BB@@@B@A@@@@@A@@AA@@@@A@@@@@@@@A@@B@@@@@@@@BB@@AB@@@@@@@B@B@@@BA@@BDBB@@@@@AB@B@@B@@B@B@@B@@@@@D@@ABCD@@A@@@A@@B@B@@BB@@@BA@@@@B@@BB@@B@@@@A@@@@BABB@@@@AA@@@@@ABAB@@A@AB@BB@@B@@B@@@B@@@D@@@@B@@BB@@@@@@@@B@@@B@@B@@B@@A@@B@@@@@@@@@@@BA@@@@BB@@@@@@A@@@@BD@@@@@B@@A@@@@BB@@@@@B@@@B@@@@B@@@@A@A@@@@@@@BBBB@@@A@@B@@@B@BB@@@ABB@@B@@@@@BBA@@@@@@@@@@@B@@B@@@@@@@A@@@@B@B@@@@@@B@@@B@@@A@@@@B@@@@@B@@@@@@B@@@@B@B@@A@A@AA@@@BA@@@@@@B@@@@B@A@@B@B@B@@@@@BB@@@A@C@AB@BB@@@@@@@@A@@@@@A@@@@BB@@@@B@@BA@@@@B@@@BA@@B@@@@@B@@BA@@@@@@@@B@@@BB@@@BA@@@@@@BB@@@B@A@@@@B@@A@@@@A@@A@@@@B@@B@@B@@@@@AA@@B@@@@@@BB@@@@AB@@@@ABAB@@@@@@@B@@@B@@@B@@@B@BB@@@A@@B@@@@@A@@A@@@@@AA@@@@@@@@@@AB@@B@@B@@@B@A@@@A@@@@B@@B@@B@@@@@@@B@@@@@@@AB@@@@B@BB@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@A@@@A@@@@@@B@B@@@@A@@B@@@@@BB@@@BB@@@B@@B@B@@BBB@@@@@@A@@@@A@@AB@@A@@@@@@@@@ABA@B@@@@@@A@@@@B@@@B@@B@@@B@@@@@@BB@@@@@@A@@A@B@@A@@@@@AA@@@B@@@@@@@@@@A@A@@B@@B@@@B@@AB@@@@BB@@@@@@@@A@@@@@B@@@B@@@@B@@@@BAB@@@BA@BBA@@@BB@@@@@B@@@@@B@@@@@@A@A@AB@@@B@BA@@@@@@B@@BBB@@@@@@@A@@@@@@B@@@@@BBAD@@BA@@@@BA@@@@@@@ABA@BB@@@BC@@@A@@@@B@@@B@@@@@AB@@@BB@@A@@B@@@@@@@@AA@@@@A@@AA@@@B@@A@@A@@BA@@BBB@B@@B@A@@B@@@@@@@@@@B@@AB@@B@@@@A@@@@@AB@@@@AB@@@@B@@B@@@@@@@@@A@@A@@@@A@@@BA@@A@@@@@@@@@@AB@B@@@@B@@@@@AB@@@@B@@@BB@@@@@@AA@B@@@@@@@@@@@@A@@@@@AB@@@@@A@@BA@A@@@@A@@B@@@B@@@B@@A@@B@@@@@@@B@@B@@@@@@@@@@BA@@@BB@@@@AD@@@@A@@B@BB@@@A@@@@@@@@@@A@@@B@@@BA@@@A@@@A@@@@B@@BB@@@B@@@B@@@B@@A@@A@@A@@B@@A@AB@@@BB@@@@@A@@@AB@@@@@@@@A@@A@@@B@B@@A@@@@@AB@@AB@@@@@@B@BB@@A@@B@B@@@@@AB@@@@@@B@@@@@B@@BB@@@@@@@@B@AB@@@B@@@B@@@@@B@@A@@@@@A@@A@@A@@@@@@@@@A@@@@@@@A@@@@@A@AB@@BBA@@@@@ABA@@@@BA@@BABA@@AA@@B@@@BA@@B@B@@A@@@A@@B@@@@B@B@B@@@B@@@@B@@@@@@ABAAAB@@@AA@@B@BA@@@@@AB@@@@@BB@@@@@@@A@@@@A@@@@A@@@A@A@@@@@B@@@@@@B@@@@A@@AA@@@@@A@A@A@@@BB@B@@@B@@@@@@AA@@@@@BA@@@AB@@@@@B@D@B@@@BA@C@@BAAA@A@@BA@A@@AA@@A@@AAA@A@A@@@A@@BB@@B@B@@A@@@AB@DAB@B@B@@A@@@AB@@@@@BA@@BA@BB@@B@@BB@@@BBB@@B@DC@@B@BBB@@BB@BC@@AAB@@@B@BA@@B@@AB@B@B@@A@@BA@@@@B@B@B@A@@A@@@A@A@@@AB@@@@AB@@A@@B@@@@@@ADABDB@DBB@@@B@@@@A@@@@A@@A@@@@B@@@B@@@@@D@@@@A@@@@B@@A@@BA@@@@@B@B@@BB@@B@@@@@@A@@@@BB@@B@@BB@@@ABB@AB@@@B@@@B@@@BB@@B@B@@B@@@@@@@@@@A@@@@@A@@@@B@A@@@AA@@B@@@B@DC@@B@@@@@B@@B@@BB@B@B@@@BB@B@@B@B@@@B@@@@@BB@@BB@@@@@@BBB@@@B@@@B@BB@@B@@@@C@@@@@@AA@@@@A@A@@@@CB@@@B@@@B@@@BB@@B@@B@BA@@@B@B@@B@D@@AA@B@@@@@@BB@@@D@@@@@@@@@@A@@@@@@AB@@@A@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@A@@A@@@@BA@@BB@@B@@@@@@AA@@@A@AB@@@A@@@AB@@@B@@BB@B@@@@@@@@@@C@@B@DABA@@@A@@@@A@A@@@AAB@@ABAB@B@BA@@@A@@BB@@@@BA@@B@B@B@@@B@@A@@AAAA@@A@@@A@AB@B@@AB@@@AA@@@ABA@@@@@@ABA@A@A@A@A@@A@AB@@@@A@@@@@AA@AB@@AB@B@@@@A@AB@BABA@@@@@A@@BC@@B@@@@AA@@@AB@@@@@@@A@@@ADA@@@@@A@@@AB@@A@@@AB@@A@AB@@A@@DC@@BB@@BC@@ACA@@@AA@@AA@A@@@ABAB@@@@A@@@ADA@@B@B@D@DC@@B@B@@AB@@@B@B@DABA@@@@@A@@AC@@@A@@@AA@@@@A@@@A@@A@AA@@@A@AAA@@@C@@@A@@@A@A@@@A@@BCBAB@@B@B@BB@@BB@@A@@B@@@AA@A@A@BDB@@B@@A@@@A@@@@AA@@AB@@A@@@@@BA@@B@@@@A@BAA@@@@BAB@B@@@@@B@@@@@B@@@@A@@B@@A@@B@B@@AB@BB@BB@B@@@@BB@B@@@BB@@BB@BBB@@BB@A@@B@B@DB@B@@@@@@B@B@@@@B@@@B@@@@@@BA@@@@@@@@@@@B@@@@@B@@BA@@@@@B@@BAB@@@B@@@B@@@BB@B@@B@ABBB@@@@B@@BB@BB@@B@BA@@BBD@B@@@BB@@@@@BA@@@AB@@B@@@B@@@@@A@@B@@@@D@@@BC@@@ABA@@BBBAB@@A@@DB@@@@@@B@B@B@@@@BB@@BB@@@@B@@@@B@@@@BB@@@B@@@B@@@@@B@@AB@B@@AB@@@D@@@B@BA@@B@B@@@@@B@B@B@@BB@@@@@BA@BBABB@@@@BB@@BA@A@@B@@BB@@@BAD@B@@@@ABA@@@A@@DA@@BA@@@@BAB@@@B@@AB@B@DBB@B@D@FAB@@@@@B@DBB@B@@@@@@ABA@A@@DABA@@@A@@BAB@@A@@@A@@@A@@BA@@BABC@@@A@@BA@A@@@ADA@C@@@@A@AA@@A@AA@@@@A@@@A@@A@A@@AAA@@@AA@C@C@@@AAA@AA@@@@A@@AAC@@@A@A@AA@@A@@AAAAA@AAAA@A@A@AC@@@@AA@@A@@@@@A@A@@A@@@@@AA@@@A@@@A@@@A@AA@@@@@AABAA@@@@A@A@ABA@@@@BC@@@A@@@@@A@@@C@AA@@@@@AA@@AA@@AA@@@C@@@A@@A@@@AA@@AA@@@CA@@@@@AA@@AAA@@B@@@@AB@@A@@@@BA@A@@@A@@AA@@@@AA@A@@@@AA@BAAA@@@A@A@A@C@@A@@@A@A@A@AAA@@A@@AA@A@@@A@@ACA@@@A@AAA@@AA@@@@AA@AA@A@@A@@A@AAA@A@@@@@A@@A@@@@A@E@@@AB@@@@A@@@@@A@A@AAA@ABAB@@@@A@@A@AA@@A@@@@AAA@A@AB@@@@@BBB@B@BA@AB@@@@@@AAC@@A@AA@@A@@@A@@BABAB@AA@C@@E@@AA@@AA@AA@@A@AAA@@@@@A@A@@@A@@@@A@@@@@A@@BA@@@A@@@AB@@@A@@A@C@@@A@@CC@@@AA@@@A@@@A@@A@@@@@@B@@A@@@AB@@@B@@@AA@AA@@@@A@@AA@@@AA@@@AA@@@AA@@C@@@A@C@@A@@@BA@A@@@@AA@@@@@A@@@@BAB@@A@A@@@@@@@@@ABB@@@AA@@@AA@@@ABB@A@A@@BA@@@@A@@@@@@A@@@@B@A@B@@@@@@@@A@AA@AB@A@@@@@A@@BA@@A@@A@@@A@A@@@@B@@@@@B@@@@A@@A@AA@@B@@AAA@@B@@AB@@A@@@@B@@@B@@@@@@@@AB@AA@@A@@AA@@@@B@@@@A@AB@@AB@@ABA@@@A@B@@B@@@@A@@@@@@@AB@@@@A@AAA@@@A@@A@@@@A@@@AAAA@@@@C@@@A@AB@A@@A@@AA@@AA@AA@@@@@AB@AAC@AAA@@AA@@@A@@@A@@@A@@B@@A@C@ABA@@@ABA@@@AA@@AA@@@@@@BA@AB@@A@@A@@A@@@@BA@@BC@@BA@@@C@@AA@A@@@@AA@@A@@@A@@AAA@C@BA@A@A@AAC@@@@@A@A@@A@@@@AB@DA@@@@BA@ABAB@@@AA@A@@A@@@@AA@@@@A@@@A@@@AAA@@@@BA@@A@@A@@AAA@A@A@@@A@@@@AB@@@@A@@A@A@@C@@@A@@@@AA@A@@@AA@@A@@@A@@A@@AAA@@A@@@AA@AAA@@A@A@A@@A@@@@@A@@@@@AA@@A@@A@@@@AAA@@@@AB@@A@@@A@@@A@@@ABA@@AA@@BAB@@@@A@A@A@@A@@@AA@@A@A@AA@@B@@A@@@@BA@@@@@@B@@@B@@@B@@A@@AC@@@C@@A@@A@A@@B@@@@@BB@@BD@@@B@@BB@@@A@AD@@BB@@@BA@AB@@@@@@AB@@A@@AA@@@@BA@AB@@@AE@@@A@A@A@A@C@@@@@A@A@@A@@B@@A@A@@@@@A@@@@@AAAA@@AA@@@AAA@AAAA@@A@@AAA@@@AA@@AAA@@@A@@@@@@B@@A@@@@@AB@@@A@@@A@@@@C@@@@A@@@A@@@A@@@AA@@@A@@@A@@AA@@@AAA@@A@@@@@@@@@AAA@AAA@@@AC@@@@A@@AAA@@A@AAA@@@AA@A@@@@A@@BAA@@AAB@BA@@AAB@@@@@@A@@@A@@BA@@@A@@@A@@@AA@@B@@@AA@@@@@AA@AAA@@@@@A@@AA@@A@A@@@@@AB@B@@@@@@@@A@@B@B@@@@AB@@A@@B@@@@@B@AABA@@@AB@@A@@@@@A@A@@AA@@A@ABA@@@A@@BA@@A@@@@@@@@@@A@@@@@@BA@A@@@@@@A@@@@@@A@@C@@@@EBBB@@@BAB@@A@@AA@A@A@@@A@@@@B@@A@@@AA@@@A@@@@AA@@AB@@CAA@@@@@@B@@@B@@@BA@@@A@A@@BA@@@@@@@A@@@ABA@@@AB@@@@@@A@@A@@@@@@@@@AA@@A@@@@AA@@@@A@@A@@@A@@@@@@@A@@A@AB@@@@AA@@@@B@@AA@@@A@A@@@@@@@@AB@@@BA@A@@@A@@@AAA@@@@@AA@@@@@@@@@A@@@@AA@@A@@@@@@@B@@A@@@B@@BB@@@@B@@A@@@A@@AA@A@@A@@@AAB@@@@AB@@AA@@A@AA@@@A@@@@A@@@A@@@BA@@A@@@AA@@@@AB@@@A@@@@@AA@A@@BA@@A@@@@@@@@@AA@@BB@@B@@A@@BA@@@@@A@@@@@A@@A@@AA@@A@@@BB@@@@@BA@@@CBA@@@@@@A@@@A@@@@AA@B@@@@@@ABA@A@@@@@AB@@@@AB@@@@@@@@A@@@@@@@@AA@@@A@A@@@@BA@@AA@@@BB@@A@@@@@A@@BA@@@AABAA@@B@B@@AA@AAA@@@@@@@BA@@@BBB@@@BB@@ABA@@@A@@@@A@@BA@@A@AAA@@B@@B@@B@@@B@@@@@@B@@@@B@@A@@@@@A@@@@@BB@B@@@@A@@@@@@A@AA@@AA@@@@@BB@B@@@@AA@@A@@@@@A@@ABA@A@@A@@@@@AAA@A@A@@A@@@BA@B@@BA@A@@BA@A@@@@@A@@AA@@@A@@AA@A@@AA@A@A@@B@@CB@B@@BB@@@BA@AB@@A@@@@@A@A@@@@@@BAAAAAB@A@@AA@@AA@@@AA@@@@AA@@A@@A@@@A@@@@BBB@@AB@@A@@@@@BB@B@@@@A@A@@@A@@@A@@@@A@@AA@A@AA@A@@AA@A@@@AAA@@@@@@@A@@@@@AD@@@@@@@@@B@BA@@B@@A@@@@B@BB@@BA@@@@BB@BB@@@@A@@@@A@@CAA@A@@BAB@@@@A@AAA@@@@AA@AA@@CA@@@@@B@@@@A@@@BB@@@@B@@@BB@@B@ABB@@@@@@B@@@@@AA@A@@@AA@@A@@@A@A@A@@@@B@@A@@@AA@@A@A@A@@BAA@@A@@@@@@B@@@@A@@@@A@@@@A@@@@@@AA@@@AA@@B@@@@A@A@@AA@@@@AA@@@A@@@A@@A@@AA@@B@@A@@AAA@A@A@@AB@@@A@@@@@@@@A@@@@@@BA@AB@BA@A@@@AB@@A@C@@@@@@@@AA@@A@@A@A@@@@@AAA@@@A@@@@C@@@@A@@@@A@@AA@A@@A@A@@AAA@@@@@A@A@@@CBA@AAA@AA@A@A@@@@AB@BA@@@@@@@AA@B@AA@@BA@@@A@AB@@@B@B@B@BA@@@@B@@@@@B@@A@@B@BA@@BAD@@@BA@@BA@@BA@@BA@@@@B@BA@@DAB@BBBA@@BAB@B@@@B@@A@ADA@@DA@@@@@A@A@AAA@@@@B@B@AC@@@A@AAA@@CC@B@@A@A@@@A@@@@@A@@@C@ABA@A@C@@@A@@@@@A@AB@@A@@@A@@@AB@@A@A@@@ABC@@@@@@BAD@BA@@@AB@@A@@@A@AAAB@AA@A@A@@@CB@@@BA@@@A@@@A@@@AB@@AA@BA@@@CA@@@BA@A@A@A@@@@BA@@B@@A@@@A@@@A@@@@@AAA@@@AA@@AA@@@@AB@@B@AB@@@@@@@B@@@@@B@@A@@@A@A@A@@@@@A@@@A@@@A@@@A@ABA@@@A@AB@@@@@@@BAAA@@A@@@@AA@A@@@AA@A@@A@@@@A@@@A@ABA@@@A@@BA@@@@@A@A@@@A@@@@@@C@@@A@@B@@@"],encodeOffsets:[[112399,23124]]}},{type:"Feature",id:"450981",properties:{name:"北流市",cp:[110.354214,22.70831],childNum:1},geometry:{type:"Polygon",coordinates:["@@FEBAL@D@DC@AB@DGFE@G@AB@DA@A@ABGFSA@@@A@@@@@@@A@@@@@@BA@@@@@A@@@@@GAAA@@@@@CACCAAA@@@@@@A@@@@@A@@@@@@@@@A@ABC@ABA@@@@@A@CA@@A@@A@@@@AA@@@@@@@@A@@@@A@@@@@@@@@@@ABA@C@@@@CA@@GA@@A@@@@@@@@AA@CEAACAGAA@@@A@@@@@@@A@@@@@GCADABAB@BEDABCBG@@@A@@@@@@@A@@@AAA@A@@AK@ABGDCF@@@BAB@@@@@@@@B@@@@B@@@@@B@@@@@B@B@@@L@BB@@@@@@B@@@@B@@B@@@B@@B@@@@@@BB@@@@BB@@@B@@B@@@@@@@B@@@@AB@@@@@B@@A@@@@@A@@@@@@@A@@@@@AB@@@@C@MB@@A@A@G@@@@@A@A@@BA@A@@BA@@@IFQF@@@@AB@@A@@@AB@@@@@@ABA@@@A@@@AB@@@@AAA@@@@@@@A@@@@AA@@@@@@@@A@@@@A@@@@A@@@@@@GCAACC@C@A@@@@@@@@@@A@@A@@@@@@A@@@CCCA@@@AA@@@@@AA@@@@@AA@@@@@AA@@CC@G@@@@AA@@A@@@@A@@@@@@@@A@@A@@@@A@@@@@AACE@@@A@@@@@@@@@IBCACA@ACIC@@@@AA@@@@A@@@A@@@@@A@A@@@@@A@@AA@@@@@@@AA@@@@AA@@@@A@@@@@AA@@A@@@@AA@ICC@CD@@@@@@A@@@@@@@C@@@C@A@@@@@A@@@@@E@C@A@CBAB@@@@@@@@AB@@@@@BA@@B@@@@@B@@@@@@@@B@@BB@@A@@B@@@@@DBBF@B@@IFGHCB@@@@@@@BA@ABAB@@AB@@@@IH@BABA@@@A@@BA@A@@BA@AB@@A@AB@B@@@@A@CF@DAB@B@@A@AB@@@B@@@@BB@@@B@@@B@@ABABA@A@@BA@@A@@A@AA@@@@A@@@@@@@AB@@@@A@@@@@@@AB@@@F@B@B@@A@@B@@EDC@AD@@A@@BA@EB@@EDE@A@GBA@@@A@@@@@A@CB@B@@ABA@CD@@A@@@@@@@@B@B@@@B@B@@@B@BABA@AB@@AA@@A@@@@AA@@@A@AD@@@@C@@@A@AB@@A@A@@@@@A@@BA@ADAA@@@AA@@A@@@@C@ABA@A@A@A@AA@@@AA@@@AA@@A@A@@@ADA@@@AB@@BBA@@@@B@B@BA@@B@@@B@@@BB@@B@BB@@B@@@@@@B@BB@@B@BADB@BBAB@@@B@BBB@@@@@@BD@B@@@B@@@BB@@BB@@B@B@@BB@@@BBAB@B@BBB@@@B@B@B@B@@AB@@AB@B@@@B@@@@@B@BB@@@@B@B@D@@@@BB@B@@DBBB@BB@@@@B@@@@@BA@@@@B@BB@@B@@@B@BB@@@BB@@@BB@@B@@@BB@@@@@@@@B@@@BAB@BBBB@@BBBBB@@ABCF@B@@@B@@ABA@@@@@@B@@AB@@B@@B@@@@AB@@@B@@@@A@@@@B@@@@@@B@AB@@@BA@B@@@@B@B@@BB@@@BBB@@B@@@@@B@@@@@@@B@B@@@@@B@@AB@@@@@B@@ADB@@B@@BB@@@B@@AB@@BB@@B@@@B@@@BB@@@@@@BB@@@@B@@B@@@B@@B@@@B@@B@@@@BB@@@BB@@A@A@A@@@A@@BA@@@A@@B@@@B@@@@@B@B@@@@@B@@@@@@@B@@@@BB@@@@@B@BB@@@@@@B@@@@BB@@BB@@B@@@@BB@A@@B@@B@@B@B@@@@BB@B@@@B@@@@@DB@@BAB@@@@BB@@@@@@AD@@@@@B@@A@A@@B@@@@@@A@BB@@AD@B@B@@@@A@A@@@@B@@@@@D@@AAA@@@AB@@@BA@A@@@A@A@AAA@@BCAA@A@AAA@A@A@A@@@@@@@CAAAA@@AA@@B@@AAAA@@ABAAA@@@A@@@A@AA@BA@@@@BAB@@@@@B@@@B@@@BAB@BA@@@A@@BB@@@@BA@@B@BAB@@A@@@ABA@ADC@@@A@@@A@@BA@@@AB@@A@A@@BA@@@A@@@@@A@B@@BA@@@A@@@@@@@@@A@@@@@@@@@A@@@A@ABCBAB@@ABA@AACC@@A@AB@@A@@@@@A@@@A@@@@A@@@A@@AA@@AA@A@@@BA@AA@@BAAA@@@@AA@@@@A@@@A@@B@B@@A@ADAB@@@BA@B@@@@@@B@@A@@B@@@@@@B@@@@@BB@@BB@@BBB@@B@@@@B@B@@B@@@B@@@B@@@@A@@BA@AB@@@@A@@B@@@@@@B@@@B@@@@B@B@@B@@B@@@@@D@@BB@@@D@BA@@B@B@@@@A@@@B@@B@B@@@@BBB@@@@@@@BB@@DB@A@@B@@@B@@@B@BAB@@@@@B@@@@@AB@@B@@B@@@@AB@@@B@B@BB@@@B@@@D@@B@@B@A@@B@@@@@BA@@@@@@B@@C@AB@B@@AB@@A@@@@BB@B@@B@@B@@@BBB@@B@@@@@B@@B@BB@@CB@@A@@@A@@@@@@B@B@@@@@@@BB@@@B@@DB@@@@@@B@B@@BB@@@@@@@@@@B@A@BA@@@A@@@@@@@@B@@@@B@@A@@@BBA@@@@@B@@A@@@@@@B@AAB@AA@@B@@@@@@@B@@@@@BB@@@@@B@@@B@@B@B@@@@BB@@BA@@BA@@B@@@BA@@@@@AB@BB@@@@B@@A@@B@@@BA@@B@@@B@@@@@B@@AB@@@@@@AB@@AB@@AB@@@@@@@B@@@@@B@@@BB@@@AB@@@B@@@B@B@@@@@@@@C@AB@@A@@@A@@@A@@AA@@@@@@A@@A@@@@@AA@@@@@@A@@@@@A@@@@@A@@@AB@@AAA@A@A@AAA@A@@@AA@AA@@@@@@@AA@@A@@@@AA@@@@BA@@@A@@@@B@BA@@@A@AB@@@B@@@@A@@B@B@@A@A@@@@BA@@AABA@@@A@@BA@DB@@B@@@@@BBA@B@BB@@A@@B@@@B@@ABB@@@BB@@@@@@@BA@@@@@BD@@@B@@@@@BB@@@@BB@@@B@@@B@@BB@@@B@@@@B@B@B@@@@B@A@@B@@@@@@AB@@@@AB@@@D@@@@@@@B@@A@@@@B@@A@@BA@@@@@AAABA@A@@A@@@@AB@@AAABA@A@@@@@AB@@@@@B@B@@@B@BB@@@@BB@@@@BB@@B@@@BB@@DD@@B@@@@@B@@AB@@B@BB@B@@@@B@@@@@B@@BD@@@@B@@B@@@BBB@@@@@@BB@BB@@BBB@@@BDA@@@@@@B@BB@AB@@@B@@@@B@@BB@@@B@@@D@@@@@@@D@@@B@@B@@@BB@@@@@@B@BAB@@@@A@@@@B@@A@@BB@@@B@@@B@@B@@@@@B@@@@A@A@A@A@@@@B@@B@@B@@@@@B@@@@@BB@BB@@@B@@@BBB@B@@@@AB@@AB@BA@@BA@@@A@@@A@A@@@A@@B@BAB@@A@@@@B@@@@AB@@@BAB@@@B@B@@@@A@ABAB@@BB@DB@@BB@@@AB@@C@AB@@A@@B@B@@@BBBAB@@@B@@@B@@A@@BA@A@@@@@@@A@AA@@ABA@@@A@@@A@A@@@A@@@AB@@A@A@@BA@ABAB@@@@AB@BA@@@A@@@@@@DBB@@AB@@B@@BBB@@@@B@@@@BBBB@BB@@@@AB@@@BB@@@ABBBAB@@@@BBBBB@@@BAB@@@@DA@@@A@@@@BA@@BA@@BA@@@@B@@C@A@@@A@A@A@@@@@@B@@@B@@@B@@@@@@AB@@ABA@A@@@@@A@@@AB@BB@@@@@AB@@CBABA@@BCBABA@A@@B@@A@@B@BAB@B@@A@@@@A@BA@A@@@@AAA@@@@@@@@@@A@@@@@@@AA@@@A@AB@@AB@@A@@@AB@A@AA@@@@@@@A@@@@@@@@AB@@@B@@@@ABAB@A@B@@AB@@A@@@A@@@@@B@@A@@@AA@@@@@A@@@A@@BA@@@AAA@@@AA@@C@@B@F@@@@C@CBA@@BAB@@@@F@@@B@@B@@@@AB@@AB@@@@@B@@A@@AA@@@@@@@ABB@A@@@@@C@@@@@A@@@@@AB@@@A@@@@A@@@@@@@@@@B@@@@A@@AA@@@@@A@AB@A@@A@@AA@@@@@@B@@B@A@@@A@@@A@@@@@@@@A@@A@@B@@@@@@@B@@@@@@@@A@@B@@@B@@@@@@@B@B@@@@@@@B@@@BAB@@AB@@@@@@@AA@@@@BA@@@@@@B@@BB@@@@@@@D@@@@@@A@AB@@@A@@@A@@A@@@A@@@A@@@AB@@@@B@@@@B@@A@@B@@A@B@@B@@@@@@@B@@@@@@@@@@@BB@@@BABB@@BA@@@@B@@@BB@@@@BB@@B@B@A@@B@DCB@B@@@@@@B@@@@BB@BB@@@BD@@@@@AB@@@@@B@@@BCF@@@BBB@@@B@B@@@B@@D@B@@@@@B@@@@BBBB@@BB@@@AB@@A@@@@B@BA@CB@@ABA@@@@B@@@B@@B@DB@@@@@BB@@@B@@@@@@A@@BABB@A@A@@B@@@@@B@@@B@B@@@B@@@@@@@B@@@@@@AB@@@@@B@@@@A@@B@@@@@@AB@@@@@B@BABAB@@@B@@A@A@@@A@@BA@A@@@@@@BABAB@@@BAB@@ABBA@@B@BB@@@@@BA@@B@@@B@@@B@@@@@@B@@AB@BB@@@B@@BB@B@@@@@DDDBBBDB@@B@@BB@AB@@@BAB@@@B@@@B@@@@B@@@@@@@BB@@B@@D@@B@@@B@@@B@BA@@B@@@@@BA@@@BB@@B@@@B@@@@@B@@B@@B@B@@ABAB@@@@@@@B@@B@@B@@@BBB@BA@@BBBBB@@@@@BB@@@@B@@@B@@@@@@A@@B@@@@@BABCB@@A@@@A@@A@@A@@@@@@@A@@@@@@B@@@BDA@B@@AB@@@@@B@@@@@BA@@@BB@@@BBB@@@@@BA@@@@@@@B@@@@@B@@B@B@@B@@@@@@@B@B@@B@DBB@@B@@@BBB@@@@AB@@BB@@@@BBB@BB@@B@@B@@B@@B@@@B@AB@@@@BB@@@B@B@@A@@@@@@@@B@@@@@@@BB@@B@@@@AAA@@@A@@@AC@@BA@@A@A@@@@B@BAB@@A@@BA@A@A@@@@@A@A@@A@@A@@@A@A@AB@@AB@AAB@@@D@D@@@BA@@@A@AA@@@@@BA@@@@B@B@BB@@B@BBD@B@@@@BD@@A@A@@@@@@@@AA@@@@@AB@@@@@B@@AB@@@@B@@B@@@@@@A@@@A@@@@B@B@@A@@@AB@@@AA@@@A@@@A@A@@AA@@A@@A@@AA@ABA@@@@@AD@@@B@@ABA@@BA@@@AA@@@A@@AA@@AAA@AA@@@A@@A@@@@@@A@A@@B@@@BAAAA@A@@@@A@C@@@A@AA@@A@@@@A@A@@@A@@@@@AB@@@@AB@@AB@AA@AA@A@AB@@A@A@@A@@@A@A@@@A@A@@@A@@B@B@@@@@@@@@@@@A@@@@@@@@@@C@AA@@@A@@AA@@@AB@@A@@BCB@@BB@BBB@BB@@@B@@B@@@BB@@B@BBB@B@@@B@@@@A@@AA@@@A@A@@@AA@@@BC@@@A@@@A@@@@@A@@@@@A@@@AA@@AB@@@@@@@@B@@B@@AB@@@@@@@B@@@B@@@BC@@@@B@@@@BD@@A@@@ABA@A@@@@A@@A@A@AB@BA@@@AB@BAB@@A@@B@@@@A@AA@@ABC@@@A@@BAA@@AB@@@BA@BB@F@@@@@@@BA@@@A@@@AB@@@@@@A@@B@@A@@AA@A@@@AB@AA@@@@@A@@@A@@@@A@@A@@@@@@@EBA@@@C@A@BB@@A@@@A@@@A@@B@@@AA@AA@@A@A@@@AA@@A@@@@@@A@A@@@A@@@@AAAA@@@A@@@@@AA@@@@@@@@A@A@@@@A@@@@@A@BBA@BBAB@BA@@@@B@@@B@@@@A@@BA@@@A@@@@@@BA@ABAB@@A@A@@@A@@@AA@@@A@@@A@@AB@BA@A@@A@@@@@AAA@@A@@@@@A@@@AAA@@@A@@@A@@AA@AC@AA@@@@AA@@AA@@A@@A@AA@@BA@@B@@@@A@@@@BAB@BA@A@@@A@@A@@@AC@@@@@@A@A@AB@@A@A@A@@B@BA@@@@@@@AB@AA@@@@@@@@B@B@B@@@B@@AB@@@@AB@@@@@@@@@@A@@AA@@@@@@@AB@@@@@@@BA@@BA@@@@@A@@@@B@BA@@@BB@@@B@@AB@@B@@@AB@BB@BBBB@@@@@@@@@@@@@BB@@BAD@@A@A@@@@@A@@@@B@B@B@BA@@@@@@@@BA@@B@@@@@@@@@@BBB@@@@@@@@@A@@@@@@B@@AAA@@B@@@@@@@@@BA@@@@DA@@@@BCB@@A@@@@@A@@@@BAB@B@@@B@@@B@@@B@@B@@@@@A@@@@@A@AB@@@@B@@BA@@@@AA@@@A@@BABA@A@@@@B@@@@@B@@@@A@AB@@@@@@@@B@B@@@B@BB@@@BB@@@@BABAB@@@B@BA@@B@@AAA@@A@A@@@BA@@B@B@@@@DB@BB@@@@BB@@BD@@BB@@@B@@BB@@@@B@@@@@@@BB@@@AB@@@B@@BB@@@@BB@@@BA@BB@@@B@BB@B@@@@@DD@@@@@@@B@@B@@@BB@@B@@B@@@BBB@@@B@@@BBB@B@@@@@B@@@B@B@@@BB@@B@@@B@@AB@@AB@@@B@@@B@B@@A@AB@@A@A@A@@B@@@@ABAB@@@B@@BB@B@B@@BB@@@@BBABB@@B@@@@@B@@AA@AA@@@@BAB@@A@@B@B@BA@@BABAB@@@DA@@B@@@B@@B@BAB@@AB@@@@@@A@@@@B@@@@A@@@A@AB@@A@ABA@@@@BB@@BBBB@@@@@B@B@@@B@@ABBBAB@@B@@BB@@@ABA@@@BB@@@@@B@@@@@@@@@@@BB@@@@@B@B@BBB@@@@BB@B@@BBB@@@@BB@@BB@B@B@@DB@BB@@@B@B@@BB@@@BB@B@B@B@B@@@BD@B@B@B@@@B@BB@ABB@@@@@BBB@@@@BB@@@B@@@BAB@@@@@BA@@B@@A@@@BB@BB@@B@@@@DB@@B@@BB@@B@@@BB@@@D@@@B@@BB@@BB@@B@@@@BBD@@@B@@@@@B@@@D@@A@@B@BAB@B@@@@@BBBA@B@@@@BBB@@@B@@@B@@@B@@B@@@@@BB@B@@@@@B@@@BB@@@@BDB@B@B@BB@BBBBB@BB@@@BBB@B@@@D@BB@@@B@@B@@@@@DA@@B@@@@@@@B@BBB@B@BB@@BBB@BB@@@@B@@@B@DD@@@@@@BBB@@@@@B@B@@@B@B@@@BB@@@@BBB@DAB@@@@AB@@@BA@@@BB@@@BA@@B@@@BAB@@@DA@BB@B@BABA@@@@@@B@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@B@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@BB@@BB@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@BA@@@@@@@@@@@BB@@@@@@@B@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@B@@@A@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@BA@@@@@@B@@@@@@@@@BA@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@BA@@@@@@@@@@@@@@@BB@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@BA@@@@B@@A@@@@@@B@@@@@@@@AB@@@@@@@B@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@B@@@@@@@@@@@@@B@@@@@@A@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@B@@B@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@BA@@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@B@@B@@@@BB@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@B@@@@A@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@B@@@@@@B@@@@@@@@B@@B@@@@@@@@@@@@@BB@@@@@@B@@B@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@B@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@B@@@@@@@BA@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@B@@@@@@@@A@@@@B@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@B@@@@@@B@@@@@@@@@@@B@@@B@@@@@@@@@@@@@B@B@@@@@@@@@@@@@@@@@@@BB@@@@@@B@@@@@@@@@@@BB@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@@@@@B@@@B@@@@AB@@@@@@@BBBB@@@@BB@@@@B@B@@B@B@@@@B@@BB@@@B@BB@@BBBA@@B@@@@B@@BB@@BB@@@B@@@B@D@@@B@@@@B@@B@@BA@@B@@@B@B@@@B@@BBBB@ABB@@B@B@@@B@@B@@@@B@@@B@B@@@B@@@B@@@@BB@@@@@BB@@B@@BAB@B@@B@@FAB@B@@@B@@AB@B@B@@A@@AAB@B@B@B@B@@A@@@A@@@@@A@@@AB@@@B@B@@@BA@AD@B@@@B@@@B@@@@@BA@AB@@A@@@A@A@@@ABAB@@@@B@@B@@@B@B@@@@A@ABA@@@A@@BAB@@ABA@A@A@ABA@ABA@@D@B@B@B@@@BB@@@A@A@A@A@ABA@ADAB@@@B@B@B@B@F@@@B@@@FABA@@@@@C@C@ED@@A@@@A@@B@@@BAA@BA@A@AB@@@@ABA@A@@@A@@@A@@DA@@BA@A@ADA@A@A@CBABA@@@A@@@A@@AA@@@CB@@AA@B@AA@@AA@@@AA@AAAB@@AAAA@@A@A@@AAAAAA@A@AA@@A@AA@@BA@@@@@A@@@A@@@@@AA@AA@A@@@AA@@AAAAA@A@@A@@AAAA@@@@@A@@A@AA@@@@AA@@@@AA@@@BC@@AAAAAA@@@@AA@@AA@@@@@CA@@A@@@A@@@A@@@@@@BA@@@@B@BAB@@AAA@A@A@@BA@AA@@AA@@@@@B@B@@@@@CC@A@@@AAA@@@@@AAA@@@@D@B@BA@@B@@AFA@@@@BAAAABAA@@A@@AB@@A@@BA@@@@@@AA@@@A@@@@@A@@B@@@@@BA@@@@@@@ABA@@@@@A@@@@@AB@@@@A@@@@@A@@@@@@@@BB@@B@@A@A@@@AB@DA@ABA@AB@AAB@BA@A@@@@BA@@B@@AB@@A@@B@@@@AA@@A@@B@@AB@B@@@B@BAAA@@@AA@A@B@@AA@@@B@@@B@@@@A@@@BB@A@@B@@B@BA@BB@B@@BB@B@BB@@@@BB@@B@@AB@@@@@B@@BB@@AB@@@BA@@D@@@B@@@B@@A@@B@@@@A@A@A@@@@@AAA@A@A@A@C@@@A@A@AAA@@@A@AAAAA@@@@@AB@BA@@@@BB@B@@B@@@B@BA@@B@@@BB@@B@D@@@B@@@CC@@@A@AAAAC@AAA@@@@AA@@@A@@AAAAA@@@B@AAD@BAB@B@@ABB@@@A@A@@B@@B@@@@@B@BA@A@@BAB@@@@@B@@B@B@BB@AB@B@B@B@BA@@BA@@@@@A@ACA@ABA@@@@BB@B@@D@@@A@@A@@B@@@@A@@BB@DAB@B@@@BBB@@BBB@@B@@B@@AB@@A@@A@@A@@B@@@BABB@A@@AAB@@@BA@AA@@@BA@BB@@AA@@@@@@@@@@ABA@@@BA@@BA@AAA@@A@@@@B@@AB@BBBAB@@BB@BA@B@@BC@A@A@B@@@@@A@@B@BDBC@@B@@A@@BB@@B@DADAB@B@@@BABACA@A@@@@AA@@C@A@@@@A@@A@@@@A@A@AA@@A@@@@@@@A@@D@@@@ABA@@@A@@@AB@@AB@D@@@B@BA@@@@@AA@BA@@@A@@@A@@@A@AB@BAAA@A@ABABC@@AA@A@@A@@@A@@@@@@AA@A@@AC@@AA@A@@@@AA@@A@A@@@@A@AA@@@@A@BA@A@A@@@A@@@@BA@A@@A@A@@@ABA@@@A@@@@ABA@A@ABA@A@@@AA@AA@@A@@A@A@@A@@@@@@@@ABA@@BA@@@@A@BCA@@AA@@@DE@ABADA@@BA@@@AA@@CA@@@BA@@BAB@BB@@BA@@BBBA@@@@@@@B@@@@B@@@B@@@B@B@@@BA@A@@@@B@BB@@@AB@@AAA@AB@@@AC@@@ABA@@@@@ABA@@B@@A@C@A@@@@AA@@@@@A@@@A@A@@@@BAB@@ABA@@@@@ABA@@@A@@BA@AB@AA@A@@@@@A@@@@@AAAB@A@B@@A@@B@BABAB@@@BBD@BBD@@A@A@@@AB@@@B@@@@@@@B@@@B@@@@@B@@@F@@@@A@@B@B@DAB@B@B@@@B@@@BAB@@BB@@A@@@@@A@@A@@@@C@@@AB@@@AA@ABA@@@AB@B@BA@@@@@A@@BC@@@ABA@@B@@AB@B@@@@@B@BAB@@A@@@C@@@@B@BAB@@@B@B@B@DA@@B@@@D@@B@@@@@BB@BBB@@@@@@A@@B@@A@A@AB@@@@A@AB@B@@AB@@AB@@@BA@@BAB@@A@@B@@AA@@ABA@@BA@@@@@A@@@A@@@@@A@@@A@A@A@@@A@@AA@@@AB@@@@@BAB@@@BA@@B@@ABB@@B@BABAB@@A@@B@B@@@B@BBBAB@B@@@BB@@B@B@@@B@@@B@B@@@BABABA@A@@@A@@@A@@A@A@A@A@@@@@@AA@@A@@@A@@@@@A@@@A@A@@@@@@@@@A@@@BB@BA@@B@D@@ADB@@B@@@@BB@@@BB@AB@@@B@B@@@BA@A@ABA@@AA@@@AB@@A@@@@BA@ABA@@@@@AA@@@@A@@@A@AA@@A@@@A@@@@B@@ABC@@@AAA@@@A@A@A@@@@@@BA@@@AA@@AB@@AAA@@@@@@@A@@@AAA@A@@@ABA@@@@B@@A@@@A@AA@A@@A@AAA@A@A@@AA@@BAA@@@A@@AA@A@AA@BAA@A@@A@@@@A@A@A@@AABA@A@@B@@@@A@A@@@A@@B@@A@@@A@@@A@@@@@A@A@@AA@@BA@@@AB@@AB@DAB@B@BA@@DA@@@@@@@@@AAA@@@AAA@@@ACA@@@A@AAA@@@A@@@AAA@ABA@@BCB@BA@@B@BABB@@BBB@BA@@B@@AF@@A@A@A@A@AA@A@AA@@A@@AB@BAB@B@@@B@B@@@B@@@D@@BFBB@BB@@@B@BBB@BB@@@D@B@@@BB@@@BB@@@@B@@@@@BB@@BB@B@@@BB@@@BB@BBD@D@B@B@B@@@DB@@@BB@@@B@@@BA@@BAD@@@@ADKBE@AB@BCDCDABA@MBCBAB@HAD@J@BABADCDCBC@C@M@ABU@AAEACCCAECA@AA@@EBADG@@FE@E@EBICAAAAG@GBCBC@AFCDCDA@ABCBABAB@D@BFBDBBDBD@DCHA@@JCBABEBEDEHM@C@AACAC@CBA@A@C@A@CB@BADABADAD@B@BADCBCDAJMBADI@WZAFEBA@C@CCCACCCC@AAC@ICE@AAA@AAE@CBEACAA@@@C@CBAD@@GDEB@BI@E@@BCDCDCFA@CBABC@@B@BABABC@CACC@@AC@A@AAA@AAA@ACAAAAC@A@A@AAA@ACAEAC@CBMEGEAI@@DADE@CEEGKCAEAC@A@KCGAE@EBIECAEC@@@@ACAA@A@ACCCCAE@EAC@A@ABEDE@ABA@CBABC@A@@"],encodeOffsets:[[113309,22741]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("安顺",{type:"FeatureCollection",features:[{type:"Feature",id:"520402",properties:{name:"西秀区",cp:[105.965116,26.245315],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@CCMAED@@A@CBC@A@@A@A@A@@AC@@@@CAC@A@ABBB@B@B@BABB@@@BBDBDB@@@@BB@D@BAB@@@B@B@AC@AAEAC@C@ABABC@ABACAB@@BD@B@B@BD@DBFDEDEFCNIHE@GACAQEEF@P@FAD@B@L@BADEBGBGDCBABGDIDECMCK@CDAHBHHHHBHDNDPHBBBH@FBJLNNLFLBDBHBFBNDFBFFHEDQBA@GDILCBEBE@C@K@I@@@E@AAEACC@@GEKEOGMEEAEBED@B@@DBB@B@@BA@@BABB@B@FAB@BB@BADBB@B@BCB@@@@EB@@A@A@@AAA@AA@AA@@@@AAA@AAAAACA@@@A@A@@@@@@A@@A@@@@A@A@@AA@@CA@@A@C@C@AAA@E@EBA@C@@@@B@@BB@B@@@@@@AB@@@BC@CACCA@ABAB@BA@@@@@CBAB@@BBBBD@DBBB@@@@@@A@@@C@CAA@@ACAABA@@@AB@BABA@@D@@CFAAA@@B@B@D@B@BB@@BLR@BB@BBB@BBBBDFBBD@BBF@RAF@B@B@BFBDBFBFDD@BHLFH@@@@@@B@@@@B@@DAF@FBBDBFBDHBBBB@@B@@BB@@@@B@@BB@@@BBB@@BB@@@@@B@@BD@@@CBAB@B@BABAD@DABAB@BEFC@ADHHFDL@VEF@BDBR@DABDHHH@FABAB@FDJBF@FDFDBL@FBB@@@FAFDDF@D@BAFABCB@@ED@B@HBFBBLBNGBB@@DBDH@@BBFP@PCDCBEBKBICCF@@BJJNNHPBBBB@HFD@PFLHFDFFDDRNXPHFNJHFDDRNFFLNABABABBBBBBBBBBBB@D@B@@@B@@B@@@BB@@BB@@BDD@B@B@BBB@B@BDD@@@@BB@@BB@@@B@@@B@@@@D@D@@B@B@BC@@@A@BD@BAD@BDDBBH@D@D@@B@@@C@A@A@@@A@@@A@@@AA@@@@@DE@CAC@CFIFABA@AJED@F@NHFDRHLDHBDBJBLBBBFAD@D@DG@ABCBKDMFIB@@B@DBBFDDDBB@@B@D@BABCBEBKBIHAJG@GGKACGKIMAEAGAODGDCBA@ALBNHHDRNDBHLDFBDDHAL@B@B@BJHR@RG@B@@R@NEHC@@@@@@BEAA@GDIBEACCAE@IBCBCBCA@@AA@A@A@A@CCAAACA@C@CBAB@@@HAB@F@F@D@D@DA@ABG@@DC@ADAFAH@FBD@FBDA@C@CFAD@BA@A@@AAAACBC@@ABCDABC@ACAEC@@CCACC@C@A@A@IFIFC@C@C@AAAAAA@@@EAEAA@@AA@@@@@A@@B@@@@AB@@@@@BB@@FBD@BADCBA@A@@@A@A@ABAB@@ABA@@BA@@@A@AB@@@AA@C@@BA@@@@DA@@BA@E@CFAF@FDFFDFLBNLBBBBJFHDLFJAJCFCJIA@@A@@@@@A@@@@BEBE@A@A@@@ABABABA@@BABADCB@@AB@BABAB@B@@@B@B@DAB@@DB@@@@BABA@AB@B@@AB@B@BAB@BABABA@CBABA@CBBB@@@B@@BB@BBBB@BAFA@ADABA@ADABABCBAB@@B@B@D@BDBD@@@BAHC@AD@L@B@JDFFHDJBHADABCBE@@BO@AAAI@EAAAAA@GD@J@F@@@DAB@ACCOECC@@A@@@@IIAE@K@EAGECQBUBGA@OOGCKD@LC@@VDD@TAHE@@B@FCDI@@BABABA@@FCLEBADADEAAEAE@C@CAAACACG@AAEBEA@@@@C@@@A@A@C@AAA@A@A@ABA@A@@@A@@@CA@@A@AA@@A@A@@@@@AAA@A@@AA@C@A@C@AA@@A@A@CA@AA@A@AAA@C@@@A@AAA@@@AA@AA@@A@@BA@@B@@A@AAAAACEAGACACAAC@CDCDAHAPBH@@@B@@@@@@@FABABC@A@@@AB@@A@@@@LMDO@@EIKGKIIGOF@@@BELEFGAE@GOCYCS@E@AAKCSAC@AA@@@A@CI@@@AACAAA@C@C@@A@@ACAA@CBA@@B@@AD@B@@@@A@@D@DAB@@E@BACCAA@@@C@C@CAA@A@CAEA@AAA@@AE@A@CBABABA@C@CBADGAAACC@C@A@@@BA@C@AAA@E@@D@D@@@BC@C@EACAAA@AB@BAB@D@@ABA@@@@BA@ACC@A@CACAA@C@A@C@@A@@BA@@AAA@A@A@@@@A@A@AAC@C@C@G@A@CAAAAAAC@CBAB@@A@@@@B@BABA@AACBA@@E@IBEAAAA@@AA@ADCB@D@@A@C@ACAAAADAB@B@BC@@AA@A@@ABA@A@A@@C@CCAIECIBABAF@B@J@D@JANATAP@@@DCLIPABIJOHQFKDG@IBOCIAA@ABCBAFEJCHEHCLGH@BGHE@K@C@IAIEQGIIGGCCGKECAACCECAAA@@A@CAAAACIE@A@AB@BA@ABA@C@CBAB@@AB@DBDBDA@C@A@CAA@CBAB@@@@@B@BADCAE@E@@@KAC@A@CACACAA@GAA@CAE@UFIAC@MCIGCCCC"],["@@AG@@AIGM@@@@AAIMEECEMEOAQBC@IHGNGH@@ALIP@B@@ABFPDNBV@FFNAJAHFLF@@@FAD@HEFCDCDCDAJEVIRGHAD@B[@U"]],encodeOffsets:[[[108474,26624]],[[108914,26885]]]}},{type:"Feature",id:"520403",properties:{name:"平坝区",cp:[106.256412,26.405715],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@AADAB@BADAB@BABA@ABA@A@ABA@@@ABAB@BA@A@@A@@CA@CBA@A@@@A@A@ABABA@@BA@CDABAB@@ABABAB@B@@@B@BAFAF@@@@@B@@@@@BB@IJEDIDIBKEGCIEAAAAMKKACEEEECE@EB@D@FAB@@CB@@@@AB@@@DBB@@A@@B@B@@AB@@AB@BA@AB@B@B@B@@@BABCDABC@EA@@AA@@@@A@@B@@A@@@@B@@@@BB@@BBBF@F@@BBBBBBD@D@D@JEJEB@B@D@D@BDDD@@FDDB@BADCBAD@BD@DABBBB@@@BABC@EB@D@DCBEAC@EAG@EBCB@BCD@@AH@BCBC@C@E@E@A@GB@@A@AB@D@DDBBBDB@D@B@B@BBB@@DBDADAJAF@DBBDAFCJ@HBBAF@@@@@@GDMFQ@@@@AQHQ@IG@A@A@ABKCGACCEGKCAQMGCMGKA@BABCDCHBPBHBFJNHLBDHL@HIHGBAJALAFADABC@A@@@AACCECAA@C@AA@EJCNALAD@BCHC@C@EBAAKAIACAGAKCQGECMGE@C@IF@BABEBEJ@DBD@DCF@@@@B@@B@@@B@@@B@@@B@B@D@@@@@B@@@@@B@@A@BBDBFBBBDBB@DBB@@@B@BBD@D@@@DB@@DBD@BBB@JF@@@@B@HFXLF@\\CB@J@dNBBVHDB\\LDBNFPNLX@DBZBLHTDL@D@V@DBT@H@JHNBD\\ZEXAHE^ROHE@AFGBGB@@@@@@CFIHEFCDAFCBAD@BA@@BAAC@A@EAA@A@CBADCAAA@E@AAA@@AAA@A@@BA@@@@@AB@@@B@@@BA@@BA@ABABACGCI@ABAFAF@DADEDAJGDAFCD@JAPAL@LBJFB@DBFDPFPFB@HDNDLBB@D@DAFCJILIJCJAHA@@HAFAHIHKDI@A@I@CAICIAGCIDGBCB@B@B@BBB@D@BAB@DBD@BBBAAAAAF@D@@@B@D@B@DA@@BAB@@A@@BA@ABA@C@@BADIACAAE@C@C@A@AADC@EACECG@EACAA@ABACA@AA@A@CBC@ABAB@F@F@FAD@B@DAF@D@B@J@D@BBDDFBF@D@FE@@B@@@B@BA@AB@D@@@@AB@@ABA@C@AAE@A@A@A@@BAD@@@BB@@BAAAACBA@AB@BA@CBA@A@C@CBAB@AABABB@A@ABA@EA@AAAA@A@A@@AA@@CAAABABBBAB@AAAA@ABAB@HA@@DC@ABA@@BBB@DBDDBHBDG@BD@DC@A@CF@BB@D@DB@N@BDB@DBB@EBBDD@BABADCD@BBDADADBDDBB@@IBBBBBABB@AD@DCJABRLHDDFDJFDDFDH@B@HCHGBGBABCA@@@AACAAABCAI@ADCBA@ABABA@CC@BED@AA@A@@D@D@B@D@@@@BAB@DBAB@B@DA@ABA@ABAFGDAD@@@BA@CACDABABA@C@A@@B@D@@C@CAA@@@CBC@CB@DA@@H@D@CC@A@AJCDAD@BCDABC@ABADAB@D@D@B@FBDBBBF@B@DADADAFABBB@BBB@B@BCBAB@F@DBFATFB@B@@@BAB@F@JEDABABCBAAC@A@AAAAE@AAAA@A@@@CAAA@C@CBAFC@@ACEGAABAD@F@@AAAA@BC@ABC@AAAAACACA@CAE@CECAEA@AACEA@CBA@@C@ACAECAAA@A@ABEBEDCDCAAAC@@ABABAB@AAAADG@ACC@@CAAAAEC@AA@@@A@CA@@@@@@B@@@@@@@@A@@A@@@@C@@B@AA@@AA@A@@CAA@A@C@AA@@@@B@D@B@@A@@@@@AC@@A@@B@@@@AA@@@AB@BA@A@A@@@AA@EBA@ACAE@CACCCEAC@C@@@@@A@@@@@@@@@A@@@@@@A@@@C@A@A@E@ABAAAAAA@AB@@A@C@A@A@AB@@A@A@@@C@@@A@@@A@A@A@C@@@A@@@ABA@@BA@K@eD@@@B[DOB@@A@ODCBSDMHEBMDOAWACEC@GBQHUJIFCBCDCDEDGFC@EB@@E@EKBGBIEM@EAUCMEOABMEMCGC@F@LBFJJ@@@@@BD@FDDPBDA@CB@@E@I@C@@HBBBBFBJ@BB@BAP@@AFADCBGBIAGCEEICA@K@C@@BGDAB@@C@CA@A@C@A@AA@ABADABCB@BABCB@BEBABA@AA@AAA@@"],encodeOffsets:[[108784,26930]]}},{type:"Feature",id:"520422",properties:{name:"普定县",cp:[105.743277,26.301565],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@AACEAAAAA@AAA@@AKQ@AA@@A@AAC@AAAA@ABABABCBC@@BA@B@BBBD@BAB@@@B@@AB@@@@@BA@@@@B@@@B@@@@A@A@@@A@@@A@BB@BABCBA@CBA@G@AB@@A@@BA@@B@F@@@B@DAD@FAD@B@BA@ADC@AAACCAACAAA@@@CAA@A@@BCBAB@@AB@@AB@B@@A@@BA@@B@@A@ABA@ABAB@@A@@B@@A@@BA@AB@@A@@@@@AB@@A@AB@@@BA@@@AB@@A@AB@@AB@@@@ABA@A@@@@@AB@@@@A@A@@BA@@@CBA@A@@B@@A@@@CBAB@@A@@@A@AB@@A@@@A@@@C@A@A@C@A@@@C@A@@@A@@@@@A@@@A@@@A@@@A@@@A@A@@@@@AA@@@@A@A@@@A@A@@@K@C@EBA@C@E@@@CB@@C@E@C@CBA@CBC@A@@@C@A@ABAB@@A@A@@@@B@B@@@DA@AB@BABC@ABCD@D@DDF@BABA@A@C@A@CAGEEC@@C@AAA@A@A@A@A@@@A@AAABA@ABCB@BA@CDAB@@A@A@@@A@A@A@A@CA@@A@AA@@A@AA@@A@AACCACAACAEBC@A@C@AA@BA@@BBD@B@D@BBDBB@BC@AB@@@@@B@@B@@BC@CBC@A@AAAAA@@CACCCACCACACACAAB@@@BMJGLCHCFCFWNKLCD@B@BAF@J@L@DALB@BHB@JHFFJF@FBH@@BFAL@DFJBLDPDD@@JLPJBBHFJHVFRFJFJDRFDBDBFDNHNHLHHNBBHNFPDDDDJFFDBBNDPFXJLFLDXFBBRBDBRNHI@@TKBDTb@BB@HDLDF@LFDBTBJEJKPGRFNFHD@BJLBDDHBF\\HZAD@TAT@NARFNDJHFDFHLJPLBBTFPFJJFFPLDBPDFADAJGDAHCB@@@B@BBB@@@B@B@BBB@BBB@BBDDBBB@@BB@@BBB@@BBB@BAB@B@@@BBB@B@B@@BB@@@@@B@BB@@B@B@@@B@B@B@@@BA@@BA@@BA@@@@@ABA@ABABA@@@@@A@A@@@A@A@A@@@A@@@@@A@@AA@@AAAA@@AA@@@EBA@AA@A@A@A@@A@CBAAAB@BA@AD@BABA@CBCBABBBB@@BDBAAE@CC@@C@ABABE@G@K@E@@CIIEGA@@EACCAAB@BABAB@D@@ABC@EDABCHEDAD@@@@@FDDBB@H@DBB@D@B@B@@@@A@@@@@A@@@AC@C@G@AACC@ABC@AACB@@@D@@A@A@AC@C@@@@@@A@@@A@@AA@@AA@@@@CC@A@AAA@A@A@ACC@AA@@AA@@A@@@AA@@@A@C@A@AAAAAAAAAABABABAKMEEQMCCGEMIGEWOQMCCEEECKGOEC@GEA@AAOAMGIMAI@@DEJDLAFADADC@OEOAA@@CGCA@@AAMHKAAAAE@G@AFC@@DABABE@A@CCEECEB@@A@EAK@CACE@EAECI@EBABA@EGGCGBA@CAQACE@UFK@ECGGBCD@FE@ABABA@CBCBA@A@ABADA@@C@@AA@@@@@A@@AA@AA@@A@@AA@@@@@AA@@@AA@AAGAACAEACEAE@CB@@@A@@A@@@@@@@EGGK@ACCAEAEACAEA@A@E@QBE@AA"],encodeOffsets:[[108304,26802]]}},{type:"Feature",id:"520423",properties:{name:"镇宁布依族苗族自治县",cp:[105.770283,26.058086],childNum:1},geometry:{type:"Polygon",coordinates:["@@GFKHG@EBIFQJAB[LOFWJOHABQFSDQF@L@B@@AH@D@B@HAF@FALEHIPQ^GNMXCXIPNZFHZ^LZDJChALAH@J@^@RBhCLCN@H@FD`CJUPA@EHKL@HBFBVCJWFDPLLNDLAHCFCHCT@H@H@L@LEFCFCRCDHA\\JHBDIPGJAFALAJ@@@HEVIHA@OBGBQ@eHQBQBKFSLGDGFMLYPQPKVEJAD@@MVAF@BAVAR@BDNDXBB@VA@EP@@CR@BA@ABE@CAC@AAE@E@@B@@ABABBBB@B@@D@B@@DDF@DBD@B@D@DBB@@@@B@P@FCF@@@@@DCNEDCDA@EFEDABAB@@CDQBA@ABA@A@KCIBK@EHADCDMN@BA@@@ABKJGHEDIHFHDH@BBDBD@BBF@DBB@B@B@@@H@D@BBD@D@B@D@@BB@D@B@BBD@D@FBJDH@B@BDFFLDNAD@@AHEPCJFJDD@@BAB@@@@@B@@@B@@@B@@@BA@@DA@@B@@@BA@@@C@@BABABA@AFEHKDCFAFB@BHBJ@JCNEDAB@B@@@B@B@FA@@B@@@B@@BB@BB@BBBAF@BCF@BCB@@@@BBA@@BBBB@@BB@B@B@B@B@B@B@@BB@@@BDB@@B@@@B@@@B@B@@@B@B@@@BAB@@DBHDDB@@BB@@BBBBD@@@D@DBL@DAFAB@BABC@AB@DAD@D@DBBB@BBBBBDD@@@@BAH@B@DAB@DABA@AAAB@@@B@@@B@B@@@@@@A@@@A@@B@@A@@@@BA@@@A@@BA@AACAAA@B@@AD@DABABABAB@BB@BBD@B@A@C@A@AB@BBDE@@@CB@BA@ABA@@B@BADB@BB@DBD@@@B@@@@@@@AACAC@AAAA@@BADA@@@@B@@ABABAB@DDDBD@@A@@BA@@@@@@@AAA@@@A@@D@B@FAF@B@BBD@D@B@@@DB@@BB@@@B@B@@B@@@@B@@@@B@B@@@B@BDBBBBB@BB@@@@BBB@@BBB@BB@B@@@FA@@@@DA@A@AAABC@AAAA@EBA@A@BA@AB@@AA@A@CA@@@AFCFAFBNFPHLFHF@@DDFBBBF@@@J@L@D@F@FADAJKHCB@RAFCEGAECEAMAEAGACEKMKKMAI@EAGAAOGMCGCGAGGAGBGDCL@NDFDJCHCBADAHCHAFABC@A@K@ABC@E@OFERFDBHBF@JGDMFEFCECCAC@@A@A@AAC@@BABDBAD@BABAD@D@FBBBD@@B@A@A@@BA@A@CAA@@@@CACAAA@@A@BA@A@A@AAABAB@D@DB@@@@BD@@@B@B@BB@D@DAB@@@FCNBDD@@DDDDJHNDD@JBVEF@DBB@HBB@DBDBDBB@D@LB@@F@F@DBBC@A@A@@@@BADAB@DBB@D@B@ACAC@CBA@@BADAD@B@BAB@@ABAB@F@@A@@@AAAAAA@EAA@@C@@BCBE@EBC@A@@@C@ABA@AAAA@@A@AA@@@EMIGIGEEGGCEAA@AGM@SBCBGB@BGJMBCNKFEFA@@BALIDIDIACIEK@KDK@QIGO@KAKOMQKQKSIAAIECCAAOOACAECICK@EBQFSBUCQaKBADYBKP[XQLCD@FAV@BGSKQKCe@EAECI@AFSJSPSNOLIBABBB@B@BBB@B@B@@CDA@ABABCBABABA@ABAA@@A@A@ABABA@ABA@A@C@A@A@AAA@A@A@ACAAAA@@AAA@CAAAAAC@A@A@CAA@@CCGECC@ACGBGFEHED@DBDDBDD@FAHCDEDGHGBACEAAGGIIQEGEECCAMIUMWKQKSMAEEIBKHQAK^WBEGEAIXUGCMEAAWLKCAMKAQKKFAB"],encodeOffsets:[[108429,26037]]}},{type:"Feature",id:"520424",properties:{name:"关岭布依族苗族自治县",cp:[105.61933,25.94361],childNum:1},geometry:{type:"Polygon",coordinates:["@@HIJOACIGB[CGQDEDEDKFK@G@G@S@GDEDGDKBMCKKCOXEDIAUAE@GLKFGB@VODIC_@E@GDMDKAg@Q@]@IBGBKDgCIKYY]EGMYUHKLOdENCJ[VCHGNGXCHENCJADORUJ@@@@EDCBkFIBIBIDMFQL@BA@ABtI@A@I@SWQKWAC@EBSTKVWRQRITEDCF]LOFCBIDCBGHCFIHCJADWTSB[VQNEDCD[`AFCPAH@@ALB`BND\\CHADOJcIY@EBMFWNGH@@@@ED@BA@GH@@AB@@ABCFEFABCHEHGHAB@@@@A@ABKDQFA@A@@@@@AB@@AB@@@@MJEPBDDL@DJ\\@F@D@D@B@@AD@@AD@@AB@@A@A@@@A@A@@AA@AAA@A@ABABABAB@DBBBD@@@BFLFHJFLFVBTCXCRBPDZDVDLBNBNBNCPEV@ZBXFV@RA^BTDZHNDFB`DJDFBNHHH@@FJLLBDHJHHBB@@@@DD@@@@NLLHDBFBVFL@F@NEB@PQTGJGFCHGLIBA@@B@@ANMDCBCFGL@JALDB@B@BAB@RADC@@BABAFCFEB@DCFCDM@C@@@@DE@E@O@A@@A@CAC@A@C@CAE@CC@@@A@CA@A@AABABA@@@AF@F@BBD@DBF@BAB@@ADQ@@FOB@@UAACWCM@ABQBU@ABENU@@BCFILUROZONKHEHCTKLERARAfGR@HAPAB@JGFU@G@@BIBKBE"],encodeOffsets:[[108354,26428]]}},{type:"Feature",id:"520425",properties:{name:"紫云苗族布依族自治县",cp:[106.084441,25.751047],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AB@B@B@BBB@B@B@B@@@BADCDAF@DABCBABC@GBABI@@@A@A@A@ABABA@ADABA@A@AAA@AA@AA@A@@AA@@GAEACAA@E@ODOFE@AAEDS@YGSG[GG@UCOHILCDKRINABSPEDCBKDEBGD[HW@GASCQGGACBAHAH@B@DAFCTGZM\\QVA@@D@FAB@DADABABADA@ABAAAC@CA@AAA@AAC@ABC@AAAA@@ABA@ABA@ABA@G@G@C@ADDDBH@JEFEBCGCWMQEAICUAEBCNCX@JFFJ@HB@FEHKJGJABGNEHEDGACEMAA@MBMJEFABGFOLCHDFHDJ@@@B@BBBBD@B@B@B@B@DCB@BAB@DBBB@BBBB@DDB@BB@@@B@BAB@@C@A@ABADABBBBB@BBBBB@D@B@D@BBDBBB@D@BB@BB@@@@B@BABADABAD@D@BABABADCDCBCBCDCBC@CBABCBABDFFDAHEB@@G@CAIQA@AAACA@AAAA@@A@A@ABA@A@A@A@AAAAAA@@@ABAB@@AA@AABCBA@CAA@C@AA@A@AA@@@A@CAC@AAAAC@AABA@CDA@CBA@A@AAC@A@CDABABABA@ABABCBC@ABA@ABCAABC@C@A@CAC@E@E@A@AAC@AAC@C@ABA@@@C@BBAB@B@B@BAF@BABCFAD@BB@BBBB@D@B@BBD@B@B@B@D@B@DA@@BABABAB@BA@@DA@@BAB@BABABABABA@AB@BABABABADAB@BCB@DA@A@A@AAA@A@AAABKJMPOTITET@BDJBF@FDfRLTLAHU@EBC@KDWRO\\ALCZABbLDRAVETAR@FDLDJBFBDPPBBDDJFBBTJRLRLPNBL@LHPRJL@LCL@JFBDCJCJKJAB@@EBEFMLADINAHA@AHAD@THN@BBBDFHHFFJHJHFN@@B@@B@BB@BB@BAB@B@D@@@BAD@FAFAD@@@DB@FBB@BBBB@B@@@BDJBBBB@D@BB@BBFDDDBBFDHLDDHHJJRHJFJBD@L@F@HG@AHGDKFGDGFIBEDABAB@JBPDJAH@LCREPGJIBAJODK@C@@BOBSBM@I@C@I@ABEBAJAFDBJDDD@@@@B@BAB@BB@B@@BD@@A@ABABCBBDB@B@D@BC@A@CD@BBB@@BBBBAF@J@FB@DABBB@BA@A@A@@B@@@BADAD@BBBBBB@D@B@H@D@DBD@B@B@B@@B@B@B@BB@@AB@@@BD@B@D@B@DBDBB@D@BDB@@A@@B@BA@@@CBA@ABAB@BBBD@F@DAD@@C@C@@@@FBB@B@DAB@@B@D@D@BDBBCHAB@D@DABABAB@D@BBF@@BB@BFBDBB@B@DBD@D@@@B@DBBD@AB@@AAGFBB@FABB@B@D@DFBHBBBBBB@D@@AACGG@CFC@@FABABEB@B@@D@DDDFBH@FABAB@D@BAB@BEBCBCD@D@B@@C@C@ABADCDA@@@AB@B@D@B@B@@BB@B@BB@@B@BAB@B@B@@A@A@@A@@AA@@@AA@@@@@@AA@@@AA@@@@A@@BAAABCBCFAF@H@F@BA@A@CCOCI@@ACCGEAI@G@C@GACECGAG@IHOBE@ACAE@KBCAAC@CAOBCDAHARCLCDC@A@C@@@@@AKKMSAIAIAGE@E@CC@EAaAEAAC@C@AA@A@@BCDA@CCCGACCAGIEA@CCIEGAACAE@KBGDCBC@CAAB@@@@A@C@CBC@AAC@@AAAC@A@A@C@A@@F@D@@@BOHQDKBQBMBWAQCKACOKSGKGCIFOFAHEJ@D@ZTVP@@PLNFNALA@@LMBA@@@E@GCUFKBOFAJ@LINIJAFAVC^OJErM@@Z@VHZdBBBDHDLBTG\\O^[HETE@E@ACQOUCICMA@@AEQOOUSKK@AGGKKGOBQFUCWAC@@O]KSEKC@A@E@CBC@E@A@AAA@CCCC@G@CFCJCDC@ACAEBI@AAAC@CB@A@@ABC@A@@AA@AA@@A@ABAB@B@@BD@B@B@@AB@BA@AAAAA@ACAAAAAAAAA@ABC@@BA@AB@B@@@BBB@BBB@BBD@D@BA@@BABA@@@CBABADA@ABCDCBCAA@A@A@AB@@A@A@@@A@C@AACCA@@A@AAAAAA@A@@AA@AAA@AAAA@@AA@AAAA@@A@A@A@A@ABA@ABEBA@@AAA@@AA@A@@AA@@@A@@B@DA@@B@@A@A@AA@@AAA@@AAC@CACAEA@@BADAF@B@B@BBBBBBB@B@B@BBB@D@B@@@B@B@BA@@@@@A@AAA@@AA"],encodeOffsets:[[108958,26010]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
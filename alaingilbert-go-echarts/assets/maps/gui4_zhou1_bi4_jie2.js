@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("毕节",{type:"FeatureCollection",features:[{type:"Feature",id:"520502",properties:{name:"七星关区",cp:[105.30474,27.298458],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@@B@@@@BDJF@@BBB@@@BB@@BB@B@B@D@B@@@B@BBBBBB@@BBBBF@B@D@@AB@D@B@B@D@B@B@B@B@DGJCHCH@D@D@@@D@@@B@F@D@BBDDDBF@BDB@@B@@BD@@BDFDD@BDBFB@@BD@@@BDBBB@B@@@B@B@@BBDF@@BB@@B@B@B@@@DAB@B@BBB@DBFBBBBD@@BBBDBFDDBH@@FFBB@@@BD@D@B@BAD@BBBBHBB@@ABABB@@DFFFD@F@FDD@D@BABC@EBA@@BAD@DB@@BB@BBFBDABAD@BFBBBD@BADDBBF@D@D@H@BBD@HDF@DADA@C@ABA@@B@DHBDD@D@BD@BBDBDDBFAH@B@HE@@BCBAFAB@JBFF@BF@FBHBF@DAD@@@@@B@@AB@@@H@F@FCDCFAB@F@@@HBDAFABAHADEBEDADAFAHALC@A@C@CBABADCBABCDA@AAAACBA@@B@@@BA@C@A@@BABA@ABA@ABADAF@DA@@BA@A@@@A@@DADADBJ@D@B@D@DBDBDAB@D@BAB@D@B@BBB@B@BABA@@B@B@B@BADAB@B@D@BAB@BA@A@CAIAA@CBC@A@ABA@@@@BAB@@A@@BA@A@ABA@ABAFAHCDABABA@ADE@A@A@A@AAA@A@A@ADG@C@A@ABABADA@ABABAB@D@BAB@@@B@DAB@D@B@BBB@BBBBBBBB@BBDBBBBBDDBBDBD@DBBFDBBDBFDD@B@@@D@DBDAB@@@HAFCDCDAF@F@B@BBBBD@BABCB@B@D@D@FDD@HBB@DBB@DBDDBB@BABAD@B@DBFBD@F@DBDBDDBDBB@FDJBD@@BFBD@FABBDBD@HBJDNDD@@ABAD@D@FDDBF@DADBDAHAD@D@BA@@FCF@F@FAFAB@D@BAD@FABBDADBJAFABAB@@ADAD@HAD@D@B@DBDDBBBBHFDDFB@D@@ADDDDBDD@@FD@B@F@DFDDBDBFBDBD@BADAHAD@FDDBBB@@D@FDDDBB@BD@B@F@H@D@B@BBF@B@BABCBC@CBC@CBC@CBCDCDA@@@@@@AAAA@AAA@AAACA@@@CC@CAAACAAA@@CAA@A@C@AAA@A@C@A@A@@AA@AAA@@@C@A@AAACAACCGCE@CA@C@ACC@AA@A@A@A@@AAA@CA@AAAAACCAA@AAC@GCC@ECA@AAAA@CB@AG@AEA@@EAAAC@C@C@CCC@AAA@A@AA@@A@AAAAA@A@AAA@AA@ACAACAA@GAA@AA@AAA@@@AA@A@AAA@A@AACAA@ABA@AAA@CAAAA@AACCCAAA@AAA@A@A@AAA@@@CAA@AAAA@A@AAA@@AA@A@@@A@A@A@A@C@A@E@A@C@E@CAA@A@CAC@A@IA@ACCA@@C@AAC@AA@@@@A@ABABECICQEQCCBGDKREHABGBIDUKC@KG@@IEOEWGCAMGKKCM@@AIAMAIAACQCMIKKKKEKEC@@@@@AAIFA@GAA@AAC@A@C@A@EAEAGAEA@CECIBA@AAC@EDC@E@C@AAA@C@C@C@AA@@CACCCCGAKGA@@@CAE@EBA@C@CAEC@@CY@EACCCKKA@C@A@@@EAG@C@A@AA@@DS@EACGA@@IEKGCAAKFOFEFCFIB@@ABMAQAO@C@E@@AE@GDE@@B@@AB@DAB@HBDBJBBAB@FQCUCU@CCKACGICAKEOEOKKGAAGCGCOCIAMAEAEAAAEE@@A@CA@@AACACAAA@AA@@@AA@@@AAA@@@@A@AA@@AA@@@A@A@A@@@AA@AAA@A@A@ABABA@@@@@@A@A@A@A@C@@@AA@@@ABCB@@A@@@AA@@AAB@@A@@BA@@BAB@B@B@@@@A@A@C@AB@@AB@B@@A@ABA@A@AB@@@B@BA@A@@@A@A@@@A@@@A@@@@A@@@A@@@@AB@@AB@@@DA@A@ABA@@@ABABA@AB@B@BA@@@@@A@@@@@@A@@@@@A@@@@@A@A@@@AAA@A@@@A@A@@@AAA@@@@@@@AB@@@BA@@@@@A@@@@@A@@@A@@@AB@@AB@B@@A@@@A@@@@@@@A@@@@@@A@@@@@A@@@A@@@@@A@@AA@@@@@A@@A@@@A@A@@AA@@@A@@@A@A@@@AA@@@@@@@@@@@AB@@@@@B@@@@@B@@@B@@@@@@AA@@@@@AAA@A@AA@@@@AB@@@@@A@@@@A@@A@@A@A@@@A@@A@@@@A@@@@BAABEFQLIJEHCDQCMMMEEMMOQESAI@AAMDWHUFMAI@@@@@G@@@AKIOOOOQMGAKCQ@Q@M@CBSHM@ODIDC@KBE@ABMBI@YB[BW@GAA@A@GAGCGAGI@@AAIOECCEEGIEAAAACACAC@EAC@A@AAC@AB@@ABA@ABABA@EB@@@@A@CBCDABA@ABC@C@EBC@A@CAAAA@C@A@ADABGBCAECCAA@A@EBC@@@ECEEAAA@CCCCAAC@CAE@A@CAA@A@@@EDC@ABAB@BC@A@AAAAA@AAC@@@AACAEAAAC@@@A@A@@@A@AAA@ABA@A@C@C@E@AAC@@@A@AAABABA@A@A@@BAAA@A@ABC@@@A@AB@DA@ABABA@ABC@A@@BCDABA@A@A@EAC@C@A@@@@@A@CBAB@@ABABAF@BA@ABA@@B@@ABA@ABA@A@A@C@C@A@A@C@C@@@A@A@@@CB@BEBAB@@A@@D@@DD@DBB@BBD@BAD@B@B@B@DABC@GBC@@AA@ABC@E@C@C@ABC@@@A@A@@@ABABED@@A@A@AB@@ABA@ABA@ABCBABABA@AD@DAB@D@DAF@B@D@@@BAB@B@@ABA@A@CBGDA@A@CBAB@@AB@@@@AB@@AD@@AB@BA@@B@BBB@B@@@BA@A@AB@BAB@@AB@D@@BBAB@@@@ABABA@CBABAB@BA@@BA@@D@@ABABCBABAB@@A@@BC@ABC@ABA@E@ABA@GFC@A@C@A@A@CBA@A@@BC@ABA@A@A@ABA@ABA@A@CB@BA@@BABCB@BA@@@A@@@A@A@@@C@@BABA@ABA@AB@@@D@BAB@@@BAHAL@@@@@B@B@@@@@B@B@@@B@@@@A@AB@@@@@B@DBBDBBBBB@@BB@@B@B@@@B@@@BBB@@@B@BBB@@@BB@B@@B@@@B@@@@B@@B@@BB@B@@@BBBB@@@BA@AB@B@@BB@B@@AB@@@@BB@BB@@@BABB@@@@@B@B@B@BBBB@@B@@@B@B@B@@AB@BAB@B@@@B@B@B@BAB@B@@ABABAB@BA@ABC@A@@@ABA@ABA@@@@@KNIR@BAH@@BD@BBB@BBD@B@B@B@B@B@@DD@@B@BBBB@@BBBBBB@@DBB@D@D@B@B@B@BBB@B@@BBD@B@BBB@@@@@BB@B@BAB@@@D@B@BA@@BBBBBBBD@BBD@BBB@BBD@BBB@BBB@D@BBB@BB@@@BB@@BBB@BB@@BBD@B@BBBBB@B@BBD@D@BBD@BBB@@@BBBBBBBB@BBB@BBBBBBBBB@BB@@BB@B@DBDBB@HDFFBDFBFBF@F@D@BB@BB@B@DBB@@@@@BBBBBBB@FDFBB@D@BBFDBBB@BAD@B@@@@@@BAB@BDFB@@@@BB@@@B@B@DBB@BBB@@BDBBBBBBB@DBBDDBBBB@@BDB@BBBBDBBBDDBBBB@@B@BBB@F@B@B@BAB@@AB@@ABA@@@AB@B@@@B@@BDB@B@@@BAB@BA@@BA@@B@BB@@BBB@BB@B@B@B@B@D@@BD@B@@BD@B@BDB@BBBB@@BBBBBB@@BB@@BBB@DBB@D@D@DBD@D@B@B@B@@@@@BAB@DADA@@@@BABABADCB@@@@@BAB@@@B@B@B@B@@@BBBBD@BBB@BBB@BBB@B@B@B@B@D@BBB@@@B@@BB@B@B@B@BA@@@A@@B@B@B@B@B@B@B@B@D@BAB@@@@AB@BA@@DA@ABAB@B@B@BAB@B@@AB@@ABA@AB@@A@@B@B@B@B@B@B@@@B@BDB@@B@@BBBBB@B@B@B@DA@@@A@ABA@AB@@CBA@A@ABA@@BA@AB@B@B@BA@@BAB@@@F@@@B@BABABAA@@A@A@A@A@AAC@A@A@A@A@C@A@@@A@@@A@A@@@AA@@AAA@@AA@ABA@@@ABA@@B@@@B@B@@@B@@@BB@BD@@BDBBB@@@BBB@B@B@@@BBF@@@DBB@@@BB@@BB@B@@BB@B@@BBBB@@@BB@@BBBBB@BD@@@BBB@@BB@@@BBBB@BB@BB@@BB@@B@D@BBBB@@BBBB@BD@BBB@B@BBBBBB@@@BBBBBB@BB@@B@@@BBD@@@BBB@BB@@BB@@DB@@BBD@@@B@BBB@BCDABABA@ABA@@B@B@B@B@B@@B@@BBBBBBBBB@@DDB@BBBD@BAF@@BBBB@BBB@BBB@B@BBBAB@B@@AB@BCBA@AD@B@B@D@B@B@@@B@B@B@D@@@BBD@BBBB@BB@@BBB@B@B@B@B@B@B@BBBBBB@B@@@BBB@B@D@B@B@B@@@BB@B@BB@@B@B@@@BB@@BBBB@BBBBD@@BD@D@B@DAFAJABCH@@AB@BA@@B@B@@BB@@B@@BBBBB@@AB@@A@@@A@A@A@@@A@@@@BABA@@BA@@BA@A@AA@@AAAAA@AACAA@@@A@A@A@@@A@A@@BA@@B@BAB@B@@@BB@@@@BB@DBJDDB@@@B@D@BA@AB@@A@@B@BBBB@BBDBDB@BBBB@@@B@@BB@@BBB@B@@@B@@E@ABAB@@ABCB@BAB@B@DBBBB@BD@@ADCBABCBA@@@@BB@BBB@BBDBBBB@BB@BB@BD@BBB@D@D@DBDBB@DBBBB@BDD@BBBBBBB@@BB@BBBBBBBB@@BBBDBB@BB@FDDBDBD@BBBBB@@@BB@@BBBB@@@B@B@B@B@B@B@BAD@B@BAB@DAB@@AB@BABA@ABCB"],encodeOffsets:[[107824,28296]]}},{type:"Feature",id:"520523",properties:{name:"金沙县",cp:[106.220227,27.459214],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@OBBHBA@@@@@@B@BAHE"],["@@SKGCCCKIGAGAQAEAKAIE@@EUG]@@EECAYGC@MIAAGQAECG@@EMCEOBQNEFiRA@@@@DCLBNBBDFDFJJDDDL@LCJ@@AFAFCH@D@HDPBFFHPLDFB@@@BDJJFP@F@B@LCJAFCDMNIFA@IHCBCLAPDFBFFPBNEFGJGAGEEEIGICG@GDGBCBC@KBOHQHM@E@S@C@U@@@E@S\\EBCBKFWTABA@AAI@]QCICOEGIKCCMEQAMCAAMCCCIIBIBIACCCGMEUFM@K@@CECEKCGF@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@B@@A@@@@@@B@@@@@@@B@@@@@@A@@B@@@@@B@@@@@@@@@B@@B@@@@B@@@@@@B@@BBB@@@B@@@@@@@@@@@B@@@@@B@@A@@BA@@@@B@@@@A@@@@@@@@@A@@@A@@@@@A@@@@@@@AAA@@@@@AA@@@@@@@@@A@@@@@@@@AA@@@@@@@@A@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@@B@@@@@@A@@@@@@@@@@@@@@AA@@@@@@@A@@@@@@@@@@@A@@@@@@B@@@@@@@BA@@@@@@B@@@@@@@B@BA@@B@AGUCA@DdBD[`IlSPaHEBABEHGVEPCD@@GNKPGHIJIFID@@ODAB@AA@A@@@A@A@C@C@C@A@I@EBA@@B@B@BBB@B@BBD@F@BBDB@BBBD@H@@BBBD@BCBAB@@IFEDCBA@CBA@C@A@EBCBED@AA@A@AB@@C@@@A@@@@B@BAD@BBB@@B@DBD@DBDDBBBB@@FB@BBB@@@BAB@D@B@BAD@B@@A@ABC@ECA@@BABBH@DABABA@C@E@MFEDEFEDCHAF@DELCFCFGPEF@@EDSAMEKGQOEOIKGEGBAB@@CFAJCJGLC@@@GBIDIJ@D@B@D@NBH@JCXAN@@@DBRFRFLFLFRBF@VEN@@ADKNQLQFC@KBEAGAIGA@KIKIKCOAC@MDOJEF@@EBAB@@@J@BBD@D@BBB@D@F@D@B@F@B@D@B@B@B@B@@@BBB@@BB@B@BBBBBB@DB@@B@BBB@B@B@BBB@BBDDBD@BBBBB@DBB@BAB@BBBBD@B@BBB@B@BBB@@B@BBB@@BBB@HBBBDDB@BBBB@BBB@B@BBBBB@@@BBB@B@BBD@DDD@D@D@BBFB@@FB@BBHA@@DBBBBB@FDD@HDD@BBB@DBBDBBBBB@@DBB@BB@B@B@B@@BDD@B@DDBF@HDDDBBBDBBB@D@@@B@BBB@@BB@B@D@B@B@BBD@B@B@DB@@BBDBBBDBD@@D@@DBBB@BBB@BBBBB@@@@@BBBFBB@JBHBFDHBJDFBHDDBD@BBDBHBHFDBDBB@DBDBDAD@J@B@DCHAB@@@@A@C@CAA@ACA@C@ABA@AAE@A@ABA@AAC@@@A@AD@BA@@AC@A@CDC@@@ACAAA@@BABABABA@@Dc@A@@@ABA@GEO@CAAAG@C@ABEREBBRDXHP@@@FBB@@@DBB@BBD@D@B@D@D@@@B@@AB@@A@@@C@A@A@@@@BAB@JAD@@@B@B@B@PAB@D@D@BA@AAC@@@CD@FADAB@FBB@DA@@BC@ABABA@A@AB@B@BD@JB@@@B@@CBC@A@A@A@ACEBC@CAAAE@A@A@CHE@ABA@A@C@@BABIBE@CDAB@D@LDB@D@@@@@HSDMBCLQPKBABA@@@@DCDCDIDEFGDCTC@@PB\\FBB@@VDJDLCLIJGDA@@HEBAPFBBFHGLEP@BJPPJRPDBHD@@DBFBBA@AB@BD@B@BBBDBBB@BAB@@ABADBBBBB@BBDDDDBABCBEBADGBABADCD@B@BBD@H@BABABABAD@FCBABE@AB@BE@C@@@@DCTILCD@BEFYCgGG[IACO]BK@A@@LW@ININWHE\\DLBVKHDBNFFRHPHbNxZlKBA@AFCHS\\DLKFCPE^IFADA@@F@N@LLBB@F@HERCHZNREDPBFJFTCFBBBLFNHHFLBFBB@D@@@@@@@P@HCFADABAROFABABAFATIDAHSJQBGHIB@@ATWBABCHCJ@L@L@N@D@JCDIDG@O@EAK@@@A@@@CAA@A@ABABAROJI@C@@@@BE@CBE@A@@IaGWEG[a@QDIJODGVmGAO@_JSGCA]I@Aw@A@AAACAC@AACAAAEACAC@@AAAECEAEACKGGEAACAAA@@g[uKICA@maUIWMGACEIC"]],encodeOffsets:[[[108699,27899]],[[109055,27863]]]}},{type:"Feature",id:"520521",properties:{name:"大方县",cp:[105.613037,27.141735],childNum:1},geometry:{type:"Polygon",coordinates:["@@A[@@@A@@@@GKOGOBWdMVKJQLCBBbCDGLU@KCcKAAA@UE@@A@@@A@@@YEcBID@@A@@@@@AB@@@@@@A@@@@@@@ABGBABCL@@@BG\\QFGBKJAB@@CJ@@GP@@@BIHyFCBEBA@C@KBKBMBA@KBSFMDA@QHUHICEAEAECOGEAI@@@MFQLCDAB@B@@GJGNETBN@FBDBDDPJXFL@@FPJXHNJ^JPLHN@PALANHVFHHN@R@R@LDHBRNPPPPLJ@B@@@H@@@@BJENGVCXBN@BBJFTPRNNFFNNDNCRGDIFKJERAFBB@A@@B@@@@@@BB@@@B@B@@@@BB@@@@@@B@@@@BA@@@@BBB@B@BB@@@@B@@B@@@@A@@@A@@@@@A@@@@@A@@B@@@@@@@@@@BB@@B@B@@@B@@@B@@BB@B@@@B@@@@B@@@@BB@@@B@@@@@B@@@B@@@@@B@@@@B@@@@@@@B@@@B@@@@ABA@@BA@@B@@@B@@@@@B@@@@@B@@A@@BA@@@@@@B@BB@@B@B@@@B@B@BB@@B@B@@@@@B@@@@@B@@@@@@@@B@@@@ABA@A@@BABAB@B@@AB@B@BCB@@A@@BA@@B@@B@@@B@@@@@@B@@@B@@@B@B@@@BABA@@@A@@B@BAB@B@BA@A@@BA@@B@D@B@B@@A@A@A@AB@@AB@@@BA@BB@@BB@@B@@@DABA@@B@@B@@@D@B@B@B@B@@@@B@BABAB@B@B@BBB@@B@@@B@B@B@@BB@@BBB@@@@@BB@B@@BB@@B@@BBBDBDBBB@@DBB@@@FFBBFBFBNBJBPDHDHDBBLHPLPFLFDBHJBDDL@DDVDVERA@ABIACAGAA@CBA@@BA@@@CF@HBF@@@F@DBPBRAN@BA@EJEDEFEPBLDBLHJF@@HBBD@FCT@@BBB@D@H@FB@@B@D@B@LLDDBD@FDZ@@FDDBD@B@FAF@DB@@B@LHHBDDDDDB@@BBD@D@D@B@BBD@F@D@FCD@BBB@JAFD@DFBHBFBFBB@D@B@D@BBB@HBB@JEBB@@@@D@LFLFLLJLDNDRBBBJBNBJ@@DNLLNHDBXHPFJF@@LHD@VLJCHABAFGLQHCDARDRFJDFDBABAB@@@@@BBD@BBD@@@DBBDB@@@BAFA@@FEPINCD@PBLDLJLJB@JHHBFBLAD@RERKLMBC@@FM@UAEEQEKEKEQAQ@C@@BMDW@IAG@M@C@A@CJIJCHA@@D@HKDIBIDE@@BAHAHFJLFPRPLHNFTBFC@@FEHODEDEFK@CBEDGFCFEFCNEF@D@B@BABA@CAGBA@AB@FDD@BAB@@@@ABC@A@A@CBA@A@@AA@AEA@@AAAACCCAC@CAA@@@AA@ABC@A@A@@B@@@D@@@BAB@B@@BFCDAFAB@D@B@DAB@DAFCJE@@BADA@AACAA@@@GACAAA@AC@A@EAC@A@AAA@A@A@AB@FAJ@B@D@D@D@B@B@@@B@B@@BBAPC@@JCJEJIHGLOHM@@DCFOHUFGBAFAbGTOJk\\_ACCcqC[BE@Q@@A@BABC@A@AA@@@C@CECMOAAC@AB@F@BCDIAEACBCB@BBBAD@BA@GAA@CAA@A@AA@@@A@A@@BCBABA@@BA@@BAACCAG@C@AB@FC@C@@@AACGEG@AEAE@C@@@A@ABA@CAA@A@AAAAC@AAAAA@@A@AA@@A@@B@@BB@B@B@@@D@D@B@B@B@B@B@B@B@@A@@A@AAA@AAA@@A@AA@@A@AAA@C@ABCBABA@A@@IGK@G@K@MCIGMGCAIAE@G@G@GBGDA@EBABEBG@EBGBEBGDEDEBC@@@E@E@A@A@E@GHABE@EC@AA@@C@ACEEC@AACCC@C@A@A@A@ABCBCBC@@EECCAACAECG@@@E@CAAAGAK@ACAC@AAC@ACAA@A@@@@@K@EBCDA@@@CAEABUSeEECG@E@I@@@GAMEWGY@G@CAK@KHU@A@UGOMKMMAEEcDgBM@@BCDGRABADCJ]FW[GSEUBa@SJMAK@OLIHc@E@iKOPKLCBH@@@@OIACEKCC@WH_BEAW@KKUAC[_AI"],encodeOffsets:[[108148,27501]]}},{type:"Feature",id:"520522",properties:{name:"黔西县",cp:[106.033544,27.007713],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@A@@@@@@@@A@@A@@@@A@@@@@@@@@A@@@@@AB@@@@@@@@A@@@@@@@A@@@@B@@@@A@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@ABA@@@@@@@ABA@@@ABA@@@@@@@A@@@@@@@AB@@@@@@A@@@@@@@@B@@@@@@@@@@@B@@@@@@BB@@@@@@@@@@@@@@@B@@@@A@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@BB@@@@@@@@@BB@@@@@@@@@B@@@@@@@@BB@@@@B@BB@@@@@@B@@@@@B@@@B@@@@@@@@@B@@@@@@A@@B@@AB@@@@A@@@@@A@@@@@@@@@@@A@@AA@A"],["@@QGKKCCEEGQAU@CECqBOEAAWGGCSAIASNADADKXABGFSHMLCDABA@IPGP@@GNOnADAH@BIRGPCD@B@D@PDb@F@B@@ADUdKH@@C@QD_LEXI^CDABQBCHAD@@ANChFdBFNNNLHP@V@BGV@LBL@D@HHZFXBN@H@@@J@FDHFFTfAVFBDB@@B@DCFAL@@@@@B@B@DB@BBD@BBDBDL@HBBBDBF@@@H@FDDBBBDDFF@@ADADAD@B@B@B@B@DDDBD@BFDDF@B@DB@@BFDF@BAHGF@B@B@F@F@@@D@FAFCHCFAHAFAH@FABAFAB@HCHAH@H@F@JBDBNHJHNDL@H@L@JH@@@BABABAD@B@DBB@B@BB@@B@BB@BBB@BBB@@@@BA@A@A@A@A@A@A@C@C@@@A@A@A@@AA@@@@BB@@B@BB@BBBBD@BBBBB@B@DBB@BAB@@@D@F@FB@BFHDHBB@@D@D@@EBAD@H@DBBDAB@@AB@@ABABAD@@@B@B@@BBB@B@DBB@HBB@@ABCAA@ADADAFBJBDC@A@EBAD@BBNPFD@D@D@@BBB@D@BA@A@BR@F@\\ArDB@VDPALDDFDF@@@LENFVHNDDBDAJAJJJDDNDBBNDRBNFDDJLFHDPDJ^RJ@BBB@BAXSLEDAFAT[F@@@V@D@T@F@N@RGPGLAD@DAHAHCH@JDJHFFHFHBHIFEAMEOAECEBODKDAJGB@JENMDCBEDI@K@A@EEOIIAC@@A@CEOKEGAECO@G@CDGBEBE@@DI@KCKCCIICECEAAAMDK@C@@B@jQFERMPAAk@A@K@CPiDIDG@G@CAI@CFQFGBADGDCLQ^M@ADE@ACASOWQ@@aIQGECGCIEMUIM@CKgAKCAEE@ACK@O@K@A@A@A@A@IGMCC@@@@KIKCAAGBG@GDEB@@@BUJABIDE@@@E@@@@@@@@@AB[k@@OUCCQGK@SJCBCDIJM\\AB@BADAB@B@@@@ABA@E@M@WOCCCC@ASSMCWGECA@AAA@@BA@A@AA@@AA@A@AAAUCOAGCGECCMUCEKyMGI@E@ZA@@@MBUOKIMAE@SRG@C@CA"]],encodeOffsets:[[[108696,27910]],[[108573,27452]]]}},{type:"Feature",id:"520524",properties:{name:"织金县",cp:[105.770542,26.663449],childNum:1},geometry:{type:"Polygon",coordinates:["@@IEEN@BENIN@@G\\CHENGRBPCPGLABIDA@A@S@QAYB]@EBA@IBIBENCD@@EHIHA@SDA@A@M@SAEBIBGD@@CBE@I@ABCBEHEPCD@@A@C@E@ACACAE@CECC@A@@@AB@D@D@D@@ADAB@@AB@@@DA@@DABCDCHCD@B@B@@BDDBD@BBD@BBBBHHFD@BBB@@DBF@BBBBBBBB@@BB@BB@DBBBFDDBBBF@BB@@B@BBB@DB@@@JBDBBFF@BBDBBDD@@BB@BA@@B@@@B@@@@@D@@@D@B@@@B@@@BB@@@@B@BB@@B@@BB@BBB@@@@BB@@B@@B@@B@@@BBB@@@B@@@BB@@B@B@@B@@B@@@B@@@BB@@@@B@@BB@@@@@BB@@BB@@@BB@@@@BB@@B@@B@@B@@@@@BB@@B@B@@@@@BB@@B@B@@@B@@@B@BAB@@BB@@@B@@@B@B@@@B@B@B@@@D@@@B@@@B@@@B@B@@@B@@@B@B@@@@@B@BBB@BBB@@@BB@@B@@BB@@BB@@BB@@@@@B@@@B@@B@@@@@@BBB@@B@@B@@B@@@B@@@BB@@@@@@B@@@HRLJLDPHNLHFDDHLRLTFHB@@FBJBFD@@LFXHB@@BD@@@BB@@@@B@@@@BB@@@B@B@B@@B@@B@B@@@@@B@@BB@@@B@@BB@@@@@BBB@B@@BB@@@@@@BD@@B@@B@@@@@BA@@@@@A@@@@@A@A@A@A@@@A@@@@@A@@@@@AA@BA@@@C@A@@@@BA@@@@@@B@B@@@B@@BB@B@@@B@@@B@@@BBB@@@@@B@@@B@@BB@@@B@@@B@@@@@B@@@B@BB@@B@B@@@@@@@B@@@B@@@B@@@@@B@@@BAB@@@@@B@@@B@@@@@BABBB@@@B@@@B@B@@@BBB@B@@BB@@@B@@@@BBB@@B@@B@@@B@@@B@@@B@@A@@@@B@@@BABAB@@@B@B@@@BB@@B@@@B@B@@AB@@@@@B@B@@@B@B@@@B@@BB@@@@@BB@@B@@B@@BB@@BBBBB@@B@@@BB@@@BB@@@BB@@@BB@@@@B@@B@@BB@@@@B@@B@@@@@B@@B@@B@@@@B@@B@@B@@BB@@BBBB@B@@BB@@@@BB@@@BB@@@@B@@B@@B@B@@B@@B@@@B@@@@BB@B@@@B@B@@@@AB@@@@@@AB@@A@@@C@A@A@@A@@A@A@@@A@@@A@A@@@A@@AC@@B@@@@@BA@B@@B@@@B@@B@@@@@@BB@@@@@DBB@B@@BB@@@B@@@B@@@@@B@@@BB@@B@B@@@@@BB@@B@@@B@@@@@BB@@B@BB@AB@@@@@B@BB@@@@B@@BB@@@@L@BAH@DKpBJ@J@F@FDBNR@L@BAHCFCDADKF@@GDCBKFID@B@PPAPHHL@@@@@B@@B\\BJ\\`BDLV@LBXAFG`@XDDFLBDPJ@@@@GDALKPOjLF@d@JGPKL@NBTIb@VATF\\H`KRCD@@@LGVcBC@@@A@ECa@O@C@ADCHOJQ@ABGBCPmHM@@HOJOB@BADCNKTGHEBALWBCBCTMJBTBHDXHBBPFrAFD@DBVHRFFDDLLRHDBD@H@TQF@NBLJVPNA@@B@YF@J@NHBAbYNIZMFA@@LOBCAY@A@ACOESACAG@AAgBADI@CJYDMAMAAIECC[CA@aCOO@EBIHEVKDADC@AB@DEBIDIF]BGFW[YACGM@I@GAS@C@U@CCKGSAKAY@CKWOMMECA[KCAUGAAcMI@A@[DE@WKGEA@@@@@IEA@AAC@CA@@CA@@C@C@AAA@@@A@CAA@CAAAEACAAAA@C@A@CAG@A@CAEC@@@@C@CBGFADCB@FAD@BC@A@ABABA@BBDDFB@@HBJFDJ@@@F@L@HAFAB@B@DD@@DBFABAC@@AAAAABAD@DABABC@@BABA@BBAB@D@BB@B@B@B@@BAB@F@@BB@@BBBB@@BB@@@B@@@@@B@@@B@B@B@@@B@B@@@@ABAB@BAB@B@@@@AB@@AB@@AB@@A@A@A@@@A@A@@@AAA@@@@@A@@AA@A@A@AA@@A@A@ABA@AA@@AA@AA@@AA@AACCAAA@AAA@AAA@A@@@A@AAA@@@A@GDCBIHCBEBOCCAOKEEIIOESEAAOKKIEGECIGMCQEMBS@SBC@YB[GAECGACIK@AGCMEQEOHILIFSACAKEE@KCGCA@@ASaACSL@@GJQMCAQAAAWEKCKEWIOEMCAAECIECCCCEOGMAAGMKGMGMGECCACAQEIC"],encodeOffsets:[[108076,26996]]}},{type:"Feature",id:"520525",properties:{name:"纳雍县",cp:[105.382714,26.777645],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@AECGGAA@D@@@B@@BB@@@B@@@B@@BB@@@@BDBB@@@BA@@@A@@@AAA@@@A@A@@AA@A@C@A@A@A@ABA@A@@@AB@@A@@@A@@@A@CB@@A@@@ADADAD@@@@AB@@AB@@@@AB@@AB@@@@ABA@@@@BA@@@@B@@AB@@@BA@@@AB@@CBA@@@CB@@@@ABA@@@A@@@A@CBA@@BA@A@@@ABA@A@@@A@@@A@A@@@A@A@A@A@@@AA@@A@@@@@A@AA@A@AACCAECCAAC@AAAAAC@AAC@E@C@CBC@CBCBE@A@C@A@C@CBABEDABA@A@A@ABEBEBC@@@A@ABA@C@A@CBA@ABCDA@A@A@ABC@@@@BEF@@MN@B@PJZCTYJGBCDCFEDGFM@@@OAQGA@ECKKECGA@@CDCHARCDAJAFADADCFKFCFDJDDBFADABCAMC@@EAAAED@@CDCNAF@BCDCFCDEBOFCH@@@DDDJFBH@H@@@N@@@DEPKL@BEHENAN@D@@@J@N@DHPLLHJBD@RAFABADMNSDEBODEBIBKHDJNFHDBB@BB@BB@LGJ@@MPEDIDSF[DYDOJKTCP@@@DHLLLBBHDJHDDHHFJBT@@@VE^ARCHBL@D@@@B@@@@@B@@BBBN@HGLADA@CFEDIBIAWCGAEAGAY@[DCB@@E@IDADEFBJBJNRBFLJLFHD@@B@FDPJTNXPHF@@DDB@@BLHHFB@HFB@BB@@@@B@@@@BB@DBDHCHGRADGRAH@B@@@@GHOL@@@@AB@BADAF@B@@@B@@B@@@D@@@D@BBB@FB@@@@BAFA@ADA@@B@B@@@D@D@B@B@D@D@B@B@B@BAB@BA@@@AB@BAB@@ABEBABA@@BADAB@@@@@B@D@D@FBB@B@B@BADC@AB@D@BAB@BABAB@@CBAB@@@D@BAB@B@BB@AB@B@B@BABABBB@@@D@BBF@D@D@B@B@BAB@BBB@@@B@B@@@D@BBFBDBBB@@D@BBB@BBBBB@D@@ABABAD@FC@@B@B@DBB@F@DBD@BBDDDDB@BBFFFD@@D@FAB@B@DBFDDBHABABCB@D@B@BBDBB@D@FAD@D@BAB@BADCDAB@@@@@FAB@BABAB@BA@@BAD@BBB@D@FBD@DBDBBBBBJFFHDFFDJPBB@@HJHBHDHBB@B@HBX@\\AZAJ@NABAF@LAD@JCPCN@TGDAGGUEMGKBOBM@KGIOI]GMIWEO@@EKIWCOACAC@EAMFSHMHI@@@ABADCRKNE@@J@FBPHFDFBFBJDVGRGB@NCTELAB@NALALAD@B@FADAzEJG@A@@HO@@DI@@BALIHAREH[@A@@DKBAHABA@@@@@@B@@@@@@@BA@@@@B@@@JCdAZF@@B@@@B@@@VFB@BBdLLDV@HKDCAaDARKLINUXc@O@AJCLEDAHC@@LEBCDCDEBG@A@KMQCA@E@E@IAILo@CBG@A@K@@A@@AA@@@@@AAA@@@@@A@@BAAA@@@AA@@@@A@@@A@@@AA@@@@A@A@@@AA@@A@@@@@A@@@A@@@A@@AA@A@CA@@@@A@@A@@@@A@@@@A@@@AA@B@@A@@@@@AD@@BB@@@B@B@@@B@@@B@B@@@@BB@B@D@@@B@@@BA@@@@@@BA@@@@@A@A@@@AAA@@@@@A@@@AA@@@@A@AA@@@@A@@A@@A@@AA@@@@AA@@@AAAAA@@AA@@@AA@@@@A@@A@@@@AA@@@@@A@@@@A@@A@@AA@@@@A@@A@@A@@AA@@A@@A@@AA@@A@@@AAAA@AA@@AA@@@@AA@@A@@@@AA@@@A@@@A@A@@@A@A@@@@BA@@@A@A@@@AA@@A@@@A@A@@BABA@A@@@A@@B@@@@A@@@A@@@A@@@AA@@@AA@A@@A@@@A@@AA@A@AA@@A@A@@@A@@@A@AAAB@@@@A@@@A@@@@@A@AB@@A@@@@@A@@@A@@@A@@@@@@@A@A@@@AAA@@@A@@@@@A@@@A@@@A@@AA@@@A@@@@@A@AA@@A@@@A@@@A@A@@AA@@@A@A@@@@@@@AB@@@@@B@D@@ABB@@B@@@@@B@@@@@B@@@B@B@B@B@@@@@B@@@@AB@@@@A@@@@AC@@A@@@@A@@AA@A@AA@@@@A@@AA@@@A@@AA@@@@@A@A@@@@AA@A@A@@@A@@A@@A@@@@@AA@@C@@AA@WGKE@@ECIAEA@@GASEQKGKCCGEMKOGKCKIGQ@@A@@@@@@@AA@@A@@@A@@@@AA@@@AA@A@@@@A@@@@A@@@A@@@@AA@@AA@@AA@@@AA@@A@@AA@AAA@A@A@@@@@A@A@@@A@@@A@A@@@A@@@A@@@C@@@A@A@A@@@A@A@@@A@@AA@@BA@A@A@@@A@@@A@AA@@A@@@@@A@AA@@A@@@@@AA@@@@AA@@A@@A@@A@@AA@@AA@@@@A@@AA@@@@@AA@@A@@@A@@@@AA@A@@@AA@@A@@@A@AA@@A@@@@AA@@@AA@@@@AA@AAA@@@AA@@A@A@@A@@A@@@A@@@A@C@@@C@@@@@A@@@AB@@AAA@@CCAAAC@AEEAAAC@I@@CAA@AAA@@@AAE@AACAECAACAA@@AAA@@AAAAAAAAE@CA@@"],encodeOffsets:[[107888,27145]]}},{type:"Feature",id:"520527",properties:{name:"赫章县",cp:[104.727418,27.123078],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@ABA@ABA@@B@BA@@B@FBD@F@FADCDCBAB@D@D@D@DA@ABC@AB@BAB@@@BAB@@@DBB@D@B@@@B@B@B@B@B@BAB@B@BAB@BAB@B@@@DDBBD@F@D@BABA@A@AAAEECCA@@C@A@AHAD@@@FA@A@A@@B@BAB@B@B@BBBBBBDAB@BBB@@BB@B@B@D@@A@@@AA@@@AA@ACAA@@AA@AA@@AAA@AA@A@A@@@A@CB@@@B@@ABCB@@A@A@A@ABADCB@@ABA@ABA@AB@@ABA@A@ABADABC@EAG@C@@@CB@BABABABCB@BCB@BC@@BADADAB@@ABABA@ABABA@C@C@A@AAA@A@A@@BA@@@AB@@@B@@@B@BB@@@B@@@DB@BBD@FAB@@@DBB@D@B@B@@BB@DABBD@B@B@D@B@D@BAB@DABAB@@AB@BA@ABABAB@BAB@@@DBB@@AB@@@BA@ABAA@@ABC@A@@@@BAB@B@B@@A@CBA@@BA@BB@@@B@@BB@BBB@BAB@B@BAB@BABA@AB@@@@AB@B@@@D@BBB@BBB@@@B@@B@@B@@BB@B@BBBBB@B@BB@@BBB@BBBBBB@@F@FDD@F@D@DBBBD@D@D@D@F@BAB@DGD@B@B@BBJBLBD@B@B@@BBDAB@@B@BB@@FD@BABAB@@BB@@BB@DBB@@B@H@@AB@@@B@B@DDDDFBD@DAB@BBD@B@@BD@B@BBB@BABBJDB@B@@@BAFA@AB@BAB@BABA@@BAB@B@BAB@@@BBB@BB@@B@@@@BBBBB@BB@BDD@BB@@BBB@DBB@B@B@B@@ABA@AB@BADE@ABA@@JIDA@ACCBCBCFKB@D@DBDAD@B@BDB@D@B@B@B@DB@D@D@D@D@BBBD@BBBDBFFD@@BBBB@@@BBDDDBBB@@@BBBB@@@B@@@B@B@@BB@B@B@@BB@@B@FCBC@@B@B@FDD@H@BABA@CBAFCD@BABA@@BAD@D@B@B@D@B@@@BAB@BCBA@ABAB@BABA@@FCDA@A@AAAECAAAA@@@CAAAC@@@C@ABA@A@A@@B@@A@AB@@@@A@A@@@AAA@A@A@@@A@AAABA@A@@@A@ABAD@H@F@BABA@@DCBABAD@B@BC@@BA@@@@@A@A@A@A@AAC@AAA@AAC@@BG@AJQLM@@@@B@BAB@BA@@B@D@BAB@@ABABABA@@@ABA@A@A@A@A@@@ABA@ABA@@@A@A@A@@@AA@AA@A@A@A@A@@@@AAAB@@A@@AAA@@@@BA@@@AAA@@@ABAB@@A@@AAAA@@A@A@@AA@@@@A@@A@@@A@@@@AAA@@A@AAA@@@A@AA@@A@@@A@A@@@AA@@AAAACAAA@C@A@@@@BAB@@@@@@A@@@A@A@@@@@A@A@@@@BKBG@A@@BA@A@C@@BAB@BAB@BA@AD@@@B@B@@@B@@@B@@ADABA@AB@@ADAB@B@BAB@BAB@B@B@BAD@@AB@B@DAB@B@D@B@D@HEB@BAF@B@BAD@BAD@@AB@@@BABADABABA@@@CB@@AB@@ABABADAB@BABA@@@@BAAA@@@CBA@@BA@ABAB@B@@A@@@AAA@A@AB@@ABA@@BC@@BA@@@@BA@@BADAB@B@HCDAB@B@BA@@@ABA@A@@@C@ABE@C@CBA@CBCB@BABADABAB@BAB@BA@@BAB@B@@@FCBABA@@B@B@@@D@BAD@D@F@D@BAB@@BD@HAD@BA@C@A@A@ABC@AAC@AAA@CCC@@@CB@@@EAA@AAC@@@C@@@A@@@@A@@@ABEBC@ABA@@@@PKHG@@@@@ABGHQBCHQDGCGCAA@@A@@A@@@@@AAA@GEA@GEKG@AA@CC@@GEWOSMOIECA@@@GCKEKIAEMQAIAIFEBCJCF@@@DA\\CZ@HBFBHBXDJBJAFCDEB@BCHK@GAMAA@@@A@@@@@A@@@CAKG@M@MC@@EASISECAOCAAMGCEGGOQKQIQIQEO@@EKKMGGKEKBG@UNKHCDCBOLKNCPCNGLGJIDIDA@KAQIGIAOGKIIIEECA@KKEQ@@CQKOMIGGGCGEECCCAAIKGMA@@O@G@E@IBGEDEDKDCBIDQHEDGDONMJED@L@J@NCP@FCRATGP@BKFAAKCIIIGMGQCMAGH@P@NADCJKDFH@BBDBD@BFJFHHLDJ@D@F@@@B@B@@AB@BAFILGH@HCHADEDCBMBKCII@@IGIAA@IBEPBNBPIPADONQHMAAACAA@ECOAQA@@]BG@Y@A@SBKBQDGB@BGJCLCFELELEDEBE@M@O@QPKJIJ@@A@E@E@UCSD]PMJ@@OAGEGM@EAIAI@@GGMAMDKH@@@@IJCNAJ@DBJ@@@L@PBB@B@BDBJHFDHRBPEL@@EHWBADCDL^GH@@QHIJAD@LPRBFBFIJKDCRAFN\\@JEBGBIBEDCDKd@J@@@DPjILCBEBIFABEBQb@@QbIAg@A@OAkGE@]IIB@BA@A@AB@@@BAB@@C@@@A@CBABC@ABABADA@CFA@ABBD@@BB@@BB@@BBB@B@@@B@@BB@BB@@BBB@@BBB@@BB@@BB@@@BBBBB@DAHBFBB@@B@B@@@B@BABAB@@@@AD@BABCBAJED@HBD@@@DB@@B@D@@BBFAF@B@@A@@B@@AB@@@B@@@@@BAB@@@@ABABA@@BA@@@AB@BA@BBBBDDB@PCDABCDCDC@AB@DBB@@F@@FBDBHDB@D@@AB@BA@A@A@@B@BE@A@@B@BBBBB@BB@@@B@@@BAB@@AB@@AB@B@@@BDBD@BB@DDBBBBB@@ABABCD@B@BABAB@@ABA@ABAB@@ABBB@BB@@B@B@@@BC@A@A@@B@BDD@BADAF@B@BFBHD@B@@@HB@B@BB@B@DBBBBB@DBD@@@DDDH@DBBBB@@D@DAD@B@BBB@BB@D@FAD@BAD@BDBB@@@@A@G@AD@B@DBDBBB@@BB@@BBB@@@@@BB@@B@@@@@B@BB@@@B@BB@@B@@@B@@B@@B@B@B@B@@B@@B@B@BB@@@@D@@B@@BB@@@@@@@@BBBBB@DAB@DBFBFDDBB@DB@BBJ@J@@@D@@@D@@@@DDB@@DBB@BB@B@@@BA@@B@B@@FHBFFFBB@B@B@@ABA@@B@@BB@@B@FCBA@A@C@@B@@@B@BBAF@D@BBD@BABCBEBABCD@@@BA@@B@BBB@@BBDB@@D@@BDBB@BBBB@BB@BB@@@B@BB@@BBB@B@@BB@@@B@BA@@@ABA@@@BBBBBBB@BBD@BBB@D@B@B@D@B@BBBAB@DABABAB@@ABAB@@@B@B@BBB@@@@@BBB@B@B@B@DAB@BAB@FAB@BADAB@J@FCDCB@BADBDBFB@@B@@CACCE@CBGHC@@BCBIBAD@D@D@B@H@DABADA"],encodeOffsets:[[106939,28091]]}},{type:"Feature",id:"520526",properties:{name:"威宁彝族回族苗族自治县",cp:[104.253071,26.873806],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ABEBA@@BCB@BAB@@ABABABA@AB@B@BA@AB@@@B@D@BABAB@BCB@BADAD@FAB@FEB@BAB@@@B@B@DAB@@A@ADCBABC@@@AB@@ADAD@BABABAB@BA@@B@HA@AD@BABABCBE@ABABAB@B@DBD@D@D@BBB@DDBBB@FBDBD@D@B@DBB@B@@@BA@ABA@ABEBAB@@@@AB@H@FCB@B@DA@@DABA@ABA@C@C@AA@AA@A@A@C@AAC@@@C@A@ABADA@ADABA@@@ABA@A@@FAB@@A@A@ABA@ABABA@A@A@@DCB@@AB@@A@AB@BABA@@B@@AB@@AD@BABABCDADCFEBA@AB@DA@AB@BABABAB@@@BAB@DEB@BCBABAD@BADAB@@@D@@@BA@A@@BAB@B@@AJA^JF@lHPBB@h@JBRa@@RaFABAJEFADAJKOi@C@@@ILcDCFCJAHAFA@IM[BEDQLCJIAEAEOQ@KBCJIRG@@HGK]DCBCXAFG@@FKAOGQECIGCA@A@AAA@O@K@@AI@CBIDMJI@@@@LGNCNBHH@@BJBJ@FHNHFPB@@NI^OTCVDF@F@B@@@JILIROP@N@F@FAFCFKFKDEDKHI@AHARCLATAB@Z@H@^A@@RBPBFDB@DBBBNBRGPMBCJOAOAMFOJAB@JBJH@@JJLDNADAFCBCDG@GHGJKBE@ABA@@@A@A@@@E@CCIGKEGEI@AACAC@AEGCIAICKAG@CAG@AAE@KAGGIGEGGKEGEACIGCECEAAAC@C@CBIB@BGBADGBEBA@IACAEECACAAAAAA@AA@@@CECEBGFGFCNARCPCNAJAN@JBJBB@FDFDFH@@BB@B@@@@DD@BDDFHFHHHDFFFBBBBBH@JBDFHFDBDFFZ\\@KFCNIPMHCFCRGJCDALCFCFCBUBUBABI@@DI@IAWII@GDCFEBCBE@CACAGIEGAKACOAQ@CBSAM@@@E@AAKKKECMEGEKGGCEAOKQIMM@CBQFW@S@A@ABUAK@A@CGKMCE@C@KIFK@@@ADEBABC@ESEUB@@A@WFA@KFODUHQH@@A@]HOH@@A@A@C@ABAAAAEAC@E@BB@DB@@D@BBDDFB@@BBBBBBBBD@@AD@BAF@@BDAB@@@B@B@@A@C@C@A@CB@@@B@DB@@F@BAFCBCD@B@@CBAB@BEBABAB@@CBCBA@A@AAA@A@A@@CAAA@A@A@CAAAA@@@CBABAAE@A@EBA@A@A@ABA@@@CAA@C@CBC@@@C@A@A@C@A@AAA@A@A@@BABABA@A@C@A@AACA@@C@@@@A@A@@AAAAAAEACC@@A@A@A@CDCBABA@CD@@C@@@AB@BCBCDCBADA@AB@@A@@@@BA@@B@@ABAD@B@BA@@@@BA@@@@B@@@@@D@B@B@B@@@BAB@@@B@@@B@@@@@B@@@BB@@@@@@@@B@B@@@B@@@BB@@B@@@B@@@BA@@B@@@@@@@B@@@@@@BB@@@@@B@@@B@@@@@@@B@B@@@@@@B@@B@@DB@B@@BB@@@B@@AB@@BBB@@B@B@@@@@B@B@@BDBD@B@@@BBB@@AB@F@B@@BBAB@@ABCBA@A@A@@BAB@F@BA@A@AB@@@BBB@BA@@@ABA@@@@@ABABA@@@ABCBEBCDCBAB@B@B@@@BABBBBBBD@B@@AB@B@@AFCBADABCBA@@D@DAB@@@B@@AB@@A@A@A@AB@@AB@BABA@A@A@@DA@@BB@@D@BADABABA@@AA@CAA@@AA@@BABCB@@A@A@A@CAAA@A@@E@A@@@C@@@AAAA@@@A@C@@@AAA@A@ABE@A@A@A@AAA@C@A@CBCA@EEAAACAA@A@A@@@@AAAAA@@C@A@AA@AAA@@AAAACA@ACAA@CBA@ABE@@AA@AA@@@@@BA@@AA@@@CAA@AA@CC@@A@@@C@C@@@AA@AA@@@C@A@AAA@AA@CAEAA@A@ABA@A@AAAA@@@C@@AAAACAA@@@C@@A@@ACC@AA@A@C@A@@A@@@@A@EBA@@@A@AAA@@@A@ABA@A@AAC@A@@@A@A@@@E@AAGCA@A@@@AA@AA@A@AAA@@@A@@@A@@@CA@@CBA@A@AB@@AA@B@D@D@F@@@B@@@B@BA@ABAB@@ABAB@@ABABA@ABC@ABCBC@C@CBC@C@C@C@C@A@CAA@@A@@AC@ABA@A@A@CAA@C@ACAAA@@CAC@A@@@C@ABA@ABA@@@CA@@ABAD@@ADABA@@@A@AD@@C@C@CBA@A@@@AAA@A@ABAAGCAAACAA@@A@@AA@@BCBA@C@AAA@A@ADA@A@ABABA@A@CBEBA@A@AAA@ABA@CBEFA@@@ABA@@B@@AB@@A@A@@BA@@@@B@@@B@B@@@BA@ABAAC@A@CCAA@@A@CBA@C@EAA@CD@BCDABCDC@GHC@A@C@A@E@@AEE@@A@C@CAC@A@GBE@IBA@AACCCA@@EC@A@AA@@CCAAC@AACACCEBA@AA@A@A@@@ACA@@AAA@AAAAACA@A@@@C@@@A@@@C@ACAE@AAAAAA@A@A@A@@EC@@BA@AAAA@AAAA@AAACA@CAA@AA@CAAA@AAA@@AAAA@@CAAACACCA@@BCA@@AA@A@C@AB@@@BAAAAAAA@AAAAAACACB@@ABA@A@ABCACBABCBCB@@@AC@ABCBA@@@AAC@ADG@A@AACBG@A@AB@B@@@@A@@AC@@@A@@AAC@@AAAAA@AAA@BA@CAAA@AA@AA@@C@CAAAAAAAC@G@EJEDG@K@K@GAA@OAK@GDAF@@ABABAB@@ABA@@@C@@@CAA@AAC@AAA@@@AAA@A@AAA@A@A@ABA@CBAAA@A@C@AAA@AB@@A@ABA@A@CBAB@@A@A@CBA@A@A@ABA@AA@@A@A@CAA@A@A@AAC@A@A@C@AAA@C@A@A@CAA@A@A@@@A@C@A@AAA@AAA@AAA@A@AAA@A@A@A@A@A@CBA@A@@@@@A@@@A@CB@@@@A@@A@@@@A@@B@@@@@@A@@@@A@@A@@@@@@@@@A@@@@@@@A@@@A@@@@@@B@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@@@ABA@@@@B@@@@@@A@@@@@A@@@@@@@@@A@@B@@@AA@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@A@@@@A@@@@@@@@@@BA@@@@@@@@A@@@@A@@@@@@@@@@@A@@A@@@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@A@@A@@@@@A@@@@@@@AA@@@@A@A@@@@@A@@@@@@@A@A@@AA@@@@@A@@@@@AB@@A@@@@BA@@B@B@@@@A@@B@@AB@@@BA@@B@@A@@B@@A@@@A@@@A@@BA@@@@@C@@@A@@@@B@@@@A@@@AB@@@@@@@@@D@@AB@@@BA@@@@BA@@B@@@@A@@@ABA@@@A@@@@@A@@@@@@BA@@@@@C@@@A@@@@@A@A@@AAAA@@@A@@@A@A@C@A@@@@@AA@@@@A@ABA@@@A@@@AA@@A@@@@@@@AB@@@B@@@@@@@@@B@@@@BA@@@@B@@B@@@@AB@@C@@@@@A@@@@@@BA@@@@@@@@@@AA@@@@@@@@@@@@@@@@B@@@@A@@@@B@B@BBB@@@@@B@@ABA@@B@@@@@BA@@@@@@BAFB@@@@@BB@B@@@@@BAB@@@BB@@@BBBB@@@@@B@@A@@@AB@@@@A@A@@@@@A@@@@@A@@BAD@@@@A@@B@@@@@BA@@@ABAD@@A@@@@@A@@@@@A@@@@B@@@@BB@@@@@B@@@@A@@@A@@@@@@@@@AB@@AB@@@@A@@@A@A@A@@@@B@@@B@@@@AA@@AB@@@@A@@@@@@@@B@@ABA@@@ABA@AAABA@@@ABA@@BC@CB@@A@AB@BA@A@@@AB@@@B@@A@@BA@@@@@A@A@@BC@@B@@A@@@@@A@@B@@A@@@@@@B@@@@AB@@@@A@@@@@@B@@@BB@B@BB@B@B@B@BDBBFBB@BADAB@DBBA@ABABCB@BAFCDADAB@@A@ABA@AB@BA@A@CD@@CDABAB@B@BBD@B@BBFBDDH@BBBBBBDBBBBDFBB@D@@@D@DAB@D@@@B@@BDADCD@B@DBD@B@D@BBB@B@D@B@B@BAB@B@B@D@@@D@DBD@BB@DDBBDBBBBB@BBFAB@D@B@B@BBDBDBDBBBD@B@@BBAB@B@BAB@D@B@BAB@BADAB@D@B@BCBABCBC@AACAAAAAAACCAAACCCA@AAA@A@ABCBCBC@C@AB@@@DABABEFA@ABABADABABAB@B@D@B@DADBB@D@BA@@@ABABCDAD@@@B@D@BBB@B@@@D@B@D@B@DABCFADA@ABCFAB@BAB@BAD@@AHABC@@@@B@DAD@B@D@B@BAD@B@B@B@BAD@B@DBBDB@B@B@BBDABBDBB@B@@@@@B@B@@@BBBD@D@D@B@BBBBB@BDBBBBDD@@BF@B@BAB@@@B@B@BAD@@@D@@@@BBBBDBB@HDD@F@B@DBB@B@B@B@BBB@BB@@B@@BD@D@D@BBB@@BBB@BFBBBF@BBB@D@FAB@D@DBDDBB@D@BBBB@@@BB@@@BADAB@BABA@CDCBABADADAB@D@B@D@BAD@D@BAB@BABADAB@B@@@FBB@B@B@BBD@BDFDFDFBBDHDFBD@@BB@D@F@D@B@B@BCFADABABABA@ABC@@@A@EFA@CDA@EDA@CD@@A@@D@DBD@DAD@BABCHAB@BAB@BAB@BAD@BAF@BABABADCDCFEDAB@BABAB@FAB@BAD@BBB@B@B@DAB@BCB@BA@EFCDABCFCDA@@@EDCDAD@B@BAD@BABGF@BA@A@A@C@C@ABC@A@CBG@C@@@E@A@C@A@C@E@A@ACA@AACCCEAEAAAAA@CAECCCAA@AAA@ABA@C@AACAAAAC@A@AAAAAAEACAAAEEA@AAABCBCAAAAA@@A@ECCC@@AAABC@A@C@AAA@@ACB@@@@@BBB@BBBF@DDHHD@B@D@@@BB@@CFAF@B@@A@@B@BAB@B@B@@@BBB@B@BAB@B@@ABA@A@AAAB@AA@ABABA@AB@BABA@AB@BABAB@BCDAB@B@FCHGJ@@@B@BBBB@F@BB@@DD@BBD@BBD@BBD@BBDBBBBD@B@B@@AD@BAFCFCBAB@B@DAFCD@B@B@DADABA@@DAD@B@D@B@DB@@D@B@DAB@@B@B@@BF@@@BAB@D@BAB@DABABADAH@D@DAB@BA@CBC@AACA@@A@GAQB@BEDCDC@EBC@@@@@@@@B@B@BDFBJ@D@B@@BB@@BBBD@@@BB@@BB@@BB@D@BB@BBBD@BB@@D@D@@BDAB@B@BB@BB@B@FDF@D@DABA@@B@BAD@BBBB@@BBBBD@BBBBB@B@BB@@BB@@BBD@B@B@B@DA@@B@@@B@B@B@B@B@B@B@B@B@@AB@B@BABAB@@ABB@@@BBB@BBBBDBBB@DBH@DBBBBF@DGZAF@@@BBB@@@BB@@@B@B@B@B@BAB@B@B@B@BBBBBBB@@B@@BD@BBBBD@BBDBBBB@DBD@BBBBBBBB@B@BBB@BBB@@@@BB@@B@B@@@B@D@BBB@B@BBB@@@BHDDBBD@@BFBLBDH@L@F@DBBBBFBBB@@BBBBB@@BB@@BBD@F@D@@D@B@BCBABA@@B@@BBB@D@B@D@B@@D@BBB@BBB@BBBDB@@BBBB@@BB@@BB@B@BAB@D@B@@@@AD@@@B@D@@B@B@FGHCB@B@BF@HAR@DDD@@@@DBD@@@B@@@BAB@D@B@@@B@B@D@BA@@BAB@@@BAB@B@B@B@B@B@BBB@@@DBB@D@B@BBB@@BBB@BB@@BB@BBB@BBB@@B@B@B@B@BAB@B@BABB@@BBB@BBBBBBBBBB@DAHAJ@DAB@@ADA@ABAB@B@@@@FAF@DBD@P@H@BBBF@F@@@@D@B@BAB@BBBFD@D@D@BCD@B@D@H@BBD@@@BB@@B@@@@@@B@@@B@@@@@@@B@B@@@@AB@@@@@@@@@@@@@BB@@@A@@B@@@@@@@B@BAB@@@B@@@@B@@@@@@B@@BA@@@@@@@B@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@B@@@@@BB@@@BB@BBBB@@BBB@@BB@@@B@B@@@B@@@@@@@B@@@B@@@B@@@DB@@@@@BB@@@@@@@B@@@@B@@AB@DADB@@D@B@D@H@B@DBD@D@BABBD@B@B@F@@@H@D@D@DBBBD@@@@BB@@D@D@D@@B@BBNBFFHDD@@@B@B@BBB@BB@BBB@@BBBB@@B@B@@BB@@@BAB@B@B@B@B@BBB@B@D@B@BBB@BBB@B@DBB@B@B@BBB@DBB@BB@B@@@B@@@@AB@@ABAB@@A@@BA@AB@BAB@@ABAB@@AB@@AB@D@B@B@B@@@B@D@D@BA@AAE@C@@BAH@HADAB@B@B@B@B@D@B@@@B@@AB@BA@@BCBA@AB@@AB@@BB@B@BBB@B@B@B@@ABB@@@@@@@@B@@@@@BB@@B@B@BBBB@@BB@@@B@@DBB@@@@B@@@@@B@@B@BBD@BB@@@@BB@@B@@@@@@BB@@@B@@@@@BA@@@@B@@@@@B@@@BB@@@@B@@@@@BA@@@@@@B@@@B@@@@@@@@@@A@@BA@@@A@@@@@A@ABA@ACA@A@@BC@A@A@A@@@A@A@A@@B@BAB@@@BA@@@@@A@A@@@@@@@A@A@@@A@A@A@AAC@A@CB@@A@@AA@@@AAA@@@A@@BA@A@@BAAA@A@AA@@CAA@ABA"],encodeOffsets:[[106515,28040]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
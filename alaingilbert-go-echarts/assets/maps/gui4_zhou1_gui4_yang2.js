@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("贵阳",{type:"FeatureCollection",features:[{type:"Feature",id:"520102",properties:{name:"南明区",cp:[106.714374,26.567944],childNum:1},geometry:{type:"Polygon",coordinates:["@@BA@A@ABCBC@@@CC@@@@@BAA@A@@AA@C@@BA@AHAF@@@@@B@@@@@DA@@@A@@@@@C@@@A@@AA@@@A@A@@@@@@@@B@B@B@B@B@D@B@BA@AB@D@D@@@@@B@B@B@B@BAB@B@@@@@B@B@B@B@B@@@B@@@B@@@@@BA@@D@B@B@B@B@DAD@B@@BB@DAB@@BF@@@D@@@@BB@BBBAB@B@@BB@@@B@B@FB@@B@@@B@B@@@B@B@B@B@@@D@BB@@B@@B@AB@B@@A@C@A@@AA@@@AA@@@A@@AA@@A@@A@@ABA@@@A@AA@@@@AB@@BB@B@B@@A@AAA@A@@BAA@@A@@B@@@B@@AB@@AAA@AA@@AA@@A@@@@A@@C@@@ACA@@CA@@A@AA@@@@@AA@@B@BA@@@@@A@@AAC@@A@@AA@C@@AAA@@A@B@@@BB@@BA@@@ABA@@AAA@@A@@B@B@BA@AAA@A@@AA@@AA@@@@@@A@@@AA@@@@@@C@@@A@@@@@AB@@A@@@AAA@@AAE@@@@BA@A@@@@@@@@@AC@A@AA@@@@@@DAB@@@D@@@@@DA@AA@@@@@C@@@C@C@@A@A@A@ABA@@@@BA@@@@@AB@B@@@@B@@B@@@B@@@@@B@@AD@@@@@@@B@B@B@@ABA@@D@D@@@@@B@@@BAB@B@@BDA@@D@@@B@DBD@@@B@B@B@B@@@BA@@@@B@@BB@B@B@BBBA@C@@AAB@@@@@@@B@@@@@@@B@@@B@@B@@@AB@@@@@DBB@@@@@BB@@BBB@@@@@B@@BB@@@@B@@A@@@@@@B@@B@@@@@@@@@@BA@BB@@@ABAD@@@@BB@BB@@@@B@B@@@B@@B@@@BB@@BBB@@@@@A@@BA@@@@@BBBBB@@BBB@@@BB@@@@@B@@@@B@@BB@B@@BAB@B@B@B@B@B@@@B@@A@@BA@A@@@ADABA@A@@@@B@@@@@@B@@B@@@@@@BB@@@@@B@@@@@B@@@@B@@@@@@A@@@@BB@@@@@@@@@@@@@@@@B@@B@@@@@@A@@@@@@FA@@B@@@BA@@@@BB@@BD@B@@D@B@@B@@@@@@@@B@@@@@@@@B@@@@@@B@D@@@B@B@@BB@B@B@BA@@BB@@D@@@D@@@D@F@B@@B@@@@B@@@B@@@B@@@@@B@@@@BB@@@@A@@B@D@B@@@@@@@@@B@@@BDBB@B@B@@@@@@B@BA@BBA@@BA@A@@B@B@@ABAB@DF@@BB@@@B@B@@@B@@B@B@@@B@D@BAB@@@B@B@BB@@D@B@@@BBB@B@B@B@B@@@DBBBBB@@BA@@@@@@B@@@BA@@@AAA@@B@@A@@BB@@@AA@@@A@A@@@@AA@@BA@@@@AA@@@@A@@@A@@@ABBB@@@@@B@@@B@@@@@@@@@B@@AB@@B@@B@DCB@@@B@B@BAD@DA@@B@@@B@@@B@B@B@B@@@@@B@@A@@@@B@@AB@@@B@B@B@B@@@@@B@@A@@BA@@@@@@@@A@@@A@@@@A@@@@@A@@@A@@@@B@@AB@@@@@@@@@D@@@BA@@A@@BA@@@@@@AB@@@@@@A@B@@D@@ABB@ABB@AB@@@A@@@B@@@@@B@@@@B@@@@@BB@@A@B@@B@B@@B@@@@@@@@D@@@@@B@@B@@B@@@BB@@@@C@@B@@ABB@@@@@@@A@@BA@A@ABA@@@@@@@B@BB@AB@@B@@B@@@@@@@@B@@@@B@@@@@B@@B@@@@AB@@@A@@@@A@@A@B@BA@@@@B@BB@@BA@@@B@BBB@@A@@@@B@@@B@@@@@BBB@BBB@@B@@@@@@@A@@@@@@@@B@B@B@DA@@@AB@@@@@@@@B@@@@@BB@@B@@@@ABA@AD@@A@@@@B@@@B@@@B@@C@@@@B@@@BA@@@@@A@@@@@A@@B@@A@@@A@@@@@B@@@@@@B@@A@@@@@@BB@@@@@@@@@@B@@AB@@B@B@@@@@B@@A@@@@@@BB@@A@@@B@@@B@@@@B@B@@A@@@A@AA@B@@@@@BA@@@A@@A@@@BA@AB@@BB@@B@@@BB@@D@D@DBB@B@@@FBBBB@BBB@@BDB@@BB@@@@BB@B@@B@@@BB@@BBB@@BB@BB@@@@BB@@@@@BB@B@BBB@B@B@@B@@B@@BB@BB@B@@B@@@B@B@DB@@B@@@@AB@@A@@@@@@BB@BB@@@@B@@@@B@@@@A@A@@@@@AD@@@B@@A@@B@B@@@@@@@B@B@B@BA@@@@@B@@@B@@B@@@@BB@B@@@@@@ABAB@@@B@B@@@@A@@BA@@BB@@BA@@@@@A@A@@@@B@@@@@B@@CB@PBL@B@F@FA@@@@DAD@JEFGBCBE@E@A@EAACCAC@@CCCAAAA@@@EAC@EAEAE@CAECEECAEEOAO@@AO@G@OGECAA@@D@D@BAAAAC@@A@ABA@@@C@A@ACACCCAC@@@A@@BCBEBA@@@@@A@@BA@A@@@ABA@@@@B@@A@@@@@@B@@@@@@A@@A@@AA@AA@@@AD@D@F@@@L@BB@A@@@@AA@@@@CACAA@@@BABA@AAAA@A@@AAAAAC@AAAABABADAB@@@@A@A@@A@@@@AA@@@@@A@@A@@@@@A@@@A@@@AB@DA@@AEAE@@@@CECAICC@KEIGMIIE@CCGAGDIFEFEJEJEHAFGBECGGCG@IAECDB@BA@@BA@A@@B@@A@@BA@AAAB@@@@@@A@@@@@@@A@@@A@A@@BA@@AAA@@@A@@BB@@@@@@B@@@BA@@@@@A@A@@A@@@A@@@AB@@A@@@A@@BA@@@A@@@B@@B@@@B@@A@@@@@A@@@B@@B@@@@@@@B@@BB@@@@@@@@B@BB@@@@@@@@A@@@@BA@A@@@@@AA@@@BA@@@@@A@@@A@@@@B@@@@@B@@A@@@@B@@AB@@@@A@@A@@@B@@@@@@B@B@@B@@@@@@A@@B@@@@@@@@@@@@@BB@@@A@@@@B@BA@@@@@A@@@@@A@A@@@B@@B@@@@@@@B@@A@@BA@@@@@@@@@@@B@@@@@@@@B@@@@EB@@AA@@A@@@@B@@@@@@@@@B@@B@@@AB@@@@@@@@A@@@@@@@@A@@@A@@@@@@A@BBA@@@@@@@@B@@A@@@@@A@@BB@A@@@@B@@@B@@@@@@@BA@@BBB@H@D@@A@A@@@@@@BB@@@AB@@@@@@BB@@@B@@BB@@@@@@ABA@@@@B@@A@@BA@A@BAAA@@A@@@@@ACAAA@@@A@@@@@A@@@B@@@@BA@@@@A@@A@@@@@@@@@A@@B@@@@A@@A@@@@A@@BAAA@@@B@@@A@@AA@@@@A@@@A@A@@@@@@@@A@@@@B@B@@@A@B@@@@@@A@@@@A@@@@@@A@@@@@@A@A@@@@@@@AA@A@@@@A@@C@@A@@@@A@A@@A@BA@@AAB@@@@A@@@@A@A@A@@@A@A@@@ABA@@@@@@BA@@@@@@BAB@@@@A@@BAB@@@@A@@@@@@@@A@@@@@AB@@A@A@@@AB@@A@A@@@A@@@A@AB@@@@A@A@C@@B@@@@A@@A@@@A@A@@AA@@@A@@@@AA@@B@BA"],encodeOffsets:[[109304,27101]]}},{type:"Feature",id:"520103",properties:{name:"云岩区",cp:[106.724494,26.604688],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@@A@A@@A@@AAA@@@@BCBA@@A@@AAB@@@@@@@@@@@AA@@@@@@@@BA@@@@@AA@@@A@@@@AA@AA@@A@@@@AA@C@@@@A@AA@@@@@@@@AAABA@@BCB@BA@C@@@B@@B@@@@A@@@AB@@@@A@@@A@@@A@@@@@@AA@A@A@A@@AA@@@@@A@@@A@A@@@@@AB@B@@ABBB@@AB@B@@A@A@@@@@A@@AA@@@AA@@A@@B@@@B@@@@A@A@A@CB@@AB@@@@A@@@AA@@A@C@@@@B@@@@A@@@A@@@@A@@A@@BA@@@@@@B@@A@@@@@AB@@@B@@@@B@@@@@@@@@A@B@@@@B@@@@@B@@@@@@@@AA@@@@@@@@@@@A@@@@@@A@@@@BAB@@AB@@@B@BAB@@@@@@@B@@@AA@A@@@@@@B@@@@@B@@A@@@@@@@@BA@@@@B@@@AB@@@@@B@@@B@@@@@@@@D@@A@@@@@@B@@@@A@AA@B@@@@@B@@@@@@@AA@@A@@@@@@A@A@@@@A@@@@A@@BA@@@@@A@@@@@@@A@A@@@@B@@AA@B@AA@A@@@@@@@@B@@@B@@@@AA@@@@A@@@@B@@BBBB@@BBB@@B@@@@AB@@@@@BA@@@A@@@@@@B@@@@A@@@B@@B@@B@@@@@@B@@@@A@B@BBB@BBB@@BB@B@@@@B@@@@B@BB@@@@@@A@@@C@@@@B@@B@@B@@@@@A@@B@@B@@@@@@@@@B@B@@@@@@B@@@@@@BA@@B@@B@@@B@@@@@DB@@B@@@B@@@@B@@BA@@@@B@BB@@@A@AB@@@@@BBFFBB@@@B@@B@B@@@B@@A@@@@D@@@@@@@@AB@FC@@@@BABB@@@A@A@@@A@@B@B@@@@AB@@@BBB@@AB@@@BA@A@@@@B@BB@@BA@@BB@@@@@@@B@@@ABB@@@@A@@B@@B@BBBB@@@@B@@@@@@B@@@@A@@@@@@@@@BBBAF@@AB@@@@@@@B@@@@B@@@@@@@@BB@A@B@@@@BB@B@@@@B@@@@A@@@@@@B@@@B@B@@B@@BB@@@@@@BB@@@@@B@@B@@@@@@@@@BB@@@@D@@@B@@@@@BA@@@@@@BB@@@@@BBBBA@B@@BA@@@@B@B@@@@@@A@@@@AAB@@@@ABB@@@ABBD@@@BA@@B@@@DB@@@@@B@B@@@@@@B@D@B@BA@@@@@@@B@BBB@@@B@@@@@B@@@BB@@@B@ABBBB@B@B@B@@@@B@@BB@B@@@BB@@@BB@BBB@@@BAB@@A@A@A@@@@B@@@B@B@B@@AB@D@@@B@B@@BBB@B@@BB@@@AB@@@@BDBBBDB@@D@@@@@B@@@BBB@B@BBBBDB@@@DAB@@B@@BBAB@@@BA@@@AB@@@@AB@B@@@@@BB@@BB@BA@@BA@@B@@@@@BB@B@@A@@@@B@@@B@BB@@B@D@@@@BB@ABA@@@@@B@@@B@@AB@BBD@B@BBB@BBBBD@B@@BB@@B@@AB@B@B@@@B@BA@@@@@B@@BBB@B@B@BABABAHAB@B@B@@@ACCA@@ABC@@AACCGDB@@AA@A@@@@B@B@@@BBBB@@B@@@@A@@@@@C@C@A@@BC@A@AA@@@A@@@@BA@@@A@@AACB@@A@A@@@@BA@@AC@ABA@@@@BA@ABA@@@A@@@@BA@A@@@@@AB@@@B@@@BABABAB@B@B@@@BBBB@@@A@@@@AA@@@@AAA@A@AAAC@@@ABABABABABA@@B@B@@@BBB@B@@@@@@AB@@@B@BA@@@A@@@A@@B@@AB@@@@@B@@@@@@A@@@@@@B@@@@@@AAA@@A@@@@@@A@@BB@@@@@AB@@@@@B@@@BABA@@@AA@@@@@A@@A@AA@AA@A@@@@@A@@@@@A@@@@BA@@@@B@@@@@D@@@B@@@B@@@@A@@B@@B@@@@BA@@@AB@@@B@@@@B@@@@@@@AB@@@B@B@BB@B@@B@@@B@DB@@@@@@@B@@BBBBBBBDBB@@B@B@FA@A@@B@@@@AB@@@@@@A@B@@B@@@@@@@@AB@@A@@B@@A@@B@B@B@@AB@@@B@@@@@@A@@BA@@@A@A@@EA@@AAA@@A@@AA@AA@@@A@@AAA@@@@AA@@@A@A@A@@@AB@@ABA@@B@@@@@@@B@@@@A@@B@@@@A@@@@CA@@AA@@A@A@@@AA@@@@AA@@@BA@@@BB@B@@AB@@A@@@@@@@AB@@AAAACA@@A@A@A@A@A@AA@@A@C@@@AAA@A@@@A@ABC@A@@@A@A@@@@A@@@A@A@@AA@@CEA@AB@BA@A@@@@BAB@@AB@AABA@@@@@@@@A@AAAAC@@A@@@@@@@@@A@C@A@@@@B@@A@@A@@A@@@@@A@@@A@@@A@@@@@@AA@E@C@@@C@@@C@@@AA@@ABA@A@A@@AA@A@@@C@A@@@@@@@@A@@@@@@A@@@@@@@@@@AA@C@@@@AAC@@AA@@@@AB@@A@@@EB@@@@@@@B@@@@A@@@@A@@@@@@@@@@@@@@A@@A@@B@@@@@@@@A@@A@@@@@A@@@@@A@@A@@@@A@@@@A@@@@A@@@@@@BABCB@B@@@BAB@@@BA@@@A@A@A@A@A@A@AB@@@AAA@@@A@@A@@@@@A@@A@@AA@AA@AAAA@@@@B@@AB@@@@@A@AA@@AA@@A@"],encodeOffsets:[[109229,27204]]}},{type:"Feature",id:"520111",properties:{name:"花溪区",cp:[106.67026,26.409817],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@@@D@D@@@D@@@@BBB@@C@@@@@C@@BA@C@@@@B@@B@BBD@@@@@@@@B@B@@A@@F@BB@@BB@B@@@BA@@B@@@@@B@@@D@@@@B@@B@@@B@@@@B@@BB@@BB@B@BBB@@A@A@AB@@@BB@BB@BA@@B@@AA@@A@@@A@BB@BB@@@DBB@@@BD@BB@@@B@@@@ABA@@@BB@@@@B@@B@BB@@DB@BD@@D@@@@B@@B@@@BB@@BBB@BB@@BA@@@A@@@AB@@@BB@AB@B@BBB@@@@A@AAA@@BA@@@@BBB@@@B@BA@@@BB@@@BB@@@B@@BB@@B@@BB@D@B@@@@ABAA@@@@AA@@A@C@@@A@A@A@A@@@A@A@@@AA@@E@A@A@@AA@@@ABAAA@AAA@@@@@C@@AE@@BA@CAA@@@ABC@C@A@A@A@A@CB@@A@@@@@A@@@A@@@A@A@A@A@A@@@@@ABA@A@A@A@A@A@@@@@C@CBAB@@A@A@C@A@A@A@A@A@@@@@@B@B@@@B@@BB@@@D@@@@@B@@@B@@C@@@@@A@@@@BEBGB@@AD@B@@BB@B@AB@@@@D@@D@@ADAD@B@BABABA@@@BB@@@@@B@@BB@@@B@B@@@BB@@@@@@AD@B@B@@@@@BAB@@@B@@@B@B@@@BA@@B@B@@@BA@@@@B@@@@@@@@@@B@@A@AB@@@B@@A@AB@@@@@@AB@@@@@@AB@B@@@B@B@@@B@B@B@@B@@@@@BA@BB@@A@BB@B@@@@@@BD@@@@B@@B@B@@B@@@@@@@B@B@@@@B@@@@@@@@B@@B@@@@@@@@A@B@@@A@A@@B@@@@@@@@@@B@B@@@B@@B@@BB@@@A@@@B@BB@AB@@@@@@BB@@@@@@AB@@@@@@@@@B@@@@B@@B@@A@@A@@@B@@@@@B@@@B@BBBD@@@@B@@@BBABB@B@@AB@@@@A@@B@BA@@@@@@AA@@@A@@AA@@@@@@BA@@A@@A@@@@B@B@@@@C@GAA@AB@@A@@@@@@@A@@@A@@B@A@@AB@@@@@B@@@@A@@@@@@B@AAB@@@@@@@@B@@@B@@@@@@B@@@@@@@@@BA@@A@@@@A@@@@@@@@@A@@B@@@BB@@FA@@@@@A@@@@@@A@@@@@@@@@@@B@@AB@@@@A@@@@@@@AA@@@B@B@@@@@B@@@@@B@@A@A@@B@@@A@@A@@@@@@@@@@@@@AB@@@@@@@@AA@A@@@@@@@@A@@@BB@@@@@BA@@@A@@B@@@@A@@@@@A@@B@@@B@@@@@B@@A@@BB@@@@B@B@@A@@B@@@@@@@@@AAA@@@@@@@@@AA@@@A@@@@@@@AA@@@B@@@@@B@@@@A@@@AA@@@B@@@B@@AB@@@B@@@BA@@B@@@B@@@@B@B@@@@AB@@A@@@@@@@AA@@@B@@BB@BB@@AB@B@@@B@@@@@@@B@@@@@@@BABBB@@AB@@@@AB@B@@AB@@ACA@E@CHKDC@AAEIAO@MBC@MAIEIM@AAAMQIMGGCEAMFGDCHCHGB@JEPAJBJHJHLFNDPBJ@JADADA@ABACGEEGIEGAKBEDCBGAIIGGGA@GEIAOKKEECKCCCA@ACAEHGLAJAV@\\CTCB@VE@@BBDBDDBDBBBB@BBBBBD@DAD@D@B@L@HBBA@AB@@AB@@@BBBB@A@C@AB@BB@A@A@A@A@A@C@@@AAACAECCAACAA@AB@D@B@B@@@BA@A@A@A@@@EB@BAF@D@DADA@A@@BA@@CE@A@@@ABCBABCBAC@CAC@AAAAEAAAEA@@CCACEEACEECI@ACI@E@@@@BABGNEBAPCNABAHG@@BICKIEIAIAGE@A@AAE@@@IGG@@IIUEWAQLCHEHGRMN@@ABKJKJ@@ENBHDPAJCD@@@D@FBBC@A@A@CBAB@@@@A@@B@@@B@BDB@@DBBD@DBBBB@B@@@BC@EAC@@@C@G@C@@A@AACA@AACAC@EAC@A@A@A@I@E@IHABAN@PGNKB@AQGKIGCA@KFIHMHA@MD@@QDICC@KGMGIGEECAAACCGC@@EA@BBB@B@@ABC@A@A@A@A@CBABA@A@A@@AAEA@A@AAA@ABA@A@@@@@@A@A@@BC@A@A@C@ABA@CBA@C@C@ABAAABA@ABAAABCBAAA@@AA@AACAAACAAA@C@A@CBCB@AA@ACAACAAAA@@ABA@ABABAAA@@CBABAB@FABA@A@CBA@@@C@@@AAAAA@@@AAC@A@AAC@@A@AACAA@AAC@@AA@@@@ABAB@@@BA@@BAAA@A@@@A@@@CAABADCBA@CBC@A@CAAAA@@B@@@B@D@DAB@B@@C@@@A@C@A@C@A@AAC@A@AAA@A@A@CAA@@@EA@@EBA@AAA@A@AAA@@AAAAAA@AB@BBB@B@@@B@BA@A@A@C@C@CAECEAAAAAAA@A@A@A@@AAA@A@@@AB@@@BA@AB@@ABA@C@AAA@E@AB@DBBB@D@B@@BBBB@BB@@D@@B@B@B@BBBBBDBBDB@AB@D@B@B@BAB@@@B@B@BA@ABA@A@@@@AA@@A@@AAAAA@AAAB@@C@CAABBD@B@@BB@BBB@B@BAHCBA@C@CBCBEAC@AB@BABBBBBAB@B@F@B@B@D@@@B@@@@B@@@@@@@@@B@@@@@D@D@FBDDBD@DBFBDB@FAB@@B@@@B@BABA@@B@@BB@@@@@AB@@@BD@@@@B@@@@A@C@A@@B@@B@D@BBB@DB@B@@BB@@B@AD@@@@@@BB@@@@@@@@@@A@@@@B@@D@B@@BBD@BFBBDB@@DD@BCHBBBBA@ABAB@BD@BBDBDCFCFABAB@B@BBFDDB@B@DB@DAB@DFBBB@BFFD@DBF@DDBDBBBBB@BAD@BADB@BB@BE@C@ABBBFHBD@@EDAB@D@DBBDB@@B@B@BB@BBFBB@B@BBDABADABCBIFE@A@AB@@A@A@SEEBCAE@A@ABADA@A@AAA@AAEBCBCBCBA@E@AACAEAA@C@C@A@CBAB@BADCBADC@CBID@B@BDDC@G@@@CBA@@DAD@D@@BB@D@DC@A@@@@B@DABABCBBD@DAB@@C@CBEHAB@BAB@BCBA@A@@B@D@F@DB@BB@D@@DB@@B@BB@B@B@BB@@D@BBBB@B@B@BBB@AB@@@B@@A@B@@@DBB@@BDBBBBB@@A@@@@BB@@@@B@@@@B@@@@B@@BB@@@B@@@@BBD@B@@BB@DB@@@B@@@@A@@B@B@@B@@BBB@B@@@D@@@H@@@@ABA@@B@@@@@B@BBBB@@@@B@@@B@BBD@@B@@B@AB@D@BAB@@AB@B@@@BA@@B@B@@@B@B@@A@@B@@AA@@@DA@@@A@A@@@@B@BA@@DA@@B@@A@@@@@BBAB@@@@@@@BAB@@@@B@@B@@@BAB@BB@@@@B@BB@@B@@@@@@@@@ABB@@@@@A@@@@B@@@B@@AB@@A@A@@@@@@@B@@@B@B@@@@BAA@B@@@B@@@@A@@@@@BB@@@@A@A@@@A@@@@B@@@@A@@@A@@@@@B@B@@B@@A@BBA@@@AC@@@B@@@@BB@@@BA@A@@A@@@B@B@@@@@AB@@@B@B@@@@@@BAB@@AB@@@@B@@@@B@B@@B@@@@@A@@B@@@@@@B@@@@@AB@B@@AB@@@@@@B@@@@@@BAB@@@@@A@@AB@@A@@B@@@@@B@@BB@@ABA@ABA@@@A@@@A@C@AB@@@@@@@DB@BB@@@BBBB@BBBBAD@@BBDBBB@@@@BB@BBBBABA@@@@BB@D@@@@@@AB@B@@@B@DA@@@A@@@@BBB@BA@@BA@A@A@C@AB@@AAA@AD@@@@BBB@B@@@BB@@B@@D@D@@B@@B@B@@B@@@@B@@@B@@A@@@AAA@@@@@@B@@@@BB@B@@@BBA@@D@@@@@@B@@@@B@@@@B@@B@@@AAB@AA@@B@@@BB@@BA@B@AB@@@@B@@A@@B@@@@B@@@B@B@@@@B@@@@@@B@@@@@@@@@@B@@@@A@@@@@@@B@BB@@@@@@B@@BB@B@@B@@@B@@A@@@A@@@@@@@@@AB@@@B@@B@@B@@@B@@@@@@@@@@D@B@@@@@@@B@@B@A@@@@@A@@@@B@@@@B@@B@@@@@@@B@B@@@BA@@BB@@@@A@@@@@B@@@@@BB@@D@@@B@@ADA@@@A@@CA@@@AB@D@@BB@@@B@@AB@@@A@@A@@@A@@BA@@@AA@BAA@@AB@BA@@B@@AB@B@@@@@@@BB@@B@@@B@@@@D@@@@DA@@A@@@B@@ABA@@A@@@@AB@@@@A@@AAB@@@BA@@@@@@B@@@B@@@B@@@@@@@@A@@@A@AAABA@@BA@A@@@AB@@A@BB@@@@@B@@@@@@@BAB@@AB@B@@@BBB@BBBBB@@B@@@@A@@@BBB@@B@@B@@@@@B@@@@@BB@B@@@@ABA@@@@@@BBB@B@@B@@@B@@@@@@@@@@B@@B@@A@@BB@@A@@@@B@@B@B@@BBB@@BA@@BD@@@@@@@@B@@@@B@@@@@BB@A@@BB@@@@@A@@@BB@@@@@@B@@@AABA@A@@@@@@@@@@@@@B@@@@@@B@@@@@@@@A@@B@@@@B@B@A@@AB@@B@@@@@@@B@@@@@@ABB@@@@B@@@@@@@BB@@@@@@@A@@@@BB@@@@@@@@A@A@@@AB@@@@@@@BA@@@@@C@@@A@@@@B@@BBB@@@B@@BA@@@A@@@@B@@AB@@BB@@@@BB@@BBBB@@@B@@B@@BB@@@DBBBBB@@@B@@@B@@@@@@@BB@@@@@@@A@@@@@@@A@@@@@BB@@@@AA@@@@@@@B@@@@AB@@@@B@BA@@B@B@B@BB@@BBBBBBB@BADB@@DAB@B@@BBA@B@@B@BB@BB@@A@@B@@@@@@@@@@A@@@@B@B@@B@@@A@@@@@@BA@A@A@@BA@@BA@A@@B@@@@@@@@B@@@@@@@@@@BB@@@@@@@@@A@@@@@A@@A@B@@@@@@@@@A@@@@@@A@@BA@@@@B@@@@A@@@@B@@AB@@@@B@@B@@@B@@@@@@A@@D@B@@@BB@@B@@@@@BA@@DAB@B@B@@@@@@A@@@AB@@@BB@@B@@BB@@@@@B@B@@@@ABA@@AABA@@@ABA@@@@B@B@@@B@@@@@B@@BB@B@B@B@@B@@@@B@@@B@@@B@@@@@BA@@B@@@@@@AA@@@D@B@@ADA@AB@BABB@@@@@@@@BBB@BA@@A@@@@A@@@A@@@@@@@A@@@@@@BA@BD@B@AA@A@A@AAA@@@A@@B@@A@@@A@A@A@A@@AC@C@A@@@CB@AC@@@ABA@A@@@A@@@@@C@CB@BA@@@A@A@A@@@@@@BC@@@A@@@@@A@@@AA@@@@@@ABA@@@@B@@A@@B@BAB@B@"],encodeOffsets:[[109232,27143]]}},{type:"Feature",id:"520112",properties:{name:"乌当区",cp:[106.750625,26.630845],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@BAB@@A@@@BB@@@B@@A@@@@@ABBB@@@B@@@@A@A@@A@@@A@@@B@@@AA@@@@@@@BA@@@@@A@A@@@BA@@@A@@@@@@@@A@@A@@@@B@@@@A@@@@A@@@@@B@@@B@@@@AB@@@@@B@@@@@B@@A@@@A@@D@@@@A@@@A@@@A@@B@@@BCB@BA@@@@@AA@@A@@@@@A@@@@@@A@@B@@CBA@A@A@@@@@@@@@@B@@@@@@@AA@AAA@AA@@@@A@@@A@@@@@@BA@AAA@@@B@@AA@@A@A@@B@@A@A@BB@@@@@@B@@BA@@@@@AA@@@@@A@@@@@@A@@@@@@A@@@@AA@@BAAA@@@@@@@B@BAB@B@@AB@@@@@@@A@BA@@@AD@@@@@AA@@A@@@@AA@@@@@C@@@@@@@@@@AA@A@@@@A@BA@@A@@@@@A@@A@@@@@A@@@B@@@A@@BAA@BAA@BC@@@@A@B@@@@A@@B@@@@B@@AB@@@AB@@C@@@@@@@@@A@@BA@@@@@@B@@@B@@@@@B@@B@@@B@@@@@@@@@AB@@@BA@@@@@A@A@A@A@@@A@@BA@@@@@@BA@@@@@A@A@A@A@@@A@@@A@@@CBC@ABA@A@@@A@CDA@@@@AA@@BA@@@@@@@@@A@@@A@@@@@A@AA@B@@@B@@@B@@B@@B@@B@@AB@@B@@B@B@@@B@@B@@BB@@B@B@@@BB@@DB@@@@@B@@A@@@@B@@A@@@@@@@A@@@AB@BA@@B@@@B@B@B@@BB@@@@BB@BB@@@B@@BBB@@@BB@BB@@FB@@@B@B@@AB@@@B@@@@A@@@A@@BA@A@A@@@@BA@@@@BA@@B@@@@@@A@@@@A@B@@@@A@@B@@A@@@@BEBA@A@@@AAACAAAAAA@@@A@@@@@@CAA@@@A@@@@AAAA@A@@@A@@B@@@@@@@A@@A@@@A@@B@@AB@@@@@AA@@@@B@@A@@@A@@@C@@@@@A@@@@@AB@@@@@B@@@@@B@@@@@BBBB@@B@BB@@@@@B@@B@@ABAB@@A@@@@@A@@B@@@@AA@@@B@@@@B@@@BB@B@@@@A@@@@@@@@B@@@@A@@@@@A@@BA@@@@B@@@B@@ABA@@@A@@B@@@@A@A@AA@@A@A@@@ABABABABAB@B@@BDBBB@B@BB@@@@BB@@@@@B@@AAAA@@A@A@A@ABABAB@@A@@@A@@B@@@@@BAB@@@@@B@@AB@BAB@@@@AB@BBD@@AB@@@@@B@BA@BD@BB@@@B@@A@@B@@@B@@B@BAD@@@B@D@D@@@@@B@@A@@@AAAA@@A@A@@@@@@BBB@@CADHBD@BD@BA@@DBBD@@@B@@A@@B@@@B@BDB@@@BA@A@AB@@BB@@B@D@B@BBB@@B@@A@@@@@B@@@@B@@BB@@F@B@BB@@B@@@@ABA@ABA@AD@@@DAD@B@@BBBD@B@@@@@B@BA@@@@@C@@BB@BB@@@B@@@A@@@@BBBBBA@@@C@@@C@@@@@@@@B@@@D@D@@B@@@CD@@@@@@@BBDBB@BA@@@@@B@@@@BB@@B@@@@AB@@A@@@@@BB@B@B@@@@@@@BA@@B@BABB@@B@@A@@@@@@B@@@BBBBD@B@BB@@@ABA@A@@@A@@@@A@@A@@BA@@@A@@@AB@@@@@@@B@@B@@@@@@BCBA@@@@@B@@@@B@@@@@@A@AA@AA@@@@B@BB@BBBD@@@@B@BA@@B@@@CBAB@@@@@@@@@B@@@@@B@@@@@AA@@AA@@BA@@@@@@@@BD@@B@@@@@B@@A@@@@A@@@@A@@B@@@@@B@@BB@@AB@@A@@@@@@@A@AB@B@BBA@B@@@@@B@@@@B@BB@@B@@B@@@BB@@@BA@@@B@@@BB@@@B@BBB@@@@B@@DBB@@BB@BB@@@B@B@B@@B@@@B@B@@@@@BAAAB@B@DA@@B@@@@@BBDABB@@B@@@B@@@@@@@BDBBBBBBB@@@B@B@F@DAB@D@D@D@BBBB@@A@@B@@@@BB@@BB@B@B@@@@@BB@@@@@B@@@BB@D@@@@@@AB@@@@@@@BB@@BA@@@@B@BBABB@@@@@@B@@@@@@@B@@BAB@@@@@@@@@BBB@@@BAB@@@BABB@@@@@B@@BB@@@AB@B@@@B@@@@@B@@BB@@A@B@@B@@B@BB@A@@@@B@@@@@@B@@@@@B@@DBB@@BA@@B@@@B@@BB@@@@@B@DB@@BBA@@BBBB@@@A@@@@@@@@B@@B@@@@@@B@B@@@@A@@D@@BB@B@@@B@@BB@@@@@@@BB@@B@@B@@@@A@@@@B@@@B@@@@@@@BB@@@@AB@@@B@@@B@@@@@@B@@BB@A@@BB@@@@@@ABABB@@@@@@B@@@@AB@@@@B@@@@@BB@BAB@@A@AB@@ABAB@B@@A@@AA@@@A@A@@AA@CAAAC@@@AB@@A@@BA@A@@A@@@BA@AA@@AB@@@B@@B@A@@B@@@@@BA@E@AB@@ABA@@@@@@BA@@@@@@@@@@B@B@@@@@BA@@BA@@BABABA@CBAB@@@@@BA@AB@@AB@@@@A@@A@@A@A@A@A@@@@AC@AAABAA@@@BA@A@@B@@@B@@@@B@@@@@@BA@@@AA@@@@@@A@@@@AB@AA@@@B@@A@@@@@AB@@AB@@@@BBBBBB@@BD@@@BB@@B@@B@@@@ABB@@@@@@@@CB@@@B@B@@@B@@@B@@AB@@@@B@@@BA@@DA@@@@@BB@@@@@@B@@@B@@@B@B@@A@@@@@@BA@@BA@@A@@AB@@@BAB@@A@@@A@@@@@@B@@@@@B@@@BA@CA@@AB@@A@@@A@AB@@ABA@@@@B@@@B@@@B@B@BB@@@B@@@@@B@B@B@@@@AB@B@BB@@BB@@@@B@@@@@B@@B@B@@BB@@@@@@@@BB@@AB@B@@@@AB@@@BA@A@@BA@CB@@A@A@C@A@A@E@@AA@@@@BA@A@@@AA@@AA@A@@@@A@@B@A@@@A@@@@AB@@CB@@@@@@@@B@@B@B@B@@@BB@@@@B@@@@@B@@BB@@B@B@@BB@B@@@@@BDB@BBBA@@@@B@@@@@BBB@B@B@@AB@BABA@@@A@@@A@@B@@B@@@@@@@BB@B@BAB@@@@@BB@@A@AB@@@@@B@BBB@B@BBB@B@@@B@BB@@@B@@AB@B@B@@@@@BD@@@@@@BA@@B@B@B@B@@BB@F@B@@B@@B@@@@@B@@@@@BB@@B@@BB@@B@B@@@@D@@@B@@ABA@@BBB@B@@@@AB@BAB@B@HCB@B@@@@@@BBB@B@@BBB@@@@B@DBB@BD@@B@BB@@@@BB@@@BA@@@@FCB@B@B@BBB@@@B@@@@@@BB@@@B@@@B@@@BAD@@@B@@BB@@AB@BD@@BBBD@@@@@B@@@@D@@BB@@@BD@@@@D@B@@@@@@BD@BB@@D@B@@AB@B@@@B@@@B@@@@A@@A@@@CB@@@A@@B@@A@@@@@@@@@AB@B@B@@BA@@B@@@@@@B@@AB@@@@@@B@B@@B@BBA@@@@BABA@@@@@BB@@B@BAB@@@@@B@@@B@@B@@@BA@@BAB@@B@@B@@@@BB@@@B@@B@BCB@@@B@@@BB@B@@B@@@@@BA@@@@BAB@@A@@@AAA@@@AB@AA@@A@@@AAAA@AAC@@@@@@@A@@BA@@B@B@@AB@@@@AB@A@@@@A@@@@@A@@@@@@@@AA@@@@@A@@A@@@@@B@@AB@@A@@@@@@@@BABA@@@B@@BABBB@BA@@BAFA@@@@B@@BBA@@BA@@B@@@BB@@B@@@@@@AB@@B@@@B@@B@@AB@@@@@@@@B@@@B@@@BBB@@DA@@@BB@@@B@@AB@@B@@@BA@@@@@@@AB@@@@@@@@B@A@@@@B@B@B@@@@@BBB@@@@BBBABB@@B@@@@B@@@@@B@@B@@@@B@@BA@@@@BB@@BBB@B@@B@@@@A@BB@BB@BAB@@@B@@@@@B@B@@@@A@A@@@@AB@@@@@B@@AA@A@@A@@@@A@@@ABB@@@A@@B@B@BA@@@A@B@AB@@@B@@AAA@@A@@@A@@@@A@@@A@A@@@@DB@@@A@@BBB@@@DA@@BB@@@B@@@B@@@@B@B@@@@@CA@A@A@@@@@@@A@@B@@BB@BB@BA@BB@BB@@B@@@@@@BBB@@@@@A@B@@@A@@B@@A@@@@B@@@@@B@B@@@@B@@@BAB@BAB@@@@B@@B@@@@@@@@@@@B@@@@@@@@@@@BA@@B@@BB@@@B@@@@@@B@@@@@@@@B@@@@@@@@A@@@@B@@BBAB@B@B@@@AB@@@@AB@@BBAB@@@B@@B@@@B@@@@@B@B@BB@@A@@B@@@@@@BB@@@@B@@B@@@@@A@@BA@@@@B@BB@@@@@@@@@@@AA@AA@@DABA@@B@@@@B@@@@B@@BBA@@@@@@@A@@AAB@@@@@@ADBB@@BBA@B@A@BB@@AB@@@@A@@@@BB@@@@BBB@@@B@@@@@BA@@@@@@@@@B@@B@B@@@@@@@BA@@@@B@@@A@@BDA@@B@@A@@BAB@@ABA@@@AB@@A@A@C@@BA@@@@@@@@@@@@@@B@@A@@@@@@@A@@B@@@BA@A@A@@B@@AAA@@@@BABA@@@A@@AC@@BA@A@@BA@@@@@A@@@@B@@@A@AACA@@@A@@B@@AB@BA@@B@B@B@@@BBB@B@@@@BB@@@@B@@BB@@@@@AB@@@@A@@@@@@@B@@@@DD@@DD@@@BBBBB@B@@@@@@@B@@@@@@@B@A@@A@@@BD@@@@@@@@@@@@B@B@@@D@B@@@B@D@@BB@@BB@BB@@B@BCB@@@B@@@@@AB@@B@@@@@A@@@@B@@BB@@A@@BAA@@@@@@@B@@@@@@BBB@@@@@B@@@B@@@@@B@BA@@A@@A@@@@B@@A@A@@B@@@@@B@@@@AB@@B@@B@B@BCBABA@@@@BA@A@@B@@@BB@@@@@A@@A@@A@@A@@@A@@@A@A@@@@AB@@AB@@@@@@AA@@@@@@@BA@@@@@A@@@@A@@BAB@@@@A@@A@@BADA@@@@@AA@@@A@@@AA@@B@@@A@A@@@@@@@@A@@@@@@BA@@@@@ABA@@@@@@@@A@@A@@B@BAB@@A@@@ABA@C@A@@A@@AAAB@@@B@A@A@@@@@BA@@@@B@@@@A@ABA@@@A@A@@BA@@@A@@@B@@B@AAAC@@@@B@@A@@A@@@@@BA@@B@@A@@@C@@@@@@B@D@@B@@BA@@B@@BB@@@BA@@BAB@@@BABAB@BA@@AA@AAAAAA@CA@A@@CBA@@@A@A@A@@@AA@@@@@A@A@@@AB@AAAA@@@A@@@@@AA@@A@@@@@@AAAACAAAA@AA@@B@B@@@@A@@@ABA@@B@@A@@@@@@A@@@@A@@@C@@@@A@ABA@A@@@ABA@@@@@CA@@@AA@@@@@@@AAB@@@B@B@@ABA@@A@@A@@@A@@B@B@@@@@B@@@BA@@A@@@BA@@B@@@@@@@@B@@@@B@@@@@B@@@@AB@@@@AB@@@@A@@@@@@BA@@@AB@BA@@B@@BB@@@@@@@@@BABBB@@A@ABA@@B@BA@A@@@B@@BB@@@@BA@@@@@@AA@@@A@@@A@@@@B@@@@@B@@@@@@@@@@@A@@@@@@A@A@@B@@ABA@AB@@@@BBA@@@A@@DA@@@@BA@@@@@B@@@@@@@@D@@AB@@BB@@B@@@@@@@@B@@@@A@@B@@@@@D@@@@@@@@BB@@@@BB@@@@B@@CDA@@B@@BA@@B@B@@A@@BAB@BA@@@@@AB@@@@@@@BA@@B@A@BC@A@A@@@A@@@A@@B@@A@@@@BA@@@@@@AABA@@A@@AA@@E@A@AA@AA@@A@A@@@A@@BA@A@@@@AACAAA@A@C@E@A@G@CAA@CCGEEG@AA@@@AA@@AC@@AAA@ABA@@@CBA@A@@BA@A@@@CAA@@AAAA@ECECAAA@@BCBED@@C@C@@@C@AAAAEE@@GE@@ACAACACC@AAA@AAI@A@@DAD@BAB@B@FBBBB@BADCBC@@A@@A@AABA@AA@C@@C@C@A@AABC@AAAA@ABCDABCBAABE@EBCBGDEBB@@BA@ABB@@@B@BB@@@BGBE@K@EEEGAGAA@A@KAIECI@CAG@IBCBCACAC@@@C@A@A@ADBB@FDBAB@B@ABBDDAD@D@A@@ACAAEA@EAIAD@D@F@D@BA@ABABEAAC@AAABA@ABCBAB@@A@@@@@A@@A@AC@A@@BA@AA@@A@A@E@@@C@@@@@@AAAC@C@AAA@AACAAAA@AA@@@@AA@@A@AAAACAA@A@@@ABA@@B@BABA@@B@@@@AB@@@B@@A@E@A@C@@@A@A@AACAC@AAA@@@AAAA@A@A@@@AAC@AA@@@A@@BA@A@@@A@A@@@A@AAA@@@ABA@AAA@@A@@@C@@@@@@CAE@@AA@@BA@@@@@@@CA@@@@@A@@ABA@@@@@@A@A@@A@@@AABA@@@@A@@@@A@@@A@@@@ABA@A@A@@@@@@@A@A@@@@BA@@@C@@B@@@@@B@B@@A@@@@@@A@@A@@AAA@@@@@@@BA@@B@@A@@@CAA@A@@@A@@@@AAAA@@AA@@@@AA@A@A@AAA@A@@A@@@@AA@@@@AAA@@AA@AA@@AA@@A@@@@AAA@@@@AA@@CA@AA@AAA@AAEA@@A@A@CAC@C@@@AA@@A@@@AA@@"],["@@@@@@A@@@@@A@@D@@B@B@@A@@@A@@"],["@@@AA@@B@B@B@BB@@@BA@A@@AA"],["@@D@@A@@B@@CA@CA@@@@@@A@@@@AC@@@@@@@@A@@@@@@A@@@@A@@A@@B@@BB@@A@@@@B@@B@@B@@@@@@@@A@@@@B@@B@@@BA@CB@@BBB@@@B@B@@@@@@@BB@"],["@@@@@AA@A@CBA@ABA@@BBDD@@@BBB@@CB@@@@@@BB@@@B@@@@@B@B@A@B@@@@B@ABB@A@@@A@@@@@AA@BAA@A@@@A@AA@@@@"]],encodeOffsets:[[[109378,27242]],[[109210,27245]],[[109208,27242]],[[109222,27249]],[[109209,27238]]]}},{type:"Feature",id:"520113",properties:{name:"白云区",cp:[106.623007,26.678561],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@A@@B@@@@AAA@@@@A@@@AB@@ABA@@AA@@A@@@@@ABA@@@@BA@@B@@A@@BAAA@@@AA@CB@@A@ACAA@A@AAA@A@@@A@@A@A@@@@A@A@@@@A@@@C@@@@@@AA@A@@@BA@@A@A@@A@@@A@@AB@@@BA@@B@@@B@@@@A@@B@@@B@B@@A@@@@@@B@@A@@@A@@@@@AB@@@@@@A@BBA@@@@B@@@@@@A@@BA@@@@BAB@B@@A@@@A@A@A@A@@@A@AB@@@@B@@B@@@@@B@@A@@@@@@@A@A@@@@A@@@@A@AB@B@@@BA@@B@B@@BA@@@@@B@@@@@@B@@@@@@B@@@@AB@@@@@B@B@@@@A@@@@@AB@AA@A@@@A@@B@@@@@B@@@@@@A@@@@@@@A@@@@@@@AB@A@@A@@@@@@BB@A@@@B@@@@B@@B@@@@@@@B@@@@@@BB@AB@B@A@@C@@BAAAB@@@AA@@@@@@@@@@@A@@AA@@@A@@@@@B@@@@AB@@B@A@@AA@@@@AA@@@@AB@B@@E@@@BB@@@@AB@@@@A@@B@@@B@@@BA@@AA@@@@@@@@@@@@B@@BB@@@@@@@@AA@@BB@@A@@@A@@A@@AA@@@@@@@@A@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@ABAA@@@@AA@@AB@@@@A@@A@@C@G@A@AA@@@B@B]@@@AG@@@A@@@@AA@@@BA@@@@A@@A@@@@@@@A@@B@@C@@@@B@@B@@@@B@B@@@@A@@@A@@@A@A@@A@@@@@@@B@@@@@@A@A@@@@@A@@@@A@@@@@@A@@@@@A@@B@@A@@@@B@@@@@@AA@A@@@B@@@B@@@@@@@AA@@@@BBB@@A@@@@B@@@A@@AB@@AB@@A@@@@@@@A@A@@D@B@B@@@B@B@@@@A@@B@@B@@@@B@B@@@B@@A@@B@@@@@D@B@BBBBDCBCB@BADAB@@B@@B@@A@@@@@@B@B@@@@@@@@@B@@@@A@@@@B@@@A@@@@@A@B@@@B@@@BA@@@@@@@A@@@A@AB@@@BA@@@@B@@A@@@@@@B@@A@@B@@@@B@@BBB@@@@BBBD@B@@BB@@DB@@BA@@@B@@@@@@@@@@@@@@B@@B@@B@@@@A@@@AB@BB@BB@@@BBB@@B@@@@AB@@@@@@A@A@@BA@@@@@@B@@BB@@B@@B@@@@@@A@AB@@A@@@BB@@@B@@@@BB@BDDBDB@BB@@@BB@BBB@@B@@@@@@AB@@@B@@@@A@@@@@AB@@AB@B@@AB@@@BB@@@@B@B@BA@@B@@A@@@@AA@A@A@A@@@A@@@A@@BA@A@A@@@A@@A@@A@AACBA@@A@@@CA@@@A@@@@BB@@B@@A@@@@A@A@A@@@@@B@@@@AB@@@@@A@@@A@@@B@@AA@@A@AB@@@AAB@@@@B@@@@@A@@B@AA@@@A@A@A@@@@@@AA@@A@@@@@@@BA@@@@@@@A@@@BA@AA@@@@ACBA@AB@@B@@B@@A@@@ABA@@A@BAAA@@@@@@@@@AAAB@@AB@@@@ABBBABB@@B@@BBBBBB@@A@@@@@@B@@@B@@@@BBA@@@@B@@@@@BA@@@ABA@@@A@@@@A@A@@A@@AA@A@@@@@AAA@@B@BAB@B@B@@A@BB@BBB@B@@B@B@DB@@B@@ABC@@B@B@D@@@B@B@B@D@B@@@B@BB@@@@@B@B@@@AB@@B@@@@@B@@BBB@B@@@@@@@@@B@B@@BB@BAB@B@@@@@BB@@@@@@B@@BB@@@@AB@@@@@@@A@AA@@@B@@A@@@@A@@B@@AB@@@@A@@A@@A@B@A@@@A@@@@@@@@BB@B@B@@@@DB@A@@@@B@@@@@@A@@BB@@@A@@@@B@@@@@@@AC@A@A@C@@B@@A@@@BB@@@B@@@BA@@@@@B@@@@@@B@@@B@B@B@B@@@B@B@B@@@@B@@@@BB@@@B@@BB@BA@@BB@ABB@@@@@BB@@@@@@@@BBB@@@B@@A@@B@@AB@BA@BB@@@@BB@@@@B@@@B@@@@BB@@B@@AB@@@B@B@@@@BB@B@@@@BB@@BA@@@@B@@BB@@B@@@@@B@B@@@@@@B@@D@@@BDB@@B@@@@@D@@DB@@@@BA@@@@@@@@@B@@@B@@@BB@@BB@@@@@B@B@@@@B@B@@@B@@@@BBB@@BB@B@@@BAB@@BB@@@@@B@@@B@DC@A@@@A@@@ABB@B@@@B@B@@@@@@BB@@BD@@BA@@B@@@@A@@@@@A@@B@@@@@BB@@B@A@B@@@@@@@@AB@@@@A@@B@@D@@@@@@@@@@BBB@@@@@B@BB@@@B@@@@A@@@@B@@B@BB@B@@@@@@@@AA@@@@AB@B@@A@@@@B@@AB@@@@@@B@@@BB@@@@@BB@@@B@DAD@@@B@@@B@BA@@BA@@@AB@@@@@B@@AB@@@@C@@@A@A@@B@@@@@@A@@@@B@@@@@BB@@B@@@BA@@B@@@B@B@@@@@B@BB@@B@B@@@B@@@@@B@@@@@D@D@BBB@B@BB@BB@BA@@@@B@BBB@@@@BB@@B@@BB@@B@@@D@@@B@B@@@BBB@@@@@B@@AA@@A@@D@B@B@@@BA@@@@BB@@@@@@BB@@A@@B@@@@@@B@@@@B@@@@BB@@AB@B@@@@@@B@@A@@@@B@DB@@@@@@@AA@@@@@@@AAAA@@@@@A@A@@B@@@B@@@@@B@@@@@@@B@@@@B@@@AB@@@@BB@B@B@@@BB@@@@@@@@@@ABB@A@BB@@@@@@@B@@@@@B@@B@@BB@@@@B@B@@@@@B@B@@@B@@@@B@@@@@@@B@BB@@@AB@@@@@@@@@@@B@@@@@@@@B@@@@B@@@@@@@@@@@@@@B@@@@BB@@@@@AB@A@@@B@@@B@@@BB@A@@@@@@@@@@@@@ABBBB@@@BB@@@B@@@@@B@BBB@@@@@A@@A@@B@@@B@@@B@@@D@DB@@@@@B@@B@@@@@BAB@@@@@@A@@@BBBB@@@B@@@@B@@BAA@BA@@@@@@@BB@@@B@B@B@@A@@HF@@B@B@BBB@@DBB@BB@@@BBB@@AB@B@@@B@@@BA@@B@DBD@BBDDB@@B@@BB@@@@@@BB@@BBA@@B@@B@@@@@@@BB@@B@@A@@@@A@@@@@@A@@@AB@B@@A@@BBBABBD@@B@@B@B@B@B@@@@BB@@@@@BA@@BAB@@A@@@@BADAB@BABA@AB@@AB@@A@@@@@A@A@@@@@@@@B@@A@@@@B@BA@@BAF@B@@A@@@@@AB@A@@@@A@@BA@@BBB@@A@@@BB@B@@AB@@@BA@@D@BBDBB@@BB@B@@@B@@BB@@@@ABABA@@BAB@@@BA@AAA@@@@A@@@@@BA@@@@@A@@@@A@BABA@@@@@@AA@@@BAA@@@A@@@@A@@@A@@@A@@B@@A@@A@@@@@@@A@@@A@@B@@@@@@AA@@@AA@@@@@@A@@AA@@@A@A@@AC@@@@B@@A@A@@@@@@@@AA@@@@@@@@@@B@@AAAA@@AB@AA@@C@A@@@@AA@@@A@@@AB@@AA@CA@@@A@@@@@A@@@@A@@@@@@BAAA@@@@AA@B@@@AA@@@A@@@@@A@@@ABA@@A@@AA@@@@@A@BA@A@@BA@A@@AA@A@@@@@@@@BA@AA@@@@@@@A@@@@@@@AAAB@A@A@@B@@AA@@A@@@@@@BA@@@@@@@CAA@@A@@@@@A@@A@@@@@A@AAA@@AA@@@@@AB@@@AAAAC@C@C@A@CBE@A@A@@@A@AAAAAAAC@@@@@@A@@@A@@@AACBAA@@@@A@@@CBA@A@BBAB@@@@A@A@@@A@@@@A@A@A@@AAA@@AA@CA@@@A@@A@AAA@@@A@@A@@@A@@AB@@A@@A@@@AA@@@AAA@@@@@@A@@@@@AAB@A@ABAB@@@@@@@B@@@BA@@AA@@@A@@@@@AB@@@@@@B@@B@@@@A@@@@@AC@@A@@@@@@B@@AB@@BB@@B@@@@@A@@@@@A@@@@@@@@BADA@@A@@@ABA@@@@@ACAAA@@A@A@@B@@BBBB@@@@@@@@A@@A@@@@@B@DA@A@@@@A@@@@A@@@@@@BA@@B@@@B@@AB@@@@B@@B@@@B@B@BA@@A@@A@AACAA@A@@@A@@@@B@@@@AA@BA@A@AB@@A@@@@@@@A@AAA@@@@B@@@BA@@@@@AA@@A@@A@@@@@B@@AAAAC@A@@@@@@DC@@A@@@@C@C@@@A@@@@@@D@@@D@@@B@AAAA@A@@B@@@A@@@A@@AAA@@@D@@@@ABA@@@@@A@C@AA@AA@C@CB@@C@@BAB@BAB@B@@A@@@AAA@E@@@AA@@@A@@A@@@@@B@@@@AA@AAA@C@A@@@AA@@BAB@B@@A@@CA@A@A@@@AB@@@@AA@A@A@GBABABABA@A@A@AA@@@A@@@@ABA@@@A@A@A@@BA@@@AA@@@AACAA@AAA@A@AAC@ABA@@@A@@@A@@@@AB@BAA@@@@@C@AA@@A"],encodeOffsets:[[109264,27297]]}},{type:"Feature",id:"520115",properties:{name:"观山湖区",cp:[106.622453,26.60145],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@@BAJDI@AA@"],["@@@AB@BAB@DAB@B@@B@@@@BAAA@@@@@@@@@A@@A@@@@@@@A@@BE@ABAA@@@@@@@@B@@@@@@A@@@@A@@@@@AAAAA@@@@AB@@@@@AA@B@@@A@@@@@@AA@@AB@@AAA@@@@@@BAB@@A@@BA@AA@@A@@B@@A@A@@@@B@@@B@B@@AAAB@@@@EDA@@B@@@@@@C@@@@@@BABBD@HBF@DDRB@JC@@FAD@BABABAB@@ADA@@BADABAB@@@BI@ABA@C@DA@AA@@C@AC"],["@@DBB@@DA@@@@BC@A@@A@@@@@@@A@A@@AA@AA@@DAB@B@B@@@B@@@B@@@B@BAD@@@DBBAB@BABAB@@@@@BABAD@@@B@B@@@BBB@@BD@BB@@@@@@@BA@@@BB@ABBBB@D@@@B@@@@B@@@@@F@@@BA@@B@F@@@BB@@@@@@BBB@@@@A@@B@@B@@@BB@B@B@@AB@@BB@@B@@A@@@ABAB@@@@@@B@@B@B@@@@@@@B@@@@A@@@@@AA@@@@@BAB@@@B@B@B@B@@@B@@@@ABA@A@@B@@AB@@@@@@@@A@@B@AAB@@@@@@@BA@@@@B@@@B@@@@A@@@@B@@@@A@A@@@AB@@@@@@A@@@AB@@A@@BA@@@B@@@BB@B@@@AB@@B@B@@B@@@@D@@@B@@@@@@B@B@@B@B@@@@@@@@CA@ACAAAC@@@@BA@@A@@AA@A@AA@@@A@A@@@CBA@@@A@A@A@@@A@@B@B@B@@@BA@A@@AA@AAA@@A@@A@@@AAA@@@A@@A@A@A@A@AABAA@@@A@@A@@@A@@@@@A@@AA@A@A@@@@@@ABA@C@A@@@@@@@@A@A@@@@CA@@A@@@AB@@C@AA@B@@AA@B@@A@BB@@@@@B@@@@A@A@@@@@AB@@@AABAA@A@@@@AA@@@@@@AB@@@@A@@@C@@B@@@@D@@B@@B@@@@@@@"],["@@@@@@@@@B@@PF@@@A@A@@A@@@@A@@B@@@@@@@@@@AA@@@@A@@B@@@AA@@@AB@@@@B@@B@@@@@@@@B@@@A@@@@AA@@@@@@@@A@@@@A@@@@MH"],["@@A@A@@@@A@@AAEEAA@@@@A@@B@B@@AAA@@@@@AB@@@A@@A@@@A@@@CA@@@@A@@@A@@@@AB@@A@@@@A@@@@@@@@A@A@@@@@@@@@AA@@@@B@@@@@AA@@@@A@@D@@@B@@@@@@@AAA@@@@@@A@@A@A@@AA@AAA@AAA@B@@@@@@A@@@@A@@@@AA@@@B@@@@@@A@@@@B@@@B@@A@@@@BA@@@@@AA@AA@@AAAA@@@A@@B@@@@@BB@@@@@A@@@A@@@@@@B@B@@B@ABB@@@A@@B@B@@@@@@@B@@@@@B@@AB@@@@@@B@@B@B@@@@@@@@BB@@B@@@@@@@A@@@@@ABBB@@@@@@A@@@@B@@@@C@@@@@@A@@@A@@@@@A@@B@@@A@@B@@A@@@@@@B@@@@A@@@@@@@@A@@@@BA@@AAAA@@@@AAB@AA@A@CB@@CAABA@AAAAAA@@AAA@A@A@@@ABA@@@@@BA@@@@@A@@@@@@BB@@@@AA@@@@B@@@@@@@B@@@@@@@A@@A@@@@@@@A@@@A@@AAAACA@@A@@AA@@@@A@@AAAA@@AA@@@@AA@@BA@@@A@@B@@@B@@AA@@@A@AA@@@A@@B@@@D@@@@@B@@A@@@@@@BA@@B@B@@@@@@@@@AA@@@@@B@@@@@@AA@@@@@@A@@@@@AA@B@@@@A@@@@@@@A@@@BA@@@B@A@A@@A@@@@B@@@@@@A@@@@@@@@A@@@@@@@@@@@@@BABBB@@A@@@@@@@AA@@B@@@@@A@@AB@A@@A@@@@@A@@A@@@@@@@@@AC@@AB@@AA@AA@A@@@@A@@B@@@AA@@@BA@@@@A@@@@@@@@A@@@A@@@@AAA@A@@@@B@BA@@@@@AAA@@@@A@@@@@A@@@@AA@AA@@B@@@@@@AA@AAAAA@AA@@A@A@@BA@AB@@@@@@A@@@@@A@@A@BA@@B@@@BAB@@ABBB@B@B@@@B@@@@@@A@@@A@@@A@@@@@@@AB@@A@BB@@@B@@A@@B@@B@@@AB@BA@@@B@@@CB@@@@@C@@A@@@A@@@AA@@@@@@A@A@@BA@@@ABA@@BB@ABB@@B@@AB@@@B@@@BB@@@A@@BA@@@A@@AC@A@@B@@DB@@@B@@CB@BA@@@C@@@AA@@@@A@@@@@B@@@@@AA@@AB@@A@A@@@@@@@A@@@@A@@A@@@@@@B@@@@@B@AA@@@@@@@A@C@@@@@@@@@@@@A@@@AA@@@@A@@BA@@@@@@@@B@@@B@@@@A@@@AA@A@@AA@@@@@@@AAA@@@@@@@B@@@@@@A@@@@@@@@A@@@@@@@@A@@A@A@@@A@@@@@@AB@@@@A@@A@@B@AAB@@AA@@A@@@BBA@BB@@A@@@@A@@A@@@@@@A@@@@C@@@AB@@A@@AA@A@@BAB@@@@@@@@@BB@BB@@@@@B@@@@B@@@@@@B@@B@@B@@@@@@ADB@@B@@@@B@@@BA@@@B@B@@ABA@@@AB@@@@@@@B@@@@DAB@@@B@@@@@@@BA@@@@B@B@B@@@@BB@@@ABA@@B@@B@@B@@AB@@@B@@@BA@@@@B@@@@BA@@@@@BA@@BA@@@BB@@AB@@@@@@@@@@BA@@B@@@@B@@@BB@@@D@@@@@@@B@@A@@@@@B@@@@@B@@A@@@@@@B@B@B@@B@@B@@@@B@B@B@@@B@B@@@@BB@@@BA@@BBB@B@@@@@@@@@B@@@@@B@@@@@B@@@B@@B@@@@@@B@BA@@BB@@@AB@@@@@@D@B@@@@B@@@BA@@@B@@@B@@@@B@@@@B@@@@A@@@@@BB@@@@@@B@@@@B@@@@A@@B@@@@B@@@@B@@A@@@A@@BAB@@A@@@@@@B@@A@@@@@A@@B@@AA@@A@@B@AA@@@A@@BA@@@A@@@A@@@@@@BA@@@@BA@@@@BA@AB@@E@@@A@@BA@AB@@AAA@@B@@A@@@@@A@@@@@@@A@@@@@@B@BB@@@@BABABA@B@@@@@@@@B@@@B@D@B@@@@@BAAC@A@@BB@@@ABA@@@@@@@@@@D@@@@@@BB@@@A@@BAB@@@B@@B@@BBBB@@@@A@@@@@@B@B@@@@AB@B@@B@@@B@@@@B@B@DA@ABA@@A@@@@@@AB@@A@@@@@AB@A@@@@A@@@@@AA@@@@A@@@@@A@AB@@@@@@AB@B@@@@AB@@A@A@AAA@@@@@A@@@@@AA@AAC@@@@@@@AB@@@@@@@@A@@@@@@@@@@@A@@B@@@B@@ABA@A@@B@@@@A@@@@@@@BA@@@A@@B@@CD@@A@A@A@@B@@@B@@A@@@@@@A@@@A@@@@AB@@@@A@@B@@@BA@@@@@@@B@@B@@@@@B@AA@@@@@@@A@@@@A@@@A@@@CA@@@@@@AB@B@A@@@A@@@@@A@@@@@@AB@@@A@@@@@A@@A@A@@AB@A@@@A@@A@@@@@@@@@@A@@@@@@A@@@B@@A@@B@@@@@@@A@@@@@A@@@@@A@@@@@@AA@@@@@@@@@A@A@A@@@AA@@@@@@@A@@@@@@A@@@@@@@@A@@@@@@A@@A@@@@A@@@@@CC@@CAA@A@AC@@@A@AA@@@@@@@@@@@@@@A@B@@BAAA@@C@A@@@A@A@A@@@@B@B@@AB@@@@@@A@@BB@@@@B@@A@@@@@@@@@A@@@@@@A@@@B@@@@A@@BB@@B@@@@@@AB@A@@A@@@@@B@@B@@@@@@@B@@A@@B@@@B@@@@B@B@@@@B@@@@@@@@BBB@@@@BAA@@A@@@A@@@A@AAAB@A@@@BA@@@@@@@@A@@@BA@ABAB@B@@@B@@BB@@B@@B@@@B@B@BB@BF@@A@@@@@B@BB@BABB@@B@BBB@B@@@@AB@@@@AB@@@@A@@@@@@@BBBD@BA@@@@BAA@@@B@@@@A@@@B@D@BB@@BA@B@@@B@@BB@@B@@@BF@@@B@B@@@B@B@@B@@B@@B@@BA@@@B@@B@B@BA@@BA@@@@BA@@B@@BB@B@@AB@@@@B@@BB@BD@@@@@@BBB@@B@@BB@@@@@B@@BB@@@B@@BB@@@@@B@@@@BB@@@@BB@@@BBBA@B@@B@B@@BB@@@B@@ABB@@@@@B@@B@@@B@@@B@@@B@@@BBB@@BB@B@@@@B@@BA@@B@@@@BA@B@@@@A@@B@@B@@@AB@@BB@@B@BB@@A@@B@@AB@@@@@@A@@@@A@@B@@@@@@A@B@@A@@@@A@@@@@@@@@A@@@@@@@@@@@BA@@A@B@@@@A@@A@@A@@@@@@@@@AB@@@A@@@@@B@@@@@@@@@B@@@@B@@A@@@BB@@@@@B@@@@@@@B@@@AB@@@@@@@@A@@B@@@@@@@B@@@@@@@@@@@@@@B@@@@BB@@@@@@A@@@@@@@A@@@@@@@@B@@@@@@A@@@@@@BB@B@@AB@@BB@@@@@BB@@B@@BA@@BD@@AB@@B@@B@@@B@@BB@@@BA@@B@@@@@BB@@A@BB@@@@@@@@B@@@@@B@@AB@@@B@B@B@@@@@B@@@BB@@@B@B@B@@@B@@@A@@@@BB@@@@@@BA@@@@@@@AB@@@@@@AB@@@@@B@B@@@@@B@B@@@B@@@@@@@B@B@@@@A@@B@@@@@B@B@B@B@@@B@@BB@@@@@B@@@@@@BBB@@B@@@B@B@@@@B@@@@@@B@@@@@@B@@B@@@@AB@@B@@@@B@@@@@@@BB@@@AB@B@@BB@@AB@BBBA@@@@B@@@B@@@BB@@@@@@B@B@@@B@@@@@B@B@@@@@@BBBB@@A@@@@@ABB@@B@@BB@@@B@BB@@B@@B@B@B@B@D@@@B@BB@@@@B@@@@BB@@@@@B@@@@A@@@@A@@@@@@@@@@AB@AA@@@@BA@@A@@@@@@A@@@A@@BBB@@@B@BA@@@@B@@@B@@@B@BB@@BBBBB@@B@A@@@@@@AA@@BB@@@A@@@A@@@A@@@@@@@A@@@A@@B@B@F@@@@@BB@A@BBA@@B@B@BA@@@@DBB@BB@@@BB@B@DB@@@@B@@AB@A@@A@AB@@@@@@@@@@A@@B@DAB@@@B@@@B@BB@@B@B@@@BABB@BD@@@@B@B@@@BBBBBBD@BB@@@@A@ABA@A@AB@BB@@@@B@B@@BB@@@@B@B@@B@@@B@BA@@B@@A@@@@@A@@B@AA@@@@@A@@@A@@@@B@@@AAAAAA@@@AA@BAAABA@@@@BA@@BABB@@@@@@@@B@BB@A@BB@BA@@B@@@@AA@@@BAB@DA@B@@B@@BAB@@B@@@@@@@B@@A@@@@@@@BB@@B@@@@B@B@B@@@B@@B@AB@@@@@A@@@@@BA@B@@BAB@@@BB@@@A@@@B@@@B@@@@BA@@@@@A@@@@@B@B@B@@B@@@@AA@@A@@B@@@B@@D@@@BB@DABBB@@@@BB@@@B@B@B@@AB@@@B@@@B@B@B@B@@B@@B@@@@AB@@A@A@A@@A@@A@@BA@@@ABA@@BA@@@@B@@@@@@A@@BA@@@@@@@AA@AAA@@A@@AAA@ACCC@AAA@@@@@A@@AA@@B@@@BAB@@@@@@@@AA@@@AA@@@A@@@@B@@AB@B@@@@@@@BA@@@@@AA@AA@@A@@AAAA@@B@@@B@@A@@@@AA@@@@@@@@@@@@@@@@A@@AB@@CA@@AA@@@AACAA@@@@AA@AA@@@@@@AB@@@@A@@@@B@@@@A@@B@@A@@BAB@@@B@@@@@@@B@@A@@@A@@@A@B@@@@@B@@@A@@B@@@@@@A@@@@@@@@@A@A@@@@B@@@@AA@@@BABC@ADADAACAA@A@A@C@@@@@AB@@@@A@@@A@A@@A@@@@AB@@@@@@A@A@@@A@A@CB@B@@@@@@@B@@@BA@@BA@@@B@@@A@@B@@@AA@A@@B@@B@@@@@@@A@@@A@@@BBB@@@@@@@A@@B@@@@AB@@@@@B@@@@@@@@B@@B@@@@@B@B@@@@@@@@A@@@@@@@BB@B@@@B@@@B@@@@@@A@A@@A@@@@A@@D@@@@AB@@@@@@@B@@@@B@@B@@A@@BB@@@@@B@@BH@@^@@A@A@@BBB@H@D@@@@BB@@@@@BA@@BB@@@@BBBA@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@B@@@@@@@@@BB@@@BB@@@B@@@AA@@BB@@@@@@@@AA@@@A@@@@@@@@@@B@@BB@@A@@@A@@@AB@@@@@BA@@@@AA@@F@@@@ABA@@@@BB@@@@BB@@@B@AA@@B@@A@@@@@B@@@B@@BB@@@@@@@@@@@B@@B@@BABB@AD@@@@B@ABAA@@A@@@@A@@@@@@@A@@@@A@@A@@@B@A@@A@@@@B@@@@BBA@@@@@@B@@@@@@@B@@@@@@@@A@@@@@AB@@@B@B@@BBA@@@@B@@@@@@A@A@@@@BA@@@@@A@@@@A@@@@@@@@A@@@@AB@@@A@A@@AA@@BA@@@A@AAA@@A@@@@AB@@@@@AA@A@@@@A@@A@@@E@AB@@A@@@E@@@@@A@@A@@@C@A@AABAA@@A@@AB@@@@@@A@@AAC@@AA@A@@@A@A@@BCBA@A@@@@BABA@ABAAA@C@@BC@A@A@@@A@@@AOE@BA@A@@@@CB@@@@@B@NGAA@@@@@@AA@@@AA@A@@@A@@@@@@@@B@@A@@@@@@AAA@@@@@A@BAB@@BBB@@@B@B@ABB@@B@@@@@B@@@BAA@B@A@@A@B@A@A@@@@@A@@@A@@A@@@@A@@DABB@@A@A@AB@@BBB@@@BAB@@A@CJ@@A@ABCBAB@@CB@BA@ABABABC@EB@@IDA@CQ@CAE@GACBAA@@@"]],encodeOffsets:[[[109206,27244]],[[109202,27240]],[[109222,27245]],[[109210,27245]],[[109179,27240]]]}},{type:"Feature",id:"520121",properties:{name:"开阳县",cp:[106.965089,27.057764],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@C@QIWAGG@@aM]@CEQYCCIIAAA@CA@@@@ABBB@@@@@@AB@@@@@BA@@@@B@@@B@@@B@BADB@A@@@AB@@@@@@A@@B@@@@ABA@AB@@@BA@A@@@AB@@@AB@DC@@@A@@A@@A@@A@@A@@@@@@C@@@@@A@@@@B@@A@@@@@@@@@@AA@@AA@@BC@@@@@@@@@@A@@@@AB@@@@CB@@@B@@AB@A@@A@@BAB@BA@@@@B@B@@@@B@@@@@@@@@@@A@@@@@A@@@@@@B@@@B@@BB@@@@@@AB@@@@AA@@@A@@@BABA@@@AB@B@BA@AAAB@@@@@@@@A@@AA@@@ABA@@B@@AB@@@@@@@B@@A@@B@@A@@B@@A@@@@@A@@@@@@A@@@@@@A@@@AB@@B@@@AB@@A@@@@@A@A@@@@B@@@BB@@@AB@BA@A@@@A@BB@@@@@@B@@B@@DB@@@@B@BA@@B@B@BAB@@@@@@D@@@B@@B@@@@@@@@BA@@@AB@B@@@B@@A@A@@@BBB@BBDBBBBB@@@@@@@BB@@B@@@@@B@@BBBBA@@B@@@B@B@@@@BB@@B@B@B@@@B@DA@@@BDBB@BBBB@BBB@@ABA@ABAB@@A@AB@@AB@@A@@AA@@@AB@@@AC@A@@@@@@@@D@@@BA@@@AB@@@@B@@@@BA@@@@@BDBBA@@@@A@@@B@@AB@@@B@B@@AB@B@B@@A@@@@@AB@@@@B@B@A@@@A@BB@BB@@@@B@DAB@B@@@BA@ABA@@@@BB@@@@@@@@@AB@B@@@@AB@@@@@@@B@@@@@@B@B@@@A@@@BB@@B@@@B@@B@@@@CBAB@@@BB@@@@@BA@AB@@@@@@B@@@@AB@@@@@@B@@B@@@@A@@BA@@B@@B@B@@@B@@@B@@@@BB@@@@B@@@@AA@@A@@@@BAB@@@@ABABADA@A@@@@AA@@B@@A@@@@@A@@@@B@BA@@@@@@BB@@@ABA@@@@@A@@@A@@@@@A@AA@@@@@@@A@@@@@@BB@AB@@@AA@@@A@@B@@@@@A@@@BA@@@@A@@@A@ADA@@@AAA@@AA@@AC@A@@@A@C@@@A@A@@@@@@@@@@@@@AC@@B@@@@B@A@@@@@@@A@@@@@@@AAAAA@A@@CC@@CC@@@@@A@@@@@@@B@@A@@B@@@@AA@@@A@@A@@A@@A@A@AA@@A@A@A@@@ABA@@BA@@@@B@@DBBBB@@@A@@@@@@B@@@@AB@@@BAB@@BD@@@B@@ABAB@@@@BB@BA@@@@B@BAB@@A@@@@B@@@@@@@BA@@@@@@@@@@@@@@@AB@@@D@B@BA@@B@@AB@BA@AB@@@BA@@@CB@AB@@@A@@@@@AB@@@@@@A@A@@@@A@@@@@@@@AB@@@@A@@@A@AA@@@@AA@@@@@B@@A@@BA@@A@B@AAB@AA@CA@B@@@@A@BB@@@B@@@@@@AB@AA@@@@@@A@@A@@@ABCB@@BBB@@B@@@@@@@@@@AAA@@@@@AB@@@B@@@@@AA@@@@@AA@@@@@@@AB@@@AAA@A@@@@@A@@@A@@@@A@@BAAA@@BA@@@@BA@@A@A@A@AB@AA@@@@@@B@@@@@@A@@@@@@@@@@A@@@@A@@@A@@AA@@@AB@@@@@@@@@@A@@@@@@@@@@@A@@@@A@@A@ABA@AB@@A@@@@@@A@A@@@@@A@@B@@@@AB@@@A@B@@@@@A@AA@@@@@@@AA@@AAAB@@AAAA@@AA@@@@B@@@@@@@B@BDB@@@@A@A@@@@@@A@@@A@@AA@@CB@@A@AA@@@B@@CA@@@@@B@B@@@B@@B@@@B@@@BB@BA@@@A@@B@A@B@@ABA@A@@@@B@@AA@B@@@B@@B@@@@BBB@@@A@@@@BA@@@@@B@B@@A@A@@@@@A@@@A@ABA@@AAAB@@@@@@AA@A@AA@@AA@@@@AB@@@A@@A@@@@A@@@@@A@@A@@@AAABAA@@@@AA@A@@@@@A@A@A@@B@A@@@@@@@@@BA@@@@@@B@@A@@@AA@@BA@@@A@@A@@CB@@AA@A@@@A@@@A@@@@@@A@@BA@@@@A@@@AA@@B@@@@A@@@AA@@A@@@AB@@AB@AA@@@@@EBAB@@ABA@AAAB@@@A@@ABAB@@@@@@@@@BA@@BA@@@@@B@@@@B@@@@BB@@@@@@@@@B@@@@@B@@B@A@@B@@A@@BA@A@@@AB@@@B@@@@@@BD@BBBBB@@B@@@BBA@@B@@BB@B@@@BA@BBB@@BBB@@BBB@BB@@@BAB@B@@@@@@BB@@B@@AB@B@@@AB@@AB@@A@EDA@@B@@AD@BAD@B@D@@@@DBFBD@@@DD@@@D@@BB@@DD@@BB@BAB@B@@@B@@AB@@@B@@@B@@@B@@@B@@@B@BBB@BBB@@@@@DA@ABA@A@A@@@A@@B@@A@A@@@@B@@@BDB@@@B@@@B@@BB@@@B@B@@AD@BB@@B@B@@B@@@B@@B@@@B@B@@@B@B@@@BA@@B@@AB@@@@AB@@AA@@A@@B@B@BAB@@AB@BAB@B@B@@BB@B@@A@@@CBA@@BB@@B@@@BA@@AA@@A@@@@A@ABA@CAAACAAA@@A@CBA@A@@@@@@B@@BB@@@B@@A@@AA@@@@B@B@@@@A@@@@@@@@@BB@@@@@B@@A@@@A@@@@A@@AA@A@@A@A@ABA@@@@@A@AAA@AA@CAA@@AA@@A@ABA@@@A@@BA@@@A@@@@AAAAAAA@@CD@@@@AA@@A@A@A@@@@@AAA@A@C@@@CC@CA@@@A@@@A@@@@BA@AA@@C@A@A@A@AA@@@A@@C@@@@@AB@@A@ABA@CA@@ABA@CB@@A@C@CAA@@@C@CCA@AAA@@@A@E@AAA@A@@A@@@A@@A@A@@@@A@A@@@A@@@A@@BA@ABC@@@A@@BA@AB@@A@@@@AA@@AB@@A@@@A@C@@B@@@BA@@@@BAA@BA@@@AA@@AA@@CA@@A@AA@@A@@@A@@@A@@DAB@BA@A@@@@@@@A@A@@@ABA@@@A@A@@@A@E@CBABB@@BB@@@BB@@@@BB@B@@AB@@@BA@@B@@AB@B@@@@A@@@@B@@@@AB@@A@@@@BBBBBADB@@@@B@B@@@B@B@@@B@@AB@B@B@B@BB@@B@@B@@BBB@@B@@@BB@@@BB@@BB@BA@@@@@B@@@B@@@B@@B@@BBB@@BB@@@B@@@B@@@B@@BD@@@@B@@@D@D@@@BBBBBB@@B@@@@BD@@@@D@@B@@BB@BBBB@@BB@@B@@@@B@@@B@@B@B@@BB@@BB@@BBBB@@@@@@B@@@B@@@@B@@@@B@@BBB@@@@@B@@B@@@@@B@@@@B@@@@@@B@@@BBDB@BB@@B@@@@B@@@B@@@@@@@BB@@BB@@BB@@B@@B@@B@@BBBD@@@BB@AB@BBB@@@BB@@@@B@@@B@BB@@@BBABBB@B@@@@DB@@@@@BAB@BB@@DB@@@@@@B@B@B@B@@@BBB@B@@@@@F@@@B@BB@@BB@CB@BA@@B@D@@@@@B@D@@@B@@@@@B@@AB@@@B@@@@@B@@@@A@@@@B@@@B@BA@@B@B@@@B@@@B@D@D@@@B@@@@@B@@BB@B@B@@A@@B@@@@BB@@@B@@B@@B@@@DAB@D@B@BB@@BAB@B@@@@@BBBABCDA@A@@B@@ABA@@B@@C@A@@@ABAB@@@@@BBB@BBBBB@B@@BB@F@@B@B@FBBBB@B@B@@@BBB@@BBBB@@BBBB@BBB@BDB@BBB@@B@@BBDB@B@@B@@B@@B@BB@@@B@B@@@B@@@@@BB@@@BB@@@@BB@@B@@@@@BB@@BBBB@@BA@@B@@@@@B@@BB@@@BB@@@B@@@@B@@BDB@BBB@BBBBB@B@@@B@@BDB@@@@B@BBB@@@D@BAB@@BBB@@@@B@DB@@BBB@@DB@@B@BBFBB@@B@@@B@@@@@@BB@@B@AB@@AB@@@BBB@@B@BB@@@A@AB@@@BA@@BEB@ACBABE@@BA@@B@BBBBBBB@BB@BBBBBBBBB@B@@FF@@BB@@AB@@@B@@@D@@AB@B@B@B@@ABBF@B@B@BB@@@BB@B@B@B@B@@@B@@BB@@@BBBB@@BBBDBB@BB@@@BA@@B@@@D@D@BB@@BB@DDBBBD@@BBAD@@@B@@BBB@BB@BBB@@BBBDDD@B@@ADAB@@BB@BD@@@@@BBBBB@@D@B@@@HF@LJNVXV@@FDN^DB`HLDBBvvDDNN@@XLHBRBBAnIlWDAJETOPIVAVJZNz`LDJDNDFDXBJ@\\CJ_AIAQAQW_UQSQACMgIG]GGE@GAERKRKD@@@B@D@xPFBB@P@R@dABBB@D@NCCOFGVG\\Q@@@SACAM@QCQEIYIEAGAOCO@I@ICOC@@[KMKCI@@FMJOHOAQIMKOKM@I@C@C@[KkCQBY@CrBLO@@@C@AAA@A@C@A@ADCDAD@BA@@B@HAD@D@B@DAB@DAFCB@H@F@FADCDCB@@C@GBEBIBGBABEBEBA@A@ADADEB@DA@A@A@CBC@C@C@CBE@ABC@ABABABC@CBC@@@EBCBABC@C@CBCBC@@@EBA@EBEBC@A@CBCBEBCDEBABC@CBCBCBC@C@C@ABC@ABEBEBCACBA@A@A@E@A@@@A@A@E@A@CAG@C@GAA@@C@AACACAAAAC@@@C@AAE@A@AB@@ABA@A@A@E@E@A@@ACAAA@@E@E@AACBC@C@A@ACAAEAAC@ACCCAAA@EDC@A@ABCB@BAB@BAFCDA@CBA@A@G@ABC@A@ABCBCB@@IDGBM@A@WKOJID@@WLy@WB"],encodeOffsets:[[109704,27464]]}},{type:"Feature",id:"520122",properties:{name:"息烽县",cp:[106.740407,27.090479],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@BA@C@@AC@AAA@ACCAA@AAA@@@CBA@CAA@@AAA@AA@@A@@C@A@A@ADA@A@AB@@AA@@CC@AA@@AA@@A@@A@EBA@A@A@@BAB@BA@@BA@@@A@@@A@@@A@@@@@A@@@@@A@A@ABA@A@@BC@@@ABA@@@@@AC@@AAA@AA@@A@A@@BA@ADAB@B@@@B@BB@B@BB@@@B@B@@@@ABA@DF@D@B@BA@A@@BA@A@@@G@A@A@@AA@@AAA@@@A@A@@@@A@CBAAAB@@@@@B@@AB@@@@@@AAA@@@A@@B@@@BCD@@@@CD@@@B@B@@@@CBA@AB@B@@ED@BC@@@@@ABA@@@@@ABA@@@ABC@E@AB@@@@B@BB@@@B@@@B@@@B@@@@DDB@BB@@DDB@@BBB@@B@@@@ABA@@@@BB@BBB@@@BA@@@A@A@@@@@A@ABC@EBA@A@A@CBC@CBA@CAABA@A@A@ABA@@@C@EDGB@@ABC@@@AB@@A@GDG@@BC@@B@@A@A@@@AB@@@B@@@@@@BB@@BB@B@B@@ABAD@@@BA@@@ADABAB@BA@@AA@A@@@A@AB@@@DA@@B@BAB@@AB@@A@@@@@@B@@AB@@@BA@@B@@@@BB@@B@@B@B@@@BAB@B@@@B@@@@@B@@AB@BA@@@BB@@@B@@@BB@AB@@BBB@@BB@@@@B@@@@B@@@@@B@BB@@B@@@@@B@@@B@@@BBB@BA@@B@@@BBB@BB@@B@@@B@@@B@@B@@B@@@B@@BD@@@@BBB@@AB@B@@@@@B@@AB@@ABAB@BB@@BABA@A@@@@@A@A@@@@@@BAB@@@@@@BB@B@@AB@@ABA@@@AB@@@B@@AB@@@@A@@DAB@@A@@AA@A@A@A@A@@B@@C@@@A@AA@BA@@AA@@BA@@@B@@B@B@B@@@@@@@BA@@@AA@@AB@@BB@@@@BB@@@@@@B@BB@@@B@@AB@@A@@@A@@@@B@@@B@BB@@B@@@B@@ABABAB@B@@A@A@A@@@@BA@@@@@@@@B@@ABA@@@@BAB@B@@@B@@B@@B@BB@@B@@AB@B@@B@@@DDBBBBB@DB@@B@B@@@@BBB@@@B@@@BB@@@@B@@AD@@@B@B@B@@@B@@B@BBB@@@@BAB@@@B@BAB@@CBAB@@A@@@C@AA@@AA@@CCAA@@@@C@A@A@AAA@@@ABC@E@ABC@C@@BABABABABA@@@AB@@@B@@@BA@@B@BBB@D@@ABBB@B@BA@@@@BAB@BB@@@B@BB@B@@@B@@@B@B@BB@@@@BB@@@BB@@@@@B@B@@BBA@@BABABA@@@AB@@A@@BBBAB@B@@B@AD@@BBABABAB@B@@@BAAA@A@A@CAA@AB@A@B@@@@@BBBC@FBRBHBHBLJDDHDTLJDDFHBXNVJnbB@JDvLh\\@@BBDBBBHFLHBDBFDFBFBB@@BDBDBFBBBD@BBDBDBBB@x@@B^JDBTH`IP@HBDCTSHW@@JS@C@KBEAIAGBABABADC@@DADAJAD@FAB@@A@@@CEA@AA@A@@@EAECAAAAAC@A@C@A@@AC@@AAA@@AAA@@AEBA@A@@FCDCB@B@D@B@@BFBD@@B@@B@BDBB@BBBBBB@DDBB@BBB@@AB@D@DBD@B@BBB@B@B@BA@@B@BAB@B@@DBB@B@BB@BBBBB@@BBB@B@BADAB@@@B@@B@@BB@@@@@B@B@@@@BBBBBB@@BBDD@B@@BB@BBD@@BBBDB@BBB@D@D@B@@BB@@BABAB@B@BBBDJ@BBBDFBBABABABABAB@DBD@B@D@B@DAB@BBB@B@B@D@B@DHBDBDB@BJFDBD@D@B@DBHFNAZAB@@CbYPgFEBADCRUNGNIF@@G@@@A@CA@AAAA@@@@C@@AAA@@BABC@@@ACCACAA@@AA@AAAA@AA@@@A@@BCAA@@ACAACCA@@AA@@A@C@C@@@AB@@A@@AAA@CAAA@AA@AA@A@@AA@@@A@@@A@A@A@AAA@@A@@A@A@AAEBA@@@A@A@ABA@@@C@@@A@@BA@@AA@@EE@@@AAAAAAAAA@AAAA@AAAAAAA@@@AB@@AFABBDA@AF@@AB@@A@@B@B@@AAA@@@AA@A@@BA@@BAA@@@AA@@@@@@@A@@@AA@EAAAA@@@CA@@AA@AA@@C@A@@A@AA@@BA@A@C@@AA@A@A@@A@AC@@@A@@@AAAAA@AAA@ACA@AA@@@@@@A@@AA@@A@@AA@@@@@A@@@AB@@AAAA@@AA@@@@A@@@AA@@@@AA@@A@@A@@@@@A@@@A@A@@AAA@@@@AA@@@@ACAAA@@@AA@AAA@ACA@AAA@AA@AA@AA@AA@AA@@A@A@A@AAEAA@A@@@@EAA@@@AAAAA@AAA@A@@@@BABA@@B@D@@@@AB@BA@@@AB@B@DCBAAA@A@@@@@ABA@AA@@A@A@CBA@C@@@AA@@@@A@@AA@@@@@AB@@@@A@AAA@@@A@@@@@A@@@C@C@A@@@A@@@A@AB@@A@A@@@A@@B@@@@@@A@@@@@A@@BA@@@A@@@@@A@@@C@A@@@@@C@AB@@ADAA@@AA@@A@A@@@E@@@@@AAA@A@@@A@A@A@A@@@@A@@CA@@ABA@A@@@@CA@@@@@AAABAAA@@A@@A@A@@@A@@A@@A@@AA@ABAA@@A@@ACAA@@@AA@@@@AA@@AA@@AA@@A@@@@@@@A@@@A@@A@@@AAA@AC@A@@@A@@@@A@@@@@@A@@@@@AA@@@@@A@AA@@@A@@A@@@@@@A@@@A@@@@A@AA@AA@@AA@@AA@A@@@@A@@@A@@A@@@AA@@AAAAA@@AA@@@@C@@C@@A@@A@@@AAAAAA@@C@C@@@A@@@@@AC@@@A@@@A@@@A@@AA@@AA@AA@@@@A@@@A@@@A@@@@ABA@@AA@@A@@AA@@A@@@AA@AA@@@@AA@@A@A@A@ABA@@@A@@@A@A@@@A@A@@A@BCAAAA@A@@@@@@@A@@A@AA@@ABA@A@@@A@AA@@A@A@A@@@A@@B@@@BA@@@A@@@A@@AA@@AA@A@@A@@AA@@A@A@A@A@@@A@@AA@@@@@A@@@A@A@A@A@A@@@A@A@A@A@A@C@@@AB@@ABAB@BA@@@AB@@@@C@AA@@A@@@A@@BA@A@@BA@@@A@A@@@A@@A@@@@@C@@AC@@A@ABA@@AA@BA@@@@@@@A@@A@A@@A@@@AA@CC@@@@B@AA@@A@A@@@@AA@A@AA@@A@@@A@A@@@A@A@@@C@A@AB@@A@ADED@@AB@BA@A@@B@@@D@@@@B@@@@B@@@@@B@B@@"],encodeOffsets:[[109224,27623]]}},{type:"Feature",id:"520123",properties:{name:"修文县",cp:[106.592108,26.838926],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@A@AAA@@@@@@@@@B@@@B@A@BB@@@@B@@A@@BA@@@@B@@B@@@@A@@BBB@@@@@@@A@@B@@A@@AA@@@@@@@AA@@@@A@A@ABA@@AA@A@@@@@@@@@A@A@AA@@@A@@@@@AA@@B@@@A@A@@@@AAA@@@A@C@A@A@A@@@C@A@A@@@AD@BA@@@CAA@A@BB@BA@@BADA@ADAD@@@B@BBDBB@B@B@HBDBB@D@@AB@@CD@@@B@@@BABBB@BBB@@DBB@D@@@BB@B@@AD@D@B@BBBBB@@B@B@B@@BB@@B@@@B@@ABABA@CBA@A@ABAB@@A@ABA@ABCB@BA@A@A@A@@AAAAA@A@A@@ACCAIEAAA@C@C@@@EDA@@@C@A@CC@@@@AAA@AAA@A@AB@@A@CDCBAB@@C@CBA@@@AA@@@@AACAA@ECA@AA@AAAAA@@ACAACEAE@AAAAAA@CA@@A@A@@@ABAB@BAB@@@BA@C@A@A@A@A@C@E@E@A@A@ABC@@BAD@@A@A@C@@@ABA@AB@BAB@@@D@BB@@BD@BB@@@B@B@B@@@BBBBB@BBB@@BBB@@@B@@@BB@B@@@B@BABA@AD@@EB@BABA@@B@B@@@B@@BFBD@@@B@@ABAB@B@BAB@B@@BD@@@B@B@D@BBB@DBBB@BB@BBB@FBB@BBBD@@BDBBBDBB@BBBDBBBB@@BB@B@BAB@@ABABA@@@A@A@@@A@AB@@AB@BAB@@A@A@CACACAA@C@AAC@@@EAA@AAAA@A@@@A@AAA@@@A@@AAAA@@AAA@AAC@A@A@AB@BAB@@BB@BBB@@@D@@@BCBABABA@AB@BABA@EB@@CDA@AB@BADA@@@@DAD@@AB@BCD@BABADA@@D@@AB@BAB@B@B@BBBB@@B@@@DBB@B@BAB@B@D@B@@@BA@@@AB@BABCBABCBA@A@C@ABABA@AB@@@BCFABEFA@CB@@ABAB@BABC@@@A@C@@@CBDBBLLh@DJNNVJFHDFDRHbJ@@XRTPDB@BCF@B]NKRCDCHABEHER@DBJ@D@HCHCJOj@D@L@BBlDFFN@@DHBFHRBBNJD@ZHDBFF@@H^FV@@JFLBD@AA@A@@@@@A@BBAB@DBB@B@B@BB@A@@@ABABABAAA@@BCA@@@@ABAAA@AB@@@BA@@B@BABA@AB@AA@@@A@A@@@@AA@@A@@A@@A@@A@A@A@@@A@@@AAAA@@@A@@ABA@A@@B@@A@AAABA@@@CAA@A@AB@@A@@@A@@BA@@B@BABABABA@AD@D@BAF@D@BA@@B@BBB@B@D@@@@@BBDD@@BB@@BBD@@@B@@@BADA@@BA@A@A@@BA@A@@A@AAA@@@@A@@@A@A@A@@BC@@@A@@A@@A@@@A@@AA@A@@A@A@@@CAA@AAAACC@@A@@@@ABA@@@AA@@A@AA@@@@A@@@ABA@A@@B@BA@@@A@@@@@@B@@A@@B@B@B@@@@ABABABA@@@A@@@AA@@A@A@@@A@@B@@@B@@@BA@@@A@@AAA@@@@@@@AA@@@@AA@@BA@@BB@@B@@A@@@@@@@A@A@AA@@@B@@AB@@BB@@ABBB@@@D@@@@AB@B@B@B@B@@BB@@@BA@CB@@@@@BA@@@A@@BA@@B@BA@@BA@@@AAA@@@@@@BA@A@@@@B@B@@@@@B@B@BA@AA@@ABABA@@BA@@@A@@@@@ABA@@AA@A@@C@@AA@@@A@@@@AA@@@A@@@A@@@AAA@AA@@A@@@ABA@AA@@A@@@A@@@@@A@@@AAA@@@@@A@@@@@@A@@A@@AA@AA@@BAA@@A@@@A@@AA@@B@@ABA@@@A@@@@@A@@@ABA@A@@@A@AA@@@AA@@@@@AB@@A@@BA@@@A@@@@B@@@BA@@BA@A@AB@@C@@BAB@@@B@B@@BB@@ABABABC@@B@@A@@BCBA@@@A@AAA@@AA@@@@@@@A@@BA@@B@B@@@@AD@@AH@HCB@@@BA@@D@BA@@HAFCD@@@B@BAB@B@B@BADBB@DAD@DAB@B@B@FAD@BAB@@@@@B@B@@@B@@A@@AA@AAA@@@@AB@B@@A@@@AA@AA@CC@@AAA@CC@@@@@A@@@A@@@A@@AAA@@@@@BAF@D@BA@@B@BA@@@@B@BA@@@@D@@AFC@@@ABAB@DA@@@@@A@A@@DC@@@@DC@A@@@AB@@@B@BB@@@@@@BA@@@A@@@@BABBDAB@@@@@@B@B@@BB@BB@@BB@B@H@@@B@B@@AB@B@@A@A@CCEB@BA@@@@@A@A@@AAA@A@@A@A@@@ABABCB@@AB@B@@@BBB@BB@@BD@@@@B@BA@@D@@AB@B@BAB@B@@@@@B@@@@@B@@@B@@@B@@@B@@AB@@ABA@AB@B@B@FAB@@@@BB@@BB@@BDD@@BB@@BAB@B@BCB@B@D@@@@BB@@BBB@BB@DBB@DA@@B@BBB@DBBDB@BBD@@BD@B@@A@@@@@A@A@@@@@A@@A@@@@@@C@@@AB@B@@ABA@@FCBCB@@@BAB@D@@@B@B@@@B@B@@@B@@@BBB@B@@B@@B@B@@@BBA@@@@@DDB@@B@@@BB@B@@@@B@@@@@@ABB@@BB@BAB@@@BD@@@D@@@@@BB@@@B@B@@@B@@AB@B@@AB@@@B@@@BBD@@@@@BA@@B@@ABABA@@BA@@D@B@B@B@B@B@@@B@B@B@B@B@@@B@@@@@B@@BB@@@B@B@B@B@@@BB@@@BB@B@@BB@@BB@@@B@@@B@@A@@@AB@@@B@B@B@@@BBB@@@B@B@BA@@BBB@@@@B@@@@B@@@BA@@@@@A@@B@@@@@@ABA@@@AB@@A@@BA@@@AAA@@@@AA@@A@@AA@BADAF@B@@@B@B@@@B@BA@@B@B@@@@@@@B@B@@ABA@CB@@@B@@@B@@@BBB@@@DB@@BB@@BB@@B@@ABB@A@@B@@A@@@AD@B@@@B@@@BA@@BB@@@@@BA@@BAB@@@B@@AD@BAB@@@B@@@B@@@B@B@@B@B@@@@B@@@BB@B@BBF@B@@@B@BBB@DDD@@@B@DBD@B@@@DAB@BA@@DBB@BAB@@@BA@@@@D@@@@B@@BBB@B@B@D@@@BBB@@A@@B@@@B@@@B@@DDD@@D@B@B@BB@@@@B@B@B@@@BB@@@@DC@@BBBBBB@B@@B@@@B@@AB@@@B@BAB@@@BB@@BB@DBBB@BBB@@@@@B@BAB@B@@@@BBB@@@B@@B@@@B@@@@A@@@@AA@@@@@@@@B@@@@@@A@A@@B@@BB@@@@A@@AA@@@A@@@@B@B@DAB@@@BBDBBBDBB@BAB@@@@@@BB@@BB@@A@@@AA@@AB@DA@@B@@@@AAA@@@A@ABA@ABA@@BA@A@A@AB@@@BB@@BA@@@@BA@@@AB@@A@@@A@A@@@A@A@@@AA@@@A@@@@A@AA@@ABC@@@A@A@@AA@@@A@@@A@@CA@A@@@A@@B@B@@@@AB@@@B@B@B@BAB@@C@@@@AA@AAA@A@A@@@A@@@A@@@A@@@A@@BA@@@A@@@ABA@AAA@@CC@@AA@@@C@@CC@@C@EACA@@@@@C@ABC@ABC@@@AB@FCB@@@BA@@BA@@A@A@@BA@@@AA@@@@@@@ABA@A@@AAA@AA@@AA@AA@AAAB@@@@AB@@@@A@@@@AAA@@A@@@A@ADA@@@@A@@AA@@@@@AA@@@BA@AB@@A@@@AA@@@A@@@@@A@ABA@@@AA@@@@B@BA@A@@B@AAA@@@@A@A@@@@A@@BA@@@@@@@@AB@@AA@A@A@@B@@@@@@@@@BA@@@@B@@DA@@B@@@@B@@A@@@A@@@A@A@@BA@C@@@AAC@@A@@@@A@C@@@@@AC@@A@@AC@@@@@@A@@@@ACAA@@ACA@@BA@@AA@@@C@AB@@A@@@A@@@A@@A@@@@A@@@A@AAA@A@A@ED@@@@AB@@A@@A@@A@@A@AC@@AAA@C@A@@A@AA@@@AAA@A@@@@A@A@GDA@A@ABA@@B@@A@A@AA@@AB@BA@@@C@@@@@@A@AA@@AA@@@AA@@@@A@@@@@A@@@@AA@E@A@@AA@A@A@A@@@AB@@@@@@AC@@@@A@A@A@@BA@@@A@@A@A@@@AAA@A@AAA@A@A@@@@BAB@@@AA@@@@A@ABA@A@@A@@@@@@@AA@@@@B@@@B@@ABABA@@BA@A@A@AA@@@@A@@@@@ABAAA@AC@@@@A@A@@AA@A@@@AA@@@A@@@@@A@@A@@A@@@A@A@AA@@@@@@@@@DA@@BA@@@@@B@@@B@AB@@@@@@BBB@@BB@@B@B@@A@@B@@BF@B@B@D@B@B@@@DAB@@AB@B@@A@@BA@@@@@ABA@@AA@@@@@@@@AA@@@A@AA@@@@@A@@@@@AA@@AAA@A@@B@@A@A@A@@@@@A@@@A@@A@A@A@@@A@@@A@@B@BA@@BAB@@@B@@@BA@@DBB@@A@@@A@@@@@A@@@@B@@@B@@@BA@A@@BA@@@BB@@AB@@A@@@@B@@@@A@A@@@A@@@A@@@@A@@A@@@@CB@@AB@@A@@@@@BA@@@A@@@A@@@A@A@@DA@@@@@@@@AA@B@@A@@@@AA@@A@@AC@@AAAAAA@@@@BA@@BA@@@@B@@@@A@@@@AA@@@@@@AA@@@AA@CCAAC@CAA@@@AB@@A@@@A@A@@BA@AA@@A@@AAA@CA@AAA@A@@@GE@@@BA@A@A@@@A@@A@@@@@@ABB@AB@@@A@@A@@@A@AA@A@@@B@@@@A@AB@@@@A@@@@A@@@@CAC@@@A@@@A@@@A@@@@BB@@@@@A@AAA@@@@@A@@@A@@A@@AAAA@B@@@@@@@@@@@@@BAA@@A@@@A@@@B@A@@B@@@@AA@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@A@@@@@@@@@@@A@@B@@AAA@@@@@@@A@@@@@@A@@@A@A@@@@@A@A@@A@@AA@@@@A@@@@@A@@@@@@AAB@A@BA@@@@@@@@@@AA@@A@A@A@@A@@A@@B@@@A@@A@@@@@@@A@@@@@A@@@A@@@@B@B@@@@BBBB@@@@@@B@@B@@@@@@CAA@@@@@@BA@@@@@@@@ABA@@AA@@@@@A@@A@@@@@@@@AB@@@AA@@@@@@AA@@@@AB@@A@A@C@@@@BB@@BA@@@@@A@AA@@A@A@@@C@@@A@@@AA@@@AA@@A@@A@AAA@@@@@ABA@@AAAA@A@AAC@C@@@@@A@@@@@A@@@A@A@@@AAA@@@@@A@A@@@A@@@AB@@A@@@AA@@@@A@@@@@@B@@@@A@@@@B@B@@@D@@A@@BA@@@@@A@@B@@AB@@ABA@@@A@@@C@CBA@@@A@@A@@@@AA@@A@@@@@@@BA@@@A@@B@@@@ABA@@@@BB@@@@@@@@@AAAA@@@@A@@B@@@@@@A@@AAA@@@@@A@AA@@@@@@@@@@@CA@@@@B@@A@@B@@@@@@A@B@A@@@AA@@@@A@@@@B@@@@@B@@A@@@AB@@AC@@AA@@@@@@@A@A@@@AAA@B@@@B@@@BCDA@@@A@@@@@A@@AA@AB@@A@A@@AA@AA@@@@@A@@@A@A@@A@A@@@@@A@@AA@@A@@@A@@@A@@@@@@@@AB@@@@CA@@@C@@@@@AA@AC@@C@@@@A@@@@A@A@@@@@A@@@AA@@@A@@B@@AA@@A@@A@A@@A@@A@A@@@A@@BA@@@AA@@@@@A@@@A@@A@@A@@A@@AABA@@BA@@@@BA@@@A@AA@@@@@@@@AA@@@@A@BAA@@AB@@AAA@@@A@@AA@@@@@A@@A@A@A@@@A@A@A@A@@@A@@@@@@@@A@@@@AB@@A@@@A@@A@@@BA@@@@D@B@BBD@@@@@@A@@@@@@B@@AA@@@B@@@@A@@@@@@BCA"],encodeOffsets:[[109131,27400]]}},{type:"Feature",id:"520181",properties:{name:"清镇市",cp:[106.470714,26.556079],childNum:1},geometry:{type:"Polygon",coordinates:["@@BAB@BA@@D@B@B@@@BC@AD@BAB@B@F@F@D@B@B@B@B@D@B@@A@@BA@ABABA@@B@B@@@DBB@BBBB@BBFDFBBBD@@BBBB@BBBB@FDB@DBBB@@@@BB@@B@DAD@@@BADADCB@@@BAB@B@BBB@BB@@@@DDB@D@@@B@FC@@D@D@B@BBJFDBBD@@@B@BBBBB@BB@B@B@B@@ADABAB@BAB@@@BABAB@B@DAB@BABA@@@A@@@AA@@AA@A@A@@@AAAA@A@A@CBC@@@AAA@@C@A@CA@@AA@AAABA@A@@@A@@DC@@BA@@@CAAAC@G@A@AAAAC@A@A@@BCBCB@BC@AB@@AAA@@@AAA@AAA@AACAAAA@A@@@A@A@@C@@AAAAB@@A@A@@@AAA@@@A@@@A@CBA@@@@B@@@@@@@@A@@B@BB@A@@BA@@@@@CAA@A@@A@@AAA@CA@@@@ABA@A@@@AB@A@BAA@@@@E@A@A@@@@B@@@B@@@@@@@B@@@B@@@B@@AA@@BB@@@@@@@B@AA@AAAA@@AAA@@@A@@@A@@@@@ABA@@@A@AA@@@B@@@B@@@@B@@@AB@@@@BBA@@B@@@@@@@@B@@@@@@B@@A@@@@@A@@A@@A@@@@@AAA@@@C@A@A@A@A@@@@AA@@A@A@@AA@@@AA@BA@@@@B@@@AAAA@@@@@@@A@A@@@@@A@@@A@A@@@@A@@A@@@A@@@A@@B@AA@ABA@@AA@@@ABA@@A@@A@@@@@@@A@@A@@@BA@@@@@AA@@@@@@@@A@@@@A@@@@@@A@A@@@AA@AA@@@@@@@A@@@@AA@@@A@@@A@A@A@A@@@@@AB@@@@@@A@A@@@@@@@A@@@A@A@@@@@A@A@@@@BA@@@@@@BA@@@@@@B@@A@@@@A@@A@@B@@@A@@@A@A@A@@@A@@A@@@A@@@@@A@A@A@@BA@@@A@@@@@A@@@@@@A@@A@BA@@A@@@@@AB@@A@@AA@@@A@@@AA@@@BA@@AC@@AB@@@AA@@A@@@@AA@@BA@@@AAA@@@@@@@B@@@@A@@@@@@@@@@B@@@@@@@B@@@@A@@A@@A@@@@@@@@@@@@@@@@A@@@@@@@AB@@@@@@@@@BA@@A@@@@@@@A@@@@@A@@A@@@BA@@@@@@A@@@@@@@@@A@@@@@B@@BA@@@@@@@@B@@@@BB@@@@@@A@BB@@A@@@@@@@@@@@B@@@@@@@@@B@@B@@@@A@B@@@@A@@@@B@@B@@@@@@@BA@@@AB@@@AAA@@@AA@@BA@@A@@@@AB@@@@@@AAB@@@@@AB@@AA@@@@@@AAA@@AA@A@@@A@@@A@@@A@@@AA@@@@@A@BA@@@A@@AA@@@A@AA@B@AA@A@@AA@@@@AA@@@@@A@@@@AA@@@A@@AA@@@A@@@@AA@@@AA@AA@@@@@@ACA@@AA@@@@@BA@@@AAA@@@AB@@A@@B@@AB@@A@A@AA@@@B@@AA@@@@AA@@@@A@A@@@A@A@@AE@@A@@@AA@@@A@@@AAB@@AAC@A@@@B@@@@@@A@@BB@A@@B@@AACAA@@@@@@B@@@@@BA@@@@BA@@@@@AAA@A@AA@BA@AAAA@@@@@B@@@AEA@@A@A@A@@@AA@@@AA@@@A@@@ABABAB@@A@@@B@@@@@@B@@A@@@BBABBB@@@B@@@B@@@BB@A@@A@AA@@@@@@@@@A@@A@A@@@@@@A@@@AB@@@@A@@@@@@@AA@@@@@B@@@@BBA@@@@@@@AA@@AB@@@@@@A@@@B@@@@B@@@@@@@@@B@@@@A@@A@@AB@@@@@@@BA@@@A@A@@B@B@B@@@B@D@@@BBAB@@@A@B@@@@@@@@@@@@B@@B@B@@BDB@B@DB@@DD@@@@B@@@@@@BB@@@@@@@@B@@@@@@B@@@@@@@@B@@@@@@BB@@B@B@B@@@@@@@@@B@@B@@@@B@@@@@B@@@@@B@@@@@@@A@@@@BA@@@B@@@@@@@@B@@@@@@@@B@@@@B@@@BBA@@@B@BB@@@@@B@@@A@@B@@@@B@@@@@B@@@B@A@A@@B@@@@B@@D@@@B@@@B@@B@@@@@@@B@@B@A@@@@@AA@@@@@@@B@@A@@@AB@@@@@BA@@@@@B@@@B@@@@B@@@@A@@@AB@B@B@@@DC@@@AB@@@B@@A@@@@@@@B@@A@@@@BAB@BA@@@A@@@@B@@@@@@@@@@@B@@@@@@A@@B@@@@@@BD@BBB@@@@B@@@@@B@BBB@B@@@BA@@@@@ABA@@@@@@BAB@@@@@B@@@@@BB@@@@B@@@@@@BBA@@@@B@@@BA@@@@@@@BB@BAB@@C@A@A@@A@@@A@@@@ABA@@@@@A@A@@@@B@@@@@AAAA@@@AA@@@A@AB@@@B@@AA@@@@@@@C@@@@@@@@B@BA@@A@@AB@D@BB@A@@@@@A@C@A@@@A@@@@@@A@B@BABA@A@@A@@A@A@@@@B@@@@@@@B@@@@@B@@@@AB@BB@@BAB@@AB@@@F@@@BAB@@A@@B@@A@@B@@A@@@@B@@@B@@@B@@AB@@@B@@B@AB@@@BB@@@AB@@@@@B@@@@A@@@@B@@@BA@AB@@@B@@@@A@@A@@@@@@AB@@@@@@A@@A@@@@@@@AA@@@@B@@@@@@A@@A@@@@@@A@@@A@@AB@@A@@@@@@A@C@@@@A@@B@@AA@@ABA@@@@@@@@AA@@@A@@@@@A@@@@@A@@@@@@@@@A@A@AA@@AB@@A@@A@@A@A@@@A@A@A@@@@@@AA@@@@A@A@A@@@@B@@@@A@@@@@A@@@@@BA@@@@@@@C@@@A@@A@@@A@@A@@@AB@@@@@@@@@@BA@@AA@@B@@AB@@A@@@@AB@@@@@A@@B@@A@@@A@@BA@@@AA@@@@AB@BA@@A@@A@@A@A@A@@@@@AB@@@@@@A@@@A@CB@@@@@A@@@@@@BA@@B@BA@@@A@A@@AB@@A@@@@@@AA@BC@@@@@@@AA@@@@A@@@@A@@@@@@A@@@@AAA@@A@@@@@@@@BA@AB@B@@BB@@@@A@@@AAA@@@@@A@@@@B@BB@@B@@@@A@@@A@@A@@@@A@AA@@@@C@CA@@@AA@@A@A@AA@@@@BCB@BB@@BAD@B@B@B@@AB@@AAA@A@@B@@@B@@C@A@@@ABA@@@@@@@CAA@@@@ABABAA@AAA@@@@AACAAA@@BCAAAAA@AA@A@@AAA@@C@@@@@@BAD@B@@@B@@@B@BAB@BA@@AA@@@A@@@@@AB@@@BA@@@B@@@@BA@A@@@@A@@@@@@@BA@@@ABA@@@@A@@@@@@@@AB@@@@@A@@@@A@A@@A@@@@@BA@@BA@A@@@@A@A@@@A@@B@@@@@A@A@@@BB@B@@A@@AA@@@@@A@@BD@@B@AAB@@@@AA@A@@@@@B@@@B@@@@@@A@@B@@@B@B@@@@@AA@@@@B@@@@@@A@@@ABB@A@@A@A@@@A@@@@@@@B@B@@@BA@@@A@@@A@@B@@@@@A@BA@@@@@@@@A@@@AAA@@@@@AAA@AB@@A@@@@A@@A@AB@@@@@@A@AB@A@@@@@BA@@@CB@@ABA@@@@@@B@B@@CB@@B@@BA@@@@BA@A@@@A@A@@@AB@@A@A@@BA@ABC@A@@B@AA@@@AC@A@A@@@A@@A@AA@A@A@@@@@AB@BA@@@@@G@@@C@@@AAA@AA@@@@A@AB@@@@@@A@@CAA@@AA@C@AA@@@@@A@@AA@@@A@@A@@@@@@A@@A@@A@@B@@@AAAACA@AA@CA@@A@B@@@@A@@BAA@AAA@A@A@AA@A@CA@@A@A@AAAA@@@CA@@@CAAA@@C@E@C@AAB@CBA@A@@C@A@C@C@@@@BBBC@AFD@@DABAB@BABCD@BBJADBBDBBB@@B@ADABAHGHGDA@G@ECCCIEECCCKGAQIBCDC@@BAAABAAAA@JA@CAACBCBCAC@ADCBCBA@ACCAA@FAA@CCA@A@MCAC@A@@ADEB@D@@CACH@ACAGCCCAA@AA@@AB@BCD@@GBA@AB@BBBBBA@ABAAABBBDB@@BB@@@B@BBBBBB@@FAB@B@BAAABBBA@AB@D@D@BAB@DABA@@BABBDBBAB@@AA@@C@AB@@@B@B@BBF@B@DAB@BA@@B@@C@A@@BABA@@@A@@@EFC@E@EACCAAC@I@A@C@E@CBA@C@EBE@E@A@AB@BAD@D@BBBB@BDBAB@DBFBH@FDBD@FCDBBB@D@D@F@BBBDCJAB@@@DAB@BAB@@@BA@AB@@CBA@C@A@@@C@E@BBBBABAAC@CAA@ABC@A@AAA@A@A@ADCHDJBHDJBJ@D@J@BCJGLGJEBGB@@GBIBIDKJIJEDCBC@A@KAMCGCA@OEOEECCAA@IEKAK@OBIBC@EDCBIHCBCFCBE@EBAB@BDJDHABAB@BAB@@AB@@A@@@A@@B@@@@AB@@@BBB@BB@BBF@B@BBCDAB@D@BBB@F@BBDAB@@ABC@ABEDCBEDGFEJ@D@@@@A@AHEH@BGFQPCJAJCFA@@BCDCBULGFAJ@FPPbDB@\\DDDJFBBBNCNIZ@DCJABBh@BBHBDFTDP@B@BBZADKP@@EBYNMJaZABLzDFNVDDHFHDPBVDBB@B@BBB@@BBB@B@@AB@BBB@FDXHNDTT@BDDDDXPN@F@B@BA@@@@@ABABC@ABAN[JIDCDATIL@RHDDPV@@\\lBA@@@@@@@@F@@@F@JCBAVI@A@@FAHCH@HABBLDLJ@@@@DDHN@J@B@B@B@B@L@PDL@BFFDA@@D@B@@@D@BA@ABABA@@DAB@FEBADE@A@@BAB@BABAD@B@B@DABADABA@ABA@@B@@A@@@A@C@ABA@A@AAA@C@@@AA@AA@A@A@ABA@ABA@@@CB@BCBA@ADC@ABA@@BC@C@@B@BC@ABAB@DC@@FAB@BA@ABAB@BABADA@A@@@C@@AA@AAA@@BA@ABAB@B@D@BBB@BB@@BBBB@@@B@@BB@B@B@@@BBBBBB@FB@@D@BBD@B@DBDBDBB@B@@@BA@ABA@@BAB@@@B@B@@@B@BABA@@BA@A@AAA@@AAAAACAAA@CAAACA@AC@AA@AAA@EAA@AAAA@AA@CAA@A@C@A@A@@AC@@@ABA@A@ABABA@@@A@@ACAE@@@A@@@A@AB@BA@AFA@@BCB@BA@A@A@@@AAA@@A@@@A@AA@@AA@AAAAA@A@@@A@A@A@@AAC@@AA@@A@C@@BA@A"],encodeOffsets:[[108993,27436]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
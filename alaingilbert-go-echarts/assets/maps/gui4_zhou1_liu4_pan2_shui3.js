@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("六盘水",{type:"FeatureCollection",features:[{type:"Feature",id:"520201",properties:{name:"钟山区",cp:[104.843555,26.574979],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@@@AAA@@EGECECA@IAIAM@IBMBODQDMBEDEHAHDFDF@@B@@BBBBBBBBDFDBFBD@JABAFCHABAHA@AJ@D@DBDBBDFDFJHBDHFLFHHHFHJBH@LBF@BBH@DBHDLBJDJLCDIBC@M@OHGNBRDNHJHJJLDBBLE@AHOBSDQ@EDO@M@IY[EEACECEGAC@IAGAAAAEECEGGEGEGCC@ACC@@"],["@@AA@@DABA@A@AAAAE@ACAEAA@AC@A@@@AA@@@A@@@A@A@@BA@@@A@@@@A@@AA@A@@@AA@@@@A@@@@BC@A@AA@C@C@CC@@@CACAG@CBC@@@@D@B@@A@@B@@@@@@A@@@@@AB@@@@AB@B@@@@@@A@@@@B@@@A@@C@@@@@@@@@@A@@@@A@@A@@@@@@@@@@@BA@@@@@@A@@A@@@@@@@@C@ABA@A@C@E@A@QH@@A@@@A@A@ABC@A@ABEB@@CBCBA@A@CB@@ABC@ABA@A@@@@B@@kVULEBYLADA@@@AB@@A@@@AB@@ABAB@BAB@@@@@@A@@BABCBC@C@EAICAACAEACBEDEDAB@D@D@HAH@FCFABA@ABA@AA@@@@@@ABA@ABA@ABA@AB@@@@@@@@@@@BB@@B@@@@@@AB@@@B@@A@@@@BA@@@A@@@AB@@A@@@A@@@@BA@@@A@@@@@@@@@A@@B@@@@@B@B@@@B@@AB@BA@@B@@AB@@A@AB@@@@@@@B@@@BJFAFCDCDCB@BCD@FABEFABE@IB@BA@E@CBABHFNFFDLLBL@B@F@@BNAT@DBRDPLBHBJFBHBD@DAFADEFCD@HJJBX@JCJ@@AJABAVAVAH@J@FZ@@@@IFEFEFEBG@G@@@A@@@@@@@@@C@@@@@A@@@A@@@@@A@@@AAA@@@@@@@@@A@@B@@@DAB@@AB@@@@@@@BB@@@@@@B@@@B@@AB@@@B@@@@@B@@@@@BB@@@@@@B@B@B@@@@@@@@@B@@@@BB@@B@@@B@@@B@@@@@@@BBBB@BFB@B@D@B@BBFJFHB@BBD@B@DADCFE@@@AB@@A@A@@B@@@@@B@@@@@@@B@@B@@B@@@B@@@@@B@@@@@B@@@B@@@BB@@BBBBB@BB@@BBB@B@F@BABABEBC@EBEBCDAB@DBBHDD@@B@@@@A@@B@@@@@@@B@@@@@@@@@BB@@B@@@@@B@B@@BB@@@@@@@BB@@@@@B@@@@B@@@B@B@@@B@BAD@BA@@@AB@@@BAD@BA@A@AC@GAGACC@A@@B@@A@@@@@@@@@A@@@@@@B@@@@A@@@A@@B@@@@@B@@@@@@@B@@B@@@@@@@@BA@@@@BA@@@@@@@@B@@@@@@BB@@@@@B@@@@@@@@AB@@@@@@@@AB@@@@@@@@A@@@@A@@@AA@@@@A@@@@@@@@AB@@@@A@@@@@@@@@A@@@@@@@@@@BB@@@@B@B@@@B@DAFIDE@C@@@E@CCAA@EACA@C@EEAEAA@ACAACAG@A@CA@C@ABCBABAD@@@@@DAH@F@DA@AAC@EGC@AA@@AA@@@@@@A@@@@B@B@BAB@@@B@@A@@@@@@@@@A@A@A@A@@@@@A@@@A@@@@@A@@A@AA@A@@A@@@@A@@A@@@@@@@A@@@@@@B@@A@@@@@@@@@A@@A@@A@@@@@@@@@@@@@@A@@@C@A@A@@@A@@@@@A@@@A@@@A@A@@@A@@@@@@@@@A@A@@@A@@@@@@@@BA@@@@@@@@@A@@@A@@@@@@@@@@@@@@BA@@@@BB@@@@B@@@B@@@B@@AB@@@@@@@B@@@B@@@@A@@B@@@@@BBB@@@B@@@@B@@F@JFD@BABA@@@@@@@AB@@@@@@@@A@@@@@@@@@@@A@@@@B@@@AA@@@@@@@A@@@@@A@@@AA@@A@@@@@A@@@@@@@@@A@@@@AA@@A@@@@A@@@@A@@@@A@@A@@@@@@@A@@@@@@@@@@@@A@@@A@A@@@@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@A@@@A@@@A@@@@@A@@@ABA@@@@@A@@@@@A@@AA@@@A@@@AA@@@@ABA@A@@@A@A@@@@@A@@@@@A@@@@AA@@@@AA@@@@@@AA@@@@@@@A@@@A@@@@@@@A@@@@A@@A@@@@@A@@@@@@@@@ABA@@BAD@D@JBHFFBD@BABABABAD@D@F@HCBABCDCDAB@@@@@@@@@@@@@B@@@@@@@BA@@B@@@B@@A@@BB@@@@B@@B@@@BB@@BB@B@B@BBB@@@B@BA@@B@@@BD@F@D@D@B@B@@B@@B@B@@@@@B@@@@@@@@@@A@@@@@@@@@@@A@@@AB@@AB@@@@AB@@A@AB@@@@@B@@@@@@@BB@@BB@@@@@@@@@@@@@B@@A@@B@@@B@BA@@B@@@@B@@BB@@@@@@@B@@@@@@@@@@@@@@@@BB@@@@B@@@@B@@@@AB@@@@@@@@@@@@AB@F@BABEFEBCD@B@BBB@B@BA@@@@@@B@@@@@B@@B@@@B@B@@@DA@CBCFC@@BBBDBB@@B@DABCBC@E@A@@@@@A@@@@B@@A@@BA@@@@@@@@@@@@@@AA@@@A@@@@B@@@BA@BFBD@BB@@B@@@@@@@@@@@@@D@@@B@N@HABAB@D@BADAB@F@BB@BB@FBB@@A@A@@@@@@BA@A@A@@@A@@@A@@@@B@@A@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@A@@A@@@@@@A@@A@@@AA@@@A@@@@A@@@@@@@@@@@@@@@@@@@A@@@@AB@@@@A@@@@@@AA@@@@@AA@@@A@@A@@@@@@@@@ABA@@@@@@@A@@@A@@@A@A@A@A@@ACACAA@@@@@@@@A@@@@@@A@@@A@@@A@@B@@@@@@@@@@@B@@A@@B@@@@@@A@@@@B@@B@@B@@@BB@BB@@@@@@BB@@@@@BB@@@@@@@@B@@@ISHICAEEBGACACDACAEACAAAAAC@AAAAAA@@A@A@AA@@AA@EABAB@AA@A@@@C@A@@@A@@BA@@@@@@B@@A@AB@@A@AAAC@@@@@A@@A@@@@@@@AA@@@@@@AA@@@@@@@A@@@AA@@AA@@@@@A@@@A@@@@@A@@B@@@@@B@@A@@@@BAB@@@@A@@BABAB@BABA@@@A@@@@@@@@AAAAAA@@ACBADCBABA@C@@A@@@C@A@A@A@@@@AA@@A@@A@@"],["@@A@A@@@@@@@@@@@@@A@@@@@@@A@@@@B@@@B@BAFBB@@@@AB@@A@@@@@@A@@@@A@@B@@@@@@@@@B@@B@@@B@B@B@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@B@@@@@@B@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@AB@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@A@@@@@A@@@@@@@@@@@@@@A@@@@A@@@@@@A@@A@C@@@AA@A@"]],encodeOffsets:[[[107173,27385]],[[107441,27199]],[[107564,27190]]]}},{type:"Feature",id:"520203",properties:{name:"六枝特区",cp:[105.476608,26.213108],childNum:1},geometry:{type:"Polygon",coordinates:["@@LKXMDEDEDGHKNI@A@@BADBDBDBDBBDDDBD@DB@BBBBB@D@DAD@@AA@@@@A@@@@BAD@@AAAAC@A@C@AAC@AB@@ABBD@B@D@FADBBBBDDDBBB@@@BBB@@@BBB@@@DBB@B@B@B@@@B@B@@@BADCB@@ADABAB@BABBB@@@B@B@B@B@B@BBD@@@FDHFDBB@D@B@B@BA@ACE@C@CDCBAD@BA@ABAB@@C@@@A@A@@B@B@@@BABAB@D@@@B@D@DAB@DAD@F@D@@@DA@@F@D@B@FAD@L@@@B@B@@@B@B@@@@@BB@@@@B@B@@@B@@@B@@@B@@@B@@@@@B@@@B@D@@@B@D@B@B@D@@@B@@@B@@@BAB@@@B@@@BADA@@B@@@@AB@B@DA@@B@@AB@B@@@@@BA@@@@B@B@BA@@@@BA@@BAB@@@BA@@B@@A@@BAB@@@BA@@@@B@@@BAB@@AB@@@@AB@@@BABAB@BAB@@@@AB@@AB@@@@ABA@@BA@@BADA@AB@B@DB@@B@BBBDDBBDBBD@BCB@@A@ABC@EBC@C@A@@@E@AB@@AB@@@CCAAAA@AAACAC@C@CBA@@BADABA@EBCBK@CAC@@@C@AAAA@@AA@@CAGCCA@@BA@A@@@A@A@@@A@A@@@A@@@AA@AC@@A@@AA@A@A@A@A@A@A@@AA@AA@AB@AA@@@@DA@ADE@ABEAA@AAAA@@AA@@@A@@@EBA@A@@@A@A@CBMFIDI@GA@AEAEBCDGLEF@BABABAB@@@D@@AB@@A@@@CB@@AB@@A@@@A@@@A@@@@@A@AB@@CCEIDIFOBG@@BCCMEKCE@A@ACGAI@E@CAC@A@A@CAA@@@C@A@CAC@A@C@G@@@A@AAA@CAE@AACAC@ACGEGSHORA@MFE@K@UEEACAKGMK@@@@CC@@@@AAGGGIACKKEI@@GGMGEAIC_CEAMCYGSC]AQBU@WEYAU@OFMDMAMAKAUCYCOCQAWDSDUAKEIEEGEK@A@@ACAA@CBABABABAB@B@AEACCAA@A@A@GB@@A@@@AD@@A@AB@@ABEB@@ABAB@@AD@@CDADABADAD@D@DCB@@ABAB@BAB@B@B@BA@@B@D@BA@@@ABA@@B@B@BBDBD@BAB@@ADAFEBAB@BBB@DADAFCHADGBEFAB@J@BCBA@ADA@@@@@ABA@A@@@@@AB@@ABCB@B@@AB@B@B@@@D@DBBBB@DBD@DBB@DBB@D@D@D@B@B@DADAB@BAB@BAB@D@@@BABABED@BADEHADEFCD@B@D@HBDBF@DBDBF@B@BB@@H@DBHAFADADADBB@@B@BAB@B@DBBDDBBB@B@B@B@B@B@B@B@@@B@B@DA@A@@@A@A@@@A@CB@@A@A@A@@@@@C@A@C@@@E@A@A@A@A@A@A@@@A@C@A@@AA@A@@@AAA@@@AAAA@@@A@@A@@CA@@A@@@AAA@@AA@@@AAAA@@A@@@@AA@@@@AA@@@AKGAA@A@ACA@@CDCB@BAB@BABC@ABA@@BABCDAB@@D@@BBB@BBDBBDDNDBB@DBD@BABABAB@B@BBBB@@BNBFBBB@B@BADCD@B@DBBPFJDFDFDJFPJFHBDFLDJFHDFNHLDDH@L@HAFDPBP@@BP@DBFDNHTFFDFFDJFRJDJ@L@@BB@@@@@BB@@@@B@@@B@B@@@@@B@B@B@@@B@@@BAB@@A@@@A@@@A@@BA@A@@@AB@@A@@B@@AB@@@B@@@B@B@@@B@@@B@@@B@@@B@@@B@@@D@B@B@@@B@@@B@@@B@@@BA@A@@@A@@@A@@@C@@@A@A@@@AA@@A@A@@@A@AB@@A@@BA@@@EAE@Q@CBEDGDCFCDAD@DBD@B@DEBCB@F@D@H@B@B@BDDDDDBB@NJJBHDTJHFJFNNHFFDJLTTFPALGFGFKDMDKJEFCFINAJA@@B@@A@@B@@AB@@@@@@AB@@@@@@@B@@@B@@@@BB@@B@@BB@@@B@B@@@B@B@B@@@D@@@@@B@@@B@BB@@B@B@@@@BB@@@B@@B@@@@B@@@BB@@B@@@B@B@@@@@BB@@B@@BB@@@BB@@BB@BB@@B@@B@@@@B@@@@B@@B@@@BB@@@@B@@BB@@@B@@B@@@@B@@@@@BB@@B@@@@BBBB@BDAD@B@D@B@F@DADAD@DAD@F@D@BBD@BBBB@BBDDBFDDBBD@B@BBBB@@@@@B@@@BB@@B@B@B@B@@@B@B@@@B@@@B@B@BA@@B@B@@AB@DAB@@@B@@@B@BA@@@@DA@@B@DA@@BA@@B@@A@@BA@@@A@@B@@A@@B@BA@@@@BA@@BA@@@@BA@@BA@@@@BCBCBC@@B@@@DAB@@@B@@@B@@@BA@@B@B@BAB@B@B@D@B@B@@BB@B@@@B@BB@@B@@@B@@A@@AAAC@@@@AA@@@A@@@A@@AA@@@A@@@CAAC@AAC@CAAC@@@A@ADCDGDCBA@CB@@C@@BA@@BABC@@@C@C@CBA@@B@D@FD@DBFBDBDF@D@B@@@DCFOFGDABAJ@F@DA@@HCJAFATBN@B@B@TCB@JGFG@@DCFMJAJAB@FA^@ZARBT@B@B@JCBAHKDOAOHQFMDGH[@@JMFM@AFMQEUEIGGEAAOIIK@@CCCOAKEI@CBKAE@@AG@EIEEEIGA@AGA@BK@C@K@IBE@A@ADC"],encodeOffsets:[[108007,26889]]}},{type:"Feature",id:"520222",properties:{name:"盘县",cp:[104.471375,25.709852],childNum:1},geometry:{type:"Polygon",coordinates:["@@EDCDADCB@@A@@@@BA@@@@@@@A@A@@@@BA@@@A@@@A@@@AAA@@@@@A@@@@@A@@@A@CB@@@@A@@@A@A@@@A@A@A@AAC@AD@@A@CDABABCBA@CBCBABCB@BABABAD@BED@@C@AB@@@BB@BB@B@B@BCBABCBABEBABC@A@CBAB@FABCBCBE@C@A@AAAAACACAAAAAAC@A@A@@@CBA@ADCDABABA@C@C@ABCBAD@@AB@D@DBDBBB@BDBFBD@F@FBF@F@D@F@H@F@D@D@@@DADAD@B@FADBF@FBBBDB@DBBDBDBD@D@F@F@H@DBDBF@FBDBD@FBB@DAD@DAFAD@B@DDFBFBBAB@@@BA@@@A@@@@@AB@@@B@@A@ABA@@BA@@@@@A@@@@AA@@@A@@@@@@@@A@@@@@@@@@@AAAAAA@C@CB@DCBC@CA@AD@@CBA@C@@@A@@@@@@@AB@B@@@BA@@@@@AB@@@@AB@@@@@@AB@@@@@@@AA@@@@A@@@@@A@@@@@@A@A@@@@@A@@@A@@@AB@@@@A@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@AB@@@@A@@@@@@BA@@@@B@@@@@@A@@@@@@@@@A@@@@@@A@@@A@@A@@@@@@@@@@@A@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@B@@@@A@@@@A@@@@@@@@@A@@@@@@A@@AA@@@@@A@@@@@@@@@AA@@@@@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@AA@@@@@@@@@@@@@@A@@@@A@@BA@@@@A@@@@@@@@@@@@@@@A@@A@@@@B@@@@@AAAA@@A@@AACAACACAC@C@CCCA@AAAAAAACACAAACAAAAA@@C@@@C@E@C@E@A@E@CBC@C@C@C@@@C@A@CDEAAC@AAE@E@EBCA@@CCIABEBC@@IEAE@CDCBA@AB@DBD@J@D@DA@ADEHIDCFB@FDHBJDBDDRDJ@DAFAF@DBDBD@B@D@DA@AB@B@BABAD@BBD@BBF@F@BADCBCBABCBCDAB@B@BBDBBDDBDBB@BABADCDCDADABABC@C@C@C@ABCBEBEBCBA@CDABCBEBE@A@AAAAACCCACCAAAA@C@CACAECACACCAA@ABBBBDBBBD@B@BAD@BBDBD@BABADC@ABCDCBC@A@A@CAA@A@@@C@CB@@A@MAKAA@@@CBAB@DCD@DBD@@DD@DADADABCDCDEDEFEDADCDADABBB@@B@BAB@B@DBDDFDBD@BABABE@A@ABC@CBABA@ABAB@D@H@DADADBB@BD@BBD@BD@B@BAD@BBD@BBBBB@D@B@D@DADBB@FBBBDBB@F@DBB@@@BBDBBBD@B@D@BCJ@F@D@HB@@BBD@BAD@BAD@BBDBBDB@@BBBB@DAB@B@B@@AD@BAD@D@F@BBFBD@@BD@@BB@@B@BABABADAB@BCDABABAFAFABCFA@@BAD@@@@CAC@CBABADCDAD@BADADCBADBBADBFBBBBDBBDBDDDDBDBDBBBHDFBF@@BFBFF@H@DJHjAN@D@F@@B@FABAFBB@DLDPBBJHNFFF@HCHCH@@EJAHFTFLHFDFDBBBDDBBBB@BABABABAB@BAD@D@D@DABA@BRCDEDEAGC@AA@@@AAAAA@ABAB@BAD@D@DAFADAF@D@DBDBDDBDDDBDBB@F@B@DBBD@BB@BBB@BBDBDH@H@DLDBHCFEB@D@NBDBDDDBBBB@DBBBDDD@BDFDFBBDDDBBDBD@B@BAF@D@HBBDBBB@B@B@@@B@B@B@BBBBB@BB@BBBB@@@BAB@@@BA@@BA@@BAB@BADAD@B@BAB@D@BAB@D@B@@DBF@DF@JBHBFDF@D@FELEHEHCFKD@BAB@B@B@B@BBB@BBB@@DJB@B@BBB@DBD@BABABE@@DAHA@@BABABADAB@BAB@B@DAB@B@BAB@BABA@AD@D@B@@@B@@@B@@@B@DBBBBB@BBBB@@B@BADBB@BDBDBBBD@FBBBDBBBBDBD@BBBBB@@@@@BB@BFBB@B@B@DABBBBD@B@@@D@BBB@DABAF@D@BABABABAB@@@BABAB@BABAB@D@B@B@D@BAB@B@B@B@B@B@H@B@BBBAD@B@D@@BB@B@D@F@D@B@B@B@@@BDBBBBBB@BBBB@BFB@@@BB@@BDFBD@F@@BF@@BBBB@DBBBDBBBBBF@B@B@BABAF@BBB@B@BBBBB@@BDB@BBB@D@B@B@@@BB@@@D@B@BBB@BBB@@ABB@@@BB@BA@CBC@@B@@@BAB@B@DAB@BAB@B@@AFAB@@@BA@BB@B@@@B@BA@@B@B@B@@@D@@AD@@BB@@@@BB@@DDDBBBBFBDBD@@BFDHBDDBBB@BB@DBD@FDBBDBBBBD@BBBB@BBDDBBVCT@HAJEB@LIFMBKCIOEEMBAFOFGFEPGNEJKBSBE@C@@@QBiBE@C@A@A@@BA@ABA@A@A@AB@AA@A@@@A@A@A@A@A@A@AB@A@@ABABABA@AB@BA@AB@@AB@@A@CBAA@AEECE@G@CAFMHC@@DATED@F@DAB@H@F@N@DADBBBD@DAD@D@B@HAF@HCACBACABGB@@AAADE@A@A@CBEAIHEBK@IBE@EFIJBDBVLLDP@JAHAPCPA@@D@N@NAL@LBVBB@NJDDHH@BXNBDFDDBJ@FGV@@@NB^ATAJCBAFCDCNUJMHGD@H@BABA@CAEAE@@@A@C@A@AAABAB@BA@A@ABABC@ABABAB@B@B@BBDBB@DBBBB@LLFFFBL@H@HABABABAB@BA@@BAD@@@B@B@ACEGCGAAAA@C@AAABC@A@A@A@@Eq@ACCGKSsCUAMAQAAGIACFMFIB@RGFEDCAKCECECCBCHM@eCYKAC@A@A@A@@@EASOEGECMGSBUQ@@CC@EEWEEEIAA@MAAA@E@A_@S@CBCD@BE@CAAGCBCDABGDABC@EFKEMDCBALOJOBCNOJIBCBGHA@CDGBIGC@CCKCM@@CMEUIQQMSKAACAGCKM@@AAAEAA@ABKDCHGBADEJIDEFKJA@C@@@A@C@EFE@GSIBKJIFE^@XMCMAC@GBU@KBGUaAA@GAE@@MUACA@[Y@AKKEGS[@AAAFE@@@AGEAACGGW@E@CAE@C@@@I@@BABGBC@E@@BE@A@ABCBEB@@AB@FAB@B@B@B@DCBABC@AF@B@D@B@@@BAB@B@D@B@BAB@@AB@DABABAB@@AAC@A@A@AACCACAC@C@AAA@@A@AA@CGGCGBCBENABKJGDA@EAECIMIQYMSAWEA@A@GAA@A@Q@UB@@IBYBE@@@@@UAcCIAQCICKEIA@@C@IJ@NFPCNIPMJMLKHECCACKCGAICCABCBAAA@AAAA"],encodeOffsets:[[107158,25966]]}},{type:"Feature",id:"520221",properties:{name:"水城县",cp:[104.95783,26.547904],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@A@@@A@@A@C@@BA@@@@@@@@@@@@A@@@@@A@@A@@B@@@@A@@@@@@@A@@BB@@@A@@@@@@@@@A@@@CC@@A@@BAB@@@A@@@AA@@AA@C@EB@@@AA@BA@AAA@@AA@@CACCA@C@ACAA@A@AAEAA@@@E@@A@CA@@A@AB@@A@AA@@A@ACCAAB@BGBA@ABC@@@@@CHAFAHBDBB@BAB@@@D@BA@ABAB@FA@@B@BA@@B@@ABABB@A@CBA@C@A@ABA@A@CBAAA@ABA@AAC@C@EBCB@@ABAB@BA@@@@DCF@@C@@AAA@@BCBA@ABABCB@@A@@@AAAC@@A@A@@BA@AB@AA@@@@A@@A@@AAAC@@A@@A@A@@AAA@@@AA@@@B@@@B@BB@B@@B@B@@@@ABAB@BA@A@A@AB@@AB@BC@@@@B@@@@@B@@@@AD@@AD@@ABCBABABADABKBK@A@EB@D@BB@@@@@@@@B@@B@@@@@@@@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@B@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@A@@@@A@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@A@A@A@@@A@@@@AA@@A@@@AAA@@AAC@AA@@A@A@@@A@BB@@@@AB@@AA@@BB@B@@A@@@A@@B@@@@@B@@@@B@@AB@ADAB@B@@@@BBAB@@@@@BA@B@@@B@@@@@AB@@@@AA@@A@@@@B@@@@BBA@AB@@AAA@@A@@A@@A@@@AA@A@@AB@@A@@@AA@@BA@@@A@A@@@B@@BAB@B@BAB@BAB@B@BCB@@BBD@B@B@@@BB@@B@@BB@@@@@@@@A@@@ABAB@B@B@@B@@DDBBBBA@@D@BB@@BBA@A@AB@B@@@B@@BB@B@@ABAB@@D@@@@ABBD@BDB@D@D@BBDBBBB@A@@@BBB@@@@B@B@BB@@BBDBB@B@@BD@@BB@BBAD@B@@EFABAD@@AB@BCCAAA@A@AD@BA@@@@BABECCA@AA@A@@A@@AABA@@A@@@BAA@A@AA@@A@@A@@@BAA@@@C@AA@@@A@@B@@@@ABA@@@BB@B@BA@@B@@@AA@BBC@A@@B@@@BB@BBAAA@AA@@@@@BA@@B@@BBA@@BAA@@A@@@A@AA@@AB@@@BA@@A@A@@@AC@@@@@BBA@@@@@AAB@@A@A@@@AA@CA@@ABAA@@C@A@BB@@@D@@A@ABA@AAA@EAACAAA@ABA@A@@@A@@@@@@@@@AA@@@@A@@A@@@@A@@AAA@@A@@@@AA@@@@@@B@@@@A@@@@BA@@@@@@@@@@@A@@@@B@@@B@@@B@@@@B@@@@@@@@@BBBDBD@@@B@B@B@B@@@B@@@B@@@@@@AB@B@@@@@@@@@BB@@@B@@B@@@@BB@@@@@@@B@@A@@B@@B@@@@@@@@@@@@@@@@@@@B@@@@@@B@@BB@@B@@@@B@@@@B@@@@B@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@BA@@@@@@B@@@B@@@B@BAB@@@@@@@B@@B@H@BBB@@BB@JDB@D@BABABABAB@B@@@B@@BBB@BDBB@BB@BDB@BDDDJBBBB@@@D@B@D@B@B@BBDFJ@BBB@B@@DF@@BHB@BHHD@@HBB@DBJ@B@H@B@@A@AFCDCBAD@BC@A@AAEBADAFG@ADEACJGDEHEB@FCCH@@@BB@DABBF@@@DGPEFADCDEDC@ABEDMDC@@FCBBFB@@NDDBBABCAECCCIDELEDEBCBCBEBIDCBQDGDC@@HBFDLLFDB@RHPB@@N@HEFCDEDCHAZIDSIY@OA@AAA@@@AB@@A@EE@CAACA@@A@@@A@@@ACA@CC@@AACA@@A@@AA@A@A@@@@@BA@@@ACE@@AA@@EA@@@BA@@BA@@B@B@@A@@@AB@@@BDF@B@F@@@@@@AA@@AB@AAAA@CA"],["@@A@CBABC@A@ABGBM@A@@@C@@@@@@@@@@@@@A@@@AAC@EA@AAB@@A@@@@@@B@@BB@@@@@@@@@@@@@@AB@@@BA@@@@@@B@@@@@B@FADADCBA@@@AAACAA@@EDAD@DCB@@A@A@@@A@@@@A@@@@@A@@@@B@@A@AAA@A@ADCFAFEBA@A@EBA@@@@@@@@@@@@BA@@@@@A@@A@@@@@AA@@@@@@@@@@@@@@@@@A@@@@@@AA@@@A@@A@@@ABA@@@A@@@@BA@@@@@@@@@@@@@A@@AA@@A@@@@@@@A@@@@BAB@@@BA@@@@BA@@BA@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@A@AA@@@@A@A@C@C@EAC@@A@@@ABA@@@A@AAA@A@A@@AA@@A@@@AA@@@@@AA@@@BA@@@A@@@AB@@@@@@A@@@@@@@@@@@@@A@CBCDADABGDE@C@C@ABABABABC@EAGEIAC@C@AB@@AB@B@@@@@@@@@B@@@@@BB@@@@@@B@@@@@@@B@@@B@@@@@@BB@@@@@@BB@@@@BB@@@@@B@@@@@B@@@@@B@B@@@BAB@B@@B@@B@@@B@@BB@@@B@@@@@B@@@@AB@B@@@B@@@@@B@@@B@@@B@@@@@B@@@@@@@@@@@@@B@@@@@@@B@@@@@B@B@@@B@@@@@@@@@@B@@@@@@@B@@@@B@@B@@@@@@B@@B@@@BB@@@@@B@@@@@@@@@B@@@@@BB@@B@@@B@@@@@B@@@@@@BB@@A@@@@@@B@@@@@@@@@@@B@@@@@@A@@B@@@@@@ABABC@IEE@@@@A@@A@@@A@AA@@@@A@@@@B@@A@@@A@@@@@@@A@@BA@@@A@@@A@@@@@AA@@@@AB@@@@@@@@@@@@@@@B@@@B@@@@@@@@AB@@@@@@@@@B@@@B@B@@@@@@@@@B@@@B@B@@@B@@@B@@@@@B@@@B@B@D@@@B@@@@@@@@@@@@B@@@@BB@@@@@@@@@B@@@@A@@@@B@@@@@@@B@@@@B@@B@@@@BBBB@@@@B@@@@@B@@@B@@@@@B@B@B@B@@@@@@@@@BA@@@A@ABA@A@@@@@@B@@@@B@@BB@@BHD@FBD@BCBE@G@CB@@@@C@ABABAD@B@DDBB@H@DBBBBDB@FBFB@F@DDBFBB@DB@D@F@@@DCFEJCBA@@@A@A@@@@@AA@@@@@@@@@@@B@@@@@@@@@B@@A@@B@@@@@@B@@@@@BB@@B@@@@@@B@@@@@@A@@B@@@@@@A@@B@@@@@@A@@@@@A@@A@@@@A@@@@@@@@@AB@@@@AB@@@@@@@@@AA@@@@@@@A@@@@@A@@@@B@@@B@@A@@@@@@@@B@@@@@@@@@BA@@@@BDDHBHBD@@B@BABC@AB@@A@@B@@ABC@ABA@@@A@A@@@A@@@@@@A@@@@AA@@@@@@A@@AA@A@@@@@A@@@AA@@@@@@@@A@@@@@@@A@@@@B@@A@@@CCAGCAA@CBADAF@FADAFABABE@A@A@AA@@AAA@AAAA@@AA@@A@@@A@@@@@A@@@@@A@@@A@@@@AA@@@@@@@A@@@@@A@@@@B@BA@@B@@EFCDCBA@C@AAA@EGEIAAA@C@A@A@AEA@AA@A@@@@@@@A@@@A@@@AA@@A@@A@@@@@@@@@A@A@A@@@@@@@AA@@@@A@@@@@A@@@A@@BA@@@A@@@@@@@AA@@@@@@A@@BA@CB@@A@@@@B@@@@@@@@BB@B@@@B@@@@@B@@@B@@@@@D@@@@@@@@@B@@@HAHEFEFEF@J@@Y@@H@PB@HNJLBBDDFDHFHDHHNJLPDR@@FRLLB@FDJFJJHLBPHJRJLBB@JCJCHIHKDMDOLMPKDADCLGVMH@LALFHHLNFL@@FPJRJRLRPRHHDFNHBBPDDBTFTJFB@@NDN@H@DGBQF]@U@@ASEIGGCCIGGCAAKKGK@C@@DOLSPIZC\\CTEJCFCNO@@HI@KAAA@@AAAGCMECILGJAFAPCFATCNMBCBABE@QACGIKKGO@C@M@I@@@CBMFMFG@ALKFO@C@@@M@@@GAGIECC@CE@AACBA@@A@@DGEDA@GFCFIHBDCF@BEHCBABBF@B@BADC@ABCDED@B@BA@G@A@I@CAA@GA@@GCAGA@AG@@CE@@@AAA@AEIAC@A@A@A@C@A@C@@AAAACICC@ACA@AAAA@CA@AAA@AA@@@A@A@ABABABABC@A@ICA@@AA@AAG@A@@@@BA@EAA@@AAAE@"],["@@CCMIA@UAKAK@MBM@C@@@OBODGBIBO@KCUKCAIAEJ@FAF@JALGFBJAF@D@B@BCFBB@BA@AHDBABBDGDE@GBA@C@C@CBC@AACACBM@E@G@A@CBE@C@SFCB@@GDENDBH@F@FDBFB@AB@D@BA@@BA@@BABA@@BABABAB@BB@A@@B@B@B@B@B@B@B@@@BBBA@@B@B@BAB@BAB@@@B@B@DAFAj@R@@@DAFATILMFOHEFEHEPABFNPFDJALENKJA@IFGBS@UDDDDB@BBB@@BD@BAB@B@BBB@@BBBFBBDDDDBDBD@B@@BB@BB@BBB@HBBBBBD@B@F@HBD@B@BBHDBBBDB@D@B@D@B@B@B@FABBF@BBD@HBF@D@BBB@BBDBB@B@B@@@DBB@@BB@@B@@@B@@@B@B@B@BAD@B@B@D@B@B@BA@@B@@@DBB@DDDBBDBBDB@BBDBBBB@D@DADAB@B@BBB@BDB@BDBD@BAB@D@D@B@@BB@@EBABCD@@CBAB@BC@@BA@ABABAB@B@D@BABCDABAD@@@D@B@BBBDDBDBB@BAB@DABAF@B@BABAB@BBB@B@@@B@B@B@D@@AD@BBD@B@BABA@ABC@ABC@A@@@C@C@CBABC@AAA@A@CBA@ABCD@B@BAB@BCD@@CDABAB@B@B@B@D@DAFBB@BBBBB@@BB@BBD@B@@BD@FB@@BBBAB@BABADCDABAB@B@BABABCDA@ABABEFCB@B@@@B@B@BABC@@BCF@B@B@@@@AB@@@@@@@@A@@@@@@AABA@CDEB@BABA@@B@BABCBCDA@ABAAA@@@@BA@ABABA@C@A@CBA@CBC@A@CBA@A@CAEAA@ABA@@D@BAD@B@BAB@BBD@D@BBBD@@BF@D@FBBBBBBAD@B@B@@@PG^GB@@@RGVGPCLEB@XEB@@@VATF@FADABCF@B@@ELLJD@F@NDHL@D@BBLAV@B@B@TEXAR@DNNRJPLFBHDLHBADAF@B@@AJAF@BAFEBA@EDC@ADADCDCBEIE@A@@@A@@@@@@BAB@@@BA@@@AB@@ABA@@@A@@@A@A@@@@@AB@@@@@@@@@B@@@B@@A@@B@@@B@@@BA@@B@@@B@@A@@B@@@@A@@BA@@@@@@@AA@@A@@@@@@@@@@BAB@BAB@BAB@BA@@@@@@BBB@BAB@BADE@EBG@G@C@CBAFCFCDAFBDBBBJDFBD@D@DABA@AB@@@@@@@BA@ABABA@@BA@@B@@@BA@@B@BCZKFAVKlU@@@A@@B@B@BAD@BA@@DAB@B@DADA@@FABAB@D@BAB@B@@@B@@@RGB@F@D@B@B@BAD@@@@@@@@@@BB@@@@@@@AB@@@@@@@@@@B@@@@B@@B@@@@@@@@@@@@DB@@@A@@@@@@B@@@@A@A@@B@@A@@B@@@@@B@@@@A@@@@BA@C@@@@@AD@DBHBD@D@@DDD@D@B@@B@BAD@@@@@B@@B@@B@@@BBB@@@B@@B@@@B@@AB@B@@@B@@@B@@B@@@BBDB@FBDB@BBFBB@B@BABCB@@BB@@@BB@@@BB@@@@@B@B@B@D@@@BD@B@BADABCDA@BB@BBBB@B@@@@@@B@@@B@BA@ABABA@AB@@@@@BA@A@@B@@@@A@@@@@AB@@@@@B@@@B@@@@@B@@BB@@B@@@B@@@@@@BB@@@@@@BB@@@@@@B@@@@B@@@@BDBBB@@@BAB@@@@A@@@@B@@AB@@@B@D@@@B@B@@BBABA@FBB@@BBB@B@@@BBBBBBD@BBBBDBFBDBCBBDBDAHFFDBGJJTB@B@BAB@BBBDFBB@BBB@BAB@@@@C@@AAB@D@@@BBBA@@DBB@@B@@@B@BA@BB@@@@B@AA@@@@D@@B@@@B@BB@@A@@BA@@BBB@@@B@@@BB@AB@AA@@@AB@@A@@@@BBB@BBAAA@@A@@@AB@D@AAB@@B@@@AB@@A@AAA@@B@BA@@@@@AB@@@B@@B@D@@BB@A@@@BB@@@BBB@B@AB@@B@@@ABBB@@@BB@B@@BDBFDBA@A@@B@@ABCB@B@BBDD@ABA@@BCBAFE@@@ABCAAA@@AC@@AA@A@CAAA@@AAA@A@@@@@AA@A@@@BAAAAAC@A@C@CCA@AACBA@@@@@CA@AB@BA@A@@AA@@@A@A@@B@BAB@AA@@A@CB@AAAACC@@@AA@A@A@AB@B@@@B@@@@@@A@@AA@@@AA@@A@A@C@AA@@DA@A@ABA@ABA@A@ABA@AA@@@B@B@@@B@@AB@@B@@@BA@@BB@B@@B@@@BB@@@@BB@BB@@BAB@AA@@@@@A@@B@@@BB@@@@BA@@@@A@@@A@B@@A@@@@BAAA@@@@@ABABCA@@BA@@@@@@A@@@@@AB@@@B@@@@AAA@@BB@@BA@@@@AAB@@@B@B@@@BBD@BB@@BB@B@@@BB@@@@@@@@@@AB@@@@@@B@@@@B@@@BA@@@@AABE@A@A@@@A@@B@@@@@@@B@@@@@@@@@@@@@B@B@B@B@@B@@FAB@L@LABABCBABADABA@@BC@@BC@@@@@A@@@@@A@@D@@ABA@@BAB@B@B@@ABABA@@@@@A@AA@A@@A@A@@@A@@BB@@B@BB@@@B@BB@@@BDBB@@@BB@@@@@BBA@@BAB@@@B@BD@BB@B@@@BA@ADAB@BABAD@@BB@BD@@@DE@C@@B@@ABABA@@DAFAD@D@BBB@BAB@BBDAB@B@BAB@D@B@DAB@A@BABA@@@AB@@A@AB@@EBABAB@@A@C@@BA@AAAACBGBEDG@@@@D@BAB@HA@ABADBBDB@@@BBB@@@BAB@@@DBB@@@@F@@BBBF@B@BBBBDD@B@DDDB@@BB@@BB@BABB@@B@@FAD@B@@BB@@B@@@B@@BA@AB@@@DD@@B@@@@@@@@@B@@@A@@AB@@@@@@@B@@@@@@AB@@@@B@@@@@B@@@@@@@@@@B@@AD@B@@@@B@@@BDBB@BB@BBA@@BB@@@@@@@E@ACE@A@@BA@@B@@@@A@AB@@AB@@A@@FB@@BB@@DF@B@@AB@@@@B@B@B@@BB@@@DBBB@@DDB@BD@@B@@@B@@@DBBB@DFFB@@@BA@@B@BBB@@ANM@@FE@A@@D@BAB@B@B@DCBAB@DAB@D@B@BAB@@@D@FAFABAB@B@B@BAFCBA@AAAAA@@@@@AA@@A@@@@@A@@A@@@@A@@AA@@@A@@A@@A@@@AA@@@@@@A@@A@@@@AA@@AAA@@AA@@A@@AA@@@AA@@@@A@A@@@A@@@AA@@A@@@@@@AA@@@A@@A@@A@A@@@AAA@@@A@@@@@C@@@A@A@A@@@A@A@@@A@@AA@@@AA@@@@@A@@@A@@@@@@BA@@@@@@BA@@@AB@@@@AB@BIJMDEFELINCLCHEHEBKEOSSIKECGEMMIEGESIGCIAMIA@CACCCC@A@A@A@G@C@EDAFA@C@AAC@CBCDCDEHCFCDAR@F@FB@@B@@AB@@@BAB@@@B@B@@@BB@@B@B@@@D@@@B@@@B@@@B@B@@A@@@A@@@A@@@A@@@A@A@C@@@A@@@A@@@A@@@A@@@A@@@A@A@@@A@@BA@@@AB@@@BA@@B@B@@AB@@@B@@@B@@@BA@A@@@A@@@A@A@A@@@@@A@A@@@A@@A@@A@@@@AA@@@KCIQIIEECCEEEGSCMAE@CAO@@AOCOBE@G@KCGKCMGCEEGCIEKACEGOIIEECECICOEAA@C@ADCBC@A@AAAEAMA@AA@AA@A@ABABABA@AAC@CAAMCCCAAAC@AAA@AC@C@A@@@C@C@A@@@A@C@A@C@ABC@A@A@C@A@AA@@AAC@AA@@CAEACA@@C@A@C@A@GBEBC@A@C@C@A@A@A@ABCBADAB@BAD@@CB@@QFg_OIKE]_@CIUFOBCDIJMDM@@@AIKMOGECCIGA@QCEAA@@AAAAC@AAA@CAAACA@AAA@C@A@A@@@C@AB@@ABA@ABABABGBG@K@EAEEKKA@AACAA@CAAAA@A@A@ABAB@BADAB@B@BABA@ABBB@B@B@D@B@@BFBF@DABABG@C@GHINMVCDEDABIDSB]BMA@@U@EHI@CAECACWM@AGG"]],encodeOffsets:[[[107697,27172]],[[107498,27271]],[[107327,26715]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("黔东南苗族侗族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"522601",properties:{name:"凯里市",cp:[107.97754,26.582963],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BAB@DADC@AA@@EBBBBDBDBBBB@BDDBFBBDFDBBB@BDFDDBF@BE@AACDA@C@A@EDBJA@A@AB@D@BDBB@ABCB@BA@@DABBBB@BBBBA@EDMFA@ICE@A@ABC@C@CB@C@CAC@CC@AC@AAAACA@@ACA@@AA@AA@A@CGABAAA@AAA@CFABBB@FAB@BAB@BAB@BABA@EB@@ABC@CDEBAC@D@@ABCBABBBEF@DABC@AB@BAB@BAB@@AAEAAHABABA@AAE@ACAAA@A@@@C@CDA@EBABA@IBE@A@CDABGFCDBBBB@@@ABB@A@BDDBB@B@BBD@DBDDF@B@@BBD@BBB@B@BHAD@B@BADBBA@@BB@@BDB@D@BBB@BB@BBBBBFADBF@BAD@B@BABABAB@DADAB@B@BAF@AA@A@@@AA@@BAB@C@@@@A@BA@AABCAAC@A@ABB@ABABA@A@A@ACC@BCA@AAB@@A@@C@C@CA@@BAB@A@BC@AC@CE@AABA@@@GDA@ABA@AB@AA@AB@BAA@@@@CAAAG@EB@AACGGC@C@A@EE@A@A@@ECA@A@C@ACAADE@AA@AAABAA@BA@GC@@BA@@AAA@@@BAB@DAF@D@DC@CBAB@@ABABA@E@A@AAAAA@@A@C@CBC@CACBAAC@ABECEBABCAAAC@C@CA@@@AAACBA@C@@@A@AB@@@BGB@B@BABA@AAA@@@ABBB@B@@CBAAAAAACAA@A@CB@@AAC@A@CB@BCBE@CBEB@B@B@DABA@A@A@CCCACBABAB@D@DB@DDBDF@BDDDBF@DIHCF@BBBDB@DB@BBBB@@@D@D@BB@BBDDDDBDA@CBED@@A@A@A@CD@FCDABAB@@@B@@CDCBEHEF@@A@AACB@@AB@@G@C@@BABA@@@A@AA@AB@A@CCCBE@GDABC@CAADC@CB@@@@@@@@@@@BA@@B@@@B@@@B@@@@@@@BBB@@DB@@@B@@BB@@@@BBFAD@H@F@@@ABC@CB@B@DB@BBB@@D@D@B@BDBBFB@B@BBB@BA@CAA@ABA@@BABBBBBBBB@@AB@BA@@B@@B@@@D@D@F@DB@DBBBABBB@FFBBBA@A@M@G@I@G@@@@BCHGHAFAFB@@B@@@BBD@@@@@BB@@@B@BA@@B@@@B@B@BBD@@@@BBBDBB@@@@ABC@AB@@@D@@BB@BBBD@B@@B@@AB@B@B@@D@D@PFNAD@RGNKJUJM@ABCBATMXC@@B@R@@@@@XAX@JBFBJD@@@@B@@@B@@@@@B@B@@@B@@@@AB@@@B@@@@@BB@@@@B@@@B@AB@@@B@@@B@@BB@@B@B@D@@B@@@BBD@DABBBBBD@DBAB@D@@@DBBAFABABA@@@A@@BA@@@@@AD@@@BIJIHEH@BCNAF@@AFALELEF@@@@CBIHQJAB@DBB@@@@A@@B@B@@@B@@@B@@@@AB@@@@@BB@@@@BA@@BA@@@@BA@@AA@@@@@A@@BA@@@@B@@@@@B@@@@@B@@@@@BB@ABB@@@@B@@@B@@@@B@@BB@@@@BA@@B@@@@A@@B@@@@A@@B@@@B@@@B@@@@@B@@@B@@@@@@@BB@@@@@B@@@@@@B@B@@@@@@A@@@@@@B@@@@@B@@@@A@A@@@@@@@@@@@@BB@@@@@@B@@@@@B@@@@@@@@@@ABA@@@@@@B@@@@B@@@B@@@@@@BA@@@@B@@B@@@@B@@AB@B@@@@@@@B@@@B@BBB@BB@ADCBABABABA@@B@B@@@D@BBB@BB@BBBBBB@B@@BBBB@@BBDDB@@BB@@B@@@B@B@@BD@B@B@@A@@@ABA@AB@@@BABBB@B@@@BBBB@@@BBB@DD@BBBBBBB@BB@BBBB@@BB@BAB@BBB@@B@D@D@B@@@BAB@B@@@B@@@@@@@@DB@BDBB@@@BAB@@BB@DBDBB@DBDB@BBB@@@BAD@B@B@B@@@BB@@@BB@@D@DB@@@B@B@B@@@B@@B@B@BB@BBBB@BB@BBB@BBB@BB@@@B@B@BABA@@BB@@BB@D@B@@BDBBB@@@@@@B@B@B@@AB@B@BBBBB@@D@D@DB@B@B@@@BCJCB@DBB@DC@CB@BABBB@FF@BBFBBBBB@BAB@BCDABCBGFMBAFA@ADADAL@DAFADAHADABAF@DABCDCBALED@BBFDHFB@BBB@@ADEBABAD@HBB@H@F@F@BBBDD@DAB@DAFCD@H@@@D@B@HCBAFCDADABABCBAAE@ADEFAFGDGBCAABA@@D@BAFCBAB@BBB@FBABABDDB@@A@ADCBC@BDBDD@@@BD@DFDBDAB@B@DFBDB@FBBBBDBBAD@F@D@@ABBBDBB@F@LA@@ADB@B@BB@BB@BDDAB@DDDA@@B@B@DCBABCAABA@@DAD@FABABA@CB@BBBABBFBF@B@@A@@@@DB@D@BBBBA@@@@@ABAACBADA@@AC@A@@DABBBABABABAB@BAD@DE@ABABAB@@AB@FADDDDBJBBDFDB@B@@BHDABBD@@BB@F@BBDABB@@DB@AB@B@DDB@@@DBD@@B@DBFDD@@@@B@F@BADABAD@D@BBBADDD@DE@CAA@ABAB@D@DCB@BBDEB@@@@@@FBBB@B@DA@@D@BBDDBBBHBDBBB@FBFDBBDFBBDBFB@@B@BABABA@@@@BABABABBDA@@@C@ADABEDABA@ABGDA@ABABG@ADA@A@@@@BADABAB@@@BAHEFEBC@ABABC@CAC@AAAA@AA@AEC@@AAC@CAEACCAACEAAAAAA@A@AAACA@@CA@ACCE@A@A@ECCAAAECEA@@AACEAAAAAA@A@@F@B@@A@@D@B@BA@ADAB@DD@@D@BBDBDABCDADEBA@ADABA@@CK@E@ABC@@B@B@B@@@BACEA@@ABAA@AECAEAKK@E@C@A@ACA@@@@DC@A@A@ACA@E@@HA@ABA@@D@BBD@FABAB@BADBB@BABAD@D@FE@AH@D@B@FBH@BBBBB@B@BDBBFBF@B@@@HED@@C@A@CA@@@@EAAAAA@@CA@AA@ADG@ADC@CFA@AH@BA@AB@DCB@BA@E@C@@BA@ADA@C@GDA@A@ACCAE@@FCD@D@@C@AACC@@@@ABA@BB@@ABA@@@AB@@AA@C@C@C@CBC@AA@C@CA@GACAA@AAC@A@@@AAC@EEBABEFABCB@@@@CAA@ACC@@@AAAA@A@AAADA@ABA@A@ABAB@B@BCBABCCAACCA@CACAEEA@@A@AAA@BA@@BBB@@A@A@@C@AEAAA@A@@ABA@AACBAB@@AA@A@AA@@C@A@ADA@AA@BE@AAAAC@A@@DCB@@AAEAAAAAAAADC@ABADCCCAAA@AA@ACAEEC@A@AAA@AAAC@A@@BA@@@AA@@@A@@BE@CBA@A@A@C@@@AB@BE@A@AAA@@@EAA@C@ABCAACAAAA@@@@AA@ABCBAAC@C@AAA@@CAAAAA@@ABA@AACBABCBA@AD@DAB@BA@CBCBC@ABA@ABC@@BABA@@BC@C@EBA@@@CBED@BB@@D@F@D@@@BABBDA@ABABABCBAA@@A@@BCBA@A@ADA@@DAB@@@@ABEBA@AAA@C@EACAAA@@A@AB@BCBE@CA@@@CGC@@AABEBC@@@ABAA@@AACCG@C@ABA@ABC@ABC@A@AAC@@@AAC@@@AE@AAEDA@@A@A@CAA@AAEAABAECA@ACAAA@AA@BAD@BABC@ABGAA@AA@BABACA@CA@AA@AAA@@@@CBA@AAA@CCAC@CB@@C@A@A@A@ABGJ@BABABADC@A@AAA@CCCAAAC@@CBABAAC@AA@AABABAD@F@@AAC@@AA@@@CBA@A@A@@AACA@@BA@AEDABA@@AAA@ACCCCAA@ACC@AAC@ACA@A@CA@@CCAADABCBABAD@@@A@AE@A@ABABABA@@@A@@AC@@AC@@@G@ABAACA@A@A@AAACBA@CB@@A@ABC@ABAAA@CEAAABCBA@AB@FAF@AE@AD@@@@@BA@@BAA@B@@@BA@C@@@BBA@AA@EACEAC@A@BA@A@CB@@C@AAACAC@A@CBAAC@AAABAC@CA@@@AAACC@AAA@@@AACA@@ABCAA@"],encodeOffsets:[[110429,27039]]}},{type:"Feature",id:"522624",properties:{name:"三穗县",cp:[108.675267,26.952967],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@@B@@A@@@@@C@ABAB@B@B@@@@@B@@AB@@@@AB@@@@@BA@@@@BA@@@@BA@@B@@@@@BA@@@@B@@@@@BAB@@@BCBADAB@B@@@DBD@B@AA@AAC@@B@@A@@BA@@B@@@B@@@D@D@B@BBBB@BDD@@@@@AB@@@@@B@@A@@BABA@@@A@@@@BA@@@@@A@@@A@@BA@@@A@@@A@C@@AACA@@BADA@@BAAA@CBABCDEDABA@@B@@C@AB@D@DB@@B@@@BA@@@@B@@@B@@AB@@@@@B@@@@AB@@@@@@A@@@A@@@@@A@@@A@@A@@A@@@A@@@@B@@@@AB@@@B@B@@B@BAHABABBB@@@@B@@AHM@@@@@@@@BFBB@@B@@@@@AC@A@A@C@C@@B@@A@@BA@AB@@A@@@A@@@@@ABA@@@A@G@CAAC@AAAAAA@A@CB@@@B@B@@@@@B@@@B@@BB@@@B@@@BB@@B@@@B@@@B@@BB@@@@@B@@BB@@BB@@@@BB@@@BB@@B@@@BB@@BB@@B@@B@@BB@B@@B@@BBB@@BB@@@@@@BB@@BB@@@@BB@@B@B@B@B@@@@@B@@@B@@@B@@@@@BBDBBBD@@ABABA@@D@BBBB@DADABABABDBDBDBBB@ABABC@C@A@C@AA@AC@AAA@A@A@@@AA@@A@@@@@ABA@@BA@@B@@BB@@@B@@@@@B@B@@@@@B@BB@@@@B@@@@@B@@@BB@@@@B@@@D@BADBBB@B@BBBD@DBB@A@@AAA@A@@@@B@@BB@@@B@@@B@@BB@@@BB@@B@@@B@@B@@B@@@@@BB@@B@@@@C@AAE@@ACAAA@@C@A@@B@D@D@B@B@F@BBB@BBBAB@@A@EDEDADABABAFAB@FBB@B@B@@A@A@@BABCB@DBD@BDBBBB@BBBBFBD@@BBBBDF@@BBDB@DBDDDB@DBB@B@BA@@DBBBBB@DD@DB@@DBB@@ABABAB@BADC@ABCB@B@FBB@D@B@BABABADA@AD@B@BCAA@C@@BCAAA@CABEB@D@@@@@BECCBCBEA@@AA@CAAC@@@A@A@@@CBAB@BAB@BAAA@A@@AAAA@A@ABAB@@BB@D@DBB@BCB@@AD@B@@C@ADCBAB@D@B@B@B@F@H@BABBD@BCB@@CACBC@@AA@C@AC@BA@A@AAAEA@ACBCBAAC@@@ACBAAEAAAAC@@A@A@AACB@DCBA@A@@A@AA@EEAACCBC@ACA@CBAAA@@@ABCBECA@AA@CBACA@ECCACAAA@ABAA@ABA@A@AEAACC@@ACAACC@@AA@AAAAA@EEA@CB@@A@ADAB@@A@CBB@ABB@@BBBB@FFBBFABBB@@BBBDBBBBB@@A@@B@BDDCFE@A@E@A@@BC@A@A@ABIBABABABA@AAAA@@A@AA@@AAEC@AAAA@A@A@CA@@EDAD@@A@ABA@@DABA@A@A@@ACBA@EAABA@CAC@A@ABA@CBAB@@C@CAC@AAE@ACCCC@@@CACA@@ABC@ABA@@BEBA@C@ABECAAA@A@C@A@AAA@BACCA@A@AA@@BEC@@ACAAEC@A@A@CC@@@@@A@@@AA@A@AAA@A@A@@BC@A@AA@BAAE@@@A@@@ABAA@@AAAA@A@AA@@A@AA@@@@AA@@AC@A@A@AAA@CC@AA@CA@A@C@C@CBEA@ABA@IA@A@A@C@ABA@AEAABAB@@CC@AEA@CCABEBC@EB@DBAC@@B@@@BA@@@@@A@AA@CCIG@@A@AAAB@AA@AABA@ACAA@CAACAAEAEBCBABCBCAAAB@BA@@BA@@ACAA@C@AACDAAA@AA@@CC@A@A@ABAACBE@ABC@A@CEC@A@A@AAC@AAAC@ACAA@EA@AA@A@C@AC@@C@CAABAAAABAAA@AB@@A@AECAAACCCEAG@C@EAA@C@C@E@E@C@CAGAA@ABA@A@CDA@ABE@CDCBCAEBC@CAACCCA@CCCAA@ADCBA@A@A@A@ABACA@ABC@A@AB@AAAACEA@AA@G@@AA@AAAA@@@E@@AAA@CAAA@CCACAC@A@C@@AA@A@AAGHABA@CBA@ABA@A@AAC@@@AB@@AAGCAF@@@@@BA@A@A@BB@B@@A@CA@@AAA@A@@BA@CBACA@CB@BA@A@CB@BECEBCAC@ABA@ABABA@CA@@A@E@AA@@CAA@@BCBA@CBA@ABABAF@B@B@B@@@B@DA@C@A@G@A@EAABA@@BAD@BABABBBADABABEBC@ABA@ABA@@BC@AAA@C@C@AACD@@AD@@C@ABAF@FABA@@B@@BBB@ABABA@@DCB@B@@@BBAD@BD@@B@B@@@@BB@@@B@@@ABD@BDB@@ABA@B@@B@@A@@@B@@D@BB@@A@BB@BB@B@@B@BB@DB@BD@@@ABABC@ABC@A@@@@BA@@FCDEBEHABA@BDABBB@BABCB@BABA@A@C@ABCB@@CDA@EH@@@BBDDB@B@@@B@B@BDBFBB@BD@@DBB@BB@B@DAB@BDB@BB@@B@BCDA@@BABGF@B@FA@@B@FBBBBCD@BBB@DCDCFA@CDABAF@FA@A@@DAB@B@DBFADA@@DDAB@DAF@B@B@D@D@DABBBBBBBB@BABABA@AB@DABA@CD@@ABAB@BABBB@B@B@F@DBB@B@BFDDHBBFDHD@@AH@@BBBB@BBBBB@BCB@BB@B@BDBBB@@BC@@@@BFBBBBB@BBBD@@BF@BB@@BB@BBBF@FDBFFDDB@@@D@@DB@BB@B@@BB@@DB@BBBB@@@BBB@BAD@BB@BBBBBBB@DBBBB@DABBD@B@AB@BDA@@B@D@@@DC@@BBBA@@DF@B@BAB@B@BFBBBBB@BDDBBB@BBBB@BB@@AD@BBB@B@BBB@@@DBBB@DDBBBB@B@B@BB@B@B@BBDFDDBFDB@@@B@@AB@B@BBB@FBD@BBB@@@B@D@@AHCFADCD@BBBA@AB@BADCBAF@B@@AB@D@@@DABADAB@F@FAB@B@B@FG@C@@BADAB@@A@AHAD@@@DB@DFF@BBA@@BA@@BCAC@ABADA@AAEACCABC@ABAB@@ABA@AB@B@@@DAFCD@BA@ABCHEBA@ACC@CBAB@BAHADAD@JBD@D@B@B@BADBLJ@DBB@B@BC@ADCBAAAAA@@B@BAD@BA@@BBB@BBB@BBBBBBDBBFF@DB@@B@BBBD@@DB@DDBF@BB@BD@@BBDB@B@BBBBFDD@BDDB@BBDBDBBB@FBBB@"],encodeOffsets:[[111415,27729]]}},{type:"Feature",id:"522622",properties:{name:"黄平县",cp:[107.916411,26.905396],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCAE@C@AC@CA@@A@CCA@A@@BCA@@AACBAAE@A@@AC@AACBAG@@@ACACEAAAICCCCEBA@@BA@ABAB@BCFC@ABA@ABABABABAACB@@@BBD@@CBABBDAB@B@@@@ABAA@A@CCA@@@@@BA@E@EAAAABAAA@@DABABEBC@CB@@ABBBADABCDA@A@@@CBCCA@CBACA@@AAAA@A@BC@@KBE@A@CAAABA@@@C@EBCAAACAAEAA@ACCEA@A@CBCACEC@@A@@CCCA@AADCD@B@BA@CCBABAEAA@AAA@ABEDABC@@@ABBBADCHEHEBCF@BBFABADABCBCBEDABGDA@C@@@G@C@EDCBA@CBC@ACAAE@E@G@A@GAC@ABABCF@BA@AAA@GEECAAC@KFABCDADCBE@ABCBGBCBEBCBK@CBCB@BEBABENAHADCBADA@ABA@AAAAAE@AEEA@AAABA@@DCDA@CAA@IDAD@@@@@@BDB@BB@D@@ADCDABCBA@@B@BB@D@BB@FBHA@AB@@A@BB@@@@@BB@@@AB@@@B@@@BA@@@A@A@A@@@A@@@@@@@EAA@C@AA@@AAA@ABAB@@C@G@A@C@@@A@@@@DA@ABA@@@A@CB@B@DBDABC@ABABBBB@B@F@B@@D@BB@BABAFA@@AB@B@@@B@B@BA@@B@@@BA@@@@BA@@@@@AB@@@@A@@@AB@@@@A@AB@@A@@@AB@@AD@BA@@@AAA@@@A@@@@@@BABABAB@@@@AB@@CBA@AB@@@@@@A@@@A@@@@@@BA@@BC@@@C@A@A@A@@@A@ABAB@@@B@@ABCB@@A@C@C@GBABA@AB@A@AAA@AA@@CAA@A@ABA@AFE@A@AAABC@@@CAAAA@@@@@@AB@@@@A@@@@@A@@@@@AB@@@@@@@B@@@@A@@B@@@@AB@@@@@B@@@@@@AA@@@@AA@@@AAA@A@@@@@A@@@@@@@A@@@@@A@@@@@@@@@@@@@@A@@@@@A@@@A@@@@@@@@@@@@@AA@@@@@@@@@@A@@@@@A@@@@@@BA@@@@@@AA@@@@@A@@@@@@@A@@@@@@@A@@@@B@@A@@B@@@@@@@@A@@@@@@@@@A@@@AA@@@@@@A@@@@@AA@@A@@@@A@@A@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@A@@A@@A@@@A@@@@@A@@@@@A@@@@@@@@@@@A@@@@AA@@@@@@@A@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@A@@@@A@@@@@@A@@@@@@B@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@@BA@@@@@@@A@@@@@@@@@A@@@@@@@@B@@@@@@@@A@@@@@@@@@@BA@@@@@ABAB@B@BABABB@BBB@BBBB@B@@B@@B@@B@BB@B@@@@BB@@@B@B@@@@@B@B@B@@BB@@@@@@@B@@@@BB@@@@@B@@@@@@@@@BB@@@AFABAB@BCDA@BB@BA@A@C@ABB@BBD@B@ADAB@BCBC@CBC@A@A@A@C@C@ABCB@B@D@B@@@@@B@@@BABABABAB@BA@ABABA@AAA@CB@@@@@@@BA@@B@@@@@B@@@@AB@@@B@@@@@BA@@B@@@BAB@@@@@@AB@@@B@@A@@BAB@@@@@@AB@@@@A@@@@@AB@@@B@@@B@@BB@@@@@@BB@@BB@@@@B@@B@@@@@@BB@@BB@BB@@B@@@@@@BB@@@@BB@@@@@B@@B@@@@BB@@B@@@@B@@B@@@@BB@@@@BB@@B@@B@@@@B@@@@@@B@@@@@B@@B@@B@@@@@B@@@@@B@@@@@BB@@B@@BB@@@@@BB@@B@@B@@B@@BB@@@@@@BB@@@BB@@B@@@@@B@@@@BB@@@B@@@@@BA@@B@B@@B@@B@@@BB@@@@B@@BB@@BB@BB@@@@@@BB@@@@@BB@@BB@@@@B@@@@@B@@@B@@@@@B@@@BA@@B@RNLF@@@@LDDB@@FFFLBLADCH@@@@GLGJGTCD@@ELEH@@GNCRALBLHPHNBFLNLHLCLCNAFF@F@@@@AJGJBJFHHF@@DHFNLRLR@@@@FJ@@DD@B@@BHCLINQRKRCPAB@@CP@@BBBB@B@B@B@@D@B@DAB@@BADAB@BABADA@ABA@@B@@A@@@A@@BA@@@@@@@A@@BA@@@@@@B@@@@@B@@@B@@@@@B@@AB@B@@@@@BA@A@@@@@A@EEAAAACAAA@@AB@B@B@BA@C@CAEAABABAB@@BBBB@BB@D@B@@B@@@B@@@@@B@B@@@@@BB@@@@B@@@@@BB@@@@@B@D@D@A@ABDNDTB@F@L@PBXI\\EXARADABAFCDA@@D@PGT@P@PBLAHE@ECCQGSCEE@@CCFMTAN@@@LAD@RCZ@NA@@B@NBFABD@DBDBBF@D@BADGBGBAD@FFD@BABABC@AAAAAA@A@C@A@A@ACA@@@AC@A@AACEAE@E@CCCC@A@@@A@ECGB@@@@@D@@@D@HBFBF@D@BB@DB@D@F@HBF@B@AAAE@ABCFA@CAG@A@@@@BA@@@@B@@A@@B@@A@@B@@@@AB@B@@A@@B@@@@@HG@@D@B@FAPB@@B@JBHHBJA@@BB@BAB@DB@BD@DH@B@BBBB@@BDBDD@@D@BA@AB@DA@BDA@@@A@ACADAB@BABA@AA@@@AABAACBA@@@AA@@@@AB@AAA@BCA@@BAA@@@@BC@AB@DEB@@ADGAA@@EAAA@AAA@A@ABA@A@AB@BADA@ABBBBFDDD@HABBB@@DBBAD@@A@CBAB@AAAEAAA@AA@@BAB@D@@A@ACAA@BC@A@CB@@A@AA@BCAAAACAGI@CAE@EAA@ADCBA@A@A@@@ABCAE@@AC@@FA@@BCBA@C@A@CAACAAAACCA@CAA@AAA@E@A@@@A@A@@AA@AC@AEAC@CAA@A@@@@AAAA@@@@A@AAAAAACB@CBADABABA@EAA@@B@B@DBD@D@@AB@DAD@BAD@FABBB@D@D@BBBBBBBAB@B@BBAB@@C@ABBBBBB@FAB@@@@B@BB@@AB@B@BD@@B@@AB@FBB@J@BBDDD@B@@AECA@BEB@B@FFBD@BB@AC@CDEBA@AFCBCAIBADA@EBADCB@D@@CB@BAD@D@@A@CBABC@ABA@@AA@@@AA@@AAA@A@@@AA@B@AABAAA@CDCBCBACCD@BCC@A@AB@@@CCB@A@@@ABAD@BB@BB@@@B@DAF@B@DDB@A@DAFABA@@@A@@EAA@@@@A@ABA@ADABCBE@AEC@@@ABCB@F@B@@BDBFAD@D@BEDA@ABA@AD@DA@A@ABC@C@ABABACE@C@@DBD@@EAEB@B@BIBABADADA@E@ABADA@A@@AABAB@HBFABA@A@@B@BD@BB@BAD@DBB@B@@ABABBDF@@DABEBC@@FC@@@A@ACAG@@@AEBAB@BAB@BABABAAA@ABA@@BABBD@BAB@DCB@BAB@D@BDB@@@B@BAB@BBB@B@@B@DABDD@BB@BBB@B@@BBBB@@@FB@@BB@@D@B@B@BAB@D@D@D@F@B@DA@@BDB@@AAAB@BB@@BAAAB@@@BB@@DABCD@F@BADABBFABABADE@CBABADA@CBCB@FABBDA@BBB@BDFBB@@DB@B@BBB@@BABCB@@@FAJ@@A@AFBD@@A@AAAB@@A@AJBH@BBB@BAA@AAA@@@CC@@BA@AA@AC@A@@B@@@B@BAAA@@BABB@@BABA@ABCBA@AAA@@@AA@@A@@C@@BAABAABAABA@@@ABBB@DA@C@ABABEA@@AAEDABAA@@@BA@@@A@@B@@A@@AC@A@@@@B@BB@AB@FADCBBD@B@@AAA@ABCD@@@@AB@B@@CAE@CC@CAACCE@ACAACACECCCACAGAAAAAAECA@GAEAAA@C@@D@@@@A@ACC@@C@A@A@C@AACC@CAACEAA@@A@CBCDAB@BC@AACA@A@CACACBABC@A@ACA@ACAI@IA@A@CACAAA@@@@@ABABABA@@@EACAAACEAAECEAA@AAACAGAACCAAC@@@CBA@A@AA@E@@@@A@CFAAA@CDC@A@AB@BBB@DCFC@CCABAAC@C@ABCBABE@A@@@@@"],encodeOffsets:[[110661,27408]]}},{type:"Feature",id:"522623",properties:{name:"施秉县",cp:[108.124379,27.03292],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@DBDFBBL@BBB@BA@@@C@ADAB@@AB@BCBABA@A@@D@@A@@BAB@@B@ADB@@BAAABA@@A@@@@A@AA@B@@AA@@@A@@@BABAAADAAC@A@A@C@@CECC@A@E@AC@@C@A@A@ABA@ABA@@@@AAB@@C@AC@ABCA@@AABA@GCCECAAAA@BCBABA@@B@BAB@B@BBB@BBBFB@@BBCH@BA@CFA@@BAD@@@@BB@AB@ADB@BBA@@B@@B@@B@@ABBDABBB@@B@@BABABA@CBDB@B@B@@CB@ACBA@@BABC@@@CCCA@AA@AA@A@ACGC@@ACAA@ABA@AH@B@DEN@L@BBFBD@@@@DB@@H@HAFAB@JA"],["@@AA@@BABB@@D@BACAACAA@@BA@A@CDCCA@C@@FA@@DEBABEFCBACCC@ECC@CCCAC@A@E@EAECAAAAAAA@CFCBADAB@B@DBBABBB@BD@DB@B@BAB@B@DABAD@BADABABA@ABA@A@A@A@@@@AAABABA@IACECCAA@ABC@CDABCBADA@ABCA@B@BCB@@C@@BC@A@ABFDBD@B@DABCBG@CBA@AD@B@BBFDHHHBD@BABABC@CAGAC@C@CDCFAD@HCBA@C@AAAAACAC@M@ACAICICI@IAAACAEEA@A@ABCHA@CBIDQ@EBABADBDDFBBADEDADAD@B@DBH@F@@CH@BABABC@E@E@O@IAC@ABIFABC@IAGCCACCEAA@ABCBCBA@E@EAAACCAAC@EBA@ADCDEBEBCAEEEEACCAAACAGAGDCBC@G@C@AAAA@CAEAECCEAICA@MA@DBF@DA@A@@B@@C@AD@BBB@BA@C@AACDEBA@@BAAA@@@@@@BBD@@@BA@@@@B@@AB@@B@ABCBBF@BB@AFAB@B@DCBA@AA@B@@ABBBBAABBB@AD@@@@BB@@B@@BB@BABAD@BABAB@@AAAB@@BBABA@@@A@@@@BBDB@@BAB@@DD@@B@BBB@ABA@AAG@IA@B@BA@BB@B@BC@EA@B@BI@EB@@A@ADAB@@AA@A@ACA@@AACE@AAA@ACBAAEBA@AD@DCBABAB@DCFABABEBAACBABE@C@ADCB@@AA@@A@BBAB@@AAA@BB@BA@AC@@CBA@E@C@C@C@A@ABA@A@C@@@AA@@EA@@A@AA@AA@A@AAA@@ACCBA@C@AA@A@AAA@ABA@@@A@ACC@A@ABA@CDA@ABC@AAAB@@AB@BBBABABABA@ABA@ABBF@@H@DB@B@B@@ED@@ADAFCB@@CEAAAB@BA@A@CAC@ABA@@AACA@@@@BABEBGAA@ABBB@@@BCBAB@B@FCBCBABABAJA@A@BF@FC@CA@@@DDFABAB@B@DAD@B@BCBC@@BAB@BCBAFC@C@EBCA@AA@E@A@AD@B@@FD@BAFADCB@BAB@B@B@@B@FB@@@B@@ABEBCBB@A@CCA@E@CBA@@@A@@AAAC@AB@B@@@BDA@D@@BAB@D@ADC@DDABADCD@DBBABBBA@B@@B@@@BBB@BB@@B@@BB@@AB@BADAB@D@BC@C@ABA@@DC@A@CDAB@FCBABBJADED@BABCF@DBDA@@AACEEA@A@AFB@FD@BA@C@CCAAI@A@EAA@@BA@@@ACA@A@@BA@@A@A@@A@EBA@AAAABAD@@@BAAAA@A@ABAAAAAAC@C@A@AAEBC@ABC@CBA@@BC@C@CAA@A@@@BB@FABABCBAB@DDABBBBBBB@@@@@BBBB@@@@@BBB@DBDBFD@@BBB@@@B@B@@@B@FBB@BBB@DDBBDBBDBBB@D@B@DABAD@@EB@@BD@@BFAD@B@@@B@BABCD@BBB@FBF@DHJDBBBBBADB@@B@BA@@D@BADB@DB@B@BC@A@AB@@BBB@BBBFBBA@AB@D@B@B@DA@BB@@@@AB@BAB@B@B@B@DD@@B@F@BDDDFD@BCF@BBDADA@CBAA@@AB@B@@BB@B@@@@ABADCB@B@BB@@ABBD@@@@B@BA@@@@B@DABBBB@DDHA@EACDCD@@@D@BB@A@ABC@CBA@CA@AA@@@A@CDAB@AAAA@CBC@CBAAABAB@@B@@@B@@BA@B@@B@B@@B@@@ABBBAB@@CA@B@AA@AB@@@BC@@@@BABABADA@@BA@CB@B@D@@ABA@BBBDBBBD@@BBBB@@BB@B@@@B@B@B@@DD@@DBDBD@BA@@DADAB@@AB@DA@@D@@@BBD@D@BAF@D@BAD@B@BBB@DBDBBBF@HDFB@D@@@@@B@BEDEFAD@B@@@@@B@@@BDFD@H@L@RFNFB@BADDJDDFRBPDPJJHFLFN@B@@@LADCHGLKDQBKDOREZCH@F@DDFFRVBTBTBTFJ@HAL@LBJDLFHDFBHAPCDA@@LCJALA@@@@LBNBJBHCHGFIFOLMLMJEHCDAD@BKC@CA@AA@@AC@@C@E@A@A@ACAAAACEAAAAAAAEA@CBCFE@AD@B@D@BBD@B@@BB@BA@@BBBBDBF@D@B@@@BBD@FB@@F@AA@ABBBBB@@FBDD@D@BBF@BF@BA@AB@DBBH@FAD@@@BBBD@@A@EB@B@BD@F@BBHBD@JD@ABABCDA@A@AACAA@A@@DCB@DDB@BBDBBBD@BABCBEBAB@BA@A@AA@AACAACAAACCAA@CGAA@ADABADAB@B@B@BBBBBBF@D@DDFB@BB@FDB@BDBBB@H@BCBAAA@CAA@A@ACC@ABABAB@BEAAACC@@AAABA@@CA@AB@@A@C@@AC@C@@AAA@@AAAEACCCAAAA@AA@@@E@E@G@A@CDEB@@ABABAAC@@@A@A@AB@B@BABA@@B@DBB@B@BABA@@B@@@@AB@AE@@@ACA@E@CAAAA@@@AB@@A@@@ABAAABA@E@CBABEDBB@B@BA@ABA@AB@@AB@@A@@@A@AAAEA@A@AA@CAA@A@AAA@AA@A@AC@@AB@@ACAA@ACB@@CDBFAB@@@B@AA@ABCCC@@@A@A@CAAA@A@@EA@AAAC@C@@AA@@AC@@AAAA@AB@BAJJHBBBDD@@B@BBD@@@BBB@DDB@BBB@B@B@BBFDB@@AB@D@HAD@B@@BB@@@B@D@D@F@@A@@CAAEC@AAA@AAA@AAABAA@BA@AA@AB@BAB@B@@A@@@ABBFCB@BA@@D@BAB@@AB@BA@@B@BAB@BBB@BAAAA@@A@@BCA@@@@CA@@A@AA@C@A@C@A@@@AAA@@@A@@AA@A@AAB@AAAAA@CAAC@ABADA@ABADADC@CBABA@@BA@CB@@AB@BA@AA@BA@A@@B@AA@@B@@AA@B@B@@CBAAADA@A@@B@@ABABABBB@BC@CBBB@@@AABA@@BAB@BAD@@C@C@A@ADBB@@AAC@ABAB@B@@@@@@CAAAAAAB@B@@A@@A@AABA@A@@A@@AAEBAA@@A@CAA@AB@@A@AC@A@ECEBC@EAEAECAA@@AAA@IDEBA@C@G@CACACAA@A@ABIFGDE@C@CAA@ABAB@BA@@@EEE@@@@@@C@CA@AA@ABA@AA@@AB@@A@@@@BAF@DCB@D@DADBBAD@DABABAJCBAFAB@@@@CFA@ABAAA@@C@@@@A@A@AC@@AAA@AABCAC@C@@A@@C@@@AE@@@CAA@@@A@CBAB@@A@@@AB@@CD@BA@AA@EACAAAAAAC@ADCB@@@B@BEDC@AAACA@AD@FEL@FBB@@AAA@A@A@A@A@ABAA@ACAADC@CDCBCAGIE@@AACCAAEAA@A@A@@@DCAA@AA@BA@@DA@@@AAABC@A@ACCCE@@BAF@DADAD@FBB@JDBBBB@@BBDB@DBB@@B@B@B@D@D@B@H@B@D@BCJAB@B@BBB@@@BD@@BB@@H@@BBB@DB@BBD@B@BDB@DDBBH@LBB@DBFBB@D@B@B@DAD@@AB@D@FBBDH@D@@B@BDDBA@BBDBBB@HABADC@@DB@BF@DAB@D@DCDABABA@ABABA@A@A@ABAHADAFABBBDBA@ABCBCBA@AFC@@@@DBD@BBD@B@B@@AD@BCBA@AA@@A@C@AACAA@AA@AA@A@A@EA@ACA@A@C@@BAAEDAAA@A@@AAE@@@CA@@ABC@A@AACC@@@C@ABAAA@A@@@CCC@@A@@BAB@@@AABAGCCAAC@@A@@A@A@@ABE@ABA@AABABCBABA@@@ABA@AB@B@D@BBB@B@BAD@BAF@D@@BDBBB@@F@F@FAB@BDBB@DB@BDD@B@B@@@FBBABBDBB@B@D@@@@ANBDABBB@BBB@D@BADAFADG@CDCDEB@@CHG@@AAAEBG@ABADA@@BBF@D@D@B@B@@AB@BABG@EBEBC@@AE@A@A@EB@B@BAFADBB@DABA@A@@DCBCA@@AA@ABA@EBE@AAAAA@@AA@BG@AC@AA@AAA"]],encodeOffsets:[[[110493,27828]],[[111077,27458]]]}},{type:"Feature",id:"522626",properties:{name:"岑巩县",cp:[108.81606,27.173887],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABA@AEAACBA@DLBD@D@BAFA@ADA@A@ADA@@@BDADBB@DBBB@BABAB@BABBBDB@B@DB@@DBBBBBDBBBBBDBBB@DBFDBBB@BCB@@C@A@@@CBBD@B@B@BAB@B@HEDA@AC@AA@EAA@EDABADCDEBABEBABCACCA@E@A@CAA@CCA@CCA@A@@@A@ABAB@@C@@@AB@BA@C@GAAAE@CA@ABE@ACBCAC@AAC@@ABG@@@@A@CBCBGBA@EBEBA@ABDBDDDD@B@@ADBBABEHABAD@DAB@BBDADC@ABABA@AD@BBBAB@BBBBBBB@B@BBBBDFFDBBF@B@BABCBA@CHG@C@A@ADBBDD@B@@AB@AABEAABABACA@AAAAE@A@A@G@A@A@CD@@@F@BCDGDA@@@@C@@AA@A@C@CA@@@CAA@AB@B@DC@CBABCAAACAAAAAA@A@C@ADA@AAABEAA@AAA@@@C@CAA@ABA@@BA@AAAACAA@E@@BEAABA@C@ADA@@@@DA@ADBD@BABBBD@@BB@CDC@@BCBADADA@A@A@A@AB@@A@AB@BBB@BC@@BA@ABA@AB@BABABA@@B@BB@B@@@@DADADDDFBAHA@A@ABCAABA@ABC@C@CA@@ABADCBAAAAA@A@AB@B@BAACAEC@AAA@@E@AAC@@@E@CAA@CBCDA@AAABCAABA@ABCA@B@BADABAD@BDBABA@@BABBD@@DBDB@@@@CH@BBBBB@@C@@@@BABECC@ECCCAD@DABD@BBB@@BBBA@A@AAA@EBA@@AC@@@AAA@A@A@EBACAACB@BA@A@A@ABA@@BDB@D@@@BA@ABA@@BB@@B@B@BA@AAC@AA@A@ADC@A@C@@AAAACAECC@CB@D@@A@A@@@ABA@@A@AAB@DAB@BABAB@@DBAB@@A@C@ADEFEBA@ABA@ABKHJLFFLLJHHFBBNFDB@@FFLDDDFD@@B@JFBBD@DBLDJFJHFHFFJLHDBDFBJBB@@@HAJCHEJAJ@LAFC@@@@DCFCDCFCDADAH@DBDD@FBHDFDHF@LADEF@FAH@FFDJ@B@@BF@HBHHLFNHR@B@@FLLLFHFFFDFBNCH@JCHCFGHAHD@@B@LJDHBD@@DHDNDLDLBB@@HHFJLFFBHDJAHALGLGLEJCB@@@H@H@NCD@F@H@FD@@FBHFFDDBDDJDJ@L@JCFGHGHE@@BAJIJIJIJGJC@@@A@A@@@ABA@@@@BA@@@A@@@@@A@@@AAA@A@A@A@@@@AA@@D@BAJAH@@@B@D@B@@B@D@B@H@@AD@B@HABAD@B@@DDBBB@@@BABEBA@@F@@@B@F@@@B@@BB@BBB@BB@@B@@AB@@@BABAB@@@B@@@B@@@D@@@B@B@B@@@B@B@B@@@B@B@BB@@@@BBB@@@B@@BB@@@B@@@@@B@B@@@B@B@@A@@B@@@BABA@@B@@@@AB@B@@@@@B@@@@@B@@@B@B@@BB@@@B@B@B@@@B@@@BAB@@@BAB@BA@@@@@@BA@@B@@AB@@AB@@@BAB@B@@@B@B@@@@BB@@@@@@BB@@B@@@@@BA@@BA@AB@@@@AB@@@B@@@@@B@@@B@BA@@@ABA@@@A@A@AB@BA@@BAB@B@@AB@B@@@@AB@B@@@B@@ABB@@@@@BB@@B@@@BB@@@@BB@@B@BB@@B@BB@BB@B@@@B@BB@ADB@@DAB@@@B@BA@@BA@@@@@A@A@A@@@@@AA@@A@@@@AA@@AA@@@A@@BA@@B@B@@@B@@@B@@ABB@AB@@@B@@@B@@AB@@@@@H@D@@@B@DB@@DBJBHCPAJALEHADCH@JAHBFFB@@@DDFHDF@@DFDHLJHBLADAHGDEFEDCHCD@@@B@HAL@B@HCFE@ECECGOGEE@AA@EEEMBEBADCLIL@H@JBJBJBD@DCBEBGBGFAB@H@JDD@@@LAJEHEJKFGLK@C@@BC@IAIEICIGI@AEGAIAE@A@@@@AA@KIEO@@@IBI@SDK@@@@@OBUDMACA@@CAMEII@@ECGCGBGBIHE@EA@@A@GCEGCMACGIKEGAIBCD@J@FAJ@@ADCDCFCDGDGAC@IAGE@ABA@@BABA@@BABA@@@@BA@@@AB@@A@@BA@@@AAA@@@@A@@@AAA@A@ABA@A@A@CBA@@@ABA@C@@@A@A@@A@@A@@C@@@A@C@A@@AA@@@AA@@@@@A@@A@@@A@@@@BAFE@@@@AE@AB@JGHG@@B@HKHQBKAKAG@AAA@@CKAK@GCEEC@@CAGEECECCG@G@OAG@@@A@GEQAC@@CIECKKMCOCA@EEECEEAGBGFEHALCNCD@@@HAB@BAB@@A@@B@B@@AB@B@B@@@B@BAB@@@B@B@B@B@@@@@@AB@@@BA@@@A@@BA@@@@CAMGGCA@EAEAMGAAKAEBA@@DA@ABA@@AC@AAC@A@C@GBABABAFA@@@A@@EAABG@@@AA@ABA@A@AD@@ABGCAB@@AAAAA@ABA@ACABA@AA@@EDC@C@CBAAAACCAA@@C@CAABCBEDCAAAA@@@A@C@@@AAA@@ACB@AA@A@A@C@CAA@ABA@AA@ADAACAA@AA@ADAB@BB@AFBBABA@ABAAAAA@A@AA@BC@CAEDCDA@@BD@D@BC@@B@@F@D@@@B@B@BEAAACAA@CAAAA@GE@AAA@E@ABECAABA@A@A@ABABGJA@A@CBCBA@CAAA@@@A@C@A@AKCGCC@A@EC@CC@CBABBF@B@@A@CB"],encodeOffsets:[[111259,27812]]}},{type:"Feature",id:"522625",properties:{name:"镇远县",cp:[108.429534,27.049497],childNum:1},geometry:{type:"Polygon",coordinates:["@@GECBABBFB@CFBBCFABBD@F@@@BA@ADB@AB@DAB@@EFA@A@A@C@AHB@@BB@BBBBF@FAB@BAB@@BB@ADCD@@@BABCBA@CAEBABA@A@@F@B@BBF@@ADAF@FAHABA@@BA@A@C@C@E@AA@@CBAB@BAHBFBB@@GH@DA@CFCD@DCHEBCBABC@A@AAA@AACBMA@B@@C@A@A@CAAAABEA@@A@A@C@ACA@@CAAACA@EBE@E@@@AACA@AC@E@ABC@ABA@A@AAC@A@A@@BAB@B@@ABABADABBBB@BAF@BA@@@B@BB@@@BDDBHDABBB@@A@AB@@@BD@DD@@B@B@BBBAD@@@D@BD@B@BAD@BB@@D@@BF@BB@B@BBFCBB@AD@B@B@BDB@@F@B@BBBB@@BBBBD@B@D@BB@@BABADC@@BA@A@C@AAC@CA@@@@ED@BABADAD@BABACAAEBCBGBAB@B@B@BABAB@BABABCBCDC@A@CBE@@ACA@@CDABGBA@AAAC@AABCC@A@AC@G@ACEAC@A@@BC@CBA@A@C@A@EACAA@KAG@AACCA@ACA@C@AAA@@CAA@AG@@@AA@@AC@@A@AAA@A@IBADC@A@G@A@C@C@A@A@A@@@AA@CCAAA@@AAAAICA@EAC@CBCBE@AB@@DFDD@B@BADBB@B@@CB@@ABB@@BBBCD@@B@B@B@FBBBDDBB@@JFBHADCD@DCDBBBDB@AB@B@B@B@B@BBB@BA@EAK@EFC@@BDBBB@BCDAFA@@@A@CD@BBDBBBBDBFBB@@BABC@@DA@@B@@@BA@AB@D@B@@BB@D@@BF@@D@@@@BD@D@DBBA@BBB@BD@@B@B@B@@D@@@BBAB@BEB@D@@A@EBABIDABABCBC@ABCACBC@A@CDE@AB@@@@@BA@@BB@@BAB@BBBB@@D@D@@@@F@FF@@B@@ABABAB@DBD@F@HCJEBAB@B@DBDBDBH@D@B@FAJCB@BB@@BBFDFBFBD@FAFDB@D@@B@BA@@BBB@D@BB@ABBF@BB@@@@BABBBB@@@@BA@A@BBBBBB@D@@@@A@A@AB@BBD@BA@CA@B@B@D@DC@ABA@AB@@ABBB@@A@AA@DADA@AAABAB@BA@@@@BCBBBAB@DA@A@B@@BA@@@BBA@@@@BABB@@BABA@@BA@@DAB@@ABAB@DCDCBAB@BCBAB@BBDDBB@BBBBA@BBB@B@@BB@@@B@BB@@B@D@B@D@B@@B@BB@@D@@B@AD@@@BB@BBABA@AAA@ABA@@@ABA@@BA@ABC@@@ABA@EDAA@B@@@BA@A@ABA@@BBBB@@ABBBABBB@BBB@BBD@BFDB@@@BE@C@C@A@@@A@@AA@C@GBC@A@@BA@ECAAA@A@A@AAA@CCA@AA@@C@AAA@@@CCAAGAIIABA@@BBBBB@@BD@@BB@@@DBDBBB@@FB@B@BB@D@B@B@@DDAD@BBBA@@@A@EBCA@DA@BDB@DB@BA@@BD@@B@BBBB@BBB@B@DBB@@B@BFBBB@B@B@@@BA@@BA@@BAB@BABA@A@CAAFAB@D@FABBBAB@B@@@BA@@B@@BBBB@D@FDB@B@@BFA@@B@@A@@@ABABA@A@CAA@@@ABABA@A@@B@B@B@@BDABAB@BA@CF@D@B@H@F@F@@BBB@BBDBDDFBBB@BB@BB@@@DBD@@@D@BA@@BDB@@ABBB@BD@BDBBAFA@ABAB@BDD@B@BBB@DBBABADG@A@AAACA@ECA@@AEACCC@E@AAAAAAA@A@A@CBABCB@BBBDHB@DBBDBBBDDBBBB@@B@BABA@ABAFADABC@AACAAAA@CCA@CD@@@BBBBD@B@BCBADAB@BICC@GAAAE@C@@A@AFAB@@@ACAA@@C@EBG@AA@CBAB@@AAEE@AAC@C@AC@EA@AAAA@BBBE@@@EAC@AA@@A@C@E@CAAAAA@@ABA@@AA@C@AAC@A@C@@BEFAD@DFBBBBBBBFBBDBBDB@B@B@B@F@DD@@BB@@BDBD@AL@@DBD@HBFFFLJTFX@B@@DTHLHPDJDHFHJHNHPDL@B@JARIPGJEBCLE@@@@NER@N@JBJD@B@@FBHBL@JCF@FADBB@FDJHHFLGBAB@BAB@FAFEBCD@B@@@BACA@@BABA@ABA@CBA@B@BB@BA@@B@B@@@@CDAD@FDDBBBBB@@@D@BCD@B@BBBD@BBB@@A@A@AA@@AB@BAB@@A@@@CCA@AB@BAB@B@B@@ADABBBDFAB@B@B@BB@@D@@BB@FAB@BBB@B@AA@AA@AAC@BA@CBCDDFDD@FDBA@A@@D@@@AAAA@ADG@@@@CACA@@ACBA@AB@BACA@ABCBABC@A@ADBBAB@BADBBABBB@DCDAB@DBF@@@D@BBF@@@BB@BFDDBBB@A@ABAB@B@BBBBDABCBA@@DBD@D@BAB@BADBBAB@B@BGEACCBCBC@C@@A@A@@A@AB@BABA@ABAB@BAB@@AD@@AAA@ABAB@@@BAB@B@B@B@BCBCDA@AD@DCA@@AC@AABA@AACBCB@@C@@B@BCD@B@BAFB@AF@B@DBBBBBB@@AB@BAB@DBD@@@B@BBB@FBBABBB@BCD@B@B@BBBBDBBBDBBADAD@@C@ABAB@DB@@B@@D@D@BBB@@@D@@B@HCDC@A@E@@DCB@B@H@B@B@F@BBBBB@BDBABAFBBA@BBA@@@ACCAABCB@D@H@DGB@DABA@A@AAECAEEACAA@A@AAAAAAA@ABAAA@ABCB@BABAD@BCAC@ABA@CBCBAFGBAAABC@@@ACCCCCABAB@FAFAB@HADADAB@@@@@AH@BD@BBD@DBDA@BAF@BDBF@BBHBD@B@@ABA@@D@@@BABAB@@@B@B@DDB@DDB@DBB@F@B@DDDBBAFABAFADCBCBAFCB@FBB@@BBDB@FC@G@ABA@A@A@AACDA@@B@D@@@DA@AAACAAE@CAACAAAAACAAAAACA@@CAA@A@ACAAABA@ABABA@AA@CAABCAC@@B@BCB@B@BCB@BE@A@CACCKB@DABBBFB@BADAB@@@@AAEBADAD@@DFDB@D@HDLD@B@B@D@B@@BBDBB@DADAB@B@HIBABAB@B@B@BADBAF@B@FBB@BHFB@BBDBB@DBBBFB@A@A@A@@@C@EA@@@ADC@C@@AB@DCFCDBD@@ABBB@B@BBBBBAB@BAAABEA@@ABABCB@@BBBBDCB@BBBB@BAB@DBD@B@B@B@@BDA@BB@BB@@D@B@@@B@BBDBFCDABADBD@@@BBDDBBBBDAD@D@FC@@BBB@BABDB@BAB@BBBB@@BAHDBA@@BCB@B@BAB@@B@@AHBB@FB@@@B@BEBABAHAD@B@D@BBD@@BB@BAB@@CB@FALBBBNHFBFBB@HDNHDB@@@@@@@A@@@ABA@@@C@@@A@A@@@AB@@A@@B@@AB@@@BA@@B@BA@@BA@@@AB@@A@@BA@@DA@@B@@AB@@@@AB@@A@@@A@@@A@@AA@A@C@A@@@A@@@C@@@A@@ACC@AAC@A@@A@ABA@@@@B@DBB@B@D@B@FA@@BA@@BABA@@@AB@@A@@B@@@@@@AB@@AB@@@BA@@B@B@@@B@B@@@B@B@@AB@BABAB@BABA@@BABAB@@@@AB@@AB@@A@@BA@@@A@A@AA@@@@A@@@@@A@@@A@A@@@@@A@A@@@A@@@A@@@A@@BA@@@A@@@A@@@AA@@@@A@@A@@A@@AA@@@A@@@@AA@@A@@@A@@@@A@@A@@@@@@@A@@AA@@@A@@B@@@@@BA@@B@@@@@BAB@@AB@@A@@B@@@@ABABCBABA@A@A@CA@@@@C@@@@@A@A@@@AAAAACAC@@AEAA@AAA@@@A@ABA@ABABA@AA@AAA@A@A@A@CB@@AB@@A@A@A@A@A@AA@@A@@@AAAA@A@@CAAA@@@@C@C@AAA@CA@@AA@@A@C@C@ABE@@BA@@DAD@BABA@C@A@C@CBA@@@AC@@@@@@AA@@@@@AA@@@@@AA@@AA@@AA@@@@A@@A@@A@@AA@A@@AA@@@A@@@@@AA@@A@@@A@@A@@AA@AA@BA@@B@BA@@B@@@B@BA@@B@@@BAB@@@@@DA@@B@@@B@@A@@A@AA@EAACACAAAA@CC@ACCAEAA@A@ACAAA@@ACA@@AAECCA@@CC@AA@A@AA@@CEEAAACAAAA@AAA@AAA@AB@@ABC@A@AB@BBBBDABCD@@A@AAA@CKICAABA@A@C@C@IAC@CBGBABA@AB@DDD@BABGFAD@BABC@EDCB@@A@A@@BAB@BA@AB@BADDBBDBF@BCBAB@BBDAD@@AB@@AB@AEE@CCA@@C@GB@B@BA@CBAB@@@DEHA@A@A@EBE@A@CBABCB@@C@A@@BA@E@ABCDABA@@BABAAC@CDEBGD@BC@A@@@A@AAC@EAA@AAA@A@@BA@@@A@ECCAECAC@A@A@AAAA@A@A@AACA@CAACA@@A@AAA@A@AAC@@BA@@AAAAAA@AACC@AAAAAEA@A@ABA@A@ACE@@ABAA@@CD@@C@A@@@CB@ABAA@C@AACBA@AACAA@AAAAAAA@@ABC@AAA@A@@AAAAA@@CA@@AA@A@@ACA@@@C@@CAECAEECE@AA@AAA@@AAE@@AC@AA@AAAAAEA@A@@D@@AA@AAACA@A@@ADA@AAAAA@AAAAA@@BG@@GCECAACGEC@A@AAA@C@E@A@AAABA@ABABA@@DCB@BA@CBAB@BABA@AAAAAAAAACBC@C@A@A@E@CBA@CB@CB@BCAE@C@ABA@CB@B@@EBEBADCB@DEDC@CAA@ADCAAAA@E@AB@@E@AHEBA@AB@DC@A@AA@@ACA@ABA@C@AAAA@CA@@ACA@EACA@A@A@A@@@ACAAC@A@@FGB@DC@@DABAD@B@B@BA@ADABA@AAABAACB@BAFGFADC@EB@@A@@B@D@BAD@BABA@@C@@ACAA@@A@AA@A@@AAAB@@@AAC@@@@A@@@BA@@@@AAB@BA@ACC@BA@@A@@@A@@A@@A@A@@@ACC@AB@A@@@ADA@CB@BABAA@AA@@@AA@A@A@A@@@AC@@A@@AA@@AC@BABA@ABAB@@A@AA@@AAAA@ABA@@@AB@BEBABC@GAA@AAEAA@A@CAA@@AA@@@C@AA@ABA@A@AECA@C@@AACCAA@C@A@C@EA@@A@AAEC@A@@CAB@@CCEBC"],encodeOffsets:[[111100,27437]]}},{type:"Feature",id:"522628",properties:{name:"锦屏县",cp:[109.200534,26.676233],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@KEBEDKHIJQHKDGDABCDEJEHC@@FCJEHCHAHC@@JBFDDFHDFHHBDABEDK@I@EBEHIJCHCHGBEBE@GBABC@@B@BC@CBE@AFCFCB@@A@AAK@A@AA@ACAA@@BGBAB@@A@@@A@@AC@ECCCG@@B@B@@@B@@@B@@@B@B@@@B@@@@B@@B@@@@@B@@B@@B@B@@AB@@@D@@AAAACCAFAFCFGDIDQ@ABQCGEEEEIAKEEAG@CG@GDIBCBCBE@CBECGGEIIMIKI@AAE@E@EDEFAFBH@FAHA@ABC@CACGEGCGAO@A@AAICKEOIKGGECAECAAE@A@C@ABA@EACBA@A@ABA@@@@DABA@A@ABAB@@A@AA@B@@@AA@A@ABAAAAC@C@C@@@A@@BC@@AA@@BA@@@AAABC@A@AFC@ABB@@BAB@BB@BA@@@BABABA@BBAB@@CBCBIHABA@@BABD@HBBABBB@AD@FADEBC@@@ABA@ADC@A@ECAAAC@CAA@ABCAC@C@CCACBC@A@CACA@BAFA@AA@@@CBC@G@C@@D@HBD@FA@AB@AA@ACCECCAACCC@@B@@@@AAA@@D@B@BACAAA@CDEB@B@BAFAF@DB@A@@B@DAA@A@@AA@@A@AB@FDB@HEDAF@HCHAFCDA@A@AAACAEA@ACCECCACCA@A@ABCJADABEBABC@CA@BA@@BCDA@A@@@@A@@GAEA@@@FBB@D@BED@BA@ABCBCB@BBBCDADC@EBGAAA@@@ABA@AAAACA@CAEDKA@CEE@ADEB@F@DBDDB@BABA@AAAAAACGK@@BABC@AAAAA@AC@EACBA@A@ABA@AAA@ADBBBBFBBB@BCD@BA@E@A@@CAAAABCAACAE@AAC@A@A@A@@@BAAAA@@@AC@AA@@@AAABA@AACAAACBABABC@BF@HADABCBA@GAGAC@CAA@ABEB@@ABC@ABCEABAAC@@@EDA@A@@@@DC@AB@BA@A@CDA@AB@BCAA@@AB@@AAAA@AEA@C@AA@ACCA@BABA@@AAAAA@@ACC@@AAACAAA@ABA@A@ADC@ADAB@@A@A@@BC@@BA@AF@B@D@@EB@BCB@@@B@@A@@BCBABA@A@A@CBABC@ABABABC@C@@@@CAAB@AA@@GAC@@@A@ABEDCBAD@@ADABAD@B@BAB@BA@@BDH@@@D@B@BA@C@CBA@C@A@AAA@CC@AAAAAA@A@ACA@@AA@@@AA@@A@A@ED@A@AA@EAA@AAA@A@A@CC@@A@ABA@@BA@ABA@A@@D@@A@AAABC@AB@@A@AACEAABAAACAAA@B@DAB@BABAD@BAB@ACB@@C@E@EAAA@@CEA@AB@AABCC@@@A@A@@@@A@ACCBA@@AC@A@AC@@@B@D@BC@AD@AABA@@@B@@BA@@BBB@DB@DB@BB@ABC@@BBFAD@@CBEBC@CBEF@BDB@@BDA@ABE@AFBD@BA@ABA@EB@BBBC@AAABA@AAAA@@AA@ACC@@A@CE@AAAA@E@EC@A@AAC@AAAA@@AAABACAAAA@CAA@AACA@@ABAB@@@B@B@D@@EBAAAB@BA@ABABDBCBBDABABA@@@IACCC@EAAAIAEAAACACABA@AAA@AA@ACC@E@EAABADAAA@ABC@CBCBA@ABA@ADA@@BA@CB@BAB@B@BADADCDB@BBDBB@DBBDAB@BBB@B@BABAB@BC@AAA@AC@@CBEBADCAC@@@A@@D@B@B@@AB@D@BB@@BA@@BCBA@CAEBE@A@CFABABA@AHBD@BBBB@BBDDB@BBF@BBDBDAB@DBBDB@BD@D@@AB@BBB@@B@FBBBBB@@DB@B@@DB@@@BB@BB@@B@BB@BB@B@BDBBDBBB@@BBBB@BBBB@D@B@D@B@DAB@DFDBDBDDBFBDA@ABABCBA@DB@BFFJH@BB@@@BBDF@DBBDBDB@@DBBDBBBBDDDBAD@B@HABI@G@CDABAB@BBBBBADBBBB@B@@ABBBADCD@@@BBBBB@@DDDABBBD@B@B@BB@@D@B@@@D@BBBBABB@ABB@ABBB@@D@DA@E@C@GDABA@ABC@EBBB@BBF@BEHABABCB@@@B@@@DBBABBBABBB@BADAB@@FD@DFBBDDBD@BBB@DBDDHB@B@H@BDB@DBBB@@@@@B@B@@B@B@@CBABCACBA@E@@@AAA@AD@BBBBDB@BDBDBBBBBDBBBB@@DBDBB@@@D@F@BAF@DAD@DAB@LD@BD@DBB@B@B@DAD@B@DCHAB@JDL@BB@FBB@@B@@BBA@BBBDA@@B@@@@BB@DBB@@BB@@@BDBBD@B@D@@D@B@BDDBBABAB@FABAB@BA@ABBBBDCDBDABAFBDAF@DBBADDD@DABBBDDD@D@FE@AAA@ABEBAB@F@JGDAD@BBB@JABABC@EBAB@FBBB@BDBDBDADEBADCHEB@BBDBDJDD@BAB@BDDCBBBABC@@B@BBB@B@@@BABB@B@HAFCBC@ACI@ABC@@@ABAB@@BBB@@FDH@H@BB@@@@AFDBF@D@BB@DBBB@DB@BBBB@B@FFBABBB@B@@C@@B@BBB@B@@BAB@@FD@B@@D@BA@C@CDEBAB@D@B@FCD@D@DBB@B@BBBDBDBB@@DA@BDC@@HBB@BBABDD@BA@BBA@@B@BD@@BB@BB@DB@D@NCF@B@FBBBADB@F@B@D@BADBB@B@BCBA@@DA@AB@FA@@AADA@CBABABAB@DAB@BADABADADB@BBBF@DDD@B@BBB@@BBB@DA@A@BBABB@@@B@@AB@@BC@@B@@B@HFADBFBBBBB@BCBBB@BBDBBB@B@B@B@B@@D@DBH@D@BF@@CD@BB@@BB@BB@BB@BBB@B@B@@BDBB@B@@BDBF@B@B@BBB@B@BBBBB@@B@BA@@B@D@DBBBDFBB@BB@@BBB@B@D@B@BAF@DDB@@AD@F@F@@@B@BGBC@ABA@G@ADCBEBC@C@ABA@AB@@ACE@A@ABCDAFG@ABABABA@A@A@AAABABA@ABABABA@AD@@CACB@B@B@@ADABC@@B@B@BACA@ECABA@A@A@A@A@@D@F@HBBA@ABCB@@@"],encodeOffsets:[[111915,27343]]}},{type:"Feature",id:"522627",properties:{name:"天柱县",cp:[109.207751,26.909639],childNum:3},geometry:{type:"MultiPolygon",
+coordinates:[["@@AB@BA@@@A@A@A@A@A@AB@@@@A@@@@@A@@@AB@D@@@@A@@@GF@@AB@@A@@@AB@@@@A@@B@@AF@@@@B@@@B@@@@AB@JBB@@@BA@@@A@@BABAB@@AB@@@@@B@@@BA@BB@@@B@@@@B@@@@@@B@@AB@@@B@@AB@@AB@@@@A@@A@@@@@@@B@@@@@B@@AB@B@BA@@BBB@@@@@B@@@B@@@@AB@B@DBB@@BBB@BB@BBB@BBB@@@@B@@A@@@A@@@@@AB@@AA@@ABC@@@A@@BA@ABAB@@@B@@B@BB@BA@@B@B@@@D@B@B@@@@B@@@@@BCBAB@@AB@D@@@@AB@B@B@@ADA@@@@B@BBBB@@@BB@B@B@BBB@@@@@A@@@@@AB@@B@@BB@@@@@@@@DABAD@BA@ADAB@B@@BBB@@@BDB@@@BB@D@B@@@B@@@@B@@@@@@@BC@@@@B@D@@@@@BA@AB@@@@A@ABA@@BAB@FAB@BABA@@B@D@@@B@@@@AB@@A@A@A@@@AAA@@A@A@EACA@@A@EA@@AAAA@ABA@@@A@@CC@@@@CAECAA@AA@B@@A@@BA@A@A@A@@@@@CA@@@C@@@A@@AA@@AA@AA@@CC@A@@AB@@AAB@@@@A@@@@E@A@@@CACAC@A@@@C@AAA@@@@@A@AA@A@@@AAAA@AAA@@AA@ABABAB@@@B@@BB@@@D@@AB@B@@A@@@BB@@@@@BBAB@BA@@B@@@B@BB@@@@@@CB@B@@A@@B@@@@"],["@@CCCGAEBIJMDE@@AA@AAAEAGAAACBCBS@OBG@BDBB@DCDECIAAA@DBBDBDFDHDD@BAB@DDDBB@@@@A@BBBB@@@BB@@B@@@@D@DDDBBBFHABABDBB@@B@B@@BA@@DBB@BAB@FC@AAC@CFCJ@B@BE"],["@@@@@A@@@@A@@@EBABA@@@@@C@@@I@CA@CBC@@B@FAF@B@@@B@@@@@B@B@B@@@B@B@@AB@@@BA@@FA@@B@@@BA@@B@@@BAB@@AB@@@BA@@B@@AB@@AB@@@BA@@B@@AB@@@B@@@B@@@@B@@BB@B@@@@BB@@@BB@@@DB@@B@B@@B@@@@BB@@@@AB@@AB@@A@@@@@A@@BA@@@A@@@@@AB@@A@@@AB@@A@@B@@A@@B@B@DHNB@@@B@D@@@B@@@B@@@BBB@B@B@@AB@@@B@@A@A@@BA@@@@@A@@B@@@B@@ABA@@F@DBF@FAD@FA@ABCCAC@E@C@E@BEFAJGBAAGAC@AAA@C@CBGBAJGBABC@E@C@A@A@CDEDCFCDCDCDAFC@ABA@CEGBEDE@ECECAA@G@CBAA@@CA@C@ABEBEAA@@ACAA@C@@@@@ABBB@@@BAFAB@@AB@BBPHDA@ADEBI@EBC@GBABEBABE@CBGCCEAAAC@EA@@K@ECCAAEAGBCEEEAE@EBA@CB@BABEB@@EACAOEOIAACCIGCAA@E@GAE@AAACCC@AGCCAEA@@AAA@C@A@CBC@IEACACAACAE@EA@A@C@C@ABG@EBCBAHGDCAC@ACACEECAA@ADAD@H@@@H@DBFBBBHB@D@FAF@DB@@@@BB@@BB@B@@BB@@@B@BB@@B@@BB@@@@BB@@@@BB@BD@@BBB@@@@BB@@@B@@@BB@@@B@B@@@B@B@@@B@@@BBB@@@B@BBBDBB@B@FBJCBGFM@AFCDCHAJAJBFCDCAEEK@I@ABIDKBG@AAC@CEGEEKIAFA@I@ED@DBD@BEDA@ABA@CA@@AB@@@A@AA@CA@@A@KEGAECC@C@AA@@AABCBAB@FBD@H@@@@@@AA@@A@@AAAAB@@@@@AACC@CBA@ACCCGCECAAA@CBBJBFDDC@CAAACH@PAT@DADABBHBFBBB@BBB@@FGHOFKFOBM@G@E@IAA@A@@@C@AD@D@BABA@E@CA@CACAC@C@CAAA@@AACAGB@BAF@JAHCDEBK@@@I@G@@@O@G@GBKBOAKCC@CAA@IEIGEEAAKEKIAEAA@@AC@MAE@CACE@E@MFA@GBIBCFG@EAAGB@DGBIDCEGIA@@KEIGEIEACAA@GAIDOFMDCBG@ABS@IAKAGCEAE@CBCBCFA@@@A@AD@BABGAE@C@@@@B@B@B@BABDB@FDBABA@A@@@ADCB@BA@A@A@BD@DC@@BABABAB@BABABBB@B@B@BABABAB@BEHCBAD@B@BDF@BA@@BAB@B@DADAFCD@B@HAB@BADAHA@@@E@E@C@@BA@CCE@ABA@C@A@A@AA@@AAA@EAACAA@C@C@AB@@A@AA@AAAAA@A@AAA@A@E@CA@AA@A@CA@AA@A@A@AAA@@AAAA@@AA@@ADC@@AEC@G@CAC@@@@A@A@A@AAACAAAA@AAADA@AAAAAEBCGEA@@@@AD@@AA@@BA@@@A@BAAAB@B@@CAA@AA@AAA@C@CCE@AA@ACACBABCBABA@CBA@ABABAB@DCBBB@@EBA@@BCB@@ABADA@A@CAABC@A@E@A@BCAAEAA@E@MDC@A@@CAAA@@AC@@A@AB@AAB@@ACCBAAAA@GA@@CD@ACB@@AAACACAAA@A@CAC@C@EDA@C@A@ABCF@D@DABC@@@@AEC@@BA@AA@A@AAA@@@@DA@A@AAABEEA@A@AA@ACAA@AA@CAAC@E@CABE@@@@AAG@G@EC@@AA@AA@AB@B@@AD@BDJ@BADEDGBA@A@BA@A@@@AAA@A@AD@BAAADACC@ABA@ACCCICAAAA@GFCDABCFCBCACA@AAAEAA@AB@FADABIBA@AAC@CBIHE@A@ABAF@BBB@BEFC@C@CCAA@@A@@DBJ@FADCBA@ADA@GB@@A@A@ICA@CBCDCF@B@@BDFDBB@FBBABEDEBADA@@BAD@@ABABC@@BCBCBCBA@GCKAA@AB@BDBF@HHDDBD@DAF@J@D@B@@C@A@C@@@BFDBBFDBFFB@B@B@@@@B@@D@BBBB@B@DB@@DB@B@@@BAB@BBBB@@D@BABA@FDDH@DBFBCD@DBBB@BA@@BBDDBBBB@ADB@BAB@B@B@@D@@BCB@@AB@FFFBBB@B@B@B@BBBBBDB@B@@BBBBB@BFBDDD@B@DBH@JBBBFBB@B@BCF@@AB@@ABABCBC@@@@B@B@BB@BD@BD@BB@@BDBADAB@BEF@B@@@DBD@DB@BB@B@BB@DBBB@B@BBDBBBD@B@D@BBAB@BADAB@@C@@FCB@B@@B@B@DFDBBADB@@BD@BA@BB@@BBABAFADAJFBBB@BBDD@BBB@@FB@DBBD@FBBB@BAB@BFB@BCD@BAB@B@D@@ABAB@@@B@B@B@@CB@@CDAAAAAAA@CAA@ACA@@BAAAA@@@AA@ACA@@@AB@DADABCBCB@@BB@@AB@BADA@ADABA@@AAAFE@ACAA@A@@@@AABA@ABAACBCAAAA@@DA@BB@B@BAB@BBBBAB@BBBDDD@FDBAB@DBBB@FDDABA@@B@BBDAB@BDD@DABDFB@FBBB@@@@BABCDA@BD@B@B@BD@BBBBBFABBD@@D@BBDADA@BFBBB@B@BABD@@B@DBB@@ADBD@DA@ADC@AAABG@E@A@A@A@C@A@ABCD@B@DA@C@@BA@ADA@CAC@A@@AA@AB@B@BBBBB@@@BBBABA@ABA@AB@D@@@B@B@@BDDBB@@BB@AFADDDAF@@@@C@A@AFDBB@BBAD@@@DBBADA@C@@BCBABABABA@C@A@EAA@A@AD@BCDABA@ABAB@BA@CA@@CAC@@CAAAACA@@ABA@A@CAA@CCAC@CCAAA@@CEAAAA@@ACAEAA@AAAAAACC@CAA@ADAB@@@B@BA@A@A@EAA@EBABABCBCBCF@F@BA@ABAAA@AAE@A@A@C@C@A@@@@B@DB@BBBD@@BF@B@D@@@B@@@B@@@@@B@B@B@B@@@B@@@@AB@@@@@@@BA@@B@@AB@B@@@B@B@@@@BB@@@BB@@@AB@@@B@@CBCAE@ABAD@FCDBDDBBBB@BA@@FD@@DBBBDADBLDFBBBDDDFHFHFHFB@HFHDJFHBB@PF@@JFFDBB@D@DBB@@DBBB@@@BAB@BBBDDBBDDDBFBD@B@BABC@CB@@ADAD@B@B@D@@AB@B@B@B@DBB@B@@@@@BBD@BDBDDBBDBHDD@CBCBEBABBBBHHDDDBB@DBBB@BBBBBLBD@B@DAHAD@@@DDFDBBFAD@BBBBDDB@F@@@N@FAF@FABCDCACA@@CAGCAIAAAAA@@C@CA@@AACEAC@@CCACAA@@CC@A@A@A@A@CACACACAAAA@A@@CC@AAE@A@CD@B@DADBB@BBB@DAFAD@D@BAB@B@BAB@B@B@B@BAD@@@DBB@BBCBAB@DBAD@DBB@BBDBBBB@FAHCB@B@DBB@@@B@@@@A@@@A@A@@@ABAB@@@BA@@B@D@B@D@B@@@BBBD@BBB@D@B@B@BBB@BFHDHBBBB@@@@BABCBAB@B@BAH@@@LHJJPP@BNPDBBB@B@BB@@BB@BBBB@BBBJL@@HLDLHLDHHJHDLFHAJCDK@@BKDGBABCHEJCP@VHPHB@@@JBN@LBN@B@H@@@LDAB@@@@@B@B@@@@@B@@@BA@@B@@@B@@@B@@@B@BB@@BB@@@B@B@B@@@B@@@B@B@D@@@B@@@B@B@@@BB@@@@B@@@B@@@B@@B@@B@@@@@B@@@@@BB@@B@B@@@B@@@@@B@@@B@B@B@@AB@B@@@BAB@@@B@@@@AB@@@B@@AB@B@@AB@@@B@@@B@B@@@@@B@@@@@B@@BB@@B@@@BBB@@@@@B@B@@@@BB@@@@BB@@@CBAB@DABABC@AAC@ABAH@FBBAB@@BAD@BB@@@@B@@@B@B@@@@@B@@@B@@@B@@@B@@@B@@@@@BBB@@@@@@B@@B@@B@@@B@B@@BB@@@B@B@@@@GAC@ADAD@F@@@HDB@@@B@@@B@@@B@@@B@@@@ABA@@BA@@@@@A@C@A@@BAFKDGBCBIFE@ALGHCFAF@JDDHFJHHHDLBLA@G@IAC@ACEAG@A@@@@@@AAECAAEAAAA@@A@ADGBABA@A@@@A@@@@@@E@A@ACACBCBAHD@@F@B@BEACAAEBA@@@AA@@AA@A@@AA@@@A@A@@BCBA@@B@@@B@@@BAB@@@B@D@D@@@B@BB@@@@B@@@B@B@@@D@@@B@@@B@B@@@B@B@@@@@BA@A@@@A@A@@@A@@AA@@@A@@@@@A@@@A@@@A@@@@AA@@@@@@AA@@A@A@@BA@A@A@@@A@@@@@A@@@@@A@@@@@A@@@@@AA@@A@"]],encodeOffsets:[[[112177,27372]],[[112130,27473]],[[112097,27720]]]}},{type:"Feature",id:"522630",properties:{name:"台江县",cp:[108.321245,26.667525],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@BCD@BAHABCAE@A@EEAA@@GECA@@ABCFAB@AAC@AA@ABA@AACC@A@CBADC@AAC@CAA@C@A@ABCAAACECCAAAAE@ABABA@EBC@E@@@@CBC@CAAC@AAC@A@ABC@ABCB@AAEC@@@ADAJKD@@@DBB@HEFCA@@A@AAAGEG@AA@A@ABC@ABEBCBCBADAAEBABCDC@@F@HBFCH@JCB@BB@BAB@B@B@BBD@D@@BBDCD@@@@B@BBBB@@@FC@@BBB@@@@A@@@ABBBBBDFF@@@DDB@B@F@@D@D@FDF@@DB@BBFF@B@BB@@@@@BCFGFGBABBBBDD@F@@D@DCBA@C@CCECCAAAABA@@L@DAB@B@FFB@BABC@CDC@E@AD@D@BBD@@BBB@@B@DABAB@BBB@B@B@BAB@BABBB@@@AABACACECAAAC@E@ECA@ACACA@A@A@AAA@@ACACCEAA@C@A@C@C@@A@@A@A@A@EA@AFEHC@AAAB@@AFCAA@AAAA@BA@C@@DA@CBABAB@BABADADAB@BA@@@ABA@@B@BA@AB@BAAA@@@AAABCB@B@@A@AEEACAAAC@@ICABAAAAA@CEAAACAC@CCE@CCCACAC@CAC@ACA@CCC@AACBAAAAAB@BABCDABAB@@CB@F@BADAFBB@@@D@DB@@@ABCBA@AAC@AAABA@A@ABAD@@@@CDA@@DC@AFCDKBABBD@B@B@B@@A@A@A@AACDADEBAD@D@DCBED@@@DAD@BA@CBCBEBAD@BABCBAD@D@BABAD@B@BADA@@B@DC@@@G@E@@A@@AAA@@BAF@@@F@@A@CAA@AA@ICE@CD@DBDBD@DADEBA@C@A@C@AACAGF@BA@A@A@A@@@A@C@EEA@@C@@@@BABC@AAC@ABC@AAAAA@A@@@@KACAAC@A@CCCAA@@AA@AAA@G@AAABA@AAA@AC@AAAAA@A@AAAAAC@E@CAAAA@AA@@C@A@AAA@@AEAA@A@C@C@AAA@ACC@ECAAAAAAAECAAA@BABC@@B@BAAABA@CBIA@@AAAAA@@DA@@GAACE@ACA@CEA@A@ABAB@@CA@@DEAAEGGBABAA@@AAA@ECA@BAAAAB@AC@AAGCAACAEAEAAA@A@@ADA@A@CDC@AB@B@BCB@B@BAB@BABAFAD@BA@@BAB@AA@ACCCA@CAABA@A@CACAA@GAAACBCBAAA@EBC@AAEBAA@BEDAD@BABCBABA@ADA@C@CAAE@AOHBDBB@BBB@D@@B@@BBFBBBBBBDAD@DABHB@D@B@D@@@BBB@B@D@B@B@BD@@@@ABB@@@BADBCBABA@ABA@ABBBDFCDCDA@A@ABIBDBBH@D@BBFBD@BAB@DABA@A@@BEH@B@BA@@DAB@@E@CBA@EDCCCA@@AC@@AB@BC@EBABC@@BABBBABABADEB@BA@@DC@ABA@@BA@KB@BBB@B@B@@@B@BAB@BCDAACBIF@BC@ADAD@BA@GBCBEBC@C@@AC@GF@@EC@ACB@BDD@BBB@BDBDFB@DBBB@BB@@B@@CDAD@BABBFCDABCA@AE@ACC@AD@B@@CDBB@BBDA@ABCB@@@B@BD@@BBBABB@AB@@@B@BBB@@@@@BBB@@@BAB@@C@CBCAABG@@B@BABCBAA@@ABCDCBAB@BC@@@A@BB@@@DABCBADA@@@@BA@@B@@AB@BA@@AAB@B@@D@BD@B@DC@C@ED@@BFDD@B@BCB@H@DCB@BAB@@@D@FABA@CDA@@BABG@@BEB@DCD@BCH@BBBB@@DB@BBBB@F@@B@@D@B@DC@GF@@A@E@EAAAACA@A@AAAAG@EAA@C@G@@BEFC@C@ABABA@CAABA@ABEBC@AAC@@@AB@BGB@@@FDB@B@B@BCD@@@@DB@B@B@D@FLLFBDBBFB@AB@BB@DFAB@@A@A@A@@@AD@B@FDL@@ABCB@BABCFCBADCBCAAAC@@@CCA@CB@BABA@C@@@@BA@E@@@@BBBBBBBDFBB@@FBFDBBDBFDB@B@F@DD@BDB@@DBBB@B@BBBBBBBDFBBDDFBDBD@BB@@FD@BBBB@BB@BBD@DADAB@BADEFGFAB@@A@ABCBAB@@@@@BCB@BAHAB@BCBAH@BABCBAFCB@B@D@@CBAAABABABB@BBBD@D@BJBJ@DB@BDB@B@BADABBDBD@D@BDBBBD@@ABADCDAB@@@BBDFBB@DDDBBD@B@B@D@@@DD@B@B@@C@@@@DBBFBHBB@FDBBBBBBBHBDDDFDBDBDDB@BDFBDDBD@NBB@JDFBDDBFBF@DBBBBD@H@D@DAHCHBDBBBDBBDFFFFDBFAFADCBCB@FAD@BBDDBBFBF@B@DADABAB@FBDDDBHDJBD@BAJEBAD@JBP@F@F@D@BABA@ADG@@@EAG@C@ABCBCFCBCAACEACBCBAFAR@JCDAB@DGBAB@B@FFDBBBJBJ@JDJDDB@B@NBDBDBBBBD@B@DA@GBCDEDCD@D@HBDBD@BABA@AACGGCGAE@A@ABCB@DAH@DABA@C@AACECBAA@A@CCAAAC@EB@FCBA@ACACAGEC@A@E@CAC@ACGAAAC@AAEGAAC@"],encodeOffsets:[[110987,27418]]}},{type:"Feature",id:"522629",properties:{name:"剑河县",cp:[108.441501,26.728274],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABCHAF@@CA@G@EAAC@GA@@A@A@AB@BC@@BADCBCAA@ABABABA@A@ACA@CAAA@@A@@@CC@@CD@BA@A@ABAB@@A@ABC@C@AACAA@CA@ACA@BADEB@DEBADGB@B@BABABBBCF@@AAA@@DA@C@AAA@AGAAAABCAA@GACAAAAAACCIAC@CBC@AAA@CBABCC@AACEECBA@AACB@@AACAAAA@A@AAE@A@ABCB@BAB@DABAA@BA@A@@AA@A@A@@@CAAACBAB@AABA@@@C@A@BBABAAABA@A@ABC@BB@@@B@B@@@@KCEACAA@A@@FA@@B@BBDAB@DCD@BBBADBBAB@@BF@@@BDB@BB@@BB@DDDB@@BBBBB@DDB@BBBB@BDBB@D@BAD@B@D@BBDABBDAH@CDAB@BBBABB@@@DABBBBFABBD@FAB@BBDADABBHBB@DBDBB@B@BADBB@DDBDB@@BBA@AB@@ABCBEBA@ABA@A@ADA@A@ABAD@DCB@B@BC@@@BBBFBFBDBBBHDBBD@@BBABBABB@FDB@BB@@BBBAHAFHBBCF@@DB@@BABAB@B@DFB@BDF@BDHB@@CB@@BBBB@BB@AJ@DABBBABA@@@ADABB@BBFDBBBBBBDB@FDD@BBB@B@D@D@BBBBF@@BB@B@B@DB@@BBBBB@D@FBDBBBBB@B@BBBBD@@BBB@BABBB@B@HBB@BBB@@BBDD@D@BBDDBLB@@@@@BBBBB@BAD@BBD@BADAB@@@@@DB@FFD@B@@@B@B@B@B@@AHEDBBBD@B@D@B@FABC@CACAC@CDCF@JDB@@BBB@D@BE@@@E@AB@@BB@BB@@@@F@H@@CDA@@@CBABA@C@ABABC@C@ABADABC@ABAFAD@DABC@CB@@C@AFCDC@C@ABCFCBBD@B@B@B@BA@A@A@C@AAABCLED@BCD@@CB@D@@C@AB@B@BABBB@BBD@BABAD@B@@CAC@@@A@EACBABE@A@@DA@ABCBADABA@BBBBABBD@BDD@DDB@BBD@DBDBDDD@DDF@DBDBDBBDFB@BBBBBAJD@@BDBBBDFF@B@BA@A@ADBB@B@@BBABA@@BABA@@@AB@B@@ABA@CBCBABABA@ABAB@DCB@@@DABB@BB@BBBED@BA@BB@BGDEF@BFBB@B@B@@@@BD@D@B@D@B@FBDDDB@BB@BBB@B@B@BDBDB@FDF@D@BBDBDFDBABBB@@A@AAABA@ABA@A@A@AAA@ABCBA@@@AA@AC@AAC@C@@B@FCD@DADABA@EEA@A@CBK@@@ABBBBBDDDF@D@DABCDC@@@@ECCAAAAABEHEHAD@@@@A@@A@AEEAAA@@CE@ECC@C@@@@E@ACA@C@@EEACAAAA@B@@@B@@A@AA@@ED@@A@AA@A@A@@C@CDAA@@@CAC@A@A@ABA@AAAA@IDG@EDGAE@@@CDADABBFCBABADADAF@BAD@B@BBBH@HFBB@B@BB@EDGFA@CA@@C@ILCB@B@@FDBBA@AD@BAD@B@BBD@BBDDBD@DA@@@@@FAD@FABAB@BBFBBDBFDBDBBAD@B@B@DBB@DBD@BCDAB@D@BDDBBB@BAB@@BBD@BBADEBA@@DBHF@@BBFFB@F@DBBABG@ADC@AB@D@BBFHBBD@BBHBBDD@DBF@B@D@HFDBDB@BABEDA@@FBDBBDDB@B@B@D@@AD@@@DA@A@ADBBAB@BCDABADCD@BAB@DBFDBD@JABABBB@B@@B@B@B@B@BAB@BABABC@ABCBA@C@ABA@A@ACAC@@AAABAAA@C@ABABCDADEB@BBBBBBFDFBF@B@D@DBDDD@FDD@DDABEDAFABCF@@EB@@@DDBCD@D@BAB@@BBBDDBABC@@@AAAB@@BBBB@BBBD@@BD@B@B@B@FE@@BA@CBAA@BCB@@A@@@EACBADEAADEA@AEBADAHFADDF@DA@DB@@@BFDBBB@@@FBD@B@D@B@DBBD@BD@B@FD@B@BAB@BBBD@@@B@@BB@DBB@B@FBBBB@HBD@BAFA@ABA@@B@BAB@BB@BB@@B@BA@AB@BABABD@@BB@@BB@@@BD@@B@B@B@B@@B@AB@BA@EBEBAD@@@BC@@DCBBD@D@B@BBD@@AB@BAB@BAD@FABABABCAABABA@ABC@AB@BAFBB@H@B@D@B@@C@A@@@A@A@ABEBABAB@DAB@DA@AB@DB@@BBF@B@@@DBB@BABAB@BAD@DBFAFD@ADAB@B@@ADAB@BDDAB@@AB@B@BB@@DBB@@@@AAAB@B@B@@A@@@@BEHDBB@@BA@@D@BBB@B@BAB@DAB@BAHGBBB@B@@BD@B@D@DBDB@DBBDBB@BB@@@F@@BBBBB@@BH@B@@BFBBDBB@BBAB@D@BAB@BDBAB@B@B@B@DABCB@DBDDB@DDBDDBD@FADBDADCF@BAB@DCB@B@BAB@HBDBD@F@F@D@D@B@FBD@H@FBDDBDBBFD@B@BA@@BBBABBBBBBADBD@@@BDD@B@B@@BFBB@DB@BBDBBD@BBB@B@D@DFB@D@BAF@DABBBAB@B@D@@DB@@BBBCBBD@B@DBBBD@@AB@@ABA@BBDBDABADAFAFBBBBDDBB@DB@BABBBB@@BBABBB@@@JHDDB@@B@B@@@@AB@@A@@@BDCAA@@FADAFDB@DFB@BDD@@BABAFB@BAB@B@D@B@BJBB@BAB@AF@D@D@D@BDBB@@BDDB@BBB@B@D@@BB@@B@@B@@B@BB@@B@BBBBB@@BBBA@@B@@@F@BB@ABBB@D@@AB@B@B@BBB@B@@B@@@B@@@@DDB@B@D@BFDB@BD@AF@@BBB@B@DDABB@BBB@D@B@B@BBFDBAD@B@FA@AB@BAD@BA@@DBDB@@D@DDBDF@BBD@DBD@@@BADAB@BAB@D@DBB@BAFBB@DA@BB@B@B@BA@CB@BAB@@@BCFC@@DBB@B@B@BB@BFDBB@@BBB@@@BBBBB@BABABAJABAB@B@D@@AB@F@B@F@DECC@A@AB@@@AAAACAAA@AA@AAEBAAEEA@AA@AA@BAA@DAB@@@BABCB@@@DAB@FFB@BBBBB@@BD@BDDB@BD@BDFBAAB@@CB@BBDBDABBBAB@BA@B@@B@B@DB@BEFBB@BB@BABCB@BC@ABA@@AA@@DADABABC@CBA@@B@BDB@@B@@BBBB@AB@BDB@DBB@BBBBBBDC@@DA@@@A@A@A@@BABA@@@C@ABA@ADC@AEA@ABA@AAAEAC@AA@CEA@@AA@ACCAAA@AAIECBEBABAB@AA@@AABC@@AA@BCAAEC@C@A@AA@A@ED@@@DA@CBABA@AB@A@C@AACAAAC@A@AAACAA@@A@AAAA@@CAC@C@@@AFE@ABABCCA@AA@@AACC@@AAAA@A@@@@@ADADAB@BA@@BE@ADA@A@EAAAIAG@CAA@C@CCEA@AAAAA@AA@A@ACAAAAA@A@A@A@AAEE@EBA@@DA@AC@@@@A@ABA@ACA@BAAAACCAA@@ABA@AA@CDCEACAG@CC@EABABC@@@AAAAA@AB@@A@A@@CA@@C@AAAAAC@@@@A@@A@A@A@EECAAECAAE@@D@B@D@@@@A@C@IBE@CACCCGGE@CA@ABAB@LBHDB@DADADA@AD@BABA@@BC@AB@BCFAFCBAAA@EAAECAC@@@ADEDCDAB@JDB@B@@@HAB@BCB@DABC@EAI@CB@@@BA@CCCBCAA@CBEACBEBAACDCACAABAB@@ABABA@EBABAAACC@A@A@CC@A@C@AAAC@@A@@AA@CAA@@A@@A@@@CBAA@AAB@AA@@@AA@EAAK@ICA@GBCDA@C@CBA@A@A@CAC@@AKCA@CBC@CBE@ABE@C@@@A@CACA@@AAAAACAAAAACACA@ACAA@ABCB@BB@@F@B@DADBBADA@@@A@AA@A@@@@@A@AA@CCA@A@G@AGACCCAA@AAC@CAACEA@CEC@@BABC@AAABAAABAAA@C@@@A@@DABABAFG@AAE@AAAFAD@BAB@BAHCD@F@B@@C@CA@AA@BAA@BAAABAA@A@C@@@A@CA@@A@A@AACAACBCC@@AAAA@A@@DCBCAABA@@@AAAAABCAAAA@ABABADCH@J@BA@G@ABCCACCAAAAACCA@@CACAAA@CCEAA@@A@@AIGEE@ACAB@DABABAB@ACAECCCACACEA@CBA@C@A@C@A@AA@AAAAA@@AACAAAACA@A@@AAAA@@@AAA@@A@@CA@@@ACA@@AAAAEAA@@@CFABEBOD@B@BABAAC@@@AEAACBAC@ACAA@AACA@@@B@BA@A@CAAA@@A@BD@@@@CAA@@@A@AB@@E@CCA@CBADA@@BABE@ABB@@B@@@BCA@B@@@BBB@@CBCAAB@@AB@B@@EDA@A@@@BA@A@C@E@CAA@@C@A@AA@AC@AA@@B@B@AC@@BA@AAA@A@AA@A@A@ABCBA@AAA@ABC@A@@BB@@@BBA@A@C@A@@@@BA@A@AA@@@B@BC@ABCB@AA@AC@A@A@CAA@C@AEAEDEB@@A@AACBA@CBCDC@EAAAE@A@@@@FDJ@DA@@BA@@AABAA@BA@A@ECAAC@CCA@ABA@EFCBCBIBA@C@IB@BBFABBDAB@B@@A@A@A@AC@AGC@A@ACA@AA@AA@ACAAAA@AA@@AAAAA@AAAACC@AAA@A@@AAB@@AD@@ABA@@D@B@@AB@F@BADCDBBA@ADCBABABABABC@@BAAC@A@A@C@A@@AA@A@AE@CAAC@AAAAAA@@AAAA@CCEAA@@C@CACAA@@@AAA@AA@@A@AGBE@ABEDA@A@CB@B@BA@ABE@CBCAA@@@GAAAA@@AD@@A@@A@AA@@CC@DBDABADCD@BB@@@ABAFADA@G@A@@CB@BCBA@A@@AAA@CEAAB@@AA@@AAAC@@AGAAA@ACAAA@@BA@A@@B@@A@AIG@AA@@AC@CA@@@ACAA@AAAAAB@AC@A@@@A@ACAD@B@BAAAAAAEA@ABCAAC@@A@ADACABAA@CECA@@AACAI@@AAACBA@ACA@AAA@@AAAC@AB@@AA@AAC@AA@BAC@AA@AA@@BAAACA@@@ABB@C@@B@@A@EA@@@@ABA@ABAA@DABABB@@@AB@AABA@AB@@@@@A@@A@@AA@A@@@AB@AABA@CA@@@ACB@BA@AAAAAAA@A@ABEBABAA@@A@AAA@@AC@@@@CC@A@CAA@AAABABA@ABCAAAAACAC@EAAA@@CABEAA@@C@@@CC@@BC@ACCC@CC@AAC@AAEAA@AACCCCA"],encodeOffsets:[[111060,26990]]}},{type:"Feature",id:"522631",properties:{name:"黎平县",cp:[109.136932,26.230706],childNum:1},geometry:{type:"Polygon",coordinates:["@@ECCCCKAQCIIKAAIGGCAAEAQIKEKEQIKCCAKGECCCAABEJENAPCBCDCFGBCDK@I@C@@A@@AEKCGAAEIIIIGCCMEKCGAA@AAEEAIBM@A@IAEEIIKIKEECEAE@ACECGACGEIAIDI@IA@@GCAA@ABG@@AA@ACCAM@ABC@@@IBGBE@A@A@AAE@EAGAA@AGECAAAAC@C@AFCDCBA@ECCECCC@E@CB@JEHCBABEBCBC@C@CAG@CBG@CAAIBE@I@C@E@C@K@GA@IFIECG@CAAAAAECGAGBCDE@EEG@C@A@GFE@A@C@EBEBC@E@ABALAFGAE@GFGDABIFA@EBKAI@C@A@A@EBCBABC@ABBBDL@DAB@B@FBDABABAD@@BDABEBCBAD@D@BFDBDBDAFBBBHBDAFEFEDEDEHA@ABIDIBEBAB@@BFDFBD@B@FA@@BAAA@ABC@@@@B@@CDABA@@DBBDD@DBBBBB@BBDA@@BD@BD@BBDBFFABADBBBH@B@BBBAB@BB@@B@D@@A@ABA@@B@@ABA@A@@BC@A@AB@@@AAB@@A@@A@@A@@A@AA@ABAB@D@@CB@AAA@@@B@@AB@B@BC@@@A@@B@B@@BB@BB@@@@BA@@D@BAB@DCD@BBBADCBA@CBA@ABA@A@C@CB@BABCB@@A@@@@@@D@@@@AB@@AD@B@B@BCFADABAD@B@@ABABA@AAAACBA@@BA@C@@DEFABAD@@BB@DABB@B@BBBDBBADABA@@@C@A@GBG@@@CDABBD@D@F@BDNAD@BAD@@BB@@A@AB@@@DBBBBBB@BAH@BG@ABCFEBCBAB@@AAA@A@ABCB@@DD@BABABE@E@@@CD@@DDBBWHCAGAEAAAAAECC@@@AAA@AA@D@@AB@@@BBBABBBCB@BBB@BB@BB@B@B@B@BCDA@A@@@A@C@A@E@CDC@@B@BCBCCA@E@CAEGC@BAA@ECAA@@CCCCC@ABADADCBABA@CCA@@ACA@CBC@AB@@@B@DADCBAAACA@@ACAC@ABA@AAA@CAA@CCACCB@BA@ABAA@AECCAA@A@ABC@@ACA@A@ACAA@ACAAAC@@AA@AACEAEDADAAA@ACA@AAA@@B@@A@CAAC@@AAA@A@@@AAAAC@@BAA@BA@ABABA@@ACAAAACCAAAACCAAACA@@@CBA@@BCA@C@CEBAAAAA@EDABC@CAC@@F@B@@BB@BC@GDABC@CA@A@AA@AAAACACA@A@AAG@AAC@A@@@@@C@@@CBA@@@@@AAA@@AA@AA@@@@@@@@@GDA@AB@@C@CACBAAA@@@A@@@@@AAC@C@@BABCBA@ABE@@DA@@FCBAD@D@BBF@@BJBB@DDF@BBFABBHAF@@BF@BAB@DAB@DAB@BDFABABABABCDADABABA@@@BBBDAD@BCBABC@ADABEDA@A@ABCBABC@@AAA@AA@EBC@AB@B@@@@A@@@@BAB@@A@@AA@A@A@A@@CA@ACACC@A@@AACAAECA@E@CABBA@@BA@@D@@@D@@E@ABABA@CAABC@A@A@@@CB@A@ADCBC@E@C@AAE@BC@A@AAC@@@EDC@C@AAA@A@CB@@A@AB@BABA@AACABAAAAA@AA@C@@ACAEBGAABCACAAC@@AA@@A@AAAA@@C@AA@@ABC@@@A@@@A@A@EA@@CFA@@B@BCBBB@@ADA@ADEA@B@@@@@BB@@@@@@BBB@DB@AB@B@FBD@BCB@@@@C@AAA@A@ABA@@@AB@BABAD@B@BA@BDABCDAD@@ABABCB@BBH@@BA@DADBB@BA@@D@@CDCBC@BF@@AB@D@BDBDBFDDB@BDDD@BBB@BDAB@BBD@B@H@@BB@DBBB@B@BBCBE@CBAB@DBDB@@B@D@@CFAB@@BD@BEDA@A@A@EDAAA@E@C@@B@@@B@@C@AA@@AA@CAEABAD@@CAAC@@C@EDCB@BBD@D@B@BABC@@B@@B@D@@B@@AD@@@DABBBBB@@EDC@E@A@@BBD@@EFABBDBD@@ABE@@BA@BBBDDBB@DB@FAB@B@@@D@B@D@@C@@@@DCFA@A@A@@BBD@D@B@D@@IEA@ABAA@AEAE@@BAB@BA@CBA@@BBBDH@BABCBABADE@@@@@BB@@@B@DD@BD@BCD@BA@ABA@AAG@A@AAA@@@C@AAAAEACEA@@A@AAAKBEJ@@C@ABA@CACEA@A@C@@AA@@ACCA@ABE@IAABABA@C@ABAB@@BDABBBB@@BBB@B@@B@@BA@@BBDD@@B@BBBAB@B@B@BBBAF@B@@A@C@AAA@A@ABB@DBDDFB@D@B@D@B@B@B@DBBCD@BBBB@@BDB@B@D@B@BDB@BBB@BBB@BHD@BDD@BB@B@B@D@B@BDB@DA@BB@BBBABBB@B@BAF@@@BB@BDD@DBFBD@BFB@AD@BBD@@@@B@BG@AAABBBB@@D@@BBBADAB@DBAD@BA@AAA@A@A@@D@BA@@BBBD@BDAD@@AA@@ABADA@@BA@EB@D@@EBCBABA@@A@A@@CFABCBE@@BCBBDECEBCD@BDFBD@BBB@@BD@@@BBB@@@BC@ABEFGDC@A@ABABAB@DBD@DC@CBA@AD@BADCHAB@@@B@@@BAB@@@BB@@DBDEBCAA@ADA@A@@DAFABBBABAB@DBD@@B@BB@BB@B@BBDBDABBD@B@D@@DBB@@FFB@@@HC@@B@BADB@@D@DBBA@A@CBAB@D@B@BBBBBDDN@BDB@@B@BHBBB@DF@BED@@BBAB@@AAA@ADCBAB@@@B@BDHB@DBB@@BDB@BBBEDADA@A@ABEAC@ABA@@D@BBB@BCB@DABA@ADBBA@ABCBAFBB@DB@BD@@CF@FBBBBBFBD@BBBCB@BBFFFDDDFBBDBBB@@AB@BBBD@B@CBCBA@@@A@ABCBA@@BDDDBABBB@B@B@B@BDBBBABBBABE@ABA@@F@BBJAFBB@BAB@B@DED@D@DDDBF@D@D@BCDBB@BAD@BABCBEBCFCDBD@D@DB@FBDDB@BB@BB@BBBB@BBDDBF@@B@BBB@@@B@D@B@BBDAB@@ADABABABABCD@BABCACDABE@A@@BA@C@@@AB@BC@@BA@BB@@@BBB@BDDBBBBB@BBBB@@BBB@BBDB@BBBB@@BDB@B@BHD@BBDB@B@B@@@@ABAACBAAE@AJAD@B@JADADAFEB@BAB@DDD@BBFDB@B@@ABBBA@BB@@AB@@CCI@E@@B@F@BBFBD@DCDAB@DABBB@@@FAFCFB@B@DBB@D@B@BBDB@@BDABAD@@A@A@@BBB@B@@A@@B@D@B@B@AA@@A@@AB@D@BAB@BBB@DABAB@B@B@@B@BBB@BAB@@BDA@A@@@BBD@@BBBB@D@@@BB@D@F@D@BAB@@B@B@FC@@@ABA@@BADBDA@@AA@A@@@ADB@A@@@AA@BAF@BA@AB@BCDAB@DDF@@@BAB@@@B@DB@@@@ACB@@@BBDBB@B@@A@A@@DBBBB@DB@BBDDABBBF@@D@BBBA@A@APCFABADEAA@ABA@@@CACA@ACCAA@CBCAAAE@AAA@CCAAA@AA@AACBGB@BABADEB@F@FADBB@DA@AB@@AA@@A@CBA@@@A@A@CB@@@D@DBBCFADA@@BDB@BBD@@ABABA@A@AAA@ABAACCAA@CAAAA@DCBCBC@A@ABA@ADAB@@AB@BCB@BAB@DADAD@BAB@BBBCBAFBF@D@BDB@@BBB@BABDBDBBBFBJBBBFBD@DDJB@@B@BABAACDACABABAB@@ABABBFA@@@C@A@A@@BABA@@DBBBB@DBB@BBDBABBB@BB@BB@BBD@B@BFDF@B@BB@BDFB@@@DD@BBB@@BBBBB@BABBD@AA@AFAB@BAB@@AACBEF@BAB@AC@@CA@AFEDAD@FADA@@BCAE@AD@BAA@@ACAA@@CAA@AB@@AA@@@B@BA@BBCD@@A@C@A@@BDB@D@@BB@DABDB@@@@@@B@B@@DDBA@BBAB@DF@@BBFBF@D@@@DA@BBA@ABCBA@ABA@C@ABBDBBBABBBDFBBB@@@BAD@BABBB@@@@CB@B@BAB@@AB@BAB@@@DDB@B@B@BBB@FBB@@B@BFCB@B@@@BB@@B@@BB@BDB@B@BBBB@BDDB@BBB@D@B@DAD@B@@A@A@C@@CG@AB@@ABA@A@ABCBABC@@BCDAFCBAB@@@D@HB@@BBA@BB@D@@D@D@BABABAD@BADAB@B@B@BADA@AB@@@@A@@DA@AFA@@@C@ABEB@@AD@@AB@B@@@BABCD@BCB@B@BAB@BBBDBB@@DD@BB@BBBB@@ABABB@DD@BBBD@B@BFB@BB@BA@@BB@DB@ABAB@DCB@B@@ABAD@@C@@B@B@FC@@D@BBBADFBAD@BA@@FABAB@DBD@HBHBB@DABABC@GAED@BABADABBDBBBB@BABB@@B@@BBD@@B@BBAB@@B@B@B@D@BBF@DBBBADBBBB@DB@F@B@@ADC@AAAEAAAAABCB@BBB@BAB@B@DAFBD@@BBBBB@BADAB@@HLBDBBBB@BABABA@CCCAE@A@CF@BFF@DLBFCDBB@BDBB@BAB@B@@BBHBFAD@BCDCAA@ADADABAB@@AFC@A@CAA@E@@FBHB@@@B@@B@B@DC@AB@@ADBD@BAFABABCDIBAB@B@DDDBFDDD@BFBDBBB@B@BCBEDGBGDE@CBGFA@ECA@@B@BB@@BB@B@CBA@@@@BCAE@EBABA@A@CF@DBBDBABA@C@@@BB@B@@A@@@DDBDDBFDDD@BBBA@@BEBC@GAC@@@@D@HAD@D@@BBB@BE@ADBDBB@D@DADB@D@DBDAD@BBB@DBDBBFDB@D@BCB@BA@@D@FABC@EBCA@AAABGAC@BA@AB@BAJGDADA@@BAAAB@BABA@A@@ABA@@ABA@AA@BAD@BEB@D@BABB@@B@@AB@@BD@@AB@@@D@D@D@BBBBBAB@B@@B@@@ABBB@@@BABAB@B@BA@C@@B@BAB@B@DAFBB@BAD@B@F@EGEICKAMEQAA@ACGCGACAGEIEGAAAEAGCM@GAGACCEECA@EAGAIAEGCEAKBGFQFMLQDGB@@@@@B@@@B@@AB@@@BB@@B@@@BB@@@@B@@@@@B@@@@@B@@B@@B@@@@@@@B@@@@@@@B@@A@@B@@@@@BAB@@AB@@AB@@@@AB@@AB@@@B@@A@@B@B@@@@@B@@@B@@@B@@@F@B@@@D@@@B@@@B@@@D@B@@@B@@@B@@@@@B@@@B@@@@A@@@A@A@A@@@@@AA@@@@@AA@@@@@AA@@AB@@A@@B@@@B@@A@@B@@@@@B@@@@AB@@@B@@@BA@@B@@@BA@@@@B@@@BA@@@@@@BABA@@@AB@@A@@@@@A@AB@@A@AB@@@@A@A@@@A@@@@@@@A@A@@B@B@@@@@B@@@@@B@@@@@B@@@B@@@B@@@@@B@@@B@@@@@@@B@@@@@BB@@@@BA@@B@@B@@D@B@@B@@@@@BB@@B@@B@@B@@BBB@@@BBB@@@DB@@B@@BB@@@BB@@@@@@BB@@B@@@@BB@@@@@BBHBH@LAJ@F@FDJFFDHDJBF@B@@@BB@@@@B@B@@@B@@@B@@BB@@@@@B@@@D@BAF@BAD@B@BBDBDBD@J@@B@BA@C@@B@BDBBB@@B@B@D@@BB@@BEB@BA@@@@BAB@@@@BB@@@@@BB@@@@@BB@@B@@@@BB@@@@BB@@@B@BB@BB@@@BB@@DDD@DB@B@@DBB@DBFA@@B@BA@A@@@@@ABABCBABA@@BABEBABABACAC@AACACCAA@ACC@CCAAACCCA@@@C@A@AAABC@@AAACAGBEEEEMCCEIEEEG@IFOFODKDGFGLENEF@DE@GIAA@A@A@A@A@AA@@@@@ABABA@C@CBCBCBADA@AB@BADEBE@EBAAA@A@AAAA@AAAC@A@@BA@@@@@AB@@@@AB@@@@@BA@@@@BA@@@@B@@A@@@@@@@AB@@ABA@@@@@A@@BC@@@EBAB@BAB@BADB@BBBD@@@@@BB@@@@@@@@@@@@@A@@@@@@@@BA@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@BA@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@B@@@@@BA@@@@BA@@@@@@@@@@B@@@@B@@B@@@@B@BAB@BB@@B@BBD@@@A@@B@@A@A@@BA@@@A@@B@@A@@@@B@@A@@@@@A@AB@@A@A@A@@@A@@BA@@@ABA@@@@BA@@BA@@BA@ADAFADABABA@A@A@CAE@C@E@ACCAACAAC@AAA@C@A@CACAC@A@AA@ABA@A@@@A@@@C@@@E@@@A@A@@@@@AA@@@BA@@@@@A@A@@@AB@@C@@@A@@@A@@@A@A@@@A@@@A@C@A@@@A@@@A@A@@AA@@@@@@AA@AA@@@@AA@@@@A@@@@@A@@@A@@@@BA@A@@@AA@ABABCAACBCBCBCDCDCDCDABA@@@@A@@@A@@@A@A@@@@@A@@@A@@@A@@@A@@@A@A@@@A@@@A@@@A@@BA@A@@@C@@@A@A@@@A@@AABC@@@@@A@@@A@@@A@@@A@@@@AA@@@@AA@@@@A@@A@@@A@A@@BA@@@AB@@@@A@@B@@A@@@@@AB@@@@@@ABA@@@A@@@A@@@A@@@@@A@A@A@A@@@@@A@@@AB@@@@A@@@@B@@A@@@@B@@@@AB@@@BA@@@@@@B@@@B@@AB@@@B@@@BA@@B@B@@@B@@@BA@@B@B@@@@@B@B@@@B@@@B@@@@@B@@@@@B@B@B@@@@@D@@@B@@A@@@@BEBGBI@CBG"],encodeOffsets:[[112111,26655]]}},{type:"Feature",id:"522632",properties:{name:"榕江县",cp:[108.52188,25.931893],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAGCAAEAGGEE@CCCQ@IBIAC@A@ABGBC@ABABABC@A@ABABAD@D@B@BBBBDDD@HDD@DCFDF@BCD@BABCDCAC@AFCDC@@@A@E@ABABAD@BBBBDBF@DABADFL@BADGP@JAB@D@D@BBDBDABA@CAAAA@AD@B@BCCCCEACAE@CDA@GAGAEEEEGAMCE@@@AAEEAEAAA@CAC@C@E@C@@BAD@B@B@DBBDHBFBDABABADABAD@BBD@B@D@DAD@BBD@B@BFAB@@@@NCNENAHDFHHJJ@JCJADMDKBIHGNAJDPFLDLBFBD@B@B@BDBJHHDJDBDBDJFDBBBJD@@BBD@CDEFCDCBEBC@C@@@@B@@BB@B@B@B@DB@BBBBBBDB@@DFFFDDPLFF@@DBBLFHFD@@@@DD@@DBHDDB@B@BCBAB@B@BBB@B@@A@AAA@ABABBB@DBBCBEBCL@FADA@@D@B@DD@DAD@@@BDB@@BD@@@JF@@LL@BFDLPBD@B@FCF@J@FBBBBB@F@NCF@PHB@D@D@DCDAB@BB@B@B@BBBDBDBHB@@BHBFBBDBF@B@FBB@BBABCF@BADAB@B@DBH@@@@@F@D@@A@BBBBDB@B@B@BABA@ABABBB@BD@@BBBBAB@B@@@BB@DABABCBC@A@AB@@BB@@BB@@BBBBBDDDLPTJDLAJEDKCGAE@ADCF@JHLHJ@HCHI@KBECAAA@@D@@B@BB@FDD@B@BB@@D@B@BB@BB@BDB@@B@BB@@BB@@B@B@B@@BB@BDDAD@D@B@DB@@AD@BADADCDBB@B@BHFBBADBB@FDD@B@@AA@@CAA@@BA@CD@DA@@@A@@B@@AB@BE@C@AD@D@B@@BB@@@DBBB@@B@BHF@@@BBBDF@@@DABAB@B@BBB@BBB@@DD@D@B@B@B@BCB@D@DAB@@A@@@@@@AA@ADCAAAA@AAA@@@@BBBCBABA@AFIF@@@BB@@BB@B@D@DDB@BDBFBDD@B@DAAAB@F@FAABABC@CB@BBADB@@AB@B@@@B@@DBD@@ABBB@@BBBBBB@B@@B@B@D@BDDD@BBBBDBBB@@H@FBD@B@@@FB@BFHB@DBDDHD@B@BBDBDBBFFDHA@@DCDBHAF@DCH@HAB@DA@C@A@A@A@A@A@A@@@ABCDABFDBDFDBBB@@BDBB@BB@@DDDDDBB@@@B@ADB@@BB@DDB@BD@B@BBB@B@BAD@B@@@D@BBBBB@B@BBD@FAD@FDFDABFB@@BA@AB@D@BAB@BABDLCBC@EBAFBD@BCBADA@ABCBC@IEA@A@HH@B@@FDLJD@H@@BBBA@A@@BBBDBBBB@B@BABA@@HDDD@BABAD@BCBA@@BA@EBABCAAAA@AB@@@B@BB@B@DBFBLD@@@@@A@A@@AAD@BAB@B@BABBBAAAB@D@@@B@BA@BBADABBDB@@B@B@B@@BB@B@@ABBBA@CBA@ADABAB@F@BBB@B@BBDBBB@@DABBB@DAFFBD@BDDBADAB@BBD@DAD@JBDDBBBBBBBD@HBBADBBBBBHB@BBD@B@@CB@BB@@DEAABABA@A@AHABCFA@CFABC@ADB@BDBB@DBBBD@D@BAB@@@BABAB@B@@ADC@@DD@@B@@@BBDBB@BDB@B@BABABAB@DBDABC@AD@@ABAB@B@@@HBD@BB@F@HDB@@BEDGBADBDDBD@BBBBF@BBD@BDDD@DD@BAD@@DD@@D@@@BBAFDB@@BBFBD@DBBBBBDBBAB@BABABBB@DBB@D@@D@@D@@BB@BBB@@@BBBAFABAB@B@BBBBBBB@@ADA@B@@DBB@BA@BBA@@B@B@@BB@@@@B@@@@BAB@BA@BBA@@A@BABA@CBBBAB@BA@@@@FBB@@@@AD@A@BA@@B@BDBB@AB@@BBBD@ABB@@BBD@BBB@@BAD@BB@BB@BBB@BDB@DABB@BJ@DBBB@@DBDFB@ABDBCB@B@BD@BBAD@BFBBBBBBB@A@ABCBDB@@@B@D@@BBABBBBB@DB@B@@DBD@@BB@@BJH@B@BA@@@@BAB@@BBDB@BBBHB@BD@BB@BB@@BA@BBDFB@BB@@@BABADA@@DB@H@B@BCBEBA@@A@@ADCBCBAAC@CDD@@BBB@@@@BC@@BB@BBHB@@B@DBDAF@BAB@@A@ADAB@B@FCBAF@HA@B@BB@@BBB@B@@BBDCDEFADABA@ABC@AAADC@A@C@CAECC@C@CFC@C@ABA@AAABEAI@A@EB@BAF@BAAABAAACA@A@A@A@AAABACACC@AB@DABAB@@@B@DADAA@C@AA@ABA@@AACAAACECCEEAE@ADAAA@AACAEAAAA@EDE@@ACA@@CAABEDABAB@AABCB@BA@CDA@AAA@A@CB@BAD@FBBAB@B@BCFCAA@ACA@AA@CAA@CG@A@A@@BADABCB@BB@@BAAA@@FC@ACEA@AAAGA@@@CA@ACMACAAAAA@C@A@AB@D@BABCAC@@@CAABA@@@GD@@A@EE@@AA@CC@A@C@AACBCAAAA@A@@AAAA@@@AC@CBABAAABABE@CB@B@BCB@DBFAAC@CA@@A@@BA@A@@@A@@BADGBC@ABCB@DAD@@CAC@CBABABAB@D@HCFEBAD@@A@@AA@A@@AC@@AA@AACCE@ADCFAFDACDA@AF@DABADE@@@B@BB@BADAFA@@@CFAB@@AB@BCBA@@BB@@BCACC@AA@AB@@A@CB@B@B@BBB@@ABCCAA@CBABAA@@@CA@AABABBH@@A@A@@C@AAC@@BEA@AACAE@CCC@AAA@@E@ABA@A@AAABAAA@@ACBA@ACA@C@A@A@A@@ACC@AGC@AAA@AAA@ACA@A@A@C@ACA@AA@AA@ADCAA@C@A@A@A@C@A@CEACCCAA@BAB@B@BBD@B@@@@ABEAA@A@A@ABAAA@A@AC@AC@AB@@AA@@@@AAA@AA@AABAAC@@BABAD@B@BABAJBF@BAB@DD@BB@@BD@B@B@DFDBB@BAD@@@FILABB@B@BB@DFFBBBBBD@@@B@BBB@H@BBB@BAB@@ADC@AACC@@C@A@@AA@@@@F@BCBADABA@ACGAA@AB@DAB@@ABA@AF@FB@BBBBAB@JF@@@C@A@CAC@AB@B@B@DE@C@@D@@@@C@A@C@@@ABA@ECAA@CAACAAB@@AF@BA@@ACACBAFE@@AC@AB@F@D@FC@@AAAABA@C@@BC@@@AC@A@@@@AD@BA@A@A@C@BC@A@A@A@A@CAA@ECCE@AB@@@ACBEACBABA@AEEA@ECEE@A@A@A@AAACAAC@C@ABA@A@ABCBABAB@B@@@@AII@@A@A@@AA@@ACA@@A@IEA@AAC@ABCACDC@A@@@@AA@AA@@AA@@@@AAEACAAAAC@@A@@@EBAB@@BB@BABABAAABAAC@A@CAAB@BABC@E@G@@AA@AGB@@C@C@AACA@ABC@@AAAAAAE@AAC@AA@EBA@A@@@E@A@ABC@@@A@AB@@ABCACBAAA@CEAC@AACCEA@CA@@A@A@AA@@ABA@@AAA@@@A@AA@AA@CAA@@AAAAAAAE@A@A@@AAAA@@@@@@AA@ABA@@@E@C@@AA@A@A@@BABA@A@AAAAA@A@C@AB@@@@AA@@A@AB@@A@A@A@@AA@AAA@C@@@AA@CAHEBB@ADABADC@A@@A@C@@ECCCAA@AC@@BA@ADCHC@ABA@@ACCCEC@A@A@@@CA@@A@@@C@@AA@@CEC@@@A@AAB@B@@C@ADE@AAA@@AC@@BA@@A@@AAA@@@@@AA@AB@@@C@@C@CBC@A@@BA@CD@@ECA@EDA@@AA@@AA@AAAEACA@EACCA@AABCB@BCB@@ABAA@BADCBA@@A@@AA@AABAA@BA@AD@@@ECBAA@@AB@@AC@A@@A@@A@@AA@BAC@@AAAGCC@AAAAA@A@DD@DBDABA@AD@@AB@BAB@B@BABADA@EAABA@@@A@@@@B@@DB@@BBA@@@AB@@A@A@@EAC@C@@CE@AA@AAAE@C@AA@CBA@A@AAAAAA@BABCB@DDD@B@@CB@BAACBA@B@B@@BC@@@CAAB@@B@@BBD@@@@A@CBABABA@@BEDCBA@A@CBAAC@A@E@@@AB@@@@A@@@E@AAABC@C@AA@@AAAA@AAA@AAGEA@CEEGE@BAAC@A@@ACBA@@@@AAA@C@@@A@@AAC@AA@CAACCACCC@@CABC@A@AACBAAACB@AA@@@A@A@@@AAA@CACAAA@CDABAB@D@BADAC@@@@AA@@BAA@@@A@@A@BA@@@A@A@E@C@@AAAA@AA@AAAE@AAAAEGA@AA@AC@A@EA@AAGAA@@AA@AA@@@ABC@@AC@CAA@@EC@@A@@AAABA@@A@A@C@C@C@A@A@@AABAAAAAAAC@A@AA@ABAAA@A@A@@BAD@AA@AACAACBC@AACACA@BAA@BAA@BAA@BA@@@@@A@@@A@A@E@@D@B@B@@AB@BABA@@@A@A@ABA@A@A@AB@@@BCB@@CB@@A@A@@@ADA@A@@B@BAD@B@@@@AFC@AAAJCAA@CDA@CB@@ADBDB@AACCAA@A@EBA@ABC@AA@@AAC@KCSEACACAAA@C@E@@BA@@BAA@@AAA@@@BD@@A@ADA@A@C@@D@B@@@@@@A@A@CAAB@BAB@B@@@@DD@B@@A@@@AAA@@@AA@@@@A@ABA@A@AB@BA@@A@@BABA@@@@AAA@AAA@CBABA@EB@@@C@A@AB@@ADAB@@A@@A@A@A@@@@AAAB@AA@@@AB@@BB@@AAC@AAAAAAAAA@ACA@BA@AB@@AA@@CA@@A@A@@@@ACAAA@@BA@@CA@AAAC@@ACA@CC@AAEAA@@B@BADABAB@BA@CAA@ABA@ADEBAB@BCD@A@ACAAAA@@A@AA@@AAAA@C@AA@@@CAAA@@@A@@@ABA@@BCAGBEC@@ABA@ADAB@@IAC@@AA@@@@AA@A@BAAAB@A@ABAB@@@ACCEAC@@AA@BAA@AAAAECE@@ACC@AA@AA@@CECC@BA@CBA@A@EC"],encodeOffsets:[[110833,26242]]}},{type:"Feature",id:"522633",properties:{name:"从江县",cp:[108.905329,25.753009],childNum:1},geometry:{type:"Polygon",coordinates:["@@GKCEAEDOBE@ABA@CCACACAAAC@CCEIIEAEAE@EACAAAACA@C@AAEIEAA@GA@@CCE@C@G@ABCCA@@E@ECCCAC@A@CDCHAFCDACC@ABCF@FBB@AABCBGCGC@C@@CBA@CAC@GDEBO@ABS@C@@BEFEDCPAFCFCD@DEFGFCJAHABAD@B@@@@@@AAAAAAA@@A@A@A@@@@@CA@E@AAEACA@G@EBGBOQA@CGA@IECAA@@@AB@@@@B@BB@@@@@@@@ABABC@@@@@BBB@@@A@AB@@@@AA@@GEGEAA@@CAGEACEC@@EBGHMDEFEHGBGH@@AHEDGEE@E@I@ICA@@BAD@J@FABA@ABCFGBCBGDEFCDEBA@IAECEAGBADA@CA@@EEACKGC@ECEAGBEAECIAC@ABE@ED@BDHBLAB@BA@A@@@GAE@EAEAA@E@CBABCD@B@@@@AAA@A@AAA@A@AA@@AA@@@@C@AB@@C@A@A@A@AA@@@A@A@@@@B@D@@@@A@@B@@@B@@@@@@E@@@@A@@@@@AB@@A@AAA@A@@@QAI@@@CAA@E@CDABABAAA@@A@EAAAECAA@AA@@@ECK@@CGA@AAABCDCFADEBABCDA@@B@B@B@B@@@BA@BBA@@@@B@@@@A@@@@@BB@@@@A@@B@@@@@@A@@@AA@@A@@BA@@@@@A@@BBBA@@B@@@@AB@@@BABA@@@@BAB@@@@CAEBE@AB@BFDBD@DBDBF@B@D@DBDDBB@BBB@B@D@BAFBBBAB@BABADAB@BBDDBCDCD@BBBCDGFABCBEBCBCBA@@BBF@@B@@@B@B@B@@@@B@@ABA@@B@B@B@B@B@BA@CA@@A@@BAB@B@B@@@B@BB@B@@@B@BA@AB@B@BBB@@@@B@B@BB@@B@@A@@@ABABA@A@@B@@@B@@DBB@ADGBKBKBCA@@AB@BF@@B@@ADCBABCBCAC@G@EBE@CAEAS@IAG@CABAACEECCGEE@CAGBCDC@QE@@GBAEAGAA@@@AAACC@ABCAAAA@ABAD@D@B@B@BA@@@AAAAABABABA@CA@E@@AA@@ABA@@AA@CB@@C@@AAAAA@KBEDEBGA@@E@CCACCADGBABAF@BA@@EAC@CACCBGBCB@@CDCBCACECCEAE@CBAL@DCFABAPMHCHA@@DCBG@MBC@CG@IDKHCDCBABCDABA@AACGCAEDCBEBAA@C@CAAA@A@@@EBEBCACACCGCCACCCE@C@CBA@@BACAGGEAAACEBEBA@CC@GAIBEA@@@@@A@@@ABABC@@@AB@@@B@BB@@DAB@BAB@@AB@@ABE@@@@BA@@AAA@DAD@HBD@BGDC@CBEBEAAE@IDEFKNGJAAACAGGKAIAEGIAEBC@@@IAEBCFECG@AIA@@AAAE@CCCA@CAAABGBA@ABABG@AD@B@BCB@P@D@D@D@B@D@B@@C@A@C@CDEDAD@AACACC@AFAFAD@AA@CAA@ABC@ABEBCACEE@CDAECEC@A@CBCBEBA@@DCBA@C@@BAH@BBBCCC@ADQDEACAA@CAAAAACAC@EBE@K@G@AAACAA@CBEBEDADCHAHAAC@EDCDEFCFADCAABA@@@ABBD@@ABCB@DBBBB@D@FBBDD@DABCD@DCBEH@HAFEDG@GBEBIBABCBADABDDBD@DAFCDCBCFAHA@A@EBADAFCBEBAD@D@B@@@BCBCBGBEFA@A@@@CBCBAFE@CACAC@GCC@A@AD@FBB@@BD@DBD@BB@@BCDEDCBAB@JAFCFEFGDIBCFAFCBG@EAC@EBEBC@A@CEGA@@IAIAKAICEACAACAEAC@CCEC@EBMFCHCHCNAD@BBD@DEHAF@DAFEBAHAHGNCF@@ABEDGH@@ABCACA@AC@CDCDEDEDGBGFADA@ABIDGJEFAH@HBDADGHGB@@CACAE@ID@@ACCCC@E@@@E@CA@@A@CAAA@CBABAAGA@CDCB@BCA@@A@A@GGAAE@GBEDCBADABENAHEP@D@BBBBBBDEFAD@B@BDDBB@@@@BDBD@BADCFEBGBCDCHCDEDGBGFC@EAGAC@EAAB@BBF@FA@CF@D@BBBCD@BBDBB@B@@A@@DBFDFBFAFAFEF@@DDDF@@BBB@@BDD@BF@FDBBBBB@ABB@@BD@FBDD@B@@BABAB@A@BBABB@B@@B@@B@@BD@JB@@BABCB@BA@@FDHADB@AB@BA@@B@@@B@BB@D@@BBD@B@BB@BB@@B@BB@BBDB@B@BDC@ABAFABCB@BAB@DBB@@ABABABC@A@AB@FBBBD@@DDB@BD@BB@BDB@@AB@@BBDB@B@@B@B@@@DB@@BB@@BAB@@ADB@BBBBBBBBB@BBD@BA@@AA@@B@@BBA@BB@B@@B@B@B@@@@BA@CB@BA@@B@B@D@@FAB@BADAB@BBB@BB@@@@ABAB@@@BB@@ABAB@B@BAB@@@@@BB@@B@BB@@B@@@@ACC@@@@@ABA@ABADBB@B@@@@@@@@A@CD@B@B@BCB@@@AC@@B@BB@@BB@AB@@AF@D@B@BBBDBDTFLDD@BB@@BBD@BAB@FAB@B@DBBD@BCACA@BA@@DCB@DBBIDBB@BED@B@@A@C@ABA@@@@BCB@B@@@B@BA@@DA@AD@@A@@B@B@BAB@B@B@B@@ABABA@@BA@A@C@@@@F@B@B@@@B@@@@ABB@ABB@ABB@ABB@BDBD@BADBDDBBBB@@BBC@AB@B@B@BBBAB@@B@BBDBBBBBBBA@BB@B@D@D@D@B@B@@@ABBB@BB@@@FD@@BB@DBD@@AD@B@@BBB@@BB@HBBBB@@F@BBDB@@BHBBFBB@BBFBBB@@BBBBB@@@D@F@B@B@@ABB@@@@B@@BB@AB@@B@@D@CBABC@A@ABCB@DBBDBDBB@BB@@B@B@@@B@@BDABBABBD@B@BADDB@@DDBDDDBB@DBBD@BB@@@B@@@DBB@B@@B@DA@BB@D@BB@AHFFF@DFBBH@BBB@BBBBB@@BBD@D@BABBF@@@B@@@@@BA@@F@B@D@BBDAB@B@DAFC@AB@BABADAB@@@@@AC@AA@@@BADB@@D@@AA@A@B@DABB@ADA@@@ACC@CDABA@ABBBBBBB@B@DAB@@B@DBFBBB@@BDF@@@DBD@FB@B@@@BA@@B@AA@@CA@@@A@@B@@@B@BAFBB@BCBA@A@ABA@ABA@@BCB@BAAC@CCCB@B@BBBBD@HDBB@BD@ABB@@BB@@@@BB@D@@BA@@BB@ABFD@@C@@BABB@ABBBB@@BB@@@ABCDABB@AB@BA@ADA@ADBBB@DDFBB@BDBFBBB@@BB@@BB@FCB@FD@@DCB@@AB@D@DAD@@@@D@@BAB@@B@@@@BB@BB@@@AB@@BD@@BB@BCF@B@DA@A@BBB@@@D@DF@@BB@@@D@@@BB@@D@@@B@BFDDDBD@@AB@BGDCD@BAB@@BDB@DBDD@FD@B@@@@BCDABCB@BAAGFDBB@@B@@@DBB@BBB@@@B@B@BA@@B@BB@@B@@A@@B@D@BBBBB@B@BABAB@@@B@BBB@@@D@F@@AB@BBB@@@@@@BBBB@@@B@BBFBBBBBB@@BB@DBBB@@B@B@@BB@BB@BA@@BBB@B@@@DBB@DFBD@BBDDFB@BBDADBBA@@BAB@@@D@BAB@F@@@B@B@FAB@@BBD@BBFBBBB@BD@BAB@BD@B@D@DA@BHB@@BH@F@D@BA@ABADBB@D@BBBABBBABA@AAA@@BAFA@@B@@@BDBBDBFBBB@@@@BB@@BBB@@B@@B@D@DCDBBAD@BBB@JFB@@@DB@BB@@BB@B@@@JJ@B@@A@A@ABABAD@B@BAB@B@DBDDBBB@B@B@B@BFFFDB@FF@BABABBDAFBD@@A@@BDFFDB@DBB@B@B@B@D@@AAC@ADAFCD@@@BDDB@@BCBABF@DBB@@BBD@@@@A@@@AD@F@B@BBFCB@B@B@FC@AAC@@BADE@@@C@AA@AC@CBADAF@DAAAA@A@AA@CAA@@@G@AAC@ABAACA@AAC@CC@ACAECCACA@A@CBA@@AED@DADC@@@CB@@AAABC@CAB@@AG@ADABABA@@BCDCBAACB@@A@ABCBA@ABA@@B@BAB@B@BBD@@@@@DA@AAC@E@ABAA@@CAA@A@@@@A@@A@@@@@AFBBCB@BC@@AADA@A@AB@DE@@FBB@B@@@B@@@D@BA@@BBD@@@BBBBB@@@BB@@BDDBDBBAHBFADB@BD@B@@BBBBBABDBBBB@BA@ABAB@@@DAB@B@BBD@D@FC@@D@BBB@D@@ABF@B@D@FADCD@B@BDA@@B@B@D@BADBB@BABAF@@@@C@@@CB@@AB@AADBF@B@FDBBBD@BB@D@BDBDB@@DB@B@B@B@@BB@@@BA@A@@B@@@@@@ABAD@FAB@@BBB@BD@BADABAB@B@FCBABCD@BADA@ABCACAA@@B@BABABCDCBABABABACE@ABA@CBA@CBA@AAE@@BEAGBAAE@ACE@CAAAI@@AE@A@CBCDA@EB@@CF@BAB@DABA@AD@D@BB@@@@B@@@B@BBDADBD@@@BAB@HC@@@@@@@@B@@BBB@@BB@B@@@@AB@D@@@D@@@@@BBD@BBH@B@BDBDBBBBBB@@B@BDBD@BAHCD@@AAA@@@A@ED@DBD@BAFCB@BBBBFA@D@DDB@AB@DA@@B@BDBBDDBBBBDDBBBBBD@@ABAB@BABB@AB@@BDBB@B@@@BBB@BD@BB@D@BA@@@BB@BDB@BBBCBCBBFDFBBB@@BD@BBDB@BBBBDB@B@BD@@AD@B@BBBDDBFB@AB@BABA@DDDB@DBB@DBB@BAB@BBDBD@@DBBBABCDCBA@@@A@@BAD@DDB@BB@DDB@BADABCBCBAD@DDDD@@BBFDB@ABD@FHDBF@B@DDDA@A@AD@DCF@B@D@B@@@B@B@DC@A@A@A@AAAA@@AAA@ADAAABAAA@A@@BA@@@CBBB@BB@@D@FDBBBBFBHBDBXGAACC@@DC@@F@F@BABA@ACC@@DABAB@B@BB@@BADAFADEBAH@@ABG@AAAAAAA@C@@BAB@@@AA@@BC@ABCCM@A@E@CACBADC@@H@HAB@D@@@B@BABCAAACAAA@A@BA@CAA@@BCBAFE@CD@B@@AB@DABBBBB@BABA@@@ABCBABCDE@A@A@ABC@@BA@@@@@C@@@@B@@@DABA@ADAD@B@B@BAB@DAB@DABCAA@ADC@CBA@A@CB@@A@@A@@AAA@@@A@AB@@@D@@A@ABA@@@A@@BB@BDA@@@CBABAB@@B@BB@@@@BB@@@BA@B@@BAB@D@@AB@B@BA@@@AB@BAB@@@@C@AA@@ABAAA@A@AAGAABCBAEECAAAC@@AAC@@CBAAA@AAAA@CCCAA@CB@BADC@@@A@@D@BAB@BB@AB@@E@AACCEAE@@C@C@CACCACGE@A@@BCHCHAJCDA@AAACCAE@CFCFAHAF@@AACEIEEACCCCACAC@EBADABCACCEAA@C@A@AABKAAAACKGEC@C@EFCDCDGJADCBAFAF@BDHFDDDBDHH@BBFADBBCFBD@H@FEDCDEHADABA@EDC@CBE@A@E@C@A@ECEAGAG@CBCDC@CE@EBEDC@C@AA@C@OBA@@@AAIKAEAA@C@A@CBCAA@@A@CBEBA@CB@@CBABA@ABMDCBCBCDEDA@A@A@@@C@@@EAE@CB@@EDCBA@A@AAA@KCEBCACA@AAC@EACAC@@CDABCAAACBEDCBC@AAEEGC@@BABADGFGFGBC@@A@@@@ABCA@A@CBCDA@C@A@A@EGKMCCBI@@@@GCEAI@CDC@CACCACBAHE@CAAICI@G@A@AB@@@DBDEDKDC@CCACA@CACCK@GC@@@@ECIEAA@IBCECECGFE@@AA@A@CC@AAI@IBIBEBE@@BCCI@@BA@@DENCLCFAF@BBDBBBB@@BBBDBDBB@BA@AB@@@@@B@B@B@B@@@BA@@B@@@BA@AB@@B@@B@BAB@@@@@@CB@@@DAB@DB@AB@@B@@@B@BDD@@@@B@@@@@BB@@DB@@@B@@@@@@AB@@@@@@BB@@@@AB@@@@@@BBB@@@B@@AB@@FBBDDHBDBDBFFFDFFDDBDB@BHBJ@DBDFDT@FBDB@@@DADAF@@@B@BADDBFBBAFAHCJCLGBB@DDB@A@GFEF@@@F@FBDDBDDDBH@HBBD@J@F@FAA@@@@@A@@A@@@@@@@@B@@A@@@@@@A@@@@@@@@@BA@@@@@@B@@@@@@@@@@A@@A@@@@@A@@@@@@@@@A@@@@@@@@@BA@@@@@@B@@@@@@@@@@@@AA@B@BA@@FAHAJCHAPKA@@@"],encodeOffsets:[[111697,26338]]}},{type:"Feature",id:"522634",properties:{name:"雷山县",cp:[108.07754,26.378442],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@BDDBBDJHDB@BBFABBD@BAJ@@@D@F@DBD@BA@CBCBA@ABABADA@ABA@A@@B@BADBDAB@B@B@@@DABA@AD@B@@CDCB@@BBABA@BD@D@BB@DABBB@BB@@BB@@@@ADABA@A@@@BD@FCBB@BB@@@FBB@B@@@B@DDD@@@B@BBBB@ADAB@B@BBBF@BB@@BB@BDFFDBB@DBD@BAHB@@DBBB@@BBBD@BBFDB@D@@@@DCB@BAFA@@B@BBB@BABED@C@CAACE@BABAD@DABCAG@AAA@AAA@@AC@A@C@A@AA@AA@AAA@@AABC@A@@B@DAB@B@B@B@F@BBBAB@D@BAB@@@BCB@B@@A@@@A@A@EC@AECAEAAAABAACC@CC@AE@AG@A@@CAAB@AA@AACAABA@CB@B@BBDB@BBCBADAB@B@@@BA@ADA@A@@AEBAA@AA@CAAACA@@@AAA@BCBA@AD@B@BABC@@D@@CBABA@@B@@DDDB@@BC@AB@@@BBADBB@BBF@@D@B@BB@DBB@B@@AB@BB@B@BB@@GBADCBC@A@ADE@A@C@AACBC@A@@@@CAA@@@@CBADA@AACBC@@@ACAAAAE@@B@BC@A@@D@BABA@BBBB@@B@B@ABAB@@DDA@A@BB@B@BB@B@BABBA@AB@B@@BB@@ABAD@B@@CDB@@DBB@B@@ABCBA@AA@FABA@AAAA@@CB@DABABAB@@@BB@FB@ABAB@BBADDD@B@DAB@BBBAF@DCB@@CAC@C@GA@BA@@BADCAC@CBEAC@ABADCDAD@BA@BB@B@BA@A@ABA@CDCA@AACA@CBAA@@EBABAHBBEBA@@FAD@DCBC@C@AAA@A@ABA@@A@A@C@@CAABABABBBBB@B@DABCBABE@CD@BBF@B@DB@@B@DBAF@BA@@D@@ABBB@@BBBB@B@BF@@BFA@CDFFD@B@DDBB@BABADCD@@BD@BADC@C@@B@@@@ABEB@BAB@BDB@B@D@BA@@AAAAAC@A@BBAD@BB@B@@D@BAB@B@BA@ABA@@BE@C@A@AACB@@@@FD@D@@AB@FCF@B@@ABABEAABCB@@E@A@@@AFAB@BCDAD@@@BDBDFBBB@BDDB@DB@@D@BDB@BBD@BDD@BBBDDDD@BBB@BB@BAFC@BAB@@DBBB@@@B@BAB@D@@BB@@BD@BE@C@ABABBBB@@BBDABAB@DD@BBDBDDB@BBB@D@BCBABA@AHIBAB@B@B@D@@@DAD@DB@DBB@BAB@D@@B@BBB@@BDBB@BDBA@ABBB@HBBAD@BA@ABC@ABBB@BBBDB@FDABBBBF@BBB@D@B@BB@FCBBF@@B@@BD@B@@BD@B@BAD@BAD@BAB@B@DDHBD@BB@AB@B@@ADAFBB@@HD@D@@DBF@DA@ABAB@@@BBDBFBD@B@BBB@FABA@@@@BA@CB@BCB@B@DA@AB@@@BBDABABABAB@ACBA@A@@@C@E@CA@@AFCDA@@B@FAD@D@@AB@BA@AD@BAB@BAD@DADAB@@ABA@CBCB@DABADABBB@BA@@BBBBDB@@BB@B@DBDABAD@BBB@@@@BBBBBDDBBAD@B@FB@@B@BBB@F@@ABA@@D@B@B@B@DAF@@AB@@@B@@B@@AB@@@BBDBBB@BBB@D@FFDB@BBBB@BBDDCDAB@BCDBBBBBBBBBF@BA@CD@@@BBDBB@BAFB@@BCB@B@B@DB@@B@BBB@@BADABBB@BA@@@BBBFB@B@DB@B@@@AA@AB@@ABB@B@BB@FFDBDBB@DDBBDDBADA@A@ABABAB@B@BAB@BCBBB@B@BB@B@@DD@BBB@D@@A@ADEBAFABFFD@BB@@B@D@BBB@DBHBB@@D@DBBD@DAD@D@D@B@@@B@BCDABA@C@@AAB@@@D@@ABADADCBA@@BBDABA@A@AH@BADBDAD@@@BA@A@@AA@A@@@@AA@A@A@@BAA@BAAA@AC@@A@A@@DABAB@AC@AAADC@@@ABCD@BDF@@BDBBADCAEBA@ABCDC@@@AA@@AAACAA@CECA@AAA@ACC@ADA@BFD@@HED@@BD@D@FADAHAB@@ABCBCD@@AJEDABBDC@ABA@A@A@@@A@AAA@ALAB@@AB@BAD@@CB@@AFABCBABAAABA@AD@BAFAD@@ABA@@BD@@DBDDFCB@DAF@@@BA@CB@@A@AFG@AB@B@BA@CBA@AACAE@A@CAGCAJABAB@B@DCDCCEAABAB@BAB@BADACAAB@@A@BA@@@@ACA@A@C@A@A@AA@@C@A@C@A@AGCBC@CBAAAAAAAE@AA@@@@CAA@AAAACPG@BBFDBD@B@BCB@BADABA@ABCFC@AAACB@@A@BAAA@ABADCG@CBAACBAAC@A@C@ABC@A@CA@AAAAAA@CCA@AAAA@@CACCA@@AA@@ACA@A@@AE@@BAAABCAA@ADC@CBAAC@A@AB@@E@A@A@@BAB@BBDBBAFAB@@AB@DA@ABCBA@ACCGC@@ABABA@A@AACAAA@AB@B@AA@AG@C@KIEC@@@AGGB@B@JFD@DABAB@BCDA@AACBEFAD@DACKBA@ABA@A@CBAB@@AA@AECBCE@EBC@EAC@A@AAAAA@A@C@@@ABC@A@AAA@A@AACA@CCA@@AA@BCA@@@A@CACCCC@@AAA@CA@AA@AAECACECBADCBA@@B@B@B@B@B@B@D@B@@CBA@GDG@CBEAGDC@CB@CGEEAAACAC@A@AGCCCCAA@EG@AEA@@A@C@EAG@@@AACAAAAAC@CC@A@C@A@AA@A@AAAA@AA@AA@BC@CA@@@A@@@ABA@@CAAB@ADAD@BABAEBE@A@BBCBA@C@ACAEACA@CCC@A@A@@AA@@A@@JEBEB@BADAAA@A@@B@BBB@BBDBBCB@@B@@@@B@@@BA@C@CDA@A@A@A@A@CCC@@AA@AAA@A@ABABA@C@@CEAA@A@@GE@A@AA@AA@C@@AA@@@A@CBCD@F@@ABA@@@AB@@@B@@CDCB@@AB@DB@@BB@@@ACC@EAABCAAGE@A@AAADCBCBC@ABC@@CAA@C@C@CBACA@@AA@A@A@@@AA@@AAA@@@CA@AAAA@@A@A@CA@@A@ACC@EAAA@@@@CB@@@ACEICIIKGEOMGEGCAA@@A@@@A@@@A@@@A@@@@@A@A@@@A@@@A@@B@@A@@@A@@@@B@@@@A@@@@@AB@@A@@@ABA@A@AB@BA@@B@@@B@F@B@B@BAB@B@@@B@D@DAD@@@B@B@@@B@@AB@@@B@@@@@BA@@B@@A@@D@@@B@BB@@@@B@@@@@BB@@@B@F@B@BBB@DB@BBBAD@FAB@@A@GB@@@@CB@@AB@@A@@@@@@BA@A@@@@@AB@@@@A@@B@B@@AB@B@@@BA@@@@BA@@@A@@@@BA@@@@B@@@@@B@@@B@@@B@@@@@@AB@@@@@@CAA@@@AA@@@@A@C@@@A@A@@@A@@@A@@@@@AB@@AA@@@@@@@@A@@@A@@@AA@@@@@A@@@A@A@@@ABA@ABA@@@@@A@@@@@A@@@@@AA@@A@@@@A@@AA@@@@@A@@@A@@@@@A@@AA@@BA@@@@@AB@@@B@@AB@@@@@@AB@@AB@@A@@@AB@A@@"],encodeOffsets:[[110720,26713]]}},{type:"Feature",id:"522635",properties:{name:"麻江县",cp:[107.589359,26.491105],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@IEOOAACEEAME@@K@IJALARCPCHCJCF@@@@GNEJCHCB@@KLA@@@OHA@MDKHGDCRMFKBKDS@CAKCOGKOIGGFABCFEPBfGh@B@@AFCL@@@@IJMFKAIA@@A@IEOCMCQCMCK@KC@@@@GEEMEIMOMEUAM@UDQDIJGH@@ABOHYJQHE@KBEF@@@@ED@BEJGJEFIH@@@@ABCBA@IAE@C@CBAD@@C@ABB@DBDB@B@BBDABABABB@@@B@B@DBB@@@B@FDFB@BAB@D@B@@ABAB@BABA@A@C@AAAA@@BADA@ACACCAB@D@D@@@D@B@BBBFBD@DAD@@BB@J@@@DBLFLFHFFJHHPNJHBNIJOFS@IBGBEHA@ABCP@RBB@@@D@D@BB@DBBBBB@B@BBBDBBAB@@@B@@@B@@@@@B@B@@@B@@@@B@@ABCD@D@DBBB@BBBBB@@B@B@@@B@@@@A@@@@@CAAA@@A@A@@B@BBB@@B@@AB@FBB@BBBAHEB@DAD@B@DABADCB@B@B@@@BBB@BD@B@@@BDCBA@BB@@B@BCD@@@@B@B@BA@@B@@@B@@@@@B@JCBABA@@@A@ABAB@B@BBB@@AB@BA@A@@@AB@@A@AD@@BB@@@B@@@B@B@@@B@@@D@@@BA@@@@B@@@B@B@@B@@@@D@F@DBB@@@@@AB@@AB@B@BABA@AD@@B@@BB@@@@@B@@@@@B@@@B@@@@@@@BB@@@@@@BB@@ABA@ABC@AA@BAB@BFBDB@DBDDBBBDBBBB@@@AA@AAA@@AA@AAABAD@H@B@B@B@BB@BBABAHEDCB@B@@BABABBD@F@B@@@BAD@BAB@B@@BBB@D@B@@@BC@CBAB@BB@B@BAB@B@BB@@BAD@B@BB@F@B@DAD@BBB@B@@B@BA@@@CBBB@@B@D@D@@@AB@BB@DBDAD@DAD@D@@@B@BB@BB@@AB@B@DAD@BA@@@A@@BA@AAAACA@ABAC@A@A@@AACCAABC@A@CBA@C@@@@@E@C@C@CFCDAD@D@BBDBBBB@B@B@B@BB@B@BBB@B@D@B@BBBAB@DBD@DBBD@F@D@@@@A@@@@@A@@@@@@BC@@@@@A@@@@BA@@@A@@@A@@BAB@@@@@BA@@B@@@B@@A@@@@B@@@B@@@@AD@@@BA@@B@@@@@B@@@@@B@@@B@@@B@@@B@B@@@B@@B@@B@@@@@@@BBB@@B@@B@@@@B@@@@@@@B@@@B@@@@@B@B@@@B@@@D@@@B@@@B@B@@@B@@@B@@@B@@@@BB@@@@@@BB@@B@@BB@@@@BB@@@B@DBB@@@B@D@@@@@D@D@D@D@@@B@@@@@B@@AB@@@B@@@B@DB@@@BBBB@@BBBB@BB@@@@BB@@DAD@BCDBD@BAHCF@DADDB@A@@BBBB@@@B@BA@AD@H@@@BA@@DABBB@@@FEFGDADC@@@A@@BABADC@EDCB@B@B@@@FCDAB@ACCCCCAAA@@A@C@C@@AAAAA@@CCAAA@ADEJG@CAECCACE@ACCCA@@C@CBABADADBDDB@B@B@BA@C@A@AFADAF@DA@ADAB@D@BB@@DAB@B@DBBBBBBBDA@@@AAABA@@B@BBB@BA@A@AHA@A@@BAB@@@D@B@DABB@B@@DBD@D@BBDBBAFAFDBAD@BBDADBD@DAD@B@@@BBBB@B@B@FABAB@BA@AB@DCDC@E@CBA@AB@@B@BB@@AB@@HDB@@ABBBABBB@@BCFBBBDD@B@B@FD@@@B@BFFB@D@D@HHBD@BFAH@BBDB@@@@BB@ABAB@@BBAB@BAB@HC@@B@BA@BDFD@@BADB@A@AB@@DBD@D@@@@BA@BBB@ADD@BDB@B@B@BABAA@BAB@D@BBADBBB@@A@B@@D@A@AB@@BB@@B@B@@BBE@A@ABABC@CBABABA@A@ABC@AAEBCAEAAAAA@@AAA@A@CCA@AA@@AB@AABC@A@ABCAGA@A@AAC@AA@@@ACEAC@CAC@A@AAACC@A@BAA@B@@AAAADCHEBADCB@F@JAB@BAFAB@DCD@@@B@B@BBBDF@BBB@BABABGFBBB@@BA@ABA@ABAD@BA@CFEAABADABA@@@CBDFADCD@BA@@FAB@BA@ABA@ABA@ABA@EAABADEB@BBB@BBBADHB@B@@BBB@@DB@BB@BDBB@BBDD@@DBD@D@DDAD@D@BAB@F@JDB@NEFCB@AAAAA@AABA@CB@@ADABAA@CA@A@CBAB@B@AIFCB@D@B@DCBBF@@AAECCCE@AAACACEAAAECC@AAAAACBG@E@C@IEAACCBA@C@@AEB@B@B@@AAAB@BC@@A@BG@CAACGACCCACAA@AAACCACC@CAEA@C@AF@@ABA@C@C@A@A@AAACCECAEAIA@A@C@CA@AC@AACCGEAAA@AAHABCHB@@DAD@DAB@@@@AEAEA@A@A@EAG@G@@A@EAIBA@AACA@@EAGAA@@E@@@@GBS@@@@@SBQ@IEEIQEOEOCS@WCGA"],encodeOffsets:[[110264,26930]]}},{type:"Feature",id:"522636",properties:{name:"丹寨县",cp:[107.788727,26.19832],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@M@OMCCCCIB@@E@EBCBE@GCEBC@C@AC@CAE@@@AACAAA@A@AB@@@BA@EBABAB@@E@AAAA@CDCBAACCAAC@A@A@CA@A@CF@@@DAB@D@D@@@FBD@D@B@@@@@BA@@BA@IEC@C@CDADBDB@DBBB@B@@A@C@CB@@@@GDC@EBA@CD@@@D@BCB@@ADAFCDAB@@A@@@@@AAABADCDAB@DDB@B@B@@@@A@@@AHGHGFICEA@@@@ECQCKH@@EBGHADAB@@IJMNG@@@E@EC@@EACK@A@@AG@M@@@QCK@AAACGCCCAKAEBEBC@MFIFEFEDGH@@@B@@ABILADCFCVEP@@@@KHIBKF@@ABCBGFEH@@BDBBBB@BAB@DABBB@DAAA@AACAAAA@@B@D@@@DAD@DA@C@C@AB@@ABAB@@A@@@AA@ABC@E@CA@ICEAAB@@@@@B@B@B@BADA@BB@B@@FBBB@@@B@@@B@@@@@B@BB@@B@@@B@@@B@@@B@B@B@B@@@@@B@B@@@B@@@B@@@@@B@@@B@@@B@@@B@B@D@B@@@B@@@B@B@D@@@@@B@@@@@B@@@B@@@@@B@@@@@B@B@@@@@B@@@@@B@@BB@@@@@B@@B@@B@@D@B@FN@@@D@@BFJZLZLR@B@@JR@@BFJ\\DN@@BL@RIHE@ABC@@@@FB@HBFB@@DBBBB@JAFBB@@@@HBH@F@B@BFBFB@B@@A@CBC@CB@@GAADGBBBB@BBHFDDBBD@@BDBD@B@B@BJBFFDDDBB@B@B@B@D@DAB@BE@@B@DFBDBD@BDDDBB@BBBBDDDBDDHBB@DAHB@@@ADA@BB@BA@A@A@BF@@@DABDDBBJFD@F@H@DAACACAAFA@@BBD@BC@CBA@AB@DBBA@@DBBB@@B@BBD@BDBB@@B@@DBDBABBD@BBDAB@D@DBBB@B@DA@@D@BABB@D@FBBD@FBBB@AA@@D@B@@A@@@ABB@AB@@A@@@@BCF@@BBE@EBAB@DABABBDFB@BBBAD@BAB@@@DAB@DABB@B@B@BDBBBBAH@@@D@@BD@@BB@@@B@BABABAB@F@@B@B@@BCBADABABCACA@AACECA@A@@BCDC@ABABE@@B@F@@@DABAFBBABA@@@ADE@EBA@@@CEC@@@@DABBB@D@F@@AB@BAB@@A@ABA@A@CA@A@@ABCAAB@D@BBBB@BB@@A@C@ACA@ABA@AFABA@@@@@AD@D@BC@AAC@@DCBCBA@AAACCA@C@EEDCB@AE@@AEA@A@AA@AA@AA@BC@@@ABE@AB@C@AA@@C@AAE@ADCF@BADABA@C@AAAAABABABADB@@@D@B@BB@BAB@B@BBD@D@DA@CBC@EB@FAAABGBAFA@@BBDAB@BD@BDBDCB@BAB@B@@A@AAAB@@ABCDCBCBAD@FBDAD@DBBC@AB@@AHBD@D@DB@@DA@CBEAA@ABA@C@ACCBCAAA@AB@BEAA@@A@@BABABA@CDA@@BBBBB@BA@EBBB@DABA@@@AAA@CA@DC@@@ABCBA@@AA@@@ABAB@AAABA@A@@A@AAAB@B@CC@@BABAA@A@@@AAAAB@BA@A@CB@D@@A@AF@BBBBBD@@D@DABBB@BCDA@@@@BB@D@@B@D@DABBD@B@F@BCB@D@DABCHA@@AAA@A@@ABA@@@AAA@CAAA@C@@@AE@AAABCAA@@A@@BAD@@CACC@@@AB@BADA@@@CD@BA@A@ABCB@DA@ABB@B@@DBBBDBB@@BBBFA@BB@B@BCB@@A@@@ABABCDAAAA@AC@A@ADAB@BADBBBB@@BBADB@@@BBHF@@BDDD@BDABBBBBBFFD@BFDB@B@@@B@@@@ADA@A@@BA@A@CBAAA@A@E@A@A@ABA@C@AB@D@BA@BB@BBB@@BBBB@D@B@D@@BB@BBB@BBH@DBBA@CBCBA@ADFBB@D@DFCBA@AAA@A@AB@BE@ADA@C@@C@A@ECAAC@AA@AA@AA@CA@BG@AAC@CAAECCE@AAA@@AAE@AA@A@ABABCA@AA@A@A@@CC@C@A@@@AAA@E@@AAA@DA@EAC@@B@B@BABC@@@@AA@@AAA@AACBA@@A@CACB@BAAA@@DADC@@@ABCB@BA@C@@@A@ABAACBC@A@AB@B@BAB@BCBABAB@DADAB@@AAC@C@E@C@@BI@AACBAAE@ACAIGACCAAC@@@@@@A@@@@@A@@@@@AA@@@@AA@@@@A@@A@@@@AAAA@@@AA@@AACACCCAAC@GD@@A@@@@@A@@@A@@@@BA@@@@@A@@@@@ABA@@@A@@@A@@@@@A@@@@@A@@@@@A@@@@@A@A@@B@@A@@@A@A@@@A@@@A@@@AB@A@BA@@@@@A@@@@@A@@@A@@@@@@BA@@@A@C@@AA@@@@@A@@@A@@@@@A@@@AA@@A@@@@@A@A@@A@@A@@@A@@@AA@@@@A@@@@@A@A@@@@@A@A@A@@@C@EB@@IBG@A@@@GBKFOLMFC@"],encodeOffsets:[[110614,26717]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("黔南布依族苗族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"522701",properties:{name:"都匀市",cp:[107.518847,26.259427],childNum:1},geometry:{type:"Polygon",coordinates:["@@EF@J@DAH@JGJCBGBIAKCWCK@QFIB@@IDOHC@MJEHAH@NDTDPBRLXLRLVBREFO@M@OBEFCDABEDKDSJULSLQHK@CGFIHG@KGGMKIMGOGMKMQISAQBQDGFKJIRAD@@ETFHNDPDHJ@JAF@HGLIJGFQ@Q@MDKDCBEDCBGNERMH[FWBYDLrL^@HCDGF@FLRLDLN@@J@JAJBFCDAFGNGNCNBF@HDDDJNFJBLDHBPHPJTFHJPDN@LCP@H@@@B@FHLLHPJPJPHLJFNBN@LBBFFHCHGPGRBHF@DIDIDCBMNAPFDHHNJPJNHHDRHVLNLTRHLBLAJGLITET@DETCVCVBHBDHPPPRGZIPGBA@@HGJIRCVCN@VBNFNPFJFNHF@@@@LDL@NDRDNDPDJFB@@@JBLBNEJI@@@@DKBE@@@AHgAeFODEBAHEJHLPPHLDDBT@LCLANEDQHCLGNCB@PG@@B@LK@@DADGFIHM@@@@DEDIDGDOBQBKJIL@@@NFFBDFBBPPJF@@HBXDT@PDPFRFFJJFR@TA@@@@T@HA@@D@BAF@JG@QAK@@CMI[AE@@IQ@@@AKQKYIYAE@@@C@@EMA@C@@@@AA@@@@A@@@@AA@@@A@@@@@A@@@@@A@A@@@@@A@@@@@A@@@A@@@@@A@@@@@C@A@A@@@A@@@A@C@A@A@@@A@@@A@@@A@@@@@A@@@A@@@A@A@@@@@A@A@A@A@@@A@@@A@@@AA@@A@A@@@@@A@@@A@@AAEA@@@AAAB@BC@A@A@A@A@@A@@A@@@A@@@A@@@A@A@@@@AA@@@A@A@@@A@@@@@@@ABAFABA@AACAA@CDAFA@@@@@A@@@A@@A@@A@@AA@@A@@A@@A@@@@A@@A@BAHINGDAJALCHEDAFADMAY@@AM@G@G@GBWCOIG@A@@IGEESK@@@@AA@@@@AACAMI@@KGABCBGBI@G@CACCAACACAAE@ECCBA@AAAEEIMEECAKIQESGAAC@ME@@KGMEOAMFCDIJEFKLKFUCAAMEWCS@MBKFC@G@MAQQCEAEGSCUAC@C@M@OAEACGCMCA@ADA@ABC@A@A@ACACBCGCSIQKCCEEEQ@C@GEKAKGOCKCGCEEKEIMIKCKBIBG@A@"],encodeOffsets:[[110003,26478]]}},{type:"Feature",id:"522722",properties:{name:"荔波县",cp:[107.898882,25.423895],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CDABAB@DCBABGBC@ABAB@@CBC@@BABA@A@C@AAEAAA@AC@A@A@@AAE@AAACAA@CACAE@AAABABC@A@A@CBA@A@ABA@@@A@@BA@@B@B@BABA@A@A@CCA@AACCCA@AC@A@AB@DAB@BA@@BABB@@BBBB@BBBBBB@B@BB@D@B@@B@@@@@@BBBA@BD@DBBBDFHHFL@DDJAHCJGNKHKFKNIJ@@ENAHBLBDBHFNBLAJGPIHKBKCCEAEKIMMQUMOGMKEEDEDAHBF@DBD@N@JCHEHIFGDMLILEJCFGNAPALANAJBRFHHLFHBFAFGDIBIAHNHHDDHHALILGFMJGNARDTHRHRBNEPCFINWVMV@VDPBBHLJLDJ@DCXM`E^BNBHDLBXEX@TBFB\\JLBBBDXLdDRDDBDKHCDBHBHD@FFFFBJBJ@TCPGD@FAFBDD@D@DAB@@BD@BBB@B@BB@@B@BB@\\IV@NJBTCVLHNCXELGJIBEDGAK@CAGAWBSHONMLGNEPCBAJEDCFEDGDGB@FMLMPCPEPBPFLDJLHVHHJBHEDAJIFOAYHMNEPBDNJHL@L@dGLDVRD@DBBBBDBBD@@@B@D@@A@C@ADCBCDGBC@ABC@CBABCBABCBA@AFCR@FBFFBJAPCP@@DB@F@D@D@@@BBD@D@BF@BBBB@BJFRJZDJBTDTJHLBLFVRBPGLBD@JH@BBDFBLBLD@@DFFFFBD@JFHFFFBDFB@A@CBCBABAB@D@BABABAD@HABAB@D@JBJAR@DD@DFFHHFBBBHDBB@@FDB@B@DAB@@A@@FEBEBEAECEAE@CB@@@@AAAAC@ADCAA@A@CDEB@@EAE@ABAFBD@HBFBD@HEHAFCDCDGDCHAFADEBC@AACAC@@@@AACC@A@ABCFEACAAAA@A@CFOBGFMBABCDAFCHAF@@AAC@@FCCIGSIMCAEEIIGKECKEI@KAKEMGGIA@C@AA@@@@@A@@@@@A@@@A@@@@@A@@A@@@@A@@@@@@@@@@@A@@A@@@@A@@@@@@@@@@AA@@@@@@A@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@AA@@@@@@BAB@BAJEFEHABABC@IAABCA@@ACCGAC@IFE@IAEAC@AD@FBF@DAHGR@@CDCAC@CDC@A@@AAAA@EBE@CDCBEDEFEACACEEAE@GCGCGEGCC@CCACC@@CDIDGDCBADBDFDBFADCJAFGBEFADADA@@AAECAC@EFCFABCAGCE@AACA@A@A@C@@BAGACA@E@EBABEBEAECKDCBGBOCEC@@HK@AHADABEBAAAAA@EBCB@BA@CAEAGAGAGIGCC@A@AKCEA@CDIJCLGFK@MCOKKIE@@EEEMBMFMHIDE@CEGECCEAI@I@IAKBCACGCC@AAIACQGS@AAAE@CAA@AESKWIKCC@CBEBC@C@GDIBI@EBCAM@IAADE@EACAC@CA@@A@]CMDE@CEEEOGMEMEKEGAMAOC@@CAAAEAAA@@CCA@ACCCAAACGCA@AAACAA@@EBCBE@C@A@CAAA@ACAA@EAA@C@AACCA@A@A@CA@C@A@AAAEBC@CCAAC@K@@@C@C@CDEEAC@CAEAECCECIEGAICC@CCC@CAAEC@@@"],encodeOffsets:[[110429,25719]]}},{type:"Feature",id:"522723",properties:{name:"贵定县",cp:[107.232793,26.557089],childNum:1},geometry:{type:"Polygon",coordinates:["@@I@@@KMKCKQ@EiESP@@@BABELAFKLIFMHKFMLKHABIHEDQRILOTEHGJMPADIZDDFDFFLJJFBBJDLHLF@BBBDH@H@LAH@@AFBNHLNHNHJL@BJNDLBHINOCMGK@GFCBCFGJAB@@ABKLIJGHGHGFMJ@@KHCJDLB@FFLDDB@@@A@@B@@@BAB@B@@@B@@@B@@@B@@@@BB@@@BB@@@@B@@@@BB@@@B@@@@@B@@@BA@@@AB@@@JIFAJ@FAB@H@H@LBHFDF@H@FBFBHAJEFEF@FBD@BDDBB@BD@DBB@B@BBB@D@B@D@BB@@@@@BCD@@ABBBJBD@BB@F@D@D@DFDFDDHDJBJ@B@@@@C@CBC@GBABEBA@@BC@GBA@CBABA@CBABA@AD@@BDDBF@HALEB@FCF@H@F@DBD@DB@BBB@B@D@B@B@@BB@@@@@B@BA@@@@B@@A@@BA@@B@@AB@@AB@@@@@@AB@@@@EBI@ED@@@H@@@HDHFFJFHFFDFBJ@B@J@FAB@LHJHJLHJ@@BBLJRD@B@B@@@B@BBBBDBBDDB@DBDF@DBJAPCBEBABCBCC@CBGCAABEBEBEAGACBE@E@C@@@A@@D@B@BQKQIMGKBEBGLEJIJIFA@KFCJ@BBB@@@@BBBBJFLFJFHHBD@BAFAFEHAH@@AHBH@FFJLLBBLLNLLHXRNFB@HLBNAJ@HERAD@BALCRARDHBJJFLDHEHENKN@B@HD@JCLKNIJCDELBJBBBBDDPJJ@@@D@T@LBBHDP@BFHBBJJHDHDNDT@RB@AHAD@NAHCD@@D@B@D@@@B@B@B@BD@D@DAFCB@DA@@@A@CBC@ADGHBD@FBJJLDJABAJAHGREHQNEBE@@BABADK@O@UBQHIFEPKPKB@TGHEDQ@E@I@Q@UB[A]AG@EGGOCI@C@MCIGGKAKDILQ@ALM@AD@@@D@BA@@B@@@@@DADAB@B@DA@@@@@@D@B@@@B@B@@A@@@@@A@A@A@A@@@AACA@AA@@A@A@A@CDABCBA@C@CBA@GFABAAA@EAA@@BA@@@AA@A@AB@B@@@BBDB@@@@B@@@@@@A@@@A@AA@AAAAA@AA@C@CDCBA@@@A@@A@@@A@A@@@@@A@@@A@@@A@ABCAAA@A@AAAAACAA@@A@C@C@@AA@QDOBAB@FGHAJAT@PEJIAMIGOMGGEIGEKEKECA@@I@A@@AC@CBC@EAAA@A@A@C@@@C@CBADDDB@BCBAB@@BBBBD@B@B@BA@ABABA@@@A@CBA@AEAECA@@@A@CAA@A@@@A@BABABAAC@A@ACACAA@BAD@@@BCDAD@F@JBB@DABA@@@@JGFEHIFI@AFC@@@@FELAF@OOGOACAGDUDUFS@CFSJSHKBIAKGKSQMKUKQGGCMGOIMIGGECBONMDAJCJC@CGEQAOHGHGDEEAA@KAMEMKIOGOIOIKGGK@E@A@@@GDO@KCMIOEGISGOAOCGAKEIIMCCGCE@MAMDMHEHCBEDIAIB"],encodeOffsets:[[109722,26743]]}},{type:"Feature",id:"522702",properties:{name:"福泉市",cp:[107.520386,26.686335],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AECA@A@A@C@CA@AAA@@C@ADAD@BA@@E@G@C@EBAA@@@@AA@@@A@@CA@@AA@A@@@@@@@A@@@A@@@AB@@A@@@@@@@@AA@@@@AAA@AA@AA@AA@A@@CAA@@@A@@@A@@BA@@@@@A@@@C@C@C@C@@@@@C@A@@@A@CAA@@@A@@A@@A@@AA@@@AA@@@@@@AA@@@@@A@@@A@@@A@@@A@A@@@A@@@C@@@A@@@A@A@@@@@A@@@A@@@@@@@A@@A@@@@AA@AA@@@@@@A@@@@AA@@@A@A@@@A@@@A@@@A@@@@@A@@@@@A@@@AB@@C@@B@@A@@@A@@@@@@BA@@@A@@@AB@@@@A@AB@@@B@@@B@@AB@@@@@B@@@@AD@@@@@@@B@@@@@B@@C@E@C@AA@CAC@CBAAA@A@A@C@AAA@A@AAAA@A@A@A@AACAAAC@C@CBED@D@D@D@F@@@@@DAB@D@BADBBDDBB@@@B@BBDBAB@BDBB@BAB@@@B@@ABC@CBA@A@@BA@@AAAA@@@C@C@CBC@CBCAA@@ABA@@C@C@A@@@AADA@@B@@A@AA@A@AAC@CBA@E@A@@A@ABC@AA@@A@ABA@A@AAAA@AB@DAD@@A@C@A@AA@@@ABA@ABC@A@@@A@EACBABA@AA@A@CDGFABAB@AAAA@A@A@G@C@ABBB@BBB@@BB@BBB@@A@AACAAACAAC@CCAEA@ABA@ABBD@BAB@BA@@AA@@@@@@AA@@@@@@A@@@A@@@@@A@@@@@A@@AA@@@BCB@BA@A@ABA@@BA@@@@A@CAE@C@@@@@@AA@A@@@A@@@@@AB@@C@@@A@@@A@A@@@A@@@A@@AC@@B@BA@@B@@@BABA@@BA@AAA@A@AB@B@B@@ABABIDA@@@@@A@@@A@@@ABA@A@@@@@DC@A@AA@@AABCD@B@B@B@@@@@BA@A@@@A@C@@@@@@@CBA@A@CBCB@@@@A@@@ABC@@@C@@BKN@BKRCJBLHLJHNDD@J@PDHH@FBHB^A\\@V@R@J@FCRGFSHA@OLOLEFGJAR@V@PCLABAB@@AFMFGRQFGHIBABIBKCIIEAC@GACH@BAD@D@B@@CBA@EDCBC@C@@B@BBDBF@@D@@@BB@B@B@BAD@BBFALBF@D@BB@BBDDBDD@BBBFDB@B@B@DADBD@B@F@FB@BBBD@@@B@F@F@B@BAB@BA@@B@BBF@B@D@@BDBBDBDBBBD@@@BB@H@DBH@D@B@F@B@B@@@B@F@B@BABBDADAFAF@BAD@B@D@DADADAD@DADABCFADAFAD@D@BADAF@FAB@F@@ADAD@D@DADABAD@F@@AD@DADABAB@BAD@BAF@D@D@DAD@D@B@BCBA@CFDD@@@@B@@B@@@@BB@@@@@BB@@BB@BB@BB@@@@@BB@@@@B@BB@@JJPFRJPHPDPDB@RHHJFHJHRFJFLJDHDBDFNDRIHEJGRQHMHKDOBW@ABMFQJQLOLILA@@H@V@XB`PVFD@F@J@RBRDB@RHPFPFPAFCBAJEZOFGDM@AE@ACAC@AB@@C@C@AC@C@AGGGAIIMCEEGFMJGPAJAFBDBZBRCDALCJ@DBFFDH@B@@B@@BB@@@B@@B@@B@@@@BB@@@@BBA@A@@@AB@@A@@@@@A@@BA@@@@@A@@@@@AB@@A@@@@@@DAB@BBB@BABAB@@ABABABABA@A@@@A@@@@@A@C@ADABAD@D@B@B@B@D@DAD@DA@ABABCA@C@AAA@BAD@B@B@@AAAB@DC@ABABABE@@A@@A@@@@@@@@@A@@@@AA@@@@@A@@@@@@AA@@@A@A@A@@@@@A@A@@AA@@@@@AAAA@@@@AA@@@@AAAAAA@AAA@BABA@A@ABABA@@@@B@@A@@@@@@@@B@@@@@@@@@@A@@@@@@B@@@@@@@@@B@@@@@@@B@@A@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@A@@@@B@@@@@@@@B@@B@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@BB@@@@@@@B@@B@@B@@@@@@@@@@@B@@@@@B@@@@@B@@@B@@@@BB@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@B@@@@B@@B@@@BB@@@@B@@@@@@@BB@@B@@@@@@@@@B@@@@@@@@@@AB@@@@A@@B@@@@@@@B@@@@@@@B@@@@@B@@B@@@@B@@A@@@@B@@@@@B@@@@@@@@@@@BB@@@@@@@@@@@@B@@@B@@@@@B@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@B@@@@@BBB@B@@BB@@@@BB@@@@@@@A@@@@BA@@@@@AB@@@@@@A@@@@@@BA@@@@B@@@@@B@@@@@BA@@@@@@BBBB@D@@ADBB@B@BEF@BAB@B@BBB@DB@@BBB@B@BBAB@BAHAD@D@B@@@DABA@@@A@@BABAB@@@B@B@B@D@@@D@@AB@@A@@@@B@@@B@@@@@@@BAB@DA@@BA@@@@BABABA@A@@@@B@@@B@BB@@B@@ABC@@BA@@B@@@BAB@@@@@BA@@B@@@@@BA@@@@B@@A@@B@@A@@@AB@@A@A@A@@@ABA@@EBABABA@@A@CA@E@A@A@AABABAD@BAAC@C@ADAB@@@B@BAB@@C@@B@@@D@B@H@D@@@BABAB@BB@@BBD@B@FB@@@@@@B@@@B@B@B@@@B@@A@@@A@@BA@@A@@A@@@@AAB@@@BAB@AG@EAAC@A@@A@AB@DABADCBC@@@CAAA@AC@@@@A@A@A@@C@C@CA@AAAAA@A@@BA@A@A@@@@@@@AACA@AA@C@A@@AA@@AB@BA@A@A@A@@AAA@AAA@AAA@@AAAAAA@@A@A@AA@@@A@A@A@@@A@@C@CA@@A@@AA@@A@A@A@C@AB@@A@AAA@AC@CAAAC@CAA@@BA@A@@AAACA@@C@@@@@@A@@@A@A@AB@@A@C@C@A@@@AA@ABA@AAA@@AAAAA@@AAAAAAA@ACCA@AA@@A@AA@A@@@AAABA@A@@BAB@BA@@B@@@@A@AAC@@@A@A@@@AA@@AA@CCAA@@AAAA@@@AAAAAAAA@@AAA@A@C@@@A@AB@BABABADABCA@@AAA@A@A@@@A@@@@@@@ABA@@@A@@A@@@@A@@B@@A@@@@A@@@A@@@@@@A@@@@B@BA@@@@@@@@@@@A@@@@@A@@@@A@@A@@@@@@@@@@B@B@@@@@@A@@@@@A@@@@B@@@@@@@@A@A@@@@A@@@@@A@@A@@@@@@@A@@@A@@@@@A@@@A@@@AB@@@@@@AB@@@@@@AB@@A@@A@@AA@@@@@@A@@@A@@A@BAA@@A@@@@@A@@@@@A@@@@@A@@B@@AB@@@@@B@@BB@@A@@B@@AB@@A@@A@@A@@@@BA@@@@@A@@@A@@@A@AB@@@@@AA@CBARIJGDA@@@@FEFKBKBE@@BEDM@AFGJGJI@A@@BC@@@@B@@AB@@@B@BABABEAA@C@@@CBACAC@AAAABA@CAC@A@@@AC@A@A@@@AA@@@A@@@A@@BAA@@@A@@@@@AA@@@@A@@@A@@B@@A@@@A@A@@@@@A@@@A@@@@@ICEAIAW@WB@@@@Q@A@@@WDSNABAD@BINIVMLQHC@MBOEC@C@@@@A@ABA@@@AA@C@AA@AAA@@@C@@BAD@BA@@@@AAACAA@@@@AC@A@A@A@@@AB@@A@A@@AA@@@@C@AA@@A@@@EAEBGBGHAD@@@@@H@J@H@N@BABAAEEA@AAABAA@CCAE@C@C@@@A@@@@AB@@ABA@@AAAAAAAAAB@@AB@BBB@DABA@AAA@"],encodeOffsets:[[110145,27243]]}},{type:"Feature",id:"522725",properties:{name:"瓮安县",cp:[107.470942,27.078441],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@A@@@@@A@@@@@A@@@@@AA@@@@A@@@@@A@@@@A@@@@@@AA@@@AA@@@@AA@@@@@AA@@@@@@AA@@A@@A@@@@A@@@@AA@@@@AA@@@@@@@AA@@AAA@@AA@@@@@@@AA@@@@@AA@@AA@@@@@@AA@@@A@@@A@@BA@@@@B@@@@@BA@@@@@@BA@AB@@@@A@@BA@@@@@@@A@@A@@A@@A@@@@AA@@@A@@AA@@@@ACGEECAI@KDCBQDYACAEAIBOBIHENFHDFJNBJHHBHD@D@@B@D@DA@@BBDBDF@@BCNEHYPIFABEDOBOEOEQGA@QCQAI@E@C@UE_OWAU@G@@@KBKJKPIRERAN@BAXCPGLGNQRIHGFQJMCCECACGKIIEQEIGEGGIQGA@OCOCOGQIOEII@@AAA@@@@@AA@@@@A@@AAAA@@AA@@A@@@@AA@@@@@AA@@@@@CCCB@B@BABAFAFABAHAJAF@H@DA@CDCDEBE@G@A@EDCBA@CBA@C@C@GBA@@@ABC@CBCD@B@B@D@BBB@B@D@@KPqA@DAZDRLl@\\@D@D@JLNLPJNBRGPIPEN@@DJNL\\L@@PDJDJ@P@PDHBFBZJFJDR@RBNBD@T@@[RUHEHDPMDC@A@AAcBQ@O@A@EAwOC@A@@@C@QLQLBF@HHF^HJHNhBDTRVRX`BRBRBJI`R`VVLNNNLHTHGXDD@@B@TRFDBDLRDbCf@@@DFHLAZWJBDA@@@ANCHAFAL@P@TANC@@@BDBNF@@DDDBB@B@D@B@B@BCBA@EAGIMAEAABADAF@H@H@LAJA@@@A@@@@@AA@@A@@@@AA@A@@A@@A@@AA@@@@@@@A@@@@B@@A@@@@B@B@@@@@@@B@@@BA@@B@@@@@B@@@B@@@BA@@@@@@BA@@B@@@B@BA@@@AFEHAHGFGDE@I@GEEAAIGKC@AA@@@@A@@A@@AA@@@@@A@@A@@A@@@A@@@A@@A@@A@@@@AA@@AA@@@@A@C@A@@@@@C@A@A@A@A@@@@@@@A@@@A@A@@@@@C@@@A@@@@@A@@@A@@@A@@@@@A@@@A@@@A@A@A@@@@@A@@@A@@B@@@@AB@@@B@@@B@@@B@@@B@@@@@B@DAB@F@HDDBF@HAFGHM@I@IDAHAJBDD@B@@@B@B@B@D@BB@DDHHBDBB@DBB@BBDBDBDBFB@B@@@@@B@@@@@B@@@B@@@BA@@B@@@B@@@@@B@@A@@B@@@BAB@@@B@@@BA@@DCBA@@NFPBRBRBP@HIFEDOBI@A@@@AD]B]@GEC@A@A@@A@C@@@AAA@EC@@A@@AAACAAAAAAA@@@@CACA@ACCCAC@@A@A@AAC@ABCBC@CAA@A@AACA@CAGCC@A@CAACCACEAE@KDIFK@C@ICC@E@C@@BCFCDAD@@B@BCDADAB@BBBBA@@DABAB@B@BDD@D@@@BA@@DAFA@C@@@@@C@A@@BAD@DADAD@DA@AAAA@A@A@@A@A@CB@BAD@@ABCBABAB@BA@@D@BA@A@@@CB@@A@@BCHEDCDAN@FDB@FDBF@H@HOXGP@F@@@FFBB@D@JSNULKJCJ@L@TJHHIVQREP@BLJNBNDVFJBB@DBJBRBLGFCDA@ALCJ@THB@HFJDNDNC@EACEQMK@KJEJAJCLACSCMBAB@C@C@A@@@@@A@@A@@@@@A@@A@@A@@@@@A@A@@@@@A@@@AA@C@A@@AAAAA@@BABABAFBDBD@B@@A@A@ABA@@BBDBBBBBFFB@@@@@B@B@@A@@@@@ABA@@@A@@@@@A@@@A@@@@@A@@@@B@@AB@@@@@@@B@@AB@@@B@@@@AB@BAB@BCBA@ABABC@AA@CBA@C@@@@A@A@AAAAA@@DO@@BADOLQRQJMDKAG@@@ACC@@EI@@@@KQKQEMCG@@GEEGAIHIBI@@@@@EEEMBKDKDKGKMAEGMGOAKBKDQHM@@FGFK@@DCHSHIHK@@@@DGBCAKEKEE@@CAKC@@@@KEQMA@@@AB@@A@@@@@A@@@A@@@@@A@@@@@AA@@AA@@@@A@@A@@@@A@@AAA@@AA@@@A@@A@@A@@@AA@@@@A@AB@@A@@@@@A@@AA@@@@@A@@@@@AA@@A@@AA@@@@@@AA@@@AA@@@@AA@@A@@@@AA@@@A"],encodeOffsets:[[110201,27581]]}},{type:"Feature",id:"522726",properties:{name:"独山县",cp:[107.545048,25.822132],childNum:1},geometry:{type:"Polygon",coordinates:["@@K@K@EAOGGM@AEICIIQCGCE@@@CAC@ABC@A@AAAE@AAA@@A@AAC@ABA@ABAA@@@AACC@@ABABCDCDEBA@ABA@AAAABABC@CBABABCDCA@@@@@@ACAC@A@A@CB@B@@EHCBCBAAEBEB@@AACA@@AA@AACA@CA@@A@CAIAAAAAAAAA@AAA@AA@@A@@AAG@ECACC@AAAAAA@CAACC@@@ADAB@@CCACAAAC@ABA@A@A@ABAB@@CFAAA@@AAAAA@@AA@A@CBCBCAA@@CBA@AAA@@C@A@C@A@@@A@@A@AAC@AB@DA@BDADBD@BABAB@@CB@@A@C@A@@@AC@E@GEAE@GBEDKAM@ABA@@@ABA@ABCA@@@B@BA@ADBBB@A@BBAB@B@@@BBB@@BAB@@BB@@@@B@@@@@DCDCDAB@BDJL\\HPJTFRAPGRENKBI@CAKEIESMKAGACBGFAHAD@FANAH@HKTELADEHEJIPAJBPDNDLLLJLBLGFG@IAE@C@GDIFGJGHGJIDABICAACCCEC@AC@@@@BA@@A@@@@A@@B@@@B@BA@@@@@AA@@@ABA@@A@@@AA@AAAA@@@A@@@A@@C@A@@@A@@@@@AA@@A@@AA@@BA@A@@@ABA@@@AD@BABA@ABABABA@ED@@A@@AA@A@@@A@@@AB@@A@@@A@@@A@@@AA@@A@A@@@A@A@A@@@A@@B@@C@ABC@C@A@AAAA@@AAA@A@CB@@@BAB@BADA@A@ABAB@@@BADB@@B@B@BA@@B@@@D@BAB@@@B@D@@@@ABA@@@@BA@A@@B@@@@@B@B@@B@@B@@B@@@@BB@@@BB@@@B@@BB@@D@@@B@FE@@B@B@B@BDB@B@B@B@D@@@BBBB@B@B@B@D@B@B@D@@BBB@D@DBBD@@@BBBCB@BAF@B@D@BDB@@B@@BB@BBBB@BA@@@EBA@AB@B@D@BAB@B@@A@@BDD@@DBDB@BAACBA@@@A@A@A@@@@D@BAB@BA@CBA@ABAB@BBBBB@B@BABAD@BBBD@B@D@@@@BD@B@AD@BAB@@BDF@@@D@@D@BAB@DDHVRFFJ@@@DJBLFT@DF^D^@R@ZAN@@CZ@XBVAF@JHNLJLHNFNDJFFH@LDLFJFFFDJNDFDNBV@HDNBLJfBNBFBDHLHPFNCRAHCLIPQRIVE\\PXFEB@H@JALALDNJFJFLDFDHDLHPBLFL@H@DFRFFDDRLTJHDADBDBDB@B@D@BAB@BCB@NDHDBDBF@P@N@DBDDVHTBFDFRRNBH@D@LENAT@XDNFBBVDLELKFEJIDCNEPBNFLH@@NFD@BBTHRFAK@ABMBC@GBGDK@@JA\\EBAJIFMDEHO@APKD@D@BAFIBI@CAEAAAEKCMBGFEBGBCECA@@AIAW@ECIACMUMOKMCMBQ@ANOLKREFCFANANAJAL@NIHIBABABCNGL@RFNHDDJFH@NCDAHCPCBAPCHMBOLKB@JMAKBO@E@GGIE@CACDCFCBG@CAG@EDG@@A@@ACBA@@BABA@CBEBCBABA@A@C@C@ADABABC@EAEE@I@ODG@E@AE@AAC@E@EB@@@HIBA@ABABABCBA@A@@@A@@@@@A@A@@@@@@@ECIBK@@BE@GAIGIEE@KBEHK@EAEGEGGAIFKMDKGDUASMIU@[JA@@A@AA@@A@AAA@AAC@@BA@C@CCCEAEBC@OHSDI@IAEAEE@EGCGACAGDCLCAQCcCWKACAAIKA[AE@SFWAWCKAGAMF]N_DW@CCIIKGKAACO@UNUXUJMDEFOAMGQGQCSBQHMNIHEJKBKGGCCGGGM"],encodeOffsets:[[110239,25923]]}},{type:"Feature",id:"522727",properties:{name:"平塘县",cp:[107.322323,25.822349],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABA@CAA@A@AB@BAD@@AB@@A@ABA@ABA@CCA@CCAAA@C@AB@BABABAB@BDH@@ADABA@@BA@AB@@@B@B@@@B@BABA@C@A@@@A@@@AAAB@@@BA@ADABCBADAD@@ONIFMJOBSFQHEFEDCRBJBFBHFR@PGPEHQFQBKAKGGKIGGE@A@C@C@AAA@C@A@A@CA@AAICCAA@C@CBCAC@A@C@@ACCCA@@AAABA@A@CBAA@@AAA@AAKBOCGAWCQ@SFG@MDMAKE@@MGQEC@EDKDODU@QCCAGCA@CCKACAA@@@SEA@C@A@@@EBA@CDA@@D@DBHFHHNBDDHFL@LENIHGFCBCDABEB@@UL@@@@GDIFGFGBEBEDKDGDGDCBA@@@CBA@ABGDC@EBOAIEOCA@@@Q@UB@@ABAAEAICCAC@GAGECI@CBC@G@EAMACEEIIIIIMAAIIQCOB@BQHWHQFQ@MF@@SHUH@BUTQNCFGLEFEJCN@LBRAPGLMNQPOF@B@DFLFJFLFHDNLLHHLDXFRFNALGJERGRBNFHHDBB@D@FAB@D@BDBH@BEHEDGDEF@DBDBB@@F@D@B@@@B@B@@BB@B@B@B@@@B@BB@@B@B@@AB@@A@@@AB@@AB@@@BAB@B@B@B@B@@@B@B@@BB@@@@@B@@@B@@@B@@@@@B@@@BABA@@BABABAD@L@H@@@P@T@PHLJHFLD@BTLPNFN@TCRGVAN@@GLIAMGI@ADHLANE@C@ICCIEKEKGE@BAFBH@NKDA@I@AF@HHHFDLDFBDBFHDJANENAJDDDDLBNBND@HAHGFMDIBADA@A@A@@BA@@@AB@B@B@B@B@BBBB@@@BBBBB@@@BBBBB@DB@BBB@@@@@B@BBBFBFBF@P@LFPHLH@HAFCBEAcUKEK@EB@DNJFF@FBFBFADE@C@AF@DJFCL@DBDDB@CBE@AB@DBDDBBB@@@@@B@@BDB@@B@@@B@BB@@B@B@B@B@B@@A@BB@@@BA@@BA@@LBZF\\FPDLHFHDL@@@FBH@DBFBJDR@BJXLRLLPBHAL@NFJDFHLLNBPANAF@LADBJFBDBFCJELIFIDMLONEJCF@NDJHHNDNDLC@@@@B@HGNEPGVEX@ZHJF@@@@@@TOjFHEDC@GK]KqZCXA\\ENGFQHMDAFCDALCNCR@R@HEJIHK@GBE@IGIOCMCEGFS@@BCJQLIHERCRATBRJLNHNHPJNNLHH@LGHEJDHL@RGTKVKTILCFCBADCFEPAN@P@FEAQKUKQKWAQCOCS@MBGFGNID@PGJC@@JAREL@XDLDJBHADAHI@IBG@C@IOWF[JURQJODKBGDQEMGOGKACAEAMIeAKCM@GAUCMCEIMECEEEICK@KEGIEMCMEKGKIGM@IBEAU@WDY@@BM@Y@QC]E]@CESAKCI@@I@EEUQCG@CBA@A@CC@@@E@AC"],encodeOffsets:[[109904,26111]]}},{type:"Feature",id:"522728",properties:{name:"罗甸县",cp:[106.751589,25.426173],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AACACEAG@GEGAGEAEECEACACBEECM@GCEEGECE@@@AAA@A@@@A@AAA@@@A@A@E@A@@@A@@@A@@@A@@@@@A@A@@@A@A@@@A@@@A@ABA@@@A@A@A@A@A@@AA@@@@BAAA@@@@@A@@@A@@AA@@@AAAAAAA@EAC@A@@AA@@@A@@AA@@@@@@@CA@@AAA@A@@AA@@@@@A@@A@@@@A@EIEEACAC@AA@@@C@A@@B@B@BBDFJFHFH@@@B@@BB@B@BB@@B@@@D@@BB@@@@@@@D@@@BAB@B@B@@@BA@AB@@@@A@@B@@@BAB@@@B@@@B@@ABA@AB@@A@ABA@@@ABA@@@AB@@A@@B@@@BA@@B@BA@@@@B@@AB@B@@@B@@@B@@@B@@@B@@@@@B@BA@@@@B@@A@@B@@@@AB@@@@A@@@ABAB@B@BA@@@@@@B@BA@@B@@@B@@AB@@A@@BA@ABABAB@@@DAB@BC@C@ABA@@B@@AB@B@BAB@@AB@BAB@@ABABA@AB@BA@AB@BC@ABAB@@AB@B@B@D@BAB@BA@@BABA@A@ABAB@BABA@A@E@ABA@ABAAAA@A@C@AA@@AAAA@A@CB@@ADABABADA@@B@B@B@BAB@B@@E@AB@B@B@BB@DAFBD@D@DB@@BD@BBBB@@BB@@BB@BBBB@@BB@@BBB@PHTHBBVNRTBLIJGLAJ@F@HAJGTERAN@H@FALABEL@BIL@BADAB@@CDEJEH@@GLOLK@OAOBABA@A@ABA@A@ABA@A@AACB@@AB@B@@AB@D@BAB@B@BAB@B@DBB@B@B@B@@BB@BBJ@F@D@B@BAD@BEDDBFBHB@@BB@@@BBBB@@BBB@B@BABCB@BABAB@B@B@B@B@@AJAB@HADABADCBE@CBCDAB@@A@A@A@A@AAA@A@A@@BBB@@BB@B@B@@@@ABA@A@@@A@C@A@AAA@A@A@AAAAAAA@A@E@CBAB@@FBDBDBD@BB@@BB@BB@@B@B@BA@@@CBA@@@@B@@BB@@@BBB@@BB@BB@FABAB@BAB@B@B@B@@@BBBBB@@BB@BB@BBB@BBB@@@BBBBBBBB@@@DBBD@B@D@B@@@B@BA@@B@B@BBBADCDAD@BCBABAB@D@@ABAB@@ABC@C@AAA@AAA@AA@@A@A@@BAB@@AD@BBBBBBBBBDB@BBBBB@BABA@@BA@A@C@@AA@A@AB@B@BB@@BBB@@@BAD@BB@A@@DBDBBJ@FADB@BCDIDED@D@HDDDDB@BBB@F@D@DAF@B@D@FLLTP^@@BDDXEVARHPLLHH@BLLVTPPFR@BB@DNDJPVDR@B@FBJJDDBNFPF@@B@LJ@B@BBB@BDDBBDBD@BF@FHJF@DBDBHNBBLZBNBFDRBJDF@B@@FHHDPCPKPONQRULI@@NIFAFAL@HLDNCFAFABELKNGJAH@F@FJHLDJB@@H@HG@AJOHOFMHMHINGPEHGFIFEFAFAD@@APERONMHKBOAQ@KDMFIFEHKDERMVS@AVGTG@@NER@REXGRG@APARDJJBBJNJJJJFFBDBN@F@HAD@DDJHFHBD@DBJDFBBBBA@@VAR@@@B@PDJFPBFAD@HCBAB@DA@@B@DAHCHCLCFCFAHAHEJEHC@@@@VK@@FABADCDAHEJGFM@KEKCGACGMEGAG@C@CB@DCB@FA@@B@D@B@TF@@B@DBLBDDB@HDDBRDV@PCLCFCD@@@@ABA@A@CBA@CA@@ABC@AAAAA@AAA@A@CDEDC@A@@@ACAA@@ABA@@B@B@D@FA@@@A@@AABA@@BAB@@C@E@CCE@AACCAEEEAAAC@CAAAEAAAC@A@A@A@A@C@EAA@ABC@CAA@A@AAA@E@CA@@AAAAA@E@CAA@A@@@AA@@A@CAAAAA@@@AB@@ABA@A@A@A@AAA@AAAAA@@@ACACAA@@A@@@AAA@C@C@@@A@@AC@CAA@C@A@@@AAAAEAACA@C@A@AAC@A@@@A@ABAAAAEAAA@@@A@A@@BC@CBABA@@B@@C@ABA@C@@@A@@AA@AAACA@A@CACBCA@@AAA@AA@AC@AA@AAA@A@AB@@A@@@A@@BABABA@A@@BA@ABA@C@A@C@A@CAA@A@@@A@C@AA@@A@ABA@ABAB@FAB@B@BABA@C@A@A@C@A@A@CBC@@@A@ABA@ABA@A@A@A@A@C@AAA@C@A@A@CB@@CDA@@BC@ABA@ABABA@C@ABCBAB@@AB@D@@AB@D@@@D@D@D@B@D@B@@@B@DAD@DA@ABA@CBABE@@@C@CACAC@AAA@C@AAE@ABC@C@C@CACCG@A@AAE@C@C@AB@BE@E@ABABAB@B@@A@A@A@@CAAA@A@C@ABCBCBC@A@GBC@CA@@@@@AA@AA@AAAACAA@A@A@A@CA@@AAAAC@CCA@CAAAAAAAAA@AAA@A@C@AB@@ABABABABADC@@DABCBA@@@@@A@@@A@@AAAAA@@@A@@@C@A@A@A@AAC@CAA@AAA@CAAACAAA@@A@@BABAB@@CDQJCBIFEBG@EBGBIBG@C@GAE@EA@AEAECA@CCCCACCCAAACCC@CACAC@CAG@G@CACBC@CAA@@@CBA@E@C@C@AAC@CACCCACAAA@AACAAACAC@@@C@I@ODEDE@EBEAA@GBC@AAEAGEGAC@ECG@A@A@@@@@A@@@AB@AA@A@@@AAA@AACA@@C@AAA@KAOEKAK@A@G@KHGBADEFCBABCBAAABC@ICECCACEGEAAC@G@GEAAKICAC@A@A@A@E@KHEFEHIHIDE@C@IBGAC@A@EAMGEAGCKAEAI@KCECEEEGAGDIBM@GAEAEACEACCCA@@"],encodeOffsets:[[109191,25730]]}},{type:"Feature",id:"522729",properties:{name:"长顺县",cp:[106.441805,26.025626],childNum:1},geometry:{type:"Polygon",coordinates:["@@EBAPELDV@H@F@@ABKN@@KBMBMEOK@@UOYSC@I@GFEBEPDJLHTHPLBDDLBRAXANARCLGRAP@@C@E@@@@B@D@B@BBDBB@@BD@BAD@D@D@B@@A@BB@DADCDAH@LBFBDHBJFDDB@JFBHDDHBDD@DCBAD@@@BBBD@D@BBBFBb@FDDF@F@BHBJBJNTLL@B@@@@@D@BCDKDQDGBCBADBP@DBDDBLAF@DB@BAFGP@JBHDHDFHBD@H@J@FBDHBD@@DJDP@D@BABE@G@E@EBADADBBAB@@@B@@B@@B@@BB@@@@@@BB@@B@@BB@@@@B@BA@A@A@ABA@@@AAA@A@@AA@A@C@A@A@@B@@CBCDAB@B@D@DA@C@C@ADADAFA@ABC@A@ABEBG@EACC@C@CA@A@AFABEB@@ED@DHHBD@BC@A@AAAAGAEA@C@C@AAAEBA@EABH@BA@@FA@CBC@@@@B@@A@C@@BA@@@AB@DBBBD@@@BD@D@B@BBBD@B@@DJB@@@B@@BBDDTBL@B@FDTDZHPF@HBFEFK@A@@PEJHLJLHFJ@@CPKN@@@@@BA@@B@@@BADABEB@@@@@@A@@@G@OAGBCBCD@DBDDBDBHBFBBDBBBBB@@@@AB@@AB@@@BBB@@B@@BB@B@B@@@DBB@B@BBBB@@D@B@BB@@B@D@B@DBB@@@BBB@B@@@@@B@BB@@B@BB@@D@@@B@@@BAB@B@B@BBB@B@D@B@B@@@D@@B@AFBF@BDHDBBBDBD@F@FBBBCFCBABKFED@@ABABAB@@CJEDA@@@GFSBC@UC@@KDC@DLPH@PHBVARAFDBHHDNDNFBABA@@@AJOBK@@HGHMJGD@RAPBNFDFFFJNBB@@@@HNBJ@@BH@VA\\DFXBPBNCFANGTCDAPCB@@@PA\\C@A@@fCL@B@@AB@BA@@B@@@D@B@B@B@@@B@@@D@@@B@B@@@BAB@B@D@B@@@BAB@BA@ABAD@FBDADAD@B@DABG@A@AAA@AAA@@@AACBADBD@@@BABBB@BBBB@@@BB@@B@@B@B@BAB@@A@A@A@@BA@A@A@A@CBAA@ACCAAAAA@A@A@A@AC@@@AAA@AA@AA@C@A@AA@CBAF@B@BBD@B@BA@@BAB@@A@@BA@@B@B@BB@@@B@B@BBBBBBBFBFDDBD@D@B@B@B@@A@A@@@AAA@ABAB@BBBB@BB@BBB@B@BBB@FA@@FB@@B@DBB@B@B@BBB@D@BBB@D@B@D@B@@@D@@@@ABA@C@C@A@@@AB@BBDBB@D@DAB@DABCBADB@@B@@@B@B@BB@AB@@A@@BABA@@@@BB@@BD@BBBBD@B@BD@BBB@D@BB@@B@BBBB@@D@@@B@DAB@B@BA@EBABADA@@@@@AAC@@AAAAA@C@CAAAAAB@BAB@D@B@D@D@B@BA@@DAD@@@@AC@AAAA@ABC@AD@D@DBBBDB@CAMBA@A@C@C@ADAB@D@D@BAD@B@B@@@B@BCGCA@CC@A@@BADAB@@@B@BA@@@A@A@@AAA@MCMCSGA@KCQEQCE@WEU@QBOAMCKGIK@A@MJQHMDE@@HGJIB@LGJGHQ@KB@CMGM@@MEGCIAQBKBMAEEAE@@AE@@@CBA@@JORQFE@@DEFIBOAQ@KFK@@DGBAFK@O@MDG@AFGPILGBI@@@A@@@@@@@ECGGGOCI@I@iFQBM@AB@@EBYJCBMDSAA@@@M@KAA@G@@AA@AACAECGAGKAAEQ@C@S@IBE@@CIAKEGCIAODIDGBC@@@@@@@@@@@A@@@@BEBEBM@C@E@I@CBOFUBM@EDOBM@GBGAGGI@AKGKECA@@CAGGMKMMGGCCIIGMDMHGN@B@@@@@ND^F@@LCLAREF@J@NEDA@@D@PMLQJOFKBIDM@@BIBAHIbA@AD@F@@@@C@@A@@A@@@A@@@ADEAACC@AAA@A@AKIA@@@OEMECAICAISFGF]\\[PSHKAGCACAAYcUGY@@@qNIF]PUDEBIBMJKJI@"],encodeOffsets:[[108861,26299]]}},{type:"Feature",id:"522730",properties:{name:"龙里县",cp:[106.979524,26.453154],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCIKKIKEA@EDCHALEHIHIDC@I@MBGJ@J@@BF@B@BHFJBJBJFDLAJ@@GHABMBODABMFAHAB@@@@@FDJ@BDJFFBDFFBDDD@@FBBBFBBBBBD@DBD@ABADABAD@B@@@BDF@@AB@@@BCBCBC@E@ABA@@F@@@B@B@BAB@@A@A@C@A@@BBBBDDBFDDBBB@B@@@D@B@B@B@B@BAAA@@B@D@BAAAA@@A@@BA@@BABGAK@A@C@C@CBC@AAAA@AAAAAACCCCAAA@@UFA@SD[DU@IBKBGHBFBDB@DDLDFDLFPLJBHFB@HHJHBJAHCDAFBLFHHJFFDHAB@BCBCBIBI@OAMCKEIGIGIAOBIFA@GHGDCDEHBNDFHHJNNRBB@BJNJFNBD@NAP@JBBF@BCDGL@D@FFDJBH@HDDHAFEHGBIFIFEFEFCJBHDH@DJFNJJHLFD@JDDBDF@@@@BFBF@@CBA@@B@@@B@@@B@@@@@BB@@@@@B@@B@@B@@@@B@B@@A@CBABABBBBBD@BBBB@BB@B@BB@BABAB@@B@DBDB@@@@BB@@@@@BAAK@@@E@C@C@@B@@BBB@@BB@@@@B@@@@A@@@@@@@@BA@@@@@AB@B@@@BAB@@@B@@@@ABAFAD@@@B@@BDDDBDBDB@D@@@B@BAB@@@BDBBABC@C@@@BBFDPHH@P@@BP@PBFFDBFFFDDBF@FBFBD@FB@@B@BBDBDD@@BDDDBB@F@B@FAFADEHIFC@CB@@@@EBE@A@K@OAA@@DA@@@@@A@@@@@@B@B@@@@AB@@AA@@AB@@@B@@A@A@@@A@AB@B@@@@A@A@@A@@ABBB@@B@@@@B@B@@@@B@BA@@@B@@@@DB@@@@@@B@@AB@@BF@DB@@@@@@@D@@@BB@BBB@BA@@B@BBB@@@B@B@@@B@B@@AB@@@B@@BBD@B@@@B@BBBBB@@B@BBD@DBBBB@B@@@D@B@F@B@@@@A@@BA@@@@@AB@BA@A@AB@BA@@B@B@DBBBBBB@@@BB@@@@BBB@BBDBBBB@BBD@D@BB@B@@@@D@@@F@B@B@@@BBB@@AB@D@@B@BB@@@@@B@@@BADABAB@BABBD@BBAFAB@BABC@E@C@C@JBFBB@BFDB@BB@C@C@CBACBAA@A@ABECA@CA@B@B@B@D@@BDBDADAD@JBH@DDJJFLBB@B@HBHBFF@F@LAFAH@@A@@A@A@@AA@BAB@@AACFAHAD@FAFBBDABADCBAB@BB@BADBBB@D@D@@@@DBBB@BA@B@BB@@@ADCDABA@AAEAA@A@ABC@CB@@@BBJ@BBB@BDDDBBBBD@@HF@@FFBBBBD@@@D@D@@@FCDA@AB@BBFDFDB@BB@BB@DB@@B@B@@AB@B@DA@@B@BAB@BB@@BD@@BB@@B@@BFHHFDDB@DBH@B@F@D@B@B@BBBD@B@@B@B@@AB@@@B@B@@@BBB@@B@B@FB@@BB@@@DBB@BBJJDDRZDF^@bN@@HHXBRJD@XAz@XK@@JCPIXLB@N@HAJC@@DADABAB@D@BAH@B@B@DAB@DCBE@ABA@ADABAB@D@FC@A@CAEBKAE@ABC@A@A@AAA@@C@@@AEAC@A@A@A@A@A@A@@@C@A@CC@GDMBC@GB@BQAS@MCGCGCIIAAEG@ACOAGKAS@C@@@I@OICCAAAAAIFKDCJILMDK@IGCA@M@MLGFGFKCIEAICGBQDQBK@ABCFQ@GBIAMGKA@MEWQKGMKKKAAKKEI@EAGBG@@BGFGBEBE@AACGGIEKEIEAAAA@@@@AA@ADILEB@JEJIFIHKFALANHRJRL@A@A@CB@@@D@F@F@DAHBFBFAFABADBAH@DDDDABAFADABOAI@CCECAA@CCAAACAA@A@A@@@A@AQCKIAA@@GIIKIGKGA@EBI@A@I@EAECGEIEEECG@G@@@G@@FCJ@FA@@@@BA@@@@@@BA@@BA@@@AB@@AB@@@@A@@B@@A@A@@@@AA@@@A@A@C@AAA@ACAC@CAE@G@E@EDA@KFGBE@CAAC@@BCB@BADAB@BADAB@HAD@@AB@FABAHAD@DAD@@@@@@AAICICGECEC@C@C@C@EAAC@IAAABA@@DC@A@@@@AAC@A@C@A@AAA@A@CAC@@AAACC@AAC@EFEFEBIAGAE@E@GCEGEKAG@G@A@EBI@EBIJ@@A@@B@@AB@@A@@@@@A@@@A@@A@@A@@@@@AA@@A@@A@@A@@@A@@@A@@@A@A@AB@@A@@@@B@@CAKCEEA@CKDILG@@NIHEHGHGJILKBA@@BAHIDEDAHEL@NHPDJMAGCKIM@AIKMGMGGKAMBE@@BG@K@GCGAA@AKEKGICAAIEKIEEECCCCC@@GGIESCMBIDIHMDA@@@@@A@SBOAMEIGIEGE"],encodeOffsets:[[109489,26813]]}},{type:"Feature",id:"522731",properties:{name:"惠水县",cp:[106.656442,26.13278],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@A@C@ABC@C@A@CB@B@D@D@BABBN@DCAAACAC@C@@BAD@BBBBBD@@B@@C@CB@@ABA@C@C@A@C@A@ABA@BBBBDBD@B@BBBB@@BD@B@@BBABAB@BAB@BB@BBDBBBBDB@@BDADAB@D@B@BBBDBBBD@BBB@@BBABADBBAB@BABBBAB@B@D@DAB@DAB@B@D@B@BAD@@@B@B@@@@B@B@BAB@BBB@B@BF@BB@B@B@BADAB@B@B@B@D@BA@@@AAA@AFB@@HDDDBBDBFFJHNHLHD@JDRC@@NCB@NGJGLEB@HDLJRH@BLAHM@OBMBAJGF@J@B@B@B@D@FBD@DBBBB@BD@B@BD@H@D@@@D@FBD@@A@@@AAAAA@CACCA@@CA@A@A@@@AB@@@@@BADAB@B@D@AA@E@C@@DCBICOAGFM@@LILIBA@@NMHQFGDGRKXBVFJJ@@HHHINAJ@D@JCJGFGBKDGFCB@LFLJJLDDHFJFJHNFPBTAB@@@@@B@NCJGJCNATDJFHH@@DDJYBCNOHIFGPSJKRQFCJGBALGNKLENGJELKBEFKBA@A@@@@IEYGW@UFOHMFGHA@@@@@KDMCMCGGCI@MDEFIPMNKJCJEFKDIAEACIECAKBE@MBOBMAKKEGICMEK@GBOAKKKQIW@ACQAIAE@CAG@E@@CKEGKGOC[EYEKA@@AB@@AB@@A@@A@BA@A@A@A@A@@@AAA@@@A@@@CA@AA@@@@@A@AACCCAA@@BAF@DCAAC@CDKIE@CBED@F@BCAEAE@EEEMI@CFAL@LFdVFBDABE@GKGOGKEO@E@EAEAAA@A@A@@@@AA@ACAA@AAAA@@A@AAAA@@A@AA@A@A@A@A@ABA@@B@@AB@B@B@BCJANCHEBG@GMCMAKACCCCBIFMBMCIEGCAEAKCECGG@GBEJ@B@LC@MAGBE@AHFFLFLDJJDD@F@BMGKBCJ@NHJBHK@@BMHUDQ@SEMOMSK@AKCGEKIOGS@O@@@G@K@C@ABABAB@@ABAB@@A@@@@@A@@@A@@@A@@@@@A@@AA@A@@@A@A@A@A@A@AB@@A@@BA@@B@@@BA@@BA@A@@@AAA@@@A@A@A@A@@AA@A@@@A@C@E@@@AAAC@CFEHCFCFG@AAGACC@A@EBC@A@CAGGMEQAQHIFKHMBQEWEKCGGKKCMEGEKEIEK@CC@EBEBEFEJGHOFMHGJGNENGPIP@BGHG@@@IAKCIG@E@EBGHILMFKBABEDECMGKK@EBEBMJ@@KJQVMROPOLODGCEG@@@ACEAICQAEAMKYAAGMCACAE@GI@EAEC@CACF@B@@@B@@@BB@@@@D@@E@C@@BaBGJABAJ@@CNAJELIPKRONC@@@CBMFI@E@QFKBKD@@]EMC@@@@A@M@GHCNHNJJDDHHNNNLHHDB@@DBLFLH@BHJBHAH@HANCP@FANEVAP@D@J@F@DANAFAF@@@@@B@@@@@@@@@@ADCHCJBPDJFHBLDJ@@AF@J@T@DFRBBHLHBFDDBBBB@@BH@B@LBN@@@B@TBNCDAZIFA@@BAN@RAjEJ@J@PDHHDH@F@@@@@@@B@@AJKHOJEH@BCH@N@PELABCH@@EL@LBRAPEJCF@@EFQRIP@@AB@D@@BF@@BFFFNBLARAJBHDNF@@HNDNA@@LGRIHKHA@IJGH@@CFGNIR@N@BJLLHNDPBRAV@XFF@RDRFLDB@THNDNDB@BB@@@B@B@@ABA@@@A@CBAB@@@BDDB@HDADA@"],encodeOffsets:[[109200,26822]]}},{type:"Feature",id:"522732",properties:{name:"三都水族自治县",cp:[107.869749,25.983202],childNum:1},geometry:{type:"Polygon",coordinates:["@@GFIAGGGUIKKCOEOAOFODKNENA@CHCHEFCDIFABODMFKHMNGPATBXBH@DBLCHAFIJKHWFELBJHHHFBF@FGLAF@LFFHJBJ@HAF@@ALDJ@F@@@@@@@B@B@@@@@B@@@BABADABAB@BABGJ@@A@@F@FBD@BBFF@H@PCJ@F@BF@FADABCB@B@D@D@BABABADAF@DABAB@@ABBD@@@BH@FCH@DBH@DADEDCDBF@HJ@H@FAPBLINA@KLAPGNODABODGDCBMDG@IECCMGQEK@MHADABABGJMJK@IBMBMBEBEDQFKLMP@BARDNLNNPNVBDDJ@FBXBJ@@DBDFHAFAHENALDBFBBBF@DAJEJABC@C@OL@BGPCFENIJAB[FIB@@CLAH@HADAN@BBLLJDBFFJNFFBB@BABDD@FBFDBDBBBDDDBH@J@HADABALH@@NJDBBB@@@@BB@@@@TLFFJH@@@BJHDPAX@H@H@HBN@@BZCNEBCBGFKDIBCBMHGJABB@@@@B@@B@@@@BB@@@BB@@@BB@@@@B@@@B@@@@EBCB@DBBBD@BABEBAB@B@@@@@@@B@@@B@B@@BB@@@@@B@B@@@B@@@B@@@BB@@@@@@@BAFBJDB@@D@FAD@BBB@@B@@@BABA@@BAD@D@B@@CBC@C@@@C@AB@BBDBBBB@BB@CAABA@CBA@AAAAAAC@@FGHEDABA@@LEJALG@@@@FODUDEBCJKBA@@@A@@HGFCFEJENED@FAFALBDBDDDHBB@BDL@R@@@NBH@@@BDLFB@@FDF@@@H@NMJI@@BABCHGFA@@LGRDFD@@@@FBJDHEHGBG@@B@@@@@@A@ACA@CBADCBCBABB@@@@B@@@BADCBEBC@@DA@A@C@@DCB@FAD@HC@@@@DAD@B@@@@AAACAA@ACBCDCD@D@JFB@@AB@@A@@@@@A@CAC@E@@@C@CBA@C@@DEB@B@@D@B@BBDDBBDABCD@DBBBBF@@@BABAFAB@@A@@BAB@B@BBBD@B@@BF@DBDD@D@FAHDF@DAFAF@@@JADDDDPNN@@@D@NEPKLEHA@@B@H@JA@@FAD@@@B@B@B@@@@@B@B@@@@@B@@@@@BB@@B@@@B@@@@BB@B@@@@@B@@@BB@@B@@@@@B@@@B@@@@@B@@BD@B@@@B@@A@@@@B@@@B@@@@@B@@@@@B@@A@BBA@@B@@@B@@@B@B@@@B@@@@AB@B@@@@@B@@@@@B@@@@@B@@@@@B@@@B@@@B@BA@@@@B@@@@@B@@A@@B@@@B@@@@@B@@@HCD@BBDDBDBD@BB@@B@@BBBB@@@@@BB@@@@@BB@@@@BB@@@@B@@@@@B@@@@@@BBA@@B@@@BA@@BA@@@@@@BA@@@A@@BA@@@@B@@AB@@BB@@@@@B@@@B@@@@@B@@BB@@@@@@BB@@B@@@@@B@@@@@B@@@@AB@BAB@B@@@B@B@@@B@@@@BB@@B@@@B@@@@@@@@@BB@@BA@@@@B@@@B@@@B@B@@@D@B@@@@@BB@@B@DB@@@@@@BA@@@@@@@A@@@A@@@A@@@@@A@@B@@A@@B@@@B@@A@@B@@A@@@ABA@@@A@AB@@@@@BA@@@@B@B@@A@@@@B@@@BA@@DA@@@@HAB@@@BA@EBCAA@ACAA@AAA@E@A@@@A@@A@@@@@A@@A@@A@A@@@CB@@@@AB@@A@@@@@A@@BA@@@A@@@A@A@@BC@C@C@A@@@ABA@A@A@A@E@A@@@AB@@ABAB@B@BA@@B@@@BA@@@@B@@@@@@A@@B@@@B@@@@AB@@@B@@@B@B@@@@@B@@@B@@@B@@@B@@@BBHDHFPNHFJLDJFJBD@@BBFDLAJ@DG@GGIGK@IDEBCF@HBLDFCBICKSIKOCCACAAAA@@AA@@AA@@BAB@D@DABABA@CAA@@A@A@ABAA@AC@@AAABABAB@BA@A@A@ACAAAAAB@@@@C@E@@@@AG@C@ABABC@ADEBAAAA@EAA@E@CAAAAEAG@@GACACAAA@A@A@AAAA@CBCDC@C@A@OGE@MDE@A@AAAA@E@IDE@E@AACKOEC@AKK@@IE@@C@@AA@AC@@C@CBC@@C@A@CB@BC@EDKFADAAA@CAABABAB@BBB@@@@AAA@A@ABADA@A@ACAGCCA@@CC@@@@ECEGAKCA@@EEOKCCEECE@@CAAAAAAAA@@C@A@A@AAA@@@A@@D@D@FADADCFEDCC@AA@@ICAACAIEACACICGCIGCA@A@A@AACAECKEKCOBIHMJGLANCBCDI@IIIGGCEBGFMDM@M@@A@EB@A@AAC@ABC@C@C@AAC@ABCBABCBABAACAECGAA@C@A@ABC@AD@F@D@D@DBB@BBBFFFBB@@F@NDHBFFFFHBHBB@DCF@DBFBDDDD@A@ABCB@BBDBB@BAACAC@A@C@CBA@IHOBC@AEKBCBA@CAEACAA@ABCBABAF@B@@@D@DCBED@DBDCBA@ADC@ACEDE@CCC@GCCACAA@AEAACEEGEIEC@EAEECE@@KCKAEAAC@AIGC@KAOHQAEUAKGKSISCIAYCQIIE@AAAAAE@@A@CAC@A@@@C@C@ECA@@DOBOAIEEEAQ@ED@BABADABADAB@DAD@BADCHADCD@B@D@BC@A@@@C@AAACAACAC@UQKCcHK@K@IGCMOAMFGNBZEPIJCB"],encodeOffsets:[[110515,26169]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
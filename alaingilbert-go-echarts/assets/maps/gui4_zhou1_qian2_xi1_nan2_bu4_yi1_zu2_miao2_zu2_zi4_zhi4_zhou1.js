@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("黔西南布依族苗族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"522301",properties:{name:"兴义市",cp:[104.895467,25.09204],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAQEOCEAK@KBGCGGKAOCK@YEEAGCMGKKCIAOCMEIKEQAMB@@[JMJEF@FBFHH@HKH]H@@aF[B@@YBMBIDABABCFADEFKRSTIL@@AFAHAHAFCBEBEB[DGBEFCFGHGFEBI@M@C@GBA@CBI@AD@D@D@DDFDDBDBFDBFDBDDD@D@D@BCD@F@DBBBDBBBBBD@D@DCBABCB@B@BBD@D@DABABCD@B@B@@@D@DBDBDDD@BAB@BAB@B@B@B@D@@@BBBDBBDB@BB@D@BBDBBDBB@DBB@@D@@BBBBDBFFBBBD@DB@DBB@DBDBBDBDBBBDBBBB@B@@AD@B@BBBB@BBB@BBBDBDBB@DBBBDBDDDBDBBFHDFDDFBBBBBHBDD@@BDDBBBB@B@B@B@H@B@DBB@BBB@DD@@BBDBBBB@BBB@BBBBBB@D@@BD@B@B@D@@BD@DBDBDDD@BBB@@BBB@@BDBDFBDBDBB@B@B@D@@ABBB@BB@B@BBBB@@DDFDBBB@BD@@BD@F@B@BAF@D@B@BB@@BBBD@B@DBFDBDB@JBDBJDBB@@@BABADABADBD@BBBB@BBBBDBBADBB@BBDDBDD@B@B@@@@AB@@@B@D@B@BBDBFB@B@B@BAB@FABABABCFABAF@FAD@D@BBBAB@B@DABABABCF@B@DBB@B@BABABABCDABEFCBCDABADADABE@CBA@CBABA@A@ABC@A@C@A@EA@@C@CBA@A@A@E@C@@@CAC@AACA@@A@CAC@A@C@A@A@C@C@CB@@@@ABA@@@ABA@A@A@A@A@A@@@A@CAAB@@@BFDLBD@h@rJNHFHB@BBFB@@AD@D@H@DBB@DBB@DAD@B@BBBB@BBBBBBBBBDBB@B@D@B@B@D@BBD@BBBBD@B@@@B@@@@@B@@AB@@@B@@@@@BB@@@BBB@BD@BB@BBBBB@BB@@DDDBBBDBB@@@BDBB@BB@DB@@BB@@@B@D@D@B@DBB@D@B@BBBDB@BBB@BDBBB@@@DBB@@@BB@BBBBB@BBBBBD@BBF@B@@@D@B@D@D@BABABADABCDABA@CBA@BFABBBB@DDBDBDBBBBBBB@B@D@BABBDBDDBD@BBBBBDBB@DBB@DAFCBADCJCBADCBABA@CDABCBCBCBC@@BA@CBA@CBAB@B@DABADABADCBCBADCBABCDA@ADCB@BCBADADABA@@B@D@D@B@BAB@@AB@@ABA@CBBBBBBBB@BA@ABAB@D@B@@BBDBDBDB@B@BABABABCDCBCDCDCFAD@D@D@DDDDDBBDD@@ABABADADADAFAFAF@HAF@D@DBDBF@@BBB@DBFBD@FBF@FBBB@@@B@@ADKZ@FABCJABA@ABE@E@E@KMGEG@GFQ\\EDIBEDABCBC@A@C@EAA@CBAA@ABABAAAACBADA@CC@C@IAABG@EBC@@@C@ABCDGHGBGDGDKDCBA@@@@B@HBB@D@B@@ABA@@@AB@@BB@BAD@@@@@BAA@@@@@@@A@@@@@@A@A@@BA@@@A@@AA@@AAA@A@@A@@@AB@@AB@@@@AB@@A@A@@@@@IBCAICC@I@A@G@A@AA@C@A@ECCE@@@E@CDABCDA@C@CDEBGBEBA@ABAB@@@DBBB@DBDBD@FBBBDB@B@DADAB@BCDABCD@@BJ@LAH@FDJ@DAHAFCHAB@B@DBBDBBBBBB@BBDABADDBJDHDLDBFDLGNKNIJODMEO@MJID@@@JBLFJDRDJBdDVB@@@@F@ZAJA@@VAR@B@B@HBB@B@XFTBZNJRJNFDFBB@HCLIBAFMDAHAHDDHB@@B@BB@BBD@D@DBDBBD@A@@BAB@B@D@@A@A@AACAA@CAC@@@A@@B@@BB@@@BA@A@@@AA@AB@AAAAA@A@A@A@A@A@@BCDCB@@ABA@A@C@@BC@A@@AA@@AAE@CAEAA@@A@A@ABAB@B@FAB@B@@AB@D@B@B@D@BAD@BAFC@@BA@AAA@A@A@@BABAAAAC@@@ABAAA@@AC@@BEBABCBCBABCBA@C@C@AAACC@AAAAA@A@A@A@AAA@@@AAA@ABAB@BAB@NFJ@XCTHPDZDV@HAR@R@D@LBLFJDDDDB@F@JGLGLAJJFLDPBVGFAH@B@HIBQAK@@AE@IBEPANLFBHBPAL@@AXCJ@HBFB`FT@D@PDLCIMEEEOAGAIBG@ABADG@AFCDCBECGCECCAECE@G@I@E@GBIDGFEFCNENAF@JDH@H@FCBEDAFCBA@AAA@@A@@@@AA@@AA@@@@AA@@@@@B@@A@@@@@A@A@AA@@A@@A@@@A@@A@A@@AA@A@@AC@@BC@AA@@@A@@A@@BABB@@BA@@DAHABA@AEAIAM@EAAC@C@GCEMIKOGEEA@@A@AAA@A@AAAA@A@@A@@AAAA@AA@A@AAA@A@AA@AA@@CAAAA@AAAAAAAA@AACACAAAAAAAAAAACAG@GAGCKCI@GCOAG@EAI@OEMAIAGCECGEAECIEIAGAK@EAECKGIEKEOGIGIEGCCECAEBGDGHC@ICCGAI@EA@G@@@AA@A@A@C@CBC@AB@@AFA@ABA@ABA@A@@BABABABAF@B@@A@AAG@E@@FEFALAH@DBFFBBD@DCBCDE@EACAAGCACBI@@@E@CB@@A@@@@JADCBA@ABCBABABACG@CDALDDBBBD@FAHE@@D@DD@@HDD@@OACAGCOOM@@MGQIQMAEAGDIBADC@G@EAKCMAGAEKKEIAI@CDEDIFUBGAGKAUAcOaOkIK@@@]EEA_GYCUEKECACCGESGQQGS@WCOEGGKMSACCCAA"],encodeOffsets:[[107360,25270]]}},{type:"Feature",id:"522322",properties:{name:"兴仁县",cp:[105.186237,25.435183],childNum:1},geometry:{type:"Polygon",coordinates:["@@Q@Q@GBU@YCOCSGWDI@MEA@ABA@AB@BBB@B@@BB@B@B@B@BBBBB@BDDBB@B@D@DABADABLH@JFPJB@@D@HKHGNALBJH@NBX@F@F@BBB@@AJ@@CHMDSDIFCJBH@BDDLJFFFBPHJBJDB@PDPFJDDBFDB@@@DBNN@BFJFLBRDPFLHN@BHNLJNRRNPLRPBBJJDDDFHPHNLLBDHDLHNDPDPDRLBBDHDP@T@B@TAVCLAFKDIEIIKKIEEBAFBFBB@BDLFHDFBRFFDD@@B@@BDBBDLJTJRJ@@@@BB\\J\\PJBJBHCPMTUPUB@HELCJ@HFLNBBHHNJFCBKFSFUHgBQLIFED@DA@@@@XCPHRPJPDBHNPVVRTLHHBRBH@JIRMR@LAvAHG`BFHX@@NVHHJL@@\\^XbXL\\HLDLFJN\\LTAXSBCDIJGDEHGDAJCDAPE^KDEFCJSRQXQLUTSFACaAAFOFK@MCK@I@M@C@GJKLEHAFAFCDAHGBE@@AKKIEIGQEKGGGGAAIGECMIIMCOAO@AAIAOCKEIMQEIACCGGIMGKGAAOGOCMAICCAIO@IBCDGFIBCFMBMAMCGCCGECAEAKGEEEE@G@C@IDG@CBA@@FCEGAAGEIKEEIMCIAEAG@@@@EMCIECIB@@A@@@@@I@K@IBE@I@OCEG@E@A@CDW@KAEIAGBGFCJALIPGLIJMFUFCBUBGB]@E@IKAGIUGEG@GBGBK@A@KAMCCA@@GEKKCAIGA@E@IDKDKDMCEEGKAA@@EGEIIGSAO@K@KDOCC@S@_EEAGAI@WD@BK@OBGAEAMKOBAF@JBF@@BLARGJA@G@EBUHOAKCIEBIHKHK@I@ECACCICKEKAC@"],encodeOffsets:[[107515,25885]]}},{type:"Feature",id:"522323",properties:{name:"普安县",cp:[104.953062,25.784135],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AGMEKCOAQEKEI@AMMCA@@A@ECCAICOEOCA@ICIAOGEAEEKICC@AAGDIJETCNCDG@@BI@@AA@A@E@EAW@MIGKAMBGHGLC@@@IAEO@IKGADADABAF@@BD@@BBAB@B@@BDBBABAB@@@B@BBB@BAB@@EDABC@ABC@A@A@C@A@@BA@A@EBA@A@AB@B@B@BB@FBDBF@BB@@BB@@@BAD@@@D@BAB@BA@CDAD@@@B@B@B@B@BBBBB@BBAB@@B@@@BAB@@A@@AA@@@@B@@BD@DBBBD@B@B@BC@A@A@AB@@@B@B@B@BBD@BA@ABABCBA@@BA@ABA@C@A@A@AB@@A@C@A@E@@BADABCDA@A@A@A@EBA@@BA@AFAD@B@BAF@@@FADAHAB@@@J@@@DBF@D@FHXDHBBHF@B@@EFBB@BT\\FHLL@B\\ZB@BDNV@@BF@HBBVbAH@LAV@HBDDNWN]@EFIJALTJ@HEF@F@D@B@@@DIBELCFIJCFABGHCDAL@BBBBFBB@@LNHDDBBBTLRNJRFVDN@@DNDL@DHDAJCH@DGBAHADIJMPADIPKPABCDFNEL@FADCBAHCBADHDBB@DAFC@AD@D@TB`F@B@BB@NBBFJFFFX@FDD@@VRTANHFDFHTPFB@@B@B@B@D@LBDZ@fGNADDDDFDFBLCDEFQHA@EJENBDHJBBBRBNDVTtHLDD@BFr@@@B@B@BADBB@B@DBBBBDHFHBDD@B@BBB@BDBB@DBB@BBDBB@BB@FBRDB@JHDDHFNPJL@B@@CNINCJADEPJV@D^`LFPJh`RE@@DA@@BC@ABABCDABAB@B@B@D@D@B@D@FAHAB@D@B@D@@@DBFBDB@@BBD@BB@@BBB@D@B@B@D@BAD@B@D@B@@@B@D@D@@@B@D@@@BADCBA@AB@BAD@BA@ABA@ADADC@@@@CAABABCBC@A@A@CB@H@BABE@C@C@AACAAE@CCEBO@A@@AA@@@AA@@@@A@@@@@AAA@@AA@A@@@@@A@@AC@@@@@AA@@@@A@A@A@AA@@@@A@@@A@@@AAA@@@A@@@A@@@A@@@A@@@@@@@A@A@@@A@@@A@ABA@A@@@@@A@@GMQUCE@@GGOKSKACGCACOMEG@@@@ACEKEIAAGEAAIKGICCKMGKCKAA@IAI@@@@DYDcD@FBHDJDF@@@DABC@EEKAC@IDAFBFBFB@@DAB@BBB@B@FBD@JDJ@D@BCBA@@H@@@@@@ABAD@B@DBD@B@B@B@@A@AAA@@CEAAA@@A@@BABABB@@BBDD@BFBD@B@HDJ@BBDBB@B@BABAB@@@BA@A@@BA@A@A@@@A@A@ABGB@@@HAFGDEFK@@@@@MEMEMAKAIDCBCFG@ADIDG@@@@VO\\mYIAAEAA@ICCAUCM@QKCIEMI]@ODKNg@uPO@@LIFI@A@@GQSQYM@AE@A@@@AA@@AB@@ABABA@ABA@C@CBA@@@C@A@@@A@C@A@A@C@@A@@AAA@@@A@AAAA@AA@@@AAC@@AA@A@AA@A@@@A@A@@@A@A@@@C@A@@@A@@AA@A@A@A@CBAAC@A@A@@BA@A@A@A@@@A@C@@@@@@@A@AAEAAAA@@@A@A@C@@@A@A@@BA@A@@@ABABAB@@@B@@@@@BAB@@@@@B@@@B@B@B@B@D@B@B@@@B@B@B@@@B@@BB@B@B@@@DBB@B@B@BAB@B@BA@@BAB@@AAAACAA@A@AAACEACAAACEGAAA@@AAC@@@AEGCG@OLIDADCD@BAD@DAFAJCJCHCAQCEEGCK@AAAAEBEFAJFLLJJJFLCBEDKBU@S@A@SCOCGAAQKOCOCMCKGGCACKKGMGOCECCIIAAQOOKQMMQKIGM"],encodeOffsets:[[107530,26046]]}},{type:"Feature",id:"522324",properties:{name:"晴隆县",cp:[105.218991,25.834783],childNum:1},geometry:{type:"Polygon",coordinates:["@@ELCFEHGB@@A@AH@B@B@B@@@B@BAB@@@BAB@@A@ABABA@A@CAAAI@GCA@C@EA@ACCAA@@AAABAB@@@BB@BBDF@@BB@B@BA@A@A@C@CAA@C@AB@B@@@@G@@@ABADC@I@ICC@EAA@A@AAA@CB@@EAEAEACB@JBDFL@FADCB@@E@ICGCEAC@CdCZ@@@@BJ@JBBDLHLLNDDHJJLBBHFBBFJFLBD@@@@FHPNBDHDBDTLPLHH@@DFRVHN@@@B@@@@@BAB@B@B@@@B@@@B@B@@@@@@@B@@@B@@@B@@@B@@BB@B@@@B@@@B@@B@@B@B@B@B@@B@@B@@@@BD@@@B@@@@@BBB@@BB@B@@@@@B@@B@@B@@BB@@@BAPDF@DBFDBBBD@D@F@BA@A@GDAB@B@D@DABABADB@@DB@B@BBBLH@B@@BB@@@@BB@@@@@BB@BB@B@@BB@@BB@B@@@BB@@DB@@@@B@@BBBB@@B@BB@@B@B@@BB@D@B@@@B@B@B@B@B@B@F@@@D@B@D@@@@@B@B@B@@@DAB@@@B@B@@@B@B@@C@A@A@@@A@A@A@A@A@A@AAACAACCAA@A@ABA@@@AABCBCBCBEAG@C@GA@@A@AAEAC@CAEAC@G@C@ADCFEBCFGBC@AFCBABA@A@@@CBA@ABA@ABABC@C@A@A@C@C@CAA@CAA@CAC@CAAAA@C@C@@@A@ABA@@@ADABA@@BA@@@@B@B@BA@@@@B@BCB@DA@A@IBAFEHABCDGBEBC@CAA@ABAFABEBC@@BA@AACAC@A@A@AB@BA@@B@@A@C@AB@@A@A@ABA@ABABA@@DA@C@CBCBCBABCDC@@BC@@BABA@@FABA@@BAB@@@BC@@B@@@HAB@B@B@DBBDBFBBB@@BB@B@@@B@B@@@BA@@BC@@BC@@@A@C@C@EI[@CCKACFONI@@@@BA@@BA@@@@B@B@RELCBAB@@@@@BAHGFGDGBAFEDEBA@@BA@@HGB@@AFC@@@@HGXMNEFAZ@dJPIBCDGC[AMA_BK@@BGDOBE\\_DCFCRM\\U[KIMKEKC[GWKWa[]@@IKGGMU@@GWAEH_BGBu@KNQJQ@IAGAQGGSKUQOUGMCAIOQOOGWD@@@@CBC@EFKJARGhEVETALEDMIGGAAKMGEI@KDGFA@OVSVONGDIAIA[O[IAA@@@@QISIKIACCA@AA@@@CCEEGDIDIDEBCBC@ABC@CDCBKJ@PDHFH@B@@BD@BB@BBFHBDBBBDDFBB@B@BBBBDBB@BA@AB@@ABA@A@ABA@A@A@CA@@A@A@A@@AA@@@A@A@A@@@A@A@C@A@A@A@A@@@A@@@@@A@AB@@@@A@@@A@ABAB@B@@@BAB@@@B@B@@@D@B@B@@BBBBBF@B@B@@@@@@@D@B@@@B@B@BAB@@@B@BBDAB@D@B@B@BBB@@@B@@@B@D@@@B@B@@@B@B@@@BBBB@B@@BD@BB@@B@@BBBBBB@@@B@BB@@@BD@B@B@D@B@@@B@D@@@B@DAD@B@BAB@BABA@@BA@@BB@@B@F@@BZNTRHR@@@BEJKJ@@OP@vMhCL@PJ^FNDJRLN@VDDBJDB@FBBBZJ[nUP@@@@CHCJ@BEHADCDBJBLFNFN@N@@@@"],encodeOffsets:[[107649,26551]]}},{type:"Feature",id:"522325",properties:{name:"贞丰县",cp:[105.649864,25.38576],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AHINSBGDKBCHUBCHIRWAY@@@@ACA_GcHecMOBIHIHGDOBMFEDGFOPONMDG@[CGASBwVGDWJCFMNAJAVBDFJHL@DJRAHILQRG^@@AD@BAFcPOP[CK@aFIJADAFCVEDU@_AEJGHKNKNIPCFCNELED@@IFKDMAKCIBA@C@MDGBODQ@MFABOPIJA@@BGFK@S@QBQDSDSHSFIFKDKFED@@AB@DCH@J@D@HFFFFLHFBDBHFDDDHBNANENADEJCHAD@JJPDBJDNBPDPHBBLHNHHJDHBDFJNRFJDLBPBJ@BBPDPJNNJFDJHBBHHHHFLHRFJLJBL@@AFGHCBEDEBGBKFIL@H@D@N@JDL@NELEPBBDbD@XBRLTXJ@B@J@sBAB@@ARKNEJCJAJAlEDAFC@@@@VIPQBCDIFMDGHWHMDG\\UDIFMPcLKVGJODWNWHMR]JOFGBK@EBE@G@A@CBG@@@A@KRETCREBAPGXIPE\\KBARIJEFAH@LGHEBALERLLBDMZSBSIKIIiQMMMIEW@a@OIEOBMFE@M@E_GKA@AAFg@K^mVWFEFEHK"],encodeOffsets:[[108419,25849]]}},{type:"Feature",id:"522326",properties:{name:"望谟县",cp:[106.099617,25.178421],childNum:1},geometry:{type:"Polygon",coordinates:["@@AUAeGKMGU@gPkRKHKJK\\DT@JNTBBXbJZCTIJUFuIGAc@ILCFEPA@STg\\[JEHBTAB@BCDON@BURUXINQRKLGfHdB`BD@@@@BZQXGJADGVADCLAHMTGJ@BGLEFEFUX]n@LEhBBB@HLF`N@F@NEPAJF@P@bFXNJNNjRJJJLATYTCNBNLDXKBBNFHDWVBJHFAF]XBLGRALFJBFTNRLXLVNNJDBFDHFRFJJHHBBDFABGHCHCFGDEBC@ACCCCAC@GFEFAHDH@BDDHFDD@@BB@D@B@BBDBBBB@DBB@BB@BBDB@B@B@BBB@B@B@B@D@BAB@BABAB@B@B@BB@B@BABABABA@ABA@AB@@CB@@ABABABA@AB@@C@A@C@A@A@AAC@A@A@CAAAAA@@ABCDEBA@ABE@A@A@ABAAAD@@@B@BAD@D@BBD@BBB@F@F@D@DBB@D@D@BADBBAB@BAD@DABABAB@BABABADCB@D@BBB@B@DAB@DCB@BA@BBDBB@BBD@D@B@@BBB@B@@B@DBB@DABADBBB@@BA@AB@B@@BBBBBBB@B@B@B@BAB@B@@@BBBBB@BDBBB@JRDBH@@@FABGECCEBADABADAD@DADCDADADCBCBABA@A@CBCBABCBA@A@A@@A@@AAAC@A@AAAC@A@C@A@CAAAA@AAAAABABCBAB@D@@@BA@A@A@@AAA@CCA@AA@AAACAA@ABA@CDA@A@A@A@C@AAAAA@@@I@GCCEDGPKHEBAFENINAB@NBDFHBFCFGHMBAHILIFG@EGAI@EE@IDWDMFAVBJDFBNRDXDHFAFE@IAGCCBCD@H@H@B@BAB@BAB@BA@@BBBBD@BAD@BBB@BBB@@DBDBBBAB@BCBABABC@CBA@E@CB@RUN[HYDSBE@C@ABGBGDAHBRHTDHBX@\\GHCFALCDAFCTOBAJMLQDCJKPGVDH@\\HTHZHT@FCBBF@PEPCF@B@FC@ABC@A@A@C@EAI@AAA@@@A@A@AAA@C@ABA@A@ABA@A@CBA@@@ABA@@DABBB@B@BAB@B@BAB@B@BAPAPBL@PKHK@@FGFIDC@@BABC@AJK@AFKBABK@E@GBMFQHSBI@G@EBIHKJIAKQSUMAASGOGA@AA@@AA@@AAAAA@@AA@@AA@AA@AAC@@CAC@C@EACBA@@A@A@ABAF@@@@ABA@A@A@A@AB@BCBABABC@@DAB@B@BB@BB@@B@D@BBBBBBAB@BAF@B@B@BA@ABABAB@B@BA@AB@@ABA@A@C@A@ABA@@BABAD@@ABAB@@ABAB@BABA@@BA@ABA@@BA@A@ABA@@@AB@BAD@D@@ABA@C@@BABABAB@@AB@@@BA@@@A@@@AB@@A@A@@@@B@@A@ABABA@@B@@@@@BA@@@@@AB@@@@A@@B@@A@A@@@@@A@@@A@@@A@@@A@@@ABA@@@A@@B@@A@AB@@A@@@AB@@@BA@@B@BA@@B@BAB@@@BAB@BA@@@A@@@A@@BA@A@@@AB@@@@@BAB@@A@@@A@ABA@A@@@C@@@@@@AA@@@C@@@AA@@A@AAA@@@A@@EGEGEIAC@A@A@AB@D@@@B@ACAA@@@AAA@AECA@GAGAGAGAG@GCA@E@CAA@IEC@MAMAC@CAGCGECAC@CEAC@CCIEEECCBA@MCA@MCE@A@ACA@C@A@A@CCGIE@E@Q@C@CAGAICKCA@A@AAA@AAACGCICGCI@C@EAOAGBICMCGCA@ACEEGEEAE@I@GBIBGBGDC@OAM@EAECEECAIAEBGB@@GDKHGDMDK@ECCCACCGECCAEEEECGCGACGCI@EBIHIDIBGBA@@BA@A@@@@@A@@@@@A@A@@@A@C@IIEE@@SY"],encodeOffsets:[[108683,25540]]}},{type:"Feature",id:"522327",properties:{name:"册亨县",cp:[105.811592,24.983663],childNum:1},geometry:{type:"Polygon",coordinates:["@@KEMGE@ECOKE@ACMICE@CBC@GCGCKCIAEECACAACCAEEEAAAA@CAGCCAAA@C@EBEBEDGFKHIFEDCBC@@DA@@DBB@BCHCFCBABA@GJGFE@ABABAB@@A@@@A@AB@@GD@DABKLCD@FAHEJ@BCFAL@HAFABCDADBF@BEBGBCAC@GAQAIBK@E@MAKAE@CB@@@@ADA@@B@@A@ADA@@BC@AB@@C@@@A@C@AAG@G@C@ACCC@EAACECCECEAAACAE@ICKIKEC@A@IAI@I@C@E@A@CBA@CBGBIFMFEDGDCBCBGDCBAB@DCJCFCFADA@A@CBEBEDEBIBGDEFEDEFABEHEBEFGDMLEBGDSDMDCBIB@@A@IAKAEAMAK@ODQBIBEBIFABA@A@AB@@ADCDAB@BAB@@@B@@AD@B@DAF@FEHGBGBABEBKAICGCCCEEGAE@A@E@G@C@GBG@C@K@CBEBIBA@GAA@KBIDEB@@EBQDEBEBCFAD@BADABBP@JA@@FAFAFCFCDCFCBEDEBEBEBCBGJAD@B@@BB@B@BA@@BCDGDADCB@D@BBD@@@FAD@BAHADAD@DAFAD@FAF@DAB@D@@LBLDFDG@AB@@@B@B@B@DAB@BAB@B@@@@@@@B@@@B@@@@HDDBD@B@DDBBD@DBF@DDBFJL@@B@D@B@B@D@H@D@JL@BNNNJL@PARBLFJDHBJBN@JALGL@PAHGAC@CBCBEFAD@D@HBFBD@@B@B@FCFBFBD@DAD@FDB@BF@BAFFFBLBJ@@AB@@@BA@AB@@EB@@ABAB@DAJDBBB@FBFBAD@DEDEBC@CD@BA@@D@@@D@H@@@BAD@B@BA@@BA@ABC@C@A@@@AAAAAA@@@A@@@AB@@@FA@@@@B@@@@A@AAA@AA@@@A@CAA@@@@A@A@AAA@@@@@@@B@@AD@BABABCFABAB@@C@A@ABEAC@@B@@A@@B@@CD@@AD@B@DBBBB@@@@@B@B@BBBBBBB@BBB@BBDBD@B@BFB@FB@DD@HAHCD@@@B@B@BAFAB@@AF@TBJDNBJBLBFBJBB@@FRBN@H@BCPBLHJHLJPHHBDJNJHHHDFBF@JCRCJGPADAB@@AFIFMNGLABABCFEF@HHFFBDDDFBHBFCNCLEPAF@D@DAFDPDN@@AN`BV@FCDUBEBCJIbEL@\\DPOdOBE@ABC@@H]RQJKBGIQ@CGKEIACBUBINMDEXIHCxUTAHB\\DH@NCPMPOHEFCNEPAHCJGJGPAdNLKRQJMVWVQ@APMDC@ABAASFG\\Ih[TSB@FODEJKd@HBvJVEJIDSIYWaAAMS@ICSL[LILGlQhOV@NHHLBfBVTZ@CDEFGFCNEFGDCBEBEBGFE@@JOFEFEBC@CDCDCFAFCBCBADGDG@MCE@CBEBADCBCDC@CBADI@GBCAE@AACCACC@EAEBE@E@EAE@CAKAGCCCC@CAEGAEAAA@C@CBCDG@A@AACAC@@AAAACCCEACAECGAGACACACCCGAE@EBGDABCBABA@A@@@A@@@GEEGAC@A@CAGCE@I@EAAACACECGAECC@C@CACACKEIECEAMGGCCBC@A@EAG@A@"],encodeOffsets:[[108631,25299]]}},{type:"Feature",id:"522328",properties:{name:"安龙县",cp:[105.442701,25.099014],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@AD@BABCDIB@@@@@BA@@D@F@@AJBDHDBBBD@FCFADCDC@AAEECAG@KBEBEF@@@FBH@B@BA@E@ABABABAB@@@BAB@BAB@BEB@BA@@BAD@D@D@B@BBB@@H@B@@FBJDHJDD@HGHCFADBDFHDJFJHPHLFJFLHFDFBL@HBJBJFFDFBDHDFBHBJFN@PBJ@FBHDP@HDJDLBH@HBHBDBBBBBBBBBBBDBD@BBBBBBBBBB@BBDB@@BBB@@B@BBB@B@BBBB@BB@BB@@@@BBBBBB@B@BBB@@@FBHFLPNJDF@H@DBDFBN@JBFB@BABGBCB@@AB@@AAAB@@@BB@@@B@@BAD@@BD@@@BBB@@@B@BB@@@B@@@@BB@@B@B@B@@@@@BA@@@@@B@@B@@B@@BB@@B@@B@@@BB@BABEDCBAFEDG@G@ICE@MBMFEDEFCHAJ@H@F@J@HDFBFDDDFDHAFCDED@BCHAB@BAHBJBHFPFFJNL@P@TBJHFJFH@@BBHLFFNDLCLCJCF@B@JHDBLLHF@@DBNDLBB@L@HAHAH@HFJVBHJLF@^@HAVADAVENEJIHKJOBKDIHEHAJBBF@LCX@D@B@FFHPDJ@F@JAL@J@@@@@B@@@JAFDDJFN@@@@BHBFDJJNFFJLHFBBFHLELCJETETGTCRCRAT@L@HE@AB@JIPOBANER@PCHANCD@B@JALDNBLCJE@@FCFKDMDEJOLMLMHGFIBM@@CMCOBE@C@CBEFODKDMAEAGCECCEAGE@GFEDEBABAHKNMJEBE@@BABCHODIDQ@IAECEGGIGIMACGGIOGKGIAKDO@A@GAMEQ@@AAAIAEAKAICMAI@SBE@@BABE@A@A@A@@DCBG@GCCA@@EEA@A@AACAC@AAA@AAAAAAA@A@A@A@@@@AAAA@C@ABC@@DC@@@AB@@@@AD@FBBAB@D@@@BABADEBABA@ABC@@@A@@@@@@BB@B@B@B@@B@DBB@@@B@@BBB@B@B@@A@@@@@EB@@A@@B@@@B@@BBBBBB@@B@D@D@BAB@@AB@@A@ABC@A@@@G@C@@@CB@@ADCD@FAFC@CBCEAEAA@AAICCBA@AB@BA@@FA@@BAB@@A@@BI@KAEAEEABE@@ACA@EBC@CACAEDE@E@A@AC@EAGAC@C@EBAFAD@DBDGHOBK@KHIBM@IAGAICKEQAOBK@MIMM@AIKC@G@C@A@A@C@A@@@IKAECCE@CAC@AACCA@C@CAGC@@@@@A@@@A@@@@@@@ABA@ABA@C@A@A@A@@BAH@ECKCKA@@@B@BCHAHEFCDC@CDABCBEBEFODE@IFAAEBMDE@E@CBABAB@BC@G@GBGACBE@C@CBG@GCECC@G@CAGCEACAECCCCEACCAC@EAA@M@I@E@E@OBK@ABGBIBC@A@CBA@G@I@G@CBCFCFEHAD@F@HBDBDB@FDBB@BAFCFADKDABA@A@A@AACAECC@C@CBABC@A@AB@@@D@BBBBF@B@BADADABABA@C@GAC@KBC@AB@B@F@B@@@BBB@DBBABAB@BCBA@C@C@A@EAC@E@E@CACAIEECECE@AA@AAA@@A@ACEC@AACCICEA@ACGGEE@AC@A@A@ADCBABEDA@A@A@A@C@AAA@CBAAA@AACAACAE@A@AAAAAAA@AAC@AACA@@@AAC@ABA@CAACAAAAA@C@AAA@AAECGGCCGEeSEC@@C@GC@@CCC@@@GFEBC@AACAKCCB@DDHABABAB"],["@@@@BB@@@@@B@@B@@@@@@@BB@@@@B@@@@B@@@@B@@@@@@@@@@@B@@@@@@@@@@A@@@A@@@@@@BA@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@AA@@@@@@@@@@@A@@@@A@@@@@@@@@@@@A@@@@@@@@A@@@@@@A@@@@@@@@@@@A@@@@@@AA@@@@A@@@@@@@@@@AA@@@@@@@@@AA@@@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@@@A@@@A@@@A@@@@@@@@@@@@@@@@@@B@@@@B@@B@@@@BB@@@@@@BB@@@@@BB@@@@@@@@B@@@@@@@@@@B@@B@@@@B@@@@@@@@@@@B@@B@@B@@@@@@@@@@@@@B@@B@@@@@@B@@@@BB@@@@@BB@@@@"]],encodeOffsets:[[[107566,25521]],[[107616,25524]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("铜仁",{type:"FeatureCollection",features:[{type:"Feature",id:"520602",properties:{name:"碧江区",cp:[109.263998,27.815927],childNum:1},geometry:{type:"Polygon",coordinates:["@@F@J@D@BA@AAA@@GCGACAAC@CDEJGDCJGHGBCDC@CAA@AIQAG@GFG@ECC@@CC@AACBABABAB@B@AA@@C@GCGGACAAB@B@@@B@FBD@DAB@@AAA@@A@GGCEAA@ABAH@BA@@@@AA@A@@@AB@B@F@F@B@B@DA@A@A@ABABAHGFEDAFAB@BAAIBEBA@ABAAAEG@@B@FAB@LAFCBABCAACEEGIEC@@A@C@CB@D@FBD@F@NDB@DA@@D@B@BBBBB@@@B@BC@ABAHCB@D@@@BB@BBBLDF@D@HG@I@CBADAD@FABADGBEBAH@FEDAD@LCREHGJKHEBA@AAAAA@@C@GAGCCAAA@@@ABCFMFMDGBIBIDI@GDC@EAG@E@AEKEMCK@@@A@@@@A@AA@@AA@@A@@C@@AA@@@@@A@@AA@@@@@A@@AA@@@AAA@@@A@@AA@@@A@@AA@@@@@A@@@AB@BA@ABA@A@ABA@A@@BC@AB@@ABA@ABA@@@A@@BABA@@@A@@@AB@AA@@@@@A@AA@@A@@@AA@@A@@@@A@A@A@ABA@A@A@@@A@ABA@A@@@A@A@@@A@EGEEEECCACKAKDGDC@ABKAIAIEGCECIC@HEFCFCFAJ@HAHAFCJEJEHADAFAL@FAD@HABCFCJGFGHGDGDC@E@GAMCMCO@UACAA@@A@@A@@@@@@@AA@@AA@@@AA@@@AA@@AA@@A@@@AA@@@@A@@AA@@@A@@@@@A@@@A@@@@@A@A@@@@@A@AB@@EBCBGFCBEBIDCBA@AACAEA@A@@A@@AA@@AA@AA@@A@A@@@AAA@AAC@CAC@A@AACA@@A@C@EB@BBBDFBLDHA@MFADED@FBFDBFAJADCBAH@J@BDABIDAFDJBHFCD@HCDDLFFDJHFJBF@F@DSDC@E@E@I@C@CBIBEBQB@FDJBD@B@FAFABI@CACCCACECG@C@CIAKAC@@@G@C@EDEFCB@BEFABEB@DBD@B@@AB@BA@AA@@CB@A@CA@EBBDD@ABBB@@@B@D@@BBA@@@@@A@@@@ACB@@AB@@C@A@CA@ECGECCCIAUIA@IAGAAE@ABA@CACDMDG@C@A@E@CAACACAA@E@ADA@EA@@BC@AOBIAGGA@KAGCACCGAEAAACA@A@@A@G@AA@C@@@AA@AACACAAA@C@A@A@CB@@ABA@@@CCA@A@EAA@CAA@A@C@A@E@CAA@AAC@A@A@@B@@BB@@CDA@AAAACCA@A@A@AAABABCBABCDAD@BAB@@ABBBDBBD@B@BABCBAB@L@DAF@@@BA@@@@BB@FB@B@@A@C@A@A@AAAA@A@AAAA@@BABABABA@A@CBA@AB@@A@@A@AAAA@A@C@AAA@@AA@A@AAECCC@A@CBA@@@ACCCC@A@@@A@A@@@A@AA@C@ADIDOFSCU@Y@KBGHEJ@LDTBFBBDBFBDBBD@@@B@D@B@D@@@BBB@@B@@BB@@BBBB@B@BB@@FBFBDBBBBBB@BBD@@@BB@B@BA@EACAABBB@DB@D@B@FB@@B@@@@@@B@@@@BB@@@@@B@@@@BB@@@B@@@D@D@B@@@BAB@@AB@@CBEDGBG@A@@B@@@B@DAD@D@BBAB@BAB@BBB@BBBB@DC@A@ABA@@@@@@@@H@B@@@@A@A@A@A@@B@@A@@@@B@@@@@@@@@@@B@@B@@@@@@@@@@B@@AB@@@@@@@@A@@@A@@@@BA@@@@@@@@@A@@@@@@@@@@B@@@B@@A@@B@@@@C@@@A@A@@A@@@BAB@@ADADCH@FADCDABADBFBFDFDFDDBD@D@F@D@BBDBB@BBBCBA@@@@DDDFFDBBBB@@@BABBBBBBB@BAB@B@BDBBB@F@B@@C@CAC@C@CBAD@BBB@BB@D@BBD@BBB@DB@B@B@B@AABABCBABA@@B@B@D@D@BCFCB@D@DBDDB@DBB@DAF@D@FAD@BADABB@@@BB@BBBB@B@@@@@@@@@@EBA@@@@@@B@@@@DFFDDDBDBBDAFBD@DBDBBBDBFBF@D@DCDABC@C@CBA@C@@@@B@B@@@B@@@@BB@@@B@@@B@B@B@@@BAB@B@@@@B@@@D@@B@BAB@B@B@@BBBBDA@AAAAAB@D@BBBDBD@BB@@@B@B@@@B@BB@@BBBB@BBB@@@BBB@@@B@BB@@@@BB@@FDHDBBDDDDDBFDFBBBBB@@@@B@@BB@@@B@@@B@@B@@BB@@@B@BBBA@@B@BA@@@@BA@@B@@@B@@@DB@@B@@@BB@@@@B@BBB@@@B@B@B@@@D@B@@AB@@@BABA@@B@@A@AB@BA@@@A@@BA@@@A@A@A@@@CB@@A@A@@BA@@@A@@DJDLB@@DDFDLDRDB@JAJANEXQFCBAHEHGJGNGHALDBA@@B@@@B@@@B@B@@@BAB@@@B@B@B@@BB@B@BB@@B@@@B@@BB@@@BB@AB@@A@AAAACCC@CBA@ABBDBHD@FAF@DBB@B@D@@@@@@@BB@@B@@BB@B@@@@B@@@@@B@@@@@@AB@@A@@BA@@@@BA@@B@BA@@@@B@@BB@@@@@@B@@B@@B@@BB@@@@@B@@@BB@@B@@@@@B@@@@BB@@@@B@B@B@@@BB@@B@B@@@BB@@B@@B@@B@@@@@BB@@@@B@B@@@@@BB@@B@@@B@BBB@@@B@BB@@@@D@B@FBZ@F@D@B@BCBEBA@AB@BBBBBBD@B@@A@CBAB@B@BBFBD@BBBADCDADAHA@@BB@@AB@BB@BBDBBBB@@A@CBCB@@@BBBDBBFDB@F@FBHDDDFFBF@D"],encodeOffsets:[[111952,28539]]}},{type:"Feature",id:"520603",properties:{name:"万山区",cp:[109.213644,27.517896],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@G@GBKAIBEAC@IACBGCEACCEAE@@@GBCF@F@F@DCDABABG@GBIDGDABEHCFCFCDK@KAEEIIGIOMMIGEGIE@IAG@@@AD@FFNDF@H@DAF@B@B@@B@AB@@E@@BABAB@D@B@BB@@@B@@B@@@@BB@B@@@@BB@@@B@@BB@B@@@@BB@D@@@B@BA@@B@@@B@B@@@BAB@@@B@B@BBB@B@B@B@@BB@@@B@B@@BB@@@B@B@B@@@B@B@@@BA@@B@@@B@@@BAB@B@@@BABA@ABEDABCBB@@BBD@@DBBB@DBB@B@B@B@@BB@@@@@@BB@@B@@@@@BB@@B@@@@B@BAB@BA@@@@BA@@@A@@@A@@@A@@@A@@@@@@@AB@@@B@@@@@B@B@@@BA@@@@BA@@@@@A@A@@@A@@@A@@@A@A@A@A@@BC@@@A@@BA@@BA@AB@@@B@@A@@@A@@A@@@A@@@@AA@@@A@@A@@AAAA@AA@@@EACCAEAE@E@CFEFCDA@CBICKAMGIGCGCCAACCCC@ACAEEKKIGEEGGGKIKCGCEGEICECICICGEKAKB@@C@ICG@A@EBAHAHAFCDC@IAIAIAG@K@KJCDABAFFNFFB@@BFFPHDHDF@FEFGDA@K@GBA@@@C@GDCDEFCFGHCBKBGAKICGCE@@CEEGCC@@A@EEGAIBG@CDGBKFIBOBGDIAFFGJIJEJCLBHLLJHDBLJFDFHDN@FALBPJNNHDBDBJALCPKPWHIHGHGN@JDHFHNLXDPBLBDDHBLNHNHPHFBFBDBB@JDFH@JABD@B@@B@B@@@B@B@@@BDDDD@B@@AB@D@BDDFDBBB@B@@BB@BBD@B@B@BB@B@BB@@@BAB@DAB@B@BABABA@AB@BB@B@BBBBBB@B@D@B@@@@AEAA@@A@@B@@A@@BE@C@KBADABA@A@AACCAAABA@@BA@ABCDCBADABABABBB@B@B@DDBBBBB@DC@@AA@@@AB@B@D@BBB@DBF@B@D@B@B@DBB@FBB@B@DD@@B@BA@@DAB@B@D@B@BBBDBD@BBB@@D@B@@B@H@BB@B@BDBBBFDHBDHDLBB@HHJBPA@BAD@@FBB@BCF@B@DBDBBB@D@F@B@DCHCNBD@DAB@BBFHBJBB@VJJBDDFDDH@FDBB@D@@@BA@@DA@B@@B@@@@@B@AA@@@C@A@@AABAC@ACFAB@@D@BDA@@BBB@@ABA@@@AAC@CFABAFE@ADAFEFCD@H@@@D@LBJB@D@DDHDFDBDDDBJ@BABE@E@AACCI@ERAFAJADAD@J@F@F@D@TC@C@EAEEIIGECKECCGDC@EDAGCIBEJCBAACI@G@ABCDIBEBCAAE@EFCBCNEB@CGAKCEAA@AFAD@B@@@DBBBB@D@DBD@BBB@BB@@B@B@@@BBB@@BB@@BB@@@@BFBDBBBB@DAJCFADAHEDAFA@@BAB@@@@@B@B@@@@@B@@@B@@@@@B@@@B@@BB@@@@@BB@@B@@@BB@@BB@@B@@B@@BB@@BB@@@@@@B@@@@BB@DBVBP@NDNDHBF@D@HCHCHGHEDIDEBA@GBC@EBKBEBCFGFIDIBEBG@GBIDEDEFE@GIGGCIGIEMMIEOKCCIGKEKIKIECCCCAMGKI@ACCACAG@MBIBABIBE@I@C@IBKAGEGECKA"],encodeOffsets:[[111918,28082]]}},{type:"Feature",id:"520621",properties:{name:"江口县",cp:[108.839557,27.69965],childNum:1},geometry:{type:"Polygon",coordinates:["@@EIGG@@AACKCKCMCG@@ACCGKIA@@@GCGBEHGDIDG@MDLNFHBF@F@DCHCDCJGLGLEJABCH@HHJDLHJJFLHDHCFEHIHEFGJAN@LBHBFHNHLHLFLEFJFHRHNFJRPNFNLJJFFDH@FBHAJ@BEREJCLENGL@BINKRKJMFIBKAICGAI@KBGFEDKBEAIAICQAC@EF@DBJ@FAHAL@L@N@R@DDLHFRHNBX@J@LDJBDJBDBL@JBNBNBFBHBN@L@JBPFRHLBBHFJDLAJAFCDEDEHAF@J@J@HBLDLFJJPHRHLDLDLDLBF@F@H@HBJBHDJDHFJDLFJLJJLJLFLHLDBFZ@PALCJIFIFEHALAJFDDDDLFNAJGHIFIFMBCHEJAB@JCDG@GA@EG@KHINCRDFBFB@BHBNBJAF@BA@@B@@@B@@@B@@@B@@A@@@AB@@CBA@@@@BA@@@@FBDFFJHHJDL@L@NADC@ABABI@@BADEB@@A@C@EAA@AEE@A@A@ABC@@B@BAB@BBBBDDDDBBB@D@J@@@B@B@B@@@BB@@BA@@B@@@B@B@@@BAB@B@@@BA@@B@@@BB@@@@B@B@BB@@B@B@@@BB@@@@B@B@@@B@B@@@B@B@B@@@B@D@B@B@@@F@D@B@JCD@L@HFFJIZ@HB@HDNHDB@BDADGAGCMHGHELAHBFDDDBB@BBBBB@@BBDDB@BB@BB@B@D@NANAPCHCD@B@FCDCDAACCGAAGIGICGGGEEECMCGCGAEGCKCMAK@IBEHELBLDHBLFHHFJJJAJCH@FAH@@BDHDLBLAHABE@CB@@@B@@AB@B@@@DA@@B@B@B@@@B@@AB@@@B@@ABAB@@@@AB@BA@A@@BA@@@A@C@@@A@A@A@@AA@A@A@@A@@A@@@AA@@C@@@A@@@AB@@A@@B@@A@AB@AA@A@A@@AA@@@AA@@@A@@@A@@AA@@@@@AAAAEAECCACCCCAAGCEC@@AA@@@@AAA@@@A@AA@@A@AAA@AA@AA@@A@A@@@A@A@@AAC@CAAA@A@CBABBBBB@ACAA@AA@A@A@ABA@@@@C@@@A@@A@A@AB@@A@A@A@@@A@@@A@@A@@A@@@A@A@@@@@@DAB@D@DADCBCDC@E@EACAAACACAC@EACBAAACCCECCE@@@@@A@@@@B@FA@@@@@@@@@@@AAAAAA@@A@@AACBABC@EBC@E@CBA@CAA@CCCAC@A@EDADC@C@A@A@@@ABABADABBBA@A@A@A@@CAA@AAC@A@CAAA@AAC@AB@D@DBD@D@DA@E@A@AAACA@A@ABA@AAAAAAAB@@A@AACAEECC@C@@B@DAAA@AAAAC@A@C@E@CACCCCECEAEAEBCBADCBC@EDGBCBC@@BA@A@@@BB@B@@@D@@@@@@AB@@@@A@@@A@@@@@@@@B@@@@@@@@@B@@A@@B@@@B@@@@@@@@@BA@@@A@@@@@@@@A@@@@A@@@@@@@@@@@A@@B@@@@AB@B@B@B@@@@@@A@G@@@@@@B@BAB@D@@CAAAAA@AAA@ABA@AB@A@CBC@C@A@@@AB@H@HAFCDA@@BA@@BA@A@@@A@C@C@@@A@@AA@@@@@A@@@@AA@@@@@A@@@@A@@@EAA@C@A@@CAABADBFBB@@A@AAA@@C@AAA@AAAACAEAEA@@AAA@A@AA@AA@@AA@@@AA@A@@@C@A@C@A@@ACCAEACAAAAECS@KFIHGLAZ@V@TDPEJCBCBA@IEGICA@CAEAEAOGMGMGAKCGACAKCOKWGMGEICM@GHGHGJOXOLKDIBCACAMGIMAOBK@ECMEGECKICAIGKKAGDKFIJIHIEECA@@CAA@@@C@G@@@@@A@@BA@@@A@@@A@@BAA@BA@@@A@@@A@A@@@AB@@@B@@BB@@BB@@@@@BB@@B@@@@@B@B@B@@@@AB@@ABA@@@A@CB@@CA@BAAA@@@A@A@@AAAA@@@AAA@@@AA@@@@AA@@A@@@AA@@@@A@BA@@@A@@@ABA@@@@@ABA@@@ABA@AB@@ABAB@B@@@B@BA@@B@@A@A@@@A@@@@@A@@BA@@@@BAB@@AB@@A@@@@@AA@@A@@@@A@@A@@A@A@@@A@A@AB@@A@@BA@@BA@@@AB@@@@@@ABA@AB@@A@AB@@A@@@A@A@A@@@A@@AA@A@@@A@@@@@A@@@@@A@A@@B@@A@@@ABAB@@A@@@@BA@A@@@A@A@@@@@A@@@A@@AA@@@A@AA@@@@AAA@A@@@A@A@A@@@A@A@A@@@C@@@A@@@A@@@A@ABAB@@A@@BA@@@AAA@AAA@@AA@@@E@A@@@E@@@ABAFAB@@A@AACC@@@ABCBA@G@ABC@@@G@A@C@AA@C@A@@@G@IBABC@@@BB@@@@@B@B@BBB@B@@@B@@@@@B@@AB@@@@AB@B@@@B@B@@IDIHIJIJIJAB@@GFGHEHIDK@I@ICCCCAECGEEA@@ECG@E@C@MDG@G@@@A@IDKFKHKHGBIBGCEAKE"],encodeOffsets:[[111212,28177]]}},{type:"Feature",id:"520622",properties:{name:"玉屏侗族自治县",cp:[108.906411,27.235813],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@AA@@A@A@@@A@A@A@@@A@@@A@@@A@@@@@A@@@AA@@A@@@@@AA@@AA@@@AA@@AAAAA@A@@ACGCACAC@CA@E@@@AAAA@A@@@A@C@AAE@A@ABA@@AAA@CB@@ABEJABA@@AA@@@AA@@@@@@ACCE@@@A@AB@@A@@BABA@@DA@@B@B@@@@@B@B@@@B@@@B@@@B@@@BBB@BB@@B@@BB@@BBBBB@@@B@BB@@B@B@@@@@B@@@B@@BB@@BBD@BBBD@@BBB@B@B@BA@@@CBA@@BA@@B@@@@@BA@A@@@AAA@C@@@A@@@A@@A@@AA@@AA@AA@@@@A@@A@@A@@@A@@@@AA@@@A@@@@@AA@@@@@@A@@A@@A@@A@@@@A@@A@@@@@AA@@A@@@@@AAA@@@@CA@@@@A@@@AAA@@@A@@@AAA@@@A@@@A@AA@@AA@@BA@@@@@@BA@@@@BA@@BA@A@@B@@A@@@A@A@@@AB@@A@@B@@@@@B@@@@@BA@@@@@A@A@@@AA@@@AA@@@@@AA@@AA@@@@@@AAA@@@AA@@@@AA@@A@AA@@C@@A@@A@AAA@A@@@@@AAA@@@A@@@A@A@@BA@@@AB@@AB@B@@A@@BA@A@@@AAA@AA@@A@@@A@@A@@A@AA@@A@@AA@@A@@@@@A@@@A@@B@@A@@@AB@@A@@B@@A@AB@@@@A@@@@@@@AA@@AA@@@AA@@@@A@@AA@@@@@A@@@A@@@A@A@@BA@@@@@A@@@A@@@AB@@A@A@A@CBA@@@@@A@@A@A@A@G@A@CAA@ACEC@@A@A@AA@BC@ABAB@BC@C@A@CAACA@ACAC@E@A@@@AE@CBGDGBCDAFAFCDA@A@A@CACAAAA@@CDMBE@EFIDKDC@A@@AA@C@@BE@A@E@AAA@AA@A@@@@@A@AAAACA@@@@@AA@AG@@@@@CAA@A@AA@AEAA@@@BA@@@@@AB@@@@A@@BA@A@@@@@A@@@@@ABA@A@A@@@@@AB@@A@@@A@@B@@A@@@A@@@@@ABA@@AA@@@@C@A@@@@AA@@A@A@EAECAA@@@@@@@AA@@A@@A@@A@@A@@AA@@@C@@A@@A@@@A@@AA@@@A@@@A@A@@@A@@@AA@C@AAAA@@@ABAB@B@BADAB@@@@A@@@ABA@@@A@A@@@@@A@@@A@AA@@A@@A@@@@@AA@@@@A@@A@@A@ABA@@@A@@@A@@BA@@@A@@@@@A@A@@@A@@@A@@AA@@@AA@AA@@A@@AA@A@@@EACCCACCCA@A@A@A@A@A@@@A@ACC@@CAA@C@E@IBA@AA@@@@AA@A@@@AA@@A@@AA@@@A@@@@AAAA@@A@@@@@AAA@A@CAAAA@@@AA@@A@A@A@AAA@C@AAA@A@@@AA@@A@A@@AA@A@AA@@A@AACA@@CA@@AA@@A@@@@ABC@@AA@@@A@@@@@@@A@@A@A@E@@@AA@@@@@@AA@@@@AA@@BA@@@AB@@@BAB@B@@@B@@AB@D@@@@@B@@@BA@@@@BA@@@AD@D@DBBA@AA@AC@AAACAA@@A@@@A@ABABA@@@AB@@A@@B@@A@@BA@ABADABAB@BAAAACCC@@@A@@@A@A@A@@@A@A@@@A@A@@@A@A@@@@@ABA@A@@@A@@@AA@@@@A@AA@@@A@@@A@A@AA@@AAAA@@A@A@CBCB@@@AA@@A@@DC@A@A@AACA@AAC@@A@@@AA@@A@@@AAA@@BAA@@A@A@@@AA@@AAA@@@@@A@@AA@@AA@AA@@@@AA@@@@A@@A@@@@AA@@@@AA@@@@@A@@A@@A@@@AA@@A@@AA@@@A@@@@@AA@@@@A@@@AA@@@@@@@AA@@@@@@A@@A@@@@A@@A@@@@@@@@B@B@@@B@@@@@B@@B@@B@B@B@@AB@@@BA@@BA@@B@@A@ABAB@@ABABA@ABABA@@BA@A@@@A@A@@@A@A@@@AB@@A@@BA@@B@@@@A@@@@BA@@B@@ABAB@@AB@@EBA@C@A@A@CAA@@@@@AB@B@BB@D@BBD@BD@@@B@@@D@@@B@@@B@D@BBB@@@B@@@B@@@BA@@B@@A@@BA@@@CB@@AB@@@BA@@B@@AB@@ABA@@@AB@@A@@BA@@@@BA@@B@@@B@B@@@D@@AB@B@@@B@@@@@@AB@@@B@@AB@@A@@B@@@@A@A@A@A@@@A@ABA@@@A@A@A@@BA@A@@@@BA@ABA@GB@@C@MDKDGBEFAHBHFFFDFFB@PDNDLLFDDJ@@BDFR@H@B@@BH@P@HDHFDFDHFDB@@FDDF@HBLDL@@BB@BBHBLALGRGLA@@@GHIHA@@BBF@@@@EFAB@@@@@B@@@BB@@@@@B@@B@@BB@@@B@D@B@@@DB@@@@BB@B@@@D@B@BA@@B@DAB@B@B@BAB@B@BB@@B@@@@@BB@B@@AB@@@BA@@B@@AB@@@@ABAB@@ABAB@@AB@BHFJBD@HBHCDCDEDCBC@@BI@E@IDCJAHBLFHJBDDNFHHDB@@@FBF@JGHAHAHDFD@@JJNFDB@@DBNBVCPA@@@@L@TCJ@JA@@P@JF@LBB@@@@@BBFBJFH@BHJDJFJBJ@JAD@@@DKLEHILGFIFLBHFJDJDFDJDHFDFDHJLHLHHFFJHLLFFDB@BDDDDBBDDDHJHNHLBJDDAB@DCFEDEF@F@FBDBBD@F@@BBB@BB@BB@@@@B@@BB@@@@@B@@@BB@@@B@@@@A@@BAB@@AB@@AB@@@D@@AB@B@B@B@@@B@@@B@@@B@B@@@@@B@@A@@B@@A@@@A@A@@@@@A@@BA@@@@@@B@@@B@@@B@@@B@@@B@@A@@B@@ABA@A@A@@A@@@AA@@@@A@@@AA@@@@@@AA@@@A@A@AAA@CAACA@@AC@AA@DABAFCBAB@BA@A@@@ABA@A@@@A@@@AB@@A@@@A@A@@@A@A@A@@AA@@@A@A@@AA@@@A@A@AAA@A@A@A@@BA@A@@@A@A@@@AB@@A@A@@@CAA@@@@@AAA@@@A@@AA@@@@@AAA@@@@@AA@@@A@"],encodeOffsets:[[111774,28109]]}},{type:"Feature",id:"520623",properties:{name:"石阡县",cp:[108.223612,27.513829],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@B@@A@@B@@A@@BA@@B@@@@@BA@@@@@@BAB@@@@@@AB@@@@AB@@A@@BABCBC@EACEAI@@@@@@@A@@@@@A@@A@@A@@@@BAA@B@@@@A@@@@@@@A@@@AB@@@@A@@@@@AB@@@@@@AB@@@@@BA@@@@B@@A@@B@@@@@@@@AB@@@B@@@@AB@@A@@B@@@@@@AB@@@@@@@BA@@@@BA@@B@@@BB@@@@@@BB@@@@@B@@@B@@B@@BB@@B@@BB@B@@@B@@@B@@@@BB@@@@B@@@@@@@@@@@BA@@@@@B@@@@B@@@@@@B@@@@B@@B@@@@@@@B@@@@@B@@@@B@AB@@@A@@A@@AA@@AA@@@@@@BA@@@@@@BA@@"],["@@EAGCKEICKAK@GBI@SESASAUAEQCE@CE@EBCBCBIFEFKLKB@@AA@@A@@@A@@@@@AACAAAC@CAAAA@A@ABCBAB@@@BFDBD@B@@@B@DA@E@I@C@AB@B@BFBH@HFDHDH@J@F@@AHCBEDC@A@@@A@A@@@A@@@A@AA@@A@A@@AA@@@A@@@AA@@A@@@@@AA@@@@A@@AA@@@@AA@@@@@A@@@A@@@@@AA@@@@A@@@@AA@@@@@AA@@@AA@@@@AA@AA@@AA@@@@AA@@@@@@AA@@A@@A@@A@@AA@@@@A@@A@@B@A@@A@@@@@A@@@@@@@A@@@@A@@A@@@@@@@@A@@A@@@@@A@@@A@A@@@A@@@@A@@@@@A@@B@@@@AA@@AA@@@AA@@@B@@@@@@@BA@@@@@@BA@@@@B@@@@AB@@@@@@@B@@A@@@@B@@@@@BA@@@@@@B@@A@@B@@A@@@@BA@@@@BA@@@@@AB@@@@A@@@@@@@AB@@@@A@@@@@A@CDoECEKGKKA@@@CCaAIAEAS@UHABEDALDP@@BDABABC@GAE@GDEBI@I@EDAF@D@@@@AB@@@@@B@@@@@B@@@@@@@B@@B@@@@@BB@@@@@@B@@B@@@@BB@@@B@@@@@B@@BB@@@B@@@B@@@@@B@@B@@@@B@@@@@B@@@@@@@B@@@@@BCD@@CB@@C@CBCBA@CBEBABABAD@B@BBB@BBBDBDBD@DBB@D@J@D@F@B@@@B@@@BA@@B@@@@@B@@@BA@@B@@@@@B@B@@@DA@@B@@@BB@@@@@BB@@@FDETAVDTAL@PMLO@A@Q@A@OHOLCJ@BDRB@JPRJRLFALAVHDBB@J@@DVtVtJNVLH@F@BAJAF@BAF@BAH@P@P@RJRZDLBB@DBZ@BAD@D@B@DAD@DAB@DAB@D@D@D@D@N@NBJH@H@VCNAHCLKDC@AJG@ADEBA@@DCHOJKHGHIHINQDEB@FIFEDCFGBGBIBKBGFIDEHDPBJJFBD@FC@GFGDCFBLRHRBD\\|HPBH@FJlDLDHBDXNJFFLJ\\@@JFNHHDDDFDFHBFFLBLDLFHJDL@TBD@LA@@LGFCHGBC@CAO@C@IBCBIAOAGAACICI@CBIDGJCPFLJPBDBF@FEFGLEJ@JBFNBJDL@F@D@@@@@BADIHCBCDKH@BED@BLRBDDVD`TBNFFDJAFEDADEBE@GAS@@BOBCDIBAHGJCHCD@HEHEHCPCR@JDJJJN@@RLXNHEZ[D@CK@G@ILIDCFELKHIFGFEHEPGFCJELEHIFGJGJ@P@T@FBn\\dFLND@H@H@DBHDHDHDH@HAHGFCJAF@JBDDRHLFDBHDFEEKGKGKGMAEAG@KBMHIFEJGFGDECGKGIEGICKGI@GDGBAFIHKHKDIDCDG@C@EAEEGKMEAECEEEGKKEK@@@AGQEMGKAG@GAE@@@ACIEEG@EBE@CFKBE@CGCEAG@ECCCAG@CBCBEDCDEDCD@@@@EDKBI@IBGFIDGB@@A@IAEAACGCIKEEEGIGIEKCCAC@AAIEA@@@ECCCKCEE@@CAMEAAGEIGKKEEIKGEIGECA@CAEBE@IDK@GAEA@@@AICIAM@Q@MF@@@@KFADIFOHQJIBA@K@OCMGIGEGCGCIGOGKCS@@@AEWISEKEEGAC@CA@@C@CBGDIFKNKNEPEJGHGDIAMAKA@@@@KBIBKD@@CBODGB"]],encodeOffsets:[[[110511,27988]],[[110677,27987]]]}},{type:"Feature",id:"520624",properties:{name:"思南县",cp:[108.253882,27.93755],childNum:1},geometry:{type:"Polygon",coordinates:["@@GQKQEACDEH@HEDC@EAIIOAGCCFEJAHALAJAHEHCDEFEJA@CFMRGJGJGHILGPCD@@ABCF@BIH@BCDKLGDMBUDG@G@BBFHDJFJDFFRJPNPHHFFFFDPERCHELAJCJCX@D@T@DDHDHLLDDLNHNERKLCBC@GDABMHEFEDMPGNCHELABOLABCHGLBNATANBVHDLTBPNNVDPAADAFEJCFCHCHCFAHAH@BDX@ZHVBDDFFJHLFLDDFH@BFNN@PBHBFDDBDBHFBFAJCLCFCFAF@DADHBPDH@FBHBBBFBJBB@B@JDHDJ@NBFBD@FBD@J@F@H@BAB@HBFAJBF@D@JBDDB@BBDDDBDDDBDDHFHDFFFDB@BBHFDDDDFDFHBDBD@HBDFBD@D@BDFJFFDBBDHDHAL@J@FADADCDGBKHCJAHCB@HBL@FA@@@@L@BB@FCHADAL@FBH@H@FDHDJBHDHFFBBJDJAJED@F@L@FA@C@GCK@EAC@E@GAKCM@EBIDIFAJBHBFAD@BACMCI@O@CFCFAFCD@FA@@DAD@D@@@D@D@JADADAD@H@D@D@FDDDB@DDDDDBFDFDFBT@@@DA@A@@@@@A@@BA@A@A@CBC@@@A@A@A@AB@@A@@@A@@@AB@@A@@@C@@@@@A@@@A@@@AA@@@@A@@@@@AA@@A@A@AB@@ABAB@@@BCB@BABAB@@@@AB@@@@@B@@@B@@AB@B@B@@@B@@@B@@A@@@A@ABA@A@@@A@A@@BC@@@A@A@@BA@@@A@@@A@@BA@@@A@@@AB@@A@A@A@A@@A@A@CBAAA@@C@AAA@CAA@AF@FBDAB@BABC@@@A@@@AAA@EBAB@BBDBFBD@DB@BDB@DDBBABABA@A@AAAAAAAAAC@A@@ABABABABAB@B@FB@@CICIAGCEAIAKDGDGLENGLIFOBEDKBKAK@CAKAGAEDGFGHEHCHEBIAI@ACGCGAAGCGBIDEBE@E@E@MCMKEG@@OOEK@@GKCGAEEKCE@CHEFEHBB@JBJHFDBB@@DDFHFHHDFDDAF@DA@A@E@G@G@EBCDAF@B@NADCBMBQHMBAHIFEB@LANDBBFDLJLFLDRDHEBC@KCMBC@ABKFMDIBIBI@G@@BC@@@A@@@@@A@@@ABA@AA@@A@@@A@@@AB@@A@@@A@@@A@@@AB@@@@A@@@A@@@A@@AA@@@A@A@AA@@A@@@A@@@AB@@@@C@EAA@C@E@EAEACD@B@D@B@DBF@D@DAB@AA@A@@@AA@@A@@AA@@@AAA@@@AAA@@AA@@@AA@@AA@@AA@@AAA@@AA@@A@@AAA@@AAA@ACA@@AA@@@@AA@@@AA@@AA@@A@@AA@@A@@AA@@@@IIOKKGIIICM@K@C@SBcIO@G@C@Y\\GFWMQK@@IMIIICQ@ODGDGFGFC@GDIDGHABCJADAP@@BT@HAFCFCBEFIBECMESAC_CUACKQ@AFC@ALGDCDAJGBC@A@@@@@C@ECKAIEMIAI@KFEHEFE@CAOAKIOEIDCHAJ@DDJDJBBBHBPAJAD@J@DBP@DADGHEDKH@@KBC@SAK@ICEGCKAKEKAEEGECCCGCMGIE@@I[EKIEWMACCGCKIk@EAGGO[{AC"],encodeOffsets:[[110636,28206]]}},{type:"Feature",id:"520625",properties:{name:"印江土家族苗族自治县",cp:[108.409751,27.994246],childNum:1},geometry:{type:"Polygon",coordinates:["@@m[EAS@O@I@IHEHGJKFIFEDOHGFEFEHGJKLEFCDKJ@J@HDLH@P@dJTAD@L@N@JDJJLHPLJJ@@@@BB@@@BB@@BB@@@BB@@BB@@B@@B@@B@@BB@BDB@BB@@BB@BB@@@BB@@BB@BB@@BB@@BB@@B@@BB@@BB@B@@BB@B@@BB@@@BB@@B@@@BBBA@CBC@E@CAA@C@A@C@BDBF@F@F@DBB@F@D@@A@@B@@@B@@@BB@@B@B@B@@BB@@@B@@@B@@@B@@A@@B@@@B@@@B@@@BA@@B@@@B@@@BB@@BAB@B@@@B@@@@@B@@AD@@@HAJAJCJENAL@BADDN@LADGFQCKCKEKIECAAMCKBA@EFGJABGNARANCDMBA@E@CBAD@F@H@H@F@BCBE@CBECGCEGEGCC@@AAECIGIAA@GAEFGF@DDFFLBFDHHL@@FLPP@@FHNLNDF@F@F@FAJCHAHDBBDHDH@BBJAJGFGDGFEHCHBFBHBL@DBLALCLAFEPKJMHKFCHCHBLBJDFBHDJDJ@@EAA@A@ABABABAB@BB@D@BBBBBBBB@B@BABABABCA@CCA@ACAC@EACAAAA@AB@FBB@B@@@B@@ADABA@CBEAE@@BBB@DBB@B@DB@BBDAB@B@@@@B@B@B@BA@@B@@@B@@AB@@@B@@@B@@AB@@@B@B@@AD@@@B@B@@@BAB@B@B@@@BA@@@A@@@A@A@A@@BA@@@A@@@@@A@@B@@A@ABABA@AD@@A@AB@BA@@B@B@BB@@B@@@@@B@@B@@B@@@B@@@B@@D@@@BBB@@@@@B@@@BB@@@@B@@@B@@@BBB@B@B@@@@@B@@BB@@@B@@BB@@@B@@@@@B@@B@@B@@@DDBB@@@@@B@@B@@BBBDH@F@LAJ@FCHBFDDD@BFDBFDDDDDFF@BDHDFFJHPBDDFDFDJJNBDFJDDNDHBB@DBD@FBDDFDDBDJBDDJDHBFBDBD@@BBBFDHDDBFBDDFDFDBDBDFDCDAHEDAFADAD@D@BDDHFTJRDHDJ@@JLJNDF@@FHJNDF@B@@B@B@@BD@@@BAB@B@B@B@B@B@@AB@@AB@@@B@@BBB@@B@@BBB@BBD@BBB@BB@@BBD@@BB@BBB@B@@BB@B@@BBBB@@@BB@@B@@@DDD@B@@BB@B@B@BBB@B@BBB@B@B@@@BBB@B@B@B@@@B@B@BBB@B@@@D@@@BBB@D@BBB@@A@@BBBBBDDB@BAB@@CB@@A@@BBB@B@D@@@D@B@@B@B@B@@@B@@BB@@@B@@@B@@BB@@@@B@@@DBF@DA@C@@BA@CBE@C@ABCBAFCBABC@@@AD@@@B@BBB@B@B@B@BBFA@BBBB@B@B@B@@@B@@@@A@@@@BC@C@C@ABA@AB@BABA@C@C@GBAB@B@BBBD@DBFBDBBFDDBD@BB@C@CBC@ABAB@F@B@D@F@D@D@@A@@BA@@@A@A@@BA@A@@@EDGBCDEDAB@HAFBJBD@F@DBH@F@DADABCFCDCDA@ABCBC@CBEAG@GAE@CAE@A@G@@AG@EAGAC@CAG@GBEBCBG@ABEBC@EBE@CBCBC@EBE@EAGAK@E@GAC@C@A@E@GBEAEBCBEBCBEBCDEFCDEFCFCD@H@FDDBDD@FDDFDBAD@BEBE@@B@BA@A@A@A@A@A@@@A@C@CBA@AB@@AB@DAB@BAB@B@B@@@BBDAB@HDLR@@@FDDB@BBF@HCHAF@DFDDBBFADCDEHIJCHBFDHLD@@H@H@DAF@FBDDHBDBFAHAHADCD@BAD@@BDFAFAFCFAFCDAFAF@DBDBFADBF@D@BADCDC@ECAE@G@E@CACACC@CBC@@@CBC@GBC@CBCAC@AACACACECAE@E@G@G@C@GACAAEEECCAEAAAEAA@EAE@G@EACCECCCAEBG@E@CAECCAECCAE@C@EBEDEDADCFADEBEAE@AAEACCEBG@G@EBI@C@CBIBE@EBEAAACACAIHIAO@OAKAI@ECGAGKGAEKCKGKEKIIIIKKEICGEICGCIAGAG@E@E@KAKCKCKCQGOGIIKEKCGAI@I@E@GBCFCFEDIBKBICGEAAGKEQAO@I@KAMAGAEAMAM@IAKACCIIAKCI@W@MAQGGECK@C@Q@M@KBKBG@EAI@CFED@RBJDJBFBLAFCHELAJ@HBJDLBJANELILQJM@AHKFMDKFIFQ@ABIAG@ECGEEIIMKMEQOEIGMGQIEGCCAKEQGCCIAE@IBEDGHGBG@GCGCGCCAG@G@C@KMcE"],encodeOffsets:[[111065,28270]]}},{type:"Feature",id:"520626",properties:{name:"德江县",cp:[108.119807,28.263963],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CBEDEDEDKBIAEGECACAECGAOAM@EMIPGDIFCDABCDCHCHKJEHABGJEFADGJADCNADABCBA@AA@@@A@C@@AE@AAAEAA@A@@B@B@DAB@@A@A@@ECA@ABC@AAA@AEA@A@ABA@AAAECA@@G@AA@GD@@C@AAEAICAACAAA@C@@A@C@AAAB@@A@AB@B@D@BABABAB@@AD@B@@BBDF@DADABIBC@CAC@ECA@AAA@C@AB@BAD@DBD@DBH@J@BABADC@ABC@C@CAC@CAGCC@A@A@@DADGJAAA@CCA@EBBHDDBBB@DHBB@DCF@@@@@B@B@B@DDDDFBF@DBFBFBDBBBBBF@BBABA@@BBBF@D@D@D@D@B@BBD@B@BBH@BBB@B@D@D@BBFDD@BABAD@F@F@D@B@BBFBH@D@D@D@B@DABCBABBBB@@@HAD@BABA@ABA@CB@DAB@DBBDBBB@B@@DBBBBFADAD@DBBBB@DBDDDDDDDDDDBBB@BAB@B@@B@D@B@D@B@BBBBBB@B@BAB@BA@@@@BA@@@@DFBRBPBRBN@H@PAPEL@HBH@FDJBF@@@@BF@LARCX@PA^@PJhPXF@T@H@Z@XELFEFCDEPDDNCLDJ@FAXEPAFBJDJDHALKZSF@FBBJFJ@@@JCHQJMHUBSVCDFHLRCRDPPPJ@JGHCJ@LBDBB@FDHB@ADGAGACGEE@A@E@M@OAEACCBKB@FDBDJBH@LCFADABI@GDCFCDCFEDGD@F@DA@ACCKCKAECEGAIBABAD@FBH@FMFGHIDADCHAHBDHBBDFDHFJHLHR@@HNFNDFDFDLBLEFCFGDGFGBEBAFHHHNDHBJBH@JCH@BEFEJGJ@LDJFHJFLFHF@@DF@L@JCHABIJGJKFGBIFEFCHBBBDDDFHJFCFCHABCDADEFADAJ@HHHLFFFB@@@BBBDFFBD@@B@BBBB@@BBB@@@BBB@BBB@BBB@@@D@@BB@B@@BB@FBDBB@FBB@BB@F@@BB@D@@B@@@B@B@@ABA@@BA@AB@@AB@@AB@@@B@B@@@B@B@DB@@B@BB@@D@@@BBB@@BB@B@B@BBB@@@B@@BB@B@@BB@@@B@B@@BB@DB@BB@BB@@BBB@BB@@B@@B@@BB@@@@B@AA@A@@@A@@AA@@@@@A@@@AAA@@AA@@@AA@AA@AAA@@AA@AA@@@@AA@@AA@@AAA@@AA@@@A@A@A@@A@@A@@@AAA@@@A@@AA@@B@@@BAB@B@B@B@B@B@B@B@DBD@B@@AHADADCDAFCBCDEDCBCBABCBEBCDGBCBEBEBCDCHEDA@AH@F@D@DBD@FBF@DCDA@CCCGCCCAE@EBEBEBGBABCDEBEBC@E@EBC@C@CDE@ADCBCDCDCBCBCBEDE@CCECCGQEMGKGKCGGOCEB@FCLCFEDG@IBCNBH@HBBC@KAE@CAE@CAI@EAIBCBABEFAD@FADCDABABCDEBABC@C@EAE@C@ABCDCH@BBDDBHBJFBHAB@FCF@HDLDNBNDD@F@J@JBF@BACAEECEBC@@NIDCLELEDAHCFALGHC@@DCHCJGDCBCBE@E@AACAIAE@EEAE@IAE@M@E@GAE@E@CC@AFEBK@IAC@A@C@ADGDEDAJGBABCBGBKBIJGJEB@JEBADABA@ADABAF@D@HBF@F@HAF@D@F@H@HB@@DBF@F@DBF@FADAD@HCDADABABCBEBEDEDEDCDCFCFABADAFAFCDECECACACECEACAECCCGAEAA@@ACACAECGCIACCICAECCCEAC@CAA@GAMCCCEIACIMCICECEACGOEICECG@AEECCCCECCAAEC@CCAEDG@EBI@K@ECGAA@AA@@@@A@@@@AACC@@A@@@@AA@@@@@A@@@A@@AA@@@A@@AA@@@@@A@A@A@AA@@A@@@A@@@@@AA@@A@@@@@A@AA@@C@@@@D@@@BA@@B@@@B@@@BA@@B@B@B@B@@AD@D@B@BAB@@@B@@@@@BCB@@S@EAECECCACCCCA@CCECC@C@G@C@CBCBIBC@C@@@C@C@CB@@EBC@EDEBED@D@PDJDNABC@EBGAIAEBCJAJ@FDNBL@H@FBD@FDL@H@DEBK@E@C@IFIBICAAEECGAGCICG@E@GAG@EBKBCDG@EAAK@@@@@EBK@GAA@GDIBGDALCHCDCBEBI@K@GBGCACCAEEEIACC@C@EAAC@GACACEGECCCCCGEAAA@ECEEGCGECCCACCCACCAAA@CCIAC@E@IAEBGAA@ABG@E@I@C@EAC@EAMAI@GCICA@A@IAEAAAGAEAG@OCGABC"],encodeOffsets:[[110550,28715]]}},{type:"Feature",id:"520627",properties:{name:"沿河土家族自治县",cp:[108.50387,28.563927],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@BA@@FAB@TEF@HAHA@@BABA@AD@FBD@FADADABCB@NCHCDADCBABA@A@@BEBKBABAD@FBB@T@FBB@BBBBBBTJJFDBB@B@H@DA@BB@@D@BJBFBFBB@D@B@BA@ABCBC@AHMBABAB@B@F@B@@@D@D@BADCB@JCBAB@@@@B@@@A@@@@B@@AB@BABABA@CCEAEA@A@CA@@@@@GBC@A@@B@@A@@B@B@B@BABAB@B@D@B@DBJ@B@BBBB@B@B@@BDFDDBFDBBDBBHDJ@D@J@N@BBB@BBFBBDDHJ@HAD@@AFDFDDN@RCTGLCHGBA@A@A@AA@@C@AAA@A@@@AAA@@ACAGACAAAEAGAE@ABC@C@A@@BCBC@ABEBCBCBCBABA@@BC@ABCBC@CBC@A@AAA@C@A@C@C@A@A@A@A@A@@@@@A@AB@@ABA@A@@B@@A@@@@BA@A@A@A@AB@@A@A@A@A@ABA@A@A@A@@@ABA@@@ABA@@@A@A@@BA@A@A@A@A@ABA@A@A@C@A@ABC@C@@@ABC@ABCBCFAFAF@DAB@DABAFABADA@ADCDABCBCBC@E@C@A@E@E@A@C@G@E@I@EDE@A@@DIBABCBABA@AACAGAIBI@EAEACAAEECEACAGBIBEFKFIBC@A@EAE@E@A@E@EAC@@@A@@BA@@@@@AB@@@B@@@@AB@B@B@B@B@BA@@@@B@@AB@@@BA@@@@B@@@B@@@B@@@BAB@B@@@B@DA@@B@@@FA@@B@B@B@@A@@B@@@B@BA@@@@BABAB@@CBABC@EBE@C@A@GACAIAC@EACAAA@C@@AABCAAAA@@AAA@@AA@@@A@@AA@@@A@AAA@@@@@@@AAAA@@A@@AA@@A@@@A@@AA@@A@@AA@@@@@AA@@@@A@@A@@AEC@@CA@@CCAA@CAC@A@AA@@@@@AA@@A@AA@@@@A@@@AAA@AA@@@A@@@C@@@A@A@A@@AA@A@@@A@AB@AA@@@A@A@A@@BA@A@A@@@A@@@C@@@A@A@AB@@C@@@A@@AA@A@AAA@CAA@A@A@AAA@A@AAA@AAA@ACE@AAA@AAAAA@AA@@AAA@AA@AA@AAA@@B@@A@A@@@A@@@@@@B@B@@A@@B@BA@@B@B@D@B@DAD@FDBBD@BADAFCFEDABAFAFAHCBAJEDCHEFCB@DAHAJALCHAJADAB@FABALEFCHCJCJCH@HBDBDBF@BAD@@@JGFCDAF@@@F@F@HDJBF@BAFADABAB@@@B@B@BBB@BBD@@@BB@BA@AB@D@B@B@BB@B@BBF@DBDBHBDB@@BB@BBB@B@B@@BB@@B@@@B@@@D@B@B@B@@BB@@BB@BB@@BBBBB@BB@@B@B@@AB@B@B@@AB@@ABAB@@@BAB@@@@@B@B@D@B@B@BBB@B@B@B@B@@@B@B@@BBB@B@BBB@B@FBB@BBB@B@@B@BB@@@@B@B@B@BAB@B@DABABABA@@BABA@ADABABABA@AB@BA@A@A@AAA@AA@@A@A@A@@@ABAB@BBB@B@DBD@B@BA@@B@B@BA@ABA@@BCBAB@@AB@B@@@D@B@B@B@BB@@BBB@B@BBBBB@BBDBBBB@B@BBB@B@D@B@BAB@BAB@B@BA@AB@@A@@BA@A@A@A@C@AA@@A@A@A@A@@@ABA@@@A@@@@BA@@AA@AA@@@AA@AA@AA@AA@CAA@AA@AA@@A@@AAAAAA@@AA@@A@AAA@@AAAAAAAAA@AA@AA@AAAA@@AAAA@@AA@@AA@AA@AAA@AAAB@@A@A@A@A@ABA@A@A@A@A@C@ABA@C@E@A@A@CBC@E@C@A@A@CBAA@@ABA@@@ABA@A@A@CB@@A@ABAB@@AAA@AAC@@E@G@IAG@E@E@A@EAEAKEBA@@@A@@A@A@AA@@A@@A@@@AAABA@@BA@@@ABABA@A@@@E@A@C@C@ABE@ADABAB@AA@AAA@AA@@AAA@@AAA@@AAA@AB@@ABA@@@CBCBABABCBA@ADCBAFG@@@CBABA@A@AAA@A@A@AAA@@@A@A@A@A@@AABA@A@@B@@@@@BA@@B@BA@@B@B@BAB@BA@@BA@@BA@@@@@A@A@@@A@A@@@A@@BA@A@A@A@ABA@@@CBABA@ABAFCFAB@@C@EACAC@C@ABADA@A@GAE@EAE@AAAEAG@G@G@KAA@@AA@@A@@AA@@@AA@@A@AAAA@@AAA@A@@A@@A@AAA@AA@@@@A@AA@@A@A@@@A@A@@@AA@@C@AB@@A@@@A@A@A@@@E@EBABA@ABA@A@AA@CAAA@A@@BABEBO@E@@@CBCBA@@B@B@D@B@@@B@@@B@B@BAD@B@B@B@D@B@@@B@BAB@D@@AB@BA@@BA@@BABA@A@@BA@ABC@@BA@A@A@A@A@@@AB@@A@@@@A@@A@@@A@@A@@A@@@@A@@ABA@@@@@A@@@A@@AACA@AAAA@@A@@@AAA@@@A@A@A@@@A@@@A@@C@C@E@C@A@E@A@AB@BAD@D@DAAC@CAECAAACAE@CACAAA@A@AB@H@D@DABABA@@BAB@B@D@DAD@@@@@B@@A@@@A@A@A@A@AA@AEBAAA@A@A@A@AAA@@@C@@B@@ADABEDABAD@B@DAF@DAB@@@DCBE@CA@@A@@@@@AA@@@A@@@A@@AA@@@A@@@A@A@AA@C@@@C@A@A@AA@@@BA@@DA@ABA@CCAAAA@AB@@@AA@A@CAA@A@@@C@@@AAA@A@A@A@@@A@A@AAA@A@@@A@AAA@A@AAA@A@A@AAA@@@ACC@C@@@AA@@A@@AAAA@@@AAA@@@AAA@AAA@@AC@AA@@AAA@AAC@AAA@AA@@@AA@AA@@@A@@BA@@BA@A@A@A@A@A@AB@@C@@AA@A@@@@ACEIMEG@@CEIMIK@@CICGIQESCGACC@C@CBEBCBGFCBCDCFEDEBCBABEBEDCDCDCFCFAFAFADABCBCBGDC@CBEBE@CAE@E@CA@@GAG@E@C@E@GBE@E@GAC@E@ABCB@BABCBABIFA@IFIHAJALAHADABIHCBCFCH@B@D@BBD@JALEF@BDDF@F@HBF@N@F@JBF@FB@FBFBJBD@B@FAFADCDIHGDCD@@GDKHEBGDCBKFKFCDMJ@@ADDFFFDBABE@IAI@E@C@MCMAKCGCE@EDA@GBEAAIAGCCAAG@CDAD@B@DBF@F@DADABCFADABCBCDEBC@EBAFABADBJ@FBJ@DBF@DBF@LADGAG@MAAD@JCHEFKDEDA@DFHPDHHLHLFNHRDDDF@DCFAFADADCDCDADCD@BCF@D@DAD@F@FADAFCFADABAHAFAF@FBFDDHDDD@DCBCDE@EAC@CAC@E@G@@BCBGFCDADAFAFADCHADAFADABADCDCFADEDCBCDCBGB@BA@C@CAA@A@A@A@A@A@A@A@AB@@A@@@BB@@@B@@BB@B@@@BB@@@@B@B@B@@BB@@BB@BB@@BB@@B@@B@@BBB@@BB@BBBB@@B@@BB@@BB@B@@@B@@@@BB@@@B@@@BBBA@BDB@@BB@@BB@@BB@@B@B@BA@@BAB@@@BA@@BABCB@B@@AB@@@B@@BB@@BB@BB@@BBBB@BBBBBBBBB@BBB@BBBBB@FDDBBBBBBBH@D@FDHDLFDDJHDFDHBJ@FDJFLDFBJ@N@F@NARBJDDFBD@D@B@FBBHDHDFBDHHFDFFDH@N@F@@A@@B@@@@AB@B@@A@@B@HBB@B@D@DAB@B@@@BA@@B@@@B@@@B@B@@@@BB@@@FAD@D@B@@AB@@@BA@@B@@ACA@A@@AA@@@AAAAAA@@@AA@@@@@@A@@@@AA@@@@@@@A@@A@@A@@@A@@@@A@@A@@@@@@@A@@A@@A@@AA@BA@CAAACA@BABCJ@BABABA@A@A@@B@B@DAB@B@BAB@BC@@BABABABA@@BABAB@BA@@DBB@B@B@DAB@@A@@@A@A@ABEFAFCFGHEDGDMFCBEBEHABGHCH@NDNDVFR@J@BBFD`@JCLADEHEBCDEBMFIHGHCF@H@DBD@DBBAHCHEFEFIHEJABEHA@IHGH@J@D@H@JAP@BALCTAH@@@F@HBBB@DBBD@B@@@BAD@BB@@B@B@D@@ADADAB@B@DAB@@ABCDCD@D"],encodeOffsets:[[110664,29785]]}},{type:"Feature",id:"520628",properties:{name:"松桃苗族自治县",cp:[109.202886,28.154071],childNum:1},geometry:{type:"Polygon",coordinates:["@@BK@E@AAMECCACA@C@A@ADSBEAGGGEEEI@KCKGGIIECAC@E@AAG@A@EBC@C@CAAEEGGAAAG@MCM@@@C@ICSAI@G@@BED@D@F@BB@BDFDFHDJBHBDBDJDJB@BJ@B@@FHBBF@LAFCDCBA@AFAH@D@BABCBAHEFADABCBC@A@A@@DADCDABC@C@@A@A@@AA@@A@A@AA@@@@@AC@@@@AAA@@@@A@@@A@@@@@A@@A@@A@AA@AA@@@C@@@@AA@@@A@@A@@AA@@A@@AA@@@A@@@A@@@A@AA@@@@@@AA@CAE@IAK@C@C@CAIEGEIGCAIEAAACBE@ABGBG@AAAA@@A@A@A@AA@C@A@@AAAAAA@G@E@CCCE@CAE@A@ABCFGDC@CBG@EDC@ADCBC@AAAAC@A@ABEBCBC@CEQ@CDEHGDGBAAG@CCG@A@E@CBGDCDA@A@CAA@C@@D@BBFB@CAEEECCGCEAE@A@ECAAACAA@@A@AD@D@BA@AACAAAA@@ABA@@AA@@GBCBCBCDABAAC@EAAAA@A@AB@D@BA@C@AAAAAAA@@BABAFADA@C@E@Y@EAA@C@@@@@AAA@@@A@AAA@@@A@@@AA@@@@A@A@@@@@AA@@@@A@@@@AA@@@AA@@A@A@@@AA@@A@A@A@@@@@AA@@@@@A@@@@@AA@@A@@@A@@@@AA@@@AA@@@@A@@@@A@@AA@@@@@ABA@@@AB@@@@AB@@@BA@@B@@@@A@@@@@A@@@@@@AAA@@@AA@@A@@@@@@@C@AAA@CBE@EGCCAAA@BAB@DDDBDBB@B@BA@@BAA@@A@@AA@@@A@@@AAA@A@@AA@A@A@@@A@AB@@A@A@@@A@@@A@@@ABKCGBMHIHGHGFABEDWRMFIBIBA@QCKCECCC@@KAICAFGBKBKAGCAC@@BG@EDGBIIIEIGGKEGAKCKAGFAF@JBLDNDLFHHBHDNDFDFFHHDHHJHJBBDHBDCBCDEDA@C@GDODMBMBC@A@A@@AAAA@CCAA@@AAAA@AAACCECGAKBGFGHDNBHCHCB@ACAMGGCA@@GJYEIGEK@C@IDA@C@E@@@A@A@C@A@@@A@A@A@@@A@A@@@A@A@@@@@AA@@A@A@@@AAA@A@@@@@AA@@A@@@AB@@A@A@AB@@A@A@@@A@@@AB@@AA@@A@A@A@@@I@C@A@AACCCCAAAAA@ABA@@@AD@B@B@BFF@BBB@F@D@BA@CFAB@@AJAB@BCDMBK@K@ICGGEICEEA@@@@AB@@@@AB@DA@@B@@@BA@@@A@@@A@@@A@@@ABE@IBMAGA@AEAEAQCMDGJ@LFHB@@HCHIDA@IBGFADENEJGJIHMBKECCCCIEKBGBEFEJIJKDOBY@LHBHDH@FBJBL@PBPGJBJBDBDBBAF@FAFAJ@D@DAJ@F@HAHDFBDBF@BBFAFCFEBCDCBCFAF@F@DBFDDBFDDBF@D@FAHBFDDFDDDFBH@F@FBB@FBBBFBDBFDFFBBBD@H@D@H@H@FBFFDBDBDBD@BBDAD@DAD@HAD@D@@AD@DDDDBDBF@H@F@DB@FCDCDABC@E@CAEBCACAE@EBCBEDEBEDEBEBAC@@BC@ADCBCBGBGAEACCGAC@EBE@C@G@GC@GKECGAIDGJCFCDEBAACCCEE@GBGDE@AAA@CC@E@@KQGCA@CBAA@@A@A@A@ABA@CBA@@BA@@BAB@D@D@B@@@B@B@B@B@BABA@@@AFAFC@ABECCC@ECCCAECG@C@EDEDCFEDCFADAFADAFADBFAF@H@F@B@DBD@H@FBLBH@FAF@FADAD@DAF@FADAF@BAHADAF@HBH@DBDBH@FBH@@@H@BBF@DBF@HBHAF@DADAD@BCBCDEDADCBCBE@G@CAE@C@IAEAGBA@CBCFADCH@F@@@BAB@@@B@B@@AB@@@B@@@B@@@B@@@B@B@B@@BB@B@@@BB@BB@BDBBB@@@B@@@B@@@@AB@BB@@@@@@BB@@@@B@@@BB@@@B@@@D@@@B@B@B@B@@BB@@@BABBB@B@BBB@B@B@B@BBB@B@BBBB@@BBBBDBB@BBB@B@BA@@BA@@B@BA@@B@B@DB@@B@BBB@D@FBB@D@D@B@D@BBB@B@B@B@@B@@BBBB@BBBB@@BBBBBB@@BB@@BBB@B@B@B@B@@@B@BABAB@BA@@BA@A@C@@ACAA@A@AAA@A@AAA@A@AAA@@BA@@BA@@B@B@B@B@@@F@B@B@@@BBB@B@BBD@B@@@BB@@B@BBDB@BBBDBB@@@B@D@B@B@B@BB@BB@@BBB@DBB@@@@BB@@B@@BBB@B@@@BAB@B@B@B@B@BB@@BB@@BBBBBBD@B@DB@@B@BB@@B@@B@@@@@BBBBB@@@@BB@B@B@B@B@B@BABA@@BA@@@A@@BA@@@@@AD@B@B@B@@@BAB@B@DAD@BAB@@A@ABAB@@AB@B@@AB@@@@@@E@A@A@A@A@ABABA@@BA@@BA@@@@@A@A@@@@B@@@B@@AB@@@BA@@J@BAB@BA@ABA@ABA@ABA@@@ABA@ABA@A@ABA@ABA@ABA@AB@@ABA@AB@@ABA@ABA@@B@B@@@B@BB@BD@B@BBB@B@D@@BB@@BBD@BBB@@BB@BBBBBBB@BBBDDBD@FAJC@@B@D@FDFBF@FBDBDDDBD@B@DAF@D@B@DB@@BB@BBD@BBB@DBB@BBD@BBB@BBB@B@@BB@BB@BABAB@@@BA@@BA@A@@B@BAB@@AB@BAB@@AB@BA@A@@@A@@@A@@@A@@@A@A@@BA@A@A@A@ABA@A@A@@@ABA@A@@BA@ABA@@BA@@BA@@B@DA@@B@B@@AB@B@B@@@BAB@F@B@@ABCFADC@C@C@CA@@@A@@AA@@AA@@A@@@A@@BA@@BA@@B@BAB@@AB@B@B@BADAB@BAB@BA@@B@BAB@BAB@BA@@BAB@B@BA@@B@B@B@BA@@@ABA@@@ABABA@@@AB@@A@A@@@@BA@@@A@AB@DBB@@A@@@@AA@@A@@AA@@AAA@@@A@@@A@@@@AA@@A@AACCAA@A@AAA@@AA@A@AAA@AB@AA@@@@@@A@@A@@@@AA@@@A@@@@AA@@@@@AA@@@@A@@@@AA@@@@@AA@@@@@@AA@@@@A@AA@@@@A@@@@@@@A@@@A@@@AA@@@@A@@@AA@@A@@@A@@@A@@BAB@@AB@BAB@B@@AD@BAB@B@BAB@@@B@B@DAB@B@B@D@BA@@BA@@@A@A@@@@@A@A@A@@@AA@@AAAA@@AA@@AAA@AA@@@@A@@@AA@@@@A@A@A@@@ABA@A@A@A@@@A@@BA@A@A@@AA@ABAAA@A@A@A@@@AAC@A@A@@AA@@@A@@AAA@@@A@@AA@@@AA@@ACAA@@AA@@@A@@AA@@AA@@AA@@A@@A@AA@@A@@AA@@@AA@@A@A@@@A@@BAB@BAB@@@B@BA@@B@@AB@@AB@@A@AAA@A@AAAAAACAA@@AA@AA@CA@@A@AAA@AAA@@@AAA@@@A@CAA@A@AA@@A@@AA@@@AA@@AAA@AA@@A@AACEAA@@CA@A@@ACAAGAAAA@AAAC@CAC@C@@@A@A@@BA@A@@@A@A@ABA@A@ABA@A@AAA@AB@@A@@BA@@BA@AB@@AB@B@@AB@B@@@BA@@B@@ABA@A@A@C@@@C@C@A@C@C@C@A@ABAAA@ABC@A@A@AB@@AB@@@B@@@B@B@B@@@B@BBB@@BB@B@BAB@B@BAB@@AB@@A@@B@@ABA@@BA@ABA@A@ABC@A@A@@@A@A@AAA@A@@@A@AAA@A@A@A@A@C@A@C@ABA@@@ABABA@@BA@@B@B@BB@@BD@@@B@@BB@B@BB@BDBB@DBB@@@BBB@B@@@B@BBD@B@B@@@BBB@@BBBB@@BB@@BBD@B@BBD@BBD@@BB@BBB@B@BBB@B@B@BBB@B@B@@BBB@BB@@B@D@@B@@B@B@BBB@B@B@@BB@B@BBB@@@BBB@@BBB@@@B@B@B@B@B@BBB@BA@BB@B@B@BBB@@@B@BBBA@ABA@ABA@AAEBA@AACBA@A@A@AB@@ABA@C@@BA@AB@BABABA@@BA@AB@@A@A@A@A@A@A@ABA@AB@@A@@BA@@BABAB@@AB@B@BA@@@@BA@@BAB@BAB@@@B@BAB@@@B@B@B@B@@@B@@@BBB@BBBBB@@BBBB@BBB@BBB@B@DBDBB@BBD@B@BBBBB@B@BB@@@@B@BBB@B@DBDBDB@BDB@@BBB@B@B@@@B@B@B@BAB@DAB@B@@@BAB@D@B@BAB@B@B@BB@@BBB@@@B@@@B@B@B@B@B@B@B@@AB@@@BABAB@BA@@BAB@@ABA@ABA@A@@@AAA@@@@AA@@A@@AA@@AAA@A@@@A@AAA@A@@@A@@@A@ABA@@@A@@BA@@BAB@BA@@B@B@BAB@B@B@DAB@B@B@BAB@@@BAB@B@BAB@B@B@BAD@@@BAB@B@@AB@B@@AB@BA@@BAB@@AB@B@@AB@@@B@BA@@B@B@B@BA@BB@BAB@BAB@BA@@B@BA@A@@BA@@@AB@@A@AB@@AB@@A@@DEBA@@B@B@B@B@@@BBB@B@B@B@B@B@B@D@B@B@BBB@B@BBBBBB@@@BB@BB@DBB@BBB@BBD@BBB@B@@@BBB@B@@@B@D@B@BAB@B@@@B@@AB@BAB@B@@@B@B@B@@@DBB@@BB@BB@@B@@BBBB@BB@BDBB@@BBBD@BBB@@@B@BB@BB@B@@@BA@@B@BB@@BB@@@B@B@@AB@@AB@@ABAB@DA@@BBB@@@B@BB@@B@@ABA@A@AB@@AB@@A@A@A@@@A@A@@@A@A@ABAB@@AB@D@B@B@BAB@BA@@B@BAB@B@D@B@B@B@@BBAB@@@B@BABA@@BABA@@BABA@@BA@ADA@@BA@@BAB@B@@ABAB@BAB@@AB@B@@AB@@@B@B@B@@@BB@@@B@@BB@B@@BB@B@@@B@B@@@BBB@B@B@@BB@@B@B@@@D@@@B@BA@B@@DBB@B@@B@@@BBB@@@BBB@@@B@B@B@B@B@BA@@B@@@B@@@DAB@B@@AB@B@BAB@@AB@BAB@@AB@@A@@BAB@@AB@@A@@B@@ABA@ABAB@B@@ABAB@@@B@@@B@BBB@B@B@@@B@B@BA@@DA@@B@@ABAB@@@B@@@B@@A@@B@B@@BBB@@B@@BB@@@B@@@B@@BB@BB@BB@@@B@B@B@B@@B@D@D@B@B@@@DB@@B@BBB@BBB@@B@@BD@BB@BBDB@@BB@@BBB@BBB@B@@BB@@@BBDDB@@BBB@BBB@B@BB@@BAB@@@BAB@@AB@BA@BBB@BBB@@BB@BBB@@BB@@BB@@BB@@BB@@BB@@B@BBDBB@B@BB@@BBB@@@BBB@@@BB@@BBB@@BB@B@BB@@BB@BB@BB@@@@BBBBB@@@B@@@B@B@@@B@B@@@B@@@BA@@B@BAB@@@@@B@BA@@B@@@B@B@@@BBB@@BBB@BB@BB@BBBB@@B@B@@BB@@@BB@@BBB@@@@B@@B@@B@B@BB@@BAB@@AB@BA@AB@@ABA@CBA@CD@@A@@BA@A@@@ABHD@@FFBD@DBDBDBB@DB@BD@BBDBD@B@@@B@D@@BB@@@BB@@B@@@B@B@@@@@B@@@BA@@B@@@B@B@B@B@@@B@@@B@@BB@@@@B@@@BB@@@@@B@@@B@B@@@BAB@@AB@@AB@@AB@@@BA@@B@B@@@B@@@BBB@B@@@BBB@@D@B@B@@AB@FA@@@@BAB@B@@@@@@@BDBD@BBD@H@@AHA@@B@@BB@@BBB@JA@@D@B@@@DFBB@@BFBDA@@@@BA@@BA@@BAB@B@@@BA@@B@BB@@BB@@B@@AB@@@@@@BB@@@@B@HB@@B@@@B@@@@BDB@@@B@@B@@D@B@@BB@@DD@@@@B@@B@@@@@B@B@B@B@B@B@B@B@B@@B@@@BB@@B@@@B@B@B@BB@@@@B@@@@@@@@BB@@B@B@@@@@BB@BBB@B@B@B@B@@@B@BBBB@@@BB@B@@BB@B@B@B@BA@@B@B@BAB@@@B@B@BAB@@@B@B@@ABA@ABAB@BAB@BBB@@@BB@BBBB@BBBBB@B@B@BBB@BBB@DBDBF@DDD@BBDBDBB@FDB@BBBB@@BBB@B@DBB@@@@A@A@A@C@A@AB@@ABA@@BAA@@AA@@@A@ABA@A@A@A@AAAAAA@A@C@AAA@CA@@A@@@A@A@AACACAC@AA@@A@AB@@A@@@A@A@@AA@@@@@AB@@@@@BB@@@@B@@@B@@@@@@A@@BA@@@A@@@@@@@@A@AAA@@@AA@AAA@@@A@AAA@AAC@@@A@@BA@A@AAA@A@A@@@AA@@@@@@A@@@ABC@@@@BAB@@A@@AA@@@@@AA@@A@@@A@CBA@A@A@@BC@A@@@AAA@A@@@AB@@@BA@@@A@C@A@A@A@A@A@@@@BABABA@@@A@A@@@A@@@A@@A@@@@A@@BA@@B@@@@A@@BA@@@@AA@AA@@A@@@A@@@@BA@@@ABA@@@ABA@@@A@AB@@ABA@@@@BAB@@@B@B@B@B@@@B@@@BABA@@@A@@@A@@A@@@A@@A@@A@B@@A@@BAB@B@@@B@@@B@B@B@B@B@B@B@BABA@A@A@A@A@@AA@A@A@A@A@A@@@AAA@AA@@A@@@A@@@AAA@@CCAAA@AA@@AA@CA@@ACCCC@@AA@@@A@A@@@AAAA@ACAA@@AA@@@A@AB@@@@A@@@C@@@ADE@CBA@A@A@@@@B@B@@@B@B@@@BA@@AAA@AAAA@@@A@@@AB@@A@A@@@A@@B@@@@A@A@@@A@@A@@AA@@A@@@@@A@@B@BAB@@@B@@@@AB@@@@@@@BAD@D@BADABC@C@@BABADAFABABABABABA@ECGCE@C@@BABADADAFAB@DAFBLDFBDDB@JHHF@@FLBBB@B@F@F@DAJEHAD@FADADA@ADB@@BAACEECGEEAACG@ABCB@B@B@D@B@BBFHDDBB@BDBF@BBDBF@VEFAF@JBD@@@@@@C@EA@@@K@IACAECMKECCAC@A@AA@@@AB@FCB@@CBAAACGEKECAAEAC@AAA@@@@CBA@ADCBC@EAEEEMKGIAAEKCK@C@@@CDU"],encodeOffsets:[[111967,28856]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
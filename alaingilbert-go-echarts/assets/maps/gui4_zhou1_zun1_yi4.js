@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("遵义",{type:"FeatureCollection",features:[{type:"Feature",id:"520302",properties:{name:"红花岗区",cp:[106.8937,27.644754],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAAAADA@A@A@@A@AABCCG@GDEFE@ECACBA@ABAA@AAABA@ABAA@A@A@ABA@A@AA@A@C@ABCDABAB@B@D@H@D@BA@CDAHFNLNDDAFFFANBBA@ACA@@@ABABEFABABADA@CB@C@C@AE@E@E@C@I@C@ABC@ADBD@B@BDB@B@BB@@BBDBF@@@B@D@BA@ABAB@@A@ABAAC@@@A@@AEDCIEBCB@DBDBB@BID@BABA@C@CAA@@AAA@@@AAA@CCEA@ACAC@E@A@EACAAA@@@IBAFCJCF@FCBM@CDAFG@G@GEMI@AIG@CA@ABAB@B@@DJBBGHABAB@B@@CBA@A@A@@A@@A@CACA@@CACAC@AAA@@@AACA@@AA@@CA@@@@A@@BBD@D@@ABAB@BABEAG@OLAH@DABE@ECAAE@EFADGLCDCBEFADAFCBKCA@CBMBA@ABAFFL@BAD@B@DBB@DDBBD@BAD@B@B@BBD@BABADAB@@BBB@B@@@H@FBFDFDJD@@DD@JAH@DBL@FAFABCBADEFEBGDID@BG@CBAF@FADEBIBAFBDFHFHDB@BB@B@B@@@DCFBD@FF@@@@FFBD@DDBDBJ@D@F@D@BA@EBGFCF@LAF@B@@@FFFDFHBDBBBBB@BDHDLHBHDD@@BBDDB@H@D@HEHKDAF@DBHDH@D@HAD@D@D@PAHAN@DBDBF@D@B@@AB@B@D@@@H@DBBBBBDABADCLED@BBDA@@BAAC@A@I@C@ABABA@ADCB@@@BA@AB@DA@ABABABABC@@@AAE@C@@AC@@AA@C@ABA@AAC@CA@A@CAAAC@A@@@A@C@AAAAC@ECAAAAC@A@CB@@@ABA@ABABAACBAD@DA@@BC@AHG@ABCAA@AF@@AAACAA@AAKAC@EBGFEAC@C@A@BEBAB@@AAEAAA@@CB@DCD@D@JHBABCB@@CA@@A@A@C@A@ABABED@BB@@D@BBDDD@BBDBF@B@B@BAB@B@B@B@BBB@B@B@BEB@BABBB@B@B@BAB@@B@@DB@@B@BDBBBB@BDB@@@@BB@B@@@@FBBBDBD@D@@@BADABBB@BBA@B@@@@@@@@@@@B@@@@@B@@@@AB@B@@B@B@@@@B@@@BB@@@B@@@@@@@@BB@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@@@@B@@B@@@@@@B@@@@@@B@@B@@@@@B@@@@@@@B@@@@@@B@@@B@@@@@B@@@@@BB@@@@@@@B@AB@@@@B@@@@@@@@B@@@BD@@@@@@A@@@@@@@@B@@@@@@@@@@@B@@@@B@@@A@@B@@@@B@@@@BB@@@@@@@@@@@B@@B@@@@@@@B@@@B@@@B@@B@@BB@B@@@@AB@B@@A@@@@B@@@B@@A@@@A@@@@@A@@BA@AB@@@@@BA@@D@FC@@B@@@BAB@DAB@BABB@DADA@ABCBBH@BBBBBFBFA@BB@BBBBBB@B@B@BBBABBF@B@BBB@B@@BB@@BAD@BAD@B@BBBB@@@B@BA@@B@B@BDB@@@DBD@DBB@B@HB@NEHAFBH@J@FDHJNFB@FB@@D@B@BADA@ABABAB@BAFEBABAF@@ABABCDED@D@F@B@DAB@HBJBD@BANIFEB@@DBFBDBBDTBDB@B@FADADCD@LFDB@B@N@DBBB@B@DABCTi@C@AF@JAHAJAD@@@F@FAFBBBABEL@DBLAFCF@DBFHHHFDDFHAF@D@F@DCHKBIBEDED@FDDDBFDLBB@BB@B@D@HBBHDBB@B@@ABC@ABAD@D@DB@@BDDD@@BB@BBB@BB@BBDAF@DGHABCFEJEHABCBGJCDCDA@ABAABB@@BB@B@BA@JBD@TBFBDB@DDDFDBBBBDHB@@@BA@A@EGMGS@EBCFABA@A@A@@AAA@A@BANCD@JBDAPBBBTDRLPFRBR@DAREPCD@JARFDDBBBHBHBDDDHBJDLBL@@@L@F@XHFBPBZHNNJJNLRLDBLDJFHDDDBFJGLKPKLGD@DADAB@FC@@DABCBA@A@@BA@A@AAA@AACAAACA@@AAACCEAAA@@A@AAA@@A@A@@@AB@@@B@BABAF@B@B@@@@@@CACAA@A@C@@DCBABA@@@A@CCAAC@CAA@AACACAAAACC@@@A@AFBD@D@FCBA@@BADGDABCB@@A@C@ACEAACC@ACAAA@CACAE@AC@AAC@@EB@ACC@CBC@A@@A@@@C@ACCCCACAA@@AC@AAA@@AA@A@@AA@AA@A@ODC@A@AC@AAE@AAA@@ECG@@@C@GACA@AA@@AAC@@B@@ABABA@C@A@A@@@ABA@C@@@AAC@@CA@A@@AEAA@@@@@AAA@@ACAA@@AA@A@@AAIGEEIGMKIIMK@AGECCKKIQAWXODKCYCCIGC@OCMYaSIEA@AMOOKMGMAAE@EACAGAEAC@AEEAAA@CAAC@ABAA@A@@ACC@@AA@A@ADC@AA@CBAAA@AAAG@GAAC@CICECEACACBCBA@@CAACAKBAD@@A@@@A@A@ABBHA@AAG@ABANA@CDCAE@EDAFDHEDACEB@FEC@AC@EDADABABC@@CC@ABACBABCC@@ABCD@@AA@AABA@AAA@@@@DC@ACAA@@AB@@AAADAAACBA@CAAB@GB@B@BB@@B@B@BCAABA@@A@@AC@@@AAB@@AAAAA@CAAB@BAAAA@@AA@@A@CAAAA@E@CDACEA@@@BAAAA@@AA@AAAAAAA@ABA@A@@AA@BAA@@AC@C@A@CBCC@@EACA@@C@A@@@AAA@ABACA@A@@@ACAC@A@@AA@@CBAB@A@@@@B@@@@AB@@A@AAA@@@@@@@@MDBB@@@@@@@@ABC@@@@BA@ADA@@@@@@@@@@B@@@@@@@@@@BA@@@B@@@@B@A@@@@@@@@@@@@B@@A@@A@B@@@@@@@@@@@@A@B@A@@@A@@@@@@@@@@D@@AB@@@AA@B@A@BBAA@@@AAAAB@FBB@BBDBBABBB@H@BA@EBE@@DDDB@DD@DDBC@@BA@C@ABCCC@A@EAABE@@BBD@D@BFF@BFDAHFD@@HB@FAD@@@F@BC@@AE@B@ABAAADABCBBA@AAAA@@AA@A@@BABCACBAB@DCBAAA@@BBB@D@@@BBDEDBDAABBC@ADAB@EAAAGCGAEC@@BCBAHEHCDK@G@CBCFC@A@CAMSEACHA@EEEGECAA@C@ABA@ABC@EBE@EA@CCAAC@EAACAA@AGCGCIAG@EAA@KHOCEAWC"],encodeOffsets:[[109518,28222]]}},{type:"Feature",id:"520303",properties:{name:"汇川区",cp:[106.93427,27.750125],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAEA@@@@@AAA@@@@CA@AAAAAACA@@@CA@@@AA@ABA@A@A@AAABA@AFA@A@A@AAA@A@A@A@ABA@A@E@CAAAC@CCAAC@@@AAC@AFAB@B@B@D@B@BB@@DA@ADABIGC@C@CDA@@DB@BBBF@BA@ABAFB@D@D@FBHEFAD@LBBBB@DBBB@BE@@BBBAD@BGH@BAD@@CBC@ABBDABAB@BAB@B@@DAB@D@BBBBFDD@BBBBD@B@@@B@D@BBDBB@B@@DBD@BAB@B@DBB@@BD@@@DBF@B@@ADABABAB@BCBA@@BAB@@A@CD@BABAB@B@D@J@BBDAB@@CBAAC@KFCDABCBAAAACAG@@@C@A@A@@BA@C@E@CACAM@GBOBC@C@C@GBC@G@GCCAE@CBGLGFC@G@A@CCAA@@CCAGKGGCACA@AAAAACEGECEE@@A@E@KBE@EDAH@FABC@E@C@I@CACA@CACEE@@@@EEC@EACD@@A@A@A@@ACAEGEGACBEJAFABC@EBEDAH@@AJCHCFAFEBCDABABE@EAK@CBG@ICC@@ICECECEAG@@@A@A@AA@@BABCBA@AAC@A@A@ABC@AACCA@CAA@C@ABC@AEKBEBAA@AAACAAA@CAC@ABADCBC@@A@CC@AA@BA@@BC@@BA@@D@@ABBB@BCB@@@BA@AA@A@@ABC@ACAB@FB@@@@DABAB@BAB@DABAFBBB@B@@D@@A@A@AAA@ACACCE@C@C@A@A@CAAACBA@@B@ACA@BAAC@@A@@AEAA@ABAAABADDHABD@@D@@CDC@AAACAAACA@ABABB@BDA@ABABBDBDCHABB@ABAF@BAFABADBFAF@F@BAD@F@HBBABAB@@ABAB@D@BAB@BA@ADA@CBAB@@G@ABCAABC@C@CAA@ABA@ADC@@CB@@C@AACAAACCBABE@CBA@AB@BC@AAAABA@A@A@A@E@CBAA@AA@A@C@@ACA@A@E@CBABA@A@A@EDEB@@ABCB@DA@ABA@ABCBCDA@A@@DDD@BADDF@D@@A@CB@DADB@@DADBDAFCDEBBBBF@BDBD@BB@D@DA@A@BF@BCFABA@BFBFBDB@BDABAFBD@BC@@@CAADCABD@D@DABAHDB@DDH@FADAF@BBBHJDF@FCBABAB@BA@AB@@A@@A@BA@@AA@A@A@A@AAA@@BA@@B@@A@C@CBC@A@A@ABABA@@BABC@@@AB@AA@CBABA@A@ABAB@@AB@@@BABA@A@A@AB@BABBBAB@@@BADCBCBA@ABA@A@A@ABA@@@AD@@A@@BA@@@A@A@A@@@@B@BB@@BB@A@CBABNFRDJDLBRDVANA^LBFDRFR@@BF@@THDhIx@B@FBTDXCNMNsVABGDBH@FE@AFBFEF@FAB@DABEDBDDHFLZ@LDB@bJZA|CLFHBHDhOF@L@DGBCDK@O@YFQL]@AE_DCBAHEDBLBNFPF\\BRDDBNFBBLHLHHFLJDBHDLBB@P@NAJCLENALB@@DB@@B@DBB@@@BABABA@@B@DBB@B@B@DDB@DBBB@@BBDB@BBB@BBBB@DFHH@JAJCL@B@BAJDFHHLDJDHDHLBHBFFJBHJJFFLDJDHBF@FAD@FEHGDM@@DKBKDKDKFIHEHE@@JAJBHFFF@LAB@DAD@BAF@J@DBDBBBBADCH@B@F@BBBD@DCBAPCD@XCFAFAFADAD@@@@@@@BCBABA@C@A@KBE@@AEAICIEKCKCEAEAEFGFEHSBAPSNGLGFK@GCGKEGGAKHKDCLS@ABCHUDQFQFOFM@E@@@@@CCIIOEM@IFELGFBHBNBHAJIHOFIBAHGLDHHDNCJFJLFNBPBLJHFNJLFFDFBDB@BB@@@D@D@FBVFFDB@B@B@DGB@B@BBBAB@DCDCHIDABAFGFIDEBAHG@CBEAC@AAAA@AAA@@AC@CC@AA@@C@CBCBAD@BA@@@AAAGCAA@G@C@AAAA@KAECCACC@EFCFCJALADG@C@E@CBEEGCCGEGGAE@CDEBEAK@CFKBAAAEAEBE@@@C@IBGBIBE@@B@DSjADCBA@A@AA@C@M@ACAKEC@CDCBEBA@A@ACCSAAACAE@CA@EFMJABC@IAGAA@CBA@E@C@C@CFADAB@BE@ABABEFABA@ABAB@BCBABA@C@@@EAA@MEGIECI@G@EAGBMFA@@G@AAA@CAC@C@@CA@A@A@AB@@A@A@@AAAAA@C@ABC@AB@@AA@@@AAA@A@AAEBAAA@A@A@AAAAAAAA@@AEBEAAAAA@AAGDABAB@BC@CAAABA@CBA@AB@@A@@@EDC@@@AB@@@@A@@BAB@@@B@@@@@B@@@BA@@@A@@@@@@BA@A@@B@@A@A@@AA@@@@A@@@A@@@A@@@@@@@AA@@@@@@@@@@@A@@A@@A@@@@@@AB@@@A@@@@@@A@@@@@@@@@@@A@@@@@@B@@@@@@@AC@@A@@@@@@@@@@A@@A@@B@A@@@@@@AA@@@@A@@@@@A@@@A@@@@@@@@A@@@@@@@A@@@@@AA@@@@@@@@A@@@@A@@@@A@@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@AA@@@@@@@@@A@@AA@@A@@@@@@A@AA@A@@B@@A@@@@@A@@@@@@@@@@@A@B@AAA@AACBAB@@C@C@CA"],encodeOffsets:[[109489,28365]]}},{type:"Feature",id:"520323",properties:{name:"绥阳县",cp:[107.191222,27.946222],childNum:1},geometry:{type:"Polygon",coordinates:["@@DICMGGKCGHABEJGPIJGBMAGAEAKHEF@JFNJPDJ@D@@@@@FENEPERCRGVAD@BKTCDGLBLHHLFDH@HELKHMHOTABGTEFEHBFBFDFDLFLDJBJBF@@AF@L@B@DABABAD@@@B@BAJEXCJ@FAF@D@D@F@DBB@@BDABBB@L@RCNEPERCRAP@HAPBTALBJDDDFJJLJHJBPALAD@@CLAB@BEN@BCLCRCRAPAD@HBJ@HANBJFJJJHNHNDNALANBFBJ@BBJ@L@LADCBGJIJGHKHEHAJCL@HDLFLJLHJFFB@BDDDD@B@FBBDBDBDBBFADAF@B@B@FAH@B@DBBDBF@DBBBBBBB@B@B@@@B@@@@@@@@C@@@A@EB@@C@E@AB@B@@@B@B@@ABADAHA@BAFAH@FB@BFDBD@FAD@B@D@BB@D@JDBH@D@B@@BBB@D@@@B@BB@BB@BB@DFBD@DCBCF@J@FDJJHLDJBF@HBFBF@B@PEPGJELGNALAD@NCLA@A@@^CRGPUBSDG@@JSE]@EBWN@RIT@TL`ZtRjrpL@CHOZUBCHKBAASJQCiACKKKGEEGESCQEKGGEKEECA@C@G@GAEAAC@ABCACCIACAAC@C@AAAA@CBCBCBCBCBC@E@CE@GAAA@C@CAAEACAEACCCAEECEAC@AAA@AAABCBAFEBCAC@ABABAB@B@HBF@D@DAPCJADCFCFCDCB@FCBABAJ@NBHAHCBAFABAFCDCDABABAHAFAJCDADCDIDCBAH@B@BABABC@CAAEA@@@A@CBADA@AAACACAAA@@BABADBD@B@@@@@@E@A@@BAJ@BA@ABA@AB@B@BBB@@@BD@BBDDBHDBB@H@B@DBF@D@@BDBDBDDDDBB@DABABA@A@AACAECC@C@CBCB@@@BA@@B@@AB@FCXKBA@@D@DCD@B@B@BBBB@F@BADBJ@B@F@BAD@@ALJZ@BRDJBLBNBLDRDPDNFJFHDHFHDA@CB@@@BB@DBHB@BD@BBB@B@FDD@F@DABCDCBCB@D@F@HBB@BA@A@C@CBA@A@@BAAA@AAACAAC@CBAFAPADA@ACCAAACBCBC@ABC@AACAAACCAAC@A@@DOFIHGJIJQEKCEACCAEA@@AC@AACE@CA@AAC@EAC@AE@EACCCCACICE@EDEBC@CAACBEDE@CC@GAOAAAA@ICC@A@GBA@AAA@GAA@C@C@CBGBCBEBCBE@ABCA@@AA@ABABAFGBA@ABA@@@@@A@@BA@ABCDG@A@AGEUOGCACCE@EKE@AECAAGQCACA@CAABC@C@@DCDEBABE@AAAE@ABABABA@G@C@A@ABA@C@EAC@AA@@BADC@@CCAA@@BCBAHEBA@C@@@A@@@A@AB@BBB@BB@@@@@ABA@ADCLEL@D@B@BBDN@HAL@BBBDADCFC@AFCD@D@LBH@FBD@DBFBDDBDDFDHDF@BFDBBBF@B@BADB@@@B@B@B@BAB@D@BBD@DBB@BC@@@ABE@C@A@CB@BAB@FABA@AAEAC@CBAHCFAD@FDDBJDJBDFBBDBBAB@D@@A@EDKBMAM@K@AAKEMGMEKGKGIAE@ACCCCEGECEGCKSUGECCOM@A@@[QWQQKEAMIEG@KAECCGCIEKCCAQKMKIIMMYGOAEAWGE@K@@@K@KAICGACCACAGAGAACCQEIBC@ODQFCBQ@QAOEQKSCAAOACBIAC@MDABB@B@BB@@@B@BABEBAD@FHTHN@F@BAB@@A@CGAAAAECCC@CCAEASAC@IAB@@A@AAA@@AAA@A@CHA@A@A@ECUEEAC@C@@@A@@ACAEAECKEMIGEKIOAMAKEEI"],encodeOffsets:[[109639,28505]]}},{type:"Feature",id:"520324",properties:{name:"正安县",cp:[107.453945,28.553285],childNum:1},geometry:{type:"Polygon",coordinates:["@@DD@BCBOBEBAB@DBDDBBB@BBBAB@@@BAB@D@D@BABA@GAE@C@A@ADCDADCBE@C@ECA@A@AAC@@AGACAA@@A@@DAB@GCGEGCIEMEOCQCKCMAKAIAQC@AIYBK@@BC@A@E@AAIBC@A@EAAAAA@A@C@CDC@@@ABWLEDA@@BA@@@AB@@A@AD@D@DDDBFBD@B@BABABCBA@CACCACACAC@@@CAE@C@A@GAAGCCAAC@AAC@@A@AAA@A@@BAB@BABI@AB@@@B@F@@@@A@C@CAABAB@@BBDBDBBB@BCBAB@D@B@@FBBB@DADABABA@G@ABCDCJCDCBIDEBGBABABCBCDEDABEBABGDGBMAI@ABABEDA@CDEDEDCDIBODCBC@E@GAA@A@ABAB@BBDADEFABADBB@BBB@BBDDFFFDBDDFBDBFBBB@D@DBBHBF@@D@FADADADADAD@DBBBBD@D@BBBDDJBDAD@BBDFBHBH@D@B@FDLFHFLHRFTDHFFFLHLLBDDjIRBTABGLADYVGP@DoKiqsQ_YSKS@QJM@AX@FF^IT@@CHATOVQH]D@@@BKBMDC@KBMBKHIFOHOFA@E@EA@BDH@PANBLDP@LBN@PAJCPGJBD@B@BDHBF@D@B@DCDAFAFBDBDADAFCHEJAHCHCLDFBB@BDBFDD@DBB@F@DADADAFAF@BAB@@DBF@D@BCHADCFCBGDABABCHAD@BCJEJ@BA@ABFBDBBAFEBCBADA@@B@D@HDFBB@JAJBJDH@N@LCHEPGHCHCLAF@LBDFFHDNDRBHHHHJHHHFJDJBB@J@J@JAJAL@NFPDDDFDNJPLHLFNBJDLBJBP@FCJEJGDAB@BAB@D@FABCBC@C@AAAA@C@A@AACC@EDEBADBBFD@BBB@BBB@B@B@D@BAB@B@DA@@D@B@BBDBB@@B@BDBDDD@@DD@@FBFBDBFBDBFDHBH@DBF@D@FBDBDDDBD@DBJ@J@F@FBFBFBDBDDBBDD@@BBBDBDBBBBDB@@D@DBB@BB@D@DADCBEBCBCBED@DADBB@@DDFBDBFAFAD@FBFBFDDBDBB@DABCBCB@D@DBFDB@FB@@H@B@D@@ABBB@BB@BBB@@@@@@@@BEB@JKHEHCD@ABBBJABAAA@ABABADBDBBBBABBAH@@DB@@BD@@B@BEB@J@DBD@BBDFBB@A@E@CDAB@BBBDBBB@DE@AAAC@C@CEBA@A@@BEAA@ADC@@AAIAA@@AFEFABAEC@ADAJCBBD@CM@GBCHAHABAACACCA@GCA@ADCFAD@DBB@JADEB@F@DACC@@CCFGEEBADAAC@AH@BFBABGAED@@E@@FBF@BDFBDBDCFCBEDCBA@A@AAEBEAE@AB@AACCAC@CAE@AFADAAK@A@@EA@CC@C@CCBGAOAA@EB@BBB@D@@ACSAGCEG@AADECI@EHC@EFAJELABAB@HC@EDALGHAAIFABA@ED@LCBEDCHCFKDKhDJHH@HD@@HBNSNKTEXGR@LDRDNFJJHJJJNHJFHFPDRDDAB@D@JEFGDEAE@M@KBO@M@IGMGIGCCCEEOCMCOGOKSKKKCIDGDIJIRIHCBG@E@A@ABCBGACAACCC@EBGDGHEBGBE@A@A@EDA@@C@A@AAC@@AA@CAA@CBC@ADADCDEFCFDDBDBD@D@@ABCBADA@AAA@ABABA@CBCBAACACACAA@@BCBA@A@AAC@@ECA@C@A@AAAAA@AA@C@A@ABCBCBABCBABC@E@AAA@E@ACAC@@@@C@C@CAACCGCCEACAC@E@CBC@ABCBABABC@AAAAC@ABC@CBAAAAACAFCFCFCDCBGEEGGMGECCACECIEMEMAKBIHKHEJCFCFIDIBKAK@IDEHBJBL@DDB@BBBBHDF@B@BBD@FABC@ABCBABAHAF@B@DC@@F@B@BADAHAFABAD@B@@AGGCCEAA@AAAA@EBE@AAA@AB@@A@A@AE@AAAC@AAAEAA@CAAA@A@@@C@@BADAB@F@BAD@@AAC@A@@AAAC@@CAC@GBA@A@BCBA@ACAEACAE@CAEAAAAKAGECGCEAOAMAKCGC@ACGCGBIBGBGHOFMDIBEDICECGUMEA]A@@QEIAKOCEIGIFGHGDIBI@I@G@@DBHB@FDDDDH@F@FJDFAFGB@D@HHFBLFHDBB@DEHGHEBECECC@K@CAMIC@EAEDEF@DBBDDFDDFADCHEFIHEBC@IEMCIRIJGHEJCP@@@BBDDBBDBBBD@BAD@BADADBDBB"],encodeOffsets:[[110091,28927]]}},{type:"Feature",id:"520304",properties:{name:"播州区",cp:[106.829574,27.536298],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@AAA@A@AAC@C@CBA@@AA@AAACCA@AAAA@AAAACA@@@@AC@EA@AA@C@A@A@CDED@@@BABBF@@BB@BB@BB@@BD@@@B@D@BBDBBBBFDFB@@B@B@@BFB@D@@@BA@EBC@IBCBCB@@CDABABABBHBJAF@L@DIT@@GXSTCDUnCHIPCJ@R\\bFHHXJb@@@BAF@DAF@@@@@DIJQPABAB@B@BBB@D@@@B@@BL@F@PCHCJIDC@M@K@K@I@GDADABSX@BA@GJAHIRGTCBSJEBABABEBQPABCBEBGDO@@@@@@@C@A@EAKAGEMGKEAAEASDIEAECOQFYMDGFQ@G@EAAKKM@E@@@CBEB]JOFEDKL[CGTED@BABkLwYaMOGQGEEAMGCULKA[CGFMXMJ@JKX@@@BALP^BD\\JHHDhEZAFC@KDSJCD@@@@@DAFA@@BAFABEDC@ABABABABG@@BBBBBD@@BB@B@B@BA@@B@@@B@@B@B@BAF@B@@@BB@LABBJFFDLFPDB@NBJ@LENIPG@@B@XAR@NAD@RE\\AJB@@BI@@DABAD@B@HAFAFAB@FDFDBBFJBDBBB@FAD@JBBBBAB@D@B@@B@BAD@D@B@BA@AAA@@@A@@B@BADDB@@@D@FCNAPBF@DHHNLNPHTDHDX@@@TAL@HAFERGJQLEH@BALFJLJJHJNHLDHNNBBHR@XAXAHCJCT@PDFDFB@JJBADAB@A@@AA@@A@A@@B@B@B@@@B@@AB@@@BC@@B@BAB@B@B@BAB@DADABC@A@@BAAABA@ABAB@B@B@BA@A@@BA@@BABAB@B@BADAB@@BBA@@D@BA@AB@BABAB@B@D@DAD@B@@@@AB@@AB@BBB@B@B@B@@BB@@A@BB@@@BAB@@ABABADA@ECEGIAA@ABEBC@ECG@CCABGBA@C@CACDBBCDB@@D@@AACBEBAACA@ACAEAEB@BADE@AAEB@B@@C@CAAC@CA@AAEAAFADCBEACBC@CA@BC@CDAB@@@@CCEBC@ACC@CB@B@DCDABAB@BAB@@CDABA@@FAFCB@B@B@BADAF@B@B@BD@@@D@BBBB@AB@D@F@B@B@BABBBBBD@@ABAB@DAF@BADABDBBBD@B@DA@@DD@BCB@BAB@DBD@D@BADBBAH@@@BADAB@BCB@@ABA@A@CBABA@@BABAAA@G@EBC@A@EBEAEBCBABE@ABEBAA@BADGACACBABAB@ACA@BABAB@BDBBBDBBD@DC@@@CC@BACGBCBABBBAB@FB@BB@@@BDABB@BDA@@@ABBDBB@D@B@B@D@DDFBDBDB@BBB@B@@@@CA@A@AABEBA@CBA@ABABA@C@@A@@EBABDD@BA@@@BBBB@@A@@DA@AAABA@@@CB@@AD@@AB@@ABBD@@D@BD@DABCBAD@DBB@BBBDBBB@B@NADAB@LDDABEBCFEDADCHKBCFEF@BBFDF@BA@CBGPKH@FBBA@ABABA@@@CAC@AB@@@@@DB@@BB@@DBBB@@B@BBD@DBDB@@DBDBB@@@@BB@B@B@DA@@@ABABAHGAACI@@@ABABAB@@DJH@BNJHFH@H@BEDCN@DA@EDEDIBEJA@@B@BBBD@F@B@FBDBDB@DF@DBB@B@@BB@BB@DBD@B@BA@AJC@AAAAC@CDAFADJFC@BB@@@D@BBBAB@@@BABAB@@A@C@A@@AEAC@AA@@A@ACA@A@AACBCD@BAD@J@D@F@F@F@@B@D@DDAB@BCBABAFEBABA@@B@BDB@AABMEEBECCKMEMBGDCB@@A@C@G@C@ABABADCBAD@B@B@@B@BAB@B@B@BBBBAB@BABBB@AB@BABBDFDF@FEHCH@DDBA@B@BB@B@B@BCBBDBXDFBPDLGB@FBH@JBHDHD@BBBBDFBD@BBDDB@@FAF@FAD@BAB@B@DBBFDFHFFB@DGFBNTDBB@D@DEDAH@L@DCFGBGDA@AD@BFDHBHBB@FBABCD@AABBACFCAC@A@@@CAA@AB@BBDA@CBADADBBA@AB@B@@BB@BB@BABDABABCBBBAA@F@@BD@@A@E@@BC@EGA@@ECBGEC@AEE@A@CAC@AF@BAFBB@D@DDBAD@B@@AD@CA@CCCA@CC@CF@FAB@@A@GAABAAAAC@AAA@EBABB@B@@BBAAB@A@B@@B@@BA@@@C@@@@@@@@B@@@B@A@B@@@@@@@@@@@@@@A@BB@@@@A@@@@@@@@@@B@A@@@@@@A@@AB@@@@@@@@@@@A@@@@@@@@B@BCB@@A@@D@BA@@@@@@@@AANC@@@@@@@@BB@B@BA@@B@@A@@@@@@BBADA@@BB@@@BBDBD@@B@B@BDBAB@BB@@B@D@@@DBFB@@DDDAB@D@D@@BB@ABB@@BB@B@BAB@BBBBBBB@@BB@BBAB@@B@DFCB@D@FBBBB@D@BB@@BB@BBABA@BB@DBBBB@BA@BB@@D@@BB@@@ABBBADA@A@@@AAA@A@@HBADBB@DABBCBBB@BA@@BB@DB@BCD@@@@BB@BABBBB@@BC@AD@BD@ADABBDBAD@@DD@BABABCFCD@@BFD@EFABDFCCGBEFCF@DBDCB@BMBAH@BBB@AGBAB@B@@@B@@@BCLADBBB@DB@DADADBFBFDJD@DBDHBH@BB@BBBAB@DBBD@BCB@B@@BD@BD@@@BBBBAD@BB@DBBFBBFD@FBHBDBFBF@BBCKJu@E@a@O@I@COcDYBSAeEG@C@@DeCaKQACECSQA@@@CCHWSGKGMMKMUUQ_[DI@WAECMCICKCy_YMUIUBOJSPIFCBkXmJABQAGAWK@@MMCCuuAAKC_GCAM]EC@@WUMUKIE@E@MJMHQVCDABEFOhaZ@DA@YBMBGECAA@C@C@CAIE@ACACAGA@C@A@C@A@AAA@ABA@C@A@C@AAC@CBABABABABAAACEAA@ACIAA@A@ABABA@AA@@AA@C@C@A@AAA@ACAA@@AC@AAA@@@ACCAA@@AAAAAA@@@@@A@A@@@@AA@@@AA@@@A@CBABA@A@AA@@AAAA@AAAA@A@CA@@@ABA@A@AB@"],encodeOffsets:[[109325,27899]]}},{type:"Feature",id:"520322",properties:{name:"桐梓县",cp:[106.825198,28.133311],childNum:1},geometry:{type:"Polygon",coordinates:["@@BD@BCDCBAB@D@D@BBBBBF@B@HAH@D@L@L@F@F@DBDB@@B@@B@@@BBB@@@@AB@B@B@B@@BD@D@BBB@@@B@@@B@@BF@@@B@B@D@F@@@@EB@FBDDDDDDHBFBFBD@FBDDFBD@D@D@BBDBDDAD@BB@BBDB@@D@@@B@BA@AAAAEAE@CBE@C@C@CCAAA@CAA@EBCBCDCBADADAB@DADBBBDB@DBB@D@B@D@@@@BABAFCHKPCDCDEBE@CBCB@@AB@B@BABA@AAA@CACBADADAF@HADADAFAF@@@DDB@BDFDFDDBDBBBADABAB@BD@F@BBF@DDDBBDBD@F@BAB@B@D@DBB@D@B@D@@A@C@A@CD@D@FBDDB@DDBFDFDFFDDDDDFDDBDDFDD@DABCB@DADADBDDDDDBFDBBBBBBBBBBDBB@B@BABCDABEB@@@BABC@A@@DCDEBCBE@ABC@ABCBCDAB@@@BA@@B@BAB@@@DA@G@CAABC@A@@BAB@D@F@DBDBFBBDBBDBD@BADBFA@AB@D@DBB@B@B@DBDBBDAD@@@DBBBB@@@B@B@B@B@@@B@@AB@@@B@@@B@@@BA@@@AB@@@@@@@B@@@B@@@@A@AB@@@@@@@B@@@B@@@@@BA@@@@@A@@@A@@@@@@@@@BB@@@@BB@@@@A@@B@@@@AB@@@B@BA@@@@BB@@B@B@@@@@@AB@@@@@B@@@@@B@@@@@B@@@B@B@@@@@BB@@@@BBB@@BB@@@@@B@@@B@B@@@@@BB@@B@@@@@B@B@@@B@@@@@@B@@BB@@@@BB@@@@BBB@@@@@B@@@@@@@@@B@@@B@BA@@@@B@@@@@B@@AB@BAB@@AB@B@@@B@@BB@@AB@BAD@@@@@B@B@B@@@B@@@@@@@@@B@@@B@@A@B@@BB@@@B@@@@BB@@@B@@@@BB@@@B@B@@@@@B@@@B@@@B@@@B@@@@@B@@@@@@A@@B@B@@BB@@@B@@@D@@@@@@@B@@B@@@@@@B@@@@@@@@@@B@@B@@@@@@BB@@DF@@@D@B@D@B@DABBB@BB@B@B@@@FAF@D@BAD@BAD@@AD@D@@@@@BBB@BDB@@B@H@F@FAH@DAD@BCBA@C@AAC@CAC@A@A@A@ABA@AB@@@D@DBB@DBBBB@B@BBB@BBB@D@F@D@ABC@ABCBC@ABCB@@A@AB@@A@@B@BBBBBBB@BBD@B@D@BBBBB@DBB@@BBFBBDB@F@@@BABAB@@BB@BBB@@ABABABABCBCBA@@B@B@B@B@@A@@ACA@@A@AAAAAEAE@A@EAEM@A@ABAFAFAF@DBB@BBDDBBB@B@F@FBFBBBDDBDBF@DBDBD@BBB@BDBD@HAL@NAN@H@H@F@D@BAD@B@DBB@BAB@@A@A@A@C@A@A@ABA@ABA@@B@D@D@DB@@@@BB@B@BBB@BBBB@B@B@B@BAD@BAD@BAB@D@B@DBB@DBD@BBDBBBBBBB@@@AB@@AAA@A@@AC@@@A@@BA@@BB@@BDBB@BBBBBB@BBBBBBB@@@B@@ABA@@@A@@@ABABA@ABCBCA@A@CAA@@AB@@AB@DAB@BAB@BA@@DABA@@@AB@@AB@@A@AAAAACGAECGAECG@CAC@CAC@@@A@@B@H@D@D@D@F@FABAB@DAB@B@D@B@@BD@B@D@B@@@BAA@A@AA@A@A@A@A@AA@E@A@AAA@@@A@@AAECEA@@CACAE@M@EBABE@CBE@@@ADCBADCBC@C@CACACAABA@CDGFCHAHADAFAFCFAFABAD@@@D@@@BCFAFCD@BD@B@B@DBBBBD@D@DABA@E@CBA@ABAHCFIDI@EAOAICKAIEMGKOKMIECCCOCMEK@IBIBI@I@A@IAICGEGGGIGGAGCQCMEGCEKAE@KBGDGDOHGFKDM@G@ICIAIBA@EAGCC@A@@@CBABADEFABCAEABAB@@AFIDI@ABCDGBABAHCDADEBCDG@A@CAE@CA@ABE@EBCBCBCBE@A@CAC@ECCA@AAACEDKDGBGFIDGBEBCACACBEBEDC@C@A@CAECG@A@AACHIDOBI@OAM@KCOAKBM@OCG@AGAE@IAKCIGCI@E@IDEDA@CACCEA@@AAAA@@A@A@@@CAA@AA@C@G@CA@I@CAAC@A@C@EBC@CAAEA@@EBGBE@AGBCBAB@BA@A@@@A@A@@B@F@DA@@F@B@@@D@@@@@@A@@@A@A@A@AAAAAA@CAEACCAA@G@EBA@A@E@CBEBAAACACACEAA@C@CCACA@EEGIIKEKCK@GDKBIFGLGHGJIHIDABC@K@KAI@AAIAEBMBKCMGMGMIIEIAIBM@GAI@GBCBODQDQDK@AFM@ABADK@@BCBKAOGIKIIICECCAIBKASBO@GBODQFQFODM@Q@KAABAAC@@AA@C@E@C@CBE@EDIFWBI@A@A@@@@C@CBEBEBEBWDC@ODABCDC@AA@A@E@ADGBCAAAAAC@C@IBE@ABC@CBA@KEEGEIAIB@@GFGFEJCLCLALCL@@CNGHEFC@EBE@GAICKCEEIIAGEIAEAGGKGCICKCGGCEBI@A@ADKBI@IGGCEA@AA@AAA@ACAAA@@AACAA@CCA@A@A@CAA@@@ABABAB@@A@CAA@@@CA@@KAMBKFIDMBO@A@KAGCCAKIGEKGKGAAMECAQC[AOEMEKACAGFABCDF`@BK^ER@Z@PCLADCHK@E@gPGCGAKE{DYBaIA@KCY@ABWR@@@@KJMBKD_@A@@BWFA@wtKRCL@D@@I^GfNBH@DD@D@BCFGJELAN@F@FBDDJHHHHFFNHPDH@F@T@XDR@PBNBB@RDH@HEJEFGLIDAFCJALBLFLLBB@@BC@AB@DFBDD@D@LEDABADBDB@D@B@BB@B@@@F@B@B@DBRV@@HFHDFBBAFABABABCDADAF@BBDB@DBF@H@D@DDDDDDBDFJPHHBBDBDBFFFJJPFJDHBLDTBTDLDHFHBBBJDN@L@N@BA@@B@B@BDDDBBDBDBH@FBDDDDDDDFBHBDBDD@@@B@@ABB@@DAJBB@BA@@B@B@BA@@BBBBFDBFFBBFFBB@B@D@@@D@D@B@@@@DNBF@D@D@DC@ABCBA@ABGBC@CBABABA@A@AACAA@BDBBBDDBBD@DADCDABCBAB@DABC@@B@BABADABCBCDEFA@@B@D@B@B@@@B@BAB@B@B@B@F@B@D@B@@B@HHDBDBD@FADAD@D@DDBDBB@D@@@@BBBBBBD@B@D@BB@@D@BBD@B@@@B@@@@B@DAB@@@DAD@DBF@F@B@DAD@F@DABAFADABEDCBABABAFADCHCDAFAB@BAHAD@F@DBDBFBD@FBB@DDDDFDBBDDDBB@BB@@@B@D@DAD@DDDBFBDBFBDAD@DADBBDBBDBFBD@D@D@@@DBBBDF@D@BAD@BABCDEBC@E@CBCDEBADCDABCD@DBD@D@D@DAD@@@DAF@D@DDDDDFBD@D@DAD@DA@@B@BBBD@D@F@BBBBDBBB@BBBBB@B@B@@ADEBADEBABA@CA@@A@@@ABE@CBABCBAB@FABA@@BCBADCBABC@@@A@A@ADC@@F@B@B@BBDDDHBBB@B@DBD@D@BB@D@D@B@@DBBDB@@B@BBB"],encodeOffsets:[[109397,29297]]}},{type:"Feature",id:"520325",properties:{name:"道真仡佬族苗族自治县",cp:[107.613133,28.862425],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BBB@@BD@BD@BDDBBB@DADABAB@DDDBDBFBBBBBFFHFLBBDBD@BCD@@AB@@BB@B@@@BD@DB@A@@B@BCD@B@@BBBD@BA@AD@D@@@B@B@B@BAB@@BDBDBBBBBB@@@@BB@@@DBB@BBD@DDBBDFDB@FBFB@@BDBBDD@BDDBDBDAF@FADADG@GAG@E@C@A@EB@@CBCDCDADCHEHADAL@HCHCD@@@@@B@@@@@@A@ABADAB@@@B@@@@A@EB@BA@@AC@A@A@@@A@@@A@@@@@@@@@@@@@@@A@@@@@AAA@A@G@E@CAABC@CD@DAJEJAD@@BB@BDDBBBBBDDDBBDBB@D@@@B@@@@@@B@BAD@B@BBB@@BBDBNF@@B@BBDBBBBBBD@B@D@D@D@BAD@B@DABAD@BAB@D@@@BA@@@C@C@CAA@@@C@AAA@CB@@@D@@AD@L@D@F@DELGV@B@BBBBBBDD@BBD@JAB@B@@ADADADCDAB@@@B@@@@BBAB@@@@@D@@@B@BD@@B@@AB@@@@BBBB@@@D@@@B@B@@@B@@@B@@@@@B@@BB@@@@@B@@BB@@BBD@BB@@BBB@B@B@BB@B@@B@@@BBB@B@BABBB@@BDB@@BB@@B@B@BB@@B@B@B@@@@B@@B@@BDBB@B@BB@@B@B@@@BAB@BA@A@ADAB@BABA@A@A@EAA@A@@@A@AD@@CB@@A@@@@B@BAB@@@B@@@B@@@@@B@BBB@B@BAB@B@@@BB@@@@BBB@B@@BB@B@@BB@@@@@@@@AB@@@@@B@@@B@@B@@B@@BB@@ABCHCBIJAD@DADBN@@FFBBF@HBD@BBB@BBB@D@BADAB@D@B@BBDDFFBBJJ@BBBB@B@D@@@F@DALGNCDAB@@A@AAAAC@A@@BABC@A@A@EAACC@A@AB@HCHA@ABA@@BAB@@@B@DDDD@@BAB@B@D@B@B@B@B@B@FDHDFFJFFBJJBBFD@BB@@BB@D@B@D@B@B@D@B@FADB@@DBBBHHBB@@B@FDDBBBFDBFBB@B@D@@BDBBBBBBDBDBH@@@H@DABABAB@@AAAAC@@DCFE@ABAB@@CAA@A@G@A@G@GBCBG@@BAD@@@B@@K@GBC@ABCBADABA@@D@@B@B@@B@B@@@@A@A@ACEAACC@@@A@CBEBC@@BABA@@B@D@BAD@B@B@B@@A@@D@B@DB@BBF@B@F@B@@A@A@@@@@AB@@@B@@BBDD@@BBBBBBB@@@D@FCFAB@BBDBBBH@D@D@D@BAFABBB@B@B@DABA@CB@B@F@VDBBB@BA@@@A@@@AB@BK@C@A@A@A@A@@BAB@DAB@LATAPCLABAD@B@B@@BB@@B@D@BBHDDBDB@B@LBF@B@BBBFJRDJBF@BA@CBA@@D@DBB@@B@JDLFB@FDDDHHFHBBB@B@@@DCDCBABABABABCBA@E@A@@AEAC@A@ABAB@BAD@FAB@BABABEDIBCB@@AD@H@BAB@DC@@BCHIDEDCDCHADAHAB@B@B@@@DC@ABA@AACIYEM@A@@AC@CAA@E@CCC@A@@AAGUGQ@A@C@@@IAE@C@ECG@C@G@EAGAC@@BA@AB@BAB@FAN@HABAJCDAHE@@BC@@@@D@D@B@B@D@B@AA@A@C@A@C@C@AA@@A@A@C@A@C@C@A@C@AAC@A@@BA@A@@@AAAACCE@A@AAA@C@AAAAA@@A@AA@@@@@@@A@@AC@@AC@A@AAA@@B@BAB@BAFA@AB@FAFADADADABABCD@BABABABAD@B@B@B@@@@GD@JCB@H@BBD@D@B@DAB@B@B@BABC@A@A@CBA@AECA@AAAAEGGKEGIIGMEIAAACCCCEAAAAAAACA@@@@AA@@@@AA@@AA@@@@AACCCCC@@AA@@@@AA@@@@AA@@@@@AA@@@AA@@@A@@AA@@A@@A@@@AA@@@@AA@@AA@@A@@AA@@AA@@@A@@A@@A@@AA@@@AA@@@@A@@AA@@@AA@@@@A@@A@@A@@@AA@@A@@@A@@@A@@AA@@@A@A@@@@@A@A@@@@@A@A@@@A@@@A@@@A@@@A@A@@@A@A@@@A@@@@@A@@AA@A@@@@@A@@@A@@@A@@@A@@AA@@@A@@@A@@AA@A@@@@@A@@@AA@@A@@@@@A@@@A@@@A@@@@@A@@@A@@@@@@@A@@@@@A@@@@@@@@@@@ACMAWCKCI@QES@@@A@CA@AGBGBADA@AB@BA@@DCBEBA@@B@BADCDABEAECEECMEACCCKEAACCCC@ECM@C@G@CBE@E@CAEAAC@C@@BA@EFABCBCBC@E@ACC@CCEEAC@AAA@CCAAAEAE@EACACCACCE@A@@EGAIAE@C@IDK@OCIMKIEKKGGCFEHIFC@A@CBQCOCGEIEMGIIGIIIMEQCKCQ@WHSFMLMTGA@@GCG@IGgCCLELGDCDAFKDC@@FABEBBJGBKHCB@FGDA@ABKBIFEB@FGD@FDJCFBBH@DFBHDT@BC@A@AAA@@FBBBPAHDDD@D@@DFB@@@BBLCBEB@BBF@DBDDDBBA@@BBFAFBF@B@BABCDAFEDCDCAEAACE@EA@@@FC@BFAHABAEG@@BBDCBABFFEHDD@@DDCBE@A@CFIBA@CAC@EBCD@BDB@HDBBDBDABGBGBAD@HDNC@AAIDCB@BFDABEBEF@BB@JBBB@@CD@BBBAF@@@BABDFD@D@BB@BCFA@AAACAAA@CB@D@F@BAACEAAC@CAI@A@AFA@@@AC@@CA@@BGAAABAACACAABAB@BBBABIBAABAC@GDGFILA@AF@@@@"],encodeOffsets:[[109965,29545]]}},{type:"Feature",id:"520326",properties:{name:"务川仡佬族苗族自治县",cp:[107.898956,28.563086],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AAAA@A@A@C@A@C@AA@A@ABA@AACCCCCCCCCCCAA@AACAC@CBEBAAAA@CA@A@AAACCAA@CBA@@DAB@BABABC@GB@@@F@F@D@BBBBDBBBDB@BBBDAD@B@B@B@@@BBBBBD@bFLDLNG\\BRLT@HKXDBAB@B@DADA@AD@@AFB@@F@B@D@DA@BBA@CBEAABA@AB@@AFAHADADADAHADAR@B@D@F@D@DEB@@A@@@A@ACCKCAEEEACAA@C@A@ABABAD@DBFBFBD@DB@BH@B@B@B@B@BEFABABCDCBCDABA@AAAA@@AE@EESAGAI@AAG@CACCAC@A@CDE@IAE@GAC@EBC@A@CBAFA@ADAA@@@BEBIDAB@AA@@AB@@AA@@@CBADABIDC@IBABAB@@ABA@ABA@AB@DAD@@ABADABABEAC@EACCA@AACA@AAAAA@A@ABCBA@ABA@AACAACAC@A@A@ABCB@@AB@BCDABC@AAACAABE@AAAAA@@BAACCCAGCAC@AAA@E@GCAAAAA@CCK@IAGACF@JBLALCJEJEDIDGFGLAJBLFNFNDJDFDBFDNHHHFFAHCDEDEDEDDBBBBBAB@DAD@BBDBB@BADABABAD@BAD@D@FBDBDDFHDDDBB@D@D@D@@D@DB@B@FBB@B@FADABADABADAD@B@B@DBBB@BBBBB@D@B@FD@@BD@B@BABAD@@BBBDBDBDABAD@DABAB@BBB@BCBABAD@BC@C@CACAECEDCFCDCB@BAD@DBB@DBB@@BD@B@B@DB@FCB@B@F@HAFAHGHCFAD@DDBBBDAHAD@B@B@FAHGDQJIJCJCHDJLLTLPLPHNDPDFFDDHDHJHN@J@NAP@L@NBFHHLLJFNLDJ@PCL@J@DBFBJFH@@@BDFBDDDDBFBF@FBBBDB@DBB@BBDFFDDD@BDF@D@DADABAFEB@@AD@D@BBBF@D@FAF@D@H@DDN@FDDDDBBLFDDBDNFFDDFBFAFCBCDABA@@@ABAFCD@@ABA@@BCBABAHBHB@@D@B@@FT@RDJDLBXDN@B@@@@@@@@@@@B@@@@@B@@@@@@@B@@@B@@@@@B@@@B@@@B@@@@@BB@@B@@@B@@@@@BBB@@@B@@@B@@BB@@@B@@@B@@@B@@@B@@@@@BBB@@@B@@@@@B@@@B@B@@@B@B@@@B@@@B@@@B@@@B@B@@@@@B@B@@@@@B@B@@BB@@@B@@@B@@@BB@@B@@@BB@@@@B@@B@@B@@BB@@@B@@B@@B@@BB@@@BB@@@@B@@BB@@BB@@@BB@@BB@@B@@B@@B@@@BB@@@BB@@@B@@BB@@B@@B@@@@BB@@@@BB@@@@BB@@DDDDBD@B@@B@@BB@@B@@B@@B@@B@BDBBBBBBDFDDBDBBFJHNJJFHHLFHBBBBB@FD@BAB@D@BBD@H@FBHBDBF@FBD@D@@BBDBD@F@DBDBBBBBBD@B@D@D@BDFABABCDAFA@@@BBDBD@F@TCJ@HBHHBBDDNTBDDJ@@BB@BDBFDF@J@HANAL@H@n@H@P@RDDAJB@@DBDBDBBAH@DB@@B@B@DBBBBB@@@BB@BB@B@BB@DBB@B@@B@@BF@BB@@BA@@B@@@BB@BB@@@@BB@BB@B@@@BB@B@B@@BBB@BB@@@B@@@BBBBBDB@@B@@@@@B@@@BDBBB@BBBB@@BB@@BD@@B@BBBB@@BB@B@B@BBBB@@BB@@B@BB@@@@BB@DBB@B@@CDCDCBA@@BA@C@ABABCBC@@@C@A@AA@@ABC@A@@@AACCAA@AA@G@E@@BGDSBK@ABO@I@G@C@IHGJGB@FGBAFIJGFEFEDGBGAA@CAC@C@GDEHGJGNEFADCFAFGBCDK@IC_AE@A@IEQCUCM@MDGHGBAFGFADANEHCFCHGDEBEFEBAB@B@@@B@@@BA@C@A@AAA@CB@@ABABA@AB@BABABA@AD@@ABA@A@ABA@C@A@AB@B@B@BABA@ADIBA@ADBBBDBB@@ABB@@@BB@@@@B@@@@@@@BB@@@@@@B@@@BB@@@@B@@@@@@BB@@@@@B@@@@B@@B@@BBBBBB@@B@@BB@B@BD@@@AB@@A@@BA@@@A@CBC@E@@AA@@@@@A@A@@@A@@@AB@@A@@@ABA@C@C@AAA@G@AB@@@@ABA@@@@@AB@@@@E@MCGEEECGGACCECGAGEAA@C@C@EACCAIBQ@M@E@MAICEEKCI@EAICGCEIGCCKEGCECC@G@AAAAAACAECA@AAAAA@AAA@AAAAAAAAA@AA@AA@@AAA@@AA@@@A@@BA@@@ADABA@AB@@A@@BA@AB@@A@A@AA@@AA@@AA@@AA@AC@@@@AA@@@AA@@@AAA@AA@@AAA@@ACAA@@AA@A@@@A@@AA@A@@AA@@@A@AAA@A@A@@AA@AA@@C@@@AAA@@@CAA@A@@@A@A@@@A@@BA@@BA@@BAB@@AB@BA@A@@@A@@@@CAA@@@EAAA@EAA@CAEAA@@AA@A@@AC@@@A@AAA@AAA@AA@@A@AA@@AAAAA@@@ACEEACAA@@A@EEKEGG@GBIBCFEBCDCBADGDEIEEGCCACAADGFEJEHALEHIJIBADG@I@KCE@@GEKEIEEGCI@KHIFIFE@ADG@IAGAICGGMGGBEFAHAHEHCDEFEAKCKCECEEMGM@@GQGKEICGCEAACGGAGBCDCBGJEHENG@EAC@ABABBJFHFDLBLDDD@BCBE@C@CHEFCDEDCD@HAJCBEBKDG@IAACECA@ALDDFBPBN@F@B@F@HFBDBHCH@BGAECA@CAKAI@GDIHI@OOCODQKQEGDCTUVANGRIDG@I@@EIAIEAE@YTKLGBICICEAOBWFEBI@KCMDCCFODCFEKEWFY@G@S@E@OWIg@OB]@ODWBQ@KAE@@@@AECI@EAG@GFKBO@O@GAMAQAOAQCE@@@@AB@@@@ABA@ABA@"],encodeOffsets:[[110443,28881]]}},{type:"Feature",id:"520329",properties:{name:"余庆县",cp:[107.905197,27.215491],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@@A@@@A@@B@@A@@@@B@@@@@B@@B@@@B@B@@@B@B@@A@@@@@@@@@@@A@@A@@A@@A@"],["@@B@DAD@BAB@A@@A@C@@DCDCFBB@CG@CAAAACBA@@@@@ABA@@@@@ACBA@@AAA@A@CDAB@B@@A@A@@AA@A@@BB@AB@DCBCBAAE@ADC@@@@D@B@BBDCBBBBBDAD@DAB@BB@BBADCB@@@B@@BDB"],["@@CA@@@@ACAE@A@KFM@C@ABG@AB@AIGGIAA@@@OAEBA@C@@@GH@@@@A@@@@BA@A@@B@@A@@@@BA@@@@BA@@@@@AB@@@@@BBH@DEBAD@BBFBBA@E@GAE@C@A@@CAAC@E@EAGAC@@@C@@@@@A@DH@F@B@@@BDDDDF@F@FBBD@B@BBD@@B@BDB@B@D@B@B@BBBB@BADABABC@EEC@ABAHCHABC@E@AAAC@CACEBMAA@@@MBY@QDC@KB@@M@SBENDD@@FFTDRHDD@FGFKBOAO@S@OHC@@@CBEDABCBQBWB[FWJOAK@E@A@KBIDIBIF@LNLFRBD@FMDMCICGEA@SGI@KD@BCBEDKHQAIACAA@IAUEMCMAKI@AFORQJUGGSIK@I@IDKLMVITC@A@EA@E@@@EHOPW@G@GAEECA@ECM@CBCDGFAD@@@BA@@D@@@BABC@@@ABA@ABABAD@BC@ABA@@D@B@BB@B@B@BB@BCBC@CBCBC@AB@@@B@D@@@@@DEBCB@@AB@@C@C@ACA@A@ABCB@@ABAA@ABABCDC@A@AC@CBEDAD@@@D@FDD@J@DELCJ@LBFDFDBBDDBB@D@HDDBB@BD@B@BBB@DADAD@BBD@B@B@BD@DBDD@BDBDB@@@@BBBBBBDBBB@BB@@@FDB@BB@@D@B@@@@B@BFD@HA^C^@B@@@BAJCPEFGJO@QAQAOAME@@ABCD@@AB@@A@@@A@AB@@A@@@@BA@@@@@A@@@A@@@AB@@A@@@A@@@@@A@@@@@A@A@BD@DBF@BBFBB@BFFDD@@@@^PNPNPHBHBPDPB`^JFNHLBP@DTTlBD@PGLILILKDOFIV@NDRDTFRHXDP@B@@BBFVN\\JJTPBBB@FBJDVFPHFFDDB@@B@LCLIPCRDJDFNLDBJFXHB@NJDBHFdQRAJAB@TEPCPCLGFIBCDM@@AA@IDKFILGL@L@F@LGDAXMJGBAAIHELEXIXSDUHMJGB@L@R@XAJA@@HCJEDI@EBMUsUs@CI@A@CAUGKBEBQKQIIOA@CQ@ADIPKPGB@R@B@P@NK@OBKCSBUFSEC@@A@@A@@@@AA@@A@@@CB@@A@A@@@@@A@@@AB@@A@@@@@A@@@AB@@A@@@A@E@C@I@C@A@CAC@CACAAA@AAA@A@ABCBABAFADAB@DADAD@@@DA@@DC@A@@@@@A@@@@@@@A@@@@@A@@A@@@@A@@@@@A@@@A@@AA@@@A@@@@@A@@AA@@@@@AA@@@@@@@AA@@@@A@@@@A@@@@@@@A@@@@@A@@@@BA@@@@@CBEFCJ@J@FAHCF@HBD@BABAAC@@COBKFCBAVGT@FBJBbBDD@@B@LLLHDFpFDCB@@@@@B@@@@@BA@@@@@@B@@@@@BA@@@@B@@A@@B@@A@@B@@@@AB@@@@A@@@@B@@A@@@@@A@@B@@@@A@@@@@@BA@@@@@A@@B@@A@@@@B@@A@@@@@@@A@AA@@@A@@@@@A@@A@@@@A@@A@@@AB@@A@@B@@A@@@@@@BA@@@@@@@AB@@@BA@@@@@A@@BA@@@@@@@@@AB@@@@A@@B@@A@@@@BA@@@@@@BA@@@@B@@@@@BA@@B@@@@@@@B@@@@@@ABB@A@@B@@@@BB@@@@@B@@@@@@@J@FBBD@FADADAB@@@BA@@B@@A@@B@@@@A@AB@@@@@@AB@@@@A@@@AB@@@BA@@@@BA@@@@@AB@@@@@@AB@@@@@@BB@@BB@@@BB@@B@@B@@BB@@@@BB@@@BB@@@@@@BB@@@@BB@@BBB@@B@@B@@B@@BB@@@@B@@B@@B@@@@@BB@@@@B@@@B@@@@@B@@B@@B@@BB@@@@@BB@@@@B@@@BB@@B@@@B@@BB@B@@@BBB@@@B@@@B@B@@@B@D@FCDABG@@@E@ICGCGGEG@EA@A@ABAD@J@F@B@@C@A@@@AACEC@A@@BADABAB@B@BBDBD@BBDBBB@@@@B@@@B@@@BB@@LALKFEJEDADAFAF@@EDGFYPQLCRALCHKDGBC@K@@@AEMEKIGOIOCQACEICCCABA@MEQEK@G@C@CE@A@@@A@@@@@ABCFEFC@A@A@@@@@CEAGCE@AACACAA@AAA@C@ABC@E@ABC@C@AA@@C@@@CBA@@BA@CBCB@@ABC@CACA@@CC@@@A@A@A@@@AAA@@AAAA@@ACAAACAAAAK@AACECAIBA@EBGBG@@@"]],encodeOffsets:[[[110505,27988]],[[110536,27814]],[[110476,27831]]]}},{type:"Feature",id:"520327",properties:{name:"凤冈县",cp:[107.716355,27.954695],childNum:1},geometry:{type:"Polygon",coordinates:["@@BAFKDGHMNOFCFENGBAHCD@DALKFQGMKMCCKKCGCG@C@S@CDWDIBIFKDGFQCOEEEEGGMOIOEQCEEICIEGAAAI@M@M@C@C@C@CBA@CBA@CBC@C@A@CBC@AAY@CAACKQYQIO@O@G@ABE@ABE@IBABE@G@UKIMAN@FCJIFGD@@IBWBQ@K@A@IHGNCVWTWJKFGFBJABIHWNCBKHE@K@K@KHEJCL@JBB@@CNADEJKHODODSFA@IBQBcRIDGDEFAD@@AA@@AA@A@A@AA@@ACEAAEAA@A@ABBDBD@D@BA@@BBB@BBDB@@BB@FBFBB@BBBBBBBB@DDF@B@BBB@BB@BBDB@ABB@@B@B@BBBB@B@@@D@B@DAD@@AD@B@D@@BD@BDD@BDB@@B@B@BBB@@@BBB@BBDB@@@BBBBBBD@@@B@BBB@@B@B@@@DBD@BB@@DBBDBB@B@@@FBJ@D@D@B@B@@@BBBAB@B@B@@@B@D@@@B@@APDR@JANBNDLFLAB@DBD@BBB@@@BAH@B@B@B@B@B@B@B@@AB@@@@A@AAAA@A@@ACAA@@@C@@AAAAA@AA@BABA@@BABA@@@EDCB@B@@@@@B@@@BBB@@BB@@@BA@ABC@A@C@C@C@@@ABA@C@A@G@ED@BA@AFCBC@A@@@CB@B@BBB@BABADADAB@@AD@@AB@@@BBB@@B@@BB@@@B@B@B@BBB@@BBD@@B@BBB@BA@@@@D@D@D@B@D@HBDBFD@B@B@B@B@D@B@@@BAB@B@BBBBBBB@@@@BBB@@BBBB@HBB@D@B@FFLPLNDDHNFFDFFFDJCFCBIAKAKJV|BX@LHTFHJP@DBF@DBH@H@@@BCLMJIJOJGFIFKDEBOJUJEBMDA@@@A@@AAAAA@AEACC@AAAAA@CA@@AEBA@ABGFCD@BAB@BCDAB@BA@C@C@A@A@ACADA@ABA@AAAACAA@@@ED@BA@@AC@GCGAE@C@GAEBEBCBCFCBCBE@EBC@ABCBCFDFBBBBBB@@@D@PDNDNBBDLJTFFHFB@FDJBJBN@H@FLJHDFLPJBRF@@^BFBVNDHDFCJAFCJENGPAHAHAJDHDH@BHDLDNBPBFBHDFDBHBLBBFBDBF@DBFBDB@BABADB@B@HAD@DB@@BDBB@@@BBD@BC@ABE@A@CBAB@@@D@@@BBBDBB@FBBB@BBDBBF@@B@B@BA@@BBB@BAF@FBBBBB@FBDDHH@BA@C@ABEBGBCBABA@E@@@CDA@E@GBABABAD@BADEBC@AABBD@DBBHDDBDAB@@BBBB@BAFBBBDBBD@BADC@ABA@@DABAB@B@D@DBBBBD@BAB@BABAD@B@BBBBB@BDBBBB@DDFBD@FBBABABCBA@@BC@CBAB@BAB@BA@@BABAJAD@JCBABCDA@@B@@BA@@BB@@BBAJCFA@A@@BBBCB@BEDAB@D@FAD@HBF@JBF@DCB@D@DBBD@DBH@BBJBHFT@FBF@@BBBBB@BADCDADCBABAFE@A@A@A@A@AAGA@@CACAEAE@CBCBABAB@D@B@DBFBFFDBDLBDB@@@B@@@FA@C@C@E@C@ABQBCBGBCBCBCBGBE@@BAB@BAFBDAB@AAB@@C@C@A@EA@BE@@BCB@BC@C@ABACALW@GKSAQH[KMKCaEC@AAAA@A@@@A@A@ABCACAAA@ACAAACAA@A@C@E@EA@AABADABA@C@A@C@C@CAGAE@A@A@C@E@EBCBA@ACCAE@A@C@C@AAA@AAG@A@AAC@A@A@C@C@C@CAEAA@@ABAB@AAEAAAAACAEAE@CAECECC@C@A@A@A@@@@DE@CAACGA@AACCAGFAB@DDB@BBHIBC@CB@B@D@HDDBD@DBD@D@BAD@BCBA@A@IAG@CAC@CBC@ABAD@B@BBB@FDD@DBD@JABABC@CCEAA@@@ABC@@BABABA@A@C@ABAB@@@BABBD@B@@@@DBBDBBBJDFBBBD@@@HCB@@B@HB@FDBB@BAB@B@BFB@BBB@BAD@BDB@FB@B@@@BA@C@A@AB@B@FBBB@BBF@@@D@B@@BBB@DABABCDMBCHIBCFEHIBAFGLIDGDGDCBADCJEHCJO@AEGCCEKGKEICEACGU@YCW@ABGBGDEDGDGDEFIBEBCOBUCMMAOKSGCAUBMBSAMHKDGBAPK"],encodeOffsets:[[110493,28496]]}},{type:"Feature",id:"520328",properties:{name:"湄潭县",cp:[107.465407,27.749055],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@KHOLKLIH@LFHNJFBRLXR\\R@@@BPNDDHFTVDLFHFDFHDDDD@BBFHJHLFLHNFNBL@B@LBNANCL@F@BC@A@ABCAAACEIAICCAECC@EBGDAB@DBDBF@BABEBA@ABA@@D@B@DAF@B@@ADA@CAC@AAC@A@ABA@A@A@@@A@BC@A@AAEAAEC@ACECGCEACCCEACAC@EAG@KAC@C@ED@BEDCDCBAA@ABK@GCMAAA@C@K@KFCD@BAB@B@@@@AAA@AAA@@B@B@@@B@@@DABGFABAD@@BBDD@@CDAB@@BBD@FBD@B@BAB@D@H@B@BABABAF@BB@BAFABCFCD@@@DADBB@DDBDBHRBBFD@BLF@FDFBDHDVPHF@B@BCHAD@BAB@@@B@@@@AB@BABEHABAB@BBB@@DBBAF@DAFADAHADAD@D@B@HBB@BBB@HAB@D@JDB@BBPBHBD@@DCFAFBDDBD@FAFCF@JDBDDDDDFBF@@BBD@FBD@BDBF@BD@BBD@@FBDBBDDFFLNDJFD@FAJGFEDGBCCEECCCAA@CFEFCFBD@NJDBL@D@FDFDFAHGFG@CAAGCKEEAGGC@A@EHEBIC@E@ECGCCECA@AG@CH@J@J@JAHCHGJEEKG@M@IAIAECA@GEEEISCKAACMCM@O@C@@AAAAAACEDEDABAD@FAF@DADADEDAFAFAHBD@F@HBHDD@@BB@@AFC@@B@DBBBBBB@BAB@BCBDB@B@D@D@B@@ABADC@ABA@ADCHEBAB@FA@BB@@DBBBB@BDDFB@BBBBB@BB@@@B@NCFAVIPIFALCJEHEPIJINIDK@A@@@GAG@CAE@CIOEGGS@KAWU{LILBJBDADECIEECEEEGMCCKMKOEEA@C@A@GAA@AA@AA@AA@@@@AAAAAA@A@ABA@A@@@A@C@A@A@A@AECCAGAC@A@C@C@C@@@@@ABA@AAA@@@AC@AA@AAA@A@A@@@A@@AA@@@AA@A@@BA@@BC@@BABCBCBA@AAA@A@ADA@@B@D@DABEB@@AFCH@B@D@B@BA@@D@D@D@B@D@BAB@@A@@AA@@AA@A@@@A@@@@@ADAFC@@B@BA@AB@BA@ABBB@BBBB@@@D@@BBBD@@@BBBBBB@@@@@BA@@@A@A@A@A@A@A@ABG@A@@AA@AAC@CBAEKCKAMBM@ICQBO@@@A@@@C@A@@@A@ABAAA@A@@@A@A@C@CAI@E@@@AAAACCA@@AAC@CA@@A@A@@@AA@A@A@@ACAAAA@A@@CAAAA@AA@@A@AAA@A@@@CA@ACC@AAC@@@C@ABC@@BC@C@A@C@@@AAAAAA@A@@@AA@BCAAAA@@AAA@A@ACE@CAAAAAAAAA@EAEAA@@AA@AC@AAA@AB@@A@CACACBAB@B@FBBBDF@BB@@B@B@BBB@@BB@@BCFEHCJCGECAMIA@WGIECAMKCECIDQJODK@K@AA@CCEEOGUEICEAA@AASOIIM[EUAA@@@ACOGWEQCSCQ@MJUPELCJKJKHK@OACSkCSO@KAMGIE_]OAOCGAGAMOMO]O@@@@CCEE@AAAAE@AAE@CACAEACACAC@AAA@CAAACGGCCA@@A@C@A@A@A@@@ACCIAGBCB@J@JGNEHGBE@CAGCE@A@CBA@@@@@A@@@A@@@A@@@A@@@A@@B@@A@@@@B@@@B@@@@@B@B@B@@@B@@@B@@@@@B@@@B@@@B@@@@@B@@@D@@@@@B@B@@@B@@@@@@@B@B@B@B@D@@@@@B@D@B@@B@@BB@@B@@B@@@@BB@@@B@@@B@@@@BB@@@@@B@@BB@@@@B@@B@@BLDJHBBFF@H@JCFEHGHGBEF@B@@ABA@@@A@@@AB@@@@@@AB@@A@@@A@@@@@A@@@AB@@A@@@@@@@A@A@@@@@@BA@@@@@@B@@@@@@BB@@@BB@@@@BBB@@@@@BB@@B@@@@@B@@IBKBG@G@E@CBABBBBFJNBH@FABADA@A@C@A@A@CACC@@MECA@A@@MDSBO@K@EBGBMD@B@@CBIAYXKBBfATCZPd@D@J@P@b@FIvDLHNLNPPBNB@JFbTNZPDD@JHDDDZCLWPBXJRLLDDHF@BNLJJNLJHFFJHBB@@@BBB@@BBBD@@BB@B@@@@BBBF@@@BDB@@BD@B@@@DAB@B@@@B@B@DABAB@BA@@@BD@BB@@BDBHBD@@@H@FD@@BB@BBF@BBDB@D@PCB@B@@BBB@@@BBB@@BB@BBD@@BBBDDDDD@B@D@@@BB@D@DAD@BDA@@FD@BBD@@BBFBD@DBBDB@BDDBBDF@B@D@BA@ADCBCHAB@@ABEDC@C@EA@B@B@@DDBBBBBDBD@BBB@DBDDB@D@B@@ABABCD@@@D@BBBBD@D@@@@A@A@E@ABABA@@@A@@B@@@B@BB@BBB@@@BBFBDDBB@BB@BDBBBD@BBB@B@BAB@@@BABADCB@@EDA@CBCB"],encodeOffsets:[[110006,28579]]}},{type:"Feature",id:"520330",properties:{name:"习水县",cp:[106.197137,28.33127],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@B@BABAB@B@@@BB@@AH@BB@@@D@B@D@B@D@@AD@D@D@B@@@@C@C@ABC@A@A@ABADAB@@C@ABC@ABABCBAB@@A@CAA@ABADAB@@C@A@A@ADADCFCDADC@@BAB@D@B@D@BABABA@A@ABAAC@ABADAB@@ABA@ADAB@D@D@DABBB@BABA@@BABCBABAD@B@@B@BBDBBBBB@DA@@BADBD@DBB@B@B@DAB@@@BABAB@B@B@BBD@BBBBB@@B@BAB@BAB@D@B@B@B@DADABCBAB@BBB@BBBBAB@B@B@@BCD@@@B@BFDB@@BABA@ABCBAB@@@BAB@DBB@B@@BD@B@BA@AB@@@B@B@BBB@@BDBBBDBB@B@B@BABC@AB@@AB@@BBBBBB@BBBB@B@BABABAB@BBBBBBADCDB@D@@BB@BBABAB@B@B@@B@DBB@@BABAB@@A@AD@B@B@@AB@@A@A@@@@B@@B@D@BBBBBBBBBBDBBDD@DBB@F@BABABAB@B@@CDAB@BABAB@D@D@D@BAB@BBB@B@BABABAB@DAB@BAB@BAB@B@DBBBD@BBBA@@@@BABA@@BABABABADAB@@@DAB@@@@A@A@@@C@AAA@AB@BAB@@@BBB@@BBAD@BAFAB@DA@@BA@E@ABA@ABABADADAD@F@D@B@B@@@@@BBBB@@BB@@B@D@B@@@BB@B@BBBBBDAB@@ABAD@BAB@@@BBB@BB@@BD@D@DAD@B@@CBADABABA@ABEFEDEBCD@BAD@@@B@BAB@BCB@BABABAF@BAB@@CDCBABABABABCBADAB@B@B@B@BBBBDBBBDBBBDBB@@B@B@BABAB@BCBADA@@DABAD@BAD@B@BBBADAB@BCBCBCDKBE@CDEBEBABAD@@@B@D@B@D@BB@@@D@B@DABBBBBB@B@BAB@B@@@BBBB@@BB@B@BA@A@A@C@@@@@C@A@CA@@A@A@AB@BA@@@AB@@A@AB@@@B@@AB@@A@@B@B@B@@@@@B@@AB@@@@AB@@@@@@@@A@@@@B@@@@@B@@A@AB@@AB@@@@@@@B@@@@AB@@@@A@@B@B@@A@@B@@ABABAB@@@@AB@B@@@@@B@B@@@@@@@B@B@@@@@B@@@@@@@@@B@@@BB@@B@B@@@@@BB@@@@@@@@B@@@@A@@B@B@@@BB@@@BBB@BB@@BB@A@@B@@@B@@@@@@B@@@@@B@B@@@@BB@@@B@@@B@@@BA@@B@B@@@@@@@B@B@@@@@@@@@BB@@@@@B@@B@@BB@B@@@@B@@B@@@@@@@@@@@B@@B@@@@@@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@A@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@BB@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@@A@@@@BA@@@@@@@@@@@@B@@@@@@@@BB@@@@@@@@@B@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@BA@@@@@@BA@@B@D@BA@@@@@@@@@@BD@@@BBFAD@D@FAB@DBD@BDBBBD@D@BDBBBB@D@@B@BB@ADADCFCDABA@A@@@@@@@@B@@@@@@A@@B@@@@AB@@@@A@@@@@@B@@A@@@@@@@A@@B@@@@@@@@AB@B@@AB@B@@@@@B@@@B@@@@AB@@@@@@@@@B@@@B@@@@B@@B@@@BB@@@@B@@@B@@@@A@@B@@@@@@@@@@@B@B@@A@@B@@@@@@AB@@@@@BA@@@@@@B@B@@@@@@@B@@@@A@A@@@@@@@@@@@@BB@@@B@@@B@@@@@@@@B@@@@@BA@@@A@@@A@@@A@@@@@AB@@B@@@@BB@B@@B@@@@@B@@@@@@A@@@A@@@A@@@@AA@A@@@A@AB@@@@A@AB@@@@@@A@@@A@@@@@@@A@@@@@@@@B@@@@@@@@@@B@@@@B@@B@@@@BA@@B@@@B@@@@A@@@@BA@@@@@@@@@A@@@@@BB@@@@@@@@B@@B@@BB@@BBB@@@@B@@@@@@A@@B@@@@A@@@@@A@@@AA@@A@@B@@@@@@A@@B@@@@@BA@@@@B@@@@@@@BA@@B@@@B@B@@@@@@@@@B@@@B@@@@@@A@@@@@@@@B@@@@@@@@B@@@@@@@@@@B@@@@@BAB@@@@AB@@@@@@@@@@@@@AA@@@@@@@@@@@A@@B@@@@@@@@AB@@A@@@A@@B@@@@@B@@@@@@@BA@@@AAA@@@@@AB@@@@@@@BA@@@@@@@A@@@@BA@A@AB@@@@A@@BABA@@B@@@@A@@@@@@@@@AB@@A@@BA@@@@BA@@@@@ABABA@AB@@@@ABA@A@@B@@@@@@A@@B@@@@A@@B@@@B@@@@@B@@@@@@@B@@@B@@@@@@@@A@@B@@@BA@@B@@A@@B@@A@@@@B@@AB@B@@AB@@@BA@@@@B@@@@@@@@@@@@@B@@@B@@@BA@@B@BBB@BA@@B@B@@@@@B@@AB@B@@AB@@@@@@@BB@@B@@@BA@@B@@BBB@D@D@DBDDBBBB@B@BC@A@A@@B@BBB@B@BA@@BA@A@A@C@A@ABAB@@BBBBBB@B@BA@AB@@@B@B@@BBXNDBBD@BABCBE@AB@@@@@@BBBBB@DBDBD@BAD@B@BCBC@A@CBA@ABABABABA@AB@BABCDCDEBCBA@ABA@AB@@@D@BABADAFABADABABAB@DEF@D@DADABBB@@@B@B@@A@A@@@AB@BA@@A@AA@@@A@A@AB@@AB@@E@A@AB@B@@AD@BBD@BAB@BAB@BA@A@AAAAA@@BA@A@ABAFAF@D@D@BBB@D@BC@@DAHGDCDCDABCBE@EBC@CAC@E@@AE@@ACAEAC@E@CBC@C@CBCDCBABABA@ABADCBAB@D@B@@@@A@AAA@@@A@ADABAB@D@D@B@B@@A@@@ABA@@@@BA@CBABA@@@ABC@@@AAA@A@@@ABBB@DDBBDBF@DADCBCBADCD@DDDBDBBBDBB@@@D@B@BC@C@@AAACAA@@CCAA@A@CBA@AB@BABA@A@G@ADEDCFCDAFADAD@@@DBDBBDBDBBB@@BBAD@BCDCBE@ABABCBA@CBAACAACAEAC@CAE@E@IBEBG@A@C@CAAA@AAA@ABCBCFAFAF@BA@@@A@A@A@@@A@@@AB@B@B@BBBABB@ABCB@BE@AACAC@A@C@ABA@C@AAECACCCACAA@EBCBCDCBA@CBCA@@AAAC@CAC@CCGAC@A@C@C@@BCDCBCBCBC@CBE@A@C@CBA@ABCBC@A@CAC@A@E@A@E@CCAEAICECAAAA@@@AAC@CCACAECEAA@AA@A@C@CAAAACA@@CBA@A@C@AB@AC@E@A@E@AAAAAA@@@AACCAAAAAAC@A@ABCDAD@DA@@BA@AAA@@AACAAC@C@A@@BABC@@@C@AAA@ABAAC@@BC@@B@DAFB@@B@D@FDFDD@D@D@D@D@B@DD@B@DAD@D@DABABCBCBAB@B@DFBDBD@DB@@F@BCDADAFABADABCBC@ABAFBDBFBBBDBD@B@@@B@@AB@DABBFDB@@@BA@@@A@@@AB@B@@@@@AAA@@@AAAAA@@@@@@@@A@@@@@ABC@C@A@CB@BABABCD@D@F@DADCBABAD@D@@BD@DBDFDB@DBF@BBBBBB@@@B@@@@@BABE@A@ADCDADCD@DADAFBHBBBBB@@B@B@DCDADABABCBA@C@C@@@@AC@A@A@C@CCEEC@A@ABA@AD@B@BAB@B@B@BBB@BA@@BA@A@ABAB@BA@@@@B@@@@A@C@A@E@A@A@ABA@A@A@@@A@A@C@AB@FEDCDABABCBA@A@AD@BA@CBADABADCBC@CACCAACAAACB@DBBBB@B@BABADAD@HABAB@DABAD@@C@C@CAECM@@@@@A@C@C@@@C@AAAEEAAEECAAEAA@AB@@A@A@AB@@AAABI@CA@BA@@@A@@CCCAGAEACCCCCCAC@EAGACACCACC@A@A@AB@@A@M@KCMAIAAEGCGCKASCSAKCGEIIOEIEECACAAAGGIOCECACCCC@C@C@GAE@CCAAAE@CBCBADABABEBABEAGCGE@@QUCAA@A@E@@@A@A@@A@A@CCACAABCBKFC@C@ACCEA@@BAD@@AAKKKEKAIBEDCBKJEHIFGFG@QCA@MAOAQ@WCS@E@G@OCMGEEGGGGCIAC@E@EBMFKHIDE@A@CCCG@MAARGFYX@FGHAFCVK@UOGCA@@@CAM@I@ICMIGCAEAG@@AE@ECEECGAA@A@O@I@G@OAMCQ@A@C@]AOEOCUB[NEDqRc@ICCACA@A@@A@E@IBEBC@CBC@CFEDEBCFCDABEBE@CDAB@@C@CBC@ABA@ABABABEB@@GDA@CBABC@C@C@C@A@@@A@CB@@C@CBC@CDA@ABE@CACAA@EACAC@EAG@I@G@@@C@@@AAC@C@AC@@A@@@ECAAGCECGCECCCECEC@@IAGAC@EACACAE@@@E@AAACACAAAAA@A@@@C@A@A@@A@@@@@A@A@CBC@@@AB@BAB@BCBCBCBCBCBA@A@CAAAAA@A@EBC@C@E@A@ABAB@BBD@BABADABA@A@ABCBA@A@CAC@CAE@AAA@AAA@C@C@ABC@ABAB@@A@AACC@C@C@GA@AAC@@@AAA@CBABA@@@@DAH@@@BAB@B@DAB@DAFAD@F@DCDGFCDA@CBC@A@EDC@C@CBC@ABA@A@@@AAABA@AAA@BCBC@@ACAABAAACAC@@@A@@@A@ABA@A@CB@@AB@@BD@BBB@@@BA@CAAAAACCCAG@C@C@CAECGC@@ACACCCAACA@@CCA@CBCBCBCBC@A@G@A@ABAD@D@D@DBFBD@B@BKJAB@@EDCDEFAFAFA@@B@BBB@@DB@@@B@BA@@BC@AB@B@B@@@B@DBBDD@BBDBD@BBHBFBJBDBD@DBJFDFHDB@BFDDHBFBHBB@B@B@B@BBB@D@BBDBDBB@@@B@BABA@CBCBEDA@ABCBA@A@@HAB@D@B@B@B@@A@A@@@A@A@@@@@@@@A@@A@@@@@@@A@@A@@@@@@@A@@A@@@@@A@@@A@@@A@@@@@@@A@@@@A@@@A@@A@@@@@@AA@A@CBCBA@CBCDCBCBAFAD@@F@DBDF@BDJDHFPFBFDF@FCFADAD@B@BDDJBBD@J@DBFBDDDBD@LEBABC@EBADAF@BFDBFBFBB@D@D@FDTVBBDABAHCDADDDDDDBADABAB@DBLDF@H@BBBB@D@D@DDDDBTBD@DCBAFAJBB@JDJ@JHBBADEHADAFBNDDD@B@D@DCHBJCDBDD@FADCFAD@FDJ@FADAFDDLBBDDFBDF@N@JBN@BABA@C@EBABBDDDBD@LAB@BDCHAF@FDBDDHFDBF@HBBBJHFBD@JCFAB@BBBDAHBFFH@BGDA@EBCBAFABCDEFCBCAG@CB@DAHBBBDADCDABC@EAAFABEBCAE@AB@BFHBB@BIF@DEBCDAD@FDFGDABCJDBB@PARCB@FADCDEBAH@DBL@JA@@LAFAF@FDDBJ@BBF@D@LBB@J@DEBC@C@E@@JAFCBABCEEA@IIA@@CAABAFAJ@FDLFHBHHHHDBLDADEH@F@FT\\DDDBFFDFDBDHAFB@DBDBB@FDHHFFFHFFHHHG`YHCF@DD@D@FBH@FAFADBFFFBAFAD@DBDN@BABABA@EB@@AB@@BB@@HDBB"],encodeOffsets:[[108823,29220]]}},{type:"Feature",id:"520381",properties:{name:"赤水市",cp:[105.697472,28.590337],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@BB@@@@@@B@@@DBB@@@@@BB@@@B@@@BA@@B@@A@@BA@@@@@@B@@AB@BAB@@AB@@A@@@@@A@A@A@@@@@@BA@BB@@@BBB@BBDBF@BBBBBBBB@BAB@B@BBB@@B@B@BBBBBBFBD@D@B@D@H@B@@BB@BDD@BABAB@B@B@BAB@@AB@BAB@@BBB@@@B@@@@@@BB@@@@@@B@AB@@@B@@@@@B@@@@B@@BA@@@@B@@@@BB@@A@@@@B@@A@@@AB@B@@@@@BB@@@@BA@@@@B@@@@@B@@@@@@BB@@@@A@@@@@@@A@@@@@@B@@@@@@B@B@@B@@@B@@@@@@@B@@@BB@@B@@@@B@@B@@@@@BA@B@@B@@B@@@@@B@@@@B@@@@AB@@@@@B@@B@@B@@@B@B@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@@B@@@@@@DA@@@@B@@@@B@@B@B@@@BBBBDB@B@B@B@BAB@@BB@D@@BBB@BBD@BAD@D@B@HAB@B@ABCPAJBLBF@BBBDBLJBB@D@BAB@@@D@D@B@NBF@F@BFH@@@@B@D@B@DAB@BAFCHAFABBFBFDHJ@@JRDDFBDBBBBBAB@B@@@B@B@@@@B@BBDBD@BBB@D@BAB@@AB@@A@@@@@@@A@A@CBABA@@@@@@@AFABCBABA@A@A@C@@@C@C@C@A@A@@@@B@DBB@HBDBFBDB@@BBB@BB@@D@DD@B@B@BBB@@BB@@@B@@@B@@@BB@@B@BB@@BB@@@@BB@@@B@@@@@D@BA@AB@BAB@B@BAB@B@@A@@BA@A@AB@B@@@@@BB@BB@@BB@@@B@@ABA@@@A@A@@DAB@BAB@B@B@B@B@B@@BB@@@BBB@@@B@@B@@@@BA@@B@B@B@@AB@@@B@B@B@@@B@@@B@@@@@D@@@B@@@B@B@BAD@BA@@B@BD@@DDB@DBBBB@D@@@BBB@B@B@@@@@@@B@B@@@BA@@BAB@@ABA@@@@@@B@@@BCBCB@DBDBBBD@JBFBD@BCBG@@@@BAB@BAB@B@@@B@B@@BBBBB@DB@BB@B@@DDBBBBD@D@@@B@BAB@@@DBD@B@BB@B@B@D@@@BABA@CDABA@E@@@A@@@@BA@@@@B@@@@@@@@@@@@AB@@@@@@@BA@@@@B@@@@A@@@CB@@FD@@B@@FAB@D@D@D@DBBB@BB@BAB@D@BBB@BBBBBDBBB@BC@CBE@CB@@AH@H@DBB@DAF@DCDBBBB@@DAB@DABAD@BAB@B@DBB@BBDBDBDBFDBBDBB@HDB@DAD@DABBDBB@BB@BDB@BBB@BBD@@@BAB@BADABAB@BAB@B@B@B@B@D@BBBB@@B@@B@@@BBB@B@@@B@BB@DBDBBD@BBDBB@DBF@BAB@BB@@@B@BABAB@BBB@D@@AB@DB@BDB@@@BDBBBDDDB@DB@BADABAB@B@BB@@@BD@@@DBD@B@D@B@BBB@B@D@B@D@@@DB@B@B@BABABABA@ABABC@@BA@ABA@ABA@A@@@@BAD@H@DA@@BA@A@AB@D@D@D@B@D@B@@@B@BBB@BABABA@BBABABAB@BA@A@CBABABCBA@CB@DAB@BA@@@AAA@ABAH@FAB@BAB@BA@@A@CAAA@ADADA@AC@C@C@CC@A@AB@BADAB@B@D@D@D@B@D@@A@@@CACAA@AAA@AAAAC@ABABABABAD@BAB@@@@@B@BBBBBBBBD@B@BAD@FAFAB@@ABA@A@AB@DAD@D@B@B@B@@B@@@B@BAB@@AB@B@BB@@@@@B@@A@@BA@@B@@AB@@@@@BAB@@@B@@AD@@@B@@@@@B@@@BAB@B@@@BA@@@@@@BA@@B@@AB@@AB@@@@AB@@A@@@@@@@@AA@@@@@@@@BA@@@@A@@@@A@@@A@@@@B@@ABA@@@A@@@A@A@@B@@@@@B@@@@AB@@@@ABACEAA@CEAAAACFCB@D@FADAF@DA@CBGBGBGBCBE@@DAD@BAB@@ABAB@B@H@D@D@B@BBB@@B@B@@@B@B@BA@CB@BABC@AB@@@B@B@@@BB@@@B@BAB@@@B@@@BBB@B@BAB@BABAB@@CB@B@BBD@DAB@B@B@BBD@@@@BBB@@BBBBB@BAB@BA@@BE@ABAB@BA@ADB@BBBBBB@BABCDAFADB@@BABCBABABA@ABA@AAA@AAE@ABA@C@CDCBCBABABCFCBABABCDABA@ABC@A@CAE@A@C@@@CBADCBABC@ABABA@AB@DC@@DCD@BAD@BA@ABCBAB@@@BBBBBD@@DAB@D@@BBB@@D@B@D@B@BAB@@C@ABCBAB@DAB@BADC@@B@DADA@@BC@A@CBABA@@BAB@B@@@B@BB@@BAB@BA@CBAD@@A@A@A@AB@BABCBABADB@BDBBB@@B@BC@A@ABAFAB@B@B@DABABAAAGC@@AA@@BA@@FAB@BABA@ACMCAC@EBABEEAEBCBE@EAG@E@CCCE@GD_ZGHGGEEEGEEGGECA@CACAA@BECGCACEEECACCS[@E@EFGBCKCCAGGGGGAKEECI@EBABBB@DB@JJB@FFADABEDIB@@@F@DADCFI@A@KAC@E@AAI@CAECE@EBKB@@IBK@CAG@ABCFCDEBA@QDOBA@CADIBAHCCE@EBCDCFA@CJE@AAAEG@ABAF@DBFABABEFBD@BADCBCACAABG@CDAH@DBDAFEDCBABEDAFAB@HC@AEGAEBGACAAA@EBIDC@EAIGAAGAE@CAGECCCA@EBEDGACA@KBC@CACCAAAB@F@DABABM@IAM@E@ACCEACKACCBEBC@ECI@EBCDEBC@ECCCAIDGACDC@A@C@CCAMBEBCFGBCAAIGI@ICA@IAEBABCDC@SACACC@C@C@CAAAAG@E@KCCAA@ABCBABCCCCCCCBGDABCBAASUECC@C@A@EAEACAAEE@CBAB@FADABKFC@CACCEACAI@C@AACIACA@C@CBEBEDE@ECEAEOCGCI@ACECAE@@@@@ABADADAD@BAFCHADCFEHAHCJAHCHCH@BCB@BG@C@E@GBEAC@@B@@@@A@@@A@A@A@@BA@@@@@@B@@@BB@@BA@@@AB@@A@AAA@@@@B@@@BB@@@AB@@@B@@@@@@@B@@BB@@A@@@CB@@@@@B@@@B@B@@@@@@ABA@@@@B@@@F@D@@@BA@A@@@ABABA@@B@BB@ABABCBC@E@ECA@AACAAACE@A@CBC@@@C@CA@DAB@BA@@@CBABAFADAD@DA@AD@@AA@@@E@I@E@E@@@A@A@A@A@A@@@A@A@AAA@@@ABABAAGAC@GA@@GAEAE@A@CAAA@@@@ACAA@A@@@A@@DA@@B@@A@A@@@A@@@@@AA@AA@@AAA@@@@@@A@@DC@A@@BA@@@A@@AA@A@@@A@A@A@@BA@ABC@@@@CAAAAA@@@@@AA@CAC@CBCBEBCBE@ABCBCB@BABABA@A@C@AAAAA@A@A@IDC@EBGDABCFAFADADABABEFCDCD@@A@AB@B@@AB@B@BAB@@ABC@ABA@A@C@CA@@A@A@A@@@@BCDA@@BABA@AB@@AB@BA@@@@@AAAAAA@CAA@A@@ACCAC@@@ADADAJ@H@@BDFHDBBFDHDFFJBHBJBF@B@D@@@JB@DBBBD@@BEBAB@BA@@@ADCB@BAF@F@F@FADAFADABCDCBAD@B@DBDBDBDDD@@@F@@BB@B@@@B@BA@@B@@@B@BAB@BB@@B@BAB@@@@@B@B@@ABAB@@BBAB@B@@ADA@AB@@@@ABA@@BA@@BC@@@A@@@A@AAAAAA@@A@@@CBCBABE@CBA@EBEBCBADCD@B@@@B@@@B@@@BB@@B@@@B@@@@@@BB@@@B@@@@@B@@@@@B@@@B@B@@@B@@@BB@@B@@@@BB@@@@"],encodeOffsets:[[108149,29142]]}},{type:"Feature",id:"520382",properties:{name:"仁怀市",cp:[106.40109,27.792514],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@H@H@FAD@FBB@D@LFJFHDHBHBJBHBHDFDBBDDABC@AFADBDDFDBF@F@DBF@FADADB@BD@FAFBDBD@D@HBJ@B@B@B@DAHAD@FBFBD@JADAD@HCDAFAH@FAHCFCHA@@FCBAFABAFABBFAHBFADADCDAFAFABCD@DBHDFBDFBBBBD@DBF@DAJADAF@@@BBBFBB@@FBHBH@HAFDHBDD@@BB@BBBFBFBFD@@BBD@FD@@FFBBBBB@BBJFDBDDFBFFFDFFBBFBHDHFHBJBBAJA@BJBHFFBDBBBDBDBDB@DBB@D@@@@BB@B@BA@@@@BA@A@@@A@A@A@AAC@AAAAA@A@A@A@ABABCBABA@EDA@ADADAB@B@BBBBBBBBBBDBBDBBBBBBBBBBBBBBBDBBB@BBBBB@@BBBDBDBF@@@BAD@B@B@D@B@@A@@B@@@B@@@@A@@BA@@@@@A@@@A@@@AB@@@@@@@@A@@@@AA@@@@@@@@@@@A@@@@@@@@@A@@B@@@@A@AB@@@@A@@@@@@BA@@@@@@@@BBB@@B@FBBB@@@@BBBD@B@BABC@ABABAB@B@BAB@DABABA@C@C@C@CBI@CAE@@@A@ABAB@DAB@BADAB@BBD@@BBB@B@DBB@B@BB@B@@BB@@@BA@@B@@@B@B@DB@@B@D@BAD@F@DAD@F@BB@B@D@DBB@BB@BBBB@BBBB@@BBBBB@BBBDBBBBBB@BBD@D@BADADCBCBCBCDABA@@DADAB@B@B@BBB@@BB@B@BABA@@@AB@@EDABABCBCBCDABCDCDAB@BAB@@@BB@AD@BADABCBABC@AB@@EBA@C@CAA@CA@@CAAAC@C@C@C@CBCB@@ABA@A@CBC@CBA@CBCBCBABABAB@@AFAB@DAB@B@D@DBDBB@DDDBBBDBBDBBBBBBDBDBD@DB@@BB@B@B@BABABBB@@@BBBDBB@B@DAD@DBB@F@B@DBD@@A@AD@@@B@@@BABAB@BABA@EDA@CDAB@B@B@D@@A@AD@FADCDCDEBC@EB@@GBC@CBCBABADAF@B@B@D@D@F@BABCBE@EBE@EBA@ADADAD@@BD@D@DADADA@B@@@@BDBDBJDd@rQFC\\MVAPDPF^BD@B@R@NDPBH@J@P@B@B@HBFDDF@FBF@@BHBFHDNJJDJ@N@DB@@B@HDVPL@DUBEHG@EZWHEBQHeJ]@@@CDKLQxsB@XE@AB@`@LCNALI@@@@XQBAEKCGACFCBA@CBA@EFEAEBEF@@EAGHCBAtUNMDMCWAS@E@AJwCgSG@@AE@@EQCQAE]KMBUBQCKAICQCMEIIA@CECE@ODSDIBGBW@WGQAAMMCGGKIMIGKIEIBK@AFGRKHIFQBE@GBK@S@@CWCGGSMOMKGG@CAEBODM@E@C@@CABC@A@AB@@@B@BBB@@A@A@CBC@A@AA@C@A@ABAAIAC@EBA@AAACEIAAECECA@EBEBGBA@C@ABCB@@AJ@@IA[BQFC@MBQ@WBA@@@OHMJKFI@MAA@OCKEECIEAAKBA@@A@@@ABE@A@A@AA@@@A@@@ABA@A@A@@AC@AAAA@AC@AAA@C@CDABABCHABAFADABCCCCAAA@AAAABCBA@@BA@AAACAAA@A@AACA@@BABEACA@@GCCAQOOIIO@AFOHKEGAAOEABGF@@CBIHKJKDICUC@@AA[EOA@@SDCDEHCFCJCDCD@@@@ABABOLKRADCNGT@@@@C@A@KCC@A@CB@DAFAJAB@@@D@BAB@BGF@D@B@BBFBB@DADDF@B@B@B@BAD@DA@@@A@@IACA@A@@B@BABAB@BAD@@CBA@EAA@CBEBC@@D@@BD@BABC@C@A@OBA@A@A@@@C@IBA@AB@@@@@B@B@D@@@BA@@BA@@@C@C@A@C@C@AAA@CA@@A@EA@@O@WGQCAAQFAF@B@DBHBB@DFP@HAB@B@@@BCd@@ABABABAB@@BBDB@B@@CD@D@BBD@@ABC@@B@B@@BD@BAB@B@BBF@BAB@B@DDB@BBB@D@D@B"],encodeOffsets:[[108549,28413]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("白沙黎族自治县",{type:"FeatureCollection",features:[{type:"Feature",id:"469025",properties:{name:"白沙黎族自治县",cp:[109.451484,19.224823],childNum:1},geometry:{type:"Polygon",coordinates:["@@AOAEAG@CACACAACAC@CA@@C@A@A@A@AAEAAAE@EAA@C@C@CBA@A@A@A@AAA@@CAAACAC@AAAA@AACA@@AAA@CCAC@ACCCC@AA@@A@@BCBA@A@@@@A@AAA@AAAAECGEAAA@A@A@A@A@@@A@AA@@A@AA@AA@AAA@CAA@AA@A@@@C@A@A@C@@AACAECEACACAA@A@ABABADADCDA@IBKDE@ABEBCFA@ABA@A@ECA@A@A@ABABA@A@ABC@GBEBIDEBA@ABC@ABCBAB@@A@@AA@@AEBABA@@@A@@@A@E@A@A@AAABA@@@AD@D@@@DBH@D@DADCDABABAB@BBBDFFHDF@BBB@F@DADABCB@@ABAD@@@B@BBD@B@BAB@DBB@BBD@F@BAD@BA@@BA@@D@BBDA@AFAB@F@DCB@@@B@DDB@DBH@F@BCF@D@D@B@@A@@@A@AB@@ABA@A@@@A@@@ECCA@@@@A@@BA@@@A@E@A@A@A@ABC@ABCBADCD@BAB@BA@A@A@A@CAA@A@E@C@ABABCBCBA@@@CAAAA@A@C@ABADCJABCBGFGBEBA@A@CAA@OIGAGAIBCHAPCJAFALKXEHAN@DDDBNCLIJIFGDEFSLKNIRCLCRABBLHNHRHNDPBFFNBFDHBF@B@FBRCFCDAFGHGJEHEDENAF@BABIACACAK@SLEJELCRILOBMACBA@KDKFCDED@@IJERBN@DDHJHHL@VELCJCN@RAHAJ@F@B@@BD@F@BFFHDDJBHAJADELEHAH@H@NDLBHBBDDH@HAB@F@B@D@J@HAHAFAFCDA@@FADGFADCDAF@H@F@HBBADABCB@@ABAB@BBB@DFBLDDJBFGLSBIDGHEHCHAHI@ABABEHMFEJAD@H@J@JBJ@HOIGICEECG@@AAAC@A@G@AAC@A@ABAB@BABCBADADAB@D@BBDBBBF@BBD@BBB@D@BADABAFADCBABABCBCBAF@D@D@B@BBBB@B@B@BBBDBDB@@B@BC@A@AB@B@B@@B@BBBB@D@BAB@DB@@BABA@ABABCB@B@JBB@B@@@BA@C@AFEFAB@DABA@@BB@@@BAF@@@BB@D@D@FAB@@@B@B@@B@B@@ABABA@@B@@@BD@B@B@B@B@B@B@D@B@B@@@B@@@B@B@BD@D@B@@AF@@@D@BBB@@BBB@@@DA@ABA@@@A@@@@BA@AB@@@@C@A@A@@BAD@BA@B@@@@@@B@@@B@BD@@@B@B@BBB@@@@B@@@@AB@@A@CBA@AB@@@@@DD@B@B@BBB@@B@B@DB@@@B@@@B@@BB@@BABA@CBA@@AA@ABA@AB@@@BDBBB@@@B@DABABABAD@FA@@BCBABABCBA@CBE@A@ABA@AB@B@DAB@@@B@@DBB@@@@BA@@@C@AA@@@@@@AB@@@@@DB@@BD@@@@B@B@@@@A@A@ABAB@@BDDB@@@@B@@@B@B@B@@@BA@@@@@AB@@@B@@B@@@B@@@@@B@@@@A@@@A@@@AB@B@@B@@@B@@@@B@B@@@@@BA@@@AB@@@@@@@B@@A@@@@BA@A@AB@@@B@@@@@@@@@@BB@@@@@@@B@@@@@@@B@@@BB@@@@@@BA@@B@DAB@@@BA@@@@@A@A@A@AAA@@@ABA@AB@BA@@BA@A@@A@@AA@@ABADABAB@B@BADAF@D@@BB@DADABBBBBBDDD@B@B@@@B@B@@@B@B@@BDB@BBABAB@@@D@BAB@BAB@@@BA@@B@JFFFLHFDFFAHAHFNDDHJFJFHJ@LARDJJD@BEFGFCBEEEGEBEJIDG@ABADADA@E@C@A@@B@B@D@DABABABC@A@AAA@AA@@AG@EC@ABAAEDGD@@CEGACBIA@CAEBGE@C@EAEGC@C@ABGA@CBC@@@C@E@ACCK@@EIA@@A@@BAF@NCFADBDBDBHBHBRFDAH@BAAAA@CABA@ABA@@B@B@B@BBDB@@B@FBDBDDD@BB@@DB@BD@B@HBDDBBBBF@BC@CBEHCB@BGBEBABCBAD@BA@A@ABEDCBCDEDA@CBCFA@ADCBABAJCBA@CBCDCDCBCBG@EBCBEBADAB@@@BBBBDDF@@BBFBB@DBBFBD@FAFAB@@A@A@E@E@E@EBA@C@EBM@CBAPQBAFCDADCDA@ABA@EBAB@F@B@BBPBF@JBF@FBHDFBFADA@A@AFSJSLMAGAC@@BCBC@CB@@CDCBCJEFCDABA@C@CAEAG@C@CBABC@A@AAAKOKMAA@@KHGFCDABG@G@E@A@CAEECGCEAE@EBE@G@AA@AACAC@AACACCAAGAC@CAA@C@ABA@E@@AACCACAECGCCAAAAAAC@E@CFIBKBC@ECGCCCAEAEAE@GFMHaROFIBE@IAGCCCEECE@QAEAG@E@GA@AAC@EACAEEIEGCC@CBC@CBA@@@A@CCAAA@G@CBA@CBCBABA@C@CAGCEAEAE@A@ABA@ABADCBAB@@AAAA@GEQ"],encodeOffsets:[[112092,19475]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("保亭黎族苗族自治县",{type:"FeatureCollection",features:[{type:"Feature",id:"469029",properties:{name:"保亭黎族苗族自治县",cp:[109.70259,18.63913],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AAACAAAAGA@@A@@C@CBC@@AC@AAG@AAG@EBG@G@EAGAAB@DIDAB@@C@A@@DA@@DABBB@B@F@B@DC@@BC@ABMFCD@B@D@@@B@@ABAB@B@DBDBDBH@B@@A@A@C@AFADG@A@ACE@A@@@@@ICAEGC@@@C@AAIE@@AMBABA@ABKCIIMACAAA@GCKDAB@BABAA@AAA@CB@AAEAE@AB@@@BAF@B@DAFA@A@A@AACEGBA@AA@@CCCCA@E@IB@@AB@L@BA@AFABAB@@C@ABCBAB@BCCCAA@C@@BAA@A@A@A@C@@@KACCQAAC@GAGCEA@@ABABAA@AECMGC@A@G@A@@@@A@@@@@A@@AE@A@@@@BA@ABAAAAA@C@A@@@ABCBABA@AAA@@@@A@ABC@A@A@AA@@@@CCCCCEEI@GAC@ACK@A@OBEBABI@GAA@@CFCDEB@@CDEFA@CCEGEG@I@ABC@K@@C@A@G@@A@A@A@AAAAAA@AA@CDEBABA@CECEACB@@CD@B@@A@A@EAAAA@@@AC@A@A@A@@A@A@GBAAA@@@A@A@ABCBA@A@A@CACBEBADABABADA@@B@BAAA@@A@A@@ECAA@@@@A@C@A@@BA@@@AA@@ACCACAA@A@@BA@@@A@AAA@@A@@AAA@@@A@A@E@@@CAAA@@AAA@C@ABA@@B@@A@@B@@A@@@AA@@C@@@ABCFCDA@@BA@@@CAA@@@AAA@A@AAA@@AAAAAAA@AABIFA@EB@B@@@BE@A@@BA@A@A@AA@@ABA@A@AAA@A@A@ABCAC@AA@@CBA@C@@@CBAB@B@BAD@H@B@@@B@@@B@B@@ABID@BAJ@@A@AB@@@B@@@@ABA@KD@BEDAD@BA@A@AB@@@H@BAB@B@@B@@@BA@@BAB@BAB@HHBB@B@B@B@BB@BF@B@HABIH@B@JBBDB@@@B@BBN@BDBBBAFADBBDFBBJHBBBB@B@B@BAF@DA@BBBB@P@BAB@F@BAH@BBB@PBDAFA@CDGDEBABMBA@IBEBCBAR@BCFA@@BCCE@A@C@A@A@ABIAGDCBABC@IFOCCAC@M@CDCBABA@EBEDEFEBABC@A@AAA@ABAA@A@AAAECC@A@ABAB@@AD@DDB@@BB@B@D@BAAABA@ADABBF@BAB@DABCDAB@DABAD@B@B@DADA@C@BDABCB@BBD@@@BBD@B@PAB@BED@DB@@BBBBB@BB@B@B@DABBF@B@H@D@F@D@BBDBFFDDDDFLBHBF@@BDFFDDB@BAFADAB@B@F@B@B@BA@ABAD@F@B@@ABABAD@DBF@D@@@@@BAD@F@D@D@FBNFDBHBDBB@@@BAB@BADAD@BADA@@D@D@B@DDBB@DBD@BD@LBD@DB@@@B@@@DCBCHADABABAB@B@D@@BD@BDB@@BB@BCH@BAJCJ@D@@ABCB@D@D@B@BDBDBBBDBHBDBBBBBBDBB@FBFBBBBFDB@@B@F@BBBDDJBDBDBDD@B@@@B@B@@@@A@A@@@@@@@@B@@@@B@D@B@@@DADAB@@@FBDBDAFADA@@B@@@BA@A@EAE@CAE@CA@A@C@A@A@C@EAAAAAAEBA@ABAD@B@F@B@DADAB@BABG@E@AB@BAB@BABA@@BADGBEB@B@FAB@BB@@B@B@@@B@BCB@BADABA@EBADCFCFCBAB@@@@@@D@BBB@BDDDH@BBB@@B@@@FAB@@AB@@@BBBHDH@@@B@BABAD@D@B@BBBDDB@BB@F@BDD@BBB@B@B@BAB@@@BB@@@D@@@B@@@B@BAB@BBB@DBDBB@DAF@DADBB@DBD@B@FB@@BBB@B@D@FAB@DCDAFAF@F@BBB@B@D@BAB@B@@ABBB@@BBD@F@FBBBB@@@B@BCHADADAB@DELCL@@ABEHAB@B@FAB@BEFABADBD@B@B@BABAB@BABABA@AB@@BBBBBBBBB@BBBB@@ADADAF@B@BBFBBBBB@BBB@@BB@BB@@@B@D@B@@CDABCBCHAF@@@BBB@@BB@@@BBB@BBBBBB@@@D@B@D@B@F@B@J@F@DBHBB@@B@@D@HAB@DCDCB@DAB@@AHGHGFAFAD@BBLBL@FDF@B@B@DCDCB@BC@@@EDE@@AAKKCCACAGECCAAAAC@CAE@GBC@CBCAE@CAE@@BABANAPCB@@A@AEYAC@A@A@ABADAJCBA@AA@IK@A@E@CBCBAFCFCB@D@HBNDJFHDB@B@@@@@AC@E@IBGBEBA@@B@DAH@L@F@B@@@@@AC@A@C@EBA@@BAFABAHOBAFEDCDE@@@C@AAACICA@AAG@AA@@@E@CBA@AAA@@AAA@A@CBADEBCBCBCBE@EBEAE@A@@AAA@A@CBA@ABA@AAA@A@CBAA"],encodeOffsets:[[112453,19083]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
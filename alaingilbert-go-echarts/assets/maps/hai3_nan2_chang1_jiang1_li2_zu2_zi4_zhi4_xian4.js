@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("昌江黎族自治县",{type:"FeatureCollection",features:[{type:"Feature",id:"469026",properties:{name:"昌江黎族自治县",cp:[109.055739,19.298184],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@BCBA@@DABABC@C@EAA@ACEEGCEAA@ABABABADCBC@C@CAG@C@@@CBC@@B@BABBB@B@F@B@@@B@@@B@BAFA@EAAAE@CACA@@@A@A@@BA@AAA@CCACACCAAAACAACACAC@A@CCCAAAACCKAC@E@ABU@CAACAA@A@A@E@@@AA@@AA@AEAAAE@EAA@C@CBA@@@C@G@C@EAA@@ACCAAAAAAA@A@C@ED@@A@C@A@EAA@CBABC@CBGDCDEDADADA@EBCBCBC@ABA@A@E@A@A@E@A@A@ACACA@C@CBG@C@@@A@AAAA@@AACAA@A@A@E@CB@@AA@@ACAGAEEKGKCCAC@@CAA@ABABCDA@ABAB@@A@ABA@@@A@ABA@ABADA@AD@BAB@B@@CD@BCBEDADAB@BA@ABA@A@A@ABC@AB@B@BAB@DBB@HBD@@BBBBDBB@BDBB@@@F@L@F@BBB@BDB@BDFDB@DBB@DA@@BBB@BFD@BBD@D@@DD@@@B@B@B@BDF@B@B@@ADAD@@@B@DBD@DAD@FAF@@@F@B@B@B@@@BABA@@H@B@@ABMhADAJABAB@@AAA@C@CBWHG@@@A@A@@@A@@@A@A@A@A@KJMHCBA@E@C@G@E@C@AAAAACAACAC@EDCBC@@@ECC@A@A@KNEHA@@D@B@DBL@DBD@DBB@DABABADABBDBBDBD@BB@FBD@DABABBBABABAD@DBBBFBBB@BB@@@DB@BDBBBB@@DAB@@B@D@FAFAD@BABCB@BAB@B@BABAB@BABCBCBC@CACAA@E@EBCBCBC@@BABBB@B@DCB@BABBBBBD@HBF@B@BDDBDF@BCBIDG@C@C@EBEFMPCFAFCNCLAH@P@JABEDCFCLGJGHKBODG@G@E@GACACAA@CAE@IDEBIHGHCBEAG@G@E@ABEBIFKHQLIDGBE@CBEBABAFCFEDABEDGAIAGEGEEACAKAO@G@EAECECC@CBGBWHSDKFKDGBEBIBMCKBKBSFMHEDCDCHCLAD@BADABGFCDED@@ABADCFCF@DCFGDADAHBFCJCLIPUZNVZ^`N\\LXDf@@@\\BTDdRNNHLT^NV\\XbNfJBBZLVNl\\FFf`h`@@PeDGDGFGDAB@BB@@B@DBF@FAFADADEBC@CBA@ABAB@DAB@B@DAHCD@FAD@B@@CBKBIBEBABABAFABA@ACABIBCDG@CDABE@GAC@AFCHCDAH@F@H@HDHFFBJFHAFAJE@A@EAC@@@A@EBIBG@QDMDIFK@UGKIGCG@CAMFQJI@@FCDCLELCB@DANBPAJKDQFKFITKL@DBDBJBBA@ABEFMFCFGHIHGBEDCDEAQ@E@AAECGAEEMAECOGMGQGMAKBADQDKJQLMTKFEHCJEJIDKAMCC@CBMFGLWBKBEDIBODGJAHBHBPJB@DBB@B@FAHAHEDABADIBCBAD@B@B@BBDB@@B@DADABABAD@F@B@B@DBB@B@B@B@@ABA@ADCBCDABAD@BAB@B@B@F@B@@@B@@AB@@@@@DBFD@@B@@@B@B@BA@@BAB@@@B@@@@A@C@CDE@A@EAG@CCA@C@A@@DA@C@EBABEB@AC@A@CB@@AB@@ABC@A@EAC@AAA@CBA@A@AAC@A"],encodeOffsets:[[111904,19466]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("澄迈县",{type:"FeatureCollection",features:[{type:"Feature",id:"469023",properties:{name:"澄迈县",cp:[110.006754,19.738521],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@ABABCFEBAB@F@BA@@@CA@AA@@E@EA@@@A@ABAB@@A@A@AAACCAAAA@@@A@E@A@AA@@ACACCA@A@A@CCAA@A@A@C@AB@BAD@BAB@B@BBDBB@B@BADEBA@A@A@AA@AAA@A@C@CBA@A@C@CAGAA@C@@@AB@@CD@@A@@@AAAAAC@C@C@A@C@C@@@A@@@A@A@@CAA@AAG@C@AA@@BA@AB@B@B@D@DB@@B@@ADI@A@A@AAAA@AAC@A@AAAA@@@A@@@@D@@A@A@@B@@@@@BBD@@@@@@@@@@@@A@A@ACCAAAAAAA@C@AAA@A@C@A@@@GGECAAA@A@G@A@A@CDADGJCDABAD@BA@E@AB@@@BBF@B@B@D@B@DABABCDABA@ABAAABC@@BAB@@BBFF@B@@ADA@ABA@AAA@AC@AB@@A@A@@@A@@@AACAAAAAACAC@AAC@CAA@@AAC@C@A@AB@DAB@BAFABCBAB@B@@@BA@AB@@AAAA@A@C@AB@@AAAA@@ACAAA@@@A@AD@B@@ABABA@A@ABCDCBABABABA@A@GAAAA@ACA@CAAAA@AA@BA@EAA@A@@@ECACAAAAC@AAABCA@@A@CCA@AC@E@@@A@A@@@@AACBC@@@@B@BAB@B@@A@EBA@A@A@A@@BCFCDABAB@@@@@BDF@B@@@@EH@@ADCB@BAB@@E@E@CBCBA@C@AAG@IBA@CB@DA@GFBB@D@@@BAHADA@@BBB@@FBD@BABADADAD@B@B@B@BDFL@B@B@D@B@D@BAB@BE@CBA@AD@BA@EDAFAJ@FBJBFBDAD@D@HBF@DADABC@@@G@I@GBGH@FBNBFBDHLNHNPFFBDDHRNBF@DABEDG@IBCDADEFEBCBBBDBBD@@D@B@B@BBB@@@@BB@@D@@@B@ABB@@@@@BB@B@@AA@@ABA@ABB@@BAB@@@D@BBDBCF@DBDDHABAB@D@BBBDDFBDBBBBDBBFBFHBHJNHT@FDB@@H@FGLGLAJBNCDALBPJCHSFGBENBJHFLJDDBPFHNHLDD@D@LFDJ@JDNJR@VBPFLDDFFHJJLFHBDBXFNAFAHCLBBHBB@BBDJBB@JDJBFBF@DEHGDCHABOHGBAD@DFP@JBDDBBBHB@DCLGBGBEB@B@DBJB@BBJ@D@BB@B@D@B@B@JBF@B@@ABA@E@ABA@CAA@ABA@@D@BAB@BABA@@@C@C@EAC@CBABCD@BEHCBEBG@CBDDBBBDD@BBBBBD@BAD@BABADADCDABFBF@F@DALPFM@A@ADAF@BBDC@CHABCFBLN@@@BDHDL@R@LBLKBC@IDIRI^OjI\\ZFfJLBNDtBd@ZAVAbKVKJCZBXFjNNHVL v^cPQ@AJG@@BAAC@CAE@A@A@@@A@AB@B@@@D@B@BAFGDCD@DBFADAF@DBBDDAB@BGBIAAAA@ABA@ABGC@C@@AA@AA@A@CBA@A@ABAAAAACEEO@@@@AC@ABCB@B@BAFAB@BB@BB@FCBA@ACBA@C@A@@@C@AA@A@AHABABC@EA@AFABCDEB@BABCB@A@@@A@@BA@@AA@@@@AAA@A@ACE@A@AD@@ABA@@@A@AAAEAGBAFIFCDCDCB@@@B@D@B@B@BA@ABC@CICCAECEG@A@ABCBAD@FADABABC@GAI@C@CBCBA@A@CEBE@E@CACAE@@A@EBA@ABABAD@D@DDJDFFFDFBFAHCPEBBNDBBBBBA@ABEBGBEE@AAI@EBCCCC@ENADBF@HAXQ@EBE@E@CDA@E@C@CCGCG@@@A@@BADOBEDQBCFEBEACZKBABA@A@CACAE@A@CBCBCBCDABACAA@CBAC@AAA@AACA@AAC@CAAA@C@C@CACCA@C@CAAAACA@A@GBG@CFAD@F@H@D@@CBEBABABAAA@AAAAC@CAAAC@C@E@C@AGEBA@EDCHEF@HDDB@@HBBBH@FDF@F@FBH@FBD@@A@CACAACCAC@C@C@C@AB@@AA@@AAACCG@EACAEACCAAAC@ABE@M@A@A@@@C@AA@C@C@A@A@A@CEACA@AA@@@@A@AAAEAAAAAABC@CBABAB@B@BABCBC@A@@@@AA@@AACA@@A@A@A@AB@DAD@BAB@@C@CDABA@@@KCIPKFA@@@CBABCBA@A@ABA@A@@BBB@D@BA@ABA@A@A@CDADBB@DCH@B@BDD@B@@@BCBADA@ABAB@@A@CFEB@@A@@@MFEAKCGI@IGKIAI@EGHWDIFIBCA@BE@ABG@C@CACACCACAAACAA@EACCCCAA@CAA@CBABCDEF@BDDDDDD@@EBC@ID@DBBA@@@C@CEAC@C@EA@E@A@AAC@CBCBABABA@GBAAA@@@@@@AB@@@@AA@@A@@@CBA@A@@@AB@@@@A@AA@@@AA@@@@AA@A@AA@@A@@AABAA@@BA@@@A@@A@@A@@@AA@@@AA@B@@@@A@@@AA@@BAA@AA@@@@AB@@A@@@AC@CCK@G@C@C@BB@BC@@DA@@@ABA@@@A@@@C@@AA@@A@@A@@@AAE@A@A@@@AB@@AHGDGBIOIOCQBG@@EEEGAMEGDCD@@AGAGHM@ICIMISGSEMDGLAJAPADAFIF@@IDQCGBABEDIBG@CGICIDMDGDM@IBKAGAEA@@UABA@E@CACAA@A"],encodeOffsets:[[112576,19944]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("儋州",{type:"FeatureCollection",features:[{type:"Feature",id:"460400",properties:{name:"儋州市",cp:[109.580811,19.521134],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@NBP@dEjIHGBCACCAEACA@CCCC@KBCBI@]JCBAD@BCBIAGAMEGBID@FDHRJ"],["@@L@HAHAFBPJBB@B@F@DDHBF@B@R@NFLDBF@LALED@D@BB@FADBFBBFDXJD@H@F@D@hIFAFARMDGFAJ@JBN@bCzEhGlI YxMJCB@bGREPEDI@IDSBGBKAU@E@C@@AC@A@E@E@EBIDMBGBGDKHEJANAJEHU@E@A@@@@AG@A@@ACBA@I@MAGCECC@EECA@KDBI@AA@IEEECCEA@@@@CAG@GFADABC@@IAMDIDEB@BADBB@D@DEFMBABC@OEEHEPIDGFMEGCEAGHAF@VJJOHADEN@DCDBDFBBN@JBF@DCFID@@FFDBADEFBH@D@DBD@B@BAF@D@FB@ASECCEIBEAIJACGDA@ABCAADE@A@BBBAJB@DEDE@AACF@@CB@B@B@@A@AAABABA@BA@@DBBB@ABCF@@AB@D@@DAFADCBA@A@@BB@@@C@A@A@@BAAAB@B@@F@@B@BC@CB@B@FEBBCDCBADBBD@@EDABBFAF@B@@ABCBADC@@@@BABCFAFFDDNHB@B@@BREBCB@B@FB@@@@@@@@AB@@AB@@CBAB@@A@@@A@@@B@BB@@@B@@@@BA@ABA@@BABADAB@BB@BB@@B@@@BC@@@@@B@B@B@B@@BBD@BBA@AA@@A@@@ACCAA@C@@C@A@A@C@@ABADCFABCDBHCF@F@DAJHF@DBF@AAACAACAEACCAA@A@CBABACGAC@CDECAAAC@@@A@AB@@AA@BAB@BB@@BA@A@@A@@@@AA@B@A@@@CA@@A@@A@AAA@A@C@@@ACCAAADAFAFEBCDCJAH@FCBA@CAEQMCGACEEMOMGGKACAEAM@EHGHAJ@H@@@D@BABC@CAE@G@CBCACAEAI@EBIBEFCB@@ABCB@DAF@@ABA@A@C@A@C@A@AEKACA@A@A@C@CBCBABABC@EA@@AA@AB@BCBG@A@@@CAAC@@B@@A@@@A@CAC@A@A@CAC@ACCACAGDC@EB@BC@IJCDA@@@ABE@GAA@A@CACAC@@@A@GACAAACAAAGCC@C@C@C@EDA@A@A@CAICCAC@CC@AAA@C@A@ABABADABA@@BA@A@A@ACC@A@E@CAE@ABABABCBABA@@BAAA@ACGACAEBC@EDAHABAB@BBBBB@D@@@D@@@B@@@B@BAB@@@BA@@@@B@@@@BBBBBB@D@HA@ABCB@B@HBF@B@F@BABBD@F@@@FA@@@@AAAA@A@ADCBA@C@@@AB@@@DBBBBBB@B@B@@@@A@C@CBCBA@CBA@@BABA@@@AA@AAAA@@@@@AB@BK@E@G@EAEAICGEIAEACACCACEAEAGAG@ADCBABABCBEBCBE@CBE@ABABE@E@A@I@GAG@I@A@GAEAAACCAAA@@@GACCCAA@@ACAA@A@C@AAC@A@A@ACAACAAAA@C@AAC@AAEKNITET@B@BCBEBEAGCEAE@IAE@OAAAA@E@A@AB@FAB@BCBCDCBEDABORAB@DAN@F@DAB@F@F@F@F@B@BA@EBEBC@EAAA@CAAAE@AE@CCAAAA@@A@CBABAFAD@FAHADCDCDAD@DABIDABABCD@BEBAD@DCBCFADCDAF@B@BABC@ABADABAFAHA@GDAF@DADE@AAAACCGAA@C@@ACA@@AAC@CCCAEAA@@@CAAAA@A@A@@@AB@BABDBB@BBABG@CBQEGAGACACACAEBMDE@AB@@@BB@FJ@@DLBDF@D@@@D@DAB@AH@B@DHDBF@F@DHFFADBB@AJBDFH@DC@CHBFAB@BFDH@@BB@@BBB@B@BADABABCBC@A@A@@@@B@D@FCBCBAB@BCHIJAFHFFFAFEDEHAFC@IIQCKBI@EGEIGICCEMBGBGEEECKGEEIEA@@@AB@@A@ABA@ABC@@@A@ABAB@ACA@AA@A@@@A@A@@@A@A@C@CCAAAAAACBCBA@@AC@E@CBABA@A@ABCBAB@BB@@BB@@@@BAB@@ABA@@BAB@B@@BB@B@B@B@B@@@@AB@@A@CBA@@@AB@@@@@@AA@@A@@@@@@@A@@@@@@@A@@A@@@@@@@@A@@@A@@B@BAB@@@@@BA@@@@@@@A@@B@@AB@@@@A@A@@@@@@A@@@AA@A@@B@@@B@@@B@@A@@@@@A@@@A@@@@A@@BA@@@@B@@A@@@A@A@A@@@A@@A@CC@AA@AB@B@B@B@@A@A@@@@@AC@@CA@@@@A@@B@@@@B@@B@D@@AB@@@@AA@CA@@@A@CBA@A@@BAB@B@BAF@DABADABABAD@@EBC@ABABABCBA@@@A@AAAC@@A@@BAB@BBB@@AB@DABAB@@AA@@@A@@@A@@CAA@A@@@AA@A@A@ACC@@@@A@@BAB@D@BA@@B@@A@@@@@AA@A@A@A@@ACA@@@A@@@@@@@@AABC@AB@@@B@B@D@@A@@BAB@@@@@B@@AB@BCB@@A@AA@@AA@A@C@@BE@@@A@CACA@A@@@A@@@A@A@C@A@A@A@A@A@A@C@@A@@@AB@BABA@@@A@AA@A@@@A@EBC@C@A@@A@@BE@A@@AA@@ABCBA@EBEF@B@DAB@@A@A@IAA@A@ADAB@BABAB@@CAA@ABC@A@AA@A@AA@A@A@@B@BADA@@@CACAAA@A@A@AAAAAA@C@C@E@ABADADABABCDEBABCBABC@A@AAC@AAE@AACAAAC@A@CBCBABADABA@AB@B@BBD@B@H@BBDBB@@DHFFJDJHGPI@IAI@G@C@IBEFGNAFAB@BGJGBGDGFCHAJKTEHIACCAKCEA@AAA@AB@BA@ADCBABGAE@G@E@CBCDEBCHEB@@CBEDEBGBGBI@C@A@E@A@GBG@CCAAAGCK@M@GBGFGFKBCBIAGCIGCEEIFEBGBIEEAGEGCG@E@G@CBGDED@BBD@HAFCB@DCHADAJDB@BABEBABABABAFAJAL@DA@C@EBC@GDCBA@A@CBA@AB@BAB@DADCFCBEBEBE@CAA@@@AAA@CBEHCHCHOfNNTVXRLFhRRNRNXPXhN\\XjNPPR^NXHhDRDBLDRHJZDDN@B@D@B@BABENEPELABAJ@XBL@H@HFLDHFJJJHJLHVPJBLDB@B@BL@HDFJNLNJFPJ"]],encodeOffsets:[[[112054,20403]],[[111853,20341]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
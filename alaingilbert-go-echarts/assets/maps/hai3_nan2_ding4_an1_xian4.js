@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("定安县",{type:"FeatureCollection",features:[{type:"Feature",id:"469021",properties:{name:"定安县",cp:[110.359339,19.681404],childNum:1},geometry:{type:"Polygon",coordinates:["@@PGVGFC@ABEBEBK@IEIKAMACCGK@@AIAK@KDIHIDIAGAGGGC@EAEDEFIFQAGEECGIAAGEMACBKFIBICIGGAA@K@EFIFIFKDICA@KAI@BJBNCZ@FDTBV@R@@@DDJCDBHDF@BAHABAFABEAE@CBAFCFCBABCF@@ACAA@@AAA@GBA@GAAAICIA@@EHCDDFDDABGBCBCJBDDFHDFHBFAD@FEHBDDDABC@@@CBABIEC@EFCHCBEF@F@DBF@DADAD@@@DADAD@@AB@BAD@DAD@DBDBBDD@@@D@R@^BJLRHFN@HAJ@B@PC@@FE@CBADEBC@@FALE@@JAB@DA@BBB@B@BBB@BBD@BSCGB@@ED@@CDA@@DCH@BCF@BA@KBA@ODEBJNLHRAPGTC@@@@BB@@B@@@B@@@B@BBB@B@B@JR@R@T@@@DBLFDBBFDRALJ@LEL@BCDOREBCBEBC@@@A@@@ABA@EDABABA@A@A@C@A@@@@@A@@ACBC@EFA@CBCBEBGDEB@BAB@B@L@BBB@@DBB@BBB@@DA@ABA@@B@B@DBFBD@@@BAB@B@BADAF@B@BBD@B@D@BC@ABCBADAFABBD@F@B@BABA@@@C@C@@@A@AACAA@@BAB@@AB@@A@@BA@@AA@A@@AA@@BA@@@A@@@@AAA@@@AA@@@AA@AA@AA@@@@AB@@A@AA@@A@C@C@@AA@@A@@@AB@@@BAAC@A@A@@@@B@@C@@@A@@C@C@@@@A@@@AB@BA@@A@@A@@A@CA@@A@@@BA@@A@@@AC@@A@A@@@BA@A@@@A@@C@A@A@A@@@AA@@A@@@AA@A@@@@CA@A@A@AA@@@@@A@A@ABAB@@AB@HABABABAD@DBD@B@B@FFBD@D@FB@D@D@@ABCAC@@JAD@FC@CCCCACE@CFADAB@DBB@DBBDDDDFBB@DBBBDBDBBDBD@D@DAH@BAFB@ADEJCJGXFHJ@JBHL@JHJLDFBNE@@B@@@FADEB@@@BABAB@BCDA@A@@@ACC@A@ADG@CAABCDCB@B@B@BAB@@A@CAA@AB@B@BAB@B@DABADA@@B@LEJOLD@@B@BADCD@@@BA@ABC@CBAB@B@B@@@DBBB@@BB@@@@@BADADABA@A@ABAB@DADBBBBBBBFBBB@@@@@BBB@BDDFB@B@B@D@D@B@@B@D@@@B@B@NAF@BBDBBDDFBDBFBH@DDBB@BB@@BA@@B@D@D@DBDDDBBBD@D@B@BAD@FBB@BD@D@BBDBF@BABAB@@BB@BBBDB@D@D@DBD@B@D@D@DBD@FADADADAFAB@@AFBDBBBBBBBBDBDBDBDBBBBFJF@FBDBFBBBBB@@FBB@DAFCDADADBBB@BD@DAD@B@F@DAB@B@F@FAB@B@B@BBBD@HBH@DB@B@B@BB@@B@BA@A@@AAA@@AAA@ABA@A@@AA@AB@B@B@B@BA@A@CB@@A@CBA@A@@@AD@BA@@@C@A@@@AB@BA@@BEAEACACAAIECACAA@AAA@@ABE@A@CAAAAAA@@AC@@AE@C@GAG@@@C@GAEBC@E@ABCB@FCDCBC@A@@@A@@@AB@@@@E@A@@@@@AB@@@@@B@@A@@@@@@@@@A@@@A@@@A@@@@B@@@B@@A@@@@@@@A@@B@B@B@@B@@@@B@@@@ABA@@@@@@@@@A@@@@@@AA@@@@@@B@@@B@@@@@@A@@A@@@BA@@@@@A@@@@@AA@@A@@@@@@@@@A@@@@@@B@@A@@@@@@@A@@@@@A@A@@BA@@@@@@@@@A@@@@@@@@B@@@@@B@@A@@@@@CB@@@@@BB@@@@@AB@@A@@@@@@B@BA@@@@B@@@@@@@B@@@@@@@B@B@@@@B@@B@@AB@@@@B@@B@@B@@@@B@@@@@B@@BB@@@@AB@@@B@@@B@@@@@B@B@B@@@@B@@B@@@@@@@@C@@@@B@@@@A@@B@BB@@@B@@B@B@B@BA@@@@@AB@@@@@@AAAACAA@@ACAA@C@A@CBEBCBABEBAB@BAB@BADAF@BABABABAD@B@B@B@B@B@@@@AD@DCD@BAD@B@B@BB@@BDBF@B@@@BCBBB@BBDB@B@D@D@B@DAB@BAB@B@BAB@D@BAB@DB@BBD@DDDFDB@DBB@B@BAFCB@FCDCB@F@B@D@BA@C@C@CBC@CBC@C@ABAD@DADADCDA@CCAAAECA@E@MC@@KCIGCGCEMIKEEEA@@@ACAKDQ@KDIDCHCH@D@B@PHJDHBLDL@NAHEJEJEDAFCHEJIFG@CBEAM@AACGGGA@@MAOCSFQDIAECEEEECGAGAIEEGCIBK@GDCB@AB@@@@AAABA@@@AA@@@@@A@@@@@@BA@@@@@@B@@@@@@@B@@@@@@@B@@@@AB@@@@@B@@CBI@C@IAA@IGAAECGIGI@CAA@AABAA@@A@AAEE@@CC@ACACACEA@A@EDEBA@GEIOAGMKA@A@CACAE@C@A@I@GCGC@@GKCG@OBEBE@CAK@AEC@@C@GAMBK@KICO@AAE@CCOEI@@EIDMHCHEDA"],encodeOffsets:[[112986,19773]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("东方",{type:"FeatureCollection",features:[{type:"Feature",id:"469007",properties:{name:"东方市",cp:[108.651815,19.095351],childNum:1},geometry:{type:"Polygon",coordinates:["@@K@CAAAEGEGAEAAAAAAAEA@A@A@CBABKBE@A@A@@@AAACA@CAA@C@EBKFCBA@C@GACAA@CBYBWEMCQCIEKEIGSKQQWIUGSOKIQSEEKIM@OBMBKAKKGFILOPKDICECS@I@C@KAIBA@A@ICUMCE@EAEKAVCT@BIV@DIJ[`qrapI^@BA\\@TDTL^LLdd\\ZDFHT@FGCl@JA\\ADOLQLIRAFS|K@HA`@X@R@FD\\DjBRJBRHvFXNZbtZVYJODKDIAEBGBCHCDE@CDEDEBCBA@@FCDCHEBABC@ABCDKDGDCFCNGTELALANDJAFAHALCLETCXGHADAD@FDFDFBH@P@LBDBFBHFHFJBHBFCBAFCDEBEBAFADAF@HAJCRKLGJEFABAF@H@H@FBDAHGJGFAJCF@DBB@DBDBHBF@H@H@PCLAHGHIDKDEFCBA@I@OBGDKDMBEDENOFEFAD@D@H@JCDA@ACECAACA@E@GAC@AAAABA@ADA@C@AAABA@AD@DADAFAF@B@DBDBD@DADABA@ABABA@A@ABA@ADABA@ABCBE@E@C@AA@CB@@AAAAACA@@C@@AAA@AAAEAA@CBCBABAAABABA@CAC@EAAC@CAAAACBABCBABA@CAA@CAC@CAK@C@A@CB@FGLMB@B@D@FD@@D@DAFCD@DBBBBDBBBBD@F@H@D@F@B@DANGLIB@B@B@B@@@B@@@B@B@@@H@XGDAD@B@BB@@BABABIBCNgBA@@@A@GB@BA@A@@@A@A@A@E@@BE@EBC@CAC@C@A@@BCBC@@@A@ACE@A@A@A@A@@CC@@@CAC@AEC@AAA@AB@@CAA@CCACE@ACA@AAA@A@E@K@E@@AAACA@CAAAAA@@AC@GAA@CBA@A@ABAD@BAB@B@B@BAB@@ABABCFCDA@ADC@@@ABA@ABCB@BCBAB@BAB@@@B@BAB@@@BABAB@DCBABABC@AAKCOCAGAGAEBG@E@IAGCIICECCGCGIAC@EAS@M@EAEIKA@CBC@K@MAE@AAAAAEA@AAEBCBA@C@C@E@CBGBC@IBGDABA@AACCA@CAA@"],encodeOffsets:[[111624,19257]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
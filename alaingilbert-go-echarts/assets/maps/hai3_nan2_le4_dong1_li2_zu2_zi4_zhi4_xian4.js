@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("乐东黎族自治县",{type:"FeatureCollection",features:[{type:"Feature",id:"469027",properties:{name:"乐东黎族自治县",cp:[109.173054,18.750259],childNum:1},geometry:{type:"Polygon",coordinates:["@@AGBO@KBELQJELIDCFEFEDG@C@ABABAH@F@@@FCFGBG@G@ECKAGAK@ABCBCDCFEBAB@B@BABE@A@@BA@@@A@AAAAA@AA@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@A@@@@@@@A@@A@@@@@@A@@@@A@@A@@@@@@@@AA@@@@@@@@@@A@@A@@@@@@@@A@@@@A@@@@A@@@@@@@A@@@@A@@@@A@@@@@A@@AA@@@@@A@@@@@A@@@@@@A@@@@@@A@@@@@@@@@@@@AA@@@@@@@A@@@@A@@@@A@@@@@@AA@@@@@@@@AA@@@@@@@@@@@@@@A@@A@@@@@@@@A@@@@@@A@@A@@@@@@@AA@@@@@@A@@@@@@AA@@@@@@@@@@@AA@@@@@@A@@A@@@@A@@A@@@@A@@@@A@@@@@@A@@@@A@@@@AA@@@@@@@AA@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@A@@A@@@@@@A@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@AA@@@@@@@@@AA@@AA@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@A@AA@@@A@@@@@@@@A@@@@@@@@@@@@@@AA@@@@@@@@@@@@@A@@@@@A@@A@@A@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@AA@@@@@A@@@@@@@AAA@@@@@A@@A@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@AA@@@@@@@@@@A@@@@@@@@A@@@@@@@@A@@@@@@@@A@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@AA@@@@AA@@@@@AA@@@@@@@@@@@AA@ACA@@AA@AA@@@@@AA@@A@@A@@@@@@@@A@@A@@@@AA@@@@@@AA@@@@@@@AA@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@A@@@@@A@@@@@A@@@@BEDEDE@@DI@A@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@ACAECCAACCIA@@CBCDCACAEE@@@@AA@@@@@@@@@@@@@@A@A@CJAN@@@@@@@B@@@@B@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@A@EDEBCAC@C@@@@@A@@@@@@B@@@@@@AB@@@@@BA@@@AD@@@@@BA@@B@@@@@@@B@@A@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@BCFEFCBKH@@@@@@@A@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@B@@@@@@@@@@A@@@@B@@@@A@@@@B@@@@@@@@@@A@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@A@@@@A@@@@@@@AA@@A@@@@@@@@@@@@@@@AA@@@@@@@@MBMBGBEDE@EAECCEAGBG@EGCAAAE@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@@@C@@@@@@@A@@@@@@@@@@@@@@CGCAAEAG@GDI@IBEFA@@DCDO@MAGBGEE@AAEE@CAACFE@AA@GEECEAIBIBCDELEDA@@@@@@@@@A@@@A@@@@@@@@@@@@@@@AB@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@A@@@@@@A@@A@@@E@CCE@AA@AGBID@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@MC@AACAB@A@@@@@@@@A@@@@@@@@@@@AAAD@BGDIBAE@@@@@@@@@@@A@@@@@@@@@@ACGACAACCACAECCCGCCBCBCBCBA@ABCDA@A@AAAA@CAAGOAC@AAA@AA@@@A@@BABC@EBC@GAEEAAA@AAAB@@A@A@A@@@C@AAA@A@AAA@A@A@ACCAAA@AAA@AA@@AKBGAAA@@BACC@AA@@AA@A@AA@AAA@AAAA@AC@ACE@A@E@C@@@AQCAAACEACAGECA@CBEAACCAAAAA@M\\M\\[v[hEHIFCB[@KBG@C@KDoNGBgPIDUDMDGBG@K@OBi@yBkHePWNQXIZEjAJCh@JDp@JFZH^FVNxDXHvBJGLBF@FDFVNJDB@B@JALBD@J@T@FDJDLCPOJKHELLLBNAPAN@LJFFRTLJTPVHXJRRTLJHLFJFRDNDXFZADAB@DBHBD@B@DALEFAD@B@DBB@BDBB@@B@B@F@LABADAB@B@B@BFBBBBBBBFFHFHBBDBL@B@DBB@DDBBB@BAHCJAD@HADAF@D@D@B@DAFABBB@BFBBBBF@NBL@D@DAB@JLBF@F@NBT@FBDHJHDDDDFJJHDJBF@H@FAHBHBDBDPBL@BADB@DB@@BDDDHLFLBFBHBD@@BB@@DAF@B@B@B@DBBB@@BBBBB@@@D@H@DAD@B@BDBDB@B@F@B@B@F@B@B@BAD@DADAFAB@BCBCFCDCHCDAD@BADAB@FBB@D@B@@@FCD@B@B@BBBBBBDD@BB@FBD@H@D@@@B@DAD@B@FBF@BBFB@BBB@@BB@@F@B@B@B@DBBB@DAV@B@FBDDLBDBBDBDDB@D@DBDBBBBDBBDBBDBDDDB@BBB@@AB@B@@@B@BD@DBFBB@F@BB@@BB@@@BADABAD@BAB@FAJCFAHAD@BAB@B@BABAB@B@B@FDB@B@BAB@DEFABAF@@C@AA@@@AAA@C@A@C@AA@AAA@A@EACAAECICAAACAA@CDC@A@A@@AAC@@@@@@AFG@@@@@@B@B@@@@@BA@@B@@BB@@@B@BA@@@@BA@@@@@AB@@ABA@@@A@@@A@@BA@@@A@@@@BA@@BA@@@AB@@@@ABA@@BA@@@@@A@@@AA@@A@@@A@@@@@A@A@@B@@@@@@@BA@AB@@AB@@AB@@A@A@@@@@A@@@@AA@@AAA@@@A@@@@@@@@A@@@@@@B@B@@@B@@@@@B@@A@@@@@@@@@A@@@@@AA@@@A@@@@@AAAA@@@A@@@@DAB@D@FABAD@B@B@B@BAFABABA@AFGDCDEFEBCBA@A@AAAACCACCCA@@AAA@@A@A@AAA@@CA@@@A@E@@AA@ABA@@@A@E@C@C@@CAAC@AAGAAACCAAA@A@A@C@E@ABABABA@A@A@AAC@ABGBG@A@A@ABC@E@C@ADA@AB@AAA@@@GBCAAE@KBEDEBABA@CC@AAAA@ADOBEDEBE@CACCC"],encodeOffsets:[[111981,19181]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
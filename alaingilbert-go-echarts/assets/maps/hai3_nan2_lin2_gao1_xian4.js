@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("临高县",{type:"FeatureCollection",features:[{type:"Feature",id:"469024",properties:{name:"临高县",cp:[109.690508,19.912025],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@MGCCEEEBADAB@@@@CDABAD@BA@E@EBAACB@FC@AABCDADCAAEFA@A@@DADA@@@@EA@A@BBAB@@@B@B@D@@AA@@@BABCDEBCB@@@CBA@@DEBAA@AA@CB@@AABABBB@B@BA@A@A@@DE@BD@BCFCFA@BIAA@A@BCFBBAD@BCBDHIBBJAFFJDDTF@BEAC@E@ABA@C@CAC@G@EACFABEC@EC@EJCDE@IAM@AACECACDM@CFGBIPUIE@GBBHDFFHENCHOJGFFF@PADABENCFC@A@CAABA@CFCJBN@JD@BABCHEH@DB@@@@FBDDFFJFB@@BAJLCB@FD@FDDDFBH@N@JABBD@@@BBH@@@@@B@FGVIFMBIBGFCLAHAHCNAJ@F@F@F@BBD@@@D@FBVALAHCT@JCJOFQFaHA@IDwNRjFRJ\\LfLR~p\\ZBBNJNDZF^FNDP@N@ZLDBFHJHDBJDNDP@FALAB@N@PDTDL@NCTKZ]DEZUNCXAHBH@LDLHNJNFNDR@LALG\\KJ[PiJ]JQJCD@LAAK@K@QCKCG@A@@KMEAADGB@DCDAAE@CB@B@BENKOCBE@E@EABADCBCBCBA@ABC@AACAAAAC@ACAACCDAH@FADAFG@ADCBADAD@FBD@D@@@B@BA@ABA@A@CB@BAB@DBB@BAF@B@BA@@@AAE@I@A@A@C@AAAC@I@AAA@AI@C@AFAHAHADK@CGAAACAAC@IEO@CBCHAPGBADGHCFG@CAEAECI@IAACIAAA@GAAADKBGBEEMAWACEGIKGIEECCEKAO@UIQCM@ICIKEC@C@KCMGEGAOCCKIGEAIFMHATEDGOIKACBMDIAKBKHEHG@@@CA@EGSIMAGEGEAE@CAE@IGCBE@E@GDCAADEBCDAB@BD@B@B@D@@@@DBBDD@B@@@BB@@BAB@AAC@AA@A@A@A@@@@@D@@A@@@AA@@AAAA@CBABAB@@AB@BAB@@@@@A@@AAA@@@B@@@B@@@BADA@@BA@@BA@@@@@@@@EAA@A@ADQF@AA@"],encodeOffsets:[[112356,20057]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
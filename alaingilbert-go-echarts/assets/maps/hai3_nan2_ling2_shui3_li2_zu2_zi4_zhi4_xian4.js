@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("陵水黎族自治县",{type:"FeatureCollection",features:[{type:"Feature",id:"469028",properties:{name:"陵水黎族自治县",cp:[110.037503,18.506048],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BBB@@@@A@@@@@@AA@@@@@@@AC@@@A@@D@@@@B@B@"],["@@KQSKqOoQOA_BSFUF_LeFE@UBW@WEIAIASSEUWCNA@EAEHLDBBAB@FAJAF@DCD@BADAD@B@F@FBDB@HBB@J@JDB@BBBDBD@H@F@B@@@@@@@BBB@DBB@@@BDD@@BBBF@FCJAFADEDCFEHADC@I@G@IFCBABCDAFAFAB@BBFBDBD@DB@BBBB@@@@JAF@B@DDDD@@BBB@HADFBBB@B@B@BE@C@ABE@A@@BAF@FBBBA@@DBB@BBBBA@ABALCHDB@BBBDJNDJAL@BABABBN@@JFBBD@@@D@FHDB@J@@@@@BDF@B@BCHEB@B@D@B@BA@G@CACACAA@A@AB@BA@@@C@A@C@EDAN@BAD@@CDA@E@A@A@AACB@@CB@@@B@DA@CBCJA@BBBH@F@HAH@FBH@BBH@BBD@@AD@D@DB@@@HBBBBBBDBBB@BBDAB@B@BBB@BAB@DAB@B@BB@@@BBFAF@FAFADADADCFAB@D@BBB@BB@BBB@DAF@@@B@@BBH@BDBDJBB@B@D@@CFCDEFABGPABEBAB@@AB@F@D@BBD@@@@A@E@K@G@CBA@@@ABAFAH@J@FBD@@@@A@A@GCIEMCGAC@A@EDEDABAD@D@F@BJLB@@BABIDCBAB@B@B@BBDFZ@B@BA@ODMBABAB@@BF@DBFAD@DAD@HBF@DBDBBDBFDBHBDDDLLBB@@BEBCAA@ABADA@CBAFE@A@@D@F@B@B@B@FGJEFEDAFEBGBC@CBCBABABA@A@CAC@A@C@ABC@KFM@ABAB@BBB@B@B@@@HKHIFEBAB@H@B@DBBBB@DBD@F@JADAD@B@BBBBBBB@FHBD@@BBB@BBB@BBBD@DBD@@@BB@B@D@BAB@B@@BDDFJDBBBDAB@BABABGDGFIDABAB@BB@B@@BD@@@BDBDBBBB@HHHHFJBB@BB@B@B@B@B@BB@@BBBB@@D@B@B@D@BBBB@@BDBBBBDBDBF@BBB@@@F@B@D@D@DBB@B@@@D@D@B@B@@@DABCHEBABAB@DBB@B@@@DABCDE@E@@DAB@DCBADE@A@GBADCFAFEDEDCDADAF@F@@G@EACAA@E@G@EFM@C@AACAEACAEBC@GBGDM@GDIFSBAHEDA@A@CBK@ABAFIHGFCDAB@B@FBBBD@L@J@F@B@DAB@BA@CBADEDADAHGJIDAH@F@DBB@B@BABCFIHK@AD@B@BABAFEDCBAB@BBB@FBBDFFBBB@HBBBBB@BBBBBB@B@D@D@FAB@B@@CBC@ABADAHAD@F@B@D@B@DAJ@D@D@BAaaW}[MECUQOMGGACQaEaG[GmAQAMGcAIGO"]],encodeOffsets:[[[112789,18878]],[[112729,18833]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("琼海",{type:"FeatureCollection",features:[{type:"Feature",id:"469002",properties:{name:"琼海市",cp:[110.474497,19.259134],childNum:1},geometry:{type:"Polygon",coordinates:["@@OEMGOGKGECIGA@MEKEOCIASGIAMAACC@@A@A@A@ABAAGBGA@@AAA@A@@A@A@@@A@@@@A@ACAA@EAA@C@C@E@AAAACAA@A@C@AAC@A@CF@BA@A@A@AC@@A@C@@@A@@A@ACGIIECMEGCE@CDC@QAIEICYE@BA@A@@@CBADAFABCDCDAD@BAB@BAB@@@BAB@BAB@BABABCB@BA@@@AAAAAACAAAA@GB@@EA@@A@ABA@A@A@AAECA@A@@@A@A@ABABABAB@DBD@DBBBF@B@BABC@C@CBCAA@AAAA@I@E@CDEBABA@CACA@CAE@C@EBCBABAFCJCDEBCBCDAFA@CCIQAB@B@BAB@B@BABCBAD@BBDBF@DB@BBBB@@@BABABABABC@E@CBCBIN@DADCBEBEDCDAF@BGBAFCJAJFF@J@@BFBPAJAJCRAPBNERCRAHD@DDHHDDFHJLJPBD@P@NHN@FCHALDPHLJ@JKFEJINGRF@@LFDBFBZHJDHDNDN@F@CNALJ@LBB@JDLCJEJEFEL@B@HBJHJDJALEDANBHFBBHJFDHFRBJEFEFCFBD@HHBHBHCJGJCJ@LBLBJ@@HLDDNBLBFJ@JALAFAF@BEDUHOHCBGFGDCNFJ@@FJDP@DBF@BDPLJL@NAHBD@@@FD@BBL@DAFAF@PDHHL@@HDHDJ@B@D@F@DBDBB@B@NLBHJPHFB@FAFCB@B@DFDBDB@BDD@@FFBBB@@@BBBA@BBB@DHJHJFDBBJHB@JBD@J@DA@@@A@@@@BA@@@@@A@@@@@@@A@@@@@@@A@@@@B@@A@@@@B@@@@@B@@B@@ABBB@B@@A@@BDAHCL@JAHDFFBJBHDHFFFFFDJBRCTEPDNB@@HBFYBIFITCJCLMDE@AHKBEBE@E@K@AEOBGLGDK@IAGAIAM@@BI@@@AFIBADALEPCFDLDFDBBJFNJAH@BANBJ@DFLDDJANGDHBHJD@@LBPCPEFAH@SXEMaQoOeIKKMY[MMEOEKAGIUIU[sIUW{KYGcQÉDY@@@MBUBIFA@CH@LAB@@C@@@ABA@A@@AA@CAAAC@A@C@ADA@@BAAKMEGGMACGCCAE@EDABADCDABIJOJK@C@A@OKDODQKOMKA@KAU@AA"],encodeOffsets:[[113085,19517]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("琼中黎族苗族自治县",{type:"FeatureCollection",features:[{type:"Feature",id:"469030",properties:{name:"琼中黎族苗族自治县",cp:[109.838389,19.033369],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAAAC@A@A@C@@@AAAA@@AAA@A@A@A@A@@AAAEIGGGGA@AACACA@A@@AC@@@AAAA@ABCBEJCHAHABABA@CBAACAEICC@AA@A@ABC@A@A@@A@@AC@CACAAA@AAA@AA@@ACEGA@AAAAAAA@C@CBIBE@C@CAA@AACAA@G@A@ABEFGJGL@@A@A@A@AAA@AB@BEN@LAD@B@D@BBD@D@BABABABAD@DADAHEFCBEFIFEHA@A@A@E@C@@@@BEFAB@DCBAB@BBBADAFCF@F@@ADA@CDCDA@A@E@ECK@KAAAC@EBEBGHGH@BA@CBA@CDCDA@GBC@AD@@@D@D@B@F@DBJ@DBH@D@DAHADABEFCDCDEBABCFABAB@BAF@@@B@BBFDDHFFHDBDBD@D@FAB@DBDBHHFDDF@B@B@BAB@@EBWBCBC@@BCFABCBCB@@C@@AA@EAAA@BA@ABCDCBCBC@AB@@A@AACAAAA@@@A@C@A@@BA@@BA@@AA@AC@AA@@AA@@@@@A@@AA@@@AAA@A@@@@AC@@@AA@AAAA@A@A@A@A@CBCBC@A@AB@@M@AA@@A@@A@AA@@@AAABA@CDCBGD@@E@EAA@A@@AA@@C@EA@@AA@@AA@I@EBCAC@AAA@AA@@A@A@@@@@@@AB@FADCFAF@B@@ABE@A@A@A@E@G@E@CBADCB@BAFAD@D@FABA@CBA@CBOFGB@@CDELCF@D@B@L@D@B@BAFADAJAB@BEFCDADABABA@AB@D@BAD@BCBCDAB@B@B@H@B@D@DBB@BDBBB@@@@@BABEBCFAFAHAD@B@BJFFFDBFBD@BBB@@H@FBHBF@RDFFFDDHDJBF@JAPEbQNGHEF@FBFBDBDDDH@FADALEJ@D@FBDBBBBDBHDFDDBDBBD@BF@B@BAD@B@DBD@HBBBDDDBBBD@DBBBB@@B@HAF@FBFDFDHFFDBB@F@H@H@BADCHELG@@BBLNLPBB@B@BADAB@D@DBHBF@D@DABCBEDIFADCD@DA@@DADAD@@BDBHBF@BBD@B@DBBBBBDDB@B@B@BBD@B@D@BBBBD@@BBDDBD@H@@BBDBBDBBBF@H@B@JBH@H@J@B@FAFAB@BAF@DAFADAFADABABCD@BBHBHBFDFDBBDBDBFFJDHBJBF@F@H@FALA@@B@@@@BBBBB@@B@@ABAB@@AB@DABAD@D@D@B@@A@A@A@AAAACA@@A@@B@@@DABCD@B@BBBBB@@@@EB@@E@C@AAABE@A@E@GAA@A@AD@BGBC@A@AAAA@A@@A@@@@@AB@@A@ABA@@@A@@@C@@@C@A@AAAAA@ABGBCB@FADBFBDDH@BBBAB@@ABABADABAB@BBF@D@F@BDD@B@B@BAB@@ABCBABAB@B@B@DBB@BDDD@DBJDDBB@B@B@FCD@D@D@D@HDBBDBBBDBHBB@@@D@DBDBB@B@HBF@BA@@B@DCJID@@AFAD@HCDBDBBDD@DBB@B@D@DBB@@@B@@@@AD@HEB@@CDAB@JAH@BBD@B@DADAF@F@@@BA@ADABC@@FG@@@@@ACE@A@@@@BABADCDE@AB@B@B@B@FAB@@@@ABA@A@A@@D@DABB@@@@@B@B@@@FBDB@DDB@@@DBBABBD@BBBBBDFD@@B@B@FBB@@ABBB@BBDBB@BDB@BBHBB@B@BABABADADCBAB@B@BABA@@CIDIHAH@EIEOBGAMKIEFG@GCMAME@EHEFKHEF@@C@@AOACAC@CDECA@CGACIBM@KAGGCEEDEN@DGLKHCHJDDDFB@@BB@DCBADAB@@@DDN@NAHCFEDA@ABADCBCDEDEBABABAFGDEBADCDCHGH@XBLBLADCD@BCDAPEFCHGLMDE@ABABC@A@EAI@I@EDE@@@ADEPBH@HBHBb@BA@@@A@@@@BBB@@@BA@A@AB@DA@@@@@@@A@@A@@A@@@@@@@A@A@@B@B@BA@@@@@ABCBAB@B@B@@@B@B@B@@@BB@@@AB@B@@AB@BAB@BBB@@@DAB@D@D@DABB@@@BB@B@B@BAAAAEBE@ABADCLGHGHO@IBEFGHDHA@AFCHAFBFBLDNBT@P@JCF@LIBGDQFQAMBODQBIBIAOAE@@@IEEBIDIBEHA@ABEDCFCFADABC@CJMDADAF@D@BABABABA@A@@AAAAA@@CAEAC@ABCDABA@A@ABA@A@ABA@A@AAAAAEEAACCAAAEBG@GEEIIGEMIOKGEMIECCCEOBS@GCKKKGCCAKCKEGCSGWIMEEIJINQLUNKDCDCHGJOBKFKBEFGFEFMAIIKIKGEAAA@CAEAC@AAA@@A@AAA@A@A@@AC@A@A@ADCBAAC@@C@C@AAEECEACCGACCCA@C@A@GHCBA@A@A@A@A@AAAEAEAAECCAAA@CBC@A@AAC@E@A@@AAKAQEE@E@CBCBCDCFEFEBCDAB@H@BCFABCDA@CB@@@FCFADCB@@A@A@CAA@ABABGFADCB@@A@A@C@C@@@A@A@CAC@C@A@E@@@A@AAE@CACAAAAAAC"],encodeOffsets:[[112576,19217]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
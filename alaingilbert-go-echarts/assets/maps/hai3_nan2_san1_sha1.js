@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("三沙",{type:"FeatureCollection",features:[{type:"Feature",id:"460300",properties:{name:"三沙市",cp:[112.338695,16.831839],childNum:220},geometry:{type:"MultiPolygon",coordinates:[["@@@@BBBA@@@A@@@AA@@BAB"],["@@BBF@BC@C@ECAE@ABAF@BDD"],["@@HBFADABC@CEAKBAJ"],["@@HBDADADCDC@CACCAGAG@IBKBCBAD@DBDBBBBFBHCB@BBB@"],["@@BAFAFCFEBGEKMAML@DCDAHRH"],["@@FFHBN@JADCBE@GACC@]@CBABAF@F"],["@@CFDFJHJHNHHBFAFCBGAEGEKGEC@ECCIBGDED"],["@@LJLHNDPDH@DE@CAGGGKCCCEEECG@IAGBCDAHDF"],["@@LAJCHEHGDCBGAKGIMAKDKDIJCNAJDHFBJD"],["@@TL`LH@HIBSGMSMKAQAKDEJ@JFN"],["@@RHXKH[@WOG]BOFAZN^"],["@@KLKFGDEHGFGFEHBJJDJBPBPCLIJKFWDOAEGCGBGF"],["@@LFTBHEBQGQQQWIIAMFCNJVNLLJ"],["@@DHHJbLJBLDNBJ@LAHEDGBK@ECEEMIEI@KFMDODK@GAIAM@AL"],["@@FYIYYOSBQHANAVNbRNZINO"],["@@GJKHGFGJGJIFCF@HBHDDDBXE^QbcJOHMDICMCGICEAKDEFSh"],["@@GDKDGFENER@NFZHJHFJDHCFKLMLKJQDMDSCMIAQAOF"],["@@\\AnS`OFOGWMMO@UDKHGDk^AXNV"],["@@VDROCWGWi_EAUAWDIR@TNNbX\\P"],["@@DVFJPDJFHFFPFLAL@VCNGHMLKJKJCPDDHHBAHATINKLMLGJGHIDOCOGOO[OYKKGGIIQGGBAL"],["@@ZPfZXLRKDKOYUOWMYEUIWQ[_AAOHCLAVHPNHZJ\\R"],["@@OLGLANDJFFhH^HjFP@LCFE@KAMEKMI]S_KOAMDOL"],["@@TFTHLBP@RAJANANGNENEHEJCFG@EEEEC[CE@Y@MBGAI@OCWEMAMBKBMJAHAJ@FFJHFLF"],["@@KIIEKCGDGHAJBJDDVFRHJB\\JRJTLXFZBRATGPMHS@KGKOKKCKCYGOIKGUGQCOAGFANALDFLALAP@HDDDHNLHNFJ@J@JDBJELO@IAMCKGOEQAOCQEGG"],["@@MFQRI^JfRX^JPBZEZSNY@YSYUM_KWB"],["@@UDS@GEUCcA_AWDYJKBABAF@DLDZ@VA\\AfH\\DpLPBPCPINKPaBMI]a_iUaGUAMBUHGBAFFJFBL@bBLBbJTLFLDTANINIH"],["@@KIKCK@M@KAMASAQCSCMBOHGHMHEJDJDJHJRJNHJHLBNBTFNBRBNFHCDALCNCRGHGHOAMKOOG"],["@@GF@RNPbPNDhNpJxHfFVF\\BL@PKHYCSOGcBiDa@_GYI[QYGSGU@cF"],["@@FENHXFDCAKQOSM[Eoa_WWSGQLQLENATDTJRTTPbJnHJBHEDOACSKiE[KSQ]OSKWIkEYFQLCRHrPZtlLFH@"],["@@XHNDRBZDTDVDRARED@FQFSCQKMOQMIOG[GI©IyHEH@NAJDNLTTNPDLBLBT"],["@@lRlHtBrMjMRcKeQ]i]iKg@kNkZWxPr"],["@@BE@SA_KISAIPIVI`DTVRTCPa"],["@@@SEagBKjTZfE@I"],["@@RaOaa@YTRbbPFA"],["@@FA\\QBEAESUCCOBGD@DBfDFHD"],["@@ICEBC@@BDDFDFADE"],["@@GCGBA@ADDBFDHABE"],["@@VuKogEcbEld`dA"],["@@\\c]_gA]TClbRjK"],["@@AIGBCB@JH@FC"],["@@NOG[UKUHWVEZLNdETG"],["@@RG^WF]EISKWXSTEVVR"],["@@DHFDRGHGAoOCWNFj"],["@@LRVBVEbI@a_SUCQJILG`"],["@@PJHAJ@HW@@OCG@IAGV"],["@@@@LAJKCECGKDGD@JFJ"],["@@LJLBNIJMDMCIECKAMFGDIVBJ"],["@@@BLDNEJKCGIIICIFGFFV"],["@@Lg_aoNEh`\\jG"],["@@FHJFNABGGKICICEBBN"],["@@b^jFdOVgQcmCkFaPHf"],["@@xNRaMoiQQ`ATBb"],["@@nyBKISUIQIeA]FSTUZ@RBJFJPJJDHDFDPARD^A"],["@@\\RL@LABGEKOKUIMCODBNHHJD"],["@@QwQKU@YBELARIVLNXN\\AVEPE"],["@@BC@GCEAEICBIDEBIGMMEQ@OLOXMN@RNJVZRAPOLGJC"],["@@`@DABCRa@WAOCKGIM@S@O@UFEVHP@N@NBLFHJHJB"],["@@\\EDCBQAEKKKKAeEE@[T@FBNHTP`f`FB"],["@@JAPGRUDI@KCMUOEAw@GDCN@THTVRNHHB"],["@@lOQe[SeRHf`R"],["@@@J@RDHRNPFNDNARCNWDE@I@EAEGQACECGA}@GBCHAP"],["@@DRNVZNH@TENKBGD_@KMOUII@MDIFKJEHCN"],["@@BZ@NBDRNPFFAF@RKFQBKAMGIIEYCG@QHCF"],["@@BNTX\\RLBHCPcBM@gEYII@@]CWFILEXAb"],["@@DjHVJF\\DDA@@@UCwACEA]DIBAF"],["@@PbFDDDnB@@FCF]AKGI[KS@IDMNAF"],["@@LFHRDN@ROLOHs@kSWMEGBYVGLGVCX"],["@@@JTTVANELGn­HsGSecEA@@EHOªGNg"],["@@BjV\\lP|DbI@@@iK]wccKeD@@Sd"],["@@DHdNrAB@RSFMEWaaICiA@@EFKdEd"],["@@HL@@pR@@LEJK~BMAK@@]K@kNEJC "],["@@FHJ^NRB^EFEFMBc_{ICwBGD@@@@"],["@@DPLPLHZ@JODYESOMQE@@MHILCV"],["@@DVP\\^PÌdJBXn\\JFxxXPÜbHH¬äHHŬètVLElWPQ^¯AW[cÛ}w[kWÏAYGMMCÑeŏ³ĉoÓAQBjQLEFkAP"],["@@BNJLLDN@VBN@REV]TaRWDSBWCQOG[BYDWLOJWRITENAXA\\"],["@@BDNN\\PF@PC@@BCBEAUAGAEe[GAOBAB@j"],["@@BLFFnRPDPFXPZFlHZANARK@@ASIOoWkMmKkIQ@MHIJAH@J"],["@@@@LLXDRGXGPBL@JAHCHEHGHG@@AO@A[EQCWAWDI@QPSVCN"],["@@BR\\hH@V@VCLEL_DUCOYkECI@UFKD[jAR"],["@@DJXNrRxGFEB_EeEGmQQ@aLGFWr"],["@@@@JJLHLDP@VAvWÐ[LCLEFIDIKEYB}R¯rSVEHDN@N@JEN"],["@@BHFFFBVJF@FC^Q@@@EBCOiCCEE[GEBQPAF@j"],["@@Fr@FDBhJtADABGNyOoEEGCkCO@EB@@WtAJ"],["@@DTÎĂÒÒ|XJ¸@EM²]lQVQXUDS@@WQyHYF£RJ]@»QkI±IKÝċ·­uWOCWHEJ@|"],["@@HnNVLNÐ¦TNrRXCRC@@HQBS@KecsQM½YAyHUP@@Ar"],["@@DX^NüTFZ@JC@@JaG[[kyyesKUAMH@@SCZ"],["@@DNPP^\\HBFAfO@@DMAM@@omKCMAI@IFIT@L"],["@@BNRfHJ@@XAHADGDIBs@aCE@AG@qFEF@@Aj"],["@@HlhHFbFLBdE@@LO@MeU_a]WGW@@@@@Gh"],["@@DJV\\bf\\bJfKDGF_@AUkIG]CWBKN@@"],["@@BJzjZL@FENY@Ke_uIC@@MJg"],["@@ZtHDxDH@vQFCRaAGQ_ECIAe@oBqbCF"],["@@DdXZ`JpBHAYFGFoAGCCkYMAmB_H]NONCL"],["@@BTZRF@XHXB@@FKB_CSIQ@@]CaDIPAR"],["@@BbHLLJRD`CHGDGAKK[GGKEG@S@MFGH@@"],["@@@F`jJJf^DBNDJ@LEFC@G@@¡ECCA@@OH@@AH"],["@@FLFHJNHT\\pJNNNLNLNPNPNPPPLFFBHFt@DCPGLBRDVBLHHJDTBFCT[@EBE@_@M@yACiO]MWu}MQYUIIQI@@CNBDFDHJJNFFJNLRbtNRJLCFGFIEIIOMQOm}Q[IUEA@@GDCF@@"],["@@@@HJPNPBJELOLM@@AO@@KEMCEKEI@@E@MPM\\@N"],["@@DLJN´lNFVLFAHCJS@E@AeWUGSQKKKCQ@QGOI@@ODKNEL"],["@@BRFPRLLBTELMPWLQLgDMAMQMGB@@EHCRMtGHC@G@CCAGP_HWF[@@GEMC@@IHKTGLETEdAR"],["@@FZVdJJh\\pJJ@`_BE@@WgWSYOOUBEFCL"],["@@BFDDFBFBHAJC^MJEJEd_JGTYNUDIFUBKAGCIGQEEECG@ODCDCFAHAN@FAJAH]tCHCBQLOJADKPCDQRCD@F"],["@@@D@D@@JDB@FABCBKAGAAA@MDA@@B@J"],["@@@B@@NFBBFC@@AGGCK@@@@F"],["@@@@@@NF@@HG@@@@CCIAABEF"],["@@BHFDJ@LCDE@MAKGGIAIBATAL"],["@@DFBBBBJ@DABA@CAC@AKCC@CD@BAB"],["@@DFTbFFLDLBh@@@DE@ICEW_GCKA[COB@@ED@F"],["@@BFDHJHHJJJJBP@HGBWK[@@GCQAOBCBKR"],["@@@@BDhZNDN@NCFGDW@IQ[GCIAWF]`CH"],["@@JVLHLFnFHALS@MCSUMMGC@MAUBGD@@KZ@F"],["@@DJDBFDNCLDR@TDTDZBLCRQFCjQRW@@@ECEEAaEWAYBQAQBUAQFQJGDS\\CH@H"],["@@BP@@HFJBR@VChWDICMGKEAMAMDUFa\\AF"],["@@TbDL@JPpPXLNdRLJNHN@VKNQGaDaGiIcOoK_K[UQSAMD@@AJCLER@HDNANCNmN@@AR"],["@@BHLPfnFD@@FATOTs@G]oKIKAEDcjEHAJ"],["@@FZ^JJCZ@RaCQKIQCQ@U@@@KLCV"],["@@@H@DHHPPBHFDHDHFRTNBPAPOHQCM@@QAEECIKIMBEFBUDMKMGC@@KDOJGHCJAL"],["@@@HDH@@DBXHD@FAdc@EBGAM_QGAGDIFG^KT"],["@@@BJTBBLBDBBA@ADIBS@CCCCCE@I@C@ABEP"],["@@@DTXHFL@PA\\Y@A@GCGYYCAGBg`AF"],["@@BZDDhf^V\\PfNB@TGHW@@mc_MsiEAUECBIF@D"],["@@@HFNJHFJFP@B\\LR@PCXGDEAYCCCGCMCMScEEO@EBE@GGAC@@ECCBCDCJIBCHADAZ@N"],["@@@@LLFBN@FADA@@AMACEAEAG@K@CFAF"],["@@@NBF@@FBrHNFbBbI@@@ECmM[IWCEEAOBCCAGCAYAEBEHAFEP@P@FFN@FEJUFGB@@@F"],["@@BDHHHBHCFICSCCKC@@EBEF@T"],["@@FTDDF@NABAJS@OACEEE@I@KFABCR"],["@@BDHVRnlxJFL@ZKTCDA@A@EOoCCIEÛÍCACAQF@@AB"],["@@@DV^F@F@RSDS@EAC_KC@@@OVAF"],["@@BF`VZRhbbTj`PFfJL@@@TSBEEoC_ScUCOAGDSRYNGAeEEAes[SEAA@CDKl"],["@@LhBFtxXpFFVDH@@@FEL]AIQ_gegaeOC@@BAD"],["@@@RFNJHNFVBLANEHMNeCsCKEEQ@MF[PKNGTEZ"],["@@BDBBBBB@D@DABA@ABCAEAACAA@C@CB@BAH"],["@@@D@BBDFBBBD@DA@CBA@EACCEAAC@A@CBAB@H"],["@@BJDJj~HHJBJBLAHEFEBGCKQwKQKOOMGAI@EBEFK^@H"],["@@BZBD@@FBH@FAHEVWVUBEAEEEECG@SBWFEFCDAZ"],["@@BVBDFFVPHBH@J@FADAHG@CACY]YKEAE@CBCDAH"],["@@BJHFPATIJKBIEO@AGAG@EBUVGN"],["@@JRDDPFjAt@HATQ@CGYACGAUAwDYBC@YV@@@D"],["@@@FDHVRDBhDD@DADEBA@CGUACECUKC@I@YFABCJ"],["@@FrNTFDHBLA\\IFGDIDkCOGGIGKC[AKHKPAL"],["@@DVHH^DJ@\\KFCBKGuCE@@sGGBCDKn@J"],["@@BRZdZbRJnJCc^mCsMKIA±DÓZKFGH@@"],["@@BnDTDFt~HFND^GJCBCJeASAIY{qikAIB@@Aj"],["@@BVBFbXHBHATE@@@@IYCEKEMEUEI@@@AD@@"],["@@LZB@HB@@NUZkNeHUHOAUKI@@@@]fIVOVILCNCZCL"],["@@@@`jlRH@jA`_@@@c@@a[qI_Lid@@"],["@@HNPVXVNBRCVMFI@QcEGWEUAIFUbE|"],["@@NHLPHRFNCLGdgNUTYTMTE^MnUHQFUCYCCA@ABMlGJ_J{XIFMLQZgvIDOAIIKc@cB{BQDQLILCC¸UDC@@ACCASCYAa@¥BU@QLKPET@NAN@"],["@@B|@@hL\\HbB¤JPAJCFI@IEGQI]E{GiC]IqS@@@@"],["@@@ZP\\RPbFXBjCTKBG@@EGkeI[BGF@@"],["@@BFhNR\\BjCF@\\WBECEMKGAoD@UB[FCB@@"],["@@@@@@^JbA@@TYMi@Ag@@@]\\@b"],["@@BHLTJBRBNA@@BEBSCYCEaKGA@@ED@@Cf"],["@@@FFJLLdBF@ZGDCHMAC]KK@]CEBMJCD@@"],["@@@FXX@@dADAHEFQASAEEAiE@@WZAF"],["@@@DHPJFFBfEFATM@@@ACSACMAE@{TAB@@"],["@@FZLR@@FBF@^U@CAEWYG@UDCB@F"],["@@@@DD^BH@HCTMDC@GWUCAMAGBCB@@QhAF"],["@@BDD@FBHBF@F@FAHCFGJKBCDGHMDEDCDAJGDCHIFG@C@CEECAG@cAKBEBCBEHCFEJEJCFM`AD@D@F@FBF"],["@@JFJFNFNBNBL@R@PALAHAFGDG@MBIAGEKKIKGMGSCUASAKBIDEDCFCJAHAJ@HDPDJ"],["@@LHFBD@HAD@DADCDC@C@CCECCCCIAEAE@C@EBCBADAD@BBF@BDF"],["@@CEEGKGEEECC@EBE@CBMJCDADBFBDBDDDFBDDFDBBFJDDFDDBDBJBHBJ@DAH@DCBA@C@CCESW"],["@@DHFDF@H@FCDEDEBGBGAQAOCCE@G@OBGBADAD@FBLF\\"],["@@FHDBDDL@HBHAF@DCFEDGBEBO@GAGACEAEAEBOHMFEFEHAF@F@H"],["@@BDDDFDF@JAHCFCDCBEBGAuAAACE@KBKBEDAHCF@LAR@L@L"],["@@DFDDJDP@H@NGDGHSAMEISGOAKBKJATBX"],["@@FDHDHBJ@FCDG@I@GAGE@EBGFIFGDAH@B"],["@@FHFFJBNBDABCCEECGEACEAEAE@ADAD@D"],["@@FDDDDADC@GCGECEACBCBBJDF"],["@@DFDDDDF@F@BC@AGK@ABCDCDABAAECAICK@CBADDX"],["@@JBJ@J@JEDE@KCECAE@CBEFGBGBEDAD@DDF"],["@@AJHFVPHBFC@GBEJALAFCBMECE@IFOBI@SAED"],["@@ABAD@DDBF@FADA@E@CAAIGA@CBADBDBB"],["@@@DBFBDDDF@FAFADEBABEAEAAUEE@CBADBDBD"],["@@PXHVNHVHJALOJGBIEWWQEGIEMAgFIF@JPJ"],["@@HBJDDBDAFC@C@AGCIGI@C@KDABBDFDFB"],["@@@D@DFFF@HCDGBECGEAGBCDCD@D"],["@@BFNDXBXEDC@EIIEACBEDEBKBSBCD"],["@@@\\AzH`Pd@xJJVGFQIa@SAUCgEUBS@W@kIWUCMJFr"],["@@DFJALEFG@ECCEAIAEFCDCHBF"],["@@DDHBHAFCDGAEGCKBGH@H"],["@@@F@DDJDBDADEDGLM@CAAECAACACBEJEDAF"],["@@CLBJFJFFNBHEBEDCFEDI@MGOIMIGGAG@EDCJDRBN"],["@@CD@D@FFFHBH@JKDE@CCCECGCC@EBCJ"],["@@@DDBB@DCDCBCACCAC@CD@DAD"],["@@FHFBFADC@CGCACAACAE@ABADBDBB"],["@@DDDBFABC@GCEECCBCBBD@J"],["@@DBHAJ@JA@A@AGKECIEGAE@ADHZ"],["@@A\\FLH@PINILGPBLDPFD@BEGM[[[MWCCBBPCFIR"],["@@@ZBLJPPPHDL@RCfKRBZIRIFOBO@K[[OMSIKCMH[B]HIJKRCN"],["@@IVBFHJHVFHLLLZFBFABEDSFO@KBeDIHIBEQMKIKCSFIHEZ"],["@@PAPKEgcqOcBSRCj@JPzJPKD_EQo[¯a{IuCXKRCpDPx "],["@@BFDBFBHBFAF@LCDA@C@AAAO@I@EAEACBABAD"],["@@\\@JBFAHCLKJS@E@AACKAC@GBC@SGC@WXIP@FBFLD"],["@@JCFGDEHKBE@ECCIBAB@FCFABC@AD@DBDABABEBAF@DB@F@"],["@@FDhPNBHAHGJSBG@E@IEIMWGECAEAI@MFGJWdAF@FDHDD"],["@@JJTDt@XGHGHYBK@EqQES@OJILCRARFZJR"],["@@@@BBB@B@BA@@ACAA@@AA@@A@A@@@@@@B@BBD"],["@@DDF@FADABC@CEIACA@A@E@EDAD@FBDBD"],["@@DBF@DADC@C@C@A@A@A@AAACACBE@CBAB@D@B@B@BBDBD"],["@@HBH@H@DC@A@ACCGCGAA@AAGCQMEC@A@G@ABAB@B@@AAICAECC@A@@BC@C@A@@C@AEAA@E@ABAB@BJNJLRTRPFFFD"],["@@BBJFL@D@FC@C@A@CGGC@G@E@CBADAD@B@B"],["@@RJD@H@FAD@D@DDF@LBBBDBDBDBJ@FAV@D@BAJK@A@CEAMGKEEAUCK@IBIDKBG@IDKDAB@BBB"],["@@F@D@FCDG@CCACCAACAC@A@ABADADAFBDBDDB"],["@@FAFCPM@ECEGAQCG@AFAHDNDHFB"],["@@@JHFHDLEFGBM@GCECCEBGJEDGJ"],["@@HE@IAICGGEG@QFAHDFFDBFDJJBHA"],["@@@JDFLBHAHGJEFICIEEIEI@KDCH@JAH"],["@@JHD@FCDCBAACAGAC@CIGACCAE@CDADCL@BBBDFFF"],["@@D@RAT@VAbBD@BrXZEHI@@IAcECICIKG[AMFCFAH"],["@@RdHFtHPANCLI@OEMUUCA[SMKICK@KBGFCJAVBP"],["@@BBJFP@LCJIFMBG@@EI@@MAMDGJABA@EJEN"],["@@BBD@B@D@@@@@@@@ABA@A@@B@@@BA@A@A@@@@@@CAA@@@AB@@@@A@@A@@@AA@@AABA@@B@BA@@BAB@BBD"],["@@AD@FBDFDHAFEDE@CACECC@EBEF"],["@@FFFDDBD@BAFC@A@CACECEAGAABCD@B@D"],["@@@FDBLBVADEBCAGGCKCIBGFCJ"],["@@BPNRDBJ@VAVM@C@MCIKWCCO@UFEHGPAF"],["@@AAC@ADDBB@BC"],["@@EDAFBFTRZXF@FCDEBGCGk]IA"],["@@CBC@CDIR@D@DBDFBHBH@F@D@D@BBBHBBB@B@NEB@@A@A@ACCIKCEGEIEEE"]],encodeOffsets:[[[114371,16963]],[[114267,16903]],[[115340,16968]],[[114392,16849]],[[114143,17487]],[[114385,16599]],[[114336,16957]],[[114280,16933]],[[115436,17080]],[[113865,16169]],[[115382,16752]],[[114402,16961]],[[115597,17187]],[[114170,16838]],[[115384,16808]],[[115027,17343]],[[114271,16836]],[[114904,17200]],[[114935,16119]],[[114421,16854]],[[115234,16908]],[[114452,16421]],[[114907,17398]],[[114150,17496]],[[115713,19937]],[[114468,16644]],[[114758,16712]],[[115081,16657]],[[114340,16649]],[[115229,16444]],[[116539,19685]],[[117685,16422]],[[117546,16276]],[[117361,16025]],[[117008,15924]],[[120664,15498]],[[120647,15482]],[[120115,11344]],[[120516,11315]],[[117327,11037]],[[120028,10993]],[[118561,10794]],[[118504,10717]],[[118537,10713]],[[116768,10265]],[[117331,10198]],[[117292,10125]],[[117334,10121]],[[117468,9971]],[[115666,9869]],[[115213,9774]],[[116907,9610]],[[118761,9546]],[[114208,9500]],[[119552,9274]],[[116674,9088]],[[117547,8357]],[[117611,7419]],[[116327,7130]],[[115256,6123]],[[114700,3943]],[[114916,3974]],[[114943,4040]],[[115229,5934]],[[115172,5878]],[[115047,5757]],[[115224,5792]],[[115286,5696]],[[115315,5497]],[[115259,5233]],[[115355,5230]],[[115618,5113]],[[115935,6458]],[[116631,7790]],[[116629,8198]],[[116840,8294]],[[117257,8316]],[[117423,8408]],[[117626,8176]],[[117708,7690]],[[117932,8588]],[[118025,8714]],[[118282,8695]],[[112092,7521]],[[112575,8037]],[[113111,8312]],[[113112,7987]],[[113264,8204]],[[114217,7726]],[[114351,7835]],[[114232,7996]],[[114319,8097]],[[114612,8265]],[[115602,8033]],[[115656,8612]],[[115940,8268]],[[115985,8322]],[[116389,9453]],[[116366,9193]],[[116935,8975]],[[117350,9059]],[[116713,9086]],[[115714,9887]],[[115647,9865]],[[115638,9855]],[[115601,9815]],[[115582,9803]],[[114139,8730]],[[114901,9048]],[[114939,9088]],[[115043,9141]],[[115239,9059]],[[115532,9125]],[[118618,9943]],[[118831,10338]],[[118941,9810]],[[119065,9093]],[[119266,10046]],[[116999,10061]],[[116324,10487]],[[116985,10488]],[[117443,10595]],[[117318,10662]],[[117369,10205]],[[117089,9988]],[[117608,11086]],[[117450,11269]],[[117320,11593]],[[117217,11097]],[[117241,10975]],[[117442,11745]],[[117311,11010]],[[116929,11294]],[[117669,11124]],[[119985,10861]],[[120455,10884]],[[118622,11107]],[[119438,10757]],[[119637,10729]],[[120073,10569]],[[120165,10333]],[[120082,10949]],[[120598,11033]],[[120269,11331]],[[119529,11778]],[[118131,14284]],[[119496,16738]],[[120534,15547]],[[117457,16559]],[[117196,16419]],[[116955,16426]],[[116674,16216]],[[116627,16047]],[[116445,15975]],[[116594,15849]],[[116718,15790]],[[116977,15806]],[[117205,15974]],[[116691,9053]],[[116500,7821]],[[117302,10182]],[[114910,9080]],[[118482,10760]],[[118496,10787]],[[119225,10034]],[[116844,16389]],[[117131,11740]],[[117095,11726]],[[117077,11707]],[[117044,11663]],[[117545,11423]],[[117058,11319]],[[117002,11338]],[[117026,11318]],[[116805,11180]],[[117110,10628]],[[117226,10624]],[[117092,10423]],[[116579,10260]],[[117138,10138]],[[117071,10125]],[[116997,10004]],[[117020,9953]],[[117144,10029]],[[117240,10088]],[[118603,11077]],[[118583,10993]],[[118135,10502]],[[118050,10437]],[[118273,10136]],[[118203,9590]],[[119285,10646]],[[119315,9976]],[[119355,9980]],[[119394,9970]],[[119253,9395]],[[114338,8881]],[[114610,8852]],[[117283,10971]],[[117171,10929]],[[117097,10989]],[[115689,9883]],[[116524,7551]],[[117261,10110]],[[117209,10077]],[[117267,10171]],[[117254,10160]],[[117205,10142]],[[119734,9690]],[[117371,11707]],[[117418,7773]],[[115730,9909]],[[115732,9895]],[[117049,10113]],[[116962,10456]],[[116960,11327]],[[117745,11345]],[[114406,16940]],[[115591,9763]],[[115037,17225]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("万宁",{type:"FeatureCollection",features:[{type:"Feature",id:"469006",properties:{name:"万宁市",cp:[110.391073,18.795143],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAAAAGAA@AAEEACEAA@AAA@ABCDEFABABA@C@@BGLEJADABA@A@CAE@G@CBIJGHCBCBCFAB@DABA@CBA@E@I@K@C@AAEAA@A@CBEDGHEJAB@BAL@D@BCBGFABETCJ@HCNAH@HADBFBDBFBD@B@DEN@F@H@FBBBD@F@HRFLBBB@@@B@FBD@B@BAD@DBBDBFDBBBFBFBBB@B@B@B@B@DAHGB@D@B@DDBDDHBDDFFFBBD@D@@@BDABCD@B@B@BBD@@@B@BBB@B@BB@BBD@FBDBB@BBHFJLJLBJENEFEHAFELALIPGHCDCDMLKVMRIJFJNFXJTHHDLFLDDBHDLLDL@HATFPDDFDNJHFPLNJHFJJFF@HAHBFBBDDBBFFBBBB@B@BJRDDB@BEDCDAFADCDIBEBADAFAD@F@DBB@BD@DABABCF@D@F@JBBBBB@DBDAD@D@BA@A@AAEAA@CAC@CBABABABAB@B@@@B@B@FDBBB@B@B@BAB@@@FB@@HAB@BBDBBBBBBB@@B@@ADABABA@ABA@ABA@A@@BA@ABA@ABCDCDCBABEBCDA@@B@B@@AZFJDJFRBD@DCF@HDNFFDJJDH@B@BB@@@D@B@@@BDB@B@B@@ADEB@D@BBD@B@B@DBBBBBF@D@D@B@FBB@DB@B@B@@B@@@B@B@@@@BBB@BB@AHBHAB@B@B@B@BD@BDNBJBTHJBPDLFNFB@JHFDLHPHNHPFBBV@LBB@NLLPCRCPPLB@D@L@PIJIBADCBCBAFCF@DBHDBDHNFHLNBB@AB@BCD@B@D@BBDBB@@BB@B@BA@@D@@@BA@KDGB@JEVANA@@Z@CGAUG{CcM«AQIuCS@A@W@IBMDcDa@I@UCYAKEQMWWU_OgKmKOCAAAMAK@K@S@SBMBaDkA[AEGCOA·OµQG@KAI@KBGJIJGBQAWsW]QkiUUbABC@C@I@CBA@C@A@E@C@GBCBAB@BAD@DA@A@EBC@C@A@A@AAAA"],encodeOffsets:[[112777,19051]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("文昌",{type:"FeatureCollection",features:[{type:"Feature",id:"469005",properties:{name:"文昌市",cp:[110.797717,19.543422],childNum:12},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@B@@@A@@@@@@@"],["@@@BB@@@@AA@@@@@@@@@@@"],["@@@@@BB@@@@A@@@@@@@@@@A@@@@@"],["@@@@@@@@B@@@@A@@A@@@@@@@@@A@@@B@@B@@"],["@@@@@@@@@B@@@@@@B@@@@A@@@@A@@@@@"],["@@@B@@B@@@BA@@A@@@A@@A@@A@@@@B@@B@@@@@@@"],["@@@B@@B@@@@@@@@@@A@@@@@@@@@A@@AA@@@B@B@@"],["@@@B@@BB@@@@B@@@@@@@B@@@BB@@B@@@@A@@AA@A@@@@A@@AA@@@A@A@@B"],["@@@@B@BB@@B@@@@@@A@@@@@A@@AA@A@@A@AA@@A@@@A@A@@B@@@@BB@@B@@B@@BB"],["@@@B@@@BB@@BA@@B@@@BB@B@@@B@BBB@@A@@@A@@AABA@A@@BABC@A@A@AA@@@ABA@@BA@@@ABABA@@B"],["@@@B@@@@@B@@BB@@@B@@@@B@@@@@@@B@@@@@@@B@@A@@@@@@@AB@@A@@@@BABA@@@AB@@A@@@@@A@@@A@AA@@@AAA@@BA@@@@BA@@@A@@B@@@B@@@BCB@B@@"],["@@A@CACDCD@@ABCBABCD@B@@AB@@@B@@@BB@@B@F@HAJCH@DBBBDD@@B@D@FADABA@GCI@EBCBBDDDDDDAD@DB@@B@D@BBBBBBBBB@D@DAD@D@LDHA@@@BBBBB@JCFIBKFGBCB@FADCFCFAFDD@BBFBHBBFDDBDDBJBFFJBH@BFFFBBBAHBDLBDB@DABC@EAC@E@AB@@BDA@CAAAA@AF@DDBDABBDBBDBBBDDDDDD@BDDDBBBBBDD@FBFABDBDBBBADA@AACFG@CFCFAD@@@@@D@HBB@@@@@B@B@D@@@@@@@BBFDB@HDDBBB@BBBAB@DCFAH@HAJADEDBDDHHDDBDDHDBD@BAFGDA@ABGHAFCDA@G@C@E@EAEAEAGBA@ELAFBFFFFDBF@D@@@@ADAL@DAFEDCD@BBD@B@BBDBFADABADABA@AB@DABAB@BA@@BA@ABAFA@C@CDGFA@@BAAA@A@@@C@A@A@A@A@A@BB@D@DDD@DALCD@DCFAF@@CBC@ABABAD@D@D@D@BADC@EB@@@B@B@BA@@@G@@@A@A@CB@BEBEDGBIBCDCBCBA@@B@BBBBB@D@F@F@B@@@DABADABADABADEDIHA@CBEBABABCD@@AHCFGHA@CB PRVXRPPLTFRLXJLDRRFHNLTRFDB@B@D@F@HAPELGHGDE`³FIHUFCB@BArETELGJGFCRWLODENILIlQF@\\GHAPAxJdFVL\\H\\ANGJKFO^¥JcDOdÃLi\\bNPN}FYFmEUMMWIQC]AS@E@I@SEUMcUkW_U[Q@@SUaaKKg{qii{KGQGOEQAcCEAIMWFTG@EBOFODKA@@ICAGCGMHIBCCEK@CAIBM@ABGMIIEAAECKCECODKFCBABEJ@B@@AJ@@BNBJBH@JCLKHAHFP@B@L@FAFAFGL@BCFKNIDSDEJAJEZHHBD@BBNAF@DEHIJGFEDCBIFIFGFMBK@KCGAICOGA@C@G@GDCDCJ@LCRBLBD@@B@FFLFNJDFDHJHLD@@NDF@B@FDBBDBBABADADABCB@BFBBDBDAD@F@J@FBD@B@BB@AAC@@AC@GAEAEAAPWBADCBGDCFAD@FCF@B@FD@@H@DBJBRBFBD@BCBA@@D@DBFADBDAD@@FDDFBF@FCHDBBF@@BF@@@DJB@BBB@@@@@@@B@@DBFBHAF@BBD@@@DABA@AAC@@DBDBBBDCDCD@BAD@D@FBF@D@@@H@F@D@FBDDBDD@BB@BD@DBBFDBBDFBHBDDBBB@BBD@B@DABADBB@BBB@D@B@@ABABBBBBD@@DAB@BD@HBFDBBDBD@BDB@BB@BC@E@G@C@CACBADCDC@A@IBC@C@CA@@CBCB"]],encodeOffsets:[[[113930,20442]],[[113873,20404]],[[113935,20443]],[[113886,20361]],[[113875,20391]],[[113887,20360]],[[113872,20404]],[[113936,20444]],[[113927,20441]],[[113943,20459]],[[113871,20387]],[[113293,20126]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("五指山",{type:"FeatureCollection",features:[{type:"Feature",id:"469001",properties:{name:"五指山市",cp:[109.516925,18.775146],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAA@AAA@A@@AA@@AA@A@@BEDGDABADC@@@A@C@A@@AAA@@AA@AAA@AAAAAE@A@ABEBCBC@@AAAAA@AAAAAAAA@@BAB@BABA@ABABA@A@A@AACBCBAFE@ABA@E@ABAFGBA@@DKFK@CBABCBCDG@A@A@@AAAA@E@EAC@AA@AA@BA@A@ABC@A@A@AAE@E@EBCBCDA@EBC@A@A@AA@@EAA@C@CAA@CACBE@CBA@CACAA@AAA@ABA@@@A@@@C@@@A@@A@@BA@A@A@AAA@ACC@A@EAAA@CCAA@A@A@CBCBA@A@A@@CGAGAA@@A@@BA@EB@@A@@@AA@ACGCC@AAA@A@C@@@@A@ABEDEDCDAB@FABCBABA@ADA@@@A@A@@@AAA@EBA@A@AFCHAB@@ABABA@ABA@@B@FAHABA@CBCBA@E@A@C@AB@BABBFBBBBFBD@B@B@D@B@B@@DBF@DBF@F@BAB@@A@@@CBEBCBCAEA@@A@CBCB@@A@C@A@@@@@@A@@@@@@B@B@@@@@@A@A@@@ACCCACAIACCAA@A@E@AA@ECAAAAAE@EAAACAAAACAGACAAACACA@A@A@C@CDABA@@@CDIBI@ADG@AAA@@CA@AAC@@@C@ABABABABCDGDA@C@@@A@@CAC@KAC@@AAC@CAACCA@C@C@@@CBABC@CBABA@AB@@A@CAGACAMEEAC@C@E@C@AB@@@@C@E@CAC@ABAB@BA@E@C@AB@BABA@A@E@A@A@CBEBABA@CCEEAC@@AEAGEKCCCCEECAAAC@E@C@G@A@E@AACBA@A@A@@B@@AB@@@BAFABA@A@ABEFCDADAD@BBLBHDL@F@HAHEHED@@E@G@ABAB@B@DCHEFEFCDKJIFKRAF@LAPBHDDBD@DAFCFAFCP@BBBBBD@@DABABCFAF@LBFDBHA@@B@BBA@@BCB@B@D@FAD@B@B@BAHAH@BBD@B@B@BABABAB@B@F@D@B@BBBDBBDBBBH@BBDDB@@@D@D@F@B@@AB@BBB@@@F@B@@DB@@BB@B@B@BB@BB@@DBDDDBBDBB@B@BABADEFCFCDEH@BABABEBABA@A@A@C@ABEBC@A@CB@@@@@B@@BBBB@@@@B@@@B@@B@@@@@B@@@@@@@@@BA@@@@@A@@@A@A@@@@@@@@B@@@@@@B@@@B@BB@@BB@@@@@B@@@@@B@BA@@BA@@BA@@BAB@@@@@@A@@@@B@B@@@@@B@@@BB@@B@@@B@@@@AB@@AB@B@@A@@B@@AB@@AB@@@@@B@@AB@@@B@@@B@@AB@BA@@B@@@@AB@@@@ABA@@@A@@AA@@@AB@@@@A@A@@@@@@@EH@B@@@@D@BB@@@B@BCD@DBBBDBBJDFDBBBD@F@BBB@BBBD@B@D@B@BB@@B@@B@DLCJAB@DCBCBCBABAB@B@DBDBFBFDDBBB@@@D@B@B@D@@@BBBB@DBB@BBB@@BBBB@@@BBB@@@B@B@B@B@B@BBHFFDBBBBB@BBB@@@@@@BABAD@@@BB@@BDDDD@BBDDDB@BB@@DBBBB@BB@BBDBDBB@DB@BBB@B@B@B@DAD@D@B@FBF@BBFBBBB@B@B@D@@@DBD@DBBBBDBD@DBHBFBPFR@HBBBB@@BADABCBAB@BAB@F@FBFBHDDBD@B@BADADAB@DAH@B@BBDDB@@@B@DAD@DAD@HD@A@ABCBGBEDEFABA@A@@@@AACA@AAA@C@C@A@G@A@ABADCDA@ABC@A@CBAB@BABABCDCFE@ABABIBCBE@A@A@C@K@A@CDEFKDC@@HAPEDAB@DAB@BA@E@CBCBE@ADABCDAF@H@F@B@B@B@F@BA@@@ABEDEBC@EBA@@@@@@B@B@@@BBB@BBD@DBFAJ@B@@BB@@BB@@F@DB@@BB@B@FBF@@@HCDADCB@BABB@@B@@B@BB@@@BBN@@@BAB@D@DADAB@B@B@B@B@BB@BBB@@D@@B@@B@B@BB@@B@@BB@@@@@B@@BB@@BBDB@@BB@@AB@@AB@D@B@@@B@BBDBBBB@@@BAD@DADADCBAB@@ABBFBB@@BD@@@DADABADE@AD@DAXAFA@@BA@A@A@ACEECGGCACAA@EBC@C@CACAEGGECCAE@A@A@@BE@ABABADEBAFADCDCFEBABCBG@C@CAG@CAI@C@E@A@C@C@@BC@@@AA@GACAE@I@A@E@A@C@A@C@@@A@"],encodeOffsets:[[112323,19283]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("保定",{type:"FeatureCollection",features:[{type:"Feature",id:"130602",properties:{name:"竞秀区",cp:[115.45877,38.877449],childNum:1},geometry:{type:"Polygon",coordinates:["@@CC@@@C@A@EAC@CCBK@@KBAD@B@D@AC@IF@BEMBBE@CJ@@A@@A@ACB@AEF@@A@A@CAEC@@@@AD@BADA@CB@DAAEDAAE@A@A@C@@@A@A@C@A@A@A@EA@@A@@@AB@@EBCAG@@AE@@AAAA@@CECA@@AA@@AC@@CA@AAAEE@@@@CECC@@AA@@CC@AA@AACA@@A@AA@@@@SI@@GCDG@@@E@G@@@@@@@@IBG@E@@AFIO@A@@POABMB@@A@EA@M@@FABA@@HEAEACAEAGA@E@@ACACOAGACAKEBC[@ABBBBB@@@BAAA@AHCAC@BHABA@E@@BI@@@A@@DADEBGBBDE@A@ABABBBJ@PBHDHDFFAFBJE@CBABAFEBFB@@ABE@AAG@E@@BGBGBC@BD@L@D@@A@BFCB@BBBJBBB@BE@ABBBBBBBBH@HH@@BRBBB@PBDB@BH@@@D@DAFAH@@H@@HD@D@@B@@GB@@BD@DDFHA@@BDABC@@@BFF@BD@@@@BBBJGB@BBB@HBFH@@BCB@D@B@@R@BB@BH@J@FAB@@CF@F@BEB@N@DCBEDBB@DBD@L@@@DJD@L@B@FFBHCD@BF@AFAHAF@BAFCB@B@@BD@DCB@BBBAF@F@D@H@H@DBF@D@B@@B@@@@@NA@@FANCJE@@@@LGPOLKDCB@H[BGD@BM@@A@"],encodeOffsets:[[118260,39893]]}},{type:"Feature",id:"130606",properties:{name:"莲池区",cp:[115.497097,38.883582],childNum:1},geometry:{type:"Polygon",coordinates:["@@ADLFDBHBPBBDBD@@@FHBFBDBFBFB@GB@BA@EN@B@@F@BA@ANPB@OB@P@EJ@BF@H@JA@@@@@@@@@H@F@@CHHD@@TJ@@@@BBB@@@DBBBB@@BDD@@BB@@DDDF@@@@FFBB@BDB@@BD@@BB@@DBDF@@BBBB@@BF@@BHAD@FA@@B@@@BB@@F@B@B@B@D@B@B@@@D@B@BBFCBBFCBA@@DCBABC@@B@@D@BF@D@B@BE@BFA@BDB@@@@BI@@DAFNAAFE@@JBDC@A@C@AB@LL@DA@DBD@F@B@D@@DD@CH@@AJ@D@AK@EDADABAF@@A@CB@@@D@@DPA@AHA@EBCDAF@HABH@BD@H@HA@@DBBBFADAD@LA@ALA@ANAD@B@DGDEDENADABC@@@@@@D@@GB@FC@G@@BAAEAEAI@E@@AG@@@EFABAD@@ABC@CBABA@ADAF@@CC@@A@CBQC@A@@FAFCBG@CBCE@E@CK@AEA@@I@@EA@C@@BCB@B@@C@AC@@E@@@A@GD@@EG@@EQ@BKBUD@@A@GHC@@B@AAC@@EA@C@A@@C@EA@@A@AC@@AM@I@EC@EC@G@GAC@@@@AE@K@@AAAK@ICG@@AG@AAA@ABGB@B@@@@@@@DABA@ABGJG@@BE@AFC@A@A@@EG@E@@@G@AACC@@A@E@@A@@@A@@@AFB@A@A@@A@AAA@A@AAI@AA@AK@@C@AI@CBABAF@DA@CAA@CBAHCH@@CBKAABA@@JMGIBECCA@A@GCCKE@C@@E@GCGCABDBK@EBE@BDCBAA@HA@@DBDHABB@B@H@R@BEA"],encodeOffsets:[[118153,39741]]}},{type:"Feature",id:"130607",properties:{name:"满城区",cp:[115.322334,38.949119],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@K@C@CAA@CAAFCDM@A@AFE@E@@DA@EBI@G@@AAAQ@@@@A@CDA@AG@AE@GAA@AHAAIAA@@@@ACE@AE@@D@BAAC@@GBCE@CAC@@HA@@@AC@C@@GG@@@BGBE@C@C@@AGA@AC@OAAQA@AG@@GAGAAAAAABAF@@AAAIAAA@ADAAEB@@@@C@KACD@HAHA@AF@H@BBF@BA@@EAFABEBADAF@AIBEEEGCGCOAI@AABABAB@F@ACHAFABC@CB@@@J@@AF@B@BAAGD@DBBGB@BB@A@@AAAABA\\@FB@A@Q@G@AAAGBAC@CB@@GACEBAE@AEBIBEEAAK@MBE@@@@@A@CF@@@@EDBBABCBE@M@KBAA@C@@ICA@BCCCICA@@O@EAA@AAECA@ID@@CH@@@@K@EYFC@@DBXG@EACEAC@CACC@C@AA@G@E@AAC@@AC@KCGCCGCG@@BC`@L@BECA@E@AACAU@A@@A@@OA@JA@AB@LDDDNCDCAC@@JABCBFN@@D@DB@L@BDLBDBJBHDBFBHA@B@DRAD@BHE@@D@B@FE@AB@DAFEFDL@BALEDEJEBEF@PBBBBBBFFFDBB@@FFL@F@HBNH@FDANNAPABAFBBHLD@@B@B@DADCDEHCDA@GD@@C@A@@@@@@@C@A@@@@@@@CAAAACCCCA@@@@@A@AE@G@AWE@C@I@E@CB@BA@@BA@CFCBC@EBGBEBADCD@DA@@BENGH@JBB@BDJ@FA@CBCCECC@C@CFABCDE@E@GBCBEAGBCB@BFH@DGHKPAB@B@BBD@DJ@FDBBDD@@BHAB@B@B@BADA@@BABENAB@B@BBFADA@BBA@@@AA@@@BCBA@@@A@@BCB@@@@@BC@@@@BA@@@C@@@C@AB@AAB@BA@@@@@@AA@@@@B@@EAAA@@AA@@CB@@@@A@A@@BBB@@@BC@E@K@A@C@A@CB@@A@ACA@@AAA@A@@BC@@A@@AA@AAC@@@@@@A@@@@A@ABEAA@A@@BAAA@@@A@ABA@A@@AA@A@@@ADC@ABABABA@@BA@@@@@A@@@@B@@A@A@CBAB@@ABA@A@A@@B@@A@@@A@A@@B@@@@@@ABC@A@AAA@AACA@@AAAA@@@@@BABC@@@A@AAA@C@A@A@A@@AA@CAAB@@A@AAA@A@@@CA@@CA@@ABBB@@@B@@C@A@A@A@AA@BE@A@ABABA@@@@B@BA@AB@@@B@@@@AB@@A@CBA@@@AB@@A@@B@@AB@@@@A@AAAAA@A@@AA@@@AB@@EB@@@@@@CA@AA@@@A@AAA@@@@A@A@@CA@@@@@@@@AB@B@@AB@@@BA@@@C@A@A@@@A@A@@AC@AB@AA@ABA@@B@@@@@B@@A@A@@BA@@B@@AB@@@@EB@B@@@BA@BBA@@B@@A@ABA@@@A@@@AB@@A@@@@BBB@@A@@B@@ADA@@@CB@@@BC@AB@A@@@@A@@@@@@AA@C@@@A@A@AB@BAAA@A@A@@@A@A@@@A@@@AA@BA@@@A@@B@BA@@B@@@B@BA@@B@@B@@BB@@@@@@BB@@@@B@@@@A@@@@BA@@B@B@D@B@B@@AB@B@BA@BB@@ABB@AB@@@@@B@@BBB@@@@@BB@@@@@@@B@@B@@B@@@@B@BB@@AB@@BB@BB@@@@@BB@@@BBB@@A@BB@@@B@@@BB@@B@B@@@BBB@@AB@BA@AB@BAB@@@@@@A@@BA@ABA@@@A@A@@@@@@B@@A@AB@@@@AB@@@B@D@@@@AB@@@@@BB@@@B@@B@B@B@@@@@B@@@@@B@B@@AAA@@@@BA@@@A@@@@BAB@@@B@@@B@@@@BBBBBB@@@@B@@@BB@@@B@B@@@B@@@B@@B@@B@@@BA@@@BB@@BB@@@B@@B@B@BB@@BB@@BBBB@@@BB@B@@@@A@@@@@@B@B@@@BB@@B@B@B@@B@@BAB@B@@@BBBBB@@BDBBAB@@@B@BB@@@@B@@@@BBA@B@ABB@@@AB@BAAA@@AABA@C@@BA@@F@BA@@@@@A@@B@BABADC@@@@BADC@@B@@BBABB@@BA@B@@@@B@@@B@B@B@B@B@@@BB@@@@B@@BBB@@@@@B@@@@BB@@@@@BB@@@@@BA@BBAB@@@B@@A@@B@@AB@B@@AB@@@@A@@@@@A@@DCBABC@@BC@AB@@AA@@A@@@A@@@@@A@@@@B@B@@@B@@A@@DC@@B@@AB@@@BA@@@@@AB@@A@@A@@AAAAAA@@A@@@@@@@A@ABABA@ABA@@@A@@B@BADC@@BABAB@B@BBD@BB@@DBB@@A@@@ABA@@@@AA@@@A@A@A@@@A@AB@@AA@@AA@A@@@@AA@A@@@@CB@@A@@FA@@@@BA@@@C@A@@@@D@D@BB@@B@B@@A@@AAA@@A@@@AB@B@B@D@BAB@BBB@@@B@AB@B@@B@@BB@@@B@@BB@@BBBD@B@@@BBB@B@B@B@BBB@B@B@@@B@B@B@@@@@D@B@B@DAB@@@B@@BBB@@B@BB@@BAB@B@B@@D@@B@DBD@@@B@@B@@@B@@BB@@B@@@BADAB@D@@A@@@@AC@@@A@A@@@C@ADA@@@AA@@@@A@@D@@A@AB@@@@@@@@@@AB@@A@@BAB@B@@ABABAB@@@BB@@BABAH@BBF@J@RAD@@@B@@@NDD@BBJLFJHLHJLHLA@@NCJEJKFAFCBADAJEBAP@JBZFDBFDBBDJAJADAFCDGR@JBLHFHFBBJHLJJFHBDBD@LDPFB@LBNDHAHANCNBR@NBCIKOEIAAEIACAGAE@I@EBI@@@ADQ@ABM@K@@DIXMBAB@BB@@B@@BA@@D@B@@@@BB@@DAD@F@D@BAFB@@@@B@FD@@B@BA@A@@@@BAD@@@B@B@@@@@BB@BB@@BA@@@@D@B@@BB@B@@BBB@@B@@@D@@@@BBBA@@@@B@@@@@BADABC@@BABABAD@B@B@@ABA@A@@BAA@DCD@@@BA@@@A@@@AB@@@@@@A@@@A@@A@@AD@AE@@@@B@@@@AB@@A@@@AD@BD@B@@FC@A@AD@@AH@@AG@@A@CIB@@@B@@GB@@@@@ACB@AA@@@B@@A@@@@B@@@B@@E@CF@@AE@@ADKBAJEBABAD@LEV@L@B@D@F@@A@CAE@C@G@G@C@EBEAA@ADA@CAC@@@ADABE@ABEBGBEE@@ADCAGEEA@K@C@CI"],encodeOffsets:[[118197,39883]]}},{type:"Feature",id:"130608",properties:{name:"清苑区",cp:[115.489959,38.765148],childNum:1},geometry:{type:"Polygon",coordinates:["@@AACGCCC@E@GGCA@AE@ABA@A@@A@@AAA@A@@A@C@@AAA@S@CBIHAD@FA@ABAH@LD@@D@HADEFCDA@@F@BHHDDFF@HBB@@AFCFEHAD@@@@OZ@B@BCH@B@D@F@B@B@BCPMHE@I@IDA@KF@@@@@@GHAL@B@@@@BL@DBH@@@H@@@D@RGDADLFHFJ@HB@BAT@D@B@BEJEH@@G@E@AEAM@C@A@U@AIAE@IAABA@@D@@@@K@C@AB@F@JDB@B@F@B@LAB@FER@ND@BBF@PB@@@BB@V@DBBBF@B@FD@A@KD_@AH@HDDDDH@LBD@@BD@B@F@HBBD@D@BD@DBDDFFBH@AW@CD@ZE@F@L@@G@@DC@@JDBBF@BBB@F@PB@JDDDADB@JD@@@DBBLAN@F@DABAAAFC@@@@DEB@@@@@F@NAL@BBFFJAFA@BBFFABDBBDAACF@FAL@CABAHDHDF@@@@DLFDD@H@BDBFDJANH@IB@BALBDA@@DGBGDAB@DBB@@CBEBADAJ@@B@DL@@BBBJ@BBB@B@BBB@@@@B@BEA@B@@@B@@@BF@B@@@DDBBH@@@F@H@@FB@B@D@BEF@@AH@HIBAB@BA@C@@@@@@@AHABAB@BBH@@BH@JDL@BB@BL@F@@B@@D@HBH@D@@FFDJ@N@@BD@@B@BB@@F@DB@D@B@@FD@BBA@@@GD@H@BC@AVALR@@FH@@FC@@H@B@@@FD@@B@DA@A@AD@@@DFB@@@JB@BFL@@D@FDFDAH@DABE@EB@D@AR@D@BD@@DE@CB@BABAB@DAD@BC@ABEB@F@@BH@@@FBJBFBFAB@@@HEDA@@HL@@DB@F@BJRCJDNDVENAD@H@JBHB@@ZAN@TEFEDCDDBB@BBCDE@@@A@@AC@@B@@@@AB@B@@@DB@@D@@AAAA@AIDAH@@@B@BD@EAACC@A@AB@D@@A@C@ADADA@@@ABA@@DABA@CB@@AD@D@BAB@D@HAHAD@B@AE@@B@DB@@DAB@@@FBBBB@@@@B@@ABL@FADAD@F@FBDABADEAAA@@ABABE@ACGEAEECA@A@EEBA@ED@A@AIG@@@BC@CA@AGEA@A@A@C@A@ABEAA@ABEECBA@@@CCG@A@E@CAABCBA@EAA@C@@C@CAWU@@KF@FAACJABAAC@ACA@ACGAAACA@GBCB@@_UAACAAB@LA@A@EEAECKD@DDDGAAAA@AAEBEGG@AFAJ@XCD@@CPA@A@E@@AEDABBBFD@CGAA[D]D@B@@BBH@LA@B@B@@@F@BGB@GE@@DIB@@E@AC@ABC@@@A@CECAEACA@ECBA@A@CAACB@IB@JABED@@BB@B@B@AEAAAEBG@AAIA@E@YACAE@AA@I@AD@AC@CBA@E@ABA@@@E@AC@BGCAAE@A@A@@ACG@DM@ADAH@L@D@JE@@FCBBB@@A@AFAB@@B@@@CBA@BD@@AA@@EC@AE@AGCACE@@DGA@FA@I@KAA@@ABC@AAQA@AHEA@AA@APGAG@CACGEECA@ABE@@BA@EOAAABA@EA@EAE@CMA@EAAC@ACAE@@GCAMCAG@C@CH@@@@C@ABMGWKEMC@@@@@@OCaISCEA]GOAA@UAC@MEM@CA@EAMA@K@C@@DBFM@CAA@CGEAE@IBC@AF@F@@CBG@@EE@AB@JEBBHGBCC@DA@IBG@I@BMEAG@C@@@ADU@A@AIEBGB@@SBACKBIA"],encodeOffsets:[[118120,39494]]}},{type:"Feature",id:"130609",properties:{name:"徐水区",cp:[115.655774,39.018736],childNum:1},geometry:{type:"Polygon",coordinates:["@@BADEHE@AB@@A@@A@@A@A@A@EAO@ADBBADG@ECK@AB@C@CBEBK@BA@@@A@@A@AAEA@@A@CB@@CAA@@@BFA@C@GBGBC@A@ABC@C@@BA@@DABCB@@AB@B@@CBCB@B@D@BC@A@@B@BDDBB@FACA@@@G@CBBJB@BB@BC@@@CA@@A@A@@B@@A@@@BD@@@B@@CFAD@AAACCCDEFSFM@YB@@GAIAG@C@MBUFMCICQDAIE@A@@CK@C@@@@@@@ADCBMBCFCFCHA@C@MB@BKB@BKBC@CBEBAACA@@GBG@C@@AAGGBE@CBAD@FGB@BOB@CC@@@A@@D@BE@ABCBCB@FBLC@I@@BG@@DB@@@ANC@AHG\\A@CDKLOPKH@@@@IFMDEB@@MB@@@@A@@@E@C@A@K@U@KFC@ABABIFABCL@BF@@BE@@D@FA@@@A@@@@@@BA@@@B@@BDA@B@@@@HA@@@A@@JA@D@BH@@BG@@BC@@B@BED@@@AACC@@B@@@BA@@B@@A@@@@@BFC@@BB@@@@B@@@B@@@@A@@B@@@B@@AB@@C@CDB@AB@@@BAB@BA@A@C@ABABAB@@ADCBAB@@@@A@@@@@ABAA@@@@@C@@@AA@AA@@@AAA@@@A@C@@B@@AA@@AAA@@@@A@A@@@C@AB@@@@@BABA@@@ECA@@@@@EAABC@E@C@CB@@AA@@@@@A@CB@@AA@@@AAA@ABWNCJ@@@LAN@BCR@B@@AJ@F@JBFBHBDFJBBFJLPDJXXRHPJBBPHT@VAJCDAVGLAHADBJDJJDFFFFHLJTFPFR@LANE@@DABCLIPFFB@@D@@A@IBCB@@@AGF@B@@EAC@GBEH@@@N@B@@AB@BA@@bKD@FATEAIN@D@D@PAJAFAbGPDJBTDNDD@PD^DNBD@B@VENCB@TODARGFAJADAHAB@@@NARBD@N@NANI@@BIBA@A@E@@@CBM@ABEDSBKBI@@@@@A@@BK@I@AAEAC@K@C@AAK@@@A@A@EDIBEJGFCLIACGGCIACKQ@IAOEQIIIGCCGEIMCDA@IU@AB@AGC@@A@ADAJA"],encodeOffsets:[[118521,39853]]}},{type:"Feature",id:"130624",properties:{name:"阜平县",cp:[114.195104,38.849152],childNum:1},geometry:{type:"Polygon",coordinates:["@@JCXELAV@LLFPF\\^NRH\\HPADIBIDSDMFKBEDI@CBAB@B@@@B@@@B@@@@A@@@@@@@@@@B@@@@@@@@@@AB@@@B@@@H@@@@B@@@@B@@B@@@@@@@@@@B@@B@@BB@@@@@@B@@@@@@@B@@@@@@@AA@@@@@@BA@@B@B@@@@@@@@@B@@@@@B@@@B@@@@@@A@@@A@@@@@@@AA@@A@@@@@@B@B@DAHEJCHO@KDODGHKdATDHDJDXHVCJEHEJGRANDRHZNPLB@DAD@B@BBD@@@B@@@B@B@B@D@D@B@DBBDB@BBDBB@@BBBD@B@D@B@@@H@FA@@B@DA@@@@DB@@F@B@BBD@D@D@BBB@F@B@B@D@D@@BDBDBB@BBB@DBBAB@DA@AB@@@FB@@BADCB@B@FADABABAB@@@@@BBBBDB@BB@@@D@DBB@BBB@B@B@B@DABA@@F@@@B@B@BB@@B@B@@@BBD@@@DA@BB@B@@@@@DABAB@B@B@@@BBDAVCbGJAVAF@H@JAD@BADABAB@@ABABA@@BABABABA@CBABC@AB@@A@BB@B@DBD@B@@BB@@@B@BAB@@@DAB@BAB@B@D@B@BA@@BAD@DAB@B@@@BA@A@@BAD@@@@A@@AAAA@@@@BABB@@@A@@@AAC@A@@DABCDA@AB@B@DABA@@@@BA@@@@@AAEB@@ABAAA@A@AB@BA@@B@B@@@B@B@@@B@B@B@B@@B@@B@D@fAPCNENMHMDE@QBM@QAG@GCKIWACEKCEACJMFKAKWCK@K@M@BOJQBEHKJMDGDGDIBQDO@C@@@EAI@ADG@CBAFIHIHIBC@ABGAIEOAE@E@E@IA@AKAIEGIGKCKC@@@CACA@CCKBCDG@G@AAAAG@@@A@A@AB@@IFA@EAA@DKBCBCCACAEBKDUJCBUFS@I@C@AC@E@E@AFCFCD@@@BA@@BAB@B@@@@A@EBC@CAGAE@A@@A@@BC@CAA@@A@@@AAEAACCAACCEM@ILMcGSGQGICCASCeDMDMNEDIJQFK@MKAUEQCMAgGKAMCWAO@WABOQ@OCEBC@C@CDABC@EAEAA@EEKECAAAAABA@CAGCAC@CBGBCAGC@AA@MBCDEBCAKAE@A@@CABEDAB@@CDAD@H@DKBA@CAGAADALABM@CBCDBDADA@KCKGA@A@ABAB@@E@@BCDBDABCBA@AAIGAAIACDCBABA@GEC@C@@AA@CAAAA@AACCC@AAABABADCD@@A@CBG@CBABABABABABADAB@@AB@BAD@@CD@@ABEBA@A@CAK@A@A@CBABC@@@E@C@E@C@@@A@A@CBE@ABBDDBAJ@FCDQHGFCBGFEDCDADCBEFEBGDEDABA@@BCBAB@BA@CBA@C@C@C@GAGBGACBMFULKF@J@LCJCJ@RBRHLNJNFHPCVKHQLKHOBUFQHMJKLEJAFAFOLCF@@IJ@L@X@N@FANAJCTANCTCRBJBLFPJRNFHAT@TBNLPPJJHHRJNJJHLJLHFFJFPHLNBNFNTFVEXCT@FPJNDJFJLNZN"],encodeOffsets:[[116697,40040]]}},{type:"Feature",id:"130626",properties:{name:"定兴县",cp:[115.808296,39.263145],childNum:1},geometry:{type:"Polygon",coordinates:["@@Q@UHCFADAJAFAF@F@B@FDDNJ@BDDADGDAAEAC@EAAAEAQGC@A@A@cE@@@@C@SCE@KBOAKAI@UAA@@@A@OAKAIAQ@OCGIACSQMBM@C@QAMB@@A@GBCBIBEBQHCBSPA@MDUFA@C@MA]COCC@MCSCIAOCaHEBIBOBC@C@M@BJSFEBC@aL@@ABA@@BA@M@@@G@AF@HBD@FA@E@BH@@A@AD@J@BC@FBD@PHBCF@DHBDBBABFJ@L@H@D@BAJ@FCPBNLABPKBBDB@@BHJLLVN@@@@B@@BRJHDNJBDTLJJBDBJDD@DI@@DBBH@BFI@DFB@BHR@B@@BB@JA@CBAHBH@R@BDD@@F@BV@ABAFC@@DG@@B@DE@AB@JBBBDD@H@DDFDBBF@B@B@B@BC@ADAB@D@HBTFJBB@BBB@D@B@BAB@BABCBA@@BABCBAAA@AAA@AACDAHCPEHA@@D@DDNHHPFJDHBFBBH@TAJ@X@FBBHFBD@RFJBTBFCB@BAB@FA@AACJ@BA@C@AD@@GBA@EB@@EBADA@AB@FCFBDA@A@A@CJA@BBDFBH@DCBA@BH@D@@BD@B@@DB@LAB@@CB@B@@HNAJBP@@DB@F@@@@CL@B@@DBAJA@DD@@D@BB@@@D@@GLB@GHBB@@@H@BAAA@EF@@@@EG@BCHAACA@[IAKAE@C@C@CBCB@BECBAGFBDCB@B@CFFEBA@ABCB@DCD@BABBFAB@AEH@FA@@BA@@FADAHC@@FC@A@ECAABCACABEB@BAB@BA@@BCDEDDD@AAA@@C@A@@@ABAD@FABAHAB@@BBB@FAB@B@@@BB@PB@C@C@I@CAEH@FABAACJ@@E@ADA@@H@DABA@A@E@@M@KAACAC@A@A@ABAD@@A@E@CAED@@CBC@KAAE@@CEgBAD@AGGB@ABC@GBEBCCIAGCIEO@CCGCIOEKC@@@@ME@@I@G@"],encodeOffsets:[[118701,40038]]}},{type:"Feature",id:"130623",properties:{name:"涞水县",cp:[115.713904,39.394316],childNum:1},geometry:{type:"Polygon",coordinates:["@@GDCBBD@BBB@BBBABADAB@@ABADABA@ABA@C@A@AAA@IASEGAC@A@CB@BADA@A@A@E@AAECCCG@C@A@CBABABCDCBEDCDC@C@@DFFFDBJ@FA@QHKHADCR@BDDC@G@@LCDNDBBDFD@B@D@D@DDBDBNABIDABIBKAM@IBCDGHAJ@BFFBHBFCJARARCDKRABEDCDEJAN@B@H@FALIL@DBHBHMHQNBHEDABA@OAQ@CFI@ABEDOBCBCA@@E@A@A@GB@@AAA@A@@@EB@BCB@B@BA@@@A@@@A@E@A@ABEF@D@@A@@BADAFB@@BB@@B@BA@A@@B@@AB@BCB@@@@@B@@@B@@ABA@ABA@@B@@@@CB@@AB@B@@@BAB@@@BB@@D@@BB@@AB@DA@CBABABGDA@CBA@GBE@A@CAA@BAA@@@@@E@AAAA@@A@@A@@AAC@@@A@@AA@@A@CA@@@@C@A@@BAB@BCBA@@A@@AAA@@AA@@AAB@AAAA@@A@@AAC@A@@@A@@CAACA@@A@@BA@A@@AA@@@@@A@ACAAAC@CB@@ABA@AAA@@@@BA@@@A@A@AACAA@A@A@@@AAA@@@A@A@CAA@@AAA@@A@AAEC@@CAA@A@@@ABA@A@@@@A@@AABA@A@AGCA@A@AAA@@@BA@@@A@CAA@@AA@@@A@@A@CAE@C@C@A@A@@BA@@@A@A@A@AB@BAB@B@B@B@@@BC@@BA@@B@B@@ABA@A@ABAB@BA@A@AA@@AAE@A@CDCDABA@A@@BA@@BABAB@B@@@B@@BDBB@D@@BBB@BBBBB@D@DB@@BB@BAB@@@B@@ABA@@@ADAB@B@B@@@@AB@@A@E@C@A@@B@@A@@B@B@@@B@BB@@B@@@BA@A@A@AB@BAB@B@@C@@@A@ABA@AAA@CBA@AACCCAC@A@@@A@@@AB@@AAA@A@CDCBABA@@BA@CDABA@EBAB@@A@A@@@@@AA@@@@CBA@A@ABA@CACBIBG@A@C@C@G@I@ABCBABA@E@@@ABAB@@A@AA@AIACAA@ACAAAC@@@A@A@@CC@ACCC@A@GDC@C@@B@@@B@BAB@@@BBD@F@BABCBCBGDEBCB@@A@CBIDC@A@A@AA@@A@@@ABA@A@CBA@ABABC@A@A@A@ABAB@@AB@AA@@@EAM@@BA@A@ADAB@B@B@BCB@@A@A@ADA@A@A@IAA@A@A@CBCDGD@@CBA@ABABA@@@E@@@C@@@C@A@A@@B@@@BA@AB@@@@ABABCBABCBABA@@@@C@@@AAAA@ACACCCAAACA@CA@@@@@A@@@@BA@@@ABA@@DABA@CB@BAB@@BB@HBB@@@BBB@BAB@@@BBB@DAB@BA@A@@@A@@@E@A@@@AAACC@@AABA@@F@@@HEBAB@DAFCBA@A@A@AAAEEA@C@A@BCA@AA@CA@@CAA@@E@A@@AA@@AA@AAA@ABC@@@A@A@A@CAC@@@A@@BCD@FA@E@G@E@CAC@@@C@ABCAA@A@A@C@A@A@ABC@CBC@CBEAaAOEA@AACA@@@BAB@BA@C@IBCBCBA@@@@DA@@@CBA@@B@@ABGBABA@@@AB@@A@A@A@@@A@CBABAB@BC@@@AD@@@BB@@@B@@D@@@B@@@BB@BBD@BBB@B@BB@@BB@@@@BBBB@BBD@B@BDB@@D@@B@@@@AB@@@@BBDD@BB@@@@B@@@BA@ADABA@EBA@C@A@@B@BA@ABA@AB@BABA@@B@@BB@B@@@@@BA@AB@D@@@BBD@@B@D@FB@@B@DAB@B@@@BB@@DBB@BB@@BBDBFDBBB@@@D@B@@@DBBBBB@@BBB@@@B@DBBBDBFDBB@@@D@D@@BB@D@BB@@@BBB@BABBDBBB@B@@B@F@BBB@@@@B@@BDAD@@@B@BAB@FWNMNCHGTELGNGPHJHHTJRDj@PAbGNCPCXIJGPEJ@DCHGPIHCNEHAB@JBDBFFBDBXCLAHBDBBD@N@PCF@NFTHDDXRFDHJDHJFB@BB@@@D@@BBDBFB@@B@@ABA@@B@BBDBBA@@B@B@B@B@DBDDD@B@B@DB@@BBD@@BB@@@DBB@FBB@B@BBBBBB@BBB@BBB@@DD@BBBBB@B@D@@@B@BDB@BB@@BBBBBDBBBBBDB@@@D@BBB@@FDBB@BB@B@FB@@B@@BADB@@BB@FBBBD@@@DBF@B@LDDBB@FA@@DBDBD@@@@B@BA@@@C@ABABA@ADAB@@AF@BCFCB@@A@BBBFBB@BB@BBFBBBBBBBFB@@@@@D@@DD@B@@@BAD@BAB@B@L@@@B@B@@@B@BBD@B@@@BBBBB@@D@BB@BBB@@BD@@@BB@DB@@B@@@@@DBDD@@@BBBBDBB@@BB@@@DBB@BBB@B@@@BBD@@@BA@@B@B@@AB@@@@BB@@@B@B@BED@@@@BD@B@BBDB@@B@BRBX@PDNFDZFJDFDN@BJTJVBFFBBFBBBBDB@@B@B@B@@BB@@@B@B@B@D@@@DD@@@@B@D@BAB@B@B@BCBA@@D@HA@@BA@AD@BCB@BAD@@@DABA@@BABBBAB@B@BA@@B@D@@@@@DA@@@@B@@@@AD@FAB@@@@A@@BA@ADEBABABA@A@A@A@@@@BAB@@@BA@AB@B@BAB@@A@AB@@AD@DB@A@@B@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@@B@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@A@@@@@@@@@@@@B@@@@@@@@A@@B@@@@@BA@@@@@A@@@AB@@@@AB@@@@@@@B@@AB@@@@@@AB@@@@@BA@@B@@AB@@@BA@@BA@@@@BA@@B@@@BA@@@AB@@@@A@@@@BA@@@@@@AA@@CA@@@AA@@A@@AA@AA@@@AA@@@@A@@@@@AA@CA@@AA@@@A@@@AA@@@A@@@A@@AA@@@@@A@@@@@AB@@A@@@@@@B@BA@@B@B@@@@@B@B@@@B@@@@AB@B@@@B@@@@AB@BAB@@@BA@@B@@@BA@@B@@@B@@@B@@@@B@A@@@A@@@@@A@@@A@AAA@@@AA@@@@@A@@@AA@@A@A@@@A@@A@@@@@A@@@A@@BA@A@@@A@@@@@A@AAA@@@@BA@@A@@A@@A@@A@@@A@@AA@@@AA@@A@AA@@A@@@@AA@@@A@@@AA@@@AA@@@@A@AA@@@@A@AA@@@@@@@@@A@@B@@@@@@A@@B@@A@@@@@A@@@A@@BA@@@A@@@@@A@@@@B@@BB@B@@AB@@@B@B@BAB@@@@@D@@@BAB@B@@A@@@@@@BA@@BA@@@AB@@@@A@@@@BA@BB@@@B@B@B@@@B@@@@@BA@@BA@@B@@@B@@AB@@@B@@@@@B@@@@AB@@@@@B@@@B@@@BA@@B@@A@@@A@A@@B@@@B@BA@@B@@@@AB@@@@@B@@AB@B@BA@@B@@@B@@@B@@@@@BA@@B@BA@@B@@@BAB@@@B@B@@@@@B@@@BA@@@A@@@A@@@@@@@@@AA@@AA@@@@A@@A@@A@@@@@AB@@A@@@A@@@A@@@@@@@AA@A@@@A@@@A@@@AA@@@@@AAA@@@@@AA@@@AA@@AA@@@@AA@@A@@@AA@@@@@@@A@@@@A@AAA@A@@@A@@@A@A@@BA@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@AA@@@A@@@@A@@@@AA@@A@@@@@A@AA@@A@@@A@A@A@@@@@A@A@@@A@@AA@A@@@A@@@A@@@A@@@AA@@A@@@A@A@@@A@@@A@A@AA@@A@@@A@@@@AA@AA@@@AA@@@AA@@@@AA@@@@A@@@@@@@@@A@@@AB@@@@A@@B@B@@A@@@AB@@@@AB@@AB@@A@AB@@ABA@@@@B@@AB@@A@ABA@@@@@@@@@@B@@@B@@A@@@@@@@AA@@@@@@AB@@A@@BA@@@@@A@@@A@@@A@@B@@A@@@@@A@@@@@@@A@A@@A@@@@A@@AA@@@AAAC@@@AAA@@A@@@AA@@A@@@AACA@@AA@@A@@@AAA@@@A@AA@@EA@AA@AAA@AA@@@@A@A@C@A@@@ABA@@@AB@@A@A@AB@@A@A@A@@B@@@@@@@@@@A@@@@@@AA@A@@@AAC@@@@@@@@@@@A@@@AAA@A@@AA@@@@@@A@A@@AC@@@AAA@@@@AA@@A@C@A@AA@@@ABA@ABA@@@@B@@@B@BD@@@@B@@@B@@@BAB@@@BA@@@@@@@A@A@CA@@@@A@@@A@@BA@AB@@@@A@A@A@A@@@A@@B@@A@@@@@@@AB@@@@ABA@@@AB@BAD@BAB@@@B@@@@@BAB@BAB@@@B@@@@@B@@@B@@@@@@@B@@AB@@@B@@AB@@@@ABAB@@@@@B@@A@@B@@@B@B@@@@@B@@@BA@@BA@@BA@@BA@@B@@B@@B@@@@B@@BB@@B@B@B@@@B@@@DA@BB@@@B@@@B@B@@@@@B@@@B@@@B@@AB@@@D@@@@@B@@AB@BA@@@AB@@@B@B@@@B@@@@@@A@@@@@@@A@@@@@A@@A@@AA@A@@A@@@@@@A@@A@@@A@@@A@@@@AA@@@@@@BA@@BA@@BA@@@A@@@AA@@@@A@A@A@@@AB@@@@A@@@@@A@@@@@@@A@@@@@A@@@ABC@@@A@@@A@AB@@@@@@A@@@A@@B@@A@A@AA@@A@@AA@A@@AA@@A@@@@A@@B@@@@@B@@@B@@@B@@AB@@ABA@@BAB@BA@@@@@@B@@A@@@@@A@@@@AAA@@@@@@A@@@@@@BA@@B@@@@@@A@A@@@@A@A@@@AA@@@@@@@@B@@A@@@@@@B@@@A@@AA@@@@@@A@@@@@@@@@@@@A@@@A@A@@@AA@@@@@@@@@@@@BA@@@@@@@@@@CA@@@@@A@@@@A@A@@@A@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@B@@A@@@@@@@@@@A@@AA@@@@@@@@@@A@A@@@@@@@A@@@@@@@@@@@@@@A@@A@@@@@@@@@@BAB@BABAB@@A@@@@@@@AA@@@@@@AA@@@@@AA@@A@A@@A@@A@@A@@A@A@@AA@@AA@@A@AA@AA@@@A@@@@@AA@@@@A@@@A@@@@@A@@@@@@A@@A@@@@@@@A@@@A@@B@@A@@@@@A@B@@@@B@@@B@@@@@B@@@B@@BB@@@BA@@B@@A@@B@@@B@B@@@B@B@@AB@B@@@B@@@B@@@@B@@@@@B@@BB@@@@BB@@@@F@D@D@BA@@B@@@B@@@@@B@DC@ABA@ABC@@@AB@@@B@BA@@B@B@@A@@@A@@D@D@BA@@B@@A@@A@@A@@BA@A@@@CA@AA@@@AB@@A@AB@@A@@AAA@@@BAB@@A@C@A@A@@@ABA@@@@AAAAA@AA@@AACA@ACA@AAAAC@A@C@C@@BC@@@ABC@CBA@A@@@C@@@AA@@A@@@@@AB@B@B@@@BB@@BB@@@BAB@B@@@@@BDBF@BBB@BBDBDBBB@@@BABB@@B@@BB@BD@@B@@@@@B@B@@@B@@@B@@DBDBDDB@DBFBB@DC@@B@@A@@DABABABA@C@@BABAB@D@D@B@BA@@BAB@@A@ABA@@@A@@@@BA@ABA@@B@DAFC@@B@@@AAA@@A@@@A@@@@@AA@@A@@@@D@B@B@FABAB@B@BBB@DAB@@@BB@@@B@BBB@BFB@BB@BBB@@@H@BB@@AB@@B@@BBB@@B@B@B@BA@@B@D@B@JBBBB@BB@@B@B@@BB@@@@@BAD@B@BCBAB@@A@@D@B@FA@@B@@@B@@@B@@@@@@@@@@BA@@@@@@B@@B@@@@@BB@@@@@@@@@BA@@B@@@@@@@B@@@@D@@@B@@@B@@@@@B@@AB@@@@@B@@@B@@@@@@A@@@ABA@@@@@A@@@@B@@@B@@@@@BA@@@@@B@@B@@@@@@@B@@@@@B@@@@@BB@@@B@@@@@@B@@@@@B@@@@@@@@@B@@@B@@@@@@A@@@@@@@@@@B@B@@@B@B@@@@@@@@B@@@@@@@BB@@@BB@@B@BB@@B@@@B@BB@@@@B@@B@@@@BB@@@BBB@@@B@@@@@@@B@@AB@@@@@@BB@@@@@B@@A@@@@@@B@@@@@@@B@@@@B@@@@ABAFGDABADAFA@@DAFAB@@@BABA@@BABC@C@@@@BAAA@A@ADCDCBCBA@A@A@@@@@A@AA@@A@A@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@B@B@@B@@@@@@@@@@@A@@@A@@@@B@@@@@@@@@C@@@B@B@@@@A@@B@@@@@@A@@AA@@@@@@@@@@@A@A@A@A@@@@@A@@B@A@@@@@@@AB@@A@@@A@@@A@@A@@@@@@@@@@@@@@@@@@@A@@B@A@@A@@@A@@@@@@@@@B@@AACAB@DCB@FAD@D@D@B@@@AA@@B@B@B@BA@@@B@@@@B@@@BB@@BAFAB@@@@@@@@AB@B@ABD@@A@@@@A@@@@@@@@ABA@@BA@@@A@@@@@AB@@@@@@AA@@@@@AB@A@@@@@@BAB@B@BA@@B@@@A@@A@@@@A@@@A@@@@@@@B@@@@@@@AA@@B@@@@@@A@@A@@@BA@@@A@@@@@@@@@@@@@A@A@@B@@A@@@@@@B@@@A@@@A@@@@@@A@@BB@@B@@@AA@@A@@@@@@@@@B@B@@@@B@@@@@@@@@@@@@@B@D@@@@@@@@@A@A@@@@@@@B@@@@@@A@@B@B@@@@B@@@B@@@B@@A@@B@@@@@BA@@@A@@@@@@@B@D@BA@ABB@@B@@@B@BA@B@@@@@@@@BAB@B@@@BAB@@@@B@@@@@@B@B@@@@@AA@@B@@@@@@@@A@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@A@@B@@@BA@@@@@A@AB@@@A@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@B@@@@A@@@@@@@@@@B@@@@AB@@AB@@@@@@@@@@@@@@@@@@A@@@@B@@A@@@@A@@@B@@@@A@@@@@A@@@@@@@@@@@A@@@@@@@@@@@AB@@@@@@@@A@A@@@A@@@A@@@@@@@@@AB@@A@@@@@@@@BA@@@@@@@@@A@@BA@@@@@@B@@@@@@@BA@@@@@@@@@A@@B@@A@@A@@@@@BABA@@@@B@@@@@@@@@@A@@@@@@@@BA@@@@@@@@@@@A@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@B@@@@@@B@@@@@B@@@@@@@@B@@@@B@@@@B@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@B@@@@@@@@B@@BA@AB@@@@@@@@@@@@@@@@@@@@@@@B@BBB@@@B@@@D@BE@@BA@@@@BA@A@@B@@@@@@@DDABA@@BA@@@@B@@@@B@FEAE@EHI@C@ADGBA@CA@C@IDADEDCACCEA@@ABABAABEDOCBGBAG@E@@@@ABA@@ACCDG@AA@EBA@@CACAAH@@CB@HAB@FEBGB@BCFIBAHIBA@E@@FEFGDEBC@@FEBCBA@@BCBGBAD@D@ABF@BEPBBKACEABABCDABCDC@ADADCBGFCC@O@GE@EE@@@AKB@HA@BD@@AB@J@@AL@FC@CACC@@AFE@@@@BAB@DC@AAC@AC@K@@@AKBGAAAAO@BCG@CC@GI@@CB@@C@AB@@AAGA@EDSAIAQEC@EAAGEAW@I@SBG@AAAECGEIGOMGCCC@@@GBOF"],encodeOffsets:[[118469,40243]]}},{type:"Feature",id:"130627",properties:{name:"唐县",cp:[114.982972,38.748203],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CAE@@BAACC@@@GA@EA@C@@CA@@DGBDBA@C@C@CDCF@B@@@B@@HDABF@@@FFBD@B@@BH@BD@BFC@E@A@@CC@ABG@M@C@AAAE@MBGB@@@AAAAG@CA@G@@M@A@A@@@A@QAEBAB@LA@M@@@ABBHJDD@@BGB@BBBA@@BHFBB@@EBAD@BB@B@BH@L@DG@AAC@CH@DBBCD@B@@ADA@C@GCQ@@@@A@AAAO@WB@IA@@EAAOC@AA@E@@CA@C@GBABABAB@BC@A@A@@AA@GBCBS@EB@FGFAG@@A@MBKBGD@DE@A@I@@AG@A@C@AA@@@@AAAC@ICAAKAAAC@C@A@@IMA@IASCG@KBA@KL@BABAF@@@@AB@B@@CJENQFMB@B@@HBHF@NAB@B@@C@EF@@CPAFABADIDKHC@@DAFGLCBGBE@EBEDCDADCBGBC@IFAFAHBF@@@DEFABADALBJDBFAB@@BBBAB@F@@@BHBAHCJDDHBADCBGDA@GDIEC@KDCBGHCFGH@FDJBDJ@JF@FFDFDDB@ABA@A@A@A@A@AB@B@DBB@NDFH@@EJELEJCDEBA@AB@@@B@BEF@B@@DD@@@D@B@B@DA@@B@B@B@DABABBB@B@B@BAB@@B@@@BB@@EBCAEICCEAEDCBEJAHANBDFB@@@FBH@B@D@HAD@@@H@HER@@ADELCJKH@@@@EBSDIBI@MAOAW@YFCBEDC@ABMDCFA@CDIDGCACKDKDSBEBKBQDUJWJSJDL@HBH@RAN@RCFGNMNMFODeBC@A@@@@AA@A@A@A@@@A@A@@@A@A@@@ABA@@B@BBBAB@BA@BF@B@@@@B@@@B@@BB@FB@@DD@BB@BB@@BBB@@B@B@@BB@B@@@@DD@@B@@B@B@B@@BD@@@B@@@B@@DB@B@@@B@@@@@B@@BB@B@@BBBBBA@BB@@@B@B@B@BDBB@@@BB@BB@@BBB@B@@BABABAB@@@BBB@@B@B@B@B@@AB@@@@@BA@@B@@@@A@@@AB@B@B@B@VBJAD@TBLBHFHFBJBTBJJTVVLHLF@@VFLDNFNHFDHFHHHHBBTNNBRDB@JBH@N@TBJAJ@TAVAVCLEJENKDCDCJKJGNIFAFABALCH@D@B@L@L@LAPAPERCTEdGDOBGBAB@BABA@@@AB@B@@@B@@@BB@AB@@@B@B@@@B@@@B@B@BA@@@@B@@@BABA@@@A@@@@@A@@@ABAA@B@@A@A@A@@@AB@@AB@@@@@B@BA@@@@@@BA@@B@BAA@B@@A@@@@BA@@B@@@@@B@@@@@BA@@@@B@BA@@@@@A@@A@@A@@BA@@A@BA@@@A@BBA@@BA@@B@@@BA@@D@@A@@@A@AB@@@@A@@@A@@@@@AA@@A@@A@@@@A@A@@A@@A@@AAA@@@@A@A@@AA@@@@AAA@@@AC@@@AA@BA@@AA@@@@AA@@@A@AA@@@AA@@@A@@AAA@@A@A@@@A@A@@A@@@BAA@@AC@@@CA@AA@@@@A@A@AA@@AEAAC@@A@A@A@@@A@C@@@AB@@A@@@A@AB@@ABAB@@AA@BA@@@@@CA@@ABAAC@C@@@@AA@@AA@@@AAA@@@@@A@@A@@@A@@AABA@@@@BA@A@@@@B@DA@@@AB@@ADABA@@BABA@@B@B@@@B@@@BAB@@ABA@@B@@A@A@AD@BCDEFEFAB@@A@AAA@@BAB@@@AA@@HEF@LABGDEF@PAPDJD@AJGBA@E@GCEMGAC@ADGGGKG@GA@GCCC@C@GBCBAHABCBAC@BA@AAABCDAFAHCHEDEFCB@DFF@D@BDFDDADEDIHCJIFEHI@EAABINEFCBABABABAACCC@@@ALCDCBA@IBANIH@NI@ADA@AAC@EFCFAB@D@PBBBB@@@JBB@BDFBBDDBB@F@BBD@DD@@B@B@B@HOFAD@F@BBB@D@D@AG@E@CB@L@@AAIDI@A@EAIACD@FB@@@A@A@IAA@@CAA@@BAA@C@G@GRK@@@E@ABIB@BG@@@C@E@@DEFC@AAG@@AAEAAA@GO@EE@EF@@AM@@@A@C@E@A@A@G@GB@E@EEAB@BA@@AIACBC@@@@@@FA@GDA@@D@D@D@@ABC@E@AA@BKACE@EC@@@@CAA@@A@@A@@A@E@AF@H@@@@K@CPBXB@@BB@@B@@[@Y@C@CA@@A@@MC@C@QF@FBBE@IAKKECEEC@A@@"],encodeOffsets:[[117809,39560]]}},{type:"Feature",id:"130628",properties:{name:"高阳县",cp:[115.778965,38.700088],childNum:1},geometry:{type:"Polygon",coordinates:["@@JE@ACECAC@MB@DA@@@C@@BCBMDC@CDE@CB@CE@CBGBGBK@AFBP@PBJ@B@LBB@FDL@@BDBNJ^HN@D@D@FENABAFGHORQRADGDCBM@E@C@A@QAWAQAUBIBM@@BGFILKFABQCQEUBE@WNOBG@A@KA@AC@GBADABG@E@AB@FABE@@JC@ABAFC@BDHD@BBFD@@FB@@BC@@AAB@D@@@AA@EB@B@BA@AAED@@IFC@K@G@CB@BCNH@BD@@@B@BBFDBAHD@@B@F@@AB@B@FAB@DBDC@@B@JBBF@DBZBF@B@BJ@BAHBFBBBF@BFCLLJ@BDDD@@B@BHDBJABFBBDBD@D@B@AEJ@@@@GAE@AA@KB@AAC@EAAC@AB@BC@@AAC@E@EAGA@BAFAFA@CDA@DJC@@@@BA@@DABBB@@CB@B@@DB@@CBABL@@@B@B@@B@@CBABFHAHBDABAHADFDADADADFBDBB@@FA@DDAF@B@@DBF@DNAB@@DBDABIB@BBDBBH@@JFPC@@FBBD@PGBCB@FABDD@TED@B@BAB@@AJAB@@BD@DHD@B@BNBBBBBBF@@DJ@B@@FD@@HB@@EJ@D@@C@GLEBGB@D@F@F@DA@AFCNEHAHAB@DFFFHADDVFPDH@@ADCFM@@TBD@BABE@EB@@HDBLB@AZDFAFBABAF\\NA@@D@@LDDAACAAIA@CDC@ADOB@DDB@JANEBEGA@GE@EC@ICEBAKCA@@GJIDMBABAF@F@@CBAB@@DB@BIBBFIC@CA@@@GAAA@A@I@BE@@@A@A@A@MJAAMA@BCD@@@B@@IB@BDF@D@AA@ACKAA@AHBH@CSAC@A@CD@DG@A@GBIAEACMAE@BE@@eE@D@@@EF@DB@@BGDGBAFCDB@ABCBADC@CBA@A@@@@@AAA@A@AAC@G@@@A@@AA@EACBE@EACBCBEHMCAE@EAC@C@C@IMC@CE@CIAAAACBA@@ECBC@CC@@CDAF@@EBABAB@@A@CA@@A@E@@@EC@@DCB@ECBBDA@@EA@C@AD@@@AA@@BE@@EGB@BA@@AACEBAGCBW@E@AAA@CCKDABBF@BCBA@EBBBABABE@E@EGCE]BGA@ACAD@BBLAHAFAB@AA@AHCHE"],encodeOffsets:[[118658,39443]]}},{type:"Feature",id:"130629",properties:{name:"容城县",cp:[115.861657,39.042784],childNum:1},geometry:{type:"Polygon",coordinates:["@@AaAAA@ABBLOBAB@HKDCBCF@FIHEF@FBHFFFHBJJ@@D@B@DCBA@CJA@C@CHE@]@@CAAA@A@CBE@CA@ECACBDJCBGBMBCAACEBE@KCC@@D@DFA@BFRABMH@H@@CBIBEBC@@@IAWAEEEEA@CGAEAA@@IMIKKEA@OEGAQC@@@@AAE@A@GCA@A@CAA@QGEAICaQ@@SKIEICWI_BBP@JLRBDDJHHBDKJEDIHAFCJ@F@B@B@@BL@B@D@LBDBF@B@JAL@@@B@@@@AJALCTAF@BAN@D@@@F@BABAJ@@MJTRBDHJPDR@JBLBPBB@@@B@VBJ@LBPBLAF@TDD@@@@@dFB@B@D@RHFBBBFBD@FBBBHCBCCC@AMICC@E@A@EBEBEBIBCDEVGR@H@J@@@NF@@@@LDPFDJPEN@DAHA@@HAD@BBB@D@BH@@H@B@BBBAFFFAAE@AB@DABEHABAJCF@N@F@B@F@H@DCBADAJCFCDCBC@A@AA@@AMBFCAACEG@@ACEDCBA@A@A@ABGBC@@BE@BDB@EBALB@@B@BCBA@ABA@E@@@G@ABA@CBABAFAJCICBCB@B@B@F@B@D@B@BABC@@AAEC@A@@@A@@@@@@@@@ABA@AF@B@H@D@@CF@AC@C@AI@ACGBG@MDACCO@AA@AAAC@E@ADABA@AD@@C@@E@@CBE@EGBA@AGCEJADAEGC@@GA@@AAAUGE@GB"],encodeOffsets:[[118811,39906]]}},{type:"Feature",id:"130630",properties:{name:"涞源县",cp:[114.694283,39.360247],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@AAA@@A@@AAACA@A@A@@@A@@AABAAAA@@@AAA@@@A@@@@@A@@@ACA@@@A@@@A@@AC@@@A@A@AA@@@CC@@@@@AAA@@@A@AA@AA@@AAA@@ACC@@EAA@@AA@@@A@AB@@@@ABCBA@A@@BCBADCB@@@BBD@B@@@B@@AAAB@@@@BBBB@@@B@@C@AB@@@BAB@@A@A@CBC@AB@@ABA@C@A@A@ABA@CB@@A@ABA@@@A@@AA@C@CAA@A@@A@BA@@BADAB@DABABABAB@@ABAB@BA@ABCBABC@IBG@E@UBIBaHUDCBAA@@A@A@A@ABCB@@@@A@A@@ACB@@@B@@DB@@BFB@BB@B@@@@BBBBBB@BBB@B@@BBB@@BBD@@B@@BBDA@@@@B@@@BBB@B@@@@BDB@@B@@BBDD@@BBB@@B@BBDA@@B@B@@@B@B@B@B@@@@AB@B@@BD@BCF@B@B@DABCFA@EDDNJPBBBDHNLTRRPCJAL@PCHANDBBBAB@@AB@B@BBB@@DB@B@D@B@B@DAF@@@B@@DBB@DBD@D@@AD@@@B@@BB@@@B@B@B@@DDB@@@F@BB@@B@BAD@BAB@B@@AHC@@B@@@B@@BBBABBB@@BBB@B@@@BB@@BDBD@BBD@@@B@BAB@@@@@B@DABBB@@@@BB@BBB@@@B@BB@@BBD@@AB@DAB@@A@@@A@@BABA@CDA@@D@@A@C@CBA@A@AAAA@@A@@@A@CD@@@@@BA@AB@@A@A@A@A@A@@BA@@B@@CAAAG@A@AB@@C@A@@@AB@@A@ABGBABA@CBAB@B@@@BB@@@@@@BA@@@@BA@ABA@A@ABA@@BA@A@BDBB@@BBD@BBB@DBD@B@@@@BB@BFBB@@@@B@BB@@BB@BD@@B@BDBBB@B@BAB@@ABEBC@@@@B@B@@AB@BA@@B@BA@@BA@@@AF@BAB@B@BB@@B@D@BA@@B@B@@@@DDBB@BABB@BBDD@BD@BBAD@B@@BB@@AB@BAD@B@@ABAB@@@B@BB@DB@BBB@F@D@BBBB@@@@@B@B@@@@B@BBBDABBD@BBDAB@BB@@@@@DBD@@AB@@@BBBD@@BB@B@BB@@FABAB@B@@@BBDBB@BA@@@BB@@@@B@B@@@B@BBB@B@@@D@@FDBBD@BBB@@@@BA@@BBBDBD@@BBB@D@@BBA@@BA@ABGBC@ABA@ABBDDL@FAHAL@PCLBL@LDPFHHJNPHFJLBBDHLb@B@BB@@B@BBB@@@@@D@BABB@@B@@@BB@AB@DABADA@@FBB@@ABB@DB@@BAB@BBJDB@BAB@BBBBB@@@BBDBBDB@@@DB@@JAB@BB@@B@@B@@@B@@@BBBBB@@@@BBB@@D@B@B@BB@ADB@BD@B@BAF@D@D@B@@AB@B@@@BB@DDDFBBDDBDDD@BBBB@BBD@DBDDBB@@@B@@@F@B@D@B@@DH@BAF@BAB@B@BBBJFBB@B@@ABBBBDB@BD@B@B@D@B@@BB@@BB@BBBBDBBBD@B@BB@sVTL`DhGDYB@BCBA@A@A@@@A@@B@@ABABADCBABABAB@B@B@HAB@B@B@@@B@BA@@@@ACA@@C@@@AB@FAB@FAD@FCB@D@D@@@B@@@B@@BBFBBBDDB@@BB@D@BDF@DBBBFBJL@P@HDFDLHHAJ@`FP@@@FBJD@F@@@FANBPHHLGJGVBHHTBR@PAPGPCDAN@fDVARGPaDAJCBBFDDRJNJFJDRXPLLJFDPLPJRHPJRLHHNDNBNAJBRJFBJHTD`CPCHLJJHRHOHMFKHSDGNMXM@EBA@A@A@@BCAC@@@A@@A@AAE@A@@@@AAACAAAABA@AA@@A@@A@CAA@@@C@C@@AAECCAAACAA@@@A@AA@@AAAACA@@A@C@@@A@AAECCAAA@@AAA@CA@@AA@@A@A@CBA@@@EAC@A@@@AC@A@@@CBAB@@A@@@@@AAA@@@AB@BA@ABAB@BAB@@A@AB@D@B@FAB@BABCB@@A@@@A@@A@@ACCAA@@@@BA@@@@@AC@@@CA@A@AAC@AAAAA@@@@AA@@AAA@A@AAC@AAA@@A@@@A@@@CA@@@A@@A@@BC@@D@@ABABADAB@@@B@B@B@@@BA@@B@BAHABA@@@AB@DA@@B@@C@@B@DADAJAD@B@@ABA@A@@@A@@@A@A@A@AAAA@A@CA@@@A@@A@CC@@@AA@AACA@@CAAAA@@A@@AACAAA@@@@@@@@BABA@@@@@AA@@C@@@ABA@A@@AAA@@AA@@@@A@A@@@C@C@AB@@AB@B@@A@@@@@@AA@AAABABA@A@AAA@@@@B@@@BA@@@AAA@A@@BAD@@AB@@C@@JABADB@@B@@@@@@@BAB@@@BADAB@@@B@@A@AB@@@B@DAFBD@DBB@BABABC@@BA@ABA@@@@D@@@DA@@B@DBB@F@@BB@F@H@D@@@DC@@@A@A@@B@BA@@DBBBB@D@B@B@@@@A@@@CBA@@B@@A@@@A@A@A@@@@@AA@@@C@@@AAAA@A@A@AAA@@@@BC@@@AA@@AAE@@A@C@AC@@AA@AA@EACA@@A@@C@@AAA@A@AA@@@AAAAECCA@C@@@A@G@C@@AA@A@C@A@G@@@AAC@EBC@ABAAA@@@@@AA@@@A@@@A@@AEAE@@@@@ABADCDA@EBA@A@@AA@@@@@@DC@AFCB@@A@@AC@@@C@@BA@ABADABA@@@A@A@@CCCCAA@@AAA@AA@A@@@A@AACAACCA@C@@ACA@AA@@@@C@A@A@@AAAA@A@ABA@AAA@@B@BABAB@BA@AAABA@C@ABA@@AA@A@ABA@A@@DA@A@@AA@@ECCEAAOEAAAACAC@AACAA@C@A@AA@@A@CAA@C@G@@AE@MIIIQQMKIGIGKGODIHKHIFMHE@E@MKGIEIK[@@GIKIGIIEOCMEIIACAG@KBMDQPS@@B@B@D@@@BAB@BA@@B@B@BAB@@@@@@C@@BA@AA@AAAAAA@A@AAA@ACA@A@@@@BC@AB@AA@A@@@A@@B@BAA@BA@A@@@A@@BABADCDCB@DAB@DCDAB@H@BAB@D@JAFA@@@A@A@AA@CAA@@AAA@AA@AAAC@A@@@A@AAA@AB@@@A@AAICAAEAC@@@AAAAA@EA@AA@A@@@A@@ACEACCC@A@@@A@@BAAC@C@AA@@AA@B@@A@A@@AA@A@A@@AA@@@@BADC@AB@DAB@HAB@BAB@BADEBA@@@A@@@AFCDCB@B@BABABAB@@A@C@AAA@@@ABA@AB@ACBAB@@A@@AA@@@A@@A@A@@@@@@@A@A@A@@@A@A@A@@@@@@AA@@@A@@@AA@@A@@BAAAB@@A@@AAB@A@@A@@@AA@@A@@A@@A@@AG@CAO@K@EBcHSFQDOFOBKBK@K@A@C@G@KDABEBEBMJIHILCDCDMLIFKFUDUBSBI@IBSAM@G@IAA@QCMASMAAGGGGGEECMGMEKCUE@@KEKGUUISAIASAIGEGEKASAC@IBUAA@A@A@A@@B@@@B@@A@@@AB@@@@A@@BA@A@A@A@@@AA@A@@BABABA@AA@A@"],encodeOffsets:[[117253,39999]]}},{type:"Feature",id:"130631",properties:{name:"望都县",cp:[115.155128,38.695842],childNum:1},geometry:{type:"Polygon",coordinates:["@@MAA@G@C@@@M@SDDP@D@TW@ABA@@@UDADER@@EPAX@D@DAJDJBD@F@B@BAN@@@@@HENMHED@@CDA@@@OLEBGD@@MHKHAB@@@@ABCJ@BADAD@BENCBG@Q@G@S@GBCIAE@CACAKACCGAA@@@@KGA@@@@@CCKGA@GAGAG@C@A@A@A@CBCFGHGBGCC@CCEAEAM@UBKBCBA@KDIDED@BD@BDAB@@BF@D@@@BFDDFLFBL@JAFEAE@@R@DND@@@BB@@D@D@Z@\\A@@@AA@@WAOA@D@L@@G@E@@B@F@BB@@@@BB@DB@@@@FDF@BDALB@@B@FAD@BC@C@C@@@CB@HEB@@@@@@ADBDBJ@@ABA@FB@F@FHAH@B@B@F@D@B@@@N@@BE@@FFFP@@HBBFBBB@@BH@BEDCF@@@F@D@@AHA@AJ@B@F@@QL@H@H@DBB@AB@DB@@BBB@B@B@DAD@D@FAJ@@@@@DABBB@@@B@@BB@@B@@@@@BDA@@D@@@@@DKA@@A@AFCHBAM@CF@BA@C@E@E@EB@AC@@FCB@BAH@B@D@B@BBBBB@DBDCHAL@DA@CDCH@B@N@N@R@D@BFB@@@L@F@@@B@H@D@B@B@F@@BJ@@B@DL@FIBE@@@A@@@A\\G@G@AAA@@@A@@BC@@FEB@@@HCFCB@FA@@FAH@D@BDD@@MFQ@EBA@K@A@E@ACA@I@EBAD@L@@@@@@CB@BAJBF@JB@B@V@B@DBNBFF@H@@@FGFI@A@A@CBS@AGAI@GEKEBCHC@Q@C@@@G@@AG@CAK@@@@@ABKHG@@@@@@LEB@JCJ@F@NGDO@A@A@A@E@C@ADG@A@APY@@@@BCFGDEBE@@AA@GEECCGG@A@EB@DCFEBC@G@CC@@KBGBAB@@EBCGCGEAA@@@C@@AEBC@GDKBCDGBKCMQG@@"],encodeOffsets:[[118062,39435]]}},{type:"Feature",id:"130632",properties:{name:"安新县",cp:[115.935603,38.935369],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CCACI@KKED@AA@A@A@@AC@AFIBA@@JDABB@D@BABFDB@BDBFFD@D@B@@AD@BBDF@@@JA@CF@@HHA@A@E@@@A@AKBG@AA@@@A^C\\CBBDHC@AEAACBBF@@@F@BOB@DC@WDI@EB@BHHAFBF@BBBBBCHCCC@DLBFFFB@B@@KBADBBB`V@@DAHAB@BDBBDH@BDB@BBDABIBBDEBE@@LV@BX@D@DD@B@FBB@DABADBF@B@H@DD@@B@DAFFBAB@FBBAB@D@B@B@B@HF@BDBD@@A@@JH@B@BFCB@FA@F@BDBFFFBDH@BAFAB@BB@BBCFABCBEAE@A@@BDL@FCHABCA@BBP@F@B@B@BB@@@@BA@@BGFCFABIBCB@B@BD@BHA@@BJVB@DCJNHFDDJHJJFR`AXJJDJFTL@@bRJDFBRHB@DBB@B@HDB@F@BB@@@@RDHBPFB@LFJLJN@@BBBFDHB@FFFFXBJB@@D@FAJADA@@@GNGBAEQ@AEB@C@CD@LDF@FABDDBNAHADACIDADB@FDBF@DAB@B@BB@D^@F@DGD@B@DIB@DA@C@A@CI@AIEGEEAG@EFEJG@EDEDALC@GBAPAAKBAB@BBBbHAB]FGB@DCGCFE@@FBBB@AAADCBA@CRC@C@@DCD@FAD@FDFBHSFBF@DC@ABCHADBJFBB@DA@GAA@ABBFJDHDB@DEDAHDDBH@@AD@@BD@@AB@B@B@@FD@@FH@@B@BB@B@BIA@AADGB@CEAA@AB@DDBGBC@AA@AAMCKCDI@AS@KBA@U@ICCAEA@CA@KCGASAMGEBGEEEBAJED@EGBA@ACGA@B@LED@@E@ECQ@ACIEKCGACAEGUHGNKACCCEG@@A@C@QDC@EAA@AAGCCAOMCEMEDA@E@CGBAA@CBGE@EEBADCBAEAAAC@ECEEBCA@@@A@CBCIGEA@GB@C@@BACEAACAKBMFIBA@CCA@CP@BCD@DJBBBBDCBKC@@@CB@[MBEBAEAEBYC@BKACA@GA@@FAFABC@SA@@ENCD@BG@OCUECCGBEECEA@GBGBMFED@BCBE@E@C@A@AHKF@H@DC@I@@FA@@GC@@EA@I@@CE@AAAAAAAMA@C@CGC@@AA@IB@BA@ABA@C@SFC@ACEBA@ADOHC@AA@ED@EO@IG@AAAC@AJABAAC@CA@MB@CAE@CA@E@CB@CEB@@AAACCECBCBCBCEGBABCBGAGBAEAB@DA@@@@A@A@@AKAB@DA@@CA@A@@DA@AACB@@AB@@@@ID@CCB@DEBEBABB@BH@F@FBD@BD@@ABAD@BB@FBD@BLAB@@BBF@H@@I@BFA@C@C@CAAAAEIBCAAGA@"],encodeOffsets:[[118446,39686]]}},{type:"Feature",id:"130634",properties:{name:"曲阳县",cp:[114.745008,38.622248],childNum:1},geometry:{type:"Polygon",coordinates:["@@REFMDI@@@ABA@@@@BEBA@ALKB@LAH@TDJBB@JNJEFAPGLED@VIHEDEGEICEGBAFIBCAMCBCCAAAC@G@AJCFANC@@GMKO@@IEC@A@AEM@@EC@AA@CBADAJABCAAGB@@AGBEAI@A@@@@A@CED@@CAEA@E@AAIBA@@A@CAIA@@EKAAGA@C@@IAEG@E@G@G@AE@CCAI@E@AA@EDCB@D@BA@I@AAAC@@A@CEDKBKBOBIBEBS@C@MAIAICSI@@@@C@OEA@KAC@CBQJAXK@K@@YCAWIQIOKABAFGLILCDMPADAHEFMLGBEBCDCDILGJAFBH@BEDABC@CD@@AFCHEFADCJEFGFCDADAFCHCFCDADELAL@DA@QCGABACAEBGCKFMCE@EDEBOKGGCLBDFDJDLFBBLHFJ@D@DEHEHAFCFGH@BEBAJ@J@@@F@@BHIB@@A@ABOF@@EFEFELCBEHIJEB@@@@CDKFA@SHBH@BEDCBI@GAEDIDQHQKMAYJIJKN@JFNDDBBDDBBBF@B@@@BB@DBD@@AB@@@@BBFBH@DAD@F@B@@A@A@AB@@AB@@C@EDED@B@F@FBDD@J@T@VEDAVILCFADBDBADADCLB@FBB@JE@@BAB@B@@@H@BBBBH@H@DCLADDB@BD@D@@LDLDJHFHBJBLB@@J@F@FBFFPBJAH@BADGJGJEJAB@DCH@BBJ@F@@@DCPARCJCHCHINGLAFIRAPN@L@L@XDBLELINBDDFFLBDJXTIXIVIRCLAFATALCLCBDHDJCDCB@DENCBAD@FCDAZEX@PBNBJ@JATCFA@@@@LGDIFKBC@@FQ@G@G@@BC@G@C@AAG@E@@EAACBMBGFIDAFCFBDDFJDBFA@@AA@@A@@@BA@A@A@AAABABA@C@A@A@AB@@C@A@A@C@@CC@@@AFE@A@A@@BAB@FADCFIFKFI@@EGMCA@CAA@A@@B@B@B@B@BAB@BCAECEC@EIEI@ACCI@EHGDEHGDALCD@JFHCB@HCDABCGACCDIBGGA@A@@@EBAAA@AA@EBCAAIBKBCBAFE@C@@AEBGBEJED@HADABCDCFCFAF@HADAHKBE@CD@LGJCBCBABEDO@@FED@@@@ABA@MGEGA@@@ANA"],encodeOffsets:[[117549,39594]]}},{type:"Feature",id:"130633",properties:{name:"易县",cp:[115.497457,39.349393],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@GKEIIKAAC@MC@@A@@@C@QBI@E@AAG@ABAB@@AA@@A@ABAB@BA@A@AB@@@BA@@B@@@@@@@@A@@B@BC@@@@B@@B@@B@@CB@B@D@@@B@B@@BD@@@@@BC@A@CBAB@@A@@@AA@@@A@@@AA@@@C@CAA@@@@CA@A@A@AB@@AAA@@@AA@AA@@@A@CBA@A@C@@@@@A@A@A@@@A@A@A@AAA@A@A@A@AA@@A@C@AA@AA@@AA@@@A@@AA@@@@ABAA@@@A@AAA@ABC@A@A@A@@B@@@BB@BB@@@BA@A@@@AAC@C@@@@@@B@D@@AB@@@@EB@@@BA@@D@@B@B@@B@@B@B@@BB@@BA@@B@B@@@B@B@B@@BB@@@@AB@B@@@BA@CA@@AAC@AAA@A@ABAB@@CDABA@@@@B@@AB@BABAB@B@B@@@@@@@BB@BBBB@BB@@@@BA@@B@@@@AB@@A@@BA@@@CD@@@BA@@@A@A@@@@@@B@@@@@B@@@BB@@BA@@BAD@@ADABCD@@@B@@@@@B@@A@@BA@A@@BA@@@@BA@@@A@AB@AAB@@@@A@@A@@@@AA@@@@@A@@@@AA@AA@@@@@AA@@A@A@A@A@A@@@A@@@@@@AAB@@AAAB@AA@@@CDAB@@@@CDABABA@@@@B@@@@ABE@@@AB@@@DABBB@@BBABA@@B@@AA@B@AAB@A@@A@@@@@AAA@@@A@ABCA@AA@AAAA@@A@A@AB@@@AA@A@A@@@AA@@A@A@@@@@@@@B@@A@A@@A@@AAAA@@AA@@AAA@A@@BA@@B@BA@@@@D@@@@A@A@@BA@@B@@A@A@A@C@@@A@@B[ESAcMAB@@EBA@@@@@AA@@A@CAA@A@AA@@A@A@@@AA@BA@ABBDA@@BAB@B@@BB@B@D@BA@ABABABA@A@CDED@B@@@B@@ABCFABA@ABA@GBA@CBA@@BCDAB@@@@BB@@@B@BBB@@@B@BA@B@@BB@@B@DBDAB@@@B@@@BDDBDDF@BB@@@B@B@@BFBB@BBBB@@D@FBBBJDBBB@@@A@@BBB@B@B@@@BBDBBB@@BBB@BB@DBB@@B@B@B@@EBIBC@A@ABG@A@CBCDA@CBA@CDCDABAB@@@B@@@BABB@ABA@@@@B@@@BBBA@@BAD@@@@@BDB@BBB@B@BBBBBBBB@@BAB@@@D@@@@A@ABA@A@@@ABA@AB@@C@A@A@@@OTCRAN@LBHBDJJNFPDJFHJLJHJ@@L\\FJHJNLF@F@NGJELGJGPCLHJHJHNLRRJJNJF@@BH@D@B@DBB@@@BBB@D@B@DBBBD@DBBBBBPFBBDFFD@@BB@@@BCB@@@BAB@B@BBB@@AB@B@DABBB@BABA@ABABA@@@BB@BAB@B@BBBBB@@@B@B@D@@B@@BDB@BD@B@DDBBBD@B@B@@@BBBB@BB@@BBDDDD@@@B@B@@ABCBAB@BAB@@@D@@BD@@@BA@ED@BCD@@@@@@BB@@@BAB@FCBCDAB@B@@@@BFBF@@@B@@@B@@BB@@@@B@BBBAD@FAD@BB@@H@B@D@B@B@@BD@H@B@@@D@B@DDBFBB@B@@BBB@B@BB@@@DB@@@DBFBB@@BBB@@BDD@B@@@BF@BB@@B@@AD@@@@BB@B@B@BBBBB@@D@@@B@@B@@@@@B@B@B@@@BA@@@AB@D@@@B@@A@A@C@A@AACA@@ABA@@@@B@B@@CD@@C@G@E@A@@AE@A@CAA@@@CB@@C@@@@@AB@BAB@@ADABABA@CAC@EACBA@@@A@@B@BA@@@A@CBAB@@A@AB@@@@@@A@@@CAABIB@@@DA@@BC@AB@@@BBB@B@@AB@@A@@@@@BB@B@BABABBB@BBB@@@@@@@BA@A@@BA@@B@D@D@@@B@B@@B@@BB@BB@@@BAB@B@@@DB@@B@@@@ABAB@@@@@@@@BBDBBB@@@BB@BBDB@@DBBBB@@B@@DDB@@@@B@@DBB@B@BB@B@B@B@B@@@BDBBBB@PFbBFBDAD@DAD@BAB@B@D@B@B@B@DBBAD@@@D@DBF@H@F@B@@EDC@AB@@@D@DBB@B@B@@@D@BAB@BBB@@BB@@BB@F@@@BB@DB@@DBBB@ADB@D@B@FFBB@B@B@BABEDCBA@ABGF@@E@@@ABBB@@DDBB@B@@@B@F@@@B@@@BABA@CBA@AA@@A@ABA@AA@@A@GAA@@AA@ABA@@DABCB@@AB@B@@AB@@@@@B@@@@DBB@BDBBDDBDBDB@BB@B@@@D@@B@BADABADABABA@@@@BAB@@A@@@AB@B@D@@@D@@@F@@@B@BABAB@DA@@HCDCDAB@B@B@JBB@B@B@BCB@B@@@DA@A@A@ABABCB@B@@AN@FB@@B@@BBA@@BABAB@B@B@D@BABAB@DAB@B@BA@@B@@@BBB@B@D@JCDAB@@@DAFAHCDADABA@A@EAC@A@@BA@A@A@@@AD@D@HCB@D@DD@BDD@@@B@B@@BDBBBDB@DBJB@BBBB@@@BABA@@F@B@BADABAJ@H@D@D@B@H@JADADBB@BAB@B@DA@@@@BB@@@@B@B@@@BAFAB@BADCB@@AB@BADADCB@B@BB@@BA@@B@@@B@D@DBDDBBB@DAB@BBB@BAB@@@D@@@@ABA@ABAB@B@B@@A@@@AA@@A@A@@@A@AB@@@@AB@D@F@B@@@BA@@@@@A@ABABC@@B@BA@@@A@@BA@AAA@@CAC@A@AAAAA@AA@@@CAAAC@@@A@@@ABABA@AB@@AB@B@BADCDCB@F@BB@@BBB@B@@ABABAB@B@BA@@@A@AB@@AD@@A@@@A@A@ABA@ABAB@B@B@@@B@@AB@B@D@D@F@DBB@@@@B@@BB@@BB@D@B@@AB@@B@BBB@B@HD@B@BABBB@@@B@@B@B@BA@@B@B@DB@@FDBBB@@@BB@BB@DBB@B@@@B@BB@@B@B@B@DBBBB@B@@@B@@A@@B@BBB@BA@@DAD@BBDB@B@B@@@@BB@@@BAB@@@BB@BDDB@@@B@@@BBD@BB@@@BBBBA@BB@@BB@@BB@BB@@@ABADA@AB@@@B@D@@B@@D@BB@@BB@@@D@BB@@@BB@@@BBBBF@@@@@B@ABB@DBB@F@HAB@DAB@HCBABADAB@@CBA@@AA@@@CA@@A@@BA@A@@@ABA@@DA@@@@@AB@BAB@BA@@@A@@@A@@@@DA@ABA@@@AB@B@@A@AA@@AA@BEBC@AB@@@@CFEBAB@F@B@@@B@@@B@@A@ADA@AFA@@B@B@BB@@HAB@B@F@@@DBDAPAFCBAJ@DER@PBB@BAFCAGRMNGAGAG@CJKBK@E@G@ABMFIDCFCBALQDCBQBQDIAEAGEE@ABIHGDCJAN@LBJABAJCBAAMACCCC@C@A@C@CEAAMCDC@KH@D@CC@ADQBCLGRGB@@EAIECEE@CD@D@DCFCDADCBABADAB@ACAA@IBAF@@C@AH@@CD@BEBAU@@A@EC@ACQ@G@GAAB@DIBA@@AA@Q@AGA@CEJ@AEG@AA@CJ@@CCCAIACIISKACMIGCQI@AA@@@@@UMKKGI@AA@ACLAAOKBAMDO@EBI@A@C@G@KEIBAAAACCGE@ADOGC@EA@@EAOEKJADCB@@MFKBQ@OESEKIEGEECEIIICCAGBKBUHCBIDUBS@OGAAOIQGWWMAQ@MAMDGBGBMCKAA@OEKCC@CAGAIEKIIGAAGEGEAK@IHQDCBEBCBICIAAECCAYEIAO@ABIFCBABEDEBILIFMD@@KBKGGI"],encodeOffsets:[[117939,40004]]}},{type:"Feature",id:"130635",properties:{name:"蠡县",cp:[115.583854,38.488055],childNum:1},geometry:{type:"Polygon",coordinates:["@@SFKBMHKFCNBFBJBDDH@L@@@@@J@B@F@B@B@DENGLILKHUHOLZ@BBBLIHID@BAF@BKBGDOFSDSFSEC@GB@H@D@N@@DBDDBBBBBJ@NAFCLABENENILDHCH@BML@B@NB@@DA@CF@@QBM@ELABAFCJAJAHCHADNFD@VBB@PB^HFBTDbJPD@@@@@@NDLFHXAN@B@D@@G@@D@DBHNDDB@HF@DB@BBDFBB@DNF@FBB@@FABBBPB@FAB@@AF@BDBFFDHDBH@HBBOB@@BFBBGB@BR@BAD@BB@LBJ@B@@EHB@CF@D@BEBAD@@IF@BA@EBAF@H@BABCHAD@@BLBB@H@PAXMF@VARFRDBALEJKHE@AN@JAVARBXBRBB@D@F@N@DAHCBCRQPQHGBEBAFM@E@C@CGMI]AMAC@@CK@EAA@K@AAI@OAOKBMBMCKG@@@@C@AA@@@@EC@@GGCAAAGIA@CIAAEGCEOKKAOAUIKMACEGGIIICGMKCUDKJWDKDMBIDIDIAKEIAAC@]GCOE@@C@ABC@A@C@@E@@KE@@IAAC@@@A@GECAc@CBC@EAAGAAKB@@@CA@O@GD@FCBC@A@KBI@"],encodeOffsets:[[118406,39268]]}},{type:"Feature",id:"130636",properties:{name:"顺平县",cp:[115.13547,38.837487],childNum:1},geometry:{type:"Polygon",coordinates:["@@@KBAB@@IE@AAC@C@ACC@G@EB@@EBA@EDGD@@A@EF@@AD@@@B@@BB@B@H[H@B@@@B@@AFEJK@@C@AI@@AE@A@A@C@G@A@@@E@K@@@A@AEC@Q@M@M@A@G@CD@DCBK@GBCDCAA@AAAAA@C@A@G@ABA@ED@@BDA@@F@F@F@DABE@@DBNGAED@B@BB@CL@@@@C@@@CB@A@@@@@AA@@AA@@@A@AACB@@@@I@EBC@C@CBA@A@A@@J@B@B@@EAC@BDBJ@F@BCJBJ@BK@A@@D@FBHC@C@A@AAE@C@EBGPA@A@A@@@CCC@AAE@A@CAACEAACA@IA@@A@AAOAC@A@EBED@FBD@BCB@BMJG@MJAB@JABCDKD@B@@DDBDABABABABEDMFAJBB@FGJEFIJGDCJCFCBECACC@E@CEA@EDCFGFGDEBCBADBB@BABD@ABADGBABAD@H@DDDHDB@@HLHHHCH@BBDNHDF@H@FABIH@BICOCOBE@CFAHKBE@GF@@BB@@A@AB@@BB@B@BA@EBEFCFADC@@B@B@BA@@@AB@BA@AB@@A@@@A@A@@@ABAB@@ABCB@BA@@B@@CBA@@@@@@BAB@@@@ABBB@@@B@@@BB@@@@@B@BB@@B@@BB@@B@@D@D@BBBA@@DB@@@@B@@ABB@@BABA@@BAB@@@B@@@BA@@D@B@@@B@B@B@@@BDFB@BB@@B@B@B@@B@@BDB@@D@@BB@AB@@B@@@@B@B@@@B@BB@BB@@@B@@BB@@B@@B@B@@BB@@@@BB@@ABB@@B@@BD@@B@BB@@@@BB@@@B@B@@B@BB@@@BB@@@@B@B@@B@@@@BB@@B@@@@@B@@@B@@A@@B@B@@@BC@@@AB@@A@@@AB@@AB@A@B@@ABB@@@AB@@@BB@@@@B@@@@ABA@@@@@AB@@@@A@@@@@A@@@AB@@@@@BA@B@ABA@@@AB@@@@@@ABA@@@@@A@@BA@@B@@@B@B@BA@B@AB@B@@@B@@@@@B@@ABAB@@A@@@@@ABA@A@@@A@@@A@A@@@A@@BAA@@A@@@A@A@@B@@ABABA@ABAHCPFAL@P@DBH@@BB@@@@BB@@@BB@@B@@@@BBA@BB@@@BABB@AB@@@BB@@B@@@B@@B@@@@B@B@B@@@B@B@B@@@@@@@B@B@@@@B@@BB@@BB@@B@B@@@BBB@B@DBB@@@BB@@@@B@FA@@BAdNTB\\F@AB@@@D@B@B@B@@@@AB@@AB@B@@@@@@C@@B@@A@AB@@A@@@A@@AA@@AA@@B@@A@@@AA@@@@A@@@A@@@A@A@@AA@@A@@@@@AAAAAA@@@@@A@@@A@@BA@A@@B@@@B@@A@@B@BB@@@A@A@@@@@A@@@@@A@A@AA@@@A@@A@@@@BA@@@@@C@A@@BA@@@@BAB@@@@A@@@@B@B@@@B@BAB@@AB@@@@@@@BA@ABAB@@ABA@@AA@A@@@A@AA@@A@@@A@@AAB@@@AA@A@@AA@@@@A@@AAA@@BA@@AAA@@@@@@AA@@@@A@@@@@@AA@@@@A@AA@@@A@@@@BAA@BA@@AAB@@A@ABA@@@A@A@C@A@AB@@A@@B@@@@@@A@@A@@A@@@@A@@AA@@@@AB@@A@A@@@AB@@A@AB@@@B@@ABB@@B@@@B@B@@@B@B@B@BB@ABAB@B@@@D@B@@B@@@@B@@@@@@BBAD@@A@@DA@@B@BC@@@AB@@@AA@A@@B@@@BA@@B@@@B@BAB@@@@AB@AAB@@A@@@AFA@@@@BA@@@AB@@AB@B@@@@A@@@@@AB@BAB@@BBAD@@BB@B@@@B@B@D@@@B@@A@@BA@@@ABA@@@@@@@@DB@@@B@B@@B@BBB@@@B@@BDB@@@@@@FA@@BA@@B@@BB@B@BBBBB@@@@@BA@@@AB@@@BA@@B@DAB@@@BA@@@@@A@@BAB@@A@A@@B@BABAB@F@@ABBB@B@B@D@@@@A@@AABA@@DB@@DB@@B@B@BBB@@@BADBB@@BB@B@B@D@B@BBB@@@D@BA@A@@@@BBBB@@DBBBB@BBB@D@BA@@@@@@@AB@B@@@B@@@@AB@B@B@BA@@BADAB@B@@@@A@@B@@@@@B@@AB@BABABAD@BC@@B@B@@BB@B@BAB@@@B@BB@AB@B@FBBAB@@@@@@B@@@@D@BBB@@BB@@@AD@@@BBB@BB@BDB@@@DAB@D@B@L@F@D@@A@@AA@AB@B@@@@@DA@@BB@@BBFB@@@A@@B@@B@@@@B@@ABA@BBAD@@@D@@@B@@A@@D@@A@@@@DA@AB@@@B@DA@A@@BB@@B@AAB@BCAE@A@ABAFMBA@AB@BC@A@A@ABAAG@@CCAAECI@@CAC@A@ABALOHG@CEG@ADAHAFBDAHAF@F@DCBADED@D@FDDDDAB@@ECI@AAA@IHGFM@AB@@CDCBCFAHAFAD@DADEB@@AB@@ADAF@J@D@F@BXH@F@@B@B@@@@DBDDBDBBDB@@@@@@B@D@@@@@@@B@D@@@HCB@DCFGDCBC@C@A@AC@GKAABEBABOMMCB@EMGGAE@K@EE@@AAECEEAAAAAA@OFEFAFIFCBK@ACKFEBE@CBAF@@E@A@CF@AGC@QB@C@AGBEACAAGAIACCK@A@KCAC@@@EMDABA@ID@DBDCCMCC"],encodeOffsets:[[118004,39688]]}},{type:"Feature",id:"130637",properties:{name:"博野县",cp:[115.46438,38.457364],childNum:1},geometry:{type:"Polygon",coordinates:["@@BE@MAIAAAACCCA@@@M@C@GHAD@TFTETCPEHCLA@ABE@AJCJGAKAAY@PKVGLGJKHKFM@C@A@A@E@A@I@@@@@KCGACAIAEDMLENGLATEJ@LAB@D@DA@EHC@EBGBCACC@E@@EAAMD]DCB@DEBA@@@A@@EA@EBCAAAA@EBAA@CE@A@@FC@CBGBC@A@BB@H@BIBABKFA@@C@ACB@BC@A@@C@AD@B@@S@C@@@@AAUAA@@BA@@FADC@GBBDA@A@CAA@AAAMC@@G@EAAC@K@ADA@A@BE@AC@G@Q@E@EBAB@@@@F@@B@BCAADBJFBAF@@E@AB@H@DBHAD@BBFBH@J@D@@ABE@MBKBBDC@GB@@AA@@@MD@@ACK@I@@AC@@ECK@AA@@AB@BCBE@CEGBABF\\LA@DFFDBF@B@BDBFBN@DA@IBA@CH@HDRB@BPBF@@FA@B@LXA@J[D@B]DMB@BKBBFC@GBA@@@C@AAAIDC@GAAMEG@A@GAC@ADCBQFCHKNMDE@E@IDBPA@@@CR@@@@@@CVCNAJAH@DIPIH@BCBEFEFINEN@J@F@@@JJBBF@DABADADCBA@CBADCDCFKDA@E@AAUAEFE@@@AAC@A@C@A@@@@@@@I@@@AAABCN@JT@B@BB@@@D@BB@B@BB@@@BB@B@BAF@@BDBHHF@D@DDDHBBJBLABDTA@@HAFABJB@V@BC@@D@H@FBANJ@H@JAB@@CDDHAAGFA@IBAF@@FH@DA@@@EBED@JAF@FBDHB@DBN@AE@CD@L@B@BN@FDBN@BCDGBGBIDIBEBAFKN@RA@@DEB@@CA@@M@ANK@ADGCGJKFMFMBADK"],encodeOffsets:[[118299,39412]]}},{type:"Feature",id:"130638",properties:{name:"雄县",cp:[116.10865,38.99455],childNum:1},geometry:{type:"Polygon",coordinates:["@@CFA@GCICAEBAB@HBB@@CAAIECAGBAD@BCDE@EAGTEAECC@EBC@CD@@@DQD@DABCDBB@BAAEA@@EFHDCDA@EHA^F@VHBB@BB@@HD@FHCBIBDFBHB@HA@FAF@DF@@@@DC@@BABCB@B@FBDBBB@@BDPBDNCH@HABDJ@@B@DBDE@@DC@G@A@E@@BAB@B@@@@@@@@@B@@@BFDBB@@ADABA@C@A@E@A@A@A@ADJDIDEBABAB@DAB@B@H@@@FAB@BABADA@@@KAAB@FCA@AAF@@ADAH@B@B@BABCDDF@BH@DFBBEDNA@BB@@B@BADCDEDID@@BDD@BBH@ADLCBBAPFB@EBAH@F@H@HAFCJABFD@B@B@B@FAB@D@FBFDF@FL@B@B@JAHCH@@@B@@AJAD@JADDFBDHJDDLJLFBBB@LDL@NA@@@@J@B@@@@@PAB@N@L@@@@@HCNARD`I@@dQH@R@TELAFALCB@RCLABI@A@IJGBCFGHGFEB@@CDAFAHBFC@CF@B@B@FALIFGDGBEBAB@DCBEDG@CBE@KACCAACFCAG@G@ABWBCB@PDF@EGCEA@AFE@AGY@A@CCCG@CACJ@H@BAACEBAA@AFA@AMFE@ACCGEKFAAEB@HC@@AED@B@EIFAF@BDHAFDBFDBB@BACEBAB@HHHEDALGCC@ABCFC@@@@A@@A@AAC@AAA@@AAA@@@CB@@A@@CCE@AA@@AAAAAAACAECA@@A@C@@CBABMFABDDKHEBAAAAIM@A@K@UAAECC@AACAEAC@C@E@AAEAECA@IECBC@@ACAAA@A@AGAMCCAE@AFPDBB@B@VC@@HOAAKMAAW@AB@H@@EAAAA@@G@EAA@IAI@EAMFGDOJGBCBCBABCDiLGDADCBABOG@CEAACGCKCOAAJA@A@@A@AG@@EC@@EA@A@A@@BC@@AC@@BG@CAGCCB"],encodeOffsets:[[118882,39866]]}},{type:"Feature",id:"130681",properties:{name:"涿州市",cp:[115.974422,39.485282],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@@AB@@DAB@B@@@B@DCF@B@@BBAD@BAB@BA@@BA@@B@@@B@@@@@D@B@B@B@@@BBBAB@B@@@B@B@F@B@D@D@@@B@@@ACF@@BCBABF@DAD@DAB@@@B@B@B@B@B@B@B@@@B@BA@@B@D@BAB@@@@@@@DDA@@BC@@BD@@DA@CBAAA@AA@@@@@DC@@B@BABB@B@@@@@C@@@@BA@BBH@@DABC@@BC@@@D@A@@DA@@BAD@B@B@B@@B@@@@@@@@@@@B@D@@@@BBB@@BB@@@BB@@@A@D@@BD@@D@D@B@B@@J@H@B@H@@BB@@@@@BBD@@DBF@D@@@B@@@@DAD@@DD@@DF@@@@B@A@@BA@@BABAB@@@@AB@@AB@@A@@@@@@FBBB@EB@@AB@@@@@@@@@@@F@D@@@@@@@@@@B@@@@B@@D@AH@@@B@J@@FHABDBDC@@BB@B@@@CB@@F@B@B@B@@D@H@@@@@@AB@DAAAD@D@@@B@@D@@@FBB@@@FAB@@BB@@@@@@@@@@A@@B@B@AABABBD@@AB@B@@B@@CB@@B@@@D@@@B@@@D@@BA@BBBDA@ABB@@@@BDF@BBF@@@@A@DB@B@@@@C@IB@B@DB@@BAB@@CBBD@B@@@@@@@B@@@@@@@B@@D@@B@D@@@DBB@@@A@@BA@@@@B@@@@@B@B@B@@@@@@@@@B@@AB@B@DA@@@@B@@@B@B@@@B@@@AA@A@@@@@@BA@@@@J@@A@@B@@@@@@EDA@C@@B@BB@C@AB@AEB@@@@AF@@@@E@AJ@@E@@F@DCB@@B@G@@BABEH@@CF@@C@A@@@@@@D@@@@@@@@@@@@@@B@DH@@@@DFA@@B@@D@DB@BDD@@@D@B@BA@@B@@FD@@ABBA@@BCD@B@@@BB@BD@B@DA@@@@D@F@HB@@@@H@@DB@ABA@@@@@@B@@@@@@@@@B@@@@@@@B@@B@@@@B@@@@@@@B@B@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@B@@B@@@@@@B@@@@@@AB@@@@@@@BB@@@@@A@@@C@A@@B@AE@@@A@@@@B@DEF@AG@E@@HB@@D@B@D@@AD@BB@@B@BB@@BD@B@DB@@@@@D@B@@B@@A@BD@B@@@B@@B@@@B@@@DAB@@A@@B@@@@@@@@@B@B@@@B@BBB@@@B@@@@A@@@AB@@@AC@A@@@A@AA@@C@@@@B@@@@@@AAC@@@@@@BA@@D@BB@@D@@@@AB@B@@@@A@A@@@@B@@AB@@@@@@@@@B@B@@@B@@@B@B@@@@@@@D@B@FBF@H@H@@@B@@@@@@@@@H@@@B@@@@@@@B@@@BBB@P@B@D@F@B@D@HBB@F@J@J@@@@B@@@B@@@BB@BAB@@A@B@DAB@@@@@@@@B@B@BCB@BA@A@@@A@A@A@A@@@A@@@@@@B@B@D@D@@@@@@@B@@@@@@@B@@@@@@@@B@@B@@@@@B@@B@@B@@B@@@@@BB@@@B@B@@@B@@@@@@@B@@@@A@@@@@@@@@E@@@@D@D@DA@@D@B@@C@@B@B@BB@@B@D@@@B@B@@@@@@@@@@BBB@@@@@B@@B@BAB@@@B@@@@@BBB@@@@B@@@BBB@B@@@@B@@GB@@BD@@B@D@BB@@B@B@@B@@D@D@BBBBB@B@@DA@@AA@@@AD@DB@@D@@AB@F@B@@@DBD@DBB@@@@@@@B@H@@F@DB@D@@@B@B@DBB@@CB@DBBAB@B@@@@@@C@@D@B@@DF@@E@@D@@D@BB@@E@@@@@@BF@@@EB@@F@@@E@@@FDA@@@E@A@AB@B@B@@@@A@A@@B@@@@A@@@A@@@AB@@A@@@@B@B@B@@@B@B@B@@@BBD@B@B@B@@@B@@@@@@ADEBA@CBCBCBABAB@B@B@FDB@DB@A@@@A@@@AA@@AA@@@@A@@B@D@B@D@@@@A@AB@@@@AB@@A@@@A@AD@B@@@BAAA@AA@@A@@A@AA@@@A@@B@@AB@@@BA@@@@CA@AB@@A@@B@AA@@@ABCBC@C@A@AAC@AAAB@B@AC@A@@@AB@BBBA@@AA@AA@@A@@@@@A@AC@AA@@@A@@@AB@@AB@BA@@@A@@@@A@@AA@@@@A@@G@CB@AIGCIACAAGCEABLEB@DEBI@A@IAABCE@CA@@GYC@@AIG@G@CC@A@MAAEBA@ACE@@KI@@ACCA@ACACA@EA@@I@C@@CEK@AACAA@KCE@AA@CEACCCACCGJAACAAF@@C@AQB@C@E@GBQ@C@A@G@AACAE@A@A@AAA@AAA@AAE@ACECECAAA@AECACAA@C@CBE@C@EAI@CEIQAM@S@CJGBABOB@BAD[BA@@@AB@BA@ABMBGC@@AGQBCAAI@CQB@FEBE@@LM@A@WB@BABC@EGM@@CG@G@A@K@AEA@CBUHA@A@@BABC@IBKDCLE@A@UAEBABKBI@CES@FJK@ID@@E@C@ACE@ABCBE@AEIBQBE@ADC@ABEB@BA@@BE@@CE@EAAHIAK@I@@EA@@@AB@AC@IAMAAA@GA@I@A@@BC@@AGBA@BL@@F@@FHFP@D@EDAHCDCB@BCDADCBADABFBBDALOAAFE@BAC@C@ABAHAD@@ABADEF@@ADCFEHEF@@@FABGJABEJADA@AHEFA@GBA@@DG@BBBD@DB@FAB@@BCHDD@BB@BA@@@@@FBHHADACPAFBBBABA@@FBDDDBFCBCJCD@B@@DABCH@B@DGJ@FBFEF"],encodeOffsets:[[118548,40463]]}},{type:"Feature",id:"130682",properties:{name:"定州市",cp:[114.990392,38.516461],childNum:1},geometry:{type:"Polygon",coordinates:["@@K@ADCD@@@@AB@@CDCJGJA@@@@@O@ICAAAAAA@@BCHE@AFE@@@A@CAGB@D@@K@A@@AAOKAAA@A@CBCBA@AAACAAC@C@BB@DBB@BA@ABC@A@CF@B@@MBQAOC@@OEIG@A@@CE@@ODCB@@CD@@@@DNANAB@BEAIB@BA@CFG@BDGAC@BD@@I@CAC@IA@@QAEFIBGBIBG@EEGG@GI@@E@GBAAA@AA@IC@BSBA@YDABCD@BBF@@@F@@CF@FBHDFHBFHB@HBDBD@DBB@@B@JFRJBJDHD@BF@BJDJBDDBD@JA@@DHFLDL@BA@GAA@EBA@@HABA@AB@D@DC@@HBDBF@JADA@E@@JABAFALBD@DABCFK@GDA@@DBBB@@DA@@D@@BBABG@G@AB@@@B@B@D@DC@G@KLCD@@@@AFIHOHC@ED@@AFC@C@@EA@@HA@GAAAAAA@ADAF@FBDBABBBH@@@HCJCCA@CBGDAFBFGBCAAA@@AK@CB@CO@@AO@AEAI@@H@LGDCBIFBN@DLA@@@@@@FBDB@BBJ@@G@EHAJAB@DEPGJCB@@@D@BD@BB@B@JABC@A@CD@FBBF@J@DB@DBFH@H@F@H@BF@JD@B@BHLB@FB@BJ@D@BB@JABBF@B@BF@DC@DFB@@@@@@BBJAFBH@@HABBADIBCBAB@DBBD@@FN@BFB@D@JF@@LPHN@@MDEBID@B@HBDBBDDDABNADEJABFHJDHFCFGFUJC@KFOHEBIF@@@B@DBDBBBLDB@JBDBB@@@@BBD@B@H@@BJ@B@F@@CHCLANAB@@@BHHE@EFAT@DAHAB@@BB@B@D@@ABABABAHAD@B@@DF@B@@BPDBB@FB@@JXAP@BB@B@B@@R@HDD@B@BC@@@ADCAA@CDGD@BBH@@C@KAGA@A@@ABCFA@@AAGE@AB@AA@AHA@AC@ICAGBA@@N@B@@KBAFARBB@@@B@B@N@@@@HDBH@BBBB@@A@AH@NBFBBD@N@H@BAD@@DB@F@D@AEC@@AAG@@@AACEE@@E@BAGC@@@A@@@ADEDCD@D@B@CAHA@CB@@DD@B@@FHB@@@AFCJCLCB@DALAVAN@FBFBDDD@HDHAHGDEDAB@B@B@D@H@HBHBB@LHDD@@@@B@LH@@@@BBDHBDBLBD@DBFDJHAT@H@R@H@DAFM@ABCBC@ADIBA@@@@BALGNG@@HCFAPK@@B@DC@@FCNGFM@G@@@@BM@A@A@EACCIBI@C@CBWFO@@FQBCVC@@B@BAX@@S@CCOCCGKGIGMAAGGEIAEAABAPQF@BB@@@CBABCB@@A@AA@E@AAE@A@A@A@ACAC@CACCCADABEBCA@ECIAMCSBA@@D@D@CQCKCCcAMBA@BD@@A@CB@A@C@ANEH@@@D@B@@@B@@@@@@@@A@@@@@@@@@@@AB@@@@A@@@@@@@@BA@@@@@A@@@@@A@@B@@A@@BA@A@@DAC@CCAE@GA@EAC@@DC@MA@GGCCIA@CBKC@GBAG@CACCJAFA@GHARADA@@@C@ADAREDMBECE@@EC@@FK@C@ACI@CBAHCD@RG@CCK@A@@DC@@@A@ABAL@FADC@E@CF@BABC@CB@@I@IEAAAA@AAEBAAABA@CACBCAC@E@AG@CHEACD@@C@@ABA@A@AEDA@@EEAG@AAGEGCAC@A@CO@CCBAC@@AAABG@@BA@E@A@A@C@EDAHG@@J@BCBI@BBI@EFA@@FEF@@QAC@KCEBG@@@@@A@KBMBC@MDCBG@ABA@IBAAEBE@EBMBBB@D@JC@@FA@"],encodeOffsets:[[117854,39196]]}},{type:"Feature",id:"130683",properties:{name:"安国市",cp:[115.326646,38.418439],childNum:1},geometry:{type:"Polygon",coordinates:["@@D@BE@EAAG@CACCCGCAAAKAOAE@@@BDADI@@@MCK@EAECCCACBC@CA@@AU@QDIDGBABCD@B@BDP@HABIFC@C@ABCH@H@F@BF@J@ADC@AFBD@F@JC@CAGDKDCEC@@AE@@JA@@DADABE@@D@FCDEBK@AB@B@B@@CD@@@BDL@DQHC@GDAB@DDJ@B@DEL@@FD@@DFAFCNQFCB@B@D@@CBQBGB@HEBIBDDDBH@AB@HLDDAB@DJHD@HNBD@@CD@FBB@@HBFDDD@CB@@@BAB@@@BA@@@@B@@@@@B@@@@AB@@@@@@@@@B@@A@@B@@@@@@@@@@@B@@@@@@A@@@A@C@@@G@MF@B@D@BDAB@@@ACB@NAdBDDDLDRC@C@@@ABDTBNDJ@FDBFABABCDDBD@DBDBDB@B@B@F@BBF@B@@B@BA@ADAB@D@@AAE@ORABBBBFFJHHBBHNHJHLDDTCN@@@D@H@B@NB@@RHDNALCHADCL@HADBF@@@D@@BBHFHDJGDA@IDMBABB@@J@@@@@@@B@D@B@D@BB@@F@FEVBBBF@B@LCDEDCBCDAB@DABCBCBA@CAEIA@I@@@E@IFMJMFEFEDA@AJGJO@CBGBIDMDU@@@@@@DQ@@B@AOJCF@F@NCLMDGREDABCD@HBB@H@NFBB@HCDBJBBD@@@B@HAD@AELA@ANA^C@A\\C@IWB@K@AEB@@AEAOA@CQ@GDGB@JAB@@CAMAEACA@E@CAEE@CKBE[BAHAAGFBHAB@BG@CAAIBCBKDE@EAAAAC@@E@SDEBO@EBIBIDGBCFAAIAGAEA@GA@@MAAEECBE@KHB@CHCAO@@C@AGG@A@CHB@ABQ@@@ABSBBD@F@@@@IBC@AHB@A@C@@@GCCIAM@MAADC@C@ACAGC@ACC@@GK@BQ@A"],encodeOffsets:[[118126,39181]]}},{type:"Feature",id:"130684",properties:{name:"高碑店市",cp:[115.873886,39.326839],childNum:1},geometry:{type:"Polygon",coordinates:["@@EDGBG@E@G@AB@FEABOAAKDBCG@AAC@AC@@CBABCDG@E@A@E@M@E@IDABGBAFCBA@@BBFEBEEABAAA@G@@@AGC@A@AAC@GB@@GBCBM@OFDH@DFPDJBHDJADAF@HAD@BHABHC@ABFh@DF@BB@LAD@DC@BF@D@F@BC@AB@B@B@BBDBDLBN@@@@F@BABCBG@@@CB@B@FI@BDABEBG@BF@D@J@D@DOAA@@A@@@ABA@EAA@AA@GBABEBC@AB@B@@@B@DB@BBC@CCCFAD@@ABA@ABA@AFDBDBBADB@F@BED@@GDCBEB@@AB@@EBG@BFA@EBAAABC@CDA@AD@BABEFDEA@A@CDEABHDAAFA@AD@D@D@DBFBL\\JB@BDGBADH@@F@@E@@FBBABG@@@A@GA@HKA@HC@@@A@@A@CC@@CIBAB@CA@K@@D@@E@A@@CO@IAMB@GA@A@@DA@KBA@@CA@C@@AC@G@@AABCDG@EAAC@AIB@D@B@BCBEAEDA@@BCBAB@FA@@FAB@HC@@B@DABI@BD@BEBA@ABBH@BA@@B@DA@@DJ@@HDDH@ADP@BBBBAHBL@@L@D@@BBD@BCDA@AB@@@@EF@BD@BD@DEDK@@BB@@HBBNBJBD@@BBA@@B@@FJ@L@JBBGFBF@@DF@@AB@@AFABAD@BCF@RAJABFF@DABAF@BDD@F@@@JCL@EIT@DFJ@LABAFAVBB@F@DKLCJAD@BA@AB@B@VGDAB@BFL@B@H@H@@DN@FHD@BA@AXAB@N@@KF@FA@ERA@DBJDBRABH@@HDNABAB@@ABA@@B@\\ABC@APABAHADIT@N@RBFJ@DBJHADB@@DC@A@AD@@A@ACC@ABADA@@BJBCHCBEB@H@DFD@FAH@JBH@D@LABBL@BA@CD@FADBFD@B@FD@F@FADCHGDIFI@AFGBEBE@IBA@C@@AC@MAE@CAEACAE@GAG@E@G@I@IEGIS@CBCBCFK@CCGAEACG@AEBECGCEAACG@ABG@CJIJBB@RI@@J@AIACAE@CAA@G@A@M@E@CAABE@C@GDK@ADGLIDCFEQ@G@cR@@_JQCMBGD@@@@K@M@A@OB@@@@A@I@@@@@MBK@KCA@AAKEKICCGIACCEBC@IBCBI@@@A@@DGBG@I@A@AEKE@ECEAC@A@EBA@A@A@C@AEIB"],encodeOffsets:[[118852,40042]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
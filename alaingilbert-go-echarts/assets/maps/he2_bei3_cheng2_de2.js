@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("承德",{type:"FeatureCollection",features:[{type:"Feature",id:"130802",properties:{name:"双桥区",cp:[117.943466,40.974643],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@aIo@S@W@uBA`LtJXLX@`@\\AJ@@AAA@A@@@AAA@@A@@A@@B@@A@@@A@@@A@@AA@@@@@@BA@@BA@@@@BA@@@@@@@A@@@A@@@@@A@@@A@@@@@A@@B@B@@@@@BA@A@@BA@@B@@A@@B@@A@@@@B@@@@A@@BA@@@@@@@@AA@@@@@@A@@A@A@ABA@@@A@A@@@A@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@B@@@@@@@@@@A@@@@@@B@@@@A@@@@BA@@@@@@@A@@@@A@@A@@@@AA@@@@@@@A@@@@AA@@@@@@@@@AA@@A@A@A@@@AB@@@@@@A@@@@@AAAAA@@@@A@@A@A@@@@@@@@@@A@@@@@A@@@@@A@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@BA@@@@@A@@@@@@@@@AB@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@AA@@@@AB@@A@ABA@@@AB@BA@A@@@AB@@ADA@AB@@@@A@A@A@AB@@A@@@@B@B@@@B@@ABABAD@BAB@@AB@B@BA@@B@B@@@B@@BB@B@@@BA@AB@@@B@@@B@B@B@DB@BBBBBBBB@@@@@B@@@@@B@@@@BB@@BB@@BBB@@@B@B@@@BBFB@@DBB@B@B@B@BB@@@@B@BB@@B@@@@@@@@BAB@@@B@B@B@@@BA@@@BB@@BBB@D@FBDA@@BAD@@AB@FCHCBC@@B@B@@@B@@@B@@AB@@BB@@@BA@@B@@@B@@@@@@@@@@B@BA@@BBB@B@@@B@BA@@BA@@B@@@@BBB@@@B@@B@@B@AB@B@@@B@@@B@BA@@B@B@B@@AB@@@@@B@B@@AB@@@@AB@@@@BB@B@@@B@@@B@@@B@@@BAB@@B@@B@@@@@B@@A@@@@BA@@@@B@@@BA@@B@BA@@B@@AB@@@@@@@B@BA@@BA@@@AB@@@BA@@B@BAB@@AB@BA@@B@@@B@@@B@@B@@BA@@B@@@@@B@@@@@B@@@@@@@@AB@@ABA@A@@B@@ABA@@BAB@@@BAB@@ABABABABA@@BAB@@@@@@@BB@@B@@@B@B@B@@BB@B@B@B@B@@@BA@AB@B@B@@@B@B@@BB@@AB@B@@@@A@@B@@@B@B@@ABB@@BB@@@@B@@@B@@@B@@@B@@AB@BA@@B@@@BA@@BAB@B@@@B@@@B@BB@@B@B@@BB@B@@@B@@BB@@@@A@@@AB@@ABA@AB@BA@AB@@@B@@A@@@A@@@A@A@A@@@@B@@@@@@@@@B@@@B@B@@@@@@@BA@AB@@@BA@@@@B@@@B@@@B@@@@@@@@@B@@@@@@@B@@@B@@@BA@@B@@@BAB@B@@@BAB@@AD@@@BA@@B@@@@@@BBB@@BB@@B@@@@@@@B@@B@@@@@@BB@@@@B@@@@@@@@@@BB@@@@A@@B@@@@A@@@@B@@@@@B@@@@@@AB@@@@A@@@@@@B@@@@A@@@@B@@@@@@@@B@@@@@@BB@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@B@@BA@@@@@@@@@AB@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@B@@BB@@@@B@@@@A@@B@@@B@B@D@B@@@B@@@@@B@@B@@BB@@B@@@@@B@B@@@B@@@@@B@@BB@BBB@@@BB@@B@@@BB@@@@B@@@B@@@B@@@B@B@@AB@@@@A@@B@@@B@@BB@@@@BB@@@BB@@B@@@B@B@@@B@B@@@B@@B@@B@@BB@B@@@@@@B@B@B@B@BB@@B@HEfBL@H@J@nBZ@MxFFLHJHFFBBRND@B@DBHABABAH@FDHOBCDGREPCFATODAHEFEVOHK@AD[VaLQHMHMMQ[UGE[WAA@@@@@A@@BA@@@A@A@@B@JAHCLIJKFCJMFKLM@M@GCIEGFIJC@I@GGOEEEEEEAEACEGZAlICKEOJKJOhMnQISEKKUEKcBciBK@CBYY]cUQCKCOEoO"],encodeOffsets:[[120836,41719]]}},{type:"Feature",id:"130803",properties:{name:"双滦区",cp:[117.799888,40.959236],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AA@A@@@@@A@@@A@A@A@A@@@@@@@AACA@@AAA@A@@@A@@@@@A@@@@@A@A@A@AAA@A@A@A@A@@@A@AA@@A@A@@@@@A@@@AB@@A@A@A@@@A@@@@@@@A@A@EBA@@B@@A@@@A@@@A@@@@@A@@@A@@@A@@@A@@@@@A@@@@@@@@@A@P^@BDBBPETCAG@BHDPMXGFAD@BDDFFHNB@FDBJHFLDDDATGNNV[ASLABCA@ABOC@K@G@KLQGQICAECEAECEAAAA@AACACAECGCC@EBEBGDCBEBEBEDCBI@A@EDABEBADCDABABCDAB@DAB@B@B@B@B@B@B@@@B@B@@@B@B@@@B@@@B@@@@@B@@@B@B@@@BB@BB@@DBB@BBB@B@BBB@BBD@D@D@BB@DBB@BBB@@BBB@@BBBBBB@@BBB@BBB@BBB@@BB@BB@BB@BBBB@@BB@BB@@DBB@BBB@@@BBBB@@@BBBABBB@BAD@B@B@B@D@D@B@D@BBD@DBBBBBBBB@B@B@@@BA@@B@@@BB@B@B@BAB@BA@@BAB@D@BB@BBB@BBBBBBBBDBBBDBDBDAFBDDDFAD@@@B@DDFB@BDBDBFDFBJBFDFDDDBH@FJ@DBD@FADBFBDBBB@BDDB@DBZKCL@FBLBJBB@@AJ@JAPCPAJ@N@F@DDJ@HAH@HCH@LABBH@FDNHHFHDJ@HBH@PEPENEJBLDHNEFAFCH@FAJANBF@HBP@JAJEDCFEFIDGFEIICEEGCIBGFGBCHEDEDEBCBCBIHI@@BA@EBC@C@C@E@C@A@E@ADMBCFEDC@@@A@@@@@@@A@@@@@@@A@@@@@A@@BA@A@@B@@A@@@AAA@@@AA@@@@A@@@A@A@@@A@A@@@AA@@A@@AA@@@@AA@@@A@@@AB@@@@@BA@@@A@A@@@A@@@A@@@A@@A@@A@@@AA@@A@@AA@AAA@@@A@@@@@A@@@A@A@@@@@AA@@AA@@@@A@@@@@A@@@A@C@A@A@@@AB@@@@@@A@@A@@AA@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@BA@@@@@@@@B@@AA@@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@A@@A@@@@A@@@@@@@@@@A@@B@@@@@@A@@@@B@@@@@BA@@@@@@@A@@@@@A@@B@@@@@@AB@@@@@AA@@@@@@@@@@@A@@A@@A@@@@A@@@@A@@@@@@@AA@@AA@AA@@@@@@@AB@@A@@BC@@BA@A@@@ABA@A@@@AB@@A@@@A@@@A@@@@@@@A@@@@@@@@@A@@@A@@@A@@B@@A@@BAB@@A@@@@@@@A@A@@@A@@@@@@@@@A@@B@B@B@@@B@@@B@@@@A@@BAB@@ABAB@BA@@BA@@B@@@@@AA@@@A@@@AAA@@@A@AA@@A@A@@@A@@@ABA@AB@@A@@@AB@@ABA@@@A@@@A@@@A@@@A@@A@@AA@BA@@@A@A@@@AB@@@@@@ABA@@AA@@@A@A@@@A@ABAB@@A@@@A@A@A@AAA@@@A@A@A@@@AA@@A@@@@@@BA@AB@BABABABA@@BA@A@@BA@AB@BA@@@AB@B@BA@@BA@@@@@@@@@A@@@@@A@@@@@AB@@AA@@@@A@@@A@@@AB@@ABA@@BA@A@AB@@A@@BA@@B@@AB@@A@A@@@@@@BA@@@AB@@A@AB@@A@@@A@@B@@A@@B@@@@A@@@@@AA@@@BA@A@@@A@@@A@@@A@@@AAA@@@@BA@@@@BA@@@A@A@@@@BA@@@A@A@AB@@A@A@@@A@@@ABAA@@@@AA@@@A@AA@@@@@AB@@AB@@A@A@@@AAA@AB@@A@A@@@@@@@@A@@@A@@@AB@@A@@AA@@BA@@@A@@@A@A@@@ADGDEDA@@BC@AB@@CBEAC@A@AA@@AA@@B@@A@@@A@A@A@@BA@A@@@@@@A@@@AAA@@@@@AAA@A@A@A@CA@@EAAA@@A@A@@@A@AA@@AA@@AA@@@@@A@@@@@A@@@@AAAAAAAAA@@C@A@A@A@@@A@@BAB@@A@@@AAA@@@A@@@A@AB@@A@ABA@@BA@ABCBABA@@@A@@@A@A@@B@@@BAB@B@B@@@@@BAB@BC@@BA@@B@B@@ABA@@B@BAB@@@BA@@@@BB@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@BA@@@@@@@@B@@@@@B@@A@@@@AMOQACUEA@UGMCMAICQEYDEB@@"],encodeOffsets:[[120626,41787]]}},{type:"Feature",id:"130804",properties:{name:"鹰手营子矿区",cp:[117.659499,40.546361],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@HCJADINKNEHAL@JDPHJPHLBH@J@LERENBHDFHFHJJLHLFH@JEFEHCJCNIHIFCBCFGBG@IBG@GAM@ECIGGEEIEKCOEGAEAOCQAOCICIEGAG@I@IBEBEFEHCDAD"],["@@@@A@@@@@@BA@@BA@@@A@A@A@@@AB@@@@AB@@@B@@@B@@@@@@@B@@B@@B@@@B@@@@@B@BA@@@A@@@A@@B@@A@A@@@B@@B@@@@AB@@@@BBBB@B@BA@@@AB@@@@A@@@@@@@@@A@@@A@ABCBCBAB@@@@@@@@@@A@@@AB@@AB@@AB@@A@@@@@A@A@@@A@A@@@A@A@@@A@@@@@@@@BB@@@@@@B@@B@@B@@@BB@@@@@BB@@@@@@@@@@B@@@B@@@B@@@B@@@@@B@@@@B@@BBBB@@BBDBB@@@B@B@@@@@BB@@@B@@B@@B@@@AB@@@B@B@@@@@B@@@B@BBB@BBBB@@B@@@B@BB@@B@BAB@@@BABAB@BAB@@@BA@@@AB@@@@@@@BA@@@@@@B@@@B@B@@@B@@@B@@A@@@@B@@A@@@AB@@@@@B@@@B@BB@@@@@@@@BB@@B@BA@@B@@@B@B@@B@@@@@@@@A@A@@@@@@@@B@@B@@@@@B@@@@@@@B@@B@@@@@@@@B@@@B@@@@BDBBB@@B@DDAB@@A@@@@BA@A@ABC@CBA@@@@B@@AB@@@BA@@@@B@B@@@B@@@B@@@@@BA@@@AB@B@B@@@B@@@DAB@@@B@BA@@@AB@@@@@B@@@@@@@B@@@B@@@@@B@@@B@B@B@@@@@@BB@@@@@@B@B@@@B@@BB@BBB@@B@@B@@@B@@@@@B@@@@@B@@@B@@@B@@@BB@@B@@BB@@@@@@@B@@AB@@AB@@@B@@@@@B@@@B@@@@BB@@@BBB@B@B@B@@@B@@@BA@@@BB@@@B@B@B@D@B@BAB@@ABAB@DA@@@A@@@@@@BA@@B@@@@@@@@@@B@@@@@B@@B@@B@@@@@AB@@@@@@@@@BAA@@@@@@@BA@@@@@@@@B@@@@@BB@@@@@@@ABB@@@@@@@@@@@@B@BAD@@@@A@@@A@A@@@@@AA@@@@@A@A@A@@@@@A@@B@@A@@@@@@@@A@CA@@A@@B@B@AA@A@@AA@@@@@@@A@@@@@@B@@@BBB@B@@@B@@@@ABA@@@AB@BAB@@AB@@@@@B@@@@@B@@BB@@@B@B@B@B@@@B@B@@@@@B@@@@@B@@A@@B@@@@@@@B@@@B@@@@AB@@@@@@@@@B@@BB@@BB@@@BBB@B@BB@@B@@B@@@@BB@@BB@@@@BBB@@@@B@@@@@@@@@B@@@@@B@@B@@BB@@@@@@@@@@@@@BB@@@B@@@@@@@@@@B@@@@B@@B@@AB@@@B@@@@B@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@AB@@A@@@@@@B@BA@@@@@A@@B@@@@@B@B@B@@@@@B@@@@@@@@@B@@@@B@@@@B@@B@@BA@@B@BA@@@A@@@AAAA@@A@@@@B@@A@@BA@@@A@@@A@@B@B@@@BAB@@@@@B@B@@@B@B@B@@ABA@@BCBA@A@@@A@@A@@A@A@A@A@@B@B@@@@@@@@@B@@@B@@@@AB@@A@A@A@A@A@AB@@A@@BCBA@A@@BA@@@@@@B@@@@BB@B@@@B@@BB@B@B@BAB@B@@A@AB@@A@@@A@@@@@AA@AA@@@@@@@C@@@A@@@@@A@@@@@@@A@@@@BA@@@@@@@@AA@@AA@@@A@@@@AA@@@A@@@@@@@@@@C@AB@@A@A@@@A@CAA@A@C@A@@@AA@@AAA@AA@@@@A@A@A@@A@@@@A@@@@@A@@@AB@@@@A@A@A@A@A@A@@@@@A@@@@@@@AAA@@B@@@@@BA@@BA@ABA@A@AB@@A@A@@@A@A@@@A@@@AA@@@@A@@@ABA@@@@@@A@AAA@AAA@@@AA@@AAAA@AAA@A@AA@A@@@@A@@@@@A@@AA@@@@AA@C@@A@@@@@A@A@A@@@A@@@A@@@@AAA@@@A@@@A@CAAAC@A@@@AA@@AA@AA@@@A@A@@@A@@@A@A@AAA@A@@@AB@@ABA@@@@@A@A@@@AAA@@@A@@@A@@@A@A@@@ABA@@@@@A@@AA@@@CCA@@AAA@@@@@@@@A@@@A@A@C@CA@@A@@@@A@@@@@A@@AA@@@@@A@C@@@A@@A@A@@A@@@@@@@@AAAA@@@@A@@@A@@@A@@BA@A@@@@@A@@@AB@@A@@BA@@@A@@@@@@@@@A@A@A@A@A@@B@@AB@@@@AB@@@@A@ABA@@@@@AB@B@B@B@B@BA@@@AB@@@@@B@@@@@B@@A@@@@@@@A@@@@@@BA@@@ABC@@@A@@@@@@@@B@@@BABA@ABA@@BA@@@A@A@@@A@@@"]],encodeOffsets:[[[120615,41525]],[[120437,41471]]]}},{type:"Feature",id:"130821",properties:{name:"承德县",cp:[118.173824,40.768238],childNum:1},geometry:{type:"Polygon",coordinates:["@@KCKICG@E@KEMKIIGKG]KSCGECAOCIAGAOCKAMAMCICO@KAMCGCCCEE@GDCKAY@MDA@IDIHCFIFC@@@A@@@A@C@@@A@A@@@A@C@C@A@A@CBE@@@A@C@C@@@A@@@A@A@@@A@@@@@A@@@A@A@@@A@A@@@@@@@@B@@AB@@@@ABAB@@ABAB@@AB@@@@@@@@@@@@@BAB@@HBBDFFJFFDDFNHHBFBF@D@@DBB@FBB@@@B@@CBKFA@@@@@AAC@C@@@A@C@AAA@A@A@@@AA@@@@A@@AA@@@@@@A@@@@AA@@A@@AB@@A@@A@@@@AA@@@@AA@A@@@A@@AA@@AAA@@@@A@@AA@AA@@A@A@@B@@A@@@@@A@A@C@@BA@A@A@@@AB@@A@AB@@@@AB@@@@AA@@A@@BA@@BA@@@A@A@@@@@@BA@@@AB@B@@@BA@@@@B@BA@@B@@@BA@ABA@@BA@A@A@@AA@@@@BA@@B@@@BAB@@@@E@QDIFEDEDQDMDY@W@E@UBSAOAMCKCCGAIAK@EGGE@OEKGECGEMECAICGAMCKAK@UAOEIIECICMAIAA@SCM@E@E@MCKAIEGAMJIDGDEFAFFF@DB@B@BB@@@B@@@BA@@@BB@@@BB@@B@@@@@B@@@BA@BB@B@@BB@@@B@B@@@@B@@BB@BB@B@@@B@@AB@@@B@@@B@BAB@@AB@B@@@BBB@@BBB@B@BB@@AB@@BB@@B@@B@@@BBB@B@@@@B@BB@@@@@@@BB@@@@B@@BBDD@@@@@@@@BB@@@BB@@@@BBB@@@@@@@BA@@@A@A@AB@@A@@@@BA@@@@@AA@@@@ABABAD@@@B@B@B@B@@@@BB@@AB@@@B@@@B@B@@@@A@@B@@A@@B@@@B@@@BA@@@@B@@BB@@@@@@A@@@@BA@@@@@@@@@@B@@@B@@AB@@@@@B@@@@@B@@@@@B@B@@AB@@@B@@@B@@@@@@AB@@@@@@AB@@C@AB@@ABAB@@AB@@@B@@AB@@A@@B@@A@@@A@@@@@@@A@@BA@A@A@@@CAA@AAA@@@C@W@UBOEMCKCOGMGIEIIEMGMEEKEEAOCOCMCOC@@MIKIKKCKEMGGEEIECAK@G@KDEDCFEHIBG@QIKGSIECECECGCOEOAMCUCIC@@A@@A@@@A@@@@@@@ABA@@@A@@AA@@@A@AA@@@@@@ABA@A@@@@A@@@AA@@A@A@A@A@@BA@A@@@A@@@@@@@AB@BA@@@@BA@BB@BAB@@AB@@A@A@A@@@A@AAA@@AA@@A@@A@A@A@A@AB@@@@A@@@@@@@A@@A@@AA@@A@@@A@@AAA@@@AA@@A@@@CA@@A@A@@@@AA@@A@@@@AA@@@AA@@A@C@@@A@AAAAAA@@AA@@AACAA@@@@AA@@@@@AA@@AAAAAAAA@@@A@@@@@A@@A@@@@@A@@@@@@@AA@@@BA@@@ABAAOLOLRTBB@@B@@@BBB@@@B@@BBB@@BB@@@@@B@@@B@B@@@BB@@@@@BB@@BB@BBBBB@B@@@@@BB@@@@BB@@@@B@@@@BB@B@@@B@@B@@BB@@@BB@@@@@BB@@@@BB@@B@@@B@@B@@@BB@@B@@@@B@@@@@B@@@@B@@B@BB@@@@BB@@@A@@@@BAB@@AB@@@BA@A@A@A@ABA@@@A@A@A@AAAB@@A@ABAB@BA@@B@@@@A@AB@@AA@B@@A@AB@BA@AB@@@@@@@BAB@BA@ABAB@BAD@@AB@@AB@B@@AB@@@@@@CF@@@B@@BBB@B@B@@@B@@@@@B@@@@@@@B@@@@@BB@@B@@@@@B@@@B@@B@@B@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@A@@@@@AB@@@@A@@@@BA@@@@@@@A@@@@B@@A@@@@BA@@@@@AB@@ABB@@B@@B@@@@@BB@@BB@@@@BBBB@BB@@BB@FFBDBJHP@HBNBFAHEFKD@FAFDH@DAHADAFCDAHCJCFCHCJAL@HDHDHBH@JAJBHDHBBBDNDHF@@NDTA\\@V@`AR@F@F@H@DBJFHHDBJBFDBBBBB@B@@@B@B@BAB@@@B@@@B@@@B@B@@@@@B@@@B@@@B@B@@@B@B@@@BA@B@@B@@@B@@@B@@@B@@AB@B@@AB@@@B@B@@BB@@@@@@AB@B@@@B@@@BAB@BAB@@@@@BB@@@BB@BB@@B@@@@B@@@@B@@BB@BB@@BBB@BBBBB@B@@@@@@A@@@AB@@A@@@@BAB@BAB@@@B@@@BBB@@@B@@@B@BBB@B@B@@@@A@@@AB@@ABAB@@@@@B@@@B@@@B@@A@@@@BA@A@@BABAB@BA@@B@BABA@@B@@@B@@@@AB@@@BABA@@DA@@B@B@@@BA@@BA@AB@BAD@B@@@B@@@BBB@@@B@B@@@@@B@@@@@BA@A@@@@BA@A@@@@BBD@B@B@@BD@B@B@@@@@B@@@B@@B@@@BBB@@BDB@@BB@B@@@B@B@@@B@BB@@BBB@B@@@@@B@@AB@@@B@@AB@@@@@B@@@@@B@@@B@B@F@@@B@@@B@@@@@@@B@@@@@@@@@B@@@@@B@@@B@@@B@@@B@@@@@B@@@B@@@B@@@@AB@FAB@B@@@@@@@B@@@B@B@B@@@BA@@B@@@@@B@B@@@BBB@@@B@B@B@B@B@BBB@B@B@@@@@B@@@@@B@@@B@B@BB@@DBBB@@@@@@B@B@B@B@@@B@@@@@B@B@@BB@@@FAZCRFJDNBNDVHB@VFBDPRBN@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@B@@@@@B@@@@@B@@@@@@@@B@B@@@@B@@B@BBBB@@@@B@@@@@@@BA@@B@B@B@@@BB@@@@@@@@B@@B@@B@@@@@@@B@@B@@B@@@@B@@B@@@@@@@B@@A@@B@@@@@@A@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@B@@@B@B@@@B@BAB@B@@@@B@@@@B@@B@@@@@@B@@AB@@@@@@A@@B@@@@AB@@@@AB@@AB@B@@A@@@@@A@AB@@@@@B@@@B@@@@@B@@@B@@@@@@@B@@A@@B@@AB@@A@@@@B@@BB@@@B@@@B@@@@AB@@@@BB@BB@@B@B@BB@@BI@[@_KWIWKsB_vAX@T@p@bJ@@pPPFLDRDdVZ^AZ@DALdjdAFLLVFLJTmRgNIPILFPDLkJYBFHBDBFFFFFFFHP@H@JIDEJFHDJ@H@NKNELINEDILKJGDIBA@@@@B@B@@AB@@@B@@@@BB\\XHF\\VNRGNGNKRUbC\\@BGLUPEFGFCBSPEBODQFCHADGPECG@ABABGBCAA@C@QMAAEEIGKGEENwY@mAI@G@K@eAGFA@@@AAA@A@A@A@@@@@@BA@@@@BAB@@@B@@@@@B@@@@@@@B@@@@@@@B@@CDEFADCN@B@F@B@D@F@D@DAD@FAB@@GJAJADADCFCFGFADEHAHDJFHDFJJJAJ@R@LANAH@HBJ@F@JAFCFCNCHAF@F@L@DBB@F@N@P@LALAD@LAJ@N@F@@@@@JDNFPHJFFJ@J@FKLEFEFCHAFBFBHDDHH@@FHBBDJBJ@FAHAHAJBL@FCF@@EHEFGFCHCDAF@BBNBH@BBFHJBF@FAFEL@LBHFFDJDFBF@HADAHCHADEJAJANBHHFJBFBHBHBDDBF@FDFBDFFHFHFFBFFHFPFHAFADIBGBK@@AGAK@GBEJGHGPGJELEREP@DATBNBB@LFLJJHFHBFDF@H@DBD@JBHFJJHJFFFJJNJPNLHLJTLZNPFVHTFNBNDNDJBJADFJCRAPINEJCJCHANCLELIFGJGFCFGFG@IAKEOAK@CDGJCHCHCDCBEACEEIGII@G@IBG@I@I@G@IDIJIDCFGJILGHEJEHCJANBHBJIJKJMJKNKJEFEFCDEBC@EKCICGCCIAGCEECEC@CDEJENGPIJGHGBEDGAIAM@I@C@MAI@G@GDIHGHEDGDGFIFGJGJCJEDK@MGGEIICEAA@MEKCYIYISGKMEQ@AGOCICGAIEGCGAGCGCKEGCG@CAC@EAKEKGOIMEEAEBGDELA@@@@B@@@@@VENALCNAHAHCFC@CEEKAK@IBKCICMGCECG@M@G@KAICEMIGECGACCIAK@GBGBEHGBGAGEIAE@CAC@MBCBEFGNCT@NAP@JBNBL@LBNAPDN@NBHBBFBHDJBJALBLBHDBFDHAJAFCF@F@HBFDBFDHJFDAJCFGLKLIHAN@NCP@NBHAFCFI@@BIDMBIDKAGAG@EGOAEGMMQGGCCCGEG@IBG@@FODI@IAMCICGEKMKCAMKGEGEEEIGKMEIEEGIECO@K@O@S@SDQ@G@IACAICEAEAMGC@EAKCOE"],encodeOffsets:[[121228,41666]]}},{type:"Feature",id:"130823",properties:{name:"平泉县",cp:[118.701951,41.018405],childNum:1},geometry:{type:"Polygon",coordinates:["@@AIBKBIHGDE@CDC@@@C@@BA@CAABAAABAAA@CAA@ACEAAAAA@@A@A@@AAAA@@@@ABCACCA@@@@ADAEEAA@@@AA@AAA@A@A@A@A@A@G@C@@@@ABAABAC@CAA@@@@@@AEB@@@@@A@@A@@AABAA@G@C@@@@A@@BG@C@A@C@@@C@ADCCAAECCEAMAUBSAGBG@CCCAAC@EDCFALCLEHGHK@GCKEICEECGCIEKAKEMKEGIMIIEEGCKIMAIBK@M@MAMAGEECAEAG@K@QBK@OAGAECEECCCGCGAGCG@EBCDIAECACCIEGCACAEAC@@B@BA@@@A@@BB@BBBB@B@@EAC@C@EAC@E@C@AB@@A@@AA@A@ABA@ADEAC@ABA@CBABA@EBABC@A@AB@BABA@@@A@A@A@@@C@EAA@CAABC@A@A@A@@BABA@CBA@@@@AAA@@ACCC@@AA@@AA@A@AC@ECCA@AAAA@A@@A@AA@@AAC@AAAAAA@AACCA@@A@@C@A@@A@@@@BC@A@ABA@C@A@@@ACGAA@A@@DCBABA@A@A@@BC@A@ABADA@@B@BA@@@ADCFCBA@@@ARCVCTARANAF@HAD@PEFCFGBC@CEGCCIGECCCAGAECI@EACA@@@G@@@A@@@A@A@CAEAOEBA@@A@@B@@A@CAB@@C@@@@A@ABA@@@B@@@BA@ACAC@@@ABADCAA@C@A@AB@AAA@@C@A@@B@@A@A@@AA@U@C@A@C@GBBF@@ABGBCBC@@@AC@@A@EDC@CBCBA@AD@@AB@@@@@A@@BAC@@A@A@@AAOEEAA@A@@A@C@@AA@@@AAAAC@A@E@@DC@@@A@@@@A@A@A@@@@ABAB@@CAA@@@CAAAAACAA@EAA@@@A@A@ABCB@AA@@AAGBC@C@@AA@@@@AB@@A@ABE@ABCBA@@@E@ABA@@@@@CA@@A@ABA@AB@B@@@@@AA@@AAAAB@@C@AAA@CAAB@@A@A@ABA@A@@ACA@@A@EAA@@CA@AA@BA@A@@@ABA@@AA@A@@@CAA@ACC@@@A@A@@@@AA@@AE@A@@A@CAAACCAA@A@A@@@AA@AA@A@@B@BA@A@@@A@AA@A@AAA@C@AAAA@@EAEC@AA@A@C@@@AA@A@@BA@A@@ACAC@@AAA@@@@A@C@C@A@@ACAAA@CAA@@@@A@A@A@A@@@AAC@A@ABA@C@A@ABA@A@AB@@ABA@C@A@AB@@@B@BB@@B@B@B@B@BBD@BBD@@A@ABA@A@@@AB@DG@@B@B@@@@@BA@AB@B@B@@@DCB@B@B@BC@@BE@A@@@A@@BA@AB@DAD@@@@B@B@@@BDA@@B@BADEDAB@B@BBFBB@B@@BBBDABAF@@A@@BAFA@@@A@@C@A@@@@C@A@A@@@A@@@A@@AAAAAA@@A@AA@@@A@@@A@@@C@@@AAAA@AAA@A@A@@@CC@A@CGAIGGAGAKEEAGAQIOGGECACAMEMAKCIEGEIG@CEEEKIGCCGCMEKAKCA@O@MBGHCHKLCFCDEBCFAFBF@D@FGFMAK@GAGBOHIDOBGBECCGCI@AGCKDGHCNCFGFGDE@KCIIEIEKCEGAICKCEBE@EBMAOIIEIAEAQEYEGACCCCBE@EBGCICECAIAIDEDIFKHKFG@KAK@OFEFGHCFEHHJFFFJLNJHFFHFHFNLDBNLFLDHDJBN@JCJEP@@AH@JFHDHDDHHNRHNBFHP@FBHBHCLAJCNAJ@@EJEDGBMAO@MDM@GBKJKLEHIDCBIECGAEECGAE@E@EDIBGBECCAAGAKBKAICIAGAEGAMAM@OCMBKAK@MAIAO@MBS@MDEHAFAD@NBD@DBFFJBHAHGHAFAH@HBLDJBDDHHFNJDFBJ@L@H@NDHDFNHJDLDJAL@LBFF@DEDGDGBMBKDMBUF@@@@A@@@@@KBCFAHBFFFJNHPFLBL@FBD@DDHFHDLDHBHDHFHBJDHDJHP@BFRLNTHZJZJLDNFB@FBJDFJHH@NCLIFIDIHEHEJCHCHGFGHCJ@H@HBJ@N@D@JBNBJCHAFGHIHOJMHIFCF@DFDFDDFBHDJHDJDLD@FADCFEDEFIFMLILINILIJJFFFBDDD@F@HDHFDB@FBDBJDHBPDNBLBL@D@JDTBL@J@L@PBF@F@JDFBHFHDJ@FBB@HAHCHAJ@B@L@D@B@PDNBH@D@J@JA@@NED@JAP@PCR@PALBJ@JBHBJBPBNAD@BBJAHCLEPMRELEFAHANCNCLCLANBJBD@JBFDHPBNBJBJFDJ@LDJ@NDXHNFHBFBJBDBFBHDFBDBH@D@BABC@CAEGOCEDGBIDIFGJMLKBCDEFGHGDCDABAHENGNCLBL@LBHBD@D@HBL@H@J@FDHDHNHFF@BAJEFEFCJALCJ@N@T@JBJ@JAJAPELCJCVANAF@NAJBJ@P@NANCJBNBB@RDL@LBNAN@JAJBF@D@PALCLCB@LCJEHAJCFCF@F@HDDFBDBP@FAD@FDFJDNFL@H@LD"],encodeOffsets:[[122102,42307]]}},{type:"Feature",id:"130825",properties:{name:"隆化县",cp:[117.738937,41.313791],childNum:1},geometry:{type:"Polygon",coordinates:["@@GCICIEGICEAECEI@G@CBADAH@JBH@LCHGFKFMFMFSHKHIHGL@J@LAJIHOHMDKBUFM@KEGEEIGKEOEKGIIGIGIIIMGKGMEMAGAM@M@IAEEGCGAIAEBGKBKFEDEBMLMJQNKFSPGLMREJCHEHEJADCFALABANBNFLFLJJJJBJCLGFKDKJKFGJGL@BDJBLBHAFCFCBGDMFEFCBCBCFDJBL@JCFGHGHGJAFBNAHGNKHEDIFEDKFABADCDBHCFCDCBGDGBIDGDMBIDKHGDABGLKJGFEDGJGNAHEHAFIBGBOHKHKLEHCHMHMLKDSBM@OASAI@C@QDODQFIDKAICIECCGKECMEOCQEOAM@IBODSHIDMDMHOHMBMDQIKEQCGBCHAFDNBJ@NCREJKFMFOFODSHQDQBQDQHILAHBFJFJ@L@T@RDRDZLPDRNLJ@NALCRAFBDDDHFPNPFHJ@HCH@HQJKFMJIHELILIJGJCFALAHBLDJBHCJAHCHCN@LAJ@L@BBJDJBJBFAHALAHCHEHGJMHKHKFILAHAHCFJAXGTCRC\\GTCVGRIVERAVAPDRBPDJBJ@H@DGBECEAG@MHILCTENAPGVITMLIFIHKJMJCTAHBJBJG@GAGCMBQFEHGNINILAFBBBHBDNALBN@PBNFLBH@P@N@PDHDDHDJBH@FCJ@F@HBDBBHJFFFHDLDHATAJAJAH@HDDDDFFDHFHBHBJ@NDDBFDDDDFHJJJ@@PLJDJB@@LFPFLFHDHBJ@JAPCF@J@NBJ@LDN@RDVBRBVBR@TBN@NAP@NALCLEHKFGJKLIHELGHAFAFEHGBGBGFGFCJAJ@LAJBJ@RBRDNBHBJBJABA@CEIEEAEDGLMNCRCRCNAREHCLGFEHKJCNAPBJBPFJBH@HADCDCFG@KBOBI@I@EDIHIBAHGJCPAP@NAN@P@JBF@RDXB`DJBHDFDBFBFBJJPHBHBL@HEHEJEH@RBNBNDN@JBLAL@LGLELILCLINELCPER@NBJBJDJFPDRBN@NALCNCH@L@H@HBNFFAJALCFCHININCNBL@J@N@LBN@FCDCBCAIGOIOGKESEMAKDODMDIFKFGLAHAN@JBNDDHDFBJ@N@NAHDHDHBFFJLLLFJBJBH@J@JCHAF@D@JHLLBDFHDJHNHELED@HANCJERILIJGHGHIBG@IEOEOBK@ADOFOLKJGJELEFAHABAH@NAH@LAF@FAHEFEBEF@LAL@FDDDFDLADEFBBE@EACGAIK@K@IFGDMFEFIDIBEAEIIGICEGGEIAECEGAGAI@K@ICCC@GDGCEIBIAMCMCMASEUGOEYMSKKIKGOMMIIIEEIEIGEIAG@IAC@C@GCEAEEGIGKIKEA@MASACBO@QFKFIFOHGHIHAF@HBLBH@@ALAHCJEBGBOEGEEEEAGEGEEEACCE@EAECCGAGAEAIAGEAGBMBIFIBCDGBGBC@GAECECIEEAG@KFKBE@EAEGIAE@AAGAM@ABEDCDGHEFEFG@@DE@EAKBIBGBG@EAICIAAEG@@GGCCAGAEBEDGFEFELK@E@IEIIEOGMEIC@@@@E@M@I@KBC@KBKBO@M@E@A@CAK@E@E@GBMDEDEDIBE@I@GAG@MBKBQ@I@IBEFCHEJEFCDIFIBO@GAE@MAIBEBG@EDEBMFGBCBGHMFKBMAMCOIGKAACGEGIGKEOBOBMBWDOBI@KCMAMAMCI@C@I@OFEHEHGFCBKBOBO@"],encodeOffsets:[[120407,42191]]}},{type:"Feature",id:"130822",properties:{name:"兴隆县",cp:[117.500558,40.417358],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@PFHDFDFDFDTJLHRJH@JAFGDEFCDABABA@@BABABADABADABA@ADCBADADAFADABADABADC@C@A@C@AD@DAF@D@DA@ADABABABCBABADAD@D@D@DBD@BBDBD@B@BABA@@@A@A@AA@@A@AB@B@@@B@BA@A@@@AA@@AA@AAA@@A@@AA@CAA@A@C@AAA@A@EA@@@@@@A@@A@@@@@@@@@A@@@@@A@A@A@@@@@A@@@@B@@@@ABA@@@@@@@@BA@@BA@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@A@@A@@@A@@BA@@@@A@@A@@@@@@@@@@@@A@@AA@@@@@@@@@@@@A@@AA@@@@A@@@@@A@@@@@@@@@A@@A@AA@@@@AA@@AA@@@@@AA@@@AAA@A@AA@@A@@AA@@AA@@@@@@@@@A@@B@@A@@@A@@@@@@@A@@@@BA@@@@@A@@@@@A@A@@@A@A@A@A@@@A@@AA@@@@@A@@@@@A@@BA@ABA@@B@@AB@B@@A@@@A@A@AA@@A@@@@@@@@B@@@@@@BB@@@BBBA@A@@@@BB@@D@B@@@@@@B@@@@AB@@@@@B@B@B@@@@@B@@B@@@@@B@B@@@B@@C@ABA@@@@@@@@@@@@@AA@B@@@@@@AA@@@@A@@@@@@@@@AB@@@@@@B@AB@@@@@@@@A@@B@@@@@AA@@@@A@@@@@A@@@@@@@@A@@@AB@@@@@@@B@@CBA@AB@BA@ABA@C@A@A@A@@@A@@A@@AB@@A@@@A@A@A@A@AA@@A@@A@@A@@@A@@@@@A@@@A@@BA@@BA@@@@@@@A@@AA@@@AA@@A@@@A@@@A@@@@@A@@@@@A@@@A@@@@AA@AAA@@AA@@@A@A@@@@@@@AA@@@@@@@A@A@A@@@A@@@@@A@@@A@@@@@@@A@@@@BA@@B@@A@A@@BA@C@@@A@@@A@ABA@@B@@A@@@@@A@@@A@@@A@A@@B@@A@@BA@@@A@@B@DAD@BAB@B@@A@@B@@@BACCA@@@AACA@A@@A@@@A@@@@@@@@@@AA@@@@@@@A@@@@@A@@@@A@@@@@@B@B@@@@@@@@@@AA@A@@@A@@@ABA@@@AA@@@@@@@@AAA@@@A@@@@@A@@B@@@BA@@@@@@BA@@@A@@@A@A@@@A@@@@@@@AB@@@@@@A@@B@@AB@@A@ABA@ABAB@@A@ABA@@@AAA@@@A@@@AAAAA@AAA@@@A@@@@@A@A@@@A@@B@@@AA@@@@A@@AA@@@@A@A@@@A@CAAA@@AAAA@@@A@@A@@@@@A@@@A@@@A@@@A@@@@@@@@@@@AA@@@@A@@A@@@AA@@@@A@@@@A@@A@@@@@@B@EACAAACAC@AACAA@CACBABABADCBADCDADA@CBABCDA@ADCBADABADADCBCBCBGBEBE@GBGDCDCFGFI@KBEFEDIDCBGBCF@BDDBDBDFDDDH@PBPKPKBBBA@@B@@A@@BB@@@@@@B@@@@@B@@@@B@@@@@B@@BBBBBBBB@@BB@@@@B@@B@@B@DBBB@@BB@@BBBBBBB@@@D@B@@@BB@@B@@B@@B@@@BB@@@@@B@BB@@D@@@BB@@B@@BB@BB@@@B@@@BB@@@BB@@@@@@@B@@@@@BAB@B@B@B@@@@BB@@BB@BBB@@@B@B@B@@@BA@@BA@AAAB@@A@@B@@ABA@@@@@@B@@@B@B@@AB@B@B@B@@@BB@@B@@@@@@BAB@B@@@@B@@B@B@@BB@@@B@@AB@B@@@@@@@B@@@BB@@@JDVDNDPB"],["@@ACEEAC@@EGGEEACCEAICC@CA@@G@EAEACAEGCECCA@AAA@CBA@E@E@C@EBA@A@A@EBC@CAA@CECACA@@EAAA@ABEAA@@C@ECEAG@C@EBGBEBEBAAC@AAC@@@CAAB@@ABA@@@A@@B@@@@BBADB@@B@@@B@@@BA@@@@@ABABABA@AB@@A@@@C@A@@@AB@@B@@BB@@BBBA@@B@@BB@@BB@BB@B@@@B@@BBB@@@@@B@@B@@@@B@BB@@@@BAB@BAB@BA@@BBBA@A@@@@B@@DA@@@@@BA@ABCBAB@@A@A@@@A@A@E@ABA@C@@AAAA@A@A@@BAB@@@@A@@@@B@B@BC@@@A@AA@@A@A@@@EAA@A@A@AAA@A@A@A@A@A@@@CAA@A@A@@@AB@@A@@AA@AB@@AAC@@@@@A@AAA@A@A@@@A@@@A@G@A@@@AAA@@@ABAB@@CB@BA@@@A@@@A@@@AB@@C@A@C@@B@@A@@@A@A@@@A@@B@@A@ABA@A@@B@B@BA@@B@B@@CD@@@@A@A@A@@@@@A@@@@@A@@@A@@A@@A@@@@BAB@BA@@AABA@AB@@@@A@A@@BA@@@@BA@A@ABA@@BA@A@C@A@@@@BA@A@CBAB@@@@@@@@@@@@A@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@A@@@@A@@@@A@@@@@@@@@A@@@A@@@@@A@@@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@AAA@@AAA@@@@AAEAA@A@@@@@@@AB@@AB@@A@@@A@@@@@C@A@A@@@@BA@@@@@@@@@@@@@AA@@A@A@@@@@A@@@CC@A@A@@AA@@BA@AB@@ABAAABA@@@A@@AAA@@B@@A@AA@@@@@@@AAA@@AA@@AA@@A@@@BB@@@@@@A@@AC@@@@A@AA@ABA@@B@@@B@@@BA@A@A@ABA@@@EA@@@@@A@@AA@AAACAAACA@@A@A@A@@B@@C@ABABA@@@A@@@A@@@@@AB@@A@A@@BAB@@A@@@AA@@@BA@@@A@ABCACAGB@@C@@AA@@AA@@@@@@@A@AA@@C@CA@@AB@@@@@@@@@B@BAB@@A@@BA@@BA@@@A@A@@B@@@@@B@@@B@B@B@@AB@@@B@@AB@@@BA@@@ABAB@@@B@@@@@B@B@@BBAB@@BB@@@B@@@@@B@@B@@B@@@@@B@@@@@@@B@@@@@B@@A@@@@@@@AB@@@@AB@@@@@B@@@@@B@@@@AB@@@@@BA@@@@@@B@@@B@@@@@@B@@B@@A@@@@B@@@B@@A@@B@@B@@@@B@@AB@B@@@B@B@@@@@B@@@B@B@@@BA@@@@@@@@@@B@@@B@@@@AB@B@@@B@@@B@@@B@@@B@@A@@B@@@@AB@@@@@B@@AB@@@@@@@@A@@AA@@@A@@@@@@AA@@@@@@AA@@A@@A@AA@@A@@@A@A@A@A@@@E@@@@A@@@@@@@A@@@@@A@@@@@@AB@@@@AA@@AA@@@A@@@@@@A@@@ABAAA@@@A@BAA@A@@@@@A@A@@@A@@AA@@@@@AB@@@@@B@@@@A@@@@@AAA@A@A@AAA@@@A@@@@@@B@@@@@@@BA@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@A@@@AB@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@BB@@@@@@@B@@@@@B@@@@@@BB@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@B@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@@BA@@@@@A@@@@@@B@@@@@@A@@@@B@@A@@@@@AB@@@@@BA@@@@B@@@@A@@@@@@@A@@@@@@@A@@@@BA@@@@@A@@B@@A@@@@@@@@BA@@@@@@@A@@B@@A@@@AB@@A@@@@@@@A@@@@@AB@@@@A@@B@@@@B@@@@B@@@@@@@@@B@@@@A@@B@@B@@@@@@B@@A@@@@@BB@@@@@@@B@@A@@@@@@@AB@@@BA@@B@@@@@@@B@B@@@@@@@B@@@@B@@B@@@@@@@B@@@@@BA@@@@B@@@@A@@B@@@@B@@@@B@@@@@@@B@@@@BB@@@@@@@@@B@@@@B@@@@@@@@@@@@B@@@@@@B@@@@@@@@B@@@@@@@@@B@@@@@@@BA@@@@@A@@@@@A@@B@@A@@@@@@@A@@@@@A@@@@@@@@@@@@BA@@@@@@@@@A@A@@@@@A@@@A@A@@@A@A@@@A@@@@B@@@@@BB@@B@@@BA@@@AB@@A@@BA@@@@@@BA@@BBB@@AB@@@B@@@BAB@@@B@@@BA@@B@@@B@D@@@@@@@@AB@@@@@B@@BB@@@B@@ABA@@@A@@@A@@A@@@@AB@@@B@@A@@B@B@@@@@BAB@@@BABA@A@@@AB@@@@@B@@@BAB@@@@A@AB@@A@A@A@A@@@@@A@AA@@@@A@@@AB@@@@A@@@@@@AA@A@@A@@@@A@@@A@A@@@@@A@@BA@@B@@@@A@@B@@@B@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@B@@@@@@BB@@@@@@BB@@@@@@B@@@@@@B@@@@@@@@A@@B@@@@@BB@@@@B@@@B@@@@@@@@@B@BB@@BBBB@BBB@@@@@BBB@B@B@@B@@@BB@@B@B@@@@@B@@@@B@@@B@B@B@@@@@@B@B@@@B@@@@@B@@@@A@@B@@@@@@A@@B@@A@@BA@@@@@AB@@@@@B@@@@@BBB@@@B@@@@B@@B@@@B@@A@@B@@@B@@@@AB@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@AB@@@@@B@@@@@BA@@@@BB@@@B@BB@@BBB@@@BB@@@@@@@@@BB@@@@@@B@@@@B@@B@@@@B@@AB@@@@@@BB@@@@BBB@@@BB@@BB@@@@@BBB@B@@@BB@B@@@BD@@BBBB@@@@B@@BB@@B@@@B@@B@@BB@@@@@B@@BB@@BB@BB@@@B@@@@@@@BB@@@@A@@@@@AB@@A@@@A@@@C@@BA@@@@@@@ABA@A@@BA@@@A@@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@A@@@A@@@@@@B@@A@@@A@A@@@@@AB@@@@AB@@A@@@@@ADA@@@@@A@@@A@@@A@@@@@@@A@@BA@A@A@@@@@@B@@A@@B@@@@@@@@AF@@AB@B@@@@B@@B@@@@@@BB@@B@@@@@B@@B@@@@@B@D@@@@@@@@A@@@A@@@A@@@ABA@@@A@@@@@@B@@@@AB@@@@A@@@A@@B@@@BA@@B@@@@@@A@@@@B@@@@@B@@@B@@@@@B@@@@@@@BB@@@BB@@@@@B@@@@@@AB@@@@@@AB@@@@@@@BA@@@@B@@A@@B@@A@@BAB@@@B@@A@@B@@A@@@@B@@@@AB@@@BA@@B@@A@@BA@@@A@A@@AA@A@@@A@@BA@@BA@A@@B@@@@@B@@@@@B@@@@@B@@@@@B@@B@@@@BBB@@BB@@@@BBB@@@BB@@@B@@@@@@@@@@A@@@@@@@@@A@@@@@@B@@@B@@@BB@@@@B@@@@@B@@@@B@@BB@@@B@@BB@B@B@@@B@@@@@B@BA@@B@@@@AB@@@B@@@B@@@BAB@@@B@B@@@@@@@B@@B@@@@B@B@@B@@@@B@@@@@@B@@@@B@@@@@@@B@@@@@B@@@@B@@@BB@@@@@B@@BB@@@@AB@@@B@@@B@@@BA@@@@B@@@@@@AB@@@@AB@@@@A@@B@@@@@B@@@@@BB@@B@@@B@B@@@@@BBB@@@B@@BBA@@B@@@B@@@@AB@@@B@@@B@B@@@B@@@@@@B@@BB@@@B@B@@@@AB@@A@@@@BA@@@@BAB@@@B@@@@AB@@@@A@@B@@@B@@@@AB@@@@AB@@AB@@@@@@@B@@@BA@@B@@@@@B@@@@@B@@@@AB@@@@AD@BA@AB@@A@@BA@@BA@@@@B@@@@@@@@@@BBB@B@@B@@@@@@@@@@@B@@@@@B@@@@@B@@@@@BA@@@@B@@@B@@B@@@BBB@@@B@@BB@@B@@B@@@B@@@@@B@@@@B@@B@@@@@@@@@B@@@@BB@@@BB@@@@@@B@@BB@@B@@@@B@@@@BB@@@@@@B@@@@B@@@@@@@@@B@@@@B@@B@@@@BB@@@@@BB@@B@@@@@B@@@B@@@B@@@@@B@@@BAB@B@@@B@@@B@@BB@BBB@BB@@@@BB@@BB@@@@B@@@@@B@@@BB@@B@@@@@B@@@@@B@@@@@B@B@@@B@DBBB@@@@@@BA@@B@@@@@BB@@@@@B@@B@@@@@@@B@@B@@@@@@@@@@@@B@@@@@BB@@BB@@@@@B@@@@@BB@@@B@@@@@BBB@@B@@B@@B@@B@@@@B@@@@B@@@@@@@B@@@B@@@@@@@BB@@@@B@@@@@BA@BB@@@@@BA@@@@@@@@@A@@@@@@@@BB@@@@B@@@@@@@@@BA@@@@@@BB@@@@B@@BB@@@B@BA@@BA@@@@BA@@B@@@B@@@B@@@BBB@@@@@@@B@@@@@B@@B@@B@@@@@@B@@B@@@@@B@@B@@@@B@@@@@BB@@B@@@BB@@@@B@@@@@B@@@@@@@B@B@@@@@B@@@@@B@@A@@@@B@@B@@@@BB@@@@@@@@BA@@@A@@@@@AB@@@@@BA@@@@B@@@B@@@@@B@@@@@@@@A@@@@B@@@B@@@@@B@@@BA@@@@B@@@@@BA@@@@B@@@B@@@BB@@B@@A@@@@@@BA@@@@@A@@B@@@@@AA@@@@B@@@@@B@@@@@B@@A@@@@@@@A@@@@@@@A@@@@@@B@@@@@B@@@@@B@@@@@@@A@@@@A@@@@B@@@@A@@B@@@@@B@@@@@B@@@@@@@@@@A@@@A@@B@@A@@B@@A@@@@@@B@@A@@B@@@B@@@@BB@@@@@BA@@@@@@@A@@@@B@@A@@@@B@@@@@BB@@B@@@@@@@BA@@@@@@B@@@@@@@@@B@@@@@@@BB@@@B@@@@@B@@@@B@@B@@@@B@@B@@@@@B@@@@B@@@@@BB@@B@@@B@@@@@B@@BB@@@@@B@@@B@@@@@BB@@@@@@BB@@@B@@@@@@@B@@@@@@@BB@@@@@B@@B@@@@BA@@@@B@@@BA@@@@B@@@@AB@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@A@@@@@AB@@@@@@@@@@@BB@@@@@@B@@@@@@@B@@A@@@@@@B@@@@@@@B@@@@AB@@@@@B@@@B@@@@BB@@@@@@@@B@@B@@BB@@@@@BB@@@@B@@B@@B@@@@@BB@@@@@@B@@B@@@@B@@@@B@@@B@B@@@BA@@@@@A@@@@@A@@B@@@@A@@BA@@@@@A@@@A@@@@@@@@@@@@@@B@@@@@B@@A@@B@@@@@B@BA@@B@B@@@@@BAB@BBB@B@@@BEBAD@B@BABCB@@@@@B@BA@AD@BDD@@@DDB@@BF@@@@BB@@@DB@BBB@B@BB@@B@@B@B@B@D@BABCFAB@B@@@B@B@D@BB@BB@@FDB@@@B@DA@@A@@A@ABA@@@@@@AC@@DCDA@@A@@@A@A@@AB@@@BA@@@@B@@@@AAA@@@@F@B@B@D@B@@B@@B@@@B@B@@B@@@BD@F@DA@B@@AB@B@@@@FB@@BB@DBD@B@BA@@@@D@BBB@@B@@@@B@@@BABAB@@@B@@@@@@DB@BBAB@B@@@@BEBA@@@A@CBA@@@@B@@B@B@D@@@BBB@B@D@@@B@B@B@D@B@@@@@@A@@@@CA@@@@@@@@BAB@@@BA@@@@D@B@@B@@@@B@@@@@BABAB@BE@@BAD@B@@@@A@AAA@ABABCBA@@@A@@@@F@@@D@D@@AB@@AB@@@BBBBBB@@B@H@B@D@@@B@@@D@@@BB@@@B@B@B@@@B@@@@AB@@BBBBBBBB@@B@@B@@ADBA@@@ABA@@BA@@BC@ABABAB@@ABA@A@@@@@@BAB@@ABAB@@@@ABB@@BAB@@@@@@AB@@ABABAB@@@BABBB@B@B@@@B@BAB@B@B@B@@A@@BA@@BA@A@@B@@@A@@A@@A@@A@AA@@@@@@A@@@@@A@@A@@@AA@@A@@@@A@@@AA@@A@@A@@A@@@@AA@@A@@AA@@@@A@@@AAA@@@@@A@@A@@A@@A@@A@@@@@AAAAA@AAA@@AA@@@@A@@A@@@A@A@@@A@@@@AA@@AA@AA@@@A@AA@@A@@@AAQSOAG@CCECACACCC@ADEHADAJCFCFELAJ@HEDEDCHCHAF@FAHADADADABCBCBABCDABCB@DCBADAB@BCDCBCDABCBABADADBB@DBBBD@DBBBDBFB@@B@B@@@B@B@@@B@B@@@@@B@@@BA@@BA@@BA@@B@@@@@@@@@@@BADADABAB@@@B@@@@@@@@@B@@@@@BA@@B@@A@AAAAA@@@@BA@@@@@AA@@@B@B@@@@AB@@@B@@@B@@A@A@@@@@A@@@AA@@@@A@@@@@@@A@@@A@@BA@@@@BA@@B@B@B@@@B@@AB@@A@@@@B@@@@@B@@@B@B@@@B@@AB@BAB@BA@A@@@A@@@@@@B@@@D@BA@@B@@A@@@@B@@@@@@@B@@@@A@@@@@A@@@@BA@@B@@A@A@A@A@ABA@@@@B@BAB@@@@@BA@@@@BA@@@AB@B@B@B@B@@@@@@@@@B@@@B@@AB@@@BA@@B@@@@@B@B@@AB@@@B@@@B@@@@@BBBB@@@@@@@@@BB@B@@@@B@@@D@B@@@@BB@@@B@@@@@B@@B@@@DBD@B@B@@@B@@@@@@@@@BB@BB@DD@@B@@BB@@@@@B@BA@@B@B@@@B@@@B@@@B@BB@@B@B@@@@@B@BA@@BA@@B@B@BBB@B@@@B@@@B@B@@@B@@BBB@@BB@@B@D@BBDBB@@@B@@@B@BB@@@@@B@@@B@@@B@B@B@@@@@BD@B@@B@@B@@BB@@@@@B@@@@@@BBBB@B@BBB@BB@BB@@B@@BB@BBB@B@B@@@@B@BA@@B@@@@@BB@@B@@@B@B@@@B@B@@@BAB@B@BAB@@AB@@A@@@@@AB@BB@@@@@@B@@@@@B@B@B@B@B@B@@@@@BA@@B@@@@@B@@@@@@BB@B@B@@@@@BBB@BB@@BB@@B@D@B@B@DBB@@@B@B@@@BAD@@@@@@@@@@@@B@@BB@@@@@B@@BB@@BB@@@@@@@@AB@@@@@B@@@@@@@B@@@@@B@@@D@@@@@@BBB@@B@@@@@B@@@BA@@B@BA@A@ABA@A@A@@AA@@@A@A@@A@@A@@@@@@@AB@@@BABAD@@@BA@@B@B@B@B@B@BA@@B@@A@@@A@@@@@@@@@A@A@@@@B@B@B@BB@@@@B@@@BABAD@@AB@BA@A@A@@@A@A@@@@@A@AB@@A@A@@@@B@@@B@@AB@@@BA@@@@@@BB@BB@B@@@B@@ABA@@@AB@@@A@F@BBB@B@D@BBB@DBB@@@BB@BBB@@BB@@B@@@BABA@@@A@A@@B@BB@@B@B@B@@ABABA@C@CAAAC@CAC@C@C@CBABABADABABCB@BCBC@E@CBC@@B@D@B@DCDABCBABCBEBCBCBABCD@BABCBABCBABABAB@@ABABCBLCH@L@DBJFFFHHFNDLLLLJNJ@@PDNDPDPDFBLFFFHNFNJJJFNHPHLDNDPFVAX@D@@@B@BBB@DB@@B@B@B@@AB@@@@@@@B@@@B@@@@AB@@@BA@@@A@@BA@@BABA@@BAD@@@BA@@@@@@BA@@@@@@@A@@@A@@BA@@@A@A@@@@@A@@@@@A@@@@BA@@@A@@@A@@@@@@@@B@@A@@B@@@@@@@AA@@@A@@B@@A@@@A@@@AB@@@@AB@@@@@@A@A@@@A@@BA@@AA@@@@@A@A@A@A@@BCBABA@@@@BB@@@@B@@A@@B@@@BAB@B@@@B@@A@@@@@@AA@A@@A@@A@@AA@@@@@@@@CCAA@@@A@@A@@A@@@@@@AAA@@@@@@AAA@A@@@AA@@@AA@@BA@@AAA@A@AA@@AA@A@@@ABA@@BA@A@A@@@A@@BA@@@A@@@AAAA@@AA@@@@@@A@A@@AA@@@AAAB@@A@@@A@@@@@AA@@A@@AA@@B@@A@@@A@@AAA@A@@CEEBEIFG@KEKGIIEGEGGCMAQFKFI@G@KAOGGICO@IBKFGLMJMBCDI@GBCDCFGFEFAJAJ@H@HBJFJDPDRBPDFBHBPFLDJFFFHHDJ@FBN@HAH@JAHEHADEDGJHBJFLBNDF@F@N@TDB@JBNBJDFDJJPFVBL@LBNDHBJDDBNFHFFDLHPFF@HH@FBLBJDHLDNDPBTBVAF@X@Z@NCRCFCFCJERCF@@@@@BA@A@@@AB@@A@@B@@BB@B@B@@AB@BAB@@A@@@AB@@A@A@@B@@A@@@ABA@@B@@A@@@@B@B@@@B@@AB@@AB@@@BB@@@@BA@@@@BAB@@@BA@@B@B@B@@AD@B@B@@@@@B@@@@AB@B@@@BBB@@BB@@@@@BB@BB@@BB@@@B@B@@B@@B@@B@@B@@@@BA@@BB@@@BB@@@@@B@@@@B@@BB@@@@@BB@@B@B@B@BBD@B@@@D@D@BB@@@@B@LEDA@@@A@@AA@EAA@CC@E@EAGAMGCEECIEEEACGA@@BA@A@@@@@@@@@@@@BA@@BABA@@BABA@@@@BA@@@A@@@@@@B@B@@@B@B@@@B@@@@@B@@@B@B@@@B@@@D@D@B@@@F@DAB@B@D@D@B@@@B@B@@@D@B@@@B@@@D@JEDEJGJCB@NCZ@LBDCHCBADG@CEEICMAKB@@AAA@@@AA@@AA@@A@@@@@AAA@AAAA@@A@@@A@@@@@A@AA@@AA@A@@AA@@@A@@@A@@A@A@AACAAAAAAAAAA@@@@@@@A@@@@@@A@@@@@@@AA@@A@@@A@@@A@@A@@A@@@A@@@A@@@@D@B@@@@@@A@A@@B@BA@@B@@@B@@DDDBBBD@DABBBBBDBB@B@FCFCDADCFADCBADC@A@A@@@C@A@AAA@A@@A@@@A@A@@AA@A@AAA@AA@AAA@@@@@A@@@A@@BA@@BAB@BABAB@B@BAB@@ABAB@DABADAD@BAB@DADAD@B@@AB@BAB@@AB@@A@AA@AAAAAA@@AAAAA@AAAAAA@@AAAAAA@A@@A@@@AA@BC@@@AAC@A@@@A@@@A@A@A@@@A@@@AAA@A@@@A@A@AAA@AAAAAAA@AA@@A@A@@@A@@@AA@@AA@@A@AA@@A@@@A@@@@@A@@@@@AAA@@@@@@@AB@@@@@@AA@@@@@@C@EBA@A@@@A@@AA@@@@AA@@@@@@@@AA@BA@A@@@@@@@@@A@@A@@A@@@A@A@@@A@@A@@@A@@@A@@@@@A@@@@@@AA@A@@@AA@@@@A@@@@A@@@@@@@CB@@ABA@@BA@@A@@AAA@@@A@A@@@A@A@@@@@AB@@@AA@AAA@A@@@A@@@A@@@@@A@@BA@@@@@A@@@A@@AA@@@A@@@@@A@@@@@A@@@@@@@AA@AA@AAA@@A@@A@@A@AA@@@@A@@A@@@@@@B@@A@@@@@@@@@AA@@@@AB@@ABADADADABABAB@@@@CBGBC@A@A@@BADEJCMKEEGECAGAM@EAAACGIBGBEAE@CA@@AC@A@A@E@ACIAABABCJCHEDA@A@AACAACAECEAIEGEACGGICCCAACECGAA@GAACGCCAACAGAC@A@A@CBC@C@@@KAG@A@A@C@@@CBABA@AACAEA@@C@ABCAC@CAACAM@G@KACAEACIGCCAAAACAE@EAIEGCGEAAAAAAECGCCAC@C@GAEAEACAGCCAEACAC@A@C@CBGBEBE@@@A@G@GAC@GAC@CAA@EECAA@AAEEACAAA@AAC@@@ABA@AFCHABA@AACAECCAC@C@C@KBI@GBI@CACAA@AA@CAG@E@AAA@AA@AAA@A@CACBE@CBCBA@AACAAAE@@@A@E@I@I@CAC@@@ECA@CC@AA@AAC@@AE@C@CAE@CBC@IDA@A@A@AACCAA@@A@CAE@I@A@A@@@A@CBEDA@A@CAAACEECCASGCAKEAAAACA@@ABC@CBA@A@CAIEAAEEII@ACCA@AACBEBEDE@EBC@A@GBA@CB@@IDIFA@CBA@AACAECAAC@A@A@CBCBA@C@CA"]],encodeOffsets:[[[120432,41585]],[[120519,41204]]]}},{type:"Feature",id:"130824",properties:{name:"滦平县",cp:[117.332801,40.941482],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AA@@@@AA@@AA@@AAA@A@@@@@@@A@@A@@A@@@AAA@@@A@A@@@A@@@@@@@A@@@A@C@CAA@A@A@A@A@C@C@A@@@@@AC@@AA@@A@AA@@@A@@A@@@@@@A@@A@@@@A@@@@BA@@@A@@@@@@@@@@@A@@A@@@@@A@@@@@A@@@AA@@A@@A@@A@@@@@A@@@A@@A@@@@A@@@@@@@A@@@A@@@A@@@@@A@@AA@@@A@@@@AA@A@@@AAC@@@@@AB@@A@@@@@@@A@@@A@@@@@A@@@@@A@@@A@A@@@A@@BA@A@A@@@A@@@A@@@A@A@A@A@@@A@A@@@@@ABA@@@@@A@@AA@A@@@CBA@A@A@A@A@@@CB@@ABAB@@A@@@A@@@@@@@A@@@@BA@@@@@A@@B@B@@@@BB@@AB@@@@A@@BA@@@A@@@CB@@@@AB@@ABADABA@@B@@CBAB@@ABA@@BA@@BA@@@A@@@A@@@@@A@AA@@AA@@A@A@A@@@@@A@@@AAA@@@A@A@@@A@AA@BA@@AC@A@A@@A@BA@@@@@A@@B@@A@AB@@@@A@@@@@A@AB@@A@@B@@@B@@AB@@@@@B@@A@@@A@CBA@AB@@AB@@A@@@A@ABA@ABA@A@AB@@@@@B@@@@@@@BA@@@@@@@A@@@A@@@A@@@A@A@A@@@A@@@AB@@A@@@@D@@A@@@A@@@A@@@A@@@A@@BA@@@A@@AA@@@A@A@@@AAAB@@A@AB@@A@AB@@AAA@AB@AC@@@C@A@@@@BAB@@A@@@AA@@@A@@@AA@@AA@A@A@@@AAAB@BA@ABA@@@AB@@A@@@@BA@@@@BA@@@@@A@A@@@A@@@@@AB@@A@@@@@@@A@@A@@A@@A@@A@A@@@@@AA@@@@@@AA@@AB@@@@@@A@@@A@@@A@C@@@ABCBA@A@C@AAA@A@@@AA@@ABAAC@ABA@@@AB@@C@A@@@ABABA@A@A@@@A@A@A@@@@@A@A@A@A@A@@@A@@AA@@@A@@BABA@@B@@B@@@AB@@A@@@@BA@@@AB@@@B@@@B@@A@@@@B@@@@@@AAA@A@@AAB@@A@A@@@AA@@A@@@A@@AC@@@ABA@AAA@@@A@@@@@AA@@A@@@A@CAC@AAA@@AA@A@A@A@@BA@@@@@A@A@AAAA@@AA@@@@ABA@A@AB@@A@@AA@A@@@A@A@@@A@A@@@A@@BA@@@@@A@@@A@A@C@A@@@AAA@AA@@@@A@A@C@ABA@@@C@A@A@A@@@@@@@@AA@A@@AA@A@@@A@AA@@A@A@@@@@@@AAB@@@@@@A@@AA@@@AA@CA@@@@A@@A@@A@@@@@AB@@AB@@A@@B@@A@A@@@A@A@A@@@A@C@@@@@AB@@@@@@A@@@@@AA@@A@@@A@A@@AA@@@A@A@A@@@@@@@C@A@@B@@A@@@A@@@@BA@@BA@@@ABA@@@A@ABA@A@@@@BA@A@@@A@@@A@@BA@@@A@@@A@@BA@@@AB@@@@AB@@A@AAA@@@A@@@@@ABA@A@@AA@@@@@AB@@@@@@A@@@@@@B@@@@@BA@@@@@A@A@@@A@@BA@A@A@A@@@A@@@@@@BA@@@@BA@@@A@@@AB@@@@@@@BB@@B@@@B@@@@@B@@@@BB@@@BA@@@AB@@@@AB@@AB@@@@A@@BA@@@A@@@@@@B@@@B@@A@@@A@@@ABA@@@AB@@@@@@@B@@@@@@ABA@@@@AA@A@@@@@@B@@A@@@ABA@@@A@@BA@A@@BA@@BA@@@AB@@@@@@AB@B@@@@@@@B@@@@@B@@@B@@@B@B@@@B@@AB@B@@A@@BC@A@@@A@@@A@@B@@@@@@AB@@A@@@@@AB@@A@@BA@A@A@A@@@A@@BB@@@AB@@@BA@@@@B@@AB@@@BA@@B@@@B@@@B@@B@@@B@@@B@@BB@@B@@BB@@@B@B@@A@@BAB@@AB@B@@@BAB@BA@@B@B@BA@@@@B@@@@BB@@A@@@@@@BB@@@BBB@@@@@@B@@@@A@@BA@A@@@A@A@@@@@A@ABA@@@A@A@A@@BA@A@@@A@A@AB@@A@@@A@A@A@@@A@A@A@@@C@A@@@A@@@A@@BA@@BA@@BAB@@@@A@A@@A@@AB@@@@@B@@@B@@AB@@@@B@@@B@@@B@@@@A@A@@B@@@@@@B@@@@BBA@@BB@@B@@@B@@@B@@A@@B@@@@@B@@@@@B@@@@@@@BB@@@@B@@@B@@@BB@@B@@@@@B@@@@AB@@@@A@@@@@A@@@A@@@@BA@@@A@A@@@A@@@A@@BA@@B@@@@@B@@@@@BA@@@A@A@@@A@@@AB@@A@@BA@@@@@@B@@@@B@@B@@@B@@@BB@@B@@@@@B@@@B@@@@@BA@@@@B@@BB@@@@@BA@@@@B@@AB@@A@@@@BA@@@A@AB@@@@@@@B@@@B@@@@AB@@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@AB@@@@AB@@@@A@AB@@A@@@A@AB@@A@@@@@@@BB@@@@@B@@@@@B@@@@A@@B@@@@@B@@A@@B@@@@@@@@AB@@@@@@AB@@@@@BA@@@@@@BA@@@A@@@A@A@A@A@ABA@AB@@@B@@@B@@@@@@@@C@A@C@E@@@A@@@ABAB@BA@@@AA@@@@ABA@C@@@A@A@CAA@@@A@@@ABA@@@@B@@@B@@@BA@@BA@@@AB@B@@@@A@@@C@@@E@A@@@@@A@@@@@AAA@@AA@@AAAACCA@@AA@@AB@@A@@BA@@@@B@@@B@BA@@@@B@B@@BB@B@B@B@@@@A@ABA@@BA@@B@@@@@B@@@@@@@@A@@@A@@B@B@@@BBB@B@@@B@BA@@BA@@BA@@@A@@@A@@B@@@@A@@@AB@@AB@@@B@BA@@@A@AB@@A@@@@BABABABA@@BA@@B@BA@@BC@@BA@A@A@A@A@@@A@@BAB@BA@@@@BAB@@@@AA@@A@A@@@AA@@C@AAA@ABA@AB@@@@@B@B@B@B@@BD@B@B@@@@A@AB@@@@A@@@A@@@C@@@@@A@A@@@A@@B@@A@@@AB@@A@@BA@@@A@@@@@A@@@A@@@AB@@@@@@@@@@@@@@A@@@A@@@@B@@@@A@@B@@A@@@AA@@@BA@@@AB@@@@A@@@A@@@@@A@@@@@AA@@AB@@A@@@@@AB@@@@@BA@@@AB@@A@@@@@@B@@@B@B@@@@A@@BA@@@@@@@@B@@@BA@@@A@@@@@AB@@@@@@A@@B@@@@A@@@A@@BA@@@@BA@@@AA@@A@@@A@@B@@@@@B@@@@@B@@@@@B@@B@@@@B@@A@@@BB@@@@@@BB@@@@@@@@@@@B@@@@@BB@@@@B@@@@@B@@B@@B@@@@@B@@@@BB@@@@B@@B@@BB@@@@@@@B@@B@@@@@@@B@@B@@@@@@@@A@@@AB@@A@@@@@A@@@@@AB@@@@A@@@A@@@A@@@@@AB@@@@A@@B@@A@@B@@@@@BB@@@@B@@@B@@@@AA@@A@@@@@A@@@A@@@A@@@A@@@@@AB@@@@AB@@A@@@@@A@@B@@@B@@@@@BA@@B@@B@@@@B@@A@@B@@A@@@A@@@@B@@A@@@@BA@@@A@@@@@AB@@@@@@@B@@A@@@AB@@@@@B@@A@@B@@@@@BA@@@A@@BA@@@A@@@@@@B@@BB@@@@@@@BA@@@@B@@@@@B@@@B@@@@@B@@@@AB@@@@A@@@@B@B@@@@@B@@@@@B@@@@@@@B@@A@@BA@@@@@A@@@AB@@A@@@@B@@@BA@@@@BA@@B@@@@@@AB@@@@A@@B@@@@A@@B@@@B@@BB@@@@@B@@@@@B@@@BB@@@BB@@@@BB@@@@@@@B@@@B@@@@B@@@@@B@@BB@@@B@@B@@@@@@AB@@A@@B@@@@@BA@@@BB@@@@@B@@@@@@@B@@@B@@@@@@@@@B@@@B@@@@@B@BA@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@B@@@B@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@BB@@@@@@@@@B@@@@B@@B@@@@@@@BBB@@B@BB@BBBBBB@@@@@BA@@B@BA@@@@BB@@BB@@@BBB@@@B@@@@BB@@B@BBB@@BB@BB@@B@@@@B@B@B@BA@ABA@@B@@@B@BAB@B@BAB@@A@@@@@AB@BA@A@@BA@@B@@@BALIJGJCJBJBJ@LEDCHG@ABCFEDEHGNGJEPELGFEFCDIHENCL@L@RDNBNBJFLDRBNAHCLELEJCPCRGTELEHCZINAJ@JDFBF@NDFBHDF@NCJAJ@JBJBHBJAJAHCNCLEPIPEVC\\@RBP@RFFDJDJDHDJFHJJHFLJLLHJBLFBBDDBDAH@HGLAHDJHHJBHDNFPHPHHHFJFFFFFFFBDFDHBHBHBFDDHFNDNFRDT@NAJEDALENELCN@LAJAP@P@LDJFHHJHHJLNLHPHPBL@RHFDBDBF@FALDLBR@NBFFNBHDF@DADCHADEDCDAHBFBJDHFHBF@J@NBNBHFNHNHLJNJJJHJHHJFLFPHLFJHFLFN@VELANCPGJGBI@K@IHKJGLGTGNENELEHEDG@KAG@IBGBCDAH@J@DFBFDFHJJFJDHDP@PALADAHEFGFGPEJ@D@J@NDNBNBLDJ@PAXCNAPAPALFJHFHDHBBHLPJNDNBLANEHGDAHACGAKFIFMFO@OAG@GCIEGGGCM@EAGBA@KDG@GBG@GCI@C@E@MBIDOBO@IBI@@AAAIAK@EDKYLCAA@CC@AAACAEACAEBC@CAI@@EAGCCECECIAEAECCACA@AEACCA@@@C@EBCCACBEACACACAAACAAAAAA@AAA@AAAC@A@AB@@ABA@ABA@A@A@@A@@@AB@@A@@@A@AAAAAAAAA@CAC@A@C@A@C@C@A@A@ABC@AAABAAA@A@@AAAA@@A@AAA@CA@@AAA@@AA@AA@AAAA@@AAA@@AA@AAA@AAA@AA@AAAA@AA@AA@@AA@AAA@CAAC@C@C@AAA@AAA@A@AAA@CA@@AAA@@A@@@A@A@@@A@@@@@A@@@A@@@A@A@@@A@A@@@A@A@A@A@A@ABA@CBADCBABADCBCFABAFCB@J@DAFCFAFADAHCFAFAD@HDFDDBDBBBB@BBFBFDFBFDDBRJRHLKH@L@D@AP@BDBBATK\\BMUHMBSCCKCGEAIECA@GMEECC@ABCHENWCOAGH@DBFSAOCA@AO]@@@@@@A@@@A@@@E@A@A@@@A@@@@@A@@@@@A@@BA@@@A@@BA@@@@@A@A@AA@@AAA@@@A@A@@@A@A@@AA@AC@@AA@A@@@AA@@@A@@@@@A@A@C@@AA@A@C@AA@@@@@BAB@@@@@BAB@@@@A@@@@@A@A@@@A@AA@@A@@@A@C@ABA@@BAB@@AB@@A@A@@@CB@@ABAB@@A@@B@@A@@@A@@@ABABA@@@ABA@ABAB@@@BAB@@@@@BA@@@A@@@A@@@@@A@AB@BA@@B@@@B@@A@A@A@AAA@@@A@@@A@AA@@A@@@A@ABA@AB@@@@@BA@@B@@@B@@@@A@A@AAAAA@AA@@AAA@@AA@@@@@@A@@A@@@AAA@@A@@AA@@@@A@ABA@AB@@A@@@A@A@@B@@@@A@@AA@A@@@A@@BA@A@@BA@@@A@@@A@@@A@@@@AAB@@A@A@@@A@A@@@A@@@A@@@@@A@A@@@A@@@A@@@A@ABA@A@@@A@A@AAAAECIACAGGIECAG@E@E@Q@_BU@[@SBMC@@GEMCACAACGAGBI@IAGCGCG@GBKDIDGDEDIBGDCBEBCBG@CCGBE@ELCFEBGAEAM@GGOAIACEEA@@AA@@AAAAA@@@@AA@@AA@@@@A@@@@AA@@@A@@B@@A@@@@@@@@@@B@@@@A@@@@BA@A@@@@@@@A@@@@B@@@@@@@BA@@@AB@@A@@@AB@@@@A@@@@@AB@@A@@@A@@@A@ABA@@@A@@@A@@@AB@@A@@@@@A@AB@BA@@@@AA@@AA@A@AA@@C@@@A@@@AA@@@@A@@@@@A@@@@AA@@@A@AA@@A@@@@@A@A@A@@@A@@@CB@@ABABA@A@CB@@A@AAA@@@@B@@@@A@@@@@C@@@A@A@A@A@@AA@@@A@A@@@A@A@@BA@@BAB@@@B@B@BA@ABAB@@AAA@AAA@@AABA@@@CBA@@BABA@A@@@A@A@A@@@@@A@@B@@A@ABA@A@A@AAA@A@@@A@ABA@A@@@@@A@C@@AA@C@AAA@A@AAA@A@A@@@@@A@A@@@A@@@A@@AA@@@CBA@@@A@@@A@A@@AAAA@AAA@@@AA@@EA@@A@A@A@A@@@CBA@A@"],encodeOffsets:[[120188,41657]]}},{type:"Feature",id:"130826",properties:{name:"丰宁满族自治县",cp:[116.646051,41.209069],childNum:1},geometry:{type:"Polygon",coordinates:["@@f@@@LNBBB@@BBB@@DBF@@@TVBBCDAB@B@@B@BBB@@@@BFBB@B@@@B@@D@B@B@@AD@@@B@@AB@BABADCB@@GFAD@@ABAB@BA@A@IFCBABAD@@@B@B@@@B@@BFB@@FBDDB@@@@@B@@AB@@C@@@AB@@@BBDDDBBD@BBHF@BBBJDJBLBF@F@LCL@J@HBFDBFBDF@DAB@FEDCDAHAJ@HBBAB@B@DBB@BBDB@@@@AB@@BBB@DBBDBB@B@B@B@BB@AB@B@@@@@BDBB@BB@@@@BDD@@DB@@B@@@@@B@@@BEB@@@@@B@BABAB@BB@BBDFB@BBBB@B@@@@@BA@@B@B@BADAD@@@@@@D@BBB@@@DDB@@@@B@BB@BB@@BB@BAD@B@@BBBB@B@@BBD@DBD@@@BBBFAB@BA@@BA@ABC@AD@BD@DB@@@B@@@BBDB@DB@@BD@BTBfPJFFFJBVDJFLFFFDHBLFPDLBBFJFDHDVHJBDADAB@VBNDJBJBH@JBFCLEJKNENCJ@FAJGBEAIAKAGIKGIECGEGCEAEA@GDCJGHAJANBJ@JAJCH@J@LDFBJDFBJBLFLHDFJHFJDJFHFFHBFBHBFBHALCJEFGDEBEHKHIBEDGFGFGFEHCNGLCN@LBRBJBFBNFD@LBHANCLAJ@JAL@D@BAF@B@F@B@BBB@DFFFBDDD@B@@@@BB@@DBB@FDD@DBB@@@B@@@DBF@D@B@@@B@@A@@B@B@@@B@B@@@FA@@B@B@@@B@@@B@B@@@@@B@B@BBDA@BBABBBABAB@B@@@BAB@HANDRAJIJGDACAGBEAGADCFCLEVCJAJ@PEHGFILONEPERAHCJGFMFIBG@ICE@ECGAK@GBGFEHIDCDEBIDEBGBGJKLELGNGHIFGDGBGBKBGAEAICIAI@A@KBI@KDMDGBGDIAGCIAKBGBKDEHIJIJKFKJGNILERI@GDG@GGIOEOMGECCACBEDQBK@MKIQMOCYKQCQCS@K@I@IEAEBGJKRGRCRARCTGPCPENELEFIDQ@MAICMBEDGHARDLFRJNCNAPGNGNCJCTGPCJAN@PBRFPDNFFDHLDDJFJDLBJCREPCRCD@J@TBPBN@TALCNKNGDGFGLKLGPGHAJABEFGBGHMHIFCHELIHKBAHCLGJCNAHCJCHAHCDADCDEAGDCBCBALEFCJEFCLGHMBGAMBEHIHGHGDE@IAKCIDEDADAFENEHCDADEBEAGAKCI@AHKHILELILCHEDKAIIIIIEKEKAMBMBABKDEBCFIFGDGFINQHKTOLERMNINKFAFCLELADCFCBCDGBC@CCEAGEMAE@MAQCKBK@EAEACECQGK@OAOGKGKMGIIGGGIEKCO@O@IBKBM@KDMFKFCBIFMBS@QCMEMCGECCAEAGAGCGCEEAEEEEEEEIGGOGOGMEGCIAGGCIBGHK@GBGACCCAAKEIAKGIKEKIGGIIEGCICICECQEO@QA[@UDOFOJKFMDGDIBIBGAIAIAI@IBMDE@GCEAMCE@EAICI@MBYJGDKFSFQHODIDKFKFGDMBQAKCIEMAMAQCK@K@MDGFCJEDEFKHOFIFMHGHCFEFAD@BGHCDKFI@IAIAIDIHKJAB@@A@@@AB@@@BABA@@B@@@@@BA@ABA@A@ABA@@@A@@@AB@BABA@A@A@@@AB@BABAB@@@DA@@BBB@B@B@@@@@BAB@B@@@@AB@@A@@B@@A@@B@@AB@@@B@@@BB@@B@@@@@BA@@@@BAD@@A@@@@@C@AAA@AAA@@AA@A@A@AB@@A@@@A@@@@@@@AB@BA@@BA@AB@@@@A@@@@@AA@@A@@B@@A@A@A@@@A@A@@@A@@B@@@@A@@B@@A@@@@@ABA@@@A@@BA@@BA@A@@@@@@B@@@@@B@@ABAB@BA@@@AB@@A@@BA@@@A@@@A@@@A@@@@B@@@@A@@@@@A@@@A@A@@BA@@@AAA@@A@@AB@@A@@@AB@@AB@@@@AA@@@@A@A@A@@@A@@@A@@B@@@@A@@@@A@@AA@@@@A@@@AA@@@A@@A@@AAA@@A@@AA@@@A@@@A@@@A@A@A@AAA@@@A@@@@@@@BA@@B@@A@A@A@@@A@@@@A@@A@A@@@AA@@A@@A@@@@@@A@@AC@A@@@A@C@@@A@@@AB@@@BAD@@@@@BABA@A@@@@@@@@@A@@B@@@@@@@B@@@@A@@@@@@@A@@@A@@@@@@@A@@@A@A@A@@@@AA@@@@@@@AB@BA@@@@@@@@@AAA@@@A@@@@@A@A@@@@@AA@@@AA@@@@A@@A@@@A@@@A@@@@@AA@@@AA@@@@@@@A@A@@@A@AA@@@@AAA@A@@@A@@@@@@@A@@BA@@B@@A@@@@B@AA@@@@@@@A@@@A@@@A@@@A@@@AA@@A@@@A@@@@@A@@@A@@@A@@@A@@@AA@@@@A@A@@@A@@A@@@A@@A@@A@AA@@A@@@AA@@@@A@@@@@AA@@A@@@AA@@A@@A@@A@@@@AA@@@A@@AA@@@A@@@A@@@A@@@A@A@@BA@@@A@@@AB@@A@@@@@A@@@@@A@@@A@@@AB@@A@@BA@@B@@A@@@@@A@@@AB@@A@@BA@@@@BA@@@@@@B@@A@@B@@A@@BA@@@@@A@@@A@@B@@A@@B@@@@@@AB@@AB@@@@A@@BA@@@@BA@@@@@A@@BA@@@A@AB@@A@@@A@@@A@@@@@A@@AA@@@@A@A@@@AA@@A@@@@A@AA@@A@A@@@A@@@@@A@@@A@A@@@AB@@A@@A@@A@A@@@AB@@A@@B@@A@@@A@@@AB@@@@A@@A@@@@A@@@AA@@A@@@A@A@@@A@@@@A@@A@@A@@@@A@@A@@@@A@@@AA@@@@AAA@@@A@@A@@@@AA@@A@@@A@@AA@@@A@@@A@@@A@A@@@A@@B@@AA@@@A@A@@@@@A@@@@@AA@@@@@@@@AA@@@@A@@A@@@A@@@A@@@@@A@@@A@@@@@A@@@AB@@A@@@A@@@@@A@@@@@A@@@@@A@@@A@A@A@ABA@A@A@@B@@A@@@A@@@A@A@@@A@@@@@@@@@@@A@@@@@@@ABA@@@AB@@A@@@@@@@@B@@A@@@AB@@A@@B@@A@@A@@@@A@@AA@@@A@@@A@@@@@A@@A@@AA@@@@A@@@@@A@@@AAA@@@AB@@A@@B@@A@@B@@A@A@A@AB@@@@@@@@@@@BA@@@@@@@A@@@@@AB@@A@@@@BAA@@A@AA@@AAA@A@@AA@@@A@@@@@A@@@@@@@@A@@@A@@@A@@@A@@@@@@@@AA@@A@@A@@@@@A@A@@@A@@@@@@@AA@@AA@@@@A@@@A@@@@BA@@BA@@@A@@@AA@@A@@A@@A@@@@AA@@@A@A@@@A@@AA@AAAAA@@@@@A@@@@@@@@BAB@@AB@@@B@BAB@@AB@@@@@@A@@@@@@@AB@@@@A@@@@@A@@@A@@B@@A@@B@@A@@BA@@@A@AB@@A@@@@B@@@@AB@@@@AB@@A@A@A@@AA@@@@@A@@@@B@@@B@B@B@B@BA@@@A@A@@@C@@BA@@@A@@@ABAB@BA@@BA@@BA@@@AB@@A@A@@@C@@@A@A@@@@@AB@@A@@@A@@@A@@BA@@@A@@@@B@@A@@@@@AA@@@@A@@@@B@@A@@@AB@@AAA@@@AA@@AA@@@@AA@@A@@@A@@@@@@@@@@@@A@@@@@A@A@C@C@A@A@A@@@@@AB@@@@@@@BA@@B@@@@AB@@@@A@A@@BA@A@A@C@@@A@A@@@A@@@A@@@@@@@@@A@@@AA@@@@A@A@A@@@A@A@A@A@@@@@A@A@@@A@@@A@@@AA@@@@@@AB@@@@A@@BCB@@A@@BA@@B@@A@@@@A@@A@@@A@@@A@@@A@@@A@@@@A@@A@@@@@@@A@@BA@@@A@A@@@A@@@AAA@@@AA@@CA@@A@@@@@@@@@@@@@A@@@AB@@A@@BA@@B@@@@AB@@@@A@A@@B@@A@@@AB@@@@A@A@A@@@@@A@@@@@A@A@@@@@A@@B@BA@@B@@@B@@@@@BA@@B@@@@A@A@@B@@A@@BA@@BA@@@AB@D@@A@@BA@@B@@A@@@@BA@@BA@@B@@AB@@@B@@@@@B@B@B@@@@@B@@@@ABA@@@@@A@@B@@AB@B@@@@@BA@@@@B@@@B@@B@@BA@@@A@@@@BA@@@@BAB@@@B@@A@@BA@A@AB@@AB@@@@AB@@A@A@CA@@A@A@@@@BKJEFMLEDIJAJCL@HCDABCFC@CBI@S@S@MBGDEDEFCDBJANAFCHEDGHCB@@@@@BA@@B@@@@@@@B@@@@@B@@@@@B@B@@@B@@@@@@@BAB@@AB@@@B@@A@A@@@A@A@@@@BA@@@@@@B@@@B@@ABAD@@@@@B@@@@@@@@@BBDBB@@@@@@B@B@BBB@@@B@@@B@@@@BB@@B@@B@@B@B@@@BB@@BB@@@BB@@BBBDBBBB@@B@@BBB@@B@@@BBB@@@BBB@@@B@@BB@BB@@B@@@B@B@BB@@B@@@@@@@@BA@@BABAB@@@@@@@B@@@BAB@@AB@BA@A@ABA@A@AB@@AB@@@@@B@D@@AB@@CDABA@AB@@A@@B@@@B@@@B@@BB@B@B@@@B@@@B@B@@@B@@A@A@@BA@@BAB@@ABC@ABA@@DA@@B@B@BB@@BA@@@@BC@AB@@ABA@A@ABGDGFGBIDONCHAFCFJLDFHJDNANBF@FEHIBE@G@GFGFCH@L@DFJFDLPFF@NABEFCFGHGHQPELMVCHEL@JCHAJEJ@JALBHBBB@B@B@BBB@@B@@@B@B@@@B@B@D@@BB@@@BB@@@B@B@@@DB@@BBB@@BB@B@@BB@@@@B@@@@@B@@AB@@@B@B@@@@AB@@@B@@@B@@@@@BB@@B@BB@@B@B@@@BB@@B@@B@BBB@@B@@@@@@@@@@@@A@@@A@ABA@C@ABA@A@@@A@@@AB@@@BAB@@@B@BB@@BA@@@@@@@ABA@A@@B@@@@@BAB@@@@@B@@AB@@ABCBABAB@@A@@@A@A@E@A@AB@@A@@B@@@D@D@@@B@@A@@BA@AB@BAB@@CBAB@BAB@@AB@@A@@@A@ABA@A@@BA@AB@@@BA@@@A@@@AB@@A@A@ABABIFOFKHOB_AM@MBMBI@KBKLIFMDMBK@QDKDGFCFCHEJ@JCJGLEFODG@GFEF@FCH@D@HCH@JEFEFIHSFEH@FBDDFBFBF@PAL@HETEJ@F@LAHKNGLKPMJKHGFEDIDKBGD@J@B@BBBBBDBDD@B@B@BAB@B@@ABA@AB@@@BBD@B@@BB@@BD@B@@@BEPEPENM^AFAFCHHDNHPHPHJFHFFFJHHDJFJDNHTNLJLNJHJHTRFDHBLDRDDBJFPHLHJLHJFLBNFHHNBFDDBBBF"],encodeOffsets:[[118867,42780]]}},{type:"Feature",id:"130827",properties:{name:"宽城满族自治县",cp:[118.485313,40.611391],childNum:1},geometry:{type:"Polygon",coordinates:["@@ICEGCMCGCGCCOBG@EACCKW@@KMABCBC@A@MAGBABA@@D@BABA@CBA@G@CBE@CBABAD@DBD@D@D@B@@AJ@H@DBPBHDLDDBBBB@B@FAJ@H@H@FB@DDB@@@B@BAB@B@B@FAB@B@@BBB@@@B@B@B@BBB@@B@BB@@BB@@BBA@@B@@@D@B@B@BAB@BA@@BAB@@ADEF@@HHHH@BBBBH@@BBB@D@BBDB@BB@@B@B@BBB@@@B@B@@@@@BABAB@@@B@@BBFB@@@@K@ABA@AAA@A@@@CDA@A@E@@@A@@BAB@BCDABA@@@A@AAAAAAAAA@@AAACA@@@A@@GCMGCAEAWCG@IAA@A@C@CBC@IBIBIBE@CBABABCBCBE@E@GBCBEAUAMAE@G@QFGBEBABA@C@C@EAC@C@@@GJCDADAD@H@DADABA@CBE@ECGACAEAGCE@E@C@CAA@A@@@ABAH@BA@A@C@ICGAGAG@G@GAAAAAAC@I@@AAA@C@GBC@C@A@CACAA@E@EBSDMBEBE@EBCDAFAHADABABGBIHAD@BDLAFABABMHMFGBC@A@@A@C@GACCCGCE@G@E@ECCEMCKCIDCFAB@@@B@BADAH@D@@A@ABABCBCBCBAB@BA@@B@@B@@B@@@@@@@@@BA@@@@@@@@BB@@@@@BBB@@@@BB@@@BB@BBBB@@B@@@@@@@B@@@@@B@@@@@B@@BB@@@B@@@B@@@@AB@@@B@@@@@B@@@B@@@BBB@BBB@@A@@B@@@@@B@B@@@B@B@@BB@BB@@@AB@@AB@BA@@D@@@@@@@B@@B@@@@@BB@@B@B@@B@@@@B@@@@@B@@@B@@@B@@@@B@@@B@B@@@BB@@@@B@@@@@@@@@BABB@@B@@@@@@B@@B@@B@@BB@@@B@B@FAD@@@@@@@BB@@@@@@BA@@@@@@B@BB@@@@B@@@@@B@@@B@@@BBB@@@BB@@BB@@B@@@B@B@@@BBB@BBBBBBB@BBB@B@@@B@B@BB@@B@@@B@B@B@@@B@@@B@D@BB@@D@@ABB@@B@@@@BBBBBBB@@BBBBBBB@BBBB@@BBBBBBB@@B@BA@@BA@ABA@@BA@C@CBCBA@ABC@CBABCBA@AB@BA@ABA@A@ABABA@AB@@AB@@@B@@@B@@@@BB@BBBB@BBB@B@@BB@B@@@B@@@@BBB@B@B@D@@@B@BCDABCDEBCDCBEDEDA@A@CAAAAABA@CACAACC@CA@@@A@@@ABA@@@@B@B@@@@A@C@@@@@@B@@@B@@@BB@@@@B@@@B@@@BB@@B@@@@@@@B@@@@B@@@@@@@B@BBBBBBBBDBBBB@B@@@@B@@@B@@BB@@@BBB@@BBB@@@@@B@@@B@@@BBBBB@BB@@@@B@@@BB@@BB@@B@BB@@LANBJDFF@DCHABGDCDCD@HFFDDHDNDLBP@JDNDNBLBPDHBJBPDDBHFTD^LLHJHLJFN@L@FDHLJLDPFLDFBD@NHFBFBJDDBJBH@R@TCT@P@L@P@FDFGDEHGFEPEL@LBH@LELGJEFCJCJBDBDFDJAH@FAFDDDDHBZFRFFBJBJFPJNBFAF@FALDJDHBDFFLFJJJLDF@HCHEDEDMHGLCHD@BDJDHFDHAPAJCPGHAHBL@NBHE@E@CAEBEDEFADCDELKDGHGNAP@B@LDLBNFHDDDJHFLFF@DJHHFJFLDNBNFDBDBHFPHRJHBFBLFHBHBJHHB@@BA@@@@DBB@B@BBD@F@@BB@B@B@B@B@B@FAB@DAB@DABABADAD@B@@@@@BBD@HAF@B@B@@ADBDA@@B@@@DABA@@D@B@@@BABAB@@AB@BA@AB@AA@ABA@@@ABA@A@@BABE@@@A@@@C@A@@@AD@@@@@AA@A@CBA@C@AB@@@BAHC@@@ABA@@B@BAB@@ABAB@B@BA@@BABAB@BA@@B@DA@@BABABA@AB@@@BA@@@@B@@@@A@@@A@A@@@ABA@@B@B@BB@@BBB@DAB@DABC@@B@B@B@BAFE@@DAB@@A@AB@BAB@BAB@BBB@BBBBDDB@B@B@B@B@B@B@BBB@@AB@@AB@BAD@@AB@DADABA@@BA@A@@HCJAB@@@BEBC@ABA@AAA@A@ABABCB@B@AAB@AA@A@@D@D@D@DAB@@ADDDDFHHBFBJAFAHCDCBAHCDAF@DBD@JBLDNHNHJDJDHDBBLJJFDBFBNDJALCHEDEFGBIEIGMCGGIQIGGMIAA@@AA@@@AAACAA@A@CC@@@A@@@AFC@AB@@A@AFABADA@C@@@@AA@A@@BA@@B@B@DA@@@A@A@A@@@AC@A@K@A@@AA@AA@@A@@A@@ECAA@@CAAAGAC@AAA@A@EAA@@@A@ABABA@@@@@A@EAA@A@@AA@E@A@A@C@A@A@A@@@ACA@@AAA@AC@AAA@@A@@@CBA@@CA@@A@C@A@A@@@AAA@AA@@AA@AA@AAA@ABA@E@ABA@A@AB@@BB@D@B@@@B@B@@A@@@A@ABC@A@@B@@@@BF@@@BA@@BA@AB@@@BAAA@A@A@AB@@A@A@A@A@AA@@CACA@@A@@AAAC@@AEACAAAAAEC@@AA@@C@@@AACC@@AAA@@@A@A@CB@@A@A@@AA@A@ABACEAA@@AAC@AC@AA@@CCA@AAAEA@@BA@C@EBA@@@A@A@@@@@A@A@A@@@A@A@@@A@A@AAA@CAAACA@@@@AAC@A@@AAA@@@A@AAA@@@CAA@A@@@A@@@AA@A@@@AA@@A@A@A@A@A@AA@@C@AB@@ECC@C@A@EAC@A@C@A@CA@@A@@ABA@@BA@@@BB@B@H@D@@@B@@E@@A@C@@@@A@C@ADAB@@AA@@AB@@A@AB@@A@@@A@@@A@@@@BA@ABCBA@AA@@@@@@A@@A@@A@@AAA@AAA@@@A@@@@@@@A@A@A@A@@@A@@@A@@@A@@@@@A@@@@@AB@@@@A@@@A@AB@@@@A@@@A@@@@@A@@@@@AB@@A@@@@@@@AB@A@@@@A@@@@AA@A@@@C@A@@@AAA@A@C@C@AA@@A@@A@@@@@@A@@@@@A@C@A@C@A@@@A@@B@@A@CB@@A@A@@@ABC@A@AB@@A@@@@BA@@@@B@@@@A@CBC@A@@B@@A@@@@BA@@B@@@@A@A@A@A@A@@@A@A@@@@@A@@@ABA@A@@B@@A@@@@@@@AAA@@AAAAA@@A@A@AAC@@@AAA@A@A@AAA@AAAA@@@AA@A@@AC@@AAAAA@AA@@A@@@BA@@@C@ABA@A@AB@@@@A@@@@A@@A@@@AAA@CAA@A@A@A@A@A@A@C@ABA@C@@@A@@@A@@BAB@BA@@@AB@@@@A@@@ABA@@B@@A@@@A@AAA@A@A@A@CA@@A@@@AA@AAA@@AA@@AAA@AA@A@@A@AA@@AAAAA@@@@@AA@@AA@@AAA@EAA@A@CC@A@EA@@@AACA@@@@AAAGAE@@@A@AACAC@A@ADC@@@@CAAAAACCA@A@CAA@A@@AAACA@@AA@ACAAAA@@@GAA@@@AB@@I@G@E@A@@@AA@A@A@@@AA@C@@@AAA@A@E@AAA@@@ABAB@@A@C@@@A@A@AAI@CAC@AACBA@GDCBCBAB@@@@AD@D@BABABABABGD@@CD@@A@A@A@ACEACCEECE@AA@C@AA@@@AAAC@AAA@AA@A@AAC@@@@C@@@@@@@@@@AB@@CA@@@AAGAA@@A@@@CBA@A@AAA@A@AA@CA@@@AAA@CACAAAAAAA@AB@@@@AB@@A@ABA@GESCKAOEIGGGCG@IHSHMJQJKFIDEFIBK@K@CAGCGCIAAEGCCOMQQCAEA"],encodeOffsets:[[121460,41299]]}},{type:"Feature",id:"130828",properties:{name:"围场满族蒙古族自治县",cp:[117.760159,41.938529],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@@@@BB@@@@@B@@A@@@BB@BA@B@@@@@@@B@@@@B@@@B@@@@@B@B@DBB@@@B@@@@@B@@@@@@@@@@@@@@@@@BA@B@@@@B@@@@@@@@@B@B@@@@BB@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@B@@A@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@BB@@@@B@@@@B@@@@@BB@@@@@@@@@@B@@@@@@B@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@BB@@B@@@B@@AD@@@BB@@B@@@B@@@@@@@@@@B@@@@@@B@@@@@@@@@BB@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@B@@@@BA@@@@@@@@@@@@@@@@@B@@BB@@@B@@@@B@@@@@@@@@B@@@A@@@@@@@@B@@@@@@B@@@@@@@B@@@@@@AB@@@B@@B@@@@@B@@@@B@@A@@@B@@@@B@@@AB@@@@@@@@@@@@@@B@@@@@@@BB@@@AB@@@@@@@@@A@@@@AB@@BB@@@@@@@@@B@@@@@BB@@BB@@@BB@@@@A@@@B@@B@@@@@@@@B@@A@@@@@@@B@@@@@@@BB@@@@B@@A@@@B@@B@@@DA@BB@BABB@@@@@@@@@B@@@@B@@@@@B@@@@@@@@@B@@B@@@@@@B@@@@B@@B@@@@@@@B@@@@BA@@@@@@@@@BB@@B@@@B@@@@@@@@@@@@@BB@@@@@A@@B@@@B@@@@@@BBA@@BB@@@AB@@B@@@@@@B@@@@B@@@@AB@@@@@@@B@@B@@@@@@@@@@AB@@@@@BB@@@@@BA@@@B@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@BB@@A@@@@@@@@@@BA@@@@@B@@B@@@@@@@@@B@@BA@@@B@@@@@@@B@@@BB@A@@A@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@B@@@@@@@@B@@@@@@@B@@@@@@B@@@@A@@@@B@@@@A@@@@B@@B@@@@@@@B@@B@@@@@@@@@@@@@BA@@@@@BB@@@@@@@@@BAB@@@@@B@@@@@@@@@@@@@@A@@B@@B@@B@B@@A@@@@@@B@@A@@B@@@@@@B@@B@@@@@@@@@@A@@B@@@D@@@@@@@@B@@@@@@@@@@B@@@@@@B@@@@B@@@@@@@@@BA@@A@@@@@@A@@@@@@B@@@@B@@@@@@BB@@A@@@@@B@@@@@B@@@@@@@@@B@@@B@@@@@@B@@@@@@A@@@@B@@@@@@B@@@@@@@@@@@@@@@B@@B@@@@@@@@@BB@@@A@@@@@BB@@A@@B@@B@@@@A@@@@B@B@@@@B@@@@@@@@@@@@@@@A@@B@@@@@@B@@B@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@B@@@@@@B@@A@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@B@@@@@B@@@@@@@B@@@@@@BB@@B@@B@@@B@B@@@B@@@BAB@@@B@@@@@BA@@@BB@@B@@@@@@@@@@ABB@@@@B@@@@BB@@@B@@B@@@@@@@B@@B@@@@B@@@@BBBBA@B@@@@BB@@@@A@@BB@@@@B@@@B@@@@@B@B@@@B@@BB@B@@@@@B@B@@@B@BAB@B@@@B@@B@@@@@BB@@@@@B@D@B@@@@AB@@@B@@@@AB@@B@@B@B@@@@@BAB@B@@@B@B@BA@@AA@@@@B@@@@@@@B@@@@B@@BA@B@@@@@@B@@@B@@@B@@@@BBA@B@@@B@@@@@@@BBA@@@@B@@A@@B@BB@@B@@@@@@BBAB@@@@@@@B@@@@BBA@@B@@@@@@B@@C@@BB@@B@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@BA@@B@@B@@@BB@B@B@F@@AB@B@B@B@B@B@B@@@B@@@AD@@A@BB@@@@B@@@@@@@BB@AB@@@B@@@@@@@B@@@@B@@@@AB@@@@@@A@@@AB@@@@@@BB@@@@B@@@@@BB@@@@@@@BB@@@@@@@@@BB@@A@@@@@@B@@@@A@@@@@@@@@BB@@@BB@@@@@@@BB@@@@@@@@@BB@@B@DD@@@@BB@@@@@DB@B@@@@@B@@@@B@B@@@@AB@@@@@BB@@@@@B@@@@A@@@@B@@@@@@B@@@B@@@@@B@@@B@@@@AB@@@@@@@@@B@@@@AB@@@@@BB@A@@@@@@@@@@@@B@@@@@B@@@B@@A@@A@@@@A@@@@A@@AB@@@@@@@@AB@@BB@@BB@@A@@@@@@@@BA@A@@BA@@@@B@@@@B@@B@BAA@@@@A@@@@B@@@B@@@@A@@@@B@@@@@@@@@@B@@B@@@B@@@BA@@@@@@BA@@@@@@BAB@@B@@@B@AB@@@@@BA@B@@B@@B@@@@B@D@@@B@@@B@@@AA@@@@@@A@@@@@@@@@@@BA@@@@@A@@@@@@BB@@B@B@@@@A@@@@@@@A@@B@@@@BB@@@B@@AB@@@@@A@@@@@@@@A@@B@@@B@@@BBD@B@BBB@B@D@BAB@@B@@@@B@B@@@@@@AA@@@B@@@B@BBB@@A@@@A@@B@@@@A@@B@@@@@@@@AB@BB@@B@@ABB@@@ABBB@@@@@@@B@@AB@@@BB@@D@@@B@@@@AA@B@@@D@B@B@@@BB@@B@B@@@@@B@@@B@@@@@@BB@BA@ABA@@@A@@@@@@B@@B@@B@B@@@@BB@B@B@B@@AB@@@@BB@@@@@@B@@@@B@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@@@@@A@@@@@@B@@@@@@@@@B@@@B@@B@@B@@@@@@@B@@@@@B@@@@BB@@@@@@@@@@@@B@@@@@@B@@@B@B@B@@@@A@@@@@AA@@@@@BAB@@@@B@@@@@BB@@@@@@AB@A@B@@@@@@@@@@B@@@@@@@@BBB@@@B@@@B@@@@@@@BB@@@@@@@@ABBB@BB@@@B@@@@@BBB@B@B@B@J@B@B@B@B@B@D@@@B@@A@@B@B@D@@@B@@@@@@@@A@@@@@@B@@@B@@@@@@@@@@@B@@@@A@@B@@@@B@@B@@@BBB@@@@@@@B@@B@A@@D@@@@AB@@B@@@@BA@@@@@BB@@@B@@@@@B@@@B@@@B@B@@@@B@@@@@@@@@@BB@@@@BAA@@@@A@@B@@@B@@@B@@@@B@@@@B@@@B@@@@AB@@@@@B@@@BB@@@@@@@@@@B@@B@@@@B@@A@@@@@@B@@B@@@@@@@B@@B@@@B@@A@@@@@B@@@@B@@@@@@B@@@@@B@@@@@@@@@@@@BB@@@AB@@@@@@@@@@@B@@@@@@B@@@@B@@@BB@@@@@@@AB@@@@@@@@@B@@@@@@@@@@BB@@A@@@@B@@B@@@B@@@@B@@@@@@@B@@@@@@@@@@@@@@@B@@@BA@@@@@@@@@AB@@@@B@@@AB@@@@@@@@@B@B@@@@B@@BA@@@@B@@@@@@@B@@A@@@@@@@@B@@@@@@BB@@@@@@@B@@@@@BA@@B@@A@@@@@@@@B@@@B@@@@@@@@@@@@@@@B@@@@A@@@@@@@@B@@@@@@@@@@@B@@@@@B@@A@@@@@@@A@@@@@@BA@B@@@@@@@B@@@@BA@@@@B@@@@@@@AA@@@@@@B@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@B@@@B@@@@@@@B@@@@@A@@@@A@@@@@@@@B@@@@@@AB@@@@@B@@@@BB@@@B@@@B@B@B@@@D@BAB@AA@@B@BB@AB@@@@A@@B@@@@@B@@@@@@@@A@@B@@BB@B@@@B@@@@@B@@A@@@@@@@@B@@AA@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@B@@@@BBA@@@@@@@@@@A@@AB@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@B@@A@@@@@@B@@AB@@@@BB@@@@@B@@B@@@A@@@@@@@A@@@@@@@@BA@@@BB@@@B@@@D@B@@@@@@B@@B@@@@@@@BA@@@@@@B@@@B@B@@AB@BBB@BA@@B@@@B@@@B@@@@@B@B@B@@@@BB@@@B@@@B@@@BA@@B@BB@@B@@@@A@@@@D@@@@@B@@@@@B@@@B@@BB@@@B@@@B@@@B@BAB@@@B@BB@@D@@@B@@BBA@@@@A@@AB@@@@@B@B@@BB@@@@@@@DA@@@@BB@@@@@@@@B@@BB@N@@@BB@@@@BBB@BB@@B@@@@@@@@@B@@B@@@@B@@B@@B@B@@@B@@@@@B@B@B@B@@@@BB@@@B@@@@@@BB@@@B@@@@B@@B@@@@@@@B@@@BB@@B@@@@@@@B@@BA@@F@F@B@@@BAB@@@@@BBB@@@@@B@@@@@B@@@@@B@@@B@@@BB@@@@@@BB@@B@@@BBB@B@@@@@@@@@B@@@@@@@@@@@@@@BB@@B@@@@@@B@@AB@@@@@@@B@@@@@B@@BB@@@@@B@@AB@@@@@@AB@@@B@@@@AB@@@B@@@@BB@B@@@B@@@B@@BB@@@@@B@B@@@@AB@@@B@@@@@B@@AB@BABA@AB@@A@AB@@A@@@A@@@A@@@AAA@@@A@@@A@A@A@@@AAA@A@@@A@A@@@A@A@@@A@A@@@A@A@@@A@A@AA@@@B@@@@@@@@B@B@BBBBB@@@BB@@@B@@BB@B@@@@@@@@BB@@@@@B@@@@@@@@@B@@@B@@@@@B@@@@@B@@@BB@@@@BB@@B@B@@@@@BA@B@A@@B@@@@@B@@B@@@@@BBB@B@BB@@@@@@@B@@B@@BB@@@B@@B@@B@@@@BB@BBB@@B@@B@B@B@B@@BB@B@@@@@@B@@B@@@B@@@@B@@BB@@D@@@@B@@@@B@@@B@@@B@B@@@BB@@@@B@BB@@BBB@@@@BB@@@BB@@BBB@@@B@@@BBB@@B@@@@@@@D@@BB@@AB@B@@B@@B@@B@@B@@@@B@@@@B@@B@@@@B@@@@BB@@@BB@@@@@@BB@B@@BB@@@@@@B@@@@@B@@@B@@@@@@@BB@@@@BB@@B@@@B@BC@@B@@A@A@@@@B@@@@@@A@ABA@@@@@@B@@@@AB@B@@AB@@A@@B@@@@@@@@B@@@B@@@B@B@@@B@@@B@DB@@@@B@@@B@B@@@BB@@@@B@@@B@B@@@BB@@B@B@@@B@BB@@B@@@@@@@@@@@B@@BB@@BB@@B@@@@BB@@@B@@@B@@@B@@BB@@@@@@BB@@@@BB@@B@BBB@B@BBB@@@B@@@B@BB@@B@B@@@@@B@@@B@@@@@B@@@B@@@F@JABABAB@BABA@@B@D@B@D@B@B@DBD@D@D@D@@@B@@@@@B@@@B@@@B@BBB@@@B@DB@@@@@@@@@@@@@A@@A@@@AA@@A@@@@AA@@@@@@@AA@@@@@@B@@A@@@@B@@A@@@@@@B@@@@@B@@@@@@@B@@@@@BA@B@@B@@@@@BA@@@@@@@@BA@@@@@@@@BA@@B@@@@@B@@@@@B@@@@@@@B@@@@AL@@@A@@BA@@@@@A@@@B@@B@@@@B@@B@@B@@@B@@B@@B@@@@@B@@@@BB@@@@B@@@@@B@@@@@B@@@@@B@@B@@B@@@@DFDDNFJDLEBALA^@P@B@ZCB@JKJInCP@FApMVF@@D@D@B@FCB@@AFB@@B@HBHDH@JDB@HFXLBBH@PHXHXHTHTJJBPAJGBAFAGMGEAIBIHOJKNGNALGPKNKNMLKNKBAFIHKFIFMJMDCLILIRKPKTMTKJILGB@HEJILMHKJGLGNGHEHCTMPINKNKJI@@FIFKFG@G@@AGEKGGGGEEAEAE@EBKDGDGFIHGJKHIJGJEDABAHCFAHAFCDEFECECIGIIIMIQIQGOCE@KCMCOGOEICICEECC@GDIDGHEJAPCLAPGLCNGHEHEHIFEFGHALEHAJCD@PETATCPCLEJG@AFCFCDGBEAICKCKIMEICKGKAC@GAEDEBGFEHCNCNAPCPEB@PELEJEFCDABCDC@I@A@E@EAECCEAGBEBE@@GDE@CACECEAGCCBOCEACEAC@CDGFELCL@VAT@J@JAH@FBP@LAN@JBLBJDFHJJDDHNDNAJAFGJAFAH@FBBJFFFLDLBNDLAF@HARK@FB@@@B@DAB@@@@AB@DABAB@@@B@@A@A@@@A@AAA@@@CBABABABABADABABA@@@AAA@@@AB@@AB@B@B@@ABA@@FA@@DA@@F@B@@@BABCDA@A@ABC@@@AAA@@@AB@@A@@@A@AA@CCA@AAACGC@ABGCEGC@@IEUCMAQ@G@AAG@E@CCDEBAJCFAFEJEJAL@JBF@J@NC@AB@@@@@B@@@D@B@B@@@BA@@BA@@@@BA@ABA@@@AAEB@@@BA@@B@@@BC@AB@@AB@BA@@DC@@B@D@DA@@BAB@D@@@BA@@@AAC@A@@@A@@CACA@AAECC@A@C@GAABC@A@@@AB@DA@@BA@@@ACA@A@A@@C@@AA@AA@C@@AA@@A@A@AAAA@@@ABCB@@ABAHA@A@@@C@C@@@@C@CAC@EACACAA@ICA@GAC@CACAA@GAE@@@@AAC@@@AAICA@@D@BAF@@AA@@A@@JCDAFABA@AB@@@F@B@BAFADA@AAA@AD@B@DCDABADA@AB@@A@A@@DA@A@@BADADAB@BAB@BAB@FEBADCDABADEB@@@D@B@B@@AD@B@DABAB@BA@ABABABAB@@AAA@@AC@AAA@@AA@@@AAAA@DGBK@IA@AGGIIGMIGKEKAC@AACCGAI@GEICKEMIKACCGIGIEKCKCMBCBC@MJMJ@BGHCL@JBJAHEJCHIJKDKBK@MASAOBUBKAMAIEIEGIKMEOEGAE@MAGCK@CAICKCIAGBGDKDEFEJCLEFEDG@ABC@G@M@MAGBGFEFKACGMCIEGACKKIGC@E@GBIDI@G@IAIAKEKKEIAECGCGBG@M@MAICECGMCIAM@GBKBEHELCJCNCPBLFNFTHLJPHPBJADCDEDM@KAM@I@K@MAMDMJGJEDKDIBEBMEGAG@K@G@MDKDMBM@QAOCIEICIAMAQ@OFKDMFKJKDKJKFKHK@KBIAM@MCMAQAG@IFGFGFK@GAGAIOAIAEAEECGCIA_CWAQCE@IAO@M@MBO@OBIDGHABGJCJ@F@JAJAP@LEHCDCDGBG@IAOEIAOAMBIDGLEFKHGDQFMBQDQDMDKNCHBFFFFJ@DABIBIAGAMAQCQAI@IAKBI@IBEDEHAHAHGHEFEBGBKHGFKJILEHGLKFKDMBO@MBM@SAQ@UAQAUAQCM@KCI@MAI@E@ODIBI@GAGCKEOEKE@@IAICOK@@IIGICECCECCAMCI@GAGAGEECCECCGCG@IBIBSBGBKCGCEEIEAGCAGAE@I@EDG@IAGCCCCG@O@M@OAGEKAM@OAMBKCMGAAAEAKBMJMJGHEFARDNBH@HIHIAGASBIDINGLEJKJSNUJOHMBSFKDGJ@NBHDFAFCHG@I@IAOCQAOCUBQBUFQJUHSD[HQDSDWHIBAJCFCDGJEFAH@HBLDH@FDF@JAHEJENIHGDQBOFMFKPEJGHOFI@IBUDKFEDCDHBFBHADBCBIHIJQBMCGBA@@@@@@BAD@D@BNBD@J@BBDABBP@D@HBBABA@@DHBDBDBFBBFN@@BDDDHH@HDBHDFBFBJDFBHDLCDCJEFCLCRCJ@LBNFHFFHDJDNJFDDJ@H@FALGHALBJBHBFFFHLR@PCHBPBPDNDHFNBH@BFJBJARBLEHG^ELGHIBGBGD@BADAJEFABK\\CLABSAI@K@G@A@MR_hOVILB@JDPARGJ@N@L@P@ZAP@P@RCFAJAHBL@LEL@HBFBDDDDDJBL@HBL@D@J@LCLEJADAJAFBLAJ@HIVADCJAJIT@JAHAVAT@@ENABG`AF@@@FBPBF@@@BHJRX@@EBABCD"],encodeOffsets:[[119700,43406]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
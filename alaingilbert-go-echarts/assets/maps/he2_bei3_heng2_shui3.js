@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("衡水",{type:"FeatureCollection",features:[{type:"Feature",id:"131102",properties:{name:"桃城区",cp:[115.67545,37.735465],childNum:1},geometry:{type:"Polygon",coordinates:["@@MCC@S@[BK@]BBNKBA@@AA@E@[BYDID@JABEFMAER@RAHAJADCHELEFCFADKNEFEDEFOLKB@@@JG@@FCBKBEGEAE@AGBEIO@AB@BAACEBCBBBGB@B@BMJC@C@@J@BE@AACACBC@@B@FAB@BCBGC@@EBA@GDBFBFDDHHB@AC@CBCBAPC@CB@L@BDBD@DBB@DCDCB@JHBJ@@@BAAKD@@G@AFCLDFB@@BCF@BBBF@F@B@@@N@PADCB@DABEBY@C@CDU@ABIBANAFGDCBCAIA@BDBFB@FF@BFF@B@@JJABJF@@FDDFB\\C@@BBB@AAJABADSLA@@JPBBF@F@PBD@@@JAHAFA@K@AD@@GD@BHBBB@HAB@@EF@B@@BBBDA@A@CC@@AAK@GFEB@@@@@D@DB@B@DBD@NDA@D@BB@REF@JBDBD@FBB@DBB@F@FBDFFH@D@NAB@BABCLADCJ@F@J@BBB@F@@BBDD@DCDBH@FBBD@AD@FD@NB@GBB@BfELABBDD@FD@H@DA@GAGHAFAAA@ADAHIVGL@B@B@N@L@F@JB@@@@J@RFHD@@NFANBB@BBHN@@@DJ@B@DBA\\@J@hCF@D@LAD@D@HCBAHCVEB@REDEBABC@G@CAI@C@AACAG@AAGAG@A@@ACAMBCDGDGBABAHIBAJGH@LABAFA@GPEHIBAFI@GBE@A@KBC@AAEB@BB@BJC@@AC@AB@J@@EF@CCFAAESMIMCAAA@ADADADBB@@BDB@C@AACA@AGAAC@GD@C@CC@IABDA@E@AE@A@C@KN@@@@BBBDBF@@AF@BABIBAB@BGC@@CEAE@BAAAQ@CBA@ACBEHGFCDI@AEEGCAAEACCMA@BEBEAAAC@@CIAC@A@MAEAW@KAC@QBGAGAOGKIEAEA@AEAA@OEICYEWEDLBFDFBLAJGHIBA@IGIGECIGGA@BA@Q@GB"],encodeOffsets:[[118422,38517]]}},{type:"Feature",id:"131124",properties:{name:"饶阳县",cp:[115.725833,38.235892],childNum:1},geometry:{type:"Polygon",coordinates:["@@GGKKA@GIKEKBOFMHM@@@SBO@KBGBKASAG@S@C@YFE@IJCP@FBJAJ@@BHBLAD@BALCH@@AB@BCFABCBKLBHFBAJD@PHB@LDB@B@DBJDNDJFBBJHDB@BFNBHBHBNBFBHHJBB@BBDFJ@JBLAJ@BAB@@@@MDMDA@@DFB@HB@@FB@JAB@DJBBBAB@@FH@@@BABA@B@FB@FBAJF@B@BEJFHBBBFBJBJDB@@@D@P@D@@A@ABC@CJADBAFJ@B@DBADCBAACBBFC@E@BLG@@DABEBCBC@@B@BEBCB@@ABAD@B@BDBH@H@DDABFHHFBB@@@D@FE@@B@BG@BHGB@JE@C@@D@DBBBHFBD@DAd@DBHFB@@@D@BB@JF@@LF@@@@D@BAD@BF@BABC@CHAFCFABBD@DCD@H@FBBDBFDBBJDBF@J@B@@BF@BAAI@EBAF@NAD@@@@@@@RATCNGHGB@RI@@B@NABB@@DBHA@@R@B@@DB@F@NAD@@CF@AGF@@CAAJA@AC@ACASCEBCBADBDI@IA@E@CAACAE@CG@CC@CEBAEC@ACD@@GLAAIBCAAF@@CE@AADIBARA@@@CFEHA@E@AH@DEJE@CAA@ECECAC@CEBCJAAE@CJAFKH@AOACBATAFA@IFAD@@AAGFAH@J@@U@AAI@@ACAAAAGCA@CEAGAEHCDABCJ@BM@@BA@C@A_DIAC@EA@@KEGEECAACAMGAAEAKEQGAGAGAGBCDB@@@EAAG@AA@@ABBD@@C@@HI@AFG@EBAB@BE@GAOBC@IAA@E@]CCAAAIEMGGIAAA@GAIAEAQAOBK@GBC@C@MAA@@A@@"],encodeOffsets:[[118480,39022]]}},{type:"Feature",id:"131121",properties:{name:"枣强县",cp:[115.724259,37.513417],childNum:1},geometry:{type:"Polygon",coordinates:["@@CGCC@A@@AA@C@@CEACCE@AAACICEGGAA@AA@AAAA@@AAA@IECCIFIFA@CB@@IHCBA@KJEFABCDABIJABADDD@B@D@BAB@@GBK@IHADA@@B@@@B@B@@CBE@@AC@GC@ACGAAE@C@ABADAD@H@FF@BBBB@BA@@BABA@@BGDAB@B@@KBABA@EAA@C@E@G@Q@@@A@E@K@GBABGBDDBDBDDD@@@@B@AF@D@DCBABGDG@A@C@ADCB@@E@C@@@EAGBCBOJAJA@FF@@FDBBBBLJ@@BFBNNCTBJ@B@HBJFB@HDFL@F@DAL@BAFCFUJGBKFC@EBCBK@G@CAGAEBEBMBEFCBCR@B@HAF@DCJ@BADPNGBEBCB@@@BFJBDDFSLCJAHAFIBBHBHHB@BHLBBBFDHFHHH@BJDP@DH@BDBBD@D@B@B@B@B@@@D@@@@D@FBHGDCB@PFJ@D@PFDBFHDLAJKBBLBFAJADBBDH@@@@@@@@DBDBFDHFDDDBJJFFBDFABNH@BCJFHDBBJHHHDBBD@NCFEF@BCNBB@BA@@DBBB@@DBBXFZFJDPFB@FB@BFBFBLJPHHBHBRAD@LBX@FBNBB@D@JB@DD@BBFBFA@ANBDDFBBBHDFF@BCJEDGHAFBDB@DAR@BBABF@DATGB@RCRGHEPMLGJCDALADAB@L@bBVFNDJBPBH@B@HDFJJAJEL@@@@@LAOQAAA@WSCAUKEAAAKEOEAACAAAIEGEGKDMKBEG@CFE@C@AB@@A@@B@DG@A@A@@BK@AB@@EC@AEG@@@AAAKDEBE@C@CBCFCFGB@DAAEHA@@@AE@@EGB@A@I@@BGACFEBBBE@@@@@@D@AGF@@GB@@EAAE@A@C@IF@AWBK@@K@C@AI@DI@@BA@C@A@E@A@E@AACCIA@ACACAG@C@A@A@A@C@E@@@CAI@ECOCMCGCCACAACCAACAGG@ACCEGGGII@AECGEECA@GECAMGCAAA@@C@SIIEQE@@IEAACCAACCAAAA@@AACKAACGAE@G@A@E@ACE@AACAC@A@ABCBC@ABA@A@A@ABC@CACCGAA@@AC@CDIBABEBAFGBCBA@@BG@G@AA@@AEGCGAA@A"],encodeOffsets:[[118563,38150]]}},{type:"Feature",id:"131103",properties:{name:"冀州区",cp:[115.579308,37.550856],childNum:1},geometry:{type:"Polygon",coordinates:["@@BA@EECA@KGG@C@I@E@EBC@GBMBMFEBEBKFGL@TADAHGLMNADEDEHIDKFEDEBGDEJE@G@E@GAGB@DABE@C@AAACIBWBC@CB@@QDEDIFEHCDEFAFGL@@ADA@QVABIHIBAB@@GBA@KDMAOJCBGHQLAFFHJJLDPBZABABABCD@@NJ@DEF@NB@CBCHD@BBAB@BDH@BMPFJDAJBDA@@@@@@BBBB@@GFEFFC@BDE@BBADB@BDHBCDIBBD@DAFABADC@@AASGBO@AD@DBBJB@DDDH@@@@D@@@@G@A@AC@AA@C@BDA@EBC@DHLBBBBDADE@A@@CEAA@K@@F@@I@BJ@@B@@@BHA@@BDFJAD@B@HEHAF@LB@KC@AAAAAEBADCD@BBDD@@DAJ@@@IFCLF@BBFB@E@C@C@CF@NADBBHFAD@D@@D]F@BBFA@IB@FAAAE@CCCGA@HB@DA@HABA@C@@@AAAB@FE@@FGAA@@HEBBDI@A@ATEFEBOBIBA@WFIFBHCDABE@EPI@@MAE@GAQFIBGAGGCGFIPCBEFEDEDMHEHCDIH@@KH@BMHABMHMFKDID@BE@C@A@G@MBG@C@UBC@UCIEMIIIAA@AAAAACFICKCECQLIC@D@BNFD@BBDBBJCFED@D@B@@CBCG@AABABADAFDHEJCBEAAD@B@BABCBC@ABC@ABFDH@B@AFH@BDHBD@DBRH@LKJBHBFLNPJPBFBNJJALAD@JCF@F@HBBGDC@AB@HAHABAJDDAFCDA@ABCBA@@J@FADBD@B@D@BABF@D@BGB@FDLD@BBBFCB@BDL@FBBB@D@VA@@D@@DBD@@BB@@@DBD@@B@FN@@@@@@DD@F@DKLGB@@@HBD@BHA@HBBBBD@HDFBBDBJ@B@DDBFDB@LCBAD@bGVCL@LDFDBBB@DA@BBHAF@@E@GFAB@B@FODADABG@C@@@BFF@FNH@FA@AJ@DA@A@EB@L@@G@A@AJAVEDABJBDD@B@FABCL@AC@@HA@CN@@AC@A@GGCCAEAEHCB@FA@@HDDA@ABA@E@AD@DADBBBF@@A@ID@D@NI@A@AHAAADAFABDABA@@BJPAFBHF@FBFHLADA@EH@@I@@LAPKFEFCFELMBCDEFEFKDGBCBIBG@QFQNBFEBA@IJCZC\\AF@B@@BB@LAAM^AL@\\AT@D@NDHAR@B@@AHBJHFDJHJHB@JAHGBIAKCEAECKAA@CA@AA@CB@@AAADM@AFEDE@MACCAGGIGAAGCIEADG@AMEBACEEIICACCGEECCACA@@@@@@@@CGAABCBIAEAKLABICKEGCAOEC@I@OEA@CDGHEAC@@@@@@C@@@A@A@A@A@CACCA@ACGO@IC@AGGEGCGAEAAGK@AGAAGAGJABEBGDITKCEACEI@A@@DAFAHAOMBC@ADI@CBE@G@ADQDAFENAFAFAHBDBH@L@DAFAD@LEHAVIDEBE@ABK@C@EEKGCA@IEGAA@I@SAMDC@IAECGBCACAAAAE"],encodeOffsets:[[118361,38222]]}},{type:"Feature",id:"131123",properties:{name:"武强县",cp:[115.982461,38.041368],childNum:1},geometry:{type:"Polygon",coordinates:["@@KAEAE@I@E@IAOAEAEDKACAE@ACEEACC@@CAAAAA@A@CBCBC@KAGAKHADA@CDBD@JBHF@@DD@@@@@@FJ@B@@DH@FD@BG@@FC@@BG@ABFBD@JF@BKF@D@HHBBABBB@BDBHBBADBDC@CD@DCBAA@AC@@@ADCAAA@@@@E@@@@@A@C@@ASAA@CDBBA@C@E@@DG@AD@BB@@HBDDDNB@DBBBJD@@D@BZCDLHC@@@@@@BNA@IDCFA@BHAFABCDMBDDA@OD@@ABF@BHB@@B@FF@B@@@@F@BF@FADA@@DBHT@@@@B@FAFLBD@@FN@@EBAEC@@DBD@DABBB^HBDDDHABDHABBFBBDBFRA@VD@AFAF@JM@ABK@BFBB@F@@CAADBHBHBHRHLFFBBBNHDBBBFDHFLF@@FBD@JB`C@B@DLB@BFDLB@BAFB@CB@@BBDCB@@AD@\\BD@DG@GBG@GAA@GDE\\CD@@ANA@CP@B@@ABAB@C@@C@AD@BD@BBGD@BCBAB@BABAFAB@DADDHFB@D@@@ACH@FB@@B@JHFF@@JBF@B@LAJJDBB@BAD@B@HDNFD@BA@ABI@CAA@AB@B@D@@@B@@A@E@EAA@CAECGEICA@ACACCGECACCECACAE@CBCDCBA@C@AACACL@@CN@@@@@AEJ@CGBAB@L@BA@S@CAABA@ACEEB@ED@JCDAAEDCFAB@BBB@JEEIBA@@AA@@IAI@CBABBBCDC@A@@AABA@@AEIAC@AA@@KJKLGDCFGDEDO@@BEBGA@C@EAEBCBWJKHEDS@EG@@CG@AA@A@CBA@@B@FEBA@ABC@A@A@A@E@CAAA@EE@AMAACBDCLABIB@BA@AECE@EAE@AAC@@B@D@DCBC@BH@DA@G@AA@ACB@BQBAA@@@ACCGICE@E@EC@@BEBACA@E@CBAEACGCC@EAAAEA@@AACCAEB@DAAC@EB@B@@AQKA@CAK@@@MAQA@@G@O@EAKAA@MA"],encodeOffsets:[[118654,38848]]}},{type:"Feature",id:"131125",properties:{name:"安平县",cp:[115.519278,38.234501],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@AIODIAE@AA@GG@EBAB@@@DBNCB@DE@@F@BKDA@BD@@@H@@DB@JCFI@@LE@@NAJQBM@C@@B@L@BCBBLBHC@BBC@CNBT@F@@AL@B@HAHBFFFFBFBBD@JAFGFGBMBBDGBBDM@E@A@AFBP@JBZJ@BCAC@@F@PBLBBBDBDHDDDBH@BB@FAFC@@BARL@@HD@BDD@BHBDD@D@BCNBN@JBDD@H@@@D@BGA@BAD@J@@E@C@AAAT@B@@AR@BGA@D@BHH@B@DP@DBDGA@LGF@DAFFBB@NB@@HFBHBJBBBDEHAJCJAFAP@FATCF@@@BDBBFBF@LCDAJABB@DAHA@GBEABHDFF@DA@ABA@@BBL@FD@@BD@@@JDL@BC@@N@@BB@@HAD@ACLANAF@BA@@@C@IAGAE@ABCAG@C@GBAF@@@BEEAAIBCDB@A@AE@@@@@BAFAF@R@H@D@@BAFB@B@BCL@D@BB@F@HD@BNBBB@DBB@B@ACHAD@BC@EB@@AB@VBBB@@@@@D@TA@C@@B@DB@D@@ADA@B@DB@LEBAJA@A@GAAB@D@HADAD@@EB@F@@DBBFAB@BBDBFAB@@FB@@@B@FA@CDA^CNCBB@FF@D@BDADAH@FP@B@@D@@LA@C@CD@F@@IHAAGH@@A@AF@@E@C@@AAGEEGBACCG@G@CA@A@ABCBA@@DAFA@A@AD@DAFABA@CH@AKF@D@AEDABBDABCCAA@I@BECAIB@DAD@B@BC@O@C@@@A@ICIAEAAAGAIEAFA@E@BIEAA@@E@AABAB@@G@@EA@ABAACIA@IBA@@EA@@GEA@CB@NCNC@@@@BA@ABIAK@IEIAC@AAAGIAGAEAMAGAGEM@ACAIGAAIEMCICCAA@A@KCA@OGC@BIEAAGEAOCA@BF@BC@AHAFIFGFEDQH@B@JAPI@SAE@OAE@CEAIA@MCGCMG@C@ACEC@K@IBABGBKAAGKCEKAAB@@@DEDCBCBABILEDM@A@M@G@A@E@CAQAIAKAAA@@AA@@@@@@AB@@@@A@AF@@GFA@EC@@CE@ACACGA@@@@GBUFCDADA@@@C@A@@@A@CBCBAB@FI@"],encodeOffsets:[[118182,39010]]}},{type:"Feature",id:"131127",properties:{name:"景县",cp:[116.270648,37.69229],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACE@@AF@AAI@I@BC@@GCC@@JB@DBBB@B@H@DBFEHA@IFSBOAC@WG@CAACAKCOAOCSEC@MAEAICEE@AI@EAA@@CIACC@@GAE@@HE@BHC@@@@@@@AFAAEFBDAH@@@J@BHA@FF@@B@@GBBFCBA@EHEDAD@D@DAFCFBLBB@@H@BFD@@FA@@BAL@@@B@BCHA@@@@BA@@B@DEF@DFHLACNHLHFJFBBDBBBPFLFBBFBVLDBXTB@BBPR@JBD@@BFBABDFA@ADFFLBLB@BHBBDBF@BHE@ABAH@FBBBBDBFABB@BBF@BDB@CH@BBBDBDAL@B@BA@@@A@AB@FDFBBD@@@B@@LBBDL@@G@CD@RB@AHAH@@AHBBFFJHRFVBN@PDNN@@BNBRN@DDFBF@N@F@JB@FLEDAJCBABAB@@@@@B@FAD@HABAFA@@F@@@B@@@DJDD@@LBDBB@@A@@AODAJC@AB@D@DBBFABE@ADAH@@@@F@BF@@H@@@AG@@CQ@@B@BB@@@C@@@ED@DBDLCD@FDAFABB@FB@D@@A@@AE@@@ADC@@BDBBDADEDBBHE@@DBDD@BD@@F@@@LAD@B@@AFAJ@@AJADAB@H@BBJDDBBBBB@B@D@F@@B@BB@J@B@BBBO@BHCB@BDL@BBHBDDB@DBBFFBD@@@@@BFAHA@A@B@BHA@@B@@EJAACBCDEF@LAP@DBLB@@D@BBJCJCDDDHDDDDBH@BBB@NCJADAFDFB@DFND@@HBB@BB@@RF@@BBB@BB@@HFJH@@HJLFHC@@@@DAVC@@@@D@HEHANCDARIDAB@RQBALGBAHEBAJEBAB@DAB@FAHBB@BAAEBAB@J@BBBBFAB@BDHAD@BFHABBDBBBFAFAFAACB@HAL@DADCBG@@E@@AFAB@@A@@CC@ABADA@ABA@AAAE@GEAA@AB@H@BAB@@@@CBADG@@A@ACAA@@BABC@CAA@@@ACC@@DI@A@A@@A@A@G@GBEECGGMUIIGCAACGSKIEECCEEEEACAA@A@@GGEECCAAKMAE@ACGACAC@AIQCGIMCEGIGICE@EACAGHEHGJ@@EAE@@AG@EBGCIACQIA@WAUCQC@@AQ@@@M@C@K@CCE@@C@A@ABAAA@A@AAC@A@C@@@@AA@@@BA@@DAD@D@HAF@B@BA@@@A@@AAEEA@@AA@A@A@A@CBA@A@@BA@@@AAA@AAECCAA@CAEAAAA@ABABAD@BA@ABA@@AA@@C@A@ABCBABAAA@AA@C@EBA@A@@AAABABABA@AB@@@@A@@A@GAA@A@@AA@@A@A@@DCBA@@B@@AAE@EAE@C@AA@A@AA@@A@@ABCDCBABCBA@A@@A@@@A@CBCBE@E@A@@@AAAAAA@@BABADAB@B@B@@@B@@A@A@@CC@AAC@A@@@@@A@@B@B@F@FAB@@@FBD@BBB@@@B@@AB@@A@@@@A@CACAEACCCAAAAA@A@A@@DC@@B@D@DB@@B@@@@AB@AC@CA@@A@@A@AAA@C@AA@@A@AA@A@@@@@@B@@AF@B@@@B@@@BA@A@A@@@@@AA@A@EAA@@@A@@@C@A@@@A@AAAAE@AAA@A@@AA@@@B@@ABADCBABA@@@@B@@ABC@A@AA@@@A@@A@@MD@BA@@@@@CB@BAB@@EDKHA@DHEBABEBCBCD@AA@@B@@EE@@@@@D@@A@BFCBAA@@@A@@@CA@BDA@AAA@CI@@A@@ADA@@@AA@AA@@E@@@A@K@A@@I@@FA@EDAAA@EA@G@@EAASBS@E@@HC@@HI@BDO@@DE@GD@BDDB@@@B@BDEB@BHHFFDD@BMBA@@BABIBA@@B@D@FBDBBFAB@@B@HA@@BAH@BIHCFOFMH@@CBABGFGLYFKAKCAAKGCAEE@@QK@ED@@A@AEEDA@@AAAGB@@@CCCC@@@@EBA@A@@A@CG@KACDGC@@GFC@MBE@A@ACCAABBDA@A@A@@B@@AA"],encodeOffsets:[[118840,38410]]}},{type:"Feature",id:"131126",properties:{name:"故城县",cp:[115.965874,37.347409],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACCCE@A@A@ABC@A@@@AA@@@A@A@@DCBA@A@AA@BCA@AACCIVCFAF@B@@ENA@AFEN@@ADGHKNEDEBGD@@@@A@CDA@EB@@@@CBGDEBGDWHCDABEDKJA@ENABAJAFCL@H@H@BADCXCNHPEHDH@FCD@BA@CDEDGD@FDDJFB@BB@@BBBBB@@BBBHHDFDJBB@BDFBDDF@@@DBB@@@BDDDH@BBBDHFH@BB@@B@HAH@@ABADEHABAFABCJ@DBD@@BBDHBD@DAD@B@B@BAB@BADAD@B@BBDBD@BDF@B@F@B@HBFDHBBDLBB@@BBBBDDBBDDBBJF@@RFJFTJD@@@BBDBNHDBHFB@FDHFFD@BJJHHFHDD@BHHDBBBDDBBBDDDDHDNDP@FBJ@D@@@F@D@B@B@B@DBHBDBDB@DJBD@B@F@B@F@B@DAB@@CJJ@@B@D@LL@XA@BJED@B@F@BB@FA@F@HB@@DDJB@DB@FBJ@@BFFJDFBNBD@TFPDPBLDDBBB@DXHD@PBTAJEB@FGAE@C@G@AAACAA@@ID@HD@@ADJ@J@BBE@@BF@BDBB@@@AB@B@B@ACBADBBDB@F@NAD@HE@@HDDCLBH@@D@BB@B@FA@@@@DDDD@@A@BHBB@@CBFF@B@BC@@FRL@@FFDBLHBBLDLBZEHKHEBADA@@NGPEDEJG@ABG@AB@@G@AA@EBAAAC@E@C@AB@JABA@AB@NA@ACCEEGG@AFAACA@@@A@CC@AHCF@@CP@ACJ@@GD@@GF@T@TABB@FH@B@@FD@AA@A@A@@@@ACDA@GB@J@@CB@BAB@@@JA@@@AA@@AB@@@AA@IB@@@@AC@A@@CEB@CA@@CEA@GC@A@@A@@A@@D@@AC@CC@@E@E@@@A@AB@ACDDF@A@@ABAB@RA@CD@@@D@@BD@@@@DD@@BBBJA@DB@B@@A@@@BBBB@@@@@@@@@B@@@@A@AB@@@B@BBDDBBB@B@B@DC@@@A@AA@@ACAA@GCA@@A@@BA@AD@@AB@DBBBB@B@BAD@B@@@@A@@ACCCCA@@@A@@@@B@@@@@D@B@@@B@@ABE@A@@BAB@DAF@B@BAB@@A@A@@AAA@AAGAE@CAA@@@@A@@@A@I@A@@@A@@AA@@C@KBC@@@A@@A@@@@BABC@@@A@A@AA@@@A@ABA@@B@@A@@A@AA@@A@@AAC@A@AA@@@AAA@A@@@ACEGGECEEEEA@@@AA@@ABE@EBC@CBC@E@A@CAEBC@C@CBCBCBC@CAC@C@E@CBCBCB@@ABA@CDABAB@@EDCD@@CBCDCDCDA@ABAAA@AAAAA@A@AAC@C@AAA@AAC@CAA@EACAC@C@C@A@A@AAA@CAAAC@C@C@A@GBC@A@A@CAAAAAAAA@AAAAA@C@C@CBE@EBCBCBC@CBA@C@A@C@A@AAA@AAAACAA@AA@AA@A@E@A@C@@@A@@BA@@@GBEAE@@@AA@@A@@@@@@A@@@AD@@@@AA@@@B@@@BAB@@@@@@AA@AC@@AABA@@@@AA@@AAC@ABA@C@A@@@@DAB@@@@@B@@@@A@@@ABA@@B@@A@@A@@@@@A@@@@@A@@BC@@@AA@ABE@AAA@AACACDCBABA@A@@@@CA@@@@A@@@A@@AA@@A@@AA@@@@@B@@A@@@AC@AAC@AACAMBEBC@A@@@HCDCJC@@AAAAEAC@OAE@EBE@AAA@@@@AAAAAC@E@A@A@AAAA@@@ABABADAHEDC@A@@@AKCA@@@C@@@A@C@@@A@@A@CBABABAB@@@@@DBHBB@B@@@@ABC@@@AAACC@A@@@A@E@AA@@@ACCAAA@@AA@A@AAA@@A@A@@@A@@CAA@A@@@@DA@@@A@ACACCAA@A@A@@DCDEB@BABC@ABC@@@C@CAEI@C@AA@A@A@@B@BC@@DCDA@C@C@@AAAAA@@AA@A@A@CA@AAC@@@AB@@@B@B@@ABAB@BABA@C@A@AAAAAECCAACCAA@@AAB@@@@AB@@B@@@@@@@ABA@@@@@AA@@@@@@@A@@@A@@@AB@@A@@AAAA@AAA@AAC@@AAA@C@CBA@@BABCBCBA@@AEAGCECECAA@@KHA@@@@A@EAA@CB@@@@A@A@@@@ECCAAAAACC@AA@A@@B@D@@@@A@@@A@CAAA@@@ABA@@@A@@CE@@@@@@B@FA@A@A@AAC@AAAAE@C@A@ABABAHEIA@@@A@AB@B@H@B@@CAA@AAAA@CCCCAACECACA@@HCBBBAACKFICDECAA@GDAAAACCAAAAAAAAAA@C@@BEAC@C@A@EBCBCDCDE@A@A@E@A@AAGAC@ABADAB@@@BCEB@AC@A@A@@AAA@AAC@A@AB@@@B@B@@AB@@AA@@CB@@CIBBDA@@@AAAACEAEAAACCAAAC@AAA@@A@@BCDAB@@A@G@C@AAC"],encodeOffsets:[[118649,37965]]}},{type:"Feature",id:"131128",properties:{name:"阜城县",cp:[116.175262,37.862505],childNum:1},geometry:{type:"Polygon",coordinates:["@@HJDHB@BDFLJLFDDBNHBB@@@@BBFBFBHBP@@@D@F@B@F@F@DAB@@@B@D@JAB@B@@@REPAB@@AB@DAHANA@@B@B@B@@@JBF@JB@@B@B@@@D@@@@@DB@@@@B@@@@@H@F@XBD@B@D@@@HB@@@BB@BDBNNG@CAAAGBAH@DABHD@@BBDAFDHBBFAAGDAHABEAABCFBDABDD@BFHBACLAB@@BBBDB@@HBBA@A@AB@LBJBJ@BBJAFBB@Z@B@F@BMAABI@EBIAABC@A@I@A@A@ACE@A@ADE@@@CGGAECE@E@AACEG@AAEAE@AAGACIGAC@C@ABE@ACACACA@@AAAE@C@CBCAAAA@@EAAAAA@A@ADC@C@A@ACAC@E@C@AA@A@@@ADCB@@ACA@AA@ICAAA@ACA@@C@A@@AA@CCE@C@ABEBC@AAACAGCA@AAB@HEBADEBAA@@@AAC@CBIBCBAACCA@C@A@IFA@@@AA@G@@A@@@EBCBABCDEDA@A@AA@@@OAC@@@A@@BCBAD@DAB@BA@@AAGI@@@@@AFA@A@@AEAC@C@@HABABA@AAAACEG@@CEEG@@@BA@EB@BF@@@AHCDCBK@GBA@BDEBEBEBAACAAAGBAEC@GBACA@EBAAAAI@A@ABBFABA@GAEBA@CBA@ABIFABGFABKHABQRA@CBQJCBMDGBGFC@@@@@UDCB@@@@GDKEGI@@IGGE@@AAA@AA@@QE@@AAA@GA@@MCCEA@CEBEBCDI@MAA@AAGCCCCCGCCIDIDAAC@@@KACAO@KBE@CFADBDIB@FA@@@GB@A@A@BGBEB@A@@@@ACEEAA@CCAACAG@ACK@ADAAGP@AA@A@A@IAAA@@@@E@C@AAAAACAICAAG@A@CBIB@BI@EB@BA@C@KB@@E@@@ACC@AC@CF@AGCACFCBAAAC@@CD@B@@BF@@@BC@A@@EAAEBCB@EDCCKCAC@@F@@@D@@AAA@@@DR@@BH@@G@@@AEE@@@@@BGBCF@BAAECAC@A@@BIDCBBP@@@BA@CAKA@@CCCI@@A@@@E@@@EBABGBC@EBA@@@@@A@ABABIDCBKFPHDD@BB@DDDD@@BBJPDDB@FABBB@BB@D@@@B@@CD@@C@ABC@@@ABA@A@C@@@@@EB@@ABABAFCFA@ABCBA@KFABCDABADAB@B@B@B@D@B@@BJHJDDDFJLHJ@B@@CDID@BEFG@CBAAEIEECAIBA@@DC@A@CCAGE@@DA@AEAAEBC@AFCDA@C@@BB@@FFD@FFDBDFCACBA@@@@BFD@@CD@DBBB@BB@J@HB@@@DABMBDLIBBH@BC@AF@DDDB@GFCBABCDNA@@@@B@DA@@@@DAB@@@J@TLL@D@N@D@B@B@JB@BLBB@T@R@BBB@JD@@FBBEF@DBDBHFBDB@B@ABB@@B@BB@BDBDBDDHBD@FDLEHCHBJ@DL@BDBFDDNBDB@@L@JAVAHEDAJIHGBABANDB@B@RFFJTHLCBCF@@@@TBN@FNADQDQNHNF@@NHBBHF@@HDBBFHBB"],encodeOffsets:[[119104,38897]]}},{type:"Feature",id:"131182",properties:{name:"深州市",cp:[115.559574,38.001535],childNum:1},geometry:{type:"Polygon",coordinates:["@@GA@IDADC@CAA@CACACK@A@@DODABAD@DBDD@@BM@@DGB@@BDK@ADEBA@C@ACAICBUFIB@B@B@HK@A@@F@BCBI@@BEBG@EME@AEA@ACGBIBEB@BCDBDGBEB@DBHADG@ABBL@JDADDDDHABB@@D@DEB@@DDBB@BD@HD@BFHA@H@BABGHABAD@@KB@@M@APA@@D@BBPBBABADBFDLBFB@FABBBB@BBF@B@DBPCJ@BB@BB@B@J@BBFB@F@@DB@D@DB@G@@BAF@AJB@T@F@DBABAFF@BH@JEJBD@BC@WBCB@DS@CB@@EB@FBJD@@B@NF@@R@D@@@@D@HCJBHBFBDDBBCBDLB@D@BBBNDGBCVAH@DDBFB@BJ@DCA@H@B@DBABBAND@H@HBFAB@@@FCFC@CFA@@JADABDH@DBBBBF@B@@BDDAFLBD@@FAFDBFA@EBCBCB@B@@@H@F@@@D@@BDBFEDIDEBEB@BBH@DC@BD@DBBBBDBJBNBHACFBFG@@@@@ABG@@B@DE@KBACCBC@A@@AAA@@AB@@@B@B@@C@@BD@@BAH@D@@H@BB@DHADBDBDDAD@D@@@HABE@@DBB@DHALBBBBH@DB@CJFN@BBF@@D@BDBL@FG@BDLDJ@BBBHDDH@@BDJ@DADCBC@IBBFCBCBE@@HE@BCA@A@@@ABA@A@AACC@@G@EB@HE@@B@B@@A@@B@@@@B@@BB@BBBLBJBR@D@F@B@H@N@BCNKFAJABADCDCF@@A@BBFLLDBHLBHABAJAL@D@DF@B@DNHHDNDB@BJDFF@PBF@TBJ@BO@I@ARGFCHEJEBEBGD@@AAEB@PDFBLKDABADE@ABA@@DGBK@ABCAKAG@@BIAI@EDOJIF@ZED@T@H@TBLBHALAP@TA@@N@NGPELALFHJB@LLHH@@@BB@NBD@D@HAL@PARBFBJBHBB@BBHJNHJFBBDB^DF@B@JBD@PAHBF@@ABAFAH@BEJ@@GD@@@ACBA@@BBH@AEL@BAN@@IBEBEC@@UQBAEACEAAAGBACGBCCAC]GAABA@CAC@CD@BFFA@@EM@@ACEKEBA@@@@@GSCA@@CBEBE@@A@E@@A@E@@E@AA@AGE@BA@@PCB@CCNADCBABEAGB@DEJCB@AM@@@@@@GDCKYD@A@CC@AIAA@CMACCAC@GA@@ABCH@@CF@D@B@AADCB@TB@BD@B@@@@@F@@@@@BBDBBC@@D@@BBBDA@CDCD@ACBCAAAGACA@AAABGA@G@CLE@AIEC@EABAH@@AD@@EH@@AECG@@CA@I@@E@@@@C@@CE@AG@IACDCB@BCLGAEAE@@@@@EEIACGGICEGAACAGCIEACGDCG@@AA@A@@CGAGA@@CAI@KAC@AAG@C@G@C@CDIBEFEBAC@C@KBC@E@gDI@[@AB@C@ACI@@M@AG@AAABMME@@GCQEI@@@@@IAE@K@M@A@A@K@UHGJCB@BBBEBGBBH@HCBG@C@@ECCAAKBeF@AAA@HMAC@@EBCC@AA@EAGDC@CCCAA@@@EAA@A@I@EDIBCDKBA@ABA@M@CEGCEEAE@A@CAA@EAC@CAIAE@QFA@@A@CCB@MAC@C@ACAC@@@@@A@EF@HBL@BD@@D@BCBAA@AA@E@@FA@GBA@AAAGC@@HC@@B@LEBGBIB@@C@OAE@E@AAIO@@KBCTABIBBBA@AA@@[DEACC@EE@AIIB@IA@E@AEE@@EEACA@AJBDBDAHCBEBMJABAV@DCD@Z@FABA@CDABC@O@M@@@A@EAEAAE@AD@@EAKCED@B@HC@BLAB@@I@"],encodeOffsets:[[118228,38635]]}},{type:"Feature",id:"131122",properties:{name:"武邑县",cp:[115.887531,37.801665],childNum:1},geometry:{type:"Polygon",coordinates:["@@GCA@G@OAIAMCUEaAK@A@CBKBCBIDKHONGFQHQDA@SHCBFB@DD@AHA@ABAJABE@@BE@CAAA@A@@M@@L@D@BBFF@B@ACJBD@@D@DHCD@BBBHB@BD@B@DCA@AA@CACBCB@BBBDBJNTNBFEBDDE@@FI@A@@BBD@@ID@AAAA@BF@BAD@L@BAF@HEJABGJOF@HEBABKBG@IHABGJABABCHCHADBNBD@@@BBHBH@BBHBD@B@DBJ@D@HADABCFQFA@UFGDABGDABEFAFCJ@D@D@H@DBH@BBD@LBJ@DB@BHDH@@@BBB@@DHHCBDJFHDDBBBFHJDHHBDFJ@F@@@@BFBFHBLBD@DADAB@B@BBBB@DD@BDFFBDF@DBLBFCFBPBJBF@J@F@FBLBNBB@LBFBP@H@@@RBNB@@L@DBB@RL@BA@A@@FBDCBA@BFDDBB@@FBBBFBD@HDBDBFDAF@B@BDFA@AD@@F@FDFHJDD@B@@BBRA@ADA@BBBH@B@@CAGD@DA@C@C@AD@BBF@FBF@FD@BABA@AJKBCDDABBBNF@@FBBDBF@B@B@B@D@BAB@FA@E@AB@DAB@B@@BDH@@FHT@FCLGXIDAFAFBD@B@DC@ADEDILKLMHKBA@ADCFCLIDCBBJ@PAL@@A@AC@C@AACMA@IGAA@AACCEA@@@@@CA@BA@AAA@GIEM@ISKI@@@A@CB@@@@CBA@@@@@MBDCBADAHEA@CC@CBED@@AAGJACKNABA@C@@GAI@A@@AAACAC@@DC@AE@@@@ABBDEDACEC@EEC@EA@@AD@B@DCBED@FABBBFB@@CF@BHDDB@D@@CB@JADBFFFJBBDAH@FE@AJCDC@@@AGIIKCECCGIAI@@@A@C@A@A@ABABCBADCBALEB@DABAB@DEBEBABA@@FA@@@@D@B@B@BA@@D@BAD@@@DC@@@A@@@CAAA@AAEBA@CCIOAA@@CCCCA@@ACCOG@EIAE@M@E@EACCM@AQAM@@MMOCM@UAQEIGEEAABG@@BGBGA@@QDCH@@@CKAA@KA@@@C@AACE@EBAB@@@B@@A@ABKACACAAG@@DCA@AAE@AAAEBCAAAAA@EBGBAF@AGE@CAAAAGA@AKEKCE@BEBACABAE@@AC@IKB@@@@K@IFIBEI"],encodeOffsets:[[118743,38541]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
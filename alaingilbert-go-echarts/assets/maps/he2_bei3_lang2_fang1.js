@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("廊坊",{type:"FeatureCollection",features:[{type:"Feature",id:"131002",properties:{name:"安次区",cp:[116.694544,39.502569],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@JAB@BE@CFB@ABCBB@CBB@AB@@K@@@AA@@@EDCBDG@@DEB@B@ADFB@AHCFAB@ABHB@BAF@@@AJB@@B@LEHCFA@ACBBK@@A@@AHCB@BCPJ@CDB@ABB@@BBDA@@B@@BB@ABRDDEHEB@@@LDBAAA@@DAJCIMKFCABA@ABCGA@A@A@@CA@@A@A@@AA@@A@@@AC@@AA@AABAA@@COAADCAA@BEE@BKA@@AEAA@@@@CACDCDBBEC@@A@@@AFB@CAA@C@@@AB@@AA@@@BE@@DEA@BE@@BC@AA@AFEA@A@A@A[C@BK@BEB@@@BCA@BCB@B@@A@@D@BA@@BA@@@@JBA@HBBAB@@AGABC@AI@@CE@@BEA@EBCBBDKDK@CB@@AA@B@@A@BB@HBB@BA@@@@B@LBHHDIFFDDDDB@ABD@@DH@ADA@@DAB@DBB@ELB@BB@@@DB@@DB@AB@@AF@DE@AA@CBA@@CA@BEEAB@@@BE@A@@@CB@@@B@ADB@BE@@@@B@@ALBFC@@B@@@@@B@@AFBBGA@@@BCE@BEBM@@@C@C@AAAAC@AC@E@DQ@@A@@AEAADEAQC@@A@@A@@@EGA@IHB@ELBDQBG@EBI@AIA@@FKHOECBA@O@GB@@@D@@@BA@A@@B@B@@@B@DD@@@EB@@IDAD@@AD@CGB@@@D@@EI@@@A@@A@@@A@@A@A@AA@B@FA@@BA@@@@@@BA@@AA@AHEBCADIAA@OA@E@@B_C@ABQH@DG@@@I@A@GB@@A@A@CAA@AB@@C@ABAAMA@FCAE@CBA@@BA@CAMBACAACC@YCQCA@C@C@A@S@@DCB@@A@A@A@AD@FA@A@C@G@AACAGIC@DGBE@AC@@@@B@DCDA@DEAA@@AF@@EBC@C@@ACBBFA@A@ABABA@GBA@@@M@@DAA@@@@@@@BAF@D@@C@AABEC@E@AB@F@BS@@JE@EBKDIFC@E@EAI@@J@F@DFB@DBPHFFFAFDBHBBDFDHL@PINW@E@@@C@OFA@GFIHK@C@GBA@A@IF@FBDBFINEH@H@F@@AJLLMJDLBJBHBH@DBFBHCHADBH@B@D@@AF@BA@C@CACHF@BB@HH@@BAB@DELQAAJBDB@H@@@@BI@@BB@B@AFA@AB@F@@@H@@@LB@BDEN@DH@@HADADADADADIJGHABOLMPGJ@@ABIJDJCD@BABEBM@KDIFEDGHCBA@I@C@@HBLA@@D@DABACAB@HBBGNFBFBADNB@JTH@BCR@H@@@BBBN@@BH@BADADAB@DAB@F@HBBC@EF@F@@CB@H@HCJDBD@DAJ@BA@CDABA@A@A@A@A@@@AB@BAB@B@@@B@BAFCAS@CAA@CBAB@F@F@FE@EBC@C@C@A@KAGBBFC@@F@BF@ALF@@HDB@F@@@BXO@JLHNB@BJEHCHCPGFC@@BAB@BABAFADABA@@@@LEDAHEB@@AHEB@BA@@@@@@@@FA@AB@DA@@BA@@DA@@FCHC@@FC@@BADAB@B@BA@@DAB@@@@AFA@@BAB@FCDADAFCDAB@@@B@BADA@EB@FCDC@@B@HAD@D@FCLEDAKA@APBDAGABQLBD@FBBCJB@A"],encodeOffsets:[[119589,40415]]}},{type:"Feature",id:"131003",properties:{name:"广阳区",cp:[116.71069,39.522786],childNum:1},geometry:{type:"Polygon",coordinates:["@@FICABADC@A@A@@A@E@DIA@CBA@CA@D@@ABD@@BA@@@A@@BHBABB@ADGC@ACA@BEA@AA@@AB@@BBAF@@@@@BAAA@BA@@AA@B@A@@AD@@AA@@@F@@AB@@AEAADAA@A@@ABAA@@A@@BA@@BA@BEA@@A@@@EB@BEABA@BCBA@@@@B@@@DA@A@AB@@@B@@ABABA@ADB@CFCC@@@C@BEA@@A@AA@@@CAA@ECA@A@@@AAA@@@BAAAB@@@BAA@A@C@BACAB@GEBGE@ACA@@CA@BGFB@ED@BAD@BE@AB@BC@EH@BKDABAB@@@F@JBB@@AH@AF@@@BFDBC@@@@FKEBBKDM@ABAA@@AC@@@IA@@EA@@D@@@G@@@DQB@AA@BCB@CE@@A@@J@@C@A@@B@BABGA@BC@A@AFA@@A@@IA@A@A@FA@@A@A@AAABABC@A@@CC@@C@@@@@@@@IAEAGAA@BCE@G@@AAAABC@@A@ABAE@@A@@DAE@@BIAADEAC@KAARHBCBOA@BLBCBKFEDC@C@GBA@@@CDEDA@@FCBABA@@@A@CBEDCBCBEDA@AB@@EB@B@@A@CB@@ABA@A@CBAB@@ED@@GDED@@CB@@AB@@CBA@@BEB@@@@@@@@ABA@GF@BA@GFCBKF@@@@ABCBEBABABA@AB@@EDOHGDGDIF@AMAKG@IWP@A@@@ECA@GE@BKE@@A@ED@AEHALBB@D@D@D@FAF@@E@E@EBADAB@DBT@DBBE@A@A@@@ABA@ABA@@B@B@B@B@B@BADCB@@ABI@CACICGDG@A@@DE@E@@FADGAE@A@CBA@CBCBABG@@AM@AA@A@@@GDQ@ASG@IMABCEAEAHMAA@GBABDBA@CIDKBQA@AE@EBBBIFDHB@BB@DICCEABBDC@C@ABGL@@B@C@OB@C@@AAG@AF@BA@AAEA@C@@@ECAAAGAW@A@E@O@UCKAYEUMAA@@@A@@EA@AE@CBO@DF@@@DEAEJCF@@AH@B@@B@@LABADA@@@@@C@@@DA@@@@C@@HC@C@A@@B@@A@C@@AA@@A@@A@BA@AC@BAIA@AA@@BA@@DC@@@@B@B@@DB@AF@CJADA@AACCCAE@GACA@@AB@DB@ABDB@@@DDABB@B@DBF@BBBB@H@CFADCJGAAFI@@DA@A@@BB@@B@@C@@BEB@B@B@D@F@H@DA@@BD@@@D@@A@@B@@@C@@A@@D@@@B@H@@AB@P@B@BCD@@AA@@A@ABC@@B@@@@A@@@@AAA@@EBA@EF@JBBEDBLBFBAD@B@@@@@FC@A@AF@BD@AFC@@BADHBR@F@B@@DL@@A@@@A@@JB@D@DH@@D@@B@@BF@@BB@@@@@B@B@B@@@B@@ABB@BA@@BAB@@D@BBAH@@AB@@@@ABA@@D@@BB@@ABC@@@@DA@@@AFD@@A@@J@H@@A@@B@@@FB@A@@BBD@@@@@B@@E@@B@@@F@@A@@D@@B@@JBFA@@@@@@D@B@B@@@BB@B@@@BAD@B@@B@@@@B@B@D@@@@@@D@@D@@@B@@@@B@B@@@@BAB@@@BB@@@@@@@F@HB@@@B@BABB@B@@@B@@@@@@BAB@@@@B@@@B@@@BBB@@@B@D@@@@@B@AB@B@BADA@C@A@C@@F@B@@BBB@@B@@@@B@@@@@@B@B@@@B@@@@@@@@@@A@@@@@@@@D@@AB@B@@B@B@@@AFFB@DFB@DB@D@@C@@D@@AD@@@B@@ADAF@BA@B@FB@@@AF@@ABFBABDBBE@@@A@A@@C@BE@@B@@@B@@@F@@BB@DBD@ABHB@BB@HB@@@BJBABB@@@@@@DABD@@BA@ADDB@ADB@@AB@BA@@DC@@HF@@B@@B@TA@HF@@@F@@@E@BA@@D@@@D@@AB@BC@@B@BBBB@CB@AD@@BED@@A@@DBA@HBAFD@B@@B@BB@J@@A@@@A@C@ADAB@B@BIF@@@B@@MF@@@B@@A@A@A@CB@D@@AD@@AF@@@@@@ANB@DB@@BADF@@CHB@B@@BEF@BEFBAHB@@GD@B@B@@AB@@B@@@@FB@@@BABB@@@@@@@DBD@@B@@B@F@J@@C@@HAJAB@@AB@@BB@BEB@@BB@@BF@B@@CDB@BFB@@FKD@DGB@BC@@B@@A@@B@B@F@D@@@B@@@HB@@@B@@FB@@BAB@B@ABFB@B@@@AB@@@@@@BADB@@CB@@A@@@BD@@D@@AF@@@E@@@BA@@DA@BC@@@A@@AFC@@BAB@ACB@@A@@@F@@@EB@D@@AB@A@@@@CB@AA@ABB@AB@BCBA@AB@@A@C@@BC@ABA@@B@@D@AD@B@@@@@@A@CH@F@@@@JB@EFBBA@AB@BB@@B@B@@BD@@BB@@@@BL@@DFB@@DCB@D@BED@@CJ@@A@@@A@@F@@BF@@@@D@@BB@@FA@@BBDAB@D@BAFA@@FCB@BABAB@BCFCDA@@@CHA@ABIFE@EB@B@D@F@@BBB@BJ@@BA@@LFACJB@ADADF@DBD@B@@AJBADHBBAHBDB@@"],encodeOffsets:[[119616,40567]]}},{type:"Feature",id:"131022",properties:{name:"固安县",cp:[116.298657,39.438214],childNum:1},geometry:{type:"Polygon",coordinates:["@@QDA@KDEBKBSFEFCDKJCH@BCL@H@DAFBB@D@F@N@B@HBB@DBFBDBJI@@@QJA@IAIJ@DAH@BDHBBDFDHAFBFH@BDBFDH@DELADAD@DJTFH@J@J@H@FBH@HBFBDBF@DBF@NBD@@@DAB@JAFAFEH@BEJCJGHCDEBE@C@@E@AECCAEBC@@DABK@AAKBC@G@IAG@EBC@CEG@A@AFGDADAI@@CBAB@BDD@B@BC@@B@BCD@@CAGB@F@DAF@D@DBBBDFD@BBBDBDFDF@BBF@BBB@BBB@B@B@BBFBD@B@H@B@DAR@H@F@DRA@B@DE@BBBDIBDHBDDDBDDFB@@BDF@LBBBD@BFL@DD@J@@@FBB@BDBDB@DD@BJ@@LF@BDB@FABB@N@BDDH@H@BJ@@ZD@HB@@DDFBAJBB@J@FA@CFAAKFBHDBBBDDJJH@BDAH@@@BA@@@A@@DCBAD@B@@A@@@@BAB@@@BA@@BABA@A@@@@BA@A@@@@@A@@@@@ABA@@@A@@BAD@FADCB@DAF@B@B@D@BABABC@@BAD@B@@B@@@@@@@@@B@@@B@@@@F@@@A@@@BA@@@@@@@@@@B@@@B@@@@AB@@@B@@@@@B@B@@@@@@@B@@@@@@@@@@@@@B@@@B@@@B@@@B@@@BA@@B@@@B@@@@@@@@@BA@@B@@@@@B@@@B@@@@@@@@@@@B@@@@@B@@A@@@@@B@@@@@@@@@@BA@@@@@@B@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@B@@A@@@@@@DADABA@EBABADAB@DA@A@@@A@A@A@CB@BADAB@BA@@@A@A@@DCBA@@B@@AB@BAB@@@@AD@@AB@@@B@BA@@@@B@@@B@@@B@@@@@B@B@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@A@@@@@@@@@A@@@@@@B@@@B@B@@@B@BA@@@@@@@@@BB@@@@B@@@B@@@@B@B@@@B@@@@@@@B@@@@@B@@@B@@@@@@@B@@@@@BA@@B@B@@@B@@@@@@@@@@@BB@@@@@@@@@@@A@@@A@@@A@@B@B@B@B@@@B@@@@@@A@@B@B@@@@@D@D@F@@@D@HA@@D@B@B@@@D@@@BBBBD@B@D@BAD@BA@AB@B@B@D@D@DBB@BAB@FAB@F@D@@@BA@@@@@@B@B@B@BAB@B@BAD@@AB@@AD@BA@@B@BB@@@@BB@@@@BB@@@@@@B@@@B@B@@@@@@@@@@@@@B@@@B@@B@@@@B@B@@@B@@@@@@B@@@@B@@@B@@B@@B@@@@@@@B@@A@@@@@BB@B@@@@@@B@@@@@@@B@@@@@B@@@B@@B@@@BB@@@@@@@@@@@@@@B@@@B@@@P@EM@A@@@I@@@@@ABAB@BA@@@@B@@A@@@A@@AA@A@@AA@A@CAA@@@E@AAC@C@C@@C@@C@@@EDEFAJ@D@@BBFAFD@@@@@@@D@@MDEH@AG@E@@BB@BFB@EAACEFA@CGBCAGC@AAAC@ADEEAA@G@CAAI@ACGCCBM@@EEBC@CCA@GG@@OMOIIGGCGC@KOCGDKIKMBCC@@GA@@I@E@CB@BABE@CB@@C@CF@BCLBB@BCFBBCBCGCBE@ABK@CAA@EC@@FA@C@BEC@@A@ABIDE@CE@@A@AEAA@@A@MACA@@C@I@E@BI@@@@@@CC@@@@GH@AIAACADCAA@ABCDM@G@A@A@@BI@@DA@AABACAAE@AICBBFK@@JO@@@CB@F@DEAGAACC@AEA@@E@AGCKGIEAAAEC@E@GEAAKEUIIDME@I@AAGACDIBIBM@@AI@A@@DKEGU@C@C@QGSBKB"],encodeOffsets:[[119047,40079]]}},{type:"Feature",id:"131023",properties:{name:"永清县",cp:[116.50568,39.330689],childNum:1},geometry:{type:"Polygon",coordinates:["@@DU@CBEIAA@EBE@MBGBGBKBA@E@IBQBIBIBC@GDKDCHINIFQAEFABGFEHDFQP@BADIJADCFEHED@@EDQ@MEMC]BA@IJ@BC@G@@@@DIBBHMBBFE@C@@AB@AAE@@C@@MBC@A@@AG@AB@DC@@FG@@FC@AA@BCB@@AJ@@@B@B@HCNAD@BBBCDDBBBBJG@@H@@D@@D@@@@J@@A@F@J@DB@BD@N@BB@FB@B@BF@@DCFAJ@B@BD@AFD@B@@ED@@FBB@DAL@BAFHDADADEAADA@KAADE@@D@DA@@DAFABA@@D@F@JB@@HD@ADLNLJHCPD@LHDHDJHPJPN@@HHB@DDD@FA@FN@DAHDBDJ@BB@D@HBBFFBCD@BB@BHDDBHA@DEBDFBB@FEA@AAA@@@FBHG@CF@NC@@@@@@@C@BEAE@AC@I@EBCF@F@@@DD@@@@D@DBD@B@F@@BB@D@BBB@@@BBB@@@B@@@BA@@@@@ABA@AB@B@@@@@J@@@BFNDAF@@BFB@@@B@@BBVNZFLBVDP@F@B@X@HBBBDB@F@@@DFBBBB@@ABEH@BB@@@DPAD@A@@@HKBAD@D@ACBADFJD@CAAA@CGJEAAFAF@@BRBLAJC@CB@AK@GD@J@B@DAHGFCJELCN@FABA@ADCCIJIBA@@HINOPKBAHGJIBCBCBCBCBC@GG@@CFMACA@@K@@@G@@@EBAB@BEA@A@@AJ@@A@@G@A@ACBIRBFK@CBA@AG@@GAAE@DGDBD@B@@ABE@@@C@AAGBCDGAGAE@CAGAGAICKNIKKBI@@@E@GFGJMAEAC@EJEB@B@HAD@L@JGHEB@PED@@@F@X@JM@OGKECACGACABEEEGESGA@AGA@GAG@A@ADGNY@@C@IAC@EGIBEAACEIECAGCMCOACAGBC@CBIBQAG@SA_AOA@W"],encodeOffsets:[[119398,40086]]}},{type:"Feature",id:"131024",properties:{name:"香河县",cp:[117.006093,39.761424],childNum:1},geometry:{type:"Polygon",coordinates:["@@AD@@C@@@@B@@AAE@GAA@A@@@@@@BA@CAB@@A@@@@A@@@CF@@B@BBFBAD@@@@DB@@CD@@@@@@@@@B@B@@A@@@ABA@A@A@@B@@A@@@DB@@AF@B@@@@@@A@@@@B@BBD@B@BAB@B@@B@@@ADC@CD@@B@BB@B@@BB@DB@@@B@@D@@J@B@@@B@@@@@@@B@@BB@B@@@B@@@D@@@@B@BD@B@ABBBB@@@B@B@BB@@B@B@B@@@B@BB@@JA@@@D@@@B@DG@@@@@@D@@@@@@A@@@A@@F@@@@@@@@B@DB@@B@ADA@@B@@@AABDB@@AB@@AB@@@@A@@@@B@@@@@@A@@@@@@FA@A@@@@@@@@B@B@@@@@@@@@B@D@@A@EAC@@@ADA@@B@@@@BBB@B@@@AF@@@B@@@@DBFBDD@A@@@@@ADE@@B@DA@@@@@@@@@B@@@@B@BB@@@@@@AB@@@@A@@@BB@@@@A@@@B@@BB@@@@@B@@@@BDBB@BBB@@@CFABAB@BAB@@FFDDFBJ@D@F@J@FCBAD@B@@A@@@@B@B@B@BB@@@B@BA@@B@@@B@BAB@@@@@BB@DBB@B@BA@B@@@B@BAB@@@BA@AB@@@@A@@@@@A@@@C@GBC@ABA@@@A@@@AB@B@@A@@@@@@B@@@@B@ABAF@B@@@@D@@@@@@@@@@@F@@@@@@@@@B@D@@@@@@DA@ABC@@BGFEDCBAB@BA@@B@BBD@B@B@DAD@B@BAD@D@@@FAD@DCFCFABCDADABB@HBAFHBBDB@BBB@LB@@BCPBBA@AB@B@@@@@B@HAF@BBJDAD@@DBD@@ADEDGACDCDE@@J@B@JDBBCHLBPDB@FELABCBI@@B@P@DC@AF@BA@GC@@E@@DEB@BCFGDBBB@DB@@DB@D@FBD@H@D@B@DBDCFDFI@@HKAA@AGCFABBBCFCHCLCH@DBBB@B@@B@@@N@B@B@F@B@F@B@@@H@@@D@DA@@F@B@F@D@CJFHJD@BFBD@VDHBFBJ@B@B@B@D@FCB@BAB@BAB@DA@CA@@CA@@CJQA@AAEBBC@E@@A@BKHB@@@BB@@@B@B@BA@ADFHBBEF@@ABA@@F@@C@A@@@A@C@@@ABA@A@A@@@@@@@@@EDA@@@AB@BABAHADABABAB@@@BADCB@B@@CBAFCFEAA@AECG@@AEB@A@@@@@ACEC@C@C@CE@@@AGBEA@@CBEAC@@@CA@@CA@@@@A@CB@@AAA@AA@@@A@A@@BCBCDC@CBAAA@@A@@@ABABA@A@@@@@A@@ABAMAACB@@A@@B@@ACCFACEAC@AL@@@A@@AF@@AHA@A@@BA@@@@BB@FDA@A@A@@BCG@@@@AA@AABGDB@BF@@ABGC@@GD@@@C@@AB@@ABAB@AEAA@@@@@EBI@AA@BCE@@@BEAA@@BA@@BG@C@A@@BCC@C@@GC@@A@@@@@A@@@AA@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@A@@@@@A@@@A@@@@A@@@@@@@@@@@@@@A@@@@@A@@@@@@B@@A@@@@@@B@@@@A@@@@@AB@@@@@@@@@@B@@@@@@D@A@@@@AB@@@@A@@@@@A@@@@@@A@@A@@@@B@@A@@@A@@@@@@@A@A@@@@@@B@@@@@BB@@B@@@@A@@@@@@B@B@@@@@B@@@@@@G@@BAB@B@B@@A@@B@B@B@@@B@@@@A@@@@BA@@@@@@@A@@@@A@@@A@@@A@@@@@A@@@@@@A@@@@@@@AA@@@@@@A@@@A@@@A@@@A@@A@@@@@@@A@@@A@@@A@A@@@@@@@@@@A@@@@A@A@@@A@@@A@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@@AA@@@@A@@@A@@A@@@@A@@AA@@@@@@@@@@@@@@@@@@@@AA@@@@A@@@A@@@@@A@@@@@@@@A@@AA@@@@B@BA@@BAB@@@@@@@@AA@A@@@@@@AB@@@@A@@C@@@AA@@A@A@@@C@BAAA@@E@A@C@CCC@@AGDC@IDAA@@@@@A@@BA@@@A@@D@@AA@A@A@A@AB@@A@@@@@@B@B@B@@A@@B@@@@@@@ABACAC@@B@BA@@@@@@@@BA@@AAB@@C@@B@@@B@B@@A@@@CBA@@@@@@@@@@A@@@A@@@A@@AB@@@B@@@BC@@@C@A@@@@B@B@@@B@@A@A@@@A@BB@@@@@@MDKDKFGDIBMDIDIDIBE@GBIBEBIDC@GBEBIBC@A@@A@@@AA@A@A@A@@AA@@@@@@@@@@@@@@A@@@@@A@@@A@@@@@@A@@@@@@@@@@B@@@@A@@@@@@@@@A@@@@@@AA@@@@@@A@@@@@A@@C@A@@@@@@A@AA@A@@@A@@@C@@@EAC@@AA@@@A@@@A@@@A@@@@AA@@@@@@@BA@@@@BAC@@@@BA@@@@@A@A@@@@@AA@@A@A@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@AA@@@@@@@@@@A@@@@@@@@@AA@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@A@@@A@@@@B@@AB@@@@@@B@@@B@@@@D@D@D@B@@@BA@ABABA@@@A@@@AAACA@CA@AA@@@@AA@@BA@ADCDADAB@DAB@B@BAD@B@B@B@BBDBF@@@@@@C@@BA@A@@@ABA@@@CBA@A@@BA@A@@B@@@B@B@@@@@@@B@@@@@@AB@@B@@@@@@@@@@@@B@@@@@@DB@@@@D@@@B@@@@@BB@@@@@@A@@B@@@@@@AB@@@@@@@@@@A@@@@@@BABA@@B@@@AABAD@BAB@@BB@@@D@@A@BB@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@B@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@B@@@@@@BB@@@@@@@@@B@@@@@@@B@@@@BB@@@B@@B@@@@B@@@@@@@@@@@@@@BB@@@@@@@@@@B@@@@@BA@@@A@AB@@@@@@@@@B@@DCJ@@@@A@@@A@A@A@@@A@C@@@@@A@A@@@A@@@A@A@@@A@@@@@@@@AAAA@@A@@A@@@@@@@@@AB@B@@@@@@@@@AA@@@@@@B@@@@@@@@@@@@@@@@@@AB@@@@@@A@G@A@A@A@@@C@A@A@@@@@A@A@A@A@@A@@@A@@@A@@@@@@@A@@@@@A@@@@@A@@@A@@BAB@@@BA@@@A@A@@@@@@@@@BA@A@AB@@A@@B@@@AA@@@A@A@@@@@@@@@@@@AB@@A@@@@@@CA@@@B@@@@@@@@@@@@@@@@@A@A@@@AB@@A@A@@@@@@@A@@@A@@A@@@A@@@A@@@@@A@@AE@"],encodeOffsets:[[119712,40641]]}},{type:"Feature",id:"131025",properties:{name:"大城县",cp:[116.653793,38.705449],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@_BGBIHECEBC@A@C@AD@HBFEBGBCACDBFCBC@@HC@@B@B@D@BF@@@DFBF@@BJMB@DD@@BBB@B@@C@@@C@SACGA@ACCB@DBDBBBJGBA@@HDD@BCBA@BH@@BBF@B@B@BDVFD@JA@B@BEHILHNDHBDIBeJA@G@ABBDBD@@EHIHGDA@EBSHGBCBKHCDIJABCFABCPCJCL@DCL@NAF@FADER@P@HDHDHRFBBPDTDDBNDLH@@DB@@DBNJBBBBFHDFFF@BBDBFBBAH@B@HCLAPZBD@N@R@RANCF@FALGNGPCB@P@B@JABIJ@DF@@B@TBB@HAHF@@FDLHDDFDARDHBDFJRHFBJ@NBNDFHBDFLFLFJLJXNPJ@@ADBB@F@BD@BF@FBB@F@FADAFAFJCD@@B@FQD@@B@VCBFDHDHBD@DI@DF@FC@BDBBN@@BB@@DF@F@ACB@Z@@@NCDAB@@B@@@@@BJ@@ECEFA@CJE@GFA@I@AB@BAH@B@HB@HBBB@F@@EB@@B@BTAB@@B@@@@B@BAB@@@B@B@B@@@B@@@B@B@B@@@B@@@B@@@D@@@DA@@B@B@@@B@B@@@B@@@B@@@@@@@@A@@@A@AAA@@@C@@@A@@@@@C@AAA@A@@@@@@@@@@@A@A@A@@AA@AB@@@@@@A@@@A@AB@BAB@B@B@@B@@@@B@@@B@B@@@AA@A@A@@@A@@AA@C@@C@@@A@@A@@@@@A@@@AA@@@@A@@@@@A@@@@@A@A@@@A@@AA@@@A@@@@AA@@@A@@A@@A@@@@AAA@@A@@@AA@@@@A@@AA@@@@@@DAB@B@BA@@@AAA@AAA@@AA@A@@B@B@FAB@AA@A@@@ACBC@@@AA@@@A@@@@@A@@AA@@@A@@@AA@@@@A@@@@@A@@@@A@@@@@@A@A@@@@@A@@@@@A@@@@D@BAB@B@@@@@@A@@@@@A@@@A@@B@B@B@B@@@D@@@B@@@B@BA@@AC@C@CAAAC@C@AAC@CAA@A@C@AAC@A@C@@@@@A@@@AA@B@@@@@BB@BB@@@D@DB@@@A@AHBB@B@D@@A@@BAA@@@@@@@@AB@@@B@@A@@@@@@B@@@B@@E@@@A@@D@@@B@@A@A@A@A@A@@@EA@@A@C@@C@BCGAAABCDC@@AABEFBB@@E@CAGEA@G@CGAE@@EAAQBAEB@J@D@B@@@@@@CB@DDDBAAFADA@@@@@A@@@A@@@A@A@@@A@@NE@AA@BC@@@C@AGABEA@@A@@BA@A@@@ABA@@@A@@@@@ABA@@@A@@BCBAFIBA@A@@BI@AAMCIACAA@ACIGCBE@I@@A@AB@F@DEBIAABBBG@AAMA@AACI@BADCA@@EDEKADEGIIAG@@BGAGECAYTGGIIADDDABA@AAAACEKEA@CABA@@DAHAB@A@ACAAACAACC@AACCBG@G@CAGIEIBAFAFACE@GB@D@FIDE@AC@AB@DUBACG@ACG@OB@GC@@GB@HDCIFA@BDHB@DC@EBCH@BCDAB@HA@EB@JBDBDE@EEACA@KAEGCECAC@EACI@BC@EA@CC@CI@AGIBE@ADS@DJQ@AECCAACBCK\\C@AACAACCACABCG@@AAA@A@C@AB@CA@BAB@BA@AACA@DABEAEJ@@C@AEAG@@HG@@DBBKA@DEA@D@BG@A@@HGAECBE@@@CG@@EC@A@@FC@@AACC@@CBEBAE@A@C@@BG@@ACBA@KN@DFB@DA@A@CBABBDDF@DIJOBKAMAIEAA[GSB@A@AC@CBEB"],encodeOffsets:[[119299,39413]]}},{type:"Feature",id:"131028",properties:{name:"大厂回族自治县",cp:[116.989574,39.886547],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@@C@CBCBCDA@ADE@CAA@@CAA@GBCBEDCBAFGHEDCBE@C@@A@@@A@@@@@A@@@@@@A@@@@BA@@@@@A@@@@@A@@@@@@@A@@@@@@@@@@A@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@AB@@@@A@@@@@@@A@@@@@AB@@A@@@@@@@@@@B@@@@@B@@@@@@@BC@@@A@ABC@C@AB@B@@@@@B@@@@AB@@@@@@@@@@@B@@@BA@@@@B@@A@@@@B@@@B@@@@A@@@@B@@@@@B@@@@@@@@A@@@A@A@@@A@@@A@@@@@@@A@@@CB@@@@@@@@@@@@ABIJ@@A@@AA@@@A@@BABEFEHADAB@BEBABC@C@EA@B@@@@AB@B@@A@A@@@A@@@@@@@@B@B@@@B@B@@@D@@AB@@@B@@@B@@@DD@N@HBH@B@@AJ@JDJ@@C@AH@BGDBHDBBF@F@@CDB@BJ@AFAHB@@DB@BHCD@B@BFB\\HDB@B@BG@AL@@C@A@ADEJF@A@NBABBDDDHBV@BB@BD@@HD@@DA@ADE@EHAACB@@E@ADAFB@BEB@@@@@B@ADDC@BEJA@CJAAAA@@CH@@@@EABCA@AAGBABEHBBLDB@BB@BBBBBABA@@BB@B@@HD@@B@@F@@DDDBADCFDFGD@JCDB@BCHJBH@BBDABC@AAC@AAIBABA@@B@B@BBFB@ANBHDB@@DDB@AHB@DHBAFF@@A@ABGBABGB@DIFMF@HBDGMCBAC@@A@AF@@@EABCDCD@BCBC@@AABICAA@AAAA@C@C@ADCD@H@B@D@DBD@BAJB@@FABGDBHGDBB@BC@C@@A@ABBGAC@@@@@@@IA@@EFAAADABAFAD@D@B@BABB@@@BB@@@BABAFADAJ@B@DA@@@CAE@GJA@ABAH@DBADHFBDHBB@@CFABCD@@AEABAB@@ACGBAF@VB@CBABAB@DAB@B@DCB@HC@@@A@CACACCAA@@A@AA@@ABEB@@GA@@AGBCAAAGGCBIAA@BAD@BA@CA@@CLM@@A@@@CCBAAAA@AACAECCDCAA@C@G@C@EAC@A@@CA@@CAACAEHADA@CF@@@FD@@HABE@@BCDO@A@@@AJADKBEFA@OCKADGAAICA@I@@@CFCDBDCHCF@BC@CA@@BCICAAE@GBA@@@@@A@A@@BABOAAD@@KAA@AAA@ACGABEGAA@GDEB@BAA@@A@@@@@@A@@@@@@A@@@A@@AA@A@C@@@"],encodeOffsets:[[119709,40805]]}},{type:"Feature",id:"131026",properties:{name:"文安县",cp:[116.457898,38.87292],childNum:1},geometry:{type:"Polygon",coordinates:["@@IBA@O@A@ODMHKHEBE@MDQBQ@M@C@YAIPADABCH[VIDEFEBEBA@IDCBIDC@ID@@@@OD@@CBEBKBOFEDGBA@CBCBMB@@KD@@A@EBACA@@DA@OBi@EBA@]HDJLCB@BBBDBBABADKBFN@DA@EB@BCDCAA@KDGB@@@@IBABIDCFBN@@@HGBEBCB@BE@EJCFIACAGAAAAHMA@BABGB@BBBAJAJGDAFBBFD@B@NEB@H@HGFBD@BFCB@FB@NB@D@FBJ@JBB@FBH@@@BBBB@FG@A@@BBXNBBLPB@GD@@U@AAAOCBEF@DBNDHB@B@BBBDB@BD@DAJFB@FDFBBBF@D@D@FBDBBBD@FDBB@V@L@BJNBBBBFALGCCBANEBADA@@@D@BB@FDDBBBBBBB@BB@@BDF@DB@@@DA@@B@BB@@BB@BBD@B@BB@@@F@B@BB@@@B@BBDBDBBDBDDB@@B@@B@BBBBD@@@B@@B@B@B@BDB@BBBDDB@FDDB@@FFDDBDBJBFBDDLE@@BA@GAmMBCAD@DGRDBRD\\JBADITF@ADBDBD@D@CZR@@JL@@ABYE@@AH@D@RAD@D@FBCFBBBABEF@@BCFHB@BBBD@B@DADADAFCHAFADAB@FBBBBBDBBADBDD@BDBBBD@B@HADAB@PEBAFEFADAFCB@@@DADA@@@@AA@@@CBAFEDEFE@@HGBAF@F@FBFBL@D@F@N@F@F@@A@A@ALADA@MXBF@@AB@HIBAD@DAD@JBPFD@DBB@@B@FH@@B@DABJB@F@BFHAB@@ADIAKDDBFFDDMB@DEFBH@FBBFAP@J@DAB@BAACAABCBGDA@@D@@@@@F@D@BHDABBDND@FAAGBCFBDI@@B@AFBBF@BC@A@@B@FDJBBFLC@EACCCCGA@BAHKGCCAAAPA@A@@@E@ABAF@@@B@FAP@B@@@@@BF@DTADAB@BNAB@JBAFA@BDABDBN@DF@@BB@DEDB@@JAH@@A@ABAF@F@H@JADAFAACF@HJBBBBFBD@NB@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@FA@I@K@A@@@@AAC@@@A@@@@@@@@@@@@@@@@A@@@A@@@A@@@A@@@A@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@AA@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@A@@@@@@@A@@@@@A@@@A@@@@@@@@@@A@@@A@@@@@@@@A@@AA@@@@@A@@@C@@@A@@AA@@@A@C@B@@A@@BA@@@@@@C@AAA@@@A@@@@@@A@AB@@@@@@@AAA@A@AAA@A@A@@@@AA@@AA@@AA@A@@@@@@@@A@@@@@A@A@A@A@A@@A@@@A@A@@@@AA@@A@AA@@A@AA@@A@@@AC@AAA@CAA@@@@@@@@@A@@AA@@A@A@@@@A@A@A@C@CAEAIAA@A@@@A@AAA@CAA@A@@@@A@@@@@@C@@AA@@@A@@@@@@A@@@@@@@A@A@@@@@A@@@@@@@A@@@A@@@@@@@@@@@@@@@@A@@@@AA@@@A@@@A@@@@@A@@BC@@@@A@A@@@@A@@@G@C@@@G@E@G@E@A@O@C@G@@D@@@D@D@D@D@D@@@B@B@@@@@@A@A@@@A@@@C@@@@@C@@@A@C@@BA@@@C@A@A@A@C@A@A@@@A@@@A@A@@@A@@@A@@@A@@@@@@@C@A@A@A@@@A@@@A@@@@@@@A@@@@@A@@@@@A@@@@@A@A@@@A@A@A@@@@@A@A@A@@@A@@@ASB@A@AA@@FE@A@AA@GGAA@G@ABA@@B@JEB@HIF@DEBDF@FI@@A@@@@@AA@CBMD@@Y@A@BDE@E@@CA@@AM@AAACD@@ECEJ@@CACCGCGAEUDA@@@RC@E@AC@IDBEBEBC@E@EAA@EAEC@@A@EAABC@@OIWMKIEIEKEKACEGMCMAI@EAQGEIACCGBQECCCKGEC@@GEGBA@SAA@@@CEI@AJ"],encodeOffsets:[[119316,39672]]}},{type:"Feature",id:"131081",properties:{name:"霸州市",cp:[116.391484,39.125744],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@EBAAEAGABAFQG@DKO@GABEC@QAO@@@@FC@@EGBC@EAICC@EA@BBB@@@@A@@@@@A@@AA@@BA@@D@B@@A@G@A@@@@AA@@BE@AAA@IB@D@@@@E@C@@BA@@@A@A@@AC@@AC@@BAA@@@@C@@CA@@CC@@@@DA@@BD@@@@@AB@AA@@@AB@BD@@FD@@@@@B@B@B@B@B@@@BBD@@AD@BHC@GBEBA@@AAC@@A@A@@@A@CA@@@@AC@@@AAA@@B@@AB@ACA@ACGBCEKBKDI@@@@A@@@@@A@@@@@@@@@@@@@@@@A@@A@@@@@@@@@A@@@@A@@@@A@@@AACCG@@@@@A@@@@@A@@@@@@@@@A@@@@@@@@@@@@@A@@@@MAC@EAAAAAGIE@BDEBCBIBG@E@E@AB@B@BG@IB@@CACFA@@AE@@CAMACCB@AEBAB@IBAAMA@CBSB@CAE@@@@A@O@EBA@@@E@AB@B@F@@@BOBBBDBHDGLABB@DHDDBD@FKDAEIAECA@@@@BADE@AABEA@@@CJEAADBHEBC@CMAACBAGC@E@@@@@C@@@CBAHADBBBDABA@CBI@O@EBAA@EAGFE@CNACCEECALCJBBC@@BAEG@A@EIABA@C@AG@@E@AA@CAC@OEIAC@CBC@ABGJA@@BE@WA@NCBKB@B@B@BE@E@M@E@C@K@EAEAE@E@ABGH@@EFCFEFAB@D@@BB@@@@CBCB@@A@EDCBEBEFABOFA@CBGBA@C@AACA@ACCCAABCAAAAAEAA@CBEBGBEDCBCBCBA@C@AA@AGADE@AE@AFABAADEEAC@C@QBC@G@@BF@AZ@BK@@IQ@DYC@C@CACA@BSECJAB[IQCCAHQ@CBCADnNHBB@@AF@CKACAEAIACCCEE@@CAECA@CCAA@ACA@A@A@A@AA@@@C@AAAAA@@@@AA@CCCAAAACAC@A@A@@AAA@E@@@EDAD@BDDKHCBGFGGA@ABDFABA@CAAEECGBACE@EBFJA@C@BF@@GDA@BFEBFLDHBDF@NE@BEB@BBBFABDABG@I@BD@DDHDDB@Z@BHF@BEB@DFFHE@OCA@ADAX@B@HBHEDBDDBBD@LAF@DCHAFCDA@ABAFCHEHKJEBA@A@E@@DEDGAEBCB@DA@EFGHEHADIH@J@BAJTARHD@D@V@FHCL@@@BBJ@@ANAJCJBDBH@B@JNFJCVJLFBBHFF@D@BFBBJFLHHD@B@FB@BFD@BDHBFB@C@EDA@@P@@IL@AEDABJF@BBBDBABBD@@EH@@ED@@CBAH@@BB@D@NA@@@DF@BBA@@BD@F@AENAAGJA@C@@H@D@@AJIB@^ANDNFR@FC@@FCFGDEBCJIBC@AROCEFGHEBAFERBJEJMDGLCHCD@JAJARAJAF@B@LAHAHANAF@FAB@JBAF@DCV@XPB`BTBH@RBJADAD@HADBPBNDHDDBJFDFBBAFHJ@FBD@J@DZ@HMBCB@H@HBB@BHB@THAO@CEA@C@E@IJ@FBF@D@JELCFAF@@IT@@A@EBAF@D@AFBBD@@@@CBE@A@@@@@@BB@CN@@@B@HAB@BABAB@B@AEDA@BD@D@FA@@BE@@BBCFB@DC@C@A@@D@@BAFCHD@HJDBBBH@D@B@B@@EBCB@B@B@@@DA@CT@B@D@D@B@RDZDD@@@@@@@@@@@@@@@BB@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@AB@@@@@@@@@B@@AD@D@B@hB@@DARCJABAB@@@@A@@@@@@@@@@@A@@@@@@@@@AA@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@A@@@A@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@B@@@@A@@@@@@@@B@@@@@B@@@@@B@@@B@DKDQ@@A@@@A@@@A@@@A@@@@@A@@@BGB@@@B@@@B@@@BB@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@B@B@@@A@C@@A@@@@@@@@@@@@@@@@@@@@@A@@@@CE@A@@@@CI@@@@@@AB@@@@@@@@@@A@@@@@@@A@A@@@A@@B@@A@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@A@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@AB@@@@@@@B@@A@@@@@@@@@@@@@@@@@@A@@@A@@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@AA@@@@@@A@@@@@A@@@@@A@@@@EBAA@AGUI@ACAA@C@AAA@@@@A@@@@@A@A@@@@@A@@@@@@A@@@@AA@@@@@@@@@A@@@@@@A@@A@@@@@@@@@@@AA@AC@@A@C@@@@@@@@@A@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@A@@@AA@"],encodeOffsets:[[119687,40010]]}},{type:"Feature",id:"131082",properties:{name:"三河市",cp:[117.078294,39.982718],childNum:1},geometry:{type:"Polygon",coordinates:["@@BDBDBF@D@@@B@@@B@@BBB@@D@B@@@F@@B@@BB@BBB@B@B@B@@@B@B@B@B@@@B@B@ACHCB@DA@@HABB@@DDH@HA@@@BBB@@@@@A@@@AB@D@@CJ@@DDA@BA@@B@B@@@F@D@B@B@@@@@@D@@B@B@B@@@@@@@@@@@F@BB@DBB@B@B@@BD@@@B@@FB@@@B@B@B@@@B@@@F@@@B@B@B@@@B@B@B@@BB@B@B@@@B@BB@@@B@@@BFAJCCC@@BAB@B@B@B@BABABADCBABCF@@@B@B@@@B@B@BB@@BBB@BB@@BBB@@B@@BAB@B@@@B@B@B@@@BBB@B@@@B@BA@@BA@B@@@B@@B@HBF@D@@@@@@@@@@BB@BB@@@@@@@BB@@@B@@@@B@@B@@@@@@@@@BBB@ABB@@AB@@@B@B@D@@@@@@@@@@@B@@@@@@C@@BCBB@@@A@@@A@@B@@@@A@@@A@AB@BB@BD@FB@@@B@@@@B@@@@B@B@@@BD@AB@BB@BCB@AD@B@B@@B@@@@@@@@@@@B@@@DE@@B@@@D@@A@@B@@ADBB@@CE@@AF@@@AA@@@@@A@@@@B@@@@@@@B@BB@@@BB@@@B@@@BC@CB@@H@@@@@@@@@@@@@@@MB@DBBBBBB@@@FDBBFDB@DDD@@@B@B@@EBABBB@D@BCBCB@@AC@@@@@@E@A@@@@AA@@@A@@BAB@@A@@BB@@@@@@DB@@B@FBB@B@@@@@@@B@D@F@@@B@B@DAB@BA@@B@@@DA@@@@BA@@BA@@@@B@BA@@D@@A@@@C@@@@JBD@@@@@@@@@@@@@@@F@B@@B@B@@F@@@@A@AF@B@@@@@@B@@@@D@@BB@@@@CBA@@B@D@@@@@@B@@AF@@@@@@B@D@B@B@ADAF@@@@BBB@DBBBBB@@B@BB@@BBBD@BBBBB@@DB@@B@B@BBBA@@D@HBB@@@@@@@@B@@@@D@@B@@@@B@@DA@@@@@@@A@@F@@@B@@BB@@BAB@@@@B@B@@B@B@B@BAD@@@BB@@@B@@@@@@B@B@B@@@AB@@@@B@B@@@B@FBB@@@@BAB@D@@@@D@D@@@@@B@B@DBB@@@D@@GD@@@@@@@@@@@@@@BB@@@@@@@@F@@@@D@@@@@@@@B@D@B@D@@H@P@@@BDDA@@B@@@@@@B@@@@FA@@@@AA@AAA@@@@@@@@@@B@@B@@@@B@BB@@@@@@@@@@@A@@A@@@@@@@BAB@BA@@B@B@B@B@B@@@@@@@B@B@@BB@@@@AB@@@B@@A@@B@@@@@@B@@@@B@@B@@B@@@@AB@FC@@B@BA@@B@@A@@@AB@@AB@@@@@FAB@FA@@B@@@B@D@B@DDB@@@D@F@BBDBB@DBB@B@BBDD@DBDB@A@@B@@ABCBA@@@@@B@B@BA@@DAB@FA@@@B@@FCD@DABB@A@@BA@@@@B@@B@@BA@@@@@@@A@@B@BA@B@@@@BAD@@@@A@@B@FD@F@@B@B@@B@@@B@@ABEB@BABB@@BB@BBBB@@@@@@@B@@@@@@@B@@BA@@@@B@@@B@@@BA@AB@@@@@AA@@@A@ABA@ABA@C@ABA@@@@B@@ABAB@B@B@DA@@ACBAB@@B@BB@B@DBB@B@@B@@@B@B@@B@BB@B@BBBA@E@A@@D@@@BB@DDBBB@B@@@DA@AB@BA@A@C@@BA@AB@@ABAB@@@BA@@B@BA@@@A@@BCDA@A@@AAA@C@@AA@ABA@A@A@A@AAA@@BCB@@CAAAA@@@A@A@@@A@@B@@A@A@A@ABA@A@ABA@A@A@AAB@AC@@@A@A@@@AEB@@AAA@ABA@ABAA@AAA@A@A@@BCBA@AA@@A@@DABA@@BA@@@ADC@@A@@AA@B@@AA@@C@@@A@@@@@A@@@A@A@A@C@A@A@@@ABA@@@C@C@A@C@@BA@AB@D@BABA@C@A@AAA@AB@BA@@@A@@@A@@@@BA@@@AAAB@@@@@BB@@@@@@BA@@@AAA@@@A@@@A@@@A@A@@@@A@@@@CBA@@@A@@@@B@AA@@@@@A@@@@B@@C@@@AB@AEA@@A@AC@@A@@C@BEE@@IA@@CC@@ACAA@G@@@@@A@AAA@BC@G@@AA@AD@@A@@AE@@@AA@@@CG@AC@A@@BAFA@E@A@A@IFA@@A@@@@DADA@@@ABAACBA@A@A@@BA@A@@CEA@C@CAA@ECA@AAAA@@AAAACBABABACB@@ABAB@B@@@B@@@FCFGBA@AAA@@AAA@ED@@A@A@A@EE@A@ADE@CBABA@@@@@@AAC@GAAAC@E@A@@@@A@@@AH@D@@AB@@ABA@C@CBA@AD@B@H@B@@@B@B@B@@@D@BA@A@CACCAICAAA@@AAA@CBAFAFEDCDAA@@AAA@@@AA@@A@@AA@A@@@A@AAA@@AA@@@@@A@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@A@@BA@@@@B@BB@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@DABA@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@BB@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@BB@@@DADA@A@@@A@@@@OI@@@A@@DAD@BAB@D@BB@@BB@D@@BBB@@@B@DA@A@A@@AC@@@A@@BA@@B@D@@BB@BD@@B@B@BA@@FGB@BAB@D@BBB@@BBAB@B@FAFAF@B@@@@EB@@AB@B@FB@@F@DABAHCB@B@@@B@@F@@BBB@B@DA@@B@B@@@B@@@@BBB@D@@BBD@B@@@D@BBDAB@FE@@@@@A@@@A@@BADE@@@ABA@@DC@ABABCB@@@@AA@@AC@AB@@AA@@@A@ACC@@@@@ADA@@@AA@AAA@@@A@@A@@BABADB@@B@@@@A@@BCA@@@ODGBMBC@C@GACA@@A@@@AACCGA@FKDC@A@A@AAA@AF@@@@@@@@@B@A@@A@@@@@@@@@@@@@A@@@ICADA@AAA@A@A@E@BE@@DE@C@@A@@@A@AAADIAE@C@@AG@K@@@@AA@E@@IE@CAEFABCBA@ABA@ABA@EDC@A@A@A@I@EAGAUCC@EA@AICEGDIC@E@A@E@@@CBC@@@G@@@A@E@A@E@A@A@M@@@A@@@@AAACAG@KDGDEDADAAEBHD@BBBGL@@EJDBBBB@BBABDD@@B@@@KN@DB@@DABC@ABB@JBDAHHBBDBHA@BB@@HA@AF@BB@@B@BB@DBBDBD@D@B@@GDA@CDA@A@CBA@ABAB@DUAE@ABDH@BA@ABFB@BC@ADEB@DA@GAACGEBCCAG@AB@BIB@HBF@D@@CBA@I@CBEBABAB@@A@@A@@AAABA@C@C@EBABCBBBEB@FB@@J@@@@@@BDAHBAB@@@@DADA@CAGHCAAHEB@@IAABC@CAC@A@G@C@CD@B@D@DBBBBB@DBAJBB@@ADADC@CDADFB@@E@@B@BD@ABNDCHGAE@ENCJA@AHABAH@B@BE@BEGA@CGA@BCA@CA@GCMA@BEAAAA@A@@@ABABBJ@BBD@BADCBAAG@IADG@ACAIDC@EHECCDABCC@CE@@@@AC@@GA@A@@AB@BAAAAA@AAAA@KCAAFGBAHABBB@ADFB@@@@DG@@BBBBDIB@FI@ACDBCA@@@@@A@AFA@BEBCF@@@DABBFGF@BCB@@CC@@GC@@AAAU@GACCACBAMAB@E@FIBCB@D@@@BKH@@A@ACA[GEA@A@ADCAGA@@CA@BGBEI@@ACA@DE@E@AAGCCAAHG@@B@DI@ICI@@BA@G@GAM@C@@CA@A@A@@@A@@A@A@@@A@@@A@A@@@@@@@@A@A@A@A@@@@@@A@A@A@@@A@@@A@@@A@A@@@@C@A@A@ABABA@A@ABA@AB@@@@IFCDAD@@AD@L@F@DAH@BAFABBB@F@B@D@B@B@H@H@B@D@D@BBB@B@B@BAB@F@B@D@HCDGBEDIBKDABCDCBAB@B@D@B@DBDBDDBDBBBDF@HBDBD@BDDJJ@BBB@D@D@F@FCDADABABADBDBBBB"],encodeOffsets:[[119576,40976]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("石家庄",{type:"FeatureCollection",features:[{type:"Feature",id:"130104",properties:{name:"桥西区",cp:[114.461088,38.004193],childNum:1},geometry:{type:"Polygon",coordinates:["@@FB@B@BABA@ANA@CBK@@D@BA@YA@BG@A@A@@CA@@D@@@@@@@BC@ADA@BAA@ADA@BFA@AB@@BBB@ABB@DB@J@BCBM@CD@BEAC@ABABAJA@@BA@CAC@C@EBABCD@@@FBFBD@D@B@@A@@D@@A@ABA@A@@B@DG@EBCF@@@B@@@DD@@HD@CFCBB@@B@JC@@BC@AFCB@@AFAHTALAJA@@@@PA@@@@TC@@HARC@FFAFBACHCB@ACAM@A@@@A@ABE@@@@@@N@@@@@B@DAF@@@@@F@@@@@@@@@D@B@@@D@@@@@D@D@B@B@@@@@@@B@@@B@@@@@B@B@B@D@@@@@@A@CD@@@@@D@@@H@@AHBB@B@B@B@@AAAD@B@B@B@@@@@@@B@F@@@B@H@@@B@F@@@B@@AB@@@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@A@@BB@@A@@B@@@B@@@@@@@@@B@@@@@B@@@@A@@@A@C@E@A@@@E@A@A@A@A@@@A@@@@@A@@@@@A@@@@@@@A@@@@@C@@@@@@@@@@@AAA@@@@CEA@EE@@@@@@@@@@EEAAAACCAA@ACA@AA@@@AAC@BA@@@@BEA@@EBG@@@@F]MABCA@GDABE@@AEBG@AEA@AABAC@A@C@@DE@A@@@E@CDAB@DE@@@H@BB"],encodeOffsets:[[117208,38892]]}},{type:"Feature",id:"130107",properties:{name:"井陉矿区",cp:[114.062062,38.065151],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@AC@ABI@@BAB@B@@@AA@@BA@@CE@A@@@A@@C@ABABAB@@A@AAA@CBABC@@B@B@DADCBCBEFAR@DD@FANEFAD@@ADBHBFB@@D@D@B@BBBB@@B@D@@@@A@@@A@@@ABABA@@B@ECCABAEAIDABEAA@AA"],["@@BDBDBH@BBJDHBD@BDDBF@FBFBBFDFFDBFBB@THB@DBF@BBBBDBDDBBB@DDBDBB@@J@DCD@DC@@F@D@DCDADBDBBFBBDADCDEFA@@B@FBADBDJHBCBA@ABA@@DBBBF@D@DF@B@B@B@@DBB@@B@HC@C@C@@BHHDBF@HBDDD@F@BA@@AAE@@@@@@A@@DA@@BA@AEAABABA@A@AA@E@I@A@@A@@@A@@@CB@@@C@@@@CAAA@@@@A@@AAAAC@ABCBC@@@E@A@@@@FE@A@AAAA@@@C@A@CECACAAAIIBE@CCA@AB@AGB@FATDXBDABC@CKKC@GA@BIDGAAAE@CBC@E@CAA@AC@@BADA@EBA@G@A@C@A@A@@@AB@BA@A@A@AMK@A@CACAC@EA@C@BA@A@ADAAAC@CBA@CBG@ECIC@A@C@CC@@@ABA@@B@@@@BB@@@@BA@@BB@B@B@@@@@@AA@@IDA@A@C@ABCBABABA@A@C@C@ABA@@@ADCBEB@BABABA@@@A@CAA@@@@B@FAD@B@B@BADC@ABA@CB@BBDABA@ABA@C@A@A@@BCBGDEDEBAB"]],encodeOffsets:[[[116750,38932]],[[116724,38979]]]}},{type:"Feature",id:"130108",properties:{name:"裕华区",cp:[114.531202,38.00643],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@BCIAADADMA@COHADNBE^@@@@AH@FB@AF@@@@ABD@BB@@B@@BDB@BBBDDBBBBFF@@@@@@@@@@FFB@DF@@@@BB@B@@@@@@@@@@@D@@@@@B@@@@@@@B@@@@@B@@@@@B@@@B@B@B@B@F@@@BJ@@@@@@@B@@@J@@@@@B@@@@@@@@@B@@@@@F@@@J@@@@@@@@FD@@BF@@A@@@@B@@A@A@AD@@DFB@CB@@@B@B@@@H@H@@@@@D@D@@@H@@@H@F@D@J@B@@@F@D@L@H@@L@D@X@D@BbGD@ZELAFALALARCFAB@lG@ABCFCBCBECAKAA@@GG@EA@A@CE@@CC@@CAACCE@ACEA@A@AB@DC@KB@@CDI@@@AHEF@@EF@@CB@@CDKFSA@E@ADS@@BBDE@@@@@AF@@@@M@@FCBAD@DAB@@EBCDA@@@EFIB@FE@A@AB@BC@@E@@G@@CE@CABAK@CC@C@AB@@ECA@C@C@@@C@E_@A@@ABG@@AHI@CBA@@B@DAB@DI@@BA@E@@@@@@KC@A@K@@@@@I@BC@@A@A@CA@@AA@A@AC@C@@@AFA@SAG@KAA@A@@@A@E@A@A@G@BFM@"],encodeOffsets:[[117263,38884]]}},{type:"Feature",id:"130102",properties:{name:"长安区",cp:[114.539395,38.036347],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@@@C@C@@@@@G@G@@@A@A@@@A@@DEA@CC@@B@B@BA@@@@@@BE@@AC@@E@@@@@@I@@@E@@@@@A@@@@@@@@@A@@@@@I@@@A@@@@@@@I@@F@D@B@@@B@@A@@@@@A@@@@@@@@@A@@@A@@@@BA@@AB@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@BA@@@E@A@@@G@A@@@BLBJ@@@@@D@@BHBJBJ@@BF@@BDBH@@BBBDA@QBBB@@@@BR@B@@@B@D@@@@@B@J@@BFGCAAA@OBCF@@@BB@H@@@BF@D@@@@DBBB@@I@HFBBFFBB@@FFJH@@BBDDB@F@D@D@D@F@B@D@DB@@DA@@F@B@FAHAFADABAD@FCFAFALAJAB@HELAHAD@D@F@LBB@B@DADCB@BAHCH@LABALBH@D@J@@@D@B@D@D@NCDAJAHCD@FAN@TGPDZB@C@ET@@ED@@@@EB@B@@@AKAG@EKQGMABCBC@A@CE@A@AG@@CA@ABEI@E@@@C@C@AA@C@@G@@BAkHA@EBQDKBKBEBKBYFC@aH@A@C@W@C@KG@K@C@E@@@A@I@C@E@G@@@"],encodeOffsets:[[117312,38950]]}},{type:"Feature",id:"130105",properties:{name:"新华区",cp:[114.463377,38.05095],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@C@@@A@C@@@@@@@@@E@@@@@E@CBA@@@@@M@@@@@@@AF@B@B@@@BBNBDA@GDBDEAEB@EQDGB@@SD@@@@OB@@@@IBKBSB@@@@@B@DAB@@@@AN@@@@@B@@N@B@@@@@@@BB@D@@@B@@A@@B@B@P@@@@I@@@A@@@A@@B@B@B@@@BB@DB@@@@@BBBD@LBP@DABA@@FBD@BB@BB@DBRBB@@@@BBB@DAD@DK@A@@@ARDFD@BD@HBB@@BDBBDDH@BLB@DADD@BHJBDFHB@DFLJBBFCDCDE@AFCRKHELGF@JA@EPABAHB@@BBD@BAFEAK@ADA@@AA@@AA@@IGEE@@AAEEAAGEJ@@@AACA@@@@@CAE@@G@A@@A@@DEPAB@BBHDAE@@@I@A@@@@@C@A@@@AAQ@@@@AARAB@ACAA@@AGAC@@AE@@AIAIAG@@@C@@@@AIAKE@A@@@@@@@A@A@A@C@BB@BA@A@A@A@GA@BG@@@C@@@@@C@@D@B@@@@C@A@A@A@@@@@A@@@A@@@@@@@A@A@C@C@@@"],encodeOffsets:[[117204,38962]]}},{type:"Feature",id:"130109",properties:{name:"藁城区",cp:[114.847023,38.021453],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAACA@CAABC@EA@A@ACCC@ABK@ACE@CD@FGDA@A@IAG@AAM@@CG@E@ABBDA@AEA@@FIB@BABBD@@D@INKBIDMFKDMDMAM@CAA@YAE@QBC@K@OHCDABIJMLMBGBICCEI@MAC@GA@CA@@AC@A@@FEA@BAF@@E@C@A@@BDB@FJ@ALF@FA@DH@B@@J@BD@@B@@@@AJCD@@@@KFQ@MBA@YF@BSLQHOHMHKJAB@BA@IJ@B@@AF@BD@@DABE@C@@B@BC@C@@@A@@FC@@DF@B@ETCL@DA@@DE@@FE@GF@B@@CJ@DA@@LCDA@@B@BFBBDF@DDBB@DD@@DF@@D@BFBH@@HB@LBDBAFADEDAD@BAB@@@HD@B@@B@D@D@@@FFJBAB@@DH@@B@BDFB@D@DABAHNLR@FBHBL@@A@A@@F@@C@@FS@@F@DYAOCSH@LBJ@HBHB@FHAPAB@BF@@B@BC@@BA@DJVB@DC@BX@D@D@F@@D@BB@NA@@F@@@D@D@B@BBBHD@D@BDLANA@@D@DAF@JFF@DAJ@BA@@DAJDBB@BADALA@FBJ@AB@DB@@@DBLABK@@FJB@BNBHFRB@DAJ@J@H@H@@BFFDLBBB@FCDABCDED@@CBCAE@I@CAACEGABDDA@A@A@BBBFD@@D@JCB@DB@@BA@@B@@@BB@B@D@BB@BFA@@BDB@FBB@B@@D@B@BD@HAHBFAHABAB@D@HA@BBHLBD@NBD@B@HE@@@CBACE@AFAJAHAF@BBP@F@FA@@B@JBHADEBAHEJCBE@BJ@FABA@G@C@AF@TGDAHCJCB@B@BBLEAEHE@AC@@GABCA@EC@EBCKE@ABADEBAA@MCC@CAOD@@GA@G@E@CAACA@E@E@IFKCIAG@AA@CCA@GBKAAA@AAA@ABA@AE@EF@ACC@@B@@AAA@@AB@@A@@BAB@@@@C@AB@B@@@@A@A@@A@CAEAA@@C@@AAA@@E@C@AD@NCB@L@@A@@DA@@@@@@@CA@@@@@BCD@@ABC@E@@AA@@C@@A@@@E@@A@A@AB@BC@AI@A@AAQAA@AA@@A@A@AFADAJ@BADGAKAA@AE@@@@A@E@@B@FABC@GBED@BAACB@@EBK@AF@H@@BBA@A@A@@D@@A@@@E@@@A@A@AB@@BBBB@F@T@F@BG@@A@E@BIG@@CCABEDEIEE@AM@C@K@AEE@A@EBCDCBAF@D@B@B@@ADBH@@@@EBA@AB@D@@@BCBAF@L@NBD@F@DBLBJ@@BABBB@BPJBBBBDBD@D@F@AAB@J@BBDDFHXBJBZDB@@@HJTH@@@BJDD@FDDFFBDBZ@B@ACGg@A@@@C@EBK@ABG@CBG@CAC@@EIGG@A@@AA@CAA@E@A@A@A@A@@DO@ABI@CAA@C@I@@@A@@@GDK@A@CBC@AFKBK@ABAAE@EAE@@@@AOAE@CCGAAACB@@ED@@KDEB@@A@C@K@@E@AAAAA@@@@@ABACAA@CEB@C@CCACK@AD@BA@GF@BIB@@CB@@EB@B@D@@C@CB@B@BC@A@AD@H@@ABQBS@AG@CAC@KAAB@DC@CA@@E@"],encodeOffsets:[[117726,38769]]}},{type:"Feature",id:"130111",properties:{name:"栾城区",cp:[114.648318,37.900199],childNum:1},geometry:{type:"Polygon",coordinates:["@@EQLIQ@KF@@A@@AC@KCC@EAA@@@@@EAC@CAECIGQGM@CDAAKHCHIAC@E@EAK@ECBAE@@DA@E@EDAFB@@@@DA@@D@FBJBJ@BD@ABCHEBAEEEEAI@ECGA@CAAKAA@C@@AG@A@@BOBE@ADAF@BKFGDC@KDM@KB@@@D@@@@@DG@@D@\\@BD@@DADB@BBJFP@@BBBAF@FADA@IFC@A@G@@CC@C@CBCBADGF@B@DB@HBH@DD@B@D@BE@CDAFA@AAA@MACBAA@@B@@@D@@AK@@BA@A@C@@BBBEFG@ICACCACBIAC@@B@HAH@BBBHDAFBF@HF@AD@FAF@B@@GBAB@BABDF@@B@X@B@BDD@@B@F@@@B@@F@D@B@@B@B@@@@@D@BBD@BBFBF@FADC@@B@FGAA@AH@HBBAJJBADF@N@AEH@B@B@F@B@@@B@B@LBH@TBB@BE@@D@D@@B@BBB@@DBB@B@@@ADJ@@@@@L@B@D@@L@@@@F@B@@AJ@@CBA@C@AB@DAJ@BG@@AH@BB@`@@F@D@@@D@DDB@FA@@B@DDDL@ABDBF@@DH@@@@FD@@ABAB@F@@EJAFE@@B@DCFA@@BA@CBCDA@EN@@@@@BE@@@@F@AC@AT@BC@CD@@EB@@@D@D@@A@AD@F@BA@CC@@ABE@@@AJIB@@ABALINGPGRGTK@AZEB@NAR@LE@@@@DCBI@@@@@AC@@A@IA@G@@CEBE@BKI@@ECA@AB@D@F@@@BE@AFB@EB@D@@BB@@DHBD@NBJ@DFJDHANANKMIEGGCACCCCAGQC@A@YBS@EACEDIFKHIDCDI@AAGIEOEGAC@ICMAA@C@OAEA"],encodeOffsets:[[117472,38730]]}},{type:"Feature",id:"130121",properties:{name:"井陉县",cp:[114.145242,38.032118],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@CHAFGLGHMHGBABCBE@QCA@CBABABADKJCBBBF@F@DBD@DBDL@DED@HBBBHBDDF@F@FCFCDCB@DBD@BCH@BBBFD@FBBABCDIDEDGBGBCDAD@DDBAFAB@DCFABABOPKLGFKJGHCBC@CACBCBABCBADBJFF@FDD@BDBBFDD@DDL@BAJ@FBDDAFAFCF@@@@F@BCDCDC@@B@DDBBBBBBFDBADABBDBBDBDBD@@@B@FBD@@@BBBB@JBFADABAB@BDD@@DFFBFAHADEDAHCFCH@JBLBFAHADAHCHIBCDCDABBPFBB@@@@LDPFJDLAJCFGFIHIL@TDFBJDNDJDJFVLFFFHRPRJLJLJJFNDRAVGMIPIHDJFRKJETBDK@MAEEEAABCAC@E@@@@A@@AF@B@@ACABIGIB@DBB@BBBCAECCCEDCB@DEACDEAEBEC@CCGAE@CAGG@AD@D@D@@G@AA@CA@@@A@A@ACEC@E@AACA@@AB@BABADIGACBCEAA@@@EBCFCDCBAAAECACACBCDC@E@@@CDC@CDI@@@AAACCCA@AACCCAAAAAE@CAA@SGA@EACAEEECAAAE@EAECC@AACCGAI@AAGACACCAAACA@@@B@@C@C@GCGBCBA@GGC@JQ@ANQDA@AD@DBBAPKHG@CBQFEDADAG@AAKDa@OH@CAqE@C@@@EED@BGAE@C@AOECGEGCEA@AYAIUKA@E@AJEJENOT"],["@@CGEQASEMMSONOHK@KDQDWFGJIFWJKBMAOAIAgIOCQQSBED@F@LBRDLHJNHJFHFPDLDPLHDFLDJAJCLMJKHKFCDGFBLAJEHADQFQCO@MBKHEJADCHAL@LBN@N@D@F@VCTCHEPCFCNANEJILMHODORAHCLF@B@VLBJBZB@DFFHDHPF@B@DBFAHC@FF@@D@F@Br@DPGb@LCBBH@BC@C@A@AD@BADAB@BBB@@@BABABAD@@@@B@@@BDF@@AB@@BB@@A@A@AB@@AJ@BBDBBB@FBBAJCFBABDBFDA@@@ABAB@B@@@B@@@B@@C@A@@@AAAAA@C@C@@@EAGACA@BC@EBMFEBC@GHOLABCAC@@BCBMR@BIRD@HHB@DAHAHDD@D@@@@A@@DBBBDBBAFAFCHCDA@AB@B@D@B@BAB@BAAC@ADAB@BAD@BC@A@A@ABC@E@A@@B@DBB@@@B@BABA@AFADABC@@B@BAD@D@B@B@BABADABAD@B@B@JC@@BB@@@@@@@A@AAA@@AB@@@@AA@@@@@AB@BA@@D@@D@D@BJDFDH@DAB@DAD@BBCB@B@BABD@B@@FBDBD@D@BNL@B@B@BABA@@B@@@B@B@D@B@HAB@FCBAB@@BDB@DBF@D@DAF@BBHBJC@AHBD@LL@DADCBWASCEBA@BHA@@BDB@DAFJJBBDBDBDFB@D@@@B@BB@B@BEF@@@@@B@F@@ADAD@BBDBB@BB@@@@@BBDB@@@@@D@@DA@@B@@@B@@@@B@J@FBBB@B@BABAFB@BAB@@CB@@@B@@@@F@BB@@ABE@AFBFCFBDCFA@CDDFDDBFADAAA@CAA@HJAJDB@BA@E@@BB@@@@@@FBDADBBFFBF@NCLNFP@TARARGBA@@@@FAAALCAAD@CCFALEBAAEBKBED@PHD@DCBBB@NBZBF@BCBGHCB@FBDBJDH@BIB@JBB@@@AC@A@AD@DA@ACABA@@FAB@J@N@B@DA@C@@CAGIBABABABIAAAA@AFE@A@I@A@@E@E@ECGCCBA@A@AAE@AAA@@ACAGCA@CD@GEAC@EAAA@A@CECAEAATGH@BAFECEAAEI@EFECA@EBC@@D@@@B@BABAACAGBCB@HBBBLABCBAB@HBB@@@BAJCBCHDD@FADADCBA@EBCDCHBDA@@DA@@FDB@B@B@@AGEAC@ADADGKGACBCEK@AAAEAAGDEA@CCIACACCICIIDI\\CD@TKJADBD@HBJFHDDABADIFEAEEE@CBO@AAOCEAAABACAIBC@@FM@AAAAC@ACCEEGAE@GEEKCEAAKACCCAQEG@CBCBC@MCAAACBIBEEIGECA@ADGBADGACIECAFCDCFAFIACCAAA@GDKB@@A@GAABAACMDEAA@EGAC@@CAE@CBC@EF@BAAAAC@@@ADIBI@UAGGEO@IHKNGHAD@RAFEB@BABC@I@O@EBGBG@@BC@C@KAKIECACAAEE@C@IEcWMMIMQQ@ACC"]],encodeOffsets:[[[116646,38880]],[[116892,38636]]]}},{type:"Feature",id:"130123",properties:{name:"正定县",cp:[114.570941,38.146444],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@G@KAABKBG@GDABA@CDCBA@A@KAE@C@C@GBKBGFA@IBKBEBEBEDC@ABCBEBGBEBA@E@@@CB@@CAC@A@E@C@C@C@E@A@CC@@BB@@CB@BBLEFABC@AA@@GAABOB@FIBE@KHGFQLED@BCFCDEDAAABADCHEBGBITA@ELEBEDEFGH@BAB@BAJCL@@ABKP@BEJCLCF@@ADCL@Jb@`@J@@ALAHDBD@D@@B@BL@D@DBBBD@@N@@D@@@F@DAF@DADBBDA@J@D@BF@BDJ@@B@BBB@H@HI@@DAB@FC@ABAHAJBH@HB@DBD@DDDDAHTBHA@BBF@@@B@H@DVRLAPENATFD@BBDF@D@@BGB@@BB@FADA@C@G@C@@T@F@F@BA@AAYAAAB@ECEC@I@ACAGDEDCNOJERFHDD@NABDFA@@FCDABC@ACA@A@CBEDCAECC@@HOF@^CBMB@F@DCBA@D@LF@FB@@@@ALNB@A@@BAD@D@F@LCD@H@DB@F@B@LBDBBH@B@B@DB@B@HCHAB@BEBCFEB@F@@P@@HB@ADBBB@HBBGBC@ABEBABBHA@GJ@AC@@@@LGDAFCJCL@@ED@BBAFF@FHJAFAF@@CDA@I@CC@AEAAB@B@B@CCBAFHBDDBJ@F@DBDA@@FCDCBADC@EAAKAECAE@@@G@G@IBI@CQAGEMA@AIA@EL@BAAK@C@@CAA@@BAI@EKBCBABA@CABI@CB@@ABI@CEE@IBE@C@CB@BMCK@A@CGCAA@A@A@C@C@@@EB@@MAAC@@@@E@C@CAWD@@CUACIB@@AD@@A@AE@@ABABOEGA@AG@GAI@KM@EBC@GDIBCBMDC@C@A@C@@@I@C@"],["@@CADC@@E@C@C@A@@@@B@@@B@BB@D@@@@BAB@D@@@BD@@@B@F@@A@C@C"],["@@@@@@@C@@@@A@CAA@I@@H@D@@BF@DJBJ@J@@@@@@@@A@@@@@A@@AC@A@@A@@@C@@@A@A@AA@A@A@A"]],encodeOffsets:[[[117372,39025]],[[117365,39214]],[[117325,39237]]]}},{type:"Feature",id:"130125",properties:{name:"行唐县",cp:[114.552714,38.438377],childNum:1},geometry:{type:"Polygon",coordinates:["@@BEAAABA@@EC@EA@EA@@@A@E@BAA@C@CBQHCLABE@EBMAGACAAABKBCFIGGE@@BE@IACAE@A@A@AB@D@@C@C@A@@C@@C@A@@@A@@BA@A@C@@AA@A@@@@DA@@@CAA@A@EBE@AB@D@BABEDCDAB@@@BA@IC@@AEAACA@@AC@A@@A@CA@@@K@ABCPASEMBOFKBCDKFA@IFABGDCBEBCBGHCHAD@B@DEFIDIJMHGHEDKJEDCLCFKRABEJADIJULABOCIAGDCBUBC@C@KFEFIHIR@@BNJLJNHL@H@F@@@@@BCTCFM^KFA@ABAB@BED@BD@BDABEBIJGFC@GCAAA@QEA@A@A@ADCJBB@BDFFD@F@BADO@GEABEDA@E@ED@H@BAB@F@BA@AA@BABAFCJENSVCBUN@@[ROLEDYRKL]REBGNEJUhR@APXBP@XBNDLBhHNBRDVFLBNL@REJIFCNMNCfCTDDBJDRHTHdHJIZINBRLRGJCFCHBJ@DAFC@AAGTGB@LEDC@@@@FAJIFGDAFKFEFE@@PEBAB@@@JAAG@@@E@@@IBIFA@AHGDEBEFGFG@C@CEIKGAAKEICECACDKHHPLFAFCF@NDLEHDFADBABHBRDB@@CBKFKBCDCDEDGBEBCDCHEFEDIBCFEDGBE@@DCD@BAFC@AAGBEHIJKDCDCFAHANKFEBGBCNODCJKHKBEBABCNUJIJGB@DC@O@CACGEC@GCC@A@CAKCAA@EKA@E@AI@AAEC@ACICMBCBBBIBCLABIAC@@ECGGIEACA@E@IB@FEFCAIE@EA@CBA@CA@@EAA@AD@@EC"],encodeOffsets:[[117369,39300]]}},{type:"Feature",id:"130126",properties:{name:"灵寿县",cp:[114.382614,38.308665],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@ACAA@C@CAKA@@@@CACGCKB@BI@_@a@DLELEDEDDDABA@GAADBBA@A@MDA@ABA@@@E@C@CCCCA@KCC@ABGDK@C@ECCAGAC@CC@AAAA@A@A@CACEACA@EBCG@GB@EAA@EA[ESEGNCHEHCJAHCH@@KRADAJCH@HDFDDB@DAF@B@BJ@JD@DD@L@B@B@B@BAAAAGD@DHBPH@D@F@FFB@D@D@BI@@@A@@BDH@BKA@A@EE@CBIB@@@JD@BAB@AFC@CB@AA@AAAAOJCBEBEJ@B@BBD@BABABCBA@ABA@@AABCDEHFJFB@D@@@@BFA@B@@BGDA@EBIAAEA@A@ABCDA@A@@A@CAA@@CB@@ABA@A@E@OHADFH@@@J@BABA@CBGDA@ED@BBB@@AB@DABBB@B@B@@AB@@ABB@@BD@@D@DHD@BA\\ENADB@AD@BCBAB@B@BA@ABA@C@C@@BCLBD@@BFDB@B@@ABABBB@BB@CREFABIBGD@BC@K@[EABCF@FADQP@DDBFBHBBDCDA@A@@@AF@@@@CCCAA@CACAC@ADABC@ABABAFGFC@CBAFIFIBOAA@CEAGA@EBA@EEMECAG@OK@EBI@ACAC@CAAM@KA@@@ABC@CCEBCDEBIACAAACBC@ABA@CAA@CBAD@JABCBCBCB@BEB@BAD@BHD@B@FBFBHJL@@@BCD@B@@FHDBDB@B@@A@ABC@A@@@A@IAAACDA@@@GCA@GDEB@@CAA@CCAAGCGAGBGBEDC@E@C@C@GFCDE@C@EBCD@DADCDADC@C@E@IBABC@EFABGFBP@DAJIPABOHOFKHGJGFGPDL@FDPAN@@@@AHSd@NAD@@IP@@G@OAMBGFCNBF@NCFKHKJ@NDPIZCJINCPDRTDLEVKNEDAHBHAHBD@D@D@B@DAB@@ABADA@AB@BAFCHCFAFEDABCDCFCHEDAHERGDC@EBICAACBAF@DAB@B@@@D@F@D@F@@@D@BADAB@B@L@DBB@B@FABA@@DC@@BC@ABA@@BABCBABABABABADAH@DAB@@@DCBCBABABBD@DDBBB@BBDBB@@BD@D@HFB@BADADCJBBBJHBBB@DABAACDC@AF@@@BABAB@B@LHLDB@BCACDCDAN@BABKBCHBDBB@LA@C@GBCDC@@BAFCBA@DB@F@LBDBFADCNAB@@BHDDBHADAD@DBBH@DABBBBBDBLFFFB@FBFBD@BADCD@D@FAPDVgFIHMFA^QLKZQFCPK\\Q@@VMDATUFMDIBEBA@ABBB@@A@EBA@A@GFCF@B@FCBAHFP@BC@A@EECCE@AAADIBCB@B@B@RFB@BBHDD@HEJIFABAACC@@AFC@ABABAB@LEN]DEDS@A@@@@@E@GGKIMIKAM@@JQJGFELED@D@VADAHCJBPDBAVKJIBCFIBALQDEDKFCLIFCHGNGJIJCFE@C@ABCDGHGDAFADAHCBAJEB@LEDCUQ@C@G@A@@AE@AGBSABGCCCCC@CAA@@GAGBIBGBAD@@EBA@CJ@@G@GAA@A@AI@ACE@@A@C@ICBAABC@CBE@C@E@@@CM@"],encodeOffsets:[[117191,39187]]}},{type:"Feature",id:"130128",properties:{name:"深泽县",cp:[115.20092,38.184033],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@C@EE@CAG@@CE@@AADKH@HCFC@E@CA@C@@GEC@AAC@E@CBCBEFGBAAAACDG@C@C@G@KCWAA@BCGAI@E@KBC@MCEA@@C@G@@@CBA@O@KG@D@L@BE@@B@B@T@R@BHAB@BL@DA@@FDB@HB@BJAB@B@@FA@ADB@BDBH@B@FDHF@PO@CBCB@FA@@P@@AJE@AF@B@@FD@BADH@B@@BFBBF@BALF@@FBBB@BD@PF@DDDDHHDFHRD@NALAB@@@@@H@FALDD@RB@@FE@EB@FEJ@AAJ@DA@A@IH@BGFCD@B@B@F@B@@AH@BABB@@BDDA@DDPB@D@DBFHBH@BBHFF@@CBBFB@B@BA@@@DC@BDGF@DBHF@D@DBDADBB@BABBFABBB@BBFB@JF@@BD@DFLCHCDBD@@I@EACBED@BCI@E@@A@E@GDGBAD@D@JEBA@GCO@A@ADCBAHAJCRCV@@BB@@DADBDDDFDFBL@ND@@AY@IAOBEB@F@N@ACHAACNAHAHEBE@IACEAEAEEAEBG@G@ABK@@@EASDMD@AAD@AGAKDA@A@K@AD@N@RABI@MF@@KEAUA@CAAGBGDC@CBABIBC@AA@E@@C@GBIB@DC@OD@@@EE@[DGBSB@RABC@G@KBCKAGOBYBAB@B@BEBEBWAA@"],encodeOffsets:[[117993,39047]]}},{type:"Feature",id:"130127",properties:{name:"高邑县",cp:[114.611121,37.615534],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@AEQA@@C@G@@FIBE@QDCBKDCBMDA@@BIBKDEDEBEDUHGB]FK@GAOAUGGP@LDHLHDBJR@@@@LRXPDD@B@DDHDNLPFFHHDH@FBF@L@F@DD@@BB@F@BAJ@HA@@@EB@D@@@N@@HDBFDLAD@HHDDB@DABFDH@B@@LEFCFABADAFA@DB@@D@JBBHBBBDDLBB@BAH@BDHBH@BFHAD@H@LA@@FAA@AEDADCAECEA@AG@CJAAC@AD@PC@CAG@ABCDABG@A@AD@HDHBFEJAB@BGDIDABADAB@B@D@BBB@@CF@DADAAEDBBDJL@JF@B@HABFB@BBFAAAAAAICAC@@EECC@A@EB@A@@@@@ABAD@BEBAF@D@DA^DBADESI@ABECGOCACAGAECM@@@@AE@@@@@@CG@@AGGOACAAAAGI@EAIM@IAKCKAEC[EI@SBOAQ@EAQA"],encodeOffsets:[[117355,38456]]}},{type:"Feature",id:"130129",properties:{name:"赞皇县",cp:[114.386111,37.665663],childNum:1},geometry:{type:"Polygon",coordinates:["@@EKCE@AFEI@E@CAE@ECACOCIA[C@@K@DLBNBHBTBJAPFJFNFRNLLLLJJJHJPTT\\HLJPPPPRPRJNFLDJEPIV@LDLHJLNHJBLFPFNDPALERNTFNBTFRDHDD@BRRJNNNdXJFD@F@HGBC@@@GACLGBAA@BAFANAJCDAD@FEFBHEB@@@FDDBB@B@BEB@B@LG@A@@DARDDLBF@B@BBDDBHBJADJDDHBLAL@PCB@D@@BDDFBBBFAJGHIH@HCDA@@HBJCFABAD@JBDBB@B@@GFCB@@@@@B@@AFAD@B@@B@@F@FHBF@DAB@@A@G@@B@@@@F@H@BBDBDBNFDADAHK@GB@DCBC@A@Q@AA@AM@EB@D@BDHBDCFAF@HDJEHCFGHSBCJGDAvV@C@CFBBE@GPFFADBBBDBAGNA@@BC@EFG@C@AC@AIN@BEBCH@@@BE@@@@BBBB@A@CHCF@BA@C@E@KAE@ECGGGEEKOCMCG@C@ACCWOKQ@@@@IQCAKGCG@KHOAAEA_USGUGKAKAS@CAAACAIEC@IEOKCC@CA@MAIA@IBABCAC@CCGAACBEBKFABA@G@@A@GI@MGC@AIIC@AIAA@I@C@EDCBGAAA@CAAGF@BGHABAB@AB@@AECE@EAAACCA@ABCAACDKAAGD@B@BMAAAC@ADABGD@@@CA@I@CFE@@DADG@EB@BGBC@C@AACDUBCDIBEAA@A@KPGHCB@ACBGAC@EFCDEDEBKBCAA@IDCCM@IBIH@A@CDEPCH@@CACACCA@BA@EBCC@EHCD@@@@ECCEDGB@A@CHC@@CGAAIAE@A@EAGIA@IBGQCABECG@AA@ADA@EFC@CDEJABABABCAGBCBAAKIKUCCEIKKCOGC"],encodeOffsets:[[116812,38357]]}},{type:"Feature",id:"130130",properties:{name:"无极县",cp:[114.97634,38.179192],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@C@CAAAAAOI@AAABA@AI@KACAE@C@MAK@E@ABAD@@C@A@@BAB@F@@G@CA@BA@A@C@E@ABCDAD@F@BFF@B@L@DBNF@JFCFAFDB@DH@AJF@B@@@AHE@S@E@A@AA@AA@@B@B@B@@@F@@@BC@@@@B@BAB@AG@E@@BAL@FA@BDABC@AF@HADEBA@@@@F@B@@F@@BBBBLCHABI@CBEB@B@B@BB@@BBBBR@B@BBJD@@ABAB@B@@@@F@@@BD@@@BB@@@FAD@BC@AD@@@@B@@D@@@@@@CB@@@BK@A@MDC@@B@D@FB@BB@@@DB@FBDBB@@@@B@B@@A@A@@B@D@@A@AB@@@BA@@BB@BB@@@AD@BDE@@FBFB@BAB@BBB@BBAL@HDB@DBBH@JBLDJEF@F@B@BDDBF@H@B@@HC@BP@DDD@NBBFABCBAF@DLFAD@@FDBBA@HD@J@JCDADEBBN@B@BBBHDB@BZCB@TA@AJDB@@BBBAB@H@FJ@@HHHFFH@JAHAJAFERB@@JBD@DBJ@@@ACD@HBACH@DEB@@AJAFB@ABABMCM@@@@DC@@DAPC@@DF@@@BJHPF@@PDRBNA@@@ADEB@D@BAB@@AAA@CAAD@D@BBBDBBB@DADAB@B@BBPLBB@@@B@LC@A@BH@D@B@@EF@BGFAD@@BBBBBBJDP@@@@@B@HIDIDC@@BA@@@@DCBCL@B@@ED@@I@CAANAFAF@FABBJAB@BAH@DANCGQCEGGCCCCE@@OACA@AA@EE@BK@AAEEA@AA@G@BC@AEC@@@ABEF@BI@@@OB@@EDADAP@@OGEECA@G@CA@ACA@BEB@@@ABAAIA@AFE@@BACA@C@A@@ACM@@A@A@ABADABCBC@AEAAQA@@A]CAECCAWMII@HA@AAGCCAECCAIMEKEE@AA@@AAACGA@ECAAAAAA@@]SA@OEBDA@Y@CAEACEECC@IC@A@@SGGI@@A@YCIAWAEGCCAAI@A@BBE@"],encodeOffsets:[[117654,38976]]}},{type:"Feature",id:"130131",properties:{name:"平山县",cp:[114.195918,38.247888],childNum:1},geometry:{type:"Polygon",coordinates:["@@@E@ADCFEAAA@ACA@ACECHG@@@@BA@AIEA@@BA@AABAAACAA@BC@AMAA@AACDC@OGC@AFALBFABKFEBDDC@BBKDBBEB@@@@ABQHQBSBO@MESAIFQLIEGCOJNJUHQBMCIEKIKIQIQOEGEEUKIEICMCICEASCK@GJEJEHIDKBICOEKC@@@@AAOEAACBCDADGJGDCBGBEBKAIAG@EDGDCBCFGBEBEACE@@CC@ABABABCAE@IAAAA@@C@EAA@@@C@CACAAAACABEBE@GAGAGAICEA@AS@KDODSFQBOBM@E@E@CAEDCAAAC@ACC@EBABCBGDABFBJFDB@DABBB@@DBDBFBBBBB@DCBABCBCBC@CAA@GCC@CBGDADBFBDBF@DAFADCFC@CAECKEE@AAC@OCOBKDIFABEHOTMJMDKBU@UC[EEAU@KDMHIJCJETQNOPCBEF@T@HBDBNDNBF@BHRDLBT@NBPIRUNEFGFGLEJCPDPHPBLFPDLNPDDLLNJLJLHDBLLJJ@NABABCDKJGHEDCDGFCN@LCLAJAL@LFHFR@V@NBNDFFLNDHBDBDBFBJFNJNLBDDHFRGLARDLBPBD@DDJBFDJVLRBRCLCVAJAJ@NBR@PBVFPHDFDJ@J@L@RALCTBLDJNHRATIJGDC`@D@RHHHDNBLDLFPJRHNHNNJ^IXCCQDOJMDIJYCO@MLILGDE@MAEDMHENAPBH@@@JO@@BC@MTcBG@@@@BMCO@ECKHOHEHILGPEPGBAJOBI@CAOHEBAFED@BAJAF@D@D@BCDCBC@CDCFAD@F@DCHED@D@F@D@FCHAHAHBHDBBDDB@DB@@FAHCB@HD@@B@DCBBJBB@@@B@D@BAB@@@@ACACAEG@@@ADC@A@@IKAGAE@E@AGC@ABC@AFA@ADADADABA@IBCDAB@DBB@BAD@DABBDBJBFADCFADDD@BA@@B@@LBNDBD@DB@BAJ@FPLH@DBNFFFB@FAB@BHDFB@PBJAJEBEDAD@HEBEBABAD@BABCD@DBDBB@DBDD@@@@BE@@B@B@DCACGAEACA@CROBC@EDEBA\\FL@D@@AHCJABAFEDQA@@AAABABA@@@ACAAE@@ACDK@AD@D@B@BAB@@A@ABADA@ABCA@BCFMB[@AGC@C@CC@@AA@BA@@BA@@@A@AAABA@CBA@@AA@AFCB@HCDAB@BA@A@I@@EGBCPGF@B@B@BA@@DA@@BB@D@BB@B@DCBAB@B@BFJBFAB@HC@AA@B@AE@@@@@CEAEIFGDCBA@BB@BAB@DABABA@AAC@A@AFIFADAPIBBBBB@@BDAD@BEA@ABC@@I@@JADAF@@F@BLB@ACG@AB@@@J@@A@C@CEA@E@E@COGGA@CHCBBBB@A@A@A@A@KCCC@@IAIA@E@CBA@CCCE@GDGBIBCLQ@@DGBGDIFGDGHMaIKAAAG@G@SB@@@@@@A@A@@A@@@EAE@AE@HK@EAE@CC@@@AAAC@GG@OFCBADCBKC@AGCGCGBAAEC"],encodeOffsets:[[116938,39125]]}},{type:"Feature",id:"130132",properties:{name:"元氏县",cp:[114.525409,37.766513],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@A@@F@@GBI@ABE@A@@AC@ABE@GD@D@BAAAA@@@@AF@@G@ADAFM@BJD@@B@DEH@FAD@@MBBHCAAACAEBOE@HAFEA@D@DuUCBIHADGTEHGDIFGCE@EBCDGAACC@A@@FBNB@@B@R@BADCDA@@HGLCBCBMECACAAAG@E@@@@@@AH@B@@@BA@CAEEGE@@@@AA@C@EB@BA@@@@@A@ED@HA@A@CAIAC@ABEBIDGA@@CBGDG@GJIHEBAAEACC@AC@A@ODK@KBGACCCIIBGACAAC@A@AAECKQCCB@@@BKHA@A@AFA@A@CAEC@@A@GFEAEFC@CBIDMBEBABB@ABKHBD@H@@ADGHBFDBDBJFBL@L@DAD@@AHAH@F@P@JADABA@EFQBC@GBMHGL@JFPHHVBJ@JABC@@D@BBBB@AFED@DAF@DB@@BDFHB@FBNCBDABBB@H@BA@CL@HBBDBBDEJEBCDEDDBJFBDCHABCH@BDBHFFJAFAJBDBBNDD@DADAH@RFDBJGHAFAAAE@CAGIAAEC@@@A@AFADE@@BGB@FAD@J@FAFCHA@@@B@BD@@AB@BDA@H@FD@DA@I@@@@BFHB@BABCBA@@JBHJDB@EHKDCBAJAD@F@TJ@@@CCE@CFBFCACBAHDJDFALAFBH@B@BCJA@BJAD@J@@AHBBBDBJ@BA@A@AAAG@BAD@H@@B@@B@@B@FC@AHFBFBFBL@@DFABAB@XHBB@BCD@FLFHHHBFFB@@BLAB@@CDAH@D@@BJBB@B@BB@BB@B@BBHADBB@BB@DB@FBD@FABA@ABAHA@@@ABE@EBCE@@GAEBEGCAA@ABG@G@AD@JBDADBBDJDH@FEAA@AD@B@B@@AL@@BC@@@A@@@BBDANBB@BBB@BEDCF@@A@C@ACCG@GAA@@C@AHEBCDADAD@D@@DH@B@D@JEB@BC@EBEAA@AO@IEAAA@BC@CC@@A@[@CH@@C@@@@@C@@LAN@LCD@HCLE@ABEBCF@PA@AB@H@@BD@B@LBBB@DHBFDJ@FBFFBFFADGBAC@@AAIAI@E@CB@@C@@A@BEFCF@B@@CF@B@@@EA@ABI@AD@@@@EA@@G@CB@FADA@C@ADCDA@GAAE@GDA@WHCAC@@AEBAAEE@EFCJ@BC@@AAAAE@A@@FC@AE@ABA@EDABCH@@AF@@EFAD@BAACDAD@@G@@AEAEAAAGBCD@@CBADAVAAGF@FCF@@EAEC@@@@@@@@AAM@E@CCGAAE@@AEB@@KBG@C@GBAEG@GAACG@ABA@KACCAAGAAA@I@CA@@CEBCBABEBEDKF@@@ACGAECBA@CCGGC@KBECCA@GM@@@"],encodeOffsets:[[117291,38572]]}},{type:"Feature",id:"130133",properties:{name:"赵县",cp:[114.776297,37.756578],childNum:1},geometry:{type:"Polygon",coordinates:["@@AE@@@@@C@AB@AEA@BGGE@@CBAE@AAAA@C@ABCBE@ABA@A@G@@AB@@CGAICQ@@@A@A@ABQBIBIBQBOBCFAB]CCBC@E@ABAFC@AB@B@@@@@BFAB@D@FD@FD@DBBJBBBBEBAAA@AEGBA@E@@IIKACCABFCBCBE@@DA@AAC@A@A@CBABCBCJAHA@IBEFGAGCC@@B@BAHCBAD@BBH@DODC@@BBDIB@DBHB@DFBFCDCBBFB@@BF@BBDH@D@FBN@B@@@@@@D@BF@FE@EDE@BHUBCBAB@DC@ADBHBBBFBF@@@HC@CBBDABC@EB@FE@@BG@ADCB@FAB@BBFD@@EB@F@BBBB@@ADI@ED@FFFBBFA@BD@DBXGB@HCF@BB@HCBCD@B@DCBEBA@@D@HB@@F@@C@@BAJ@BFB@@A@ABFDL@FBF@D@JBDGLGBBDCN@RHJHFDDBD@FB@@@@B@FBD@LDD@@BB@@@LER@KJFRFBPBD@B@NBJDD@HBPFJFBH@BCJCDGJELCJDFFBT@ZAB@D@HRDBDDBDHDFHNJJIBADCPGL@D@RAF@ZBB@DBN@NBNCLCNEJCLAJMC@@@ACBA@AJA@EB@BFB@ACBAF@H@@DN@BBH@JBB@B@HC@EDCF@BDL@BAD@DD@B@BFBD@BADBB@BDDBF@@@DBD@@CBALBD@DBH@@BATARD@N@@A@ABAF@H@BKAAACA@AA@I@@@AF@@@@O@CB@H@F@BAJBBIJ@BWEC@@HOFGDAHMBADCNMBCBAHE`IRDEI@@DEZEBI[GCAA@@EC@@I@AFQ@AEABEB@BKAAAAA@MAC@CA@@@CC@AAKCKEKFGFBBBFCDC@K@IFG@AHEDC@C@@ADCF@@M@@@A@CCAA@E@CC@CECUBCBAB@JA@@AE@AEE@CGCI@@CBECAACEKGCAO@@@@AA@@BKDM@@HGB@D@FCB@@@EAAYAEBABA@EAE@I@@IAAEK@@CA@A@A@ACAGDGDC@AAEBA@@EGICCAAA@CB@C@CAAIBC@AA@CCEE@CBOAAA@EACEIAA@@ABAEFGCEODC@@EBCEDEIAAGBAAEA@GCCAAE@ECAEBAAE@AEA@G"],encodeOffsets:[[117561,38551]]}},{type:"Feature",id:"130181",properties:{name:"辛集市",cp:[115.217658,37.943121],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AA@ECK@ADAAEAAC@CK@EHA@A@CAEABC@A@C@CAEBI@@@ABAD@BCBEDCBICABGBGBA@@BCDAHB@BB@N@F@@@B@@A@@@CL@@AJ@FB@JBFD@DAH@@@P@BC@@@A@ALABCBAHABADE@@@CTAHCB@HA@A@E@GBA@@FG@@HC@AFC@KD@F@@@@CCG@EDCFMACBCB@J@@IB@BA@GAAC@@SJ@@AAG@CD@FCBAFC@ABG@CBBBEBABAA@EC@ALAB@B@@@D@@@@@FAB@DVJ@DAJD@@BEDCHCB@FC@@HE@ABBDF@@B@BADA@GAADA@@D@D@J@BJ@ARI@@D@H@BJB@J@D@DAHQBA@@AA@C@AB@@@DIDQD@DJBDFBF@@J@@BABBH@@D@@FB@DA@DFDDAB@@AH@B@@B@DF@@HHA@DBJ@H@FA@@F@DI@@B@VB@@B@BBF@BBBFAH@BFADADH@@DHABD@DIB@@@BAD@BCBC@@FGB@FCDC@CECD@DEB@B@BBFDA@F@BDADAT@B@BDFBH@BBDDAF@DA@A@@B@@@F@DDBFA@H@FF@@F@NHB@BN@@FD@B@D@@B@@D@@B@JBTC@@LTA@CLDBBBN@@BHA@MBG@OBG@CDDDAFDHD@LDF@P@D@@B@DAHBH@BVB@B@JA@@D@DEFABG@@@AB@BA@Q@A@@@E@@B@@@BAD@B@@A@EB@B@BBB@@B@HRDHLL@B@B@@F@BJ@@L@@BAF@PAF@L@F@BF@@DH@DBF@@F@DBBB@XBFAFA@A@ABAZAPABHDLLAH@D@BA@QTAHA\\CF@@F@@PCD@@CJAHAD@@@@FBBD@JABADAD@HCHABB@DVBFBJ@DE@ICA@@@G@@ACB@LC@A@EF@@CDAAM@C@@BAFAH@@HBBF@JBPCBJF@J@@EBADADAB@@@B@D@@@B@BCDCVEHA@@@@HBBDBDF@@DD@@FH@@@DDBBB@B@BA@@B@B@ADF@@GF@DADAAEJAD@DABC@CCI@AG@CCAGAAI@KCACH@@EAKACC@@@AE@AEMDIA@@CAGAAKAGB@CAA@CF@BA@G@@@CBCCCCACAGB@CAAG@@@@CBG@AC@@AD@@@@A@A@@BA@@BB@BB@D@DABDLAF@@C@AH@BA@@@@H@AEDEGBMAIACAAAAA@CACD@@CAG@AFAFAJCFCAEAC@@@C@@@E@G@@@ADADAFAB@AEECEB@@ACEKCBAC@@@AAEAACAG@ACCBIB@@EB@DEDED@@A@EBGAG@C@BMAAAB@C@A@GDB@CAIA@AECCG@UBADCHAMAAC@A@CKDAAACCEAGAIAGDC@@@@@@C@QE@@M@AC@AI@EFA@@DAT@@CDAXAD@@AACFI@IAGE@BEBACAE@S@A@BIE@AB@@@HCAC@A@@CE@A@AE@A@I@AAAA@@ADIAO@C@AAE@AAAAAEBA@AECKAEBCBAAAAO@A@CB@BON@@@LA@@BCBAHGBA@A@GGBAEC@@GACA@CA@CA@CFC@@@AAGBCCCCCB@IAKBAH@BCAG@CFAHAACDC@AFAJAHABDB@@@D@H@BABCPC@E@ABAHEF@@@BEAG@ACBA@AAECKCK@UDaHC@ABKDA@ECCA@C@AAIACEAGCC@AAAA@GGB@AAC@G@@HALK@C@ECC@@@@@@EMA@@@AC@C@@AA@@AC@CC@@@UBC@"],encodeOffsets:[[118070,38565]]}},{type:"Feature",id:"130183",properties:{name:"晋州市",cp:[115.044213,38.033671],childNum:1},geometry:{type:"Polygon",coordinates:["@@@E@I@@@@@CG@AABACBI@CAA@E@EAI@QC_JGFABADMNCDABGNCBEHGP@@FDAXI@AJIAABE@G@A@@D@P@@E@@B@@@JBBB@BDBBALG@E@AB@B@BM@C@@BG@C@@B@BADA@A@@D@DC@A@A@@FA@@DA@AJE@@HABC@@BDLDB@D@DFA@DBBBDBA@@@@B@BBBBF@@@@L@D@BA@CF@LC@@FA@BDBBDH@DBFBP@@@@BF@FBFAB@BALEL@BAD@D@BCL@H@@@B@@@J@DBB@DAJ@BCP@@@B@B@B@B@FBB@DBB@@@BHHFJ@@BD@DAH@DAH@BAL@F@D@@@BHhPFB@^T@@BBBBBBFDB@DHBB@BB@@BFFFLJNDBFDDBHDBBB@@GJJXNDBFDDBB^@@RBBBBFD@DABABCBAB@B@@@DN@BB@D@B@BD@AF@BE@GCA@EB@@CAKA@GB@A@Q@S@A@AF@@A@K@CLHP@B@DA@@H@D@@@FBNDD@LAF@J@HBADB@XBLDH@D@D@H@DCBBBBHAFEDADAF@D@BBD@HF@@@DDBF@D@DE@GLGBC@E@KBE@OBE@AK@@@AIE@@@@A@AKKCGGQA@@@AA@A@AFAB@@@@ABC@A@@@AF@@@B@R@B@@ABA@@H@BAFE@C@CB@@I@AUA@AAGBG@C@AC@O@E@KCC@CGBECCDCH@PAH@NAB@AG@@AMAAKC@DSB@KD@AS@I@AC@@@@AC@A@C@@EM@@AGA@M@EE@@E@GEBCA@C@E@@@AB@B@@CBECCAAG@EAACA@S@CBCB@A@ECBAE@A@AFA@CDCDFD@DC@EHA@ED@DA@ABC@A@@JA@CACGB@CG@BCBCAEG@EBAA@AAE@A@AA@@U@AJ@@C@EB@@E@GAI@CGB@GE@@C@AA@G@@BA@CBEC@CCBA@@EC@@@AGBA@AI@@@AECEIA@CRCJC@C@@BAD@B@@BB@RABG@C@C@IIA@A@G@CJ@BQI@@A@I@C@CB@BCA@@EEBBE@@A@@@@@OA@CA@E@@BAB@D@BC@E@ABAF@DABEDA@E@M@AABCI@BCK@@@@@C@@A"],encodeOffsets:[[117860,38715]]}},{type:"Feature",id:"130110",properties:{name:"鹿泉区",cp:[114.313654,38.085953],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@@BM@@@@BA@C@A@@@@BGBE@@DABED@@AD@@I@AA@DADEC@@GC@@C@@@A@@DEFAH@@C@AB@B@BAB@@@@CB@@@@A@CACAE@E@@DCBAFAD@D@DBB@@AB@BIBABAD@FB@ADCN@DA@A@ICAA@BAA@AA@@BAB@AEB@BCB@ABB@BCD@@A@@@@@@@CB@@DB@B@H@@AZBB@@A@CL@DAB@BMB@BA@A@AEAAAG@@@F@@CBADCF@@@B@F@@CD@B@D@ABBBB@BFH@FA@BF@BAHCB@PG@DNBBCBCBIAA@GBGB@HB@E@AD@BC@EAEAE@AAC@A@C@@@@@A@AA@C@E@@@@A@@@E@AC@ACA@W@A@@@CEEBC@EAA@@CAAA@CAGBAAA@A@@AAAA@A@IA@AC@G@CB@DA@KB@AA@EEGAGGKE@EDC@AAAWGA@ABEB@CK@EAEAEABGD@@E@AA@@@@AG@C@ABH@BB@B@BABI@CAAAGA@BI@C@IB@AIBADA@G@EAKBEBICGCABBDEDEA@DDF@D@@SIE@C@IBABCDGL@FCAGIIA@@ABADABA@EG@A@@J@B@@CECG@B@ACA@@BC@@A@A@@GBEDEBI@C@EBA@AH@@CFEB@B@B@@FDBBHJDBF@BBEBGBIHDDLBBBDFFLHFF@HBFFDD@BBDBB@BEN@@ADBJBDBABBDFBP@BAP@DFFBFEFCJABCBGCIEGAC@CAIBSLC@[DCJJJJDDDDBJBDDB@CFBHFBBB@BFLADBDLHCHCB@BBDHF@BA@A@A@EC@@CB@@CBGACDAD@FABCDCBEBC@GCADIDAB@@A@GAA@ABADKBAAGAA@ADBHBDABABA@@@C@@@AD@FDBEF@FFJBBDFEFABG@SHBBBFFD@D@BBBFBD@FB@HDCB@HDDB@BB@BBF@BBB@B@DAHDFDF@F@@@@B@J@BEF@BBBBBAJABABABHJDB@@@DCBA@M@I@A@EB@@ABDB@BCBC@@B@BBD@@A@IAA@AJG@ICCAEAA@GDAHADE@YA@BADB@DBBBABBBB@@AB@JF@BAB@@@@GHFDBDB@BDB@BBEFCD@B@FFDBBHAHDHD@BLDDABCDAPEH@@HBDBB@@D@@DBF@FGLF@@BBF@F@@@BB@B@@@@@@@TAH@H@BBLBbJTF\\FFBB@FBA@@HDHFAB@BDDFDBB@B@B@BB@BDDD@HBDBFDD@L@HCBAD@LDB@DDDDD@F@@@B@BAB@NCB@B@AABCHBB@BACCFCFCFKCK@IDKBC@@DEDKFI@ALOBA@@DKBI@ABA@AHGFEFCFAFKB@JSHAFADGBCBAKICEA@EGACGI@ACCCBA@AKG@CCAAAC@@AA@GACC@CEBQ@@B@L@@CBC@CAA@A@@A@QACAA@@AAAC@EA@@ABCBO@KAC@AA@A@@@@CAA@@A@@@A@A@AB@@@B@@@J@@@@@@O@A@AB@@@@A@@@CAA@@@@@@A@M@@@"],encodeOffsets:[[117120,39e3]]}},{type:"Feature",id:"130184",properties:{name:"新乐市",cp:[114.683776,38.343319],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@E@C@C@AB@@@BMABK@@@@EAE@@K@CABCDE@A@AN]DE@GP@@DDBFCDAF@D@BDB@BADCBED@@EBACMBC@GCQEIFMPCDCFBHBDJ@D@DF@FBABBBZB@F@DADAF@D@D@BAHAFA@BD@@BFC@@@A@A@A@E@GF@JAD@@@@A@A@@@@I@I@IA@CAE@@@C@GJ@B@DBB@@@@@@D@@@@B@F@@@@CD@D@@BB@B@@@B@BB@@B@B@@BFA@A@@H@D@@A@GD@@AF@@@@CBG@E@CF@D@@A@@@CBA@A@@C@A@@A@A@@@A@@B@D@D@F@@@CDDBH@F@BB@C@@C@A@DC@@A@C@@C@EH@@CF@@C"],["@@@BABE@E@S@@@@D@H@DCBEBA@@AA@AH@@@CCEAAC@OBAD@B@L@@DBB@@@@BBD@@DBBBBF@@JDB@@A@@BADCFCBA@A@CBAF@FAB@B@DB@@B@@C@@B@B@@BD@B@B@@AB@@@B@D@@@@DB@D@D@@@@CBAB@B@F@DBJBF@@AF@HHEJADALBBDBHBNBFAF@BADKRGDAD@B@ABF@B@@@B@@FFBD@@FB@BABBAFFD@@BCB@FB@@DBB@DAB@@FJFDBFE@EJAF@B@BDJFHHFD@@BDAJKBADAJAAADDNDJ@BFDBBJ@@B@FLB@FBBLDDBB@D@HDD@HFBD@D@PCDA@IHIJMVADPLRJXJDB@ZL@L@BWRIDAD@LBB@PFD@@@@@TJJDJBNBD@T@FAJAPALALAFC@@DAHIFO@CBABIFGH@@@AI@ACAEA@@@@@@KB@CAMJEDAHC@K@GJ@FB@BBP@@DPA@@DBL@@BBDBHAAEBEHCDAB@DDDI@G@@AGAAABAC@EBEBCB@BBBBHBB@@GB@@FD@D@BE@@FCD@PGJGBE@@@@DCLKH@D@@C@C@A@A@@BAH@H@BAAA@@@CB@@CA@AA@CB@HCL@DEBA@CACBKBEBA@IF@B@BC@IAEAC@GD@@C@CBAB@BA@GB@FAB@HBB@@ACKEKCG@@IBC@CAACCIAIE@@AGCICIAEQ@I@AA@CAC@CAGAA@EGGACEAG@EDE@@@E@@AE@ADCBA@ACAAGAAA@M@AACFCBIDI@@BGFBFKFAAA@A@IDGDCBSHE@@B@D@HABEBI@@AAFIDGFABCFGBIAA@@@EBE@O@AAE@GBIBEB@BDFAB@D@@GFA@C@MAC@KAAG@AGBC@A@ABGBEBGAGBC@@A@A@CA@A@EAA@AC@@EB@AAAC@A@A@@A@@@AB@@AA@E@EBIBEGE@BEAAC@@FK@IDEDCBKH@@@@BDI@@HGBAAABAF@BADAHGAA@AABCA@@GO@@@@EFADEFA@ABADG@G@ACAA@A@G@AAAC@K@A@ECAG@C@KD@DE@@DG@@F@DD@B@@@CDB@D@@@@DAAE@G@@D@D@BE@A@@@C@C@E@@D@FAH@D@@E@@BC@@H@BC@G@@@@BEB@AA@A@@@AAA@@@A@A@@AC@C@@D@@E@A@@B@B@BBBB@B@@@D@@@B@@@@BBD@@@B@@@@@B@@@B@B@@C@IBE@@H@F@B@B@B@@ED@AC@@AEBGBABC@C@E@CBCBE@A@"]],encodeOffsets:[[[117376,39202]],[[117294,39264]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
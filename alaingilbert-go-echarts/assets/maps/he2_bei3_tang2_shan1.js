@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("唐山",{type:"FeatureCollection",features:[{type:"Feature",id:"130202",properties:{name:"路南区",cp:[118.154354,39.625058],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@EBABGB@@EBEDEDCBABEFEBABCBDBBB@@@@DBFDB@@@@D@@@D@H@B@B@@@F@F@@@FD@@FF@@@@@B@DB@BCD@@C@AB@@AD@@@@@B@@@BB@B@@@@@@B@D@@@@@D@@@@@@@D@@@@@B@@@@F@@@D@B@D@@@F@D@F@@@F@B@B@F@D@H@@@D@B@@@@@L@@@@@@@@@J@@@D@D@B@B@B@B@B@B@D@@A@@B@@@D@@@B@B@D@D@@@@@B@FA@@B@B@@@B@@@BBDBB@@@@@@B@@B@@@DBFBBB@@B@@@B@@@DBDB@@BB@BB@BB@@@@B@@@BAB@BA@@B@@A@A@A@E@C@E@C@@@@@@@@AA@@@AAA@A@AA@@A@@@@@AB@BBB@B@@@BABAB@@ABCB@@C@C@@BABAB@BADCBABA@CACAA@@@AAABABCBCDEBKGASEBE@EACACIBA@A@@AAA@A@AD@B@BA@A@@F@@CAE@@ABA@@@AA@@A@@@A@@@A@@@A@@@@AA@@AA@BA@@B@@@BA@@@BB@@BB@B@B@BA@@@@@@@C@@B@B@B@@@B@AEB@@A@AB@@@D@@@AAA@@A@@@AA@B@@AD@DCHCDAD@DAB@B@D@@FD@@EB@B@D@B@B@@DB@D@FAD@@@@@D@B@@CBC@@D@BA@@@ABA@AB@B@B@@CBC@ADAAAGCIAHA@AC@BCGDAI@AIBAAABAIA@@CACABA@A@CBC@C@C@@@AAAA@A@A@EB@@C@CA@@CC@@C@@E@@@@@C@@BE@CAABA@BAAABAE@CA@@ABB@AB@B@@A@@@@B@B@BA@@BA@ADABAD@@@@AFAFAFA@A@AAA@@@ABA@@AA@A@@@A@@@A@A@@@A@@@B@@@@A@A@@B@@AGA@@@@A@@A@@@@G@@@@BA@A@CA@A@BA@@@@@@@A@@@@@A@@@@A@@@A@ACAEC@@@@AB@@@@@@@B@@@@@@@BAF@@B@@@@BBB@B@@@@@B@@@@A@@@@@@BA@@@A@A@@@@@@B@@@@@@@H@BAB@B@@C@BD@@B@@@@@BDBA@@BJA@@@CBA@E@@B@@@@BFB@A@ABA@@@@@A@@B@@@@A@@AA@@@@@A@A@A@@@@B@@@@@B@@@BA@@BCA@@A@ABA@@@A@@F@@A@AAA@A@@B@F@@A@@AA@@@AB@@@@A@C@A@@@A@A@AB@@ABDA@@BDBDB@B@BB@@@@@B@B@@@@BB@@AB@B@B@B@@BB@BDBBB@@BBDFBD@BB@@B@BC@EDCBA@@@@BA@A@@@A@@@A@@@A@@@A@@AA@@@@@A@@@AB@@"],encodeOffsets:[[120986,40517]]}},{type:"Feature",id:"130203",properties:{name:"路北区",cp:[118.200692,39.624437],childNum:1},geometry:{type:"Polygon",coordinates:["@@@C@@@@@C@A@@@@A@A@@A@@@A@@@@BC@@BAD@@@DC@ACAA@@@@@E@@EC@@E@@@E@E@@@A@A@G@C@@@C@@A@ECCA@@@@AACAA@EB@@A@C@ABC@ABE@EBCBCBA@G@I@CB@BCHABCDCBADAFCFCHCD@D@B@BFJ@D@@@B@B@B@B@@ABAD@B@@B@@D@DAHAF@DB@BBBBLFFBDBFBDBDDB@DFNP@@@@BBBBJJJDFDDBFD@BB@@D@D@@EF@BCFAB@B@@BDDDBB@@B@BBBBF@B@D@B@FB@@@@@@F@BBB@FA@@@@D@LBHBB@DBBB@@@@B@@B@@@@AB@@@BBDJAJFBFP@L@LBL@H@D@B@D@BA@@@IB@HBB@@A@@@A@@C@C@C@@A@A@@@@@@@@@E@@@@@@NB@GE@@@@@@@@CB@@C@AD@B@@@@AB@@AB@B@@@D@BA@@BEDBFBFDDEGGIG@A@CAA@A@@AA@@@@BA@@@ABA@@B@@@@A@ABA@CAABAA@@@@A@@@@@@@AAA@A@@@A@@BA@@BB@CBAB@@C@A@A@AB@@@BCB@B@@AFB@AFAB@@EB@H@CCAA@@@AGEAAEE@@@@@@@@@@AA@AAA@@@AA@@@ABA@AB@@A@@@@@AAA@@AAA@@CACA@@A@@@A@@@AAEACA@@A@@@@A@@@@A@CAAA@@A@@@A@A@@@EBA@@@@@C@C@A@A@@@C@@@A@@@@BC@A@A@A@A@A@A@C@C@@@I@@@@@@@@@K@@@@@A@C@@@G@C@E@A@A@E@@@E@C@E@@@C@A@C@@@E@@@@@@A@@@@@C@@@@@@"],encodeOffsets:[[120970,40574]]}},{type:"Feature",id:"130204",properties:{name:"古冶区",cp:[118.447635,39.733578],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@H@HAJ@L@F@N@FC@E@C@@@E@M@A@ABG@@@ADI@@BADIBEAE@GCAGE@AAAAAGIGIACAIEEIKQFEJMHCBIFKFC@EDA@CBAD@DCHABCHEDYVCDGHABA@@@@@IHKHABEDEB@@@@MDG@KFEBEBDVFHJFDBJB@@DBBDBB@D@BAFIP@BBHBF@BIHEHKJEFCDGCKDAHCF@F@F@BJLFJDDFF@@NLHJFFNRHNDHD\\LENGD@D@B@@@BBBB@H@DBB@@BBB@B@D@DAFAD@B@D@B@BBBBBB@B@B@B@@BB@BBDBBBB@@BD@D@B@BDBDBB@B@D@B@B@DABAB@D@B@B@DAFABA@A@ABCFEBAAC@CCAACDANEB@@ADABAB@D@J@L@B@B@@@DC@A@@@C@A@E@A@ABA@@B@J@NBH@B@B@@@BA@A@ABCBA@@BAD@H@F@NAD@B@DAFC@ABA@C@ABABAB@D@B@B@@@DBDBFDFBD@FBB@@@BABABABAB@F@B@B@DAB@B@FCFAB@BC@C@A@E@AACA@ACACCAAAKCIAA@AAA@@@@CBEB@@A@A@C@@BB@@@A@@@B@@@C@@BD@@@BFA@BB@@CB@@@@@BEB@BD@@@BH@@@D@B@D@@AB@@@FB@EB@AFDB@GB@A@D@AHD@@CB@@AD@AFB@@ED@@FB@@E@@BG@@BI@C@@@BA@@A@@@EA@@@C@@CK@ACB@@C@IE@@@AGA@@FGBAA@@@BA@@C@@@DA@@AC@@AG@@A@@@GC@@D@@@C@@@@CB@@A@AB@C@A@A@@@@E@@GI@BFC@@@CA@BAD@F@@@DC@@EA@@KA@@F@@ACA@BFC@ACAA@BAABF@@@DB@@BC@@B@@@D@@@@E@EA@F@@@D@@@@C@A@CBCB@BAB@D@D@B@@@BA@@@C@A@@@A@A@A@@AA@@@AA@@AA@@@@AAAAAAA@AA@@AAA@A@E@AA@@@@A@@@E@AA@@A@@BA@@@AB@@ABBB@B@@B@BB@@@B@@@B@@A@@@A@@@A@@BA@A@A@AA@@@@A@@AC@@A@@@A@@@A@A@@@A@@A@@@@@EAC@A@A@@@@@A@A@AA@@B@AA@@@@AC@ACAA@A@AAAA@@@A@@@AA@AA@@AAA@@@AB@@A@A@A@@@@AAA@AAA@A@@@A@A@ABA@A@@@A@AA@@A@@@@@A@@@AB@@@@C@AAA@AB@AC@A@@AAA@AAC@C@CCA@@A@@@@BA@AB@BA@@@@@@BA@@B@@ABB@A@@A@@@@ABA"],encodeOffsets:[[121286,40618]]}},{type:"Feature",id:"130205",properties:{name:"开平区",cp:[118.261841,39.671001],childNum:1},geometry:{type:"Polygon",coordinates:["@@MCKAE@KAGBE@BDBD@FAFTFHBALCFADADABBB@B@@BBBD@DABABCDABA@ABAB@@@D@DA@AD@BA@ABAB@@A@A@AAA@@B@@@@@BB@@B@BBB@B@@BB@@@@@@@@@D@F@D@F@B@B@B@B@@BB@BBB@@@@@@@@@@FFBBHF@B@@BBDDG@A@@FA@EB@BEA@BA@A@AD@@A@@B@B@B@DA@AB@DAA@@AB@@@B@@@BBB@B@@@@@@@B@@B@ABBB@DAB@B@B@@A@@@AB@B@@AB@@@@BB@@@BBB@D@BJHHHCFECEACAAF@@ABC@@@A@A@@BA@@B@@A@C@@B@DA@@D@@@@@@F@@HMA@@@@@@@F@@@@@@@@@B@BD@D@D@@@@B@@@BA@GAA@@J@@ABC@A@C@G@K@KAK@O@AHCN@F@B@ZCJJFBBBBDBHDHFNFB@HDDBHCN@JDFDFFFD@BJAF@HOR@TFJHBFCFCPBDCPEJCH@F@DDCD@B@BBD@F@@@B@BABABC@C@ABCB@B@@@FBDDB@D@DCDBD@B@B@@@FAHCDAJAFAD@JBPDD@@@F@F@NBB@LBB@BBBBBBBBD@F@DAJEDAB@DABBB@FDDBBBB@D@F@H@C[CGGMMQEEGIMK@@EECCEIIK@A@E@EDEBGLCHDDCFELIFGJG@AAEAG@AJOBE@A@CAAACCA@@IACAIEEGCUE@E@Q@C@A@W@G@CACE@@@@AAAC@@CEAIAE@C@AAACWAA@C@@@A@@CIAI@CAC@@AC@A@E@A@G@E@@@C@@@@AC@GAC@@@@@EACAEECKG@@C@ECMCA@QAA@"],encodeOffsets:[[121098,40517]]}},{type:"Feature",id:"130208",properties:{name:"丰润区",cp:[118.162215,39.832582],childNum:1},geometry:{type:"Polygon",coordinates:["@@@I@A@@@GSEOEEAGAE@MBEBE@KFCBCBAFCFABED@@A@KAY@KBM@AF@DKC@@KFGBABOFBBKD@@A@CBCEGBEHABA@A@A@CBE@ABABE@CB@@@@A@CB@BA@@@A@AB@@A@A@AB@@A@ABA@@@ABA@AB@@@@A@A@AB@@A@A@AB@@A@A@@@A@A@AA@@A@@@CA@@ABC@A@A@AB@@A@EBABC@CBCDA@ABA@@@AAA@A@@@AAA@AA@@AAA@@@AAA@A@@@A@C@A@@@AE@BAAGDCAA@C@A@AAA@A@A@E@CBE@A@C@CBABA@EDCBA@ABGDEBC@ABEBABE@CAC@A@A@A@AA@@AA@AA@@AA@A@A@C@C@@@CBA@@@A@A@AB@@A@@@C@E@CBC@@@BDANABCB@B@@HJHHHHDDNNFDPFHLDHDDHJJBD@D@HBHAF@VBB@LBXJHJFHDJ@HG@CFBD@BBBBFDJDABB@DDJHFBBNJHJBBBBBD@@BFBD@D@DDH@FBBID@@BJH@@J@D@FAN@@CDABGHGH@BDBB@@@FBBAB@FBB@DBDBDBDAD@B@BBB@DAB@@@BABAB@F@BBB@BB@@BD@B@B@DBD@BBB@B@@@B@BBD@@@@@B@@@BBFA@@BBD@B@B@D@@@B@B@H@B@BBBFBJ@DAB@B@HADAJCDHBNETMJGHABIJ@@IJCRBD@B@@@FDN@D@@DL@BDJDN@F@JCLEJELA@AL@@BFBDFJDJRKLAHAD@B@VBD@X@H@RBLAV@JAJ@JBDBDDPJPFHFFDDF@N@BFLBL@JDDFDNALKBCJCHAJ@HDHDPRHFLFF@B@PAJBLLHFNDHBHHFL@FFJHHBBJHJJD@BBD@F@N@D@D@BB@BB@@D@DBD@BBBDBHDDBFFDDBDBDFJDJBDFDB@BBB@JAD@D@FBHDF@FBX@HBHBB@H@J@D@F@FBD@DAD@BABCDCLCBEP@@GFAD@BA@A@C@CBCBC@A@AAAAAEEAC@A@@@A@C@CFE@A@AAAAAEG@A@ABAJIFCBAD@@@BB@LBHBBBBB@TBD@@@B@B@@@@@DADCBE@EBEBCDALKLCBA@@@AAKACGEAA@CFIHGEMAC@CBCBAHCD@DCDEBA@CCICCCCA@CACACCAC@A@E@E@A@CA@AAK@EAC@IEGGAA@ABCBG@C@C@AA@A@IACA@@@A@EBA@ABE@A@AA@AAA@A@A@A@CBEBABMBC@C@A@@AAABE@CAA@A@ACA@ACAA@A@C@A@A@C@A@CAAACC@@@CBCBC@AHEDCBABA@@@CB@@EGEAE@A@A@@ACAC@@AAACCAIGCCIE@@@@CAAA@AL@BMO@AAEAGCCAEC@@@@KGACA@@C@ADAB@DABA@C@EDGFIDOACDODEAEIGSEQ@GPE@IB@AECEEECICM@GDCAGCA@MEGEGCCAAAAAIEDI@Y@A@EDMBGAEIEIBAC@A@@BA@@@@@AA@@@@@AACAA@GAKAC@@@@@EBA@AAE@@@@@@@EAA@C@A@E@AAAAA@@@AACCAC@@@ABADE@AFE@@@C@CA@@AECCAECICIIAAAA@@@@MOCEA@CCCAEACAEAKEAAAAA@@CBEBG@C@CA@@@@ABCBA@@@A@A@A@A@@@CEI@A@A@CDCDGDEBEBCDADCBADG@ADAG@CBAFADM@AGIBACACACAC@C@A@CBE@AAACA@ACCAE@G"],encodeOffsets:[[120896,40513]]}},{type:"Feature",id:"130209",properties:{name:"曹妃甸区",cp:[118.460379,39.27307],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@@A@@@@@@B@@@@@@B@FDBBBB@@B@@@B@@@@@@@@A@@GECA@@A@"],["@@A@@@@@@@@B@@@@BBB@BBB@@@BBBBB@FD@@@@@BA@A@A@@@@@@@@BB@B@DDDBBBBB@B@@B@@@@@@@BA@@@AA@IMAAA@ECAAA@CCA@"],["@@MCOEU@[@SHëªIXIAHAHCPELGNEJMPUPMHKBK@SAA@S@GBKFUNQHI@EAGCSOKHOLIHENGNEJ[OU^FB@BZF@@D@B@BGHBNDAB@DCBCDCDMABGMCCAC@A@EAGAQXdZ£SKweKLr^FD@HCDCFQNADAFBHBHDFFHHFFBJBL@J@DBBF@FCDAHAD@BBNAJ@@BDDDBDAHCVAF@DB@FBDBDBAD@BKHCFCFAB@F@D@FDDHF@@B@@@@BB@PHDDAD]Z@BCH@H@L@L@HBDH@HBHBPAjEDEBEAI@IBGFCNBRDTDPFLDRJ@@AFA@C@E@CAA@A@MAA@@@C@A@@@EAA@C@E@E@A@A@A@@BBB@B@@BBDDBB@BDBBDBD@B@B@@@B@@@D@B@BAF@@@@@BAF@@ABKAAHGVERAP@DKNAD@F@B@B@@@B@@@B@B@B@DBPB@@D@DDP@DLHFB@@D@BGD@F@HDB@D@B@F@BABAB@F@L@^HHBJ@@@B@F@@@J@B@DAFABCDCBC@A@CAAGEGEECAA@A@A@ABCDAD@FCBA@A@@IY@AF@NDBAD@LCHCHCBGBEBCDCDCBCBCEECCCC@CBQDQDA^KFCTGRCBABCBCBM@CfBFBLP@J@B@@@N@BAN@N@B@LBNBF@@@@@FDH@DBHLFLEFEDAD@FAB@JCPM@A@A@EAGACGU@AAA@ACIACBgB@HAH@F@F@NBVBPAH@BGBG@ABODM@CBIFADAjKFARENCB@D@@@B@D@D@\\BJ@@@B@B@B@D@B@D@DBDB@@B@B@B@B@B@@@BAB@JAHABEDGLGBABCDA@@@@BADAJGLGJEBE@I@CBA@A@AAAFD@NAJ@BABGH@@CD@B@FFDJ@JAH@HGDCFIDABAHCF@DCFILGFAHBFFBF@@@@@@BH@BCFENA@CFABCFA@@AE@ABA@CDBAGACHD@D@B@@BB@AB@BA@@D@BABD@AFF@@FAA@@AB@F@@@BKGI@IBIHFLVZDFBBJBJDPHF@D@F@HBRBLDJBV@NAP@T@F@AA@C@CACAA@@@AECCCCCAI@AAI@AAABABADCB@BA@E@A@EBEBEBC@@CICEAEAEAA@@AEAE@@AECGAEAI@GBEBCBG@@BCDA@EACACCA@AAACCAAAAACCCAAKKEGECBM@IBQBQCEGEAKKSsH]FWE_KMEUEQCEECG@EBIRSBGAIIMGCIB]AQUIASJC@CAfHQ`BAB@D@BABAZYHEHCLADC@CAEQMWUUU"]],encodeOffsets:[[[121465,39970]],[[121481,39982]],[[121419,39878]]]}},{type:"Feature",id:"130207",properties:{name:"丰南区",cp:[118.085169,39.576031],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@@@@@@@@@@@@B@@@@@@B@@B@@@@@@@B@@@@@B@@@@@@@@@@@@A@@@@@AB@@@@@@@@@@@@@@A@ABCD@@@@@@@@@@@@B@@B@@@@B@@BB@@B@B@@BD@@@B@@HA@@@@@B@DBB@B@B@BB@@B@@BB@@@BDBBBBDBBB@@BBBBB@@BB@BB@@@@@@B@@@@A@@@@B@@@@@@AB@@@@@@@@A@@@@@@@A@@A@@@@@@A@@@@@@@@@A@@@@B@@A@@@@@@@@@@@@AA@A@@AA@@@@@@@@@@B@@A@@@@B@@@@B@@@BB@@BB@@@@@@@@@@@@A@@B@@A@@B@@@@AB@@@@ECAA@@@@B@@A@@B@@@@A@@@@BA@@@@@@@@A@@@@@@@@@@@@@@@@@@AB@@@@@@@BABA@@@@UMAAA@@AA@CA@@@@AFABCBOBIACACAE@EBGDEF@@AD@@@D@@BJBD@D@BB@F@FBDBFBF@B@NBH@B@D@H@B@PBDBFB@@HGBA@@@A@@@@@@@@@@@@@@@@@@B@@@@A@@@@DBLHGHAD@B@B@B@B@BBD@B@B@D@BBB@@@@AB@BABAD@B@@A@A@@@A@A@A@@@A@A@A@@@AA@@E@@B@B@@@BA@@@@B@@@B@B@@VDB@@@B@B@PBBB@@B@B@@@@J@L@B@J@HAJ@@@J@H@B@D@D@D@DB@@@D@B@@@@B@B@@@BB@@B@@@B@B@@BB@@@B@@@BBB@@@B@BB@@B@@@@@@@@B@B@B@@@B@@@B@@@B@@@B@@@B@@A@BB@@BBBB@BBB@B@@BB@B@B@@@BBB@B@@@BB@@BB@B@@@B@@@B@@@BAB@@@B@@@B@@AD@B@@@@AB@@@B@@A@A@AB@@A@A@@@@BB@@B@@@@@@@@@B@@@@@@B@B@@@@@B@@@B@@@@DBD@F@B@B@B@B@B@@@HBH@@@@@@LB@@@@DB@@B@B@@B@@DD@@@BDB@@@BB@@@A@BF@@CE@B@@@@@@BF@BA@A@@@@@@@@@@@AB@@@DABB@@B@@BBA@BD@@A@@@AAB@AAEDBFCB@@@@@BB@@@@BB@A@AB@@@@DAFDAB@@@@@@@@@@@@@BBD@@BB@@B@D@@@@B@BBB@B@@BB@@BB@@BABBA@@LBDB@BBBD@@FHD@BDFHBAFL@@HADFDAB@@@LCAAPEBAHALE@@LD@CBEN@LAZ@LBB@@@FCBADEBEDADALEF@FANAF@HBFBPFTF@H@@@B@J@HBFDD@BDBBB@BAF@D@B@DBDBDBDBDJABHN@BCBEDAH@J@H@B@DADAFAF@BAD@BAD@B@@@FAB@DABAFAFEBADAFCFCFA@@HABAFA@@@@BA@@B@@@@@B@@BB@@@B@@@B@@@B@@@B@B@@A@@B@DAFCD@@A@AA@@AACCEAA@@AACA@AAA@@@A@A@ABA@@AA@@@@@A@A@@@@AAA@A@ACAC@@CBBA@@BAB@B@@@B@D@B@@@@@BA@@B@@BB@@@@E@AB@B@BBB@@@@EB@@@B@BAB@@@DB@AB@@A@@@A@@@@@A@@B@B@B@@@@@B@@BB@@@@@@AB@@@@@B@BAB@A@AE@@@@@AF@B@DA@@B@AI@@ABAC@@@@A@@@ACD@@@@ABA@A@G@@@@@@@A@@@@B@B@@@B@@A@@@@B@@@@@@A@@@@@AAA@A@@A@@@BE@A@@@@@@@A@@@@@@BA@@@@FDDB@B@B@@@B@@B@@@@@B@@@@@@@B@@A@BDBB@B@@A@@H@@@@@@BB@@@@@HB@BA@@@@B@B@@A@@@B@@@B@B@@@B@@@B@B@@BB@BA@@B@BBB@B@BEBEBE@@@@BCBABCB@@AB@@A@A@A@@B@@@@ABAA@BA@@DBF@ABBBABB@BADBF@@AD@@@@@F@@@@DD@@DB@@D@DA@@F@B@BBBBB@@D@D@D@DAB@B@BABD@DB@BJBABBJA@BBJHCADD@@BGBJBHDBBCB@BAD@DA@A@A@@BAB@B@@ABC@@@AD@DA@C@@@@@C@EBC@A@@CA@A@C@A@A@@FC@@EC@A@A@CBC@CBGDCDC@@BA@B@@B@@@BB@BB@@C@@@A@@B@BA@BFA@@@A@A@A@@@@D@@@@@@ABA@A@A@@AA@@A@@AB@@A@@@ABB@@BB@@B@@B@@@B@@@B@@@B@@@BB@@B@BA@@BF@DE@@@@BABA@C@@B@BBB@BB@B@JAF@HALBF@LBNDB@RBB@NDFDD@@@LHFDBFBD@F@@@@BD@HBD@@@@@D@@@F@H@B@F@BBD@@BD@DBJDJ@@@B@@@DBBDXBB@B@DBFBJDF@@BDBB@@@@DFDBH@X@B@D@R@F@F@FAFALEH@NC@@@@FAFCBALGJG@@@@B@BAHGDCZUFCDGBADG@CBCDAB@FCD@LEJEDANGFIRECEGGEEMACCAECMAI@@AO@CAO@AAA@A@AKIA@IEAACAA@ECBK@GGK@@AEAC@A@A@A@G@ADCDC@A@E@MBC@@@A@C@CDIB@FKFG@@@A@CAK@EACCA@@CAICGEIAEAI@CC@E@QBEF@BADIDIFM@AFKKEAG@CCG@E@@@@AEAM@K@A@MBM@A@M@@@A@IKOEAeA@DANADADABQDSHED]LCBCRAR@DDDDDFFADADCDCDADAFAHGDGDKDC@ABMCE@@BJZ@@@BABEDC@CBAD@B@B@BBBFDHFHFBB@D@BADCDADEBCBA@I@@@E@A@@@I@GA]GK@E@A@ABABE@A@C@A@GCE@C@AHC@@@EAKG@CCO@C@CA@AO@C@A@A@A@@@A@@@A@A@EBCLM@CBOFQHUBGLBBA@@BE@A@@@@BE@A@A@C@@@A@@@A@AACACCA@AAACCAA@@@AAA@AB@B@B@F@F@D@B@FB@@B@D@@@B@NBB@B@DBF@D@B@BE@@QIKCOESCQCMAEDAH@JBJAFCFiFOBGAGAG@AC@G@K@K@GDG@A^YBCCCOGA@@A@@A@@@GECC@E@C@EBADEDELG@ABCCACAEAA@@CBEDUBGACCCAC@@BIAM@ABCBGDC@EAECAI@K@IAEAGEEGCEAGAGBEBCRMDEDC@GECq]ININGHGFmPkPEDCDAD@HAFCDEDQDe@A@AN@B@@@B@B@B@@B@@@B@D@D@@@D@D@D@B@B@D@B@F@D@B@B@B@D@B@@@B@@F@@@@@@BB@@@@@@@@@@@@@B@@@B@@@B@B@D@B@BAB@B@B@@@B@B@B@@@@@B@B@@@B@@@B@B@B@@@B@@@B@@@@@@@@A@@@@@@@@BA@@@A@@@A@@@ABA@A@@@@@CBA@CBC@ABC@ABC@A@@BC@ABA@A@CBA@@@@@@@@@@@@@@@@@@@@B@@@@@B@@@B@@E@@@@@@B@@@B@@@B@@@@@@@@@B@@@@@@@@@@@@@@@BB@@BBBIDAB@@A@@BBB@D@DBBBB@@@@A@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@AB@@@@@@@B@@A@@@@B@@@@@@@@A@@@@@@@@@@@AB@@@@@@@@@@@@@@@@A@@BA@@@@@@@@@AA@@@A@@@@@@@@@@@AB@@@@@@@@@@@@@@@A@A@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@A@@A@@@@@@@@@@@B@@@@A@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@B@A@@@@A@@@A@@@@@@@A@@A@@A@@@@@@AOH@@@@@@@@AA@@@@A@@@@@A@@@@@@B@@A@@@@BBB@@A@@B@@A@@A@@A@@@@@A@@A@B@@AB@@@@@@A@@A@@@@A@@@@@@@AA@@A@@@A@A@@@@@@B@@@@AB@@@@@@A@@@@B@@B@@B@@BBA@@BA@@@AB@B@A@B@@A@@@A@A@@@@@A@@@A@@@@@A@A@@@A@@AA@@@@@@@AB@B@@@@@@@@@@A@B@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@BA@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@AB@@@@@AA@@@@@@@@B@@@@@@@@@@@@@B@@@@@@AB@@CH@@A@@B@@@B@@@@AB@@B@@@@@@@@@@@B@@@@B@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@AA@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@B@@@@A@CB@@A@@@AB@@A@@@@@@@@A@@@@@@@A@@@@@@@@A@@@@@@@@@A@@@@@@BA@@@A@@@A@@@A@@@@@A@@@@@@@@@A@@@@@@@A@@@AB@@@A@@@@@AABE@A@@AEBAD@@A@E@A@E@@@@@A@C@@@ABA@C@@@A@G@C@A@ABAAA@A@C@@@@@@@@A@@@@@@A@@@@@A@@@@@@@@@A@@@@BA@@@A@@@@@A@@@@@@@@@@@@@@@@@A@CB@A@@@@@A@@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@A@@@@@@@@@@@@@@A@@A@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@BA@@@@@@@@@@@@@@BA@@@@@@@@@@B@@@B@@A@@@A@@@CBC@EBC@@B@@BF@BIH@@@BEAC@@@@@@@@@A@G@GAMAA@A@CBAB@@AB@@@B@BA@@A@@A@A@@@@@@@AA@@A@@@@@A@@@@@@@@@AA@@@@@@@@@@B@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@BA@@@@@@@"],encodeOffsets:[[120676,40273]]}},{type:"Feature",id:"130223",properties:{name:"滦县",cp:[118.703598,39.740593],childNum:1},geometry:{type:"Polygon",coordinates:["@@QBQDOB[DO@Q@GCMCAEAG@C@@CA@AECCAG@G@Q@A@CAQCUAQAC@IDE@QBOEIAAAA@C@]G@AOCGACAACAS@AUAM@A@K@E@EBCFA@CDI@EBG@G@BJBDHJHJBBBB@BHFDB@HBFAFCJAB@@CJ@B@@AH@B@B@N@F@@@D@FEDM@E@K@I@GBG@@@@B@@AB@B@@B@@@@BAA@BA@@@AB@@@@@@ABA@@BAB@@@@@BB@DDD@D@BBB@BB@@@BBDA@@BBB@B@D@@A@@B@@@B@@@@@BB@@B@B@@@BAB@B@B@B@@@BBB@BBB@B@@B@B@B@@@BA@@B@BB@@BBB@@B@@@B@@BBBBB@B@DB@BBD@@@@BBA@@@BBB@B@@@@@B@B@D@FB@@@@B@@@@B@@@B@B@@@B@@@BD@@BB@@@@@BBB@B@B@@AB@@@B@@@B@@@@A@@@A@@AAA@@@@AAABA@@BA@@B@@AB@@@BBF@@@B@@@@@BBF@B@B@BB@@BBB@BBBBBB@@@@BB@@BB@@B@@BB@B@B@@@B@D@@@B@@A@@@A@C@CBA@ADADAB@D@@@@@@C@@@EFBF@@@@@@C@@@AD@@AA@@C@@AEBB@ABBBDD@AEB@BD@@@EB@@LB@@FD@@C@@@EBC@ADB@@D@AEJ@@HF@@@@@@B@B@DBAB@@@DA@@@@@D@@@CD@@H@@@BH@@BD@@BB@@C@@@DB@@A@@BBHA@EB@BH@@F@@J@DA@BDL@@DD@@@B@@F@@@BB@@A@@@DAJ@@AH@@@FA@@EC@@FA@BEC@@BA@@DC@BGC@B@A@@HCABEA@@FEA@@A@@BC@A@C@@@G@@A@@ACA@AF@@@@A@@DA@@AEB@A@@AC@@@D@@@A@@@B@@AA@@@D@B@BA@AF@D@@B@BBB@JBLDBBDBBDBDB@BD@B@F@B@DADA@EBEDA@A@CBA@A@E@A@ABABABAB@@A@EAC@EAECCACA@@A@A@C@A@ABAB@B@DAB@BEDCBA@C@MBE@G@C@AB@@ABAD@B@BAB@@A@A@G@MAI@A@@@AB@B@B@F@B@D@@@BCD@@A@A@K@I@C@A@ABCB@BA@MFCBBDDB@DBDABEFAD@B@BABEBCBA@A@C@A@ABCBA@A@C@A@A@CACA@A@A@CAC@@AAAAAC@AAA@@@A@A@AAAAAAAA@C@A@C@EBCBC@A@A@AA@@AA@C@GAAAA@@A@C@C@MHKFG@E@C@A@AACAECA@AACBA@CBIFCBE@C@AAAAAAAAA@KAA@MAE@E@@@C@OCIAC@EBIBCBGDEB@@A@A@C@CACDC@A@CCEA@@A@A@AD@B@DADABABA@@@E@C@AAA@C@CDABCBA@CB@B@DB@BDLH@@@@FDDBHDFBBBP@ANK@@BBBDB@@@@JFDDJHDBBDBB@@BDBD@@@B@BBFHF@FA@@D@@ABABCDGF@BADAD@D@@DDBBDBB@D@B@B@D@B@B@DB@BDB@B@BBB@DAFBB@BB@D@D@NABAFADAB@B@B@B@BBB@@B@BAF@BAB@F@B@@DBJBB@B@@B@D@DAHAD@BBBHHJFD@FBL@BBB@@D@B@F@F@BBDDDDBDBB@DDDDDJ@DABCFCDC@GDABAD@DBDFNZCJBHBFDD@FAJGHAN@F@JCNEJCLB@@LA@@PCLADA@@HAJBHHBMBI@ABEAKAAAAOEAAAABABCFEHEBABA@CDKBEBAD@BADABA@C@AAEAAAACAEAA@AEAAACIGAAAA@A@A@CBEFIHK@ALUBCBA@AB@BBHBFBFBB@B@B@DBHAD@@@B@B@B@B@JBL@F@F@BABAFAB@B@B@BBHBLDHBD@HBJ@B@D@FAD@BA@@@@BAFAFEB@DCFABAD@J@BAJ@DBTBLBDBBBDDBBD@DDB@@@FABADCDAB@F@@@JB@@B@AJB@@BB@@AB@@B@@BE@@@FL@@@B@@B@B@@@@@BB@@DB@@@@@@@D@@B@@@DD@@C@@@DD@D@@@@@AB@@B@BD@@@F@@@EB@@D@@@C@@BF@@@AB@@AB@@D@@@@F@@@EA@@F@@@@@@CC@@@D@@@B@@BDB@@C@@@JB@BL@@B@@BCB@EJB@DIB@AD@@BCB@AFB@@@JB@AIAB@HB@@GA@A@@H@@AG@@AAA@@K@BAA@@@J@@AC@BA@@K@@@L@@@K@@A@@@A@@@@C@@@J@@AB@@@AA@@B@@AC@AC@ABDB@EGB@DHB@@@H@@@@@@@@@@E@@AC@A@@F@DAJBB@B@DBBBBDBD@@BBHBFFHHBD@BBB@B@BBBD@H@H@BBDAHEDAFCD@D@DAD@B@B@@@@BBB@D@BABABA@C@A@AFAD@D@@@B@FFHBBDB@BFF@BDD@@BBFHBB@DBBBDJ@JD@@BJBFBFDHB@@B@@@@B@JAHAF@BABE@ADCDCDAB@F@D@B@B@@@D@LGHEHEFCBEBC@CBA@G@A@CBA@C@@BADGBCBADCBADAFCDCHCDCFGHGJGBGB@DGAECCECOIEGCGCEAAAC@AAQAG@ADG@@BCPK@AACGAA@GCC@QAKAAA@KBC@GBEAAAAAAAAA@CACAC@EAICGCE@@AAA@ABA@EB@@ABA@C@C@C@@BA@ABABC@A@@BA@@BC@A@C@ABIBA@A@A@G@EBCBG@@BA@ABBBBDDB@@@@@@A@@BCA@@I@@BA@A@AB@@A@CBCBKB@@@BED@@AB@@CAA@AD@@AB@@BBA@AAC@@A@@ABA@ADE@@B@@@B@B@@A@@B@A@A@@A@@@@@AD@BAD@@@D@@A@@CAA@BAFCB@DCD@BA@BD@@@BADKME@I@K@ICAEB@CC@@AE@E@AAC@BHIDE@A@CAOMCCMGAAAAOECAAAGBACAA@@CEAGKCA@I@OFEBCBKF@B@D@B@D@BU@QBE@"],encodeOffsets:[[121551,40582]]}},{type:"Feature",id:"130224",properties:{name:"滦南县",cp:[118.682379,39.518996],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@@B@DAF@BBBB@B@D@@A@A@CAEAAC@"],["@@EBCBBDBBHDDBB@DABAACACEACA"],["@@E@GDABCBEJCDGHG@IBI@EC@E@ADC@@HGBA@ABI@MECBB@B@BAB@D@JAFIFKHIHCBAB@@@@CBADABKHCHAFGBIBA@AB@@A@A@A@A@A@@@CACAC@A@C@A@A@A@@@I@[AC@C@A@@@C@A@MDQFEBiLCBEBAJ@DCNAP@BAHAHG@OBUAMAE@E@G@GBA@AhBDDJ@BBB@BHVBDBH@F@B@BONIDA@EBC@CBEFKFEL@BENCJCJABE@AF@R@FDDJ@FBJBHFJDDB@@DBBD@FBL@D@B@@EHELA@CJ@D@D@B@@AD@N@F@BCDCD@B@H@B@B@BBDBF@@HL@HALFDB@DBBBJFB@LJ@B@BBB@BBP@DBP@@BJDNBFDDNBFFHHDFJLFFH@H@FAJ@DCB@DEFAF@L@B@N@VB@BBTBDDBHBPD@B^HD@B@BBJBPFRAF@JCD@RBVBRDDBB@R@H@H@DBFD@BDB@@@DBHBFNDHDR@P@\\CPARCRAF@RAV@@A@C@A@C@ALEDAFAPEJ@B@LDBHDF@@BBBDHABBDBPFBBBBNHDDPNDBB@F@JCAGD@BBF@F@@BD@@DFADB@J@L@JNFFI@A@I@@AACA@@@A@@@AFCHGDCBCDCFCFEHEDCBCBA@AACACGG@A@CBC@ABABA@A@@BCBA@A@A@@BCBA@AD@@EC@@C@CL@@A@AB@@AA@@AD@@@@CA@BEBA@ABA@@BCAC@AACAES@DK@@AE@ABGCAB@CCC@@@@CA@CECAAB@ACAIGA@AAIEEEOODIBGCCCCC@EAC@CCMUACCEAAAEC@EACC@AAABINAFABFBDHCVC@@B@@EC@BIfDB@@@BABC@ABA@AAACCCEIKKCAEAC@A@CBEICAAC@UBMF@@A@OFA@AB@@@@A@CD@@@@CDA@CB@@MAAAEBEHAD@BC@A@IECCEAAAC@KEEAEIBEBC@AAGI@E@CA@GF@NANG@E@@AAKC@COCAC@CAA@A@AEACAAE@CBABADEXE@@BI@@EAAAAC@ALADC@A@C@ABGBC@@@@@GFMCB@AAEAC@A@A@A@@AA@CAGAA@CAAEO@@@@@E@@@@@@AAQAE@S@O@MBU@IAKCQAGAE@C@E@OGICIAAACEUYEKJGJAJ@LH@A@@@EBA@@BB@EE@BEC@BA@A@CB@@ABAA@@AA@C@C@DGHBABDCB@BAF@@BB@DEBADEB@FMDE@AAG@@@@@@AEEEGAEBKHEJCD"],["@@TBL@LANGVONOFIHMFKDOBGBGJJWyXOXFzAHK@OGaCYAU@QFYLMHKBECIAG@KDmVG@ICMBGFELENSVEFMPQXQ\\]ITKRW^xfTL¤cYRWHBFBB@D@DBNDAHNBDCDCDA@CBAMCGAAHA@C@@@YE@AEAV]\\PFIHMFMJGPKLGTPHDFBJ@RGVMLEHAT@B@"]],encodeOffsets:[[[121431,39949]],[[121423,39935]],[[121484,40201]],[[121178,40007]]]}},{type:"Feature",id:"130225",properties:{name:"乐亭县",cp:[118.912571,39.425608],childNum:11},geometry:{type:"MultiPolygon",coordinates:[["@@A@@@@@@@@@@@@B@@@@B@@@@@@@B@@A@@A@@@@@@@"],["@@@@@@@@@@@B@@@@BBB@B@BA@@@@@@@@AA@@A@@@C@"],["@@@@A@@@@B@@@@@@BB@@B@@BB@@AB@@@@@@AA@@@AAA@"],["@@A@@@A@@B@@@B@@@@DD@@B@B@@@@AB@@A@@@@@AA@@AA@@@A@"],["@@@@@@@B@@@@@@B@B@@@B@BB@@D@D@DBB@BBB@@@@@@@@@@@@A@@AAA@AAA@A@C@AAC@C@A@@@"],["@@@@@@@B@@@@DDDBFFB@@@@@@@@@BA@@AAAACCGC@@A@"],["@@AB@@@@@B@@BBFFBDHDHFHFBBBB@BB@@@B@@@BA@@AAACAAECGEGEGGCAAA@@"],["@@A@ABGHADCJCF@@@BB@BCFIFIDCBAB@@@B@@@@@B@B@B@@A@AAGCCAACCGECAEAAAC@MGAA@@@AEAGCMEGCECKCCAC@A@IAA@C@@@CDA@AB@B@@B@@@BADAF@D@DBF@DBPHNDJFJFLFDD@BNDDBBB@@D@BBFFDBBB@B@@BB@BA@ABA@A@"],["@@bLVFLFXVfdFBJ@H@HEDGBIAEGCKECGAGCKKSIKWKYG_@aBEF@HBJFJ"],["@@@BBBDFB@ABIL@@BB^RFBB@D@B@@AB@BA@CAEAICGEGIKGEA@AAABCF@@@DABA@"],["@@CC_[QOECCAECGGKKEEEACCKCMCmIICE@EAAB@@@@@B@@@BB@FB`HlLFBBBLHBB@B@B@BBD@@BDDDBB@@ABAB@B@B@B@@JLBJAFAHIDOAmKCAOSAEAAWIYCO@IBIF@HCHEBG@KC_MaSKIcGc@eCFOBMD[NKD_FLTBLHFDFARAR@JANFDFHLLBBDDBDBBBBDDBB@BDBBDBD@FCBAD@@AHADAF@HBJBFDHBF@@BFBF@@BBBFBFDFDJ@@ADAFAF@F@B@FABA@CDABABBB@BBJ@BBJDDDDFD@B@@BBBD@D@DBBRBBB@@@@@@@F@@@@FPBB@DBBBH@DBB@@@B@B@BBDBF@BDAEN@H@@@@ADAH@B@D@BCDKB@BBDBBFB@@AJ@@WFCFABAB@DBFDBFB@B@BBB@DBDPD@DLDBB@@@FMHMBE@@HDBF@J@BH@BADAFFJFBLFD@BBFBDDJFB@D@@ABCFGFABBNB@@DAB@DC@@@@DCB@@@@@BAB@PEB@@@NEVAD@BBJDAF@D@BBDBFLDJLDFDDBB@BAB@BADAB@@A@eCAJD@@FA@@@UDGDACAEEBMBAJBB@BDDFBD@BFBBDFBDNVDDD@FBD@DDDDAHCJPPFFJFBBB@JHDB@BBADBDFB@@D@@D@DDA@DBAH@BBF@@CLT@BFBD@BJ@@A@@HA@@ACB@BBB@@A@AD@D@B@HCDA@@@@@C@ABCDEB@@AAE@C@ABAB@FCBAB@FAF@B@B@JFBBN@HBPDB@HBD@DBLBBBB@F@B@@@@A@@BA@@AG@@@@B@F@@AE@@@@A@@@@B@@CA@AGD@B@BAPDD@@ALABARCF@D@BAFA@I@A@@QEGAGB@@@ABA@AA@KB@AJA@CMB@BC@@CN@@AE@@AH@@DBAAAD@FCA@EBA@DA@ACDA@BA@@C@@@@@@@@@@A@@AAA@@@BA@A@@B@B@B@BAB@@@@EB@@@BJ@@AGD@@AB@@@AD@@D@@BE@@@J@B@@@BCR@FBBA@@B@@EZA@B@BB@BCH@@AB@@BFA@ACBACHA@EDA@A@@@AB@BC@AC@@@A@@@@A@A@@@AB@@@FA@@@@@@@@G@@A@@@@BEDMA@@AKE@@CCCE@A@AAGJBF@@AB@@A@A@@@AD@@DB@DC@@H@H@F@FADAB@B@D@F@@@@@FAFCB@B@B@F@B@B@@BB@AB@DB@@BBBHFBDB@DD@BBBBBDB@@F@J@JANCDABADCB@PAFANAD@P@J@L@J@D@FAD@B@DADAD@LEL@LBJ@B@DAHADAD@FBB@D@BBDBB@B@DBF@HBD@BBHAF@B@BABABAJGBEBABABAHALGFAFCDA@AB@BABC@@JGBALE@@BAB@HALGB@FAD@B@D@D@B@F@B@LDHBFDFDB@@@DBFBB@B@LS@ABA@K@AAA@AECCAC@CAEAGECAICICQIQEA@K@C@C@GCA@A@CDADABAFABA@AACACCA@AAAAECCCCAC@I@@@AAAAC@A@A@AA@AACCACAGACBCAA@AA@AAAACECGACAAACC@CACCGQO@@GQAEAAIGCCEAAEEGAEIMGMGIGGEEGE@@@AA@GGMMYaMUCOGImgOGECCCCACACAACACAC@G@C@AB@Age"]],encodeOffsets:[[[122174,40344]],[[121479,39991]],[[122088,40321]],[[121481,39985]],[[122102,40323]],[[122031,40276]],[[122049,40294]],[[121616,39981]],[[121659,40047]],[[121676,40071]],[[121852,40120]]]}},{type:"Feature",id:"130227",properties:{name:"迁西县",cp:[118.314715,40.1415],childNum:1},geometry:{type:"Polygon",coordinates:["@@DGGGIAGB@@CBKBOD@@KB@@KAIDMFIDE@M@GBIHEBC@ECGAIAYDGHEJ@DBBHFBDBL@B@@ABKDKLCBADAF@FAFCDCB@@@@A@A@@@C@SAA@AAAAAG@KAA@@C@ABEDIJAB@B@BFHBBBB@B@BEF@D@D@B@@@BBDFFBBBB@B@BADAD@D@D@BABC@EB@HO@AFKDCDADABC@CBC@EAE@C@I@G@A@GAGAW@EAE@GCEJ@FADAFADCJCNEN@FDDBDLJLH@@BBBBBD@BBDAHCFKDKFEDCHABGJKNKHIFC@MLEHGJCDAFAF@B@HHLHFDBR^@@BB@BBH@FAFABABA@GDMFIDIDA@EDEHENAJBLBLDLADAJ@B@F@@ADEJABKNOLGFCDAFBFDB@BBBBBJJPJBD@DCBIFGFGFCHAFCDGDKFEHAD@FDBBBDDDHBB@HBBDHDFBBDDJDHHBDHFJFFBFDDBBBBD@B@BCBGFIDADABBBDJ@B@F@B@BBD@@DBF@FBHAJADHBBFBN@HBDBHFFFNLLDNDDFFDF@H@F@HDDDBD@H@D@BB@D@HANENGBABABECK@ABCJGHABABABCBGBEDCFAF@FANATCFAF@B@DBDBB@D@D@HAD@B@BB@@@JBDBBBBHBH@H@HBHBJDD@B@B@@ABGBA@@B@B@DBD@F@F@HDFBDBHBFDF@DAB@BABC@C@GBCBCDCHI@@D@D@FBD@D@B@BAFAHAREH@F@NBVBFBDAHAF@F@DADABABADAF@JAJAJAD@DAD@B@B@JBH@XDFBDBNHHD@@@B@@DBBB@BB@BBBBBBBBB@@@B@BADC@ABA@AB@@@F@B@B@DC@@B@B@BBB@BAL@@@@@EAAA@@@A@@BABA@A@@@@@A@A@@AA@A@A@AA@@ACAAAC@A@AA@@AGAA@AGGGG@@FEBC@@BA@AB@@ABA@A@A@A@C@@@AB@AA@@AA@@AAA@@@AA@A@A@A@A@@AA@AA@A@EBA@A@A@ABA@@@A@CCA@@E@G@GBI@E@AAAAACCCKAGAO@C@GBI@@@A@C@CAC@CBCBADAF@DAH@B@DAB@BA@A@CB@BAHANBB@D@DABA@K@G@GFGDEJCH@F@TCpSYMCE@@AC@@@@CACE@@BCAAAE@AAAEA@E@C@E@AAGCGCE@ACEACCECGAA@A@@@@AA@A@ABA@AB@@AFABCBCBC@C@C@E@CDCBA@@BA@A@@@A@A@@ACCCB@BA@A@@@A@@@AMBC@KCE@K@IACCA@AACICCCGECGEKACACAACAC@A@C@CBA@ADA@@D@DBDBD@D@DCBADCBA@A@AAACGEAECGCMAGAECCAAAAG@EAAACCCCAKCAAAAAC@ABA@@BA@AA@KK@A@CAGAECEGGCAAC@AAGAECCCCAAKIEEIICAOGIEGC@@CCAA@A@ABAFCBA@@@A@AAAEEAA@@@E@@DADAB@@A@@@@@AAAACA@AEAA@@@CBA@ABC@ABAAA@C@@@@@A@C@A@A@A@ABEBG"],encodeOffsets:[[121298,40925]]}},{type:"Feature",id:"130283",properties:{name:"迁安市",cp:[118.701144,39.999174],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AACGGEEGAAA@@ACACAACAA@A@IACBE@@@@BBD@@@F@@@@@@@@G@@@A@CGA@FHA@AC@BBDD@@BA@@@BB@@A@@BI@@@D@@@@@@B@@@BL@@@K@@@L@@@ABD@@BI@@@B@ABL@@@BB@BH@@BG@@@@BHB@@GAA@JB@BIA@@A@BEA@AD@@BCA@CJA@FIA@AD@@@AK@@AIA@@D@@@CA@AA@@@C@@@D@@D@@@@E@@@FB@@E@@@@@@CA@@BA@@B@@AE@@@D@@@CA@@F@@@E@@ACA@@@BA@@@@C@C@@C@@@DC@@C@@@AC@@@@@@@A@@CA@@A@@@@@A@AA@@@K@@E@@AF@@@AA@@BA@@AA@BIA@@@IA@@E@A@CBCDABEB@@A@CCC@AACCAACAKASACAI@ABI@C@ABEBCDA@EFEBAB@@@@ABC@EBC@A@I@GAC@GAKCGAAAA@A@A@EBABABE@E@K@IAA@A@A@A@@@C@GBCAA@A@A@EAEAGAAAA@@BABADKV@BGLEJAF@D@B@BBBBBJHBDBBBFB@FBDBBBBBBF@B@DABCBABC@ABAFCL@DABABGFEFADABBBBBPFBBBBBLAF@BAJANCHAHAF@B@B@B@B@D@B@@@@@DBBAB@BAD@BAB@D@@BBBFB@BDBB@B@@@@@BA@CBCB@@@F@@BBFFBB@B@B@@ABEDAB@B@BBBDD@@HDJFPHDBJJFFLJBBDDDDBFBH@BBDDBHHDFBFBH@D@BLLB@@BAB@@AB@BBDBBBBLDDBDDBDBB@FBHBBDBFDHBNBHDFDFBDHBB@B@BABCDABCDC@C@CACAC@@@CB@BAB@D@D@BBDBDDBDBLBHFFDDHDDDJBBB@DDJBL@F@LDD@NA@B@@@B@@@BABA@DDBD@@@B@B@@@BAB@@ABCD@D@F@D@DADADADEB@BA@@BAB@B@BBB@@@@@BBBDHDFBDDF@BDFDHBH@B@F@D@FFBBB@BBFBBAD@@DFDB@@@@BD@@DFZNTGbENANCJAD@FCDCHEDCJAJCDCNGDCFAHCDCFCB@B@DBDDB@B@DAFABADADCBA@A@@A@CB@@FC@@B@B@@@BC@@BB@@@AACB@@B@B@B@@B@@C@@@@B@@DB@BBDDB@BBB@@A@@BA@@B@@@@@B@@@BADA@A@@@EBAA@@AAAA@@A@@BA@A@AAA@@@@@E@E@@DAH@H@HBD@HBNBL@FHHJDBFDF@HAHCFEJCLEHALEHCFCDAFCD@FAF@FC@@JCH@D@JDF@D@FAPKFANEHCH@JBFBD@D@DAFCDCB@@A@@CKBADCFAPI@AB@AC@@BC@A@@@A@A@C@A@AAGCC@BC@A@A@@@A@@@@A@@DA@@@AA@A@@@@@@@@AAC@@@AB@FBB@@@@AB@B@AMCM@A@C@MAIAKCECCA@@@A@EBE@GACAIGSOEEMICCAAKCA@AA@ABe@AAG@Q@G@@BEDADCFALEFEBCBABC@AAC@ACE@C@AAC@A@ABE@EDI@A@@@@AE@AAE@@@G@A@ABA@AB@@A@E@ACGAACCAAACACAI@E@AAACAEAG@C@AAAACA@A@GAG@AAAAEAA@A@@@@@A@EBW@C@ACGAC@EAC@A@A@EBAACAAEGEG@@@AAAAACAAAACAEAAAACEIHGHEHCDGDCDEDCBABCDABADCHAB@@@DAB@D@B@HAB@DADAFEDGFGFKHC@@@A@A@C@E@A@CBCDCD@BAFABE@GBIBA@@@@@@AA@CGAEAEAI@@ICI@ACAA@CAAEGAA@@CC@AEE@ACAAAEG@E@A@@@CBCBEB@D@B@BABA@A@CAA@A@@A@A@C@CBC@C@EDCBGFCBAAG@G@C@AA@A@AAA"],encodeOffsets:[[121542,40833]]}},{type:"Feature",id:"130281",properties:{name:"遵化市",cp:[117.965892,40.189201],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@B@@B@@@@B@@@@@@@@B@@@@@@@@B@@B@@@@@@B@@B@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@B@@@@@@@@@@B@A@@@@@@@@B@@@@@@@@@@@@@@@BB@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@B@@@@@@@@B@@@@@@@@@@@@BB@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@B@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@B@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@A@@@@@@@@@@BA@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@A@@@@@B@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@B@B@@B@@@D@@@@B@B@@@@@@@@A@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@A@@BA@@B@@@@@B@@@@BBA@@B@@A@A@A@@BA@@@AB@@A@@@AA@@@A@@@AA@@B@B@B@@@B@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@B@B@@@BA@@@C@A@A@A@C@A@A@A@A@A@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@A@A@@@A@A@A@@@B@B@B@@@B@B@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@AA@C@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@A@@A@@ABABA@@@A@@A@@AAA@@AAA@@BA@C@A@A@AA@@A@@A@A@ABCDCB@@ABA@CDABA@@D@B@@@D@JAD@B@B@@BBBB@@B@@BB@@D@B@@BB@DA@@F@HBB@@@CDB@ABA@@@FB@@@@EFAB@B@@@B@@@@@@@@@DBDBJDB@FDDDB@@B@@@B@@@B@@B@@B@@@B@@@@@BB@@@@B@@@@@B@@A@@B@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@C@@@@@@@@@ADC@@@@@@@@@@B@@AB@@@@@@@B@B@@@@@@A@@@A@@@A@@@A@@@A@@@@@A@@@@@@@@@A@A@A@A@@@@@CF@@@@C@@@@B@BAD@B@@B@B@B@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@A@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@B@@@B@@A@AB@@ABA@A@E@@@A@BAA@@A@@AAA@G@EAA@E@@@@@AB@BCBA@A@@@AAC@A@A@C@@@ED@@@BB@@@@@@@A@@@A@@AABA@@@@@@@@@@@@B@@@@C@@B@B@BEABA@@@@BA@@A@@AA@ADAFB@AD@@@@@D@@@@AB@B@@@BA@@@@@A@@A@AA@C@A@@@ABAAABA@ABAB@@@@A@@A@@@@A@C@@@A@A@AA@A@@@@AC@C@@@@@AB@@@C@AB@A@@@@FA@AAA@@@@AC@@EBA@A@BB@B@@A@@@@@AAEB@B@@@D@@AI@@@@@A@@C@A@A@@B@BB@@@@@@DB@A@@BBBBBE@@FC@@B@@AB@D@@@B@@@@@@C@@BAB@B@BC@@A@@AA@@@A@@@@@A@@A@@@AA@@@@A@@@@BC@A@A@@AAA@C@@@AA@@AA@@B@@A@AA@@@A@@@@@@@@ABA@@B@@@A@@A@A@@@@@@@@@ABADCBAB@@@@@BB@B@@@BB@@BB@BAD@BBBBB@@FBD@BB@BBBB@@@C@@@@BD@@@C@@@B@@BC@A@@@A@@@@@@B@@@@@@D@@@@@ABC@@BDBB@@@A@C@@@@@@B@B@FAB@@@@@B@@ABB@@@@@B@@@A@@@@BB@@@@@@A@@@@BB@B@B@@@@A@AA@@@@ABA@@B@@BB@B@@EBIBKDA@@B@@@B@BB@@BB@@BA@@B@B@@A@ABA@ABA@@B@BB@@B@BA@@B@@@@@B@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@A@BA@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@A@A@@@@@@@@@@@A@@B@@@@@@@@@@@@A@@B@@@@@@@@@@@@AB@@@@@@@@@@@B@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@B@@@@@@A@@@@@@@A@@@@@@@@@AA@@@@@@@@A@@BB@@@@@@@@@@B@@@@@@@@@@A@@B@@@@@@@@@@@@@@AB@@@@A@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@BA@@@@@@@A@@@@@@@@@AB@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@@@B@@A@@@@BA@@@@@@@ADCD@@A@A@A@@AA@C@@@A@@@@@@@@@A@@@@@@@@AA@A@A@@AA@@@@@A@@BA@ABA@AB@@AB@@@@@B@@AB@@AB@@AB@@AB@BC@A@@@@DAB@B@@@BA@@@@B@@B@@B@BA@AB@BBB@@ABA@@@BBDB@B@@DB@@D@BBD@BBFAFAHAFAD@H@FBFDD@@@BBAF@BBBFB@@DBDBDFB@DBD@FAB@B@B@FAD@F@F@B@DAB@BBB@DDDFFHDBFBFBH@@@DBD@JDFBDDFBHFFH@@BDFFBDDBD@B@DADAB@B@D@BBFDDBBBB@DAB@JEJC@@DAB@HAB@D@FAF@FCFADABBB@DD@BJJFFBBJFDBB@B@DAD@BA@@DBBBBBLFDBTHDBFDDFBBDBB@B@FCDAB@@@B@B@J@F@DBB@@@BBDDBBB@B@B@JCD@DAF@DBD@F@@BD@BBB@@BDDB@FD@@D@DBJ@J@F@B@@@F@BBDBBBB@DADAF@DADBB@B@BBB@@BBB@B@FBH@DBBB@DBDBJ@HAJ@LAD@D@D@DBFDDBBBB@BADGBEB@BA@@D@BBB@BBBDFFBBB@DBFFB@DBD@HBD@HBH@B@@@F@FAHADAD@B@D@DBFBDBHDDBFBFBHBD@D@DBHDFDBBBBBBHFHDJFFBF@DBBBBBDDJHBDBFBD@L@HBNBDDBD@DBBAD@@@FBDBBBB@BADA@@D@B@B@H@LB@@D@D@DAB@B@D@HB@EBCFGLEHCDCBEDGHEHEJEDA@CACOIIIAAAA@ACAAEBEDCHEPKLMBAFIBC@@@E@ABIBCCKAKAKBIFMFGFCB@JCJCNEHCB@BABABE@EAG@AAA@@Q]CAGEGK@G@ABEBEDCHIFGNKD@JELGLMHIBADGFCLELCDEBGAC@AACAAAA@@KGKIACCC@EFMDMDIBCBEBC@EFIEAC@C@IBA@AAA@ECACCIEIACACCCEECAGCCAAA@AAC@C@CA@@AAAC@C@M@E@C@AAC@IIIGAAGGEI@EEKGGGAMCGEKKIAOBA@E@KEGEOQGCGCI@GBIDADKLMBECCC@IAKEK@A@MCEECGEOEOICCCAIAI@IBU@KBQAG@W@C@UAA@C@GBKBQLCJEJCFABIBIBCBCBABA@@D@@@B@H@B@DCBCDEDEDGHCBCBA@C@C@C@E@A@A@EFEDABC@C@GAUAC@C@CAAAAAA@ABABADCBEJADCBE@EBE@@AACA@E@GAE@A@@A@G@CCAACE@E@EBEA@@IGGKA@CB"],encodeOffsets:[[120609,40926]]}},{type:"Feature",id:"130229",properties:{name:"玉田县",cp:[117.738658,39.900401],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@BB@@B@BB@@B@BAB@DDH@JPEB@BA@BB@@KD@B@@BDB@@D@@@@BBB@BBDD@BBB@@BD@@AD@B@BADB@@B@@@@J@B@@@@@B@@@B@@BB@@@DB@@B@B@@BBB@@@@C@@@@@CBC@@@@@A@@D@B@DEL@B@BCDAB@@KPA@@@@@CAC@@AA@@BA@@@A@@@AA@@@B@B@JA@@B@@@DE@@DC@@BD@@@@@@@@@@@@@BB@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@A@@@@B@@@B@@@B@@@@A@@B@@@@A@@@@B@@B@@@@@@@@B@@BAB@B@B@D@@B@@@B@BB@@B@@@@@@@B@@@@@@B@@@@@@@@@@A@@@@@@@@B@@@@@@@@AB@@@@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@@B@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@AB@@@@A@@@@@AGEHGJ@@A@@@@@AA@@A@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@B@@A@B@@@@@A@@B@@@@@@@@@@@@@B@@@@@@@@@B@@A@@B@@A@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@A@@@@B@@@@@@B@@@@@B@@@@@@@@BEFA@CF@@ABAB@BA@@@@B@@@D@@AH@B@FAH@H@D@B@@A@@DAB@@@@A@@@@@@@@@@@@@@@@A@@@@A@A@A@A@@@A@@@A@@@@@@@@@@@@@@@@@@BA@@B@@@B@@@@@B@@@@AD@B@@@@@B@@@@@@@@@@A@@B@@@B@@@@@@ABA@@@A@@@@@@@@@@@@@@BB@@B@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@B@B@@@@@@D@@@BA@@@@@BB@@B@@@@@@BAB@@@@@@@AA@@@@@@B@BA@@@@@@@B@B@B@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@B@@@B@@B@@@@B@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@BA@@@@@@@@@@@@B@@BB@BAAAA@A@@@@@@@@@@@@A@@@@@@A@@@@@@A@@@@@@@@@@@A@A@@@@@@@@A@@@@A@@@@@A@@@A@@@BB@@@@@B@@@B@@@@A@AB@@@@@@D@@@B@@@B@HB@B@@@@D@@@B@BC@ADFBA@@@@@AB@BDDABD@B@BA@HJGBCBIDEB@D@@AH@B@@BDBB@@DF@@FABFB@HC@@@@FLB@B@BA@@BA@BDB@@@@BB@@BB@@@B@@A@BH@DD@@H@@@@B@@DBB@B@@@B@@@BFBBBBBDDB@BB@BAB@B@B@@ABAB@@A@@@A@@B@DABAB@@CBAB@B@B@BAB@B@@AB@@@@@BB@BB@@B@@AB@BCB@@@BB@@BA@@BA@BDH@@@@B@B@B@@AB@@@B@B@@@B@D@DAB@DBBA@@@@BAB@@@@AAA@@@ABAB@B@BAB@@BB@BB@@@@F@@@ABA@@@@B@@B@@BB@B@@@B@B@@@BB@@DA@@@A@@BA@@DBB@B@B@BA@B@@B@@@@@@B@@B@B@BBB@B@B@@@@@@@B@@@@A@@@A@@B@@@B@B@@@@@@@@A@@D@B@B@B@@A@A@@B@BABA@@AABA@@@@B@BAB@BCB@@AB@BAB@@@BAB@@@B@B@BB@@@A@C@A@@CA@@@@@A@@@@@@@@A@@@@B@@@@A@@@@A@AA@BAB@@@A@@@BADCB@B@@@B@@@B@@A@@BAB@B@D@@@D@B@BAD@B@B@BA@B@@D@B@BBB@@@@BBBBBB@@@@@BA@@D@D@B@B@B@B@B@@@BBB@B@BAB@B@@@B@B@DB@@B@B@@BB@@BB@DAB@B@D@B@@@B@B@B@BBB@B@@BB@BBBBFDB@@@DABB@@BBBB@@@B@BB@DBH@@B@D@@B@@CB@B@@@B@D@@@@@BA@@BBD@D@B@@@DA@@D@@@DCLEB@D@@@B@B@@@@@@A@ABADC@ABC@@@@B@FAD@B@BBB@DBB@D@FADC@@FABC@@DA@@B@DAB@@@@@@@@@@@B@@B@@@@@@@@@@B@@B@@@@@@@@@@@B@@@@@@@@@@@@B@@B@@@@@@@@@@B@@@@B@@@@@@@@@@@@B@@@@B@@@@B@@@@@@@@@@B@@@@@@B@@@@@@B@@@@@@B@@A@@@@@@@@B@@@@@@A@@B@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@B@@@@@@@@@@@BA@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@A@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@A@@@@@@@@@@B@@B@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@B@@@@@@AB@@@@@@@DAB@HLJH@@FBFAF@F@BDDB@D@H@BB@F@HBF@B@BD@BF@FAF@DABCFIDABCBABAB@BBBBDBD@D@VBHBD@D@BAFCFEB@B@F@D@D@D@B@DADAHGFCFCDCDA@C@A@G@A@@@CB@BADADAJAJABADEFIDICIEIACAE@@BKB@FKFIDK@I@ECMCI@ACK@@@CCM@E@@@AACDQJI@@JIBAHGNIFSAMCGIDCBGBA@A@CBI@EAAA@A@A@G@A@A@@@C@A@AAC@AB@AE@A@@@A@@@@AC@A@A@@@AAA@AAC@C@A@AAC@@AAA@AAE@A@ABAB@@A@CBA@AAA@C@CBCACACAA@EAA@ABEA@@A@CA@AHGHGBADC@@BM@E@C@IG@AI@@JCAA@ECG@C@CACAE@@ACAAAAGIMIAAGECI@CAACBCIAEAA@AACDEH@@GCIEGGIWIKAA@UAE@GBGAC@C@IAGICCCGGKOEECMMCCGGGGGI@@@ADABABMAC@@BACAA@CAAAA@AA@@A@AA@@@@A@@@@@AA@@@@A@@@AAA@@@A@@A@@A@@@AA@@A@@@@@AAA@BABA@ABABABABABAB@@@@AA@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@AA@@@@@@@B@@A@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@A@@@A@@@A@@@A@A@@@@@@A@@@@@@@@@@@@A@A@A@@@@@C@A@AAC@A@A@@@@A@@@A@@@A@A@@@A@@@A@@@@A@@@@@A@@@@@@@@@@A@@@@@A@@@@@A@@@A@@@@@A@A@@AA@@@@@@@@@@B@@@@@@@@@@@@@@@@@BA@@BA@AB@@ABA@@@@@@@@BA@A@@B@@A@@@AB@@A@@B@@A@@BA@@@A@@@@@@@@B@@@@@@@@@AACAAACCAAACCCEEA@EAA@C@E@@@C@EECC@@@@IHEJAF@D@FBDDD@BADADCBEBA@A@E@E@EBCBA@ABCFAB@@ADBB@@BDD@BBBADCDA@@@@FAB@F@B@B@BBDBDD@@@DBBAB@BABABABCBC@EBABGBCDCDA@@BABABABABA@A@ABC@C@A@A@A@A@@A@@A@@A@A@C@@@@@CCAACCAAACACAEAGAA@CBA@@@ABAD@B@@@@@BFDBBBD@@@B@B@@@B@@AD@@AB@@ABAB@BA@@@A@@@A@A@CAEAA@A@@@A@@BA@@@ADAB@B@@@B@@@@@DBB@B@@AB@@@B@@A@A@AAA@A@@AA@@@@@@@@AA@@CCCACAACAAAA@AA@B@@AB@B@D@@AHAF@B@@@BBB@BB@B@@ADE@@B@B@@@@@B@DBDDBB@B@@@B@B@B@D@@@@@B@@B@B@B@DAD@B@B@B@@@@@@@@B@@AD@B@B@D@B@@@@@@BBD@BB@@B@@@@B@@CF@BAB@@@B@@@@B@B@@@DAB@BAB@B@BBB@B@@B@@@@@BAF@D@@@D@@@B@@@B@@B@@@FAFAD@BA@@B@@@BB@@BBBDBB@B@B@B@@AD@@@@@@@B@BB@@B@@@B@@B@@@@B@@@@@B@@AB@BCBAB@B@@@@@B@@BB@@FFBB@B@BB@@DA@@@@BA@AAC@AA@@A@AB@@A@CBABA@@@@B@@@@BBBB@BB@@B@BB@@@AB@@A@CBA@CBA@@B@@@@B@@@DBBBBB@B@@@@@B@@A@EAE@@@A@@B@B@B@@@BA@ABA@A@AB@@A@@@@@@@@BBBBDB@@@BD@BB@@@@@@B@@CHABAB@@A@@@A@@@AACAAA@AA@A@@A@@A@@@A@@@AB@@@@@B@@@B@B@@@@@BA@A@@@A@ABA@ABA@C@A@A@AAC@AB@@CBAB@@A@@@A@E@G@A@C@AAABA@ABABA@CBCBCB@BA@@BBD@@@B@@@BA@EF@@A@A@ABEAA@CA@@A@@@@B@@@@HB@BB@BB@@@BA@@@IA@@AB@@ABAB@BB@@BL@@B@@@B@@ADBBB@DAB@B@@@BB@BA@@B@B@D@B@@B@@@B@@@DCBAB@B@@@B@BB@B@@@B@@@BA@GDAB@@@B@@@@@@BBB@B@B@BB@@@B@@@BG@GBEBG@CBA@AB@@A@AB@@ABAFA@@BC@A@C@A@C@CCA@A@AB@@AB@BABAFABA@@@A@@@A@CEAC@@@@AA@@A@EFCBABA@CA@@@@"],encodeOffsets:[[120361,40715]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
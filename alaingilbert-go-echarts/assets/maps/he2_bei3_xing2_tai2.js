@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("邢台",{type:"FeatureCollection",features:[{type:"Feature",id:"130502",properties:{name:"桥东区",cp:[114.507058,37.071287],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CACFA@CC@ACBA@AC@E@@C@CC@@CD@@KB@AE@AE@@A@@K@AF@BM@AB@H@BBB@FBDBBDB@B@@DB@AJ@AB@F@@@@@@A@B@@BAD@BA@@@@@@BEL@BAB@BCF@B@FB@BF@@@@CB@AG@@D@D@D@BE@@B@D@@@@@H@@@B@BBFBH@@@BBHBB@B@DBB@DBDBF@@@@@DBH@@BH@B@BB@@@@B@BH@@@@D@@E@@FBDD@BBB@F@@B@@@@BBA@@@@B@@B@@A@@@CBB@CA@BA@CC@@@@AA@@@B@@CEB@@@A@AL@BC@@D@B@@@@BD@@DH@@BB@DDH@@@BA@@@@@BBF@@@@@A@E@@@@AOBA@@B@TFF@@@D@BE@CAK@IBADA@@J@BCLA@KH@F@D@L@BD@@R@@EB@@@F@B@@CF@@@@G@E@@@EI@AGAACCEAE@BFQ@E@BFI@@CA@@@AB@BA@ABA@@BEB@BA@AG@@B@@A@CF@@AG@@AE@@BA@A@C@C@@DC@@DI@C@AAACAAA@@A@A@A@@CA@ACAE@@AJ@@A@@@A@EGA@ABAF@@ENAB@@A@AE@G@O@G@A@CA@A@A"],encodeOffsets:[[117263,37910]]}},{type:"Feature",id:"130524",properties:{name:"柏乡县",cp:[114.693425,37.482422],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCGEIEIA@BWBIB@B@FCBC@AB@DEB@JB@JBDD@@FL@BGD@@CBAH@DBN@FB@BFABCAAB@F@F@@@B@BAD@P@DAJBBDFBHDF@B@@E@QCM@ABM@BFB@B@@H@BDFBH@@@AF@@DBBEJABAH@D@BAF@@@@@DLB@BCF@FCD@BBJFDCFE@EB@@@@RTFBR@PBTAJ@\\FFDLBLDJBN@BJ@FHJBBBBBDHPBH@@DH@@@@@@BF@@@@DNBFBHBDPDDHAF@BTJPARAJAJARABAB@B@HQBKJIBQBC@@@GHSHU@U@MCICOAEAEDGDEBIBIBGBGDUIUAAAA@ACCKKCAA@KE@A@A@G@M@K@AFGLA@E@GAE@CA@SD@KAAGCA@@@@@AAC@AGAAC@M@@@G@GBEDEAI@CBCD@BKDGBCBKBC@AAA@A@C@C@[IMISQAA"],encodeOffsets:[[117414,38313]]}},{type:"Feature",id:"130521",properties:{name:"邢台县",cp:[114.561132,37.05073],childNum:1},geometry:{type:"Polygon",coordinates:["@@M@MCE@MAc@GHAHABBFB@DHFLHNHPDP@JBDETQHKCA@GIGIKGYCMDMBKDQAKCA@AACAEK@CIMCAEACGFA@@CABABE@C@CDABADE@@ICAAGBCCADAD@@EDA@K@AEE@EBCB@DK@@F@BA@CAACECEB@HFH@BBBEDQJEBODGCCCMEE@E@OAEBGBQFSHGJCFINQJEDCBQBOBKBQFKDQJA@Q@S@OA[@UAGAG@EACAMA@@CAMECACACCQGAAECOGCCIEAACCIEQAMEQEOGSOGLMHKFGJEJFPBBNPHHHJ@N@D@HCJO@C@C@OFGFEFGNBJBFDHFNJH@BHPARAJC^AFCNLNLDHBFBN@VFHFBBHFPDH@P@JP@B@N@NLTJLLTJJNDLFRPNL@R@H@DBNHNHHBFFLFPBJ@H@L@JAFDLLNDBFDDBJHLFDBHDJDJDFBLBPNFNAN\\KPBPA@@R@B@L@NGFALENENEHENKDABIBAFCHAH@PDVGB@LCD@BAD@F@H@DBJBTFLBFBRBFBVDJFPDBBLDPDFBFIDE@CFG@AF@BABCAA@CAAC@BADG@CHKLENDLHhJ@AJIDKDOHMJMJGLGBCBA@@DCLMNINCNCRBD@J@NB@@TDH@NAHAHCHCHC@@\\IHCFEPKPODCBADCHGJIJMDCFAJGZKZEPAHA@@NAHAFANCPELEDAFCXCLA@@@@@@@@@@LATBB@HBJBL@HBF@JBD@HBH@LD@@BBHHFHDHHGBAJE@@@MF@JA@@F@D@PIL@@@BALDHAFABAB@DC@AE@AKCBEC@A@C@A@A@AJ@F@@ABELE@EBA@IBC@ABAN@DGAGAEE@AE@A@AACO@@QAC@AAC@EBA@CBAJC@CAAA@EG@@CC@ADABE@A@C@A@@BK@ABAB@BC@CK@CA@C@A@ADCAA@A@A@@AA@C@GBABAD@@C@AACK@AAACB@B@BE@GIBEA@@@CG@AACA@CA@@C@GEECACAA@@C@GBEBE@CC@@BIBWAK@A@E@@CK@CA@EQ@@HCBED@@@@CDG@G@I@C@A@@E@C@ACEE@EA@EI@AJABI@@A@AG@G@BFA@@LC@@DD@@D@DF@D@@BABBDD@@DEBBD@D@B@BDBB@H@P@H@F@@B@BA@MB@FE@AB@BHB@F@B@@@BI@@BF@DB@BDB@@@B@B@BB@BBBDBBD@J@@CD@@CD@D@B@B@@AF@@BH@@BE@@D@BA@@@BHB@@AFA@AB@BAB@@ABA@@B@@DJ@AEF@R@AEF@FBDDBBBHJ@@F@@@F@H@@E@@DA@E@@@A@@FQ@@@ACK@C@E@G@@LKBADI@@@CBAB@JBL@DAFC@@@E@SEA@@@ABBP@@@@@F@B@@@@AE@A@@@@AB@@G@CCA@@AG@@CC@@A@@A@C@@@ADK@@B@B@@FA@DA@@@B@@B@@D@@DABB@@DAA@D@@@BA@@@@A@@B@AA@@@@@AE@A@AAC@ACI@@BG@ALABEF@@CJBDJ@@H@B@BABEDKBAA@AACBAB@@A@CE@A@AB@BIB@BCB@CE@A@@AAAI@KCK@E@GABEC@EJAB@H@BCDABMDAAGAI@KCA@CAA@CAAG@AE@@KBIFCLAFAF@JAFAB@DK@GDCHEFAF@B@@C@A@@GKA@GAC@ICE@A@CCCCBEFCJ@F@DEB@@ABC@GCA@CBCBA@AB@@BB@@@BAC@@CB@B@@@@CBA@QB@@@@@@A@AGEGA@FGCCCAE@@@AGWAKA@CAAACCCCK@AABQB@F@FCDAAA@GBAAEIGCG@CC@A@@BE@@AACCBEBCBGBCAGAA@GBABCD@@I@E@I@W@I@K@E@GAOBI@QDMDEBKBI@YFG@I@"],encodeOffsets:[[117009,37869]]}},{type:"Feature",id:"130503",properties:{name:"桥西区",cp:[114.468601,37.059827],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAAAI]CBAA@AG@@@CQACEBA@C@@@@BAB@LABA@A@AAAC@G@AABGBGBIBG@C@BD@BF@@AB@D@@DDHJHBFAB@HBBCBEDE@A@ARBBL@DDDDBBDBB@BLHX@B@@BFDDHD@EHBHF@B@B@@@@A@@RAB@D@@A@A@@DD@AB@@A@@AA@@BABAD@DDB@HAD@BA@CFE@I@EDAFDDDDB@F@JDD@HBB@HL@@@B@DA@E@EBGFCD@HCLA@EBIBE@EBKBEDAJ@LF@@BBHDBB@DBB@LDJ@HBBBNCBADC@A@GBAFID@AFHBF@L@LDJ@BB@BB@F@@DDA@AJA@ABAB@F@@D@BA@ABBD@BBBLAFCBA@A@A@GI@ACDI@@FEBABKH@@AJ@@EF@@@@C@@G@@A@A@@A@@A@AAG@@AG@C@@@@AEAC@CAA@C@AAAAG@A@@AGAE@A@A@@@G@@@@@C@AF@@A@C@C@CH@@BDA@@@@AEA@@E@ADE@ABA@AFK@A@@@@B@@ABC@AA@B@@@@@@@@EBAI@@BCA@@@ACAAAAC@EAA@A@G@AAACEG@GC@A@@A@C@CAC@@B@@@@A@E@@HBDBD@DA@C@AAAA@AECG@A@K@C@A@ACCEEC"],encodeOffsets:[[117192,37877]]}},{type:"Feature",id:"130522",properties:{name:"临城县",cp:[114.498761,37.444498],childNum:1},geometry:{type:"Polygon",coordinates:["@@D@ACAC@MEBC@A@GAA@@AACAAGDE@@AABABA@@@C@B@@BCBABAHBDEBEAOBANEJDB@BCACDSJK@SA@@IAGAMBIDIDKJIAGDCBC@CFGDAFAF@DQNE@E@AABCB@AAA@EBEDG@EE@GECAACCCCAAAM@IAG@GAAAKAMMEGFIHKHKBG@ICKCOCYBOLKHEFCBCDADA@CDCLEJGNOLIDABGDGDSLEDGBYNQHAD@D@DAFA@OCABCA@@C@@A@@AB@@A@@@@B@B@BAFEF@BDFFLHDDPLLFJDDLVLJBBDAHADBBABABAFIDCD@FEB@BCB@@BDHAFDBHRJAB@HJFBB@F@JBBBDH@@GD@D@BHAFCDD@F@@C@GD@FDDFAB@@ADBBDBD@DG@ODCF@D@BJGJAN@DDJCB@DBLAFAFCDCFED@HBDA@BDAHGLOB@B@FBJADCVADCBBD@D@HA@AFAH@BC@CF@DEJ@B@@D@@HCBABCD@BBNB@A@AHCBBCLBDDBBAB@DDBBFBF@FD@BA@@BBABAHG@AHEBB@DBBHBDAFCD@J@B@JB@BJDBJD@NHJ@@H@BH@B@BALEFADABBDH@DBDADAB@JJBNBB@@DDDPLJFD@JFDBBBDBT@LBLBVHTH`VFBBBVHPBHBL@^EHAVGFCFAFCLCJA@AB@NCDALCDARCF@JA@EH@D@@@RBBFH@RBQS@@@@FAF@DEECAI@ADC@EDE@AKA@C@@@@BE@A@CBGBAFIAA@CE@@B@@AGCE@A@GA@A@AEN@BAN@RDF@@@@ACEAGCEAABI@C@OBC@A@A@@@E@EBADBBAAEA@@EAM@CBGDA@@HC@AEK@@CCIAA@@I@ECAIB@CAAG@@BABO@CBKBG@AAECCAEBCBIFEBECAGGC@GB@F_AED@FCDE@GEAAAGAQ@GDGDE@@CG@SBMB@CAB@BAA@CE@EBCAAA@AAAEACCCIC@GBGIAACAAACE@@B@@BBB@@@A@A@A@D@B@E@@@@@@B@@B@D@@@A@C@@@CD@@D@ABGC@@BA@G@YC@@IACBCD@JCBA@@BBF@@E@@CA@BJBD@BADEFGBA@GBA@@DA@K@BCC@GFBDDBABCB@B@@A@CAGBEB@@O@CGAMAAEA@G@AC@@AA@CABC"],encodeOffsets:[[117157,38259]]}},{type:"Feature",id:"130523",properties:{name:"内丘县",cp:[114.512128,37.286669],childNum:1},geometry:{type:"Polygon",coordinates:["@@@DDPBNDHBBDPJVJJJJJHNJD@R@PFJNHHL@@@\\DJBPDBDFDF@DBF@J@BE@A@A@A@@B@@@BA@@@BD@@@DBBAPDB@BE@C@CBCRGZMHAFCTKHCHCBAJCPKHMFIDKDCB@BCDCDAFELGPKZAPDLDJDH@LALGJGHENFBNBLBB@HBH@JBNBBDDDDBBFD@HFFH@FCFAB@BBA@ADBBF@F@RM@CBEBEHCDED@DAHCJBLIJCJCNAHBJB@@TBL@TIDCDB@ACAFIBMPAFBFAACBGBADA@AA@D@@@B@BABA@BF@HCBBBD@BB@HBB@D@FA@NBDBDC@ADDBB@@BD@@B@HFBBBBNDHP@@@FAHADBB@@@@ADABACAACHED@ADL@B@@CB@HAB@HAFEBC@AACAIB@@DF@@@AE@AB@DA@IDCDAJB@@ZDH@B@@AD@AH@B@CC@@D@@@D@B@@@C@AA@@@@@@@@F@A@C@B@B@B@@AA@AA@@@DFBBDBBBHJHAD@DJDDFBBB@BBBDBFAF@@DBB@ABA@DNATAH@@DF@HCHCR@HBBBFBH@HDDBFBD@@@LBL@DA@I@CD@FBDBRAFADA@CAE@CEG@EK@@KAAA@@HC@A@@G@@@@CFA@AEA@C@AAMI@EA@E@@BAEACC@A@CEAE@E@ABADKBGFMBCNKDAB@DAF@FAB@FAB@RCNC@@JAPEBADAJ@DADE@GCCE@GC@@EE@AB@@CC@A@@CCM@CCAKA@BGB@ACAGCIEGGGGACCIAAAAEIGKCGACAACG@ECGGOAECC@AAAGMCGEGGGAA@@KCG@GAC@IAE@GAK@IAGAA@SAKB@@@@@@@@@@KBWDEDCBKFOFMDEBGBMB@@GBOBYFYLIHEBCDINIJGHCDABCDOPOLEFGD[J@@GDGDGDGBMBG@SC@@MAI@C@QAMDMDMJKNCD@@ABADKHIHINGNCPCLIJ@BgIKGMCKFGL@DCHABD@BB@DBBADABE@@BEH@DCFEJEAOCKCAAOCIEUCEAQAEAKASEIACAG@E@C@ABC@KDA@UHOCG@GBEDABAJCBMLGFMFMFKFEBMHK@A@Q@@@OBOA[L"],encodeOffsets:[[116697,38260]]}},{type:"Feature",id:"130525",properties:{name:"隆尧县",cp:[114.770419,37.350172],childNum:1},geometry:{type:"Polygon",coordinates:["@@OAGA@B@AE@AACCC@I@AC@@M@UAWEQEYEBBCBGDCBA@ACAAE@AAI@CB@FGB@BCBE@G@SAA@SE@NCBIDECBBBDDHHLFJBBBBDJBDHHHHJFHDDB@BHA@ALBDB@DDN@DB@D@@DA@@BFF@@HDF@DD@HCFCBI@CBABOFIB@@MDQDA@EBA@EBE@CBA@CBMLADENAHCLAB@B@FBFDFB@D@BDBF@AF@B@@FNJBBD@B@BFB@DE@@@@@HB@D@@GB@BB@LL@@FFH@DBF@DCBEBQBCAEAC@@D@JCBK@KA@@C@EACAGCG@@HCFEDC@BFE`A@@HHDBHFDFAJEDAFADBFDBBH@LADAP@BA@AH@BB@DJADB@FFA@CBAD@DA@E@AJAXA@AJBJFHFDDBBTRNJ\\JD@D@B@B@BBD@LADAHALC@ADCDAJ@FBFCHAH@@@N@D@BBBHD@BB@@@@B@HDBB@LTCB@@DBF@H@FKBEH@B@L@N@H@B@BLFB@DBLLDD@BBBBBJVCVAHAHAJAJCFCHBFBFDPDJLGNQRIPKLMNETAHI@UJMNKHGFEBCFEJIJEDAL@BEB@DAFBFABAB@N@@DFDJFJCACFCEG@AID@@@C@CDA@EI@@DC@A@AAAAYAE@@AA@GAEA@HB@@DEDMBKF@@@BFBB@CHOCAEIBEBQLE@ACF@LEAC@ANA@AAARCNCNCBGDEHGJDLFDGH@NB@DXBJEJCL@BAFCLAH@BBNBLBHCDDB@LEDA@CJCFABADBBB@@@CH@BCAIF@B@JCFEDC@CD@@AHMT@D@@ABAF@@AE@@CL@AQ@EAC@CAEICICGGCC@AEACCAACEGBAGEBCBA@GQ@G@GCGACAEEAC@C@AEBCJCCC@E@AHAACD@ACACC@@FCBACAAADBBCBACKDEAEDAB@DDFKFA@@@CGB@@CAKJAEGHC@A@C@@BAD@GG@@@@AEAAOOCCMKAAGGEEC@ABI@A@@C@AE@BEIB@BABC@@@CCAEA@AB@GAAA@SB@BCBKDEBGC@MEAE@CABAH@L@AEH@@ED@BID@C@QAE@E@@EC@AGGBG@@@G@BFA@@@A@@BADABBBA@BDQBAAILGFCDABMFADCBIFSHEBIDA@KBOCCCECAA@AGKCGACAACMEIAECEGIOOSKKAC@IA"],encodeOffsets:[[117483,38122]]}},{type:"Feature",id:"130526",properties:{name:"任县",cp:[114.671936,37.120982],childNum:1},geometry:{type:"Polygon",coordinates:["@@CIGICECK@@ADCBMBACE@CAA@I@CD@BCBE@E@A@G@@FC@CBCBC@KAAL@BEFEDGACAEAAECCOAO@A@BDC@@D@BCDEB@DAFF@DB@HEAA@GHKD@BE@C@AC@@K@@@@@CC@EC@SACAACEEA@C@@CC@IB@DIDAB@DAB@FBD@BBD@RP@BD@B@BBFF@BFBHCHM@AB@BAD@JAB@FKFAF@BE@I@@B@B@B@D@BFDDABLF@@BCDA@ABEBGBKCAB@@K@OJC@E@@@IBE@@N@@IFABGHHNBB@BDDBFHPDH@FDHFDJCDA@MTFB@TBH@F@DA@AHA@EDAJ@BBF@BBBDB@DAHCDAAAZFRFXFVBN@@@BDJ@D@DDBBF@@B@AHBPBJBD@LBTLPPHJDFBFFJDNBBBDDHHL@BBBFDDDPDLAB@JCFATGJEDABCNEBADCHEJKAA@IHA@CBC@CVAJEB@HBJ@H@DC@EAEFAFG@E@CAAG@AGDG@@B@B@FAFAHGHCFEG@ACJ@BKAC@CC@AC@SBCB@@AC@AKBAFBDADCDA@@B@ACD@NABBBFFCHAB@LIQAKACGGACASOCCG@@@DAB@B@@AD@HD@CF@FABCBA@CFCBE@AA@@CAADC@AB@DAFEDAAAE@ECBEBCDE@KAEE@@EE@AGA@A@@EDAFCAEGAG@CDC@@DACG@BDOBABCBOAC@@@AAMBACGBAA@AG@@HC@K@E@I@EB@HBBE@GBBDA@A@ACECA@C@KB@ACCICIBE@EBAAI@@B@DI@ACEI"],encodeOffsets:[[117542,37980]]}},{type:"Feature",id:"130527",properties:{name:"南和县",cp:[114.683863,37.005017],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAGAM@CAAAKHGJGJABC@AACBC@CAIC@@@FE@E@@DCBKB@@C@AH@BG@@@ED@FABC@C@AC@EK@GBCA@IE@@EACE@ECAEDCA@@AACA@@@@@KAA@@CCACAC@O@GBE@EBA@@BGBCBSFEBAB@FAB@BEAA@@AA@GAE@GDALG@EB@BVNFF@JEHEHI@CB@PEA@MA@QAGA@CGACBGF@@BLABALHDD@DDBLJ@@DJ@D@L@@LA@IAE@@LIFEB@AACE@@AA@@CE@ECKBC@@CD@@CGCC@AD@DBDFB@D@FDF@BJ@@BD@@DAFAF@H@DB@DBDBFF@H@DB@@DDBBBH@@D@@FBJA@HAFA@A@BDBBL@BD@B@DC@ABAB@H@DBB@@@B@BBBCD@B@B@DDBL@@DADA@AB@BAL@@@B@D@BAFCB@BDD@@FHB@BBJAD@@DD@B@FFBDDBTBD@@FDD@@@@L@@@BDD@F@@ALCHGB@FB@GCAE@BE@CFADC@A@CD@ACB@P@PBDDBFFBDBHBFCFE@ABKLBD@DADAD@@EH@B@F@F@DA@ADCJ@B@DBF@BDNADABC@@DLDFHJDJFJBDJ@@C@AJ@BBFAF@JAJDDD@BLAD@B@FDBDB@B@ACHAF@AA@GFAJ@F@L@D@@GH@@BBBHABDNABB@@D@PBDABAPAACH@BD@CD@AGL@@@ACCCCCG@AA@AE@A@@AFG@AGA@EAA@@@CH@BFJ@JMBCDC@AG@ACE@AE@@CEKBAM@AEB@@AAAC@A@@AC@IA@A@AI@@BA@ADG@ICIC@A@@@@A@G@CE@C@CQGB@C@AD@@G@ADCLEB@@CB@@DB@FC@@DCHAAA@@AA@CD@@@@ECACC@CCBK@C@ABE@CB@FI@EAAA@CEASDCA@A@CAAA@C@@CI@CA@ADM@AKECAKIMM@ACIAA@@C@IB@AAGE@@@@@AC@@AI"],encodeOffsets:[[117558,37804]]}},{type:"Feature",id:"130528",properties:{name:"宁晋县",cp:[114.93992,37.624564],childNum:1},geometry:{type:"Polygon",coordinates:["@@@K@AB@DA@EE@@BE@AB@BC@S@GN@BC@@DCDEFIDA@E@BJADG@@D@@AACAABEBID@DCBKFA@CCGDKAMAAAG@KBEDABK@IDIFWA@CMAG@CHKEICGHCFAHMDMDQDBB@BMB@BBDKFE@BDF@RKFAJABFPDDGA@EA@A@@LENAFC@CA@@GFBHBB@@BF@ZBBBBBB@D@@CJ@@FCB@D@D@@JC@BFHEDBDIDIEEC@CM@A@ABEBEACBA@AFK@CBIFIJEFADEFGHMLIN@VGJSBMFKNOLQJMRKH@N@VGVGT@H@@ADARIJALGR@@R@JDHB@DA@@BH@B@B@BAF@DABAD@B@BB@BBFDA@@HFAHB@BFA@@B@D@@@@BF@HFB@BBFABBFFDF@BBDD@HFBBBHABBFJFCAD@FD@PCDFEHBFBA@@BBFJBD@FBBPBDAF@DF@DBBD@JABB@D@DDAB@BBDDHJ@FB@FABBD@HCHCDB@B@B@BDB@@FLBB@JJ@F@FBB@BAFAZBBB@F@@DA@E@CHA@GN@LC@AB@@B@@P@DBLHDFBBFDDA@@DJDHF@BFF@@BB@@IBADAVAFD@DDDF@B@DB@D@B@@@NE@CD@BD@D@FCBGH@JEL@D@DCAEAAHELELFLDBBD@@D@@DBD@NBB@BBBBALA@AFFB@BER@B@JD@@FB@DB\\HAJYFCF@@FJJ@FBF@B@DBJ@DAABBBH@@D@@@@@J@F@BD@@@@@L@ADJ@ADBBN@F@B@FCBA@CBEBAF@D@@A@CBA@AF@B@@DPB@@@@B@@@AFFA@FB@HBB@BC@A@AE@ACBAF@@GD@@EDADGFC@AC@BI@CUI@CBA@E@@@@@C@@@ABABKD@@FBBBAFAAADAH@BAD@BEDA@EDCH@BB@@TI@@BDHBB@@AJA@@@IDADANBDEFCH@DD@@@@@ELCD@BED@@GH@@EB@HAF@B@B@@GDABGDS@@F@BCBABGDABABKB@@@D@@A@O@@BG@CECIAA@@EBI@@DK@@B@@@@A@@@E@MAAA@GAE@E@IDC@KBIBMIEAOAOIKMAEAGLI@KQGCAC@GAACG@BEA@G@ECBAD@BAD@DABA@A@ABCFBDAFICGBEBCBABA@BDHDA@@@A@CFCDEAICAAAC@ME@A@CGEKIMKOCMCGCOD@A@ACAA@CQ@AG@KGKCI@CBABcDCOAKB@B@B@BAGIDA@BDAACLADCDCIMIUEOAGCICSEQ@MPGDAVBJEFKJC@@ESACCKEGKAQ@Q@UBUD@@ABBDEDGBAAC@AAC@AB@@E@@@CBSAOF@BQDI@"],encodeOffsets:[[117779,38316]]}},{type:"Feature",id:"130529",properties:{name:"巨鹿县",cp:[115.037477,37.221112],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@K@ODGBQ@KJA@GBEDAEAAMBC@BDA@@@CBCDCBEAABBLD@@BA@AD@TBDD@@DBDALI@BDH@EFGDGHEBEBA@A@@@CHBHH@BB@D@FEHEBBF@FCDG@I@GAA@IFUB@DAD@DGB@JBBBBRAACB@AABABC@AB@@@B@AEH@@@H@HABHD@@FF@F@RBD@C@AJC@@FG@BFK@G@ABDBF@FB@NHDFALCDA@ATAB@BB@HBAB@BFDD@@D@BA@AJAAFF@@B@DB@J@BAD@FFHHBBNLDDPPBBBF@@@@HHC@AB@@@D@BGDFHIBBL@DA@DH@@B@LECE@CBAFCFBLCBDDAAABCBBBDDA@ED@BDBDC@BDGB@B@FDDIDADBFD@D@FBBFBDDH@H@HHRB@DAFABHHADFBBDDFB@BDDHHJDJDBF@DBD@FBRK@@DF@F@@FCBA@@B@LJ@RC@APETBDA@@F@@@BAD@BBD@BBHAFCACBA@@VCVAR@R@LBFHDLBDFT@@LCbK@@PCFEFIFM@C@IB@ZADDBB@BDNDNR@FACOF@@CAG@A@I@GBK@OBGACAQICKAGAKEBKJKLAdILGBMBEAC@GAGAACGAA@GFIDEFGLAJDH@BIBMCIGGOEGAG@UAOAM@WBKCEGKCCGDIAMDI@A@SR@@@B@B@BF@@HDB@DEB@DADADE@AF@AGCGECC@AAG@E@@@EBA@A@A@A@UKQEKCECMECAEECO@AHIFAD@DABA@EAEO@AACCAC@EAEAICECEB@PC@@CMG@@E@ABCCAAGC@CAE@A@AB@BABE@IBA@ACABCCACAEIBG@C@EBC@SBYBABMJM@K@A@]@QLGHDF@@HFEBC@A@CBC@A@E@KB@@E@EBEBI@I@ADEBSD"],encodeOffsets:[[117716,38052]]}},{type:"Feature",id:"130530",properties:{name:"新河县",cp:[115.250907,37.520862],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@EAEACCCE@@JCBG@G@@BAD@FBBDA@FB@@BBBBAAA@@@@D@@FB@DA@CB@BDB@F@@C@ED@@A@A"],["@@@LJBABAD@B@@@B@HI@@LC@ADC@@FE@CA@C@E@@BGAGEEA@@D@@E@@AIDSF@@@@CBC@@@A@KBE@OAG@K@CBKBMCCBCBG@CACACCECAGQEOCUAWA@NA@ADC@CC@EA@KC@EE@@@aLKDIDELIFUACBOH@NFRDTDJBHFPJVJNCDCDKBBDCB@ACBHJABA@A@A@BLDPdCBADAJ@LDLHH@@BDRB@DB@B@BPCHDNDPDNLLJHFJDRKFDLDJDDEBBBB@BBBJJNJJFVDD@VAD@H@NAH@B@D@F@@AJCLCNENGBANG@ALG@@JGDCFGNGFCFCFEDAJOHEHDBHAHEJBR@HBF@NJ@FOF@BADCAGJEXEB@JAPAFAFEBSB@J@ACFA@GB@HB@EF@@EBABB@@D@B@BA@GCBA@@GHBDD@DBFBB@EJAB@AE@A^E@CC@C@EBAGCAMBE@@D@D@D@FEAAAE@DKJE@@I@CB@@CCAAC@CDABBFBBBBD@@LKAE@GBGFA@C@IBCE@AB@AG@@A@@@AIJ@@@@EL@B@FB@DB@F@BCACAAKACGD@FAB@ACD@B@@BBDB@H@@@@@@C@@G@CC@CIAAA@CBCP@HABT@BD@BCBABE@CACJADCGAACA@BCAAF@ACD@EEEF@HA@AA@A@@@@B@ACBIICOEANG@ACA@AB@AGCAD@DMAE@CFI@@MC@ADABABYBOAKCIIEGBECAE@GBCAMBCEEC@ACAG@IBG@A@@EG@@E@@@@BA@CJ@@EFGL@DAAKCC@EFGD@BBDA@C@C@CG@@DC@C@BCAAOBCBCB@B@@CBEB@DBDEDE@@@@D"]],encodeOffsets:[[[118060,38300]],[[118063,38316]]]}},{type:"Feature",id:"130531",properties:{name:"广宗县",cp:[115.142626,37.074661],childNum:1},geometry:{type:"Polygon",coordinates:["@@GBIDIFA@MJIHCDIPAH@BHFRDDBDBF@ADCLCJCBCBAFCDCFC@@EA@C@@D@D@@F@BDDBFBF@B@FLHNBJ@DADC@AHBFBA@LADABC@@D@@@@A@@@@@@FG@@@@BBD@@@FB@@@@@@@@B@BB@@HC@G@AL@@@B@LAH@J@DAH@DJFANOBBFBNDHBBE@BD@@@FB@FAD@@BBB@F@DARADACC@C@CA@D@DAFBF@HDF@N@DC@@@ABEF@D@DDLB@F@DBD@BHDBAD@B@FH@DN@@ODA@DFDFBJBF@FBDDDBBP@BF@FABCBC@EBGJ@BDPFFDBNFFDLDRFVLB@B@B@B@FA@@F@H@BBD@FDDHBHE@@BCFCBCBA@CFA@GC@@AEA@A@@@Q@@T@BCJBNCJDHLDFHLDXAN@PBVBH@HBPFHHDJANAJG@@N@DV@@AF@L@J@VAHILGLKHGFKCSAICKAI@@@KBMBCFKBAF@D@DE@C@@JCR@@A@CBAB@AE@@A@C@AE@@C@@A@CABK@EA@AEBAD@@ACKOICMCA@KAI@I@E@KMAQACAECKAECOAGAK@KFEFEBAHQ@IDEFGFCDAFCLCHAFBFDDHBFJNDDHBJIFICKKWEG@C@A@@@OBE@I@G@C@@@AAIAG@EAGEMCKEKGMCCEGUGA@SCMKAADEBELGJKB@DGNCAGIE@AMABA@EE@@C@CH@HCDK@OAACAOCC@E@EB@@@B@BA@E@@CC@@DA@@@@EQ@KBEAA@AE@EBABGA@@CDABF@FD@B@ACJ@H@@CD@@DV@@CH@JBDDBBNIF@FA@E@@D@@@B@AECKAAGBCCAC@I@AD@D@@GEBCEW@A@@ACAMAOAAA@GA@G@CBAB@DEBE@@IF@HABADAP@FB@ACGEQDBH@DAAA@A@@@A@A@CAC@CMBA@@IBMIDEDQAIEMGECEACCCACCAEA@IAI@C@EBCBAFAD@HB@BDDBJ@B@DXJHBB@BG@@JAL@BEB@F@JIBCAEC@@C@G@MAA@EB"],encodeOffsets:[[117904,37786]]}},{type:"Feature",id:"130532",properties:{name:"平乡县",cp:[115.030075,37.063148],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@ACA@CBACA@MBBD@B@@ODQD@@C@@C@@@@KBA@@BBDBFB@@DA@EBGBGDG@G@A@MHC@@F@@C@@DBB@@BBGBCD@@EDA@@CA@@DA@KFCD@B@HC@@B@DHADRD@F@@D@H@B@@B@D@DJ@JCH@BAB@@BJB@B@@JBD@@@BBDBB@@FA@BBNLADF@@BFF@BDH@@BCDADINI@AEG@@D@@BB@FHB@BEH@BB@F@@BBBH@DDDDBD@@K@BHDCH@HBBFEDCB@FB@B@BHF@@FF@BF@LCFADAFFDF@BBCBEFCBA@@BCDBB@DB@@BAFED@DABADEBE@@DGCC@@BA@A@CB@@H@DDTPDBHBDHLBRBR@HAPCL@D@TCFABCJ@J@FAFAF@@@LAF@B@D@DAB@D@FAGE@@CEHGRK^@B@L@N@NIBAZATAD@FAD@H@JABFBDDDBABDB@JAF@BA@ABACK@C@CFEBA@@D@@C@MCE@GAEBE@C@CDBD@D@BDBCBQ@C@EAA@AC@EBA@@E@@ACF@AACGAMAEPABMIE@CBG@C@IBG@K@A@@BKH@D@@GA@@A@A@@@@@@A@@E@@AC@A@@H@@E@@@@B@@@@@@CD@BABC@KABAEBGD@BC@CAIGMEKA@E@EACAACE@@@C@@AC@@@@BA@GDADBBA@A@SGEAC@KAA@UAIAKDA@A@IBA@ABC@CBE@I@CD@@I@C@CCMCGCCAA@GCCAIDAB@BGAA@A@AA@A@C@@A@@B@@@@AB@BCA@GGBCAK@E@E@@BABCBE@A@@EIAA@A@"],encodeOffsets:[[117714,37837]]}},{type:"Feature",id:"130533",properties:{name:"威县",cp:[115.266703,36.975478],childNum:1},geometry:{type:"Polygon",coordinates:["@@EICCEIQAMCKBA@WDYBMAMAOECAKCA@Q@SDABA@K@A@E@I@K@@BQBKAUAAN@JB@NA@DBD@D@B@B@@@BBBCBG@CAFRDH@BEAO@CBABGBE@@JF@FA@CBADAH@B@@HBBPBNBDB@BB@X@DFFA@HC@C@@B@JBDDDHABBDLBFA@@@C@@@@FEBE@MJAACCIAG@@DU@@CC@@DG@I@BDA@C@@EAECB@DB@AHAB@FBFB@FBLAR@@F@@B@@CD@@DF@B@@A@A@@FAF@D@PDDBBB@PCLGDG@@D@DF@@FABNB@BJFBHMDCHA@ILKHAFCFBBNLTDB@VHFHDDHNFLDLFNBH@FBHBJ@B@@@D@H@JAF@P@@@B@DFHLXDLEJIJGACCIMAECGECEAGBKDEDCBEDEHCF@JGRABEFEF@LBLBHDPBFDLBFBDBRLNF@J@J@LBB@NDJDLPBD@@BCFA@BFBL@BA@D@BD@@@BFD@B@@@BFA@AB@D@BQ@ID@@@DCFC@E@ABELADAN@L@@BJDLBJDTELGHKLKHGJR@LAbE^CZAZAH@ZCD@JAVCVAFBFBH@L@DBJ@FCF@B@P@RGNKJODEDCFOBABCDCDGAC@CAE@CHAB@J@FIBCDAJKHGDCHIDG@E@G@ACGIMAACECGFKDCLOFAAE@@@@CBAA@A@APC@AIEG@EBAAG@AEB@BABOD@@ABE@AB@DABBBB@DF@JAD@D@BC@CDEFCPCDDBDF@BBF@JFFHBN@DAJ@NHHNBJ@F@PEPK@AA@AABABAD@D@DCHGHGHMFGFOFKFMBGBELUWAAAGEA@GCEA@@AAKICC@@IGEI@@@CBEBCBE@E@IAAUQACCEGM@@AA@A@@EKKOEC@E@@C@A@CO@AB@HA@@@CD@@C@@AI@CAGDMBBBEBCD@J@FEJ@BFBHFA@@H@BFD@@@BBD@DA@AAG@A@A@CDQ@@BE@@LEH@FAB@D@DABC@CACDMBGQBG@IAE@A@KAA@YA_CA@E@GBCGIGII@@CAGEGCGCQBA@SDIDKBG@CGEA@CACACMCWCA@SCAAC@QGMAGBIBECACCCIGQACC@CBC@E"],encodeOffsets:[[118138,37767]]}},{type:"Feature",id:"130535",properties:{name:"临西县",cp:[115.501048,36.870811],childNum:1},geometry:{type:"Polygon",coordinates:["@@IDOAKCAH@BEHC@UDAEICO@@@G@C@QFC@IDI@BDIBA@E@@DEFBDADG@CHADABC@A@ACAECEKBECACEBGFOHJDFJCJ@BGJ@B@L@J@FWJADEN@PBDBFB@BHDF@BBBBDHJ@BPVXCB@LANDRBFJDDFJ@FAD@DDDRBJHDDBDFDJAHANBRHD@BBTDB@XDNDBDBD@DFBDHH@LAJCTCB@RAHDHDHFDB@@JJJHDHHAF@B@`DZBB@LBB@F@JBH@RAJCLED@DADAHADALCDALCN@D@JADBB@FBFBBDDDD@DABBBBBBB@B@B@BC@CF@@@XENDDBB@NHHD@@BBDBDBBBJFPFBAD@F@J@J@JANCRI@ARGBAD@F@FAB@JE@@@@A@@A@@B@@A@@BA@@@@BA@@@@@@@AAA@@B@DB@@D@B@@C@C@CA@@A@@C@A@@A@@@@@@@E@@@A@AA@@@G@CKA@@@C@A@ABBB@@ABA@IEID@@BFA@@@AA@@A@@@@ACECEAC@A@@A@@@GACACAA@CCAAGCAACCAAA@@@CA@AAAAA@AA@@CAA@AAA@@AAAAAAAA@@AA@@CC@AA@@A@@BA@CBA@@@@AA@A@A@@AAACAAAA@A@A@A@@AA@A@@AA@AA@CCAAA@@@@A@@AA@A@@@@@A@A@A@@@CBA@@@@BA@A@@BA@A@C@A@@@A@AAAA@@AAA@@AA@A@A@E@@@A@@@AAAACAAA@@@@@B@@AB@@@@@@@@A@AB@@@AC@@A@C@A@@B@@@@A@@@@@A@@@@@@B@@@@B@AB@B@@@@@@CA@@C@AAAAA@@@A@A@@BC@ABABA@ABAA@@A@AAA@CAAAA@A@AAC@C@AAA@@@@AA@A@@@AACAAAA@AA@@@@A@@A@A@@@AAA@@@@AA@@A@@@@@A@@@A@@BA@A@ABA@@@@@@@CAAAAAA@AAAAA@IC@AA@A@A@CA@@A@EAECC@AAA@@@A@CA@@A@CACAA@@@A@C@A@CAA@CAA@AAA@@AAAA@@AAA@@@A@@AA@@A@A@C@A@@@A@@@A@A@ADEFABCDGD@AA@@ABC@G@A@@K@E@ABAB@D@BAD@@AB@@CBI@A@CAA@@A@A@A@GAC@C@@AAAAA@@@A@AB@@CBAB@@A@@@A@@@AA@@AA@A@@@ADC@A@@@A@A@AA@@@@AA@ABC@ABCBC@AB@@A@A@A@A@A@@@A@@BCBAB@@A@@@@@C@C@@@A@AAA@CAAAA@CA@AACAA@AA@A@C@C@A@C@AAA@A@AA@@AA@@@A@@@@@C@AA@CBC@@@A@C@A@E@ABAAAEcF@F"],encodeOffsets:[[118217,37641]]}},{type:"Feature",id:"130534",properties:{name:"清河县",cp:[115.667208,37.039991],childNum:1},geometry:{type:"Polygon",coordinates:["@@IBI@I@E@C@ABOEIEAACACAAA@@GCMGA@CAMCWF@@E@@DADA@A@A@AAAAAACBC@CCACEAEAA@CAIBC@M@KDCBKDCBGBCBCBC@KFIDAHCNBD@DADCBC@A@EBG@KF@@AF@@CR@D@B@BBH@BCBC@AA@@C@AEG@@@EBAGAEI@EFI@C@ADAFAACNBH@DBJ@@@DC@@D@@GBA@@BDPB@D@@@@FFDLPFL@@@BBB@@HNDFBDVRBB@J@FAFADAF@D@@FJJH@@DDLJBB@@FBHDB@HFBBXBF@BBJBFAXAB@BAJAB@JCB@H@J@B@JBHBFBDBRJPLDDDP@DCL@@BL@@@HTHTFDBD@LBPBHBF@PDNLCNAFGNLHB@VDHBLFLFFDDBHBDMDWBC@A@G@GDKBEBIBAFMB@LIFCBADCXGHCFAHCDA@@@@FAB@DCB@@@@@HCFAFCLMHGBC@@FMBEB@FM@@@ABEDEJUCAEACCCACCCCA@ECGCCA@AA@@AAAAA@A@C@AAAACACAA@AA@AAA@A@@@CA@BA@C@A@A@A@@@@@AA@@@ABABG@A@A@@@AAA@@A@A@A@A@@A@A@ABABA@@A@@A@A@AA@AACAC@CAAAA@AAAA@@A@A@E@ABA@@@A@@A@@AAAAAAAAAA@AAAAAA@ECAACC@@@AA@@ABE@@@AB@@AFAB@FADA@@BABA@AA@C@CA@@@@@C@A@@@A@A@AB@@@@A@A@@@@@@@AB@B@B@@@@@@@BB@@BBBDBB@@@@DA@@BABA@ABC@A@@@ACECIEGAEAAACAAAAAAAAEACAAA@@A@@A@@AEA@AC@@A@@@A@@BA@@@@BABA@@@@@ABAAC@A@AA@@@@@AAA@@@@@A@A@@BA@A@@B@BA@AB@@@@AB@@AA@@@@AA@AAA@@AIFA@EBE@C@ABQH@BQJMD"],encodeOffsets:[[118501,37830]]}},{type:"Feature",id:"130581",properties:{name:"南宫市",cp:[115.408747,37.359264],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BRBDAH@PAL@H@J@BBH@DE@DPEBQ@CMCM@AAACCYBA@@J@DENEJEFODF@@FLDB@@FDDD@BCB@@MXBVBPDRFBHFDDDDBDBH@DADANDLADAL@H@PBF@LAB@@@D@DA@@@@TEJC@BF@@@@CB@FFBHAH@@@F@DDBF@@ED@BCD@@KJ@@G@A@@@ABCBAIA@KOQA@ACA@@DCBA@@EC@@@@@BBABAA@AA@@ECBAA@EECCAAACA@@CA@@AAA@AAAAA@AAAA@A@AAA@AAA@@@AAAAA@AA@AAA@AAAAA@C@AAA@AAC@A@A@CAA@A@AAC@CAC@C@CBE@AAAA@A@A@C@AAC@CAAAACEC@CAE@CBC@C@C@C@CBC@CA"],["@@BF@F@B@BC@@F@DE@PR@C@@F@FCAC@CFADA@@@ADADAPABBADD@D@@CH@@D@D@DCBAAC@EH@FDDBLCBK@EH@FI@@DAB@@@@@FH@@FB@H@JAH@DB@BFDDFNADBHAF@DBRKHGDAPINBLCB@HA@@BAJAJGBARUB@BC@@HKBEFEDCFGJEFCRC@@DAD@XAJABDBBD@F@BA@CHAHBF@H@F@FIHCFAFCLEJCFGFCBCNMHKBGBC@SHKLEFAFANENAHAD@FAF@J@D@H@LHB@FD@FABBFBBDBDBHAFDJBD@AMAE@@KIAAAAEC@@EEB@BIPIDAHAFB@@D@F@@@DABCD@B@H@HCBADA@C@CBEA@@@@@CCACACCCHABAHAL@F@B@@@R@H@F@D@B@FBB@BALA@@@ABAHC@AB@BA@AB@@AAAAAE@@E@GBCBCBAD@F@BBDH@BHDD@@BF@DA@@@A@A@@@AB@BCJGL@HA@@BA@A@C@ACCBCBAJIBADCBAFELIB@DAJG@@DAB@JEJE@EHCFCDCB@@ADC@ECGFGGOGACAECKEKEGAUCA@KGHMBEDMMKOCE@GAOAKAC@CASESG@G@@AK@@DK@CCOCCOKQICAEAGAIAA@I@G@A@IDA@IBABA@WBEBIAAAE@KVAFAHENELEPEHGNGHGHCDC@C@ABABBBB@@BOLOFE@I@MAGG@MBI@CAMEGIEE@AAE@ACCCODEDCF@DADC@C@IBE@@CAAAACBA@@BAF@BC@APABA@BFH@BBFAH@JF@BOD@B@BBBDA@@@@BFEBKPCDELDHDFBBJNDH@B@H@FCHGJCDGHILCBADEJI@A@GB@DBF@DBDCHCDADABEPCDCFIPMLQHO@A@E@EDI@CAK@G@EAEAUBUDIBC@YDG@YBYB]DaFKBQ@UBI@K@E@@BU@@C@MICKBEHCFEJ@HBBDHBBBH@HBDAFANKHcJKBILALLFHBLBJDDBD@DAD@D@D@D@DAF@DBD@DFBBBB@DBD@B@D@B@BBBBBF@DAD@D@DBD@BBB@B@DBB@B@D@BBB@BBD@B@BBBBB@BBB@@BBBBB@B@@BB@BBB@B@BBBBBB@BBBBB@BB@@DB@@DBBBDBFDBC@AH@H@DA@IF@DDBD"]],encodeOffsets:[[[117958,38256]],[[118059,38294]]]}},{type:"Feature",id:"130582",properties:{name:"沙河市",cp:[114.503339,36.854929],childNum:1},geometry:{type:"Polygon",coordinates:["@@EASCQDA@CBE@GBIBGBA@CBCBADAFK@ABC@CB@D@F@@I@E@E@EC@EIAG@GBC@A@AAACC@O@@@EBA@AFA@EA@HEBA@@A@@@EAAA@KBIBIDABA@IBEB[DI@E@KB@@A@A@MCE@AA@@ICA@CDEBE@@AABCA@FEB@@A@@@A@@@G@EBCB@BAB@@@B@BA@ABC@CE@AEKAG@AE@A@GAG@C@KJEDIHGDIBKAK@AAMIGBCCAEICIAC@AAA@C@[BA@C@E@@@O@C@ADE@IC@@A@GAG@IAOAKAQ@A@I@IBC@GAE@EBQDABCA@AECA@E@EAG@@BABKFAB@@CBIAG@AD@@DBB@FF@F@D@BBDDB@BDBBBABE@EBIJ@@AFCBGFSFK@A@U@G@@@E@WDEDEFGHKFEDEFKHCJIJEFATCPCHAF@FILEFGHIJABKFABMJCBKHENADKFYHMD_JMHADGJIHMDIBO@IBQAOAC@G@JFDDBBJFDDPHFDBBRHDDDBDBNFDB@@NBDBFBH@HBVB\\@PBT@R@B@RILCRELAPARADAFCRIJMDEHITGREHAFAPBF@F@NFDDHDPCFARIFCAA@AEG@GFAFDBDDBB@@A@EL@@CDAFAF@BFL@B@FC@@BCBCDDHABBJD@@CFABCB@D@DAFABDB@@EBDHFBDBJN@DFLDBBBB@LDRBLCNANCZDLHHJHJB@LDRGFSAC@ICOGOGMEKCGA@AEBABGHGd@NBF@NDN@J@H@ZEJ@LAFANCRCJ@PAHBF@L@J@X@J@F@J@@@DCBAHAB@HBDBHADAFADAD@H@JAHAHABA@B@HBDBBB@B@BA@KBA@A@@D@B@FABDDR@@H@@BBBDAJ^BBDBFDDFBDB@D@L@B@H@FD@BBBBBD@B@@CACAC@GF@B@@@@@@AD@DBD@B@@@@BHDH@DFBB@BBAN@@ABEL@@@@BF@@BH@H@@B@BJ@BABIJ@@FFBF@DF@B@D@FB@D@J@H@H@DC@@@@FCDA@GR@@FDBL@@DF@B@L@XBJA@A@AI@@ACE@E@CEAAC@CBCD@HD@DC@@DD@LAFDF@@DB@@BF@BD@BFAJE@KF@JBB@@KK@C@I@@CI@AKCCC@GCBKBAAK@@HEDAHB@DHBRBB@@NFB@ODAJ@FGFG@IEEUM@AFAH@BKHCF@HBB@@BB@FB@ABA@EBAFATEDA@GCEA@C@A@CIA@G@CA@CI@@EEA@AQ@E@@HOA@EEEC@E@@BA@K@I@CCEGC@CACB@DE@O@@DE@CBE@@IA@E@SB@EOBCC@E@@@@K@@CBC@AD@DGAIA@E@@@FAAG@CB@D@@A@E@IAEACBE@ACEIECACACA@@@@A@A@@@EAKCECKAAAC@"],encodeOffsets:[[117269,37715]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
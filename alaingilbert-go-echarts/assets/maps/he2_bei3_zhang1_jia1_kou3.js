@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("张家口",{type:"FeatureCollection",features:[{type:"Feature",id:"130702",properties:{name:"桥东区",cp:[114.894189,40.788434],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBA@CBEDA@CA@@GFABABID@@ABA@EFCD@BA@CH@DAB@@A@CBA@@@@@@A@G@@@AA@@@C@@@CF@BCB@BA@@@AAA@A@@@A@ABCDCBEDEB@BA@@BCBA@A@@@A@AACA@AA@A@@@A@EBCB@@A@CBCDEBCD@@A@@@@@AG@C@@@@@@AB@@@@@@@@@C@@@@A@@BABCBABAFAB@@@ACG@@@@AD@@@@ABBB@B@B@BB@@@@@@@@B@@@BB@@B@@@BBBBD@B@B@@@@@@BBB@BB@BDB@@@@BB@@@B@@@B@BB@AB@BB@@BBBBBB@@@BBBBB@B@BB@@@@B@@D@@@@BBB@B@BB@@@BBD@BBD@B@B@@@@@@@BABBBBB@B@BBB@B@BBD@@@B@D@BBB@@@B@BBD@@@@@BBB@@@BBD@B@@@B@BAB@@@B@@@BAD@@@B@@@B@B@B@@BB@@@@@BBB@@@BB@B@@B@@@@BB@@BB@@@@B@@BBBBBBBBDBB@B@BB@@B@@BBB@@B@@BB@B@@@B@BBB@@@B@B@B@@@@@B@@@B@@AB@@@B@@@@BB@@@B@@A@@B@B@@@@DBB@B@B@F@DABADCBAB@FCB@BC@AB@@AHAB@D@DBB@@@DA@ABAB@@@DAD@@ABCD@B@@@B@@@DBB@BABAB@HADCB@FABABAB@D@FCB@BADARGD@D@D@H@BBFLN@DBDBFAB@@B@@B@HBDBB@B@DA@AD@@@B@BC@AFBB@BA@@DC@@DAB@B@B@AC@@B@DAB@@@BADABA@@B@DAB@B@FBFAD@DBB@BBB@B@B@@BH@BBDB@@D@J@B@J@FBB@DDB@@BD@F@B@JF@@BBBBBBB@@BBD@B@@@@BBD@BBDB@B@AD@BA@@FDB@BAD@BCB@@CB@BABAB@@C@@DC@@@@@E@@@@B@FAD@FADAFA@@@@B@D@@@@AB@BABAD@BAD@DAD@FBFA@@@@@CAA@C@AB@DCFCD@BABABA@@BAD@B@B@BBD@D@B@@BBB@@F@B@BAB@B@B@B@BBD@B@HAD@HA@@DA@@B@B@D@B@@@B@BCB@B@B@@@@@B@DBB@B@F@@@N@BAFABAD@D@D@@@@@BBB@FDB@DDB@B@B@@@@ADAD@@ABBB@DBD@B@B@@@@MBQ@IDOD@CGKCCGAAAC@E@@@ELIBADCMQ@ABCBABCDCBA@E@G@EBEBC@GBEBCAACBKEIB@@SJIDGDA@_JA@UFC@[JITGJQNI@ECE@GBGBEBCI@@CCCCAG@I@ECEGAIBKKA@GCO@GBABGAC@C@C@A@E@MA@@EI@AA@BI@ACABCFCAK@CAIAKA@A@A@A@AB@BCDADA@ABA@@@A@@@E@C@ABA@A@A@@A@@A@@AA@@CAA@@AAICCAA@CCCCA@@AA@A@A@AAA@A@@@AAA@A@CBAAA@@@AACAGA@@AAAAC@AAA@A@ABC@AA@BA@@@@@@BBB@B@B@@CDCDCB@@@B@@@BA@@BC@@BCD@@C@CBEDGDA@A@A@CAA@@@A@EDA@C@C@ABABEB@@@BEAABG@ABC@@@ABCDABA@ABABABABABC@I@"],encodeOffsets:[[117707,41676]]}},{type:"Feature",id:"130706",properties:{name:"下花园区",cp:[115.287352,40.502652],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@A@@@A@GCCBE@A@A@CAA@ACEC@@@@@CDA@AAAIC@@B@@A@@A@@@C@@B@AAD@@AAA@@BGAAB@B@@B@BBBA@@@BB@@@CD@BA@@@@@A@ABDB@@@B@@F@@B@@GA@BI@@@@B@@AAA@A@@BAB@@AA@@@A@@A@A@CB@AB@@@@AA@@B@@ABBBA@C@A@A@@B@@DB@@@B@B@BABA@B@B@ABADABAB@BAB@@BB@@@F@@AB@BE@A@A@@@A@ABA@AB@@@@@BAD@@BBBBBDBFBBBB@@@@FBHBDBD@B@BB@@@BABAB@BA@A@A@A@A@C@A@G@CAA@ABA@CACAA@A@C@A@@@GAAA@@CACEA@@CAA@CCB@@AA@@A@AA@@A@AA@@@AA@@AA@A@A@@BA@AAA@C@@B@@BBBB@B@B@B@@@B@D@@B@@B@BBB@B@@B@@@@@@B@@B@BB@@@DB@BBB@@@@B@@@B@@AB@B@BAB@@@@B@@@BB@BB@B@@B@B@@DB@BB@BBB@D@@B@@A@@@A@A@A@@@@@A@G@E@CBC@CD@@A@A@CCCAA@@ACC@AA@A@@@@@CB@@ABAB@@A@@A@@AC@@@@A@C@A@@@A@@@C@@@A@A@A@@BA@@B@@ABE@CBC@CBA@@@AB@BCF@BBF@@BB@@BDPGD@DAF@DABBBB@F@BFFB@@@B@DB@@DBBBD@D@@@@B@@@@BDABA@@B@@BBBBDB@BB@FB@BB@@B@@@B@B@B@B@@BB@@@BA@@@I@A@@@B@BB@@@B@@@@@@@B@@B@BBBB@BB@F@D@DB@@FAB@@BD@@BD@@@B@@B@@@D@@@@B@B@DABBD@@@DBD@B@@@BDBBB@BB@B@@BD@@B@BAB@BB@@@@@DB@B@BBBFBD@BB@@A@BBBADDBBB@@B@@@@B@@C@@@@BAB@@@@A@@BA@@@@BB@BBB@@@@@@B@BBBBBB@BBBDBB@@B@@BD@DDB@@@D@DAJAB@@B@@@B@BBB@DBB@@D@B@DA@@@@D@@@BB@@@A@@D@@@B@@@@D@@B@@@BAB@D@B@D@@@B@@BB@@@@@DA@@B@B@B@B@@@@@@BB@@@@@B@@@B@@@@B@@BB@@B@FDFBBB@@B@@BAB@B@@BB@@DBDB@@@@@B@B@BBBDDDBBB@@B@BBB@BB@@BB@@@BB@B@B@B@B@D@@AB@@BD@@B@B@B@B@@@@B@@BBAD@B@B@@@@BB@@@B@B@B@B@@BHAD@B@BB@@B@@BBB@@FBDBB@@@D@B@DBB@@@B@BB@A@@B@B@D@BAD@@A@A@@FC@@BAB@@@@AB@B@F@B@D@B@@@B@@ADA@ABA@ABADC@A@ABA@AB@BADADCB@BC@@B@DB@BB@HAB@BAF@@@DAD@B@F@B@FBB@B@BA@@BA@A@AB@@AB@@@D@B@@@B@@AB@BAB@FB@B@@B@DBB@B@B@@@BBB@HDDBB@@@BAAAAAAACAA@@AB@D@@ADEBA@C@@B@B@@@B@@@B@@@AC@CAA@ABA@C@@B@@@@@B@@@@@BA@A@@BA@ABC@A@@BCB@@C@AAA@A@AB@FABA@@@AB@FBD@BB@@BDDB@@B@D@B@@BB@BB@BBBBBBB@BBBBBB@BABAB@BB@@@@B@D@BA@@BABABABA@A@A@@BABABAB@F@@@DABABC@AB@@AB@B@H@BACAAA@AAC@AA@@@CA@@@@AC@@CCCACCA@CA@@CAAAAA@@@A@A@A@C@AA@@@CAAAACCAAAAAAAAAAAA@EAA@AA@AICA@A@AACBC@@@CECACC@@A@A@A@@@GACACAA@CCA@AAA@ACAA@@AC@@BG@A@C@AA@@AA@A@A@A@E@ABA@EAA@CA@@A@A@A@A@A@ABCB@@E@A@ABA@@@EBC@A@@@@@AB@DAB@@A@ABC@C@C@A@A@C@ABC@A@A@AAC@@@A@ABABCBAB@@A@C@A@A@A@@@GFGHCCAAA@@AAC@@@C@@BA@@@A@AAAAAAAEGIC@AA@@CAA@ABABC@@@A@A@@@AAA@A@A@AC@A@A@CAC@@@@AACA@@CACAAAAACAAA@CAG@C@A@AAA@A@G@AAAAA@AC@@AC@@AA@@A@@BABAB@DAF@BA@AC@@@@A@AA@@AC@A@ABA@C@ABABABA@CBABA@@BA@AAASACA@@@B@@@@C@A@@@@@@BC@A@@D@@CA@@@@"],encodeOffsets:[[118038,41424]]}},{type:"Feature",id:"130703",properties:{name:"桥西区",cp:[114.869657,40.819581],childNum:1},geometry:{type:"Polygon",coordinates:["@@AACBAB@BEDEDABKFA@MFIBGBIFLDB@@HC@B@BFBB@@ABC@@BB@@@BB@@FAHADA@@@BBBBD@BE@A@@B@BBBBBBBEBJH@@@@CD@@@LINA@EBHD@BHBBADJB@BABD@@@@@@BB@B@@L@@B@@@B@@@@@@@B@@JBH@ABAB@BB@@BHBB@CHABABCFAB@@ADBBCFAB@B@@D@@@@@@BB@@BB@@@DAB@B@@A@@@@BB@@@ABB@@@@@B@@FB@BBBDD@B@B@B@B@B@B@B@B@B@@BBB@DD@@BB@@@F@B@BAB@DAB@B@BA@AB@@@DAD@BAB@B@B@B@B@@@BCBABAD@BAB@@AB@@@FAB@BABAB@B@@CBABC@CBA@@@AB@BAB@@@B@B@@ABAB@@@B@@@B@@ABCDAB@@A@A@CDA@AD@B@BB@B@@BB@@BBBD@BBDBH@A@@BABCDAB@@@BABA@A@BB@@@A@@B@@@@BA@@@@@A@@@@@AB@FA@@@A@@@@@BB@@@@@BEFA@CB@@AB@@B@@B@@@@C@ABABCBAB@@@BABABABA@@B@@CB@@AB@B@D@BB@BBB@@B@B@@@BBBB@@@@B@@@@BA@@B@@@DBB@AB@B@@BB@BAB@@@BBB@BAB@@B@DB@@@@@D@B@BA@AB@@@B@B@B@BBD@BADAD@D@B@B@BDB@B@D@B@D@BABEAA@AAA@A@@@ABA@@@B@@B@D@BBB@B@@@F@@@B@@BBB@F@B@B@@B@@B@@@B@@B@BBB@@@BBBDD@@B@@@@DB@BD@B@B@@B@F@BCB@BABA@A@AA@@A@AAA@CBC@@BA@@FGBA@@BA@ABA@CBC@@DC@@@AB@BC@ADA@CBA@@BA@ABADAB@BA@@BAD@@A@A@@@A@@BC@CB@BAD@B@@BB@@@B@BA@@D@FC@@BB@@B@BA@@@@B@B@B@B@B@D@@@B@B@BAD@DAD@DAB@@ABAD@@AB@@A@C@@BA@@@@AC@@@@@AB@DABABA@ADC@@@@@A@AA@@A@A@A@@@AA@@AC@@A@ABABA@AFA@@DAB@D@@@B@@@BCDC@AB@FEB@@B@BB@BABAB@@A@@@A@A@A@@BA@@B@@A@@AA@A@A@AB@BAD@@@B@@@BBB@BAFAHCD@BA@A@@DADAJAFABAB@DB@@@@AAAA@C@C@AACEE@@@AAC@@@A@ADCA@@A@@@@@C@@A@AB@BCB@@A@CAC@A@GB@BA@@BADA@EDA@ABCDABCBE@A@A@A@CA@@@@@A@AB@@@@A@@AA@@@@@A@@BA@@@A@@@A@@@@@A@A@A@@AA@A@A@@@AAA@@@AA@AA@@@AA@@A@AAAACAAAAAA@AA@@@@@AA@@AA@@@@@AA@A@@A@@AA@A@@@@AA@@@A@A@A@@@A@@BC@A@@@A@@BA@A@A@@@AAC@A@@AA@A@@@@AC@A@A@@AA@A@C@A@@AC@A@AAA@A@AAAAABA@A@@@@@@@A@AAC@AAC@A@@AAA@A@AA@@@@@CA@@@@@AAA@A@AAAA@@A@AAAA@AA@@ABAA@@A@A@@@A@@AA@@@@CA@AAAA@AA@@@@@@@A@AACAA@A@@@AA@@A@@@A@@@@@@A@@A@A@AAABA@@@@BC@@@@DH@B@@BABEBADABA@AB@@@@@@D@@@@@@@@BA@@@@@@@DBH@@@@B@@@DCFADCDAB@@@DAFAB@@@B@B@@BDBBBB@@@B@B@DA@AB@@AFAFCDADCBAB@@@B@B@BB@@B@@ADA@ADE@@D@@@B@@B@@@H@B@@@@B@DAB@@@BA@CDGB@@ADCFEB@BA@@JCBABAHE@@DBB@FCDAB@DAJ@D@BABABABABAB@BADCBA@@D@BAH@BAB@@A@@@AAA@A@AA@C@@AA@AE@AAA@@@CAA@A@AA@@@@@C@K@A@ABC@A@E@GBC@C@CAA@EC@@CACACA@@@AA@@A@@B@NEHCAAAA@@BA@ADG@A@A@@BAB@@AAACBAB@@A@@@CBA@@AAA@@@AAC@@A@@ABAA@cJIDOB_FYHMD@@ABMBOD@@ABABABCBA@ABFAD@J@DB@@DB@@FB@@KDEBABAABA@ACBA@OBBDD@D@ABB@@BGBEB@@AEGBCD@BAB@BEB"],encodeOffsets:[[117584,41662]]}},{type:"Feature",id:"130705",properties:{name:"宣化区",cp:[115.099494,40.608793],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAA@AAAAAAA@AAAA@@AA@C@A@@@CAAC@@AAC@EAA@@B@@ABEBA@@B@BBB@B@DA@AD@@@BAD@BAB@@@BAB@@@@A@@@@@A@@@@DAB@BBB@DBD@@A@@@A@@@A@A@@@@DABCF@BC@A@@BB@DBBBBBBBAB@@A@CAGCA@AA@@A@A@A@CAA@@@@AEAA@ABA@@BA@@@A@C@@@A@@BA@@B@BAB@@ABA@A@EAA@E@A@C@CB@@E@ABA@GBA@@ACAA@@@ADA@CDCBABA@@BAB@B@BCDAB@BAB@BCB@BA@@@A@C@A@E@A@A@@B@@A@AB@@ED@@@B@BC@ABC@A@A@@@@BAAA@@@A@CAA@C@@@A@CAEA@@AA@AA@@@AAA@C@GB@AA@A@A@A@@@A@@A@@A@A@C@AB@AA@@@@@@A@A@A@AC@@AA@@BC@A@A@A@A@A@@A@@AA@@AAA@AAA@@@AACACCAA@A@A@A@@@@CACA@@AA@@@ABA@AA@@@AAEAECA@@@AA@@@A@@A@@@A@@@@@A@@A@@@@A@A@A@A@@@CB@@@@A@@AA@@@C@A@C@A@AB@@A@@@@C@@A@@@C@@@@B@@AA@@C@@@@@CBA@C@@@AA@CAA@A@A@@@AA@IBCBC@@@A@CCC@@AA@@@AAACAAA@AAAA@A@A@@@@A@AAA@@A@@B@@AB@@@@@BA@A@@D@@@@A@@A@@@AACABCAA@A@BA@@AACAEAAA@A@@C@@@@AAA@ABA@@@AC@@@AAAA@AAAC@@A@C@CA@@C@AACBA@A@@@@@@C@@@AA@@@C@@AC@@AA@EB@@CAC@E@A@@AAAAAA@@@@A@@@@@@@A@@AAA@@@B@J@@@B@@A@@AA@@@A@A@A@A@@@AA@@AEAA@@ACAAAAA@@@AB@BAAC@@@@@A@@C@C@AACA@@CAA@@@A@EE@A@EAAAACBE@CBC@OHAC@@AA@@AE@ADE@ABA@@B@DAD@DAF@BA@@@AB@@AB@B@B@@@D@@@B@@@B@D@B@@@@@BD@@@BB@@@BABA@@DA@@@@B@B@@BDD@BB@DBDDB@B@@@DCD@DAF@H@B@@@@@B@B@B@@@B@@@@AC@A@AAA@@ACA@@@A@AA@A@@AAA@@A@@@@@BA@A@ABA@@@A@@@A@@A@AAA@@C@@AAA@@@@A@@@@A@@@@AAA@A@AA@@@@C@A@@@A@A@AAAAA@@AAC@AA@@AAAACAC@@A@@@A@@C@AA@@BADA@@@C@A@@@C@ACA@@@A@E@@AA@@C@C@@@A@A@AB@@AB@@@@A@@AA@@A@@A@@AA@@A@@@@G@@@AB@@A@AACAC@AAEC@@@@@@@A@A@@@@@ABC@AAA@@BCBA@A@@BA@@AC@@@C@@@C@AB@@AA@@@AAE@AAC@@@@A@A@@A@AAA@AAE@A@AAAB@@@BA@AB@@C@A@@@@AA@@AAA@AB@BC@@@@@A@@@A@@B@BABADCB@BC@@@AACAA@AAC@C@@AAAA@CAAAA@@BC@@A@@AAA@A@@AA@AAA@AAACC@A@@@A@@CEAAAAGCAAA@ABAF@D@B@@BB@@@@ABA@ABA@AAA@@@A@@B@@@@DB@@AB@@@@@D@@A@A@A@@@C@C@GF@@AB@B@BC@CDADAB@@A@ABC@@@AAACAAA@@A@@@CB@@C@@@AAAEEA@CAA@AAAAC@AAA@A@CAA@A@AA@@C@A@@A@@@A@@@A@A@@A@@CAA@A@C@@B@B@@A@@@A@A@@@AA@@ABA@ADC@@@@AA@A@A@@AA@AA@@BA@ABA@@BAA@@A@@AA@C@A@@ABAB@@A@@A@AAA@@@AA@@A@AAA@A@@BA@A@CC@@A@ABC@A@C@CBCB@@AB@@A@@@A@@@A@ABA@@BA@ABA@@@@@AC@A@AAA@@A@A@@@A@ABE@IB@@A@A@@@@BA@A@A@A@ABA@E@ABGA@@A@A@C@E@A@EACA@@@A@@@@@A@@B@D@BA@@@@@AA@@CAA@@@A@@@@CAA@A@CBA@@@CA@@@@@AAA@@AA@AAA@A@@AA@@@A@@@@@A@@@A@A@C@E@A@@@@BA@@@@@@@@@A@@B@@A@@@ACA@A@@EEA@@@BAB@D@D@@@BABAB@@@AA@A@C@A@C@@@@DAB@D@DBB@BBB@BD@@B@D@D@D@D@BBB@@@BBB@@@@@BADA@@BA@@B@@AB@@@A@@A@@@AAAAA@@BA@@@@BBF@D@@@AC@A@@@AAA@A@AAA@@@A@@@@@@@AA@AAAAA@A@@@C@A@C@@@BA@AB@@A@AAC@AA@C@AAC@A@@@@@@CBA@C@A@@A@@AA@@A@A@@@@A@@AA@@@A@@@AA@@@@AA@@@AAACAC@@A@@BAB@BAB@DCB@BCB@@@A@@@ICCAA@A@@@A@@A@@@@C@AAA@AAA@CACAA@A@A@@@@CA@A@@@ACA@AA@AA@A@A@A@C@@@A@G@AA@@B@B@@@DAB@BA@@CA@@@@@ABA@@BA@A@A@@@@BAB@BC@ABABA@CB@DC@A@A@AFGB@@AD@B@@@@CB@@@DAB@@@BA@C@@D@@@@A@@BA@A@@B@@AB@B@BC@@@AB@BABABAB@BABADE@A@A@A@@CA@@CC@A@CAC@@A@@B@@A@@A@ABA@AB@BA@ABGBA@@BA@@@@BABA@@@A@AB@@A@AB@@CBCB@BAB@@AE@@@AA@@A@@@ED@BAB@B@@@@A@A@@@CA@@AA@@@C@@@@@AAA@AA@CBAA@@A@ABA@ABA@A@A@A@@@CCACC@AAA@A@@@CBA@@@A@@@@@A@@@@B@@A@A@C@@@@A@@A@@@AA@AAA@AA@@AAAAAA@@A@@A@@@AAA@A@A@ABA@A@A@@@A@A@@@AAA@A@ABA@A@@@ABCB@@@@@@D@@B@@@BB@@@B@BD@@BD@BBBB@BB@@@B@@BB@@@BA@@@ABABA@A@AB@@A@ABA@@BA@@@@@AB@BA@ABA@@@@@ABA@A@@@A@@@A@C@@@A@@@ABABA@@@AB@@ABC@@@AA@A@@@@CBC@CB@@C@A@@AAC@A@@@A@@@@AACAA@A@CAAACACA@@AAAA@@MCC@AAAA@@@AAAA@@C@@AAAAAA@@@@A@A@AAA@A@@@A@A@ABA@AA@@A@A@AB@@C@@AA@@@AA@AB@BC@A@@@@AA@@C@@BCAA@AA@@@@A@C@AA@@GAA@@@A@@B@B@@A@AB@@@@@B@B@@@B@@@@C@@@ABC@@@@@A@ABA@@BABA@A@@BEB@@@BB@@@D@BB@@DBBBD@BB@B@@AB@@B@@B@@@B@B@@@BABB@@BB@@@@B@B@B@@BB@B@B@@@BA@@@@BBB@B@@@B@BAF@B@@@B@BAB@BC@AB@@AB@@BB@B@@AB@@ADA@A@AB@@@B@@@B@@A@@D@BB@@B@B@B@B@@A@@@@B@@A@A@@@A@AB@B@@AB@@@B@@@B@B@@@F@D@@@B@B@B@@@@@B@@@B@@@@@B@@@B@@@@@@BB@@@BA@AB@@@BAB@@A@@@AJBBD@DABBHH@@B@AD@B@@AB@BABCDABCDAF@@AB@BA@@@DBBBBB@@B@@BBB@B@B@BA@BD@BA@A@A@@@A@@BA@CBA@A@A@@@A@C@AD@@AB@BBB@B@BB@@@@B@@AD@@@@@@B@BBB@@@@BABAB@B@@@BA@C@@@AB@@AD@@A@@@AB@B@@@@@B@@@@@D@@BD@@ABAD@B@@ABB@@@B@@B@@AB@B@BA@@BB@@BA@@@@B@@@@@D@@BBBB@D@DBF@@B@@B@@@DAB@DABAF@B@@@BA@@B@@BB@@CBCDA@@B@@ADCDA@CDABC@ABA@A@A@@B@@A@CDAD@BA@ABCBA@@B@B@@A@@@A@CA@@C@A@@AABC@A@@B@BCBCBA@@@AB@BCB@@ABAB@@A@@@@@AAA@@BA@A@AA@B@@@@C@A@@BCBAB@@CCA@@@A@A@A@A@A@CB@B@@A@A@@ACBA@@@A@@A@@@BA@@B@B@@BB@AB@@@B@BB@A@@@@B@BBBB@@FBBB@@A@@@@BBBBBDFB@@@@BAB@@CBA@CF@B@B@@@BB@B@@@@BB@@D@@BBBBBD@D@BAB@@CBABADEBCDCB@BAB@@BD@B@BA@CBADAB@BBB@@@@@BAB@FB@B@@@BBB@B@F@@@B@HB@@@B@B@B@@ABAD@B@@@@@@F@B@DBD@B@F@B@DAB@@@B@@B@@ABA@ABA@AB@B@@@BBBBB@@@@DDLBD@B@F@B@@@B@@BAB@@B@B@@BDBBB@B@@ADAB@B@B@@B@@@@@BB@B@B@BAF@BDFBB@BDBB@@BB@B@F@BAB@B@@@@A@@B@BBDD@@@B@@@BA@A@@B@B@D@D@@@B@B@@@B@@@B@@@@A@@@ABADA@@@ABA@@@AD@B@BA@AB@@@BBB@D@BB@D@D@B@DCB@B@BBB@D@@@B@BBB@B@B@F@B@DBB@@@B@DC@@DB@@B@BAB@BBB@F@F@BDHAFDF@BBFDB@DDFJFBBBDBA@@B@@@@A@@B@@AB@@A@BBA@@B@@A@@@@BA@A@@@A@@@A@@B@@A@@@A@A@@B@@A@@@@@A@@B@BA@CB@@ABA@@BCBA@ABAB@@A@A@A@@@CA@AC@GBABAB@B@@@BBB@@AB@D@BBD@B@@@BAB@B@BB@BB@@@B@@B@BB@BD@@BAB@BABB@AB@B@DAH@B@BBB@BAB@B@@@BB@@@@BB@@B@DB@@@@B@B@BDBBB@BBDBD@B@BAD@B@B@B@@@BBB@@@B@B@BAD@BB@@@ABAFA@A@@@@B@B@@@@B@B@B@@@@B@BBD@@BBBB@D@B@B@BA@A@@BB@@@CBEFAD@B@BAD@@@B@@AB@@ABADABABAB@DABA@A@ABCDCB@B@@@B@@@@B@B@CDAB@BAB@@@@@BB@D@D@@@@BBBBBA@@BBBB@B@@@@B@@@B@@B@@@@@@@AB@D@@B@@B@@@B@@A@@B@@@@BB@@B@B@@@@B@@F@BBB@@@@B@@@@B@BBBB@@B@@@@B@@@B@B@B@@B@D@B@DBDB@@@BABCBEBE@A@C@A@ABA@ABCBA@A@@@AD@@A@@@A@@AA@@DABCF@BC@ABC@@@AA@B@BAB@D@@@BB@BBDBJDDDDBMFFF@@FFBDDAFCHAJEHAJANEB@LEBAFCFC@ABADABBFA@ABA@ADCHABF@@FAHA@AA@BAC@C@ACPAB@DA@BABBBBAFALC@@EA@@CA@@CAI@C@EBBAB@DABABABA@@PCNABA@@NCZG`EPAJCdIB@AB@BB@@@BD@B@@BB@BB@DA@@B@@@BADABB@BA@AB@@@B@BCH@BAB@@BBBBGDMFA@@@@BB@@B@@DBDBDB@@FDB@DBD@D@HAF@B@D@BAB@L@D@@@@@B@@B@BBB@D@@BB@BBFB@@BD@B@@B@BBB@B@@@BA@FB@A@@FABABAD@D@B@FCB@@@B@DBB@B@B@HCFCDAD@@@DC@AD@@AB@@A@@@A@@DADCDC@@@A@AAA@A@@@@B@@ABBD@BAB@B@BBD@BBBB@@HBDBBB@@B@BBDAB@B@BB@@B@B@BBB@B@B@@BB@DDDDB@DBJDBB@@BB@DB@@BB@@@@BB@B@B@BAD@F@@@B@@@B@BAB@BCDC@ABAB@B@B@B@BLBJ@DBLEDADDB@BAJB@@BFJ@@NBF@B@D@D@D@HBBAHAP@HDB@LLJAHBDF@F@JBHDDDD@@DJFAHAHAF@FDJ@RMHIJS\\ID@VEB@`IB@HCJCTI@@JALFDABBADAF@HADAF@F@H@FABCDADABAD@BNRCDABKJ@F@@@FBDBBDHLDDHC@CP@JAR@N@@DBD@DB@@BDD@@BB@HHBBBBBBBBB@@@B@@@BABAB@F@@AB@BABA@@B@DABAHBB@D@B@BA@@B@DAD@B@BABAD@BA@@@ABC@@@@DAB@F@B@DA@@D@BAD@BAB@B@FBF@B@@@@@@B@@@BF@BB@@B@BA@@DA@@D@@@DABAD@B@@@B@BB@@B@DCDCBAF@@AB@@A@A@@@@B@B@@@@ABABADCBAB@HA@@DAB@D@F@B@NB@@@@@FB@BBF@B@B@B@DD@BB@@@F@@@D@@@BBDBDBD@D@DBD@DBBBBBBBB@B@BBB@D@B@B@B@DAJADBD@B@H@DBB@B@B@@@BADEBCB@DA@A@@@AAAACAE@ACEAA@@AC@ABAAA@@A@GC@@AC@AAA@@@A@@@C@E@AB@@AB@D@BABADADADAB@@A@CB@@ADCB@DAFC@@D@B@D@@AB@DA@@D@@ADABAD@H@@@BABADAB@BAB@BA@ADA@A@@@ABC@A@EAA@@@ACEA@@@BC@AAA@A@CA@@@@A@A@@@AAAA@@A@@BA@A@@@A@AAABABABAB@@@B@BA@AF@BA@@@@BA@@@AA@AAAA@@@A@A@ABA@A@@@AAADAB@B@DA@@F@B@@@B@B@DA@@BABADADCFC@@@CB@@@@AB@B@BBB@@@B@@@@A@A@@@AA@@@AA@@@A@A@A@@@AB@B@B@@@@AA@AACA@@@@@AA@AAAA@@@@DA@A@A@@BABA@@@CBA@A@A@@@@AAE@A@A@AAEAAA@@@@@A@ADG@@@AAACAKG@@A@@@AC@CAC@A@A@@AAAAA@@AACAC@A@@BC@@@@@AAAAAACAC@@AAC@@@@ABADABAFCJCB@B@@ABABA@ABA@@BC@A@A@A@C@ACC@A@@BABABAB@B@@CB@AAACAC@A@A@@BA@@AAACAAAA@A@@@@@ACA@@@@A@AA@@C@GBE@ABG@A@A@@BA@@BADABCB@@E@A@ABABAB@@@B@BABABABAB@@ABC@A@@@@@AAA@ABABA@"],encodeOffsets:[[118237,41556]]}},{type:"Feature",id:"130708",properties:{name:"万全区",cp:[114.740557,40.766965],childNum:1},geometry:{type:"Polygon",coordinates:["@@IDCBEBQFc@OHBT@HDAFDXNB@BBJHFHBH@F@FBHBNDP@HDHBJAJAHAFEHEJIREJADAPARBJ@@BF@FDH@DFDFFJFLDHHHFJFDF@@@@DBHFHHFFFJBFFLFJDJBLDFFHFFFHFDLJHDBBPDNBJBJBB@HDHBHBHDJHHFHDLLDDBDBDLFFDFDDBFDJDPBB@FBH@@@NAN@N@V@JBRDLBLDLBNDPD@@PFJDNFLDFBJDF@LDL@JAdANAJAHARATCVBH@HAJAHCPG@@TIHCHENGFGBGBEFEDEBI@A@AACA@@C@@A@@@CCAA@A@@AA@A@AA@@@A@@@@AA@A@E@A@AA@@@A@@@E@@@AAA@A@C@AA@@@B@BA@@B@B@BBB@FBBA@A@C@A@C@ACA@A@A@A@CBCBC@AAC@A@A@A@A@@BAB@@A@A@C@@@@CAA@@@BA@AAA@A@@BA@AAA@@@ABAA@CA@@A@@@AB@@@@@A@@A@AA@A@@@A@AA@AAA@@A@C@ABA@@DA@@@AB@BABABA@A@@BADABABAD@@@@@@AA@@@BA@@DAB@FE@A@@@@AA@@@@B@@@B@@EBA@@@@B@@@@@B@@A@@A@@@@B@@AAB@B@BA@A@@BADCBA@AB@G@CAAAC@AA@AA@@AA@A@@A@ABCB@DCB@B@@@BADCBA@@@A@@@A@@BABA@@@A@A@@BA@ABA@@B@DAD@BADA@@@ABABA@ABA@E@@BA@@BA@ABCBADA@A@@@A@A@A@ABA@ABC@C@@BAB@@A@ABA@CBA@A@A@E@@AA@@CCA@AA@@@A@A@A@A@A@A@A@A@ACCAA@AEA@@@A@@@@AA@B@@AA@@@@@BA@A@CB@@A@@AA@@A@@@@C@@@@ABADEAABC@@BADEBABADGA@GA@AA@@ABABAG@IA@@@A@@@@@@@A@@@AK@@@@AAA@@@@@@ACABA@CIABGA@AGCFAB@JM@K@@DC@@@@IGFAAAAAAA@A@AB@F@@AACAA@A@@CBGBEB@@AA@@A@@AD@BA@@AAAEA@D@@GA@KCGBEDCBGFGFCBEDEDA@GHQDIDQ@C@C@_IK@[BMGGCCGGIYQcIaA_CCAGA@@UAMEG@G@EAA@@@GA]GA@C@M@EASAS@G@GBIB]FK@A@M@QH[NABA@KF_HYHA@OBG@GBI@UDGB"],encodeOffsets:[[117144,41686]]}},{type:"Feature",id:"130709",properties:{name:"崇礼区",cp:[115.282668,40.974675],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABABA@@B@@C@A@@@@@EBCBEBC@EBA@@@@@@F@@@@CD@@@DA@ABABA@@DA@ADC@ABA@EC@@ABC@@B@ACAAAC@AA@@@@@AAC@AA@AAAAAA@@IEA@E@C@@AA@CCA@EAI@A@I@C@@@CAAAG@@AA@A@A@AAA@CAC@EBEAA@A@CBA@@@ABCBAB@@A@CBA@@@BDA@A@A@CB@@CD@@ABA@EA@BADA@@@C@@BCBA@A@CAGAA@@@@AA@EBCACAM@EKAAG@C@C@C@QHCBABA@EDC@A@ABABEBA@CDGBA@ABABA@CA@@A@@@A@C@AD@BC@CB@D@@@@@BB@CD@B@B@@BD@B@@FFBD@B@D@DBBBB@@@@CAA@ABEBIBCBCB@@@BABC@GDEBABA@AA@@A@@@C@ABA@@B@B@BBB@@@BA@@@AB@@@B@B@B@@@BA@ABABA@@A@AA@EFA@@BCDAD@@A@@@C@A@CB@@EB@BABAB@B@BD@@BB@@B@@@B@B@BB@@B@B@@@@CD@BABABCBA@@B@@@@BD@@@@AB@@@D@BA@@BC@AB@BA@CBC@CBC@ABA@A@@@C@A@A@A@A@A@@@@@ABA@@@AA@@EDC@@@ABA@@@A@@AA@C@ABA@@DAD@@@B@@@B@BC@AB@@ABA@CBAB@BAB@@AB@DCB@BADA@@B@@CD@@AD@DAB@BAB@@ABEH@@AB@@AD@DBB@B@BB@@B@BABAB@@BBBADC@@@@B@@D@@@BBB@@@BBBB@DDB@BBFA@B@@B@DBFB@@B@DC@ABA@@DD@@BB@BB@B@B@@@@B@B@D@@@B@BAB@@@B@@A@A@CBA@CBE@@BABCDA@A@@BE@A@C@C@A@CBA@@BCBC@ABEFCBABA@AB@B@BAB@BAB@B@BAD@@@BAB@@BB@@@DAD@D@F@DABAFAD@@@BDFB@B@BBBBDB@BBBDBBBD@DBB@@B@@@BBB@@B@B@BAB@@@B@@DBB@BD@L@B@DBD@BAB@@@BABAD@FAFAD@B@B@B@DDDBBBB@B@HBHBBBF@D@B@F@F@F@F@@A@@@A@@BAB@@@B@PA@AB@BAB@@@@@FBBBB@B@BAB@@@BAB@@@@H@D@@@BB@@BD@FBB@B@BABHB@BBD@B@@@@@@@BADCBAFCDABAFAB@BAFABA@AB@B@B@B@@@BAB@@@B@B@B@@@@B@@AB@B@@@BLF@D@@AB@BFBBB@B@@@@@B@B@@@@DDB@B@@@B@@@DDBBBB@@@@ABAB@@@B@BB@BBB@DBH@BB@BAB@@@D@B@DBH@@BF@@@B@B@DBF@H@F@DBBBD@BBBDBBBFD@@FFHFFD@@DDDFBD@BBDBBBJBHDBB@BBFBHDD@VHLFHHZdHLHLFHLJLJAFEJKDKBMASEK@IFCJNRJJPNNLLJHJFJHFLFLAHEJAPCJ@RDJDLHHJJJHBJ@HCHCJIFEBAJMB@LGLILCD@H@JBPFPDNDPHLFTFPARCPCN@PBB@HANAF@JBPBPFFFHFJBF@LAJEFEFI@O@GHCHCLBNBLHNLJDJBNAR@PCJB@@NBJFFBXDJFHD@HDHHHHJFDDADAB@@@B@BBB@BB@B@D@@@BBBD@BBBB@@B@ABC@@@A@@B@@ABBDAB@@B@@DB@@BBBBB@@B@@@B@B@FB@@B@@@D@B@FAD@DAD@DADD@@BBB@@@@B@@@@BD@@@@B@@AB@BABADADA@@B@@ABADAB@@CB@BAB@D@DB@@B@@ADA@@BABAB@BBB@BBBBB@B@@@B@@B@B@@@@B@D@D@D@DBB@F@B@BBD@BBBB@@B@BAB@@A@A@A@A@@@A@@A@A@AAAAA@@A@C@A@C@A@@DCBA@@@A@@DABABA@AFCBA@AHAB@B@@AB@@AD@DBB@B@BAB@@AA@@A@@@E@@@AA@@AA@A@@AE@IAA@@AC@IASACACAK@EAE@CAC@CAC@E@AAAAAAA@A@CA@AAA@AAA@@CAGEEGEECGACAE@GBEFEDGDCDEJOBCHMBEBE@K@EBGFCDAHEBEDG@E@EAGEEEIIOGOCIEEEGCGCIEG@EAC@OFCBAJGBABABG@CAG@CACIOEEB@CE@CAGACACAEAEBG@GAG@I@EAEAECCAC@ECG@CAGCEAC@EBQBEBEB@B@B@BBB@BBBBDBD@B@BBB@B@BA@@HEDCBAB@B@@@BA@@BAB@F@@@B@@ABA@A@@AA@@@AAC@A@@@@F@B@B@@A@@BABA@@B@AAA@A@AA@AA@@@A@@@AA@AAA@C@@A@@AC@@@BC@ABADC@@DGBC@A@@@A@@CA@@AA@EAA@@@A@CAA@ABA@@@A@A@AA@@A@@@A@@AAA@C@@AAA@@@AA@A@@@ACCAECECKIGEICCACAIEGEKEIEGIAACC@AEKCEAGAC@KAW@ACGCGCE@GCI@C@GEO@E@GCBA@A@A@C@A@AAA@A@AAAAAACAC@CAC@C@CACAAA@@C@@@E@@@A@@ACCA@A@A@E@AAA@@E@@@@MAA@E@C@A@CB@@GBA@ABCDABAB@B@@A@A@@@@@@B@BA@@BE@ABCDCDA@@@AAA@@@A@C@ABCB@@C@@@CB@@ABA@@@AAE@@A@@@A@@@@A@E@EAA@A@ABC@ABC@@@CBA@E@A@CB@@@@AD@B@@ABC@ABABA@C@CBA@@@ABA@C@A@GAABCBA@@@ABABA@@BE@A@ABAB@@A@@@A@AAAAAAAAGGA@@AC@AC@@CAC@CA@@@@A@A@C@CAA@AA@BC@CB@B@@A@A@A@CCA@ECA@AA@@@@C@C@C@ABEBABM@@@E@A@A@CAA@@@@@A@A@A@ADA@@@A@C@A@A@@@CB@@GBC@GBA@C@AAA@A@A@A@ABA@E@@@AA@AA@C@C@AAA@A@C@AB@@ABABABC@EDCDA@@B@DBB@D@@@@EBEAC@CBC@ABC@"],encodeOffsets:[[117905,41793]]}},{type:"Feature",id:"130722",properties:{name:"张北县",cp:[114.720077,41.158596],childNum:1},geometry:{type:"Polygon",coordinates:["@@M@MB@@G@EAA@OAICECCAECECKEACACCCKKGCGEIGGCGAGAGCA@IAIAMAOCELADGHKJIJKLEDMJGLGJKHCBABKBMBOBQFMHKLEJAJAHAHCNBH@LBPDLBR@PAH@@BD@@@BA@@@C@A@A@A@CBABAB@@@@HDBBFBBBB@BCB@B@BAAAFAB@B@BCBCB@@AFFA@@B@@@BB@@DA@BBEDDDB@@@A@@BD@ABAD@BAA@@AAEBBDADDAD@@@@B@@@BE@ABKF@@ABAB@B@@@B@BA@CF@DAB@F@B@B@BB@@@BABCBA@@@BBBDBBD@@HB@BBB@@@BB@BBB@JDEFCDBB@BDBBBBB@BCBABABA@ABGHKJQJSLQHOFMLQNCFKJABKVGTEPADCJCFCNCFAF@PBLRPTNPLLHDHDFFP@PELCJGLOLWPa^EHACC@ABIB@BA@@BABADCDADCHAJAH@BA@UN@@C@E@GBGDAB@@@D@BAF@@EFEBDDHFB@BB@@@B@B@B@B@BDHGDA@JHEBGDBDFABDEDBBA@BBGDB@D@BBGBCBODFHTRIFMHEBQJECII@@IFIFBBBB@@@BCDCB@BFDBBJGDBB@DDDDBBFAHFGHAD@@A@HFKHCDC@@BAB@D@BDDBBB@HFABFBD@FJFFBBHH@@D@D@B@D@FBB@F@BBF@FADANADAH@B@DBBB@B@J@@AJ@B@H@@BBBBB@B@DA@@CC@ABAB@BBD@FDB@F@D@DBB@@BBDABBB@BD@FAF@FBF@FCF@FBDBB@B@AABAD@@@F@DB@@DABB@BABE@@BBBD@BAB@LH@@DAFCD@BB@FFD@BAD@BFDBB@DBBFBJBFD@FDLF@DBB@DDBBDBDBBBBBFBDBJBLGHELI@@HED@@@@@FCLE@@JKTSRQB@NAHCBADADEDGJKFGFMDEJGHEXGFCRGHGBGHGFGDGFEDK@I@CDGDEFAFCLGB@PEJEJAJ@DD@JBFDDDFPBN@N@PAR@LBPBNDLD\\FPFLDNFPDJBPBTFVB`HjDVDTBRBRBRBZBPBL@FBD@PJHDBBPJFDHHVRFFHJBDZEPAHAFCFGDI@KFEFALBN@V@D@dCJAHCHAJALBPDFDFFAHMHIDGBG@K@KBEFCD@LBDLJJFLFF@@@FADEFIFEDCFCFCD@HAH@JDHJLFLFRFPBRDRCTCTBTAJGDCDEFEBADAJGJCH@L@H@LAR@R@XCH@HBLDHBJ@N@LBP@P@JCJCLCHEP@JCN@HAJAJALARALAJAJEFEFGHKLILCNGJIJKFKFOBAHGHGNCRALCJAJCHCJELA@@@@@@PANEJEHEHEFEHGHMHMNMFEHGHELCHCFCDEDGBG@KBEBKBSDGFIBG@E@GBGDIDGAAAC@@@A@A@A@@A@@AA@G@A@@AA@B@@A@A@A@AB@@C@GDCB@B@BADCECGIGGCG@GGCIEWCEAIEMA@@IAODQ@MBIAICMKKGMAKAGDGD@H@PEJEFIFKBE@IAGEEEOEOAIAE@MBGBA@OAM@ODQDOBSEKEOGMCOCOEIAG@C@KDKJKHA@INABEFIJGDGDI@GAIIGIKGICQCI@ODIBGFKBKEGEEIGIKIMKOMIIMQDIJEL@TFNBLALCFIBEKIKIEGGKGKYcGGKEUGC@GCEAAAA@CAAGAIAAAC@AACCECC@@ECGEEE@@ECAACAAA@AACAA@C@E@GAE@C@A@A@@AE@@AG@C@A@C@@BA@AAAG@CAA@AAA@@A@A@@BABA@@@@AAAACC@@A@@@A@A@CC@@@@@A@A@@@@@AAAEA@ABA@@@CKE@A@@@ABA@@@A@@A@A@A@@@A@AB@@A@A@A@A@@BABEBABA@EBABCBEDABCDAB@@@@@@A@C@AAA@AGABA@A@EAC@@AA@@A@@@C@G@@A@AB@@A@ABA@A@AAEA@@@@A@ABA@@BOBA@@@A@AB@@@B@@@BE@E@E@E@A@C@E@AAGAGAA@A@AACACCA@A@A@C@EBEBC@ABAB@@A@ABC@CAA@K@C@@AAA@CA@@@A@ABA@A@@@AA@A@@@AA@CAC@AACAAA@ACAAAAAA@A@CE@A@@BCBEBA@C@E@CBC@C@@AA@@BA@A@@BC@A@ABA@ABA@A@ABAB@BADAFEBAD@DA@AB@DAB@D@D@B@F@@AB@B@DCBA@AF@DAB@DAB@B@@@@A@@BA@A@A@@@C@A@A@@A@A@A@@AAA@@CC@@AB@BCDA@@@EACAA@@@@AEBAAA@CCA@AA@A@@AA@A@@@CA@@@@@CDABAA@@A@ADE@A@@@AJCFEFAFAHEHMHGFGDSJ@@OHGDIBGBG@UASDQBGBIBMBcBIBK@KCE@ICEAKCMEICOE@@OCMCKAKCKAQCIAU@M@"],encodeOffsets:[[117319,41974]]}},{type:"Feature",id:"130723",properties:{name:"康保县",cp:[114.600404,41.852368],childNum:1},geometry:{type:"Polygon",coordinates:["@@M@OACECCAE@ICCI@IBIFOFA@KHEDEBCFCH@D@JCLEFCHEHGHAHGHQHEDWHGFIHCFENEHILCHCFCBABGDMBA@QRSTIL@@KFED@@@@C@GF@@KJGFKHIACAEAAAAACACAAACCA@CAE@CK@EECIAEAAA@CAAEC@ABC@AEC@EAAC@EDCB@@KGA@ABC@AA@AF@BA@AAACB@@CAE@@@C@ABBBA@A@CAEAE@EDE@EAE@EBC@@AAABAAC@AA@CAC@E@A@ECC@AAA@AB@BDD@@CBA@A@AAAA@@@G@ABI@@@I@AAACAA@G@CBMBCBEBE@D@@@@B@TBf@F@B@BCFABCJCFAFAB@@@BAD@D@DAF@B@F@B@D@@BBABBB@@B@FB@@VZ@BKTABCB@@@BAB@B@B@H@B@B@BBB@@B@DBLBTBN@L@ABABEJCFEHEHCFGJEHADCDAFCBCFAB@BCDADCDADCFCF@@ABABABAD@@@B@@FDBBDBGJAD@BB@DHRGTDELCF@@HBADGH@B@B@BB@CBABABA@GHEDCDCBABKLCBCDBBB@@B@B@@FDDD@B@@BDBFBD@B@BAB@@C@MD@@@B@B@B@@CB@@@B@@BDDFBD@B@@@BABCBA@@BADA@ABAB@@AB@B@D@BBB@@BB@B@@@@@BAB@@@B@@BDBDBBDBHDD@@B@BFJDDNLJJFFDFLLJHDDBBNLVRJFJHFFLHHJLNLNJNJNFDJJHJHHNJABCDAB@DA@@D@B@@@BD@B@B@@@A@ABA@AB@@@BB@@BD@FAD@DAB@H@B@BAB@B@DBB@BBBBB@BD@D@@BB@@AB@@A@A@A@AB@@@D@@BBDBB@@BB@@DA@@DA@ABA@@@@B@@BBBBDHB@BB@@DA@A@A@A@@@@@AD@BAB@@@B@BDB@@ABA@@B@BAHDD@@B@@AD@BB@BB@BB@@B@@B@B@F@FB@A@@BABAB@BADA@@@@@@B@@B@B@@B@@@@@@A@@B@BA@@F@B@B@DAFCB@BAB@BADAB@B@D@@@DADADAFADAB@HBFD@@BBdFF@FDFBD@B@B@@@BB@@FAB@H@F@F@B@B@B@@@@@AB@@AB@@@BBBDFBBB@BB@@@B@@B@@@@BBBBBB@@BBBBBB@FDFBDBB@FBD@B@DB@@DAB@B@@@BB@@@D@B@@BBBB@BA@@@@B@BBBA@@@@@@BC@@@@BABAB@@CBAD@BABA@@BABAB@@C@@@ABA@@@CAA@A@@BABA@AB@@A@A@AB@@ABABAB@B@B@@@@@@@BABAB@B@D@@@BB@@@BBA@@BAB@@BBD@@@B@B@@@B@@B@@CBCBCBCBGDA@ABADABA@@@@BC@AB@@@@@@@BHB@@@B@@@BA@ABABABA@A@@B@@@D@@@D@BBBDFBDBBBBB@BDDHBF@BBFBDBD@B@@BBBADA@@D@B@BABADA@@@@B@BAB@B@@BB@@B@@@B@B@@BBD@B@@@BB@BBBB@@B@@AB@@@B@BFN@BB@@B@@A@CDABAH@@BBBBB@BB@@@BBBBB@@BBABBB@@@@BFBBBBB@@B@B@B@D@@@B@F@B@BHDJDLDJBPFJDHFNHLFLBL@NANANC`OVKLGLAH@FAL@J@DBJBRHPHHBLAH@BBB@@BD@FBDAH@N@DAB@BA@ABCBABADBH@FBD@B@F@F@D@D@D@D@@@@AB@@@@AB@BB@@@@BD@@@@@@DC@@D@N@H@@@@BBB@@B@@@DBD@F@B@BBBBF@BBBBBBB@BAB@@NB@B@@B@@@@LB@@@AD@@B@@H@@@DNBJBDBH@@@@FBD@H@H@\\AH@HA@A@@@AACA@@A@A@@A@@AE@@AB@B@B@@A@@DEFEFCFABA@A@A@@DCDA@A@@@ABAB@B@B@@@B@@@BAAAAC@@BAB@@EBAB@@BD@@@@@AA@A@AB@DAD@B@B@AA@@@A@AB@@@D@@@AA@@@@DC@A@@B@@@@@@@BAAA@@@A@@@@@A@@@@B@BA@@@C@@@A@@AAC@AA@@@ABC@A@A@A@A@E@A@A@ADA@ABA@@@A@A@@AA@A@@CGBABE@@AA@A@ABC@A@AAAB@A@@C@A@@@AB@B@F@B@F@@@BA@A@@@ACA@A@@F@@@@ABGBA@ADABAB@D@@@@BBB@BBA@@@A@A@@@A@@DB@@@@@A@@AA@@@@@@DA@@B@@ABC@@@@BA@@@@A@@A@@@@B@DC@@B@AAA@@AA@@A@A@C@A@@EAG@A@G@@C@@B@BA@@B@B@D@B@B@DB@@@@BCBA@@BAB@@@@C@AB@@ABADAB@BB@@@B@B@@@@B@B@B@BBB@B@@BBA@@BA@@BA@ABABCBABADE@A@A@@@@DG@CDKBC@CB@@C@ABA@CBA@AAA@A@C@A@ABCA@A@A@@A@@A@@@@@@A@A@ABCBABA@AAA@A@@@CBAB@BADC@ABAB@B@DA@@B@@A@A@@@@@A@@A@AABA@@@@BAB@D@B@@@DABA@@GCFE@ABAC@EAIBE@G@@@AA@@B@B@B@BAB@@A@@A@CA@A@@BC@@BC@ABC@@@A@@@@C@C@@@@@@C@@@@B@BA@A@@@@D@@A@@B@@A@A@@A@A@A@ABA@A@@A@@@A@E@AB@@AB@JCBABA@ABA@A@C@@@C@A@AA@A@A@@@@AAC@A@@DE@CCQ@KDGFIDGDCDEBEFIBADE@AAA@@AAECAAEEAAAAA@AA@AAAAC@@AC@AAEE@A@EAA@@AAACCCAAAA@@@OAWKSFA@@@@AA@@@OB@C@A@ABCCCAA@A@@BA@@DAD@H@@@@AA@AAA@AA@@BAD@BIPSFEHEFKFGBCBGDK@G@C@GEKCG@IBKDIDQBIBI@E@ABE@MAUAI@ACQ@A@I@GAGAIAG@IA@CEACCEGEEACACCACCECEEEECEAGGAE@E@CBCDEHABEAIAIAI@C@CCIAOGKGKEGBE@UEOACGIEEUQGGECOIAAGCOIC@EAK@OAYAQAQAQASAUCiC_GUASEOAIAOCMEKCOE[EKCMCOAKAQ@OBM@"],encodeOffsets:[[117315,42439]]}},{type:"Feature",id:"130724",properties:{name:"沽源县",cp:[115.688692,41.669668],childNum:1},geometry:{type:"Polygon",coordinates:["@@NBF@DBFBJJJJDDHDLBH@JBR@PDLDF@HBH@L@PAH@^AJ@H@RBL@NBHBH@RBFALCJCHEFADAJCHCH@JBRHFFDDBDD@F@B@D@@P@BFBJ@B@B@D@B@DANGBAFABABAJAB@HDR@B@D@J@B@FADALE^IB@T@NALAHCB@FEDCDCFE@A@@@A@A@AAC@A@@@CBA@C@CAC@A@A@A@C@@JBD@B@LBB@DBHBF@HBBANBJBD@BBJBHBB@@BDBFF@B@@@BBBDDBF@B@B@BAB@B@@BB@B@B@BAD@B@@@B@BAF@D@@@BBDBBJJFDNFJ@JEDEFONWDCFCB@BCBAB@@AB@DBF@HBD@B@B@D@J@D@F@D@B@B@B@B@LBH@L@H@FB@@B@DBB@HDDDFB@@@B@@@@IHOLEHABCBEHCFCDADCBCFADA@BB@BDDBFFFBDBDBB@D@F@@@BB@@B@B@@BBBBFD@BBF@DBBE@@B@@@@GFgFIPA@SPCBHJBDFHB@DDHFFDLDJBVAB@FBJ@JBNFLBHBF@HBHDHBLDNBJBDBPDJFTJJHLFPHFDPFHDFDPJPDHDPBXDFDTHPJPJNNf^P@HB\\DVJJJJFDBFBLD\\CHDLHDR@DFLBFJFFBNHHFBBDDFHHDN@TCHAJAFBLDDBDDBH@HAJBHBDDDFD@@HBF@D@JEFANCF@NDJFNFJFLJJLDFP@PBJ`HHB@LFDDD@HBF@JEBABCLEFCL@HBNBBBLBNJRJHBFBJ@LBD@JBHC@A@EBIBCBEDIJIFMFMFIDGDCDGBEBEFGFGDEDCHEFEDCBG@ABGFIDEBCLIXSHEDEBA@ABCDI@ABC@C@@@I@EBC@@BAB@B@@A@@@@@@@@BA@@@@@@B@@A@@@A@@@@@BAA@@@@@@@@@@@A@@@@@@@B@@B@@A@@B@@@@@@@@@@A@@B@BADC@@BABA@@@@AA@@@@A@@AA@@A@@@@AA@@BA@@BAB@@A@@@@@AB@@@BA@@@A@@@@BA@@@@@A@@@A@@BAb@@LX@T@FAB@r@@AEGAEAACCAEGMEGAMEKGIIKKGOGIECAQCKCGAECSQIGIGKMKISMMGICIEGCIGEEGEIEOGOGMGGCDGBEBEN]FMFOFO@A@@@AAC@@AA@@@AAC@A@@BAB@BA@@@ABA@A@A@ACCCAAAAA@A@A@IHCLAJCFCHELGNILOHKLMBG@K@EFIFS@GBK@OAEAECEAC@EFGTEJGFEFE@IDG@G@CDG@EFEHEH@PCFEHKDI@IFIDGDEHELCAAACABA@GA@@AAACAA@@@AA@AACAAAA@A@ACAAA@@AB@@ABA@@@ABA@@B@@A@C@AAAACA@@A@ACCAA@AAA@CAAAC@@B@BA@A@C@@@A@AAA@AAAAA@A@ACACCA@@A@A@C@@BC@ABE@@@@AC@@CEEEECCCAAA@@AA@ECAA@AA@EECA@A@@@C@A@@A@A@A@A@A@A@CB@BCB@B@B@@A@@@CAA@E@A@@@AB@@@@M@AAAC@A@A@C@C@AAAAAAA@@BI@ABA@@AA@@AA@@@A@ABA@@@C@@@AAA@AAA@@CAAA@A@CA@@AA@@AAA@C@E@C@AAAO@B@@ABA@ADABCB@B@BA@@@A@@@@D@BCBAB@@A@A@A@@@@AAA@@AAA@AAC@AA@AAAA@@A@C@A@A@AB@@@@CBC@K@@@A@A@A@EBEBC@A@@@AA@@@C@@A@GEAAAAA@@@A@EBE@A@@@E@@AI@@@AA@@ABADAD@BCB@BAB@@A@C@CB@@@BADBBAB@@AB@@@BABADAB@B@DBDEBEBAB@BABEDCBC@@DABABBB@BA@@@A@CBCBABC@C@A@GDC@C@A@EBCBC@E@@@CAC@@AEE@@C@@@C@ABA@GDAB@@ADAB@B@B@D@@CF@@AD@B@B@B@DGBABAB@B@@EF@B@@ABC@@B@B@@ABCBA@@B@D@BBD@@@B@BA@CDAB@@@D@B@BA@@B@DAF@@ABCB@BA@ABCACBCBA@A@@@A@ABABAB@BA@@B@BADABIFA@A@A@ABABABAD@BAB@D@B@@@@EBA@EBCBAB@@AB@BA@ADAB@BA@@BADABA@EAA@A@CDG@CB@@C@A@A@ABA@AAA@EBA@AAA@@CA@@ACA@@@AE@BECEKAGAIFOKEEECCCCICECKACAEAAACCCCAKEMEG@E@KAIAEAEAGAICGCCAGCQMGGEGEGGEIMGOACAACAA@A@@C@A@@CA@@A@ACEAA@@BA@ABA@A@CAC@@BA@@BA@A@GB@BABED@BABABCB@@@B@@ABCD@@@B@D@B@D@BB@BBBBB@B@@@@B@@@B@B@B@BA@ABA@@@AAAAC@AAA@E@A@CAC@C@C@A@@@@@@A@AA@@@A@A@AAAAA@AAA@ABAB@@CB@BA@@@CAC@A@ABA@@DA@CBAB@BA@@@CBCBABABA@@BA@@@@@AC@@@@@A@@A@AA@@CCCBC@CBC@EBA@C@@@A@@@EAA@A@@@A@@@AAAA@AA@@CA@@@BAACBA@@@AB@@@D@BAA@@@AAAAC@AA@A@@@C@AAAA@AAA@@@A@CBCBCDABA@A@CD@H@DA@@B@B@B@BA@B@@BB@H@B@@BB@@@@B@B@B@@BDBBCHCJAH@H@FAHEJCHATALAF@LAHCHCFEDGDKDGFGHEFMNGNGNGHEFGFGFIFMFOB@@@@@@KBIFGDIDIBKDQBMDGHGHABEPELILIJMHKDKJGLEHEFIFIBKBQBKBIBIBGBM@IDO@GFKDIDIDO@O@KAM@I@GAKCGAG@WDQ@Q@KBG@K@G@IDIHCBABEFCFCDIHSBSASDQDQCOAQEKEKEGIICG@GBC@EDEDCDEFEJCFEB@@E@KEIEKIAC@KDCFELAL@H@HAJCNGBGEEECOCKAIBGBGDIBcDC@U@M@KAEBEF@LCJEHEDGBOBYFFP@VAFFHHLHLBPDJ@D@DBJBJBJAF"],encodeOffsets:[[117625,42589]]}},{type:"Feature",id:"130726",properties:{name:"蔚县",cp:[114.588903,39.840842],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@BDJ@BBDBB@BDHEJIFG@KCaM@A@@AEA@CA@@@@@A@C@@AA@@@@CBC@@BA@@AA@Q@AJADCDCH@DAD@BAB@B@B@@AB@@AFAB@@@BB@@B@@@B@@@DAB@@ABAB@@@BAB@DKL[JMB_EQBKAM@IFA@@@CFGPEHCFEJGHENGJCPBNBJ@PAVBR@\\ALBL@J@NBN@DBVBL@JDHBDFH@B@DAF@D@@A@AAG@A@@@@FA@@B@@DHA@@@E@AF@@@AC@@@AB@DADABAB@BA@@D@D@BAH@BBBA@@DEDADCDA@@B@@BDBBBBB@BBF@F@BB@@DB@B@@@D@@@BDBB@@@@B@BMP@BCF@BBD@D@FAB@FD@CFAFCJEAC@E@@@CBCBAB@@A@@@ACC@E@C@AAC@A@@@A@@@@@A@A@C@EA@@C@A@GAGACAA@C@C@A@C@A@A@G@ABC@CDC@A@C@AACAGCCCA@A@ABEAC@@@CDA@GBGAEAA@@AA@CAA@A@AA@@A@C@@BA@@@@BDB@B@@@DAB@B@F@@BB@B@@@BABCBA@ABC@A@C@A@ACCC@@C@ECC@CAE@EAE@A@CAGCA@CCA@C@I@A@A@CAI@A@@AA@CE@@@A@ABABA@@@A@@AAA@@BA@ADAFAD@D@BCB@@EA@@@@A@AFA@@@@@@@A@@@A@ABCD@B@@@@ABA@@BAA@@EDAB@BABAD@D@B@BBBBBBBBBBBBBHDDBDBDBDBHBBBBB@BB@@BB@@@DAB@BB@B@B@B@BABAD@@A@A@@@AD@@@@B@B@B@@@@B@@@BCB@BA@@AAA@@@@A@A@@B@B@B@@B@@@@B@@@BABABABEDA@@@A@AC@@A@@@@@@FAB@@@HBF@B@DB@BD@B@BAD@BA@EDABA@C@GBCBA@@B@B@B@@DFDFBB@BBB@BBBB@BBLDFBB@H@B@@@BBDBBDDBBBD@@@B@BB@BB@DAF@@@@A@A@@BA@@DAD@@@BA@@BABA@AAA@EBABC@A@E@@@AAAACCEAAAAAC@@@A@A@A@@B@DA@@D@HDD@L@BBD@DBFBHBD@DDD@DD@@B@@@D@D@BAD@B@B@@@B@@BDBFB@@B@BBD@B@B@DBBBD@DBBBB@BBB@D@D@FBB@D@D@DAD@J@B@BB@@@B@@@B@B@@@@BBBBBB@BDBB@DF@B@@@BAB@@@F@@ABA@@BAB@B@@BD@BBD@BAB@BB@DB@@@B@@@BD@@BD@B@DBBBD@DAB@@BB@BB@@@B@@CH@B@B@B@@@@HD@@DBFBDBDDD@DBB@B@HBB@BBBB@BBB@BHDBDBB@BBBBBFDB@FBD@H@B@D@BADADEB@@A@A@@BA@C@@@AAC@@@A@@@E@@AAAC@ABA@@AA@@@C@@B@@@B@BAB@D@B@B@B@B@D@B@B@BBB@F@B@D@B@B@BA@@B@@@BBD@B@BBBBHCRCTCRAFFDBLHNJBHDFBDDHDNDHNJHFBBFDHDN@F@J@LBNDLDLFJHHH@LAH@NBH@D@RBH@DHLHJFBBBBBN@HAJCJELCLCB@P@NDFBJ@HBNBN@H@TLBBJDCNRFDHVJBFBJBFADJRCJCFYBCD@BEFAFEJGF@DFDNDHBFBJFBDDB@@NHNFFDFBL@J@F@@ANEN@B@B@L@TAB@HBHJHBDABAFABCDGFGBADCDCA@AA@AB@@@@ABAAA@@B@@A@A@@B@@@B@@@@@D@BA@@@A@AB@@@FA@@BA@@B@B@@A@AB@BAF@B@@@FA@AB@B@BA@AB@BAB@@@@@D@BA@@D@@@@@@A@@@A@A@@@@BAB@@@@A@AB@@@B@HB@@BBD@B@@@@@BBB@DB@AD@@@BB@@@@@BADA@@BBB@@B@@BD@@@BAB@B@@@BBB@BAB@B@@@B@B@BBB@B@@@@@BBBBBB@@@DB@BB@B@@BBBBD@ND@@BBBB@@DBDBBBDBB@B@DBBB@@@@@B@@@BBD@BB@D@@@DAD@DA@@@@@BBB@@D@BA@@BA@@B@BABA@@B@@@D@B@@@B@@@B@B@BA@@@@B@BAB@@ABA@@@@B@@AB@BAB@@@BAB@B@BABA@@B@@A@@AA@@@A@@AAA@AA@AAC@@ACA@@@A@@A@@@AC@@@@@@@DABA@@B@B@BAB@B@BB@@B@B@@@B@B@B@BAB@B@B@BB@@B@@@@BB@BBBB@BB@@BBB@BBB@@B@@@@B@@D@B@B@@@@A@@B@@@@@B@@@B@DA@@B@B@BBD@BDDD@@B@B@B@B@BAB@BAB@@@BBDAB@@BBB@B@@@@@D@@BB@@DB@@B@B@@@@@@ABA@AFC@@B@@@BB@@F@@@@A@CAC@AAA@ABA@C@ABABABAD@@@B@F@BAB@BAB@BCB@DAF@JGFCPGFCDEBICICGEGEGEGCG@GDKDEHGFG@@JIFGDM@EBEAEBA@A@C@@BC@ABABADADA@A@A@@DA@@@A@A@A@@@A@@BCDABA@A@C@G@AAC@ABADCDC@AFABA@@BCBE@A@A@C@@@A@ADEBCBABABA@A@ABABCB@@A@A@ADA@A@ABG@CB@@@BAB@DA@@BABCBC@A@@@C@A@A@@@A@A@A@@@A@A@@@ACA@A@ABC@@@A@A@A@AAA@@@@@AB@BCHA@A@@BC@CBA@AAA@AACAAAA@ACACA@@G@A@EAA@E@C@AAEC@AA@A@@AAAAC@C@@@C@A@@@CAACC@@@AA@@@ACAAA@@C@@AAAACAAAF]IcGKKMCKES@KBO@SDSFMJQN_CKGQGQCIAAAEIUEOCIBMBEDMFOJUJKGQIIGKOD_DSCIGEAQIIAMBMAMCGGQKOIQGOIOKECKIOKQWICIEIMCQECAAIDCBObQHUBeCM@CBODOHOBQ@SAGGUAIHKHGGAOBM@E@@@EICEA@@O@_EI@GBKGECGCO@K@AIAEAA@CCE@A@CAA@@CAACAAAE@AA@@@A@@@C@C@A@EDC@EBA@EBA@@B@@@DB@BD@@@@ABA@@@A@A@A@GBA@A@A@ABABABCDABAB@BA@@@@B@@@B@BABADA@CZgH_CSKtUA@@A@AACAAACAA@AAA@@AA@@@A@C@A@AACA@ACAA"],encodeOffsets:[[117313,40508]]}},{type:"Feature",id:"130725",properties:{name:"尚义县",cp:[113.969618,41.076226],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@GLEFCFIFIHINGDEFAFFFBJAD@F@D@B@D@@A@@B@@@@@BAB@B@BBB@@@@@B@BA@@B@@E@CAAAAAAA@@@@A@CBCDAAC@CAGEAAA@@@GDCDCD@FABABABABA@ABAB@BAD@@@@ABEB@@CF@DABADABAF@@@F@@@BA@@@EDGDA@ABA@@BDDBBDBDFBBBBD@BDBBJBPFBFBDDJCFCDGDIDKDCF@D@BA@@B@@AB@@@@BBB@DB@@@@@BCBAB@DAD@B@F@D@B@BBB@BA@CFCDABABCDAB@@@BAB@L@B@D@F@@AB@@A@A@@B@@@@@B@@B@F@@@B@@@BB@BB@BB@@JBDBH@@B@@@BADA@AB@@ABEBEDC@GEA@ABMBCBE@E@ABC@A@E@CAC@ABA@@@EFABABCBAB@D@@@@ABABADA@A@A@AAAGAA@AAC@@A@ABC@CFADEHEDELEJ@L@DANCL@BGHKLMHQNKJMJIHONULKHEFEDKJKLCFEFKLQPOLULaPIDIFIHDJPF`CNBL@NDPJLJHLDFNRHHFHLLJLDLBNHJLDJALAR@V@NBH@JBPDVLHHJHFJDJDJBLAR@NLJHIFDBDDH@DADGFIJBDABBF@B@BA@CBEDCDABAB@@A@@@@@A@@@AB@@@D@B@B@@@@AB@@BB@@@BA@@@A@BB@@@@AB@B@BA@@@@@@A@@A@AB@@@@A@A@@@@@@@@@A@@@@@@A@@@@A@@B@@@@A@@@A@@@CAA@AA@@A@AA@@A@@C@A@@D@@@@@B@@@@A@A@@@@A@AA@A@A@A@A@C@@@A@@@@C@A@A@@@@A@CA@@AAAA@@AB@@C@@@C@C@@A@@A@C@@@@A@A@EBAB@@AB@@A@AB@BABBP@BA@C@M@BD@D@@@@ADA@@B@FDB@BI@@@LBAFGABB@@FB@B@H@@@B@BABAD@@@DH@@B@@@BBDC@GFC@EBABC@MF@@A@YECFCDGCAB@@@BCB@@ABABBB@@ABADCFABA@ABAB@B@@@BA@@@AF@@AB@B@@BDFBBBB@DAB@B@@@BBDD@BBBB@DBFBB@D@D@B@B@@BB@@BAB@BBD@BBB@BABGF@@@B@BBHBBBBFB@DDFBDBBFBBBB@@F@@@D@B@@@D@BBBAD@BA@@@CA@@@B@B@BBD@@@BCB@@@B@@@B@@B@B@@@@@@B@@@B@BB@@@@BAB@@BBB@@@B@@@@@A@@B@@@@BBBBDBB@@B@BB@@B@@@BB@@@DA@@BBB@@B@@A@EB@@A@@B@@@@CBA@G@@AE@ADAA@BSJABEDC@_HKBoJ@@@B@BBDBFBFDJBBBDDFDDBBDBFDB@FBHBDBJBHDFBHDDDBBDBBDBDBBDLFTBDBH@DBDBDBBBB@@B@DBDA@@FADABAF@D@J@B@BBFFFFB@BBBBDBDDHDD@@BDDBB@B@@@BBD@B@@BBB@PBJDB@DDDBD@B@DBBB@@BB@B@B@BB@@@B@@@BBDANBB@B@DB@BBBB@D@@@DBB@@BBB@@DB@@B@DFB@@@@@@ABAB@B@B@B@B@@@@@AB@@@@D@@@@@A@@BB@@@@B@@@@B@@@@@@BA@@BBBFCBABABAB@@@@A@@@@D@BAD@B@@@B@@@B@@@DA@@BBB@BB@BBBB@@@B@B@@AB@@@B@B@@@D@@@@B@BA@BB@@B@B@DAB@@@B@@@B@B@@@B@@@B@B@@@@AB@BBB@@ADA@@BBDFB@D@@@B@@@BBBB@@D@BBB@D@@@BB@@BBB@B@FBB@DAD@BABBB@@AJC@@B@D@B@B@@@B@BBB@@BB@B@@ABABCB@B@@AB@B@@@B@@@B@BA@@B@HAD@DA\\CpG@@@A@@@@B@@@B@LAXCBAB@JC@ADAFEGGAAEEEIC@EABAGEA@AACC@A@CBA@AD@DCLGGEB@@@BCHGGEEBAACCCCA@CAIHAAEC@ADADC@A@@AAAAJEJE@@JJFDRIFANGJESQEGPCDAHAAAC@A@HCAAB@AAFCACEBACHCFAIGB@HCCG@A@A@A@A@A@@AAA@GECCFAFE@@BE@A@C@@BAHCHAF@D@@@VMB@@ABGBIDGBCDCBCBA@AB@@AJABAD@BDFGb]XOPKHKDIFK@OEOCECGKGOKSMQOAK@OBEDEDMDEDIBCFOHSLUBALIDERMNKPERGTKRILIHGBAB@BABADA@AAAAACA@AAADCFEICA@AAA@@A@@AA@AGA@@ACCAAA@A@@ABADAB@@A@@A@A@A@EBA@CDEB@@A@A@@@ABABA@@LEBAF@@A@@@A@@C@CBBCACFABB@@BB@ABCBAC@@AB@@@A@CCFCAAB@@CA@@A@@@AB@EE@BA@ADADA@A@EBBBABA@A@ADA@AAEAAAGC@@@@BABADAB@B@B@D@@@B@@A@@AC@@BG@OAQCKAO@KAGDMBGBGBIFILKNGREPANALABADALGHIHKNIFCLKJILIHGBCFKAAGCKIECEGEEEGCEAKCIEIEKAEEIEEGGGECA@@@@CEIEGEGGKCIEEEEC@CCG@EAE@@AIBQBOBCFIJQFIFGBEBGBIAICG@GCOAMAG@E@EAGEGIGAAA@WMECCBCBCBC@A@BFD@BBBDABAAA@@@A@@ACB@@A@AAC@AAABC@A@AAC@AAC@AAA@CAAAAA@@E@EJBDCBC@@DCBABKDEBGACGCBG@OJG@CBEDG@GDA@ABG@IBKAA@EEEDEBCACAE@I@G@EJIDMJGBEFGBCCKAGFECAEMCMCMEEAICIAMAEEAE@EEGCAGC"],encodeOffsets:[[116874,41716]]}},{type:"Feature",id:"130727",properties:{name:"阳原县",cp:[114.150348,40.104663],childNum:1},geometry:{type:"Polygon",coordinates:["@@GEIIEAA@IAI@IBIBSBMBMDOFSFODOBKAEAG@G@CBCDCBADGDEDABA@EBG@G@E@EAECCEC@@BA@ERABITEL@FCL@B@BIL@PBL@JBPA@ABEFGD@@C@@@CAA@CDE@EBCCA@A@C@C@ABC@ABABA@EBI@A@KBABKDG@G@IBIDIBEBCFGBKBG@KGECQFGL@HBFDFBBDDLDRAR@NBPHBBFDJFNN@@FHDHBF@JBB@B@B@FBB@B@@DDB@@B@B@D@@@FAB@DAH@BAF@B@BBD@BABCDCF@B@BBHDHBD@DB@BB@@BBB@FAFBD@DAJADAB@HAD@DADAB@BANEB@BA@@BCBABC@A@C@ADA@CJGDCDCBCBA@A@AAE@E@A@A@C@C@ADABCDABADCBA@@B@D@@@FBDBDDDBBBBBHDLDNBBBD@H@HBDBBBB@BBDB@B@@@F@D@D@B@D@BDHDDLLBD@@AH@D@F@BA@EFCHA@EDCFABABADAD@BAB@DCLAB@N@B@BABAJAFA@@BA@@B@B@DAB@DAB@DA@@B@B@@A@@DGP@@ADB@LHHDPDF@FBDBBB@BB@@@DD@@B@B@B@BA@@BBB@B@B@@@BA@@@@B@DBB@@@DCBCB@DAB@@@F@B@B@B@@A@C@C@A@@@CCA@@@AB@@AB@D@DAH@DA@@BA@@D@@@RDNFDBPFRHTHNBLDVBB@TCB@RADBFBJBDFBN@NFTLNHLLBPAHALCXARATDJFFBBBJFPFB@TFZDPDNBLCZETATBLBJBNFXHPDRDHBNFLFNFLLXVPPHDHFPLV\\FHDFDPDJ@NPBPFPDJDLBH@PFB@RBBAF@BALAJDJDDBF@RQF@LLDDbBF@DHJBFBB@JFHDB@T@HBDBFBB@DB@@BA@@B@BBB@@@BB@@@@B@B@@@@AB@B@B@B@@@@A@AB@@A@@@BB@@@B@DA@BB@B@@@@ADAB@B@B@B@B@@@B@DD@@BADA@AB@D@@@@@@ABBB@B@@AB@BB@@@@B@@@BABA@@DA@ABA@@B@DADA@A@AB@D@BA@BB@D@@@DBB@@@B@@@@A@AB@DABAB@@ABCDCB@@@@AB@B@B@BAD@BADCB@DCBC@@@AB@DCDA@@AA@@@AB@@A@@@ABEBA@CBA@C@@@AA@@@AE@C@CAAAA@@@C@@@@@A@@B@@AA@@AB@@A@ABA@@@AA@@@A@BA@@@ABCBA@@AC@@@C@@@@@A@@@@@ABA@@B@@@BC@@BA@@D@B@@A@@@ABABA@A@@A@AAA@@@@@@@BC@@@A@@A@@A@AAA@ABA@@BCD@B@@@B@B@B@DAB@@AB@@@B@B@B@@AACB@@A@A@AAA@AA@@@AAAACA@@B@@ABA@@BEDCBADCBA@ABA@@@ABCA@@@GGAACBC@AABI@@B@@@BA@A@@BAB@@A@@AA@@@@@@@A@@@A@@@@@A@@@A@@@@@A@A@A@@@C@E@@@A@A@@@A@@BA@@@ABAB@@@B@B@@@@A@@B@@@@A@A@A@AA@@A@CB@@@@A@@@A@@BAB@B@BC@@BA@@@AAA@@BA@@BAD@@ABA@A@A@@@ABE@A@A@@@AAA@A@@B@@A@@@A@AAA@@@A@A@A@@A@@AA@BA@A@@@A@A@@@AA@@@BA@@@AAAC@AACA@@AAC@@@A@@A@@@@A@E@ABA@@B@BA@A@@@AB@@EB@@A@@B@B@@ABC@@@@@A@@@A@@@@B@BA@@@BBAB@B@@A@@BBBB@CDCDABEHCHADEBABCBGAGIGAA@SBK@A@A@M@MF@BE@I@K@EAECMEMG@@CAACIEEAGAMCEC@CHEFIBEFE@ADCZADEDIIQBCAEAIAEUICGQEDMICAASKG@M@MAGAI@EAMCO@A@KDKDIFIDGBM@AAAAEAGIGK@CAG@Q@CAG@MBG@KGGIGKEKCMCKAI@E@M@GCECAAGEMICGCMCGACCEAGMIKGCAEEQBSDQDGDAAAAA@C@AA@@A@@@ABA@A@C@A@E@A@AAA@A@C@A@A@A@A@C@A@ABA@@@A@@@@D@@BB@@AB@BBDBB@@@F@@@B@@BD@B@@@DAB@@@B@BA@CFCBABC@A@G@C@EAA@ECAAAA@AAAACGC@AAA@AAAAAA@GAA@A@CAC@CCCAEACA@@GC@@@@@A@A@ADG@@@A@@AAA@@AA@CBC@AACAA@C@@AC@@A@@@A@@CAA@@ABA@AAC@AAC@@@ABA@AB@BA@@@E@@BA@A@@@ACEA@CA@AAAAAAA@@@@@A@A@@@A@@AAA@I@C@CBC@C@A@EAC@C@A@AAA@AACAC@AACAA@A@C@AAA@@@EACA@AA@@@A@A@C@ABC@C@@@A@@@CCC@CCC@GAEACAC@AAK@C@GCC@@@CBA@@@@B@B@B@@BDBBBBDFBDBB@B@@@F@BADAB@FBB@BABAB@@AB@@C@CB@@AB@@@B@B@@E@CBA@@AAAA@@@C@AACAACCAAA@@A@G@A@EAKCAAA@AA@AAA@AAACECE@@@A@A@AB@DAHAD@B@BAFCB@@ABC@A@AACA@@C@AAE@G@AAACEAA@AAAAACAEAEAE@E@"],encodeOffsets:[[116933,40866]]}},{type:"Feature",id:"130728",properties:{name:"怀安县",cp:[114.385791,40.674193],childNum:1},geometry:{type:"Polygon",coordinates:["@@@DCBEDIDKDIBI@KBOGICK@MBEBGDOLCJEHEFIFG@GAGEGCMAGAGCEAAACEECGCGAICABA@A@CAA@@BA@AB@B@@@@A@A@C@@@@B@@@F@B@BAB@B@@ABA@A@A@A@CB@@EAA@AAAAA@A@@@AB@BA@ABAB@@A@E@A@@@@@ABC@AB@BA@@BA@A@A@@AGDE@MCKAIAG@GBIFCF@FAHCFCL@BADEJEJELCD@B@@AD@B@D@BABABEHABCB@B@B@B@BBF@BB@BDNFBBDD@B@@@FAF@B@@A@EBA@ABCBE@A@@B@@@@@DAB@B@@AB@B@D@B@@@BC@EAEAA@A@@@ED@@ADCB@B@B@BBB@@@@@B@BABADAB@BBDBB@BDB@@BBB@BBBB@@AB@@@B@@@B@@@B@@@@@B@BAB@BB@@@BBFBB@@BB@BBBBB@@B@@@BAB@@CF@B@BB@FFBB@B@BBFB@AFBB@J@B@DAD@B@B@@BBFDDDB@BBDDBB@BBB@FA@@DADAB@B@BBB@B@@CDA@AAA@A@A@A@CBG@@@A@A@@@A@ABA@A@CB@@AB@@@BBBB@@BAB@@@BB@@D@@DF@BA@@BB@@@DB@@@@@B@DABAD@@@BHLDNBDADCFCDABKHMJEFGFG@ICGCG@K@MFID@FABABA@AB@@@BABABCBAD@@A@C@A@@@AB@BAFABB@BBBBDBFD@B@B@BABADABCBEF@DAHAD@@GJEJKFIFABCBEBEHCFAF@HCHEJGHEDIJW^ILKLGNHDDBFH@FBFFFNBJBJDFBNFNDNDBFFDHELBDDHAFEHANIJCFIH@J@F@DBDBFAFCFFB@LBJAH@BAB@HCH@FCDAH@PIH@DADHHBFALCBADA@CD@DAACFIF@@@BBBBDBB@BBD@BBD@BBB@D@BABBD@BBB@@@DA@BB@@@B@BBBAACAAC@AEB@D@DADA@GASPGd@REFADAJCHAVCJ@HAH@PAB@ZG`GLEB@BA\\MRGN@B@L@^EJAHAH@T@TBFBN@D@B@^HHB@@B@FBH@H@NFVB@@HBDB`DbBdJZRHJDHHDNH\\AL@`JD@D@R@JCRCHGB@FCFCDAHEHEACEE@@EENECACCICCAAAA@@A@@@CBA@A@ABB@@D@BAD@@ADEBA@CB@@BB@@@B@@@BC@@B@B@DABAB@BAB@D@B@F@FADABA@A@@CACAA@C@A@@@@A@A@A@@@A@@A@@@AAAAA@@@@@@A@@A@AAE@@@@A@@A@A@@@AA@@@@@AB@@@@A@@@AA@@@@CBA@@@@@@A@@@@A@@@A@@A@A@AA@AB@AAAA@A@@C@C@A@@A@@@@BA@ABADCA@A@@@@@@A@@@ADADCBAB@B@BA@CBABABABCBA@@BA@@@A@@BC@A@ABCFEDA@@A@@AB@B@@A@A@A@CAAAA@@AC@A@A@@A@A@A@@@@@@A@A@@B@B@BEBA@@A@@ABC@A@A@A@@AA@A@@@A@A@ABC@A@AACAC@AAACA@A@A@A@@A@@C@AA@@A@@A@@A@@@ABA@AAA@A@ABG@C@ABAA@BA@ABA@AC@@AAAA@@@@A@@AAA@@A@ABA@A@@@AAC@A@CBA@@AA@A@@@ABABAHAD@@BDB@@B@B@B@@@BABAB@DA@AB@BA@@DAB@@A@AB@@@@@B@@@@AB@B@@@B@@@@AB@@@B@@@B@B@@A@@B@@@@AB@AAB@@@BA@@@AB@@@@@@AB@CAAAEAEICCA@ECAAE@ECGBACE@E@A@AAA@ABA@@@CA@@CDA@@@A@CAA@E@A@A@A@AAA@@@C@A@AAA@A@CDA@C@C@A@@A@CAA@A@@BAB@@A@ABC@@B@BA@@B@BCBA@@B@@@@@@A@@@A@@@A@A@@@C@C@A@AB@B@@A@@@A@@CCAAA@@@@B@@A@A@ABE@A@A@@AA@CA@AAACE@ABE@A@A@AAA@@@@A@@@@A@ABABC@@@AAACA@AA@A@@@BA@AA@@@A@E@A@C@KACC@@@@AAAA@A@@@ABAB@BAB@BA@@@AA@@@A@CBA@E@A@C@CAA@E@@@@@@@@ABCBA@@@A@A@A@@GAA@@@E@A@A@AA@@A@A@@EBA@A@@@@AA@ABABCDAB@@A@AAC@@BA@ADADCFABCBADA@@BA@A@CACAAAA@@@CA@@A@@A@A@@A@@@A@ADEB@DA@@BA@A@@A@CEAAAA@A@@B@@@AAEA@@AAAAA@@@@@@BAAA@@@A@@BAAA@A@A@A@@B@@A@A@@@@@AA@@A@AAA@@@AB@@CAA@EACAGAS@A@GCIEA@EAIACGE@aACCKKE@QRE@CAICICKBABE@ABQAA@OEG@KAICOCOEOA"],encodeOffsets:[[117280,41312]]}},{type:"Feature",id:"130730",properties:{name:"怀来县",cp:[115.517861,40.415343],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAA@A@A@AAA@A@GBEBA@CBC@@@@@@ABABA@A@@AA@BA@CBABED@@A@@@A@CBCDA@A@A@A@A@C@@@A@A@C@E@C@@@A@EHA@@@AB@B@@@@@@CB@@ADCBCDGF@BA@ED@D@D@D@F@AA@AAC@A@A@ABA@CACAA@A@@A@@AA@A@AA@ACAC@A@AAAAAC@CAAA@@GBABCAA@@@AC@A@A@AAACC@AAC@@@@BA@A@@BAB@DA@A@A@@@A@@@A@@ACAA@@ACCC@@AAA@@@AAAAAAC@AA@@@A@@A@A@AAA@AAA@AAA@A@C@CBAAA@CAA@ABABEBCBAB@@C@EBA@A@A@CACAG@A@@@BAB@BAB@BAB@B@B@B@DABB@AB@@A@@@@@AC@A@@@@BA@A@@@@@C@@BA@@@C@EAAAC@@@@BA@@@AB@@@@@B@@ABAD@@A@A@A@AA@@@@A@AACDA@@@C@A@@@A@CAGCEBGAG@CAC@C@@@@@@B@@CDA@@@CAEACAA@AAAAA@BA@@@AAA@@@AA@@AA@@A@@BCBA@A@@A@AAA@A@C@@@C@A@@@@BCBC@@@A@AA@@C@A@A@A@@@AB@@@BC@@@C@AACA@@CB@@ABA@A@ABA@C@A@A@A@C@E@A@ECAAAA@A@A@@@A@A@A@@@A@A@@A@@BA@@@@C@@@AB@AAAC@A@ABC@@AA@A@A@@@@AA@@C@CAC@@@E@ABC@@BABAB@@@B@@@BA@A@AAAA@@AAAAC@AA@@@BA@@B@@@@E@@@A@CCAAA@@@A@A@AEA@AA@@A@BA@CB@@CBAAA@C@@AAA@A@@C@@AAA@EAA@CC@@A@A@@@AB@B@@G@C@AAA@A@E@@@C@AAAAAAA@CCAAEAA@ACAA@@EAE@ABAD@B@@AB@@@F@@BB@@@@BB@@@B@B@B@BAB@F@BB@FBD@@@@B@@AB@DADA@AD@@ADAB@B@@@B@@AB@F@B@DAF@D@B@@@D@@ABAB@@@B@B@BAB@@CDEBABAB@BAB@B@@@B@BAB@@@DAB@@@@C@@@@BAB@B@B@@ABCD@B@@ABA@@DAB@B@D@@@B@B@D@BBBBDB@@D@B@@CBCB@BA@@D@@ABA@ABA@@B@B@BBB@B@DA@CD@DAD@BAF@D@B@BAB@@@@CBAB@@BD@H@BB@@@DB@@@BBB@D@B@BAD@@BBBB@@@B@@@BDBBB@B@@@BCB@@@@@B@B@B@B@@@B@BA@EC@@A@ABC@ABA@@B@@@@AB@@@D@D@@@B@@AB@B@@AB@@A@AB@@GFCBAB@@EHABABADA@AF@@@B@J@@BB@@@B@@ABAB@BA@BB@BBBHHBB@BBBBB@BDFBDFHBB@@@B@@BB@D@BB@BBBB@B@B@@@BAD@DADAF@BAD@@@BB@HD@@BAD@@@@@@@B@AB@BBBBB@B@@ADABB@@BD@B@@BB@@@@BA@@BB@@BB@D@B@D@BB@@BDBD@B@@@BB@@@LCB@BA@@B@@B@B@@@BDJBDBD@D@B@FB@@BB@@@@B@@@@A@ABABA@@@KBA@ABA@@@ABA@C@A@CDIBA@E@A@C@@AA@AA@@A@@@AB@BA@@@AAAC@@@A@A@A@CBA@AA@@@A@ABA@CFABCF@BA@@@@A@AA@AAA@A@CBA@@A@@@A@C@@@AA@@A@@A@C@C@C@A@A@E@@@AB@@@@@AA@@@@@@B@@@@@@@AA@@@@B@D@BAB@@BB@@AD@B@BABADABAB@BA@DBH@B@HBDBDBB@B@FAFDB@ABC@@@@@@BB@CBABA@A@@@CB@BAB@@AB@@E@A@GBE@@@A@@@BB@BA@CBAB@BA@IBABC@ABA@CAA@A@@@A@@@A@ADABC@A@A@CAA@ABGBAB@@E@C@EBA@CBA@GBGDA@I@CBGDE@IDABGFA@A@AB@@AB@BA@A@A@EAA@EDAB@@BB@@ABABEB@BA@AB@@AB@B@@A@CB@@AB@DCBABA@@B@BAB@BAB@BA@CB@@AB@BABCFEJ@@CBA@@BCB@@BB@B@@CDADAB@@@BAB@BABCBABAB@@AB@B@B@D@HBB@BAB@@ABAB@DABABAB@B@DAB@B@BBD@@BBB@@@@@BDB@@ABE@CBABA@AB@@@BB@@BD@@BDB@BBBBH@B@B@BBB@D@H@DBB@BBBDBBBBBD@DB@BD@B@@D@DBB@B@D@@B@B@BBB@B@@@B@B@@ADAB@BBB@DB@@BJDFHBBBBBB@B@B@@AB@@@D@@BD@BB@BBDDHGHE@@B@B@B@D@B@@@BADABABAB@@@D@BBB@B@D@BAD@B@B@D@D@D@BAB@@@BA@CBA@@@@B@D@FA@@B@BAB@F@@@DABAB@B@B@B@B@@@DBB@FBB@BAF@B@B@B@B@@BB@@B@D@BAH@@BD@@BBBDB@BBB@DDB@DBDBHB@@B@B@B@@@DDDBDF@@D@DABBB@B@JD@BBBB@FBB@BBBBBBBBBBDBBDBBDB@@B@@B@D@B@B@B@@BBBBDB@@DBB@DDDBDD@@BD@@@@DB@@B@@BBD@BBBDBF@HAD@@@BBB@@@@@DB@B@@@@@BBBBBBDBB@@AB@@@B@BBDBDBB@@B@@@@@B@@AB@@@@@@AB@@@@@@@B@B@B@B@@@@@B@@B@@@@B@@AB@@@@@B@B@B@B@B@B@@@B@@@@@BAB@@A@@B@H@H@PCRCNARAPCDADCF@H@LFFBJBH@HCFCHCHAHBHBBB@B@BBB@BBB@@@B@@@BB@AB@B@B@@AB@B@BB@@B@@@BB@@BBADBBAB@B@DADA@@DA@@B@BA@@B@B@B@@AF@B@B@DA@@B@@@B@B@@@@@@B@@B@B@@@@BB@@BB@DCBE@@@A@@@CAA@@BABC@@@@@A@@@@HAD@B@HBDDBBDJFJJJJHLBL@P@FCB@BAHCDGBSAMAKAG@G@@CICGAACM@@BCBA@A@@BABA@@@A@A@@BA@C@ABABA@@@@F@BA@@BA@AD@BABA@@D@@@BC@@@AAC@@AACEA@@AB@@@DC@A@ADCB@@AB@@A@ABBA@BB@B@BB@B@D@D@D@F@F@BBB@DA@@B@DB@@DAB@B@DC@@B@@@BA@AB@BAB@B@BBB@@@B@DABA@A@C@@@@CCAAA@AC@@ACBC@@@A@C@AA@AA@AAA@@A@AA@@ACAC@A@C@A@@DEB@B@B@B@HD@@BA@@@AC@@@@AA@@A@@A@@A@@@@@@@@B@@A@A@@B@@AEDAAABAABAB@@A@AA@@@@ABABAEB@A@@BAAA@@BC@@@@EA@AAA@@@AD@@@@@D@DC@@@@D@@B@@@@B@@@@@DE@@@B@@BA@@B@@CB@B@B@BA@@DEBCFB@@JA@CBAEA@@A@@AB@BA@B@A^]BA`aJIHGBGF@BGFB@CB@@@B@@AB@@@D@@BD@B@HB@CB@DB@AD@@C@C@@@AB@AFB@@@@@@E@AGA@@H@@AE@@AF@@A@AG@@@H@@AG@@@H@@AE@@@BAF@DD@CA@A@@@@ADC@BB@D@DBD@BA@@HD@AD@@CBBH@@C@@D@@ALBBABATBB@B@@@B@@@B@B@DB@AB@BAHADAB@@C@@BA@@@@@A@A@A@@BA@AB@@ABABA@@BC@@@@CCA@@A@@BA@@@A@A@ABC@@@@BAB@@@DA@A@@@AA@@AAAA@CA@@@AB@BCBABA@A@@@@@@@@@@@@B@@BB@@@B@B@DABA@ABAB@B@@E@@@@@@BA@@DAB@B@DAB@@@@@@AAA@@@@@AAAB@AA@AA@@AA@@@@A@@B@@@BC@ADA@ABABA@A@@@A@A@@A@@A@@@AB@@@@EBBB@@@D@@@BA@A@@@@@AA@BA@@B@@A@A@A@ABAB@@AB@@@B@@CA@B@@A@@@@B@@AB@@@B@@@DA@@AA@@@@BA@ABA@A@A@@D@@@@@BABA@A@@B@BA@@DA@@@@@@AACA@@@CAA@@AA@@AAA@C@@AC@A@A@A@C@A@C@A@@A@A@@A@@@C@C@AAA@A@ABA@CBA@A@A@@@A@@@A@AAA@@@@@A@@@@@AA@@@@AAA@@@@AA@A@A@@@@@@@@AA@@@C@@AA@AA@@AAA@A@@AAA@@@A@@@@@@@@@@BA@A@@@AA@AA@@AABCA@@C@AA@A@AAA@A@C@ABA@A@@BC@A@EB@ABA@@ACCAAAAAAAAA@@@AAA@AA@@AAA@@@@AA@@@@@ACA@AACA@@@A@@@@A@@@@@@@A@@@ADA@A@@AAA@@@@A@ABADA@A@@AAAAAAAA@@A@A@A@@AA@AA@@@@@A@@@CA@BCAA@A@@CAA@@A@@@A@@@AA@@@@A@A@@@AA@AAA@C@C@A@A@A@CBCBC@ABA@@AAA@@CACACA@@JEBA@@@@ACA@@AA@@AAA@A@AAAAAA@@A@AEA@A@@@A@ABA@@BAB@B@BBB@@@@A@@B@@A@@C@AA@@@AB@BA@@@ABCDABC@@@AAA@@@AB@@A@@AA"],encodeOffsets:[[118634,41113]]}},{type:"Feature",id:"130731",properties:{name:"涿鹿县",cp:[115.205345,40.379562],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@AAC@A@A@@@A@A@@@K@ABA@ABC@A@@@ACC@@@C@@@@EAAAAAAAEAAAA@@AAAAEAAB@@@DADE@ABE@@BABCB@BABAD@@@B@@A@A@@C@CACA@@EBA@CAKCA@E@CA@@C@AAEAA@@AA@BC@AA@@@EAA@A@@AAAEC@@AA@A@C@@CAAAAACAAAAA@AA@@ACA@A@A@@@C@AAAAA@ACC@@AA@AAA@AAAAAAAA@A@EAA@CA@@A@@AC@AA@@CAA@A@C@CCCAA@A@A@A@@@ABCAAAA@@@AB@BA@@@EACAAA@@@C@@AAA@IECGGIECWQCCSGMEE@ODM@C@AAACBGDKAWACEECAIAA@GBMFGDOJGHCDI@OFIHWJODMDaHOBi@QCSIGGGIILIVEPCNAFANDJFPJVBFBBDJHRHRDLM`IRENCT@TAP@LFTDLLNHLJdE^BBDBBBBB@@@DB@BBBD@@B@@B@@DDBB@D@@@B@D@@@DBDBB@BB@B@@BFDBBD@F@B@FBB@H@@@DBDB@BBBBBBD@BBB@BAB@DAD@@@BGBADA@@B@@@@BB@B@B@B@B@@AD@B@BDB@B@@@B@B@@@B@B@B@@@B@B@D@@@BADADAB@@CBA@AB@@A@@DAH@B@BCB@B@B@BA@ADAB@B@BABABABADCF@B@B@@@D@B@BAFAD@@ABEB@BCDCDAB@BBD@B@H@D@BABCBAD@@@B@@@B@B@B@@CB@@@B@BCBCBABAB@BAD@@@D@BABBFAF@FCNEHIJ@@EHGHCFCL@HDHFHFHFHDHDJAJCFEDOHEDIHE@CBA@ADA@ABA@ABE@A@@@C@ABABAB@B@DAB@BBB@BBD@D@B@@@BA@ABA@AD@DA@@B@BA@@B@B@@ABAB@@@@AB@@ABAH@BABA@@BAB@B@BB@@@@AB@@@BD@D@BDD@@DB@@@B@B@BCFABABA@ABABABA@@B@@ADA@A@@BA@@@@BAB@@@B@@C@@@@DAB@@A@CB@@A@@D@@A@C@@BA@EH@B@B@BCDA@@DABAB@BADA@AB@@@@@B@BAB@@AB@B@@@@DB@@ABA@CB@@A@A@@@BBH@B@@@D@B@B@B@B@@BBBB@BD@@B@B@@D@@B@B@B@DBDBB@BBB@BBD@@@@@@BB@@@B@B@DBJD@@B@@@A@ADA@CDA@ABA@AB@@@BD@DBBB@B@@BB@@@@BB@@B@@@B@@BB@@@@@@B@BB@@BB@@@@B@DAB@D@@@@B@D@BBD@B@@BBD@B@BA@@BAB@@D@B@D@@@B@B@BBBBB@@B@@@@@@@B@@BB@B@BBB@B@@@BBD@@C@E@AA@@@@AB@@BBBB@B@@@BB@@@A@@BA@@@AB@@CBAB@@@@A@AA@@A@AAC@C@C@C@A@@@ACA@AAA@CAC@A@CB@@@@@D@B@D@BBB@@A@ABAB@@C@C@A@AB@@B@FF@@@BDB@B@@@BA@@@@B@@@@@@@@AB@@@@@B@F@D@B@B@@@B@@@@@B@@BB@@@BBB@BBB@@BB@B@@@@DB@@B@DAB@B@DB@@@@@B@@BB@DB@@B@@@@ABC@A@@@@B@@@@@B@@DBFBB@F@D@B@B@@@HBBAF@B@BAB@B@B@B@@A@@B@B@@@JAF@BAB@@@B@B@@@BB@B@BBD@@@@B@BAB@@AB@BAB@@@B@@@B@@@BA@@DADAD@B@D@BAB@@@DDB@B@@AB@B@BBB@@@BB@@B@BBB@@@@BA@AB@BB@D@B@@BB@@@BB@AB@BAB@@AB@@BBB@@@B@BBB@@@@CD@BAB@BB@@B@@@B@B@@@BA@A@@@@D@BBB@DB@@@@B@B@@@B@@@BB@D@@@BBB@B@DBB@B@BBD@BBBBB@DBB@FFBB@B@@@DA@@D@@@BB@BBBDBB@@D@BAB@@@BABCDCD@@A@ABA@@HED@D@@@B@B@B@@@@C@@@@BA@@CA@@@@@AB@@@B@BBB@BAB@BA@@@@AA@@@A@CBEBAB@BBHDBBBBDF@@@B@@@BDDBB@BBB@BBB@@@BBB@BB@@@AD@@BBBB@DBBBB@@@DBD@BBBBD@B@@ADA@CDABABA@@@@B@@@B@@@@ADA@@BBB@BB@@B@@B@D@@@BAB@@A@@BABBB@F@BBB@BBB@@@@B@B@@D@BBF@BB@@B@@BA@@B@D@@@D@@BD@@AB@@@BABAD@@BB@BAD@B@@@@@B@B@@@@@@FDBBD@DBBBB@@@BA@@H@@@@@@BB@@BB@@@@BB@@BB@@@@@BA@@BAB@B@@@D@D@@@BB@@@F@B@@DB@B@D@@@B@D@@CBAB@@BBD@@@@B@@@BD@DBBBBB@@BBD@BB@AD@B@BBB@@AB@B@B@@BB@@B@@BBB@@@BBB@@@BB@@DA@DBB@DB@DFDB@@BBHB@@B@D@B@B@DBDBB@BAB@DBH@B@D@B@B@B@B@B@@ABABA@A@@AAA@C@CAGAEA@@@@AAAAAEACAAAA@@BC@A@@@@BAB@BAB@@@B@B@F@@ABA@@@E@@AA@@BA@ABABABCBAA@A@B@BA@A@A@A@@CA@@@AB@B@D@B@AABA@@@AB@@B@@A@@BDAB@B@@@@B@@BB@@BA@AB@B@BB@@@A@@J@@AHB@@@AE@@@@A@@CABAB@@@@@B@@ADC@@A@@A@@ABAAA@@@@ABAHB@AB@BB@@BC@B@AD@@@B@@@@BA@@@JDBB@BCB@D@@@@FDBDB@DBB@B@F@DAHDB@@@B@@@@@@@@@@@DB@@@CB@D@@A@@@@B@D@@@@@@A@@DBTB@G@C@A@ABA@@BABADABA@ABA@A@@BABCDC@@@AAA@@DA@AB@DA@@FIDEBA@ABA@@DAB@@ABA@ABA@A@AB@BADA@CBA@@DAB@@@@ABA@@BAB@@AFABABA@@AA@@BAFCB@FBB@B@B@@ABA@@BAB@B@HEBAJCF@HCDAJ@B@HCHAB@DAB@FAD@F@@@BAHABAB@DBB@B@D@BABCB@@@B@@@B@B@DBB@BAD@BAJAB@@ABADAB@@AAA@@B@@@F@HAB@F@@@BA@@BA@ADA@@B@B@BADAA@@A@@@@D@BAA@ECEBA@A@CACAGAA@G@CAB@@ABABABCBA@A@ABC@@AA@@BA@A@C@A@@B@@B@@@@@@@A@@@@B@@B@@@@BA@@F@B@B@D@D@D@B@@@@BB@@B@@@D@B@@@BB@DAB@B@BBB@@B@B@@B@@ADEBADEB@BAB@@@B@@BAB@D@B@B@B@@BDBB@@B@@ABA@@B@@@BBB@@BD@B@F@B@JADCB@D@B@BA@@B@BAB@LA@@B@BABAB@@@@@@A@@A@@AA@@E@A@CACACCI@A@@@A@AA@@@ABA@KD@@A@@A@@@AACAC@@AAC@A@C@A@@AA@@AB@@A@@A@@AA@C@@AA@BABC@@@AAAAA@ABAA@@@@@@@C@AB@@GCA@@A@@BC@ABEBC@CBC@A@@@A@AAAAAA@@A@CAA@@@A@@AAEGACCE@AAAAA@AAAGGAA@AAAB@@ABABA@@@A@@AA@@@I@A@@BEB@BCBABAFG@@BADAHE@@BAB@@@BA@@@ABA@@@A@@@C@C@@BA@@@@@AB@BAD@BAB@@@FDB@@A@A@@@A@A@A@A@@@@DA@A@@@AAACA@A@@@A@@AAAA@@BC@A@A@CAA@A@@CA@@A@@A@GAC@@BADA@@@@BA@A@A@CBE@ABC@CDCB@@C@AAA@A@A@AB@BAB@BA@@@CB@@ADADA@@@A@CA@ACAA@A@C@A@A@@@C@ABA@CB@BA@@@ADCBA@@@A@ABA@A@@D@@@@@BA@C@@BA@A@A@@@ABA@ABABAFADC@@BA@A@A@A@@BABA@@@C@@@A@CBE@C@A@EBA@@@A@@@ABABC@@BCB@BC@CBA@@@A@@C@EAA@@A@EBA@A@A@A@A@@AA@@@@AA@@@E@@BA@@@ABCBA@A@@BA@@@A@@@AA@@AA@@A@AA@@@@AA@AA@@@A@@@A@@AB@@A@AAA@@@@AACA@A@@ACAEAAAC@AAAA@A@A@AA@ACCAAAA@EA@@A@@A@AAA@@EAME@@C@E@CBAA@@AA@@@@A@@@A@A@AAA@@A@@@A@A@CA@@AAA@@@CA@AACCAAAAA@@CA@AC@AA@A@@A@@BA@A@A@AB@@@B@BA@@DC@AB@@@@A@@AAB@@A@@@A@ABC@ABA@AA@ABAAA@@B@@ABA@A@CAA@@@AA@@CAA@@AC@@AAAA@@@@@AAACCAAAB@@AAAAC@AA@@@@@@A@@@A@AAA@@@A@AA@@A@AAA@@@ABA@@@A@@B@BABA@@AAAC@A@A@@@A@@AA@@@C@@AABABABA@@@C@@BACAAAAAAEEAAEIUIS@ACMCEEICYMEOCW@QA@A@AA@AC@A@AAC@@@@FC@A@A@A@@AA@@@@BA@@@A@AB@@A@@AC@A@@@AAA@AAA@C@@AA@@AAACAA@A@@CCCA@@@@A@@@CAA@@A@@AC@@AA@AAAC@@@AAAA"],encodeOffsets:[[118113,40798]]}},{type:"Feature",id:"130732",properties:{name:"赤城县",cp:[115.831498,40.912921],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAEACA@@CBABA@@ACAAA@AB@@ABABA@A@C@@@@C@@@ACCAAAAA@ACC@CA@ACAA@A@A@AAAAAC@C@@@ABABC@@BAB@@ABE@@@@@ABAB@B@DAB@@@B@B@@ABAB@@@BABAD@@DNBBDHDJ@@@HBHBLBNATCHGDABA@EDO@K@KAIGIIEICIAACCGAA@C@GB@@@@@B@@@@ADAB@@BB@D@@@B@@AFCDA@@AA@@A@@A@A@@@@A@@@@A@A@@@A@@@CBA@A@E@@BA@A@A@@@ABA@@@CB@@CBCBA@A@ABCAAB@AA@@A@@@AA@@A@ABA@@@A@ABAA@@A@@@A@@AA@AAA@A@AAAGAGAGBGDEDGDG@IAEAKEG@E@CDCBODQBMBQDODG@G@A@@@@BA@AB@@@@A@@@A@A@A@A@A@A@@@@@A@@BA@@@@@@AA@@@@@A@A@A@A@@@@@@@A@@B@@@@A@@BA@@@@@A@@@A@@DA@A@ABABAB@@@BDD@B@D@B@B@BAD@@AB@BABAB@BA@A@IDEDABCBAB@B@@D@BB@@BDBDBBBB@B@@@@AD@@@BBDBD@BB@BBBB@@@B@BBD@DBD@@B@@@LHDBBB@B@@CH@B@B@@@@BBFBBBB@B@F@BB@@@@@B@BAB@D@@ABAB@@@B@BCB@@@@BBBBB@@B@@@@DBBBB@@B@@A@A@A@@B@@@B@B@B@@BB@@B@@B@@@B@B@@A@@@A@AAA@A@@B@@A@@D@@EDCDCBABAB@@CBA@A@@@A@E@@@CBA@A@CBBB@B@@@BAB@B@B@B@@BBBBB@@B@@AB@@@@ABE@@BABA@@@A@ABABABBB@B@B@@@BAB@@@BB@BB@B@@@B@B@@B@@D@BBB@BAD@@B@DF@B@@BB@F@BAD@B@@@BCB@BABA@ABA@CBABAB@@G@C@ABCB@BC@@@CBA@@BC@A@C@@@EDCBA@CD@BA@@D@BA@CBCBCBABABC@A@@BA@@B@F@D@@@B@@BB@BBD@@HDB@@@BBAB@BBD@@BBDF@BBFBDBB@B@@@BCBA@ADCFAB@@A@A@A@CAG@A@C@CAIB@H@FFP@H@DDJ@HDFDHDH@BBX@LBDBHDFFL@BDDBBHJJFLFHFJFDBDBJDHFLJFDFDDBBD@@B@B@@B@@BB@BD@B@BB@@@B@@@BB@@B@B@B@@AB@BBB@D@B@@BB@FBB@@DB@@@B@@@BADCH@@CDAB@BAD@@D@@BB@@@@DBB@BBB@@B@@@B@@BBBB@B@BBA@@@ABAB@@@BA@A@E@@@@@@BBD@B@@BB@@@BAB@BA@@@E@A@AB@@AB@@A@A@ABCDGF@@ABA@A@AAA@C@CAAAAAA@AAA@A@A@AFAFAR@FBDDFBH@DDH@FBDDDBFBF@F@JBH@HAHBFBFBDBDBH@DDFA@FFJPBD@DBH@DAHABABIHABED@PBD@FFHDJDHFHFFDJHPJPFJFFBH@F@FCHAFGFCBEDAH@F@LAFAFGNADIPCFCDCHEFAF@HBFBDDHFFFHHFDB@@BB@BBB@BDBB@B@BBBBBBF@D@DBD@DBF@FBL@DBDBTBJBD@@BB@JBF@@BB@B@@BB@@B@@@F@@@BB@B@FBBDB@@@DB@@@B@DB@B@DBBBBDHPJNHFFHFHHHRNHDDBHDJDHBFBFBJBLBF@H@NFLFDBDDBDBBBFBDDLDFDJDDFDFFPLJEHBLBDFAFF@@B@@DB@BB@@DB@BBB@FAB@BBB@BAB@B@D@@@DAH@DCB@B@FBB@BABC@AB@@ABABCB@@ABA@@BADAFAB@FA@@@@@A@CBA@ABCBABABAB@B@B@JEBABC@A@AB@@ABABABAB@@@B@B@DADADBBAB@@ADABA@@BE@C@AB@@A@A@C@@BADCB@@A@A@@AC@A@C@AB@DABA@@@A@AD@BA@@@AFE@@@ABABAHA@C@A@A@ABC@@DE@@@C@A@ABABC@@BAHCB@BAD@@@D@@@FF@BD@DB@@F@D@DAFAB@D@D@HCB@D@D@BADADAB@@@B@@AAABABA@CD@DAFCBA@ABAFAFAAC@C@ABABCBA@A@@BA@@BAAABC@A@@DAD@B@@@BA@ADA@ABCBCBA@@BB@@J@@BF@@@B@F@FAB@@@B@BBBBHFB@@@@D@@BB@@B@D@FAFAB@B@B@@@L@D@DA@@@@BAB@B@D@B@@@BBBBB@@BBD@BBB@BB@BB@@@@@B@B@BA@ABADC@@@@@@B@@ABA@A@ADCB@BAB@BA@P@BB@B@D@F@DBB@BB@@BB@@D@BBBDB@@BB@BBB@B@@@D@@AB@B@B@@BB@@BB@@AB@BAJ@@BBBBBB@B@D@D@B@BBDBBN@@@@@BA@@B@F@B@DB@@B@@@@A@ADA@ADAB@B@B@B@B@B@@@@B@D@@@BDBFFB@@BBBFDB@@BB@BBDDFDFFDF@@BD@@@@AF@BAD@@@D@B@BB@DDDB@B@BBBBB@BBB@B@B@@@D@BABA@@@BDBB@DBB@BBBDD@B@BB@BDBB@B@D@BA@@@AB@B@@AB@BA@@BB@BBBDB@B@BBDBBBB@@B@@BBBDBB@@HBB@BABDBBRCL@NANCJELKLAJ@NANAN@`BPALGPEJEBABAB@B@@@BA@@B@@@B@@A@@BAB@@AB@B@BAB@@@B@@@BA@@BA@ABADA@@BA@ABAB@@AB@@@@A@@@C@C@@@AB@@@BAB@F@B@B@@@B@@@BABADABA@@BA@@@A@@@@BA@A@@@@@AB@B@BA@@@@@@B@@AA@@A@A@@BA@A@@BA@@B@@@B@B@BAD@B@BAB@@@B@@@@@@@@@@@@@@AA@AAA@@@@AA@@A@@@A@AA@@A@AA@@A@@@@@A@@@A@@BA@@@@@A@A@@BA@@@A@@@@@A@@A@@AA@A@@AA@AA@@CA@@A@A@@@A@@A@@AA@@@C@A@A@@@A@A@@@AA@AAA@A@A@AAAGBK@IFIBIDG@IFKDGNUFKROHGHGDEFEBA@MEEKOECEI@C@KDGHEHEH@F@JAFG@EAEBMCMGICEIKDEBEDGPMJCHAHEHCBAB@B@BA@@BAD@@A@@B@@AA@@A@A@AB@@CB@BAD@BA@@BA@AB@@AB@B@@@@A@@@A@A@@@A@@@A@AAA@@@A@@@A@@@AB@@@BAB@BADC@@BA@@@C@A@@@@BA@@BAB@B@BAB@B@@ABA@@BA@A@@@A@@@@@@BABA@AB@@A@@@@@@A@@@AAA@A@@@A@@@AAA@@AA@@@A@AA@@A@AA@@A@@@AA@AA@@@AAAAACAA@@AA@@A@@AA@@A@@@A@AA@@@@AA@@A@@A@@@A@@@A@AAA@A@@@@@@@AAAC@A@@@@@@@@@A@@@@BCBA@@@A@@@A@@@@B@@A@@B@B@@@B@B@@@@A@@BA@@BA@A@@@@@@@A@@@A@A@@@@@A@@@@@A@@@@@@@AB@@A@@@@DAHGFCDGBEBMAIDCFEFCHCNAT@T@J@DAD@DEBADC@GDKBIJIFCNKFELI@A@@@@@A@AA@A@@@AA@@AEAAA@A@A@A@CBA@EAAA@@AA@@AAA@C@CA@ACCAA@AAC@AAAA@CC@@@A@@@A@C@C@@BC@A@CAE@A@@@CFCDAB@DC@@@A@A@@DA@A@@@ABAB@B@BA@A@@BAB@BAB@@AB@BADCD@BAD@@A@AB@@@DA@@DC@A@CB@@AFEDAB@DA@@BABABC@AF@@ABA@AB@D@DAB@B@BAB@@@@A@A@A@@BEBABAB@B@@@DCDCB@@ABA@@BA@A@@BC@@@ABABCB@@CBE@A@A@@BADCB@FAB@BA@@BA@ADABABADA@@F@B@FAD@BABBB@BAD@@@BA@@@G@@@@DA@@BA@A@CAA@@@ABABAB@FABABADABA@@BA@@AA@A@@DA@@F@B@@@B@@ABA@@B@@A@E@A@@@ADA@A@A@@@C@CAA@@BABC@AB@BA@A@ABAB@AC@AA@AAAAA@@@EAA@AAAAAA@@A@A@ABC@EBABA@A@C@A@AAAAA@A@CBE@A@EAE@ABA@@@ABC@A@C@A@@B@@@DAB@B@@AB@D@B@@ABA@EBA@AB@@AAAAA@A@A@A@@BCAA@AACBA@C@@@ACA@A@ECA@C@@@A@AA@@@AC@AAG@G@A@@@CAA@CBA@@@A@EBAAAAA@GAA@A@@@ABEBA@CBA@A@C@@AA@@BA@E@C@A@@@C@ABA@A@AAA@GAA@@BA@A@@AA@@@A@@@A@A@@AA@A@AA@AA@AC@@CCAA@A@ABA@@@C@@@ABABA@A@C@AAAA@ABA@@BABABA@ABE@A@A@CDA@AB@@AB@@@BABA@AD@@A@A@@@AA@@A@@@A@CBA@A@AAA@CA@AABABA@A@@@@B@@@B@B@@ABABAB@BA@@B@B@D@@@B@@@B@@@B@@CBA@ABAB@BA@@B@B@@@@C@@@A@@@@@@B@BA@@BC@@B@B@@@@A@A@A@@BABA@@@ABC@EB@@@@AAACAAA@C@AAACA@GC@@B@@A@C@E@CA@@AAACAAABCA@@A@@@A@A@A@@AC@AFABA@A@@@@CAECA@AAA@CAAA@@AA@CA@AA@E@A@A@@@AAAA@AAAAC@AAABCBCCC@A@A@@@@A@A@@@A@@@AB@@A@A@A@@CAAA@A@@CAAACA@@EA@@AA@@A@A@@AAC@AAAECAA@@AA@@@A@@BA@@@@AAGA@@@@@A@A@@AA@@CC@@@A@AAA@AAA@A@AAAAA@@CA@AAA@@@@DC@C@E@@@CAAAA@A@A@@@AACA@@@AA@A@@AAB@@@AC@EACACAC@@@E@@@AA@A@A@@@C@@@@@IBA@A@A@@@A@EBCBA@A@@BA@@@C@A@CBA@@@@@A@A@AA@@@AA@AAB@@A@@@@@@A@@AAACECCA@@AACA@A@@@A@CA@@CCA@@AC@AAC@@@AA@AA@BA@A@CBC@ABABABADABCB@BA@ABABAAA@@@@CAA@CAC@C@A@A@C@C@A@C@A@A@A@@BA@@B@B@@@@A@@@A@CACAC@A@CAAA@@ACA@AAC@ECAAC@AA@@A@@A@@AA@@@@@A@AAA@A@CA@@AEAA@E@A@C@@@A@@AA@AAAAA@C@IAA@AAA@@AC@@@ACA@@@A@AAC@EAA@A@ABA@@AA@AA@@AA@@AA@BA@@@@BA@BBBB@@@B@@@D@B@@@BA@@B@@A@@@@A@@AA@@A@@D@@@@A@@A@AA@@@@@A@AAEEA@@@CA@@@@AAB@BA@AA@AA@AAAA@ACAA@@AA@@@A@@A@A@AA@AA@AA@A@@@A@AA@CBE@@@C@A@@A@@@A@A@@@AAAA@@@ABA@A@AA@@E@A@C@AAA@A@AB@BAF@BA@AB@B@BA@AB@BAB@B@D@B@BAB@B@BCD@B@@@B@@A@@@CAC@A@A@@BDB@B@@BBAB@@@@EBAB@@A@C@@@A@@@EBABC@C@@@A@AAA@@@EDA@A@ABC@A@C@@@CB@@ABA@A@C@A@CAA@@@ABA@A@@@@@ABA@ABABC@ABC@KA@@A@ABAAAA@@@A@AA@AAA@CCAAAA@@AAA@CAAAA@@A@A@@AAAAAA@@@AAA@AAACAAA@@ABC@A@@AA@@@G@ABA@AA@@A@A@EBA@@@AEA@AAA@EAE@A@@@AAA@C@GBEDCCA@GAA@CAIA@@C@CA@@ACAA@A@A@CAA@AAE@AA@@A@A@C@@CE@@@@@ABC@@@CAC@AAA@@C@ECA@C@A@EBA@CB@@EAC@A@A@EF@@A@C@C@CAEAAAECAACACAAA@CAAAA@@C@@@C@ACAAAAA@@AA@@C"],encodeOffsets:[[118544,41515]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
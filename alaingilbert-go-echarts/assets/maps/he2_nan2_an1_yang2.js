@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("安阳",{type:"FeatureCollection",features:[{type:"Feature",id:"410502",properties:{name:"文峰区",cp:[114.357082,36.090468],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@E@@@@CA@@@@B@DEA@@E@@A@A@@@AEB@AA@C@@@@BAA@BE@A@C@@FA@@DC@@AA@@B@@E@@ACA@@C@@BE@AB@@@@A@E@G@A@@@@IC@@@@G@@G@@@@C@@KE@PBR@JD^@HBF@@@B@J@@@H@D@FBFBD@H@D@@@BCHELCFAFELAFAD@@@B@B@F@D@D@J@B@F@@@D@@@B@D@BAF@H@BAH@D@BAJBBABF@H@@B@B@D@@@@BFFAD@F@@@F@H@H@@@@D@@N@F@F@@@@JBB@@J@J@B@L@D@@@B@J@@@N@J@@G@@@C@@@K@EH@B@@@D@H@@AD@@@@@@CD@@@F@AA@@B@@@B@AEA@@A@@AG@@AC@@B@@AB@@@@@@AB@@C@@B@@BB@@A@@@CF@@B@@@BB@@@B@DAF@@A@@@@@CB@@BB@@BBA@AFABD@@B@@@@@AEDAACD@@CB@@@C@@EA@@CEB@CJAB@@ABA@@FA@@DA@A@@@@BADAB@FAJED@BAHCDADACB@AD@@@B@DEB@HC@ADC@B@@@@DC@ADAB@@ADAB@@BDAD@B@F@FA@@D@HAHA@BBD@@@@FA@AAIB@@@@A@CJA@A@A@@@A@A@A@C@C@@BCBABC@A@@@C@A@A@@A@A@@IAA@A@AA@@@@AAC@@A@A@@@@@AC@CAA@@@@C@@@A@IBC@C@@@@@@AA@@@@@A@A@A@A@A@A@AB@@G@AB@@ABCDAC@@@@A@@B@@@BA@@@A@@@@EA@AB@AA@A@A@A@ABAC@@@@E@@@A@@BAAA@A@@@@@A@AB@@@@CCA@@@A@A@AAE@@@A@@@@B@@A@@@AAC@@@C@A@C@@@@@ACCBAABA@@@@IEE@@A@@G@@@@AG@C@@BE@@BGBABEA@@@G@@C@@@@C@A@@@@E@@@AAAA@AA@@@A@@A@@@EC@@C@@K@A@@@A@C@"],encodeOffsets:[[117152,36831]]}},{type:"Feature",id:"410505",properties:{name:"殷都区",cp:[114.303553,36.10989],childNum:1},geometry:{type:"Polygon",coordinates:["@@BAAABI@AG@I@A@G@A@@D@D@@@F@D@DB@@@A@@HI@@@S@@@M@A@@A@I@G@G@@@AC@C@@FOBC@@@@F@B@@A@@@@@A@ABC@@BA@@BA@@BA@@BA@BDA@A@C@@@@A@CC@BDG@@B@@@@@@A@@@A@@@C@@B@@AEA@AAA@@C@@@@@@@D@@A@@@@B@BC@ACA@A@A@@@AC@@GBAB@DA@@B@@@BE@AB@@C@K@C@@@AEA@CI@@@CA@@FG@@@@D@DB@@FG@BBB@@B@@A@BFA@@D@B@@@@@FA@BFA@BFB@@BDB@@@@BF@@BFBB@BB@@F@D@FD@@BD@@B@BB@@@B@F@D@D@@@B@@B@@B@@FB@B@FA@AAAB@@@@B@B@@BDF@@@@B@@@F@B@@@@B@@B@@@FB@BAB@B@@@B@A@@BA@@@@DA@@@@@C@@@B@@DB@@@@@@@@BB@@BB@@D@@BA@@D@@D@@@A@@D@@CFD@@F@D@DB@@@@D@@CB@@@@@B@@@BF@A@@BAB@BB@B@@@B@@@BB@@@B@@BA@@B@BB@@@@@@B@@@BB@BA@AD@BBBB@@@@@@@@@C@@FAD@BC@@B@@@B@N@DA@B@B@@DAAB@@@B@H@B@B@@@@J@@A@@B@D@F@@@@B@@F@@@@@@CRA@@@EF@@IAKAE@@@A@@@G@CBG@A@A@GBCBCBI@@BIBA@ABE@A@E@@@M@@@K@A@@AK@A"],encodeOffsets:[[117083,36965]]}},{type:"Feature",id:"410503",properties:{name:"北关区",cp:[114.355742,36.10766],childNum:1},geometry:{type:"Polygon",coordinates:["@@N@@BJ@@@BBBA@BH@@BH@B@F@@@BDB@B@@A@@ACHA@@DA@AB@B@@@@@@A@@@GC@@CD@AC@@B@ACB@@C@@B@@A@@AIAAAA@A@@@CCA@A@A@@@@@A@@F@@A@A@@@@B@@@B@@@F@B@@@@B@@B@@@D@D@@@@B@@A@@B@@B@@@CB@@D@@B@@B@@@B@@AA@A@BAB@@C@@@@@@AA@@@A@@@AA@@@B@@ACA@A@AB@@A@ABA@CBC@AB@@AB@F@FB@AB@BA@AB@AAAAAA@@@A@A@ABAB@BAB@BB@@B@AC@ACB@A@@@@AA@@@AA@@@A@AI@A@@AA@@AIB@AC@@C@@D@@@@C@@BG@C@@@A@G@@F@L@@@D@@@HI@M@@@I@A@@@C@K@A@I@I@@@AA@I@@E@E@M@@@@C@@G@G@E@@@E@C@EBAE@@@@@C@A@AG@E@@BBL@@@B@L@@@N@@@F@BAF@BABAJ@@AJADAD@H@B@BAH@D@H@@@B@@BFBL@J@FP@@@F@BIB@@DB@@@@B@@H@AK@A@AD@@@@@D@@A@@F@@@@@BD@@D@@@@@BBBFB@BAN@BAJ@@A@@"],encodeOffsets:[[117123,37035]]}},{type:"Feature",id:"410506",properties:{name:"龙安区",cp:[114.301331,36.076225],childNum:1},geometry:{type:"Polygon",coordinates:["@@@IAQ@O@@C@@@@D@@A@@BAAA@C@ABA@@@B@AF@@@@ABA@@AAH@@@@@G@@CDA@@@@BC@C@BH@@@@AB@B@@A@A@IAAB@EA@@@@BA@ADA@@BA@@B@@A@@C@@E@@A@@A@A@@BC@A@@@@B@@@@A@A@A@@@@A@EA@@@@@C@@@@@ABABCB@@@A@@A@@@@BE@@@@@@@C@AF@@AA@@C@@@AB@@A@@@A@@@@A@@AB@BCB@@@@AA@A@@A@@@A@@@@@AD@CAAC@C@AA@@A@@@C@@AA@I@A@@@BA@@@@@@@@A@@@A@@@D@@AE@@@@@E@A@@D@@A@@@A@@@@@AB@@@@@@@@CBAA@BA@@@@@@BA@@@AA@@@@A@AA@@AA@@A@@B@@A@@@C@@B@BA@AA@@@@@BA@@@@@AA@A@@AB@@@B@@@@A@BB@@AD@@@@@@@AA@@@@B@@@@A@@@@B@@@@A@@@A@@@@BC@A@@@@@@B@@@@AB@AAB@B@@C@@AAA@@@BA@B@A@@BBH@B@@A@@AA@@@AAAB@@@@@@B@B@@B@@A@@D@@@@@A@@A@@@@B@@A@BFCA@@@@@B@@CB@@BFBB@@A@A@@@A@@@@AA@@@AAAACAA@AA@@AAB@AA@@@AA@A@@@@A@@@@@@A@@B@@A@A@@@AD@@@@@AA@@@@B@AA@@@@@@@@AA@@@@@A@@@@@A@BA@@@AA@@AAA@@@@B@AA@AA@@@A@A@@@@@@@AC@@@AA@@@@B@@A@@A@@A@@@C@AB@@@@A@@BA@@@@@C@@@@@AD@@@@A@@A@@A@@BA@@@@DA@@@@@A@@@@B@@A@ABBB@BA@@B@BA@@@CD@@@@BB@@@BA@@B@@@B@@AA@@@B@@@@ABBBA@@@DB@@@B@B@@BB@@@B@@@@B@BB@A@B@B@D@B@@B@BBA@@@@BB@@@B@@@@C@@B@@@@@@B@B@B@BA@@BB@@@@BA@@@BB@@@B@BCH@@@B@@BB@BA@AF@B@D@@@@B@@@B@@B@@A@@B@@AB@@@B@@@B@@DA@A@@B@ABB@BB@@@B@B@B@@A@@B@@@@A@@BB@@B@@@@A@A@@@@BBBBB@@@B@B@@@@A@@@@B@@AB@@@BAB@BA@@@@A@@@@AA@@@@@@@@@AA@EBA@A@@@AA@@C@@AAA@@@@@AC@@@A@A@A@@@EA@@A@@B@@@B@B@@@B@AACEDEDMFCBCDKH@@ELJN@@A@A@@@@AAAC@ADBB@B@@BBC@CDA@AAEBAA@BDBB@AB@@@@@@C@@B@B@@@B@@C@@BE@S@@@@B@B@DBD@FB@BBAB@BGH@B@@@B@@@@@@B@@@B@@BAB@@E@@@@@@@F@@@@B@DABB@@@@BB@@A@@BA@ABA@FD@@@@B@@B@@@@@@A@C@@@@@B@@B@@A@@@@@B@@H@@@@AB@B@@AB@@A@@@@@A@@BBB@@@@@BA@B@@@@@@@A@@B@@B@@B@ACB@B@BAAA@@B@@@@@@BDBB@B@B@@@D@@B@@@FAD@@LA@@@BB@D@B@FAD@@@@@@@B@@@@@DAD@@@@BDDBDDDB@@@B@BADBJ@@@@AB@@C@C@@B@@AFA@A@@F@@C@@C@@AB@B@@A@C@@A@C@@A@A@@A@@@A@AA@A@@@@@B@@@A@A@@@@@@B@@@@E@@B@BB@BH@BA@@BA@@D@@B@@H@BAB@@BB@@DB@BA@@BAB@@AA@@AB@BAB@@CB@BC@@@BB@@@@@B@@A@ABAB@BABA@@@A@BB@D@@@BDB@@A@@B@@BBA@@@@B@@FADB@BCB@@@@B@B@@@@@B@@B@@B@@A@@@@B@@@@@@@@BDB@@BB@@@B@@@AEB@@DD@@C@A@BB@@BB@F@@DFA@FB@@BB@B@@@@@BBDBBB@@BB@@AB@@@@H@@EB@@D@@DJB@BF@@D@L@D@@@BAF@@A@@@AB@@CBAHA@@BD@@B@B@B@BDD@@A@A@@B@@@@C@@@@@@@DB@BBB@BF@@@AD@@@B@@@B@@@@@@@@AH@ACD@@D@B@@D@B@B@ACB@@AB@@AB@@AB@@AD@BAB@@@@@B@@@@A@E@@D@PA@ED@D@@B@@@H@H@J@BB@N@@@T@@@J@@GB@@@A@@C@C@E@@@C@CB@H@B@J@H@@CBG@A@GBE@A@C@A@@@C@@@E@A@I@C@C@E@A@A@@BCBEFKBEDEFKDG@A@@@C@GACAE@E@C@G@@@I@A@@AE@GC]"],encodeOffsets:[[117094,36843]]}},{type:"Feature",id:"410523",properties:{name:"汤阴县",cp:[114.357763,35.924514],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@C@ABC@A@GB@BB@@@CB@@@B@B@@C@A@@@AJB@@BBB@@B@B@J@@F@@AB@@AB@@AB@BAB@@@D@BA@@@E@@@A@@B@@@@@@AB@@@@@B@@@@@D@@@@@BA@A@@@@D@B@@A@A@@@A@A@@@A@A@C@@@A@@@BB@BA@@B@B@F@B@@AB@@A@BB@@@@@@@F@B@@A@CD@@A@A@@BAD@BAF@B@BAB@BBB@@@FAB@@@@@B@@@B@@@@BB@@AB@@@B@@D@@B@B@@A@A@@@ABABA@@BAB@B@@B@@@B@@AB@B@@@@B@B@@@B@AAB@@@FD@@B@@@B@H@B@B@@C@@AA@@@ABA@@B@@BBA@@@@@@@@@@@@@AB@@@@@DB@@BB@B@FDBB@@@@BA@B@@@B@@@@@@BBB@@@@B@@@AB@BB@B@BB@DAF@B@@@@B@D@@B@@B@@@BB@@@@B@@@@CB@B@BA@@@@B@@B@@B@@B@@@@@B@@@@@BB@@@@@@BBB@B@@@B@@@@AB@@@@@B@@@BA@@@@B@@@@@B@@A@@B@@A@@B@@@@B@B@@B@@@D@BBAD@BB@@@BA@@@@@@@B@@B@@@@@BA@@B@@@DBB@@BA@BB@@B@B@@AB@BBA@@B@B@@@@@@@B@@@@D@@B@@BB@@BB@BB@@@B@@B@DD@@@D@A@@BB@@@D@BB@@B@@@@@@B@@DBA@@B@@D@@@@@@DA@@@@@A@@AC@A@@@@B@@A@@@@BC@A@AAAB@AA@A@E@A@A@ADCDA@@B@FBL@BBDBB@BBBD@B@@B@B@D@@@DC@@@BB@B@@@DBB@@BBB@BAD@D@BBD@BF@B@D@B@@@@@@@@BABA@AB@@A@@@@@ABADAB@@@B@@A@A@@B@B@BBB@@@@@@@@BAB@@B@@BB@BB@@@B@@B@B@ADCB@BAA@@AB@@@@@B@@A@@B@@@BAB@@AA@@@BA@E@@@@@@@FDJB@@@@@FBB@@D@B@@@@@@FD@@BD@@@@@BF@B@B@@D@B@@@@@@@ADAB@@@B@B@@@BA@@@A@@@@CC@@DA@@@@B@@AAC@@@A@A@A@@@A@ABA@AA@@AAACA@A@@@@A@A@@@AA@@@@@AA@@@@@A@A@A@AAAA@AD@AAC@@@@A@C@C@A@@BA@@@@@@B@@B@B@@BB@@FAD@@@B@BA@@B@@@@D@@@@B@@@B@@@@@B@@@@@B@@A@C@A@@@@D@DBB@B@@D@AB@@@@@@B@B@BB@@BD@@@BAD@@@B@@@BBB@@@@DA@ABA@@@B@@B@@@B@@@BA@@D@@@BB@@BED@@@@@@@F@@A@@B@@@@B@@DABABA@@@@D@@@@@B@@F@B@@B@B@B@@@@@@A@@B@D@@AB@B@@@@BF@@@@DB@@@@AB@@AB@BCB@@A@@B@@FBAJBB@B@@@@ABA@@@@AGD@D@@A@@B@DC@@@H@@@@BG@BB@BA@@@@BEA@@@B@BAD@B@BB@AB@@@@C@@D@@@LF@@@D@@H@@@@H@@D@@J@@B@H@F@B@@@@@BAF@@AD@@@DB@BF@@@@AB@@BD@@CB@@ED@B@F@@ABB@A@@D@B@@BFA@B@@@B@BF@@@FB@C@A@@B@@D@@F@@@@AD@B@@@B@L@@@@DD@@F@@@BB@@@B@@BBBBB@@F@@@@@@B@D@@D@@@@H@@FBBAHA@AF@@AD@H@@B@@H@@@@BF@JF@@@@ABBBDABD@@@@D@B@D@@@D@BB@@B@@@@A@@B@@@F@BBB@B@@@B@DD@@@@BAB@@@@@B@B@BB@AB@@@F@@@@@BDBAB@B@B@B@@BBAB@@F@@B@@@B@@A@@@AB@@@@@BDDCBA@@BAH@@@BAB@B@B@B@B@B@@@@@B@@B@@@@D@D@JAB@@@D@@@@@BB@DBD@@@@B@B@@@BD@B@@@@TCB@LA@BHAB@@@@@BD@@B@DAFCB@DADBDBFBB@BB@@D@B@B@B@FBD@B@B@B@B@F@@C@@@@@B@@B@@@B@BBB@@@@@@AH@@@HA@CBAB@A@BA@@@@D@BB@@@@HAB@@BFA@BE@@@BBDA@B@@@BBF@@FA@BD@@@D@@BB@@AHA@DB@@@@DFA@B@BAL@@B@B@F@CIHA@FBB@DB@@@JAB@B@AC@@AG@@@@@A@A@@@@B@@C@@@@B@@@@BF@@A@@@@@@B@@BBABBB@@B@BBA@C@@@@BF@BA@BDA@@DB@DL@@@@B@BB@@DAB@DAD@@@AGD@AAAG@AC@@A@@F@@@JA@DB@@C@@BB@@@@BB@BBFB@B@@CL@@BJAXELAH@@@@C@A@CB@@@AA@@C@@G@@B@AC@@B@B@BA@@@@@@@@@A@@BA@@GBAED@@A@@AACACCAAIE@A@@A@AA@@@@@AB@@@@AIACAAA@@@@BABA@ABA@A@A@@ACAAAA@AACCC@ACE@@FAA@@@C@@AA@AC@@A@CAA@AAF@@AGB@@@@AC@@@AAA@@@A@@FACEEB@AAABA@@CG@A@A@@@@@AAB@@A@AB@@AAA@@A@@@A@@BCBA@@@C@@AAAA@A@@@A@A@@CAGM@@CBA@@@ACA@AAC@AAA@AA@@AA@@AAAAB@@@ACDA@ABAAGACA@@CAA@@AB@B@@@@@@A@AB@@AAAA@AA@@@A@@@EK@@ABAE@@A@@@BBA@AC@A@@BA@@@A@@@@AA@@C@C@@@@AAA@GD@@@B@AE@EA@@AA@CBA@AAAAA@AAA@@@A@AB@B@@AB@B@@ABA@AAA@AAAABA@@BA@A@@@AAA@@@@A@E@EBC@@@A@AA@ABE@@@@BAB@B@BB@@BB@BB@@@BAB@B@@@B@@BBB@@BBB@B@@@BAB@@A@@@A@ACAA@AAC@GAEACAEAC@CB@@ABA@AB@@A@AA@@AAB@@AB@@@@AC@AAGAC@A@CAA@A@CB@@CAA@EACA@@@@IG@A@@BA@@BAA@@A@@AAA@A@A@AAC@@AA@ACAA@@@JA@EA@@@@@F@@A@KBKDABE@@@ABCBABA@ABA@ABA@GAA@@@A@ABA@E@A@CBABC@E@@@E@C@A@@@AA@@E@A@@A@AA@A@A@ABA@@@A@@@@@@@@AA@@@A@A@AAGA@A@@BCAEAC@@@@E@E@AAC@CAA@E@A@@@A@C@A@C@ABA@@@@B@@A@A@@@@C@@@AA@@@@BAB@B@@@@A@@@@I@A@@A@@@A@@A@@A@@@AA@@@C@@@@AA@@CB@@CB@B@@CBA@@@@@@ACBAA@@A@@@@BB@@@A@A@@@@BA@A@@@A@BBA@@B@@A@@B@@AB@@@@G@A@C@E@E@A@@@@C@A@@@@EAA@C@AB@A@@@A@@@A@@@@B@B@@@@A@A@@A@GA@@@A@A@@A@@AA@@@A@@@CBA@E@@AA@@@E@G@A@@@@@CBCBCD@@A@@@ACA@C@A@A@A@A@E@C@C@I@A@@@@A@@A@@@E@A@@BB@@@ABA@E@C@C@A@AAAA@@A@@@@C@C@A@@A@AA@@@AAA@A@@@@A@@@@@@A@A@A@A@@AA@E@AA@CBA@@@@A@@AC@@@AA@E@AB@@@@@B@B@@@@G@A@A@C@A@@@@@@E@AA@EA@@EDA@E@@@@@@AA@A@A@@BA@M@@@A@@AAC@@A@@@@A@@BA@AA@@@@@AC@@A@A@A@@@@AAA@A@@A@C@@@@@A@@@A@A@@A@@AA@@AA@@@@A@@@A@@@@@AA@AA@ABA@@@@AA@@AA@@@@@B@@ADAD@F@BAB@@@B@B@@@@@AA@@BA@CBA@@@@D@@@@@@A@A@@@@AAA@@A@@A@@B@B@@@@A@A@@@A@@AA@@A@A@@@C@@@@@A@AA@@@@@A@ADAB@@A@@@@ACC@A@A@@CB"],encodeOffsets:[[117013,36620]]}},{type:"Feature",id:"410522",properties:{name:"安阳县",cp:[114.130207,36.130584],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@FAEIY@EECAC@YH@@BD@N@@BBBB@D@@@BA@@B@B@@@BB@HDB@D@B@FAFA@@B@@@B@D@BAB@D@@@BB@@@BBB@B@B@B@@B@@BBB@@@B@@@@@BC@CBC@C@A@A@A@A@A@@BA@AB@BAB@BBD@BBBBBD@BBBBB@@@B@@@D@B@@@B@BAFCDABABCBCBCBABC@@@A@@@A@CA@@A@@BA@@DCDA@@DC@@@@UO@@"],["@@@ED@@MB@BAFA@AD@@CB@@GB@@E@@@@@GFADADABD@BHA@GB@L@BA@@B@DANANAFBFBB@PBD@DEDG@ABC@C@CFEBCHATCB@D@RATBPCLEDEDCBCFG@AFCFE@ANGHAJCD@PAJ@F@L@BAH@HAHAB@HAHGJGBCBABA@@DAFAAADA@@DABB@@@C@@B@@DH@LA@EH@@EHA@CF@@A@@F@@@@@JA@A@E@@@E@@@AB@@AF@@A@@@@B@@A@@@@@@@AC@@@A@@CA@A@CBAG@@@CAADAB@BADABBBBB@BABDJA@DB@@BF@@@@B@@BA@ADA@BB@@@B@@A@@BD@B@@@DDB@CBB@DH@@CJ@@EB@D@@ABDHA@BD@B@@D@BFB@@F@@DB@@B@@@D@DFB@B@@B@@@N@@DD@HA@BB@@BB@B@N@XAF@ACB@B@D@@DB@D@NA@@BJBFJ@BFD@D@AEJ@D@FA@AD@AENAB@BB@FF@@FDB@BH@BB@@BBDHBBNA@CDA@@HAB@ACDA@AD@ACDACC@AD@AAB@AA@@@@JABA@ALAAEFAAK@@@@AK@@A@CI@@C@A@CI@A@@CK@@MBA@AG@@EBA@AG@@F@@ACG@A@@FA@@HAD@AEBAAG@E@AACE@@AKBCG@AA@CIA@A@@BA@ACB@GUDAAC@A@@@AC@@CBA@AD@@AB@EIAEACABACACC@@AA@AA@ACBCE@@A@@C@AB@B@B@@@CIEB@ACBCK@@@@@A@@@A@A@A@@ACAEAI@@A@@A@EG@KBWFIB@AK@@DA@A@AE@AAA@@@@AA@@@DA@@CIB@@E@@@@BD@@BBHBBC@BH@@C@CBA@CB@@AAA@@@@@CKA@@CB@ACB@@AAE@@@@@DAB@A@AA@AAAB@AA@@@@@@@@BE@@A@@A@@@@@@DA@@@@@@B@B@@@@BH@@BDA@A@IB@@A@@CAA@EGBDJE@A@A@@@BK@A@AEB@C@@A@@CGB@BA@@AC@@@C@@AEB@@AE@A@@@ACBAA@@F@@AEB@AA@GB@@@@AAC@@@@@ABB@A@AB@DGB@@G@@B@@@@A@AAA@@@A@@@@A@@@@@DE@A@A@A@A@C@EAA@A@A@C@@@AAA@EACACACBA@EDCBA@@@AC@@@@A@GB@AKBA@SD@@B@@B@BBB@JB@B@@@@B@B@D@@@BADABAD@@@D@D@B@B@B@@@B@BIB@D@B@@A@BJ@BEB@@@@AC@AGBGBC@@@EBE@A@C@CB@AA@CB@BA@CB@BCD@@@@@ACD@BGDA@CFA@@@C@@BDACBCBGDABC@IFEBA@CBAB@@@@@BCB@@EB@@AB@BA@IB@DFA@DB@@FD@@@A@@DC@BDCBBF@@@@A@@@ACEB@BAB@AA@@AA@@D@@@@@BE@CBA@@@A@@A@@@AE@@D@@@BA@@AA@@@@DA@@B@@@@A@@BA@@@BD@@BH@@@BB@BFA@@@A@@@BBE@@@@@BDA@BJ@@BB@@@BBJB@@@B@@B@@BB@@@@@BDA@BBDA@@@AAA@ABA@AB@B@B@B@@BBBBBBA@@BABA@@BEAE@A@@BA@@BAD@DAB@B@BA@@B@BDB@BA@@@B@@B@@@B@@BB@@@@@@@DA@ABB@B@@BA@@@A@@@@AC@@@DA@@A@@@@AB@@@@A@@C@C@@@A@@@@A@@A@E@@@A@@@A@@@@@@B@BE@@@@B@@@@@B@BDB@D@@@BBBBBBJ@@@BA@@@@DA@BDA@@@BDC@@DD@@H@@@B@@@@A@A@@BCB@@GBBD@@@BA@A@AC@@E@A@G@@AG@@AABAA@@I@@AM@@@@BI@ABM@ABA@AEAA@@@@C@@@AC@@@@E@@@@BC@@@@@C@@B@BBLG@@@@A@@A@@CA@AJE@@@O@@EE@@F@@QB@D@@@@E@@@@A@@E@C@A@@@@BI@@@@@@A@A@G@A@@BACB@@@A@ACBM@A@@@A@@@ADC@EB@@@D@@@@@@@@AAAAC@@BABA@@A@@@A@@@@A@@A@AB@@AA@@@A@@A@@@A@@@AAAA@AB@@@BAE@@A@@@@@A@@DC@@@@@CAC@E@@@EC@DC@@@@B@@@CC@@@AB@@@CA@@AA@@A@@@@@@A@@CA@@@D@@@@@B@@C@@B@@AB@A@@@A@A@ABA@@E@@@AA@@@@@@A@E@@@A@@E@AC@@@A@A@@A@BB@BEBA@A@@EA@@@@AA@@@C@C@E@A@@@A@@A@AC@@AC@@E@C@EA@@AAAAE@@AE@@@@CA@AA@AEB@AEB@@E@@@@@A@CB@AEB@@@@AA@AAH@@EA@@C@C@@BA@@AA@@AACAAA@@@@A@A@@AA@@EEB@CE@A@@AA@@A@B@DC@@CA@BF@@A@@@A@@AA@AC@@@@@@@@@A@@B@@@@AA@@@@A@@@@@A@A@@A@ADA@BC@EA@@@@@AB@AA@@@@BA@AC@@C@A@@A@B@@ABABA@AB@B@BA@@@@@A@@A@@ADA@@DA@ABA@@BB@@BA@AB@@ABA@@CA@@AA@ABG@@@@AC@@@AB@@ABG@@AAAA@@@@F@@A@@@@@@@@B@B@@@A@@@@@BBBB@@@B@@@@B@BD@B@@@@D@BA@A@@BD@@@@DE@@@@BEB@BA@@@@D@DA@@B@@I@CAABA@@@A@CCACCC@A@@C@CB@@@@A@@@@@@@C@EBA@C@A@@A@@KB@@BC@E@@@AC@@@A@A@A@CA@A@@@@A@@@BBABA@A@BDA@@@@AA@@@@B@@@@@@@AAB@@@@A@AA@@@B@@@@@BA@@BA@A@@B@@G@@@@A@@@@@BA@@@@A@@@@@D@B@@@@A@@@@A@@C@@EAB@BAB@@@BA@@A@@A@BA@C@A@@E@@@@@@@F@@@BA@AA@@@A@@@@@@@@A@@@AHG@ABAAAA@@EAC@C@A@A@@T@F@@AD@@@@A@@@A@AD@@@@@@@BAA@CA@ABBFABBB@DCD@AA@@@AAABCD@BB@B@@B@B@@@IMFK@@LGDCDANEFCFCA@ABA@@@ACAC@A@@A@C@@@@A@@B@@@@AA@@@@A@@@A@@@@AA@@AA@ABBBA@@@@AA@@@A@@A@@@@B@@C@AA@@@@AA@B@AA@@@AA@@@@BA@@@@@@@@A@C@EB@@AA@@A@@@@@A@@BA@@@AA@@@A@@@BA@@A@@A@@@@@@AC@@@CB@@@A@@@@@@@A@@A@@BA@@AA@@@@@@@@@@@@@@ABAA@@@@@@@@C@CA@@A@@@B@@@AA@@@A@@@A@A@@@@@B@@A@@@@AAA@A@A@@B@@A@@C@@C@@A@AAABA@ABABC@@@AAA@A@AAECGA@AAA@@@@@AC@ABA@@AA@A@@@AB@B@@A@@@@@A@AB@@AD@BA@@@E@@@@AAEAGAA@A@C@ABC@A@A@@@@A@A@A@@AAAAA@C@A@@@@A@@@@@@@AA@@@@@@@ABA@A@A@@@AA@@AA@@@@BA@@@@@@C@@@@@A@@@AA@@@A@@A@@@@B@B@@A@@A@@@@@B@@@B@B@@A@@@@@@@@@AA@@A@@@@A@@BC@@@AA@AAAA@@@ABA@A@@AA@@BA@A@@@@@@AA@AA@A@A@A@@BA@ABAB@BC@@@@@@@@@@@C@ABA@@B@@@B@@@@CBCBA@@BB@@BA@@@@@@B@@@@@@AA@@@CA@@@@@A@ABA@@BA@@@A@A@A@@A@@@@A@@@@@BB@@@@@@A@A@A@A@@@@@AA@@@B@@@BBB@@@B@@ABA@@@AB@@@@A@AA@@A@@@BB@@@B@@A@A@CCA@@@@C@@@@ABA@@@@AA@@CA@A@A@AA@@@@@A@AA@A@A@@@@@@B@BA@@@C@ABC@@@@B@@@@@B@@@B@@@@BDDB@@DF@@DBBB@@@@@@@@AB@@@@C@ABAB@@E@ABA@A@ABABABCBA@A@@@A@@@@@A@@B@BBB@B@B@@AB@B@@@D@@@@@B@AA@@@A@@@A@@@@B@@@D@@@B@@B@BBD@B@@B@@@B@@@@B@@@B@B@@@@@@B@@B@@@B@AB@@@@@B@@B@@@@@@B@@B@B@@@B@@D@@@@@BAB@B@BA@A@AB@@@@@D@B@B@D@@@BA@@@A@@@@B@D@@B@@@@@C@@@A@B@DB@@@@@@@BA@C@@@@@BB@@@@@@A@@@AB@@@B@@A@@@@@@B@@@BA@@@AAA@@BA@@@@BB@BBBB@B@@@B@@A@AB@ACACCA@@@A@A@A@@@C@@@A@@@BB@@@@BB@@D@@B@@@@@B@@@BB@B@@@@BA@AB@@@@@BB@@BBBBBBBBBB@B@B@B@D@@@@@@B@@CBA@A@A@@@@@A@@B@@@BBBBD@@@B@@@B@@@B@@@@@BAA@@A@@@@B@@A@CB@@AB@@@DA@@B@@@@B@@@@B@@A@@BA@@@@@@B@@B@@BA@@@@B@@@@@B@@B@AB@@A@@@@BB@@@B@@@B@@@@@@@@@@BB@B@DB@@@@@A@@@@BBABB@@@@A@@@@@DB@@BDBBB@@@@@BBBBBB@B@BB@@D@@@B@@BD@@@@BB@@BB@DBBB@@@B@@@@C@C@CB@@@BB@@B@B@@@B@@A@@@BBBBBDBF@@B@BBB@B@@@@B@BB@@@@@B@B@@@A@AB@@@@DBHD@@@B@@@@@B@@@@E@@@@@@@F@@B@@CDAB@@@@BB@@AB@BA@@@BB@@C@A@@@@@@@@@@AA@CBEBCBCBABAB@DABABA@C@A@AAA@@@@@@B@@@BBBAB@@@BABABAB@@A@@@@@AAA@EAC@C@@@A@@@@BDD@B@@@@ABA@C@A@A@CBCD@@@@BB@@A@@@@@A@@@@@A@@BA@A@@@@B@@@B@@A@A@@@@A@@@@EDA@C@A@@BA@@@@BB@@@A@@@A@@@@B@@E@@B@B@@A@AB@@@@D@@B@@BB@@B@@@@DD@@@@B@@A@@@@@@B@@B@@@B@B@B@@@@@B@B@@BB@@@B@@D@@@@B@@@@@B@@BA@@@@B@@@@FAB@BB@@@B@@ABB@@B@@@B@@@B@@B@B@@B@BB@@B@B@D@BHF@@@B@@B@@@@D@BB@B@AB@@@B@B@B@@B@B@B@B@@B@BA@@@BB@BBD@DB@BDH@BB@BBB@@B@@@@@AB@@@@B@@B@@@B@@A@A@@@ABA@@@A@@@@@@B@BDAB@B@B@@@@B@@BB@@@D@@@B@@@@A@ABA@A@AB@@@@BB@B@D@B@@@@C@@B@B@@@B@@@@A@AA@BB@ADB@@@@B@@A@AB@@@@@BAB@BBB@BB@@@@B@@@@A@@@@@@BA@DB@@@BB@@B@@C@@FB@FBADC@@BC@A@@BA@@@BBABCAA@AB@@@@A@@@@B@BA@B@AB@@@@@B@BAB@BABB@@B@@F@D@@@AJ@B@@@BB@@B@@@BEB@@@B@@@@AB@BA@B@MTDHML@DDFNIATHHLKVH@LFBMRAHOD@AM@OLNL@@BCBA@ABAB@DAFAD@DAB@@BB@@B@B@BA@AB@BAB@BAB@DBDBDBBDBD@D@B@@@B@@BB@@B@B@BAB@@ABE@A@A@A@@BA@@B@B@B@BBD@@@B@@CBABA@A@A@AA@@@AAAA@A@@@C@A@A@A@@BAB@BADAD@DAD@B@DBB@@BBB@D@D@FAJEFADADCDABABABA@A@@@@BA@@DABAB@@@@@BA@@D@@@BBDB@@BB@BBDAD@D@@BBB@FB@BB@D@B@B@B@BADAB@BABABCBC@A@A@A@A@C@A@AACACCCAAAAAA@A@A@A@A@CB@@@B@@@@BB@@B@@F@J@DBD@@AD@BAB@B@BBDDDB@BB@D@D@BA@@BABA@CBC@E@AB@BA@@@@@@BA@@@@A@@A@@A@@@AA@AAA@@@@@@AA@@@@A@@@@B@@A@@@A@@@@@@@AA@@@@A@@A@@@@A@@@A@@@@AA@@@@@A@@A@@A@B@@A@@@@B@@@@AB@@A@@@AA@@@@A@@@@A@A@@@A@@@A@A@@@A@A@A@@@A@CA@@A@@@@@@@B@@A@@B@@@@A@@B@@@B@@@BB@@@@B@@@@A@@@@BA@@@A@@@@AA@@@A@@AA@@@@DA@A@@@@@A@@@@@BB@@AB@@@@@B@@BB@@@@A@@BABA@@@@HE@AB@@A@@DA@AB@@@@@@A@A@@@A@@@@@AB@@@@AB@@@A@@A@@@@B@@@@A@@@@@@@A@@@@@@@@@AB@@@@@@@NGHAB@@@@@D@B@B@B@B@B@@@BA@@B@AA@@@@BAB@@B@@B@@@B@B@@@@B@@@BBB@@@@B@@AB@B@@BB@@@@@@B@@@@@BBB@@A@@BB@@@@@B@@@@@@BBAD@B@@AB@@@@AD@B@@@B@B@B@B@B@@@BA@@B@B@@@@A@A@@@B@@@BB@B@B@DABBB@@@D@@@B@@@@BB@BABA@@@@B@@@B@@@@B@@@@B@B@@@@@@@AB@@@B@B@@BABAB@@@B@B@@@@@@BA@A@@@@B@@BA@@@B@@AB@@@B@@B@BA@@BB@@@@AB@@@@@@@BB@BA@@B@@@@@AD@@@@B@@@BA@CB@BA@@B@@@@@ABB@@@@@@@@@@@BB@@@A@@BB@@B@B@@A@@@BB@@A@@@@@AB@@@B@@A@AB@DAB@BA@@@A@@@@B@@@@@@@@A@@@A@@BB@@@BB@@AFA@@@@@@BA@@@@@A@@BA@@BB@@@@BAB@@@@@@@DAB@B@B@@@@@@@@A@A@@@@@@BDBFB@@A@@BB@@B@@@AC@A@@B@BBB@DAD@BADA@A@A@AAE@ACA@A@@A@@@@@@@@AA@@@@@B@@@@@@A@@@@B@@@@@AC@@BA@@AA@AB@@AAAAA@@@@A@@A@@A@@@@AAA@@B@@@@BB@@@@@@@AA@@B@@@B@@@@@@B@@@BDB@@@A@@A@@@@@@@BA@@@@@AB@@@@@@@B@B@BB@A@@AAD@@@@@@@BB@@@@B@@@@@B@B@@@@BBBBB@@@@@@@BB@@@@@@B@@B@B@@AB@@@B@D@D@B@@@B@BAB@B@@@@A@@B@BAB@@@@BB@B@@@@@@BB@@B@BBBBB@BBD@BA@@@@DAB@B@BDF@@@@B@@@DF@D@@ABDJ@@B@D@D@BCF@B@B@@@B@@@D@AABCBCFCDCDCFKBCDAD@B@DB@@DBFDFD@BBBBD@BBBDBB@DAB@B@HCDABADA@ABAHCD@PCHCFA@CBAF@JA@@B@@BB@D@H@D@B@@@@BA@@B@@@BB@@BB@BBB@B@BBFADAD@BABAB@F@B@@FBF@DL@TA"]],encodeOffsets:[[[116700,37198]],[[117090,37126]]]}},{type:"Feature",id:"410527",properties:{name:"内黄县",cp:[114.901452,35.971704],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@LABHJ@B@BJH@@@H@B@@AB@F@ACD@FA@DL@@DB@@BBA@CFAAAF@B@@CB@@@D@F@BAHA@AHAB@BDDA@FD@B@BFD@DABB@BFA@AB@@@B@@DH@@AB@@A@AH@@@@@@AN@@@B@BRC@BBA@BJB@@DF@B@D@B@ABF@BDNA@@B@BFBA@@B@@ID@B@BB@BNA@BBA@@B@BBB@@CB@@@@@@@A@AEB@HA@@HBL@@BJA@@DA@@C@AIAGAC@@CI@AAEB@A@B@AC@@FEBABEDE@@HCBAHCDGDK@C@A@AAECKACAGCI@ACQEO@A@AB@@@BAB@BAD@@@B@DBB@B@JABBB@AEB@@@@ABAB@B@@@ACAAD@@CHA@AFABBBAD@@BF@@@D@BBBAAAB@@B@@B@@@B@PCCEB@@BDAB@@BHAB@AAFAVG@@@AB@@@@@B@AE@GEAA@@@BAAA@BACCE@@@@C@AC@A@@AGA@DA@@B@AIA@A@@@BA@@DA@@@@BABD@A@BB@BBDH@@@A@@AEAA@ADDBFD@AEAA@@@@B@@CB@@DBAAAB@@EB@@GAA@@@@A@AA@AAAA@@@FACEA@@ABA@ADA@@AC@@D@@C@AB@@B@@@CB@@BB@@A@@@@@CBAAE@@B@CI@@@@A@@@BA@@@@E@@AD@@CEB@CD@@AAC@@@@B@AEA@@ABACE@@CGAEGBA@@A@@FA@@@AEA@EA@@@@A@@B@@@@A@CE@@A@AF@AE@EACHAAAD@@@@AB@AE@@@A@@D@@C@@B@BAAAD@AC@@A@@CC@CEA@@AB@@@AGC@AEA@@AB@@E@CB@@AB@AEA@@AA@ACA@@B@@@AE@@CB@AI@@C@CI@@BAAA@A@@A@@AAA@@BA@AA@AAAC@@A@A@@@@@A@@AA@@CA@@BA@AA@@@@@@@BA@@AA@@AA@C@@BA@@DA@@A@A@AK@@A@@A@@@AAGA@@@AA@@@@A@C@A@AD@B@@@@CB@@AE@A@@ADE@E@AAAAAAM@@D@@ABA@@A@@CF@BFH@B@FC@AB@@@@CB@@AD@@A@CA@@AFB@E@AGA@@B@AC@AC@CA@A@AACD@AGAMB@@AA@@@AA@GE@@AC@@@GA@@A@FAA@@CEB@AE@@AC@CCG@@DEBAA@EAE@@C@A@@CC@AA@AB@@AI@AEBA@@@@AAA@AAA@@@A@@@AAAEA@@AA@ACA@@@A@@@@@@BC@@BC@A@A@AA@AB@@@@ACB@ABA@AA@@AB@@AACBA@E@@@A@CD@@I@@@E@@@@B@@A@AACBABCB@FD@@@AD@@CF@BBF@@E@@@@B@@CB@@AF@@@@C@@@A@@A@@AF@@CD@@AAA@@B@@C@@@G@CBBDC@@@@@ABA@AA@AAA@@A@@E@@@KB@E@@@I@CTD@EB@@CB@@EF@AGH@ACBG@A@@AC@C@@GABG@CAA@@@AAAIAA@A@C@CC@CDA@A@A@CIA@CDAAE@EC@EEC@A@@DEBA@AB@@@D@@EB@@EC@D@D@BI@@@BDIB@BA@@B@@C@@BA@@CC@@@A@@@A@EAA@@AG@C@E@@@@BA@A@C@EB@BA@A@@B@@A@@B@@@FAB@D@@A@@AA@@@A@@A@@C@@@A@A@@A@AGB@AG@BDC@@@@BA@AB@@B@AB@@B@B@@@AB@@B@@@@BC@@@D@@@@BCBB@@@@@@@B@@@A@@BB@B@@@@DB@@BA@@@BBA@@@BB@BODIB@B@@GA@@IAWCA@@BMA@@@B@D@@@BA@KA@D@BI@AEG@@@AAIBA@AE@@A@ABA@@@ECA@@@CC@@AAC@@D@@@@C@@DC@A@@FGB@@OB@B@@AH@BED@@AHEBEBBB@BBB@BFHDH@DBAB@@B@@@BB@B@B@@@BBDDFD@B@@A@@BBBBH@FBDB@BB@@BB@@B@@@A@@@C@@B@@@@B@@@@B@@@@A@B@BB@@BB@@BBBBBF@B@D@L@F@@@D@B@@@B@@@BB@@BA@ADA@@@@B@B@@@@C@@B@BA@A@@BCDABA@EDAB@BA@AFA@@BA@@@BB@@@@A@@@ABB@@B@@@@ABCB@@@DA@A@@B@@@@C@EBA@@A@AAA@@@@A@C@AA@B@B@DA@@BE@ABA@A@AB@@AF@B@@A@C@EBA@@@@A@@A@@@A@C@E@@@@@CB@AA@@BCBAB@@@@AAAB@@ACAA@A@ACAE@AA@A@@A@A@CBC@A@CBA@@@@A@@A@@@A@A@@B@@A@@@@@AB@@@BA@@@ABAB@@@@@B@@@B@@A@@@@@A@A@A@A@A@@@A@A@A@@AA@BB@B@@AB@@ABC@@@A@@AA@@@A@A@@@AAA@@BA@A@@@AB@@C@BBA@AA@@ABAB@@E@A@ABC@E@ABA@@@AF@@AB@@@@AB@@AA@@CB@@A@@B@B@BA@@B@BCB@@A@EAA@@@@D@B@@A@A@@@@@@B@FA@A@@B@@@B@B@@B@D@@@BB@D@BA@@@A@AB@@@@E@ABC@I@BBDB@@ABC@A@@@@B@BA@@@A@@@@@@BBB@DBD@@C@@@@BAB@@@B@B@@A@E@@@@BBB@B@@A@CB@@@@@B@@A@@B@@A@@BA@BB@@BBB@@@BAB@BA@@DAD@FBDBFBHBD@BBB@DB@B@B@@@BA@AB@@A@A@AA@@AA@AA@@@A@A@AB@@A@@AAA@@AAA@A@AB@@@@AF@BBBB@@@D@FAF@B@@@@@BB@B@@@BAB@@ABBBBBB@BBB@BA@@@ABA@@@ABAB@@@B@BBB@BBBBB@DAB@@BB@@FBFA@@@C@@HBB@B@@D@D@@@BB@@@@@B@@AB@@@BBDB@AA@@B@@@BFBA@@FL@@B@@@B@@BBBBB@@BAB@@@@@@@@ABA@@BB@DB@BDBHAB@BCBBD@@A@BBBB@@BB@@BBB@BBD@BBB@BD@@B@DA@@HNDB@@@B@B@@@BBBBB@@@D@@ABAD@@@B@@@BB@BB@@BAB@@@BA@B@@@@@B@BDH@@ABBB@BFADFEB@@@B@@BB@B@@BD@@@@HA@BE@BBB@DBB@@@BDB@@BD@@@B@EB@@DF@BDDBD@BBBBBBD@@@B@BAB@BABAB@@@@BBDBJB@B@@A@@B@@@@BBB@@@@BJFBBDDDBBB@@@BC@BFHA@@AB@@@B@@@@@@@@ABA@A@@@BDA@@@@HD@@@BB@@A@@D@B@D@@@F@BB@@@BJBFBD@@@B@B@B@@@B@@@@DLDA@BFADJ@@A@A@A@@B@DB@@@DFDA@BBBB@@BD@BDBDBABDBFFJA@@BC@@BAB@DD@@B@@@BBDCBHVA@BDB@@AB@B@DJB@@BDHLA@BF@BD@B@FBHABBFC@GB@@EB@@@BDH@BE@@@BHB@FA@@BHB@NA@@DL@@@BDJB@D@@@DJB@@@BL@@"],encodeOffsets:[[117519,36993]]}},{type:"Feature",id:"410526",properties:{name:"滑县",cp:[114.519311,35.575417],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BG@@BG@@BC@MBA@AAABA@@@CB@@AAIB@A@@A@CB@@@@EBA@IB@@@@C@@BC@@B@BE@@@KBAAD@AC@@GB@B@@E@@@AB@B@@@@@@A@@@C@@@E@@@BA@@E@@@A@@@BA@@@A@A@@ABA@@@@BC@A@A@CAAB@@@@A@CCGB@@E@E@@AB@@AC@AB@@@@A@AAAA@@AAC@AA@BAA@@CG@@@A@AA@A@A@@AA@ACGBAAABAE@@A@CCA@AE@@AB@@A@EC@@AC@A@@@AAC@@A@@AEB@A@@@@AA@@@@A@@@@@AA@@@@@@A@@A@@@@@@A@@@@@A@@@C@@BA@@BA@@A@@@B@@@@CB@@@@C@AA@@ACB@@A@IB@@A@CC@@E@@C@@@C@@@@CA@G@@BC@ACAB@AE@@A@@KB@@EBCC@@C@GB@AA@@ACBC@@BC@@GE@@@@A@AI@@@@AA@@BE@@B@@@AC@@@@@@BA@@BA@@@@@C@@BC@@BA@@BC@@@@D@BC@@BC@E@OBA@C@OAAA@@@A@@CB@C@A@@@CA@AA@@A@@D@@@AA@@AAB@A@@A@@@@B@@OBBBCB@@@CA@AIA@@CB@@A@@@A@C@CB@AC@C@@@A@AB@@AA@@ABA@@B@@@@C@@@AAEDAAA@AA@@EA@@AAADA@AC@@C@@IA@AA@@BA@@AE@@@ABA@@@@@@ACB@AGB@@AEH@@ACKE@AAAAABACGB@A@@@@E@E@A@@DC@@BIBI@@@@BE@@@@@G@@BBB@@EBCB@@EB@@@@E@AEC@@CC@@@C@@@@DA@@D@@A@MC@@@BAAIAKC@ACAAEAAGAA@@@CBC@@GC@E@@EA@C@@C@@@A@@I@@@@@C@@@A@@@E@CC@@CB@@A@@C@@UBAE@EAC@AA@@AA@@EA@@CAB@A@AE@AA@@A@@@C@AAA@@@B@@@@@A@@AB@B@@@BAA@@A@@B@@@@GG@@AC@@A@AB@@@B@@AB@@G@@A@@CG@@@@A@CGB@CA@@AC@@AA@@BC@@EA@@CBAA@@@C@@@CB@AC@A@@DA@@D@@C@@@E@@CA@AC@@C@@BC@@AE@@A@@@ACB@AA@C@@A@A@@IB@AAB@@@A@A@AA@@CC@@CA@@CEBAEB@@@@CAEACC@@C@@A@CC@D@A@BA@@B@@@DE@@BG@A@@B@BCA@BC@@@C@A@A@@AA@@FE@GB@AC@@A@@K@@@E@@AA@AAA@@AA@@CA@@CA@AA@@@BC@@EC@@BE@AAA@@BA@@@EBBD@@@BD@@DA@BD@F@@A@@B@@A@@BA@@@C@@B@@@@@DC@@DG@@B@@G@@D@B@@@BC@@B@BA@@AA@@@C@@CE@@A@AA@@AC@G@A@A@@@A@@AC@@EA@AC@@@@A@@AA@@@@DCB@B@@ABA@@@BFF@B@BFF@BJ@@@@D@@@D@@BG@@BHA@BB@BDD@@CB@@B@FE@C@@BA@ABMA@B@B@@@JA@@D@B@@@DC@@@@@@FA@@@A@@@@@@F@@@@B@FFA@@B@@B@@@BDD@D@@BD@@B@BB@B@BDD@@BD@@B@B@@@D@@C@@@@FE@A@DNE@EB@DC@@B@@@BC@ADE@@@BHFADFBABBD@B@ACD@BDFA@C@@F@@BBDB@@BB@BD@@B@B@BBB@B@@@A@B@AB@BA@BHDJ@B@@@@@BA@KB@FA@G@@BC@@BD@@@EB@D@@@FC@@B@@BF@@DAB@A@FA@@DADA@@@@@BBDF@@@@@D@@@B@@CC@@AF@BDB@@A@@@@DA@B@@@@BL@@@@B@@BNABDH@@@BB@B@@BD@@G@@DG@@@E@@BE@@CA@@F@FA@@D@@BFA@@@@H@@OBA@@@@BA@@@C@A@@BA@A@EBA@@@@D@@@F@@@@@B@@DDBB@B@B@@@B@@@B@BB@DA@@B@@B@@@@@BB@@DB@BDF@B@ABB@@C@@@BB@@A@@@@BD@@B@B@@@AD@@@D@BB@@@@@@@@BD@@@@@@B@D@@A@@BSB@@@B@B@BA@@DF@B@AF@B@@@B@B@@@BBD@@@D@@B@@@@BC@@A@@@DB@@D@@A@@BA@BD@DC@@@@@A@A@@BA@@BA@@AA@@B@@@BA@A@@@A@@BB@AB@@@@A@@@@DA@@@A@@@@@@DB@@FA@@B@BB@@B@@BBB@@B@@@DA@BF@@BDD@@BB@@BDABDD@BF@@B@@DB@@@@DBBBFB@@@@FA@@BB@@@@@@@@@@@@FB@@B@DNA@BB@B@@@BD@@@@A@@B@@@@@@GDB@@BA@@@DHB@CBB@ABB@CBA@@@@B@@@@@@E@BBCB@@CBA@@@@B@DAB@@@@BF@BBB@@@@@@B@@@@BCBABCDC@A@ABA@@BAA@@@@@@AD@@AB@@@B@BA@A@A@@@AB@@@B@@@D@BBB@@CB@@@D@@@@CAA@AB@@AD@BAD@BA@A@ABC@@B@@@@@@B@@B@BBB@BD@@@@BB@@@B@B@BD@@B@@@@@DD@B@@@@BDA@@@ABB@@BB@BA@@BBBBBB@@@@B@D@D@B@@BB@BB@BB@B@@@@@@@@@ED@@@@B@D@@@B@@BBB@@BAB@@@@BBDB@@@B@BD@BB@B@@B@@BB@@B@@@B@@@BF@@@BB@BA@@@@BB@@@B@BB@B@@@B@B@@@@BBB@@D@@@B@@@@BB@@BB@@@@@D@@@BB@@BB@B@@@@F@@@@@@B@@B@@@B@@@BD@@DABB@@@BBB@B@@DBDBFBDBB@DB@@D@BBB@@@@H@B@@@@FBDBBBB@DB@@@@ABA@@@@HBB@@@BBH@BB@BBB@BB@BBB@B@@BB@@@A@@@A@A@A@AB@@@B@BBBB@@B@BAHGBAB@BA@@@B@@@@B@@@@@@BA@@B@@@BAB@@@@@B@@@@BD@@A@@@@BBB@@@BA@ABCDCBA@A@@B@@@B@@@BBB@@@@@B@@@D@@BBBB@@BF@@B@@@@BB@@BB@@@@@B@B@@AB@@@@A@A@A@AB@B@BB@@B@D@@@BD@BAB@@B@@@DCB@@@@A@@B@@ABABCB@HA@@@A@@@@FABAB@@@AA@A@@B@@@HB@@B@@@@B@BA@@B@B@B@@@BB@BBB@@@B@@B@@AB@B@@@@@@B@DADAAA@A@AB@F@@AB@@@B@B@@@B@@@DFDDBB@BBBA@A@A@A@@B@@BB@B@@@BBD@@B@@@@B@@B@@@@@BB@@@B@@DAB@BA@@B@B@@@@@B@@@@@BA@@@A@@B@B@B@B@B@@B@@B@@@@B@B@BBB@@AB@D@D@@@@B@B@BAAC@A@AAC@@BB@@BAD@F@FAB@B@@@BD@B@B@@@@@@@AB@@@@@@B@BD@B@@@@@@E@C@AB@@AB@@@B@@@BBHHHFDBRFB@FDB@FD@BB@BB@D@@@H@@@@@@B@BB@@B@@BA@C@@@@@@BBD@B@@@@@BB@@@@B@@B@@@BBBBD@D@@@B@B@@@B@@DB@B@B@LAB@@@@BB@@@B@@A@@B@@B@@B@@BB@@@@@@@ABA@@@@BB@@@B@@@B@@@B@@@@@@@@@@@@B@@@@DA@@@@@B@B@@BBB@@@BA@@B@@AB@D@@@DABAB@DAB@B@B@B@BAB@B@D@B@@@HDB@BBB@@B@@@B@B@@@BBB@DBBBB@BDD@B@@@@@B@@@@@@ABB@BB@@BH@@B@@BA@B@@B@@A@@B@@B@@BA@@@JJ@BB@B@@B@@@@B@@B@@@@AB@@@@BBBBB@B@@BD@D@DBD@D@D@FA@@@@@BB@@B@BBB@@@B@BB@B@@@@@B@B@F@@BB@B@@@@ABAB@@@B@B@@@B@B@@@DB@@BB@BFAFABG@@FC@ABG@@@APA@@HA@EB@D@@CD@@@@@@CD@BB@@DD@@B@FD@@B@BAB@@@BFB@JABB@@H@BFJ@@A@CLBB@@A@@@C@A@@NB@AB@XDJB@@HB@@@AJAPC@AAA@@B@AA@@B@@AA@@C@@A@A@@AB@@@A@@@@@@@A@DA@A@@C@@@D@@A@@A@@@BA@@A@A@@@BAA@@@BAB@@A@@D@ACH@@BHA@B@BB@B@@@D@@@@BB@@@B@@BB@@@@CBA@E@@@AB@@@@AB@B@@AFAD@B@B@@A@@F@D@H@@BB@FBB@@@B@@@D@@DB@@AD@@@@AB@@AJAAC@@J@@A@C@CFD@@FA@@@C@@BAB@FA@CB@@@@@CK@AC@IBGA@@GE@@@EG@@E@A@CD@@E@GA@@CAEBC@CA@@DA@A@@@EK@@BAFHB@BADAHAJ@@CJA@A@CF@@CH@@CD@AC@AB@F@DAF@B@DADABAD@@@DAF@D@DBD@FBDB@@FE@@JADCNAHGHAF@BABCBGBB@C@@@C@@C@@CC@@@@ED@@@AE@C@@AEB@@CDA@@@AFCACB@AEB@@@@@@AB@@A@@@ADA@AB@@@@C@K@@@@A@@AC@@EF@@@ACD@@AAA@@BC@@@CBA@@AE@AC@@@AGH@@@@A@AA@@E@@ABA@@A@A@@@A@AA@ABC@ACA@@@A@@CCB@@@@C@@A@@@@A@@@C@@A@AGBA@A@@@ACG@A@@ECKCEAAAC@AB@F@AEDADBB@FA@BJAB@B@@@BDB@@BB@J@@B@@HAAA@@B@@@BAD@@@@@@BD@AAB@D@@AAIC@@AA@@CA@@EAI@E@@@@BAB@@@AC@@B@@C@@BAAA@@A@@AACAB@AC@@A@CB@ACAC@G@@D@@CF@@C@@B@@BFABD@@@@DABD@@@ADA@AF@@A@C@AAABCBA@@@AD@@BB@@@@AB@@@@A@AAC@@AA@AAGD@@@C@@@@AFA@AB@@@A@@AB@@@@EEBAAB@@AABAC@@EA@@AEB@@@E@@@@@FA@@E@AAE@CC@@GB@@AE@@DA@@@AAB@@@A@@AEA@@BA@ACA@AA@@GIA@BBE@AGCE"],encodeOffsets:[[117709,36230]]}},{type:"Feature",id:"410581",properties:{name:"林州市",cp:[113.820129,36.083046],childNum:1},geometry:{type:"Polygon",coordinates:["@@CHAF@NBLJHLBHAPADALATGB@NBPLBD@BBHINIHIJ\\FHHGJKBS@QDQJA@BPNNNF@BB@D@DBB@JDDF@D@@@DCBA@CB@BABBBDD@@@HAJCDCHAB@F@F@F@DABABCDC@C@CF@B@@D@F@@DAL@@@@ABAFA@CACBAFABABABGDED@BBBDBB@H@JBD@@C@AFAB@BBBB@BD@@@@A@CBAB@NBD@FAB@JB@@DBB@DD@BB@BEB@FFD@D@BD@D@@C@C@@@CB@BA@AAA@@BBDADADAB@BFF@@BBADMBABADA@CBC@C@IACAAAA@ADADAD@BBB@BABG@A@@B[FEDABADEFA@@B@BDBB@BAH@FAD@BB@@@B@B@BB@HADAB@DBNDVDHBDBBB@BAB@BIHKJAB@B@@@@FBBBDF@@BADCBBB@BDB@HA@@DAB@@BABA@AH@@@FCDCHA@CBGBEBI@AAA@CACCCCAAA@CB@BBBBD@@@FBD@BDBJBB@DBDBB@BBFBFDHFFDBDBD@J@@ABADAD@D@FDHBB@@B@D@D@BB@B@B@BBBD@D@D@@BBD@FADCF@DCF@D@F@HANCFAB@BBBFHDBFBJ@B@DBBJLNJNCNADIP@DBJ@N@BBH@@BH@@B@B@@@B@@A@AA@@A@@AA@@AA@@@A@@BA@@B@B@D@@AB@@@BAB@@@BB@@B@B@@BB@@BA@AD@B@B@B@BBB@@B@BBD@B@BBD@B@D@BAB@@@BA@ABA@@@@@AB@BC@ABABAB@@@BAB@B@BAB@D@B@@@B@B@@@B@BBBD@@BBBB@@B@@@B@@@BABA@A@@@@AC@@AAAA@@AA@A@@@A@@B@@@@@B@BAB@BBF@D@B@B@@@BAB@BAB@D@B@B@@AB@BABA@ABA@CB@@A@@@C@@@A@@B@@@B@B@@BB@B@@@B@@@@@B@@@BA@ABABABA@@BA@@DA@@B@D@D@D@DBB@D@B@BBB@B@B@B@BBB@B@B@FAB@FAF@DAHABAB@D@B@B@BBB@@@@B@BCBABA@ABC@@@ABA@@@@B@@AB@BA@@BBB@@@BAB@B@B@DBBBBBBD@B@DBF@DBD@D@B@D@BBB@B@B@D@D@B@@@B@@@FB@@B@@AD@DAB@B@@ABABE@ABC@@BAB@@ABAD@BA@@@@B@FEBAHQFQ@EB@DANBNDLDB@@@ZGD@DBFFZ@FJEB@@VP@@BABCBABC@ABA@A@E@@@@MKPKN@@BPCBGNQEA@KUGKLGGBSMJCE@CNKCGNSA@B@@ABA@@@@@A@@FA@A@@@AA@@A@@@ABI@@C@E@@@@AA@BA@ABA@A@A@@@@BAA@B@@A@A@@B@@@@@BAB@DBBAAA@@B@@AB@D@@AD@BCEAA@@ED@@@@AA@@A@@CAB@@A@@@@B@@@@@@A@@A@@AAA@ABA@A@@@@BAB@@@@A@@A@BCA@@ABBB@@@@@@A@@@A@AD@@@@@@A@C@AAA@@@@BAB@B@BAB@@@@@@A@@@C@@AA@@@A@@A@A@A@CB@A@A@@@@B@@@B@BA@@B@B@@@@A@@@AA@@@@@BA@@@@A@@@AA@AAAG@ACA@@CAC@AAA@@B@@A@AA@A@A@A@@@@A@A@A@@BAA@A@@A@C@@A@@@@A@@GE@A@C@A@AA@@A@AA@A@@@@A@@@A@@@AA@BA@@@A@@AAA@EB@@@@@A@@B@@AA@@@@@A@@@@@@CA@@@A@@AA@A@@@@@A@A@A@@@A@@@@A@@@@B@@@@A@@C@@C@@A@@@AA@@@AC@@@@@BAB@@@@A@AF@@@@A@@B@@@B@@@A@@A@@B@@AB@D@B@FC@@@@@B@@B@B@@@@A@@@A@@B@B@@AB@@@@@B@@@@@B@@@AA@@@@DCDAB@B@D@B@BA@@@@@ACC@A@@B@@@D@D@FBB@BB@@@@B@@@BABABA@A@@BAAA@A@@@A@@@@B@BBB@D@B@BABA@CBABADADAFADAB@@B@@@@@@@@B@D@@@AA@@B@@ABA@@AA@@@@BADC@@@AE@@@@@@@F@@@@@@A@@@@@A@@GCCA@@@@BAB@@@A@A@@@@@A@@A@A@@A@A@AAA@@@AEACAAAA@@B@@@@A@@@A@AA@@A@@DAD@D@@@@@@A@@AACAA@@AA@@A@@C@@AA@@@C@@@AAA@A@AAAA@A@@@@AACA@AA@@C@@@@@B@@A@BAAA@@@@@B@@@@CAA@A@@A@@@@@@@@A@@@A@@@A@@A@@B@@@BAA@@@@A@@@@@A@@B@@AA@@@@A@@@@B@@AB@@@@A@@A@@@@@@AB@@C@@BA@@DAB@@@@A@@B@@@BB@A@@@@@A@@@A@@@A@@ACAA@A@@@AB@@@@@B@B@B@DA@@@A@@@@C@A@A@A@A@AAAAAAAA@AA@@A@@@@BAB@@A@@A@A@@A@@@A@@@@@AC@@@AA@@@@AA@@B@@@D@@@B@B@B@@@B@DDDB@BBAB@@@@A@@@AAAAAA@@A@@B@@AB@BB@@B@@A@@@A@@@@B@@@@A@@BA@@B@@@@@@@AA@@@@D@B@@A@@@@@@CAA@B@@@D@@@@@A@@@@C@A@@B@@@B@@A@@@C@A@A@C@@@@BAB@B@@A@ABA@A@@@@@CA@@@A@A@@@@A@@@@A@@@@A@@@@BAA@@@A@@@@A@@@@A@A@@@A@@@@@@A@@@AA@C@AAA@@@@A@@@C@@@A@@B@@@B@@@B@@B@A@@@@@C@@@ABA@@@A@AAA@A@AB@@@@@B@@@B@B@DABABABAB@B@BAF@@@BABAD@@@@@BA@@@@@@@@AACA@@CE@@CAAC@@@@@A@@@A@@@@@A@@D@BAD@@@B@@A@A@@@@B@B@B@@B@B@@@@BBB@B@B@@DB@@B@@B@BA@@@@@D@@B@DDB@B@@@@A@@AAA@@@AAAC@AA@AA@@B@@@BB@@@AAA@A@C@A@@@A@ABA@@@@@E@AAC@A@A@@@A@@B@@AA@@@@A@@BC@@@A@@AC@A@@BCBC@@@AB@@AD@B@@@BAB@DC@@@A@@@AC@@A@@@A@@@@BB@A@A@A@@@@@ADB@@BABB@@@@B@B@@@@@BA@@@@B@BB@@@@B@@AB@B@D@D@B@@@BA@@@@A@A@@@@A@@@@BA@@F@B@@@DADADADAB@BAD@@@B@@A@A@@BAB@@@B@BA@@BA@@B@BAB@DA@ABABA@@@E@@@@@@BA@@B@@AA@@AAA@AAAA@CAA@@@@A@A@@@AB@@@B@D@B@@@@@@A@AAA@@AA@@@A@A@@AAA@E@AAA@@@@A@@@A@@@A@A@A@A@@D@@@B@@A@@@@AA@@ABA@@@A@@@@@@A@A@@AA@@@@@@BA@@@A@A@ACA@AAAAAAC@@@AA@@@CAA@A@C@C@AAA@AAA@A@A@A@A@A@@@@B@B@BA@@@A@@@A@@@@@B@@@BA@A@@A@@@@@BC@@@@@A@@AA@@@A@@BAB@A@AAA@@AACAA@@@@A@A@AB@@A@A@@@AAAA@A@@@@A@@@@@AB@@@@A@@@A@@@@B@@AB@@@@A@AACA@AA@@@ABA@CA@@A@A@@B@BAB@@A@A@A@AAA@AA@@A@A@CBE@E@@@A@@A@@@@B@@@B@@@@@@A@@A@@AB@@@BABEBA@@BA@@@@@@AA@@@A@@B@DABAD@@@@AB@@@BB@@BA@@A@@AA@@@@@BA@@@@@@B@@AAA@A@@@AB@D@BABABA@ABA@A@A@C@@@@BAB@B@@ABA@@@ABA@@B@DAB@@AB@@A@@AAA@@@A@CBA@AAAB@@@@@B@B@@AB@@@@A@@A@AAA@@BA@AB@FC@@B@@@B@B@@A@@@@AA@@@AA@AA@@@AA@@AAAA@@AAC@A@AC@AA@@@CA@@AAC@A@@BA@A@A@@BAB@@@B@B@D@@@BAD@B@B@BBD@B@B@B@D@@@B@B@@@BB@@B@@@BAB@B@@@@B@@AB@@A@@B@@@@BBB@B@@BB@BA@@B@BB@@BBDAB@JDDB@@D@B@B@@BD@B@@@F@B@B@B@@@@@@@BB@@@@@@@@BA@@@@BAD@B@FA@@A@A@A@ABA@@AA@@@AA@AAA@C@@@@B@@@@@@A@@B@B@@@@AB@@@AA@@A@A@A@@@A@@A@AA@@AB@@@@@B@B@@B@A@@B@@@@@B@BA@@C@@A@@A@@C@@DA@A@AAA@@@A@@@@B@@@B@@@@A@@@A@@@@A@A@@@A@@BA@C@AB@@@A@@B@@A@@A@A@@@@A@@@@BA@@@@A@@@C@A@AAA@AA@A@@A@@A@A@A@@BABABABCBC@@@@@AA@AA@@@AA@@A@@@@BA@A@A@@@A@@ACA@@@@@A@@@AA@@A@CAA@AA@@@A@A@@@AAA@@@AA@@@CAC@EA@@C@A@@@@A@@A@@@A@@@A@@@@@@AA@A@@@A@AA@AA@A@@AA@AACACAAA@A@@GCAAA@A@@@@AB@@A@@AAA@@@@A@@AA@@A@@@AACC@AA@A@A@@BA@A@CAA@CBC@@@AA@AA@@@AC@@I@A@A@@A@C@@AC@@@ABA@A@@AAAA@@@E@E@AAACAA@AA@@A@AA@@A@@AA@@@CAA@A@AC@@A@C@@@AAEC@@AAAA@AAAAACA@@AAAAA@AAA@@@A@@@A@AB@@@D@@ABA@A@A@@AA@ABA@@@AD@B@@AB@BCB@B@@@@@B@@@@A@A@A@A@ED@BA@ABABA@E@CB@@A@A@A@@@AAAE@A@@@AAA@@CC@@AA@@@@BA@ABCBA@@AA@A@AAAAA@@CA@@GAA@AAA@@A@A@@C@@B@@@@@B@@A@@@@@@@@@C@AAA@A@@@C@@@@B@BA@@@AB@AA@@@A@@@AA@@A@AA@@AEAA@@@@A@@@A@@BA@@BAB@@@@A@ABA@A@A@@BAB@@@BA@A@@BAB@@A@@BA@@BA@@@A@A@AAA@@@ACA@@@@@A@A@A@@AABA@@@AB@BA@@@@@A@@A@C@@AAAA@A@@A@A@ABA@K@A@C@CCA@C@A@AA@@A@@@A@@@AAE@@@A@A@C@A@CAA@C@CBA@@@AAA@@@CBA@@@AACAAAA@E@A@@BA@@BA@@@C@C@@A@@A@@ACACA@AA@A@ABE@ABA@GAA@ABC@@@A@@@@BA@A@AAA@@@@@@BAB@BBB@BB@@BA@EAA@@@@@AB@B@@@B@BA@ABA@@B@@@@@@@@@@@B@@@@@@@BD@@@B@A@@B@@@@@@@@B@D@B@@@B@BB@@DF@@@@FBBBDBB@@B@@@@EH@B@@@B@BBBBD@BBFDBBB@B@@BBABBB@@@BB@@B@@@B@@@BDB@@@@@BA@@@@B@@BB@B@BBB@@BDBB@@ABABA@@B@B@BDBBBDDB@@B@DBB@B@@ABA@@@A@@@AA@@GCA@@@A@AB@@AB@@@B@BC@ABABCDA@ADA@@BA@A@@DA@@@@@@B@@@B@@@@@B@@@@C@ABEB@@@@@BC@@@@B@@D@@@@B@B@B@@C@A@C@C@AB@@ABA@@@C@EBIB@B@@AB@BA@@@@AB@@AA@AFB@@@@BB@FBADA@ABBD@@AB@B@B@B@B@@ABEB@@A@AB@@AB@@@DB@DFBBBB@B@@@@@B@@ABAB@@ABAB@@@BBB@@@@ADADA@@B@B@@@@A@@@@@A@A@@@ABABAD@BA@@FB@@A@@@@B@@@AB@BAB@B@@A@CAA@A@@@@@@BB@@@@@@@A@C@C@A@@@A@@@A@@@AB@BAB@BAAA@A@@B@@@@A@A@@@@B@@A@A@A@@@@A@@@@BAAA@@@AA@@@CB@@AAA@@@AB@@@@E@@@@@AB@BABAB@A@A@@@@ADA@@@@@AB@D@BAB@@B@BB@@BDBBA@@@A@E@@@C@@@A@C@@@ABBB@B@@@@E@AB@@AAA@C@A@@@C@A@AAA@@@EBC@@@A@EDCBA@CB@@A@GAA@CA@@A@@B@@C@@@AA@@IAMZEHEDABEHAFAHAFFTFPBLDJFVLRAX@D@DAPAJABANABCLBNOD"],encodeOffsets:[[116368,36833]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("鹤壁",{type:"FeatureCollection",features:[{type:"Feature",id:"410602",properties:{name:"鹤山区",cp:[114.163258,35.954611],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@ECA@CA@EKMABCBEH@@GDGBE@ECEDM@CDCB@@EAIBCCCEAEC@EBCCA@E@O@IA@F@@ABAB@BCBA@ABA@@@AB@@ABA@@@A@AB@@@B@BA@@@C@ABA@CBCBCBCB@@A@E@@@AB@@@@@B@@B@B@@@@@AB@@A@C@C@A@A@@BA@@@@@AAA@@@@@AB@@@@A@A@@@@@AAAB@@CA@B@@@@@B@B@BAA@@@@@B@@@BD@@B@@@B@@CDA@AB@@A@C@@BA@@B@@ADAD@@@BBD@@@B@@AD@@@B@@B@@BA@@@@B@@@B@BBD@B@F@@@@AB@B@B@@@B@D@BBB@B@@AA@@A@@@BBB@@BBDBB@@B@@@B@@@BBB@@@@@BA@@B@BA@@@A@@AA@A@@@A@@BB@@@@B@B@B@B@@@@@@@AA@@@@B@@@@@@BB@B@B@@@B@@AB@BAB@@@@@B@@D@@BB@@@@@@@A@@@@B@@AA@@AB@DADA@@@@@A@@@AB@BAD@@@@@@@@@@@D@@ABABAB@@AB@B@B@B@@BBB@@@@@@@BAB@@BB@@@BAB@B@@BBBBB@@B@@AD@@@B@@B@@@BB@@@@@@@@B@@@@A@A@@@A@@@@@BB@@@@A@A@@B@@@@B@@BB@@B@@@@@D@@@@@@@AB@@@@BB@@BB@@B@B@B@BA@@@@@@B@@B@@@@@@@B@@B@D@B@BBBB@@@B@B@B@@B@B@D@BAD@B@B@HBFBBB@@@@@F@@ABC@@BA@@B@B@@@@@BA@A@@B@@@BBB@@AB@BBD@@@@B@BBB@DHBF@B@BBB@B@@ADAB@BABBB@B@BD@@@@DB@@@@AB@B@B@BB@@@@@BA@@@@@B@B@@@B@@@B@@B@@@A@@@BB@@D@D@@@@@@B@AB@B@@@@@@@@@@@@B@@BB@@AB@@@@B@@@@@@@B@@DA@@D@@B@@@@B@@@@BB@@A@@@B@@BB@@B@@AB@@@@@B@@@BB@@FAD@B@@@@@@@@@AB@@@@BB@@B@@B@ABB@@@@BBD@@@@A@@B@@@@B@@BB@@@@ABAA@BBB@@BB@@@@@B@@@B@@B@@B@@A@@@@B@@D@B@@@@BBDBD@@B@BAB@BD@B@A@@@A@A@@@AB@@@FB@@B@B@B@@@D@@B@@@@BB@BD@@@BB@@B@B@FAB@@B@@@@@@@@BB@@@@@B@@B@@ABA@A@@BA@@@A@@B@@@@@@A@A@@AAAA@A@@B@B@@@@@@AA@@AB@@@@@@AB@@@@A@A@A@@AAA@BAA@@@@BCB@@@A@@@A@@BA@@@AB@@@@AA@@@A@@@@@@C@ABEB@@AAA@@@A@@DG@A@A@@AA@@B@@A@@A@@AB@@A@A@A@A@@@@A@@@@D@@A@@@A@@A@@B@AAA@@@@A@C@A@A@BAAA@@@@@@A@@AA@@@A@A@@CA@@B@AABA@@@@@A@@BB@@@A@@@AB@@A@@AA@@@@DC@@B@@A@AB@@AAABAB@@@@A@@B@@@@@B@@C@@B@@AB@@@@BB@@@@@BC@@@@D@@@@@B@@AB@@@@@BAD@@@B@@@@BB@@@@A@@FCFEFEDABA@ABC@ABC@G@G@G@K@C@@A@OCAAICGCAAECGGIICAKI"],encodeOffsets:[[116906,36784]]}},{type:"Feature",id:"410603",properties:{name:"山城区",cp:[114.184318,35.898033],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@@@@BC@ABBVC@BHC@@ACB@BA@A@A@A@A@A@CDABAB@@CJADCD@@CDA@CBEDIB@B@DBF@B@@BFBBFL@B@B@BBFE@AH@@@B@@LN@FDBB@FD@@BBLJDBJJHHFDBBHDJDBBPDB@@@@D@L@H@H@HAD@BAD@BABCBEFEFEDB@@B@@BD@@@@@@B@B@@@B@@BBBA@@@@@BB@BB@@B@@ABB@@@@@B@@@@@B@@B@@@@@@B@@B@A@@B@@B@@@@BC@@B@B@@@@AB@@@@@@@@B@@B@B@@B@@BBA@BB@@BBB@DBBBBB@@B@@B@@B@@@B@B@@@AAAE@@DA@@@A@@@@DBAEB@@@@A@@B@@@@B@@@@@CB@@@@AA@A@@@@@@@BABB@@B@@BB@@@@AAG@AB@A@B@@A@@BB@BD@@@@ABA@BBA@@@@@A@@@@B@D@@A@@B@@@B@@@@@@A@@B@@@@@@A@@B@@B@@@@@@BC@@AAB@@@@@@A@@BA@@@BBB@@@@B@@A@@@@BBB@@A@AD@@@B@@@@AB@@@BB@@BBB@@@@@BB@@B@@A@@@@B@@ABBDA@@@@@@@@BA@@@@B@@@B@@@@CB@F@@@@@F@@BC@@@B@@@B@@@@@@@@@AB@@B@J@B@@BD@@@B@@@BBD@D@BB@DBC@@@@B@@@B@@A@@BC@A@@AC@@AAA@A@@@@@@@BAC@@@@AAA@C@C@@B@D@B@@@@A@@@@@A@@@@@A@@@A@@C@@@@@@AB@@A@A@@BC@EA@@AA@A@@@@A@@@@B@@AB@D@D@B@@@@@BD@BBCB@BB@B@B@B@B@@@@BB@@@@B@@B@@@B@B@@B@B@BDBB@@BBB@BAB@@@B@B@B@@@D@BB@@@A@@B@@CD@@D@@B@@@B@@A@@@A@A@@BABC@@@@@@A@C@@@@A@AAE@@@@C@@AC@@E@@@@A@C@@@AA@E@@@@IAEC@@@@@@F@B@@A@@BB@@BA@A@@@AB@@@@A@@@@BA@@BB@ADABCA@A@@@@A@@AAA@@AA@@@BA@A@@@@@@A@AAA@A@@@@B@BA@@@A@CBAB@B@@@@@BA@@BABAB@@@@@@@@@A@C@AAEC@AAC@C@ABA@AA@@AA@C@@@AAA@@D@@C@@@C@A@AA@C@AA@AAAAC@AAK@E@AB@DCBCB@B@F@B@B@@BBABBB@D@@A@@B@@@@A@@B@D@@BB@@@@@B@@C@@@@C@@@@AB@CA@@@A@@@@A@@@AAC@@@A@@AB@C@@@C@@C@AA@@@A@@AAA@@AA@@@AC@@@@@@A@@@@@@@A@AB@AAA@@BA@A@@@AAB@@AA@CA@@A@@@AB@@@@A@@@@A@@@@@@AB@@A@@ABCAAC@@@A@@@@A@A@@A@@@@BA@@@@BA@@@@@A@@@@@AB@@A@@@@@A@@B@@A@@@A@A@AA@@@@@@AA@@@@A@@@@@A@@@@AA@@@@A@@B@@A@ADA@@@@@A@@A@@A@@@AA@@@@C@A@@A@E@CBA@@A@AAAA@@B@@@A@@A@AA@@@@@@@A@@@AAB@@@@AAECCBA@C@@AA@C@BACADA@DBA@AC@AE@@@@A@CAEAECAAIC@A@D@@ADAA@A@A@@@BA@AA@FAA@@A@A@AD@BA@@AAAC@@EG@GBA@@@@@@B"],encodeOffsets:[[116929,36705]]}},{type:"Feature",id:"410611",properties:{name:"淇滨区",cp:[114.298789,35.741592],childNum:1},geometry:{type:"Polygon",coordinates:["@@@FIBGAGAYA@D@@@B@@@@MBCBI@A@ABA@CF@B@B@D@@ABAF@@ABAFA@@BA@EDCBABA@ABC@A@A@CBABA@ED@B@@@BBF@B@FBFBD@D@@BBD@BBH@B@@BB@@BBBBB@B@@AFGAG@A@AB@DA@CBFNFB@AD@BF@DEBCII@@DGB@B@JBDA@AFG@@BAB@DBDBABB@ADABADFFA@BCBFA@BB@@B@B@F@BAD@@@@EBA@@@A@AB@DA@@@@BA@@@A@A@A@ABA@@DA@@BA@A@A@CACA@@AAAA@AACA@AAA@A@CBA@CB@@AB@BAB@D@D@F@@BF@@BF@B@B@DCD@BABEBABCDA@AD@@@D@FAB@BABADABCDIDA@AB@@A@CAEAA@CA@AAA@@AAC@CBCBCDADAB@BAB@@A@K@A@CBA@C@@@A@AB@BA@@@@AACAAA@E@AA@@@@@A@@A@@AAA@@@@GDA@A@EBE@@@AAA@@@@@C@EB@B@@@@BBDD@B@@@@G@A@AAA@@@A@@@AB@@CBA@A@@@@A@AA@@A@@A@EF@@A@@@AA@@A@A@AA@@C@@AC@@@A@ABAB@B@@@BA@A@A@AA@@A@@BCD@@A@CAC@A@C@A@C@@@A@CG@@A@@@A@C@@@EBA@C@AB@@@@AB@@@@@@@@AA@@@@@@A@A@A@E@@@A@C@@AA@A@C@@@CAICA@CBAA@@AAA@@@ABA@@AA@A@AA@@@@@AB@@@BA@@@A@@A@A@AB@@A@@@AA@@A@A@@@C@A@A@A@C@AAA@A@C@AB@@C@A@A@@@A@AB@@@B@BAB@@@BBD@BB@@D@@BBD@@B@BBD@BB@BB@BB@@B@@BBB@@B@@BB@@@@@BA@A@@@A@@@EDA@@BAB@@BB@B@BB@@@@@BA@@@A@A@@@@BABBB@DAB@@@B@BB@@@BA@@BA@CBA@@@AB@B@@AB@BA@A@AB@@@@@D@B@BAB@BABABABC@A@@B@@@BBB@BA@@@@@@@AB@@@@B@@BB@@@AB@@AA@@A@@B@@C@ABCBA@@@@B@@BB@@@@@@AB@@ABAFAB@@A@@BB@@@@B@@@@A@@@A@@@@@@BB@@@F@F@DAB@B@@@BBB@BBB@B@B@@@BA@A@AB@B@@@DBB@BA@@B@@BDBBBB@@@@@BA@@@A@@B@@@B@@@@@BA@@@@B@@@@@@BBBBB@@B@B@@@BAB@B@@@@@BBBD@BB@BBB@A@AB@@@B@@BB@@@B@@@@AD@@@@B@@@@BAB@@A@@@@@B@@@B@@@B@@A@A@A@@B@B@B@B@B@B@BBB@BBD@D@B@B@DB@@B@@B@@BDBBBB@BDB@B@B@B@@AB@@@@@@BB@@@B@B@@@@B@@@B@BA@@BB@@@@@BA@@@C@@@@B@B@B@B@@@B@@@B@@B@BBF@B@BB@@@B@B@@BB@@BB@B@B@@@@A@C@A@@@A@@B@@@B@B@@B@DBB@BB@BBB@BB@@BA@@@AB@@@@@@JBP@F@B@DDFAD@BFDFDDJAFB@@DADCN@FCFDF@HAHC@@FGDABA@@@A@@BGF@AE@A@A@AEKAAAE@@@AAE@C@AJAFCDAB@DC@@DCBCDI@@BABADCB@B@B@B@B@B@@ADA@BD@AGD@AUBAD@@A@@H@@A@@@@B@HAH@@FD@BB@BB@@ABCB@B@@@BB@EBBB@@A@@@B@BBBBC@@@C@BJDBBFDFBDBB@@@@@BFD@@BAB@CCBDBABD@B@@BD@B@DAA@A@@AA@@C@@@@BA@@@@@@@@@@@@B@AA@@@AB@BA@@B@@BD@@@@A@A@G@A@@@AC@@E@@BA@B@A@@@A@A@@A@A@@BA@@@A@@@@ABA@AB@BABA@@B@B@@@@A@AC@@@@A@@BA@@AA@@@@@A@@@A@@@@BA@E@@AA@ABA@A@ABE@ABC@AB@B@@@DCB@@@@A@E@@@@@@AAB@@@BA@@@A@E@A@AB@@AAA@@B@@@D@B@B@@@B@B@@@B@B@@@@A@C@@B@B@@A@@@@@C@@@@@A@@@@BA@@@@@@@AB@@@F@@@B@@A@C@@BA@ABA@@BA@@BA@@@EI@A@A@@@AA@AA@BI@@B@D@@@@A@A@@DA@@A@@AHAB@D@BAD@B@DA@@@B@BDD@B@@B@@@BABCB@@@@@B@@B@B@@@@@D@@@B@BB@@BB@@@B@B@@@@@@A@AB@@@@BB@BB@@@@@B@B@@@@C@@@@@AB@DAB@@BB@@@@A@A@@@A@ABE@C@CB@BA@@@@@B@@BB@@B@@B@BAB@@BBB@@@@B@@@B@@@@@BB@@BB@@@BB@B@@@B@@@@@D@B@@@@BBB@B@@B@B@B@@@BD@@@@B@@BAB@@@B@@B@@@BD@BB@@@N@B@@AB@B@B@@B@@@@F@B@FC@@FBB@@B@F@@@@B@D@B@B@H@@@@@@A@A@@@@BAF@B@@B@@BD@@@B@@B@DAB@@B@FBB@@@B@B@B@B@@@@B@@@@@@BBB@B@@BBB@@@@B@D@D@@B@@@BBBBB@D@D@F@B@BA@@A@@AB@F@@@B@@@@B@@B@J@D@D@F@B@B@B@B@D@B@BD@@B@@@DCDADA@@@@B@H@F@@@B@@BF@B@DA@@B@@@B@@BDAB@@@@A@@BAB@@A@A@E@A@@B@B@@@B@AE@A@@D@@ABA@A@@@A@@@@@@AACAA@@@CAA@A@@A@A@A@A@AAA@@AA@A@@A@A@A@@B@AA@A@GA@@AA@@A@@@@ACE@@@@@A@@@A@ABA@C@A@C@AAC@@@C@AA@A@BC@C@C@AAAAC@@AA@AA@@E@@@@BABAB@BAB@@@@C@@@@BA@@@@AAB@EG@AAGE@CGCBA@@A@EEBCAOB@@ACCBAEAM@@F@@AACBCEKFIBCD@@E@AEEDEDC@@AA@C@EDABG@@AIAC@G@@EG@I@E@AAAEAACBCEE@@AE@GAA@@M@E@@@OBEAADADCBA@EACACB@BCD@@@DCB@@BHF@@@"],encodeOffsets:[[117066,36511]]}},{type:"Feature",id:"410622",properties:{name:"淇县",cp:[114.208828,35.622507],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@GIAAA@CAEAAAA@AA@@@A@ABABABCDEBABA@@@A@A@AAAACAA@@AABA@@BAD@@ABA@A@A@ECE@AACAE@A@A@@@AAA@@AAA@CA@@@@@AAA@A@@BC@ABA@@BADADA@@@A@@@@@E@A@A@@@AB@@@B@B@@@BBHD@@B@B@@AB@@C@CBCB@@AB@B@@@@BDD@BB@@@B@DAB@BABA@AB@@A@A@EAG@@AA@@@@A@A@A@Q@A@G@A@GB@@A@@A@@B@CCAAAA@@@AA@@@C@C@A@@@A@BB@@AB@D@@G@A@@@AB@B@DA@AB@@A@@ABA@AA@@@A@@@@BAB@BABA@@B@@@D@BA@@@@@C@ABCBEBA@EBCBGDC@@BBB@B@BA@@BABA@A@A@A@@@@C@AA@A@CB@@@DAB@B@BA@@BG@ABA@A@@AA@@CACQFA@AAA@@@A@@@@@A@@EA@@@A@IBA@A@@@AA@@A@@@C@MDAB@@AB@BABABA@A@A@A@AAA@A@A@A@C@A@CDAB@D@BABA@@BAB@BA@@@A@CBC@A@CB@@A@CBCBABA@GHCBA@ABCBABA@ABABA@A@KBABE@C@A@A@ABGBABEDA@AB@BA@C@A@@BABEBED@@CBABABABABAB@BAB@B@BADABABAHABBDAF@BBBABA@KHCBABC@C@C@@B@@@B@D@BBBBDDB@B@@@D@B@D@B@@@B@@@BCB@B@@@B@D@D@@@D@D@@BBB@@@B@BBBBB@BB@B@DBD@@@B@BAB@@EBAB@@A@ABCBA@AB@BABA@@B@@BBDHBBBFBB@@@B@@@D@BABA@@B@DABAB@@@BAB@B@B@BCH@BABABA@C@@@EBCDAB@@A@A@AAA@A@CBA@CBC@AB@@@@@BBFDF@B@B@@ABA@ABGBE@CBE@ABA@CBC@C@A@@BE@@@A@CDCDABCB@BBB@B@F@F@@BBBB@@@BAB@B@@BD@@@D@BB@B@J@@@BD@@B@@BBB@@D@DAB@DBB@B@@AB@B@B@@BDDBB@@B@@@BB@@@B@@B@BB@@@BA@@B@@B@B@BBHD@@@BBBDBDBBBB@@BB@B@@BBBB@@@B@B@@B@@@@B@@@B@@@B@@@@B@@B@D@@@FBD@DB@@B@@B@@BB@B@@@B@B@@BBB@DBB@@@BB@@B@@@@@B@BD@@@B@@@B@BAB@@@@@BB@@B@@BBB@@B@@@@ADADABABAB@@@B@B@BB@@@@BBBB@BBB@D@@@B@@@@@AB@@@@@B@@B@B@@@@BA@@@@B@@BAD@B@@AB@@@B@B@@@@@@B@@@B@@A@@@A@@@@@@B@@BB@B@BCB@@@DB@@@@BD@@@ABA@@@@@A@@@@B@AA@A@@@@@A@@BB@@B@BB@@@B@B@B@@@BB@@A@@B@@A@A@@@@B@@@@A@@@@@@DBB@BBB@@B@@BB@BAB@B@B@D@B@@@B@@@DHB@@@D@B@D@B@D@DBB@@@DC@AB@@@BBB@B@B@@A@@@ABABAB@@@D@@BD@@@BBB@B@@@BB@@B@@@FEB@@@@BB@@B@B@@B@B@DA@@BA@@B@@@B@BBB@H@@@@@@ACCAA@@@@@AFAD@@@@@B@BB@@F@FAB@B@HC@@@@BB@BB@@@@B@@@@BBF@B@BBBD@B@@B@@ABAB@@@D@B@DAB@L@B@@@BA@ABABCDCDADAD@BB@@BB@BDBB@FBDBB@@@BAB@JCDCBABCBA@ABA@E@C@@BCB@DCBAFABA@ADC@C@A@AAE@@AE@@@E@C@CBA@ABA@@DAB@DAB@B@BBB@BD@BBBBB@@DBDBB@B@B@@AB@@CB@BAB@B@B@@@B@@A@@B@@CBAB@@@B@FA@@@@BC@A@E@A@AA@@AEBDA@AEBCEABCB@BAAABAC@CBA@AH@BEB@AC@I@AHA@CJ@DJFA@CAEC@@BEAEMDAB@@CBAB@H@HBBE@@@AAAAA@AA@@AA@G@AAC@AA@@@CACAE@E@AAE@A@@@AFCB@BADAB@B@D@BAB@BADAFCB@@AB@BEBA@@BEBA@@@C@A@ADEB@BAB@J@DANA@@@@@A@@@C@@AEAA@A@AAC@C@C@@@C@ABA@@BAD@@@B@D@@BBA@@@@B@@A@C@ABABA@@@A@AAA@A@@A@@AA@A@C@A@A@@AAAAA@@@A@@B@@@D@@AB@B@@@@A@@@A@A@AAA@@@AC@C@EA@@A@@A@@@A@@@ABABABA@A@@@A@@@@@ACA@@C@AA@@CAAAAAAC@AAEACAA@@AAEAAAAA@@@A@A@C@A"],encodeOffsets:[[117018,36440]]}},{type:"Feature",id:"410621",properties:{name:"浚县",cp:[114.55091,35.67636],childNum:1},geometry:{type:"Polygon",coordinates:["@@ECA@A@G@OB@@EDCDABA@@AA@A@@BA@@DAFCF@BA@CBA@A@C@C@A@@@A@G@@@C@A@EDA@GDC@ID@@@@@@@B@D@BBD@D@B@B@D@B@@BD@@@B@B@@B@@AD@B@B@@BB@@B@@ABCD@@@BBD@B@@B@BBDAB@D@B@BB@@@B@B@@AB@@@@@B@@@@BBB@@BF@@@@BBD@B@BBB@@BH@F@B@BA@@BABABA@CBA@@@A@@ACC@A@@A@A@@BA@AD@D@DA@@BA@A@@@GCAA@@A@A@@@A@@B@@@B@B@F@@@@@B@@CBCBAB@@AB@BAD@@@BBB@B@@@@DBB@BB@@BB@B@@@B@BBFBD@BDF@F@B@BAB@BC@AB@@ABBB@@BBBDBB@B@B@B@@ABABCFADABAB@B@B@@BBB@BBFBDBB@BBHJ@@@B@B@D@B@B@@BBBBFBBB@@BBBDBF@BBDBBBBDB@@BBD@@@DB@B@@@@@B@@@BABABAB@B@@@B@@@BB@@@FBD@D@@B@@BB@B@B@B@@@B@@A@A@@BC@@@A@@@@B@@BBBB@BB@B@D@B@B@@BB@@@@BBB@B@B@@ABAB@B@D@BA@@@@@AB@AC@A@@@C@AB@@AB@B@D@@@D@DBD@B@BBBBF@@ZBHBHBJA@E@@E@AG@@DA@C@@DC@ADADBFBB@DABCBCFBPA@@F@N@@@BB@HBF@@FFADBDFBBB@B@F@JFH@@@HBDBJ@@AHCB@F@DBB@@CDCFFF@B@FC@ADEJFLADBD@BE@@@BNBFDABD@@PADBFA@F@BB@DADHF@BH@BFHA@BB@@@@AB@@@@@D@@A@ABA@ABAB@@@@@FB@@BBB@@BDBB@B@D@DADB@B@@B@D@@BD@B@D@B@DAB@B@B@@@B@@@@DF@B@@B@@@BB@@HBB@B@@B@AB@B@B@@@@BBB@@BB@B@B@B@B@BB@B@DB@@B@DBBB@@@@@@@B@@@BAB@BC@@@@BBFA@@@A@A@@@@B@F@B@BA@AB@@@B@@A@CBB@@@@B@B@@HBB@@@@B@B@@A@A@@@@@@B@@@B@@@BBAD@B@FB@@@@@B@D@@B@F@F@D@B@H@@@@@BA@@@AB@@@@AB@AAB@@@B@B@@A@@B@B@@@A@@A@@B@@@BBDA@B@@@@B@DA@@@ADA@@DA@@BB@@@@@D@@BB@@B@@@@BB@@@B@@@@B@J@@B@@@@@@ABA@A@@B@@B@@@D@@B@B@@@@A@@B@BAD@B@D@B@@@B@F@B@DBD@BBF@F@@@@@BDBFAD@@@BHBBBB@B@@@B@@B@@@@@@B@@@B@BAB@B@B@@B@BB@F@@@BB@@B@D@F@@@F@D@BADAB@F@B@BAB@@@B@HBB@BAB@BAB@BADABA@@F@BALCLAB@@@@E@@@@FBB@@I@@BBBDB@@BD@BBB@B@B@BB@@@BB@AB@@AB@@@BJH@@@@DBFBB@DB@@DAB@B@DBB@D@HBBBD@B@@@@A@@@@DAB@@@@AAA@A@@F@B@@@@A@A@@BA@A@@D@@@AC@CAA@A@@@@B@@@B@@A@A@@B@D@BA@@CAAAJ@D@BAF@@@@@BAB@@@B@@A@CAA@@C@A@@@@A@A@@@AB@B@@E@A@@@@B@B@@@@A@C@@B@FBB@@@DA@A@AB@@A@A@AB@@@DA@@BB@@BA@@@@BA@@BE@@B@BAF@D@BAB@F@@@BABA@@BBB@AAD@@@BA@@B@B@@AB@BB@@B@B@@@B@@BB@@@D@BA@@BA@@@AAAB@@BB@B@B@@@B@B@B@B@B@@@@@B@@@@A@@@A@@@@BABA@@B@@A@@BA@@@@B@@@@AB@B@@@B@@@@B@@B@DAB@D@DAB@B@@@@BBBF@DB@B@BBBBD@@BABB@@@@BADA@AB@@BDA@@@@F@D@B@@@B@@@@B@@B@FAD@B@@@@ABE@@BAB@B@BAF@@AB@@C@A@ABBD@B@@@@@BB@B@BB@FAD@@@@@@AB@B@@C@@DABA@@@@@AA@BA@@B@@@@@AA@@B@@AB@BEB@@ABAFCB@BADC@AB@B@@A@AD@@@@@@A@A@@B@BCB@@AA@@A@@@A@@@A@C@@@E@K@C@AAEAAAA@@AA@@AAA@B@@@@@@A@@A@@@@@@AD@@@B@@@A@@@AA@@AAA@AC@EAGAA@AB@@@@AECCCAA@@A@A@A@@A@@@AA@AB@CCGEG@AAA@AAA@AAA@@CA@@A@A@@@A@A@@@A@AB@B@@A@A@@AE@A@A@@@@@A@A@@A@A@@AA@A@AA@@A@@@@EBC@C@C@CAC@C@@AA@A@AAAA@@@@BA@@@@@AA@@@@@@AA@A@@AII@@B@@AA@@@@AB@@@@AA@B@@AA@@@AG@@AAA@BA@@@@@@@A@@@@@ACC@AAAAA@CAA@A@@@A@A@@@AA@AAA@GC@@A@C@A@A@ABA@A@A@A@CBA@ABCB@@C@A@@BA@@@AB@@A@AA@@@A@A@@@@CB@@@@@A@@@@@@@@@@A@@@A@@@A@@@A@@A@@B@BA@@@@@@A@@AA@@@@AA@@@@BA@@@A@@A@@A@KBA@A@A@@CA@@@A@A@@@C@C@AAAA@@A@@@@A@@A@@A@@@@@AAC@A@@@@D@B@@AA@@@AAA@@@@@@@@G@@@CAAA@@AECA@ECA@QECAGEGGAA@@A@@@A@@BA@@B@D@F@@@@A@C@@A@A@@@@A@@B@@@@@@@A@AAC@@A@A@EBE@C@AB@@AA@@BD@B@BBDABA@A@@@@@@C@CBA@@AA@A@A@A@@A@@@@AA@A@A@A@A@@@@B@@AB@@@@A@@@@@A@A@@@ABA@CB@@@A@@AA@@@@A@@@@A@@A@@@AC@A@@@AAA@@@AB@B@B@B@AA@AAACCCE@@A@@@A@A@@@A@@BE@A@@B@BBBCBCBA@@@@@@@@ABA@@@AA@@@A@AAA@@A@@@A@A@AB@@A@A@@A@@@GA@@A@@@@BBB@@A@ABEB@@@@@B@@GBA@ADAB@BA@@@@B@@A@CD@@A@@@BA@AAC@@C@A@@@AAA@A@@B@B@B@B@@A@@BA@A@@@@@A@@AA@@A@@A@@@AE@@AAAA@@@C@@@A@@@@AA@A@@@A@@@AB@B@DADCBAB@@A@@AA@A@@B@@@AC@@@@@A@@@@BA@A@@@AB@@A@@@@A@@@@@@A@@ABA@ABGHABA@@@AAAAA@@@A@@B@B@B@B@@@B@@AA@@@AAA@AAAA@AAA@@AAG@A@@AA@G@@B@BA@@@@CAA@AACAEA@@@@@A@G@@A@AAC@@@CAA@CAEACACA@@@AAA@A@@AACB@@AC@@A@@@A@@@@A@@@@E@@@@@@AAA@@AA@@C@@@@@A@@AA@@A@@A@@@C@@@AA@A@@A@A@@@A@A@@A@A@@AA@@@@ABA@@A@@AE@@A@@@A@@@AA@@@AA@A@@AACA@@@A@AC@A@@A@AB@@AA@AA@@@C@A@@@@@FC@@@@@@@@A@A@@AAAA@@AA@C@C@A@@@@@AAAAAA@@ABA@@AA@BA@@B@AC@@@@@ACC@@@@A@@@ACA@A@@@A@@A@@C@@AAA@A@AA@@@@@@@@AD@BAB@B@@ABC@ABC@@BAB@DB@@@@@C@@DA@@AA@A@C@@@A@@BA@@B@B@B@@A@A@@BA@@BC@@@@@@BB@AB@BAB@D@DCBADA@A@@A@@@@@@@AA@AAE@@@@BA@C@A@@B@AAA@A@@@A@ACA@@AA@ABA@@@@A@A@@A@ABADA@@B@@@B@AAAAA@@@@ABEBGB@@@BBB@@@BC@@@AB@@A@A@C@C@AA@@A@BA@AA@@AA@@B@@A@A@@C@@@A@@AA@AAA@EA@A@AEAAA@@@C@E@A@@@AAA@@@@@@BADA@G@AB@@@D@B@@A@A@@@@BAAA@A@AA@@@@C@@@A@AA@@@@C@@@@@@CA@@AC@GAA@@A@@AA@@AA@@@AAE@@@A@AB@@AAA@@AAQI"],encodeOffsets:[[117075,36297]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
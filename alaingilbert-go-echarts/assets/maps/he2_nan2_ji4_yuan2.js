@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("济源",{type:"FeatureCollection",features:[{type:"Feature",id:"419001",properties:{name:"济源市",cp:[112.602256,35.067199],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@ADGAEF@@C@E@EBC@ABIDEBCBEDIDIDCBA@CBA@A@E@G@C@G@ABA@@@CBE@IBG@ABE@EAKACAE@C@A@E@C@I@GAA@EBG@A@GBIBABC@ABCBABCDADA@CBCBABABABCDA@ABA@CBEBC@EBIBC@CBA@E@EBA@AAA@AAAAIACAA@C@GBA@ABE@CBAB@@ABAB@B@BADCJEHCDCDCBCBC@EBA@C@EAA@CACACAAA@@AA@A@MAGACCCACCAA@A@ABKFEFCBCBABEDADABA@ABA@C@C@C@C@AAA@ABA@CDGJEHABAB@@A@A@C@G@IBC@CBE@G@C@A@@@AB@B@D@B@B@F@F@BAFEPADA@CDABCB@@ADCBCDCB@@CBAB@@CDABCDABCBABABCBC@CBC@IBEBG@EBIBIDABC@MFCBCB@BA@ABA@MAA@KBE@C@A@C@C@EAA@I@A@A@A@C@E@AAE@C@C@CBC@CDA@A@A@A@@@A@@ACAAA@@A@A@A@A@ABC@CD@@AB@@@B@B@BBBBDBF@BBB@B@D@@HBF@DCDAD@@@DBRXBHAF@B@H@HBH@FEHGNAHBJFPH^FVBJ@B@@@BA@G@ABAD@BBBJJ@DBDADELGLMXKLINAH@HDFLHHBNBHHJHVJBBDB@FADAD@F@FBJAHMZCNEFOHGDA@@B@BBBDDBD@DPCRAJBJBDBF@HALCJCLAD@B@BA@@B@@@@@@A@@@@@AA@@@AA@@A@A@@@@@A@@@@@@@@A@@@@@A@@B@@@BA@@@@@AB@@@@A@@B@@@B@@@B@@@@A@@@@B@@@@@@@@B@@@@B@B@JDFBNFB@F@NCZENARADANERGTC\\@FAHMACCCAAACB@@@DALAXCLAFBHDXFbBJ@FDBBPPDBJ@DABACKUgCECEBC@AB@J@LDNDH@D@FAJAF@HDF@JCL@R@HBJBRAHBJFDBDFJHLNJFL@JALCL@LALCVETGBALCDBFDDDH@HALEDAFABABBFFBBBBB@JEHAFAHCNID@DBDBRDNBD@B@F@HEJEPGB@BAD@DAF@D@BB@F@FBBFBDBJ@H@PBXBF@FCNAF@D@FBFBPFFBBBBBAFCNA@@BABKBI@IDAD@DAJDFJFFFDFJDD@BABACK@C@CDI@AB@B@DNDJDBBBDAHABCDI@A@I@CBAB@@@B@D@B@@ABABAHAJEDEB@R@BE@GDATOB@@@DAR@BC@AIIAOLWBEF@BHDLJTLFHBJ@B@HCBADADAFAB@FAB@BB@@B@@@H@B@@AAA@A@@@@@A@@@@@A@A@AB@@A@@@@@@BAB@@@BAAA@@@A@A@A@A@@BCDC@@JCDCA@@A@AB@@@@AAAAA@AA@@@A@@G@GC@@C@AA@@@A@@ACB@CD@@AEB@GC@@AE@@C@@@@D@@AB@@AAKF@@@D@@GBA@CB@@IA@@@DAAQBBAG@@@A@A@GB@D@@@A@BA@AACB@@A@ADAD@AADA@@CACC@@@DA@@AA@A@AB@AEB@A@@@@H@ACCAA@@@@ABAA@@CG@@AE@A@@AA@@AB@@C@@AC@@AADA@@@E@@@A@@DAAA@C@@C@@@A@AAGBA@AEA@@AAGH@@@@AD@B@@CA@@CABAIB@@AE@@AE@@AA@@@ACAC@@C@CMFAB@@@@@B@@@B@@BB@BD@@HAAA@CG@AEC@@@A@AC@C@@@CABA@@@@AA@@BA@A@@@@A@@@@@@@@@@@AB@BAD@AC@@@CGB@AE@ACE@@@E@@@IBBF@BA@@@@@A@@@AAB@@@@@B@@A@CA@@AB@@AA@@@@@@@A@@AB@@@AEA@@B@@@@@@ACA@A@DA@@CBAACB@AA@AB@A@@@BA@@AA@@B@@@@A@@@A@@AA@@@@AA@@AA@@A@@@AB@@@@@@AB@@@C@@AD@@AE@@@A@@AA@@CB@@BB@@A@@@C@@C@@@C@A@A@@@C@A@E@A@@@@@C@@A@@@@AC@@HA@@B@@AA@@AB@ACB@@@AC@@B@CE@AIB@@A@A@@EA@@A@@@@BAAAB@@@B@@@@@@C@@@@@@@BA@@C@@@@A@E@@A@@@@@@B@@AA@@@C@A@AB@A@@AAC@@@AB@@A@A@@B@@@BE@@@A@@B@@E@A@@@G@@@D@@AA@@AA@AB@@@ABABA@A@@AAAD@@A@@@A@@A@C@@@A@CAAD@@AC@ACA@@@@A@AB@@AA@@@A@@@A@@AE@A@@@C@G@@@@B@B@@@@AA@@@B@B@@@@@@@@AA@AA@@@@@@ACA@@A@GF@@A@IBA@@@@@A@@@AAAAA@C@A@@@@@A@A@@AA@@@AA@@AB@@A@@AAA@@A@AAA@@@A@@A@@@AC@@@A@AA@@@A@@DE@@A@ABA@@@A@A@@BA@AA@@C@@@AB@@CBA@@@@@@A@@@@AA@@AB@@@@AB@BBB@BA@@@@@A@@@A@A@@@@ADA@@@@@AA@@@A@@@AD@B@@A@@A@@A@@AC@AA@@@@B@BA@@@@@@@A@@A@AAAC@AB@DA@A@A@@@ACA@@@A@@AAA@A@A@@@@A@A@A@@A@@@@@AA@@@AA@@AAA@@@@@BA@@AG@AC@@CCAA@AG@A@C@AAC@A@@@@@@@DAB@B@B@@@BBBB@@B@@A@@D@@@@@AA@@@@B@B@@@@A@@@A@ACEA@@@B@@A@A@@@@@A@@@@@B@BA@EA@@A@A@@A@@A@@@@B@@E@@@@BB@@BD@BB@@@B@@@@GB@@@@ABA@@AEB@AAE@AACB@AA@AA@@A@A@A@@@@AA@@A@@B@B@@AA@A@@C@@@@BA@@@@@@@AA@BAB@@@@A@AAAB@@AB@BA@AB@@@@@B@@@BB@@@A@A@@B@@B@@@@B@@A@@@CBC@@D@@@@DBB@@@@@@@ABC@A@@@@@C@C@@@AA@@A@@@AC@@CB@C@@@BAB@AC@A@A@@B@@@AB@@B@@@@@B@@@BA@A@BAE@@AD@@CA@@B@@@AB@AAA@@@@@@BA@@CD@@@E@@@AAB@@@DA@A@@@@A@@CD@AAC@BCA@@@@A@C@A@@A@@A@AKA@@@@DAAACA@@@A@@JBFBF@FAHADHBBBB@BBH@@DAAAB@DF@AAE@@DFB@AAB@ACBABD@@DFB@@@@@@@BAB@@@@@@B@A@@DB@@@@@@@A@@CAAA@@HCA@AEB@@CB@@AB@@DD@@C@@@AFA@@B@@A@@@@@A@@@A@@@A@@AA@@@AA@@@AAACB@@@DA@AB@@A@AA@@BGFCB@@GDAAA@@@@@@C@@AA@@@@B@DAHA@@@@A@AEBA@DB@@B@@@@B@CGEFCCJEDJDA@@DACCAE@@@@HEABCAC@C@G@A@AAC@A@@@ABABA@A@GBA@ABE@A@EA@DD@@DAA"],encodeOffsets:[[115205,35738]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
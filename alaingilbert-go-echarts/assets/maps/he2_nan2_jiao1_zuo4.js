@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("焦作",{type:"FeatureCollection",features:[{type:"Feature",id:"410802",properties:{name:"解放区",cp:[113.230816,35.240282],childNum:1},geometry:{type:"Polygon",coordinates:["@@BC@AE@BAA@@CB@@A@@@A@@@@oBEB@BSJLBB@@@@DABD@@BB@AB@BB@@@@B@BA@@B@@@BA@@@A@@DCB@AA@@@@BA@@@BB@@B@H@FBB@DBD@BBB@@B@@@@AJANH@@FD@AJA@BB@BGA@B@CG@@D@@AB@D@@@DAAA@A@@@ADE@@@A@@B@BIAAHNDAFEA@AA@BAC@@@A@AAABAEIAA@@D@BD@@@A@@BB@@@@@@DDA@BA@@@BB@D@@DDHFFDAD@B@F@@AB@@@B@BA@@BC@@B@BA@@@B@@@@@@BA@@@A@@@@@C@B@BB@@B@@D@@@@FB@BAFABABCA@BC@@@@@A@@AG@@BA@@@AB@@@B@D@F@B@@AD@BCD@BA@AH@BAD@B@@BBDBFBFDBBDBFB@B@@BB@B@B@B@DAH@D@@@BBBDDDDFDB@@B@BB@@BFBBBH@@DBBHDBBBBHBBCB@DC@@@@A@A@@@A@A@@@@@@@BA@@B@@A@@@@AAB@@AB@BAD@@ABCBA@@@@BE@A@@BA@@@@@C@@@A@@@A@@@@BC@@@AB@@@B@@ABC@ABABADABAFGBABC@AB@FCB@FAB@BA@@@@@AABC@C@A@@@AA@@@AB@@A@@@@@@@A@@@@B@@@@A@AB@@@A@A@@AB@B@@@C@GABADB@AC@@@F@@@CA@@LB@EW@BAC@@AD@BA@AB@DA@AA@A@@A@@@@@@@@@A@@D@BC@@@@@A@@@@@A@@BA@@BA@@BE@@B@DCB@@@@A@@@A@@BC@AB@BG@@@EBA@C@@@@@AAA@A@@@A@ABA@C@C@@@A@@@AB@@EBB@EF@@@BCBKB@@AG@BOEBGACBEBA@@@A@CBA@E@CA@@A@@@CAIA@@BCDUBIVI@ABAJCLAFAFAD@D@D@@C@@EAIBGDMBIDG@OA"],encodeOffsets:[[115944,36008]]}},{type:"Feature",id:"410803",properties:{name:"中站区",cp:[113.182946,35.236819],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@AAA@@DCB@DCAI@@@AHAB@BC@@@C@@BC@@D@@@@A@ADA@@DA@B@C@@@A@@@G@@@C@@@@@@CAABCA@@ED@BC@@A@AAABC@@@A@A@EA@DA@@A@@ABCA@@A@@BCDE@AFC@EA@@@BC@C@@B@BC@@FAABDA@@@EBEB@BCB@BCB@@AB@@AB@BAF@B@BA@@BABABED@@AB@@B@D@@BC@@B@BA@@B@@B@@@@B@@@B@@@B@B@B@D@B@BBB@@ABAB@@B@@B@@BAB@@@@@@B@@AB@B@@@@BB@@@@@@@@ABA@A@@@A@A@@@@@@BAB@B@@@BA@A@@@@BAB@B@@@B@BA@@@AB@B@@@DBBA@@@A@@@AC@A@@C@@@A@A@AB@@A@@AA@@@@B@@@BA@@BA@@B@D@B@@AB@B@@@B@@D@B@B@@@@B@@@@A@@@C@@@A@B@B@@BB@@@@BA@@B@@B@@B@@@@@@ABA@@@@BBD@B@@B@@AB@@A@@@@BAB@B@@BB@BB@@@B@B@@ABBB@B@@@@@@@B@@@@@@BA@BB@@@@B@@@@@@@B@@@F@@B@@@@BB@BAD@@@B@@@@@@B@B@@B@B@@@BB@@A@A@A@A@@@@B@@B@BB@@@@A@@@@BDBDDBD@@@B@@@BBBA@@@@@G@@@@BB@BBFBDBBBDDB@@B@B@B@D@B@@@B@BBBBDBB@FBF@B@@@B@BAD@@B@FBB@DB@@@@BB@@A@@B@BDD@B@B@B@@@@F@@@@B@@@@BBBB@@B@@@@@@@@BA@@@BB@B@@A@@DB@BB@B@D@B@BADAB@@AB@BBB@@DBD@HBB@B@@@D@@@@@B@@@B@@AB@BA@@BAB@B@B@B@BB@@B@@AB@B@@@B@BB@@B@@@B@B@BBB@BBBA@@BBB@@@B@B@@@B@@@B@@B@@BA@@@@B@@@@@BBB@BBBA@BB@B@B@@@@@B@@@B@B@@@@@@D@@@@@BBA@@BABAB@@AD@BABCFCB@@@BA@@BCB@BAB@@CBA@@BA@@@A@@@A@AAA@@@A@@F@B@B@@A@@@@AA@@@@@AB@@@B@@A@@@@@AA@@@@A@AB@DC@ABABABA@A@@@A@@@AB@@@@A@@A@C@A@@AAA@@@@ACGAAAAAGCAA@CG@AAEA@AA@@A@AA@ECCCCCAA@A@@@CBG@C@A@A@AAA@@@AEACAAAECEACAAA@@@ABC@ABGB@@ADC@ABC@@@A@E@C@A@@BA@@B@@AH@@BB@@@@@D@@ADBBABABE@AEA@@@@@CA@@@AAA@D@@@@@B@@@B@@A@@@@A@@@B@@A@AD@@AB@@A@A@@BA@@@E@ABCECGECC@@@CAA@@B@@ACB@C@@@@A@@AB@@@C@@A@CB@JBBFBABBB@@@D@ABB@@BFBBEMCBGJB@A@AB@@@F@BC@@B@B@BB@C@@@CBA@@@CH@@D@AHB@AAAB@BIC@@EG@BMBI@@@@@AA@AAC@CAA@EAG@"],encodeOffsets:[[115910,36023]]}},{type:"Feature",id:"410804",properties:{name:"马村区",cp:[113.322332,35.256108],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@D@@@@A@A@@@AD@@@@B@@@@@AB@@@@B@@@@BA@@B@@@@D@LF@@A@@@@B@@CD@@@A@@@@E@A@@@@D@B@BB@@BD@B@@B@@@@@@A@A@@@@@@@@B@@B@B@@BB@D@@@@@BB@@D@D@B@@BDDF@CDAB@BA@AB@@@D@@DBDDDC@ADG@@D@@DB@BGBB@BB@@BA@@B@@BABBB@@BB@@@B@@A@@@@AA@@AABA@A@@@BB@@@@BFB@ABA@@@@BB@B@@@@E@AB@@@@BB@BD@@@@BEA@BB@C@@B@@@@A@@@A@C@@@A@@@@@@A@@@AA@A@@@A@ADABA@@BB@B@@@@@@BA@AB@BAB@F@B@@CC@BA@@@@@A@@CBA@AB@BADA@@@@@AA@@@ABA@ADAB@@@B@B@BBB@@@@@B@BADABADAB@@@@B@@B@@@@AB@@CB@BABAD@@A@@@@@@A@A@@@@A@@@CD@@C@@@AAA@@@A@@BABA@@BCBDD@@F@DBBBB@B@ABBB@@B@BDDBFD@B@BB@@BBBHF@@JJDFDBB@B@@@B@DEHK@@@BBA@@A@BAA@@AAAB@@ADB@AD@@@CA@ADB@AA@@AD@D@@@CA@@B@@A@@@A@@B@B@@BD@@@A@@B@B@BBBBB@B@@BB@@B@B@B@@@BAAAB@@@@AA@@@A@@AF@@@@@FBD@@@@B@@@@CB@@@BA@@@@BDA@@BBB@B@B@A@J@@BAB@DBB@@A@@@DBB@@BA@BBDBBB@B@@@B@B@@@B@BBBBBBD@@BDB@@BHFDCBABBB@DA@C@@ACD@@AA@B@DB@@ABA@@DBBBA@A@A@AD@@ABBBCBB@@FBBE@@@C@@B@@CBBD@@AB@@BB@D@@B@@H@@@B@BAB@@@@@B@@@@@B@@@BBD@@@BBBCHB@CB@B@D@BA@AB@L@ABB@B@@AB@ABB@AD@BF@D@BA@@@AD@B@@BFC@AA@BCC@AC@EBCEABIC@KA@@@CBID@@E@@@@BE@AA@BCA@BEEA@CB@B@@A@@@A@@@@@@CAB@C@CA@BI@GABAGABCE@@CA@A@@CC@@EA@@C@AE@BE@@@@A@BAB@BC@CEA@CC@BGI@@FC@@A@@A@A@@EA@@E@@C@BCGA@CG@@ABG@AE@BCCABA@@EAE@@BC@@@@EC@@AA@@@CB@CA@@@A@@CDB@GF@BC@AE@@@@@@A@A@@BIIA@@I@@DE@@BEAAD@BGABAC@C@A@BEAAA@AB@A@E@@A@@@A@@@@@@C@@@AE@BI@A@C@@EABA@@@AA@BGA@@@AFG@@@@BA@E@BEG@A@@@E@@BG@AAA@@B@BE@@@@B@@E@@@AD@@G@@B@BC@@B@@@PB@@BB@ADB@@@@@@FDBAD@@B@@@@@AD@DE@@F@BAB@@@BA@@@@D@@AA@@@BA@@ACBAFA@AH"],encodeOffsets:[[116026,36104]]}},{type:"Feature",id:"410811",properties:{name:"山阳区",cp:[113.254881,35.214507],childNum:1},geometry:{type:"Polygon",coordinates:["@@I@IHG@@F@@DB@@DA@LABF@@FC@ADS@@@G@GHMBE@I@O@A@MAC@@@ABCBKB@@@H@B@NBJ@@JHD@H@@FH@@@BJ@@BBBB@B@DB@@@B@BJ@F@B@H@DPD@@FL@@FB@@D@DNLF@A@@FBD@BEF@DC@AB@@@DBBA@@@BB@@CFBB@B@@@D@BABBB@@@ADC@@FB@ADBB@D@@@@D@@@H@@@B@@@D@A@B@@CB@BCB@@@@@@CD@@AD@@@D@@ABABG@@J@DB@CDA@CB@@@B@@A@@B@@BDA@CB@@@B@@A@@@AB@@A@A@@A@@ABAA@@AC@BA@C@@A@KATI@AFApA@@@@@B@@@BA@@DB@ABF@@BADPBH@JCNAHCJAFB@@@DC@C@C@EBEBKBIDAB@BUJAJCVAD@@JBDB@@B@@@DBF@B@DAB@@@B@FADAHBFAAPH@@BA@ALAD@@E@@FAA@FA@@B@@@B@@@D@DAB@B@B@@@BBB@B@@@@@DAB@F@@AHA@@BAD@@@B@@@B@@A@CDA@@@AF@@AB@@AB@@@B@@@@@B@@@@ADC@@@@B@@@@@@@@@BB@B@@BCBA@@BABC@@BD@ABX@@FKA@@DB@@E@@@D@@BCAABHBD@@@A@A@@BB@B@@@A@@B@B@@A@@@@@@B@@@@@@@BA@@B@@BB@@B@D@D@BAB@@EB@@@@@@@B@@BD@@DB@@DBF@DB@@BBBBDBBB@@@BBBBA@@@@BB@@BB@BB@@BA@@B@@@@BBBBB@BBBDFB@@B@@@B@@B@BDDD@@DB@BDD@BDB@@BD@@BBDBB@@@BBBB@BB@@@DB@@B@BBBBB@BBFBDB@@B@BDDA@AB@BA@AB@@@B@BB@@D@@@DC@@B@@@@@@B@B@@@@B@@@BCBA@ADA@@BA@@@@@AA@@@@@BABCBABC@A@A@@@@AA@A@A@A@@BABCB@BA@@B@@B@@@@CBABA@@BAB@DB@@@@@B@@ADD@@@A@EBA@ABAB@@A@@@@A@A@@AB@BABCB@@@B@B@@B@@@B@@@@B@@@D@B@@@B@@@@@@AD@A@@AFB@A@@C@@AAA@@@@BAF@@@@@@AAA@@@@AB@BEA@A@@A@@A@@@BABBB@@BB@@@@@BA@@@A@@AA@AAAB@@@AB@@AA@@AAAAHA@@CC@@@CH@BCDCCCA@@@C@@BAB@@ABADCE@CC@AA@C@C@@@AA@@@@C@A@@AA@A@@@@A@@@@@@B@B@@@@@@@@AA@C@@AA@@A@A@C@@B@F@@@@@@B@@DC@@@A@@B@@@KEC@@@@@@AB@@A@@A@@@@@BA@@@@A@@@@@BC@@B@B@@@@@@C@ABGB@BEDA@BB@@A@@BB@@@C@@B@@A@@BA@A@EF@@CBC@@@@A@@@BCCA@E@@@@A@BCA@@AA@@O@@@AD@@A@AH@@@BC@@F@@@@A@@F@@A@AB@BBH@@AF@@@B@H@AFF@B@@A@@H@BE@@B@AHB@@B@@ABFB@@@@LCDI@@F@BAH@AFB@BEDADABIBCDADAJ[BGB@BEGCBG@GBGB@DAD@HCBGBAB@BIB@@AA@DEB@B@@@@CBCBGBBBCA@@CBC@@M@@AAE@@A@A@@AA@@EA@@A@@@BC@@AC@@AC@@@@@E@@BA@@@A@@@A@@BA@@@@@@AC@@BEB@@E@DCIOK@@A@@OA@BAFA@GFMCGA@@@@CB@CI@C@AAA@W@@CMJQ@@PK@@MwDEB@FKF@@A@ABAB@ACBCBI@IDC@EAIAEA]GKA@C@@eG@EB@@@BG@AA@A@@ABEA@G@UA@ABGA@@AC@@CBKUAC@"],encodeOffsets:[[115879,35941]]}},{type:"Feature",id:"410822",properties:{name:"博爱县",cp:[113.064379,35.171045],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@@AA@AA@@CIA@@AAAC@AAE@E@A@KCGAC@C@E@ABCB@@A@EBE@C@A@ABA@A@EBA@A@ADCJ@@@D@BAB@B@D@B@B@@A@A@@@EAAAA@A@A@A@AB@@AB@BAB@B@B@B@BCD@@ABEBC@ABEDAB@BAB@B@@EDADABABCDADA@ABA@AB@@EF@BA@A@@@E@ABA@@@@@AHADH@@@@BABAB@@AD@B@@E@AB@@ABA@@@BBD@@@@B@DB@@B@B@@@@C@SD@@@BBB@B@@@B@@@D@BBH@BF@BB@@@@@BA@C@@B@@@B@@@B@@D@@@@@@B@D@@@@A@@BA@@@AC@A@ACBA@A@C@GB@@CFCD@@@B@B@@@B@@@@@B@@@@@B@@A@@@@@ABB@@B@@AD@@@@A@@JAD@D@BB@B@@@BB@@@B@@@@@@AB@@@@BB@@AB@@@@JJBBB@B@BAD@D@BBBBDBHDBBBB@BBBBB@BBDB@BBFD@@B@BB@BB@B@BB@BB@B@BBBBB@@BB@@@A@@@A@@@@D@@@B@@BBDBB@@BBB@@BB@@@FBBBB@B@@@@B@@@B@@@@B@@B@B@@@@BB@D@@@@BBBB@BB@@DD@@@@@B@@BBB@BBB@@BB@@@A@@@@@@@AA@@A@@@@@@B@@@BBBABB@@@@@B@@B@B@@B@B@@@B@B@@B@@A@@@@@@@@@A@A@CA@@A@@BABAFA@@@@@@@@@@A@@@@@AC@C@@@A@@AAAAA@@@A@@@A@A@@A@AAEAA@A@EAA@A@ACA@@@A@@@AB@@CBA@A@@@A@AB@@@B@B@@B@@@B@BAB@B@@@B@@@@@@B@@A@A@@B@@@BB@B@B@@BBB@B@@@BA@@BA@@B@@B@B@@@@@BB@F@B@@AB@B@@@@@C@@A@@@@@AB@@@BA@@@A@A@@@AB@@@@@@@BB@B@DABB@@@@CD@B@@@@B@B@@B@@B@@@@A@@@AB@HD@@BB@@B@B@@BBB@@BD@@@BA@@B@B@@A@@@BBB@@@@@@B@@@@A@A@@B@@B@DB@@@B@BAA@@@@@BA@@@@@AA@@A@@B@B@@@BF@B@@@B@AB@@@@@@@@A@@@@B@@A@A@@@@@A@@BA@@A@@A@@@@@AD@@A@AB@@@@C@@@@@@B@@@@BBAB@@@@A@A@@@C@A@AB@@A@B@@B@@HD@@@@@@@BAB@@BB@@@@D@@@@B@B@B@D@@B@@@BB@B@@@@BC@@A@@A@@@AHE@A@@@C@@B@@ABB@@B@@B@D@B@BBBB@B@BBBBBDB@BB@@@@@BB@@B@DB@@B@@B@B@AB@@@@@@BBB@@@BBFBB@BB@@@B@B@@@B@B@@BDBD@DBD@@AB@B@@AB@@@@@@D@BBBBB@B@@@@BBBB@@@@@@B@@@@@@A@@@A@@@A@@@A@@@@BAB@@A@AAAA@@A@@@AB@@A@@B@@A@C@A@AB@@@@@@B@@BB@@@BB@B@@B@@@@B@@@@EA@@A@@B@@@BBB@@BBA@@B@@@@@B@@A@@@@@A@AC@AA@@@@@@@@BAB@@A@@@AAA@@@@@@BA@@@@A@@@A@@A@@@@@AB@B@@A@@@A@AA@AA@@AAB@@AB@F@B@@A@@@C@A@A@AAAA@A@@@@@@@@A@@@@B@@@@@@BB@B@B@D@B@B@B@B@H@J@J@DADBB@@BBHFFDBB@B@@ADABADAF@B@@BBFBBB@@@B@B@@BB@@@@BBB@BAB@B@B@@AB@BAB@BA@@@@BAB@@@B@@@B@@@@@B@JAFAB@B@FBB@DBBB@@B@D@B@BBB@B@DBD@BA@@JALCHCHABABAB@B@B@BBBBD@@@FEBAB@DADCFEFE@@FAHAHAD@@@BAB@B@D@BBFDDBFAB@DALEHC@ADA@@D@B@B@FBBBDBF@D@@B@CB@@@@AAA@@B@@A@@@@@@A@@@AAAA@@@@@A@@E@@@@@@A@A@ACC@A@AB@@@AA@@@@CAA@EAA@@@BC@A@A@@@AAE@EAAACAA@A@A@@@A@C@A@A@AA@CCAACAEAAAA@@A@@H@@@@@B@AA@A@@@A@@ACCCCA@A@@B@@@@@AAA@@@@A@@B@B@B@B@@@AA@@A@A@@@@A@A@@@@A@@@C@ABA@@A@@A@@@@E@@@A@@@@@@@A@@A@@AAB@@@@@@@A@@@@@@@AAABA@@@A@A@@AAA@@AA@A@AB@@@@@BA@@BA@@@@AAC@A@@B@BA@@@@@@@AA@@@@AB@@A@@A@@AA@A@B@@@D@@@B@@@@@@A@@A@A@C@@@@A@@@ABA@@@A@C@AB@@AB@@A@@@A@@B@@BB@@@BAB@B@@@D@@@@BBD@@B@@@B@AA@C@@@ABA@@B@@A@A@@@ABA@A@@B@B@@A@@@ABA@A@@@@B@B@@@B@B@BA@@@@@@@@AA@@@@@ABA@@@A@@@@A@AB@@@AA@@@BABA@@AA@A@A@C@A@A@A@@@A@@@A@@A@@@@AB@@A@AD@@AC@A@@@BA@@FCBABA@AB@@A@ABE@ABA@@BA@@DA@ADA@AFAFA@@B@ACBB@ED@@A@AD@D@KECMC@@@EA@@EK@@OC@C@G@A@EAIA@@@A@@C@AAAAA@@AI@@G@@EG@C@IG@@AI@M@A@G@@LADABA@@D@NBB@P@J@F@NAHGH@@@T@BCD@@EE@BA@KCB@@CA@@@EH@JGJ@D@VB@A@@E@BQD@BSB@@A@@A@@@A@@AC@@A@@@@@AABC@@@AA@@@GBAAA@@@@@@@A@A@A@@@AG@@EG@@KG@@@@MEB@AB@BIMCKECAECAA@@CBC@C@EAC@C@EBCB@@CB@@A@ADA@@BWFA@KDC@GDA@E@A@"],encodeOffsets:[[115801,35891]]}},{type:"Feature",id:"410825",properties:{name:"温县",cp:[113.08053,34.940189],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@E@A@A@@H@@@FBR@@@N@B@F@TA@@D@D@JBPA@BJ@H@F@H@B@@CJ@B@DAF@@@B@B@D@@B@@D@@@@BBA@@@AB@B@@A@A@@BCBCD@@AA@@EBABA@A@@@A@AB@@AB@@@B@@C@@@A@A@A@CBAA@@AHC@@DA@@DC@@B@DD@BB@BBD@B@DAD@BBB@B@BA@@BA@ABA@A@C@C@A@A@@AA@@BA@@A@@A@B@@A@@@@A@@AA@@@@DB@@BA@BFC@@BA@BB@B@B@@BB@@@B@@@B@BA@@BA@AB@@@@@BA@@BA@@BG@@@C@@B@@BFB@AAD@@FB@BFD@@BA@@BAB@@C@@BC@@BC@@D@D@@C@@ACB@@A@@A@@A@A@A@A@CDAB@@A@@@@@@AA@@AEB@BC@BFB@@DB@BA@BD@BBB@B@@@@DB@@BHA@JF@@@@B@@H@@@ABA@@BAD@D@@@@@@@F@FB@B@@@@A@BB@@@B@@AFCHA@B@@@@@BB@@DBL@@B@@B@@@@DBFAD@D@B@DA@@FA@@@CB@@CA@@A@@JGDCFC@@FE@AC@@AG@@G@@D@@CJ@@AB@@BB@@BB@@B@@B@@BB@@@@BBBD@@@D@@A@CB@ACD@AGB@@AB@@AB@@A@@@EB@@@B@@AH@@BD@@AF@BFB@@D@BB@@F@@@FD@@D@BFAD@AD@@B@B@@BFA@D@@B@CHF@BFB@DTB@B@B@@@BD@@BD@ABB@@@@B@FC@BDA@BBL@BA@BF@@@@B@BBFA@H@@F@@B@BNA@B@@@@@CD@AIB@@BD@B@HA@@@DBA@ABJBAB@@CD@@@@AAAB@@AB@B@DAB@D@J@AGH@HA@B@@B@DAHA@C@A@@D@@@B@@AH@@@AAJAF@@BH@@D@@@B@@BFH@@@B@BJ@JA@@@@HBD@@F@D@D@HBLDB@F@F@BBD@BB@BB@DJ@@BBB@@BB@B@B@F@B@HCD@LCB@XE@AB@BCB@@@DA@@DAFAD@D@FBD@D@DA@@BBFDDBLFNDBBBBDBBAH@BADA@KB@@AB@@E@ED@@AB@@@@OBAC@@C@CC@BC@CBE@AB@@ABC@@@AC@BA@AB@@CCA@CC@@BC@BGC@@CA@@@BIBE@@@A@C@AB@BA@C@C@AJ@BA@CBEB@@ABBD@DBH@@A@CB@BGB@@A@@D@B@B@F@@@BI@E@@@CC@FQBBBGBGDGDGNBHQB@@CFICAJQEA@@CAC@QEC@CAKCGAGAGCC@@@GCA@EAIAAAC@GACAIAAA@@G@OCI@@ADMLk@@G@E@KAI@AB@@@@QA@DABGA@@AAABA@E@A@AFAH@BGN@@@@E@EACA@@EAGCGAA@ECEAC@A@CAC@CA@@CAA@AAAAC@EAEAC@M@A@C@C@E@GBE@GBKB@@C@CBE@GBC@A@C@ABCAOCA@QGA@MECACAAAKEKGYOCACAEACAKC"],encodeOffsets:[[115646,35665]]}},{type:"Feature",id:"410823",properties:{name:"武陟县",cp:[113.401679,35.099378],childNum:1},geometry:{type:"Polygon",coordinates:["@@CGAEIAEA@@FBIRDBEJ@DA@GRMACHCHAHAHAAERD@@D@@@FAJ@@E@A@A@C@@@@BA@AHA@@D@BG@CAC@AA@BA@AF@DABI@@B@D@DABA@@B@D@B@@AFAJ@@B@@DD@AHD@@AD@@DDB@DA@@BABD@@B@@AD@BA@@BAF@DADD@@D@DD@AB@P@@A@@BC@@F@FA@@BA@@LCBABG@ABCAAAAAAJA@@BFA@N@@H@@LH@@FH@@B@@@B@B@B@@@@@@BBAB@H@@BB@@D@BA@B@@@@@BD@@BB@@@B@@@@BA@ATC@ARF@@@@BAL@DD@@BB@AH@BVBH@B@AF@BB@B@@BAH@@A@@FfH@@@DLB^HFBJBFBD@JCJ@DADA@BBABAB@@@LE@EFAxC@NL@@OR@NI@DX@B@BBD@J@@DDA@@@@HBNDHEB@BE@APB@@@BL@JPCDF@@@FA@AD@@B@@@@B@@AB@@@B@@@B@@AF@@@@@D@@BD@@BD@@A@@@BB@@FB@@BB@B@@@BF@BN@@@@BB@@BB@@@@@ABB@@@B@@BF@@@@@@DD@@EAAB@B@DBD@ABB@@BB@@F@@BE@@@F@BJB@BBB@@@B@@@@D@BB@BA@@AA@@D@@@@B@@FA@@BB@@@@@B@@@@ABC@@D@@A@@BCB@BGA@@A@@@AA@BEB@@ADABAA@@CB@@@@@@AB@@A@@B@D@@@@@@@ADB@@BAB@BBB@DA@@@@@@BA@@@@@AD@@@FA@@@AV@@JB@E@@@CBCB@@CB@BCB@@AD@@BB@@CB@@AB@@A@CB@@A@@DBB@@A@@@@D@@B@@@B@@@D@@@BB@@D@@@B@@@@@AB@@AB@@D@@@AB@@B@@@AB@@B@@@AB@@BB@@BB@@@@@@BB@ABD@AFB@@@@@@AD@@AJ@@@D@@B@@@AB@@D@@@CDBBA@@@AB@@@D@@AD@@C@@@@@DDA@@@BB@@HA@@BF@AFB@B@@CD@@@FB@C@CD@@D@@@CB@@D@@@BB@DB@BB@@A@@JBDBBAHB@@AHABA@ADF@@FF@@B@@A@B@@B@DJ@@DBB@@@@FBBAF@@AB@@@A@BEB@AFD@BCD@@GB@@AC@@AA@@CB@@AD@BC@@E@@A@A@A@A@@GABAD@@EC@BGBEDB@AD@@AD@@AF@@@D@@EB@@E@C@@D@@@D@@@D@@ADBB@ADN@@AB@@@B@@CBB@@@@@D@@@AFB@@B@@ABAB@@GC@@ADB@AC@@AA@@A@@@CA@@@@EB@D@@AA@BG@@NB@CP@@@AB@D@@VDBBD@FBPDB@@EBGA@BA@@@@@@@@F@@AC@@AA@ACD@@@EA@A@@@CD@BEKABEDOBACA@AD@BCAA@@@ABGA@DG@C@@BCDEDEBBDCBEDGBEBCBC@@B@LB@@D@FB@A@@@@AA@BC@@@C@@ADB@AA@BE@@@IDBFSJBDEBCDBBEA@BEB@BCF@@A@@A@BGGA@A@@AADIA@AAAAKA@CA@A@@@@E@@A@AB@@C@A@@@A@B@HI@@BABAHCBMDA@@@AA@@@A@@@A@@DIA@BIAA@A@@CE@@BCA@A@@A@@@@A@@A@C@@A@@ADGA@@@E@@@CA@@DC@AG@@@CA@@AC@@AA@@CA@@AA@@@A@AGK@A@C@A@E@@AH@H@J@CI@@ACEB@@M[IUAA@@ePEBCDMJCBEBGDEBGBCBEBIBE@G@EBK@CBKBGDEDCBC@EBMDOBI@IBE@ABM@G@I@k@G@K@C@SAG@UAI@G@QAC@OCA@SCEAA@CAGCC@KCIAAAIAKAA@AAE@ICGAEAIACAEAAAC@OEA@EAEACAMCECMCAAGCACA@ACCCCCACAC@@EEEGGKACAA"],encodeOffsets:[[115949,35754]]}},{type:"Feature",id:"410821",properties:{name:"修武县",cp:[113.447755,35.223514],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@F@@BADE@@HCA@DB@@@B@@DDA@@B@@BD@@F@@D@@AF@FB@@ABDBADF@@BAH@BH@@DHBADD@@@@FB@@FB@B@@@@BD@@EJ@AHD@@DFB@DADA@ABB@@@@@AFF@@B@DB@@FD@@DB@B@@DF@ADHBABHBJ@@ADBD@A@DB@@@@@@@B@@@BA@A@@DFBAFB@ADB@@BAF@@@@@FC@AJ@D@@LBD@AJFBAD@FBDD@ADB@@BED@AA@C@@B@@ABC@E@@ABCA@BAA@@BA@A@BAK@A@@BABC@A@A@@DGAADAA@@C@AA@@A@@@@@A@@@@@A@ABA@@@G@@@@AC@A@@AA@@BC@AA@DA@@@@D@@AFEA@@AAADAA@BC@@B@B@BABAA@CB@BA@@CAA@B@@BC@BD@@@DCBA@AAABCDGE@AA@AC@@ACAAAA@A@A@@@A@A@@@AAACAAAB@@AA@CA@@B@@@AA@CBA@AI@B@A@A@A@AA@@CB@A@@B@@A@@DA@@@@@A@@C@EA@@@@E@@BB@@@B@@B@@A@BBAB@@A@A@A@@@AA@@@AAAAA@A@A@AB@@@C@@AA@A@@@@B@@@BA@@@DB@@C@C@@BB@@BCA@BDB@@C@@BCA@BA@BB@BB@ABB@@@AB@A@@GLCFA@@@A@A@CACEII@@GEAA@AA@@A@AECCAACA@@@AABAA@A@AACAE@@@CCACA@@@CAEAAAA@AAAAA@@@CA@@A@@AAAAA@@A@CAAA@@AC@@CA@ACC@ACA@@CCACA@@@@A@@@AA@CEAA@AAAAA@A@@A@@@AB@@AAA@@AA@@A@@B@AAAA@@A@AAACAA@AA@@CAE@CA@@CC@@AA@@@@@@@A@@FA@@B@@@@ABA@EBA@EDA@@BADABEHABCBABAB@BAD@BA@@@A@@B@@AD@@@@@B@@@B@@@D@@@@AB@@@BAF@@@@ABAD@BC@ABA@@BA@BB@@@@@BA@@@AB@@@@@@B@B@@@B@B@@@@@CDA@ADBD@@@@BB@BB@D@B@@@@B@@A@@B@@@B@@@BABABAB@BCDA@@B@B@@B@@B@@@@@BA@@@A@@B@@@@BB@@@@@BA@A@E@@@@B@@BB@B@B@@@B@@AB@@AB@DA@ABA@AD@@AB@@A@EDADABC@@BA@ABAB@@AB@A@@@@@C@@@@A@A@@@A@@@@@A@A@A@@AABAAA@AA@@@@A@@@@@AB@@@AA@@@A@@@A@A@@@A@AA@@ABAAA@AAA@A@@@A@@@AAA@@@A@A@@BA@@@AAA@A@A@A@AB@@ABA@@BA@@@A@@B@@@BAF@B@B@B@B@BBB@B@B@B@@ABA@@@@B@BB@@BB@@B@B@B@@B@B@B@@@B@@AB@@@@@B@@B@@@B@@@B@@@@@BB@AB@@@B@B@@@B@@@@@BB@@@@BA@@@@B@@@@@B@@@@B@B@@@@@@@@A@A@@B@@@@@@AB@@@@A@@@@B@@B@@B@@B@@BB@@AB@@@@A@@@@B@@@@@B@@BB@@@@@@@BB@B@@@BB@@B@@BB@@@@@B@@@@@@A@@B@@@B@@@@@@@B@@@BB@@@@@@@BA@@@@@A@@B@@@@BB@@B@@@B@@BB@BB@B@@@B@@@@BB@@B@@@B@@@@@BB@B@@@B@@@@A@@@@@A@@@A@@@@BB@@@BB@@A@@B@@A@@A@@A@@AAA@A@@@AA@@@@BA@@@AB@@@@AB@BA@@@AB@@@@B@@@B@@@B@@B@@@@@@@BA@@@@@@BB@@@@B@@A@@@@B@@@@@@@@@@@@@B@@ABA@@@@BA@@@A@@@@@A@B@@BB@B@@B@@@@@B@@A@@B@@AB@@BB@@@B@@B@BABAB@@@@A@@@A@@@A@@@@BBB@BBBBBBB@@@B@BAB@BAB@B@@@@@BA@@B@@@@A@@BA@@@@@@@@B@@@@A@@@@BB@@BB@@BB@@@@@B@@@@@BA@@@@@@@A@@BB@@@BBB@@@@B@@A@@BC@A@@@@@AB@@@@@B@B@@@BBB@@@@@BA@@@@B@@@@@@@@B@@@@@@@@@B@FAB@@@BB@@@@ABCD@D@@DBBHALBJ@DC@ABB@BBR@H@LCHBLDHDPDHAD@DEB@D@B@B@DAFGFC@@BABABAD@JBB@B@BABA@@@C@ABADAB@D@B@FBJ@B@FAFANEJEB@FAB@B@BBBB@B@BADCDEFABA@@B@JDLHDFH@JFBFGFKF@LD@B@FCHYJMBO@@DBDXPJDNCJODCH@B@DABBFADBB@BA@@B@F@B@F@BBB@@BB@@BBBBB@BB@@B@@BD@@BBB@BB@BB@H@B@BA@@D@B@B@DB@@BB@@B@@BBB@B@@B@@DB@@@BDB@BBBBBD@@DD@@B@@@B@B@@@BA@@@A@A@@BA@@BADABC@A@A@K@@@ABAAC@@@A@ADEFEDABA@@@AA@BA@CAC@@@AB@B@DAB@D@D@D@BABA@@B@BAB@B@D@DADAB@HCBCDAHCDADA@A@A@AB@BABC@CFCDEDABALEBA@@@@@A@A@@@AB@@B@@@@B@BA@A@@C@@@@@@@@A@@B@B@@A@@B@BADG@@B@@@BABEDEBABB@A@CA@@AB@@@BA@B@@@AB@@BB@@A@@@AF@@@@CCBC@@A@@@AB@AI@@@@A@C@@A@@A@@@AAB@@AA@@B@@@DA@@A@@@AC@@AA@GBACD@@B@@D@@@BA@@@AAA@@@ADDD@@@BA@@@A@@@BB@@AB@@@D@CCF@@AE@@@J@BABA@ADB@B@ADGD@@AB@@@B@@CB@@AB@@@CABCA@@CCB@AA@@@D@@AC@@CB@@@B@@A@AC@@AB@@AA@AB@@@BGA@FA@@CA@@AA@@FI@@AA@@AB@@C@@@C@@BCA@@AF@@@J@@@B@@AG@@BEA@AD@@AD@@AA@@@@@@AC@A@@B@@A@@@@A@@@A@AA@@@@CF@BGJB@AB@BG@B@@@@BABD@ABA@AD@@AB@@CB@@CBC@@EA@CC@@CA@@EA@@C@AB@@@B@@CD@BCHBBE@@@@BEEA@AAB@@BG@@BACABA@CEAB@B@BG@@@@F@BCH@@AHB@AD@@@@DB@FB@DB@@EB@@@BAB@DBB@B@@A@AD@@E@ABA@AB@B@H@B@@@@@BEA@BCEA@C@@@A@@@@@AA@BC@@@A@@@BD@@A@CA@B@A@@EA@@@@@@C@@BAAAC@@CA@BGA@@DA@@AA@ACBCAA@BAA@@F@@BA@@BB@@@B@@CC@@CD@@@@A@AB@@B@@@AB@ABB@@AB@ADB@@CB@ADB@@A@@@@B@@AB@D@@AB@BB@@@A@@@A@@AAA@@@@@BA@C@@@@B@@F@BB@@BH@L@@@BI@@A@BMB@BGFBBKD@BEF@@@@CAI@@E@@BA@@BAAAAAACAAAADMAE@AAA@CABIEA@EGABE@G@@DSA@@AA@BGA@BAG@@E@@BA@@B@DIB@@CI@@C@AA@B@@@@AE@@EE@BCB@BABG@@GAABCAIA@@@BA@@ACAA@@A@@@CA@@D@@@CC@@D@DEA@@C@@DA@A@BEE@@AB@@GA@@A@@CB@C@@@@@DC@@BC@@@A@@B@@ABCA@D@@@CA@@B@@@AC@@@I@@BC@@B@@@@A@BEC@BAA@@A@@@@A@@AA@@AA@@B@@@AA@@B@@@AA@@B@@@CA@@BA@@B@@@@@A@@@CA@@A@@@C@@@A@@@AC@@@@@@BA@CA@@@BA@@D@BA@@BA@@DA@@AC@@BA@ADA@@DA@AD@D@@@FIA@@BU@@B@@E@@BC@@@@B@@A@@@@B@@CAA@ABA@AA@BC@@@@@@C@A@@@@BA@@B@@@@A@@DB@ABCB@BA@AFB@@B@@@BB@AHA@AD@@@BC@@@AD@B@@A@@@@@A@@AB@@EA@@@@@@CB@@BB@@AAAC@@@@@@A@@AA@AIA@A@E@@AF@@@EA@@AA@BAC@CAA@A@BB@FC@@C@@@@E@@AA@@@A@BA@@@@A@@AA@@AAD@DB@ADAAAHAD@D@@A@A@CFB@@BA@AJA@ABAHGDC@CBA@AH@HAHHDAFA@AHI\\CBCBADAJCBCBAFA@BEG@ABE@@@CJKD@@@D@BAJF@@B@@@D@@@@B@@@B@@@@F@BBAB@BBAFB@D@D@ABHB@ABCFB@AF@@CJ@@@JBAJ@@@B@B@@"],encodeOffsets:[[116103,36089]]}},{type:"Feature",id:"410883",properties:{name:"孟州市",cp:[112.791401,34.907315],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAC@E@A@CBC@A@@@CDCBCDCDCBIHABMFABCBABA@OFE@CBOBC@E@E@E@A@KACA@@GAECAAA@A@ICC@CAA@A@G@E@G@GBA@A@K@IAC@A@ABKFA@@BEDGBABIBEBCB@@@@CD@@ABCBCDEB@@ULCBGDC@CBEB@@EB@@CBC@UCCAA@GC@TBD@B@B@@@B@HA@BH@B@R@@@@H@DBF@@B@D@BAPEAEAEA@BE@@BA@@BBBC@BDC@@DBH@@@@D@@FB@BHA@@HC@@BBB@BC@@BB@@@AFA@@@@@C@C@AAA@ECCCACA@A@@AEB@@E@@BC@@@@B@@CBA@@AA@@AC@@B@@B@AB@@A@@@@BA@A@@AA@@BA@@@@DGB@BBB@BDBDB@@@BBB@B@@A@ACA@@@AAA@AA@AAA@AAA@A@@A@@B@A@A@@@@@AEB@@AA@@A@A@@@ABAA@@ABA@BB@@@B@@A@@@A@@B@@@@A@@@@B@BA@BD@@A@AACB@@@@BF@D@@B@@@DBAD@@@B@B@BAB@@@@AAA@@@BB@@A@A@@@A@@@AB@@A@@@@B@B@@AC@@@AA@@@@@CD@BCD@B@F@B@@@B@@@B@B@@BB@@@@B@@@@B@B@B@@B@B@B@BB@@@B@@DB@B@@@B@BCBA@@BBDBBB@@@@B@@@@@@ABA@@@@@BBD@@BB@@@@BB@@@@ABC@@B@@@B@@B@@@@CB@B@@B@B@@@B@@@@@B@@AAA@ABA@@@@BA@@BB@@@@@B@@@@B@DA@@BA@@D@@@BBB@@AB@B@@@B@BAB@@@CF@@@B@@BBB@@@D@@B@@@BB@@@B@BBB@@@BB@BB@@@BA@@BB@@B@@BB@B@@@@@B@D@B@BBBB@@B@@@@@B@JAB@@@HEB@@@DB@B@@@@B@@BBB@@@@@@@@@A@A@@BB@@@@@A@A@@H@D@@@B@F@@BB@@@B@@@B@@BA@@B@B@@B@BDD@@BC@BB@D@B@@@D@BB@@@B@@@BCBB@@@BABAB@B@@BAB@@BB@@BC@@@H@@@B@F@@@@AB@@@F@@A@@@AB@B@@@BA@@D@BB@@@BBAB@D@@@B@@BA@@@@@@@@BF@B@@@@@@DB@@A@@@@@@@D@@@@A@@@A@BBAB@@@@@BB@@FB@B@@@JA@BDFA@@@BD@@A@BDA@@BB@@BA@@@GB@@BD@@@@@BD@@@@@B@F@B@D@@@B@B@D@@@D@@@@D@@@BA@@AA@@DB@@BB@@@F@@BC@@BD@@@A@@B@@@@A@@B@@@BB@@BB@@B@@B@@BB@@@B@@@@@@AB@@BB@@A@@@BBAB@@BDABBDA@@CBB@B@BD@@@@@@@AB@BF@@A@@BB@@@@@@@B@@BA@@BB@@D@BA@@@@@A@BB@@B@@@@@B@@AAEJA@@F@@@F@BDF@@BHA@D@@BDB@BA@@@ABA@@@@B@B@@BB@B@D@F@JBD@@@BABA@ABA@@@@B@@@D@B@@@BABA@AB@B@DBB@B@BA@@@A@@@@@AA@CA@A@@@@@ABA@@@ABABCB@DA@@B@DBBAB@@@@@@A@A@@@A@@B@DAB@BA@AB@A@BC@@B@BAB@DAHA@@@A@@AA@@BAAA@A@@@@@A@A@@@AB@B@D@D@B@BA@@@AB@D@B@B@B@B@BA@@B@@A@@@A@A@AD@@A@A@@FA@@F@@CC@@@DA@@@@BA@A@@FA@BD@ACF@@@A@@BD@@DF@JA@@DDBBHFJ@F@FBBB@@A@@BA@@B@@B@@@@@BAB@JKFCA@@CA@AED@@AFA@BB@@B@@@@B@@@BADCB@B@B@B@@@@BB@@@DA@BD@@@@C@CD@@AD@@AD@@@BA@AB@@AC@AEA@@EC@BBA@AE@@@AD@@@H@@AB@@AB@@A@@@@BAB@@AB@@A@A@@@A@@AA@@@A@AAAB@@AD@AEB@@AA@@C@@B@@BB@@@@@@BA@B@@@@BB@@AB@@BB@B@D@D@B@B@BAB@@AB@@A@AAA@ABC@C@AAC@AAAC@@C@AD@@CB@@CD@BG@@BBDAB@B@B@@@D@@@@A@@BA@@BAB@@@B@B@BAFA@@BB@@DCDA@AB@B@@@@ABA@@B@AA@@@@@CA@@@@C@A@A@@BE@C@ADI@@@A@G@E@GAIB@AO@I@C@CB@@S@E@A@M@@AQ@E@@@GC@ABIBA@ABCB@@ABGJADABADCBCDCBA@@@ABK@E@A@@@A@C@C@AAE@AACAAACCCAGGGIAA@C@CAAACEECCCCCCAAAA"],encodeOffsets:[[115563,35650]]}},{type:"Feature",id:"410882",properties:{name:"沁阳市",cp:[112.950716,35.087539],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@@CC@@AB@@@E@BDC@@AEB@@@BAB@@@@CB@@D@@DE@@@EB@@@B@BC@@B@B@B@@@BA@@@ABA@A@A@A@C@A@@B@@ABA@C@C@A@A@@B@@@B@B@@@@@BBBAB@@BB@@@B@@GBCBA@ABA@@@ADB@A@@BABA@CBA@@@@B@@@B@B@@@@A@ABCAA@@@CBA@ADAB@B@@AB@B@@@@@BDBB@@B@@@@@B@@ABA@A@CAA@A@@BABAB@@A@C@@@A@@@@@AB@BABAB@@C@IAE@C@A@A@@AA@A@@@@@AB@B@@ABA@C@ABA@@B@@@@@@@@@@@B@@B@B@@AB@@B@@B@BA@D@@@DBDB@@@D@BFH@@DBBGB@@ACA@@AA@@@A@@@@@A@EBDND@@@BDBD@@B@@BF@@BF@@BA@BJBA@DB@@DA@C@@B@@G@BH@BB@BFB@HABBB@@@D@@@@DBBCB@@@B@@@F@@CBBB@@BD@@@DA@@BB@@BB@F@@BH@@DB@AB@B@@B@DBBDG@@@@@@BFA@BBAB@B@@BB@@C@@DDDB@@CBBBC@CB@B@BA@BD@BABB@@@C@A@@H@B@B@@BHAABRCB@@B@@JA@@DAB@HC@@@E@BL@BA@@BC@@@@@@DF@@BD@@HFA@BC@@DDA@BB@@@B@@B@DD@@H@HB@@@B@@BBBBB@B@@A@@B@BB@CDID@@CDAD@@@B@B@B@B@@BBAB@@A@AB@@@@@@@BA@@B@B@B@@@@@B@@@@@BBB@BBAF@@@F@B@DDHDFHFHBHBDDBB@BBBDBB@D@@ABAD@D@D@@FDDBBBB@@@D@D@D@B@BB@@@@BB@@FDDF@@B@DBDB@BB@DD@@@B@BBDBD@@BB@@BBD@B@D@JALADA@@B@D@B@@@B@D@D@DADADAB@B@B@BB@BB@BA@@BC@A@C@AB@DADAD@BAB@D@BAB@J@H@B@H@D@@@@BBBBBB@JBDBB@B@B@DAFAD@BAD@D@FBFBDBFDFBDBB@@B@BB@BBDDDDDDBDBD@D@@@B@BB@@DBB@B@BABAB@BAB@@@HBDBBD@D@D@HBD@HDB@BBDBJDDBDBD@D@DADADCB@B@DAD@B@BAB@@@B@B@@@BB@@D@@BB@BDBDBB@D@B@D@FADADAD@BAB@B@@A@@AAB@@@@@BBBBBB@B@D@@@B@@@@A@EBA@@BA@BB@@BBBB@@@B@@@@ABA@@@@B@@@@B@@@B@@B@@A@@@@B@BB@@B@@@BA@A@@@@@@B@@BBDB@@@@@B@@@@A@@@@@AB@AA@@AA@A@@@AB@@@FB@@@@@A@@A@@@@AAA@@A@@AA@@@@@@@BAB@D@B@@@@AB@@@BA@@B@@@BBBBB@@@BA@A@@B@@@B@@@B@@@B@@@@@@@@A@@@@A@AA@A@@A@A@AAAAC@@@@@@@BA@@@ABA@@AC@CACAC@@@A@A@@@A@A@@AAA@EAAA@@A@AA@@@@@@BAA@A@@@@AA@@C@AA@@A@@@@AAA@ACAAAAA@A@AA@A@A@C@AA@@@AA@BA@@@@D@@@BGF@B@@@BB@@@AD@@@@@AAA@@A@@@@C@A@A@A@@C@@@@@AA@@BA@A@@@@@@GC@@@AA@B@@@BAB@D@@@B@B@@@@@BAAA@@@@@A@@@@D@@@@@BAB@@@BC@@@@B@@@@BB@@AB@@@@@B@B@@@@A@@B@@@@@@@@@BAA@@@A@E@@A@@@A@AB@@@BB@@@@B@@A@@@@BB@A@A@@CAA@@@@AB@B@@@@@@A@@@@A@AA@@B@@@@A@AB@@A@@AC@@AA@AA@A@@@AA@@GCA@@B@@@B@@A@@@@AA@A@@@@@@ADC@@@@AACBA@A@@A@@@@@@BA@@B@B@@@B@@A@@BA@@@@B@@@@D@@@@@ABA@@@A@EAA@@@@A@A@@@@AB@@AB@@A@@@AAA@AA@A@A@@A@@@AB@B@@@@A@@@@A@@@A@A@ABA@@@A@@@@A@A@@BAB@@@B@B@DA@@BA@@B@@@B@BDB@B@FBB@B@FBBBB@@@@B@B@@@B@@BBBB@BB@@@D@D@@B@@@@@B@@@@@@@@B@BEBA@AB@@@DBB@B@@@@@@@@@B@@@@AA@A@@@A@A@@@@A@AA@@@@@A@BAAA@A@@@A@@@@B@@@BB@@@@@@B@@@A@@AA@AAA@AA@@@A@@@@CC@@AAA@AA@A@@C@A@@A@@A@A@@@@A@@A@@@A@@@@@@AAAAA@E@@AA@@AA@AA@CAAA@@@A@@@C@@B@@@B@@@A@@AA@AAAAA@A@@AAAA@A@@AAAA@@@ECAAA@AC@AAAAA@AAAAAGCCAAAAAC@C@ABA@A@AAII@@@@BA@@AA@@@@BA@@@@@@@A@@AA@@A@A@@A@CBC@IB@@@@@BC@@@AA@BA@@@@B@@@@A@@@@@A@@@@@A@@@A@A@@DCDE@@HAD@B@B@DA@B@BBD@@B@@AB@@@@@@C@A@@@@C@@@@A@@@A@@@AD@B@@A@@@@AAE@@AAG@A@C@@@A@@@AAA@A@@TCD@@@@@@A@AA@@C@A@@C@AA@@B@BA@@BAF@@@@ABC@@BABA@A@@G@BCBG@@@@B@BAF@@@B@B@@AFE@@BAB@BAB@BCDCBABABCFC@@@ABA@ABAFCBAD@FABA@@DC@A@A@A@ABA@ABA@@BAB@B@B@B@BBFB@@B@B@@@@A@A@C@ABA@A@C@@DIBCB@B@FAB@B@BAB@D@F@FAB@@@DABA@@AC@G@@B@@IAIA@@@G@AE@@@A@@@CG@@AE@IBBB@@G@@BA@@@C@@@@B@DGBCBA@@@@AGBG@BHI@C@A@CBA@A@@BA@BB@B@@C@@DA@ABAI@BAB@C@@GBA@C@@AA@BJC@@D@@@@@AMB@A@AE@@@@GEBAAA@@@@@AEB@@AAK@ACB@AEDA@@@@@AA@BAC@@AC@@A@A@A@CSA@AEE@DGA@@@@CEB@AA@A@@@BCC@EB@A@CC@@E@@@EA@@A@CA@AEE@@BC@@AG@@BA@@@A@@F@@@BA@@BA@@BA@BHC@BDA@@D@BC@@@C@AA@A@@A@@AA@@@@AA@@AA@@AA@@BI@@DC@@@@HH@@BD@@BEF@@EDCDIH@@@BB@@DA@@D@@EB@@CBA@C@C@EBCA@@@@@AA@@@AK@CA@@A@@@@@AGBED@BA@@@A@@A@B@@A@A@@E@E@@@@@@@CBC@AB@BA@@G@@@@A@@E@@IGB@AA@@C@@A@A@AAC@@AABEDILA@AB@@@@A@@@@AB@@AB@@@AAEAE@I@GEAACC@@IB"],encodeOffsets:[[115536,35831]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
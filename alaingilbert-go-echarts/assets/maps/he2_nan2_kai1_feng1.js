@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("开封",{type:"FeatureCollection",features:[{type:"Feature",id:"410202",properties:{name:"龙亭区",cp:[114.356076,34.815565],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@E@C@M@A@@A@A@A@A@C@@@A@EAGCA@A@@@@E@@@A@@AGAA@AA@@@@@EAC@@@I@A@@A@A@@A@@C@A@AA@@@@A@@@@AA@@@AAA@@BA@@@BD@@B@@@A@A@A@@@I@@BA@AC@@@AA@A@@@@BA@@@A@AB@@@@A@CBCDAB@BAB@BA@@@C@@@@AA@@@A@@@@AA@C@@A@@C@AGA@@HA@@IA@AE@CFAA@@AF@@AC@@@DA@@BA@@@AGB@ADA@A@@A@@CB@AGA@@BA@AC@@@CB@AA@@E@C@CBA@@AAAE@I@@AAA@E@C@C@EBG@AAAAAC@A@@A@AAA@C@@@C@@BABABAB@H@D@D@@@@C@@AAA@EAA@CB@@@A@@@A@E@CK@EBE@@@A@EACCA@C@G@CAC@A@A@CBCDCBCBIBODCBA@EAGBA@EBABE@GBCAMAC@ABMBBDA@AGA@@DA@@A@@@@A@AAA@BF@@@@GBAGCBACB@AADA@@C@@AD@@AC@@CAB@AE@BBA@AFA@EFA@BDBDD@AD@@@@A@AC@@BFA@@BC@@BB@@@C@BBAB@@@BA@BBA@@AA@BBCB@@F@BFB@BF@@AA@@@@@@@@@D@BB@AF@@B@@@B@@AB@@BDHB@BD@@@B@@@BB@@@B@@A@@@BB@@BB@@A@@@@D@@DA@@BB@@BA@@DA@AC@@BHA@@B@@@B@@BFA@@BB@BBB@D@@@B@AAD@@BD@@AAAB@@BJABBA@@BF@@@FDB@@FB@@D@@@BA@@BA@@BC@BJA@A@A@@BD@@@KB@BD@@B@@E@@@A@@BA@@AC@@BC@A@@BA@@@C@@BA@BFA@BJ@@@@@AGB@AA@@AC@@B@@@DA@@DA@BDGBBBGBCDB@@@A@@BA@@@KB@BC@@AD@@CC@@@AAED@AABEB@@BBA@@LBD@@C@A@A@@B@B@F@DB@@BB@@B@BBD@BBBB@BB@A@CB@@AD@@A@@@@@@@AB@@BB@CNB@BKD@@BB@DHB@B@DABD@@D@@AD@D@@DFA@BDABFB@BADHBAAE@@@AD@B@@A@AD@ACDADHB@@@BBD@@@B@@AB@@@DB@AAEBAAAFA@@@AAAJA@@@DD@BBDA@AB@BDB@AEBBD@@C@@JB@@B@@@H@BB@BFBBFA@@FA@AC@@@DA@@BHABDA@BBD@@D@BA@@D@BB@BBB@@BBJA@BFGABBA@BHB@@DBB@@BFB@@FDAFX@BI@@@@@@B@D@L@FDD@BBV@DBB@FDT@@DAB@DADADAD@D@D@HAD@FAFAB@BEBABADCBABAD@DAHAF@A@LDHBFDDBDBBBNDF@L@BBD@H@R@DBH@H@D@D@D@H@FAF@TAD@@@PAB@FBDBDBD@DDDBFDBBFDDFDDJNHH@BBFBB@@BBB@BBDBD@FDDBD@B@B@B@DAHAFADABAB@BAB@FCFCBAFCHCHEG[EOACACCMA@@@AGA@@@A@@A@@@@A@@@@A@@C@CB@@@C@@B@BB@@@@@E@@A@@BEB@@A@C@@@@@@AD@B@@@A@C@@@@@B@@@AA@ACB@A@@GB@@@A@@H@@AGB@@@AA@CB@AA@@@FA@@@AEB@@@@NC@@@@@@@@G@@@@@@@EB@@AA@@F@@@@AE@@@@A@@@@@@@@@A@@DAAE@@B@B@@@B@@BB@HA@@@AF@@@@@@@@BB@@@@A@@D@@BB@BAB@@@CCBAA@HA@A@@@A@@B@@AA@@@@@A@@AEEBCC@A@@C@@@@B@@@@AAE@AHC@@AAA@@@AGAEA@CBAA@@A@A@@@CAECACAAA@@ADA@A@@BB@@@B@@BAB@B@@@@@@@BBBAB@@@@AAA@@@A@@HA@@@CBE@AB@@A@@@A@@@@B@B@B@@A@A@@A@C@@A@A@E@@@ACAA@@@AC@AA@@A@@A@GC@@A@@@@@@B@@A@A@AA@@A@@BA@@@@@@C@@A@EA@A@@I@@@G@@DAB@@@F@BAB@DA@K@@@BH@@ABA@ABG@I@A@A@@@CC@AAA@A@AD@@A@@ACB@@@B@@A@CAC@A@A@A@C@@AE@@I@E@A@@FEBA@"],encodeOffsets:[[117092,35636]]}},{type:"Feature",id:"410205",properties:{name:"禹王台区",cp:[114.34817,34.777104],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@BAAAB@@ABCA@@AA@@A@CG@CGB@ACEBBDA@CCA@AA@@CB@B@@AA@ECB@CA@@BC@ACE@@AA@@DA@@CA@CB@B@@AAC@BDA@AC@@BDAB@B@@ACA@@AC@@BCB@@E@@@C@@@@@@AEB@CA@@BCB@@AB@AA@@@E@@BBFBA@B@@@BG@@F@@@HA@A@@BG@@G@@@AB@AAG@ACB@@AE@@AA@@@E@@@C@@ACB@F@@E@BLA@@BC@@@@@@D@@@@CB@DIBA@@BC@@@@@@BA@@CC@BDC@BFA@BHCB@CA@@D@@@B@@A@KB@AE@@D@@C@AA@@@@A@@AC@@@@A@@@CE@@@A@@@A@@CE@@A@@AEA@@B@@@@A@BDC@@CA@BBA@DJ@@@@FL@BDNDHDDHN@@@@BD@L@DBD@@BL@D@BBJ@@NAH@@@JAF@B@LABA@@NAB@R@T@DAN@@C@ASAS@AB@@MBAC@@DAA@@ABADEBABA@@FADAJEJCLC@@BDB@BBBDDDBB@@B@B@@@@A@ADADABAAE@@AE@@F@@@B@@BDAB@@@BCDCFAB@BA@BB@D@FEAC@AB@B@@@BADEBA@ADEBBDE@CAAFE@@B@BCB@@A@@@A@C@@@A"],encodeOffsets:[[117193,35554]]}},{type:"Feature",id:"410203",properties:{name:"顺河回族区",cp:[114.364875,34.800458],childNum:1},geometry:{type:"Polygon",coordinates:["@@@DM@CBS@Q@A@MB@@ABA@@@BDDH@@BH@@A@@DA@@@@@AAA@G@@@@B@BI@@FJ@@@BF@@@D@B@B@BBD@D@BA@@@A@BD@@@BC@@B@BBB@BDD@@B@B@J@H@BAB@BA@@AG@@L@B@@CBA@A@E@@BA@CH@@@J@@@@BFBB@@@@D@@@@B@@AB@@@BBB@B@@@@A@@@@B@@@HDB@@@@BB@@BBD@@B@DB@B@@@F@B@BD@B@@@@B@BA@A@A@@@@@@B@@@BA@@BAF@D@@GB@@@B@@BB@B@@A@ABAA@@@@@@A@A@AB@@@A@@AA@@@BCB@BB@BBBDFDDB@@B@B@@@BBDAB@BF@E@@@@@@BB@@@@@@@D@@B@@@@B@DB@@@@BD@@A@@@A@@A@@@B@DA@@B@AC@@D@@@@BB@@A@@A@B@BAB@@@@@@B@@B@@@@@@@@@@@@@@A@@@@B@@@@F@@@@@@@@BB@@@@B@@@AH@@B@@@@@@@@F@B@@E@@B@@@@B@@@@@@D@@@@@@@@@A@@B@@@@@@@@B@B@@J@@@@B@@LA@AB@@@@B@DB@@CB@B@BBD@DB@BB@@@@A@@@A@A@C@@J@BA@A@AC@@@@@@@@A@@@@@@@B@@A@@AA@@@@@@CB@@A@@@@D@@@@C@@@@@BB@@@B@@A@@B@@A@@@@@AD@DCDA@@@AD@@AA@B@TEBA@@@@B@@ADABA@AB@@@FA@A@@D@@@B@@A@@BHDA@@BDA@@BB@@@BA@BDDB@@@BB@B@@B@@@AABAJA@@@@@@@@BD@@@@@@B@@CB@B@ACAEHC@A@@@E@@@AF@@AACCBA@@@AEA@@@ACGB@CC@ACA@@ACB@AAGC@@@AGEB@AA@@AE@@@FA@CA@CBAAIB@EIBACEB@IA@@@A@@AA@@AA@A@C@@A@@@AC@@CE@@@BA@@@@AC@@CI@AK@CCAB@AB@A@AG@@AB@@EBA@@@C@@AC@EB@@C@AGB@ACA@@ACAAE@@EB@@@CE@AE@@ABACED@AABCBCB@B@B@@A@A@@@AACCACAAA@AC@@KDIDIFCBEB@@ABABCFAB@BB@CB@@BDNA@@BAT@TB@B"],encodeOffsets:[[117147,35611]]}},{type:"Feature",id:"410204",properties:{name:"鼓楼区",cp:[114.348306,34.78856],childNum:1},geometry:{type:"Polygon",coordinates:["@@AG@AABA@@@A@A@@A@@AA@E@AAAAAAA@@CAA@G@E@E@A@ABEDID@BA@@AGFCBC@CBA@A@A@CBC@ABAB@BAD@BBBBBB@A@DB@@@B@D@B@BDB@F@DABABC@@D@F@B@@@B@@DAB@FBB@BB@@@D@@C@C@G@A@ABABAB@@@D@@@DBB@B@BB@D@BBBB@BAH@F@D@D@FBB@BJ@F@BB@BB@DAD@F@@@BBA@@D@@BDB@@AB@BHA@@DB@@@@BCB@BHA@B@@AB@@CB@@D@@BE@@BB@EB@DBFB@@JB@@GB@BHD@@@@BD@B@@B@@B@@@B@@B@@D@@@B@@ABA@ABADCDAB@@@@@BAB@@@B@@A@@B@B@@B@@BDB@@AJ@@@B@B@B@@@A@@@AC@@B@@AB@BB@@B@@B@@B@@@@@BBB@D@@@@BB@B@@@@B@J@@BD@F@@@@BBB@HB@BB@@@F@@@@@@BDBBH@F@B@@@D@B@B@B@BB@N@D@F@D@B@FA@EB@F@@EJ@@A@A@@H@B@BB@@@@B@@CB@@@AG@@CGAC@@B@KBA@E@IB@@G@MB@@AI@A@CAK@@AC@C@KAC@@@@GMCCCGCM@AEK@@@@CIB@AAB@@DD@AC@@CEEIAC@AAC@A@GCMAGAI@@@AA@A@ABA@@@@A@A@AA@@@@@A@A@@A"],encodeOffsets:[[117049,35522]]}},{type:"Feature",id:"410221",properties:{name:"杞县",cp:[114.783139,34.549174],childNum:1},geometry:{type:"Polygon",coordinates:["@@@C@@@@B@@@B@DB@AB@D@AAD@@@@AB@@C@@@@@CA@@B@@@AA@@A@AC@@AA@C@A@AAA@@@C@@AA@@@G@@CC@@@@@A@@@@BA@@@@AA@@@A@@BABGEG@BA@GC@@A@@@@@@@A@@C@@AC@@BG@@CA@@B@@@AA@@@@BC@@DE@@@@FA@@E@@@CA@@DA@@DA@@@@DB@@B@@GB@@D@BD@@@BA@@B@@DA@CB@@@B@@@DAB@@@B@@DB@ADC@@JA@@@@BCB@@@AAB@@A@@CA@@CC@@FA@@BE@@B@@@BE@@BA@C@IB@@C@@@CA@BA@@@E@@B@@C@@C@@@AA@@C@@CBA@AA@B@BE@@A@@C@A@@BA@@BA@@B@@@BB@AB@B@FA@A@@@A@AAABG@@@A@@AA@@AC@@@C@@@@D@@@AA@@AC@@A@@@@@AA@@BC@@A@@@AEB@@ABABA@@@A@AB@BCBAD@@GH@DAB@@G@@BA@@BI@@AD@@AC@@DB@@F@HC@BF@@A@BDIB@BCB@B@BD@@B@@@DB@@JA@BDHA@BBDA@@BD@@DB@@B@BDBB@BBAF@F@@I@@DA@@BB@@B@HD@@DD@@DHE@B@DC@@B@BB@@@@B@DA@AFA@@DA@@@@DA@@B@@@BD@@@F@@@@B@@@@C@@BHABB@FA@@@A@@BC@@BA@@@@D@B@@@@@@@BB@@@A@@B@@@@@@B@@@@@@BB@@LD@@DC@@BCB@BA@G@@B@@@BBB@@CB@BB@A@BJA@BB@@@@R@@@@@L@@@D@D@B@@DA@BH@@@BBDCA@@A@DJF@@BB@@B@D@B@B@@AB@@G@@B@@F@@BCBA@AB@BA@@@A@BD@BA@@@B@@BA@BDA@BBA@@A@@A@BD@@@AB@@@B@@B@@@@BBB@B@@DB@@E@@BF@@BF@@@B@@@B@@@BE@@@BBB@@DA@@@B@@J@BA@@D@@@@C@@D@@C@@DA@@@C@@B@@G@A@BBE@@AGB@BAB@FD@B@@D@@@DA@@BC@@DA@@DF@@DBBDB@DH@@BA@@DB@@D@@@FD@@DC@@@@@C@@DI@@BA@@@@@BDE@@B@@@@F@B@@@@B@B@@J@@FB@@@@FD@@CB@@@B@@BA@@BH@@BC@@@AH@@@DC@BLAAAHB@AFC@E@@DA@@AA@@@A@@AE@@AA@@@@@C@E@@CA@ABA@@@@@@JEB@H@@ABGB@AC@A@@D@BE@@@@BB@@BA@@@@DBDD@@B@@BFBJC@BHC@BF@BAA@B@ND@@@D@@B@@@B@BD@@@@FA@BHB@@DA@@BBB@DA@@FF@D@@@@@@BFV@@D@@@@HCD@DD@@D@@A@@FAA@FAA@@@@@BCAC@@BA@CFA@AE@@A@DNDABDA@@@BFBA@DC@@BD@@FBB@@@DAB@@A@DHDA@DD@@BD@@@CBBBPEBDD@DFFA@DBDABBB@BB@@DBBEBBBA@BDC@BFA@BBC@DF@@@@@@ABBFDA@BBA@BB@@@D@DHDABF@@FA@B@@@B@N@@BBBA@BB@@BB@@DB@@BB@@D@B@BC@@BA@ABAB@@@B@@@D@DA@@D@@@BBDBFA@BD@DD@@B@@@BA@@@A@WB@@@@AH@@A@@B@@B@@@@BAD@B@@@@@@@D@@A@@@@BAF@@B@@@AD@@@@@D@@B@@F@@D@@B@DA@@B@DB@@@@@@CD@@@@@@B@B@BML@@@@@B@@@@D@@@@D@@@J@@DB@@@B@@B@@B@@@@DB@@@B@@BBH@A@F@B@@A@@D@@@@@BA@B@B@BB@B@@B@BB@@BD@BA@@JA@@DBLAFAB@@AB@@@@A@@B@B@@@AD@B@@AD@@F@@@BA@@B@@@BBB@@@L@@@@D@@AB@@@@@@@@BB@A@@@@HA@@@B@@B@D@@@@B@@B@@@BD@@A@@@@B@@@@BB@@@@B@D@@@BF@@@@CB@@@BD@BJ@@D@BD@@DA@FBA@@@BB@B@@D@B@@@@@@D@BDB@@B@@@@FA@@@@BF@@H@@@@@@CF@@@D@@@@A@@FA@@BB@@B@HAB@@HDA@@@BBB@@BAB@B@ABF@H@ABB@@C@@@BB@@AB@@D@@@@@C@@@@@D@@B@@C@@@@B@@@@D@@B@@B@D@@@@D@@@B@@@@DF@FB@BBB@@@DB@B@B@@B@@B@@BB@FABDB@@B@@@C@@@DDA@@@@BBD@@D@@@CB@@DB@@CA@@@B@@AF@@@C@@@F@@@A@@BB@@@@@B@@AB@@CN@BEA@@@@IB@@@@@@@@A@CF@@A@@@AB@@@@F@@@EB@@@B@B@@@D@@CB@@@F@B@@@@@B@D@@@@@B@@B@@BA@@@B@B@@BB@@@A@A@@@BBAB@@A@@@BB@@B@@@ADA@@C@@@BA@@@BBABBB@@@B@@@B@@@@@B@@C@@@D@ABD@@@CB@B@@@F@@@FAB@@@BBDB@@@AAAB@D@@AD@@BD@@DB@@D@@B@AAB@@BB@@AB@@BB@@AB@@BA@@@F@@BC@@BB@@AD@@@@@@@@BD@@@@@@@@@@A@A@@D@@AD@@AB@@GB@@CD@@A@@N@@@@EA@@E@@C@G@@@A@A@@@C@@@@@C@@@@AAG@@@A@C@EA@@D@B@@@@A@ABA@@@@BF@@A@@@@@@@B@B@B@@@@@@A@C@@AA@A@@A@AE@@@@A@E@AA@@A@@@AD@AEG@@AA@@@C@@@@A@A@AB@@AB@@@@C@@DB@@@BB@@@@@@BB@@AB@@@D@@@B@@@BB@B@@@AB@B@B@@@D@BB@@@@@@B@B@@AA@@CB@B@@@@@A@@@B@D@@@@A@A@C@@A@@@BA@@C@@A@@B@@@@@BAAA@@A@@@F@@AEB@AF@@AG@@@A@AAJA@@@@A@ACA@@@AAD@B@@@C@@@@@BAB@@@@@AIBA@@@CB@@CD@@B@@@CD@D@@B@@@AB@@B@B@@@AB@@B@@@AB@BBJ@B@@BF@@@@@BB@H@F@H@FB@PAXAB@AAD@@E@@@A@@@@B@@@@@@BJ@BEBB@@@CB@@@B@@@B@@@@@@@B@@BB@@A@A@@JA@@B@@A@@@BB@BC@@@D@@@@J@B@@A@AF@@A@@@AB@@G@@A@@A@@D@@@@@B@@A@AA@@@B@@@@@D@@@@@@A@A@A@A@EAG@E@@@A@DA@@A@CE@@A@@A@@B@B@@@FI@@@C@@@@@A@@B@BA@A@A@A@A@A@@@@AC@A@@B@BA@@@A@@@BHA@@D@@@@A@A@A@@AA@@CA@@E@@@CA@@A@AC@@A@@@C@@@B@BAD@@@E@CC@BFA@@EA@BF@@A@@@@EA@@FA@@CA@@CA@@B@@@AA@@@D@@ACB@A@@@AA@@AC@@CA@@CA@@AA@@B@@@CA@@B@@@GH@FABEB@@CJ@@@ACAK@@CI@@@@BA@A@A@E@A@A@@@@@@@ADE@C@A@A@C@GBC@C@ABG@CBI@G@C@A@@@@@@B@@AD@@BF@@AB@B@@G@@@A@@@@AA@@A@@GC@@C@A@A@@@CAA@@AG@ABKBCBGA@@CA@@C@@@CB@BA@@@DFB@AF@BA@A@AB@@@B@@@@AB@AE@@@@@BG@@AA@AA@A@@@ABAAA@@ACD@BA@AA@@@B@@AB@AADAB@@@B@@AB@@FD@AE@@AAB@@@A@CEBA@@@@@AB@CC@@@@@@@DA@AED@AC@@@@A@@@A@FA@@DA@@B@@A@BAA@@@BE@@@AED@BA@@@ACE@@B@@AA@@@@@AEEB@E@@@A@@@A@@@A@@A@AAA@AADCBDDB@@DABBB@BDFABA@@B@EIBAAE@AAEB@AAEBAECBCI@@C@AEEKAEC@@AA@CE@AA@CAC@@@@AIB@@E@AECCAC@@AE@@AA@@A@@BIDCB@B@@E@@FC@DFD@@@@@AB@@A@A@@BEA@A@GAAA@@AC@AG@@C@@CA@@A@@B@@BB@@CB@@A@GC@@B@@@A@@ABA@@@A@@@@@@CA@@EA@@@@AD@BAF@D@@@B@@@@ADA@AE@@@BA@@@@GA@EM@@CA@@@A@@A@AACDA@A@@@A@@@BB@@@BDB@@CCCD@@A@@@CDA@A@AA@@A@@@A@@@A@@AD@@@A@AG@@A@AA@@D@@@C@@@BA@BGD@@AB@@A@AB@@@IA@C@@D@@BB@@A@@B@J@@AF@@@D@B@@CBB@AJB@ADBB@@AB@@AD@BI@AD@@AB@@CD@@BB@@@B@@C@@@A@@@AD@@ADB@CD@ABB@@FB@@E@@@CB@@DA@@DB@@@@C@@@A@CA@@A@@@BA@@CE@EA@EC@AAA@@ABCWC@AGA@B@@CA@AC@@CA@BID@BE@@@@C@BCIA@BEAB@@A@@@AC@BA@@A@A@FABAB@@CB@@C@@@C@@C@@A@@@@BA@A@@@@@ABAA@@@A@@A@A@AA@@CE@@DCB@I@@FA@AF@@@@AB@@A@A@@@CB@BC@@@AB@@@D@@@BC@@@D@@@A@AB@@B@@@A@@D@B@D@B@D@@BB@@C@@@DB@@@H@@@@CA@@C@B@EB@@@@AA@@C@@B@@C@AA@BAA@CBA@A@@AG@ACD@ACA@@C@@@AB@@A@ABBD@@DB@@A@@@CB@B@B@D@@AD@@B@BD@@A@@@@B@A@B@@@@@@A@@@B@@BA@@@B@@@AB@ABB@@AB@@BB@@AB@@B@@@@@AB@B@@EABC@@G@@F@@DB@B@@@@C@@@ED@@CB@@AB@@A@@@@@A@CD@@@B@@BD@@@@@@A@C@AC@@@@A@@@CB@@C@@@AB@@GA@@C@@@@@EA@@@E@S@@FA@@@I@@FE@@@E@@@G@C@G@A@@@@D@@@BG@@BA@@DC@@@@BBB@@@DC@E@C@CA@BA@A@@D@@@BC@AB@BI@AFG@@@A@@B@@A@@@@@@EA@@@A@@@@CB@@CAA@@@A@A@E@@@@B@D@@ABA@C@@A@@CA@@C@@G@@AC@@@A@@C@BA@@@@A@@@CC@@@A@@@@AB@@AE@@@E@@A@@A@@AA@@C@@A@@B@@@BA@@AE@@BG@@BE@@@I@@@C@@AA@@@A@@@@BA@@B@@G@@@A@@@ECC@@@@AC@@EA@A@BAC@@@BBA@G@@AA@@@@BAAC@@CA@@@@DC@@@G@@@@@E@@EC@@CA@@AG@@C"],encodeOffsets:[[117508,35069]]}},{type:"Feature",id:"410222",properties:{name:"通许县",cp:[114.467467,34.480433],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@C@@@EBC@A@@BA@AAGAG@@AA@@AE@@@@@CBE@@A@@M@@AC@@@G@BAA@E@@@A@ABB@@ABB@@CBC@@DC@@D@@@DA@@BDBANB@@@H@@@C@@D@@C@@@A@@@G@@AE@@B@@A@@EA@BAG@C@@A@@@BC@@BA@@B@@@AE@@B@@@AC@@AA@@DA@@@C@@@DDA@@FB@BB@DA@@B@FD@@@C@@@A@AB@@@@@H@@@BA@@BBBB@@B@@D@H@DA@BA@CBG@@DB@ADE@@C@ACBE@@@BB@BB@@B@BC@@DKBA@@A@KC@MBC@@A@@CBABA@E@@@AHABBBAFB@ADA@AAABC@@BEAELA@ABAAA@@DAA@BDBBCBBFABBCBC@ABC@CBA@@@A@A@G@@@@AK@@@C@@A@B@EGA@@BAD@BA@A@A@@@A@@B@@@BAE@@AQ@@@@BC@@BC@BBE@@DA@ADEAAB@@@BC@AFB@@B@@@@@@@BD@AB@@@D@@@BK@A@@BE@@AA@@@@AC@@@@CF@@AF@@CB@@@D@@@@@BC@@@A@A@@@AA@@@@@@FA@@@@@@GC@@A@@@AB@BAE@@EDA@C@C@@A@@@CA@AC@@AE@@AA@@AB@@@G@BAE@B@K@@@C@G@@AA@@B@@@DA@@B@@@EA@@B@@@D@@@@@@@DA@@CA@@DAA@DA@@A@@@BC@@@@B@D@BCA@D@@BF@@BF@@BD@@F@@BF@AFBBAB@BB@@B@@@B@@C@@DCBBFC@@BA@BDA@@@@@A@AE@@@DA@@C@@@D@@ACB@@AA@@D@@@FA@@EA@@@@F@@@AA@@A@@@@A@@AC@@F@@@CC@@AA@@D@@@CABC@A@A@@@IBA@@AE@@B@BA@@@AA@@@D@@ACA@@AA@@AA@@AA@@A@@@AC@@@CB@@@CIBC@@BA@BDA@@AABB@BHC@BFMBA@@@A@BDWD@C@@E@@BA@A@@@BDB@BD@@@@F@@DB@@@B@BHBA@HFABFB@@FB@@B@DD@@B@@L@BF@@B@@BH@@@FB@BB@BF@@E@@DC@@DE@@BC@@BD@@@C@@@E@@B@B@@@FD@@DD@@J@BG@@BA@@FBFB@@D@BF@@D@BED@AC@@ACBE@@AC@@@GB@BA@@BA@@A@@@BCDA@@FE@BDIB@@AB@D@BF@@F@B@FE@@F@@@F@@@@AACB@A@@@@@BA@@AG@@BA@@ACB@ACB@@C@@@K@@DBH@J@@A@@@BHA@BFA@@D@BD@@B@@A@BDB@BD@@BF@@@@@@D@@@@D@@@DB@@B@@BDC@@D@@@@@B@@@B@@@B@@@A@@@CA@BDA@@@A@@@@DAB@DA@@CC@@@@D@@CBBF@@@@@@@B@@@@B@@@@@@BB@BDB@@@B@@@@@@@@AB@@H@@@@@F@@AB@BA@BDABBFAB@@BF@@@@@BB@B@D@B@BAD@B@@BBF@@A@@@@N@@FAB@BJFABB@BB@D@BD@@F@@@BC@A@@@@@G@@B@@@AEB@@C@@AERA@@@BF@@@@@D@BBB@BD@@B@@@@B@@C@@DF@@B@@D@@@BDD@@R@DDH@B@@@@BD@@@@@@AC@@@@@CF@@@C@@B@B@@@@@@BD@@B@B@@@@B@@A@@B@B@@@@@@A@@@A@@@@B@@@@A@@F@@@@ABA@BB@@AB@@@@F@B@BF@@B@@@@D@@@@@@ABLA@@B@@@@B@@@@H@@@@@@@F@@ABA@@BBB@@@@BB@@AD@AAB@@@@@BB@@FAFA@@@@@A@@@@@A@@AB@AAO@A@@D@@@AGD@@AD@@@B@H@ABB@FB@BB@@A@A@@B@@@B@@AD@@AFA@B@@@@B@B@BAB@BADABA@@@BDA@@B@@A@B@@@@@@@@B@@CD@@BBB@@@@F@@@B@D@B@@FJA@@@BF@@@@B@@JABB@@BH@D@D@@E@@J@@A@@B@DB@@D@@@@BH@F@@@FG@@@I@@@@B@@A@A@@DB@@BB@@@@JF@@BG@@@@BB@F@@@@@R@@DB@@D@@@@@C@@@@B@@B@B@@@@@A@@B@@@@@@@@@@H@@@@@BB@@A@@F@@@@BA@@D@@@DA@@@@D@@B@BB@@@@@@@@@E@@@@A@@C@@@AF@BFA@@C@@@D@@@DB@AFB@@A@C@@@E@@ACFA@D@@@@@D@@@B@BBA@C@@@BB@@ABC@@A@@@@@C@@A@AB@@@@@@@B@@A@@@@B@@BD@ABD@@@@B@BB@@@C@@@C@@D@@@CAB@B@@@B@@@BB@@@@DB@@CA@@C@@@@@@@@D@@B@@@BB@@@F@@@@@@@@@B@@A@@C@@@@@C@@@@A@@D@B@@@@@@@@@D@@@@@@A@A@@@@@@@AAA@A@@B@@A@@@@@C@@C@@@@ANC@@DJ@@@@PC@A@@BB@@B@F@@@@AD@B@@@@C@@@@@DB@BF@@FA@@@B@@JBJ@B@NB@@F@@@H@BB@@F@@DPAAD@@JA@@@A@C@IB@@AD@@AF@@BDA@DD@@CD@@BH@@ED@@DF@@@D@@@B@AGD@AAB@AG@@BA@A@@B@@AB@@FJA@@@@@DH@@BF@@@D@@@LC@@F@@@BDHADDHA@B@@BD@@AEB@@BB@@AL@@@D@@@D@B@@@BFB@DEB@@AD@DB@A@@@@BB@EBB@EB@@@@CC@@CDC@G@@C@@@EU@A@@@@C@E@@EB@@CAA@AB@@CA@AGB@@E@@C@@A@A@@@AC@@@C@@M@ABB@AAED@AGD@AIAE@@@AC@AC@C@@B@@AA@@A@@F@@A@CB@D@@BHABA@@@GFA@I@@@@B@BAB@@DF@D@@@@@B@@BF@@BB@@@B@@BB@@CF@D@BEA@BGBBAKD@@C@@BG@@D@@AG@@AB@@AA@@@A@@DC@@E@@A@@EI@@@@A@A@@A@E@@@@@@AF@AC@@@@B@@AJ@@CD@@@@@D@@CC@@E@@@CA@@CB@@AG@@CCAAA@CE@@CB@@CD@@AB@@C@@@CA@C@@EBA@AHA@BF@AAB@H@@@@AD@@@B@@CD@@@@CD@@@@@@CB@@A@IA@@@B@@CA@AA@@F@@A@@@A@@@A@@AE@@AE@@@FA@@CA@A@AA@@@@@AA@@@A@@B@@ACB@@@@BB@AAB@ACB@@AA@@@B@@AACB@@@B@@ABAB@DA@AE@@@@AH@@@BA@@@A@A@C@AA@@AE@CIB@@@DBAC@A@@AGB@@CA@C@C@@@K@@@@@Q@@@@@AAB@AIB@A@@ADA@@AA@A@@@AH@B@@ADA@AD@@CC@@KA@@A@@@@A@@@@@@@@AB@@@A@@A@@@@@@@A@C@@B@@AD@@AB@@@B@@EAAGB@AD@@@@@@A@@E@@@C@@A@@@AB@@C@@B@@CB@BEB@@C@A@@A@@A@AD@@C@AGF@CC@@CC@@G@AA@@AB@@CJ@@@@EBEAAA@CA@A@AA@@CC@@AB@AC@AGBACB@@IA@@C@@@AC@@A@ADA@AJAACB@@@AED@@G@EA@@C@@@CA@@AE@@BM@@BC@@DEA@DE@@DCB@BCB@@@@AFC@@BA@"],encodeOffsets:[[117350,35095]]}},{type:"Feature",id:"410212",properties:{name:"祥符区",cp:[114.441285,34.756916],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@@@@@@AEB@BC@@BA@@@A@@@@B@BA@@AEAA@BAG@A@@@C@@BC@BH@@C@@@@BBP@BBA@@@B@@@@@B@@@@EBEB@@AA@@@@A@BBC@@BA@@A@@A@AA@@AB@BE@@@@@@@G@@@@@@A@@A@@@KBBA@@@@@@@C@@A@@@AEA@E@@@@@BA@@AAB@BA@@@@@EB@@@@@@A@@B@@@B@@@@@@@@A@AB@@@@A@@A@A@@@AC@@@@@@@A@AD@@@E@@D@@@@BD@@@@@@AC@@@@@ACG@C@QC@AC@@C@@@@AE@@CD@@@@A@@A@@@ACA@AAC@@@@@E@@A@@QBBF@@@DA@BF@@A@@@@H@@@@@BAD@@E@@@ACC@A@@AAAEBAIA@EB@@@M@@B@@@AE@AA@C@ABA@C@A@A@@A@@@@AE@@@@@@AD@@ABEBA@A@ADA@@@BBAB@@AAEDBD@BAB@@A@ABGD@B@@@B@@C@@BA@BF@@CB@@@B@@CB@B@@C@@D@BBJ@@BH@@A@A@BJ@@B@@@F@@@ADA@@@@@A@A@@F@@UB@@BH@@@@@@A@EB@A@@CB@@@C@@A@@@BD@@GBC@AG@@@@E@@@AB@@@@@A@@M@@A@@@@@@@AAB@@EB@DI@@@J@@D@@BF@@A@ABA@@@CB@@A@@@ABAA@A@A@@EB@@BBABAAA@DH@@BBC@AE@@BFA@@DAB@B@@A@@B@AC@@B@@A@A@AIA@@@CDCB@B@@A@@@A@CD@E@@@@A@@B@@@DA@@C@@@@@@AA@@AAA@@AC@@B@@@AB@@C@@@BA@@CB@@AA@@HA@@BAB@A@@A@@BAB@CA@@@B@@CCB@BBJGA@DD@ABC@@AEDBBA@@ACBBDA@@@EBCBC@C@@@@B@B@@AABF@BA@@@A@@D@@A@@AA@BBEB@@A@@@E@AAA@@DCBGEAA@BABAAABAB@@A@ABA@C@A@CB@BABGHJDCBCBEBA@@@@@ABC@@LA@BHL@AB@B@@C@@@@@@FEB@CA@@DB@@@BLB@@@D@@@D@@@CBBDA@@BFA@@B@@BC@@DDA@B@@@@EB@@B@@@D@BBA@BBEB@@@B@@A@ADA@@BD@@@C@@BEBBBA@@FC@@BEBBF@@E@BFB@DDB@F@HFFA@@@BABB@@@@BB@BBC@@@@BA@@B@BADBFDA@BCB@@BB@DBDDA@BCB@D@@@BGBBB@@@ABBC@DJ@BA@@AA@@@DF@@BD@@@AB@B@@D@B@BC@BDB@BB@@@BB@@@B@@BC@@@BBA@BDBA@@D@@BA@@@@@@BB@@@B@@BC@@DB@@@D@ACD@BDAB@@A@@AA@@DB@@BA@BFC@@@C@@BNABAD@NBDBHAF@BAFAB@HAFBB@DAPCJADADADCDAB@B@D@DBH@D@B@DDFBB@@@F@FAL@D@BABA@C@ECA@A@A@C@A@@CAB@A@AAAA@ABC@ABABAD@DAB@B@B@DAD@DAHE@BB@@AJCFCBAB@F@F@H@B@DB@@BBBBBB@B@FBB@@@BB@B@@@B@BA@BBH@BB@B@@@@@B@@B@B@B@@B@BAB@B@@B@@BJBHDN@H@BBD@BBDFJDF@@B@@@@@@AB@BF@@@BF@@DB@@@B@@@F@@D@@@B@@D@@BB@@@@@BBD@@@@CF@@BLAB@@@@A@@@CB@@DDAAGB@AED@ACD@@DB@@A@@@@D@@AB@JA@CDA@@@@@C@@@@D@@AB@AKF@@@@EDA@BD@@@F@@@B@@BF@@BA@BDH@BBA@@B@@@HH@@AB@B@@G@@@EH@@A@@@AABAE@AF@@@B@@BBA@@DA@AB@@DFA@B@@@@D@@@F@@@DA@AD@@BB@BD@@@ABAAC@@BDB@ACD@BB@@@ADAB@@DB@@CB@@BF@BDD@@AB@@DDA@FBB@@@ADA@@BBB@DDB@ACFABDA@DHH@@D@BB@@BB@AD@BA@BBABA@@@@B@@@D@B@@@BA@ADA@@@EFBB@DCFAACF@BABCFAB@@A@A@@BBDEFC@A@@AABA@EBCDAD@@A@CB@AA@@@E@@@BF@@BF@BFCBDBA@@BFF@@D@@FA@@BFDB@BB@BDA@BHD@@@FAD@@BD@@@B@FA@@BA@@BHB@A@@BBADDL@@BDJ@@BD@@@@AB@@F@@DD@@B@@@BD@B@B@@BB@@BB@@@B@@JFABDJA@FJABBDAB@@DEB@@F@@BB@@BFABH@@D@BH@BDA@BB@BDD@@DHABD@@B@BF@@B@DABD@BE@@B@@@F@@@BGDBFBDA@A@@DA@@@@@@@AC@@@@@@@@IBABBB@@A@@@@AAA@@A@CC@AAB@@A@@AB@AC@@CBAG@@@BA@@@C@@@@BEB@@A@@BABCB@BA@@@@@ABSFA@B@@BC@@B@@CBCDC@@B@@@@@BA@@@@BA@@@A@@A@@@@@D@@C@@@@@@BA@@D@@@@B@@BB@@@@A@@@@@@@B@@@@@@D@@B@BABI@@@@D@B@B@@@B@@A@@ACAC@AAA@A@@DA@@C@A@@A@@BKB@@@A@@I@@@@A@A@@@@@@A@@@@B@@@@@@@@@C@@@@A@@@@@@AF@@@@A@E@@@@@@A@@@BG@@A@@@@@AA@@@@@@@@@E@@A@@@@@@B@@@@@@@@@@@@A@@@@A@@@@A@ABA@B@@@@BA@@A@@C@@@BDA@@@CBA@@@B@@@@B@@@BC@@A@@A@@C@A@@A@@@@C@@@@@@AA@@@@@@@FBH@@B@BB@@GD@BBF@B@@A@@@@@@DB@D@ADFF@BB@@@@@B@@BA@@@@B@@@BGBB@ABDD@@A@ABA@@AC@@@@B@@A@@A@@@@@@E@@B@@GBA@@AA@@@A@A@@@BFCB@@@B@@@@@@@@@B@@F@@B@@E@@@BB@@FA@@@@@@H@@@@@@@@@MD@@@@FA@B@@EB@@B@@BDAB@@B@@HA@BG@@@@B@@HA@@@BDA@BBB@@A@@@@@D@B@@@A@C@@B@@@@D@B@@@FA@AB@@@@F@@@@AAA@@@@D@@DAD@@@@B@@B@@@@@@BB@@@B@BH@@B@DNBDBDFPH\\B@JERIB@DABAB@B@LCFA@@B@H@BAF@LAF@JAB@B@FAJ@B@J@JBBA@@LGDABAD@B@HAF@D@D@F@H@D@DBDBDBHDBBFBBBDBBBFBFBDBDBDBB@B@B@B@FAJEB@PGB@BAB@DAD@B@H@@@BBB@FBB@B@B@DAHAB@HAD@HAD@DAB@L@JAHAD@FAD@B@FAD@D@@@F@B@D@LBF@F@F@HB@@HBJDDBDBBBBBAQG[@CDGNAJA@A@A@AHA@AJA@AD@@@@CD@CKD@@AB@FAB@@AB@B@@@AEFFB@@@BAAO@@FB@@@IB@BA@@@C@A@ACA@@@@@AC@@G@@@@@AB@AAAI@@@A@AA@@A@@@@D@@A@AD@@@@@@@A@@@@C@@B@@@@C@A@@E@@@B@@AA@@@E@@@A@@@@@AE@@@@@E@@B@@@AA@@@@@AFA@@@A@@@AA@@@AA@@@@AC@AA@@@@A@@@A@@CG@CB@@@@@CKZC@DB@@@AG@@A@AK@AEBC@AC@AKBEB@@ACAE@KA@@@E@@@BC@@@@A@C@AGA@C@@@@CE@@@AAC@A@@E@A@@E@A@@AH@@@F@@I@@L@@CD@H@@ABA@BB@@AB@ACC@@@@@@@@A@CA@A@@A@@AB@ECB@@ACC@@@AIC@@A@@DA@@@@AE@@C@A@@@@@AA@@@@@A@@B@@@AC@@A@@@@A@@C@A@@@@A@@GB@@@@@BAA@@@@@@@@BA@@@C@@K@@@A@AA@@A@@@AB@@E@@@BC@@@ABC@@A@A@@@@B@@A@@BA@EBKBCA@@IB@@ABC@@AA@@A@AA@A@@A@A@AAB@@@@C@@@@BA@E@B@G@AA@@@A@@CA@@@@@AA@@@@A@@CA@@@I@@@C@@C@@@@@@A@@@@NK@A@A@A@@@@C@@D@@@@A@@C@AB@@C@AC@@@@EA@@@@C@@@@BC@@A@@@BE@A@@B@@@@C@@@@@@@ABC@A@@A@@@@AB@@@BG@@@@XAB@@@B@@A@@@AC@@CACB@AEAC@A@@@CB@@C@C@@@A@@BABAB@@AD@@A@A@CA@@AA@@CA@@AA@@AABAA@@@M@A@@@AEB@@AECBCGC@@@A@@AAB@ACBAEBA@@@@@@CED@AAB@AED@ACB@AAFAAA@CA@@AAABAAC@CEBCEC@ACOFAADA@@C@@AC@@CCBCGB@@@BA@C@@AA@EC@@AD@@CABAE@@B@ACCBCMC@@@C@@@K@@BA@@AA@BF@@AC@@@AGBCCGBAC@@E@@@KD@@C@@@E@@AG@@C@@@@IB@EA@@BA@@@@BAB@@BHA@BBC@BHA@@@C@@@E@@CC@@FG@@AC@@DC@@CCB@AE@@BC@@BA@@J@D@B@@IB@@BCOB@CE@@@AAG@@@E@@@MAA@I@IA@@@A@@EB@@AEA@@C@@@@@D@@A@C@@B@@E@A@@@AA@@@BOD@@@@CI@@MD@B@@D@@@@D@@@@@BA@@@@BBB@B@@@@@@@B@B@@@@C@@@@@@@@@A@C@@@@B@@D@@@@@D@@@@BA@@@@@@@@@E@@@A@@A@@@AC@@@@@@@@@@DB@@DA@@C@@A@@A@@@A@@@ABA@D@@@CD@@@D@@@A@@A@A@@C@BAC@@AA@@@@@@BA@@@@@@@A@@B@BD@@@@@B@@@AD@BA@@A@@@DAB@A@A@@@C@@@@@CEBBD@@@F@@@D@BA@BEA@@C@@@C@@@DB@AEE@@B@@@DB@@@@@@F@@@@@@@@AAA@@@@C@@B@@C@@@CB@@A@@E@@@@BA@@A@@@@@G@@@@@@@@A@@@@B@@@@@A@AA@@@@@@D@@@@@CA@@CQ@@@@@E@A@@A@@H@@AE@@I@@A@@AA@@CB@B@@@@A@@J@@@H@@E@@@EAG@@@@@CA@@C@AB@@@@IF@@@@C@CAG@@AAIB@@@A@@E@@A@@IB@EA@C@A@@@E@@@@@AA@AC@@DA@@@@@@@@@@A@BA@@@CB@A@@ABCBABA@AB"],encodeOffsets:[[117161,35366]]}},{type:"Feature",id:"410225",properties:{name:"兰考县",cp:[114.821348,34.822211],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@E@A@A@@@A@@@A@@@@A@AA@@A@@E@E@A@@@C@@AA@@@A@@@CBAKAA@A@@E@E@E@IBC@@AC@A@@@A@@A@@C@C@A@A@@@CD@BAHC@A@@BA@@@A@A@AB@@@BG@@AG@@@A@@BC@A@@B@@A@@@A@A@@@@BABA@@B@BAA@@@DC@@@@FBB@D@DA@@D@B@@@F@BI@@FABAA@CAA@@KBG@@BA@BCG@C@@CCB@CAE@@AAB@@CAA@@E@@@E@AAA@A@@@@A@@A@@BCDA@@AE@@D@@I@@@@AA@@@@@@@A@BBAB@@@@A@@I@@CB@@A@A@@@A@@@@@@@A@@DIA@@B@AA@@B@@A@@@A@@@@@CG@@C@AA@@A@CB@@AF@@@D@@CB@@A@@@@@@D@@A@E@A@A@@AAB@@@CAAA@@A@EB@@E@@@A@A@@D@@AC@B@C@@@DA@@@@@A@@@A@@@A@AAAB@A@@AB@@D@@@CB@B@@@AA@@A@@@BA@AB@A@@@B@B@@AA@@@A@A@@AB@@@AA@@@@@C@A@@@@@A@E@@@A@@DC@@@A@A@@@A@@F@@@E@@A@@B@@@BE@@D@B@@@@@@A@@J@@B@AFM@@DA@@BA@@@@@A@@AB@@@E@@@D@@@E@@BA@@@B@@DA@@CA@@D@@@CC@AA@@@@CB@C@@@D@@@AA@ACEBA@@AA@@@@AA@A@A@@C@@AA@AEAE@@C@@A@@@C@@@@@@C@AA@@@@C@@A@@@@@@DA@@@@C@@@@@D@@@@@CA@@BA@@A@@@DA@BAG@E@BAA@A@AB@@AA@A@@CB@GA@GBA@@@AA@@EB@@@B@@C@@@E@@D@@@@G@@@AE@@@@EB@@@@@AA@A@@BA@@AAB@BG@C@@DK@@@@JE@@@G@@BB@F@@@@B@FB@D@BB@@F@@D@@D@B@BHD@B@@@@@AD@@F@@@B@@LBFBD@@FALA@BBDD@FA@BBLB@@@BH@@A@@CYDDL@@@@A@@DDH@@@B@@@B@@B@@BBD@@@@BB@@B@@B@@@B@@EB@B@@@@BB@@A@@@@F@@@@BF@@@@B@@@F@@@B@@BA@@@F@@@@B@D@@A@@@@D@@B@@@@@@@C@@B@BC@@@@@@BB@@B@B@@BJBBA@@B@@@@@HD@@B@@@@DB@B@B@D@@ABA@@J@@EA@@BPAB@@A@EEBF@@A@A@@BA@EBA@@BC@DLC@@D@@C@@BIB@BGB@B@B@BIBMBCH@DH\\BRJ@D@D@BAB@B@JCJCFA@@B@BAJCFAJCNCB@JCF@DAB@D@DBB@B@DBJFDBDDFHFFBBBDDJBF@B@B@D@@A@@BCDABGFEFCBABGDCDABCBGDOLCDAB@B@B@DDFFJHL@BDFB@JJFFDF@@DBRNFDDDJJDDBB@BFDB@FFFDDBFDLDH@VBBABAB@HAXGD@LCD@B@@@BBDABA@AA@@@BA@@HAB@@@@B@@FA@@B@B@@@@DA@@@ABI@@@A@@@A@DFGBBFB@@@HAFNBD@@@@D@@AB@@@BDAB@DC@@B@@HA@BA@@B@@ADP@@CB@@@@AL@@GBA@@AEB@@AAECBCOB@@ABA@CFCDAFEFAHAHAF@HABADCBEBEBEDEFCDEFA@AHDDDDFDFBDBBJHB@FDBBDBDBD@H@H@HBH@H@HAH@DAD@F@B@V@H@@@H@H@@ADCBEDE@@FEFCDCB@FAD@D@DBBBDDDFDFHBDBB@HBDBB@FFLHFDFDDBD@FDDBF@D@N@F@F@B@F@F@F@@@H@DBF@DDB@BBD@D@B@R@JI@GBC@AAECE@EFC@@RAD@DA@@JI@ADCBEFCAQHAF@DBFDFBHBHCFCFCFAHAB@DCFAB@FADFDFFDFBHBH@DEBE@@@EBEAEACAAA@CBCE@BA@EBISGOIFAAC@ACA@A@CO@@AEF@FA@CB@VAAIF@FAJA@DDL@@L@D@BBB@H@@@F@B@HAFAD@@@LCDCAE@E@@AC@AAGCCKMAAAE@G@E@K@C@ABG@@@KBA@CBABCFCFA@AH@FAFOHE@@@@DAAA@@@AF@@CAACC@@GABA@@@@B@BBB@@CC@@@D@@C@A@AC@AE@@AAA@@A@@@C@@AA@@@DA@ACA@@AA@@D@@CB@@CA@@BB@@@@A@@AABAAAB@AA@@@AD@@@AAA@F@@ACA@@A@@ACHABB@@@AB@@CBB@@@@@@AA@@B@AA@@DAB@@AF@@CC@@@C@@@@@A@@E@A@AA@AAA@C@@@E@@DA@@@A@@BKB@@UDQBGB@A@@@BM@@@@@@A@AC@@@D@FAAAA@@@AAA@@@@BA@@A@@A@ACD@@@@AABA@@A@@@A@@@AAA@@AC@@@@@@@D@B@@A@@AA@@B@@@AAA@@@AC@@B@@@@BFAB@D@@@@AA@@@BC@A@C@@@@@A@@BA@G@A@AABAA@AGAEAA@@G@A@A@AGC@@A@A@C@A@@B@@B@@@GGAA@@B@@A@@B@@E@@CCBA@AAABAEC@ABCB@@@@@@@JC@BH@@EBBDC@G@A@@CA@@@A@@DA@EB@AA@IB@CA@@B@A@A@@G@@D@B@EC@@DAB@C@@A@ABAIAOG@@C@A@@IBCA@@A@@CI@@AA@A@AA@DE@K@S@A@M@C@ACG@@GE@E@@D@@C@@@@BA@IAC@@@KF@D@DEAG@A@@A@@CAAAAB@@@CA@@AA@@@@DA@ABBDA@@A@@A@ABA@@@AB@B@@@AA@BBA@@A@@@DA@@@@CA@@AC@A@@AC@@E@@A@@FC@@D@@@AC@@AA@@@A@C@@C@@@CA@@A@E@GA@@AAA@AAAEB@@FAA@A@AA@@@@@BA@@@G@@CA@@B@BABAC@@@D@@BJBDCBA@@AA@AMB@A@B@@@AA@A@@@AA@@@@BA@@AAB@@@@@B@@AAB@@@G@ABAA@F@@A@@AE@A@@@C@"],encodeOffsets:[[117731,35593]]}},{type:"Feature",id:"410223",properties:{name:"尉氏县",cp:[114.193081,34.411494],childNum:1},geometry:{type:"Polygon",coordinates:["@@@EA@@CC@@A@AG@@GABA@@@AACAAAA@A@@B@@ADAB@AA@@DCA@@ABA@@D@@A@@@C@@BAB@@ABA@ABC@@@@@@BC@@@A@A@@CA@A@A@@@AB@@@D@B@B@BCB@@@B@@BBDB@@B@@B@@AB@@AFABA@ABA@A@CBABAB@@@BB@BB@@A@BBB@@@B@B@B@B@@@BHE@AB@@ABAB@@A@@AC@@DB@@DB@@@B@@@@@ED@B@@@BAB@BAB@@A@@@C@A@CAEBA@A@@B@@BB@BDB@B@@@@@BA@A@A@EBA@A@@GC@@CC@@CA@@CA@@@CB@BC@@D@BAB@B@BCFA@@@A@AA@ACE@@AAAAA@@@EBABA@CBA@IB@@A@@AA@@A@@BCBA@C@A@AAA@@A@G@C@@@EAABC@E@ABA@@BEBA@A@C@C@@@ABA@CBA@A@AACAA@A@AB@@@@@BBD@BAB@@AB@@A@EDABABAB@B@@@D@B@B@D@@KA@D@@A@@@CABFC@@DE@@@@@@D@@@BBD@@FB@@@BBBA@@@@BABABA@A@A@G@BDC@CBAA@@@AC@@CA@@BA@AB@BAB@H@@ABABA@A@A@A@AAAAA@@AAB@@AB@@@B@BBBIBBHA@A@@DB@BA@@@@@DEBEBEDC@@@ACA@AG@@@AG@@FBJA@CBA@C@A@@@@BBF@@@B@@@@EDCBA@@@@GC@@CAA@ACAAAAA@A@@@ABA@@@@C@@FC@BF@@@FA@@AC@@AA@@BG@C@@AC@AI@@AAA@A@@CC@A@@DA@CB@EA@BF@@@HA@@@AAA@@FCB@@BDEBBJDJ@FE@CB@@@BA@A@BHBB@@@BE@BF@@@@EB@@BF@B@BA@A@@FA@BD@@BLJABD@@KBBDIBBHEBEB@F@@@DA@@@B@@@AB@@B@@@A@BF@@BDDA@BB@@BDABD@BB@@D@DC@@BC@@F@@@DA@@BA@@BG@@DA@@CIB@F@@F@@@F@BDAHB@@@D@@@@BC@BD@@@AB@@DA@@BB@@BA@@F@@@D@@@@AC@@@AA@@B@@BHCB@@BHD@DJB@BB@BC@BDA@BLB@@@@@BD@@F@@@@DE@BDD@@BB@@@ABB@D@@@@BBBC@@B@@@DB@AAB@@FB@AEB@@FD@@BD@@CB@@FD@@F@BBD@B@BBDBB@B@@F@@@EB@BFA@@@DCB@@A@@HDA@AA@@AFA@@B@@B@B@CF@@@@@AE@@DBBB@A@ADA@@AE@A@@@C@CBA@@BAFA@CFA@@HA@@BABBBA@BB@@@BLB@@FD@BL@@BF@@HA@B@@B@@BB@B@B@@BBA@CD@@@C@@ALA@BDA@@A@@A@CA@E[H@ACB@@@@@B@@@A@@BD@@BD@@AB@@DA@A@@@BD@@B@D@@@B@@BD@@@F@@@B@@DD@ACB@B@BFF@ACD@@ABJ@@@BDA@BB@@AB@BA@AD@AEB@ACB@@DB@@C@@@C@@@BB@AEB@@FB@AEF@@@B@@@D@@C@@D@@BA@@DB@@@B@@@D@@@A@@BC@@BD@@B@@@FL@@ADA@AD@@ADA@@@@F@@FAB@@B@BB@@@@@@@BD@@@@@@@C@@BD@@@@@@@B@@@B@@@D@@@D@@BBA@BB@@@@F@@@AD@@BDABDB@@DA@@H@@@DB@@FC@@DD@@@A@@@@D@@@BA@@B@@@BA@@@AB@B@@MB@BA@@@E@@BC@@@@@@CA@@AE@@@F@@CB@@A@@@A@@CBC@@@@A@AA@@D@@@AC@@@@@@D@@@D@@@F@@@F@@@AC@BHBBE@BBA@@BBFDA@BA@@@B@@@A@@D@@B@@DD@@C@@B@B@@AB@@BD@@@@AD@@@@BDA@@B@@@FBB@B@BB@@@BF@@@EB@@H@@@AB@BB@@DB@@BB@@D@@@@@E@@BF@@BB@@BBA@@D@@@B@@@BA@@AA@@D@@@D@@@DDB@C@@AEB@@HB@@CB@@B@@@C@@@BB@AE@AF@@@B@BBB@@@A@@@C@@@@DF@@BC@@@A@B@@DB@@A@@B@@ABBBDFA@@B@@@@@@@B@@A@@@BDA@B@@@BD@AAB@D@@BBA@@@@@DB@@AFA@DD@@BC@@AA@@DB@@AD@@@@@@E@@@CB@AE@@@@BBB@F@@@BAAC@@B@BDB@AAD@@A@@A@A@@A@AD@@EB@@FB@@@B@AEF@@CFA@B@@B@B@@@@AB@@@B@@@@@@E@E@@@ABABC@@@@H@@AF@B@@AL@BDB@@@B@@C@@@AD@@A@@D@@@@@@B@B@@@CB@@DB@@D@@@BDA@BD@@CF@@B@BA@@BA@@AA@@DA@@B@@@HB@@H@@C@@D@@A@@D@@@BB@AFE@@@@B@@@@A@@B@@C@@B@B@@@@@DA@@D@@AJD@@A@@@BA@@@@B@BD@@CB@BDD@@D@@@HB@@F@@@CB@@A@@@B@@@AB@@F@@@E@@@BB@@D@@@CD@BDA@@@@@@FB@@AF@@DA@@DH@@@ABA@@@C@@BA@BACA@DA@@AA@@@@@@@@DB@@BD@@DB@@J@@@DBJE@@BBB@@A@@FB@@BB@@FF@@DB@@B@@E@@@BD@@@FD@@F@@FB@DB@@CB@@AB@@DB@@CD@@@B@@D@@BC@@@H@@@BD@BBD@@BB@@BB@@DB@@AB@@DD@@@BD@@BB@@BF@@@BC@@DA@BFA@@CCA@FD@@BC@@B@@@D@@@BB@@B@B@DB@@B@B@DB@@@@@@BD@@IA@@EABAEB@@@FA@B@@BF@@A@@D@@BDDAABBDA@@DB@BHE@@BAAA@@AAABDABA@@BC@A@C@@D@DB@@B@@@DB@@BDA@BCB@@J@@BB@D@@@BABA@@@@@DB@@E@@BABDB@ACD@@@@@@@B@@A@@@@BABFB@ACB@ACB@AAB@AK@@D@@A@@BBD@@@D@BDB@@@B@@DB@FADADAICHGBA@ADAB@D@B@BAB@@@BABABBBA@ABBHFDA@CB@BBF@@@B@@@FAAAB@@BB@@@@CB@@@B@@AAEBB@@@A@A@@D@D@DAFA@@B@ACDA@BB@AAFC@BD@BAC@@CHBAI@ADA@DA@@@B@@DBA@AB@@@@BBA@AB@@GB@@BA@@DB@@A@@@DA@@B@@@AD@@BB@BB@@BB@@@@@@@DB@@C@@@AB@@@@@@FDCB@@@B@@@@ADADC@@B@BJB@B@@@@AD@@B@AB@@@@ABA@CB@AE@@BFD@AA@@CGB@BBBAAA@@FA@@@B@BBBBA@@B@@@DA@@B@BAB@@@AE@@@CI@@@J@@CFA@@BA@B@@@@@@@BN@@@@B@@@@BA@@F@@@@@BHD@HA@@AC@@B@@@@D@@DA@@@BFAB@@@@@@@AG@@VA@@@EB@B@@@@@B@BC@@E@@@A@@@AIB@B@@@AG@@AI@A@CD@@@@ADA@@@A@@DA@@AEB@@AD@@@@A@@@AHCBAB@@@BA@AACFCBB@@BAAA@@B@BCB@B@FABA@@BC@@@@BAAEBAACB@@ABA@@@E@@@@@GA@@B@@@@@@A@@@A@ACA@@A@@@@A@@@@@@A@@@@@@AEDA@@@C@@D@@DB@@CBA@C@@B@@@B@ACB@@D@@@B@@@A@@@A@@@A@@@@@CD@AC@@@AA@@C@@@C@@C@@@@@@@AE@@ACA@ACB@@@@AC@@A@CB@AEB@AG@@B@@@@IAG@CL@@@D@@@DA@BDA@BB@@AH@@BB@@A@@@@@BDABB@@@@@E@@@EF@@E@A@EE@@A@CBA@@JAACF@@EB@DC@A@@@BB@@AB@@AHA@@D@@BF@DA@BD@@BFC@A@CE@@A@CA@AE@EB@@AH@@A@IC@@CC@@E@@@A@AF@@@D@@@C@@AD@@AF@@CD@@CF@@@AEA@@AEA@@G@@AA@@@AEK@@@@AC@@C@AA@@EA@AEEB@GABAGA@@@A@@CE@@@@@ACA@AC@@B@B@@AF@@@@DXCACB@@@B@NAAED@AGA@BA@BB@ACB@@AD@JA@D@@DA@@D@@B@@@BB@@BB@@BB@@BB@BD@@@C@@BB@@B@@A@AF@@BB@JA@@B@B@D@BA@D@@@CB@@BD@@D@@@ED@@BB@@@@@@BB@@B@@@E@@B@@FB@@E@@@CB@@BA@BD@@@C@@@DB@@C@@BFB@@@@@B@ACB@@AD@AEDA@CD@@@@A@@@AA@@ABAAABEE@@AE@@@AC@@AE@@AE@@@CDB@A@C@A@@D@@A@@@BB@@CBB@CB@@DB@@C@@@@@@@C@@@AB@@F@@@AB@@C@@@AB@@BH@@@A@@E@AB@DBD@@DB@@C@@F@BAF@BA@@@@BB@A@A@@BEB@BA@@@ABBBAH@ACHA@@@@BED@@E@@B@@C@@@CAA@C@@@E@@@A@A@@AA@@G@@@A@@EDAEEAAA@C@@CA@@AC@MB@@C@@@IB@AAIAEAI@@B@@C@AKIA@GBA@A@@B@@AACB@CG@@@@C@@CBA@@AC@@ACB@ACB@@@@A@@@A@@@A@CBE@SD@B@@@@@D@@A@@CA@BBA@AAE@BD@@@@A@AA@AC@@B@@IB@@@BC@@B@@@CC@@BE@@BABC@@FA@@@A@C@@@@@@@A@@F@@C@@BCB@@CBGBBBA@@BA@E@@@@@@@C@@@E@@AIBCB@BG@@@@FC@@@@@EBA@@@@DC@A@@CQD@A@@EFC@AEC@@@CB@@E@@@@CC@@AA@AEC@@AC@@@@G@CGEA@CC@BCA@@C@@A@@@A@A@@A@A@@GA@@@@AMB@C@@ACA@AC@@@@A@CI@@@A@@G@@BA@@BC@BBGB@@A@CBAEE@@AAC@@@BA@@A@@AE@@E@@EMBBFA@@B@DA@@DA@@DA@BDAAA@BBC@@B@@@AA@@@AB@A@@C@@@@CEBAE@@@F@@AE@@AEA@@E@@FAIWGBC@A@A@@@CAAA@@@@A@BFCBC@ACA@AAA@G@@CKBAAA@@A@@@@@@A@@AE@@DA@@CAB@C@@AA@@@D@@C@A@@A@AAB@@@AA@@B@@@B@@@CC@@AB@@ED@@CDA@@@AE@@AC@@@@@A@I@@F@@AA"],encodeOffsets:[[116887,35028]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("洛阳",{type:"FeatureCollection",features:[{type:"Feature",id:"410302",properties:{name:"老城区",cp:[112.469766,34.6842],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CFBBAFC@@BCDC@@@EA@U@I@G@C@C@CAGAEJCDADCF@@CHCF@BA@BB@@A@EAK@C@E@C@A@GBG@@D@@@B@D@@AJAH@@A@BLA@@@K@ACC@@DO@@@@G@@@@G@S@@D@DA@@B@@AB@@@DAL@D@BADD@B@@B@@@BCFAB@BAD@BAFAD@BBB@@BDDB@BB@BD@BBD@@AFCFABAD@@BB@@@@HBBBB@BA@@FCBABAB@D@@@F@@AFA@@F@B@JEB@FADAB@FCBCB@@@BA@@BAB@BBBB@@BA@@@A@@@@B@@@BA@@B@BA@AB@BADA@@BBDBDBDBBB@@DBD@F@@@@@DAJCD@HA@@FAB@BABA@@DCDCBAB@EM@AAACA@A@@@A@ACA@A@@FADCBA@@@C@@@A@@@@C@CDA@EB@AAA@@AA@@AAB@@C@@@@A@@@@@@AA@CAAB@@@ABCB@@ABM@@HDF@@@B@EAOIF@JDB@@A@@D@B@BBB@B@B@@ABC@G@K@@B@@@"],encodeOffsets:[[115188,35512]]}},{type:"Feature",id:"410303",properties:{name:"西工区",cp:[112.427914,34.660378],childNum:1},geometry:{type:"Polygon",coordinates:["@@QK@@QMA@ED@BA@ABCBCB@F@N@@AB@@@@BDB@@BBB@B@B@@A@@@ABAB@DB@B@@CB@B@BBBD@B@@C@ADABCBCAA@@BAD@BCDCDGDABBBEDGFA@A@AA@@A@AAEAA@AAA@E@A@@@CDABCB@B@@@BBB@B@@@@A@@@ABA@CAC@EAAA@@@ABA@@@AA@@AC@C@A@ABA@@AAB@@ABA@A@A@@@AA@C@A@@AAA@A@A@A@@B@@AB@BBB@@ABAD@BA@A@ABA@AAACCAAACAA@AA@@CBBFBFAJCA@@AAE@AABECCEBCBCDA@IAABA@CB@BAF@@@BBJ@BAD@B@@CDABABA@CB@BEBABA@EB@@A@GAA@@BA@AD@B@@@BBBB@@B@B@B@B@D@B@BBD@@@@@@D@@@BABADA@@@@DBD@@B@FF@D@B@DADADAHEBABABA@AB@BCBC@AB@DBB@D@D@D@HAB@BAD@@@BB@@@BBD@@BB@AD@B@BABAB@BBD@D@@B@@@D@@@B@@BBPBB@BBFBBBBBDBFD@@B@B@@@@CBC@AB@@I@ABA@ABABEB@@@@BFD@@B@@@B@B@JBBC@A@CBK@C@@BA@@@AB@@C@CT@H@@@@@@H@@P@@CD@BDL@@@B@AKB@@@BGBI@@@C@A@@@CH@HAB@D@F@D@L@FBB@@@AAB@@ADEDG@@DEBCDCFI@@CAKEAACACA@@GE@@ECCA"],encodeOffsets:[[115145,35486]]}},{type:"Feature",id:"410304",properties:{name:"瀍河回族区",cp:[112.500131,34.679773],childNum:1},geometry:{type:"Polygon",coordinates:["@@EA@D@@A@@@@L@HAD@BA@A@A@AAA@C@@@@BA@ICE@PJFBA@@@E@GC@@AN@BA@AD@B@@BADBB@@B@@@@B@@@@@@DA@BB@@BB@@BB@BFAB@DCD@@@@@@B@@@D@@ABCDEB@@@BDB@B@B@@@BDBBB@BFN@@@@D@DB@@BB@@@BBD@@BDBBBBD@FBDBDAD@LAH@LAHAD@HA@@BABA@@BC@E@C@A@@BAD@CEEEKIAE@CCE@A@@JB@@B@@A@@@@PBB@@A@@@@@AA@@A@@@A@@D@@@BMBM@@@AGBEA@@C@KAA@EAAAAAAACACCCCAA@A@@@@A@AAEC@AA@G@A@E@A@ABA@ABABEDAB"],encodeOffsets:[[115191,35511]]}},{type:"Feature",id:"410305",properties:{name:"涧西区",cp:[112.395756,34.658033],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AEKAAAAACA@AA@@A@ABA@CBA@AAC@E@C@@@A@@BCF@DCHA@@BCA@D@DA@ABABA@AD@@@@AB@B@@@B@@@B@B@F@@AF@B@F@D@@@BBB@B@@DDBDB@@B@D@BB@@B@B@B@D@D@@@@@B@B@BBBBBBBB@@D@D@B@BEFAB@D@@@B@BABAD@BABB@@@@BB@B@B@B@B@BB@@@@@H@@DB@@@BB@@@@BB@BBB@BBF@BB@@@@AD@@@B@@@BB@BBDBBBB@@@BBFB@@B@DBD@@B@@@BBB@BAB@D@@CDAB@BABAB@@@@BB@@B@DBD@BBDBB@@@BB@@@D@B@@@@BBB@B@@BBB@@DB@@B@@BBB@D@DBD@DBJ@DAB@B@@C@@@@N@BB@BAJBB@DCDAFADDAFBBF@BB@@DBBIAEAEDA@@BBB@DBBBDBBDBBB@BAB@B@@ABCBA@@AA@ABA@@@AB@B@B@B@BB@@@B@DBB@@B@B@B@BA@@BA@BB@BAB@D@D@@BB@@B@@AB@B@@BBFBD@DBB@BA@@B@@@@@@AAA@A@@@ADABADC@@B@F@B@BBB@FBBBB@@@BBB@B@HEFCAABAHCDCDC@ABC@AB@DBDABABCD@@@@AACAAA@A@@DA@A@@CBABA@@B@@@@A@AAA@AA@AC@@@@BA@@@M@EDADABAB@@AFCEGECAACC@ACE@@@@CE@CBC@CACAAAACAA@AAAACCECAACACAA@GAA@EACAEAAAIE@@A@]QGEMK@@A@A@@AA@AC@A@@@AAACAAACAAAAACAA@CACAEAA@AAABC@A@A@CCA@EAAA@@ECC@AAA@GCCAA@@@"],encodeOffsets:[[114999,35386]]}},{type:"Feature",id:"410306",properties:{name:"吉利区",cp:[112.589112,34.900467],childNum:1},geometry:{type:"Polygon",coordinates:["@@FA@BB@B@BDDDFDB@BBD@D@@@@@B@BE@@A@@AD@@AAA@AD@@GB@AGA@@EC@@@@@AG@CD@ACD@AA@AB@@AF@@AFBFBFBBO@A@C@AE@CAG@@@@@@Q@AAGB@@G@A@@@A@AAC@SAAICGA@@EAA@M@K@IBGBABA@ABCBEBA@C@@@@@A@AA@@A@@BILCFCDCDCBABEBABCBC@ABC@CBCBEBEBAB@BGDCBABEDABKJADABA@GF@@@@BFDDCB@@CBCIIFDDFEDHA@@@@@@AA@@CABBFB@@@@@GBCBA@@@@@BB@@@D@@@@B@BBHC@@DAHE@AB@@B@BA@@BCB@@A@BDBB@@B@@B@@BB@@@B@@@B@@@B@@@@@BA@@@EB@B@@@DC@@CA@@BA@@DA@BFB@GD@@BBDB@@@B@@@@@@CA@@@B@A@@@@A@AB@@@@@@A@CE@@ACABBDA@BBA@CE@@BF@BCEA@BBCB@@AG@AAAAACGGBEBE@EAIA@@@B@@DBBBCB@@@@LB@B@BB@@@@B@D@B@@B@ADD@BBC@@DB@@@@@@BCB@@A@BB@@F@@@C@@DB@@A@@@@B@BBA@@B@@@AB@@DC@@BF@ABB@B@@A@@@A@@@@@AA@@B@@@AB@B@D@@BBA@A@@@DDA@@BD@@B@@@BB@@D@D@@@@@B@D@BA@@@@@@A@CA@@@@@CD@DA@@B@@@@A@@A@@@@AB@B@@@A@@A@@@A@@@@BAB@@ABA@@BABBB@@@@@BA@ABB@@@@@@B@@A@@D@@@@BBB@@@A@AB@@@BB@@@@@B@B@BB@@BBBA@BD@BBF@BFA@BB@BA@@@@HA@@@@@A@@AAC@@AA@@A@@F@@@@A@@B@@@@BB@B@@@FBB@@A@A@@@@@B@@@@@B@BA@@@B@DF@B@B@@@B@@A@A@@@@@BB@@@@C@@@@BA@@@AAAA@@A@A@A@CB@@@@@@B@D@BBD@B@H@@BBBDD@@BDH@@BB@@A@@@@BB@BB@@A@@@A@@@A@E@ADC@ADC@@@@B@@B@@BD@@@A@A@@B@@@BA@@B@@@B@B@@@AA@@B@BB@@@@BA@A@A@A@@BCCA@@A@@@@CAE@@@@DABBB@@@ACB@@A@A@@B@@@@@@AB@@@B@@@@A@@AAB@BA@@BBBA@@B@B@@@BB@@FA@B@@@@@B@B@AB@@@@BBB@BBB@BBBB@BB@@B@BDB@@@@AAA@A@@CACA@AAA@AHA@C@@B@@AB@@BB@B@@A@@B@@@BAA@@@@AD@@BB@@BB@DA@@@A@@D@@AF@@@"],encodeOffsets:[[115320,35758]]}},{type:"Feature",id:"410311",properties:{name:"洛龙区",cp:[112.463833,34.619711],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@@ABA@@@A@C@@@CA@@@A@@@A@A@AB@@AB@@A@@@AAA@AB@@GDABEBABGDCBC@@@@@ALAD@FEH@HKAAR@@A@K@@LI@ADBB@DAD@FFBDBDBB@DBBBBBDBBBDBBB@B@@@BBDB@@BB@B@@@NLHF^RB@@@JFBBFBDBFBB@HBB@DBDBBBFDDDBBBBB@DBBBBBBD@DAD@DDF@@@@DF@BDDBBFDFHB@RN@@RLDBFD@@HF@@DBDBBBLFDB@@HBDBD@D@H@J@V@B@@F@@CDAD@@BABAB@BAB@F@B@H@B@@BFDBBB@@@@@@BBBDDDDDBBBBBBBFBB@LBD@@@FBHA@B@@ANAN@@C@@@@B@@@BB@@B@@@@@BA@OA@@@@@BA@@@IA@@@BDF@DBFLJFFDFB@VPBBFDDFHHRJB@@@@@@@BAAA@@@A@ABAB@B@@A@@ACBC@@@AA@AAAA@@@@BC@@@@B@H@DB@A@@@@@AAA@A@AAC@A@ABA@@@@B@DB@@B@B@B@DADAD@DAB@FBBBB@@B@@@BB@@@HFB@F@F@@IF@@CB@@AB@@AP@@@BA@@@AC@A@@A@A@E@G@C@@@A@@JAFBH@PCDAH@JBF@RB@O@AB@@AGABG@C@@BAAA@@AA@@C@AACA@AA@AAA@ACEA@AA@@A@@CB@ABIC@AI@G@@B@NAD@XA@@@EBAB@D@@CPABF@@F@@AAS@@B@@CLAH@@GBAF@@GD@@GA@@@AA@AK@A@AAE@@@E@AC@A@@@CD@AEB@@CD@@A@A@@@CAA@EE@AEG@@CC@G@AA@@@IH@B@JAJAFKBCQ@COAAAEAEAE@CDO@@@EAO@ABK@AHDBEF@DCHA@AD@AKBEHEFE@@DIAEDIBCFECCDGBGCE@@AA@@A@C@@@C@@@A@AAC@C@EACAAAC@A@@ACA@@A@A@C@A@@@CAEACA@AA@E@@B@@AB@@ABC@AB@@@B@D@B@BA@@B@@@B@@@B@B@@AB@@ABA@A@@@CA@@@AA@@@A@@@A@@B@B@@AD@@ABCBEF@@C@@BCB@BA@EBC@@@A@A@EA@@GBC@ABA@@BA@E@A@G@@@A@CBC@AAABA@A@ABAB@@C@CBA@A@ABA@A@@@AB@@B@@B@@@B@B@@@@@@ABC@A@A@A@AAA@A@AAE@@@A@CAEAA@A@@@KAC@A@ABC@A@CAAAA@@A@@@@@@ABABABA@E@A@A@AB@@A@A@C@C@@@A@A@EBC@C@EAC@A@@@@BABA@AD@BED@@@B@@BB@B@@QKADGAKFAABAG@@EC@CD@@GBACC@BEA@ADC@CAEJ@@FH@@QFAEID@BGBIDOJGLA@GAAAA@A@C@E@A@A@G@A@A@CAC@CACAEAAAA@ACA@CCAAAA@@@G@EAC@A@@CAAACAA@A@C@EAA@A@A@A@A@EDA@@B"],encodeOffsets:[[115079,35351]]}},{type:"Feature",id:"410322",properties:{name:"孟津县",cp:[112.445354,34.825638],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@AA@AAEAA@CBC@CBCBA@A@A@@@CAA@@@@@AB@B@BBD@B@BBB@B@@@@@BCAG@A@@@@@AD@@@@BBBBB@@B@@ADBD@@@BA@A@AB@B@B@@BBAB@@@@@@A@QIGGCEECAAUOA@C@AB@@@B@D@FAD@@ABAB@@GBC@GBKBG@KBC@CBCAEAC@AAAAAC@@AC@A@@AA@@CAC@@@@@A@ABCDCD@@ABABA@EB@@GBC@IDCB@@@@E@C@CA@@AACACACAAA@@CBABA@@BABA@@@AB@@A@@@@@@B@@AB@@AAAAA@AB@@AB@@A@ADEDA@CBEBA@IFA@E@@@EB@BE@@@C@A@ABABED@@ABA@AAGA@@@@AA@@BCBADEBE@@AC@AACA@@ACAAC@@AA@ABCBE@ABC@ABADE@A@@@AA@C@IAA@A@@@A@@@EC@A@@A@AFAB@BAB@B@JA@@BAD@D@@A@A@@@ECCAAAAAEAAAA@OAAA@@@A@@@C@@@AC@C@AAA@ABABA@C@@BAA@@AC@A@@AA@@C@ABA@GBC@C@C@A@CAA@@BADADA@@BABABABGFCBCBCBA@C@E@@BB@@D@D@@BBBD@@@B@BB@@@BDB@@@@B@B@B@B@@@BA@AB@@@@@BAB@@AB@@CB@@ADABA@A@C@@@A@CDA@A@A@@@AAA@A@@@A@@@@@@@@B@BB@BB@@@@@@CB@@AB@@@@AAA@@@@B@B@@CBADAB@B@BA@ADA@A@C@@@@BABBB@BAB@BAB@@@BBDB@@D@BB@B@D@B@@BB@@@B@@@@CB@DEBA@A@@@A@@B@DB@BB@D@B@DAB@DA@@BA@CB@@@@A@ABCBA@@@AD@B@FAB@BBBBBBFF@@@@A@C@A@@@@B@B@@BBBBB@DBB@@@F@@@BBBBBB@@@B@B@DBB@@B@@@D@@BB@@B@B@@BB@BA@@@AB@@@BAB@@A@@BCB@@AA@@@BAB@@A@A@@BAB@B@@@@ABAB@@ABA@@@@DA@@@A@@@@@@@@B@@@B@@A@A@ABA@@BA@A@@BAB@@AB@B@@A@A@@B@B@@@BBB@BBB@@@B@B@B@B@B@BBBDHBB@B@@BB@@D@@@@BBBA@@@EFABEFEFA@@BIF@@A@A@@BA@CAA@CB@@CBC@ABA@AB@B@@@D@@ABA@KHC@IDEBAB@@A@CBEB@BA@C@A@A@A@AB@@A@G@C@E@A@@@AB@B@BA@@@A@ABEFA@BB@@A@@BC@A@CBABA@A@@@CBA@@@A@AD@D@@AB@D@BBDBB@@HDFDBBBBBBD@B@@BBB@@BD@@BBB@@@BB@@@B@D@D@@BB@@B@BBB@B@B@DAD@BAB@B@BAB@BBD@B@BBBB@B@@@BABABCBEDABABAB@BAB@@@BB@JBDBB@@@BD@B@@B@@BB@@AB@@@HD@@BBBBB@@@@BB@@@B@@@@@@BBB@@@@BBB@BB@@@B@@@BB@B@@@@D@BAB@@IDBH@N@BBB@@BBDBDBDBB@FBD@B@FAD@DADADCDCFGDIBC@A@ABABA@@BADAF@BAB@HAD@B@DBJBBBBBB@BBB@FAF@B@DAD@JAFAD@FADAB@BAB@DCBABABADADAB@BCDCBADABAD@BAJAHAB@H@FAB@HBJ@D@F@B@D@F@DBLBFBF@BAH@JAF@DA@@B@BAH@D@H@F@B@B@DAB@DAJCJCFCDAFAJCBAD@FAF@D@@@FEHBBCB@BB@CC@@CFBB@F@BAB@HAB@B@BABA@@B@D@BBB@H@D@D@DBBAB@BABCLIBAFCBADAHC@ABAFAFADADAD@BAD@DABAFABADADCDCDEJK@AB@@@BBB@@@@@D@B@FADABAB@BAHAJAL@N@B@FB@@HBJDBBHDB@DBVDD@DA@@FA@@FADAD@HCDAVK@@FADCDABA@@DC@@@@DAFAJABAHAFC@AB@LEBAB@D@JBL@B@B@HAH@F@H@B@B@DBD@JDB@B@BBFDHB@@DBLBB@F@F@F@D@PADAF@PE@@Di@EI@BS@@@AA@A@GECCCAEG@@A@@@@AA@@ACAAA@A@A@A@A@AEEIMA@EAIEA@A@C@A@@@ABA@AH@BA@@@ABA@ABA@C@@@ABAD@@@@A@A@C@ABC@G@ABA@CB@@A@@D@@@B@@@@DBBB@@@BAD@BC@AB@@AB@BABA@@BABA@@B@@A@ABA@EBABG@ABA@A@AA@@A@A@A@AB@@@BA@A@A@@BA@@@@@AACCAAA@A@E@E@@@@@ABABCBGDA@EB@@A@@@C@A@A@EBA@AAA@CAA@AAC@A@ABE@@@G@A@@@A@A@AA@@CAA@CA@@CAC@AA@A@@A@@B@@@@A@@@A@@BA@@@A@@@@@@@@@BA@@@AAA@@@A@@B@B@D@D@@@BA@AAA@@@A@CD@@@BA@@BE@@@AAC@CA@A@@@@@AABC@C@AH@@@DAD@DABA@@@ABA@A@ABC@A@A@A@C@C@@@CAC@CCEAAA@AAC@ABGBC@@@@B@@@BA@EAE@@@C@AA@@@@@A@A@A@@B@@A@@AA@A@A@@@AA@AAAA@@@AB@@AAA@@@AB@BAB@@AA@GGCEECGEAA@@@AB@BA@A@@C@@@AB@@A@@@AB@@@@KA@@AB@@O@@BA@@BA@@DE@@JE@E@A@GE@@A@"],encodeOffsets:[[115298,35560]]}},{type:"Feature",id:"410323",properties:{name:"新安县",cp:[112.13244,34.728284],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@A@@B@CG@@A@A@A@GAA@A@@DADAB@D@BB@B@FAD@D@DAB@"],["@@A@C@G@A@A@EBAAA@A@A@EBG@@@C@A@@@@@@@@ADADA@@BABA@@@A@A@@AAA@@@@@C@AB@@A@CC@@CAA@C@E@CBEAA@A@@ACA@@EEA@@@@@AB@@AA@@@@@@@BAB@@@@A@A@@AAB@@@@AB@@A@A@C@@@A@CAC@CA@@A@AA@A@A@A@A@CBABA@@B@B@@A@@BA@@AA@A@@A@AAA@AAA@IB@@AAC@EC@@C@AAA@A@@@AB@@ABAB@@@D@@@@@BABABABCBIFABABADCFEHADABAD@B@BABAB@@@BAB@DCB@BA@@@A@GCA@@@CF@@C@GAEAA@CBABCB@@@@@B@@AD@B@@A@ABE@A@AB@A@@A@BA@@@@@A@@A@C@A@A@@A@@@A@A@@@@@A@@B@BAD@F@B@@AB@@@AA@@AAAAAAA@CAA@A@@@A@@B@BAB@BAB@@@@@B@@ABA@@@AB@D@@@B@B@D@@BB@@BB@@FDB@@BBB@DBB@@@@CDABED@@@B@B@@@BA@AB@B@@@H@B@B@B@B@BA@@@AB@@ABAA@@CACA@@C@A@@@CDCBABA@AB@@A@A@ABAAC@C@G@A@A@A@@AAA@@EAAAA@A@@@@BABC@CDABAB@@CDABEBCBABCBCBC@@@ABEBC@CBABA@@@AB@B@B@@A@CBADA@@@A@@@@@AD@B@@@@@BABBB@@AB@@BB@@@B@BBD@@BB@@@B@@AB@@B@B@B@@@@@@B@@C@@B@@@@D@B@@@BB@@@@@@ABCB@@B@D@BA@B@@BB@@FB@@B@BBB@DBB@@@BDB@@@B@BB@B@@@B@B@B@BA@A@@B@@BB@@@B@@A@@AA@@B@@@@A@CBA@ABA@A@A@A@CBIDA@A@@B@@@BBB@BBAB@BA@@B@@B@BCB@BBB@@D@@@@B@B@BCJ@@@@D@BB@ADAFA@AB@B@B@D@B@B@B@BAB@@B@@@B@B@B@B@B@BA@@@@BB@@BBBBH@BBJ@@ABAB@@@@@BB@B@B@D@B@@BBB@BBBB@@BD@B@B@D@@@@@@@AB@B@B@B@B@BBB@@@@AD@BBB@@@B@@CF@B@D@@A@@@@AA@@@ABA@ABA@@@AB@@E@A@A@AB@BA@@@AB@BAD@@BBBBDFBBBBBBB@@B@BAB@BABABKFCB@BA@@@@BAA@@AA@@A@AB@BA@@AABBBB@@@AB@@ADA@@B@@@@A@AB@@A@@B@@@B@@@BA@@@@@EBBBAB@@@B@@@@@BB@@@@BA@AB@@ABAAA@ABA@CBA@@BB@DF@B@@C@@@A@AAA@@@A@ADAB@B@@@BBB@@DB@@DBB@BBDD@BBB@B@@AB@BA@ABI@@@C@A@EAECA@EAAA@@AB@@A@@@CAA@AAC@CA@@A@EAIAA@CA@@ABA@@@@BBBBDBBBD@D@@@B@@@BAB@B@@@@ABA@@BABAB@@A@AB@@CB@BAB@BAB@@A@A@@@@@A@@@CDCBA@CB@BCB@BCBABABABABEDA@@@A@AAE@G@ABA@C@A@A@AAA@@BA@@BCDAD@@A@EBA@CB@@CBABA@A@A@A@@AA@@@@@ABBBA@@B@@@@@@A@@B@B@@@@DB@@@DBB@@@B@@@BA@CBA@AB@@AB@@@B@@BBD@@BB@BDB@@B@B@B@B@@@BDBBDBBBB@@B@B@BB@@@BB@@B@@D@FBB@@@@B@@@B@@@@B@@@B@@@@@@B@@BB@@@A@@@@B@@@@@B@BBB@@@@@@B@@@@@@B@@@B@@B@@@@BA@@@@@@B@@A@@@AB@@@DBFBD@BBBBD@DBBB@B@@@@@@BBB@BB@@@B@B@@BB@@@BBF@@BDB@BDB@@@FBB@D@D@D@DADAD@D@HB@@B@DBB@@B@@AB@@BB@@@@BAB@@@@@@@@B@@@@@BB@BAB@B@D@@@B@@@BD@@@@@@B@B@@AFB@@@@@B@B@BB@@@@@@@BCB@@@B@BB@B@B@@@@B@@@BB@@B@D@B@@@@BA@CB@@@@@B@@@BAB@B@@@B@B@@@B@BBB@B@B@B@@@BACA@@@E@CAA@@@A@@@@B@B@B@B@@@BA@@@CAA@@@@BB@@B@@@B@@@@A@@@AA@@AAA@@@C@AAA@@BA@@@@B@@@BA@@BA@@B@@A@A@@B@BAB@@@@A@@@@@CC@@A@@@@B@@A@@@A@A@@@A@A@A@A@A@AA@@A@A@@B@@AB@@@BB@B@H@B@@@@B@@@@CB@@@@@@FB@@DA@@D@@@D@B@B@B@BBB@BB@BB@B@F@BBB@@BB@@BB@@@D@@@B@@@BJ@BB@@F@B@@@@BBB@@AB@A@@@AA@@@@@C@AAA@A@@BC@AB@BAB@D@BB@@@@@B@@A@ABA@FH@@@BAB@@@@BBDBD@@@D@B@A@@B@@A@@@@@@@A@@@@BA@@@@@A@@@@B@@@@ABA@@@ABA@AB@@ABA@ABCAAB@@AB@@A@@BA@@@@BCBA@E@A@ABABEFABABA@@@ABE@A@@BCF@B@B@@A@EDA@ABAD@@ABG@A@A@@B@@@B@B@@@BB@DBB@@BEB@@@B@@B@F@@@FBB@B@BBBDB@B@@@B@D@BAB@@@BBBB@@@D@@BBB@@@@@@HBJ@B@BBB@BBD@@@@A@A@@@A@@BB@@BB@B@BB@B@@@BB@FBB@F@BA@@@A@C@@@AB@@@B@@BBDBB@@BB@@B@BADAD@BBD@JBB@B@D@D@D@LCHABAFB@@BBBBB@DBB@D@D@DABA@@@A@A@ABCBABCFCDAHCD@DEBCBEAA@@@C@AAA@AAEACAA@A@A@A@@BA@@DCD@BAB@B@B@B@@@BBDB@BB@@@B@B@B@B@DCD@DAD@D@F@BBF@D@B@B@B@J@B@FBD@D@B@D@F@LAB@NBB@BAB@@ADADANED@BAJCJAFAH@FAJAD@DAD@DABABADABADCBADC@@BADA@@DADCDABC@@DABADCB@BCFOBE@A@E@E@A@A@C@ABA@@B@D@H@F@DAD@JAH@D@B@B@@@BABAFGHIDCB@BAB@BBD@D@D@D@B@BAB@BABCFCBADADAFELEBAB@B@DBBDDDBDJC@@BA@A@C@@A@A@@A@@@A@@AAA@AA@@@@AA@A@@@@A@@@A@@A@@A@AAAA@@GC@@A@@BA@@AA@@@@AAC@@A@CAIAA@@A@@BA@ABABABAFCDABABA@A@@@AAAAAA@C@AAA@ABA@A@ABC@CBA@A@A@AAA@@@AA@@@C@C@A@@AA@@A@AA@@AC@@AA@AA@C@AAAAAAECGC@@AAAC@A@CBA@@@CBCB@@@B@DA@@B@B@BADAB@D@@AB@@@AAB@FEBAB@@@B@@A@ABA@@B@F@D@H@B@@@BAB@B@B@D@B@@AFADAB@@@BAFAJCD@LGB@BA@@@C@@@ABAB@BAD@DA@@DAB@DBB@@AB@B@@@JE@AB@FEFEBAFE@@B@AA@A@@C@@@AA@@@AAACGAA@A@A@A@A@A@A@@AA@AAA@A@@@A@AB@B@@@@ABA@@BA@AB@B@@AB@BAB@B@@@@A@@@A@@@@@@B@@@B@@C@@B@BA@@BABA@@@@@ABA@AB@B@@@BA@A@@BB@@DA@AB@@@BA@A@@BA@@B@@AAA@@@A@AA@@AC@@@A@@@AA@C@A@A@@AAAAAA@@E@@@A@CAA@AAAA@@@A@A@@B@D@B@@@@@EEAAAAAAA@EBA@C@@B@@ABAD@B@B@@A@@DAB@@CBA@CBA@C@A@@ACAA@@@@B@@@BABCFA@@D@@A@@@A@@AA@C@A@A@@A@CA@AC@A@@BA@ABA@AAABA@A@@D@B@B@BCB@@A@ABABCDA@@@A@A@@B@BB@@@@BA@@DA@@@@@@AAA@@A@A@@@@@@B@@@B@B@BB@@B@B@B@DCB@@@D@B@B@BABC@@DA@@BA@@BA@A@@@@BAB@@A@@@A@A@A@A@@A@AC@@A@@A@A@@ACAA@@@C@CA@@A@E@AC@CA@@@@CBABAB@@C@@@@@@@AC@A@A@C@A@A@A@AA@AA@A@@@ABCB@@AB@HBB@@@FAB@BAFA@ADAB@BABADC@@@ABC@AAI@A@@BE@ADA@A@M@@D@@@@ABA@CAI@CAC@C@CAA@AA@@@CA@@AA@AA@A@AA@@@@@A@C@@AA@@A@CAAAC@CAA@@@AA@@@@BABA@ABADC@@@CBA@AAA@A@@@AC@CAA@@@EAAA@@A@AACAAAA@@A@@@A@@BC@@@@AAE@AAA@AAA@@A@@A@@@C@C@AAA@@AA@@@@AA@CBA@EBA@A@A@AAA@@@E@ED@@A@C@C@EAA@@@AAA@ABGBC@A@EBA@A@CBC@"]],encodeOffsets:[[[114719,35619]],[[114921,35463]]]}},{type:"Feature",id:"410324",properties:{name:"栾川县",cp:[111.615768,33.785698],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@A@A@A@A@CAC@CBAAABABA@ABAB@B@BEB@@ABBFBB@DA@CD@@BB@B@BAB@@AB@@@B@@ADA@A@@@A@A@ABABA@CB@@AB@B@@A@@B@B@@@@AAA@CBA@AAA@CBA@ABA@@@CBABA@A@ABCB@B@@ABA@A@A@AAA@G@AA@@A@ABA@A@C@A@EAG@AAAAA@@@@@AB@@@@A@CAA@EBA@AB@@CB@BA@A@ABE@CBC@MBA@A@@@A@ABA@A@@B@B@DA@@@A@AB@AA@ABC@AAA@@AAAAA@@A@A@CBA@@@EAA@@@CBA@ADA@AB@@BB@@CFA@ABAB@@A@ACA@@AA@AAA@@@CAA@@@A@@@@B@B@BABA@@@EAA@A@A@ABC@A@A@A@A@A@@A@@AI@@CC@@AAAA@@A@@@EECCAAA@A@A@AB@@ABA@CBCDA@@@A@AACBCBA@ABABCDA@CB@BAB@BA@@BA@A@A@C@CA@@ABA@AAA@@@A@CDA@ABA@AAABC@A@ABCDCD@@CBA@A@@@ADAB@@@AA@A@@B@@CA@@A@@BA@ABA@AB@@@BAB@B@@ABG@A@@@AA@@C@@AA@A@A@A@ABEDA@ABEBABABA@A@@@C@A@@@A@@BAB@BCBA@E@ABAB@BA@A@CB@@ABC@@BCAA@AA@@A@@@C@A@@@ABA@@@AAA@A@CBA@A@A@AAA@A@A@C@A@C@CBAACB@@EAA@ACA@A@CBABABA@AB@@@@A@A@@B@@C@C@@@@@A@AAAAC@A@AA@@EAAAA@A@CA@@ABABA@A@@@A@A@A@@BA@@DABABABCB@@@BA@CBADABA@A@ABE@AA@@@@A@@@A@ABCBA@@BA@ABGBA@A@@@CAAA@@A@E@@DAB@BA@AAA@@@A@@@A@A@@BA@A@@@AB@@@B@B@B@@@B@@ABABABAB@@BD@@AB@@@@A@AB@@@@@BB@@B@B@B@BAH@B@@BBBB@B@BAB@BABB@@B@BAB@D@F@BBB@B@D@@CBCDA@ABA@AB@@AB@BA@C@CBAB@B@B@B@B@@A@ED@@AB@BA@ABA@@@@B@B@D@B@B@BABCBA@@@DH@BA@A@A@C@@@@B@BABA@@@AA@@A@@BABE@@BA@@@@B@@CA@@AB@@@@A@A@A@@B@@@B@B@BBB@B@D@BBB@B@@ABABA@@@A@@@ABA@A@A@@BAB@@A@@@@BC@A@A@@@@B@BAFB@@B@@AB@BAB@B@B@@@@B@D@@@BB@@BBB@B@@@@@@@AA@@@@@A@@@A@A@@@AA@@@AA@@@@@@D@FD@BBB@@BAD@B@BA@@DBBB@@AHBB@B@BB@B@B@BC@AAAB@@ADC@@B@@@@@@B@@BB@@BBF@B@BDBB@@BB@@@D@@ABAB@B@B@@A@@@@DA@@BC@@@CHABABA@@@@HAB@B@@BB@BBD@BAD@@BB@@@B@@D@B@@BB@@@B@BBB@@B@@@@BDBBB@@@B@B@DB@BB@DAB@@@B@B@@@@@BBB@B@@@D@DAB@@@BABABA@@DAD@BA@@B@DD@BBDB@BA@@BAB@B@@BB@@B@B@@B@B@@DB@BAB@@@BAB@@@B@@@B@@@B@B@@@B@@A@@@A@C@A@@@@@@@A@AB@@AB@B@DBB@D@@@BBBBB@D@D@FC@@BBB@@@@BBB@B@@@B@B@@BB@BBB@B@@@B@BAB@BBB@BBD@B@B@B@@@@@BB@BAB@BBB@@B@B@BBBFBBBBBB@BB@BD@BB@@@B@BAB@@HBB@@BBBBBBBBDB@@@BBFBD@D@D@BBB@@BB@@@BBD@@B@BB@@@BBBB@B@B@BBBB@BB@@@B@@BBB@B@@BDB@@BB@D@@@BA@AB@@@B@@@@@@@@@@AB@@@@@@AB@@@@@@A@A@@@@@@DBB@@@@@B@@@B@B@@@@@B@B@@@BBB@B@@@B@BBB@@@B@@A@@B@@@D@B@B@@@B@@@BAD@@AD@B@DAB@B@@@B@@@B@@@@@@ABE@A@@@@B@B@@@BABABAF@BABBD@@@BAF@@@B@B@@@BBB@@@@ABAB@@@@@@@BB@@BB@@BA@@@@F@B@BA@@B@B@HBB@@D@BB@@BB@@BDBDBBAD@BBBAB@@FB@BB@@@@BAD@B@BBD@@@@FDDDBB@@D@B@D@BBBBB@@BD@B@BBF@B@FDB@DBB@B@BA@@B@DBB@B@@@DA@@B@FBB@@@B@@BB@@BB@@BB@D@LDBBDD@BB@B@DB@@DADAB@@@B@BBB@@BFBB@DBNDBBBBDDB@@B@@BB@@B@@@B@@BAD@@BB@BABABBD@B@B@B@BAB@B@@@B@@@@DBB@DAB@DBB@@BB@BAD@@@B@B@FB@@B@@@@@BABA@@D@B@@@FBB@@@FBBBB@B@B@@BBDBB@@B@B@D@B@D@D@D@@@DB@BBBB@B@B@@@@@BAB@D@D@@@B@BCBABADC@@BA@BD@@@B@B@B@B@B@BA@@B@B@@@BBB@DB@@BBB@@@BABB@AD@BAB@B@B@D@@@DDB@B@DB@BB@@@B@FA@@B@D@D@D@@@B@@D@BB@B@@@BB@BB@B@DB@@B@BB@@B@B@@@@@B@BB@BB@BB@@B@@@@B@@BBBBBB@@@BBB@@@BBBB@B@BB@@B@@B@B@@F@@AFBB@@@@B@BBBBBBB@@B@B@@BB@B@@@B@BB@@@@B@@@B@@A@@BA@C@@@@@A@@B@@AB@@@DAB@@@BCB@@AB@BA@@B@B@@ABAB@BADAB@@@BBB@@@BAB@@@@@BBB@@@DBDBB@DABB@@B@DBH@B@@@B@B@@@BAB@BAB@BAD@@@B@BADAD@BAD@B@@ABA@@J@@@@@@B@@@@BBB@@BB@@BBBB@@BB@@@BBBB@@DB@@@@@BBB@B@B@@DBB@B@@AB@BBBBB@@@@B@BB@D@BB@@BBBBDB@B@BB@BB@@@D@DB@@@BA@@B@@@B@BA@@B@B@BB@@B@@@BB@@F@B@@@D@@B@@@B@BBB@B@@BBBBDDBBBBB@B@B@FB@@D@BAD@B@BAD@BAD@BAB@BC@ABC@CBC@ABC@@BAB@BA@@BA@@@ABABA@A@A@A@ABA@@@A@A@A@@@AA@@@AA@CA@@AA@@AB@@A@AAA@@@@BC@A@BB@BAB@@@BAFADA@@@A@@@A@@@AAC@@@@@AB@BC@AB@BE@ABA@@@@@A@@AA@@@@@AB@@@@A@@@AA@AE@A@@B@@@@A@C@@@@BA@ABAB@@@@A@C@@AA@@@A@@DA@A@@@@BAB@@@B@@@@A@@@@AA@@@@B@@@BA@@@@B@@@B@@ADABA@AB@@A@@@ABA@@B@BBB@@@@AB@@ADAB@@A@@@AB@@C@A@@@@B@BA@ABADA@AB@B@FE@C@@@A@A@@@A@@B@@AB@DABA@@BC@A@@B@@AB@BA@ABC@A@@AA@A@@BC@A@A@A@@@A@ABA@@@A@A@AB@@ADCB@BABE@A@@@AA@AAA@A@@A@@@A@A@ABC@A@A@@@A@A@C@C@CBA@@BABA@ABA@ABC@@BAB@@@BA@ABA@ABCBA@@@AAE@C@AAAEACA@@BA@@BABA@C@AB@@@@AB@B@@AB@D@D@DBB@B@BAB@BABABCBADCBABA@A@AAA@@AA@CCG@AAA@@@A@ABA@C@A@A@@A@CA@A@A@A@@@AA@AA@@@@@A@@@@AACACA@@A@@AA@@@@C@@@ABC@A@ACACAA@ABA@@@A@@C@AAA@A@A@@BAB@FAB@@AB@BEB@B@BBBA@@BCB@@AB@@@B@@@B@BBBDBBBB@B@@BDBBDBDB@@DA@AB@@@BB@@B@DBB@@@DB@@B@BA@@BABADA@@@@@AA@@A@@AE@@@A@@BABA@AAA@@AA@@@@BABA@@B@D@B@@@@@BB@@@@BB@@@B@@@B@@@DA@@B@@@BB@BDB@@@B@BA@@FBFB@@@@@@@A@@@C@C@C@@@AB@BAB@B@@@@B@B@BBBB@DBBBDB@@BBBBBB@@B@@BBB@B@@@@BBF@B@BB@BB@D@DBBBB@D@FDB@D@B@BB@@@@@@BA@@@@B@@@B@BBB@B@@AB@BABAB@@@B@@@B@F@B@F@BA@@DC@AD@B@@@B@B@B@B@B@BABA@@BAB@B@BA@AB@@@@A@AB@@@B@@ABABABABABA@AB@B@B@BA@@BBD@@@DD@@B@BAB@DBD@@A@@@A@@B@BABADABAB@B@F@BB@@B@@BB@BBB@D@B@B@B@@BB@BA@AB@@CBA@@BCB@@C@A@@@@@ABA@@B@BAB@@@@ABADE@@BBB@BAB@@@@AB@@@B@@ABAB@BA@AB@@A@A@@BAD@@AB@@C@@@@D@DA@@BBD@DB@@@A@A@@@@BADABABADIB@@AB@F@B@B@D@BBB@@@B@@@@C@@B@@@@A@A@@BA@A@A@@BABA@@B@@@BAB@FAB@@@@AB@B@@ADE@AB@@@@CAA@A@@@ACCAA@A@C@A@A@C@A@@HC@@B@@@@@@@AAA@A@AA@@AA@@AAA@AA@@A@A@A@EAAAA@@@@A@@@@A@@@CA@A@@A@@@A@A@@@A@@@A@A@A@C@@@A@CCCAAAAA@@@BA@A@AACAAAC@@ACA@@A@@@A@A@E@C@A@A@@A@@@ABE@@@CAA@@A@A@@@AAA@@A@@@AAA@AAA@@A@C@@@@@AE@A@@@A@CBAAA@@@AA@B@@CDEBG@C@@@AAACC@AACAA@A@ABADI@@@A@@@@ACC@CCC@@AA@@@@@@AAA@C@A@@EC@AAAAACAA@@@A@CBABEBC@EBA@A@G@A@ECGCA@CBAA@@C@@A@@@AA@C@AA@@@AC@CA@@A@CF@@AB@@EBA@A@C@A@C@A@ABCBA@CD@@A@AB@@CBABA@ABA@ABAD@@BB@@@B@BA@ABA@A@A@AAA@@@A@A@C@@@A@C@A@A@@@AAAAA@A@E@@@AA@@@@@A@AAA@@@C@A@@AA@@AA@A@@AA@@BA@@@@B@@AAA@AA@AAA@ABA@@@A@@@AAAA@@@@AA@@@@EBA@A@AAA@AA@AAA@@ABA@A@@@A@@A@ACAAA@AAAAA@A@@@A@@@A@@A@AC@ABABAB@@AB@@@B@@A@@@@B@D@DADA@@BA@@@@AC@@@@B@@A@A@@@A@@AABA@@B@@A@A@A@A@@D@@@B@B@BA@@BCBAB@@AFA@@@ABADG@A@ADCB@@@A@CAA@AA@AAA@@CAA@A@AA@@@@@A@@CA@AA@@AA@AAACA@@A@AACAA@@@@E@A@AA@@@@AA@CA@@A@@@A@A@A@A@@AE@A@A@ACC@@A@C@@@@AA@AAEA@AA@ACACAACAA@ECAAA@A@@BA@@@C@C@A@@A@A@A@C@AAAAAAAA@@A@A@@@A@AA@A@@A@@@AAC@B@@A@A@@BEB@@A@AAAAA@@@@@AB@BABA@@BA@@BAB@BA@ABA@C@A@C@C@ABC@@BCB@@CBA@C@A@AB@@A@@B@@AB@@@@ABA@AAA@A@A@CB@@A@@@A@AA@@A@A@ABABA@ABABA@@@@@A@@@BB@@ABABABA@@@A@CAA@AA@@@AA@A@@@@BAB@B@@C@A@A@A@EBA@@B@@@@A@@@CB@@ABC@CB@@@BA@A@A@C@AB@@@@@B@BA@A@A@A@AA@@C@@@AA@@@CA@@@A@CAAAAA@@C@@@A@A@A@C@@@@@AB@@A@A@ABACA@C@ABC@@B@@ABAB@BA@@BA@@B@B"],encodeOffsets:[[114361,34496]]}},{type:"Feature",id:"410326",properties:{name:"汝阳县",cp:[112.473139,34.153939],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@EA@@AA@@A@EBA@A@A@A@A@EA@@AAA@@A@@@A@@@AA@A@MC@A@@@AB@@ABA@@@A@@ACCCA@EE@@CBC@A@A@AAA@@@A@@@A@@B@BA@ABAB@@E@A@@@@@AD@@@@A@@@A@A@@@ABA@@@@B@@@@DD@BBB@BBB@@AB@@@D@D@BA@@BA@AAC@@AABA@A@@@CAA@@@A@@@AAA@A@CCAAEAA@CAAAC@C@A@A@C@GBA@@@AB@@A@C@CBA@@@AA@@C@@@EAA@AAA@A@A@@@A@A@@@@@AB@@AD@B@@ABA@ABA@AB@B@D@BABCB@BAB@@@B@BA@@B@B@@BBBBBBBBB@B@DB@@@@ABAB@@@B@BAB@@ABCBABAB@B@@@B@B@@BBBBBB@B@F@@@@@BA@@@ABA@CB@@AB@@@B@B@@AB@@AB@@A@@@A@AB@@@@@@@B@@BBB@BB@@@B@@B@B@@@@BB@@BAB@B@@BB@@BB@B@D@B@@@BAFBB@@BB@@@@@BAB@@CBAB@BAB@B@BB@@BAB@@@@@@@@B@B@B@D@B@@B@@BBAB@@@B@@@BB@BB@BB@@B@@@B@@@BB@@DA@@B@B@@@@A@A@@@AB@@@@@BBB@@@B@@@BA@AB@B@H@BADB@BD@@@B@@CB@BAB@BADBB@B@B@@DD@@@B@@BB@BBB@@@B@BAD@@ABA@@B@B@B@@@DB@@B@B@@B@FDD@BBDBB@@BBBBB@BB@B@BB@@@B@BFD@@DDBB@@@D@@B@BBB@BBBAB@@AF@B@@@@B@BBBB@B@B@@@@B@@BB@@B@@@@D@D@D@DB@@@@BB@D@BBB@@@BBBBB@@@DA@@FBB@BBFB@@D@BB@@@BBB@BAB@FAB@@@B@@@@B@@BBB@BBBBBDBBDB@@B@BB@@BBB@@@BAB@@@B@B@@@BA@@B@@DBDDDBB@B@B@BB@@BB@@@@@BBB@@D@B@F@@AB@@@D@D@B@DAB@B@B@DBB@B@DAB@BCFADA@ABABEBAB@BAB@B@DBD@D@@@BBBBAB@@@B@@BDBBDBBBB@B@B@D@@B@BB@DBHB@@@@BBB@@@@D@BABEBAB@@@BBBDD@B@@@@A@@BA@A@EAE@E@CB@@EBC@@@AB@@ABA@@@@@@B@@B@BB@@BDB@BDDFBBBF@@@BFBBB@B@BA@AB@@AB@@GBABCDABA@@B@BABA@AB@B@@@D@@@BABABABADBD@B@BA@@DADABABABAB@BBB@B@D@DAB@@ABABCB@@AB@DA@ABAB@B@B@BBA@@BB@@@B@B@@@BCBA@@@A@@B@@B@B@DBDBB@B@B@@@B@B@B@BBBDD@FBBBBB@B@@@@ABAB@@@BB@B@@@D@F@@@B@@B@F@B@@B@@@B@DB@@@B@DBBBFBD@B@B@@@BBBBBBBB@B@B@BAB@B@F@@@B@D@@AB@BB@@@B@@@BAB@@@@@BAB@B@@A@@BABADAHABADADADBB@@AB@@AB@B@D@B@@@BA@@B@@@B@B@@BB@BBD@D@@@@@B@@@@BB@B@@@B@BBB@@@BBB@@BBBB@BBB@@B@B@@@B@@A@@B@D@@@D@D@B@FB@BD@B@DBFBBBDBBD@@BB@B@B@@@@AB@BABADAB@BAB@BCB@@BBB@@@@B@B@B@B@@@B@B@@B@@@D@@@BAB@@@@@D@BAB@@@B@@@B@@@B@DA@AD@B@@@@B@@@@@B@@@BBBBB@@B@B@BAFA@@B@@BF@BBBBDB@@B@D@D@D@DA@@@@B@BBBAD@@@D@@@B@DF@B@@@DAD@@CBA@ABABAB@FA@@BE@AB@@A@AD@BAD@@@B@@FHBB@B@BBDBH@D@B@@@B@B@BAB@BAB@@@@@BBBBB@@@BAB@D@B@@BB@BBD@B@B@BA@ABEB@BA@@B@B@D@BA@@B@@@D@@A@AB@B@@ED@@@@ABCDABCDABABEJAD@@@B@D@@BDBBFFB@@BBDBF@@@@BB@@@B@B@@BBBB@BBDBBB@DFBBBFBBB@@BB@BBD@F@D@@@BG@@BA@@B@@@B@FBDBDBD@BBF@@@B@DABAB@BADCBABCDCFEB@@@@@BAB@DAD@B@DADBBAB@B@B@@@@@@BBBDB@BBB@B@BBBB@@BHBDBB@B@BAHABAD@B@B@B@FABBB@@@B@DABABAB@BA@@B@BB@B@DB@@BA@@B@@ABEDAB@B@B@@@B@B@B@@DDBB@B@@@@BBD@B@BBHBFB@@B@D@B@DAH@DAF@F@B@H@DAB@@@@BB@BJBB@BB@@BB@@@B@FAF@@@BA@C@@@A@@@A@ABC@@@A@@B@BAD@BAHAH@H@@@B@DDB@@@B@BA@@BAB@BA@@@E@@@AB@FCB@FC@@FCDCBABABABAB@B@BA@@DCDA@@@@@@HDFCBA@@DABA@A@@@@A@CAEAKEEBC@@@A@A@@CA@CA@A@@B@BB@@@@BA@@A@ACAACA@ACAAA@@@@@A@A@@@@A@A@@@@@AA@@@A@CA@@@AB@@AC@A@@@@C@BC@ACA@@A@@BA@@@@@AAAC@@A@@@@@@B@@A@@@@@@@AA@AAC@A@ABA@@@AAAA@A@@@AAA@@@ABA@@@@@AA@@A@@@A@@@A@CA@@EAA@A@AA@AA@@AAAACAAACAACA@A@@@A@@DA@@B@@ABA@@BADABAB@@AB@DCBA@@BA@@DC@@B@B@DAB@B@DADAFADCB@BADABA@A@A@A@@A@CAA@GC@@CCF@@A@@AA@ACAACA@ACEKAAAA@AAAACCA@ACA@AA@@A@A@A@A@A@@BABA@@B@@@B@@@B@B@B@B@D@B@B@@@BAAAB@@CDADAFABAFEB@@ABIBCBABADADCB@@ABC@CBABK@@BEBM@ABAD@DAB@B@H@@@B@@ADABAB@DCDCDG@@BA@AB@@ABA@A@AB@@AB@BABA@A@AA@@EAC@A@A@A@@@@@GA@@AAAAAA@A@@AAAACAE@@AC@@@A@@@@BE@C@A@@@A@@AAAA@@AAAA@AA@AAAA@@@AB@BA@@AA@@BABA@@A@@AA@@@@A@A@@FAB@B@@@AC@A@@A@@@A@GD@@@@A@AB@@ACAE@E@@@ABC@@BABABA@@@@AC@A@ABABC@@BA@@@AAA@@@AAAA@@@@@AC@@@ABA@A@@AA@@A@C@A@CBGE@BA@@@CBBB@B@BCAC@CA@@A@@@AAGCCA@@@AAC@AB@B@@AB@@AAC@A@A@@AA@@@A@A@@BBB@@@B@@@B@@@@@B@@@@@AA@@@AC@@@CE@@A@@A@C@AAA@@CAA@G@@@A@@@@@C@C@@@A@AA@AA@@@@AA@AB@@A@@@AA@CB@@A@@@CCACB@AA@A@C@CBA@A@@AA@AAG@AAA@@CA@@A@@@AA@A@AA@@@A@A@A@AB@@@D@DAFAB@@B@@BBB@DB@@B@@@B@BA@A@A@A@@BAB@B@B@@@@ABABADA@@BA@@@@B@B@@@@A@ABABA@@B@BAB@DA@@BAB@@@@AA@@@A@A@A@A@AA@AA@@@A@EBA@A@C@A@A@A@@@A@AAAA@@EBA@@@AA@@BA@@@A@@AAAA@A@@@@@A@@B@BA@AB@DA@C@E@@BAB@@@@AA@@A@@@A@@A@@AABAAAA@@AACEAC@AAAAA@@@CAAACAA@A@A@A@AA@EAA@CC@@AA@C@A@@@ABCBA@@AA@C@A@A@@@C@AAA@@A@A@A@AAC@ACC@A@EAA@AAA@AA@@AAA@CAA@A@AAA@CAA@AAA@E@@@A@@@@@AA@CBC@@AA@A@CAABC@A@AB@BBB@BAFAD@FAB@BAB@@@D@@@BADCDA@A@A@@BABCBA@@DAFAB@BABBB@B@B@DA@@D@B@JA@@BC@ABA@@AC@A@A@@@@@AAA@C@@A@BA@@@A@@@AAA@@CAA@A@AAAAAABAB@BA@@@@@@@@@A@@@@@AA@ABA@A@@@@AAC@ABAAA@@@AABAAA@A@CA@@@A@@@C@A@A@AAC@@AAA@AAA@A@EA@AA@A@@@A@AA@@A@A@ABA@@@I@A@A@AC@@CAA@C@CAA@AB@BA@A@A@A@A@@BA@ABA@A@C@AACAAA@AAAAAA@C@@@A@A@@AA@AAAAA@CAAAI@A@ACAA@CAA@AAA@ACAAA@ACC@A@@A@@@@@@A@@AA@AECA@@A@@@@A@@@EE@@AC@AA@CAA@@@@A@A@@@@AA@@@@@A@@@A@@AC@@AAC@GAAAC@A@@AA@E@EAA@A@A@A@E@CBABC@A@@B@@ADAB@@AB@B@D@B@BCBABEF@BAB@@@BABA@A@A@CA@AA@@@A@A@@@A@A@@@A@ABA@ABC@CBCBADA@@@A@A@ABAB@D@BA@@@A@@@@@GAC@@AA@@AB@BAA@AC@@A@GAAAA@@BA@@BA@@@@@CAC@A@A@@@A@@@@C@@@A@@@A@@@C@@AA@@A@@@A@C@ABA@A@A@A@"],encodeOffsets:[[115027,34637]]}},{type:"Feature",id:"410325",properties:{name:"嵩县",cp:[112.085634,34.134516],childNum:1},geometry:{type:"Polygon",coordinates:["@@DG@@@@AAAA@@BAB@DAD@DA@A@@BC@ABA@A@AB@@ADA@ABE@@B@BABA@AB@@A@@A@@A@CA@@A@@@C@AA@@@A@A@AACAAAA@ABABAB@@A@ABA@@BCBA@K@C@A@EDA@AA@ACAAC@@A@@@AB@@A@ABCDAB@BABAB@@@@E@A@AB@@CBEDA@@@C@@B@@@BALA@@@@@A@@AAAA@@ACAA@@@E@C@A@AAC@A@C@C@ABA@AA@@@@AAAA@A@@@@@AACAA@@@A@AAA@ABA@C@A@A@C@ABC@A@E@@@AB@@@@BBD@BBB@@@@B@DA@ABCB@@AB@@B@@@BBDDBBB@BB@B@BABCBC@CBAA@@A@@A@C@@@@@BD@@A@A@@@@@CC@@@C@ABC@@@@A@ABABA@AAA@A@AC@@C@A@@@C@CB@@A@A@A@C@A@@@EAA@E@A@A@A@A@@BA@@@A@A@C@@@AAACA@AAA@AAC@A@AA@@AA@@@@AA@@AA@@EAA@CACAAAA@AA@@A@C@A@C@A@GB@AE@A@A@C@A@CA@@A@A@A@GBA@E@A@@@@@@AAA@@CA@@@A@AAACAGA@@CEABA@@B@B@BABAB@@ABA@@BAB@@ABAD@@ABA@@B@B@B@@@B@BAB@@AB@@C@@@AD@@CB@@A@A@A@A@@@@@AB@@@@A@A@C@A@A@A@ABA@A@ABA@ADA@ABC@CBC@A@A@@@E@C@C@A@@BAB@@A@EAA@C@@AABAAA@CB@@A@ABC@@@C@C@@@C@A@A@AAA@CAA@A@AAA@CAC@@BA@AB@@AB@@ABAB@@BBBB@B@@@@@B@D@@@B@BAB@@BBBB@@@BAB@B@B@D@B@B@B@BB@BB@@B@BB@@@@AB@B@@@B@@@B@@@BAB@@@B@B@BBF@BB@@B@@@BAD@@@BB@@BBB@@@B@B@BABC@@BAB@@ABA@@@A@@@C@CA@@ABABABCBA@@@A@@@EB@@@@A@@@@@AB@BCBABA@AB@B@@@B@@BD@B@@@BB@B@@B@B@@@B@BB@BBBBBB@B@D@B@B@BB@D@D@@@B@@AB@B@BBFDB@DBBBBDBDB@@BFBBBB@@B@@D@B@@@DD@B@B@BBF@@@B@B@B@B@@@BB@@DBB@@@@BBB@F@@@@@BBBD@B@BB@BDBBB@@BB@@BDB@@@B@@@@BBB@B@DB@@BB@BBBB@DBB@@@A@CD@B@BCHAB@B@@EB@BA@ABAD@@ABA@A@@@C@@@@B@B@B@BA@@@ABBB@@@B@@@B@BA@@@@@BD@@@@AB@@CBCBC@A@@@@@@BA@@@A@@BA@ABAB@BBDB@@@@B@@@B@@@BBBBB@BBBDB@B@BB@@@B@B@BA@@BB@BBBB@BBB@B@FA@@@@BB@@@@BBBB@@B@@@B@BAB@BBB@@BBB@BA@@@@@AB@@BB@@@BBB@@BB@@@B@D@@BB@B@B@@@@BB@@F@B@B@BBBB@@B@B@D@B@@@D@B@B@@@B@BBB@B@B@BAB@@A@A@@AA@@BCBAB@BAB@BADA@@BAB@@@DCB@DABAB@D@B@D@B@B@FA@@BA@@DEB@@@DBD@@B@@BBD@B@@B@@@BD@@@BBDAB@HDFDB@H@B@B@FAD@FABADAB@@@B@DBBBBB@BFD@@@B@DBB@B@@@@B@@BD@DDD@BD@@@@@B@@CJAB@B@BBBBD@BDDBB@B@@@DAHCF@DA@B@@B@@BBAB@D@B@@@BBF@@@@D@B@@@BB@BBB@B@@@BB@BB@@B@B@@@BB@D@@AF@B@@@BB@B@D@F@B@B@@@B@@@DB@BD@BBDBBBB@B@@A@@BBBBDBDDB@@@D@B@B@B@@@B@@@B@B@@@B@@@@BDB@@B@@@@@@B@@B@BBFBB@B@B@@@BBB@BB@@BB@@BBB@B@BB@@@@@@A@@@GD@@@B@D@B@B@D@BBBDD@B@@@BBB@D@@A@@BCF@BA@A@@B@@A@EBA@AB@@A@@@ABAB@@@B@BAB@@@B@B@@A@@@@D@@A@@@A@AAC@A@A@E@A@@BA@CJABABCBAB@@@@@B@B@@CAC@AA@@CBC@@@@@@DA@@BC@AB@@@B@BA@@BABA@AB@BA@@@A@@B@@A@ABA@AA@@CFAB@B@@A@ABA@@@AB@B@@@@@B@DA@AD@@AB@DA@@BABA@@AA@A@A@C@A@AAA@@AA@@@AAE@A@A@ABCBABABA@@@@B@@@BC@CAA@ABA@@@CC@@C@AA@@ABA@A@A@@BABABABABAB@BA@@@A@@B@B@@A@@BABA@A@AB@@ABABA@A@A@A@A@@@A@C@@BCD@@ABE@A@E@A@@@A@@@A@ABABA@@BA@A@AAA@@@A@@@@@AB@@@@@@AAA@C@A@ECC@A@AACAC@A@@AAAA@E@AA@@@@@AAA@AA@@@AAAAAA@@CAAACAA@AA@A@A@A@@A@A@ABA@@B@@@D@D@D@@@B@@@@@@EAEA@@ABA@@@A@ACA@@A@@@AB@@C@@@A@@@A@@AA@@@@AA@@@@A@C@A@@@ABAB@@@@BB@@BB@BABAB@@@B@@BF@@@BB@@B@@@@CBABAB@@ABA@@@CA@@A@CAA@@@AA@@A@@BCB@@CACAAAAC@@@AAAAAACAAA@@@A@@@A@@BA@AD@@ABAAA@A@AFA@@BA@EBA@AB@@@B@BBB@B@DB@@@B@BAB@DBDB@B@BAD@B@@@D@@B@@BB@@@DBDBBB@@@@@B@@@@BBB@@B@@@B@B@BDBB@@@@B@B@DAB@B@B@@BB@BDH@DBB@@BB@B@BABABCDABADABABA@ABA@A@CAC@C@A@@BA@A@@B@@A@@B@DABAB@@AB@@DBFBBB@B@DBF@B@@ABAD@BAB@BAB@@A@AB@@AD@BAB@BABAB@@AB@D@D@D@B@B@@@B@BAD@B@B@B@@@BB@B@BBB@@B@@@BAFABA@CD@BA@@B@B@B@@AB@B@B@@@B@B@BAD@@@BBB@@@BAD@BABA@@BA@@@@BAD@@ABCBA@@BA@@@@B@@@B@B@@@DEFA@A@@BCBAB@BABA@@@@@@B@DA@@B@@@BA@CB@BA@@B@@A@AAA@@@AB@B@@@BA@@BABCB@BA@@@A@@@@@AB@@A@@@@@BB@@@@@B@@A@@@A@AB@@@@@BCB@@@B@@BB@@@D@B@@A@AB@BAB@@@@@D@B@@A@@@@BBFB@@B@@@B@@A@@B@@@@BB@@@B@@@@AB@BAFA@@BADA@@B@@@@BD@B@@@B@@@B@@CBEBAB@@A@ABA@@A@BAD@@@@BB@B@BA@@BB@@BB@@DBB@@B@@B@@@B@B@B@@AB@B@B@B@BABAB@B@@AB@@ABA@AB@@AD@BAD@DAD@BADA@ABC@ABC@ABA@C@ABC@@@@D@@@BDB@BB@BBBB@@@B@@@@@BA@A@@B@@@@BF@D@BA@AB@B@@BBD@D@BH@B@BBB@B@@@B@@@@BBBBB@B@FAB@@@DBD@B@@B@@@@DBB@BB@@B@B@@@@B@@@B@D@@BB@D@@@@BBBBFDDDBBBBB@B@B@B@D@@@B@D@BBBBB@@@BD@BBD@BAD@@BB@D@B@BBB@@@B@@B@@BBBDB@B@@B@B@@@@AB@@AB@B@B@B@B@@AB@@@B@@@B@@@DABAD@@@B@BBB@DABA@@BBB@@@D@B@B@B@@@B@@AB@B@BA@@B@B@@@BB@@DB@BB@AB@B@@@@@@DBB@BBD@BA@@B@@@@@@@BB@B@B@BD@BB@@@BBBBB@@@BBB@B@D@B@BB@@@@B@@@B@@@B@@@@@@B@@ABBDD@@AB@B@BBBBBDBFB@B@@@@BBBB@@B@@B@B@@B@@@B@B@@@B@BB@BB@@BDDBBBDB@@BD@@@B@BD@@D@D@@@D@@@B@@AB@BAB@BA@A@@@A@C@A@@@ABAB@B@@@F@B@B@BA@@B@BABB@@B@DAJAD@BA@@DAB@BAB@B@D@HB@@B@DA@@B@AB@DBB@BDB@@BB@BBB@@D@DBB@B@BD@@@@BBB@B@B@F@@@BB@@@BB@@BB@@@@B@@B@BBB@B@B@@BBBBB@@B@@B@B@@@B@HBDB@@B@BB@D@BBD@B@FB@@@BB@BAB@B@BABBBABB@@B@BBB@B@@@@@BD@@B@D@D@D@BBB@DBDB@@@BB@@B@BDBBBB@BBB@FAB@DBFBBBB@BBD@@@BBBBD@B@@@FB@@@@BB@BB@B@B@D@B@B@DB@@B@@BB@@@B@DA@@F@@BB@@@DB@@BB@@BBBBB@DAB@FBFBBBBBD@BBBBDB@@@D@@@@DBB@BBBB@@B@BA@@B@@C@C@A@@@@@@@A@@DABA@@BC@@BA@BB@B@@@@AB@BABAB@@A@@BC@@B@@AB@BBH@B@@A@@BC@A@ABA@CBADABA@@BCB@@A@@D@DCB@B@@@@A@A@@B@@@@BB@BAB@@@@@@A@@B@@@B@B@H@@@BA@@@@@A@CB@@A@@BA@@B@DA@@BABCBA@@B@@@BB@B@BBDB@BBBB@@BD@BB@@@AD@@@@@BD@FBD@@@@@@B@@B@B@@BD@D@@@BB@@DD@@BBF@B@@B@@@@@BLD@@@ABA@A@ABC@ADG@@BABCBAB@B@@BB@BBBD@BBB@@BBBB@@@BA@@BBB@@@@B@BDD@B@@BB@B@DAB@B@@@BABAB@BABBD@@@B@D@B@@A@@@A@@@@@@B@B@DAB@@AB@@@@BDAB@B@DAB@D@B@F@B@@@@@@BBB@@D@DA@@JCHAFAD@DAFCDAD@BB@ABAB@B@DAB@D@B@B@FAB@FAHALAH@FBB@DBB@HBDBFBFBD@BBDDB@@@B@@@B@@ADCBABADCBA@ABADAB@DCDADC@A@@@G@@@@@AA@ECAAA@@AAAACAC@A@AAAA@@@E@AA@@A@AE@@@AGAA@@AA@AAAACAAA@AAA@@EB@@AAA@@@AA@ACAACA@@AAAAA@A@@AA@@CEA@@@@@BAFA@A@@BABCBA@@@ADC@@BC@A@AB@@@@AD@@@B@@AFA@@@BB@DBB@B@B@BAB@B@@BB@@@BAB@B@FCB@@@D@@@@B@@@B@BABAD@@@@B@@@@AB@@A@@B@B@B@B@@@@AB@BADA@@BB@B@H@@@@@@@@BA@A@C@A@A@@@@B@B@D@BBBAL@B@D@@@B@D@@@DAB@BAB@D@B@B@D@B@D@BAB@B@D@B@B@B@D@B@D@D@D@@@BAB@@AD@@@DAB@DA@@BBD@@@B@B@DAB@@AHB@AB@B@@@BAB@@CBA@A@A@@BA@@@@H@B@BAFAD@@A@@BA@A@AB@@AD@DA@ABA@ABABCBA@ABA@@@@@A@AAA@@ACCAAAEACAA@C@@AEAA@C@C@@@C@A@@@@BA@@@A@A@@@AA@AAAAA@@AA@A@@AA@A@A@@@AAA@@@@@A@@@@@CAC@AAA@@@A@A@@@AB@@A@@@A@C@ABA@@BA@@AABCBCBCBABGBCBA@AB@@@@ABA@A@@@@BA@A@@@A@@AAA@@BC@A@@@E@A@A@ABA@A@A@AAAAAA@A@@@A@AACAEAA@C@A@@CAA@@@A@@@@A@E@AA@@@E@C@@@A@A@@A@@BABA@@@@@AAAAAEAC@ACAAA@A@A@@@A@A@A@CACAA@A@@@@AB@@@B@DA@A@@@A@A@@AA@@AB@A@A@ABABAB@@CBA@@DABABA@@BA@C@C@AAA@ABABABABABC@CB@@A@AACBCBABABA@A@@@C@@@ABAB@BA@A@AB@BADCBAHA@@BA@@BAB@@A@AAAEA@A@@AEAACEACA@AC@@AAA@@@@A@@@@B@BA@@BA@@D@FA@@DAF@F@FBB@B@@AB@@@@@@ACCAA@A@@BAFABA@A@C@@A@AA@@@@GACAA@@A@AC@A@A@A@AACAAAAC@@@A@@BAAAAA@@C@C@CAA@A@ABA@ABAFAB@BCBEBADA@CBA@A@CAA@A@A@CBA@C@C@@@A@@BE@A@C@@@AA@A@@@@AA@@AAA@A@A@CACCCA@@@AB@@A@@@A@A@@BA@A@@AA@AA@@A@AA@ACCAAAAA@AAA@AA@@@@@@A@@BA@EBA@AAA@A@@AAC@@@EAAAA@EA@@CB@@A@AAAA@@A@AAC@A@@A@@A@@C@C@C@C@@A@@@AA@@@A@@A@A@A@AA@A@A@@A@E@@BA@ABAAA@AAA@@@@C@@AACC@@EC@A@A@@AAA@A@@AAAAA@AA@CAAAC@ECA@@@@A@AA@@C@@@A@A@AB@BA@@BC@A@A@@AA@AAA@@@A@@CC@@@A@AAABC@ABA@ADA@@@A@@ACA@BC@A@G@ABAB@@A@@@A@@AA@A@@@@BA@@B@B@@@@@@A@AB@@CA@@A@@@A@@@AA@@AAAA@@A@@@A@@BAAA@@@AA@C@A@A@A@@@@@@@@@BA@AA@@A@ABA@ABADA@@BA@A@@@@AA@@AABE@A@@@A@C@AAA@@AA@@@ABA@AA@@A@@A@A@@@@A@@AAA@AA@@@A@@@@@@BAB@@@B@@@BA@@BA@@@A@A@@BA@@DAB@BA@@B@@A@@@@@E@AAAAAAA@@@A@A@@@ABABADABA@@BA@A@A@@BABA@@@@CAA@A@AAAAAAAA@@@A@AB@@A@A@@BA@ADABA@A@C@ABAB@BAB@BA@@@ABC@@BA@@@@B@B@@@B@B@B@BBB@FB@@D@@@BB@@B@DAD@B@@@BA@@B@HAD@B@B@D@D@BBDBB@FBBBDDB@B@BB@@B@@@B@DB@@B@B@BA@BD@BBB@@AB@@A@C@C@@BA@@AA@AAA@ACC@@@@@A@@B@BA@@B@B@@@B@@@@@BC@@@@@A@@B@@@B@@@@ACCA@CA@AA@A@AA@@AAA@C@@AA@@CA@BA@@FC@ABA@A@@BA@A@@@@AAAAA@@@C@@@AA@@@AAA@@A@AAA@AAA@A@AAA@A@A@A@@A@@@A@@@@@CAAAA@A@@AAB@@@BAB@BA@AB@@AFADCB@@A@@@A@@@@CA@@@C@A@A@CAA@A@C@@AAAC@A@@@@BA@@@A@AAC@C@A@@@AB@@@@A@@@@AA@A@AAA@@AAEC@@AAA@@AA@A@@@A@@@AA@@A@@@A@@@BA@@@AA@AAA@@A@@BAB@AA@@@@@@@A@A@C@A@@BC@@AAAAA@A@@@AAC@@@CA@@A@C@A@@@A@A@A@A@@@A@@@AAA@@BA@@A@A@A@CBC@@@@AAA@@@C@@AGCCAAAA@ACAAAEA@@@A@G@CAA@ABA@A@EB@@C@@@CBADEB@@GCA@C@C@@@CAA@AA@@A@@B@@@B@D@BBB@B@BAB@D@B@B@D@B@F@@A@@BA@@D@@@BC@@BA@A@AAA@E@A@A@EAC@AAAAAAAA@@EA@AE@@@A@A@@AA@@A@@@@EA@@A@@A@AA@ACAA@@BA@@AA@AA@@AA@ACAAA@AAA@AA@@C@AA@@AA@@@A@AAA@@AA@@@A@AB@B@@@EG@@@A@@AAAAAA@@@AAA@C@@@C@CBC@A@@AA@A@AAA@@BAFMAEAACE@@A@@@A@CAAAA@A@@@@@ABAB@D@@@@@@G@A@A@A@@@A@A@@@A@A@A@AAA@A@EBC@AAA@A@CAA@A@@@ABA@ABCBCBA@@@A@AA@@AABADC@ABAAEAAAACCEC@@AA@@@@B@BA@@BA@@DA"],encodeOffsets:[[114767,34444]]}},{type:"Feature",id:"410327",properties:{name:"宜阳县",cp:[112.179238,34.514644],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@BA@@@BBDFAB@@@@@DHBD@@BBB@BB@@BB@@@@F@B@@@BBB@@@DADAB@CB@BBBC@@BBH@B@@@BA@C@EBAB@BA@BBBF@B@@@@AB@BA@@B@@@DA@@BA@BBDA@BA@BDC@AA@@@@BDD@B@@BD@@BF@@@B@@B@@@BGB@BED@@AA@@A@@D@@AB@@F@@@KB@BNCBDD@@BBA@DCDBB@@@B@BD@@D@@@@BB@EB@@BLB@AC@@A@@FB@AB@@@@@@@D@DAB@B@B@FF@B@@A@CB@B@@B@@@B@BCDAB@@@@A@ABA@@B@BADAD@FABA@@D@DADABADAFABADC@@BABADCD@BA@A@@B@B@BBFB@@BB@BB@B@B@H@D@D@BBBAB@B@@@BAB@BADADC@@B@D@@@DBDB@@BBBA@@BA@@B@@A@A@A@A@A@G@@@ABAB@@A@@@A@A@@FCBADC@@@@AA@CAA@AA@EC@@AA@@AA@@@C@A@A@@@CBA@@B@BA@@@A@@@@BA@ABA@A@AB@@@B@B@DBB@BBBBBB@@BB@@A@@BA@E@C@ABA@@@@B@@@@@B@B@@@BB@B@D@B@@@@B@@@@ABB@@@@BBAB@F@BAB@@@@ABC@@@A@@@@DABADAB@FBHBD@@@DE@@B@HDB@@@B@@ADA@CBA@A@@BABA@A@ABCBABCFGDEBCBABAJEDABABABA@A@@@@@C@@BABA@@BA@@B@B@BBD@@@FDD@BB@@JAB@BBB@BBB@@@@BBB@@AB@@@BA@A@@@ABAB@D@B@B@B@BBBB@@@DBD@DBB@@@D@B@B@@@BA@@@@BA@BB@B@@@@@BA@A@@@@@@BB@@BA@@@@B@FF@@DB@BB@B@FBDAF@D@B@DB@@DDB@@@BAD@@@@@B@BB@@@B@B@@ABAB@@CBCB@B@@@@@@B@D@@@H@FAB@B@B@BBFAB@B@H@D@B@D@DAB@B@FAB@D@HABAB@BB@@B@FBD@D@B@@@FCF@@@B@BBB@B@B@FAB@DAB@@B@@B@@BB@BBD@D@@@@A@@CA@@@G@@@@AAA@A@A@A@A@@A@@A@BA@ABCBA@A@A@@@CBAFE@A@A@C@CA@AAAAAA@A@A@A@@@@@C@C@A@A@AA@@A@C@AA@ACCC@@@AAA@A@@@C@E@ABE@@@E@A@A@@@A@@@ABA@@@@BCB@BABAB@@C@CDB@AB@DG@CDE@AB@@@D@F@D@BBB@DAB@BAB@@@BBB@BDBBBBFL@B@@B@DBHDB@BBD@FD@@BBFBB@DDB@B@D@BABBB@@EBC@CAABCJ@@KL@B@@@BQLB@GFG@EBCBKC@I@E@A@ABC@A@C@@@EB@@A@AA@@AACB@@C@A@CBA@A@@@A@@A@ABA@@@@A@@@ABA@@@A@@@CA@AA@EBC@CBA@AAAB@@@AAAAA@AAA@AAAC@@AA@A@ABA@C@A@C@AAEECAECAAA@@@AA@@@@BE@C@C@AB@@A@@BA@A@A@@BA@A@@BA@A@E@@BAB@JA@@BABA@@BE@A@@BC@C@ABA@CAABA@@@@BGBABC@@@A@@AAAAA@CAEAA@CAA@@BA@GDAB@@A@@A@@A@@A@@@AB@DA@A@A@@@CBC@@@@@CAAA@@AA@C@@@@@CAAAA@A@CCCCECCACAC@AAC@A@IAA@ABC@A@AACACAAAA@AAACEE@AAACEA@@@CBC@@@AAAAAA@A@@@ABA@ABAJCFAB@B@HAHCDAFABABA@ABABA@C@CBGBC@@@AB@AA@A@@@AAA@AACA@AAA@@@@@@A@A@A@@AAA@AAAA@@AC@CAAA@@AA@C@A@A@A@A@AAA@AAA@AA@@CAC@A@A@EBEDA@@@C@A@A@@ACACCAA@A@C@A@A@C@ABA@@@AB@D@BA@@@@@@@AA@@C@AAAAAAA@E@AAA@CAA@A@A@A@@@@BAHCBA@@B@@@@@@AA@GAC@A@AA@A@@@A@ACE@@AG@A@@BA@CBC@@@AAA@ABC@A@E@AAAC@CBEDCBC@EBGBID@@CBC@@@AA@A@@@@A@E@A@C@A@CBA@A@CB@A@@A@@BA@CBA@A@@@@@@@@B@@@BA@C@A@@@C@AAABA@ABAB@@A@A@CBA@A@@AA@C@ACA@@@@@AA@AB@@A@@AAAA@@AA@AACAAA@@AA@A@ABADAB@@CH@BAD@B@BAB@B@@KC@A@@@@@AA@E@AA@@CC@@AA@@C@C@@AA@A@@@@A@@@@C@EAC@@A@@@@BC@@A@@AAC@@AAAAA@AC@A@AAA@@A@@@ABADAB@@CBA@@@AB@@@BA@@D@B@@@@AB@@G@A@A@@@A@@@@B@@@@A@ABA@@A@@A@@@@B@B@@A@A@CDC@@@@BA@AD@@ABCBAB@DAB@B@BAD@@@BA@G@AAA@@BA@@@AD@@@BA@ABABA@@B@@A@A@@AAB@@AD@@ABCB@@@B@@@@@@@B@D@DA@@@ABA@@@AAAAA@CA@@@@@C@@CAAAAAC@AAAAEAEAA@CBA@AAAA@@AA@@CA@@A@@AE@@@CBA@@@A@@AA@@@CAA@A@C@A@A@A@@AAA@@@@EA@@A@C@AAAA@@C@AAA@AAEACAA@EBA@AAA@AACA@A@AA@@A@@CACAA@AAC@C@C@A@@@AC@@@@A@A@AAA@@@AAABAAABA@A@ABA@@A@@EAA@C@AAC@A@@A@AA@AC@G@A@@@A@AA@@@AAAA@AA@A@A@AAA@@@@A@@A@@AA@@A@@AA@@E@A@A@A@AA@@@@ACA@A@CAC@@@AA@AAA@@CA@AAA@CBAA@@@CBA@@@GAC@A@A@ABA@CB@@ABC@IBCBA@@@AAABA@@@ABA@A@E@@@A@A@AB@B@@@B@D@B@@@BABA@ABA@@BA@@@C@@@C@C@@@ACA@@@C@@AA@ACAACC@AA@@AAAA@@@A@A@@@A@@@@A@AA@@@AAAA@@@@@AEACAAAAA@A@ACBA@C@A@@BC@C@C@A@ABA@@@A@A@@@AB@@A@@@A@AB@@A@@@AB@@AB@BAB@B@B@@A@ABAB@@@BAD@@@BBBBD@@BB@@B@BBFHFD@@BD@BBDB@BBBB@BBDDB@DBBBF@@BBB@@@@B@BB@@@@B@@@@@B@@@B@@AB@@C@A@@@@B@B@B@@@BA@A@@B@B@B@BA@@BA@EAC@@ACA@@AAA@@@AB@@@@AB@BBFABAD@@@D@F@@@BBB@@@B@BA@@@CBCBA@@B@@AB@D@@ABC@@@A@A@@@CBA@@@@B@@@BA@ABA@ABCBC@@@@@ABEDCBEDA@A@A@CB@BAFABA@BBB@@@D@@BB@D@B@@@@B@B@@@@@BC@@BA@@BA@A@EAA@@@ABABC@A@@@A@AB@@@@@B@BBB@BBN@DBB@@BBBB@@BBBB@BB@@BB@FDB@BDBBDDBBBBDBBBBBB@BB@BB@B@BB@BBBBBDBBB@DBB@B@B@@BB@@B@@B@@CBABA@ABA@E@ABA@CB@@A@EA@@CBA@@@@BAB@@E@@@A@AA@@A@A@CB@BC@A@@@AB@B@@@F@F@B@B@B@@@D@B@@@DBB@BB@BBFBD@B@@@B@B@B@DBFBB@B@B@@@BBDB@B@@@B@@@@CBA@ED@BA@@D@BA@@B@B@@BD@B@B@BAF@BB@@B@@DB@@DBBBDBDBFFDB@BBB@B@D@@ABCBA@A@A@CAA@A@A@@BAB@@@BBD@B@@BB@BBD@B@BAB@@A@A@AB@B@D@B@B@@B@@DB@@BB@D@B@D@B@@@B@B@@@BBB@DB@BB@@B@@@BABA@ABABCBA@C@@@A@@B@@BB@B@@A@@B@@BBDBB@@BB@@B@B@B@@B@BBBBBB@B@B@@@B@BA@@D@B@F@B@@B@HBDBBBB@FBBBDBHBDBB@@@B@@B@B@@@BB@JCD@B@B@@@@@BBBBB@@B@D@B@@@BA@@BBDBD@B@DAB@B@@BBFH@BB@BB@@D@@@FBB@@B@@@B@@@BABCH@FAFABBB@DBH@BADADABABA@ABAB@BABA@A@@@A@@B@@@@C@AB@@@B@@@@BBB@BB@@BB@BAB@DAB@BA@EBEDGFCB@B@BAB@@ADA@@BB@AB@BBBFDB@@@@@DAJCBA@A@@B@@@BDB@B@CD@@FCDEB@@DB@BAF@@@@@@@CDA@AB@B@@@BCB@B@@DDBB@@@B@@@@E@@B@@DDBF@BB@@@B@D@B@DB@BB@@D@@@@FDB@B@@AFGDAB@D@B@B@B@@@@B@@@BA@A@@@BB@@B@B@D@B@B@BAFC@@FAB@B@B@BAB@@@@C@@@A@@B@@@DA@@@ABABE@AHGBAB@BC@ABADCBABABABABABA@AB@@AB@@@DABAB@B@@@@A@@B@@@@BBA@@B@@BB@@B@@BBB@BB@@B@B@@@B@@@@B@@AB@@@B@@B@D@B@@BB@@@D@@@AA@A@@@@@@B@B@BB@@B@BA@@A@@A@A@@BACCLC@A@@B@B@DCDC@A@@B@FE@@A@A@EB@@AAB@B@DA@@BA@@BAB@F@@@DCBA@@@@@BA@@B@BABA@@B@@D@@@BBB@BB@@BB@D@D@BBDBF@@HAHHDB@@ABA@@B@@B@@@DA@@@@@BABABABCD@@@@BBDF@H@DB@@@LA@@@BB@DAD@D@"],encodeOffsets:[[114646,35470]]}},{type:"Feature",id:"410329",properties:{name:"伊川县",cp:[112.425676,34.421323],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@A@@@@@BCBA@A@A@@@A@@C@@@A@EDA@A@AB@@A@@AA@A@ABA@A@A@CAA@@A@@EB@BA@@@C@@B@@A@@B@BAD@@CD@B@@ABADAB@@@BEBAB@@@@B@DF@@BB@@@BBBBB@BB@BDDB@BBB@@B@BB@@FA@@BB@BBBDBBBBBB@@BB@HB@B@@BFB@@@BBF@@@B@BB@B@BBDBDBB@BB@BBFDB@@B@@@@@H@@@BCDCBCDA@CBAB@BABCDABABCD@BA@@@A@@@A@CCAAC@EAEACAGAA@CAA@EAG@KBGBEBA@EBA@A@C@A@CBA@A@AB@B@B@F@BAD@BBB@B@@AD@DAB@@@BBH@@DF@B@B@@@BBBB@D@HBB@@B@@@@A@@@ABGDAB@@@@@B@B@BBB@DBB@B@FBBBBBB@B@DB@@B@@@@@@ABC@A@@B@@AB@B@D@B@B@D@BBBDDDB@BB@B@D@@@B@FCFAB@B@D@DB@@BBB@BBB@BBB@B@B@B@D@B@@BB@BB@DBD@@BBBBB@BB@@@B@B@B@@@@B@BBB@BD@BBB@B@@@BBBA@@B@@ADAH@D@DABAB@BABABEBCBGDGBA@A@EBIDAB@BAB@B@@@BBBBBBB@@D@DA@@B@DFBB@BFFBDBBB@BBDBDBBBB@D@BAB@JBB@D@BBD@DBDBFDDDDDB@B@BBDB@@@@D@B@@BB@BB@D@@@@AD@D@@@B@BCBA@@B@@@BB@@@@BB@@@BAHCB@@AB@DBB@FBDBB@BBBB@@@B@@ADABAH@@@@ABBB@DAB@B@DAD@@@BAF@@ABAB@@IBA@AB@@@F@BAB@@@BAB@@@B@BAB@@@BA@@B@D@DAF@@@@BB@@B@BBFDDBFFBBD@B@D@B@BAB@B@@BD@BB@BBB@BBBBB@B@@BABBB@DAD@FAB@@BDB@@B@@@B@BA@@B@@@@@AB@B@BB@@@B@B@DAB@D@@@DABB@@BBB@@@FA@@D@B@D@BAB@F@J@D@@@@@D@DAHCBAFABAHC@@BAB@BB@@B@@@BA@@BAB@B@@@B@@@B@@D@@@D@B@@AB@B@@BB@AB@FCB@B@B@B@B@FBD@B@B@DBBBDB@@@BBD@F@H@@BBBBDDB@BDB@BBFBDBDBD@DBB@B@H@B@B@F@D@B@B@BBHBB@HKPIJCHA@AJCBFRE@@EG@@FIDBD@BCB@AFD@BDHA@@DCD@@FH@ABBBLEHBBCRL@@@AAA@@@A@@FC@ABCB@BA@A@@B@D@FBD@D@FAB@B@@@D@D@B@B@@@BAB@B@F@B@BABABA@@@@@@@BB@BBDBB@D@BAB@D@LB@@B@B@FBDBB@@@F@BBB@B@BBB@B@B@D@BA@@@@@@@A@A@@@AA@@@BA@@B@B@BAB@B@DAD@@@BABAB@B@BABBD@DAB@@@H@B@F@B@@AB@BAD@HA@@FBB@B@@@D@FAB@@ADA@AD@@@FEDABA@@BC@@@A@AB@@@B@@@B@@B@@DB@@B@B@BA@@BA@@@A@A@@@A@@@AB@@A@A@C@A@@BAD@BA@@BA@@@AF@B@@BDBFBDB@@B@D@B@B@@@DB@BB@D@BBDBFBD@D@BBB@@@D@@@D@B@@@BB@@@@BCDAB@@A@BF@D@B@B@@@BA@@B@@@BCBA@@@@BAH@HAB@@@B@DB@@D@@@B@@B@@BBB@BBB@B@@@BBB@BBACB@@@@@B@@@BA@A@CBA@A@C@A@A@@AC@@@CBC@ADA@@BABA@@@A@AAA@A@AAA@@BA@AAA@@BA@@DBB@@@@C@@@@AAEB@AAEAA@@@A@ABCBABC@@AI@C@AAIAAACAE@AAA@@@EAAEB@GA@ACE@@@AC@A@A@C@A@AB@@AB@@C@A@@@@DAB@DAB@A@@A@@AC@A@@@AA@AA@@@ABCBAAA@AAAAACAGAA@@@AA@AA@@@@A@A@AAC@@@ABA@@FAD@DAB@BA@A@C@E@@AE@A@C@A@AAEA@BA@@DCBA@I@AB@@@LDDBJBHBF@JBB@@@@ABE@C@C@A@@@@FCDAB@@@BBDBB@@@BAFAD@BAA@AAA@AA@@CA@@A@ACAAAA@@@A@A@@AACAA@@@AAA@A@@AC@@@C@E@@@CDA@A@A@GAC@AA@@@C@A@@@A@AAAAAAAA@A@AB@BABABAB@BABE@C@CBC@CBIBA@IBI@A@A@AAAAC@A@AAAAA@CA@@AA@A@@AAAAC@A@CAA@@A@AAA@@A@A@@B@BEDABE@C@QC@AAAEC@@AAA@@@ABCD@@@B@BBB@@DBDBGBBB@BBBAD@B@BADADA@@BCBA@@BC@EBEBA@C@@@CA@AC@A@CA@@CA@AC@AAAAEC@@ABC@@@AA@AAAICC@CCECGC@@@@@@CBCD@@ABA@A@ABABABABCDED@@EDA@EDA@@B@@@F@@ABA@AB@@ABA@@@A@CCA@@@G@G@GBABC@ABA@@@@B@@AD@B@B@@@B@@@DAB@@E@EBA@@@A@@AA@@AAAAIA@@A@@A@CBG@A@E@E@CBG@CBA@C@A@@@EAGAAAA@C@AA@@@@@AAACC@@@A@A@A@@@A@ABAFCBA@@@AB@@AA@@C@AAAA@@@ABA@ABABCBA@@@A@AAEBA@A@A@C@ABGBABA@A@CAGA@AA@AA@A@AAA@ACAAA@A@@@@A@A@A@ABCACBA@C@CBA@AB@@@@A@EFCDADABCDABA@ABCBA@@@E@AAC@CACAEAA@@@A@@@AB@@AH@@C@E@C@AAA@@AA@AAAEAACEA@AAAC@AAAAA@@@A@A@@AA@@@@AEAC@AA@EEAAAC@@@C@A@@BCFIBABADCBADCBA@@@@FC@@@ABAB@@@@C@@@AB@@A@C@A@AB@@AFABAB@@A@A@AAC@AAA@@@A@CBA@A@@AAAA@A@@@@BA@ABA@A@A@A@@@A@CAGAC@A@AAAEG@@@A@@BC@ABCB@@@BAF@@AB@@EBABABAB@DA@@BC@C@@@ACEA@@@C@@@C@ABAAA@@@@@CBC@C@C@A@@@CAAAAAE@@AA@@@EBABA@A@@@AAAA@A@@@A@@@@@A@@A@C@@BCBA@@@A@@@A@@@A@ABC@@@@@A@AB@@C@@@A@@@@A@A@@@A@A@A@A@@A@AA@@C@@BA@@B@BAB@@@BC@EBABA@G@@@@@AB@@@B@BAB@DA@AB@@A@A@@BGA@BA@CBA@A@@@C@AA@@CBA@CB@@C@@BA@AB@@C@C@C@A@C@A@A@A@C@A@A@ABC@A@C@A@A@C@A@ABA@CB@@C@A@@@C@A@K@ABAAC@A@A@@@@@@B@B@D@BAB@@@@@@@@@G@AAA@@CBABA@@B@@A@A@A@A@@@@BA@"],encodeOffsets:[[114966,35061]]}},{type:"Feature",id:"410328",properties:{name:"洛宁县",cp:[111.653111,34.389197],childNum:1},geometry:{type:"Polygon",coordinates:["@@EA@@A@C@@@ABAB@@@@A@@@EAA@A@@@C@ABA@@AA@CAA@CBA@CA@@@@@A@@@ABA@A@A@A@AACBABA@AAA@@BC@AA@@@A@@@AA@@@AA@CCAAAAMCCAA@EA@AA@AAA@@@A@CBCB@@CAA@A@@ACCAAKCC@A@@AA@@AA@@AA@@@A@EAA@@@CB@@A@A@CAA@@@ABA@A@CAA@ECA@E@AAA@B@@B@FAB@BA@EDABA@@B@B@BAB@BA@@B@B@B@BE@I@ABA@A@AAA@C@AB@@CBAA@@ADADEDA@A@@@A@A@A@CBA@AAABA@AAA@C@@ACBCB@@A@A@AAA@A@@@AB@BA@AB@B@DCD@B@B@D@B@B@BBBBB@B@@@BAB@B@B@B@@AB@@ABA@AB@BA@ABC@ABA@AB@@C@E@EDA@@@A@@A@@ACACAACACCAA@@A@AF@@@DBD@BA@@B@D@@@@ABA@AAA@@@AB@@A@C@@A@@@@@C@@AA@ACC@@@@A@ABABEDABEB@B@@@B@B@@ADAD@@@@A@@@@@A@@BA@@BA@@B@D@@@B@@CBC@@@ABGAABA@AB@@AF@BA@@@A@@B@@AB@@A@@@@B@B@@@@@@@B@@@@AB@B@D@@@@CBA@@BAB@@AACAAAE@A@A@A@A@@BA@@@C@AB@AA@ACA@@@@@AD@@@B@B@BABAD@@@@@B@@AB@@@B@BB@@@@@@B@@A@@@@B@@@BBD@B@DA@@B@@@BCB@@AB@BC@ABA@E@ABCBA@AB@BA@@@AB@BA@A@@@CBA@A@@@A@@BC@@@@BA@@@A@C@CBA@A@ABA@@@@BA@A@@@@B@BAB@@@BA@ABA@A@C@@BA@@BABEB@B@@ABABAB@@A@@BB@@@DBD@L@D@BBB@BAD@B@D@B@@@BBBD@@@@@B@@@BB@BB@B@D@B@@@@@B@@DD@BBDBD@BBD@BAB@DA@CB@BA@A@@B@@ABDD@@@@@B@@@@A@A@@@@@@B@@B@BBA@AB@B@B@B@@BBBBB@@A@B@@@B@@@B@@@@@BC@@@@@AB@B@BBB@B@BBAB@BBDAB@B@AB@B@BABA@A@A@@@AB@@AB@@B@@B@@ABABABBB@B@B@@@B@D@B@@@@B@BAB@@AB@B@B@@@@@B@B@@BBBB@@D@@@D@@B@B@BAB@FB@BDBBB@@A@@B@@@@@B@@A@CBAB@@ABA@@@@B@@A@@@@@A@@@@@@BAB@B@D@@@B@@@@AB@@@BAB@B@B@@@B@@@BAB@B@@@@@B@@AB@@AB@BADEDCDCD@BC@GBABA@@B@@AD@@AF@@@@@BA@@AAAA@@@A@AB@@AB@B@F@H@@@DABABA@A@A@A@A@@BC@A@A@A@A@AD@@A@@@A@AAA@@@ABA@AAA@@A@BABAD@F@@@BCB@B@@@BB@BBBBB@@BBB@B@BAB@BAD@@BBFB@@BB@B@B@@@B@@@B@B@F@D@@@B@B@BD@B@BB@@@@@@@BBBBB@@DB@BBB@@BBB@B@D@DA@@B@B@B@BAB@D@B@B@B@@@@@BBBBBBBA@@B@B@@BFBBBB@B@D@BBB@@AJ@D@@@@@@@@B@D@B@BCB@@@B@@@@@BA@AB@B@@@BA@AB@B@B@BB@@@BB@@BBB@@DBBB@@@@@F@B@B@B@B@@@BBB@@BBBB@@@BDBD@@@@D@D@BBBB@@BB@@DB@@@@@@@B@B@@B@@@@B@BCJ@B@BBBBBBB@B@B@BBBABBB@B@D@@B@@@@BA@CBAB@@FL@@FAD@B@BBB@BBB@@@B@@@DABABABAB@HB@@B@@@@@@A@AB@@@@ABA@@F@BADAB@@@B@@@BB@@B@B@DA@@B@@@B@B@BAB@B@B@@ABABA@@@@D@@AD@@@B@D@DBB@B@FA@@B@AB@@@@BB@@@BAB@B@B@@@D@B@B@@ADA@B@@B@B@@@BAB@@@B@@B@@B@B@@@@A@AD@@@B@@@B@BBB@@BB@@D@@BDDBBB@@@@@DB@@@@BBBD@@@B@@@@@BB@BBDBBBDB@@BB@@BB@@B@@@B@B@BB@@B@B@B@B@B@@A@A@A@A@@@A@A@@BC@@@A@A@A@A@A@@@@BA@@B@B@FA@@DA@@@@B@B@@@DAB@H@B@BAB@B@@@BAB@@BB@@@B@@@DB@BD@B@B@D@@@BAB@B@@@D@D@@@D@@@D@B@DDB@BBB@B@@@@@BB@BB@JFB@FBB@F@D@@@B@@@CA@@@A@C@A@CBA@AB@B@@@BBB@F@@@B@B@D@BBF@DBD@D@DB@@BB@D@@@BB@@BBB@@DBD@B@DBD@@@@BB@BBB@@@@@BB@@@@BAB@B@BBBB@BD@B@B@DBF@@@B@@BBBB@DBBBBB@@B@B@BBD@@@B@@BBB@@DB@@@@BDB@BBB@D@B@B@DBB@BB@@FBBB@DDB@@BB@BBB@@@D@BB@B@B@BA@@B@BBBBB@B@FBB@B@DBB@BB@@BB@@BB@BB@@@B@B@B@B@B@@@BB@D@@BBDBB@BBBBDD@@@@AD@@@@@BB@@BB@B@B@BB@@BBB@@@@@@@@BB@@BB@@@@BBBB@DB@@@B@BB@@B@D@@B@@@A@BBB@@@@@@B@@@B@@@B@@B@@@DAD@D@D@@@B@@A@A@A@ABA@@@@@@B@@B@@B@@@B@D@@@B@@@BBB@B@B@@BB@@BBB@@BBB@@AB@@A@CDA@D@@B@DAJABAB@D@B@@@@@@B@BBBBDBB@@B@BB@@BD@BDBBB@@B@B@@@BBB@@@@B@@ABB@@BHB@AB@@@@@@@B@AB@B@@@BB@@@B@@@B@@@AB@@@@@BA@@@AB@B@BB@@@@B@@D@DA@@A@@@DC@@BCBB@@B@BAB@B@@A@A@AB@BB@A@@@ABA@@B@AABAB@@ADB@AFA@@@@B@@@@@B@@@@A@@@AB@@BB@@@@AB@@@B@BADB@B@BA@EBEDA@BBA@CB@@@@B@@@BBA@AF@@BBBBC@BB@@BB@@@B@D@@@@B@D@DC@@BBBAFBF@BBBAB@@AB@D@@@B@D@BA@@@A@ABCFEB@@@AAB@@AB@DADAB@B@B@B@@@@A@@@CL@@BBAB@BBBB@@BA@@H@@ADA@A@@B@B@B@BA@@@@DAJAJCD@B@BA@@@@@A@A@AB@FABAB@H@DAFC@@B@@@BA@AB@B@D@@A@AA@@@@ABAB@@B@@@BB@@ADCBAB@@AB@B@DBB@BBB@B@D@B@B@@B@B@@@BB@@@ADABABABAB@B@B@@C@@BBBA@@BGFEDD@BADA@@B@@B@@@B@@C@EBCB@B@@@B@@CB@@@@@B@@EDABAB@@B@B@@DBFEDABCB@BA@AAAD@BBB@B@@DBBBBAB@@@CBBBBAAAHEL@B@B@@@BBBBB@@AFBBA@@B@@BH@@@@BB@@BBADD@@@B@B@@FB@@FF@BDBB@EBA@@DD@A@@@BBAB@@BBDABDBD@ADF@@BABAC@@@BA@@F@FA@@CB@@@@D@@@A@CB@@@@JA@AD@@@@B@@FA@B@@A@@@B@@@@BC@@@C@C@@@@@@@B@@@@B@@@@B@B@@B@@@@A@@B@BB@BB@@@B@@DA@@BCBBA@ABB@@B@BB@@A@A@@BBBDA@@@@@B@@@B@D@BB@@A@@BC@@@@@D@@D@@B@@A@@@AB@@B@@AB@@@@@B@@B@@@DADCB@D@@@B@B@@@@@@@DAB@@@AAGAAB@@@A@@AA@@B@FEBBB@AC@@B@@ABB@BB@BA@@@ACCB@@@BB@BD@D@@@@BB@@@BAFABBB@@@BABAB@@@BBB@@@@BABCFAH@D@B@BBDBBDFBB@@B@D@B@BCBADAD@JAB@B@@B@F@BB@@@DDBDDD@@EDAB@@@B@@B@B@@@@@ABA@AB@@@BB@B@D@B@@@ABABA@@B@@@@B@B@BAB@@@BB@@BB@B@B@B@@F@F@D@D@B@BC@@BA@A@ADAHEFCFAB@@ABA@CBA@AAA@@AAA@AA@@@@@A@@BAD@@@@@@AB@@@B@B@BA@ABABAB@BABABCBC@AAG@CAABABE@EDGBA@A@@@A@@@AA@EA@@C@@@AAA@@AEGAA@@@ABA@C@AACAC@AB@@A@@@A@C@AA@AAAA@@@@A@A@C@IDA@@A@@@A@AA@@@A@CAGACAAAEAA@AACAGAA@@@@A@E@A@CB@@A@A@@@A@AAAAAAAA@@@@A@A@AA@@AA@CAAA@@@AB@@@@AAA@@@AB@@@D@B@DABABAB@BA@A@@@AA@@ACAA@AA@@A@A@@@A@C@A@C@A@@AA@@CA@@@@A@A@C@ABAB@B@@@BA@A@AAC@AAA@@@AAC@A@@BA@AB@B@B@DBB@B@B@DABA@@@C@AAA@ACAEECACAAACA@@CA@@@AA@@ABE@A@A@AAC@@@A@AB@@A@CB@@AFCB@DA@@@@@A@@@ACAAA@@A@A@A@EACAA@A@A@@@A@C@EAAAA@@AAA@C@@@A@C@@@A@A@A@E@E@@@ABA@@B@D@@ADAB@B@@@BBB@@@F@@@BA@A@@B@DA@@FBB@@@DAB@BAF@B@BAB@BADA@@@AA@@@AA@@@A@AAA@CAACAAAAA@AAAA@A@@AAAA@AAAACAAAAACCAAACA@ECA@@AA@@AAAAA@@AAAA@@AA@CAM@AAA@A@A@@@@BAB@@@B@D@BABA@@B@FBB@B@@AB@@AD@@A@@@@@A@A@@A@C@A@@AC@@@A@AAB@BABE@ADAB@B@B@FCDAFCBA@@@@D@DABAB@BAB@@A@@@A@@B@DA@@B@B@@@D@BA@@@CBA@@@AB@DADA@@B@@A@A@@AA@A@@@E@C@@BCBAAE@ABA@@@@BA@@B@BB@@DB@BD@FBB@@AB@@A@A@A@AB@B@@A@@@A@A@A@@B@D@@@BA@@@A@@@A@@@@@A@@A@@A@A@@A@AA@@AEAA@CCAAC@AAAAAA@AC@AAC@@ECEGAAA@@@AA@@ACAA@A@@BC@A@@BABAB@@@@A@ABA@ABA@@BA@@B@@@BAB@@@B@@@BA@@B@B@@@B@BAB@D@D@D@@AB@D@B@DABA@@CCAA@BA@@@@@@@@A@@@A@@@A@@A@@A@A@C@AAA@A@@AAAA@A@@AAC@@A@A@AAA@@@@@@A@@@ABC@AAA@CA@@@@@@@ABAA@@ACA@@AA@@A@A@@@ABA@A@@BA@@@A@A@A@C@@@A@AA@@ABCBA@AAA@@@C@ABCB@@A@@@A@@@A@@BA@A@A@A@A@@BA@@B@@A@A@@@@ACAAA@AA@@@@A@@AA@A@A@CAA@@BC@AAC@AAC@@A@AAAAC@A@@@C@A@A@A@A@AAAACCECAAAA@@@@@CAA@@@C@A@@@A@@A@A@@@AAA@CA@@@@@AA@C@CA@@A@EBA@A@AAAA@@@@@A@@@AAA@A@AAGC@C@AA@@@ABAB@@A@CAE@@@@@AB@B@@A@@@@@A@@AAAAA@@ACA@A@@@CEAA@A@A@AAAACCAAAA@@@AAA@A@A@@@AC@@@A@E@@@AA@@A@@@AAA@A@@@ABA@@@A@@@AB@@A@@C@C@@AAA@@A@ACAAAAA@@AAC@A@@A@A@@A@AAAAA@@BA@A@CA@@@A@AAA@A@@@@CA@@AAAA@@A@@AA@AA@AA@@AA@AA@@@@@A@@@@I@@@AB@BA@C@ABC@CBABA@@@C@ABA@ABA@AB@@A@A@@@A@G@CAA@@@AACBA@CACA@@A@AA@@@@A@AB@@A@AA@@A@CBABA@AB@BA@A@@@ABA@@BA@AD@@A@CB@@A@@BA@@@@B@@@@@DAB@@@BA@@@A@@@@@AAA@@@A@A@@AA@A@@@AAAAAA@A@A@@A@EA@BE@@@@A@AA@@@AAA@A@AA@A@@AA@A@@AAAAAA@@@A@@A@@@AAA@@AAAA@@@@@A@A@@@AAA@@@CAA@A@@AAA@@A@A@@A@CA@@@C@C@C@A@@@EBA@@@A@@ACAA@A@CC@@C@A@A@A@ABC@@BAAAB@@A@AA@@CAA@AA@@A@A@@@ABA@A@A@A@A@@@C@@AAB@@CDABABADA@@@C@C@A@AB@@@@A@A@A@AA@ACA@@C@C@C@A@C@A@A@@@AAAC@AA@A@A@AAEA@@A@"],encodeOffsets:[[114141,34950]]}},{type:"Feature",id:"410381",properties:{name:"偃师市",cp:[112.789534,34.72722],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@@D@D@@@B@BA@@BBBA@@BB@@@@B@B@B@@BB@B@@@B@@@B@@A@@B@@@BBBBB@B@BBFB@@DABCBAF@DCF@PBHBD@B@B@F@@@HA@BD@@@B@@@@@BADG@@B@DB@@@ABG@@@@@@DBB@@A@@BABA@@AAACA@@@A@KAC@E@C@EAA@CA@@ABCBA@CBA@@@CAA@@AAA@@@@CBA@C@@@A@CA@@EB@@@@AAA@A@A@@@AAA@AAA@@A@EA@@CAA@AB@@A@C@A@A@ACA@AAC@@BABE@CBCAA@ACAAA@AAC@A@@@@@A@A@@@@ACAC@AA@@E@C@A@@DCBA@@AAGCEACAAAACA@@ACAAAA@A@@BCBCDAB@@@BA@A@ABCBCBA@A@CAA@A@ABG@A@EAEA@@ABC@CB@@@@@@AA@@CACAA@ABE@@@CBAB@@@@AD@B@@A@E@A@E@K@AAA@@@AAA@GBA@A@A@@@E@C@C@A@A@@@ABAB@@A@A@AACAA@A@CBC@E@ABAAA@@@AB@@BB@BA@AB@BA@ABCBCD@@ABA@EBABKFED@@CDA@EDA@AB@@A@@@AA@@@A@@CCCA@@GAGAC@C@C@@@AAABC@A@A@@@AAA@CCCAA@A@@BABA@C@@@A@@@AAA@AA@@A@A@AAA@AA@@@AA@@@C@@@CAA@@@A@GBG@AB@@@@ABAD@@A@@@AB@@A@A@C@E@@A@BA@CBAD@@@@@@DFAHCHDDEFADCJBFCJ@@EFGFAFBLC@@BGBCDE@AFGC@BAL@BBP@F@@CP@DBFBFBFBBDPR@ADELIBIBA@G@@J@@BBH@D@@DH@BFF@@FBB@D@@@B@BC@@DA@BFC@@D@@@BBDF@@@F@BBB@L@@BBB@@B@@HC@@HE@AB@HG@KB@DA@@@BT@BE@@@AEOB@DC@A@AB@F@@WBC@MBA@@@@HBJD@AJ@BDA@@@BB@@BFBBDB@BBB@@BDBBBD@@@BB@@BBAB@@@DAHHB@BA@@B@PQAE@IAG@CBODG@EAIB@@@B@@@D@H@F@B@BB@D@@BLB@@@@BA@@B@@@BA@@D@@@@BABA@@B@@BBHFFDDFHHB@@BA@ABA@@B@@BB@BA@@B@@BBBBB@@B@@@B@BBB@@@BA@@@@B@B@B@@@@BBD@@@F@FBB@@A@@@A@@D@HABAD@BBB@BBDF@DBD@D@@@D@D@B@B@BAD@B@BAB@B@@ABCBC@CB@@G@@B@DADBB@@@@B@B@@DBD@B@@AF@@AB@@C@@D@B@@BB@BAB@@C@C@A@A@@@@B@@BB@B@@AB@@@@@@@@B@@@B@@AB@@@B@@@@@@AB@@@@BBBD@DB@@DBB@DB@@BBB@B@@@B@H@@@F@BAB@D@BBB@DBB@BBB@FAB@B@D@@@B@@@FAB@HCDABABA@@@@F@F@B@B@BBDDBB@@@@B@@AB@B@B@@A@@BAB@B@B@@@BBB@B@BAH@BAFAB@BAB@@@@AB@BA@AB@BA@ABA@@BAD@@ABC@A@@AACA@@@@@A@@@CB@@@DAB@BAH@D@BAD@B@B@@@@@BCBA@@D@B@BAB@BA@@B@@ABGB@BA@@B@D@B@B@JFFBB@JNFF@B@B@B@B@BBBDB@BB@@B@@B@@@FHDBDDHFB@B@@@AI@C@C@@@C@@@AA@@A@@B@@@@ABC@@AAA@@@@AB@D@@@B@@ABABAB@BAB@BAB@@@BAD@@B@@B@@@@AD@DB@@@ABABABAB@@@@@CA@@@@BAD@B@B@@BB@@@@A@@B@B@B@@@B@BA@@B@@BB@@@A@CD@@@@@@B@D@HAD@@B@@@@KBA@A@@@@@@B@@@@BB@@AB@@B@@@B@D@B@B@@@@BBB@@@@@BA@@@@@@@B@B@@B@@@B@@BB@@B@@@@@@@@@C@@@BB@@@BB@@@@BA@@@B@F@B@@AAA@@@@@@B@B@@@B@@BB@@BBAB@D@@@B@@@AA@@@@E@@@@@@A@@B@B@B@@@AAA@B@@@B@DBBB@A@@@@A@@@@@DA@@@@CA@AAAB@@@HF@@@@B@AB@B@@BB@@C@@B@@@BH@@@BABABAJEBAB@B@@@@AA@@@BAB@B@HB@@@@CB@B@@@DB@@@B@@B@B@B@@HCB@DBD@@@BA@@@@AAAA@@@A@@B@B@BBB@@@B@B@@A@@@C@@B@BAB@DAB@@@@A@@@@A@@@@@@AA@@@ABCBC@@@A@@@DABAB@@@@A@@C@@@@A@@@A@@B@D@B@@B@@@@@@H@B@@@@AAA@@@A@@@@B@DB@@BA@BB@@@@AB@@@@A@@@@@A@@BA@@@@E@@A@@B@@@@A@@@@H@@@@A@@@AA@A@A@@@@@@@@@BABA@@A@@@ABA@@@@@C@GBABA@@@@A@A@@B@D@BAB@@@B@B@DAD@@@@AAA@@A@@@@@@@A@@@@@AA@@@@A@A@@@@B@@@@A@@CAA@@@A@@A@E@C@CA@@@@@@F@BA@@C@@@@A@@B@B@@AB@B@B@BBB@@A@@@@@A@@A@@@BA@@A@G@@@@A@@DADAB@BA@@A@A@@AA@ACC@@A@@@@B@@@@@A@@A@@A@@@@A@@@@@@A@@@C@A@@@@@@A@@@@B@B@@@@@@@@C@@@@B@BBBB@@@@B@J@@@FCFADALEHCJCFAB@BA@@BAB@@@ACEECG@AAA@@C@C@M@C@A@@@CACAACCC@AAEACAA@ACAKIA@@@A@A@@BCB@@@@A@ABA@A@ABABA@CB@@@BADCB@@@BEBEBCBEBCDA@C@A@A@AAA@EA@AMCCCA@CCCA@@A@CACAC@QCA@CACAA@AA@A@@@MAC@BAGB@@BJ@@C@@G@BA@KHBBE@A@AHA@C@AAIAAACA@AAA@@A@@B@B@@@BCB@BA@@@AB@@@@A@@B@@@@@B@@@@A@A@@B@DABA@@B@DB@ABAA@@AAA@@@AFC@@@@@A@@A@ABA@@@@A@@@@B@B@@@AA@@@@A@@@@@@A@@B@@@AE@A@@@@BAD@@A@@@@A@@A@@A@AA@AC@I@@@AB@B@@C@@@C@@AC@A@GA@A@CVA@A@@J@@AD@@BF@@A@ED@@AD@AAJC@@ACB@@CA@AA@@@ADA@C@@SB@@AA@A@A@@@A@@@AEB@@AE@@EOH@@AB@@CF@@A@AB@@@@@D@B@@@BC@@D@BAB@@@BB@DA@@DB@L@B@B@@@B@@AA@AAA@@@@@@AB@@ABA@@B@@@AA@@@ABAB@@@@@BAAAB@@AB@B@@ABA@ABABCB@@AB@D@@@@A@@@AB@@@@@B@@A@@BC@CBMB@FEFA@@B@@ABADABAHEFA@@B@B@B@@@D@B@B@BA@@D@@@BA"],encodeOffsets:[[115616,35375]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("南阳",{type:"FeatureCollection",features:[{type:"Feature",id:"411302",properties:{name:"宛城区",cp:[112.539558,33.003784],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@@A@@C@C@EBA@@@ACAE@AACGFIDA@CB@BCD@BBF@@DVCB@D@@BFA@@BDF@@C@KB@B@BCB@B@BIBA@A@C@C@C@A@@@@BA@E@C@@@@D@@C@@BGBC@@A@@I@A@CC@@GAC@@AAAA@EBCBC@@@A@@B@@BD@@BBAB@@@@IBBB@B@@ABC@E@@@A@@@@DA@@@@@GBG@@D@@A@EBA@@@@@@@BB@B@BAB@DCBA@@DAB@@BD@@AF@B@B@BDBBDBD@BBB@BBBB@DB@@DDBB@BBBBBFBBBJDFBBBDBB@BBB@@D@BDDDD@@@B@B@B@B@@@D@B@B@DA@@@ABAB@@ADA@@BA@A@A@C@A@A@CBA@A@A@ABABABABCB@@AB@@@HBB@@@BBBBBB@B@@@BABADAF@FCF@B@D@DBFB@@BB@B@BA@@DA@@@@@AB@BAB@BABABA@ABC@C@E@A@ABA@@DA@@BA@A@@@@@AB@B@@BD@@BBBBBBFD@BB@BB@@BBBD@B@B@D@B@@BFBB@B@BAD@D@@CDAF@@AB@BBBAB@B@B@@BB@B@@@BBBDD@BBBBBB@DBB@B@BBB@B@F@D@H@B@BBBBD@BB@BBF@B@@@D@BA@@F@B@@@@AB@@A@C@ABGBA@@@A@A@C@A@AB@@ED@@@@BB@@AB@BCBABABAB@BAB@B@@BB@BB@@B@B@@@@BBBD@@DB@@@@@B@DADAB@B@@BB@B@@HF@BBB@@DB@BB@@B@BBD@B@@@B@@@@BB@B@B@BA@@B@@A@@@A@@@A@@@A@ADE@A@A@C@ABA@ABEB@@@B@BA@@BABABAB@BEFAB@@@B@@@F@@CFAB@@@BAHAB@BAB@@BBBB@@@B@@@BBB@@@DBDDBBB@BF@B@BAB@@@BBB@B@@BB@B@B@BBBBBBBBB@DDBBBDB@@B@D@BBDBB@@@FBB@BB@@B@B@@@B@@@@BDBFBB@BDDDBBD@BFD@@BBBBBBBBDBDBFDFDB@BB@@AD@BA@A@A@@B@@@H@@BB@B@D@B@B@DBH@B@@@@@FBF@@@LD@@B@@@F@@F@@B@BB@BD@@A@@FB@B@B@AADAB@@DD@DABG@E@@J@R@B@TPNAD@BBDCHC@A@@@@B@D@@B@BBDBF@DABABA@@@D@@D@BA@@DEB@@@@@BAB@@BB@@@B@@LAFAD@@BD@@@@A@C@@HAAAD@D@BAB@B@F@@@B@@D@@@B@BABAFADAD@@@F@@@BBB@BBB@@BBBBBDBB@DBBBD@@@BBD@@DFBBDJBF@BB@@BF@BBB@@@BB@@@BB@@BDDDDBBBD@B@@@B@BABABAB@BADABCBABADCD@BEBADCD@@C@@B@@@F@BABABAB@B@B@@BB@BBBBH@BBFB@@DBBB@BBBBDBDBDBD@DDDBBBD@BB@B@BA@@B@D@BADF@B@@@@DBB@@BDBB@BB@BBBD@@B@@@BA@@BA@@BA@@@@DA@A@@@A@@@A@@F@AK@ACACCAABAACAB@@AA@@@A@@@AB@@@@C@@@@@@@A@@B@FA@@@AB@HAHA@@BB@@@@BB@@B@@AD@B@@@@@@BB@@@BA@@@AB@FABABBB@@@B@@AAAB@@@DAF@@@B@@DBB@@BBDB@B@@@B@@C@@B@@@BBB@@AB@@A@C@@B@@@BDBB@@@B@DC@@D@@@D@DCBADABAB@@ABAHADCB@@@DFBB@@@@B@B@@@@@B@@BBB@@B@BADADAB@ACAAAAAA@ACCB@DC@@@@@@EC@@BA@@AA@A@@BA@ABA@@BAAAD@DCBA@ABA@AD@@A@@@BBB@@BBDDJABAF@DA@@BBB@@@DABADAB@DAB@BAFAHC@@@@@A@@BADAB@TJFDHDDB@@@@CD@@BB@B@@@B@@@@@@@B@@BD@@@@B@B@B@@@B@@BB@BA@@@@BB@@D@B@B@@AB@B@BB@@@@BA@AA@@A@AACAC@AACAGCIAA@C@C@AAAAACB@CA@@AC@@A@@C@@CEBA@I@AA@@@@B@@AAAAC@@C@@CFAAACI@@DADAAAB@ACD@D@DA@A@A@AAC@@A@@@AA@@A@@AA@@A@ACC@AAC@@BA@A@AA@@A@@@A@A@@AC@A@A@A@A@A@@BAB@CKB@D@A@@CF@AA@@JAB@DA@@BABC@@@A@@@C@@@@@AAA@@FC@@FADADAAC@@FA@AAAACAAJC@E@E@@B@AAAA@AB@@A@ABB@ED@@@B@BAB@@A@DD@@HD@@BDBFDBBDBDDJEACJCBDLC@BB@@ADCBAAEEB@CCB@AA@@BA@@AA@EIEB@BA@AAB@AEB@B@D@AAHAAAB@DAB@AAB@@AB@@BB@AAB@AE@A@@CBAGB@@BB@@A@AA@CC@@A@A@@AB@@CC@@CAANAAGA@A@@AC@AA@@CBAAABA@A@@@@DCADFCBA@@CA@A@BDE@AA@AA@ACA@@@C@ACA@@A@@CB@DMAA@A@@A@@@A@@@@@@A@@@@@AA@@AA@@@@@@@@BA@@@CA@BA@@@A@@@@@A@@AABAA@AA@AA@AAA@AA@AAAA@AA@@@AA@@@AA@A@@@A@CA@@AB@@@@@@A@@A@AA@A@@@AA@@A@@CB@AAA@@@@BA@@BB@@B@@@BA@@@@AAA@@@A@@AAA@AA@@AB@@@@@@AA@B@B@@@@CBA@@@A@@@A@@@@BA@@@@DE@CBA@@@A@@@A@@@@A@@@CA@A@@A@@@C@@@AA@@A@@BA@@@@BA@CCCBAA@@CBGD@@@CGBACCBC@C@BDC@@AA@@BC@E@A@A@@BABEBBB@@EDB@IHA@C@AEACCBCBA@CGCBED@@@B@BA@@AA@BA@A@ADAB@B@BAA@CI@@DA@C@@B@BAAA@AB@DA@A@@DDDA@AEI@@ACCBCECBACFAAA@@H@BAF@B@@@@E@C@A@@@@BAB@@A@C@C@@FA@AA@@@@AF@@@@ABA@@CGAC@@FA@@ACDA@ACB@@AA@EA@FA@A@@GBABG@E@EGA@AC@@ACCAACAC@A@AEBAAA@@@A@@@EB@@BDCBBB@@AB@@BBCBA@@A@@CBABA@CC@A@@AA@@@ACBACA@A@A@@@@@@E@@A@C@@BK@C@BDEB@BA@@AA@AAA@A@@@@@AAAC@A@AAAAAA@@@@A@AAACCAA@AACAC@AB@DA@@@@@CA@AGAA@@@@B@@AB@B@@A@@AAA@AC@AD@@AHA@@@@GO@@@@AAAC@@@CAAB@@@FAHC@@AE@A@@@CA@@A@C@A@@@AD@ACB@@ADA@CE@@A@CAC@CC@AECBAEHA@@@AUBCBAAAA@A@AAA@@@A@@BA@@@A@@@@AAA@AA@@@AA@DA@A@AFAACD@CI@AEBC@@@EBBBC@@@@@A@AA@A@@A@A@C@@@A@@@@A@AAEAG@@D@@AB@@AFACEAAAA@AA@ACDA@@BA@@B@@AFA@@DDDABBDABGB@@@AE@@ACB@@ADA@@@ACB@C@A@AB@@A@A@A@@D@BBHCB@BBBA@ABA@@AAAAAA@@CAAAAE@@DAAAB@BAAECEACAAAC@AAA@@@@A@ABA@@BABCDA@AA@AA@GG@AECBA@@D@RE@AB@@A@ABA@ACB@AB@AGC@@DA@CB@@@AC@@A@@ABGBEBBBCB@ACBC@EBEDAADAACABACA@A@A@@@CB@BA@BBB@CBBBBDGDA@C@ACCCEGBEBA@@BAEGDAAEKD@@CG@CLC@AAAFA@ABA@AC@ACA@@@C@BFGBCED@AECB@@@CEBCBBB@@@B@@A@AB"],encodeOffsets:[[115285,33422]]}},{type:"Feature",id:"411303",properties:{name:"卧龙区",cp:[112.528789,32.989877],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@B@@@@AB@@@B@@@@A@@@@B@@@BA@@@@AA@@@@@@@A@@@@GCCEAAA@E@@@@B@B@BBBDBBBBBBB@DBB"],["@@AA@AACACEBA@@@AC@A@AC@A@GBEB@@@@@@CBA@AACCA@A@A@C@C@A@A@AB@@@B@F@B@B@@ABAB@@@B@B@@ABA@C@E@ABA@@@@@AAAAAAAAA@A@C@ABA@@@A@@@@@A@@B@B@@A@ABABA@AA@AAA@@@AA@CAA@EBKB@D@BBDAD@FBB@@@@@B@@@@@B@@BDBB@DBD@@@@@@D@@@@@BDBD@BBBBDBBDD@B@@@BBA@BBD@BA@@B@B@B@BBB@B@B@BBBB@@@B@D@D@B@B@DBFAB@B@@A@@@@D@@@B@@BAD@@@B@BBD@B@B@B@B@B@@A@@@MDEBA@C@EBA@A@@ACCA@AAE@C@@@@AA@E@CBC@@@@FC@ABCAA@G@BFEBBBE@@BDH@B@D@D@B@@ABEF@@@DABBF@D@HBBBFA@@@@B@@IBA@C@QBE@AAAB@@@@@FI@IB@C@CE@AB@BADCA@BC@@BE@A@@B@@@BABB@B@@@@DADA@@@C@@@A@AJC@@BC@@BC@B@CAA@@@@B@@A@@BA@ABAFCAADB@EJ@@@@DB@@AF@@DA@DB@@@BA@@@@@BB@@@@B@@B@HB@@@BD@@DABA@BDE@@BB@@BIA@FA@@BB@@B@B@@E@AB@@IABCC@@AC@@BA@A@ADGA@@AFAH@@AFC@@HABJH@@@@BA@AD@FB@@DBBAFBAFFB@@@BABA@ABB@@H@@A@@@@DC@@BB@@BD@AD@@@BB@@B@@AB@BABB@DBABDB@@@@@F@BAB@@AB@@ABBB@@AB@@@BFBBBB@@@B@B@@@@@@@B@B@@@B@@@J@@J@D@@@BB@@DBFB@@@@A@C@AF@@BJAD@BB@BB@B@@@BBB@@BB@@BBBBCA@@EB@@AB@@BD@@OB@@CF@B@B@@@@BDA@@@A@A@@B@A@AABCB@B@@@@C@@BAAEB@D@@D@@@B@BBB@DAA@D@@B@DB@@@BB@DDCBBBABAC@@DFBABD@@DC@@@A@@@@B@BABDB@F@@AD@@C@@B@@BD@@CD@@DD@@B@F@BBD@H@D@BC@@HB@D@@@@BB@@@BAB@B@B@@@@A@@D@@BB@@AB@@A@@BAB@B@@@@BB@@B@BBBBB@@@BBB@B@@AB@B@@AB@B@B@@@B@B@@AB@B@B@BBD@BBB@BB@@BB@B@B@B@B@D@BBB@BBBB@B@B@@@@ADAB@DCD@@@B@@@B@BB@@BB@BBBA@@B@B@BBBB@@@B@@@B@@ABAB@B@@C@@B@BAD@@@B@B@BA@@DABAD@D@B@BB@BBBBFD@@BD@DBB@B@BA@@BA@@@A@A@A@AAAA@@A@C@C@ABA@A@A@@B@@@B@@@BBBBB@B@B@@ABCH@BA@@DA@@BAD@B@B@@BB@@BB@@DA@@BAB@DAB@B@B@D@@@@@B@@B@@@B@@@B@@AB@BAB@@@BA@@B@BAB@B@B@@B@DDB@B@@@BB@B@@B@BB@@@BDBB@BB@B@BAB@BCBABABCBKBA@CB@BABCB@B@BABABABABC@CBABCDA@@B@@ABCDCBABA@@B@BAB@B@B@B@BAB@BAB@BA@@BABABAB@@A@A@CAA@A@C@A@A@C@ABGBABGDGFA@ADCDA@@@@@@BBB@BBB@D@B@@AB@D@DAHB@@B@@ADABAB@@ABA@@@C@ABA@A@EBA@@D@@@@@BBB@BB@@@@B@@@FBB@B@@@@ABBB@@@D@BB@@B@B@@@B@@@D@B@@@B@@@@@B@@AB@B@B@@A@BDABAB@@@BD@BBB@BBBBJF@@@BCFAB@@HF@BBB@@@D@F@B@B@BBBB@FBBBB@AB@B@@ABAB@BA@CBA@@@CBA@A@ABA@A@@@@B@@C@C@@@EAA@CA@@@@A@A@ABCDCBA@ABC@@@A@@@ABAB@@@@@@@BBDB@@@AB@@@B@@BBBBAB@B@@@@@BDB@@@@@BA@@BA@BB@@CF@@ABABA@@B@@@@BBB@@@B@@@BBB@B@BBBB@@@BBBBDB@@BB@B@B@@CBC@AC@@@@@@AB@B@@@BADB@@B@@@@@AC@@@@@@BBB@@B@@B@BB@@@@@A@A@@B@@@@B@@@B@@@BDAB@@BADA@BBBDDAFA@@BBB@D@B@@@@@@B@@@@A@AB@D@BEAA@@@@A@@A@AAA@CBBB@B@B@@@BBB@BB@@BB@@B@@DB@BB@@B@D@@F@B@B@BC@@BAB@@@BA@@@A@@CC@@@CDC@@AE@A@@@AB@B@@@@@BBBDB@B@@@DB@@B@BB@@@BAB@BBB@BBBD@FB@@D@B@@B@@@@DAD@B@BB@@B@B@DBBBBB@@@B@B@@DFB@@B@HB@BDDB@BBDA@DBDB@BB@@@@BB@DADB@B@@FBBBBBB@B@@@DA@@@@BA@AFB@BBD@BB@@@B@@AB@@@F@BB@@B@D@@@BBB@@@D@B@@ABC@@BABADA@@@B@D@@B@@@@AB@@@D@FB@@DB@@DCB@BCC@A@A@A@A@@@DA@@@A@@B@B@B@B@B@@@@C@@AAAA@@@@DBB@@@ACA@@@CA@@@@B@F@D@@@B@@@CA@@DA@@BBBB@@@BDB@B@@DBBB@BBD@BB@@@B@BCBA@AFB@BB@@@B@@B@@@BAB@BBBBB@@@BAB@@@D@@BBB@BDB@F@@BBDDDAB@BBD@BBF@@@@BB@@B@B@@@B@B@B@@A@@BBB@B@@@DAB@B@@@B@B@B@@@@@@AB@@@@@B@@C@@D@@@B@B@@@B@DBBE@A@A@AB@@ABA@@@A@A@AA@AAA@A@A@AB@@@AAA@AEA@A@AB@AABC@@B@BA@@@@@BDBB@BBB@@@@@F@DADAFABAB@@@BA@@@AB@BCD@@AB@B@B@BBB@H@@@B@BABC@AB@@@DCAA@@A@@AACA@ECA@AC@@A@@@A@A@ADA@A@@@A@A@@@@ABA@@DC@@@AC@AA@@@ABA@@BABADABA@@B@F@D@B@@@@@@C@A@@@@B@@@D@B@@@BE@A@A@A@@BA@@EAA@@@E@A@CA@@@A@@@C@A@ADC@AB@BAB@@DBBB@@@DBD@D@B@B@@@@@@C@ABA@@@ABA@A@@FBB@D@BBB@BBBB@@BFDB@BD@B@B@DA@@BA@AA@AE@@B@B@H@@A@E@C@@B@B@@A@@H@@@RAF@@@FT@@@D@B@B@B@B@@B@@@BAD@D@B@@@@@BDB@@BC@BBD@@B@@C@BF@@AB@@BB@@B@@AD@FABAD@@AF@@F@FB@D@D@B@@A@@@IF@BAB@B@B@DAB@@B@@B@D@BD@@B@B@D@@A@@@AD@@A@@@A@@@A@@@A@@@@BAB@BBBB@B@BD@B@DADCECACCCACA@@A@A@CBE@E@A@ABCBC@@@@AAAAAAAACCCEACCC@A@@@C@@@A@ADCBCBC@A@@@A@ABADCBADCDEBABC@A@C@AB@@A@AAAC@AACACCC@CACACAAAAAA@AA@CA@AE@AAGAA@AAA@@@A@ABABABA@A@E@@@AD@@@DCBCFA@ADCBCBADABABC@ABABABA@A@A@@@AACAACCCC@AA@@A@@AA@@A@AAE@@AA@@AAECIAACE@@AC@A@@ACAA@CAAACAAAA@@AA@AAA@A@@@E@@BCBCBEBA@A@A@@@CA@@@E@A@A@ABC@C@BBGB@@@D@B@@C@@AC@EBKB@@@A@@AA@@BA@A@@@@FA@CB@@A@CC@@@B@BABA@CAEAC@A@AC@A@@@@@@BGDCDAAC@MBSOA@Q@I@@@@FAHCBC@@CA@CBBBA@A@A@@EB@@@ACA@@A@AE@@@@E@@@AC@@K@@AE@E@@@@@AAG@C@A@A@C@AAA@@@G@@@AB@B@B@@ABC@@AAA@ECECCACAAAAAAAAA@@EC@AACCACC@AAAAEAC@@@@@A@@@A@AA@@AAA@E@@AAAC@A@C@AA@ACAACCA@AAAAAAAAA@A@A@@AA@A@AA@@A@ABA@E@@AAACAAC@C@@AA@A@@@A@@AAAA@@BA@ABABG@A@@BADE@@@E@@@A@@BAFE@ABABABA@AB@@A@A@@FABAB@BAD@B@B@F@BCB@@@B@@@B@@@B@@@@AB@@A@A@AAA@@@@@A@@@AAC@A@AA@@ACA@@AA@AGE@@@AAA@@@ABABC@C@A@@@@CA@@ACAA@@@@@A@AA@@AAA@@@ABA@ABABABADA@ABA@@AA@@@@FC@@BAB@D@B@B@@@B@HABAD@B@@@BA@@@@@A@EB@@A@C@@@AAE@AAAC@AAAAA@G@C@E@A@A@AAA@A@CAA@AAAA@ACCAA@A@@@AAA@@@A@ABAAA@ABA@@BEDC@@@CBC@A@AAAAE@@@A@C@A@AACAA@@AAA@@AECAAAAAA@@AC@@@ABA@@@@B@B@@AB@@CB@BAB@F@D@D@BAB@BABA@ABA@ABA@@@@B@@CB@@A@AAA@@EACAC@A@E@EDE@CBABAB@@A@A@AAAA@A@@AA@G@@BA@@DABABABABAB@B@B@DAB@B@D@B@B@B@@AB@BC@@BABA@@B@@C@A@A@C@@@A@A@A@A@@CCCC@A@CA@AAA@CAAAEAICAAEAAAAA@AAACC@@CAA@AA@A"],["@@AAABC@EB@@A@@B@BBBBB@BDADAFAAC@A"],["@@B@@@BB@ABABABA@@AAA@@AAA@@@@@AAA@@GJBDBBB@"],["@@A@GC@DADHBB@BE@@"],["@@B@B@@@BA@AAA@A@@AA@@A@ABABAB@@BBBBB@"]],encodeOffsets:[[[114936,34128]],[[115174,33496]],[[115088,34092]],[[115096,34082]],[[115125,34079]],[[115135,34073]]]}},{type:"Feature",id:"411321",properties:{name:"南召县",cp:[112.429133,33.489877],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@CUBCAIEACCGIOEICCAACB@@E@@@AAA@AA@@A@C@@@A@@@AAAAA@@@A@@BA@@BA@AB@BAB@BCDABAD@B@@AAC@AAGAA@@@@E@A@@@ACA@@@CECA@A@@@@AAAA@@A@@B@@A@@CA@AB@@ACA@@A@@@AB@@AA@AA@@@A@G@@@AB@@A@@BABA@@@@@@B@B@@A@@@AAA@ABA@@@A@CCA@AA@@ABA@ABA@C@@BA@ABA@@AAAA@@@ABGFIDCDA@@@@FAB@B@B@@ABA@A@EA@@A@A@ABA@C@AB@B@D@@AB@@@BA@A@@DBD@@CB@BEBA@AAA@AB@@C@A@A@AB@@CAA@AB@@A@@@AB@BAB@@@BBB@D@BCB@B@BA@A@EB@@A@@@@D@BAB@@BB@B@@A@@@A@@AA@A@@@C@A@A@E@GBCBA@@@BD@@@B@@@B@JBBJ@@DC@@@@B@@@D@BBF@BB@AB@@ABAAA@AAE@CBCA@@@@@@AA@A@@@@AA@@CFCFEHDD@B@BBB@BA@@BABAB@BA@G@@@@@AF@BA@ADA@@BBBAF@@AD@B@B@@C@@BA@@@@B@B@FDD@B@@A@@@A@@@@@AB@@@B@@A@AA@@AC@ECC@@AA@@C@A@@@@A@A@@A@CAAAEB@@CBA@A@@@AAGACACACAAACAA@@@@@ABA@A@@AA@@@A@AB@BAFAF@BABABAB@BAB@DA@@BCDCDABA@ADAB@B@@@BBBBBBB@B@@ABA@ADAB@@A@A@@B@@@BBDBF@B@B@@@D@B@B@H@BADABCBAB@BA@@@@B@@@BA@@@A@@@AB@B@B@BABA@ABAB@@CBA@AD@BA@ABA@A@@@CA@@AB@B@@A@@@A@A@AB@@@@@BA@@@AB@B@B@@@@CBA@A@A@A@EBA@AB@B@B@B@B@B@@@@@@C@C@A@A@A@ABABABBB@BCDABA@@B@@@BAB@@ABCBA@@BA@BJ@BADADAB@@A@ABA@ABA@AB@@@@BF@@DB@@BBBABBFBFDB@B@BB@@@B@@@@@BC@A@@@ABAB@@ABC@E@A@ABA@A@A@A@AB@@CDGBGBA@A@@BCB@@E@@B@BAB@B@BB@@BAB@@@B@@BB@B@@BBBDBFBB@BDF@@HBDBBB@B@B@@DB@@BB@B@@@@B@F@B@HAB@B@B@@@DBB@D@B@B@F@@BHAB@D@B@D@B@@@BBB@BBDBDBB@FB@@BB@@BB@@@@BB@@BBB@D@BBB@BBB@BDBB@@D@B@B@@@B@@AB@B@B@B@F@B@FB@@B@D@B@B@B@@@DAD@@@B@D@@@BDB@B@BBB@BABAB@@@@@AD@B@D@@DD@@@@B@B@@@AC@@@@D@B@@@@BB@AB@DADADABA@A@@AAACAAC@A@@@AA@@BA@AD@BCBA@@@@@AA@AAC@A@@A@@B@@@F@BAD@B@D@B@B@DAB@BBB@B@B@@BBBD@B@@@@@BBBBB@@@@BBB@BAD@D@B@D@BBB@D@F@@@B@DB@BB@BB@BB@@@@@B@BK@A@@@AD@@@B@FCDA@@BAB@F@@@@@BABA@ABADCBAB@@@BA@@B@@@BDDB@BBBB@FCB@D@L@B@DA@AB@BAB@@@BABABAB@BBDBBBB@B@@@B@@B@D@@@BB@@D@BB@@@@BA@@BABABA@@@AF@BCB@BA@@B@BAB@BAD@@@BCBC@CBA@AB@@BBBB@@@@CHCB@@AB@@ABA@@@@@BB@@FDDDBBBBBFAB@BCDABBB@@BBB@@@B@DADABAB@BA@@B@B@DBB@B@BBD@FAB@B@BBB@B@B@@@B@B@@@B@B@B@H@@@@@@@@CBABA@@@@B@B@BBDBB@@@B@@@DFBBBFENAB@@BB@B@BBB@@@BAD@D@D@@@DBB@B@@BBBBBB@@@B@@FH@@A@A@@B@B@@BB@@BB@B@B@@BB@@BBD@@@BBB@BBB@BBBDB@@BB@@BBB@@AB@@BBBDB@@B@BB@@@FB@@@@@BB@@BB@B@@@F@@BFB@@BBBBBBBBD@FBB@B@F@B@BBB@B@@AD@@A@@@CB@@AB@@@@E@A@C@A@A@CBA@A@AAA@A@C@A@@@AB@@@BBB@DB@@D@D@B@HD@@FABCDA@@D@@@FAB@B@BAB@DBH@B@@@B@BFBBBDB@BBDBHD@BD@@@B@BB@@@@AD@D@B@B@BB@@AB@BB@@B@@@B@B@B@B@@@B@D@B@@@DB@@D@BB@@B@B@BBBB@@DA@@@A@@BA@@@A@@@A@@B@BA@@B@@A@@@AD@BAB@D@B@@@B@B@BBD@@@D@B@BAB@@@BBB@BBB@BAB@BBB@@@@ABA@@@A@@BB@@D@@A@@BABAFE@@B@B@D@BAB@BA@AB@B@@AF@@@D@BABAD@DABAB@@ABC@@@C@A@CAC@ABC@@@A@@D@BA@@B@B@B@FABADC@@BA@A@@@A@@@AB@B@@AB@@@@CB@@A@AB@DADADAB@B@FDB@BB@@B@DAB@B@BBB@B@@@B@BABBB@DAB@@@@CBA@A@AB@@@@CBABAB@@@D@BBB@@@HFB@BBDB@BB@@@BBB@@@FADADABAB@@BBA@@BA@@BBBBBBBBB@@BFB@@@@@BB@DBDBBBDBDBBBDBBBB@B@BA@@B@DBD@D@B@@@B@@@@@BC@A@@BA@A@AB@BAB@BABA@@B@D@B@B@@ADA@A@A@AB@@A@@@C@A@@BABAB@@@BBB@B@@@B@B@B@B@@@@A@A@@@A@AB@@ABA@@B@@@B@@@B@@A@A@A@@@A@@B@@@@@BA@@BAB@D@@@@A@@BC@AB@@@@@BC@@DAB@B@FB@@@@BADAB@B@B@@@BABAB@@BB@B@B@FC@@@BBBB@B@BB@BBAB@B@B@D@@@BB@@B@@@DA@@B@@AAA@ABA@@@@@CBA@AB@B@BA@ADC@A@ADA@@@A@A@@BAB@@ABA@@@@DAB@DAB@BC@@@A@ABABABA@@@@@ADAB@B@BCB@HA@@@@@@AE@A@A@AB@@A@AA@AAAA@@@A@@@AD@B@BA@@B@@@@A@ABCBA@AB@@@HA@@@@BBB@@@B@@BB@B@B@DBB@B@DB@@@@BDBB@B@@B@@@BA@A@@@C@@@@DA@AB@@@D@B@BBB@BBB@B@B@@BB@@@BFBBBB@@BBHBB@@@BBBBB@BB@@BB@BBBBF@@BBBB@@@@@BD@BBB@B@@D@@@B@@@D@@DB@@BBDD@@B@BAB@B@DBH@B@B@@AB@@ABABA@@B@F@B@@@@@@CBABABC@@AC@@@A@@@@@A@@@A@@@AHE@@@A@A@@B@DAHA@@F@B@B@B@D@BAB@DA@@B@@B@BB@B@DBD@BBB@DDDB@@BBB@DBB@B@B@B@@@FDBB@@BAB@B@BA@A@@@E@A@@B@FABA@@DAB@@AB@BABAD@B@B@D@DA@@BBB@BAB@@@BAB@BE@@BC@A@@B@B@BAD@D@@@@BB@@B@@JAD@B@D@B@BAD@@@B@@BB@@@BBF@B@FA@@B@@@HDB@B@B@B@@A@ABC@ABA@@D@BADAB@BBD@F@B@@@@@BC@@BA@@BA@@B@@A@ABE@A@@@@DAB@D@B@B@B@DBD@B@B@B@@@B@BA@A@A@@@AAA@C@A@@FAB@@@BA@A@@DA@@B@BB@@FBB@B@BD@BBD@BD@@@DAB@@@DDB@B@@@DD@@BBB@F@DAB@@@BB@BBD@@@@B@@@B@D@B@B@@@BBBB@BBBDDB@@@B@B@@@B@@@BA@@AABA@@@AB@BA@AB@B@B@B@D@B@B@BBBB@@B@B@BAB@@@DB@@DAB@@@B@B@@@B@BBBBB@@@DAB@B@@BB@BDB@@B@BB@@BB@B@@@B@BDBD@@@D@B@BAB@B@@BD@@BB@BB@FE@@AA@A@A@@@@BC@@ACAGACBABCAA@@A@@A@E@AC@@@@CB@B@FGCC@IEC@@@A@A@ABCA@@EBA@A@C@ABACC@A@@@AAAEGAAA@C@CAA@AA@CAACC@@@A@AAC@CAAAA@AAADA@@GA@@@ABA@ABED@BAB@BCF@B@DA@ABA@@ACAA@@AAAAC@CCCCECCCCCAAAA@@@A@CA@C@@@@@CAAAA@A@A@AA@@A@AA@A@@@A@@@A@@CAAAAC@A@A@@@ABA@@@A@@A@A@@@AAA@@A@A@C@AA@AA@AB@BCA@AC@A@@@A@A@@HAECA@@@EA@A@AAAA@C@@C@A@@CEAAA@E@@AAA@@ACA@AACCAABABA@ABAB@@A@@AA@@AAB@DE@@AAAC@AA@@@A@@BA@@@A@CA@A@@@AB@@@D@@@@B@@@@@AB@@@BB@@@AEEA@EAAAE@C@AAAECECA@@C@A@A@A@A@@@AB@BA@AA@@ABA@A@GA@@AA@AA@@@A@A@A@A@@@@@AB@@AA@A@@@CBAAC@CA@@A@A@C@@@A@A@AAAA@@@A@@AC@@@ACA@AA@@@C@A@@EE@AC@AACC@@AAACCC@AEE@A@A@@BAACEG@@BE@@@AAC@ABAAA@AAA@@EA@@ADEBBB@D@DFB@@BD@@AB@B@@ABCACFCBGDAMCCAACA@BBBCD@JG@CAAADIA@CAA@@@A@ABCBCB@@A@C@ABCB@@A@AAAEAA@A@AAACAAAAAAA@AA@@BADA@@AAC@AA@@AAA@@A@G@AC@ABAEGAA@AAA@@@AAAB@FABAB@DFB@BCJ@@IAGCBCADCFDBDAI@AAKIOB@BGLB@BDEHBDFH@@GDCCGAACBIEEECBAKF@I@@C@AIBCACCCEGC@A@A@A@@HEFCBCBAB@@GBABA@CBGBC@C@AAC@G@ABC@GBI@@ACEEAA@AA@ACAAAACAC@A@CAAACDCBA@C@@A@AAAAAA@AB@@@@@B@@@B@@@B@@@BC@@B@@@BC@A@A@@@ACC@A@@@@AA@CBA@A@A@ABE@@J@@@BA@C@C@A@@E@EE@@BC@ABEBC@@BA@@@AA@@BA@@AED@@@@AC@AAD@@AA@AC@@@@A@C@C@AB@@A@@@@A@A@A@A@C@@ES@@E@QB@@G@@@@BA@A@@@@D@F@BG@A@A@@@BFB@@BAB@@CBA@A@C@@ACAAE@@AAAAA@AAC@A@EA@@@BAB@B@@AB@B@D@@@@A@A@C@C@CA@@A@AA@CA@ABA@@BCD@B@B@D@@@B@@DBB@F@@@B@FB@@AB@@@B@B@BAF@@A@C@@@A@@@@@@B@D@@@@A@C@E@A@@@ABCBABAB@@AB@B@@BBD@@B@@CD@@AB@B@@B@B@@@B@B@BCB@B@@@B@@@BDB@FDB@BD@BB@@@BBCD@@A@@BADABA@@@G@A@AAA@A@A@@BC@ADA@@B@@AB@@A@ABEBCBCBE@@@@@A@AAA@CA@A@@@@ABA@@@ADBBA@@B@BFB@BBB@B@@BAB@B@B@BBB@@B@B@B@@AB@BA@@B@B@BAFCAA@@@A@A@@@C@@@@DA@@@@@A@@B@@@@A@A@A@@@A@A@CB@@A@A@AA@@@BA@A@A@@@A@A@@@AA@@@@AE@AAC@ABACCAC@AE@A@ACA@AA@@@C@@BA@A@@AAAA@ABA@A@@@AA@@@A@@AEA@BABADA@@@A@@AAC@AAACA@@@ACA@A@@AAAA@@CB@@DB@@A@@@C@E@A@@@@@DB@@B@BD@@A@CA@@@@BBBB@@@D@@A@A@A@A@A@@@@B@@CB@@B@B@B@B@D@ADA@BBFAADDDAD@BAB@DCBBDCBDF@@@@CDCBCBI@@AAB@@A@A@DDG@CBGAABCFB@@@AFA@GABC@CAECBCAECA@ADD@EDAB@@@FAD@AKHGC@@@AA@A@IA@@A@C@CB@DABFLBB@BBDEBCBCB@AAAAA@A@A@KADGNCDAFA@BDBL@BAB@BBB@HFDAB@BDB@BE@JFIBBBH@B@ABDHILBBCDCDA@ICCAAAAAMCQH@EE@@DGAA@BCAAGDSHEAACIACBADGF@DCBGFABCBADBDBH@BG@AGMDSIA@KJAB@CECC@C@EBAA"],["@@AD@BA@C@@@A@AA@@C@A@@@AAE@@@A@@BA@@@A@@AAC@AEA@BAB@@@@CB@@A@A@AAAAEA@@@ACACBA@@A@@A@@ACACAB@AC@ACAACA@@G@AA@CE@@@A@A@@AAAACAA@A@@@AAA@C@CB@@@@@AA@C@@@EAC@AA@AAA@ABA@A@@AAA@@@CA@@A@A@ACAA@@@@A@A@@B@@@BBF@@CD@D@@DD@@@B@@AB@@A@AB@@ADA@A@E@@@@C@AA@@ACA@@@AA@@AA@@AAA@A@@@A@AAADAB@BBB@@@@B@@B@FB@A@CBAB@@@@@@A@@@@A@C@A@AA@@EBCBACAAB@BC@AA@CB@A@@@A@@@A@@A@@@@B@B@@@@AAA@@@@AA@AA@@@@@@BD@@@@A@@@CAAB@@A@A@@B@@@@D@@BAD@DA@A@A@@AA@ACAA@A@@AAAAA@A@AA@@A@@@A@@BAD@@@BA@AAA@AAA@@@AB@@CAAB@@A@AD@@@@CA@@@@A@A@CBA@A@@@@@AA@ACAAAECAAA@@CA@@AA@CAA@@@@@A@@BCF@BDB@@@@@@AB@@@@@B@B@@@D@B@B@@@@C@@@AB@B@@@@AB@A@@A@@@@@@DBB@BA@@@@B@D@@BB@B@B@@@B@B@B@@BDA@E@EBA@@@@F@@AB@@@@A@A@AAAA@@AE@ACAAA@@A@@@BBBFB@@BA@@@C@A@A@@@AA@A@@@AAA@@@B@BBD@@@B@@@BBB@B@BAB@@CBA@A@C@A@A@@BAB@@@BBB@@B@BBDBB@@B@B@@ABAB@@@B@@@@BB@B@@@@EDA@AAA@@@@BA@AB@BABAB@@@B@@BB@@@B@B@@@F@BDD@@@B@@@@@@@B@@AB@BBBBB@B@D@B@D@@AB@BAB@@AD@@@B@@@D@@AB@BA@@B@B@B@B@B@@ABAD@B@@A@CD@@BB@@DBBB@@@BABAB@B@B@B@@DBBB@@@@AB@@BF@J@B@B@B@@@@@B@@@@@@BB@@@@AB@@A@@@@@@B@@A@@@A@@B@@A@@@@@A@AD@@CA@@ABAAA@@@A@@@BBDDFJJPDHBDJFDBVA@DBBFAD@D@FD@DBALIB@TJNCBHH@@AAGACBCDABAHEDA@CHEBCDAJBBDFBTGHCBBADB@HB@CF@@FRGNDBBBBDBJDB@DCDCAAJKCGBAA@G@AAJAIEF@@ACA@ABAEC@GAA@ABA@AAKACB@BEDCHMBC@LB@@@FAD@BAEKBA@CDAD@B@@@JBB@A@AAACHI@@BB@B@@@@BB@BB@BB@@ABABAB@BAA@@@B@@HDLG@BBC@E@@BAFCC@BCB@FDDBDABFHDDEBAHBDAH@CCA@AAAA@@BABABAB@@@BB@@@BBB@B@BJ@DADADC@@@@CEDAACDA@CBA@ABCCCBCEBAACD@@CA@@EAC@@@A@@B@@A@@@@C@A@@CBABAB@@"]],encodeOffsets:[[[114960,34156]],[[115132,34053]]]}},{type:"Feature",id:"411322",properties:{name:"方城县",cp:[113.012494,33.254391],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CAAAE@E@A@AACAA@AA@@@CA@AA@ACAA@AA@@AA@@A@@B@BABA@@@@AA@@AA@@@@@A@A@@BA@@@A@@@@@@C@A@@@A@AB@@CAG@@@@@A@I@A@@@AHB@B@FB@BE@@BD@@@B@J@@@@F@@@@EBE@A@CBCBAB@H@@@@C@@DCBA@CB@BBB@@A@@@@@ACA@@@C@@@@@@AC@GB@@@B@@G@@@@@AD@BC@A@CAE@C@AA@@@A@@AA@@@@@AAE@A@@EBA@@@@A@@CC@@@AABA@@AA@A@AAA@@A@@@@@A@A@@@@A@CAC@A@AA@@@A@@A@A@C@@@ACA@@@E@A@@@@D@BCBA@A@BDC@@BCBC@A@AACBA@@@A@C@GD@B@@A@A@@@@AA@@B@@A@AA@@A@CAE@A@@@I@A@ABABA@A@AAA@A@@@A@A@BBA@EBC@@@@AAAM@@B@@AD@@A@@@A@@A@B@BB@@BAB@@@@AAA@A@@BA@A@C@@@AA@@@@ABA@@AA@@@A@A@A@@@@@AC@@@A@@@@@@@A@@@AAA@@DC@@@@CAGCECSIA@CBAB@@@B@@@@GDEBABA@CBA@CBABCB@@A@AA@@CBE@ABIBCCAA@@AA@A@@@BC@@BAB@BABCDC@BBAB@@AB@BAB@@@BBB@@AB@@FD@@@@@@CDA@DD@BBBBBBBBDA@CBCBABA@@@AA@AA@@@@@A@A@@@@@AACE@@A@CDGBAB@BA@ABCBABCDC@@@C@@@CDA@@@A@CA@A@@@AD@B@@@BA@@AA@A@@@AD@@@@A@@@ACAAA@@AA@CA@@@E@CB@@A@BB@BA@@@A@AAABEBA@@B@@AB@@A@@A@@@@A@C@@BA@@@AA@@@@AA@@GBGBA@@B@@EBA@@@@B@@@@@@@D@@A@@B@@@B@@BB@@BABDABBBDDDB@BBLE@@@@B@@@B@@@BCB@@@@AB@@AB@@AB@@A@@@ACAAA@@AAAAC@@AA@C@@A@E@ABCFCDABCDAB@B@B@@@BADADCD@B@B@@@D@@@BDDBDDFDDBBBBBBBB@@@@ADAD@B@B@FAF@D@B@BB@BDDDBDFDBBDBB@D@DBBBBBBDB@@BBBFFBD@@AJ@HAD@B@HBD@B@DADAH@DABAB@HA@ABADEDGF@@@B@B@BHDDFDDDBJA@B@DJ@E@BLDAFFJFDABBDHCD@HG@CEGACF@AKAAHA@JPBL@BBJACECCDDBDABH@JI@ADA@CEA@ABEBA@BB@B@@BB@BBBFHAB@BBDH@B@@@BB@BB@@BBD@BB@BC@AB@@BBBBBBBDBBB@B@BBBBFBBB@@@DABAD@B@@@DADABAB@@@B@DBB@CJBBDBH@@IDCAA@ADBBBDDBNHCDADEDBBA@@@ABA@@AC@@EA@C@CAAFABC@@FB@@BB@BBBAB@BBD@B@@AF@@FHBDAB@@@B@BFF@BDDBDBB@@DDBBD@@BFF@@@B@D@@BBB@BD@@D@@BB@@@B@BB@B@B@B@@@D@B@BB@@DBDAB@D@@@BBB@@BA@@@@B@B@B@B@@@B@@BBB@@HBB@B@BA@@BBB@@ABA@@B@B@B@B@D@@@DBDFBFBBD@F@BBFBB@FF@B@@AA@@A@@B@@@@@A@@C@@@A@@B@@@BDBB@@@B@@AB@@@B@@BBDBB@@CFA@BB@@BB@@@BA@AB@BABABBBDDBBB@BD@@BB@BF@B@BBDF@@@B@DD@B@BB@B@BFB@@B@FDGB@@@B@B@@@BBDB@ADA@@BBBB@@B@D@B@BB@BB@@B@B@@@@B@@AB@B@@@B@BBDBBDB@@@B@@@B@@@BBBB@@@BBB@B@B@BBDB@@@@D@B@@D@B@@BBBBDDDDFDDDDDD@BBBB@@BBBD@@AB@BCBA@E@ADA@ABC@AF@BAB@B@@HB@@CBBB@BBBBB@DBD@B@B@@DDBB@DBBB@DBD@B@BBFHBB@B@@@BDDAB@B@D@BAB@FB@AD@B@B@B@@FD@JDDEHA@A@@D@@D@@B@F@BB@@@BBADABBDBHBD@@AD@@@@@B@BBB@@EFABA@@B@B@BBB@@@BB@@B@BB@@BF@BB@@D@B@DBB@DBBB@@@B@B@@B@@@B@@@DA@AB@B@B@@@BAB@BAB@B@BBD@D@D@B@B@@@B@BBBBBBBBDF@AB@D@FAFCB@BCB@BA@AB@BA@@@@BBB@@@B@DA@@B@@@F@DAB@F@B@DBB@D@DBD@B@B@@@D@B@D@B@@@@@@AAA@A@@@A@@BCD@@AB@@@@A@A@AB@@@AA@AB@BC@@@A@A@ABABAB@@@B@F@@@@@B@@A@@BABADAFCBAB@BADBB@DDB@BBB@@@B@@ADC@@@ABC@@@@B@B@BAB@BAB@@@D@B@@@@A@ABC@A@A@@AA@@BAD@@@B@@AB@DA@@@@B@BB@@@@@B@@B@B@BAB@B@B@B@B@B@BB@@BA@@B@@@BCDA@AB@@B@BB@@A@AF@FAD@B@@ABAD@@@@CB@@@B@BA@@@C@@BA@A@ACE@@@A@AFCBA@@DBB@F@B@@@@@@AB@@@@A@A@A@@@A@@@C@@@@@C@@@AB@@@B@B@@@BAB@FAB@BA@@@@@A@A@A@AAA@@@CBABA@CAA@E@CAABA@CBA@ABC@@BA@AA@@AAA@C@@BA@A@@AA@A@@@AB@B@@A@@AA@@@A@@BC@A@A@@BA@@BABAB@BA@AAA@@@A@@@CAA@AA@@@@@ABA@AACA@AC@CCAA@@@AAE@E@AAA@A@@@AA@@A@@@@A@B@@A@@@ABA@@@E@A@C@CBABA@A@C@C@C@A@@BAB@@ADCB@BA@@@@B@BB@@FDDBBBFBBBD@J@DBBBH@BBD@B@B@B@FAB@D@D@@@F@B@@@BAD@BAD@D@BAB@DBB@D@B@B@DAFC@@B@@@AF@@BB@ADA@@BABADABAF@@@DA@@BA@@@A@C@E@EBADEB@@@B@D@B@BCB@DA@@BBBB@@B@B@BB@@BB@B@@@@D@B@B@@@DD@@B@B@@@@@@BBBBB@@B@B@B@DA@@B@B@@@B@BBJ@BBD@BBBBB@@@F@HAB@DAB@DA@@DAB@DA@AB@@A@@DCDCBA@A@A@ABA@AFEDAD@DCB@@A@CBA@C@@@A@@@CFCDA@@B@@@B@@A@CDAB@AAB@@@@AB@@A@A@@B@@DBA@BBA@@@@BAB@B@BA@@@BB@@@B@@AA@@@B@B@@BBA@@@EBA@@@AB@ACB@@C@@CBA@@@@@ACC@AA@@B@@A@@@A@@AG@@B@@AB@@@BDBA@CBA@@A@BAA@@@@@@AB@CCB@@@@@@@DA@ADA@CB@B@@@B@B@BDDA@@B@@BB@@@BA@@BH@@DA@AB@BAAAD@DAB@B@BBD@@@B@B@BAB@@@@AD@@ABA@@B@@AB@@A@@BA@@BB@@@@B@@AAA@AB@@@B@BB@@@@BA@@BABAD@@@BA@@B@@A@C@A@@BA@@@A@@B@BBB@B@@BBAH@D@ADB@J@D@D@FADABABABA@EB@@AD@@@F@F@BBB@DBBBB@B@BAB@NB@@@BAB@@B@DD@@FA@@@@@D@@@BH@BBDA@@DAFCBABA@A@@B@@@B@BB@@B@D@D@DAB@@@@A@AB@D@D@FAB@@A@AB@BB@BHAB@@AB@@@BBB@@@@C@@B@@@@@DDDBB@@ADA@@FEBAJEB@B@B@B@BBBBD@B@@@@@BA@@@A@A@A@C@@@AA@CA@@A@@BBD@@@BA@A@@@A@@@@A@A@CAA@@@CBA@@B@@@B@@@B@B@B@BCHF@@@A@@BA@A@@AEAA@@A@EAA@@@AA@@AE@A@AB@@@B@DA@@B@BCBA@@@A@@A@@A@@@A@A@AA@@@A@C@A@A@A@A@CAAAAA@@A@E@A@C@@@@AACAA@A@@BA@A@@@ADA@@@@AC@@BA@@BA@@@E@@BAB@@ABA@A@C@A@AA@@AB@@@BBB@@@BABCBA@E@@BA@@@@B@@@BF@@@@FC@AB@@A@CBA@AFC@A@A@CBA@C@@AACAA@C@A@@@@@@CBA@@@AA@@A@A@A@AB@@ABC@CBAB@@@B@@@@@@AD@@@C@@A@AA@@@A@@A@@@@@A@@A@@A@A@@BBB@@AB@@@C@@AD@AAC@B@B@@@AC@C@@A@@@C@@@A@@DAA@BC@A@@@@@A@@@@AABA@@@A@@A@@@@@AA@A@@@@@@A@A@@@@B@@@ACA@B@@@AB@@A@@AA@B@@AA@@@AA@@BA@C@@@A@@A@A@@@@A@@@@@A@@@AB@@@BB@@B@@@@@@AB@@@@@AA@@C@@AA@@@@@@AAA@A@@B@AA@@@@@@@A@@@@@A@A@@AABA@@@A@@@@@@@A@A@A@@@C@@B@@ABA@@@@@@@AA@@@A@@@@@@AA@@AAA@A@@AA@A@A@AA@@@@A@@@@@A@@@@A@@@@A@@A@@@@@ABAB@@A@@@CAA@@@@@@BA@@@@A@@@@@@@@A@BD@@A@@@C@@@CA@@@AB@@@B@@A@@@@@AB@@A@@BA@@D@B@@@B@@AA@@AB@@@B@@AB@@@BA@@DBB@B@@@B@@AB@BB@@DA@@@@AEB@ACA@@A@CB@@CB@@A@A@@@@B@BA@@@@@@A@A@@A@@BA@@@AA@@@@A@A@@@@@A@@@@@@A@@@@AA@@@@@@@@@@A@@AAA@B@@A@@@A@@B@@A@@@A@@@@BA@@@@@@BAB@@@BA@A@A@@@@@@AA@@AA@@@@@A@@AAA@@ADCBA@@ACAEAC@@B@@A@@@@DC@@B@BA@A@@AAAB@AA@@AB@@A@GCCC@CAAAA@A@@@@A@A@CCC@@AA@@@@@ABC@AA@AAA@AAAAC@@@@A@@@A@@AAAAECCAAACAAA@AA@A@A@A@AA@@@@AB@@A@CAA@A@@@EAA@CAA@@ACAAAC@E@@ACAEA@AC@AAA@ABABA@A@@AC@@@@@A@@AA@A@@@A@ABAD@@@@@A@@AAA@A@@AC@A@@@@@@D@@@@@BBBBB@@AB@@ABA@@@A@@@EA@@@@A@A@AAA@@BA@@@A@@D@@ABAB@@A@AABA@@AA@ACC@@A@AB@A@@@@AC@@@@AB@BA@E@@@@AA@B@@A@A@@A@@@AAC@E@AAC@@@A@A@@BA@@@A@E@@@@BAB@@@@C@@@CB@@ACA@A@C@CAA@@@@E@EA@@@ABA@A@C@IDC@AAEE@@AAAC@@AA@@ABC@A@@@AAA@CA@@@A@@AA@CAC@@@@C@A@@@@@A@A@@BABEBA@@@AB@@G@CDGD@@@B@BADABAF@@@BBBBB@@BB@DA@@B@BA@@@EBCBA@@@@F@@BF@BDF@@BB@@@@@BEBA@ABAB@@@F@B@@BL@F@@@BCF@FA@AD@@EBADAB@B@@BB@DDD@@@@AC@CAAB@BAB@BA@A@BB@BFB@@B@D@DAB@DABABCDABABA@@@@BABABABAB@@A@EB@@ABE@C@@@@@CB@@E@A@@@@A@@B@@@A@@@@@@@@A@@@@@@ABA@@@@@@@@AB@@A@@CB@@@@@@A@A@@@@@BA@@A@A@A@@@ACA@@@@B@@@BAB@@ABCBAB@@MCAACCI@A@CAA@A@A@IC@AAA@AIGEE@@@@ABAA@@@A@@@BA@@@@CA@@@@BA@GGACA@@@G@C@@B@@A@A@@@A@@BAB@@@@@A@@A@CBBB@BA@ABA@@A@@BC@CE@@AB@B@B@FABBBBBA@@@K@A@@@A@@DA@@@A@@CE@A@@A@@@@CC@@A@@A@@@@BA@@CG@@@@@@@G@@ABA@A@A@@@@A@@@E@CBA@CBABGF@@@BADBB@B@B@@@@ABEF@B@@AB@B@BCD@BA@AAA@BDA@A@@AC@@B@@CB@@AAC@@@ABC@@D@@FB@@@@@D@@GH@@B@BD@B@@@@CB@@BBABEFED@@AAAACCA@A@A@ACAAA@@@@AA@AA@@A@A@@CAA@AAACAGC@@@AA@@BCBKD@DI@@@@CA@@@@DA@@@@C@@A@@@@FA@@@@C@@A@A@@@@B@B@@@@A@@@A@@@AA@A@@@AAA@@@A@@@A@A@C@@BA@@@AAA@A@A@@AC@CAE@@C@GB@@A@BDA@BF@BC@CBA@A@A@C@A@E@BFBDAF@@@BEBC@A@A@@@A@ECICEAAA@@E@CA@@@@AA@A"],encodeOffsets:[[115668,33924]]}},{type:"Feature",id:"411323",properties:{name:"西峡县",cp:[111.47353,33.307294],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@AAA@@AB@@@F@@ABBDAB@@BBB@BABABA@A@@AAAA@A"],["@@@GB@@A@CAGA@@CA@@@AAA@AAC@G@A@CB@@A@AAA@C@CACAA@@@AE@@BA@@@@@A@A@@BABAB@AC@@CCAA@A@@AA@@A@A@@@@B@@CAAD@BAB@BA@@B@@@B@BA@A@C@A@@@@A@@@@A@A@@@AC@@A@C@@BA@ABA@AAA@@A@A@A@C@@@@AA@@@@@AB@D@@@B@@A@@@@AA@@@CB@@@@AA@E@C@C@@@CA@@@@@C@@A@CA@A@ACAACAA@@A@A@ADA@@@AA@AEBA@A@A@A@A@CEA@A@AAA@C@E@CBA@@BA@@B@@ADAD@@AB@B@@@@CA@@CB@@@@AD@BA@@@A@ABAAC@@@AB@@@@AB@@A@@BEB@@BB@B@BAD@B@B@BABB@@D@BA@@BA@E@@@C@A@@@@DAB@@AB@BA@@@AAAAAAA@ABGBA@@AA@@@AC@@@@CA@@A@ABC@A@A@@@A@EBC@ABA@@BAB@BABB@@B@@@@ABC@@BAB@@BB@@BB@@CF@B@B@@@BAF@BAFAB@B@B@@@BB@BB@D@@@@@BA@A@ABAB@@@@@D@BBD@BA@@B@@BD@BBFBB@BABA@ABA@ABCB@BC@ABAAABC@@BAB@@ABA@A@A@C@A@A@E@@@A@@@ABAB@@AB@DAD@@@@@B@BBB@@@@ABABABAB@D@B@@CB@@CF@@@@BD@@@BABAB@BABABCBG@A@@@CBCBEB@@EBABCBA@A@A@AA@@E@A@EBEB@@A@CAICCAA@@@@@ABABEBABA@@@AAA@C@C@A@@@@ABC@@AA@@C@A@C@@@A@AD@BA@@@@CAC@@AA@CBA@C@A@AA@@AA@C@EAEBC@@@AB@@@BBF@BBB@F@BABABCBC@@@@BAB@@@B@B@@A@@@@@A@@@AEA@@AA@@@ABA@ABABAB@@@B@BC@@BABA@@B@FAD@@@@A@A@A@A@ABABEBA@ABE@@@A@@BA@@@C@A@@@CDADABA@CB@BAB@BA@A@@@AAA@@@A@C@ABA@@B@@@B@D@@@@BBHBDBDDBB@@@BA@@@AB@@A@@@C@A@@@AAAA@AAA@ACA@@@@AB@@AB@@BD@B@@@BA@@BA@ABCAA@A@ABA@A@A@@@A@@B@@BB@@@B@B@B@B@B@B@@@BABADA@@BA@AAGAC@@@A@@@@BA@@DAB@BEDAD@@AD@BABABCD@@ABIBA@A@A@AA@@@@CAAC@A@@@CA@CECEA@AAA@C@A@A@A@A@ABABCDCDAB@BAB@BA@A@A@ABA@C@@@AB@@AB@@C@@BAAA@A@@A@@@C@A@A@AA@@AA@GC@@AA@@AA@A@C@@AEACA@@@E@GCC@@@A@A@ABA@A@EA@@A@ABEDABE@A@A@@@@AAA@AAAA@A@A@E@A@@@A@CAC@A@@@C@EDC@@BABA@CB@@ABABA@@B@B@@A@AA@@@BADA@@B@@ABE@@@@@AA@@A@A@@@A@@BA@@BA@AD@@A@ABA@@BCBCBA@@BCDEFA@ABA@A@AB@@@@@CA@@AA@@CA@@@A@ABA@@@CAA@@B@@AD@D@BABBF@@ABABAB@@@B@@@B@B@@@B@BAB@BCB@@@BB@@B@@@B@@@@EB@@ABCBABCBA@GAC@A@EBA@ABA@@@ADA@@@A@@@@AB@@@@AA@@@A@CB@@C@@@AC@@AAA@@@A@@@@BAB@@@@@@A@@@@@@AAA@@ABC@A@AA@@AB@@@@@D@B@@@@AA@@AAA@@@A@@@A@@@A@@@A@@AA@@@AAA@@@AB@B@@A@@@A@@AAC@@AA@B@@A@@B@B@B@BA@@@AAA@@@AB@@@@@B@@@BA@@B@@A@@@A@AA@@A@ABA@A@@@A@@@@@@@@BA@@@AB@@B@DBD@B@DBB@D@BB@@B@@@BB@@BB@@@B@B@BB@@BB@B@@B@@@BA@AB@B@B@@@BBBDBBB@B@@BDBBA@BB@B@@BBB@@@AB@@@@@BAD@@CB@B@@A@@BABA@@@ABA@A@EBC@@@ACA@CAICECEBA@@@C@AAGCAAACA@@@C@ABA@@@ECAAA@A@AAAAAA@AA@@@CB@BA@A@@BAAC@@@@@@@@@@B@B@BBB@B@@@BBF@D@@@B@@@@DBD@B@BE@@AC@@B@B@FAB@B@@BBBFBDB@B@B@@DHBD@BCFA@KCA@A@ABA@@B@BFJ@@FHDFB@ABA@ABABA@@@C@CAA@A@EHAB@AA@@@@@@A@AAC@@AAA@A@GE@CA@E@A@@A@A@CAAACA@A@CACACEA@@@A@@@BFBF@B@@A@A@A@A@A@C@@@A@@A@A@@BA@@BAAC@@@@@AB@B@BABAD@B@D@@@B@A@EAA@E@GBABCBCDABABC@C@CB@@AHABCBA@@@E@I@AA@@AC@A@@A@A@AA@@A@@@A@A@A@A@AAAAA@GA@A@@@ABABE@A@AA@AAC@A@A@CE@AAC@A@A@AA@AAEACAGBEACA@@C@KFBB@FCDCFEJ@LBH@BBBDJ@F@FAHEJKJIRAJ@RELCFCFGTGNBBDFFDJB\\PBFFNDN@D@J@JADDXANALELCF@HCFEHIFOJAFBD@FBBAB@F@FHJBFBDBBBADCDADABAB@@@BC@ABABAD@D@BBBB@@@@DA@AB@@@BBB@BB@@BA@@BBB@DBB@BBBA@@B@@@DBF@B@B@@@B@BB@@B@B@B@DBB@FDDB@@DD@@@@B@@@B@@B@B@BAB@@B@FBBBDBB@B@B@FA@ABAB@@@F@@@DABAD@B@@@@@BB@B@@BB@BBDBBB@@@HC@@@BBBBB@B@BB@B@BB@@BB@@BBBB@@@@D@@AD@B@B@@B@@BBDB@@BB@BBB@BBBBB@B@B@BA@@@BBBDBBBB@B@BBD@DBBBD@B@@BBBB@@@B@B@@B@@BB@BBBBB@BBBB@@@B@@@B@DBBBB@B@BA@AB@BABB@@B@@@@@DAB@B@DBB@@BBH@@BB@@@BBDBB@DB@BBB@B@BB@@@B@@@BD@BBBBB@BB@@BB@B@@@@BA@@BAB@B@B@B@D@B@@CBAB@@@DABABAB@@A@ABA@@BC@@@CD@@@B@@A@ABA@@BAB@@@D@@ABA@EH@B@@@B@BBBBBB@B@@BF@B@BABAB@B@@@BBBD@B@B@BBBB@D@D@@@FAH@B@B@DAJAD@B@@@DBD@HB@@BBB@DB@@@@BB@DBB@@@F@@BD@B@@ABDDBF@BCB@BB@BB@BB@B@B@B@@BBBBBB@B@B@@B@BB@BF@@@BABAB@@BB@BB@@BBBBB@@ADAD@D@@@BBBBH@@AB@B@FAB@B@BBDA@E@@@@BBDBB@B@@@B@BB@@B@@BB@@AB@B@@AB@BB@BB@@@B@BABBBBB@@DFBBDFBBBB@B@B@@@B@BBBBDBBBFDDBDBBD@B@@BB@@BB@BB@@@BBBDF@@@B@B@@@DB@@@D@B@B@@BB@HBDB@@B@DCFADAFA@@B@@@JBD@D@@BB@FAD@B@@@@A@@@@BAB@@@@@BA@@AC@@BABABABA@@@A@@@A@A@A@@BA@@B@B@@AB@B@@@B@@@B@BBB@@ABA@CF@B@@@BBDB@@B@B@HABAB@@AB@DABAB@@@B@@@@@BBF@BAB@B@BABCDAB@@A@@DABABABA@CB@@AB@B@B@@@B@B@BABA@@DBB@B@BBFB@@BBB@D@BBBBB@@@@@D@D@@@@AB@B@@@@@BAB@BABADAB@B@BDB@FB@@DABBDAD@B@D@B@B@B@BBB@B@B@DAB@B@BB@@B@BA@@B@D@@@B@@@BBB@DB@AD@BA@@DAB@B@@ABABAF@B@DA@ABA@AB@@@B@D@@@B@B@BABAFABAB@FCBAB@B@B@B@@BD@@@BB@@B@H@BA@@@ABA@A@@BAB@BAB@@AB@@@DB@@@AB@B@@B@@BABC@@B@B@DA@@DCDCBAB@D@BABBB@BAB@DCB@@@B@BBB@BA@@DBD@B@B@B@@AB@@ABA@ADAB@DCBABAB@DADABBB@@@B@DCDAB@BA@@BAB@B@B@BBDDFF@@B@@@BBBB@@DD@@BJ@@@BB@B@B@B@B@D@BAB@B@B@FB@@B@BA@A@A@A@@B@@@B@DB@@B@BBB@@BB@BDB@@@BABAB@DE@@AA@@BAB@BCB@DA@@B@FB@@B@DAB@B@@@BBBB@BB@BBD@BAB@@BBAB@@@B@@C@A@AB@B@BAB@@@B@B@NAD@DAF@BAB@B@@ADA@@BAB@FAB@DBB@@@@@BA@@@@B@BBBBH@FBB@D@B@B@BAB@@@BBH@B@BBB@B@B@BA@@@ADABAB@B@BADA@@B@BAB@DAB@BBB@DAB@BB@@@@@A@AB@@@@ABA@@DAB@BABAB@B@@@B@B@BC@@@A@@BA@@BA@A@AAA@@DCB@@CAAAEBA@@FA@A@ABABAB@BABABBDAD@DBB@B@B@B@B@B@@A@AB@@AB@@ABABA@@@AD@BAD@B@BDBAB@B@@@BA@@@@D@B@B@B@@@D@@@BBBBDBB@@@B@@D@@BB@@D@@@BBB@B@B@B@@A@A@@@@BAD@B@B@B@@A@@DAD@BA@@DA@@B@@@@@@AB@FAB@B@B@D@@@@ABA@A@@B@B@@B@@BBB@DBB@@@B@BABABA@@AA@@B@@@@@B@BABAB@BABAB@B@@@BBB@@@B@@@DAB@B@B@BBB@BA@@@@BA@@@AB@@@BAB@D@B@DA@@DA@AD@BAD@D@B@D@B@BAB@@ABA@AB@@AB@BA@ABA@@@@B@BBBBB@@@FA@AB@B@@@@A@@@A@@@ABAB@BADA@ABA@@@@B@@@@@FA@@B@@@B@DABABABA@@DBD@@@B@@@B@BA@@BA@AD@BA@A@A@A@@AA@AA@@A@@BC@A@@@AA@@AAE@A@A@A@@BA@A@@@A@@@A@@@ABA@@@@AAA@@@AAA@@A@A@A@A@C@A@ABA@A@@AAAA@@BA@A@A@@@C@A@@@@@AAAAA@@BABA@@BA@@BAB@@ABA@AAAAA@A@A@A@A@@A@AA@@AA@A@A@@AAAA@A@AAC@A@CA@AC@A@A@A@A@@B@@A@A@@BAB@@E@CBABAFE@@BA@A@A@ABA@@@A@@HCB@@@@A@@@@@@@A@A@@AC@AAAACAAACAA@C@@AA@AAA@CAA@@@A@@CAA@A@A@A@A@A@A@@@CAGACB@@C@C@@AA@AA@CAABCA@AC@@@C@@AAC@@@CA@A@@CA@AA@@A@A@@@A@A@A@AAA@@CE@AA@@C@ACE@AAA@@@A@A@A@@A@AAGAA@C@A@A@@@AAC@A@@@A@@B@@C@A@@BCDABA@C@A@A@A@@BAAA@A@@@@@AB@B@BAB@@AB@BAD@@@B@@@BBBAB@BABA@@B@@@@@@C@CB@@ABAB@B@BA@AB@@C@@BABCBAB@@A@CBAB@@EB@@A@@AAA@@AA@A@@@A@C@A@@@ACAA@C@@A@@@A@A@E@AAAE@@ACCAAAA@AAA@AAC@@EC@@A@C@AA@@A@CB@@@@ACAA@@AC@AAA@A@@@ABA@C@A@@BABA@@@E@C@A@@@A@@A@@@@AAA@A@@BA@AAA@AACAA@A@@AB@@AAAA@@A@AC@@@@G@A@CBA@B@@B@@@@AD@@AB@@A@C@A@A@EDA@@BA@A@CB@@ABAD@BABCD@@AB@@CB@BC@@@CBEF@@ABA@A@@BADCD@BED@@AAA@@@@@AC@@@@CA@@ACAA@@GAAAAAAA@A@AA@@@G@C@AACBA@AAEAAA@@AC@@AA@A@@BAFCDA@@@@@@A@AAA@A@@@AA@ABA@@@A@G@A@@CAAA@@ACA@@A@@@@@AB@A@@AA@A@AA@@A@@@@A@@A@AAA@@ACB@@@AAA@@@@B@FCBADABA@AD@@@BA@@DABA@@B@@A@AB@JAB@@A@@@@@AA@AA@AC@A@CCCA@A@@AC@@@AAA@@@A@@B@BA@A@@@C@ABA@A@ABE@@BA@@@C@A@@AACA@@A@@CAA@AA@@A@@@A@@@A@A@@@A@A@A@@BAFC@@B@DC@@@ABC@@B@D@B@B@DAB@B@BAB@B@B@B@B@DA@AB@@AD@BAD@DAB@D@DAB@B@@ABAB@B@DA@@@@BC@@DA@@BABA@A@@AA@C@@@ABA@@@A@A@@DABAB@@@D@BAD@B@@@BAB@BB@@@B@@DAB@@@B@B@@AB@BAB@BBDBB@@@B@B@@@@@BC@@B@B@@@B@DA@@D@D@@ABBB@BAB@DAB@@@BBB@BD@BB@@B@@B@B@@@BB@@D@BAB@@@DA@@B@DA@@BAB@BAB@BAB@B@@@B@BA@AAAAA@@AACAC@ACCACC@A@A@A@@@AA@AAC@@AA@CCA@AA@@B@B@BAB@B@B@B@@AB@D@@ABAB@@ABA@AB@FAB@D@@@DE@A@A@@AA@@BC@A@C@C@@@@CC@C@A@CAA@@A@@@AA@@AB@@A@@A@@AA@A@CAA@@@@AAAC@AAABAD@@ADA@A@@@ABA@@ACAA@@@ABA@@BA@ABABA@@BA@@@C@A@AB@@@B@@ABABABE@@BA@@B@@@@A@@AA@@@ABABAB@B@@ABC@@A@@A@@AA@@ACAA@@@A@AAA@A@@@A@E@A@AAA@A@A@@@ABA@A@ABA@A@E@A@@@E@@AACA@@@C@@BA@ADA@@@@CC@@@A@@@@BAB@B@B@@A@A@@@E@A@@@ABA@@B@B@@A@@BC@AB@@AAC@A@@@@DA@@@A@AB@@@BAB@B@B@BE@A@@BC@A@E@@AA@@@@@ADC@@@CAC@@@C@@AA@@BABABC@@BA@@B@@ABB@BBBBB@@D@@@B@@A@@@ABC@@DABAB@DB@@AD@@B@B@BABAB@@AB@@A@A@@AC@AAE@@AA@@A@BCBACCA@@A@@DA@A@@EBAA@@AA@@@CAC@AA@@@@A@A"]],encodeOffsets:[[[113686,34275]],[[114446,33932]]]}},{type:"Feature",id:"411324",properties:{name:"镇平县",cp:[112.234697,33.03411],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@ABA@A@C@@GA@AMAC@AA@@A@C@AA@@A@A@@E@@@@E@@@A@@H@@A@A@CAE@@BC@A@A@A@E@@@AABC@EAE@@A@K@C@A@@JA@G@@@@@A@@B@@E@AB@@@BBDE@A@AC@@E@E@A@AB@@C@@@CAA@C@@@@BA@@@@AE@@CCABC@CAAA@C@CAAJMA@F@@AB@@@B@B@@@@@B@B@F@BABGB@B@HAB@@@@@@E@EA@@A@C@A@A@AAA@CA@@A@A@C@AA@@@A@@AAC@AAC@A@CBA@A@AF@DCF@DCBADADCF@@ABABAB@BABAB@@A@AACAE@C@A@C@G@@A@@@@I@@BC@E@A@@EA@C@A@C@A@GBG@A@A@AAEAAA@A@@@A@AA@AAAC@@@ABA@@@CCAA@@@A@A@@B@D@@C@AB@@A@A@AA@@AA@@@@CCAA@@@AEC@@C@DFA@@DFB@BB@@B@@C@@BB@B@BDBBBBB@@D@BBFBF@B@D@@@@ABAB@@A@A@G@ABA@@BA@AD@B@B@B@B@BBD@B@DABADAB@D@@AB@BCAA@E@CCIAA@@@@DA@A@@A@CAA@A@@@A@@CACA@AC@GFCDC@AA@@AFA@@@A@@BA@@BADFBCDE@@A@C@@@@A@A@AA@B@B@@@@C@@@AAA@@@CLPDD@@D@D@@B@FBBB@@A@E@@@AB@BD@B@@@@D@DB@@@@D@F@@@@AB@@@B@@@D@B@@BBAF@B@@AF@@AB@BA@CB@BA@AFAD@B@@EBCB@@ADA@@@DDDD@@BBB@FBD@@@@F@@B@BBDDBB@B@@EBA@@@@B@DBDBB@DB@@BB@@B@BDBH@EDAA@DAB@@DBD@@@@F@@@BAB@B@@B@@@B@B@B@D@@@@@DDB@BBDD@@BBBB@@@BA@@@@BA@@@@B@B@@AB@@AB@B@@AB@@@@@B@@@B@@A@@@ABA@E@A@CA@@@D@JAFHB@@BBB@@F@@@@A@I@@D@@B@B@@B@H@DD@@BA@A@@B@B@@E@@H@D@@A@@@ABAB@@@BCBABABA@@@A@@@QFA@@@@@C@A@AB@@ABA@@@@DDAABC@A@BBBBB@@B@B@FB@@FBD@@D@AAJCFA@CH@BA@B@@@BBB@BBB@B@BBB@B@B@B@@@BA@IBGBA@@@@B@DDJ@B@D@BBB@F@@B@DDBB@BB@@DBB@DAB@B@B@B@B@@@BA@@DHFBB@@CBABF@AB@@@B@@D@LBBB@B@BBB@B@@@D@D@B@B@DA@EF@@BF@BFD@@BBABA@AB@@@FAF@BB@@D@BBD@BBBBB@@JBB@BD@@@@BB@@BDBD@@@B@B@@@BAB@B@D@B@B@B@BB@@@@BBBBB@@@@BAB@@@B@BB@@@BBD@B@BAD@B@BA@@@ABAB@@@@BBB@@@@B@BB@@BD@DBB@@@@@BAB@@A@@@@FDB@B@B@@@@AB@@@@@B@BBBB@@BA@@@@B@@B@@B@@@B@BABAB@@@B@@@B@@@BB@B@BBBBF@@@B@B@@@BBBFDHDDB@BF@@BBB@BB@DD@@DB@@ABADAB@@@BBBB@@BB@@BBBBDBB@BB@B@@@@B@BDDHB@@@BA@BBDFB@@F@@@BCB@@@@@@@DB@@BBD@@ABA@A@A@@@AB@BAB@@A@AB@@ADABABAB@@@BABAB@@AF@BBD@@BBDBDBB@DAB@FB@BBAF@B@@@BB@@F@B@BB@B@@B@BBDB@@B@@@B@@ADBBABA@@BBB@@B@BB@B@@@@@BABA@AAAB@BADA@@B@B@@@@B@@@B@B@@@B@BBD@@B@DDBBDB@@FBB@DBDD@@@@AB@BAB@B@@BBBB@B@B@@BBB@@@BAB@@@B@@@BD@@B@FCFAFAD@B@DBB@@@BB@B@BB@@@BB@@@B@@@B@@A@A@ABA@AB@@@B@@BBB@B@F@B@@@@@@B@@@B@@@@FBF@@BB@@BBB@DBD@BBB@D@@@@BBBB@BB@BAB@@AD@B@BAB@BA@@BBB@DDB@@@B@BAB@BB@@B@@@@A@A@@@@B@BA@AB@@@BA@@H@B@@@B@@BBB@@BA@@B@@@DB@BA@@BDB@@@BA@@@@BB@BB@B@@B@B@FD@D@@DB@B@@@B@F@@B@HBBBD@BB@@@ABCBADC@ABA@ABAB@@AB@@AB@@@B@BBBB@@B@@@D@B@@@BBB@BB@@F@@@DA@@B@@@B@BBBA@@DB@@BCB@@@@@AA@CAAAAAACAAA@A@A@A@@F@B@BBDFHD@@@@@A@A@A@IAE@@BA@@@@AACA@@@A@A@ABABA@A@@AACA@@AA@@DCB@@@@ABCBA@@@A@A@A@A@AB@@ABA@@@C@@@A@@BC@@BA@ABA@@@C@A@C@AAAAA@ABA@@@A@@@@@@@A@@CC@A@E@@@A@A@@AA@@@A@@BABA@ABAB@@A@@B@BBB@FC@@@@@AAA@@@@@A@@BABA@@@A@AA@CAAAA@@@AA@A@@BA@AB@B@D@B@B@DA@@BA@A@AAA@A@@@A@@AC@A@A@@BB@B@@@BBB@@B@B@D@@@B@@AA@AEAA@@B@@@BBDB@BBF@@BBBBB@B@@@@@BA@@@E@@B@FAF@B@AC@@@A@A@A@@@A@AAA@@@C@A@@B@@AAA@C@@@@B@@@@BBA@@@@@ABA@@D@@@@@@A@A@C@@@A@A@@@@BA@@@@@@CA@ADE@AB@@@@@B@DBB@@BB@@DB@BBFDBBDB@BBB@@@@B@B@DAB@B@@@@@DB@@@@BCB@@@BADB@@BA@@B@BBB@BBB@@A@@BC@AAA@@@@@AB@BABA@@DE@@AAB@@AB@@A@@@@CA@A@@@@@ABAAAAA@@@A@@BA@@A@AC@A@@@@@@BABA@@B@@@D@BAB@DADCBAB@B@@@@@DBB@FB@@D@D@@@@A@@B@B@BAB@B@DA@@B@DAB@@ABABA@@@ABAA@AAEAA@AA@A@A@A@E@C@@AA@AGE@@BADE@A@@IEAAAAA@AAC@@A@@BABAACB@@@@A@ABA@@@A@@@@@A@@@A@C@@@A@@@A@AA@@A@C@@AABA@@@@@AAA@E@@@A@@A@@AAA@A@@@@@CB@FAB@B@BAD@@@B@BA@@BABABC@@@AA@BG@C@CBA@@@A@CAA@AAA@A@@@@B@DCBCB@HEHCBAHABAD@B@B@D@B@B@DBB@B@@@BABABA@AB@@ABA@ABA@A@A@A@ABA@A@AB@BADADCBA@@@AB@DCBADAD@BABABABA@A@ADABA@ADAB@LADABABADA@ABA@A@AAAA@CA@A@@AAA@@@@AAA@@A@A@CCA@@@@A@ABA@A@AB@@A@@BA@ABA@@@A@@@A@@@AA@@@@@C@A@A@A@CBA@AB@@CB@@AA@@AA@@@A@ABC@AB@@CB@@ADGBA@@@A@AAAAA@A@@@A@@@AB@B@B@BAD@D@B@@@BBBBB@B@B@@@B@@AB@@A@AAA@CAC@@ECAAAAA@@A@A@CBCBA@CB@@A@A@A@@BC@A@AD@@@@ABABA@@@A@@@A@@AAAAA@A@@@ABAAA@@AA@@A@A@@@A@@DC@CBABC@@@@@A@AAAAAA@AAC@A@A@A@A@A@@AA@@AAA@AAC@A@A@ABA@@@A@A@@@A@ABA@@@ABA@@@AAA@A@@AAAA@A@AA@@A@@A@A@AB@@@BA@@BA@@AC@@@@B@@A@A@A@AB@@A@@A@@C@A@@GD@@A@C@GAC@A@E@AC@@CC@@DC@@AA@@@@DC@@BE@A@ACABA@@@@@@B@@CD@@ACABCE@@BDBAAADACCA@@A@@CAA@@@@CBB@CAA@A@A@@@CC@A@BFAB@@@D@@A@A@ADBBB@A@@@@B@B@@CB@A@@A@A@E@@DA@@PC@@AA@@BA@@FB@ADAA@AA@@AA@AA@@A@A@@AAAC@IB@AE@@B@D@B@@A@AE@CA@@A@@@C@II@@@A@@@A@A@@@@@@@A@A@@@A@AAEA@A@@BA@@AABA@@BA@@BA@A@E@@@@CABACAA@BA@ABA@@@AA@@A@@BCC@@AA@@AD@@C@@B@@@@GA@BAB@BA@A@@EABEEAABCA@@EAC@@BAB@@@@IGBAA@@@A@A@AB@AA@@BA@@@AAA@@@E@A@@@@CB@@@AA@AA@@B@BA@BBAB@BA@@B@@AB@@BEA@AB@D@@@DCA@FB@@@BB@@@@AD@@E@A@A@@@@E@A@C@AC@BCABCB@@@BAD@BA@@@AA@@E@@@CC@AA@A@E@A@@@@@@@@@E@EAAAA@CAEAA@@G"],encodeOffsets:[[114940,33654]]}},{type:"Feature",id:"411325",properties:{name:"内乡县",cp:[111.849392,33.044864],childNum:1},geometry:{type:"Polygon",coordinates:["@@BABABCB@BC@AAC@A@@@A@A@@@@@AAAAAAA@AA@CAAAA@@AA@AA@AAAB@@A@@@AABA@A@A@A@AAABAB@@@B@B@@B@DBBB@@@B@@@BADAD@B@D@B@@AB@@A@CBA@AB@@A@ODAB@@ABCDAB@@@B@B@B@D@@A@ABGDAB@BAB@@CB@BAB@BA@A@@@E@A@@@A@AB@@@BDB@B@@CBCBABEDC@GFABA@@BC@CBA@A@@@A@@A@A@@@AAACCA@AA@@E@A@@AA@@A@@@@A@CB@@CDADCBCBA@AB@B@@ABCBAB@@AB@B@@BDBB@@@BA@@@GBA@CBA@@BAD@@@B@@A@C@CBC@A@@B@@ADABAB@D@@AB@@AAA@AA@@@@@@@B@BDDDD@@@@@B@@@@DBBBB@@@@BBB@@B@DB@@B@@D@DBBA@@BA@CBEDCBCBQJCF@@EBA@@@@BBDBBDFBB@BB@FDBB@B@@AB@B@@ABA@@B@@@B@@ABABA@AB@@A@@BE@@B@@ABEF@@@@@@BBB@DD@B@@@@CFA@@BA@A@@BABCB@BA@A@CBA@@@CAA@A@@@AB@@EF@@@B@B@@@B@@BB@@@BABAFAB@@@@@B@@D@BBB@@D@@BB@@@B@B@@B@@BD@D@BAB@@@BB@B@@@B@B@@@BB@BB@@@B@@@B@@@BB@B@BBBB@DBB@@@D@B@BAB@B@@@@AB@BBB@BAB@B@@BBB@DDDB@@@@@@@BEDABCBEBABA@ABED@@A@C@@@@@@@@B@@@B@@@BADA@@BC@ABAB@@@BB@BDBBBB@@ABCF@BABA@B@@BDBFBB@FADA@@BC@@@ADBBA@@B@@@@@BA@@AA@AA@BA@A@@@ABABA@@BBB@@B@@@B@@AD@@@BBBBBDB@@B@B@@@B@@@BB@@A@@B@@EBA@C@@B@@CBCDABABC@@@A@CFAB@@@@@BBDBD@DBB@FBF@@@BB@@DBBBD@@@B@@@@A@A@@@AAAAAC@@AC@@@AA@@@A@@@A@AB@@AD@@ABABC@A@@@A@@@A@A@@AAA@A@@A@A@@@A@AD@@@B@BBDBD@B@@@B@D@B@BBB@BDD@B@BB@@B@@BB@D@@BB@B@@A@@BA@A@A@A@@@ABA@A@C@@B@@@@@DBB@@BBB@@BB@BBB@DB@@BB@BBB@@@BAB@@A@ABA@A@ABCDABEDABAB@@@B@@B@FB@@FB@@BB@@BDBDBDDB@DB@@H@B@@@BA@@@@BB@@BB@D@B@B@@BB@BD@BB@BA@@B@@D@@ABA@@B@@@@BBB@@B@BB@@BAB@B@@DDDBBB@@B@D@DBDBB@@@BB@AB@@AAC@A@C@@@@BABAB@B@@A@@@@@CAAA@@A@@@A@ABABE@ABE@A@@@A@ADE@@AA@@AA@@BA@AD@BA@@@@@AA@BA@ABA@AB@BAD@FAB@@@B@B@D@BAB@@@DB@@@@BD@@B@@BB@HABAB@BBBBBB@@B@@ABA@@BA@C@@B@D@@@F@B@@AB@@A@CA@BA@A@A@ABC@A@AAA@@FA@AB@@@BA@@@@BA@@D@BBBAB@@@B@@ABC@@@@DA@@DB@@@@@ABA@@BCBC@@@AB@@AB@DAF@D@B@BBB@B@DFB@B@B@B@B@FA@BBB@@B@BCB@B@@@BBBDDB@B@BDBB@@@@D@@@@DB@@D@D@F@B@@B@@A@@D@@BB@@@@@BA@@@C@A@@B@@@@BB@@@@@D@B@B@BB@BBB@BAB@@AD@B@@@BD@@B@B@@@@@@B@@B@D@B@B@@A@A@@@AB@@ABA@ABCDB@@@A@@B@B@@@BB@@@BBBDD@@BDA@ABAB@@@B@B@@@@AB@@BF@@B@DBDBD@B@BBB@@@DAB@H@D@BBB@BB@@B@@DB@BH@D@BA@@H@B@B@@B@@BBD@D@@BB@@BBFA@@@BCB@@@BB@DDABADB@@@BB@@BF@BBD@@@B@BA@@BA@ABABA@A@@@BC@@CAA@ABCB@@AD@B@@@BA@@@C@@@AAAA@AAA@BA@@@AB@@ADABAB@@BB@@@D@@BD@D@@CD@B@@@@BB@@@F@BAD@@@BAFA@A@A@AB@@A@@B@B@@CB@@@@@BBD@BA@@BAD@@@BA@A@@@AB@B@@@B@F@@@B@BA@A@A@AB@@@@@B@@DD@@@@CB@BAB@@@D@@DBBB@@@F@@@B@F@BAB@B@BAB@B@@@B@BBB@B@B@F@B@@@BBB@B@B@@BBBD@@BB@@@BB@@@AD@BA@A@ABAB@B@@BB@@@B@@A@@@AB@@AFABAB@BA@@@A@@B@B@D@@AB@@ABAB@BAB@@AB@B@@BBBD@@AB@B@@@BCB@BC@ABBB@BBDBB@@@@BB@D@BBB@@@BB@@@BA@@BB@@B@@@BB@D@B@DDD@@@@@D@D@BAD@@BB@@@B@BCF@@C@A@EBA@@BAB@BA@AB@BC@A@@BA@A@A@A@ABA@A@@@BBB@DDB@@BD@BBB@@B@@@B@B@BDDDBBDD@DBBB@@BBBB@BABA@@@A@A@ABA@ABA@AB@@CBA@@@CB@@A@ABC@@@AA@@A@A@@@@AA@@AACA@AA@@A@CBA@ABA@AA@BC@C@@@CBA@@@A@A@@@AD@@@@A@A@@@A@CAAAA@ABA@@BA@A@@@A@CB@@@A@@AAA@AB@@A@C@ABC@@@A@ABCB@@@B@B@@AB@B@@@DBB@@@BABAB@@CB@@AD@@@@CBA@A@AB@BA@A@CBC@A@CBC@ABC@@BA@@BCBA@A@A@A@A@ABA@A@CBA@A@C@A@@@AD@B@@CDA@@@EDAB@@@B@B@B@@@B@B@@@B@@@BB@@BBB@DB@@@DBBB@@@B@D@@AB@@AF@B@BAB@B@D@@@BABA@@@@B@@BB@B@@BD@@@BDBDDB@D@@BBBB@@B@@@@@BA@IBA@@B@BA@@@ABCB@@AB@@C@@BABCBABEDA@@@@@BB@B@@DA@BB@BBB@@@@B@@B@@@BBB@B@@BB@A@@B@@@@@BB@BD@@BBDB@@@B@H@B@@AB@BBB@@B@B@BBB@@@@@@@CBEDAB@@@BBB@@BD@@BBFBBBB@DABBD@H@@@B@@B@BBBBBBBHB@@BBBD@@DB@@@@BD@@@@B@BB@@FC@ADCBC@AB@B@BA@@FEDA@@D@@ADA@@BA@@DCBA@ABCBA@@DAB@B@@AB@FCB@B@D@B@@@BA@@BC@@@@@AA@B@DAB@H@@@@@BDB@@@BBBB@@BA@@@BBBBD@BBB@BAB@@@BBB@B@@B@@@@B@@@B@D@F@@ABAB@@@B@DAB@B@@@BBB@BBD@@BBBD@@@@DAB@@@BBD@B@@@FD@@BD@BBB@BBBBBDD@BF@BB@B@F@B@B@@@BD@B@DB@B@@@B@D@B@@@BBB@@BB@BB@@@FA@@BADAB@@@BADABA@AD@@@BAB@@A@ABABA@@DAD@@@@@@@@AB@BA@ABAAA@A@@@A@@BC@ABA@@BA@A@ABA@@@@B@B@BB@AB@B@B@D@B@BADC@AB@D@@@@AB@@@B@D@BB@@B@B@D@B@HBBBB@@@@B@B@B@@BB@BDF@B@DB@@BDF@@BB@B@B@B@B@@@B@BB@@BDB@@@BDB@@D@BB@@@D@@BDB@ADBB@DBBB@@BD@D@@@DAHBDB@@B@B@B@B@B@B@B@DB@@@B@@BB@DBB@BBB@@@DBBBDBBBDBB@BBD@@@B@B@@@@@@@B@@A@GD@@@B@@AB@B@B@BAB@@EFABAB@D@FA@AB@@@B@BA@@@@B@B@B@BBDB@@D@BBD@B@BBBBB@@@B@BBB@@BBB@@@@B@B@B@BBBBB@BABD@DBB@BBB@B@DBB@BBB@B@D@@@D@D@@@D@BAB@@@DAB@BBBA@BD@B@FBB@@@BA@AB@D@D@F@@@B@B@D@DAD@BAB@BCB@BAB@B@BAB@B@B@D@B@B@@@@@BA@@@@B@B@B@B@@@DA@@BC@@D@@@BA@@BA@A@A@@@A@A@AB@BA@@BCBA@@BA@AB@BA@@BABA@A@A@AB@BA@AAAAEACAA@@@AAA@@@A@@BA@AA@@A@ABA@A@AF@@@DA@AB@B@HAHADC@@BAB@B@B@B@BAB@F@D@BA@@BABA@@B@D@@A@@@@@A@@AAA@A@ECEAAAABAA@@CA@@AE@@@@BAB@BAB@BAB@@@BABCBC@AAIB@@AB@DABA@@BA@A@@@AB@BADC@AAABABABAB@B@B@D@D@@@@@@@@A@A@A@A@ABAB@FAB@B@B@B@DA@@@@@A@ABA@@B@@A@@@@BAB@B@@@B@@@@ABA@@DB@@B@B@BAB@@ABCB@DA@@BABAB@BA@A@A@ABA@@B@@@B@@A@@@A@@B@@ABADABABC@A@G@A@A@C@@@A@AAEAC@A@@@AB@B@@@BABCB@BA@@@AAAAAAA@A@@@ABABCB@BADCDC@AB@@CBA@ABABABA@ABEBE@ABAB@@@B@@BB@B@BA@@@@B@DBBBDBDBDBHBBB@@B@B@DA@@FABBDBB@@@@B@B@@B@D@@@BB@@DD@FBD@@BBB@@@@A@@BA@@@@B@@@B@@@@ACC@E@A@A@@B@@AD@@@@A@ABC@@BEAA@AB@BCB@@ABE@@@@H@B@@ABABA@AB@@AAA@A@ACCFGDEDE@@BB@@@@@BBB@@@@@@DBDAF@BBB@BBBA@@BAA@@AAE@A@C@@@A@@D@@CI@AA@I@A@@@A@@AC@@B@DAHAF@B@B@D@@@B@B@@BB@@@B@@@@AAA@@BA@A@C@@B@@@FAB@B@@A@ADA@A@CAA@A@@BA@ABA@@B@@@BAB@DB@@BAB@B@D@@@BAB@BBB@FA@ADA@@AC@CB@B@@A@@BA@@@C@ABAD@B@BAB@B@@@FBB@B@BA@@@A@ABA@E@@B@DCJCHEBA@@B@AA@@@@@CAA@AAC@CAA@AA@@AE@EA@@@@@A@@@A@@@@A@E@A@A@AA@@@A@@BAB@BAB@B@@@@A@@@A@@AA@@A@@A@AAA@@A@CAA@C@EBEBEDA@@@AC@@A@@@A@AB@@A@AA@@@A@AAAAA@@@ABA@ABA@@@@CCCAA@EA@@CAAACCA@@@AC@A@A@@@A@A@@@A@@A@A@@@CBABA@BB@BABAB@@@@A@A@@A@AA@AA@@ABABCA@BA@@@A@@@CAAAA@@@@AAAA@E@@@AA@@A@E@AB@AEAA@CBA@CACAAA@@AC@ABE@@BABA@A@@BABABABC@@BAB@@@BA@ABA@@B@B@B@BA@@AC@AA@@C@@@@@@DA@A@@@EA@CEAAB@@A@@GACC@A@A@@A@A@@AAAACAA@AA@@AA@AA@A@@BABCBA@@CA@@CCA@@AAA@AE@@ACAGCECAA@A@@@A@A@@AEAA@A@AAA@@A@@@A@@@A@ABABA@@@A@@@@AA@@@@@AB@@AAAAA@@@@@A@@B@@A@A@A@EC@@@@@BA@AB@@@@A@CAC@@AA@@A@A@@A@AA@@@@BABA@@B@@A@ABC@A@AAC@A@@AAA@@@A@AB@@@@AAAA@A@@A@@A@A@A@A@C@ABA@A@@@A@A@@ACAC@@AA@@@@ACA@IA@@AAAA@AAC@A@CA@@ABE@E@@BAB@BAAA@@EC@AAE@@FEB@@C@A@A@C@C@@@AAA@A@AAAKAC@@@@A@@BAE@BADA@@AAGE@CB@@A@@@A@A@A@ABA@CAA@CA@@AAACCA@@@@EAA@A@C@ACI@C@A@@B@HAJAB@@A@@@A@A@AAA@A@AAA@AAA@A@@@AABG@@DEBIDBBC@@@AC@EA@@E@A@AA@AAAAB@D@BACB@C@@B@BA@@BAB@D@@@@@B@RE@@B@@@B@BABADA@A@@BABA@@B@@@@C@GF@@@@A@AB@B@@AC@@C@G@AA@A@@@@CJ@B@@@@@@EA@AA@@GABE@I@C@@DBB@F@B@BA@@B@@@@A@@@A@@@@BA@@@ABA@@BA@@@A@A@@B@@A@@B@@A@@AAAA@@CCAAA@CC@@@@C@A@A@A@@@A@@@@ABA@A@@@E@@C@CA@@BA@CBBFCG@CA@A@AA@@AA@@CAAAC@C@A@@B@FA@@@AAACCAAA@@@@E@@C@EAA@AA@@CCCC@@C@CBA@@BABCDCDAB@@CBGBEDC@@@E@C@C@A@@@A@@@@@@BB@B@@B@@@B@@AB@@A@A@@@@B@@@BAB@@A@@BC@I@@DA@AB@AA@@@@@A@@@@@ACA@@DA@@@C@@BE@@B@@@@A@@@I@C@A@A@A@@B@@AB@B@BA@@@A@A@A@ABA@@@@@@BA@@BB@@B@@@@A@C@@@@@@@AB@@@BKCA@AAB@BA@@BB@@BG@@@AA@A@EC@AA@@A@@BA@@BAFGDCB@DEB@@ABC@@BE@A@ABA@@BAB@@A@E@@@CA@@ACCECGE@@E@A@C@C@C@ABGBE@A@A@A@CB@@A@A@EAGC@@AA@AA@A@@AA@@@CCCCA@A@@@AAA@ICGAQ@G@@B@G@ABG@C@E@A@@@A@@B@@@@@A@A@AAA@@@AAACA@AAAAC@@A@@@AAC@@@@A@IAA@A@CCAA@A@@@A@C@A@E@ABA@A@ACC@@@C@A@@AA@@AACAC@CAA@A@@A@@@A@@B@BA@A@E@E@C@AAA@AA@CAECEAA@@AE@@BA@@B@@@BAB@@AB@BADABAD@B@@@B@B@F@BA@@B@B@@ABABAFADA@@@ABC@A@@@@AACAAAA@@A@AAA@C@AAAA@ACEACAAA@@AC@AAC@@AA@A@C@A@AAAA@@AA@@@A@C@E@CAA@@CAAAAAA@ICEAAAC@@@@AAAAAECAA@@@A@@BCB@@@@@B@D@@@@@@@BABCBC@ABA@ADG@C"],encodeOffsets:[[114421,33630]]}},{type:"Feature",id:"411326",properties:{name:"淅川县",cp:[111.490964,33.13782],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@FDFBFBLHHLDFCNAFCL@DGLAFCFEJIHKJABMHCNFLAJEFCBCBABQBE@C@OBCBI@Q@YB@@K@GAEACAOEGAGAIF@NFHNJJFJFHBNBHBNFHDBH@FCLADEHEFMLAD@DADBD@BBDDFAD@BGDEDGDA@A@A@CDABA@@@C@A@MFUNEDABIHAB@N@BAB@BA@AB@@@@@B@B@@@@BBBBBB@B@B@BA@GCCAAAAAAFGDKBIFINABEJGFCBGBO@MAAAGCIECAEEGGSSIAEHPTBBLLLLAHALADGLIDGF@@QFAB@@CF@DCDEFA@A@ABA@C@ABABADAB@BA@@DAB@BADABABABC@A@A@@@ABCBA@CBA@CBABADEFOVD@DAD@BABCDA@D@@B@BD@@ADCDEFABCB@DCDA@@BCD@B@@BB@@BBHB@@DB@BB@@@ADABBB@BDBDBBBBDBD@B@B@B@BAF@F@B@BB@BDB@J@HDANE@IFCLFJFBXBDBRHFJLED@@@DBFBHADBFBBBB@@B@B@BBD@BDFB@B@D@BBB@@B@BAFAB@B@@@BHBB@BBBBB@B@B@B@@@B@@@BBB@B@@@@BBD@@BBJ@F@@@B@DABABG@@DAD@D@BABADCDABAHAF@B@FBB@A@@@C@A@C@ABABA@A@@B@@@@BDAB@@AB@@@B@BB@@@D@B@B@B@B@B@@@@AAEAE@@B@@@B@DFDBDBB@B@BDBB@D@B@BB@F@B@@DHFB@B@BB@@BD@B@B@@@@B@@BBAFGB@B@DBD@@@B@BABAB@BAA@CEEG@@EI@A@AB@BAB@B@LDB@DE@AACCG@@@A@ACAEAAA@AA@A@EBA@A@@@BD@@AFA@C@CA@@@@@A@@@CAE@A@@@AAA@A@A@A@@@@@@@@D@BB@AB@B@@ADA@@B@@BBBBBBBB@B@BBFD@@B@BAD@@@B@BDBBHDBBD@@@B@FAFDJDDBB@BD@@D@FAB@B@BA@@B@BA@AB@@@@ADA@@BC@A@@@@BA@@A@AA@@@AAAB@AAAC@@@AAACAAA@A@@@A@ABAB@@A@@@AA@A@@AA@@A@A@A@@AA@@AA@@A@@@AAC@A@CAA@C@CAA@@@BA@@B@@A@@@@@@B@@@B@B@BAB@@@BBB@@@B@@@@AB@@A@@@A@@@@BA@@B@BB@@B@@A@A@A@AB@@@@ABB@@BD@BB@@@B@@@@ABA@@B@BB@@B@@BB@@@B@@@B@@@B@@@B@BB@@BB@@@@@A@C@@@@BA@@BBB@D@BA@@BB@B@@@@B@@@@@@@BA@A@@B@@@B@BB@@BD@@D@@@DAB@@@B@@B@@A@@B@@B@@@B@BC@@B@BAB@FAB@D@HBB@DABADABA@@FA@@@@@A@@@AA@@A@@DA@ABA@A@A@@@A@A@@@A@@BABABA@@AEBA@A@CBC@@@AB@DB@@B@BAB@@@B@@DB@@BB@@D@@@@BAB@B@BAB@FEDC@AB@DADA@AB@BAB@@@BCB@@AB@@AB@@@B@B@@@BB@@@@F@BA@@@AB@BC@A@@BBB@@@@A@AB@BABA@@DAB@BA@AD@FCD@@@B@D@DBB@@@B@F@B@B@B@BB@BBB@B@@B@B@F@BAFCBAB@@@FBB@B@BAB@B@@@D@HDF@@@B@BDBF@@@D@BBB@@BB@@HDB@@BB@@B@B@B@D@@@BB@B@BB@AD@@@BA@@BA@@D@B@BAB@B@B@@ABA@ABADCDCBABAB@B@B@B@D@B@BBB@DFDFB@@D@@@BBDDB@@@@BBB@B@B@JABA@@DCBABA@ABC@@BCFC@ABA@CB@@A@@B@@@D@HBBBB@@AB@BCBA@A@@@A@A@A@A@A@A@@AA@@@AB@@@B@B@B@BAB@B@DBBAB@@AB@@A@@@AAC@@BA@@BA@@@@DB@BBB@BBBBB@@B@D@@@B@@@BA@@B@@A@@AACCCAGAAA@@@@@C@A@@@AB@BAD@B@@@B@BB@@B@B@@ABA@ADAB@BABCDC@@B@D@@@B@@AB@@@F@BAB@FABABAB@B@B@B@@@@@BC@E@AB@BA@AD@@A@A@@BABABAB@BA@@B@@BB@BF@@B@@@@@B@@@@A@A@@BA@A@@D@DABABA@A@EAA@AAE@A@@BA@@D@FAFBD@B@@BB@@B@B@DAB@DBB@@BD@D@@B@@ABCB@@@D@B@D@@@BB@@AD@B@@B@D@D@B@BB@@B@BAFABABA@@@@B@DBJDDBB@@@FAFAB@F@@@BBB@B@B@DABAFA@@FADADA@@B@H@DABABA@ABABA@A@@AC@@@@DE@@DA@@@A@CBABABABA@@@@AA@A@A@@@@BC@CBA@@BABA@@B@@@F@B@B@D@B@B@B@BA@@BA@AD@BABBBAD@@ADABAB@BAB@BA@AAAAE@AAC@@AA@@A@CACAC@A@@@AACACC@@@ABA@AA@@A@AA@AA@@@@@AB@BA@@@CA@@@ABA@@AACA@@AA@A@C@A@@AA@@A@@B@@A@@@A@GA@@CCAACACAC@@AA@@EA@@EAA@@@@A@@BABAFCBADCBAB@B@BAB@@@BA@A@@AA@AAA@@CAA@AAA@@AA@AA@@AA@C@@@@@AD@B@B@BA@@B@B@B@B@@AB@@@@AAA@@@CAA@@@AA@@A@ACC@AAA@A@A@C@A@@@AACAC@A@A@@BCB@@@B@B@@@@BBB@BB@B@@@B@@@B@D@BABA@@BC@@BAB@@@B@@@B@@B@@BD@@BDBBBB@@B@B@@@@@@A@@ACAA@CA@@A@@AE@EAA@CACAC@A@@@@BADEB@@@D@BABADCDA@@@AD@B@FA@@@AB@@@AA@@A@@@A@A@@@CAAAAA@A@@BC@@@A@@@AA@AA@@ABAB@B@@@BABB@@BBB@@AB@@@@A@@@ABCA@B@@AD@@CBEBA@EACA@AA@B@BA@ADEBA@@AAAAACA@@A@@BABAD@@AB@BC@A@@@A@@@A@@@@@@D@B@@@FCBAB@BAFADABAFC@A@@@@@@CACCA@AA@@@ABA@AAA@ABA@@@@@ABA@A@A@C@@AA@CAAAAA@A@@A@@@A@@@A@@AAA@@A@@@A@A@@@AAA@@A@ABC@C@@AA@@@@A@A@@AA@@@CA@AAC@@@@A@@@@BABEBA@A@@AA@@@A@@@A@A@@FE@@BA@@B@B@DB@@B@DAB@B@@ADABA@AB@B@@AB@DE@@@@@ACCA@AA@@@@@@FEBA@@@AF@@AB@@@BAB@BABA@@@A@@@AB@BA@@@ABA@@@AAAECA@@AAACEAAAC@A@@B@FA@@DERIDADAFCDAB@@AB@AA@C@CA@@@CAA@@@AA@A@@A@AACA@@@@@A@@@@CCCC@A@A@@@@@@BBB@BB@@BA@@@CBABABC@@@AB@D@DAD@B@@@@A@@BC@AB@DAB@HA@@B@@A@@AAAC@@@ABA@@BADABA@@@ABAB@DADABCDC@@DAB@@@@@@BB@@BB@F@@@BBB@DDBB@B@@@B@BB@@@B@B@DAD@@AB@BAHED@FCBADADA@@@ACA@A@@BAB@@@B@F@@@B@B@@ABA@ADA@@BA@ABAHCBAB@@@@C@A@A@A@@BADCBA@@BAPCB@@@BAB@DAB@@@BA@@@A@C@ABCBC@A@@@A@@AACAA@@@@A@A@@BABABBB@B@B@B@BA@B@@@BA@BB@BBBB@@BB@BBDBB@@BBBBBBB@B@@@@@B@B@@@BBD@BADA@ADABAB@DCH@BAB@BADADAB@@@@@@C@A@@@@@A@AD@@@B@@BBFDBBBB@B@@D@BBFBJDB@BBBBDB@@BB@D@F@D@B@@BB@@BBBBB@D@B@B@@BD@BBD@@BB@BBBDDF@BBBBBD@B@BBB@@@BBBBBD@B@@B@D@BA@@B@BCBEBABA@@@A@AB@@A@E@A@A@@@ABCBABC@ABA@@BA@A@@@AB@@AF@AE@AA@BAAA@C@@BABAFGBAD@BCC@@@AACAAA@C@ABABAB@@@@EA@BC@C@A@ABCBE@A@A@@BA@@DEB@@@BA@@D@D@DBD@BC@C@@FADAD@@AB@B@B@BA@@BADADABA@@@AB@@AA@A@EAA@@@BCAA@@HA@A@@B@ACB@@@D@BABC@CBB@BB@B@@G@C@A@A@@B@D@DE@A@CBC@EBCCA@A@A@@A@A@@@@@@@B@@CBB@@HAIC@ACABECA@EE@BA@EB@@CB@@A@@@AAA@A@@@A@@B@B@@B@@@@B@@B@AB@BE@ABC@AA@CCAAC@@@AA@@@@AAA@A@@@@AA@@CC@@@AAG@@CC@@C@C@E@@B@BAB@@@B@BBB@@AB@BADABCBA@@@ABC@A@@BAB@@@BA@@BA@ABCB@B@@@B@BB@@B@BADC@@BABAB@@@B@B@BBB@B@BA@ABADAFC@@@CB@@@@@DB@@B@B@B@@A@@@ABAB@@AAAAA@A@@@A@ABC@ADE@@BABADCBA@@@@@A@@AACAAACA@AA@@A@@@@A@@@@A@A@A@@ECC@AEE@@AA@IB@@A@@@A@ABABA@@@@CBC@@ACA@@A@@A@@@@C@A@AD@@@@@BABA@@AC@AC@@AC@A@A@A@A@@@@B@BA@A@A@C@@@A@@BAB@@C@CAC@C@@@@B@@G@@@@AA@@@AAAA@@@@AABA@@E@A@@BA@BB@BC@AA@BC@BFC@@@@B@BA@@B@@A@@@@@@A@@AA@@AB@@A@@BA@BB@@A@@@@B@B@@A@A@@AAA@A@@@@ABB@ABA@AA@A@A@@@A@A@A@@GAACA@EACCAB@BA@@B@@I@@@G@C@A@@@AA@@ABCBC@A@C@ABC@A@@@@CCA@@C@E@@BEBCBIBA@AA@AA@CBC@CBC@@@C@@@A@CB@@E@ABA@C@ABA@A@A@@AAA@A@E@@AAA@@AG@AA@A@@@@D@@@FA@@@@@@@A@@@ABA@ABA@A@@@ACA@A@@@@@ABAB@@A@@@@@A@A@@@AA@@@CBA@EB@@BE@A@A@AA@AAAAC@@@AA@@@@@@C@A@E@A@ABA@@B@BA@ABADA@EBC@GBE@A@EAA@@@@BC@A@E@A@CB@@CA@AA@BAAA@@AAAAABAA@@AACA@AA@ABC@CB@@@B@B@@ABAAAA@@C@ACA@BADAB@@@AACACEAA@AC@@@C@@B@@CNMBMGMECAOSGGEGMIOGCCGEKEEBC@MFMHKLQNQPKNCRAFCNDNABORENABAFCDKHKFEBABA@CBEHEDEBEBABCDAD@D@@@BEDCBEBAD@DD@DDBF@@@DADIACBAHEBA@C@AAG@E@ABIAGBC@CBABEFCBCBCBCD@@@BBDB@D@DADAB@@ABABAFCFCB@B@B@@BAB@BEDAB@@@B@B@B@BB@B@D@B@@AB@DEBAFCDAFCD@BA@BB@BD@DDB@BDDBDDBBB@B@B@B@B@DAB@D@FBDBBBBDBB@BB@BBB@B@BABABABEHAB@DABABA@CDABA@@AA@@@@A@A@C@CBA@@BC@AA@CCAAC@A@A@A@A@ABE@CA@@AA@A@@BE@@@AACCCA@CAG@C@A@A@@BA@A@@BA@A@A@A@ECA@ABA@ADABC@EDABAA@BAD@B@BABAH@B@D@@CDADA@ABA@@BAB@@DD@D@B@DABABCDAB@BADCF@@ABA@ETIPADCPINKDOHOFI@KBQDOBGDMF@@IJMFMFE@KFCBABAFDXCNBH@D@FAHGJEDEDEAA@ECEEEECGSOK@@BEFAHBJBFFLAR@BADCF@BCJCHBNDNPX"],["@@@@@AA@A@@@@@ACA@@@A@C@A@@@AA@AA@C@AAC@E@@@@BA@BBBBDDB@DBBBBBB@@DBBB@BBB@@B@@BB@@@B@@@BB@BBB@D@BA@@BAB@@@@A@A@@CE@@@A@@@AAAAA"]],encodeOffsets:[[[113924,33747]],[[113699,33975]]]}},{type:"Feature",id:"411327",properties:{name:"社旗县",cp:[112.948245,33.056109],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAEAA@AACC@@@ABA@@@@@@AAA@AC@@A@@@@@A@@B@DAB@B@@A@@@A@A@AA@@@A@AAA@A@@AAA@AB@@@@@@@B@@B@@B@@@@@@A@@@A@@A@A@@@@A@AB@@ABA@A@@A@AA@@@AB@@@BB@BB@@@@@@ABC@C@AB@@@B@BA@A@A@C@@@@A@@A@@@C@C@@BA@@@@B@@@@A@A@A@AA@@AA@@C@C@@@@@@@@@AB@@@BB@@BB@@@BB@@AB@BBB@@@@@B@@@@BB@@@@AB@@A@A@A@@B@@@@A@@@EBGBBB@@C@ABDL@@@B@@A@A@@EID@@@BB@EB@AMD@@AAA@@@CCACAAAA@AA@@A@A@@A@@AA@@A@@@AA@@@C@AB@@@B@@CB@@@D@@IBC@CBA@A@A@C@I@@BOBE@@@@@B@@B@BBD@@BBB@DBB@@BB@C@A@@BA@C@A@CDA@@B@@CAA@A@@BC@@@@AA@@BCA@BA@@B@BA@C@@@A@C@@B@@BBABC@FH@@A@AB@@@B@B@@A@EBACIB@CC@C@IBACA@@FC@BBKD@@AAABAAABAC@@C@ABA@BBCBABAA@@C@C@A@A@@@@@@D@FBD@@C@@@BDBNCBBB@@EBA@A@A@@BB@@BBBB@B@@BBBA@BD@@@B@@ABA@@@A@@@AA@@@B@@@BB@@B@@@@@@B@@BABB@@FC@BDAB@@@@EBA@A@@@@@C@CBEB@@A@@EGB@AA@A@AGCBAE@@EBEB@@AC@@ABAEA@ACA@EBCBBBGDDFA@A@BBBFC@@BBDA@BD@BB@@@@@B@@BB@ABIDC@CBA@A@ED@@ABA@@@@@@BBB@@B@@@@BDB@@B@@@@@@B@@@B@@AB@DCF@@@@AB@@@@@B@@@B@@AB@D@@A@A@B@@B@@@@A@@BB@@BBB@BB@@@B@BB@@@@AB@@A@@@AA@@AB@@@@BB@BDA@@@BB@@B@@@BBBB@@@@B@@@@A@@BB@@D@B@@@BBB@@B@@B@@BBB@BB@BBBB@BBB@@BBBB@ABBB@@@B@@@@@B@@ABB@@D@@AB@@@@@@@@BB@@BB@@@@B@@@@@@@@B@@@BB@B@NB@CDA@@@BB@BDD@@@B@BDB@@BBBF@ACB@B@@DB@DACEDB@C@@B@B@BABBDA@@BBD@@BB@B@BHMBBB@DD@@DA@@BB@B@@@DDB@@B@BA@@AA@BHDA@@@BBFA@BBA@@AA@@BA@BBA@CBA@BBGBBBC@A@A@BFA@BBB@@AFAFJB@@BB@@AB@@BDA@DFABFABCD@BA@@AKDACIDBDIFCCCAAAECCA@AC@@GC@@C@BA@ABA@@@C@@FAA@B@BA@@BBBBBA@@@@F@FIDBBBDBB@BEB@@BDCBCBEB@@ED@@BB@B@@@@@D@@@B@@ADAB@@CBA@IB@@BBE@@DB@C@A@DLA@AB@@@B@B@B@B@BBD@@@B@B@@@BB@@B@BAB@@BD@BDD@B@BB@@BB@@@BB@@B@@@BD@B@B@BCBC@C@BDA@BBCBCB@@DJBBEB@DD@@@BDBB@BA@@@@@BBJ@B@FA@DD@@@@BD@@BB@@DDABBBB@B@D@DBBDJBHBD@BBDBD@BB@@@B@@@BC@@@AN@BB@B@@D@FAB@AAB@B@@@B@B@BBB@B@BABAB@J@@@B@F@DBB@@@BBB@@@@AB@@B@@B@B@@@@AHCD@B@@@B@DABBB@D@DA@AD@ACB@B@DA@A@C@@B@F@@@B@BD@@D@B@B@@@@B@@BBB@D@DBB@@@@@@B@B@@@@@BB@BBB@B@@BB@BA@B@@DD@@@B@@B@FA@@@BBF@B@@@@BB@@@B@@BBD@F@DBB@D@@ABC@@@@H@@@@A@@HAD@@B@@@@D@@@B@BD@@@@B@@@AA@ADAB@DA@CD@@@@@@GBADADAB@F@FA@@@@@E@@I@A@@@C@@AF@@AEAA@A@BG@@B@J@B@@@@@H@DB@@BAB@@@B@D@@@@@@@@B@@AB@@@B@B@@@@BB@@BB@@@@ABABA@@@@BB@@BB@@BBBBDB@@BDB@@B@@BBBBD@B@B@FBFBB@D@BBB@@@@DBF@@@BBFBJDFDB@@@B@B@D@FA@A@@BEACAEF@B@D@B@B@B@DAD@@AAEB@ACB@@@HAD@@@BF@DBD@@@B@BBB@B@@AB@@@D@B@B@@@B@@BB@B@@@BBB@@B@@@B@@@@@@A@A@@B@B@@@@D@@B@@E@@B@@@@D@@B@@C@@B@@D@@J@@CLCDA@AB@@B@@HDDBBB@BBB@DB@B@@@BBB@@B@@B@BBBDB@B@B@DDBBBB@@FCFEBAAA@@DA@@@@@AACA@@@HG@@@C@@@@EA@@@CD@BA@@D@BB@@DA@@@AD@@BB@B@ACB@BBB@@ADC@A@ABA@@@AFEBA@@@@@A@AAABC@A@@HEBADAB@DAF@@@B@@@@@@B@BAB@BH@@@@@@@H@@DB@@A@@B@@@@BD@@D@@B@@@@BDF@@@B@@CB@@@B@@@B@L@@ABAAAAEBA@A@A@@BF@@DAD@@@BB@BAB@@AAADAB@@@@B@@@@BA@AB@@@B@B@@@@AD@H@@@B@BDHHB@@A@@B@@D@@B@@A@@@B@@BBBA@@@@FFJH@BBB@BJDB@B@B@DBB@J@DDBBND@@BADABA@@BA@A@@@A@@B@BD@@B@B@B@@@AB@@@@B@B@@@@@@@DA@@@BA@@B@@@@@@B@BA@@@@@@@B@@@@@@B@@@A@@@@B@@B@F@@@DA@@@@D@F@BA@@FAB@@@BABABABA@A@@B@BABADCBABA@CBA@C@C@AA@AEA@@A@BABA@ABA@BB@DBD@@@@CC@CAA@@@ABABCFA@@BCB@@EDE@A@@@EAK@@@A@E@@BABAB@FA@A@@@@AA@@CE@AAE@@@E@@B@DAFA@@B@@A@AB@@CAA@@AAAA@A@@BEBABC@A@A@@C@A@@@@@@@@AIC@A@@A@A@A@A@BBA@A@A@C@CB@@@CB@@@BA@ABA@AA@@@@ADAFA@@ACA@CA@A@@ACAA@@@AA@C@@@@@GBEB@B@@BB@BE@A@AGABACCB@ACCABCEEE@@E@CGAAACAAFAA@@@CAAA@@@@FA@@@AA@@AAAAAA@@AA@@@GA@@@CC@@DC@@C@EA@@AAAA@@@C@@AC@@A@@AA@CC@@CA@E@@C@AC@@ACB@ABACAC@@AAC@@AA@A@A@A@A@ABC@@@AA@ACA@@@ACAG@A@ACE@@@A@A@AAC@C@@CEAC@BC@@AAB@A@ADA@AA@AA@A@@AA@@AAA@GK@@AA@@A@AAD@B@@ACECB@AB@CCB@AAB@FC@@C@@@AA@@B@B@BABBDADAB@@A@CB@@AHCB@@@@AB@ACBAAAA@@@@@AC@@FA@@@BH@FABBB@@AH@BB@@@@FCB@B@BABDBAB@B@BB@AACB@BDFADBDB@@@@@@CBDB@A@BABDFDFD@B@@AB@B@AA@AD@@ALAAGAA@AB@HA@AB@@BHA@@A@B@D@@@D@D@@@B@@@B@ACD@A@@C@@@@@@AC@A@@@A@@B@@CB@DAD@@@@@ABB@@@DA@A@@@APA@BH@@A@BBBA@BDB@D@B@D@BB@@@@@@@AB@@@@@@@B@@@HC@@@AACAC@EA@D@AA@@A@@EA@BA@@@@A@AA@@AEAEAAFA@BB@@@@BB@@CA@C@@AABBAB@B@AC@AA@@@B@@A@AHG@@D@B@CAAA@@CCAC@@ACACABAA@@A@BDC@@ACB@AA@AADAHCAACAAAAA@@@@@@@A@CGB@@EB@@@C@A@@C@A@BA@@CIC@ACACACACCBACA@@BCBCCECA@@A@A@A@A@ABAB@DA@AA@EDACBAB@@C@@AAA@@AAC@@ECAA@@AG@@AAA@@@A@@B@@AD@@@@A@@@@AAAACC@A@MDGB@@EEGBAAA@C@A@AAAACBC@C@C@C@A@A@AAAAAAEO@@ABA@@@AA@@@@@AB@@@@@@@@AEBCC@AA@@CEKD@@CE@ACG@@@@A@A@A@@@A@A@@@A@AEB@AIB@AAA@@@C@@@@C@A@@@BFGBBB@B@BC@@DC@BD@@I@@@AAC@EB@@@@AA@@C@@AAAC@QAE@CBEBJL@BA@@@@@@BB@@@@@@BA@@B@B@@@@@AA@@@@@@@AB@@@@C@@@AB@@@B@@B@BB@@A@@B@@CBCB@@@D@@A@@@@@AA@@@A@A@A@@EA@@CCACA@A@@@AB@@@B@@A@@@A@@AA@@@ABA@"],encodeOffsets:[[115734,33596]]}},{type:"Feature",id:"411329",properties:{name:"新野县",cp:[112.360026,32.520805],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CBCBA@@@ABAB@BA@@BABABABC@ABABA@CBA@A@@@AB@@@@@@ABA@@@ABA@A@A@A@A@@B@@@@@@@B@@@@A@@@@BA@@@@@A@@@@@A@@BABA@AB@@A@@@@@A@@@@@A@@@A@@@A@@@A@@@AB@@AB@B@BAB@BAB@BABABABC@IBABABCBA@CBA@EDABAFAB@B@D@B@@ABA@@@@@@BB@BD@F@DADADCFAB@@@B@@H@AFJBHBADF@@BNB@EH@@BJ@F@D@CJB@BB@BAD@BB@@@D@D@@@@B@@@F@B@BH@@@@B@@BBAH@@T@@@H@B@BBDBFDDDFDBBA@BB@B@@@D@B@@@@FB@B@@@@ABAB@B@D@@AD@J@BA@@B@DC@@@@B@B@H@DB@ABB@@B@@ABBBA@@@AB@@@@AB@B@@@B@B@@@@ABA@C@A@@DH@@D@@AB@@@BAH@@@BBB@H@D@D@DFABLC@CBA@E@EAAFAJEBBBB@@D@@C@@DA@C@@FNBB@@AB@FBBND@@@@@D@D@@@@BH@@FH@B@@F@B@@@@GBBFBF@@@@A@BFA@@BBDA@BDEDA@BB@DBD@B@B@DA@@D@BB@B@@B@FFB@@BABDD@@DBBB@@@@BCB@@@@ABBBC@BDBBD@@BA@C@ABC@A@ABC@ABA@A@C@ABA@@BQDBH@@E@CBCBA@BFBDEBCBGBA@BFC@ABA@EBE@@@AB@@@DBB@B@B@B@DC@GB@BB@B@@DDA@B@BA@@B@@C@CB@C@@ABB@@B@B@@@@@BB@@@A@@BBB@BBAFA@@@B@FF@@@@B@@BBFDDDB@BBBB@B@B@@@B@@DBF@LB@BD@D@H@@FC@A@A@BBMF@DBB@@FADD@@BBB@D@@@@@F@HB@@VB@BHBD@AFB@D@AB@BGJABAD@@@@DB@@BB@@BF@DNFD@@@@B@@@H@D@F@B@FAD@BB@@B@B@BAA@@C@E@@D@B@@ADBFBB@AB@@@@@BBB@BDBAB@@@BB@BJ@@@@H@DD@B@DB@@@@@B@B@B@@BB@@@@@DADC@@DAHCA@DMA@D@F@DBD@HBD@BD@@@HB@@@BA@@B@BA@@BA@@DH@@D@DD@AF@DB@@@@FBB@DD@CH@BB@D@BBBB@@@@JB@DH@@BD@@H@BB@@D@FD@@B@B@BB@@B@@@B@F@D@@AB@@@B@@@BAB@BH@@B@BB@F@@D@DJAJ@@E@@@@BABBF@RAD@B@JA@@@A@@B@AEAA@G@CAEBA@C@@FEBA@@@A@C@C@ACG@AF@AAFAAEH@B@DBBAD@@E@@D@DAF@B@@B@@D@F@BBB@DD@BB@B@FAD@B@FANC@@B@@@@A@A@A@A@AAC@A@A@@BC@AA@@@C@@@@@@BA@A@EBCAA@A@C@C@A@@@A@AA@A@A@AAA@A@A@A@AB@@AAC@AAB@A@@@ACCAAACAA@AACAC@@@@C@@@@@@@AC@CAAAC@@@A@@@@@A@@@@AA@EBCAC@A@CLAFAB@DBB@@B@@BB@BBBB@BABAB@@@@A@AB@@@@@B@@@B@BAD@B@B@BBBBBBBB@@@@B@BAF@D@B@BA@@@A@A@@BABA@@@A@A@E@A@@BAB@B@D@D@B@B@B@DDBBB@DA@@@@@@FAHAB@D@@B@BBD@@B@FACA@A@A@ABE@@AC@@BA@CB@DA@CBA@A@AAA@@@@@@B@FAB@@@@CH@HA@@@@B@@C@@B@@@F@D@BA@@@AAAJA@@@@BAAA@@AC@@@AB@@@D@DAFAB@BB@BD@HB@@DDB@J@@@@BD@HA@AD@@@@C@@D@F@B@@A@@B@D@D@D@B@B@JA@A@ADA@A@ALAD@@@CE@AB@AE@@@CDACU@@AE@ADC@ADAB@AC@CBEBCBADAB@BBJAD@JCBFBBBBHABAFBBB@@@A@A@@@ADC@AB@@A@@@A@AA@@ABA@A@A@A@@@AB@@A@@AA@A@A@@DA@AB@@@@E@CB@@ABAB@@AB@D@BAB@@A@@@A@@@@AA@@AB@@AB@@@@@BA@@@@A@@@A@A@@@@B@@A@@@A@A@@@@BC@AAGA@@A@A@@@AAAAA@@@A@A@@F@@AB@@@@@AAB@AA@@@@A@@A@@BA@@@@AA@A@@B@B@@@BA@@@AA@A@@A@AAA@@A@@@@@@C@AAA@@@@ACAAA@AA@@@@@A@AAAAAB@BC@A@A@@AA@@@@@@CA@@@ACA@A@@@@B@BA@@B@@AB@B@@AAC@@BA@EBABA@AAA@@@AB@@A@@@@AA@@@@A@A@AAA@@@@@A@@@@@@A@@@A@@A@A@@AAAA@AAC@AAC@@@ABAAA@AA@@A@C@CAA@@@A@@A@@@A@@@@BAB@@A@A@@BA@A@@@@@@AAAA@A@@@@BA@@B@BCBA@@@C@AAAGC@@AAA@AAAAACAA@A@A@C@CBABABABABABCBADEB@@A@C@@@A@AAA@@CAAAGAC@A@EBCBKDC@A@A@A@@@CCAACCAACAA@@@@A@A@A@A@AAE@C@@@C@@@A@A@A@KAA@ABGAA@A@CAA@AAC@C@C@CAEAAAAAAAAEA@@A@A@ABEDADABA@ABA@ABC@E@CA@@C@AAC@CAAAA@CCA@@C@A@ABEBEBABEDABC@A@A@@@A@ACA@@A@CAEBA@GBA@ABCBCDABCFABA@@@A@@A@GAA@@ACAA@@@C@A@A@A@ADCDAFCDAB@BAJEDADABADADC@@@A@A@AA@CEA@C@A@CBEDEFCBC@A@CAAA@@ACAEAECACAGA@@E@@@C@ECMMA@ECAC@E@CDEDCDC@A@@A@C@GBEBKHEBEBGD@@C@GDCB@@AB@BABADADABCBIDCBKDGBGBAE@AAC@CA@A@C@ABC@EBKDC@C@A@IAEAA@@AA@AGAGAACCACCC@@A@@@A@CAC@C@A@C@CAAAA@@AACCAACCAAC@AAC@CAC@A@A@E@C@CB@AA@@@@A@A@CBABABCF@@ABAB@@A@@@@@@AA@@@@@AA@@@@@@A@@@@@@@@@@@AB@@@@A@@@@@@@@@A@@@@@ABA@CDCBABC@ABC@AB@DA@@B"],encodeOffsets:[[114993,33115]]}},{type:"Feature",id:"411328",properties:{name:"唐河县",cp:[112.807636,32.681335],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@OEEGEI@AE@KBG@GFGBG@EB@@IDAB@FADEBGBA@U@M@CAKDA@KJIHGFSDABOHC@S@IBSBKBC@C@A@CBADABMFCD@@EAAA@BCBMHCBA@A@A@CCAC@ABABABA@CACEGCGCCKI@@A@ABEDABEDC@CAGAEAE@C@@@C@GCCA@B@B@B@@CDCBABCBCBIFABA@CBEDCBCD@B@B@B@B@D@@BBBD@@BB@H@BB@@@B@BADEBADCDABAB@HAB@FADBB@@@DB@B@B@@@B@BADCBAFABAFAF@B@B@DB@DDB@BBDBD@BBD@@@DBF@D@BAB@BAB@BABCFCBAB@B@@@FBBBBBBBBBBF@D@D@DBD@BBB@D@BBBAH@BBB@L@B@B@B@@@D@@@DBF@B@B@B@B@B@@B@DBBBDDBBDD@@B@B@B@D@LCDAFAB@D@HBBBDB@@BB@B@B@@@D@BA@CFABADABABABABAB@D@D@B@BBBBDBBBBB@BB@@HDBB@B@D@@ABADA@@@AB@@@@@BBBBB@@@@@@@BAB@@@B@BA@AB@@@@@B@@@BB@@@B@DBD@B@@@BBB@BBBA@@D@BBD@BBB@BB@BB@B@@@@B@@@B@@@@B@@@@@B@BBB@B@@@@@BB@@@@@BA@@B@@BB@BABAB@FAB@@BD@BA@A@@BA@@@ABA@@@@@@BDB@B@@DB@@@@@@BB@@@B@BADA@BBBB@B@B@@@@BBB@BBBD@@@@BB@B@D@@@@B@@@BB@B@BB@B@@B@@AB@@A@A@@@@BBB@@@@AB@@@BB@@@@@BBA@BB@@@@A@@BE@@@@B@B@@BBBB@B@@@B@BB@BH@BAD@@@@@B@B@@@BA@@@@@@B@B@@@B@@B@@A@@@@BA@@BA@@BB@@@@@B@@@BA@ABC@A@@BA@AB@BA@@D@F@@A@@BCB@@@B@BBB@@@BA@@B@@@B@B@BAB@BB@@B@B@@@BA@@BCD@B@@@B@B@@AAEAABGBAAAAAEIDC@IBAAA@CBABADAF@DBDJCHEBD@BBFBD@@B@FAD@D@@@@BH@BAB@@@@A@@AADAFA@D@@DABFC@DFHAAED@@@B@BDD@@BAB@BEBBB@BKD@DDH@@LCBFCBFHAB@@ABAFFHDDBDD@B@HCACAADAA@AAB@@ADA@@B@B@B@BDBABDCBBBFCFAD@DA@BDAAAFAHABA@@@BD@@B@@DAB@@CD@BHA@@BDA@BAB@B@BA@@BQFC@@@ABFD@BHHB@@BBBB@DCBA@AB@BAB@@@@@BB@BBDBBBDDFBFABA@BBCB@@BFBBDB@@BBBBBB@@AB@BABAAA@GDAAC@@@@B@B@BA@@B@B@DDA@B@@CB@BA@BD@@BF@@A@AHCBAACBCC@@EB@BA@@@AB@@CBBDB@@BBBBBDFEB@BA@@BC@@@BHBF@B@B@@B@@@D@B@B@@@@BBBB@@@@@D@AAFA@@D@FA@BDJC@BDEB@B@BCBB@@B@@BBB@BB@@@@@B@@AB@@@B@@BB@B@BBBBBDAVA@B@@GBBFDABFD@@DBD@D@BF@@DCB@BA@BDC@@B@@@B@D@BB@@D@@@BBF@@GDEB@@A@BB@D@@BDBB@@@@HP@@@@GB@BC@@BBDB@BB@@@BA@A@@BA@@@@@BBBHB@@D@@@@CBA@@BBDBD@BBBDDBB@B@B@@B@BBBB@B@BBDBB@@@@B@B@BBB@@BB@@AFAACD@L@@AD@B@@@@F@@@@B@B@B@BDDA@B@@BB@@@BDDB@BADA@@@BB@DAAA@@BA@@AADAAC@@FA@@B@@@B@BBFA@B@BBDBDDBBD@@BDB@FHF@H@BAHA@@@BEBB@@FBB@@DA@BCBBD@@EB@@BDDH@@AB@B@@E@@B@@B@@BEB@@@D@D@BA@AB@@@@@B@D@F@@A@E@ABG@@@BBEBBDDADFDABD@@FJ@BCBCC@@@BCBA@@BBBABA@@@@DCB@@DJB@ABA@A@CB@B@BABB@@BB@@A@A@@FCDADHB@DADABDBFD@B@JGA@FC@@AAFABA@AB@B@F@D@@AB@@BD@ACD@D@DABDHA@D@@HCDA@@BBDADDB@@A@@B@@AB@@@BB@@D@AA@A@@@@B@BA@@FCB@B@DAD@JCBAA@@AA@@@@@A@@AACB@AC@AD@AEAAB@B@CEHCAADAFAB@BDB@BFBA@@BD@@FAFA@@BFDABHB@B@@BHA@FB@@@FADAD@@@@@B@B@FA@@@@BAACD@@EA@BA@AA@@@@@@@@AA@@A@@@A@@BB@@B@@@B@BA@@@A@@ACB@AA@AA@A@AA@AA@@AB@B@B@FA@@AADAAMAC@@D@@@AC@E@C@@@@B@B@D@D@@@BBBADAAAB@BAD@@@BDBABBBABB@@LCAAD@@EB@BDJAD@D@@DJABDFAB@@@@A@A@@BAB@@@EGD@BAAA@@@AD@B@@@D@B@@A@AB@@ADB@AB@@B@@D@@AB@B@DB@@@AB@DCB@D@B@@AB@D@A@@AA@CAA@AA@@AC@A@AA@@@@@F@PA@AJ@D@B@B@B@DAD@JA@@@C@@DA@@@A@@BAD@@@B@@B@@@BB@@BB@@@@B@BB@@BBBBBBDDD@@B@BB@@NC@BFAA@@A@@JC@FB@B@@@@A@@CKBAD@@@AAHAFA@@B@@@@@@AB@B@B@@@BA@@@@AA@@@@@A@@@@AA@ABA@@AA@@A@@AA@@A@@BA@@@@@@@@D@D@@@BB@@BBB@B@B@@@@@@A@@B@@AD@D@@@B@@@@B@@D@B@B@B@@A@A@@BAD@D@BA@@@@@@AAA@@A@@BA@@B@@B@BB@B@BA@@BAB@@@@@@B@BB@@@B@@@@@@@@AA@@@@A@@@@@@BAB@BB@@@BBB@B@B@@BBB@B@@@B@@@@ABA@C@AB@@@@@B@@@BDB@BB@@@@@@AB@B@@DDBBB@FBBBB@BA@@B@@BB@@@B@@@@A@@BA@@B@B@BDDD@@FB@@@B@B@B@@BB@@@@B@@@@C@@DADA@@@AB@@@AAA@@@@A@@BA@@D@@@@@BA@@@@@@B@@B@@@@@A@AB@@A@@@@A@@A@@@@B@@AIKFADAF@RBD@BB@BD@@@BB@@@@FAD@BB@@J@@@ACD@@CD@@A@AAAHAAE@@B@D@@@@@@D@@BB@BJA@BFA@B@B@@@B@B@@@B@B@B@@H@BDF@@DC@FL@DB@@BDDFA@B@@@@@@A@@B@@@@BB@@B@BA@@FPBBBBBBB@B@D@D@D@D@DABBBBB@D@B@BBHAFF@@HANCB@D@BDBB@B@@B@@@@@BC@@@AB@@@B@BB@@BH@@BBFD@@BD@BB@BB@@B@BAB@@A@@@A@AAA@@@A@@B@@@B@B@@@B@@@B@B@BAB@B@@@@A@@@A@@@ABCB@AAB@@DB@B@@@@@@A@@BA@@B@BA@@@@@A@@A@@@@@@A@@B@@A@@@A@AA@@A@@B@@@B@@AACCCAC@@@A@@AAA@@C@@A@@C@A@@DA@ABA@@A@@@JABB@@@A@AAA@@AA@GB@ACD@AABA@@@E@AC@@A@A@A@AA@@ECB@CABA@@CAAA@ACD@@@@AD@B@B@BAB@D@@@@@AA@A@AB@B@BA@@ACD@B@@A@@@@F@@@D@@@@A@@AEAAAAFA@@DAD@BB@@F@AEDAA@BA@A@@DA@@@@@@@@AA@@@A@@@AAA@@A@@AA@@AA@@@@@@A@CB@B@@C@AD@AEGB@AC@@@@@@CC@A@@A@E@@AA@@AA@A@@BAAA@A@A@@A@@B@@A@@A@@A@@@@A@@@CAEJ@@CAADI@@@ABBBB@@BHBB@BBDBBDDBBHFB@BB@A@@AE@A@I@@AAB@@@@GAB@@CM@EJ@B@B@@A@ABAD@B@F@BBHBHAD@@A@@D@@AHAD@@@AABAB@BCBA@A@@@ABAD@ACBA@@@@A@@@DA@AAC@@@@B@@@BB@@D@ACA@BA@ABA@AB@F@@@B@B@BBBD@B@@@@@EB@@@DAAC@AACD@ACD@@ADAAAB@FFDA@@@@BC@A@ABA@ABC@A@@ACAA@@@@@@@A@@C@@A@AAE@AACAA@AC@@@@@@A@@A@CC@@@@ABC@@@BB@B@@CB@@ABA@@@@@@AA@@@AF@BBHA@A@@BBB@@A@@@BBDDD@BB@BBD@@B@ADA@@@CAC@B@AB@@@@AA@@AACB@BA@@BB@B@A@@BCBC@A@A@@@AB@@BDABCBBB@@A@@@@B@D@B@@@D@@BBAB@AA@AAED@BC@@B@@@B@@AEEB@AA@@@BBE@@CB@@ABB@AA@@@AAA@@AABC@EAA@A@@@@@@A@@@@@AA@@@@BA@@A@@A@@@AB@@@BD@@@A@@@A@EGACCEAC@@AAD@@A@A@@@AAA@AAA@A@@A@A@@A@@D@@@AA@AAAA@A@@@@AAA@AEC@AA@GBA@A@@EAA@@C@@@BC@E@A@@@@D@CCDCDCB@BA@AFA@CB@@ADAB@BD@@D@BB@@@@B@@A@@B@DC@@@A@@A@CB@C@@@AD@A@@A@@AAA@AAAA@@@@AA@@@@BA@@@A@A@C@@@@A@BAB@@A@@@@@A@AA@BAA@BA@@@ACACC@@@A@@A@@AA@AABA@@DB@@BAA@@A@@@@@@@@A@@A@BAB@@AA@@@@BA@AB@@@AA@@@@@@@A@@BAF@@@BA@@B@B@BB@@@CA@@EA@@@@CAC@@B@@AC@@@@AA@@@@@@@B@@CC@A@A@A@A@A@@@@@@@@@AB@@A@@@EECEA@A@C@E@@@C@CDA@@B@@@F@@ABCD@@EB@@A@C@A@@AAB@@A@@B@@AB@B@F@@AB@@C@@@A@@BABA@KDA@G@@@GA@@I@A@C@A@ABADCD@@C@G@A@@AA@@@ABA@@@AAA@A@ABA@AB@@A@A@A@AAAB@@@@AB@@@B@AA@@CA@A@A@ABA@@@A@A@@@AA@@@A@@DC@@@@@AA@A@A@A@A@@@A@@B@@@@A@@AAA@@@@ABA@@A@@AAAA@AA@@@C@A@@@@A@A@A@@A@@@AB@@@B@BABA@C@@@AB@@A@@@@AA@@A@@A@@@A@@@C@A@AA@AA@@@A@@@@@@BAB@@ABA@@@CA@@A@ABA@@@@B@@@@A@A@@A@@C@@@AAA@@@@@@A@A@@BA@A@@A@AAC@@@@@@@@ABA@@@@@@@AA@@@A@AB@@A@BAB@BABA@A@@BABA@A@@AA@@@@@AACB@@AB@BABCDC@@@A@@@AA@CCA@@AAAAAAA@@CBABCD@@A@A@@@@@A@@@@DA@@BAD@B@B@@BBBB@B@@AB@BA@C@AB@BCB@@@DADAD@@@B@@BDBB@D@B@B@@@@GBA@@B@@AD@B@@@D@@@BB@B@B@@B@@BF@B@@CB@@CBA@@@@AC@@ACAAA@AA@AAA@CBA@C@@@A@@@AA@@@CBC@A@GAAAC@AA@A@CB@@@A@@AA@AA@A@A@@@ACAA@AAC@A@@@@@CDABC@@@C@@BAHC@@BADE@@BAB@@AB@H@@@B@BA@@B@B@B@B@D@D@B@BA@@BAACFCBBD@AA@AAA@@C@CBC@A@@@CAAAC@C@A@C@AACAEACAA@@A@@A@BA@@B@BAD@B@FB@@BBBADABA@@BA@@@A@A@@@@B@B@BA@A@AB@B@@A@A@ABA@@@AAA@AAAAA@AA@C@@@@AAAA@@@@A@AA@@@C@A@@A@@@@@AB@@@@A@@B@B@@@BA@@@A@ADA@@BA@@@A@@BABA@ABCBABA@@@CAA@ABA@@@A@@@AA@AA@@@AA@@@@@A@@@ADAB@@A@AAA@@@AB@@A@@B@@@BDB@@@B@@ABCB@@A@@@A@G@@@A@A@CBABA@A@@@AA@A@A@A@A@@@A@@B@B@J@BAD@DABAB@DAB@@@@C@A@@AA@@@C@@@A@@BABA@ADCDA@@BC@@DC@AB@BA@A@AB@BABAD@@@DEDCBAB@@@D@@@B@@@BAA@@A@A@@AC@A@A@A@@@@A@AAA@AB@@CDA@ABA@AHAD@@A@A@@@AA@AAA@@AAB@AG@@@A@ABC@EBA@A@@A@@AAA@ABADA@ABC@@@@AAAAAAGAC@AA@A@C@@AA@@BAFC@ABG@@BA@A@AA@@A@@@A@A@A@@BA@@F@B@D@B@@@B@BCB@D@@A@@@@EEAACC@@AA@A@@@A@@EDCBA@A@A@AAAAACGCAAC@GAWAKKKU@EBE@ABKBC@A@KGKGAMCA@KDILIPKFM@C@G@]B@@CBC@WBGAIAKKAAMKOAC@ADADB\\CHKBEAMASIIGEAOI]GKCUEUKCAMIGGIBACEIBECCECICG@C@EBGBC@EDGFKDABBH@DCL@FIACA"],["@@B@@CFC@AAA@AC@I@CBADADDBLB"]],encodeOffsets:[[[115433,33142]],[[115437,33140]]]}},{type:"Feature",id:"411330",properties:{name:"桐柏县",cp:[113.428287,32.380073],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@CACBCBCACCCEAACAGAUMeYEAAAC@E@CDEJKDC@EBI@C@CD@BAD@DAFIHCDIDKDA@GAMEA@E@G@@@EBEBGDGLKLGJABGBKBE@ABCD@D@DBDZPFD@@@B@@@DCFEDGDEDEBGBK@IBEBEDCFAFAF@FBDABADIB@@GHKFEHEDOHA@IDADOLA@SNEDEFGPAJADCBC@GBCDEDEDA@E@KCYMGCG@I@I@ECCA@@QO@A@E@CBC@CAEEU@E@C@CEEA@CAA@CDC@A@IAaCG@GJEXEJADK@G@UCKEE@[PKFEH@DKNEFG@OBCB@@@@@B@@@BBB@@DDBBFF@@@@@BC@A@ADA@@@A@C@A@E@@@AB@@@B@B@B@@@BB@@B@BAB@@AH@BEDAB@@BB@@@D@BBBD@HBBBBBBB@@@@AD@BCBAB@BBB@BB@@@@BAB@FAD@B@B@@BHA@BB@@BB@BBB@@B@B@@@BCBGB@BAB@DC@@BAB@BBB@@@@@@B@B@BBD@@@B@BB@AB@@A@@@C@@@A@ABCDCF@@C@ABABA@@B@BABA@@BCD@@AD@@CBCD@BABAB@@@B@@@D@@BB@@@B@D@@A@CBA@ABCBC@ABI@A@A@@@@B@@@B@B@B@BBB@@B@B@BADAB@B@@@H@B@@@B@@@DABA@@@A@@CA@A@@@AB@@@BA@@B@BBB@@@BABC@@B@@@@@B@@B@@BBB@@B@@@B@@AB@BBB@D@@ABABAD@BABAB@@@B@@AB@@CB@B@B@@AB@@A@A@@@@B@@A@@B@@@@@BB@D@@@B@@B@B@@B@BB@B@@D@B@@BBBBB@BBB@B@@AB@B@B@BA@A@@B@BABA@A@@@@@@B@B@@AB@@ABCBABAA@@EAA@C@ABA@@@ABB@@@@BB@DBFBDBBBD@B@D@D@BBDB@@B@D@DAD@@@BB@BBBC@AAEDBDAB@@ABA@C@C@A@A@A@A@@@ABA@@@G@A@@BA@AB@@CFAB@@GDAB@@@D@@ADCB@D@@@@@BBD@BBBBD@@B@B@B@@BBB@@@B@@DAB@B@@BBDBB@H@BAD@D@@BB@@B@@@D@B@DAB@BBB@@BBBDB@BD@@B@@B@DA@@DA@@@AAE@@@AA@A@A@@A@@@C@@@ABC@@@AB@HA@@@@@A@A@CAAAC@@@A@@BCBC@C@@DA@ABAD@B@@ABA@@@AAAAA@@@A@ABC@AB@@C@@B@@@@@B@B@@@DCBADA@@BBBBBB@BB@DDB@@B@@@B@@CDADABA@@BA@BD@B@@@@BB@@@BABAB@@@BABABA@ABB@@@BAB@@@B@@B@@@@@@AB@B@@@@@@D@BBB@@@@BAB@@@B@B@@@@B@BB@@D@@@@BB@B@@@@@@A@@B@BAB@@@DB@@B@BA@@BA@A@@@@B@@@B@@BBBB@D@@@B@@@B@@@@BB@@B@@B@@@BA@@D@B@BA@A@A@@BA@@B@@@@B@B@B@@B@D@@@B@@BBBBB@@@BB@BA@@@@BB@BB@@@@@@AB@@@B@B@B@B@B@@B@@@@CD@@@B@@BB@@B@B@@@B@BAB@B@B@@DB@@B@A@@BA@@@@BABBB@B@B@@@BAB@BAB@B@BB@@B@BA@@B@@BB@H@D@@@DCBCBAB@D@B@J@@@HB@@H@B@LCB@BA@AB@@@D@@@BA@@@E@ABA@@@AB@@@BA@BB@D@B@@@FA@@DCBA@@@E@@@AB@DCD@@@F@D@B@B@DFFF@@B@@@BA@@@@@@@@B@B@B@B@B@D@BA@@@A@@@@@@B@@B@@B@@AB@@@@BB@@@@@@@@ABBB@@@B@@@B@@@@A@A@@C@A@@@A@A@A@A@AA@@A@A@@AAA@@@@B@BA@@BBBB@@B@@@@@@A@@BA@A@@A@@@@@@@@A@@@AB@@@BB@@CGAA@A@@@@BADAD@B@DBBAB@@@DBBC@@B@@@BB@@DBD@BA@@@@@@B@BB@@D@B@BBBBBBB@B@D@@@DB@BF@@@@BB@DB@BD@BBB@@B@D@D@@DD@B@D@@BDCD@@@@@BDDB@@@DAB@B@BBBB@@BC@@B@B@JBB@B@DAD@B@BABBJ@BBDB@@@@BA@@BAD@BAB@AA@@BAJ@BAD@@@DADAB@FAF@@@@A@AA@@ABADCBC@@BA@@@@BB@BB@B@@BBBBBBBBB@BB@BBD@B@B@DC@@BA@A@A@AAA@@BA@ABA@@B@@@BA@ADBDAD@@@BC@@D@B@DABAB@BG@@BAB@BB@@DDBB@BB@B@@@DB@@@@BA@@B@@@DBBBDAD@DABA@@BA@ABA@@B@@B@@DD@B@B@@BBD@B@@@BD@BFF@B@B@@DDDB@@@BA@@@@B@B@@B@BBB@@BBF@@AB@B@BB@BBBBB@BBDBB@BBB@@@@B@BBF@@BF@BB@B@@BDBBBBB@@B@B@B@D@HAB@BA@@@A@ABA@@@@F@@@D@DA@@D@B@BABA@@@A@A@@BCBABA@@@@F@B@BABABABABA@A@ABA@@B@BA@ABA@@DAB@DA@@BADA@ABAB@B@@@@@BD@BB@DB@@B@B@D@B@@@@AB@@@@@@C@@@C@A@C@CBABA@@@@@@B@BBBBBBDB@@@@DA@@BB@BB@FAB@@@BA@@D@@AB@DADA@@BABA@@DA@@BADA@A@A@@@@BAB@B@BBB@@@DA@@F@@@BA@@@@B@B@DBBB@@BB@BDAB@L@@@@@@D@B@B@@@DAD@DA@@@BDB@FBB@@@DFBBBBBB@B@@@D@B@@BDBB@@B@B@@@BAB@@AB@DAB@@ABA@@D@BB@@DC@@BA@AB@@@B@DDB@B@DBBB@@BDB@DDBBBBB@B@B@@BBB@@B@D@@BB@B@BBDB@B@D@B@@@B@@B@BB@@@@@B@B@@@@AB@@@@@@B@B@B@@@@BAB@@BB@@BB@B@@AB@@@DB@@@BB@B@@AD@BAD@@@@BBFBDB@BB@FD@BBB@@@B@BDBBBBBBB@@@B@B@B@BAD@B@BB@@@@@BADAFCB@B@@@DB@BB@@@B@DA@A@@B@@@DD@DBB@B@B@@@@CFAD@@@BB@@B@B@@AB@@ED@@A@AB@@@B@@B@B@B@DBD@@BB@@BB@B@BBBB@@@@@@BAD@BAB@@@B@BAB@@BBAB@@A@@B@@@@BB@BB@B@F@F@@@B@@@@A@@B@@BB@B@BBB@@@B@@@@AD@@BB@@@BA@AB@B@@BB@@BB@@ABA@@@@@BB@@@@BD@@@@@@@BA@ABA@CA@@@@@BA@C@@@AD@@@B@@BB@BB@@BA@@@@BBDBB@@BD@B@DB@@B@@B@B@DDB@@CBA@@BABAB@BABA@@DB@@B@BA@@DBDBB@D@BA@@BAB@@@BA@B@@@@BB@BDBB@BBBB@@B@@@B@B@@@B@@C@@BA@@B@@@@@@C@@BA@AB@BA@@@A@A@@B@D@DB@@DCB@@@@C@ABABABA@@DBBBB@B@@@B@@@D@@@@@BABA@@D@B@@@DDBBBBB@B@B@@@DBB@BA@@D@@@B@B@BB@@B@@ABA@@B@@@BABCBA@@B@B@@@BADAB@D@BA@@B@BAD@FC@@@@B@@@@@@E@@D@@@@A@@BADA@@@A@@@A@C@A@@@@B@B@@@@A@AF@@ABAB@@@B@BA@@BALEBAB@FAD@B@LG@C@A@@BA@AD@BABAD@B@F@F@D@B@B@DDBBB@@@DCBAB@B@FB@@@AB@DI@@B@BAB@B@@A@@@@AACGCC@AAC@@@A@@BA@ABC@@@AAC@ABA@@@AB@F@B@B@@ADA@@D@B@BA@@BA@A@@BC@C@C@@BADAB@@AFCDGDCBABAB@B@BABAA@EAA@A@A@@AAAC@A@@@CAAA@@AA@A@AAC@@@@CAA@CA@@CAECAC@AAA@@AI@CAA@AAAAAAACC@@DABABA@@C@E@E@@CB@@@@A@@EC@@ID@@@AAC@A@AAAA@AACA@ECC@@BA@ABADE@ABAB@DADBBA@@B@BCBA@@A@@@GAAC@@EA@@AA@@B@@@@A@@CCAE@@@A@ABC@@AA@E@@CAA@@C@CA@ACAA@@@AB@BA@A@A@@BC@@@A@E@@@A@A@@AAAABCB@DA@A@A@@B@@@BA@EAC@@@ABAB@@A@A@A@A@AAAAC@@CA@@BC@A@@AA@@AE@C@C@AAA@@@@@@A@@@@B@B@@A@ABA@CA@@@A@A@@A@A@ABC@AA@@A@@@@B@B@B@B@@@BAB@@A@@@AE@@A@@@A@A@@@AABE@A@K@@ABAA@@AA@@@@A@@BCB@B@BA@ADAB@@@@CAAA@@@A@A@@@@BA@@@AAC@@AA@@@@@A@@@AB@@A@@@A@@A@@ABCAE@AAA@@@A@A@@AC@@AAA@A@@A@@AEC@@A@@@@@ABA@@BA@@ME@@@ACAA@AAEA@@@@@@HCFABABAA@A@AA@AAA@AAAAA@@A@A@A@A@A@@@A@AB@BAD@B@B@@A@@B@DA@@BA@A@A@@@A@EACCCA@AA@AB@@CBCDA@ABE@A@C@AACAA@CA@@ABC@@BCBA@AB@BA@@@AACA@A@@@ABABABABAB@@AB@AAAACAAAA@A@CB@BABABA@C@A@A@A@@AAAAEAAAAAAAACC@A@A@EACCC@CACACCCCAEAGEEC@A@A@E@C@A@E@E@C@A@AAA@@AACAEAC@A@EBCBABADCBCBCBCBA@A@A@CAC@@AA@@A@A@@AABA@ABABAB@D@FAD@DABABA@A@A@A@AA@A@A@ABA@C@G@C@A@CAAA@BA@@BABADABEAA@CB@@@E@A@A@ABCBC@ADAAAC@C@AAAAAAC@C@A@A@ABA@ABC@A@CAA@@C@AACAAECAAEA@AA@@@@EB@@ABABAB@B@@@B@DGBEBE@GBEECICEACBAF@FAH@FBHBJBHA@E@A@AAA@@@AAA@A@ABABABA@ABA@C@E@A@CCAAA@@AA@@AACCAKGGCA@A@QGMIIEA@AAGGEAEA"],encodeOffsets:[[116201,33074]]}},{type:"Feature",id:"411381",properties:{name:"邓州市",cp:[112.087493,32.68758],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@BADEBCBC@C@EACA@@AC@A@ABC@A@ABC@A@ABCBA@ABC@ABA@CBA@IAKA@@AAA@E@AAC@CAEAAAC@A@A@C@CA@B@@@B@B@@D@@@@@BB@B@B@@B@@@B@B@BB@@@BAB@BA@@@A@@B@@BB@B@@@BA@A@CAA@AB@B@@@BB@@@@D@F@BDB@@@B@@A@@@AA@A@@A@@@A@@@A@@B@@@B@BEBA@AB@@@@ACA@AAA@A@@BBB@@A@@@A@AAA@@@A@ABAB@@A@A@A@@@@@A@@@AA@AA@A@AA@@@@@@@A@@A@A@@@@A@@@@@AD@@@@CBA@@@@DADAB@@@B@DDB@@CB@BBB@B@BABA@A@AAAAA@A@A@@B@B@@A@@A@A@@AA@@A@@A@@@@BCA@BBDBD@@@@@@A@@@A@AAAA@@@A@AB@@CB@@@AAAAGA@@@@@A@A@AB@B@@@BBB@FBD@BB@B@@B@@@BABADA@@@@@AA@AA@AAAACAACAA@A@CBABKFABABCD@BABABABE@ABABADCF@@@FADABC@A@@CECE@GBEDKFGLKJCBABABC@@BC@G@C@A@CDCB@BBD@BBBBBBBB@BD@@@F@BCBA@A@A@C@E@CBABA@@BCBA@A@ABABAD@BBD@DBFBB@B@B@BBF@B@B@B@@ABABADADAD@@@D@DABA@CACACAC@ABEBA@CBAAGCA@C@AAA@CGCCAAAACAA@EAAAACC@GG@@AAAAEAC@CBA@A@@AA@@@ADA@@DADADABA@A@C@A@CAA@GA@B@@CB@@@@BB@@A@A@@@@@@B@@@BB@BBDB@@BB@B@@@B@@CBODA@IBCB@@AB@J@DABA@ABAB@DAFAF@BAB@DGHAD@BAFABA@AB@BAB@FABA@CBAB@BCBABA@M@SCMEECME_EA@QAQFQLGRGJAD@BCFOHIAMAM@S@EBEDGBEDCBOF[TEFCBED@@KHQDIFIDEFMJEJCDELQLONCBGDGBQ@EDOHC@IDWHIDMCIGGKQEGLALDRD@@@D@@BBBDFDBBB@@A@CBABB@BDD@@@BBBBBA@@@A@A@@DAD@BAB@@BDBBB@@BBBABBBB@@BBABB@@BDB@@DAB@F@B@D@@A@@B@FBB@F@HAD@FAB@BCBAB@@A@AB@BAB@F@B@D@@@@@@@BB@@D@BBBBB@@B@B@BAF@@FAB@DA@@B@@B@@@B@B@@@@@BA@AB@B@@@@@BDB@B@@@BAB@BAB@B@@@B@@@@@@EB@@C@@@@@@BBBH@@BB@BB@@@F@BBB@BB@B@B@BAD@B@BAF@@@DAB@@@D@@@D@DAD@DAB@@BBBB@JADAFA@AF@D@@@DB@D@@B@D@BAD@B@D@DABA@@BB@@B@D@H@@@J@@@@AB@@ABADDFBB@BDHB@@@B@B@B@@@B@BBBB@BAA@BA@@@@@BBB@BB@B@@@@A@A@@B@@@AAB@@AB@@@BA@@BB@@@B@@@@B@@@@AB@@A@A@@D@AED@@ABBD@@AAAB@@AB@F@@@ABBB@@@@BBBB@@B@@B@@H@@@@A@@D@D@DBD@@@BA@AB@@@D@B@B@B@@A@A@@B@B@B@B@D@@BD@@BBD@@ABAB@@@@C@@B@B@D@@B@@@@BB@BD@@AD@D@@B@BABAB@@@B@@@JAB@@BF@BFD@FD@@@B@B@B@@B@@@@@@BB@@BDBBBDBBB@@@B@@@@ABCDABAB@@CF@BAD@B@B@@@BBBBB@BA@AB@B@@@BA@A@A@@@CA@@@@A@@D@@EDCBAB@BABA@A@AAA@A@@@A@ABAB@@CDABA@@@AAA@@@A@A@AD@BAB@@AB@@A@AB@@@BAD@B@@ABADCBABA@@BA@AAA@@@A@ABA@@@@F@D@DD@@DH@BB@@D@@DB@@B@@B@B@BB@@@@BB@@D@BBDDB@@BAD@BAFA@@B@AA@@@@@@AA@A@@@@B@@@BBB@B@@@BA@@DA@@FABF@@FDBAFDB@BJDGB@@AA@DA@@@@@@@B@B@@@@B@BDBAD@FAD@D@BCFC@A@@@@B@B@D@HA@A@@AAA@DADABC@@@A@BDA@@@@BGB@@BBAD@@B@FBB@B@@BA@@B@@ABCBCBAB@@ABA@A@A@@BC@CBEB@@@DADC@CAC@C@@@AB@@A@CF@@AB@@@B@BAFAD@B@B@DADB@@F@@A@ABAB@B@DBBDBBB@@D@ADC@ABEHABAB@@@DBBABB@@BBF@BB@FBFDDBB@@BBB@B@D@F@F@BABA@@@@B@@@BB@B@DBD@DBBB@@BB@@@B@D@@DD@B@BAB@B@F@B@D@B@@@BBBDDB@B@JBB@@@@@BD@B@@@BD@BBBBB@BDBB@@B@BBB@B@@@@@A@@@@B@@@B@F@DAH@B@H@AH@R@HBJDB@BB@@B@B@DDDD@@B@@BB@B@@BBB@@HDFBB@B@@@DAB@B@B@F@HABAD@D@D@B@F@@@HFFDDD@BB@@D@@@F@BA@AB@@AB@B@BAF@@AD@BA@CFA@CDEHAB@@AB@@@BB@@BFDB@B@@B@@AH@@AA@@ABA@BBB@LD@A@@BA@@@@@@D@B@@@@@@AA@@AB@@A@@@@B@BAB@B@B@@@B@@A@ABA@@@AB@B@B@D@J@@@B@@@@@@AF@@AD@@@B@@CB@BD@@@@B@@@@@B@@BBAB@@CJ@D@@AB@@@BA@A@@@A@@B@B@@@BA@@@A@@@AA@A@@A@@@@B@@@B@D@D@F@@@D@FCHADA@@BADCDCBA@AB@DAD@@@@@B@BC@@DAFA@@@ABCBEB@@ADAB@@ABA@@BE@@@ABEAA@@@A@C@@@A@@BA@@@@@E@C@@A@@C@C@@A@C@@ABA@@F@B@@@AAEAA@@@@C@CC@OCDK@@B@BB@@D@@@@@@A@ABBB@B@@@@@@D@BF@DCEABC@AB@@AB@@@B@BE@@BBD@DCHED@@BDBDB@@@B@@@BBB@D@BB@B@@C@@B@JBDDF@B@DB@ABA@@@CBABCBA@C@AAC@A@A@A@A@ABCB@@AB@BAH@B@B@@@BABA@@@@@C@AAEAE@A@CA@AAAAACA@A@@AD@@@@AA@@AEA@CB@CED@@@FD@B@@BBDD@@@@BB@@BBB@B@@@BAD@@@@C@AB@B@@@B@DB@D@@AB@B@@BDBBB@@B@B@@@BBBFBBBB@B@H@HAB@D@B@D@B@@FB@F@D@@AJ@@@@@@BH@D@B@D@F@DBBBB@@@BABA@ABABABA@@DEBCBCDA@CDE@CBEB@B@DAB@D@BBD@BB@@@B@@BBD@B@B@@@DBB@BBB@B@D@B@@@FBF@@@@@@@BA@G@AHABA@A@E@A@A@@@@@A@A@@BA@@@ENBBIDBD@B@BB@DADDB@DF@@B@@B@@A@@D@B@DB@@D@@@BAB@F@F@@@BDB@F@AC@A@@BAF@@@@AB@@@@@H@B@@IB@D@L@B@@@BF@FADBB@@F@B@B@B@D@@AF@DBB@B@@@@GB@@@F@@@@@@FB@B@@@BBD@B@@@BBD@NB@BHB@@@D@BAB@B@HB@FBDBB@BBFBF@@@@@@@@@B@F@B@B@@BDD@@F@@@BB@@B@@ABC@A@@DABAADD@@B@D@B@F@@B@B@F@@@BC@@@@AA@@A@@EDB@C@@@CBAB@AF@@BA@@@AB@@ABAAAB@@A@AB@@BBB@@A@@D@@B@F@@@B@BB@@B@@AB@@BBAB@B@@@B@@GD@BE@@BGBE@@HBBCB@B@@AD@@BD@ADJB@@BAF@@@@A@AA@@AB@@EJB@AA@@AF@ACB@BA@CC@@A@@GAA@@@@A@@A@@A@@@@AB@@A@@CCB@@BE@@CA@@@@FIA@BCDBBEBAB@@AB@@@@A@@B@DBA@D@@AD@@AD@BIB@@@D@@@B@BC@C@@A@A@BA@A@@@AB@F@@AD@@ADBBC@ABAA@@A@AG@@ABA@A@@@A@@BA@@@C@E@A@@@AA@@A@A@AC@@E@CA@@A@GC@@AG@@CIA@@@@AAAAC@A@@ADGC@@CAA@E@@A@@CBEC@@C@CG@@CB@@AB@@A@AB@@A@@GA@@C@@AAC@GAC@C@E@CNB@CDBBG@CD@BC@C@@@@AA@@@A@A@A@@@@CAA@C@@C@G@@I@@AAA@@A@ABACA@AA@@@@A@@BAAAEBC@@@A@CF@D@@@BB@A@A@AA@@ABC@E@A@E@C@G@@@A@@C@ME@CAE@@AA@@CA@@@@BCBAHI@ABAC@A@BEC@GA@AUA@@GAE@@@@@C@A@AA@@CCEB@@AA@CNEAAB@B@D@@EG@C@C@@AKAE@CA@@@A@@@A@AAAAAA@CCECAA@@@A@@E@@E@A@@EBAB@AAA@AB@@@A@@A@@@@@A@AA@BA@@@DDAD@@@@AB@@A@ACB@CA@A@@AHAD@@C@A@A@AAA@C@@BA@@F@FAB@BAD@AEB@HADAFAACAEB@DADAF@@@AGRC@AB@BAD@B@B@BAD@BAB@D@BAD@B@@AC@AAACD@AABA@@@@DA@A@@A@AA@CC@ACAB@@EA@E@AA@A@@A@CB@@C@A@AAC@CAAB@FCACB@AC@AB@AEB@@@@@AEAEHA@@@@@A@EA@G@@EG@@A@@C@C@@@@@C@AMEAA@@BA@MA@ED@B@@CD@@@@CA@AAFABIBEFBF@B@DAD@AKEB@C@C@C@GAA@A@@BG@A@@BA@@@CG@@CB@D@B@BA@@@@@A@A@@@ABA@@@@BA@@B@AABA@@@AA@BAA@@C@G@A@A@@D@@C@AB@@A@IBC@@@C@ABABA@@@@@AEA@@@@@A@C@@@AAAB@AAECCCECCAAAA@G@@@S@@@BGAA@@@A@@G@@A@A@E@@@A@@C@C@@@A@@ABC@AAAA@DIC@E@I@@AG@@FMA@AE@BCGAIABEG@"],encodeOffsets:[[114918,33181]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
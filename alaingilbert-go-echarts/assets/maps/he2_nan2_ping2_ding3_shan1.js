@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("平顶山",{type:"FeatureCollection",features:[{type:"Feature",id:"410402",properties:{name:"新华区",cp:[113.293977,33.737251],childNum:1},geometry:{type:"Polygon",coordinates:["@@@E@@E@E@K@GB@@E@E@E@CBCBAAGB@@ACKD@@@B@BGDMFDB@@EJIDABQF@@@ACEGDGEHELI@AAGDC@A@@C@AG@GD@FC@@@H@DBABBBABBA@@BDACABA@CHEKUAEKCA@MC@@E@EF@BGBI@G@A@CBUDiJGBWB@@MCEBG@SEE@CJAD@@CD@BEH@@C@C@A@A@CAA@A@C@@@C@AA@@C@@@A@CDABCBCBA@@B@BAD@@ABABABCDA@ABC@ABA@C@AAE@C@@B@DCN@H@B@@CD@@@B@@@B@@AD@@@DA@A@@BA@@@@H@@DB@@@BA@@@A@@@@@@@A@@@@@@@B@@B@@@@A@@@@@B@@@@BA@@BB@@@@BAD@@B@@@@B@@A@AB@@F@@DD@@BCAAH@D@@C@A@A@CD@@@@@B@B@@B@BB@@B@@H@@C@A@@HB@@@@B@B@@AB@@@B@B@@B@@@@@@B@B@@B@@D@@B@@@@A@@@@D@B@@B@@F@@DB@@DB@@D@@BBBB@@@@B@@@B@@AB@@@@@D@@@@BB@B@@B@@F@D@J@@@BDB@@BBBBB@BDBA@AB@@BF@B@D@BA@@DA@BDA@A@B@@BA@@BD@@BB@B@@@H@@A@A@@@@D@@@B@@AB@@@@AB@@@@@@C@@@A@A@@@AA@AADA@ABBDCHCBADA@AAA@A@@H@AC@A@@DA@@@@@AD@BD@BHEDAA@@@A@A@@@B@HA@@BD@@BBBA@BBD@@B@@@B@@B@@DA@@BBBA@@BBCB@@@@AB@BF@@A@ABAHABAD@D@H@B@BAB@BDB@AA@@B@B@@B@@DAB@@@@@BBB@@@@B@@BA@BD@B@DJ@@BABFBB@@DAFCFCBAB@@@B@@@B@DCDAHCBAB@@@DD@@@B@@F@B@@@@@@@@@F@@@@@@@BA@@D@B@@BB@@@@A@@@A@@B@B@@@@@@@H@@@@@@C@@FAD@B@B@B@HEF@DA@@@@@@C@@@@A@A@@B@@@B@B@@@@ABA@@D@BBB@@@@ABABCBA@@FAB@BCDABAHBAADCACDCJB@@B@@@AA@A@@@@@@B@B@BBB@HD@@D@F@@@@AA@AA@@@@@@D@D@D@B@D@BAB@B@DED@BAFCB@B@DA@CEEAAMIHI@@AEAABA@@HCAIH@@A@@B@@AD@@DA@AAABAB@HDADBL@HA@@@@AE@GCB@@AC@A@C@@@EAC@@@A@@@A@A@@@C@AAC"],encodeOffsets:[[116028,34542]]}},{type:"Feature",id:"410403",properties:{name:"卫东区",cp:[113.335192,33.734706],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@A@G@I@@@A@O@IB@FBD@B@D@@@B@B@@@B@@BD@F@@@D@BBD@@DA@HBF@@@@GBK@CACB@GBABABBB@@CC@@BA@@@@BG@BJGD@@ABBBBF@@GJNJBBFF@DB@@FC@A@@B@BBBBB@@BAB@@@@B@FBB@L@@DJABBB@BB@BBBBBBBB@@BBHBB@DBBBD@B@@@BADABA@ABCDE@@DCDAB@DC@@B@D@J@FBD@@L@@GF@BBJ@BB@H@@@@BBH@DA@@LD@@@D@D@@@B@DA@A@@B@B@@@B@B@B@@@J@@B@@@BJ@@@DBF@F@AA@A@AAA@E@G@@@ACE@@@@@@@ADA@@DIBC@CBA@EB@@AB@BBB@@@@@B@B@B@@@B@@@@AAA@AA@AAAAA@AAA@A@@@ABA@@@A@A@AAA@@ACAAA@AAACACA@@@@@AB@D@@AB@BAD@DAB@BAB@@AB@B@BAB@BAFA@AB@DAH@BAB@BABCB@B@DAB@B@B@BAB@DABAB@BCB@DAHA@@DAB@B@BBD@BBB@B@D@D@B@B@B@@@DAB@B@@@D@B@@@@@CEHAAAB@@@D@@AP@@BB@BA@C@C@AFB@ABCEA@A@AE@@@@DE@@@C@@@@@@@A@@A@@@@@@@C@CCC@CBA@AAA@@@@B@B@@BB@B@B@B@@@B@@@B@@AB@D@@BB@@CB@@BD@@ABB@F@@ADB@@@BEA@@CF@@ABBBCD@@A@AB@@E@CC@AGG@@A@EC@G@AAM@c@KBCBEBA@CBEBC@I@G@E@I@K@[@@@@CD@@AC@@C@@@C@@A@@AA@@AC@@@AA@@A@@@E@@AA@@@C@@@AAA@@@@BQBA@CF@P@@A@@@KBIBC@A@@@G@A@"],encodeOffsets:[[116056,34538]]}},{type:"Feature",id:"410404",properties:{name:"石龙区",cp:[112.898818,33.898713],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@A@CBA@@@@AA@@@@@A@@A@A@@@A@@BAB@@ABAD@DB@BB@@@@@D@B@BAAA@@FB@@DE@A@@@CBCCC@@BAAEA@@@@@A@A@@B@BA@@@A@@@ABA@A@A@@C@A@A@ABCBA@A@C@@BCB@@E@@@AAB@@AE@@A@@@A@AA@GB@@AAA@@@A@A@A@@@ABCBCDA@@BABA@@B@@@AA@@AA@@BADABB@AB@@ABABC@@@ABABCFAB@@ABCHAD@F@FA@@B@BA@AB@BABAFB@B@BAF@B@@@DB@@BBD@B@@B@@ABBDBDBBDB@@DBBB@@B@@@@@@BB@@@@@ABDBB@@B@BB@@@B@BA@B@@DDB@@BB@@DB@B@H@@@BAD@D@DA@A@A@@BAB@DA@@B@B@DCBA@@B@@@BBDA@B@B@B@B@B@@@@@B@@@BBA@@@BCD@@@BA@@@A@@B@@@@@@B@@BA@@@AB@B@@B@@@@BBBBA@D@@BB@@BA@@DABA@AFCB@@BD@FA@AD@FABBFE@A@@A@@@B@BA@@D@@CB@@A@ABA@@@AA@BA@@@AAAAA@C@AAA@AA@@@ABA@@@A@@B@@@AA@@@A@A@@BA@@@@@@CA@@AA@A@@AABA@A@@A@@A@BA@AAAA@@A@@@@@@@A@@B@@A@@@ADA@@@@@@@@@A@@@@@@B@@@@A@@B@@A@@@A@@@@A@C@A@@@B@@@BA@@A@EB@A@AHAB@@A@@@@A@@@A@AB@@C@@@@@@@BABA@@@@@@@A@@@AB@DA@AB@DAB@B@@@B@@ABBCB@@@@B@@@B@@@B@@ABA@@B@B@@@B@B@BB@@@@@@@@@B@@D@B@@A@AB@@AA@@@@@AAA@@A@@@A@AAA@@B@@@@A@@@A@@@A@@A@A@@@BC@@D@@@@@@@AA@@@@@@B@@@@@AAAAA@@@@@@@AAA@@BC@@@@@CA"],encodeOffsets:[[115629,34670]]}},{type:"Feature",id:"410411",properties:{name:"湛河区",cp:[113.320873,33.725681],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@B@@AB@B@BAB@@@@AA@@@E@@@@D@@A@@B@@"],["@@AA@ACA@@AAAAA@A@A@E@C@CBCDA@AB@BA@@B@B@@@B@@@BA@@@A@A@A@A@A@@BCB@@CAC@ABA@AB@@@AA@@@@@@@ABA@@@ABA@@@@@@B@@G@@A@@E@@@C@E@@@@@DAA@@@@A@@E@@@@CB@@A@@@@@A@@C@E@@@A@@@@@@A@A@CCA@@C@@@CAE@@@A@@DA@@@@@A@A@@B@@@B@@@@A@E@@BA@@@@DB@@@B@B@DD@CD@@FB@@BB@@D@@ABAAABA@AB@@A@@@A@@@EB@A@@C@@B@B@@C@A@AB@BC@@B@@E@@D@B@@@@IA@BA@@@A@@@BD@BC@A@@EC@AAG@@@@B@@C@@CE@@@@BC@@C@A@@@A@@K@GB@@@B@DD@@@@B@@C@@@@@@BC@AA@@@@C@AC@B@@AAB@@@E@@@@@@@@BA@@B@@A@A@@@AA@@K@@@E@@@A@A@@B@@A@A@@@@BI@@BAB@@C@AJ@@A@@@@B@@@BA@@@@BD@@@@DD@BBAB@B@D@@A@A@A@@@@@A@@@@@A@@H@@C@@@@A@@C@@@@B@B@BE@@DAA@BC@@@@BA@C@@FB@BF@DA@@@@F@@F@TFH@FAND@@XAHAjIVCDAB@H@J@HA@AFEF@@@NDB@LDBFLVGF@DABDBCB@AB@AAABAAAB@C@G@@EDC@@HBHD@@@@BCDBH@BKJGFHFHCDF@B@@REBAJCFI@@CANEHC@A@A@@LCBD@@HABBDADAF@F@F@@@HAL@F@F@@@JAP@B@@@J@H@B@H@B@H@@@B@D@JALA@@B@@@@ODEB@RA@A@@@AA@G@@BA@@AA@@A@@@EB@@A@@@A@@@AA@@@@@@A@@@@D@AI@@C@@AC@A@@AC@A@@B@@@AAA@@@CC@@C@@@C@EA@@@B@@A@@@A@@A@@AG@@AEAAEA@ACA@EB@@@BA@@@ABE@ABGBAACE@I@A@@@A@@A@@@@@@C@@BAA@@CAA@@@ABA@@A@A@@@@@@@AB@@@@A@@@ABC@G@@@A@AAA@@A@@@AAA@C@AACAA"]],encodeOffsets:[[[115946,34475]],[[116025,34465]]]}},{type:"Feature",id:"410421",properties:{name:"宝丰县",cp:[113.054801,33.868434],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@JAD@@A@@@@A@@A@@@A@A@@@AA@@AB@@@@@@@@@C@@AA@@@A@@@@@@AAAB@B@@@@C@@F@@A@@A@@C@@B@@@@AB@@@BCF@@@@A@@D@B@@@@@@@FAD@B@AA@A@ABB@@B@BC@AAA@A@@D@AIC@@EAA@@AG@ACC@@@AAA@AB@@ABCBABEBCBEBA@A@C@@BA@@@KAA@E@A@@A@AB@@AAAA@A@AB@D@@EA@CBA@A@EDABC@CFA@A@ABC@A@C@C@C@@@@@@@BBB@@B@@E@C@@@GCA@AAA@A@@@@@@@@BBB@@A@@@IACDBDCDBBGAABCBADA@EB@@ABADAB@B@@A@AAC@@@AB@B@@A@A@@@A@@@@B@B@@D@@@@@@@CBE@GFA@A@A@C@EB@@@D@@@@G@@@@@@@A@A@@@@B@@@B@@A@@AA@C@@@AB@@@@@@E@@@@@@@@@A@E@@@@A@@CC@@A@ABGDCBCDA@@@A@@@A@ABEDEDCB@@AAAEAB@@CIA@C@@AAB@@@A@@A@AA@@@@A@CB@@@AA@A@@@BBA@ACA@ABA@G@C@C@ABGBAB@B@BE@@ABA@@@@DAAA@@ABAA@@CB@@@AA@@@A@@@AC@AABAA@@AC@@GBA@@@B@B@@@B@CBGF@AACC@@B@@@@CB@@@BBDG@@@@BBB@BCBABGDCDAA@BCBBBB@@B@@@B@B@@@D@@@@A@@B@@A@@BA@@@C@@@@@@B@BG@@@A@A@@AC@@AB@@AA@B@B@ACB@@CB@@A@C@AAE@@BAB@CA@AAAAA@AA@AC@@I@C@E@@@@AA@A@@A@@C@@@@@A@@BA@@@A@@@@@AAAA@@@CA@@CA@@CE@@@@AA@C@@@@@@B@@A@@@@CA@@@@A@A@@@@A@@@@A@A@@BA@@@A@A@@A@@GB@D@@@@GA@@@AAA@@@@A@A@@@@DCAAC@A@C@A@@BA@CH@@CB@@A@A@@B@@A@@@@AA@@BA@A@@BA@@BC@@@AD@@C@A@@DA@C@@@ADEBADBBABA@AD@BCACF@B@@@@@@AB@B@@@B@@@@@@AD@B@@@@@D@@A@@@A@@@B@@B@@@@EH@B@@@@@B@BCB@BAB@@OB@@A@@B@@C@AA@@EB@AA@A@A@@@@@@@A@A@A@@@@@A@CB@BEA@BABAB@@A@@@BA@@@A@@A@@@@@AB@@@@CCAAA@A@@A@@@CAAA@A@@@CB@@AA@CAAAAIC@@AC@AE@@@@@AB@B@BB@@BA@A@@BABA@@@@DAB@@ADEBAB@BAB@@@BA@@@A@@@AB@DC@@AC@@BE@@@A@C@@@AD@@AB@DA@@BB@@BA@@B@@@@@@B@@BA@BB@@@@A@@B@B@@@DA@@@@BE@@BA@@@@@@B@@@@AB@@AAE@A@@B@@A@@@@B@@@DA@B@@BABA@BB@BCA@@@B@@A@@@C@A@ABAAABBB@@@@A@@@@@@@BB@@@@@@C@@@AD@@B@B@@@@B@@@B@@@B@@A@@@BB@B@B@@@BB@BB@@@@B@@BA@@B@BA@C@@@@A@@@@@@@@AAA@A@@@A@A@@@AB@BA@@@A@@@A@@@@@DAAA@BA@@@A@A@CBA@@BCBA@@B@@@B@@@@@@ABAB@@@@@@D@@@BAB@@@B@@@@@@BA@GB@B@BFAB@@@AB@@A@@@B@D@B@@@@@@B@@@BA@@@@B@@A@@@@@@@@B@@@@@@@@CB@B@@@BA@@@@B@@@@@@@BB@BB@BABB@@@@BB@B@BA@BB@B@@BB@@D@@@@B@@AB@B@@@B@@B@@@AB@@@B@BA@@B@@BBB@B@DBBBB@B@@ABB@@B@@AB@B@BA@@DC@@@ABA@@@B@@@@BEFAAEBC@@BEBC@@AA@ED@BABCB@@AB@@AA@@@CABAA@A@@A@@@@ABA@@B@@AA@@@@@@@@AB@@@B@@A@@DC@A@@AB@A@@@A@@@@@A@A@A@A@ACBAA@@A@@@ABCDA@A@@@CBA@AB@@@B@BCBC@C@AB@@G@A@A@@CA@@AA@CC@@@AABA@@@A@@A@AA@CABA@@@@A@@A@@@@A@@@AACA@@CAAAACACBA@@@AA@C@AA@@CA@@A@E@ABA@A@A@ABAB@B@BAB@@AB@BAB@@@B@B@B@B@BABEFA@@BA@A@@@AB@@AB@@ABA@@@C@ABC@C@A@ABA@ABAB@@@BBB@@@@A@A@A@ABA@@@ADADA@@BA@A@EBC@@AA@@AB@@A@@@AAA@@AAABA@ABC@@@ABABABA@CBEBA@EDCBABC@A@CBABCDA@@@CAA@@@A@AB@@C@AAAAABA@CBA@A@A@@AB@@@@@@E@A@@A@@@@@@AB@@AB@AA@A@@AAACAC@AA@AAAAC@@@A@@DADAB@BAB@@@BAB@D@B@FBB@B@@ABA@CD@@ABA@ABA@AB@@ABAAAAA@@@A@A@AB@@@AA@C@AA@@ID@@ABB@BBDD@@@B@@AD@@@BB@BBBBB@BBB@@B@@AB@@@B@B@@@B@@@BBB@BA@@@A@@@@@@B@@@BBB@@BAB@@@B@@B@@@@ABA@CBABABABA@@A@@@B@B@B@@B@@BB@@B@@@BB@B@B@B@@@B@BA@BBBBBBF@@DBBD@B@@CA@@@DA@@@@@CB@@@@@B@B@@E@A@@@@B@@@B@@@B@@@BB@@B@@D@@@@B@@A@A@@@@BABB@BBB@B@DAD@B@B@@@AA@@A@@AB@@@@ADBBB@@D@BBDBBBB@BA@@@@@@CA@@@@@@BAB@@AB@@@B@B@@@B@@ABA@@B@B@B@BBBA@@@BAB@@BBB@@@@@AB@B@@B@B@BB@@ADBB@@B@BB@@DA@ABA@A@@B@@@BA@@DABB@@JCB@@@BBBBD@DBBB@@BBB@BB@@F@B@HD@@FAB@@@D@BAD@BBB@@@B@AA@@D@D@@@@@@BAB@@BB@@@@DAB@HBDBB@BB@@B@@AB@B@FA@@B@B@B@DABAB@BBB@DDDDDFBB@BB@@@@AB@@B@@B@@ABBB@@B@BB@B@B@DB@@@ADBBA@AB@@I@A@A@@@@D@@B@@@AB@@ADB@@HB@@@BB@@@@A@A@@BA@@B@@@@@@C@@@@BB@@@@@@BA@AB@B@@DL@@@@@@BB@D@@AD@DBD@B@FB@BB@@AB@@@@AB@@@@BB@@ADBB@@@@C@@B@@@D@@@BADB@A@@@AC@@@@AA@@AD@@A@@A@@@@E@AB@BB@@@BB@@A@@B@@@@@D@@@@B@@B@BAB@@@BAB@@@DB@@DCB@@@@B@@B@@@H@H@@@@B@B@@FAD@BB@BAF@BBB@@BAB@@B@@B@BA@CB@@@@AB@@@@@BA@A@@D@@@@CB@@@J@BD@B@@B@BDA@@@BB@B@@@@B@@@@@DFBDBBDABD@@@@B@@BJ@@@BABCB@B@@@D@@@B@BBF@B@B@BAB@@B@B@@BBD@@AB@@AEA@@B@F@@B@@@AB@@@F@@@D@@BB@AA@@@@B@@@@@@@B@B@@@@DBA@BABBDB@@FB@@AB@BAB@@BBA@@@ABBHA@@BBA@BBCBB@@BCBCB@B@B@BA@BB@@@A@@B@BA@B@@@@BB@@@CB@F@B@B@@BB@@@B@BD@BFCB@JCBABB@@BFB@@@@@CLAB@B@BFADAB@DCFABA@CB@BADALEB@B@F@F@B@DABAHABAD@BABAFAB@FCFCBAB@DAB@@@BABAB@@ABA@@@AB@@AB@@AFADA@@D@DABAB@@AFCB@BA@@DA@AFADAD@B@B@B@DAB@@@@ADAFCDABCBAFCBABEBABCBEDABABABAFAD@LEB@B@DBF@D@F@D@@@B@B@B@BABA@ADC@A@A@EBADABCDC@ABADAFCDABAD@B@BABAB@D@@@DAB@D@B@@@B@BBB@@@BB@AB@D@DAHAD@B@BA@@FAD@DABAB@BAFAFCB@BA@@B@B@HCB@DCBABAA@CCCCA@@A@@@@A@AA@@@A@@@A@@AAAA@AA@B@@AH@D@@AJ@FAH@H@F@@BD@@@D@@DD@B@D@@A@@A@@A@@@A@AA@CA@A@CA@@A@GA@@CB@@AA@BC@@CA@@@@AAE@A@@@E@@@EA@CC@@@@@AA@AC@A@@AACC@@@@A@CA@@A@AA@@A@A@@B@@@BE@@A@@AD@@AL@@AF@D@@CF@@@@@AA@A@C@@@@D@@@@@@@N@@DD@@@@B@B@@A@C@@@@B@BBBB@@AB@@@@CB@B@F@@@@F@@@@D@FA@@B@BA@@@@@@@CB@@@B@@@@A@@B@@@@@B@@A@@AA@@BAB@@B@B@@@@@@@@@@@B@@@@@@@@@BB@@@BB@@B@@AD@D@ACB@@@@A@@BA@A@@@@"],encodeOffsets:[[116001,34670]]}},{type:"Feature",id:"410425",properties:{name:"郏县",cp:[113.212609,33.971787],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AFEBA@C@AB@@A@@BABAHEB@BC@ABC@C@A@AAC@@@A@A@A@E@AE@E@CA@@I@@A@@@AI@@@A@A@A@@@A@A@@@@BCBA@@@C@C@@@C@@KB@@CAG@A@@G@A@@AAI@AHE@@@KC@EAI@C@A@@@CDA@CBCD@@CFAD@BABCBAB@@A@C@AACAA@GAAA@@AAAAAAAAA@@AAABACIAB@@@D@BABAFADAFABAD@BA@@BBB@B@@DD@BBH@@BB@FD@BJC@@@@BBB@BADA@@@AA@B@BBBA@C@EB@@@@@@A@C@@@@B@@E@AD@@A@@B@@A@@@@DB@@@@BE@@@@D@@A@A@BB@B@@@@B@@@B@@BD@@@@@@@@@A@@BB@@B@@@B@B@@@BB@@@@@@BC@IBA@@@@@@BAB@@@B@@A@BDC@C@@BA@@@AA@@A@@A@@@@@@@@@A@@@@@@@@@@@A@AA@AB@@BB@@@BA@@@@@A@@@@B@@A@@@A@@D@@@@@@ABA@@@EBC@@@@@@E@@E@A@A@@D@@A@@BA@AA@A@A@@D@B@@@@A@A@@C@@CM@@@@@@@C@@@@@@D@BBB@@@@E@@DC@E@@BK@@BC@@BB@@@AF@@A@@@@B@BB@@B@BB@@D@B@@D@BD@BB@D@@BBB@@@@D@@DFB@@F@@@B@F@BB@@@@DB@@ADB@@BA@@DB@@H@BB@@D@BDBB@@B@B@@@BB@@@@BC@A@C@@CC@@@C@@AE@G@G@EBI@@BC@G@@BA@B@@BBBBB@@@B@@@B@@BBB@@@@@@BB@DDDDB@ABABCDA@GDA@A@@@ABA@EDEBABA@ABCBC@EB@@ABA@C@GBCBC@A@@BAA@@A@AAA@@@A@C@A@CB@@C@A@ABABA@C@ABCBEDCBAB@BCDADCBAB@F@B@BCD@BABABA@A@A@@@C@E@C@E@CAA@A@KFC@EBABABABCBAFADABAFABEDABADCBEDCB@B@@A@CBA@A@A@C@CBEB@BCB@@ABA@ED@BA@ABCBC@@@CBEB@BA@@BA@@B@@AB@BA@ABAB@@A@CBA@ABEDEDA@EBABABC@ABGBABCBA@E@E@A@A@KFCBABA@@DABEBCDA@CBEB@BAB@BADABAB@@AD@B@BDB@ABB@@@B@@BB@@@@BBCBB@@BBA@BDJB@@BBBB@@@A@BB@@@B@B@@@@@BCBA@@B@B@@ABA@AB@@CD@@EDEB@BA@@@AB@@@BEFBB@@@@CBAB@@B@@BB@BBBA@@@CBABB@BB@@@@DBBE@@BD@BB@DB@@BBA@BA@BDA@BB@B@B@B@@@@B@@@B@D@@B@BA@@B@@@@@BB@@D@D@B@B@@@@BBB@DDD@@BD@B@@BA@@@B@B@BB@DA@@DD@@BC@@D@@@BD@@@@D@@@AA@AAA@@B@B@@C@@@B@@B@@@@B@@@@BAB@B@@B@@@BB@BB@@@B@@@@@AB@@@@B@@@DB@DA@@DAA@B@@@@BBBAB@BB@@@A@@@@B@@BB@B@@@BA@B@AB@B@BBA@@BBA@@B@@@B@@BBB@@B@@@@@DF@@@@@AB@FBDB@@@B@@B@@@B@B@@ABBBA@@BABDD@AAD@@BB@BB@@D@@D@@DABBB@@@@@@@D@AAA@@AB@@@B@B@@AB@@BA@@@B@@B@@@@AB@@A@@B@@A@A@BB@@A@AA@@@BB@@BA@A@@@D@@@B@@B@@@DBBBBBB@@@B@@AB@@@BB@@@B@@B@@@BAB@@@@@@B@B@@@B@@@@@@B@@B@@BB@@@@B@@B@@@@@B@@A@@@@@A@@@@@BB@@BA@@B@@@B@@@@DD@@BA@@B@@@@B@@@B@B@B@@@@@@BB@@@BBBBB@@@B@B@@BBBDDFBD@@DBBBB@@@B@@B@B@@B@FDD@@@B@B@D@@AAC@@@AB@B@B@@A@@@A@ADA@@@A@@AAA@@@@ADCB@@A@@BA@@B@@@BAB@B@@AB@@CDGB@@ABCB@DCBADCBABA@@BAB@@AB@@A@A@A@@AAA@@C@@A@@A@@@A@@@A@@AAAA@A@@@@B@B@@@BAB@@@@C@ABAAABC@A@@@@@@BAB@BBB@B@@@BA@@@A@@BABCBABA@BF@B@B@B@BA@@B@@AA@@A@A@A@@@AB@DA@@@C@C@@@@@AB@D@@@@@@@BA@CB@BCB@DBB@BA@@B@BGBA@@DAB@BBB@D@@@@@C@@@@BBD@@B@@A@@@A@@B@@@BB@@A@@@@@B@D@B@DAB@@@BABC@@@@BBBDDBHDB@DBB@BBB@BBB@B@@BD@FBDBDBF@HBB@B@@@@@HKBCBC@@F@F@@@B@@A@C@C@@@@@@@@BA@@B@@@BA@A@@@@@@@A@A@@B@@@DDB@@B@@B@@@HB@@@@B@@@@@@D@B@@B@@B@@@B@B@@B@@@B@B@BB@@@D@@A@@B@@A@@B@@@B@@@@@@@@@BAB@@@@@@BB@@@BC@@@BB@BBBB@B@DBB@D@B@B@FBBAB@@@@ABA@@@C@@BA@AB@@@DA@@@@@A@AB@@ADB@@FAA@@AB@@CBB@CB@B@@BB@@A@@@@D@@A@@@@B@D@B@B@@@BC@@@@@@@AA@DA@A@@@A@@@A@@@@@@@@BAB@@@AAB@B@FAAAB@B@@@@@BCB@@B@@@BDA@@B@@A@@D@@CB@B@@A@@@@@AD@HAB@@@@AFA@@BA@AD@@@D@BB@ABD@AHA@@H@@EB@@AB@@AB@B@B@@G@@J@@AD@@C@@@AAAA@@AB@@@C@@AA@@AEB@A@CA@@A@@A@@A@@AA@A@AAA@AA@@A@@A@@A@@@@@AB@@@B@BABC@@B@@CFA@A@@@@B@@A@AD@@A@AD@@A@ED@@EB@AEB@B@@AH@D@D@H@@@BJA@@@A@@FC@@FDA@F@@BDDBB@BBB@B@B@@@B@@AB@AB@@@DB@@AB@@A@@@CDBF@@A@AF@@AB@@CB@@DB@B@ACB@BB@@B@@@FA@B@@@@B@B@B@@A@@@@D@@B@@@AB@H@B@@@@@BB@@@BBB@B@B@FF@@AF@B@@C@AH@@A@A@@A@@AA@@K@AA@@AA@@AB@@AA@A@@AB@@CB@@AB@@A@AH@@@JA@@BA@AJ@AEB@B@@CD@D@@@AE@C@AE@C@@A@CE@CC@@@@@CC@@@C@@@@@AA@A@@AA@@@@BABA@AB@@@C@@EF@@A@@D@@B@@D@LA@@AG@A@@ACB@@BF@@A@@D@@@F@@@@@@@@AB@AEBAB@@A@@BCA@@@@C@CA@@@@C@@@A@@BCBA@A@@@EB@AE@AA@@CC@ACC@@C@@@@ACB@@G@@B@@GAAD@@AC@@@@A@AC@@@@A@AC@@@A@ABAB@@@@A@@A@@EAC@AAA@@@CD@@@AE@@AE@A@@A@@A@AAA@AIAE@A@@@@AA@@AE@AA@@A@A@CA@@@AA@@@@@@@CAAAE@@A@@AAIA@@@A@AACA@@CAAA@CAED@@@@@CGCGACEE@@@C@A@@BADAFC@AB@@CB@AC@A@CAE@A@@@CBA@ABA@C"],encodeOffsets:[[116092,34672]]}},{type:"Feature",id:"410423",properties:{name:"鲁山县",cp:[112.908202,33.738293],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@BFAACA@AD"],["@@@@@C@@A@A@@@@A@@@@@BA@@B@@@@A@@@@B@@@A@@@@A@@A@@@@@@@C@@@CA@@@@A@@@@A@@@@AB@@@A@@@E@@@@@A@@@@@A@C@@@@BA@@A@@@AA@C@CBA@@@@@@B@BDA@BC@@@@@@@B@@BA@@@AD@@@B@@CACCA@@@A@@@@@@C@@@@AF@@BD@B@@AAA@E@@@CE@@@@C@@BA@E@@@@@@B@@@@CAA@A@A@@@@BB@BBBB@@D@@@@B@BB@@@@@A@@BA@A@@@@B@@@@A@A@@@@B@@C@E@A@@@@@@@@@B@BBB@B@@BA@@@@B@@B@@@@B@@@@@@@B@BCB@@@@A@@@CA@@@@BD@@ABAAAAC@@@A@@@E@C@AACA@AA@@A@@BA@@A@@AGCA@CA@@C@C@A@@@A@AAAAAAAAC@E@A@@@AB@@@@A@CCCAA@CAGCA@CA@AA@AAC@A@A@AB@@@BBB@B@@@@A@@@A@AEA@@A@@CAC@AB@BA@C@EBE@@B@BA@@A@AA@@BCBAD@@A@@@AB@@AAA@A@A@A@A@A@ABA@A@@@@A@@@@AAA@@@@@CBA@@BA@@@C@AB@@BB@@@B@BAD@B@B@@A@C@@@A@ABA@ABA@A@@@@@AD@B@@CD@BA@@@A@AAA@CCA@CAABA@ABEDCBABAB@@@BA@@@@@E@A@@@A@ABAB@B@B@B@@ADA@@BBB@@A@@B@B@B@@A@@BC@AD@@@B@@@BBB@B@@@@A@C@A@C@@@A@A@C@CAC@A@CAA@E@A@CBE@@@A@@@CBA@@@A@AA@@@@ABA@@BABA@ADA@EDEBC@A@@BCEAAAAAAAAA@@@A@A@C@C@C@AAA@A@ABA@AB@@A@A@A@@BCB@@A@@@A@@@@A@A@@AACAA@CAA@C@@@AAE@@AA@@A@AA@@A@@AA@A@A@AB@BAA@@AAA@@AC@@@ABA@A@A@C@@ACACA@@@A@A@@AA@@A@AA@ACA@@AA@A@CB@@A@AAAAA@@@A@A@@@A@CB@@CA@@A@ABA@A@@@AAAAA@A@C@A@A@A@A@@BABA@@B@@ABBB@@AB@@A@@@A@A@@@A@CCAA@AAAAA@@A@A@C@A@@@A@@@@@AC@AAA@@A@CBE@A@AA@@CC@@A@A@CC@@A@CB@@C@@AAC@AACA@A@EA@@AAA@@@CB@@@BAB@@A@EB@@@B@DBB@B@@@B@BABA@@@A@A@A@C@CAA@A@A@C@A@CB@@@@@BAF@B@BA@@@AB@@AB@@AD@@@@A@E@C@AAA@CBABC@@@AB@BAD@B@BA@A@A@A@GC@@A@@@EBA@E@AA@@A@@AA@@@C@ABA@C@A@C@IB@@@AA@@A@@C@C@ABA@A@@@@BAD@@AFA@AB@@A@ABA@AA@@CBC@A@A@C@ABABA@@BA@CB@@ABEBA@@@@B@F@@@BABA@A@AB@@AAEC@@A@A@A@A@CAA@AA@@CACCA@AAC@CAA@A@@A@AA@@@CBA@ABC@A@A@A@E@@@GBCBA@@@@B@B@@GF@B@@@B@@@B@@@@@B@@BD@@ADABAB@D@@@@A@E@A@@@ABAB@BA@@BA@A@G@CAA@A@ABA@@@CCAA@@CA@@@C@@@A@@@CA@A@AAC@@A@@@@AAAA@@AEAA@AAA@@AAA@AAAA@@A@GAAA@@AAAAAE@@A@@AA@A@A@AAA@AAA@C@@@A@@BCB@@@@@D@@@BAB@@A@@@@AAAAC@@@@CAA@A@CAA@A@A@@AA@@@A@AA@@@@GB@@A@@BABAD@B@B@@A@@@ABA@C@@B@@@B@@BBBBB@@B@BA@@B@B@BBF@@@@@@GBA@ADA@A@CB@B@@@@ABABAB@B@B@@ADA@CBA@CB@@@@AB@BA@AB@@@B@B@@CB@B@BCD@BABA@A@@BAB@D@@@@AB@BBB@BA@@@CB@@A@@@AA@@C@A@A@A@AB@AAAA@A@AA@A@@EDA@A@A@@AA@ABAB@@A@A@A@CBAB@@@@EAA@A@CB@@AD@@@@A@@BAD@@@B@@C@A@AB@@AB@@@@A@@@@B@@@B@B@BA@@@A@@@A@@@AB@BA@@B@B@@@B@B@@A@A@A@A@@@A@A@AA@@A@ABAB@@@B@D@@@BA@@B@B@BCB@BA@A@C@A@@@ABABA@ABA@@B@BAB@@@BAD@@@@A@@@A@C@C@CAA@@@ABA@A@AACAAACACAAACACAA@@A@@@@EA@AA@AAAAAAAA@@AB@@AB@AA@ABCBCBEB@@A@AA@@A@@ACAAAA@GE@@A@AAC@@@A@ABAB@D@@A@@B@BAB@D@@A@CBA@AAABA@@@A@A@AAA@A@CBA@@@AAA@ECA@A@CBCBCBA@@B@BA@@D@@A@@BA@A@@B@@@B@@@BAB@@CDABEBA@A@A@@@ABC@@@@B@@AD@BBD@D@B@D@@AD@BA@ABCBC@ABABC@@@E@@BA@A@@BABA@ABC@A@A@@@EFABAB@@@BC@@@AA@@@B@@AB@B@@A@AAA@ABA@AAA@AA@@A@ABA@C@@@C@AAA@A@@@A@C@A@ABC@@B@@@BA@@@ABA@@@@B@@@B@@AB@@@B@@CB@@@@AD@@@B@D@B@B@@@@@@BBA@AB@@@BB@BBB@@B@@AB@@B@@@B@@@BB@@B@@@B@B@@BB@BB@@FDBB@@BB@B@BBB@@@@@B@@A@@B@@@B@DBD@B@B@@AB@@@@@BBDBB@@@D@BBB@D@B@B@D@@DB@@@@@B@@@BA@CDEB@BA@@BABA@AB@@A@BB@@@BBBBB@D@@@@@B@@@BB@B@B@B@BBB@B@BBB@BBB@@@BB@B@@BB@@D@@@B@BBBB@@@@@BAB@@@BAB@BED@@ABB@@DB@@BD@B@BB@@BBB@B@@BDBB@DD@B@@A@@@A@@@@B@@@@B@F@@@BABAB@@A@AB@@@B@@@B@BBB@B@D@DA@@FFB@DDBD@@@B@@AB@BA@@B@@@BNDB@B@@B@@@B@@@BB@BB@@FBB@B@B@B@B@FAB@@@BB@@FB@@B@B@B@B@BAD@B@@@B@@@BB@@@D@@@B@@@B@@@D@@B@@@B@B@D@DB@@@@B@@AB@@AB@BBHBB@@@BDB@ABA@@BB@@BD@HB@@@@B@@@B@@A@CBABAB@B@@@B@BCDADAD@BAB@BAB@@@B@B@@@B@B@@@B@@BDBB@B@B@BA@A@@BA@AFEBADA@A@A@C@ABA@@BABC@@@AB@D@BADAF@B@B@B@B@FBF@B@@BB@D@BBHBD@BB@@BD@@@B@@@B@@@@BB@@@@@B@B@@B@DBB@@BBD@@FF@@B@@@@@@BB@FD@BBB@@@B@@@@B@@@@BDD@BBBDB@BBB@BBB@DBBBDB@J@BBDBB@BBBBB@@BB@B@@@D@B@BBBB@BBBDBBBD@B@B@BAB@@AB@B@B@B@B@@ABAB@DBD@B@DB@@BDB@B@J@@@B@BAB@B@@@BBB@@@B@B@@BFBB@B@BBB@BB@@BD@B@B@B@D@@@B@@DBB@B@BBBA@B@@BBAB@BBD@B@@B@B@BAB@@B@@@@@B@@@@@@@@ABA@ABBBBBBBB@B@DB@@BB@B@@@B@@ABB@@@@DBB@B@@@@@B@BBD@@AB@BAD@@IBA@C@@@CBA@A@A@AAABA@EBCB@@ABADAB@@@B@BCBCDAB@@C@@@A@ABA@EBC@EBABA@AAA@@B@BADBB@D@BBB@@AD@DBB@@@@B@@@F@B@BBB@DBB@BBB@B@DBB@BB@@BBB@BBB@FBB@D@BDD@BBB@B@B@@@BB@B@D@@@B@B@DBB@@ABAD@B@@@B@DBB@@DDB@FBB@@B@B@B@BBBBDBB@D@@BBBB@BBDDFBB@@BBBBBA@BB@@@@B@@@BB@@B@@A@AB@@@F@D@BBABA@@BAB@@AF@B@@@FCBA@@@E@EBA@AB@@@D@@@B@BAB@@ABA@AB@B@DAB@D@D@ABAD@DA@@D@D@HBF@BB@BB@@BAD@@AB@DB@@FAD@DABA@AB@BC@@DAB@BAB@DA@@B@BBB@@@D@BA@@BABABCBCBBD@B@B@@BD@@BB@@@B@B@@AB@B@BA@BD@DBB@@@B@BA@@B@BBBBDBB@@BD@B@@AB@B@B@B@B@@@BA@A@A@A@AAC@ABAB@DABA@ABA@ABCB@BABADEBABA@@@@B@@@@@BB@BBB@@BBHBBB@@B@B@BBB@B@@@BB@@B@B@@@DBB@BB@AA@AA@A@A@@BADA@ABC@@B@B@@AB@@ABA@AB@@@B@B@B@B@@@@@AA@@@@@A@@B@B@B@B@@@@@BA@@B@@@BA@@@C@@@A@AD@BABAB@@@BA@@B@@B@@B@@@BBB@D@@@BADBB@BAB@B@B@@@DAB@B@@@B@B@B@BA@@JC@@BBD@B@@B@@BAB@B@@@B@BBBBBA@@BAB@BAB@BA@@DCB@BA@@@AAA@E@A@CBA@A@@BA@ABABC@CB@@@D@BBBBB@@BBDBDBB@@@BBBA@@BA@@B@@@@B@@@@B@F@@@@A@@BB@B@B@DAB@BABBBBD@@@BAB@@@B@DB@@B@DCBADAB@D@BADAFCB@FADAB@BABABA@@D@BAB@BABB@@BB@B@@@BA@@BB@@BD@FAB@B@@AB@BCBC@@B@BAB@B@B@@@@@AA@A@@BABAB@BAB@D@D@BAD@@@B@BA@@BA@@BA@@B@B@@AB@FEBA@A@A@A@A@A@@BA@ABA@@BA@A@ABABAB@BEBA@ABAB@@A@AB@@E@EBCDGBA@@BADEBABA@@D@BABA@@BAA@BABC@AB@@BB@@B@@@AB@BA@AB@DCDABA@@B@B@B@@@B@BB@@HAB@@B@B@@@BF@@BA@BB@@F@@@DA@AD@B@B@DABAB@B@D@@@@B@BAB@B@@@B@@ABA@@@@B@B@@@@FBBB@AD@DDDA@@B@F@@CA@@EB@BB@A@A@C@@@@AAA@@CBCBA@@BA@AB@@@B@B@@@@B@@@@BB@@@@AB@D@BBBDB@@@@D@@AB@BB@@@@@@B@BBBABBBAB@D@@@B@@@@A@@DB@AAAB@BA@AA@B@@C@@@A@@B@@@@AB@F@BB@@BA@@@@@A@@@@B@@AF@@A@@B@@C@@@A@AB@@@@@AAB@@AA@@@@@@@@AB@@AA@@AB@@CBA@@BC@@D@B@@@F@@AD@@BD@@CBA@@B@@@B@@A@@BA@ABAFABC@@BA@C@@B@BA@AB@B@@AA@@A@ABA@@@@F@@BBD@@JDBBBB@DBB@@DA@@B@B@BB@D@@@BB@B@BBDD@@@@BA@@@@B@@@@B@@AB@@B@@@BABA@AFB@ADAB@@@@@B@B@B@@@@@@@B@B@B@@BFA@@BBD@@@@AB@@@PA@@BA@ADA@A@A@@@@@AFG@@@@@AA@@@B@@@B@@@@C@@@@@ABC@@@@@@@A@@@ABA@@@@@@@ADEDB@ABCB@BAAABCFABC@@D@B@@CB@D@@@BC@@D@@AB@@AB@B@@AB@@B@@B@@@@AB@B@@@DA@@DGB@@AB@D@B@D@BBB@B@D@@@@CBGDB@AC@@CE@@@BAB@@@@A@@A@@@BC@A@@A@@AB@@A@@A@@@@@B@@@@@@AA@@@@@@@B@@@@@@@B@@@B@@A@@CA@@@G@@B@@AB@B@@C@@BC@@@A@@@A@@DC@@@A@GDM@C@AD@F@BBD@B@BAD@BAB@DCBABABA@@BC@A@AB@DADABADCB@@@D@@@BBD@@@D@B@B@DBB@B@D@D@@@FG@ADC@@BCDI@@@E@@B@@CAEA@@ED@B@@A@@D@@ABB@CF@@A@A@A@@D@@@@B@@D@@@@GB@@@@@B@@@@@B@B@B@@@@C@ABAAAC@@C@@C@@A@@B@@A@@@A@@B@@@BID@@@BA@AJ@@A@@B@B@@@@AB@B@@@F@@@L@@@BB@@B@B@@@@AB@@A@@@@@@F@@@A@BB@@@ABDD@@@@@BBD@@A@@@@D@@@@A@@C@@C@A@@HAL@@@@B@@@B@DD@@A@@F@@DD@@@@A@@H@BBD@@FB@D@@AAC@@B@@@B@@AJB@@@@@A@CF@@@@AD@@ABAB@D@@@@A@AD@@@BCA@@BA@@@@AB@@@@C@@F@@@B@@B@@A@ABBD@@B@@@B@@@BAB@BABBBA@@@CA@@AA@@EC@@DCCA@A@@@A@@C@@B@@A@GA@@AA@@C@@@@@IB@@@@@AA@AA@AAA@@A@A@A@AA@@@@CE@@@@@@@C@@E@A@@@@IA@@@@@E@@C@@C@@@DA@A@@@@@BB@BA@@BA@@@@@A@@@@B@@@B@@@@@@A@@@@BA@@AC@@@A@@A@@@@A@@BGB@B@@A@@@@@@@@B@@AA@B@B@@@@@@A@AC@A@@@@@@@A@@@@BBD@@@ACD@@@@@C@@C@@B@@@@@C@@@@AA@E@AE@@A@@@@B@F@@E@@@CC@AACAACA@C@@@A@@@@AC@CB@@@@@L@@A@@J@@@@A@A@C@@@@@A@@@C@@@@B@@AB@BA@AB@B@@@@@@AA@@A@@@A@CAC@@@A@C@@B@B@@@@@B@@A@@B@D@@@@AB@@@B@@C@@B@D@B@@@@CAA@@@@@@@A@@A@A@@@@C@C@C@A@@AB@@@A@@A@@A@AAAA@AA@@AA@@@@A@@@C@A@@@AA@@@B@@A@@@@C@@@@@@@@@DA@@CBAAC@@@@@@C@@@@@D@@@@@C@@AB@B@@@@@A@AA@@D@@AA@@@A@@@B@@AA@@@@@A@@B@@B@@B@@A@@B@@A@@@@BB@@@@@@@A@@BB@@@@@A@CBBB@@A@EAC@@@@AA@@A@AA@@AAB@@@@E@A@@AB@@A@@B@@AA@@@C@@@@@B@D@@@@@A@@AD@AAB@@@ACB@@A@@@@@@@BB@@A@@@C@@B@@@@@@AA@@@@BC@@@A@A@@@@A@@@@@@@@@@A@@@@@A@@@@@@@@@@@AA@@@@@@@B@@@@A@@BAA@@A@C@@B@@@@@@C@@@@@@B@@A@BB@@A@@DB@@D@@@@@B@BB@@@F@@BF@@@@@@@D@@B@@@@@@@@C@@@@@@@@D@@A@@@@@@DA@A@@@@B@@A@@AA@@@A@@@AB@@@A@A@@I@@A@@@@@@DB@@@AA@A@@@@@@@D@@@@AE@@A@@@BA@@@A@@@@@C@@BB@D@@@@B@@C@@@@@BB@@@B@@A@@A@@@@@@A@@B@@@@AB@@AB@@@@@@@A@@A@@@@B@@C@@G@AB@@@@A@@AA@@D@@@A@@A@@@@A@ABA@@@AA@@E@AA@B@@A@@B@B@@@B@BAA@@@A@@C@@@@@@C@@@@A@@D@@A@"]],encodeOffsets:[[[115947,34476]],[[115853,34378]]]}},{type:"Feature",id:"410422",properties:{name:"叶县",cp:[113.357239,33.626731],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@AB@AA@A@AAA@@@@B@@AB@@@B@D@BA@@@AB@@C@ABAB@@AB@@@@AAA@@@A@@BBB@BA@@@@@AA@@AB@@@BA@@BA@@@AB@BC@@B@@A@ABA@A@@@C@AAA@A@CBC@BBABA@@BCB@@AG@@AB@@A@@AA@@@CBACA@A@@@A@A@@DCB@BCB@@@@@@A@DDA@@B@@@@B@ABB@@@AB@DABAC@@A@@BA@@@BH@@@B@@@BA@@@BBD@BD@@@@B@DA@@@DA@BDA@@B@@AB@F@@AB@AA@A@@@B@@BA@@@A@@A@@ABA@A@AB@@@@AB@AAB@CA@@@@B@BA@@B@@A@BBA@CB@D@BA@@@A@@@CBED@D@@@B@@@DAB@D@BA@CDC@CBEF@BAB@B@B@BABCDCD@@@BA@@BCBA@CB@@CBA@CBA@GBE@@@A@AAAAC@AAI@AAA@@@A@A@@@CBA@A@A@@@AAAA@A@@@@A@A@@@CC@@A@A@C@@@@@@AAA@@AAA@A@@@AAAA@@CBA@ADA@C@A@@@A@CFAB@F@F@D@B@@AB@@CB@@E@ABCBABAB@@CB@BAA@@BE@@A@@@EDCBA@A@C@A@CAA@ABC@C@ABC@AB@@A@E@@@C@C@A@EBA@A@A@C@AAG@AACAI@C@AAEAAACAEC@@AAA@@@@@ABA@CD@BA@AB@@@B@D@D@D@BABAB@D@D@B@F@@AB@B@@@BA@B@@@@@@BB@@B@@@BBB@B@FBF@B@@BBDDD@@BDBBBB@BA@@@@B@@BBB@D@@@B@@BB@BABA@ABAB@@AB@@@B@BAD@@@B@@BB@@@BA@A@@B@@@BBB@@@BAB@@@DBB@BB@@BAB@@AD@BAB@DABBB@D@FBB@DABAB@D@@BB@B@B@B@B@@@@ABA@EBA@AB@@A@A@@@A@@B@@@D@@@@@D@@@B@@@B@B@B@@A@@B@@@@A@E@A@CA@@ABED@B@B@@DF@B@BAB@@@D@@ABA@@@A@@D@@DB@@@BB@BFB@@@B@@@@@@AAA@A@@BAB@B@D@BBB@@BDBB@HDDBB@DBDDB@@@@@BA@@B@F@D@BBBBBBBBB@@@B@D@D@@@DBB@HD@BB@@@AB@@@BB@@BDBBBD@F@@@B@@@D@BBBBBA@@AC@@@@DB@@B@@@@@DA@A@A@@@@@@@A@@A@@@@A@@B@@AA@A@AAA@@@@@@@@@B@F@D@@@@A@@B@B@@@@@@A@@B@B@@AB@@@@@A@@A@A@@C@@@AAAAA@@A@@B@B@B@DB@@@@@A@@@@F@B@@AD@@@@@DF@@F@B@BB@@@AAC@@BE@@@@@D@@@@B@@@B@DDDB@@@A@@BC@@B@@AA@@@@@@@D@@ACB@A@A@@@@B@DAD@B@@B@@@BB@@A@@D@B@@@@@B@@@@@F@@@B@@@A@@B@@B@@@@@@B@@B@@D@@@D@@@@@@@BB@@@@@@B@@@A@@B@@@@@@AB@@A@@@@@B@@B@B@@@@D@@B@@@@CB@@@@@@D@@@@D@@@@B@@BB@A@A@@@A@AB@@@@ABBF@@@BB@@B@BAB@@@@@@BB@@@C@@@BB@@@B@@A@@B@HD@@@@A@@B@@@@B@@@@@@BA@@BA@@@@@AB@@@@@@@@BB@@@@A@@AA@@@@D@@@@A@@C@A@@AD@@@@@B@@@B@@A@@@BF@@B@@C@@@@@@@B@B@@B@@CA@@@@@@@BJ@@@@B@B@@BA@@B@@@B@@BB@@@@A@@B@B@@C@@@@B@@@@C@@@@@@D@@@@@@@@@@AC@@@@@@@E@@AE@@@A@@A@A@@@@@CA@@CB@@@AAB@@@@A@@@@D@@@@@@@@AD@B@@@BB@AB@@@@@@A@@@@@@BB@@@@@@@@@@B@@@@@B@@@@@@@@@@@@B@@B@B@@@D@@A@@B@@B@@@@A@@@@D@@@BA@@A@@@@@@@BA@BD@@A@BBC@@BB@@@@@C@A@@@@@D@@@B@@BA@@@@BA@@BB@F@@@@@BA@BB@@B@BB@@B@@D@FBB@@@AADAB@@@@@A@@AB@@@@@@@A@@A@@B@@@@AB@@@@AA@@@@AB@@@@@B@@BA@@@B@@@B@@BC@@@BBB@@@@@A@A@@BD@@@@@C@@@@@D@@@@@@@BDAB@DB@@C@@@@@@@@@D@@B@@@@A@@BB@@B@D@@@B@@@@@BB@@BBB@BB@B@BB@@@@B@@BA@@@B@D@D@D@@B@B@@@@B@@@@@@BB@D@@A@C@A@@@@DA@@@A@@B@@C@A@@@@BA@@@@@A@A@@@@D@B@@BD@D@B@@@BB@@B@@@@A@A@@BABA@@BA@@@@@@D@@@B@@@@@D@B@B@@I@@@@BK@@@@@A@@DBD@@@@@B@@@DDBBBBD@BDD@@F@@@@E@A@@B@@@BFF@B@@B@@D@@@@@A@@@@DD@@@@@C@BD@@C@AA@@@@@B@@@@@@@BBDB@@@@@@@@A@ABB@@@A@@@@@@B@@@@AHA@AB@@@@@@BB@@@D@@BB@@A@@B@@@@@@@@A@@@A@@B@@@@@B@@AB@@AAA@@@@B@B@@C@@@DD@@@@F@@@@JB@@@@@B@FD@@@@@@@F@@D@@B@@B@B@B@BB@BBB@@BBB@@@@A@@J@@@@@DB@@BB@@HF@B@@@@@@A@@@AB@B@@@@@B@@CB@@@F@DB@@D@@@DB@D@B@B@@@@B@@@F@D@@@@B@@@@@BA@@D@@F@@@@B@@B@CB@@@@F@D@@@F@@@@BH@@@@A@@@@B@BA@@B@BA@@@@@@B@@B@@BAB@BAD@DB@@DA@AB@B@B@B@B@@@B@@A@@@A@@@A@AB@@ABAB@DCDAD@F@B@B@B@BBBB@@DB@BBBBBBD@B@DBB@B@@@BB@BBB@@@H@D@BA@@B@@@@@BA@@@@@@B@B@@@AB@B@@BB@DB@AB@@@D@@@@B@@@@B@@@B@JDFBBHABAF@BA@@B@@A@@FAB@BDB@BFFB@BH@@BB@@@@B@@@BA@@@B@@F@D@@@DD@@D@@BB@B@@@AB@D@@BB@D@@BD@@@BJC@@@@@@B@@@@B@@B@@@B@@@BA@@F@@@BB@@BB@@AH@B@@B@@@@B@BB@@D@@@B@@BF@@@B@@@BB@@D@@BB@@BB@@@@D@@@DD@@BC@@D@@\\@L@J@F@H@J@D@FADAB@FADALAd@N@BBH@D@@F@BH@BHD@@D@FA@@B@BC@ADAA@B@@@B@B@@@FB@@@BBDA@@BB@@D@B@B@BB@EDB@@B@@DB@BBB@DB@@B@BBFBDBFD@@@LJ@@D@BH@B@@@FD@@@@B@D@@AD@@@@@@@B@@A@A@@LB@IL@F@@@B@B@B@B@DB@@BAB@@CB@J@@@@@BA@@F@J@@G@@B@D@N@@@B@BHDB@ADADAB@B@BBB@@B@DBD@@B@@@BBBA@@DC@@@A@@@A@AA@@AAC@AACCC@CA@KBG@C@@@@@@CDBBCF@B@HBB@BABAB@B@B@F@B@@@@@BA@@AEAE@@@A@@@AB@B@B@DDB@BB@@B@@@BA@@@A@A@A@A@AAA@@@AA@AAGCAA@@@A@G@ABABCDA@@AC@BCBAABAAA@@GB@AB@AADA@@C@@@A@@@BA@@B@@ABAB@@@B@B@@@BEBA@@@A@AA@@CAA@AB@@EAB@AB@@@C@@AB@@@A@@@BA@A@ACBAABAA@@A@B@BA@@A@@@@A@@C@@AC@@B@F@@EB@@A@@@E@E@AB@AC@@BAB@@@@A@@@AB@@@@@@A@@BAB@@AD@@@C@BAA@@EB@@@A@BA@@B@AA@@B@@@A@@@@ED@@@@@@AN@@@BAD@@CD@@CB@@CD@AC@@@A@@N@B@@AAEB@DA@E@@F@F@@@@EA@BC@@@A@@B@@C@@B@@ADBBABABD@@B@@@@@@@DCD@A@@@@A@EC@@@@@C@@@A@@A@A@@@@@A@@AC@AA@@@@A@AABE@CB@@AB@@A@C@C@@AA@@@@A@A@@@A@@A@@A@@A@@A@A@A@AA@E@@@A@@CA@@@@@E@I@@AA@@A@@GBE@@@A@@@@@@@SW@C@@AB@@@@AAACAA@@A@A@@@@BA@@B@@GA@EA@@FEAKCGABA@ADB@AC@@CA@@@D@@E@@C@BAD@@AC@@@@AA@BA@A@@@@@A@@BAA@BA@@@AB@@@B@B@B@B@J@@A@@@CAA@@@@@A@@@@@CC@@@@CC@@@A@@CA@@@@AD@@A@AG@@DA@AA@BD@@BC@@AC@@BA@@ABAA@@@@AB@@A@@D@@A@AD@@@F@@AA@@A@@E@@AA@@BAA@AABE@@AA@@CA@@@A@BA@@@C@AA@@C@@@AA@@@A@@AA@@A@@@GA@@A@@@A@@@@@E@@@A@CA@@CB@@ECA@@@CA@@C@@@C@@EA@@@@A@A@BCAGB@@@@@BC@@@EB@@CB@@C@@@@@C@@A@AAA@A@@@A@A@GHADABABA@A@A@A@@AAAC@@BMB@DGB@GA@@BC@@@@C@@AE@@@@M@@AE@@BA@@@C@@AE@A@EFA@@@A@@BABCA@@B@@AAEB@AGH@@@@@@A@@@C@A@A@@@@B@@@B@BD@@B@FAB@@@@@CCCCAAAAGK@A@A@AACAAAEA@@EAA@ACAAA@AA@@A@A@A@CBEBCAC@C@A@@AA@@@A@ABC@C@@AAAA@@C@CA@@G@AAA@@A@C@@@EB@@@B@@CI@@AC@@AF@@A@@@CF@@@B@@A@@@AB@@A@AB@@@B@@CA@@@@A@@BAAA@@@A@AA@@AC@@@@@D@AA@A@@@@@@@CA@DCA@@@A@@@@@@AA@@C@@BCA@@@@@AB@@BB@@A@@@@B@@A@@@A@@A@@AE@@@@@B@@@B@BA@BBA@@@A@AI@@@C@A@@@@@@@@B@@@B@DAB@@B@@B@@BA@@@@@BB@@@@@@@@BB@@@@@@BB@@@@@@@@BB@@@A@@DAA@A@@@@@@@BA@@@@@@@@@A@@@A@@@@AA@@@@@@@@@@A@@A@AABAC@@@@@@@D@@A@@@@BA@@AAA@@@AA@A@B@BA@@@@@@C@@@B@@AA@@@@E@@BF@@@AB@@A@@@@@A@@A@@@@@@CA@@B@B@@@CIBAAA@EAGAA@CA@ACA@AAAAC@@AAA@AAAA@@@@A@C@C@C@C@A@A@A@A@A@A@A@AB@@A@AB@@AB@AA@AA@@@C@A@AA@@@@C@@@ABA@ABA@A@C@A@A@ABABC@A@AAACAAAAA@@CAAAA@AA@@AAEE@@@A@CDA@@B@D@@A@@@A@CCC@@A@C@AAAAA@A@A@A@IFABEF@@CB@BA@CACC@@@@A@@@@D@@A@AA@@A@@BA@GB@AAAA@@B@BA@EBC@C@A@@B@B@@A@CBC@C@A@@@AAA@@@A@@@@BABABEDCB@@CBAAG@@A@@@C@@@@EB@@CCA@@@BA@A@@MAA@ABA@A@AACAA@AAE@E@@@C@@BA@@FABABABCBEBC@C@I@A@BCC@"],encodeOffsets:[[116004,34150]]}},{type:"Feature",id:"410481",properties:{name:"舞钢市",cp:[113.516343,33.314033],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@ABA@@@AAA@A@A@@@@@AAEAA@CA@@A@C@@A@@A@ABC@A@@B@@ABA@AA@@A@AB@@A@CCACA@@@A@@B@@@@@@A@@A@@@BAB@@A@@@@E@@C@A@A@A@@@CB@CAA@AAA@@AAC@C@@BEI@@@A@@@AA@@@C@@@@@AA@@B@@A@AAA@@A@@A@A@A@@B@@@F@@ACCCBCCCE@@@@CD@@@@@BA@@@BDBFBD@@ABCD@BB@BB@@@B@@@@BB@@BB@@@@@@@B@BAB@@A@AB@@@@@@AB@@@@@B@@@BA@@@@B@@@BA@B@BB@@@B@@@@@@@@B@@B@@B@@@@@@@@B@@@@@B@B@@B@@B@@AB@@@BB@B@@@@@@@ABA@@@@@@B@BA@@DA@@D@BB@BDA@BF@@@@CB@@AAA@@BA@@@A@A@CA@@AB@@A@@BA@@@A@@BB@@BA@@@A@C@@@AB@@@BA@@B@@@@@BA@@@A@@B@@DB@@D@@@B@@@ACB@@@@@@@@@@B@@B@@A@@@@B@DB@@B@@@BABA@@@@B@@@@B@@B@@@@@@B@@@@@B@@B@@B@B@BBB@@@BBB@BB@@B@@@@B@@@B@@B@@@@@@AB@BA@@@@D@@@B@B@B@@@@@@@B@@ABBB@@@B@B@@@@@B@@@@@@BBA@@@@BBB@B@@@@B@@BD@@@BB@@@@A@@B@@@@A@@@AA@@A@@B@@@B@@@@@B@@B@B@@@@B@@@DAB@@BB@@B@@BA@B@@BB@@@BA@@A@B@BD@@A@@@@@@B@B@@@@B@B@@B@@@@@BB@@@B@BA@B@@B@@@@@B@D@@ABB@CB@@@D@@@B@@@@DBD@@A@A@D@BBC@@BD@@@A@@BA@AA@@@B@BB@@@@B@@@@@BB@@@B@@B@BD@@@C@@B@@@@A@@@A@AB@DAD@BA@@B@B@B@BB@@B@@AB@D@@@@B@D@B@DBBB@@@DAB@D@B@BED@BAB@D@BA@@BED@@@@AE@@A@@@@@AB@@@FABADAB@@A@AA@@A@@BB@@B@B@D@BAB@BA@AB@@@F@@AB@@AB@@BD@@@@CB@B@@@BAB@@@BBBBD@B@@D@B@F@B@@@BBBBDBB@B@B@B@D@B@@@B@@B@B@B@@@BB@@@@B@@ABADA@@@CBA@@@A@@B@BBF@@BB@@B@FBB@@@BBBF@@@BAB@@@B@@GEADA@A@A@@@A@@@A@@@AB@D@@BB@D@B@B@@B@@@B@B@@A@@AC@AB@@@DBB@@B@@@D@B@B@B@@AB@@DD@D@B@@@BC@A@@@CB@D@B@@FFBB@@BBB@BBDB@@BBBBDBBB@B@BADAB@B@B@B@D@BAB@BAB@B@@@D@@B@@B@B@D@@BBB@@BBA@@BAB@@@BAB@B@B@B@B@B@B@D@D@D@D@B@@@@@BBBBB@BB@@BDBB@BDB@BDBB@HBFBB@BBJA@D@@@A@AB@@D@@@@B@@@@B@@@@@BA@@B@@AE@@@F@@B@@BA@@@D@@@@@@@ABA@B@B@@B@@BB@BB@@A@@B@@@@C@@@@@@BDBA@B@BB@@@@@@@@@@@BB@@@@@B@@@B@@@@@@@@AB@@@@@@B@B@CB@@@B@@AA@@@@@@@@AA@@@@@@AA@@@@@@@@AA@@@@B@@AA@@@@AA@CBA@@@A@@@@@@@@@@B@D@@BJB@@@B@AAB@@A@A@@@A@@@@BF@@@BB@@@B@@@@A@@B@@@AA@@BA@@@@B@AD@@@DB@@B@@@@B@@@B@CDB@@D@@@@@@@BBBC@@@@@D@@BB@@B@B@@BBAB@@@B@@B@@D@@@@D@@@B@@DB@F@@@B@@C@@BADAB@@@@@D@@DB@@B@@@AD@@B@@@AB@@HBAB@@@BDDAAAD@@BB@D@@@@CA@@EB@@A@A@@@@@@B@D@DAB@@CBA@BF@@AB@@@C@@A@@@CB@@D@@@@B@D@@ABB@@B@@DB@B@@AB@@C@ADB@AB@@B@B@@@CB@@CF@@A@@@CB@B@B@B@@B@@B@@B@@@B@@@B@BB@@FB@@B@@@D@@@AA@@B@@@DF@L@@BB@@@L@@A@@@@@A@AB@AFD@@A@CB@@@@EBDB@@CD@@C@BB@@A@C@@@@@@B@@@@A@ABB@A@@@CB@@CD@@DB@@@B@@DB@@AB@@D@@F@@BB@@C@@@@BCD@@@@@F@@BB@AAB@@@B@@B@@B@B@B@@CB@@D@@@CB@B@@DB@@C@@@@BBBE@@@FB@@EF@@@B@@AB@@@B@@@B@BC@B@@DBBBBB@ABB@BBA@@@BBB@A@AB@@DB@@B@BB@@@AB@B@@BB@@@B@@@@@BBA@@B@@AHBDBB@B@B@B@FAFAFAB@AFD@D@@@D@@AA@@A@@BBB@@BD@@@B@@DB@@@@@@A@@@A@@DB@@B@@@@@@@@BB@@@@AB@BA@B@DA@@F@@BB@@@DB@@A@ABBF@@@@A@@@@B@@@AH@@D@@BB@@AD@D@@D@A@@BB@AB@@@@DF@@@@BD@@CE@AA@AA@@@@@@@B@@@@C@@B@BABADAB@DA@@B@@@@FB@@B@@BB@@D@D@@@BAB@BAB@B@B@B@B@@@DA@AB@B@FDBA@@@@F@B@@@@A@@@AB@@BB@@B@AD@@@@A@@@AAA@@@ABCBA@A@A@A@A@@@AB@@@B@B@B@DCAAA@AA@@AA@@@@BA@@AAAA@C@A@CA@@AC@@AD@@AC@@@BAAA@@@AA@@CD@AEA@ACA@@CBA@AE@AE@@KBABA@@@@GE@@CC@A@@DA@AA@@@CA@@BC@@A@@AAG@@C@AC@@@@AC@@C@@AA@@AC@A@C@@@AG@@@AA@@ACE@@C@@BAB@@@BA@AB@@@@@@@AE@A@A@AAC@A@CAABCA@@E@E@A@EA@@BA@@ED@@@@C@@A@@@A@AAA@C@@@@AA@A@@@@AA@@AB@@AC@@AB@B@@@@AA@@@A@A@@@@AB@@AC@AAC@@@@@@A@@FA@AA@@ABA@@@A@@E@@A@@@AAAAA@A@AAA@@B@@A@AA@@@BA@@A@AE@@D@@AC@@AC@@@ES@AAAA@BA@@A@@AB@@@@A@AAC@A@A@@@@@@@C@@CA@@D@AC@@@E@A@A@A@@AAACAA@A@@@C@A@A@AA@@A@A@E@A@AA@A@C@A@@@@ABE@@@C@C@A@A@C@@@A@@B@HAB@@B@@BA@A@AAE@A@AAAA@@AAAB@@ABAB@@@@IBABC@@@A@AB@DCBAFEDC@A@@@@@AA@@A@CBC@E@@FC@ABE@@@ABAB@BDB@BB@@DA@@B@B@@ADA@@B@BBB@D@BB@@BAFBBA@A@ABABA@@@A@@A@GC@@CE@C@@AAAA@@@AA@AA@@@CAAAA@@AA@@A@@AA@@@@AAA@@@@A@@AAA@A@A@A@@@C@AAC@AA@@@A@@@AAEA@A@AA@@@C@A@@AAA@@A@@@ABE@@B@@@D@@ABCDAB@@A@ABEFCBAD@BAB@@A@C@A@@@@CAA@C@@@@C@CA@AA@C@GBE@A@E@A@@@A@A@@@A@A@A@A@A@CB@@CBA@A@CBCBA@A@A@AA@A@@@A@@@A@A@A@A@A@A@@AA@@@AB@@@@AAA@A@@C@@AA@@ACCAA@A@@CA@AA@@@@@@BA@ABADA@@@A@A@A@A@@@@BAB@BABA@ABA@@DCFA@@BA@A@AA@@EBA@AAA@@@A@AB@@ABCD@B@B@@A@@BA@A@@B@@CD@BA@@@A@CAECAACAAACAAA@@A@@@@@CD@@A@A@ECAAEAA@AA@@A@@@EBEBABE@@@CBC@CAABC@A@A@@@C@C@C@A@ABA@@BA@BBBB@@@@CBA@A@A@AAA@AAA@A@"],encodeOffsets:[[116170,33955]]}},{type:"Feature",id:"410482",properties:{name:"汝州市",cp:[112.844517,34.167029],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@A@@@A@A@@BA@@@AA@AAA@AAAAA@@A@@BC@@@A@@CCAAA@A@A@A@@@A@A@CB@@A@A@A@ABA@CAAB@@C@A@AA@@A@@@@AA@@@AB@@A@ABABC@@B@B@@@D@@AB@@A@@@AB@@@@A@A@A@A@@@@B@@@@BB@@@@A@A@A@A@@@A@@BAB@BA@@BA@A@@@AD@BCBAB@@@B@BBBB@@BAAA@CA@@A@A@@@AA@@A@A@AAA@A@@@AAGAAA@@AA@AAA@@@@A@@@@@ABABCFABABA@AD@BAB@BABCBA@AB@BBD@B@B@B@BAB@@A@A@A@A@A@@BA@C@@AA@CAAAAAA@@@ABA@@@A@CAC@@AABA@A@@BA@A@@@A@@AC@@AA@A@C@AAADADABAB@@ABC@@@A@AAA@@@CBA@ABA@CB@@ADA@@BABCBC@EB@@CAA@@BC@AB@@AAA@@AAE@G@C@CB@@CBCBAC@C@A@CBA@A@@BAB@BA@ABA@@@C@@@A@@BAB@F@F@@ABED@@A@E@@BA@AB@@ABAB@ACBA@@BABA@@@@B@@@@@BBBBB@@@B@@AB@@BB@@B@FA@@BBBBB@@@B@B@B@D@B@B@FAB@@@B@@BBBB@B@B@B@@@B@@B@@A@AB@@CBA@ABA@@@ABAB@B@B@@A@A@@@@@AB@@CBABAB@B@@A@A@A@AB@@@B@B@BABA@@@A@@@CAA@AA@@@AA@EBCBC@@@A@@B@B@B@B@@BBB@B@@B@@@BB@@DB@BBH@BBB@@BB@B@DAD@B@B@@BDADB@D@@@BA@@DBB@@B@@@BAB@@B@@B@@BBBB@@@D@D@@@@@B@@@H@B@DB@@BB@B@D@BB@@@DF@@D@@B@@BB@@@@A@@@@@A@@@A@@@A@AA@@@B@B@@BB@@@B@BBD@BA@@BA@A@@BBD@B@@DBHDBB@@B@@@DBD@DB@A@AAADA@@B@@AHFDAB@D@B@@@BB@@@BAB@B@@BD@@@@B@BB@B@@BB@B@@AB@@ADAB@B@BBD@@@@ABABAB@@AD@B@@@FBFBD@@BAB@@@@@HCB@@@B@@@@BBD@@A@A@EB@@@B@B@@B@@BB@@@ABAB@@BB@@ABA@@B@@BBBBB@@BBBBB@@BBBB@@@B@@@B@DAF@@@@@B@@BD@@BFBDBB@BB@B@BBBB@BB@@H@@@@@B@B@BBD@FB@@B@BABABA@@BA@@B@BAB@BA@@BAB@@CHCDCDA@ABCB@BA@@@G@A@A@CBC@AB@BANAF@@ALAB@DAD@BA@CDCBABABADAJ@BA@EFABEBCBCB@DA@BBAB@@A@A@C@A@A@A@A@@@A@@@A@@@ABAB@@@B@B@B@B@BB@@BDB@BDBBDBB@BBBBBFLBDB@BDDB@BBB@@@BE@DD@@HDB@DBB@@@@B@B@BABCBABA@CDEBCBCBA@A@CBA@A@@@CD@@AB@@ABCDA@@BA@ABCBAB@@AB@BA@@@CB@@@B@@@BDBBBBDBBBDBB@BB@@BBBB@B@FB@@DBB@@@B@@@B@@@BB@@@@B@BA@@B@BB@@B@B@BB@B@@AB@B@BBD@BBB@@@@@@B@@@@A@@@@B@@@BDBB@@@@B@@AB@@@DB@BADD@@@@@@BBD@@BA@@B@@D@B@@BB@@@@B@B@@@@@@B@B@@@@BBDB@BDBBBBDB@@@AB@@@@AAA@@@@BDBB@@DB@B@@@D@FALFFBDBB@@@@@@BABCB@@ABEDFDDDD@JDBB@BBB@@D@BA@@FDBBBBD@@BDB@@DBB@D@@BDB@@D@B@FAFAD@@AB@DA@AB@BCBC@A@ABCAA@AAAHACACA@@AA@A@A@@DCBA@@B@BB@@FDBB@BRDD@F@BAFC@A@AB@B@@@BB@B@BB@DBB@D@BBBB@@@BBB@@DBB@BBBBB@D@BBBBB@B@J@JAB@JADAD@DAD@F@BA@ABABABA@ABAB@B@BBBBBB@B@B@@@B@D@@BBD@HBB@B@B@DC@@F@D@@@D@@BB@B@BB@@B@DBBB@@@B@B@@BBBBBDB@@@DB@@BBB@BBB@BBH@B@F@B@BCBA@@@A@@@@AA@@BA@@AA@@@ABA@@@A@A@A@@@A@@B@B@B@D@D@B@FBF@BBB@@BB@@B@@@BDB@@B@DBB@D@FA@@FAFCBADABAB@@@B@B@B@B@@@F@@@DB@@D@@BD@B@B@B@B@@BB@@@@@AA@A@@BA@A@C@A@G@AFCDCB@DAB@B@BABA@@AAC@CAA@AA@AGAC@CA@ABA@@@C@@AC@AA@@AA@@A@@@ABAA@@A@@BABA@@BBDBB@B@@@BBBBD@DBB@FAB@BBB@@@B@DC@AB@FBB@@@BA@@@BB@@@B@@A@@D@@@BA@@BAB@DB@@D@B@@BB@@BD@BBD@B@BAB@DBBBB@BABA@AB@B@BADC@@B@B@D@B@BAD@@@B@B@DAB@B@@@@@@AB@B@B@B@B@B@B@B@B@@B@BB@@@DBB@@@B@B@B@DAD@H@B@FBD@BB@@B@FBHDDBB@B@B@B@DC@@FAD@B@BAD@D@D@B@B@FAB@D@BB@@F@B@BABBD@@AD@B@DAB@DBDBD@B@D@FBB@@BDBB@@@B@B@@@D@B@D@@@BA@@BG@A@@@AB@DCDCB@@C@@B@B@@@BBDBB@@@BA@@@AB@BC@@@A@@BA@@FC@@BA@@DAB@BA@@B@BBB@@@FAB@B@DBD@D@@@BBB@LDD@@BB@@@AB@@B@BD@@B@@A@@@@@A@@B@BAB@@A@@@A@AAA@@A@AA@@@@BAB@BA@@B@B@B@D@B@@@BA@A@AB@BA@@@AB@@ABA@@BABC@@BA@@AAA@A@@AAAEA@A@C@A@A@@@@@AAACAAA@@@A@@@A@@@ACA@@@A@@@E@A@A@@CC@A@CAAA@@A@@@A@A@A@@@C@@A@AA@@@@@@BAB@@ABA@@@@@BB@DBB@D@@AB@@A@A@@AA@@AA@A@@@C@@@AC@@@AAA@@AAA@@@AB@BABA@CB@DC@A@@B@BBBBBBFABBB@DB@@B@B@@@@ABA@@@@D@B@BAB@BAB@B@DA@AB@@AD@@@DC@@@AAA@@@@@ABABAB@@AD@B@@@BCDEBA@A@AACA@AAA@AAE@@@@@@ABABA@@@A@@@AAA@ABCC@A@A@@@C@ECA@@@@A@AA@@@A@AACA@@ACCEACAA@@@A@A@@AAAA@A@@AA@@@@@@@A@A@A@@@A@@A@@@AB@@CC@@@@@A@@@AB@@AA@@A@@@@@B@@@@@BA@@@@@A@@@@A@@A@@AA@@@@A@@@@A@@@A@A@@@@@@@BA@A@@@AA@@@A@@A@@BA@@@A@@AAAAAA@C@@@AA@@@C@@@B@B@@AA@@A@@BBB@@@AAB@B@@@@AB@@@BA@@@@@AA@@@B@@AA@@BA@A@@@A@@BB@BBC@@@@@@@A@AACB@@@CC@@@AAA@@AC@BBC@ACAB@@ABAA@BA@A@@@A@@@@A@@CAEAA@@B@@@@CE@@@@A@@@AA@AA@@@A@@@AB@AB@AAA@A@@B@AAB@@A@A@@AA@@@@@@B@@AAA@ABAA@@@@@ABB@CB@@CCA@@A@@@@@BA@@@@A@@@A@@AAA@@A@@@@ABA@A@@A@@@@@@AA@@@D@@@@A@AB@BBB@@B@@@C@@C@@A@@@CD@@AC@@CB@@CAAA@A@@@B@@AA@C@@AC@CCA@AA@@@@@A@A@C@CA@@A@@@@@AB@@A@AC@A@@@A@@@@@@A@A@AAAB@ACB@@AAB@AA@@CAAC@@AF@AA@C@@A@@AAAAB@D@@ABAAA@@AA@@@BADA@@@@AAFE@A@@BA@@B@@AFAFC@@DC@@BAB@BA@@@A@AB@DA@A@@@@@A@A@@AAB@@@A@AA@AA@CI@AAB@AA@DAAA@@@@AA@@@A@@AA@BCA@A@ABC@@BABABC@ABA@A@A@ABADK@@@@A@AE@@AAABIDA@ED@AACA@@@A@@AA@A@E@A@@D@@AA@@@@@AABA@@@@B@@AAB@@A@A@ADADA@AA@DAAAB@AA@@GBAA@B@@AB@AA@ABA@@BA@@EA@ACBA@AAB@C@@A@A@@@@@@@A@@@@@BBA@@AC@@@E@@@A@@B@@@AE@A@@@FB@BA@@BC@AA@@@A@AA@ABA@A@E@AAA@@@C@@@A@A@ADAB@@I@@AA@@@@@ACCBAAACCE@@@@A@@@@@@AAA@@B@ACA@@@@AACI@@@A@@D@@C@@@@BAB@@@@A@@B@@A@@DABA@@@@AA@AB@@AA@ABE@AAAC@EB@@@A@A@@G@G@@@A@@@@A@@A@CD@@CA@@A@AB@@A@ABA@@@@A@@C@@@@@A@@@@BA@@A@@AAA@@B@F@@B@@@@BC@@BB@@B@@D@@B@@@BCAAB@@C@@@A@@@@D@@A@CA@BA@@A@@A@@B@@A@@BA@@AEAA@C@CAC@@BC@A@@A@@@@K@@CA@A@@BAB@@@@@@AA@@@@@D@@@@A@@@AB@@@B@B@@A@@A@@GA@@CA@BA@@B@@@AC@@@@@@B@B@JA@@BABCA@B@@CAA@A@A@@A@AA@AA@BA@@@@AA@@B@@A@@AAACECCCCA@AAA@ABCBA@A@A@@@EBA@A@@BA@@@AAA@CAGAA@CB@@@@AA@@BA@A@@@@C@C@@@BBA@@@A@AAC@ABC@@@A@EB@@GCA@E@@@AAA@AA@@AACAC@AAAA@@A@ID@@AACB@@AB@@A@@@@BAB@BCB@@AAA@@@AABC@@AAA@A@@@@ABA@@@@A@AA@@BA@A@@ABAAA@A@A@@@AB@BA@@@A@A@@@A@@BA@AB@@@@@@DB@@@@@@ABA@AACAAAC@@@AACA@B@@A@@BB@@@BB@@A@A@C@CBA@A@AAA@BA@A@@B@B@@@@A@@C@@@@AA@@A@@@A@@@A@@@A@@B@F@@@@A@A@@@@DA@@@@B@@C@@DB@@@AACCA@@AEAAAA@AABA@@@A@A@A@A@@A@@@AA@@AA@@@@A@A@A@@@BB@BABABADAB@BA@@@@@AA@@@A@AB@@AA@A@@@A@@@@B@@@B@@AAA@A@@"],encodeOffsets:[[115433,34772]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
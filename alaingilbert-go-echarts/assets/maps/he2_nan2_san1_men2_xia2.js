@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("三门峡",{type:"FeatureCollection",features:[{type:"Feature",id:"411202",properties:{name:"湖滨区",cp:[111.188397,34.770886],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@@@AA@@@@@A@B@@B@@A@@@BB@@@AB@B@@@@@@@@B@@A@@B@B@BA@@@@A@@@B@@@BA@@@@@B@@BB@@B@@@@B@@@@B@@AB@@@@A@AB@@A@@AA@@B@BB@@B@B@@A@BB@B@@@@@A@@@@B@@BB@@B@B@B@@A@A@@@@@@BA@@@@A@@A@@D@@@@@AAB@@@B@ABBB@B@@@@@ABA@@BA@@A@@@@A@AA@@A@A@@@@@@A@A@@@@A@@B@@A@@@@@@AA@@@@A@A@@AA@@@AB@@A@A@@@@@A@A@A@@@A@A@A@@@A@@@@AAA@@@@A@A@@AA@@@@A@@B@BAB@@A@@@@A@CAA@@@@A@@CBA@@@@@A@@@@@@B@@@B@AA@@@AB@B@@@@A@@A@A@@@@ABA@A@@@A@A@@@@@@AA@@@@@@@AA@@@B@@@B@@@@@@A@@@A@@A@BA@AA@@AB@@AA@@@@@@BA@A@@@A@B@A@@@@A@@AA@@A@@@@A@A@A@EBA@ABCB@BE@@A@@@@B@@@@@A@@A@@A@BA@@A@A@A@@@A@@BAB@B@B@@@@@@A@AA@@AA@@C@@@AA@@@@AA@B@@A@A@@@A@@B@@@@A@@@@@BA@@A@@@ABAB@@A@@A@@B@@@@@A@AAB@@@F@@@@AA@@@@@@@B@@ABB@@@A@@BB@@B@@@@@AA@@@@D@B@@@@@@@C@A@@A@@@A@@B@@@@@@@AA@@@A@@A@AB@@A@@A@@B@@AB@F@@@@A@@BB@@@@@A@AAA@@@@@@DA@@B@BAA@@@A@A@@A@@A@@@A@@@@@@ABA@@@@A@E@C@@@@@@@AABA@A@@@A@@@@AAA@@@@@@@@@@ABA@@@CBA@AAA@A@@A@@@A@AB@@@@@D@@A@@@@@BC@@@@A@@@A@@@AA@@@@D@BAA@@@@@A@@@A@@@@A@@B@B@@@@A@@AA@A@@@@@@B@@BBB@@B@@@@AAA@@@AD@@@A@@@AA@@@@@@A@@@@@AA@@@ACACA@A@@AB@@@B@@@BA@@AAA@@@@AB@@@@@B@@@@@@A@@A@@@@AB@@@@@@@@A@@ABAAA@@@B@@AB@BAAA@ACA@@@@@@@A@@BBBBB@@@F@F@@@@BBDAB@@@@BA@A@ABC@AAA@@B@B@@@@@B@@A@@AA@@@@B@@B@B@@@@@@DABB@@B@BAB@@@@@@BBBA@@@@@@@BB@@BAB@B@@@BB@AB@B@@@@A@@@@@AA@@@AA@AB@@A@@@AAA@A@C@A@@@AA@@A@@@@BABAA@@@B@B@@@@@@A@@@@B@@D@@@B@B@@@BBA@A@A@@@A@@@B@DB@@DBBB@@BBB@B@B@D@D@@@BB@@@@@B@@@B@@@BAA@@AB@B@B@@A@@@AA@@C@@@A@@BB@@@BB@@@@A@A@@@@@@@AA@@A@@@@AAB@B@@A@@A@A@@@@AB@B@B@@A@AAB@AA@@BA@@A@@@A@A@EBA@@@C@@AA@@B@@@B@@@B@@@BB@@B@@@@@@A@A@@@@BA@@BABAA@B@B@@@@A@@@@@@@A@@B@@@B@@@BA@@DAB@@A@A@@@@AAB@@@@@@AB@BD@@@B@@@A@AB@@@B@BBBA@@@B@@B@@A@C@@@@BB@@@@@@B@@@@@@A@A@@@CBABAB@@AB@@@BA@@AA@C@G@A@@@@@@@@A@@@A@@A@AB@@@@A@A@@B@B@@CDA@A@AAA@@B@@B@@@@BA@@@@@A@@A@AAAAAAB@@@@AB@B@D@@AB@@@BAB@@@@@@@B@BA@A@@@@@@BB@@@@@AB@@@@BB@@@B@@BB@@@BA@@@BBDA@@D@B@F@@@B@@@@B@@AB@@A@GBA@A@A@A@A@@@@B@@A@@@AB@@A@A@@@@B@@@@A@@@@A@@A@A@@@@B@@@@@@C@@B@@@@C@@@A@A@ABA@@@A@@@AB@@@@@ABA@@B@@@@AB@@@A@@@@@@@A@@B@@A@@@@A@A@@A@A@ABAB@@@@@A@ABAB@@A@@@@AAA@C@A@@@@ABAB@@A@@A@@@ABCD@@@@@B@BAD@@BB@B@@@B@BB@BB@B@@@@AB@@@@ABAB@BCJKBGJJHDDBB@B@@AB@@A@A@ABE@A@A@ABC@@@A@@B@@AD@@@@ABABA@CBCB@@NTLLNLJDH@HCDCJIFCDCF@D@L@@@HBH@JALAFABCFE@@DEDCFAJ@B@H@HDBBDBDFBHBDBDFLDHDFBBFDFBF@NERIJGJCFADB@@@@FBPJHDBBHBJDH@FBLFJHJHDBD@B@D@B@DADABCDEDCFAFAD@DAD@H@B@BAF@DAD@DAH@DAB@BAB@@@@BB@@@BAD@B@B@DBB@A@@C@@@A@@@A@@@@@A@AA@BA@@B@@@@CBA@@@@@AA@A@@AEE@AB@@@@A@@@@@@A@@@AA@@@AAB@A@@@@@@@@B@@@B@@BB@@@@@BAB@@@@@@@@@AA@@@@@A@A@@AACC@AC@@A@A@@BA@@@@@@@A@@AAB@@@@@@A@@A@@A@@AA@AAC@AA@AA@AA@@AA@GAC@@@@@@@BAB@@@@A@@B@@A@@A@@@@A@@@@B@BAB@@@@@@AB@B@@@B@D@@@B@BBB@B@@@B@@A@@@@D@B@B@@@@AB@@@B@@AA@CB@@@A@@B@AA@@AA@AA@@@@@AB@@@A@@@CAA@A@BA@@@@@@@@AA@@@@AA@ABIA@BA@@@A@@@AB@@A@@A@@@@AB@@@@A@@@BA@AA@@@@@ABA@C@@@AAAA@@ABA@@@ABA@@@@@CB@@@B@@A@@BA@A@A@A@CBA@@@A@A@A@AAA@@@A@@@C@C@AA@@@@A@@@A@A@@@C@C@@AA@@@@@AB@@A@A@@A@@A@@@A@@@@@@@ABA@A@A@@@A@@@C@A@@BA@A@C@@B@@A@@@@@@@A@@@A@@BA@@@A@@BA@AB@@A@A@AB@@A@@AA@A@@@@B@@@@@@A@@A@@@AB@B@B@@@@A@@A@@@@A@@@@A@AB@@A@@@@A@@@@B@B@B@@AA@A@@@A@@@@BA@@@@A@@A@AA@BA@@A@@@AAAB@@@@AB@@@BA@@@@DADA@AB@@A@A@@@@B@@BBB@AD@B@B@B@B@@@D@B@@@@A@@B@B@B@@@BA@@B@BAFAB@B@B@HEBBB@@@@A@@@@@@B@@@@@@@B@@@@A@@@@A@@@BAA@A@@@A@@@A@@@@AB@@@@@@@A@@@A@A@@@@@@@B@BAB@@@B@@@B@@A@@A@@@A@ABA@C@@@@AF@@@@A@A@@A@C@@B@@A@@@A@A@@@A@BA@@A@@@@@A@@@@@A@@A@@A@@B@@@@@@@@@AAA@A@@@@A@A@@@A@@A@@ACB@@A@@B@B@@@@A@A@@@@@AD@@@@@A@@@C@@@@@@@@@A@@A@@@@B@@@@A@@@@"],encodeOffsets:[[113999,35563]]}},{type:"Feature",id:"411203",properties:{name:"陕州区",cp:[111.103563,34.720547],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@A@@A@@@@C@AAABAAA@A@A@AAAAAAA@A@ADI@A@A@@A@@@@A@A@@@@@@CA@@AA@@AAAAC@C@@@@@ACAC@@A@AA@AA@AA@@A@A@A@A@E@@@@@A@BB@B@@@B@D@D@B@BA@@@AB@@@BBB@DAB@@@@BBBB@@@B@@B@B@BBB@@@@DB@B@@B@DAB@BAB@@A@C@@@AB@BA@@@A@@B@DA@@@@BA@@AC@A@A@ABE@EBA@A@CAA@A@A@AAC@@@CAE@C@A@@B@DA@A@ABA@AABB@B@@@BB@@B@DA@CF@A@@@B@@@@BBB@D@@@B@@@AB@B@@BB@@A@@DAB@@BB@D@@@@BA@AB@BBFBBBHBB@DBD@D@D@B@BB@B@@@@AB@@ABA@@@A@@BA@EBG@CB@BA@@@A@ABA@@B@B@@@B@B@BA@A@@@ABABA@@@AA@@A@@@AA@@@@@@A@@@@@@A@@@A@@@@A@@BA@A@@@@@BB@B@D@FAB@B@@A@ABA@A@AA@@A@@B@@ABA@A@A@@BAB@BA@ABC@@BA@EDGDA@@BAB@B@DBD@D@BC@AB@@@BCBAD@@C@@@AB@@@D@B@DBD@B@BCDAD@B@B@BA@@BB@BB@B@BBBDD@D@@@D@@EB@BA@@@@D@B@BBB@@BB@@@D@@@BB@BBDBB@@BB@BBBB@D@B@BDD@@A@@@@@A@AB@BAB@@ADAB@BA@ABABCDAB@@AB@@AB@BA@CDA@@B@@@@@@@B@@@B@@AB@BB@@B@@@B@B@@@BAB@B@@AB@@@BBB@@@BA@@BA@@BB@@@@B@@@B@@@BA@AB@B@@BB@@@B@@A@@@@B@@@@@@A@@BA@@B@@BB@@@BAB@BAB@BA@@D@B@@@B@DA@A@G@C@@@@@@A@A@@@@A@@@@B@@ADABABCB@BA@@AA@@@@@@@A@AD@@AB@@@B@@AD@@C@AB@A@B@@@@AB@BA@A@@@@@AB@@@B@BBB@D@B@@A@@@@@@@BB@@ABABAB@B@@AD@B@@C@@@AD@@A@C@@AC@A@@@@@AB@@@@G@@BA@@@@BA@@BAF@@@@A@@@@@@@CB@@@@@@A@C@A@E@A@C@CBAA@@@@A@@@A@C@A@A@A@A@@@@BB@D@B@@@@B@@A@C@A@@@GB@@@@@@DDDD@@A@C@@B@BBB@B@B@DJ@@F@B@@@@A@A@C@@@@@BB@@@@@@B@@B@@AB@@@@C@A@A@@@@@@B@@@@AA@@@B@@@@@B@@AB@@@B@BB@B@@@@BABA@@@@DAB@B@@@@B@DBB@D@@AD@@@@B@D@B@@@BD@@@B@@B@BBB@B@@B@@@@B@@B@@@@@@BA@@@@@AD@B@@@B@@@@@@B@AB@@AB@B@@@BB@B@@@@B@@B@B@FB@@@@AD@@B@@@ABAB@@A@@C@@A@@A@@C@@BA@@@A@@@@B@@@B@B@@@@AB@@@@D@@@B@BBD@@@DA@@@AB@@@@DB@BAB@@@@@@D@@@@@@B@@B@@@BC@@D@@G@@@C@@@@@A@@DC@A@@BA@@BAB@@A@A@A@@@AB@BABB@@@@B@@@@AB@@@@@BBB@@ADABFDJD^L`J@@fDF@VDD@L@LBJ@D@HFDDBFBLBDDPHNFJDADAB@BABA@@@@BC@@@AB@@@D@BAB@B@F@BAB@B@@@BA@@@AAACCIGHILADI@ABABA@@@@BA@@@@@AAAA@@A@A@@@AAA@@BC@A@A@@@@DCBA@@B@@@@BA@AB@B@@B@D@B@BB@@@@@BA@AB@B@B@@@@BABAB@B@@@@B@B@@B@@@@AB@@@@@@@B@@@A@@B@@A@@@AB@B@@@@BA@@B@@@B@BAB@B@@@D@@@@@@AD@@@@@@@@A@@B@B@@@@B@@B@@@@@@A@@B@B@@@BA@@B@@@@A@@B@B@B@B@B@HAB@@@BA@@@A@@A@@@E@A@C@@@CBAA@@B@@A@@AA@@@A@@AA@@@@BA@@@@A@@A@@@@B@B@@A@A@@@@@@BA@A@@BA@@@C@ABA@@@@BABBBB@B@BB@@@@@B@@A@@A@@@@AB@BBB@B@DC@@@A@AB@B@@@@@BAB@@@@B@@@B@@@@@@B@H@D@B@@BB@@A@@BA@@BABADA@@B@B@@@@@@@@A@@@@A@@A@@D@B@@@@AA@@@B@AA@A@A@@BAB@@@A@@@C@@ABA@@@@@@BA@B@@B@B@@@BA@CB@@A@@@A@@@AB@@@@@@@B@@@@@@A@ABBBA@AB@@A@@B@B@@@@@@@@AA@@A@@@A@@@A@@@AB@@BD@@@B@FAB@B@@@B@@@AB@@BBA@BBB@@@@A@ABA@@@@@B@BB@@@@ABA@B@@B@@@BB@@@@@@B@B@@@@@AA@@A@@AB@@@D@@@BB@@B@@@@A@ABA@@BB@A@@@A@@@A@@@@AA@@C@C@A@A@A@AA@@AACA@@CAA@@@B@@@B@B@B@AA@@A@A@@@C@@@@A@@B@@@@@@@@A@A@@BBBA@A@@B@@@BB@@B@D@B@B@BB@@B@@@BAB@@B@@BB@@@@B@@@@@@ABAA@@A@@@ABA@AA@@A@@@@@@ABAA@@@@@@BA@A@AA@BA@C@@@@A@A@@@@A@@B@@BB@@@@A@@@@@A@AB@BBD@BAB@B@@A@@A@CBAA@@@@@E@E@@AAAA@AB@@@@@@@B@BDB@BB@ABA@@@A@@BBAB@BB@@@@@@@@@BA@@@@@BB@@@@@@@@A@@@@BA@@@@BB@BB@@A@@@A@@BA@@@BDBDB@B@@BB@@@@B@@@@@@@BB@@B@@@C@@B@@BB@B@@A@@@AA@AA@@@@@@@@BBB@@@B@@A@A@@@@B@@B@@@B@@@@@B@ABC@@@@@BB@@B@@@B@@@@@AD@@@@B@@@@C@@@@BAB@@@B@@@@BBB@BAB@D@@AB@B@@@@@@@@B@BB@@@@@B@@@BABBB@@@@@@D@F@B@@@@@AB@B@@@@B@@@B@@@@BB@B@@@B@ABA@@@CB@@@@@@BB@B@B@@@@AA@@@B@@E@A@@BA@@@@BB@@@BAB@@@@B@@BB@@@@@@A@@@@B@@@BB@D@@@@@@@A@C@@@@@BB@@@@A@@@AA@@@B@@AA@BA@@@@@@@B@@B@@E@@@A@BBB@@@@@A@@@@BB@@@BABA@@B@@@AB@@@@B@@@@@@AB@@@B@B@@@@ABB@@@@BB@@D@@@BB@@BBB@@@@@@@@A@ABA@AB@@@B@B@B@@@ABB@@@@BB@@@@@A@@@@@@BF@@ADABAB@FAB@B@B@@@@@@BB@@BB@@@@@@B@A@B@@@BAB@@@@@@BB@@BA@@BBB@@A@BB@@@B@@@@@@@@A@@@A@@BB@@@@@@B@@B@@@@B@B@@@B@B@BA@@@@@B@BB@@@@@@ABA@@B@@B@A@@@A@@@@B@@@@@B@DA@@@B@@B@DBB@@@@@@BA@ABA@@@@B@@B@@BB@B@@@@@BB@B@@B@@@B@B@B@@@B@B@B@@@@@B@B@@@BA@@B@@BB@B@@@@@BB@@@@@@@BA@@@@B@@B@B@@@@@@@@B@BB@@B@B@@B@@@AB@@AB@B@@@@@AAABAA@@@A@BB@@@@C@@@@BB@@@@@AB@@@@@@@B@BA@A@A@@@AA@@@A@@B@@@@@A@A@@A@BA@A@@@AAA@@@BB@@@BA@@B@B@@A@@BA@@@@@@A@@A@@@AA@@@A@@@@AB@@A@@@B@@@@@ABA@A@@@@BA@@@@@@@@@@ABA@@A@@A@@@BA@@@@A@B@@@@BB@@A@@@@@@@@B@@A@@@@@@BB@@@@@@@@@D@@@B@@@@@C@@B@@@@@B@B@@A@A@@@@BA@BD@@@BB@@@B@B@@@@@@BBB@B@@@@@@@@@AB@@@@BB@@@@@B@@@@@B@@@ABB@@@B@B@@@B@@@@AD@B@@@@B@B@@E@@B@@D@B@BAB@@@B@@@@BA@@@A@@@A@ABA@@@@@@@B@B@@@B@@@@@@@A@@B@@B@@@B@@@B@B@AB@@B@@@@@@B@@A@@@@@@@A@@@@@@@@B@@A@AAGFA@A@A@EBABA@@@AB@@A@A@A@@@@B@@A@C@@@A@A@A@A@C@@BAA@AA@@@@@@B@BA@@BCBCB@@@@AB@@A@@B@@A@BB@B@@@BB@@ABBB@@@@B@@B@@A@@B@@@B@B@@BA@A@A@@@@@@B@@B@@@BAB@@@@@@B@@B@@@@B@@A@A@A@@B@@@BB@@@@@@@@A@@B@B@@BB@@@BAB@B@@@BAB@@AB@@@B@@AB@@@B@@@@@@@B@@@@AD@B@B@@AB@D@@@B@@@B@B@B@BA@@@@@@B@@@B@@@@BB@B@@@BA@@@@B@@BD@D@@@B@B@@@B@@@@@BBD@D@@@B@@@B@BBB@B@B@@@B@DAB@B@B@B@@AB@@@@A@@DA@@@@B@BA@@B@BA@@BBBB@@D@B@BA@@@@B@@BAB@@B@@@@@BA@@@@@BB@@@BA@@B@@@B@@AJBBAB@@B@@B@@B@@@@@@B@@A@BBB@D@@@B@@BA@@@@B@@BBB@@BBA@@@@B@@DAB@@BA@@@A@@B@@A@A@C@@@@@@BA@@@A@A@AAA@@@C@A@@@A@A@@B@@@@A@ABA@@@@@@B@@B@@@@BA@@@@B@@A@AB@@@@@@D@HBB@@BB@@BBBB@@BBD@BBB@@@BB@@@@B@@@@A@BB@@@B@@@@@@AB@@@B@BD@@BDDBB@@@B@B@@@@BB@@@@@@@@A@AB@@@@A@@AA@@@A@@@@@@@@@@BBA@B@@BB@@B@@@@@@@@B@@A@@BFF@BB@B@@B@@@@AB@D@@A@@@ABB@@B@B@@@@@B@@@B@@@DB@DBB@DBBBDFDBBBBBDBBBDB@@B@D@B@B@B@BABBF@DBDBB@B@BBBB@BBBFHBBB@DAB@D@F@FADAHCDCFEFCB@D@B@B@LA@@J@HBFDD@F@F@@@B@D@FBD@BDHH@DBB@BD@BBB@B@D@BBBBBBJHBBDBDBB@D@FBD@LDF@H@LAFADA@A@CDCDA@@AA@@@C@@@@@AAA@@@A@@@@@@BB@@B@@@@A@@@A@@@A@@AA@@AAAA@A@A@@BC@AA@@@AAC@@A@@BA@@@A@@@@A@AA@@@AAA@@@@@@@A@A@@A@C@@@@@AA@AB@@@@@DAD@@@@A@@A@@A@@@@@A@AB@BA@@@A@@AE@A@@B@@BFD@@DB@BB@@BAB@@@B@@B@BB@BBBB@BB@@B@@@@@@ABC@@@CB@@@B@@@@AA@@BA@@A@@@@@ADA@ABABC@@DABCBA@@@A@@@AC@@@@AB@@@BABAAA@A@@@@@A@@AA@A@@BA@A@@BAF@B@@@@A@A@@BAB@@A@@CA@@AA@@A@@@AAA@@@AAAA@@A@A@@@CCAA@@@A@A@@BA@@@A@@B@@A@@@A@A@A@A@@@A@@@A@A@@BAB@B@@@AA@@@@A@A@@@@AAA@@BAAA@@@AF@@@@A@@@@@A@@CAA@ABA@@@AAA@B@@@B@FAB@@B@@B@B@@@@C@ABABA@@AC@ABADADABABAB@HCHC@@AC@@@A@@@A@@A@@@@@AC@@B@@@@AD@@@@@@@@ACB@AD@@A@@@AB@@A@@@AAC@@B@@@@FB@@@ACBAB@@@@BBB@@B@D@AE@@D@@@@CAC@@@C@A@A@AB@@@B@@ABA@A@@@@@@@@C@AB@@AAAB@AA@@A@@@@A@@@@A@@@@A@@@@@A@AA@@AC@@A@@@@@@@@AB@@A@@A@AB@AA@B@BAB@BA@@DB@@BA@@@@@AB@@@BADB@@@A@@@@@@@AB@B@@@BA@@BA@@B@@ABAB@BC@@AA@A@@A@@@BC@@@A@@C@@A@@D@DAD@BAB@@@@C@@@@B@B@@@B@@@@@B@AAB@@@BB@@B@@A@@FCB@@@@@@B@@@BB@FB@@@@@D@B@@BABA@AB@@@@@@B@B@@B@@@D@@@@@@BCB@@@@@@B@@@B@BA@B@@@@HBHB@@@@@BBAB@@B@@@B@@BB@@A@AB@@@B@@B@D@B@@A@C@@HAB@@@AA@B@A@@@A@@BAB@@ACC@@@@A@@A@@B@@A@@@@DA@@@A@@@@AB@@@A@@AC@@BADAB@BAB@B@D@@@B@B@@@BA@@@A@A@CAABCAAB@@@B@B@B@@AB@@@B@B@@@@@B@@L@D@BBABA@@@A@A@A@@B@@AB@@BBBB@B@BBB@D@@@@BB@@@@A@@BAB@@@BABC@@@CAG@@AAFAB@@@BB@BB@B@BB@D@BB@@@@@DA@ABAB@@BBBBA@@@@@A@@@@@@B@D@@@B@@A@@BA@@FA@@B@@BA@@B@@@@B@@@@@BB@@BA@@@BAB@@@@@@D@B@B@DAB@BABAB@BAHABAB@@@DCBABC@@A@A@@@@ABA@ABA@A@@@@@@AA@AC@@A@@@AB@@@BAB@@@BA@CAEA@@@@A@@AA@@BABAB@@@@AB@@AB@B@@@BA@@BA@@@A@@C@A@@@@BAB@@@@A@@@@@@C@@@@A@A@@@@AB@@AAA@A@@AA@@A@@@A@@@AA@A@@E@A@@@A@@A@@@@GB@@@@@@BE@@B@AAB@BA@@@AB@@@@A@ABABABA@ABA@@@@@A@@BA@@@@@BB@DA@BAB@@B@B@B@@A@@@@@@@C@@@@B@@@@@@@B@BA@AB@@@DABA@AA@@@@AB@@@BBBA@@@@BA@@@AA@@B@@@BA@@@@CC@@@@@D@@AB@@@AAC@A@@@A@@@@@B@ACAA@@@B@BA@@A@AA@BAB@AAAD@@CB@@@A@@AAA@@A@AB@@@@@@AA@A@@@@@@A@@A@@@@@@@D@D@@@D@@A@@A@@@B@@@@AEB@@@A@@C@@BIB@@@@DAB@@@C@@@@@DA@@EBE@@@AB@@D@ABAB@@CE@BACACCBAA@@BAAA@@B@C@@CB@FAA@CA@AEE@@EA@@@A@A@@CCAB@AA@@A@@G@@AA@@@ABEA@BA@AAAA@@A@A@K@GFBBABAADA@@A@ABAACA@@@AAA@ABCBBB@@ADABAFCAE@CA@A@@@BABAFC@@@A@@@@DA@@@A@@@ADAFAD@@@@A@@@AA@@@CBABC@FCHE@AB@AA@AD@@@@A@ABABABABABC@@A@@A@@@A@AA@A@C@A@A@AAA@CAA@A@@BA@ABCD@BA@@A@@@AA@AB@B@@B@@B@BC@A@A@@BAB@@A@@@EDCBG@A@ABEBA@@B@B@B@@@@ABA@C@IDIBCB@@@@ABA@A@A@@@@BCB@BG@@@AB@@AAAAA@AB@AK@@D@@@B@@A@A@A@A@CBCBA@@BA@BB@@A@EFAD@B@B@@ABC@A@@@C@A@@BA@ABAAE@EAABAA@@CDC@A@@@@@@C@A@@AA@@AAD@AAAA@@BEB@AA@@A@@@@@DAB@AAB@FCFAB@@A@ACAABA@@@A@@B@@A@@AA@@B@@@B@@A@@@@@A@@@@@EB@BCA@BA@ABBBA@@@AB@B@@@BAAA@@B@B@BA@A@ABA@@@AAAD@@CD@@B@@@CBC@@@@A@@A@@A@ABA@@B@@A@@@@BA@@A@@@A@@@A@@A@@@ABAA@@@@@@@A@@BGA@AA@BA@@@A@@A@AA@@A@A@@@AACA@AAC@@AAA@@@AAACAA@A@A@@@@A@C@A@ABIBCBA@@@@CCB@D@BA@@BA@AA@@AA@AA@@AA@A@A@AA@@A@@@C@A@@@A@@@@AA@@@@@@@AB@B@B@B@BA@@@C@C@C@CB@@A@@@@A@@@A@@@A@@@@A@AAB@@@A@@@@C@AA@@A@A@@CAA@AA@A@@A@@AA@@A@@@@@@A@AA@@AAA@A@A@@AA@@A@@@@BC@@@@CCAAAAA@CAAA@@@CAA@@A@A@A@A@A@@@A@@AAA@@AA@@AAA@CAA@A@EAA@A@AAAAA@@@ABA@A@A@@A@C@@AA@AAA@@CA@CAAEA@@AAA@CAA@A@C@A@AAA@AC@@@@CA@@AA@AA@@@C@AAA@A@@@AAAACAA@AA@AA@@@E@CAA@A@C@@AAAAAA@A@AB@@@@AA@@@@A@AAA@@A@@C@CAA@C@CA@@AA@AA@@A@@@CAA@@CAC@C@CAE@AAC@A@A@@@E@A@AA@@A@A@@BAB@D@B@D@B@@DB@@A@@@C@E@A@EAA@IEA@@AAA@@@@A@A@AAA@CCA@C@@@C@@@C@C@@@A@A@AB@@C@A@A@C@@ACA@@A@@@A@@AA@AB@@A@A@ABA@G@A@CB@@A@A@@@@@CB@@EBA@A@@@AB@@@@@B@B@B@B@B@@AD@@@B@B@@@B@B@B@BA@A@A@A@A@@@AAA@A@@@A@@@AA@@AA@@CAAACAAAA@@A@@@@@A@@ACAA@@@@CA@@@@A@AACC@AC@@@AA@@AA@A@A@@@A@@BCB@@@@@@A@AA@@@@A@@BA@A@@@A@AA@B@BC@@@A@A@C@@@A@ABA@A@@AA@@@@BAA@@@EBA@A@CAC@A@@@C@@BC@@@@@ABAB@BA@A@A@ABA@A@@@A@@@CBA@A@@@AA@@A@@@A@CBABE@@@AB@B@@A@@B@B@@@@A@@@GAA@ABABABCB@@A@@@A@AAA@AAA@C@EB@@EK@@BADA"],encodeOffsets:[[113886,35279]]}},{type:"Feature",id:"411281",properties:{name:"义马市",cp:[111.87448,34.7474],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@C@E@AAAA@AAA@@ABABABA@@ACBEDC@@@A@E@A@A@E@@B@@@@@BB@@@@BC@GF@@@@CA@@C@@@@@A@AAABA@@@A@@AA@@@@@A@A@@@AB@B@B@@AB@@@BBB@@AB@@A@@@@B@@@@@BA@A@@@CB@@G@@@ABABAB@@@D@@@@B@B@B@@@BB@@@B@@C@@B@@@@@@BB@@@@AB@@@BB@@@AB@@@@@BB@@B@@@@AD@@@@@@@@B@@BA@@BB@@@B@@@@B@@AB@@AB@@B@BA@@@B@@@@@@AB@BB@@B@@BB@@@B@B@@@@B@@@B@@B@@@B@@@@B@BB@@B@ABB@@BB@@BBB@@DDB@BB@@@@B@B@BA@@@@BB@BDBB@@@@B@BBB@B@@A@@BC@@B@@@@@@@@@B@B@@@@@@@B@@@@@@@@@@@B@@@@CB@DAB@@AB@@@BAB@B@BBB@B@B@@@@CD@@@B@@BBB@BBBBBD@BDD@@BBHAD@D@@@B@F@B@@ABC@C@@B@@@BBBBBB@@@BB@@@L@B@BAB@BABAHE@@@@B@@BBB@@@@@BB@@@DAHCBADAD@BAB@DAD@FA@@BAB@B@@AD@B@BABABA@@B@@AD@B@@@DC@@DABAFCB@@AD@BCD@BA@@@@EE@@@ABC@A@A@A@A@@@AA@A@@@@@AB@@A@@@A@@@AD@@@@AC@@@ABA@@BABA@@@A@@AA@A@@BA@@B@@ABA@@@C@CDA@A@AAA@@BA@@@@@A@A@C@A@@@@DCBABABAB@BCB@DAB@B@B@BBD@B@@@@B@@@F@B@B@@B@B@BBDAF@D@D@DAD@BA@@@@@A@A@@C@@@@@@@@A@ABABA@@D@@@@@@A@@C@C@CAC@@@@@@A@@@ABA@@B@BAB@FA@@BAB@@A@AD@HADAB@@AD@BAB@B@BBB@BAB@BB@@BB@@F@@@@@@A@A@A@@FC@@@@@A@@@A@@@A@@BAACDAF@@@AC@A@A@@AA@@BA@@AABA@A@@@@@ABC@@@ADAB@@@@AEEA@A@A@CBC@@@@@@@A@@BEA@@@BD@@BKA@AA@@FAA@@CBABA@@@AAA@@@@A@@@A@A@@A@@@E@KDCAA@C@@@@AAAA@@@A@@@@@BD@@@B@@AACAA@A@CAGB@@ABBB@@@@A@@BCAA@GBC@AB@@@B@FAB@@AB@@@@@B@@B@@@@BA@A@CAAABBAAA@CAAAEAAACCA@@@C@A@CB@@CDAD@@@BB@BB@@@@C@G@E@ABEA@@AB@@@@@BBB@BA@A@CAA@@B@@@D@BA@C@EBA@A@ED@B@@BD@@@@@BC@"],encodeOffsets:[[114569,35551]]}},{type:"Feature",id:"411221",properties:{name:"渑池县",cp:[111.761797,34.767951],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BCABA@@@BB"],["@@@@AACEAAAC@A@A@CBGDEBA@A@@A@AA@@A@ABAB@@A@AAEBAB@@A@@A@@C@C@@AAA@@A@DD@B@@ABA@@AAA@BA@@@BDA@AAEFA@@@BB@@@B@@BAHBBB@@A@CB@@@@@@A@A@@@C@A@CDCB@@A@@@@AABAA@@A@@B@@B@@BABCB@@A@@BABA@@@@@@@A@@@@@@D@@@@@@@BA@A@A@@@BA@ACBA@@A@@@@AB@@@B@@@@AB@BABABAB@B@B@@A@@B@@ABA@BBA@@@AF@@@@@@HA@@@@@BB@@@B@F@@@@BBB@@B@@@B@@@BB@@@BBB@BA@@B@@B@B@@@@@@D@@@@B@@@@@BA@A@@B@D@@@@B@@AB@@AB@@A@A@@BA@@B@@A@ABAB@@BB@@@B@@B@BF@DAB@@A@AB@@A@@B@@@BD@@BBB@@@@@@@BAB@BAB@B@@B@B@@@ADABCD@@A@ABGBABA@ABABA@CBA@A@C@@@@@@@BA@A@@AB@@AA@@@@A@@@@@@AB@@AA@@@EB@@AB@@@BA@@@C@A@@@@@@@@B@@@@ABAA@AA@AB@BCB@@@@A@@A@CAAA@A@@AAA@@A@EBBB@@BH@D@@ADAB@@A@AB@@@B@@A@@A@@C@A@AAA@A@AA@AA@@BA@@@@B@B@B@@ABAB@A@C@KA@@@@@A@A@@@A@@BA@A@A@@@A@BBADBB@D@B@B@@AB@@A@A@@@C@A@A@ABA@CBAB@@BD@@@B@@BA@@@@@B@@CB@@@@@BA@@@@BB@@@@@DD@BA@AB@@@B@@@B@ABB@@A@GB@@@D@BA@C@A@@@@A@@BAB@@@AA@@@A@@@AA@AB@A@@@@GAGA@@@@@AABA@@@A@@@@@@@DA@A@@@@C@@@A@@@@A@A@@@@A@@BABAB@@@A@C@@@@EAA@@A@@@A@@@@A@ED@@@BA@@@AA@@A@BBA@@@@@A@@@A@A@@@@@@D@@A@ABC@CBC@@@@BD@@@@B@@AD@@B@@@@BBB@@ADA@AB@BA@@@AB@@AB@@A@A@@B@@@@@@@B@@CAAB@@A@@B@@@@AB@@CA@@ABA@ABA@B@@BBAB@@@@BA@@B@@@@@@B@@@BD@@BBB@@@@@B@@@@@@B@@B@@@@@@BB@@BBABB@@BAD@@@@@@@@@@BAB@BA@@@A@@B@B@B@D@@BD@D@@C@@@BFC@A@@@AA@A@@A@ABBD@@A@@E@@A@@@BD@B@@@BA@@B@@@BC@@BDA@B@@@@@@C@@B@@A@@@BD@@@@B@@@@B@@@B@@BD@@GDGDA@ABABCBCBAB@BBD@@ABAB@B@D@@A@A@@@@AA@EBA@@@A@B@BB@@B@BAB@DB@@@B@@@@@B@@E@@B@@BBAB@@BB@B@@B@B@@@@@BB@@A@A@AB@@@B@B@@@B@@@B@B@B@B@@@BA@@@@B@@AB@@@B@B@@BBDD@@B@B@@@BBBB@@B@BB@@B@@@BB@@DB@@@BA@AB@@@B@B@@A@E@AB@@@BAB@@@BBB@@@B@@@@@BBBABAB@@A@@B@@D@@B@@@B@@ABADCB@@ADAB@BCB@B@@@@@BB@@AB@@B@@A@@@A@@D@@AD@B@@@@A@@@AAA@AA@AAAA@@@@A@@BA@AA@@ACA@@EC@AA@@@@BBF@@@B@@ABA@@B@B@@@@@BB@@@@B@@C@CB@@@@A@@BBB@@@@D@B@@@@B@B@@@@@@BB@B@@BBB@@@@@@B@@AB@@@BD@BB@@B@@BAD@@@B@BBBBB@@BB@@@B@@@B@@@B@@A@@@AA@@@@@@@B@@BB@B@@@@@D@@BB@@B@DABAFBB@DDDDLFBBDD@B@B@@@BD@DDDHBLBF@F@DBBABBH@DDDBBJFFBBBDBB@FBFAFBBBBBBHBBDNBD@@AB@H@D@@@BDBB@DAB@BBDJBDBBDD@BDBDBFFBDBBDDDBLBB@BAJ@D@D@DBLHJDBBBBDBBBBBAD@BABAB@BCH@B@LADADADCDADCBA@ABCDAB@B@DBBBBDB@@B@HBP@D@B@B@B@B@BBFFDFDFD@H@D@D@B@DDBBB@@@DDDBB@B@DAB@@@NBHADBH@B@@@D@D@B@@BBB@B@D@B@B@@FFFDD@LBLAD@FAB@D@@BDBDBB@BDBB@BB@@B@D@D@BBBDBD@B@B@BALCD@B@B@B@DBD@B@DBDDFDBDBDBFBHBF@D@D@@AF@D@D@BAD@B@B@BBBDDFDFBLBH@TDB@H@FBTB@@DBF@B@@@DD@@B@@@HADAB@FAFCFAFAB@B@DBHFFF@@FDB@@@DBD@B@BARKFABAB@BBFBDFDDBDDFDBB@D@H@FAF@R@HAHAHAFCDCDCFGBA@@AA@@AAAC@AA@@@A@@B@@@D@B@@ABE@A@EAA@@A@@@AAAA@A@@AA@@AB@@@B@B@@@@@AC@AAA@A@AAI@G@@@@A@AA@@@C@@AAAA@@A@ABC@A@@@A@A@ACAAA@A@EA@@E@A@@@@A@@FA@AA@CAA@@A@@@A@A@@@AB@B@H@BA@@BCBAB@FCB@@@@A@ADE@AB@F@BA@@B@BABAFEBABAB@F@B@DA@A@@B@@AB@@@BA@@BADBBAB@BA@@BAB@BA@@B@BA@@@@@A@@B@@@@@B@@A@@B@@@@@@@B@@@@AB@A@C@@@C@CAAA@@@@BA@A@@EGB@BAB@@@@A@@@@AAC@A@ABA@@BAD@@@BBB@B@D@@@@BB@@B@A@@BA@AA@@@@@A@EA@@AAI@@A@@@C@@@A@@AA@@AA@AAE@A@A@@AAAA@AAA@A@A@C@@@C@@@CB@@EA@@@@@@DA@@@@@A@@A@G@A@A@@A@@BA@@@AB@B@@@BBB@B@B@B@B@@@B@B@@@B@@@@A@@B@@@DD@@@@B@@@@@BA@A@AB@B@@@@AB@@AB@@A@@@A@@B@@AB@BBD@@@B@BB@@BB@@B@@@@@@A@@@AA@@A@@B@DB@@B@@A@@@A@A@A@A@@B@@@B@DBF@@@B@BD@A@@@A@A@AAA@A@A@@@A@A@@@ABA@A@@@A@@@@DAB@@A@@A@C@A@@@AA@@A@@@@@@A@AAAA@@@A@AD@@@@@@A@@A@A@A@@@@EA@BA@A@@@@@@@AC@@A@@@C@A@A@ABA@@A@@@@@A@@@@@@A@AB@@@@AA@@BA@@@AA@CAA@@@GAC@C@CBCBC@C@C@A@EA@@A@ACA@AC@@AE@A@@AA@@@A@A@@AAA@AA@@@@@@@AAACAC@AAAAC@EACA@@A@@B@@@BA@@@@@@@AB@@@@@AA@@@A@@@@@@@@A@@@@A@AAA@@@@@A@@@@@@B@@AA@@@A@@@@A@@@A@@@@@@A@@@A@@A@EAC@@@@AA@@A@@AAA@A@@@AAAAACCA@A@@@A@A@A@AA@ACA@@AC@AA@@@A@@BA@@BAB@DAB@@A@@@A@@AA@C@@CA@@@@@A@AB@@@@@@@@AB@AABA@@@@B@@BB@B@B@B@BADA@@DAB@FAB@@@BCDC@AB@@AB@BBB@B@D@B@BAH@F@BBB@@@B@FCBABABABADA@ADA@ADAB@DADC@@B@@@@@B@B@@@BA@ABA@ADA@@BAB@@@BABA@AB@BA@@@@@ABA@A@@@A@@@CACAAACAA@A@@B@BA@@DBB@JBFBB@@@DBD@BBB@DB@@B@@@BA@@BBFBB@FDFBB@D@@@J@BAB@@ABA@@@AAA@ACCAAA@CA@@CA@@AA@A@@@ABABCB@@@B@BBB@@@D@@@@ACEA@@AB@DAB@BAB@BBBA@@BAB@@A@@A@@A@@@@@A@@BAAAFA@@@@B@@A@@@A@@@AB@@@BAB@@@@@@AB@BC@@BA@@A@AABA@BB@@ABAB@@@BB@@BB@A@@B@@ADALEBABA@ABA@A@AA@AAAAAACEAAAA@@BC@ABA@@B@@ABAB@B@F@@@BA@@B@BAB@BA@@B@@B@@B@@@@C@ADE@@@A@@AA@AAA@BA@CBC@C@EBA@A@@A@CBABC@CB@B@HBB@B@B@@@DHBA@@@@AA@A@A@A@A@ABA@@@@@@C@A@A@C@@AA@AA@AAA@AA@C@A@A@A@@A@@@@BABA@@AI@AAGAA@AA@@A@@B@@A@A@A@A@A@A@@@AA@ABA@A@A@C@A@A@A@@BEBCB@BAAC@@@@@DI@A@A@A@@C@@@AA@ADA@A@AA@@@ABA@AB@AAA@A@@@AB@B@JCDAB@B@B@B@BAB@DAB@@@@@@AB@@BB@@@@A@@AA@@@AB@B@@A@A@A@A@@@AAAA@@@A@AC@@A@CAA@AAA@@@EA@@AA@@@AABC@A@@@DABA@@@@@@AA@@A@C@@@@@@AD@@@@A@@@@A@A@A@@@BA@@@A@@AAE@CBBDAB@@@B@@@B@@@B@@@@ED@@@B@B@B@@@@E@@@AA@@AAA@ABA@AAA@A@ABC@@BA@CBGBC@@B@BA@AB@@EBA@ABA@@@AB@B@@@B@@@@D@DBD@D@@@@B@@@@C@@@ABAB@B@B@@@@@@D@@@@B@B@@@@ABC@CBC@C@E@CBAAA@A@@@@A@A@E@@@A@@A@C@AAA@A@A@CBA@ADA@ABABABCD@@@@@B@D@B@B@@@@AB@@BB@B@BCB@D@D@@AB@BA@@@AB@@@BBB@@@B@@ABAB@@AB@B@@BD@@@@BC@@B@@@B@@@BA@@@@B@B@@B@@@B@B@B@BAD@B@@FF@@@@ABC@ADC@@BA@EDABCB@@CD@@A@C@@BA@@@ABABABA@C@@BA@A@AB@@EBC@CBA@ABC@CBABGDCB@@A@@A@@@@AA@AA@@@@@GFABABA@ABA@K@@@A@@A@@AAAAAA@@A@@@@DAD@BA@E@A@@@C@C@GBAA@@CC@AACAAAAA@AA@@@A@@DC@@@@@A@AAA@A@ABA@A@@BA@@BA@CDA@@@@@A@@@@@@@@@@@A@@@@@@@A@A@@@@@@@@@AD@@AB@@@@AAA@A@A@@A@CA@AAA@@@@ABA@A@@@@@AAA@CC@@AA@AA@@AA@BAA@@@AAA@@@@@@A@@@AA@@@A@@@@@@A@A@@AA@@@AA@@ABA@@@@@@@A@@ABA@@@BA@@BA@@@A@@A@@@A@@AB@@AA@@@@@@@@@BC@@@@@AA@@A@@@@BA@@A@@A@@BA@@@@AA@@@@@@@AD@@@@A@@AA@@A@A@A@@@@@@C@@BABABA@@H@@@DA@@B@B@@A@@@@@A@@B@@@BA@@AA@A@@BA@@@A@ABA@@B@B@@@@@B@@BB@@@B@BABBB@@@@@D@@@DB@@@@HED@@A@@A@@A@@@@@AF@B@B@F@B@@@D@FCDA@BB@BABABA@@BB@BBBBBF@D@@@@@D@@A@@@@AC@@@AFCB@B@FAD@B@@A@C@@@AB@DBB@B@@AAA@A@@@@BA@@FBBAF@H@D@@@@@AAA@@A@@BCDC@@DAB@D@@@B@DDBBFBBBDBB@BBAABBDBB@B@@A@@A@@@@A@@@@BA@@BA@E@A@@BAD@HAB@DB@AB@@@@@AABA@@HADBB@B@DBBB@@@A@@AC@@@@B@@@B@BB@B@@D@B@DBLCF@@@B@@@@B@B@@@B@@B@BB@@B@BADA@@@CC@@A@A@@AADC@CAB@AC@ACMD@ALA@@E@@@BA@@@CB@@@BB@@FC@AHA@A@@@AA@@@E@@AC@@AA@C@AC@@@@BBD@ACB@@ACBAAB@@AB@@C@@@AB@@ABA@@@@@AAEAAB@@ABAFAD@B@@A@@@AAG@AD@AA@ADAA@CBCB@@A@AA@@A@E@@@@@AA@@AAA@AA@@ACCG@@@@A@EBAC@A@@ABC@C@CBA@@A@@KB@@A@@C@GCEAA@@@@DCBABABA@A@@@@CB@@A@@@@AB@BA@@CAGGGB@@AEAC@A@C@CAA@@AAA@AA@@C@@@@AB@BA@A@AB@@A@@@@ABCD@@E@A@AB@@AB@@CBA@A@BB@@FAB@B@@@EFA@@@@BCDCDA@A@@@@BKDDDAB@@@B@BB@@@ABA@@@AAA@A@@@@@@@@BBB@@C@@@A@@AA@C@A@@@@A@@BA@@@A@@A@@@A@A@@@AAA@AA@@@AA@@AA@@@AB@A@@A@@@@B@@A@A@ABCB@@A@@BA@@BABABABABABABCDAB@BADA@ABGH@BAFAB@B@@CB@@A@@@@B@@@D@@A@ABA@A@A@EB@@EDABA@A@C@A@A@@@AA@@B@B@@A@@@A@@A@A@A@C@A@CBEH@BA@A@EC@@@@@CA@@ACAA@C@A@@@A@@AAECC@@@AF@@@@@@A@@AACC@@@ADA@A@@@ABAB@DC@@@@@@E@ABA@@CA@CFED@@DCA@A@AC@@A@@@@BABIDCB@@@@A@ECAA@ABAA@@AC@C@E@E@@@@A@A@AAA@@AA@@A@ABA@A@@@@@@AB@BABA@@A@C@A@A@@A@@BAB@BA@@@@A@A@@@@A@@BAFC@@CCACCC@@A@@A@E@AA@A@IBC@CBABADA@C@A@"]],encodeOffsets:[[[114720,35619]],[[114449,35443]]]}},{type:"Feature",id:"411224",properties:{name:"卢氏县",cp:[111.047858,34.054324],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@A@@@AAA@@@A@A@E@CA@@A@@@ABAAA@CAA@AA@@AB@@AAA@AA@@A@@BCB@@@@AAAAC@C@ABAB@BAD@@A@ABCBCBCDAB@BAFAFIZCBKHAB@BCDEDMJEDKNIJEBGB@@E@IACAABA@CFEFEHCBQDUFUFG@CBCFCBABGBG@G@GAKBABCFKN@@GJGHEFABEBIDC@IDABGF@@ADEHAF@@@FABCDGDABCDAH@B@DBDDFDDBB@B@@@B@BAD@DBBBBDDBB@DADCBEDIPCFCHAH@B@D@F@J@B@DADCBGBEDAFAFCFAFCHAFA@C@C@C@CBCBGF@D@@@BBF@DABABAF@DABC@ABE@C@E@ECE@IBMFA@@@AAAAC@A@EBE@EDIFMFADAD@HAFADCBABCDELEJABCBC@IBI@@@A@EBC@GB@@C@A@IHADABEBEBA@CDCF@BC@A@C@GAECGECAEAC@E@CBEBABC@A@AAEAAA@@ABABAB@@GBEBI@AB@@AB@D@DBJ@B@B@J@BABE@ODA@@@@@AHADADGFGDCD@B@@@B@BBDBD@BBH@BB@@BBBFBDBD@DBD@BDB@DBHBB@D@B@D@J@H@DB@@BB@B@BADCFCD@B@B@BFFBBB@@@F@DBBBHHDBHDNFF@JBN@HBDDHLBDBB@BCBADADAH@B@@@D@D@B@BA@EBSBE@CBC@ABAB@B@B@BBF@F@HAJ@HABCDINEFAB@@AB@B@B@B@B@@@BCF@BA@@@@@A@ABA@A@ABABAB@@@DBB@@B@@@DB@@@@@B@BA@AB@B@@CDA@AB@@@DA@@BABCBA@A@A@CA@@A@AAAC@@@@BCBA@A@C@@CACAE@K@I@C@C@A@A@C@CAA@@BA@@B@BBDBB@D@FBB@D@D@B@@C@GDABA@BB@@@@B@B@D@DBB@AB@@@@@B@BA@@@B@@B@@@@@@B@@@B@@@@@@@AB@@@@@B@@@@B@@@@B@@A@@@A@A@@@A@@B@@A@@@BB@@@@B@@BB@B@@@@@@@B@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@@A@@@A@@@@@@@@@@BA@@@B@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@B@B@@@@@BB@@@B@@BB@@@@@@@@@BB@@@@@@@@AB@@@@@@@@A@@@@@@@@@@@@@@BB@@@F@@@B@HCb@BCHAF@BBHFFFBPFNFDDDDBJDH@@D@DBBB@@FFBBBD@BABCDAB@B@@HDLJ@@JLDJBH@@B@B@BA@@DA@@B@@C@@@AB@B@BAB@@@BEB@@@BAD@B@B@@@B@@@BAB@B@@@BDB@@@B@BADA@@@@AA@A@@BA@AB@@AB@B@B@BA@@DBB@@AB@@@@C@@@A@@D@B@@@BA@ABA@@B@D@B@B@B@D@DBB@BBDBB@BB@@B@B@DAD@DAD@FB@@B@BB@@HBB@B@B@B@B@D@B@B@@@BADABA@CDA@@BA@@D@BBD@B@@BB@BABBD@BA@@DA@ABABCBA@AD@BB@@B@DAD@@@BB@@BA@ABABADC@A@@@A@C@@DA@AB@@@B@@@B@B@DBB@@@B@B@B@@@FBD@D@B@B@@@BB@@B@@AB@B@BB@@B@BB@@@B@B@BBB@B@@@B@DAB@@@BABB@@BB@@DB@@@B@F@@BB@BB@@B@BBB@D@FB@@FAB@BBBB@@B@DA@@BBB@@B@@@B@@BB@B@@DD@B@@@D@@BB@B@@@BFD@@BBB@@@@B@D@@@BB@BB@@@BBBB@BBB@B@BBFBB@BBB@D@F@B@DB@@BBB@F@B@@@B@@BB@@@B@B@BD@@BBB@@B@B@@BBB@@@BB@BFB@@BBDB@@FBB@@@@BAB@@@B@BBBBBFD@@B@BB@@@BBDB@@@@DBD@B@B@BA@@B@@A@CBA@ABE@@@AACBA@A@@@@@A@@BAB@@@BA@C@@@@B@BCD@BABAD@@@B@@@BA@GFAB@@ABBBABAB@@BD@BAF@B@B@B@@BDB@@B@D@B@BBB@B@D@B@@B@@AB@B@@BB@BBBB@B@B@B@@BD@@@BAB@BBBBD@B@DAH@BBB@B@D@@BB@@@DAD@@@BDF@BDJ@@BB@B@BAD@@@BABABAB@BBB@BA@@BABA@CBA@@@@D@@@B@BBDBD@BBB@@@B@B@@@BA@@@BD@@BB@BAD@DADADCDB@@BB@@@FBDB@@B@@@DABA@@B@HBB@@AB@@A@@BA@@@CBA@ABEBA@AA@BCB@BA@@@A@@AA@AB@BAF@@@@ABA@AB@@AAI@A@AA@@A@@B@BADA@AB@@A@AB@@@FALGB@DE@@B@B@B@B@@@@@BBBBD@B@DBB@@@BAB@@A@ABA@@BA@@@@@A@@CC@A@@A@CA@@@@AA@@@ABE@@@@@ADGBEBA@A@@@@@AAAACA@@C@C@AAC@A@A@@BAB@@@B@@@B@BBB@D@B@@@B@@@@@@B@BBBBBFDBDFBDB@B@@@BDDBB@BDB@@B@BAB@BABADA@@D@@AD@B@@@DBFBB@@@DAD@BA@@BB@@DD@@@B@B@BBB@@B@@BD@B@@@B@B@@@BCB@B@BC@@@@BBB@BB@@B@@B@@BB@@@B@@@@B@FB@@@@BH@@BBBBDBB@J@D@D@B@B@B@BB@@BB@B@@@@B@DABA@@B@D@D@B@@BDB@BB@D@B@B@B@FDDBB@B@B@B@BCB@BE@@BCB@@@D@F@B@@@B@@@@B@@@@AB@BCBCD@B@@@DBB@B@BA@AB@BAB@@@B@@BBD@B@BB@@BB@D@@BBBBB@@@@B@BBDBD@B@BBBBBB@BB@@BB@BAB@B@B@@BBBBBBBD@@@B@F@B@BABAH@B@B@D@@BB@@ABAD@B@@BBBBDBB@@BAB@DBB@@ADA@@BCB@@@@@@BF@B@B@@AD@DABBBB@BBB@DDB@BBBB@BAB@B@@@B@@HF@@@B@@@BCB@@@B@DA@ABA@@@@@@BB@DB@@FBDBDBB@BBB@B@@@DAD@B@D@@@@@BBB@@@@@@AB@@A@@@@@A@@BB@A@@@@D@@@B@@@@@B@@@@@B@@@BA@@@@BB@@@@BB@A@@@A@@@@@@B@@BB@B@B@@@@@B@@@BA@@BB@A@@@@B@@@B@@@@A@@AABA@C@@@@BA@@@A@@B@@A@@BA@@@AB@BA@@@A@@DA@E@@@ABC@C@@@AAA@A@@B@@A@ABA@@@AB@@@B@BBB@B@B@@@BBBB@@B@F@FAD@BBDBDBB@D@@@DBD@B@D@@ADA@A@@@EBCBA@A@BB@BBB@BA@@B@BBB@@@B@@@BCB@B@B@B@D@@AB@B@B@B@B@BABA@C@@@G@E@ABA@@BAB@@@B@BB@BB@@A@@@@BE@@BC@@@AB@BAHAD@@ADCDCFCBC@ABA@@BA@@@A@@@@@ABA@A@@@A@@@A@ABA@A@@BA@@@@@A@@@C@ABA@A@@@@B@@@@@B@@@@A@@B@BA@@BADAB@@@@A@@@@@AB@@@AACA@AEAA@ABA@A@@@@C@@@CA@AA@AA@A@@@@@A@A@A@@BA@ABA@@@@@@A@C@A@@@A@AAABABABA@@@AA@@@BA@@BA@@B@B@B@BA@A@ABAA@A@CBAAA@AB@A@AAA@A@ABA@@@@D@@A@@@@@A@@@A@@@A@BA@AAAA@@@A@A@ABAB@AAA@@@@A@@@@B@B@@@@@@A@@@@CCBA@@@AB@B@@ADAB@@CBA@AAC@AACAC@ACC@@@A@@@@@A@C@AAAA@@A@@@A@@@@ACAA@@A@C@A@C@ABA@AAC@K@C@CA@@A@@AB@@@BABABA@@@AFABA@AB@@AD@B@B@BAB@@A@@BA@A@A@@B@B@@A@@B@BAB@B@DAD@B@@@B@@A@@D@@AB@@@B@B@DA@@B@B@@ABA@@B@@ABAB@DABAF@B@BAD@@ABA@@DA@A@@@AB@@C@AAC@A@@@A@@B@@@@A@@@@A@@A@A@@BA@@@A@@@@BCBA@A@A@A@@BC@@@@B@BDB@@BBAD@@@B@@AB@B@B@B@F@BBDBBB@@BA@AB@DA@@@@@C@ABA@@@@@A@@@@@@@A@A@@B@@@BA@@@AB@@@B@@ABE@@BAB@BAHBBA@@D@DA@@@A@@@C@AB@@AB@@AB@@@@@B@@@@@BCBC@@@A@A@@@AFABAFCBABAB@@@@@DD@BBB@@@D@@@@@BD@B@@@BA@@B@BBB@BA@@@@@C@AB@@AAC@C@@BEB@@@BBDDDBBBBDBD@@@BB@@@B@FCF@D@@@BAB@BAD@BAB@@ABAB@BA@@BA@@@A@A@ABA@A@@@AAAAA@A@A@A@C@A@ADC@C@ABAB@@ABA@@B@B@BBB@B@@@DADA@BD@B@BBB@BABBB@DAB@B@B@@@B@B@FCBCBC@@BBDA@@BAD@B@BBB@B@BAJ@F@@A@A@A@AB@@ABA@A@A@AB@BAFCB@@ABA@E@AA@C@@AA@AAAAC@A@C@@@AACCEC@@@@AC@A@ABC@A@@A@@AEA@@BAAA@ABCAAACAC@@AA@@AAC@@@AA@G@A@AB@@A@A@E@@B@@AA@@AA@@A@@@@@@BABA@@@@AA@A@@@A@A@@BE@A@@ACBA@ABEBABA@A@@@A@A@@B@F@BA@@@@@@@A@@@A@@@ABA@C@ABC@@BC@A@@@A@@@A@A@C@@@AB@@@@A@@AA@A@A@@@AAA@A@@@A@A@@@@@A@A@@@A@@@@AA@C@@@@B@B@@@@@@@BA@@@@@@BA@@@@@@@@@@@A@@BAB@@A@@@CAA@@CA@AA@A@AA@@@A@@AAA@AA@A@A@AAAAA@@A@@A@AC@AA@@A@@AA@AAC@C@C@EAAA@@A@ACAAAAAA@AA@GA@@BA@A@A@@AAC@@AAAA@AAAAEAAA@A@A@AA@AAA@ABA@@A@@@@@A@A@AAC@AAA@ABA@A@A@@@AAA@AAA@@@A@A@@@AAA@A@@A@AA@@EDC@C@A@AAAA@@C@A@CAA@A@@BA@@B@B@@@@@@@B@D@B@@@BA@@@A@A@@@A@@@A@@@A@AB@@A@ABA@@CA@A@@@@A@AA@@AA@A@AB@@ABA@AC@ACCA@@@ABC@CB@@ABABAB@@A@CBC@@@A@A@AA@@@@A@A@@@A@CBA@@ACAA@A@@@A@AAAC@@@@@AA@AAA@@@A@@AA@C@@@@A@@AA@@BC@AAC@AAA@@@ABA@G@@B@BABADG@@D@@AB@@C@@B@@@@A@ABABA@@@C@@AA@@AAACA@E@AA@@AA@@@A@@@@A@@@CD@BA@BB@BADA@A@A@@A@AAABG@@AACA@@ABA@C@AB@@AA@AECC@@@@@@@BB@@B@@B@@@B@B@@@B@@@@BB@@@@@@A@A@AA@@AA@@C@A@@@@@@A@ABA@ABA@@@AA@BE@A@A@@B@B@D@@A@@B@@@BA@AB@B@B@BA@@B@@@B@BABA@@@AAA@A@C@AAA@A@A@A@@@AB@B@B@@@@@BA@@DB@@@A@@B@@AF@BA@AB@@@BB@@B@BA@A@A@@D@B@B@B@@ACG@@B@DABA@A@A@A@C@A@A@@B@BAB@@ABA@@FCB@@@@A@A@A@ABADAD@B@@ABA@@BAB@BAB@DCDA@@@C@AAA@A@E@CBA@A@AA@BA@ABA@A@AAAAA@@@ABG@A@A@A@AA@@@A@C@EBA@@AC@C@IA@@A@@@EBCBEBCDA@@@CAGAA@@AA@A@C@@@A@@C@@@A@A@@CEAA@A@@AAA@@AA@@AA@C@AAACCCAEAAACAA@A@A@@@A@AAAAACEAACE@@AAAABA@A@A@@AAA@@ABA@@@ABA@@AA@@@AA@@A@A@@@AAAAC@A@@F@B@AC@A@ABA@E@ABA@@AGAA@A@@@CBCBC@@AAAA@AA@@AAA@@BABA@A@@AEA@@A@AA@A@A@AAAA@AA@A@A@A@@AAAA@@ADA@AAECCBA@@@AAC@@@E@@AA@CAA@@@@CAA@AA@@GAC@CA@@A@C@IBCBA@A@G@EB@@C@C@A@AA@A@A@AACAA@@A@A@ABABA@E@@AA@A@AAAA@A@A@@@AFGB@BA@@@C@@BA@AB@BAB@@@@A@@DC@@D@@AB@BAB@@@BABABA@C@@BADA@@@A@C@A@A@ABA@AB@@A@@A@A@@AA@@AAAAA@AAC@@A@@@A@@A@AAA@ACAA@CAAA@@A@@AG@AA@@AA@C@ABA@C@@@@@AA@BA@ABAB@@A@AAAAA@C@A@@@A@@AAAAA@AAAAA@@AA@@@@A@A@@AAAA@@@AACAA@CAC@A@AAAAAACAA@@B@@A@A@AAAAA@AAA@AAA@@CAAA@@@AA@A@C@@BC@@@@@AAAA@@AA@@AAA@A@@A@AAAAA@A@@GD@@A@AAAC@AAA@@@AAA@@@@A@C@ABCB@@E@@@A@AB@BEBA@A@A@CAAAEAA@@@BA@A@A@AA@@@A@@@@@CC@@CAECA@CA"],encodeOffsets:[[113720,34359]]}},{type:"Feature",id:"411282",properties:{name:"灵宝市",cp:[110.89422,34.516828],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@A@A@ABE@AAC@@BABAAABA@@BAHEB@@A@@@A@@BCBA@ADC@A@A@@D@B@@A@@BA@AB@@@@@B@B@DABB@@F@BAB@DAB@@@@AB@@A@A@AAC@C@@A@AC@A@@AAA@@@ECAAAA@A@A@@BA@A@@A@EA@@CAAA@@EA@AAA@@A@AA@@@A@AA@AA@@ACA@A@@@A@@AA@@@A@E@A@AA@@CAA@E@C@A@AAA@EAAAA@A@AAA@AA@A@@AAA@@A@@@C@A@@A@AA@@EC@A@@@AAA@@@C@@@ACC@@@AAA@@@A@@@AA@AA@@CBA@@@AAAAA@EB@@EAC@A@AAA@@@AAA@@AE@A@@@A@@CA@@AA@BA@A@@BA@C@A@@@AAA@A@A@A@@AAA@@@AAA@A@@BA@@@AA@@A@A@C@C@EA@@A@A@A@@@A@CAA@A@@@A@@@A@@BCB@@@D@B@@@BCDABAB@BAB@@AA@@C@CBA@@@AAC@@BABADAB@BCB@@ABC@AAABA@@AA@C@AAC@@@AB@@CB@DABCBAB@@A@A@C@A@A@A@A@A@GA@@AAA@@@EAC@CBC@CBA@A@@@AAA@CAAAA@CAC@A@A@A@C@A@@@AB@BAB@@A@C@@@@B@@@D@@A@@BA@CA@@ABA@A@A@@BA@@BAB@@@BBB@@@@CBABA@@@A@AC@@A@A@AB@@A@@@A@A@C@AB@@A@AF@@A@ABA@A@@B@@@DA@@@CB@@ABA@A@@@@BCDGLCBMDCB@@CDCFA@E@IAI@G@IDABC@E@IAA@@B@FBDDF@D@BA@GBIBK@C@CAA@C@ABGDC@EAAB@@ADABCD@B@@@BBBAF@FAFADCDC@EBCBCBG@G@GBIBCBCAGCE@@AE@CBKJABCDCBEDCDEDGBIBKBE@CDCBODKBK@EBIBABABAB@BDJDBBD@BADGHEF@D@FADCDCDEB@@A@@DBDBL@DDDBBD@F@FAD@FBFBF@DBFDDFFHDFBB@@D@DBBB@DDDFJBD@B@DBDBBBA@@HAHCF@N@J@DBBD@BAD@DBB@BDJ@F@JAFAFCD@@OHCBCDAB@HAHAFEPCDCD@F@@AHABADAD@B@FBHBJBFABCBA@EBEACACCGEAACAA@@@IB@@MDGBEDKDA@KHULA@EDAD@D@@@B@BB@@B@B@@@@@@A@@@@@@BA@@@A@@@@@AB@@@@@@@@A@@B@@A@@BA@@@@@@@@BB@@@@@@@@B@@@@@@A@@@@B@@A@@@@@@B@@@@A@@@@@@@@@B@@@@@B@@B@@@@@@@@@BA@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@A@@@@BB@@@@@@B@@@@@@@B@@@@@@@@@B@@@@B@@@@@@B@@@@@@@@@@@B@@@@@BAD@@A@@@@@@B@@A@@@@@@@@@BB@@@@@@A@@@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@A@@@@@A@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@BA@@@@BA@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@B@@@@@@@@@B@@@@@@@@@B@@@B@@@@A@AB@@@@@@@@@@@@A@@@@@@@@@AB@@@@@@A@@@@@@B@@A@@@@@A@@B@@@@@@@@@BA@@@@B@@@@@@AB@B@@@@@@@BA@@@@@@@@@@@@B@@@@@@@@@@AB@@@@@@ABA@@@@@@@@@AB@@@B@@@@@B@@@@ABA@@@@B@@@B@@@@@@@@@@@@@@BB@@@@@@@B@B@@@@A@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@A@@B@@@@@@@@@@@B@@A@@@@@@@@@@B@@A@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@ABA@@@A@@B@@@@@@@@AB@@@@@@@@@@@B@@@@A@@B@@@@@@BB@@@@AB@BAD@@@@@B@@@@@@@@@@@B@@@@A@@@@@@BB@@@@@@@@@@@A@@B@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@A@@@@B@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@A@@B@@@@A@@@@@@B@B@@A@@@@B@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@BBB@@@B@B@D@BBB@BDHBDDDDBDB@B@DBB@@@DDBD@B@@@B@DBPJFBNFHBFB@D@D@HAB@@@@CAECGAO@OBE@KDGD@@@B@@@DHLHX@DBBHTPEREB@FAHCHCRCRBFDDBFFJHBBRRDFBBFHHBDBNBLAD@^MBATIDCLEJGTMB@TKPCNDB@JDJDJD\\L@@THPHPDRDHBFAD@zBL@EH@bAFBFFfnBDBBPLJLBJFDJDD@J@PCB@PED@PKJGDAFCPMNIR@HBB@JFDBJFTFL@NBP@F@HDRHDFJJDHJJDFJNNZDHJZ@`CTBFFHJBJ@L@VINKVYBADCJ@JD@@VNPHPJBB^RTJBABC@@AA@A@@@@BA@@@@@A@@A@BA@ABA@@B@B@B@@@BA@AB@@AB@D@@CB@@@@@D@@@H@@@@CD@@A@@@AA@@@@@@@@C@@@@A@ABA@@C@@A@@B@@CB@@C@AAA@@@C@@@@@BA@@@@@A@A@@@A@@B@@@B@@AD@@@@BB@@@@DB@@@BABA@@A@@@BC@@@@EAA@A@@@@A@@A@A@@A@@@ABA@@BAA@@@@@@@@A@@@ABC@@@@B@@A@@@@A@@@@A@@A@@@@AAA@A@AA@@@C@@A@@@A@C@A@@C@@BC@A@CAA@@@@@@ABA@C@@B@BA@A@@A@A@@A@A@@BA@@@A@@@@@A@@BB@@@@@A@@@@B@B@D@@@@@BA@@@AA@@@@@@@AA@@@@D@B@B@@@@@@A@EI@@C@A@AAA@A@AD@B@@@CCCC@@@@@@HA@@B@D@B@@@@A@@A@C@A@@A@@B@B@B@B@D@B@@@B@@@@@BBDAD@B@F@B@D@B@@@@@@@DA@@@@@@B@@@@@BE@AB@@A@@B@@AH@@@@@BA@@@@B@D@@BD@B@@@BC@@D@@@@ABC@@@ABABABA@@AA@@@@@@B@@@@A@CAA@A@A@@BA@@@@B@B@@ABA@@@@@A@BBAD@@@BC@@@A@@BA@@BCB@@@@@@@B@@BB@@ADABABABC@@@A@@B@@@@@@B@B@@@@D@H@B@B@@C@A@@@A@CB@@ABA@ABA@A@@AA@@@AB@@AB@@@@@@@@A@@B@@@@A@@AA@@@ABAB@@A@@@A@@@A@@A@@AB@@AB@@A@@AA@A@@BA@@@ABA@A@@@A@A@@@AA@@ABA@@@A@@@A@@@@@@@AB@DCB@@ABA@@BA@@BADCBABAB@@ABABC@@BA@ABAB@@@@@B@@@CC@A@A@CAAAAA@@AA@CAAAA@@A@@@C@@AA@@AA@A@A@C@@B@@AFA@@@C@@@CCCAA@A@AAAA@@AB@@A@A@ABCDC@A@AAC@C@A@C@@BA@@D@@@BCDA@A@@BAD@@A@CAC@C@ABA@AB@HCFCB@@AD@BAB@@ABA@AB@B@B@BA@@@AB@@@BBB@B@BAB@@@@ABA@E@C@AAA@@@@B@B@@AB@@@@@@B@@@B@@@@B@@@@@@@BB@@B@@@BB@@B@BABA@@B@B@@A@A@A@@@A@AB@BAB@@@B@@ADAH@FAB@@AB@@@B@BA@@BA@@@@@AAAA@C@C@C@CAA@GAAAEAAAA@@BAB@@@@@CAA@@BA@CB@@@AA@@@ABA@@A@@@C@A@AA@@@@@A@@@BDEB@@C@AA@@A@@@AAABBB@BAB@B@@C@AB@D@F@DB@@D@BBB@B@B@DBB@B@FAF@BAB@B@D@@BB@@A@@B@@C@AB@@@B@@ABA@@D@B@@@BA@ABA@C@AA@A@@C@@A@AAA@A@@@@A@@AAAA@@@@BA@CAA@A@@BA@@B@@A@A@C@C@A@@@AAAB@A@AA@CA@AA@@AA@@A@@A@A@ABAB@@A@@@ABAB@@A@@@@@A@@DA@A@A@C@A@@@@@@C@I@@BA@AAC@A@A@AAEA@AA@A@@@ABAAAAAA@@@@A@A@A@C@A@ABA@A@A@@@CBC@A@A@AA@@AA@ACA@@AAAA@A@@@@@@AAA@C@@A@A@A@@@C@E@A@A@@@A@@@A@AAA@@EAAA@@BC@ABA@A@AAA@AA@AAAAA@@A@@C@A@C@CA@@C@A@CACAAAC@EBE@A@@@AAAA@@A@A@A@AAA@@@A@@B@@AB@B@BA@@@@BBB@B@@@DAD@B@@@FCB@@@B@@ABA@@B@@AB@@@BA@@@@B@@AB@@@@@DABBB@@@B@@A@@@A@@@@@@BAA@@AB@@A@@@@@A@A@A@@AA@@@@@@@@B@@@BAA@@@@AA@@@@AB@@A@@@@@A@@@@@A@@@C@@@@@@BAA@@@B@@@@@BA@@B@@@@A@AA@@@@C@A@C@CB@@A@A@AAA@CACAEA@@CAA@@A@@@@B@BAB@@C@A@@DA@A@@@A@@GE@@@A@@@ABA@AAAAAA@CCA@AAA@AABA@CBC@@@A@AAE@@@@@@DA@AB@BC@@AA@CBA@AA@CAAAAA@@@ABCBA@@AA@@@C@A@ABGBA@A@A@E@A@@ACAAAAAA@@@A@ABA@AAA@@AAA@AAAA@A@AACAC@A@A@@A@AAAA@@@CAA@@AAA@C@AA@@@A@@BA@ABAB@@A@AAA@C@@@ADCDA@ABA@@@@@A@@A@@@A@E@C@@@A@AD@@AFA@ADA@A@A@A@CAECA@A@A@C@A@@ACA@AA@C@C@A@@@ABCBA@@@@@@AAA@@AAA@A@A@C@C@I@A@CAAAAA@@AG@@@@EAA@@@@@@A@@AA@@@AA@@@AAA@AA@@@@ADA@A@AD@@A@A@@@A@C@@AA@@@AA@A@A@A@@CC@@AA@@ABC@CB@@A@EACA@@A@C@@BC@@@CBABABA@ABA@@@CA@AAACC@A@@@ACAEAACECAAAA@A@A@@@@A@@@A@C@A@AAA@@@A@@@A@AB@@@B@BBD@B@D@DB@BDBB@B@@@@@BABAFCH@B@@@@AF@B@@BB@@@@DBB@@@@BDD@@@B@@@@AB@@AB@B@BA@AB@@A@CAA@C@AAAA@@@@A@A@A@A@@@CFA@KHEB@@A@@B@BA@@BCBABA@@@@BB@@B@BBJ@BA@@BAB@B@@E@ABA@@BBB@@@B@@ABA@ADB@@BABAF@BAB@D@@AB@@@BA@@BA@GAA@@@ABCB@@A@@@CAEA@@A@@AA@DCBCBC@CBC@AAA@@AC@@B@@A@@@A@A@@AA@AACAC@A@A@@@C@@B@DAB@BA@AB@@AAA@ABABABA@A@@BC@A@AAA@@CI@ACE@A@@BC@C@@AA@@@C@AAA@ABG@C@AACAA@ABA@A@@AC@@@A@A@AAAAAA@@AA@A@@BA@@@@A@C@AAA@A@A@C@AA@AC@@"],encodeOffsets:[[113511,35054]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
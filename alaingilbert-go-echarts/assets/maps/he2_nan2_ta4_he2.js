@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("漯河",{type:"FeatureCollection",features:[{type:"Feature",id:"411102",properties:{name:"源汇区",cp:[114.017948,33.565441],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@ACE@@ACB@EE@@@A@@EG@@ACBA@@C@AA@@A@CG@ACE@@AA@ACE@E@@AA@AC@@@@@FEBK@@@BLG@@J@@@BA@@F@FG@@BF@@B@DC@@DA@@BA@A@@DH@@@@DE@@DB@@F@BH@@HBA@@BN@D@@@DDBBBB@@@@D@D@@F@@FVCB@FAD@D@N@D@B@BBDBBBDBD@DB@BBBBB@D@@@BD@FDDBHB@@NF@@@D@DB@BBBB@@@@@B@@A@@F@BABB@@D@DD@@B@@B@@@B@@BD@@FF@@ADB@@A@@DA@@BBDA@@CC@@FG@AHG@@@@@AB@@B@@BD@@B@@@@C@EFAB@B@BABADCD@@@@@@DBFFB@@B@B@@BBB@@@@@@B@B@@BLD@B@AF@BC@@F@@C@@@@FA@@BCB@FA@@F@@@BB@@DD@@BF@D@B@@JG@@D@@@J@DF@B@BADAB@FEHCBAFE@@DABAB@D@BAB@JAB@D@NABAB@@AB@BE@A@C@A@A@ABA@@B@D@DBB@BB@@FB@B@BBBA@@B@BABKDA@@B@B@BBDDBDDBBDBB@@@B@B@FEDAFEB@BAB@@@BAD@D@D@F@F@F@D@@AB@BCBA@ABA@C@@AI@CB@@AB@@AD@@BD@@BDBDHDDBDBDBD@@BBB@B@B@BAB@BABA@@@@DI@A@ABAB@B@B@@@B@BDBBB@DADA@@BAB@@@BEBCB@BAB@B@DBB@DBB@D@B@@@FADAFAF@B@D@B@B@@@F@F@D@DB@@B@D@@BB@DBD@D@D@F@@@F@D@@E@CAA@A@A@A@@@A@@@C@@AKAED@@CC@@EAIAW@@CQ@@@EA@@AA@@AB@@A@@@CG@@DK@C@@BA@ADC@@BE@@AA@C@B@@@AGE@@AA@@CC@@AD@@AA@@AB@@AA@@AB@@A@C@@@EA@@CE@@@@@@A@@A@AA@AAAC@IA@CE@@AC@@A@ABQC@@AA@@AE@@EA@C@A@@CG@@CC@@@ADC@@DG@@@@CC@AEA@@@@AC@@@@EA@@EC@@BA@E@@@E@A@@AE@A@@CC@@EA@@EG@A@A@E@K@A@A@A@@@@@@D@@A@@@@@@BE@@DC@@AK@@@@FD@@B@BC@@BA@CB@NC@@BG@@BE@@@@AE@@EG@@CA@@@A@@@@AE@@@C@@@QB@EA@@@O@BDOBG@@BA@C@@AI@@@@AC@@AA@A@@A@AG@@CAB@CB@AA@C@AC@@GCBE@@@E@"],encodeOffsets:[[116571,34268]]}},{type:"Feature",id:"411103",properties:{name:"郾城区",cp:[114.006943,33.587409],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@ABC@E@@@E@C@C@C@CAA@@AC@A@@@CAC@E@E@@@A@A@C@A@E@EBCBEB@@A@C@A@CAA@CAA@A@ABA@ADAF@@A@AB@@CBCBA@AAACA@@@A@A@A@AB@B@BCJ@@@@ABABA@ABA@A@A@AA@@ACACACCCCGCA@AC@@AC@@BA@@BA@@DBJ@@@DAB@BABADA@@BC@E@E@E@C@C@C@AB@@A@ABA@EFCBEFA@A@@@A@CAAACCCAAC@A@A@AB@LCBA@A@AB@AA@A@AEA@@AAA@CAC@A@@@AB@B@B@B@D@BAFA@@BA@ABMBC@A@IBA@ABC@A@ABCB@@EFABGDEFA@CBABA@E@QCAAC@C@A@A@A@A@ED@BA@A@CA@@A@AA@@AA@@@CAC@AAEAC@@AA@@A@A@@@ABCB@@IPADABIH@@A@CB@@A@A@@@CA@BGFBBEBC@@BA@BH@@A@@B@BD@BLA@@@@F@@BBC@@@@BB@BD@BADA@@BCB@@@@A@@B@@BDC@@DAB@@EDBBA@CDACGBC@@BEBBBA@C@@AEB@@@BA@@@@FA@@BBDABCBBHABBBCBBBA@@B@@A@A@@CCB@BC@A@@D@B@B@BCB@@BBAB@@F@@@@DH@@D@@B@@FEB@DABBD@BB@BD@B@F@BEB@@@@@B@DEB@BD@@BG@@FE@@B@DH@@@@BD@@B@B@F@@H@@FB@ADP@AFB@@@@EBBD@AD@@D@@@@DB@@BH@@FB@D@@@D@@@B@@@BAD@BA@CBAB@@@BBB@FAD@B@D@B@B@B@@ADA@A@@D@D@BAB@@A@BBB@B@@@@DB@@B@@B@BBDBB@B@B@@BB@@@BBDAB@@@FA@@B@JD@@DDAB@D@@DBBB@BDD@FB@B@@F@@JB@@@DALEBADAB@BA@@@C@A@C@A@@BAHEDA@AB@@C@E@A@@BCD@@@@A@ABCB@BB@@BBB@FBB@D@F@@@@DBBFBD@BB@ADB@@AB@@DBAD@@BBDA@@BAF@BD@DB@D@@BB@@A@@D@@@@AA@@AD@@BB@@AB@@@B@@@@@D@BCB@@BB@@B@@B@@ABA@AB@D@B@@@@A@@@@@@C@@ADE@@@@A@@@A@@@BAA@@A@A@@B@@@FHBB@B@@@AB@B@@AB@@CB@@BB@@@DC@@@A@@CA@@@@BCB@@@@@BD@@@AB@@AAA@@@@B@B@B@@BB@@@BA@@@A@@A@@BAA@A@@C@CAA@@AB@@@@A@BB@B@@@@@@A@@@CBAFA@A@@@B@DB@D@@@BABA@@D@@A@@A@A@A@@CF@B@@B@B@@@@BB@A@@@A@A@AB@@@@BB@B@@@B@@@@@B@ACBA@A@@@@B@@@BB@@B@@@@@D@BAHBH@DB@@@@BB@@DA@@@A@@B@@B@@D@@@FABAB@DBB@F@@AB@B@@B@@AB@@@BBA@@B@@@@@@BC@@B@@@BB@@@B@BB@@B@@@AB@@@@@@B@@@@AB@B@@@@@BB@@B@@@BA@@B@@@@D@@@@B@@@@@@C@@@@D@@@@AB@@@BB@B@@AB@@@@B@B@BA@AB@@@B@@@@@@AB@@@@@B@@DB@@D@@FBHB@D@@BB@A@@D@@B@@@BB@@AB@@B@@B@@F@@DB@@@BB@@AJ@@@@@D@@@@@H@@DH@@A@A@@H@BBB@D@DAB@B@@@@@@B@@C@A@@D@@HA@@D@@BB@@@@CA@@AE@BCAA@A@@D@@AD@@AC@AABA@@@CD@@EF@@@NBB@@A@AFBB@ABD@ADF@@B@@B@@@A@@B@BD@@FB@@AB@@@@@DA@E@@@@@EFB@CB@@E@@F@BBB@BABB@@@@@B@BB@B@@@@@@B@@@D@@D@B@BBB@@@@@@E@A@C@@D@@@D@B@@@J@@@@IB@B@@MB@@ED@@G@AD@@ADA@ABA@@@@@EE@@I@@@I@@@@H@B@B@HDD@@@@ABBBAH@@@@I@AD@B@DBB@@A@@GA@E@@BCBA@A@A@CA@@@A@@AA@@@@A@A@C@I@E@ABAB@@@AA@@AA@@A@CBAB@@@BA@ABA@A@A@@AAA@ACE@A@@AA@AAA@ACC@@AAAAA@GC@@A@CCEGAAACACACACAC@AAA@C@CCIAA@A@CAC@EAA@E@A@C@AAA@@AA@@CAA@A@AA@@AC@A@A@C@C@@@A@GAA@AA@@@A@A@A@@@A@AB@@C@IDGBA@C@"],encodeOffsets:[[116785,34386]]}},{type:"Feature",id:"411104",properties:{name:"召陵区",cp:[114.093902,33.586565],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BKADAI@@AA@@BBB@DGA@BC@@AA@BGAA@@AA@@@A@C@C@A@@BA@@DA@@@A@@@@AAC@@A@A@AC@A@@FE@@BG@@AAA@@A@@B@FE@@@C@@CCD@BE@IF@@@@@@DBB@DB@BB@@@B@B@FBB@@@@@BA@@B@LB@B@@A@@HF@@BBB@B@DGH@BQA@D@@A@@A@@A@A@@@@C@A@@A@A@C@@@A@ADA@@@C@A@A@A@A@AACAA@@@AB@B@@@BA@@@E@C@IB@@DDBD@B@@@B@@@B@@ABA@ABC@CBIBA@A@A@A@C@@@@@AB@B@@@B@@C@@D@DF@@BC@@FD@BBB@AFA@@BA@@DA@BJ@@KAABAA@AKEAAG@E@A@@@@@C@ABCD@@@B@@BB@@@B@BB@@B@@CFPDBAHFBCDB@BDB@@ABA@EH@BSG@COCAD@@@FABAD@@A@A@A@CA@@C@@@ABABB@@BBFBFB@DHB@@DF@@@BAAA@@BABBBAB@AT@@E@E@@@@GC@AAB@@CGA@AE@A@@@@@@AE@A@@@BA@@@AB@@AG@@A@@A@@BABCBA@A@@@A@A@AA@@@CA@@@AEG@@D@@@F@@A@AB@@@BA@@FA@@@A@K@CD@@ABC@@@A@@DA@@FC@@AE@@AE@DR@@BXBJ@FD@@DC@BFBL@@@D@@@B@@@B@B@BBB@D@FBAD@D@B@HAJCD@@@BAB@@@B@B@B@@@B@@BBB@H@B@@@D@D@B@BBD@@BBB@B@DB@@BB@@BB@B@D@B@FBB@FBD@D@BBBDJ@D@DBB@BBDBDBDBDBDBBFHDDB@@@HDB@BBBB@@DD@BBB@BBB@@@BDF@BBB@BB@B@B@BAB@@A@@BADAB@@@BB@@BBB@N@HAF@LAB@F@L@D@B@B@@BB@@BB@@B@@CD@B@B@B@B@B@BBBBDHFDDD@B@B@B@BCBABABAD@B@B@@@B@BBBBBDBF@@@@BBB@BAD@B@B@B@B@@BB@@D@BAB@@AD@D@BBB@BBBFBFFDBDBBBBBB@F@DBDA@@JAB@B@@@@@BBDFDB@BB@B@D@B@B@F@FBJBBBDBFBLBFDB@BB@@@AB@@A@@@A@C@@@C@EC@@A@@@@@CB@@AB@@CB@@CE@@C@@JA@AX@@FB@@GBIJ@@AA@C@@@G@@CC@@@A@@AA@@AA@@E@@C@C@@@@AC@@AB@@@@IB@@A@@D@@CAB@A@@BAB@@A@AB@@@D@AG@C@A@EA@@EA@@@@GAC@@AC@A@A@@BA@A@ABC@AF@@CD@@AB@@ABA@@@@@@FB@AFB@@@C@AF@@AF@@AB@@@H@@AC@@@D@@G@EGB@GCB@C@@C@@@@@@@@@@A@A@@BAAEB@B@AEA@GC@BC@@@C@@@@BC@C@@@C@@AEC@@@A@A@ABC@A@A@@BA@C@@DA@A@A@E@AB@@AHB@ED@@@BE@@B@BC@@@@DB@@B@F@@A@E@CE@@A@ED@@CB@@C@@@@@C@A@@D@B@BDL@@BL@BCA@AEBICABE@A@@AA@BA@@CCA@A@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@CB@@BB@@G@@@@@@@@AA@@@QCA@@@GF@@AM@@@@EA@@BE@@E@@A@@@E@@@@A@@CA@@C@@C@ABA@CD@@CD@@AC@@AACB@B@@AA@@@B@@A@@@@B@@AB@@E@A@@@AC@@CB@@AC@@GB@@AB@@AB@@A@A@A@@@A@@@AA@@AH@@@@AAA@E@@@AA@@CEACEABAA@AM@@CB@@@BAA@GEA@@@AAQ@C@@BA@@AC@@AGB@@@AA@BABA@@E@DCAGA@@AA@A@@DA@@CK@B@A@@@A@@BG@C@@CA@ADA@@CE@AASAAA@D@BA@"],encodeOffsets:[[116952,34253]]}},{type:"Feature",id:"411121",properties:{name:"舞阳县",cp:[113.609286,33.437876],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@BEA@EBEBEBA@A@A@A@CAGA@BA@@@AB@A@@@@@A@@AA@@@ABA@@A@@A@AA@@CA@@B@BAA@A@@AB@AAA@BAAAACA@@@AADA@@@A@@@A@@BA@@@E@@FA@@E@@AFAA@@@@@DA@@CA@A@@D@@@CA@@DA@A@A@@@@AA@@@A@BBA@@AE@@@@@C@AD@@@@@DA@@AE@@@@CA@@BA@@CA@@@A@@CC@@DA@@D@@@BAA@B@B@@A@@@@@@@@D@BA@@A@DC@@DA@AC@F@@A@@D@BC@BEA@@B@B@@@@@BK@@@A@@AK@E@@C@@@AB@@B@@@C@@@AA@@EA@@A@A@@@A@@@AA@@@@AA@A@A@A@@D@@@BE@@DA@@D@@@A@AA@@BCA@B@DA@@BA@A@@CA@@@AA@BC@A@@@@@@CA@@D@@@BD@@@A@@BE@@AAB@DA@CBC@A@@@@@@@@B@BA@@FB@@D@@C@A@@AC@BBCBAC@@A@AB@GA@@B@@@AC@@B@@@AA@@CC@@@@@A@CBAB@@@DA@@@E@A@@CA@@@C@@@@@A@@@A@@B@BA@@B@@@BA@@@E@@D@@@BE@@BD@@BJ@@DA@@@A@@F@@@D@BB@BBH@@@DBD@@@BBBB@@@DAD@B@B@@BB@@@B@DBDADAF@D@B@B@BB@@BBBDB@BBB@FB@BFBBBD@B@B@BHLBBBBDDDD@@@@A@EBA@@@ACA@@@A@@@@@@B@B@D@@@B@@@@G@BHA@BF@BA@@@DBBA@AB@@@B@FEB@F@@BD@@@B@@AF@@BN@@@@@BF@@@D@@D@@AB@@HHA@CNA@AD@BB@BB@B@B@B@BABABCHGB@B@@@B@B@BBB@@@@D@@@@@DA@@DA@@F@@AD@@@@A@BHADB@B@@@@@FB@@@D@@@DB@@D@@DB@FA@@DB@@D@B@@@F@@@@@B@@@BB@@H@@@BB@@BB@@@B@@B@@@DB@@B@D@@ABB@@@B@@DB@@BF@BA@BBB@AB@@BF@@@@BB@@BE@@@C@@B@BC@@@@BA@@B@@B@AB@BB@@AD@@BD@@AC@@ABBB@@CH@@B@BC@@B@@B@@DB@@@D@@D@@D@@D@@@@@B@@@@BB@D@@@BI@A@A@A@A@@@A@@B@@ABB@AB@@@B@@@@@BABB@@B@@D@@BC@ABD@@@@FC@@@B@@DD@@BCA@BABHBLDFB@EB@@FHB@@@AB@@A@@B@B@@@BBBDBB@@@@BA@@@DTX@@@@@@B@@@F@HA@@@BB@@BJ@F@@@@@B@@DB@@@F@B@@B@B@B@BB@@@@BB@@@@B@@@B@B@@B@@BD@D@B@@@BA@@DAF@BA@B@B@@B@@BBD@@@B@@@@@B@BB@@@D@@@@@D@@F@B@@B@C@CD@@@@@@A@@@ACABABCA@BA@@@@DA@@@@B@@ADB@@F@@E@E@@@@FCBA@BF@BA@M@@@@B@@BDC@@DA@@DC@@DC@AB@@M@@B@@@@C@@F@@B@@@A@@@BBA@@@ABB@@@A@@FB@ABD@@@C@@BA@AB@@@B@@@@A@@B@@@B@@A@AB@@BDA@@B@F@F@@@BA@@FE@A@@@BD@@@DB@@@@@@BB@@A@A@BB@ABBBDA@B@BAB@@B@@@A@@BD@@@A@@BBA@FA@@BBB@DB@@B@B@@ABAF@@A@A@@@A@AB@BA@@@AB@@B@@@D@@@CBBBA@@BHA@@BBABBBDA@ABDFCDAD@NADAB@B@@@BB@@D@B@B@B@@@@@@@@AEGGEAA@@AA@@AAAC@C@A@A@A@AB@@@B@@@B@HDFDBB@BB@@BBAD@F@DAD@B@BAFADADCBA@@D@BBB@DD@@BBBB@D@D@BAF@@@BBBB@B@B@@@@@@@FKBC@@B@@@H@@B@@B@B@@@B@D@@@@B@B@@B@@B@@B@@BBB@@DAF@D@FA@EB@@AJ@F@D@B@@@@BDA@BFAB@BA@A@A@C@@J@JBD@B@@AB@B@@@BABA@BB@B@@B@BB@@DA@@DB@@DBJ@@BB@@@FA@E@ABLB@DBDFAB@D@@@D@@@B@@@@@@A@@AED@@C@EB@@EB@H@@A@@@A@@@AD@BBDA@B@@@@CB@@@@D@B@@AB@D@BABA@@@B@B@@@DD@@@@B@DB@@B@B@DBF@@@@B@@B@@B@AB@FF@@@@@@@B@J@@@@@@BB@@B@@@@FA@AF@@EH@@AC@@AFA@C@A@@@@FA@A@E@AACA@@AACBA@CFA@EA@@@@CG@@C@@E@@@BAAA@@DA@A@A@A@CB@D@@ADA@DB@B@@@@AB@AADAAABAAGDABAAC@AB@@E@@B@@A@@FA@BD@B@AAFA@AD@HABDDCB@AAFC@@BA@CD@AC@@@AB@@@@@DA@AB@BC@AACA@@A@@D@AA@@@E@@B@AKC@@A@AB@@@AGB@@AD@FAAAHE@ADB@@B@B@@@DAB@@@JGBABCJO@@DABA@@B@B@@@BB@@BDBF@BBD@D@@BB@@BBB@@@DBB@B@@AFCB@B@B@B@D@D@BBRD@C@I@@@CH@@IA@C@E@@AC@@CA@@A@@@EB@@EDA@AB@@E@@D@@@@ED@@ABEA@C@AK@@@A@A@@@@A@AA@@@A@AA@EECA@@@@@@DCBCBA@A@ABAFED@@@@@@AC@@AA@@@BA@@@@H@BGH@@ED@@DB@AC@AB@@CB@@@CA@BE@@EC@@AA@@@A@@@@AC@@C@CA@BA@A@EB@@@@A@@@@AAAAA@@C@C@@ME@@GACAECC@@A@@@CAAAA@ACAC@CAAACAAAA@C@M@C@C@EBA@UD@EE@@@@C@C@@A@AACA@C@@@CAM@@AB@GG@@A@EA@@CF@@C@@G@@CB@B@@AB@@CD@@C@AE@@AH@@E@EB@@A@@@IH@AK@@L@FA@EA@@CAEB@@@@A@GDA@C@@EBE@G@C@@FC@@B@@K@@AQB@AG@@AA@EAG@A@G@UBC@@AC@AAM@@A@@EB@AI@A@@BG@@BC@@BA@C@@AA@@A@@A@@AC@@AC@@C@AA@AA@AA@ACB@@CA@@I@@IBEBI@@D@@@CC@@BC@@@AAEB@AD@@AC@AAF@@@C@ACD@@@A@AKA@A@A@@@A@@B@@@B@B@B@BABAD@B@@BB@B@@@B@@C@@B@AA@@AA@@B@@@B@@A@E@@@@@ABECA@A@@BCB@@A@A@A@A@A@ABA@AB@@C@C@@@AA@@@AA@@E@@A@@@CBA@CBABABA@@@@D@@A@@@@@@@B@@BBBF@@DC@@A@@E@@C@@A@@BAA@@@B@CC@C@@BA@@AC@@@BG@@A@@@@@@B@@E@AA@B@BA@@C@@AA@@@EB@@C@AABA@@B@@A@@A@@@@@@A@@@CA@@@B@@@B@@@@A@@CA@@@C@@AA@AA@@@BB@@BC@@@C@"],encodeOffsets:[[116343,34216]]}},{type:"Feature",id:"411122",properties:{name:"临颍县",cp:[113.931261,33.828042],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@@C@@@@AA@@@@B@@CB@@AA@@@@CAG@GAABC@@@@@A@@@AA@@A@@@@@@BABBDA@@@@@A@@@A@A@@A@@A@@B@B@B@@@BAA@@@@@A@AA@E@@DB@B@B@@@@BC@@@ABAB@@C@A@@C@A@@@BEBAB@D@@@B@@@@A@A@@A@B@@A@@BB@DBD@@@@BBB@AB@@@@B@@AB@@A@@AA@A@A@@@@@BB@BA@@B@@AC@@@@A@AD@@@@DB@@@B@@CD@@A@@AA@@DA@@BA@A@@B@@@AAAEG@@A@@@@B@BB@AB@@B@@@B@@@@@CF@BD@@@@@@@@B@@A@C@A@@BAB@BA@@@@AA@@AA@ADC@@@@@A@@@A@@BA@@AC@@BB@@B@@C@@@@BA@@AC@A@@CACE@AB@@CBAA@@BCCA@@BA@@CA@BAAC@EAAA@C@@E@C@A@EAA@AA@@AAA@AD@B@B@@C@AD@@@B@F@DA@@BCBGFAB@@@B@D@B@D@@ABA@CBABKFCB@@A@@IE@@@@AEAC@ACA@AA@CC@A@CB@CC@@I@AB@@E@@BAAC@A@@AA@@@A@AAAAC@A@AA@@@CA@@@@@AAA@A@BA@ABC@C@@@@BCB@BA@A@A@C@A@C@EBA@AA@@A@AB@DABC@AB@@A@@@C@@@C@A@@EG@@AA@@C@@C@@@BCC@AA@F@@A@BEO@@@@H@@A@@B@@AA@@A@@@AD@B@@E@@B@D@@@@IB@@B@@@@B@@C@@BKAAD@BC@C@A@@@A@@B@@@BA@@D@BA@@B@DA@@D@@@@BB@@BB@BB@BB@@@@@F@B@@FB@@@D@@D@@@AHB@L@@@@H@D@@FA@@DF@@@B@DA@@D@@@D@BA@A@AFAB@@AHC@CBA@BBB@@BB@BA@@@@B@@@@@B@@@BB@@@BB@@BB@B@BDB@@B@BABAB@@F@@D@@B@@D@D@BDH@@F@@BC@@DBB@D@DB@@BA@@B@@A@ADA@@A@FE@@B@BC@@@G@@BD@@BA@C@@@CBA@@@A@CB@@@@A@@B@@C@C@C@A@EH@@@BEFABBBBD@B@B@@@@ABAB@@A@A@GB@@@@BB@@@@@@DB@B@B@D@B@@@B@D@@@@BC@@F@B@@BD@@@B@B@ABB@B@@@BBB@@@AB@BA@@AA@@B@B@@@@@@@@@B@@@B@@F@@@@D@@D@@DE@@B@@B@J@B@@B@@H@@A@AH@N@D@DB@@@C@@@AJB@@@DA@C@@HD@L@ADH@@FE@ABCB@@@B@BA@AB@DD@B@F@B@FBB@B@B@BAHG@@BBB@FBBBBAB@D@BAB@@ABB@@B@BD@@@BB@BA@@BA@AB@@@B@@@DB@@B@@@BCBB@@@@@@@FA@BD@@@@D@D@@@B@ACBAB@@@A@@CAA@A@@DAF@FAD@BAB@DAFAF@D@NCB@B@DBB@@@@@B@BABAB@@@B@FB@@B@@@@@BDDABF@B@DBBB@@BD@@B@H@@@F@F@@@@@FC@@B@FBNAB@@D@JDJBB@ABITAFE@A@@HD@@BADE@ADD@@@@BELDB@B@BB@B@AJADD@HA@AH@@A@ABBBBB@BDB@BAD@@@D@@@JBD@@@@@@@AA@@B@@@B@@@@A@A@@@A@@B@AADAD@B@B@D@L@@@@B@@CDB@@@@BD@@@@B@@@BB@BADBB@@BB@F@B@@ABA@A@AB@D@@AF@@AD@@LB@BABB@@CDB@BB@@@B@HB@@BB@@@D@@AF@@FB@B@@@@B@@@DB@@@F@@@@F@@@B@@@@B@@B@@D@@@BB@@D@@BD@@@D@B@@@FD@@@@@B@B@BB@B@ABFB@CDBABDB@B@@D@BB@D@BABB@B@B@D@@@@CF@FBJ@@EB@@@@FB@BAB@@ABA@ADA@@@@@E@AA@@@B@B@@@D@@BF@@AFB@A@@B@FB@@@BBDC@@@BBD@B@B@@DB@B@B@B@B@@B@@J@@AB@@C@@@@B@ABB@@B@@@@DBH@B@@B@B@B@@@A@AF@F@@AB@@@@BF@B@@B@@@@D@@@Z@@C@@H@@DB@@CB@@@BADB@BBAB@@ABAB@FO@CDE@@@ABA@A@@E@A@@@@CB@@A@C@@@@@@@EA@BC@@@CB@@@FC@@@ADA@DHC@BBB@@D@@BDB@AF@@BB@DBBEB@@AD@@CB@@CD@@A@@@@@@@@B@@A@@AA@@B@@C@@A@@A@A@@@CD@@AD@@CA@BA@@@A@D@@H@@BD@@AHB@@@@D@@@D@@@F@@A@AB@@A@@B@@@BA@AIAAIG@@A@@HABCDAALF@@DPBBC@ABAHB@C@@@@@C@@D@BC@A@@@A@@D@@CDB@AA@@A@A@AA@@C@AC@@AB@@@@A@@CB@@@EB@@IBB@@@CE@AA@C@@L@@@F@@A@AA@@C@@@A@C@ABA@@@@B@@AB@@A@@DB@@BA@A@@B@BGA@@A@AO@@@@@@@A@@@A@@AA@@AA@AC@@@AAAA@@A@@E@A@@@AAAC@@@A@@ACBC@A@@BAB@@C@AB@DBD@B@B@@AB@B@BCB@@@B@ABD@@C@@D@@A@@AA@@FABABCDCDC@@BAB@FABA@ADCDEDGBE@@B@B@@B@@@@@B@@@@@@D@@@BA@@@A@@BA@@@@@@AA@AD@@@@AB@@A@@@@B@@AA@A@@AB@B@BI@A@A@@A@B@A@@A@C@@A@BA@@AAC@EAB@BGBA@@@C@@B@@A@@U@@B@DA@C@A@BD@@E@A@@CA@@GA@A@AGC@@C@@@A@A@A@CB@@A@AB@@AB@@AD@@A@@B@@GA@AK@@B@@C@@B@@CAC@@@CA@@AD@@ABC@@@AC@A@A@M@A@C@C@@@@@A@@B@@A@E@A@AC@ACB@A@B@DSAI@@@A@C@A@A@@A@G@AB@@EF@@BD@@AB@@OD@BIDBBE@@A@ACA@@A@@@A@A@@@A@C@@@A@A@@[@@QA@@CB@@C@@E@@@I@@@A@C@@@C@@@@D@B@F@@@@A@AAA@C@@@@C@@@A@@@@A@A@@A@A@@@@AAABA@AAE@@@@FA@@DEA@F@@@@@FCB@@@@A@@BA@@EC@@A@AB@@@A@@@@AE@BCC@BAA@EA@B@BA@MA@@E@@FC@@D@@ABBBD@@BC@@BC@@@@BBBADF@@BB@@D@@A@@AC@@@GB@@@CB@D@@@@A@@@@A@A@CBC@A@AAG@@@@B@BG@@CG@@@@@C@@@@@I@@BA@@A@@CA@@@EA@@@@AA@@BA@@A@@@AC@@@@BAA@@@CGAEA@@@CA@@CA@@@@@A@@B@@@@A@@@A@@BABA@A@@@@@BA@@@AAA@@A@@B@@C@@@@@@D@@@@A@@@@@@C@@A@@@AB@@A@@@AA@@@@A@A@@B@@A@@@@@@@BA@@A@@@AAA@@@A@@A@@@AD@@A@@@@A@@@AB@A@@BA@@@AA@A@@BE@A@CAA@ABEB"],["@@C@EB@@A@@BB@D@@B@@H@@A@C@@A@@@"],["@@B@@@@AE@@AA@@B@@@BC@@@@@@BB@B@F@@@@A@@"]],encodeOffsets:[[[116696,34527]],[[116557,34704]],[[116550,34700]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("新乡",{type:"FeatureCollection",features:[{type:"Feature",id:"410702",properties:{name:"红旗区",cp:[113.875245,35.30385],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAACA@AAC@AACC@@EDBBABEECDCACF@@B@@D@@AB@@BBOHME@@GCEBA@EADEA@@@EJ@@KBAB@BABA@ADA@ABEJ@B@@ELCHABC@GAAF@@ENB@@LBBAFEL@J@F@B@B@D@B@D@BE@C@@@W@@J@@@B@D@D@@@F@H@F@D@D@B@@@F@@@B@@@BBB@@@D@@@B@D@D@@B@@CHCB@@@B@@@B@DBB@BADAD@@@FAB@BDB@D@FAB@@@B@@@BBB@DCB@D@B@BB@B@BB@B@B@DB@DBDB@@A@ADA@@@AD@VA@G@@N@@@@BH@@D@@D@@BB@@CDA@A@BB@DAD@L@@GB@BBB@BFFABD@@HA@@B@@BD@@BB@@@BBA@@BBDBHBB@@BBDBBBBBB@BHF@B@H@@BB@F@BBBABFB@BDFAFH@BB@AB@@BB@ADBLC@@DN@@D@B@BBB@DDFA@BHA@@BDD@DF@@BC@CFHF@HG@B@@DA@A@@@AB@AABC@ACG@AA@AA@@C@EAB@CIA@B@ACB@HABE@A@@DELAA@@IBB@@@@@A@@B@@A@@BA@@H@@@DA@B@@DA@@@@DAAA@@AAB@RGB@FB@EB@BB@ABB@@D@@@D@@@BB@@DAHBJCDBABDFD@@AD@@i@EMCQEAE@AA@@AA@@AA@B@AACA@@@FA@AC@BAB@@A@@BOCE@CBA@CGCBAA@@@@IBA@CEKFAABAAABAAAA@@@A@@AA@BB@@EE@@@@C@AAB@@ACBA@@BEHAC@@A@@BBDA@BDA@ACA@BD@BCCA@@@CBBBA@@@AB@BCBA@CB@CA@CBIBA@@A@@@BA@ID@CID@@@AAB@AA@ACEBCB@D@@AA@A@@A@@C@EB@AMB@@CFD@AAEB@AE@A@@FAAE@@@@A@@CC@@E@@A@@CA@@@@@@A@CAB@G@CA@@I@@CBACE@CB@A@ABABBD@@AH@@A@A@@@BE@@AFA@@BA@AF@@CE@ACC@GCBAACAAB@A@@AA@@@@@C@@@A@CBA@A@AAAA"],encodeOffsets:[[116703,36054]]}},{type:"Feature",id:"410703",properties:{name:"卫滨区",cp:[113.865663,35.301992],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AE@@AABEC@AC@A@GAABGA@@AD@@C@A@CA@@KEA@CH@ACAE@A@ADA@AAABFB@@C@@BB@@DA@@FABAB@@@@C@A@@@@D@DCDABDJDFG@CCCHHJ@JO@EA@DA@A@G@GABDK@@DKA@DI@@@@BC@@BA@@@@FA@@B@JA@@F@@A@@B@FC@@DC@@BA@@F@@A@@LA@@F@@K@@BF@@DF@@BDA@DEAIHE@@@LB@B@FB@@JD@@FDA@AB@@B@@@BB@BHABB@@ABABA@CBAB@B@D@@@@B@B@@@D@BB@DAF@D@B@BBDA@@B@B@B@BBB@FBB@BA@ADAAEACBCB@@@FAFAD@DBB@F@H@JF@@D@FAF@@@DEDBFDB@@@B@BCDAB@BABBB@B@D@@A@C@C@A@@@C@@AA@A@@@A@@@E@@@A@C@C@E@G@E@@@C@C@A@@@IX@@@D@F@@A@C@A@C@A@A@E@IFKBEAA@KA@FM@@BEA@B@A@C@@A@@@EE@"],encodeOffsets:[[116622,36076]]}},{type:"Feature",id:"410704",properties:{name:"凤泉区",cp:[113.915184,35.383978],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@@@@@Q@@BC@@B@FE@@HC@@@G@@BE@@C@CA@@@A@@BAB@B@B@B@B@@F@B@@H@DF@@@@@@BA@ABA@@B@@B@B@@B@@B@@@@D@@LBB@AD@BAB@@@@@@@@@@@@@@BD@@@@@@B@BB@@@@@@AB@@@@D@B@B@B@B@DA@BB@BBB@@@B@@@@B@B@@C@BF@BB@@@AD@B@@@B@@B@@@@BD@@EF@@CJ@@A@@BD@B@D@@PC@BJAH@BBBA@A@@BAB@@@@BB@@@BAD@B@B@BARA@DXAF@AED@B@B@B@FAAEVABARABAB@@@@BB@@B@@@@@@D@@@@@@BD@@@@@@@B@@@@@B@@@B@@@@@BB@B@@@@@@@B@@B@@@@BB@@@B@@BBB@@@B@@@D@@@BBBBB@DB@@@@BBBBB@@@@BD@@BB@@B@BD@@@@B@B@B@BAA@@@@@@AB@B@@AD@AAB@@@D@@@@@B@@@@DBBABA@@@@B@@@@@@@B@@@B@@@@@@@BB@@@@@@B@@B@@@@@@B@B@@AB@DBF@BBB@DBD@B@@BD@BDF@@@B@@FA@@B@@@B@@@@@@A@A@@@A@@@@B@@B@@@@@BB@@@@AB@B@@@@A@@@@B@@@@B@@@@D@BA@@@@B@DA@B@@B@@@@A@@@AB@@@@A@@B@B@@B@@D@DABB@@@@A@@@@B@@B@@D@BBDB@@BB@B@@@BB@@@B@@A@@@@@@BBB@@@@B@@@@A@@@@@@@BB@DBBBB@F@@@@B@@@@GFAD@B@@@B@@@@@@@B@@@@D@@@@@CB@@@@@@D@@AB@@@@@@@@B@@@@B@@@B@@A@@B@@@A@@B@@A@@B@@@@B@@@@B@@B@@B@@B@@@B@@AB@@@@@BAB@@@B@B@@@@@BA@@BBB@B@@BBAB@@ACBACB@D@BA@@@@@A@@DA@@BAFAB@@ABBD@@@B@@AD@@@@A@A@@BAFA@A@@@C@@@@BA@@@@C@@@AA@A@CA@@@@B@@A@@@C@@@@@@A@@AA@@@A@@@@@@BAB@B@@@@@A@@B@@@@@B@B@@@@B@@@AC@@@@F@BAA@@AAAB@@@@@@@@@@@@BB@@@@@B@@@@@AA@@@@@@@@BB@@CKD@@@@@A@@@@AA@B@@@B@@@@@@A@A@@@@BAB@@@@A@A@@@A@A@@@C@@A@@AB@@AB@AA@AB@BD@A@@B@@ABB@@@@B@@A@A@@@CL@@@@@@AFA@FBB@A@@@A@A@@@AB@@C@@AAB@CAD@ACDADC@@@AAACACCA@@AAA@@AA@@@AA@C@@@AAA@ABECECA@EC@@EAAA@DC@CBICAG@@CABHCACA@GEA@@@HEAKEGA@AOIA@EGA@ADE@@@@@IBAEAACABAA@@@AAAAG@@ID@@@E@M@@AA@DHABE@E@ABKLC@CBC@I@E@IBC@A@G@E@@@@E@MBE@EA@AAC@@C@@AA@@G@A@MAAGABA@I@@@A@I@Q@@@KAG@@AACCBC@ABAB@@GHBB@BF@BD@@@F@@CB@@@@C@@DGBBDIB@BGB@@EB@@EB@@C@@@A@@@@@@@BA@@@@@@@A@@@@@A@@@@@@@@@@@A@@AB@A@@@@B@@@@AA@@@@@A@"],encodeOffsets:[[116485,36204]]}},{type:"Feature",id:"410711",properties:{name:"牧野区",cp:[113.908772,35.315039],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@B@LAFCEEFADA@@@@AEABAAE@A@@AG@A@E@AGA@AAAACAAA@@AAAGAC@AB@AA@@A@@AC@@AA@@@GB@@ACEBAEA@AAA@@HK@C@CBA@@A@BCB@DA@@AC@@@@CG@@A@@M@@@@HUBC@@B@@CB@B@BA@AC@CCAA@A@A@@A@AAAA@C@A@CDA@AA@@A@@@A@EBC@A@ACA@EB@@C@CBABA@CAA@@@A@@@A@GD@DA@@@@BC@A@A@AAABA@CBADA@@@A@ECCACF@@E@EBC@@@IEG@E@A@CAC@EBEB@@A@ADBDBFCB@BABA@EAA@AAA@A@A@@@CBAAA@C@E@CBA@@A@C@@@A@A@@C@A@A@AB@DABAB@BA@A@@BC@@@A@C@A@A@@BE@@@BDBHHA@HE@BD@BH@LB@@R@J@B@@@J@B@BABHNBB@H@@@BB@@@DD@BBB@@FAF@N@F@@F@H@B@D@JAF@J@D@DAD@LKBAF@F@BACGB@@BN@F@@@C@@JH@BBBB@@B@ABDBBBBFJA@@@@F@BCB@FHB@PJ@BHBLFFB@G@@FB@HDBDBAGDB@@BHJDDAD@@CBBFB@@FDB@FDFDBA@@A@@AFAAC@CHAB@AAD@DGBCB@@C@@BBJB@@FAB@BAAC@@@@HAAAB@B@AG@@F@@A@@EMC@@AA@@AE@@@@@@@D@@@B@DAH@DCB@@AB@LEECACGECC"],encodeOffsets:[[116722,36184]]}},{type:"Feature",id:"410721",properties:{name:"新乡县",cp:[113.805205,35.190836],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAG@AAA@@@BABBHB@@FE@@H@FA@@FA@BH@L@@@J@@@@@BA@@@@B@@@@AB@AE@A@@@C@ABU@ADA@@@@@CE@@K@@ACA@@A@@DA@A@@AI@AB@@@@@D@B@@@FABBBBB@BA@EDABAB@@E@A@@FDB@HFD@@BBA@A@@BAD@@CFA@@D@DA@@@ADB@ABDBAB@@D@@F@BBB@BB@A@@D@B@BEA@BCAAB@@@BB@@B@@ABB@ADBBA@BB@@B@@FBB@A@@@BD@@D@@@@@B@@B@AB@@@@A@A@@@AB@@@DCA@AA@A@A@@BABCAAD@BAAA@@DDB@B@B@@ADFB@BADB@D@@C@@BDBEBBAF@@B@@@B@B@@@AFA@@D@@ABA@@@C@@BADE@@@AA@@@B@D@DBB@B@@B@@@@BABBBB@@BF@@AFA@D@FB@B@@@@DD@@B@@@DB@@@@B@B@@A@@F@@D@@BCAADB@@B@@@@C@@@AFABB@@@A@@B@@@@@BCH@BC@@BB@@@F@@BB@FBB@BF@@@BHB@B@F@B@D@@AB@DBB@DD@D@@B@B@B@@@@E@E@@@@B@@A@@BA@AAE@@@@HBJ@@C@CA@B@@@F@@A@A@MA@@@F@@@@GA@B@B@D@DF@@BH@@D@DADB@DCHB@F@@B@HBA@@@ABABA@JB@BDB@BFB@@A@@FA@@BA@@@@B@B@@A@@@@@@DA@@B@B@@@D@@@DF@@B@@@BBFC@@A@@@A@@@@@DG@@B@@A@EDABA@A@CAA@@@A@@D@B@@C@C@E@A@C@BHCA@BAH@D@@E@AH@FA@A@C@@HAB@@ABA@@BFD@DBB@B@BBD@F@@B@BB@@B@BD@@FB@@@D@D@@@AHABDB@@@@@D@@DF@@DB@@DBD@B@@BN@@B@@@B@@D@DF@@AH@@@D@@GF@@E@AD@@AR@@@@@@@@@@@B@@@@@B@@B@@A@@@@@@BBA@@@B@@@@@@@@@@@B@@@@@B@@@@@@AB@@@@@@B@@@D@@@FA@@FA@@HA@AJAACHA@CD@@@@@DA@@@E@@ACE@@AAAHG@@BABAD@DAF@@GGBAGAC@@F@@AB@B@D@B@@@D@@AB@BAAGA@@A@@@AA@@BCB@EC@@IA@@E@AKA@@F@JGFB@CCB@AE@@CE@@AL@@@@EB@@KB@@@@EB@@AD@@CD@@E@AB@@@@EB@@I@AB@@E@@B@@AD@@A@@J@@CLB@CL@ACHBH@B@B@@CFBP@@IGIDGDDH@CECIBADC@C@C@@B@D@@@@@BABA@EB@@CA@@AD@@@EABABBB@BCB@F@DB@BDGB@LF@@DBB@D@@@BC@@HBBAHBB@D@@BFDBA@BF@@BB@F@@F@@@BD@B@A@B@HBD@BADGFK@@@AFIBAB@BCB@BA@ABALA@@FI@@B@CFFBB@FAHD@@NFPGAA@@BA@@@CA@@@DEDBDCFFBAAAFC@@DDBBD@BBB@BDBBBBBBB@B@DAB@@@D@@@@@B@@BB@A@BBBDABHDD@BDF@@DE@@BAB@@EB@BF@@A@@@B@BG@@BC@AAAB@B@BDAF@BDDA@@@JB@@D@HBA@D@B@@@@B@@DB@@@@FD@@DB@@@@@BFEB@@@BBFA@BF@BEC@DA@BNA@@F@DB@@@@BBB@@@CDAFABDB@@BBA@B@@JC@DJCB@@A@@@BB@JADAB@@DDAB@DA@ABA@@B@AADA@@B@DD@AACB@BDB@ACB@AC@AB@@@BDFG@AB@DA@BA@BBD@@@@@FF@@AAB@@BB@CEDACEB@B@@BB@AA@@B@@@BFB@AC@@BD@@@A@@BD@@AEB@BF@A@C@@@AB@@AB@BDFCBBB@@@B@A@BA@@@A@@@ADABB@@B@BB@@@@@@BBDAAAHC@@CCBACCBABDBAAGA@@@A@ABBB@@AAA@A@@A@@B@@A@@@A@@@AB@BACC@@B@AA@@A@B@AB@A@AAAABA@@A@AA@@B@@AA@@@AAA@@A@AA@@@@A@@A@@@AAA@@CC@@GB@AAAA@AA@@@@AA@AA@@@CCA@@A@@@@B@AAA@AA@@@A@@B@@@FA@AEDAAAAAAB@@AAAAAA@@@@@@BB@GDA@CDCAABCAEA@@@@CAAA@@A@ABAAB@@@@A@@@@@A@@@@@@EE@@@@C@@B@@FFBD@B@@AB@@CGABA@CA@A@@@@ACA@C@BE@@AEAA@@@@@AA@@@A@@A@@@@B@@@@CCB@AD@BB@@AC@@@@@A@@A@ABACDAAABAB@B@@ACBAAA@ECBADDB@B@B@AAB@AAB@ECABGE@@CCCBA@BA@@EB@ADA@A@@@@@@A@@@@@AA@@@BACA@@DAAAA@@@@A@A@@@ABCA@BDBCF@@@@@@@B@@A@@@BBABAAA@B@AB@@ABA@BC@@AB@AA@A@BBA@@B@@AAGDAAABDB@@CA@@BB@@EAABA@@@B@AB@@@@ABAABAEEDGD@@A@@@AB@@@@@@@A@@A@@@A@@AA@@@@@ABA@@FC@@AA@@@@@@BAJAB@BCA@@A@@@@ADA@CA@B@@A@A@C@A@CBE@AAA@@DCCC@A@@@@@A@@F@HA@@@@@EA@@@B@@C@@@@D@@@@A@@@@CA@C@@DEA@@@@AC@@A@@@@@C@@@A@@A@@G@AA@A@@@@A@A@A@A@@@DCC@CMI@@@B@@CA@@E@@@IB@@@@@@A@@@@@@@@@A@@C@@@@A@@@@D@@@@A@@@@@@E@@ADB@AC@@@D@@AC@@CC@@AC@@CAAA@AABAA@@AIEA@@@A@AB@AABAAABBBEFAAA@CAA@ACAA@ACCB@@@AAB@ACBAA@BACABAFFHC@@BAFD@@B@@AB@BDBA@@GGBAIC@@A@ECEAA@@@C@AACCA@AD@ACBAAA@B@AB@@ABACCD@AAB@@AA@BACDCAAAAAAA@ACACAC@@BAAADA@@AEAE@@ABBBA@@BC@ACA@AACBAA@BCAC@@BCBBBA@@B@@CC@@@BACCBCEABACACPBBAF@@C@@@AH@@C@@@A@@@ACAACBAAA@@@@A@QDBFCB@@CB@B@BAA@DBD@@E@@COBI@@@@B@@A@A@@@@@@B@@@@@@KCD@@@B@@EA@AE@@@CA@@CB@AEAA@CA@@EC@@CK@@@@@CCG@"],encodeOffsets:[[116525,35908]]}},{type:"Feature",id:"410724",properties:{name:"获嘉县",cp:[113.657433,35.259808],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ABB@@@@@BEAC@@@KAA@@@ADADAFCHAFCDAACFCFAD@@@DCHB@AH@B@@BBADC@@BDBABCPAFLBAFC@@D@@@BFB@@C@BDB@@BD@@BE@@@@@@@@@ABB@AH@FA@OCEAC@AAUC@@@CBA@@O@@DMA@@AHB@@BC@A@@F@@B@@D@@@BB@@BD@@BCA@BD@@HA@AB@BA@@@EA@B@@@C@@@@AA@DA@@@A@@BM@BCA@CA@BC@@@C@@@C@@@@D@FA@@FC@@@E@@BC@@BC@@BCAAFAHD@@FC@ABHB@@@B@B@B@BF@@@ADC@@BA@@DB@@BD@@BA@@HC@ADC@BEA@AFB@@@A@@BE@ABEA@@@@AAA@CJA@@@AB@@@FH@ABB@AHB@@BB@CT@@@HAFHB@FFBAJDBB@BBF@NBBCBBDBBBBBBB@AB@@AF@@@BJ@D@@E@AFC@ALEAAHA@ANB@@@AJ@@K@G@@AA@@A@EA@@@@@@DAB@@@@B@BB@@@B@@@B@@AAA@@BC@A@@BA@@@@@@BA@BCA@@DA@BCA@@BA@BAA@@B@@@AA@@B@B@@C@@DD@@DA@@@A@@AB@@AE@@@BB@ABBADBDB@@BB@@CB@AHB@@DD@BBAB@@@D@@@@B@@FB@A@B@@D@BC@@A@@@B@@ADB@@B@@@@@B@@@DFBADB@AFD@BBB@@@BAB@B@B@DBD@J@J@D@D@D@F@D@B@H@DBB@B@BBB@@BB@@@B@BAB@B@@FZ@@@@@@C@A@ABABAB@B@@@BAB@B@@@@@@@B@B@B@B@B@@BB@@@FB@@B@BBB@@@BBB@BBB@@B@@DD@BJFBB@BBB@@BBBD@@BBHDDDB@DB@@DBBD@@BBB@B@@@@BBB@@B@@@BAB@B@HFBBDBJBFBFB@CH@@F@@HBD@@@@AB@@@B@BA@ABAB@@@D@D@B@B@@B@D@@J@@@@B@BH@@BF@@DB@@BD@@BB@@BB@@@@@@B@B@@@@B@FAB@@BB@@B@DB@@@@@@B@B@@@@B@B@@BD@@@E@@@@B@BF@@@@BBB@@@@@A@A@BB@BBD@BABBB@D@@@@@@@@BB@D@HA@C@C@@EA@@ACA@@@AAA@@@@EAC@A@AAA@CEC@AB@BA@@BA@GD@B@B@@EBGF@@@@CBG@ADBAGD@B@F@D@D@@@@A@CB@@@B@DBB@B@BAFCB@@@@AH@@C@@@@@B@@@BD@AE@A@@@AE@@C@@@C@@@A@AB@@C@@@@B@@@@A@A@@B@@AB@@EB@@@EA@ACA@AIAB@BABA@@B@GAA@@@@EGACDA@BC@C@CG@@AE@@C@C@A@AHB@@@@@E@@NBB@B@@@@E@@@ADBD@@@AI@G@@F@BBB@@AB@@@@A@@F@F@@@@@@A@A@AC@C@@CAA@CBA@@@C@A@E@AGA@A@@AEA@EAA@@AE@@@A@@AD@@ADG@A@@@@@AB@@@A@BABE@@D@@@@@@AA@BCDB@AC@@@@EB@@@@A@A@@A@@C@@@AC@@C@@A@A@@E@CEB@BE@@AA@AABA@A@@A@@@@AAA@C@C@A@@BB@@F@BC@AD@@@B@BA@@@CB@BE@@A@A@@@A@@@BEAAAFAC@@@DC@A@BC@AEABC@@@A@ACA@CB@BB@ABCDBBA@AB@B@B@@BDB@C@@BA@@B@B@@@@@BAA@@@@A@@@@@CC@@A@@@BAA@EA@@@AAB@AABCA@BA@@@AA@@A@@BADB@AFB@A@A@CB@A@@AAA@A@EC@@@BACABAA@BC@@B@@C@CB@DE@@BC@AB@B@AA@@EC@GCA@EB@F@@@BABAFCB@@AAAAABA@E@@@A@C@@@@BA@@CE@BA@G@@@@CCBG@@@BC@A@A@@A@@ABC@AA@@AA@EAC@C@@@AB@A@@@AA@@D@A@D@DA@@@@A@@@@@@@A@@@A@AC@@@@A@C@AA@@@@CBABB@@B@BC@AA@BCB@B@@AFB@AC@B@FABE@C@A@AA@@@A@@A@@@@A@@@@C@A@@@BA@ABAB@AC@@@A@AA@@@A@@AAC@@CBAGA@AIA@@AF@B@B@@D@EHD@CJFBAB@BA@@B@@ABA@@AA@@A@@CAC@CAE@@@A@C@@C@A@CB@B@BEIA@CH@@@BE@AEA@@GAA@@@AH@@C@ADA@ADC@CHBBB@DBBIB@AJBBAHCLCA@BC@ADCABC@@B@@CAABA@@BCICBCFB@AGAA@@B@B@@A@@@@B@@AA@@A@BD@B@@@BA@@AAA@B@@@BB@ADFBBBB@FBCHAA@BGCADGAABCAFKAABA@@AA@@B@@ACA@@@@@@ICEFGABABCAAA@AA@@DEC@CCA@@@FI@@CADCA@DCBCBA@AAAA@A@BABABBFC@@@AKD@EA@@AE@@BE@ADA@AFB@AFCAADCFIAETCA@J@@AFB@@BCA@BD@@@D@"],encodeOffsets:[[116359,35905]]}},{type:"Feature",id:"410725",properties:{name:"原阳县",cp:[113.940046,35.065587],childNum:1},geometry:{type:"Polygon",coordinates:["@@EBM@KBA@E@CBC@C@C@GBEBKBE@A@EBODE@C@GBCBG@QFGBA@ABCBA@CDIHABCBABC@CBMDC@IBE@C@C@C@CBA@GDC@A@C@Q@MBE@I@C@ABCBCBEDA@ABCBA@C@A@G@IBC@GBIDC@A@A@GFQLCFBBJVN\\@@FABD@@DJI@G@G@@BF@B@D@B@L@BHB@@@B@@BB@@DB@@BD@@BB@@D@@BHD@@CB@@D@@@F@@HBBC@@@BD@B@@@@B@@B@@@@BDB@AF@@DB@B@JB@AJB@CB@@@B@@@B@@B@@CBANGDABAB@@GJA@B@@@B@D@@@BAB@@@@F@@B@B@@DLBBBBBB@CJBB@@@BHBAHB@@@F@@BB@@FLC@B@@EDAAABABB@B@BB@BABADCDB@CDDB@@EJ@@B@DDD@CF@@BBB@BBADABHBFEJD@@@@@@DB@BA@@@BB@@ABBBELDBBAHBBCHD@ABBDGEAA@AAEABCA@@A@@@ABB@BB@@A@@@AACB@@@BB@@@A@@B@@@@A@AB@HB@BEAADJDAD@@ABBB@DA@@@ADDBBCD@@ADBDKBGAABIA@AJCAA@AADGD@BCB@BCD@@@BG@@B@HB@@FB@BAF@@G@@DJBAFA@A@@D@B@DD@B@@@F@DBD@DB@@@BB@@BB@BA@@@AB@@ABAEADIC@FGC@@@@A@ABE@@JB@BHBAB@DD@BB@@@B@@BBB@@@D@@BBABAB@@A@@@B@D@@B@@@@@@BB@@@B@@B@B@DAFEBA@D@@BEA@BA@A@ADB@@BADA@@@AAAB@D@@B@@B@D@B@@D@@B@B@@@B@@@@@@@B@@B@@C@C@B@CB@@B@@@BBA@@D@D@FBB@@BB@@BAD@BB@@@@B@BAD@@H@DA@D@@H@B@@ADF@@J@@BB@B@@CB@@@DB@BL@@@DF@@@@B@BCV@BAD@@@B@F@@BBA@@@@@A@@B@@A@@@@@I@@@KAGB@@EB@@E@GF@@EA@AGBA@A@@BB@BBHBBH@DD@@@@L@@DD@@FB@@DBBBFA@@DB@@D@@BFB@@FA@@@C@LD@@@@@@@A@@@@B@B@@@@A@@J@PA@DF@@@AC@CBB@A@ADA@@DAAERCB@@@@@BBABBDDB@B@@@B@@@DG@@B@@@DE@ABOABDBDBADFDABD@A@@DD@@@AB@AADA@AD@DB@ABBDABBB@BDD@@AB@AABA@@BFBF@@CBBBAB@@BDBDBDB@BBBBBBCDBD@ABB@@BA@BDCBDBA@@BAA@B@BBDA@BBCB@DDBBD@@@B@FBFDB@@@JDABHH@@ABACA@@BA@@@ECAB@@GDEEABDBABB@ABBDA@BB@@A@DD@BBBBDB@DBB@BBFEAABABBBA@BBAB@@@B@JF@BB@ABBBB@BB@DD@@BD@@DD@@BC@@@D@@BCA@BF@@@@@@@@B@@C@@@@@@B@@D@@@@B@@@@@@@@@B@@@@A@@J@@@FB@@DA@@@J@DND@CD@@B@B@B@B@@@@@@BBBH@@@@BB@@@D@@@@@B@@@BD@@@@FB@CD@B@@D@@B@@@@@@C@@D@@@@A@@FB@@@@B@@G@EB@@@@@B@D@DD@CB@BBF@DAB@D@B@B@@@@ADBB@BC@@@@@BB@ADA@IBAB@@@@@@BB@@ED@@AB@B@@@@BB@@@B@@@BB@@@@@@@A@@B@@@BC@CHFFABBBBA@@@@BAA@@@B@BAFB@@AA@@DB@@CABABBHCBB@@@AB@AAB@B@@BBA@@ADB@BA@@BAA@B@BBBAAA@@B@@@@A@@@@@@DECA@ADBBA@@B@B@@@@@BBBB@CB@BD@A@@BB@@@@B@@@@@@@@BCB@BFA@@ABB@DADD@@HFBAFDBAAC@@DB@@@@FGB@@@FABA@@AA@@@C@@BBBB@BDB@@CC@@B@BB@@BDB@@AAA@ACAAAB@B@@B@@BACCABAABAAAA@ACB@@A@@AC@ABD@@@B@@@BDAAC@AA@AA@@@@AA@C@@@BBD@@@C@A@@BFB@AC@A@A@@D@@@BBDA@@JCBFFA@@B@D@@AACD@@@FLJ@B@@@@@BDBFBBB@@B@@HB@@@@FB@B@BNEBBDAACGDACFADABBB@@AA@@@B@AAB@DA@@DA@@@ABA@@B@A@@CD@AAB@HAF@BAB@FABHBABH@@FABDDA@BB@@BBAB@@BB@B@@@@@BBB@AA@A@@@@@ABB@D@@@@B@AEAA@@BA@DB@BAB@CEFAAA@CACB@@A@@BA@BFAB@BA@B@@B@FA@FB@@A@@BA@@@BD@BBBAB@@@DAA@HA@BB@@@@BBAB@@@B@AG@@AAB@HAF@D@@@D@B@@A@@A@@@CA@@@A@@DA@A@AACB@@ABA@@B@@@BA@@B@AABA@AD@FA@CB@@@@@@A@@@@BB@@B@@@@@@B@@@BBA@@@@BA@BFAJADA@A@@@@@@B@B@@@@@@@@A@@@AB@@@B@@@B@F@HAB@ACB@@AD@HD@ADBB@BBBAD@@A@@DA@AB@A@B@DA@@C@@CB@@AF@@@B@@@AA@@B@D@@BDAB@F@@BD@@CFBBA@@F@D@@@DABDB@@C@@DH@@DBBD@@@@@@HA@@AAB@@@BB@@HA@@@A@@@@@AFA@@D@@B@@FA@FB@@BD@@C@@@@B@@BF@B@@AB@@AB@@B@@B@@@@@@A@@AAB@@B@@@AB@@@@@@AF@@@@ACB@AD@@A@AAAA@@@@@D@AAD@@@@@@A@@AAB@@@HAD@@B@B@BD@AEB@BDA@BBJAA@FA@CDA@@@A@C@GA@A@D@@C@AAE@@DCBABD@@BCBCBBB@BDB@@@@AAAN@@@@@@@@C@@AAA@@C@@AC@@@DA@@CA@A@@@A@@ACBAEA@AA@A@@ACD@@AD@AK@@B@ACC@@@AG@EA@AA@@@@@@GBAA@@@EA@@@A@@@@@@@A@A@@@C@A@@@@BA@AA@@BAAABC@@@A@@@A@@@@@@A@@@@@@A@@B@@@@CAABCA@BA@@B@@A@@@@B@AAB@C@@@@C@@CAA@@A@A@A@A@A@A@C@E@C@A@@@AB@@@B@@@@A@@@@@A@@@@@A@@B@@ABG@ABG@A@ABA@AAABCAA@G@@@@BA@@AGAG@AAE@A@CCKAE@CAAAG@AB@@@AG@C@CAC@@BAB@F@@A@A@ED@@A@EAAEBIB@@@ANCF@AA@C@@@@@CAC@EAAFABACCDAACAAHAAAAC@CAAB@FA@@@AGI@AAAA@@S@CC@ABABCDABABAFA@EBEBC@GBC@C@C@CBCBCBA@CB@@C@A@IBA@GDC@@@CBOJGDABCBCBCBGDEDKFIDEDA@EBC@@B@@KFGBEBEDIBQHEBEBA@C@E@E@MAA@@@IAG@M@IAU@O@I@@@E@Q@IAC@EAG@@AKAKCMEGCECKIGEACCAGIGIIEKKEAAAEEMG@AGEKICAAAAAAAEAEACAAAQAE@C@G@E@KAOAMAGBE@A@A@IBI@YBI@CBSBIBQ@GBQBEBE@C@A@CBMBC@"],encodeOffsets:[[116532,35727]]}},{type:"Feature",id:"410726",properties:{name:"延津县",cp:[114.20509,35.141889],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@B@A@@BCB@@@BC@ABAAA@CA@BGCC@@BA@BDA@GBE@A@@@A@@@A@@B@@@B@@@@@@A@A@@@@@@@@BCBIBEB@AAB@@@@AB@A@@@A@@@@A@@@AA@@@@@B@@@@A@@DEBC@@BABBBA@@@AB@@A@@@AB@BA@BD@B@BCB@@@B@@DB@@B@@@@BA@C@@@C@E@GBA@BB@@BHA@@@A@AB@A@@A@@AGBB@CB@@A@ABAAC@@A@@AB@@@BA@@EEBA@@@@AABA@EB@AAB@@@BA@BD@DBBEBDFA@ABA@@CAB@@BBBFA@@@@@@CAA@B@@@@@BBBA@AA@@@@A@A@@AA@AB@AA@@ACBACEB@@AGABAGEBA@ABE@GBA@BBC@@DB@A@@@AB@B@@CB@@CBA@BBA@@@B@@BA@AACBEBBDHCBDCBAAMF@A@AEA@@@@GA@@@AA@AAAEAC@@@@A@I@EK@@C@BD@BC@A@@@EBAEID@@CBAA@@C@@@@B@BBDA@AE@@@B@D@@AC@A@@@DBB@@@@BBB@@BBDCB@A@@@A@@AC@BBD@@@BA@BDB@BBABBBBADDAB@@@AA@A@BBDB@BBB@BA@AC@@AAA@@@DD@@CA@AAAAA@@@D@@BB@@ABEB@@A@EH@@@@CA@@BDABA@BBA@BBA@A@A@CCABFDB@BBDA@BA@A@ABBBCBBDBAB@@@@B@@@@BD@@AAC@@BDA@D@@A@@@@@@BB@@@B@@B@@@@BBBF@@AFD@B@BD@@@@@BDBB@BADH@@BA@@@AACEE@@@AD@@@@@FF@@@@@@@B@@@@@B@@A@BBBAB@@@BBDB@@@@FBDBBADBDCB@HCA@@A@@@@B@BBBB@BA@BBBBBBFC@BEB@@A@@@@B@@BBB@BBA@@@@@@BB@DD@@B@@BBB@@@@BBB@BB@BHA@@DD@@BB@B@@@BB@@@@@BBB@@@BB@B@@BB@@@AB@@B@BB@BABB@B@BBAA@B@@@BBA@@@DDABA@@B@@@B@@@BA@@@@BB@B@BB@@AABAB@@@B@BHABACABDDABDD@@GDBBCBAA@@@@@@AAA@@@AACB@B@@@B@@ABB@A@@@A@AAEDACA@@BA@@B@@@D@BAEA@BF@@AC@@@B@@AC@@BDA@AE@@A@@@BBA@@AA@A@DFCBDF@@B@BBABBBABBBLEDFB@JA@@@@BBDADHB@DAF@PD@AB@@@BA@ABDB@@E@@DBBBA@B@@BB@@BB@@BBFRFND@F@jC@@BC@CEBACAIDGACB@@AA@@C@@@C@@@AA@BAAA@@FEAA@QHA@BB@@BBCB@@@@CB@@@ACB@@G@@@AB@@@BA@@@@B@@@@AA@JB@KBCF@@@BAFGBA@BDA@B@DJA@FBD@@@BBB@@BDHBBBEHA@@BFB@@@BF@@@B@@BBBFBAB@B@DDB@B@BBB@@@B@@@@@@@@A@@@@BB@B@@B@DABB@BBB@@B@@@@@@C@@HABA@@@A@A@A@@AC@@@A@@B@HCFFB@@@DFA@@@BBBBBH@B@@AD@B@@@@@@HAD@@AB@@A@A@A@@@@@A@@DB@@@@BEB@B@FAD@@@@@D@BB@BBDBD@D@D@B@@@B@@@@@@@B@D@B@D@B@AD@JA@@@B@BL@@@@@B@@@@DFA@@BB@F@@BBB@@@@@B@FAB@DABAB@HAHADADAHANCFCFAFCFABAZAFBRBD@B@@@BBDBRFFDDBB@@D@B@BDBB@DBD@BBB@DAD@@BB@B@D@D@JB@@FBBBDBB@BDBBB@BBB@B@@@@BBBB@BBB@B@@BBBJ@BB@BB@BB@@@B@B@B@@FAB@F@@B@@B@BBA@B@@B@@@B@@B@@DBB@AL@@FBBBB@B@@D@DBBBDBDB@@B@@@BB@@B@@B@BD@BBB@@B@@FBH@D@B@B@B@D@BBD@@B@@D@B@@@@@BJ@BDN@@@BB@@BBB@B@B@D@@@B@@AD@B@B@J@@AB@FABB@FDRJBB@@BB@BA@@B@B@@BF@B@@BB@@BB@@@BB@HBD@@BB@@D@@@@D@@@@@BBB@@@D@@@@@BBB@B@BB@A@@B@B@@@@A@C@@BAH@B@BC@A@@@@B@BB@@B@F@D@@@B@BBBFB@B@@FBB@BBB@@@B@@@DB@B@@@@AB@@BB@@BABB@@@BBD@D@B@B@@@BA@@D@@A@@AA@A@@HAFABA@@@@BBBB@B@A@@@AB@BCBAB@@@@B@B@@B@BAB@@BB@BDB@@@B@B@BBDA@@DAAAF@@@@@@@@A@@B@DAA@BAA@DAA@CG@@B@@AA@HC@@@@@@@AB@@@@@AC@@A@A@@AMB@C@AA@@E@@@@@@@@@@A@@AB@@E@@A@AEAA@C@@A@@CA@@@AEC@ACCB@AA@@AC@AC@@AEB@@C@@@AA@AA@@@AA@@A@AB@@EA@@C@@@@B@@@B@@C@@B@@@@@BAA@@AB@@@B@B@@A@@@AB@@BB@@AB@@AB@B@@@@@D@@CACB@@AB@@@@CA@@C@@@BD@@A@@A@@@@C@@AC@A@@@A@A@@@ABEA@E@@CB@@A@A@A@@TA@AB@@@@C@A@@@@C@@A@@@@@@A@@A@C@@BC@@A@A@@@AC@@@@@BA@@A@@@DA@BAA@E@ACA@@CA@@A@@@@@AA@@@CBA@@A@A@@@A@@@A@AAACC@@@A@@@@@E@@@C@@B@FAB@B@@AB@D@@@B@@A@@B@PA@@@G@@B@AE@@@CB@@E@EB@@DF@@AF@@@H@@CH@@@AC@@@AAA@@G@ACMB@AA@@@@@AK@@@@@ACB@@@@@BA@ACE@@BD@@DA@@@C@@@@@E@AC@A@@@@CBCB@@EBB@A@CB@@AE@@@AD@@E@@@CFA@@C@@AD@@AH@B@@ELAB@@A@@@@@ACIAGB@@ABAA@B@@@A@A@AAA@A@@@ACA@@AA@AC@AE@@@@DEBACC@BDA@C@AAABCEEBAG@@F@BCD@@A@@@AD@@CFAF@CMB@F@@E@@D@@@@C@@@A@AC@@AC@ACA@A@@A@AC@@AC@C@AC@@A@@@@AB@EEA@@@@@@E@@@@B@@@B@@E@@@@D@@C@@@A@CB@@I@@@A@ANBBAB@@AD@F@@E@AA@@DC@ACA@@AGB@AH@@AC@@@C@@@@@AIE@AEA@E@AE@@B@BA@@@ADA@C@@@@@CA@@AB@BAD@@@H@@A@A@C@@AC@@@@EBEBCB@@AIACCI@@@A@A@@AGE@@A@@@@@@AGBA@@AC@AB@BA@@@A@@@@@AF@BA@@AG@CAA@@ABA@AECK@@C@AG@@CBG@A@A@@D@@A@CB@@@B@B@B@B@@CBA@@@@BBB@B@@CBGBA@A@@A@@AA@@C@A@CAA@AAAAAAEB@@@@@BDL@B@@C@@BA@@@BB@@CBEBBD@@A@CBGBKDA@@@AC@@GBA@ABC@GB@@@A@ACEACAA@CAC@CAEE@IB@@AA@@C@AE@@@A@CC@@AC@@@@A@ABA@AE@@A@@A@AA@A@AA@AG@@@AA@AAA@CACAA@@@C@@A@@@AA@AA@AACACAC@A@@BA@@AA@AACAC@@AA@AAGA@AA@@@@AACKA@ACCB@@@EE@CQ@@IBAAB@ACA@BFC@@A@A@AC@GB@@A@BB@@@B@@@@C@BBC@@@@@B@BB@B@BC@@BDA@B@@E@@B@@@@A@@B@@@AA@BB@@@B@@@@A@@@@AA@@BA@@BA@E@@AA@@@@@@DC@@AA@@EEB@@@AC@@@EB@B@@@@@B@@GB@@AA@@A@BB@@GB@@@@@@ACCA@@CG@@@DA@ACCB@@C@E@@@ABEA@DC@@AE@A@CB@AC@A@@@BB@@A@@@E@@BA@@DD@@@CB"],encodeOffsets:[[116919,35961]]}},{type:"Feature",id:"410727",properties:{name:"封丘县",cp:[114.418882,35.041198],childNum:1},geometry:{type:"Polygon",coordinates:["@@Q@G@C@AAK@E@MCAACACAECGAKCB@E@GBCB@D@TB@BB@BHJ@B@@EBA@BB@DBDBBGBBBBDCBDDABEBBB@FBD@D@@@@@DBBE@MD@B@@JAFABB@F@BC@@F@B@BE@A@AB@@BD@D@DBH@@A@@BBHBB@DBFDL@D@BBF@BBHBH@@AB@@@@@HBBADBB@BAB@B@BAH@BAH@BA@@@@B@@@@@B@@@@@B@@A@@@A@@B@@@B@D@F@D@B@B@B@B@B@BB@DB@@@D@@D@A@BBA@@@@@@BA@@@ABB@ADBB@D@@A@@@@B@@@@B@@@@@@@@B@@@B@@ADBBAB@@BBB@@A@@B@D@@@B@B@@@@@@@B@@@B@@F@@BBHA@@@@@@BBB@@FBH@@D@BDA@@@BLC@@BC@BD@@@BBBB@BFDA@BB@@@B@B@@DB@@C@@BD@@@DB@BB@@@D@@@@@@M@BB@B@@A@ACA@AAADAD@@ACABCD@@BF@B@DC@B@B@@H@D@B@@CB@DEBB@@@DRF@@F@@DABDB@DLBB@@@@BBB@BH@BBB@@BDBD@BBB@@AB@@@BBDBDBD@BBBB@@B@@@BD@@@B@DBDBB@BBB@@B@@BHB@@B@BBBB@@@@BF@@BAB@B@B@@D@@BD@@D@B@@BFD@@@BB@@JAF@BF@DBD@DBBBDDF@B@B@@HAD@BAB@HA@@BD@@B@LCHADAB@@@ACFADA@@AA@@B@@AD@@@@ACK@A@@@@FABBBBBBB@DBB@D@@@BB@@@BB@B@HADA@@@AAA@A@@B@DA@@@A@A@A@A@@DAB@@@@CB@B@H@DA@@BHD@@@DLBFB@BA@@BB@DBH@@ABE@@B@@@@@B@@ABA@@BBD@@ABBH@@@@@@@BF@BH@@@B@B@@DJBDBJ@@DAFAFA@@@@BD@@@D@B@BG@@@C@ABA@@BB@@D@@B@@BB@@@@@BDB@@FD@@BB@@@B@B@H@D@@BB@@B@BF@@DD@@@B@@BB@@A@AD@@A@@@A@CH@@@@AH@@CD@@C@@@@@AD@@@B@@AB@@@@AB@@@@EACB@@CC@@A@@ACFA@@B@@AB@BBF@@AD@@FD@@A@@BBB@@DB@@DB@@BB@BBB@@BF@@@L@@@@BD@@BHAF@@EB@@BB@B@D@@@D@@ADB@A@AB@H@@AF@@C@@@AB@@A@B@CDD@GB@@@@AB@@A@AB@@@AE@A@AB@D@@AB@@AA@A@@@@A@@B@@CAA@A@@BA@@@@@A@AD@AE@A@@@@@@AE@A@@@@@@@A@@@AA@@@@A@@B@AA@ADA@@@A@A@@FA@@B@B@D@D@H@DBB@BBFBFB@ABAD@@@BAB@@@@F@BBBB@BDB@BBB@B@B@J@D@FA@@@@@C@@@@@@@A@A@@@A@@@@BA@@F@@@B@@@D@@@@@B@B@@D@@@@FCB@B@B@BA@@@A@AFA@@B@@@BABA@@FCB@@ABDB@AA@A@@A@BCB@BACE@@AA@@CE@@DA@BDA@@B@DA@@BA@@@E@C@A@ADABAD@B@@AB@@AHB@@BALABABA@@DAB@B@@B@@FA@@BDBABBD@BAB@@@DAF@D@FAB@B@@@@B@@B@B@@@@A@@@@BA@@@@@C@@@@FA@@@@BA@@@@@A@A@@LCFA@@B@BADA@@@@BBFD@@DCDCDEDCAC@@DA@@@A@@@@IE@AHG@@AA@@AAAA@A@@@@B@BABAB@@@BA@@@A@@DABA@@B@@A@@BB@@@@BA@@AA@@@A@@BAFADCB@B@@@@AA@@@@@@@@@@A@@@@B@BA@@BA@@AA@@@A@A@A@A@@B@DA@@@AAABA@@@A@ADA@@@A@@@@@A@@@A@@@@B@B@@@@@AC@A@@@AB@DAHBB@B@@ABAD@DA@BDA@@@@ACDA@@DDF@@AAAA@BADA@A@@AA@@@@BADADCDAFC@AD@DAB@@A@@ACB@BDB@AC@@BABDBA@@@@AC@@AA@@@@@@BAB@@@AC@@@@@AAAAA@@BA@@B@AAB@@@@@@@B@@AF@BB@@BABB@@@A@@@A@@D@@@BADA@@@@DA@@D@AE@AB@@@@@@A@@BFB@@@@A@C@@A@@@B@B@@AA@@@BAB@B@@@CID@B@B@@@BABBB@@A@@@@B@B@@@@@@ED@@A@@B@BB@@BB@@BFB@@A@@D@@@D@@AA@@@@@AA@@B@@AB@B@@@@AD@DA@@@@B@D@DACAEEC@@AA@CB@@@@C@@@A@@@@A@@@@DAA@B@@@B@FCB@@B@ABDDDBBBAB@@@CCAAAA@@BA@@BAB@BAB@B@JADAFAB@FAB@@ABAJCF@FACCECQMCA@@CEEEIIA@CE@AGKEICE@C@A@ABADCPKHCDABADCHCBADAFEHEBADC@AB@@@@C@A@AAECIACAAEEEGCCCAIECAA@A@CAC@A@CBE@IDA@MDIDEBIDABA@@@EBIDIDA@A@ABC@C@I@AAAACACAICGA@@GAE@E@E@KAC@A@E@@@C@C@EBA@C@EBC@GBIBK@A@CBC@GBC@GBA@GBCBA@A@A@EAA@AA@@G@A@C@CBA@ABA@OHA@IFEBA@A@A@A@CACACAEAEAAACAAAEAAAGCCACACAC@G@E@C@C@E@GBA@C@ABCBKH@@ABIAI@A@I@EBA@A@IBE@KBE@ABG@A@@@EBKDA@A@ABCBA@QJIFA@GFGDEDABEDEDA@ABA@ABCBEBGBCBA@A@A@C@CAECC@CAAAA@AA@@AAAE@AGGIMCCCEECAAECCACCC@CACAEAA@OB@@C@SBE@EBG@C@C@C@G@G@CA"],encodeOffsets:[[117048,35743]]}},{type:"Feature",id:"410728",properties:{name:"长垣县",cp:[114.668936,35.201548],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@@@DD@BDBF@D@@A@BFFA@DB@@DD@@DB@@B@B@B@@BA@BJA@@@B@BD@B@@BDA@B@@@BF@@BD@@AD@@@BDB@@DF@@@D@@@@CB@@CB@D@@BDA@@D@@@B@AB@DB@@FD@@AB@@BD@@BB@@DHA@D@B@@H@@DB@@@@HA@@BA@@@A@@B@BD@@BH@@H@@A@@@@BB@AB@@A@A@@BB@@@@@A@@@B@BBD@@@B@@@BBF@@B@BBA@DB@@FB@@BB@@BBD@FBFVA@@@DB@@@DA@@DDF@@@B@@@D@@@@@J@@@@B@@@DD@B@@FF@D@@HD@DA@@B@HBBBBFDB@BLDJBBB@A@@NDB@@@@CB@@C@@D@@@D@@DD@BFF@@@@@FA@@DAFA@@AA@AH@@@@@F@@A@@J@JA@AD@@CB@F@F@@@@@@BHABDBABBBBF@DL@BG@BF@@HA@BDA@B@@@@B@BA@@F@@BB@@AB@@BJB@@@DD@@BCBBB@BB@@FB@@BBBCBBF@B@@@D@@A@@@AB@BB@@BA@@B@B@@@DBDA@@D@D@B@@@BA@@DB@BJB@@D@@DAAAPA@@@A@@B@@@@BBA@BB@@B@@@CB@@@BBB@@D@@@B@DDA@@@B@@BBPBD@B@PAF@D@@AD@@A@C@@D@@AB@@AD@@AD@@@@@B@@AB@@A@@@@D@@B@@@AF@@AB@@B@@J@@B@B@@F@@HD@@AD@DA@BB@@BHAD@@@DDFA@@LA@@@BF@@BBABDD@@AH@B@@D@@D@@@D@@@@FD@@D@BA@@J@BA@BD@@BBD@@@@@DA@@@@@A@@@BB@@AB@@AD@@@B@@@@@B@@@@@@@@BB@@@@@@@BB@@@@B@@@@@BB@@@@@BFA@BB@@@BD@B@@@BBD@@FDB@@@BA@@BFB@DDB@@@BFBABBHABDB@@BB@B@B@@B@B@@DH@@BB@ABBD@BB@@BBBBB@@@@@BAD@@BA@@BF@F@@@HADDB@@@@@BADBB@B@D@@A@@B@BA@@@B@B@@AB@@B@@@F@@@AB@@F@@@D@@@B@@@@@@@@ABA@@F@@@@AHA@@BDC@BBLA@@F@@A@AD@@AD@@@@@JAB@FA@@@@DAB@@@@BJABB@@DA@@B@BABBB@NAD@@AH@@AH@@A@AD@ACABAACKAAFA@@BA@BB@AANC@BB@B@@AFA@BB@AC@@BFB@@@AEB@@CAADC@C@A@@BBB@ACB@AC@@D@AAAAJD@@@@@AJAAA@@DA@@BAD@@BDAB@AID@@C@@BAFA@AF@@AB@@EBCB@@B@BB@@@B@@@B@@@@@BCF@@BBB@@@@REN@@@AYACA@A@A@C@A@C@A@AA@@@@AAA@AAAAA@@ACAAA@ACCA@AAAACCAAAA@@AA@ACA@ACCA@AAAA@AAA@@@AAA@@EGA@@AAAA@@CCAAA@@AAAA@@EG@@AAACAA@@@AAAAC@@@CAA@AAC@AAAAA@CAAE@WEMC@@EC@@AG@ABM@@@K@I@GBKBQ@I@@@G@AAAAACAA@M@U@MAWCMAOCA@KEA@AAGCCAKGIIAAGIACCE@@CEAG@ABC@CJEBARKBAHEJIDCJGFGDEFEFK@A@M@GACKKMGQIMAQEA@QCGECEEIAA@@ACAECGACAAAA@ABABABCDADALCFADAF@B@N@H@B@@@BABCBA@A@C@A@AACACCCA@AACEIC@AGCICECGACA@@UAG@KCECCAECEEA@EC@AAACCIIEBE@IDAB@BA@EBA@EBCBIBA@A@ABA@AB@@AB@@BBBBDD@@A@ABAACCAC@B@AA@EDA@@@A@B@CB@@@@@B@@B@@@D@@@@@DAB@@BD@FFDBCBC@A@@@@@CBC@@B@@A@A@@BA@@@BB@@@@B@@BC@@@C@@@@BA@AE@@AA@@AAA@@@@BC@@F@@@@A@A@@@@@@BA@AAAB@@A@A@C@DJ@@A@A@AB@@B@@BA@A@@@B@@@@D@B@@A@AE@@@B@@@@A@@BBFC@@@CB@@@@CBAB@@C@@@@B@@@B@@AAAB@@AAE@@BA@@@@@@@A@BBA@@@AB@@BBBB@B@@@@BD@@A@AB@@@@@@BB@@BD@@@@ABACAB@@BDA@ACA@BD@@@BA@CBC@@BEDCBCDCBAB@@@@BB@@@BCBABB@BB@BE@CC@@CBBD@@@@CB@ACBC@AB@BA@A@GACBA@@B@@@BBD@@@@A@A@@@@@@B@@@B@@@@@B@@CB@B@B@@ABBB@B@@CBA@@@@B@B@B@B@@BB@@AB@@ABA@@@@@@B@@@@@@@@B@@B@@A@A@CDEBAB@@@B@@BB@@AB@@@@AA@@@BA@@@ABCB@@@B@@AB@@A@ABABA@@@@@@BBBBB@@BB@@GH@BJF@@@@@B@@CB@@BDCDCFCDCD@@ECAA@@@@CBABA@@@EBKD@@@B@B@@@@AB@@@@EB@@@@@D@@@@AB@@@@@B@@A@A@@@@A@@A@A@EBC@E@CB@@A@ABC@AAABAC@@EB@@@AA@A@CB@@ABABKBAB@@GA@BA@@BA@C@ABCB@B@B@D@F@@AB@@CBA@@@CB@ACB@@DF@@BB@@DFABA@ADB@@@@BBBA@AC@BA@ED@@ABAB@@A@@@EB@B@B@@ABA@A@A@ED@@@@@CA@A@@@@@C@@@A@@@E@@@AB@@@@@B@@@B@B@@@@@@@D@@@@EBC@I@A@A@A@AAA@ACA@AA@A@E@@A@AB@@C@AB@BEAEAAAA@CAG@C@C@A@A@@@EB@@@B@B@@CB@BBBA@@@@B@@B@@B@@@B@@@@@@@BBF@@@@@@@BBFC@@B@B@@@@AB@@@BBB@DA@@@@B@@B@B@@BA@@BC@A@@B@BBF@@A@@B@BA@@B@@A@@H"],encodeOffsets:[[117235,36053]]}},{type:"Feature",id:"410781",properties:{name:"卫辉市",cp:[114.064907,35.398494],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCA@A@ABAEAA@@@A@@AE@@A@AE@@GBAFAA@BADBBA@@B@@@BCB@@@AGHE@EG@DAD@@CEC@AC@@GB@AEBCCA@AAA@C@@@CM@@KDCA@BAA@@BAA@@AEGEBACA@@@@@CBEBFFEDKBA@@@@@DDHFBDFDKFA@@BA@CDG@CBA@@@C@@@@@@@F@@BB@@BD@FN@@@BE@@@BHA@A@BBGB@@@@BDABA@EB@@IAAA@@@DA@ADCHC@BBA@GB@DBDEB@BB@@@B@BB@@D@B@@B@@BB@@BB@BB@DDDBBB@B@@CDCBBDC@DBA@BB@@@DA@@B@@@B@B@@@BAA@EEB@B@@@@K@@D@@@B@BA@@@@@AA@BA@@@@BACA@@BBBA@@BA@@BB@@@@D@@@B@B@@@B@B@@A@AB@@@@@B@B@@@@A@@@A@B@@B@@B@@@@@C@DL@@AA@@@@@@@@BB@@@@A@@@@@A@@A@@@@@@@@@@A@BB@BB@ABE@@@@@BD@@A@@@@@@A@A@@@@@AB@@@@@A@A@AB@@@@@@@B@@BB@@@B@@@@D@@@B@@@@A@@B@@D@BBB@@D@@@@@AB@@@@@D@@@BEBAB@@@B@B@@C@@BA@@@C@AA@BA@EBAB@@CB@@@B@@@@ABC@A@BDDA@BA@AB@AA@@@BB@@B@B@@B@@BB@@@B@B@@@BBB@@@A@@@@@@@@@BB@@@@@B@@@@B@B@@B@@B@@@B@@@@B@BD@@A@@@@BA@@@@@@A@B@A@@A@@@@@@@@B@@@@BB@@@@@B@@@@@@@BBD@@@@@@A@@@@@@@BB@@@@BB@@B@@@@B@B@@B@@B@@@@@@@AA@@@@B@B@BBB@@@BB@@@@A@@@A@AB@@@@B@B@B@@@@@@B@@@@A@@@A@A@@@@@@BAB@@@B@@B@@B@DABB@B@@@@B@F@@@@DAB@@CBA@ABA@E@@@ABA@A@AB@@A@A@A@@@@B@@BB@@@@B@B@@B@ABB@B@@@@@BAD@B@@B@B@DB@@@ABA@@@@@@@A@@B@@@@@@AB@@@@B@B@@@@B@@@@@@@B@@@@BBBA@@B@@@@B@B@@B@B@@@B@@DB@@B@@@@@@B@@C@A@@@CA@@@BA@@B@@A@@@@@@B@@AB@@@@B@@@@B@@@@A@IB@D@@@J@D@@@@BD@@@@@DA@@@@D@D@@@@@AABA@AB@B@@@BC@A@@@@BAB@@A@@@@@B@@@@B@@@@@@B@@BA@@@@@A@@A@@@@AB@BBD@BJCBF@@@@B@B@B@B@FB@@BB@@BAB@B@B@@BB@@@@B@@AB@@A@A@AAA@@@@@A@@A@D@@@B@BB@BBD@B@@BB@ABCFA@BB@@@@@@AB@@A@A@@@A@@@AAA@ACA@A@ABABAB@@AA@@AAA@ABA@@B@@A@@BA@BB@@@@B@@A@@@@@@BA@@B@BBDB@D@@@@@B@@BB@BA@@@DB@@B@@@@B@@ABA@ABABAB@BA@A@@@AB@@BB@B@BAF@@ABCBCDABCBABA@A@A@K@A@@AA@A@C@AB@@@@@B@BBBBDBB@@@@AD@B@BA@@@@B@@ABCB@@B@@@@B@@IACBA@A@A@A@@@AD@B@B@@CBABA@CBA@@BA@@@AABB@B@@@B@BB@@@@@@@@@BBA@@@A@@@BBD@@BBB@@ABAB@DA@@@B@BFB@@BA@@B@@BD@@ABAB@@@B@@@B@@@B@B@@B@BDDDDDBB@BBB@B@B@B@B@B@B@@ABADA@ADCB@@ABAB@@G@@@CBA@@@A@A@CBC@ABA@ADA@@B@D@@@BEB@@@B@@A@A@@@A@ABAFBFAB@B@@@@BB@@B@@B@@@@BB@@@B@@B@@@@@A@@@A@@BB@A@A@@B@@@B@@@@@ABBB@@@@@A@@BA@AB@B@BAH@BAB@DBB@BBD@@@BBBBB@@@D@BB@@@@@B@@ABA@@B@BA@BB@B@B@@@@@B@BD@@B@BBB@B@@@B@@AB@@AB@@@BA@AB@B@@A@@BA@AB@B@B@BAB@@@@@BA@AB@@AB@@@B@@@@@BBBF@@BBB@@@BB@@B@@@B@@BBA@@B@@A@A@@D@@@B@B@BBD@@@@@@@@@B@@@@A@@@@@AD@@@@B@BB@BBB@HB@@DB@@BBBB@B@BBB@@ABAD@BAB@@@@BB@@DD@@BB@B@@@BBFBB@@B@B@B@@@DAF@B@BABAB@@AFCB@B@B@B@@@@@@A@@@@@ADA@ABA@@@ABC@@B@BAB@@BB@B@B@BA@@@C@@BAB@@@B@@@B@BBB@BBBB@@DBBBBB@BBBBB@@FDBB@@D@B@@@BDB@B@DB@@B@@BB@@@BBB@@@BBB@DB@ADABADCDCB@@@F@@AB@D@D@DAB@BAF@DAF@HABAB@BA@@@A@ACEAE@A@@@@BAD@DAB@DAB@B@BBB@B@@@BADCFA@@D@B@BABA@ADG@A@A@ABA@A@@BABA@C@AB@BA@A@C@@@A@@AAAEAACGAA@@@AB@BA@ABAB@DABAB@@@BAFA@@BA@A@A@@AC@C@AAAA@AAAAA@@@A@AA@@@C@C@@@C@C@A@@@ADA@A@@@A@@@A@C@A@C@@@ACAACAA@A@C@A@@@AD@D@D@BADALGB@BAAA@ABEACBABGBABABC@A@ABA@ABABABABABADA@@FCFABA@AB@D@B@@ABAB@FCBAHABAB@B@D@F@BALAB@B@BABAB@BADABAB@DAHGB@BADADAB@@@DAB@D@DAB@@@B@@ABA@AB@BA@A@CBADCB@D@B@B@B@B@BBB@B@B@B@BABA@ABA@@BANCD@@@B@@@BB@@B@B@JAB@@@B@@FB@@@@@B@@@B@BBB@REBD@DB@@BB@B@BAH@@AB@@A@ABA@C@@DAB@B@@B@D@@B@B@B@B@BA@AB@@A@AAA@AD@HCDAFAB@FADABAD@@@@@B@@A@C@@@AB@BA@ABA@A@@B@@@B@@BAB@BB@@@BAB@@C@ABA@@B@H@@@@CBA@@AAB@@@B@D@D@@@B@@B@@BBBBDDA@@@@BB@@@HAB@H@B@R@B@B@B@@@@@@A@AAC@A@@E@@AA@AA@@@@@A@@@@BA@@@A@A@@AAA@C@A@CBAAA@@@@AAC@A@@DCBA@@@AA@@AA@A@C@@BA@@@@A@A@@AC@@@A@C@A@A@CAC@A@C@A@@@@@@JCD@HCB@FCB@D@@@H@B@@@B@D@D@B@B@DAB@@ADEBE@CB@@AB@B@@BB@BADCFC@@PAH@B@BA@EBA@@@I@A@ABC@@@A@@@C@A@AAA@AA@@A@@CM@AAI@@@@A@C@@@@AC@AAC@A@A@A@C@G@EA@@@AA@AAC@@A@AA@@@AA@@A@@@CACAAACAC@@@@AAAAA@EK@@BAA@CA@@@@A@@@AA@B@AAA@@@@AE@A@EB@@@A@A@A@@AAA@@AAAI@AA@AA@A@AAA@AA@A@@A@A@AAA@AAACA@CAAAEA@@IAC@C@A@A@@AC@CBA@AAC@CAA@CA@A@A@CA@CAECQECAAA@@A@C@QAEAYBABEBEDEBEDMDGBCBCBGBGBA@ABCBA@EBA@@@@@A@AA@@@EAA@@EB@C@@A@@@@@K@@A@A@@IBC@@B@A@C@A@C@A@@@@@@@A@@@A@C@CACAC@AAAC@@@@@C@EBA@A@AF@@@@CA@@@B@@@@@B@B@BA@@BC@GB@@@@@@@ABC@@@AAGAAAA@@B@CE@@A@EEGDA@@@@B@@BD@@@B@B@B@@ABGB@@@D@@@@A@@@AA@AAACBA@@@@AAA@@@@@B@@@@@@A@@@A@AAA@A@"],encodeOffsets:[[116802,36195]]}},{type:"Feature",id:"410782",properties:{name:"辉县市",cp:[113.805468,35.462312],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@BBBFFLBBBBFDB@DAD@BBD@B@B@B@B@FFJFDBDD@@B@BADABC@@B@B@B@DBD@@BB@@@BABABA@AB@BA@@BA@@DAF@DBHBFBBBBBB@B@D@@@@@BABBBBB@B@BBDB@@B@H@FBDBD@@@DBB@TFFEFCF@X@BBBF@FSR@BDDFLFPCH@D@HDPBBLNTPDBJJFNF@D@DBDBBB@@@B@BAB@B@D@BBBB@@@@BBBBBBH@@BH@B@BB@DBD@F@BBB@BD@@@BAB@@@BAB@B@BABA@@B@B@F@B@DAD@BA@@DABA@@DABAB@DAB@B@@@B@BBB@B@BAB@@@BBB@@B@BBB@BBDBDBB@D@@AB@@A@ABA@C@@@ABA@A@@@ABA@ABAAA@AB@@ABAB@D@B@BBB@DBBA@@@C@E@ABA@A@@BA@A@@DC@@BAD@B@B@DAB@H@B@BAB@H@B@D@J@B@B@B@BBD@D@B@DAB@DAD@HBB@@B@@BDAD@@@B@D@B@B@L@D@H@@@B@B@@AD@B@@CBAB@BAB@@@D@DADDD@@FDAFOR@@ULUFA@@B@@@D@BB@DDDB@@DBBDBB@DBD@@AJABAB@@EJCHELGRANDFHPHJDTBB@B@HAFA@ABABADAF@B@D@B@BA@A@AA@@ECC@A@A@AB@BA@@B@D@@@BBBBBB@DBB@BBB@B@DBB@F@D@F@F@B@@D@BA@ABEBA@@@CBABCDA@C@A@@AC@A@A@@B@FFHADGBCD@HFHF@@@BAB@NAB@BAB@DBB@F@B@DBB@@BB@B@BBBBHDLDLAJKJCD@RHJHDL@@JB@@BB@@D@@@@AB@@@DBB@HBB@@@DAB@DAFCB@@@D@FA@@B@BBB@D@@@B@D@B@BB@@BAF@@@@@@AAABA@@D@B@@@D@@@F@B@@@B@AAAC@@AAA@@@BA@A@CBA@@@@B@BC@@@@@B@BBABA@ABA@@@@F@@@@@BA@@B@BB@@DA@@B@@B@@BBAB@@@@@B@@B@B@B@@@@A@@B@B@@@@@@AB@B@BB@ABA@ABA@@B@@@B@@@B@D@D@B@@@@@@@A@@A@@@@B@B@DBB@@@@ABA@ABA@@A@@@@@@BA@@EB@@ABCBABA@@B@B@@@@@B@@@@@@A@AB@BCBC@@@@AA@A@@BABA@@BABA@@@A@@@@@AAAAACEA@@C@@BA@@BAB@@@FABA@@@A@A@A@ABA@@ACBAB@BCEAA@@A@@A@BEB@@BA@@B@@B@@ABA@@@AJAFAD@@@B@BA@@BAD@D@B@D@@@@A@A@A@@C@@@@A@@D@@A@@@@FABAD@@@@@@A@@@@@A@@@A@@@@B@@CB@B@@AB@BCB@DCBABAD@@A@A@@BA@@BAB@@@B@HD@@BB@@B@@@B@BA@@@AAA@C@AA@CCAACA@A@A@AB@BABA@@AAAC@@AA@A@AAA@@@A@@B@@A@@@@CA@A@@@A@@@AA@@A@@AABAAA@@@AAACAAE@AACAA@A@A@@@AFG@@@@@AA@CAAAEA@@@@CE@@AAA@@@A@C@A@@@@@@@@@@AB@A@@@C@@A@@@@@@@A@@@@@@@@@@@AB@BAB@@A@A@@@ABA@@@@B@FBB@@AA@@AAA@ABA@A@@@@B@BBB@B@@A@@B@@@D@BAB@HBB@BAF@BAB@B@@BDBDB@BB@@@@BD@D@@@B@@AB@@AB@F@B@BBDBBB@@B@DA@@B@BB@@B@DAD@B@DBB@D@B@B@@@F@BB@@B@@@B@@@BBB@D@B@DDD@B@L@B@BAB@B@AC@AAA@CBA@ABG@A@ABAB@@AB@@@@@A@AA@B@@@@@A@@@AB@B@A@@AB@@@B@@@@@A@@@@A@@AA@@@@@AA@@@AA@@@@@ABAAEBEBAB@@@B@B@@@@A@@FA@A@@@C@AB@BCB@BAD@DAB@B@@@B@DA@@H@@@BABA@@DABCB@BCBA@@@A@A@A@A@A@AAA@AAACCCCACA@@@@A@A@@@A@@@A@@BABA@@AC@@@AB@@AA@AEA@@@B@@CBABA@@AA@AC@AA@@B@@@B@AA@@@@@@@@A@@A@A@@@AAABB@@B@@AB@DAB@BADA@@@A@ABC@@B@B@B@B@DAJB@@@A@@A@@@DABA@@@A@@B@@A@ABC@@@@AAACAA@A@A@@@@BAD@B@B@@BB@L@B@B@B@BADABADCDABA@@BE@A@AAA@@BA@@B@B@@ABABABAB@BA@@@A@@A@@@CA@@B@@AAA@@@A@@@@@CCAAAA@@@AB@@@@@@@BA@@@@@AAB@@AB@@@@AB@BAB@BB@@BB@@BABABAB@B@BDB@BB@@B@@@B@B@@@BA@@@@@@AAB@DEBAA@@AA@C@AAA@@A@A@@@C@BB@@@@@B@BBB@B@@@BA@@@A@@A@@AA@A@A@AB@@AA@@EAA@A@A@A@@@@@AEID@AAC@ABA@@@@@BB@@@@@B@@AA@@@@@@@@A@@A@@@@@B@@@BA@A@@B@D@@A@@@ABAB@BA@B@@@@@C@C@@B@@C@@@@AC@@@@@C@I@@@CJAB@@@@@@A@@A@@@@@BA@@@A@@@@B@@@@AB@@A@@DB@@B@D@@@@A@@@@A@@@CA@@@A@@@A@AA@A@@@@@@AB@AA@A@@A@@@@@@@A@@@@@@A@A@@A@@B@@@@A@@@@B@@@@@@AB@B@@CAA@A@@@@ABC@A@@@@@AAA@B@AA@A@@@@@AA@@@A@@B@B@B@@@BAB@B@BA@@F@B@BAB@DA@@BA@C@@E@A@@@@@@AAACBA@@@@AA@@@A@AB@@@@@@@B@B@@@B@@A@@@@@@@@A@A@A@@A@@B@B@@@B@@A@@A@@AA@A@A@A@@B@@B@@@@@@@AA@@@@A@A@@A@@@AA@@@@AA@@@@@@B@@@@@@@AC@A@@@@@@@A@@@@AA@@@@@A@@@@@@B@@@@B@A@B@@@@B@@A@@B@@@ACA@@@@@@A@@@AA@@@@A@A@@A@@@@@A@@A@@@@@@@@@B@@AA@A@@@A@A@@AA@@@AA@A@@@AA@@A@AA@@AB@@@@A@A@@@A@AB@@@@A@@BA@@@A@@@@AA@@@@A@@A@@@@@@AB@@@@AB@@@A@@@@BA@@@A@@@@@@A@@@@@@A@@BC@@@@@@@DA@@@@C@@@@@@A@@@@@@@A@@@ABCHE@@@@@A@@E@A@AACAA@@A@@@@@@@B@@A@@@@@AA@A@@@@B@@@@A@@AA@@A@A@@AA@AC@A@CA@@@@A@@B@@@@@AACBC@@@@AA@A@@@@B@@A@@B@@@B@@A@@@@ACBA@@@@@ABC@@@@@@A@@A@@@@@@B@@A@A@@B@@A@@A@@@@@AA@@@@@@B@@@B@B@@@@A@@@A@@@EB@@@A@@CE@AAC@@@AAC@CAA@AAE@CBA@@@A@A@@@@A@@@@A@@@@A@@A@@@@@@@A@@@A@@@@@@@A@@B@BAAA@C@@A@@@@@C@@@A@BBC@@BA@A@@B@@@@B@ABA@A@A@@@@@ACA@@@AA@@AC@@@@AAAA@A@@A@@CAAAA@A@@@C@@@A@@AA@AA@@@A@@A@@A@@@@A@@@@@@@AAA@@@@A@@@A@@@@@A@@@@@@@C@@A@@@@C@@@@@@@@AA@@A@@A@ABQBABUBBFEBA@A@A@C@BFE@WB@CQBABA@A@C@AB@@A@@A@@A@AB@@@BABAAG@IB@AOD@@@C@AAC@@@BI@@DE@@FC@@A@@A@@@@A@@@ABC@@A@@AAED@@@@A@A@@A@@@A@AAA@@ACBA@A@A@A@C@@@@@BA@@@@@@AAA@@@@@@@AC@@@@@@@@@@@@@@BA@ABCA@KA@@@C@@A@@@@AA@A@@@@AB@BAB@@A@@@@E@@C@GA@E@@@@A@A@A@ABA@AB@A@@@AM@@@AAC@CA@@CE@@CC@@@@@A@ACGB@B@@GBC@A@@A@@@@@@C@A@AAABC@AAA@@A@B@B@@@@AA@A@@E@@A@A@@F@@@C@@AA@A@@@@@@A@A@@@@A@@C@AA@@AA@EBA@@@@@@A@A@@@@A@@AA@@AC@@AA@@CE@@AG@@A@A@@I@@@@C@AA@A@C@C@@@A@AB@BABA@@@A@@B@@C@GA@@@EG@@DEAEAIACAAAGEA@A@AB@@A@@@AA@A@@A@A@AA@@ACCA@@CAA@CCGCAA@@ACAA@@AA@AAAIE@ACC@@@AA@AAA@AA@@A@AAA@@@EA@@A@@AA@A@A@A@A@@@@@@@A@A@AB@@A@A@ABAB@B@B@D@@@@Y@@EA@A@ABA@@@A@@AA@AAA@A@CAG@A@C@E@C@C@C@I@I@C@CAA@A@A@AB@@A@AAC@@@@@A@G@A@A@@BAB@B@FC@@B@BA@A@CAA@AB@@A@@FA@@CEAA@@C@@C@@BGA@BG@ADE@@@@@AHA@A@FB@DABDBAB@@AH@@BA@BFBAF@@@@AFGAADC@@DA@@@A@@B@DB@@FB@@DD@@DFB@@AD@DA@@DA@@BC@@BAB@BACAB@@@@@AAHA@@BIAAHE@@D@@B@@B@B@@@B@@B@@@@AB@D@@B@@@@B@@BC@@BC@@BFB@AH@@BA@@@I@@@E@@BB@AD@@@D@@@DA@@BB@@BJ@@EB@@BB@@DB@@EHB@A@@BAB@@BA@@BD@@B@BA@@@A@@DD@@BC@@@B@@BDA@DB@ADDB@@A@@BA@@DA@@@A@@BC@CH@B@ACA@BABABI@@@F@@BE@DDC@@@A@@BA@@A@@@B@@AB@@C@CC@B@@BB@B@@AB@@C@@@@AC@BDHAB@@BD@@B@@@BB@@C@@@AB@@BA@BB@@B@@@@BD@B@@@@@BJA@@B@@@BD@DA@D@@E@@B@@@BA@@AA@@B@@@AAB@@A@@BB@@D@BAAABCFAFAB@@A@@@CHABA@@@@BA@A@@@@B@@@@@@D@@@@BABA@@@@@@AA@@B@@@B@B@@@@ABKFABCBCFED@DADABA@@B@B@BCBCBGDCBADGDA@CBCBC@A@A@ABA@@@ABABC@C@C@A@CBA@A@@B@@BD@DABB@@B@@ABCBEFCF@B@B@@BDAB@B@@@L@B@BADCBAB@@AB@@@B@B@@AB@@A@A@@@A@@@"],encodeOffsets:[[116104,36346]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
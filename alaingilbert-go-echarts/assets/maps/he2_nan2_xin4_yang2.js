@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("信阳",{type:"FeatureCollection",features:[{type:"Feature",id:"411502",properties:{name:"浉河区",cp:[114.058713,32.116803],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@B@GA@DAD@DA@EBA@E@@AAA@@@BIJAH@DDFFJDDBBD@FBDBAGCAA@@A@A@AFMBA@G@A@C@A@AB@@A@@@ABA@A@@@@B@@@B@"],["@@@@BB@BF@B@FAB@@CBC@CHBA@@@B@B@B@@@@BB@@@@BAB@@ABAB@@A@@B@B@@@@@@B@H@B@DB@B@F@DAB@BA@CDCDCDGDAB@@BHHDNFHBBD@DADEDCB@FADFB@@@@B@B@B@B@@BB@@BB@@@BA@B@B@@BBB@B@@@B@@@B@@@@@@@B@@E@AB@B@BBF@DB@@B@@@BA@A@@@@@AAAB@@@@@@@@@@BD@DDB@BBB@B@BBD@DA@@B@@ABA@ABA@ABA@@B@F@B@BBDB@@B@@@B@@@B@BB@@BB@B@@@BABCD@@BB@DB@AD@@@D@@@B@@A@@D@F@@A@@@@B@@@@BB@@@B@B@@@D@B@B@@ABABA@A@@@@@@@@A@@A@@@E@ABC@ABA@ADA@@B@B@@@@F@B@BD@@BAB@DABAB@B@B@@AB@@@B@BB@@@@@ABABC@@BBA@B@@@@@@A@@@@BAD@@AB@DA@B@BB@B@@AF@@B@@@@B@@AB@@@B@B@@ABA@@DABB@@@@@D@@@BBB@@BBBBBB@@@BB@B@@ABAB@B@@@@B@@@@@@BBB@B@@BBDB@B@@@@@@AB@AAAA@A@@@@@A@@@@AAA@@A@@@@@@BBB@D@@@@@B@@A@@B@@@B@BAB@@AB@@BB@@B@@@A@@DABA@ABC@@@AD@@@@A@A@A@C@@BA@@@A@@@A@@@AB@B@BB@A@@@A@A@@@@FA@@@@B@@D@@@@@@B@@@@I@AA@@A@@A@EB@@AAB@DCB@B@DAD@@@@@BB@@BB@@D@BA@ABAB@@AD@D@FAB@HABAB@B@B@@@DBBB@@D@BA@@@@BA@@BA@@DABA@@BA@@@C@A@@@CAA@ACCACCA@AAAC@@AAAAA@@CA@@@A@A@@@A@A@C@@@E@@BC@@BABABABAB@B@FAD@B@F@B@F@B@DBJDB@@@FADAFABADA@@B@D@BAD@B@@A@A@@@ABA@A@A@ACE@C@A@@@CBCBE@A@A@@DA@@BCBABA@A@ABA@AB@B@@@BABBB@DBHD@@BBBBDDBB@@BBB@B@B@BAF@D@B@B@B@@@BAJCBADABADA@ADCDAB@BAB@B@B@DDD@BDDBFBB@DBB@DBBBDDBBB@D@B@BB@B@@@F@BB@@BB@B@D@B@@@@BBB@B@DAD@@BB@@@BBB@BAB@DAB@B@B@B@BBFDDBBBBFFHFD@BDB@FDPFFDB@JBBCNDDEBSDCDALCMSBIA@YDA@@A@AAC@@@ABA@@BAD@B@B@@@@@ACC@@C@A@A@@AACA@@AA@AAAA@A@@@@A@@A@A@A@AB@@@@@A@@@@A@@@AAAA@@@A@@@AB@@ADC@@F@B@BABADAB@@@BA@A@CBG@C@A@E@C@@BA@@@A@C@AB@@ABA@AB@BA@A@A@A@@@A@@@@@@@@AA@@A@@@CA@BCB@@A@AAAA@@@CDI@EBM@@@@BA@@@@@@@A@A@@BA@@@A@A@@A@AA@@@C@@@@@AAA@@AGAA@@BA@@@@D@B@@@@@BBBBB@BABAB@B@DA@A@@@@@@AA@@A@@@AAA@@@A@A@@ACC@AA@@@AA@AA@A@@@AA@A@@AC@@@AAA@E@@B@@@B@B@@@@@@A@CAEA@@C@AA@@A@AAAB@@@B@B@@@@ABA@ABAAA@@AA@@AA@@BA@@B@B@B@@@BA@@@@BADA@@@@DC@@@@DC@@DA@ADAB@DC@@HGDA@C@G@@J@@@F@D@B@DABAB@@@@AD@D@BC@A@@@@@@BA@A@@BA@A@@BA@A@@@A@@@A@@BA@A@@@AB@BA@@BA@@@@BA@@@A@AB@@@AA@AB@@C@@@@CA@C@C@ACGFAB@DA@@@@BA@A@@@A@A@AA@@@CAAA@@@A@A@C@@A@@AC@@A@C@A@A@@@A@@CA@@BA@@@@B@B@@@@AAA@@@@BA@@B@BB@A@@@@@A@A@@@@BA@@@@AC@@BA@@B@@@@@BBB@@@@@@A@@AC@@@@BA@@@@B@@@@@@@B@@@B@@A@AB@@BB@BB@@@@@B@B@@BB@@B@@@BA@AB@BA@@B@BC@A@@BCDGEAACFCB@AEBEBCDBDDDA@CBABGF@@A@@JBDFBJB@@DGJEBCFBD@@BDD@FCHBFCHCDFDDFFBDJFBA@@B@@@DAB@@@@@BA@@@ABAD@B@BAB@BAFA@@BA@@B@@C@@@A@CBA@@BA@A@@@@A@@A@@BABAB@@A@@BBFA@@BBB@B@@@@ABE@A@AAA@CAC@@BA@@BC@@@@AC@@AE@AA@AAA@A@@CAA@@C@@AAA@C@A@@@AA@@BABA@A@A@AAAA@@AA@ABA@@A@@@AA@@AA@AA@ACAAC@GAA@A@EBA@AA@@AA@@A@EAAA@@@A@@@@FAB@@A@AB@BEC@AA@@EB@@@@@AB@@A@ABA@@@A@@@@AA@@AA@@AAA@@A@@@@@AB@@@BBBB@@@@B@@ABA@@@C@A@AB@@@D@@AB@@A@@@AAAACA@AA@A@A@C@CBG@AAA@@CAA@@A@@@A@A@@BA@@B@DAB@@A@@@A@ABEBC@ABC@@BAFABABA@@BCBA@ABABAB@DABAB@BA@A@@@A@@@A@G@@AC@@B@B@B@@AB@BC@@BA@@B@B@D@D@B@BB@@@@@@BA@CBCBC@@AC@A@AB@BAB@@ABC@@@A@A@@D@@A@@@@@E@@@A@@BABA@@A@@@AAAA@@@A@@@E@CAA@AA@ECAA@@@ABCB@@AA@@@@@CAAAA@@A@@BCB@@AA@@AAA@@AACAAACA@@AA@@@@A@A@@@@@ACAAA@C@A@A@@@AAA@@@IBK@GAECGI@A@C@CA@AAIAG@E@GDGDG@@@E@A@KKAC@M@CAECCCE@C@EAIACAAC@EBC@IECA@@EEGIEC@E@@@@@AA@@A@@@@@@AAA@@@@@@AA@@@@@AA@@@AA@@@@A@AA@@A@@A@KDSBA@GAC@CCAKACECG@GBE@G@EAAAAAAAC@GBGBE@O@CAKGSMMICEEAE@EBGD@@GHEL@F@D@D@DEHAD@D@D@@@DA@CDIBEDEDCFAHBJ@FBD@HAFADEFADAF@FFJDFFFDFBF@J@HADCDGFQJGFIF@D@BDH@B@DABEBGAMAKCCEEECAACGMCGCGEICCC@EAG@OGCACAA@ADEDA@@BA@A@@@CACCAAA@A@A@@@ABCB@@A@@@GHGFEBCBEFAD@F@FBH@D@BEFEDAB@H@DDLDL@B@@ADADBFBD@H@B@FCLAB@BCBC@CBE@IDEBCFCBC@CBCBAD@DBF@NBLDHFJDDDDBD@B@DABC@C@CBEDGDIDE@E@C@@@C@CBKFCDCBAAEACAABA@EFCFCBA@CBABAD@HDN@BBDFFHJFLBBB@F@B@B@B@BBFHDHBBHFDFBD@@CHCHGFCDMFQDCDCBCDMLEFEFADAFADABG@ABC@QJ@@@DBRAJ@BAD@B@DCREVBBB@HANCDABCBIBCD@B@HDHDTVJN@BBF@JANCTBDPXHJBDLHBD@HADAJCDAB@D@HADAB@@CAEAGCAACBEDAFCBMLAHE^ADCDCDCBABALANBDDBB@FBHBDB@DANAD"]],encodeOffsets:[[[116493,33043]],[[116480,33047]]]}},{type:"Feature",id:"411521",properties:{name:"罗山县",cp:[114.512872,32.203883],childNum:1},geometry:{type:"Polygon",coordinates:["@@EIAE@ABA@ALG@A@A@CCCME@@OCE@C@EB@@ABIFGBA@C@AACAA@EEACCACACBA@CFEBABAAA@@A@MAAAAC@E@CBGBIHCBA@A@CGA@@@C@C@CBABAFAFAF@@CBKDEBCBCBCACACEEAEAE@CBWF_LEDCDEB@@C@A@EAG@A@G@I@GBE@C@AAGCCAABEBE@EBEACACAC@C@CB@BAFADEHIHIJGJ@DA@@DAFA@ABEBCD@@ADAHAHCDSTCD@FADEFGJ@@GJAL@DBF@D@DC@AAAAEICCCAE@C@A@@BEDA@IJEDGDGBEDEFCDA@C@IBB@BB@@B@B@D@B@BBBD@@@@B@B@@@@@BB@@DBBBDBBB@@BB@BB@@BA@AD@@@BB@BBDB@@@@B@@BA@AD@B@@BBFDB@@BBB@D@F@@@B@@BBBB@@B@@@ABAB@@@B@@@F@@@@@BC@@@@B@B@@AD@BA@ABA@@B@BBD@@ADAD@DAB@@@@@@AAA@C@C@A@A@@@AB@@ADA@@BA@A@A@@@BD@@@H@B@@@B@@@BABA@ABCBA@ABAB@BABAD@@ABABEBAB@@AD@BADAF@B@B@@@BA@CBA@@@AB@@@B@B@@@BB@DB@@BB@BAH@D@D@B@BBBB@BDBB@B@@@BA@@BC@@@A@@B@B@D@@AB@BA@@@@@AAAA@@A@@B@@@@@BB@BB@@BB@@BB@@@@@B@@AB@B@BA@@B@@@@FA@@BBD@AFA@@B@BA@EB@@@@@B@@BBFBB@@@BB@@BBB@FAB@B@HBD@BBBDB@@BBB@@BB@@B@@@AB@BBB@@BBBBB@B@B@BA@AB@@B@@@B@DBB@BD@@@BB@DB@B@BBB@@BBF@@BD@@@@AD@@AB@@BD@DBB@B@BAF@BDBBBD@B@@@B@@A@@B@@@BB@@BF@@B@B@@@B@BBDB@@B@@@D@DGFAJLH@BCHCPDDBBHCHDB@BEDBBHC@ELA@AHCBFF@BBABE@GHJFADFDDEDDB@DBCDD@FHLA@AB@HFB@@LIJBHGD@@A@@BA@@BABCB@@AB@BB@@@BAB@B@@@B@BB@BBB@BBB@@AB@@ADAB@@@B@B@BAB@B@B@B@@BB@@BBA@@BA@@B@@BFBB@BBDA@AB@@@B@@@BB@@@@@BBDAB@BB@@@BBD@@D@D@D@B@@BDBBD@BA@@@EB@BA@DB@B@@@B@@@BF@BBB@DBHDDBFDB@DBF@B@BAD@BB@@B@BBBB@B@BAB@B@B@D@@@BA@@BA@ABC@@@ABA@@BA@@B@B@@@BBBE@@@A@@@A@@@AA@@A@E@@@@BA@@A@@A@C@@BC@A@AB@@@B@@AB@B@@@@AA@B@@A@@@@@@@ABAA@BA@AB@@A@@@@@@B@@@@@@A@@BAB@@A@@@@@AB@@AB@@@AA@@@A@@@@BA@@@C@A@@@@B@@B@@@@@@B@@@@@BBB@@@B@@@@@B@@@@@BAB@BAAA@@A@@@@@@@@@AA@@@@BA@@@@@AABAA@@BA@@AA@@B@@A@A@@BAB@DC@@BBD@B@@B@@BB@@B@D@B@@@B@@@B@@B@BA@@@@@@@D@D@D@B@BA@@@AA@B@@@@AB@@@@ABB@@B@@@B@@@@@@D@@@@B@BA@@B@B@@@@@@B@@ABC@@B@@@@B@B@B@BB@@@D@@B@BCFA@@B@D@B@D@D@H@@B@B@@@@B@@@B@B@@AB@B@@@B@@B@@@B@@@@A@@B@AC@AB@@A@@B@@@@B@BBB@@@@B@@@BC@@B@B@@AB@@B@@D@B@B@D@BA@@BAB@@BB@D@@@@@B@BA@@@@B@BB@@@@@D@@B@@@B@BA@@B@BB@B@@DAD@B@@AB@@@@@@A@@BA@@@@B@BA@@B@@B@@B@@@@@B@B@B@@B@@B@@@@@@@B@@@BB@BB@@@B@@@B@@BB@@BB@@@@@B@B@BB@@B@BB@@@B@@@@BB@@B@B@@@@B@B@@@B@@@B@BBB@BBB@H@B@B@BB@@B@@A@@@@B@@@@@@B@@@B@@@B@BABA@@D@@@@@@@@@B@@BBADAB@@@BB@BBBBBBBFA@D@@AB@@@BA@BD@@@BB@B@D@B@@@@B@@@@@B@@@@@B@@BA@@@@B@@B@@BAB@@@@BBAB@@@@FBB@B@@A@@@@@@B@@@BBB@@@D@@@B@@@B@@@@@BBB@@AF@J@B@B@@A@@@@BB@@@@@@@BB@@@@B@@A@@@BB@@B@@B@BDD@@BA@@@@@@@B@@B@@A@@@@B@@@@DABB@@@@@@A@@BB@BBB@@B@@@@@FHAB@F@B@@@FBF@D@B@B@BAB@HCB@DADCBADCFGB@BAB@BABBB@B@@DDD@@DDDB@BDD@BB@B@B@B@DABALKDCB@@@B@B@@@BBFBFD@BHFDBBBBBBBDDBBDBB@B@B@BAJEBAB@@ADCB@BABEB@@AD@D@B@BBB@@BBB@DBNBF@@BBBB@BB@D@B@H@F@B@BBB@DB@@B@D@F@DAB@DAB@BABADEBC@@BE@ADCDCB@BAB@D@B@HBH@@BBBB@DDFFBBHHDBDHBDB@@BBB@BB@DBFBFBD@B@B@B@B@@@DCF@DADAB@BAB@B@B@DBD@@BB@B@B@B@B@DABAB@BCBADAB@DC@@DAB@B@D@@BDFDDDDBBBBB@DFBBBBB@@@B@@@B@B@BABADABAFA@@DCDADAFABAF@B@D@H@D@BBFDFFBBD@DBB@BAB@BABABA@ABABA@A@AAA@AACCAEE@AAA@A@A@G@ACCECAA@AAC@AB@@AB@@AD@B@F@DBH@B@BBDBHHDB@@HBDBDDBBBBBDB@DBBB@@B@@A@@@A@G@CAC@CAGAAAAA@@@ICCAA@CAAACEA@@A@@BA@A@@B@@AFADADADA@A@@@C@C@A@AEIA@@AAE@A@A@A@ABAB@@@B@B@BAJCDABAB@@ABCBC@A@A@A@AAA@@A@EAA@CAAACAACAA@@ACAEAC@CAAAAA@A@EAA@AAGC@A@@AA@A@AAC@@@@@A@ABC@@BC@C@@BCA@AC@A@A@A@AACAE@AAA@@AAA@AAA@A@C@KCA@@@CA@A@@AA@AA@@AAACAAAA@@A@@@E@C@A@@EC@@@A@E@C@@@AAAAAAA@@AA@A@A@ABE@ABA@@DA@ABAB@DI@A@A@@BA@A@@@AAAA@CAA@E@A@A@C@A@A@CAAAAA@AAC@AA@@AA@@A@CAA@A@AAACAAAEAAAAAA@@A@CA@AA@@@A@A@A@ABA@@@A@ACCAAAEACACCG@@AAA@A@AAA@@AAA@AAA@I@AAG@A@@AAACEEIG@AAC@@@A@E@C@EAI@CAC@A@CA@@ACAAAGCAAEA@@EAA@@@C@A@A@A@C@CAAACACAAA@@@A@CBA@ABC@E@A@@AA@@AAA@@AA@@ABC@C@A@@CC@@AAA@@@C@A@E@C@CA@@AAACAAA@CAEAC@@@A@@AAA@A@@@A@ABC@@@AAGBABA@EBA@A@A@A@@@ABB@@@AB@@A@@AA@@@@B@BBB@DA@@BA@@B@@AB@@A@@B@@A@@@@@@BAB@@@@@@A@@AA@A@AB@@@@A@@BAB@BA@@B@D@@BB@DAB@B@B@B@D@@@@ABA@A@@BAB@B@D@@A@A@@@@EAE@A@@AA@@A@@@A@@@@BADAB@@@BA@AB@D@DBBBBB@@@@BA@@@A@AB@@AB@BA@@BA@@@AB@B@@@@A@@@CBA@@@C@ABA@@BAB@@A@A@ABABA@@@ADC@A@A@@DA@@@A@@AA@A@C@@BC@AB@@@@A@@@A@@AAAACA@AA@@AB@@@@@BA@@@@@A@A@A@@@@BABA@@D@@A@@@@A@@AA@@@A@A@@@C@C@CAC@@@@@BA@AAAAA@@AA@@@@A@AB@@A@@AAA@A@A@A@@AA@@@A@A@AA@@AA@@@@ABA@A@@@AAA@A@A@@@@AA@A@@@@A@A@@@A@@A@C@@AK@@ACCE@@@A@AA@@A@@@@@ABA@ABA@CB@@AB@@C@@@@HAB@DABA@@@A@A@A@@@AAA@AAA@@CAA@C@@@@@A@@@AAAB@@A@@@AA@@@AAC@@@AA@A@@CAA@A@C@@@@@A@A@C@@AA@@@A@ADC@A@@@C@@@A@A@C@@@A@@@A@AA@@@@A@@BA@A@ABA@A@@@A@AAA@ACCA@A@C@@@@A@@@AB@@@FA@@DABA@@@A@@@A@@CC@@@@@ABCBA@ABA@C@@@A@C@AA@AAAAAAA@A@ABGDGBE@A@CA@A@ABGBE@EAC@@AAE@C@A@@CDG@@CCKEGA@@A@A@AB@BERCHCFAJCHABC@C@G@EAAEAC@A"],encodeOffsets:[[117256,32524]]}},{type:"Feature",id:"411522",properties:{name:"光山县",cp:[114.919152,32.010002],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@A@AAA@A@A@AAA@A@A@@@A@A@A@A@C@@@A@AAAACAAA@@AA@@@ABABAAA@@@AA@AA@@A@@@ABA@ABABA@@@AB@@CAA@@@A@@@AB@@@DAD@B@@@@A@ABA@@BA@A@AAA@@@AAAB@@CBCBABA@A@A@A@CA@AAAAAA@@@A@C@C@CBA@@@A@@@A@C@@@@@A@C@@@A@@A@@AA@@ACCCCA@AA@@@A@A@ABA@G@@@ECAAC@@AA@CAAA@@@B@F@@A@A@ABAB@@@@DH@B@@@B@@ADA@A@@@C@@ACAAAA@CDEBA@A@@@CAA@A@AB@@C@C@@@EB@@AB@@ABAD@BCBABA@ABC@A@AB@@A@ABABA@@@A@@A@@@@B@@@@A@@@@A@C@@@A@ABE@ABA@CB@BA@AF@B@@A@A@@@@@@@AB@@@BBBA@AB@@C@CBCBC@@@ABC@@@A@AB@@@@@B@B@@AB@@ABA@CBA@@BAB@BA@@@A@A@C@@@A@AB@@AB@@ABA@@BA@A@C@@@AB@@ADABA@@@ABC@@@@B@B@B@@@@@BAA@@@@A@@@@B@@A@AAAAAA@AAA@@@A@AAAB@@@@ABB@A@@@@@A@A@@D@AA@@BA@AA@@@A@C@A@EC@A@A@ACAE@@@AAAAAA@@AB@@C@@@AB@BADAB@@@@A@CAA@@@CAAAAA@AA@@@C@G@E@C@C@@@@AA@@A@@@AAB@@@@@@AA@A@@AAA@AAA@A@A@@B@BA@A@A@@BA@@@@B@@BB@BBB@@@@@BABAB@@ABA@@@A@@@@B@B@@@@AD@@@@@BB@@@@@@B@@AB@@@B@B@@A@A@CAAA@@@@A@CFA@A@AA@@AA@@AB@AABAA@B@BA@@@AA@@@@AB@@@BAB@@@@A@CAA@A@@BA@@B@@AF@@@@ABAA@@CAABC@CAA@CC@AA@@C@@AAAA@A@@BA@AA@A@C@ABABAB@B@@@B@B@@@@ABABA@@BC@G@A@A@AB@@AB@@E@GBC@@@ABA@@@@@@@AA@@@@A@@B@@@@BB@@B@BBD@F@BB@@DD@D@@@BA@AB@@C@A@CCA@A@@@A@AA@@A@C@@@CAAAAAAA@CAA@CBC@CAEAEAC@AA@@AA@@AA@AAA@A@@D@D@B@B@B@@@BC@@@@BA@@BAAA@EACAAA@@A@@@@@@@@BA@@@GBC@AAA@@@A@A@@BA@@BAB@BAB@@@B@B@BBB@BBD@@@B@B@B@@ABC@CBCDA@C@@BGDCBA@A@C@@@ABC@C@A@A@AAA@@@A@@BA@@@A@E@@@@AA@AAC@C@A@@AAA@AAC@AAAA@A@AA@@A@A@@BA@@@A@A@AB@BAB@BA@AB@B@BA@A@CB@@A@@@AB@@AFAB@@CB@BBB@BB@@B@B@@AB@@@@CAC@CA@BA@@@DD@BA@C@AB@A@@A@@A@AAAAA@@@@@AC@@@A@A@@@@A@@A@@@@BA@AA@@A@@@@@AB@DBD@@AB@@@B@@B@@B@@@B@B@@@BBB@D@B@BAB@@AB@BBB@B@@@B@B@B@@ABCBA@GB@@@@@DA@@BA@@DAB@BAB@B@@@@@BB@@B@B@@DFBD@@BL@@@D@BB@@@B@B@@@@@@BBB@@@@@B@BBB@B@@@BAB@B@@B@@BB@@B@B@B@@BB@@@B@B@BBB@BB@@@BAB@@@@@BB@@BBBB@BAB@@@@D@DBD@D@@@B@B@@@B@@BB@@@@@@BC@@@ABAB@@@@@B@B@B@@@@AB@@@@A@@BB@@BDBBBBB@@@B@@@B@@A@@BAD@@@D@BBB@@@B@@CB@@@B@BCD@B@@ABAB@B@B@BA@AB@@AB@B@D@@AB@D@@@B@@A@A@@B@@AB@@ABA@@BA@@B@B@@AB@@@@AAAACAC@A@@BAB@@A@CBAB@@@@@B@@@BB@@BB@F@FB@@@@@B@BC@A@A@AB@@@BAB@B@@C@A@A@A@A@CBA@@AC@A@@@ABA@AB@@@B@@A@@B@BBB@@@B@@@@A@AB@@@@@@@BA@@@@BA@@BA@@@AB@@CBA@AAA@@@@@BB@@@BA@@B@@AA@B@@@B@B@BAB@FABABBH@B@@AD@B@B@@@BBB@BB@@@D@FBDBB@BBBDBB@@DBD@F@B@D@@@B@BB@@DD@@@B@DAD@BB@@BB@BB@@BB@@@B@FAD@BAB@D@B@@BBDBDBBBDBD@B@B@B@D@@@B@FB@@FBBBHDBBDB@BB@@D@BBD@DBJ@F@D@F@B@@BD@BJHFFBDBB@@@BBH@B@JBB@BBB@BB@BBB@B@BB@@DHBDBDBFBBDD@B@B@@AB@B@B@B@B@@BBB@@D@BB@BBBBFBBBDBBB@B@BBB@D@BB@@BB@@BBD@BBBBBDBB@B@D@B@B@F@B@DBB@BB@B@@@BAB@@@B@BCJA@AB@BCB@@AB@BAF@B@B@BBB@@BBBBBB@B@@@D@F@B@@FD@@@B@D@F@@@BB@BBDBBB@BB@@BBB@@@BDB@@B@LDD@B@B@BBB@BB@@BB@BBFBD@B@B@B@BBDB@AD@@@DADH@F@B@F@B@D@D@@@B@@@HA@@@A@@B@D@@@@@@@B@@@@AB@@B@@B@@AD@B@@@@@@CA@@E@AB@@@@@@A@ADA@@D@@BB@@A@@@@@@BB@@B@@@@@@C@@@AB@@@@@B@@@@BB@@B@@@A@AB@@A@@@@B@@BB@@@@@@@B@@@@@@B@@B@@@@@BB@@@B@AB@@@@BA@@B@@@@B@@@B@BB@B@A@A@@@@B@@@B@BA@@B@@B@@@B@B@B@@@B@@@@@@@@@@B@@@@@@@@B@@D@D@BAB@@CB@B@@@@AA@@@@AB@@@@@AA@@@@@A@@B@B@@@@A@@B@@@@@@A@ADBB@DE@@BCBA@ABADA@ABA@A@@@@B@B@BB@@FA@B@@@@@DD@@@@BB@B@@A@@@AB@BA@@@@BCA@@A@@C@@@A@@A@@@C@A@@BA@@BAB@B@B@@@B@@A@@B@@@@B@@B@@AB@BCBABABABA@@@@B@BA@@B@@@@@B@@@@AB@@A@@B@B@H@B@BA@@B@@ABA@@@AB@JCB@@AB@BCBAFA@AB@B@@@B@@@B@@@BBBA@@B@@@B@@@BB@@@@@B@@@@@@B@BABA@@B@D@B@@@B@@A@@B@@@@@@B@@@BB@@@B@B@@@@@BBB@@B@@A@@B@@@@B@DA@@@@B@@BB@@@@B@@@@@@@@B@BB@@B@@@BA@AB@B@@@B@B@BBB@@@BBB@B@@@@B@BDB@@@@BB@BB@@@@@@BBBB@@@@@@BB@B@@@@BB@@D@@BB@@@@@D@D@@@@BB@@B@@@BA@@@@B@@@DD@@@DBB@@@BB@@@DAB@@@@BB@@B@@B@@B@@@B@@B@@@@AB@@@@@B@B@@@@B@@DB@@B@@@B@@@@BB@@@@ABBBA@B@@B@@B@@@@B@@@@@BB@@@@@@@@B@@AA@@A@@@@B@@@@@B@@@DD@@B@BB@@@@@B@BB@@@B@@@BBBB@@@@@@@B@@A@@@@@BB@@@A@@@BB@@A@@@@B@@@B@@@AAB@@@@@@@B@@BB@@@B@@@@@@BB@@@@@@@B@A@@B@@A@@@@@@B@@@@B@@@BB@B@@BB@@@@@@@B@@@@B@@@BB@B@@@@@B@@@@B@B@@@BB@@@@@@BB@@B@@A@@@A@@@ABAB@B@B@@@DB@@B@@ABA@@@@A@A@@ACA@A@@@A@@B@B@@@B@@@@A@@@@@@CA@A@@@@@AB@@A@@A@@AC@@@@@BE@A@A@@CA@@@@AC@AA@@@@@@@A@@@A@@@@@AA@A@@@AB@@@B@@@B@@A@@@A@@AA@@@@CA@@@@A@@A@A@@AAA@A@AA@A@@@@@@@AB@B@B@@@@A@@A@AAB@@AB@@@B@@A@A@A@@@A@@@@A@A@@@@A@@@A@@D@@A@@B@B@@@@A@@@@@ABBB@@@B@@@@@@@@AB@@@@ABB@A@@B@@@BA@@@@@@@A@@@@@A@@@@BA@@@A@A@@@A@AB@BA@@DE@ABA@CDCBC@@@AB@@AB@@@B@@@@A@A@@@A@A@A@A@@@@@A@@@@D@@@@@BA@@@@BAB@@@B@@A@A@@BA@@B@@@@@AABA@A@@B@@@@@@A@@BA@@B@@@@A@@BA@@@@B@@@B@@@@A@A@@BA@@B@@@@@@@@@BB@A@BB@B@@@@AB@@A@A@@@@@A@@@AB@@@@A@@D@B@@@BA@@@A@@@A@@@@@@@AA@A@@AB@@AB@@@B@@A@@@@A@@@@@@A@@@@@A@@B@@@BA@AAA@A@@B@@@@@BA@@@@B@BA@@B@@@B@@@BA@@BA@@@@@A@@@A@@@@B@@@B@@@@@B@@A@@@@B@@@@@A@@A@@B@@@@@B@AAB@@@@A@@BBB@@@B@@@@@@@B@BABAB@@A@@BA@@BB@@@@B@@BAB@@AA@@@@@BB@@BB@@@B@@@B@@@@A@@B@B@@@@B@@B@B@B@B@@BBA@@@AB@B@@@@@B@@BB@@@@A@@@@B@@@@B@@B@BB@@BA@B@@@BB@@@@AB@B@@@@B@@BB@@@@B@@@@@@BB@@@@@B@@BD@BABBFA@@B@B@B@F@@BB@@@B@@@@C@G@C@AAA@@A@CCCACACCCEAAAA@@@A@A@@DEDE@@BA@A@A@A@ABC@A@A@A@@AC@AA@AAAAA@@@A@G@A@I@C@ABC@A@@@AA@A@@B@@A@@A@@@@A@A@@B@@@FADA@@@@BA@ABAB@@@BC@A@AA@ACAAA@@AC@A@CC@@@@@C@AAABC@A@@@@B@BB@@B@@@@@@A@AD@@ABA@@FBBA@BB@@@F@DB@@B@B@B@@ABAB@@@DCBBB@@@CC@AAA@A@@@A@A@A@@AAA@@AAA@A@C@C@AA@AAAAAAAC@A@EAG@AAA@C@A@C@A@@@C@A@@@@DA@@@@@@@AA@BC@@@AA@@A@@AAB@BA@@B@@@B@@A@@B@@BB@@@BAB@@@A@@@@A@@A@@A@@B@@A@@B@@@@@@B@@@@@@BA@@@@@@@@A@@A@@@@B@B@@@@A@@@@BA@@B@B@B@@BB@@@@@BABA@ABA@AB@@@@CB@@A@@BAB@BA@@@C@@@@BADA@AB@@@@@@ADA@@BAB@B@@@BABAB@B@B@@@B@BC@ABA@@@AB@@@B@@BB@@@B@B@@@B@B@D@@@BC@@BAB@BABAFE@@B@D@BBF@@@@BB@DBBBB@BB@BBBBBBD@B@@B@BBD@@@B@@A@ABA@@@@@@AE@@@@B@BDB@B@@A@@@A@@BC@A@AB@@@@C@AB@@@D@B@B@BAB@@A@@@A@A@@@A@AB@BABA@@@AB@@@D@D@B@BABA@A@@@A@@@ABABEBC@A@@B@@@B@@A@ABAD@@ABCBCDE@@@AD@BADC@ABA@@ACB@@A@A@@B@@ABA@@B@@@BAB@BA@@@A@@@@B@@@@@B@@B@@B@B@@BB@F@D@BAB@@@DABA@@BA@A@@@@B@B@B@BB@@BBB@DA@ACAAAAACAEBAA@@@ABEBE@@@AA@@@AA@@ABA@A@@A@@A@@@@A@@BC@@@A@AAC@@@E@@@AA@@@C@@A@@@@AGB@DE@@BC@A@@@AAA@@AACCA@@@BA@@@C@A@CBA@ABA@A@A@@@@@AA@A@A@A@A@GAA@@@@A@@@CAC@ABA@@BABAB@@AB@@AAA@@BA@@@@@C@AAC@@@AAA@@@@@A@@BC@AB@@AB@B@@@BBBB@BD@B@@@B@@ADC@@@A@@A@A@@AAA@@@AB@@@@@DA@BB@@@@B@@BB@@B@BAB@@AB@@A@EBA@@B@@@@@BA@BB@BAB@B@DBB@B@@@B@@@@E@A@A@A@@DE@@A@@AA@AAAAB@B@BABAB@B@BA@@@ABA@@B@DB@@D@B@DA@AB@BAB@@@B@B@B@B@BABAB@@AB@B@B@B@@AB@@A@@@A@@@A@@B@@@BAB@@@@EB@BA@A@C@AB@BABC@@@AAC@A@@B@@@B@D@BAB@@A@@@A@A@@A@@AAA@@AAA@@A@A@@AAAAAAAA@A@@AA@A@A@@CAAA@@AAC@A@@@AA@@AC@CAA@@CAAA@@BCA@@AAA@A@AA@A@@@@ABAA@A@C@@@A@CCC@A@AAAAA@A@A@@@ABA@@@@@AA@AA@ECA@@@@@@B@BA@@@@@A@@@AAA@A@A@A@@@C@A@AB@@@D@@A@A@A@@@A@C@A@A@@@A@@@@B@@@@@B@DB@BD@BBB@@AB@@@@@@ABAA@@AB@@@B@@DF@@AB@@CAC@A@@@A@@@A@@@@@@B@@@B@@AB@@AAAB@@@@@@AB@B@@@B@@A@CBABA@@BABGF@@A@A@CA@@AA@@@AAA@@A@E@C@@@@@@@@BBBB@@B@B@B@B@B@B@@@B@@ABA@ABC@@@A@A@A@AC@@@@@AA@@AA@@@@AAA@AAA@AA@@@A@@A@@A@@BABAB@@A@A@@@A@A@AAAACA@@@A@@A@CAA@AAC@EAA@ABA@A@@@@BAB@@ABAB@@AB@@A@@@@@@B@B@B@B@@A@CB@BBD@@ABADAB@@@B@@B@B@@@@B@B@BAB@BAB@B"],encodeOffsets:[[117803,32511]]}},{type:"Feature",id:"411523",properties:{name:"新县",cp:[114.879239,31.643918],childNum:1},geometry:{type:"Polygon",coordinates:["@@ONEDGBGB@@CAG@K@@@GB@@EBA@CFA@AD@B@BBBBD@B@BABIHEDAD@F@J@D@DBBFDBD@B@@@BA@A@CAC@CBA@IDA@A@A@@@ABCBCD@@KACCA@@@A@CBEDA@ABAASGMC@@AA@@CBABAJA@@BCBAASC@@GAE@ABAD@@@B@HFHDJ@FADA@AB@@KCGAS@@@G@GFOREJ@@CBAB@D@DADEHABCBE@E@@@BDADCHE@KDKB@@A@A@CAAAABC@EB@@A@@AGKEACAC@E@I@A@A@@CCMEICCA@K@@@IA@@CA@@@G@AA@A@GDOJADAD@HAFAFET@DAR@FEPAH@@ADBD@BAJ@H@DBBDBBDBH@DCJCD@DBBFDBDBDBDBDDBPFFB@@B@@BB\\BDBJBLADBDBBB@D@HAD@@@DDDHBB@BDD@BADABIBE@ABAD@B@BBBB@BB@DEJ@B@B@HBDBBBDBBBBADCJ@B@FBD@BBHBDBBDDB@@B@BCF@@@B@DCDCBC@C@@@CCCAA@A@AFAD@@A@@B@D@B@@@DAB@BABAD@B@@@@DD@@@B@@@B@@ABCB@@EB@@A@@B@@@B@@D@B@B@DD@BBB@B@B@@@BAB@B@BAB@@@B@@B@@B@B@@@B@@@D@B@B@@@D@@@BCD@B@B@@BB@@@D@B@B@@@@@D@BBB@DB@B@@B@@BD@B@@BB@@B@@@BABB@@B@@@@@D@B@DB@@BBBA@@BA@A@A@CAA@A@@@A@A@@@AA@@@@A@@BA@@AC@CBA@@@@B@@@BBB@@A@@B@@@@B@@B@B@@@D@@B@@@@BBBB@B@BB@@@@BBAD@B@@ACC@@B@@ADBD@DB@@@@BA@@@A@AA@@AAA@ADA@@BABE@@BA@@B@@@DAB@B@@A@ABAB@@ABA@ABAB@B@@@B@@AB@B@@@BBB@B@BB@BBD@BBB@BB@D@D@BBB@@B@@F@B@@@B@@AB@@@B@BBB@B@D@D@BA@@D@B@B@DAHC@AD@B@DCDAD@BA@@@A@A@A@@AC@AAA@A@A@A@@BA@ABA@AB@@AB@B@@@B@BBD@HA@@B@@A@@@@@@B@@@BBDBFBB@BB@AB@@A@@D@@A@@@A@A@A@C@CB@B@BBB@@BB@@BB@@BBDBFBF@DAD@DBB@DBBBBBBDB@@D@B@@@BBB@@@B@B@DDB@D@@@BAB@@A@@@CCC@@AAE@C@AAA@@@AA@@@@@AB@@@@@BB@@@@@@B@BA@@D@HAF@@@BA@@BAB@B@H@D@@AB@BABA@@@@@A@A@@@ABABABAD@B@B@@BAB@@@BBBBB@@@DB@@BDDB@DBD@BADB@@BBBA@@@@BE@@@AB@@AB@B@DBB@@@@@BA@A@@BA@@@@BB@@B@@A@ABBBA@BBA@@BB@@BBB@B@DEB@@@@@BBDBB@B@@@@A@A@@BA@@@A@@@@A@@A@@@@BC@@@@@A@A@@B@@@B@BA@@BABA@A@@@@AA@AAA@@@A@@B@@AB@B@B@@A@AB@B@B@BBB@BB@@@BBB@@@@@@BA@B@@@BB@@B@@D@D@F@H@D@@@B@@BBBBBDB@@B@DBB@@@@@BABC@ABA@@D@@@BA@@BBBBBB@@F@DB@B@B@BFDB@D@B@@@B@@BAB@@BBC@@@@B@B@@@@@BAA@B@@A@BB@B@B@@BB@BBBBBBBB@@@@A@@B@@@@@BB@A@@@@@A@A@A@@D@BA@@B@BABC@@BA@@D@B@B@@AB@BA@@BA@@BAB@@@D@B@B@@@B@@ABA@AB@DAB@BA@@BA@@@A@A@@@@BAB@@@D@BA@@D@DADAD@@@BAB@AA@A@@BA@@@@@@B@B@@@@ABEB@@ADAB@BAF@BAB@@@D@B@@@@@@B@@A@@@@@@BB@@@B@BABAB@@@BAB@D@BAB@BADA@ABCBA@@BA@@FA@@D@D@@@BAB@B@DB@@B@B@FADCB@BBDB@BD@@@B@B@BC@@@A@@@ACG@@@@BABAB@B@@@@E@A@@BBDBB@@BD@BBFD@@H@B@BAB@B@@@B@@BDBDDBD@@BB@@@BB@@@D@B@@@@@D@B@@@B@@@B@DAD@D@B@@@B@BBBB@BDBB@B@B@B@BADADA@@BABB@@B@BBB@B@@AB@BAB@@@@@@ABC@C@@BA@@B@@@B@DB@@BA@@B@BABAB@BA@@B@@@BBB@@B@@BBABAB@B@@BB@@BBDBBBBBB@@@D@B@B@B@B@@@B@B@B@BBB@B@B@BBB@@@@A@ABA@ABA@A@A@A@@A@A@@@@A@@BABCBA@@AC@ADAB@@@@A@A@A@A@@@@B@@@BA@@BABA@@BA@A@@B@B@BAB@FBD@BBB@DBB@@@@B@@DBBBBBB@B@@@B@B@@@BABA@AB@@@@BB@@@B@@BBB@BBB@B@@B@@BB@@B@@@@BDB@B@B@@@D@BAB@BA@@@A@@@A@A@A@A@A@AA@AA@A@@@@@@D@F@B@@@BB@B@@BB@@DBB@B@@@HEBA@AB@BADAB@@@@A@@@ABA@@@@@@BABB@@BA@@@A@@@A@@@@B@@@B@@@B@D@DB@@BA@@CE@@@A@@BA@@BBBA@@@@@@BA@@AA@AACA@@C@A@@@@@A@@B@@@B@B@D@B@@@B@B@B@@@@C@@BAB@D@@@B@B@B@B@BB@@B@@@@@B@@A@A@@@@B@FDB@@BBB@@@@B@BA@@B@B@B@BBBBB@D@DDB@@@D@B@B@AB@B@@B@B@@@@ABAA@AC@@@ABC@A@@@AEC@@@A@A@A@AAA@A@ABCBA@EB@@A@@B@BBB@@@BA@@@@@A@AA@@AA@A@@@@@@AD@@@@@B@BB@@DAB@B@B@@@D@@@@@BA@A@@BAD@@@BB@@BBFB@BBB@@B@@@B@BABA@@@A@@@@BAD@DBD@DB@@@@BAFC@@DBB@D@@@FCBAB@@A@@@C@C@A@@AAAA@@@@@C@@AACA@AA@C@@@AA@A@@@C@A@@@A@@B@BA@@@A@@CC@@@@@ABA@AAC@@CEAAA@C@C@A@@@GACAA@AAAC@@@A@@@ACAAA@A@@BA@@@AA@AA@A@@@AAE@ABAAA@@CAACAAAA@@@C@@BA@@@@FC@@BC@@BA@A@@@AA@AA@@@C@AA@A@@@@@@A@@@A@@CA@@@@A@KDEBK@SA[C@@SEAA@CBEDIBEAMAAGACAKEOIEGAG@E@ABCFAF@BA@A@AECCE@E@CDELIBA@AACMKICEAIAA@A@BA@A@@BA@AHAB@@A@@ACAA@AAAABA@A@@AA@A@@@@@A@AB@A@@AAABA@C@@@A@@BA@AAA@@@AB@@A@ABA@A@@@A@ABAAA@A@@@A@@B@@A@AAC@@B@@AB@BA@@@AC@@ABCBA@A@ABC@@@@@A@AAA@@B@@CB@@@@@B@B@@A@A@GAC@GB@@KHCB@D@HABE@E@@@AAEMIOAAEKAAKEC@AC@AROBC@CA@EAICA@@C@ICIACA@C@CBEHABABC@A@A@CDEJAPADCBCBC@G@GACAACAAAACAGCIGGEEACEAC@EAC@@C@ABGFKLEBEBAAA@AACCEAKCI@IACAGCGCC@MBA@@BAL@BCBG@KAC@QCC@CCCGACIKCCECC@I@EPEH@@"],encodeOffsets:[[117580,32226]]}},{type:"Feature",id:"411503",properties:{name:"平桥区",cp:[114.125656,32.101031],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AICEIA@@@BE@AHAB@DCBCCCAADAFBFA@EDBDFBCHAD@@@BADA@@@ABA@@BAB@@A@@@AA@@@A@A@@@@AAA@@AA@@B@BA@@@A@@@@@@@A@@@@@AB@@@@BD@@@B@@@@A@AA@@@@A@@@AB@@BD@@@@AB@@@@@B@B@@@@@BAAA@@@AB@@@@BB@B@@A@A@@@@@AB@@DB@@@B@@@B@B@D@BD@@BB@@@@D@B@B@@BBDB@@B@@B@B@B@@@BAB@@@@CBA@EBDH@B@D@DDB@@@@@DA@@BBB@@A@@B@B@@AB@@@@AB@@ABA@@B@@@BAB@@@B@@@B@@@BAB@@@BAB@@@BAB@@@@@@@BADC@C@@B@@A@ABCBA@C@E@@@I@@@@H@DCBGH@@CDA@CB@BCB@@CD@@@@CD@@@@CBAB@@@@AB@@A@A@A@@@AB@@BB@@BB@@BBAB@BAB@B@@A@A@@@A@BB@B@BB@@B@DB@BF@D@B@@@@A@A@@@A@@@@FBB@B@@BD@@@BBB@@B@B@@BBB@@B@@BDD@BB@B@@@B@BB@@B@@@BB@@@@@@@BCBA@A@ABABA@AAAA@@@@A@C@@@@@AB@@BBBH@@BB@B@@@@@D@@BBB@@@@B@B@@AB@@@B@B@@@@@@AB@@@@AN@FCJ@D@@BBBBB@@@DA@ADB@@B@@@BB@@@@@@@@@B@@@B@B@BABA@@BAB@BA@@B@D@B@@AB@@@D@F@B@DAH@D@BAB@@A@CBABABA@E@@@CD@BA@@B@@@B@@BBBB@@B@@@@@@B@@@@BAB@B@B@@@@B@@B@B@BB@BBB@@DBBB@@@B@B@DD@BD@@@@A@A@C@AB@@AB@B@@BD@B@BB@ZCB@AJNTKDCBCDATCFMCADIAA@ECOEECA@ACC@GEEEAAAACCAE@A@A@A@ABA@CBA@AAA@A@@AA@@BC@C@AAA@A@@A@C@A@A@@AA@@A@E@@@AAAA@C@A@AACCAACAA@CAA@EACAACC@CCA@A@A@ABA@CBCD@BCBABCBABIDAB@@A@A@A@C@E@ABA@A@A@AA@@AACCAAAA@@GCCAA@AAAB@@A@A@@BAB@B@BABABAD@@CB@@@B@BAFAD@D@@@B@DDF@B@B@BAB@B@@@B@BA@C@ABC@A@@@CBABEBCBEB@@A@ICCAA@E@A@E@A@C@EBA@A@ABABABAB@@AD@@@F@@@D@B@B@@@B@B@@DB@@BBBB@BD@BB@BDBBDDD@BBB@D@@@B@D@@AB@@ABCB@@AB@@AB@@@@ABC@@@AACA@@A@A@A@ABGBA@EBC@C@@BA@AB@BABC@@@AA@@AA@@@@C@CBA@A@CDA@BB@@FAB@@@@BB@@B@J@@A@@@@@@@@CA@@@@@EB@@@@@B@B@@@BAAA@A@@B@@@B@@@B@@AB@@@D@B@B@B@@C@@B@@AD@BABCB@@@B@@@AA@@AA@@BA@ABA@@@A@@@@BA@@@@@C@A@AA@@@@@@@BB@BB@@@@@B@@@@@BBBBBA@@B@@@@A@A@AC@AA@A@AA@@@@@@@A@@A@A@AB@BA@A@@A@@AAAAAA@@AA@A@@@C@@@@AACB@@AB@BA@A@@@A@@BA@@@@@@AE@@BA@A@@A@ACBA@@BC@AB@@@@@B@@@@A@B@AA@@ADAB@B@@@@AAA@@@A@@BA@A@A@ABCBA@AB@@ACA@E@@@@@@A@AB@BCB@BAD@BAF@@@B@@@@B@@@@@@B@B@BABA@@@A@A@C@@@A@A@@AA@@@@@A@@B@@@@E@CB@@@@A@@@C@@BCA@@CAA@@DCBA@A@@@AAA@@AAA@@@A@@@A@@@CAAAA@E@A@@@AB@BAB@BAB@BA@@@CBC@AAA@A@AAA@CCC@@A@@@@@@@@A@BB@B@@@@@BAB@@A@@@CAE@AAA@A@@B@FA@@@@@@@A@@@A@@@A@A@AA@@@A@AAB@@A@@AA@@AA@A@A@A@@@@@EAAD@@ADKFKLAJ@FBLJTDBJFHD@@DBBB@DAHAFABC@CBE@@@AAA@CMACEAA@CBEFILADBF@@@BD@FBB@BFDFBD@DAD@BABA@C@ABADA@C@C@A@@@ABCDAD@D@LAFADABC@EBA@QHAB@@@AABABEBGD@@@@@@FBBBB@DB@B@@NF@@AB@@AB@B@@@@@BD@BF@@@BB@B@BB@@BD@@@B@B@@BB@BBFAD@BB@@@@B@@@BA@@B@@@B@@@@BB@@BD@B@@AB@@@@@B@B@@BBDB@@@@BABCB@@A@ADA@AB@@@@@BB@@BBBA@@@L@BAFBB@@B@B@@@B@@@BF@@B@@@BA@A@@@A@A@A@A@@B@@@BBD@BAB@B@@@@B@B@@DBB@BAB@@@@A@A@@B@@@@@@@BB@B@D@DBF@@BB@@@BAD@@DB@@BDBB@B@B@B@B@BA@AB@B@@BD@FAB@@A@@@@B@BCBA@ADBBBB@@@B@B@@@F@B@@AD@@@B@BABA@@B@@BBBDB@@D@DB@DB@@@FBB@@AD@B@B@@BFDD@@@B@@A@@@BB@@FB@@BDHB@@B@@@ABADA@@@ABCACBA@AB@BCFAB@BAB@@DD@FDBBBB@BB@B@BBD@B@@JC@@FD@@@B@@A@@DF@F@D@@@ABABCB@@DDBBBBBB@BBB@DBJ@@BB@BBDFDDB@@DBB@DB@@@@BD@B@BBB@@BBDB@@B@D@BB@BB@B@B@FBB@@@B@@@D@B@B@B@B@@B@@ADA@@BA@@BBF@DBB@B@@@BABAF@BBB@BB@@BB@D@@@DBFB@@B@B@BADAFAD@@BB@FDB@@@B@B@B@@AB@BADEBCB@@ABB@@DD@BB@@@H@BBB@@@BDB@BDBBBBB@BABAB@FCBA@A@@@AFEBAB@BA@@D@@@@@@A@@@ABABCBA@ABC@@B@B@@@BAD@@@@@BB@@B@D@B@H@B@BBBB@@B@DAF@@@BBFBD@B@BAB@DA@AB@@CAABA@C@CAC@AA@GC@@AA@A@@@A@@BABCB@@@BA@@D@@BB@DBB@@@B@BABAB@@ABA@ADE@@@@DCBA@@BAB@@@BABA@@BE@CBC@@@C@A@@BA@A@CAC@@A@AA@@@A@E@@@AB@@@B@DDHD@@BB@@B@B@@AB@@A@@CE@A@C@A@A@@@ABA@@@A@@@@AAA@@@@@@@@@@AB@@@A@BA@@BC@AAA@@@A@@@@B@@@@@@@@@@@@A@@@@C@@@@A@@B@@@A@@@@A@@B@@@BB@@@@@E@A@A@@D@B@@AB@@AB@BAB@@@@A@A@@@A@A@A@@B@AAA@@CAC@@@CAE@A@CD@JC@@BA@A@A@AB@@@@@D@D@B@B@@A@A@@@@D@@@B@B@@@@@@@@BB@B@@@@@D@FB@@BA@@@@BA@AB@@BBBHDBB@@BB@@B@B@@@@@BC@@@@B@@@B@@BB@DBF@B@@@B@@A@A@@B@@@B@B@F@@@BBB@B@@B@@BAB@@@@B@@@@@B@@BBBBB@@B@@B@BB@@@B@BBF@BA@@@@@@BB@D@@@DBB@B@PBJ@BBBA@@AA@A@A@@@@@@D@@A@@@@B@@@@A@A@C@AB@@@D@@@@E@@F@D@@B@B@@@@AB@@@D@@A@@@@@@B@@@@B@B@@@@@@@@@AB@@B@BB@@@@@B@B@@@@@BD@F@@@@@@A@@@@B@D@@BDAB@@@BBF@F@@B@@@B@BAB@@@@A@@B@B@@@@A@AA@@A@@B@@@@AA@@@B@@@@@@AA@@A@C@@@A@AB@@@F@@@DA@@B@@B@@D@D@B@F@B@D@@@@AB@@BB@@B@B@@@@@BB@@F@@@B@B@@@@@@AA@B@@A@A@@@A@@B@@@@A@@@@A@@A@@BAB@BB@@B@@A@AD@@C@@@CA@BE@C@@A@@@@A@@F@@CA@@BC@@CB@@@D@@@B@@C@@@AF@@@@CB@@CB@@@A@AA@AB@@A@@A@@AC@@BAA@A@@@BA@@@@AA@BA@AA@@AA@@AF@@AC@@AD@@CB@@A@@BA@@@@BA@E@@A@AAD@@@B@@A@@@ABAB@@@D@@@@@@AB@@@@CB@D@@CA@@@@@D@B@@EA@@AD@@AA@@@D@@AF@@A@C@A@@C@AEC@A@@E@@C@A@@@@BC@@AA@@@@CB@@AACA@@@@A@A@@B@BAB@@@@A@@@AC@@AB@@@@AB@@AC@BEB@@A@E@@@@B@@A@@@BB@@ABB@@@@@A@@BAB@@@@AA@@@@A@@B@D@BADB@@@@B@D@@@@@@A@@B@@@@@@@@D@@A@@@@B@@DAB@@@BB@@@@@B@@B@@@@A@@@@@A@A@@@@D@@@B@@AD@@BB@@A@A@A@@B@@@BA@@@@B@BA@@BBBB@@@@@A@@B@@@@B@BD@@BB@@BB@B@@AD@@BH@@CJ@@D@@@BB@@A@A@@@@@@@A@@@ABC@@@@DBFDDD@@BDBH@@A@C@@@@B@B@D@B@BBBB@B@B@@@B@@@BA@AD@@@HAFAFAD@H@B@B@B@@@BB@@@DB@@@@@B@@@B@@BB@@@@@@AAA@A@@@@B@@BB@B@B@B@@@@B@B@@@BB@@@@@A@A@@B@@A@@@@A@A@@AB@B@@@D@B@@@@@@@@@@AFA@@@BD@BBD@D@DC@@B@@D@@DB@@@D@@B@BB@@DABBDBB@B@@B@@@BBB@@@@@BB@A@@@A@@BA@@BA@A@AB@@@@@B@@@AB@@@@@B@@@BB@@@@@B@@@B@BB@@@D@@@@D@@@B@@@D@@AF@@B@@F@B@@A@@B@F@@A@@BI@CA@C@@DA@@A@A@@A@@A@@@C@AB@@C@@B@@@@@B@D@@@@A@@A@@@@@@A@@B@B@@A@AC@@A@@A@@@@@@B@BA@@@BC@A@@A@C@@@@A@@@@D@@@@@CA@@@@@@D@@@BA@@@C@C@A@@@@B@@@@BB@@@BBD@@GB@@AA@@AA@@CB@@@B@@AA@@AC@@@AA@ABC@ADAB@@@B@@@BA@A@A@@B@@AB@@AB@@@B@@@BAB@B@B@BADBB@@ADAD@B@@@@@BBB@BA@BB@@B@@B@@B@@BB@@A@@@@HBB@B@@@@@BB@@@D@BB@BB@@@@@@@@BB@@@F@@@BA@@@@@B@@@@@@B@@@@A@@@B@@@@@@B@@@@B@@A@@B@@@BF@J@BA@@B@@@B@B@@ABA@@@@A@@@A@@@@AB@@AAA@@@@@A@@B@@@@A@@AA@@@@BAB@@@@A@@@AAA@@B@@AB@@AA@@A@A@AB@@AAC@@@A@@A@@@@@@@@A@@@A@@@@@A@@B@@@BB@@BA@@@@@A@@A@@@AAAA@A@A@@B@@@DB@@B@BA@@AA@@AA@@@@DC@@@@@AAA@@B@@@B@B@@@@AB@@A@@B@D@BA@A@@@A@@@AA@@@BAB@@@B@@A@@@A@@@@@A@@B@@A@@@@@@@@@@B@@@@@@AA@@AA@@@@@BA@A@@@A@@B@@@B@@B@@@AB@B@BAB@@@B@B@@@@D@BA@@B@BB@FA@@B@@@@@AB@@@@D@@@@@B@@DD@@B@BA@@@@B@B@B@@B@@@@B@@BB@@@@B@@B@@@@B@B@H@@@@@BA@@@@@A@@@@@AB@@@AA@AA@B@AA@@A@@AAA@@@A@@@@A@@@A@@@@A@@@@@@@A@@A@@A@@B@@@@@AA@AA@@@@BA@@A@@@@@@A@@@@@@@@A@@A@@AB@@@B@@@@@@@@A@ABA@A@@@A@A@A@@@A@A@A@AB@@ABA@@B@@@B@@A@@@ABA@CBG@A@A@EBA@C@@@@ACAC@@@A@@@A@@@@BCDABABA@A@@@A@@D@B@D@JDJDF@BBB@DBDDB@B@B@DAFABAB@FADAB@B@H@D@F@BAB@@@BADCBA@C@@BC@CBABEBI@I@A@C@C@CAC@CBA@C@AA@@C@@@C@@BA@@BAB@D@@ABADABABAD@B@DADADAB@@AFIBCAE@@AA@A@A@@@C@A@C@@BAHEDAF@F@@E@@@@@AA@AAA@@AB@@@@@@@AACB@@@@@A@@B@@@@AA@@@@@@@B@@AC@ACA@@@@AA@@A@@@BA@@@@@AA@@@@@@A@@A@@B@@@@A@A@IBE@@AA@A@@@@@A@@@A@@@C@@AA@A@@@A@@@@B@@@@AAA@E@@A@AB@A@@A@AB@@@AA@@@@@AB@@@A@@@@@A@@@@@A@@A@C@A@A@@A@@ACB@@A@@BA@@@CEBAAAAAA@AAA@@A@CBAB@AA@@@@@@@@@C@@@ABABA@@@A@@@A@@@@@@@@A@@B@@@@AA@@A@A@A@GAA@AAA@A@A@@@A@@@A@A@@A@A@@@AA@@@@@A@@AAA@@@AAA@A@@@@@A@@AA@@AA@@@A@@@A@@AAA@@A@@@@@@@A@@@@AA@A@A@@@@@A@@@@AA@@@ABA@@@@@AB@@@B@@@@A@@BA@C@CB@@@AAAA@@@ABA@@@A@@@@C@@@@AAA@@@@@ABA@@@@@C@A@@AA@AB@@ABC@A@A@C@@@@AA@@BA@A@@@AD@@A@@@@@AA@A@A@@A@@@@BA@@BBDA@@@@B@@A@@@A@@@@A@@@ABA@@@A@A@@@A@@A@A@@@@G@C@C@A@C@AB@DE@A@AC@@@A@@A@A@A@A@@A@@@AD@BA@@@@@@@@A@AB@@A@A@@C@@@@@@@@A@@@AA@BA@@@@BA@@@@@ABB@@B@@A@A@C@C@C@@@@@@ABA@@@@A@@@A@@@A@C@AA@@AA@@@@AAC@AD@@CBA@AB@B@@@@AB@@BB@@AB@ABBB@@@@B@@A@@B@@B@@@@@@@@@BB@BB@ABA@A@@@@@A@@@@@A@@AA@A@@@@@A@@@@A@@@@A@@B@D@@@B@@A@@B@@@B@@B@@BA@@BA@@@@B@@@BA@AB@@@@@@@@A@@@@B@@@BAB@@ABBBA@@@@@@B@@@@ABB@@@@@ABA@@@A@@BAB@D@@AD@B@@@@BB@@A@@F@B@@@BB@@B@@@B@@@F@AA@A@@@A@AB@@AB@BA@@D@BAB@@AB@@A@@@C@A@ABA@A@AAAAAA@@@AAC@ABA@E@CAA@ECCAGCCAA@AAE@@A@@@A@@@ACAB@@AFA@@B@@AACCA@AA@C@C@C@@@AC@A@@AAA@CBAA@@@@A@@A@@@A@@BAB@AC@AAAAE@@@AB@@AB@AA@@AA@@@A@A@ABA@A@A@A@@BABC@@BA@@AA@AAA@AAAA@@@A@A@AB@@A@@ABA@@DABA@AB@@AB@@@HCAGJI@KA@GEA@@BKBEGC@DCCAA@CCCFECBCIEHGF@BAAAE@AEGD@BKB@FGDAAFC@ACADGAGCAOCGDADG@IKEBCHC@@@A@@@CAAAA@@@A@A@@@AE@@AA@@A@@@@BA@@@A@C@AACA@@A@A@AA@@EBAA@@@BA@ABAB@@@BB@@@@@@BAB@@AB@D@B@@@DA@@@AB@@EBABA@ABA@C@AB@B@@AB@@@@A@CB@@A@@@ABIEACEECCCEGDEDGAEDC@AC@@ACDEFAHI@C"],encodeOffsets:[[116899,32798]]}},{type:"Feature",id:"411527",properties:{name:"淮滨县",cp:[115.419537,32.473258],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@MDA@C@CAC@ABAB@BA@AD@@ABA@A@GAA@A@M@C@C@Q@G@E@C@@@@BCLBBADC@@BA@@D@@ABAA@B@@@BBB@@@@A@AB@@@@A@@BB@@B@@@@@@G@@@@B@BA@@@@B@DB@@DBHBB@HB@@A@@B@@B@@@B@@@B@@@@@@@BC@@DB@@BB@@B@@@D@F@@D@AF@BB@@BB@@BB@@B@@@@BBBBB@@B@DA@AB@@@@@B@@@@B@@B@B@@B@BB@B@@@@@D@FAB@BAD@BBB@@@@@@@B@@AB@@@B@@@BA@@DAB@BC@A@@@C@@B@AA@ABA@@BCB@@@FA@A@A@A@@BABD@@BEHABA@AB@BABAFAL@@A@@@@@MGCAACA@A@CAC@A@A@C@CBIBQDC@A@G@EAA@CAAACC@AAC@A@A@A@AFCBABABA@A@@@A@A@AAAAAAAA@AAA@A@A@ABA@CDABABABABEDABA@@@ABA@A@C@CAC@AACAECEEA@@AA@A@@@A@AB@@@@AB@BAB@DBB@D@DABADADABABCFEFCB@BA@A@C@A@AAC@ECEAAAA@A@A@A@ABEDABEDC@EBEB@@C@C@EAM@A@A@A@@BABA@AD@DADABABIHAB@B@B@D@@@BBD@@@@@BAD@@AB@B@@DB@BB@@B@@@DAD@D@D@@@BA@@BEFA@@FAB@DAD@J@B@F@D@B@NBRBF@BAD@HAF@@@B@@ALAB@@@F@BAD@@@@@BCB@@AB@B@D@@CFAD@@@B@B@@BB@B@@AB@@A@C@A@ABA@AB@B@B@@@B@B@D@@@BBD@BA@@@CDA@@B@@BBBD@B@@@B@@A@E@@@A@@B@@AD@BAB@@AB@@CB@@AB@HA@@B@@A@@@CACAA@AA@@A@@BKDEBKHEFC@@BA@C@G@G@C@C@A@KDC@A@@B@BDJ@@B@@@D@@@BB@@@BDRC@@F@B@@B@@BB@B@BFD@F@@@FGDADA@A@CD@@@@BFA@D@@B@@D@@@BA@@BABA@@@BB@B@BD@@@BD@@D@BB@@BBD@@@D@@B@B@@A@@D@@@BB@AH@@BBABB@@BDB@D@BG@AFCD@BAB@@@BA@@F@BAB@@A@@DA@@BA@A@AC@@CAAA@@A@@AAAC@@BA@A@@@@@@A@A@@A@AA@BA@@A@@A@C@@@A@@@BD@B@@@@A@A@ABA@A@C@E@K@C@@B@B@@@B@@@BI@A@AD@FB@@BD@@BB@@DDBD@H@BB@@@BF@@@@@BD@DA@@B@@BFA@@BBB@@@@JBB@@@D@H@H@@@DB@@AB@@@B@BBB@BB@@BBB@B@B@@@@@B@@B@@A@AB@HAB@@A@@@AB@B@D@BAB@B@@B@@@@AF@B@@B@@@@@@F@@B@B@@D@FBL@@@@@@@DDAB@@@B@@@AB@B@@@@@B@@@@B@D@B@@B@BB@@@B@D@D@D@@A@@@@B@BBB@@ABA@AB@@ABABA@@@ABA@A@A@EBAA@@AAA@@@@@A@I@@BABBDAA@BA@@D@ACD@@AB@@@B@AABAACB@A@FA@AJA@A@@B@BBB@BB@@@@@B@BB@@@@@BBB@@AB@@@B@B@B@@@B@@@DABAB@FAB@@@@@FA@@B@B@@@@AB@@AB@@CA@@AD@AA@@@@A@ACB@@@@AAA@@@@A@@B@@AA@@@AA@B@@@A@@AB@@AC@@AB@@AB@@C@@@C@@@BB@AI@@@@@A@@@@DA@@@@B@@@BABA@@B@B@B@@@FF@B@@@BB@BBA@DDEBBLB@@B@@@B@@BBB@@@@AB@B@@B@@@@@BBBB@@@@A@@BB@@BD@@BA@@BA@AB@BAB@@@B@@@BB@@BAB@@AB@BA@@@@BB@@@@BBA@BFAA@FB@@BA@@DBBC@@BA@@BB@@BD@@@DB@@@AB@BB@@FB@AF@B@@A@@@@BA@A@@B@B@@A@@@AB@D@@@@@@A@A@@@@BADC@@B@DCBA@A@ABCAAB@BBB@BB@@BB@@BAB@F@D@FBBAB@BCBCDAD@B@B@DBBDF@D@D@B@BABA@@AA@CAAA@CCCA@@@A@@BABAB@B@D@@@BB@BBDBD@@BBLFBB@DBBDBB@D@DAB@B@FD@@BBD@B@DCDCBA@AAAAAG@C@@C@@DA@ADADABA@@DABADAB@DDFBDBFBB@BBDBBBBB@BABAB@BA@@@BB@@B@B@HAF@@ABA@C@C@ABCB@BABBD@BBBB@DBD@BB@B@BADABC@A@@@AACCCECA@A@@A@@@A@AB@@@BAD@DAF@BAB@D@@@@@B@@@@A@@A@@AA@EA@@A@@@EB@@A@@@@@A@@AAA@AAA@@AA@@AA@@@A@@@ABAB@HIDABA@@BAB@D@B@B@@@B@FBD@D@BBD@B@B@@BB@@B@@@BABAB@BCF@@@B@@B@B@@@B@BAB@DABA@@B@B@BBH@NBHBJBF@DBNBNBDB@@PBB@F@DBD@B@B@BA@@AC@A@A@@B@@@B@B@BDBF@BB@D@FBBBBDBBB@BBB@B@D@DCB@@A@A@E@@@@BC@A@C@E@A@A@A@AAAAC@AAE@AAA@ABAB@@@D@BBB@@DBBBBD@BAB@@@FCD@B@DBDBB@B@@@DE@@@A@C@AB@B@@@BB@BBBB@B@B@BABABC@A@A@AACAA@ACAC@@A@A@@@A@@BADAFA@@DAFCHC@@JC@@HCDAB@BAF@D@DB@@BBD@DABA@A@CAC@A@ABA@AB@B@FBD@B@BA@A@@@AEE@A@@BA@@BAB@DBB@@A@AAC@A@A@@BABADAFCB@BADGBABEBCFMDGBC@CB@@CBCBEDEBAAGBA@ABAHAFAB@BA@AAE@CBAB@BCH@D@F@D@B@FCBA@ABADIBADG@@DAB@D@D@D@NAJAD@BA@AAEACAACACACAC@CBABC@A@A@@@AA@A@CBA@@FADAFCD@BAB@@@BBDBBDFDRNLHLFHB@AAA@@AAC@@ACA@ACIEIAGAEAA@A@C@A@@@A@ABEBG@A@AA@@@A@C@C@A@A@@@AAAC@E@C@A@AACAA@C@A@A@A@ABABCBA@A@A@A@@@@AA@@E@CAGACAA@A@C@ABE@A@@@@@@@@A@C@@@A@A@@@@BAB@BA@@@@@A@@@A@AA@@@A@@@A@AB@BA@@@@AAAAC@A@C@A@@BA@A@@@@@A@@@@A@A@A@@A@@AA@@B@@ADABA@A@@@@@AC@@A@@@A@AB@B@F@@@B@B@FCBA@@@A@@@@AAEAAA@@AB@@AB@BABA@@@C@A@@@@A@@@A@@B@@AB@DA@@@A@@@@@AA@@AA@CACAA@AAA@A@@@AB@@@@@BBB@@@D@@@@@BA@A@AA@@A@@@AB@@@B@@A@@@CCA@CAA@A@AA@A@CAA@AACAC@A@AAA@ABA@@@@@ACB@@A@@AAAA@@@@@@A@A@@A@@@@A@@@@BA@ABA@@@@AA@@A@@@ABABA@@@@A@@BC@@@AAA@@AAA@@BA@@B@@@B@B@@ABAB@@A@@@A@@A@@@A@A@@@@@@A@C@@@@@AAAA@@@@BA@@@A@@@A@@A@@@@@A@@@A@@@@A@@B@DCD@@@@@@A@@A@@@@A@@B@@A@C@@@A@@@ACAAA@@AAA@AA@@A@@@@@@BAB@BA@A@C@A@@ACA@@AAE@EAC@A@GD@@C@G@MB@@C@A@C@EACAGCAAEACCYMUIAACCC@ECA@GCKEA@CAE@WA@@C@E@K@A@@@C@EBA@"],["@@@@AAB@@BB@@B@ABB@@CEAB@@@@@@@B@@B@@@"]],encodeOffsets:[[[118141,33023]],[[118284,33249]]]}},{type:"Feature",id:"411525",properties:{name:"固始县",cp:[115.654481,32.168137],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@AACA@@A@A@@@@@BB@@ABB@AB@@A@AA@@@AA@@@@@@@A@@@A@AA@@AA@A@@@@@A@@@AAAA@@@A@ABCD@@@B@@A@ADA@@B@B@@BB@@@@@@AAABDB@@@BC@C@@@CB@@AB@B@@AA@@@AAB@B@B@@BB@@@@A@A@A@@@@B@@ABA@C@C@A@@@@BD@B@@BBB@@@@AB@@@@AB@@A@@@C@@@@@BBBD@@AD@@@BED@@@BBH@BA@@@A@A@AA@@AA@CA@@@A@@@AC@@A@@@@@AB@@AB@@BB@B@@AB@BB@@B@B@B@@@@AB@@B@@@@BA@@BB@@@@@@@BB@@@@B@@@@@@@@@@@@BB@@B@@@B@@A@BB@@@@B@@B@@B@@@A@@@@@@@@@@@AB@@@B@@@@@@BB@B@@@@@B@@@@@@A@@@@@@B@@@B@@A@AA@@@B@@@@@@AB@@@@@B@@B@@@@B@@A@@@BBA@@@@B@@@@@@@@BB@@@@@@AB@@@@@@A@@@@@A@@@@B@@@@@@@@@@A@@B@@@@@B@@B@@@@@@@@B@@A@@@@B@@@@@@@BA@@@@@@@@B@B@@@@@BA@@@A@@@@BB@@B@@@@@BA@@@A@@@@@@B@@A@@B@@@@A@@@@@@@@B@@@B@@A@@@@@A@@@@@@@@B@@@B@@A@@@@B@@@@B@@@@@@B@@A@@@@B@@@@A@@@@@@@@B@@@@B@@@@@@B@@@@@@@@@B@@BA@BBA@@A@@A@@@@@@@@B@BB@B@@AB@@@B@@D@@@@@@@@BAB@@@@@B@B@@@@B@@@@@AB@B@@@@@B@@@@@@@@A@@@@B@@J@@@@B@@@@@@A@BDAB@@@B@@A@@@A@@B@@D@@B@@@BAB@B@@A@AB@@A@EA@@@BA@@@@@@B@@A@@@@@@@@A@@A@@B@@@@BB@@A@A@@@@B@@AA@@A@@@@BB@A@@@@BA@@B@@@@A@@@@@A@@@@B@@BD@@BB@@A@@B@B@@@@AB@B@@@@A@@@A@@B@@B@@BA@@B@@B@@@@@@BA@@B@@@@@BBB@@@@BD@@@B@@@B@@@@@@B@@BBDBHBBBD@B@@AB@@BB@@AB@B@@@@@B@@@BB@@@B@BBFDBBB@@@DBB@@@BB@@BB@@BBBBBB@@@B@@@@@B@D@@@B@@CH@B@BBH@F@D@BAFABAFAB@BAB@@@L@FBF@DBB@FDF@BBHBFDJBFFHBB@@B@L@F@D@@@XBF@DBB@LFHDB@FDD@DDBBVJZNDDFBBBHDDBFBD@B@D@@@NAH@D@@@HCB@D@FBF@BB@@DB@BB@D@B@B@@ABA@A@@@@B@@@BBB@BB@@BBDB@B@@@B@@@D@BA@@@@B@@B@@@@B@@@@C@CDA@@@@B@@B@@@B@@@@@B@@@@B@@@B@@AB@@@@BBBB@@@@D@B@@@@@@@@B@B@@@BB@@@B@@@BABA@@@A@A@@@AB@@AB@BB@@BB@B@@AD@@@B@@B@BABA@@B@@@BB@@@@AB@BAB@@@@@B@@B@@@@B@B@@@@B@BB@BB@@@DA@B@@@@AB@BBB@B@BBDBD@BBB@D@BBBB@B@DBB@DD@@B@@@@A@@BA@@B@@@BBB@B@@A@@@@@C@@AA@A@@@@BA@@B@B@BBB@DBDBB@@BB@@B@@@@@B@@CBA@@BA@@@@B@@@B@@B@D@@@B@BA@ABA@@BA@@BBFBBB@@@@@B@@ABEDA@A@@@E@A@A@@B@B@@@BD@@B@@@@@BABCB@BA@@@BB@@@BB@B@B@@@@@@B@@@@@BAB@@@B@D@BBDBB@B@@B@@ABAB@@@B@@@B@@B@B@@@B@@@@ABA@AB@@@@@B@B@@@D@B@@@@@@B@F@BAD@B@B@DBHBDBF@@@BB@@@@@B@B@BABADAB@B@B@B@B@DBBBD@B@B@D@FBDBB@@B@B@D@D@B@@@B@@B@BAHAF@B@B@@@B@D@BBBBFBHFJDJ@BDB@BD@BB@@BB@B@@B@PFD@DA@A@@@A@CDCFEJEDCBCDEBA@AB@B@BBBBDBJFHFHDFDBDFFDFDHB@@BB@B@BA@@@A@A@A@AAE@A@C@A@A@CAC@A@A@@@A@ABA@@BAB@B@D@D@BBD@@BB@BD@@BDDD@BBB@FBB@B@F@BBB@B@@@B@B@BBBBB@BBBBDBBBB@BBD@DBB@B@D@B@BAB@B@@ADAFABADCFAD@BBBB@FBR@J@H@DBBB@@BBBBAB@BABEDCDEF@D@D@B@DBDDBHHFBB@@@DADADAJGFCFAPELEFAFADA@@BAB@B@DBB@@@DBD@FBDBD@BBDBDDBDDDBDBF@N@H@BBBDBDAFELK@@BCFGBABGBE@EAC@CAAEIACAA@@BAB@D@DBBBBBFDTXFFFDDBFDJFDDBBHLD@HANCF@BBB@@B@BAHAN@PBH@BBDJHLFRFB@@BDBHHZ`BDDBBDFHDFBFBFFBB@B@FBHAD@B@L@HAJEHGDCD@BACAG@CACBEDC@C@CAKKIIEAECA@OIOICA@C@G@IAECEECIEA@MEGCEGEECEACBCBABBFBFBF@@@HBL@H@D@DA@A@C@EBAB@LEBCBG@ACI@G@E@C@CA@E@EAAAA@AAACECCAACAA@@AA@@BA@@BAB@B@V@JCBA@I@@@M@@@@A@@@@AA@@@@@@@@AB@@AB@@A@A@@@@@@@A@@AB@@A@@@@@AB@@@@@@@@@AA@@@@@@AB@@@@A@@@@@AAA@@@@@A@@@@@A@@@@@@@@@A@@@@@@B@B@DABA@@HE@CBA@A@A@A@@AC@ABIBCBCBC@CBABE@ADE@ADEBC@@@A@@@A@E@E@M@A@@AA@A@A@A@C@E@C@EBC@@@A@A@EAE@@@ABE@C@A@ABADCFA@ABMBMAC@AAA@C@ADM@A@C@@AAECECAC@ABAFAB@JABABCBI@IAEBABADCBAJKBC@EAACEEMAEASAK@I@C@GFMHUBAFK@E@K@C@ADGHEDADCBCJSBABABC@A@AAC@AAA@AAA@CAE@C@ABABA@C@A@AAC@A@E@AAA@EAC@EAA@A@@@AACAA@@AEAC@@@A@G@C@CAA@@AACACC@@@ABCBEDKBMBCB@JGJE@ABAAA@CCEAGCEEAECGACAAAAABAA@@A@AAA@AAA@@@AB@B@@@B@AC@AA@@@@AB@BAA@@@@A@AB@@@@A@@DAAA@@@ABAB@AA@AA@AB@@@@@AB@@@@AA@A@@@@@@A@@A@A@A@@@@A@@B@@AA@A@@@@A@A@@@@@@@A@@AA@@B@@AB@@@@A@@D@@@@A@@@@@A@@@@BCB@@@@@@B@B@@B@@@B@@@B@@A@@AA@@@A@@@@@AB@@@@AA@AA@@@@@@B@@@@@@A@@@A@@@@@@@AB@@@@@B@@@@AB@@@B@@@@@@@A@AA@@@@@AB@@@@A@@@@ABA@@@@@@A@@@@@AA@@@@A@@@A@AB@@AB@BA@@@AA@@A@@@A@@B@@B@@@AB@@@@@@@BA@@@@B@@@@@B@@@@@@A@@@A@@@@@@A@@@@@@@@AB@@@@@@@@A@@@@@@A@@A@@A@@@@A@@@@AA@@CBCBA@CACGAA@ABCBC@CCKIIEUCGEEAG@@@@@IBGFCHGBA@CAA@CBEDC@ADG@AEQ@CM]AACGGMCACACA@A@A@A@ABG@AAACEAE@@BA@A@@@A@@@A@A@@AA@@@C@Q@AAG@C@@@AAC@A@G@@BABADAD@B@D@DADCBCDCJGBE@E@C@GCGAC@C@E@AAA@EAAA@A@EBC@C@CAAA@@AA@CA@AACAAAA@@AAA@A@AAAACCAAAA@E@CAA@@@A@@@@@A@@@A@@@A@@@A@A@A@@BA@@@@@@@A@@BA@@@AAA@A@AAC@CAA@@@A@@@A@@@A@@@AB@@@BABAB@@@B@B@D@@@BA@@BAB@@@B@@@@@BA@A@@@@@A@AA@@AA@@AAA@@@@AA@@@@AAA@A@@@A@@@AA@@@@AA@@@AAAA@AA@@@A@@@A@A@@BAB@B@@@@AB@@@@C@@@A@@AAA@@AA@AAA@@AA@@@@@@AAA@A@AA@@@@AA@@AAAAAA@AA@AA@AA@@A@@A@@@@@ABA@AB@@A@@@@@ABA@@BAB@BA@@B@@@B@@@@B@@@@@@@@@@@@D@B@@@@@@@@A@@@@@A@@@AA@@A@@@@@AA@@E@@@AB@@A@@@@@A@@BA@A@@@A@AB@@ABADAB@@CB@BA@@B@@A@A@A@@@A@@@A@@BA@@@A@@@ABA@A@AB@@A@CBA@@BA@@@A@AB@@AB@@A@@@@@@@A@ACCC@@@AA@@@@@@@G@A@A@@@A@@@A@A@@@AAA@C@A@A@A@C@CBA@AA@@AA@A@@@A@A@A@@AA@@A@A@C@@@A@@@A@@@@AA@@A@@@A@@BA@@@A@@@A@@AA@@A@@@A@@@@A@@@AB@@@@@B@BAB@B@B@@@BA@@AA@AA@A@I@UFEBEFEB@@A@A@CACACAC@A@C@ABIFADAD@BC@ABA@C@CBA@@@A@C@C@ACCCACEEAAEECEECAAC@WCA@CAA@CAC@@B@BADAB@@@BAD@D@@@BA@CB@@CBEBA@AB@@A@CAA@@B@@ABA@C@AB@@ADAB@B@BB@BBD@@@BB@@@B@@BB@@B@BB@@BBBDBD@BBB@@@BAB@B@@AB@@A@@@AF@@@@CB@BA@@B@BA@@BC@EB@@ABABA@@@A@EAAAA@C@@@A@@@@A@A@C@A@AAA@AAA@@@@A@@@ABA@C@A@A@CCA@@AA@E@A@@BA@@B@B@B@@ABCDAB@@A@@ACA@@A@@B@@@DBD@BB@AB@@@B@@BD@@@BA@@BA@AB@@A@CBA@@@@@@BB@@B@@@@AB@B@BBB@B@@A@ABA@@B@@AD@@AB@BA@AB@D@B@B@@@BB@@@DBBBBBB@D@BA@B@@B@@B@B@B@D@@@B@@BBDB@@@B@B@@AB@B@B@B@BADAB@@@D@@@@ABAB@B@B@BBB@B@BBB@@@BAD@@@BBD@B@BB@BDB@BB@B@B@B@BBB@@@B@B@B@B@@B@DBBBBB@@@@@B@@@F@@A@AB@BA@@B@@@B@@@D@@@B@@B@@@B@B@B@@@BA@BB@@@@B@D@@B@BBB@@@BAB@B@@B@@@@@@CBA@C@@@@B@@@@B@B@B@B@DBD@@@BD@DBB@@BBBBBBBBFB@@BBB@BB@@@B@@@B@@CBEDCBA@ABA@@B@B@B@@@B@@@@@BA@C@AA@BA@@@BD@BB@BD@D@@@@ABABCDAB@BCBA@AB@@C@@@@B@B@B@@@@BBBB@B@B@D@@@BAB@B@@A@ACAAAA@@A@AA@@A@@@@@ABAB@@@@DB@B@BBD@@A@BD@@@@BB@@@B@@@BA@@@@B@BB@@BB@DB@@@@@B@@A@A@@@@B@B@@@B@@@B@@@B@B@@@@A@@@AA@@A@E@A@@@A@@@@A@A@CBA@@AAA@@@A@@@A@@AAAA@A@AA@@AA@A@@BC@@C@@@AAA@@@A@@@@BBB@@@@ABAAA@@@@@@B@@@@@@BBB@BBBBA@@@AA@@@@AB@@@B@BB@@B@BBBBB@@@D@@B@@BB@@@@B@@@@@@A@AB@@AB@@@B@B@F@B@B@@@B@@@BB@DBB@BBB@@BA@@B@BBB@D@@BB@@BAB@@@@@@B@@D@B@@B@@@B@BA@AB@@A@@BA@@@@B@@CBA@@@@BAB@@AB@@ABC@@BA@@BA@A@@@@@@BBB@@@B@@C@@@A@@@A@@@AB@@@@CA@AA@@@@@A@@DAD@@@B@B@BDBBB@B@@AB@@@@@BD@@@CB@@@@@@@B@B@BAD@B@D@@BB@@@@A@@@@B@@DD@@@B@B@@@B@BA@CBA@A@@@ADAB@B@H@@@@A@CA@@A@A@@@A@@A@@BC@@@@@AC@@@AAAA@@A@CAE@CAA@AB@@A@@B@@@@A@@A@@A@@AA@@@@@BA@AC@@A@@@@@AA@@@@@@B@BA@@@@@A@@@C@@@@@AB@@AAC@@@A@AB@BA@A@A@C@@@C@@@A@@B@@@BABAB@B@BA@A@A@@@@B@B@BA@@@AB@@@D@@@BABCB@@@B@@@BBBBB@B@@@B@B@@@BB@BBDAFB@@@B@B@BBD@BAD@B@B@@@B@@@@@@@B@@@@@B@@@@@@ABA@@@@B@@AA@@@A@@@@AB@@@@@@@@@A@@@AC@@@A@A@@BAB@BAB@@BB@@@@BAB@BF@@A@@@@B@@@@D@@@B@@@@BA@@DBB@@B@@BDD@B@BA@@@@@A@@@@@@B@A@@@@@@A@@@A@@@A@@@AA@AA@A@A@AB@@A@@DA@@A@@A@@@@@@BA@@BA@@@A@@@@@@@@B@@@BA@A@A@@@@A@@A@A@AA@@A@@AA@A@AB@@AB@@A@@@@A@@A@ABAB@@AB@@A@@@@@A@@@A@@AA@@B@@@@@@BBBB@@@@@B@B@@A@@@@@@B@B@@@B@B@@@BA@ABAB@@CAAA@A@@@@A@@A@@@@@@BA@@@@@AA@A@@BA@AA@@@@@AC@@@@@AA@@AB@@@C@@@@ABA@@BA@@@@@AB@@A@@@@@CBABA@@@@@AAA@A@A@C@C@@AGGACCAAA@AAC@A@AAA@@@A@A@AB@@A@A@A@A@@A@AAA@EAAA@@@A@@AABEAA@C@ABA@@@A@G@CCECC@AAC@A@A@AB@@@BAFA@@@ABA@ABEAA@A@A@E@ABC@AA@C@@@AB@@BBA@@@@@A@@B@@@@@@"],encodeOffsets:[[118193,32797]]}},{type:"Feature",id:"411526",properties:{name:"潢川县",cp:[115.051908,32.131522],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABC@E@A@@AA@A@@@@AA@@@@@A@@@@@@B@@ABA@AB@@A@@@AB@BA@@@@B@BA@BD@@AB@BCDABC@@B@@CFADAD@BC@AB@B@BA@@@A@@@@BADAFAB@B@@@B@@@BABABA@C@C@@@A@@B@@ABABA@@B@B@@@B@B@@@BA@ABA@A@C@@@A@@B@D@@A@@B@BAD@@@B@@@BA@A@ACA@@@@@BF@@@@@@AB@B@BA@@@C@AAA@@@@AACAAAA@AAAA@AACAA@@A@@E@AAC@A@@@EFABABA@AB@@AD@@C@A@A@@@A@A@@@A@@AA@@@A@@B@@AB@BADA@@@A@A@A@ABAB@@A@A@AB@@CB@B@@@@A@@BCBAB@@@@@D@@ABA@AB@@@BA@@D@@A@@BAB@BABAB@@@@A@@AA@A@A@@@AB@@@@@B@@A@A@@@@@@BB@@@@@@@@@AB@@@@@@@A@@@@A@@@@BA@@@@BB@@@@B@@B@@@A@AB@@A@@AA@@@@BA@@@A@@@ABA@BB@@@BB@@B@@ADB@@B@@@@@@CB@@@@@B@D@@@B@D@B@DBB@BBH@F@BBDBBBBBBB@@B@D@D@BBB@BB@BB@@@B@B@B@@@BBB@BDD@@A@AACD@@A@AB@BA@A@A@@@CAE@@@A@@AABEA@@AB@BC@@B@B@@@@A@@@AAA@@@@@@BADBB@B@D@@@@DDB@D@@BB@BBBDB@@B@BAD@@A@AB@BAB@@@@CBEB@@A@@@@B@B@@B@@@@BA@@@@BBB@@B@D@BAD@J@B@H@B@@@B@BBBBB@@BBD@@@B@B@BAD@B@B@B@BAB@@CFCF@@@B@B@@BBBBDFDDDBDBDDB@@@BB@B@D@H@D@@A@@@A@@AE@A@A@A@@@EBAAABC@@AA@@@@@A@@A@@@@A@@@@@AA@@@A@@A@A@@B@@A@@A@@@AAB@@AAA@@@@A@@A@@@@@@B@@A@@AA@@@@@A@A@@B@@AB@AA@A@A@A@@@@A@@A@A@@@@B@@A@@@A@@@A@@AA@@A@@@@BB@@BA@AA@@@@@AA@@AB@@@BA@ABABA@@@@@@@A@@@A@AA@@@B@@A@BBA@@@@@A@@@@BB@@@@@A@@@@@@BA@@@@@A@@@A@@@@@@B@@@B@@@@AB@@AB@@A@@@A@@@ABA@@@@@AB@@@@A@@@@BBB@BAB@@A@@@@B@@@@@B@@@@B@@@@@@BA@@@A@@BA@@BB@B@@B@@@@@@@B@@@B@@AB@@A@C@@@@B@@A@@B@@@B@@@@@B@BA@@B@@A@A@@A@BAA@@@@@@@@A@@@AB@@@B@B@@A@@@A@@@@@AB@@@B@@A@@@AB@@@B@@@@A@@@@BABBB@@@@A@@@AB@@@B@BA@@@A@AB@@@@AB@@@@C@@@@@@B@@@@@B@B@B@B@@@B@B@@A@@@A@@BA@@B@@ADCD@DAB@BCF@@ABA@@B@B@@@B@B@@AB@@@@@B@@@@@B@@@@@@AB@@A@@@@BAA@B@@A@@B@@@@@@A@@@A@AA@B@@@@@B@@A@A@@@@BC@@@@B@@@B@@B@B@@@@@@B@@@B@B@BA@@@A@@BA@BBB@@@@B@@A@A@A@@B@@@@@@@BBBB@B@BB@@@B@BB@@@@@DB@@@@BB@@@B@@@BA@@@A@@@A@@B@@@BBB@@@@B@@@B@@@@@@@B@@BBD@@@@DB@@@B@BAF@@@@D@@BB@@@@BA@@B@@@@@BDB@@@@@@@B@@A@@@A@A@@@@B@@@BDB@BB@B@@@@@AB@BA@@@CA@@A@A@A@AB@B@@@B@@@BA@@@AA@@AB@@@@@B@@@@DBB@@@@B@@@@A@A@@@A@@B@@BBBBBB@@@B@@AB@@@@@B@@B@B@B@B@@@BB@@AB@@AB@@ABB@@@B@B@BAD@@AB@BBBA@@B@@B@@@B@@B@B@@@B@@@BA@@@AAA@A@@@A@@B@BB@BBB@@@DBB@D@@@@DDB@@@B@@@DABA@AB@B@@@BBB@@@@@D@D@@@BB@@@B@@CD@@@B@@B@B@B@@@@BB@D@@@DBB@B@B@DBB@D@BBD@@BB@@B@@@BA@A@@B@@@@@B@@BBBB@@@B@B@BB@@@DB@@BB@@@B@@BBBDBB@BFDBDBHBFBB@@BBB@B@BBB@BAB@HABADCDA@@B@BBFBB@FDFDB@BBBBFDB@@BBDBB@@BDDJBB@@BDBFB@BDFBDBNHFDB@DBDBB@BBBD@@@B@@@B@DAFABABCBA@EB@BA@@@@B@BB@BD@BBBDDBDBBB@@BD@B@D@B@B@LCD@B@PCDAF@B@D@D@BBB@BBDFJFFFDFBBBDBD@BBD@D@@BD@L@D@F@HADBB@B@@BBDBLDD@D@DBB@BBBBBBDFHLBDBB@B@F@B@B@BCD@DAB@B@@BB@B@@DBB@JBF@BAB@BAJGBABABC@CBCB@BA@AB@B@B@N@FBD@D@@@FAFAD@FCBAFCBAB@B@B@B@BBFBFDD@BBB@D@B@B@@ADAFEDEBABABCBCBA@C@CAA@CBA@ABA@@@@BAB@@@B@B@@BB@FFFDDBBBD@DBD@B@B@BA@@B@BAFCBABABABADCB@BAB@B@B@BBB@BBBBBB@B@B@B@@@BABABABED@B@B@B@BBD@BDDBBDBB@FBH@B@D@RCJADAD@B@B@D@DBB@B@BDDBNH@@@@B@@@BKBEBA@ABAB@BAFG@AC@BA@AB@B@B@B@@E@@DA@AB@BAB@@B@AD@@@B@D@@ABA@CB@@A@@@A@@BA@@@A@@@@@@AA@ABC@ABA@E@C@@@@@AAAA@@@@A@AA@@@@@@A@@@@BAB@@C@AA@AAAA@@@@@AA@@AA@@AA@@ABEC@@@@E@C@@@AA@@AA@@CD@@A@@@@@@@A@@@A@@@AA@@@@BA@@GAAAG@CA@@C@A@@B@@A@A@@H@@@@@@@@AA@@AB@@@@@BAB@@@@@AA@A@@@ABBBA@@@CB@@AD@BCAADK@A@@D@F@H@R@D@D@N@B@B@HBB@B@BA@@BCB@@ABABAD@DBD@B@NCB@FAD@AAEGAECIAEAG@ACE@EAA@CAE@E@K@@BA@ABABEBABE@A@C@EAG@A@ADG@@@A@@@C@A@@@@@A@@AAAAAA@@AA@@AA@@A@CA@@A@AAECAAA@@@A@@A@@@A@@@@@ABA@@AA@@BA@@@AACAAAGAC@AA@@@@@@@@A@@@A@@AC@@@@AA@A@@@@@AB@@A@@@@A@@@@AB@@AA@@@@AB@@@B@@@@@@ABA@@@@@A@AB@@@AA@@AC@@@A@@B@@@@@B@@@@@@AB@@A@@B@A@@A@@B@@@BB@@@A@@B@B@@@AA@@@@@AB@@@@B@@@@@@B@@@@A@@@@B@@A@@FBB@@@BAB@@@@ABA@A@@@AC@@@@AB@@@B@@@@A@@BAACB@@@@@@@@A@@I@@@@A@@B@@@@@@@@@@A@@@@@ABA@@@@A@@@@@@A@A@@@@BA@A@@@@@@C@@@@A@@BA@@@AAAA@@@@@@@@@@BB@@@AB@AAB@@@A@@@@@@@@@A@@@@A@@@@@@A@@@@@@B@@@@@@A@@B@@@@A@@@@A@@@@@@A@@B@@@@A@@@A@@@@@@B@@@@@B@@@@A@@@A@@@@@@B@@@@@@AB@@@@A@@@@B@@@B@@A@@@@@AA@@A@@B@@@B@@A@@@@@A@A@@@@@@B@@A@@@@@@@A@@B@@@@A@@@@@@A@@@@A@@@@@AB@@@@@@@@@@@@A@@B@@@@@B@@@@@@@BA@@@@@@AA@@@@@@@@@A@@B@AA@@B@@@@A@@A@@@@A@@@@BA@@@@@@@A@@BBB@@@@A@@@A@@@@B@@@@@@@@A@@@@@AAA@@@@@@@A@@BA@@@@@@@@@@B@@@A@@@@AA@@@@@AAB@@@@A@@@AA@@A@@@@@@@@@@A@@@@@AA@@@@@@A@@AB@@A@@A@@@BA@@@@@A@A@AA@@ABA@@@AAA@@A@@AB@BAE@CA@@@E@C@@C@CBC@EAADA@@BABAAA@@@C@CAA@@@AB@B@B@@C@A@C@@@AB@@A@@@A@@@CEAG@A@@CAAADACAAA@ACBA@C@@@@A@AA@@@@A@@A@A@@@@@@@AB@@C@C@A@@DA@@A@@@BC@@BB@D@@BABA@@@A@@@AA@AA@@@@A@A@@@@@A@@AACAECAAA@@@@AAA@@@A@@@A@@AA@@B@@A@@@@AA@@@@@A@@@@@@B@BB@@@@@A@A@@@C@A@@AB@@@AA@@@BA@@@A@A@AB@@@@@AA@@@@@A@@@A@@@AA@@@@A@@A@@@@A@@@@B@B@@@@AA@@@@@@@A@@@A@@@@@BA@@B@@@@@AA@@@@@@AA@@@A@@@@B@@@@@BABA@@@AA@@AA@@@A@@@BA@@@@@@@@A@@@A@@@@@@@AA@@@@@A@@AC@@A@BA@@@@A@@@@A@@@@@A@@@AA@A@CA@@@@BA@@@A@@@@AA@@B@@A@@A@AA@@@@@@B@@AA@A@@A@@@@@@B@@@B@@A@@@@@A@@B@@@A@@A@@@@@A@@@@@A@@@AA@A@@@@@B@@A@A@@B@@@@A@@B@@@@@@ABAA@@@@AA@@@@A@@@@A@@@A@@A@@B@@@B@@A@@@@@@BA@@@@@@BA@@@@BA@@@@@@AAA@@@@A@BC@@CACAA@@B@@A@@A@@@@@@A@@@@@AA@@@B@@@@AB@@@@@@AAA@@@AB@@@@A@@B@@@@A@@@@@@@A@@B@@@@@@AB@@@@A@@@@A@@AAB@BC@CAA@@@@A@@@@@A@AB@@AAABA@C@A@@@AAC@@@@A@A@C@@@@@@AAC@@A@@@@@@A@@B@@A@@@@BCB@DC@@BA@@BA@AD@B@BAB@DC@@@@@A@@A@@ACA@@AC@A@AA@@@A@A@A@@@@@A@@@@BA@@@@@A@@@AAA@AAA@@AA@@@AA@@AA@A@@@AA@A@@@@A@@AA@@AAC@C@@AAA@@@@@ACAAA@@@A@AAA@@A@G@A@@@@@AA@@AA@@A@@BA@@B@@A@@@C@@@@@@@@A@ACBA@@CAAAAEA@@A@@@@@AA@A@@@A@@@E@A@CB@@AB@@@@C@@FE@AB@@A@@@@AAA@@@CAA@ABA@A@A@A@GBA@CBC@A@@@@B@B@DA@AD@BA@@D@@@BA@ABA@@@CB@@A@@B@@A@@BA@@BA@BBB@@BFBABAACB@B@@BBA@B@@@DF@@@B@@@B@@DB@BC@@BA@@@ABA@@B@A@@A@AA@@@@AA@@@A@CA@@AA@A@A@@@@B@BAB@@A@@@@A@@A@A@ABC@A@A@@@AACAAAAAC@@BC@@BA@@@A@@@@@AB@@@B@@A@C@A@@@AAAC@@@@B@@@B@@@B@BAB@@@@A@@@A@@@@A@@A@@@@AA@BA@@@@@A@ABCB@@AC@@C@@@@AABAC@@@@A@CBA@@BA@@@ABA@@@@B@@BB@@@B@@@BA@ADA@B@@BB@@B@@AB@@AD@@@@@@CACA@@AACB@AA@@@@A@A@AAA@@@@CAA@AACB@@C@AAA@@AAE@@AC@@A@CAA@AA@A@@@A@A@A@@@AAC@@@A@C@A@@@@A@A@AA@A@@@A@AAAAA@@@A@@@AB@@@BAD@@@@@@AB@AA@@A@AAA@@AA@AACBA@AA@@AAA@A@A@@@@@@BAB@@ABCB@@A@"],encodeOffsets:[[117890,32637]]}},{type:"Feature",id:"411524",properties:{name:"商城县",cp:[115.406862,31.798377],childNum:1},geometry:{type:"Polygon",coordinates:["@@GAICC@C@OFIBA@A@GIEGCGGEA@@@ICGEMAGBCBGLEJIFGBGBA@EAICGIGECAEACBABMFABBBBDPTBH@FCFA@ILAB@@C@E@EBGDGBEBGAIAC@CBAFENCDCDADAHBJ@HDF@@DHDBBJ@D@D@LAJCFCH@@AB@F@BDDHLBD@B@F@BAF@D@@@D@@BD@DDDFFBHFLDFBD@BAF@DBF@BDHFD@B@@@BA@A@ABCAIAMAG@EBADCJADA@A@EAC@G@C@EBAB@B@DBD@BABA@GBEDGF@@ADCL@J@J@D@DCDAB@@@@DB@@@B@@@B@@@@B@B@@B@D@@BBB@@B@@@BAB@@AD@@ED@@@@AB@@@D@@BBBBBDDB@@BBAB@BBF@B@@@BBBB@@B@@AB@@@BBBDB@B@@@B@@BDBBB@DBHB@@B@D@D@B@BBDF@@BD@BAB@B@@@@DD@@@B@@ABA@@@@B@@@B@D@@@BBB@@D@B@@BDBBB@@@D@@@@BBBB@@@B@D@D@@@BA@ABED@@C@A@CA@@EDAB@@@@CAC@CAC@AB@@@@@B@@ABABA@@@A@@@AA@AEAAA@@AA@@C@AB@@@BAB@@@@C@@@A@A@A@CB@@AAA@@@@@C@@B@@@@B@B@@BB@@B@B@@@@AB@@A@AAA@@@@BA@@FABAD@B@BBB@B@B@B@B@@FD@B@@@BAD@B@@BDB@AB@B@@@B@BBB@BB@AD@@BBDB@@BB@DBD@@BB@@B@D@BB@@BBDB@@@B@BBB@@@BBBBBBBBB@@@B@BB@BB@@BB@BB@@@@B@B@@@BA@ABC@A@@@A@@@@BBD@B@@ADABA@@B@D@BABA@@F@@A@AB@@A@@@@B@@@B@@@BA@@BA@A@A@A@@BA@ABABA@A@A@A@@@A@ABA@@BCBA@C@@@CAA@@@AB@B@@ABA@A@ABABA@A@BBBBB@@BB@@@CF@@@B@B@B@F@@A@@@A@A@CAA@A@ABA@@AAB@@@@A@@@AB@F@BA@@BA@ABA@@@AA@@@A@@A@@ACB@@@@A@@B@@BB@BB@B@@@@B@@CD@BA@@@A@C@@AAAAA@@A@A@@BA@@BAD@@@B@@@@BB@B@@BD@B@D@@@@AB@@BB@BA@@BA@ABAB@@AB@BBD@D@@@B@@B@HBB@B@B@B@B@@B@@@@@B@BAB@BAB@D@B@D@@AB@@B@DDBB@@BB@B@@@BAD@@CFA@BH@@@@@BD@@@B@@B@@@F@@BD@B@B@@AD@@@B@@B@@@@BB@B@BA@@BB@@B@@B@@AFAF@B@@BBFADBBBBBDB@BBBB@@BB@BBB@@@BBA@@B@@@@C@AB@@A@@B@@@B@@BBBB@B@B@@@BBBB@B@@@@@@B@D@B@@BD@B@@@B@B@B@@@BBBB@DBB@@@BD@@BF@BB@BBD@@@DABBB@DB@@@@BB@B@B@B@@B@@BDABB@@DBDB@@@@@@BC@@BA@@@AA@@AA@B@BCB@@A@@@A@@AA@@@A@@B@BA@@B@@AB@DAB@@@@@BDBA@B@@D@@@BD@@DABAB@@@@@B@@ABB@@@@@BB@@@@@@B@@@B@@A@ABA@@@A@@@A@@@@@BDBB@@B@D@B@@@@A@@BA@@@@B@@@B@@AD@@AD@BBBBDBBB@@B@B@D@BAB@B@@@@B@@B@@@BA@A@A@@B@B@B@@BB@@D@B@@BB@@@@BBB@@@@B@AB@BA@@B@@AD@@ACA@@@A@@@A@@CE@@A@B@AA@@@ADABBBAEA@AA@AAB@@AB@@AB@@@@AB@@@DA@@B@BAB@@A@@@CB@@ABCB@@C@A@A@@B@D@DAB@HAB@B@B@B@BAB@DB@@B@BB@@@@@BA@@BEF@@@D@@A@@BA@@D@B@F@@@B@@@BBB@@@@B@@@FBBBBB@DB@DA@B@B@@@@@@D@@@B@@@@AB@@AB@@@BB@@BB@@@@B@H@B@@@BB@B@B@@BBDB@B@@@@BB@BD@D@BB@@BB@@@B@@B@B@@B@@@BBB@@BB@@B@@BB@BBB@BB@@B@@@@@B@@A@@B@@@@@B@B@B@@@B@@B@BBD@@DB@BB@@@@B@@@@CDA@ABA@C@@BAB@@AB@@CDA@AD@@@@@BA@@@@B@@@@B@@@BD@B@@@@D@B@B@@@@@BD@B@@@B@DABBB@BA@@B@B@@@@@B@@B@DBD@@ABA@BB@@@@@@B@@A@@B@@@@A@@@@B@@@@@@@B@@A@@@@B@@A@@B@@BB@B@@@@A@@B@@A@@@B@@B@@@@@B@@@@B@@@@BA@@@BBBD@DD@@A@B@@B@BB@@@@@@AB@@@@AB@@@@@@AB@@@@@@@BA@@@A@@@@BB@@@B@@@@@@B@@B@@B@@B@AB@B@@@@A@@@@B@@A@@@@B@BA@@@@@B@B@@B@@@B@@@@@B@@@@@BB@@@A@@@@B@@@@@BA@@@A@@@@@@@@BB@B@@BA@@@@@@@BBB@@@@BA@@@BB@@@@@B@@AB@@@@DBB@B@@B@@@B@@@@@B@@B@@@@@ABB@@@BD@@@B@@@@BB@@@@@@B@@@B@@@@@@@@@AB@@B@@@B@@BB@@B@@ABAB@@@@A@@@@@@B@@BB@@@@@@BB@@@@A@@@AB@@@@B@@@B@@@@@@@B@@B@@A@A@@@@@@B@@B@@@@B@@B@@B@@@B@@@B@@@@BB@@@@A@@B@B@B@@AB@@B@@B@@BA@@@B@D@@@B@B@@@@AAA@@@@@@@@B@@@@BB@@@@@BA@@@BB@@@B@@@B@@BB@B@@B@BBFDDBBB@@@B@@@@@B@B@@B@@BBB@@B@@@B@BA@AC@A@@AD@@A@@@BB@@CB@D@D@@@BA@@@@@@B@B@@@@B@@B@@B@B@@D@B@DA@BBBDBCBBBDB@@@BBHDF@@B@@@B@@@BA@@D@B@D@@@@A@ABA@@B@DBD@@@B@BBBA@AB@BCFBD@DAD@@@@D@F@@DBF@ABA@@BB@BA@@BA@@@@B@@@BD@@B@@@B@@DBB@@BBB@B@@@B@@AAG@A@@FC@A@@BC@@ACAA@@@@D@@@B@@@BA@@@@BA@@@@AA@AA@C@@A@@B@D@D@B@BA@@@A@@B@B@B@@@@@AA@@@A@ABA@B@@BB@@@ABA@@DA@@D@D@@A@@CABABB@@@@@@AA@@@A@AB@BCB@@@@A@@DCBAB@@@B@BB@B@@@B@@@@@BBB@@BBB@@@B@@@@@@@B@@B@@BBB@@@BAA@BA@@AA@@@@B@B@@@DBBBB@@@@@@@@AB@@@@@B@AA@@BA@@D@B@@BAD@B@F@B@BBBAF@BAB@B@@EBAB@@A@@B@B@BBD@BDDDF@D@H@B@@AB@B@DBBAFBB@@@B@@BBFBB@BBB@@@@B@B@B@BA@@B@B@B@@BB@B@BBD@BBBDBBDHH@BD@D@B@B@B@BB@@@@B@BADA@@@@B@@@BA@@@@B@@AB@BA@@@@@D@@BA@@BB@@@@D@@B@@@@BBB@@AB@B@@B@@@@AB@@@@@@@BB@@@@@@BBBDB@@BABAB@@A@@@A@A@@@A@A@@@@B@@@@A@A@@@@AAAA@@@@@@@AB@@BB@@@B@@@@@B@@@BA@@BABAB@@@@B@@B@@@BA@@BAB@B@@BB@@@BBB@B@@@@B@@B@B@B@@A@@@A@@@@@@B@@@B@@AB@@A@@@@B@@@@BB@@CB@@@BAB@B@B@@BBB@@B@@@B@@@B@@@@@@@@B@A@@@@B@@@@@B@@A@ACC@AA@@@AA@CB@@A@@A@@@C@@@@@@A@@B@@@AEA@AB@@@@AA@@BA@ABA@AB@B@@@D@@B@@@B@@@@@@@@BA@@@@@B@@BB@@@A@@B@BA@@@@@@@A@@@@@A@@@@@@@A@@@A@ABC@AAC@A@A@A@@EACBAAA@@A@@@A@A@@@AAAAA@A@@@A@@DABA@A@@@C@@BA@@B@@A@A@A@@B@B@B@@A@ABABA@A@@@AB@@@D@@@D@B@B@B@@ABAB@@@D@BB@@BA@@@@D@@@B@@@@@B@@A@A@@@@B@@B@@@@@BD@@BAB@@@@B@@BB@@@@BB@@@@@@AB@@@BAB@DBF@DBB@@@BBBB@@D@@B@@@@AD@@@BB@@@B@B@@@DBB@@@@@@G@ABABC@@B@B@DAB@@A@A@@@A@A@@CC@@@A@@B@@@@@AA@@@C@ABC@A@A@A@@@@@@DA@@C@@A@@@@BA@@@AAACA@A@A@A@@BC@CB@@@@@B@@BDB@@@@BA@@B@@@B@@@D@@@@A@@AA@A@@@@B@B@@AB@@AD@BA@@BA@@BA@A@@B@DA@@@A@@B@@AB@@@BAB@@A@A@@@AA@C@@@@A@@@@A@AB@@AA@@@CAA@A@AB@@AA@AAA@CAA@@A@@@A@@@A@A@E@A@A@@BA@@BAB@@@@@@@@A@@A@@AA@@@@C@@AAAA@A@AA@@A@A@@BA@@@@BB@@B@AAAAA@AA@@@@@@@A@@@@B@BBBA@@@@AA@A@@B@@@B@BB@@D@@@AD@@@BBB@@BBB@B@BB@BB@@@B@@@B@BB@@AB@D@B@B@@B@@@B@F@B@@@BB@@B@@@@@@A@A@@@A@@@A@@@A@A@@B@B@@@@A@@@@CAA@@AA@@A@A@@B@@A@@@A@@AA@@@@ACB@@@AC@A@ACA@@@@BABA@@@@B@@@BBB@@@BBBBBDB@@@@ABA@A@@@C@A@AAAAA@@@@@A@A@A@@D@@@BAB@DA@ABADCBABA@@@@@CACA@@AAC@@B@@ABBD@B@@A@@@@@A@@@A@A@AB@BAB@DAFCDA@@@A@@@A@@AAA@AA@@EAAAAAAAAA@@AA@CAC@@C@CAA@A@A@A@@@@@@A@@D@B@DA@@@@@@@AA@A@AB@@A@AAA@@@@C@A@@A@@AAB@@A@A@A@@@A@@@@A@@@C@@@A@@@AB@@ABAB@@@@E@@@A@@@@AAAACAA@@@@A@A@A@A@@AA@A@A@A@AAAA@ACA@@A@AAC@A@@BC@A@@AA@A@AAA@A@A@ABABA@@@@@C@@BABC@A@A@A@ABA@@@A@A@@CAAA@@@A@@@C@A@A@AA@@@@AABC@A@AAAACA@@A@@A@@@A@A@CBAB@@ABA@@BC@@@AB@BAB@@@@AAA@A@ABA@@@@@AA@@A@@@@B@DAB@@@BAB@@AB@@A@@AC@@@A@@BAA@@AAC@C@@@AB@@@DB@BB@@@BADCBA@@@A@A@AB@@AB@F@B@@BB@DDB@B@D@B@BA@@B@@@@@BB@BBB@B@B@D@B@B@@B@@@D@B@BBFBB@@@B@BABA@@FAD@@AB@@A@AB@@ADA@@@@BE@@B@@@BA@@@ABA@A@@AA@AACACAA@@AAA@@@AA@@@A@@AA@@C@AAA@@A@ABABC@@BAD@B@BA@@@AB@DBB@@@BAB@FADA@@DAB@@A@@@CBC@A@@BABC@A@A@@AAA@@AEG@AAAGECAEEGEICKEICE@@@AACCACECGCEACA@A@CACCCACEAGAEAG@A@C@CCCAEEAA@AAC@AACCCE@C@MDEBE@CBC@@@CAGCECEEEICEECMEICGCAAAEAEEIA@EAA@@A@A@C@A@G@CCCCAEACC@A@ABA@AHGHKHKBC@@@IAECGACCAEAEAG@EAECKGEAACCAMCGACAECCCAC@A@C@GA@@AGCAAAACICC@C@A@A@ADCBCBAAAAAAEAC@E@AACCIEKCCEKCAIGECICICAAAACCACCACCGAOECCGK@A@A@C@ABADA@A@@@AACAABA@EDCBGBC@C@@VaDE@A@ACAAAAAAE@E@MAE@G@EBCAAACGEEEACCIGAE@EC"],encodeOffsets:[[118133,32157]]}},{type:"Feature",id:"411528",properties:{name:"息县",cp:[114.740456,32.342792],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AAA@A@@@A@A@@BAB@@A@@@AAA@@@@@@@@@@A@@A@@AA@@@@@CBA@@@@@@AB@@@@AA@AA@@@@A@A@@@A@@A@@@A@@@@A@@@@BA@@@A@C@A@@@ABABA@@@@@@@@A@@@@AA@@A@@@A@@@ABAA@@A@@@A@@@A@A@@BEBABADA@@BA@IDA@@B@@AB@BA@@@ABA@G@A@A@@@@BA@@B@@A@@@@@A@@@ABA@@@@@ABABABABADA@@BA@@@@A@@A@@@@BA@@@A@A@A@AB@@AB@@@B@D@@@BB@@@D@@@@BB@AD@@@@ABA@@B@@@BA@A@@A@@C@@C@@@@@AEB@@AAA@A@@@@@@BAB@BCBAB@BABAD@@CFA@CA@B@B@@@@A@@@@B@@A@A@@@@B@@@@BB@@@@A@@B@@B@@B@@A@A@@DA@ABC@C@@@@A@@@@@@A@@@@@@@@@@@@A@@@A@A@A@@@AA@@@ABA@@@A@@@@@@B@B@AAAA@@@A@@@@@@AB@@A@@A@@B@A@@AA@@@@A@@@@A@@@@A@@@@@@@A@@AA@@@@@@BA@@B@B@@@AA@@A@@A@@@@@A@@B@@@D@@@@A@@@AA@@@@@@@BA@@AC@@@CB@B@B@@@@A@@B@FB@@D@@@@A@C@@BA@@@@AA@@B@@A@@@@@@@C@A@@@@B@@GB@@A@@@C@C@A@E@A@E@G@@@AD@B@B@@@@BD@B@BBB@@@BHDBBB@FBB@B@BBBB@DBDBFBD@@BBBDDBBBDBB@FBB@@@BB@B@B@B@BADAD@BA@ABCBIDABA@A@@@A@AB@B@B@B@BBF@BB@FJ@B@B@D@D@@@BCBCBCBEB@BA@@@@BAB@@@BB@DFBBDBB@DBJD@@B@BBBBBH@DBD@D@H@B@@@BA@@@AACAA@ACAAAACCCAGA@@CAGGCAAAA@G@CAE@A@C@@BA@@BA@@BBD@BBBFDDD@B@H@B@BBB@BFFDBBD@BBB@B@BABAB@BABABABA@ABA@CAC@AAEEECAAC@G@C@A@E@ABEBCBCBCD@@EBABCBABABA@A@@@A@@@A@AAAACEA@AAAACCCCCE@AC@A@A@CB@@CDA@CBABADA@ABCBA@A@A@A@A@@AC@CAA@A@A@ABA@CBCBE@CD@@A@A@ABA@@@@B@@@B@@@BCB@@AB@@C@@B@@D@@BD@ADD@@@BB@@@BD@@@@BB@@@D@@BB@@@@@@AFBABB@@AB@@AD@@BB@@@B@HB@@@@@B@@@DB@@@@BB@@B@@@@BB@BBB@@F@F@@JFAAJAB@BA@@HA@BD@A@@@BDCB@D@@@D@@B@@@DD@@BD@H@B@B@@B@@@@@H@@A@@@AD@@AD@@@BA@A@A@@BB@@@@B@@@BB@@FBD@BB@@@BBDBB@@@@A@AD@@@@BB@@@@@@@D@@A@B@BB@@B@@B@D@@BD@@AB@@@BB@@@@BB@B@@B@D@FBB@@@B@@B@@AB@B@@@B@BAB@B@DBBBB@B@@AB@@CBA@AB@@CDCDABABA@ABA@AAA@ABCBAB@BE@E@EBAAAA@@CB@@A@@@@@@B@B@@BBB@@@@BAB@@A@ABABA@ABA@A@@@A@A@@BAB@@@@A@A@A@A@A@@@AB@@AB@@@B@B@D@BBB@@A@@BCD@@@@A@A@EB@BC@@@A@@B@@@@A@@@AA@@@A@BA@@@A@A@@@@@ABA@C@@@AB@@A@AAA@@@A@@@A@@BAB@B@@@@AB@@@@@D@B@BA@A@@@@B@BAAAB@@@@@B@@@@@@@BBB@@@@@@B@B@@@BBB@B@@B@@@B@D@@@@@B@DB@@D@BD@BA@@D@B@B@BH@@DFD@@B@@@@@F@@@@BDEBA@@B@@@@@BC@A@A@@DA@@DC@@AC@@BBB@@@B@@CA@DA@@BA@A@@DBA@B@@ABA@@BBB@@@BBB@B@@@BF@@B@NDB@DABC@@LC@C@@@@BA@@BBB@B@D@FBD@F@@LB@@B@@B@@@BB@@BB@@BB@T@D@@B@@B@@BB@@AD@@ABA@@H@@@@@D@FBB@B@BA@@ACNAD@D@B@BBADB@BB@BD@D@FA@B@@@BB@F@@A@@B@FBB@@H@@@BB@@D@@@DBD@@@@BA@@D@@@B@@@@B@@D@@AJ@B@LBDBB@@BDB@AD@@@@@F@@C@@B@AEBB@A@AF@@AC@AGB@@DB@AIB@AC@@TD@@@BBB@BB@@@@A@@DBD@@CF@@A@@B@@@B@@@B@HB@ABBRD@@@DB@@DA@CF@BA@@JB@@B@BABB@B@@B@D@FB@@B@@@AJ@ABB@@@B@@DD@B@@@AB@B@@@B@@BAB@@@@B@@BB@@B@B@DDD@B@@@@@@@BC@A@@@@B@DGA@BEBC@@@AAABA@A@I@ABGBE@@BB@@F@@AAA@A@AACB@@@@@@BB@D@BAD@DB@B@@@BB@@H@@FB@B@@@BB@@A@AD@@@DBBBDBB@F@D@@@@@D@B@B@B@@@BAAA@@@@AAAIF@@@ACB@@A@@@CD@@BDA@@DA@@@DDA@C@@JABBBA@BFA@CB@@AF@@AH@@@BF@@BBB@@@@BB@@BB@BB@@BDA@@H@B@B@@@B@@A@@AC@A@CA@@A@A@@@A@@A@@A@@AADDB@@B@@B@@@B@B@BA@@BAF@BA@@BA@@DA@@DA@@B@@@D@@BB@BDA@D@@D@BD@@@@BF@@@DBB@D@DD@B@PA@@BB@@@@DC@@@@B@@@BB@@@@@B@@@@@@@BB@@BBDB@B@@D@@B@BD@@B@B@@@@@@@BB@B@B@@@@AB@@BB@BALAB@B@@@@@BABAB@BA@@B@@A@@B@DIA@DAB@BE@IC@@AHAD@H@@@D@DC@@DBJ@H@F@DH@@BJ@ABD@@@B@@BB@@@HAB@B@@E@@@EHA@CB@@C@@D@D@@HB@B@@@@FA@@B@@@B@DA@BB@B@@@B@@@@@BB@@HA@@BA@@@A@@@@B@@@@@B@@@B@@@@F@@BB@B@@BBABB@@B@@@B@@@@AB@B@B@DC@@D@DAH@F@J@@AB@BBBAB@BA@@BABA@@@@DA@B@ABA@@B@B@@BB@AD@C@L@AB@DCB@@BH@@@D@@AB@B@@@@BDA@BD@@B@@@@@AHG@CB@@@B@L@@@DB@CD@B@D@B@B@@B@@B@@@B@@BDB@BB@@B@D@@@BB@@DB@BBABB@@B@B@@@@DABDB@@DB@@@AB@@BB@F@B@@@B@BAH@D@BAB@DA@AB@BEB@@@@@@@B@@@@BAD@@DB@B@@@BA@@V@@CB@@CF@@CB@BB@CD@@AB@@BB@@CD@BBB@@@@@D@@BB@@@@@@B@@B@BB@BDB@AD@@@@@B@@BB@@B@BFBVJJFB@TAF@@@N@NAH@B@BAB@NEHCB@@@BD@@HABBB@B@@@D@@@@ADAB@@@B@@AB@@@B@DGB@JAD@BDD@BAD@@AACD@@B@AD@@DB@@@@AB@@AD@@AFAA@FA@ADA@C@@BAB@DCFE@AB@DCB@DCDAB@@@@@B@FA@@BD@@BBB@@BABDDB@D@F@@BB@@C@@@@@@AK@E@CA@A@@@@E@@@@A@@@@ABE@@@@@AA@A@ABC@A@A@@B@@@BA@GBA@@B@BA@@@@A@@@@@A@AAA@AA@@AAA@A@A@@BA@@CA@@G@G@C@@@A@IA@@@@AA@AB@AE@@@AB@@CAC@@@@E@@A@@AAG@C@CA@CA@@AC@@AA@@EBCB@J@@A@@@A@@@A@AD@L@F@D@B@B@BAB@B@@@@@@AAC@@B@@@D@B@@@@BB@@ABBB@@@@B@B@@@@B@B@@AD@BB@BB@@@BBDB@@BDB@B@@AB@@CB@@@BA@A@EB@@A@@BA@ADCBEH@@A@CCA@AA@BAAA@@BGA@@A@@@CB@@@@A@AC@@@C@AA@@AAC@@@AC@@C@@A@AAA@@B@BA@AB@@A@@@CA@@@@CEB@A@@C@@D@BCBCBEH@@E@C@AEA@A@@AA@@@@A@ED@CQ@A@@AA@@C@@@A@@@CI@A@AB@D@LCB@D@D@H@H@D@B@@AD@FELGFALC@AB@@@BBB@DBDB@@B@@@@AB@@GBA@@DA@@BA@@BA@ABC@@@AB@@@F@B@@@@A@@@AACAA@@@AB@DC@@B@@AAC@A@@@C@A@A@@@A@ABAB@BAB@D@B@@@BA@@@AAA@@@A@A@@BCDE@@@C@ABA@@DA@A@@@@BC@A@E@@BABK@@@A@@BE@GBC@AAEAQ@M@A@C@E@A@IBC@CBA@EB@FE@AB@@A@@@C@CBC@C@@@AA@@ACA@@@ABA@@BC@A@@@@AC@A@@@C@A@AA@ABE@IAA@CA@@@AAA@@@ABA@CDC@A@A@A@E@AAAACGKCEAAAAAAA@CAC@C@KCCAAA@@@AAABC@G@E@C@KAC@@@CAC@AACACAACEEEIECEAAA@AAC@C@A@E@CBODA@C@KDA@A@C@A@C@@AA@AAACCCAA@AACA@@A@A@@B@@AFAB@DABABABE@C@A@@@A@@ACAAA@CACAA@ECMGCAEAACA@AEAC@@AACIAC@@AAAC@AA@ECAAAAA@ECECA@EAAAA@@@CBCDABGBA@ABA@AAA@A@AA@@AAAEAGACEC@AAAACAA@@@A@@AA@@CA@@A@@A@A@A@@AAAA@@@A@@@@@AB@B@@A@@@AA@@AC@AAC@A@CAA@A@A@CA@@C@A@@A@@A@A@A@@@@A@@DC@@@A@@AA@@C@C@@@@@A@AA@@A@A@@BABCB@@A@@@A@CC@@@@@CAA@C@@AA@AAAA@@@@B@@@BBB@B@@AB@@A@@@A@A@@@@A@@@AA@@@ABAAA@@BC@ABA@A@@@A@BA@@BA@@BA@@AA@@A@A@A@A@@@@A@@@@BA@@@A@@AAAAAA@@@AB@@@B@B@@@@@@A@@A@CA@@@@@A@@@@BA@@@@AA@@A@A@@@@@@A@@@@@AAA@@A@@@@@@A@@@@@@AA@@@AAA@@A@@@@@@A@@@@B@@@@AB@A@@@@@@@A@@A@@@@A@@@A@@AA@@@@@@@A@BB@@A@@@A@@@@@@BA@@A@@@B@@AA@@@@B@@@@A@@@@@@AAAA@@A@@@A@@A@A@@@@AAA@@@CC@@A@@@@@A@@@@@@BB@@BA@@@@@@@@@AA@@@@A@@@@@@AA@@@@AABAA@B@@A@@A@@A@@@A@@@CA@@@A@@A@A@@@@@A@@B@@A@@@@A@@@AA@@@@AA@@A@@A@CB@@A@@A@@AA@C@@CC@@A@@@@@AB@@A@@@AA@@@@@C@C@@@@AA@@@CA@@A@@A@A@@A@@@@A@AA@A@@@@A@@AAA@@@@CA@A@A@@A@A@AA@@"],encodeOffsets:[[117600,32913]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
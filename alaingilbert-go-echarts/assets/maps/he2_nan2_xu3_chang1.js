@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("许昌",{type:"FeatureCollection",features:[{type:"Feature",id:"411002",properties:{name:"魏都区",cp:[113.822647,34.025341],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@@AC@@AA@@BC@A@@A@@EA@BA@AAAAACG@@@@@@E@@BA@A@CAAAACBC@A@@@@CE@@B@@A@AA@@@DE@@@@FI@@C@@B@@AD@@E@@@AA@@A@@@AA@@@@B@@E@@B@@A@CAE@A@@@@@@F@B@@@@C@@BAF@@C@@DF@@B@D@@C@@FAJBB@D@@HDB@@JB@@DD@B@D@B@@B@@BF@@TE@@@@@B@D@BAB@BA@@@@@@@@BBB@@@@@B@@A@BBC@@FCB@@A@C@@B@@@A@@@@A@@AA@@AA@@A@@@@C@@@C@@CE@C@E@@C@@ABC@@@@EA@C@A@@F@@I@@@@@@@E@EA@FE@@BABBDBA@B@@@BA@B@BHB@@@@FBB@@BBB@@BD@HAB@BA@F@@B@B@D@@D@@D@@BH@@@B@@BB@@@F@@FBABDB@@@B@@B@@@@B@BABDA@BHD@@FA@BFBB@BB@@B\\@B@@@JI@@@@@@@B@BBB@BB@@BA@@BD@@@EB@B@@@@C@AB@F@@A@@@@@@BC@I@BBAF@DB@@BB@AHF@ABA@@BA@@D@@@D@@@DB@D@@FB@@AB@D@@DD@@BD@@C@CF@@AD@@CJB@@B@@@BAAA@AB@@CH@@AB@@@BC@@HBF@@@F@@@F@D@@@D@@@@@@E@C@CBEHB@C@@F@@DBBF@@A@A@@@@B@B@H@@@B@@DB@@@B@@@@A@@@@@AB@@ED@@CB@@CDB@@@C@@@@@A@CB@AABAC@@AF@@AF@@@@@@A@A@@F@@A@A@ABB@CF@@@A@@@@A@@C@@C@@@A@@@@@CB@@C@A@@@A@@@@@A@@D@@BB@@@@AA@BEH@@G@A@CB@@A@CD@@@@AD@@A@@@CG@@AB@B@FCD@@G@AB@@E@C@@@AE@@IA@AA@@@@@AM@AEU@A@ABCFEF@BCASAG@C@@@@ABA@@@AC@@@A@DA@AAB@C@@@@@@@CD@@@AAA@@CC@@BCA@@@@@@@C@E@@A@@@@@A@@@@@@AE@@C@@@AB@@C@@FD@@BI@@EA@BI@@BA@@D@FE@@BC@AD@DB@@@@@@B@@@@@B@@@@@B@BB@@BA@@@E@@B@B@D@BA@@@A@@AE@@A@@"],encodeOffsets:[[116545,34820]]}},{type:"Feature",id:"411024",properties:{name:"鄢陵县",cp:[114.177399,34.102332],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CA@@AA@@A@ACA@@A@@AAAA@EACC@@A@@@@A@A@A@@C@@@A@@A@@A@@BA@@@BD@@A@@@AD@@A@A@@@AAABB@@@AB@@A@@BC@@BA@@@G@BFA@BBIBBHSDA@@@@@AAC@@AO@@@@DADAB@D@@AD@@@@A@C@ABC@GDA@A@A@Q@@@A@AB@@GFCD@DB@@F@@@FA@@@AA@AA@@A@@@C@A@@A@A@@@@EABA@CA@B@@@@@B@@@@A@@@A@@AA@@@A@@A@@AA@A@@@BCB@@@BB@@B@@C@A@AA@@@@A@@B@@@@@B@@@@CB@B@@A@A@@@AA@A@@A@@@@@A@AB@@@@A@@@C@@A@@@@@AA@@@@@A@AAA@@@@B@@@B@@BB@@@B@@@B@@@@A@@@@B@@@B@@A@AB@@@@@@@A@@AA@@@@@A@@B@@A@@A@AC@@A@@@@@A@@@ABA@@@A@@C@@AC@@A@A@CA@B@@@@C@@@@FC@@@@@@B@@@@A@@A@BAB@B@@@@A@@B@BA@@@@D@@ABAHA@FBD@BB@@ABB@@@@D@BB@A@B@@@@B@BAJA@A@@BB@B@@BA@@@@@@BA@@B@@C@@BBB@@@@@@AB@@@B@@AB@@C@@@@@@@@A@@@@@AA@A@@@AFCHCFCD@BABEBA@AB@@CDCDADABEB@@BB@@@BC@@@@DC@BAA@@@A@ADA@A@@BA@A@C@CAA@@B@DA@AB@@@BADBD@@@B@@BDBB@@B@F@@@@BB@BB@B@@BDB@@BB@@BB@@@B@@@@@@@P@@B@BB@AHA@@@@BAB@@CA@@@BA@@BA@@@@@AB@B@D@B@@@DB@@B@BE@@@K@@@@DBBF@@D@@AA@JA@@F@@DA@@@B@@A@@BD@@B@DB@@B@B@BB@@BCA@DC@@@@B@@@BADC@@@@D@@@@@DGAAB@BADOA@CE@BKCBADGB@@@BH@BJJB@BAB@@A@@@@BA@@B@BE@@@C@@@C@@@@@GA@BC@@AG@@@@C@B@@ABB@@DC@@BC@@D@@@B@BB@@@@DA@@@BB@@@BA@@@@@@@@@@BC@@DA@@DC@@BA@AFCAA@@AE@@BCA@AC@@@AA@AGD@CCB@B@@ED@@A@@D@@ADB@@F@@@@@@@D@BA@@D@@B@F@@@@B@@H@B@FB@@@BB@B@@@@D@DB@BB@@@BB@D@F@@@@D@@B@B@@@B@B@@@@B@@A@A@AB@@@D@@@@CB@@@BA@@BA@@BA@A@AB@@@B@@A@@B@@BBB@@BDB@@@@@DB@DB@@B@@@DBBA@@B@@B@D@FABA@@@@DAB@B@BAB@@@B@@ADDB@@@@@@@BAB@@A@E@C@A@@@A@A@@@A@AA@@A@@D@@A@@@@@A@A@@@A@@@@DAD@@AD@@@B@@B@@@@H@@@@@F@@@@@@@@B@@AB@BBB@B@@A@@@@@AB@B@B@@B@@@D@@@@A@A@@@AD@B@BBB@B@@@@AB@DA@@@@A@@@@@@ABA@ABA@E@A@@@@@AB@@@B@@@BA@@@@AA@@@@@@@ABAAEB@@A@BDB@BB@@@B@@@@BBB@@@@@HB@@@B@B@@@@A@@@@B@D@BA@@@AB@B@B@BA@A@@B@BC@A@@B@@AD@D@@@BAH@@@BCF@@@@HDD@DBBB@@@B@@@D@@CD@BA@@B@@C@@DCFA@BB@@@@B@DB@@@B@B@@B@B@BBD@BABBB@B@D@B@@@B@@@BAJ@B@@@@B@@J@D@F@D@B@@@B@DA@@@BBB@@B@B@@@@BBD@B@B@B@@B@@B@@@B@@@@@B@H@B@@B@@@@@@B@BA@@@A@@@@@@@@B@@AB@@@@E@AB@BE@@B@@C@E@AB@A@@@AA@A@@@AA@A@@@@AB@B@@G@@@@@@B@@C@@@AB@B@B@B@@BF@@B@@BBB@@B@B@@@@DB@@@@@@B@BB@@@B@@B@B@@B@@@@@@B@D@B@BB@@@@B@@@B@B@@ABA@AB@@@D@@A@C@@D@B@@@@@B@B@BBHD@@A@@B@@@@@@BHA@BB@@@F@DABDD@D@@AB@B@@B@BBDBL@BA@ED@B@FD@BBD@BB@B@BP@@@ACPABDN@AA@A@C@C@@B@HAH@D@B@B@DBB@BB@@@@@B@D@@BD@DBBBBBBBDDBB@DB@@@B@D@BB@AB@@E@A@@DC@AB@BA@@HA@EB@@@HB@@BD@@@@AB@B@BBDBBB@@B@BA@HH@@B@BD@@DB@@FBB@@@EJ@B@@@@@D@@BF@@B@@CB@DC@@FA@@BD@@D@@@A@@@AB@@B@@BA@B@BB@D@@@@C@@BB@@@DBA@DB@@CF@@BB@@@@@@@@BB@BBLA@DH@B@BBB@BDD@DAAEB@@@@@BBDB@@B@B@D@HAJXEB@@@FB@BF@@BF@@@E@@BFFA@D@@D@@@@BBA@@B@@B@@@AD@AAB@BBACB@@CB@@CB@@C@AB@AENA@FF@@@BF@@@BB@@A@@BD@BF@BFDAB@@@HAAAD@@AB@@AH@@@@B@@DJB@@@@@BDB@BD@@@DNA@B@@B@@HB@B@@@BAA@FCB@DAAABA@ADA@AB@F@B@@C@A@AAC@KB@@EB@@A@@B@A@B@@@J@@GDBDC@A@ABAAAB@B@@@BC@@@@BS@@AA@C@@C@@AA@@CA@@DA@@CA@AE@@AEABAEACB@@A@@B@@@@@AC@@@CA@AI@@@@@C@A@E@@BDD@@@@CE@@AB@@AAA@@@@@AF@BAAEB@@AF@@A@@A@AAC@AI@CDC@AAAD@A@B@B@@CA@@AACD@@@D@@AG@@A@@H@@AB@B@BA@GDA@@A@@C@@B@@GD@@@DA@@@AB@AA@@@@CC@@AEA@@@@AA@AEA@AEA@AA@@CG@@A@@@@AEB@A@@@@@AAAC@@@@@@CAE@@AEH@AE@@@C@@@@A@@@@@C@AAC@@@C@@@CB@@@@@@C@A@@@@AA@@@A@AAA@@AAGC@@@@@D@@A@@@@F@@CB@@AJA@AB@@@AG@A@EC@@C@@A@@AA@@AJ@@@@I@@@AB@@C@@@@@A@AJ@@@@CAA@EF@@GA@@A@@@AA@@A@AA@@@@CBA@A@CB@@CB@AFD@DA@CB@@DL@@AB@D@B@@C@ACABCDEBCBAC@@C@B@E@@BE@@@E@EA@@CA@@A@@@GA@BK@@@CD@B@@@F@@A@GFB@A@CB@B@@BH@H@@A@AD@@@@EB@D@AGB@@AA@@EB@@@@AB@@MC@@AG@@G@C@@@ED@@EF@@G@@F@@EA@U@CI@ACG@ADA@A@CBI@CA@@AE@@CF@@AB@@@A@A@@CB@@E@@@GD@@EBOB[BO@G@@BCD@@CB@BC@@@AB@@@@AF@@@D@@@@@@ACGEGAC@@@AD@@CD@@CD@@CC@@G@@@@@@CA@@@@@@@@@@@@BC@AB@CA@CWABSB@N@B@@ABA@@@CB@@@BADBBKFB@A@@@@H@@C@@@AB@@A@AFB@AA@AA@@A@@AA@@@@E@@@C@@BCB@@CB@@CB@@G@@C@A@CAAAA@C@@@@EA@AA@@AACBAEA@@EAC@@DACKBAAG@@AE@@C@E@K@@G"],encodeOffsets:[[117042,34590]]}},{type:"Feature",id:"411025",properties:{name:"襄城县",cp:[113.505874,33.851459],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@A@@BE@A@C@CAAAA@@CCA@AAC@@@ABCDCBEBABA@C@CBE@C@AB@AA@@AAAECGCA@@@A@@@A@@B@B@B@B@DBDBB@@BB@@BBHFFH@B@@@@@@A@A@A@C@@@AA@@A@A@CBMBC@CBED@@CBADAB@B@H@B@@BBHDBBB@@B@@BB@B@B@B@B@B@@AB@@A@@@AAA@CCA@A@A@@B@@@B@@BFBF@@AB@@@@A@E@A@A@A@ABABA@GAA@E@ADCA@D@@@@D@H@LAB@@DDDBD@BBD@BB@@B@B@@@B@@CD@@ABAA@@A@@@AC@C@AA@AAA@A@CBCB@BCAAGA@@@M@C@A@@@@HI@E@@@AB@@@@I@A@@DA@AB@@CAA@A@A@A@@@E@K@@JKA@@@B@BA@@@@@@@C@@BC@A@@@@@EC@@A@G@@A@CI@@K@@ECCAEAAAA@@@CAA@AAA@@CA@@@CA@FAAA@A@C@@@AA@@CBAA@@A@@E@@@A@A@@E@@DB@AF@@A@BC@@@EAA@BC@@AA@@DA@@AC@A@@BA@@@A@@@A@A@A@A@@AA@A@@@@@BB@BAB@DDD@D@D@@@@@@@BB@@@@@@@D@@@F@@C@@F@@B@BFBAD@BEA@B@D@DABA@@AO@@BC@@@A@BBGBDF@@@@A@C@@@A@A@CB@@A@A@A@C@C@A@A@AAC@AAA@A@CB@@GBCBA@ADA@ABCBA@ABA@A@A@CBA@A@ADABA@ABG@CBA@@BEBABA@ABA@A@@BA@ABA@CBC@ABA@@BC@A@@B@@@@DBDBBB@BBBDB@BB@BBB@B@@@B@BA@@B@B@BBB@BBBBB@@BBB@B@@A@@@A@A@A@@@@@A@AAA@@BA@@FAB@DADCJ@@CB@B@@@@@@DF@B@@@H@FBB@B@BBB@B@F@B@B@B@@BD@B@B@DAD@BADA@GFABAB@@@BA@@B@DABEF@B@DAB@BAB@D@@@BBF@D@BBDA@@DA@@BEDCBAB@@@B@D@@FFBDDHDH@@@@C@BF@DBBDB@@DBBBB@@@B@BJ@BB@@@BFBB@D@@@@@@BB@@B@@D@B@BB@@BBF@@BB@@@@@BBFBJB@BBB@@@@BB@F@@BF@@B@@DC@@B@BBD@FB@@@BB@@@@@BABAB@@@D@@B@B@@D@@B@B@@D@@BC@BB@HA@@@@HA@BD@@@@@DD@BDD@@DB@@BBFA@@F@@@BABAD@@@B@@@D@@B@@D@D@@B@AD@@@BA@ABBFA@@B@@@D@B@@B@B@BA@@@B@F@BB@B@D@@@BB@B@D@@@@BBDA@B@@@@@B@B@B@@@B@@@B@D@@@@@D@@@@@B@BBB@@@BB@@BB@B@BAD@LAB@@@@C@@FAB@B@@@@@AA@@@A@@D@B@B@B@@@@@B@@@B@@@B@@D@@@@B@@@@@@D@@B@@B@@BB@@@@LAF@D@@@@@BB@@@@@@B@@@BB@BB@@B@@@BBB@@A@@B@@@@BBDAB@@@@@BB@@B@@A@@@@BA@B@@BB@@@BBAD@@@@@BD@@B@B@B@@@@B@@@BB@D@D@F@F@@@BAB@B@@@B@@B@BB@B@DAB@@@@A@@B@B@@@@@BA@@@AB@@@@@BB@@B@B@BAB@B@@@B@B@@AA@@A@@F@@A@@AC@@B@@@D@FA@@@@BB@@@@BAB@@B@@B@BA@@@BBB@@@@B@@@BB@@B@B@B@B@@B@@B@@@B@@@BB@@B@@@BB@DBBB@@@@@BA@@@B@@B@@B@@@@@@@@A@@B@@@B@@AB@@@@@D@@@D@@B@@@B@@BA@A@@@@@@@D@@B@@@B@@B@BA@@@@@A@A@@D@@@@@@@@@@A@A@@D@@@@CAC@@@@BAB@AAB@@@@C@@A@@A@@@EAA@@A@@@@A@@@@@@B@@@B@@@B@D@B@B@@@@A@@@@B@@@@ABA@@@A@A@@B@@@@@@@@@DA@@@A@@B@@@@B@@D@@@@B@BB@@A@@@B@BB@@@BB@@B@DB@@@@@@DBB@@@@@@B@@B@B@D@D@@@@@@B@B@@@@@BB@@@@@@@@A@@@@@@B@@@D@BB@@B@B@@@B@D@D@B@B@@@@B@@B@B@HAB@@BB@@@@BBB@ABAB@BAB@D@B@@@DBBBDDB@B@@@B@BABA@A@@AA@@@AGEAAAAAA@@@AB@@AB@B@B@@@@E@@@@@@D@@AB@@@D@B@@@@AB@@CB@B@@@@A@@@@B@B@@@@ABA@AB@BA@@@@@ABA@@@@@A@A@@@A@@@AA@@A@@C@AA@@@AC@@CD@@@@AD@B@@@@A@@B@B@BA@@@C@@A@C@@@@@@AAA@@@C@@B@@@BA@AD@@@@@@A@AB@@AA@@A@@@AAA@@@A@@D@@@@BB@@@@@@@D@@@@A@A@@@@@@@A@@B@B@B@AE@@D@B@@@B@@@D@B@@@B@B@@@BBB@@B@@BB@A@@BA@@@AB@@@B@D@B@@@BA@@@AB@@BB@@@B@@@@C@A@@@@@B@DB@@@@@B@@@BA@@@@B@D@B@@@@AD@@@@@@A@@B@BAB@@@@A@AB@@@B@@@@BB@B@D@B@@A@@@AB@@@@@DA@@@@B@@@@@DAF@@@@@@A@A@@@AA@@@@@@A@A@@A@@@A@A@@@@@@A@A@@@@A@@@@DA@@@@A@C@@@AB@@@D@@@@BB@B@@@@@@A@@A@@@@A@@B@@@@A@A@A@@A@@@A@@@@B@B@BC@A@BA@@@@B@@@@C@@@@B@@A@A@@D@@A@A@@B@@@BC@@@AA@ABCA@@@A@@@@@A@A@@@@@@AA@@FA@@@@@@B@B@@A@@@E@@AA@@@A@A@@A@@@@A@G@@BAF@B@B@@@BA@@A@@@@AB@BB@@AC@A@@@A@@@@@@@C@A@@B@@@@AB@@@@A@C@A@@@@D@@@@A@E@A@AB@B@@@@@@A@@@@@@@C@@@A@@E@A@@@@@@C@@@@E@C@@@@@@A@A@A@ABC@@@AB@@@@C@@B@B@B@@@@A@@BA@@@@B@@E@@@A@@@@@ABB@@@@B@F@B@@@@@@A@@B@@@@A@A@@C@A@@@@A@A@E@@AA@@AA@A@@@@AA@@@AAE@AA@C@A@@A@@B@@@@A@@@AB@DABABAB@B@F@BANA@@B@@@@D@@J@DAB@@@@@@B@DB@@@@DBB@H@@A@@B@D@@B@@@BD@B@@@@F@@@@@@DBBB@@@@BB@DBB@@AB@B@@@@A@AB@@@@A@@B@B@@@D@@@@@@CA@@@@AF@@CC@@@@C@@E@@@@A@@@A@@@@@@@@@A@AB@@BB@@ABA@@A@AA@@A@A@BAA@A@@@C@@AA@E@@@AD@@@@@C@A@@@A@C@A@ACA@@@@@@AA@@@@HAB@B@@@BABA@@@@@A@AACAABAFE@A@@FGB@D@D@D@@@@AB@@@@@DAB@@@B@DA@@D@B@@AC@@AH@@@D@@A@AF@@E@BB@BCB@@@@AB@@AA@@C@CAA@CD@@AE@@@CG@A@C@CA@@@@CE@@@BABA@A@AA@ACA@A@@AA@@A@@AA@@A@@@@@A@@@@@ABA@@AA@AAB@DAD@BG@@BABEB@B@@A@C@@@CB@@C@A@@CE@@EB@@@C@G@@K@A@BG@@C@@@@C@@EA@@@A@E@@@@AAA@@AAA@@AA@@@@@CB@@C@AB@@A@CB@@A@@@AB@@@B@D@D@@ABCLB@AD@@@@A@@A@@@JA@@@@@C@AF@@@@ABC@@B@@@BB@@@AB@@@@G@@BCA@@EG@@@@E@A@AC@@A@@G@@CEB@@@@@AA@@A@@@@I@A@@@@@@@E@@EBAA@@@@AA@@@@@AE@C@A@AA@@C@A@@C@@C@@@A@A@@ABABC@A@@BA@C@@@@@DA@@@@@ACBAAC@@B@@@B@@@BG@A@@FA@@F@DC@BF@@@B@@@@A@@@C@@@C@A@EBAC@CKABAF@B@@E@@AA@@AI@CA@@CB@@CA@@A@AA@A@@AABAB@@A@A@@BA@C@IAI@@@@D@B@BABA@EB@ACB@A@@A@C@E@I@@BA@@FEBC@E@CB@@AA@AA@@@@AA@@@@A@A@@C@A@@@A@A@@@@AG@@@A@@@ADEL@@@@@@A@A@A@"],encodeOffsets:[[116365,34512]]}},{type:"Feature",id:"411023",properties:{name:"建安区",cp:[113.822983,34.12466],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@B@@A@@@@B@@E@@BD@@DC@@HD@@A@@@C@@B@BAB@@B@@FAD@@@@CA@@@AA@@@@@@@@@AB@@@@@@AB@@A@A@@@@@@@A@A@@A@@CAAA@@CABC@EBE@CB@@@BBB@DB@@@A@ABBDA@@BDA@@@@D@@A@@@AB@@BF@"],["@@LA@BD@@@@DFBD@@BB@@BH@@@@DB@@@F@ABB@D@ABB@BAB@B@B@@@D@@BD@@AFBD@BBB@@@J@AB@BF@@@@ADAB@@FJ@@@@@@CF@@@D@@BB@@BF@@BD@@BD@@@D@@F@@N@@AB@@G@@B@B@@EH@@E@EF@@AD@@@H@@@@@B@@BB@@DB@B@@B@@DB@D@@@@@HC@@DA@@DD@@@F@@BJ@@DD@@@H@B@@AFCBBB@@AB@D@@A@ABBBAD@@B@@@@@D@@BFBB@B@@BBF@@FD@BA@@B@@CA@@A@AB@@@@AB@@B@@@BD@@@B@@@D@D@@DB@@G@@D@@@@C@@@@@C@@A@@EC@G@@@E@@G@@EBA@@A@A@@JC@@@A@CD@@A@@@@AA@@@AB@@@@A@@BAAA@AB@@@@@@C@@B@D@F@B@@@F@@BDB@@B@B@J@@@@BADF@@D@@@@B@@B@B@@@B@@@@A@@B@BB@B@@DL@@BB@@AB@@DJ@@BH@@AB@@BL@B@@CB@D@@DL@@@B@@BB@AD@BF@F@@@F@D@B@@DB@@@B@B@@@BBB@B@@@BA@@BBB@@BB@@@B@@@B@@AD@@@@AFA@@H@B@@CB@@@@AA@@@@AH@@@BAF@@@@EJ@@@BBJ@@G@IF@B@JA@BDA@DF@@FB@@B@BF@H@@@@A@A@@@@@E@CAE@AB@AGD@@A@CD@F@AF@@@BD@@BBA@@FA@ADADA@@BAAG@A@A@A@@@@@A@CD@B@@@@C@@BAB@BA@@@A@A@@@A@@A@@A@A@C@A@@@@A@@@@A@AA@@@A@@A@A@@@@A@@C@@A@A@@@AA@AA@@@AE@@@A@A@ABA@@D@@@@A@@@@H@@@@ABA@@@@@BBB@@B@B@@B@@@BBAF@D@@@@AF@@ABAF@@@@@BA@@@A@@@@@@B@@@B@@A@A@@@@A@@@@A@G@A@@@@@A@@@AA@@@@A@A@AAC@A@@A@A@@@AA@A@@CBA@@@A@C@E@C@I@@@@A@@A@I@AB@@A@@@A@C@A@A@AAABC@AAA@A@@@@A@A@@CAA@@@@@AAB@DE@CD@@@@AB@@ADC@@@C@@@A@@AACAC@GC@@@@DE@A@@BG@A@@@CBC@@@AB@D@@A@AB@B@@A@A@ABA@@B@@A@C@A@@B@@@@@@A@A@@GA@@@@A@AA@@@@@A@@AAA@ACB@@@FABBBA@@@@@@B@@B@@B@@A@@@A@@BA@@@@B@F@B@BAB@BA@@@@@@@B@@B@@CBA@@@@@AAA@A@ABC@@B@B@@@@@@C@@@AA@A@A@@B@@@@@BA@A@AAA@@BA@@@@@@@@@@E@@@@@G@@A@@@@A@@BC@@BC@C@@B@@@B@B@@@@@B@@@@CB@@@BBB@@@B@B@@@B@D@F@B@@@BA@A@@@@@@CABC@@@A@@BA@A@ABA@C@@B@BA@E@C@AA@@@ABCA@@A@@@CAA@@C@@@@CA@AA@AA@@@AB@@@@A@@BAB@B@@AB@@AB@@A@@DA@@@@@C@@BAB@B@@@@A@@A@A@@@A@A@@@@C@@E@C@A@@A@@AAA@@C@C@@A@A@@A@@EAA@G@@@AB@B@@CF@DEPA@AB@BA@AB@ACAAB@@A@@DA@@CG@@@@DY@@@C@@@@@@AA@E@@A@@A@@BE@E@@B@B@@@A@A@AA@G@CA@@@@@AA@BAA@@@@@@DA@@BI@@@@AA@A@A@A@A@@CA@A@C@AA@@D@AC@A@@EAA@@@@BEA@BE@@AC@@@A@A@@@B@@B@F@@@@CB@BAB@BA@ABA@@E@@A@@FI@EAE@@D@@C@A@A@A@BA@A@CAAC@ABBFBF@@ABEBI@EDADIJIAC@C@CB@F@@BB@HAB@B@@FJ@@@B@DA@CBS@ADKAGAA@EDG@AAA@ACG@K@@@@D@FA@@B@HC@EDA@A@@BH@@D@@@BC@@B@@C@@D@BA@@D@B@HG@AFB@@B@@A@@AC@@@@B@@@@@B@@@B@DA@@D@@@@@B@@@DD@@@@B@@B@@@E@@DAA@B@B@BE@@@@B@B@@@@E@@BE@@BD@ABBBA@@D@B@@@@@D@@CA@DA@@DC@@FA@@B@@@@@B@@A@@@A@@CA@@@G@A@A@@@@@@B@BE@AA@CE@@@@DGAAF@D@D@F@@@@C@@@C@E@@@E@@@E@GA@@AD@@A@@BG@@DA@@BBBAB@@A@@@IA@DC@@BE@@D@DC@@AC@@CC@A@@BA@@EC@A@@C@@@C@@@CB@@AB@BAE@BGA@@AA@@CBEAAJ@D@@A@@@@B@@@@EBAD@@@@@@AFA@@C@@AB@@AA@@AAA@A@A@@@@@@IJ@@A@[@@AA@@AAAAEB@@EC@AGB@ACABA@@@@@@AA@@@A@ACAB@EE@@@A@@AA@@@G@@AC@@@@CC@A@A@@@@EABA@GBC@@AA@AA@@AA@E@@A@AGA@B@@A@@@AABACBA@AF@@EFBF@@@@@@@J@@@@EB@D@B@@F@@D@BA@@@DF@D@F@@DD@@@D@@@@@@BB@@BB@@BB@@@@@@B@@@AD@B@@@DA@ED@AAB@@@@A@@@@AA@A@@@@@@B@@ABA@A@C@A@@@@SF@@AE@@@AA@C@A@C@@CA@@IA@GC@@@CAABI@ED@@@@C@AE@@CD@@@BE@AD@@@@@@A@E@@@@B@F@DBB@@@@AF@@@@A@@B@@B@@@BB@@B@@@FC@@BA@@@@DJ@@E@@F@@C@@BBB@@@@AF@@D@@B@D@DABBBB@D@BAB@@@F@@@@H@BDBBBBB@@AFB@@@BB@D@@AB@@BD@@BF@@@@BF@@BB@@@B@@A@C@A@AF@@@B@@AA@@A@A@@@@@A@@@@@A@@@@A@@CBCD@@AF@@E@CB@@AJ@@AFB@@AJ@@EC@@@DA@@B@@@DF@@ABCBBB@@AHA@CD@F@BA@AJ@@A@CBG@@@AECAB@AB@@GBAD@@E@IBO@AC@BC@@TC@AC@BCBIA@A@@A@ACAFK@A@@C@BCF@BC@AC@@GB@F@BEJSBAA@IAICC@@@BAAM@E@AD@@E@@@@@E@E@@@G@AC@@AA@AA@C@AAECBAC@@@@A@@@EAA@@@A@ABABA@@@@@A@CAA@A@MDC@E@EBCBA@@DB@BB@DB@@@@B@B@@@@@@@B@BA@@B@@@@A@@B@@@@@@@@BB@@B@@DB@@@@D@BG@@@@AC@A@@AB@@AA@ABA@@@@D@@@BC@@GD@@CC@@AA@A@@ACB@A@@C@C@@@@@ACB@@E@@@@@@AAAD@@A@@@CA@@A@@@A@@BAB@@ABA@@A@@ACA@@@AA@BA@ABC@A@ABAAEAA@AA@@GHABA@A@A@EAA@E@A@C@@CBAB@@A@A@@DABAF@@EG@BCK@C@@GD@B@@C@@IA@B@@@D@@CAC@M@G@@B@BG@@@@AA@I@@D@@@@C@@@A@A@@@@B@@A@@B@B@@A@A@@BA@CAA@@A@@A@AA@C@@@@E@@@@@@AAC@@A@@@@C@AB@@@@GAA@C@@A@@C@A@@@@A@CBI@@@@C@@A@@@MBABE@A@A@ABABCBA@@B@@@B@@A@@@@BB@D@B@@BBF@B@@BB@@@@@BBB@@BB@@@F@B@B@@B@D@@@@B@B@@A@@@@B@@@@A@E@A@@@@@AA@B@@@@@B@@@FA@@@@@AB@@@B@@A@A@A@@@@D@@A@@B@@AD@B@B@B@B@@@@D@F@@@@@@D@@@@B@F@@@@B@@@D@@@@@@@B@@@@A@A@@B@B@F@B@@C@@@@@@B@D@B@@A@@B@@A@@@@B@D@@@@@@@B@@@BBD@@AAA@@B@@B@@@AB@@A@A@E@AB@@@H@B@@B@@@@B@B@@BB@@@F@@@BA@A@@@@@@@EB@@BB@@@@@@@B@B@@@@@B@@DBBAB@@B@@AD@@A@@@@B@BC@@@@B@BA@@@@@@D@@A@@@@@ABB@D@@A@A@A@@B@@@B@@@@B@B@B@@A@@@@B@@B@@@@B@@@@A@A@@A@@C@@@A@@B@@@D@B@@B@@C@@B@@@@@@B@B@@@@B@B@@@B@@@@B@B@@@@B@@B@@@B@B@@@@E@CB@@@@A@@@@@CB@@@@A@@B@@@BA@C@A@A@@A@@A@@@A@@B@B@@A@ABA@@@@B@@@@C@@B@@A@C@A@@@@@AB@@A@@@@@A@@C@A@@@@@B@D@@A@@@A@@AA@@B@@AB@@A@C@A@@@A@@B@@AB@@@BAA@@@AA@AA@@A@A@@@A@C@@@A@@@A@C@@@BFA@A@A@@@@B@@@@@@@B@B@@C@@@@@@@A@@A@@C@@@@B@@BB@B@@@BB@@BA@@B@B@@@@C@@BAB@@A@@@@D@@BB@B@@@@D@B@@@@D@@ABA@A@@@@B@@A@C@@B@@C@@DD@@B@@BBD@@@@BB@@B@@@B@@@B@B@@@@AB@B@@@@ABA@@BAB@B@@A@A@@@@@@B@@A@A@@DA@@B@@A@C@@@A@@BC@@@@@@@@F@@A@A@A@@BA@@B@@BBBBBBHF@B@@BB@@@BABABA@@@A@A@CCAACA@@A@C@A@ABA@AB@BAA@A@@A@@AA@@DAB@@D@@BAB@@B@BBA@A@@@BD@BBB@B@@ABCB@@@J@BB@@@@B@B@@@BA@A@A@A@ABA@AB@B@@AB@@BB@@BBNDB@BBB@@BDB@BB@@B@@BB@B@@BBBB@@B@B@@@@@B@@@@B@B@@@BB@@B@@B@@@@@@BBB@@@@@@B@B@@@@B@B@@@@A@A@@B@B@@A@@@@@BB@@@B@@A@@@@@@D@@@@CB@@@@@B@@@@C@AB@@@@AD@@@@@B@@@B@@A@@@@B@@A@@@@BFD@@@B@@@@BB@@@@A@@@@@@@@@@B@B@@@@HA@B@B@@@@@BA@A@@@@BBBBB@B@BA@A@@@@BB@A@ABA@@B@@@@@@@@@B@@@@DA@@BBBB@BB@@B@@B@@@@@BAB@@@B@B@@B@@@B@B@@A@A@@@@B@B@@@DBDA@@@@B@BAB@@@@A@@BB@@@@@@BA@@@@@B@@@AB@BB@@@BB@@@@BB@@@B@@@@@@@B@@@B@@AB@@@@@DB@@@@D@@@B@@@B@@@@C@@@@@@B@@@@@B@@AB@@BB@@CBA@@@@@@BBA@@@BBB@B@@BAB@@@BD@@@B@@@@A@@@@B@@GB@@@@@@B@B@@@BFB@@@B@@@@C@@B@DB@B@@D@@@BB@@@BB@BA@@@B@@A@@@@@@BBB@@@@BB@B@B@@BB@BB@@B@B@@@B@@DD@B@@B@@@BDBD@BBB@@@@@@CB@@@@F@B@@BB@@BBBB@@@@BB@@@@BBB@@@@@@@B@@@@A@@@@B@@A@@B@@@B@@AB@@@@@@B@@@BB@@B@BF@@@@B@B@@BB@@@@@@@B@BCBA@AB@@AB@@@@AB@@AAA@ABA@@@@@@@AB@@A@AAA@@HA@CFAD@ACDABDB@@AACB@CC@@LAAA@A@A@@B@B@AAD@B@@AD@@AL@AAHAAAF@B@@B@@@J@BB@@B@@BD@@@BDA@DBB@BA@@@BB@A@@B@@@BA@@BBBA@BBBFA@BDAD@B@@B@@@@D@B@BA@@@BNA@DLA@D@BD@@@B@@AN@@BB@@AB@@@@B@@B@@DD@B@D@@@@D@D@@B@@DD@@AD@@CH@B@@AD@@BD@BB@@D@@CD@@@BA@A@AA@@AB@@BB@@AD@@AH@D@@DR@B@@D@@D@@@FB@@B@B@HCH@@C@@B@BA@@B@"],["@@@@@ACABACA@DEABAA@A@@A@A@A@@@@EC@@A@C@@@C@@AC@@@AA@@C@@@@AA@@@@@@A@@@E@@E@@@A@@C@@@A@@A@A@@EE@@BC@@@A@@AA@@G@A@@AAA@DC@@AAABA@@KC@@BE@@BC@A@@B@BAB@BA@E@A@@AA@CAABA@@A@@@A@@C@@A@@A@DC@@@A@@K@C@A@A@C@CBBBA@@@@B@@@B@B@@A@@@A@@@BB@@@@@@C@IA@@C@@@C@ABA@ACA@AAAA@B@BG@@BGB@BSD@@ADD@@BAP@J@FC@AB@HA@@BBAFD@B@@AH@D@BI@@BABE@C@@DGB@BA@AAAD@B@B@@@@B@@@@@B@@@@F@D@@@@@@DB@AD@@DB@BB@@C@@D@@@@@@@DBA@BCBB@@@D@@B@@AB@B@@D@H@TBDB@AFEDEBAB@V@BFN@@B@@@@BBB@@JF@@BL@H@BDB@BBH@FCB@HBLBBCT@DAB@@C@A@@EI@@@ABA@GAA@@@EDAD@D@JBJIBCFCJ@FABA@@AEAEBA"]],encodeOffsets:[[[116551,34699]],[[116520,34979]],[[116691,34795]]]}},{type:"Feature",id:"411081",properties:{name:"禹州市",cp:[113.488478,34.140701],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAA@@C@A@A@@A@E@A@@ABA@A@@@@A@C@@@@E@@@C@@@@BE@@AA@BD@@@BBH@@KBC@@@@AC@@@@BE@@FD@@@A@@BABAB@@@@BB@@@BBB@@@@D@@@D@@D@@@@DDF@@D@BD@F@@B@DBF@@C@C@@DA@A@BFI@@BAB@@IB@@G@@B@BA@@BA@@DA@@BB@B@@BA@@BB@@BB@@B@LB@@BB@@@@B@BG@@B@DA@E@@BE@@E@A@AAA@A@@AA@@@@A@G@A@@B@@@AC@@@@@@BA@A@A@@@@@@AEB@@A@@@AAA@BDA@A@@CA@@DA@@BE@@B@BE@CA@D@@@BA@@BA@@C@@BAA@@BA@@@A@A@A@AAA@CAAC@@@ECB@ED@@EB@@@B@AI@@G@C@C@G@@BA@A@BFA@@FC@@F@BC@@B@BC@@B@BA@@@@@@BEB@DA@@@ADABA@@@A@@B@@@@@BB@@@@BB@@BBB@B@BBB@@@BB@@@@BB@@D@BFA@BB@@BD@@@A@@BB@BB@B@@@DC@@BI@@@@HA@A@A@@BA@@BA@@FG@@@GB@BAC@BAAC@@@C@@BAB@@EB@B@@A@GBC@@B@@@@@BA@A@@DC@@@@BA@@@CB@A@@@AA@AD@@@@A@A@BBEBA@A@BB@@A@AB@@@@@@@@@B@@@B@@@BCBB@@B@@@@@@AD@@A@A@C@A@@@@@@BC@@@@@@BA@@AA@A@@DAA@DA@@BB@EB@@CA@BA@@B@B@@@@CB@@A@@BAB@@@D@@AB@B@@A@ABEAA@A@C@A@CAA@A@AA@AAA@@D@@A@@AA@@@@@@BA@A@@@@@@@@@A@@@AB@@@@AB@@@@C@@AAA@A@@@A@@@@A@A@@@AA@@@@A@C@@@@A@@@@@GA@@A@@@@AA@CC@@A@@@@B@B@@@@@@@BAB@@A@@@AB@@@@@@@@@D@D@BA@@@E@E@@@ADADGL@@@@A@A@GAE@CACAEAC@@AA@A@AAA@AAA@CAA@GCCAACAA@@@@ADAB@@A@CBA@C@A@@@@@B@@@AA@@A@@@@B@@@BA@@@AC@A@@D@@@@@C@A@AAA@CB@@ABAHA@@@ABA@CAA@ADA@@DAB@@@@@@C@A@@B@@@@@D@D@@CBA@@B@@@B@B@BB@@BA@@@ABA@A@A@E@@AABABADAB@@@B@@AB@@A@A@AAA@AB@@@@@@@BADBBAB@B@D@@A@AB@@A@A@@@@@@BBBBB@@@B@@@B@@@BB@@@@DB@BB@@@B@B@BA@@BA@AB@@ABABCDABCDA@AD@BA@CH@DA@@BA@A@AB@@A@@@AB@@@BA@CD@B@@B@BB@@@B@@CB@B@B@@@BA@A@A@@B@@BD@BAD@BBB@B@@@B@@ABAB@B@@@@F@BBB@BBB@BD@B@BABCFAD@@A@C@@BA@ABAB@B@@@@BB@B@@CD@@C@@BA@@BCBA@A@ABA@ABA@C@@@@@AB@B@@A@A@@@CAA@AAEBAAAAAAA@@@@BCDA@@DABABA@@B@@BB@BB@BB@@D@@B@@@D@@@BBB@@BB@@@B@BA@@BC@A@CAA@@A@@@@AB@BA@AB@@@@@@BBB@@@@D@@@B@B@B@@@BB@BB@D@BDD@@@B@B@F@@@B@@DB@B@@@B@@@B@@BBDBBB@B@@@@@B@B@D@BFBBB@BB@B@BB@@BBBABA@@B@B@D@@AB@@@D@F@F@@@BAB@BA@@B@B@DB@@B@B@FABB@@@@@BB@@@BBB@@BB@BBB@D@BA@@B@@@BB@@@BBBB@JBB@DBFDB@@@D@F@D@B@@ABCB@BAB@B@B@B@DCBA@@B@B@FD@B@BB@@@B@B@@@DB@@@B@@@B@@@B@DB@@BB@@@B@@@BAB@@@@B@B@@@B@@@@DA@@BB@@DB@@B@BB@@@@AD@@AD@B@@BB@@@@B@BAB@D@B@@B@BB@@B@@DBBB@BB@@B@@@@CDA@@@@BB@BBB@@BB@B@@D@@@@@DABB@DBBB@@BB@@BB@BBB@B@@BB@@BBBB@@B@BABAB@@@@@BB@@@B@@FABAB@@@B@BBB@B@B@@@B@@D@@DDDDBB@@B@B@@BAB@@BB@BBBBBB@FB@@@D@@A@AB@@BF@B@B@BDBDBDBBBFBD@F@D@B@B@HADAF@D@F@B@B@ZB@@BBB@BDB@@@DBBB@@B@DA@@B@@B@@@B@BCBAB@BAB@B@B@BB@@@@BDAD@B@B@@@@@BD@@B@@@D@D@BAFAH@HA@@BB@@B@@@BBB@BAH@BABB@@DBB@@BB@BA@B@@@@@BCFABAB@@@D@D@D@@@B@B@@@BBBBB@@@BA@AB@@@B@@BBBBDBBDBB@BBBBBBD@B@@ABA@AD@BA@@@ADAB@B@@@BBBBBBDB@D@DB@@@B@DB@@BBB@D@B@@B@B@BAB@BBB@@@BAB@B@@@@BB@@B@@B@@ABA@@B@@@@@BBBBB@B@B@B@B@FCBABADA@AB@@CBA@AB@@@B@D@B@B@@AB@BABAB@BAB@@@BA@AB@D@B@@@DA@AB@@AA@@A@AAA@@EA@A@@@E@A@@B@BAB@DADAB@B@B@B@B@@BBD@@BB@@@@B@@@B@BADABABC@@@@B@@@D@BBB@B@B@BA@@@@B@@@BBBBB@B@H@@@B@BABB@@B@BAB@@A@@B@DA@@B@@A@@@@CA@A@CAC@A@@CE@@@AA@A@@@@A@@@@DE@@@@@@EE@@@A@@B@BAB@BA@@DA@A@@@A@@@@B@@AB@B@F@B@B@@@BAD@@@B@DBDBB@@@B@@@BABABA@@FAD@BADCB@@CB@@@@AD@B@@A@@@A@AB@@AD@BB@@BBBBB@@@B@BA@A@AB@@@BAD@D@B@HA@@BABAB@DA@@D@BA@@DDDB@B@@@BAB@@@B@@B@@BDBBBDBBBBBD@@@D@B@B@DCBAB@BAB@B@BAD@D@@@FB@@B@@BBBB@HD@@DEBABCBAB@@@BABA@@@A@A@AA@@A@@@@@A@@@AB@BC@A@@BADABAB@BC@@BAB@BA@@@A@@@@B@B@BA@@D@B@B@BCB@B@@@B@B@BA@@@@B@BAB@B@BBB@BA@@D@@@BAB@BAD@B@B@@@@B@B@B@@BBBB@@BBB@B@DBB@BBB@@@D@@@B@B@@@B@BBHBB@@@B@DA@@FAB@@@B@@@BBB@@AB@@@@@B@@@B@@@DA@@B@B@@@@@DCB@BA@AB@B@DA@@B@B@B@D@@@@@B@B@B@@ABA@@FAB@BAD@@@@@BABABABCB@DA@@@CBC@@B@@C@AF@@@@AB@@A@@@@D@@@@@B@@@ACB@@AB@B@@@@AB@@@BA@@@A@@@@@@BBB@B@@ABA@@BB@@BAB@@@@@@@@@BA@@@AB@@C@AA@AA@AA@@A@C@@CCAAAAC@A@@@AA@@A@A@@@A@C@@@AB@@A@@@@A@@@C@@@AA@@@A@@@AA@@A@@C@@A@@BA@@@A@AA@@A@C@@@@@@BABA@@@@@@@@B@B@@AB@@A@@@@CA@@@@@A@BDA@@@@A@@A@@@@BCAAACAA@@@@AA@AA@@A@@@A@A@@B@@A@@AACACAA@A@AB@@@@A@@AA@@BAB@@@@A@@@@@@@@@A@@AA@@@AA@@ABAAAAC@AAAA@@A@@@@AA@@AB@@@@AB@A@@AB@@A@@A@@A@@@ABAA@@AA@@AB@@@@BB@@@@CA@@AB@@A@@@@@@@AA@A@@A@@@AACCB@DC@@BA@@FAB@@AB@AA@@BA@AB@@A@@AA@@A@@@@@A@@C@A@@B@D@@A@@BC@A@@B@B@@@@@@A@A@@@@@@B@@B@@@@D@@@AA@A@@B@BAHAFA@AB@AA@@B@BA@@B@@@BB@@B@HAB@@@BB@@B@B@B@@AD@@A@AA@B@@A@@B@@@BAB@BAB@BA@@B@@@@A@@HAB@DAAC@ADABABADA@@@@BB@@B@B@@AD@HAB@@A@@AA@A@@RCB@HAFCAECIAGAGA@@@A@@@@AAA@@BAAA@@C@@@@@A@@BAA@@ABA@ADA@@@@@@@A@@AA@A@@@@@AEA@@@AA@@A@@@@@@@BA@@@A@@@AB@@@@A@@B@@@@@@A@@@@@@AA@A@@A@@A@@A@AA@AA@@AA@E@@@@@DA@@@@@@AA@AACAC@@A@@@@ACC@@@A@@@A@AA@@AAA@@@A@AAA@@@@AA@A@@@@B@@@@A@@ABA@@A@@AA@@C@@@@ACAA@@@@D@@A@@@A@AE@@A@A@@@@@@@HA@@@A@@B@@@@@@A@@AC@@A@AB@@@AAA@A@@AB@A@@@@B@DA@@AA@@BA@@@A@@@@@A@@@@D@@@@@@A@@@A@@@C@@A@@C@@@@BA@@@A@@@A@@@@@@@A@@AA@@@@AA@@A@@ABA@@A@@@@@B@@A@@@@A@@AB@@@@@BA@A@A@@B@AC@C@@@A@A@@B@B@@@@A@A@@@AA@A@@@A@AB@@@@A@@@@AA@@AAAAA@@CB@@@@@A@@@@@@@@@AB@BAB@A@@A@@B@B@@A@AAAAA@A@@B@B@@A@@@@@A@AGB@@@@@A@A@@@@@@@@B@@@@@AA@@@@@A@@EC@A@@B@@@@A@@B@@@@A@@@A@@@@BC@@@@BAD@@@@@@A@@@@DA@@@@@C@@@@B@@@@A@@AA@@@@B@@@@A@AB@B@@@@@@A@A@@A@A@@@@@@@AA@A@@@@A@@@@AA@@A@@@A@A@@A@@@@@A@A@@@AAAA@@@AAA@@@AA@@ACA@AA@AAA@MCAA@@AA@@BA@@@ABAB@BAB@B@B@B@@A@@@A@A@@A@@A@I@@DABA@@@AAA@AAC@@B@B@AAA@@@BA@AC@@@BA@CGBA@A@@@@A@@A@A@C@C@A@@@A@A@@@AAC@@@A@@@@@@@@B@@@@@@A@@A@@@@@A@A@@@@C@C@A@A@@@@A@@@@A@CA@@@@@@CAA@@@AA@@A@@A@A@@@BA@@A@A@@C@@@@A@@A@@@@B@@CB@@@@@@@@A@@@@B@B@@AB@B@@A@@@@@@B@@A@A@C@A@@@A@@@A@@@@@@@@B@@B@@@BB@F@@@BB@@@@D@@A@BBA@AB@@@@BD@D@@C@@@@B@B@@@@@@@@C@@@@B@B@@@@ABA@@@@A@@@AC@@@@@@@B@B@@AA@@@A@@@@C@@@C@@@@BA@@@A@@@AB@@@@@@@@@@AA@@@@A@@AB@@@@A@AA@CAA@@A@@@AA@@A@@@A@@@@AA@A@A@A@@@AA@@A@@@@@AA@A@@ABA@@@@AA@AB@@@@AA@@@@EBC@@@A@@@BD@@@BE@@@@BB@@BA@A@@@A@A@ABA@A@@@AA@@@@A@@B@@AB@@@@A@A@@@@B@@A@CBA@A@@A@AA@@@A@A@AB@@E@E@C@C@A@@A@@@A@@A@A@A@@@AC@@@@C@AB@A@@AA@@@AAB@@@@@BA@@@AA@@@@A@CBAA@@@@@AB@@@AA@A@@@AA@@AAA@@A@@@@@@@AA@@@@C@E@KB@@@@AA@@@AA@@@@C@@@@A@@@@@@CA@@@A@@@A@@@@@A@A@A@C@@@@B@@BB@@@@A@A@EB@@@D@@A@KBC@ABA@A@@AA@@A@@AA@A@A@@@@@C@@@@@C@A@@@A@@@A@A@A@@@@@ACBAA@@@@@C"],encodeOffsets:[[116148,34811]]}},{type:"Feature",id:"411082",properties:{name:"长葛市",cp:[113.813714,34.19592],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@BAE@@@A@@C@@G@@AA@@AC@EA@C@@C@@AKBA@@@ABA@@@@DG@GDA@A@@@EA@@C@@@@CA@Q@@CC@G@@BC@@BA@@AA@@BB@@B@BAB@@C@@DC@@@AAC@@AC@@BA@G@@DC@@BC@@CA@@@@C@C@@C@A@C@@CA@@@@A@@A@@BA@@AM@@BA@@@C@@A@CKB@CMB@A@@ABA@C@@@@@@AA@C@CB@AEBAA@AABAA@@AB@@A@@@@BAA@@B@@AAA@CCB@A@@AC@@@AA@@A@I@@@AA@E@BBGBBBK@@BC@@BA@C@BBA@A@@@@B@BBBKB@@DDA@BD@BA@ACCBBDC@EB@DGB@@BB@B@BA@@B@@@@@@AB@BBB@BA@@B@@A@@BA@@BABB@BA@@BB@AB@@@@@D@@@BBAB@@BB@B@@B@@@B@BHBHDJBFEDGBA@QD@@@BBB@@@BA@GBC@@BA@A@@@AA@@@@CBABABCB@BBDCBA@GB@@@B@@A@@@ABA@ABA@AB@@A@@@@BA@B@@B@BC@@BA@A@A@@@AA@@A@GBA@@@AA@@A@@@ABA@@@BBA@@BEBGBABA@@@@BBB@@C@@@@@@AA@@@@@@@@B@B@@@@A@A@@@@BAD@@@BC@A@@@@B@DB@@@@@B@@@BB@@@BA@@BAB@@BBA@@BA@EB@@AB@@CDA@DDBB@@B@@@@BBB@@@@@@B@@@BA@@DB@@@@AA@@@@BA@@BB@@BBBA@@B@@@@BB@@@BA@@@BBA@@@@BA@@BB@@@@@BB@BB@BBDBBAB@BB@@B@@BB@@@B@@@@@@@@@B@@A@AB@@BB@@@B@@A@@B@BBBBDBD@BB@@@@AB@B@@@B@@@BBB@@B@@B@DBBBDB@A@@B@@@@B@@B@ACB@@@@@B@@D@@B@@@BA@@@A@A@@@@@@B@BA@A@@@@D@B@@@BBB@@@B@@AB@@@@DB@@@BB@@B@@@B@@B@@@D@@@B@@B@@@BA@@D@B@@@B@B@@@BB@@B@D@BBBBDD@@@D@BB@@BBBB@@@BABAB@@A@@BB@@D@@@BA@@B@@C@@@AB@@AD@@@B@BAD@BAD@B@@@DBF@DB@@@@@B@@@@B@@BB@@DF@@FBAB@ABB@@@J@@A@@@BB@BA@@@AD@@AB@@ABAF@@C@@@@@AB@@@@@B@@@A@@B@@B@@B@@ABCB@BF@@A@A@AF@@AD@@@B@@DB@B@@H@@B@BBBB@@ADA@A@C@A@@@@B@BA@BB@@@@@@@@D@@D@@@BB@@AL@@@D@@@D@@AB@@BDA@D@B@@@DC@@BD@@B@@@D@BC@@BFBD@@FF@@BD@@@D@@@D@@CF@@B@@CB@D@@A@@D@@B@@@D@@BFBF@@BC@@D@B@@@B@BE@B@H@@BA@BBFA@@D@@BHB@C@AD@@CLB@AB@@AH@@@B@B@@AB@D@@BD@@@B@@CB@D@@DD@N@B@AF@@H@@BF@@BB@@BD@@DB@FB@@D@@@BF@B@B@BA@@BAB@DB@@@@BBB@CDBB@@B@BB@J@@BF@@B@@@ABA@BF@@BD@@@@AH@AC@AD@@AB@@A@A@A@@B@B@@AD@@@@@@AD@@@@B@@BBB@AABA@BDA@@B@@A@@@BBA@@B@@BBA@@B@@AB@@AD@F@@@D@@@B@@AD@@DB@B@@@BB@@B@@@B@@A@@@A@@@CA@@AD@DA@F@B@@@@@D@@BCB@AB@BB@@@B@@AN@@BD@B@@@@@B@@BBD@@FA@@B@B@B@D@@BB@B@L@D@@CD@@@B@@@@@@@B@@BD@BB@@FA@@@@AEF@@A@@AAAGB@B@@A@@DAF@@ECIAIFAAC@@DA@EB@BB@@B@@G@@AEB@@FDAB@@CB@D@@DB@B@BB@@BJD@@BD@H@@AB@@BD@@BB@@E@@AED@@ED@@@@@AB@B@@@BBBBBDB@BBB@DD@@H@@B@DAFC@@@@@A@@AE@A@@B@D@B@DAB@AI@EH@@B@@BHB@BD@@D@FCFAFA@C@@@@ABA@@CB@B@AGJAAA@A@A@@BA@@BA@BB@BBBBB@B@B@B@BABA@@@GBA@ABAB@@AB@@DD@@B@@BBDAD@ACH@B@B@B@BABA@A@@B@AA@A@@EA@@AC@A@@@C@@@@F@@CD@AEDB@@B@@@@CLB@@@C@A@A@C@@@ABABABAFCB@@@BA@@BA@AAC@A@@@@BAB@B@DBBBB@B@DAB@BA@@D@D@B@B@FA@AB@BAF@D@BAFB@@D@H@B@@@BB@B@B@DABAD@@@BB@@BB@@@JAB@DAB@BAFA@@B@BBBB@@DF@BBBB@@@B@DE@A@ABA@A@CD@@ADA@@B@@DB@@DD@@DD@@HB@B@FAB@B@B@@A@@@@@ACA@AAA@@@AB@B@FADBB@D@@@B@@@BA@ABA@A@@@AFC@@@@A@@@A@@CA@@CD@@BB@@@BABA@@BAF@AG@@A@A@A@A@@@A@AAB@@@AAA@@A@@BABADAB@B@BAB@BABE@@BA@@@AA@@@CAAA@@@A@@DA@A@A@A@C@@BA@@B@B@B@@DB@B@@@D@@A@@@@D@BAB@BA@@BA@AD@@@B@@@@CB@BA@@DB@CB@@BBABCC@@AA@@G@@FAB@@GB@@ABAD@@CB@F@@@BAA@@A@C@A@@CAA@CAACAAAAAA@CAC@@@C@A@@@@AAA@CAA@A@C@G@GBA@@@@D@D@BBBM@ACOBBD@@O@@A@AAAC@AAC@@E@AFCB@@AAKAC@A@AA@A@@BC@C@ACCBE@@@A@@AGB@A@@@@A@@@@BC@AB@@CBCB@BEB@@AB@AC@@A@@BEE@C@@D@BC@BHA@@BBF@D@F@@@@@B@B@@G@E@@A@AA@@EE@@CCB@AIBA@E@@J@HI@AA@@I@@F@@E@AB@@G@@B@@B@@B@@A@@DA@G@@@EB@B@@C@@BA@@@A@@@A@@AA@AA@@AB@@A@A@AA@@A@A@@@A@@CA@C@E@@@E@E@@ABCA@@AA@@@K@@CC@A@@DA@K@@AA@@BG@@AI@@CA@@BA@@AK@@CA@A@@A@AB@@@@@@A@@@A@AA@@@@@@CE@BC@A@@I@A@A@@@CA@AE@@@A@E@C@A@@@@D@@@@A@@BBBAB@@@B@@A@@B@@BB@@@@@BC@@D@B@@ID@@@B@BB@FA@@@HF@@@H@D@@FB@@@@D@@@@@D@@C@@@@HA@@CC@C@@@A@@@C@@A@@@AA@@B@@A@@B@BB@@DA@@@ABC@@EE@AA@@@AAAAE@@@C@@@@@AC@ABAA@B@BC@A@@BA@AAED@BA@G@@@C@@CI@@AE@@@C@@CB@@CD@@G@@@@@CCA@@@AA@A@@CA@@AA@@@@@G@@@C@@BE@@F@FG@@FA@A@@@@HA@@BM@@@@EC@@@C@@AC@@AE@@AA@@AC@@@E@@D@@@@I@@EA@CB@B@@E@@ABAI@@@A@AAC@EA@BC@@AC@@@A@A@A@ABA@BAC@"],encodeOffsets:[[116542,34987]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
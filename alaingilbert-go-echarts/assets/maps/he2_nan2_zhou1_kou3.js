@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("周口",{type:"FeatureCollection",features:[{type:"Feature",id:"411602",properties:{name:"川汇区",cp:[114.650628,33.647598],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACACCAKIAAAA@AECAAC@C@@@ABABCD@BABEBABCF@@EJCDABCBEJCHABAD@D@B@BBJ@B@DCFC@CBE@I@A@EB@@@BA@A@A@G@K@E@QAEB@@A@@B@@@DADABABA@@@CBC@EBABCBCFK@OB@@GT@ACAC@@AC@@G@@AHB@@FC@@DCACB@@@AGB@ED@@G@CD@AK@@A@AB@D@@@@E@@@ADA@ELODcJ@@@B@@AD@@AB@@@@A@@BCB@BA@EB@A@ACBCB@B@@@DA@@D@@C@@AC@@@B@E@AAA@@@@@IGB@@@A@IG@@AC@AC@@CA@C@@DAB@BE@@BC@C@@@BB@BA@AFAC@B@@ABA@@FC@@DA@@F@@@@@BD@@@H@B@@FB@@DD@@@B@@@B@BDCB@@B@@@AB@@I@ABC@@B@@A@@@BD@B@DAD@@@BAB@B@DBBBBBBFBDBFDBBBBD@ND@BHBBBDB@@@@@BAHAD@@BJ@B@FAB@BABA@EBABA@C@A@A@A@AC@@A@@@@BB@@B@BB@@@@DJ@BBADF@@@@B@F@D@@A@@BC@A@EAA@A@@B@BD@@BE@@DE@@B@DB@@FB@@BD@@DA@BDM@@F@@ABFB@D@@@L@@BAB@BBDADB@A@@B@@CB@@@@@D@@@BADCBCBA@A@CB@B@B@B@@AB@BCBA@@BB@@BDBB@F@@J@B@@AD@B@BABA@@B@D@@BN@@DBN@@@B@@A@@FB@@DB@@DB@X@@E@@@B@DD@BDE@@DA@@DC@@BCBABB@@F@@FB@F@BB@@AF@@BB@BAB@@@@ED@B@ACB@@AB@@@B@@E@@@@BI@A@@B@BAD@HBF@FBPBTHFDB@JDDBNBFBF@FAHC@@DCDABAB@HABA@ABA@A@A@A@@B@D@LBB@@A@A@KBA@@BCBABA@CBA@@BAB@DADAFABABA@C@A@C@@A@@AA@@AAA@@@CBI@ADCBAD@F@FPP@BNBBLHCRB@LFJ@PI@BH@FKB@JA@AAKNCB@HMCI@@CACCFML_@@JA@IJILFNEBAA@LM@@CKCBIG@@MBA@CM@AGMAGA@IAEM@@QJE@@E@CCG@G@AB@BABABCDI@@@ABADAFAD@D@@A@CAC@AAAE@C@C@A@AA@CAA@ACA@@EEEC@@EGAA@A@GBC@@BCBC@@@A@A@ECEAE"],encodeOffsets:[[117559,34321]]}},{type:"Feature",id:"411621",properties:{name:"扶沟县",cp:[114.394821,34.059968],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@C@@G@@IA@A@E@BA@AD@@@AAA@@@@@A@@@@@AA@@WB@@@@@@@@E@@@@C@@C@C@@AA@B@AAA@@@@@K@@@@A@@@E@@I@E@@@@EYGMCEAIA@@YE@@@I@@C@@@@@@AA@I@@@@@@@C@@F@@@@A@@@@D@@B@@D@@B@@D@@@@@@@DB@@DAB@@ME@@@AA@UA@@@HD@@DC@@DC@@DC@@B@@BDFHDH@B@@@@C@@@E@@B@@A@@B@@ADA@@DC@AD@@@HAPA\\AP@FC@@H@@@FA@@DB@B@@@A@@BE@@DF@@BB@@DAJ@D@BCB@BDH@BDJV@B@@FE@@@@HE@@FC@@F@@@D@HH@@BD@@NA@@B@@A@@FB@@BA@BHC@A@@F@@C@@B@BG@G@@AA@A@@D@BEA@H@BE@@@A@C@@D@@ALB@@H@@@BB@@DB@@F@F@@AF@@@F@A@DD@ABADCFADDB@B@DA@C@A@@BK@@CA@@DCBC@BEA@@DA@@D@BAB@D@@B@@B@BB@@B@@@BB@@HE@@FBB@D@@I@@B@B@@@@@DA@@B@@@J@@I@@BB@@BB@@@@DD@@F@BBH@@A@@BIB@BA@@DE@@@@@@BC@@@@@D@BH@BB@BBB@@@B@@B@@B@D@@@@@@@DA@@D@@@D@BBD@@@@@B@@@@@@D@@BFG@BF@@BF@D@@@@D@BB@B@@@@@BFA@B@@B@@@DH@@BBB@BFB@BFB@@B@@B@BF@@DD@@@@BBA@@B@@CB@@C@@HA@@@@DB@@@CB@HABA@A@@BG@@@@BH@@BC@@@C@BD@BB@@DA@A@B@C@BB@BCD@DBJD@BBB@@@@BE@@BA@BFABE@@B@@@@BB@BA@@BF@@D@@C@AC@@@F@B@D@@@@BJB@@D@@BD@@@@A@@@@BA@BDBFBABF@@BFB@@DB@@CB@@DB@@BD@@@@DBB@@AT@@@@AD@@A@A@BBAB@B@BCDCA@HI@@@A@B@A@@@@BA@@FA@@LBD@B@B@DA@E@A@@BCB@BABBBCBA@EDB@AB@@@@@B@B@@@BD@@@DB@ADDB@HF@D@H@@D@@BD@BFB@@BD@@D@@F@@@DA@@D@BFD@FE@@@BRC@DB@D@@C@@B@FA@@@@D@@E@@H@@ADAJA@BF@@@D@@@@@@@F@B@@AB@AAHADA@@DA@AD@@@@EB@@@@@@@D@B@@@B@@ED@BA@AF@@AD@@D@@@AD@@A@@JA@@@AD@@BBBB@@@@@ACF@BBB@AAB@@DB@@@@C@@@@@ATCF@DAB@@@B@@@B@@@@@DA@BDA@BD@@BB@DA@@@D@@H@@DDABB@@@AB@B@HAB@LJ@B@DA@@@BJBFBJ@BJA@@D@@@NAD@@BB@@DD@B@BBFFCB@FB@@@H@@@BB@@@B@B@@@F@@@DBB@D@@@DA@@@@FC@AF@@@@GBBDG@ABAA@B@@ABA@AF@@@B@BAA@@@@ABE@ABE@@@@DA@@CC@CAA@@B@FB@@@D@@@L@A@F@ABH@@@A@@BB@@BF@@BD@@BDB@@B@@@@D@DCB@FF@ABA@@B@@@BD@@H@@@@B@@E@@@@B@@B@@@B@B@@AD@@@@C@@@A@@DE@@BE@@D@@D@@B@@B@@BF@@AB@L@@A@@@C@@BAC@@A@@@@@@@AA@BED@@A@@BAFBBCB@@CF@AAD@@AD@@A@@R@@BF@AB@@A@@@@B@@@B@BABC@AB@@HB@F@A@BD@@@L@@B@@H@B@B@@@B@DAD@BAD@DAAAEBAAADCA@ABB@CB@BBBAB@FKFB@AD@BABBB@BCA@BEAABABG@@F@B@BADA@@@BD@NAD@@L@BB@LA@CD@@A@AA@@AAA@@F@DA@B@DF@BCA@@CH@DAB@@ACBG@C@@@@AA@AA@AB@@A@@@G@@@@BAB@@@D@@@C@@E@AB@@CAAA@@EB@CC@@D@@@B@@CB@@BD@@B@@@AF@@B@@@AB@@AD@@A@@@BD@H@ABB@@FB@@@@AF@@BH@@@B@@@D@@@@CD@@@G@@@A@BMCA@AB@@C@@@CD@@CD@DA@@AA@BA@BAB@@@F@B@ABH@@@D@@BN@@@@BF@DA@@@@F@@BB@@BH@HBBBB@@AB@D@FA@@D@@@@@B@@AD@BE@@@@DA@ADA@CF@@CFB@CD@@AN@@A@@@CC@@@E@@AA@@@@E@@C@@AC@@AG@@@BC@@C@@CAA@A@CAC@@@A@@@@AA@@A@@@@A@@DCDA@@B@B@@AB@@C@@@EBAB@@A@A@@@AA@@AG@@AC@@@@CDCLBBADAAKD@@@@C@CAA@@G@BC@AGDBCB@@C@A@AB@@@B@B@@@@C@@@@D@@@B@@@BCB@@I@@F@@CDB@A@EA@@C@A@CB@@A@C@@@DA@BCC@ABC@@A@@A@@BMAB@C@@C@@C@@AC@@@C@@BA@@@@@IAABA@C@@E@@@@A@@@@@@AC@@@A@@@C@@@@@A@@A@@AB@@A@CB@@BKI@@GC@@@G@@@@@I@BDC@@BE@@FB@@@E@@B@@@@A@CB@@G@@@C@A@ASA@@AH@@C@CAEA@@BC@@CEBC@@A@@AA@@G@@@CO@@@E@GAE@CC@@A@@@EDE@@@@AAB@AABA@@BA@@B@H@@AB@DEB@DG@@BCBA@C@@@ABABABABAB@@@@A@A@A@A@@A@CAE@OGGC@@@EBI@@@A@AAA@C@@@A@ABCBGBA@ABE@E@AJB@@H@FB@@@@@BB@D@@AB@@DFB@@F@@D@@@F@@@BB@ABF@@@@@B@@@BBPC@CB@@@B@@CD@BCJ@@@@@D@@BHEBB@E@@D@@@AABA@@@@DU@A@@BE@@BAB@BI@@A@BAAGCB@@@C@@@KIA@DI@@AC@@EC@@MBC@KI@BIG@@A@IAA@C@A@@C@@CA@@U@C@A@@NA@@@@F@@@@AB@DAB@@@@@B@@@@GA@@@@@@C@@@@@@@CB@H@@A@AD@@A@@D@@EH@@@DA@@@@@@F@FAAAB@@@AG@@C@@B@@A@@CA@@@@AA@@@@AC@@@@@@CA@@@@@EDE@@@@AA@@@@@E@@@@A@@CB@@@A@@A@@F@@@BO@@@AAE@A@BEA@OA@C@@EA@@@G@@@AAB@B@D@@A@@B@@AA@AA@AB@@BB@@@@A@@B@@A@@@AB@@A@@DE@@@ABA@G@@@@@A@@@@AE@BAA@@@@@@@A@@@@A@@A@AA@AAB@A@@A@C@@@@CA@@@CB@@@@A@A@@@EAAAA@A@A@C@C@A@@@E@A@EB@@AA@@@@A@@GB@@@@A@@D@@AD@@A@@CA@E@@@E@@@@G@@FE@@D@@CA@D@@G@@@@@E@A@BBA@@@@@AAA@AAG@@@@A@@A@@@@EA@A@@@C@@AC@@@@A@AB@@@@E@A@@A@@@@AB@BA@AFCBA@A@ABADA@@DA@A@@BA@C@A@CBEC@@@@C@A@@@@C@CBA@@@@G@@A@A@@AA@@G@@@@@@@C@@@KCC@@@C@@@@@@@@D@@@@C@@@@C@@AA@@@AB@@I@@@@BA@@@@@]C@@@HC@@@@@@AA@@@@C@@KBI@@B@@E@@B@@@@@BA@KB@CA@I@@@@AA@@@@@C@@BIB"],encodeOffsets:[[117172,34702]]}},{type:"Feature",id:"411624",properties:{name:"沈丘县",cp:[115.098583,33.409369],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@OAMBA@I@G@EBCBG@MBC@I@C@C@AAA@AACACAA@A@AB@@A@@BABALAB@@C@A@@@AAECAAEAAAA@KDE@ABG@CBE@CDIFGFC@@@C@GBE@IBE@CDA@@B@F@B@H@DBF@DBFAB@BA@E@A@AD@@A@@@ABA@@BCFCBC@A@G@CD@@A@@B@@@B@B@B@B@B@@AB@@@B@B@@@BD@@BD@@BB@@BF@@@F@@@B@BFBFEBAC@@@D@@@BB@@@F@A@BADJBDBBBFBDHPFHDA@BBA@BA@@@CBDF@@B@@@B@DBD@@AB@B@B@@@BBB@@DB@@@@@D@D@@@@@@@@ACC@A@@@A@@BA@@@A@A@@@A@A@A@A@@@@@ABA@@B@@@FABBBBB@@@B@@@B@B@BBB@B@B@@B@@@@@@@@BABA@A@@@@B@@@F@@@DA@@BA@@F@@@@ABAB@B@@@B@@@B@@B@B@@B@BA@ABA@@BBB@@@DBB@DB@@@@B@@ADCBA@AF@@BB@@@B@@BA@@F@@@B@@@DB@@DB@@BD@@F@D@FBD@DA@A@@@A@@DC@@B@@@B@BC@@DA@@BB@@BC@@FA@@BBDD@@D@D@@@@@B@@@B@@@B@@@B@BB@@A@@@BB@@B@@@@@BA@@@@@@D@@AB@EA@@F@B@@BA@BA@@DD@@DBA@DDAB@B@@BCBEF@@BDDDBB@B@@@B@H@F@B@D@F@V@@@J@@@B@@@B@B@@@B@B@J@B@H@H@B@D@F@L@D@D@D@DBB@BBB@BB@BB@@ABCF@@AB@@@@@B@B@A@DB@@BCBB@@@BBCB@@@AGB@BC@CAA@@@@BA@@DA@@@B@@@A@@FA@@BBA@BDA@DA@@D@BABAB@DABAB@B@BABA@@@A@@@@@BFD@@@@DB@@DB@LB@@B@@BD@@@F@@@@BH@@DF@@@B@@BB@@DA@@BA@AA@J@FF@@@H@@D@B@D@@@BA@A@@H@@@@E@@@A@@@A@@FBA@FA@BBB@@@A@@B@B@@B@D@@B@@@B@@A@@DED@@E@C@A@B@DDBB@@AB@@A@MAC@CBA@A@ABABCD@FAB@@@DCHCDCB@@ABABGBGDA@EBCBA@EDGFCBHJB@@@BBFB@@BBBHBDBB@B@DAD@B@@@D@H@BBJA@@BB@@D@@F@F@@@D@@@B@ABHB@@D@B@ADH@@DD@@CDBD@@DF@@@@B@DABABAD@B@B@BBDBD@B@B@@@@@BB@@DB@@@@@D@@B@@B@ADD@@B@@@@FB@BABB@B@@@B@BAB@@@D@BAA@BABB@@B@@@B@@@@F@@B@@BAB@B@B@@@@C@A@@BGF@B@BA@@@@B@@@BAD@@CB@BA@@@@B@B@@@BA@@B@@@B@@@@B@@@B@ADA@@BD@@BB@BFB@@B@@@@@DA@BDG@@A@@@AC@@BAA@DC@@B@@A@@@@B@@@BA@BBBDCB@BB@@B@@@@@BB@BDB@B@@B@@@DBF@@@DB@@DD@@@BAD@@IBB@AB@@@DA@B@@@A@@@@B@@BHA@BB@@@D@@@B@@A@AB@@@@@B@@A@@AGBAAAB@@@BBDA@@DA@@B@@BB@@@@DF@@BF@@BB@H@@AB@@AB@@DB@B@BAB@DBB@BBBBA@@BBBJA@BB@@@B@@C@@FA@@F@ACB@B@@C@@BABADADA@DD@@@B@B@@@D@@@D@@BD@AAF@@@D@@@FAFA@DB@@@BFJA@BD@B@F@AGD@@A@@@AA@AC@@@EB@@I@@NAB@@@B@@CHA@@DA@G@@BEAEF@@AAE@@@AE@@@AC@@ACAG@@L@@@D@F@@AH@@@BBF@@CB@@@DC@CB@@A@@@@B@@@@EC@@@@A@EHCB@@@B@@@@@@AA@@A@EBA@CBC@AB@@CD@@C@AB@@@@@@BDA@@@@DAAAD@@AH@@@@@@@F@AAFAB@FA@@@@B@@@@@B@BBF@@B@@JAAADA@@D@@AD@@AF@@@DFDDB@@@DAB@@AB@@CJ@@@F@@B@@@@F@@AB@B@@@@@@EB@@@@A@@@CB@F@@BD@@@@DB@F@B@@CB@@E@@@BD@@BB@@@B@@@B@@B@@@@BED@@DB@@@B@@EBGH@@@F@@BB@H@@BD@@@D@BC@@@A@@@@B@B@B@BABAB@F@@BF@@@@BD@@BB@@BB@@CBI@CNQBADEDCBC@C@AAA@ACCAEEAAACC@AMS@A@A@A@ABABABAB@J@H@H@D@@@N@FBFA@ADO@ADI@C@A@ABI@EAE@A@ABAFAJBJ@DAB@@AES@@@CAE@GCI@C@C@@@ADEBG@AACA@C@AAEBC@CBABCBC@C@CAEAAA@AAB@@A@@@@@@AA@@@@A@A@A@A@@@A@A@AAI@@@ABAB@BA@@BA@@@A@ABA@A@A@@B@@A@ABA@CB@@A@@@A@@@A@@@A@@@A@A@EACCCGEGGAC@G@CDI@@@E@AAAAAAACCAAAC@@AAGACACA@C@@ACBA@@@AHAJADA@A@C@AAAA@AAE@CAE@ACA@KAKAA@AAAAAAA@A@@@@@@A@A@@AA@CAAAI@AD@@@@A@@@ADA@A@A@@@CEI@@@G@ABABEBCBEBABA@ECI@A@@@@BAB@@A@A@@BAD@@ACM@AA@AAA@ABCDC@A@@@@A@AAKAC@AA@A@A@A@CDABC@A@@@QDCBAAAA@@AEAAA@E@C@CBCBGDC@C@C@A@@@CC@A@@CGCCEAI@@@K@C@GCCAAAAAGGCGA@@BADABA@ABA@ABC@A@C@AB@BA@CAOGOEAAECEEEI@CAC@M@CACA@@AIAIAGAIAC@E@G@E@]@"],encodeOffsets:[[117885,33878]]}},{type:"Feature",id:"411622",properties:{name:"西华县",cp:[114.529756,33.767407],childNum:1},geometry:{type:"Polygon",coordinates:["@@GBM@A@@@A@AB@B@F@J@D@B@B@@B@@BB@@@B@@D@B@BABAD@@@FHB@@@BA@CAA@C@@B@J@@G@ABAA@B@@C@GCA@A@G@@@@@@J@@@JF@@F@@@@AB@BCB@BC@@B@HC@@FA@@NA@A@@J@@@@F@@@@DA@@DB@@R\\@@@@B@B@@@D@B@@@B@B@@@BB@BDB@@@AFCAAJC@@PA@@BC@@AE@@FA@@B@H@BB@B@D@B@@@J@TB@C@A@BDA@BBDB@F@B@@@@AB@@@@@D@D@B@N@B@B@D@@B@@AD@BC@@BB@@D@@BD@DA@@@@DA@@@BLB@@HA@@@@BC@@BA@@BA@@B@BA@@D@B@B@B@@@DD@BHB@B@@HB@@DB@F@@@ACB@D@B@@C@AV@@@@AB@@@@@@ABA@A@BB@@@@@@A@@@@D@@E@@D@@@@@@ADBB@B@@@BD@@@DB@@@B@BA@@B@@@@@B@@@BDB@@@@BA@@@@B@@@@BB@@@B@@@@@@BAB@@@@A@@@A@@B@@@@@@A@@@A@@AA@@@A@@@A@@B@BBB@@@@@B@@B@@@@@BD@@@B@@@@@BAB@@@@@B@@@@BBB@@B@B@@@@ADA@@@@@A@@@@@AB@@@@@BBB@D@@@@AA@@A@@DA@A@@@BBB@@@BB@@@B@@BB@@@B@@@@@@A@@@@@ADBB@BA@F@@B@B@@@@B@D@@@BB@@BBB@@B@@E@@@EA@@CDCHE@@BAB@@@R@B@B@B@HCD@BAD@B@@@@@BC@@@CBABC@C@@P@@BD@BB@@@@B@TCAGJAAAB@AEH@@@B@@AD@@AB@@@BA@@A@BABB@@B@B@@@BC@@B@@@AC@@B@@AB@@@@BB@@@D@@@@B@B@B@@B@@@DDFBB@BB@BB@@@DB@B@BB@@BB@@D@HL@F@D@@@BF@@BHABDLCB@@BD@FB@BFDABB@@BBB@@F@@D@B@BBDBB@D@@@@HA@@DA@@DA@AD@@@D@@@F@@B@@BB@@@BBB@@BEA@B@BA@@B@@@DG@@@@@@BEAALCAAB@@A@@D@@AB@BA@M@A@ATXB@DDBA@@BAD@@@@@@@@@@@@DB@@@@VBB@@B@@NF@@BA@CA@@C@@@@@@@CA@@@@CA@@@@C@@B@@@@@@ED@@@@@@@J@B@@B@@@@D@@@@J@@ZF@@JBFBNDZH@F@@F@J@@@@F@@@B@@L@@@@@B@BBA@B@@BD@D@@@@D@@F@@@@@@@@@XA@@BB@@@@B@@@@@B@BB@@C@@BABF@B@B@@JH@@@@D@@JA@AD@@@@@B@@B@@J@B@@DLAB@@A@@@@@AF@@@@AJ@LA@@@D@@B@@B@@@@D@@G@@^D@@@@B@@A@@J@@@BA@@B@@BD@@@@@@D@@C@@@@@@@@@@D@@DD@L@@@D@@@@@@@HB@@BB@B@@@@H@@B@DAD@@@@@@B@D@@D@AF@D@B@DAB@@@BCB@@CBAB@B@BABED@BABA@@B@@B@@@@B@F@@A@@B@B@@D@@BD@@@B@B@@F@@B@@@@B@@H@BBB@BB@@@@B@AAB@F@@@@@H@@@@CDB@@@CF@@EH@@@@@@F@@@FDB@@@BC@@BC@@@@B@@A@@HB@@@@@BB@@FAB@F@@@B@D@D@B@B@B@BBFB@@B@B@@@@@DA@@B@@D@@D@B@@@@BBA@BBBB@@@@B@@B@@@@@@@B@ABF@@B@@B@@@@@H@B@BA@@F@@CB@@@BA@@B@@@@AB@@@@@AA@@BAB@@BBB@@@AB@@@@C@ABA@B@@@H@@FB@@@DPBB@AFB@F@BB@@P@@A@@@EB@@@@B@@DA@@@B@@F@@@@@B@@B@@F@FC@@@@B@@D@@@@D@@B@@B@@B@@B@@DB@@@@AD@@@BH@@A@BB@@BFH@@@@DB@@FD@@BB@@DB@@BB@@DF@@HLB@@@@BABAB@@EB@@BD@@EB@@CF@@CF@@@@@DAB@BKB@@@FCB@@@J@RAP@@@@H@@JA@B@B@@B@BA@@BG@AB@D@D@PA@@@C@@B@@C@@B@HBD@@AB@@G@@@I@@@@C@@BC@@@@CA@A@A@ABCB@@@ID@@C@@@CB@@A@@@@C@@AA@@CB@@A@@B@@@F@@AHAB@B@@@@A@@@@BA@BHA@E@@FA@E@@B@@AD@@A@@@@B@@A@@B@D@B@@AF@F@AAD@@@@@B@B@F@@@@EA@@A@E@@A@@AC@@@@@EAG@@@@@AI@@@@AE@C@AAC@A@@@CAA@AAAEILA@@@E@AABG@EB@@CCACAA@@@A@@F@@@@@@AAK@@@@@A@ABA@@DA@@@ABA@A@ABA@@BABA@@@A@A@A@EACD@@@@A@@@A@CB@@CB@B@@@@@@@@@F@@@BA@AA@@@@@@C@@A@@@@@@@BA@ABA@@@E@@AA@@AA@@GAA@@A@@AA@ABC@ABC@CBAD@BABCDCBA@@@A@ABCAAEB@C@@@@@A@@AG@@@@A@ACA@AG@@@@@A@A@I@CA@B@@E@@@I@@AYBAB@@@B@@@@ABCFM@@@A@AE@@@ABA@G@AAC@AAE@C@MAEAA@@@@FE@@B@@E@GAAACAC@AAAA@AA@EAA@@@@B@JA@@AA@A@@@A@E@@AK@A@@@C@@@@AGAK@@A@AC@@A@AB@@@@@@CF@@IB@BE@@BCA@@C@@A@@A@A@A@A@@@CA@BEA@BA@@@EF@@AFEBA@@@@AA@A@C@AAAA@@AE@@BA@@A@EEA@@@EA@BADA@AD@@CB@@CF@ACC@@C@A@@@FW@A@@CA@@CA@@EB@@@@A@@AM@CM@@AC@A@@@ABABA@C@@BA@I@@@@EAAAC@@AA@@ABADA@@BA@A@A@A@@D@BABADCDAB@@C@@@@@A@@DA@@@@BCACBAAA@AB@@@K@@@CEABA@@@EN@ACB@@CC@@AA@@EA@@C@AF@@CF@@AC@@A@AB@B@FBB@D@@AB@@@@C@E@A@@E@BCAAI@@C@@A@@A@AA@@A@@B@@@BDB@B@B@D@B@BAFAB@BA@ABA@E@AAI@@BCBG@A@@@@CAAAGA@AMCC@AAAAECCAEAAAAAAA@C@ABA@A@@BC@C@AAC@@AAAAC@A@A@A@C@ABAB@@CBCBABAB@@ABABA@A@C@A@CCA@AAC@A@@@ABABCDAB@@ABA@A@CAAACAEAAAA@A@C@ABCDAB@@ANADCFCF@DCDEHADCD@BABABADAF@DA@@DADABABA@ABC@C@CAAACAICA@E@E@A@ABCBEFABE@EAA@A@A@@BCDABADCDCB@@ABA@@@A@A@AAAACCAAA@A@A@CBGBABC@C@I@@@A@AB@BADCFA@A@@BA@@@A@@@CAA@AACAAA@AA@A@A@A@@BEDEDAB@@A@@@A@A@AAACAA@AA@@@A@CBABC@A@A@A@@AAA@@A@@@A@ABAB@FAB@@@DBB@BBD@D@FAB@BABA@CDABIBCBA@@@C@@@CAA@AAC@A@A@A@CBED@@CB@@ABA@@@@@GBC@A@C@C@E@AAA@AAAC@AA@@@A@@@A@@@AB@BAD@DAFAD@BA@AB@@GFA@AB@B@@@BBF@F@F@D@B@@@@A@A@A@EAA@A@AB@@A@AB@@ABABEDABABA@ABA@AAC@AAA@@@A@@@A@@@@BABAD@@AD@@@@@B@@@B@@A@A@C@A@AAA@@@A@A@@@CB@@ADCDABCB@B@@ABA@@@A@ABA@@@@A@@AAAA@A@@@@AA@@@A@@A@AC@ACACAACAA@A@@A@AAAC@A@AAC@A@ABC@A@C@AACACACAC@AAAAAA@ECKAEACAAAIAEAE@A@A@C@A@A@@ACACEAA@@@@A@A@IB@@CBCAE@A@AAAACACAEEEAAA@AAA@A@CBC@@BA@A@CA@@AA@A@A@A@C@ABA@AA@@@@AEACAAAAA@@@A@A@C@ABABABADA@A@A@C@CCGEACAA@A@A@A@A@A@ADC@@@AA@@AA@@AA@A@C@K@E@A@KBE@"],encodeOffsets:[[116869,34460]]}},{type:"Feature",id:"411623",properties:{name:"商水县",cp:[114.611651,33.542138],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@E@@AFIA@@J@@@QA@EI@AA@CC@@@@@C@@B@@B@@BA@@BE@@CA@@DE@@BE@@B@@@@@@@@@BD@@DCBA@@F@P@F@@KAAB@@A@C@@A@@AA@@@@@E@@@CC@@CC@@@A@@B@@C@@@C@@@D@@@C@@BB@@@D@@@A@@BA@A@@AA@@B@B@@@BB@@DB@@DB@@DD@@D@BB@BBC@@@B@@@A@@BB@@@A@@B@@@@@FD@@@E`@@E@ADC@@B@@C@@BC@A@ANA@@B@@@AC@@B@BCA@@@AGA@@@CA@@AI@A@@EA@@CC@@AA@E@@EA@@AA@@@G@A@E@@@A@@DA@@BE@@B@J@@E@A@@@CB@BA@@AA@AB@B@@@BAA@D@FA@E@@B@@@AE@@A@@@@A@A@@@@@@BB@@B@@@D@@@@C@@BA@@@EB@@A@@@@@G@@C@@AC@@@DA@@AA@@@@HC@@BD@@@C@@B@@@AA@@BA@@B@FA@@D@@@B@BB@@FBBA@@BC@@@A@@@C@ACGB@@@DA@@BC@BBB@@D@@@BD@@BB@BH@B@@@BC@@B@B@B@@A@@BAB@BC@@@A@@DD@@DB@@@@@@BB@@HB@@@B@BH@@BB@BEB@@@B@B@@AB@@@D@BK@@AA@@@A@EA@B@DA@BJ@BA@C@A@CD@@C@@@I@A@A@@@BC@@@@A@@@A@A@A@A@E@CBA@ABA@C@@ACA@OK@AA@BC@A@E@@D@@O@M@E@A@KA@AC@@BA@@AB@@AE@@AG@B@DA@@@@G@@B@D@@@F@@DA@@@@@B@FB@@D@@BX@B@DC@@BC@@@B@@@FB@@C@@DA@@DA@@CE@@BC@@AA@@CA@@DA@@@@DA@@C@@@AA@@BE@@E@@@DA@@@@AC@@@@@@A@@A@@BCA@A@@@C@@@I@@@BGB@@@F@@@@A@@DG@BDA@BLA@@@@BB@@D@@@FD@@AB@@D@BC@@B@@@DA@@@C@@FA@@CA@@DA@@EC@@FI@@@@@@@@B@D@@A@A@MA@CB@@@C@@D@@@GA@G@@AB@@A@AA@@BE@@DA@@C@@@AA@@D@@A@@BA@@CA@@D@@@AA@@DA@@A@@E@@B@@@BC@C@@@@B@@A@A@A@A@A@@@AAA@A@@BE@@AA@@A@@A@@G@@@@@BKAA@ABA@A@@@AA@A@@CB@@@@@B@CG@@AK@@CG@@D@AA@E@@AA@@EB@@IC@A@@BB@@DC@@BA@@DD@@DB@@B@@@HC@@CA@@BC@@CA@@DE@@BA@@AC@@AC@@CA@E@AB@A@@@EB@@ECB@@@FC@BEC@@B@D@@@D@@@@B@@HB@@@@@@B@@BAB@@HA@@DA@@@@@A@@DA@E@@BC@@AA@@@@@@@A@@C@@@@@@@B@BA@A@@@@B@@@@@@AA@@CA@HA@@AC@@AC@@DA@@F@@ACA@C@@DA@@BB@@BA@@BB@BBE@@@F@@B@@E@@@BBC@@AA@@BEB@AB@ACA@BFC@@@@@@BC@@@@FA@@@C@@BC@@B@@A@@@A@@BA@@CA@@DC@@BD@B@E@@@E@@@C@@A@@C@@AD@@@C@@AC@@A@CI@@A@@@@@CA@@DA@@C@@@DA@@CA@@CA@@@@BE@@FBB@B@@G@@BB@@B@@@B@@@B@B@BA@@BA@@BA@@HD@@BA@@DD@@B@@@B@FA@@BA@@@@@@BA@@@B@@BA@A@BD@BD@@BC@@DC@@DAB@B@DD@@@DB@@@B@@F@@@B@@@@FF@@AB@@F@@N@@BE@@H@@DB@R@@BB@@@@@@@@@HA@@AA@@D@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@BDB@DB@@ABB@@@BAFDBAJBFB@ADK@@AK@ACA@C@@@@B@D@@@@@DA@@DC@@F@BF@@D@F@BE@A@@@CA@@@@ADA@@@AF@@C@@FGA@BA@@B@F@B@BCB@@@DAB@@@B@BAD@B@B@B@@FD@BD@@@D@D@@A@@D@@@D@@AHDB@BFA@A@BFAB@@@B@B@@@@@@@@D@@@@DDA@HHA@F@HC@@@D@@BG@@@A@@BE@@BE@@B@D@@EA@BEA@@@@@@AB@BA@@BC@@DE@@BAD@B@BAB@@@B@BBD@@BD@H@@B@@FB@@F@B@DBHC@@@A@@B@BA@AB@@@BBA@DC@@@@BA@@J@@A@@BD@@B@@D@D@@@@FB@@BB@@BB@@@D@@DH@@@D@B@@BI@AJ@HA@@EW@@BIB@@@DF@@DA@@DA@@BA@@D@@@@@BD@@F@D@@@D@B@@@BA@@B@@BB@BBDBDBDBD@B@D@BAD@B@BBD@B@BBDBBB@@@@BBBBDDBDB@BBDB@@@@B@@BB@@@@@BBBBB@@@B@@B@BAB@@@B@BA@@@ADABADCBC@@DA@@B@B@@@B@BBB@D@B@B@@@@A@@@A@@@@BC@@BCBA@A@@B@@@B@@@B@BBD@BBB@BAB@BABAFCBABA@@BAB@@@BAB@B@FBB@B@B@@@@@@A@C@E@EAE@A@@@ABAB@HE@@BAB@@ABCBE@CBC@ABA@@B@@@B@@@B@@BBDBBB@BBF@D@D@B@D@HA@@@@B@BA@@DA@@FCDAB@B@B@D@BBB@DB@@D@@@B@DAJABADCB@BA@ABA@E@CAC@AAA@C@@BA@EBABAB@@@B@@@BB@BB@B@B@D@BADAB@@@B@@BBBBDBBB@B@@@B@@@BAFCFC@AB@B@B@B@@BBBDBBBB@DB@@B@@@B@@AB@B@DEBC@ABAB@@@J@D@D@BAHADAB@B@B@BBDDBBBBB@B@@@B@BA@@DADCBCBADC@AB@B@B@FBF@BAFEDABAB@F@F@B@JDDBBBDBD@D@BAB@BABABC@CB@@CBEBCBABA@ADCBCFGDC@CDEDEBCBM@@BADCBAD@B@B@BBFBDBBBDBB@B@BA@@BADCBABA@@B@D@BBB@DDB@D@B@B@BABA@@BABADADA@@BABAD@B@B@B@D@BBBBB@@@@AD@BAJ@@@BA@@A@@@DAACA@@@A@@@C@@CA@@EA@G@@@C@@A@@@@@EB@@CD@@EB@BA@@@ABDBEB@@AAA@@D@D@@AF@@ABA@CD@B@@DD@@BBD@@JHB@@@A@JH@@@@B@BBF@A@@@D@@BD@@@@CB@@C@@@ADADA@B@BFAB@@ADA@AB@@@@@BA@@BC@@@A@@dIPCFKB@BC@@F@@@@@@CBAB@@@BLC@@D@HC@@FHA@B@@DADB@CD@@EA@BG@@@HD@@BD@DB@BHS@@PAL@DEDABAFAD@DA@@B@BABABC@C@@@AB@@@FARBF@L@H@B@B@B@@A@@FAB@J@F@DAD@DE@C@AAI@A@A@CBCBADGFIDABADCFI@@DEBAFABA@ADCBABA@@D@D@BBFD@BBBBBLJDBBDBDBFDF@F@B@B@@ADAD@@AD@H@BBBFH@@FDFF@@DB@BBB@DBBB@D@D@F@BB@BBD@D@BH@D@@@B@BABADCDCBAFCFCBCBADCBADABADCDEB@BCBA@AJGDEBA@@@ABA@AAE@EACAA@A@@@CB@BCPG@A@@AAB@AAAAABCEBA@@C@@AA@@AE@@@@CAA@BA@@BE@@@C@@AC@@AA@@EC@@AC@@@@AD@@CB@@@D@@AH@@@B@@@D@@@@@@@BA@@B@@C@AAABGC@@@@@@E@@G@@C@A@@C@@C@@G@@AC@@BAA@AC@AAA@@@@CEA@@CFAF@@AB@B@BA@AB@@A@@B@B@BC@@C@@@@A@@@@@@A@A@@C@@@E@@@A@@AA@@BGAA@@A@@@@@@@C@@@A@@BA@A@ABAA@@AI@BAC@@@@A@@A@@A@@C@@@C@@AG@@@@@E@@@C@@@C@ABA@@@@G@A@AD@BB@CCAB@@AB@@AAA@@AA@@@AA@@A@CA@@CC@@CB@@AA@@A@AA@A@@B@@@E@BBA@@@CA@@@@DA@@AA@EA@AAC@AAA@@BA@@@@BA@@BE@A@@@@B@@@@@@A@@GA@AE@@@@@@A@C@@BAC@@@@A@CA@@@@AA@@A@A@ADB@@BA@C@@B@@A@@@@D@@A@@@@B@@@@A@AB@@@B@@@B@@B@@B@BCC@@@@A@@@@@AD@@@BACCAB@A@A@@AFCA@ABA@CBACA@CAA@G@A@@D@@@@@@A@@B@@A@C@AD@@@@@@@@@@@B@@G@AA@@AA@@GBA@AB@@@AAAC@@@A@@@AB@@AB@@AD@A@@C@C@@B@HA@@@@@I@@@AA@@A@@D@@@@@@C@@@EA@@ACD@@@@A@A@C@CA@@ABA@@@A@CAA@@@@AA@A@@@@AAA@@CG@@AAA@CAAAEAGC@@A@ABA@@BC@AA@@@EE@@A@@@@@ABA@@@@@E@@@A@@@@A@@@@@@B@@@@AB@AA@@@@CA@@II@@@A@@ACACAEA@@A@C@@A@@@ADGBEB@B@B@@A@@@@@A@@B@@@@@@@E@@@@ABC@@@@A@A@C@IB@AC@@@A@@AC@@ACA@AA@ADA@@BBBB@@@BA@@B@@B@@@DC@A@BAA@C@GA@@@@G@@@G@@BB@@@A@@HA@BEA@@FA@@C@@@@@BA@"],encodeOffsets:[[117468,34102]]}},{type:"Feature",id:"411625",properties:{name:"郸城县",cp:[115.177188,33.644743],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@AA@@@A@@@A@@AC@@A@@@FA@@DA@E@A@@C@@C@@AE@A@@D@@@B@@A@@F@@@@A@A@@BE@@@@@@AE@@@I@@DA@@BA@CB@@A@CCCE@@E@@BC@@BC@@@CBBBIB@@@AE@AAA@@@@@A@@@@@EBA@EBBBE@@@@@@@G@@BC@BBCB@@@@CB@A@@@@A@@B@DC@@DA@@BAD@DAB@F@BB@@B@@@@A@@@A@GD@F@B@@D@@F@@A@@@@@@BA@@DCD@@A@@DE@AA@@G@@BE@C@@@K@@@BHBD@@BD@@F@@B@@BF@BE@BFAF@@@HCB@@GB@DA@@@A@MB@@@JA@@F@@BDB@@B@@@BC@BHE@A@C@@AIBAE@@A@@CEBEB@@C@@@E@BBC@@AC@@@C@@@A@A@@@C@@CCBCBABAB@@@DA@A@BDE@@@EB@@@DA@@@A@@AIBAA@AB@AAAAA@CAA@ABA@A@@CA@@BA@@BG@A@@AE@@AE@@C@@A@@AA@@@CB@@CBAA@@A@BBABBH@@@BA@@@@@A@@B@BA@@@C@@@A@@AGB@AA@@@@@@B@@@ACB@@A@@BAA@JC@ABA@A@@@@BB@@BDB@@A@A@@@CB@@@DB@@@B@@BEDCBCB@@@B@@@B@DBD@@@F@D@BA@@D@B@D@F@B@BBB@B@B@@AB@@@B@@D@@@@DBD@BBDBBBB@BB@BD@@ABBF@@@@D@@BB@@@@@BD@@A@@@BF@@@B@@CB@@@@BB@B@@@DBB@@@@A@A@CB@@@A@@A@@AE@@BC@@BE@@@@@@D@@A@@@BBA@@BA@BF@@A@@@A@@B@B@BC@@EA@EB@@E@@B@@B@@DD@@B@B@DA@@BA@BDA@@BB@@@@D@@I@@AE@@@A@AAA@@BE@@@@AAB@AA@@@@BA@@@@BA@@@BDBBAB@@@@@B@@GD@B@BC@AB@@@BADF@@B@FB@@BGBBBCB@DC@BB@BA@BD@D@DB@A@@B@@ABB@@B@@B@@@@B@B@@A@A@@@@B@@AB@@@@@BA@@D@@@@@@@B@@@B@B@@@@B@@@@@A@A@@@BBD@@@@BA@@@B@@B@@C@@@A@A@@BBF@@@BB@@BD@@DB@@@@@@@B@@@@@@@B@@HB@@B@BBDB@@BDA@@DA@@@B@B@@@DA@BD@@CB@BB@BBA@A@@@D@@B@@A@A@@BB@B@@BA@@DF@AF@@@@A@A@@@@BA@@D@@BD@@B@@DD@@DF@@D@@@CD@@DB@BD@@@C@@BDB@@@B@B@@B@@@A@@B@@F@B@FD@BD@B@@EBBB@BH@B@@B@@@BD@@B@@@DA@AB@@@B@@A@ABA@F@@FD@@B@@@BA@@DB@@B@@@BAB@FC@@@A@@HB@BAABD@B@@CD@@BF@@CB@@BD@@@F@@BBA@BB@@BA@@BB@BBB@@BB@@@D@@BD@@BA@@BB@BB@@@BB@@B@@@B@@@B@@D@@BB@@@B@@D@@@BE@@BA@@BB@@D@@@B@@@@B@@B@@B@@F@@BD@@@D@@@D@BDC@B@@B@@@DA@@BBB@@DDB@B@@@DA@@@@@@@C@BDA@@@A@@B@@@BBB@B@@@BD@@D@@@BD@B@@CDB@AD@HB@B@B@@@D@@@B@@@D@@@BA@@@A@@DB@@DC@@@@F@@A@@DD@@BH@@D@@@FJCBADADADAHCDCBABE@ABABA@CBAB@@AB@LBBABA@CB@B@B@@B@BB@B@@AFAB@B@H@B@B@BA@B@@@B@@B@@B@@@BB@B@@@@BB@BB@@@B@@@D@@B@FAFCDAFA@@BC@@B@B@@@@CB@@@B@@C@A@A@@@AB@@@B@@@@@@A@@B@@@@@B@@BB@B@@@@B@@@@B@F@F@@B@@@BB@@BD@@@@@B@BB@DF@@BB@@@@B@@@@B@B@@@@@D@@B@@D@@BAB@@B@D@@@B@DFB@@@@@@DB@@@B@@B@@@@B@BB@@@@@B@FB@@@B@@@@A@AB@B@D@@A@@D@@B@@DB@@@@B@@B@@@@B@B@B@D@@@@@B@@@@@@@@@BA@A@@BBB@B@@A@ABA@@BA@@@@D@B@@CD@@AB@B@B@@@@@F@@@@@@@@BB@@@B@B@B@D@@@@@BA@@@@B@D@B@@@@@@E@@@A@@@A@A@AB@B@@@@@B@B@@A@@B@B@B@BB@@B@@@@A@@BE@@BADABAB@D@@@@@BA@@@A@ADAB@BC@@B@@@D@@B@B@@@@@BFAD@@@@@B@B@@@@@H@@@B@@B@@@@@@@AB@@@@@@@@BBB@@BADA@A@A@@F@@ED@FBD@B@@ABA@@@@@B@@B@@@BA@A@@B@BB@@B@A@@B@@@@@D@@@B@BFD@@BB@D@@@BF@BAB@BAFCHCB@@A@@@@@A@A@A@@B@@@B@B@D@@A@D@@@@@@@@BA@@B@@@@A@@@@B@@@B@BCDCDCDA@AB@BAFCD@@@@D@@@@@@@D@@B@@DDBB@@@BB@BD@@@@@B@@@@B@BDABBBF@@B@@@@A@A@ABA@@BAD@B@@@DB@BB@B@D@@@B@@ABC@@@AB@DA@@@AFCDCFCBA@A@A@AB@B@BAD@B@B@DCBABABAB@BA@@@A@A@@BABA@AB@@@H@D@D@LAD@BBB@B@F@D@FBFBFDDBFDBBB@DBDB@BDBB@BBB@D@B@BAB@FCHCDABAB@@A@C@CBC@C@AB@B@B@@@@@@A@A@@DABE@@@A@@BCFCHGBABA@@@@B@H@HAH@D@D@B@D@DBFBB@F@D@B@@@BB@@BBDBD@B@@@B@@ABC@@D@H@D@B@B@@@B@@@DBD@B@B@@@@CF@@EBA@@@@BF@@@@D@@@@DD@BD@@DA@B@@@@B@@@B@@@@@BB@BB@@@FB@@@D@@BAB@@ABB@@@@F@@A@@@C@@B@@BDAAABA@@D@@@@@@@D@DAB@@@B@B@B@D@BA@@BC@@BAB@A@@A@@@@AAC@A@@AAA@@J@H@@C@A@@@@D@@GBB@B@DD@@BD@B@B@@BD@@BB@@@@BD@@@@@@@D@@BF@@BB@@B@@@BB@@ADEBCBC@AAEAAEGAC@E@E@ADCBCDAB@@AB@@A@A@@@AB@BA@@BA@AB@A@G@EA@A@CAC@G@@BEBCBABAB@@@AE@I@CAC@A@ABADIBA@@BCBCBCBABABCFGBABC@@BABA@AB@BCHGB@@AB@@ABEBC@@BCFMBIDIDGBGBC@CBA@CBAEAE@C@]CEACAA@@@C@CAGAE@CAC@A@C@C@K@@@IAG@E@I@G@A@@A@@AOAEAAECC@CBCFC@E@E@E@CAICC@C@E@EAACCAC@EACACAACCAC@ABEFCB@@AD@FABAB@@EAGAIEGAAAE@G@EAABA@ABEDADADAB@BC@C@C@@@EECCC@M@@@KAKCIAG@AAC@@@GGCCAEAG@EAEAAAA@@AAA@A@A@AA@AAABCBC@@ACA@AACAA@@ABABE@@BE@@B@AA@ECGEG@@AACCA@AB@FAB@@EDA@@EA@@B@@@FGFCBA@CBABC@C@C@A@@H@@CBCBCD@A@@@@AA@@@@@@@AA@@@CCACAAGEKGCCCECC@@B@@A@A@AB@@@@ABA@E@A@@B@@A@@A@@@@AB@DA@AACA@AAB@AC@@@A@@A@AB@@A@@A@AA@@CB@BA@@D@@A@@B@@OAGAE@K@I@EAAG@AAIAA@@@ABA@AFA@CD@BEDCBAC@@@A@@AA@@BABAAA@C@@@@E@AAA@ABC@@CB@BABA@C@A@EAA@A@@CE@E@GA@@AA@@AC@@A@@E@@AE@A@ABABA@A@A@@@@@@B@@ADC@@@C@@AG@A@@AE@@@G@AH@FA@@@A@@CC@AF@@"],encodeOffsets:[[118045,34237]]}},{type:"Feature",id:"411626",properties:{name:"淮阳县",cp:[114.886153,33.731561],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@B@@@B@@B@@BBB@@B@@B@@B@@@@@B@@@BB@@F@@DJA@AA@@@@A@A@@@AD@@BB@@BD@@@@@H@B@@B@@F@B@BA@AA@@CA@@@@A@AB@@@@@B@@D@@B@@@@@@@@GJ@@@BC@@@@@@@CF@@@B@@@CAB@@A@@BA@AB@BCBA@BB@@B@DB@@@BBB@@DF@@BD@@@B@@A@@@A@@D@DABAB@H@D@B@BB@@@D@@A@@@@BB@@B@B@B@@B@B@@@@BB@@@@AB@F@@@@DB@@BF@BF@@@BE@@BB@@D@@@FA@@H@@B@@B@@@BD@@@F@@CF@@@B@@ABA@@DAB@@@@@B@BBDBHFBBF@B@L@H@L@VAHA@@B@@@@@@@B@@AB@@AAA@@@@@@@CA@@CC@@AB@AA@@@A@A@@B@@CA@FA@ALDLCFC@E@CBE@@AIA@OAMCAEDAB@@AB@B@BA@OF@@@A@@@@AA@@CA@@@AA@C@@@GB@@CA@@EB@@BD@AAD@@AB@@AF@@@E@@C@A@@C@@AAAAA@@@@FIDEB@@CBA@A@A@AFB@CDA@C@ADAB@@@@C@QCA@AC@@AB@@ADAFEDAJC@E@@@CG@@AC@@CB@@@@E@@D@@CA@@CB@@@B@@A@@@C@@@A@@@C@@@A@AGAC@@BCA@DA@C@@A@@@CC@@A@@@AAA@A@@@AB@@@B@ACD@@@@@@@B@@C@@@ACA@CA@AA@@CB@@A@@@@ACD@A@C@@@C@@AC@@@EA@@@@AA@@@@@@A@@@CA@@AB@@AF@@A@@@CA@@@A@@AC@@@@A@@@A@@@AA@@A@@AAA@@AB@@AC@@AC@@@A@@AA@AAA@@AB@@AA@@AAB@AE@@@C@@AA@@DE@@AC@@DA@C@BAABA@@GB@@@D@@EBA@A@@@AA@@CB@@A@@@AC@@EE@B@BAB@@@@A@@BAB@@C@@@AC@@A@@@AA@G@@AAAFA@@@AACC@@E@A@EA@@@@B@@@AA@A@@@A@AC@@@D@@ACA@@CC@@D@@@CE@@CC@@CA@@@AC@@@CB@@A@@B@B@@@@@BEE@@CB@@AA@A@@AB@B@@@@AC@@@B@B@AAA@@ADA@@ACB@@C@@@A@A@@CB@@CB@AA@AC@A@AA@@GA@@@@@@@A@@@@@@@A@@CC@@AA@@A@@AE@AB@B@@@D@@@@AA@@@B@@A@@C@AA@@B@B@@@@@A@@@@@@A@A@@@A@@@@@@@CB@@A@@@@BA@@@A@@B@B@@@@A@A@@A@@@@AA@BA@@@AB@A@@C@CACB@@AAAD@@CDAAAHA@AA@@E@AE@BC@A@@BAD@@A@AHC@@@A@@@@BAAAAC@@B@@A@@B@@A@@B@@BBA@B@@F@@AB@BBB@@@F@@BJ@@@@C@@A@@AB@ACB@@AB@@C@A@AC@@CA@@@@AF@@@FAB@@FD@@A@A@AB@@@B@@@AEB@@AB@AA@@B@@@@C@@@@F@@AD@@AF@@BB@@@@B@@DAB@B@@@@@AA@C@@@AAA@@@@DA@@@A@@AE@@B@@@AC@@@@A@@AC@@@@@AEBA@@ACA@@AAAAAAC@AAC@C@@C@@@@A@@BA@@@A@AAA@A@A@E@C@A@CB@@A@C@E@@AC@C@A@@@A@@DADAFC@AA@@@A@@C@@DA@@B@B@@@CA@AA@@A@@B@B@@@C@@CA@@C@@AE@C@@@AA@A@ACA@@A@@@@@AA@@ADAACAAB@@A@@@A@@B@@@@AD@@CBB@AD@@B@@@BH@ACB@@C@@@@@AA@AEA@@AC@@AB@BCA@@@A@@@@@@A@@@AB@@A@@@A@A@@B@@ADA@@BC@A@@@A@@B@@A@AHE@AB@D@@@@@@A@ABA@AA@@@@E@@A@@@A@@@AAABB@ABC@@@A@ABA@@@A@A@BA@AEA@@@@@AC@BCA@@@@AC@@@@@A@@CA@@A@@@@@A@AACAC@A@A@ABCBABA@C@A@@E@@CC@CA@DC@@CG@BCA@C@@@GABAA@@@C@@@E@E@@@@CA@@AB@AI@A@G@C@@@ABC@C@AAAACAGAA@@EAAA@@A@GIEFCDC@CBA@CB@@EAA@CAC@CA@@GAA@A@C@A@@BA@AB@@ADEFABABEDABAB@@ABABABCF@@@BAB@B@DAB@B@BAB@B@@ADABCFABEDADCBA@E@ABE@CAE@@AC@A@CAA@C@AAA@A@EACAG@GAA@C@A@A@EBA@CDA@EF@@ABADEJABAD@B@FAD@B@B@BBB@@B@DBFBDBB@BB@@BBBD@BAD@@@BABAB@BA@ABCDABA@ABABABCBEDA@CBEDABA@@@A@A@IB@BOHADA@@D@@@BBBBD@FBF@BAB@B@@ABCFIH@BABADA@CFCDABCBABCDABADEDEDABCDCDABABA@@@C@G@C@C@EBCBAB@B@@CJADABABA@@B@HDH@D@FF@RI@@FNJBB@BHHN@BDNB@NA@@JHDADL@@KNB@ABMFKEIJ@JIB@@K`ENDDDB@@DJGNA@MDBL@BIBA@ELG@@AOJI@KEA@DQKGAAAMO@EOE@C@ABCD@BAJ@D@@BB@BB@@BB@@@@D@B@DABABEBCBCBA@AB@@AB@DABABAD@@AB@L@B@BA@KAC@A@@@@B@B@BAB@BABGBA@ABCBCD@@GDEBE@EAMACAICA@ECSGOAEAE@GAC@ABA@@@@BAJ@@@@@FA@@@A@@BA@BDA@C@@F@@A@ABBB@B@D@BBB@@@@ABEF@BE@@F@@ABB@AFB@@D@@@B@B@B@BB@@@@DB@AD@@AFA@@JE@@D@@@@A@@B@BD@@B@BL@HB@B@@D@@@B@L@@BF@B@@@B@B@@BB@@I@A@@B@FBB@@BBBBBD@DBBBHBF@@@@AF@@E@@B@FBNBD@F@BBD@BBH@B@BA@@F@@B@B@@ENAD@B@@A@@@A@ABBZ@@@J@@@FA@B@@D@J@B@B@@@@BHB@BDB@@@@@BH@@@B@@@@@DFABBAD@B@B@@ABCDADABC@AB@DAD@BAD@BBB@@@BB@HB@@BB@@BB@@@F@@AB@BAB@@@@@@B@@@@D@@@@B@@BAB@@E@@@@@@@@@A@A@@DA@@D@B@@@B@@C@BD@F@B@B@B@@ABAB@@AB@B@BAB@B@@CB@@AB@B@B@@@@BL@B@@@@E@@@@B@@BBBDDD@@FAH@BA@B@F@@KBFJBB@BBB@D@@@BBD@B@DBF@@@@BJ@@@@H@FB@@@@D@@BXA@@@G@A@@@@BB@@J@@A@ABE@@B@@ABABG@@B@B@B@@DB@@@@@@@F@F@J@P@B@@B@@FA@@@@BDB@@BBF@@B@@@@@JA@@D@@@BA@BBA@@B@@@CK@@A@ACDABA@@B@@B@@F@@@FJBFL@@@@B@B@B@@B@@BB@@BD@@@B@@@@AB@B@@@H@B@BB@@B@B@@AF@@@@AD@@@@@@A@@B@@@BB@A@@B@@@@@@@DB@@D@B@@B@B@@B@@A@@B@@AFB@@@@B@@@B@B@@@@@BBB@@ADBBAD@BAAAB@@@B@@B@@D@@@@@@A@@@@@E@@@AB@@B@B@@@@@B@BB@@B@@BBBBB@@@@@@D@@@@@@@B@@@B@@@@@@D@@BB@@@B@@B@@@A@@@A@A@@B@@@@B@D@@B@@@B@B@@@F@@CD@@B@@B@@BF@@AB@@@@@AC@@BA@@@@BA@@BF@@BFDAB@@@@@AA@@B@@A@@@@@A@@@AB@@@@@@A@AA@@@@@@@@@@AHAAA@A@@BADA@@@BDA@@@BB@BDAF@@@B@@H@@@@B@@F@@@@D@@H@@AB@@@D@B@@AD@@@@DB@@@D@@B@@@@D@B@@BB@@A@BB@@@B@@B@@DJB@BABA@@D@BAFAFEFAB@D@BA@@DADC"],encodeOffsets:[[117693,34722]]}},{type:"Feature",id:"411681",properties:{name:"项城市",cp:[114.875333,33.465838],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@GBK@ADC@@@E@AC@@BA@A@@CB@@BBA@A@A@AB@@AAA@@@A@@@@AAC@@G@@@DA@@A@@@BA@@AC@@@A@@BAB@BA@@BA@@AA@@BA@@AA@@B@@@DKB@BA@@DC@@@A@@BC@@AC@@@A@@CA@@A@@E@@AEB@CA@A@@DAB@@@@@@@B@@@BA@@B@@AB@@A@@@@@@@A@@@@@@@@B@@@@@@@@A@@@A@C@@A@AA@@CA@@CE@@A@@@@A@@@@BE@@@A@@DC@@DD@@BB@@BB@@B@@ADA@@@@@@DEA@D@@A@@BC@A@A@@BCA@BA@@@@AIAABG@@BGA@AE@@AEA@@AD@@B@AD@@@DDBABB@AFD@@FB@AF@@F@@@@FGA@FE@@@AF@@CJ@@AD@@CFDB@BAJC@AF@@@D@@@BA@EA@@@AC@@BC@@AC@@@@EE@@AE@@AB@@AA@A@@DC@AHC@@FC@ABC@@D@B@FB@@B@BA@@@@BC@@BI@@BG@@BC@@BA@@JBB@D@@C@@B@D@@@D@BCB@BA@@BB@@DC@@@@DCAABA@A@MA@B@N@@@HCB@@@BK@@D@@@DBFA@BF@B@@G@@AGB@BC@@BCB@AE@@BE@@B@BF@@FDABDB@@BB@@BB@BDB@ABB@BFB@BA@DCBBBD@@BB@@B@@@BB@B@@@@BDBBBB@@D@DA@@BC@EB@@@DH@B@@BABA@HF@B@@B@DB@@DBFB@CB@ADB@BCB@AF@@DG@@AH@@BEBBAFD@@CB@ADB@BE@@@BD@@H@@D@@BE@@@@B@D@@@B@BAB@B@B@B@@B@D@@BE@@B@@F@AB@B@BF@@F@@D@@@B@@AD@@DB@@BC@@BC@@DAB@NA@@BB@@B@@@D@@D@@A@@DB@A@AB@@BB@@@@DB@@A@@@D@@@@@B@@@DAA@B@F@@B@@B@@@B@DAB@@C@@DB@ABC@AH@B@B@D@DAB@A@DA@@CA@@B@B@@@D@@@@D@@A@@@DB@@AD@@BF@@AB@@B@DAB@@AF@B@B@@BBAB@@AB@B@@@@@@A@A@@@@D@@A@@@A@G@DFH@@@@@HBD@B@ABB@D@@C@@@AA@@@AB@@A@AA@AB@BCB@@BDB@BD@@BB@@@D@@BJAD@B@B@@@@@AD@B@@F@@@@@@@A@@@@B@@@@@BA@A@A@AFCH@B@@@BD@B@@@FBDBDB@BB@@@J@@JB@@D@@B@@BBA@@@@@A@@@@B@@@@@@B@@@F@@@@AB@B@@@@@BF@@F@@BBD@@AB@BAB@@@HDFBBBDBB@BB@@DH@@BB@B@@B@B@@B@@B@DBB@@@B@BA@@DBD@B@B@@@@@DC@BB@@F@@@D@@@@C@@@@BB@@B@@@J@@@@GBA@@@@D@DB@C@@BA@@BA@@B@@@B@@BDBB@@A@@BAB@HB@@BB@@B@HA@@@@@@@@@@@C@@B@D@BA@@@@B@@@@C@@@@B@HBB@DDBAB@DAB@BDBBE@@@B@BBADDAB@@C@@B@@@@@B@@D@ADA@@@@AA@@@A@@@A@@B@B@@A@@@@@@BC@@@@@@BA@@@@DAB@@CA@B@B@BB@@B@@B@@D@B@@D@AB@@@D@B@@@@F@@BHB@@@B@@@@A@@@@@@BAF@@AB@@@@AB@@BB@BBD@BFBB@@BB@@C@@B@@D@@AB@A@F@@@AB@B@@B@BB@@BA@@DD@@DB@@D@BB@@B@@BB@@BB@BA@@BA@DB@DAAC@@B@B@H@@B@BAD@@@D@@@F@@@@@H@@BD@@@D@@@@BB@@@@B@@D@ABJ@@BB@AB@B@BAB@@@B@@@D@@@@@@@BB@HB@AB@@BB@@@F@@@D@@@@B@B@@@@B@@@@@@DD@@A@A@AB@@@BAB@@A@ABA@@BEDE@@FB@D@@B@BBD@@BBB@AD@@BH@@@@DD@@@@B@DH@@@@F@@@@D@AHBB@B@DA@@@AB@@@@@@C@@@A@@@G@@BC@@@A@@DC@@B@@D@@BD@@FB@@BD@@BD@@@F@@AB@@ABB@D@@F@@BB@@BD@@@ABDFBABBBBA@BB@@JAB@B@@@B@BAFCDAB@FCDABABABAB@BADCBAB@@ABABA@A@@BC@AACAA@@AAA@CAEACAA@@@AA@A@A@ABC@E@ABCBAFIBCBA@@FEB@DCB@FAB@B@D@B@HBH@DBFBB@B@BBD@B@DBB@D@@BF@DBF@BAF@B@DABCFCBADEBABC@@@ABA@A@ABA@C@ABA@A@@DEBABABA@@BABAFCBABAFEBC@@BAB@@AB@D@B@B@HB@@DBD@DBB@FB@@DAB@DAD@DCFEDAHEFCB@DAFAB@HCHABABA@@DADCDG@C@@BA@EDCBABAB@B@DAD@NBB@@@BA@@AACCA@B@D@F@@@FC@CB@@@@A@@@AC@A@@@@A@AB@@@A@AAB@@EAB@EB@@@B@@@F@@@@@@GB@B@@A@@@C@A@CG@@@E@@E@IBBB@@AB@@CA@@AA@@@E@@CG@@A@@E@@@C@@AA@@@KAA@@CA@@C@@C@AE@@@@B@@@B@BA@A@ABABA@CBABA@A@CB@@CCB@AAB@AB@@EB@@@A@@@B@@CB@@A@@B@DBD@@AHA@B@@DAAA@@A@DA@AA@@C@B@A@A@@@@BA@@DEBA@@AAA@@AAA@AAA@C@C@C@C@K@E@C@A@G@G@A@I@A@A@@@A@A@@@A@@@I@@@U@E@C@A@E@G@A@@@AAACCAC@@FEDA@AA@A@CB@CAB@CC@@CB@@AAB@@@A@EB@@FBA@@@C@@@@B@@A@@@@@AA@@A@@@BA@@A@A@@@A@@@A@@@A@@@@@C@CC@AC@AB@@ED@@AA@@AB@@CD@@A@A@@@AD@@CB@@@B@B@@CAC@E@C@EC@@AA@@CA@@C@@@A@@@EB@@AA@@@A@@AE@@BABCD@BA@@@@@CAA@CA@@A@AA@@AB@BABA@@@@A@AA@@@A@@@A@A@AB@B@@E@@@AB@@CB@@E@@@A@@@@@@BABAB@@@@@@@@@AA@A@A@AAA@A@@@A@@@A@AAAAEB@@A@@@AB@B@@@@@B@B@B@B@@@B@B@@AB@@@B@@@BDD@B@@@@@@C@C@@@@@A@@CA@AA@@A@A@A@@BC@CAA@@@A@@@CEDA@@B@@AAB@ACBEGGOACAEAAACCIABB@E@@@A@@A@@@C@@BDFAAEAEA@@@E@@@E@@AA@@AC@@AC@@A@@EB@@BBC@@@@B@@DA@D@B@B@@CBABCAAAA@@@AD@BE@ABA@C@ABA@E@C@@@GBA@A@C@E@AB@@ACB@@ABA@A@EA@@AAAA@A@A@@AAEAA@@@AA@E@C@UC@@A@ACAA@AAAEAAA@A@A@A@@@ABAB@@@BCBE@EACAKA@@EACCEEG@AAE@G@ED@@I@CA@@CG@@@@BM@@DI@G@@@@CC@A@@@A@@AA@@BG@@AA@@BC@@@D@@AE@BAE@A@C@@BD@@@A@C@G@A@@@"],encodeOffsets:[[117612,33872]]}},{type:"Feature",id:"411627",properties:{name:"太康县",cp:[114.837888,34.064463],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@@@AA@@@AA@AA@@@@A@@@A@@@@CDCB@@ABC@A@EBEFEBABC@@@ABABA@CI@@@AA@@@A@@A@BA@@AA@C@@@@@@AC@@@A@@C@@C@@BA@C@@@A@@BG@@@@C@@E@@@@A@@G@@@@A@@BEACA@@A@@CB@A@@CBAB@@@BBBGB@B@@@@@@@@B@@B@B@@@@A@@B@@@B@@@@@BA@@@BB@@@@A@CBAE@@AE@@AB@@@@AB@@BD@@@@A@@BE@@AA@@@@AC@@DE@@@A@A@@@A@@@@C@A@@A@@@@B@B@@@B@@@AA@@@A@@AC@@@@@@@@A@@@A@@@@@@@C@@@@A@AAAA@@@AA@@A@A@@@@@A@AA@@B@@@F@@@@@B@@@@C@@@@AA@@@A@BBABC@ABCA@BA@AA@@@@A@A@@@A@@@@@EA@BA@@@@BA@@@@A@AA@C@@@CA@@@@@@A@@@@BAA@@A@@@@B@@@@C@@B@@E@@BA@A@@@AAA@G@@@A@A@@B@@A@@@C@@AA@@AA@@@@A@A@A@@K@AEEI@@E@@@@AA@@@ABCBBDB@@@DL@@A@@@AB@AAB@@C@@@IB@@@@A@@@AE@AA@AC@@@@EB@@@AA@O@I@E@E@@@@@@@A@@CA@A@A@@@AHAB@BA@@@AF@B@BI@@@AA@@@@@B@H@@WBB@@@@F@BB@@F@@E@A@A@@@@@C@BBE@E@@BA@C@A@@@@BA@@@@@@BC@@BA@@@@FEB@@@FGB@AAB@@@@@B@@A@A@GB@BE@@@A@@@@BA@@DB@@BD@@@@@@BA@@D@@@DC@@J@@DABAB@B@B@@D@@D@@AD@@@@@@J@@@HA@@BC@GAA@@@@DA@@@@D@@OBC@C@A@@BAH@@ABA@@@@A@AIB@@@G@@O@QBI@@@A@ED@@A@ALA@CB@@@@E@@DE@@DA@@FC@@AA@@FA@ABAB@@@@KA@GE@@CA@@AA@@CA@@AC@@EA@@C@@G@AE@@EBE@@@@@@@CB@@G@@FC@@@@BC@@B@BG@A@@D@@@@@@@D@@@@B@@H@@A@@@@@A@CBA@@B@@E@@@@@MB@@@B@D@VB@@DD@@@@B@DBB@J@BH@AJJ@@LAD@ND@@FD@@BJ@@CJB@L@@@D@@DABHABB@@@AJA@AB@@AF@@@BCV@@@@ABBB@@C@@@@FAAGF@AC@@@@@I@ADC@@DA@@@A@@DODAA@@A@@@@@E@BAA@@A@@@E@@@CE@@@EA@CA@@BC@A@@A@@@@EAG@@@IA@B@FAF@BABAHAD@B@B@@@DBB@B@B@@AJ@F@@HDPHF@DBB@@@@B@B@B@B@@A@ABABABAB@B@@@DABAD@@CHA@CFA@@BG@A@@@AB@@ABBBA@BB@@@@CF@F@@@BD@@DBF@H@F@@DP@@H@@@BB@@@BD@FA@DD@@AB@BF@D@DG@@BB@BTB@D@@@H@@@DAB@@@@@@AF@@@A@@EF@@AD@ACJ@@@@@H@@@D@@HJ@AL@@DAB@@@BA@@@BB@@@@@D@@@B@@@D@@B@@@@B@@@@@@FD@B@BAJB@@@@B@@AD@@@D@@BD@@@@DD@A@NB@AB@@@@BD@BAD@ADB@@C@@@D@BA@@D@B@DB@@F@BCA@DE@@@@JA@AD@@A@@@C@@@@@@D@@A@A@@@A@@B@B@DA@ADHC@BADH@@@BB@D@D@@C@BLCBABKACD@D@@D@@BH@@BB@@B@@@B@BA@AB@F@@@DA@@BA@A@@@CBCD@@@B@@B@@@BB@@@@@B@@BD@D@BBB@DD@@@AD@@H@@BD@@BD@@@@F@@B@@BF@@@D@@D@@F@@BB@@D@@D@@BC@@BJ@@AB@@AH@@@BA@CHG@@BCDA@ABAB@@@B@BABA@@FA@B@@@BD@@AB@@B@@@@@BD@@BB@@B@@@C@@D@@@D@@BB@@BB@@@H@BABBB@@@B@B@@E@ABAA@@A@@@AB@@AB@@AB@D@@@@BF@@A@ABBB@DA@@@DB@@B@@@DD@@@@AF@@@B@@ADB@@D@@@JAD@B@@AF@@A@@@AF@@AB@@ED@@DB@@DB@@@BA@B@@DA@A@@B@@ID@BCA@@CA@@@A@CB@@A@@@A@@DCB@@@AB@@A@@ACC@@@HA@@@AA@@C@@B@@CB@@CB@@D@@@FB@@E@@F@@CD@@A@@B@@B@@@AB@@DH@@AD@@BD@@@@B@@@@@@@BD@@HABH@HFBA@AB@@@B@@B@@B@@A@@B@@@@@D@@DH@@@B@@BD@@@B@BBB@D@B@@BD@@B@BB@@B@@@AB@@D@@@@@DA@@B@@C@BBC@A@@BCAA@@@A@@@@@@D@DH@@BB@@DD@@FF@@@@@H@@@D@@C@@B@@DD@BB@A@@B@@BH@B@AA@@D@ABB@B@@FD@@B@@D@FD@@B@@@H@@@@AB@@A@@B@@@B@@BD@@@J@@@F@@AH@@AF@@BB@@A@@@AB@@@@DB@@BB@@@@BF@@@F@@BA@@B@@B@@@D@@D@@@B@@B@@A@DB@@@D@@BH@@@@DB@@DB@@@@DAB@BC@A@@@@@@F@B@B@@BB@DA@@D@@B@@@B@@F@@@@B@@@@AB@@@H@BEJ@@ABAD@@A@@@CB@B@@ADBD@F@D@@C@@AA@A@@D@@CB@@AH@@A@@@C@@B@H@D@H@@@F@@@F@@EJ@@@B@@ET@F@@@B@@F@@@@@DB@@HA@@B@@@DA@@D@@@B@@D@@B@D@B@@@@C@@AA@@@C@@D@BXAF@@GJB@@B@@@D@@AF@@B@BD@VA@@D@D@@E@@@CC@@A@@NA@@@GA@@GDA@A@ACE@@B@F@@B@@@BB@@F@@@@F@@BB@@BD@D@BCB@@CB@@A@A@@B@@AD@@C@@BA@@@@@@B@F@@AB@@CB@@AB@@@@BFA@BB@@BD@@BA@A@BD@@@B@B@BBA@BA@@B@@@@B@@B@BB@BB@@@FB@D@@@@EB@@BBA@AB@@AF@@A@@@B@@@AB@@AHAA@CGAA@@A@BCA@@@@ABB@CB@BBDA@BDABBB@BDB@@DHCCGA@@A@@@A@@@GD@@@B@@@@@B@@BB@B@@BA@BB@@BBB@AAD@BDD@@HB@@ADA@CD@@AB@@B@@@@D@@ABA@@B@@DDA@D@B@AFADA@BB@B@@DDA@@BA@DHAD@@CB@@EB@B@D@HMFO@C@@B@D@@ABAJ@@A@AJCB@F@HBFB@@@CFABBNABDDA@AB@@@B@@A@@@B@@@CB@@@@@@@D@@BD@@@@AB@@A@CBAD@@AD@@ED@@BF@@GD@B@@EB@BCB@@@B@B@B@DDFDD@BBD@B@@@DCAA@@@AB@AGGBAC@@EBAAGBAGB@@AB@@BF@@DHAAG@AB@@AB@B@@AE@@CC@AC@@C@@CDA@DF@@GD@@@D@@EB@@BB@@DLI@D@@H@BC@CC@@G@A@E@@@C@@A@@CJ@@ACABCC@@AB@@AD@@AE@@A@@@@D@@AIB@EA@@CA@@BA@@AC@@A@@@A@@@AB@@EA@@@A@@@@@@CA@@A@CD@AG@A@@AC@CB@@@@@@G@@A@@CA@@BA@@AA@@@A@@AC@@@C@@A@@@AB@@AD@@A@@@C@@@A@@@@B@@B@@@BB@@ED@@@@@@@B@B@@G@@@@@AA@@A@@@AA@@@B@@A@@BC@@@A@@@AD@@@@@@I@@C@@@A@@@C@@AA@@B@@@@@@A@@@C@@CC@@@A@@AD@@@B@@C@@@@@@@C@B@A@A@C@@@ED@@AB@@@BAAA@@ABA@@A@@@@@@@@FAAAB@@AA@@C@@B@@CA@ACA@@BC@@CACB@BB@@@A@@@@@BBAB@@AB@@A@@@CA@@AA@@C@@AAF@@@B@@@B@@@@@@AH@FA@@@AB@@CH@@@BAFCBC@@@A@@AAB@@@@CB@AAA@A@@A@A@@@ACAC@@EA@BACC@AAA@ACAAAAEAAAE@CAA@AAA@@@AEB@DA@@@EB@DC@@C@@@@@@@AA@@BAA@AA@@E@@@C@@_DC@M@K@C@C@C@AAC@@@@OZ@BC@@BA@CACCB@AB@@CB@AAC@@E@@@@@AC@@@AA@AA@@A@@@@@CA@@B@@@@A@@@E@@C@@@@AA@CA@@@C@@@@@@C@@@CA@@C@@GB@CG@@CAB@@@A@AA@@C@@@CF@@EB@B@BABAA@AG@@A@@AA@@DA@@AC@@E@@@FAA@A@C@@@@B@AEB@@I@@@@@@@AB@@A@A@@E@@A@@AAA@DD@BA@@AA@A@E@@BC@@A@@A@@A@A@ADACAA@@A@@@C@@@@@@@@DAD@@GA@C@@@D@@AB@@@@@@@@@@A@@@@@@B@@AB@BAB@@BB@@CF@BBB@@BB@BB@@B@@BD@@CFC@@FA@CEB@GA@ACB@ACA@@AB@@A@@A@AA@@@@@@@AGBUBK@G@K@A@E@AAGECAAAA@@@@@A@CB@@AB@BA@@@E@@DE@@@C@@A@@@AA@@@@GB@@E@@@CA@@AF@@A@@AEE@@AA@@C@@E@A@@B@@A@@A@@A@A@@@@A@A@AA@@A@@B@@@@C@@AAA@C@G@A@ABCBC@@@@B@@@BA@@@C@@AE@@CA@AA@@A@@C@AA@@AABADA@@BAB@@@BA@DB@@A@@@E@@D@@@@@@AD@@I@@H@@@@@@A@@@@CA@@@@@A@@B@B@@B@@DB@@BABA@E@@@@AA@G@@@@@C@@AA@@AC@@B@@@B@B@@B@@BIB@CE@@@AA@@A@@@@@"],encodeOffsets:[[117697,34727]]}},{type:"Feature",id:"411628",properties:{name:"鹿邑县",cp:[115.484454,33.86],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAECCAECEAEAC@E@A@A@AAC@KBC@C@G@@@A@@BABAB@@@B@B@@ABA@ABABABCDA@A@C@ABA@A@@B@B@BABEDCDED@B@@CBA@@B@@AD@BA@@@C@A@A@@ACA@@A@C@AB@@AB@B@B@B@@A@@@AEAACB@A@A@@A@@@@@C@@AAA@@A@CA@CA@@@@C@@@@@@@C@@C@EDABA@@BCBCDCDADA@@@A@@@@@@B@@A@@@AB@@@@@@@@@C@BC@A@A@@@A@@@@B@B@B@@@@@BA@GDEDABA@ABE@@A@@@CAA@@EC@A@A@@@C@@@@@AB@A@@@AAA@@@@BAB@@A@@@@A@@@@AB@BA@C@EAC@@FE@@@@B@BCBAB@@AA@A@@@@@@A@@B@@@@@@@AA@@@G@@@@@A@A@@@@@C@EB@A@@@@@A@AC@@@A@@@ADA@CB@B@B@@AB@@@@C@A@ABCBAB@@AF@@@B@@A@@@AAA@A@A@@@@BA@A@@@@@A@A@@B@B@B@@@B@@@F@@@@A@C@A@@@@@AB@@@@C@A@A@A@@@A@@A@@@@@@E@@@@@A@A@A@@BC@@DA@C@@@@@AB@@AB@B@BA@A@AA@@@BAB@@@@@@@@A@@@@@C@A@A@A@@@@@@AA@@@@@CA@@@AC@@@@BC@A@A@@B@B@@A@@@A@@E@A@@@@AAA@@@@@@AA@@@A@@C@@@@A@CEA@@@C@A@@@BA@AC@@@@AC@@@@@A@A@@@@@@A@@A@@AE@@CAAA@@@@@C@@AA@@A@@@AE@E@A@@@@@@A@@A@A@@AA@@@@@A@@@@B@@@@A@@@A@@B@@@B@B@DA@@@A@@D@@A@A@@@AD@@EBCBEDEBA@@@@C@@@A@@AAA@@A@@A@A@@A@@@AA@@@@A@@@AABA@A@G@A@A@EB@BA@A@@A@AA@A@A@@DABABKAA@@BA@AB@DABAB@BAFABCDGDCBCBCBABIDIDCBEFCB@BA@@BD@@BDB@R@D@@A@CB@B@DCB@DEA@B@B@BAB@DA@CFEJ@@@@BBBB@BD@@@@B@DF@@@E@@BA@@BC@BBC@@AA@@FB@@DA@@H@@@DBB@@B@@DB@@B@@B@@@E@@PABA@A@@BA@CBBFNDPBB@BJ@@AF@D@FEDKDKC@BEBB@@DA@@@@B@B@@BBA@@BD@@DB@@D@@@@@@BB@BA@@BA@@@@@@@A@@@@B@@@@@@BBB@@@@BA@@BB@BDA@BDB@@HFA@DDA@BB@@A@@B@@@F@@@FA@@B@B@DADADA@AHB@@@AD@@B@@B@@BB@B@@@@BJ@@AD@@BB@DH@@@@@B@AB@@BBHB@@D@BC@@AC@@B@@@B@@@@AB@BBBFHA@@@CD@@B@D@@FD@@DB@@@BD@BH@@BBA@@@AB@B@@DD@H@B@@@B@D@B@B@DC@@D@@@B@@B@@@AD@@@D@@@@C@@@AB@AAB@@C@@@AB@@CA@@@AE@@@@B@@BB@@ALA@AB@HADA@@BCFBB@TD@@@BB@@BBA@@DD@B@B@@D@@BB@B@@B@@@BB@NA@@@DB@@HAB@BB@@DA@BD@@@@@BP@@@@CJ@@BB@@AD@@BHBDB@ABD@BA@@B@BAB@BCBCBABCBA@EDCAA@A@@BDBCFB@ADB@AF@FJ@DBB@@ADEBAB@DAFAFAFADAD@DABADAD@DADCFAB@BFA@@DA@@BA@@FL@@AHA@AB@@GDBBBF@DBJBB@DBF@@DC@@AA@@BA@@DAA@H@@B@@@AD@D@@A@@@AHA@@DA@@D@@B@AB@@ABADABAB@@A@@@@@@@FBAJ@@@FAA@DD@ADB@B@@B@@@@FB@BB@@EL@DAB@@@D@F@D@@@BDD@@A@@B@@BD@@BF@@BL@@@B@@@D@@AB@@BLB@B@@JB@@@A@EAACAAAAEAECG@@@@AEC@@@@AB@@A@@@@DA@@@E@C@@@AD@@AJ@@B@@@@@C@@B@@B@@B@DAD@@@@DB@@CB@@D@@BA@DF@@AH@@@@BB@@B@FBFF@@BD@BD@@J@@BB@@AJAB@@@D@@BDA@@BF@BDFD@@BD@@BBA@HB@B@B@BAB@B@BABADAB@BD@DB@BB@BB@BAB@DEHEBAD@BADABANEBADADADABABABA@@DAFC@@B@BANAH@H@@@D@@AB@BAB@BAHBBADABA@@DAB@@@DAD@D@B@BAB@B@H@@@H@F@D@J@@@@B@@@@@DBB@BAB@@B@@@A@ABB@BBD@BDA@BB@BB@@BBD@@AB@B@H@F@B@@@@B@DA@@BBD@@CB@@@B@@AD@BAB@@D@@H@@@@B@@D@@DB@@B@@@BB@B@B@@B@@B@D@BD@B@@BF@@DABA@@BA@AFA@@FAB@B@@@@@@BD@@BFA@DD@@@B@B@@BB@@DA@@F@@D@@BB@@@B@DC@B@@B@@ABC@@DADCDC@@@AB@BB@@B@@AD@@@@BA@@B@@@BB@@A@@@BP@B@BD@DD@@DBDA@@A@@@BC@@J@@F@@BB@@DB@@D@@@@B@@BBDD@@D@@BA@@@B@ABAB@@BB@@BAB@DA@@D@JB@@BBDAD@@@F@@@B@H@DBB@B@H@@AFAJKB@@AFB@@BD@@DB@BAD@@BF@@BD@@HD@@@D@@DF@@A@@@@LA@AF@@DBAB@@AFAB@@A@AHA@AB@J@@A@AB@D@F@@FB@@@BFC@@HJ@@@AC@ABA@@B@BAD@BA@@@@@ABA@@B@@BB@@@H@@DJ@@@D@@@@IF@B@B@@A@@D@@BFBAHPB@BB@D@@@@A@@H@@@BA@ABCH@@EF@@@@A@@@ABAB@ACBCAA@@@AA@@CF@@AB@@A@@@GA@@@BAB@BAFCBIACACCAACAAAA@EBIBA@A@AOEEAIBM@EAECCBAB@@@BABA@@@C@CAICGE@AA@@AA@AC@ABA@@BABABEBCBA@AACA@GAQ@@ACE@@@ECG@CAC@@C@AAAA@C@CBABAHADA@@@@@AAAAAAA@E@CAAA@OC@AAA@@@C@CAK@G@A@ADG@CAAC@A@AAC@CCAA@C@A@@BC@C@@@C@C@C@ACAEAAAACCI@E@C@@@@BAA@BAAAAB@A@@FCDAFCB@@@AE@AAAEBCAE@CACCAC@ABAFABA@A@CACCCAACBGBA@@@A@AA@AAO@CBC@A@@@@B@@@B@B@DBD@@@BBD@BA@@B@@A@C@AB@@AB@F@B@@@B@@BB@BBFDBBB@@@F@B@B@BBB@B@BAB@DCB@BA@@@ADA@A@@B@@AB@@@DA@@B@@@B@B@B@B@BAB@B@B@B@B@@@B@BA@@B@@@BBDDB@BBBB@@B@B@B@B@B@B@B@@BBB@BBB@@B@@B@@DCB@BCBAB@D@B@DCDC@C@@CABE@EBC@E@@BA@ABABCBC@E@C@AA@GCGCCA@@@G@E@CAG@AAAKAA@@@@@AAAA@ABABABADABADAAAAAAC@CACBE@ABC@CACAA@C@@AGCM@CEEA@CBCBCBCBC@EBCBCBE@ABC@ABCBCBABA@ABA@A@A@C@@@AAAA@@AA@C@ACAAAC@G@@@AA@C@CAAAC@A@@@AB@JIBA@A@A@A@ADIBCDCFCDADADADCBCBCDEDCBABADCBCBC@CBAA@@A@@@AA@@AE@@AC@@@@@@@C@@A@@A@@AC@@AA@A@C@@AC@@C@AAA@HC@@@@@@B@DG@I@@@BB@BB@D@BB@@@@@BB@A@AB@@AD@@ABC@A@A@A@@@A@CBC@@@@@@@C@@@ABBBCB@AA@@@@D@@@BE@@@@@AA@BA@AB@@@C@@EA@@A@@AAA@@@@A@@@A@@@@@@ACB@@ACC@@C@@C@@@@@AE@@@@AB@FE@@D@@A@A@C@CA@@A@@@A@A@C@G@C@@@AD@BA@@@A@C@CAAA@@AA@@A@C@E@A@EACAC@A@C@C@G@GBG@A@@@@@ABABGHEDAD@@@B@@AFCB@@@B@B@@@@A@A@A@@B@DAD@D@D@BA@ABCBGDEDA@ABA@C@A@AAA@CA@ACACAA@"],encodeOffsets:[[118204,34567]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
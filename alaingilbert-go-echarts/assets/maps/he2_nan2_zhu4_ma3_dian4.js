@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("驻马店",{type:"FeatureCollection",features:[{type:"Feature",id:"411702",properties:{name:"驿城区",cp:[113.993914,32.973054],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@DB@@BCBADBBCD@@C@A@@A@BABA@@DB@E@ACA@ID@@@@A@@@@AA@@A@A@A@@@@@AA@AAAA@@@A@@BA@@@@@CA@@@BA@@@AAHIKA@BA@@@ABA@@@A@@@@@@AA@@@@@@@ABA@@@A@@@AAA@AA@@@@A@C@@@ABAB@@A@@@A@A@@@CBAB@@CA@@@@A@A@C@C@@B@@ABA@@@CAAAA@@@BA@@@@@A@@AAA@A@@@A@C@AAAAAA@@C@A@CAKCKACAABA@AB@@@B@BA@AB@@A@@AAA@@A@C@@@ABABCDEJABAFCFADABABABA@A@EE@AACEACACAACCC@@EFEDIBIAC@E@GBEBGEGC@@EAEAQCK@S@O@IBMAQ@O@MCABADEDA@ADEDCBC@E@CAA@AAACEGCCCCECCACAE@E@AAC@A@E@EBA@A@A@CAAAAAECEAC@C@ABA@GBA@Q@OBKD@@C@QBGCI@EBEFCBA@G@EDCA@@A@E@CAC@G@C@@@AACAACCCC@IBCAE@CBCFCDCBEB@@CACAA@@@CBA@@@AA@@@@AD@BEJ@B@H@@E@@B@@A@@@@A@@AAAA@C@ABC@@C@@@C@EB@@GA@B@@C@GCEGA@@@@A@@@@A@A@B@@@AB@BBBDBBB@DBB@BA@A@A@A@C@A@C@A@A@@@ABCBA@CBAAA@GBC@C@ABGBA@A@@AAC@AAAA@@AAA@@@AAAAAAAAA@AAA@@A@EBABA@C@E@C@C@@@@BM\\AXUTE@QDKBGDMDUDM@CDGAKBA@KEGI@AQBGCONBHCBfvBJHVATEPE@QEEBCCIIE@BFGDIBC@ECO@ODCLNN@@ZGZBTLIBS@DRCJ@BCL@@LHKNEHEHFFBAJGRKD[DIJBXEFBALAF@JJ@D@LAPBDLBJ@B@NDDLNAVCHAD@LBBDDBDNH@DI\\DA@B@VA@KBMC@FAT@JDJNDBNBDLLFPH^DD@@B@@@BB@BB@D@@@B@@BBA@A@A@C@AB@DB@@D@B@@A@A@@BA@@BA@C@A@ABA@@@@@A@@BA@@B@B@B@@A@@@A@@DBB@@AB@@@B@@A@@BBBB@@D@@@B@DBBBBBB@B@@@B@B@@ABABC@@D@B@DGB@B@F@B@BB@@@B@@@@ABADAB@@@BB@BBB@@@@ABEBA@@BAAA@@@AB@@@B@@@@@@@@B@@@BBA@@@@D@B@@@@AC@@@@AB@@@BA@@A@@@@A@@D@@@BA@@B@@@@A@CBACAA@@@@CBA@BD@@C@@AAB@@A@A@@HE@A@@@CBABC@@@@@A@CB@@A@A@@AA@A@ABA@@@A@A@@BC@A@@BA@A@@@@@A@@B@@CBA@@ACBA@ABADABABCDCBA@@BCBA@@@ABA@A@A@@BABA@ABA@@D@@@@@@AB@@@BAA@BA@A@AFADCB@@@D@B@@@@@FEBAB@DAB@@ABAB@B@@@@A@AC@@@@E@@B@@A@@B@@GBC@A@C@ABC@CBA@@B@@A@@@@B@@@BB@@H@@@BMD@B@@@@@@A@A@A@AB@@A@@BA@A@@AC@@CA@@@ABA@@BAD@J@D@B@B@BA@@@@HGBA@@B@B@D@DBFBB@BBDDFFFF@B@@B@DAD@B@@ADAA@CA@A@@@@@A@@@A@@EAAAAAA@@A@AA@B@B@B@@@BB@C@C@@@DBB@@D@@@@CD@@CD@@AB@B@@@H@@DD@@CFA@GD@@BB@DB@@BB@@@B@@B@@@@A@AB@D@@@DB@@D@D@@FB@@FB@@@@D@@BB@@A@@B@@N@@@B@D@@@@@@B@DBF@D@F@DBB@BDHHJ@@@B@@@BABA@C@I@CBABAF@D@D@B@DD@@@B@F@FABAHBNB@@D@HDDDBB@D@F@F@DAF@B@@@BBBDBJ@@BB@FDBBBD@D@B@F@B@HBDDDFBDDBBBB@@BBD@DDB@B@@AB@B@B@@@DBFBD@D@DBB@@@@BBBABAB@B@B@@@BB@B@D@BB@B@B@@ADABBB@BDBBB@BDB@@B@B@D@@@@ABA@G@E@CBA@CDADAF@FBF@JBB@F@B@B@DA@@@A@@AE@E@ABCBC@AAC@@BA@@@AGCCAAAAA@A@@BA@@@@AA@A@@@ABAB@@A@@@A@ABC@@BE@E@A@ABABABAFCJGBAFGDCDCBAB@@@BBJFPJ@BBBBD@H@@@D@B@BDJDHDD@BFJHHBDBBFLDHBHDH@BBF@D@B@BBB@@B@HBF@B@D@B@F@B@@@DADABAFCBCBA@@@KBC@E@@@ADCD@FAFAHAB@D@H@J@H@D@H@B@@@@@@@B@T@J@T@FE@GDABCAC@C@C@A@@FAFALGJBHAF@@EBA@@@A@@B@@@B@@@B@B@@E@@AC@C@@C@B@A@@A@A@@C@@AB@D@@@@A@A@ABC@@@@H@@@@A@AB@B@B@@@@C@@D@@@@EB@L@@@@B@B@BB@B@BB@@D@H@@@@@@G@@B@@@@CB@BA@@@E@A@@D@@AB@@@@AAA@@@A@@BA@@B@D@@@@@BFB@B@JB@@H@B@LBHB@@F@D@@@@@BC@@@@A@@@@C@@B@B@AI@@@@C@@@@A@A@@CA@A@@@A@A@@D@@AC@@@F@F@J@F@B@F@B@BB@BDBFFFBFBPBHBHBLAF@D@HGBCNSDCBC@@BIBA@C@A@@@CCGACAA@C@AAABC@@@C@@@@@A@@A@A@@@@A@@B@B@CA@@@ABBB@@B@@@A@@@AB@@B@@B@D@D@B@A@B@@AAA@AAAAA@@BA@@B@@@B@@@@@AA@@B@@A@@@@@A@A@@A@@@@A@@@@AA@@@@@AB@BADCAA@@KIECACACAE@EA@BGA@A@A@@CA@AA@@CAECECEA@@CBABEB@B@@CFAB@BCBA@E@G@EAG@AAA@CAAAA@C@ABCBABA@C@A@AAAACGAAA@AAA@A@C@EDEDABEDA@A@AA@@ECCCAACA@CAEAI@ICOBA@@@@@@@CB@@D@@@CB@BCB@@@@CBGB@B@B@@@@G@@DD@@BGC@A@A@BABA@ABAEC@BADAA@CAAA@AL@@GA@CD@@AD@ACB@@@@ACCA@@F@@A@A@@@@FA@@A@AC@@@@AB@@CC@@@BG"],encodeOffsets:[[116876,33669]]}},{type:"Feature",id:"411721",properties:{name:"西平县",cp:[114.021538,33.387684],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@AACAE@@BAAA@ABAAA@A@EBA@C@@@@@A@A@@@AAC@@CCEAAB@@AAC@A@AAA@@@CB@BA@A@@@CB@@AAA@ACA@AB@B@@AF@BED@@@FAD@D@BB@@B@@@@@BCDEFABCDA@@B@B@@ADAB@J@@A@AB@BA@BB@BB@BB@B@BBF@B@BAB@@@AA@GBA@@@@B@@@D@B@B@D@D@@AF@B@@B@D@B@B@@B@B@F@B@BB@@B@B@B@D@@@BBBBDBB@@@B@B@B@F@@BDC@@@DB@@@D@@@@@@@B@BBD@B@B@@A@@BB@@@ABB@BB@BFT@@D@@BD@@BC@@@BFB@@@AB@@B@@B@BA@@@BB@B@BBBBB@B@@@BF@@@@B@@AB@BB@@BEB@@@B@@@@D@BBD@@BA@@B@@B@B@@@B@@B@@A@A@@BD@@BA@@BB@@B@@B@B@@B@@D@B@BBB@@@B@@@@D@@C@@FB@@AB@@F@B@F@FB@ADBB@D@BBD@B@B@BBF@@@@@@A@@BAB@@A@AB@@@DF@BD@@BB@@H@@B@@@D@BBD@@BB@@@DD@@B@@D@@B@DH@BB@@@BD@@AB@@D@@BBB@@CB@D@@DF@@H@@B@BALA@@BFF@@BAB@DB@BDB@BFC@@DB@@B@@BBAB@@D@@BC@@BD@@BB@@D@B@DBBBB@@AB@@@@BB@@BBB@BBCDBLB@@@C@BDD@@@E@BBD@@BC@@BFABB@@D@@AD@@D@@@CJ@FAJA@@@JB@@DA@BDB@@BBBB@@B@DD@@BD@@BB@@@@BB@@BD@B@@AD@@AH@@AB@J@@BFA@@@BN@BBD@@BD@VAH@B@H@FBB@@BH@@BRA@BL@@@@AD@@ED@H@F@FA@@@DCB@H@B@@A@BF@DB@@@@@BDB@@BF@F@BDB@@BF@BDH@@D@BB@@B@DB@DA@BH@@FB@@@F@@FDA@BF@BD@@F@@@F@DA@HD@@B@DBBA@@DBA@DH@@B@BB@B@@BD@@B@@J@@BD@B@@AH@PAACP@@@B@@FRA@@D@@@F@@B@@B@@@B@@DH@@FF@@B@@F@@AH@@AD@@MDAB@@AD@@A@AC@@E@@L@@BD@@CF@@A@@@@B@@@@C@@@@B@B@B@L@F@B@B@H@@FB@@FD@@DB@F@@BB@F@@@F@B@@AD@@FB@@F@@D@@B@@B@BFD@@D@@H@@CD@BC@@D@@DH@@DB@D@B@@FF@@BB@@BD@AR@B@BD@@BF@@DJBD@BB@BBBB@@@@B@@@@F@@DB@@F@@@D@BA@@BB@@BA@@BB@@BC@@BD@@DB@@BF@BH@@A@D@B@@BF@@AD@BCB@@AD@L@@CH@@D@@@BA@@BB@@BB@@F@@F@@BF@@BD@@EB@@CB@@@D@BA@@DCL@B@@@B@@EB@@A@@BAB@@@@E@@@CH@BF@@B@@D@@BBB@B@@@B@B@DABA@AB@@@@BH@@BA@@B@@AB@@B@F@@B@@@@B@F@@BHB@DA@BBD@@H@@F@F@@@BSA@ABAAAB@@BBAB@@E@@CA@CGA@AEAE@AA@BABA@@D@@@DBB@B@B@@@BCBA@E@@BCPD@DTH@AFGB@BA@@CA@ACAADGEABOCDE@@@AA@@A@A@@AA@@@A@@DCBAD@@@@@B@F@H@BBLF@BBBBALB@@AIB@@CB@@AB@BEA@AAC@@ED@@AE@@C@CD@@@@A@@@ABA@@@@D@B@B@B@B@JADAD@BAB@BA@@@A@@@A@@@AACCC@@JAD@F@@@B@@A@@@ABA@@B@DBBBB@B@B@B@D@@@B@BCB@@@D@B@B@@@@B@D@@B@B@@@@BB@@@@CRB@AHG@C@AAA@AE@@GB@@@@AKAA@@@AB@@@@A@EAA@A@@@A@@ACAA@CA@@@@@@JEF@@ADC@DD@@@F@@E@AB@@@BB@BH@@AF@@EB@AAB@B@B@@ABA@ABA@C@A@@AAA@CADCE@AACAA@ACCCKAIBACBE@EEAAACC@@BAH@JAB@BADCDCDAD@D@@ACACAAA@ADCDCBAF@BCBA@@E@@AEAGAQAE@E@C@CBIFCB@@ACKA@EAA@@BG@@BEAO@CA@G@ACC@@GC@@C@CH@FMDKDKDCA@BC@@@A@@BABC@ADKA@@A@@CMBA@AAA@@A@@ABA@@@@KBA@GDA@@BCA@@A@ACA@A@GBA@A@CC@@A@E@GD@A@A@@@A@A@A@@A@@@@@A@A@@@@A@C@@ACB@@AAIA@@@@BA@A@G@@@@B@D@B@B@@@BC@@@CIA@@AA@@@E@@@DA@AB@@@AG@AAAA@@EB@@@ACAA@A@A@@CA@@@G@@I@@CC@@@DWKABKC@BC@@CAC@A@CACCCBA@@@@@@CQGKE@BA@A@I@@@@C@@A@@DC@A@C@QA@@@A@@E@@BA@@@E@I@@HE@A@@@C@@BA@A@I@@@@FI@@H@@C@@@AB@B@@KA@A@@@BA@@@AC@@A@A@@@@BAA@AA@A@@BC@BCA@E@@DIA@BM@@@C@@F@B@@@BA@A@IA@@C@@@@@A@@@C@@AO@A@ABFB@D@B@DAB@B@BC@@AA@@BA@@BCACAE@@FC@@BA@@@A@C@@BA@AB@@A@@@A@@@A@@DA@E@@@ABA@A@@@@B@@C@CBAB@@@B@@@B@@@B@@@B@@@@@B@BB@@DB@@JB@@@@@@DB@@BA@@J@BBFA@@H@HE@@@A@@DA@@@@CA@@DC@@@@CGAA@A@@AC@@@A@C@@ED@@AC@@EA@A@@@@AA@A@@AA@@BC@AAA@@AC@@@A@I@@LEA@D@BI@@GA@A@@A@AC@@B@@ADC@A@A@GACA@@@@@@@A@CI@@BA@@@G@@@A@@B@@AB@@E@I@C@@B@@A@@@@B@@AB@@A@G@@EA@C@A@@CE@@DAAA@@@@A@AA@BA@AE@@@@BA@C@@@A@A@A@@A@A@AC@@@A@@CB@@@B@@A@A@A@A@C@@G@@@A@AA@A@@C@@@C@C@@A@@AAK@@F@@M@A@@A@AA@@@@B@@@@@@@B@@@@@D@@@D@@A@A@A@A@@@A@A@@B@@A@@@C@A@AA@@@@A@@@C@C@GBA@A@C@CBCAC@A@EB@@AAA@@A@@M@G@A@@@@D@@C@A@@@@@EA@@@@@B@@A@@@@@@@@A@G@@@A@@A@@@A@A@C@GBA@A@CA@BA@@AA@C@K@@AA@C@@@@A@@@@@@@A@@C@@@@@@@A@@ABA@AB@@A@@AA@@@@B@BA@A@@@@@@A@@@@@@B@@AA@@A@@@@@A@@@A@@@AA@@@@B@@@@A@@@@@AB@BA@ABA@@@A@@@@AA@@@@@@A@@A@@@@AB@@@AA@@A@@ABA@@@@AA@@A@A@AA@AAA@@@@AA@AA@@@@@@@A@@@A@@@@AAA@A@@A@@A@@AA@@@@A@@@@@A@@@@@AAA@@A@@@A@@A@@@@B@@ABB@@@A@@A@@A@@@@BA@@B@@A@@A@AC@@@@@A@A@@A@@@@A@AACAAE@@@@@AAA@@@A@@@BB@@A@MAE@A@AAC@@@AA@AA@AAAACAA@KGEAAACCAA@C@@CAAAC@@A"],encodeOffsets:[[116378,34008]]}},{type:"Feature",id:"411722",properties:{name:"上蔡县",cp:[114.264381,33.262439],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@Q@G@MB@B@@D@@D@@@@A@@BA@@B@BC@@BEB@@O@C@@EM@@A@@CBE@AAA@CAA@C@ABCBCB@@ABADA@AB@@A@A@@CCB@AA@@AA@@CAEA@@D@@GBAB@B@FB@BHCDE@AB@@@D@@E@A@A@@BE@AG@ABA@A@@@A@@C@AAEAA@AC@AE@@B@@BPAH@@E@@AC@@@@DA@A@@B@@C@A@@JA@DB@@@B@B@BA@E@@CC@O@@C@@A@GH@A@AC@A@@@A@@D@BB@@@@@@BABED@@A@C@MAA@A@@D@B@BAAA@A@BAIEEC@@BGAAI@A@@GA@C@BGC@@@A@@AA@BEE@@GG@@@C@AACF@@GAC@@AA@C@G@@@@EC@@@@C@@EAK@C@CBC@GBA@A@AAA@@@A@E@A@AAAAA@@@E@@EC@@@AA@@E@@@BGC@E@@BA@C@E@@@@BI@@EC@A@@CBI@A@@E@@A@@I@@FAD@DE@A@@BAB@@A@E@A@@B@F@@EBM@@@@B@F@@@@@BA@C@AAG@@@CAA@@B@@@@@@@B@B@@A@C@@@C@C@AAM@@@@A@AE@@@@A@@A@G@@P@@E@_@IA@@A@@AC@A@@@AACC@AA@@AA@A@A@A@A@@@@A@@@E@@@AAA@A@@@ABA@ABEBC@C@C@C@A@CA@@@@AC@A@@@@@CFA@@BE@CB@@CACCCA@AC@C@C@C@A@@B@@@DAB@@@B@@@@@@@BD@B@@@@B@@@BD@@@@@@FDBBBB@@D@@B@@@@B@@A@@BABAA@@AB@@BDAH@@@@A@@@@@A@BB@BB@@@AD@@BB@@@@@B@BB@@B@@@@E@@@@@BFBDB@B@D@AD@@A@@@@D@@@@@@@B@@E@@@@@AHF@B@@@@ABB@AB@D@F@@BDB@@B@@B@@B@@B@D@@ABAB@@@B@@C@@@A@@B@@@BDF@B@@@@@@@@AB@D@@C@@BC@@@@@@DA@A@@C@@A@@@@D@@@@@@@BF@B@@D@F@@B@@DA@A@@N@B@@@B@@BB@D@@A@@B@@AB@@DLAB@@A@KBA@@@A@@A@A@@AAAC@@AIACAAAAA@ABA@A@@@ABA@@B@D@@@@C@A@@@A@@@BD@@@BA@BD@D@BB@@B@@@D@@A@@B@D@@C@EBA@C@C@EA@@A@@B@@@D@B@B@B@B@BH@@@CHC@@@A@CA@AA@A@@BC@@B@@AAEAA@A@CBC@@A@C@@A@M@@@@C@@@A@@G@A@A@A@ED@HEHA@ABEDA@@@E@A@BF@BC@@@ADD@ALLBCX@@D@@DJ@@@@H@@DB@@@B@BBBBD@@A@@FB@BB@BBH@@A@@BCB@@F@@@B@@BB@DJ@@D@@A@@@A@A@C@A@@H@B@B@@A@@B@BJ@BA@BD@@@D@B@@B@B@@@@@B@@@@B@B@B@@@B@BHCF@B@@@DDB@B@HAB@B@BDB@@@DB@AB@HCB@LA@@@@AB@BB@@@BB@BABDN@@@BB@CL@BADAB@@@B@@ADB@CDCLCLENG@@D@DD@@HD@BDH@B@@DBPAF@@AH@@BB@FLBBD@@DAJEDAD@F@F@RBHBFB@BF@@@ABADE@ABCDCD@BBBDBDB@BC@C@CBCDCDABA@IBG@AB@@DDBBFB@FAFBDJALBDDBDB@DBBBF@CDDBB@BB@@@B@DAB@BAB@BA@A@A@BBD@@B@B@BD@BB@@@@@B@@CB@@AB@@@B@D@D@B@@BB@@BBAHB@@BD@@AHB@CAA@AB@@BJ@CBLB@AB@@A@CBBTBBBF@@DB@BCB@@DD@H@@AB@@@B@A@L@@DB@@CB@B@@BB@BHCDF@@@ABABB@@B@@HA@BD@@BB@@AD@R@BB@@B@HFB@AB@@A@@DN@@BBBBADFFB@DB@@B@@F@@A@@B@@DB@@DB@@C@@@DB@@CB@@D@@@@@BJ@@D@BD@@BD@@@C@@BD@@@@BD@@@F@@@F@A@C@@AD@@CB@@DB@@AB@@@B@@@@AD@@AD@@@B@@E@@D@@A@@@@D@AEB@BDA@@BFA@AB@@BD@AA@@F@@@@AE@@@F@AAA@@AB@@AA@@AB@@CD@B@BD@@@EB@@CD@@BD@@BB@@GDB@@BB@@@@@@@A@@B@B@@A@A@@@@@@@DB@@@@@@@B@@BD@@AF@B@@CB@@@@@B@@CB@@GA@AB@@@A@@@@A@@GA@@@@@@C@@@C@AD@AFD@@E@@DA@FA@@F@@@BBAF@B@@DD@@BD@@BB@@AF@@CB@@DD@@AB@@DD@@G@@@AA@@CC@@CB@@AD@@CA@@AB@D@@JA@@FB@@BF@B@@B@CH@@DL@@BH@@D@A@@@@DA@@@BBB@@B@B@BAB@LB@A@@@@@HB@@@@BB@@BF@@AB@B@BB@@B@B@B@B@B@@@@A@@D@D@@A@@@AF@@@@BB@@CB@@B@@@CB@@DB@@AB@@@@CB@@B@@@DB@@CF@@AB@@B@BA@@BH@B@@H@@@CD@@@A@@DNBB@B@@@@C@A@@@@@@J@@ED@@FB@@CB@@DB@@ED@@@B@@C@@@AD@@A@CA@@BC@@E@@@CA@@A@@B@AKB@ACH@@CB@@@@@@E@@HA@A@@@J@@@D@@@BDB@AB@@@@B@@@@D@@B@@B@@C@@@FF@@AB@@B@@@DB@@C@@B@@CB@@DB@@BD@@AF@@DB@@CB@@CD@@@EA@@A@@@D@@AD@@C@AAW@@@CA@@E@A@@@@CB@@@E@@@C@AH@@@@@CBA@H@@BF@@BA@@BB@@AD@@BLBB@F@N@P@@@@CF@B@D@@ABBL@@PDB@BD@B@BAB@DAF@B@B@B@B@@@B@@@@@AD@@B@B@J@@@D@@@DCB@D@B@@AAIB@@C@AFBB@@@B@@BL@@A@C@@BA@@@A@A@@FA@AAA@@AGA@@@A@@GA@@A@@@@A@@CC@@CB@@@D@@ABA@AB@@@@A@A@AD@@A@@@AAGA@@AC@@A@@@CA@AAD@@AB@@C@@HABDD@@@B@@@D@@AB@AA@EA@@A@A@@@CB@@E@AB@@AB@@B@@@AD@@@C@@AD@@G@@B@@BB@@C@@BD@@@DH@@@@@B@@@FA@@B@@AD@@@@@@C@@@AA@@A@@@@B@B@@@@@@BF@@B@@@AF@B@@E@CBB@A@@@ABAB@@BB@@ADA@@B@F@@@@I@AF@@AB@@CB@@@F@B@H@@@B@@BB@@FF@B@@BD@@DB@@FB@J@@BB@@D@@HB@B@@DB@A@AD@@B@@@AB@BMB@D@@AD@@@@AD@BCF@@@F_@@C@@E@@@@@AB@@@A@@AB@@@A@@@D@AAA@@A@CC@@CA@@CA@@CA@@A@@@A@AB@@BB@B@@AB@@@C@@@A@@AD@@@C@@@D@@@D@@@@AB@@@D@@DD@@D@@@F@@@@BB@@@BD@B@@@BALB@@@E@O@EB@DA@CC@@A@@@@@@@@@AF@@AF@@CB@@DF@@AB@@AA@@@@AD@@@@@D@@DBBJ@@FRB@@I@@@JBBE@@@FB@B@@A@@@@@DB@@EB@AFB@@GB@@@A@@AH@@@CEH@B@@@B@@@@C@@B@B@@@@@@@@ABA@@BAAA@@@A@ABE@@BA@C@AA@@BE@@AC@@BA@@C@@@BC@@@@@@C@@@A@AB@@DB@@C@BBA@C@C@A@ABGD@BAA@@CD@@@BA@C@A@@@AA@@@@E@ABB@C@@@A@@@@@C@@@BA@@C@@A@@AA@@B@BCA@@@BC@@@@C@@@AA@@AB@@MBA@CD@@AD@@AA@@CC@@BA@@@C@@@@EE@@A@ABAE@@@@AF@@AC@A@@@@A@A@ABA@A@A@@@C@A@@F@@AC@@@@GC@@A@@AFA@BCA@@DC@BEAAAF@@BG@@CH@@BEA@ADA@BCA@@DEACA@@CAA@@@@AGEB@BA@AA@G@@C@@FAD@@AB@@C@CA@AACA@A@@A@A@@A@@@AA@@AC@AADA@CABA@AEA@BAA@ACA@@AA@@AA@ACCB@EE@@AA@@@CAACA@GB@D@@G@A@@CB@@A@AE@@@A@AACBG@A@@AA@E@G@A@AA@@CA@@@C@AEBABA@Q@C@@L@@AB@@AABI@@@ACACBA@@D@BA@E@ABM@A@@C@@AAC@A@A@AAIAC@A@GDA@@@@@AA@@A@@@@@AA@@I@E@@@EBA@@@AAA@AAA@@@CAE@@C@A@@C@@@@@A@A@AB@@E@@AAG@A@@@@C@I@EA@AAA@A@A@@A@AAEAC@CAC@G@"],encodeOffsets:[[117282,33945]]}},{type:"Feature",id:"411723",properties:{name:"平舆县",cp:[114.619159,32.96271],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@BC@ABGD@AAA@@G@AMBABA@AB@@@@@@@@C@@@A@C@AABA@@@A@@@AE@@C@@@@@@E@@@@AEJC@@BAA@@@B@@@@CA@A@@AA@@@C@@C@@E@@A@@D@@@@AA@C@AB@@AA@@A@@@@@BB@@@B@@A@C@G@C@ABA@@B@@@@@B@@BBBB@@@B@BAB@BA@@@A@AACCA@CAA@E@A@A@ABAD@BBB@DDDDBHHBB@BBDA@@@A@@@A@CCA@@@C@A@C@KDA@A@A@CAA@A@@@A@@BABABAD@B@B@D@F@BBB@@@BBB@DBB@@@BA@A@C@CAECECCAA@AAA@A@A@ABA@EDCBA@ADABABAB@B@B@@BBB@B@D@B@F@D@F@D@@@B@BB@@@BBB@B@@AB@B@@A@@BAAEAA@A@ABA@@B@B@B@@BB@BBBAB@@@BAB@BAD@B@B@@FBLDJ@@B@@AD@@@B@@@B@BDD@B@B@BA@ADCBABA@CBA@A@@@A@AA@C@A@AAE@CAA@@@AA@A@@BA@@D@@@D@@BFDF@BB@ABABAB@@@A@@@CA@@@@@A@A@CBGD@@AB@@@BB@@@FB@@B@@BBB@BBB@B@BGD@BA@A@@@A@E@EAC@CBA@AAC@@AAA@@B@BCB@@A@A@A@C@AAA@AA@@AA@AAA@A@A@CBABE@EBABA@ADA@@BEJCBCDCD@@@B@B@@BBBBB@B@B@@@@@DC@CBAB@B@@AB@B@@@BBB@@@@BAB@@ABABADAB@B@B@@@@FBB@@BBD@B@@BBBB@@B@BB@@BAB@DAD@@@B@DBB@B@BBD@@@B@@BBB@@@D@JAD@BABAD@@ABABCBABKDCBCDABC@CB@@A@A@CACA@@AAAAAA@A@C@@@@D@B@@AB@@A@C@EA@@@@AA@A@C@@@A@EBA@CBABA@@@@AA@@AAAAACACACC@A@@@A@A@@@A@@@AA@@@AAG@A@A@AAA@AAA@@@AB@@@BA@@B@B@BB@@BB@BBB@B@DAB@B@B@@@BB@@@@AB@@ABAB@@AB@@@BBB@@B@DDB@@@BB@BB@@B@@@BA@@@@@CBA@@@@B@B@@FFBB@B@B@B@@@BABADA@@@CA@@AAA@A@@@A@AA@CAAA@@@@@CB@@CDABA@A@@@AA@@@A@@@A@A@AAA@A@AAA@@@AC@CAC@EBA@A@@AACAAAAAACACCA@CAC@C@A@ABC@@BA@ABABAD@BBB@@B@@@BABAB@@@B@B@BBB@BDBB@@@BA@@@A@E@C@@@@@@B@@@D@@@BA@@@AB@@AAC@A@MAC@@@CDABA@CBOBCBAB@@AB@@@BDFBF@B@BAHAFAD@B@@A@@B@A@@AAA@@CAAACAAAAAAA@A@C@A@@A@@ABA@CAA@AB@@@@@@@B@@BDBB@@@BAB@BAD@B@B@D@@@BBBDB@BB@@B@@A@@BA@@BABAB@@A@@BA@E@A@A@A@AB@@AB@@@DB@@DB@BBBB@@BBB@@ABABA@@B@B@@@BB@B@B@@@@@BCBAB@BAD@@BD@@BD@@@@@BABA@A@A@A@@@AB@B@B@B@@@@@@CB@B@@@D@@@@D@@AB@B@F@B@B@BBBBDB@BB@BBB@DAB@B@B@DBB@BB@BBB@@@F@B@BBB@B@BBB@@BBB@BBB@FBB@DBB@DD@BDDBDBBBB@DBFDJDFBBDDBB@B@D@@@@J@@L@@D@AD@BCHCF@@@@@B@@AD@@AD@@@BA@@@CBA@AB@B@@@B@@@B@@AB@BB@B@@B@@@B@B@@BBB@HDD@HBFBBBBDB@@B@BAFAF@DBB@B@B@@B@DDB@@B@HBBBH@BBBDD@@@B@B@@CB@@@B@HA@@@@@@D@D@@BBBBBB@BD@@@@BB@@BD@@@@D@@E@I@I@[@C@@@@@@BAB@BBF@BA@A@CB@@@@@@@H@B@@A@AB@@CB@@@D@D@@A@@@@HB@@@J@B@BBB@@@@@@AB@@@@D@@B@@B@@@BA@A@@@@@@BBBBD@@@B@@A@@B@@B@B@BB@@@H@B@B@@@@@BB@@@B@@B@D@B@B@@@B@BAB@@@B@@D@@@BB@@@B@D@DBF@BB@FF@@ILFB@B@FADBBBF@DB@@BB@@BDA@DB@B@@@BAB@BCBA@@DADABAD@B@DBB@BBF@DA@@@BN@@FD@P@@@FA@AD@@A@AB@@AB@@@@@@CC@@@@ANAH@R@B@H@D@DBD@FBBBB@@@@B@BBB@BFBJ@D@@@@@@BBH@BF@@@BAB@B@@@@@D@@@@B@DF@DB@@B@BBB@BB@@B@FA@@F@J@@@BB@@@@B@@@BB@@@@B@HCB@D@JBBBB@B@D@BB@@@DB@N@BAF@B@@A@CB@DADB@B@@AJBB@@BA@@@KD@R@B@BAFA@B@D@@DB@@BBB@H@F@B@@BB@H@DABBB@@@F@@B@BA@@DB@H@@@@CHAB@BDDB@@B@@AF@@AF@@BDA@AD@@AHA@BH@@@@AAEB@AE@C@@@CL@@A@@DA@G@@@M@ANBB@B@BADB@C@@D@@CA@@AB@@ADA@A@C@@@C@AD@@@@CAA@IB@@AD@@AH@@AJ@@AD@@A@@B@@A@AA@@E@A@CD@BAD@@ED@BGD@@CB@B@@BA@@BF@@BF@@F@@D@@BD@@AD@@B@@FBB@@A@@@C@@BED@BI@ACADE@@BC@@DI@@BE@@F@@EHB@E@@E@@@BEA@@EC@BEA@BACA@C@@BCA@@@BC@@FB@BF@@BHB@AH@BAJB@B@@B@@ADB@AB@B@D@@AB@@@@CFB@C@@@@B@BC@@@AA@@AA@@AC@@CD@@CB@@@F@@A@@B@@@@@@BF@@DB@@DB@@B@BD@B@@@B@@@@@@@@@@A@@@@@@B@@@@@@@B@@@BA@@@AB@@A@@@A@@@@@@BA@CB@B@@DFA@BF@@@@BB@@DB@@@D@@BD@@AB@@@D@@CB@@ALA@C@@@AB@@BB@@AB@@BB@@AB@@ABA@AB@@@D@@BB@@A@@@BB@@C@@@HD@BB@@@@@B@@BB@BA@@B@B@BAB@AA@@DB@B@@AD@@B@F@@CD@BAL@H@B@@B@H@D@B@@@C@@AD@B@F@ABF@@BC@@@D@@AB@@BH@@AB@@BB@@@B@D@@D@@H@J@@CN@@A@@H@@DB@@D@JJBD@BADCDAF@@ADEBA@CBA@CB@@ABA@EAA@@@AAAACACAA@ADEBABA@ADC@ADE@I@A@A@C@@@A@@@CBAB@B@@ABA@@B@@AD@@@BC@@DAB@B@@@FE@A@@A@A@AAA@@@A@@@AAA@@@@@@@AA@@@@@@@A@@@CA@@@@@A@A@@@@@@AA@@A@@@A@@@@@@A@EAK@C@A@QDWF@AAA@CFILKBGBA@AQGAAICCACA@AAA@CBGBA@ADEDEII@A@AA@EA@B@BEA@AA@AB@BCA@C@@@A@AE@@CEA@AG@@@@C@@@@E@@AG@@A@ABA@G@@DBB@@@@@@@J@BCHB@EA@@AB@@G@@KAI@@@C@@BAB@@@@@D@BE@@A@@I@@@@@I@@@@AG@@@@AA@@CAC@@@AA@A@@@A@AGCB@@A@A@AA@@@@AECG@A@@AC@AACB@@AA@@ACB@EOBE@@BG@@A@AC@@AAC@@I@QB@DO@A@@@@A@AAACE@@@@@ABE@A@@@AAEA@@A@A@C@A@A@EBG@@@CBC@@@@@@KEEAAACAAACAGGAAGE@AA@CCA@GCA@AAA@A@CBA@CBABA@EBEBGDGBA@@B@@A@@@A@AAA@AACB@@CC@@A@@@AA@A@@@@@AA@@A@A@@@A@@@C@A@E@C@@@CA@AOAGA@@AA@@C@@@ED@@CB@@AB@@C@@AE@CC@AEE@@@@@@AB@@IA@AE@A@BACWF@AAKB@@DBA@A@@@AHAAABA@A@@D@@BDA@@@@B@@BBA@@@@@@@@@C@@@AA@AI@CB@AAB@@@AEB@AE@@@@A@@@@B@@A@@BA@@@C@BBGB@F@ACB@@@BA@@AA@BBA@@@@AA@BB@@EB@@BBE@@A@@KBCG@@B@@@B@@@@ANAAC@@D@@@@BFA@@@A@@@@AEB@ACCGBA@@AAA@@@AAO@@BCB@AA@@@@EA@@@@D@@AA@AA@@AC@ACA@@A@AA@@@AE@@@@@@AE"],encodeOffsets:[[117414,33554]]}},{type:"Feature",id:"411724",properties:{name:"正阳县",cp:[114.392773,32.605697],childNum:1},geometry:{type:"Polygon",coordinates:["@@CC@ACACC@@CC@CA@A@AAABA@ABA@EHCDABCDCBA@GDA@ABA@A@C@E@EA@@A@E@A@GBE@E@CBGFAB@@@D@B@D@@@B@BBB@@BFADEJ@BA@CBCBCBA@C@ABABCBAB@BC@A@AB@@AB@@@D@@@DB@@B@DAB@DBD@D@D@D@B@JAJAFAB@DAD@@@DABCDAB@@A@ABE@C@G@A@A@CBEBA@ABEBCBA@A@A@CCCAA@AAE@ICICC@A@C@@@@B@@@BABABCBAD@@@@@B@@@B@@BDBD@@@@@DAB@F@B@BAH@DAB@B@@@BA@@@A@@@AB@BA@@B@B@B@B@@@B@B@B@@@BAB@B@B@@@@@@A@@@A@@BB@@@@B@@@@@@B@@@@@@@@BB@@A@@B@@BBB@@@@A@@@@BB@@@@B@@@@@@@B@@B@@@B@@@@@@B@@BB@BB@@@BBA@B@@BBB@@A@@B@@@@@B@@@@AB@@@@G@A@A@@@@@@AA@@@@@AA@@@A@@A@@@@A@A@A@@B@@A@AC@@CA@@@@@C@@@@@BA@@@@A@@@EBA@@A@AB@@A@C@@A@A@@@A@ABA@A@@B@@@AA@@@A@@@@B@@@BAB@@@@B@@BB@@B@@@@A@@@@@@@@@@@@BA@@@@B@@@@@B@@@BA@@@A@AB@@B@@B@@@B@@@BABC@A@@@@BA@@B@@A@A@@@A@@@BB@B@@@@CD@@@@BB@@BB@@ABA@@@CA@@A@@@@B@BBBBB@@B@@@@B@@@@AB@@AA@@A@@@@B@@@@@B@@@B@@@@@@B@@@@B@@BD@BA@@B@B@BB@@BA@@BA@@@BB@B@@@B@@A@AB@@@@BB@@@B@@A@@@@B@@@@BB@BA@@B@@B@@@B@@@@@AB@BA@A@@@A@@@ABI@E@@A@@@AB@@@@A@@A@@@@@@@@A@@@B@@A@@@@@@@@A@@@@AB@@E@@@A@@A@@@@@@A@@AAAC@@@A@@A@@@@@AAA@G@@B@@@AA@@@AA@@@@AA@@AABA@AA@@@@A@C@CB@BA@CAABA@A@A@AB@@A@@@A@@BA@@BA@@@@B@BAB@@A@@@A@CB@BAD@BBB@@D@@BB@@BA@@@A@@DB@@BB@@BA@@HC@AA@@A@@A@@A@@@@@@B@D@D@@AB@@C@@@@@@@DB@@@@C@@@@@@B@@D@B@@@@BAD@@B@@A@A@@@@B@@@@BD@@B@BA@A@@@@B@@@@B@@@@B@@C@A@@@@@A@@@@DA@@B@D@@@BB@@@@B@BB@@CD@B@@DAJ@@@BE@A@BLHAAC@@@C@@JJ@@@BA@BFB@@@@BB@@BD@@BDABB@@B@@@@@@BB@@HAF@FADF@@BB@@@@@@@@@A@@BB@FB@@HDHBFBB@H@DB@B@@@APB@@@B@@@@AF@@@B@B@@@@@BEB@@@@@DA@@B@@@@@B@@@@@B@BA@AB@@@@@@@B@@@@@@@BA@@BB@A@@B@@@@@BA@@@@@@B@@B@@@B@AB@@@@@BBB@B@@B@BBB@@B@@@BAB@@B@B@@BBF@@@B@@@@A@A@@@@@C@AAADB@@BB@AB@BD@@@@B@@BB@@@B@BA@BB@@@@A@@@ABADAB@B@B@@@@@@@BA@AAA@@B@@ABAB@@@@@BB@@@BA@@DD@@@@@B@@A@@@AD@@ABAA@@@@@B@@AB@B@@A@@B@@CF@BADB@J@@FB@@BBB@B@@B@@BF@@@B@D@JB@JEBA@G@@DC@@@@CBEEAAFE@@DA@@@@BDFE@@B@L@F@BAF@B@B@@@B@@A@AB@@LBAF@@CAA@ABA@@@A@BBF@BB@DDBBCBABB@B@B@B@@@B@@@B@D@@@B@@@@@B@@@BB@AB@@D@@AB@@BH@@AB@B@@@@@@@@@BA@@BADA@GA@@AD@@EH@@DC@@HD@@CD@@DD@@@@C@@@AD@@CB@@EB@F@@CD@@ABBB@AFFB@EJ@@AND@@AJBBBBAD@@BBB@BB@@@AB@B@@@@@BAB@@@BA@@B@@@@@B@BABA@@BA@@@AB@@@@@@@@@AB@@BBB@@@B@B@BBD@@@B@B@BBB@DBBABBB@DB@@B@@B@@BBDBBB@@B@@BDABBBA@@@@BBBB@@B@@@@@B@@@B@B@@@@@@B@@BB@@B@@ABB@@@@BB@@@B@B@BBDBB@B@@@B@@@@B@@@@BB@B@@@D@B@@B@@@@AB@@B@@@D@DB@@BBB@@BA@BB@@B@@ABB@@A@@@@BB@@@@B@@@@@BBBB@@A@@B@@BBBBBBBDBB@B@B@@@@B@@BBB@@BBB@@@B@@@@@BB@BBDB@BD@@@B@@D@@B@@@@@@@@B@F@B@@@BB@@@@B@@DD@@B@@@B@@@B@@BB@@@BAB@@B@@BB@@@@BBD@B@@B@@@@@B@@@@BB@@B@@@@@@B@@BB@@@@A@@@A@@BB@BB@@BA@@@@@B@@@B@@A@@@@BB@B@@B@AB@@@@@B@@@@BA@@@@BB@@@@@@B@@@@@BBBB@@@B@BD@@DBB@@@@@@D@@B@@@@B@@@@AB@@@B@B@B@@BB@@B@D@@@DBB@BABB@@F@B@B@B@BA@@DA@BB@@@@@BBA@@B@@@B@@@BB@@@B@@@@@A@@B@@@@@@BBB@@@@B@@@@@@DA@@HDBB@@@@BBB@B@@BB@@@@@AB@@@BB@@B@BB@@@B@@@@@@B@B@@@@B@B@@@@@@@@@ABA@@@@B@B@@@BB@A@@B@@@D@B@@@B@@B@BB@@@DB@@BB@@@ADB@@@B@DB@@@B@B@B@@@B@B@@@B@BA@@@@@BB@B@@B@@AB@@@@@@AH@@ABB@@DB@@BA@@BB@@B@@BA@@@@@@B@@@@@@A@@@@@@B@B@@@BB@D@@@@@@B@@@B@@@@B@@@B@D@B@@B@@@B@@@BB@@@DB@@@@B@@@@@@@@@@@@B@@@@A@@@@B@@B@@@@@@BB@@@D@D@@@@@@@@B@@@@@@B@DA@@BCB@@@@@BBB@@@@@@B@@B@@@BAB@@A@@@@@@BB@@@B@AB@BA@A@@@@B@@B@B@@@@BBBBB@B@@@@@BB@@B@DA@@@A@A@@@A@@B@@@BB@A@@@@@@@AB@@@@@BB@@@@B@@@B@BA@BHCFB@@D@@C@@BB@BFDB@@BA@@BDB@AJB@@AB@@@@@B@@B@@@D@@@@@B@@B@@BB@@BB@AB@@AB@@@BBB@BB@@BABB@@@@@D@@B@BB@B@ABA@@B@@@@B@@BB@@@@B@@A@AAAB@@@@AA@@@@@@AB@@@B@BBACBA@A@@B@@B@BBFB@D@@@@BA@@BA@@@@DBAB@@@B@@@@@B@@B@@A@BDB@@A@B@@@@@B@@AB@BBB@@@@B@B@@@@@DD@@AD@@@BB@BB@@@BA@@@@@@@@@@BB@BAB@@@@A@@@@@@@A@@@@B@B@B@B@@@@@@BB@@@@@B@@@@@@A@@B@@@@@@ABB@@@@@@B@B@@B@@A@AB@@@@D@D@@AF@@@BAAA@@@@@C@ABA@AH@@@@B@@A@@BB@@@@DBB@BB@@AB@@@BA@@BA@@@B@@AB@@@DB@@CB@@@@@BDH@BB@@@@AB@@@B@@@@BBB@DA@@B@@A@A@A@@@A@A@@@A@@B@BA@BB@@DB@@@B@B@@A@@BAAA@AAA@@@A@A@AB@BAB@B@FBBB@AB@@@@ABA@@@AAA@A@@AAA@@@C@E@C@E@A@C@A@A@AA@@@A@ABABABABCB@DAFCB@BAB@B@B@BBB@DBFDFDDBD@B@B@@A@@AA@CAA@A@@AA@A@E@C@A@ABCBABA@AB@@@B@B@DBB@B@B@LCD@B@D@@@B@DDB@@@B@@@B@AC@AAAGGCACC@CAA@ABCBAB@B@F@B@DBB@DDBBB@@@B@@ABA@A@A@@AAAA@@@A@@@@@AB@BAD@H@D@B@@@@A@@AA@@AAAA@@@ABABA@@@A@@@@@AC@A@@@A@@A@A@AB@@C@@BADC@@@A@@AAA@A@ABA@A@@@@A@@@A@AB@BCBABCBCBA@@FC@@B@@A@AAAAC@@@A@AB@DCBABABEBAB@B@D@D@D@D@DBB@B@@@BABA@CB@BAB@D@B@B@@A@@@A@@@ACA@A@@BAB@BADALCBAD@D@DBB@DDB@@BB@@D@BBB@B@@B@B@B@BCB@DE@A@A@@AA@@AAA@A@C@C@@@AA@@AA@A@A@ABAB@B@BAB@BB@@BBBB@BDD@BD@BBB@D@D@@BB@FHBBB@@@D@BB@@@B@BAB@BA@ABAB@@@BB@@BB@B@B@B@BADA@@BCDEBAB@D@D@B@B@BA@AB@@A@@AA@@ECCC@@CAA@AAA@ABC@@A@@A@@A@ABA@A@@AACC@AAA@A@CAE@A@@B@@AB@D@DBB@BBBDDBBBBBD@BBBDB@BB@AB@DA@A@ACEEEAAAAEA@AA@@@BAB@BAFAB@B@BBB@BBDDBBDBBBB@@AB@@@@A@A@@AAAEAA@@BA@@BAD@D@LBF@B@B@@AB@BABABABA@C@AB@B@BAB@D@B@B@B@BBDBFDBBB@B@DBD@DBFBDBB@@ABABCB@BA@A@@@AA@AA@@A@C@E@A@@AA@@A@@@A@@BA@AB@B@BAB@D@B@FBHBD@J@D@BBFB@@B@BDBB@@B@@@@@BA@CB@@@B@@@LDB@@@@BBB@@@D@BAB@DAB@B@B@@@BB@BAD@BABA@AB@@A@AAEBA@A@AB@@AB@@@B@BBDBHDFD@@BBBB@B@@@BABA@ABC@C@A@@@@B@@@B@BFBDB@B@@@BAF@@@B@@BBB@BBDDBBB@@B@@ABCB@B@B@@BB@@BBHDB@D@@@D@@@BG@@@@B@@@B@DF@@B@@@@@BC@ABC@AB@@AB@B@H@F@J@D@B@B@BABA@@@@@AAAA@@AB@BB@@@A@@@A@@B@@@B@@AA@@@CA@@@A@@@@B@B@@@@@BA@@@@@@@@AA@@@@BAB@@@@@D@@@B@@@@@@@@AA@@@@AB@@@BA@ADABA@@BA@@@@@DB@@@FA@ABAB@@CBA@A@CBG@A@A@@@A@EAA@@BA@@A@@CA@ACCB@@@@@A@AA@BAAAA@@CA@@A@@@C@AA@@ACA@AA@@@A@@@@AA@A@C@A@C@@DCA@@K@A@@@A@@DGH@B@@@@@AC@@ACB@A@@A@A@@BC@@@G@@AA@CDA@@B@K@DBCA@@AA@A@@@AB@B@ACB@@@@ABAB@@ABA@ABAAA@@BI@E@G@CBC@@@CDA@A@A@@B@@A@@@A@@@AAAB@AA@A@@AE@@@@@@A@@@A@@@@@A@@B@@@B@@AB@@GA@@A@@@@@A@@@AAAB@@C@A@@@AB@@E@@A@A@@GC@C@@@@DA@@DGB@F@@@FA@A@GB@@A@@AA@@@C@BAI@@AG@@C@E@GAI@CD@@C@C@@@GBCBG@@JDF@@ABA@CJB@C@AB@@@@AB@@ABABA@A@@@@@ABABK@AAA@@BA@@@@@A@AAA@@@@@@A@A@@@ACA@@@@CA@A@AC@AA@@A@@@@@@@A@@@@AA@@A@@@@@CD@@@@AA@@OBA@C@@C@CAA@C@@AE@@@@ACC@@@@CCB@AAA@@@C@@@AB@@CB@@CB@@AB@@ABE@AB@@A@A@A@@@AA@@@CABC@BB@@@@BB@@@B@B@@@DBB@D@@BB@@@@A@@@A@A@GB@AC@@AAA@@AA@@A@@A@AA@@AE@@G@@BE@@BA@@DEB@AABAAIB@@@DCB@C@@CB@@CB@AC@@D@@@BA@BD@@E@BJBB@@@@BBAB@@A@A@A@C@@@@@C@E@A@CAAACA@@C@@B@BA@@A@@@AEA@@@GA@@A@@@ACAC@ABC@A@@A@@@@A@BD@B@BBB@BE@@@AA@@AFAH@B@J@BABBB@B@@ADAFB@CHA@@@@@@BAD@@@@@@A@C@CCA@A@@@AA@@@A@@A@AB@@@A@@@ABA@@A@C@@CA@@@A@BAI@@B@@@AA@@E@C@AA@A@BA@A@AA@@IB@@ADEB@@CA@@C@@QCAA@BGAA@@@A@@@A@@@@BE@@DC@CA@@@B@@A@@AAA@A@@SC@@BDA@BJA@@CA@BHD@@BE@@B@BAABFA@@@@DE@@@@@C@@BCA@AA@CAKAA@I@@BC@@@@A@@A@@@C@@@AB@@@@AC@C@@@CA@@A@@@GA@EAA@@@@BE@A@@A@@@AEBC@C@@AAAA@BCAAA@C@C@MBBD@@ABA@A@EAC@@@@@G@@@AB@BC@@BA@@AA@@@@AC@S@A@@AA@@AA@@A@@@AA@@@KA@@@EAC@E@C@AAA@AB@@A@@D@D@@KD@BA@CCA@M@AE@@A@@@AAA@A@@AA@AB@BA@@@AAB@CB@B@@AB@@CDB@@@A@@AA@AD@@BD@@CB@@CB@B@D@@A@@@@@AB@FAAC@@@@@E@@@@@AC@CE@@AGA@A@C@@@ABC@@A@CA@@C@A@@@@@C@A@@@AA@A@AA@@A@A@@@@@@@AA@A@@@@@@@A@@@@BABB@A@A@@B@B@@A@A@C@@@@BA@@@@@ABA@AB@@@B@@@B@BBB@@@BA@@D@B@BA@@@@B@B@@@B@@A@B@@BB@@B@@@@@@AB@@@D@@AFAB@B@@@@@DC@AB@@@AA@A@C@A@A@@BA@@BA@@B@B@B@B@B@@@@@BA@AB@B@@@B@B@BAB@BABAB@@@BA@A@@A@AA@@@A@A@@@@B@@@DA@@BBBBFAF@F@@ABADABAB@BBB@BAB@BABADCDC@@BAB@DA@@BA@@@AAAAA@C@ABA@A@A@@@ABA@@@AA@@@A@EAC@A@@@@AAA@@@@AA@@A@@BC@@AC@A@@@@AA@@A@A@BC@@@@@@@A@@A@@C@@B@B@@A@CAAA@@A@@AAC@EA@@A@@@A@@A@@AB@B@B@@A@@BC@@BC@@B@@@@G@@@@@AA@A@G@C@@AC@@C@@@AC@@@C@A@CD@A@@@BACB@@GB@@ABABIEB@IE@E@@@AA@AAA@@@@@AA@@A@@A@@C@@@A@@@@GAA@@@A@@AC@@BA@@BA@BAEA@B@@@@A@@AC@@@A@@A@@C@@A@@AA@@C@BCC@@AC@@@@AD@@@BA@@DA@A@@@A@@@A@@B@BAA@A@C@EAEACAA@@AAA@AA@ACCGCAGGAAEECCA@AA@AG@GAA@C@A@ABA@CDCD@BAF@@ADCFABABA@CBA@CBE@C@A@@@CAA@AAA@E@G@A@C@A@@AAAAA@@AEAM@CAA@AA@AAA@C@C@@BA@AFABA@CD@BA@ABIFABA@A@A@CAAACCAAAAAACAGE@AECEAAA@@A@A@@@A@CDKLABCBA@A@A@A@@A"],encodeOffsets:[[117178,33063]]}},{type:"Feature",id:"411725",properties:{name:"确山县",cp:[114.026429,32.802064],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@C@A@A@@D@B@@@AGAC@@CCECCA@@@@AD@B@@@B@@@@@@@B@BA@@A@@@CI@@DG@@AC@@BA@A@@AA@@AC@@A@A@@A@@@@B@@@@AAAA@@ABA@@@@@AB@@A@@@@B@B@BA@@AC@@BA@@@C@@@@@@B@B@@@@@B@@A@@@@A@@@@AA@@A@CB@@@A@@B@@@@C@@@@@@A@@@@B@@@@C@A@@@@@CAABC@A@@@@B@@B@@B@@A@AB@@@B@@@@AA@BA@@A@@@BA@@@@@@F@BA@AFD@@BA@@B@@A@@BD@@B@@@B@@A@ABA@@@@B@B@@B@BD@BA@@D@@B@@BD@@A@@B@D@@@@FB@D@BFD@@@@B@D@BE@@BC@@@B@@BC@@BB@@FA@C@@@@@B@@DC@A@@D@@A@@B@@@@C@@@A@AB@B@@@BA@@@C@BBB@@@@FAB@@@@AB@@@BA@@DC@@BD@@BE@@BB@@BB@@BABB@@B@@B@@A@@@BBB@AD@@BB@@@@BA@@BBBB@@@A@@DA@@D@@E@@B@@@DA@@@C@@@A@@DD@@AB@@DE@@@@B@@B@@@@DAFB@@D@@@DC@@B@BA@@@AAA@AB@@@BB@@@@@@B@@A@@@@B@@@B@BA@B@@B@@@@A@A@@@E@@@AA@@@@A@A@@@AA@@BA@@@@@C@A@E@A@C@CA@@@@AB@@C@@@E@@BAB@@@D@B@@@BB@@@@@@@A@@BB@@@@@AB@@@BBB@@@@@@A@AB@@@@@BA@A@A@@@AE@E@AA@@A@CB@AC@A@@@@@@B@@@@E@C@@A@@@@@A@A@@@@AAA@@@BA@@@@@@@@A@A@@@@@@A@@@@B@@@@C@@BA@@@@@A@AC@E@@@@F@@C@@@A@@B@D@B@B@@A@@@@@@BC@@@@@@@@B@BBB@@ABAAI@OAA@A@CA@@C@A@@A@@@@B@@AAE@A@A@@AAA@@@@AA@AAAA@@@A@@@@@A@@A@AB@@@AA@A@AA@@E@A@A@@@A@@@@B@BA@@@A@E@CAA@@AA@@@A@@@@@AD@@@@A@A@@@AA@@AAGCAA@AA@@BAB@@@@AB@@EAC@@@@@A@A@@A@@@@@@A@A@@@C@@@@@@B@BA@A@C@C@@@@@A@@B@B@BAB@@IDC@@D@BBF@D@@BD@DB@BBA@@@@B@B@B@@@B@B@@A@ABA@@BA@@BA@C@@@@B@B@F@@@@AA@@A@@@@B@@B@@@A@@@@B@@D@@@@@@B@@@@@@@@@@A@@@@@@B@@BB@BAD@@ABB@@@A@@B@@@@@@@@B@BB@@@@@B@@AB@B@@@B@B@D@BDF@@@BA@@BA@A@@@AA@@GCCCA@@@A@@B@@@F@B@@BBB@@@BD@D@BAB@@@B@D@@AD@DAF@@ABAB@@A@AB@@ABCD@@@@CF@BAB@BA@ABABA@@@A@CAA@@AC@@@AB@@A@ADAB@@@B@@@BBB@@HDB@@BBD@D@DABBB@DA@@BCBA@ABA@C@EAAA@@E@CBA@@@AAAAA@G@A@C@A@@@AA@@@@C@AB@@A@A@@@AD@BABADAB@B@@@B@@@@C@@@ABA@ABEF@B@@@BABEDA@ABABA@AAAAACA@AC@@A@AAG@@@A@@ACC@@AA@BA@ADCFABA@@BA@A@A@@@A@ECA@@AC@EBCBABA@A@@@EACA@@C@A@@AA@@AAA@ABEBA@A@@@AAA@CAE@AB@@AB@BC@@@AA@A@A@A@C@@@A@@@ABABA@A@ABABCDCHED@BA@CBAB@@@D@DAD@@@BAB@@ABA@C@@@CB@BA@A@E@A@@B@@AB@BBD@B@@AD@BAB@@@B@@BD@BDDDHBB@@@@@BA@A@ABA@@@CJA@@B@@EAA@A@ABCD@@A@AACCA@A@C@E@E@A@C@ABABC@@BAB@@@B@DKHA@C@EBA@ABKFAB@@ABA@@@A@AB@BE@@B@B@@A@A@@@@@@B@D@B@@@B@@CBAB@@@B@@C@@@@F@@@@A@@@@@EDC@ABA@@@ABC@A@CBAB@@A@A@@@ABADAB@@A@@@AB@BA@@@AAA@A@@@C@@@ABA@CA@@A@A@A@AAAACC@@A@C@@@ABAB@@@@C@@@A@@@A@A@AACA@@ABABAB@B@D@@A@CD@@CAC@A@@@@B@B@@ABA@@BAB@@@D@@@@A@@@AB@@@DA@@@A@A@@@ED@@@BDD@B@@AB@@@B@@ADA@@B@@@BABCF@@@BBB@@@@@BCBA@@F@@@@CB@@A@@B@B@DAD@BB@@BA@@BABAF@@@@ABC@ABA@A@@B@@@@@B@BB@BB@BBBBDBB@BAD@@BB@B@@@B@D@@@BBB@@@B@@BBBBB@@BEF@B@@BD@@@B@@FFB@@@@BA@A@@BBF@@@@@B@B@DA@@A@@@AA@A@A@@@BB@@AB@B@@C@@BC@CC@@A@AB@@@@HH@@D@@@DD@BB@BB@@@B@B@BAB@BAD@@AD@BAB@F@@@F@@B@@BBB@BBD@BBB@B@BBB@B@@@D@@BHBB@BCD@BBFBDBD@BBD@B@@BBBB@@B@B@D@@B@BB@BBJ@B@D@@@D@D@F@D@B@BAFAB@@@BB@BBBBBBBBB@B@@BB@BB@BB@BBD@BB@B@HABAD@D@HAB@BBDAB@DABA@@B@B@D@B@D@B@B@B@B@@AAA@CAACAAA@ABA@@A@B@B@@@@@@B@@B@FHHDD@@@@AHB@@FAD@@@D@@@AD@B@DBBBB@@@B@@B@@@@AF@@@@G@AFI@ABC@@@@BB@@B@DA@@B@DBDB@@FADADCDEDAF@DBJAD@DDBDDBBB@@D@H@D@DBF@B@@@DBFCH@B@DAFEFAJ@HDRAD@@@LCPAR@B@HAB@BAD@D@FBFDBBBBDBB@B@B@FAF@B@D@BBF@F@DBDBFDDDDDFHBDBBB@DBF@D@DAFCBCB@FCBCBANDP@R@NBJAP@T@L@RDFBFB@@HDHFFAHAF@D@JBJAFCFE@@DDBDDBDBFBBD@BFFB@B@BABABABCDEBEBAFIDCBABA@@D@B@@@BB@BB@@@BAB@@A@A@@BAB@BADBLBLDDBB@D@@@BBBBBBD@B@@@B@B@BB@@@B@@@@AB@@B@BBDB@@B@BA@@@AD@D@B@B@@@@@DB@@BADA@@B@B@@@B@@@BABA@@D@B@@@@@BBB@BB@@B@@@B@BA@@@@@@B@@B@@@@B@@@B@BA@@B@@A@@@A@@DAB@B@BA@@@A@@@A@@B@@@D@@@B@@BB@@@B@B@B@BAD@B@D@B@@@BBD@@@BA@@B@@@B@@@@CB@@AD@@@@D@@B@BAB@B@@@@@B@@@@@BBD@@@B@@A@GD@@CB@DABAB@@@@EH@@C@@A@@@@A@O@AD@@@@G@AJB@@@CH@@IBB@IC@@@AC@@@A@@@@CGAA@A@EACC@@@@AB@@BD@@CB@@GC@@@@CB@@@@A@@G@CB@HF@@@AB@@AB@@@@@@A@A@@@I@@FE@@AE@A@A@@DE@@D@@G@@@@DCBAD@@@@A@@AA@@@BE@I@@@@AABAB@@@@CD@BABEBKB@@C@@@@IAA@@@@@A@@@@BA@CAA@@A@@E@@@@A@C@@B@B@@@BA@C@@@A@A@A@@BA@A@@B@@@@@@@@A@@@@B@B@@A@AB@@@B@BAB@@A@A@@@@@AB@BB@A@@BAA@@@@A@@@@B@@@B@@@@B@B@@@BB@@@@@B@BA@@B@@BB@@@BB@@@BB@B@@@@B@@AB@@@@B@B@B@@@B@B@B@@@@@BA@@@@B@@@@@BB@@BBB@@@B@@A@E@@HEB@@A@@@@B@@B@@B@@@@A@@BA@@D@JDB@BAB@@@@A@A@@@ACAAA@A@A@@BABADAB@BA@@@A@A@AAC@@@@@C@@@@BA@A@@AA@@@M@@K@@@AC@@A@@@@@@@@@@@A@@@@@@@C@@B@@C@@@BCA@E@@@@FA@C@AAA@AAACCEEC@AA@C@A@CB@BCD@BA@AA@AA@@E@CBA@A@@@AEC@ACAC@AAA@@A@A@CAAA@@ABA@A@@B@@A@@@AB@@A@@BABA@AB@@ABA@AB@B@@AB@@@BGB@@@@E@@B@B@@CA@A@BAJB@CH@@@@BBB@A@AB@@BB@D@@A@AF@AD@@A@@BD@@DD@@@@AFBD@@B@DB@DBD@BB@@BF@@F@@B@@@H@@B@@@@F@@@@N@@@@@@@@A@@D@@AHBB@ABN@@C@A@@BAD@@@@CB@@A@A@@@@@A@@@AAE@A@@@C@@F@@@AA@@B@@A@@@AA@@@AA@@A@A@@@@A@@C@@AA@@@AA@AE@BCC@@@@@A@@@@B@AC@@B@@A@A@@A@@@@A@AA@AA@BC@AA@@BA@BI@AC@@@@A@@@@@@@A@@C@BAA@A@@@@@@A@AB@BEA@@CJE@ABE@A@A@A@A@ABCD@BAC@@EE@@AA@@A@ED@@DB@B@@@B@@GPBBAAAB@@@B@BAB@DB@@BEKA@@BAB@@@@A@@@A@ABE@A@E@K@AF@CE@A@@B@@CF@BEFBAF@D@@D@@CH@B@FA@IIAC@A@@@E@@AA@@@@AAA@AA@@EI@A@BC@ADE@@@AB@@@@ABA@@@A@@@@BBBA@@BC@@B@@@@A@@@@CC@@AB@@A@@A@@@@BABA@@@AB@BBB@@A@@@@@@@A@ABABCBA@@B@@@@@AAB@@A@A@@AA@@@A@@C@@ABAA@@AA@BCBBD@@@@@B@B@@@@@@A@@AE@AA@A@@@BA@A@@@AA@AAA@@@@AAA@A@@@@BAA@@@A@@@@A@@@@B@@A@@@@@AB@A@@AB@@A@@@@@@@A@@@@@@BAB@@A@A@@@@@A@@@@@AB@@C@@@@FA@A@@@@@A@A@@BE@@@@@A@@OA@B@@@ACAG@A@EAGAGC@@EAA@@AB@@@@@@@@@A@@AE@BC@EBE@GA@@A@@@@A@@@AACB@AC@@AA@@A@@A@AEB@@A@@II@@@D@@BDGBAK@@@BA@E@@@@AE@@BC@@@A@@@C@@@@@@C@@AAA@@@A@@@@@A@@A@@@A@@@@BA@@A@@@@@A@@B@BAB@@AB@@@B@@@BAA@@@@A@AA@@A@@@@AAAACBA@CA@@A@AC@@@A@@CC@@@@AD@@C@CAC@AAC@@B@BE@@@@@@@@@@@A@C@@@ABA@@@B@B@@B@@@@AB@B@@@@@A@@A@@@A@A@@A@A@A@A@@AA@@@@@@BBB@B@@@@A@@AA@@@A@@@@@A@@C@@AA@@A@A@A@G@C@EBEBGB@@C@@BAB@@A@@@A@A@A@AA"],encodeOffsets:[[116872,33242]]}},{type:"Feature",id:"411726",properties:{name:"泌阳县",cp:[113.327144,32.723975],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@C@ACC@@@C@C@AA@AAC@@ACAA@@A@@E@@ACA@@C@A@A@AAAAAAA@C@@@AAA@@@@@@@ABC@CA@@AA@@A@@@ADCA@@A@ABCAA@C@CBAB@@@@@BBBDH@@AA@@A@@B@@@B@@@@@@B@@@@BAB@@@B@@@@A@@@AAAA@@ABA@@@@@BB@BB@B@@@BBB@B@B@B@@@B@D@@@@B@B@@A@@@A@@@A@AA@B@@@@@@A@@A@@A@@BA@@@@AA@@@@@@@@B@@AB@DA@@@@@@@B@@B@@D@@BA@@@BD@D@@B@@FB@@D@@AAA@A@@@AB@@E@AB@@@B@@@@@@BB@@A@@BAB@@@@BB@@BA@A@BB@@@@@@@@@@BB@AB@@CA@@ABBBB@@BB@@@@B@@DDDB@B@@ABB@ABB@@B@B@@@@@BA@ABB@@@@@@D@B@B@@AB@@@@BB@@@@BBBBB@BB@@@BB@C@@B@@@DDAB@@@@B@@CDA@@@@BA@@@@@AAC@@@ACA@CB@BA@@DEB@BABA@CDCDDDC@@@@@@B@FAD@@D@@@BB@FB@B@HAB@@BFD@BBB@B@@B@B@BB@BBB@@C@@@@BB@B@@@@BBB@BBB@B@@@B@BC@BB@@BDDFBDFHB@@@B@@@C@@A@@BA@@B@@@@BB@@A@@B@@B@@@@@B@@@@B@B@FBD@BA@BB@BB@@B@@BAA@BA@@DF@AA@@B@@BFABF@@@A@@@AD@@AFCBBB@@BBAAA@@@C@@@A@C@A@@B@@@AADABAAC@@BA@@B@B@D@DA@AB@A@A@@AB@@ADABB@@BB@@@@BAA@D@DB@@B@BCA@@@AC@AAAC@CCAA@@B@@@AA@AB@B@AG@ABE@@B@@B@@@@B@BA@@DA@@@AAA@@D@BA@@@@DDB@@@@B@@@@D@@BBBBD@BBF@B@BD@@@@B@@@@@@BBBD@@@BAD@BAB@B@BAD@@@@CBEEA@BBCB@BC@BDC@BD@BBDCB@@A@@F@@@@@AACAAA@A@@@E@A@@BAB@BABB@BDC@@@AA@@A@@@@@BD@BCB@@B@@@@@ABBDC@AB@B@@@BABADA@ABBB@@C@GB@BC@@@@BC@GBGAAAE@A@C@AB@B@BA@A@I@@FDN@@BA@H@@A@BB@@@J@BBF@@@BAAA@GEAACCAAAC@AAAAG@@AAAA@B@@CJBB@DI@BF@D@@@B@@B@@@@BB@@@@AB@@@@B@BBBAB@@@BBB@@BB@@@F@BB@D@@D@@@@D@@BHABFC@@B@DA@A@@D@B@@@@B@@BB@@BB@@@BB@B@@@B@@BB@@@@@@@@CB@@@BABB@CBBFE@@@AAC@CB@@EBBBBBBF@@@B@@C@@@E@@@@@@BA@C@BD@@ABA@A@@B@BBB@@@@C@A@ABA@A@C@@B@@C@BDB@BB@DB@BA@DDA@FB@@B@B@B@BD@@B@F@@ABBBC@BDA@@HBB@@BB@B@B@@AAIB@@B@@@AB@BCB@@@B@DB@@@@DB@BB@@@B@@BDDDBD@BA@@@A@@@@BB@@B@B@@@BA@@@@B@@@@B@@@@B@@@@ABA@@@AB@@@B@@@@A@A@@CA@BBA@AD@B@@@B@@@B@@A@A@ABA@A@@@A@@@A@A@@@A@@@@B@@BB@B@B@@@BA@ABA@@@@@@DA@ABBDFCB@@BCBA@AB@B@B@B@B@BB@FDDDDA@AB@BDDABDBDBDBDD@DJ@@ABB@D@@@@B@D@@FA@@HA@D@B@@@@@@BBBBDBBBGDCBBBB@@BDA@BD@ACB@@@BBBABDBD@@BDDD@@BBDBA@C@@@GH@B@BA@@@B@@BBDA@A@ABBA@BD@B@@DA@@A@@A@@AEBBBBFBF@@BBB@@@@@ABB@@FB@@@BBC@B@@FBDBD@B@@GD@@A@@@@@@@A@@B@@@@@@AAC@A@C@A@ACB@AA@A@BG@@AOB@B@@@BCB@@A@BA@@@@C@CBA@@DA@@@@B@@@BBD@@@@@@@DB@C@BDA@@@A@@@C@C@@@C@A@B@@@GB@AA@@BGBA@@BBBBHKB@BC@@BBBA@A@@BA@C@CECEBA@A@BCADA@@@@@@CACAEBACA@BD@BAAA@A@ABACABA@A@ED@@@@AAG@@BA@AAEBG@@A@@EB@@BD@@@@B@BBABBDA@@B@@A@GD@BA@@D@BA@CBCBAAABA@A@@@BB@@D@@@EDA@BBA@DDA@@BDADF@BA@C@BBB@@@BB@@HLB@BB@@BB@@@BBBB@@BCB@B@BBA@BD@@ABDDF@@@DBD@B@B@B@@DF@B@BBHBD@@B@BDB@@B@@AD@B@B@B@B@BBB@@BD@BD@DBAB@BDA@BD@@B@DF@B@@DD@@DBB@@@BD@@BD@@@B@BB@BB@@F@DD@@CD@@D@@HB@@B@@BB@BBBB@BB@@B@@EB@@@@BBDB@@B@EBBBBDBBDHF@@@FFDFBADD@BDABDBABHB@F@@AAA@@@AFAHA@@@@D@B@@B@@BBBD@@@BDBB@BD@@EBCB@B@@B@@BAB@BAB@@A@@D@@DAD@B@B@B@AAB@B@B@B@@@@BJD@B@@@@@@B@D@HCDCH@@@BA@@B@FABA@AB@B@@@@@B@D@@@@@BD@DBB@@@B@@DBB@BB@@B@D@BA@@BB@@BDBB@@FFBBD@JCD@B@B@BA@@B@@F@F@@B@DBD@B@B@BD@@DA@@D@@@@@BA@A@@F@B@@@B@@AB@B@@@D@BBF@D@BB@@B@@@@B@BA@B@@B@@F@B@@ABA@@@@BD@@@@@BBAB@@@DD@BBB@@ABBBB@@@BABA@@@CB@@@B@@AB@BBB@B@@@@@FB@@B@@@B@BA@@BA@@AAAA@A@@@@@C@@@@B@D@@BB@B@BB@@@B@@@@BCBAB@@@B@B@@BB@@@@@D@@BB@B@BABAB@BBD@@BFBDB@BF@D@BBDB@BB@DBB@FB@@B@B@DBB@@@BA@@@@BBB@B@B@B@@BBBDBBBDBFDBBBB@@@B@@@B@@D@BBBBB@BBB@@BAD@B@@@@BB@@DD@D@B@B@@B@B@BBDBD@DD@H@BA@@BB@@BBABB@@@BABA@@@DFDDDADD@BE@@@A@@@@B@B@BB@@@BB@B@BA@@@BB@@@@D@@@B@@B@@@B@@FJ@AD@D@BB@@BB@BBB@DDA@@B@B@B@D@@@@F@@B@@@BA@A@@@BB@@@@@@@@AB@@@B@BDDDB@@@BAB@@@BBB@BA@@@AB@D@BAB@@@@BD@B@@@DBB@FBBB@@@@B@B@B@BB@@B@BA@@B@@@B@B@BBB@BBB@B@B@DA@@@@AAAAB@@AB@BAB@D@D@D@@@B@B@D@BADBD@DA@@F@BAFAFA@@B@@@BBB@FBBBFDB@B@@@DC@@@@B@@@BBDBBBDBBBFDDBB@@@B@@ADC@@@AB@B@@AB@@@@A@ADCBA@@BAB@@@B@BBB@FA@@BBB@B@@AB@DE@CB@BAB@BA@ABA@A@@B@B@B@B@@@B@BCBAB@@A@@@@B@@BDB@@@BBBDD@BB@@BD@@@@BBB@B@@A@@B@@BB@@@B@B@B@B@B@B@@@B@@@BBBB@B@B@DADAB@B@DA@@DAB@B@B@B@B@@@B@B@@@B@F@B@F@HAD@B@@BDBD@@@@@@DBB@D@@B@D@B@@@BA@ABCDAFEBAB@@@BADCBA@@@C@@@AF@BACCG]EOKKACAMMCCI@IBS@ENDLAB@@U@ACBJ[@CMGACCCAA@KBCDGBUKMCC@M@AAICKOAKBC@I@@IBEBKEAWFIACJC\\QLIHABEEFGFGLMKG@@DK@ADICQT@JASKYAYH@@MMDKPCP@FDD@JAHCAEF@JJDDFARFF@FOBSGUAIeuDAAGPMHDRA@BHJLFB@LAHBDCN@VCNCHCLARCF@VSBWN[@AC@A@I@AAA@@A@AC@A@A@@@AAAA@@@AAC@AACACAE@ADC@AAAAG@@@C@@@AAA@A@AAA@AAC@AAA@AA@@@@E@@@EBA@ABC@@BC@ABA@A@A@A@@AAA@@ACC@@C@@@GG@@@@BAB@@@DDD@@AD@@@@ABA@@AA@@B@B@B@@B@@@BB@@C@A@A@@@@AE@AB@B@@A@@A@EE@@@A@@AC@@@AFE@AA@AAAA@@@A@@AA@A@@@C@A@@@AAA@@BC@AAAACAA@AAAA@@A@A@@@@@AB@B@BAD@BA@@@@BEBA@AB@@AA@@ABC@C@A@AB@@@DA@@@@@EB@DA@A@@@@AA@A@@DEBA@A@@@AB@BC@@@A@@BA@@@ACC@A@@FCA@@@AAAAA@CA@AAA@@@@@AAB@@A@AB@@ABC@A@CACA@@ABA@@@CA@@ABABA@ABAB@@AB@DA@CCA@A@@@@AA@@C@AAC@@AAAC@A@@B@@AA@@AAA@@@A@@BC@@D@B@@A@@@@DBB@BAB@@A@@@@@@AC@@@@AA@@@@B@BA@@AA@@AA@@@ABAB@@A@@AA@@BC@@@@@A@@AA@A@AAA@@@AB@@@@@@A@@@E@E@AAAA@@A@@A@@@@BA@AB@AA@ABA@@@A@ABC@AB@@@@@@AAAAA@A@@AA@@AC@CAA@A@A@@@@A@@BAB@@@FC@@BA@@@A@AA@@A@@BCDE@@@@@A@AAA@CCC@@A@@@@BCBA@@@A@@ACA@@A@A@EDCBAB@@@@A@@A@ABC@A@A@A@A@@AAAAAACA@A@A@@AA@AECA@@ACAEAAA@@@@BC@ABC@@@AAA@@A@@C@@BA@@@AAA@@AA@@BA@A@@A@A@A@@@@@@@BA@@@@@A@A@@@@AAA@@@@A@@@A@C@ACAAAA@A@@AC@A@@@AA@AA@A@A@AAAACCA@AC@@AACAA@A@CCA@@@A@@BAB@@CD@@AAC@@@AB@BA@CBA@@BA@AB@@A@A@@@AAAC@@@A@C@@@AAAAAAACE@@A@EAA@AC@@B@@CBC@C@@@A@A@C@@@@K@A@CB@AAA@@AACAA@A@@@@@AB@@E@@@CB@@A@AAA@A@AB@@@@@B@BCBAB@@CB@@ABAB@@CBCBA@@BC@@@AB@@A@EBA@@AAA@@CB@@@@CAAAAAAAA@@@@@@@ABAB@D@D@B@D@@@D@@@@A@@B@@A@C@A@A@@@CAA@@AAC@@@@A@A@AB@BCBAB@@CBA@CB@@AB@BABA@@@AB@B@BABABABABABA@E@@@@@ABABAD@@@B@B@@ABABA@C@@@CBC@@@E@@@@@AB@B@B@@ABA@GBC@A@A@A@@@AAAACA@AA@A@@AAE@@AE@A@A@@A@AAA@CAAAA@AAAAA@@A@ABA@@AE@AA@AAA@@@@A@A@@B@@A@@CACC@@@A@AEE@AAC@@A@C@AA@@@A@ACC@@@AA@@@AB@BAB@@ABCBC@CBAACA@@A@@@AB@@@@CA@@A@A@@AAACC@@AAA@AB@@AHA@ABCBA@C@@@AD@@C@CBCA@BAB@@A@@@AB@BAB@@BB@B@B@BAB@@CDA@A@C@AAA@@AAAAAAAAA@AA@A@@AAA@@@@AB@@ADCDAB@BB@@B@B@@E@EBA@CBCB@@C@ABI@AB@@BBA@ABC@AB@@AB@@@@CAAAI@AAABA@C@CBA@A@IAA@A@@@AD@@AAAAA@A@CB@@A@CC@A@@@@DCAC"],encodeOffsets:[[115966,33372]]}},{type:"Feature",id:"411728",properties:{name:"遂平县",cp:[114.013182,33.145649],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@AA@@CAA@@AC@@HEB@DC@@CG@@@A@A@@BC@@DC@@D@@C@@@AA@C@@@D@DAA@@A@A@A@B@@B@BB@BBBBFB@@@B@@@B@@@@@BDBB@CB@BA@C@CBA@@@@AEEEECCAAA@EACAC@A@A@@@ABGH@@@@ABA@A@C@I@C@AB@@AB@B@@DB@@BD@@@BAB@@@BA@@B@B@B@B@@@@A@C@AN@@G@@@AA@@A@@@@@@BA@@@AB@DAD@B@D@BAD@HA@@@@BA@@@@F@@D@@B@B@@A@A@AB@BA@CBA@ABEF@@@@A@C@@@A@CDEB@B@BABB@AB@@A@@B@@@@C@@@AB@BABAB@@@B@BAB@B@@ABAD@@ABCDADABCBAB@BABBD@@AB@DA@@@@B@@@@@BAB@@@BAD@@@B@B@@AB@B@BBB@@@B@BA@@D@B@@@@ADAB@D@@@BGF@@@B@BA@BB@@@DC@@AAB@D@@B@DBAB@D@B@@A@@@AB@@C@@@@B@@B@@@AB@@A@@B@@D@@B@@A@C@@@@@AB@A@@@A@@@@@@A@@@A@@B@@BBAB@@ABAF@B@@A@AAA@@A@@BABCBA@@@@@A@@AAA@E@A@A@CHA@C@@@ADAB@BA@A@@@A@A@AAAACAA@@@C@@@AAAA@@@BA@@@A@@BA@CA@@@B@@@BA@A@A@@@AB@@@B@@@@AB@B@B@DAB@@AB@@@B@BA@C@@@CAA@@B@D@B@BAB@BFBBB@@B@@@B@@BBD@B@D@@@B@B@BBB@BB@@@@@BB@B@@B@@BB@@@BB@@BBBB@D@@BBB@@B@@BBBB@@@DDF@@HDB@@@@B@@ABAB@B@BABDD@@BD@B@@@B@B@@@@@DAB@F@BBBAB@BBBAB@@BFBD@B@@@BD@BBDB@@@DBBDDBBFBLHB@DBBBBBB@@BBB@@D@BBB@F@NBB@@@AA@@B@@@B@BB@@@@F@BBBD@B@B@@B@@@@B@B@@@@BDB@@@@BA@@@AB@@@@@BB@@@@B@@AA@BA@@@@@@BB@@@B@@@BB@B@@@@@B@@@@@B@@B@@BB@@@@BB@B@BB@@@@@B@@@B@@@@@@BBB@@B@@B@BBB@@B@B@BB@@B@@B@BA@@@BB@@B@@BA@@@@@BB@@@@@@@BB@@@@@B@@AB@BABA@@B@@@@@B@@A@@@@@BB@@B@@@B@@@@@B@@@BB@@@A@@@@B@@@@@@@@BABA@@@@@BB@@@BA@@BAB@BB@@@@@@@D@@@@B@@@@@@@B@@D@B@@BL@D@B@@BB@@ADBB@B@HAD@B@B@@@B@@@@B@@@H@B@@@@@@B@@@@A@@@@FB@@@@B@D@@@@C@@B@H@N@@@@BB@BB@@FAB@D@DBDAD@B@B@HAD@D@@@B@@@@@BBB@D@@@B@@@@AB@B@@@B@B@B@B@@@@C@@@C@@@@@A@@@@@@@A@@B@@B@BB@N@@@@EL@BB@@@BD@D@@@D@@@@BBBB@@@H@@@@D@B@B@B@BA@@@A@@DB@@@D@@B@B@BB@B@B@@@D@B@@A@@F@@BABB@@B@B@@B@BB@CF@@DB@D@B@@FH@B@@@BA@@@A@@B@@@@AD@J@F@@@BA@@@AB@@@H@@@B@@AJ@@D@B@@@@@@DBHBB@B@D@BC@@@AD@@B@BB@B@@HJ@@A@CFB@KJ@B@@@D@@BB@BBD@@AB@@BB@B@@B@@B@B@@FD@@BC@@FD@B@@@D@@BB@B@HB@D@@D@@CB@@D@@B@@CB@@@F@@G@GB@AE@A@IB@@AA@@C@@@@A@@IA@@CA@@A@A@@@@@A@@@A@@@A@@@A@@BADAD@@@@A@@B@B@BA@@F@B@@CB@@@B@@@B@@@BAB@@AD@B@@@B@@AD@@EF@DBDB@AB@@AB@@BD@@A@ABA@C@A@CEABAB@P@@BD@@@B@@@@@D@@@JBB@B@@A@@@A@ED@@@N@@AJB@CF@B@ADD@@AB@B@@BBB@A@@B@B@@@BD@@B@@A@@@BLB@@@ABA@@D@@@@GJ@@E@@J@B@B@@AD@@@B@F@@GJ@F@@@B@@AF@@@@B@@RBD@B@D@@CB@@@@D@@J@B@B@@ALFRH@D@@@@B@DADDDBB@D@DBD@@AAEB@F@@@B@FCBAB@FG@GFCB@B@B@H@@@@B@@@D@@N@B@@@@D@BD@DAB@B@FBBB@@@AD@@AB@B@@BDBB@@@D@DG@@G@@A@A@A@A@A@C@@@AB@@@FBD@D@B@FAD@@@@C@AB@@@@C@@@AA@@A@CACB@@A@@AC@@B@@@B@D@@@@@@C@AB@BA@@B@B@BAB@BBBBBDBJ@@BDBB@@@B@BB@@@B@LAB@@@BACK@@BA@@@AB@@@@CAA@@@A@@@A@MB@B@@CA@@@@E@CA@E@@A@@@@@@@C@@B@@@@DB@B@@C@@@@D@@AD@@@@CBA@@@@@@@@@ACE@A@@@AB@@@D@@@@A@@BABA@@@C@AA@@@@AA@@@CA@AE@C@A@@BAA@B@@A@E@BG@@@@F@@@@A@@@@@@@C@@B@@@BCC@A@A@ACAE@@@@F@@@@@@AA@@A@A@@@@AA@@BC@@A@@AAAB@@@@@B@@@@@BGAC@@BA@@BBBA@AB@@@@A@@A@@@@CA@AACA@E@@@@C@@A@@@A@@A@C@@A@@@@@@@A@@BA@CG@C@A@@@@AA@@C@@@CAB@@MC@A@@F@@@@C@@A@@@@EAA@AB@@@DDJ@@@@C@@@A@AA@@A@A@AA@C@@@@@@@BAHC@@@@AA@@AA@A@@@@A@A@C@A@A@AAA@E@A@E@A@@@@BAF@BA@@@A@BB@@@BB@@@@F@BCBEB@@A@AA@@@A@@A@@@A@@A@C@AC@@@@A@@AAE@@@@@AA@@G@@A@@B@B@@@@ABA@@D@@@@E@A@@C@@@@@A@@@C@A@@@A@@@@CA@@@@@@A@A@@D@@B@@D@@A@@D@B@@@@CB@@@@A@@A@A@@A@AE@@CA@E@@@A@@@KA@@@@@C@@A@A@@@BI@@@@BA@@@@BA@@AA@@B@@@@I@C@C@A@@EB@E@@B@B@BA@@AA@AC@BCCAA@@@E@GBIAKHEBEB@@@B@D@DBDADCB@HEFS@I@S@A@@@@@@@A@G@C@G@I@G@C@A@GBEBEBC@CD@B@@@FAD@L@@ABADEDABCBCB@@A@E@A@C@A@E@GAA@@@AA@A@A@CAE@ACGAGCGEKAAACGGEI@ACCCGCI@A@A@C@@@GACAA@AOIIEAA@@A@ABCDCDEHABIHEDABABAB@B@B@FAF@@AD@B@B@@@BA@AB@B@@@BBB@@@@AB@@@BBBBBDBHD@B@@AB@@BD@BADAD@B@FBF@@@B@@CBA@A@E@A@IAE@EAE@CBCB@DAB@D@F@HAB@B@@C@A@A@@@CA@AAACA@AAABABC@@@A@AAAC@A@A@@A@@@A@ABABAAA@A@@A@CAC@C@EACA@@A@A@A@@BA@A@CCC@AA@@AAAACCEACCAC@G@A@E@A@CACAAECA@@AI@CAAA@A@@@ABE@C@E@E@CAACCGCC@@@MAGAABEBE@A@@@C@@C@A@C@CBEBADAJ@D@B@BA@A@@@A@@GICG@AAA@C@E@CAE@C@A@@@@C@A@@@M@@@@AB@@@AA@@@C@@A@@EA@@EC@C@@@CA@@C@A@@B@B@@A@@@"],encodeOffsets:[[116535,33786]]}},{type:"Feature",id:"411727",properties:{name:"汝南县",cp:[114.362379,33.006729],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@@@@A@@@AAAAC@A@A@A@@AA@@@@AA@BA@@@AA@@@A@@@@A@A@@@A@@@@@A@@@AAAA@@@@ABAACB@AA@@@AACAAA@@@AA@@@CAA@AAABCAA@AAA@A@@@C@AAA@A@@@A@AA@@BA@@@@@@@@A@@B@@AB@@ABABA@@@@@A@@@AB@@A@AB@@@@A@A@@B@@AAA@AA@@BCAAAABI@@MC@BI@@FEABEA@AA@BC@@DE@A@@FA@@DC@@B@@@D@@C@@CC@@DC@@GD@@CG@@FC@@BB@@HCBAB@@AB@@@@@@@@A@A@@BG@@AA@@BC@@@BAA@@A@@@A@@@@@A@@@C@A@@@A@@@A@A@AAAABADCA@CAAE@ABOA@HA@@@A@A@@CC@@F@BB@@BF@@FD@ABC@AD@B@B@B@B@BAF@BIF@DB@AFA@@B@B@@@@B@B@ABD@@@@B@@@@@@@B@@D@@BAJB@@AB@@BADB@@BBBB@@@@@@BB@B@@@@AD@@B@A@@B@@@@@D@ADF@@BBB@@B@@BD@@@@B@@B@B@@@BB@@B@@B@@@BA@@@BB@@E@@@@D@@@BBF@B@@@B@@@@@B@BA@@D@@C@AB@@@B@DM@BAA@GA@BC@@@@B@@@@@@M@@@@@@E@@A@@@@G@@@AE@@@AE@@AAC@CAA@@C@AC@EA@B@@C@@CC@@AB@@@BCE@@B@BC@A@@AA@@B@BAA@A@@G@@DIAABB@B@@DA@A@@@@F@@A@AH@@A@@BA@A@@BAB@BA@@BABAB@@@BA@@B@@@BA@@@@BAB@BB@BB@D@B@BB@BBD@DB@BFD@B@@@BAB@D@FB@@BBBB@@ADC@ADAB@D@B@@BFDDFBDBBB@BBD@B@@E@@F@B@AD@@D@@@@AD@@@@@@@B@@@@@@@@@@@B@@@BD@@L@@@@N@@BB@@@BAB@@@@@D@@@@BD@B@B@B@@ABA@CBABAB@@@B@BBBDB@B@@@B@B@@A@ABA@ICC@@@AB@@@B@@A@@@@AA@@@@@@BA@GF@@@F@BA@@@A@AA@@AA@@@@A@@@@@AB@@@@A@A@A@@@A@A@A@@@@@BA@@@A@@A@A@@A@@AA@@A@@AA@@@ABA@@@@@A@@A@@@A@A@@A@@@A@@@@@@B@@B@AB@@@BAAA@@B@@@@@B@BA@ABA@@@A@@B@BA@A@@@@@@B@@@@@@A@@@@BAB@@@B@B@B@@@DAB@@A@A@@@@D@B@@F@@@@BB@DBB@@A@@B@@@@@B@JB@@@@@DA@ALAFABC@@D@@A@ABBB@@@@@JAF@@B@@BB@@@@@BCDA@C@@H@@@@CF@@CB@B@F@@BF@@EJ@@@B@B@@@@@@@BA@@BA@@E@@GDAH@@@@B@@A@@D@@D@@HA@@DC@@AA@@B@@D@BD@F@BBBDH@@@@@B@@BD@@D@@JAA@JG@@D@@IA@B@H@@C@@B@P@B@@B@@@@DG@@F@@A@ABCBA@@DC@@H@BA@@@C@AA@@@@A@@@@@A@A@ABA@@@@C@@C@@BA@@D@@A@@@A@@@AB@@C@AA@@A@C@A@C@ABA@A@A@@@A@@AA@@@C@@@A@@@@B@@@B@@ABA@A@CB@@@B@@LBGJBB@@B@@A@@DB@@@@B@@AB@@@B@BB@BBB@@@@B@B@B@@@BB@@@@@B@@C@@JDB@B@FCA@@ABABB@@@@B@DC@ADCAABAD@@CAA@@@AH@@D@@DA@@B@@D@@B@BB@@E@@B@B@@@@EB@DD@B@@A@BDC@@BC@@DHB@@BKB@BB@DBBBC@AFDAB@BABABB@B@D@AH@@CC@@@H@@A@A@A@AH@D@@A@ADA@@D@@@CA@@D@@@@@@ABDP@JBJBF@DDBBBDDFD@@BBB@B@FCBAFCFCD@B@B@BBB@BBDHBBBBB@D@B@BADABAD@B@BBDBB@BBH@FBH@F@B@DA@ABADE@@@AFABADA@@FBFDFDDB@@BBB@@DB@B@B@AHB@@FBFBDBDFDLJ@@BBCDABA@@B@@@@BB@@@@@B@@B@@@@B@B@@@@@BA@@@BB@@@@A@@@A@@@AB@@BBBB@BBB@BA@B@A@C@C@A@@@@AA@@B@@@B@@@AA@AA@B@@DBA@A@@@@B@@B@B@@@@B@@@@@D@@ADBB@B@DBBBDDH@D@@@B@DABAJ@@ADCDMTADGHC@E@KBGAGAOAEAEAEECA@AAAA@E@A@E@I@E@E@@@D@@BC@@@@B@B@@@BDB@@@B@B@@D@@@@@BJA@A@@@@D@@B@@@@@AD@@@@C@E@@@GAKAA@G@@@IAA@A@AE@@@@C@A@@@AB@@@B@@BB@B@@A@@BC@@@@B@F@@ABA@@D@@A@@@@H@@@@G@C@@@AAA@A@@A@A@A@@K@A@@F@@C@@@@D@@A@A@A@@B@B@@G@@@@@AD@B@B@B@@C@A@@BD@@@@B@BB@A@D@@@@DBD@@@FA@A@@@A@@@A@@@@B@@AB@F@@B@DBADD@@BBB@@ABA@A@@@@FFA@@@B@D@D@J@@A@@@BB@@AB@@@@AB@@@@AJ@@B@B@@@@D@@@@LB@@B@@@F@B@@DF@@B@BB@B@@@@B@@A@@D@@A@C@@@@BC@@@@AC@@@@B@B@@@@B@@D@@B@@@B@D@@@B@@@@@D@@@@B@F@@C@@@AB@B@@A@A@@@@BH@@@BB@@@@F@BB@@@B@@D@@B@D@BB@@@B@@@@B@@BBB@@@FADA@A@E@@A@@A@@AAB@@@B@@ABE@A@@B@F@B@F@B@BBB@B@D@B@B@@@@@@BBB@@BB@@@@GDAB@@@@@@D@B@@B@B@BB@@B@B@@@D@@I@CC@@A@@BBB@F@@B@@@@D@@E@@@@BND@@BA@D@@@DB@@B@@B@D@H@@@@AB@D@D@D@D@@BDBDDDB@@DAF@@AB@DE@@@@B@D@@B@@B@@D@B@D@D@DADAF@BAB@B@@@BBB@B@@@F@@@B@@B@B@B@B@B@@BB@@BDDBB@@B@D@@BB@@@JB`@F@@@@OH@B@@@@B@@F@@B@B@@N@BBD@D@@@D@B@@@@A@A@@@@@@@AB@DB@@H@BBD@B@@A@@@@@E@A@@N@FA@@@E@AB@F@B@@@BA@AB@F@@CBC@EJ@@@@BF@@@@BAJ@DB@D@@FJ@@A@@F@D@B@@AF@D@AH@@F@@@BB@@D@@FF@@@B@BBBBB@F@B@@@B@BBB@B@HAD@DAD@L@FB@@@D@@D@@F@@H@D@B@@BD@HB@@DEBBD@@@H@@HF@AFB@@BB@@@D@AHD@B@@HB@J@BBAH@@FDJFABB@B@BB@A@A@CB@B@NBD@B@@@FCBA@A@@@@A@@A@CB@@@B@D@@B@BHGB@@@@DP@D@@DF@B@@A@A@A@@CAB@@IB@D@@@@AB@B@@C@@D@@BF@@@BGAO@@@AF@@BBDB@FBBBD@@@@B@@@BAB@BBHF@@AB@B@F@@@@C@@BAF@DCAGA@@E@ABAHA@@@CB@AABC@E@AEAJK@@EEA@@AAE@C@C@A@@AA@@C@@@@A@@BA@A@A@@@A@A@C@AA@@@A@@A@@@@@A@A@G@@AAA@A@@@@AB@@@@A@@ACAA@A@@@@B@B@@A@@@AA@@@@C@@A@@B@@@@A@AAA@I@@@A@@G@@B@@@@C@C@@DA@@BAB@@@@A@G@@@@@@DAB@B@@AAE@ABA@A@@@@D@\\@J@J@F@@@@C@@C@@AA@@A@@C@@AAAAAAA@@@C@C@@@@B@@G@A@@DA@@@A@A@@CCAA@AAGAA@G@AA@CCA@@@@A@AAA@CBEBE@A@AA@ACAAEAGAC@GCA@AA@@@A@A@@@AA@A@@ABA@@@A@@@A@@@ABAB@DA@@B@@A@@BC@@BC@@@A@@@@DEDG@ABCC@@@@KI@@@@@@C@AAACCAACECIAE@CAAAAACCC@ACCA@CAA@EAA@AAA@AA@@AA@A@AAA@A@A@E@@AA@AAAA@CAA@A@A@CBA@AAA@@ACAAAAAA@A@E@A@A@@BC@@@@@@C@@@ADA@@@@@@@A@A@ABA@@B@B@B@B@BA@A@@@@AC@@AC@@BC@ABADA@A@@@@@A@AAA@@A@A@@@ABAB@BA@AA@@AAAAA@@CA@@C@@BA@@BAB@B@B@F@B@@AB@@@BABA@AB@@AB@@@@AA@@ACAAA@A@@@C@A@ABC@ABA@A@@AAAC@@@A@@@@@@BAB@DBB@BA@@@BB@D@B@B@BBBBBBBDBB@DB@BB@@@B@AB@@@@ABCBEBG@A@AAECE@A@@BA@@BADAPADAB@BADC@@D@NBB@D@BB@@BA@@B@@A@@@C@@@A@@@@D@F@B@@@B@@A@@AAACA@AAA@A@@@A@ABAB@@A@@@AA@ABCBABAB@@AD@BAB@D@D@DBB@DDDBBBBBBBBD@BB@B@FAD@DBD@@B@@BB@B@BBB@B@B@B@@@B@@BB@@B@B@BADC@@DA@@@@B@BB@DBBB@@@B@B@BB@@DB@@B@BCBA@A@@@A@A@AAAEE@@@A@A@@B@DA@@@@B@@A@@@AA@@AAA@@A@CCA@@@AA@A@@BA@@BABA@@BA@@@@AA@@A@A@A@CBA@A@AAA@@AA@@A@A@AB@@A@@BA@@B@BBB@BBB@B@H@BB@@B@@B@@@B@@@B@B@@@BDDDBDBBBBB@BB@@B@@B@BADAB@FAB@@@D@B@B@@B@@B@@F@D@BA@@BA@C@@@@@@D@BBBBBBB@@DBDBB@B@@@DAD@BADCDALCBADABABA@@BCBA@ABC@I@C@@AA@AA@@@C@AAA@A@CAA@@@C@CBA@AB@@AAA@@@AAAA@@@AAC@AA@EA@@@@@A@ABABCBABA@@BA@A@@A@AA@@A@A@@BA@A@AB@DCD@@@@A@A@A@AAAA@@@A@A@@DCDCDAFI@AB@BCB@BAFAF@BADAB@B@B@BBB@@BB@@BBB@B@D@B@B@BA@ADA@@@BB@BD@BBB@DAD@FBF@B@@@B@B@@AHC@A@AAA@AAA@AA@@@EA@@A@@A@@BA@@HCDAB@B@@@@@B@@D@@@B@@BABABAA@@ACEAE@@@C@@@CB@@AB@B@@B@@BB@DBF@B@B@DBBB@@@B@B@DAB@BADABCB@@A@A@ACC@A@A@@@A@@BC@@@AI@KCEA@@@A@ABC@ABAA@A@@@A@@CA@@AABA@@@@B@@@B@B@@@B@B@BA@@@CBA@AA@@@@@A@@BA@@@@AAG@AC@@@@A@@DA@@C@@BA@@@A@@AB@@AB@@A@@BA@@AAA@C@@A@@AB@@@@A@@G@@BAB@B@D@@@@BBAB@@E@@BC@C@@@@@BAB@@@@AA@A@@@@@@@AA@B@@@@A@@@@B@@@@A@@@@@A@@A@@@@A@A@A@A@@@@@@B@@@@@@@B@@A@ABA@@A@@@@@@@@B@@A@@AAA@@A@@BC@@CC@@@@A@A@@@@@AA@ABA@@@A@@@@@A@BA@ACB@@@@AA@@@@@A@@@A@AB@C@@B@@AB@@A@@C@A@AE@A@AA@@@@BABBDAAA@@@A@@B@@@@B@@B@@A@BB@B@BA@@@@@AA@@@A@@A@@@AB@B@AAAA@@@@C@@@@AAAB@@AAA@AA@@A@@BA@@BAA@@AA@@@AA@@@@@C@@@A@@@@A@@@@BA@@IA@BCA@AB@@AA@EC@AAA@@@DC@@@EAGD@AABA@@@A@@@@@AA@@@@A@@B@@@@@@@BAA@@A@@@@B@@@B@B@@CBA@@@AA@@@@A@A@AAAA@@@@@A@AA@@@@@@BABA@@B@A@@AA@@@@@@@BA@AB@@A@@@@A@@@@A@AA@@@@A@AD@@CBA@@@@@@@@A@@@@@@C@C@@@A@@A@@@@A@@@@A@@B@@@@@@A@@@@@@@@@@A@@@@@CA@@A@@A@@@A@@@AA@C@A@@@A@@@@@@A@@@A@@@@C@A@@A@@@A@A@@@@B@@@@@@@@A@@@@B@@AA@@@AA@@AB@@CA@@AA@BG@@B@@@@A@@BA@@@@AAA@@@@B@@A@A@@@A@A@@@A@A@A@@CAA@@@A@BC@@A@@AA@@C@@AAA@@@@A@@@A@C@@@AB@A@@A@@@A@A@@B@BA@@@@@@@@A@A@@@@@@A@A@@@@A@@@A@@A@AA@@A@@BA@@@@A@@AA@A@AA@@@@AAGC@@CB@@@@@@@A@@A@AA@@@@@@@AB@@@@@A@@@A@@A@@@A@@@AB@AA@@@@A@@ACB@@ABA@A@A@E@@@AAABA@CA@@C@A@@@AA@@@A@A@A@@BA@@@@@A@@A@@@@C@@@@A@CA@@ACA@@@A@AA@A@@@@@A@@@@A@@A@@B@@A@@A@@@@@A@@B@AA@A@@A@@B@@@@A@@@A@@@@AB@@AAA@@AB@@@B@@@@@AA@@@A@@@@A@@@AA@@@@@A@@@@@AA@C@AA@@@@AA@@@AA@AB@@A@@AA@@@A@@@A@@@CC@@@A@@A@@A@@@A@E@A@@@@@@A@@@@CA@@@C@@ACAAAA@@A@@@@@A@@AA@AA@AA@@@A@@A@A@A@CAAAAAAA@AA@@@@BA@AA@A@@@@@A@@A@@A@@B@@@AA@BA@@@AAB@@AA@AA@@CAC@@@A@@@BA@@@@@AA@C@@@A@A@@A"],encodeOffsets:[[117073,33442]]}},{type:"Feature",id:"411729",properties:{name:"新蔡县",cp:[114.96547,32.744896],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@@@AA@A@@B@@A@AA@@@A@@@AA@AKFACCB@AAA@@A@@@AEE@@A@A@A@@@ABAB@@A@@@@@CB@@@@@B@@@@BJA@@A@@@D@@@DA@@BA@@BD@@BA@@BB@@@A@B@@B@@BB@@@AB@@@@@BB@B@@A@BDB@@@@@BBC@@BB@@DA@@BA@@B@@A@A@@@EB@@@@A@EBA@ABCB@@A@@@A@A@A@@@A@@BA@AA@@@@A@@A@A@@@@AAA@AAA@@@@BIB@BEBB@A@BDABBBA@@@A@@BC@BDC@@@ABB@CBAAAB@@@J@B@@@@BB@BB@AB@F@B@BAB@B@@ABAB@BA@@BAB@BA@AAA@@@@@@BC@C@C@A@@@A@@A@AA@C@A@@@@@@A@@@@@ABA@@A@@@A@CBA@@AE@C@A@CCBA@AA@AA@@AC@@EBA@@@@@A@CBCDA@CDA@@BEFCDA@AB@@@DCB@BEBB@EB@BC@@BA@@B@@A@@CC@@B@AC@BD@BC@ABC@ACC@IBA@CHA@@@A@@BA@@@A@CB@B@@C@@@A@A@AAGB@@AC@@A@GDMFA@ABA@G@MBM@@@E@SBA@IEUIEA@A@AA@@AA@@@@@C@@BCA@AAAA@@@@A@@@@A@@AC@@@@@A@AAC@@DA@@AA@@BC@@DAAA@@DE@@DA@@DU@@@AB@@A@A@@CC@AB@@@@@A@@@@@@@@A@@C@@@@AB@@ABCB@BAB@@A@@B@@B@@B@@@@@@A@@@C@@@@@A@AB@@@@BB@@@@@@@@AB@@@@A@A@@@@@@B@@DB@@B@@BA@@@A@@@@B@@@B@@AAA@@BB@BB@B@@@@ABABA@A@C@I@E@G@A@A@@BA@@BAD@BAD@@@@A@@@CEA@@@A@@@@@AH@@C@@@C@A@GCAA@@AA@@@A@ADABA@@@AA@AACCAAA@AA@@@A@@BE@A@@@ACAEA@A@A@@@A@@B@D@D@BAB@BA@A@@@AAAAA@@ECGCCAAAA@@@A@@BA@@B@BABBF@B@BA@@BABABC@ABA@@A@@@A@ABA@CBA@A@C@@AA@A@@A@KC@@A@@@A@@DAB@@@@A@@@AAACA@@@EAAAC@I@C@GAEAA@C@A@ABA@A@@BAB@@@B@@@BB@@BB@F@D@B@@@BBB@@B@@@BABA@ADAB@BA@CAEACAC@CAA@A@AAECCAAAA@A@A@C@A@ABA@A@@B@DABABABABA@@BA@A@E@KAC@C@AB@@AB@@BBBFBB@@@B@B@@A@@BA@AACAAACCAAA@AAA@A@EBABA@AB@@B@@BFBBBBBFFDF@B@BCBA@@BAAA@ACAAC@AAAACAACAAA@CAC@A@@BA@@@@BBF@D@BBB@BDDBB@@@BAB@B@BB@@@@BD@BAB@BBB@DB@@DDFD@@BB@@@BA@@BABA@A@C@C@A@ABCFAD@@CBABA@A@A@A@@AA@@A@@BABAB@@ABA@A@A@@AAC@@@A@AAEGA@@AC@C@A@AAC@@ACC@AAAAA@@AAA@ABA@A@AB@B@B@BBB@@BB@@D@D@B@B@BB@@BB@@@B@BCFA@ADA@A@A@@@@AAA@A@CA@@AA@CCA@CAC@C@ABKDCBABA@AB@@@BDB@B@@@B@@@BA@A@C@A@ABA@@DABAB@@A@A@CAC@C@C@C@A@A@ABAFABABCDA@@B@B@@BDBB@B@BA@@@ED@@ABADADABADA@@B@B@@@B@@B@B@BAB@B@BB@@@B@@CDAB@@@DA@@B@B@BB@@@B@D@@B@@@@@B@@ABAB@B@@BBBB@@B@@@BB@@BAD@B@@B@@C@@@@BF@@@@DD@@@B@@BB@B@@D@@A@@@B@AB@@IDBF@@@@@F@@@@D@@@BF@@B@@@B@BA@B@D@B@@@D@@@@@@A@@BABABBNH@@@BB@BHCBAD@@A@B@BBF@@@@@@BF@@B@@B@BB@BDD@@BB@@BBB@@@C@@B@@F@@B@@BDA@AP@BB@@B@BB@@ABDHBDA@BF@@@@@B@@EB@A@@C@@@BDMB@B@@A@@@A@@@DHLA@@@BF@AA@@FA@@AAB@@B@@B@AAB@@BB@@A@@DA@B@EHAAAD@@@B@@AB@@@@A@@B@@@@@BFA@BF@@A@BBA@@DBJB@@B@@@D@@@@@@@@AB@AA@@@@@CB@AC@@@@BABBBGB@B@@@BAB@CA@BL@BXEBD@A@BBFB@@JA@@B@@@@F@BFD@@DBF@@@DA@@BA@@DC@@F@@@DB@@BB@BHBPB@@D@@@D@F@B@D@@@B@@@B@BB@@B@@@@@BBB@@B@@@DD@@DABBB@BBB@@@B@@@@AB@HAHCFAFAB@BADAB@DAB@B@BBB@HDB@DDB@@BHFBBHHDBBBDBBBFBLF@@@@@@AD@D@@AH@F@B@B@D@B@BB@BF@B@@@BAF@B@@@@DFBB@B@B@@B@P@@CRAJ@@@BD@BD@@B@BH@@AF@PA@FDA@BB@@BA@BD@BBD@@@BDHBF@@@@BBB@B@@@DABHB@@@B@B@@B@@BD@DB@@B@@H@@B@@J@@@@@J@@@@BF@@A@C@@@@BA@AD@@@J@LB@@@HA@@BB@@FGAADI@@@@@@@A@CA@@@HAB@B@BH@@BF@@@@@@D@@H@@BFB@DF@@B@B@@@DDB@ABAB@@BFB@A@AFBB@@B@BJJDCHAJCL@N@F@B@B@BCDGBCDEDCB@DAHADC@@FCHSDIDEDAF@F@JFBBFD@@D@H@NBL@NBDAH@L@J@NALE@@HAVIFABABC@ADCBABC@C@AAAAA@@OC@@A@AAAAAA@A@ABABAFATAN@F@HALAB@F@@@HAB@NBP@D@B@BADAHEFEFADAB@@@B@FBBBHDHDD@F@H@JAJ@HAF@B@DCBABA@AACAE@A@C@ADADCJAB@DCBA@@BEAG@GBA@AHELCBAF@NEH@B@HBLBBAF@DADCDGBGBE@ACAKBIA@@AAAEBEBADCDCDA@@@CACEGCK@C@EBABAFGBABAHCLEDC@AHI@@@A@AACC@E@I@GBGDEDA@A@A@EACAAA@A@AAG@@BE@@B@@CFABCDABC@E@EAAACCMAACMAICAAA@A@GAG@@BE@EBADABABA@C@A@CAICE@AACAC@E@ABEDCBA@AAC@EAAAAACA@@A@A@@DAD@B@B@@CBCAA@I@EAC@A@@BAB@@@B@@BB@B@BB@@F@B@BBDB@BBB@B@@ADABEDCDCD@BBBBBDAD@HEHEDCDC@E@C@A@A@C@@@A@C@C@ACE@A@@@A@@B@D@B@DBB@BB@B@D@BB@B@B@D@B@D@@@@A@@@AAEAAAAAAA@AAC@CAA@@@@A@C@C@ABA@A@AB@B@F@@@BA@@AAA@CAAAAAA@@@CBCBAB@@AB@BBD@B@B@@ABC@A@CAA@@@AAAC@@@A@A@C@C@A@C@A@@@A@AB@B@BBBBBB@@B@BABA@A@EBCAAACAAAC@A@@B@BADAHEDA@A@AAAA@CAA@@CA@@AAB@BC@A@C@AACAAA@@@@A@@@@@A@@A@A@@@ADAHEBA@A@C@@@A@@AA@@AAA@AAA@BBAD@B@BABCDA@@@CDAB@@@@@B@B@@@@C@A@@B@@@BA@A@@@@BAB@@@@@BA@E@@BEA@@AAA@@B@@CA@@C@@AA@@AB@@AD@AA@CB@@AA@@EBBAEB@AA@@@@AA@@@@ABA@@BA@AB@@AA@@A@@@A@ABA@@BAB@@AB@@AC@@AA@@@B@@A@AA"],encodeOffsets:[[117918,33379]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
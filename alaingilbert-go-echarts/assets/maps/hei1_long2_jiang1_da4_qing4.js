@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("大庆",{type:"FeatureCollection",features:[{type:"Feature",id:"230602",properties:{name:"萨尔图区",cp:[125.135591,46.629092],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCIG@@A@C@KBA@GBACA@CCAACA@AA@AACCA@@AGFABCBCB@@@BA@oIE@AAA@A@A@@@OEA@C@CBE@C@KBKAC@A@EAIHWBJJ@@FD@@BBDF@@@@cFABYD@@@@GBA@MDOB@@C@CBIHA@A@G@E@MAA@C@@@@@@BBJ@B@B@@@F@@@D@D@BAF@@@F@@@B@@@B@@@@AB@B@BDJCDABEBBBAB@@JJ@@@B@@A@KFCBIDA@CBFDDDAHAF@@@B@@@@ABDL@@BNPLB@@BB@BBE@SDCBMBA@KBABA@EBC@A@JDJBDBDBFBFBLDJBFDHBFBHBFBDBB@DBFBBBD@B@@B@@D@BBFBFDFBDBDBFBBBDBB@DBFBFDFDFBFBBBFBFBBBBBB@B@BB@@BBB@BBB@@@BBD@@BB@B@DDD@BBB@BB@@@@FDFBBD@D@DBJAJ@B@B@D@BABBL@J@JBL@J@J@JBH@J@J@H@BDFBJBBLFDBBB@H@B@D@D@H@B@@@B@L@@QDYC@@A@@AC@A@CAE@EAGAE@EA@@A@EA@@@BA@@D@@@DAB@B@B@B@BAB@@@B@D@@AB@B@B@B@@AB@@@B@B@@@BAD@@@BB@F@DBF@F@B@FBF@DBF@FBD@B@B@@@B@@@`DvF@@NBRDD@B@BBD@R@F@H@B@FWr@D@B@BA@BB@D@B@@@B@@@@@B@B@@@H@JBN@FBF@LBXBJ@B@B@AAACCA@CCCAAACACAAACAAAC@AAA@@@AACAC@A@A@C@@AA@@R@F@JAPARKDA@I@E@QCECA@@@ACCCEBAJCHCBAB@DADAB@@ACA@@@AG@CBGBOFEBGGEEEGAAECCA@ACCEGCCAAAACAACAAAAEEA@CCDABADCHCFCFDDC@@DADEDAB@DDNAD@F@BABA@ABG@A@E@A@AB@@@B@@ABA@@@@BA@ADA@AFGDAD@PFJAJA@C@A@C@A@KBEDE@A@@GAGCEAEAGAECGAEAEAGCEAGAGACAGCGAGAEAECA@BADCHELIHEDCBA@@@@DABCJGHEDCC@E@C@@@C@OAM@OAI@EECGAA@A@ACE@C@C@A@KAC@ABGEEGI@@AA@ACC@@@@@AAA@A@A@A@A@A@@@@@@@@@C@@@A@A@E@@@C@G@CEE@@EGIF@@@@A@@B@@A@@@@@A@@@@@AA@@@@A@@A@@@@A@@A@@@@@@A@@@@A@@A@@@B@@A@@B@@@BADA@@B@@@@AB@@@@@B@@A@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@A@@@@@@@@AA@@@@@@@@@@@A@@@@@@@@@A@@@@@@A@@AA"],encodeOffsets:[[128115,47693]]}},{type:"Feature",id:"230603",properties:{name:"龙凤区",cp:[125.135326,46.562247],childNum:1},geometry:{type:"Polygon",coordinates:["@@DECAIAAAKCUEIBBCNALA@C@@@@ACF@J@B@@AB@F@D@DCB@AGAA@A@@LAL@DAFELG@EBGBG@C@I@EEEGGCE@@S@W@C@KBE@C@G@EBI@G@IBI@@F@H@B@B@D@F@H@BGBMBOBQDMBE@CDCDIJADGHGHBBJDFDNFHDDBDBFDDBBBBDDFBFBBDHDFDHDFDHBFBDDHBB@BBFBD@DBDBDBHBHBF@DBF@BBDNBLBH@BBNFJDJD@B@BCJAJAHAHJFBBNHDDBB@BBD@BGBEAE@@BAD@@D@@@@B@@@@A@@@@B@@@@@B@@@B@@AB@@@@@BA@@BBAB@B@@DLBLAD@F@DAD@B@PF@@B@B@B@BBF@pJB@@A@@DADABAHE@BB@DDBBB@@BDBBBDDB@BDHAB@LAD@B@@@JHDDBB@@@B@@@@B@@@@@@@@@B@@@@@@@@@@@B@@B@@@@@@B@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@BA@@@@@A@@B@@A@@@CBAB@@A@@@@BA@@@B@@@@B@@B@@@@@@@@BB@@@@@@BB@@@@@BB@@@@B@@@@@B@@@@AB@@@@@JEFH@@FF@D@H@D@@@F@B@B@@@D@@@@@@@@@B@B@B@B@BBB@B@@@@DD@BBB@@HJFFAH@BBD@L@B@D@DDF@B@BBBDHFFJ@PBN@PBD@@@D@F@D@CDGFIHADCB@@@@ABCDGFKJGFCDABB@FDFBHBHBHDDBHBHBFBHDFBFBHBFDHBFBFBHDHBDAB@B@D@B@D@FAJA@@@@BADAB@BABAB@@@BADA@AB@BABA@@@@BABA@@@@BA@@@@BAB@@@B@DA@@D@@@BA@@@@BA@@DCB@BAB@BADABAB@BABALGFC@CBA@A@EBC@AA@@ACA@AAA@A@A@@AA@@@AACA@AAA@OF@AGCCAICEDKECDCDGBA@CA@@C@C@A@A@GAEAA@@A@@E@A@A@@@ABABA@@DA@@B@B@B@B@@@BA@ABA@CAC@A@A@A@E@C@CAA@EAGEA@@AA@ECNOPSHELEDCLGRQBAJKFEHCB@XIDEBA@@@@A@@A@CBCAA@ACCCACACAAAE@AAAA@ABABABCB@@@D@@@BABA@ABA@CBEBA@C@@B@@AB@BGBG@@BCDEBCDADC@@@AKQ@AAAAAA@AAOE@@JEHEPCD@BABALQ@@A@AAC@CAA@A@@@OCCAKAECKIGJABMRILGLCDINGJGAKEGCMCEAMEMEEAAAFCHEJGHCJGHE@@B@@@B@LIJEJGHCNFJDFBPCJCB@FBDBDBF@FBD@B@BADCDABABA@AB@@A@@B@@A@@BABAB@B@@AB@BABADA@AB@@A@@@A@@@AAA@@@A@@BAB@BAB@@A@@@AAA@AA@KA@@AB@@ABA@A@@BA@@@@@AAABIFKHCCJELGECGDMJIEHEJEDCCAIGAA@@HENGLGDC@@DCDADABADAFCAA@@AA@ACE@@A@A@@@@@@A@@AA@@A@AAA@@@AA@@@@@@AAAAECA@ECIGECGGCDGFGFAB@@BBBBB@@B@@EBCDCBC@EBC@A@A@CAGAKAEAIAEACAODKDIBC@ABADADGAEAAF@HAFAH@BADAFI@M@O@WBDBDBHDDBJFHDFBHFJDDBJFJFDBJFJDBBOJ@@C@E@K@E@IAI@I@E@O@K@GAK@K@M@E@E@GAE@KHKFCBEDA@EAGAG@CAAAGGCEEEAAEEBGDICCEIEEGIEGMBE@GAG@ABABABEA@AA@E@HG"],encodeOffsets:[[128014,47557]]}},{type:"Feature",id:"230605",properties:{name:"红岗区",cp:[124.891039,46.398418],childNum:1},geometry:{type:"Polygon",coordinates:["@@KLKRORQDODKAUCQAQCE@OASCQAOCKAG@KEQGQGOEQCOEBCBEJSFKDI@A@ASAIAC@A@EAGA@@@@@@A@IAICGAG@KCIAMC@GAKG@E@AEGAE@OBKBGBMBI@KBIBC@ADADCDEFBBBB@@@BFFDFFHFHBBDBD@J@JBH@BBB@DFDB@@CF@BABNFFBHBDB@H@HADDDDHFLBBHLHAFAFA@D@@@F@@F@DAF@B@HABFDHDFBFBDBBABMDABAHADCF@BB@F@DAF@F@B@BADABCD@PCJCABEHEHEJEHABCDABBD@DBBBBDFBDDDBDBDBDBBBDDFBD@@BDBD@BDDBFDFDF@B@@BD@@BB@BBDBBBDBD@@DFFFDDBDJLLLHJDDBBABABGJEDGHGHBDKBOFQL@@CBEBGDABEDKDABCB@@@DBF@FBF@DLDJBFBFBFBTFNFVFTFTHBFDLBFBDDNDPFFFHJNDDLNDFHHHJLNJHBD@@@ABAFEJIBABAJA@@D@BDDHDFBD@@DF@@AB@@@B@B@F@D@DDDDFDAF@DDDHDFBDBB@BBFBD@BRCB@@@@@@@@@XE@L@N@H@H@H@F@B@HBH@B@F@D@BBF@HBHADD@B@NBF@H@B@B@JGDAD@@@PANCB@HA@@@@ZCBAdE@@@@CEAA@@EC@@IIXAJGFBB@D@@CA@A@AB@AB@@A@@@@BA@@@A@@@A@@@@@A@@B@@@@@@A@@C@@@BC@AF@FBHA@AAC@AAACCMGAAIEBGBGBIDI@A@AICICMEAAG@KAMAAC@AAE@CAEAGAGACAC@CACAE@AAACGACAECGCECGCECGAAAECEACAACAECCACAGCMEECICAAHGHGBCJIDCDCF@NARCPANAHA@A@G@E@C@A@A@G@EJ@JAH@J@FAH@D@F@LAD@X@T@@@EIDEFGFIBGBIBE@AKFGBAB@E@EE@@AKAK@A@DC@A@@BA@@B@@A@@@@@AB@@A@AB@@A@@B@D@D@B@@@DBB@F@@@F@@@H@H@J@BBF@D@B@F@H@@@B@@@@A@A@@@@@A@C@C@A@C@A@CAA@A@A@C@A@C@A@C@A@A@C@A@C@A@A@C@A@C@A@A@C@A@C@A@A@CAA@C@A@C@A@C@A@A@@@@BA@C@ABA@CBA@A@AB@@@@@H@F@@AE@E@A@A@A@C@EAC@C@A@@@BCDG@E@@E@CAC@E@CAC@C@@@E@CAC@C@E@C@CA@@C@A@A@E@CAC@A@A@A@@@E@KAUAE@GECAECECA@AAEACAAACCBOB@@AFMBGCAMASE[CUC@@"],encodeOffsets:[[127925,47319]]}},{type:"Feature",id:"230604",properties:{name:"让胡路区",cp:[124.870596,46.652357],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@@@@AA@C@AAEACAA@CAEAGAEAGAECIAKCEAEACACAIAICB@D@FAB@BALAB@NADATCF@AAA@@AA@OKAM@@CKBA@@@@@A@@BEBGCCECDAB@JCDALEB@@@@A@@II@@BAAAFABADCCI@A@ABA@@@@@A@@@A@@@E@@BE@A@C@C@@@E@@@A@AAI@A@@@@BCAG@GAE@A@C@E@AAG@G@A@E@G@G@G@M@KWF@@@@@@@@A@QD@AACAE@AAAACCECGCCE@CBCECC@C@C@E@A@A@@BA@@CE@@ACCECGACC@@@IBABABIJEFAB@B@@ACIGKMGIGGCEKMCCIMEGEECOCMACAECKAESGSEUEMESEEAEAEAIAKC@BBB@BCBGDGDGBEDGBEDEBGDGBGDEBGDGBGDEBGDEDGBEDGBGDGBEDE@IDE@EBA@BB@@AB@B@D@DAD@B@B@@@@A@A@@@AB@BAD@DADAD@BADAF@B@BAB@@@@@BADAF@B@@AF@B@BA@@B@FAB@F@D@B@B@B@@@@@B@F@FBHBF@BBFDF@@DHBBBD@BBB@BDHBFDF@B@BBBFFDHDFFFBFDDBB@@BBD@FBB@JBJBJBHBB@DBD@B@D@B@FBJ@JBB@D@D@CHAD@BCFAFAHAFCFAFAFCFAD@DABABEDGHGFGFEFABA@GDEDIDIDCBEDGDGDGDIDIDGFEBCBABEFEDCDCDCFCBCDCDABADCDABBB@DBD@@BD@BBB@BBD@BBFBDBDBD@DBDBFBD@BBB@B@BBDBD@DBDBD@@BD@BB@D@D@D@@@@BBD@D@F@D@DBD@D@F@@@F@FBH@H@HBH@J@FBD@D@F@D@D@D@DBD@B@@DB@BDDDDDDBBBBDDBBBB@@BBBB@BB@BDB@DD@@DFB@@BB@BBBBBBBBBBBBBB@BBBB@@BBBB@BBBBBBDD@@BBB@DBBBDB@@B@DBBBDBB@BBB@@@DB@@B@B@B@FBJ@D@F@JBD@F@DBD@B@A@CFEHEJEHEJEJEHGLEHADCDAD@BA@CDCBCDCBCBKJKJMJKHIJCBEDCDCDC@IFQFQHQHSHQHMFGDGB@B@@B@LFFDFDFBDDFBFDFBFDDBBBDBFBFDFBDDB@FBBBDBDBFDDBBBFBDBFDFBBBDBBBDBDBFBFDB@FBB@@BB@B@FBF@FBD@BBF@B@BBFBD@BBB@B@@@@@B@B@BBB@B@BB@@B@@@B@@@B@@BB@@@B@@@B@B@@BB@B@@@B@BBDBF@BB@A@ABA@A@@BA@@FBB@FBFBFBB@B@@@BBA@@B@@AB@B@BA@@B@@@B@@@@A@@@B@FBD@D@DC@ADBB@BBDBFFHDDD@@B@@@@@FDCB@BAB@@DBFBDBD@@BD@BBB@B@BBD@BB@@@@@A@@B@@A@@@@B@@@@@D@D@DBB@DBB@FBBBDCFCHGHGJGFGFCBAHGA@KCKCGCICA@A@@AA@A@AAA@A@AAA@A@@@@@A@A@@@A@A@@@A@@AA@A@E@A@@@AAA@AAA@AA@@A@@@CAAAA@CACAEACCCAEAEACAECCACAEA@A@@BABABABCDABCBABABCBADABCBCDADEDABCBABADCBABCBADCB@@AB@BABABCDABABADCBADABABCB@BABCDABADCB@BADA@ABAB@BABABAB@BABAB@@AB@BADCBADADCB@AAAA@AAAAAACAACCAAACAAAA@AAAAA@AA@DCA@AAAA@@AA@AAA@C@G@AAC@E@@@AA@C@A@C@C@CAC@E@C@AAA@C@C@C@@@A@GAI@MAGAK@A@@@CG@CBIBIDK@CH@NBPBF@D@B@@@BBB@B@B@B@B@B@B@BBB@B@B@B@B@@@B@D@B@B@@@B@BBB@B@B@B@D@B@B@@@D@B@B@B@B@F@B@D@B@@@D@D@B@B@B@B@B@D@@@B@@B@@B@@@B@B@D@D@B@F@B@B@@@@@B@@@@@B@B@@@B@D@B@@@@@@@@@B@@@D@FBF@B@B@D@D@F@B@F@F@B@B@D@D@D@F@D@D@B@H@HBB@F@@@BA@A@C@ABC@A@C@ABA@A@@@@@@@@@A@C@A@@@ABA@A@A@A@C@ADBB@B@B@B@B@D@BBB@B@D@D@BBF@B@D@B@DBD@B@B@D@BBB@D@B@B@B@DBD@B@B@D@B@BBB@D@B@B@B@B@BB@@B@D@D@B@BBB@B@B@B@D@BBD@B@B@D@B@B@BBB@B@B@B@D@D@B@BBB@B@B@@@B@BBB@D@B@@@@@FBB@@@FBF@HBFBF@DBB@D@@BB@@@ZDRC@@@K@A@@@A@G@C@C@A@GAACAKEAAAICE@A@G@I@IAG@I@I@IAK@I@IAKBA@A@C@A@ABIAI@C@CACEAEC@@@@AAA@AAC@CCA@A@@AC@AA@@A@AAA@AA@@AAA@A@AAAAEAEAAAEAEAECECEACAA@CAAAEACACAEAECEAAA"],encodeOffsets:[[127943,47819]]}},{type:"Feature",id:"230606",properties:{name:"大同区",cp:[124.812364,46.039827],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CAE@EAE@C@@DABALCFCBAHCFADA@@RKPELAACHGHGFCHIBABAAACCGIKKIKACCCEECE@@ACACAAAC@AAA@@AC@@@ACECEAECC@AACAC@@ACCEACAAACACACCCACCEAAAA@CACBADCBAFGFIFGFGBAIDODC@ADCBABA@E@E@CBE@A@@ADEBCBGBANCBAAAACAECECGAEGBA@E@CBE@@@@E@@@CEBEBGBGKAAEKCGCCBC@G@GCAGAEAMEBA@ADE@@CACEA@AAG@IAI@C@CAAAEGEGCEEE@A@@AAAAFEDCBCBCD@JALAJ@NAHALAPAF@HBBFF@H@BL@HNDJBLDH@HBJDJBB@@@@@@@HBFBB@D@JBTB@B@BCJELITAFADPFRDPFRHRHLFH@LBPDRBTDPBF@RDRBVDLBPCRCPQLQLKE@KACGAC@ACK@ACIAI@I@CAGAG@A@A@ABA@ABCBC@ABE@A@ABABEDG@ABEAADCDC@@B@BCBAFIBADGBA@EBIBE@C@C@@@AA@@A@@@@QIBABE@G@AEADKA@C@GAOAC@@A@ABC@ABG@@A@ACA@A@AAA@CAA@AAC@GASCMADABADADAB@BAB@@@@A@@FCVMLGD@HDB@NFTFAFTFTFVHD@J@PBJBL@JBL@FBDAF@B@@BLDNFFBPDF@LBPBH@J@J@H@FA@@DGHIBCHKE@KCA@AACC@EBEAC@IB@H@RDRDPDLB@@DEDEDEFECCEEAA@@IEKEGCHEDAFADABA@E@E@C@A@ABCDIBE@C@@B@@A@ABA@CBA@A@@@ABA@A@@@AB@@A@ABA@A@@BABA@@AE@C@A@A@ACQ@A@A@A@CAA@A@C@A@C@ABA@A@AB@@A@A@A@ABC@A@@@A@AAAA@BAB@@ABC@A@A@A@@@A@@BA@A@@@@BA@@@A@@@A@A@ABA@A@@BA@A@@@ABC@A@@@ABA@A@@A@A@IAK@BAE@BC@EC@@CBEBK@@A@A@A@@@C@AF@FAHA@A@@@@A@@@ABEC@ADA@@B@@ABA@@BAB@B@BC@@@C@@@@@@B@@AD@BA@@@@A@A@A@@BAA@A@@@@D@BA@@DC@@@A@CAA@A@A@ALABAAA@G@AAA@@@A@A@@@A@C@@@ACEBA@@AA@AAAAAAAA@AA@@AACEEACAA@AG@C@A@A@C@A@C@C@A@A@A@A@A@C@@@AAC@A@A@C@C@A@A@EAA@A@C@CAC@K@@AB@@CBAA@A@A@@@BCBG@ABA@A@@GAA@C@A@IAE@C@CAA@ABCBCDABA@I@AABA@A@A@@A@@@AD@BA@@@BA@C@@@@A@A@@@@@AD@B@@GAA@A@C@@@@@@@AB@DIAEAC@AAA@A@AB@D@@AD@DA@@B@@A@A@A@ABAD@@@@@C@@A@@BC@@B@DI@C@A@EDCBIFKFEDKFKHGGGEEEA@K@G@A@E@C@@A@@ACAA@@ACACAA@@AECC@AAA@A@@A@@AAA@@CCC@GCECEACAAAAAA@@C@CAE@@@AC@EBE@C@@A@C@E@C@C@E@E@K@G@@@G@GAG@@BC@@@A@@@@@A@@CWME@CCGCE@A@AECKKECIICA@@AA@IAAQGOISIA@A@AAA@@@EBEDEBCBEDEDIDEBEDCBGDCBEDEBCBEDID@@E@G@KAQ@A@GH@@KNIJQTEDAB@@A@@@AB@@@BB@B@@@AB@BADA@AB@@@@BB@BB@BBB@@@B@@B@@A@AB@BA@@B@@@B@@@BAD@B@B@@ABA@E@I@E@E@E@A@C@A@E@@@@A@A@@EAIAAAGCICCAA@@@@@A@@@@@@AAAA@CCECGEGEAA@@AEEEAAA@C@@@@B@@@BCDAB@@@@@BAFAFA@C@A@C@A@A@A@CAA@@@A@A@AAA@C@@@A@@@A@@@AAA@A@@AA@A@@B@@A@ABABAB@@@@@@MCEA[LQJ@@MHA@ABCB@@@DAB@@ADADADAD@DABAB@DAD@@ADAFCFCHAHABBBD@HDHBD@FBDBB@@@@@@@B@@@DBD@DBDBB@DBB@DBF@BBD@DBD@BBB@DBB@DB@@DB@BDBBBBBDBBBDDBBDDB@BDBBB@@B@DBD@DiNA@KBOBKBG@GBA@@@A@A@@@CB@@A@A@A@A@A@C@CBG@ABC@C@C@CBC@A@C@A@A@CBA@E@EBA@E@EBA@C@C@A@CBC@A@C@A@ABA@A@C@C@CBE@A@@@EBA@A@C@@@C@CBA@C@A@CBC@A@A@@@A@C@ABA@A@A@A@@@A@C@@BA@C@C@C@ABC@A@E@ABA@A@A@A@A@A@@@@@@B@BBH@DBD@D@DB@AB@B@B@B@BAD@D@B@DA@@B@B@@@@ABGBEDMFGDKFEBIDAB@@@@@BCD@BADAFADCFADAD@BA@@@AFCFAFADCFAFCFADCHADB@FBLDFBB@D@D@J@B@D@DAD@H@D@F@LAF@F@B@B@B@FBD@JBJDJBHBF@FBDBB@BBDCDEDA@AF@D@B@B@BB@@BB@BBD@BBB@BBB@BB@BBBB@BDDFHDFFHFFDFBBFFDDBDDDBBDFB@@F@F@F@BADAB@@AB@@A@@@@B@@@B@@BB@DAF@FAD@D@@KFKFIFIDIFCFC@KDKDKDKDIBC@IDKBKDKBGBIBCBIBA@@@ABE@ABA@ABABCDABABCBABAB@@@BA@ABCDABCDCDA@ABABABC@EBGBCBE@IBEBEBGBGBIBEBA@G@GBG@EBA@@BCFCD@@CBA@AAA@C@A@A@A@@@A@AAA@AA@@A@A@AA@@A@A@AAA@A@CBABABA@@BABAB@@@B@@BB@@@BABA@A@@@A@@B@@@@@@@@@@@D@@ABA@@@@B@@AB@B@DCF@B@B@B@BAB@B@@@@@BBB@@B@BBB@BB@B@B@@@B@B@D@@@BFDDFBDDBBDBBBBB@FBHBBBD@DBB@@B@@B@@@@@FBJDJDB@BBDBJFFDFDB@FEFCBAJEHGHCFEH@NBP@L@P@NBN@P@P@PBN@N@@@B@HDJBJDB@F@LBHBHBJBB@@@BD@BBBFFBBBBHHBBJHHJDDF@FAL@DAP@FAF@J@F@FAD@D@D@H@DBJ@@@B@BBD@D@D@BBF@B@D@B@FB@@F@FBF@FBF@B@D@B@@DBBBDBDBDBDBDBB@DBDBDBBBDBDBDBDBBBD@DBDBBBDBDBDBDBDBDBBBD@@AD@D@D@D@D@D@B@D@F@@@@@B@D@B@FAH@F@B@D@F@F@H@F@F@H@F@F@D@D@B@@BBHHFFFFHHFFFFFDBBHHHFFFHFHHFFHFHFFFHHHFHFHHBB@@B@FAF@JCF@FCHAHCHAFCHAFCHCFAHCHAHCFAHCHAHCFAFCHAFCHAHCHCDA@AAA@A"],encodeOffsets:[[127733,47521]]}},{type:"Feature",id:"230621",properties:{name:"肇州县",cp:[125.268643,45.699066],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@AA@AAAA@AAA@CAA@A@BA@ABABBBBDBB@DB@@B@B@@A@@@@@@A@@AA@@A@@EC@@BADGC@ABEABABADA@ACACAFCB@CCAACBABE@@AB@FABA@@@@@ABAB@B@BAB@B@BAB@BAA@@A@@B@HCACABGB@@@A@A@@A@C@A@CAC@@@@@@@@@@A@@@A@@@@@A@@@@@@@A@@@@@A@@@A@@@@@@@A@A@A@@@@@AA@@@@A@@@@@@A@A@CBACAC@@@A@@AA@AAA@@@@CAECA@G@A@A@EBC@A@C@E@C@CBC@A@C@C@C@ABA@A@A@A@@@CBCBEDCBCBCB@@CBCBCBCBCBCBCDCBCBABCBCBEBCBCBCBCBCDCBABCBCBA@ABCBCBA@C@A@A@C@A@AAA@A@A@C@A@A@C@@AA@A@A@A@A@C@A@C@AAA@C@A@C@A@C@A@A@A@AAA@A@C@A@C@A@A@A@C@A@A@AAA@A@A@A@A@A@@@A@A@A@@@A@C@AAA@A@C@A@A@A@A@@@A@A@ADCBABCDABCBABCBADA@@@C@A@C@C@A@C@C@A@C@A@C@A@C@A@C@A@A@C@A@A@C@A@@@C@A@C@A@C@A@C@A@CBA@C@A@C@A@C@@@A@C@A@C@A@A@A@ABA@A@A@@@A@@@A@@@E@AA@AAC@CACAC@CACAC@@@@A@A@G@EAC@@@C@CBCBC@A@@BCBABABABAB@@@@AB@B@B@@@@A@A@EBA@CBA@C@CBCBC@@@EBG@C@ABC@C@C@@@EDABA@C@@@@AA@@A@@BA@@@@@AAAI@ABK@E@IBA@A@EDABAC@AEBC@ABC@EBGBEBG@GBCBC@EBGBGBC@EBC@ABBB@BA@IFGDEDCBCBABD@FDC@CAC@ABEDEFABCBCDCBADABA@AB@D@B@BBB@@@B@B@@AD@@@B@@AF@DADI@A@C@K@@BC@@DLBGFAB@@@B@@A@CJO@@DA@E@KAC@G@@DC@@CAAOCBAA@DE@AAAAAG@G@C@@@A@@@A@E@CAAB@@@AAAA@@@AAA@A@AAA@C@CAE@CAA@CAA@A@@@C@ABC@CB@B@B@BAB@B@B@B@BAB@B@D@B@@@BABAB@@A@A@@B@@A@@B@@@BB@@@BBBB@BBDBDBD@DB@@P@@ADALAJMJA@A@A@AAGCA@ABA@QAA@@DAJAD@BAB@B@@@BAF@B@B@@AB@BAD@@CF@DAD@BAB@@A@A@C@A@AAA@AAA@AA@@A@AC@@@AB@@A@A@A@A@@A@C@@@A@C@A@A@A@A@AB@@@BA@@@@BA@@BA@A@@BA@@@ABA@AB@@A@@BA@@@AB@@A@@BB@B@@BB@BBB@DDFD@@@@@B@@CDA@CAABAAC@CAC@C@CB@@C@@@@@ADAB@B@@AB@@@@B@@@B@DBD@AB@DADA@IAC@BI@@A@A@AHAB@@I@@@BIBA@@BE@@ABAD@@@CCA@@A@@DABADAF@@@BAB@@@@@@@@@B@BAD@D@B@D@BA@A@A@C@A@@AEAABA@C@A@@@A@ABICABED@BA@@@ABA@@BA@CBADADA@@BCD@DG@S@_@@BDFDH@DNFDX@@@B@@@@@B@@AD@@BH@H@H@@@H@L@F@F@D@D@F@D@BD@F@FAD@@B@@BF@D@DB@BBBBDBFBFDHDD@DD@@BB@BB@@@@BBB@BDDBF@@BBBDBD@@BBBD@@@BD@F@B@H@L@B@FFHFHHLGLEFCLEJEDAFCB@D@J@@C@AD@@AB@@@@D@@@@BCBAB@B@B@@@@AB@@CBC@@@CBAB@B@BBD@FBJB@CBA@@@@@@D@B@B@HB@@@ABC@@@@B@B@@@@@@DAB@@B@@ABC@@B@@@@B@BABBBJ@B@BADCDABAB@DBD@F@JBB@D@B@HB@@@BAB@BAHAD@@B@B@B@AB@DA@@BL@D@DBD@B@B@FBB@B@D@D@B@B@D@BB@@D@B@B@B@B@B@D@D@B@D@B@B@D@H@@BBBBDFFBD@BB@@BBBBBBBBBB@@BB@FABD@@D@B@@@B@B@@@B@BBH@B@BBBABKB@B@B@DBB@@@D@@CB@@A@C@@B@B@AB@@@B@B@B@@B@@ABC@@@A@@@@D@@@D@@A@ABA@AB@BA@@@AB@BCD@AF@B@@@B@@B@B@BG@EBED@@@B@B@B@@@ALAF@DD@@FADF@ABL@JBB@B@@@@BAB@B@@@BAD@B@@@BAB@@@BAB@B@B@B@@@B@@AB@@@@@BAB@@@B@@@B@B@BAD@BA@ABB@BB@B@B@@@BAD@B@B@B@BA@@B@BAB@B@D@B@D@BBB@D@B@B@BDR@B@B@B@DBF@@ABAB@@@BAB@B@BA@@B@@@BAB@B@@@BAB@DAB@B@BA@@@@DAFCJAD@B@B@@BBFDHHLJFBRDB@LFJFFDFDHBFFBB@DBFBFBH@B@FBF@BB@BHDFDHDF@BBB@BBBLDHDHDB@JBLDHBDBFBBBDFBFFHFHDHDFDFFHFJDHDFDFC@A@EAG@CAI@IAGAGAG@IAGAE@E@@@GAI@AAE@E@@@AB@B@BABA@@@A@@@AA@@B@@A@@@@AA@@AA@@@@AB@@@@AD@B@BABADADCFAD@BCHCHAFCFCFAFABC@IAMAKAIAG@B@BB@BBBBBDDAB@@A@A@IAK@EAG@@C@E@@@@B@D@FAH@B@Q@K@ABCFCD@@ABCDCFBD@H@F@H@F@F@HBD@F@H@F@@@F@FBF@DFBF@D@FBB@@@@@BADADABAJ@B@FBB@F@B@@A@A@CB@@A@C@C@A@AB@@@@A@A@A@A@CBA@A@A@A@A@@@A@@BA@A@@@A@A@A@AlHjJHBB@B@@ABCBCBABA@ABEBAD@B@FAB@FA@ABC@A@AJ@@@B@@@@@@A@A@ADBF@B@JBDAD@BAFADAB@BADAB@@ABA@@@ABEBG@EBA@A@@BC@E@ABC@EBCH@HBLBJ@D@BBF@HBH@D@DBB@@@BA@@B@@@FAFADAB@FAHCHADAFAFAJCBBD@JBHBHBHBJBFBH@DBF@DBF@J@HBH@HBF@HBD@@ADQBGDGBGBABCBABGBGBI@CDUBMBE@ADQDM@CBI@@DOBGDMBI@ABGBKDMDQDI@C@@BIB@@A@CBC@C@@BC@CBC@A@@@A@CBC@C@@@@BA@C@ABE@A@CB@@CBC@C@@@A@@BA@A@@@CBC@CBA@A@C@ABA@A@@@CBC@A@ABC@C@@BA@C@CBC@CBC@A@ABA@C@A@ABA@@@CBC@C@CBC@A@@BC@C@@BC@CBC@@@C@CBA@CBE@@@@BE@ABEB@B@DBB@H@B@D@D@BB@@BAB@@@FBD@D@B@F@B@DBB@D@B@F@H@BB@@B@@AB@@@DBF@D@@@@@BA@C@CBC@@B@B@B@J@B@@BB@@B@@BBB@@BBBBB@@B@B@B@B@@@BB@@@@@B@BB@F@F@HBF@@@D@B@@@TBD@B@B@B@HBB@F@F@LBB@D@B@B@@@B@F@DBB@F@B@B@D@@@JBF@D@D@D@BBD@D@L@FBD@D@N@DBF@D@F@B@FBB@B@B@@@B@D@F@FBL@B@D@JBB@J@B@HBD@D@@@D@JBD@H@D@F@D@FBD@J@FBD@F@B@@@@@B@@@JB@@B@B@BM@A@A@@@AB@@@@@@GB@@EBE@A@@@A@A@@@ABC@CBA@G@EBE@@@C@@@ABE@EBE@@@A@A@@@A@G@@BA@C@@@EBC@A@E@A@@BC@A@@@@F@H@@BF@@A@ABA@A@A@A@A@A@ABA@AB@B@@@@@AA@@@AA@AA@@A@A@BAAACECCA@@A@@@A@ABA@@BC@ADA@AFEDCDCBA@A@@@AB@DBBBB@DBFBBBB@DBB@@@@@@CB@@A@@@C@@@A@@F@P@DBB@@AB@J@D@FBB@P@RBBA@@@C@@@A@ABA@A@@@A@A@A@A@@@A@@BA@@@A@A@@@ABC@@@A@A@@@A@@BA@A@AA@C@AAI@E@EA@@BA@@@A@A@A@@@ABA@A@A@C@@@AIAIA@BADCH@@A@A@A@EAGA@@K@@@@@@@AA@BEA@BADEBKB@@C@A@A@A@@@A@C@A@A@AA@AAE@A@A@@@A@C@ABC@@@A@C@ABA@A@@@C@A@ABC@@B@D@B@BBB@D@D@FBD@F@BBJ@@@FBBA@@BA@@B@BA@@@@B@@@BABCBABA@A@@@@B@@@B@@@BB@@@@@AB@@@@A@ABA@CBCBBB@DBBBD@B@B@@@B@@@@ABA@ABA@A@@BC@@@AB@@C@@BA@@BADGB@DC@@BABAB@BABABABAB@BBBAHCCAJEJE@ABAFGA@CBECECEECA@@@@BABAB@@AAAGCC@EEIGEBIDID@@DFEBCEAACBC@CBCB@@AA@AAACCCCACEFCFGHGA@DA@A@AAA@C@A@AA@@@C@@@A@@BC@A@@BC@A@@@@@@E@@A@G@C@AB@@A@@@@@@@@@ABGA@@@A@C@C@CBC@G@@F@B@B@D@DAD@B@AAC@BCF@@G@C@A@@@@B@@@B@@@@@BC@GA@@@@AB@@AAA@@@AAA@@@@AA@AA@@A@@@C@GAA@@@AB@BAB@B@@AD@BA@C@E@A@BAQ@KAA@A@C@C@A@A@BEBEA@EAG@C@@@@@A@@@A@@@A@@@A@A@CAC@A@@@@@C@E@A@A@A@A@EA@AA@CA@@A@@@AA@@@@A@@A@@@@A@CAA@AAA@@@@@AA@A@C@@@AA@C@C@CA@@A@@B@@AD@BE@@AA@A@@@@@A@@BCAE@E@A@@@@@@BA@A@EAC@A@C@AAA@A@C@@@@BA@A@E@C@C@A@@@@@A@A@A@A@CAA@@@A@A@A@@@CAAAA@CCAAAAA@AA@@A@@@@AA@@@A@@@@@A@@A@@@@AAA@CAA@@@AA@@AA@@AA@@@@@@@@@A@@@A@@@CA@@A"],encodeOffsets:[[128548,46740]]}},{type:"Feature",id:"230622",properties:{name:"肇源县",cp:[125.078223,45.51932],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@B@DBB@DBF@DBD@B@BBB@B@BB@@B@BB@B@@BADBF@B@@@B@@@D@H@H@BBBB@BCFB@ABPDBB@DD@@CH@D@LBF@B@@CP@DIB@@@@A@@BAHEKA@CD@@AL@D@B@J@BC@CBE@@@A@@BC@@@A@A@@AA@A@A@CBAB@BABCDADCDABAFEFCBAD@DBD@ECC@BADADAFCHCJEB@@AAABAD@FAD@HAHAFAD@DAHAH@FAHAFAD@BAD@FA@BBDBAFCB@B@JAF@L@BAJ@BB@B@@@@AB@@@BB@@B@@D@B@BAFC@@D@D@D@BAD@H@FA@@D@DADAD@B@DAB@FAB@B@@@@@@A@ABA@@@@BABABABADA@AB@D@DADAD@@@D@FBH@B@B@@@@@BDBD@DBDBD@DBD@BBBF@@@B@@@B@@@B@B@B@BAB@B@B@D@B@D@B@@@D@B@D@B@D@B@DAB@D@B@D@B@D@B@D@@@B@D@B@B@D@B@B@D@B@D@B@D@B@D@B@D@D@B@D@D@B@D@@@B@BCDABADABADCBADABCB@B@@@B@B@B@B@D@B@B@BBD@B@@@B@B@B@@@B@B@B@B@B@B@BBB@B@D@B@B@B@D@B@D@B@B@BBB@B@B@D@B@D@B@D@B@BBD@B@D@B@B@B@B@B@@BD@B@B@D@B@B@B@BBB@D@B@B@D@B@DADABAB@DADABADADCDADADADAFADADABADADADCDADADADADADA@@DADADAFCDADA@@B@B@B@B@BAD@D@D@B@D@DAD@F@D@B@D@FAB@B@H@B@FDDB@@@@BB@BBB@@@B@@BDBDDAB@B@@@@@@@@B@@B@@B@@@@@B@B@B@@@@@@@B@@@B@@@@@B@@@@@@@B@@@@@B@@@B@@@@@@@@D@DBB@D@B@@@@B@B@@HABABDGDA@@@@BB@ABA@ABA@A@ABA@A@AB@B@@@@ABEBA@@BF@BADABBDDA@EDDBDB@BCBABABFBBAD@CHAB@@FD@@@BB@@BB@@@@@@@@BA@A@@@CAA@CAAAAAAB@BABB@B@DBB@BBB@BB@BBB@@BB@BB@@D@@@B@@@B@@@@@@@@BB@@BB@@BB@@B@DBB@BB@@@@@BB@@@@@B@@@B@@B@@B@@@BBB@BBBBDDB@BBDB@@B@B@B@@@B@DBB@B@B@B@@@@@B@D@D@F@B@B@@A@@D@B@B@BBD@B@D@FBB@B@@A@@@@B@F@F@DB@AB@@@@@B@B@@BF@@ABC@@@AB@@@DBD@D@B@@B@@@D@BBB@@@@B@BBB@DBB@@@@@@BB@@@@@BB@@B@@@DBB@@BFBB@B@B@B@F@D@@@@@B@D@DBB@B@@@B@@@B@@@B@@@@@D@H@FBB@AFAFB@B@D@D@B@B@LBR@ABB@F@D@B@@ABC@@@ABA@ABA@@B@HBD@@@B@@@BBB@@B@@B@BB@@B@BB@@BA@@@@HBD@@A@@@@@A@@@A@@B@BA@ABC@AB@@A@A@@@A@A@@@ADEBC@@J@@AD@PQEAIAAKD@@GFITWEEACZGXCDAB@HAB@@E@A@@A@A@A@CCAAAAAABA@ACAEAGCGACAB@BCBCBCDCBC@@BABC@ABA@CBA@E@A@ABA@C@A@@@@@AA@A@AA@@A@@@@@@@@A@@@A@@@@A@@@AAA@@A@@@@AA@@@@@A@@A@@AB@@A@A@A@A@A@@BABA@@@ABC@AB@@A@A@@BABCB@@ABABABA@@@A@@BA@C@A@@@@BA@@DCBC@@MKA@IGIEIAIAI@A@@@K@Y@SAMAIAWGQCOAKBMFGFKLQDKAKEQO][KEICYEA@_CE@UE[IQG[GKCG@IDEFCHAJEHGBSBSASEG@I@MBK@CACC@GFGLGFGBG@IEEGGSWeoGEc[OIOCO@KDMJKPOTKHCBEBGCGCEC@CBCBI@EEEGAKDKFKJOJUBS@M@QC]GaOGA_GI@GBGBSLA@QHODMAICOKGEAAWSAAMGOCCAG@KBGBIBAAE@A@C@CFCH@L@DDDHBHBPHJHFH@FEFGFK@M@OAODKHGPETO\\CR@L@FCF@F@FAHCFEBM@QAS@E@O@C@I@QBYDMDKFEDCDADAF@ZAJAFA@CHEFEDIDEBEAE@EAGGCEAGBG@E@EEEEEEKACCAKGMICMBUFSHW@OGKGEKCSFKFWNQJMBIAMGMGSMICOCC@G@IDEDCH@JBJDNAHEFOBOAOIWWKIIEOAMDUHODKDOAQEKGKIKCUEM@M@MD]POHKDGDGBG@G@GACCAC@C@EDEJIDG@CCECEQGA@GCKGECCAK@I@WHaJCBMFMNGHGJKRADILGBEBK@UAUCYEMCIEECEIECEACBG@KDMFOJGFIHCD@B@BAFETCLEHGDIFEFAF@D@DDDFDJFFFFHBFBJAPETEJGHIDIBSCSCQAWAMAMAGAGBGDKH@@EFCBEDIHGHAF@DBJFJLLFJ@FCDEFGBIBI@GCIAM@GDGFGFCH@F@FFHBDLJDF@FAFGFIDK@QEMGMIMGICIAK@C@MBKBMFOHMJCDGJIDAD@FDDLDNDFD@DAFADBBDDDD@DCDC@I@KAKBE@EBAB@DBBDBJDLHDDBFBFADEHGDKDMDM@KDGDEDAD@D@N@F@F@FADEBIDEFCFCHCBGDMFKFGDEFEBO@O@KAIAIAEAEBCBCHGNINCHADBFBDDDHDFBR@TBHBDDHFJFHDJDH@J@DBBB@DCDGFQHGFIFILELAJADDFDDDBJBH@N@LAH@FBFDBF@L@HCL@@@H@HCHGJ@BN@N@VJnPRHJD@FBPBHJRVLPFNHTJ\\@X@X@X@DOBE@A@AbR`NFDLFDBJDFDFBHDHDHDFBFDFBHDFBFDD@DB@BFBD@BBDBHDFBBBB@@@Bx@@BB@F@F@HBF@F@H@D@D@B@DBCDGBCDEBEDEBCBEDEBE@@B@@ABCBCDEBCBEBC@ADC@A@@@@BAJCFALEHCNEFCHABA@@@@@A@AB@@C@A@CBC@A@A@A@ABAA@@C@CAC@CAG@A@A@@@@B@B@B@B@B@B@BAF@B@D@BAD@D@D@B@@AD@B@@@B@B@B@B@BAD@B@@@B@B@D@DAB@D@B@DAD@@@D@B@B@FA@@B@F@DAD@D@B@B@BAB@D@B@D@DAB@D@D@B@FAF@B@FAF@B@DAB@B@D@B@D@DAD@D@D@BAH@DAD@B@B@B@B@B@@@DA@@B@B@@@B@HAH@LAPALAB@jM@CAC@C@AA@AAACA@CCAACCAACAAAAACA@ACA@@CAA@CAA@AAC@CAC@AAE@CAA@CAA@CACAC@CA@@A@@@@@@@A@CAEAC@GAGCC@AABABGDGDEBEBC@@BC@CBABA@CBCBCBCBC@@BA@C@@DABAB@NG@@RI\\KFBND@@@@@@BABABAB@@@@AB@B@@BB@B@BB@@B@@@B@@@D@B@BBB@B@@@B@DBB@B@B@D@B@D@B@BEBE@A@@@@BADC@A@@@A@@D@B@BBFFBF@@BBHFHFFDDDB@BB@B@@@@B@@@@@B@DBJDHDBBJBFB@@@B@B@@F@B@D@B@F@F@F@J@F@B@BA@@@A@ABC@A@@@A@@@AB@@ABAB@@@@AA@@@A@AAA@@AAA@@@@BAB@BC@ABA@@A@A@@A@@BA@@B@@@BAFCRSJILM@@HGB@R@LBH@F@@@JCFCDAFAFCDAHCDAFCFAJCFCFCDAFAFCFA@@B@BBB@B@TJPJRHBB@JBB@@DBJJFDLLFD@B`@T@H@@CDC@AB@BCBCDAB@@AB@BA@@B@@AFCBAJDBAB@@@B@D@B@BAFB@BB@D@B@B@B@@A@C@A@CBC@A@A@@@@@@@@BA@A@@BEBCBA@CB@@@DB@D@@BCBA@@AF@@ABAJ@@J@@@BABGB@B@@@AJD@JBB@BC@CBAC@CAA@@@A@@@@@BA@@@ABABC@@@@D@@@DAD@D@DBD@BBBADBB@DC@@@A@@@@ECCCA@AAA@@AA@A@@AB@@@BA@@B@@AB@@@BAB@BA@@B@@AB@B@@AB@@A@@B@@A@@BAB@B@B@B@D@B@@@D@B@@@@B@B@B@BA@@B@@BDB@@@BBB@BBB@BBB@D@B@B@@@BA@ABC@CDE@@BC@ABA@@@A@ABE@A@@@ABA@ABCBI@CB@RBB@BAB@HDBBB@B@B@NIBIBKBC@@@OA@@CACACAC@AAAAA@@A@@A@@@AB@@@@AB@B@@@BABA@A@@@A@C@ABA@A@A@A@ABA@A@A@ADAD@BAD@@@"],encodeOffsets:[[127980,46781]]}},{type:"Feature",id:"230623",properties:{name:"林甸县",cp:[124.863603,47.171717],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@A@A@C@AAC@A@A@A@A@AAA@C@C@A@@@AAA@A@A@A@C@A@AAA@C@A@A@C@CAA@A@A@C@A@AAC@A@A@C@CAA@C@A@E@AAC@C@A@A@AAC@A@A@A@A@A@CA@B@D@B@B@BAB@B@@@B@D@B@@@@@@@@@BAB@B@D@BAD@B@D@BAB@@E@A@GAG@A@C@C@E@C@C@C@A@A@E@E@A@E@C@C@A@A@E@EAC@@@A@@@@@@@@@A@C@A@@@A@A@@@@@A@@@@@A@A@E@A@C@C@A@A@@@A@@@@AA@@@C@A@A@A@A@A@C@C@@@A@C@A@E@A@A@A@A@C@@@A@A@C@A@A@A@A@AAA@@@A@A@C@A@@@A@A@A@A@A@AAA@A@A@A@A@A@A@AA@@A@C@E@OAMAG@@DCLAJAJ@DDH@@B@L@HBNBJ@HBB@@@D@D@D@B@BBD@F@D@DBD@D@B@D@B@@B@@@FBD@B@H@DBB@BBB@@BBBBB@CDB@@BBBBB@BBBBBBDBBDDBBBDBBBB@BBBBBA@CDCBABCDABA@@BA@ABABA@ABABABA@AB@BCBABA@CDABCBADABA@ADABCBABCDABABCBADABABA@@BA@CDABADABCDABABADCBCFCBADADCBABADABABADCBADABABAB@@@BFBDBDBFDDBFBFBDBDDFBDBDBB@BBDB@@B@@@BBB@BBB@BB@@B@F@B@B@@BB@@@B@B@@@B@B@@@@@B@B@BBB@B@BBB@B@@BB@B@JDHDLDLDB@GHABEDEHIHGHGHEDCDAAEAA@CAA@CAC@C@@@@@A@@@@@@BA@@@@B@@@@AAC@AAA@A@AAC@@AC@CAEACA@@BA@ADAEC@@@@A@@@CCGCEECAAAA@CA@BCDC@C@EAA@@@B@@@@@@A@@@AB@@A@ABA@@@AB@AA@@A@A@EAEAEAA@EA@@AB@@@BAB@B@BAAE@CAAAA@@@A@A@@AA@A@@@A@@@A@@AA@@@A@@@A@@@AAA@A@AAA@A@@@@@A@A@AAC@EAAAA@E@AAC@EAE@EAA@A@@AA@EAA@ECEACACAAACAAAEAECCAEAAACAECCACAAAEAA@CCEAECEACAAACAECEAECEACCEAECECKEA@@@A@ABCBEBCDEBEDEBCDA@@B@F@D@B@D@D@D@DAD@D@D@D@D@D@B@D@DAD@D@D@D@B@B@B@@@@@B@@@B@B@B@D@D@D@D@D@D@D@BA@A@ABC@CBCBC@CBA@CBA@CBCBC@CBA@A@ABEBEBA@CBC@GDUHWHOFEBWHA@GDMDiPWHGDIDGBQ@cBELGNCDCHCHCFADEJCHCFCHADGLGNGLCFB@@@F@D@B@D@B@BBB@B@DBDBDBHBFBDBDBB@BBDDFFBBDD@B@BBBB@FDHFJFLHDBNHNJPJBNBNBN@NBNBLEHGLCDEHINGLABGLUABHB@@@@@HABAB@BBF@B@@@BBABABA@C@A@A@ABABA@@@A@@@A@@BB@BB@@BABC@@B@B@D@DDB@@AAA@@@@B@D@@@@@@ABA@@B@B@@@@@@BCB@B@@@BBBBBB@@@@@@AB@@@@@BD@@B@@AB@@@@@@@@B@@@BB@@A@@@ABA@@@@@BBBB@@@@B@@A@@BA@@@A@@@@AA@@@@BAB@@ABAA@@@A@@A@@@A@@B@@@@B@@@B@@@@B@BB@AB@@CB@@@B@@@@B@B@B@B@B@@@@B@@@@A@GA@BA@@B@@@@@@@BB@@B@@B@B@@@@@@AA@@@@@@@B@@@B@AA@@B@@@B@@D@@B@@B@@A@CB@B@@AA@@B@@A@@@@A@A@@BAB@@@@C@@@AB@@ABB@@@B@@@BAB@@@B@@@BB@BA@@BAB@@@B@@@@@@@BBA@@BA@@D@@@DAB@@@B@B@@B@@@@@@E@@@A@AD@@AB@DB@B@@@B@@@@AC@@@@@B@B@DA@@B@B@@@DBBB@@A@@@A@@@@AA@@@A@@@@BB@@BB@B@@@@@ABA@@@@B@@@@A@A@A@@@@B@@D@@@@@@@@@A@@@@@@BB@FD@@@B@@C@@@@@@BA@A@A@@BA@@B@B@@D@B@B@DB@@@@@CB@@@@@BB@@B@BB@@@@A@A@@@@@@BB@BBBBBD@@@B@BB@D@@A@@AA@@AA@A@@BAD@B@@@@@D@BAB@@@@B@B@@@@@BB@@@@@ABE@A@@BB@B@@@@B@@BBB@B@@@@@@@@@@A@@B@B@@@BB@@A@@BC@AB@@AA@@@AAB@@@@@B@@CBAB@@@B@@B@D@@BB@@B@BB@D@@@@@@@@@BB@AB@D@@@@@@@A@@B@@BB@@@@@@A@AA@B@@@@BDB@A@A@A@@@@@DB@@E@@@@@@B@@@@@@A@AAAAA@@@BB@@B@@B@@@@BB@@@@A@EA@@@@AB@@@BAB@@@@A@@A@@A@A@AB@@@@BB@@@@AD@@B@B@@@BA@@@@@AD@B@B@@A@BB@DBBB@@A@C@@@@@AA@A@@A@@@@B@@@@BB@@@@ABE@CB@@@@@@B@B@BAB@B@@@@BA@@B@@@@A@A@A@@@@@@B@BB@BBB@@@@ACA@@@@B@B@@@D@F@B@B@@B@BA@@@@A@A@@A@ABA@A@@B@@B@B@@@@@@B@@CD@@@@@BB@ABB@BB@@B@B@@AB@@@B@BB@@B@@ABA@A@A@@B@B@F@@@@@@@@BA@A@A@A@@B@@@@@@B@D@@@@@@@BD@B@B@@BCB@@@@B@B@@BB@@@@A@A@@@@@@@@@HH@@B@@@ACA@@@BA@@BB@@@DB@@BB@BA@@@@@@A@@AAA@@@@BAB@DAB@@@@BB@@B@@@@A@CB@B@@@@B@BA@@@@@@@B@@@B@@@@B@D@@@B@@A@@@ABA@@B@B@@A@A@@DA@@D@BBB@@@@B@@@BB@@@@@AB@@@@B@@B@@@BA@@B@@@@C@@@@@@BB@BB@B@@DA@@B@@@@@BB@@@@@@B@B@B@@@@@B@@@AB@@BBBBB@@@BA@@@@@AB@B@BB@@B@@UAQpBD@B@B@P@H@F@F@B@L@N@L@NAN@F@H@N@VAL@AE@A@@@@@A@AAC@C@ABAFAFCFCFCFAFCFCFAFCFCB@B@D@BAHAH@DAD@@@@@LDHDDBFBB@B@@BJDPFFDBBB@B@BBDBFBDBB@@BB@FBFB@@@@BBBFHDB@BBJD@@HBBBB@BBD@BBFBHBJ@H@BBFDFDD@DDHBJDJDFB@BJBFBBB@@DBDBFBFDHDBBD@@BDBFBDBDBBBB@DBDBDBD@DBDBHDFDB@FDD@BBBBBFBDDFBDDFBDBFDDBFBDB@B@B@D@FBD@B@B@@BB@D@B@B@@@@@BB@@B@@@B@D@DBF@FBB@@@B@B@@@@@B@B@B@BBB@D@BBB@H@JBHBB@@@@@@BB@@@BB@@@BB@@BB@BB@@BB@@@BBBB@BB@@@@B@@BD@FBHBHDFBD@DBF@FBHBHDFBFBB@DBFBBBF@FBFBBBFBHBDBHBFDHB@@@@B@@B@@@@@@B@A@@@@@@@AB@@@@@@@@@@BBB@D@DBF@DBD@DBHBF@FBD@B@@@FBF@BBF@BBF@BBD@@@B@JDJDLDHBD@B@BBJDTFBC@CDE@CBABCBEBEDEBCBE@ABEBEBC@ABEDE@CBABEBGDGBEBG@A@@@@@@@A@@@A@@BA@@@@@A@@@@@AB@@@@A@@@@@AB@@@@@@A@A@@@@@@@A@@@@@@@AB@@@@A@@BA@@@@@A@A@@B@@@@A@@@@BA@A@@@ABA@@@A@@@@@@@@@@@AB@@@@@@@@@@A@@@@@@@A@@@@@@T@T@T@V@V@H@P@R@P@V@@I@MR@J@T@T@T@L@F@L@F@X@`@B@^@V@d@F@L@J@DSDQDSBEBIBO@A@@@AHU@ADKDMDKDMDGEGAGA@@A@A@C@E@E@C@E@C@E@C@E@E@I@I@C@E@C@E@E@C@E@E@C@E@E@G@E@E@C@@@EAE@C@E@C@E@@@G@G@E@G@E@I@A@A@@@A@K@O@OKEMEKOCCAAACGIEIAAAA@AAAEKAC@AAEACEKACCI@@@ACC@A@@@A@A@@LSJODEB@@CHK@ADEBABEB@@ABA@AB@@@BC@@@@@AB@@A@@BCBABA@ABABABA@ABA@@@@@AHCLGHEJENG@A@K@EA@@@@A@@@@@@@@B@@@@@@@AGEBA@CBA@CBCBA@@@@@CBA@OFMDOFABA@@B@@AA@@AAA@CA@ACAAAAAA@AAAACA@@AA@@A@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@A@@@@@@AA@@@@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@A@@A@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@AA@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@A@AA@@@@@@AA@@@@@@@@@@AA@@@@A@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@@@@@A@@@@@@A@@A@@@@@@@@@@AA@@@A@@A@@@@@@A@@@@A@@A@@@@@@AA@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@A@@@@@@A@@A@@@@@@@@@@@@@AA@@@@@@A@@@@@@@@A@@@@@@@@A@@@@@@@A@@ACAAAAAA@CCA@AACCAAA@AACAAAAAA@@AA@AA@@A@@A@@AA@@@A@@A@@A@@A@@A@@@AAA@AA@@AAA@@@AA@@@AA@@A@AC@ACEAA@AAAAACEACAACEAA@CCCAAACACAAACCCAC@AAA@@AC@@AAAA@AAA@AAAACACCAACAC@AAA@AAAAAACAACEAAACACACAAACACCAACACACACAA@AA@A@I@WAKAE@EAM@IAG@@@A@A@@@@@A@@@A@C@A@@AABA@C@q@EXA@G@E@Q@C@AAA@C@QCMA@@uE_C@@A@@@A@A@C@EAE@CAE@EAA@E@E@CAE@A@@A@@BC@A@@@A@A@@BA@@@A@A@ABA@@@C@A@@BA@A@A@A@ABA@C@@@CB@@A@@A@C@A@AAA@@@A@A@A@AAA@C@C@A@A@A@A@AAA@A@"],encodeOffsets:[[127961,47958]]}},{type:"Feature",id:"230624",properties:{name:"杜尔伯特蒙古族自治县",cp:[124.442572,46.862817],childNum:1},geometry:{type:"Polygon",coordinates:["@@AGAO@EICQGmOUIM@M@AFAJEFGFIBMBG@OFQDMDMBMFIDGHGJKLMLIJCJCHALAP@LDLFJHFFDLDPBZDRBHDFDBF@FCHEFEB]NIDEBAFBFDDFDFBHBH@HALCHARAJBF@FBDD@D@FALENENCJKFIDKDIBEDCFAD@FBDDDJFJFDHAHEHEDGFIDGBGFAD@FDFHFXJDBFF@FAFGFGDIFGFGFEDAF@DDDDBJDNHDBBFBD@DCDGDEBQAGAGAG@ODCD@FBFFH@FAHGJIDIHGL@BA@@B@@@B@@@B@B@@@BB@@BJHFDPF@@B@B@@BB@@@B@BBB@@@B@@BB@@B@@BB@@BB@@@B@B@@@B@@@BA@@B@@ABA@@BA@A@CDKFABCDABEDABABC@A@ABABAB@B@@@B@@@B@B@@@BA@@B@@AB@@@B@@@B@@AB@@@B@@@B@B@@@@@B@@@B@B@@@BB@@B@@BBDB@BDBHFBBBB@@BDBD@DAHCDGLABCBC@C@AAECEAE@I@C@AAEEEE@GAOAGECGEAAAAAACBA@A@@@A@A@A@A@A@@@ABA@EBCBEFABAD@DDDFDFBDDBD@D@BABAD@B@DABAB@BAD@BBBBBB@DBBB@BADCDGFK@IAG@OAA@A@A@AAA@A@A@A@AAA@A@C@C@A@C@@BA@C@E@EBEBCDCB@BAD@@@D@D@BBD@@NXBHBFBDBDDFBF@DAH@@CHEF@BEBEDEDADAD@D@B@FBHFFDFHFB@DBJBD@DBHDBDBB@B@DAH@DABC@GBCDAB@B@BBDBDDDBDBDBB@@B@B@ABCBADGDAB@DAHADGFIDCBA@KBGBGBC@CBCBADCD@DBBBDFBBBDDJNDHBDADCDCBEBK@KBC@CBA@ABABAB@BCH@DDDHFLHDFBDBF@F@FADCBCBE@K@G@GBCDCFADCF@DDDFBFHDF@DBDFFHHJJJLBD@BBB@D@DBBAFAFCDGFIHEDAD@D@H@FAHCDCBIDIBE@CD@D@F@DCJINMLEDCBMBIFKJAB@DBD@BDHDDFFHDBH@FAB@BED@BCD@DCHAD@DAD@HAF@D@BBBBBBBDDHFDBBBHBJDFDDBDFDD@@@FCFEHILKR@@ABAB@@ADA@B@BBD@HBD@BBD@B@H@L@N@HAN@L@B@@@@A@@BA@@@@BA@@@@@@BA@@@@@AB@@@@A@@B@F@JBRDHBJ@FBHBHBB@@K@GBG@G@G@GBG@G@GBG@G@E@AHAHCFAJCHAHCBAD@DADBDBBDDBDBDDDBDBBBDDFDDBBDDBDBBBDBBB@@B@B@FBD@DBFBD@D@DBD@BBB@B@BAD@FADAF@DAD@DA@@B@@@@BBHBD@DBD@@B@D@@@BBDBDBBBDBBDDBBBDDBBBBD@BBD@DBDBDBB@DBB@@@B@BB@@B@B@B@B@B@D@@@CDADCF@D@F@FDDDBDBBBABCFAB@DBDBDFBFBJ@F@F@F@@B@DCBGDCBE@EACAE@G@CBCBCDAD@D@BDDA@AB@BAB@@@BAB@B@DB@@B@@ABCDCDCD@@DBB@D@B@B@@DBBDBABA@@@@@ABC@ABA@@@A@AB@@A@@@@@ABA@@@A@@B@@A@A@ABAB@@@@A@ABA@ABA@A@ABA@ABA@CBA@@@ABA@@@C@A@C@ABA@A@CBA@A@@B@@@@@B@@@B@@A@@@B@@@BBBB@@@BB@@B@@BB@D@BA@@B@@@BAB@B@@@@A@@@@@@@A@@BA@@@AB@B@@AD@BABCBGBMDC@E@C@@BABA@@BA@@BAB@@@B@@@B@B@@@B@B@@@@@@@@B@@B@@B@@@@@@B@@@@@B@@@BA@B@@B@B@@BB@@B@@BB@@BB@BBB@BB@@B@BBD@@@BBB@@B@B@BAD@B@@@BBD@B@BBD@B@DBD@BBD@B@DBB@@@BBBDBBDBBDDBB@BBBBBBDDBBBBDBB@@@@BBBB@BB@@BBB@@@@BBBDBBBBBBBBBB@BD@BBD@D@DBB@D@@@BBB@@@B@@@BB@@BBB@@BB@@BBB@@BB@@@B@@BB@BB@@BB@@BB@BBBB@BBBDBBBDBB@@@DB@BDD@@BB@@@B@@BD@DBD@D@BBB@B@@@BBB@B@DBB@@@B@@@BBB@B@@@@AB@BAB@DAB@DABAB@DAB@BA@@@@B@@CJ@@@B@BADADCFADD@LBDBN@FBX@Z@\\@^@HPFRDJFLDDBFDD@@A@A@CBABEBABCBCBA@ABCBCB@@@@B@JDPFVJVHLFJHLLJLLLDFDBBDBBJJHFHJBBBBNHJFHDFBJFHFHDRJDB@@H@PBFBTBVBXBVBH@B@J@TBTBBBR@PBJBJAJ@JATANADEHKHMHKBCDGDEDGFIBCDEDGDGDCHMFKdAR@HAJCHCXGjONCHCB@XGFAPEXGVGHCD@DAB@FAFABAB@B@DAD@DADAB@DAB@DAD@DADAD@BAB@B@@A@C@C@C@C@C@C@C@A@A@A@@@A@@@@@A@A@A@C@C@CBC@C@C@A@C@C@C@C@CBC@C@C@C@C@A@C@E@AB@DCFAFCFADCFADABAB@@AHAHCNERGTGRGRGREJED@DCDCFCDAJILGNILILIDADADCDADCB@@ABCDCBCFGHKFGFIFIFGFIFGDEB@A@C@CAE@C@IAE@C@I@EAA@A@A@@@CA@@A@AAA@CAAACAA@@@CAAACAA@AA@@CCAAAAAAA@AA@AA@AA@AAAAAAAAAAAAAAAA@@AA@CE@@CCA@ACA@@AAAAA@@AAAACCAAAACCCCCC@ACA@@@AAC@C@C@C@C@E@CAC@E@IAG@G@GAG@E@E@@@E@CAC@C@C@E@CAC@A@@C@C@C@A@@AAC@@ACAC@CACAC@A@AAA@AACAEAC@CACACACAE@AAC@AAA@AAC@@AC@CAABADCBCBADCDCDADEDCDCFCFEBADAFAHEJCJCHCHCHCFCDAJCJCFCHCB@BAFEHEHEHGFCBABA@CBCDEBEBEDEBEBGBEDE@ABCDGC@C@A@IAI@EAA@C@A@C@CAA@GAIAIAIAA@EAC@AA@@AACCAEEECECGEEAA@A@ACEAECG@AAA@AACAACG@@CEAE@AAEAG@E@E@A@@@@@A@A@A@C@EBA@E@AB@@A@ABE@@@ABEBC@A@@@@BA@A@ABEBC@ABCBC@CBC@ABA@@B@B@@@@@@A@ABC@C@C@ABA@@AA@@AAGGGEGEGGEEGEGEEEGGGEEEGEGGAAECEEEEGGEEEEGGAA@@@A@C@C@E@E@G@E@E@G@E@E@C@A@EBG@E@A@C@A@@@@@E@C@A@C@C@C@C@CBC@@ACAAACACACACACACAAAC@CACAAACACACACAAACAC@CAAACACACACACAA@CA@C@A@E@EAE@EAE@@@EAA@C@A@E@AAC@C@C@AAA@@@I@CAG@C@C@C@EBE@I@E@EBO@CBK@EBE@CCGIIGAAGGAAAAEEAA@AAC@@A@IAGAGAKAE@A@ICIAGCA@@@M@M@OAO@O@M@MAO@K@O@MAG@EFGDGHIFABEDEFA@ECECIECAAAA@ICICEA@@@@A@@@@AA@CAC@AAGAEAA@AAAAACCAACCEEC@A@@@C@A@A@@@A@AAAA@AAA@@@AA@A@@@@@ABA@A@A@A@ADE@C@ABA@@@A@@B@BA@@@C@@@@@@@@@@@AB@@@B@B@BA@A@@AA@@@A@@BABA@AB@BABADAB@B@BBB@B@@@BBB@B@@@BBB@BBB@@@B@B@B@D@B@BBB@DA@@DCDE@AB@FAH@HAH@B@FAJAHAHAFAFAJAF@DAHAFAD@BABABAB@DCDCBADCBAB@@A@@BABADABABADCBABAB@BAF@BA@@B@JADAJAHALALCLAJCD@JALCLCLCLCD@DEJEJCJELELE@@@CBC@EBE@CAA@@@A@@@A@@B@@@BA@@BABC@A@E@E@EA@CEAACCACCCEEAACEEEEGCEEGCC@AAAAAA@@AAA@AAA@AAC@AAA@@AAA@A@C@E@@BCBCFCDAAA@CAEAE@GAIAICIAC@EAA@A@A@E@E@KBE@C@G@C@CBC@A@I@C@C@A@EAKCEAA@@C@A@C@C@G@EAE@G@E@EAA@@Aw@@A@AAEAGCCAAAC@EA@ACAC@ECEAGCEAECEAGCGCGCEAECICCAKEEC_MaQ@B@BAFCPW@W@W@[@SIMGOEUKIQ"],encodeOffsets:[[127105,47014]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("大兴安岭地区",{type:"FeatureCollection",features:[{type:"Feature",id:"232701",properties:{name:"加格达奇区",cp:[124.139595,50.408735],childNum:1},geometry:{type:"Polygon",coordinates:["@@AE@EDCFC@C@GEGEEIAKAICKGKIIEGAGFCFCDI@KBGDO@I@CBGJAFIDI@EACCEGCCKAK@MAIAECIGKKIKIGICK@WDIBGJINAHGFEHKBOBG@GCECEAEBCFBHCFGBKHGFCH@JDLFLDHBFALAFDHDH@HELELEDI@IBIBKFEDGJ@JAFG@O@G@GBCFAPADIBGBEBCFBF@FCFEH@DE@IAI@CD@DDLJTBJBJBD@DEBCCEECAC@GBEDEF@HJTJJHJHH@PDHHN@F@F@FEFGDC@@FDFPLFFFHHFHBH@HALCHCHCDGDMFEHEDC@KAG@GBEDEHAJAR@HAD@HCPCDADA@ECEDCJCFADA@EAEBAFALCLAJALELGFFHFDDBH@HBFFFLFJDJBH@JAL@L@HBLJRNJLDHDHJHFF@DMFEFCBHHDDDDABGFCD@DFD@HBHDDAH@DFDBFAFAJBFAFIDIBEDAFAFCDCFBDFBLBRBP@JBJBHFDH@F@PAHFDh@PB`@d@DDBFADCFIJGFCF@H@D@@PFV@^BhC\\CRAhIXCTCTAnAtDRBNATCN@LALALEPIFEDIBCDCF@H@PFNBJBH@JEH@HBNFHFJFLBZDT@dBN@LCHADEBEAEAKESAI@I@IDMAE@EDGFGFGDE@EAECEEE@E@GBCMASAEAECAC@CFGDEBKDGHKFIDEBC@EEKCGBCDEJKDEBE@ECCGCECCCECG@KAGAECCE@ECCCAEAKCK@K@GBMBI@GACCEE@GAG@EGEEEAC@ECAC@ACBGBMBGDEFAJARAP@BA@CCEMEKCOGGGCEBIBGLIFIDEFCFCDCDEAAGAICICCEAEBG@QACECEGCG@GFIHGHEFEDE@EECGGQGCAAAECGGGCEAE@IDGBI@GDGDIBGBIBEDEFEJANBJCDEH@FBHFDDFBHG@IAI@IAO@U@W@KAQGWGSGK@EBGJCFBFDFADEDOFIAEACAAGAEECE@IBGBKBEBAHEFKDKBQAW@EA"],encodeOffsets:[[127079,51444]]}},{type:"Feature",id:"232723",properties:{name:"漠河县",cp:[122.538591,52.972272],childNum:1},geometry:{type:"Polygon",coordinates:["@@UF[AeKuACBOBoEaNKPKHADQpEDO@[CIEAABCDW@AIGAAO]ACWKEQIK_QWGJMLKSMkIgEFQHQWcSKOG{cQEEGO_UECGAGAICMIMiYYEKAOAHQA]AKBIFoTUHOFYFMDoJuFCBEBAHDP@\\MTGFKHKPWTUNWLHRbjCDULYLELEHODiCaAYBIFOPYNSB_EYGODQ\\MLEBAD@FABADC@E@ICOBKAEBGFEDGDG@GAEBCBCDCBMDMDIBK@E@AB@B@B@DCDAF@J@NABABABE@WBGBE@AD@DABCBEBEAI@KAC@CBIDGBA@AB@B@FADCBCBABEHEDCBGAE@CDAB@DBB@BCHANCJCBC@G@OBGBGBGFOFSHGBA@KGICCBMAK@KCYCM@IBIDKHCBEBK@GBCBCBCDMJ@D@BFDPHRHPDTFZFJDFFBFBDJJJDLFJBPBFDLHRLHBBB@F@F@FBDNDH@FDBBAHBFDDHBNDL@Z@JDHBJ@N@N@NBRHHHDHBFNBLDFDFDNJJHFFHHHFLFJDRFHDBFDJLNBLDFDHDBHDDBBLBDDBFBLBT@LBPFLJ\\TBDLLTDFDJFRHHBTH\\PT`JJLDJDHD@DITUNAFHLHH@BEPDB`DXRNFTDPJRVJRBR@NFFJJNNHNLRLLBLFJHFPFbFxPDDDBFBDBFBF@HDDDBDBBBFAD@B@D@BDBDDDBDD@D@BBBBDBDDHFBDBDBD@DBBDDFDF@D@DADCBCBCBGBE@EBGDEDEBABAFEDOHEDAD@DDBBDBBDDFFBD@BABA@CF@BADAF@FEBEFEDCDABAD@BADADAD@DADEDIJABEDC@C@EAGAEAEAC@E@EBG@E@ABEBADCBCDCDCDCBE@CBEBEBEBCBEBIBEBEBEBMFCDCBCDCDAD@DBF@B@D@DADBDBBBBHDH@DBH@FBFB@BAB@DADADADAD@D@D@D@D@DBDDBDDBB@D@B@F@BBD@B@DADABEBEBCDEBEDCBIFCBCDABCDCDCBC@CBC@C@C@EAIAGAGAG@EBGB@BADABCDABCDGDABCDCBCDCBABCDABEDABCBADCDCBCBE@GBE@EBEBCDCBCBADCDCDEDEDEDEDC@EBE@CBGBGBC@EBGBKJEJ@DEPjD\\D´TLB^hL\\FPFJFFDDDJDFDDBDBNDNAjGVAT@RDZJhPHDPFJDJDPFPDNBXB`FNFXF`ChAJ~BX@vAjG~CX@RB\\HxhplPHjAjKLAFAFAF@D@J@NBJ@pNNFfLNHf\\lRN@H@XMNKFO@EBCBEBCBCDCbO`MtWx@rTTHxG\\AXBXFJDHBHBH@JBJ@HBbDGF@NAL@NAN@z@VB^DBNAN@HARAH@VCP@LAP@f@¤LJBHBNDJDLDTJ\\PĞpRBN@t@SDALBHBjNTHJLFFDDDBFDFBFDBDDBDDDDFDHFFDLHFBHDFDFDHBRHxNLB|EPEJCHAPEJCJAJAHAQR@JAt@lAD@DADADABCBEDE@EBCBGBCBEDEZSJCJ@J@HDNL@HkbDbNNhR\\A`KZUAQIGGEEEQMKOJOPCRBbNV^DHJpRHb@PAtQ\\GRGFKFEFCDCNQX[JED@JAJAJ@HBHBLDLDF@JBOJALAFAL@HAH@N@F@ZBZBhAzQzetUPGFAHEFADAFALCJCJEJCFCJG`mFGDAFAVATBHFHBBBDBJ@JAD@FADADAHCFEFCDEBEDSJKRGJEHSPGFCJAD@`BDBt^rRbFXDdBXETUdWPIIAAACCAECCAAAACAEAA@CCBCBCDADCAAAA@ACCCAECECAA@@AG@E@ABABCFCBE@CACCEAEA@GAA@GBGBC@E@CAGAECAAAAAAECEACAEBC@CCACCACAE@IAA@CAAAAA@EBABAHADADABABCACAEAC@ACGAAAACCEAAC@AACCCCCCAAACACCECCACCECECEACACACCEAECCACAAAGAEDEBCDABGDKFEBKAEAE@C@C@C@I@EAC@CAEAGAI@C@C@C@C@G@CCAAAAACAECAACCACAECG@C@CAEACCCAAACAA@EAEAC@EAA@EAECCCCAE@CAC@OCA@EAACCC@A@CBADCBABAAECACAC@C@C@IAEBC@C@C@CECCACCC@A@C@CAAACAAACAE@ABC@ABCBA@CAAACEECC@AAA@ACCECCCGAECGACAECECGCC@ECECCCCACCCCAC@CAAGCCCCAMEEAEAC@E@GAIE@A@CBC@EAACEAEACCCEAECCCECCAEAGCEAGAGAEC@A@C@E@C@GC@CAGEC@AACACE@CDCBAFCDCDABADAHCFADADABCB@BAHM@I@EAIEIQCUCOEQGQSGGSQIKIIOMOGaWKKKGWESBWAGCU@I@UBKAUKWIUESEWMKIIIUUMO[cIQKMQQYSKSIMMMEAgNO@WIgWCKSS@ADETGJIHGCKEKOMAK@EAOFMEQBCLYJIFKJSJM\\mHUJUNGNKDCDKAKIQ@GNGP]FIHMFK@SAYBKBA@@@A@AA@CCCA@ADA@@AEEEAAICKEA@AAAA@A@AAA@@@@A@A@A@C@A@AAAAACA@@AC@@AA@CGAC@AAE@A@@A@CBKFA@A@A@K@C@A@ABC@CDA@GCMCEAC@A@AA@ACACCCACAEAAAK@A@@@AA@AA@ACECEEGEGE@A@@@@CACEESBWEOKOMQOGK]GiKkH]GWIWC[IIEeWOEwCWBED_XGBQAICOQAAa@UCQCUEOCYCWCC@A@E@AACAAAC@A@EAG@C@AAECA@CAC@AAAAACACAAA@GAGCA@A@ABA@ABCAAACAAAA@A@CACA@@@@CBABEBCBCBGBEBCBC@CB]EYA[D]AUCQOGMQOWCc@MQ[MwEkGKFGDSJIJC@gMWCUAQA]JBRcN[@]@IEKCeC[A]EO@sbWDMDWBWBkHeHIJBFJVCHELBDDFLTBD@HCFQLUTIPCRENKNM@UYUKCAIBGDGPINABC@aFEDIVIJ_COBADG^OLAL@JG\\QTML"],encodeOffsets:[[125246,53735]]}},{type:"Feature",id:"232722",properties:{name:"塔河县",cp:[124.709996,52.334456],childNum:1},geometry:{type:"Polygon",coordinates:["@@SC[EK@E@@@CFADA@C@C@E@CAGAG@E@EBGBCBGHADC@A@A@AAECCCG@CAEBE@aFG@CAE@KEICC@C@C@A@QJCDEBCBCBA@AAOICACAE@C@G@CBA@CA@A@C@CBADEBCBCDAD@LCTENELE}GuEwzA@EAA@@A@AA@@@AA@@A@@@AAABA@@BA@@@C@C@@@A@@A@@AC@A@@A@@@@@@@A@@@@@@@@BAB@@@@A@A@@@AAC@@@A@@BA@@@A@@@@@A@@AA@@AA@AB@@@@@@AB@@AB@@A@@@A@@@A@@BA@A@@@A@A@A@@BA@A@A@A@@A@AB@@A@@A@A@@@A@@BA@@@A@@@A@@BA@A@@@AA@@@@CB@@A@A@A@@A@@A@@AA@A@A@@@ABA@A@A@@@A@@AA@@@A@@@@AA@BAAAA@A@@@ECAAECAAA@A@ABG@A@@@A@@@@C@@A@AA@BGB@BBB@@B@AB@@C@GAAACC@A@A@A@@@AB@@@@@BAA@AA@@A@@@A@AA@AB@B@@@BA@@@AA@@AC@@A@ABAA@AAA@@@C@C@A@@@AA@@A@BA@@B@BA@@B@@@@A@@@@AAE@C@@@A@@@A@@AA@@@@A@@@A@@BA@@KK@@G@@@AAA@@@@A@@B@@A@@B@@@B@@@BA@@B@@@@@@A@@A@A@C@@@A@@AC@AA@@@AAACACAA@@A@@@@AABCCCA@AAA@ABMNA@C@A@C@CCOO@@@AB@@A@@@A@@AAB@@A@@BEAA@@E@AA@@@AA@@C@@B@B@@@@BB@@@@C@A@C@AA@@BA@@@A@@@@A@A@@@@@AB@AA@@AA@@A@E@@A@@BA@A@@B@B@B@B@@AD@DC@@@A@@@ABCBAB@@@B@@BB@B@@ABABC@A@C@A@@@@AA@@@@@A@@B@B@B@@@BA@@B@BA@E@ACA@@@@@AAAB@@AB@@@BA@@B@@@@C@@CA@AA@@C@@BAB@@@@A@A@A@@@@@@@ACA@@@@@A@@@A@ACA@@A@@A@@@AA@@@@AA@@A@@@@@A@@@@EAA@A@@A@@B@BA@@B@@@B@@AA@@@A@@AA@A@@@@A@@AA@@@A@@AA@@@A@@AAA@AAA@@@@A@A@AA@@@@A@@A@C@@@@BA@@@A@@@A@@C@@BA@@@@@AB@@A@AA@AAA@@@@C@@@A@C@@A@@@AA@@A@@@A@A@A@AAA@A@C@A@@@A@A@AA@@A@A@A@A@C@A@A@@@ABA@A@A@A@@AA@A@A@E@C@A@@@A@@AA@@@C@A@@@A@A@C@A@@@A@AAA@@@A@A@@@A@@AC@A@EAG@A@EBC@CBCD@@C@A@KGAAA@@@A@K@EAA@A@@@A@@@AA@@A@@@A@@@@@AA@@A@@@A@CA@@A@A@A@@@A@@AA@A@@@A@@@AA@@A@G@A@A@A@K@AAA@A@A@A@A@G@A@C@A@A@A@@@ABA@@@A@@@A@@@ABA@A@ABCBABAB@@CBABA@AB@@A@@@A@@BA@A@A@A@C@C@CBC@A@AA@@A@A@@@A@@@AA@@A@@@A@AAEAC@C@A@OCA@QBI@G@M@MBC@ABEDCBC@EAE@I@S@IEE@K@KASBQ@IAQ@MCG@C@CAAECEIEC@S@C@A@CFADBBBJBDFFDBBB@B@DBBAD@BCBEBCBCBKBEBCBABCBADCBCDC@E@CBEAG@EBC@EBCBABADABAD@DAD@D@D@B@FDBDBFBHBB@FBJDFBHBDDDBBBBD@BEJCF@@GFCDE@E@W@O@gACBCBCDCDCBG@E@E@KACAA@BB@BLNBDBD@B@BIJ@B@D@D@BC@EAGAEACBC@ABABEFCDCBEBC@E@EBCAEAGAE@A@EDABCBC@G@G@E@G@E@CBC@CDEDEDCDCBGFGDEDEDCDEBEBCBGBG@MJEFA@EBE@CAGCGCECGCEAEAAACAAA@C@C@CBE@A@C@AAC@C@ABCDCBABCCACA@@CECCCAECEAC@GAC@G@EBG@IBGBC@EBC@AA@@AEAEAAACAC@CAAACCACACACACAAACAACGGCACCCAAAACCACAOCC@C@CDABCBEDEBEBG@EBE@C@C@AACACAE@A@E@EBI@EBE@E@E@C@C@EAAAC@CAAACACBC@CBG@E@E@G@CBKAsBM@SEcKIGCICECICCCIUAWBGCOIa[II_O]G¿WCAAAE@CAE@GACACAACA@@AA@EBC@EAA@EA@@@BDBBB@D@@@B@B@@AB@BIFCDABCBAB@@@B@B@B@BBBAB@@@@ABIHCDAD@DDHABGL@BBD@@AFAJCHCLABAD@BABABA@IFACC@G@KDABC@CAAA@AGGC@@AC@C@EBIBC@A@GCCAO@E@@BAB@BCBC@CA@A@@AAEAI@GB@@@BBD@BGBGAEAGAK@A@CHABA@CDADCBCBEBGAE@C@ABA@ABBB@BDBBDB@@BABA@@BC@EBC@GBGDCBI@I@ECECC@E@G@ABCBG@ABABCBEBA@@B@@AB@@ABA@@FAB@B@B@B@B@B@@CBAB@@@B@@@BDF@B@D@D@B@B@BBBB@DDHDBBBDBB@D@FDF@DBF@B@@@@@@DAD@BAD@D@DAFA@@B@B@DBDBB@B@BDBBBBDBB@HBBB@@@BAD@FBB@FAJBBBDFBFDFBFBD@TAH@D@@BB@@DBB@D@BA@CBEBABABBDBB@B@D@@@D@@B@DBB@FDBBFBJBLDDBDBBB@B@BAD@B@@@B@@@BBBBB@B@@@B@@ABA@AFABABC@IBC@A@A@A@CAA@@B@B@BADADCDABEDABA@MBA@C@C@C@A@CAA@C@C@ABCBC@E@A@AAA@A@C@A@CBE@CAC@ABCBCBI@GBGDEBCBA@@AICICGEAAAA@A@C@@CAAACAAACCA@A@E@A@AA@@@AACAAACI@G@WRGJEJILKHKHUHWDULKHKBiEI@GBMTEBG@U[G_CYBGBC@GFEFE@EAEECEMKGEI@]A@A@MAGCEEGWE]BK@IBUAMAgAOA_CMGCGEGAMAASMCA_CQC}IgKgUWGiEQEMIEAWImOSEKBMDUMIBEHEDADAB@B@BA@@@@@IAGAC@@@A@AB@@ADAD@@@BBB@D@B@BA@@BEBC@@@@BABA@ABE@A@AB@@ABCDCDA@CBAB@BCBCDAB@B@B@BBB@DB@@B@@A@EAA@A@ABA@ABC@C@AB@@@BAB@@A@A@C@ECEAC@EAC@@@@@@B@@@BBB@BBBCHB@FBDBBBB@BBBB@BBB@B@@@BADADCD@@UHjL^HLPHNRLPFPAXFTDFDB@@@@@BHFHFFFFDBDB@@BBB@@B@L@BBFBDBDBDDDB@BBBB@D@FBNDHDB@DCD@BAB@D@L@B@B@B@LEDAB@@@@BBF@BBDDHB@@BD@@BB@BDBBBBB@D@B@B@B@@@@@BB@B@BBBBBB@LFJDBBFFBF@@CB@BDBDDB@@B@B@@ABALBZ@TELGNEJO^MH@HJRBLCLCDMLMHIVGV[nINITELIJKZADFRENBP@FBLPNFLDLGHIJSHCF@BTTDLhXXJP@hMFBNNJNLTZTRRLNJR\\dNPVVJJLJXNTFVFXJVLLBVAJ@V@HDXBTAXFLHLLbXPHPNJJJLTRHHRTRHPFVDRDFJBJ@F@JGNABA@ADCBCBEBGDCBABCBCDEDABCD@DDFDBBBD@HFDBD@@H@D@F@D@BFDHBHBFBHDFBDBFDDDFDFBDDBDBFDFBB@FAD@D@BJFHBF@D@FBFBNFDBDDHDBB@DBDDDDDDBDDFDFDD@HDFDFDDBHBFDHBDDFDDD@BBB@BDDFFBDBB@DABAD@BAD@BBFBDBBBDBB@D@D@BDDBDDDDFD@D@D@FAJBD@D@D@DBDBBFABABCDAB@D@BDDBDFBB@PDD@DBF@DBDDFDFBB@FBD@FBFBB@DBBBDBDDFBDBD@H@FDDBDBBDDBBFBDBBBBDDH@D@D@D@D@J@HBFBDBD@FBJ@D@D@D@F@FBLBFALEHCBADCFAFCHBBBDBDBFDFBDDDBDBFBFDFDDDDBFDDDDBBBDBDDDDBD@BBDFBDDBBBBDH@BBDBFBDADABCBCBGBABAB@FBBBBDBB@JBF@DBDBBDDDD@FADBFBFDBBBBBBFDHBDBF@D@HAHAB@HBB@BFDFBD@DAFEDADAB@B@FBH@@BBFDFDDBDD@BBBBBCDCBADADDDB@FBDBBBBBDDBFDDBBJB`OTC`MDCJQX[BG@EACBEBEJENKFCDCDCBCBKBGDGHGSJA^UDCDG@GDCFCPCJAJAH@HAH@DAH@D@D@H@J@FAJGJKpGCTFNDJBLBR@J@JBL@J@F@AtCJ@HAJCdOVGLICQY_NO`ONCzGR@RAJLCJEHMJQDMDKHGHELGFCJAfFPJHLDLGNGJELEHAHCDADAHEJAF@B@F@D°hzT`FJH@HBV@P@xD¶@\\CFAJ@ZCJA\\@fI[^Mp_FCnUZB^AZKbKnCHAFCFCDCFCBAFAJ@HAFCJCJGVCNCTARClGVKDMEOAQ@GBEDGPMJEHCLBHBHDLFJFHDJFNHxZNDPBV@LCJE^[BEDW@IBGBCDCHEDEJCPGLK\\WQNAJCHAFAFADADCFEDCAAGGACAAGCGECAAACC@A@EBCBIDGBIBC@EAAEECCECGCAAEA_EeCSGICMQCGAKAIBIBADOBIAIBKHG@CFAJCHBJBHBF@FBDBDBBBFBDBBBDDBDDDBBHBFBDBP@ZBL@F@FBBBFFBDNJLJB@JBD@DARCDAR@D@MGEAAAKGGECAICKCECKEAAGAGAME@GAACAAAC@AA@AA@@A@@BA@E@AAAG@GACAECCC@C@A@C@CBABAHG@@AAEGCCACEC@ACCCAGCGACACABA@ABCAACACAE@EAEAECCACAAAACAACACACACACAAA@C@ABE@CBC@CAA@CBC@ADCDC@C@AA@E@E@GBGBE@C@CACEC@EAEAE@EAEAAAAABC@A@ABCJKBEBCBC@AAAAACAEAC@CAGCA@ACCC@@@AACAACACAEAE@CACAEACAEAACCCCACCEACCECEACAACCCAEAA@A@ABAFAFAFAFAD@FAJAB@DADABA@A@AAEAEAC@C@C@@DE@@FAFADADCDABCBCDAB@DADCBABCBABA@A@CBABAFC@@@AKA@AEAC@CACACAC@CAC@A@GBABCBC@G@E@E@E@C@CACACAC@GAECAAAAAABABCDADAFADADADA@ABA@C@C@ABC@ABCBADABCBEBCBC@A@A@@AA@@AC@A@AA@@ABC@ACE@AB@@@DC@@@ACI@A@@AA@@A@CC@@@C@@@AB@DA@AAA@@@@AA@@@AA@@@@AAA@@@A@@AA@@@AC@@AA@@@@@@E@A@@@A@AA@@A@@@AB@B@B@BA@@AC@@@A@@A@CC@@B@F@B@B@B@H@B@@@B@@@B@@@BA@@B@@@B@@@BA@@B@BADALEBAB@@AB@B@B@B@D@B@@@B@@@D@DABABABA@CBC@A@E@CBG@G@M@CBIPSHCDCBAFAHAH@HBB@B@B@B@B@@AB@B@@@@@B@B@BAB@@@B@BA@@B@BA@@B@@@B@@@B@@AB@DA@@B@@AB@@@FBB@B@J@D@DA@@B@B@@@@@BA@@B@B@H@P@F@B@B@BA@@B@@@B@@@B@@AB@B@B@@@B@@AB@@@B@B@@A@@B@B@BADA@@B@@AB@@A@@BAB@@@@A@@CA@AA@@A@@@@@C@A@@@@BA@@B@D@@@@A@@A@@CB@@@B@B@D@@@BA@@@@@@@ABAA@@A@@@@@C@@@AB@@@BA@@@@@AAABAB@@@@A@@AAA@@@@AB@B@@@B@@BB@B@@A@@B@@A@@@@BAAA@@@@@A@@B@D@B@BA@@@A@A@CB@@A@AA@BC@@B@BA@AAAA@AC@A@A@@DAB@JAB@BBB@B@B@BABAD@@BDAB@B@B@@@BB@@B@BAB@B@B@DB@@B@@@BA@@@@B@B@@@D@B@@@B@@@@A@A@@@AB@D@DADAFC@@@AB@BAB@BA@@@AB@BBB@D@DADABA@A@AB@@ADADABAB@B@B@@@@A@AB@B@B@B@BA@@@A@A@A@@@A@AB@BABCBC@A@@CGECUOSKOKKCCAG@C@KFEDE@C@GAeKeK"],encodeOffsets:[[128748,53608]]}},{type:"Feature",id:"232721",properties:{name:"呼玛县",cp:[126.652396,51.726091],childNum:1},geometry:{type:"Polygon",coordinates:["@@MACEGGKKIGGEKI@I@UGKMEQCII@CD]AMWBM@wMS@SAMEMC@I@OECWCSCS@OAYAYBgFOB[CQCCAIKEMICUAMBUDOJ_CMC_OM@SFMD[BYEWISKUICAK@E@@@KBMBYFSFQHOHEJDHBBIFKBU@UAWFSFUHKNOFGAG@MAUAE@CDUPUDUBOFIDQJUBQAGAYEA@EAICA@CAA@CA@@AA@@A@@@@@@@@@@@EAWDCDOLSRIFSVCHGRCN@LFNDPBJGNOJOLUFWFO@IBOHINAJDJDNCLOH]FMHAREHMHCDEHBDRHDDNLJJ@BDN@NCBUJQPDHHHHBXHHFBDBDAFADKPIH]RYJ[N@BBLBDLJTNLJGJMRGF]NKFENBPENkvIJKLILGLCT@NENINEJCBUH@B@DBBTXPPFLBNGJCLAJGNMNEJEJKLQNAB@@AN@BFL@RELGRCROJKFKHDRJRXNJJBH@HALITBLBNBPBJCHAN[BgEQEMLKJ@FAHKNAL@ZCNKPGL@PA\\SHYDC@AD@BbBD@DBF@D_fAD@DBDB@\\DZ@DHH\\DfHVHDJJLr`DBDJBVAR@JERKRDJlNFLENlHxF\\NNRd@XDRPHNRPVD^B\\CZB^FDAD@DAFAHADADAFABADA@@@@DBDBB@B@BBDBBBDBBAB@BAB@B@HDHBB@BBBDBDBBBBD@DBB@FDBBD@H@FBB@D@BBDBBBF@B@D@XDZDPDVFRDVDb@BBPRJDRBHA`WFCXAxDPFfXJF\\JXDXJ^HlGVG@@DCBCBC@A@@@AAA@AAAAAA@AACAEAA@DGAA@AAA@A@@@A@@@@D@FBD@FBFDD@B@B@@@BA@A@@BAD@D@BAB@BAB@B@FBB@@@@AA@@CAA@A@A@ABADCDA@ABADAB@DCDCBA@@BAB@F@BAB@BA@A@@D@FA@AB@@A@A@CAA@A@@BCBC@@BAB@@@D@HBJB@@@@B@@A@ABABCFCFGJAVNNCLATFnPXJFBNJRFjFXHhVhL~JRD`DDBTNBBBNFHDHNH`DPBhBNBVBJAL@^AXFFHDFBH@N@B^BJ@HFNLDFFFFBF@FEHED@HAZA`D\\HVH@FANSHAJ@jFLALGVKXCVGLGLGJKFIHIXQH@J@BDBBBD@B@@BBB@F@B@B@DDBBDBBBDB@@@D@BBBBBHFJDJD@BB@DAFAHCHAJ@DADABAD@DBF@DAB@D@B@B@BBB@F@D@DABAD@D@B@DBB@D@D@D@B@NAB@BAFCBADCBCBC@A@A@AB@DBB@B@B@D@JAD@BABABEB@BA@@@A@@@AAAAA@A@@@A@@@ABC@A@AAACACAKCIAEAAAECA@CAA@@@@C@@@C@AAAACBABAFADAB@@A@CAA@CA@@AC@G@SBC@EAEAECEAACAABI@EAA@EBC@A@@AAGAA@CAAAAAACA@A@CACAA@A@@@EBCBC@C@ABC@CB@@@@@@@AAE@CCE@E@CAAACAAGCCCA@AA@A@A@A@C@C@ACE@A@@@A@@BADA@@@A@A@A@A@ABA@EB@BA@@BA@@@AB@FADABABAH@DABAH@F@D@FDFDJ@J@DAHCHAD@FAD@@AB@BA@AA@ACCA@AAABAB@BAD@F@HBFADADABCDCB@BADGB@L@HBFBHBHA@AAC@A@@HAJ@FBBB@@@BDBD@DA@ABA@AF@P@DBHDB@D@JAFAD@D@@BD@HH@BBBDBD@BALCH@D@BDJEB@BABA@ABCBADKDGBIBE@@AC@AHKBACG@CBCDCJGBA@@@@BAAA@A@A@A@A@@BADABADCJE@ABA@@@A@A@@@CAACA@A@@FBB@FBD@FAB@@BB@BDDBDBHBF@DBF@BBDBÀX^H`PJJb\\PJHDXAVBDJDDDJDFDJJHdLTFN@tALBDAH@F@F@H@DAD@DADBBBDBD@BBFBD@D@F@F@F@FAJ@FAF@B@F@DBDBBBD@D@F@FAH@FAFAFCDABADCD@D@PDDBDBBDBBDBDDDBHHBDDBBBDBDBDBDBDBBDBB@DBDBDBBBFBF@@BBD@FAD@HAJAH@FAH@D@HBD@FBFDDBDDDF@@DBDBADABCDAD@B@DBD@B@D@BAF@D@D@DBBDBBBFBFBHDFDHDHDDBF@FAB@FENIH@HADAFAFADCFCFCHCHEDADCFCFCDCD@DAF@H@F@H@H@D@DABAFCB@F@HBFBDBFAF@D@FADADCFEBABAD@DAFBHBFBD@@A@C@C@AJI@A@AACACKM@AAAB@DBLBF@F@H@DADCDCDADAhBP@X@F@F@DCHE@@DEFI@AACAACACCGAEAICEAA@GAEACACA@E@A@C@CBC@CBCBABCBADAFAD@FAH@FBDAF@D@DCDABCDABADAFALADADAFADA@ABCAA@C@AAACAEEACAIAABCDEB@D@T@D@JFDFBFDBD@H@NDR@JBR@TALBL@F@JFT@J@F@FBD@DAFCBAD@NAN@H@J@RAB@PDB@D@D@FBBBB@@@B@@@BB@@B@@@B@B@@@BBB@D@DAD@D@B@B@B@B@@AB@@@B@@@BAB@BADA@@BABADABAB@B@BA@@B@@@B@@@B@BA@@B@B@B@D@B@H@B@B@B@B@B@BBL@B@B@B@H@B@@@BB@@B@@@B@B@@BB@@@B@B@B@@@DBB@@@B@@@BB@@@@B@@@B@@@BB@@B@@@B@B@FBL@B@@@B@BBLHB@D@@@DCDAD@FAB@H@FBB@D@@BB@@@B@B@@@B@BBB@@@B@D@B@B@@@B@D@@@B@@BB@@@B@D@F@B@B@B@@BB@B@B@B@BA@@B@B@D@B@B@B@B@@@BBB@B@@@B@D@B@B@BBB@B@B@@@B@@@BB@@B@@@@D@B@@@D@@B@BBB@@B@BA@@B@@@@AB@@@DB@@@B@@@B@@A@@D@B@@@@B@@B@@B@B@B@@B@BBB@BB@@@B@@BB@@@B@@BB@@@B@@B@B@@BB@@@B@@BA@@@A@@@ABA@@@@BB@B@FB@@@@@B@@@@@BB@@B@@B@@B@@@BB@@@DB@B@B@@@B@@@@DB@B@@@@@@@B@B@B@@A@AB@@@DB@@BDB@@@D@@A@@@AB@@A@@BA@BB@B@@@@DB@B@FABA@@@AB@@A@A@A@@@@B@@@@BB@@@@@B@D@BADAB@BA@A@@AA@@@A@ABAD@B@@@B@@CDC@@BA@A@A@A@@@@BAB@@@BF@B@@@BB@@BBA@@B@@@@@B@B@@B@@@B@@AB@@B@D@B@D@@A@@A@@A@A@@@@DB@@B@@BBF@@@BBAF@@@BA@BB@@@B@@@BA@@B@@PPDDD@B@D@B@NMBAB@BBB@DDADBB@@@@@BB@DBDBBB@B@@BBD@@BB@@@D@B@B@@@@B@@@@A@@@AB@@A@@@A@@@@BA@@@@B@@B@BB@@H@@@LL@@AB@@@B@@@B@@B@@BB@@@B@@@D@F@BB@@@@@B@@A@@@ABA@@@ABB@@@BB@@B@D@D@@@B@BBB@AB@B@BD@@BB@@B@@AB@@A@A@@BBBB@@@B@@@BBB@AB@@@@A@@B@@@B@B@BDDBBHBD@@@BAA@@@AA@AHA@ABBB@@@@D@@B@@@B@H@BAB@B@BBFDBBFD@@B@B@BBABB@@B@@B@@@B@@BB@@@B@B@B@BA@@B@B@B@@BB@@@@BB@B@B@@@DA@@@@BB@@B@B@@AB@@@B@@@B@@AB@@@B@B@@@@BA@@B@BB@B@B@B@@AB@B@B@@@B@B@@AB@@@B@@@B@@@BA@@BA@@@@@@BAB@@BB@@BB@@@@@B@@@B@@AB@@@D@BB@@B@B@@@@@BA@A@@@@@@B@@@@@@@B@@@@BBD@@@BB@@@D@D@@@B@@AB@BABB@@B@@@BB@@B@@B@BB@FBB@xyvF~HKFMFSFKDC@CBADADCFAB@D@D@BDBB@DAH@D@F@DBDBPJBBB@DADAFADCRIB@D@D@D@JDLFF@DBH@bEF@FADBH@DDFDBBB@B@D@BCHGDAHAFAF@H@HBDBF@D@D@B@BCDE@@F@L@\\FTDfLfLHBD@F@FCLED@H@DBLDPLTLVPFDDH@@@BADADABA@@B@B@@@B@B@B@@ABA@A@A@A@@B@B@@A@A@A@ABCBCB@BA@@B@BABCBCBC@A@AAA@@B@@ABA@ABA@@B@@EDCBCBC@A@@B@@@B@B@@A@@@A@C@@@A@A@@@@@AB@@A@@@CAA@A@A@ABA@@@AA@@A@A@A@CB@AC@ABABA@A@A@AAA@IBA@CB@@@B@BBDB@BB@BABA@@@ADB@@B@BA@@D@B@B@@ABA@C@A@@@@B@@@@BBAB@@@@@BA@@@@BA@A@@AA@@@A@A@@B@@B@BB@@@B@@A@ABBB@B@@@@AB@@A@@B@@@D@@@@@BB@AB@B@@@@@@AB@@C@A@A@@@A@@DB@@@@B@@C@A@@@AB@@@@@B@D@@@@@BB@@BDB@@@B@@A@AB@@@BA@@BA@@@CBABA@A@@@@BA@A@@@A@@BA@@@A@A@A@@BA@@@A@@@A@@@ABA@A@E@O@G@A@A@@@AB@@@@A@A@@@CBC@I@A@A@EA@@A@@BA@@@CBA@@BA@@@A@@@A@@@ABA@@@ABA@@@A@ABA@A@@@@@A@A@@BA@A@A@A@A@GAG@GBEBABCDGDOTAJ@D@N@HAH@D@F@BAD@DABABABCBC@@@A@@@A@C@A@A@A@A@@BA@ABKFCBABA@@@AB@@A@@@A@@@AB@@A@@@A@@@A@G@A@A@A@E@A@@@DDB@@@@B@@BD@@ABA@A@A@@B@@@BB@@B@B@@@B@F@@@@B@@BD@@B@@BB@@@B@@BB@B@@B@@B@@BB@@@@BB@BCBA@@B@@@D@@DDB@@@BB@@@BDJ@B@@CD@@A@@BDF@BAD@BB@@B@BBD@@BB@@@B@BADADAFADCBABAD@BAD@B@D@DAB@BCBCBCBEBCBCBADABBBBBBBFDHBD@DBDBDBD@F@F@F@H@D@DABAHAB@D@DBD@DBDBDBD@FB@BLB@B@@EDABAB@D@BABABADABCDCBA@CBADADCBCDCBEBEB@@CF@@@D@DBDBFBF@B@BABCBCBA@IBEBC@EBEBEBEBAB@B@BBBBFDDBDDBFBFDDDFBDDDBDDBDFBDBFBDBDBF@FBDBDBBBBD@B@@DDBDB@HDDBD@FBDBBBBB@BADADAFILAD@B@BADBBBBFBFBF@FBFBD@DFDBD@F@HAHAF@F@B@@B@DCDCD@BAD@DBB@DAD@DAF@B@DBBDBDBDBDBDBBBBDBBDBDBFDFBFBF@DBDBBBAD@BABDBDBHBHDDBDD@BFDBDDDFHBB@@GHABAB@D@D@B@DDDFDDBHBH@BB@B@FAB@@@BB@@BBBD@BBDBBB@HNFHBHBBBLFFDLDJDDBHFLHBBFBNHB@F@FBH@HBNBFBH@J@FADADAFAFAFADCBCCKAO@IFG^]LI\\ObURKFCFCDCDABC@EDEDIDGBEBCBEFITEFADADA@ABCBEBCBCDCFEBEDABCDAF@D@ZABBJDTRX^DNJH`HXBJ@DADAD@DCJCFCFAHAFCFCFCFC^KHAFCFCFCFC@C@EBCACAAGAEAyC{MECECAA@A@CBCLYBEDCDEFCFEHGFEFMBOASCGGEGAs@DQ@GCIE[[CECWAWJOLSTSmRGNANBLDHFBBLRBJFLDDV@bIPGmpYPKzeDCFKDECEEICECCEIQ_CIAOBINIPETDdLLBLBh@FAD@DABAFCDEHGFIDGZUVSdUXWFIHKNSDE@C@EBEBEAEAEAE@CEKAGMKUEECGACCCCAC@EAE@CAEBG@EFILO`KF@^@@VA^EHAFAD@DCDEBEBA@E@EAECEAAG@I@WJOBOEqUeIcSMGIEECCACEECCCC@GBE@C@ADCHG[vI^AH@J@HAJ@FAFCHCFCFCFAFADA~OzE\\C[TIPENEHCDEBC@GBEAE@CAGIKGMACCIMaACCEEECAAAGCECGEAEEIJOCECEECG@iFKBO@eKUOGO@G@GBET[VY@IKa@YRUTMfCHCNILUHMTQ\\UXUPYHMXwDEJGFEZS`qLKfQTGPEHEFEC[DKPIXE`BXBXIT]EWMQAOBGFGLWFIFIDK@KW]ICKASGWIYOCEEEAEBEBE`]FCFApO¤ULAPCvOhQHCFADCDE@OSYk_QY@KAMAEEC]_AGPW`SnOLAhALACTCLMT[Lc@UMUgOHcDU@KAKIWUEIGO@IPJO`[lU^EPAL@Z@JDZZ@NENSV}bEJAHDNDDRH~PnFrBNAPEFEDOAOCUIQeeQQmcCGCKKSIOO[GIBIDODEBOPqH[DWBmDmBiJKpKkvkLQZcSEE@@AAAA@@@@AA@@@@A@AAA@A@@@@@AA@CC@@@@@@@C@A@@@@@A@@B@@@BA@A@AAAAACA@A@@AAAAAA@@@AAA@@AAC@AA@@@A@@@AA@@@BA@AB@@AAC@A@C@@@A@AB@@A@A@AB@BABA@ABA@@@AA@@AC@A@A@CAECCAA@@@A@A@CBA@A@@@AB@BA@@BA@@@ABA@A@CBA@A@C@@@AA@@@AA@@@AAEAEAAAA@EAGAA@I@I@I@K@IAI@K@I@IAKAIAIAI@KAIAKAIAC@G@I@I@I@K@I@I@K@K@I@I@I@I@I@K@K@EAGAC@@@A@@@@@A@@@@@A@@@A@@AA@@@EBO@A@A@A@CA@@A@G@I@E@C@GAGAGAIAE@IAGACAE@C@C@E@GAE@A@A@E@CAEDEDEDEBEDEDEAGCGAGCGAGC@@KCEAEBI@GBGBIBGBIBEAG@G@IAG@E@IAG@IAI@C@G@I@EAI@G@G@I@G@I@G@C@E@A@C@A@C@E@C@A@@@C@I@E@IAI@I@G@GAA@ABC@ABA@ABA@@BA@@BA@@BA@@BA@@BA@@BA@@@@B@@A@@B@@A@@B@@A@@BA@ABED@BA@AB@@@@@@@BA@@@@BB@@B@D@@@B@B@@@@A@ABA@ABA@CDA@CBA@A@A@A@A@A@CAC@C@CAQ@ABGDGFEBADGDGFEFGDEFGDGFEFGDEFCBCBGDIFIDIFGDIDGDED@B@BBB@B@B@@@B@@@@A@@BC@@@ABG@EBEDG@C@CBA@@@@@A@@@A@@BA@G@CAM@EBIAA@E@I@@@ABEAA@C@ABC@A@@@E@A@@@ABA@@@@@A@@@A@AB@@A@@@A@ABA@@@A@@B@@A@@BCBA@@@A@@@ABAA@@EBA@@@@@CBA@@@AA@@A@A@A@@@A@ABAA@@@BA@AAA@A@A@A@A@@@A@@@EA@@@@A@@A@@AAA@@AA@EC@@AA@@@@@AAA@A@@@A@@A@@A@@CAA@AAA@@@AAA@@@@A@AA@C@@A@@A@@A@@C@A@@A@@@@A@AAC@C@AA@@@@@A@@@AA@@A@@CAAA@@G@@@A@AA@A@@A@@@@A@@A@@A@@@@AA@@@@@AA@A@A@@@C@AB@@C@@@A@AB@@BD@@AB@@A@A@A@CBA@@@E@A@@@AC@@AA@AA@CAA@@@ABAA@@A@A@AB@BA@@@A@A@AB@@CBA@@@C@A@@@AA@@A@A@A@A@@@A@@BA@A@A@A@A@@@A@A@@A@A@C@@BA@@@@@AB@@@BA@@@@@AB@@@@A@@B@@@@A@@BA@@@A@E@A@@B@@C@AAA@@@@@AAA@@@CA@@A@C@A@@BA@@@A@@E@GEEEECECCCCCECCCAAECCC@@GAA@C@C@C@A@A@A@A@A@@@A@A@A@A@EAA@@@A@@@A@@AA@@@A@@@A@E@A@@@A@@@@BA@@@A@A@A@G@@AIA@@A@AAEB@@A@@@CBA@A@AB@BAAABAAC@@@A@EBEBA@@@@@A@A@@BA@A@@@A@@BCAC@@@A@@@A@@AGE@B@@AFBDBB@BFBDDFBDBBBB@BBD@B@BABAB@AC@AAC@A@A@@BAD@B@B@BBBD@LBD@BAF@BBB@DBDHFBB@DBD@B@@B@BBHAB@B@BB@B@BABAB@@@BB@D@@@BBDBBBF@D@F@BBB@@BABA@E@A@ABAB@@BBBDBB@D@BB@@BBD@B@BA@@BC@E@AACAAA@@@A@@BAA@@ACAA@A@A@AB@B@B@BA@E@A@@@@B@@@BA@A@C@A@CBA@CBA@@B@BBBDBFBBB@B@@@BABCD@B@B@@D@F@BBB@BB@BAB@@A@A@CAC@CBIDC@@B@BAHABCD@B@@BB@@DBDDB@FBFDBB@B@@ADCDEDAD@B@B@BB@B@BCBCB@BAB@DBBB@@BD@@BB@@@B@@CDA@@@C@C@A@CBABAB@B@BBBD@F@B@BA@@BABABAB@DAD@DBDBBDBDADADCDGBEBC@EACAA@A@CBEDEBEBCB@B@@@BB@FBBB@B@@ABC@EAC@ABCBE@M@GAA@AB@@BBB@B@@@BBBBBBB@FBFFDB@D@@ABA@A@A@CAA@AAC@EBC@GBABEFIFKFGDGDGBA@@B@@BBB@D@BAB@DAF@D@DB@BABA@@BA@CAC@@@C@CBCBAB@B@@@BB@D@B@B@BB@@@BAB@@@B@@BBF@F@B@BBB@@B@@CBABAB@DBBDBB@@B@BA@ABABBB@BDBBBB@@B@F@BABA@A@A@AA@CAA@AAAC@ABAB@D@BBB@BADABEBEBC@CAAACAGEAAAASIAACACCCAC@EBA@@AA@C@C@A@@@@BD@B@BBBB@@ABG@ABCBA@ABA@@BB@@@B@@@BA@@B@B@BBB@@@@BC@A@A@@@@B@@@B@@@B@@BB@B@@@BA@@@A@AAA@A@A@CB@B@@@@@BB@DA@BB@@@AB@@@BB@@BD@B@@@@B@@C@ABABC@ABA@A@AACC@@A@@@@B@B@@@@@BC@AB@@@@@BB@BAB@@@BBB@@BABA@EBA@AB@@ABA@EBA@@@AAA@AA@@A@@AAAA@@@A@@B@B@@AB@@ABA@A@@A@@B@BAB@AA@@@@A@@@AB@@A@CAAA@@A@A@AAAAA@CB@@@B@@BBD@@B@B@@ABE@C@C@CA@@ABA@CAA@@B@B@@@BCBAAEAA@A@@B@BB@B@@@@B@BA@CBA@AB@B@@BBB@BAB@B@@B@@CDAB@B@BB@BA@@@AB@@AB@@BBBB@FAB@B@@B@BABABA@A@AB@DABB@@BD@DAB@BB@BAB@BA@C@CB@@ADCB@@@BB@BBB@@@@BA@A@CAA@ABABA@ABAB@@BBBBDD@BBBABEDCB@@ABBBBB@B@@ABCBA@AB@B@B@@BD@B@B@B@BABCDCBAB@B@B@@B@DB@@BB@BABA@E@ABA@@B@BBBBB@@@BA@A@A@C@AAAAA@AB@@ABA@@B@BB@BB@@@@@@AB@@@@@BA@@B@@@BA@A@A@@@C@@@AB@@ABABA@A@A@@@ABA@A@AA@AA@A@A@@B@@B@BB@@@@@BA@EBABC@A@@@AAB@@@@A@@A@@@A@@@A@A@ABA@E@AA@@A@A@A@@@AAA@@@AA@@A@@@ABA@A@AAA@@@@B@@@BA@@BAB@@@@AA@@A@ABABA@AA@@@@@AB@BA@@@@@@CAA@A@@B@@@@@@ABA@A@C@AA@@AB@B@@@@AA@@A@AA@@A@@@@BA@A@A@A@@@@A@@@A@@A@@AA@@B@@@B@@@@@BA@A@A@A@ABCAA@AB@@BB@@@B@@A@@@AA@@@AAB@@@@A@@AA@ABB@@B@@A@@@ABA@@@AB@B@@@@@@@@@@@@@B@@@@A@@@@@@@A@@B@@@@@@BB@@B@BBB@BBB@@@BB@@B@@@@@B@@BB@@B@@@@@@@@@B@@@@@@@@@@B@@@B@@@@@@@@B@@@@A@@@@BB@@B@@@@B@@@B@@@@@@@@B@@@BB@@@@B@@@@@@@@@@@@@@A@A@@@@@@@@@A@@@@@B@B@@B@@@@@@@@@@A@@@A@@@@@AB@@@@@@@B@@B@@@@B@@@@@B@@@@@@@@@B@@A@@@A@B@@@@B@@@@@@@@@B@@@@AB@@@@@@@@@@BB@@@@B@@@BB@@@@@@@@@BA@@@@BB@@B@@@@@@@B@@@@@@@@A@A@A@@@@@@@@@@B@@@@@@@@@@A@@A@@@@AB@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@A@@@@@AB@@@@A@@A@@A@@@A@@BA@@@BBA@A@A@@@ABC@@@@BBB@@@B@@A@AAA@A@@BADAB@@@@B@B@@@@@@@@@BB@@ABA@@@@AB@@@C@@@A@@BB@@@@@A@AB@@A@@BB@@@B@@@@@A@ABAA@@@@AB@@@B@@@@B@@B@@C@@@@@BB@@@@@BA@@@@@CA@@A@A@A@@@A@@BAB@@@BBB@B@@@@B@@@B@@@@@@@@B@@A@C@@@A@@B@@@@B@B@@@AB@BC@A@A@ABA@@B@@@B@B@@C@@@A@@BC@@B@@@@@@@B@@@@ABA@@@@@@@@BD@@@@@A@CBA@@@@@@BB@B@@@@@AB@@@@BBB@BB@@A@@@A@@@@@@@@@A@@@A@@AABA@@BA@AAA@A@AB@@@@@BB@@@@@@@A@@B@@A@A@A@@BB@BB@@@@B@@@D@B@B@@@BB@B@@BB@@A@@@@@A@@A@@A@@BB@@@AB@@A@A@@@AB@@@BA@@B@@@BBA@B@@@@A@AB@@@@B@@@B@AB@@@B@BCACB@@@@AA@@A@ABB@@@@@@@A@@@@B@@B@@@AB@@@D@@B@@A@@@B@@@@@@BBB@@@@@@@C@@@@@@BA@@B@@@@AAA@@B@@ABBB@@B@@@@@AB@@AA@@@@AB@@B@@@@BAB@@B@B@@@AB@@@@@@@@A@@AABC@A@@@@BB@@@A@@@@@@BA@@B@@@@B@B@B@@B@@A@C@@@@@@BB@@@B@@@AB@@@@B@@@ABAB@@A@@@A@A@A@@@@BB@B@@@A@A@A@@@@@AAA@BA@@A@@@A@@BAA@@@@@@A@@A@@@@@B@@A@C@AB@@@@@B@@A@@@A@@@@@A@@@A@@B@@@@@BA@@AA@@@A@@B@@@@A@@@AB@@A@@A@@AB@@B@@@@B@@@B@@@@A@@@@@@@B@B@A@@BA@@@@@@@@@ABAA@@@BB@@@@@A@AB@@B@@@ABB@BA@@AB@B@@@@C@B@@B@@A@@AA@@BA@@@@@A@@@@@@@@A@@ABA@C@AB@@@@@B@@@@ABA@@@@@B@BBB@B@@@B@@@@B@@BB@@@@@B@@A@A@A@C@AA@@@B@@C@@@@@@@A@@B@@@@AAA@@B@@@@A@A@ABA@@@BB@@@@A@@@A@@B@@@@B@B@@@@@CB@@@@@B@AA@A@AB@@BB@@CB@B@@@BBB@@@@@B@@B@B@@@AB@@@@@@A@@@@@ABAA@@A@@BB@@@@@A@@@@B@@@@@@@@A@@@@@@@@B@@A@@@A@@A@@AB@@@@A@@@@@A@ABA@@@@@@@@B@@@@@@@@B@@BA@@@@@@@@@@@@@@@B@@B@@@@A@@@@@A@@@A@@@@@@@@@A@@@@@@@A@@@A@@@@@@B@@@@@@@@@@@@A@@@@B@@A@@@@@@@@@@@BB@@@@@@@@@@@@@@A@@@@@@@A@@B@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@B@@@@@@A@@A@@B@@@@@A@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@A@@@@@@@@B@@B@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@A@@@AB@@A@@B@@@@@@@@@@@@@@B@@@@@BB@@@@@@@@@@@@B@@@@@@@@@B@@@@@@B@@@@@@@@A@@@A@@@@@@@@@@@@@BB@@@@@@B@@@@@@@@@@@@@@@AB@@@@@B@@@@@@@@@@@@@@@@@@BB@@@@@@@@B@@@@@B@@@@@@@@B@@A@@@@@A@@@@@@@@@@@@@B@AB@@@@@@@@B@@@@@@@@@@@@B@@A@@@@@@@@@@@AA@@@@@@@@@@A@@@@@@B@@@@A@@@@@A@@@@@A@@@@A@@AB@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@A@@@@@AA@@@@@@@B@@@@@@@B@@A@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@@@A@@@@@@@@@@@@A@@A@@A@@@@A@@@@@@@@B@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@B@@@@@@@@@@BA@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@B@@A@@@@@@@@@A@@@@@@@@AA@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@B@@A@@@@@@@A@@@@@B@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@B@@@@@@B@@@@@@B@@@@@@@@@@@@@@B@@A@@@A@@@@@@@@@@@B@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@A@AB@@@BC@@@@@AB@@@@A@@B@@@@@B@@@B@@@@@@@B@@CA@B@BB@A@A@A@@B@@ABA@@@@@BB@@A@@@A@@AA@@@@@@B@@@@@@A@@@@@@@A@@@@A@@@@C@A@@@@BB@@@A@A@@BAA@@@BA@AB@@@@@@@BB@@@@@B@@B@@A@@@@B@@A@A@@@@@@@@@A@@@@@@B@@@@ABAB@@B@@@@BA@@@@BB@@@A@@@B@BBB@@@@@@@@@B@@B@@A@@@@@F@@@@@AB@@B@@@A@@@B@B@@B@@@@A@@@@BB@BAB@@@DD@@@B@@@@@BAA@@@@A@@@@@@B@@@@AB@@B@BB@@AB@@B@@BA@A@AB@@A@@BA@A@AA@@A@@@B@@BA@@@A@@@@@@AABAA@@@BAB@@A@@@@@BB@@A@@B@@B@B@A@@B@@@@@B@@A@@@@@@@A@@@@@@@AB@@@@@@AAABC@A@AB@@@@@BD@@@@@@@A@@BA@@@B@@@@BCBA@A@AAAA@@A@@@@B@@ABA@@@ABA@A@ABA@@@@D@@@@BBA@ABBBA@@BAB@@@BA@B@@@@BA@ABA@@@@B@@@B@@@@A@@@@BA@@@A@A@AB@BAB@@ABA@A@@@@B@@A@A@AB@@@@@@AB@@B@AB@@@@BB@@@@@@@B@@@@ABC@@@@@@@@@@B@@@@A@@@@@A@@BB@@@ABA@A@AB@@A@ABA@C@@@@BA@@BA@B@@@@@ABAB@@AB@AA@@B@@A@A@@B@BA@@@@@A@@@@B@@A@@B@@CB@@AB@@@@@B@@B@@@@B@@A@@@A@@@A@@@@@@@A@@@@@A@@@@@AB@@@@@B@@@@@B@@@@@@A@@@A@@B@@@@@@@BA@@@A@@@@@A@@@@@A@@@@@AB@@@@A@@@@@@@@BB@@@@@@@A@@@ABA@A@@B@@AB@@@B@@@@A@@@@@@A@@@@@@@@AB@@@@@@A@@@@@@@A@@B@@@@@@B@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@AB@@@@@@A@@@@A@BA@@@@@@@@@@@@@@BB@@@@BA@@@@@@B@@@@@@@@@@@BA@@@@@@B@@@@@@A@@B@@@@A@@@@B@@A@A@A@@@@@@@@B@@@@@@A@@@@@@@A@A@@@@@@@A@ABA@@@@@A@A@ABC@ABA@@BA@@BA@@BA@@B@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@BA@@@@@@B@@@@@@A@@@@@@@@@A@@@@@@@A@@BA@@@A@AB@@@@@@@@@@@B@@@@@@@@@@ABA@AB@@AB@@@@@@@@@BA@@@@@A@@@A@@B@@A@@B@@AB@BA@AB@@@@A@@@@@@@A@A@@@@@@@A@@BA@@@@@@@A@@@@@A@@B@@@@@@@@@@@B@@@@A@@@A@@@AB@@@@@@@@@B@@@@@@@@@BA@@@@@@@@B@@@@@@A@@@@BA@AB@@@@A@@@AB@@A@@@@@@@@B@@@@@@@B@@@@@@@BA@@@@@@@@@ABC@A@A@@BA@@@@@@@AB@@@B@@@@@@A@@@A@@@A@@@@@@B@@@@@@@@A@@@@@@B@@A@@@@@@@@B@@@@@@A@ABA@A@@@@@@B@@@@A@@@@@@@A@@@@@@@AB@@@@@@@B@@@@A@@@A@@@@BA@@@@@@@@@@@A@@@@@@@A@@BA@@@A@@@@@AB@@@@@@A@@@@@@@@@@B@@@@@@@@@@A@@@@@A@@@@@@@A@@@A@@BA@@@AB@@A@@@@@A@@@@B@@A@@@@@@@@B@@@@@@@@A@ABA@@@ABA@@BA@A@@BA@AB@@@B@DAB@@@B@@AB@@@B@@@B@B@B@D@@@BB@@B@@@B@B@@@@@BA@@@A@@B@@A@@@AD@BA@@@@BAB@@@BABCFQHQLYC[GKIGEQJchMFUAGIOAmRQBKCIMEEVQ@IC]EcBAD@X@HALODWBSEMWIMIGGEIECK@QIGGKCKAEReDODIBGAQ[]GGOISA[CgFCAMKGMEIIEQAkCA@oMCCCI@MIEICDKNCREHIFMFSEKIGOGQAQEGCIIWUGIAMBU@CQIWGUBQDYFeHSBWBQBWCOECAAEKgEKAASIWKOEIEG@gOG@iBiAKAIGEAQBCBYVIHCDIHIPONYNODUF_C[BUJ[DC@UAM]IGOA[@YEMGKOAEGWDKDc@I@COMAEMWOOM@SFkHEB_OC@EBEHAJCNBHABA@EAIAOCU@KNKBEB[NWFADCRGLGDmdMFMFM@MASIQIKAWDOFIFIAGCWCEBEFODWCQAQAQCEEIWGGMCQ@g@gD{@QA_G]C_EOIKOQL]NYNCBghCHSXKJQNOBM@QGMCQCQ@SHMFYH]PCDQJMLKJQD"],encodeOffsets:[[126688,52633]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,8 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("哈尔滨",{type:"FeatureCollection",features:[{type:"Feature",id:"230102",properties:{name:"道里区",cp:[126.616973,45.75577],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@AAA@@A@@A@AACAA@A@@@A@A@@@A@@A@@A@A@AA@@A@@@@@@AA@A@@@A@AAA@A@@AA@A@C@@A@@@@A@A@A@A@AA@@@@CA@@CA@@AAE@@@EAEA@@E@CA@@CCCACA@@G@@@AAAB@@EBED@@CB@B@DAB@@CF@@@B@D@@AB@@ADCDAD@@AB@BA@@B@@AB@@@BAB@@@B@@BB@@@B@BB@@@@@@@@@BB@@B@@@B@B@B@@@BBB@B@BBB@@@BBB@FB@@@@@@@BA@@B@@@@@B@@@@AB@@@@@@@B@@@@@B@@@B@@@B@@@BA@@@@@@BAA@@A@A@A@A@C@A@@AA@@@A@@@A@@@A@A@A@@B@@BB@@BBB@@B@BB@@B@@@B@@BB@@BB@@BB@BB@B@BB@@B@B@B@B@B@@BBB@@BB@@BBB@B@B@D@BDBB@@@B@@ABA@A@A@@B@B@@BB@@BB@@@B@B@@@B@@BB@@@B@B@@BBA@@BA@AB@BA@@BA@@B@@@BAB@@@B@@@B@@@BBB@@@B@@@B@B@@AB@@@B@@AB@B@@AB@@A@ABA@@BA@@B@@ABA@@@A@ABA@BB@@BBB@@B@@@BA@@@@@@BA@@@@B@BA@@B@@@@@@@B@@@@AB@B@@@B@@@B@@@B@@@BAD@@@B@@@B@@@@@B@@A@@BA@@@AB@@@@@B@@@@AB@@@@@B@@@B@@@@@B@@AB@@@BA@@BCBB@BBBDBFAD@D@F@FBDBFFDHDH@H@FCFCDEDGFGDCHAHBF@HBDBDBDBFBDBFFHFFDDFBBB@FBDABAFAJ@H@FBF@N@D@L@H@H@NEJGFAF@HBD@LHLBFD@D@D@F@B@B@B@@@BABADABAD@B@D@D@B@B@@@@@BA@AA@AA@@@AA@@@AAA@@A@@AA@A@A@A@A@@@A@A@A@AB@B@@BB@@@BB@@BBBB@BBB@@@BBB@B@B@B@BB@@BBAB@BABA@@BA@@@ABA@ABA@@B@DBBB@D@BBB@D@B@BBB@B@FB@@B@D@BB@@B@B@@@BBB@B@B@BBB@@AB@BA@@BA@@@A@A@A@@@A@@@A@A@@@ABA@A@AB@@A@AB@@AB@@A@@@@BA@@@A@ABAB@@@B@B@B@D@BBD@BBBDBFBBB@B@B@@@B@B@BAB@@AB@BA@@B@BCDAHEB@D@@@BB@@@B@B@@ABED@@AB@@@B@@@@@B@@@B@B@B@DBBFD@@@B@@ABC@@@CHADBDBD@B@B@B@@@@BABAPILMFIBEBBFBD@LANATAD@XAT@HBJB@@LDDBJBFBF@NDPJJFHDB@D@@@D@B@B@FAJEDCD@D@D@NBDDDB@D@DCBABCBABAB@D@FBBDHLJDBD@D@HAB@JAFABA@@DIHEFABAJAJAJDBBHBFBF@H@F@LBB@D@HB@@B@DA@@HH@BHJBD@@HF@BHF@BB@DBDB@@DBDBB@D@BBF@DG@@@@@@BA@@@@@ABA@A@@@@@@BA@ABCBABA@ABA@@@@@@@@@@@@@ABA@@@AB@@@@A@@@@@@@@BC@@@AAAB@A@@@@A@@@@AAAA@@E@AAA@CBC@@@@@BABE@@@A@A@A@@AAAA@@AAA@@@@@@@@@@@AAAAAAACA@@AAAAA@AAC@@@@AA@AAA@@AA@@@AA@@A@A@@@@A@AA@ACA@@@@@@AA@@ACA@@A@@AA@@@@@@@AA@@@AA@AA@AAAAAAA@AAECA@CACAEC@BAB@B@@EA@DA@A@AAECCCCC@@A@@A@@@@AAAA@A@C@C@A@AAAAA@A@@AA@C@@@AAA@@AAAAAEAAA@@AAAAAEGAA@@CEA@AA@CA@@@@AAACAAA@AGBCBCBEBGBABCB@@@DA@ABAAAAACACAACG@@AA@@A@@@A@C@ABA@A@@@@A@@A@AAE@A@A@AA@@AABA@CBE@CAAC@AC@EDMDEBEBCBA@ADAJAH@JABADA@C@EEGEAEAOEIAGAEAEAC@ACACAAEGEGEEEEAAGGACAAEEIE[EM@C@A@@@A@@@A@@@@@AB@@@@A@@@A@@BA@@B@@@BABAB@@AB@@@@CBA@A@@@ABA@@@@BAB@D@@@BAB@@@B@@AB@@A@@@@BA@@BA@@@@B@@@@@@AB@@ABA@A@@BA@AB@@A@@@A@A@@@@@ABA@AAA@@@ABA@AB@@AB@@A@A@A@@BA@A@A@A@A@@@A@A@C@A@EBGDMBEDIFCBAB@@@@CBCB@@CD@@C@@@CBA@@@A@AAA@A@@@AAA@A@A@A@@AA@A@A@AA@@A@A@A@AAA@@@A@AAA@@@CAA@@@AAA@@@A@AAA@A@A@@@A@A@A@A@@@A@A@@@A@@@@AA@@AA@@@A@@@A@@@AA@@A@A@A@@@A@@@@@AAA@A@CAA@A@CAA@A@CAA@@@A@A@C@@@A@A@@@AAA@A@@@A@@AA@A@A@AAA@EACA@@AA@@A@@@AA@@@@BCAA@@@@A@ICA@A@AA@@A@@AB@@@@@@@B@@@DBB@@@@@@@@@@A@@A@CAA@@@AAA@CAA@@@@@@@@@AB@DABADAB@B@@@BA@@@@BA@@@@@A@@@AAA@CAC@A@AAA@AAA@AA@@@@AA@AA@@@@A@@@@@@@A@@BA@AB@@@B@@@@@B@@ADE@ABA@@@AA@@@AACAAACA@@AA@@A@@AA@AA@AA@"],encodeOffsets:[[129262,46666]]}},{type:"Feature",id:"230103",properties:{name:"南岗区",cp:[126.668784,45.760174],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@@@@@@@@@@@AAA@@@AA@@@EB@@A@@@@@EBCDCB@@@@A@AB@@AAAB@A@@@@@@@@@A@@@@A@@@@A@@@A@@@@@@@A@C@@@A@AAA@@FA@A@@@@@AA@@A@@BA@@@A@@BA@@@A@@@@@@A@B@@@@@@@@A@@@@@@BA@@@@@@BC@C@A@AAAB@BAA@BC@@A@@C@@BCFF@@@ABABBBB@@@A@CBA@E@@@@@@@A@@@ABC@AG@BCF@BG@@@@BE@@@@@@@@BEBG@A@@@@@A@@@@@@@A@@@A@@BC@E@@BA@@@A@@CAE@A@AAC@E@CAA@@@A@@@A@@@A@A@@@A@AA@C@@@@C@A@EA@@@A@CA@A@@@A@A@AAE@CAC@A@G@A@AA@ACAA@EAC@CAA@C@A@C@AAAA@@CCAAACEABCBC@AACA@E@A@AAAAAE@C@GDG@E@CC@C@C@E@AA@A@A@ADCDCFCDCBE@I@EB]@CAWDGJSBADC@@A@A@A@A@AAC@CA@@@@A@@@A@AAQEK@O@O@C@IFGDQJYBKIA@@@@@@@A@@@@@@B@@@@A@@@@@@@A@@@@@A@AB@@B@AB@@@@A@@@@A@@A@@B@@@@A@@@@@@@A@@@@@A@@@A@@@A@AB@@@@A@A@@@@@A@@@A@@@@@@B@@AAA@@B@@@@@@@@@@A@@@@@@@A@@@@@@BA@@AA@@BA@A@@B@@A@@@@@A@@@A@@B@AAB@@A@@@@@A@@@@@@B@@A@@@A@@@@@A@@@A@A@A@@@A@@@A@A@@@@B@@@@A@@@CB@@@@@B@@AB@@@@A@@@@@@@@@@B@@@@@@B@@B@B@B@@AB@@C@CBABA@CBA@A@@@@@@@AB@@@@C@@@@BA@@@A@@BA@@@A@@@@@A@AB@@@@A@@@A@@@@@@@A@@BA@@@B@D@N@\\FJFFFBBBDHHBBFFFFFHFHBBBDBDD@FBFBHBJBPFFBFBFH@F@DCBABIBG@IBCB@BABADAFCFCN@FBDD@BB@DAF@DABBB@@BBB@B@F@BBB@@@@B@@B@B@BAD@B@@@B@@@BB@@DHBBBDBDBBBBBAB@@C@@DABAHAFADADAHA@BBBDBBB@B@@B@@DBBB@DF@@BBFHBBBB@BB@BBBFBBBB@@BB@B@@@DBB@@@BBBBB@B@B@D@D@BBBBB@@@@@BB@@@DDDDFDBBB@B@@CFB@@@ABA@AFDDBDBB@FDBBB@BBBBBBB@@BBB@@B@@B@@@@@@BB@@@BB@BD@@BB@@@@@@DB@BBBB@@@@@@B@BB@@B@@BB@@BB@BBB@@@@BD@BBBBB@BB@BDBBBBBB@@@@@@@@@@B@BB@@BBBB@@@B@B@B@@AFAB@@@@D@DAB@BBF@@@BBBB@@@@@B@@B@A@BB@B@@@@D@BA@@@@@@@@B@B@B@BBB@B@@@B@@@BBB@BBD@@@@@B@DB@@B@BDB@@@@@B@@BBBDB@@DDB@BB@BBBB@@BBDB@@BB@@@@@@AB@B@@BB@B@B@FADABAB@B@D@B@@@@@B@@A@@@@@@@@@@@ABEAA@@BAB@@@@@@@D@@@@@B@@@@@@@@A@@@@@@@A@@@@@A@@A@@A@@A@@@@AA@@B@@CAA@@@A@A@CABCB@B@D@B@BC@A@@@A@@@A@@@@NB@@@@@@@@B@LBB@@BD@BA@@@AA@BA@AD@D@@@@@ADB@DB@BB@B@B@@AB@CADA@AB@B@BAB@BA@C@ABC@@@A@@BC@CCA@AAAE@A@A@C@CB@AA@@A@AA@@CE@A@@BA@@@@@A@@@@@BB@@@B@@@@@@G@@@@@A@E@@C@@@A@@AC@@@@@@A@@@A@G@@@@@@@EB@@@@E@@@@@@@@@@@EBA@@@@@C@A@AB@@@@A@@@@@A@AACACA@@@@@@GFAAAA@@@@@A@A@@@B@@A@@@@@@@ABC@A@@@@@A@A@@@@@C@A@AC@@@@@@@@@C@@@@@@@@@@@@CA@@"],encodeOffsets:[[129689,46834]]}},{type:"Feature",id:"230104",properties:{name:"道外区",cp:[126.64939,45.792057],childNum:1},geometry:{type:"Polygon",coordinates:["@@NFHBBBVDND^JFDDBFBHFVNBBDF@@BFBJAFCD@F@DBJAJAF@DADGNKRCLAHBHBFDFJJHBLBHBVCZ@N@NDLFNFRNPLJFLDHFFD@@DNJPBBFHFDRDTBJBTHRPHLFFTNLFLFF@XFbFNDJDDBBBBHD@@AB@@@B@@A@@@A@@@@@A@AB@B@@BBA@@B@B@B@BBB@@@BA@@@@@@DCB@@@@A@A@A@@C@A@@A@@@@@A@@@@BA@A@@BA@B@@B@B@@@@AA@@AB@BBB@@@@A@C@@A@@@A@@@@A@@@@B@@@@A@@@@@@B@@A@@@A@@@@DA@@@@AA@@@A@@@@B@@B@@BAA@@AB@DABAD@BA@@@A@@@@B@BA@@@@AA@@A@AA@@B@@@@AA@@@BA@@AA@@@@BA@A@@B@BAB@@@@@B@@@DC@A@@A@@AB@B@@AFAB@@@BB@@@@@A@@@@@@B@@@B@B@@@@@A@A@@ADA@@@@B@@@B@@@B@@A@@@@@@B@B@@@@@AA@@@@B@BA@A@@BAA@@A@@BA@A@AB@@A@@AA@@A@BA@@B@BA@@BA@@B@@A@@@A@@@A@@@AB@BA@A@@BA@@@AB@BA@@@AB@B@@@@@BADABA@@A@@A@@DE@@AABA@ABABAD@@ABADABABA@AB@@@@AA@@A@@@@BAAA@A@@@@@@DA@@B@AA@@@@BA@@B@@B@B@BB@@@B@@AFGBA@@B@AA@@@AB@@@BAB@B@B@@A@ABC@@BA@@@ABA@@@@B@B@B@B@B@AA@A@A@AB@@@D@B@B@BAB@B@BABA@@@AD@@AB@@AA@@AAA@@@@BA@ABA@@@@B@D@B@@AB@AA@@AA@@B@@AB@BAFBB@@A@@@AAAAA@A@AB@@@F@@A@A@@@AEA@@@@BAD@@@@A@@CA@A@@@@BA@@DBB@BA@@AE@A@@@@B@@@D@B@@@B@@A@@BAD@@@BA@@@A@A@@@AB@BBDBB@B@@AFC@@BAB@@A@A@AB@F@B@B@B@D@@@@AA@AA@@BAB@@AAA@@@AB@B@BA@@@AB@@@B@B@BA@A@@AA@A@@B@B@BABA@A@A@@B@BAB@DABBBB@@BB@@BA@@@AB@@@@BBA@@B@BA@@A@@@@AB@@A@@@@@AB@B@BAD@@@CE@@@A@@B@B@BA@@BA@@@AB@BB@AB@B@@A@@A@@@@A@@@C@A@@@A@A@@@A@@A@A@@@A@A@@A@@@AB@@AA@@@@C@A@@B@BA@@CAAA@@@A@AB@BA@@@A@@@@DABA@AB@@A@@BAA@@@@@@A@@@@@A@@@@@A@@@A@@@@@A@@@@BA@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@AB@@@@A@@@@@@@A@@@@@@@AcYMOQGAI@E@AABA@CFKY]ABA@A@A@A@C@C@EAEBG@GDIDGDIDG@MAG@AA@AE@AAA@AAA@CAECDFFD@BBB@@DD@BDD@@@@@F@@@D@DB@FD@@DDDD@BAB@@@B@B@@@BABA@AAC@@@A@A@A@C@CAA@A@A@AAEAAAEAA@CAEAAAA@A@A@C@E@C@@@A@A@@BAB@@@B@B@D@B@B@@BDBB@B@BADAB@BCBAB@BAB@BAB@D@@@F@F@BAB@@ABCBCBCBABEBIDGDEDA@@DCFAFCHADAB@BBB@@BBBBDBDB@@BB@B@B@B@BA@A@AAE@C@EAK@C@G@C@C@CBA@@@A@G@CBEBC@ACCACAA@AA@C@CBADAB@B@@AAAC@A@A@@AAABE@C@C@GAE@CAC@C@C@A@ADAH@B@D@B@D@BA@A@C@AACCAACAAAA@A@ABAACAAAAECBC@ABCAA@CACAA@ECAC@C@C@A@AAAEAAAA@AC@A@C@A@@@CAAAAA@AAAA@A@A@C@A@@AA@@@@@@A@AA@A@A@@A@ACAA@A@A@ABA@@@ABA@@B@DABA@C@ABCBCDABABCBA@C@A@@BA@@@A@AB@@@BBB@B@@AB@@A@@@CCAA@@C@A@A@A@@@A@CAA@@B@@BBDB@BABA@A@@@AACC@@A@A@ABA@AD@B@@BBBB@B@B@B@@@@@B@@@@@B@@@BBB@@B@B@B@@B@@ABA@C@GAA@A@@@AA@AA@AAAB@@@B@@ED@@A@ABA@A@AB@@AB@B@@@BA@A@A@ABA@@@AB@@@@A@CD@@AA@@AA@@@AA@@AAA@@AA@@@AA@@AA@AA@@AAA@@@A@AA@@AAA@AAA@@@@AA@A@AAA@@AA@A@A@@@AAA@@@A@@AC@@BA@CBCDKDC@GAC@A@@@A@CAC@A@A@@@AD@B@DABA@ABA@A@@BCBDBA@@BA@A@A@@ACAA@BC@@@@C@C@@BABB@@B@@ABC@@AA@KAA@@@@@@@@@MA@@@@@B@@@B@@@BADA@C@A@A@ADDBB@B@@@B@DB@@@AB@@B@@B@@@@BB@@@@B@@@@@B@@@@@@@B@@@@@@A@@@@@C@@@@@@@A@AB@@BBAF@B@@@@@@@@@@@BA@@@@@A@C@A@A@ABCBEBA@A@A@@AA@A@@B@@@@A@@AA@AC@AA@AA@AAAA@CC@@CAAA@AA@@@@@A@ACA@@@CAA@@@@@C@AAA@AA@@A@@@A@A@AAA@A@A@@@@@@@@@ABC@@@AD@@@@@@@@@B@@A@@B@@AB@B@@@@@@@@@@@@AB@BABABAD@BAB@@@@@@@BAB@B@@@@AB@@@@@@CH@B@@D@FBJBJDB@JBBBB@"],encodeOffsets:[[129686,46895]]}},{type:"Feature",id:"230108",properties:{name:"平房区",cp:[126.637611,45.597911],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@@AA@@@@@@@A@A@AA@@CAA@A@@@@@A@A@@A@@@@@@C@@@CAA@A@@A@@A@@@A@E@AAA@C@E@A@@@@@@@AEAA@@@@@@@@@@A@@@@@ABA@@@@@CB@@A@@@AB@@ABABCBA@@@@@C@@@A@@BA@A@@@AAC@@F@B@B@BAF@F@BBB@B@D@B@BAB@BAB@BA@C@@C@EC@@@C@A@@@A@@@CAA@CDABITCHBX@DA^@FJBDADADCDAF@LDLDJ@F@D@BB@B@@A@@B@@AB@B@@@B@@@B@@@B@@BB@@@@BB@@@@@B@B@@BB@@@@@B@@AB@@@BAB@@AD@@@B@BB@@BBBB@D@HAFDBAJEDCB@B@D@BBB@@@@@@@@ADEDEBA@AD@DBDBJBBB@@B@B@FBB@BABAB@B@FBB@FBDBB@BBBBD@BAF@F@F@NBL@HAFBBBBDDFDHDBL@FAF@J@H@FB@@BBBF@BBB@@D@D@B@D@B@D@B@BAB@B@@BB@@BB@@BB@@@B@@@D@F@H@JA@@@@AA@E@@@@@@@EAC@AAA@A@A@A@ABC@A@ABA@CA@A@A@@A@C@A@A@@AAAA@AA@@@@AA@A@A@A@A@@@@AAA@A@@@AA@@@@@@@A@@@CAA@A@A@AA@@CCCACCCC@@@@BA@@@@BA@A@@BA@@@@A@@@@@AAA@AAEECA@@A@C@@@A@ACACAA@ACA@@CAC@C@C@A@AAC@CAA@CE@@AAA@@@AAA@A@E@CA@@@AB@B@@@BA@@BA@A@A@@@AA@@@A@@@@@A@A@@@@@CDA@A@ABCCA@AA@@@@@@@A@@@@@AA@@@CAC@AA@@A@@@A@@@@@@@AC@AAA@@@A@@A@A@A@AA@@A@AAEA@@@@@@@CBC@A@@@CBA@A@AAB@B@BA@@DICA@@@A@@@A@C@@@CBA@C@A@A@@@A@@@AAA@@@@@CA@@A@@@@A@@A@@@CB@@@A"],encodeOffsets:[[129690,46633]]}},{type:"Feature",id:"230109",properties:{name:"松北区",cp:[126.516914,45.794504],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@AE@AAC@A@CACA@@CACAA@@AGE@AGE@@ACGI@AGG@@CBA@@@GAC@A@KAE@G@E@EAGAAAICIBIBABEBGFCJ@@ABEBIBA@GBC@C@CAKICGAA@E@CBABADABADA@C@CCACCMAC@C@C@CDIFEBA@A@C@@@C@A@GCIEOIMCE@EAIACAKC@@IAGAS@WBC@SBMBKBC@EAAAAFEJKNOJABAB@@@@@B@B@BBD@@@B@BBB@B@DBD@D@D@@@B@B@B@@@BAB@B@BA@@B@@ADABA@AB@B@@AB@DAF@B@B@@ABC@A@@@@@@B@@@BB@@BB@BBD@DBF@DBB@@BBBBBBB@DBB@BA@@B@B@@@DAB@@BB@@@B@@BB@B@@@BAB@BABABA@ABCBC@EBCBA@ABA@ABA@ABCB@B@B@@@B@@ABB@AB@@B@@B@@@B@@@@@BB@BB@@BB@@AB@B@B@@@@@@@@@B@B@@BBB@BB@@B@B@B@BB@@@B@B@BAF@D@@H@BBBD@B@@CBA@@FAHD@HJHFFF@@B@@B@@@BAB@@@@@HAJ@BA@MA@@ABA@ABABA@CBCBCBA@CBCBABA@ABA@ABA@A@A@CBA@CBABA@@@ABAB@BADADABAB@DAB@B@D@B@D@BAD@BAB@BABABABAD@@@@@BBDE@@BDBBB@B@@B@@AB@@BB@@BBD@@BBADADAF@BABAD@BAB@B@@@B@B@B@@@BADADAB@B@F@DA@A@G@E@@@A@A@A@A@A@@@A@@AA@C@@@A@AA@AAA@@A@A@GBG@@DH@@@F@@B@@A@A@@@CB@@A@@@A@A@A@@@CB@@A@@@A@@@A@A@A@A@@@A@@@A@@@A@ABAAEBC@ACCCAA@A@@A@KBAA@C@A@AA@AAA@@@I@E@G@CBE@@BDFDHC@@F@JFA@D@@ABC@@@@B@@@@@@D@BB@@@B@B@D@D@BAB@@@BAB@BABBBA@AD@@CDABAD@@@BABA@@BAB@@A@@BAB@BABAB@@@BA@ABABABABABCBABABCBA@ABABA@@BCB@@CDABA@@BABABABA@ADCB@@@@CDABAB@@ADABABABA@CDAB@BAB@@@@A@@@@@AD@@ABAB@B@@@@@@CFCD@@AB@BCD@@B@@APHFBFBFBFDHEFCDADCBAB@B@@ADAFCFEDAB@B@@@B@BBB@B@@@@A@A@A@C@AF@DB@F@F@D@D@B@@@@B@@@B@@BD@D@B@F@B@D@@@D@AB@BB@DBD@@@@B@@AB@DADABC@G@EAADADBBBBBBBBB@B@BBB@B@@A@@B@@@B@@@BA@@@@BA@@@@F@F@D@B@BB@@B@DBF@B@@@@@@@B@FCDA@ABADCFC@AB@BADAD@B@B@B@B@D@FBFBB@B@D@B@D@BAB@@@BADCBAB@B@FAH@FBB@D@D@B@BBD@B@BBB@D@B@B@BBB@@@@@@@@B@B@B@@@B@D@@@B@@@B@@@@@@@B@@B@F@BBB@@@B@@@@@@@@@@B@BB@@@@BB@@B@@@@BB@@@F@D@@AB@@BB@BB@@@@@BBB@B@B@@@D@B@B@@@@B@@@@@@@B@@BD@BBB@BB@D@F@B@@@BA@@@A@@@@B@BAB@@@@@@AB@@@B@@@B@@@@@AA@@B@@A@@B@@@BBB@@@B@@@@@@AB@@@@@B@@@@@B@@BBA@@@@B@@B@@@@B@@@@@@A@@B@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@B@@@@@@A@@@@@@B@B@@@@@B@@@B@@@@@@@BB@@@@@B@@@@B@@@@@@@BA@@@@@A@@@@BA@@@@@@B@ACAA@AAE@EBABAJA@@B@BB@@B@B@B@B@B@DBH@D@B@BAB@B@B@B@@AB@B@B@B@BABBB@B@B@DBBB@@BB@B@B@@DD@@@@D@DAD@D@B@B@@C@AAA@@@E@@@ADCDAHE@A@C@AAA@@@AAABA@@@@B@@@@CD@B@D@B@B@BBBB@@BF@DBDBBBBD@@@B@BABADC@C@CCEAEACAA@@@A@A@@DABCHAB@B@D@D@DBDFBB@@D@DCBCFEBA@A@A@C@A@C@A@@B@@@BAB@@@B@B@B@BAB@B@B@@@H@DABAB@BCBIBCBAB@B@B@B@D@FBD@@@FABA@@FCB@@@B@FBBBDDDBB@B@@@DABA@AACAG@A@A@@BABAB@BA@@BBHBFBD@@@B@FABC@@BA@AAC@@AAA@A@KBG@A@C@A@@@CA@@@@@A@A@C@@BA@@DCFEBABA@EBA@@BAB@BABBB@BBBB@B@@AB@B@@@BBBB@BDDDB@B@B@B@FAFCBABA@ACCAA@A@@GE@CCAEEEEDAJCPIFADCFHDBDBH@B@B@B@DBDBBBB@@@B@BADADCBC@A@@@A@A@A@@@EBA@C@ABA@ABAD@@AB@B@@AAACAEAGAIAM@E@EAAACCCAIBK@I@E@CC@A@EDEBGECEAACAAAGCAGAGCEAGCEEGAEBCBE@C@CACAA@@@A@EBC@C@C@AAAA@K@GAE@CAAAA@AAC@G@OAECE@@CCEGGI@ABA@@@@@@LENGLCFADADAB@@@@@@@FIDCHAJBNJHDDBH@H@HAHB@@@@H@LH@@DDFFHNFJDBFBFBF@TDFAH@HBH@FAFIFCDBB@F@FBDFFDH@FAHELUJQBADAR@P@JBFF@FDHDL@FDLDDFBHAFMFMFOBADCD@R@BIAI@C@EDCBEAIAE@@CEAAUMGEEACAEC]IMCUCAAGAMEA@AAIAA@ICIAEAC@"],encodeOffsets:[[129663,46889]]}},{type:"Feature",id:"230110",properties:{name:"香坊区",cp:[126.662593,45.707716],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BC@@BA@A@@BA@@@A@@@@AA@@@A@A@@@@AA@@@@AA@@@A@@@A@@@A@@@ABA@@@AB@@@@AAAC@E@I@KCKCE@CBCDCBCBIA@JAFCDEDCDCD@B@B@BBBF@D@D@D@@D@FCH@H@DBFBBBBB@F@B@BD@BADADFBBDBBDD@@BBBBD@B@D@B@DBD@FBB@DB@BBBB@H@B@D@DBF@BBB@B@@@B@B@@D@B@@FBB@D@@@@@@DBBB@@@B@B@@@B@@@B@@@B@DBF@D@BBB@F@DB@@@B@@AB@@@FAD@@@B@@@B@@@@@@@B@@@@@BAHAF@@@@@@@@AF@@@@AHE@ADH@@BAD@B@@@B@@@@@@@FAB@D@B@@AAAAAB@B@@EEAD@@@DB@@@ADB@ABA@BB@B@B@DAD@@@@@@AB@@@@@@@B@@@@@@A@B@@@@@@@@B@@AB@@@B@@AB@@@BB@@B@@@@@BEB@@BB@B@B@@@D@B@@@@@@@B@@@B@@B@@@@@@B@@@@@@@@@BBABB@@BAB@@@@@DADCFA@@@@B@@@FA@@B@@B@@BB@B@@@@@@@@@@BB@@@@DB@@@@@@@@@@@@@D@@@@@@@@BDB@D@@@@@B@B@@@@@B@D@BA@@@@@@B@@@@A@@@B@B@@@@BBBBHE@@@@@@DBDBBBB@@@@@B@@@@@BAB@D@@@@@B@FA@@@@@@@@@@F@@@@@FA@@@@@@H@B@@@B@@@@@@@BD@@@B@@@DF@B@@@@@H@@@@@@@@A@@AA@@@@B@@@@@B@@AB@F@@DB@@B@BB@@BDAD@B@B@F@BB@BDB@DAD@@@BB@B@D@DBB@@@B@D@HBD@LCDCDAB@@AD@@BB@@@B@BB@@B@B@B@@BB@BBB@B@@B@@B@BBB@BB@@BBB@@@B@BB@@BBB@@BB@@B@@BB@@BB@BB@@B@@BB@@BB@@DCB@@@@@BA@@B@BAB@B@B@@A@@@ABA@@BAB@B@BAB@@@FC@@@A@@BABBB@@BBB@@B@B@HBD@B@BA@@@AA@A@A@@@AA@A@@@A@@@@@A@@@@@A@A@AAAAA@@@ABCB@BAB@B@@@DDBB@@B@B@BA@ACAAA@@@AB@DBB@@@B@B@B@D@@@BBDD@@B@@@BA@@@AAA@A@@BAB@@@B@@AB@D@B@DABABADCDABAD@B@BA@C@AB@BA@@B@BAB@B@B@DB@B@BB@B@B@@B@B@@@@B@@BB@D@B@B@B@BB@BBBBBDB@@B@D@B@D@@BBBBBBFBBB@D@D@D@DB@FBBBD@DBBAD@BADFDBBBBBDAB@B@BBBBBBDDBBD@B@D@BABC@A@C@A@G@CB@B@B@D@DBD@DBF@H@D@DAFBB@BB@B@D@BB@BA@A@CBAB@D@DBBB@DBDBBDD@FADAH@B@@@B@DAD@D@H@D@L@FBD@F@BBB@B@@A@A@A@AAA@@CACAAAAA@@AA@ABABCDGBEDE@CB@FCHCJCFABADADADABA@@BA@A@E@E@@@CBA@ABA@ABADA@ABABC@A@AAAAC@@@A@A@C@A@A@@BA@AB@B@@@D@F@D@B@B@B@BBFBDBB@FBBBFBBBB@B@B@DBD@B@B@B@@@D@BBB@BA@A@@@A@A@@BA@ACCCC@@ECA@@C@C@@@E@@@@CC@ACC@@AA@AECCEAAA@CEA@EA@@A@GCECCCEECACCEE@@AA@@CA@BG@@@I@@@ABACCA@@CA@@GAAACC@@EA@@CAA@C@DABABA@AB@@AC@CAEAA@AAA@@AA@@@@@C@@@@@C@@@E@@@AA@@AA@A@AAC@A@EA@@C@CAC@A@AAA@A@@@A@@A@AAA@E@EAE@A@A@C@A@CAA@C@CAAAC@AAAAC@AAC@AAA@EACAAAA@AAAAAAA@@A@A@AAA@AAA@AAC@CA@A@@@A@A@C@C@@DBB@DAB@BAB@@A@A@@@C@A@A@A@A@ABA@@BAB@BAB@@@B@@BBBB@D@B@@@BA@@BB@@@BBDD@BBB@B@@@B@@ABABABABA@CBA@A@@@A@AA@A@@@ABAA@@AA@AA@ACEAC@A@C@AA@@A@@A@@@A@A@ABABCBCB@@AB@@@@@@A@EBC@AAA@A@@A@@@A@@@@CAEAEA@AA@@ACAAACA@A@@@C@ACABC@@BC@@BADC@@@@@@@@FC@A@@@@FE@@B@FGDA@AB@CAC@A@A@A@A@A@E@AAC@@@A@AB@@A@@BA@A@CBE@EBEBC@ABA@ABAAA@IBG@E@C@@@A@@@A@@AA@@AA@@AA@A@ABA@C@A@C@A@C@C@@@AA@AAEAA@@EAG@I@E@EBK@CACGCEACAAEAGBK@MAE@E@E@ABC@AAAAA@CAEAA@EAA@A@ABABA@EAA@A@@@AAIACACAC@@BABCFCF@B@@@@@@A@AAC@A@A@CDIFABECGBC@A@AA@AA@@A@A"],encodeOffsets:[[129651,46713]]}},{type:"Feature",id:"230111",properties:{name:"呼兰区",cp:[126.587905,45.889457],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@B@B@@@BADCDCBABA@@@A@AACACAA@A@A@G@CACAEGCDEBOJIDCBFFFFDB@DHF@@@BBBDD@BABABEDEBA@A@A@A@CCACA@AA@A@@@ABA@@@AAAAAA@AAABA@AB@@AB@FABABEFCD@@AB@@@D@B@B@@@@DB@@B@D@B@H@LAB@B@BB@@BD@BAB@@ADEBA@@@C@EAGAAA@@ABA@ABAB@@@B@BBHBD@BABCB@@A@A@CACCAAEAA@@@A@ED@@ABEB@@C@EAC@A@A@A@A@ABADAJADA@ABCBG@@@A@A@A@ABA@A@A@@@A@AB@@A@@@@B@D@B@D@B@BABEFADCDC@@@AACECAC@C@A@A@GBADCB@@@B@B@@BBBDBFDF@D@DCDABABA@@@C@AAAAAC@CAE@@AAAAA@A@C@A@C@@D@@A@@@@@ABBB@B@@BB@B@D@BGFCBCD@B@@@F@@BB@B@DA@A@C@C@CBC@@@@@CC@@@A@AAA@@AACAA@A@A@AAABA@A@A@A@@BA@A@A@A@ABA@C@G@CAA@A@A@A@A@@@AAA@@@IBABAB@FBF@BBBBD@@@A@AB@BAB@BAB@DAD@D@D@DA@@B@B@B@B@B@D@B@D@B@@@B@@@@B@@@@@B@B@@@@@@@@@@BAB@@@B@BAD@D@B@B@@@@@B@@@@@@B@@@B@B@@@BBB@@@BB@@@@@B@D@B@DBB@B@B@BBABA@AB@BBBBB@@B@DBFDBDBFBDAF@BABB@BBAD@BBB@@B@BAB@B@BAB@@@B@D@@BB@D@BB@B@BB@BB@@AB@@B@B@@@BAB@@@@@@@ABABAB@@A@@B@@@BA@@A@@ABAB@@BHBH@DDH@BBBDBVNLJHFHFFDFDHFFFJFFDJFXRDBFDBBB@NDDBJBBBD@DBFBHBJDXFDBDBJBNDBBFDDDDDJJHHHHFFFFDDBB@@@BAB@B@B@@@@A@@B@B@@@@A@@B@@@BBB@B@B@@BB@@BB@@@B@@@BB@B@B@@B@@AB@B@@@@@BA@@BA@@@@@@B@@BBB@@@BDBDDD@BDD@BBBDDBFDDBDDDDFBDDFBDDBBFBB@@AF@D@BAD@B@DAD@DAD@DAF@DAD@D@DAB@B@B@@FBFBHA@@B@AB@B@B@B@B@@@@@BB@@@@@@B@@B@@@B@@@@@@@A@@@@@B@@B@@B@@@@@@@@@@@A@@@@@@B@@@@B@CB@B@@@@B@BBB@@@B@@@AA@@@@BA@@B@B@@@A@@BB@@@@@@B@@B@@@@B@BB@@@@@@ABA@@B@@BA@@@@@@@B@@B@@@@A@@@@B@@@@A@@@A@@@A@@BBA@B@@A@@B@@B@@@@A@@B@@@@B@@@BB@@BB@D@B@CB@B@BAB@@@@B@B@B@@AB@@@B@@@@B@D@B@@B@@B@B@@AB@@B@@@@AD@BDB@B@B@DA@@B@A@@@A@@B@@D@B@@B@@A@CA@@@B@@B@@@D@@@@BA@@@@@BB@@@B@@BA@@B@@@@@AB@@FB@@@AA@@@B@@@@@@BB@@@@@@@@A@@@@B@@@BAA@@@@@A@@@BCB@@@@D@@@@@@BA@@B@@@B@@@ABA@@@BB@AD@@@B@@@@@@BA@@@AB@@B@@@BA@@B@@@@BA@@@B@@@@BA@A@@B@@BB@@B@@@@@@A@@@@@@B@@B@@@@B@@AB@@B@@A@@@@B@BC@@@AA@@CBA@@BB@B@@AB@@@B@@B@@@B@@@@@@AA@@A@@@AB@@B@@@B@A@@@AB@@@@B@@@B@@@@@AB@@@B@@@AB@@BB@@@@BA@@@@@@@B@@@@BA@CB@@@B@B@@BB@@BAB@@@BBB@@@D@B@B@@@AD@@@B@@BBB@@@BA@@@@@@@BBB@@@B@@B@@@B@@BB@BBBBB@B@@@BD@@@@B@@ABA@@B@@@BBB@@@B@@B@@@@B@B@BB@@B@B@@B@@@@A@ABB@@@B@BBB@DAB@@@@@@B@@@@C@@@@@@@@@BBA@@B@@@@B@@@B@@@B@BABBB@@@B@@@A@ABAB@@B@@AB@BB@@@BA@@@A@@@AA@@@B@@B@@BA@@BA@@@@B@@B@@AB@BA@@DB@@@B@@@@@B@@B@@BB@@@@@@B@@BBB@DA@@@@@B@@@@BB@@BDB@@@@A@@B@@@@B@B@@@BBBB@@B@B@@B@@@B@D@B@@B@@A@AB@@@@@@B@B@BA@@@D@B@@@BB@B@@@@B@@@BB@B@D@B@BA@@B@@BD@B@B@@A@@@AB@B@BA@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@B@@@B@@@@@@@AA@@@@@@@@BA@@@@@@B@@@@B@@B@@AB@@@@@BAB@@@@@B@@AA@@A@A@@@@@A@A@@@A@@BEDAD@FAB@B@B@B@B@DCB@@ADAD@B@D@B@B@BBB@B@@@FBB@B@@@BBD@@@BBF@DB@@B@B@H@@@D@BA@@@AB@HAB@B@BAFEBC@CBA@@B@BA@@BA@C@@@AB@@ABCB@@@D@BAD@B@@@BA@@@AB@@AB@B@HBB@B@@@D@D@FBB@B@D@@@F@DAB@B@BBBDB@@@BBBB@@B@@@H@B@F@B@B@F@B@B@B@@ABAB@DA@@F@B@H@B@BBB@@@B@BBFB@@BBBBB@FDB@BBD@BBB@F@BA@@DAD@DBBB@@B@@BBBB@BBD@D@B@B@@AB@@AFA@@B@BAB@B@H@D@B@@@@@@@@AAA@@@A@@B@@@@A@@@@BA@@@@B@@@B@B@B@B@@@B@@B@@BB@@B@@@B@B@@A@@@@BA@@@@B@B@@@B@@BB@DA@@B@BAB@H@D@B@@@B@FB@@DB@BB@FD@@B@DBB@DDB@@BDBB@F@@B@@B@D@B@F@@BD@@@BBF@D@B@@@FBB@B@@BB@DDBBB@B@BD@@B@@BBBDB@@B@@BB@B@DB@@B@B@BBBB@@BBB@@BBDB@BB@BDB@@BB@BBBB@@BBBB@@@D@B@@@B@B@DAB@@@DA@BD@B@DA@@B@F@@@@@@A@A@A@A@@@A@A@@@AA@@A@@@@@@B@@@@AB@@@@@B@@@B@@A@@@A@A@@@@B@@@@@BA@@@@@A@@AC@C@A@@ICGCICCA@@@A@@B@BAFC@@B@B@@@@@BA@@@AB@@AB@@@B@B@BBB@@AB@B@@@BAB@BA@ABA@@@ABA@A@A@AA@@AAA@A@A@@@A@A@@@A@@BA@@B@BAB@B@@@BA@@@A@@AA@AAC@AACAA@A@AAA@AAC@A@AAA@AAA@A@AAC@AAA@CAA@AAC@AAA@AAAAE@A@AAA@@@AAAAC@@AA@@CAECAAAAAAA@AACAAACAAAAAECGCEC@@AA@@CCA@@ACCAA@@AAAC@AAAAAAC@AAAAA@AAAAA@CAAAAAC@AAA@AAA@@AABA@A@ABCBE@CBCBE@ABEDG@CBCBEBC@ABEDEFKDIDCDGFIFELSHIDGB@HC@@B@@@BA@AD@@AD@BAB@BAFEDABCDAJCHIBA@@AAC@A@@A@@@@@@@A@@@A@@BABA@@B@@A@@@@B@@@@@B@@@@@BA@@B@@@@A@@@@@@@@@@BA@@@@BAB@@ABA@@@A@@@AHCBGBCBEBC@ABA@AB@@@@@B@@@@@B@@@@@@AB@B@B@B@BB@A@@B@B@@@@@AA@@@@@@@A@@D@@BB@B@B@@@B@@@@AB@BB@@D@B@B@B@BAB@BAB@B@B@@@BAB@@@BA@AB@B@@@BA@@B@@@BA@@BAB@BA@@D@BAB@B@B@@B@@@@@@@@@B@B@BB@@@D@D@DBD@B@B@BA@@@AB@@A@ADAB@@@BA@A@@B@@@B@B@B@@A@@B@@@DEA@@@A@@@A@B@@A@@@A@@B@A@A@@@BC@AB@@BB@@@@@@A@A@@@A@A@@BA@@B@FA@@B@B@B@B@@@C@B@B@@B@@PQ@@@@LGB@@@@@@@B@@@B@@@@A@@@@@@BA@@@@BA@@B@@A@@B@@@B@@A@@@A@@@@@AB@@@@@B@@@@@@@B@@@@@@@B@AA@ABB@AB@@@@@@A@@B@@@B@B@@AA@@@@@@@@@@@@@@ABA@@@A@@B@@@@@@AA@B@@@BBBA@A@@B@@A@@@@@A@@@@B@@@@AB@@AB@@@@@@@@AB@@@@@@A@@@@@@@@B@@@B@@@@A@@@@@@B@@@@@@@@A@@B@@@B@@@@A@@@A@@BBB@@@B@@@@AB@B@@AD@AB@@@@@BA@@@@@B@B@@B@AB@@@@@B@B@B@A@@@@B@@@@@@AB@@B@@@A@@@@BB@B@B@B@B@B@BAB@@@AAA@BAB@@@@AA@@A@@@@B@@@B@@@@A@@@AA@CBA@@@A@@@AA@@AB@@A@@@@@AA@@ABA@@@@AAAB@@@@@D@@@BA@A@@B@BA@@@AAAA@A@@BA@@@@BB@@@@B@@@BA@A@@@A@AA@A@A@A@@AA@@@@BC@A@A@@LIFA@@B@F@@BBAB@B@@@B@@AB@@A@@@ABA@AB@DBD@B@@@@AAA@A@@B@BAB@BBAB@@BBB@DC@@BAB@@B@@@B@B@AFI@@@AA@@@AB@DA@C@A@@A@ABCAAA@@@ADA@A@@@@A@AB@DABABB@@BBBBB@B@DABAAA@@CAA@AABABA@AAA@@A@@A@@BAB@@@A@@AA@AB@B@@A@A@A@@A@ABA@@BADAB@BA@@AA@@AA@@@A@A@@B@B@BBB@B@BBB@B@BBBAB@BA@ABCDABCBCBA@@BAB@D@D@D@BAB@BA@ACCCAEAAA@@AABAB@LEBAD@@AD@BADABCBABCBAD@B@HAB@BA@A@A@AA@M@AACA@@@CFABKBCGDKB@BOFYJIBMFE@A@EBIAEA@@OCIAWGOEE@E@KB_DeFUFOBIBQDGFGFKFIJEHCDCDQFGDOFGBUHUHGDC@IDIBMBKBGAE@CAC@MEIAC@WA[A@@KAMCKAA@A@CBKBGBE@CAICA@E@AEAGAEACAC@C@G@@AGAACAICMCaEWEE@KEKESMEEGKQOSGIASAQCECEGAAIOCM@@ECGEKCIEOKQMMEKEMCM@Y@UDGAKAGAIICEAEAGBGDKLQHMBC@CBEQ@C@CDABEPENENGBEACCCK@ECKCG@EEEIAO@Q@CBABIRKVGFEBG@ECCEEAE@A@CAEDEJEBG@GAG@EBSCE@EAEACAEIGMEECC@@KGG@@@@@GAGBG@G@CAGCMIIAGBCDEJ@@@@@@A@CBCBEBKDMHKF@@@@@@AB@BHJFHDD@@DFBF@P@HBD@BBBBB@DBF@H@LBBBBD@D@D@FAB@@@B@DBDBD@F@DAFAHBFFHDFBHDHBDBBHBBBDFBFDAHCF@F@BDDF@J@L@JADBDDBBFBF@N@JBHBFBDBBB@BA@A@@BC@AB@BAB@B@DAB@F@@"],encodeOffsets:[[129636,47071]]}},{type:"Feature",id:"230112",properties:{name:"阿城区",cp:[126.958098,45.548669],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@ABAB@@A@@DAB@@@B@@@B@@@@@@@BB@@@@BAB@B@@@BAB@@@B@B@B@@AB@@@@@@A@@@@@AAAA@AA@AA@AAAAA@@AAAA@@AAAA@AAA@@AA@@@CA@@CAA@AAAAAA@@A@@@@ARELEB@@@@C@AA@@A@AA@@A@A@@@@@A@A@ABC@A@AB@@CBCBC@A@ABA@A@@@A@AAA@C@AA@@CAA@@AAAAC@@@A@CAA@C@A@C@C@A@C@A@CAC@C@C@E@AA@BC@CBABCBEBA@A@BA@@BA@A@@@A@@@@@A@@@@AA@@A@@@AA@@@@@A@@@@B@@A@@@@A@@A@@A@@@@@@A@@BA@@BC@CDCBC@ABABCFCFGDA@A@@B@B@@@B@BB@@BB@@B@@@BBB@@@@@@@BAB@B@D@B@BAB@B@BAD@B@@@B@@@@@@@@@AA@@AA@@@@@A@@@A@@@@@A@@@@@@@A@A@A@@@A@@@A@A@@@A@@@A@@B@@ABA@A@@@A@@BA@@@@@AA@@A@A@@@A@@CCGGAAAA@AA@@A@AA@E@A@C@ABA@C@E@G@@@A@@BAB@@@@A@@@A@A@A@A@A@A@C@A@@@A@@@@@@B@@ABAB@@AB@@@@@B@@AB@@@@@@@BA@@@@B@@A@@BA@@@@BAB@@@B@@@@@B@@@@ABA@A@CBA@C@A@A@CB@@@@A@@@AAA@@@A@A@A@@AA@@@@@A@@A@@@@@@AA@@@@@A@@A@@AA@@@@@AAA@@@A@@@@@A@@AA@@@@@@A@@@@@@A@@A@@A@@@A@A@A@@A@BA@@@@@A@@@@@ABA@@@A@@@A@@@@@@@A@@BA@@@@@C@AB@@@@C@ABA@@@A@A@AAABA@AAA@@@A@A@@B@@ABA@A@ABC@A@A@A@@@A@@@CDA@@@@@@@AA@@AAAA@@A@A@A@C@A@@@A@@B@@AB@@@@@@A@@@AAAACACA@@@@A@AB@@AB@@A@A@A@AA@@AAAAAA@AA@A@@@@@CAA@@@A@A@A@A@@@AB@@@@@@@@AB@@@BA@CD@B@@A@ABABA@ABA@AB@@ADA@@@@B@@@@@@@B@@@@@@BB@@B@@@@B@B@B@@B@@B@@A@@@@BA@BB@B@BBBB@B@@@B@B@@B@@@@@@@B@@@@@@@@BB@@B@@@BBBBDBBB@@AB@B@BAB@@@B@B@@@B@@@@@@B@BB@@BB@@@BB@A@@B@@@BA@AB@@DDDBAB@BC@CBA@A@@@ABA@@@ABA@GHABBBB@@B@BAFAB@@ABAB@@A@A@A@@@A@AA@@AAA@@@A@A@A@A@A@@@A@ABA@A@@@A@A@@@E@CAA@CBGBA@C@A@CBCBA@A@@B@B@@@B@BA@@BAHCFCFABC@AD@DADCDAAA@C@G@CBE@C@C@E@AAACAAIIA@C@A@ABBD@@BB@DBFBBDFHFDBFBF@@B@B@BC@A@MFEBA@A@CAOEICEAEAGAC@E@ABABC@CACA@AA@CAABCBA@@FADABABAF@BBB@DAFADABAB@B@DADAD@BBBB@BBDA@@D@BB@B@DAB@@A@BFBB@BBB@BAB@@@D@L@FA@ABCAA@G@C@AAA@C@A@CBCBE@CAC@C@ABCBEDCBCB@D@DBDAB@BCBE@C@A@ADCF@@EBC@CAAAE@CDCBC@A@ACCA@AA@A@GDCBADABA@@B@FAD@BBB@BBD@BCDEDCDA@C@C@C@GBCBC@CACBCBADAB@BBF@DA@A@AAAB@BC@@@CA@@@AA@A@@@CA@@AAA@A@@@A@A@A@@@A@A@A@ABAA@@A@A@AAA@AA@@AAA@A@A@@AA@A@ABA@A@@@C@A@A@@@A@A@A@AA@@A@A@AAA@AA@@A@AAABA@A@A@A@ABA@A@A@CAEAA@A@EAAAGCC@CAAAC@A@A@@@C@A@ABEBA@@@A@@@AB@@ABADABADABA@@BABABC@A@@BA@A@AAA@AB@@A@ABA@@B@B@@@BBB@@@B@@@@ABABAB@@@@AA@@@@ABA@CDCDADAB@BA@ABCBCBA@AB@@@@@@A@@@@@AB@@@B@BAB@@@BA@ABA@ABC@EB@@A@ABA@@@@@@B@@@B@@@@A@@BAB@@@@A@@B@BA@@B@@@B@BB@@@@BBB@@C@@@A@@@AB@@@@@B@@A@ABA@ABA@ABAAA@A@@@@B@@@B@B@@@BA@@@A@@@A@A@@@A@@@A@A@@@@B@@@@@@A@EBC@@BA@@BA@CBAB@@A@@@A@@@@@@@A@A@@@A@@@A@AA@@A@A@@@AAA@@@A@A@@@@@@@A@@@CBA@A@@BA@@@@@@@A@AA@@A@@@@B@@@B@@@@@@C@ABA@@A@@A@@AA@CCAA@@A@C@ABA@A@A@AB@@@@@@@BBD@@@B@@@@A@@@@@A@A@CAA@@@A@A@A@@B@@@@A@@@@@@@AB@@@@@@AB@@CBA@CB@B@@A@@@AB@@@@A@@AA@CCEA@@A@@B@@A@@B@@@B@@C@A@EBAA@@A@A@A@A@C@C@C@A@A@@@@A@@@@@@EAEAA@@@A@@B@@AD@BAA@BA@@@@@@@A@CACCA@@@@@A@EBC@C@ABC@A@CBA@A@@AA@@@A@EF@@AB@@@@BB@@@@@B@@A@@BABA@CB@BAB@B@@@@A@@@A@@@A@ABA@A@@@A@A@A@A@@@@@@DB@@B@B@@BB@BBB@BDD@@@B@D@@ABCDEFCDABAD@@AB@BAD@@AB@@AFA@@@C@@BABDBBBADIB@@AD@B@@@@@@A@@@@@A@@@CA@B@B@@@B@@A@AB@@A@@@@B@B@@@@A@A@AD@B@@@@@B@B@@B@B@B@B@D@BBB@B@@@@@@@@@@A@@@@B@BBB@FDDBBBDBBBB@B@B@BBB@B@D@@@B@@@@@@B@@AB@@AB@@BD@DBBDD@BDDBD@BBB@B@D@D@B@B@B@D@B@@AD@B@@ABAB@@@B@H@F@D@@@B@@CFCD@B@@@@B@@@B@@B@@@B@BAB@@AB@@A@A@@B@@DBF@B@B@BB@@B@BB@@DFB@DBD@BBB@D@D@D@DB@@DB@BBBBDBDB@@@D@B@@@DBFFBBB@BB@@@@B@@@@@AB@@@BAB@@@@AB@@@@DDDDDBDD@@BBB@B@B@DB@@B@@@@@@@B@@B@@@BBB@B@@B@B@B@B@B@@B@@B@@BBBBB@@@B@B@D@BB@B@B@@DAB@B@BAD@B@B@B@BBB@BBD@F@@@@@@@FBB@@@@B@BBBAB@BAD@FAFAF@DAB@B@@AB@@@BAB@@@D@BBF@B@B@B@B@B@D@DBA@@BCBEHA@@@EF@@@@@BED@@@@@@@@CDAB@@AD@@ADDB@B@D@@@BDBBBDB@BB@@BFBFBDB@@@@@B@@@BB@B@BBD@FAB@@@@@@@BA@@DADABABAB@B@@@B@@@@BB@@B@D@BBDDF@BBBB@@BB@AB@B@@@BBBB@@@B@B@DAB@BABABABA@@@A@@@AAA@ACCAA@@A@@AB@@A@@@A@CAAAA@@@A@@BA@ABA@AB@BAB@B@B@B@D@@@B@B@@@BA@ABA@CAA@CD@D@B@B@@@B@B@@DBD@BBB@BBB@B@B@BB@BBBBBBB@BBDBFBB@BBD@BBD@BBBBD@BBDBD@B@DBB@D@B@B@F@FBF@B@BBB@@@@B@@@BBB@B@BBD@D@DB@@F@BBD@B@BBB@@BB@@F@@@D@@@@@D@@@@@B@@BB@BBB@FBDBD@@BA@@BABABCBD@B@DB@@FB@@DDBBHB@@DB@@DBBDBA@@J@@@H@@ADB@@BB@@FFDDDBFFDDFDHDB@@@FBB@DFB@BBFDDBB@BBB@BBF@@BBBH@NBH@JCHCJCHCH@FAFBD@D@B@B@B@B@BAZ^EL@DABBBF@J@HBPRZNd@B@@@@@@@B@@@@@@@B@@A@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@AB@@@@@B@@@@@B@@@B@@@@@B@@@@@B@@@@B@@@B@@@B@@@@@BA@@@@B@@@B@@@@@@@B@@@B@@@@BB@@@@@B@@@@@B@@@BBB@B@BA@@B@B@@@B@@A@@AA@A@@@A@@B@D@D@B@B@@A@@@A@@B@B@@A@@@@A@A@@@@@@@BCBABAB@B@@BB@B@@@B@@A@@AA@@@A@A@AA@@@@@BA@@@AAB@@A@@AB@@AA@A@@A@@@@@AA@@@BA@@@@@@@@AA@@@@B@B@DAB@@@@@AAA@@@@@B@B@@@@AA@@AB@@@B@@@@AA@A@@@@@@A@@B@B@B@@@@AA@@A@A@A@@@A@@BA@@BB@BB@@@DA@@@A@@@AC@@AB@B@@@@@@A@@@A@AB@@A@@@A@A@@@@@C@A@@@A@AB@@A@@@@B@AA@@@@@A@@A@@@BAA@@@@@@A@@@@@C@A@@B@@@AA@@CC@@BA@@@A@@BA@@B@@AACAA@@B@@A@AB@@AB@@A@@@AB@@AB@@@@@@A@@@@BA@@A@@@@ABA@@@@@@A@@@@@BA@@@@@A@@BAB@@AAA@@@A@@BA@@@@@A@@@@BA@@B@A@@A@@@A@@@@B@B@@A@@@A@@BCA@@@@C@@@A@@@@BA@@@@BA@ABABABA@A@@@A@@@A@A@A@AA@BA@@BA@@@@@ABE@@@A@A@@@A@A@@BABA@ABA@AA@@A@@BAB@@@@A@@@A@@B@@A@ABA@@@AB@B@BA@@B@@AB@B@@AB@DA@@@@BAB@@@AAB@@@@@@A@@@@@AB@@@@@BA@@@A@@B@@A@@@@BA@@@A@@@@@A@@@@@@@@@A@@@@@@AA@@@@@@@AB@@AB@BA@@@AB@@@@@BA@@B@@A@@@A@@BAB@@@B@B@@AB@@@BA@@BC@A@@@A@@@A@AB@B@@AB@AA@AB@@A@@@ABAB@B@B@BA@@BA@AB@@A@@BAB@@A@A@@BA@@BABCB@@@BAB@@AB@BA@@@ABA@@@A@@BA@AB@@A@@@A@@@A@A@@BA@@@AB@@A@A@@@@@@BA@@BA@@B@@@@A@@B@@A@AB@@A@@@A@@@A@@B@B@DAD@B@B@B@@@B@@BB@@@B@B@B@@@BB@@@@B@@@@BB@B@B@@@B@@@B@BA@@@@@@@@BB@@@@@BB@@@B@@@B@B@@@B@@@@@B@BB@@BB@@@@B@BBBBBB@@@B@@@@@@BB@@@@@@B@BAB@@B@@B@@@B@@@@BDB@@@@B@B@@@B@B@B@DB@@B@B@B@B@B@B@@@B@B@B@@@BAB@@A@@@@@A@A@@BBB@@@@@@@B@B@@@BBB@@@@A@@B@BAB@BA@@@@B@BAB@B@BAB@BAB@DA@@B@@@B@B@BBB@@@B@B@@@@@@@@A@@@A@A@@@@@AAA@A@ABA@@@A@@B@BA@@B@B@B@B@@@@@@@@@@@@A@@A@@A@@@A@@BA@A@@@@BA@@B@B@B@@@@A@@@A@@@A@@@AA@@@BA@@BA@@@A@AAA@@@AB@@AA@@A@A@@B@@@B@BA@@B@BA@@B@BAB@B@@@@@BA@@BA@AB@@A@AAA@A@A@A@AA@@@@A@@BA@@BA@@@A@@@@BA@@BABCDA@AB@B@BAB@D@BAD@B@B@B@BAB@B@@@B@B@@@D@B@BBBAB@B@B@@@B@@@B@@@B@DBB@DBDB@@@@D@@@BABABABABAB@BAD@BABADAB@BABA@@@A@A@@B@BA@@B@B@FBD@BBD@B@D@F@B@B@BBB@B@@@B@@@DABA@@BA@@BA@AB@B@B@@AFBF@B@B@B@B@BAB@BABAB@BAB@@ABA@@B@@AB@B@@@B@@AB@B@B@D@BBD@D@B@@@B@@ADABA@AB@@A@@B@BAB@B@B@BAD@BAB@BAD@B@@@DA@@D@DAB@BAFAB@FAD@B@D@B@B@@@BBB@@BB@BBB@@@B@@@BAB@B@@@BBB@@BB@B@BB@@B@@B@@BBB@@BB@@BB@@@BB@@@@@@@BA@@@@B@@@B@@BB@@@@B@@@@BB@@@@BA@BB@@@B@@@B@@@@@B@@@B@@@@@B@@B@@BB@@@BB@@@@@BB@@@@B@@@@@@@BA@@@@@@@@B@@B@@B@@@@@B@@B@@BB@@@B@B@@@B@B@BAB@@@B@@@@@BBBB@@@@BBB@B@@@B@B@BBB@B@B@@@@@B@B@BAB@@@B@BA@@B@@@B@@AB@@A@@@@@A@@BA@@@@BA@@BA@@B@@AB@@@BAB@@@B@@@BAB@B@BAB@B@@AB@B@B@BAB@B@@@B@B@B@B@B@B@B@@@B@@@B@@@B@@BB@@@BB@@B@@BB@@@BBB@@@@@B@B@B@B@B@@BB@B@@@BB@@B@@BD@@BB@@@BB@@B@@@B@@@D@@@B@B@@@B@@@B@@@@@B@@BB@@@BB@@B@@@BB@@B@BBB@@BB@B@B@@@BBB@B@B@B@D@@@B@B@B@B@@@B@B@B@@@B@D@@@B@BB@@B@B@BBB@B@@@B@@@@@B@@A@@B@@A@@B@@ABA@@BA@AB@BA@AB@@A@@@AB@BAA@A@IE@EAA@A@A@A@@A@AA@@AAACAAAAEACCAA@A@CBA@E@ABA@@BA@@BCBABADC@@@A@@@@AAA@@@BA@AB@DA@@DABA@@@AB@@A@@ACAA@@@A@@@@@AB@@A@@@@B@@A@@B@@@B@BAB@@@@@BA@@@@@AB@@@@@@A@@@@@@@@@AB@@@@A@@@@@@@AA@@A@@A@@A@@@A@@@A@@AA@@@A@@@@@@@A@@@A@@A@@A@@@@AA@@@@A@@@A@@@@@A@@A@@@@@A@@@@BA@@@@@A@@@AA@@@@AA@AAAA@@CAA@A@@@@@CBA@A@A@@@A@@AA@A@@@@A@@@@@@@A@@@@@@@@@C@@AA@@@@@@AA@@@@AC@@@AB@@@B@@AB@BC@@@A@@@A@@A@@A@@@@@@@@AA@@@@CC@A@@@AA@@@@@A@@A@@AA@@CC@@A@AA@@A@@A@A@@AA@@@@@AAAAA@@@AA@@A@@B@@@BA@@@AB@@A@@B@B@@AB@@ABA@@@@@AB@@A@@BA@A@AA@@AA@AA@A@@A@@A@A@@@A@@@@@@AAA@A@A@A@ABCBA@A@@@CAA@CAA@@@AAA@@AA@@@A@AA@@AA@@@A@@ACBA@@AA@ABA@ABABA@AA@@@AA@@A@@AA@@AA@@AA@@A@@@A@@@A@@@A@@@@@@@@A@@@A@A@C@A@AAA@A@@@A@@@A@@@@B@@A@@BA@A@ABA@C@CB@@A@@BA@CBA@C@A@@AA@@@A@AA@@@@AA@@AAA@@@A@@AA@@@A@@@AAA@@@ABABABA@@BA@A@A@A@E@ABA@@@ABA@@BC@A@C@E@@@ABA@A@CBA@A@A@@@A@A@@@@@@B@@@BA@@B@B@@B@@B@D@@B@@B@@ABC@CBC@CB@@@@A@@@@BA@@@@AA@@@AAA@@@@C@A@@@AA@@@BA@@@A@@@@@AA@@@AA@@@A@@@@@A@@AAAA@@@A@@A@@@@@A@A@@@A@A@@@@@A@@@@@@A@@@@A@@A@@@@AA@@@A@@@@@A@@A@@A@@AA@@@A@@@@AA@@A@@A@@@A@@AA@@@AA@@A@@AA@@@@AA@@@@@AA@@AAAA@@@@AA@@@A@@AA@A@@AA@@@A@@A@@@@@@@@@AA@@@A@@AA@@AAAA@@AA@@AA@@A@@@@@A@@@@@AA@@@@AA@AA@@@A@@@@@@@@BA@@@AB@@@@@@A@@@@@A@@@@BA@@B@@@@@@@AA@@@A@@@@@A@@@A@@BA@@@@@@@@@A@@@@@A@@@@@AB@BA@@@A@@@@@@@A@@AA@@@A@@@@@@BAB@@@B@@@BAB@B@BA@@@AB@BAB@BABADABABAB@B@BAB@@@BA@@AA@@@A@@@@@@@@@@@A@@A@@@@@@A@A@A@@@A@@@@@A@A@AA@@C@C@@@CCAACA@@A@A@C@G@E@ABAAA@@@@@ADCBABCBABCBCBEBC@AB@@ABA@@@A@@@A@@@@@@@@B@BBBB@@B@BB@@@@@@@@@A@A@A@E@@@A@@@@@A@@@@B@@@A@AA@@A@@@@@A@A@@BA@@@@@A@@@@@G@A@A@A@@BA@A@@@A@AB@AABA@A@A@A@AB@BA@A@A@ABAA@BA@C@A@@@AAC@A@@BA@A@@@@@ABA@A@@AAB@@CB@B@@AB@@ABA@@@A@A@A@@@@@CB@BABABA@A@@BA@A@A@@@A@C@@BA@A@@B@@A@@BABA@A@A@A@ABCB@@AB@@A@@BA@ABAB@@AAAB@@A@A@A@A@A@@@@BABC@A@@@A@AAA@A@@A@A@@AA@A@@AAA@@@A@@@@A@@A@CACA@@A@@@"],encodeOffsets:[[130471,46385]]}},{type:"Feature",id:"230113",properties:{name:"双城区",cp:[126.312624,45.383218],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@CACC@CACAAE@E@CBEBE@C@CAACGAI@CAEAIACAE@C@A@CDC@C@CAEIACACAACAC@A@E@C@I@EBCFAB@BAB@B@DABC@CBEAEACACGCCSEGCGCACCAGCECMIEC@AAEAAAAGAMAAAACACAGCCGCEAG@C@CD@BADADABC@C@CCEEAAKCAAAA@CACC@C@CBCBCBEAGEE@CBAD@DBF@DCDEDEBCAECAC@EFCDE@CECG@C@EAAA@CBAFADAJCBAACCAIAE@GBA@CBGDABIBGCEE@GBCDADABA@A@@CCE@CAEBGBAAAA@@@A@ABADA@A@A@@AAAAC@C@CBC@AACCEAA@C@AB@BBB@FADCFCDEBC@CAACCEAACAGBCBAFCDCBCAEACEE@A@AB@B@BBBABABABCAEECGCEAAEBIBC@CA@CDEBCACCCA@CAGBEFCDCDADEBE@ABEDCBC@AAE@ABC@C@AAACCACBC@CDCFABC@A@ACACBCAAAACAA@ABA@@BCBABC@C@CBC@E@ABABA@F@BB@BABCBCBE@@BAD@FADABCBE@EBEBEBG@E@EAE@C@ABEFEBE@EBAAA@CAAAGBG@A@A@@@AA@A@@@A@@BA@@@AA@AACAC@AACBA@E@ABCDA@A@A@C@AACACAC@A@CAABC@@B@@@B@@BB@@@BABCDAD@B@B@B@@BBD@B@B@DD@@@B@@ABC@A@C@A@ACC@CAC@A@CDCBA@CAAAAACAA@C@AB@BBBBBDBDB@@B@F@D@D@@B@@ABCBABBB@BD@@CD@B@@B@@@B@BBBDAB@DBBB@BADCBCBEBCAECEAG@E@E@CB@B@B@BA@CBC@CDCFA@C@A@CACCA@A@@@G@I@KAOAKAGBGBEFABADAFADCBABE@GBEBCD@H@DCBE@GAI@IBCD@DADADGBG@EACDAF@FCBEBG@K@KAK@G@EBEDEFAFCF@F@F@F@DBDDBBD@BADCBE@IBSJOHAB@DBBBDBBADABA@C@CBIBCDCBAACAAACAC@C@ABABA@@@A@@AAAB@@ABABA@CAAAA@@A@A@EDGFAD@D@DBFDD@@@BABABC@AB@@@DBB@DA@@BA@E@CBEDIL@FGFCBE@GAEBCBADBBHDDDDHBBABEBKBI@IDGHKHAD@B@FB@@@@@@@BBL@@B@B@DCBCBED@B@B@@@@FJDD@FDHDDHHFHFPBH@D@J@N@J@B@BEBGBCBGR@JDDDD@FABABG@EBCD@F@BJDH@F@DBDF@D@DCBC@C@ACC@E@CD@DBD@D@DDBHBHBDD@BA@@HADEFE@E@EAGBCBAB@DBBFD@DFBDBDBBDABGJONCBJBNBTBZ@L@@@B@J@JBJBJFJHB@NL@@DCBCFCFGHMFGFCFAD@H@HBTHhNX@FCFEHEJELBHH@DBFJNHDLFZLJJFHDJBFDHDJDFJJLHDDDBF@PDJHBD@BADCFAF@B@DBDBFFF@H@BABCBABEFCB@BADAD@FBHDFJDF@VBH@F@LCLADCFCDAB@D@F@FBF@HDHDFDDDDD@D@D@DCDEFGDABCBABCFCF@B@@@B@DBD@@DDBBB@@B@@ABC@AB@BABB@@BBB@@BBD@B@B@F@BABBB@FDHDDBFDDDB@BBDDFH@DAHAJ@FBDDDDBD@HDDBD@B@D@B@HBP@D@F@F@LAHABADADAJIBCFIDE@AB@FAPCJAHAHAHADCHCJGHAFADCDBF@F@HBTBHBBBDA@AB@@A@@BA@@@A@@@@@A@@@A@@@@BA@@@@@A@@@@BA@@B@@AB@@@@A@@@@@A@@@A@@BC@A@@@A@@@A@@@A@@@ABA@@@@@A@@@@@@@AB@@A@A@@B@@A@@@@B@@A@@@AA@AA@@AAB@BAB@@@B@BA@@@AB@@AB@BAB@@@BA@@@ABA@@@A@@BA@@@A@A@@@A@@AA@A@@@A@@@A@@BA@A@@@AB@@AB@@ABAB@@AB@AA@@@A@A@@AA@@@A@@@A@A@@AA@@AA@@@A@AB@B@B@BA@@@A@@AAACC@A@A@A@AA@@AA@@AA@AA@A@A@A@A@@@AAA@A@@AAA@@AA@@AA@@@A@@@AA@@A@AA@AA@@AA@@@AB@B@B@@@B@@@B@@@B@@BB@D@B@B@B@B@@@BB@A@@@@B@@A@@@A@@@A@@@A@@@@@A@@@@@@BA@@@@@A@@@@@AB@@A@@@@@@EAA@AA@@A@AAA@A@AA@@A@A@A@@@A@@@AA@@@@@@@@A@@A@A@@AA@@@A@@BA@A@@BA@@@AB@@ABA@@BCDCBC@@BA@@@C@A@@DE@@BA@C@ADA@@FCFA@@BABB@@H@@@DBDBDD@@DBF@@@FBFB@@F@BB@@DB@@DB@@@@BBB@B@B@B@@@@@@BD@B@B@@BB@B@BBB@@@B@B@@B@@@@B@@@BBB@B@@@@BB@@@B@B@@@B@B@DBBBB@@@@BB@BBB@@BB@@BBBB@@BB@@@BB@@DBBBDBBB@@B@@B@@AB@BCF@BA@@@@@A@@@A@@BAB@@@B@@@@@@@B@@B@@BBB@@@@BBB@BBB@BBB@D@DBB@BB@@B@@@@@B@@A@@B@@A@@@ABABCBA@CBA@@@@@@@@B@DBB@BB@@B@DBB@@@@B@@@@@@@@A@CA@@A@@@@@@@A@@BB@@@BBB@B@JDB@@@@@BBAD@@@@BB@@B@@@BB@@DBFBB@BBB@B@B@@BB@@@B@B@BB@@B@B@@@D@B@B@@@B@DBB@B@DBB@B@DBB@B@BB@@@@B@@@B@B@B@@@BB@@B@@@B@@@B@@BB@@B@@B@@@B@B@@@B@B@B@B@@@B@B@B@BBB@@@B@BB@@B@DB@@B@BBB@@@B@BBB@B@B@@@BBB@B@B@@BB@B@B@B@BB@@B@B@BBB@@@B@DA@@D@@@DC@@DADA@@@@BADAJEFCNAHCFAB@D@B@B@@@B@B@B@B@B@@AB@B@B@@@BA@@BAB@BA@@B@BBB@BA@@@@B@B@@@B@@@BAB@@AB@B@BA@@BA@@@@@@@A@@B@@AB@@A@@B@@@BA@@@A@@BA@A@@@CBA@A@@B@BA@@B@B@DA@@@@BA@@BABA@A@@@AB@@AB@@@B@@@@@BA@@@@B@@@B@@@@@B@@AB@@@@@@@B@@@B@@@@@BAB@@@@@B@@@B@@AB@@@B@@A@@D@@@@@BA@@@@@@B@B@DAB@BADAD@@@BA@@@A@A@AA@@@@@@@@A@@@@@@@@B@@@@@BA@@@A@@@@DA@@B@@@@@@A@@B@B@@@B@@@B@B@B@@@B@@@@@B@@@B@@@@A@@@@B@@@@@B@@@BA@B@AB@@@B@@@@@B@@@@AB@B@@AB@@BB@@A@@@@B@@@@@@@B@@@@@@@@@@@@AB@BB@@@A@@@@B@@@B@@@@@B@B@@@@@BAB@@@B@@@B@@@@@B@@@@@@@B@@@@@@AB@@@@B@@B@@@@@BAA@@@BAB@@@@@B@@@@@@@B@@@@@@A@@@@B@@@@@@@B@LJZARIHCJED@P@P@L@RFBBB@@@B@@@@@DBD@BBB@B@B@B@@@B@DB@@B@@@B@D@@@D@@F@DD@B@@ABA@ABA@A@A@C@AAA@A@EBE@A@A@A@ED@BB@@B@B@@AB@@@D@@@@@B@DABABA@@BA@@B@@@DA@@@@B@BA@@@@B@@@@@@@@@@@BBBF@@@@@@B@F@D@B@BBF@B@@@B@@@@BB@B@DB@@D@@@@@@@@BB@B@@@@@B@B@DB@@BBB@B@@@@@@@B@@B@@B@@B@@DA@@B@@@@B@@B@@@DB@@@@B@BB@@B@@@B@B@D@B@DA@@D@B@@@B@@@B@JD@CB@@A@ABA@B@BAB@D@@@BAD@D@@@@@@FBBBB@@@BBB@B@B@@@@B@@BB@BBD@@@@@@B@@@B@@@BBD@DB@@B@@B@@@@@B@@@@@@BBB@DDBAB@B@DC@@@@B@B@@@@@@ADCDE@@@A@@@C@E@G@A@@BABA@@@ABC@@@A@C@A@A@A@C@C@AAA@AACCC@ACCAA@CAC@@BA@@BA@@@A@@@@A@@@C@A@A@AAA@A@A@AACAAACAECA@AAA@@@@@@B@@@@@@@@A@A@AAC@A@A@A@A@@@@A@A@@@@@ABCB@B@@@@@@A@A@@B@@@BAB@@@@A@@@A@ADB@@B@@@@@B@@@@@@@@ABC@@JABCAACABA@AD@@@B@BE@@BA@@BC@ABA@@BCBADCFEDCBA@@@C@A@@CC@AAA@AAA@@@A@AA@@C@@@@B@B@B@B@@@B@B@BAB@@@B@@@B@@@@@@ABA@ADAB@BA@AB@@@@A@@@@AA@@@@BA@@FEAACFA@@@@A@A@@@A@AAABEA@A@A@KACF@B@@@B@BA@@@@@@@A@A@E@ABC@E@@LI@@C@E@A@EBEA@@C@A@AC@@ABABCEAKAKA@BAD@B@@C@AAA@A@CAC@IC@BAHA@@@C@AAA@ABAFA@AF@BAB@@C@CA@@A@CJC@C@A@AAA@A@A@@@GAGAGC@@@@@ABA@A@@@A@@@A@@@A@@@@@@AAA@@@CA@@@@AAA@BC@A@@@ABA@@@@@A@@BA@@@A@AB@@A@@BC@A@A@@@@DC@ADC@ABC@@@C@A@A@@@A@A@A@C@CBCDEDBDBBBB@@ADEAABCBCBC@@BA@A@ABA@A@ABC@AAA@A@CA@@A@@AA@AAC@AAA@@B@@ABA@@@@@@B@@@@@@@@A@@@@@A@@BA@@@A@@@CBA@@@A@A@A@A@@@@A@@@A@@A@@@@BA@@B@@@B@@@@@@A@@A@@AA@@@@A@@BA@@@A@@LD@@@A@@@@B@BA@@B@BA@@@@@@@A@A@@@A@@@A@@A@@AA@@@@@@@@@B@@@@A@@B@@@B@@@@@@@@@@AB@@@@@B@@@@@@@@@@A@@B@@@@@@@@A@@@@A@@@@@@@@@@A@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@AB@@@@@@@@A@@@@DAA@@@@@@@AAA@@@@AA@@A@@@@@@A@A@C@A@A@AEC@EAE@C@CA@@@@@A@A@@A@A@EAE@@CBABE@AG@@D@B@@A@E@@EAC@@A@AB@C@C@AA@C@A@@@@B@@A@A@A@@@A@AAA@A@AB@@@B@@A@@@A@A@@@AA@@@@@BABA@@BACAA@@C@CA@@AABA@@@A@@@AD@BA@CAAAC@@CC@@@@E@AAAA@@A@AA@@AA@@BADB@@BAAAA@@@AAC@@@CDA@C@@@@@A@@@AA@ACC@@AABA@AB@B@BA@@@@@AB@BA@@C@@@@@@A@@B@@@@AAA@@C@@A@@@A@A@@A@@A@A@A@AAAECAA@@A@A@GAG@@AA@A@A@@A@@BAB@B@@AB@@A@A@@A@@@A@A@A@A@@@AA@@@@AA@@@@@@AAA@@A@@@AB@@A@@BAB@ACECCGCCCAGACBEDEBGFKFEBEA"],encodeOffsets:[[129556,46334]]}},{type:"Feature",id:"230124",properties:{name:"方正县",cp:[128.829536,45.851694],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@A@AB@@@@A@A@A@AA@@A@AAA@AA@@A@C@A@A@A@A@A@A@C@A@C@A@A@A@A@@@A@A@@@A@C@A@A@AA@@A@AAA@@AAAA@AA@@@AA@@AA@A@@@C@A@@@A@@@AA@@AAA@A@A@A@A@A@ABA@@AA@A@A@@@A@A@@@A@AAC@CA@@A@A@C@A@A@A@A@A@A@A@A@@BA@A@@@A@A@@@A@A@A@A@A@A@A@AAA@A@@@@@@@A@A@@@ABA@A@A@A@@@A@A@AB@@BB@@BB@@AB@BA@@AA@@AAAA@@BABAB@B@BB@BB@@B@BB@@AB@@A@C@AACAA@A@ABAB@B@BA@A@A@@@AB@B@@BBBB@B@@A@AB@@@@@BB@@BBDBD@BABA@CBAB@@B@BB@@@@BBB@B@@@B@@B@@AB@@C@@@ABABA@@AA@A@@B@BA@A@A@AB@@@BD@D@@B@BABA@@@AAA@@@@BA@A@@@AAA@@AA@A@ABAB@B@D@BABA@CBA@@BA@@BB@BB@B@@@BA@A@CBA@BB@BBBBBBBB@B@BB@BBB@B@@ABA@A@@B@BABABAB@B@BAB@BABABAB@B@@@@BBB@@@B@@@BBAB@DABAB@@@BDB@@@BAB@@@B@BA@A@@@A@@BABA@ABAB@@BDA@EDEBA@A@C@C@ABCB@BABABA@AAA@C@AB@D@@BBF@B@@B@BABA@BBABB@BBB@BADB@@AB@BCDCBA@AB@@@BABA@C@A@@BB@@BBBB@@B@@ABA@AAA@A@A@@B@BB@@BBB@B@BA@C@A@ABA@@@A@AA@AA@ABA@@B@@BBDB@@@B@B@@BBA@@BE@@@@@@BB@BBB@@BC@A@A@@AAAA@CB@@ABCA@@ABA@AB@BB@B@B@BBBB@B@BA@C@@@@BBB@BABB@B@B@B@BBABA@G@CACACAC@ABCBA@C@AA@A@G@CAAAC@@AAA@CAA@AAACC@AACACAA@AA@A@AB@@@D@DABA@A@ACCA@AAA@A@@CCACACAABA@A@@ADA@A@@E@@A@@BA@ABAAAECCA@A@A@ABADC@@@AAACA@A@C@AB@B@B@B@BA@A@ACAC@C@AAC@KGIEAAAA@C@@B@D@B@D@B@@AAA@E@A@ABAAA@A@A@CAAAAA@@AAAA@ABC@@@A@@BCCE@A@A@A@A@A@@@ABCB@@A@AABA@AA@C@@AA@AB@AA@BBA@@@A@A@A@A@A@AA@@A@A@A@@BE@@A@@AB@@A@@@ABABA@@AAB@AA@A@@@A@A@CB@@@BA@A@@BA@AA@ACB@@AAAB@B@@AAA@A@@@A@@@A@ABA@@@A@A@A@AB@AA@@B@B@@A@A@A@AAA@A@AB@AA@ABAA@AA@CB@@C@AB@@@@ABA@A@@@A@A@A@@@AB@BABA@ABBBDD@BDBDD@@@B@BABAB@BA@A@@BCBB@BB@BBB@BAB@@@B@BB@DBDBBBBBBB@@@@@BABA@ABA@AB@B@@@@@B@@@@A@@@A@@@AB@DAB@B@B@BABA@@BBBBB@@@B@@AB@@@BA@@@A@AB@@@@A@@@@@@@A@@B@@A@@@@@A@@@A@@@@@@@@BA@@@@B@B@@AB@BA@@@@B@@A@A@@@A@A@@@A@@@A@@@CBA@CBA@A@@@A@A@@B@@@B@@@B@@@@A@@@@@A@A@@@AA@@A@@AA@ABA@AB@AA@A@@AC@A@@@AB@@@BA@@BA@@B@@@@A@A@CAA@@@AA@@A@@@@BA@A@AB@@C@A@@@A@@B@BA@@@A@A@A@@@A@@BA@@@A@CBA@A@ABA@AAABA@A@@@A@@BC@A@A@A@A@A@@BA@@@A@@@A@A@A@@@A@ABABA@@BA@A@@BA@@@A@@BBB@@@B@@@@A@AAA@@@@BAB@@AB@@A@@AA@@@A@A@ABC@ABA@A@A@@A@@A@CAC@A@ABCB@BA@A@AAA@A@@@A@@@A@AB@@A@@@A@A@@@@@AAE@E@A@ABA@A@@@A@@@AA@@A@@@A@A@ABA@A@@@A@@AA@A@@@@@@@@BA@@@C@A@@@CA@@C@@@@@A@BAA@@A@AA@@@@@@@@AA@@@A@@@A@@@AAA@A@@@A@@@@@@BA@@@@@@@@@@@@A@@@@@@@@AAC@CA@@@@A@@@@@@@@@A@@BA@A@@@A@@ACBA@@@@@A@@@A@@@@@A@@@@@AB@@@@@@A@@@ABAB@@@@@@@@@@A@@@@@CAAA@@@A@@@@A@A@CBABA@A@@@A@AA@@A@A@@@A@@@AB@@@@A@A@A@@@A@@@ABA@@@A@@@AB@@A@ABAB@BA@@B@B@@BB@@@@@@@@A@@BA@@@@BABAB@@A@@@@@@A@@AAA@@AA@@@A@@@@A@@AA@A@@@@AAAAA@@AA@@C@@AA@@AAA@C@A@A@@@AA@@A@@@@@A@@BA@A@@@@B@@A@A@@@A@A@@@A@@@ABA@A@@@@@@A@@A@@@@@A@A@ABA@A@@@A@@@@@AB@BAB@@@BA@AAA@A@CBA@E@A@CAA@A@A@C@A@@@A@@BA@AA@@AB@@A@@@AA@AA@@AA@C@A@E@@@A@A@C@A@E@C@A@CA@@@BB@@B@@B@B@@BB@BD@@@@AB@B@@B@@@B@B@B@B@BA@@B@@@B@@AB@BBB@DB@@BB@B@BBB@B@D@@@@BA@@B@@@B@BB@@BB@@B@@@@@@B@@@B@@@B@@@B@@@@@@A@@BAB@@@B@@@B@@@@B@@B@@@@@B@@@B@B@@B@@@B@@BB@@@@@@BB@@@@B@@@@A@BB@@@@BD@@@@B@@B@@B@@@@B@@@@@BBB@B@B@@@@@@AB@@B@@B@@@@@@@@A@@@@@ABAB@@AB@@@B@D@@@B@@@@BB@@@B@@BB@@@@B@B@@@B@@@B@B@BAB@@ABABA@@B@@@B@@@B@@@B@@B@@@@B@@@@@BB@@BB@DBB@@@@BB@B@D@B@B@D@B@@BB@B@@@B@DBDAB@@@@AB@@CACBA@A@ABABAD@B@D@@@BBBBBB@BB@@DBB@F@B@@@@BBBBD@B@B@@@B@@@B@@AB@@@@@B@@@BB@@BBB@B@@@B@@A@@B@B@@@B@B@B@@AB@BAB@B@@@BBB@@BB@B@@@@@B@BA@@B@@A@@BAB@@@@@B@@@@BBB@@@@@B@B@BA@@D@B@B@DADA@@B@@AB@@AB@B@B@B@@@B@B@B@D@@@B@BBB@@@B@@@BA@@B@@@BA@@BA@@BA@@@@@@B@@BB@BD@BB@BB@B@@@@@B@B@BAB@B@@AB@@@B@B@@@BB@B@@BB@BB@@@@@@ABAB@@@@AB@B@BAD@BAD@@@@@@@@BBBB@@B@@BB@@B@@@D@B@BBB@D@@@BB@BDBB@@@BAB@@@@@B@@@B@@B@BBB@BBB@@@@B@BB@@@@@ABABADA@@@AB@@@@ABA@@@@@@@@@@@@@@@AAA@@@A@A@A@EBE@CBE@C@C@C@A@A@@@A@@@A@@@A@ABCBEBCB@@@@A@@@A@@BA@@@@B@B@@@@AB@@AB@@@@@B@B@B@@@@@BB@BBD@BB@@@@@@@BA@@@AB@@@@@B@@@BBBBDBD@BBB@B@BAD@@@DA@@BA@@@@@@BA@@@@@@B@@@B@@@@@BAD@@@BA@@@A@CB@@A@@BA@@@@BA@@BA@@@@@@@@B@DA@@@@B@@@B@B@@HBDBBBFBHBFBD@DBLBDBB@FBPFL@LBLAJ@PCPARANAD@B@F@JBR@X@NCB@JCJCJEFCTENELEHGPKBAFADADAD@LEFAB@HCDADAB@DCFADCD@DADAD@DA@@D@B@B@D@D@DAJAJAJAPALCTAL@HAJ@D@HAF@LCDANCLCNAPALBD@FBD@HBNDRDJFHBVJBBHBDB@@HFDBBDBDBFFDFDDBHDHBNBN@NBLBNJHHHJJHJFNH@BHBFDJDLBH@F@D@N@@@R@JANAJAHBPDL@NAD@FA@@D@D@JCDALEB@DADADABADAJAFCHCJGDEBEBC@GDCJIFEJCNIDC@ECCCG@@IGIEA@@@EEAE@IBEFEBABADAFARAJANCVCN@H@XFJBL@NCLEB@DCDAB@F@@@H@LBFDDDBF@@@HBBDF@@@@BBBDDF@DBFHJ@@HJFFFBFBH@F@RCNAH@L@bGTGD@FAJAF@D@BBFBBBFFHFDBDDFDFFDBLJRPRH^JHDDBBDFFDFBBB@BDHFHDLHHDDBDDBBDBHJNHBBNFJFFHFL@B@BBD@@@BB@BFBDJPJZFNBBHJNHTPDDNDLBTFJFFDBBHHBFDFDHBD@DAL@HDFFP@BAH@D@D@D@FBFBDHJLLDF@BBHFPBHBDJLBBFCB@@@B@B@@@B@@@A@@AAA@@BCBCBABCDA@AB@F@H@J@DBD@@@@AB@BADEBADEBCDCDG@@D@BA@@@AB@D@@CAAC@@@A@CA@A@ADAF@@ADA@@AA@@A@DA@AA@@A@A@A@ADA@@@@B@B@B@@A@@AAA@@A@@@AA@@@@A@@AA@@AA@@A@@@BAB@@@B@@AA@@AA@@@@@@@@A@@@@@@B@@@@AA@@@@@@@@@@@@A@@@@@@AAD@@@FC@A@@@@BA@@@ADA@@@CAABE@ADAD@BAFCB@BAB@BADEBCFCD@@@@A@A@@@@@@BA@@@A@A@A@A@@@A@A@C@A@@@@@@@A@@@A@@@AAC@AAA@@@@AABA@@AA@@@A@@AA@A@A@@@A@@B@@@BA@@@@@AB@@@@A@@@A@A@C@@@A@A@@@ABA@ABA@A@@@@BABC@A@AAA@A@@@@@A@@@A@@@@@AB@BA@@@AB@BA@AB@BA@A@@@@A@@AA@CA@A@@@@@@@@@ABABABABAB@BA@@@A@A@@@@CAAA@@A@@A@@B@@@@@@@@@@@@@BA@@@@BA@@@A@A@@@@@@@@@@@@@A@@A@@A@AA@@@@@AA@@A@@@@@@A@@A@@@A@@@@@@@BA@@@@@@@AB@@A@@@@B@@@B@@@B@@@@AB@@@@AB@@@B@@A@@@@@A@@@@@AA@@@@@@@@@@@BA@@@@@@B@@@@A@@@@@@@@AA@@@@@@@A@@@@@@@@@A@@BA@@@@AA@@@A@@@@AA@@@@@@AA@@B@@@@AB@@@@@@@AA@@A@@A@@@@@@@@@AB@@A@@@A@@@@@@@AA@@@@@@A@@@@@@@AA@@@@@@A@@@@B@@@@A@@@A@@@AB@@@@A@@B@@@B@@A@@@A@@@A@A@ABA@AB@@A@@@@@A@@@A@@@A@@@@AA@@@@@@B@@A@@@@B@A@@A@@@@@AAA@@@@@@@A@@@@@@@@@A@@BA@@@@@A@A@@BA@@@A@@@@@A@@@@@@@A@@B@@@@@BA@@B@@@@AB@@A@@@A@@@@@A@@@@@@@A@@@@A@@@@AA@@A@@@@@A@@@@A@@@@@A@@@@A@@@@@AA@@A@@@@@A@@A@@@@@AA@@@@AA@@@@@A@@A@@@A@@@A@@@@A@@A@@@@AAA@@@A@@@@@@BA@@@A@@@@@AB@@@@@@@BAB@@@@A@@@@@AB@@A@@B@@@@@@A@@A@@A@@@@B@@A@@@@@A@@B@@@BAB@@@@@BA@@@@@@@@AA@@@@@@@A@@B@@A@@@@@A@A@@@@@A@@@@A@A@@A@@A@@@AA@@@@@AA@@@@A@@A@AAA@@@@A@@@A@@@@@@B@@@@AB@@@@AB@@@@A@@@@@A@@@@@@B@@@@AB@@@@A@@@A@@@@@A@@@@@A@@@A@@@@@@@AB@BA@@@@BA@@@@B@@@@@B@B@B@@@@BBA@@@@B@@@BA@@@@BA@@@@@@B@B@@@B@@@B@@@@AB@@@@@@@BA@@@@@@@A@@@@@@@@B@@@@AB@B@@AB@@@B@@AB@@@@@@A@@B@@A@@@A@@@@@AB@BA@@@A@@@@BA@A@@@@@@D@@@B@BA@@B@@@BAB@B@@AB@@@B@@@B@B@B@BAB@@@@@BB@@B@@@B@@@@BB@B@B@@B@@@@@@B@@@B@@B@@B@@@B@B@@@BA@@B@BA@@B@@@B@@@@AB@@@@AB@@A@@B@@A@@B@@A@@@@@@BA@@@@BA@@@@@@B@@@@AB@@@BAB@@AB@@@D@@@B@@@@@B@B@@@B@@@B@@@B@B@B@@@@@@@B@@ABA@@@A@@B@@@@A@@@@@@@@B@@@@@B@B@@@BBB@@@BBB@@@BAB@BA@A@@@ABABABCB@BA@@@@@@@@@@BB@@BB@@@B@@@BB@BBB@B@DBB@B@B@BB@BB@@@@@B@@AB@@ADADADABAB@DAD@B@@@BADADADA@@BA@@DCBCBA@A@A@@B@@A@@@@@A@@@A@A@@@AB@@@@AB@@AB@@@@@@A@@A@@@@@@@@@A@@@@@A@@AA@@AA@@AAA@@AA@AAA@AAA@@@@BA@@@AA@@@A@A@AAA@AAA@@AA@@@@A@@@@@@A@@@@@@@A@@@@@@@ABA@@@@@A@@BA@@@@@AA@@@@A@A@AA@@AA@@@@@@A@@@@A@AB@@A@@@AA@@@@@@@AAC@AAAAAAA@AA@@A@@@@@@@AAA@@B@@@@@@A@@@@@@@@@AA@@A@@@@@A@A@A@@@@@A@A@@AA@A@@@@@@@@@A@@@A@A@A@@@@@AB@@@@@@@@@@A@@@@@@A@@@@@@@A@@@@@A@A@@@@@C@AACAA@A@A@AA@@@A@@@@@@@A@@@A@ABA@ABA@A@@@E@E@A@A@CBA@@@A@A@@@@@A@AACA@@A@A@@@A@ABCDA@A@AB@@C@AAA@CA@@AAB@@@BABABABA@@@A@ACCCAAAAAAEAC@CAC@@@A@@@A@AAACAGCCAA@AB@BCB@BAB@@ADCBA@CBABABCB@@A@ABC@CBABCB@@CACAE@C@CACBA@C@CACAEACACCECAC@@@AB@BA@CBEBCBCBCBC@A@@BAB@B@BAB@@ABABC@A@ACACAAACAACA@A@GBA@CBC@C@IAGAA@A@C@C@C@G@E@A@@@A@CA@@CAAACAAAA@CAA@CBA@EDC@CBC@A@AA@@@A@AAC@AACCC@AAA@CACBA@A@A@A@@@@@AB@@@BA@A@A@@@AA@AAAC@A@ABE@A@@@@@A@@BABA@ABA@C@ABABABABA@@DABAFAD@@@BAAACCCAECAAA@A@@AAAAAC@EAC@@@C@A@@@A@@@CAGAECG@A@AA@@A@@@A@@@AB@@A@@@C@E@@A@@AC@@@AB@@@@@@A@@@@@A@@@A@ABA@A@@@@AAAA@AA@@@AA@@AA@@A@A@AA@@@AA@@A@ABA@A@@@@@A@@BA@@@A@AB@@A@@@@B@@@BA@AD@B@D@@@@@B@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@AAA@A@@@A@@@AAA@@@A@@@A@AA@@@@ABA@@@@@ABA@@BA@A@@@@@A@A@A@@@@@A@@A@@@A@A@CA@@@@AAA@AAA@@@AA@@CA@@@@E@CB@@A@A@@@C@@@C@A@E@@@E@@@A@C@A@ABA@A@@@A@C@C@A@AA@@A@EAA@@AA@A@AAA@@@A@@@@@A@A@A@@@@AA@@@@@AA@@AA@@AAA@@@A@@A@@A@@A@A@@@@@AAA@@@AA@A@@AA@A@A@AAA@A@A@C@A@@@AA@@@@@@@@AA@@AA@@AA@@@@A@AA@@@@AAAAAAA@AA@@AA@@AA@@AAA@@@@@A@@@A@@@C@A@@BC@C@C@ABA@@@A@@@A@CBA@A@@@A@@@A@@@A@@@@A@@@@@@@B@@@@@@@B@B@D@@@@@B@@A@@@A@A@A@@BA@@@A@@B@@ABC@@BA@AB@@@@A@AAA@A@AAA@@@@AA@A@@@A@AB@@CBABABA@@@AB@@A@A@A@A@AB@@A@@BA@@BA@@@ABA@@BA@@B@@AB@@AB@@AB@BABA@AB@@ABA@@BA@@@@@A@@BA@A@@@@@A@@@@@A@AAA@@AA@@@CA@A@@@@A@@@@A@@A@@@@@A@A@ABA@A@ABCBEBABAB@@@@ABA@@@C@A@@@ABABA@A@@@A@A@AAA@CAA@A@A@C@A@@@AA@@@@@@@A@@@@A@@@@@@A@@A@@@@@@AA@AAAAAAAA@@AA@@AA@@@A@@@ABA@@BA@@@@@@@@@@AA@@@@@@A@AAAAAA@@AAAAAAA@@A@@@A@@@A@A@@BA@@BA@@@A@@BA@A@AB@@A@@BA@@AA@A@AB@@A@@@A@@@@@@@@@AA@@A@@AA@@@@@@@@@A@@B@@AB@@AB@@A@AB@@A@A@@AA@@@@@A@@@A@@B@@AB@@AB@@A@@@AB@@A@@@A@C@@@@@A@@A@@AAA@@@@AA@@@@A@@@@AA@@@A@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@@AAA@@@@AA@@@@@@@AA@@@AA@@@@@@A@@AA@@@@@AA@@@@@@@@@AA@@C@@AA@A@@AA@A@@@@A@BCD@BEDADA@@BA@@B@@@BAB@B@@ABAB@BA@AB@@@@EBABA@@@@@A@A@A@@@A@A@ABA@AB@@ABA@A@A@A@@@A@ABA@@B@@A@@@@BA@A@AB@@A@A@A@A@@BA@A@@@ABA@ABA@AB@@A@A@A@A@AB@@AB@@AB@@A@@@ABA@A@A@AB@@C@ABA@A@@BA@@@E@ABA@@BA@ABA@@BCBA@A@@@A@A@AB@B@@@BA@ABA@@@AB@@AB@BA@@BA@ABA@A@@@ABA@A@A@A@@BA@@BA@A@AAA@@@ABA@@BA@ABA@A@@BA@A@ABA@@B@@@B@@BB@@@DAB@BAB@BCB@@@@ABA@@BA@ABA@AB@@A@ABA@A@A@@@A@ABA@A@@@A@A@ABA@@@ABABA@A@@@A@A@A@A@A@@@A@AB@@AB@@A@A@A@@@A@@BA@A@@BA@AAA@A@A@@@A@A@A@A@A@@@ABA@A@@@AAA@A@AA@@A@A@A@A@A@@@A@A@A@A@@@A@AAA@AAA@@@AAA@A@AA@@AAA@AAA@@AAAA@A@@AA@A@@@A@@@ABA@A@AB"],encodeOffsets:[[131979,46714]]}},{type:"Feature",id:"230125",properties:{name:"宾县",cp:[127.466634,45.745917],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@ACA@@AA@A@@@@AA@@AA@@A@@@@@@@@@AA@@@@@@A@@@@@@@AB@@@@A@@B@@@BA@@@@@@B@@A@@@@B@@@@@B@@A@@B@@@@@B@@@@@B@@@@@BA@@@@@@B@@@B@@@@AB@@@@@@@@A@@BA@@@@@@B@@AB@@@@A@@B@@A@@B@@@B@@@BA@@@@@@B@@@BAB@@@@@@@@A@@@@@@@A@@BA@@@@@A@@@@@AA@@@AA@AA@@A@@@AAA@@A@@A@@A@@@@@A@A@@@A@AB@@ABA@ABC@@@ABA@@@A@AA@@@@A@@@AA@@@@AA@@@A@@A@@@AA@@A@@@@AA@@@AA@@@@A@@@A@A@@@@@A@A@@AA@A@A@@@@AA@@@AA@@@@@@@A@@BAB@@@@AB@@A@@@@@A@@AA@@@AAA@A@@@A@A@@@A@@@A@@B@@AB@@@@@@AA@@A@@@@@A@@@A@@BA@@@A@@@A@A@@A@@@A@@@@@@A@@@@@A@@@A@@B@@@BA@@@@@@@A@@@@@A@@@@AA@@@@A@@@@@@@@A@@B@B@B@B@B@@@BA@@DA@A@@@AB@@@BA@@B@@@B@@@@@B@@@@A@@BA@@BA@@A@@A@@@@@@@A@@@A@@@A@@@A@@@@@A@@@@@@@@@A@@@@A@@@@@A@A@@@A@@@@@A@@@A@@AA@@@AA@A@@@@A@@A@@@A@@AA@@A@@ABA@ABABA@@BA@A@A@A@AA@A@A@A@A@A@C@A@@@ABA@A@@A@@@A@A@AAA@@A@@@A@@@C@AB@@@@A@AB@@C@@AA@A@AAA@A@@@A@A@@@A@@@@@A@@A@@AA@@A@A@@@@@A@@@ABAB@B@@A@@@AAA@@@@AA@A@@@C@A@@A@@@@AA@A@@@AB@@A@A@@@A@@@@@A@@@@@@@@@@@@@@@@B@@@BA@@@@@@B@@@@@@@@@@A@@@@AA@@@@BA@@@@AA@@B@@@@@B@@@B@BA@A@@@A@A@A@@@AB@@A@@@AB@@@BAB@BAB@D@BAB@B@BA@@BAFCBA@@@A@@@@A@AACA@AA@@@@@AA@@A@B@@A@@B@@A@AB@@@@@@@@A@A@AB@@ABA@@@@@AB@@@B@@@B@@@@@@@@A@@@@@@@@@@B@@@B@@@B@@A@@B@@@B@@@B@@@@@@A@@@A@@AA@@@A@A@@@@@A@@@@BA@@@@@@@@@@@@AAAA@A@@A@@@@A@A@@@@A@@AA@@A@@AA@@@A@A@@@AAA@A@@@A@@@@@A@@A@@@@AA@@@AAA@@A@@A@@A@@@@@@AAA@@@@ACA@@AA@@@@@@A@@@@@@@A@@@@BA@@BA@@@A@A@@BA@@@C@@@AA@@A@@@@@@@A@@@A@C@A@@@AAA@@@@@@@A@@@AB@@A@@BA@AB@@A@@BAB@@@@A@A@@B@@AAA@@@@BA@A@@@AB@@A@@@@@A@@@AB@@@@@@AB@@@@@@@@AB@@@@A@@@A@@@A@@@@@@B@@@@@@@BA@@@@@@@A@A@@@A@@@@B@@@@@@@B@@@@A@@@@BC@@@ABA@A@@@A@@@C@A@@@@@@@@@A@@BA@@@AB@@ABA@@BA@A@A@@@CAA@@@AAA@@@@A@A@@@@AA@AA@AAA@AAA@@A@@BA@@@@@@@A@@@@A@@@@@@@A@@@@AA@@@@@A@@@AB@@A@@@@@@@@BA@@@A@@@@@A@@B@@@@@@@B@@@@@@ABA@A@@BAB@@A@@@A@@@A@A@@@@@@@@@C@@@@@A@AB@@@@A@A@AB@@@@A@@@@AA@@@@@A@A@@@A@@@@@ABA@A@AB@@@@A@@@@@@AA@@@@@A@AB@@@@A@@AA@A@AA@@@@AAA@@@AA@@A@A@@@@@AB@@A@@@@@C@@@A@AA@@A@AAA@A@@@@BA@@@@@@@ABA@AB@@@@A@@@A@@A@@A@@@A@@@A@C@@@CB@@A@A@A@@@@@A@@@@@AB@@@@@@A@@@ABA@A@@@@@@BA@@B@@@B@@@@A@@@@@A@@@@@A@@B@@AB@BA@AB@B@@@@AB@@@@AB@@AB@@A@A@@BA@@@AB@@@@@@@@@BA@@@AB@@@@A@@@A@A@AAA@@@@@@@@@@@@A@@A@@@@@@@@@@@@AB@@A@@@@AA@@@@AA@@@@AAA@@@@@@@@A@@A@@AA@AA@@@@@@@AB@@A@@A@@A@@AA@@@A@@@@@@@@BA@@B@@A@A@@@A@@@A@@DC@AB@@A@A@@@@AAA@AAA@A@A@A@CAA@A@C@A@A@A@@@A@A@A@@@A@@@A@A@I@AAC@C@CAA@@@A@C@CBC@G@C@@@A@@A@CAA@AAC@AAA@AAAAA@@@AA@@ACAAAA@@AA@AA@@ACA@@@@@C@@@CAC@AAA@CAAAAAAAAAAA@@@A@@A@C@CAA@@@@@AACACAAA@@@@@B@@A@@@@BC@@@A@A@ABA@A@@B@@ABA@@BA@@@ABA@@@AB@AA@@@A@A@AAC@@@A@A@@@@AAA@AA@AA@@@@A@@@C@CBC@CBA@EBGBEBA@ABA@AAC@C@ABE@ABABC@C@A@ABA@A@E@A@A@@@@@A@A@ABC@A@@@@@A@A@@@A@C@A@AAA@C@C@C@C@C@A@@@A@@@A@@@@B@@A@A@@B@@@B@@A@@BAB@B@BCDCF@BABCD@BA@A@ABA@@@CBA@A@AAA@@@@@@@@@A@@@@@@@@@CB@@@@ABA@C@ABC@@@A@ABABABABC@EBCBA@A@C@@@A@ABA@A@A@CBA@@@@@A@@@CA@@A@@@@BA@@@@@ABCB@@ABA@@AA@AAAA@@@@A@@@@@@BA@@@A@ABA@@@A@@@A@A@@@CBC@@BA@A@A@AA@@A@@@AA@@AAAA@A@@A@@A@@BA@@@AA@@@@AB@@A@C@@AA@AAA@A@@@AB@BAB@@@@A@@A@@A@@@A@@A@A@@@@A@@@@@ADA@@@A@A@@@EAA@AA@@AA@@AA@A@AA@@A@@AA@@@@A@@CE@ACAACAA@AAAAAA@CBCDGD@@A@@BA@A@@@EDCB@@A@A@A@@BCBAB@@@B@B@@ABA@@@AB@@A@@@C@A@A@A@@@C@A@C@@@C@GDABA@@@A@GEGC@@@@DAFCDADA@A@@AAA@AAAA@A@A@@@ABABA@@@AA@CCA@AAA@AAA@@ACC@@@@A@A@A@A@CA@@@BA@ABCDCBABCFAB@@A@A@GCCAA@@@E@A@ABA@@B@@@BA@@BABA@@BAB@@AB@BA@@@@BA@@@@BA@@@@@A@@@A@A@AAA@A@@@AAA@@@C@A@@@A@A@A@@@A@A@A@A@@@C@A@A@A@A@AA@@A@A@A@@AA@AAA@@@AA@@A@@@AA@@A@@AA@@@@@A@@@A@@@A@A@@@C@@@A@@@A@@@AA@@A@@AC@@AA@@@AA@@A@A@@AA@A@A@A@A@@@@@A@AA@@A@@AA@@@AA@@A@@AA@@@A@@@A@@@A@A@A@A@A@A@A@@@A@A@ABA@A@A@@BA@A@ABA@A@AB@@A@@@A@AB@@A@@BA@@@AB@@AB@@@@AB@@@B@@@@@BA@@BA@@@A@@@ABA@@@A@ABA@A@@@@@A@A@A@AAA@A@@@A@A@AA@@@@AAAA@@@@A@@@A@ABA@A@@@A@A@@@A@@AA@@@@A@@@@@AA@@@@A@@@@@@B@@A@@@@@@@A@@A@@A@@@@AA@@A@@AA@@@@A@@@@@A@@@A@@@@@A@@@A@@AAB@@A@@A@@A@@A@@@@@AA@@@A@@@A@@B@@A@@@@@@AA@@A@@AA@@AA@AA@@@AA@@@AAA@A@@AA@AA@@A@A@AB@@A@@@A@AAA@@AA@AA@@A@A@C@A@C@EBA@EBABA@CBC@@@CB@@A@C@ABA@ABC@ABA@A@A@ABA@@@@BA@@BABCB@BA@@@A@C@C@AAC@A@A@A@@BA@@@A@A@@BA@@@AB@BA@ABA@ABABA@ABA@A@A@A@E@EA@BA@A@A@@BAB@@AB@@ABCB@@A@@@A@A@AAA@A@E@C@A@C@AAC@EAA@A@@@ABA@@@@B@B@@ABABA@CBABABC@ABA@ABABABABAB@@C@@@@@CACAA@CAA@@@A@@@A@@@A@A@A@ABAAA@C@@@A@A@@@A@A@ABA@A@A@C@ABC@A@ABA@A@@BCBADAB@@AB@@@@@B@@AB@@AB@@@B@@B@@B@B@B@BBB@B@BA@@BAB@@AB@@@@A@A@ABA@@@ABA@@@ABA@@@A@@@@B@BB@@BA@@B@@BB@B@B@@AB@@AB@@B@@B@@@B@@@B@@@B@@A@A@A@@@AB@@@@@BAB@@@B@@@BB@@@@B@@@@@@@@@@A@A@A@A@@@ABA@@@@B@@AB@B@BBB@B@@@@@B@B@@@B@@@@@@A@A@@@A@AAA@A@@@A@@@CBA@ABA@ABA@A@ABA@@@@@ABA@ABA@@@@B@@A@AA@@A@A@@@@@@@A@AA@@@B@B@@@@@BA@AB@@A@A@A@@@A@A@A@A@A@A@@@CAA@A@A@@@A@A@@@@@CA@A@@A@@@A@@@@AA@ABA@@@@@@@AA@@@@@@@A@@AAAAAAA@@@@@AA@@AAA@@@@@A@@@A@A@@@A@@@A@@A@@@@AA@@@@@@@@ABA@@@A@@@A@A@A@@A@@A@@@@@AA@@A@A@A@@@A@@AA@@@A@A@A@C@CBA@A@@@@B@@@B@@@BA@@B@BA@@@@B@@A@@@AB@@AB@@@@@@@B@BA@@B@@AB@@@B@B@@@B@@@BA@@BAB@@@B@@AB@B@@ABA@@BA@AB@@A@ADAB@@AB@@@B@BA@AB@@@BA@@BAB@@ABA@A@A@AB@B@@@BA@@BBBA@@BA@A@@B@B@@@B@@@BAD@@AB@@A@@BA@A@@@A@AB@@@B@@@BA@@@AB@@@@A@@B@@ABA@@BA@@B@@@@@@BB@@@@@@@B@@@@@@@@@B@@@@@B@@AB@@@@@BA@@@@B@@AB@@@@A@@B@@@@@B@@@@A@BB@@A@AB@@@@CBA@@BA@A@@BA@@@ABA@A@@B@@AB@B@BA@@@@B@@@B@@A@AB@@@BB@@BAB@BABAB@@@B@B@@@B@B@@AF@B@@@@AB@@ABB@@B@B@B@B@@@B@@@BABABAB@BAB@@@@AB@@@@@B@@@D@@B@AD@@@B@@@BA@A@@@@@@B@@@BB@A@@@AB@@@@@B@@@@AB@@@B@@BB@BA@AB@@@B@@@@AB@@@@B@@@@@@@AB@B@@B@@@AB@@@@@B@@@@A@@BA@@B@@@BA@@BA@@B@BA@@@BBBD@BA@@@AB@@@B@@AB@@DD@@BB@@A@@@@B@D@@@@@B@@@@B@AB@@B@@@@B@@@@BBA@@@@@@BA@@B@B@@@B@D@@@@@B@B@@@BA@@B@B@@@B@@@@A@A@@BD@@B@@@B@@CB@@A@@AAA@@AB@@@B@@@B@B@BB@@B@@A@A@A@@@@B@@@@B@B@@B@@A@@@A@@BB@@B@@A@A@@@@@B@BB@@@@A@CBA@A@@@@@BB@@@@@@@@AB@@B@@B@@@@@BB@B@@BA@@BB@@@BA@B@@AB@@@@B@@B@B@B@@BB@@@BA@@@A@A@@AA@A@ABABAD@@@@@@B@B@@@@@@BA@A@@@@B@@@BA@A@C@C@A@@@@B@@@BBB@@@BA@@@A@A@@@ABA@A@AA@@A@@@@@A@@@@@A@@A@@A@@@A@@@@@@@A@@@A@@@@@AB@@@@A@@@A@@@AB@@@BA@@BABCB@@@@@B@@ABA@@B@B@@BBDB@@ABA@@@@B@D@@B@@BA@@B@@@BB@B@@@B@B@@@@B@@@B@B@@@B@D@@@B@@B@@@@BA@A@@BAAA@@B@@AB@@ABA@A@@@@B@@DF@@C@ABA@A@@B@@@@@BA@@B@@B@@@ABA@@@AB@A@@A@@B@@AB@@AA@@AAAACBA@ABA@@@@B@BABABA@A@@@@BBB@@@BABA@A@@@A@@B@@ABA@A@@B@@BB@BA@AB@@BBB@@B@@C@A@A@A@E@A@@B@B@BA@AB@@ED@BA@A@CAAAA@@B@@@B@B@@AB@@C@AB@@@BA@@@A@C@@@A@@@@@@BBF@@ABA@CA@@AB@@@@@BDB@@@B@@C@AB@@@@FB@B@@@B@BE@@@A@@B@BBBBB@B@@@BA@EAABA@@BA@@@BB@@BBA@@BA@C@A@@@@@AB@BAB@@@@BB@BB@@BA@@BC@@B@@ABABA@A@ABA@A@C@@@A@@B@B@BBBA@A@A@A@A@@@@@AB@B@@AB@@AD@B@BA@A@A@AB@@A@@B@@BBA@@@ABEH@BA@@@A@@A@A@AA@@@AB@@@@BBA@@@CB@@@@@@@BBBAB@@@@@BB@@B@@A@@BABABCBAB@BC@ABAB@BABBB@@CF@@@BB@@@ABCBAB@@@@A@A@@B@@ABA@@B@@AB@@@BABA@@B@@@B@@@B@@@BA@@@AB@@ABA@@@ABB@@@BB@@@BA@@B@BAB@@@BB@AB@@@BABA@@@@@BB@@@@A@A@@@@@@@@BA@@@A@@@A@@@@@CB@BB@B@@@@@A@A@@@A@@@@@@@@B@@@@AA@@A@EB@BA@A@@BB@@@@BCD@@A@@@@@A@ABA@@@@BAB@@@@BB@@AB@@B@@B@@A@@@BBB@@@BB@@@@ABA@@@@@@B@@ABC@ABCBA@@BB@AB@@@AA@@@@@@B@@BB@@@@CB@@@@@B@@@BA@@@@@@@@B@@A@@@@@@B@@B@@@B@@@@D@B@@A@AAA@@BB@@B@@A@A@@@@AAB@@@BAB@@@@@B@@@@@BB@D@@@@B@B@B@@A@CD@@@@@@AB@@A@AAA@A@A@@@AB@AA@A@@B@B@@@@@B@@@BA@@@A@@BC@@@@H@DBDBDBFBHBFF@B@JDDBF@HALADAB@B@LBNDLB@@\\BXBD@JBNFD@DBF@HBLANAJAJCD@HCVGVGHAPEHCREDCDCFGJILEHEHERCJAPAVEfE`CLAF@F@PFXHJBPD@@FBJBFAB@F@NEJAZIPE@ALAHCHAJCL@J@`CFANERCRIDA@CBGAE@M@KEAA@A@AAAAAC@AAE@A@GDCAC@CFKDCHAJ@HBPJFDJLNFFBF@HAJAV@N@FAH@FALCLCH@H@LDPFLFHBD@HBVFJD@@B@@@B@D@JBJBFB@@HDHFHFBBDFFJBLDL@@@FBFDHHFB@HBJBLDDBBDBD@FAD@DCBABEDGDIDABA@@@@B@BADAJD@JDJDTDD@D@HANAP@DBD@@DBF@BC@C@CBQBC@CBABAB@BAB@BBB@BBBDDDAJADBBCBABADAB@D@DBB@B@BA@@BC@AB@D@BADABA@AAAAAECAA@A@@@A@AB@DAF@H@FAB@DALAJAD@FAF@F@F@F@B@B@BAB@B@BAB@B@B@DBBBD@BBHFFBFBFD@@DBD@F@D@FCPIDCHGBAFMDG@KAOCI@O@ABCDGBCFEFCB@FCFAF@J@N@JBHD@@RDBBBDHDHFFHFDHBBBD@D@B@D@FAF@HEDABEAC@ACCAAACACACECCECC@AAC@A@A@A@A@@BADCBAFADAD@H@B@H@F@F@H@F@D@D@B@FAF@DAD@D@HAB@JAHAD@LBNBLBT@bDLDHBHBH@D@HAH@HAFALCLCFADAFAD@B@JAD@D@LANCD@REB@J@J@H@HDFDFDFJJX@@FDLDB@D@D@B@B@RCRERAVCJANEJELIFEDCPIHENEHCH@L@P@LBRFB@BBHBFDFBFFDBFBHDDDD@DBDBFBF@D@DBD@D@F@FBB@D@D@BBFBD@DBF@D@F@B@F@D@D@RALBJBLDLFHDFHHPBBB@@@DDBBDDFD@@DB@A@A@@@A@@B@@C@A@@@@@@B@@AB@@A@@B@@AB@@@DAB@@@B@@A@@BC@A@@@@@A@@@A@@@@B@@A@@@@B@@AB@@C@@BC@A@AAA@AACACAA@A@@@A@@@@BA@@B@@A@@@@@@AAC@AAA@@A@@@@@A@A@A@@@@BA@@BA@@@@@A@A@@B@@AB@@@B@@@@@DAFADABAB@@@B@@@B@@@B@B@D@D@D@F@DAF@FAB@B@B@@@B@BB@@@@@@@@@@@@@@B@BA@@@@BA@@B@BCBABA@@@@A@@A@A@@A@AAA@AAA@@@@A@@@A@@@@BA@A@@AAACA@@A@@@CAA@A@A@C@@@AA@@AA@@@AAAA@@@@@@@@BC@ABC@A@ABA@@@@BABA@@@@@@AAA@@AA@A@@A@@@A@A@@BA@@@ABA@A@A@A@@@@@AAAA@@AACA@@AA@@@@@@@AB@@AB@@AB@@A@@@AB@@A@@@A@AAA@@@C@A@A@A@@@A@A@A@A@@BA@@BA@@@CBCBA@A@C@@@ABA@A@@@@@A@AA@@@@@A@@@@BA@AB@@@@AB@@A@A@@@@@AAA@@AA@A@@@ABA@ABA@@@A@A@A@@@A@AB@@@@A@@@AAA@AA@@A@@@A@@@@BA@@@A@@@A@@@A@AACAA@A@@A@E@A@CA@@AAA@AAAA@A@@@C@ABCBABAB@B@DADB@@BA@@@@BAAC@C@A@@@AAA@@@A@C@A@A@C@AAA@@@@AA@CAA@@AA@@@@A@@@@@@AA@@@A@@@A@@@A@@@ABAB@BA@ABA@A@@@A@@@A@A@@@@@AA@@@A@@AA@@@@@A@@@C@A@@BA@@BABA@@@@B@@@@@@@@@@AA@@@BA@@@@@@@A@AAA@A@@@@@A@@A@@@@AA@@@@@AC@@@@AAB@@@@@@A@@A@@A@@@@A@@AA@@@A@@@@A@A@@@A@@@@@AA@@@@@@A@@@A@@BA@AB@@@@@@@@A@@@A@@@A@@@A@@@@A@@@AA@@AAA@@@A@@@AB@@@@@C@AAA@A@AAA@@CAA@AAA@@BA@@@A@@@ABA@A@A@A@@@A@@@@ABA@@"],encodeOffsets:[[131318,46852]]}},{type:"Feature",id:"230123",properties:{name:"依兰县",cp:[129.567877,46.325419],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@C@A@C@@@A@@@A@@@@@A@@@@BA@@@@@@@@@@@@@@@A@@@@BAB@B@BA@@B@B@@A@@BA@A@A@@@A@A@A@A@@BA@@@@@A@A@A@@@ABA@@@ABA@A@@@A@A@A@@@@@ABA@@@A@@@@@@A@@@@@A@@@@A@@@@@AA@@BA@@@A@A@@@@@A@@@A@@AA@@@AAAA@@AA@ACA@@A@@A@@@A@@@@B@@A@@BA@@@@@A@A@A@A@@@@@A@@B@@A@@@@@A@@BA@@@@@AA@@@@@@A@@B@@A@@B@@A@@BA@@BA@@@AB@@A@@@@@AB@@@@@@A@@@@@@BA@@@@@A@@@A@@@@@A@@@@@@@AA@@@@@BA@@@@@@BA@@@@@@@A@@@@@@@@@@@@@@AA@@@A@A@@@@AA@@@A@@@@@@A@@@A@@@@AA@@A@A@@A@@A@@@@@A@@@@@@@@@@A@@@@@@@A@@AA@A@A@AAA@A@AAAAA@AAAA@@@@A@@B@@@@A@@@@@@@A@@B@@@@@@@B@@@B@@@@A@@@@B@@@@@@@@@@@@A@@@@BA@A@@@A@A@@@A@@B@@A@@BC@@BA@A@A@@@@@A@@@@@@@@@@@A@A@@@@@A@CBA@A@A@CB@@A@AB@@A@CB@@A@@@@@A@A@AAC@CAGA@B@@ABA@ABA@EBA@AB@BA@A@CA@@AAAAA@A@A@@ACACCC@CCE@AA@@A@A@A@C@AAA@AAEAAAA@A@C@AB@@CAA@A@AAABA@@@A@AACAAACAA@A@@A@C@ACACAAAA@CB@@AB@BABA@ABC@A@@B@D@B@@@BA@C@ABA@@@AB@@ABA@A@A@C@@B@@AB@BABA@A@C@C@ABA@A@@@A@@@A@@BCBA@A@@@AB@@@@A@@@@A@@AAAAA@@A@A@A@@AAA@@A@AA@AAA@A@AAC@CAAAACACA@AA@BA@@BA@ABA@C@CBA@CBCBA@@BABA@@BC@ABA@@BAB@@A@C@@@AACAAACAA@A@AAA@A@@@A@AA@@CCCACAAA@@@ABADABAB@BAAA@A@@DABAD@BAB@@ABA@@AAAAA@@@@A@@@A@@BA@@@@AA@@A@@@@@@AA@@@A@A@A@A@AACAAACACAA@@@C@A@A@@AC@A@A@@AAAAA@C@@A@C@CBA@ABCBCBCB@BA@@BA@@@@@A@@AAAA@A@A@CAA@A@AAA@@A@@@A@@AA@@AA@@@@@@@@@@ABA@ADABAB@B@@@BABA@AB@@A@AA@@A@AA@@A@A@@@@@A@@@@@@@@@@B@@A@@@@B@@AB@BA@@@@@@@A@A@A@@@A@@@A@@@A@A@@@@@A@@@C@@@A@@BA@AB@@A@@B@@A@@@@@@@AB@@@@AB@@@@@@@BA@@@@BA@@@@BA@@B@@A@@B@@A@@@A@@@ABA@@@AB@@A@A@@@A@@@@AA@@@A@@@@@@@@AA@A@A@@A@@A@@@A@@@AA@@@@A@ABA@A@A@@@A@@@A@@BA@A@AB@@A@@@ABA@@@C@@@@@@@@BAB@@@@@B@@ABA@@B@@@@@B@@@BA@@@@B@@@@A@@BA@@@A@@BA@A@@B@@A@@@@@@@@@@B@@@@@@AB@@@@@@A@@B@@A@@@A@@@A@A@@@@@@@AB@@@@AB@@A@@@@@AB@A@@A@A@A@@@@@A@@@@@AB@@@@ABA@@B@@@@@@@B@@@B@@@@@B@@@@@B@@@B@@A@@B@@A@@@@@@@@B@@@@@B@@A@@B@@A@@B@@A@@@@@@@@B@@@B@@B@BBB@@@@B@@B@@B@@@@BB@@B@@@@BB@B@@@@@@B@@@@@B@B@@@@@BA@@@@B@@@@@@BB@@@@@@@@AB@@@@A@AB@@A@@@@B@@@@@BA@@@@@@BB@@@@B@@@@A@@@@BA@@B@@@@@B@@A@AB@@@@@@A@@B@@@@@B@@AB@@@@@@@B@@BB@B@@B@@@@B@@B@@@B@@@B@@@@B@@@@BB@@@@BB@@@@B@@@@B@@@@@BB@@B@@@@@B@@B@@@@@B@@@@@@B@@@@@BB@@B@@B@@@@@@B@@@@@@@B@@@@@B@@@BA@@B@@A@@@AB@@@@A@@@@B@@@@@@@B@@@@@B@@AB@@@B@B@@@@AB@@@B@@@@@@@@@B@@@@@@BB@B@@@@@BB@A@@@@@@BA@@@@@@@BB@@@@@B@@@B@@@B@@@@@BA@@BAB@B@B@B@@@B@@@BA@@@A@@@@B@@A@@B@@@B@@@B@@A@@@@@@B@@@@B@@B@@@@@@@B@@@@B@@B@@@@@@@B@@@BA@@B@@@@@@@@@BB@@@BB@@@@@@A@@B@@A@@@BB@@@@@@BB@@@@@B@@BB@@@@AB@@@B@@@@@@@@@B@@@@@@BB@@@@@@@@@B@@A@@@@@@@AB@@@@@@@@@@B@@B@@@@@B@@@@@BA@@@A@@B@@A@@B@@A@@@A@@@A@@@@@@BA@@B@@@@@@AB@@@@@@B@@@B@@@@B@@@@B@@@BB@@@@B@@B@BB@@@@B@@@@@@@@@@@@@B@B@@AB@@@@AB@@@@@@@@@@@@A@@@@BB@@@BBDB@@@@@B@B@@ABA@ABABABAB@B@@@@@@@@@BDBB@@BB@@@@@@BABA@@BABA@@B@@ABA@@B@@@@@B@@@B@@@@@BBB@B@BADAB@@@@@BAB@BAB@B@@@B@B@@@D@B@B@@@B@@A@@B@@@@AB@@A@@@@B@@@B@BBB@@@B@@BB@@ABBB@@@@BB@BBD@B@@@B@@@B@@@@@@@B@D@B@B@@@B@B@B@B@@AB@@@@@@@B@B@@C@EDADCFABA@ABA@EDABC@CB@BAFBB@D@@CB@B@@AB@@@@@BED@@C@BB@@@@@@@B@@@@@@@@@@B@@B@@A@@@@@@@@B@@@@@@B@@BB@@BA@@@A@AB@@B@@@BB@@BB@@@B@@B@@B@@@BB@BB@@@BA@A@A@@@@@CB@B@B@B@BB@@BCBB@@@BB@@CB@BE@CB@B@BDBB@@@D@BB@DC@A@@B@@ABC@@@CHCDADCFABCFABA@@B@@C@CAI@G@E@A@@BCBADABADAD@@BB@BB@@@A@@@A@A@@@A@EDFN@B@BBB@F@D@D@D@B@BBB@DBB@BBBBDBBBBDD@BB@DBBBBBDDFBDDDBDBBBBB@@BBBBBBBFBBBB@@DFBBDDBD@BB@@BBBBDBBBBBDBB@@B@@B@@B@BDBDFFDFDD@B@@BBBBBDDDDFDFBBBB@BB@BDDD@BBBBBDDDDBBBB@@@B@@@B@D@B@BBBBB@@BBBBBBD@@BBBBBDBBB@BB@@@AB@@@@ABAB@@AB@BAB@B@BA@@@ABA@@@AB@@A@@B@@AB@D@@A@@BA@@B@@@BA@@@@BA@ABC@ABABA@@@A@CAEA@@G@@@AB@@@B@@A@@BA@A@ABA@A@A@A@A@@B@@A@@BA@@B@@ABAB@@@B@@AB@@@@AB@@A@@@AAA@A@AAEA@@A@@@A@@@@BA@@B@B@@AB@@@@A@@@AAA@AACAAAA@AAAAA@@@A@@@A@@BA@AB@BA@@@@@A@@@C@A@C@C@C@A@A@A@@BA@C@A@A@ABA@A@@BCBCBCDCBCBCDCB@@A@@@A@A@AAC@@AA@@@A@@BA@@@@B@@@B@@@B@@@B@@@B@BAB@@@B@D@B@@@BB@@@@@B@@@@@B@@@BAB@B@@@B@@@BBDBBBBBBBBB@B@D@@@B@@@B@@@B@@@@@@BBB@@@B@BB@@@@@@@B@@@D@B@B@@@@@B@@@@@BA@@@ABA@@@AB@@@AA@@@A@AAEC@@CA@A@@A@@@@@@@ABA@@@ABA@@@ABABA@@BA@@B@@AB@@@@@B@@@@@B@@@B@B@@@F@D@@@BA@@@ABA@@BABA@@@AB@@A@@BABCB@@@@ADA@@BA@@@A@ABA@@@@@A@A@C@C@A@C@A@A@@@@@C@@@ABA@@@AB@@A@@B@@A@@@@BA@A@A@AB@@A@@@A@@@A@@@@@AB@@@@A@@B@@@@@@@@@@@BB@@B@@BB@B@@@@BBBBBB@B@@@B@B@B@@AB@@@B@@AB@B@@AB@@AB@@@@@@@B@@@@BB@@@@@B@@@@@B@@@@@BA@@B@@@@ABA@A@AB@@A@@@AB@@A@@@ABA@A@@@A@A@A@@@A@@@A@@B@@A@@@AB@@A@ABA@ABA@A@C@CBCBABCBAD@@AB@B@D@B@BCBA@C@CB@@AB@@AB@@AB@@@B@BBBAB@@ABABCB@@AB@B@@BDDB@BB@@B@@@@@BABABABAB@BA@@@@B@B@B@@@@@BA@@@ABA@A@@@@@@@AB@B@B@B@@@BABB@@B@BBBDBBBDB@BB@BB@B@BBB@H@D@D@BBB@@BBBB@@BBAB@B@@AB@B@BBB@@B@BBD@@BDB@BDB@BBBBB@BBBAB@@A@ABCBA@CBCBGFCBCBABABABCBCD@B@D@DABABEBCDABCF@@A@@BAB@@@BAB@B@B@BAB@@@BBB@B@BAB@@@B@B@B@BA@@B@B@@@@@B@@BBBBBB@@BB@@@BABADAD@BABABADAB@BAB@B@BA@BB@B@BB@@DBB@@BB@@@BB@@@@BB@@BB@@@@BB@@@BBB@B@D@D@BAB@B@B@BAB@B@@@@BBB@@@B@B@@BBBBB@@B@@BB@@DB@@BB@BB@@BBB@B@BBD@B@B@BB@@BB@@BB@DBBBBBB@B@BB@@@@@@B@@B@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@B@@A@B@@@@@AB@@@B@B@@BB@BBB@@@@B@@@@@B@DAB@@@DA@@B@B@@@BB@@BBBB@@BA@@D@B@@@@@B@@@@B@@B@@BBBB@@BBB@@@@B@@@@BBB@BB@@@BB@@@@B@@B@@@@@BD@@@BB@@@@@B@@@@@B@@@@@@BBBB@@@@@B@@@@@@BB@@@@B@@@BABA@@@@@@@@@BB@@BB@@@@@BB@B@B@@BBB@@@B@D@D@B@@@@@B@@@BC@@@@B@@@B@@@B@@@B@@@BB@B@@BBBB@@BB@@B@B@B@B@BBB@@@B@BA@@B@@@@@B@@@@@BBBBB@B@B@@@B@B@B@@BB@D@B@BA@@B@DCD@BAB@@@@BB@B@BBD@B@DBDBB@DB@@BBBBBB@@BB@B@@@B@B@D@@D@B@B@@@B@B@@@BA@@B@@AB@@AB@BA@@DAB@DAB@D@B@B@BA@@D@B@B@B@BAB@@@D@BA@@B@B@@@B@BAB@@@B@B@B@@@BA@@B@B@FABAB@B@@@B@D@@@B@B@B@B@@@B@BAB@DAB@D@B@@@@@@A@@@@BABABA@ABA@@@AB@@@B@B@@AB@@@BAB@@@@A@AA@@AAA@@@A@@B@BABA@@B@BA@@BA@@BA@@@@@@@AB@@ABAD@@A@@@@@@@AA@ECA@@A@AA@@A@@@AB@@ABADABA@@@AB@@@BAB@@@B@BAB@B@BA@@@@@@BBB@B@@@@@@@B@@AB@@AB@B@@@@@@AB@@@@BB@@@BBBBBBBBB@BBD@B@BBB@@@DAB@B@B@B@@@@@BB@@BB@@@BDB@@@@@B@B@B@@BB@@B@BB@@B@B@D@B@@@B@B@@@BBB@B@@BB@BBDB@@B@@B@@B@@@@@B@B@@@B@@@B@BA@@B@B@@@B@B@B@B@@@B@B@@AB@@@B@B@B@BADAB@@@B@B@B@@@B@B@DA@@B@@@BABAB@BAB@D@@@D@BAB@B@D@D@@@@@BAB@B@D@B@@@B@B@D@H@D@@@B@FDB@BBB@@@@@FBBB@@B@@@B@B@BAB@@@@@D@BALAPCPCJAFALAAABC@@DAFEDADADCDADADBFBB@BAB@BA@AB@BC@C@ADABAB@DABCBA@ADADADADBDABAB@@BB@B@DC@@@AA@@ADA@CAAAA@A@A@CB@B@BA@@EI@ABCBAFABA@@@AB@JCDAAEBAB@BAH@BALEDAFADAJEFAB@H@JBDAFFBBDB@BB@DBBADB@AB@B@BA@@BAHCFAJ@FAF@@@FALBA@BCDABB@B@@DBB@B@AABAF@BBB@DA@AA@A@BEB@DDB@JAF@@@B@DBLHHFFBJDNFfPRFBB@@LFPJLJHHFFLN@@BBBBBBBBBBBBDBFDHDDBHBFBbJPFRJPPBB@BBBBBB@@BDDDH@@BB@@BA@ABABA@@BA@@BABA@@BA@@BAB@@AB@@A@@B@@AD@BAB@@AB@B@@AB@@ABA@A@@B@@A@A@@@@@A@@@@B@B@D@@@@@B@@AB@BAB@@@BAB@@A@@B@@@B@@@@@B@@@@B@@BB@@@@B@@@B@@@B@@@@@@@@@B@@@B@@A@@AA@@@C@A@A@@BA@AB@@AB@@AB@@A@@@A@A@@@@@@BA@A@@B@@A@@BA@@@A@@@@@AB@@@@@BA@@B@@A@@@@@@@AB@@@BA@@B@@AB@@@B@@@@@@@@A@@@@BAB@@@B@@A@@@@AA@@@@@@BA@@B@@@@A@@@@B@@A@@B@@A@@@@@@@AB@@@@A@A@@@AB@@A@@@@@A@@B@@@@A@@B@@@@@BA@@BABA@@B@BAB@@@B@@@@B@@@@@B@@B@@@BB@@@@B@@@@@@@D@@@@@B@@@B@@@BA@@D@@AB@@@@@@@B@@@@B@@@BB@@B@B@@@BB@@@@@@BB@@@@B@@BB@B@@@@@B@@@@@@@BB@@@@@D@B@@@BBBB@BB@@@B@B@@BB@D@@@B@BA@@B@B@@B@@BB@@@B@@@@@@B@@@DB@@D@B@@@B@B@BB@@@BB@@B@B@@@B@B@@@B@@@BA@@B@@@BAB@D@B@B@@B@@@D@D@B@BAB@BAD@BAD@BAB@@@BA@@BA@@B@@AB@BABA@@BA@@@ABA@@B@@@B@@@B@B@B@@BB@B@BBBB@BBB@B@@BB@@@B@BB@BBDBBBBBBDBB@@A@A@@BA@@@@BAB@@@BAB@@@B@@@@@@B@BBB@BB@B@BB@B@B@B@B@B@B@B@@@B@@@@A@@B@BAB@@@B@B@@@B@B@B@@@@A@@AC@A@A@A@@@A@@AAA@@@@AA@@@@@AA@@@@@@@A@A@@@@@AA@@A@@A@@AA@@@@AAA@@@@AA@@AAAAA@@AA@@@@A@@@A@@A@A@A@A@A@A@@@BA@@@A@@BA@@@A@A@A@A@A@A@C@C@A@C@A@A@AAA@AAC@A@A@AAA@A@ACCA@@AC@CA@ACAAAA@@A@@@A@@BA@@@@BA@@A@@AA@CACAAAC@C@AACAC@EAC@C@AA@A@@@AB@@AB@@A@@BA@AAA@@@AAA@@BAB@B@B@B@BAB@@@@A@AAA@@@AB@@@B@@@B@B@B@B@B@B@B@B@B@D@B@D@B@D@B@D@B@D@H@D@B@@@@@@CAAACDABC@A@@@@B@B@B@FDDBJ@BBDDD@@BB@@@B@B@@@@@@@@AB@@@B@@@@A@@@ABA@@@@BAB@@AB@B@@BB@@@B@@@B@@AB@@@BBB@B@BA@@B@D@@@B@BAB@B@@A@AB@@A@@BAB@B@BAD@@@B@@@@A@ABA@AB@B@@@B@@@B@BBB@BAB@DABABADAB@@@B@@@@@@B@@@@AB@@@B@B@@@B@B@@@BA@@BAB@B@@@BA@@BA@@B@BAB@B@@@BAB@@@B@@@B@BAB@BA@@@A@@B@@AB@@@B@@@B@@A@@B@BB@@@BB@@@@@BA@@BA@A@@BAB@B@BA@@B@B@B@B@B@BB@@BBBB@BB@@@B@@@@ABABABAD@BAB@B@@BB@@@@ABABA@AB@@A@@BAB@B@@@@@BA@@B@B@BAB@@@@@B@@@@@BA@@@@@AB@BAB@B@B@B@B@BBB@@@BBB@@@B@B@D@BBB@@@BB@@B@@BB@@@@@@@B@@B@@@@BDB@@@BB@@BBB@@BB@@BBB@B@B@@@B@@@BB@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@AB@B@B@B@@@B@@A@@@@BB@@@A@@@@B@@@B@@BB@@@BBB@FBB@B@DD@@B@@@@@@@DBBBB@BB@BBBBDBBBB@DBBBBB@BBB@DBFBD@BBDB@@B@B@B@B@D@B@B@B@DDB@BB@B@BBD@@@@B@BAB@BA@ADA@@BA@@BABA@A@A@@AA@AEGCCACGG@A@AAEA@@@@AECCGECAAC@AABC@A@CACCA@A@EAA@AAA@@@AAAA@ACCE@E@ACAAA@C@AEEA@@ABC@@CEAA@C@ACE@A@@ACDA@AAA@@BG@CCCBCAAAABADCBA@AAA@ABA@A@AA@AAAC@ABABCAA@C@CAEACA@@CA@CAAC@@@A@A@@@@BAB@@@B@BAB@@@B@@GB@@AA@CE@@@ABADABCBABADADAJEBEBADAB@@AACBE@ACA@CACBEAA@ABA@AACAC@CACAA@@C@A@AA@@BC@@@@@A@@CAC@A@ACA@C@@@@BA@A@A@AAA@A@ABA@A@A@CC@A@ADG@AC@A@@A@@@CBA@A@AD@D@@@BAA@AAABA@@A@A@@BAB@@@CEA@@B@BAAA@BC@@AAGAABBB@BA@A@CAC@@CAACB@AAA@@B@B@@@@@@A@@A@@@FC@@A@A@@@@@@AD@@@@@@A@@@A@@A@@A@@@@@A@@@@@A@A@@@@A@@B@@A@@@@A@@@@AB@@@@AA@@@@A@@@@AA@@@@A@@BA@@@A@@A@AB@@@@AA@@A@@B@@@@A@@@@@@ABA@@@@AAA@AA@@@B@B@@@B@@@@A@@AA@@A@ABA@AA@@@CB@@A@@@A@@@@@A@@@BA@AA@A@A@A@A@@@A@@B@@CDA@@@AA@B@@@BB@@BA@@@A@@@A@@BAA@@A@@@@A@@A@@@@A@@ABA@A@@@@@@B@@AB@@A@@AA@@@@D@@A@@@A@@@@@AA@@@@@@AB@@A@@A@@@A@@A@A@@@A@@A@@@@BA@@@@C@@A@@A@@A@@A@@AA@@A@@@@B@@A@@AA@@C@@@@@@C@@@@@@ED@@@@AAAA@@A@@A@ABA@@@A@BB@@BB@@@BA@@B@@@@@@A@A@@@A@@A@AB@@@@@A@@@@F@@@BC@@@A@@A@ABA@@@@AA@@AB@@@BAA@@@CB@@AA@@@BA@@@@@AA@@@AB@@@@@@@@AA@@BAB@B@@AB@@A@@A@@@A@@B@BA@@@@A@@@CB@@@@@AB@@AA@@@@A@@@@A@A@@@BAB@DA@@A@@AA@@@ABA@A@ABA@@@@AA@@@C@@@@@@@BA@@B@BA@@A@C@ABA@A@C@@@@@@@@@B@@A@@@A@@A@CB@B@BA@@BA@@@A@@@A@@@@@@BB@B@B@B@BA@AB@@@@B@@@@AD@@@B@@@@B@@@B@@@@B@@A@C@@BAA@@A@@@@B@@B@B@B@B@@@@BA@@@E@@@A@@@@AA@@B@@@B@B@@@@A@A@@@@@AB@@AB@AA@A@A@@BA@@@AAA@ABA@B@@BBAB@@@@DB@@B@AB@@@@@B@AB@@A@@@BB@@A@A@@@@BB@@@B@@@@B@@@@C@AA@@A@@@@B@@B@@BA@ABAB@B@@@B@@C@@@@@@@@AAB@@@@@@A@@@@B@@A@@@@A@@A@A@@@@@A@@@A@@@A@A@@@@@B@@A@@A@@@A@A@@@@@@AA@@@B@@A@@@@C@@@A@A@@A@@C@@@@@@AA@@@B@@AAB@@@@AB@@@@A@AAC@@@@B@@@@@A@@@@A@AB@@@BA@AAA@AAA@A@@@@@@B@@A@@AA@@@@@A@@@@@@@BA@@A@@@A@@@@B@@@@@B@@A@AA@@@@@@@BA@@@A@@@A@@@@@@A@@@@B@@@AAA@@B@@AA@@@@@B@@@@@@A@@@@B@@B@@@A@@@A@@@@A@@@@@@@@@@AB@@ADA@@@@BABB@B@@A@@@B@@@@AB@@B@@@@@@@@BA@A@A@@@A@A@A@@A@@@@B@@AB@@AA@@@@@CBEDA@@@AA@@A@@@@@@B@@B@@@ABAAA@@@@B@@B@@BA@@AA@@@A@@@BA@@A@@B@@@@A@@@@A@@AA@@CB@@@AA@@@A@@B@@B@@@@@@B@@A@EAA@@@A@BA@A@@@@@@A@@@@@@A@@@@A@@@A@@A@@@B@@A@@@@AB@B@@@B@@@@A@@@@@@A@@@A@@@@@@A@@A@@B@@@@@@A@@@@A@@A@@@@B@@@@AAA@@BB@B@@@@BA@A@@@@@@B@@AA@@@@BA@@@@A@A@@@@@@@A@@@@A@@A@A@@@A@@@B@@A@@@A@@A@@B@@@B@@A@@A@@@AABA@@@AB@@A@AB@@@A@@@@@A@@@@@@A@@@@B@@A@@AA@A@@@AB@@@@@@@B@@AA@@@@@@A@@AA@@@@@A@@@@@@@A@@A@@A@@@AB@@A@@AA@BA@A@A@@A@A@A@A@AA@@@A@A@A@@B@BA@@@A@@@AB@B@DAB@BAD@B@D@B@BAB@@A@A@@@A@@CAA@A@AA@@@A@@BAB@@@BBDBB@BBB@B@@ABA@C@A@AB@BBB@@@B@@A@A@ABABCDA@A@AAC@A@ABAB@DABBD@BBB@B@DAB@B@B@B@B@B@@@BA@@@AAA@AB@@@B@B@BBB@@@@@@C@AB@BAB@BB@@@D@@@BB@B@BA@AB@@AB@BBBBBBB@B@B@BAB@@AAC@@BAB@@@BAB@B@D@B@DADCDABABAB@D@B@B@BB@@@BA@@B@@BBDB@@B@@@B@@A@A@@@A@ABABABA@A@A@AAA@@@A@@BAB@B@B@@@@A@@B@B@@A@@@A@@BAB@@ABA@A@AB@@AB@D@B@D@@ABA@@AAA@@AA@@A@@@@BAB@BA@@AAAAAA@@@A@@B@BABBDBDB@@B@BA@@BC@A@ABADAH@B@FAF@DADADA@A@@@AE@A@A@@A@@@A@@B@D@B@BA@AB@@A@@CAAAAC@@@AB@D@F@B@BAB@@A@CBAA@@AC@C@@AA@@A@ADABABAD@BAB@@ABA@@BABABAB@D@@@BA@A@A@ABADABABABAB@B@D@@@B@@ABA@AB@BAD@B@B@B@@A@AC@@A@@@C@A@A@AB@BAB@D@B@D@BBD@B@BA@A@AB@B@B@@@@DBBB@@@B@B@DAB@B@BA@@AA@@@AB@@BDB@@B@@@@A@@BAB@B@@@B@@ABC@@@AAA@@A@CC@@@A@A@@BA@@B@@@@@AD@BB@@@@@DAB@B@B@DDDBBBB@@@B@@@@A@@A@AAA@AA@@AC@@@@@AB@BBB@@A@@@ABA@@B@BBB@B@B@@@@@B@AA@@AEA@AA@@@A@@B@B@DBBBB@@BBAA@@A@A@@BA@@BA@A@@B@BA@@@@@AB@BA@@@@@AC@@A@@@@BAB@B@B@B@@A@A@@@@@AB@B@@@@ABA@@B@B@@AA@@@A@AAA@@A@@A@@@@A@@@@@A@@B@B@BBB@B@@@B@@@@A@A@A@@BA@@B@B@B@@BB@@A@@AA@@@A@A@@@AB@B@@AB@B@@@BBB@@BB@@A@@@AA@A@@AA@@AA@A@@@A@@@@C@@A@AA@@A@@AB@B@D@@@BA@@@@AAA@ABAA@@A@@A@@@AB@DABAD@DAB@BCB@@AB@B@B@@@AA@A@@@A@@B@@B@BB@@@B@@@BC@@BAB@@BBBB@@AB@@AA@A@@A@@BA@@B@BAB@@@B@@B@@@@B@@A@@@ABA@@B@BBB@@BB@@@B@BA@@AAA@AA@@@ABAB@BA@@@A@@BAB@@@@A@@@@@A@@B@BA@@@@@AB@@A@AA@@@@A@@B@BB@@B@B@@A@A@@A@@A@@@A@A@@@AB@D@@AB@@A@A@@DAB@@AB@B@DBD@@@BAAAA@A@A@@AA@B@@AB@@A@A@AAA@@@AB@@AB@B@B@@@BB@@BA@@BA@@BA@@@@A@A@@@@BA@A@@A@@@@@A@AB@B@@AB@@@B@@BB@B@BBDBB@@@B@@A@@@A@@@@A@@@A@@@A@@@@A@@@@BAB@B@@@@@@A@A@@@@B@B@B@BBB@@@@@B@BA@@BA@@@ABA@AB@@@B@@B@BBB@@B@B@B@B@@@@A@@@A@A@@@@DAB@@A@@@CA@AAAA@@@A@@BAB@@A@@A@E@@@@@A@@@@@@@@AB@BA@@BA@@@@@AA@@@@@A@@AA@A@AAYGE@E@A@AEACCC@@A@CAEA@@@@@@@A@@A@@@@@@@@@@@@A@@A@AA@@@@A@@A@@AA@@AA@@A@@AA@@AA@@@AA@@AA@@@@AA@@@A@@AA@@AA@AA@AAA@AAA@@@AA@@@AA@@AA@@@AAA@AB@@A@@@A@A@A@@@A@A@AA@@A@AA@AA@AA@@AAA@AAA@EAAAA@AA@@A@AA@@@@C@@@CAEACAC@AAC@A@C@A@@@@@@BAD@BABA@C@E@EAC@@@C@CAC@AAA@A@AA@@AA@@@A@C@@@A@A@@@A@@@AA@@A@@@@@@@@@A@@@@@@@@@A@@@@@@A@@@@@AA@AA@@A@@@A@A@@@@BA@@B@BAB@B@B@@@BAB@@@B@BAB@B@BAB@@@@ABA@@@A@@BA@@@A@A@A@@@A@A@@@AAA@AAA@@@A@A@A@A@A@A@A@A@A@@AA@@@AAA@@@AA@@@AAA@@@EAA@A@A@A@@A@@@@@A@A@@@A@AB@@A@@BA@@BA@@BABA@@@@@A@A@A@@AA@A@@AA@AA@@@@@@@@A@@B@BA@AB@@@@@@AA@@@@@@@A@@@@@@@A@A@@@@A@@A@@A@@@@A@@@@@@A@@@@@@@A@@@AA@@@B@@A@@B@@A@@@A@@@A@@@@@@@A@@@@@A@AA@@A@@@A@@@@AA@@A@@A@@@A@@@A@@@@@@@@@@AA@@@@A@@A@@@@A@@AA@@@A@AA@@A@@@@A@@@@@@@@@@@@@AB@@@DA@@@@@@@A@A@A@@@@@@@A@@@@B@@A@A@@BA@A@@@A@@@@@@@@A@@AA@@AA@@@A@@@@@AA@@@@@@BA@@@AB@@@@A@@BA@@BAB@BABA@@B@@@@A@@@@@A@A@@A@@A@@@@@@@A@@@A@@@@@A@@@A@@@A@@@@@@@ABA@AB@@@@A@@@@@A@@@@@AA@@@@@@AA@@@AA@@@@@@@@@@@@@A@@B@@A@@@@@A@@@AB@@A@@BA@@@@B@@@@A@@@@@A@@@A@@@@@@@@@A@@@@@@@@@ABA@@@@@A@@BA@A@@@A@@@@@@BAC@AAA@C@AA@@@@ABA@@@AB@@AB@@A@@@@@ABA@@@@A@@A@@@@@@@@@@A@@AAAA@@A@@@AA@@@@A@@AAA@@A@@@@@AAAA@@@A@@@A@@@@@AA@A@@AC@A@@@@@@@AB@@A@@@A@@@ABAB@@@@@@@BA@A@AB@@@@@@A@@@@A@@@A@@AA@@@@A@A@A@@@@@A@@@A@AA@@@@@@A@@@@AA@@@@@@@A@@@ABA@@@A@ABA@A@@@ABA@@@@@A@@@@A@@A@@AA@AA@@AAA@@@A@A@A@AA@@A@@@@@A@AAAAAAA@@A@@@@A@@@A@@@@B@@AB@@AB@@@@AB@@A@ABA@AB@@@BA@@BA@@BA@A@@@A@@@@B@@@@@B@@@B@@DB@@@@@@AB@@@@@B@@@@@B@@@@A@@@A@@@A@@@AAA@@@AA@@A@@@@BA@A@A@@@AB@@AAA@@@A@@@A@AB@@A@AB@@A@@@A@@@@@A@@@@@A@@@AA@@A@AA@@@AA@@@A@@@@@AAA@AA@@@@@@A@@@A@@B@AA@@@A@@@A@@@@@A@@@AB@@@@A@A@@@A@@AA@@@@@@@A@@@@@A@@@@@A@@@@@A@A@A@A@A@A@A@@@A@AA@@A@@@AB@@A@@@A@@@@@A@@@@A@@A@@@A@@@@@A@@A@@@@AA@@AA@@A@@AAA@@@@@AA@@@@A@@A@@@@@@@A@@BA@@@@@AA@@A@@@AB@@ABA@@@A@@@@@A@@@A@@@@A@@@@@@@@AA@@A@AA@@@@@A@@@@A@@AA@@A@@@@@@A@@@@@A@@AA@@@@@A@@@@@@@@@A@@B@@A@@B@B@@@BA@@@@@@B@@@@A@@B@@@@@@@B@@@@@@@@@BA@@@@BB@@@@B@@@@@@@@A@@BA@@@@@@@@BA@@@A@A@@@A@A@@@@@@B@@@@A@@B@@@@A@@@@BA@@@@@AB@BAB@@@@A@@@@B@@@@A@@@@@A@@@A@@@@@@AA@@@AA@@@@@@@@@@A@@@@A@@A@@@AA@@A@@@@@@@AA@@@@A@@@AA@@@@A@@@@@@@A@@@AB@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@A@A@@@AA@@@@@@A@@A@@A@@@A@@@@@ABA@@BA@@@AB@@A@@@ABA@AB@@@@@@@B@@@@@@@B@@@BB@@B@@@@@@@B@@@@A@@@@@@@@@@B@@@@@@@@BB@B@@@B@@@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@A@@@A@A@ABA@A@A@@@A@@@@@A@@@@@A@@@@B@@@@@@@B@@@@@BA@@B@@@@BB@@@@@B@@@@@B@@@@@@@@AB@@C@@@@BA@@@@@@B@@@B@@@B@@@BA@ABA@@B@@@@@B@@@@@@@BA@@@@@A@@@@@A@A@@@A@@@@@A@@@@@@@AB@@@@@@A@@B@@AB@@@@@B@@AB@B@@@B@@@@A@@B@@A@@@A@@@A@@@AB@@@@@BA@@@ABA@@@A@@@@@@B@@@@@@@B@@@@A@A@AB@@A@@@AB@@A@A@A@@B@@@@@@A@@@@@@@A@@@@BAB@@@@@@@B@B@@@@AB@@@@@@@@A@@@@@@@@BA@@@@B@@@B@@@@@@@@@B@@@@@@AB@@@@@BA@@@AB@@@@@BAB@@@BA@A@@@@B@@@B@@@@@@A@@@@B@@@@@@@@@@@@A@@@AA@@A@@@A@@AA@@@A@@@A@A@@AA@A@@@AB@@A@@@A@@@@@@AA@@AA@A@A@AA@@A@@@@@A@@@A@ABA@A@@@A@@@AB@@A@ABABA@@@A@A@@@A@A@@@A@@@A@@@A@A@@@A@@@A@CAA@@AA@@AAA@A@@BA@@@@@A@A@@@@@@@A@@@@A@AAA@AAA@AAA@@A@@A@@@A@AB@@A@A@@@AA@@A@@AA@@A@@A@@@@@A@AAA@@@@A@@@AB@@@@A@@@A"],encodeOffsets:[[132901,47071]]}},{type:"Feature",id:"230127",properties:{name:"木兰县",cp:[128.043466,45.950582],childNum:1},geometry:{type:"Polygon",coordinates:["@@EFKJIFMFIBUDQBQFQDA@A@C@C@A@KCEC@@IWEIECECGCG@I@I@A@QFC@MDKBC@C@IBA@C@EBCBEBKDKDEBGBG@GBC@G@GAGAKCaCS@KAMAKAC@GBIBA@GBC@C@CBE@EBA@C@C@E@G@E@E@G@A@G@C@CBEBABCDAB@@@B@B@B@BBD@BDDDFFDBDBDBDBBDD@BBDAFCBGFE@EBC@A@C@C@AAGAECEGGEGCACAAQC@@GCIAM@I@E@EBEDA@EDEFADCHAD@B@PDJBP@LCHENABGHCDOJEDC@E@C@CA@@ECEAEAGEAAC@AACAA@A@A@ABA@A@ABA@A@E@E@E@E@EBC@IBKBCBA@EBG@E@CBA@@B@B@@@BBBFDBBBB@BABCBABC@A@@BAD@@ABA@A@CAC@A@CBABABADCAIBCBCCAA@AAA@ABA@ABABADAD@RADAD@D@@AAE@CC@CAO@MBGBC@C@SCICICC@CHGNCH@F@DADBJFHDBD@D@P@H@B@FB@@D@OBABAB@BDFABBBD@BB@@@BCF@D@BA@ABC@A@@@CDA@K@CBEBCBEFC@@HADADBDDBBBFB@DB@BB@BABEBAB@DAF@DGH@F@DDBLFBB@HDHDH@@B@BBBBD@D@D@F@HBLFDD@@AB@B@BA@@@@BB@BB@@@@@@@@@B@@AB@B@@@B@BA@@B@BA@@B@@@B@@@B@B@BAB@@@BB@@@@B@@D@@@@BB@B@@@@B@@B@@@@BB@@@@B@@@@@B@@@@BB@@@@@@@@@B@@B@@@@B@@@@@B@@B@@@B@BB@@@@@B@@@@@@A@@@@B@B@@@@@B@@B@@@@B@@@@@BB@@B@@@B@@@@@B@B@B@@@@@B@@@@BB@@@D@@@BBB@B@B@@BB@@@@@@B@@BB@@@B@@@@@@@B@B@B@@@B@@@B@@@B@@@@@@@@@@@@@@B@@@@@@@@@@B@@B@@@@DBHF@@D@@BB@B@@@B@@@AB@@@BAB@BA@@BCBADA@ABA@@@ABAB@@@B@@@@B@@@D@DBB@B@@@BBB@@B@@ABCDA@@B@BA@@BA@@D@BABB@B@DB@BB@B@@@@@BB@@BB@@@@ABAB@B@@@DA@@B@B@D@BB@B@HDFDD@BBB@BB@B@@@@BBBBDB@BB@B@BB@AB@@@B@BB@BBBAB@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@BB@@@@B@@@@B@@@@@@@@@@@@B@@@@@B@@AFBFADBF@DDF@DBBFD@BBBCF@DDFBD@DCHBDAD@FAB@@@D@DCF@F@F@@ABA@A@ABAACA@@A@A@A@@@A@A@A@@B@B@@@@AB@@BBA@@@A@@@@B@@B@@D@@@BBB@@ABAA@BA@@@@B@@A@@@@@ABA@@@@@@B@@@BB@D@FADDDBFDHD@BBD@@B@D@B@@D@B@@HDBDJBDBFDDD@D@BF@@@@DDBBD@B@BFBHDLDDDDDFJABAD@BBDJF@B@D@B@FBDFD@DDD@D@@HDFDFF@DDDDH@DBBBBHBDBDBBDBBBBAH@BABIJCB@BGFML@DCBCH@BAB@@ABABA@@B@@A@@@@@A@@@@B@@A@@BA@@@AB@B@@@BA@@B@BAB@@@BA@@BABA@@@@@@@@@AB@@A@@BA@@@@@@BA@@@@@BB@@@@@@@BB@@B@@@B@BA@AB@@@@@B@@BB@@BBB@@B@@@@@@@B@@@BB@@@@B@@@BAB@@@BB@@B@@A@@@CBCBABA@@@@B@B@@@B@B@@AB@@@B@D@@@B@B@@@B@@BB@@B@@@DB@@BBB@@B@@A@@@@@@@@B@BBB@@@B@@B@@@B@BBB@@BB@@@@B@B@@ABAB@@BBFDDBB@BBEBAB@BCDC@C@AAE@@@CDC@A@GDCBAB@DCF@HABAB@@@@AB@@A@@@AB@@@@@B@B@@@@@B@@@@@B@@@@B@@BBB@@BD@@@B@BB@@B@B@@@@@BB@@B@@BB@BB@@BB@@@BBB@@@DBBB@@@B@BBD@@BB@@BBDD@@@@@B@@@@@B@@@@B@@@B@@@@B@@@@@@@B@@@BB@B@@@BBB@@@B@@@BA@@B@@A@@@@BB@@@BB@BB@@D@BB@@B@@@B@@@B@BA@@B@@@@AB@@A@@@A@@@A@A@@B@@@B@B@@@BA@@B@@AB@@@B@@@B@@BB@@ABA@@@@@AB@@@@@B@D@@@B@B@BAD@@@BBB@BBB@@B@@@BBB@@@B@@@@@@@B@@@B@@@@@B@@DB@@D@D@D@B@@AB@@@BB@@@@BBB@BA@@D@@BB@@@@B@@@BBB@BBD@BBB@@B@@@B@D@DAB@B@@A@AB@@AB@DBBBBBD@BB@@B@@BBBBBB@BBD@BBDBBB@@B@BBB@BBB@B@B@B@B@B@BA@@BBB@@@@@@BB@@@BB@@B@B@@@B@B@@@@@B@@@B@@@@B@@BB@@@@@BBB@@@B@@B@@BBB@BBBBBB@BB@@BB@@BB@BBBB@@BB@BBB@@@B@BB@@B@@@@BB@B@B@B@B@BA@@B@BAB@B@@@BBBBBBDBBB@@DBDBB@@@B@B@BA@@B@BADCD@@A@@@@@A@A@@BA@@@A@@BA@A@CB@@A@A@@BA@@B@@AB@B@@AB@@ABA@@BABAB@@@@ABA@@@A@A@@BA@A@@@A@@@@@@B@DB@@B@BA@@B@@@BA@@@@@@B@@@@BBB@BBBBBB@B@BBB@B@B@DBD@BBB@@@@@@@@@BA@@BC@@@AB@@AB@B@B@@@BA@@B@@@B@B@@@@@@@@A@AA@@AB@@@@@@AB@B@B@@@BBD@@@B@B@@@BB@@@@@BB@@BB@@@B@@@@ABAB@@@D@BAB@D@@@B@BBB@BBDB@@B@@@B@@@BA@@@@BB@@@@B@@@B@@@B@B@BAB@@A@@@@@A@@@@@@@AB@B@BAB@B@@A@@B@@A@@B@@@@@B@BBB@D@@BBABB@@@@B@BB@@B@B@B@B@D@@BB@BAB@@@@@@@BA@@@@@A@@B@B@B@BAB@B@BA@@B@@AB@@A@@@A@A@@AA@@@@BA@@@@BAB@B@@@BA@@@@BA@@@AB@B@B@B@D@D@B@@@BABAB@@@BAB@@ABA@@@@@A@@@AB@B@@@B@@@@A@AB@@AB@@AB@B@@A@@@@@@@A@@B@@@@@B@@@BBB@DAB@@@BA@A@@@A@@B@BAB@B@B@@@BA@@@@AA@A@@@@@@@@BAD@B@B@@@B@BA@@B@@@@@B@B@@@@@BB@@B@B@D@@@B@BAB@@BB@B@@@B@@@BA@@BAB@B@B@@@D@B@@@@@@A@A@@@A@@@@@@B@@AB@@@BAFABABAB@@@B@BBD@@@BBB@B@BB@@@@@AB@@@D@@@B@D@B@@@BADAB@@AB@B@@@D@B@B@@@B@B@@@B@@@@@B@@@BBB@@@@@D@@@B@BABCB@@A@ABA@AB@BAB@@@@AB@DC@@BAB@@@D@DA@AB@B@B@@@B@@@B@B@B@@BB@B@D@@@B@DBB@B@B@@@@@@@B@@A@@@@@A@A@@@@B@@A@@@@@@@@AA@@@A@@@@BA@@@A@@BA@AB@BAB@B@DAB@B@BA@@@@B@DB@AB@B@BAB@@@B@@@@@B@@@B@BAB@@@B@B@B@BBD@@@@BB@@@B@B@D@@@B@BB@@B@BBB@@@B@@@@@B@@@@B@@A@@BA@@@@B@B@@@BB@@B@@@B@B@@@B@@@B@@@@@B@@A@ABA@AB@@@@@B@B@@B@@@B@B@B@B@BB@@B@B@B@@@@@@B@@B@@@@BB@@@@@BAB@@@BB@@B@@@BBB@@@@@D@@@B@@@@ABB@@@@@BB@@@BB@@BB@@B@B@B@B@@@@@BB@@BBBB@@BBB@@@BBBBBDBDB@@@DB@@D@@@B@B@B@BAB@@ABA@@@ABA@A@@BABCBABA@ABC@CBC@@@AB@B@B@BB@@D@@AB@@@B@B@B@@@D@AA@AAAAAAA@A@A@@@A@A@@A@@AA@AA@@@AA@@@BA@@B@@A@@@C@A@A@A@@@A@@@@@@@ABA@A@@B@@A@A@@@A@AA@@AAA@AA@@A@A@@@@@@@@@@B@B@@@@@B@@@@@B@BAD@@@B@@@B@DAFAD@DA@@B@@AB@@@@@BA@@@ABC@C@C@A@@@ABA@@@@@A@@A@@AA@@@@A@@@AB@@AB@@A@AB@@AB@@@@@BA@@B@@@@@BABA@@B@@AA@@A@@A@@@@@@@A@@A@@@A@AA@@A@@@A@A@@A@AA@@@@@@@A@@@A@@BA@@@@@AB@@@@A@@BA@A@A@A@A@@@AB@@@@AA@@C@@@@@ABA@A@@@@@A@@@@BA@@@AB@@@@AB@@A@AB@@AB@BAB@@A@@BA@@BABABA@@BA@@AA@E@@@A@A@@@AA@@AA@AAA@AA@@AA@@@AA@@@@AAA@@@@@A@@B@@@@A@@@AB@@@BA@@@@BA@@@@@AA@@A@@A@@AA@@@A@@@AA@@AA@@@CA@@CA@@@AA@A@@AA@A@A@A@A@@BC@@BA@@@@@AB@@@B@@@BAB@BA@@@@D@B@B@@@@@@@B@@@BA@@B@@@@AB@@@B@@@B@BA@@B@B@BAB@BAD@@@BAB@BA@@B@BADA@@DCB@BAB@@@B@@@B@B@DAB@B@@@@@B@@B@@B@@@@BB@@BB@@@B@BB@@B@BBB@BBD@@@@BB@@B@@@BB@@BB@@B@@@B@@@@@B@@@@@BB@@@@@@@B@FAFADAB@B@D@@@B@B@BA@@B@@AB@@@B@@AB@@@B@@@B@@@B@@A@@@A@@BABA@AB@BA@@DAB@@@@@BA@@B@@@B@B@B@B@@@B@BABAB@B@B@D@B@@@B@BA@@B@@@B@@AB@@@@@DCBA@@B@@@B@@@@@BA@@@A@A@AAC@A@@@@@A@@@A@@@@@@BABA@A@@BA@C@A@A@@A@@A@@@A@@AA@A@@@A@CCC@@@AA@@A@@@A@@A@AA@A@@@@@@@@@@@C@@@@AA@@AA@@@@C@A@ABGBA@A@@@AA@@AACE@CA@@@@A@@BA@@@@@AAA@AA@@A@A@@AAAACACA@A@@@AAE@C@@@A@@@AA@@A@@@@@A@@@A@@@A@@@A@@CCAAAA@AAA@@CAEAAAA@A@A@E@CAA@A@AA@@@AAE@C@C@C@@@@@A@@AAA@@AAA@@ACA@AA@@@AA@@A@A@A@@@A@AA@@A@@@A@@@AB@@AB@B@@A@@@A@@@AB@@C@A@@AC@@A@@AA@AA@A@A@@@A@@@@BABADAB@BA@@DABAB@BAB@@@@A@@JC@A@AAGB@@CBA@@D@BAB@@@BABABABABABA@@@@@@CC@@@@@A@@@@@A@@@@AC@@BA@@@C@@@@@AB@BA@@@C@@@@@A@@@@AACA@A@@A@AA@@@@@A@A@@A@@@@A@@@@@@B@BAB@@@BA@@@A@AB@@@DABAB@@@B@@A@@@@@@@A@C@E@A@A@AA@@@AAE@A@CACAA@@CCE@A@ABC@A@A@A@C@A@ABC@A@A@A@CCAAC@AAA@A@AAC@C@A@@@AAA@@@@CAAC@AA@@@AACAAAA@@@@@A@@@@@@@@@ABABA@A@@@@@A@CAICGA@@CAC@AA@@A@A@A@BEAI@CGGEEEAKAM@CAAA@CBCCGAC@@@I@@ECCA@@A@AA@@CAAAAA@@ABC@A@CA@@@@A@@A@@AAA@AAAA@@CAA@G@A@A@A@@@A@@AAAEAC@AA@@CCA@CAC@C@@@C@@@AA@@@A@CB@BA@@@AB@@AB@@@@@BA@@@@@@BA@@@@@@@@@A@@BA@A@@@@BA@@@AB@@A@@@@@A@@BA@AB@@AB@@@@@@@B@@@@AB@@A@@@A@@@@BA@@@@B@@@B@@A@@@A@@BA@@BA@@@AB@@@BA@@@A@@BABA@@@@@@@@@A@@@A@A@@BC@@@A@A@A@@AA@A@@B@@@@A@@@@@A@@BA@@@A@A@A@A@@@@AAA@@@A@@A@@AA@@A@@A@@@@A@@A@@@@@@@@AA@@@AAA@@@@@AA@A@@@@@A@@AA@@@AAA@@@@AA@@@@@@@@@A@@@AC@A@C@AAEBGACEEAE@G@A@E@ABA@@BC@@DEBA@@DA@@B@@@BBB@BA@@@AB@BA@@B@BA@@BA@A@@BA@ABEBCBC@CAC@@@AB@@@B@BAB@BA@@B@B@BBB@@@B@B@B@B@B@@AB@B@B@@A@AB@BA@@B@B@B@B@@AB@B@B@BA@AB@@A@@@AA@BAAAA@AA@A@@BAB@BA@@@AAAA@@A@@@ABA@@@A@@@@B@@@B@B@@@B@@B@@AB@@@B@@@BB@B@BA@@@@AA@@BADBDBFBDB@A@@BA@@@@B@BB@@B@B@B@@A@@@AB@B@@BB@@B@@BBB@@BB@B@B@@@BBBAB@@@BAB@BA@@B@B@B@B@@@B@B@@@B@B@@@B@@@B@@@B@@@BAB@@@B@@@B@B@B@@@@@B@B@D@JAF@B@A@AAGOEGGCKEKCIAKAQBC@C@E@A@E@C@E@CAC@EAAAC@C@A@EAE@C@C@CAC@E@EACACAC@CCGCEACAEEEAECGAAAA@QEKAO@K@G@GDMFGFOJCD"],encodeOffsets:[[131162,47024]]}},{type:"Feature",id:"230126",properties:{name:"巴彦县",cp:[127.403781,46.086549],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@G@@@A@@@AAAA@@A@ACAAA@A@CBE@@@C@A@A@EAC@C@@@A@A@GAA@A@@BA@@B@@AB@@A@C@ABC@@@A@AD@BA@@B@@@DAB@@ABA@@@AB@DADEFABA@A@GBA@@B@@ABC@@@G@A@A@@@CAE@AA@@C@AA@@A@A@EA@@A@A@AAA@A@C@A@C@CB@BA@CDA@A@A@A@A@EBC@CBAF@@@B@@@B@B@@@@@B@BB@@@@@@@BA@@@B@@B@@AB@@@@@@@@@B@@@@B@@@@@@@BA@@@@@@@D@@@BBB@@@@@@@@@@B@@@@A@@A@@A@@@@@@@A@@@@@BB@@B@@@@@@BA@@@A@@@A@@B@@@@@@@@B@@@@@@@@@@@@@@@@A@@B@@@@@@B@@@@B@@@@@@A@@B@B@BA@@@B@@@@@@@@@B@@A@A@@@@@@@@B@@@@@@B@@BB@@@@A@@@@@@@A@@B@@@@@BA@@BB@@@@@@B@A@@@@@@@A@@BA@@B@@@@@B@@B@@@@@@@@@@@@@@A@@@@@@@AB@@@B@@@@@B@@@@BB@@@@@@@@@@@@@A@@@A@@@AB@@@@@B@@@@@@@@@@B@@@@@@@@A@@@@B@@@@@B@@@B@BB@@@@@@@@AB@@A@AAA@@B@@@@B@B@@BA@@@AA@@@B@@B@@BA@A@@@@@@@B@@@@@@B@@@BA@BB@@@@@@@@ABAA@B@@@@B@@@@B@@BB@@@@B@BC@@@@B@@B@BBAB@B@B@@@AA@@A@@@@A@@@@B@@@@@B@D@@@@A@@@@A@@@@@B@@@B@@@@@AA@@B@@@B@@@B@@A@BB@@@ABAB@@@@D@@@@@ABAD@@@@B@@@@@AB@@@@@@@@B@BAB@@@B@@B@@A@A@@B@@BB@@@@@A@A@@B@@@@B@@B@@A@@B@@@@@@D@@B@@@@A@@B@B@@AB@@@A@@@A@A@@@@@@@BA@@AAAA@@@A@@B@@@@B@@B@@@@A@@B@@@BB@AB@B@@@AAA@AA@@BABAB@@@@B@@@@A@@B@@B@@B@@@@BA@@@@@@@BB@@AB@@@B@@@@@@@@BABA@@B@@@@BA@@B@@@@B@@@BDA@BB@@@@@CB@@@BB@@@BA@@@@BB@@@BC@@@@@@@BB@@@B@@A@@@@@BB@@@@B@@A@@B@@B@@B@B@B@@@@@@@A@AB@@B@@BA@@B@@@@B@@A@@BA@@@@BBABB@BBA@@BB@D@BB@A@@@A@AA@@@@C@AA@@@@A@@@@@AB@B@@@@@@D@@B@B@F@@@@AB@@@BA@A@@B@@@@B@B@@@@@@ABAB@@@@@B@@B@@@@A@@@AB@B@@@AB@@@@@B@@@@B@@@A@BA@@@@D@BB@@B@AB@BB@@@@@A@A@@@@B@@B@@@A@A@@@@BB@@@A@AB@BA@BB@@B@@@@@AAB@DAB@@B@@@@A@@@A@@@BB@@@@BAB@@@@A@@B@@@@@B@@ABA@@@@@B@@@B@B@@@@B@@AB@DA@B@@@@@@@@@@@@@B@@A@@@@@@@@BF@@@@@@@AB@@@@BB@@@@A@@BDBBB@@@B@@A@@@@B@@BB@@A@A@@@A@@B@@@B@@@BA@@@A@@@@@AD@B@@B@@@BAD@B@B@@B@@A@A@@@@@@BBB@BBB@@@BB@@@AB@@@B@@BBB@@BAB@@@B@@@@B@BA@@@B@@B@@@B@@B@B@@AB@@B@@@B@@AB@@@@@BB@BB@B@B@@@BA@@@B@B@@@BB@@B@@@@AA@@@BA@@@@@BB@@@@@BA@@BA@@@B@@@B@BB@@A@@@A@@B@@@B@@@@B@@@@B@@B@@B@@A@@@BB@@B@B@BA@CF@@B@@B@B@BA@@@B@@@@@@B@B@@@B@@BAB@@B@@BB@D@@@BBB@@@B@B@@@B@@B@@@@B@@@B@@@AAB@@@@@BBBBBD@@@@AD@B@BA@A@@B@@@@@B@@CD@@@BB@B@B@BBDBB@@@B@BBBBB@BBB@@BB@@@BB@@BB@@@@B@@@@@B@@@@@BA@@BA@@@@@@@@@@@@B@@A@@B@@@@B@@@@@@B@@@@@@@@B@@@@B@@B@@BB@@BBB@@@B@B@B@@@@B@@B@@@@@@B@@B@@BB@@@@@@@@@BB@@@@@@B@@@B@@@B@@@B@BB@@@@@@@@@@BA@@B@@@B@BB@@@@BB@@B@@@@@B@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@A@@@@A@@@@A@@B@@@@@DB@@B@@BB@@@@@@@@@@BB@@@@@@@BBB@BB@@@@B@@@@@@B@@A@@@@@@@@B@@@@@B@@@@@@@@@B@@B@B@@@B@@BB@@@@@@@B@@@@@@@@B@@@@@@B@@@@@@@BB@@@@A@@B@@@@@@@@@@@@BBB@@@@@@@@@B@@@@@@@@A@@@A@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@B@@@@@B@@@@@B@@@@@AA@@@@@@@@@@B@@@@@@@@@B@BA@@@@@B@@@@@@@@@@@@@@B@@@@B@@@@@@@@B@@A@@@@@@@@@@BB@@B@@@@@BB@@@@@@@@B@@AB@@@@@@@B@@B@@@@BA@@@@@@@@@BB@@@@@@@@@@@B@@@@A@@B@@@@B@@@@A@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@B@@@@@@@B@@@@@@B@@@@BB@@@B@@B@@BA@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@B@@@B@@@@B@@@@@@@BA@@@@@BB@A@@@@@@@@@@@@@BB@@B@B@BB@@B@@@B@@@B@@@B@@@@B@@B@@@@@B@@@BA@@@@@@@@@BBA@@@@@@B@@@@@B@B@B@B@@@@@@B@@@A@@B@@@@@@@@@@@@@B@@@@@@A@@@@@@@@B@@@@@@@B@@@B@@@@@@@@BB@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@B@@B@@@@@@@@@@@@@@@@B@@B@@B@@@@@@@@B@@@@@@@@@B@@@@@@@@B@@B@@@@@@@@@@B@@@@B@@B@@@@@B@@B@@A@@B@@B@@@B@@@@B@@@BB@@@BBDBB@@@@@@@@BB@BBB@@@D@@A@@B@@@@BB@@@@@@@@B@@@@B@@@@@@@B@@@B@@@B@B@@B@@@@B@@@B@@@B@@@@@B@@@B@B@BB@@B@BB@@@@@@BB@@B@@@B@B@@@BBB@@@B@@@B@@@@BB@@BBB@@B@@B@@@@@@@B@@B@@@B@BB@@@@B@@@@@B@@B@@@@AB@@@@A@@BBB@@@BAB@@@@@@@B@B@@@@@B@@@B@@@@@B@@B@B@@@B@BB@@@@@@@@@@@@BA@@@@@@@@B@@@@B@@@BB@@@B@@@B@B@@@B@@@BB@@B@@@@A@@B@B@@@@BAB@@@@BB@@B@AB@@A@AB@@A@@@@@@B@@@@@@BB@@@@B@@B@@@@A@@@@@@@A@@@@@@@A@@B@@BB@@@@@B@@@@B@@@@@@@@B@@@@B@B@@@B@@@@@B@@@@@B@B@@@@AB@@B@@@@@@@BA@@@@@@B@@@@@@@B@@@@B@@@B@B@@@@@BA@@@@AA@@BA@@@@B@@B@@B@@B@B@@BB@@@@@@B@D@B@B@@@BA@@B@BAB@@@B@B@@@B@@@@B@@@@@@@@@B@@@@@B@@B@B@@@B@B@@@B@@B@@@@A@@BC@A@@@@@@@A@@@BB@@B@@@BBBB@@B@@@@@B@@@B@@@@BBB@@BBB@@@BA@@B@@BB@BB@BB@B@@@B@@@@@B@@@B@BAB@@@BA@@@A@@@@BA@@@@@@B@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@B@@@@@B@@@@@B@@B@@@@@@@@@@@A@@B@B@@@@@B@@B@@@@@@@B@@@@@@@@@@BA@@B@@B@@B@@@@@@B@@B@B@B@BBB@@@@@A@@@@@AB@@B@@@@@@B@@@@@@A@@@A@@@@@@@@@@B@@@@A@@B@@A@@@@@@@@@@@@B@@B@@@DB@@@@B@@@@@@AB@@@@@B@@@@@@@@@@@@@@B@@@B@@@@B@@@@@B@@@@@@@B@@@B@B@@A@@B@B@B@@@@@@@B@@@@@B@@@B@@@@@@@B@@@@@@@B@@@AB@@@@B@@@@@@B@@@@A@@@@B@@@@@@@@A@@@@BA@@@@@@@@@@@B@@@B@@@@B@@A@@@A@@@@@AB@@B@@@@@@@AB@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@B@@@AB@@@@@@@B@@@@@@B@@@@@@A@@B@B@@@@BB@@@A@@@B@@@@@@@@@B@@@AB@@@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@@B@@B@@@@@@@BB@@@AB@@B@@@@@@@@B@@@B@B@BBB@@@B@AA@@@AB@@@B@@@B@@@BB@@B@@@@B@@B@B@@@@@@@A@@A@@B@B@@@B@@@BBD@@A@@B@@@B@B@@@@@B@@BA@@@@BB@BB@@@@BB@@A@AB@@@@@@BBD@B@@@BB@BB@B@B@@@@B@@@BB@@@@@B@@@B@@B@B@@B@@A@@@@B@@AB@@AB@B@BB@@@B@B@@BB@B@@@B@@@@@@@@@@B@AB@@@B@@@@BAB@@@BB@BA@@@A@@D@@@AA@@@@@AB@@@B@@B@@@@A@@BB@@B@@@B@@@@@@B@@@B@@B@@@B@@B@@BA@B@@@@B@B@@@@@B@B@@BB@@@@@B@@@@@@A@@B@@@@@@@B@@B@B@@B@@@@B@@@BB@@@@@@@@@@B@@@B@@@@@B@@BA@@@BB@@B@@@@B@@@@@@@B@@B@@B@@@@@B@@AB@BB@@@@@AB@@@@B@@B@@B@@@@@B@B@@@@B@@A@@@@B@A@@BB@BA@@@@@B@@@BB@@BB@BA@@@@B@@@@A@@@@@A@@B@@B@@@BB@@@@@@@DB@@BAB@@@BB@@@@@AB@B@@@@@BB@@BA@@DB@@@A@@B@@@B@@@@A@@@@B@@@B@@@D@@@@BB@@@@B@@B@@@@@BA@@A@B@B@@@@@@B@@BA@@B@@@B@@@B@@@ABA@@@@@@@BB@@B@@@B@@B@@@@BBBA@B@@@B@@D@D@B@@A@AB@@@@@@@BB@B@@B@@@@@BA@@@@@@@@B@@@B@@@@B@@@@@B@@B@@A@@@@@@@@B@BABB@AB@@@@BB@@@@AB@B@@@@A@@@AB@B@@@@@@B@@B@B@@@@@B@B@@@@@@AB@@B@@@@@@@@B@@B@@@@BA@@@@@@@B@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@BB@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@BB@@@@@@@@@@@B@@@@@@A@@@@@A@@B@@@@@B@@@@@@@@@B@A@@AB@@@@@@@@@BB@@BA@@@@A@@@@@@@@@@@@A@@@@@@@A@@B@@@B@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@B@@@@@@@BB@@@@@@B@@@@@@@@@@@@@AB@@@@@@@@@@@BB@@B@@@@@@B@@@@@@@B@@@@@B@@B@@@@@@@@@B@@@@A@@@@@AB@@B@@@@@@@@@@@@@B@@@@@B@@@@B@@@@B@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@BB@@@@@@B@@@@@@@@@@A@@@@B@@@@@@@@B@@@@@@@@BB@@@A@@B@@@@@@@@@@B@@@@A@@@@@@@@@@@@B@@B@@@@@@@B@A@@@@B@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@A@@@B@@@@@@@B@@@@@@@B@@@@@@@@AB@@@@@B@@@@@@@@@@@B@@@@@@@BB@@@@@@@B@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@A@@B@@B@@@@@@@@BB@@B@@@BB@@@@@@B@@@@@B@@B@@B@@@@@@@@@B@@@@@@@@@@B@@@@@@B@@@@@@@@@@@B@@B@@@@@@@@BB@@@@@@@@@@A@@BB@@@@@BB@@@@@@@@@B@@@@@@@BB@@@@@@@BA@@@BB@@@B@@@@B@@@@@@@@@@B@@@@@@B@@@@B@@@@@@@B@@@@@@@B@@@B@@B@@@@B@@@@@@@B@@A@@@B@@@@@@@@B@@@@@@@@A@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@B@@@@@@@B@@@@@@@@@B@@@@@B@BA@@@@@@@@@@@B@@@@@@B@@@@@@B@@B@@@@@@@BA@@@@@@@A@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@BB@A@@@@@@@@@@@@@B@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@B@@B@@@@@@@B@@@AA@@@@B@@@@@BB@@B@@@B@B@@@B@BA@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@@@BA@@@@@@@@@@@@@A@@@@@@B@@B@@@@@@@AB@@@@@@@@B@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@A@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@BA@@@@@@@@@@@@B@@@@@B@@@@@@@@@@@@A@@@@@@B@@@@@@@B@@@@@@B@@@AB@@@@@@@B@@@@@@BB@@@@@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@@@@@@@B@@@B@@@@@B@@@@B@@@@@@@@@@ABB@@@@@@@B@@@@@B@@@@@B@@@B@@@@@@@B@@@@A@@@@BB@@@A@@@@@@B@@@BA@@B@@@B@@@B@@@@@B@@@@@B@BA@@@@@@B@@@B@@@@@B@@B@@B@@@B@@@BAB@@@B@@A@@@@@@B@@@B@@@@@B@@A@@@@@@AA@@@@@@B@@@A@@@B@@A@@@@@@@@@@@@B@@@@@B@@A@@@A@@@@@@@@@@B@@@B@@@@AB@@@@@B@@@BA@@@AB@@@@@A@@@B@@@@@@@BA@@A@@@@A@@@@B@@@BA@@@@B@@A@@@@B@@A@@@@A@@A@@@@@AB@@@BA@@@@@@AA@@@@@A@@@@@@B@@@B@@@@@@A@@@@@@@A@@@@@@B@@@@@@@@@B@@@@@@B@@@@B@@@@@B@@@@@B@@@@@@@@A@@@@B@@@@@@@@A@@@@@@B@@@BB@@@@@A@@@@@@@@BA@@@@B@@@@A@@@@@@B@@@@@B@@@@@@B@@@@B@@@@@B@B@B@B@@@BB@@B@B@@@B@@@B@@@B@B@B@B@@A@@B@@@@@@@BA@@@@B@@@@@B@@A@@@@B@@@@@@@@@@@BA@@@@AA@@@@BA@@@@@A@@BA@@@@BA@@B@@@BAB@@@BABA@@BA@@B@@AB@@AB@@@B@BAB@B@@@B@@@B@@@@@B@@AB@@@B@BA@@@@B@@@B@@A@@@@@@@@B@@AB@@@@@B@@B@@@@B@@@B@@@B@@@@@B@@A@@@A@@@A@@@@@A@@B@@A@@BA@@BA@@@@B@BAB@@@@A@@@AB@@A@@@A@@@@@@@@BA@BB@@@@A@@@@@A@A@A@@B@@A@@B@@AB@@@@@@AB@@A@@B@@A@@@@@@B@@@@@@A@@@@@A@A@@@A@@@@@A@@@@BA@@BADA@@B@@@B@@@@@@@B@@@@@B@@@@A@@B@@A@@@A@A@@BA@@@@@@B@@@@@@@@A@@@@B@@@@@AA@@B@@@@A@@@A@@@@B@@A@@AA@@@@A@@@A@@A@@@@@@@@@@@@AAB@@@@@@A@@@@@@B@@@@@@A@@@A@@@@@@@@@@@@AA@@@A@@B@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@A@@A@@AB@@@@A@@@A@@@@@@A@@@@@@@@@@A@@@@@@@A@@A@@@@@@@A@@A@@@@@@@A@@@@B@@@AA@@@@@@@@@@@@@A@@@@@A@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@A@@@B@@@@@@A@@@@@A@@@@@@@A@@@@@AB@@ABAA@@A@A@@@A@@@AA@@@@A@@@@@AB@@@@A@@@@@A@@@@B@@@@@B@@A@@@@B@@A@@@@@A@@@@@A@@@A@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@A@AA@@@ABA@A@AB@BA@@BAB@@AB@@A@AB@@@BA@@@A@@B@@A@@@A@@@@B@@A@@@@B@B@@@BAB@B@@@D@@@BA@@B@@@D@D@B@@@B@@@B@@@@@B@@@@B@@B@@@@@B@@@@AB@@@B@@@B@@@D@B@BAB@@@B@@A@@B@B@DB@@BA@@B@@@BAB@B@@AB@B@BAB@@@@A@@B@D@@AB@BA@@@AB@@AHG@@BA@@BADBH@D@B@D@B@DBB@B@D@B@B@@B@@B@@@@BBB@BB@@BB@@@B@@@B@@AB@@@@@@@BBB@BDB@B@@B@@B@BBB@@@@@@@@B@@@B@@B@@@B@@@BB@@@@B@@@@B@@BB@@@@B@@@B@B@B@@B@@B@@BBB@@@@AB@@@@@BB@@@@@@@@@@B@@B@@@@AB@B@B@@@@@B@BB@@B@BBBB@@DB@B@@BB@@B@BBB@@@B@B@@@BB@@B@@BB@@@B@B@@BB@BB@@@@B@@@B@@@BAB@@@B@BB@@B@BBBB@@@BB@@BB@@@B@B@B@@BB@@@BB@@BAB@BAB@@A@@A@AA@@A@@AA@@AAA@@@AA@@A@@@@@A@AA@@A@A@@AC@@AA@AA@@@@@@A@@@@@A@@@@@A@A@@@@BA@@B@@@BA@@@@BABA@GDE@CBADAHCB@D@DC@@F@BBD@D@DC@ABAFAAAA@CAECAA@@BABA@@@A@A@@A@@AA@AAA@@@A@@@@A@@AA@A@A@@@@@@B@@@@AA@AA@@CA@@A@@@AA@@@A@@@A@A@@@C@A@@BA@@@A@A@@@A@A@@B@BADADA@@B@@@@AA@@A@@BA@A@@@A@@A@@A@@@A@@@@@@@AA@AA@@AA@@@A@@@@BAB@@A@A@@@AA@@A@@@@@@AA@@@@B@@A@@@@B@@AB@@@BA@@@@@@@@B@BA@AB@@A@@BA@A@AB@@A@@@ABA@@B@@AB@@@@A@@B@@@@@B@@@@AB@BABA@@BA@ADGDA@CNKHE@ADAJIBA@ABGAAAAACCACAGAAAAA@CCGCC@CEEECGC@@@CCC@CECAC@E@A@C@AIEAC@ABCBAEICCCCKCGCEA@A@AACCA@C@@E@@A@CCCECCAIAACGC@@@A@CA@C@A@@@AC@AGCECCACCEBC@A@@A@@@A@@@@B@BA@@@@B@@@@A@@B@@ABBBA@@AA@A@@@CA@@@@A@@B@@@B@AA@@BA@@@@@A@AB@B@B@@@B@B@B@@@DBBBBAB@B@BA@@@E@EDE@C@C@@BA@EBCACDG@CACCE@CDEAA@AECAA@CCE@CAEBCAEBE@@@A@@@@@A@@@@@@@@@@A@@@@@@A@@A@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@BAAA@AAAA@@@A@@BAAA@A@@ACAAAAA@@@@@AAAA@AAC@ECGCA@A@@A@C@A@AB@@C@@@ABABA@@@@AA@@AA@@@@A@A@@ACAA@A@BA@A@CB@@AB@@A@AB@DCBA@@@AA@AA@@A@A@CAC@@@A@@@@@@A@@BABA@@B@BAB@BCDA@AB@@ABA@A@@BA@@A@@@A@A@@AC@@@GECA@@@@@AA@@@@@@@@@@@@A@@@@@@@@@@@@A@@@A@@@A@@@A@A@A@@@@@@@A@@@A@@AA@@@@@@@AA@@@A@AAA@A@@@C@@AA@@@@@A@@@@@A@A@A@@@@@A@@@AA@@A@@@@@A@@A@@@@A@@@@@A@A@@B@@@@@@@@A@@@@AAA@@@A@@@@A@@@@@A@@A@@@@A@@@@@@@@AA@@@@@A@@@@@A@@A@@A@@A@@@@A@@A@A@@A@@C@@@@A@@A@@A@@BA@A@A@A@@@A@@@AB@@A@AB@@A@A@@@ABA@@@A@@@@@@@@AAA@@A@@B@@A@ABA@@CCKEGAE@C@C@C@AAAAA@@@CGCG@GAAKECA@C@EHG@CBE@CBAFABA@AAAA@@CEAAACAACBCBC@GD@FEDAFADAL@B@DC@@B@D@BAB@@A@CDE@A@@AAC@AABACE@ABABAPAC@@@EAA@G@O@C@C@CAEGAIBC@C@EDGHMDGBIBC@A@A@@B@BAJCHCFCBADA@CBC@EACACCAKCIAGAA@GECGAE@E@@CKAKEICEAAGEGEGC@@EAIAIAC@A@@@A@@@ICUEGAC@GAKEOEKCG@G@KDKDEBG@EBM@U@IBGBE@EAMEIKECOIGAI@GBCDEL@DBDCD@H@BBF@BBDBBBBB@B@FB@L@NBFAH@DCBQJQDMFEB_DI@K@IDGBADALEB@D@@DBBBN@B@@B@B@BABA@GBA@C@ABADABADCBABC@@BC@ABKFA@ABBB@@BBFBDBDD@BABA@ABC@C@C@A@AB@@ABADADCBAD@BABA@ABAAA@A@AAA@A@AAA@A@@@@B@B@@BB@@BB@@ABA@CBAB@@AB@B@BB@B@B@@@@ABAB@@BB@@@A@AB@@@BB@@@BB@BABABBBB@DB@@BBABCBA@A@AAAA@@AAABCBA@@B@B@@B@B@BC@@B@BBAD@B@BB@D@B@@CBA@@B@@B@@EJ@B@A@A@@@AA@AB@@CDA@AA@@BAAAA@ABA@@@@BBB@B@@A@C@CAA@@BAB@B@@@BA@@BA@@@A@A@AB@AE@A@@@EBKJ@@@B@BAD@@@@BB@@@B@B@BBBB@@@B@B@@A@@@A@@A@@A@@B@@AB@B@BB@B@@ABA@@@@BAB@@C@@@@@A@BB@B@@B@BA@@BB@@@@B@@@BA@@BB@@B@@@B@DAB@@B@@@B@@A@@@A@@@@@@BB@@B@@A@ABB@BB@@A@ABA@A@A@A@A@A@@A@@B@@@A@@@BA@@@@@@@A@@B@A@A@A@@@@@A@@B@AA@A@@@@@B@@A@@@@BAC@@BA@A@@B@@A@@@A@AA@@@B@@@B@@A@@@A@@@@B@@@@@@A@@@@@@@@B@@A@@@A@@@@@@@@@@B@@@@A@@B@@@@@@A@@BA@@B@@A@@@@@@B@@@@@BA@@@@BABAA@@A@B@@B@@@@A@@@@B@@AB@B@@@@@@@@@@@@B@@BA@A@@@A@@@@B@@@@A@@BAA@BBBA@@@@@@@A@@@@@@@A@@@@@A@@B@@@@@B@@@BA@@@A@@@@BA@@@AB@@@@AB@@@@@@@B@@A@@@A@@@@@@@A@KH@@@@OR@@@AA@A@D@@@A@A@A@A@@@EBA@@@AB@@@B@B@@@B@B@@@@A@@AA@@BAD@@B@B@A@@@@B@@@BA@B@@@B@@@B@CF@@A@@@@BA@A@A@@@A@@@@BAB@@A@CB@B@BA@@B@@ABA@A@C@CAC@C@@@A@@A@A@A@@@@@@@@@AA@A@A@ABC@@@ABA@AB@@AB@@A@@@AB@@A@A@@BAB@@A@AB@@A@A@A@ABA@ABA@A@A@C@@@AAA@@B@@A@@@A@A@A@@AC@@@@B@@@@@@BB@@@@A@A@@@@BAAA@A@A@A@@B@@@@A@@@@@A@@@@@A@@BAB@BADAFADAHGD@B@@@B@@AB@BA@AB@@@@AB@@@@@@@@@@@B@@A@@@AB@@@@A@@@@@A@@@@@@BA@@@ABAB@@@B@@@B@@@@@@@BB@D@BB@@ABGJIDCBADCBEFABA@ABC@@BC@@BAB@@A@@@GDA@CHGJKTEFEJCHCDCJELCFAF@BADAFAD@DCHAF@BAFAD@DAFAD@B@BABBB@@BB@BBB@BBDBBBB@DBBBB@BBBBB@BBDBBBB@BBDBB@@BBDD@BB@DD@@BB@@FDHDFDBBBBDBBBDBBBB@BBBBBBFDDB@@BB@@BDBB@B@@BB@B@BBFBB@BBB@BBD@BBB@DBB@BBD@B@BBB@BBB@B@BBD@BBB@B@BBBBD@BBD@BBB@@@B@@AB@@A@A@ABA@@@AB@@@B@@@B@B@@@B@BBB@BB@@B@B@BAB@B@@AB@BABA@AB@@A@A@@BA@AAA@A@@@A@@BA@@B@@AB@@@@A@A@@@EDABA@@@@B@@DBJDHDJD@@@B@DBD@@@B@@@@AB@@@@A@@@@@@B@B@@@BA@@@A@@@@@A@@B@@A@@@@@@@@BB@@B@@@B@B@@@B@B@B@B@@@@E@A@@@CBA@C@@ACB@@A@CBA@A@@@A@C@@@A@AA@AA@AA@AAA@@CA@AAAA@AC@AA@AA@@AAAAA@A@@@CAA@A@@AA@@@CAAA@AA@@@ACA@A@AACCA@@AA@A@EA@@A@C@E@AA@@C@@AE@A@C@A@@@@AE@A@CA@AA@CCA@CAA@@@ECA@@ACA@@EAA@@@A@C@G@A@ABA@@@CBA@@AA@@@A@A@@@@@AB@@@@@BA@A@@@A@@@AA@@@AA@@@A@A@A@A@@@A@@@@@AB@@@@@B@@A@@@@B@@BB@B@@@@@@A@C@G@A@A@ABA@@@EB@BA@@BA@A@C@C@AAA@AA@AA@@@AACAC@CB@@ABE@A@AAC@AAA@ECA@AAAA@@EAAAA@@@A@AAA@G@A@E@@@CBA@AB@BA@A@A@E@A@A@E@"],encodeOffsets:[[129922,47497]]}},{type:"Feature",id:"230128",properties:{name:"通河县",cp:[128.746124,45.990205],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@ABAA@@A@A@A@@AA@AA@@@AA@@AA@A@@B@@@BA@A@A@@@AAA@@@@@AB@@@BCAEACACAAB@@BB@@@@ABA@A@@A@@@A@@BA@@@AA@@@A@A@@@A@@@@@@B@@AB@B@@@BB@BB@B@@ABA@AB@@@BBBB@BBABB@@B@@@BA@@BABA@A@A@@BA@A@A@A@@@ABA@@B@BA@A@A@@BA@A@A@A@A@@@A@AAA@A@@@ABA@ABA@@@A@@B@@BD@DADADAF@BAB@@@BAB@@ABA@@@ABA@@B@@ABA@AA@@A@@@CB@@ABCF@@AD@@AB@B@F@B@HBFFFBDAHBF@B@D@BBD@@B@@@@@@@@@B@@B@@B@BB@@B@@BB@@@@@B@B@@B@@@@BB@B@@BB@@@@@@@@@BB@@@@@@BB@@@BB@@@BB@@@B@BB@@@@@B@B@B@B@@AB@@@B@@@@@B@@A@@@@BBB@@@B@B@B@@AD@@@B@B@@@B@@@@@@@@ABAB@@@B@@AB@@A@@B@@AB@@AB@@@B@@@BA@@@A@@@@@AB@@@@@B@@@BA@@B@@A@@@@@@@A@@BA@@BAB@@@B@@@@@BA@@B@@AB@@@@@BAB@@@B@@@@@@@@AB@@@@@@AB@@@@A@@BA@@B@@ABA@@D@B@@BB@@D@@@D@D@DBB@DD@@BBD@FBBB@BB@@@B@B@B@H@B@DB@@BBBBB@BB@@@BB@@@@@DBB@D@BA@@BBBBDB@@BBB@@@DBFD@@@J@@BDDHAD@DBBDBN@LBFBFFHH@DBJAFB@B@B@@@BBD@DB@@HBJDDBB@@@@@B@B@BABA@@@@@@@@B@@@@@B@BBDBBB@@B@@BBDDB@@@@BB@B@@@B@DBD@B@BBB@BBDDB@D@B@B@BAD@B@B@D@B@B@BAD@B@BDF@DB@DBDBB@F@BB@@B@@B@B@B@F@D@B@@@@@@@BA@@@A@ABCB@@A@@B@B@@AB@@A@ABA@@@@@@@@B@@B@@@@B@B@@@@BBB@@@@BDBBB@@@@@B@@@@@D@@ABA@@B@@@@@D@@AB@@BD@@@@@B@@@@@B@@@@DD@@@@@@ABABABABABAB@@A@ABC@@@AB@DA@BH@B@BID@@@B@@A@ABA@ABCB@@ABA@CBABAB@@@@@B@@@B@BBBB@@BB@@@BD@@@B@DA@@B@@@B@@@BA@A@@BA@@B@@@B@@@BB@@B@B@@@B@B@BB@@B@@BBB@BD@@BB@BB@BB@@@B@@@@@D@D@DBF@B@@BBB@B@DBF@B@B@B@BBFBDB@@BB@BBBBBDD@@@B@@@B@@@B@@@B@@@@@BB@@B@@@B@@@DBF@B@@@BDBDBBBBB@@@B@BB@@BBB@B@@@@AB@@@B@@B@@DDFBB@@BB@@B@B@HABAB@D@@@@@BB@@BB@@@@@D@@@@@@@@@@@BBBB@@@@B@@@BB@@B@@DD@D@B@@@BBB@@@B@@@BB@@@@B@B@DAB@@@BABAB@@@@@@@B@@@B@@@@@BBD@B@B@B@@AB@@@@A@@@A@@@ABCD@@@@A@@BA@@@A@@@ABA@@@A@C@A@A@A@ABABA@@@A@A@A@A@@@A@@@AB@@@@A@CB@@ABA@@BABAB@@@B@@@BA@@@A@@@A@@@A@@BA@@@A@@BA@@@ABA@A@@@C@A@A@CBEBEBA@@@@@@@A@@A@@@@@A@@@@@A@@@AA@@AA@@A@@@AA@@A@@C@AAA@AAA@@@AAA@@@A@@AA@@A@@A@@@@AA@@@@@A@A@CBA@A@@@A@@@A@ABA@CD@@CBABA@@@ABA@AB@@C@ABA@ABA@A@@@ABA@@@A@@@A@@B@@A@@@AB@@A@@@@@@@A@A@C@@@@@ABA@AB@@A@@@A@@B@@@@AB@@AD@@@B@B@B@BBB@@@BBB@@B@@DB@@D@@BB@@BB@@B@@@B@@BB@@@@BB@@B@@@@AB@@@@AB@@A@@B@@@B@@A@@@@B@@@@BB@B@@B@@B@@BB@@BBB@BBB@@BB@@B@@@B@B@@@FBB@@AB@@ABABAB@@AB@@@BA@ABA@@BA@@B@BA@@B@@A@@B@@AB@@@@@B@@@@@BAB@B@@@@@DB@@B@@A@@B@@@B@B@B@BAB@@@B@@A@@B@@@@AB@@@B@@@B@@@@@@BBB@@@@B@B@@@BB@@B@B@@@BB@@@@@@@B@@@@BB@@BA@@@ABAB@@@@A@@@AB@@@@A@@BA@@B@BA@@BA@@B@@@B@@B@@BB@@@@B@@@@AB@B@@@B@D@DAD@B@@AB@@@@A@@BA@@@CBC@EBCBA@@@A@@@C@ABA@@@@@A@@@@@A@A@@@@@@@@@@@@B@BB@@BBB@BB@@B@B@@@B@BA@@@@BAB@B@@@@@@@B@@@B@B@B@D@@@BA@@@AB@@B@@B@@BBB@@BB@@@@B@B@@@B@BBBBBBB@BBB@BB@BB@@BB@D@@D@@@BBB@BBDB@BB@@@@@B@@@B@DA@@@@B@@@B@@B@B@BB@@BB@@B@@@@CBC@ABEBABA@@B@B@BAB@@@@@B@@@@@B@B@@AB@@C@@B@@@@B@B@@BB@@B@D@@@B@@AB@B@@BBBFBB@DBB@B@BBB@@@@B@BAB@@@B@@@B@B@@@B@@@@@BABC@AB@BAB@B@B@@@@@B@@@@D@B@@@@@B@@@@@@BB@@@D@@B@@BB@B@B@DB@@D@D@BB@@B@@@B@@@B@@@@AB@@B@@BB@@@@BBB@@@B@D@BB@@B@@@@@BBDBFBB@@@@B@@@DB@@@BBB@B@B@BB@@B@@BB@BBB@@BB@@@B@DA@@B@B@B@BA@@@@@@@A@@@@@A@@@@B@B@@@@@B@B@D@BAB@@@B@@@BA@@DAB@@@BAB@B@@@H@B@@A@@B@@@B@@AB@B@BABABA@@@AB@FA@@BA@B@@B@@B@@@BB@@BB@D@H@H@D@F@@@B@B@D@B@B@DBBBBBD@BBB@F@H@D@B@@AB@@AB@@@@AB@B@@@BBB@@@B@@AB@@@B@B@B@B@B@B@@@B@D@B@@@BA@@B@B@D@D@B@DBB@@@B@B@F@HAD@@@DADABAD@BABA@@B@B@@AB@@@@@B@B@@@BBDDDDBBBB@@@B@@@@BBFBB@@@B@B@@@@B@B@B@@BB@@BB@@BBB@BB@@@@@@@BAB@@@B@B@@@@ABADAB@BA@@BAB@B@@@B@@AB@@ABA@@BA@@BB@@BDDBBDBBB@@B@DB@@F@B@D@@BD@DBDDB@BBBB@@BBDBB@DBB@B@@BB@BB@@@BBB@@BB@B@@@@BB@@B@@@B@BBB@B@F@@BB@@@D@@AB@D@B@D@D@B@B@BBD@D@B@B@B@@@@AB@@@B@DDBBB@BB@@B@D@@@B@@@B@BA@@@A@@@A@@B@@A@@D@@AB@B@B@B@B@B@B@DAB@B@@@B@@A@@BA@@@A@@@@@AB@@@BAB@@@B@B@B@B@B@BAB@B@@@BABABADA@@B@BA@@BAB@D@BA@@@@@@@@B@@B@@@@@@B@@@B@@@B@@@B@BA@@B@@@@@B@B@B@@@B@@@@@BB@@B@B@B@BA@@B@@@BA@@B@@@B@@AB@B@@@BA@@@@B@@@B@@@B@@@DAB@BAFAB@@AB@@@B@B@@@B@B@@AB@@@BABA@@@A@@@@@@B@@AB@B@@@B@@A@@B@@@BA@@B@B@@AB@@A@@B@BA@@@@BA@ABA@@@A@@B@@@BAB@@@BA@@@A@A@@@A@@BA@@@A@@@@@A@@B@B@@@B@BBB@B@B@@@B@BA@@B@@A@@B@B@B@@@B@@@B@B@@BB@B@@BB@@@B@BBB@B@B@D@B@B@@@@B@@@@BB@B@@@B@@BB@@@@@B@@B@B@@@B@D@B@FAHAB@B@@@DA@@B@B@B@@AB@@@BA@@BA@@@A@@@A@@B@@AB@@ABABA@@@@@@@A@@B@@@@@@@D@@AB@B@B@B@@@B@B@B@@AB@@@B@@@B@@@B@@@B@@A@@B@B@@@B@B@B@@@@@BB@BBB@BBB@@B@@@B@@@B@B@B@B@@@B@B@@@@@BB@@B@B@@@@@B@B@B@D@D@B@B@B@@AB@@@B@@@@BB@B@@@D@@@D@D@B@BA@@B@B@@@B@@@B@@AB@@A@@@A@@@A@AA@@A@@AA@@@A@@@@@@@A@@@@@A@@B@@@@AB@B@@@@AB@@@@A@@B@@@B@D@B@FAB@B@@@B@B@BAD@DAB@@AB@@@@AB@@@@AAA@@AC@@A@@AA@@A@@AA@A@@@A@@@@@A@@B@@AB@BCB@BCB@@A@A@@BA@@@@B@@AB@@@BAB@@A@@B@@A@A@C@EAA@A@AA@@ACAA@CAAA@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@A@C@@@A@C@A@C@A@@@ABA@ADABCBA@@@A@@BC@A@@@@AA@A@@@A@@@ABAB@@@BA@AB@@A@@@A@AA@@A@AA@@@AC@@@@@AAA@@@@@A@A@@@@@@@@BA@@@@DB@@B@B@BBB@BBB@B@B@B@D@B@B@@@B@F@@@DAB@B@B@@@BAB@B@BAB@B@@@@@BBB@BBBBB@@BBB@@B@BB@@B@BB@@B@D@D@B@B@F@B@D@B@@@@@@@B@@@B@@@B@B@@@BA@AB@@AB@@@@@@@B@@B@@@B@BB@@BBB@BB@BDDB@BBB@@B@@@DBF@DBB@BBD@DB@@BAFABADAD@BAB@B@B@@AB@B@DADAB@B@B@B@B@B@B@B@@@B@B@B@B@B@@@B@BABABABA@@@@@@B@B@B@B@B@D@B@BAD@B@BAD@@@B@@@B@@@@@@@BB@B@@BB@@@B@@BBB@@B@BBB@B@@@@@BB@@B@@@B@B@@@B@@@@@B@@@@@B@B@@@@@B@@@B@@@@@@@@B@B@@@@@@B@@BB@B@@BB@@B@@@@@B@BA@@BB@@B@B@B@FBB@B@BBB@@@B@@@B@@@@@@BB@@BBB@@BB@B@@@BB@@B@@BBB@B@@@@@B@@@B@B@BBB@B@B@@@@@B@@@@B@@@B@BB@@B@@@@B@B@B@@@B@@BB@@@B@@@@@B@BA@@B@B@B@BBB@B@B@B@BBB@@@B@@@B@BA@@B@DBB@D@B@B@B@B@B@B@@@B@@@@A@@@A@A@@@A@@@ABA@@@@@@BA@ABA@@@@B@B@@@BAB@@@@@DAB@B@B@@@B@DAB@BB@@D@@AD@B@BAB@@AB@B@@@B@@@@@BB@@B@@@D@@@D@D@@@B@@@B@@@BA@@B@@@B@D@B@B@B@@A@@@@BA@@B@@@B@BA@@@@BA@@@@@@BAB@B@B@BABAB@BA@@D@B@B@B@BA@@B@@@@@B@@AB@BA@@@@@ABABCB@@ABBD@DAD@B@DAB@FABA@@@ABABABC@@@@BA@@B@DBB@B@@@B@B@@@@@@A@@@@B@BAB@@@B@DA@@B@B@B@B@BBB@B@@@@BB@@BBDDDBB@BB@@B@@@@@B@@@@@B@@@B@@@B@@@BB@@BDBDDDDD@BBB@@@BB@@DB@BBB@@DB@BB@@@BB@B@@@BAB@@@BAB@@@@@@@B@@@@@B@@@@BB@@@@@@D@@@@@B@@@BB@@B@BBB@@BB@B@@@B@@B@@BB@B@@@B@@@B@@@@D@BB@@BB@@@@BB@@B@@@B@DBD@B@@@@@BB@B@@@B@B@@@B@B@@@B@@@@@B@@@@@@@@@BB@@@B@B@@@BB@@@B@@@@BB@@BB@@BB@@@@B@B@BBDFDD@B@BBB@B@@@@@@BAB@B@BA@@B@D@B@D@B@D@B@@@@@@A@A@A@@BABABABADABA@@@@D@@A@@B@BA@@B@@@@A@A@@BA@@@@@AA@AAAA@A@A@ABA@@B@@@BA@@@@D@D@B@@@BAD@B@DABAD@B@DAB@B@@@BA@B@@@@FBDBFDB@BB@@B@D@B@BB@@BBBBDBDBDB@@@@B@B@B@BB@@@@BB@BBB@@@@@@D@D@B@DBD@B@B@B@B@BA@@BABABAB@@AD@BA@@BAB@BAB@D@B@B@D@@@B@@@DABAD@BAB@B@@@B@B@@BB@@BB@@@D@BBD@@BB@@@BA@A@@BA@AB@@@DEBADCFABABA@C@C@ADCDABABABADADAHEDADAB@DABAB@@@BAAA@AAAAA@ACA@ACA@AC@AAA@@@AA@A@ABA@@@ABAAA@@AAAA@@AA@A@C@C@GAA@A@AAAA@@ACAAACAAA@A@AA@BA@A@@@A@A@ABA@@@@@@B@B@BA@@B@@A@@@@@A@A@A@@B@@ABABABABA@A@@@@@AA@@ACAAC@@@ABA@@DABABA@@BAAA@A@A@@BA@@BA@@BA@@DAD@B@DA@A@A@C@ABA@@BCDABADADAD@B@B@BAB@BAB@@@BA@@B@@@@AB@@@B@@@B@B@B@@@B@B@BA@@B@@@BA@@B@@@BAB@B@BA@@@@@AB@@A@@@@@A@@@@@A@@@@AA@@@@@A@@@@@@BA@@BA@@@ABA@@@A@@BA@@@A@A@A@@@AAAAAAA@@@@@AAA@@@AA@@A@@@@@@@@@@@AB@@@@@BA@@@@B@@@B@@@B@@@BAB@B@B@@A@@B@@@@AB@@@BA@@B@BA@@D@@@@@B@B@D@B@D@D@B@B@@@@@B@BAB@@@B@@AB@BC@@@@DABA@AB@@@BA@@B@BA@AB@BA@@B@@A@@@C@E@@@A@A@@@A@@@@@A@@@@BA@@@AB@@AB@BABA@@B@BA@@B@BA@@@@@@B@@@@BDB@@FDBBB@@@B@@B@@BA@@B@BA@@B@@A@@@@@A@@@@@A@A@C@@@A@@@@@@AAA@@@A@AA@@@@@@@A@@@A@@@A@@@C@AAAAAAAAACAAA@@A@@@A@A@AB@@A@@@@@A@@@@@A@@A@@@A@C@A@@BA@A@A@@@A@@@A@@@A@@@A@@B@@AB@@@B@@BD@BBB@B@@@B@@@DADCDADADCDADA@AB@B@BAB@B@D@B@@AB@B@B@D@D@D@B@D@@@B@@@@@B@@ABAB@@AB@@@B@@@B@BBBBB@BBDBBBB@BB@@B@@@@@BA@@@A@AB@@A@@B@@@B@@@FBBBB@B@BB@@B@@@BA@@@@BA@@@A@@BABA@@@AB@@AB@@@@AB@B@B@B@B@BAB@B@@AB@@@@A@@BA@@H@@@FBDBB@@@B@BABAD@BAB@@A@@B@@A@@@AB@@AB@@@@CBA@@@AB@@@BA@@B@BA@@B@@A@ABA@ABA@@BABA@@@@BA@@A@@AAACAAAAA@AC@AAAAAA@@AAAA@A@A@C@A@@@A@@AAAACCCCAAAA@ACCACA@@AAAAACECECCACAAAA@@@ACCCEEEACACA@@@@AA@@@AAACAAAAACAA@AA@@AACCCAACE@@AAAAAEAAAAAA@@AAAACACACCEACCAAAACAA@@ACCAAAAACAA@AAA@CAA@A@A@C@C@C@EAA@A@AEMAAIKACAGEOAG@ACEKKGIACAE@E@C@C@CBG@AEOCE@GBK@CACCGCEAEGGAAECIESEKAMCCCSOMGGIAAEMIYIOACAEA@@A@@AC@A@AEKEGIEMEAAMGGICAAACCCAGCKGGCGEACA@AACEEEACCAGC]IQGQOKICAEEECCCCAGEEEAAEAAAC@E@IBEBC@SHaHK@G@MBQDE@G@EAEAEEGI@@GIAE@CCEACAA@@@@CEAA@G@@AECCECKAG@@@E@A@CBCDA@KFMDK@IAWEG@M@UDMDIBQBEBCBABABEFAF@JBFFF@@B@JFJH@@DHDD@FCDMJIDEFIJCD@HADAFCFIHGDEDIBCBABCBCBCBA@KFCBIDC@C@@@EBC@MBK@OCGAIBMBIBQ@@@M@C@E@G@KAICECGA@AMGIEIGGIGGMIKAMAM@MAGAGCCAECECAEACACCAGE@@CAGAAAUIGAIEQCMCGAC@EAC@KAOBMBKDMDCBKDE@GBC@I@GBK@SBKDOBIBIBIBCBC@C@A@A@C@@@CBC@CBCBC@CDEBCDA@CBCBGDA@EBKFC@CBCBEBABOLGHKFMFSFEDIFIDIDA@MDW@Q@IAE@A@C@MBQBOBODI@KBKAK@OEEAA@CAKACAC@EAGAEAAACAGA@@CA@@ECCCAACC@@A@E@IBC@A@A@@@@@A@A@A@@@A@@@A@AB@@A@@@A@@@A@@@A@A@@@A@A@@@A@A@A@A@@@ABA@AB"],encodeOffsets:[[131313,47060]]}},{type:"Feature",id:"230129",properties:{name:"延寿县",cp:[128.331643,45.451897],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@@A@A@A@A@A@@@AA@@AA@@@AA@@@A@@@@@A@A@@BA@@@@@ABA@A@@B@@A@@@A@@B@@@@A@@@@BA@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@A@A@@@A@A@@@@@AAA@@@A@@@A@@@A@A@@@A@@@A@@@@A@@@@@AA@A@@AA@@@AA@@@@A@@@@@A@@@A@A@A@@@A@@AA@A@A@A@A@C@CAA@A@@@A@A@@@A@@@@@A@A@@BA@A@@@@@A@@@@@@@AB@@A@@@A@@@A@A@@BA@@@A@A@A@A@A@A@A@A@AAA@CACAC@AAAAC@AAC@AAA@@AA@A@A@@BA@A@A@A@A@@@A@A@A@ABA@@BA@A@A@A@@@A@A@A@@B@@A@A@A@@BA@A@A@A@A@C@@@C@A@@@C@@@A@AB@@A@ABA@A@@B@@A@@@@@@@A@@@@@A@@BA@A@@@A@A@A@AA@@@@A@@@ABA@@@A@A@ABA@ABC@@@ABA@ABCBABABCD@@A@A@@@AA@@A@@A@@A@@@@AA@A@@AA@A@@@AA@@A@A@@@A@A@C@@@@@A@C@ABA@A@@@A@A@@@AAA@A@A@@@A@A@A@A@A@C@C@A@@@AA@@@@AAAA@@A@AA@@AAA@@AA@@@@@@AA@@@@AA@@@A@A@A@A@A@AA@@A@@@@@AAAAAAAA@AAA@AA@@@AA@@AA@@AA@AA@@A@@@AA@@@@AB@@@@A@@BA@@@@@A@@@@@A@A@A@@BA@A@@@A@@@A@@AA@@@A@@@@@AA@@@@A@A@@@A@@@A@A@A@@@@@A@@@@BA@@A@@A@@A@@@A@@@A@A@ABC@AB@@@BCB@@@@CBCBABCDA@ABA@AB@@@AA@A@@AA@A@A@@@A@A@A@@@A@A@A@@@A@A@A@A@@@@BA@@@A@AB@@@@AB@@A@@@ABA@AB@@A@@@@B@@@@@B@B@B@@@@BB@@@B@@@B@B@BB@@B@B@B@@@@@B@@@B@@@BBB@@@B@@@@@B@@@@@@A@@@AB@@ABA@@BA@AB@BA@@B@@@@@@A@@@@@@@A@B@@@A@@B@@B@@@@B@@A@@B@@@@AB@@@@@@A@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@A@A@@@AAA@@@A@A@@@A@AB@@@@A@@BA@@BA@@@@@ABA@@@A@A@@@A@AB@@@@A@@@A@@BA@A@@@A@A@@@A@A@@@@@C@@BEBCBADAAA@@@A@A@@A@@@A@@@AA@@AA@A@AAA@A@@@ADB@A@@B@@@BABA@@B@@@BBBB@@B@@@B@@AB@@ABA@ABA@@@AB@@A@A@A@@BBBA@AB@@AB@@@BB@@BBB@@AB@@@BB@ABA@A@A@@@@@@@A@@@AB@@@@@@@B@@@B@@@@B@@B@@@@BB@@@@@B@@@@A@@BA@A@A@@@A@A@@@A@@@AB@@@B@@@B@@@@@B@@@BA@@@A@@BA@@@@B@@@@@BB@@B@@@BBB@B@@@@@@A@A@AB@@@@@@@@A@@@A@AAC@@A@@C@@@@AA@@@@@@@@@@@@BB@@@@@@@@@A@@@ABA@C@ABA@@@A@@@A@A@@@A@CBABC@@@C@@BA@AB@@@@@@@@@B@B@@@B@B@@ABA@@@@DA@BB@@A@@@@@@@A@@@@@@@@BAB@@BD@@@D@DCB@@A@@@AB@@A@A@@@A@@@CB@B@@@B@@@B@@BB@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@A@@@@@AB@@@@A@@@A@@@@@@@@@@B@@@@@@@@A@@@A@@@@@A@@@@@@@AB@@@@A@AB@@@@@@@@A@@@@@A@@BA@@B@@@@@BA@@B@@AB@@@@@B@@@BB@@@@@B@@B@@@@BB@@B@@@@@B@@@@@BAB@B@B@@B@@B@B@DBBBDBB@@@BB@@BAD@@@B@@@@@B@BBBB@@@@@@B@DA@@B@@@@@@@B@@AB@@@@@B@B@BA@AB@B@B@BA@@@@@@B@BB@@FBD@BBB@B@@@B@B@@@@@BB@@BB@@BB@@BB@@BB@BB@@@@BB@@@B@@B@B@@@@@B@@@B@@@B@@@B@B@@@@@@A@@@@A@@A@@@A@@B@@AB@@@@@B@@@B@@@B@@@B@@@@@@A@@@A@A@@BA@@@A@@AA@A@AB@@@@@@@B@@AB@@@@@B@@A@@@A@A@@@A@A@A@A@@AA@A@A@A@A@C@A@A@@AA@A@A@@AAB@@A@@@A@A@A@A@C@@@A@AAA@@@A@@@AB@B@@@B@B@@@B@@@B@B@@@B@@B@@BB@@BB@@@@B@@@BBB@@@@AB@@A@@@AB@@@BB@@B@@@B@@@B@@@@AB@@@@B@B@@BB@BB@@B@@B@@@@@B@@@@@B@@BB@@@@@B@@@B@@A@@B@@A@A@ABA@@@A@@BA@@@@BA@@@A@@@A@@@@BA@@B@@@@@@A@@BA@@@A@@@@@A@@@A@A@A@@@A@@BA@A@@BA@@BA@ABA@@BA@@@AB@@A@A@A@A@AAA@A@A@C@AAC@AAA@@@A@A@@AA@A@A@@@A@@@A@A@@@AA@@A@@AA@@A@@@@@@AA@@@@A@@@A@@@A@@@A@A@A@@@@@@@A@@BA@@@AB@@@@ABABA@@BA@A@@B@@@BA@@BA@A@@BABA@@@@BA@@@@BA@@@AAA@A@A@@@A@A@A@@B@@AB@B@@AB@B@@@B@B@@AB@B@@BB@B@B@B@@@BB@@B@BB@@BB@BB@@AB@@ABA@A@AB@@AB@@A@ABA@@BA@@BA@@B@@@B@@BB@B@@AB@@@@@B@@@@@@AB@@AB@@@@@BB@@@@B@@A@@B@B@@@BB@@B@@BB@BB@BB@@BB@B@@@@@BB@@@@B@@@@@B@@@@@B@BAB@B@@ABCBABCBABC@ABCBEBABA@@BA@@@BD@@@B@B@B@@@BBD@@@B@DB@@B@B@@BB@D@B@@@B@@@B@B@BAB@BCBABAF@BAB@BCBAB@@AB@@@BB@@B@@BBB@@BBB@B@@B@B@@@D@BBB@B@@@B@@BBA@@B@BA@@B@@AB@BA@@@AB@B@BAB@B@@@D@@A@@B@@@@A@@@@BBDBDBBB@@@@B@DBD@B@@@@B@@BBBBBBBBBBDBB@BBD@DB@@D@@@@@B@BD@@BBB@@BB@BBDB@BB@@B@@BBBB@BBB@BBD@BBB@D@BB@@@D@H@D@DAD@B@@@B@DBD@D@BBJ@B@B@@@B@@@B@B@B@@@B@B@B@D@B@B@DBB@B@B@B@BBB@BB@@@@@B@BA@@BCD@@@B@@@B@@@B@BA@@@AB@@@@@@@@@B@@BB@@@BB@@@@BA@@B@@@@@@BBB@@BB@@@@B@@@@@@B@BB@@@@BB@@@@BB@@@@@BA@@B@@@@@@@@@@B@@@@B@@@@@@@@@@B@BBB@B@@@B@@@@@BA@@B@@A@@@@@@@@BA@@B@@AB@B@@@BA@@BA@@@@BA@@@@@ABAB@@ABA@@@AB@@@@@B@@@@@B@@@@@@A@@@AB@@A@@@@B@B@BA@@B@@@@@@@BA@@@@B@@@@@B@B@B@@@DA@@D@B@@@B@@@B@@@@BB@@@B@@@@@BAB@BA@@@@@@B@@A@@B@B@BBB@@@BBB@@@D@@@@@B@@@BA@@@@B@B@@@BB@@B@BB@@@@BBB@B@@BB@@@@@BAB@@@@@B@@B@@@@B@@@@@BAB@B@BA@@@@B@@@B@B@@@@@B@@B@@B@@@@@BAB@B@@@@@BAB@@@@@D@@@@@@@@@B@B@@@B@@@B@@@BA@AB@B@BA@@@@@@@A@@@@@@@AB@@@@@B@@@B@@A@@@@@@B@@@BA@@B@@@@@B@@B@@B@@@@@@@B@@@@@@B@@@@@@AB@@@BB@BBB@BBB@@BBB@@@@@B@B@@B@BB@@B@DB@@B@B@B@@AB@BA@@BA@@B@@AB@@@@@@@@@B@D@@@B@@@B@B@BA@@D@@A@@B@@@@@@A@@@@@@@A@@B@@@B@B@@@@@@@B@@A@@@@@@@A@@@@B@@@B@@@B@@@@@BA@@@@@@@@BA@@@@@@BA@@B@@@@@B@@@BA@@B@B@@A@@B@BB@@@AB@B@@@@@BA@AB@@@BAB@@AB@@@BA@@B@@@@@@@B@BB@@B@D@B@@@B@@@@@@@B@@@BB@@D@@@B@@AB@B@@@B@@AB@@A@@B@@@@@@@B@@@@@@@BB@@DB@B@@B@BB@@@@@@@BB@@@@BB@BB@@B@@B@@B@@@@B@@@@@B@@@BBB@B@@@B@B@@BB@@@BB@@BB@@@@@@B@B@@B@@@@BBBBB@@@@@@@@@@@@AB@@@@@B@@@@@B@B@@BB@@@B@@@B@@@@A@@@A@@@A@@@@BA@@@A@@@A@@@@@@@@@@@@B@@@@@@A@@@A@@@A@@B@@@@AB@BA@@B@B@B@@@@@@A@@B@BA@@@@BA@B@@@BB@@@@B@@BDBBBB@@@@@@B@@ABEDAB@@ABA@A@ABC@A@ABA@AB@@A@@B@@@BA@@B@@@B@B@B@@@BABA@@@A@@@@@A@@@BB@@@@AB@@@@BB@@@@@B@@@@@@@@A@@@@@@@AB@@A@@@@@@@@@@@@@@@@@@B@@@@@B@@@B@BA@@B@@@BBB@@@@@BB@D@@@B@B@@B@@B@BB@@B@@@@ABABA@@B@@@@@B@B@@@BB@@@BB@@@@@B@@@B@B@@@B@B@BBB@B@@BD@@@BAB@@@@@BAD@@@B@@@B@@@BB@B@B@B@@@BB@B@BA@@B@D@B@B@B@B@B@B@@B@B@B@BAB@@ABAB@BAB@BB@@@BB@@@B@@@BB@@@@@@BBB@@B@@BB@@@B@@@@@B@@@B@B@@@@@B@@@@@@B@@@@@@@@@B@@@@@B@@@B@@@B@@@B@@@@@@@BB@@@AB@@AB@@@B@@A@@@@@A@@@A@@@AB@@A@@B@@@BCB@@AB@@A@A@A@A@A@@@@B@@@@@@B@@@@@BB@@@@@B@@@@@B@@@@@@AB@@A@@@@B@@@B@@@@@B@@@@B@@@B@@@@B@B@@@B@@AB@@@B@@@B@@@@@BB@@B@@@@A@@BA@@@@B@@@B@@@B@B@@@BBB@B@@BB@@@B@@@@@BA@@B@@A@AB@@@B@@@@@@BB@@B@@B@@B@B@B@@BB@B@@@@@B@B@@@B@@@@@BB@@B@@B@@B@@@BB@@B@@@@B@@BB@@@@BB@@B@@@@@BBB@@@B@BA@@D@BAB@BA@@BAB@@@B@B@@@@@B@@@@BB@@@BB@B@@@BB@@BBB@@B@@B@@@@@B@@@@AB@@@B@@@@@@@B@@@@@@A@AB@@A@@@@@@@AB@@A@@@A@@@@BA@@@@B@@A@@BA@@@@@@@AB@@@B@@@@@@A@@B@@A@@@A@@@@@@@AB@@@@A@@@@@A@@@@@A@@@@BA@@@@@A@@@@@@BA@@@@@@@AB@@A@@@@B@@A@@B@@@@@@@B@@@@B@@B@@@@@@@@@BB@@@DBB@D@F@B@D@B@B@@@F@B@D@B@@BB@@BBB@@B@@@BA@@BBB@@AB@@@B@D@B@B@B@DBB@F@B@DAB@B@BBB@@A@@BA@ABA@@@@B@@@B@B@BAB@B@@@@@B@@@@B@@@@B@B@BA@@B@@@B@B@@@B@B@@@@A@@B@B@@AB@@@@@B@@@BB@@B@B@D@B@BB@@BB@@@DB@@BB@BBBB@@@@@BBB@@@B@@B@@@B@@BB@BB@@@B@@@@B@@@BABA@A@@B@@AB@@@@@@@@@AA@@@A@AB@@ABABAB@@@BA@@B@@@B@BA@@B@@@B@B@B@@@@@BA@@B@@@B@B@@@BBB@@@B@B@BADAB@B@@@@@@B@@BBDB@@@@B@@@@@@@@@@@BABA@@B@@@@@@@BA@@@@B@@@@@B@@@B@@@@@B@DA@BB@@@B@B@@AB@@@@@@@@@B@@@@@DBD@BB@@@@@@@@@B@@@@@@@@@@B@@A@@@@B@@@B@B@BB@@B@@@B@@@B@@B@@@@@@B@@B@BB@ABB@@@@@D@@@DB@@B@D@@@B@@A@@@@@@B@B@@BB@@@B@B@BAB@B@@@B@@@BB@@B@@@B@B@BAB@F@F@BB@@@@B@B@@@B@@@BAB@@@B@@@B@B@BBB@B@@ADABAB@D@DBB@@@@BB@B@B@BAD@BAB@B@@@B@@BB@@@BA@@BA@A@@B@BBB@@@@@@A@@AA@AB@@@B@@AB@B@@AB@BABAB@@@B@B@B@@@B@@@B@@AB@B@B@B@B@D@@AB@@@B@B@BABBB@BAB@B@DAB@@@B@@AB@@@B@B@B@@@B@@A@AB@@@B@D@@@BAB@B@@A@@B@@@BB@@B@DBB@B@@@@@@AB@@AB@@A@@BA@@B@D@@BB@B@@BBAB@BAB@@BB@@@BB@@B@B@@@@@B@@@@@@A@@@A@@@AB@B@@@B@B@DAB@DA@@B@@@B@@@B@B@@@B@B@@@@A@@B@@ABA@@@A@A@@B@@A@@@@@@B@@@B@@@@@B@@@@AB@@@@@@@B@@@@@BAB@@@B@@A@@BA@@@A@@AAAA@AB@BA@A@A@ABA@CBA@@B@@@B@@@@@@A@@@@@ABAB@BAB@BA@A@@@@AAAAAACACAA@@A@A@@BA@AAA@AAAA@DA@AB@B@@ABABA@A@A@@CCCA@ACCAABAB@BA@ABA@@B@B@B@@@B@B@BA@@@@BAD@@@DAB@@BBBBAB@@BBAB@B@BBB@B@B@@@@A@AB@@BBAB@B@B@@@B@BAB@@@B@@@B@B@BB@@@ABABB@@DA@BBBB@@AB@B@@A@@DAB@B@@@B@B@@BBA@BB@BABA@@B@@@BA@@@BF@@AB@B@B@@@BBB@B@B@B@B@@@B@AAB@@BBAB@@BD@B@@BABBBB@@@DABA@@B@B@B@B@B@F@DD@AB@@@D@BAB@BB@BB@BBBB@D@B@BBBAB@B@B@FBB@BA@C@A@C@A@@@@DBBBBJFLHD@BBD@D@DB@B@BABA@A@A@A@@B@D@BDBBB@B@@CDAB@B@B@BDBFDBBAB@BAB@@@BF@@@@BCB@BB@B@BADBDBDB@DB@B@BBB@DD@B@BABCBC@@@A@@B@BBBB@DBDBBBD@BDBBB@DBB@BB@@BDBB@D@H@BBBD@B@DABAD@DBDBDBH@B@BAAAA@A@A@A@BA@AAA@A@@D@B@@A@AAAAAA@A@A@@ABAB@BA@@DBBA@@DAB@BB@BB@B@D@@AA@AAA@@A@@@@F@@AB@AA@@@A@A@@CAAA@@@AB@BAB@@BBBB@@@B@BAB@D@B@@A@AAA@AA@@A@AB@B@B@BBB@BA@@@AA@AA@AA@@AB@D@B@BA@A@@BAB@DADC@ABA@@CAABA@AAA@BAAAB@BA@A@AA@E@AA@@@CBAD@B@BBB@BABA@ADABAD@D@B@B@FAFCB@AC@@BABAB@BA@AB@@@B@B@@A@A@@BA@A@@CA@A@@BABA@CBAAA@@A@@@A@AA@@@@@ABABABA@ABA@A@ABABABA@A@AB@B@BA@@@AAA@AAAA@A@AAAAAA@AAAB@DAB@B@@A@@@AAAA@@AB@@AB@DAB@BA@A@C@ABABAB@B@@BB@BB@@B@B@@A@@B@BB@@B@BA@A@AC@C@@A@@BAB@B@B@@A@AB@B@@BB@BABA@@D@@@BA@@@AA@@@A@A@AA@@@@AAA@@@BADAB@BA@AACAC@AA@@A@@@@BAB@@@@AAAAA@@@ABA@@B@B@B@@A@ABABAB@B@DBBBD@B@@@BA@@AAA@@@AAA@@A@ABABA@AB@BB@BB@@BB@@ABA@@AA@@AA@@BAB@B@@@B@B@B@B@BA@@B@B@@@@@@@B@B@BBB@B@B@B@B@B@B@@@B@B@@@B@B@@AB@B@B@B@B@B@B@B@D@B@B@@@DBD@BBB@@@B@B@@@B@B@B@@BB@BAB@B@B@B@B@B@BB@@BB@@B@@@B@D@@@B@B@@BB@@B@@BBB@BB@BB@BBB@@@BBB@B@D@B@@@B@B@@@B@B@B@B@D@B@D@B@B@B@B@B@B@D@B@@@BBB@BBB@@@BBB@B@B@@@@@BAB@@@B@BAB@B@BA@@B@@@B@B@@BB@B@BB@BB@BBB@BB@@BBB@B@BB@@B@BBB@BBB@@@B@B@B@B@@@B@B@B@B@B@@@BBB@B@BB@@B@B@BA@@B@B@B@B@B@@@B@B@B@BBB@@AB@B@@AB@@@B@B@B@@@BA@@BAB@@@B@B@B@B@B@@@B@B@BABA@@B@BAB@B@@@B@B@BAB@@@B@B@B@BAB@@@BAB@BAB@@AB@BA@@@@DA@ABA@ABA@C@@AA@@@A@@@AB@BAB@B@@AB@B@BAB@@AB@BA@@B@BBB@B@@AB@@AB@B@B@B@BA@@B@B@BAB@@AB@@ABA@@BA@@B@BAB@@A@@@ABAB@B@@@B@B@DA@AB@BAB@@AB@BAF@@@B@@AB@B@BAD@@@BAB@B@B@BA@@B@@@BA@@BA@@BAB@B@B@B@@@BAB@BAB@BA@@B@B@@AB@B@B@B@@@BAB@B@@A@@B@@@@AB@BAB@@@B@B@B@B@BA@@BAB@BAB@B@@@B@B@B@@@@@B@BAFA@@@@BAB@@ABABA@@@ABA@A@@@AB@@AB@BCFC@ADC@A@BA@@@A@@BA@@@A@@@A@@@A@@@@BA@@@@@A@@@@@A@@@A@A@@@A@@@A@A@@@ABA@@@A@@BA@A@@@@BA@@@@@A@@BA@@@@@C@ABA@@@A@@@@@A@AA@@A@@@A@A@A@A@A@@@A@@@AA@@A@@@@@A@@@@@@AA@@@@@AA@@@@A@@@@@A@@@A@@@A@A@@A@@AAA@@@@A@@@@A@@A@@@@@AA@@@@@AA@@@@AA@@@@AA@@A@@@A@@A@@A@@@@@AA@@@@@@A@@@@@AA@@A@@@A@@A@@@@@@A@@@@A@@@@A@@A@@@@A@@@A@@@@AA@@@A@@A@@A@@@@A@@@@AA@@A@@@@A@@AAA@@@@@A@@A@@A@@@@@AA@@A@@@@@A@@A@@A@@@AB@@@@@@A@@AAFSF_HSD]FQFQDWBOCOEGCCIPMZEXI^CXCT@T@R@JD@A@@AA@A@A@@@AAA@AA@@A@@AA@@AA@CAAAAA@@AA@@@@@@A@@@A@@BA@@AA@@@A@@@@@@@A@@@A@@@@@@@A@@@@A@@AAA@@AA@@@@@A@@AA@@@A@@A@@@A@AAAA@@AAA@@A@@AAA@AA@@@@@@@@@A@@@@@@BA@@@@@AB@@@@A@@@@@A@@@@@A@A@@@A@@@A@@@A@@@@AA@@@AAA@@A@AA@@AA@@A@@@A@A@AAA@AAAAAAAAAAA@@@AAA@AA@@A@@@@A@@AA@@@@A@@@@AA@A@@@@@AAA@A@AAA@AA@@CAAAA@AA@@A@A@AA@@@@AAA@A@A@AA@@A@@@@@C@@@AB@@AB@@A@A@@@A@@@A@@@@@@@A@@@A@A@@BA@@@A@@@@@@@A@@@@@@AA@@@AAA@A@@@A@@@A@@@AA@@@@AAA@AAA@@@A@@@AA@@@@@AA@@@@@@AA@@@@@@@@@AA@@@AAAAACA@@AA@A@@A@@@CA@@@@@@@A@@AA@@@@A@A@A@AA@BAA@@@@A@A@@@A@A@A@C@CAA@C@C@A@A@CAA@@@@@A@@@A@A@@@C@@@G@C@A@@@A@C@A@C@A@@@A@@@A@@@A@A@@@AB@@A@@@AB@@A@AAC@@@@@A@A@A@A@AAABABC@@@@@ABA@@@A@ABA@A@A@@@AB@@AB@@@B@@BBA@CBA@@BA@A@B@@B@@A@A@ABABA@@@A@@BA@@@A@@@A@AB@@A@CBA@@@@B@@CB@@A@A@A@ABA@AB@B@@@@@BB@@@AB@@@B@@@BABABA@@BAB@B@B@@@B@@@B@B@@@@A@CBCDA@@BAB@@@BB@@@@B@@A@A@A@@B@@@B@@@BA@ABA@@@AAA@CBAB@@ABB@@@BBA@@@@BA@@BABA@@@A@A@A@@@A@@@ABAB@BA@@@A@@@C@@BAA@@A@@@A@@BA@AD@@@@ACAA@@A@@A@@AAA@CAA@@A@ABA@@@AA@A@@A@@BA@@@AA@AA@@@@BA@@B@AA@@AA@@@A@@@AA@@@E@A@@A@@BA@@@AA@@@@@A@@A@BA@A@A@A@A@AC@A@A@@@@B@BBBA@@AAAA@@AA@A@ABA@@B@@@B@BBB@@@@A@A@@@A@@B@@@BB@@@@B@@A@@@A@@@A@@BAB@@A@@A@@@@@A@@B@@A@@@A@@@@A@@@@B@@@B@@@@A@@B@@@B@@A@@AA@@A@@@A@A@AAA@@@@@@A@A@ABA@A@AA@@@CA@@@@@AB@@@B@@@@@@C@A@@AAA@@@@A@@@@@AB@@@B@@A@A@ADC@@@@@A@@@AB@BBB@@@B@@A@@AA@AB@BA@@@@B@B@@@BABA@@A@@A@@@@BA@@@BB@@@B@@@BA@@B@@@BA@@AA@@C@A@AA@A@@BCA@@@A@@AA@B@@AA@@A@@@A@AA@@AA@AAA@@AA@EC@@AAA@@AAA@@AAA@AA@@AAA@@A@@@A@@@A@A@@BA@@AABA@AAA@@@@@AA@@@AA@@@@AA@@@@@A@@@AB@@@@A@@@A@@@A@A@A@A@A@A@@AA@AAA@@A@@A@@@AA@@A@@@A@@BA@@@@BA@@BA@@@@BA@@@@@AAA@@@@AA@@A@A@A@@@A@A@AB@@A@AAA@@@C@G@C@@@A@@@A@@@A@@@A@@@A@@@AA@@@@@A@A@@@A@@@@@A@@A@@AA@A@A@@AA@AAA@@@AA@@A@@@@@A@@@A@@A@@A@@@@A@@@@AA@@@A@@@A@@@@AA@@@@@A@@@@@A@@AA@@@@@A@@@@AA@@AA@@AAAAA@AAAAA@@AA@@A@@@@@A@C@@AA@@@A@@@@@A@A@A@@BA@@BA@A@@@AAA@@AAA@AA@AA@@AA@AAA@A@A@@@@AAA@@BA@@BABABA@@BA@@@@BAB@B@BA@@BAB@BAB@DAB@BAD@@A@@@A@@AAA@A@A@CA@@A@AA@A@@@A@A@C@A@A@A@@BA@A@@@A@@@AAA@A@A@A@AA@@AAAAA@AA@@A@@@ABC@@@A@A@A@@BA@A@ABA@A@@@A@AB@@@@@BAB@@@BABABA@@@A@@BA@A@AAA@@@A@@@A@AAA@@@@@AA@@ABA@@@A@AB@@A@@@A@@@AAA@A@@BA@@@CA@@A@@AA@@@A@@BA@@@A@@@C@A@@@A@A@@@AAA@AAA@A@AA@@A@A@@@A@@@@AA@A@A@C@C@A@A@A@ABA@@@C@A@@BA@@@A@@@A@A@A@A@@A@A@M@E@C@E@C@@A@A@C@A@A@I@A@A@C@C@A@AA@@A@AA@@BA@A@A@A@@@A@A@C@@@A@A@@@A@AA@@AA@@A@A@A@A@@BA@AAA@A@@@A@A@A@A@AB@@A@ABA@A@@AA@A@A@A@AB@@A@A@A@@AAA@@AAA@A@AA@@A@A@A@A@@@AAA@A@A@AA@@A@AAA@A@@@@@CA@@AA@@AAA@A@A@A@@@A@A@@@A@@@A@@@AAA@A@@@A@A@A@A@@@AAA@A@A@@AA@A@A@A@CA@@E@A@@@A@A@A@ABE@A@A@E@E@A@C@A@C@ABA@@@A@A@ABA@@@A@@BA@ABA@A@ABA@@@A@ABA@ABA@ABA@ABA@A@@@@@@@AA@@A@@@A@A@@@A@AA@@A@A@A@AA@@A@@AA@ABAA@@@@A@A@@@A@@AA@@@@@AA@@A@AAA@@AA@@@A@@@A@@@A@ABA@@@CBA@@@AB@@A@A@ABA@@@@@@BA@@@@@A@AB@@A@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@A@@@@@@@@@@@@@@@A@@@@B@@@@@@A@@@@@@@A@@@@@A@A@@@A@@@@@@@@@@@@@A@@@@B@@@@AA@@@@@@A@@@@@@BA@@@@@@@A@@@@@@@@@@BA@@@@@@@AB@@@B@BBB@@@B@B@@@B@B@DBB@B@B@B@B@B@D@B@@@B@@@B@@@B@B@B@B@B@B@@B@@BB@@B@@BB@@A@@B@@ABA@@BA@@@@BA@A@@@@BA@ABA@A@AB@@ABA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@AB@@A@@@@BA@@@@@A@A@@@A@ABA@@@C@C@A@A@AB@@A@A@@@A@@@A@A@@BA@@@A@@@A@@@A@@@A@AB@@@@A@@@A@ABA@ABA@A@ABA@AB@@A@@@ABA@A@AB@@A@@@@@@@@BA@A@@@@@@@A@@BA@@@AB@@@@@@@@@B@@@BB@AB@@@@@@@B@@A@@@@B@@@@@B@@@@BB@@@B@@AB@@B@@B@@B@@@BB@@B@B@@B@@@B@@@B@@B@@B@@@B@@@@BB@@BBB@@BB@@@BB@@@@@@@@AB@@@@B@@@@@@@@B@@@BB@@BB@@@@BB@@B@@@B@BA@@BA@@BAB@B@@A@@B@BA@ADA@@@AB@@@BA@@@ABA@A@@@AB@@A@ABA@@BA@AB@@A@@BA@AB@@@BA@@B@B@@@B@@@B@@@B@@A@@@@BA@@@ABA@ABC@ABAB@@A@@BA@@@AB@@A@@@@@@@A@AB@@@@A@@@@B@@@B@@@@ABA@@B@@@@AB@@@@AB@@"],encodeOffsets:[[131482,46390]]}},{type:"Feature",id:"230183",properties:{name:"尚志市",cp:[128.009894,45.209586],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@@@@AB@@@BA@@B@BA@@@@B@@@BB@@B@@@@@@AB@@@@@BA@@B@B@DBB@BBB@B@B@BADAB@B@BABABAB@BAB@@AB@@@@A@@A@@A@@BA@@@A@@@A@@@A@@@@@AA@@A@@@@@@@@@A@@A@@AAA@@AA@@AA@@@AA@@@@AA@@A@AA@@@@A@A@@@A@@@A@@@@@A@@A@@A@@BA@@@A@@@@@AA@@@CAAAA@AAA@@AA@A@A@@@A@@@@@A@@@A@A@ABA@@@@AA@@@A@@@CAA@@@AA@@@@@A@A@@@A@@A@@A@@A@AA@@A@@@A@@@A@AAA@@@AAA@AAAACAAA@@@@AA@@AA@@@A@@AA@@AA@@AAA@AAACAAAA@@AAA@AA@AA@@@AA@@@@AA@@@AAA@AA@AAA@A@@@A@A@@@A@A@A@A@AAA@@@A@@AA@A@A@A@C@@@A@CAA@A@A@@@A@A@@@A@A@ABA@A@@@A@A@C@A@C@A@@@A@AA@@A@CA@@AAA@A@@@AAAAA@A@A@AAA@A@A@A@A@AAA@@@A@A@A@@@A@@@@@A@A@@@ABA@A@@BCBA@A@ABA@@@AB@@A@@BA@@BA@@@A@@@A@A@@AA@@@A@ABA@A@A@@@A@@BA@@B@B@B@@@B@B@@A@@B@@@@@BA@A@A@A@AB@@A@A@@@@@A@AAA@@@AA@@A@A@@@AB@@A@@@@@A@A@AA@@A@@@C@@@A@A@A@@B@@AB@@@BB@A@@B@@AB@@@BA@@@@@A@@BA@@@@@A@@A@@@@AA@@@@A@A@@A@@A@A@@@A@@@@@A@@@@@@@@@A@@@@AA@@@@@AAA@@@A@A@@@A@AA@@@@@BA@@@@BA@A@A@@@AB@@AB@@@B@@AB@@@@@@A@@@A@ABA@@@A@A@A@@@AB@@AB@@A@@@@@@@@@A@@@A@@@@@@@A@@@@@A@@@A@@@ABA@@@AB@@@BAB@@@@ABA@A@A@A@A@@AA@@@A@AAA@A@A@AA@@@@@@@@@@@AA@@@AA@@AA@@@AA@@@A@@@A@@@@@AA@@A@@AA@@@A@A@@@A@@@@@A@@@@@@AA@A@@AA@@@@@A@@@A@A@@@A@A@@@A@@AA@@@@@A@@A@@A@A@A@A@A@A@A@@AA@@@A@@@@AA@A@A@@AA@AAA@AA@@A@A@C@ABC@@@A@AAA@AAA@@B@@@BA@CBCBA@@BA@@@A@@@A@@B@@A@@@AB@@A@@@@BA@AB@@@@@BA@@@@B@@@@@BA@@@ABA@@@AAA@A@A@@AA@A@AB@@A@@BA@@@A@AB@@C@A@@BA@A@AB@@A@@@@@A@@AA@AA@AA@AAAAA@AAA@A@A@C@@@ABA@A@@@A@A@@BA@C@A@C@@@A@A@A@A@A@A@@@A@A@A@ABA@@@@@@BA@@@AB@@A@@@A@@@@@@@@@@A@@@@@AB@@ABA@@@ABABA@A@A@@BAA@@A@@BAAA@AAA@AA@@@A@@@@BA@@A@@@@@@@A@@@A@@@A@@@AB@B@BA@@@@@ABA@@@AA@AAEA@@CAA@@AAAAC@A@C@A@@@A@@AAAEAAAAAACAAA@@A@A@CDCBCBABA@@@AAAACC@AC@AA@AAA@@@A@A@@BA@A@@@@AA@A@@@@@A@@@A@@AA@@@A@AB@@A@AB@@AB@BAB@@A@@@@@A@A@A@AAA@@@A@A@A@@@A@@A@AAAAA@AAAAA@ACA@CAA@A@A@AAAA@AA@@ABA@ABAD@@ABAB@@A@@@A@@AA@@C@A@@@AAC@EACAA@AA@AA@@AA@AAAACAA@A@@A@@@@@AAA@@CACAAAAA@@AA@AA@ECKCCAA@AA@CACCA@A@@AA@@@@@AA@AC@@AAA@@@A@A@A@@@AA@AA@@A@@@@@AA@@@@AA@A@A@A@@@A@@@@AAA@@CAA@AAA@@@@AD@DA@A@CBAAA@A@@A@C@A@A@A@A@A@AACA@@A@C@CAAAC@EAAA@@A@AAAA@@@D@B@BAB@B@@@@A@ABA@CBA@C@@@A@@B@@@@@BABB@@B@B@@A@@@A@ABC@@BA@AB@@@@AB@@@@@BA@A@@BA@ABAB@DAB@@@@@@AAAAAAA@A@A@ABA@A@A@A@@@@A@@@@@A@@@@BC@@@AA@AACAA@@@@A@@@@@@B@@A@@BA@@@@BA@@B@@A@@B@@@@A@@@@@@@AB@@@@@@@BAB@BABA@A@A@@@@@@A@@@@@@AA@@@@@@@A@@AA@@@@@AA@@@@@@@A@@@@AA@@@@@@AA@@@AA@@AA@AAACA@AC@@@@@@AA@@AA@@@AA@@@AAAAA@@@CAAAA@@AA@@AA@@AA@AA@@@AA@A@@A@@ECCA@@AAC@CAAAA@@@A@@@A@AA@@C@A@A@AACAAAAAA@A@C@A@A@A@A@@@A@CBA@CB@@A@AB@@A@A@@BA@AB@@AB@@@@A@@@AB@@A@@@@@A@@@A@@@AB@@A@@@@B@@@@ABA@@BA@@B@B@@@@@BA@@@@@@@A@@@@AA@@@A@@@A@@@AB@@@@A@A@A@@@CBA@@@A@@@@@A@@@AAA@@@@@A@@@A@A@@@@@A@@@A@@@AA@@@@@BA@@@ABA@@@@@A@A@A@@@@@ABA@@@@@@@A@@@A@@@@@AA@BA@A@@@A@@@A@A@@@A@@AA@AAA@@@A@A@@@A@@AA@@@A@@@A@@@@A@@@A@@@@@@@@@AA@@@@@A@@@@@@A@@@@@AA@@@@@@A@@@@@AB@@@@@AA@@@AA@AA@AC@AAA@AAAAC@AAAA@@@@A@A@C@E@CAC@A@A@E@C@C@A@C@C@A@@@A@@@AAA@AAA@@@E@C@C@@@C@@@ABC@CBCBADA@ABC@@BABA@A@@@A@A@C@C@@@@@@AA@@@@@@AA@AACA@AA@A@C@A@A@ABA@A@A@@@A@@AA@@@@@@AAA@AA@@@A@C@A@A@@@AAA@A@A@A@@B@@AB@@@@A@@@A@A@@@AB@@A@ABABCB@@@@AB@@A@AB@@@@@D@B@B@B@@@BA@@@@B@@@BB@@@@B@@@@@@@@@BA@AB@@@@AB@B@@@B@@@B@@@BA@@@AB@@AB@@@@@B@B@B@@@BB@@@@B@@BB@B@@@B@@@@BB@B@@BB@@@B@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@B@@@@BB@@@@@@@BB@BB@@@@BB@@@@@@B@@@B@@B@@@BBB@B@B@@@BB@@@@@@@@B@@@@@@@B@@@B@@B@@@@B@@B@B@@@@@@@B@@BB@@B@@@@B@@@@BB@@@B@@@B@@@@B@@@BB@@@@@@@B@@@@A@@@ABA@@B@@@@AB@@@BB@@@@B@@@@@@@B@@@@@@@B@@@B@@@B@@@B@BAB@@ABA@A@A@@@@@@@A@@@AB@@@B@@@@@B@@BB@@@B@@@@@@@@@@@@B@@@@@BBB@@@B@@B@@B@@B@@@B@@B@@@@@B@@@B@B@@@B@BBBBBBBB@B@@@B@@@@@@@BAB@@AB@@@B@@@B@B@@BB@B@BBBB@@B@@@B@BBD@B@B@@@B@B@@@B@@@@@B@@@@@@@BA@@@@B@@@@@@@@BBA@@@@B@@@@BB@@@@@@@@@B@@A@@@@BA@@@A@@@ABA@A@AB@@A@@B@@A@@BA@@@@B@@@BA@@B@@@B@@@B@@BB@B@@@@@B@B@B@@@@BB@@@BB@@B@@B@@B@@@B@@AB@B@@AB@@@B@@@@BB@B@@@@@@@BB@@BB@@B@@BB@@B@@@@B@@BB@BBB@@@B@@@B@@@@@B@@@@AB@@@B@@BB@@@B@@@@BB@@@B@@@@@B@@B@@@BBD@@@BBB@B@BB@@B@@BB@@@B@@@B@@@B@@B@@@@BB@@BBDBB@DBDBB@@@BB@@B@@@@B@@B@@BB@@BB@BB@BBB@BB@@B@@@@@B@@@D@@@@@@@B@@@B@@@@@@@BAD@@@BA@@B@B@@@@AB@B@BBB@@@DBBBBBBBBBBBBDBBB@BB@@@@B@@@B@@BBBBDBB@DB@BB@@B@BBB@B@B@D@@@B@@@B@BB@@B@B@@@B@BA@@@@B@@@B@@@@@B@@@@A@@B@B@@@@AB@B@@@@AB@@AB@@CD@@@@@@A@@@CAA@A@A@@@A@A@@A@@A@@@@@A@A@@@A@A@@@AAA@@@AA@@A@A@@@AB@@C@ABA@@BA@ABA@@@A@C@A@A@ABAA@@AB@@A@A@A@A@@@A@A@@@A@A@C@@@A@A@AAA@A@@@A@A@C@A@@@C@@@A@@@A@@@A@@@@@@@@BA@@B@@@@AB@AA@C@C@CAA@A@@A@A@@@AA@@A@@A@@@A@@@@@@@A@A@ABA@AAA@AAA@@@A@@@A@A@@@AB@@A@@@A@@B@@@B@@@B@@BB@B@@A@@BAB@@@BB@@B@@A@@BC@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@B@@CBABABA@A@@@@@ABABA@@@@BA@@@B@@BB@@@BB@@@B@B@@@BB@@@B@@BB@@B@B@B@B@@AB@@@BA@@D@@@@@B@@@B@BBBB@@B@B@B@@BB@B@@BB@B@@BBB@@BB@@@BBBBB@@B@BB@@B@B@B@B@@@@@B@@@@@@A@@@@BA@A@@@C@CBA@A@@@CAA@AAC@E@ABA@A@ABA@ABA@@BA@ADABAB@@A@AB@BA@@BA@@BA@CB@BA@@@A@@@ABA@@BABAB@@@@B@@BA@@@AB@@ABA@A@ABA@@B@@@@@BBBB@@@@@@@BB@@@@@@@BA@@D@@BB@@@B@@BB@BDB@@@B@B@@@@@BA@@B@BA@@@@BA@@B@@A@AB@@@@@B@@AB@B@@@@@@@@ABA@@@@B@@AB@@@@@BA@@@AB@@@@@B@@B@@@@@@BB@A@@BA@@BA@@B@@@@BB@@@@@@B@@@@B@@@@@B@@@@B@@B@@A@@B@@@B@@B@@@AB@@A@@@@@@B@@@BA@@BA@@B@@@@B@@B@@@B@@@@@@@@A@A@A@@@A@@@@@@B@@A@@@A@@@AA@BA@@@A@@@@@A@@B@@@@A@A@@@A@@AA@A@A@@@@@A@@@@@A@@A@@@@@@@@A@@@A@@@CB@@A@A@AA@@A@AAA@A@A@A@ABA@@@A@@@@@CA@@A@@@@BA@@@ABA@C@A@AAA@@@@@AAA@@B@@A@@@B@@B@@@@A@@@@BA@@@@@A@@BA@@@A@@@@@@BA@B@@@@B@@BB@@@@@BBBBB@BBB@BB@B@@@DB@@B@BBB@D@BB@@B@B@@@@@DBBBB@@@@BB@@@@B@@@@BB@@@@@@@B@@@@@@BB@@@@BB@@@@@@@B@@@@@BB@AB@@@B@@@@AB@@@@@B@@@BB@@B@@@B@@@B@@@B@@A@@@@BA@AB@@C@@B@@A@@@@@ABA@@@A@@@@@@B@@@@@B@@B@@B@@BBB@B@BB@@BB@@@@@BA@@B@@@B@B@@@B@B@BA@@@BB@@@B@@BB@B@@@B@BA@@B@BAB@@@@@B@@@B@@@@@B@@@@AB@B@@@@@B@@@BB@B@@@@B@@@BB@@@@@AB@@@B@@@@@@@B@@@@@B@B@@@@AB@B@BA@@BA@@B@@ABABA@@B@@ABA@@@@@A@@B@@AA@@A@A@A@AAE@A@A@A@A@ABA@ABC@AB@@@@@BA@AB@BA@CBCBA@@@AB@@@B@@@B@@BB@@B@@BB@@B@@@B@@@@@@@BA@@@A@@B@@@@AB@B@@@BAB@@@@@@@BA@@@AB@@AB@@AB@@CBAB@@A@AB@@AB@@@@A@A@@@A@A@@@A@@@A@@BA@@@@@AB@@@@@A@@A@@@@@AA@@AB@@@@@AA@@@@@@@A@@@AB@@@@@@@@A@@B@@A@@@ABA@@BA@@@ABA@AB@@A@@BA@ABA@AB@@@@@@ABA@@BA@@@@@@@A@@@A@A@@@AD@@A@CDA@A@@@C@@@A@@BAB@@AB@BA@@@ABA@@AAACA@@A@AACA@@@@@@AAA@A@A@A@A@@@ABA@@@A@AB@@A@@@AB@@A@A@@@@@A@A@@@A@@@A@A@CACAA@A@AA@@C@A@@AA@A@A@@@A@A@A@A@ABA@@@A@@@@@@AAAAAAAA@CAA@CCAACAA@@@@BA@@BCDAB@@ADA@ABABCB@B@@C@@@@@@B@@BB@D@@BB@@@@FBBBDD@@B@DB@B@@@B@BBDB@@BB@DD@@@B@@@@AB@DA@CB@@ABABEBC@ABC@A@@@@B@BA@A@AB@@BD@B@BAB@@@@@B@@@B@B@@@BA@@B@@AB@@@@@BA@@B@@A@@@@B@B@@@B@B@@@B@@@BB@@BB@BBB@ABA@@BA@@BA@@BB@@B@BB@@B@@@B@@ABA@AB@@@B@@BB@B@@@@@BA@A@@B@@@@AB@@A@@@B@@@@@@B@@A@@@@@A@@@@AA@@B@@@BA@@@@@@B@@@@@@A@@@A@A@@@@@A@@@A@@@@@@@@AA@@@@B@@@@A@@@@B@@A@@@A@@@A@@@@@@@@@@A@@@A@@@AA@@@AB@A@@A@@@@@@@@@A@@@A@@@@@@@@@@B@@@@@@A@@B@@@@A@@@A@@@@@@@@@AA@AA@@@@@@@A@@@@@A@@@A@@@A@@@@@@B@@@B@@@@A@@@@@A@@@@@@A@@@@@@@@A@@@@BB@@@@B@@A@@@AA@@@AA@@@@@@B@@@@@@@B@@@@@@C@@@@@A@@@@B@@@@AA@@AA@@@@@@@@@AA@@@AB@@@AA@@@@BA@@@@@A@@@@@@@@BA@@@A@@B@@A@@@@@A@A@@@@@@BA@@@@@@@@B@@A@@@B@@B@@@@@@A@@@A@@@@@@B@@A@@A@@@@A@@@@B@@@@@B@@@@@BA@A@@@@@@@A@@@@@@@@B@@@@A@@@@@@@@BA@@@A@@@A@@BA@@@@A@@A@@@@@@@A@@@@@@@A@@B@@@@A@@@A@@@@B@@A@@@A@@@@@@AA@@@BA@AA@@@A@@@@AB@@AA@@@A@@BA@@@@@@@@@@A@@@A@@A@@BA@@@A@@@A@@@@@@@A@@@@AA@@@A@@BA@@BA@@@@@@@@@@@AB@@@B@B@@@B@B@@AB@@@@@B@@@B@@@B@@@@@B@@@BB@@B@@@@@BBB@@@@A@@BA@@@@@@@@B@@@BB@@B@@@B@@AB@B@@@@A@@BA@ABA@AB@BA@A@C@@@A@@ACA@A@@A@@@A@@@@@A@A@A@@AA@A@@@AA@@A@AAA@@A@AA@@AAAA@A@@AAB@@@@ABA@@BA@@@AAA@A@@A@@@@A@@B@BA@@B@@ABA@@@C@A@@@A@@B@@A@@@A@AB@@@@@@@@@B@@@B@@@B@@A@ABADA@A@A@@@ABA@@@@BA@@@A@A@@@A@A@A@A@@BA@@@@@@BA@@@@@@B@@@@ABA@A@C@C@A@ABA@A@AB@@@@@@@@BB@@@@@B@@@BA@ABA@AB@B@@@@@B@@@D@@@B@BA@@@@@@B@@@B@@@B@@@B@@@@@@@B@@@BA@A@ABA@A@@@@@ABA@@@AB@@A@@B@@A@@@ABAAA@A@A@@@ABA@A@@BA@A@A@A@A@@@ABA@@@ABA@A@A@A@A@@@C@A@A@@B@@@@AB@B@@A@@@A@A@C@@BA@@@@@CAAB@@@@AB@@AB@@@@A@@@@BA@@B@@@BA@@@ABA@@@A@@@@BA@@@ABABABA@AB@@AB@@A@@@ABA@ABA@ABADAD@D@BAB@@@BA@ABC@A@@@A@CB@@A@C@@@A@A@@B@@@BA@@@@@@@A@A@CAEACA@@@@CBA@A@@@@@@@@B@D@@AB@@A@A@@@AB@@C@A@@@@@@BA@C@@@A@@@ABABABCD@@A@@@BDA@GDABCBADA@ABADABEDEFCFCDEDEDCDCB@@ABA@C@CBC@A@@@CDA@ABCBA@A@A@A@@BC@@@@B@@B@@B@BB@@BBBBBHHDD@@@B@@@B@BB@@B@@@@AB@@@B@@@BAB@BA@@@@B@@@B@@@B@B@@@B@@@B@B@B@@@@@@@B@@@@@B@@@B@@@@BB@@BB@@@@@@@@A@@@A@C@ABA@A@ABA@C@A@A@AB@@@@@@A@AA@@A@@@AA@@AAA@@@A@A@@@@BCBEHEDADAB@BADCD@DAD@@AB@@@B@@@@B@@@@BB@@@@@@BA@@@@@@B@@@@BB@@B@@@BB@@@@@B@@@@@B@@@BAB@@ABB@B@FADABADAD@@ABBF@D@D@D@DBB@D@B@D@D@B@D@B@DBB@@@D@BBBB@@BB@DB@@B@DBB@B@B@@@BAB@B@BADAD@DA@@B@BAD@B@B@B@@@@@B@BB@@B@BB@@B@D@@A@KFQF@B@@B@@@BBBBBBB@DB@@DB@@B@@BB@BBB@BB@BB@BB@BB@BBBBB@@BBBB@BB@B@@@@@B@@@@A@@BA@A@A@@@A@AB@@A@A@AB@@@@AA@@@@@@A@@@A@@@A@CB@@@BA@AB@B@@@B@@B@@@DBDBB@@@@B@@B@@@B@BB@@@BBB@@@B@BB@B@BBB@@@B@D@BA@A@@B@B@B@B@B@@@BABB@@BABAB@@AB@@@BA@@DABAB@B@B@B@BA@AB@@@@AB@B@@AD@B@@@B@B@B@@AB@B@BABA@ADA@@@@B@B@B@@@B@BA@@BA@@@ADA@@BA@BB@B@BA@@@@B@B@@AB@D@BB@@B@D@B@@ABBBAB@B@B@@ABAB@B@B@B@BA@BBAB@@@B@B@@AB@B@B@H@@@@@B@@@@@B@@AB@B@@@@@B@@@BBB@@@A@@@@@@B@@@@@B@@@F@B@B@B@@@@@@@@AAA@@@AAAAA@@@@@@@@@@B@@@B@@AB@BA@@BADAFADADABADABCD@B@@@@BBAB@B@F@H@D@B@BB@BDDB@D@@@D@DB@@B@B@B@@@@@B@@@B@B@B@@@@B@@@@B@@@@@@@@@@@B@@BB@@AB@@A@ABA@A@ABABCBABABA@ABA@@B@@ABA@A@AB@@A@@@A@AB@@@@@@@B@@BB@@@B@@@@@@@B@@ABA@@B@@@@@B@@@@@B@@@@@@@@AB@@@B@@@B@@@@@B@@BB@@@@@@A@@@AB@@@@@B@@@@@B@@@@A@@B@@AB@@@@@@@@@B@@BBB@@B@@B@@B@@@@@B@@@@@BB@@BB@@BB@BB@BB@@BB@@@B@@B@@@@@@@@@BB@@@B@@BB@B@@BB@@@B@@B@@B@BB@BB@@B@@@@BB@@@@BB@@@BB@@B@@BB@@@B@@@BB@@@BB@@@@@B@@BB@@@BB@@@@B@@@@@B@@BB@@@@@BB@@@@@@B@@@@B@@@@@B@B@@@B@B@@@@@B@@@@B@@BBBB@@@B@@@@@B@@BB@@B@@B@@@@@B@@AB@@B@@B@@@B@D@@B@BB@@B@@B@@B@@A@@B@@@@@DAD@DAD@BA@@@AA@@@@C@AA@@@@A@AB@@A@@@A@@@@B@B@@@B@B@B@DAB@B@BA@@F@D@B@D@@AB@BA@@B@BAF@B@B@B@B@@AB@BABABA@@B@BB@@B@@@B@@BB@@@B@BB@@BB@@@@BBB@@@B@@BB@D@B@DAB@@AB@@@DAD@B@BAB@B@@AB@@@@A@@B@@@B@@@B@B@BBB@D@B@B@@@B@@@@@@@@@@B@@@B@@@B@@@BB@@BB@@BB@@BB@@@BB@@B@@BABAB@BAB@BBB@@ABBD@@@B@@BB@@BBB@@@B@@BB@BB@@B@DBB@DB@@B@B@DABAB@B@B@B@BB@@@@@@@B@@@B@BB@@@@BBBB@@BB@@B@BAB@@@BA@@B@@@@AB@BA@@BA@A@@@@BA@@B@@AB@@A@@@@BB@@B@@BBBB@B@@@@BB@@@B@BB@@@BBB@@@DD@@BB@@@BB@@@@@B@@B@@@BDD@@@@BB@@@@@@@@@BB@@@@B@@@B@@ADA@@BA@@@A@@B@@BD@@@@BB@@@@@@BB@@@D@@@@@@@@@B@@@@@@@B@@B@B@@BB@@@B@B@B@DA@@@@B@B@DB@@BBBBB@@B@@B@@B@@@B@@@@AB@@@@@B@@@@@BB@@@@@B@@@B@@@@@BB@@@@@BB@@@@B@@@B@@@@@@@B@@BB@@@B@@@B@@@BB@@@@BB@@B@@@@@@@B@@A@@B@@@@@@@@@B@@@@A@@B@@@@AB@@@@A@ABA@@@A@@@@BA@@@@@@BA@@B@@@@@B@@BBBD@@@BA@@B@@ABCB@@CBA@@BAB@@B@BB@@@@@B@@CDABABAD@@AB@@AB@B@FAB@D@BBBDDFBBBBBBDBB@@BBB@@@@B@B@BBB@FJFB@B@B@F@@@B@DBHDB@B@@@BADEBADADCBAB@@A@@DBB@B@B@B@@@@@DD@BB@BBB@BBB@DDB@@B@@ABAB@B@@@B@BBBBBB@BB@@@BCBCBEDCB@@@@HDHFB@@@B@BAHCD@@@D@B@D@@@B@B@B@D@@@B@@@BA@@B@BA@@@A@A@@BADA@AB@B@B@@@DAFC@@B@B@@AB@@@HCDCDAB@BBBB@BBBBDDB@BDF@@@B@@B@@BB@@@BBB@B@@BB@@BB@@BBB@F@@@B@B@@CB@B@@@@@B@@B@B@@@@B@@@BB@@@@B@@A@ABA@@B@@@BBB@BBB@@@D@BA@@B@@B@@B@@AB@@@BB@@@@BBBBB@@BB@@B@@@BBB@B@B@@AD@DA@@B@B@@@B@@@B@BAB@@@B@@A@@@@B@@@@@BBBBB@@BB@BA@@DABA@@@@B@@A@@B@@@DB@@B@@@@@B@DAB@B@B@BAB@@@D@B@B@DAFAD@BABABABAB@@@D@BAD@B@BA@@@@DA@@@@@@@@B@@@@@@@@@B@BBB@B@DA@@B@BAB@B@@ADCBA@ADEDC@A@ABA@AB@@@@A@@@AB@B@@@@A@@B@@@B@@@B@D@D@D@D@D@B@BBB@D@B@@@B@B@@@@@B@D@BAB@B@@@@@B@B@F@B@B@BAB@D@D@BABAF@BAD@D@BBB@BAB@FAHAFAB@DAD@DAD@@@B@@@@AA@@A@@BA@@BADA@ABA@ABEBADA@ABA@A@A@A@@@A@@@A@CAA@@@A@AA@@C@A@@AC@A@@@A@A@A@@AC@@B@@AB@BAFADABAD@BADABADABA@@@ABA@A@A@@@@@A@@@@@A@@A@@A@@@@@AAA@@AAA@@AAA@@@AA@@A@@@A@AB@@@@A@@A@@A@@@@BA@@BA@@@@@@@A@@@@BA@@@AAA@@@A@@@AB@@AB@@AB@BAB@@@BA@@BAB@B@BA@@BA@@AAA@@AA@@A@AA@@A@@@A@A@AAA@@@ABA@@@A@A@@@ABA@@@ABA@@@AB@B@B@@@B@B@B@BB@@B@@A@@B@@A@@B@BA@AB@B@@AB@@A@@@AB@B@@AB@BABA@@@@BA@@B@@AB@@@@@@@B@B@B@@@B@@@B@@@B@@@@@BB@@@@@@@BB@@BB@@@BB@@B@B@@@B@@@B@B@B@@BB@B@@@B@BBD@BBD@B@B@B@BBB@B@B@B@@@BA@@B@@AB@BAB@@AB@@AB@B@@AB@@@B@B@B@@@B@@@@@B@@@B@@AB@@@@@@@@AB@@A@@B@@@B@@@B@@A@@B@@AB@@@B@BAB@B@@@@AB@@@@A@@@A@@@@AA@@@A@@@@@A@@@@@AA@@@AAA@@AA@A@@@@@BA@@@@@A@@@A@@@AA@@A@@BA@@B@@@BA@@@@AA@A@@@A@@A@@AA@@AA@@@@A@@@A@A@@@A@@@A@A@@@ABA@@B@@@B@BBB@@@D@B@B@B@B@@@B@@@BA@BB@B@B@@BB@B@D@B@B@B@B@B@@BB@B@B@B@@@B@B@@@B@@@@A@@@@BA@@@A@@@@@@BAB@B@@BB@@@B@@AB@B@@@B@@@@@@@@A@@@A@@@A@@@A@@@@BA@@@AB@@@B@@@@B@@B@@@@@@@@A@A@@@A@@@A@@@A@@@@@A@AA@@@A@@A@@A@@AAA@@AA@@AA@@AA@@AA@@@@A@A@@@A@A@AAC@EA@@AAA@@@@@@@ABA@A@A@@BABA@A@@@@@A@@BA@@@@@@@A@@@CBA@@@@@@@AAAAA@@@@@A@@@C@AB@@AA@@A@CAAACAA@A@@@@AA@A@A@AB@@@@A@@@@@A@@@AA@@@@@AA@@@@@A@@A@@@A@@@@BA@@@AB@@A@@@@@AB@@AB@@@@@B@@@@@@@@@BAB@@@@@BA@@@@@@B@@@@@B@@@B@@@@@@@@@@A@@@@@@@@B@@@B@@@@@BA@@@@B@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@AA@@@A@@@A@@@ADA@@B@@@B@B@@@BA@@B@@@DA@C@C@@AC@@BA@A@@@@@@B@@@@@@@B@@@AAB@@C@@B@BA@@@A@A@@@A@A@@@@@@@@BAB@@AD@@@D@BADAB@@@B@B@@@B@@@B@BAD@B@BA@@B@@@@@@@@@A@@A@@@@@@@@@@B@@B@@D@@@@BD@BBB@@@B@@@@@@@@@BAB@B@@@@@@@@AAA@A@@@AA@@A@@@@@A@@B@@AB@@@B@@A@@@A@@@@@A@@@A@@BA@@B@@@B@B@@@B@B@B@@AB@@@@@@A@@@@AA@@@@@AA@@@@@@A@@@A@@@@@@BA@@B@@@@@@@B@B@B@BAA@@A@@BA@@AA@AA@@A@@BA@@BAB@AA@AB@B@B@@@BA@@B@BAB@BA@@BA@@@A@@@AA@AA@A@@@AB@BA@A@@@AB@A@BC@@B@B@BBB@B@@BB@@B@@@B@@@BB@B@@@B@BBBCDAFA@AD@@@@@B@B@@@B@B@@@B@B@@AB@@@B@@@@@BAB@@@B@B@@@B@BA@@@@B@@AB@@AB@@@@@BAB@@@B@B@@@B@BB@@B@B@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@AB@@@@@@@BA@@@@@AB@@@@A@@A@@@@AB@@@A@B@@@@@@@B@@@@@@@@AB@@ABAB@@AB@BA@@BA@@B@@@@@@@@A@@@@@A@@AA@A@@@A@@@A@@@@@A@A@AA@@A@A@A@@@A@@AA@@@@@A@A@A@@@@@A@@B@@@BAB@BA@@B@@@BA@@@@BAB@@@B@@A@@B@B@B@B@@@B@B@B@@@B@B@B@@@B@B@B@@BB@B@@B@@BAB@BAB@DCBADADA@@@@DA@A@@BAD@BAB@B@@@B@@@B@@@@BB@@@AB@@@@@B@@@@@B@B@B@@@B@@@B@B@@B@@B@@@@@B@@BB@@@B@@@B@@@BAB@@@B@B@B@@@@@B@@@@AB@@@B@@A@@B@@B@@B@@@BB@@BBB@@BB@@BB@@B@@BBB@BBBBBBBBB@@@@B@@@BBB@B@B@B@B@@@B@@B@@B@@B@@@@B@@BB@BB@@BBB@@@BBBB@@@@BB@@B@D@D@B@B@B@B@B@@@B@B@B@BB@@B@B@@@B@B@BAD@B@@@@@D@B@B@@@B@B@@@BB@@B@B@@BB@B@@B@@B@@@@BB@@@BB@@B@B@@@DCBABADABAB@BA@@D@BAB@BAB@B@@@B@BA@@B@@@@@BBB@B@B@@@B@B@@AB@@@@@B@@@@@@@B@@@@AB@B@BAB@@@BAB@@@D@@@B@D@@@D@B@B@B@B@B@@AB@B@B@@@@AB@B@B@@@B@B@B@B@@AB@BAB@B@B@@@B@B@B@B@B@@AB@B@B@@BB@BBD@BBD@BBBBD@DBDBB@BBB@B@B@B@B@B@B@B@@@B@@AB@B@@@B@@@B@@@BA@@@@@@B@@@@@B@B@@AB@B@@@@@B@@@B@B@@@B@B@DBD@B@B@B@B@B@@BB@@@B@B@B@@@B@@@@@B@@@@@BB@@B@@BB@B@@B@@@@@B@@B@@@B@@@B@B@@@B@@@B@@@B@BB@@@@B@B@@@B@B@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@B@@A@@B@@@@@@AB@@@B@@@@AB@B@BA@@@@B@@AB@B@@@@@B@@@B@@B@@BB@@BB@@B@B@B@B@B@@@B@@@BA@@@@BA@@@@@AB@BA@@@@@A@@@A@@B@@@@@BAB@@@@@@@B@@@BA@@B@@AB@@@BABAD@BAB@BA@@B@@A@@B@@@@A@@@A@@@A@@@A@AB@@A@@BAB@@AB@@@BAB@@AB@BAB@@@BA@@B@B@BA@@B@@A@@BA@@B@BCB@@A@AB@@@@ABA@AB@@AB@@A@A@@@AA@@A@@A@@AA@@A@@@A@@@@@@A@@@@@BA@@@@@@@@AA@@A@@AA@AA@@AA@@@@@A@@@AA@@@@A@@@A@@@AA@A@@@AA@@A@@@@AA@@@BA@@@A@@AA@@@@@A@@@@@A@@B@@@@A@@@@@@BAA@@A@@@A@@@@@@@@BA@@B@@AB@@@@@@@B@B@@A@@@@@@B@@@BAB@B@BA@@B@@@BAB@BAB@B@BAB@BAB@@@B@@@@@BAB@@@B@@@B@@@B@@@B@@AB@B@@@B@@@B@B@@@BAB@B@D@D@@@B@BAB@@@B@B@@@@@B@@A@@B@@@BA@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@BA@@BAB@B@BAB@@A@@B@B@@A@@B@@AB@BA@@@AB@@@AA@@@AA@@AA@@@@A@A@A@A@A@A@@@A@@@A@@@A@C@A@A@A@A@AAA@C@A@A@@@A@A@@AA@A@A@@BA@@@@@@B@@A@@@@@@@@B@@@@@@@B@@A@@@@B@@@@@@@BB@@@@@A@@B@@@@@@@@@@@@@B@@@B@B@@@@@B@@@@@@@B@@@@@@@@A@@B@@@@@@@@@@@@@@@B@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@B@@@BAB@@@@@B@@A@@@@B@BAB@B@@@BA@@B@DA@@B@BAB@@@B@@@B@@@B@@BB@BBB@@@BB@@@@B@@BB@@@B@B@@@@@BBBAB@@BB@@@BBB@B@B@@@BBB@@@B@B@@@B@@@BB@@@@@@B@B@BAB@BAB@BAB@BAB@@@B@BAB@B@BAB@@AB@@@B@BAB@B@@@B@BAD@B@D@B@F@F@B@B@F@BAB@B@B@@@B@F@@@DBB@B@B@B@@BB@B@B@BB@@B@B@B@B@@@B@B@BB@@B@@@B@@@B@B@@@B@B@B@B@BB@@BB@@DB@@@@B@B@BBB@@@BBB@B@B@BB@@B@B@B@B@@@BBB@B@BB@@BB@BB@B@B@@@BAB@B@B@B@@BB@B@BAB@@@BAB@B@B@B@@@B@B@BBB@@AB@B@B@B@@@BB@@BBB@@@B@B@@@D@B@B@@@B@B@B@B@@AB@@B@BB@@B@B@D@D@B@B@J@B@B@D@B@BD@F@D@F@N@B@B@@@@B@B@B@B@@@B@@AB@@@B@D@@AB@B@B@B@B@D@D@B@BBB@@@@@B@@@B@BB@@B@BBB@BBB@B@@@B@B@@@B@D@@@B@@AB@@@B@@BB@@@BB@@D@@AB@@@BBB@B@@@B@@@BA@@B@B@@AB@BB@@B@@@@BB@B@B@@@B@@BB@B@BAB@@@B@@ABABAB@@A@AB@@@@A@@B@B@@@BAB@B@BAB@@@B@B@B@@AD@B@@@BB@@BBBBB@BB@@B@B@B@BBB@B@@@B@@@BAB@@@B@B@B@D@B@B@@@BBBB@@@DBB@B@B@BB@@@B@@@BC@ABA@CBA@ABA@AB@@ABA@A@AB@@@@AB@@ABABAB@@AB@@BB@B@@B@B@B@BBB@@BB@@BBBB@BB@@BB@B@@@BAB@@AB@@@B@B@B@@@@@B@@BB@@@D@B@@@@@BB@@BB@BBBBB@BBBB@@BB@@BB@@@@@B@@@@BB@@@B@@@@@B@@@@BB@@@@@B@@@B@@BB@@@@@B@@B@@@@BB@@@B@@@@@B@@@BB@@B@BBB@@BB@B@B@@BB@@@@B@@@@@B@@@B@B@@@@BB@@B@@@B@@@B@@@B@@@B@@@D@H@D@@@B@BBB@@@BAB@B@@@B@B@B@@@BB@@@@BB@B@@@@AB@@@@AB@@AB@@@@AB@@@B@@@BB@@B@@@BB@@@BB@BBB@@@B@B@B@B@B@B@@@B@@@B@@A@@B@@@B@@@@BB@@@@BB@@B@@B@@@@BB@BABBB@@AB@@@B@B@@@B@@@BB@BB@@BBB@BB@@BB@BB@BB@@FDB@@BB@BBB@@BB@@B@B@@@BB@@BA@B@@BB@@@B@AD@@@BBBB@D@@@BB@@AB@@A@@@AB@@A@@@A@@A@@AB@@@@@BB@@@ABAB@@A@A@@@@@ABA@@BBB@@@BA@@@A@AAA@@B@@@B@@@@CD@B@B@BA@@@A@@B@@@@@B@@B@BB@@@B@D@@@@A@@@A@@B@@@@DB@@B@@B@BAB@B@B@B@@@@B@BBB@B@@@B@@@BB@@@BA@@@A@@@@B@@A@@@A@@@@@@B@@B@@@B@@@@AB@@@@@B@@@@BA@AB@@@B@@@B@@@BA@@@@@AA@@A@@@@B@@@B@B@@A@AAA@@@A@@@AB@B@BBB@@BBBB@@ABAAA@@@@@@B@BBDB@B@B@B@B@@A@BB@@@@@B@@B@@AB@@@BB@F@@@B@@B@@@B@@BB@@BBA@@@AB@@@@BBB@@B@@AB@@@BB@B@@B@@AB@B@BB@DBB@BB@@@BB@@@BBBD@@@@BCB@@AB@@@B@@@BB@AD@@@B@@@B@@ABABA@@B@@@B@B@B@@@B@BA@AB@@A@@B@AA@@A@BA@@BADAB@BB@@B@BAB@@A@@@A@@@AB@B@B@@@@A@@A@@A@@BA@AB@DCDAB@@@@@@A@A@@@A@@@A@ABA@AB@BABA@A@@@A@@BA@@A@@A@@@@@ABAB@BAB@B@B@@@DA@@@A@@B@DAB@@@BAB@@@B@@@B@@AB@@@B@BABAB@B@@@@AA@B@B@@AB@DAB@AA@@@A@@BA@@BA@@B@B@B@BAB@@@B@BA@@@@D@BABABBB@B@B@B@@@@@D@BBB@@@BA@@B@@@BA@@B@B@@@B@@@B@@@B@D@B@D@B@@@B@D@H@@@D@@@B@B@@@B@@@@@B@DBB@B@D@D@B@DBD@B@B@B@@@B@B@@@@@BB@ABBB@B@B@@@@@BB@@@B@@@@@@DB@@B@@@@BBB@@DBBBBB@B@@BB@@@@@@@@B@@B@@@@B@@B@@@@BB@@B@@@B@BBB@BB@@@@BB@@B@@@B@@@B@B@BB@@B@@B@@@@B@@@@@@@B@@@B@@AB@B@@@B@@@@@@@B@@@B@@@B@B@@@BA@@BA@@D@@@@@B@@@BBB@B@B@BB@@@@BBB@B@@@BBB@BBDB@@BBB@BBB@B@BB@@@@B@B@@B@@B@@@@@BB@@@B@@B@@@BBB@BB@@B@BBBBBBBBBBB@BBB@B@@@B@@@BB@@BBB@@@BB@B@@BB@@@@@B@@@B@@@B@@@B@B@@@@@B@@@@@B@@A@@B@@@@AB@@@@@@@B@@@@@@@@BBB@BB@@@BB@BB@@BBBBB@@@B@@@@B@@BB@@@B@@@@BB@@BB@BB@@@@@@B@@@@@@@B@@@B@@@@@@@B@@BB@@AB@@@B@@@B@@@@B@@BB@BBBB@DBB@@BB@@@BB@@BBB@B@@@B@BBB@@@BICQ@S@S@WD]DWJYFONDJHDPFPDXARCRE^ETC`GTEBE@BB@@@@@@@BA@@B@@@@BB@@@@@B@@@BB@@@@B@@@@BB@@@@@B@BB@@@B@@B@@@BB@@@@@B@@B@@@@BB@@@B@@B@@B@@@B@@@@@@BB@@@@@@B@@B@@@@@@@@BB@@@B@@@BB@@@@B@@@@@@@BB@@@@B@@@@BB@@@B@@@BB@@@@BB@@@@BB@@@@B@@B@@@@@BB@@@@@@B@@B@BB@@@BB@B@@@B@@@B@@@@@B@@@@@BB@@@@B@@B@@@@B@@@@@B@@@BB@@B@@@B@B@B@B@B@@@B@@@BBB@@@@@B@@@B@BAD@@@@@B@@AB@@@@@B@@A@@B@B@@AB@@@B@BA@@B@B@@@B@@@B@B@@@B@@@@@B@@@@@B@@A@@B@@@B@@@B@@@B@@AB@@@B@@A@B@@B@B@@BB@B@@BD@@@BB@@@@@@@@B@@B@@@@BB@@@B@@@@B@@B@@BB@@@@@@B@@B@@B@BB@@@@@@@B@@@@@@@B@@@@@B@@@@@B@@B@@@B@@B@@B@@@@@BB@@@@BB@BB@@@@@B@B@@@@@BA@@B@B@@@B@@@BB@@B@@@B@@@B@B@@@@@@@@@B@@@@@B@B@D@B@D@B@D@@AB@@@@@@@@A@AB@@@@A@@@@@A@@@@@@@AB@@A@@B@@@@A@@@@B@@A@@@@BA@AB@@@@@@A@@@@B@@@@AB@B@B@@A@@@@@A@@@@@A@@@@@A@@@A@@BA@@BA@@@A@@@@@@@@@AA@AA@@@@AA@@@@@@B@@A@@BA@@@AB@@AB@@A@@@@BA@@BAB@@@@@@A@@AACCAAAACCA@@A@A@@A@@A@@@A@@@@@ABA@@@@@A@@@AB@@AB@@@BA@@@AB@@@@A@@AA@@@A@A@A@@@A@AA@@@@@@A@@@@@A@@@AB@@@@AB@@@@AA@@A@@@@AA@A@@@A@@@@@@@A@@@A@C@@@ACA@@A@@A@@A@@@@AA@@@AA@@@A@@A@@A@@@@@A@A@@@A@A@@@@@A@@BAA@@A@@@@@AA@@A@@CA@AAAA@AAAAA@@AAA@AAA@@@@@@@@@@BA@@@@BA@@BA@@@@BA@@B@@A@@BAB@BA@@BAB@@@@@BAB@@@B@BAB@B@@@BA@@@@B@@@BA@@B@BAB@BA@@B@@AB@@@@@@A@@@A@@@A@@@@@@AA@@@@@AA@@@@@@@@A@@@AB@BA@@@@@AB@@A@@BA@@@ABABA@@BA@AB@BA@@B@@@BA@@B@B@B@@@DAB@BAB@B@@AB@@@BAD@BAB@@@B@B@@AB@B@BA@@@@@@BAB@B@BA@@B@B@B@@AB@B@@@@@D@@@B@@@BAB@@@B@BA@@@@B@B@BAB@B@BAB@@@B@B@B@B@@AB@@@BA@@@A@A@@AA@@AA@@@A@@@@@@@AAA@@@ABAA@@A@@@A@@@A@@A@@A@AAA@@@A@A@A@A@@@AB@@AB@@AB@@A@@B@@A@A@@@@@A@@AA@@AA@AAA@A@@A@@A@@@A@@@C@@@A@@@A@A@@@AA@@@AAA@A@AAA@@@AA@@AAAAAA@@@AA@AA@@A@@AA@@@@@@@A@@AA@@@A@A@AA@@AAAAA@AAC@AAA@A@AAA@A@A@@AA@@@A@AAA@A@@AA@A@A@@@ABA@ABABA@AB@@A@A@C@A@A@AB@@A@@@A@@@AA@@AAAAA@AAA@@AA@A@@@A@A@A@C@@@A@@AA@AA@@C@AA@@A@C@C@C@A@A@A@@@AAA@A@@AA@AA@@@AA@AAA@AAAAAA@@@@A@A@@@A@A@@@C@A@A@A@A@A@@@A@A@AA@@@@A@AA@AAAAAA@@@AA@@A@A@@AA@@AAAA@@AAAAA@A@@A@@AA@@@@AA@A@A@AAA@A@@@AAAA@@AA@@AAAA@@@@@AB@B@B@BA@@B@B@BA@@B@BAB@@@@AB@B@@@BAB@BAB@DCB@@@BAB@@@BA@@@@B@@A@@BA@@@@@AB@@AB@@@B@@AB@@AB@@AB@@@BA@@@A@@@A@A@A@A@@@@@A@A@@@AB@BAB@@AB@B@@A@@@@@A@@@AA@@A@A@@@AA@@AAA@A@@@AAA@A@A@A@@@A@AB@@A@@@A@@@A@@@@B@B@B@B@BAB@B@B@@@B@B@B@BAB@@@B@@AB@@@BA@@B@@@BAB@@@@@BA@@@A@A@@@A@A@@@AB@@@BAB@B@BAB@@@B@B@B@B@B@BA@@B@@AB@@@@A@@BA@BB@@@BBBB@BB@@@B@BDB@BBB@B@B@B@@BB@B@B@B@@@BAB@@@B@@AB@B@@@B@B@B@@BB@@BBB@BBB@@@B@@B@BBB@B@@@B@BBBBB@BBB@@BB@@@B@@@BBB@B@@@B@B@@@B@@@B@B@@@BA@A@@BA@@@ABA@@BAB@@AB@BAB@@@B@@@BA@@B@BA@AB@@ABA@ABA@@BA@@@@@A@AA@@A@AAA@A@@@A@A@@@AAA@A@@@A@A@A@@@AB@@@B@@@@@B@B@BAB@@@B@B@B@B@DAD@B@D@@@B@B@B@B@@@BAB@B@@@@@B@@A@@AA@@@A@AA@@A@AAA@A@A@A@A@@@AAA@@@AAA@@@AAA@@AA@@AA@@@A@@AA@AA@@A@AA@@ABA@@BA@A@@@A@A@@@AB@@@@AB@@@BA@@@@BA@@B@BA@@@@BA@@@AB@@AAA@@@AA@A@C@A@A@AAA@AA@@A@AAAAA@A@AA@@AA@AA@@AAA@@@A@@@A@AA@@AAAA@@AA@@@AA@@A@@@AAA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@A@CAAACAA@CAAAC@CAAAA@A@@@AAAAA@@@AAA@A@A@C@A@A@@@A@A@@@@@AB@BA@@B@@A@CBA@@@AB@@A@@@C@A@A@@@@@AAB@@A@@@@@A@@@@A@@A@@A@@@@A@@@AA@@@A@@@A@@AA@@@A@A@A@@@A@AA@@AA@@B@@A@@B@@A@@@@@AB@B@@@B@@A@@@AAA@@@A@A@@@AA@@@C@@@A@@@AA@@A@@@A@A@@A@@@A@@A@@@AA@@AA@@BA@A@C@A@@AAA@@AA@@@@@@@@A@@@@A@A@A@@AA@@@@@@@AA@AA@@AA@@@A@A@C@@@AAA@A@@@A@@@AA@@@A@@@AA@@@@A@@@A@@AA@@@@A@@A@@@@@@@AB@@@@A@@@AB@@@@@@A@@AA@@@@@A@@@@@A@@@@@A@@AA@@@@AA@@@@A@@A@@@@@AB@@@@@@A@@@@@A@@@A@@@@@@@A@@@A@@B@@A@@B@B@B@DA@@B@@@B@@@B@@@B@BA@@B@@@BA@BB@@@B@@@B@@@B@@@@@BAB@@@B@BA@@@@BA@@@@@A@@@A@@@AA@@A@@@AB@@A@@@A@@@@@AB@@A@@@@@A@@@@@@@A@@B@@@@A@@@A@@B@@AA@@@@@@AB@@@@@B@@@BA@@B@@@B@@@BBB@@@B@B@B@@@BA@@B@@@BA@@@@B@@@B@@@B@B@B@@@B@@@@@D@@@BA@@@@BA@@BA@@@AB@@@@AB@@@@@BAA@@@@AA@@@A@@@A@AAA@@@A@A@@A@@@@@A@@@@AA@@@@@@@@A@@@A@A@A@AAA@A@@@A@@@@@AA@@@@@A@@A@@@@A@@BA@@@@@@@AAB@@@@AA@@@@A@@@ABA@A@A@@@A@C@A@A@A@A@A@@@A@A@@@A@AAA@A@@@A@@@A@A@@@@@A@@BA@@@AA@@A@@@A@@@AA@@@@@A@@AA@@@@BA@@@@BA@@@@@@@A@@A@@@A@@@A@@@A@@@@@A@@@@A@@@AA@@A@@@@AA@@@@AA@@@@AA@@@@A@@@A@A@@@A@A@@@A@A@A@@A@@A@@@@A@@BA@@@@@A@@@@A@@@A@A@@@A@A@@@A@A@A@@@@@@AA@@A@@A@@A@@@A@@@A@@@@@A@@@A@@@@@A@@@@AA@@@@AA@@A@@@A@@@@@A@@@@A@@@@@@@A@A@@@@@@@A@@@@@@BA@@@A@@@@@@@AA@@@@@A@@AA@A@A@A@A@A@A@AB@@@@AB@@ABA@@B@@A@@B@@@@@B@@@@AB@@A@A@@@@B@@@@@B@@A@@@A@@@A@@@@@A@@@@AA@@@@@@@@@A@@@@@@@@B@@A@A@@@A@A@@BA@@@@B@@AA@@A@A@@@A@A@A@@@AB@@@@A@@@@@A@A@@@C@@@A@@@AB@@@@AB@@A@@@A@@@AA@@@@AA@@AA@@@@AA@@@@ABA@@@A@AB@@A@@@@@@BA@@@A@ABA@@@A@@@@AAAA@@A@@AA@@@AAA@@@@@AABA@@@A@@@@@@A@@@A@@A@@@@@A@@@@@@@ABA@A@@@A@@@AA@@@@@AA@@A@@@A@@@@@A@@A@@A@@A@@@A@@AA@@@AA@@@@@A@@@@BA@@B@@@BA@@B@@@BA@@@@BA@@B@@@@A@@@@B@@A@@@A@@AA@A@@@A@@@@@AB@@@BA@@D@@@@@@AB@@AB@@A@@@@@A@@@AA@@@B@B@@@B@B@@AB@B@@AB@@@B@@@B@B@B@@@B@@@B@B@@@B@@@@A@@B@@@@A@@B@@AB@@@B@B@@AB@B@@@B@B@B@@AB@@@B@@@@@@AB@@A@@@A@@@@@A@@@@@A@@@A@@@A@@@AB@@A@@@@BA@@B@@A@@B@@@@@B@B@@@BA@@B@@@BBB@@@B@@A@@@@@AA@@@A@AAC@A@@@AAA@A@A@A@@A@@A@BA@@@@@@@A@@@@BA@@@AB@@A@@@@@A@@@AB@@A@@@A@A@AB@@A@@@AB@@@@A@@@AB@@@B@BAB@@@B@@@BA@@BA@@@@@@BA@AB@@A@A@@@A@@@A@@@A@@@A@@BA@@B@@@AA@@AAA@@@AA@@A@@@AAA@@@A@@@@A@@AA@A@@@@@@@A@@AA@@@AA@@A@@A@@AAA@@@@AA@A@@@A@A@A@AB@@A@@BA@@@AB@@@BA@@@@@AA@@@A@A@AAABA@A@C@@@A@A@A@A@A@@@@@A@AAA@AAA@@@@AA@@@@AB@@A@@@A@@@A@AA@@A@@A@@A@@@@BA@@BA@@@AB@@@@AB@@@B@@A@@@@BA@@@@BA@@@AA@@AA@A@AAA@@A@@@@AAB@@@@@BAB@@@B@B@BA@@B@@AB@BA@AB@B@@A@@BBB@B@B@@@B@B@B@B@B@BBB@B@@BB@@@@AB@BA@AB@@AB@@A@AA@@AAA@@AAA@@@A@@A@@@AB@@A@AB@@AB@BAB@D@B@B@@@@@BAA@@A@@@@A@@AA@@@@@A@A@@AA@A@A@@@AAA@AAA@@A@@@@@AB@@@BABA@@@A@A@@@A@A@A@@BA@@@A@@@ABA@ABA@@@@@AB@@A@AB@B@BA@@BAB@B@@@B@@@BA@@BA@@B@@A@@BA@@@A@@@@@ABA@@@A@@A@@@A@@@ABC@@@A@A@A@A@A@@@@@A@@@@AA@A@@@AAA@A@@@A@A@@@A@@AA@@@AAA@@@@@ABA@@@@@AB@@A@@@@B@@A@@BA@@@@BA@A@A@AB@@A@@@ABA@@@A@@@@@@BA@@BA@@@ABA@@B@@@BA@@@@B@AA@@@@@A@C@@@@@@@A@@@A@A@@AA@@A@@AA@@A@AA@@A@CB@@AA@@A@@AAA@@A@AAA@@@AA@@A@A@AB@@A@ABAB@@A@@@A@@@@A@@AA@@@A@@@@AA@@AA@@A@@AA@A@@@AA@@A@@A@@@AA@A@@AA@AA@@A@@AA@@AAA@@AAA@@@AAA@@AA@@A@@A@AA@@AA@@A@@@@AA@@@@AA@@A@@A@AA@@A@@@A@A@@@A@@BA@A@@BA@@@AAA@@@AAA@A@A@A@@BA@@@A@A@A@@AA@AAA@@AA@@@@A@@@A@@A@@@A@A@A@@@AA@@AA@@A@A@A@AA@@A@AAA@@@A@A@A@AA@@A@@@AAA@C@A@A@ABA@A@@@A@A@A@@@A@@@A@@AA@A@AB@@ABABAB@@A@@B@BB@@BA@@BA@AB@@@@A@@AA@@@AA@A@@A@@AA@AA@@A@A@@AA@AA@AA@@AAA@@@A@@@AA@@AA@AA@@AAA@@@AA@@A@A@A@A@A@A@@BA@@B@@A@@@@A@@BA@A@AB@@A@AB@@A@@BA@@B@@AB@@@B@B@@AB@@@@@@A@@AA@@AAA@AAAA@@A@@AA@@A@@@@AAA@@@AA@@@@@A@@@AB@@AA@@@AA@@@A@@AA@AA@@@A@@@C@A@A@A@A@A@@@A@@@A@A@@@@AA@@AAA@AA@@A@@@@A@AAA@AA@@AA@@AA@@@@@@@@AAA@@AA@@@AA@@A@@AB@@@B@@@B@D@@@D@@@B@BA@@B@B@B@B@BABBB@@AB@@@@@@A@@@AA@@AC@AA@AA@AA@@AAA@@A@@A@@@AAAC@@A@AAAA@@@@@AA@@@@A@@@@@@AA@@A@AA@@@AA@@A@A@@@AAA@A@A@@@@BA@@BA@@BA@AB@BA@@BA@@B@@@B@B@@@B@@@@@@A@@@@A@@A@@@@AA@@@AA@@@AAA@@AA@@@AA@@@@AA@A@@A@@@@AAA@@@AA@@AA@@@@@AA@@@@@@A@@@A@ABA@@@AB@@A@@@A@@@A@@@@BA@@@@@@@@@A@A@@@A@@@A@A@@@A@A@A@A@A@A@A@@@A@@AA@@@A@ABA@@@AA@@A@@@A@A@@@A@A@@AA@@@A@A@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@A@@@@@@@A@A@@@@@A@@A@@AA@@A@@A@@A@@A@@AAA@A@@@A@@@A@@@A@@@@@A@@B@@A@A@C@A@A@AAA@A@@@@@A@@A@@@@@@@@@@@A@@@@@AB@@@BA@@@A@@@A@@@@@@AA@@@@A@@@@A@@A@@A@@@@@A@@@A@A@A@A@A@@@@@AA@@A@@@AA@@@@@AA@@A@@@@@AAA@A@A@@@AA@@@@AA@@@@@A@@@@@AA@@A@@@@A@@AA@@@A@@@@@@@A@@@@AA@@@@A@@AAAA@@@AC@@A@@A@@@AA@@@@@@@A@A@@@@A@@@@BA@A@@@A@@@AB@@A@A@C@A@@A@@A@@@@A@@@A@@@A@@@@A@@A@@@A@@@A@A@A@@@A@@@@AA@@A@@@A@@@A@A@@@@@A@@@AB@@@@A@@@A@@AA@A@@@A@@@A@A@@BA@@@AA@@A@@@@@AA@@A@@A@@A@@@AA@@@@AA@@@AA@@A"],encodeOffsets:[[131817,45808]]}},{type:"Feature",id:"230184",properties:{name:"五常市",cp:[127.167618,44.931991],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@@@@@ABABA@ABA@C@@@CBAB@@ABA@A@A@A@ABCBABA@@@@@AB@@B@@B@BA@@@ABA@A@@@A@@B@@A@ABA@@@@@@BB@@BB@@BBB@@BB@B@B@@BB@@@B@B@@BB@@AB@B@B@@BBB@@@@B@@@BAB@@@B@@BBAB@@@BAB@DAB@@@B@@@BBB@B@BB@@B@@AB@BA@A@@BA@BB@B@B@@@BAB@BA@CB@B@@AB@B@@BB@BBB@@@B@@@@@@AB@@A@ABA@@B@BA@@@@BA@@@AB@B@@@BAB@B@B@B@B@@@BAD@@BB@BB@@B@@@@AB@D@@@@BB@@@@@B@@@@@B@@@@@B@B@@@B@@@B@@@B@@@@@B@B@@@B@@BB@@@@@B@@@B@@@B@@@BB@@@@B@@@B@@@@@B@B@B@@@@@B@@@@@B@@@@BB@@@B@@@@@B@@AB@@@B@@@B@@@@ABAB@@A@@B@@AB@B@@@BA@@@@@@@@AA@AA@@A@@@A@@@A@@AA@@@@@AA@@AA@@AAAA@@@@@A@@@@AA@@@@A@@AA@@@@A@@A@@A@@@@@A@@@@@AA@@@@@A@@A@@@A@@@@@A@@@AA@BA@A@@@@@A@@@@AA@@@A@@A@@A@@A@@AA@@A@@@@@AA@@AA@@@@@A@@@AA@@AAA@A@@@A@AAA@AAC@AAAA@@A@@@@AA@@AA@@@AA@@@@@@CBA@A@ABA@A@@@@B@@A@@@ABA@ABA@AB@@@@@@@B@@@B@B@@@BA@@B@@AB@@A@A@A@ABA@@@A@AB@BA@ABABABA@@BA@@BA@AB@@A@@@AB@@@@A@CBA@@B@@@@AB@@ABA@@@AB@@@BA@@@@@@AAA@A@@AC@@@AA@@AA@A@AAA@@@@BA@A@A@A@@@A@@@@BC@A@@@AB@@ADCB@BAB@BAB@BA@@BA@@@@B@@A@A@ABABC@@BABAB@BAB@@@BA@@@A@@BABAB@BA@@BB@@@@B@@ABCB@BA@@BAB@BAB@B@@@B@@@BA@@BA@@B@@ABA@A@AB@@ABA@ABA@@@@@@@B@@B@@B@B@@@@BB@BB@@@@BD@@BB@B@@B@@@@B@@BB@@BB@@@@B@B@@@@@@BB@@BBB@B@@@B@@@@BB@@@@AB@@@BB@@@BB@@DDB@@BB@@BB@DB@B@@B@@B@@@B@@@@BBB@B@@@B@B@BA@@B@B@@@B@BB@@@@@B@@@B@BAB@@AB@@@@@BA@@B@BA@@@A@@@@D@@@BA@@BABAB@BA@@@@B@@B@@B@@B@BBB@@BB@@@BB@@B@@B@@@@BB@@BB@@@@@@@@AB@B@@AB@@@BB@AB@@@@A@AB@@AB@@@@ABA@A@AB@@AB@@AB@@@B@B@BA@@BA@@@A@@B@BA@ABA@A@A@ABA@@@ABA@@@A@@@A@@@ABA@ABA@ABAB@@@@@@@BB@@@AB@BAB@@AB@@@@A@A@@@A@ABA@@AA@@@@@ABA@@BA@@B@@A@AB@@A@@B@@@B@B@@@@@BA@@@A@AB@@@B@BAB@@@B@BA@@@ABA@AB@B@@@@BB@@@B@@@BAB@B@BA@@@ABA@A@A@A@A@AB@BA@@BABA@ABAB@@AB@BAB@B@@@BA@AB@@ABA@A@@@AB@@@@ABA@AB@@AB@@A@@@A@@B@@AB@@@@@@BBA@@@@@@@A@A@@@A@@@AB@@ABABA@AD@@@@AB@B@@@BAB@B@@@BA@ABA@ABA@AB@@@@CAA@A@CA@@@@A@@@@@@@@@@@@@@B@@@B@D@@BB@B@BAB@B@BB@@BBBA@@B@B@@@BA@@BAB@@@B@B@BA@@B@@A@CDABAB@@CB@@AB@@AB@BA@A@A@@B@BA@@B@@@B@@A@A@A@AB@@@@ABA@AB@@A@A@@@@@@@@@@@@@@@AB@@A@A@@@A@@@@@A@@@A@@BC@C@@@A@@@@@@@@@A@@@ABA@@BAB@@@B@@@@@@@@BB@@@@@B@@@@@@@B@DBB@B@@@@@@@BB@BB@BB@@BBBB@@BB@B@B@@B@@B@@B@@@B@@B@@@BB@@BBB@@BB@BB@@BB@@BB@@BB@@@BAB@D@B@B@@@@@BB@@BBB@@BB@B@@B@@@@B@@B@@@@@@@@@BB@@@B@@@B@@A@@B@BABABAB@@AB@BABAD@BA@@B@@A@ABC@AB@@A@ABA@A@ABA@C@AB@@A@@BA@C@A@A@ABA@@B@@@B@@@B@BBB@@@BA@@@A@A@A@@B@@ABABABAB@@@B@BA@ADA@@B@@@B@@BBA@@B@@@BB@@B@@@@A@@@A@A@@@A@@BA@@BA@@B@B@@AB@B@BB@@B@BBBB@@BDB@BBB@@BBBBBB@@BBBBBB@B@@@BAB@BAB@@AB@@@B@@A@@@@BA@@@A@@@AB@@@B@@@B@BA@@@@B@B@BA@@@@B@@@B@@@B@@@@AB@@@@B@@BB@@@B@@@B@@@B@@@B@B@@@B@@@B@@@BB@@@@B@@BB@@@@@@@@B@@B@@B@@@@@BA@@B@@@@@@@@BB@@@@@BB@@@@BB@@@B@@@@@B@@@BB@@@@@BB@@@BBB@@@B@B@@@B@B@@@@@B@@AB@BA@@B@B@@@@@B@BB@@B@@@B@@@B@@BB@@@@@BAB@@@B@B@@@BB@@B@BB@@B@@@@@@@@@@@@B@@B@@A@@@@@A@@@ABABA@@@@BAB@@ABA@@B@@@B@B@D@D@B@@@B@@@B@B@B@B@B@DAB@B@B@B@B@B@B@@BB@@B@BB@@B@@B@B@@@@@B@@@@@B@@@@@B@@B@@AB@@B@@B@@@BBB@@@B@@B@@B@@@@@@B@B@@@@B@@@@@B@@@B@B@BA@@B@@B@B@@@B@@BB@@@BB@@B@BB@@B@@BB@B@@@BB@@@@@BB@@BB@B@@@B@@@B@@@@BB@@BB@@BB@@@BBB@B@@BB@B@@@@@@@@@@D@BAB@B@@@@A@@B@@A@@@@B@BB@@B@B@@@@A@@@@B@B@BC@CBAB@BADABCH@@AD@BA@A@@@AA@@A@CBA@AB@@@B@@@B@@@@C@@@@@@A@@@AA@@BA@A@A@AAE@A@ABA@@BA@CB@@@@@B@BABA@A@AAA@@AA@@AA@C@ABABA@CACBA@A@CBA@ABCBCDAD@B@B@BB@@B@@CDABA@CBCBE@AB@@@BBBA@A@A@A@ABCBA@A@@@ABAAAAA@C@C@A@@B@DABA@ABA@@BADA@A@CAA@A@C@A@@@E@ABABA@A@A@@B@BA@ABEDA@A@A@A@@@ABC@A@AA@@AAA@A@C@C@A@C@ABABB@@BABA@CBA@@@ABC@ABC@C@C@A@ABABC@A@A@A@ABA@ABA@@BABABA@ABBBB@@BABADA@ABA@@@C@A@A@@@A@@A@@A@@@A@@AA@@@A@C@A@C@E@E@CBG@A@G@C@C@E@EBC@E@C@E@C@I@CBC@A@G@C@A@A@A@A@A@A@@@A@A@@@@@A@C@A@AB@BAB@@A@A@A@@@C@@@A@ABA@@@A@A@A@A@A@A@E@A@A@CBC@C@A@A@A@C@C@E@A@EBC@C@CA@@A@@A@@@A@@@@@AA@@@AA@@AA@@@@@A@@@@@@@A@@@@@A@AAA@@@A@A@@BA@@@@@@AA@@@AAA@AAC@@AA@AACA@@A@@@@@A@@A@@@@A@@A@AA@@@A@@@@A@@@CBA@CB@@A@ABA@@@@@@@AB@@A@@@@@@@@@@B@@A@@@@AA@@A@B@@A@@B@@A@@@A@A@@@@@AB@@@BA@A@A@A@@@A@CAA@A@@@@@CBABCBAB@B@@A@A@@AA@@@A@A@A@AAA@@@A@ABC@A@ABA@ABA@@B@@@@@B@@BB@@@@@B@B@B@@B@@BB@B@B@@@@B@B@@@B@@@B@@@@@@B@@@B@@@B@B@B@@@B@BBB@@B@@BBBBB@BB@@@B@@AB@@A@@BA@C@@BA@AAC@A@@@AAA@@@@BA@ABA@@BA@ABAB@@AB@@A@A@A@A@@@A@A@A@@@CA@@AAA@@A@@A@A@A@C@C@A@A@A@@@CAA@C@@@@@@@AB@@@@@@GA@@@@@@AA@@@@@@@@@@GEA@GFC@@@A@@@A@CA@@@A@ABAD@BAD@@A@A@AACGGA@A@A@ABA@A@AA@A@A@C@A@@@AA@@@A@CCAA@BAEEAA@@AD@AAA@ABAAA@C@CA@@A@@AB@BAA@@AA@C@CA@@@AB@B@B@ACA@A@A@A@@@A@@BA@@@@@A@@@@AA@@@AB@@A@A@A@ABA@A@@@ABA@ABA@ABA@ABA@@B@@AB@@A@A@@@A@A@A@A@@@@@AB@@A@@@A@AA@@A@@@A@A@@@A@@@A@@@A@A@A@@@C@A@A@A@@@A@@@@@@AA@@A@AAA@AAA@@AA@AA@@AAA@@@ABC@@BA@@@@AA@@@A@@@A@A@A@@@@@A@@@A@A@@@AA@@@AAA@C@AA@@AAAAA@CAAA@@@@AAAACC@@AA@@CAAA@@@@AA@@@@AAA@AA@@AAAC@@AA@AA@AAAA@@CACACA@AA@@@E@@@A@@@@BABABADA@@@BBAD@B@D@@AB@BAB@B@B@@@B@@@D@B@@@@AB@@AB@@@@@B@B@B@@@@A@A@@@A@@@IF@@@@@B@@@B@@@@@@@@@@@BA@CAA@@@@BA@AB@B@D@BB@D@D@B@@B@@@B@@@@@B@BA@@B@@BB@BHDB@@@B@@@BB@@@@B@@@BB@@@@@BA@@@@D@F@D@B@D@BB@@@@BB@@D@@@@AB@@@@@B@@AB@B@@@B@B@@@BABBB@B@@@@AB@@@B@B@@BB@@BB@@BB@B@@@B@BBB@B@B@@@@@B@@@B@@@@@B@B@@AB@B@@A@@BCDA@@BCBA@@@@B@@@BBB@B@@A@@@A@A@@@AB@B@@@B@BBB@@@@@B@@@@@@@@@@@BA@A@@BA@AB@B@B@@@@@DFFBD@D@D@BBB@DBBADB@@B@DAB@B@B@BBB@B@@@B@@@B@@@@@BB@DLBBBD@@@@AD@@@@@B@@@BAB@B@@AB@@AD@@ABABCBABA@@BC@@B@@@B@@AB@BABCDCDCD@@@BA@@@@B@@A@@@A@@B@@@B@@@@@BB@AB@@@@AB@@ABCBCDADAB@BA@@B@@ABAB@@AB@@@@A@@BA@@@@B@@AB@B@B@@A@@B@@@@@@ABAB@@A@@@A@ABA@@B@@AD@B@B@D@DBB@@@BA@@B@@AB@@@B@@@BA@@B@@A@@BBB@B@@@B@@@BAD@@@B@@@BAB@D@@A@BBA@@@@B@@AB@@@@@B@@@@@B@@AB@B@@@@@B@B@B@@@BAB@B@@AB@B@DA@@B@B@B@B@B@B@F@B@@AB@@@B@B@B@BA@@B@@AB@@BB@@BB@@@BBB@DBB@@BDB@B@BBBB@@B@@B@@@@@B@@@@@B@@@B@B@@@B@B@B@@@BBB@@@B@DBBB@@BBB@@BB@@B@BBB@B@@BBB@@@@A@@B@B@@@D@B@@@D@@@D@@@B@B@@@B@@A@@B@@@B@@@B@@@@AB@@@BA@@@@B@@@@@@@B@@@@BB@@@@DD@@@@@@@@B@@@BD@BBB@BBD@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@BBDDBB@BB@DBBBB@@@BB@@DBB@@@@@B@@@@@@B@D@@@@BB@@@@B@B@B@@@D@B@B@B@BBB@@@BBB@BBB@@BB@@B@@DBFBB@BBB@B@@@B@DBB@@@DAB@B@F@N@@A@@D@@B@@B@@@@@D@J@@@@@BBAD@B@BBB@B@DBB@BAB@BB@D@BABABCD@BABBB@BD@FBB@BBBBB@BBF@DAFEN@DABBBBBF@DABBB@@B@BGH@@AB@BAB@D@B@BABA@@@@@C@CAAAC@C@AB@B@BFFBB@@@BABABCBE@CB@@AB@B@BBD@@@BB@ABABADABCB@BADCDGBCB@BBB@DABCBA@CACBCBCDA@AAAEC@C@C@ADBD@BDBDDFDDBHD@BABAB@@A@IBCAA@C@AD@F@D@BABEFWHEBGF@D@DBD@DABGDAB@B@B@D@BCBC@CBCBADAD@FADABEDCBAFCBADABEDCBA@EACBC@GDEHDBADAD@@AF@DFF@@@DBDABBBDHADAHCDADADBFDF@BABABC@C@C@A@@BEBCB@B@BBDBD@BDBBBDD@@BB@BABEFCBC@O@@@A@GBECAAACAAEAAACAC@CB@B@BAFAFAB@BCBABE@I@QCEAE@C@ODC@A@C@C@IECACACBA@GDA@A@GBEBADCD@@CAA@AACEAEEAGECAAAE@CBCF@L@FABCBGBCACAAAEKAAEAAAA@CBCBEBE@ECECC@C@ADAB@DADADAB@BBJAF@D@DABADGBSHCFINGDE@CBE@IAIAGAGBEBAB@B@BBD@D@DABCBCBKAA@C@A@E@ABCAEAGAE@EBA@ABEAA@A@A@ABA@CAABA@ABCDABAD@@ABABA@ABC@GAC@ABCBCBABADAFCDCBE@ABCBA@ADC@A@ABGBA@@@AB@B@DDBHDBBBBADCBC@IAE@CBCBA@@BADAB@@CBA@AB@D@BABA@A@C@ABE@C@A@CBA@C@A@CBEJCBA@EBA@GBCBEBABA@A@A@C@CBCBCBC@E@C@C@CAC@C@E@@AAA@@A@AB@@@BA@@B@@@BB@BB@@@@@@BB@@@@BB@@B@B@B@B@@@B@@@@B@BA@@BA@A@AB@@@BB@B@B@@BH@HBB@B@@@BBFDBB@B@B@B@BB@@@@B@B@@@BD@@@BB@B@@A@@@@B@@@@D@@@ABA@@B@@@@ABA@A@@BABBB@@DD@BBB@@B@@@@@D@B@DC@@D@BB@@B@BBAB@@CAAB@@BB@@BBB@@@BBBBF@@@@@DD@@BDBB@DABC@@B@@@B@@ABBB@@DBD@@@BBBD@AB@BA@A@@@@BB@@B@B@@@B@@@@A@@BAB@B@BBB@@@B@B@B@@@@A@@B@D@B@@B@D@DBAB@@@BD@FF@B@@@@A@CH@@BAFAB@DF@FBB@B@@@@B@B@@@@DBD@F@FBD@BFB@B@D@B@B@@@@@@@@BB@@B@@B@BB@@@@@@B@CB@@@@@B@@@@@@A@@B@@@@B@@B@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@B@@@@@@@@B@@@@@@B@@@@@@A@@@@B@@@@@@@@A@@@@@A@@B@@@@@@@@A@@@A@@@@B@@A@@@@@@@@@B@@BB@@@@B@@@B@@@B@B@@@@@@ABA@@@ABA@@@@@@B@@KC@@@B@@AB@@@B@@B@@BB@@@@B@@@@A@@@A@@@AB@@@@@BB@@@B@@@@@@B@B@B@B@@AB@D@@@B@@AB@@@B@@@@@B@@@@@@A@@@@@@@AB@BA@@@BB@BBD@BBB@@@BB@@D@BBB@BAD@B@BAB@B@BAB@@ADADADBBCF@BA@AACACACFAD@D@D@B@B@B@@@B@B@D@@AD@BCD@BCD@@@@@B@BAD@@@BA@@B@B@@AB@@@B@@@@AB@B@@@BADB@BB@@@@DB@@B@BB@@@@@@@B@@@B@@@B@@@BAB@B@@@@HDHBHB@@B@B@B@BBB@D@D@DIB@@@DBD@@@BA@ABEB@BEBAB@BBD@@@B@BG@AJDD@DBB@B@BBD@@@@ABC@ALBLBFBADAB@BD@@B@B@DB@AF@F@B@F@DJ@@KF@D@BAF@B@B@@@@@@@B@@A@A@@@ADELBB@B@B@AFBB@B@B@@@B@B@@B@DEBBB@@@B@@BB@B@DAB@D@BAD@D@FAB@@@@@B@DDDBB@@@@@@@B@@ABB@ABC@@@AB@@@B@FBFB@@@@@@@B@@B@B@D@D@D@B@B@B@B@@@BBFAB@D@@@@A@@@AB@@@@AB@@@FBDDB@@BB@@@@@BA@@B@@@@ADAB@DA@@BA@@@@@@BA@@@@@@B@@@@@@AB@B@B@@@B@DBB@B@@@@@B@@@@@@A@@AC@A@@@@@@BAB@B@B@BAD@B@@@BBDDB@@BB@@@@BB@BAD@@@@@@@@A@@@A@@B@@@BBB@@@@@@@B@@AB@B@DA@@B@@@@@@@B@B@@@B@BB@@B@B@@@BBB@@@B@@@B@B@@@@@@@B@@@B@@@BADAB@@AB@@AD@FAB@@@@@@@@A@@B@B@@@B@@@B@B@@@B@@@B@@A@@@A@A@@@A@@B@B@BBBAB@BAB@BAB@@@@A@@@@BA@@B@@@D@@@AA@A@@A@@A@A@@@AB@@A@AB@@@@@BA@AB@@@@@@A@@@A@@@@B@BAB@@@FAD@BAB@BAB@@A@@BA@A@A@@BA@@@@B@@@@@@@BAB@DADABAB@@ABABCDCDCB@BA@@@@BB@@@@BABABA@@@@@A@@AA@A@@@A@AB@BAB@@@BAB@BBB@B@@AB@D@BABA@AB@BABCBABCBA@@BA@@B@@@B@FABAB@D@@@B@B@D@BBDBD@HDBBFBB@B@FBDBB@B@B@BAB@B@B@B@BABBB@@@BBB@BBB@B@@@BBB@B@B@@@B@B@D@@@B@B@BAB@B@@BB@B@B@BB@@BBB@BBB@B@@@BBBAB@B@B@@@B@B@B@@@B@B@BB@@DB@@B@B@@B@@DB@@D@@ABABBB@B@@CAE@ABABCDADADBD@DAHAD@D@D@B@DCFCDC@AAC@AAA@ABC@E@AB@BABCDAHCB@B@@BDBBDB@D@DADCF@BBDBD@FA@@DEBCB@D@F@DA@ABAAC@C@CDADAFCDABAD@D@DBF@DADAB@D@B@BBD@H@B@DBBAB@@E@K@C@@BA@AAA@AAAAEB@@@BA@C@AAAC@@@CBAAA@AA@ABCBC@C@ABABABCBE@CAA@ABEBABABC@EB@DABADBB@@BDBDBD@BABAF@D@HBFBFBJDPFDBB@B@FANEB@D@@A@A@AE@EACAGECEAAAE@CAA@@ACBAB@D@B@JJBBBDBBF@D@D@F@DAH@D@B@BBDCBC@CBCD@BADEDEBG@AB@@A@A@@@A@AB@B@DADAB@D@B@HADAB@DBF@@@B@B@@@B@B@BAB@@@B@B@B@B@B@@@B@BB@@BBB@@@B@B@B@@@BABA@@BABE@A@AA@AABAHGB@BA@@B@BA@@B@B@DAD@@ABACACC@@BAB@@A@@@AB@A@@A@@AA@@AAA@@@@@@@@A@@@A@A@@BA@A@ABA@@AACAAAAA@@A@@@AA@@@@@@@@@A@@@@@@@AA@A@@@A@A@AA@A@AAAB@@A@@B@@@@AA@@@@A@A@A@@A@@@AA@@@@@@@A@@@@@@@A@@B@BC@@BAB@BAB@BABAB@@@@ADCB@@A@@BA@@@@@@@@BA@@B@B@B@B@@@B@DB@@@@B@B@@BBBBBBB@@BBB@B@B@@@BA@@BAB@@@@@DBDBBBBB@@B@@@@@@@BA@@@AB@@@B@D@B@B@B@@@BBBB@@BB@@@@@@B@DC@@B@@@B@B@B@D@BAB@B@BA@@@AB@B@@@B@BBB@BABBB@B@@@B@BAD@@@@@BAD@@@@@B@@AB@@@@@@@B@@@B@@@B@BA@@@@B@@@@@B@@A@BB@B@B@@@B@@@@BB@@@@@@@@B@@@@B@@BB@@@@@B@@@B@BB@@@@B@@BB@@@@B@@@@BB@@@@@@@BB@@@@@B@@BB@B@B@@@B@BB@@B@@@@@DAB@B@D@B@DAB@B@BA@@@@@A@@@@@A@@BA@A@@B@@AB@@@@A@@B@@A@@@@@@BA@@@A@@@@BA@@BABA@@@A@@@@B@@@B@D@B@B@B@B@B@B@@@B@@@@@BA@AB@@@H@F@D@B@BAD@B@F@@@@A@@D@@AB@B@B@B@DABAB@DC@@B@D@DAD@B@BA@@DADCFCFCDCDEFEFCBABCBAB@BCDABAHCB@AC@@B@@@DCBABABA@@B@@@D@B@@A@@@@B@D@@@BA@@B@B@@@BA@@@C@A@@@@@@B@B@DA@@@@DBFBDBB@B@@@@@@@B@@A@@@AB@B@@@D@B@@@DAB@@@B@D@BAB@@A@@BA@A@CBCBCBAB@BAB@BA@@B@@@BA@@BAB@BABABA@@B@@A@@B@@@B@BA@@B@@A@@@AB@@A@@B@@@@@BA@@BA@@@@BADB@@@@B@@AD@B@B@@@B@@@@ABA@@@@@AB@B@D@@@B@B@B@B@B@BA@@B@BA@@B@B@B@B@B@@AB@B@BA@@B@B@B@BBBA@@B@@@@AB@@@BA@@B@BA@@@@B@B@BAB@B@@A@@@A@@@@@@@A@@@A@@@A@@@A@@@@B@@A@A@@@C@@@A@@@@@ABAB@BAB@@A@@@A@@@@AA@@@@@@@@BAB@B@BAB@D@D@B@B@BA@@@@@A@@@@B@@A@@@@B@@AB@B@B@B@@@B@B@@@B@@A@@B@BA@@B@B@B@BCBAB@@@@A@@@A@@@A@@@@@@@@BAB@@@B@@@@AB@@@B@D@@@B@BA@@@AB@@A@AB@@@@@@BB@B@BB@@B@@AB@BA@@@@BA@BB@B@BB@BB@@B@BB@BBB@@@BB@@B@B@@BB@B@B@@@@@B@@@B@@@@BDB@BB@@@D@B@B@@ABAB@BAB@@AB@@@@@@ABA@@@A@@@AA@@A@@@A@@@@@@B@@AB@@@@@AA@A@@@@@AA@@A@@@A@@@@@A@@@A@@@A@@@@BA@@@A@A@@@A@A@@BA@@@@@@@@@@B@@AB@@AB@@@B@@B@@B@@@@@@@B@@@B@@@B@@AB@@@@B@@@B@@@@@@@@B@@AB@@@B@@BA@@B@@B@@@B@@BAB@@B@@B@@@@B@@@B@@@@A@@B@@@B@@@@@@AB@@@@@@@B@@@@@@@B@@@@B@@B@@AB@@@B@@@B@@A@@@@@@B@@@@@@A@@@@@@B@@@@@@@B@B@@A@@@@@A@@@@@A@@B@@@@@@BB@@@@A@@@@B@@@B@@@@@@@@AA@@@B@@@@A@@@@@@B@@A@@@@B@B@@@@@B@@@@AB@@@B@@A@@@@@@B@@@@@B@@A@@B@@B@@BA@@B@@B@@@@@@@@BB@@BB@@@@@A@@B@@@@@D@@@@@@@@A@@@@@@@A@@@@B@@B@@BB@@B@@@@A@@A@@A@@B@@@@@@@@@@B@@@@B@@@@@B@@@@@@A@@@A@@@@B@@@B@@@B@@@@@B@@@@@@@B@@BBB@@@@@@@@B@@@B@@@@@@AB@@@@@@@@A@@@@@@@@B@@@B@@@@@@@@@B@@@@BBA@@B@@B@@@B@@@B@@@@@@@@B@@@B@@@B@@@@A@@B@@@@@@A@@B@@B@@@@@@B@@@B@@@@@B@B@@@B@@@@@@@@A@@@@B@@A@@@AB@@B@@B@@@@@B@@@@A@@@@A@@@B@@@BA@@@@@AB@B@@A@@@@@AAA@@@A@@BAB@BA@@@A@@@AA@@A@AA@@AB@@AB@@AB@BAA@AAA@@AA@@A@@@A@@@A@A@@@A@A@@B@@@@AB@@A@@@@BA@@@AB@@A@@@A@A@@@A@@@@BA@A@AAC@@BAB@B@@A@A@@B@D@BAD@FABABA@@DAB@@CBA@@@@@A@@CCA@@AA@AC@A@A@@@ACAA@@@CCAAEA@@@@AA@@@CAA@@@A@@@@D@@@@ADABABAB@BC@@BADC@AB@@A@@B@DBBBDDB@DBB@BBBBBB@B@@@@B@@@B@BAB@B@B@B@@@B@B@B@@BB@D@@@BBB@B@DBDBB@B@@@B@@@B@B@@@@@B@B@@@BA@@B@@@BAB@@@B@BA@@B@B@B@B@B@BB@@@@@@DBBBB@@@DBBB@BB@BA@@B@@ABA@@BA@AB@@@D@@@B@B@DCB@@@BC@@B@B@@@B@@@@@@@B@@AB@BA@@@@@@BAB@BAB@@AB@@@BAB@BA@@B@@AB@BA@@B@@@@AB@@@@@@@@@BA@@B@@@@@@@B@@B@@@@BA@@BB@@@@B@@@@B@@@@BA@@@@B@@AB@@@B@@@B@B@@@B@B@@@@@BA@@BAB@@@BADA@@BA@@BA@@BA@@B@@A@@@@@@BA@A@@@ABA@@@@@AB@@@B@@A@@@@@@@A@@@AA@@AA@@@AA@@@A@@@A@@BA@@B@DADAB@@ABAB@@A@@@@BAD@BAB@BAB@B@B@B@F@BBB@B@B@@@BB@@@AB@@@@@B@BA@@@AB@BABA@@@AB@@AB@@A@ABA@@@@@A@A@@@@@A@@@@@@@A@@BA@@@@A@@A@@@A@@A@A@@A@@@A@@@@@ABA@@@@@A@@@@@A@@@A@@@@BA@A@AB@@A@A@@@AAA@@@A@@AA@@B@@A@A@A@@@A@A@@@AB@@A@@@@AA@@AAA@A@AA@@@AA@@@@A@@@@@A@@@@B@@@B@BA@@@@B@@@@AD@@@BAB@@A@@B@@@@A@@@A@@@A@@@AA@@A@@@A@@@@BA@@@@@A@@BAA@@A@@@@@A@@@@@@AA@@@@AA@@@@@@@A@@@@@@AA@@@@@A@@A@@A@@A@AACA@@@@A@A@@@AAC@A@AAA@@@CA@@A@A@@AAA@AAAAA@A@@@@AA@@@A@@A@B@@A@@@@B@@@B@@AB@@@@@B@@A@@B@@@@@@AA@@@B@@@@AB@BB@@@@B@BBB@D@B@BA@@B@@A@@B@@@DB@@@@B@@@B@BAB@B@B@B@BBB@@@BBB@B@@@DA@@B@@@B@@@@@@@@@@BB@@@@@B@@@@@B@B@B@@BB@@@B@B@@@@@@AB@@@@@B@@@B@@ABB@@B@@@B@@@@A@@@@B@@@B@B@B@@@@@@@@@@A@@@AA@@@@@@AB@@AB@@A@@@A@@@@B@@@BA@@A@@@@A@@@AB@@@@AA@@@@@@A@@@@@A@@A@@@@@@@AA@@@@@AB@@AB@@AB@A@@A@@@@A@@@@A@@@@BA@@B@@A@@@@BA@@@A@@B@BA@@@@@@@@@ABA@@@A@@@@BAB@@@@AB@@A@@B@@A@AB@@A@@@@@A@A@@CA@AAA@@@A@@AA@@@CB@@A@@@@@@AA@@@@@@A@AA@A@@@@@AB@BAB@B@BA@@BA@@B@@AA@@@@@BABA@AB@BA@@B@@@B@@ADAB@@AB@@AB@@ABAB@@@BABABCB@@AB@BAB@BAB@B@BAF@D@BBB@DB@@B@B@DAD@@@B@B@@A@@B@@@@@@@@A@@@@@A@A@A@AA@@A@AA@AAAA@@A@@AA@AA@@@AAA@@@AAA@@@A@A@AA@AA@A@A@@@A@@@@@CB@@A@@BA@@@A@A@A@AA@@AA@@@A@@A@@@A@A@@AA@@A@@AA@@@B@@A@@B@BABA@@@@B@B@BABADA@@@AB@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@D@@AB@@@@AA@@A@@BA@AB@@@@AAA@@@A@@@A@@@AB@@@B@@@BA@@B@B@@@B@@@B@BBB@BBB@BAB@B@@@@@@@B@@@B@@@@BB@@B@@@B@BB@B@DBD@D@B@@BBA@@@@@AB@@A@@@@@@B@@@B@@@B@@@D@@@B@D@B@B@@@B@B@BBB@B@@@D@B@B@@@B@B@@@B@B@B@B@@@BA@@BBBAB@B@D@B@@@B@BAB@@AB@BAD@@@BA@@B@B@@@BB@@B@BB@@B@B@@@B@B@@@@@B@@@@BB@B@@@B@B@B@DB@@B@@@@@@@DC@@BA@@BA@@@@@ABA@@@@@A@AB@@@@@@A@@@@@A@@@A@@B@@A@A@@@A@AA@@A@A@@@A@@@C@A@AAA@A@AA@@ACAA@CAAAAA@@@A@@@A@@A@@AAACAAAAAAAAAAAAA@C@@AA@A@ABA@@@@@A@AB@@A@@BC@A@@@@@@@A@@@A@@@@@@@C@@@A@@@@@AA@@AAA@AAAA@@AA@@AA@@@@A@@A@@@AA@@A@CACAA@CAAA@@AA@@@@@AA@@@A@@@A@@@A@@AA@@@AAA@A@AA@@C@AA@@A@@@@A@@@@@A@@AA@@@@@A@@AA@@@A@@BA@@@@@A@@@@@A@@@A@@AA@AAA@@@A@@A@@@AA@@@AA@@AA@@A@@@@@@@AAA@@@@@A@@BA@@@ABA@@@A@@@AA@@@@AA@@A@@AA@@@@@A@A@A@@@@@AAA@@@A@@@A@@@AB@@A@@@A@@B@@AB@@@@AB@@@BAB@B@BA@@B@@@B@@A@@B@@@@A@@@@@@@@AA@@@@@A@@B@AA@@@@@@@@@A@@B@@A@@@@@@@A@@@@@A@@@A@A@@@A@AAC@A@A@@@AA@AA@A@AAA@@@A@A@@@A@@BA@@BA@A@@@@@@@A@@@AAAAAAAAAA@@@A@A@@@A@@@@@A@@@@A@@@AA@@@@AA@@@A@AA@@@@A@@@@@@@@@@@@@@A@@AA@@@A@@@@@A@@BA@@B@@@@@@@B@B@B@BA@@BA@A@A@@@A@@@A@@@A@@@@@@@A@@@@@@@A@@A@@A@@BA@@@@@AB@BA@@B@@@@@@A@@@@@@AA@@A@@@@@@A@@@A@@AA@@@@@A@@A@@@AA@@@A@@@@@@@A@AA@@@@@@AA@@@A@@@@@@@A@@@@@@@@@AA@@A@A@A@AA@@A@@@@A@@@A@@@@A@@A@@A@@AAA@@@@@@A@@A@@A@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@A@@AA@@@AAA@@@@@A@@@AAA@@@A@@A@@A@@@A@A@A@@@@BA@@BA@@B@@A@@@A@@@A@@@ABA@@@@BAB@@A@@@@@@@@@A@@A@@A@@@A@@B@@A@@@A@A@A@C@@@@BAB@@@BA@@@@DABABAB@@@BA@@B@B@@@B@@@@@BA@@@AB@B@B@B@BB@@B@B@D@B@@@B@@BBB@B@@@@B@@BB@@@B@B@B@BAB@B@D@B@B@@BDBBBB@@B@@@@B@@B@@@@D@D@B@B@@@B@B@BA@AD@BAB@BCDADAD@BA@@D@@@D@D@F@@@B@BBB@BB@@B@@@B@D@D@B@D@D@F@B@B@D@DBF@D@B@B@@@@@BBBBD@BBBBB@BBD@@BBBB@@B@@BB@@@@A@@B@@@@@B@@@@B@@B@@@@@B@@@@B@@@@@B@@B@@@@@@@@@B@@@B@@B@@@B@@@B@@BB@@@B@B@@@B@BBB@@BB@@@B@B@@@B@@@B@B@@ABB@@@@B@@@B@@@@@@@B@BA@@@@B@B@B@@@@@B@BA@@B@@A@@@@BB@@B@@@B@@@@@B@B@@@B@@@@@B@BB@@B@@@@@B@@@B@DA@@B@B@B@@@@@BA@@B@@@B@@@B@@B@@B@@@@@@@B@@A@@@@@A@AB@@AB@BA@@@@@A@@B@@@BA@@B@@@B@@@@@B@@@BA@@B@@@@@BA@@BAB@@AB@B@@@BAB@@@DAB@DAB@@@B@B@B@B@D@B@B@BBBBDBBBB@B@D@@@BBB@@@B@@@B@BBDBD@BB@@DBFD@@@BB@B@@B@@BBB@@BB@@BB@@BB@BBDB@@B@BBBB@@B@@B@@BB@@BB@@@@@@BDB@BDBBB@@BB@@B@@BB@@@@@@BB@@@@@B@@@@@@BB@@@@B@@BB@@@@@@@B@@B@@@@B@@@@@@@@B@BABABA@AB@@@@@@A@@B@@@@@@@B@@A@@@@BA@@@AB@@@@AB@@@BA@@@@@@@@B@@B@DBBBB@@B@@AD@@@@@B@@@@@B@@B@B@B@B@BAB@B@B@BBBBBB@@@@@@BA@CBABAB@@AB@B@@A@@@@BA@@@@BAB@@AD@BAB@@@B@@@@A@AA@BA@A@@@@@AB@@@D@B@DAB@BAB@@@@@@ABA@A@A@C@@@@AA@AAA@@AAA@@AA@@AAAAAAA@@AA@@CAA@@@@AA@@AAA@@@@AA@@AA@@AA@@@@@@@ABA@@@@B@@A@A@@@A@@@A@AA@@AAC@@@@AA@A@@AA@AAA@A@@AA@A@AAAA@AAAAAA@@CA@@AA@A@@@AB@@ADA@ABA@A@@@@AA@@C@C@A@@@C@CAC@AA@@AA@@@A@AAAAA@A@@A@@A@A@@@ABA@A@A@@@AB@@AB@DA@@BA@@@@B@@AB@@A@AB@DAB@FAFABAB@BABABAB@@A@A@A@A@@AC@A@A@@A@@@A@C@C@A@@@A@A@@AA@C@A@@AA@@@A@@@A@A@@@A@A@A@CBC@@@A@A@@@AA@@A@AAA@A@@A@@A@@AA@AA@AA@@A@@@A@AA@@A@@AAEA@AA@@@A@@@@A@@A@@@@A@@@@@C@@@@@@@@A@@A@@@A@A@@@A@A@@@AA@@A@A@@AA@@@@@@@@@AA@@@@AA@@A@@A@@@@A@AA@@AAA@CA@@A@@@A@A@@@AA@@@@@@A@@@@@A@@@AA@@A@@AA@@@CA@@CAA@A@@@A@@@A@@@@@@BA@@@@@A@@@A@@@@AA@@@@@@@AA@@A@@@@@AA@@@@A@@@@@AA@@A@AAC@@@A@@A@@A@@@A@A@@AA@@@A@@AA@@@@A@@A@@AAB@@A@@@A@@@@@A@@@AB@@A@@@AA@@@@A@@@A@@A@@A@@AA@@@AA@ACAAA@@AA@@@AAAA@@AA@@@AA@@@@@@A@@A@@A@@@@AA@@@AA@AA@@@AA@@@AA@@AAAA@@A@AAAA@@A@@@A@@AA@@@A@@BA@AB@@ABAB@BAB@@@BABABAB@@@B@@A@@B@@A@@BA@@@A@A@A@@@@@A@ABA@A@ABE@A@C@A@A@A@@AA@AA@AA@AAA@@AA@@@AAA@AA@@A@@AA@@AA@A@@@A@@@A@@@@@@B@B@BA@@@ABA@A@@BA@@BA@ABA@@@@BA@@@A@A@A@A@C@CAA@@@A@A@@@A@@@A@@AA@@@@@A@AA@@A@@@ABA@A@@@@@AB@@AB@@A@@BABA@ABABA@@BCB@@AB@BAB@B@@@@AB@@@@@@A@@@A@A@ABA@@@@@A@@@@@AB@@@@@B@@@@@D@B@@@@@BA@@@@@@@@@A@A@@@@@@@@@A@@@AAAAAA@@A@@A@@@@@A@A@@@@@A@@@@B@@@@@@A@@@@@A@@@@@@AAACAAA@@A@@@A@@AC@A@@@A@@A@@@@@@@A@@AA@A@A@@@A@@@@@@@A@@A@@EC@@A@AA@@A@@@@A@@@@@A@A@@BA@@DCBABAB@@@BAB@@@B@@@B@B@@@B@BAB@@@@@@@@@AA@AA@@A@@AA@@@AA@AC@@AA@AA@@AAA@@@A@@@AB@@@BA@@BABA@@B@BA@@BA@@@@@A@AB@@A@@@@@A@@@A@@@A@@@ABC@@@AB@@A@@BA@@@A@@@A@@AA@@AA@@@@@A@@@@B@B@D@D@D@B@F@D@B@B@@@@@@@@A@@@A@A@@@@@A@AB@BCBABA@A@ABAB@BA@AB@B@BAB@@@B@@@@BB@@BB@BBB@B@B@@@@@B@B@BA@@@@BA@A@A@@AA@A@AA@@AAAAA@AA@@@@@AA@@A@@AA@@@CC@@AC@A@@@AA@@@AA@AA@AAA@A@AA@@A@AAC@AAC@A@@AA@A@A@AAAACAAAAAAAAAA@@AA@@A@@@A@A@A@@@A@C@@@A@A@CB@@A@@BABAD@BAB@@@D@BAD@D@B@@AB@BBD@DBD@DBB@B@B@B@@A@@@@@A@@@@@A@@@ABA@ABA@@@@@A@@@@@@A@@BA@@@A@A@AAA@A@@@@@@A@@@@BA@@DABCB@@@BA@@B@@AB@@@B@@@B@@@B@@AB@@@@ABA@@B@@@BA@@B@B@@@@@@@@A@A@E@@@A@A@A@AB@@A@A@A@A@@@A@A@AAA@@@@@@A@@@@@@@A@A@A@A@A@@@@@ABA@ABA@A@A@@@A@@@A@@A@@@@@ABA@A@@BA@AAA@@@A@A@A@A@@@A@@@A@A@A@@@A@@@AB@BABA@@B@A@@@@@@@AA@@AAA@@A@A@ABE@A@C@A@A@A@A@@@A@@A@A@@@A@AAA@A@A@C@A@ABA@A@A@A@A@@@A@@@A@A@@@A@@@A@AB@@@A@@@@@A@A@@@@@AA@@AA@AAA@@@AAA@A@@AA@A@@@@AAA@AAA@A@@@@@@@A@@B@BAB@BAD@DA@@BA@@B@@@@CBAB@@ABAB@BA@@@@B@@@B@@BB@BBB@B@B@B@D@B@@@B@@@BA@@@A@@@@@CAC@A@A@A@@@@A@@A@@@A@C@@@A@@BCBC@@BA@@BABAB@@AB@BAFAD@DAB@B@@@@@@@@@A@@AEAE@CAC@AACACCAAA@AAA@AAEAAA@@A@@A@@AA@@AAAAA@@AA@A@@@AA@@A@AACAAAAA@@AA@@@@AA@AAA@@@A@@A@C@A@@@A@@@@AA@AAAAA@@@A@A@A@A@@@@@@AAA@@A@@@AAA@@@A@@@@@A@@@A@CB@@ABA@CBCBA@@@A@C@ABAA@@A@A@@@A@AAA@AAA@AA@@AAAAAAAAAA@AA@@A@@EC@@AAA@CA@@@@A@A@A@@@@@AA@@A@@@@BA@@@A@@@@@AA@@A@A@A@A@@@A@A@@@@@A@@@@@@@A@@@@AA@@@AA@@A@A@@@A@A@@@A@@@AAA@A@@@@@A@C@A@A@A@A@@@A@A@A@A@A@@@A@CB@@A@@@@AA@@@@AA@@AA@AA@@A@A@@@A@A@@@A@@@A@A@A@C@CBA@@@@@AACA@AA@A@@AA@@@A@AA@@@@AA@@AAA@@AA@@@AA@@@@AA@@A@@@AA@@AA@@@A@@@@@A@@@@AB@@A@@@A@@@AAA@A@@AA@AA@@A@@@AAA@A@A@AAA@@AA@@@@A@@AA@A@@A@@AA@AAA@@@A@A@@@A@@@@@A@A@@@A@A@@@A@ABA@CBA@A@A@@@A@@BA@@@A@@@A@@BA@A@@@AB@@A@A@@@@@A@@AA@AAA@AA@@AAC@C@@AA@@@A@A@A@A@@@A@@@CA@@CAA@EAA@@@@@@@ABA@A@@BA@@@ABAB@@@@A@@B@@@@AB@@@@ABA@@@@BA@@@@@@BAB@@@B@@@@A@@@A@AB@@@@A@@@A@@B@@@@AB@BAB@B@@@@@B@B@B@@@BA@@@A@@@A@@@A@@B@@A@@@@@@@A@@@A@@AA@A@AAA@@@@@A@A@A@@@A@@@@@A@@@@@A@A@A@@@A@AB@@A@@@AA@@@@A@@@@@@AA@@@@B@@A@@@@@ABA@@@A@@@A@@@@@AB@@A@@@@BA@@@A@@@AB@@@@@B@@A@@@A@A@@@A@@@@@@A@@@A@@A@@@AA@@A@@A@@@AA@@AEAAAA@@@@@AA@AA@@@CAAAA@CAA@CAEAA@A@@@@@A@@BA@ABAB@@A@@B@@A@@@A@AB@@A@ABA@@@ABA@@BA@A@@BA@@B@@A@@B@@C@@@A@@BA@@@@@A@A@@@A@@@A@@@A@A@@@@@A@@@@B"],encodeOffsets:[[130823,45143]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("鹤岗",{type:"FeatureCollection",features:[{type:"Feature",id:"230402",properties:{name:"向阳区",cp:[130.294235,47.342468],childNum:1},geometry:{type:"Polygon",coordinates:["@@K@GAA@EDCBCC@EAH@@@D@D@D@B@B@D@B@B@DAD@B@@AF@B@B@@ADCLE@EBAAA@@@@BA@BBA@@@@BCBABA@@@F@FDFDBB@@J@B@B@@@DBB@@B@B@@@D@D@@B@@ABAD@BAB@B@@@BA@@@A@@@A@@@CAA@A@A@A@C@A@@B@@@D@BAD@B@DADAB@@@B@@BBBB@B@DB@A@@@@BA@@@A@A@@A@@C@A@A@@AA@A@AAAAAAAA@AAA@@@@@BAD@@@BAB@B@@@@@BA@@F@@@AE@C@C@@DAFCMEGACAC@"],encodeOffsets:[[133432,48458]]}},{type:"Feature",id:"230403",properties:{name:"工农区",cp:[130.274684,47.31878],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@AA@BCAC@CA@DABA@AB@@@B@DBDBFDDBFDJ@F@B@BDBFBHFFFDBBDBB@BA@@BBBABABCDD@HB@@@@B@@@@@@@B@DB@@BDBH@BBF@FABCDCD@HB@@@@@B@BADA@A@@B@AAB@@A@@B@BBFAF@DKBC@@@A@ABE@@@ABC@C@A@A@C@A@A@C@C@C@@BGDC@@CA@@C@A@A@@@A@AAG@C@AA@@AM@CAA@A@A@@EGCCAAIE@@@@@AA@AA@@ABA@CAE@"],encodeOffsets:[[133379,48426]]}},{type:"Feature",id:"230405",properties:{name:"兴安区",cp:[130.239245,47.252849],childNum:1},geometry:{type:"Polygon",coordinates:["@@[GG@MCIBM@A@@AAD@BAD@B@DAB@BAF@DAB@BAFAB@B@@CF@B@BABEAyGWrUIMJRNFHMDJJCFGEcBEJK@SCDGAKPIiYIFQIA@AB@@A@@@A@A@A@@BA@A@A@@@A@A@A@A@AB@@A@@@A@A@A@@@A@A@ABA@@@A@A@ABA@A@A@AB@@A@A@ABA@A@A@@BA@A@ABA@A@CBA@CBA@CBA@C@ABC@CBA@A@A@ABA@ABA@A@@BA@A@@@A@A@C@C@A@A@A@ABC@A@A@@@A@A@AA@@A@@AA@@@A@@@@@ABA@@@@@A@@@@@AAA@@@@@A@@@@@AB@@A@@@A@A@A@@@AAA@@B@@A@@@AB@@A@@@@AA@A@C@A@@BA@@@A@@B@@A@@B@@@@@B@@@@AB@@@@@@@@@BA@@@@B@@@@AB@@@@AB@@@@A@@@AAA@@@@@@@A@@@AA@@A@AB@@A@@@A@@@A@A@A@@@AB@@@@@B@B@@@@@B@@@@B@@@A@@@@@AB@@@@A@@B@@A@@@@@A@@B@@@@@B@@@@@@@B@@@@@B@@BB@@@@@@AB@@@@AB@@@@@B@@@B@@A@@B@@A@@B@B@@@BAB@@@@@@A@ABA@@@AB@@A@@@A@@AA@A@@@AB@@@@@BA@@@@B@@AB@@A@@@A@@@@@AB@@@B@@A@@B@@A@@@@@A@A@@@A@@@A@@@@@A@A@A@A@ABA@A@A@AB@@A@@@è¶¢ID@`mn{\\aLX@F^VRCXCBABCVHBAVJDD@BJDHGL@JDEHRFFEJJDADA@@BABAFCDEB@@@@AB@@A@@A@@@ACCEAACKB@@BB@@@B@BADAB@@@DABAB@D@BABALADAB@B@JFF_@AF@H@D@J@JFBBfIVABC[QTW_W@GICBYWMMGGA"],encodeOffsets:[[133416,48308]]}},{type:"Feature",id:"230404",properties:{name:"南山区",cp:[130.286788,47.315174],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBKBABABC@A@ABCB@@A@CBABA@@@A@@AA@DLBBDFBD@@B@@@@BA@@B@@A@CFEDABAB@@CBCBCDAB@@@BDBD@DB@ABBD@F@DBB@BA@@BBB@@B@@@@JFBBDDFH@@@B@BBB@DBN@@BBD@H@BBB@@@B@B@D@@@DB@@CD@FDDDAFCB@HBL@D@DBHBNF@@BA@@@A@@@AAA@@@A@@@A@@@@@@AAA@AAA@@AAAAAEAB@BE@ABC@A@@@A@AA@C@A@AA@@DE@@@AAA@@@AA@BADAB@BAD@@C@@@A@A@C@@BEBAD@@@AE@A@AAA@A@AA@@AAA@@AACA@@@ABABC@@@A@A@@CC@A@@@@FC@@@CA@@AECCACA@@@AEI@A@C@A@A@AAAIEI@C@G@E@@BE`IEA@A@"],encodeOffsets:[[133402,48390]]}},{type:"Feature",id:"230406",properties:{name:"东山区",cp:[130.317002,47.338537],childNum:1},geometry:{type:"Polygon",coordinates:["@@MWs@GbiABM_LEB@JERaAEH@D@FAL@HZ@BCH@ADA@GBW@@VIDAPSNA@B@BABA@ABADA@A@A@A@A@A@A@A@@@A@A@@@@@@AA@@@@@@A@@@@@A@C@IBC@C@KBCBE@GBC@A@@@@@ODA@AA@@@@B@@A@A@A@@@A@@@AAAAAAACCAA@@@A@A@AAA@A@@AC@CAA@ABA@A@ABA@C@@@C@@BA@@DABABABAB@BABAB@BABA@C@ABC@E@AA@A@KB@@C@A@@A@@A@CA@AC@AAA@@@OAA@ABA@C@A@C@ABG@EBA@A@CBC@E@@@@@CBA@c@GB@@@@@AAA@@A@@AA@@BA@@BABA@@B@@A@A@AAA@@@A@@@AD@BA@A@C@C@@@CB@@@@A@@B@@ABA@@@@BC@A@@AA@A@@B@@A@A@@@A@AB@@ADA@@@A@A@A@@@A@AB@@AB@@AA@@@@AAA@A@@@A@@@CB@@@@AA@@AAA@A@@@A@@B@@@@@B@BA@@@C@@@AB@@@@A@@@A@@@@B@@@@@@@@AB@@@@@@@B@@A@A@B@A@A@AB@@@@AB@@A@@A@@A@@@A@@@@@@@@@@@@@@@A@@@@@@A@@A@A@@@@@@@@B@@@AA@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@AB@@@@AB@@@@B@@@@B@@@@B@@@@@@B@@@@A@@@@@A@@@@@@@@@@@A@@@@@@@@B@@A@@@@@@@@B@@@@B@@@ABA@@@@@@@AB@@A@@@@@AB@@@@@@AA@@@@@@AB@A@@AB@@@A@@A@@@@@@@@@A@@@@@BAA@@@A@@@@@@AA@@@@BA@@@A@@B@@@@@@@A@@@@A@A@@@@BAD@@@@A@@@A@@@@@A@@@@BB@@@@@A@@@A@@@A@B@@@@@@AA@@@A@AAA@AAA@@B@@A@@@A@@@AB@@@@@@ABA@@@@BA@A@A@@@A@A@@B@@@B@@@@A@@BB@@@AB@@CA@@@BA@@@AB@@@@B@@BA@B@@@@B@@@@A@A@A@@@@@A@@@@B@@@@A@@@C@@@@B@@A@@B@@@B@@@@@@ABA@A@@@@@@B@@AB@@@@@@ABA@B@@B@@@BB@@@AB@@@@@B@@@@A@@@@@@@AB@@A@@@@B@@A@@@@@AA@@@@@@A@A@@@BB@@@@@B@@@@A@A@A@@@@B@@@@@B@@A@@B@@@@A@@@A@@@@@@BA@@@BB@@@@@B@@@BA@@@A@ABA@@@A@@@A@@@@@A@@BA@@A@@@@@@A@A@@@ABA@@@@@@BA@@AAAB@B@B@ECaCDGJEQUS@S@SBO@MMEGDUHGHE]IT]BAHIVFBC[KTI_OIDKD@D[HKF@DED@FOHDHJDEJDJDLBBELFPDLFNHAFD@FIDE@T^MDc^dpC|OPSNM@@@@BB@@@@@@@B@@@AB@@@@@@A@@@@@@@@@@BA@@@@B@@@AB@@@@@@@B@@@@@B@@@B@@@@B@@B@@AAA@@@@@@B@@A@@@@B@@@@@B@@A@@@@@@@@BB@@@@@@@@@B@@@AB@@@@@@@@@@@@@@@@@B@@@@@@B@@B@@@@@@@@@@@B@@B@@@@@@@A@@@@B@@B@@@@@@@B@BB@@@@@@A@@@@@@B@@A@@@@@@@@@A@@@@BB@@@AB@@A@@@@@@BB@@@@A@B@@@@@@B@@@@@@@@B@@@@@@@@BB@@@@A@A@@@A@@@@@@@AB@@@@@@@@@@@@@B@@A@@@@B@@B@@@@B@@B@@@@A@@@@B@@@@BA@@@B@@@A@@B@@@@@@B@@@@@AB@@@@@BB@@@BB@@@@B@@@@@@@@B@@@@BB@@@@@BAB@@B@B@@@@@@@@@@B@@@@@@@@@B@@A@@@@@@AA@@@@@@B@@@@@@@@AA@@A@@@@@@@@@BB@@@@A@@@A@@@@B@@@@@@@@B@@@B@A@@@@BA@@@@@AB@@@@B@@@@@@@@@@B@@@A@@ABB@@@@@@@@@@@AB@A@@@@@@@@A@@@@@A@@@@@A@@@@B@@@@B@@@@B@@@@A@@A@@@B@@@@@@@@A@@@@@@@A@@BB@@@@@B@@@@@@@B@@@@@@@B@@@@@A@@@@B@@@@B@@@@@@@AB@@@@@@@@A@@@@@@B@@B@@@@@@@@@@B@@A@B@@@@@@@@B@@B@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@A@@B@@A@A@@@@@@@@@B@@@@@A@@B@@@@@@@@BBA@@@@@@@@@A@@@@@@@AB@@@@B@@@@@@@@@@@@@@@B@@@A@@B@@@BA@@@@@AB@@@A@@AB@@@@@@@@@@A@@@@@@B@@@@B@@@@@@@@B@@A@@B@@@B@@@@@@@@@@@B@@@@@@@B@@@@A@@@@B@@@AA@@@@@@@@B@@A@@@@@@@@@@@@@@BA@B@@B@@@@@B@@@@A@@@@B@@@@B@@@@@@@@B@@@@A@ABA@A@@B@@A@@@AB@@AB@@@@A@@@AB@@A@@@RJJEjZOJBLCHTDL@FIdAHFDEIINCEGQMNIVJXqzHFBBA@A@ADE@@@ABABE@ABA@CBE@ABA@C@ABC@ABC@BB@N@JANDH@\\HHBNHXNAZJD@H`XSX\\RADUBeJ@B@B@B@D@BFJ@B@@DBDBFD@BB@@D@@ED@@@@@BDD@@@B@B@@ADAB@B@@DBBB@@BB@BB@@B@BBB@B@BBF@@C@ABAF@@@D@B@B@@@DC@ABA@CBABB@@B@@BB@B@@CF@@BBB@D@B@@B@B@@@BAD@BAFA@FBBBBB@BB@BBB@BB@@@@@@@B@@@B@@BB@B@@@B@@AB@@EDCB@@@D@DBF@@E@@@AB@@@@A@A@AB@@C@AB@@@@B@BBB@BBBBBB@B@BBB@@@B@B@DB@@@@B@B@@AB@@@@@BCAA@A@AA@AA@@@@B@@B@@@@BB@@@B@@BB@@@B@BB@@B@@BB@@B@@BB@@@@B@@B@@B@@@@@BBBH@@@@BF@@BDBBBBDF@@BD@@B@B@JCVIFAD@B@DAD@@@@@@E@@@ABBD@B@DA@@@@@B@@@BAB@BDDB@A@ABABADABAB@@AB@B@@CDABA@@B@BBB@@BDDB@BDDBD@F@B@DBB@@DD@B@@@B@B@B@BABAFCD@B@B@FA@A@C@GAC@@@ABC@@@A@@D@@@@A@A@AAA@CBA@A@GCA@A@C@ABABCD@@C@A@EACCAA@@AAAA@@AAAC@@@@BAB@@A@@@@@@CBA@A@@AA@B@BAAAA@@A@AA@@@@AA@@@A@@@@@@AA@@BA@@AA@@@@@@B@@@@ABEAA@@AA@@@CAC@@@@A@@A@AAA@@A@A@@CAAAAA@A@A@@AC@@@@@CAA@A@A@@AC@@@C@ABC@@@C@A@AD@BAD@@@BA@@@C@@AA@A@@@C@C@@@A@AA@CA@@A@A@I@@@AAECECE@@@A@@GDCDCBA@EAE@AAGAC@@CAA@@@@@@@A@@@@@GAC@DCBABAAA@AB@@AAAACCAEEGEEACA@A@A@ECIAECCAEAC@C@A@@BAB@BA@C@A@@BADCIIEFQEFGICK@GHIC@ACCUIABUGADABWDQD]U@EKW[bm|_nC@¡Jçµ@AA@@@@@AAAA@@AA@@AAA@@@AAA@@@A@A@@@A@@@@@A@A@A@A@A@@@A@A@ABA@A@ABA@A@ABA@@@@@@BAB@B@D@@AB@B@@@@@B@BAB@@@B@@@@@B@@AD@@@B@BA@@B@D@D@B@@@@@B@B@@@B@@BB@B@D@B@D@@@B@@@B@@@B@@AB@@A@@@AB@@A@A@ABA@A@@@@B@@A@ABA@@@@BA@@B@@A@@@@BAA@@A@C@A@A@C@A@ABC@A@A@A@A@A@@@AB@@AB@@@@ABA@@@@BA@A@E@@@{VWDEFBJBHBBHDT@BBzAH@FRCFEJ@HPJPDBDADIBKAG@E@GHGN_V]JSHSTADBBBDADED@DBRBFBDFBNDJAH@RFPHXL@@D@D@FAB@B@B@DAB@@@B@BA@@B@@@BA@@@@@@BB@@@@AB@B@@AB@BABA@@B@@AB@@@BB@@@@B@@B@@B@@A@@BA@ABAB@@@@@@@B@@@B@@@@AB@@@@@B@D@@@@A@@B@@A@A@@@A@@@AB@@GFADAFAFBDBFFDL@HAFBBB@DADCBOB]JEDABAD@DLfBLBHAHCFCDIFAD@BDD`RJLFFNDHDH@FALGJAB@B@DA@AB@@@BB@@B@@B@@BB@@BD@@BB@@@@@BBB@B@@@BB@@@BBB@B@@AB@FA@@@@@@@@@@@@BB@@@@@@A@@@@B@@@@A@A@ABA@A@AAC@@@ABA@A@ABA@A@@BAB@@A@AB@@C@ABA@A@C@A@E@GCE@G@EDAFCLGZBXBJDBTFLHFHDL@JdLZTpJbIlKZa²Y@pKSrD|VrUdQi~B~O]bO^IjE@AB@@A@AE@@@@@@AHC@A@A@AA@AAA@BA@@DA@ABADE@ABC@CB@FG@@@AAAGA@A@@BADAD@B@BAAAaBUYNkOMPiVQ@CFAzCBC`FCC@@BAFADAB@DEAACC@CEBI@@@A@GAEAA@EL{AIEYYhaMIJESOQKMGLKZEMODAzQAVA\\TJEFEBCDCBA@@AA@@@A@@B@B@BA@@@A@@CA@@@A@AA@@BA@A@@@@@AAB@@AD@B@@A@@AA@@@ADI@AFAB@B@F@BAB@BA@@@AAA@@@@DA@ADA@@FAB@@ABA@@AAA@@A@@BA@@@@BA@C@@AA@@BCBA@@BBB@@AB@@@B@@@B@BAB@BA@@B@B@@A@@B@@@BAB@@@@A@@@A@@@AB@BA@@@A@@@@AAB@A@@@BAA@@@@A@@@@@AB@@A@@BBB@B@@@B@BAB@B@@@FB@@@A@@AA@@@@@A@@@@B@@@@@@@B@@A@@A@@A@@@@B@D@BB@@@@@@@C@@@AB@@AB@BA@@A@@@B@B@@@AAA@@@B@@@B@BB@@@A@@A@@A@@@@B@@BB@@B@@@@B@@AB@@@@@@@BB@@@@B@@@BABAB@@@A@@AB@BBB@B@@@@A@@@@B@@A@@AA@@@@@ACBA@A@@@@@@A@@@@B@B@@@@@@A@@@@@@B@@@B@@@AA@@A@ABA@@A@@@@BAB@@@@@A@@@AA@@A@@@BAB@@@@AA@A@@@@@@@@@B@@@B@BB@@BA@@A@@AB@BB@@@@@@B@@@D@@A@@@AB@D@@@@AA@A@@@@@A@@@B@D@@@BA@@@@@@B@@AA@AAA@@@BA@@@@@AB@@@@@@BB@@@B@B@@@@@@@@A@@AA@@@@@@BAD@@@@@BBB@@@@@@@@@@A@@@AD@B@B@@@AAB@@@@@@@BB@@@B@@BB@@BA@AB@@@@@@AA@@@@@BA@@@A@@@@B@BB@@B@@AAA@@A@ACB@@@@@B@B@@@@@AAA@@@@ABA@@C@@@@@@AD@@@CA@AB@B@@A@@@@A@A@@@@@A@@A@@D@@@@A@@@@@@B@B@@@@@@@@AA@@A@@@@D@B@@@A@A@@@@@@AB@B@@@@@@@@@@@B@@BB@@@BA@@@@B@@@@A@@BA@@B@@AAA@@B@@@B@@B@@@@@A@@@A@@@@B@@@@BABB@@@@@@@BA@@@@@BB@@@@A@@@A@A@@A@@@@@B@@@B@B@@@@@@@@@AAA@@@@@B@@@B@B@@@@@AAA@@@@@B@@ABB@@@@DD@@B@@@BB@@@@BA@@AA@@B@B@@@B@@@@A@@B@B@@@@BB@@@@AB@A@AA@A@@@@B@@BB@B@@@@AA@@A@@@AB@@@@A@@B@@@@@@D@@B@BA@@@@AA@@@@@EB@@@B@@@B@@@B@@@AA@@@AB@DB@@B@@@@@@A@A@@@AB@@@@@AA@@@A@@BAD@@@@A@@B@@A@@@@@@B@BBB@@@@AAA@@@A@@B@B@BA@@A@CACAA@@A@@A@A@@@@@BB@@@@@@@@A@CA@@@@@B@B@@A@@@C@@A@@B@@@B@A@A@@A@@@@@AA@A@ABA@@@@A@@B@@A@@A@A@@@@@@@BA@@AAA@@B@@A@A@@@@@@A@@AA@B@@@@@BA@C@@@@BB@BB@BA@@@@@AA@@C@@A@@B@@@@@AA@@@@@@@A@@A@@AA@@B@@ACA@@@A@@B@@@@A@@@@A@@@A@@A@@@@B@@@@AAA@@BA@B@A@@@A@@@A@@B@@A@AA@@@@@B@@@@@B@@AAA@@@A@@@A@A@@A@@@@B@B@B@@A@@@@A@A@@A@@@A@@BB@@@@B@@@@A@@C@@@@@BA@@@@@AA@@@@A@@BBB@@A@@A@@@@@B@BA@@@@@@A@A@@@@@B@@@@A@@@@@@B@DA@B@B@@B@@@@@BE@@BA@BD@B@@@@A@@A@@AAB@A@@@@@AC@@@AAC@@A@@@AA@AAB@@@BB@@@A@A@@@@@@A@@@A@@@A@@A@@B@@@@AB@@A@@@@@@A@@@@@@@A@A@A@@A@@B@@@B@@@@@@A@@A@@BA@A@ABA@@@A@@A@@@@@@B@@@@A@@@AB@@@B@@@AA@@@@C@@@@@B@@@@AA@@@A@@@AB@@@A@@@@@A@@@A@@A@B@@B@@A@C@@@A@@@BA@@@@A@@@A@B@@A@@@@@@A@@@@ABA@@@@@A@@AB@@@@@A@A@@A@CB@@@@@A@@@@@@CA@@@@B@B@@A@@AAA@@@D@@@BA@@AA@@AB@AA@@A@@B@BBB@@@B@@@@@AAA@@@BAB@@@@A@@@@A@AB@@A@@@@@BA@@@AA@@@AB@@@@A@AA@@B@@A@@A@@A@B@@@BA@@@@A@@@@@AA@@@@@@B@@@@@@A@@@@@AAAB@@@@B@B@@B@@CB@@@AA@@A@@@@@@ABA@A@AA@@@@AB@@BBA@@@@@@@@@C@@@@A@@AAA@@@@BBB@@@B@@@@A@BA@@@@C@A@@@@@@@BB@@@BA@@@@AA@A@A@@@A@@A@@@@A@@@@@BB@@@@A@CBOIB@@@B@BB@@B@@@BAA@A@A@@@@@@@D@BA@@A@A@@A@@@@@@D@@@B@BAB@A@@A@BA@@@@@A@@@@@@AB@@@@A@@A@@@@@@BAB@@@@A@B@@AA@@@@AAA@@A@AB@BA@@@A@@@@@@A@@@@AA@@@@BB@@A@@@A@@@@BA@A@@AD@@A@@A@A@A@@@@@DAC@CA@@@@@@B@@A@@@@D@@A@@A@^eTWJMNOVAA@@AFACA[MBCF@^EFEAEMEIASGDKKOg@a@k@m@SBID"],encodeOffsets:[[133659,48188]]}},{type:"Feature",id:"230407",properties:{name:"兴山区",cp:[130.303481,47.357702],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@A@@A@@A@@@@AA@CBCBA@C@ABC@@@A@@@@B@D@B@B@BBB@D@@@B@@@B@@AB@@A@A@ABC@AB@BA@@BBB@@@D@@AB@@C@ABC@@B@B@D@@AD@B@D@@BD@@@B@BBB@D@@@@BD@@@B@BBBBBDB@@@B@BB@BBB@@@@B@@D@DB@@B@@BB@FBBA@@@@@A@@@@B@@BB@@AB@@B@@@@B@@@B@@B@@B@@B@BB@BBABA@B@@BB@B@DA@@@@@@@BA@AB@@@@BDBB@@BBBB@@BBDDFBB@D@@@DCBABAD@B@B@HDB@B@DAB@BBB@B@@@@@@CB@@@D@BA@@D@HBD@B@B@@E@A@ADCBEBA@A@A@A@A@@@ACC@@AA@C@A@EACCC@ACAAC@@AA@A@AB@BADC@@@ABA@@BABABCBABAB@A@CC@ABA@A@@@A@@@@CBA@C@AA@B@@@F@@@@C@CBA@C@EBUJIDA@A@@@AC@@CEAAAAAC@@AE@@@@AGAA@@@@A@@@@AA@@@@@AA@@@AA@@AA@@@AAA@@@A@@A"],encodeOffsets:[[133437,48490]]}},{type:"Feature",id:"230422",properties:{name:"绥滨县",cp:[131.852759,47.289115],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@C@EAC@ICIAAAiGKCA@E@O@O@A@E@KAG@M@E@E@I@GDMBK@GB@@SBEBKD@@CBC@CDEB@BE@CBA@OHA@C@@@A@A@A@@ABA@AFE@C@@AAA@A@A@A@GDABA@@@G@CBG@CBCBA@@BCBA@@@CBC@CBC@A@A@A@GCGGEGECCCGEAAMEMEOA@@K@C@@@A@CCCCCAC@M@A@E@K@@@A@CAC@MGICC@@ACACCIICAEGAE@@IKEEIG]IUEYAI@@@E@CBA@CBA@C@CBABA@ADCB@BI@IBA@CAC@A@E@EAC@A@GBCBA@EDGHEDEBGBEBEBG@I@E@A@A@GDC@ABABCFGDGBEDCBCDMF@BSHY@K@O@U@SCBHBHBHDFoFE@H½LaD[BOPLTQJBVZNTGBN@HBHHR@D@H@Aj@\\@^@|AH@D@F@B@JBAn@B@D@BBDB¤BGFGPÖ@ARP@S~y@@CC@MP@PTFfFFFlJGL`FHO^HBCdJDRXFHFHdM@M@AT@BRDdP@NAPCtKI@HGFKA@RV@´~NZFNP^@@@@lLTFLjAXAfJnb^`XVLFdDzIYnQxOTAV@°TNARG~OZAJAL@NDHFRRTvBBrFVCpOxCpB BZDxNVBbA^GH@|PV\\LTjfJl@NCgªg°SCGEEAECACECC@CAACA@ACIA@KAEAG@_CQAQCCCCAACAA@E@CDGHOHIDCBEBABABC@@BC@AB@@A@@BCBA@A@A@C@AGKIMCCCCKCMEGAEAGEACBCBG@GBC@A@CAACCCACEEGAA@C@C@CAIEGACIOAABIBEAECCCCGAK@O@GAIAGCSIGEGGEGCEAEAEAE@CACCAIIOIYKMCGCCGCCA@AAC@E@KEGCECAA@E@A@ABC@C@CACCEECEAE@M@E@EAGAEAECCCKKCCCCECCAKCIEEEQK@@KIGICAEAE@M@CBAAQDUBQAMAaEIAGAIECEAAAECAEAeGMCA@CAA@AAAACAAACA@@EA@AA@A@CAA@@@YGOAICCAEECCEIAI@M@UBKBAHEREBABA@A@A@A@C@@ACAA@@CA@@A@AAE@I@CAAC@A@EGWAA@G@C@QA@@AAAAAAAAA@@AAAAEGECEMEAAAA@OA@@@@CA@@AAAAA@C@A@ABGBC@CBE@IAE@G@C@K@A@C@A@CCACKAAASAQCOAQDIFGJADCF@BUTUPIDQ@OCKCCAIASA@@MAGAKAMAG@MAKBUBI@C@GDA@E@CA@@CAGCA@@@AAGGAAAAAAC@GAA@A@A@@AECECAAA@@@@A@CA@CCAAIGCAEAGAE@A@A@C@KBABA@]HIBA@MB"],encodeOffsets:[[134847,48383]]}},{type:"Feature",id:"230421",properties:{name:"萝北县",cp:[130.85155,47.576444],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAAAC@A@m@BIAA@E@C@G@{B]@[@i@BG@C@Q@GAG@GAMHSYMAURIKSPO\\AbC¾KGF@pECEAGAGAGECGAKAOCEECEAKCICEQEKAE@K@KFOLMHQHABEBWHK@]@]DcDQFKBIBE@CAICABELEHCDCBCB@B@@D@B@@@@B@@@@@B@AA@@@AA@@@B@@B@@@@@AB@AA@@B@B@@@@@@@BD@@@@@B@@@@ABB@@@@@@@@B@B@@A@@@@@@@@B@@@@@BA@@@@@@BB@@@@B@@@@@@@A@@B@@BB@@A@A@@@@@@@B@@@@B@@A@@@@BB@D@@@@@@A@@B@@BB@@AB@@B@@@B@@@@B@@@@@@ABB@@@@@@@@@B@@@@B@B@@@BBBB@@@B@@@@@@B@@@BBB@@@@@B@@@B@B@@@B@B@@@BB@@@@@@B@B@@@B@B@BB@@@@AB@B@@@B@@A@@@A@A@@B@B@@@@@@B@@AB@@B@@A@@B@@@@@B@@@@@@A@A@@@A@BB@@B@B@@B@@@@A@A@A@@@AB@@@@@@@B@@B@B@BBD@@B@@@B@@@@@@@@A@@@@@BB@@@@@@D@@@@@@B@B@@@BB@@@@B@@@@@@A@A@A@@AB@@@@@@@@AA@@@@@A@@@@B@@@@@B@B@@A@@B@@A@A@@@@@A@@@@B@@A@@@ABA@C@AB@@@@BBFBB@@@B@AB@@A@@@@@AA@@A@@@A@@B@@ABA@A@AAA@A@A@@@@BBB@@@@AB@B@@@@@@B@@@@@B@@@BB@@@@@@@@A@@@@B@@@@B@@@@B@@B@@@B@BAA@@@AAB@@@@@@@@AB@@@B@B@@BB@@@@B@@A@A@A@@@@BB@B@B@@@@BA@@@AD@@B@@@D@@@@@@@@BAB@@B@BBB@@@DBB@@B@@A@A@A@@@@@@@@BB@B@@@@@@@@BA@@@AA@@@@@@@B@@AB@@A@@@@@AA@@A@@@@@ABA@@@BBBA@@@@@B@@@@B@@@@BA@@B@@B@B@@@B@@@@@AB@@A@@@AB@@AA@@AB@B@@@@BA@@@B@@@B@@B@@AB@@@@B@B@@AB@@@@B@@B@@A@AB@@A@@@@@@@@@@@@B@@@@@@A@ABB@B@@@@@@@A@@@@@@B@@@B@@@@@@B@B@@@@@@B@BABB@@@B@@@@@BB@@B@@@@@B@@@@@A@@AB@BB@@BB@@@@@@B@@@@@B@@@AB@@@@@@ABB@@@@@BA@@B@@B@@@@AB@@@@@@B@B@@@@@@@@@@BB@@@@@AB@@@@AAA@AA@@C@C@CAC@C@CAE@EAC@C@CAC@A@CAE@CAA@E@CAC@C@EAE@IACAA@C@EAG@EAG@EAE@GAC@IAC@EAE@CAG@EAC@EAC@C@EAE@CAE@A@EAE@C@CAE@G@EAE@A@E@KAYAK@WCE@QFGDEBGBGBE@C@IF@@A@OHMBGDYF[HKBCBABOJOHIFCB@@IBGBC@OBSBA@M@CBE@EBKBE@GBE@@@A@AAA@A@A@A@@@A@A@A@AAA@@@A@A@AA@@E@EAYC_EQAGA@@ABA@A@@@@B@@BB@@ABCDADEFIF[SUBByRCBNPYFKLNHRLTPIFNJgbZZJF|BFKB@FBHBB@@@J@FA@DDDBBCFA@CBEBAB@@DD_EADyDEB@DUROjPNMlVZbABBABA@C@CBAB@@@BHBBB@B@@EHA@@DAD@BCFAB@BCB@@ABB@BBB@@B@B@BGD@B@@@@F@@B@BA@@BiF]JaP^}P}AjcRqV{UqCToL@±ZYbkLaJoIYScKCH@DBFLFJJBF@DKPa\\KDCDADBDDDBFEVBDLLLPBF@DGNKHw^¿`EDIN@LCHKDeDK@AB@FL`@FCPCFABEAG@G@OHCJCJBh@F@@A@A@@BA@@@A@@@@@ABA@@@@BA@ABABABA@ABA@@@A@@@@B@@@@@@B@BB@@B@BBFBF@@BB@B@@@B@@B@@@B@D@D@@@B@@B@@@@FADEBE@SGUEK@KDOPIP@JBFPLHFFF@FE^GFOBQ@I@EF@@CPBFHFBFAFGDIDAFCPER@FFDNBJ@RCLAN@NFJBF@VKH@HBt\\PLJFBBAPCBQDEDEFADYNQDEBA@BBJJFDBHATEVCFBFDH@HALCFJPFB@B@DGBMFEHAF@HJRFFF@dFJFPHVRJPRJBD@DIBCFAFBD`TDDAHIJAH@FBHHNDLAXEJKLYNIHAD@DDDhVTDP@LCNBNDJFLJJPNNBHCNEJBNFHJFFBFFJBLCDKDAD@D@FBFBD@FADCLKDGVIRKVQBEDADAJ@D@LGPOLKNI@A@AAK@EBADCFE@A@C@C@A@A@A@@@AHAB@BA@ABA@@H@HCDA@@@AAAB@@A@@NCB@BABC@@BAH@JCF@BA@@AA@AB@DAD@D@@ABAFG@AB@H@@@@@@@@AB@B@B@B@@@B@B@@@@@@A@@D@@@@@@AA@@@@@B@@A@@@@@@@@@ABAB@B@@@@A@@D@@A@@@A@A@@B@@@@AB@B@@@B@@AA@A@@@@A@@@@D@B@@ABAB@B@@@@@@@@@@A@@A@@@@C@@@@BAB@@@@@B@@@@@DB@AB@@@B@@@B@BA@@@@@AB@A@@@@@@A@@BAA@@@B@@AB@D@B@B@@AB@@@B@@@@@@A@@@@B@B@@@BABA@@BA@@@A@@B@B@@A@@@A@@@@BA@@B@@B@@B@@@B@@@@A@@@@A@@@@@@AB@@@@@@A@@@@BA@@@ABA@A@@@@@A@@@@@A@@@@@@B@@@@A@@BA@@B@@@@@AABA@@B@@@@@@BB@BA@@@@C@@A@@@@BA@@@@DB@@@@@A@AA@@AB@@@B@@@@@AA@@@@B@B@@@@@@A@@A@A@@A@@@A@@B@BABA@@F@DBB@DBD@B@BABA@@B@BBB@@@@@B@@@@B@@B@@@D@@A@@D@B@@@@@@A@@@A@@B@BB@@@@B@@@@A@@B@BBB@@@@B@@BB@@BB@@B@@@@@@@@AFB@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@BA@@@@@@@B@@B@@@@B@@@@@@@B@`RB@@@BCB@B@BBBF@@DB@@@B@@AB@@@@BBF@D@B@@@BDBB@B@B@BBB@@HBJFD@B@B@FAJEDAB@B@B@BBDDB@@@B@BADADAD@B@FBB@@@HAB@F@@B@@BB@@@@B@D@JAB@DAD@FBD@DANEHAB@B@@@BB@@AN@BBB@BBBB@NFHBB@@BBB@@ABABA@G@KBA@CBGDABAB@D@DBDBBDBDBB@@B@BEFCD@@@D@@BBHFFFFP@BDV@P@B@B@BA@I@ABEBEBCB@BA@DH@BB@@@BAFABA@@BBD@TJDBHHJJBB@B@BABEFOJCB@BAB@@@BB@@BB@D@D@B@@@BC@@@@B@BBBBB@JBNDB@BBBD@DBB@BB@H@^DD@XBL@D@B@FCB@B@N@H@D@D@FAFCFADAB@DBHBHBBB@B@B@D@B@DBBDHBD@@DBJ@BBBBB@BBB@DAFALEDAB@B@BB@@@D@LAHBF@BBBFDB@B@B@B@DAHGFEDADAHADAB@B@B@BBDHDJBB@@B@B@BAFCB@B@BBB@@BDFBDBBB@B@D@BADCBC@ABA@@J@H@DAB@B@@AHKJSBCBAB@DARAJA@AB@@C@A@@B@JDBBB@DAB@BCDEBCBAB@B@L@JBPBF@BBFDB@BBLBFBD@F@F@B@B@@B@B@BBB@@HDTFDBDAF@NENCL@DADBBBHDHBHBDBFANAF@D@B@BBBBDDBBHDFBDBH@F@BAD@FGDCBA@@D@B@PJFDBB@B@BABAD@B@B@@B@B@J@F@B@DBHDJFBBB@@@D@DAHCDAF@D@BBBDDJBJ@BB@B@B@F@VAJ@BBD@@@BAFCB@F@F@BFCH@DBDBBDDFBXJHF_VUNULQDKBIAGGISMS]GIQG[COESQQaESAMDgAKoYgyIQCQ@KDKJKZ[BKBWDOHKLGRGlIPCFAHGVa^[xWZO¾h_bslFMBIEFBMHM\\Y^OU¦I@LVDF@bJp\\NLfA|C^ApGF\\JvPfBZExGCTERKNIH[FqDInkEKKSk@@@@O]EMMY³}U@@QLBHE@GLJDsBO@McOQC@ABSN@N@GcGEWECQcIAD]GGP_EHKkIEEeESE@ONOD@@Dz@T}O@BQÕ@HOHE£A"],encodeOffsets:[[134436,48488]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,5 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("黑河",{type:"FeatureCollection",features:[{type:"Feature",id:"231102",properties:{name:"爱辉区",cp:[127.50045,50.252105],childNum:1},geometry:{type:"Polygon",coordinates:["@@omkaY[UWcaI_@cA{JUbaf[PSHO@MSOyAS@UFeFGgKoEC@KMYCUAU@gB_@U@]@SAGKCO@Q@GMEAC_IQIFI@GEMQISGMIUQUSGSYe`g@@C@C@C@A@A@AA@@A@A@A@AA@@A@@@A@AAIE@@AA@@@AA@@@@A@E@ABA@@@@@AB@@A@AAA@A@A@@@C@A@@BAAC@@AAA@AAAAAAC@@AA@@@A@@A@@A@AAA@A@A@A@ABAA@@CA@@AA@@AAAA@A@A@@AAABA@A@@@A@AA@@@A@@@A@AA@@@CA@AA@A@@@AAA@AAA@@@@A@@AA@@@@AA@@@AA@AA@@A@AA@@AA@@A@CC@@C@A@A@A@A@@@C@G@C@C@A@A@ABI@A@AAA@@@AAA@@@A@AA@@A@AAA@A@A@G@AAQ@C@C@A@CBC@@@A@ABA@ABA@@BEB@@ABA@@@A@A@CBEACBC@C@CAA@ABA@A@CBABCD@BABABABABABA@@@A@@B@@A@A@AB@@A@C@A@AA@@AC@@@AAA@AAA@@A@@AA@@@A@@@A@@AA@@@A@@@A@@@A@@@A@@@@@A@@@@AA@@@A@@@A@AA@@@@A@@@@@@AA@@@@@A@@AA@@@@@A@@@@A@@A@A@@A@@A@@@@@AA@@@@A@@@@@A@@@@A@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@AB@B@BBB@B@BA@@@AB@@CA@@@AAKEAACAA@A@AAA@EBE@E@C@A@E@C@A@AA@@A@A@A@C@A@A@A@A@CAA@A@C@A@AAA@A@AAA@CAC@C@E@A@A@CAC@E@C@AAAAA@@AAAA@AAA@@@AAAA@@@AAE@A@AA@@A@A@@AC@A@@A@AA@@@AB@@@BAB@@AA@A@AA@@AA@@A@AAA@@BC@A@A@A@A@@@A@ABA@A@@BB@@BD@B@DB@@B@@@@BA@A@@@A@A@@@A@@B@@@@@@C@AB@@@@@BA@@@A@AAA@@@@A@@AA@@A@A@A@@BABA@A@@@A@A@AAA@@@A@C@@@AAC@A@A@A@A@A@ABA@ABA@ABA@A@A@C@AACACAEAAAAAA@@@OGA@CAA@A@@@AAA@@@A@E@A@A@@@@BABBB@@BBBBB@@B@@B@@D@@CBAB@@A@A@E@@@CAAAECAACA@@A@AAA@AAA@A@@AA@A@@@A@@@AAA@@@A@@@AAA@CA@@@AAAB@@AB@BA@A@AA@AAA@@AA@C@I@C@AAA@A@A@A@@AA@A@A@A@AAA@C@I@A@G@G@E@A@AAA@CA@@AAA@AA@@@AAAAA@@@@BA@@BA@@BA@A@AB@@@BABA@@@C@A@A@@CMGKOOEE@@EAA@MG@@@AA@BA@@@ADAB@FCB@BAB@@@B@B@D@B@B@D@A@AAC@CAA@C@CAEACAA@CAAAA@AA@@@C@A@A@CBA@C@A@A@CAC@CAAAE@AACAAAAAAAACAC@E@IAG@EAE@CAEAEAECECIGEACA@@AA@AAAAAA@CA@AAA@C@A@AACAACAEAEA@@@@@AA@CACACCA@AACC@AAACAAACCAACAAAA@CACBA@A@A@C@CBC@CBE@A@A@ABA@C@C@ABA@A@CBE@CBC@CBC@ABA@AB@@ABA@@BABA@@@CBA@ABA@C@@BC@@B@@AB@@@B@@ABA@C@E@A@A@A@ABA@@@A@@@ABA@@BA@@@@@@BA@@B@@AB@@A@A@@@@@AB@@A@A@A@A@@@A@A@@@C@@@A@A@@@@BAB@BABABABABC@AB@@@@ABA@A@A@@@AB@@A@@@ABC@EBGBE@A@C@ABC@E@A@@BABABAB@@A@A@C@A@C@C@A@CBC@EBC@C@CBC@ABCBCBABABA@CDABADABAB@DA@ABEBABA@ABABADABA@EBEBC@CBC@CBABCDCDA@@BB@DBBBBDBB@BAB@D@@BBBBBB@B@@@BA@C@A@CBA@ABABABBB@B@BB@AB@B@@@B@@BBBBBBBB@B@BABA@ABCBCBABABAB@BA@@BABCD@@AB@BABABCBCBABCBA@@B@@DBB@DDBBDBDB@BDADBD@BBHBHBD@DBBDB@@@@B@@@@@@ABAB@@@@@@@B@@@@@@@@@BA@@@@B@B@@@@@B@B@H@@A@@BA@CBIF@@@@@B@@@@@@BF@@@BDF@@A@@@@@@B@@@@B@@@BB@@FB@@HF@@@B@B@D@B@@BBFFB@@@ADAJ@@@B@@@@GDA@A@@@@BGD@BAB@@@@GB@BABCBGH@@@@@BEBAB@@CJ@@@@@@@@@@@@@@EHED@@@BAD@@@@@@BDBB@@LH@@@B@J@@@@@@@@@D@@@@@@@@@B@@@@@B@@DBBB@@B@B@B@@@@@@@@@@@FA@@B@BBB@BD@BAD@DA@@@@@AB@@OH@@EF@BAB@@GB@@CBAB@@@@@BIFA@EBE@KB@B@@@@C@@@A@EF@@A@@@I@A@@BCBCBCH@B@@@F@@AD@@@@@@@@@@@@@B@@@@@@BB@@DDB@DN@@@B@B@L@@AH@B@@@@@@@@BB@@@@F@F@B@D@JBB@@@BB@@@@@BBBA@AJ@@@@@BB@@@@@@BB@BN@@@@B@@BBB@B@D@BA@@BABABEBABABA@CBE@A@CAA@A@E@C@@@@AA@A@@@A@A@A@ABAAA@@@E@A@C@@@A@AA@@C@ABA@A@A@A@ABA@E@AB@@GD@@A@A@@BA@A@@@CBAB@@AB@@AB@@@@CBA@@@ABA@IDC@A@E@A@A@A@@@ABEBABA@CBABAB@@A@@BC@A@A@A@@BA@C@@AEBCAEBA@@@C@CA@@AACA@@A@C@A@A@A@@@AAA@A@A@A@@A@A@@@A@AA@A@C@CAA@A@A@@AA@@AEAAA@@A@C@A@A@A@A@A@CBA@A@K@@@AAA@@@@A@@AA@@@@EAA@A@@A@@@AA@AAA@@@AAEBAAA@@@A@@AE@C@AAAA@@@@AAAAA@@@A@@@AAA@A@@@@AA@@@AAA@ABA@@@C@G@A@@@A@A@A@@@G@@@@AA@@A@@AB@@A@CAA@AAA@AA@A@A@@@A@@A@@@A@A@@BA@@B@@ABABAB@@ABA@@B@@AB@@A@AAA@AAA@A@AAA@AAC@AA@AC@A@A@A@C@C@A@A@AB@@C@I@AA@BA@ABE@ABABA@AB@@@@AAA@@@A@A@@AAA@@@AAA@@@A@A@@BABA@@ACA@@A@@AA@AAAAA@@@A@CAAA@@@A@AAA@@@@A@@B@@@@AA@AAA@AA@@A@@@A@A@@@@ABA@@AA@AA@A@@@@A@@@@A@@@@@BADAD@DAD@@AB@@@B@B@B@BAD@@@B@DCBA@@@A@@AAA@A@@@@@@A@@A@@A@@@ABA@@BA@@@@AAA@@@A@AAA@A@@AAAA@A@A@C@@@ABA@A@A@A@A@A@A@AAC@AAA@A@ABAB@BA@ABA@A@@@@AA@A@@BA@A@A@AB@@@@@BCBA@A@@@A@AA@@@@@AA@@AA@@A@@AA@@A@@@AAABAA@@B@@AA@A@@B@B@@@@A@A@AAAA@@A@@AA@@@AC@@@A@A@@BA@@BA@@@A@@BAB@BA@AAA@@@@BA@@A@@AAAAA@@A@@BA@@@A@A@@A@@BA@AA@AB@@AAA@E@@AA@A@A@@@@BB@@B@BA@A@A@@@EBA@C@@@A@A@@BAB@B@B@B@B@H@@@BE@CBC@C@A@@@A@A@@ABA@@@@A@A@AAC@A@C@A@C@A@A@A@A@@@ABA@ABAB@@@BA@@BAB@B@@@BA@@@ABA@C@A@A@AACCAAA@A@@@GFABCBA@AAA@AAAA@A@A@@C@C@A@A@@@A@ABA@@@CBA@@@AA@@@C@ABA@AA@A@C@A@CBA@@B@BB@@@@BCBADABCBA@@BA@@@ABA@AB@@AB@BBBA@ABA@AB@@@BC@@BAB@B@BCDABBBB@@BABB@@BBB@D@DAB@B@B@DBBB@@BBB@@@BBB@@BDBBBBB@@B@BABAB@B@@@BB@B@AB@B@@@B@@@B@@AB@@@B@@A@AB@@@DAB@B@BBB@B@BBD@D@B@BBBB@DBBDDFBFHHHDJHB@@BBB@B@B@@@BBD@D@DADADBBAB@BBB@BADABC@ABAB@B@BADGF@BABABBBFF@B@FBBBBBD@DABCDEF@BCHIFCDCFADGFGBCDADCDCBGHIFEDCL@DCFAH@F@HAJADEFBF@DAFABADABEJAD@FBBBD@BFF@B@BABAH@DADBBBBBBA@AB@BA@ADA@@BA@@@C@A@A@C@C@A@A@A@@@AB@@EBA@EBABA@@BAB@@@@CDA@@@@BA@A@ABC@ABA@ABAB@@AB@@@@A@@B@@A@@B@@@@@B@@AB@@@B@@AB@@@B@@@B@@@BB@@@@B@@@B@B@@@@@B@@@@@@@B@@B@@B@B@@B@@B@@@BB@@B@@BB@BA@@B@B@@A@@B@@@@@B@@@@@B@@@@@@AB@@@@@@B@@BA@@B@@@@@B@@@@A@@B@@@@A@@B@@A@@@@@@@@B@@A@@@@B@@@@@@AB@@A@@@@@@B@@A@@BA@@@@@A@@@ABA@@@@@@@A@@@@B@@@@@@@@@BA@@@@@@@@B@@A@@@@@@@@BA@@@EDABAB@B@@@BA@@B@@A@@B@@ABA@@BEDABC@ABA@A@@BAAA@A@C@A@@BCB@@A@E@A@@BA@A@E@A@A@C@A@@BA@A@@@A@ABA@@BA@ABA@@@@BA@A@A@C@A@A@A@ACA@C@A@@@A@@BCB@@EB@BA@A@A@@BC@AB@@A@@@E@ABA@CBAB@@A@A@@@ABA@C@@@ABA@@BA@@B@BA@@B@@ABA@@@A@@@BB@@BBB@B@BB@@@@@BAB@@A@A@A@ABA@@@A@ABA@@B@@ABBBB@@BH@DABBF@@AB@B@@@B@FA@BB@B@@@B@@BD@@@B@@@BB@B@@@BB@@@BABB@@@BB@B@@@B@BBB@B@@@F@@@B@@@B@@@@BB@ABAB@@A@A@ABA@@@ABA@A@@@E@AB@@A@A@GFAB@@AB@@@B@@@BDBBB@@@BBB@B@BB@@BB@BB@@B@@BB@B@D@B@BAB@B@@A@@B@BA@@HEBAB@@@B@BAB@F@B@@@BBD@@BB@@B@@@BB@@B@BB@@@BB@@@BB@@BB@@BB@@@@BBDA@@B@@A@@B@B@@B@B@D@B@@B@@@BA@BB@@B@B@@@BBB@@B@@AB@@@B@D@B@@A@@BEDA@@BA@A@C@A@ABC@A@C@C@ABC@A@A@AAA@@@C@@@A@ABA@ABA@A@ABA@ABA@@B@@C@A@A@A@@@C@C@A@A@@B@@A@@@@BA@@BABA@AB@@A@@BA@@@@@@B@@@@@D@@@@@BC@A@A@@@AB@@@@AB@@@@@B@@@@@BBB@B@@@B@@AB@@AB@B@@@@BBB@B@@B@@@@@B@@@@@BAB@B@B@B@B@@@BB@@@@BBBB@BBB@BBBD@@@B@B@@@B@@@@BB@B@B@BG@M@G@O@I@I@E@K@C@@@@B@@@@@B@@@@A@@@BBA@@@@BA@@B@@A@@B@@AB@@@FCB@B@D@@@@@BA@@@@B@@A@@B@@AB@B@F@B@@@@@BBHAB@@@B@D@B@@BB@D@BBDA@@B@@AB@@BB@B@BBBAB@B@D@B@@AB@@CD@@@F@B@@@BBD@DBBBD@BBB@DBD@BBB@BBDBBBDBDBFBDBFDDBFBDBDBDBD@B@D@DBB@B@@BBBB@@BBBBBBB@DBDBB@@BB@@BB@@BBBBBBBB@@@B@BBBBBBDDBBBBBDBB@@@B@DBB@@BD@@BBBBDBBBDBDDBBDDBBBB@BBB@@@D@D@B@D@B@D@B@D@BAD@B@D@BAB@B@BA@@BA@@BB@@BBB@@@B@B@B@B@B@B@D@B@D@B@BBB@B@BB@@B@D@@@BBBBB@@@BA@ABADA@@DB@BD@@@BBB@B@BBB@@BBBBB@@B@@DDDDDDBBBBB@BBDBB@B@DBD@B@BBD@@BB@DBBBDDB@DBBBBBBBD@DBDBB@BB@BBBB@@@BBB@B@B@B@F@B@B@B@B@DBB@@@DB@@DB@BB@BBBBDB@BBBBD@BBB@@BDBDFDDDDBBBBDB@BBB@BBDBBBDBBBB@BBD@BBD@D@H@B@DBDAB@@@@@B@@@DB@@D@DBD@BBD@D@BBB@BBD@@@DBBBBB@BBB@B@@@@B@BADAHAFAD@DAF@D@DBD@FBDBFB@@DBD@BBBBB@BDBBBBBDBB@@@BB@B@B@DBFBB@B@DBBBB@BBB@BBA@@B@@@B@BAB@B@B@@@B@B@@BBBBBBB@@BB@BBB@B@B@B@BBD@@BD@@BBB@@BB@@B@B@@@D@B@B@B@B@BBB@BBDDBB@@BD@B@BB@@BBB@BDBBB@BD@BB@@D@@@@@BB@BBBBB@B@BBBB@BBDBDBD@B@B@@BB@B@BB@B@B@B@DABA@AB@@BD@B@B@BBBBB@B@B@@@B@B@B@@B@DBBB@BBB@B@B@DAB@@BBBBBD@B@DBD@B@BBD@BBDBB@DBDBBBD@BB@@DBB@DBD@B@D@D@BAD@@@@@@@FAH@HAN@LAL@F@FAF@F@D@FAD@F@F@FAF@F@DAF@F@D@DAD@D@D@D@B@B@BBDBB@BB@@D@@BBD@BBDB@BB@@BBBBB@@B@@B@@@B@@BB@BDBDBFD@@BBBB@@@@ABC@CBC@C@C@A@ABA@AB@@@BBBAB@BAB@@@BB@BB@DBB@B@BBB@@@BBBB@FDBBD@@BBB@@BBBBB@B@D@D@B@B@D@D@DDDBDBDBD@D@DBB@D@@B@BB@@B@BABADAD@@A@ABA@@@@B@@BB@@@B@BA@ABA@A@@@AB@@@@@@@@@@@@BBD@D@BBB@B@@BBBB@@BBB@BBBB@BBBBB@@B@BAB@BAB@@AB@@@BBB@@@B@BAB@@@B@BB@BBDBBBDBDDDBFBB@BB@@@BABAB@DAD@@@B@F@DABAB@BB@@B@BDBDBB@BB@@@B@D@@@D@BAB@@ABABAB@@B@@@BBDB@BBBA@@BBB@B@@@B@@A@ABAB@BABABA@@B@@AB@BB@BB@@@BBB@DBB@B@BB@DBBB@@@BBB@B@BBBBBBBB@BBABBD@B@B@B@BAB@B@@@B@@BB@D@BABA@@B@BBBBBBB@BBDAB@BBBD@D@B@@@@AAABA@AB@D@BABBB@BB@@B@@@@AB@B@BA@@DAD@@@@B@BBBBBBB@@BB@@@BB@B@B@BB@@B@@@BB@@@@BB@@B@B@@B@@@@@@@@@@@@@@@B@@A@@B@@B@@BB@@@@@@BB@DB@DB@@BAB@BB@@@B@D@F@F@D@BB@@@@@BAD@@BD@D@BBBBBDBDBD@DBD@FBFBDBD@FDDDBBBBBD@DB@DBHDDBF@DB@@BBB@BBBBBFBDDDDDBBBBBDBDBBBBBB@B@B@BAD@DAB@BCDCDABABAB@B@B@BBBDDDFDD@B@B@BABABCDABCBCD@BAD@@BDBB@BABBFBD@B@B@B@B@B@@@BB@@BAB@D@BBB@BB@DDBB@B@BBBDBBB@BBBA@@B@BCBABCBCLENFCHCJCHCJEJCJEHCDADAFEHCFEHEHCFEHCFEHEHCBCFAHEHCBAR@DBD@D@DBB@B@B@B@B@B@DAB@DCB@BAB@BAB@@@@@@A@A@@@C@AA@@A@@B@@A@@@@@@BAB@@AFCBAB@@AB@@@@AB@@@@AB@@@@A@@B@@AB@@AB@@AB@@AB@@AB@@AB@BAB@BAD@BAB@HBH@J@J@JBF@J@D@@@B@D@F@D@B@D@B@F@D@H@J@H@J@H@H@J@FBJ@H@D@J@JBH@JBF@H@JBH@H@FBJAHAJAHAHAJ@FAFBLD@@HDHBHDHBHDFBFCFCFAFCFCFCDBF@B@B@F@HBF@D@D@F@DBHBJBF@JBHBHBHBD@F@J@H@B@@@DBB@B@B@P@FA@@B@@BB@@@B@@@@@B@@@@@B@@@D@HBFBL@L@J@J@J@J@J@L@L@J@J@L@J@J@J@H@D@JBLBJBLBJ@JBJBLBJBJ@L@J@JBL@J@J@J@B@HBFBB@BBFBFBBB@@B@@B@@BB@@D@B@B@DAB@B@BA@@B@@AB@@ABA@@B@B@DAB@B@@@B@DBFDDBB@B@D@@BB@@B@@AB@BABABA@@B@B@BA@@B@B@@@D@BBD@BA@@BAB@@B@@B@@@B@@BBD@BB@@BB@B@@BBBBBB@@@BDBBBBB@B@BAB@@A@@@@B@@@@@B@D@@@@@@DDB@@B@@@@@BBB@B@B@@B@@B@@B@BB@BF@TFLKJGVOhOxU^MVShcTYPUXgV]«@APSJOLKHEjSVEfIPMDQ@KAKKIKMESAMBO@YJ_JKHGZ]TQVITa^[HELUAKAIAIEQCKCGCCIGaMQGKIM]K]O]KKCIAKFIRKRGRCZA^ARGLIDK@K@[FQCIGKMKU[KMAEEMEOAG@IJQHGNOTS\\[nWTENAjK^GTA^ETEPCMQhGVALCFEFM@WAcAO@[OgEOQ["],encodeOffsets:[[130650,51342]]}},{type:"Feature",id:"231124",properties:{name:"孙吴县",cp:[127.336303,49.425647],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@DABA@@A@AAA@AAA@AAE@A@@@A@C@AAAA@A@A@@CC@A@AAA@A@AAAA@A@ABA@@AAAAA@BABABA@A@AAC@AAA@E@A@CAE@A@ABEBCBA@A@CCA@CA@@CA@BAB@BABA@A@A@ABA@CAAAAAA@A@E@A@ABCBABA@CBA@AB@BAB@@@@BBB@@B@@@B@@@@@B@@@B@@AB@@@BABA@A@CBE@EB@@@@CBEBCB@@A@ABCBC@CBC@ABABABABADAB@B@B@@@BB@BD@@@BB@B@BBBBBBBBBDDF@BB@@B@BBDBB@B@@@B@D@BBB@B@DAB@@@B@BDDBB@@@B@BC@A@EBC@CAA@A@AA@A@AA@AAABA@C@CAABA@A@AA@@AAA@C@@@ABCBABABABCBA@A@CB@@@@@B@@A@AAA@A@A@AAAAAAAAA@G@AACAA@C@AA@BA@ADA@ABA@A@CAABA@@B@B@B@BBDBBABGDA@@@A@CBC@ID@@C@CBCBCBABCBCBCB@B@BA@@BCDCBCBABA@CBA@@@A@A@A@A@C@A@E@A@A@ABC@CBCBABEDCBG@CBABAB@@EFC@ABEFCDCBABCDC@@BAB@D@B@B@BAF@DC@E@C@A@C@C@@@A@A@AA@@A@AAA@@AA@AA@@A@CAAACAAA@@A@@@A@@@ABA@@BA@A@@@A@A@A@A@CAA@A@A@C@A@@@A@@BA@@B@@@B@@@B@@B@@BA@@B@@@B@@A@@BA@A@CBA@@BA@ABABA@@BAB@@A@@BC@ABA@@B@@@@@BB@@@BBDBB@BBB@BBB@@B@@BB@@@@AB@B@B@@@BA@@B@@AB@@ABA@CBEBA@C@@BA@A@@B@@@B@B@@@@AB@@@@ABA@ABA@ABA@@@ABA@A@@@A@A@@@A@A@AAC@A@AAA@A@A@@@C@E@C@C@A@@@@BA@ABC@CB@BA@AB@@A@@@AB@BA@@B@@AB@@@B@BAB@@ABA@ABA@@@@B@@@@@@BB@@DBBB@@BBB@BBBBBB@BB@@B@@@BBB@B@@@BDB@@@BB@@B@@AB@@@D@@AB@B@@@@@B@@A@@B@B@@@BA@ABC@@@AB@@ABA@ABA@CBA@A@CBABE@CBEB@BA@@@ABA@@BA@@@C@C@@@E@C@C@A@C@C@AACBA@C@A@A@C@ABA@A@CACAC@@ACAAAAAAAEAAAGGA@@@@@A@@@AB@@@@A@@@@@@@@@@B@@@@B@@@@@@@@@@@@BA@@@@@@@B@@BB@@@B@@@@@@@@@@B@@B@@@@@@@AB@@A@@@@@@@@@B@@B@@@@@@A@@@@@@@A@@@@@A@@@@@@@@B@@@B@B@@@B@@B@@BB@@@@@@@@@C@@@@@@@@@A@@@BB@@@@A@@@@@A@@@@@@B@@@@@@AB@@@BA@@B@@@@@B@@@@@@@B@@B@@@@@A@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@B@@@B@@@@@@@@AA@@@@@@@@@@@B@@@@@@@@A@@@@@@@A@@@@@@@B@A@@@@@@BA@A@@B@@@@@@@@AB@@BB@@A@@B@@@@@BA@A@@@@@@@@@@AA@AB@@@BA@@@@BAB@@@@A@@@@@@B@@@@@B@@@@@@A@@@@@@@ABAB@@@@@@B@A@@@@@@@@@A@@@@@A@@@@BA@@B@@A@@BA@@@@@@BA@@@@@A@@@@@A@@@@@@@B@@@@B@@@@A@@@@@@@@@A@@@@@A@@@@@@@@BA@ABK@C@ABC@@@A@C@CBC@A@C@GDABEDGDA@ED@@@@AB@@A@@@@@A@CBCDA@AB@@ABAB@BAB@BAB@B@@@BB@@B@@@B@@@B@BBB@B@B@BBDA@@B@B@B@@@BAB@@@@A@AB@@A@@@@BA@@B@@@B@@@B@@AB@B@@AD@@@B@B@@AB@B@@@B@@A@@@C@@@A@C@A@A@ABA@@@A@@B@D@@@B@B@B@B@B@B@@AB@B@B@@BD@@@BBB@B@@AB@@ABABA@ABCBA@AB@BA@@B@@@B@@B@BBB@BBB@BBBB@BBBB@BD@BBB@@@BAB@B@BA@@B@BAB@@@B@@BB@BBBBBDB@B@@@B@B@@ABAB@@@BA@@BABAB@@@BABAB@B@B@B@B@B@@@BBB@DB@AB@@@@CBA@ABABABAB@BA@@B@B@@@BBBBDDBDBD@@BD@BB@@@BA@@BAB@BA@@B@@ADABABABAB@BAB@@ABAB@@AB@@@B@BAB@BAB@@ABA@@@@B@B@B@@@B@@@B@@@B@@@B@@A@@B@@ABAB@@A@AB@@A@ABA@CB@@ABA@@@A@@BB@@B@B@B@B@BA@@B@BA@@B@BA@@@AB@@@B@@AB@BA@@BAB@@A@ABAB@@AB@@AB@@A@@BA@CBABABA@ABAB@@A@@BA@@@AB@B@B@BA@@BABA@ABCBA@A@C@ABC@@BABA@@@@@B@@@B@BAB@B@B@F@D@B@BA@@@A@@BA@@@AD@@AD@B@BAB@DA@@B@BA@AB@BA@@BAB@BAD@DAD@DAF@DAB@B@BAD@D@B@BAB@B@F@DAD@DAD@B@B@B@DADBB@BBDBBBDDBBDBBB@BDDBBB@DDDBDBB@@B@@@@FBFBDBBBBD@B@B@DBB@BDBB@BBBB@BBB@@DBFBJHFDFDFBFBDBF@FBH@JBF@D@DBBBBBBBBBBD@BBFBB@DBD@D@B@B@DAB@D@B@B@D@@BBB@BBDBB@DBFBDBD@B@DBD@BBB@C@A@A@C@A@A@@@A@ABA@EDA@CB@B@@ABB@@B@@NHB@FB@@FFPPHLDN@@@B@B@D@@ABAB@@A@@B@BAB@@AB@@AB@@@@BBBB@B@@BBB@BB@@DBB@BBB@F@H@H@B@J@D@B@BBB@B@B@B@@BB@B@B@B@BBD@J@D@B@@BB@BBB@@B@BABA@@BA@BB@B@@DBB@BB@@B@@@B@BB@@B@@@B@B@@BB@B@BBB@BBB@@@DBBBFDBBDB@@F@B@B@@@BADA@@@CA@@@@AA@AAAA@@AABA@A@@B@B@F@B@@@B@BB@@B@B@DBB@PH@@B@BBBBFBDBDBBBD@B@B@B@BAB@BAB@BAB@B@B@B@B@D@BB@@D@B@@@B@BBB@B@@@B@B@BA@AB@B@B@@@BB@@@B@@B@BBB@@@B@@A@@@@BAD@@@@@@@@AB@@@B@B@@@B@B@@A@@A@@@CAA@C@@AA@@AB@B@BAB@@@B@B@B@B@D@@AB@BBB@@@BB@@BBB@B@@BA@AB@@A@@B@@BBB@@@@BBD@@@B@BB@@B@BBF@B@@BBBB@@B@BBB@BB@BB@BBBBD@F@D@DBB@B@F@D@D@DBB@BBB@B@BBB@D@B@B@DBB@B@B@B@D@B@B@B@@@BBB@D@F@B@D@F@F@FAB@BBB@B@DBBBLFBB@@B@@DA@@B@@ABA@A@AAA@A@@BA@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@B@@B@@@@@B@@@@@BB@@@@B@@@@BB@B@@@@B@@B@@@@@B@@BB@@@@@B@@B@@@@B@@@@@BBB@@@B@@@B@@B@@B@@@@@B@@@B@@@B@@@B@@@B@@BB@@@B@@@B@@BB@@@BB@BBB@B@@BD@@BBB@D@B@@@BAB@B@@@@AB@@@B@BABABABABA@ADCBADAB@B@BAB@DBD@D@DAFBDAB@B@@@B@BA@@FA@AB@BAB@BAB@@@D@DAB@D@D@R@BBH@B@B@B@BBB@@@BBB@@@B@BB@@B@BBB@J@BAB@B@D@D@H@D@@@B@B@B@B@D@@@DDB@@@BB@@BBB@@@BBB@@B@@BB@@@@BB@@@B@@B@BBB@BB@@B@B@@BDB@@B@@B@B@@@B@@BBB@@@B@B@BABB@@@B@BBBBB@@BB@@DB@@BBBAB@B@B@B@BBB@@@@BB@@@B@@BD@BBBBBBB@BB@@BDAB@@@B@D@@@B@BBB@B@BA@@B@@@@AB@B@F@B@@B@@B@@BB@@JFBBB@@@B@@@BBB@B@B@@@BBB@B@D@D@D@@@_hZfHTVTVRNJTHRJFN@HEJRJ`JBDNF@H@RDPHLTB^@V@`@hAV@VBZDLND@pFhLHKLMLIJIRKdMB@EGLAPEJGDGBG@FDGDEFKNO\\WLGTGdMrQZKNKPSLKLSROHE\\IZBzOzGVBZD\\RJDLFHBR@bKNELBLBJDFBfUXKII@@ACAAAA@A@A@AB@@A@IBO@C@A@AIBC@ABCF@@CBA@@@@AA@A@@BA@@@@@A@@@@@@BA@@@@BA@A@ABCBA@A@A@AB@@ABAFCDABCDADA@AB@@ABA@A@@@A@A@@A@@AA@@@C@@@A@A@@@A@@@A@A@@@ABADA@A@@FE@ABA@CACACA@@@@@GECCAAACAA@@AGAA@AAAAA@AII@@@CBG@A@AAA@ACEAAECEAC@G@C@A@E@CB@@E@@@A@@@A@@@A@@@A@@@@@A@@@@@A@A@A@CAEAAAEAC@CAACA@AA@@AA@@A@@@CBG@GAE@A@EACAAAC@AAC@E@@AMAOEAACAAAEACECCIGAAC@EACAA@C@CA@@A@A@@AA@@AA@A@AAC@AA@@AA@@@@@@@AAA@@BA@@@@@A@A@@A@AA@@AAA@AA@@A@AA@@A@@AA@AAA@@@A@AA@@A@@@@AA@@AC@@ACCAAAACA@@A@@A@AAA@A@AACAC@@@AAA@AAAAA@AAAAA@@@CAAAAAAAAAA@@CC@ACCAG@A@ABC@ACC@@AA@A@A@AAA@A@AAAAAAC@A@ABG@C@ABCHEFCDACAAA@AAAA@A@C@C@C@AAA@C@A@@AAA@A@@BABA@@AAAC@AAA@@A@A@AABA@@BAB@@A@@@A@A@@AAA@A@A@@BA@A@@@A@BAAA@@@CAAA@AA@ABA@@BA@@@A@@A@AAA@AAAAA@@AB@B@@@@A@AA@AA@@AB@B@@A@@@@@@BA@C@@AA@@ABA@ABABA@@@A@AAA@AA@AAA@@A@A@@AA@@A@@C@C@A@@BA@@@@@C@@@@BAACAA@@AAABA@A@AAC@AAAA@A@@@AA@AA@@A@@AB@BABA@A@@AA@A@AAAAA@@@A@@BA@@AA@A@A@A@A@@A@EACAAAAAAA@@@AB@@A@@AAC@C@@@AA@@@AA@BA@A@@BC@@@ABABA@A@A@A@A@@@AA@A@A@ABA@ABC@AAA@A@AAA@@AABA@CBC@A@@@A@@A@@B@@A@A@@@A@@@B@@A@@@C@A@AA@@B@BAB@@A@@@@@A@@A@@@A@A@AACA@@@A@@@AA@@@A@@AB@@@B@@@AA@@A@AA@@@@@A@AA@AA@AEAA@@@C@@BA@@A@@@A@@A@@@@AAICOGI]U@@WQSEKIUWMQAC@@@@@@@A@@A@ABA@@@@A@@@@@A@@@A@@@A@@@@@AA@@@@@AA@@CCCAACCCOIIGIEEIIEAA@A@@BA@@A@@AAA@@@AB@@A@@@@AA@@@@A@@@@A@@@@@A@@@A@@BA@@@A@@AAA@AA@@A@@BA@A@AAA@@@A@@A@@@A@@BAB@@A@@@@AA@@@@@AA@BA@@@A@@A@A@@BCAA@@@AA@@AAAA@@A@AB@A@@A@A@@@AAA@A@@@BAA@@AAAAA@A@A@@AA@@@A@@B@B@@@@@@A@@A@@AA@@@AAAAA@@A@@@A@@@@@AA@@@@@@A@@@@@@A@AAA@@@@A@@@@@A@@@AA@A@@@@@@@@@@AA@@@A@@@@@A@@@@@A@@A@@B@@A@@@A@@A@@@AAA@@AB@@@BA@@@@@@@@AA@@CC@@A@@@A@@@A@@@AAA@@A@@@AB@@@@@@AA@@@AAB@@@@AA@CA@@@A@@A@@@@A@@@AA@@@A@@@A@AAA@A@A@CA@@A@@AA@A@A@@A@@B@@@@A@@A@@@AA@@@@A@@@AA@@AA@@@A@@BA@@@A@@B@@A@@@@@AA@@A@@BABAB@@AAC@AB@@@@@B@@A@@@AA@@@CAA@@@@@@AA@AA@@AA@C@@@A@@@A@A@@@AA@EA@@@@@A@@@AA@@A@@B@AAA@@@@A@@@@@A@@@@@@@A@@@@B@@@B@@A@@@@@A@A@@@@@@@@AA@@A@@@BA@@@@@@@A@@@@@A@@@@@@A@@@@A@AA@@@AAAAAAA@A@A@@@@@AA@@@@@A@@A@@AA@@@@@@@A@@@AAA@A@ABA@AA@@A@@A@@A@@AA@C@@@@@AA@@AAA@A@@@AA@@@A@@A@@AA@@@B@@@@AA@A@@AC@AAAAC@AAA@E@A@AAA@A@A@A@AACAA@E@A@ABEAC@A@E@C@EAA@A@@@A@A@CBCBGBCBABABAD@@ABEDAB@@A@AB@BA@ADCBABABCBGDGBABCB@@AB@BADCD@@@B@@@@G@ABGLIFGDE@I@C@OF@HEDKDIJEFCHIBGBQ@Q@IAEGGDEFODKBCFMFIDKDI@BIMADIMAAAAAAMCA@EDE@CJEHIDG@EDCLAP@TBN@RAP@LABAFCLAPCLCLANAF@@A@C@@CACACAAA@A@@CAA@AAA@AA@AA@ECGEA@ECCCEAAAGCCC@@@@@AA@AACACA@AC@A@AAA@AAAAACA@A@CAAAAAA@AAAA@@AAA@AACAEA@AA@AAE@E@ABA@A@C@@@E@@@EBA@A@EBABCAA@"],encodeOffsets:[[130324,50181]]}},{type:"Feature",id:"231123",properties:{name:"逊克县",cp:[128.478749,49.564252],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@AB@B@DBHBFBB@D@BBB@B@DAB@D@@@B@B@B@B@@@BA@@@@BA@A@@BA@@B@BCDAB@@A@@@EBA@A@@@AB@@@BABAB@B@@AB@B@BCB@@A@C@AB@@A@@BAB@BABAB@B@BA@@D@H@DAB@B@BABABAJGD@BABAB@@@H@B@BAB@B@@@BAB@@@F@@@DB@@B@B@B@@ABBB@B@D@FC@@B@B@B@B@B@F@HAB@JA@AB@@@B@BAB@@@B@@@D@B@B@@@@@B@@BB@B@B@@AB@H@DDB@B@B@@AB@D@@BB@B@B@@@BBB@D@F@D@B@D@@@B@B@BAB@@@FAD@BBB@B@D@D@F@DB@@BB@@B@@@H@@BB@B@BBB@B@@@BB@@H@B@DCB@@@BA@AB@@AF@@@DA@@B@BAD@D@H@B@BA@@@@BAB@BA@@B@@A@@B@@@@A@@@@@@@A@@A@@@@AB@@@@@@@@@B@@@@@B@@@@@@@@@@A@@DA@@@@@@@@@@@A@@@A@A@@@@@@@@@A@@@@A@@@@@@@@@@@B@@@@C@@@@@@@@BA@@@@@@@A@@B@@@AA@@@@@@@@@A@@A@BA@@@@@@B@@@B@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@B@@@@@@A@@@@@A@@B@@A@@@A@@@A@@@@@@@A@@@@@@AA@@A@@@@@@@A@@@@@A@A@B@@B@@A@@@@@@@A@@@@@@A@@A@@@@@@@@A@@@@A@AA@@B@@A@@A@@A@B@A@@@A@@@AB@@@@@B@@@@@@A@@@@BA@@@@@@AA@@@@@@@@@A@@BA@@AA@@@@@@A@@@AA@@@A@@@@@AA@@A@@@@@@@@B@@@@@@@@A@@@@@@@@@AA@A@@A@@@@@@@A@@A@@@@@@@@@@A@@B@@@@@B@@@AA@@@@@@@@A@@@@@@@@A@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@A@@@@@A@@B@@@@@@@@@@@@@B@@@@B@@B@@@@@@@@AB@@@@@@@@@@@B@@@@@@@B@@A@@@@AA@@@@@@@@@A@@@@@@@@@@B@@@@@@@B@@@@@@@AA@@@@@@@@@A@@@@B@@@B@@@@@@@@@@A@@@@@@@@@@@A@@A@@@@@@@@@B@@A@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@B@@A@@@@@AB@@@@@@@@AB@B@BAB@B@BA@@B@BA@@@@B@BA@@@@B@@@@@A@@@@@@@A@@@@@A@@@@@AA@@@@@@@@@@@@@@BA@@@@B@@@@AB@@@@A@@@@@@@AB@@@@@B@@@@@@@AA@@@@@@@A@@@@@@@A@@@@@@AA@@@@A@@@@@A@A@AA@@@@@@@@@@@@@A@@@@A@@@AA@@@@@@@@@@@A@@@@@A@@@@BA@@B@@A@@@@@@@@@@@AA@@@@@A@@@@@@A@@@@B@@A@@B@BA@@@@@@@@@@B@@@@@B@@@@@@@@@@A@@@@@@@@AA@@@@@@@@@@B@@A@@@@@@@@@@@@B@@@@@BA@@@BB@B@@@@@@B@@B@@@@@@@B@@@@@BB@@B@@@@@@@B@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@AB@@B@@B@@A@@B@@@@@BA@@@@B@@@@@B@@@B@@@D@@@B@B@BBB@B@BAB@@@B@@@@A@@@@@@@@A@@@@A@@B@@@@@@@@@B@@@@@@@@@@@@@@@BA@@BA@@@@BA@@B@@@B@@@@@B@@@B@@B@@@@B@@AB@B@@@B@B@@@B@@@@@B@@@@@@@A@@@B@@A@@@@@@@@@@@@@A@@@@@@@A@@BA@@@@@@@A@@@@@@@BB@@@@@@B@@@@B@@@@@@@@A@@@@@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@B@@@B@@@@@@@@@B@@A@@@@@@B@@BB@@@@@@@@A@@@AB@@@@@@@B@@AB@@@@@B@@AB@@@@@B@@@@@B@@@B@B@@@@@B@@A@@@@@@B@@@@@@@@@BB@@@@B@@@@@B@@@@@B@B@@@B@@@BAB@@@A@A@A@AAA@AA@@AA@@A@AAA@A@A@A@A@AAAAAAAA@@@@AAA@@AAC@@@A@@B@B@B@@@B@@@B@B@DADAB@BABABABAB@BAB@@AB@@AB@@@BA@A@AB@@AA@@@@@A@AA@@A@A@@@@@A@A@@@A@@@A@@@A@AA@@@@@@@AA@@A@@@@@AA@A@AA@@A@@@A@A@@@A@A@A@C@C@A@CAAA@A@@AA@A@@@@BAB@@AB@B@FBH@@@BB@@B@B@@AB@@@B@@AB@@@@AB@@@@AB@B@@@@@BAB@@AB@@@B@@A@@@@@A@@@@@A@@@@A@AAA@A@@@A@A@@AA@@@A@AA@@AA@@A@@A@@@A@@AA@@@A@@@A@@BA@@@C@@@A@@@@B@@@@A@BB@@@@@B@B@B@BAB@D@@@BAD@@@BA@@B@@A@@B@@A@@@A@A@@@@@A@@@A@@A@@@@AA@@@@AA@A@A@AAA@@@ABA@@@@@A@A@@AA@@@A@@BA@@@A@@B@@@@@B@@@@@B@@@@@@@BA@@@@B@@@@@B@@A@@@@@ABAA@@@@A@@A@@BA@AB@@A@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@A@@@@@@A@@@@@@@@@A@@AAA@AAA@AAA@CACA@@@@@AA@@@@@B@@@@@@AB@@AAAACAA@AAA@@C@@A@@@@AA@@@@AA@@@A@@A@@AA@@@@@AA@@@@AA@@A@@@@@@@AA@@A@@A@@AB@@@@@@A@@@A@A@@@@@@A@@@@@@@@@@A@AA@@A@ABA@@@@@AA@@A@@@@@@A@@@@@@@@A@@@@@@BA@@@A@C@AA@@A@@@A@@@@@@@@@A@@@@@A@@@A@@@@A@@@@@@@@@@@AB@@@@@@@@@@@@@@A@@@@AA@@@A@AA@@@@@@AA@@AAAA@@@@@@@@@@@@@A@@@@BA@@@A@@A@@@@@@AA@A@A@@@@@@CA@AA@@@BA@@@@@AA@@AA@@A@A@A@A@@BABA@@BABAB@@A@@B@@A@@@AA@@@@@ABA@A@@@@@A@@@@@A@@@A@A@A@A@@B@@A@A@@@@@@@ABA@@@A@@@A@@@A@@@A@@@A@@@@@@@@@@@@AB@@@@@@@A@@@@@@@A@A@@@@@C@AAA@@A@@@@@AB@@@B@@@B@BB@@B@@@B@@@B@@AB@@@BA@@@@@@A@@A@@@@@@@@BA@@B@@@@A@@A@@A@@@@@@@@A@@@@@@@A@@@A@@@@A@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@@@@@@@AA@B@@@@A@@@@@A@@@@@@@@BA@@@@@@BA@@@A@@@@@A@@@@@ABA@@@A@A@@@AB@@A@@@A@@@@@A@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@BA@@@@BA@@@A@@@@AA@@@@BA@@@@@@@A@@@@@@@A@@@@@A@@A@@A@@@@@@@@@@@AA@@A@@A@@@@A@@@@@@@@B@@@@@@@@AB@@A@@@A@@@AB@@@@@@@@@B@@A@@@@@@@A@A@@@@@@BA@@@AB@@A@@@A@ABA@@@@@A@A@@@A@@AA@@@A@@A@@A@@@@@A@@AA@@@@@@A@@B@@@@@@A@@@@@@@@@@@@@A@A@@@@@@@@A@@@@@A@A@@@@@@@A@@@@@@@ABCB@@AAA@@@@@@@@A@@@@@@AAA@@B@@@@A@AB@@@B@@@B@@@@A@A@@@@@A@@BA@@A@@CBA@A@A@B@@A@@A@A@@@@@A@@@@@@A@@@@@@BABA@@@@A@@@@A@@@@@@@@A@@B@AA@@@BA@@@@@A@B@AAA@@B@@@@@@AA@A@@@BA@@A@@@@@A@@@@A@@@@@@A@CB@@@A@@EBA@A@@@A@@@A@@@@@A@A@@A@@A@A@@BA@@@@@A@@@@@ABA@C@@@AB@@A@@@@A@@A@A@A@CA@@A@@@A@A@@@@@@B@@A@C@@A@@@@@@AA@B@@A@@A@@CA@@@A@C@AAA@A@@@AC@@@@@BA@@B@@@@@AA@@ACAA@@@ACACA@@@@ABA@A@A@@@@AABA@@@@B@@@BBD@B@B@@@B@@ABA@@BB@@BA@ABA@A@A@AB@@A@@A@@C@@@A@@A@@B@@@AA@B@@@@AA@@@@@A@@AAA@@@@@@@@@A@C@ABA@C@@BA@@@A@@@@A@@CAC@AAC@A@@@@@A@@@@@@A@@@@@AA@@@A@AAA@@@AAA@AA@@AACAAA@A@@@A@@A@@@BCAA@AAAA@ECGEA@@A@A@@BA@AAE@AB@@ABABA@ABA@@BADCBC@@@A@A@@@@CAAA@@CAA@AAAAA@A@@AA@A@@@AA@@A@@@A@A@@@AA@@A@@BA@@@A@@@A@AAAB@@@@A@@AA@C@A@@@AB@@A@A@@@A@@BA@A@@@A@@@@@AB@@A@@@A@A@@@A@A@AB@@A@A@@@A@@@@@ABA@@@A@AB@@A@@@A@@BC@@@@@ABA@ABA@AB@@ABABA@@BA@ABA@@BA@A@C@A@A@C@C@ABC@CBA@CBABEB@BA@@AC@CACAC@AA@@@@@@B@B@@@BAB@@A@AB@BABCBAB@@@BAB@BA@@@A@AA@AAAAA@GAA@A@A@A@C@A@A@A@@@@@A@@A@A@A@@AAAC@A@AAA@@@A@A@@BAB@DAF@DA@@B@@ABCBA@A@AB@BAB@@@BA@@BA@AD@BABABADABA@@BA@ABABABCB@@ADAFABA@@BA@@BADA@AB@DA@ABA@@BA@ABA@A@AB@@ABAB@BABAB@BAB@BABA@@@AB@@ABA@ABADABABCDAB@BABA@AB@@A@A@A@AAA@@AACAA@C@A@A@C@A@A@A@A@@AA@AAAAA@@AA@A@A@@@@@@@AA@@@A@AACACAA@AAA@AAAAAA@AAA@C@AAC@@AAAAAA@@AAAB@@@DA@A@A@@BA@@B@BADA@ABA@@@A@AAA@A@C@A@A@AA@@A@AA@@A@AB@@CBA@A@A@A@AA@A@A@A@CBA@C@CAA@C@@AA@@C@@AAA@@@CAABAAA@A@A@A@G@A@A@A@C@A@A@AAA@CCC@AAE@E@C@A@A@A@A@A@ABC@AAA@A@AAAAAAAC@A@@@AA@A@A@A@A@@@AB@BA@C@CBC@ABC@ABC@C@AB@@A@ABC@AB@@A@@@AAC@AAEAAAA@AAAAAAA@@AA@AAC@E@AA@@A@A@@@@AAAAA@AAA@@AAAAAA@A@@@C@A@A@@@A@@@AA@@AAA@@AAAAAA@@AA@AAACAAACAAAA@C@AAC@C@CAC@A@C@G@A@@@AAA@AAEACAC@CAA@AAA@BABA@@@A@A@AB@@A@A@A@A@A@AAA@@AAAA@@AAA@CAA@A@AAA@A@A@C@A@A@C@@AA@B@@A@A@AB@@A@A@A@A@A@A@@ACCAAACC@AAA@@AA@AAA@@A@@@@AC@AAA@@@AACACAAA@@@A@AB@@@BA@@BA@A@@@A@ABC@@@AAC@ABA@@@AB@B@@AB@@@@@A@@@AAC@C@A@A@AA@@A@AA@@@AAABA@@@A@@BA@A@@@@@@AA@@@@@@@@A@@AA@AAAAA@AAAA@A@A@A@A@ABC@ABA@ADABADCBA@@@A@AAA@AAA@A@A@@@A@@@A@@AA@A@AAC@A@A@@@A@A@@@A@A@@AA@A@AAA@A@AA@B@@A@AAA@A@AAAAACAAACCA@AA@@A@@AA@@CAACCAA@@@@ACAA@AAAA@AAAA@@@A@@@A@@AAA@A@A@C@A@AAA@CAAAA@AA@@@@@A@@@@@A@@@@@A@@@@@A@@B@DAB@@@BAB@B@B@B@BAD@BAD@BAB@B@D@@@B@@AD@BA@@BA@AB@@AAA@@@AAAA@@A@@A@AB@@A@C@A@A@@@A@A@A@A@@AA@@@@A@A@@@@@@AA@@A@@A@AAAAAA@AAA@CAC@CAAAA@A@@A@AAA@A@AAAAAA@AA@@A@A@@@A@@A@@AA@AA@AAA@A@EAEAC@A@@AA@@A@A@AAAAA@AA@AA@@@@DAB@BABA@A@ABAAA@@@AA@AA@@@AA@@AA@@@AAA@@@A@@A@@@C@A@ABA@@BA@@BA@A@A@@@A@@@A@A@A@@@AAA@@AA@@AA@@@@@A@A@A@A@@B@BAD@B@@AB@@A@@@@AA@A@@@A@@BA@@BABAB@B@DAB@@AB@@C@AA@@@AA@@AAEAA@CAC@C@G@C@A@@@C@E@E@C@AA@@AA@@@AB@@ABA@A@A@A@A@@@@AAAA@A@A@C@A@A@@BABABAFAF@BAB@@A@@@@AC@@AA@A@@AC@A@@BA@@@C@A@ABA@@@AA@CA@A@@@A@A@A@ABABCA@AAA@C@CAA@@@ABC@ABCB@BA@@@A@AAAAAAAC@@@@A@@AA@A@C@A@@@AAC@A@@@CBEBA@ABAB@@@@AA@A@@AA@@CAA@EA@@C@CA@@A@AA@@AA@AA@@AAACA@CAA@@@A@@@ABA@A@A@@@ACC@AAAAAAAA@AC@@A@A@A@ABA@A@A@@@A@A@A@C@@@A@ABA@A@@@A@C@A@AB@@ABA@C@CBA@A@EBCBABAB@@@BBB@D@B@B@@A@A@C@A@AB@@A@A@C@A@A@@@@@@BB@B@@B@BAB@@@B@@BB@@@@@BAB@@AB@@@B@B@@@@CDA@@BAB@DAD@@A@AB@@A@@@@@@BBB@BABABABABA@C@A@ABA@@BA@@DA@@B@B@F@B@@CDADA@@B@D@D@B@DAB@BB@@BB@@@@B@@ABCBABA@@B@B@BA@@B@B@BBBDBFBD@@@@@@@A@A@A@EAA@A@A@AB@BABABABABAB@@A@@B@@@B@B@@@@BB@@B@FB@@B@@B@@@B@D@B@DAB@B@B@@BB@BAD@D@D@BA@ABC@A@CBA@@@@@@B@B@BBB@@BBF@DB@@B@@B@@@BA@AB@@C@C@A@AB@@ABAB@B@DAB@B@BA@ABA@AB@@A@@@ABA@@BAB@@BB@@@B@@@@AB@DAB@B@BBB@B@B@B@D@B@@AB@@A@@BADABABAB@BCBCBCBABA@A@C@C@AAA@A@A@C@C@A@AAA@@AAAA@AAAAA@A@A@ABA@CBCBCBCBEBCBCBA@A@@@GACAA@A@A@ABA@@BC@A@A@C@@@A@A@AB@BA@AB@@A@CACACACACAAAA@@AAAA@A@A@A@C@@@A@@@A@CACA@@CAEAA@@@ABA@CBC@A@A@C@A@A@AAA@A@AACAC@A@A@A@A@A@@@A@@ACACAAAA@AAA@@@@@@A@@@ABA@A@@AAAAA@AACAC@C@A@A@@@A@ABABA@A@A@A@@A@AAA@@@A@AA@A@AAA@@AAAA@A@A@C@@@ABA@ADABA@ABA@A@AA@@AA@@A@A@C@A@AA@AA@@A@A@@A@@@A@C@A@A@CAAAA@A@ABA@GBCBA@C@C@A@A@ABE@CBA@AAA@AAAAAAACCECCA@@AC@A@EBC@C@EBEBC@@@A@@@@@A@AAA@A@ABA@ABA@@@A@C@A@AAAAA@@ACC@@AAA@CAEAE@AAC@KCCAC@CAC@A@C@GACACACAAAA@@CAC@A@CAA@A@@AA@A@@@AAA@AACAAAAAA@AAAAAA@AA@@@A@@@A@@@AA@@AAAAAA@CAA@Gj`vEnM~EPSvaXhIFiZj@f[xvDteDEiJKVTJpBFFbZRpn@vu^GFW^LFFBF¾VNJNZDj^HTRFZHdHdYhwªC¢MHExŏbƋhë·»CCEB£JßNVsLVyN@BB@BB@@B@@@B@@@B@@@DD@@BB@@@@@@@@AB@@A@@BB@BB@@B@@@@B@@@BA@@@@BB@@@@@B@@@@@B@@@B@@B@@@@@@@@B@B@@B@@@B@@@@@B@@B@BBB@@@@@@@@B@@@@B@@B@@@@@B@@@BB@BBBB@@B@@BB@@@@B@@@@A@A@@@@B@@BB@@@B@BBBBB@BB@AB@@B@B@BB@@B@B@@@@BBAB@@@BBBB@@BB@@B@DB@AB@B@@@@B@@ABB@@B@@@@BB@@@@@BA@AB@@@B@@@BB@@@B@BBB@B@@AB@@@BBB@BB@@@B@@AB@@@B@@@B@@@@@B@@B@@@@@BB@@@@@BA@@B@@BB@BB@@@AB@@@BBBJFFJJFJHPJDDBDDBDD@@BB@@@@B@@B@@@@@B@@@B@@@B@@@@@B@@B@BAB@@@@B@@@@@@BDNRVXLJTFXR@@^VHJDPBJ@B@@B@@@@B@@@BB@@AD@@@B@FB@BBBB@@B@B@@@@BBB@@@BB@@A@@@A@@BB@@@B@@B@@@B@@DBBBB@B@@@B@@@@B@@@@@BA@ABA@@@BBB@D@@@B@@@@A@@@B@@@B@BA@@@@BB@@@B@D@DAB@BA@BB@BBB@B@BBD@BAB@BAB@B@B@@B@@@B@B@B@BABAB@B@@AD@@@BABB@@B@@BB@@D@D@BB@@@BA@@B@@BBBBBBDBFBB@@@@B@B@B@BBB@@AB@@@B@@BBBB@B@BBB@@@BABABA@@BB@@@BBB@@B@@@BBBBBD@BBB@B@BABB@@BBBDAB@@@@@D@@@@AB@@@B@D@DB@@@BB@@@B@BB@BBB@@BBB@B@B@@ABAB@BAB@BB@@BD@B@@A@@@@B@@@@ABA@@BBB@@B@B@@A@A@@BB@BBBBB@BBB@@@@B@@AB@@AB@BBBB@BB@D@@BBABB@@@B@B@@AB@B@B@BB@@@B@B@@@BA@AB@@ABBBB@B@@@BB@BBDBB@@ABAB@@@BBB@BB@D@B@BBD@D@D@B@B@BB@BBBDBCBEDGFAD@B@DAH@B@BBDBBBB@B@BBB@B@B@BBB@@DD@BAD@B@BBHDD@BDD@@BBBBBBBBBB@D@@BBBB@BBBBB@BBB@B@@BDBD@B@BBB@B@BB@@@DBBBBBDD@BD@@BB@@B@@B@@@BBB@@@B@BBB@@BB@@@BBB@@@BBB@BB@@BBB@@@@B@B@@@@AB@@BB@B@@@@@@BB@@BBD@BBB@B@@BB@@BB@B@@@DBD@B@DBFBD@BBJHDDDFFBBBDBBBPFNB@BF@D@BBD@BBDBFBB@F@HBH@DA@@B@@@BB@@BBB@BDDBD@FBBBFBDBB@B@B@@@@@B@@@@@B@@@B@@@B@@@B@@@F@@@DAF@B@D@H@D@FBFDBBDF@BBB@B@BAH@D@@JJ@BBBBB@BBBBH@@BBBDBBDDHF@@@@B@BDBD@DAB@BEF@@@BCBAB@B@@@B@B@@@B@@@B@B@@@D@@BB@@@BB@B@@@B@B@BA@@BAB@BCDCBADCBEBA@@BAB@B@B@DABAB@B@@A@@B@@A@@@@B@@@@@B@@AB@B@@B@@B@DA@@DEBAD@JA@B@B@DAP@J@BA@@B@B@BBBBBBD@@JJLG_^IPAN@NB^DfCfOxGNB\\BZJZTLJLDjFXBV@JCNGx]NCJ@LBTJNJNRHHBN@JBDBDHFZHrD^@fA^@N@R@PBJBTBPDVDZJLBXBJ@XGjGnCVCvWTGhIpEXIJ@PBHDDFDPJXDDHDFBnCbGhMFEFIHaCOECUKWQmcQSCO@EFKLOPEVIZA\\FlPjJ^FRBbCPCnY\\ObM\\A\\@X@V@^ERQJMHKNSTcHUJ_LOTO\\MdGhAdBHDVPRPdZ`PfJdD`AdGJEvgHEUAA@@@BC@ABAFEBAAA@@A@A@EBCDGDABCBA@AB@@@AA@@@ABA@A@ABA@A@@B@@AB@@A@@@A@@@A@A@A@ABA@@BA@@B@B@@A@A@@@C@@AA@A@ABA@@@@B@@@BA@@@AACA@@A@BB@@AB@@A@A@@ACBA@A@@BA@@@@@@@@B@@@@A@@@@AA@@B@@@@A@A@@@AA@@@ABA@A@A@@AAA@AA@@C@A@A@@@@AA@@ACAA@AAAAAA@@AABA@A@A@AB@@C@A@AB@@AAA@AA@@AA@@@@BA@@BAB@@AB@@ABA@AA@@A@@AAAAAC@AAA@A@AAA@@AA@A@A@A@@@C@@@A@@A@@@@@A@A@AA@A@AAA@A@@@@@AA@A@A@@@A@A@@AA@@A@@@A@A@@AA@@@@ABA@@@AA@AAA@@@AACAAA@@@@@A@@BA@@@A@A@A@@@@@AB@@@BBB@BB@@BA@@@@@A@@@@BAB@@A@@@A@@@@A@C@A@A@@@A@@AAA@@A@AAA@@A@@A@A@C@@@A@@@AA@@@A@@AA@@@A@@@@@A@@@AA@@@@A@@@AB@@A@@BA@@B@@@@@BA@@B@@A@@@@A@AA@@@@@@A@@A@@@@@A@@@@B@@@@A@@@@@A@@A@@@@A@@@@B@A@@A@@AA@@@A@A@@A@@@A@@@@AAA@@A@AA@@@@@@@A@@@ABA@@@@@@@A@A@@A@@A@@AA@@@@@A@@@A@@@A@A@@@@@A@A@@@@BA@@@@@AB@@@BA@@@@BA@@@@@@B@@AB@@@@A@@@@@A@@BA@@@A@@A@@A@@@AB@@A@@BA@@B@@@@@@AB@@@@@@@B@@@B@B@@AB@@@BA@@@@@@@A@A@A@@AA@@@@AA@A@@@A@@BA@@B@AA@@@@@AB@B@@A@@@@@A@@@@@AB@@ABA@@@@B@@@BA@@AAB@@@@A@@@ABA@A@@@ABA@@@A@AB@@A@@@@B@@@DA@@@@B@@@BA@@@@BA@@BA@@@@B@BA@@B@@@@@@A@@BA@@@@BA@A@@@A@@@AA@@AB@@@AA@@@@B@@@@A@@@@@@B@@@@AA@@@BA@@@@@A@@B@@@@@@@B@@@BA@@@@B@B@@A@@A@@@@AA@@@@@A@AA@@@@@@BA@@@@@@AA@@A@@A@@@@@@@AB@@@@@@@BA@@@@AA@@@@B@@A@@BA@@BA@AB@@@@A@@B@@@DAB@@@BA@@@AB@@@@A@@@@@A@@@@@A@@B@@A@@@@@@@@@A@@@@@A@@@@@@BA@@@@B@@@@A@@@@B@@A@@@@B@@B@A@@@@@@@@A@@A@@@A@@@@B@@@B@@@@A@A@@B@@@@@@@@@@@B@@A@@A@@@@@@@@@@AB@@@@@B@@@@A@@@@@A@@A@@@@@@A@@@@@@@@@A@@@@@A@@@@B@@@@@@@BA@@@@@@@@B@@AB@@@@@@@B@@@@@@@@AA@B@@@@@D@B@@@@@@@@@@A@@@@@@@A@@@@B@@@@@@AB@@@@@@@B@@@@@@@@A@@@@B@@@@@@@BA@@@@A@@@@@B@B@@A@@@@@@B@@@@A@@@@@@@A@@B@@@@@@@@@@@@@@A@@@@B@@AA@@@B@@@@A@@@@@A@@@@@@@@@@@@@@@A@@@@@@A@@@@A@@B@@@A@@A@@@@A@@A@@@A@AAA@@AAA@@AA@A@@A@@@@BA@@@@AA@@B@@@@A@@AC@@@AA@@@CA@@@@BA@@@AB@@A@A@@@A@@BA@@@A@A@A@A@@@@@A@@@@AA@A@@@C@@@AA@@@@AB@@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@A@E@AB@@A@@@A@AAE@@CC@C@C@E@CBEBG@E@C@C@A@@AAAAICA@AA@@CE@A@@@C@AAA"],encodeOffsets:[[132324,50266]]}},{type:"Feature",id:"231121",properties:{name:"嫩江县",cp:[125.221192,49.185766],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@WKKHWJM@BKHK@@MGAWS@@CYIQCIIWFE@OIMEGEE@EHO@E@IAI@@GAEI@K@Q@OAEFCDCDGFKD@MQ@AAUACTLHJ@LBCPJDLA@DF@RCLBBDFD`JFHNAPHVACPHBP@F@LLFAPAFFNFAHNN@FV@JJHFLEPLJFVXNCJNRCBDBBJVDlTROBA@A@ABCDCB@BM@A@C@CBCAIEECCAGEGEICIGEACCEAEECAECGCECAACACCIEICIEGGICGEAACACAAAGCCACCAAGCA@@AA@C@I@M@I@K@I@I@IAK@A@E@A@A@C@E@E@@E@C@C@C@IGBCBC@ABA@A@AD@@IAC@C@A@ADABCFADEDCD@BABCB@@@@@@A@@B@@AB@BA@@@ABAAAACACACAA@CA@@A@A@C@G@@@@B@@AB@BE@C@IAG@GAC@I@GAE@A@C@EAI@KA@@E@I@IAA@A@A@A@@H@@@@@D@@AB@B@BC@E@E@ABA@@@A@A@@@A@ABA@CBABA@@BABABCDCF@DA@@@A@@@@BA@A@@BA@@@@@@BA@EFCBAB@@@BA@@DA@@A@ABCFEBABABAA@@A@@A@A@@@@@AAA@A@A@A@@@AAA@@AA@A@AAA@A@@AA@A@AA@@@AA@@@A@CAA@A@C@A@AA@@A@@A@@A@ABABCBA@CBG@EBA@A@ABC@CDABBBAD@D@@@B@DAB@B@B@BAB@B@B@@AD@@@DA@BBAB@D@B@B@F@@@D@@@D@@@BBB@B@B@BA@@D@B@BAB@BB@@BEA@@ABA@A@@@@BCB@@@BA@@B@BA@AB@@E@@@AB@@CB@@A@A@@BA@@B@B@@CB@BA@@@C@C@C@A@A@@B@@CBABABA@CB@@CBABA@@@CB@@A@CB@@CB@@A@@B@B@@@BAB@@ABA@A@A@@@@B@B@B@@CBA@@@@@@B@@@@@@B@@@@@@@@@@@@@@@A@@@@@@BA@@@@@@@A@@@AB@@@@@@@@@@@@BB@@@@@@B@@@@@@@B@@@@@@@B@@@B@@@@@B@@A@@@AB@@@@@@A@@B@@@@@@@@@B@@@B@@@@@@@@@@@@@@@@@@A@@B@@A@@@@@@B@@@@@B@@@@@B@@@@@@@BB@@B@@@@@@@@@B@B@@A@@D@@BD@@@B@B@@@@B@@@B@@@@B@@@B@@@B@@@@@BBL@@@@N@@AD@D@@@B@@@@@BAF@B@B@@@@@BAB@F@@B@@B@@@@@B@@BB@@@B@@@@D@BB@@BDDFBDBDBBADAFAD@FADADAF@BAFAD@B@B@@@@ADAD@BA@GA@@EAC@GCC@GAAAA@A@CBEBEDKBGDC@I@E@ABAB@@@@@@A@A@A@A@C@A@E@A@A@A@@BA@@BA@@@C@A@@BA@@@AB@@@@A@@@@A@@@@A@A@A@A@A@A@@@A@@@@@A@@@A@AAA@A@AA@@AACA@@A@CD@@A@E@E@@@A@ABA@@@A@@BCA@@A@A@@@AAA@A@C@A@@BA@E@E@@@A@ABA@@@EBAB@@A@A@E@A@ABCB@@E@GKID[LWJiFaBWHSZIAEAE@CAA@AAC@C@E@G@@@K@@@C@DDDH@HGJCD@LBHFHDHBHBJHHJTFFVXLFJBFHJHFFJNFHBJDNDHJLHDDDCHIL@HBFFFJFHFALCLGHQ\\GJCF@FBHHFJBP@PBNHNJNbJ\\DNFDLFNFNDLDD@THLDLANCTIRGPGLEHAL@NBF@JCNAJBHFHDFDDBHBFBH@N@L@FDLDF@HADE@ECGIGAEBEDCFETENCFEFCPCNCJAPANEBAJCJCLADAF@FBDFFHJJFDFBHBH@J@LBHBDB@HAD@D@LBDBBDDJHHBHFFHDJHHDBBBDBH@HAJAFALBF@JFBBBB@J@BADAHCF@DBDHFFDBFBJBH@H@LAFBDABGBC@CBE@CDCF@DDBBBFDB@L@HBD@F@DB@B@D@@ABC@C@I@CBCB@B@DBBDBDDBBADAB@BA@CBE@E@ABAB@FBD@@FBHDHBDFHJFFDDDFFDLLJFB@HDDDBFBHEBEBEDCD@D@BDBPHDBBDAD@BCH@BBBDBDBABADCBEBCBAD@@BDDBFBJ@HAF@B@BB@BADCD@BA@@@@BA@@B@B@@@@@B@@@@@BB@@BB@@@BB@@B@BB@@BB@B@@@BBB@@AB@@@B@BAB@B@@@@AB@BA@@B@@A@@BA@A@AB@@CBA@ABA@A@A@@BA@@@A@CB@@A@AB@@A@@BAB@@@BBB@B@BBB@BBB@@BB@@@B@@@B@D@D@B@@@B@@AB@B@@@B@@@@@@@B@@@@@@@B@@@@@@@BBB@D@B@BBB@B@B@@AB@B@@@BAB@@@@@@AD@@@B@@@DAB@B@BB@@B@@B@@@BB@@BBDBFDBBDBBBBB@B@B@@BB@B@B@B@B@BA@@BCDEF@@ABABA@@BA@@@@@A@@BABA@@BEDIFIJMJA@KHGD@F@BDDFBJ@F@DDDFCDCFCBK@E@EBCBAF@D@F@DAFAHIJEHEL@DBDD@D@FALIH@JBBDB@@DAD@BAD@BBB@B@DAFAJCHCBG@E@GDCB@DBBDBH@BBHDJDDDAFEBCD@DDDBDDDBDCDEBGBEAEAECC@G@CBGBEDAFEJEDGDKHEFEDEHEF@F@D@D@@E@E@CCAICGECEAG@CBGDABCBEDEF@DAHAFEDEFAJAJCJ@RBBBHFHJDH@F@TCVERILINEFIBCAGEGAG@GHCDAD@FDFFBF@D@FCFDFJJPPLJDDBF@HAFAJ@NAPAN@JBFBDDDHLBDABCDEBKDKBEDC@@D@BDFDFDFHFLHDD@F@BBB@DDDBF@HBDDB@D@D@BCBADCDAD@BFDBDBFAFEHOJMHSHIDEBE@MJCDAF@F@DB@LDNBNBTBTBL@NFHDDDFD@F@B@DCBMJIDCDAD@FBBBBDBH@RAD@DBBDBFAFCDEHCFCHEHGHEDKFKHAFADBDBDHFNJBB@D@@EBC@C@EBEBEHEBA@CBE@CD@@AD@FAFABGDGDGHABADBDDDFBB@LBP@DBH@L@LAPCFADFPRDDAH@HAJ@HDDHDJDLBP@L@LDLDLHJHDFBJ@HAJCJ@BGFGFEBGBE@C@EAE@E@GBEBEBEFA@AD@BBBFBH@H@D@DFJFFFHBHDFBB@FAFCFGBCHCLCJAD@F@NFNFHDHFBBAFCFEDGBYBO@IBG@EBG@ABI@E@IDGFGF@D@BBBDBHDLFHDBB@B@BABEDKBGBABC@CAE@C@CBC@CDCB@B@BB@DBJBNBF@DBDDDDDBBBADCDCFAD@F@DBBDBFBPDFDHHHDFBHBFABABEBEAIBEDEFCFAFAB@BBBD@HBB@BADAD@D@DDBJBD@L@FAD@DAD@FBD@FBN@H@J@D@DDBB@@AFADABEBI@E@G@CBCBCBEDA@ABCBABIDGDC@CFAD@BBBFBH@JALAFAFBDBFBHDDD@BBD@BBDD@B@BABCDAF@D@BBDBDD@D@FABCBCB@B@BAFBD@DBDDFFDD@B@DAJAH@FADA@@B@BAFBD@F@DBBBHFDBD@HBLDDBHBDDDDBD@D@DABEDGDCDCBA@AD@HBBBBD@DBHAFAFABAFCF@F@FBBB@BADEDEDKFCBABABBB@BBD@BBBBF@B@BABEBEDABABADBBBDFBBDFBDBD@B@DAFADADBDBBB@D@FCFAJAFBF@H@DAD@BBBDBD@D@FAF@D@DBBDFHFFDDDBDDHBFBF@FAFADAD@D@HBFBB@@DABABE@C@C@C@ABC@E@ABABAB@BBBBDDBD@DBF@B@BAF@D@F@FDFBDB@D@@CDEBCBABABCDCDAD@DBDBDBDFBD@F@J@F@HDFDFDBD@DABEDIBC@C@C@C@EBE@ABCDADBB@BFBFDJBJDB@@BADAFCHCFGJABAF@DCDCBGBEBC@@@@D@BD@FBF@DBFDBD@D@DDBD@F@F@JENILENGLEFAJAFBB@FDBDBD@DCDIHGFADADADADGFADAHAFBDBDD@F@D@FAD@DAD@HCFADBBB@@@BADABE@C@CACBABABBFFDLDHBLAJ@NALAD@BC@CBABAD@B@B@J@J@FBFDLFFDBFBLFHDJBDBBFDHBFFBBD@DADAD@F@DBFDFBHBHAF@D@BBDD@BADEBCAEAE@C@EBCD@FBDFDLBH@H@FBDBFDLJHHHJDDHDHDDDJBF@HAJCFABC@CACCAA@E@GBCAACDAJ@DAB@DAB@@@B@B@B@D@@BB@LHBBCD@BBDDBD@F@DBBB@DADA@C@EB@B@DBDBBD@LAL@FAHBDBFBDDDDBD@DBBDBDBH@BBBDDDD@F@H@D@BD@B@DCD@DBDHBFD@B@FADAFADAFCFCFEFCF@H@F@BGFEDABAFBDDBBHBF@FBF@BBDADADCBCBC@ABCBABCBADABEDAFADABAB@DBB@F@BBBDBDBBBDBBBBBBB@B@D@B@BABCBA@ABADAB@@CBCBCBABABADAB@B@BB@BBD@DBDBB@FBD@DBF@DBD@DBDBD@DDBBBBBDBB@D@B@BBDBBB@@BBB@BABABADCBABCBC@E@CBC@CBA@ABAB@B@B@BABABC@E@A@CAABC@CBCDGBABA@AD@B@BDBDDDBB@FBD@DB@BBB@D@BABCDCBABEBEBCBCBC@C@A@C@A@AACAAAA@C@CBC@CB@@AB@BBDDFBBDBDBDB@BDDDDBBDBBDDBDBB@D@JFFBFBD@BBD@D@B@B@DAB@BCBADADADAB@D@BAD@DAB@DADCBA@@BA@@@A@@@A@AB@@AF@D@DAD@B@DB@BBBBB@BBD@BBD@@BB@BBB@@@B@B@@ABCDCBCDABAB@@ABC@@@ADAD@B@B@B@BB@B@BBBB@B@BA@@B@BBBB@DBB@B@HAFAB@BAB@HAD@D@D@FBHDB@DBBBBBDBDBBBDBD@DBB@DBBBDDB@BB@BA@@BCBCDC@ABCBCBAB@B@BBBB@BDBBDBBBF@B@D@HBD@B@B@D@D@B@D@DBFBF@DDDBDBBDDDBD@B@B@B@B@BBBBB@B@BABA@CB@@CBAB@B@@AB@BA@EBABA@ABC@CBCBC@C@C@C@C@A@@@@B@@BBD@B@@BB@B@D@B@D@D@B@D@BAD@DCFAD@DAF@D@F@D@D@D@B@D@B@B@D@DBD@BB@BD@DDDBBB@B@@BB@BB@@BBBA@@BA@A@GBCBA@ABEBA@@@@B@BBBFBDBBBBB@B@B@BBB@B@BBBBD@B@BBB@B@D@BBB@BBBBBBBB@@B@B@@BBB@D@B@D@B@DADAD@DCBA@A@A@A@A@A@CA@@AAA@A@C@A@C@ABCB@BABABAB@@ADA@ABA@@D@F@B@B@B@B@B@B@B@B@B@B@@@BB@B@BAB@B@@@B@B@@BB@@B@DB@@BB@BABAB@BCBABA@AB@B@BBB@BAD@B@B@@BBB@B@B@BAB@B@BBB@BB@BABA@A@ABABA@A@@@A@@@A@@@@B@@ABBB@B@BB@D@D@BADAB@D@DBBBBBBBBBDBBAB@BCBCDEBAD@D@HBBB@D@DABKHCDAB@D@D@BHF@BB@@@B@@@D@DB@AB@@@B@B@@AB@B@@@@@B@FAFAB@@@D@BBBABB@ABAB@B@DA@@B@@@FABBB@@@JB@BH@B@B@B@@@B@@A@@B@@@B@F@B@@@B@@@B@@BB@@@B@@@B@FBB@B@B@B@@@B@B@B@B@B@D@D@D@B@HB@@DDFDBBDDFDDDDDFDFDFFHFF@@@@B@@AB@@@B@D@BB@@D@@BB@B@@@@BB@B@DA@@@@B@F@B@@AB@@@B@@A@@@@B@@A@@B@@@@AB@@A@@B@@@@AB@@@D@B@BB@B@@@B@B@B@B@B@@AB@@@B@B@B@B@@@BB@@B@D@@@B@DA@@BAB@B@@@B@@ABAB@B@@@BBBA@@B@DBB@@BBB@@BD@@B@F@@@B@DAB@B@B@@@BA@@AC@@BAB@@@D@@@BAD@@@B@B@B@@B@@@@BB@@@@@BB@@@@B@@B@@@@BBBB@@@H@@@BBDB@@@BB@@B@@@B@@@@BBD@D@BBB@@@@@@BB@D@@@@BB@@@@BD@B@@B@B@@B@BB@@B@BBB@DB@@@BB@@@@B@@@BBB@B@@@@BB@@FDB@@BB@BB@@@BB@@@@@FB@@B@@@B@B@B@B@B@BBB@@A@@BBBAB@@@B@B@B@@@BB@@B@DA@@@@B@FA@@BBBA@@B@@@B@DA@AB@@@@AB@@@B@BAB@@@B@@@BAB@@@B@@@@@B@BA@@B@F@@@B@D@BAD@B@FBBA@@J@F@B@JBFAN@DBH@B@@AB@@@B@@@@@B@DAD@H@FCFAH@BA@@D@@AB@@@@@@A@@@A@AAA@A@AFMDKDABADA@A@AB@AA@AAACAAA@A@AAACCA@@AAA@A@CBA@AA@@A@@@A@A@A@A@AACAEBA@AAAAC@@BC@ADCDABADCBABA@A@A@ACCCECCAA@A@A@ABABABADCDC@ABA@CBC@A@A@AAAAAAAACACAAAACCCCACAEAAAAA@AA@@CAE@CAGCCAA@@CACAAAACCECC@CAEAEAC@CAC@CACAAAAA@A@CAC@@BC@A@@@@AAC@E@E@C@A@@@A@@ABA@AA@@CCAA@@A@@@@A@@AA@@@@AB@@@@A@@@@@@@@@@@@@@@AA@A@@@AA@@@@AA@@A@@@AAA@A@A@@A@@AA@@AAAAAA@A@A@@C@CB@@ABA@A@@B@@A@@@AAA@AAABC@A@@BABBB@B@@A@C@C@AA@ABAAC@AAAAAAA@A@AB@BA@A@CAA@@@A@@@ABA@A@A@A@AACBAAAA@AAAAAA@A@AAA@A@@AACAA@@A@AAA@CAA@A@@AAA@@ABA@@@AB@BABA@ABABAB@@@@A@@@AAA@AB@AA@ACAAA@@A@@@BABABA@@BA@A@C@@@C@A@@AAA@CACA@A@AA@@ABABA@C@E@A@@BC@CBABA@A@@AAA@EACACCCAAACAAAA@@A@A@@BA@A@A@@AA@A@@BA@@BA@ABA@A@AA@AAAAA@AA@AAA@AA@AA@AA@A@AAC@C@AA@@@@@@@@@@@@BA@@B@B@BAB@@A@A@@AA@@@A@@B@BAB@@@BCBCBA@A@AA@@A@AC@A@CAC@C@CACACACCC@C@A@A@C@C@A@A@AAAA@@AA@AC@AAECA@AA@A@@AA@A@AAA@CAAA@@A@@BA@ABAAA@A@@BAB@BAB@D@D@D@DAD@BA@@@@AAAA@@ECCACA@AAA@@@A@@@AA@@@AAAA@AA@@ACAAAC@AA@@@CA@@AAAAC@A@A@A@C@C@CBC@C@C@EBE@C@EBE@E@E@EBC@E@C@EBE@E@EBK@KBM@GBG@E@@@@@@BC@A@C@C@AAC@CAA@CA@@AACAAAC@CAAAC@AAC@A@AAC@C@AACAAAA@@BA@C@A@AAA@AAACAA@@@@A@A@A@@@A@AAAAA@A@A@AAC@@BAB@BA@C@A@A@AAAA@A@@AA@A@C@CACAAAA@AA@A@AAAAA@AAA@@@@C@@@AAC@@AAACA@AAA@AA@@A@AAC@@AACCAAA@AAA@A@A@A@C@@@A@A@@@AA@@AA@AC@@AC@AAA@A@A@A@AAA@@AA@AAAAAA@@@A@A@@@A@ABA@A@A@@@AB@AAA@AAA@AACAA@A@EACAA@A@A@@A@@AAACAAAAACA@AAAAC@CA@@EACAEAC@CAC@E@CBC@EBGBCBABA@@@@@@AAA@AAAAACA@@C@AAA@AAC@C@AAC@CAC@@@CA@@A@@@@@A@CBCAA@G@C@C@AAC@AAA@AACAAACAAAA@AAA@ACAACACCECACAC@@AA@AACAA@ACAAAAAA@@ACA@@CA@@A@CAA@A@A@A@E@A@A@A@A@AA@@A@AA@AAAA@CACAC@AAAAAACAA@CCAACAA@@AC@AAA@C@CAA@A@CAAAA@AAAACCCCCC@@@AA@AAAA@@AA@A@AAA@A@@ACA@@CB@BCBAB@@A@@AAAA@A@@@C@AA@@A@AAA@A@A@C@A@C@A@A@A@A@A@A@@AA@AA@@AB@@AB@@A@ABA@A@C@ABC@A@C@A@C@A@C@A@C@C@@AA@AAAAACCAACCCAAACAAAAA@@AC@@AA@C@A@@AAACAAAACCAAAAAAA@@@A@AAAAAA@AA@@AA@@AA@CACAA@AAAAAA@@AAAA@@@AAA@C@C@AACACACACAECCAEACAEACACAAAC@AAA@AAC@CAA@AACAA@CAC@A@@@A@E@@DC@@BA@@@A@C@ABAAA@A@AAA@@BA@@@AB@AC@A@CAA@@@A@C@A@@BAAG@A@@@@@A@E@ABA@@@AB@@@@A@@B@@A@@@@@C@ADA@E@@BA@@@AB@@@@AB@@A@@B@AA@@B@@@@@@A@@@@@A@@D@L@F@J@J@P@H@N@H@@A@A@AAA@@@@@A@@@A@A@@ACAAA@AAA@AA@A@@A@@A@@@A@A@A@ABA@A@@@@@A@@@@@AA@A@AA@@@@@ABA@@BA@@@A@@@AAA@A@@@@@A@@@@BA@@@@BA@@B@B@D@@A@@@@@C@@@@@A@@@@B@@AB@@@BAB@BA@AB@@A@@B@@@@AB@B@D@D@@@B@B@B@D@@@@AB@BAB@BAB@B@BAB@BAB@@@D@@@B@BBB@B@D@BAD@D@B@D@BAB@D@B@B@@AB@FC@AB@@@@A@C@A@@BA@@@AA@AA@@A@A@@@AAB@@A@@@AA@C@A@A@@@@A@AB@@@@AB@AC@A@@A@@AA@@AA@@A@@AA@@A@@A@AA@@A@@@AA@@AC@AA@@A@E@A@ABA@@@A@ABGF@@ABA@@@@BA@A@ABA@C@A@A@@AA@@@AAA@@AA@@A@AAA@A@@AACA@A@@@A@@BA@@BAHEB@B@@@BAF@@@B@B@BA@@B@BAB@B@@@BABAA@@A@@A@@@A@@@E@@@A@A@AAA@@@A@A@@A@@AAAB@@A@@A@@@AAA@@A@@@C@@AA@@@A@A@@AEBA@@@A@A@@BE@AACBG@@AA@AABA@@@AB@BAB@@@B@BAB@B@B@@@BA@A@@@@AAA@A@AA@@AA@@B@@@B@BA@@@AB@@A@AB@@AB@BA@@D@B@BA@@B@B@@@BADAB@BAF@@@B@@@BAD@@AB@B@B@@AFA@@DA@AB@@@B@D@B@BDB@B@B@D@B@B@B@@A@@B@BAB@@AB@BAB@@@B@B@@AB@D@B@B@F@B@B@@AB@F@B@@@DA@AB@D@B@B@BB@AB@B@BAD@BAFC@AB@BA@@@AB@@@@AB@@A@@@ABABAFC@@B@@A@@@@@@B@@@@A@@@@@@B@@A@@@@@@@@@A@@B@@@@@@@B@BA@@B@@@@@B@@AB@@@@A@@@@B@@@BA@@@@@@@A@@B@@@@A@@@@@@B@@@@AB@@@@@@AB@@@@@@A@@@@@AB@@AA@@@@@@@BA@@@@@@@A@@@@@A@@@@@AB@@@@A@AB@@AAA@@@AA@@A@@@AA@@@@A@AA@@@@A@@@@@@@A@@@@@A@A@@@A@@A@@A@@@A@@@A@@BA@@@A@@BA@@@A@@@@@AB@@@@AB@@@@@BA@@BABAB@BAD@BAB@B@@A@@B@DC@@@@BA@AB@BAFAB@FA@@BA@@B@B@B@D@D@B@B@D@@@B@@AB@BCB@@ABAB@AAAAAABC@CBGBA@A@AEE@AACAA@EBCFIBABCBABE@CAEFEBCBI@G@EBGDE@CDKFCJEHGDADCBCDCHAHEBCDEDCJEDG@AFEDCBA@CACAAAA@E@AEEAABABA@AHEBC@A@ABABAD@BABC@AAA@ABAAABCBC@C@CAC@A@@@A@AAA@AA@IGGCGGAECEACCAA@AA@A@A@CAC@A@AAA@A@ABA@C@@BAB@@@@A@@BA@@@A@@@A@@@ABAA@A@@A@@@ABABA@A@AA@AAAAAC@@AA@A@@AA@AA@AA@C@A@ABA@C@CAA@AA@BA@AA@AABADC@A@ABA@AD@@A@@BAB@BAB@AA@ABA@@BAB@BA@@B@@AB@DABABCDA@A@@A@@A@AB@DAB@D@B@B@@BAB@B@D@@BB@@B@DA@@B@BAB@@@B@B@D@D@@@@B@BBBBBB@BBB@DABAHE@@B@B@BBDDBBB@B@D@B@BA@@B@@A@@@ABA@AB@@A@@BABAB@BA@@B@B@B@B@D@B@D@B@D@BBB@B@@@@@AB@BB@B@@@B@D@D@DAF@@A@@@G@A@A@A@ABA@AB@B@@@D@B@FA@@B@B@B@@A@AA@@A@@B@B@B@@BF@B@BB@@BAB@@BAB@@@BB@B@@@B@@AB@@@BBBB@BB@@@AB@@@@BB@BABA@AB@@@B@@AB@@AB@@@B@B@@BD@@B@@BB@@@BBBBB@B@@@@@@A@AB@B@@BA@@@BBBABB@@B@@@BB@@@BB@@BB@@B@@@@BBB@@@B@B@DA@A@@@@BAB@B@B@@AB@B@@B@@B@B@BAB@@ABABAB@B@BBD@BBB@B@B@B@B@B@B@BA@@D@B@B@B@BB@BB@B@BBB@@@B@BB@@@@AB@@AB@B@@@BB@@@@B@@@@B@B@BB@@@B@@ABCDA@@@C@ABA@A@A@@@A@@BC@CBC@CBAB@@@@B@@@@@@B@@B@B@@BBB@@AB@B@@B@B@@@B@@@BBB@BBB@@B@@A@@@@B@@B@BBB@@@B@BB@D@B@@BBBB@BBB@@@BB@BD@@ABAB@@@B@B@@BB@B@@BB@BB@B@@@B@BB@@@@BAB@BABAF@BAB@@ABBJ@D@@@BAB@B@D@D@B@B@B@D@@BBBD@BBB@BBB@B@BBB@BBB@@@BA@@@AB@BA@@BABABA@@@AB@@AB@B@@@B@@@@B@@@B@BBBB@BBB@DBB@@@BA@@@BB@@B@@H@@@B@B@B@@@B@H@D@@@B@BAB@BB@@B@@B@@B@B@BB@@B@@@B@BBBB@@@@BBBBD@F@@BB@@@B@BBFABB@@B@BBB@@B@@@BB@B@FB@@@@BB@@@B@@B@BB@@L@B@B@DAB@B@B@B@B@D@B@@@BBFB@BB@@BB@B@B@DBD@B@B@@B@B@@@B@BB@B@B@B@BB@@B@B@B@D@B@@@DBBB@@DBD@@@B@FADBFA@BD@B@@AB@B@B@D@@AB@@@BABADAB@BAFABA@@B@B@B@F@B@D@JCB@BA@@B@DA@@@@BA@@BA@@BADA@@B@B@@AB@B@@@HC@@BAF@B@BAB@B@B@B@BAD@@@BBB@@@D@B@F@@@B@BBBAB@B@B@@@B@B@@B@@D@F@B@B@DBB@F@DAB@BABAFABABA@AB@@A@C@AAA@AA@@@@@AMA@@A@@@@A@@A@@@@BIB@AA@A@@@@AA@@A@IAC@A@E@E@@@@@AA@@@@@@@@@ABG@@@K@A@A@@CMA@CC@@AA@@@@@@@A@@@@@@@@@@@@BC@@@E@@@ADGDADA@AB@J@@@B@@@FEB@@@D@@@@@@ALAF@FAB@JE@A@@@@BADA@@HA@@BA@AFE@@PG@@BA@@@@B@@CBC@AACA@AAA@@@EB@@@@@@@@@@A@A@A@@@AACA@@@A@@@@@A@@@@@@@@@C@@@@@@@@@I@A@@KG@@AAAC@@@@@@BC@A@@FCFG@@@@@@@@@@@@@@DI@@BAFA@A@@@@HGDABA@AHA@@@@BA@AHC@A@@B@B@HC@@@@@A@@BIBC@@A@EEAA@@@A@C@A@A@@GE@@EA@@AA@@A@@@@@@A@@@@B@@@CE@A@@AE@@@@@@@A@@@@JEDAB@@AB@@@@G@A@A@@@@@A@A@@B@@A@@@@@@@@@A@@@@@@BABA@@@@@@@A@@A@ACCAC@GAGAAAC@CACB@ACACAAACCA@CA@@@AB@DABADADABABA@ABA@@DCBA@AB@@ABABABADADABAB@BA@A@AAAAAAAAA@@@A@@@ABAA@@A@AAABABABAB@DAB@D@B@@A@@@AAAAAAA@@@CBA@AAAACAACAA@@AB@DCDCBADAD@DAD@FAFAB@BABCBABAB@BAFABAB@@CBABABCBADCB@BABADADABAD@DAD@D@FAD@DAB@D@D@B@D@B@B@@@BABABA@AB@F@D@BAD@B@F@HAFAD@BA@@B@@@BA@@B@B@B@BA@@@@BAD@BABABABA@ABA@A@@B@B@@@D@@@B@B@@@B@B@B@B@@@BA@@@@B@B@@@BA@@@AB@@A@@@@B@@AB@BA@@@@@@B@BA@AD@BAD@B@B@DABAB@BA@AB@@A@A@ABA@@B@@AB@@@BABAB@BABADAB@@AB@@@BA@@BA@@BABAB@@@BA@AB@@ABA@@@A@@BA@@B@@A@AB@@A@AB@@A@A@A@A@AA@@AB@@@B@BA@@DAB@BAB@@@BAB@@@BABA@@@AB@@@@A@@@A@@@A@@@A@@@A@A@A@@B@BA@@BA@ABA@A@A@@BA@@BABA@@BA@ABABABABABC@@@AB@@ABA@AB@@A@@AAC@@AC@CACAACAA@A@@@A@AB@@ABABABABAB@DA@@@@BAA@@CAA@A@@@A@A@A@A@ABABA@A@@BABA@AB@@A@@BABA@@@A@A@@@ACAAAAA@AAA@@@A@@BA@A@AB@@A@ABA@A@@AA@AACA@AA@AAAAAA@AAA@AAA@@@@A@@@AB@@ABAB@DABAB@BABA@@BA@@@AAA@A@@AC@@@A@ABA@@@A@A@A@A@A@A@@@C@AB@@@B@BAB@B@D@B@@@D@@@B@@@@A@@@ABA@@@A@A@@BC@@@ABA@@@A@@@A@@@AB@@A@@B@@@BAB@@@@@BA@A@@@A@A@AB@AC@A@A@AAA@A@A@@@A@@@AA@@A@@@ABA@ABA@ABABA@@BAB@DCDAB@@@@@B@@@BA@@@@FCB@HCFCBAHCD@B@D@DAD@B@@@D@BAD@L@BAB@@A@@@@@@B@@@@@B@@@@@@@@@B@@@@@@A@@A@@@@@@@B@@@@@B@@@@@B@@A@@@@B@@AB@@@@AB@@A@@B@@@@@B@@@@@@@@@B@A@@@@@@@BABA@@@@@@B@@@@@@@@A@@@@@A@@@@B@@@@@BA@A@@B@@A@@BAB@@B@@@@@@@@B@B@@A@@@@@AB@@@AA@@BA@@@@@@@@@AB@B@@A@@@@B@A@@@@@@@B@@@@@@@B@@@@@@@@@@A@@@@@@@@@@BB@@@@@@@@@A@@@A@@@@@@@@B@@@@@@@@@@@@@@@@A@@B@@@@@A@@@@A@@@@@@@A@@@@@AB@@A@@BA@@@@@@@A@@@@B@@@@@B@@@@@AA@@B@@@@@@@@@D@@@@@@@@@A@@AA@@@@A@@@A@A@@@A@@@@@@B@@@@@B@@@@@@@B@@@@@@@@AA@@@@@@@@@B@@@BA@@@@@@A@@@@A@@@@@@@@A@@@A@@AA@@@@@@@B@@A@@@@@@@@@@A@@@@@@A@@@@@@@@B@@@@@BA@@B@@@@@@@@A@@@@@@@A@@B@@@@@@@A@@A@@@BA@@@@@@@AA@@AB@A@@A@@@A@@@@@A@A@@@A@@@AA@@@@@@AA@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@C@@@@@@@A@@@@@AA@@@@@A@@B@@@@@@@@@@AA@@@@@@@@@B@@@@@@@@@@@@@@AAAA@@@A@@@@@@A@@@@@@B@@@@A@@@@A@@@@@@@@@@@@A@@@@@@@@@AA@A@@@@@A@@@B@@AB@@@@@A@@@A@@@@AB@@@@@@@@@@@@@@@A@A@@@@A@@@@@@@@@@@A@@@@A@@AA@@@@@@@@@B@@@@@@@@@@@@AA@@@@@@@BA@@@@@@@A@@@@@@A@@A@@B@@@@@@@A@@A@@A@@@@@@B@@@@@@AA@@@@@@@@@@@@@@@@@A@@@@A@AB@@@@@@@A@@A@@@@BA@@BAAC@@@AA@@A@@@@@@@B@@@@@BA@@@@@@@@A@@@A@C@@@@@@@@@@AB@B@@@@@@@AAA@@@@AA@@@@@@@@AA@@@@@@A@@@@@@@@AB@@@@@@A@@@@@@@A@A@@B@@@@@@A@AA@@A@AAAA@@@@A@@@@@@@A@@AA@@@A@@@CA@@@@@A@@@@@@A@@@@AA@@@A@AA@@@@@A@@@@B@@@@@@A@@@@A@@@@@@@A@@@@@@AA@@A@@@@BA@@@@BB@@@@BA@@@@@@@AAAA@@@@@@@@@@@@@@B@@@@@@@@@@@@AA@@@@A@@B@@@AA@A@A@@@@@@@@@B@@@@A@@A@@@@@A@@@@@AB@@E@A@A@AA@@@@@@@@B@@@B@@@@@@@@@BA@@@@@@@A@@@@A@@@A@@@@@@@A@@BB@@@A@@@@@@@@@A@@@@@@A@@@@A@@@@@@BA@@A@@@@@@@@@@@@@@@A@@@@@@A@@@@@A@@@@A@@@B@@@@@@@B@@@@@@@@A@@@B@@@@@@@@@@B@@AA@@@@@@@@@@@@@A@@A@@@A@@@@@B@@@@@@@@A@@@@@@A@@@@B@@@@A@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@B@@@@@A@@@@@A@@@@@@@@@@BA@A@@@@@@@@@@A@@@@@@@@A@@@@@@@AA@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@A@@@@BA@A@@@@@@@B@@B@@@@@@A@@@A@C@ABA@A@ABA@A@@@A@A@CBCB@BABAB@@AB@B@@B@BB@AB@B@@@@BA@ABA@@@A@@A@@A@@@A@@BADABABA@ABC@CBAB@BA@@B@BABABAB@B@@@@@BAB@@@@@BB@@B@@ABA@ABAA@@ABAB@B@B@@B@@B@BCB@BAB@B@B@B@@ABA@AAA@A@C@@BA@@@@BB@B@B@@AB@BB@B@@@BA@@B@BB@B@B@@@@@B@B@@@@@AB@@A@@B@@A@@@A@@@A@@@@@@BAB@@@B@BA@A@@@A@@@A@@@@@A@A@@@A@A@@@AAB@@ABA@@@A@@AAAA@@A@A@AAA@@@A@@@A@ABA@A@@@CB@B@BA@ABCBA@A@AAA@A@AA@@AA@@AA@@A@A@A@@@AA@@A@A@A@A@A@A@@@@@@A@@@@@@A@A@A@@BA@A@@@AAAAA@AA@A@@@A@A@@AAAAAA@AA@@AA@C@ABA@@@@B@B@@A@A@A@AA@@@@@@BA@@@@@@@AA@@@A@@@@BAB@@@B@@@@AAA@@A@A@@@A@@A@@@AAA@@@C@A@AAA@ABA@A@A@A@A@A@@@@@@A@@@@@@@AA@A@A@AB@@@B@@BB@@BB@@A@@BA@ABA@@@@B@@@BA@A@@@AAA@@@A@@@@A@@@A@@A@@@A@A@AAA@CAA@@@@A@@B@B@@A@@@@B@@A@@A@@@AAA@@@A@@@@AA@@BA@@@A@@A@@A@@@A@@AA@A@@B@@AB@@A@@@AA@@A@A@A@@AA@AAA@C@A@@@A@A@AAA@@@@@@A@@@@@@CAA@A@@@BA@@@@@@@@AA@@A@AAA@A@A@AAA@ABA@AB@B@@@@B@@B@@AB@@B@@@B@@@@@@BABA@ABC@A@@@A@AAA@A@A@AB@@@@@B@@B@@@B@@@@BAB@DAB@@@@@BB@@@@B@B@@@BA@@@@B@@@@BB@@@@A@A@@B@@A@@@A@@@A@A@@@AB@AA@@@B@@@B@@A@@A@@@AB@@A@BB@@@@@B@@@@A@A@@AA@@A@@ABA@A@A@AB@@A@@@A@@@A@A@@B@@B@B@BBB@@@BB@@@BB@@@@BA@@@A@@@@AA@@@A@@B@@@@B@@@BB@@@B@@@@A@@@@@@@@B@@@@A@@@ABB@@@@@B@B@@@@@ABE@A@ABC@ABABADABAB@B@B@@ABEBABA@A@A@@AA@@AAA@@A@A@@@A@A@@@A@A@ABCBA@A@C@A@A@A@A@C@@@A@@AA@C@CAA@CBC@CB@B@@ABA@CBCAA@A@AAA@@A@A@A@@@A@@@A@A@AAA@AA@@@CBA@A@A@@AC@E@A@A@@A@A@A@CB@BA@@@C@AAA@@ACAAAA@CAAAC@AA@@@E@ABCBA@A@GA@@@AA@@ABADE@@@A@@A@E@C@A@A@AAA@A@A@ABA@A@A@@@AAA@@AAA@@@@@A@@A@A@AB@@@B@B@@A@A@A@@AAA@@AAA@A@AAAA@AA@C@AAAA@AAC@ABA@AK@C@E@GBE@EBEDCBAD@DABADA@A@A@@AAAC@A@A@CAC@C@C@C@C@CAAAACAA@AACAAACCA@AAEACACAAA@A@C@E@AAAA@AAAACA@@A@C@@@AA@A@A@A@@@C@A@@AA@@@G@ABA@EBC@C@EBCBCBC@ABA@C@C@CBABADBB@@BBD@BB@BABABA@C@A@AACBC@C@C@A@A@C@A@AB@@@B@B@@BBA@CBA@@@AAA@@@A@@B@B@@@BA@A@A@A@@AA@A@A@@@A@A@@@AA@@@A@@A@ABA@A@A@A@@@BABA@@A@@@A@ABCB@BAB@BBB@@@B@@A@A@ABA@@AA@@AA@A@A@A@A@@@@A@AA@@@ABA@A@C@C@CBAAC@CAAA@A@A@@@A@@A@A@@AA@@ABA@A@@@AA@AAA@@AB@@AB@B@B@B@B@B@@@B@B@@@BAAA@AAAAA@@@AB@@A@@@@IAA@@@ABA@@BAA@@@@@A@A@A@@AACAAACAA@C@A@CA@@AA@AB@@@DAB@BCBABABAB@@AACAAAC@@@A@ABAB@@A@@@AB@@ABABA@A@@@AA@@@ABA@@@A@A@AA@@@AB@B@B@BA@@@A@@@@A@C@@@@A@@@A@@@AA@@@AB@@@BABA@@@AB@BA@@@@@AA@@BABA@ADABABABAD@BAD@B@B@@@BB@@@B@BB@@BB@@@@A@@@A@A@ABA@ABCAABAAC@A@A@AA@@@@C@@@AA@@A@C@C@@AAAA@@@AAA@@@AAAC@AAC@A@G@A@@@@A@@@A@@@@@AA@A@A@A@ABAB@@A@A@@AA@A@A@A@@@@B@@@BA@@@A@@A@@@A@AAAAAA@A@A@A@@@A@C@AAA@@@@AA@@AC@A@@@AB@BA@A@@@A@@@A@A@CDA@AB@@BB@@@B@@@BA@@@A@A@@@@AA@AA@@A@@AB@B@BA@@B@@AB@B@@A@@@AA@@@C@AAA@@@@A@@@@B@B@@@@A@@A@A@AAA@ABA@ABABBB@@BB@@A@@@A@@@A@A@@@@@A@@@@@@BB@BB@B@@@@A@A@@@@AAAAA@A@A@A@A@AA@@AA@@A@@@@B@@@@@B@@@BAB@@@B@B@@@B@@@B@@B@@B@@@@@B@@@@@B@@@A@BA@@@@@@@@B@@@@@@A@@@@@A@@@@@A@@@@@@B@@@B@@@@A@@@A@@@@@@@@@A@@@AA@@@@@@A@@AA@@@@@@B@@@@@A@@A@@A@@@@@@A@A@@@@@@D@@@@A@@@@A@@@@@@@@@@C@@@A@@A@@@A@@@@@@@@@@@B@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@A@@@@@A@@@@B@@@@@@A@@@@@@B@@@@@@@@A@@@@@@@@@@A@@@@@@@AA@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@A@@@@A@@@@@@@A@B@@@@@@@@@B@@A@@@@@@@A@@@A@@@@B@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@AB@@@A@@AA@@@@@A@@@@@@A@@B@@@@@@@@@@@@@B@@@A@@@@A@@@@@@@@B@@@@@@@@@@A@@@@@@A@@@@@@@@@@@B@@A@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@AA@@@B@@@@@DC@@B@BAB@@@@@@B@AB@@@@@@@@AB@@@B@@@B@@@@@@@@AB@@CBAAAAACA@AAAAA@A@ACA@A@ABAAA@@@A@@A@@A@@AA@@@C@@AA@@@@AA@@@A@AAC@A@@AA@@AAAA@@AA@@@CBADCNGDCDA@A@@@@@@@A@@@@AAA@@@@A@@AAAEAA@@A@@@AA@@A@CA@@A@A@AAC@@@A@A@CAA@AAA@@@C@AAA@A@@@AAA@A@A@A@@AA@@@AAA@@A@@A@A@AA@@A@A@C@@@@@@A@@A@@@A@AB@@A@ABA@A@ABA@A@AAA@A@EAC@A@A@A@A@@@A@@BA@@@AB@@A@@@A@@B@@@@@@AB@@@@C@A@@BC@@BABCBAB@B@@AB@@@@AB@@A@@@@@A@@@@B@@@@AA@@@@A@A@@@A@@@A@AAA@@AA@AA@AA@AA@@@A@@AA@C@A@AAA@@BA@A@@@AB@@AB@@@BAB@@@B@B@@AB@@AB@BA@AB@@@@A@@@A@@@A@@A@@AA@@@A@@@A@@@@@AA@@@@@@@AA@@@@AA@@@@@CAA@@@@@AA@@@@@@@A@@@@BABA@A@@@@@AA@@AA@@@@@@AA@@@@@AB@@A@@@@@@AA@@@@@A@@A@@@AA@@@A@AAA@@@A@A@@@A@@@@@A@@@A@@AA@@@A@@@@@AA@@@@@@@A@@@AA@@AA@@@A@@@A@@A@@@@AA@@@AA@@@CAA@@@@@@A@@AAA@@@AB@@@AA@@B@@A@A@A@A@A@@@@@@A@@@@BA@@@A@@@@A@@AA@A@@BA@@A@@@@@A@@A@@@@@A@A@@AA@@@@@@AA@A@@@A@A@C@C@A@@@A@@@@AA@ABA@@@@@A@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@A@@AA@@@A@@@ABA@@@A@A@@BAA@@@@@@@@AA@@@@A@@@CAA@@@A@@B@@A@@@A@@@@A@@A@@@A@@@@B@@A@@@A@A@@@@@@A@@@@@A@@@@@@A@AA@@@B@@A@@@A@A@@A@@@@BA@@@@@@AA@@@C@A@A@A@@@@@A@@@@@A@@@@@C@@@@BA@@@@B@@A@@@A@@A@@AA@@@ABAAA@A@A@AA@@A@A@C@@@@@A@A@A@AA@@A@@@A@@@AAC@@@@@AAAA@@@AB@B@@AA@A@A@@@AA@@@@@A@@@@AA@@@AB@@@@A@A@A@@@A@@@@AAA@@@A@AAC@@@A@@A@@@A@@@@A@@C@@@@@A@@AA@A@@@AB@@@@@@@@AA@@@BAB@B@@A@@BA@@@AB@B@BAB@BAB@BAB@@A@@A@@@@A@A@@@A@@BACO@ABA@@A@@AAA@@@A@CB@@@AA@@@A@@A@A@AA@A@@AAA@@@A@AA@@@AB@@@B@@A@@A@A@@@@AAAA@@@@@@A@@BAB@B@@AA@A@@@@@AA@@@A@AA@A@@@AA@@@@B@BA@@@@A@AA@A@@@A@@B@B@@AA@@@A@A@A@@@BA@AACAGBIB@@@@@B@@@@@B@@@@@@@AA@@B@DA@A@@@@@AB@@@@AA@B@@A@@AA@@AA@@@A@@@AA@@@@A@@@@B@@A@@@@@@A@@@AA@@B@@A@@@@@@@@@A@@B@@@@A@@@@@@@@@@@A@@@@A@B@@A@@@@@@@@@@AAB@@@@@@A@@@@@A@@B@@@@B@@@AB@A@@@@A@@@@@A@@@@@@@A@@A@@@@@A@@@@AB@@@@@B@@@B@@AB@@@BAB@@@BBB@@@D@HADEDC@AAA^@J]GOABABIHIHEFEFIHIHCBGHCBEFABE@ABGBGBGDEBGBEDEBGDCBC@ABABA@@BAB@BEFGHABABCDEBEDEBCDCDKDIDCBA@CBCBCBCBEDEDEBCDED@BA@@@@BA@ABAB@@CDAFABAB@BAB@@@@ADAF@@EBEDCBAFADAB@BBD@B@DA@@@A@A@K@A@GAA@OD@@@@AAA@@A@@AG@A@@AA@@@AA@CC@@AA@A@A@@CA@AA@A@A@@@AACA@@AA@@@@ACBAAA@@@AAA@A@@AA@A@A@@AA@A@AAA@@@AA@@@@AAA@@AAA@@A@@AA@@@@BA@@BAA@@A@@@@AAA@@@G@EAE@@@KAMAQC@@@@AAA@EAGCECGCEAGCGCGCECEAAAE@G@G@E@G@A@E@C@ABABCBCDEDCDCBEFEDEFCDEDCDEFCDEDCD@@A@@BGHGFGHGFABCDKDABC@@B@DCPAB@FAB@FAB@FAFAFAF@BAD@B@@QGQGA@sMYG[CE@EA@@DGKAGCEHEAECCAAAA@@@A@A@A@@@@@A@@AB@A@@A@@A@@@A@A@@@@@A@@@A@@@EA@@@@A@AACE@@AA@A@@CAAAACAA@AA@@ACCCA@AAAAA@@AAAAAC@AAAAAEACACCA@AAC@AAA@AAC@A@C@@@E@E@ACAC@@@CAE@A@A@AB@@@A@@@@@AAA@A@@A@A@C@U@CGE@EAG@A@AA@AAA@AAA@CBC@E@JEPFJLRDHFLDHAFABEH@NJJ@L@HGPBL@N@NAP@PBDHV@FJ@^JBFCTDN@^AJAFIR@UYNGF@PEL@T@LBRETFJBTBDKMKDIRMFMNGQMAALE@GWIWEEGAANGDEDKDELIGGMBEDC@MAC@CIKKIEI@JEWKFCGIUAOHYGMGO@SHC@A@C@MBA@C@OAEDqOkGeEEAKJKJGFKBA@@@M@S@gCFGQBOLIP"],encodeOffsets:[[128429,49859]]}},{type:"Feature",id:"231182",properties:{name:"五大连池市",cp:[126.205516,48.517257],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@IC@@BCBA@A@ABACA@BABA@C@@ABCBA@@C@CAA@@BAB@D@BAD@B@@GA@@AGE@E@C@@BA@@BAB@B@DAB@BABAAA@@AA@@@BCBCBC@@EC@@@ABA@A@@@@EAA@@@A@BA@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@A@@BA@A@AB@@A@@@@B@B@BAB@@@@@@AB@@ABA@@BA@AB@@A@@@@@@@@@A@@A@@@AA@@@@@A@@@@@A@AB@@ABA@A@@A@EAC@CAA@CA@@A@@@A@AA@@A@A@A@A@A@@@@@AA@@@@@@@@AA@@AAAAA@A@AAA@A@AAGCCACAC@@AG@CAAACACAGC@ACAAAECEAAACCACAA@@@AAAAC@AAA@@@AAECB@@AA@@A@ABA@@@A@A@AAA@A@A@AB@@A@A@A@A@A@AB@@@@@B@@AB@@EBA@A@AB@@@B@@@B@BA@@@A@C@@AA@@@A@@BA@@B@@@@A@@@A@A@AAA@@@AB@@ABA@A@C@A@A@@@A@AAK@A@G@MA@@A@A@@@AAE@A@A@A@@@A@@AA@@A@@AAC@A@@AAA@@C@A@AA@@A@A@@@CB@@A@G@AB@@@BA@A@@@A@A@@B@@@BABABCAA@CBC@ABCBC@ABE@CBE@A@A@A@@@@@@B@@@@A@@@AB@@@@@@@B@@@@A@@@@@@@@@A@@@@B@@@@@@@@@@AB@@A@@BA@CAE@C@C@EAC@E@CAC@E@C@EAC@E@C@CAC@E@EAE@E@E@E@E@E@EAE@E@E@C@E@EAE@E@E@E@C@EAC@C@A@A@AA@@A@@@ABAB@@A@@@@BA@A@@B@@@@@BAB@@@B@@AB@@@BA@A@A@@@@BA@@B@@AB@@@@C@C@C@@@@@A@A@A@@@ABEBABABA@ABABCBAB@@@@G@EB@@A@@BA@EFCBA@A@C@E@E@A@A@CBA@A@CA@@AAA@C@ABA@CDA@CBA@ABA@ABC@EBE@IDGBEBA@EDBD@H@F@F@DBF@B@F@DG@E@S@A@A@A@A@@@A@AA@@E@E@E@AAA@E@@@AB@@CDCBABEBEBEBC@GDGBGB@@ABA@@@@@ABA@A@A@A@C@A@C@A@C@C@C@A@C@@@A@A@A@A@ABA@A@@@ABA@@@ABA@CBA@C@GB@@@@@@@B@@@@AD@@ABABAB@@@@AB@@@@@B@@@@@B@@@B@@@@@BAB@@@B@B@@@B@@@@A@@@@@@@@@AB@@@@ABA@@@@@AB@@@B@@@@ADCFAB@BINABABA@@B@@AB@D@FADAD@DABADAH@DAD@DAFAH@@@@@D@BAB@B@B@B@BAB@B@@@DAB@B@DAB@@@@@D@@AB@D@BAD@BB@@@BB@B@BAHAF@F@@@BA@@@@DAHAB@BAD@DADKHCDCDABA@@BAB@B@@@B@BB@@B@@BB@@BBB@@@BB@@D@DBD@@@DAB@DA@@BABAB@@ABAADAFAD@B@@ABA@@BA@@@@@A@@B@@@@@B@@@@@@@B@@@@B@AFAF@B@B@@@D@B@D@D@D@F@D@B@D@F@D@F@F@JAF@VA@A@A@@AA@@@C@@@A@A@A@@@CB@@A@@BA@C@@BA@@B@@@BB@BBB@@@@B@@@@ABA@@@A@A@A@AA@@@A@@BA@@@@AA@AAAA@A@A@A@A@CBC@A@@BC@A@@@A@A@@AA@@@A@@@A@A@A@@BA@@BA@A@ABA@A@A@A@A@A@@@AA@@AA@A@@@@@@A@A@A@@@A@@@A@@@C@AAA@A@A@@@@@@@@@AB@@@BA@@BA@A@A@ABABA@C@A@ABA@@B@B@@ABA@A@A@@@AA@@AA@AECCAE@EAA@@@@DA@@@@BBD@B@BBD@F@DBF@FBD@DBJBF@FBHBF@FBNL@NBD@DGACEGCEF@F@FAD@F@F@HAD@FAFCFCB@@@B@BAB@B@DAD@@@BABD@BDFBDFH@BBA@@B@@@B@@B@@BB@BDDDBB@@@@@B@@AB@B@@@BA@A@A@@@@@@DBB@@@B@F@BAB@B@@@@@BBD@B@D@B@B@B@@@B@FADAB@DAB@B@BBD@BBBBFDBD@BBB@BB@B@BBB@B@HBDB@@H@B@@@B@@BBB@@AB@BAB@BAD@B@B@B@BB@@@@BB@@@B@B@B@B@B@D@DAFADAB@B@@@BB@@B@BB@BDF@B@B@B@@BB@B@BB@BB@B@@@@BBBBB@F@HAH@JAD@F@FBCFCFAFEHADCFCHABADAFCDAD@@@@D@@BHDFBFDFBDBBBB@DBDBFDFDD@BBDBDBDBHDFDHDFBFDDBDBDBHDFDFBFDFBFDFDB@LAFA@@B@BAB@@@B@D@@@@@B@@@@AB@@AB@@@@@BBDB@BB@F@@@D@@@B@PF@BAD@@@F@@@D@@@B@@B@@BHD@@BBD@@D@B@BBR@B@B@@@B@@@B@B@B@B@B@B@@@B@@@B@@HPI^]@BB@BCDCFGBC@@@A@AA@@A@AB@@A@@BA@@@A@@@@@A@@B@@B@@@@@B@@@@B@@@@@@@B@@@@@B@@B@A@@B@@@A@@A@@@@B@@@@@B@@@@A@BB@@@@@@@@@@@BA@B@@@@@@B@@@@@@@@@@@B@@A@@@@B@@@@@@@@@BA@@@BB@@B@@@@@@@@BA@@@@@@B@@B@@B@@@B@@BB@@BB@@@BA@B@@B@@A@@B@@@@@BCBA@@@BB@@@@@@A@@@@@A@@@@@A@AJBHBD@BAB@@B@B@B@@@B@@BA@A@@@@B@@@BBBB@@@@@ABA@@@@@BB@@B@B@@B@B@@BB@@@@B@B@@BA@A@AB@@@B@@@@B@BB@B@@B@B@@@@BA@@@A@@B@@BBB@@@B@BB@@@BBBB@B@@@@B@@BB@@A@@D@B@@BB@BB@@@AB@BDPAB@@@B@@@B@B@@B@@@@BA@ABA@ABA@ABA@A@@B@@AB@@@BA@A@AB@@B@@B@@@@@@A@@B@@@BBB@@@B@@@@@DB@@@@@@B@@@BB@@@D@BBB@@@B@BB@@@@@B@@@B@B@B@@A@@B@@BB@@@@@B@@@@BB@@B@B@B@@BA@A@@B@@BBBB@@@@D@BB@@B@@@B@@@BBB@B@B@@@@@D@B@B@@@BBB@B@B@BBBA@@B@@BB@@@@B@@@BA@@@@@AB@@@@@D@@@@@B@@@@@B@@@@@B@B@B@D@@BB@@@@@@AB@@@@@BB@B@@@B@@@@A@@BBB@@@@@@@@B@@@@@B@@@@B@B@@@B@@@@A@@B@@@B@@@@B@@B@@@B@@@@AB@@@B@DB@@B@@@@@BB@@@@@@@@BB@AB@B@@@B@BA@@B@@@B@@BB@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@@@B@@@@@B@BAB@@B@@B@@@B@D@D@B@B@@@B@B@@B@@@@B@@BB@B@@@@@B@@@@B@@@@@BB@@AB@B@@BB@@@@@@B@@AB@@@@@B@@@@B@B@B@B@B@@@@AB@@B@@BA@@B@BB@@@B@@@@B@DB@@B@@B@@BB@@@@@BB@@@B@@@B@@BB@@B@@@B@@@@@@BB@@@@B@@@B@@BB@@@B@@@@@B@@@B@B@@@B@BBB@@@B@@B@@@BB@@@@@B@@B@@@@B@@@BA@@@@B@@B@@@@B@@BB@@B@@@@@BABAB@@@@@B@@@@@@BB@@@@B@DB@@@@B@@B@@B@@B@@@@@@BB@@@@B@@@B@@@B@@BB@@@@B@@@B@@@B@@A@@BABA@@BA@@BA@A@@@A@AB@@A@@BA@@B@@@BAB@@BB@B@B@DBB@@@B@@BBB@@BBBB@@BB@BBB@@@B@@@B@B@@@@@BB@@@@@A@@B@@@@@B@@@BA@@@@BA@@@ABADABA@AD@@AB@D@@@@@BA@@@@@@@AB@@@B@@@BA@@B@@AB@@@B@B@B@B@D@FBB@B@BBB@B@BAB@B@BAB@@@BAB@@@B@@@@B@@@@D@B@B@@@BBB@B@@@@BB@BB@@B@@BB@B@B@B@BB@@B@B@BBD@@@B@BBB@DBB@B@@@D@BBB@B@@@DBB@@@BB@@B@@@BBBFBB@@@B@@B@BB@@@@@B@@@@@@@BCBCDMHCDAB@D@@BB@@BBBB@@BB@@@BBD@B@B@@BB@@@@BB@@@D@@BB@@@BB@@@@B@@BBAB@B@BDB@B@BBBBB@BDBBBBBAB@DA@@B@@@@@@A@@@A@@@A@@B@@@@@@A@@B@A@@@@A@ABA@@@CD@@@@A@@@B@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@BA@@@@@@@@@@@B@@@@@@@@B@@@@@@@@A@@@@@@@@@@B@@B@@@A@@@@@@@@@@@@@A@@@@B@@@@B@@@@@B@@BB@@@A@@B@@@@@@@@@@B@@@@@@@@@@@B@@@@@A@@@@@@B@@@B@@@@@@@BA@@@@@@@@@A@B@@@@@@@B@@@@@@BA@@@@@@@@@@@@@@@A@@@@@@@@@@@@@BB@@@@@@B@@@@@@@@@@@@B@@@@@@A@@@@@@@@B@@@@A@@@@@@B@@@@@BB@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@A@@@@@@@@@@@B@@@B@@@@B@@@D@@@@@@@@B@@@@@@B@@C@@@@@@@@B@B@@@@B@@@@BB@@@@@A@@@@@@@BB@@@B@@@@B@@B@@@B@@@@@@@@@B@@@B@@A@@@A@@@@@@@@B@@@@@B@@@@@B@@@@A@@@@@@@@@ABB@@@A@@@@@A@@@@@A@@@@AA@@@A@@@A@A@@@A@AB@@A@@@@@A@@@@@@BB@@BB@@B@B@B@B@BBBBB@B@@B@B@@@@@@AAAA@@A@@@@B@@@@@B@B@@@B@@@B@@@AA@@AABABAB@BAB@BBB@B@@@@B@@A@A@@@@@@B@@B@BBD@@@B@@B@@@BA@A@@BA@@@ABA@A@@BB@@@BBB@@B@@B@B@@@B@@A@@@A@@AA@@BAB@DCB@B@@@B@@@B@B@@ABA@@B@D@@BB@@B@@B@BBD@B@@@B@B@B@B@BBBB@B@B@@@BB@@@B@@A@@@A@@B@B@B@B@@BB@B@@@BABAB@B@B@B@@B@@@@@B@@@B@@B@H@B@D@BBD@BB@B@@BB@B@@BBBB@@@D@D@BB@@B@@@D@@B@@B@B@BBDABBBAD@BAB@B@B@B@@@B@@A@@AA@@A@A@@AA@@A@A@C@ABC@ABABABCB@BABAB@@BB@@@@B@@ABA@@B@BA@A@@BA@@B@@B@@@B@@@B@@D@B@@@@@@B@@ABA@A@A@@B@@BBB@B@@@B@BA@@B@@B@@@BABAB@BA@@B@@@BA@AB@B@B@@BDBBBD@BA@ABABABADA@CB@@A@@BBB@@DBB@D@B@DBBBDBBB@@@B@B@B@@@@BB@AB@BA@@B@JB@@@@@BA@@B@@BBBB@BBBAB@@A@A@@@A@A@A@A@A@A@@BA@@BB@BBB@@B@@@BAB@BB@@BB@B@@@@B@@@B@BBBDBD@BBDAD@D@B@B@BA@@B@@B@B@@B@B@B@B@B@@BB@@BB@BAB@B@@@@A@@AA@ABA@ADABAB@@@B@@@ABAB@@B@B@B@B@BAB@@@@B@@BB@@B@B@@@B@B@B@@BB@B@B@B@@A@@@A@AB@@@B@BB@@B@DAB@AA@@@A@A@@BAB@D@B@B@D@D@D@DABBB@D@B@BABA@AAAC@AA@@AABCBADAD@D@B@BAD@DADAFAD@D@FAB@BAH@@@B@@BB@D@@@B@B@B@B@@B@@@D@BB@BDBB@BBBBBF@D@B@B@BBBDBDBF@BDBBDBBBD@BBBBDBBDBD@D@D@D@D@DBB@B@D@BB@BB@B@B@BCBA@CBCDAFCFAF@HAF@D@L@@BAB@BBD@BBBBBD@B@@BBBBBB@B@BB@@BB@BB@B@B@@@@A@A@@BAB@B@@@@B@@@@BB@BB@BB@@B@B@B@BAB@B@B@BBB@B@D@F@B@@@@B@@CFAB@BB@@B@@HBB@B@DABAF@@@B@@BBDBB@DBBBBBD@@BB@B@D@@ABA@@D@B@B@BB@B@F@D@@BB@B@B@DA@@B@@BBB@B@B@B@@@B@@@B@B@BB@BBB@B@DBDAB@BA@@@ADAD@DAB@DBD@B@@BB@@@D@B@B@B@B@D@B@B@DABAB@B@@@B@B@@@B@B@@@BB@BB@@BB@B@B@BAFABA@@@A@ABABABCBABAD@BAB@F@BA@@@@A@A@@@@@A@BA@@B@@@@@@A@@@@@@B@@@@@@A@@AA@@A@@@@@@AB@@@B@@B@@B@@@B@@A@@A@@A@@AA@@A@AAA@A@@@@AB@B@@@B@@@B@@@BAB@B@B@BA@@@BB@@BB@B@@@@@@A@@@@AAB@@@BA@@B@@@@BA@@@A@@@B@@BBA@@B@B@@@B@@@B@@@@AB@B@@@@@AA@@@@@A@@B@@A@@@A@A@@A@@A@@@@BA@CBA@A@@A@@@A@@@@A@@@@BAB@B@B@BBB@@@B@D@BAB@BA@A@@@@A@@@A@@@BA@@@AA@@@@@@ABAB@BAB@BBB@B@B@BBB@@@BB@@@@@@@@AB@@B@B@DB@@@@@@@B@@@@B@BBB@B@@@B@D@B@BBB@@BB@B@B@@@BB@@B@@@BA@@@AB@B@@BB@@@B@@@@BB@@@B@@AB@@B@@B@@@B@BB@@B@@@@BA@@@@@@BA@A@@@@B@@B@DBB@BBB@B@@@B@@@@B@@@B@@B@@@B@BB@@B@B@@A@@@A@@B@BAB@@AB@@@AA@@AA@@@A@@BAB@B@B@@B@@@@@@@B@@@@B@B@B@B@B@B@BAB@BBB@D@@@B@BB@@B@@@@B@@@B@BB@BB@@@@@A@@BA@A@@B@@@B@@B@@@@@@AB@@@@@@BBB@B@B@@@@A@A@@B@BAD@B@@BB@@BBBBBBB@@@B@B@@@BBBB@BBBB@@B@B@@AB@B@B@@@@@@@@B@@@@B@B@B@B@B@B@@@BB@@B@B@B@@@BB@@BB@@BBB@B@BBB@B@DABAB@@A@ADA@@B@B@BAB@@@B@@@B@BBB@B@@@BBBB@@@B@@AB@BA@BB@@B@B@@@B@B@@@@@B@@@B@@@B@B@@A@A@@BA@A@@@@B@@@B@@@B@@@@AB@@@BA@@@@A@A@@@@@A@A@A@@A@AB@@A@@@AAAA@@BA@A@A@@A@@B@@AD@B@B@BBB@BA@@@A@A@ABA@ADA@A@AA@@@@A@ABABA@@BBBAB@BA@@@AA@@A@@@@BA@A@@@@@ABABABA@A@AB@@ABADAD@BAB@BABABC@AB@@@B@@@@BB@@@B@BAB@@A@@A@A@@BAAA@@@@ABA@@BABA@ADADAB@B@@@B@B@BAB@B@BAD@B@@@B@@@@@@@@AA@@@@@@@B@B@@A@@B@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@BB@@@@@@B@@@@@@@@@@B@@@@@@@@B@B@@A@@@@@@@@B@@@@@B@@@@@A@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@B@@@@@@A@@B@@@@@@@@B@@@@@@A@@@@@B@@@B@@@@B@@@@@@@@@@@@BB@@@A@@@@@@@@A@@@B@@@@@@@@@@A@@@@@@@A@@@B@@B@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@BB@@A@@@@B@@@@@@B@@@@B@@@@@@@@@B@@@A@@AB@@@@@@@B@@@B@@@@@@B@@@@@@AB@@@@@@@@A@@@A@@@@@@@@@BBB@B@F@@@BA@@@@B@@@@@B@@@@B@@A@@@@@@@@@B@B@B@@B@@@AB@@@@@BB@@@@@@@@@@@@@A@@@@@@@@@@@@B@BB@B@@@@@@AB@@@@AA@@@@AB@@@@@BB@@B@@@@B@@@@@@@B@@@@@@B@@@@A@@@@@@B@@@@BBB@@@B@@B@@B@@@@@@@@B@@@@DB@@B@@@B@@BB@@@@@@@B@@@@@BBBBB@@@BBB@@@@@@@@AB@B@@@@@@@B@@@@@@@BA@@@@@@@@@B@@@@B@@B@@@@@@B@@B@@B@BB@@@@@@A@A@@B@@@@@@@@D@B@@@B@@@@@@@@@AB@@@@A@@@@@@@B@@@BB@@D@BB@AB@@A@@B@@@@B@@@@@@BAB@@@@@@B@@@@@@@@@@@@@@@@BB@@@@@@@A@@@@B@@@@BB@@@@@@@A@@@@BB@@@@@@@@B@@@@@@AB@@@@@@B@@B@@@@@@@@@@A@@@@@@@@@B@@BB@@@@@@B@@@@@@@@@@@B@@B@B@@@@@@@@@@@@@@@A@@B@@B@@@B@@@@@A@@BA@@@B@@@@@B@B@@B@@@@@@@@@B@@@@@@@@@@B@@@@@@B@@A@@@@@@@@B@@@@B@@@B@BB@B@@@@@@@@@@@@A@@@@@@@@@B@@B@@@@@@@@A@@@@B@@@@BB@@@@B@@@@@@@D@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@BB@@@@@@BB@@B@@@B@B@@@@@B@@@B@@@@BBA@@BB@@@@@@B@@A@@@BB@@@@@@@A@@@@B@@@@@@@B@@B@HHBBFBBBBBBBDB@BD@DBDBB@B@BAD@B@B@D@B@DABBD@D@B@D@D@F@@@D@D@@@B@@AB@BA@@B@@AFADAF@BADAB@B@DAB@BAB@BA@@BA@@D@BAB@@A@@@A@AB@@@@A@@@@@ABA@@@C@@BA@@@AA@@A@@CA@A@@@AAA@A@@@AA@@AAAAAAAA@AA@@AACA@@AA@@@@@@@A@@B@BAB@BA@@BA@A@A@@BA@@@AB@@ABA@@B@@@BAB@@ADAD@BAB@@A@@B@D@D@F@D@@@B@B@B@BBB@D@BBB@B@@@B@B@@@B@B@BA@@B@BAB@BAB@BA@@@@BA@@@@@A@A@@@AB@B@@AD@B@FADAB@BA@@BA@@@AB@@A@@@A@ABA@@@@AA@@@AA@AAA@AAA@CAAA@@A@@A@@@@@AB@BAD@@AB@@@BA@AB@BABAB@@AB@DAB@B@@AB@@@@A@@@AB@@AA@@@@A@@@A@@@AB@@AB@@@B@D@B@B@B@DBB@B@B@B@@@B@B@@AB@BA@@B@@@B@@@BBDBBBDBB@@@BBB@@BB@BBB@@@BBB@B@@@D@D@B@D@F@D@@CBE@A@A@A@CBA@AD@DCBADADCFEBAD@FE@@BABADAH@DAFCBADADAD@BAB@B@F@B@D@B@B@B@B@@@B@DAB@BADADADC@AB@@A@ADADADABADADADAD@@@JCD@DAB@@@B@HCBAAAAC@A@A@A@AB@BADBB@B@BAB@BCB@@ABBD@B@DBBBH@B@BBBBBBBBB@B@B@BBB@@@@A@@@@DAB@B@DABABABADABA@@D@B@BB@@BBB@B@BADBD@B@BABBB@@B@BBBB@B@DBD@FAB@D@@A@A@@AACC@A@A@@BA@C@AAA@A@C@A@@@AAAAC@A@AA@@ACEACAAAAAAAAA@A@@A@@ACA@@A@@@A@ABABCBABABABAD@DAD@DABAB@@@DAFADA@@@@FAF@DAB@B@BA@A@@BA@@@A@@@A@@@@@A@@@AA@AA@@@@BA@ABAB@DAB@BADABAB@F@B@B@BBBBDBB@BAB@B@B@BA@ABA@ADB@@DBB@DDB@B@DAFABAB@F@DBB@F@B@BBD@BBB@B@BABA@ABBBB@BB@BAB@B@BB@B@BBB@B@BDD@@@B@BBBBBD@B@@@B@F@BBB@BBB@BBB@@@ABCB@@@B@@B@DBBAFAB@B@FA@@F@@@D@B@B@BAF@F@BBB@@BFBDBBBB@BB@@BBBBB@BBBBDBB@B@BDBBBBB@BBB@D@@BDBDBBBB@@B@@@@DDHDBBFBDDFDB@HFFDB@@BBBB@BBB@DB@@@BBBDBDBDB@@@D@BE@MBKBKDODKBEDABKBO@QBM@SAO@KBCD@FCHGJIF@DCF@FDBBNBBBBNBCJNBAJJ@LCJCNEDELAPCFEHCFHJBR@R@HAJADGFEJILCFC@GPED@J@F@HCJEHKBAH@@@@@@A@@DCBC@ABA@@DABAHAHCDABABADABCB@@ABAB@@@BAFCBA@@BCBABADAHADADAB@B@@@B@B@FBD@F@B@D@FBBAB@F@B@DBBBB@B@B@B@BBB@F@B@BBD@BBBBD@@BB@B@@B@@A@@@B@@BB@@@@B@@BB@@B@B@BB@@BB@@@@D@B@@BB@@@@BB@@@BBB@BAB@B@BB@@B@@@@@@@B@@BB@@@@B@@@@BB@@@@B@B@B@BBBBBB@@B@@B@B@@B@@@@@@@@B@@@@@B@@@@@@AB@@B@@@BB@@@@@@@@@B@B@@@@@BA@@@A@@@@@@B@@@@@@@B@@@@@B@@B@BBA@@@@BB@@B@@@B@@@@FBB@@B@@@B@B@@@B@@@DBB@@BBB@@B@@@@B@DB@@B@@B@@@BA@@@@@A@@BBD@BA@ABAB@@@BB@@B@@@@@BA@@@@B@@AB@@@B@@BB@@BB@@B@@@@@BB@@B@@@@B@@A@@@@BB@B@B@@BB@@@DBB@B@B@BBB@@@B@@@B@@B@@@B@@B@@@@B@@DBB@@B@@A@BB@@B@@B@@@@A@@B@@zMUtKUàM¤IFAD¼D¸ìƌgŐaFwNGD¡x©ZgGcGcEYSQ]GCiMYMI½UEEAKEX]HEv]@uomYQEaEoASILUjIFfCCsu\\w@eijYJEgbWTuFON}Fm_uHiCAC@CAAAA@@A@@AA@C@A@CA@@AA@@@A@CA@@A@@ACAA@AAAAA@@AA@@AAA@@@A@A@A@AA@@A@A@A@@A@@ABC@A@@@AA@@@AAC@C@A@AAC@AA@@AAAAAA@@AC@A@@AAA@AA@@AA@@@AB@@@BA@@B@B@BA@@@@@AA@@A@AA@@A@A@@AA@@@A@A@A@@@A@A@AB@@AB@D@B@BA@@@AA@ACCAAAA@AAA@A@C@C@@@A@AAA@A@C@A@A@AAA@@A@AA@AAA@@@AA@A@@@A@CA@@A@A@@AA@@AAAA@@AA@@@A@@@A@@AAAA@@AA@@@@BABA@@@A@@AAACAAA@@AAA@@A@@A@EAA@A@A@@AAAAA@@AA@@A@@@A@@@AA@A@E@@BA@AAA@AA@@AA@@AA@A@A@A@@@ABA@ABABCB@BA@ABAA@@AA@AAAAA@AAAA@@@A@@@AA@@AA@@A@@BA@ADA@AB@@A@@AA@@@@@A@A@@AA@@AA@@@@@A@@@A@ABA@@AA@A@A@AB@@AB@DAB@B@BB@@B@BA@@@AA@@A@A@@@A@@DCBAB@BABA@A@@@@@AGAAAA@AA@AAA@AAA@AAA@AA@AAAAA@A@AAA@@A@AAA@A@AAA@CA@AA@AA@A@A@E@C@A@A@E@A@A@C@A@ABC@A@A@@@A@A@@@A@A@C@A@@@A@@AA@A@@AA@@B@@A@@@@BA@ABA@A@EBABCB@@CB@@CBCBC@ABA@CBA@A@A@CAA@CAAAA@AAAAAAAA@@AA@@A@@@A@@@A@@@ABA@ABA@@@@@@@C@@@AAA@A@@AAAAA@AAA@@AA@A@A@AA@@AA@A@A@@@CBA@EBA@ABC@AAA@@@A@@A@A@C@AAA@A@@A@@@A@A@A@A@AAA@C@A@AAA@A@CBA@E@A@A@AAC@CAC@AACAEC@@AAA@@@AAAAA@@@AAA@A@CAC@AAA@CAAAA@AAA@A@A@AAA@AAA@@AA@@AAC@AA@@A@A@A@@BCBC@A@A@C@CAA@A@A@A@A@AA@A@CAECC@AA@@A@A@CBA@@@@AA@BAA@@A@AC@@AA@@AA@A@A@CAC@A@AAAACACACAA@AAA@CA@@@@C@ABC@ABA@AB@@ABA@ABCBABA@E@A@@@A@@@@AA@@@A@AACAA@AAAAC@AA@@AAA@AAAAAA@AA@@A@@@A@A@A@A@ABA@ABA@@@A@@AA@@AAA@AAA@C@AA@@A@A@@BA@@BA@@@ABA@C@A@C@CB@@A@@@@BA@@B@B@@AB@@@BA@@@A@A@@BA@ABA@ABCB@@A@CDABA@CBABC@@@C@A@@@A@@@@A@@@@BA@@@@@AAA@AAA@@AA@@A@@AA@A@CBA@C@C@A@A@A@EA@@A@AB@@A@@@ABABABAB@@A@CBA@A@C@AAA@A@AAA@@@A@@AA@A@A@ABC@@@A@ABA@A@@BA@@BAB@BCBABABABCBEBEBABA@AB@@@@@BAB@@@B@BA@B@@D@BAB@@AB@@A@A@@@A@A@@BAA@@A@AA@@AA@AAA@@AACAAAAAC@AA@@A@A@A@CBC@ABA@@@AAA@AAA@AA@ACAAAA@A@@@A@A@@@A@A@A@AAC@A@A@AAA@AAA@AA@@@AA@@@A@A@A@A@AAC@AAA@A@@@CAAA@@A@AAAA@@A@A@CAA@A@A@A@A@AACAAAA@AAA@AA@@C@A@C@@@A@@A@@@AAAAAA@AAA@A@A@CAC@AAC@@@C@@@AAA@@A@AA@AA@A@@AAA@@AA@@AB@AA@@AA@@A@AAA@AAA@@AA@@A@@@A@AB@@A@@BA@@@@@A@A@A@A@A@@AA@@AAA@A@AAA@@AAA@A@A@AA@A@CACACAA@C@AAA@A@@@C@A@C@@@A@@BA@@@@B@BAB@B@@AB@B@@ABAB@B@@A@@B@@@BABCBABAB@BA@CBA@AB@@@@@B@B@@@BBB@@@@@BA@@BA@CBCBAB@BA@@@BDA@@B@B@@@@AB@@@B@B@@@B@@@@BB@@BB@B@BBB@@@B@BB@@@@BAB@@@@@B@@@B@B@@@@@B@@AB@@@@@BA@@@@B@B@@@@@B@@@@@@A@CBA@A@@@@BA@@B@BABAB@BA@@@A@C@A@@@AB@@@BA@BB@B@B@@@@AD@@@B@D@B@B@@BB@B@BB@@BBBBB@@@B@BAB@BB@@BBB@B@B@B@@@@@@ABABCBCBAB@@@@@@BD@BB@BD@BDBB@DB@@B@D@D@B@@@@@B@@B@@@@BB@B@@@B@@@B@@@@@B@@@BB@@B@B@BAB@BADABADAB@BAB@@@B@F@H@B@B@B@BA@ABABA@ABA@@@ABA@@BAB@B@@@B@@AB@@CB@@ABA@@@EBABCBCB@@@@@@@B@@ABA@AB@@ABA@A@@@A@A@@BABADAB@@ABC@ABA@@@AB@@A@A@AB@B@@@BAB@B@DAB@@ABA@@@A@A@A@A@A@A@A@A@C@@@A@@@AA@@@@A@A@A@A@A@A@A@A@E@CBC@A@CBA@A@A@A@ABA@@@EB@@A@@@A@CAAAA@A@@@A@A@@BA@ABA@A@CBC@ABA@A@ABABA@@BAB@@A@@BC@ABA@@BA@@B@B@BBD@@@BABAB@BAD@@@BA@AB@BADABAB@B@@AD@B@BAB@B@D@BAB@@A@A@@BA@@@A@@B@@@B@D@B@@AB@B@B@D@BA@ABA@ABA@AB@@A@C@@@A@A@A@@@A@@BABAD@B@@ADAB@@A@EB@BA@@@@@BD@D@D@B@D@@AB@@A@A@E@A@@@A@@B@@A@CBABAB@@@B@B@BBB@B@BA@@BABABABA@A@A@CBA@A@@@@B@BCBADA@@B@BA@AB@@CB@@A@@B@B@@BB@@B@@B@@@B@@ADAB@B@B@B@BADADCH@BABABAB@@AB@@AD@B@BBBDD@B@BBD@@BBB@@B@@@B@@AB@B@@@BBD@BBB@@@@@@@B@@@@AB@@A@E@ABA@ABA@AB@BABAB@BAB@@@BBB@D@FA@@BAB@B@DAB@B@BAB@B@@@@ABA@ABA@CBGBA@@BA@@BCHADBF@BB@@BB@BBBB@BBDBBB@BB@@BB@@@BA@AB@B@BBB@@BBDBBB@@BBB@@B@@@@AB@@A@ABA@@BAB@@@@@@C@@@C@A@@@CBA@@@@@AB@D@@@BA@ABCBA@A@ABA@@BABA@@BA@E@ABC@A@A@A@AB@@@B@B@@@B@@@B@@A@@@A@C@E@C@A@@@ABA@@@@BA@@@@@E@E@A@C@A@C@A@@@A@ABA@@@ABAB@B@@@B@B@@A@@@@@A@ABA@ABA@A@@B@@A@AB@@ABAB@@@B@B@D@B@BAB@@@B@@A@@@A@A@@@AA@@A@C@A@AAA@A@A@@@@@C@AAA@C@CACAA@A@@@ABC@ABA@A@@@A@A@@@A@@@A@@@A@A@A@A@C@@@AAAAAAA@A@@AC@AAA@@@A@@B@@A@ABABC@A@CBA@A@A@A@A@AA@@C@C@C@A@C@A@A@A@A@@@C@@AA@A@@@A@@@AB@BC@@BA@A@CBA@C@EBA@A@A@A@@@C@A@@@@@A@AAA@A@CAA@EAC@A@A@C@C@@@A@@BABAB@@@BAB@B@@AB@BAB@@ABA@ABA@C@@BA@@B@@@@@@@B@@@B@@@B@B@@@@A@@BA@A@A@A@CAA@A@C@A@ABA@A@ABABA@@@AB@@A@CAA@A@AA@@AAA@AA@AA@@AA@@@@@A@@@A@@@@@A@@BAB@@@@A@@@AB@@C@A@@@A@ABA@ABC@@@A@A@ABA@A@A@ABA@@@@@@B@@@B@@AB@@ABAB@@@@@BA@@BA@@BA@ABA@@@A@@B@@AB@@@@@BA@@@AB@@A@A@@BA@@@A@@@A@A@@@C@C@A@@@A@A@CBG@ABA@A@ABA@@@A@AAA@@@AAA@@A@A@@A@@@@@A@A@A@A@@@A@E@A@@@A@@@ABA@@@@@@@A@@@A@@AAA@@AA@@@AA@A@@@A@@@C@A@@@AAA@@@@AA@AA@@AAAA@@AAAA@A@@A@@@A@CAA@A@AAC@A@AAA@A@A@A@AB@@A@@@AB@@A@@@A@@@A@C@A@@@@@A@AB@@@@A@ABA@@@A@@@A@@@A@@AA@A@@AA@@@AA@@@@A@A@@BA@@@A@@B@@@@A@@@A@A@@@A@AB@@A@AB@@@BA@CBABA@ABA@A@@@AB@@@BA@@B@@@BA@@@A@@@A@@BA@@@@@AB@@AB@@A@@BA@@AA@A@@@AA@@AAA@@@A@@@@@A@@@A@@BA@@BA@@BABA@ABA@A@@B@AA@@@A@@@@@A@@@@@A@@@@@A@@@@@A@C@A@A@ABAB@B@B@BBBA@ABC@C@A@@B@BB@@B@DDB@B@@@BABA@ABAAA@@C@A@@AAA@AAA@A@ABABABA@A@@@AAC@A@C@ABA@@@A@A@AAA@@B@B@@A@ABA@@@@DAB@@ABAB@BBB@BA@E@A@@BAD@B@BA@@@A@A@A@AB@@BB@@BBB@BDB@@BABAD@@@DB@@@B@@B@@@BGBAAA@@EA@AB@BA@A@A@A@ABADAB@@@@AB@@A@A@A@@@A@@@@@A@A@@@A@@@C@A@A@C@@@E@@@A@@@A@A@@B@@A@AB@@@@@@A@@@A@@AA@@@@@A@@@@@@@A@A@@@CAA@@@@@A@@@@@@B@@@@@@BB@@@@@@@B@@@@A@A@@BA@@@@@@@@@@@BBB@@@@@@B@@A@@@@@@@A@@@@@A@@BA@@@@@@@A@@AA@@@@A@AA@@@@A@@@AA@@@@@A@@@A@A@A@@@@@@AA@@@@A@@A@@@@@A@@@@@A@A@AB@@@@A@@@@@@@A@@@@@A@@@@B@@@@@@@@@BA@@B@@A@AB@@ABA@A@@@@@AB@@@@A@AB@@@@@@@B@B@@@@@BA@@@@BA@@@A@@@@@A@@@A@@B@@@@A@@B@@B@@B@@@@B@@@@@@@@@B@@@@@B@@@@BB@@@@BA@@@A@CB@@@@A@@@@BB@@@BB@@B@@@@@B@@A@@@B@@@@@@@B@@@@A@A@@@AA@@@@@@A@@@@@@@ABA@@@@@@@A@@@@@@@@@@BB@@@A@@B@@A@@@A@A@@@@@@@AAB@@@@AB@@@@AA@@@@@A@@@AB@@A@@@@D@@@@@B@@A@@@@@A@A@@@@@A@A@A@@B@@@@@@@B@@@@B@@@B@@@@@B@@@@@@@@B@@@@@@A@@@A@@@A@A@A@@@ABA@A@@@A@@A@@@@AA@@AA@@A@A@@@A@@@A@@@@@@@AA@@@@@@@A@@@@@@@@@@A@@@@@@@A@@@AB@@@@A@@@A@@@@@A@@@@@ABA@@@A@A@A@@@@@A@@@@@A@@B@@@@@BA@A@@B@@@@@@@@@BB@@B@@A@@@@@@@A@@@@@@@A@@A@@@@A@@@@@A@@@@@@BB@@@AB@@@@@@A@@AA@@@@AA@@@@@AB@@@@@@@BB@@@@@B@@B@@@@@@@B@@@@A@@@A@@@A@@@@@A@@@@B@@@@@@@@A@@@ABA@@@@@A@@@@BA@@@@@A@@AA@@@@@A@@@@@@@AB@@@@@@ABA@A@@@A@@@A@A@@@AA@@@A@@@A@@@AAA@@@@@AA@@A@AA@@A@@@@A@@@@@A@@AA@A@A@A@AB@@A@@@@BA@AAA@A@AA@@AA@@AA@@A@A@@@A@@@@@@@@B@@A@@BA@@@CA@@@@A@@@@B@@@BA@@@@@AB@@@AA@AAAAA@@@@A@@@@@A@@@@BA@@@@@ABA@@@@@@AA@@@@A@@@@@A@A@A@AB@@@@A@@@@@@B@@A@@@C@A@@@@B@@@@A@@@BB@@@BB@@BA@@@@B@@@@@B@@@@@B@@@@A@@B@@A@A@AA@@AA@@AA@@@A@@AA@@A@AA@@A@@@A@AA@@AB@@A@@@A@A@@@A@@@C@A@A@ABA@A@@@EAC@A@@@@@CBA@ABA@AB@@@@@@@B@@@@@BB@@@@B@@@BA@@@A@A@@@@A@@AA@@@@@A@@A@@@@@@A@@A@@C@A@A@@@ABA@A@@@ABA@ABA@@@A@@@A@ABA@A@A@ABA@AB@@ABA@ABCBCBA@ABA@@@@@A@A@A@@@A@@@AAA@A@AAA@@AA@A@@ACA@@A@@@@@@AA@AAA@@@AAA@A@@AA@AAA@@AA@@A@A@@@A@C@AB@@@@AA@@A@@AA@A@A@CAA@A@@@A@A@A@@@A@AAA@A@A@@@A@A@A@@@A@@@A@A@@AA@@@A@@@@@@@AA@@@@A@ABA@@@A@@@@@@@A@@@A@A@AB@@@@A@A@A@A@A@@@@@A@@AA@A@@AA@A@@@@@AA@@@@A@@@@@A@@@A@AB@@A@A@AB@@A@@BA@@@@BA@@@@@A@@BA@@@A@@@A@@@A@@@A@@@AAA@A@@@AAA@A@@@A@@AA@C@@@A@ABA@A@A@@@@@A@@@@@A@@@@@A@@ACA@ACAAAA@AA@AAA@@@@@A@A@@@@@AA@@@A@A@AAC@A@A@A@C@AAC@I@I@EAI@@@@@@CBA@C@CBA@A@CBA@A@A@A@A@A@ABA@A@@@AB@@ADABC@AAE@C@ABEAACGACC@CAEAEAACCACCCAECG@CACAEAAAACAAA@ACC@@AA@@AAAA@@AAAAA@A@@A@AAC@@@A@ODA@ABKJEDA@A@I@A@YFE@U@eCgIICCAUEIGHKW[DKQQ[KkEeIUCMDS@FLGNWEOJUCIEeCSEWK_MIJKPIN@P@AA@A@@@C@A@C@@@A@A@A@@@A@A@AAA@A@A@@@A@A@A@AA@@A@A@@@A@A@@@A@C@@@A@A@A@@@A@A@C@@@A@C@@@@A@ABC@ABC@CB@HBDB@A@CBA@A@A@@BA@CBAHA@A@CA@AAA@A@EAECA@AAC@CA@@A@@C@E@@@ABA@A@@@A@@BA@@@@@A@@@C@@@@E@C@E@A@C@AD@B@@@B@@@@AB@@@@@@@@ABC@C@A@@@ADABAD@BAD@@E@KA@DA@@B@B@@@B@@@@@@AB@B@@A@"],encodeOffsets:[[129414,49513]]}},{type:"Feature",id:"231181",properties:{name:"北安市",cp:[126.490864,48.241365],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@A@AA@@A@AA@@A@@@@@AB@@@@@@@BA@@@@@A@@A@@@A@@A@@@AAA@A@AA@@ABA@A@A@A@AB@@@@@B@@B@B@@@@@@B@@@@@@A@@@@@A@@@AAA@@@A@@@A@@@A@A@A@@A@@@A@@@AA@@@AB@@AB@@@B@@A@A@ABA@AB@@A@A@@@A@@AA@@@A@@B@@@B@@@BA@@@A@A@@@A@A@A@A@@@ABA@@@A@A@A@A@A@A@@@@@@A@@AA@@@A@@A@AAA@@@A@@BAAA@A@AB@@A@@@@@AA@@A@A@@A@@A@@AA@@@@A@@@AA@@@C@A@@AA@CAC@A@AAABA@@@@BB@@@@@@@@@A@A@A@@A@@@@BAB@@A@@@A@@@@B@@A@@@@@AA@@B@@A@@@A@AA@@@A@@@AA@@@ABA@@@@@@A@@A@@@A@@A@@A@@@AA@@@@A@@@A@AB@@@BBB@@@@A@@@@@AB@@A@A@CA@@@@@AA@@@B@@A@@A@@@A@@BA@@@A@A@A@@@A@@@A@A@@@@@@A@@B@B@@@@A@@@@A@A@A@ABAB@@AA@@@@@AB@@A@@A@A@@AAA@@A@A@@@ABA@A@AAA@A@A@@BA@A@AAA@@@AA@@@A@AAC@A@@@A@@@AA@@@@A@@AA@@A@@AA@A@A@@BA@@@@@@B@@B@@B@@@@A@@@A@A@@@AB@@A@@@@B@@@@A@@@A@@BA@@@A@@@A@@@AB@A@@A@@A@@AB@@A@@@@@AA@@C@A@@@@AA@@@A@@@A@@@@@BA@@@@@AA@@@@BA@@@@@AB@@AA@@A@A@@BA@@@@@BBB@@@BB@@B@AB@@A@ABA@@@@@A@@@A@@@@@A@AB@AA@@@A@@@@@@BB@@@@B@@A@A@@A@@AA@@A@@A@@@A@@@@@A@@@@@@AAA@@@AB@@AAA@@B@@A@A@@@@BA@@@BB@@@@A@@@@@A@@@AB@@@BA@@@A@@B@@A@@BAA@@@@@AA@@@@@@AA@@@@@A@@@AB@@AAA@A@@@@AA@@A@@A@A@@@AB@@AA@@A@A@@@AAA@@B@@@@BB@@@BAAA@@AA@A@@@AAA@A@A@@@AAA@@@@AB@@@@AA@@@AB@@A@@@@@@A@@B@@@AA@@AB@@@@AB@@@@@@A@@@@A@@@@@A@@A@@@@A@@A@@AA@@@A@@B@@A@AAA@@@@@BAB@BA@@A@@@A@@BA@@@@@C@A@@@A@@@A@@@@@@@@@@@BA@@@@AA@@AA@@@@BAB@@@B@@@@@@@BA@@B@@@@@@@BB@@@A@@AA@@AAA@@@AA@@@@A@@AA@A@A@@@A@@B@@@@A@@A@@@@BAB@@@@A@@A@@@C@@@A@@@AA@@AB@@A@@@A@@@AA@@@@AB@@B@@@BB@@@@@@A@@@A@@@A@@@@B@@B@@@@B@@A@A@@@A@@@@@@BA@@@@@@A@@BA@@A@@@AAA@@@A@@@@B@@@@@@@@ABA@@@@B@B@@AB@@C@A@A@A@A@@A@@@@@AA@@@A@@@A@@@@@@B@@@B@@@@@@@B@@A@@@@@A@@@@@@@@@AB@@@@@@AB@@A@@@@@AB@@B@@@B@@@@B@@B@@@@AB@@@B@@@@B@@@@@@A@@@@@B@@B@@B@@@@@@@@B@@B@@@@@B@BA@@@@@B@@B@@@@@@@@@@@@@A@@@A@@BB@@@A@@@@B@@@@@@@@A@@@A@@@@@@BB@@@@@@@@B@@@@AB@@@B@BAB@@@@A@@@@@@@@B@@@B@@@@@BB@@@@@B@@@@@@@@B@@@B@@@@@B@@@@A@@@B@@@@B@@@@A@@@@@B@BB@@B@B@B@@@@@@@B@@@B@BB@@@@@@@B@@@@B@@@@@B@@@@@@@@B@@B@@@@@B@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@AB@@@@@B@@AB@@A@@@@BB@@B@B@@@@@@@B@@@B@@@@A@@@@B@@B@@@@@@B@B@@A@@@@@@B@@@@@@@B@@@@@@B@@B@@@@@B@@@@@@AB@@@@@@@@A@@BA@A@@@A@@@B@@BB@A@B@@B@@@@@B@@A@@@A@@@@B@@@B@B@@@@@@D@@@AB@@@B@@A@@@A@A@@@@@A@@@A@@@@@@B@@BB@@B@@B@@A@@@BB@@@B@@B@@@@@@BB@@B@@@@A@ABA@@@A@AB@@@@A@@B@@@BA@A@ABC@ABA@A@@BA@@@@B@@@@@@@@A@@@@@A@A@AB@@@@A@@@B@@B@@@B@B@B@B@@A@A@@@@B@@@@@B@@@@@@@B@@@@@@AB@@AB@B@B@B@@CB@@AB@BCBAB@B@@ABABC@ABABCBC@BF@B@@BB@BBDBB@B@@BBBDDDBBFBFDBBDB@BHDDBDBBBDBH@@BD@DBDBHDBBB@B@BBB@B@BBBB@@BB@@@@@@@@BB@@@@B@B@B@B@B@@@BBB@@@B@@@DBB@DBD@FBB@@@@BAB@BA@@B@B@@@@@B@@@@BB@@B@@@@B@@@@@@@@@BA@@BAB@@AB@BA@@B@@@@A@ABA@A@@@@@@BA@@B@BAB@@@B@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@BABB@@@B@FB@@@@@BAB@B@@FD@@ADADAD@@B@@BB@BBBA@ABA@C@ABA@AB@@AD@F@F@BH@@HB@@@ABC@A@CBA@AB@DBD@@@ABAD@BD@B@BA@ADBAB@B@BABAD@@JDB@@@@ABA@@@@@@@A@@@A@AB@@CLBF@@@BC@ABCBABC@@B@D@D@BA@@@@@@@@BA@@@@@A@@@ABCD@B@F@D@F@@@@@@D@@@B@@@@AB@@@B@@@BAB@B@@@F@DB@@@DBD@BBB@FDFBB@B@BBB@@D@BGBAB@DAB@@@B@BAB@D@BCAGAA@@DAD@BAD@B@B@@D@B@@@D@B@B@@@B@B@B@@@D@B@@@B@B@@@B@B@@@BBB@B@B@@@B@B@B@BBB@B@@@B@B@B@@@D@B@D@@@B@B@@B@OJMLOJI`NXLTFfDJFVDPIXFHMEKT@NCVDfJlF\\LRRCLX\\GLJHVFDBJDhJfDV@F@ZEB@J@B@B@FCLIBAB@PCB@@@D@BBB@@@@BBBBB@BB@BB@BB@@BD@BDB@BBBDBBFBDBDBH@FDDBDDDBBDFBFBDBD@BDDHBBAF@B@DBF@BADCB@BA@@B@@@BAB@B@B@B@B@B@BAB@D@BAB@D@DAB@D@@@@J@FBJ@J@D@BBD@B@B@B@D@BBB@B@@@B@@B@@@@@B@B@@@@BB@BBBB@BBDB@BDB@BB@@@@@B@@@@@B@@@@@B@B@B@BAB@@@D@B@@BB@@@B@B@BB@@B@B@BB@@B@@@B@@@B@@@B@@@B@@AB@@@@@B@@A@@B@@AB@@@BAB@B@@@BAB@@@B@@@@@B@@@@@BB@@@@B@B@@BB@B@@BB@@@@@B@B@B@B@B@@@@@BAB@B@@@B@@@@@@@B@@@B@BAB@@@@@BB@@@@@@B@@@B@@BB@B@@@B@@@B@B@B@@@B@B@B@BBB@@@B@B@B@@@B@B@DBB@B@B@@BB@@@BB@@@@BAD@B@@@B@B@@@BB@@BB@BBB@@@BBB@B@@BB@BBB@@@@@@@BB@BD@@@BBB@@BB@B@BBB@B@@@B@@@B@B@B@@@@AB@BABADAD@BAB@BA@@BAB@B@B@BAB@B@B@@@B@@AB@BAB@B@@@BAB@B@@@B@B@DB@@@@B@@@@B@@@@B@@@@BB@@@B@@B@B@@@B@@A@@@A@@A@@A@@@@@A@@@@@@BAB@BAB@DA@@@@B@D@FB@@B@B@BAB@B@D@@@B@@@B@B@@@B@@@BA@@BBB@@@B@@@BBB@@@BB@@@B@@BB@@BB@@BBB@B@@@@AB@@@@@@A@@@@@A@@@@@A@@B@@AA@@A@@AA@@B@@@@@@A@@B@D@@@B@@@@A@@@@B@@@@@BAB@B@B@@@@@B@@@@@BB@@@@@@AB@B@@@@AB@@@@@B@@@@@B@@B@BBBBB@@B@@BA@@@@B@@A@@@A@@B@@@@@DB@@B@@AB@@@@A@@@@@@B@@@B@B@@@BB@@BB@@BBB@B@BBB@@A@@B@@@BAB@B@B@B@@BB@@@@@B@@@@@@BB@@B@BB@@B@@@@BB@B@@@B@@@B@@BB@@B@B@@@B@@@B@B@BA@@@@@@BA@@@@@@B@@@@@B@@BB@@@@@B@@A@@B@@@@@B@BA@@B@@@@@@@@@@A@@B@@@@@B@@@B@@@B@@@@@@A@@@@@@@AA@@@@@A@@A@@@@@@BA@@@@B@@B@@B@@BB@@@@@@@BA@@A@@A@@@@B@@@@@B@@@@@@BB@@@@@@@B@@@@@@@B@@@@AA@@A@@@@@@@@@AB@B@@A@@@@@AB@@@@@B@@@@@B@B@B@@@B@BA@@@@B@@@@@B@@@B@@@@@BA@@B@@@@@@@B@@@@@@@@@@@@B@@@@@@BB@@@@@@B@@@B@@@B@B@@@BB@@BB@@@@@BB@@@B@B@BA@@B@B@B@@@B@@@B@@@@@@@@A@@@@@@A@@@@@A@@@A@@@@@@A@@@@@@@AB@B@B@@@@@B@B@@@@@B@@@@A@@@@@C@@B@@@BA@@B@@@@@B@@B@@A@@B@@A@BB@@@@@@B@B@@@B@@@@AB@@@A@@A@@@@@@@@B@@@@@@@B@BA@@@@@@B@@@@@@@BB@@B@B@@@@@@A@@@@@@@A@@@BA@@@@@A@@@AA@@A@@A@@B@@@@@DAB@@@B@@A@@A@@A@@A@@@@@A@@@@@@@@@A@@@@@@AA@@@@AB@@@@@@AB@@@B@@@@@B@@@B@@A@@B@@A@@@@@A@A@@@@@@BAB@@@@@BA@@@@B@B@BA@@BAB@@@@AB@@A@@@@@@@@@A@@B@@@@@B@@@@@@@B@@@@@BAB@B@@@@@B@@@@@B@@@@B@@B@@B@@@@B@B@B@@@B@@@@@B@@B@@@B@@B@@B@B@@B@@BB@@@@@@@B@@AB@@@@@B@@@@@@@B@@@@A@@@@A@AA@@@@@@@@@@B@@AB@B@@@@@@A@@@@@@AA@@@@@@@A@@@@B@@@@@B@DB@@B@B@@@@@@@B@@@@@B@@BB@@@B@@@@@@@BAB@@@@AB@B@@@B@@@F@@@D@B@B@D@@@B@@@B@B@@@@@B@@@B@B@B@@@BA@@@@BABCBAB@B@B@B@@ABAB@@FB@BBHA@A@@@AA@@@A@@C@@BCBA@AA@ACA@AA@@AA@@BAB@B@B@@@B@@A@ABC@AB@F@B@@AAA@ABABA@@BA@C@@B@BAB@@@@A@AB@BBB@B@@@B@BAD@B@D@BB@@B@B@BABABAB@B@BBB@BB@@@B@DB@BBBAB@BA@A@@@ACA@C@AA@@A@AB@D@D@BAB@AA@A@A@ABABAB@B@D@B@@@@@B@@@@@B@@@@@B@@@@@B@@@B@@B@AB@B@BAB@BA@AB@@AB@@AB@@@B@@@@@B@@@B@BB@@BB@@B@B@@BB@@AB@@@BA@@BA@@@@B@@AB@@@B@@@B@@A@@@AB@@A@@BA@@B@B@BAB@BADAB@@A@@BAB@@@BAB@@@B@B@@@B@@@@@@AB@@@B@@AB@B@@@@@BB@@B@@BB@B@@BB@@@B@@@B@@@B@BAB@@@@@BAB@@@@@B@D@B@@@B@@@B@@@BA@@B@@@BAB@B@B@B@BBB@D@BBB@B@DBB@@@B@@@@BBBBB@@BBBB@@BBB@@B@@B@BB@@B@D@@@B@@@B@B@@B@@BB@@BB@BB@@@B@@@@@@@B@BA@@B@@@B@F@B@@@B@B@B@B@@@@@B@@@@B@BB@BB@@B@BBB@@@B@BAB@B@BAH@DAB@B@@@B@D@D@@@B@B@@@B@@@B@@AB@B@@@BA@@B@@A@@@@BA@@@AB@@@B@BAB@@AB@@AB@@A@@@@BABA@@BA@@@A@@@A@@@@B@BAB@B@B@BAB@B@@@D@BAB@BAB@@@B@D@@@BA@@B@@@@@BA@AB@@@@@B@@@B@@@@@B@@BB@@BBBB@BB@@BBB@B@DBB@@@BA@@B@BABAB@B@BAB@D@B@B@DBB@B@B@B@@AB@@@@@@A@A@@@A@@@A@@@@@@@AB@@AD@B@BAB@BA@@BA@ABA@@@ABA@A@@BABA@AB@@@D@D@B@B@D@FBB@DBB@B@BBB@@@@@B@D@@@B@B@B@B@FAD@B@DAB@B@@AD@@ABA@@B@@@B@B@@BD@@@B@B@B@B@D@B@D@D@D@@@BBB@B@B@B@B@DAB@D@BABAB@@@@AB@@@B@BBD@@BB@B@BBBBBB@@D@B@B@B@B@@@B@@@B@@@B@B@@@B@B@BAD@BA@@B@B@DBDBD@B@BBD@@@@@B@B@B@BBB@D@B@@@BB@@B@B@@@B@@@@A@@BA@A@A@C@A@A@@BABA@@BAB@@@@AB@B@BAB@BAB@@@@@B@@@@A@A@@@ABABA@@B@BAB@@@B@D@B@D@B@F@F@@@@@B@@A@@B@BA@@B@D@F@D@B@@@B@@@@A@@@A@@@A@A@@BAB@B@B@D@BAF@B@@AB@BA@AB@BAB@B@DABAB@@A@@@CBA@@@@B@DA@@B@D@@@D@@@@@@@BA@AB@BAB@@@BA@@@@@AA@AA@@AACAAA@@AA@A@ABAB@@A@@AA@@AAA@AAAC@AAAAAA@@AA@@AAEBCDG@AB@@AB@HADAB@BAB@BA@@@@@ABA@A@ABA@C@ABA@AB@@E@CAA@A@@BA@ABABA@ABAB@BAB@BAF@B@@@BA@@@@@A@@@@@@AA@AAC@A@@@ABA@@@A@@@AA@AA@@AC@A@ACCAA@A@ABC@@BA@@BABABA@ADGBCBC@A@A@A@ABABC@@@A@@@AA@@@AA@@@A@AB@@@DA@@BAB@@A@AB@BCDA@A@A@@B@B@DAB@B@B@BABABA@AB@@A@AAA@A@A@A@@BABADAB@@@@AB@@@B@F@B@B@@@BA@@@C@A@C@CAC@@@@B@@AFAB@@@BABC@@@ABCBA@AB@@@B@B@B@@@D@B@@@BAB@BAB@BAB@@A@C@A@ABA@@@A@C@A@@@AB@@@B@@AB@B@@@BA@A@C@ABA@A@ABC@@@ABABABC@ABAB@@A@@BC@ABABA@A@@AC@A@A@AB@@AB@BAD@@AB@@@BA@AB@BABAB@B@BAD@DAB@B@BAB@@AB@B@@@B@B@BBDBB@@@B@@@FA@@B@BAB@B@B@B@DAB@D@DAF@B@B@B@B@B@B@B@B@@@@@BB@@B@@@D@B@B@B@B@B@B@B@B@@@B@BA@@BA@C@ABA@A@@@ABAB@B@@@BA@@B@BAD@BA@@BABCBA@AB@B@@@B@B@BA@@BAB@BA@@@A@@@@@@DADABAFA@@B@BA@@DA@@BA@@@A@@@ABA@AB@BA@@B@BAB@BABAB@@A@A@A@A@G@E@A@@BA@ABABCBABC@ABA@A@A@AA@@A@@@A@@@@@A@@@A@@@AAA@@@@@AA@@@@@A@C@C@A@@@CAA@CA@AACA@@AAC@@@@@@BADADABABA@@@@@@@A@A@AAA@AA@@ABA@A@A@@AAAA@AA@@A@AAA@@@A@A@C@A@@BC@@@@@A@AAAB@@A@@BA@@B@D@B@@@B@@ABABA@A@AB@@A@@B@B@DAB@@@@@@@@A@@@@@A@A@@B@@A@@@@BA@@@A@@@@@A@A@@@A@@@@BA@A@@A@@A@A@@AA@A@AAA@@AA@@@@@A@@@A@A@@BA@@@@@A@AB@AC@@B@@ABADADAB@@AB@@A@@@@AA@A@@@A@A@@@@BAB@DAB@@ABABADABA@A@@@AB@@@@ABABA@@@ABA@@@ABA@A@A@@B@@AB@@@D@B@D@@@B@B@BBD@B@DBDBDBB@B@BA@@B@BAB@D@F@F@B@B@B@@@@@@@@A@@@A@@B@@A@@BAB@@@@AB@@@@A@A@@@ABA@A@@BAB@@@BABAB@@AB@@@BA@@@A@AB@@@@A@@@@@A@@@@@@@AA@@AA@@A@@AAA@AAA@AAAA@@AA@@@@AA@AA@@@A@@@@BAA@@@@@@@A@@@A@@@@@A@@@AB@B@BAB@BA@@BA@@B@@A@@B@BA@AB@@@BA@ABABA@@BA@@BA@@@@BA@@@AB@@A@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@A@A@A@@@A@@BA@A@@@A@@B@@ABA@@B@@@B@@AB@@@B@B@@AB@@@@A@@AAA@CAA@@@A@AA@@A@@@CAA@@@A@@AA@@@@A@A@@BA@@BCDA@AB@@@@A@@@@AAA@A@@ACA@@A@AAAAA@@AA@A@ABA@ABA@C@A@ABA@A@AAA@AAA@@AA@@AAA@A@@@@@A@A@@@@@A@A@@@A@@AA@@A@@@A@ABA@@@ABA@AAC@A@@@A@A@A@A@A@A@@AA@@AA@@A@@@A@@@A@A@@@A@C@@@@@A@@BABA@@DA@@@@@@BA@@@A@A@AA@@AAA@@AA@@A@@@AAA@A@@@A@A@AAA@@@A@A@@AA@A@@@A@AAA@@@@@A@@@A@@AA@@@@@@@A@CAA@@@A@@@@@@AA@@@@@@@@@A@@@@@A@@@@@@@AAA@@@A@A@A@@@A@A@AA@@@@@A@A@@@A@@@A@@@AB@@@@@B@@@@@@@BA@@@@A@@@@@@@@AA@AA@@AA@@@A@@@@A@@A@@@@@A@A@@@AA@A@AAC@@AA@CAA@@@AAA@@@@A@@AA@A@@BA@@@ABAB@@A@@@A@AA@@A@A@@A@BA@A@A@@AA@@@AB@@@@A@@@A@@@@@A@@@AB@@@@A@@BA@@@@@AB@BA@@BAB@@A@@BA@AB@@@@A@@@@@A@@AA@@A@A@A@C@@@A@@@@@AA@@@@BAA@@A@@AA@@A@@A@@@@@AA@@A@@A@A@A@@AA@@@AA@@@A@@AAAAA@@AA@AA@@AA@@A@@A@AA@AA@AA@AAA@AAA@A@A@A@@@AA@@@@A@@@@@@@A@@@@@A@@A@@A@@@A@A@C@A@A@CAA@C@AA@@A@@A@A@@BA@@@A@@@@@@@A@@@@@@BA@@@@BA@AA@@A@@AA@@BA@@BA@@@A@A@A@A@C@@@AA@CAAAA@AAA@@ACACAGCGGCAC@CAAAA@C@IACC@@A@AC@@@AA@CCCA@@AACAGAC@K@A@ACACCC@CA@AAAA@A@AEE@ACAEC@BA@A@@B@@@B@BABAA@BA@@@@@@B@@BB@@@@ABB@A@@B@@AB@B@@@@A@@AC@AAAA@B@@@BB@BB@@@@AB@B@@A@@BA@@@@BA@@@A@@B@@@B@@@@@B@@@B@@@@@@@B@@@@@BA@@@A@@@@@BBAB@@BBAB@@@B@@@B@@@@A@@@@@A@ABA@AB@@@@@B@@@B@@@@A@@@A@@@@B@@CBA@@BAB@A@B@B@@@@A@A@@@@@@B@@@BA@@BB@@B@@@@@BB@A@@BA@@@B@@BA@BB@@ABB@ABB@@@@BA@@B@@@BB@@BB@@@@B@@AB@@@@@@@@@B@@@@AB@B@@@@@BB@@@@B@@@@@@@B@@AB@@@B@@@B@@@@@@@@@@A@@BB@ABA@@B@@A@E@A@A@@@A@E@C@C@A@A@A@@@A@A@AAC@@@A@@@AA@@A@@@A@@@A@@@A@A@@@@@@@AB@AA@@@@@C@@@CA@A@AC@CAA@CBCAC@E@A@C@A@GAC@AAC@C@EAA@@@C@AAGACAEAC@C@A@C@G@ABE@C@EBE@CBA@ABE@G@C@A@CBCBC@A@ABAAC@@AC@A@ABAAGCC@@@C@E@C@C@CAC@AA@@@C@@AACAAAE@AAA@G@C@CACACAA@A@@@@@A@@A@@ACBC@@@AA@AAC@I@CACCAAAAE@@AA@A@A@CAC@CA@ABAB@BAB@AAA@CBE@@BGACA@@@@A@@@@@A@@@@BA@CBA@ECABAAA@AACAC@C@C@AAGAAAC@A@AAAACCEAEAA@AAGCAAAAA@@@A@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@B@@@@@@A@@@@@@@@A@@@@@@A@@@@@A@@@@@@@@@A@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@B@@@@@BA@@@@@@@@A@@@A@@@A@@@@@@@@@@@B@@@@@@@@@@@@AA@@@@@@@@@A@@B@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@B@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@AA@@@C@@@@@@A@@@@A@@B@@@@A@@@CBC@@@A@AAA@@@@@@@A@C@A@@@A@@@@BB@@@B@@@@@A@A@@@@@@@@B@@@@@@@B@@E@@@AA@@A@@@@B@@A@@B@@@AA@A@@@A@@@@A@@@@A@A@@@@@@@A@@@@BAAAB@A@@@@@@@@@AABA@@@B@A@@@@@C@@@A@A@A@A@A@@AA@@@A@@B@@B@@@@B@@@@@@A@@BB@@@C@@@BAA@@@A@@@@@@@@@A@@AA@@BAA@@@B@@@@@B@@@@@@@@@@@@@@B@@@@@BB@@@@A@@@B@@@@BA@@@@@@@ABA@@A@@B@@@C@@@A@A@AA@@@@@A@@@@A@@@@@AB@@@@AA@@@@@@@@@A@@@@@@A@@@B@@AA@@@@@A@ABA@@@B@@BA@@@AA@@@@@B@@A@AB@@@B@A@@A@@@@@@@@@@@A@BAAB@@@@@@A@@BA@@@A@@@@B@@@@A@@@@@B@@@@@@@@@@@CBA@@@AB@@B@@@@@@@@@@@ABA@@@@@@A@@AA@AB@@@A@@@A@@A@@B@@@@@AAA@@A@B@@@@@@@B@@A@@@@@@B@AA@@@A@@@A@@@@@AAB@@@A@AAA@AB@@AA@@C@A@CB@@@@@@B@@@@@CB@@@B@@A@@@A@AACA@@A@A@C@AA@@@@@A@@D@@@@@AA@@A@@@@B@@@@AAA@@A@@@@@A@@@@@AA@@@B@@A@@A@@A@@AB@@@@A@BAA@@@A@@@@A@@AA@@@@A@@@BB@@@@A@@@A@A@@@B@@BA@@AA@@@@@@@A@@@BC@@ABAA@@@@@A@@@@A@AAA@A@A@@A@@@@@AABA@@A@@E@AAA@A@@A@@AA@@@B@@A@A@A@@@A@A@C@CAC@@@ABB@@@@@@BA@@@@@@@B@@@@@@@@BA@@@ABA@@@AAA@A@@@ABA@@@@@@@A@@@@@@@@@@BA@@@@@@A@@A@@@@@@@@B@@@@@@@BAA@B@@@@@@@@A@@BA@@@@@A@@@A@@BA@@@B@@B@@@@AB@AA@@@A@@@@@@@A@@@AB@@@@A@@@A@@@A@@@@@A@@@A@@@@@A@A@@B@@A@@@@@@@@@@@@@A@@@A@@A@@A@A@@@@@@@@@A@@@@@@B@@@@@@A@@@AA@@A@@@@@A@@@@AA@@@@@A@@@@@@@@@@@@@B@@@@@A@@@@A@@A@@BA@@A@@@@@B@@@@A@A@@@A@@@@B@@A@@@@@@@@A@@@@@@@@@@@@@A@@A@@@@@@@@@@@@A@@A@@B@@@@@@A@@@@@@A@A@@A@@B@@@@@B@@A@@@AB@@A@A@@@A@A@@@A@@@A@@@@@AA@@A@A@@@@A@A@@A@A@A@A@ABAB@@@@BB@@B@@@@@AB@@A@B@@@@@@B@@A@@@@@A@@@A@@@@AA@@@@B@@@@@@B@@@@@AB@@@@A@@B@@@@@@C@@@A@@A@@@A@@A@@@@@A@@B@@B@@@@@AB@@@B@@A@@@@@AB@@@@ABA@@A@@@@@A@@@@@@@@@A@@A@@@@@A@@@@@@BB@@@A@@@A@@@@@@@@@@@A@@@@@A@@A@@@BA@@@A@@@@@@@AA@@A@@@A@@@@@@B@@B@B@@@@@@B@@A@A@@@@@@B@@@@@@@@@@A@@@@A@@@@A@@@@@@A@@A@@@@@@@A@@@@@@B@@A@@@@@@@@@A@@A@@A@@BB@@@@@A@@B@@@@@@@@@@@@B@@@A@@@@B@@AA@@@@@B@@@@A@@@@@@@A@@@@@@@AA@@@@@AB@@@@@A@@@@@@@A@B@A@@@@@@@@@@@A@@@@AB@@@@@@@B@AA@@A@@@@@@@@@B@@AA@@@@@@@A@@AA@@@@@B@@@BA@@@@@@A@@@A@AB@@@A@@@@@@AA@@@BA@@A@@@@B@@A@@@@@@A@@@AB@@AAB@@@@@@@@@A@A@@@@@A@@A@@AB@@@@A@@@@@AA@@@@@@BA@@@@@@B@@@B@@@@AA@@@@@@A@@@@@@A@@B@@@A@@A@@@@@@B@@A@@@@@@@A@@@@@B@@B@@@@@@A@@@A@@@C@A@ABA@@@A@@@@@A@@@@@@@A@@BA@A@@@A@@@A@@@@@@B@@@@@@A@@@@AA@AB@@@@@A@AA@@@BA@@A@@@AB@B@@AAA@@@AA@A@@C@@@@@@B@@@@AA@@B@@AB@@@A@A@@@@@@@@@@@ABAAA@@@@@@@@A@@@@B@@BB@@@@@@A@@A@@@@@@A@@B@@@@A@@@@A@@B@@@A@@@@@@@@A@@@A@@@A@@@@@@@@@@A@@@@@BA@@@@A@@@@B@@@@@@A@@A@A@@@@@A@@A@@@@B@@A@@A@@@@@A@@@A@@@@@A@BB@@A@@@@@A@A@@@@@@B@@@@A@A@@A@@@@B@@@@AA@A@A@@@@@@AA@@@A@@@@@@@A@@A@@A@@@A@@B@@B@@@@BA@@@AA@@A@@A@@A@@A@@@@B@@@@@@@@AB@B@@@@@@@@@AA@@@@A@@@@AA@@@A@@@A@@@A@@@A@A@@@@@@@A@@A@@A@@@@@A@@AA@@@A@@B@@@B@@A@@@A@@@A@@@@B@@@@@@CAA@@AA@@@A@@@@@BA@@B@@@@@@@@@A@@AA@@@ABA@@@A@@@@A@@A@@@@A@@A@@@@@A@@@@@@B@@A@@@@@@A@@@@@@@@@@A@@@@@@B@@AA@@@@AB@@@@A@A@@@A@@@A@@A@@A@@@@B@@B@@@@BA@@@@@@@@@B@@@@B@@A@@@A@@A@@@@@A@@@@A@@B@@A@@A@@A@@@A@@@@A@B@@@@@@AB@@AA@@@@BA@@@@BAB@@@B@@@@AA@@A@@A@A@@@AB@@@@@@@A@@@A@AA@@@A@@@@@@B@@@@A@@@A@A@AA@@@AA@@AA@@@AAA@@@AA@@AA@@@@@A@@@@A@A@@BA@A@A@@@A@@@@@A@@@A@A@@@@@A@A@@B@AA@@@@@@@A@@@@@@@A@A@@@AA@@A@@BAA@@A@@@A@@@A@@@@A@@A@@@A@A@@AA@@@A@@@AA@@@@A@@@@A@@A@@@@@AA@@@@@@@AB@@@@@@@@@@@A@@@@@A@@@@AA@@@@@A@A@A@A@AA@@A@AA@A@@B@@@@A@A@A@@@A@@@A@@@AA@@@A@@B@@A@@@A@AAA@A@A@@@C@A@@@AA@@@@@A@@B@@@@@@@@A@@@AB@@@B@@@@AA@@@@@@A@@@@@@@@A@@@@@@B@@A@@@@@@@@A@@@@@@@@A@@@@@@@@@@A@@@@@@@@A@@@@@@@@A@@A@@@@@@@@A@@@@@@@@B@@@B@@@@@@@B@@AA@@@@@A@@@A@A@@@A@@@@@A@@@@BA@@@@@@AB@@@@A@@A@@@A@@BA@@@A@A@@@A@@A@@@AA@@B@@@@@@@@@B@@A@@@@@@BA@@@@B@@A@@@@A@@@@@@A@AA@@@AA@@@@@A@A@A@@@@A@@@@A@@A@@A@@B@@@@AB@@@@@B@@@@A@@A@@A@@B@@A@@AB@@AB@@@A@@AAB@@@AA@@@@@@BA@@@@@AA@@@A@@A@A@@A@@A@@@@@A@@@@@@@A@@B@@@@A@@@A@@@@@@@A@@@@AA@B@BA@@@@@A@@@AA@@@@BA@@@@@A@BB@@@@@@A@@@AA@@A@@@AA@@@@AB@@A@A@A@@@A@A@@B@@A@@@@@BA@@AA@@C@A@A@@A@@@A@@@@@@A@@@A@@@C@A@A@C@@BA@@B@@AB@@AA@@@A@@A@@AA@A@@@AB@@AB@@A@A@AB@@A@@@@@A@@@@B@@A@@@A@@A@@@A@@AB@@@@A@@@A@A@@@A@AB@@CB@BA@@B@@@@@B@@@@A@@@@AA@@@@@@@@BB@@B@@A@@@A@@@@@@BB@@B@@A@@@@@AA@@A@@@@@@@A@@@@@@B@@@B@@A@@@@@AB@@@@A@@@A@@B@B@@@B@@A@@@@@A@@@A@@@AAA@A@@@@A@@@@A@@@A@@@A@@BA@@AA@@@A@@@A@A@A@@@A@AA@@AB@@A@@@@@A@@B@@A@@@A@@A@@@@AB@@@@A@@@@@@@@B@@@B@@A@@A@@A@@B@@B@@@@B@@A@@@@B@@@@A@A@@A@@A@@B@@A@@@A@C@@@A@A@@@B@@A@@@@A@@@A@@@@@A@A@@@A@A@@@@BA@@@@@AA@@ABB@A@@@@@@B@@@@@@AA@@@BA@A@@@@@@@@@@@@AA@@B@@@BA@@@AB@@@@@@B@@@B@@BA@A@@B@@@B@@B@@@@@@B@@@@B@@@@@@B@@@@C@@@B@@B@@@@A@BB@@B@@@A@@B@@B@@@@BA@A@@@AA@@AAA@A@A@@B@@A@@BAA@@A@@AA@@@@@A@@B@@@@@@@B@@A@@A@@AA@@@@@@ABA@A@@AA@@@@@AA@B@@@@@B@@A@@@A@@@@@A@A@@@AB@A@@A@@@@@A@@@@A@@@@AA@@@@A@@BA@A@@AABA@@@@AA@@AA@@@@@ABB@@@@B@@@@A@@A@@A@@@@@A@A@@BB@@@@@@@A@@@A@@@@@A@@@@B@@@@B@@@@@@@A@@@A@A@@@@AAA@@@@@@A@@@A@@@@@@@@@AA@@@@@@@A@@@@B@@@@A@@@@@@@A@@@@@@A@@@@A@@B@@@@@AA@@@@B@@@@@A@@@A@@@A@@@@@@@A@@A@@@@B@@AA@@@@@@A@@BAA@@AB@@@A@@@A@@@AA@@A@@@A@A@@@@@A@@@@B@BA@@@@A@@A@@B@@@@@B@@A@@@@@@A@@A@A@@B@@A@@A@@@@A@@BC@@AA@@@@@A@@B@@AA@@@B@@@@@BA@@@A@@@@@A@A@@@@@@A@@@@@@AA@@@@A@@@@@@BB@@@@B@@A@@B@@@@@B@@C@A@@@AA@@A@A@@AA@AA@@@B@@@@@BA@@AA@@@A@@@@@@B@@@@@@A@@@A@@@A@A@@@A@A@@A@@@A@@@@@A@@A@@BAB@@@AAC@@@@A@@B@@AA@@A@A@A@@A@@@@A@@@@@@A@@A@@@A@CBA@A@@A@@@@@@ABA@@@@@@A@@AA@@A@A@B@B@@@@AA@A@A@@@@AA@A@@@@@BA@AA@AAA@@@@@@BB@@@@B@@A@@@@@@@@AA@@A@BA@@@@@@@@AB@@@@@BAA@@@A@@@ABA@@@@B@@A@@@@A@@A@AA@@@@@@@@AB@@@@BB@@A@A@@@@@@@@A@@BA@@A@@@A@@@A@@A@@B@B@@AA@@@AB@@A@@@@A@@A@@@AAAAAA@@D@@@@AA@A@@@@BA@@@@A@@@AA@A@AAA@A@BAAABAB@@@BB@@@A@A@@BB@@@B@@@@B@@@AA@A@A@@BA@@@AB@B@@AA@A@@ABA@@@A@@B@@BBA@@@@A@A@@A@A@@@AA@AAAAA@A@@@A@ABA@@@AAA@A@AA@@A@AA@@AAA@@@@A@A@AA@@BA@@@AABA@@BAB@@@CC@@CB@@A@@AA@AAA@@A@AAB@@ABA@@@A@A@AAA@BAA@AA@@@ABAA@@@A@@BAAA@@@A@@B@@ABAAABA@@BA@AA@@BA@@@A@@@AAA@@A@A@@@@@@AA@AA@@@A@@AA@@@AA@AB@AA@A@BA@AA@AB@@AA@@C@C@A@@@@@@AAACA@@A@@BA@A@@AAB@@BB@@ABA@@@AAA@ACCAA@CAA@@C@AAAACA@ABA@AACAA@@AA@AAABA@AAABAAEBAAA@@AB@B@@@BA@@@@A@A@A@@@@@AC@@AAA@@ABACA@AA@A@A@A@A@@@A@@@@A@@BAA@AA@@CA@@@AB@@@AAC@@BA@A@AA@@AAABA@ABA@AAA@@AA@@@@B@BA@A@@AAEAAAA@@BA@@@@C@A@@@C@AAAA@AA@@A@AB@@@@A@@@AD@D@@@@@@AC@@@AA@@BA@AA@@@C@A@@@AA@A@A@A@@@@@AD@BA@AA@AAAA@A@@BAA@@A@AB@B@@@@A@@AACB@A@@BA@AA@A@@CA@A@@@AB@@AA@@@C@CAA@@B@@ABBB@@A@A@@DA@@A@AAABA@A@@@ACA@@ABAAA@A@AA@ABC@@@AA@A@@A@A@@BA@A@A@@A@@AA@AAA@A@@A@@@@A@@@AA@@@AAABAA@A@@A@@A@BA@@B@@A@@@B@@@@B@@@@A@@@A@@@@@@@@@@@A@@@A@@@A@@@A@CAA@A@@@@@A@A@@@@@A@@@A@A@@BA@@@ABA@@BA@ADABAB@BCBABABABADCBAB@D@B@BAB@D@B@@@BAB@D@@@B@@@BAB@FAD@B@@@@@@BBD@B@@@@@B@@BAB@F@DAD@B@@B@@B@F@D@DAF@D@F@D@DAF@D@D@F@D@B@BA@@BABCX@@B@AB@D@@@@@@@B@@@BA@@D@B@B@@AB@@B@B@FBB@@@@B@@@B@B@B@BA@@D@@@B@@@@@B@BAD@@@@@B@B@@@B@BAD@B@B@BAD@B@B@@@@@B@BAB@@@B@@@@@@@B@@@@@@@B@@@@@B@@@B@@@@@B@@@@@@B@B@B@B@@@DA@@D@B@@@DBB@B@B@BBB@@@DBD@D@B@B@B@@@DAB@B@D@@@FDD@D@@@HAD@B@@@F@B@AB@@@@@BB@@@B@@@@B@@@B@@@@@B@BBB@B@B@@@@@B@BAB@B@B@@AB@D@@@B@@@BAB@@@B@B@@@@@@@B@@@B@@@B@B@B@B@@@BB@@B@@@@@B@@@@@B@@@BA@@B@@@BAB@@AB@@@BAB@@@@@@@D@B@B@B@B@@AB@BAB@D@@AB@B@@@B@@@B@@@@@@@@@BBB@B@@@@@@@BA@@@@@@@BBB@@BB@B@@@B@@B@@@B@B@D@D@D@B@B@@@@@@BBB@@BB@@BB@BB@@BBBBB@DD@@BBB@BB@@B@B@@@B@BA@@@@B@@B@@@@@B@@A@@@@@ABA@@@@B@@@@@@@@@B@@@@A@@B@@@@B@@@B@@@B@DB@@B@@B@B@B@@@B@@AB@@@@B@@@@BB@B@BBB@@@BBB@@BB@@B@B@B@@@@BB@BD@@BB@@@BB@B@@@B@B@B@B@@@@BB@@@B@BB@AB@@@B@B@@@B@@@@AB@@@@AB@@A@@@@@A@@@A@@@A@A@@@AB@@@@A@A@@@@BA@@@AB@@@@@B@@@@A@@B@@@@@B@@@@@B@@@B@@@B@@@B@@@@@@@B@@@@A@@@@@AB@@A@@@@@A@@@@@@@A@@@@B@@@@@B@@@@@B@@@@@B@@@@@@B@@@@@B@@B@@A@@B@@@@A@@B@@A@@@@@@BA@@@@B@@@@@B@@@@@B@@A@@@@@@@@B@@AB@@@@AB@@@@@@@@@BA@@@@B@@@@@@AB@@@@AB@@@@@B@@A@@@@@@B@@@@@@@@AB@@@@@B@@AB@@@@@B@@@@@@AB@@@@@@@@@B@@@@AB@@@@@@@B@@@@@@@B@@@B@@@@@BB@B@@@BBB@@AB@@@B@@B@@B@@@@@@@B@@@@@B@@@B@@@@@@B@@@@@@@@@B@@@@@@@@@@AB@@@@@@@@@B@@@@AB@@@@@B@@@@@@AB@@@@@@@B@@@@AB@@@B@@@@@@@B@@B@@@B@@@B@B@@AB@@@B@@@B@@B@@@@@@@B@@@@@B@@@@@@@BA@@@@@@@@B@@@@@@@@@B@@@BA@@@@BB@@B@@@@BD@@BB@@@B@@@B@@@B@@@B@B@@@@@@@B@@@@@B@@BB@@@BB@BB@@B@BBB@BB@@B@@@@B@@@@@BB@@@@@@@@DB@@B@@BBBBB@B@@BB@B@@BB@@@B@@BB@@@@B@@BB@@@@@B@@@@BB@@@@@BB@@@B@@BB@@BBBB@DBFBHDDBB@B@FDBDBBB@BBB@BB@@@@BBBBB@BBBB@@@@A@@@@@@@AB@@@@@@AB@@@@@@@B@@A@@@@B@@A@@B@@A@@BA@@@@@AB@@@@AB@@@@AB@@B@@@@@@B@@B@@B@@@@@BB@@@@B@@@B@@@B@B@@@@@B@@BB@@@B@BB@@B@@@B@@@B@@@B@B@@@B@@@B@@@B@@@B@@@B@@@@@@BB@@@@@B@@@@@B@@@@@B@@@BB@@B@@@@@B@@@B@@@@@BB@@@@B@@@B@@@@@BA@@@@B@@@@@@AB@@@B@B@F@D@B@B@@@@@@@@@B@@@B@B@@gDqFm@KTD`CVbBzLABCDABEFEFAB@@@@JDPDFBABADCFADAD@@B@B@@@BB@BADADAD@BBAFBN@EPB@D@FBD@F@D@BB@@@BAF@B@B@@AB@@CBAD@HAD@@B@@BB@@@B@@@B@B@@@B@@@@@B@@@BB@@B@B@@@B@B@B@@@B@B@@@B@B@@BB@B@@@B@B@B@@@B@B@BB@@B@@@B@B@B@@@B@@@DB@@B@B@@@B@@@B@@@B@BB@@B@B@B@@@B@@BB@B@BBB@B@BBB@BBB@@BBB@@@@@BAB@BBBBB@BABA@A@A@A@A@A@AB@@AB@@ABG@KAMA[AUC@FCDW@AJCLAJ@HXAFBAB@B@B@B@@@BBB@@@BAB@@@DADC@CDCB@@@BA@@@@@@@AB@@A@@B@@A@@@AB@@@@AB@@@@A@@B@@@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@@@@@@B@@@BA@@@@@@B@@@@A@@B@@@@A@@@@B@@A@@B@@A@@B@@@@@@@B@@@@@B@@@@@@@B@@B@@@@@@@@B@@@@@B@@@B@@@@@B@@@B@@@@@B@@@B@@@@AB@@@@@BA@@@@@AB@@A@@@@@AB@@A@@@@@AB@@@@A@@@AB@@@@A@@@A@@B@@@@@@@B@@@@@BA@@B@@@@@B@@@@A@@@@@@B@@@@A@@@@B@@@@@@A@@@@B@@@@@@@@A@@@@@A@@@@BA@@@@@@@@@AB@@@@@@@BA@@@@@@BA@@@@@@BA@@@@B@@@@A@"],encodeOffsets:[[129611,49373]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("鸡西",{type:"FeatureCollection",features:[{type:"Feature",id:"230302",properties:{name:"鸡冠区",cp:[130.981185,45.304355],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAACAAA@@A@@A@@@A@@A@@@@@@@A@@AA@AA@AA@@@@@@@@@C@@@E@A@A@AAAAA@@@@AA@A@@@A@@@A@@@A@@@CACAEAA@@@A@A@@@A@@@A@@@@@AB@B@@@@@@@B@@@@AB@BA@@B@@AB@@A@@@A@A@A@@@A@A@@@@B@B@B@@AB@@@@@@A@@@@@A@A@@@@@A@A@@@@@A@@A@@AA@@@@@@@BAB@@A@@@@@A@C@@@ABA@@BA@@@@@A@A@@@@@A@@@@@AB@B@@@@@@@@C@C@A@@D@@AB@@@@@@A@@BA@A@A@@@C@A@A@@@A@@@@@@B@@@B@B@@BB@@@@AB@@AB@BABB@@@@@@B@@@@BBB@@BB@BBB@@@@B@@B@@B@BA@@B@@@@@BB@@B@@BB@@BB@@@BB@@@B@@B@@BB@@@B@B@@A@@BA@@@@B@@@BA@@B@@@@@@@@BB@B@BAB@B@B@B@BBBB@BBBB@@@B@B@B@@BB@B@B@B@@AB@@@BBB@@@@BBBB@@@@@DBB@B@B@@@B@B@B@@@@A@ABA@ABA@A@A@ABA@A@@BA@@B@@AB@@A@@B@@A@@@@BA@A@A@@@A@CBA@@BCB@BA@@@@B@@A@@@@@A@@@@@@@AA@@A@@@@@@@A@@@@@@BA@@@CB@@@@@BA@@B@@@@ABAB@@@B@@@B@@BB@@@B@@@@@@CB@@ABABABB@BBBBB@B@B@@ABADAB@B@BB@@@DDBBBB@B@B@B@D@HBDBHDBBB@@@AD@B@D@@@B@BB@BB@B@@@@B@@@@BB@@@@@BB@@@@B@@@B@BB@@B@B@@BB@@@B@@@B@@BB@@@@BB@BBB@@@B@@@@@B@@B@@@@AB@B@@@B@@@@@@BB@@@@@@@@@@@BB@@@@@@@@@B@@@B@DB@@B@B@B@B@BBB@@@B@B@B@@@BB@@@@@D@@BBBB@@BB@@@@B@BA@@@@@@@@B@@@@@B@@B@@B@@B@@B@@@@BB@@BB@@@@@D@D@B@DB@@BA@@B@@@@A@@A@@@@A@@@A@@@A@@A@@A@@@@@@@@@@BA@@@@@@B@@A@A@@@A@@AA@@AA@AAA@@@@@A@@@@@@B@B@BA@@BA@@@@BA@@@@@@@A@@@AAA@@@A@@@A@@@@@@@@@@@@@@@A@@B@B@B@DAB@@A@@B@@A@A@@@A@ABA@@@A@AAA@A@@@@B@@@B@B@B@B@BB@@B@B@B@B@@@BAB@B@B@BA@@B@BAB@BAB@@AB@B@@@BABADA@@BAB@@@B@B@BABBBA@@B@B@B@B@BA@@BABAB@@AB@@A@@@A@A@@BA@@@A@@BA@AB@@AB@@AB@@ABA@@B@@AB@BA@@DABA@A@@BA@@B@BABAB@B@B@@@B@@@B@BAFAD@@@D@DBB@@@@AB@BAB@B@B@B@BB@BDBB@@@BA@@BC@AB@BAB@FADCD@DA@@BABA@CD@BA@@BB@@BBBBDBD@DBB@B@DBDDB@BDB@D@DBB@B@DBBBB@B@BAD@B@@@BAB@@@B@B@@@@@BEMAAA@@AC@@@@@AB@@@@@@A@@@A@@@A@@@@@A@@AA@A@@@A@A@A@@AC@AA@A@AAA@A@CAA@AAA@@@@ABCBADABA@A@@@A@@BA@A@@@@@A@A@ABEBEBC@A@A@@@@@A@@@A@@@A@@@@@A@@DA@@@@BA@AB@@A@@B@@A@@@@@@B@@@@@B@@AA@AACAEAGCAA@@AA@A@@A@@@@@CBA@C@@@ABA@A@E@E@E@@@@A@@A@@@@@ABA@@@@@CAC@@@A@A@@@A@ABA@@@@@A@A@@@@@ABA@@B@@A@@@A@A@@@@@A@@@A@@B@@A@@@A@@@A@A@@AC@A@@@CAABA@@@A@@@AAA@@@A@A@@@A@A@AA@@A@@@A@@@A@@B@@AB@@@@A@@@@@@A@@@@A@@@@@@@A@ABA@ABA@@@@@A@A@@@A@A@A@A@A@A@@@A@@@C@A@A@ABA@@@A@A@C@A@@@A@CBA@C@A@A@A@CBA@@@C@ABA@A@A@@@A@@@@@@A@A@A@A@@AC@@@A@@AAA@"],encodeOffsets:[[134092,46328]]}},{type:"Feature",id:"230305",properties:{name:"梨树区",cp:[130.69699,45.092046],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AA@@@@@@@@A@A@@@C@@@CAA@@@A@AA@@A@@@@@A@@AA@@@@AA@@@AAA@A@@@AA@@@@@@CAA@AAA@AAA@AAA@@@AA@@@A@@@@AA@@@A@@@A@@@AA@@A@@@A@A@@AA@A@A@@@@@@@A@@BAB@@A@@BA@@@@@@@A@@@@@@AA@@A@A@A@A@EAA@@@AA@@A@@@A@A@AAA@AA@@A@@AA@A@C@AAA@A@@@A@A@C@@@@@A@A@@@A@A@A@A@A@A@@@A@@@AA@@A@@@AAA@@@A@A@@@A@@@@@A@@AC@A@A@@@A@@@@@A@@@AB@@A@A@AB@@AB@@AB@@A@@B@@AB@@A@@@@@@@@B@@@@@B@@@@@@AB@@@BA@@BA@@B@@@BAB@@B@@B@@@@B@@@BB@@@@@@@B@@@@@BA@@@@@@@@@A@@@A@@B@@AHABAD@@@BABCB@@AB@@@@C@ABA@E@AAEA@@AACAAAA@@@@AA@A@AACAA@@@@@A@@@@@@BA@@B@BA@@BA@@B@@A@A@AB@@A@@@AB@@A@A@@BCB@@A@@BA@@@AB@@@@A@@@A@@@AB@@@@@B@B@@AB@@@B@@@B@B@@@B@@@BA@@@A@A@AB@@@@@B@@ABABA@@BA@@B@@@BB@BB@@BBBB@B@@@@ABABCB@BAB@@@@@@ABA@ADAB@@@B@BAB@@@@AB@@BB@@@@@BAB@@ABCDA@@BA@@@C@@BA@@@AB@@@@@BC@@@A@AB@B@@@@@@BB@@BBB@@BABAB@@AD@@@B@@@D@DAB@@@@@@CB@@C@C@E@A@A@@@A@ABA@@B@@@@@B@@AB@BA@@DA@@B@@@B@@@B@@A@@@@@ABA@CBC@CBCBEBA@A@ABC@@@@@A@@B@@@B@BB@BB@B@@@B@@@BAB@@AB@@AB@@A@ABA@C@A@A@C@@BA@A@@BC@@@A@A@AB@@AB@@ABCBA@AB@@AB@@@BAB@BABAB@B@@BB@B@@@@FBB@BBD@F@B@B@@BD@@BBB@@BF@F@B@BBB@@B@B@B@@@B@@@@@@BB@@B@@B@B@@@@@BB@@@@@B@@@B@BBB@@BBDDBBBB@BBB@@@B@BB@@B@B@@@@@@BA@@B@B@BAB@BABABABAB@B@B@B@B@D@@BB@@@@@A@AB@B@@@B@@@@BBB@DB@@B@@B@BB@B@@@@@@@BAB@@@@@@@B@@@B@@@@B@@BB@@BBB@BB@B@B@@@B@B@@@B@BB@@@AB@B@@@@@BB@D@BBB@@B@@BB@@@@B@@@B@B@B@B@DB@@B@B@BA@@B@@AB@@@B@@B@@BBB@@@@B@@@@@B@B@@@BD@@@@B@@@@@B@@AB@@@B@@@@BB@@BBB@B@BB@@FB@@@@@@@@A@A@@BA@@@ABA@@@@@@@EB@@A@A@A@@B@@@@@@@BA@@@@@@BA@@@@BA@@BAB@@@B@@@BBB@F@@@B@@@BA@A@A@@B@@@@B@B@B@B@BAB@B@@@B@B@@@B@D@@@B@B@B@B@@@B@@BBBBB@B@@@BAB@B@D@B@@@@@@B@D@F@D@B@D@D@B@@@@@BBB@@B@B@@@@@BC@AB@BB@@@B@@BB@BBFB@@B@BAB@B@@@B@B@@@B@@BB@@DBB@D@B@@@@@BB@@@B@@@@@BB@B@BB@BBBBBD@BBBBB@@@@B@@@B@B@@AB@BABABA@@B@@@B@B@B@B@B@B@B@BAB@B@@AB@@A@@AA@AA@AA@@@A@@@A@@@A@@@A@@@A@A@@@@BBB@@BB@D@B@BAB@@@@@BB@@BBB@B@B@D@BBBA@@B@B@@ABA@A@ABA@@@A@A@@BA@@BAD@@AB@@@B@D@B@DA@@B@B@B@BAB@B@B@DA@@D@BAB@BA@@@@B@@A@@@@@@BB@A@@B@B@DAFA@@B@@@B@DBD@BBB@@B@@@B@B@@AB@F@B@D@D@@B@@BBB@B@BAB@@BD@BBBB@BBB@DB@@D@@@BAB@DAB@B@B@B@B@B@D@B@B@@AB@B@B@BAB@@@B@@@B@@@F@B@@@@A@A@AAA@AAC@C@A@C@ABCB@@CBABA@@DAD@DAB@D@B@@@BDB@@@B@B@BBB@B@B@B@@@B@@@@@B@@@B@@@B@@AB@@A@A@A@@@@BA@@@@@AB@@AB@@@@@BA@@@@B@@A@@@@BC@@@@@A@@@@@@A@@AC@CAA@CA@@@@A@AAA@AAAAA@@@@@AAAA@@@@AA@AAA@AAA@@AAACA@AA@@@A@@AA@@@A@@@A@@BA@@BC@@@A@A@@@AB@@@@@@@BBBBB@BBB@B@@@@@B@@@B@DCB@@@B@@@BA@@B@@AB@@A@@@A@@@A@@@AAAAAA@@A@@@@@@@A@@@AB@@@BAB@B@B@@@B@@@@@@@AAA@@AA@@@@@@A@A@A@@@@BA@@DAB@@A@@B@@@B@@@BA@@B@@@@@BA@@@@@@BA@@@A@@@@@A@A@A@@@@A@@A@@@A@@@@@A@@@@@A@@A@@A@AA@@@@A@@@@A@@AA@A@@@AA@@@@A@@AA@@@@@@A@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@@@@A@@B@@@@A@@@@B@@@@AB@@@@@B@@@@@BA@@B@@@@@B@@@BA@@B@@@B@B@@@@AB@@@B@@@@A@@B@@@@A@@@A@@BA@@@AB@BA@@@@@A@@@@@@@A@@AA@@@A@A@@@@@@@A@@@@@@@A@@@@@A@@BA@@@AB@@@@@B@@@@AB@@@@@B@@AB@@@BA@@B@BA@@@@BA@@BA@A@@@@B@@AB@@@@@@@@A@@@A@@A@@A@@A@A@@@AA@@A@AA@AA@@AAA@AA@@A@@@@@@@ABA@@BA@@@@D@DAB@@@DAB@BA@@BA@@BA@ABABA@@@ABAB@BABAB@@AB@FAD@@@B@@@BABA@@BABA@AB@@AB@@A@@BA@@@A@A@A@A@CAA@AAA@CACA@@CAAACAC@AAA@CB@D@BBF@FBDBDBBB@@B@B@B@BADAB@@@BA@@B@@@B@BBBBB@@@B@@@@AB@@@@ABA@ABA@A@@@A@AA@@AAAAC@AAA@AB@@ABA@@@AA@@A@A@E@@@AA@@AA@C@@@AACCAA@A@E@A@AC@AAA@@AAAAAC@E@A@A@@@@@ABA@A@CAA@A@ABA@A@ABEDE@A@AA@@CA@@A@C@CAA@ABC@ABA@A@AACAA@A@A@C@EAA@ABED@@C@@BA@CDA@A@CB@@A@@BAB@@@FAB@@@@A@A@@BA@@@A@@@@BA@C@@@A@@@ABA@@@AAA@A@A@A@A@C@C@@@@@A@@@@@ABA@@@@@A@@AA@@@"],encodeOffsets:[[133936,46083]]}},{type:"Feature",id:"230303",properties:{name:"恒山区",cp:[130.904963,45.210668],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@B@@A@@@@@@@AB@@@@@@@@@@@AA@@@@@@@A@@@@@A@@@@@@@A@@B@@@@A@@@@@@B@@AB@@@@@BA@@BA@@@@@@@A@@@@@@@A@@@@B@@@@A@@@@@A@@AA@A@@@A@@@@AA@@@@BA@A@@A@@AA@A@@@AA@@A@@AA@ACA@@@AAA@@AA@A@@AA@@@@AAA@@@AA@@ACAA@AACACAAAA@@AA@A@AAAAA@AAA@AA@@AA@A@AA@ACAAA@AA@@AA@@A@@AA@@@A@@AC@CAA@A@AA@AB@@@@@@@@A@@A@@A@AA@@@AAAAA@@@AA@AAA@A@@@ABC@@@AAA@@AA@@AA@@AA@@AAA@AA@@A@C@ABA@@@CBA@A@C@A@A@C@ABA@A@A@EACAAAC@AA@@@AAA@@A@EAA@AAA@A@A@A@C@A@A@@@C@C@A@@AA@EAAAGCAAA@E@A@KD@B@@A@ABE@A@A@ABA@ABADCFAB@@@@@BA@A@@@@@@B@@@@@@@@@B@@@@@@@B@@@B@@B@@B@@B@BBAB@@@@A@A@A@A@@@@@@B@B@F@BBBDDBB@@D@B@@BB@@B@@@F@B@BB@@B@@AB@BA@@BBB@BBDBB@BB@@B@B@@@BAB@BAB@B@@A@@B@@A@@@A@AAAAA@@@A@@@AB@@A@CBABA@A@A@@@AACACAEAE@AAC@A@@DBB@BBDBDBB@DB@BD@DBB@BBB@D@B@B@B@B@@AB@@@BA@@BA@@BABAB@@ABAB@@A@@@C@EBA@@BA@ABABA@AB@B@@ABAB@BAB@@AB@@ABA@CB@@A@CBC@@@@@AB@@AB@B@@@@@@@BB@@BBB@BB@@BBBB@@@BB@@B@B@@@@BB@@@@B@@@B@@@@@@A@@BA@@@@@@BAB@@AB@@@@ABA@@@AB@@A@@BA@@@@@A@@B@@A@@@@@A@@B@@AB@@@B@@@@@B@@@@@@@B@@@@@@@B@B@@BB@@@B@@@@@@@B@@@@ABA@@B@@AB@@@B@@@B@@A@@@@B@@A@@@A@@B@@A@A@@@A@@@AB@@A@@@@@A@@@AB@@@@A@@@@@A@@B@@A@@@@@@B@@A@@@@B@@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@B@@@@B@@BB@@@@@BB@@B@B@@BB@@@@@@B@@B@@B@BB@@@@B@@@@@B@@@@@B@@@BB@@@@@@B@B@B@@@@@B@@AB@@@@@@AB@@@@A@@@AB@@A@@@A@@@@BA@CB@@AB@@@@@B@B@B@@@@B@@BB@BB@@@@@@A@@@A@A@A@AB@@A@@B@@@B@@@@@@@BB@BBBB@B@@@B@@@B@@@BA@@BA@@@AB@@A@@@A@CDA@@@A@@@@@A@A@AAA@AAAA@@@@@@A@@B@@@B@B@@AD@@AB@@@B@@@B@@BB@@@B@@BBB@BDBB@@BB@BBB@BBB@@@@BBBB@@@@B@BBBBB@BBB@@@@@DBB@DBD@@BB@@@@@@@@B@@@@AD@@@@@BA@@@@@AB@@@@A@@BA@@B@@@@AB@@@@@B@B@BA@@BA@@@A@@@A@@@@@A@@@A@A@A@A@AAA@A@@@A@AC@@A@C@A@CBC@CB@@ABAB@DA@AD@B@D@B@DBD@BBB@B@B@B@@A@E@@@A@@@A@@@A@ABA@A@A@@BA@A@C@A@A@A@A@A@A@CBA@AB@@C@@@CAA@AAA@AA@AAC@@BA@A@AAA@AA@@@@C@C@A@EBA@@@A@A@@@AA@AAC@CAA@@@A@@@EBCBA@A@@@@BAA@@@@@@@BA@@@@@ABA@ABC@@@CBA@A@A@ABA@A@A@@@CBA@C@A@@@A@@BC@AB@@AB@@@B@B@@AB@B@BAB@BA@A@@@ABAAC@A@A@A@AA@@AA@@@@A@ABA@C@A@@AA@AA@@@@@B@B@@@B@@@B@@@B@@@B@@BBB@@BBB@@@B@@BBB@BB@@BBD@BB@@BBB@BB@@BB@@@B@@@B@@A@@B@@@@@B@BBB@@DB@@FD@BB@@@BB@@@@BBBBBB@@@@@@@@A@@B@BA@@@@@@B@BB@@@@@@BBB@@B@@B@@@@@@@B@@@@@B@@A@@B@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@BB@@@@@@B@B@@@@@BB@@B@B@@BBB@@BBFB@@@B@B@@@B@B@@@@@BB@@B@@@@@B@@@B@B@@A@@B@@AB@@@BA@@B@@@@@B@@@@@B@@@@@B@@@@@@@@@B@@@BAB@@AB@@@@@@@B@@@@@BB@@BBB@@@BB@BB@@BB@@B@@B@@@BBB@BBB@BD@BBBBB@BBB@DFFDDDHHDDBBBBBB@@BDBB@B@B@DB@@FBJ@@BB@@B@@@B@DAD@BA@@B@B@B@@@B@@@B@DB@@B@@@B@@@DABAD@BAB@BA@@B@@@@@B@@BB@B@BD@@FBBB@D@B@F@@@@BBBD@BBB@BB@@@BBB@@AB@F@@@B@B@BBBB@BB@DBHBDB@@DBB@BBFAF@B@BBBABABA@@DA@@@@@@@A@@AA@@@A@@@A@@BABA@@@@@AB@@A@@@@DA@@B@@A@@@@B@@@@@@@B@@@BB@@@@@@B@@@@@B@@@@A@@B@@ADA@AB@DAB@@@B@B@B@@A@@B@@@@AB@@@BA@@@AB@@AB@B@BAB@B@B@BAB@BAB@@@@@@A@A@A@@@A@AAA@C@@@@AAAA@@@@AA@A@@BA@@@A@A@AAA@@@A@A@A@@AAAAA@AA@A@A@A@ABA@A@AAA@@@@@@@@@AB@@A@@@A@@B@@AB@@@@A@A@@AA@@@AA@@@A@@A@@AA@@AA@@@AA@@A@@@@@AB@@A@AA@@@@A@@A@AAA@@AA@AA@@@@@A@@@@A@BA@ABA@@BA@@@@AA@@@A@A@@@A@@@@B@@@B@B@D@@@B@B@B@@AB@@@@@@@BA@@@CB@D@D@@@@@@@@@@ABA@@@@B@@@@@B@B@@@@@B@@AB@BA@@D@B@@@@@B@@@BA@A@@@@@@BB@@@BB@@@@@B@B@@@@@B@B@@@@@B@@@@@@@BA@@@A@A@A@@B@B@@@B@B@B@@@B@@@BA@@@AB@@ABA@@@@@A@@@@@@@ABA@@@@B@@@B@@@B@B@@@B@FBDBDB@@B@@@B@@@B@@@B@B@@B@@B@BBBBB@B@F@@@D@@@@@@@@@B@@BBBB@@BB@@@@@@@B@@@@B@@@BB@@@BBDBBBBBB@BB@@@B@@BD@@@B@B@B@B@@@@B@@@B@B@B@BAD@@@B@DAB@B@B@D@B@DAB@@@B@D@B@B@@@B@BAB@B@D@@@B@@@B@B@B@B@B@B@@@B@B@@@@@B@BAB@BAB@@@@@@@B@@@@@@B@@@@B@@@@@BA@@@AB@@@B@@@B@@@BBB@B@@@B@B@@@B@BB@@B@@@B@BADB@@B@D@@BB@B@@@B@@@B@@@@AB@@@B@@@@@B@B@@@B@@@@AB@BA@@@@B@B@@@@@B@BAB@@@B@B@@@D@DB@@@@B@BA@@@@B@@@@B@@F@F@F@B@B@BA@@D@B@DA@@@@B@@@@BBB@@BBHDFBDBBBB@@@B@@@@@B@@A@@@ABA@@@A@@@A@@B@@A@@B@@AB@@@@@B@@@BAB@@@@@BA@@D@BA@@BA@@@ABABA@AB@@ADI@ABB@@B@B@@@B@B@@@B@@B@@B@@BB@@@@@@@B@BA@@@@B@@@B@B@@@@A@@@A@@@AAA@@@A@@@A@A@@@AB@@A@@BA@@@A@@@AB@@AB@@A@@AAA@CAA@A@@@CB@@A@ABA@A@A@@@ABA@AA@@AA@@AAA@AAA@@@A@A@ABA@AB@@ABA@A@AB@@@@A@@@AC@AAA@@AAC@@AA@CCAAA@CAAA@@AAAA@@AAA@@@@@AACCAA@@EAAA@@A@CCCAEACA@@AA@@@A@E@@AA@A@@@A@@B@@@BAB@B@BAB@BAB@BA@@@A@@BA@A@@@@BADB@@BA@@B@@@@A@@BA@@BAB@BAB@B@B@@@B@BBB@@@B@B@@@B@B@@@B@B@@@B@BBB@B@@@B@B@@A@@@ABA@A@@@A@@@@@A@@A@@@@A@@@@@AAA@@CA@@@@AA@@@@@@@A@@@@@A@@@@B@@AB@@@@@@@@@B@@@@A@@AA@@@@@AA@@AAA@A@@@@@@AAA@@A@@A@@A@@A@@@@@A@@AA@A@A@@@@@A@@AA@@@@AA@@@AA@@@AA@AA@@@@@@@@AA@@@@A@@AA@AAAAA@@AA@AA@@A@@@@@@A@@@@@AA@@@@A@@@@@A@@@A@@A@@A@@@@AA@@A@@A@@@@@@A@@AA@@@A@@A@@@@AA@@@@@A@@@@@@@@@A@@@A@@@@@A@@@@@@AA@@@A@@@@AA@@@A@@@@AA@@@@AA@@@@@@A@@A@@@@@@@A@@@@@A@@@@A@@A@@AA@@@@AA@@@@@@@AA@@@@@@@@AA@@@@@@AA@@@@@@A@@A@@@@AA@@@@@AA@@A@@@A@@@@@AA@@A@@@A@@@@@@@AA@@@A@@AA@@@@@A@@@@@A@@@@@A@@@@BA@@@@@AB@@@@@@A@@@@@A@@@@@@@@@A@@@@@@BA@@@A@@B@@A@@@@@@@A@@AA@A@@@@@ABA@@CCCAAACAAAA@@ABC@A@A@C@C@@@A@ABABGBA@ADABAB@BA@@B@B@BABABAB@BABAB@BC"],encodeOffsets:[[134178,46137]]}},{type:"Feature",id:"230306",properties:{name:"城子河区",cp:[131.011304,45.33697],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CA@AAAA@A@A@A@ABA@@B@@A@CAC@@@C@EBABA@@@A@@@A@A@A@ABABA@@@AB@@@BABCB@@ABA@@BA@@@AB@BA@@BA@@BA@@BAB@@@B@@AB@@@B@B@@@BA@@BA@ABAB@@ABA@A@A@A@@@ABAAABA@A@@@A@AB@@CBABAB@@A@A@@BA@ABA@ABA@@@ABA@A@A@AB@@A@A@A@A@@@AAA@A@A@A@@@A@@@@@@BBB@B@B@@AB@B@B@@@B@BA@@@@BA@CBA@A@A@@@@B@@@@@@@@@@@@@@@B@@@B@@BB@B@@@B@@@@@@AB@@@@AB@@ABA@A@@@@@@@@B@@@@BB@BBB@@BB@@@B@@@B@BA@@@@@@@AB@@@@@@@@@@@BB@@@@B@@@B@@@B@@B@@@@B@@@@@@@AB@@@B@B@B@BB@@B@BA@@B@@B@@@@@@@BB@@BD@B@BBB@BBB@BB@@@@D@@@B@@@@@B@@@@@@BBB@@@B@B@@@@@BBD@@@B@@@@BB@@@@@@@@D@B@@@@@BA@@@@B@@@@@DBBBBBB@@@@B@@@B@@A@@@CB@@@BA@@@@BA@AB@@C@@BA@A@ED@@CB@@@@@B@@@@@B@@@B@@@@ABA@AB@@@@@BB@@B@@BB@@@B@@@@@B@@A@@@@@@B@@B@@@@@@BA@@@CB@@ABA@@BA@AB@@@@EDGDEF@@A@AB@@ABA@AB@@AB@@@@@B@@@@BB@@@B@@@@AB@B@@@B@@@@@@BB@@B@@B@@@B@@@@BB@@BB@@B@@@B@BAB@B@B@B@B@@@B@BBD@B@B@@@B@B@D@B@@AB@BA@@DC@@BA@@B@B@BAB@BADAB@@@@@B@@@@@@BB@@@BB@@BAB@B@@@B@@@@@BB@@BB@@@D@@B@@@BBB@@@@B@@@D@@BB@@BB@@@@B@@@@@B@@B@@B@@B@BB@DDBB@@BB@B@B@@ADAB@@@@B@@BB@BB@B@@@@@@@@@@AB@B@@@@@@B@@@B@BB@@@B@@@@@@@B@@B@B@@@B@@@BA@@B@@@@@B@@@B@F@B@@@B@@@@@@@@B@@@@BDBB@B@@B@@@B@@@B@@@@@@@B@@@@@BA@ADAB@B@BAB@BA@@B@@@B@B@@@BA@@@@B@@@B@@@BA@@B@BAB@@@B@B@@@B@@@BAB@@@@AB@@A@@BAB@@@@@B@@@BA@@B@@A@@B@@A@@BAB@@AB@@@@@@@B@B@B@B@DAB@@@@BB@@BB@B@@@@BB@@@B@@@@AB@@@@@B@@@@@@@B@B@@@B@B@@A@@@@B@@@@B@@B@@BB@DB@@BB@@B@B@@@B@@BB@@@@BB@B@@BB@B@@@BB@@BB@@@@BB@A@@B@@A@@B@@A@@@ABA@A@A@@@A@@B@@@@@B@B@@@@@D@@@B@@@@@@@AA@A@@@A@@BA@@@@@AB@@@@@B@@BB@BBB@@@@@@A@@B@@@B@B@@A@@@@@A@@@@B@@AB@B@B@@@B@@@@@@A@@A@@A@@@@@A@@@@@@B@@A@@@@B@@A@A@@@A@@BA@@@A@A@@@A@@@A@@@@@A@@@A@@@@A@@AA@@@A@@@@@@@AA@@A@AAA@@@@@@@A@@AA@@AA@AA@@@A@@@AA@@@@A@A@@@@@@@A@@@@@@B@@@BA@@BAB@BAB@@@B@@AB@D@BA@@B@@@D@B@@@B@@@@@@A@@@@AA@A@AAA@@@A@@@@@A@@@@@@@@@@@@AAAA@A@@@@@@BA@@BABABABA@@@A@@@A@@BAA@@@@A@@@@@@BA@A@@@AB@@@@@@@@A@@BA@@@AA@@@BA@A@A@@@A@@BA@A@@@ABA@A@A@@@A@A@@BA@A@A@@@ABA@A@A@@AA@AA@@AAA@AA@@A@@B@@AB@@@DAB@@A@@@A@@@A@@@@@AA@@A@@@@@AA@@@@@@A@@@@A@@AA@A@@@CB@@A@AAA@@@@@AA@@AA@@@@@A@@@A@@@@@ABA@@B@BAB@@@BA@@@A@@@A@A@A@A@A@@A@@AB@@ABABABA@A@C@@HDBBFBFBDBD@BBDBBBB@BB@@B@BBB@@@D@DAHA@CCCCEACECEEEAC@ABE@CBEBEBEBA@@A@AACAA@A@@A@@@@@CBA@C@@@A@AAA@@@@@A@A@A@A@A@A@@@ABA@@@A@@AA@AA@AAAAA@@@AB@AABA@@@A@@@AB@@A@@BA@@@@BA@@CAC@AA@BA@@B@@A@A@A@AAA@@@@@AA@A@@@AB@@AAAB@@@@@@@A@C@ABA@A@AACAA@A@CAC@A@ACA@CCCAA@A@CAC@CAAAAA@@AA@@ABC@@DABAB@@CBC@CDEBA@ABA@@BAD@@AB@@"],encodeOffsets:[[134163,46394]]}},{type:"Feature",id:"230304",properties:{name:"滴道区",cp:[130.843613,45.348763],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@@@AAAA@AACAAAAA@@A@AAA@@@@A@@@A@@@@@C@@@A@@@A@A@@@ABA@A@ABABC@ABA@@B@@AB@@AB@@@@A@A@A@A@A@@@A@@B@@@@@B@@@@AB@@@@@@AAA@@@A@@@A@ABC@A@CBC@@@AB@@@D@@@BAB@B@BB@@B@B@@@B@@@@@@@@@@@B@@@@BB@@BB@@@B@@@@BB@@B@@@@@@@B@@@@BB@@@@@BB@@B@@B@@@@@BB@B@BBB@@@BBBBBBB@BBBB@@BB@@@@@@@B@@@D@@@BBB@B@@@BAB@DAB@@ABAB@@@B@B@B@BA@@DA@@B@@AB@B@B@B@@A@@@@@C@AAA@A@@@@@A@@B@@@B@@AB@B@BA@@@@@AB@@A@A@A@@BABABABA@ABABA@@BA@@B@B@BAB@B@@@BA@@@ABA@A@A@@@C@C@A@A@A@A@@@@@AA@@A@A@@@A@A@AB@@AB@@@B@@@@AB@@@BA@ABA@@BA@@BA@AB@@A@ABA@A@@@A@@@A@A@ABA@@B@@@BAB@@ABA@@BA@@B@BA@@B@@AB@@AB@BA@@BA@@BA@@B@BA@@B@@AB@@@BAB@@@BA@@B@@AB@B@@AB@@@B@@@B@B@@BB@@@B@@@B@B@@@B@@AB@@@BAB@@AB@@AB@@@BAB@@@B@@AB@@@BAB@@AB@@AB@@ABAB@@AB@@@BA@@@AB@B@@AB@@AB@@AB@B@@ADAB@BADA@DD@B@@AB@@@B@@ABAB@BABABAB@BA@@BABAB@@AB@@AB@BABADAB@BAB@BAB@D@@@B@@@B@B@@@@@B@@@BA@AB@@CBAB@@A@@@A@A@@BB@@@@B@BB@@B@@BB@@B@@B@@BB@@@B@@@@BBB@BBB@BBB@@@@@B@B@B@B@B@@@B@B@@BB@BBB@BBB@@@BB@BB@@@@BB@@@@@B@@@@@@@BAB@BA@@B@B@@@B@@BB@B@@@BB@@B@@@@@B@@@@BBB@B@@@B@@@@@@@B@@@B@@@@BB@@@BB@@@B@BB@@B@B@D@B@@@B@@@@BB@@@B@@@B@B@@BB@@@@@B@@@@@@@B@@@BB@@B@@@B@@AB@@@@@@B@@@@B@@BBB@@@BB@@B@B@@@@@@B@@B@@B@@B@@B@@@@@B@@B@@@@BBBB@@@@B@@@B@B@B@@@B@BB@@@@@@BB@B@@@BBBBBBB@B@@@@AB@@@@@BB@@@@@BBB@D@DBDBB@DBB@@@@@B@@B@@B@@BB@@@BBB@@@DB@@B@B@@@B@B@@@@B@@@@@B@B@B@@@B@@@BB@@@@BB@B@@BB@B@B@@@BBB@@@B@@BB@@BB@@BB@@@@BB@@@@B@@@B@@AB@@@B@@@@BB@@BB@@@@BB@@@@@@BB@@@@B@@B@@A@@B@@@BA@@B@B@@A@@B@@@@AB@@@B@B@@AB@@ABA@@@AB@@ABAB@@@@@@BB@B@@@B@@BB@@@@B@@B@@AB@@@@BB@@B@@@B@B@B@BBB@B@@@@BB@B@@@@@B@B@@AB@@BB@B@@BB@B@DB@@B@BAD@B@D@@@BCBA@A@@@@@@@AB@@@B@BBB@@@B@@A@@B@@@@@@A@@@@B@B@B@B@B@BA@@B@BA@@BA@@@@@@@A@@@A@AA@@A@@@A@@@@@@@A@@B@BAB@@@B@@@F@D@B@@@BA@@B@@A@AB@@@B@@@@@B@@@BB@@BB@@@@@@B@BAB@B@@@BA@@@@B@@AB@@A@@B@@A@@@@@A@@@@B@@A@@@@@@@AB@@@@@B@B@BA@@BA@@B@@@B@@@@@@@BA@@BA@@@@@@BA@@@@B@B@@@B@BBB@B@@@@@D@BA@@BA@@BAB@B@@@BAB@@@BA@@@@BB@@B@@@B@@@BA@@B@@@B@@@@@BA@@@@@@BBB@DBDBB@B@@@B@@@B@@@@@@@@A@@@@@@B@BAB@B@@@@@BA@@B@@@B@DB@@B@B@B@DBB@BB@@@@@BBD@@@B@@@@BB@@B@@@BBB@BB@@DBB@B@@@B@@@@@@@B@@A@@@@B@@A@@D@BBB@@AB@B@B@@@@@@@B@@@@@BBB@@@@@B@D@B@B@@A@@BA@@BA@@BA@AB@@A@@BAB@B@D@@@D@D@B@B@@@@@B@@@@@D@B@@@BA@@@@B@B@B@@AB@@AB@B@@@B@@@@@@@@@@@@BBD@@@B@B@@@BB@B@B@B@B@@@B@BBB@@@B@BB@@B@@AB@@@B@@@@@B@@@@BB@@BB@@@B@@@B@B@BAB@F@@@@@B@BA@@@@@B@@@@B@@@BBB@BBD@@@@@B@BB@@B@B@DB@@B@@B@@@@@@@B@@@@B@BAB@B@@@B@@@@@B@@BB@@BB@@@B@@@B@B@D@B@DBBBB@@@@@B@B@B@B@@@@@@@@@@@BBB@@@B@@@B@@@B@@@B@B@@@B@@@@@BAB@@@BAB@B@B@@@@@@A@@@@@A@@@AB@@AB@B@@@@A@@@@@A@A@@B@@@BA@@@A@@@A@@@@@@@A@@DA@AB@B@@@@@@@@AB@BAB@@A@@@@@CBA@@@AB@@@B@B@@@@@@@@@AA@@@@@A@@@A@@@@@AAAAA@AA@@@@AB@@@@A@@B@@@AA@@@@@@A@@@A@@A@@@@BABA@A@@@@@A@@@AA@@@AA@@@@@@@A@@@ABA@@@@@A@@AA@@@@@A@@@@BA@@BAB@BA@@BAB@@@FEHCFC@@@@BAB@@AB@BA@@DA@@B@@A@@@@A@@@@A@@@@B@@@@A@@@@@A@@AA@@@AA@@A@@@@BAB@BA@@@@@A@@@A@@@@@A@@@@DA@@FCB@B@@AD@@@BAB@@A@@B@@A@@DA@@B@@@@A@@@A@@A@AAAACA@@@@A@@@@@AB@@@@A@C@@@@@@@@@AA@@@@@A@@AC@A@@@@@A@A@@AA@A@@@@A@@@@@A@@@C@@@@@AAA@AAA@AAA@C@@AA@@A@@@@@@@AA@@@ABA@@@AAA@A@A@@@A@@B@@@@A@@@AB@@CAA@C@C@@@@@A@@AA@@A@@A@@@@AA@@@@AA@@@@@A@@@@@@@@@ABA@@@@@AA@@AAAA@@@C@@@@AA@@A@A@A@@@A@AAA@A@A@A@@@CAA@@@A@@@@@@@@@A@@A@@@@@@@@@@AA@@@@@@@A@@@ABA@@@@@AA@@@@@A@@@A@AAA@@A@@A@@AA@@@A@@@A@@AA@A@@@AAA@@@A@@@@@AA@@@@A@@A@@A@@@@@@AAAA@@A@A@@@C@ABC@@A@AAGCCAGAC@A@A@A@A@AACA@C@@AAA@A@CBAB@BA@A@A@AAAAA@AAA@E@EBAAA@CA@@CAGACAA@@AAAAAA@A@@@E@A@@BA@AA@@A@@AAA@AACAA@@@@@E@A@CAAEA@@ACA@A@@AA@@@@@A@@@ABA@ABC@ABCB@@A@@@A@@@CAA@@@A@@@A@A@A@@@ABC@CBA@@@A@@@AA@@AI@EA@@C@A@AAAAC@@AAAAAACCGGCCECCEA@AAA@AAAAC@@AAA@AAA@A@@@AA@@@AA@@AAA@@A@@AA@AA@@A@@@@@A@@@@@@BA@@BA@A@@@A@@@@@@@@@A@@@@@A@@@@@A@@@@@AB@@A@@BA@@@AB@@@@A@A@@@A@@@@@AA@@A@@@@@A@A@@@A@A@@EAAA@@AA@AA@A@@@AA@@@@A@A@@@@@@@AA@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@AB@@@@A@@@@@A@@@@@@@AA@@@AA@A@@@@A@@A@A@@@@B@@A@AB@@@@@@@@@AAAAAA@@@@AA@@A@@AEC@@CA@@AA@A@A@@@@@AB@@@@A@@@A@@AA@@AAA@AA@@AAC@AA@@AAA@AA@@A@@BA@A@ABA@A@A@A@A@A@A@@@A@@@ABABABA@@BA@A@"],encodeOffsets:[[133835,46296]]}},{type:"Feature",id:"230307",properties:{name:"麻山区",cp:[130.478187,45.212088],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@@@A@A@A@@@AA@A@A@EAE@@AA@AC@@AA@A@E@C@AAA@EA@@@@A@EBA@CBA@A@ABA@@@@@@@AAC@AAA@A@AAA@A@@AA@@@@@@C@@@@@AA@@@A@C@A@AB@@A@@A@@AAAAA@@AA@@@AAA@@@A@@@@A@@@AA@@@@AA@A@@BA@AA@@@@A@@A@@@@@@A@@@C@@@A@@@@AA@@A@@@A@AA@@@@@AAA@A@A@A@@AA@AA@@@@A@@@EAA@A@@@@@AA@@AA@@@@@@A@A@@@@@A@@@CAA@A@@@@AA@@@@@@@@A@@A@@A@AA@@A@A@@@A@A@A@@@A@@@@AA@@A@A@@@AA@@@@A@@A@@A@@@A@@@@@A@@@@@@AA@@A@AA@@@@A@@BA@@@@@@@A@@A@@AA@AAA@@@@@A@@@@@A@@@A@@@A@@@A@AA@@A@@@A@@@A@@@A@@@A@@BA@@B@B@B@@@B@B@@@@@B@@@B@@@@@BA@@@@BA@A@@@AB@@@@@B@@@@A@@@@@A@A@AA@@A@A@A@A@@@@@A@@@@@A@@@AAA@@@@@@@A@@@@@A@AB@@A@@B@@@@@B@@@B@@@@@B@@@@AB@@@@A@@@C@A@@BA@A@@@A@A@CBA@A@AB@@@@A@@B@@@B@@@B@@BB@@@B@@@@@@@@A@@B@@A@@@@@@@A@@B@@@@@B@@@@@@@@A@@BA@@@@@@B@@@@AB@@A@@B@@@@@@@@B@@B@@@@@@@@AB@@@@@@@@@@A@@B@@@@B@@@@@@@@B@@@@@@@B@@@B@@@@A@@B@@@@AB@@@@A@@@@BA@@@@@A@@A@AA@A@AAA@@AA@@@A@A@A@@@@@A@@@AB@@@@A@@@@B@@A@@@A@@B@@AB@@@@@B@@@@BB@@@@@B@@@@@@@B@@@@@B@@B@@B@@@@@B@@@@@@@@@BA@@B@B@@@@@BA@@@A@@@A@AB@@A@@@@@AB@@@B@@@B@@@BBBB@BB@@AB@DAB@BB@BB@B@@@B@@C@A@C@A@@@C@A@ABAB@@@B@@@@@BA@@@ABAB@@@B@@@BBB@@BB@B@BAB@@@B@BB@BB@@@@B@B@@@D@@@D@B@F@D@B@D@B@B@B@D@B@B@DBD@@BB@@BA@@BA@AB@B@@BB@@@@BBBBB@BBB@@B@@@@@B@@AB@BA@ABABA@@BB@@@B@B@@@@@DBBBDBBB@@D@BBBBDB@@D@DB@@BBB@BBB@BBB@BBBBBBBB@BBB@@B@@BB@D@@@B@B@B@B@@@B@@@D@@BB@@@@@B@@D@BAB@B@@ABA@BB@@@B@B@B@BAB@B@@AB@@ABCBA@AB@@A@A@@@EBE@CBE@CB@B@BA@@@@@@@@@@@@BA@C@CB@@AB@@AB@DAF@B@@@B@@AB@@AB@B@@@B@@@B@@BB@BB@HD@B@@B@@B@B@D@DBBA@@B@@A@@B@@@BB@B@BBB@DBBB@@BBBB@BB@BB@DBB@B@D@@B@DDBB@@DB@B@BBB@@@B@@@@@@@@B@F@B@BA@@B@@@@@BB@@@B@@B@@@@@@BB@@@@BBB@FB@@@@@B@@@B@B@@@B@BB@@@@B@@@B@B@@@@@B@@B@@DD@@@B@@@B@@@@@DDDBDBB@@BBB@B@B@B@B@@@@@@B@@@B@B@@@B@@@@@B@BAB@BA@AB@@@B@@BB@B@B@BB@@@DBD@@@@@A@@@CBA@A@AB@@ABABAD@BA@@@@B@@@BA@@BA@@@ABA@@@@D@BBB@@BB@@@BA@@@@BA@ABA@@B@@@BCDAB@@BBBB@@@@@B@@@B@@B@AB@@@@@B@@BB@@BB@B@B@@@BBBDBB@@B@@@B@@@B@@@BB@@BB@@@@B@@@B@@@B@@@BB@@BB@@BDF@@@@BB@@@@B@@B@@@B@B@B@BAB@B@B@@@@BBB@BB@@B@B@B@D@BAB@@@@@B@@@BA@@BA@@B@@@BB@@@B@BBB@BB@@@B@@@B@@@B@@@@A@@@@BB@@BB@@BBBB@@@@@@@B@@A@@@@B@@@@@@@B@@B@@B@@B@@B@@@B@B@@@BB@@@B@BBB@@@B@@@@B@B@@B@@@B@@@B@@BB@@@@@@@BB@@@BBB@BB@@BB@@@@@B@@@BAB@BA@@B@@@B@@@B@@@B@@B@@BB@@@@B@@@B@B@@@B@@@B@@BB@@@@B@@@@@@@@@B@@@@@@@@@@B@@@B@@B@@@@@@BB@B@@@BBB@@@@@BBB@B@@@@@B@B@@@BA@@@@B@@@BBD@D@BBB@B@BB@@@@@@BB@B@B@@@B@@@@@B@B@@@B@@@BADA@@BAB@@A@@@A@@@@@A@A@@@A@@@CBA@ABA@ABABCBA@ABA@@BA@@BABA@AB@@ABABABA@ABABA@@@A@@BA@@@ACCB@BC@ABABC@@@ABA@@BA@@BA@@@ABA@@B@@A@@BA@@BABA@@BA@@BA@@BA@A@@BA@@@A@@BA@A@@BA@@BA@@BA@A@@BA@@@A@@@A@A@@@A@@AA@@@A@A@@@A@@BA@@@ABA@@@AB@@A@@BA@A@@BA@@@AB@@A@AB@@AB@@AB@@ABA@@BA@@@AB@@A@AB@@AB@BA@@BA@A@@@AB@BAB@B@@@B@@@B@B@BAB@@@BAB@@AB@@AB@BAB@@A@@BA@@@@@A@@BA@@BAB@B@@@B@B@@@BB@@@@B@B@B@B@D@D@@@B@B@B@BA@@B@@A@@@ABA@A@A@AB@@AB@BABAB@BABABA@AB@B@B@@@BA@@@@B@@A@ABA@@@A@@@AB@@@@@B@B@BBD@@@@@B@@@@A@A@ABA@@@AB@@CB@@A@A@A@A@@BABA@@BA@CBA@A@@@AAA@A@@@C@@@A@@@@@@AA@@AAAAA@AAAAAA@@A@AAA@A@@A@@@@@AA@@@AA@@@@A@@A@@A@@@@@@@A@@@AA@@@@@A@@AA@@AA@@@@@A@@@@@@@@@@@A@@@A@AA@@A@ABA@A@@@C@@BA@@D@DAB@D@BAB@@@B@@@B@BB@@@@@@BA@@@@@A@@@@@AB@@@B@B@B@B@B@@@@@BA@@BA@@@AB@BAD@BABAB@B@BA@@B@B@@@B@@@D@@@@@@A@@@@@A@CAA@CA@@AA@@@A@A@@@A@A@ABA@@@EAAAA@@AA@@@A@@ABAD@@A@@@@@A@AA@AA@@@@A@C@C@A@C@E@C@A@@@@@@@@A@C@ABA@A@@@AAAAA@AA@@@A@A@A@A@@@C@A@@@A@A@@@A@A@ABA@A@A@A@@@@@@AB@B@B@@A@@@A@@@EAA@A@@@A@@BA@AB@@A@@B@@A@@@@B@@A@@@@@@@AB@B@B@@@FA@@@@@@B@BA@@B@@AB@B@@@@@@@@@EA@@AAA@A@AA@@AA@@@@@A@@BA@@@A@@@@@A@@C@@A@@@A@A@@@@@A@@A@AA@@@AA@@@A@@BA@@@ABA@A@@@CAA@A@A@A@@@A@@@@@AA@@@AA@AAC@A@@A@@@@@ABA@@A@@A@A@@@A@A@@@A@AAAA@AA@@AA@@@A@@A@@@A@@@@@@@A@AB@@@@@@A@A@@A@AA@@@CAA@AA@@@@@A@@@ABAB@@@@@A@@AC@A@A@A@A@A@ABABABABA@ABA@A@@@AB@@@@@@@A@AA@@A@A@@AA@AAAAACCAA@@AA@A@A@@@A@@@@AA@@@@A@A@@@@AA@@A"],encodeOffsets:[[133723,46195]]}},{type:"Feature",id:"230382",properties:{name:"密山市",cp:[131.846635,45.529774],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@AAA@A@C@C@C@CACA@@@ABA@@@A@@AAECAA@@B@@AF@D@BA@@@@AA@@O@A@@A@@@@@@FA@@@@@AA@@@A@A@AB@AA@@A@@@A@@A@@AABA@CBC@A@CAAA@A@@@@B@F@B@@A@@@@@AECCA@AAA@@AA@BA@A@AB@@C@AAAA@A@@BAB@BBD@B@B@@A@E@A@@AA@@C@CAAAA@@A@@@AB@HA@@@A@@@@EAC@AAAAAA@@ABABA@@@C@AAAC@@A@@@A@A@ABAAA@AAAA@A@@AAE@K@AAA@@@@ADA@A@A@@CACAABAF@B@AA@@AAEA@@AA@A@ABCB@@BD@BA@CDA@@@C@AA@@@@@A@@DCB@AAA@@@G@A@@@@A@@@ABA@A@@AAA@AAE@C@@A@@@A@@@@BA@@B@JDB@@@@@AEAC@@AA@@A@A@EBEB@@A@A@A@@A@@@A@@BADC@A@@@ACA@AA@@@@@@B@D@@AB@A@@AE@@A@@@A@@@@BAB@F@@@B@@A@A@CAAAA@E@A@A@CCCAA@@A@@@A@@@AD@D@BA@@@A@@AAAAA@C@A@A@AF@@A@@@EAA@@@ABABAB@B@@@B@@B@B@BCBAB@D@BB@B@@@B@BA@ABM@A@A@@B@@@@BBD@B@@@@B@BAB@@A@A@CAA@@@@@@@AB@@@@@@EAG@C@A@A@@B@@@BBD@@@B@@@@A@IEA@EAA@A@A@@@A@BB@BDB@B@@@B@@AB@@AAECA@A@@@@@@B@@@BFB@@@B@B@@A@@@C@A@AAAAA@@@A@@DA@@BA@A@GAA@@@@@@B@@@B@@@@@@GBE@A@@BA@B@@BBB@B@@@@ABI@GBA@@@@B@@@@@BH@B@B@@@@B@@A@IB@@@@AB@B@@A@E@@@AB@B@@@BEDA@AD@BA@A@GAA@A@SDófádĻzƗXăpqL«ZůWFCBA@CBA@EAA@A@A@MH@@AB@B@@BD@@@B@BCDCHA@ABIBLFFDB@NMVEHAPC\\TUlO`dAFKBOKTsqS\\¢yI_CcEGA{GG@SBIRWftVABMT}SBGDIFUHc@@EC@@GCCASI[QqYWHyEG]kQASAA@A@KC@cEG@K@BABC@ABAB@BA@ABA@AB@@A@@BB@@BBB@@@DBD@FA@CB@@A@ABABA@@B@@AB@B@BA@@BA@@@A@@BA@A@A@@@A@@B@@AB@B@BAB@@A@A@A@A@A@A@A@ABA@A@ABC@AB@@A@AB@BAB@@AB@@ABA@@B@@@BA@AB@@AB@@ABA@ABABABA@AB@B@DAB@BA@ABABABABC@C@ABC@CBEB@@@BA@@BA@A@AB@BBBD@BAB@D@B@@@@B@B@BD@@@B@@B@@@B@BB@@@B@B@B@B@BB@BB@BBB@@@B@B@B@B@@BA@A@A@A@@@A@A@A@A@@AA@A@ABA@@@AB@B@@@BA@@B@@AB@@A@CAC@CACAAAAB@BA@@B@@AB@B@@@B@@@B@@@BBB@@BBB@@BB@BBB@@BB@@BB@@BB@@@@B@@@B@B@@@B@@@BA@@@@B@@@B@@@B@B@@A@A@AB@@ABABAAE@C@C@@ACACAC@C@AB@D@DABABABCB@B@B@BA@A@A@EAC@ABA@AA@BA@@@CDCBABCDABABEDEDCB@BA@AB@BABAB@@@@@@B@@@@BB@@@@@@B@@@BA@@@AB@@@@@@@@@B@B@B@F@@@@@BB@B@@@@B@@@@@B@@A@@@@@@BA@@@@@A@@@@@A@@@@@@@@BA@@@@@@B@@@@AB@@@@@@AB@@A@A@@@@@@@@@A@@BA@@B@@@BBB@@@@A@@BABA@AB@BABA@@B@@@@@@@@CB@@A@A@C@@@A@@@@@@@A@@@@@@@A@@@@B@@@@A@A@@@A@A@@AA@@@@BA@@@A@@@@@@@@@A@AB@@@@@@@AB@@A@@A@@@@@@BA@@@@@A@@@@@A@@@@@ABA@A@ABC@A@A@@BA@@@C@@@A@A@C@CBA@C@A@C@A@A@A@A@C@C@C@E@AAA@E@A@G@C@ABC@E@E@E@C@A@G@CBKBABIDC@@@@@A@A@@@A@@@AB@@A@AB@@@@@@@@AB@@@@A@@AA@@@@AAB@@@@@B@@@BC@@@A@@A@@A@@@A@@BA@@AA@A@@AA@A@@@A@A@@@C@@@ABA@A@A@@@A@@@C@A@C@E@G@E@G@EBABGBEBEDCBCBA@@@@BA@A@@@ABA@ABABEDCDCBCDABEDAB@@A@ABGBIFA@ABA@EBAB@@@@@BA@@BA@EBEBCBAB@@AD@@ADAB@@@BCB@@A@EBC@@@A@C@A@ABA@CB@@@B@@AB@@@@@@@B@B@B@@@@@@@B@@@@@@A@@@@@@B@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@B@@@@@@B@@B@@@B@@@@@@A@@BA@@@A@@@@BA@@@@B@B@@@B@@B@@DB@@B@B@@@@B@BBBBB@@B@@@@BB@@@B@@@@@@@@@BB@B@@@@@@@BB@@A@@B@B@@@B@@@@@B@@A@@B@@@B@@@@@B@@@@@@@@@@@@BB@@@B@B@@@B@@B@@@@B@@@@@@@@@@@B@@@@@@@@@@@@B@@@@B@@@@@@@BABAB@@@BA@@B@BA@@@@BAB@@ABA@ABA@@BA@@B@B@@AB@@AB@@A@@BA@A@ABAB@@ABA@@BA@@BAB@@AB@@AB@@AB@BA@@BA@@B@BA@@BA@@B@@@BAB@@@B@@@B@@@B@B@@@B@@AB@@@B@B@@@BB@@B@@@BBB@@@BB@@B@@BB@B@@@BB@ABBBBD@DBB@@BBB@B@BB@@B@B@BBB@B@@@D@BB@@B@@@B@B@B@B@B@@@B@B@B@B@@@B@BBB@B@BB@@BBB@BB@@BB@@BB@B@@@BB@@B@BA@@@@@@B@@@B@@@@AB@B@@AB@@AB@@AB@B@@@@@BA@@@AB@B@B@@AB@@@BABAB@B@@ABA@@@@@AB@BA@@B@@@@@BA@@B@BA@@BABA@@BA@@@@@@@@@@B@@@@@@@B@@@B@@@B@@@@BB@B@@B@@BB@@@@@BB@@BBB@B@@@B@B@@BB@@@@B@@B@@@BB@@B@@BB@B@B@@BD@BBB@@@B@BBB@@@B@BBB@@@BB@@B@BA@@B@@AB@@@@@B@B@B@@@@@B@B@BA@@@@B@@@B@@@@@BBB@@@B@@B@@BBBB@BBB@BBB@B@@@BB@@@@@BB@@B@B@@@@@@BB@@@AB@@@B@@@B@B@B@@@B@@@BB@@BBBBB@@B@@@BBB@@BB@BBB@@@BB@@B@BB@@BBB@@BBB@@@BBBB@@BB@BB@@BBB@@@@@BB@@@B@BB@@@AB@B@@@B@@@B@@BB@@@B@B@BBB@B@B@@@@BBB@BBBBB@BB@@B@B@B@BA@@B@@@@@BB@@B@@@BB@BB@@BDBBB@BBB@B@@@B@@@BAB@@@B@@@@BB@@@B@@BBB@@@@BB@@@B@BBB@@@@@@BB@@@B@@B@@B@B@B@B@@@B@@B@@@@@@@BB@BB@@@BA@@BA@A@A@A@ABA@A@ABA@CBA@C@C@C@@@A@@@@@A@@@@BA@EBA@A@A@A@@@A@@A@@@B@@A@@@@@AB@DA@@BAB@@AB@@@B@BBBB@BDBDBB@@OHUvTfIXPnF^G\\D^XDbYTOXC@CAK@E^MfS\\bHTG^g@@@@@BHBB@@BD@D@@@B@DA@@@@@B@B@@DB@BDBB@BBB@@BAD@F@@@BB@HBB@BBH@B@BBDBFDNFB@@B@@@BABBBB@DBFB@B@B@B@@@BA@AB@@FD@@BBD@@BBBB@@BA@@BA@BB@@@BB@BBBB@D@@@@EBABA@@BAB@B@@BDB@B@B@D@@@B@D@B@@@BBBBBBB@BB@@BB@BA@@BA@@@@@DDB@BB@@@B@B@D@@BBB@BB@@B@BBD@B@B@@A@@BAB@DBB@B@@BQPWTCVlZPGTEEMLG\\EBD@B@@@@BB@@BB@D@D@@@BBBB@@BBB@@B@DBB@@@@@@BB@@@@@@@@@@@B@B@B@F@B@BAB@B@BAB@@@D@D@D@B@B@@@B@@@@BB@@BBBBBBB@BBB@@@@BBB@@@BB@B@BB@@@@@@@@@BABA@@B@BA@@BAB@@A@A@@BA@@@A@@BABAFCBADAB@D@D@@@D@D@D@D@D@D@@AB@B@BAB@B@B@B@@@BB@@B@@@B@BA@B@@B@B@B@B@B@DBBBB@B@B@B@B@B@B@BB@@@@B@@@@B@@B@@@@B@@B@@BBBB@BBB@@@B@@@B@@@kIJIJCLVHrMXbXFDBPQUOAi@B@B@BBB@BB@@@@@B@@@@@@@@B@@@BBB@B@BBB@@@@@B@@@@@B@@@@@DBB@@@@@B@@@BBD@B@@@B@@@BA@@@@@AB@@@@A@@AA@AA@@AB@@@@@@@@A@@@@@@@@@@@A@@AAAAA@@@@A@@@@@@AAB@@A@A@@BAB@BA@@@AAAAA@A@@@AB@BADAB@FAB@@@BAB@@@B@@@@@@@B@@@BB@BB@BBB@B@B@DBB@B@B@BB@BB@@B@BB@@BBB@@@@B@@@@@@@B@B@@@B@B@BAB@B@DABAB@B@@@B@@@@@@BB@BB@@@@BBB@@@B@BBB@@@B@@@BA@@BAB@@@@@@@@@@@@@@B@@B@@B@@@@BB@@B@@BB@B@B@B@B@D@B@B@@@@@B@@@@AB@@A@@BA@@@@B@@@@@BB@@BBB@@@@@@@@A@@@A@AB@@A@A@@@A@@AA@@ACAA@@@A@A@@@A@A@AA@@A@@@A@@@AB@@AB@@ABAB@@@@AA@@@@AA@@A@@AA@@@A@@AA@A@@@AB@@@hN`~[l^x_@@BB@@@@@A@@@@@@@@@AB@@A@@@@BA@@@A@A@@@@@A@@@@B@BABABABABA@@BA@@BA@AB@@@@@@@BA@@@@@AB@@@BAB@@@BA@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@A@@AA@@@@@@BA@@@@@@B@@A@@@A@@@AAA@A@@@A@AA@@AAAA@@@@A@@@@@@@@@@@@B@@@DAB@B@@AB@@AB@@@@AB@B@@@B@BAB@B@@@DABAB@@AB@@A@@@@BA@@@@@@BA@@BAB@B@B@@ADAB@@@BA@ABABA@A@@B@B@@AB@@A@@@@@A@@@A@@A@@A@@@@@ABA@@@@@A@AAA@A@@@@BA@@@@@@B@BBB@BBB@B@B@B@B@B@B@@@B@B@B@D@@@B@B@B@B@@AB@BAB@B@@@BAB@B@@@@@B@@@B@F@B@B@B@B@BBB@@B@@@@B@@@B@B@@@B@B@@@B@@@B@B@@@B@BAB@BAD@BAD@B@@ABBB@@@BD@@@@BBB@D@B@B@D@@@B@BBBBB@BDB@BBB@D@@BB@@BB@@B@@B@@@@@@@B@@B@@@@@@@AB@@@B@@@@AB@@@@C@@@@B@@@B@@@BBB@@@@BB@DBB@@BB@@@@@B@@@@@BB@@B@@@@@@@D@B@B@@@@@B@@@@@B@@BBBB@@BD@F@D@@@B@B@@@@@BB@@@@@@@B@B@D@DBB@@@@@@BB@@B@B@B@DBFBB@D@H@F@F@B@DAB@B@BAB@B@D@B@F@B@B@DBF@F@D@D@B@@@BBBBBBDBJFHBBBD@B@B@B@@@BAB@B@@@BBB@BBD@@DBBDBDB@@BBB@B@D@B@DAB@DA@@DAFADABADAFCDC@@B@BA@@BABABAA@A@@@A@A@A@A@@@AA@@A@@@@A@@@@AAA@@@C@C@C@C@A@@A@@@@@@@@@@@@@@@@@A@C@A@AA@@A@@AC@A@@AA@A@AA@@A@A@AA@@A@AA@@AA@AA@@@@CC@AA@@@@AA@@@@A@@@C@A@@@A@A@@B@@@@A@@B@@@@AB@@@@A@@@A@@AAA@@A@@@A@@@A@A@@@AB@@AB@@A@@@@@@@AA@@@@@@@@@@@AAA@@@@@@@A@@AA@@@AA@@AA@@AA@@A@@@A@@AA@A@AA@@A@@@@AA@@@@@@@@A@@@@@@A@@@A@@@@A@@@@@A@A@@@ABABABA@@B@@A@@@AB@BA@@@A@A@AA@@@@A@@@@A@A@@@A@@@AA@@@@BA@A@A@@@AB@@A@A@@@A@@@A@AB@@A@@BAB@BABAB@@@BAB@B@BABABABA@@BA@@@A@A@ABC@@@A@AB@BA@ABA@AB@@ABC@ABC@CBA@A@@@AB@DCDADCBCDCBADABCBA@@@@@@@@@A@@@@@A@AA@@@@A@@@A@@@@@@BA@@@A@@B@AA@A@A@A@E@CACDA@AFCDABABAB@BCB@@@@@@@B@@@B@@@@@B@@@@@@@@@@A@@@@@@B@@@@@B@@@B@@@@@B@@A@@B@@@B@@@@@B@B@@@@BB@@@B@@@@@@@@@B@@BB@@@B@B@@@B@@@@@@@@AB@@@@@@A@@B@@@@@@@@@@@BA@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@A@@A@@@@@@A@@@@@@AB@@@@@@@B@@@@@B@@@B@@@@@@@@@B@@@@@AB@@@@@@@@@BB@@A@@B@@BB@@@@@@@B@@@@@@@B@@@@A@@@@B@@@@@@@@@B@@@@A@@@@B@@@@@@@@@B@@@@@@B@@@@@@@@B@@@@@@@@@@A@@@A@@@@B@@@@@@@@@@@BA@EBC@ABE@C@ABEBE@@@@@ABA@A@BB@B@BAB@BA@A@ADADAD@DABABABAB@BBBB@BABCBABAB@BBBDBBBFBB@B@B@BA@@B@@@B@@BB@B@B@@BBB@BABABEDA@ABAD@@@BAB@@ABA@AB@BBB@@@BABABA@@B@B@@BBB@B@BB@B@B@BB@@@BA@AB@B@B@DDBBD@D@F@F@RBD@DCBAB@DABABAB@BADBB@@BBB@B@B@@B@D@B@BABABCDEBADAD@BBBB@B@BABABCB@@ABBBBBB@B@D@HAD@DB@B@BCBA@@B@@@B@@BBB@DADABAB@BBB@@B@@ABCB@@@@@B@BB@B@D@@@FAB@D@BBBDBBB@B@B@D@BBDBBBD@@@BA@A@A@C@ABAB@B@DBBBBBB@BA@AAA@@@A@AB@H@FBD@D@DAD@DBD@BBBD@B@B@BBBB@B@F@B@DBD@@@D@BADCBADABAF@D@BB@HBDB@D@BABA@C@AB@B@B@BB@@BBB@BA@AAA@CBCBCBABAF@D@DAHC@ADABCBC@ABABAD@B@BBBBBB@@BA@@BABAD@BBFDDDBDD@D@B@BA@A@ACCECCCAAAA@CBADAD@DBDBDFDDD@D@BA@A@CAAGCCAAABABADCBADABABABABAD@BADAHAJAD@DAD@B@JEB@B@@ADA@@DADADCDAB@DADCDADAHEB@@@DA@AB@B@@@@AB@@@BA@@@@DABABABCB@@@@@BABABA@ABA@@BCBCBA@@BABA@@BABADAFADAB@B@BAB@BA@C@@BA@ABC@@@A@C@CBA@ABABA@@BABA@@@AB@B@D@@@BABAB@@@B@@@B@B@@@@AB@BA@A@@@@@@BA@@@A@@AAB@@C@CBABEBC@@BA@@@A@@@@@@@A@AB@@AB@@AB@DAD@@@D@@@B@D@B@B@@@F@B@D@DBHFBBB@B@@@@@B@@ABA@@@@@@B@DAD@BADCDADA@A@@B@BC@@DCDA@@BCDABA@AB@HIDEBABABCBC@@BEBADCDGBABA@C@A@A@@@@BA@A@A@A@E@A@@@A@C@C@AF@@AD@HAB@DAB@@@D@@@DA@@B@B@@@@@@@B@BAD@D@BA@@B@HAB@B@B@DAB@D@BAB@B@DA@@B@D@B@DAB@B@BAB@B@D@BAB@FAD@B@B@@@B@BC@@@@@AB@@@BCBCDC@AB@@@BAB@DADAB@@@BADA@ABABAB@@@BA@@DCBABCB@@A@A@@BCBC@@@ABCB@@A@@B@@A@@@@BA@AB@@A@@@A@@@A@AieRa¢YvMÆ¥BǖRHMC@A@@A@@@AHC@A@@A@CAEAECAA@A@C@AB@@@D@BAB@@@@ACC@@@A@@B@@@HBB@@@B@@A@A@C@A@ABA@A@@@AMCECAA@@@ABAB@@A@A@@A@AA@@EBC@@@@A@@@ABA@A@@A@EAEAA@@AB@@@H@BAB@@A@@A@AAC@CA@@AA@A@A@ABAB@B@B@BBBB@@B@HAD@@A@A@@@AAAAAA@G@G@CACA@A@@B@@AJDB@@@@A@@@@ECA@@@B@FA@@@@@AEA@A@A@AA@@AA@CAKCA@AA@@@@BA@@B@NB@@BA@ABAA@@@@A@@ABC@@@A@C@CEACAC@@@ABAB@B@FBB@B@D@DABA@@@@AAICICAA@A@@@ABA@AB@BB@@@B@B@@BD@@B@@@BAA@@A@AB@@@@@B@DDB@@@B@@@@C@ABABC@A@@AA@@IBC@C@E@A@@A@@DA@ABAA@@AA@EAAA@A@E@A@@B@B@B@@AAG@@BA@@B@BBBBDDHBDB@A@@@ACAA@@AA@BABABC@A@@@@A@@AGDA@G@AAA@@AB@@CBADAB@B@D@FBF@BA@@@@@AA@E@AAA@B@@A@@BAHBF@@A@@@@AAC@C@AA@@@ADE@@B@B@BB@BBB@@D@@@@C@G@C@AB@@@B@@@@F@@@@B@DA@A@@@CAA@A@@B@BBB@@@BAD@@A@@@@CAAAA@BA@@@@FBB@B@@@BA@@A@@AGAAAA@@A@@B@@@DBB@B@B@@A@A@A@@FA@A@@@@CC@AACB@BCB@@@@AACCCAAB@@@@AB@BBDF@@F@@AB@ACAC@A@AB@B@FAB@@@@A@@AACAA@@A@@@AB@@A@A@A@@BEBA@A@@BC@A@@A@@@E@A@C@EE@@@AB@JD@@BA@@@AA@EAA@AA@AAA@ABADC@@@@@ACE@A@@B@@@B@DF@@B@B@B@B@BABA@A@@@AAAECGCA@@A@@BAJBD@BA@AB@@AA@A@ABAAA@AAAA@A@A@A@@EEAAACAC@A@A@A@@@AB@BBBB@HB@FFB@DBDA@@B@B@@AB@AA@AA@AAE@A@@@@A@ABA@AB@D@BA@@@AAAAA@@@A@@BC@A@@@A@@GCC@C@A@@@@B@@@BB@@BBB@BA@@BA@@@A@ACACA@A@AAC@@@CDA@@@EA@@@A@AAA@A@@A@A@EBCBAAA@CK@@@ADAB@@AAA@@A@A@A@EAAA@A@@BCDE@@@A@AA@@@A@ADA@@@C@C@AA@@@@@ABABAB@@@AA@@GBCBAAA@AA@@@A@@BADAB@@A@@A@AAEAA@A@@B@@@B@B@@ABA@C@@@A@@A"],encodeOffsets:[[136274,46182]]}},{type:"Feature",id:"230321",properties:{name:"鸡东县",cp:[131.124079,45.260412],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@AA@@@A@A@@@AA@@CAA@@AA@@@@A@@A@@@@@@BA@A@@@A@A@@@@@@@A@@@@@A@@B@@A@@@A@@A@@A@A@@AA@@A@@A@CBA@A@A@A@@@@@@@A@@BA@AB@@@BA@@@@BA@@@AB@@A@@@@@A@AB@@@BA@@B@@A@AB@@A@@@A@A@@@A@ABA@@@AB@@A@@@A@@@@@AB@@A@A@@@A@@@ABA@ABA@A@CB@BAB@@@@A@@@@@@@A@@@A@@@A@@@@AAAAC@@@@@A@@@@@@A@@@A@E@A@@@A@@@@@A@@@AB@@A@@@A@A@@@@A@@@@@@@A@@AAA@@@A@@@@@@@@ABA@@@@@@@@@@@AAAA@@AA@@@@@BABC@@@A@AAA@@AACCA@@A@AA@@@@AA@@@@@A@@@@@AA@@AA@@@C@@@A@@A@AA@@A@@@@C@@AA@@AA@@@@A@@@A@A@AB@@AA@@A@@A@@@@A@@@@@A@CBABA@ABA@A@@@AB@@CD@@ABA@@BA@C@A@A@@@A@A@C@AAA@@@A@A@A@A@A@ABA@@@A@@@AA@@AA@BABAB@@@@@BB@@@B@@@@@@@BB@@A@@@@B@@A@@B@@B@@BBBBB@B@@@@@B@@@B@@@@BB@@@@@@@@A@A@@@A@@B@@AB@D@@@@@BA@ABA@@B@@@@@@A@A@@BCB@@@B@@@@@@@B@@@B@@AB@@A@@@@B@B@@@@@B@@A@A@@BA@@B@@@B@@@@@B@@@@A@A@A@AB@@A@AB@@@@A@@@A@A@@@A@@@A@@@A@@@A@AA@@@@@@@@@@A@A@A@A@@@@@A@AACAA@C@A@A@@@A@@@A@@AA@@AA@@@@@A@@@A@A@ABA@@@@@@A@@@@@@@AA@@@CAA@A@@@AAA@@@@@C@AAA@AA@@A@@@@@@A@@@@ABA@@@@@E@A@ABA@A@@@A@@@A@@AA@@A@@A@@@@@A@@@A@@BA@@@AAA@@@A@AAA@@@A@A@A@A@A@@A@@@A@A@@AC@A@@@@@@@@@@A@@@A@A@@BA@@BA@A@A@@@@@AB@@A@C@@@@@A@@@@@A@A@C@C@@@C@A@A@AB@@@BA@@BAB@@AB@@AB@@@BA@A@C@A@@@@@A@AA@@@@A@@@@@@@A@A@A@@BA@AAC@@@@BA@@@@@@BA@@@@@@@A@@@A@A@CA@@AAA@AA@@A@@@AA@@@@@A@@AC@A@@@@AAA@CAA@A@A@@@CAA@@@A@@@AB@@@@A@A@ABA@@@@@@@@B@@@@@@A@@@A@@@A@A@CACAA@AA@@@@@@AB@@@@A@@@A@@@AB@@A@@@A@@@AA@@@@AB@@A@AB@@A@A@AB@@AB@@ABC@@@@@A@A@AAA@@@A@A@@@@@AB@@@@@@AB@@AB@@@@@@A@@@A@@@AB@@ABA@A@@@@@A@@B@@@@@@@BA@@@@@@B@@@@@BA@@@@BA@@BA@@@@@AB@@A@A@ABA@@@@@@@AA@@AA@@A@@@@@A@@@A@@B@BA@@@AB@@A@C@E@@@A@@@A@ABA@@@@B@@@@@@@B@@@BB@@B@B@@@B@@@@@@AB@@ABA@@@ABA@A@A@A@A@@@@@@B@@@@A@@@@BA@@@A@AAA@@@A@@B@@@@@@@BABAD@@@B@@A@@@@B@B@@B@@B@@@@@@@@BB@@@@@B@@@B@@@@B@@@@@@@@BB@@@BB@B@BB@@B@BAB@@@BB@@@BAB@@@B@@@B@B@@@B@@@@@BBBB@@@B@@@@@B@@BB@@BB@@@@@@BB@@BBB@B@@@B@@@@@@@@@BB@@@@@@@@B@B@@@@BB@@@@@@@@@@B@@@B@@@@B@@BB@@@BB@BABB@@@@@@@@@@@@@@@@AB@@@@@B@B@B@B@B@B@@@@B@@B@@@BB@@@@B@@@BB@@@@B@BB@@B@B@@@@@B@@A@@@@@A@@@@B@@@B@@@B@B@@@B@BAB@DAD@BAD@BAB@@@@@@@BB@B@@BB@@@B@@@B@@@@@@@@BB@B@B@B@@@@B@B@B@@BB@@@B@@@@B@@@@@BB@@@@@B@@@B@@@B@@@BB@@@@@@B@@@@@@@@@@B@@@BA@@B@B@@@B@@@B@B@@BB@B@B@B@@@B@@@BA@@B@@@BA@@B@@@@@B@@@@AB@@@BA@@@B@@B@@@@@BA@@@@B@@@BA@@@@@@@@BA@@B@@@B@@@@@B@@@@@B@@@@@BA@@BA@@@@BA@@B@@@B@@BBB@@B@@@@@@@@@@@@ABA@@@AB@@ABA@@B@@@@@@@BB@@@@B@@BB@@@B@B@BB@@B@@@@@B@@@BB@@@@@@@@@@B@@B@@@B@@BB@@@@@@BB@@B@@BB@B@@@@@B@B@@@@@B@@@B@@@BB@@@BBB@B@@@B@@@BBB@@@B@@@@B@@@D@@@@BB@@@BBB@@@@BD@B@@@@@BB@@B@@@@@B@B@@BB@BB@@@@BB@@@@D@B@B@B@BAB@B@B@B@BBB@B@@BBB@@BB@@@@@@BBBBB@B@B@@@BB@BB@@B@B@BA@@B@BA@@B@B@@@B@@@@BB@@B@@@B@@B@@@@B@@B@@@B@B@@AB@B@@@B@@@B@@@@@B@@A@@B@@@@A@@B@@@@@B@@@@@B@@@BBB@B@@BB@@@@@@@@B@@@@B@@B@@@@@@@BB@@BB@B@BB@@BBB@@BB@@@@@@@B@D@B@@@@BB@@@B@@@B@B@@AB@B@@AB@B@B@D@@@D@@@B@@BB@@@B@B@B@B@B@B@DAB@@@B@@@B@B@B@B@@@B@@AB@@ABADA@@BAB@@AB@BA@@B@@@D@F@B@D@B@@@@@@@@@AB@BA@@D@@@@@BBBBBBB@@@BB@@BB@BB@@BB@B@@@B@@@B@@@@@@B@@@@@@@B@BA@@B@@@@ABA@A@@@AB@BA@ADCBA@AB@@@@AB@B@@@B@@AB@B@@@@@B@BA@@B@B@@@DA@@B@@@@@@@BA@@@A@@BA@ABA@@@A@A@A@AB@@@@@B@B@BAB@@@B@BBB@BB@@B@@@D@@@@@B@@BB@BB@@B@BB@@BB@@@B@@@@BB@@@BB@@@B@@BB@B@@@BBB@BBBB@@@@B@B@B@B@B@B@DBB@B@@BD@BAB@B@@BB@B@@@B@D@BB@@B@@@B@@@@@@B@@@B@BB@BB@B@@@DBDA@@@@@BB@@B@BB@BBB@B@@@D@BB@@B@D@BB@@B@@B@@@B@@@BB@@@@@@AB@@AB@B@B@D@@@BA@@B@B@@@@AB@@ABABABA@@B@@@@@@@B@@@@@@@BB@BB@BB@@B@@@B@@@@@@B@BB@@B@B@@@B@B@DBB@@@B@FBB@@@D@B@B@B@B@B@B@BB@@BBDB@@B@@B@@@BB@B@B@BBB@D@@@BAB@B@B@B@BB@@B@BBB@B@BBB@B@DB@@B@B@B@B@BAB@@BB@BBD@B@D@@@@@B@B@B@@@FBB@@@B@@B@B@BD@@BB@FDB@@@BA@@B@@AB@B@B@BA@@B@@A@@@A@A@A@A@@@@@A@@@@@@@A@@AA@A@@BA@@@AB@BAB@BAB@B@B@@@B@@@@A@A@C@C@A@A@A@AB@@CB@@ABA@ABABA@ABA@@@A@@B@@@BA@@@@B@D@D@B@BAB@@@B@BAB@B@B@B@DB@@B@B@B@BA@@B@B@B@D@D@B@BAD@B@BAD@B@DAB@B@@@B@BBB@@@BB@@@@B@@@@@@@@A@@B@@AB@@@B@B@B@B@F@D@B@B@B@@@@@@@@A@@@C@@@@@ABA@ABABC@@@A@@@@@AB@@@@@@@@@@@BB@@B@@@BBB@D@B@BBB@B@DBB@B@B@B@@@B@B@B@B@B@@@@B@@B@@@@@@@@B@@B@@@@@B@B@BBB@DBBBB@BBB@B@@@DBD@@@BBB@@@@@B@@@@@B@@@B@BAB@B@B@@@@@B@@@BB@@BBB@BA@AB@@@@AB@@A@A@@BA@@B@@@BA@@B@@@BAB@@@@ABA@@@A@A@A@A@@@A@@@A@@@A@A@@BA@@@@@AB@@@B@B@B@B@@@BAB@@@B@B@@AB@BABABAB@B@@AD@@@@AB@@B@@@@B@@B@@B@@B@B@B@D@B@@AB@B@@@@@B@@@B@@B@BBB@@@B@@@BB@@@@@B@@@BB@@@B@@BB@B@@BB@@@D@B@@AB@B@@AB@BABAB@@@B@B@B@@BB@@AB@@@@@BADABA@AB@B@BA@@@@B@@@@@@@BA@@BEBA@A@A@ABA@@@@@@@AB@B@BA@@B@B@@@B@@@@@B@@B@@@BB@@@B@B@BBB@B@D@B@B@B@B@BBB@@@B@F@B@B@@@B@B@D@B@@@B@@@B@B@B@@BB@@@@BB@B@B@D@@@@@D@B@B@@@@@B@@@BB@@@@B@B@@@B@B@@@BBB@@@B@B@B@@@@@@@B@@@BB@@B@@@B@B@B@B@@@@@B@BB@@@@@@BA@@B@@@BAB@BAB@@@B@@A@@@@@@@A@@@@@AAAA@@AA@@@@A@@@AAA@@@A@@@ABA@A@@@@@A@@@A@@A@@@AAA@@@@@@AA@@@@AB@@@@@@@B@@@B@@@BAB@B@@AB@@@B@@A@@B@@@@A@@B@B@@@@@@@@A@A@@@A@A@@@@AA@@A@AAA@@@A@AAA@@@A@AAC@@AA@A@A@@AA@@@AA@@A@@@@A@@A@@AA@A@@@A@A@AA@@AA@@@@A@@AA@@@@AAA@@@@@A@@@A@@@A@@@@@@@A@@@@@@@@B@@AB@BA@AB@@A@AB@@A@@@@@AB@@ABA@@@@B@BA@@@ABABA@A@@BA@@@A@ABA@@B@@A@@@@@ABA@@BA@@BA@@@ABA@@@@@A@@@A@@@@B@@A@AA@@A@@@AAA@@AA@@AAA@AA@@AAA@A@AAA@@@A@A@A@A@@@A@A@A@A@A@@@A@@@AAC@@@A@A@AAA@A@@@AAA@A@AA@@AA@CACAABAA@@A@@@AAA@@@AA@@A@@AA@A@@@AA@@A@@@A@A@@BA@@@A@@@A@A@@@A@@@A@@BA@A@@@AB@@AB@@A@AB@@AB@@ABA@@BA@@BA@@BA@AB@@AB@BA@@BABAB@B@@AB@@@BA@@BA@@@A@AB@@AB@BAB@BA@@BA@A@@B@@A@AB@@A@@BABA@A@@@@@@@A@@A@@@@@@@@@@@@@@A@@@@@@@@@@@A@@A@@@@A@@@A@A@@AA@@@@@@@@@@@@@A@@@@@A@@@AB@@@@A@@@@@A@@@A@AB@@@AA@@@@@@A@A@@A@@@@@@@@@A@@AA@@@@@AA@AAAAA@@@@@@A@AA@@CA@@@@A@@@A@A@@B@@A@@B@@@B@@AB@@@@@@@@A@@@AA@@@@@@@@A@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@A@@@@B@@@@@@@@A@@@@@@@A@A@A@@@@@@BA@@@A@@DAB@BAB@D@B@@@D@FAB@@@DA@A@@BABC@@BC@@BADAFAFAB@@AB@@A@@@@BAFAB@BAB@JEHABAB@@@BAFCBADCDADCFCBABAB@BA@@B@B@@A@@B@DADAFCFAHABAFAH@F@H@F@D@B@D@@@B@@@B@B@B@BA@@D@@@B@B@@@B@B@@BB@B@@BB@@AB@@@B@@@@BB@@@D@@A@@@A@@@@BA@B@@B@@BB@@@@@BA@@@@@@@@BAB@@@BA@@B@@@B@B@@@@@D@JCBALADAH@B@D@F@F@F@D@BAD@H@B@F@B@BBF@D@D@D@B@B@B@B@D@B@D@B@DAD@B@B@@@D@@@B@@AB@B@D@BAB@B@BA@@@@B@@@@@B@@@@@B@@A@@@@B@@@@BA@@B@@@@@@BAB@@@@@@@@@B@@@B@@A@@B@@BB@B@@@B@B@@@@@@A@@B@@@@@@@B@@@@@@@B@@@D@B@B@@@DA@@@@@@@@@AB@BA@ABAB@BA@AB@@@@@AA@A@@@AB@@AB@@@@@@@@@B@B@@@BA@@@@@@BA@@@@@A@@@@B@@A@@@@@@B@@@@@B@@@@@B@@A@@@@B@@@@A@@@@@A@@A@A@@A@@@@@E@A@A@A@@@@@@@@BA@@B@@A@@@A@@@@A@@A@@A@@@@@@@BABA@ABAB@@ADAFCFCBABADCBADADC@@B@@ABBB@BAD@FBB@B@B@@A@A@ADABABABA@C@CBAD@D@DBDB@BD@D@F@BBBABA@@BAB@B@@@@A@A@@@A@@@A@@B@@A@@@A@@@A@A@@@A@@A@@AA@@AA@@AA@AAA@@AA@AA@@AA@A@@@A@@@A@@@ABA@@@AB@@ABABBDBDBD@DBB@@@BA@@@AB@@A@@@ABA@@B@BAB@B@@BB@B@B@B@@@B@B@B@B@@AA@A@A@A@@@A@AAA@@AAAA@A@A@A@@@A@@A@A@@@AA@@@C@@A@A@A@@A@C@A@ABC@AA@ABAB@B@@AB@@A@@FADAD@BAD@D@BABABABAB@@ABA@C@ABAB@BABABAB@BA@@BA@@BAB@@A@@@AB@BA@@BA@@BA@ABAB@@@BAD@BAB@B@BAB@B@B@B@B@B@B@@@BA@A@ABA@@@AB@@@B@B@B@@AB@@@B@@AB@@A@ABA@@@AB@BABAB@@@DAB@@EAC@C@@AA@AA@@AB@@@BAB@BAB@@ABABAD@BA@ALH@dFD@LB@B@TBRB^lFHzXGrZ\\RTJDBHD@@FD@@GdEVCJAH~TNSBAsUXeJQTAH@|HHBdF`DzJ¡T[trLSPLABEcP_Vk[SODGBUFMNA@ECKEA@ABABEDCBA@A@IBC@A@EBCBA@A@C@KAC@C@C@C@QHABABCD@B@@@BBD@B@@@BEFAB@B@H@D@B@BCFAB@B@F@D@BCHAB@B@H@B@@ADAB@@@D@@@BADEF@B@BA@ABC@C@@@AB@@A@C@ABA@A@A@AA@@AA@@AAEBA@A@A@EBA@@@C@CBCAC@A@A@AAC@ECCCDA@AB@CAA@AACACCEAAAAACAA@CAAC@@CAACA@G@C@CAA@CACAEA@A@@CAACC@C@A@A@BCBABA@A@@BCDCCC@@AABEBA@A@@@CEAC@GAA@@AACAAAAAAA@AEAAAACAA@AC@@AA@AAC@A@A@AAACCECCAAACCAAAEAAA@AC@CAAAA@ACCCAACAE@CAA@AAA@@@@AA@A@C@@@C@A@ABA@A@AB@@ABABCBADCB@BCAAAA@ABABC@AC@E@C@C@A@A@A@A@CB@@A@C@A@A@CBABC@E@C@G@AA@BCBABABABCB@BA@CBA@CBABA@A@ABA@ABCBCBA@ABCBA@@BC@ABA@CDA@ABC@ABCACAA@AAA@AA@AAA@AA@@ACAA@BCCACAAA@CAAAA@AC@C@C@A@A@A@@@A@@@A@C@A@C@A@@@A@A@@@CA@@AA@@@AAA@A@AA@@AA@AA@A@AAA@@AA@AAA@AA@@A@A@A@@@A@A@@AA@A@@@AAAAA@@A@AA@@@AA@AA@A@A@AA@@A@A@@@A@@@AB@@A@@BA@@AACA@AA@AAA@@@AA@@A@A@A@@ABA@A@@AE@@@A@@@@@AA@AA@AAAAA@@@@@AB@@AA@AA@@C@@A@@@@@ABAB@BA@@@A@@@AB@@@B@BAD@B@@@BAB@@@B@@AB@B@DA@@BAB@B@B@B@BAB@D@@BB@D@B@B@B@@@B@BBD@D@@@BA@@@A@@@A@@@A@A@@@A@@@AA@@A@A@@@@BA@@@C@@@A@@@ABABA@@BA@@B@BAB@B@@AB@@AAAAA@@@A@A@A@@CCCCAAAAACACAA@@CAAACAAAC@ACAAECCAAAAAAAA@@A@@AA@A@@@AA@AAA@AAAAA@AA@AA@A@A@@AA@A@@@A@AA@@A@AAA@CAA@AAA@AAA@A@A@@AA@A@C@AA@@AA@A@AAA@@@A@A@A@AB@A@@A@@@A@@@@@@@A@@AA@@A@A@AAA@@AA@@AA@A@@AA@@@A@@A@AA@A@A@A@@@A@A@@@CA@@CA@A@@@AA@AACAA@@AA@AAA@@AA@@AA@A@A@A@AAA@CA@@AAA@@@C@@@AAA@AA@@C@AAA@CA@@@AA@@AA@AA@AA@AA@AA@AAAAA@A@ABA@C@A@ABA@AA@@AA@AAA@A@A@A@A@@@AA@BA@A@@C@@@AAA@A@A@A@A@BA@A@@@A@@@@@A@AC@A@@@A@@AAAA@@AAAAAA@@@A@@@A@A@AB@C@@@AAA@@@A@@@AA@AAA@@@CAA@AAA@A@A@AAA@@AAAAAAA@@A@A@@@A@A@ABA@@@ABA@@@@@AB@@AA@@@A@@AA@@A@@AA@@AA@@@A@A@A@@@AA@@@A@@@@AA@@A@AA@@A@A@@@@@AAA@@@A@CAAA@@A@AAA@CA@@@@@A@@AA@@A@AA@@A@AA@@A@A@AAA@A@A@@AA@@@@@@AA@BA@A@A@@@@@AB@BA@@@@@A@A@A@@@AB@B@@@B@@@@A@@@A@@@@BA@@@@BBB@@@B@@@BAD@B@@@B@@AB@@@BA@@@@BA@@@A@@@A@@@AAA@@@A@A@@A@@AAA@A@@AAA@AA@@CA@@A@@@A@A@A@A@A@@@AAA@AAA@A@A@A@A@A@A@A@A@A@AAA@@@AAA@AA@@A@@@A@A@A@A@A@@B@@AB@BA@@BA@@BA@ABA@@@A@@@ABA@A@AAA@@@A@A@@@A@A@A@ABA@@@CB@@A@A@A@A@AAA@@@A@AAA@A@@BA@@B@B@B@@@@AB@BA@@BAB@B@@@B@@GBA@ABA@A@C@A@A@ABA@A@@B@BA@@@ABA@A@AB@@C@A@A@A@@@C@@AA@A@C@ABCAA@A@A@A@@@C@AAA@AAAAA@A@@@AAA@@@A@ACA@@A@@C@ABA@ABA@A@AB@BA@A@@BABA@ABA@@@ABA@A@@@ABA@@B@@@@AB@@@@AB@@A@@B@@@@A@@@@@AA@@A@A@B@@AAA@A@@@A@AA@@A@AA@@@AA@@A@@@A@@@A@ABCAAA@@AA@AA@@AA@AA@@AA@@AA@A@@@A@@A@AAA@AACA@@C@@@C@AAA@AAA@AAAAA@@@A@A@A@C@C@A@A@@A@C@AAA@A@C@A@@BA@@@A@@@@@A@@@C@A@@@AAA@@@@@AAA@A@@@@AAA@@@AAAAACA@@AAAAA@@A@AAA@@@A@A@@AAAA@@A@CAA@AA@@AA@@A@@@AA@@@@@A@CAA@@@AAC@AAA@AAA@AAA@AACAAAA@AAABABAB@@ABABA@ABA@@B@@ABA@@@ABA@@B@BC@A@A@C@A@A@A@A@@AA@@@@AAA@@AC@@@A@AA@@A@@AAA@@@A@AA@@C@@@AAA@A@A@A@A@A@@@A@A@@@A@A@A@CBA@AA@@A@@AAA@@A@@AA@A@@@C@@@C@@@A@A@A@@@AB@@@BA@BBAA@@A@A@@@@@@A@@@AAAAAA@@AA@A@@BA@@B@B@BAB@@@BBB@B@B@@@@AB@@A@ABA@@B@@@BB@BB@B@@@@@@AB@@AB@@@@BB@@@B@@@BA@@BA@@@A@@BA@@@A@@@A@@BA@@@A@A@@@A@@@AAA@@@A@@@@B@@@B@@AB@@A@@B@BA@@BA@@@AB@AA@@A@AA@@AA@@AA@@@@A@@@@A@A@@@A@ABA@@AA@A@A@A@A@@@AB@@@B@@@@A@@@A@@@@@A@@@@BAB@BA@@BABAAA@AB@B@BA@@B@B@@AB@@AB@AA@@@CA@@A@A@@@A@AB@@A@A@@AA@CAAA@@A@CAABA@@AA@CAA@A@A@@A@AA@AA@AAAAAAC@AA@AAA@AAA@@BA@A@A@@BAB@@@BA@ABA@A@A@A@A@A@EAA@@BA@A@@BA@@@AAAAA@AA@@A@@AA@@@@A@@@A@@A@@@AA@@A@AA@@@AA@@@@BA@@AA@@@@AA@@@@A@@AA@@@@A@AA@@@@A@A@BA@A@@@A@AAA@@@@@AA@@AB@@@@A@@B@@@B@@@BAB@@@@AB@@A@@@AB@@A@AB@@A@@BA@A@@@A@A@@@AA@B@B@@@@AB@@@@AB@@@@A@A@A@@B@@A@@BA@@@A@@@AA@@A@@@@AA@@AA@@AA@A@@@AA@A@ABA@A@A@A@A@A@@AA@A@@@A@@@A@A@A@@@@AAA@@AA@@@A@@@AAA@A@@@AAA@@@@AAA@@@A@AA@@A@A@A@A@A@C@A@A@AA@AAAAAAAAA@@AA@@ABA@@@ABAAA@A@A@@A@@AA@@@AA@A@A@A@A@A@A@A@A@A@AB@@A@@B@@@B@@ABAB@@@BAB@@@BA@A@A@A@A@A@A@@BA@C@@@@@@A@@@AA@@A@A@A@A@@@A@A@@A@AAAA@@A@AAA@A@@@A@AAA@CAA@ABA@A@A@AA@@A@A@AAA@AA@@C@@@@@@BABA@@BA@@@A@A@ABA@@@A@A@AAA@@AA@A@A@E@@@AC@AA@AAAAAA@@@AAAAAAA@AA@A@AA@@A@ABA@A@@@A@@@@@AA@@@AA@@@A@@@A@@BABCHAD@BAB@@@@A@@B@@A@ABA@@BA@@@A@@@@@AB@@A@C@ADADAD@B@HAD@@C@E@CBE@CBKFGD@@@BCDBB@BBBAB@B@DCFABADABBFA@AB@BBPADAF@DBBBFBF@@B@@B@@@B@@@B@@@BA@ABAB@@@@A@@@A@AB@@A@@@A@@B@@@@AB@BAB@@@@@BA@@@A@@BA@A@@BA@@@ABA@@@ABABCBADA@ABABABCB@@A@@@ABAB@@A@ABA@ABA@@@AB@@A@@@@@A@ABA@ABABA@@@CDABCBA@CB@BA@ABAB@F@B@@@BCB@B@BCB@BAD@@@BBB@@RBB@DBDDB@B@B@F@D@BBBB@BB@BBD@@@@@B@B@B@B@@@@@BAAAA@@@@AA@@@@A@@@A@@@@@@@A@@@@@@@@@A@@@@B@B@@A@@@@BADEBCBAB@BAB@B@F@BAB@@@@ALCB@F@B@BBHDBBFBB@@BB@D@D@@@B@B@D@B@B@B@B@BBB@FBB@@@BB@B@@BBD@BBDBFBB@B@B@BAD@B@B@D@B@B@DA@@B@BAD@B@@@BBB@BB@@BB@@BB@@BB@@BB@B@@AD@B@@@BBB@BBB@@B@BBBB@@B@@B@BB@@@@B@@@@@@A@@BBBB@B@DBD@@BB@@@B@@BB@@@BB@@BBB@BBBDB@@B@BBB@@BBB@BBB@BBBBB@B@@BB@BBDBDBBBB@DB@BB@@B@@BB@B@@B@@BB@B@@BB@BB@@B@BDB@@BB@@@BB@@B@B@@BB@@@@BAB@@@@BB@@@@@B@@@BBB@@@B@@@@@B@@A@@@@@@B@@@@@@@B@@@@@@AB@@AB@@@@A@@BA@@@@@@@@B@@A@@@@B@@@@@@@B@@@@@B@@@@@@BB@@@@@@@@@@A@@B@@@@@@@BA@@@@@@@ADA@ABABA@ABABABA@A@@@ABA@ABCB@BABAHAB@B@B@@@D@D@B@BAD@BB@BBDBBBDBDD@@AB@B@@@@@BBB@@@B@@@@@@@BA@@@@B@@AB@@@@@@@B@@@@@@@@@B@@@@@B@@@@A@@B@@@@AB@@@@@B@@@@@B@@@@@B@@@@BB@@@B@@BB@@@@@@B@@@B@@@BB@@@@B@@@B@@@BB@@@@B@@B@@B@@@@B@@@@B@@B@@@@B@@B@@@@@@B@@B@@@@@@BB@@@@BB@@@BB@@@@@@B@@@@@B@@@@@@@BB@@@@@@@BB@@@@BB@@@@@B@@BB@@@@@B@@BB@@@@@@@B@@@@@B@@@B@@@@@@@@@B@@@@BB@@@@@BB@@@B@@BB@@@@@@@@BB@@@BB@@@@@BB@@@@B@@@B@@@@@B@@B@@B@@@@@B@@@@B@@@BBB@@BB@BBBBB@@BB@@@@@BB@@@@@@@@BBB@@B@@BB@@B@@B@@B@@BB@@@@@B@B@B@@BB@@@@@B@@@@BB@@@@BB@BB@@@@@@@BBB@BB@@B@@@@BB@@@B@@A@@@@@@@@@A@@BA@@@@@@B@@@@@B@@@@@@BB@@@@DB@@BB@B@@@@@B@@B@@@@B@@@@@B@@@BAB@B@@@BA@A@@@A@A@AAA@@@A@A@@@A@A@@@A@A@@@A@AAA@@@A@A@A@ABA@AB@@AB@@@B@@A@@@AB@@CAAB@@@@@BAB@@@B@@ABA@ABA@ABA@A@AB@@A@@@@B@@@BBB@@@F@B@@BB@@DBFBDBDDB@@@BBFB@@BBDDBB@@@@B@BB@@BBBB@@BBDBB@BBDDB@@BD@BB@@BB@BBD@@B@@@@@BAB@B@BA@@BAB@BAB@B@@@B@BBB@BB@@BB@@BBB@BA@@B@B@B@BAB@@@DA@@B@B@DBB@BB@@@BA@@BA@@B@@@B@@AB@@@BA@@B@@@B@B@@@B@@BB@B@@@B@@@B@@A@A@@@A@@@@@ABA@@@@@@@A@@AA@@@@AA@@@A@A@@@A@A@@@AA@BCJ@BA@@BABAB@B@@AB@@ABC@@@AB@@@@A@AB@@A@@@@@A@@BA@@@@BA@@@@B@@@B@@AB@B@@@BA@@@@@A@@@@BA@@@@@A@@@@@@@@BA@@@@BA@@BAB@@@@CB@@@B@@@@@B@@@B@@@B@@@@@B@BADAFAF@B@B@B@@@@@BAB@@@B@@@BABCBABAD@B@@B@BBB@DBB@B@BBB@B@@BBD@@@B@B@B@@@BBB@@@B@@@@@B@@@B@@@B@@@@A@@B@@@@BD@@BBNBAF@@@@A@A@@@A@AB@@@@@@@@A@BB@BA@@B@@@BBB@@@@B@BBB@B@B@@@@AB@@ABBD@DB@@AB@@@@AB@@@BA@@B@@@B@@ABBBA@@B@@BBBB@BBBB@@BB@@@B@BA@@B@B@B@B@B@B@@@@@B@BBB@@@D@B@DA@@@@B@@@@BBBBD@B@BB@FAFAFADAF@BAD@FBFFFDBDDFDD@DGBCBC@@@A@AAA@@@AAA@AACAAAC@CAEAEAAAGC@@@D@BABABAB@BA@@BB@@@@B@B@B@B@B@@@B@@AB@@A@ABA@@@AB@B@@@@@B@@@B@@@@BB@@BB@@@@B@BBB@@@DA@@B@B@@BB@@@@@@B@@@@B@@B@@@@@BB@@B@@@@@B@@@B@@@BA@CB@@A@@BA@@@@BB@@BBB@BB@@BBB@@@B@BAB@B@@@B@BAB@@@B@B@@@B@BAB@B@@@BAB@@@B@@@B@BAB@@B@@B@@AB@@@B@@@@@@A@@B@@@BAB@@@@@@@B@@B@AB@@@B@@@B@@ABABABAB@@AB@@@@@@@BBBBB@@@@@@@@@@@@@B@@@@@B@@BB@B@BBB@@@@@B@@@@A@@@A@C@@@A@@@ABC@A@@BA@@@A@ABA@AB@@AB@@A@@@@@@@@B@@@@@@@B@B@@B@@B@@@B@@BBB@@BB@@BB@@@@@@@B@BBB@@@BB@@@@@@B@@@B@@BB@@@@@@B@@@B@@@@@B@@@B@@@B@B@@AB@@@B@@@B@BA@@@@@@BA@@@@@@@@B@@@@@BB@@@@B@@@@A@@@A@A@A@@BA@@@@@@B@@@@@BA@A@@@A@@@@B@@@@A@AAA@@AA@@@@@A@@B@@@@AB@@@B@@@BBB@@@@@@A@@@C@@@@@A@A@@@@@A@@@@B@@@B@BAB@B@@@BA@@@@BA@@@@BAA@@@@AA@@AA@@A@A@@AA@A@@A@@"],encodeOffsets:[[134206,46539]]}},{type:"Feature",id:"230381",properties:{name:"虎林市",cp:[132.93721,45.762685],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@AAAA@A@AA@@@A@AB@BABA@A@@AA@ABAB@BA@@BA@A@@BCBAB@FCBABA@AAA@AA@A@A@@AA@@@A@@@ABA@A@A@EAAACAAA@ABABADABA@AAAAAA@ABABABCBC@CBCB@B@BABA@ABA@A@@A@BAB@B@@@@AFAF@B@DAF@BAD@FAB@@@@@@@@@@A@@@@@@B@@@B@@@@@@@@A@@@@@@@@@@A@@@@A@@@@@@@@@A@@@@@@B@@A@@@@@@@@@A@@@@@@B@@A@@@@@@@A@@@@@@@A@@AA@@@@BA@@A@@@@@@@@BA@@@@A@@@@@@@@@A@@@A@@@@@A@@@@@@@A@@B@@@@B@@@@@@@@BB@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@@@AB@@@@@@@@@@A@@@@B@@@@A@@B@@@@@@A@@@A@A@@@A@@AA@@@@@@@@@A@@@A@@A@@A@A@@@@@A@@@A@@@@BA@@@@@A@@@A@@@@@A@@@@@@@@B@@@@@@@@A@@@@@A@@@A@@@@@@@A@ADA@ABABCBED@BCBBD@D@F@B@B@BBBA@@@@B@@AB@@@@@@@B@@@B@@B@@B@B@@@@@B@@@@@@@@ABADCBABCDADCDCBCDA@@B@@@BAB@DAD@BAD@BA@@BAB@BABA@@B@B@@AD@B@B@B@@AB@@ABABABABA@A@AB@@A@ABABA@AB@@@BA@@B@B@@@B@@@B@BA@@B@@@B@BAB@@@@BB@@B@@@B@B@@@@@@B@@B@@B@B@B@@ABA@@B@@@BA@@@ABABAB@B@@@B@B@@@@@B@@B@@@B@@@@@@@@B@@@@@@B@@B@@B@@@BBB@B@@BB@@@B@@@BB@@BB@@BB@@B@@BB@@@@@@@B@BB@@@@@@@@@@B@@B@@@@@@@BA@@BA@@B@@@B@B@@@B@@@BB@BB@@@B@@@B@@A@@B@@A@@@@B@@A@@@@B@B@@@B@D@@@B@@B@@B@@B@@BDD@@@@BBB@@BB@@B@BB@@B@B@BB@@B@BBB@@@BBD@@@BB@@B@B@D@B@@@@@@@@@@@@@@@@@BB@D@D@D@D@@@B@BB@@@@@B@@B@@@BB@@B@B@B@B@@@B@B@B@@BB@BBBBD@@@B@@@B@@@@@BAB@BB@@B@@@B@B@B@B@@@@@@@BBB@@@@BBBA@@B@@@@@@ADB@@B@@@B@B@@BBB@@B@@@BB@@@BBB@BBB@@@@@@B@BBB@B@@@B@@@BA@@D@@AB@B@@@B@B@B@@BBB@@@BB@@B@@@B@B@@@B@B@BAB@@@B@B@B@D@B@B@@@B@B@@A@@B@BABA@@@@@@B@@@B@BABAD@@@BAB@B@@@BBB@@@B@B@@@B@B@B@@@@@B@B@@@@@DBB@B@@@B@@@@B@@AB@BA@@B@B@B@@@B@@@@BB@BBB@@@@B@BBB@@B@@@B@@@@@B@B@@@@@BB@BBDBB@FB@@B@@BBB@BBB@B@@A@@B@@@@AD@B@@@B@B@D@B@@BB@BBB@@B@BBB@@@@B@D@@@@@@@DAD@D@@@@@@BBBA@@B@B@@@B@@@@@@@@@B@@@AB@@AD@@@B@@@@@@@@BBBBB@@BBB@@@B@@@B@@@@@@AB@B@@@BAB@B@@CB@@AB@@ABA@A@CBA@@@AB@@@B@@@@@BAB@B@@A@@@ABA@A@@B@B@@@@@@@B@@ABA@@@A@A@AB@@A@@@@@@@@B@@@B@@@BDD@@BB@@@@@@@BBBBB@BDB@@@BBBBB@@BBB@B@B@@@FBBB@@B@@@BDDD@@@BB@@@@@A@@@@@CBA@@@@@AB@@@@@B@@@@@B@B@@@B@B@B@BABABEBADAD@@@@@BA@@BABABA@AB@@@@A@AB@@A@@@@B@@@@@BB@@@D@B@F@B@@@B@BBFFB@B@@@DBB@BB@@B@B@BBB@@@@BB@@BB@@D@B@@@@@B@@@@@B@@@@@B@@@B@@@BB@@@B@B@B@@@B@B@@@BBB@B@B@B@@@DAB@@@B@B@@@BBBBBB@BBD@@BBBDBB@@BB@@@B@@A@@@@@@BAB@B@@@@CBAD@@A@@B@@@@@@@@@B@@@@@@@B@@@B@@@B@@@B@BB@B@BBB@BAB@B@B@@@B@BAB@BAB@B@@AB@@@@@FBB@B@B@BBB@BBD@D@D@B@D@B@BBB@B@BBB@B@@@BAB@@@B@@A@@D@B@F@B@@@B@B@@AB@B@B@@@@@B@@A@@B@B@B@BADADAB@B@B@B@BBF@D@B@B@B@B@BBB@B@BBBAD@BADA@@@AB@BABA@@B@BABAFADAF@BABA@@BA@@BAB@@ABA@AB@@A@@B@DAD@BAB@@A@@BA@@@A@@@@@AB@@@@@BAB@@@B@@@B@B@@@B@@@BB@@B@@AB@B@@@@@@@BA@@@ABA@A@@BADABABAB@@AB@B@BA@@B@@A@@@A@@B@BADA@@@AB@@AB@B@@@D@@@@@B@@@B@@@B@@@@@DBB@DB@@B@@@@@@@B@DA@@B@@@B@BBB@@@B@D@@@B@@@BB@@@@@BBB@@@@B@@@D@BAD@B@DBB@B@@@BB@@B@@BDBBBB@D@BBB@@@BB@B@B@@@@B@@@B@B@@B@@B@@@BB@@@@@@@@@B@@@@BB@@@@BBB@BBB@B@@@@BB@BBB@BBBDBBBBB@@@B@@BB@@B@B@@@@@@@@@B@@B@@@@@BB@@BBB@@B@B@@@B@@@B@@@@@B@@B@@BB@@B@B@@@@B@C\\G`XrRTT^NMKJA@D@B@@@@BB@@@BBBBB@D@B@BB@@B@@BBBB@@B@D@B@B@B@BBBB@B@F@B@B@B@@BD@@@@BBBB@@B@BBB@B@D@DBB@@@DBB@DBD@D@B@D@BBD@B@DBD@B@BBB@DBBBDBBBDBBBB@BDDBDDBBB@@BB@B@B@F@DAB@H@DAFA@@BC@G@A@AB@BCBADC@@B@BA@@@@B@@@D@B@@@D@D@B@B@BAB@BAD@BAB@@@@@@AB@@A@@@@BA@@B@DA@@B@BA@@@@BA@@@AB@@@B@BA@@@@BA@AB@DC@@@ABABABABA@@@AAA@@AAA@AA@AAA@@@@@@@@@A@@B@@@B@@@BA@@@A@@@@BAB@@A@@@@@A@A@@@A@@BA@@B@BA@@@@@@@@B@@@@@@@B@BBD@@B@@@@BBD@@@@@B@B@@@@@@ABCB@@@@AB@@@@@B@@BB@@@B@BA@@@@B@@AB@FA@@@@BAB@B@B@@@@@@@@@@@@B@@@@AB@B@B@B@BB@BBB@B@BBBD@BBB@@B@B@@@B@BBD@B@B@@@B@B@@@BA@@B@B@@AB@@@BA@A@@@A@@@@B@@@@AB@BAB@D@B@BBB@B@DBB@BA@@B@@@@@@C@A@@@@@@BA@@B@@@@@B@DADAB@BA@@@A@@@@BC@@B@BA@@@@B@@@@@@@@@BB@@@@@BB@@@@BB@DBDDÁ¬p¾`@B~@JZTZHLN@`DJCRTHX^ZdPcjZJJH^XHRfjRTpthjĺo@@@B@BA@@B@@A@@@@@A@@BA@@@@B@@@BA@@@@@@BA@AB@@@@@@@@@@@B@@A@ABA@AB@@@@@@@B@@@@@@@DBB@@@BBB@@@@A@@@AB@@@@@@@@@@@@@@@B@@@@BB@B@@BBBB@@@@BBB@BBBB@@@@@@@@@@@B@@@B@@@@B@@@@@B@@@D@BA@@B@BA@@@A@@B@@B@@B@B@DBBBB@B@BB@@BB@@@@B@BB@@@@BB@@@@@@@@B@B@B@B@D@BA@@@@B@@AB@@@@@@A@@@@@@B@@@@@@@@@BBB@B@BBD@DB@@B@@BD@B@BBD@@@BBB@B@DB@@@@@B@@@B@B@B@B@BBB@@@BB@@BB@@@F@BB@@DA@@@@@B@@@@@B@B@B@@@@@@@@@BB@F@B@BBB@@@B@BB@@B@BBB@@@BBD@@@DB@@@@BB@@B@B@BBB@@@@@@@@B@@@B@@@@@BB@BD@@@BB@BB@A@@@@D@B@F@B@B@@AB@@ABAB@BAB@@A@@BA@@BABAB@@AB@DAB@@AB@D@D@BADBD@@@B@@@DA@@B@@@@ABA@@@AB@@@@@BA@A@@B@BC@A@@@A@A@@B@@@@@@A@@@@BA@@@@@@BAB@@@B@@A@A@@B@BABA@A@A@A@@@@AA@A@@AAAA@A@@@@AA@@@@AA@@AAAAA@ACAA@@@AA@@@@@BA@@@A@@@@@ABA@@@ABA@@@@@@@@@@B@@@@@F@@@@@B@D@B@@@FA@@D@@@@AB@@@@@B@@@@@@@@@@@BAB@@A@@B@@@DAB@B@D@@@D@B@@@BA@@@@BAB@B@B@@@B@@AB@B@@@@@B@@@@@@BB@@@B@@@@@B@@@B@@AB@@@BB@@B@B@D@@@BBD@B@BBB@@@@@B@B@@@@@@@B@@@B@B@B@@@BA@@B@BA@@BA@@@@@@B@@@BA@@@@@ABA@A@@@@B@@A@@B@AA@A@@@A@@@@BA@@@@A@@A@@@@B@@@@@@AD@D@@@@AB@B@@@BAB@@@B@B@@@B@@@@B@@BBB@@DBB@@B@BBBB@@B@DBB@DDB@BB@@BBB@B@BBB@BB@@@B@@B@B@@BD@BB@@B@BBB@@@BB@@B@@A@@B@@@B@B@@@DBB@@@B@BB@@@@B@@@B@B@D@D@B@B@B@@@B@D@@@B@@@@@B@@@@@DB@BBB@@B@@ABA@ABA@@@A@ADA@A@@@AB@@AB@@A@@BABC@A@A@ABA@A@A@A@C@EAA@A@AAC@A@@@A@AB@BC@@BA@ABA@@@A@@@@@A@@B@B@@A@@@A@@@AB@@A@@@AB@BAB@B@B@D@@@F@@@B@BB@@@BB@BD@BDBBBBBDDDBBB@@BBB@BBD@@@BB@BB@BBB@B@BBDBB@BBB@DBB@B@B@D@B@FAB@@@B@B@@@B@B@B@B@B@BBHBF@D@@@BBB@@@D@DBBB@@D@B@@@B@@@@@B@@@@B@@BA@@@@B@@@B@DA@@B@@@@@@@@B@@B@B@@@B@@@BABBD@@A@@B@BAB@B@@@B@@@B@@@B@BB@AB@B@BAB@B@@@@AB@@@@@B@@@D@B@@@B@@@@@@@BAB@@@B@B@@@@@@B@@B@@@@@@AB@B@B@@@B@B@B@@@@@B@@BB@@@B@@AB@@@@@@@@@@@@BB@@A@@@A@@B@@@B@@@@@B@@@@AB@@@B@@@B@BBB@B@@@@@@@B@@B@@B@B@@@B@B@@@B@@B@@B@@@D@@@@B@@DBB@@@B@@@B@B@@@@BB@@@@@@@@@@B@@@@BA@@@B@@@@B@@@@@B@@BB@BBB@@@@@B@@@@@B@@@B@@@B@@@B@FBBB@@BB@BB@@@B@@BBA@@B@@@@AB@BABB@@BAB@@@@@@@B@@A@@@@BA@@@A@@@A@@B@@A@@BA@@B@B@@@@@@@B@@BB@@@@@B@@@@@B@@BBA@@@A@@@@@A@@B@@@@AB@@@@AB@@@@@@@@@B@B@@@@@@@@@@A@@@@BB@AB@@@@B@@B@@@@@@A@@@@B@@@@BB@@@@@@@@@@@BA@@B@@@AAB@@@@@BB@@@@B@@AB@@@@@@@@@B@@@@@B@@@@@BA@@@@@@@@A@@A@@@@B@@@@A@@@@@@B@@A@B@@B@@@B@@@@@BB@@@@B@@@@@B@@@@@BB@@@@@@BA@B@@@@@@DA@@@B@@B@BAB@@@A@@A@@B@DAB@A@@AB@@BBAA@B@AA@@@@@A@AB@B@@@@@@A@@AB@@@@@A@@B@@A@@@@D@@@@@@AB@B@@@@@@AB@@@@@B@@@@@B@@A@@@@@A@@@@B@@B@@BABBB@@@@AB@@@@A@@BB@@@@B@@@@@@@B@@ABA@@B@@@BB@@@@@@@@@@BB@@@@A@@BB@B@B@DBB@@B@@@@A@A@@@A@@@@@A@@BB@@@@B@@@@@BB@AB@@B@@@@@@B@@@@@@B@B@@@@@BB@@@BB@@@B@@B@@B@@@@@@@@BF@@@HAB@@@B@@@@AA@@BA@@AAB@@@A@@@@@@FA@@@@B@@@@B@@@@@@B@BBBA@@@B@@B@@@@@B@@@@@@@@A@@B@@@@@@@@@@BB@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@FB@@@@B@@@@A@@@@@@B@@@@@@@@@@B@@@@@@@@B@@@@@@@@@B@@B@@@@F@D@@@BA@@@@@@@@B@@@@@@@B@@@ABB@@@@@@@B@@@@@B@@@@@@@@@BA@@B@@@@@@@@@@@@B@@A@B@@@@@B@@@B@@@@@@@B@@@@@@@@@@A@@B@@@@@@@@B@@@@@@B@@@@@BA@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@BA@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@B@@@@B@@A@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@A@@@@@BA@@@BB@@@B@@@@A@BB@@@@@@@@@B@@@@@@B@@@@@@B@@@@@BAB@B@@@@@@@@@@A@@@@B@B@@A@@@@B@BA@@BA@AB@@A@@BA@@B@@@B@@@@@@@@@BA@@@@@@@@@@BB@@@@B@@@@@B@@@@@@@@@@A@@@@@@@@@@B@@@B@@@@@@@B@BBB@B@BBB@B@D@@BB@B@BAB@@@BA@@B@@AB@@A@@@A@@@@@AB@@@B@@@@@@@@@AA@@@@@@@@BAB@@@B@@@@@@B@@@@@@@@BA@A@@@@@@@@B@@@B@@@@@@A@@@@@@A@@@@@@@BA@@@@BB@@B@@AB@@@BBB@@@@@@@@B@@A@A@@@@@@@@B@@@@B@@@BB@@@BB@@@@@@B@@@AB@@A@@@@@@B@@@B@@BB@@AB@@@@@A@@A@@@@@@@AB@@@B@@@BB@@B@@@B@B@B@@@DA@@AB@B@@@BB@B@@@BAB@B@B@@@BB@B@BB@DB@GBEDIBCFKASACYYIKCE@SBKDSDAFAJAP@PDPBL@XGHCbYPMN[BETMBC@GCKMOSIYKeIY@UEEECIAICK@GBCRIZENEHBNDPHF@FABA@@@CEGMSKQCI@GBEDEFINOBE@KCMGKGIIEQCI@oBG@CAACBCBAZKHE@A@AACECQCGGEE@ICIEGKGGAI@IFCD@JCTEFOJKBGAKGIMAQEYCGIKMIWKMG£QQAKEMGCECKCGBIDKHIVeHGbUhgBI@KKaQUicYMW]IWAMBY@EDCBE@ACCCCGE@C@IACAAECC@EBSJIDE@OAcEOAC@GGIGCEAGBCBCDCFALKDC@GBGACAEECMCMIECEG@ABGDI@CCCEEIAG@MDMJIHILAHIHEDKDOBIAWIKEECCOAG@GBI@ACAMDGDG@CCIS@C@CLMDGACAACCE@E@IBK@E@EEAEBEJO@GCECM@GBIDEFED@h@JCFEBEAKAEEAGAC@WPC@EAOG@ABCBE@E@CGIAC@C@CBAdMBCBC@EACCAGAKBC@MCQGEACCIMEEACAEAGIKIGCC@A@CBCD@J@DBHPDDD@J@FCHMFEF@HATBHADA@CAO@CDCJEBEAQEGEAKAK@MBKAICGECEAE@CDEDCVIPGJIBCAG@AEAEAG@CBGDKLKFEBUACAACACAQACC@A@EBEFEBE@AAAABAJG@A@ECCEG@G@EFENO@A@EAE@AAAAACAEAC@CA@AACA@@@A@@@@B@@@BBD@@@BA@C@C@A@C@EAA@@BA@@@DD@B@@@BIDABA@EAA@@A@@@C@AA@@AA@A@AB@@@@@@@A@AA@CA@@A@A@@F@@A@C@ABA@ABA@@@A@@@@ADA@@@A@AAA@@DC@@@AA@IAA@@@CBA@@@A@AAAAACCA@@A@AB@B@BA@ABC@C@A@@A@@@AJC@@@A@@@@A@C@A@A@AA@@BABC@@@@AAA@ABA@@@A@AEECAAAAA@AB@@BB@B@@@BCBA@ABAD@BA@A@A@@@@A@C@@@AA@C@A@@A@@@C@A@ACCA@A@A@@@@BAD@@@@A@A@@@@A@A@C@A@@AAA@A@@@@B@@BF@@@@A@A@A@@ACA@@EAAA@@BABE@A@AA@A@A@@@A@@@@BB@@B@@@BA@@@AAA@CBC@@@A@@@DCB@AA@@A@ABCAA@GDA@A@@A@@BABC@A@AAA@@AAA@CBADADA@A@GACA@A@@DCBA@@@ACA@A@A@E@@A@A@@@AB@D@BABABA@C@AA@AA@CAC@AAAA@AC@A@A@MG@A@ABC@A@@AAC@C@AACA@B@@@BDH@@ABA@AAAEAA@AA@C@@B@@BD@BA@GBABCACAAC@ABEBAAA@@A@C@CBG@CBA@A@A@ECAAA@@BADA@ABG@E@AAAA@@BABCBAB@B@HBB@@AB@@A@AAAA@CBC@@@A@@A@A@A@AAAGA@@AE@@A@C@C@C@A@@AACAABA@@B@HB@@B@B@@AA@@AC@IAEAAA@@B@BABA@@@C@A@AHE@@@AAAC@CAA@CBC@A@@@@ADI@A@A@@A@A@ABCBA@A@GECA@A@C@AA@@A@@@AB@D@H@D@@@@A@A@@C@K@C@AA@@@A@AB@B@D@F@B@@@@A@AAAC@A@C@A@@A@@BCDEB@@A@@AAAAC@A@ABAB@BABA@A@AAAEAC@AB@DAF@H@BAB@AA@@ECECACA@@@CBA@A@CAC@C@C@EB@AA@BABAFAJEBA@AA@@ACAC@CBEBEBABAACA@AAAB@BABAB@AE@CGE@A@@@@BAF@BA@@@C@ADE@@@AAAE@C@AAAA@A@A@@BAD@B@DFB@@@B@@ABC@CDC@@@AA@@@C@AAAA@A@A@ABC@A@A@CBA@AA@@AI@A@A@@A@AFAJABA@A@@CCACAABC@C@ACAEAA@A@A@@BB@D@B@@B@B@BCDEDA@ABAAA@@CBO@AC@G@GNǕQAÅ¦uN¡ZQbjf@B@B@@@B@@@BA@@BAB@@@@@BA@@@@BA@AD@B@@ADAD@@@B@BA@ADABCD@@AB@@A@ABAB@BCBAB@@A@CBCBA@AB@@A@@BCDADAD@@A@@B@@@@ADA@@@A@A@C@EBA@ABC@A@A@ABA@A@CBA@C@A@@@CBA@A@ABC@A@CBA@A@A@GBA@@@ABC@C@ABA@@@@@@@A@A@@@CB@@C@@@A@CBA@GBC@@BE@@B@D@D@B@@@B@F@B@B@BAB@@@@@B@B@DABABCHCDABAF@@ADADABABCFGJA@@BABCBAD@@CBCD@@ADA@@@@BCBCBCDABC@CBA@@@@@@@AB@BA@@@@@A@A@AAGECAC@A@E@@@A@A@C@A@@@C@@@C@CBA@@BA@@BA@@B@B@@@@@@@B@@AB@@ADAFAB@D@DA@BB@@@B@@AB@@@@@@@BABA@@B@@A@A@@@A@@@A@ABAB@@C@A@A@@B@@ABAB@@ABAB@BAB@D@D@B@@AD@BAB@@@DABA@ABA@A@CBEBCBABAB@@ABAB@@ABADAD@@AB@BABABAB@@@@A@ADABABCB@@@@AB@@A@@B@@A@A@@BCB@@A@GFCBCBCDCBA@CBCDCBCB@@CB@BA@A@IFA@C@CBC@IBGBCBABC@ABABABABCBABCDABABBBDBHDBB@D@BABC@C@CCCECACAC@CBAB@DBBBBDDFDDD@B@BABA@C@C@ACCCECAAC@ABAB@@AB@@AAAAAAA@C@ABAB@BADADCB@BGDCBC@E@ABABADAD@DBB@BABA@AA@@AAA@A@A@@B@DABABC@A@AC@GAAC@E@ABCBABCDABC@@@C@CAA@E@A@A@AA@A@A@AACAAC@CAC@CBC@C@EAG@A@@B@B@@BB@BABA@AAAACAA@A@AB@B@D@B@BAB@@C@AACAAAC@A@A@A@AAACAAC@A@EB@@C@A@A@@A@A@@@@DABA@@@AA@AAA@ABCBCBA@AA@@@A@@@AB@DA@A@ACAC@GBC@A@A@AAAABA@@DABABA@A@AAAAAC@CBABCFADABABA@C@A@@@@A@AAA@AA@CAABA@ABABCBA@ABCDC@QAE@E@C@C@AACCA@A@A@@BAB@@A@@A"],encodeOffsets:[[135441,46791]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,4 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("佳木斯",{type:"FeatureCollection",features:[{type:"Feature",id:"230803",properties:{name:"向阳区",cp:[130.365346,46.80779],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CBED@@@@@@A@EDABED@@CB@BA@GDED@@AB@@GDSL@BKHCB@@CB[NBBFFFBBBFBP@PBPAJCRGNCDAFB@@BB@BFB@@LDF@BBDD@B@@@F@B@B@B@B@DDDDDDBBBB@DBHBF@F@F@F@DAB@DADAB@D@DB@@FBD@DBF@B@RAJAD@@@JCB@JEG@GAECCAIGIEEC@AA@AACA@@@@ECCA@AA@AA@@@A@@AAAC@@AAAAEAEAGCA@G@KAC@I@@BEAA@@A@@@A@@@C@@@ABA@@@A@@BC@@BC@@BA@ABA@@BC@@@@@@@@@A@@B@@@@@@CBA@@BC@@@@BA@ABA@A@@BABC@@@@@@@A@@@@@@DE@@BA@@@@DIFI@@@@DEBA@@@CDCHIBCYBE@@@@@@@A@@@G@@@@@AAI@EAC@G@CJHBABA@ABA@A@@A@AAAA@BE@@@CA@KBA@GDIC@@BD@D@B@HC@@@AB@JF@@JM@@@A@@BBBE@@BB@@F@@@JP@B@@@DDB@BBBBDB@@"],encodeOffsets:[[133475,47924]]}},{type:"Feature",id:"230804",properties:{name:"前进区",cp:[130.375062,46.814102],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@DC@@DADCFCDEDE@ADCBABCDE@CB@@ADEBC@@FOBCDIj_]GqTEBGNSECFQC@EI@@J@BCJCJSLF@ADZAADGJCD@D@@ABCF@@@@EJCJ@@@@AB@@CF@@@@@@@B@@@@@@ADAB@@@BAB@BAB@@@@AD@@AB@D@@@@A@@@@B@@@@@@@@AD@@AB@BAB@@AD@@AD@@@B@@AB@B@@@D@@@B@@@BB@FB@AJ@D@LBH@B@HDFBFBDK@@@A@A@@@@@AAA@C@A@@@A@A@@A@@@@A@@@A@@@@BA@@BA@A@@BCBABABA@@@@@ADA@@DANGHE"],encodeOffsets:[[133546,47917]]}},{type:"Feature",id:"230805",properties:{name:"东风区",cp:[130.403664,46.822571],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@GFMHCB@@CB@B@@@@ABABABAD@@@BAB@@AB@@@@@B@@@B@@B@@@@B@B@@@B@DBB@B@@@@@B@B@@CLBBBB@@BDBB@@@B@@BBB@@BDBFD@@@@DBBBB@@BFDJFJHDBFDHBH@IFA@\\BRBHDDDFHBBJFL@VBbJHJHFXbPVJLJPHRJVEbPj@D@FBD@FB@DBB@B@BBB@BBB@BBB@@BB@BBBB@@BAB@B@@@B@B@B@BA@@B@B@B@D@@@D@B@B@B@B@B@@@B@B@BAB@@@B@B@B@BA@A@ABC@@BA@ABA@@@A@A@@@@BA@@@AB@@A@@@@@AB@@@@A@@B@@A@@@@BA@@@A@@@@@A@@@@B@@A@@@@@AB@@@@A@@@@@@BA@@@@@@@@@@@A@@@@@@@@B@@A@@@@@@@@@@@@@@@A@@B@@@@@@@@@@A@@@@@@@@@@@@@A@@B@@@@@@@@@@A@@@@@@@@@AB@@@@@@@@A@@B@@@@@@@@@@A@@@@@@@@@@@@B@@A@@@@@@@@@@@@@A@@@@B@@@@A@@@@B@@@@A@@@@@@B@@@@A@@@@@@BA@@_IWGKWhK@@A@@A@@@AFGBCDCDAHAEAA@@@A@AAA@A@AAA@A@AAA@@@A@@A@C@C@C@@@A@C@AC@GAEAAAA@CAA@CA@@CAA@AA@@A@AAA@CA@@A@AA@@A@@@AAA@@@@@A@GAOADEBCBA@@DBBAB@@AB@@ABAB@BA@@BABA@@BABABA@ABA@@BC@@BA@@BA@@BAB@B@DAB@B@@@BAB@D@B@BAB@B@@@@@B@DADABADAB@@AB@@ABCBCDCBEBCDC@ABA@AAA@@AAAAA@CAAAA@AAA@AA@@CA@@A@A@AA@@AAA@@@A@@@A@AAA@A@AAK@I@E@A@AB@@A@@@A@A@AA@@@@CBCB@@@@GAE@A@A@EABCBA@A@CBC@A@C@A@AAAEACACAC@AAA@C@@BABEDA@E@A@A@C@A@AACBAAC@C@ABA@C@FNA@AAAAACAA@@A@@@CA@@AA@@@@A@@@A@A@@@A@C@@@A@@@A@A@A@@AA@A@@@A@@@AA@@A@@AA@AA@@A@A@AAA@@@CAA@@@A@AA@@A@@A@@A@@@AAA@@AAABA@@@@FKBAAE@A@AAAAC@@@A@@BA@C@@B@@AA@@@@@B@@@@A@@@A@A@@@@B@@@@@@@B@@@BA@@@@@@@B@AB@@@@ABC@ABC@A@A@A@A@A@A@A@ADS@@VOLGBEWEC@£HCJADEP@@ADCF@BA@@DCFADABCD@BCFCFEDCDCB@@CD"],encodeOffsets:[[133546,47917]]}},{type:"Feature",id:"230811",properties:{name:"郊区",cp:[130.327194,46.810085],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@A@A@A@ABA@C@CBA@A@CB@@A@@@@@A@@@A@@@A@A@@@CA@@A@A@AAA@A@@@A@A@A@@@C@@@A@@@@@CB@@BDDBB@@DB@BDBF@D@DBBADAB@BBDBBB@@B@BAB@BBB@BABCDABBBBBADDD@DAH@@BB@BCBBD@@@BDF@B@DBBDF@@AD@BB@FF@B@DBBDB@B@FDFBDB@BB@B@@BB@BBB@F@BDBBD@D@BADBBD@BBFDDHFD@B@@B@BF@B@BHHBDDDFH@BBB@@@B@BABAB@@AB@@CB@BABA@ABA@@@@@AC@A@AAAA@CCA@A@A@C@A@A@A@A@@@CAAAC@EACAA@AAA@AAAA@CAAAAACAA@AAAA@AACA@@@@@@A@@@CCA@A@EAA@AA@@A@@AA@@@A@@@@@@B@@AA@@@@@BA@@@A@A@A@A@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@AA@@A@@@A@A@A@AA@@AA@@AA@AA@@A@@CA@A@@A@@@@A@@@@@@AA@@@AA@@A@@AA@A@C@A@A@@AA@A@@AA@A@A@A@A@ABA@ABA@@@@B@@A@@@@@A@@@@BA@A@A@AB@@A@@@@@ABA@AB@@@BAB@BABA@@@@AA@@@ABA@ABCBABABA@@@@@A@@AAA@AA@AA@@A@A@A@A@A@AB@@A@ABA@AB@B@@AB@@A@@@@AA@@A@@A@AB@@@@A@@@A@@BA@@@AB@@@B@@ABA@A@A@@@A@@BA@A@@@ABA@A@AB@@AB@@A@@@ABA@AB@@A@@@A@A@@@A@A@@BA@@@@@A@@@@A@@@A@CBABABCBA@ABA@AAA@@@A@@@A@A@@BAB@B@B@@A@@@C@ABA@A@AB@@@BA@@B@BA@A@ABA@@@C@A@@@ABA@A@AA@@A@@BA@@@A@@@A@@AA@A@@BA@AB@@@@AB@B@@@B@@A@@@A@@B@@@@@@A@A@@@A@@AC@CCAAI@CAECA@A@A@@@@@@BADCBBDBB@D@@@@A@C@G@C@A@C@A@C@A@C@A@C@A@A@A@A@A@A@A@A@A@@@A@@@A@@B@@BB@B@B@@A@ABA@A@A@A@AB@@BB@B@@BB@BAB@@@BA@@BA@@B@@@BBBD@D@FBD@DBBBD@D@BBDBDBB@@BB@@@AB@@@@AB@@@B@@@BB@BBDB@BDBD@@BB@DD@B@BBB@B@B@BBD@BBB@B@B@B@D@B@D@D@B@B@B@B@B@B@@AB@@@B@@AB@@B@B@B@B@B@B@@@@B@@@B@@B@@BB@BBBB@@BB@@@@BB@B@@B@@BB@@@@BB@@B@@@@@B@B@@@@@@BB@@@@B@@B@@B@BB@@@B@@@B@B@BBD@@@B@@A@A@A@@@A@A@@@A@ABA@@@@B@@A@@@A@A@A@A@A@A@A@A@@A@AAAA@AAA@@@@@@@@A@@BA@A@@BA@A@@B@@AB@B@@@AAACAAAACAAAA@@A@A@@AA@@@AAA@AAAAAA@A@@AA@A@A@@@A@@@A@@@AB@B@@AB@@ABABA@@BA@@@AB@@AB@@A@ABC@ABC@ABA@ABA@C@C@@@A@@@@A@A@CBA@A@@@AB@@A@@@A@@@A@A@@@A@AA@@A@@AAA@A@@@A@C@@@CA@@A@@@@@@@@A@@AA@@@AA@A@@@ABA@@@C@A@@AA@A@@@A@@AAAAA@@A@C@@@@@A@@A@@@@@@@A@@@@@AAA@@@A@@A@@A@@@@A@@A@@@A@AA@@A@@@A@@A@@@@@@@A@@BC@@@AB@@A@@@A@@@@@C@@@@@@@A@@@@@AA@@A@@@@A@@@@@A@@A@@@A@ABA@@@ABAB@@AB@@@@A@@@@B@@A@@@@B@@@@@BA@@B@@@B@B@@A@@B@@@@@@@BA@@@@BA@@@@@@B@@A@@@AB@@@@@@BB@@@@@BA@@@A@AB@@@@@B@@@@@@A@@@A@@BA@@@AB@@A@@B@@@@@@@BA@@@AB@@@@A@@B@@@@@B@@AB@@@BA@@@@BAB@@@@@@@B@B@@@BA@@BA@@BA@@BAB@@@B@B@D@@BB@@@BA@@@A@@@@@@@@@A@@@A@@@A@@@@@AA@@@A@@A@@@@@A@@@A@@@@BA@AB@@A@ABA@@BA@@@@@C@A@A@@@@@@B@@@@@B@BA@@@@BAB@BA@@BA@A@@BA@ABC@@BA@@@@BA@@BA@AB@@AB@@ABAB@@AB@@ABAB@BAB@@@B@@@@DL@DBD@BBL@DCV@HAH@DDDFH@@BB@@B@BBBB@@B@BB@@BBB@@@BBB@BBB@BBB@@BB@@@BBB@BB@@B@BB@@BBB@@BB@@@BB@BB@@BB@@@BB@BB@@BB@BB@BB@BB@B@@BB@@JL@@HL@B@@@BB@@B@@@BBB@@@B@@BB@@@B@BB@@B@B@@@B@BBB@B@B@@@B@B@@@B@BAB@B@@@B@B@@@BA@@B@B@B@@@B@@@BAB@B@@@@@B@B@@@@@BAB@B@@@B@B@BAB@B@B@@@B@B@B@B@B@B@BB@@B@BB@@B@B@@BB@@@BB@@B@BB@@@@BBB@@B@BBB@B@D@D@B@D@BAB@B@B@D@BAB@B@BAD@@@B@B@VB@@B@RBTH@@@@BBB@B@@@B@BBB@@@B@B@D@B@B@B@B@@@B@B@@@BAB@B@B@BAB@B@@@BAB@B@BAB@@@BAB@BAB@BAB@BADAB@BAB@BA@@B@BAB@BAB@BA@@BAB@@ABAHEHCVEP@XBHFFDBDBH@FCFCDMPQPCJAFD^@@EDAB@@C@@@A@BBA@@@A@@AA@@@@@@B@@A@@@@B@@EB@@AAA@@@@@@B@@@@@BA@@@BB@@B@@B@@@B@@@@@B@@@B@@BBB@@BB@@BB@@BC@@@BB@@@B@@A@@BB@@B@@@@@BAA@@@@AA@@A@@B@@@@@@@B@@B@@BA@@@@@@BA@@A@@AB@B@@@BB@@B@@A@C@@@@B@@AB@@@BB@@@B@@BA@@@A@@@@@ABA@@BAB@BA@AB@D@@AD@B@@@@CB@@@B@@@@ABAB@B@@BB@@ABA@@@@@@D@@@@B@B@BB@@@@@BA@@@@@@@@@@B@@EAWCCBA@ABABA@@@C@CAABAB@B@@@B@@AB@@BBAB@@@@@@@B@@@@ABA@@@@@A@@B@@B@@@@B@@A@A@@@BB@@A@@@@B@@@@@BB@@@@@@BA@A@@B@@@@@@AB@@B@B@@@A@A@@@@B@@B@@B@B@@B@@B@@@@A@@B@@AAA@@B@@@B@@AB@B@@DB@@@B@BBB@@@BB@D@@B@B@B@@AB@BrLBRAVETqIBB@@@BA@@BAB@BB@BB@@AF@D@@@BAB@BA@@BD@@BB@@B@@AB@@@B@@AB@@A@CBAB@BBD@@A@@B@B@@@BBBBB@@BBBB@@ABCDCB@B@@@BAB@BA@@BB@@BD@B@B@BBA@@BA@ABCBAB@@@@A@@@A@@BA@@@@@A@@@A@@BA@@@@@@A@@@@A@A@A@@BA@@@AB@@@@BB@BA@@BB@@@@@@@AB@@@A@@@@A@@@A@@@@@@BBB@@@@@BB@@@@@@B@@AB@@A@@@@@@@AB@@@@@BB@A@@B@@@AA@@BA@@@B@@B@@@@@@BB@@@@A@@@@B@@@@AA@@A@@B@@@@@BA@@@@BA@@@@@AB@@@@A@BDA@@@@@@BA@@@B@@@@@@@@BB@@@@@@BA@@@@@A@@@@BA@BB@B@@@@A@@BAB@BA@AB@@A@BBA@@B@@@BA@@@AB@@@@@@A@@@@B@@@@@@@@A@@@AB@@@@@@@B@@@@A@@AA@@B@@@@ABA@@A@@@@B@@@@@A@@@@@A@@@@@@B@@@AA@@BA@@@@@@B@@@@A@@@@@@A@@@@A@@@@@@@@@ABA@A@@B@@@@A@@@A@@@@@@@@B@@@@@@@@@@@@A@@B@@A@A@@BA@@@@@@B@@A@@@@@@B@@@@A@@@@@@@BB@@@@A@@@@@@@@B@@@@@@A@@@@@A@@@@B@@@@@@@@@@@@@@@@@B@@A@@@@@@@A@@B@@A@AA@@A@@@B@@B@@@@@@@@A@C@A@AB@@@@@@@@A@@@@@@@@@A@A@AB@@A@A@FDB@B@DAH@BAF@JCD@HAD@BAD@@@@BB@D@FAFAFC@A@@@AA@DAB@DADABC@@D@D@DAD@B@NEHCfMLEB@@@DAB@BB@BBB@@BBB@BBB@@BFBBB@@EFCD@@B@BDDDDDDF@@B@BADCDCDCDAB@FAFABAFC@@@AB@@@@@@@@AB@@@@@B@@@@AB@@@@@BA@@B@@AB@@@@AB@@@@@@AB@B@@@B@@AB@@@@@@@B@@A@@@@@@@@B@@A@@B@@@B@@@@@@@B@@@@@BA@@@@@@@@BA@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@B@@@@@BA@@@@@@@@B@@@@@@@@@@@@@B@@@@@B@@@@@B@B@@@B@BAB@@@BA@@@@DABA@@B@@AB@@@BA@@B@@@@@@AB@@@@A@@B@@AB@@@@A@@BA@@BAB@@A@@BA@@@AB@@AB@@A@@B@@A@@BA@@@AB@BADCBADC@AB@DCDC@@DA@AB@@AB@@AB@@ABA@AB@@AB@@AB@@@@BBBBD@@@@@B@@@B@B@@@@@@BB@@@@@@@A@@@ABA@@@A@@@AB@@A@@@@@AB@@@@A@@B@@@BAB@B@B@D@@@B@B@B@@@BAB@B@B@DA@@@@@@B@D@B@B@BA@@B@BABAB@@@@@@@B@@@D@B@@@B@B@B@B@B@D@BBB@B@B@B@B@BBB@@@B@B@BA@@@@B@@@@@@@@A@@BA@@@@@@@@B@@@@@B@@A@@@@@@B@@@@@@@@@@@@@@@BA@@@@B@@@@AB@BBDBLFDB@@DB@@@@B@@@@@BB@@B@@BB@@@@@BB@@@@BB@@B@@@@BB@@@@@@@@@B@@@@@@@@B@@B@@@@@@@@@@@B@@B@@@@@@B@@@@@@@@@@@BB@@ABEFCDA@IAA@A@SN@BAB@@@@ABA@@BAB@@AB@B@@A@@B@BABDAHAD@D@BBDBBBFDBB@B@BCB@B@BBBD@FBHBHDBB@@@@@@B@@@BBB@@@BB@@@@@@@@@@@B@@AB@@@B@B@BAB@B@DAB@B@B@@@@@@@@ADH@F@@@@@@@@@B@@@BBB@F@FBLBPBDBHBRBFBB@D@@A@ABC@CBA@C@@@ABA@EB@@E@ABCBEBE@EBC@AAAC@A@@@WAGAUAA@@EBC@A@CBA@C@A@A@A@@@ABA@@@C@ARBRAJCBAFA@@B@DGBA@C@@B@@AA@AC@A@C@C@EBEF@F@@@@bB@HBF@D@F@D@B@TBB@B@D@DBAB@@B@B@BBBADA@@B@BBBD@@DA@@@@B@B@@@@B@D@@@B@@@@@@@D@D@B@@B@B@BBJDDD@@B@@B@BB@@BB@@BB@A@B@@@@@B@@@@@B@@@B@BB@@@@@@ABB@@@@@A@@@@BB@B@B@@@B@@@@@@@@@@AB@@@B@@@@@@@@BB@@@@@B@@@@@@@B@@@@@B@@@@@AA@@B@B@@@@@@@A@@@@@@A@@BB@@@@B@@B@@@A@@B@@@@@B@@B@@@@@@ABB@@@B@@@@@B@@@B@@@@@B@@@B@@A@@@A@@@@@@B@@@@@BA@@@@B@@@@@B@@@AB@@@@@@@@B@B@@B@@A@@@@@@@B@@@B@@@@@@AB@@@@BB@@@@@B@@A@@BB@@@AB@@@@@B@@@B@@BB@@@A@@B@@@@@@D@BB@@A@@@A@A@@@BB@@A@BB@@@@B@@@B@@@BA@@@@B@BBB@B@@@B@B@@@@@BAB@@@B@B@@@B@B@@@B@@ABA@@@@@A@@@@@A@@@@A@@@B@@@B@@@B@@@@@@@@@@@@@B@@@@BB@@@@@BA@@B@@@B@@@@@@@@@@A@@B@@B@@BA@@B@@@AA@@@@@A@@@AA@@ABAB@@@@B@@B@@A@@B@B@@@BA@@@A@@@ABB@@@@B@@@@@AB@@@@@@B@@@@@BB@@@@@@B@@@@@A@@@@A@@@@B@B@@@BA@@@BB@@@@@B@@@@@@@B@@@@@@B@@@@@@BA@@@@@B@B@@B@@@@BB@ABB@B@@@@B@@A@@@A@@@@@@@@@B@B@B@@B@@@@A@@@A@@@@@@@@@A@@@@@@BA@@@@@A@@@@@@B@@@@B@B@@@@@@@@@AB@@@@@@B@@@@@@B@@A@@@A@@@A@@@@@B@@B@@@@@@A@@@AB@@@@A@@@@@@@BB@@@@B@B@B@@@@@@@BA@@@@BB@@A@AB@B@@A@@@@@@A@@@@A@@B@@B@@B@@@@B@@@@BB@B@@@@@@@@@B@@@@A@B@@@@@@@B@@@@DB@A@@@@@A@@@@B@@@@B@@@@@@@@B@@@@@@A@@@@B@@B@@A@AB@@@@BB@@B@@AB@@@@@@@BBB@@@BB@@@@@@@@@@A@@@@B@@@@@@B@@@@BD@BBD@BBB@B@DB@@DBB@B@BB@@B@DBB@BBB@BBB@B@BB@@B@@@@B@@@B@@@@@@@B@@@@@@@@@B@@@@DBDBDBDBDBB@@BD@BBBBB@FDB@BBB@B@@@DBBBLKJD@BBB@@@@BBBBB@B@@BB@BB@@B@@@B@BBB@B@B@BB@@@@B@@BB@@BB@@B@@B@@@BABAFE@BDB@@BBB@@@@@@B@B@BABA@CBAB@B@@@B@@@B@B@@BB@@BBDB@@B@@BB@FBBBB@B@DBDBB@D@B@BBD@D@D@D@BAB@@@B@B@BAB@B@@AB@BAB@@@BAB@B@@A@@B@@@B@@AD@BAB@@@BA@@B@BAB@@AB@B@B@B@@@@ACC@@@AECCCJCJAJCH@ACA@CAA@AA@AA@C@A@@@@ADA@A@A@@CCCCAC@@@ABABA@AA@C@CA@@ACAAAAA@@B@BABA@A@A@@@A@ABA@A@@@A@A@A@A@A@C@@@C@A@A@A@@@ABA@A@A@@@A@A@AB@@AAAAA@@AA@AAA@AAA@AAA@A@CAA@@EAC@E@COiFaIUGQIOIKOUWaGEGIaIUAK@IEAAEGCCGCQA[AID@@C@IBQBA@E@CAC@EA@@CAC@A@CBCBA@CBE@E@E@E@GACAA@AACACCCC@C@A@A@A@A@E@@@ACCAAE@KC@@EA@AAA@@EACBMDQHIDOBOAO@EAAAEAEEAA\\MDA@@DALG@ATKHC@@BA@@FCHCB@@ADA@@FCBAFCB@@@@@@@FCDA@@@@CAAAAAA@CC@@A@O@@I@@@EA@@AF@AA@AB@@@N@@IE@@IBA@@D@@G@A@CAC@@JDHCB@LAB@@D@@AFB@BB@B@BB@B@BAB@BAGADIH@D@FBJ@BB@@@@H@@@B@@@@@@@F@BCE@TKDIDI@A@IJ@@FRDDETFHMFArS^Hi`¤GD@XFAFKHUP@@CT@B@B@B@B@B@B@B@BAD@BADB@@AF@BBJ@JBJ@BB@AB@BAB@@@FCHGHEDCDABABABADAHGBADCFEHEDCDCBA@@AAAC@ACCCEACAECECGCECCAC@@@@JCHEDAJEDAFA@A@ABC@ABE@EBA@E@ABGBC@C@A@AC@C@A@EAC@A@@@@A@@EAKEKCCAICEACAC@EA@A@@@ABA@A@AB@@A@@BA@@@@BA@@BA@A@C@C@CAA@A@@C@AA@@AAA@@AA@AAC@A@@AA@AA@@A@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@@@@AA@@A@@@@AA@AA@@@@@@A@@@A@@@A@@@A@@@@AA@@@A@@@AA@@A@@AA@@@@AAA@AA@@A@@@A@@A@@@@AA@A@@@@A@AA@@@AA@A@@@A@AB@@A@@@@@A@A@@@@@A@@@A@@@A@@@@@@@@A@@@@@A@@@@@ABA@@BA@@B@@@@AB@@@B@@A@@@@B@@AA@@A@@@@@@@@@@@AA@@A@@@A@@@A@@@@@@BA@@@@B@@@B@@@BA@@@AB@@@@A@@@@B@@A@@@@@@@@BA@@BA@@@@BA@@@@@AB@@@@A@@@AB@@@@A@@@@BA@@@@BA@@@@B@B@B@@@B@B@@@BA@@B@@AB@@@B@B@@@B@@@DABAB@BA@ABA@A@A@@BAB@@AB@A@@@A@A@@@A@@@A@A@AB@@A@@@A@@@AAA@AA@@A@@@A@@@A@@AA@@@AA@@@@AA@@@AA@@@A@@@A@A@A@A@A@@@AA@@@@A@@A@@@A@@@A@@BAB@BA@@B@@A@@@@AA@@B@@A@@@@@@@A@@@@@AAA@@AA@AA@@@A@@AA@@@A@A@@AA@@@@A@@@@@@@@A@@@A@A@C@A@@@A@@@@@A@@@@@@A@A@A@@@@@A@@@A@@AA@@@@@A@@@@@A@@@A@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@A@@@A@@@CB@@ABA@@BA@@@A@@@A@@AA@@@@AA@@@AAA@@@A@A@A@A@@AA@A@@@A@@@AA@@A@A@@@@@@@@@@@A@@@@@@B@@@D@@@B@BA@@B@@@B@B@BA@@B@@@@A@@BA@A@AB@@A@@@AB@@AB@@A@@@A@@@A@@@A@@@A@@@A@@@@@A@@@AB@@AB@@A@A@@@@@A@@@AB@@@@AB@@@@A@A@A@A@A@@@CB@@A@A@@@AA@@C@@@A@A@@@A@AA@@A@A@A@ABA@@@A@@A@@A@AA@@C@@AA@A@AB@A@@@@@@A@@@@@@@@@@A@A@@AA@@AA@@@@AA@@@@@@@A@@A@AA@AA@@AAA@@A@@AA@A@A@A@A@@@A@@@A@@@A@@@A@@@AA@@A@@@AAA@AB@@A@@@@@AA@@A@@@@AA@A@@@AB@@A@@@@@A@@@@BB@@@A@@B@B@@A@@@@@@BA@@B@@@@AB@BA@@@A@@@@@AA@A@@AA@@AAA@AAA@@AA@@A@@@@@AA@A@A@@@A@@@@@AA@@@A@@A@@@A@@@ABAB@BA@@@@@@BA@@@@@AB@@A@A@@@@@AB@@@@@B@@@@@@@@AB@B@BA@@@AB@@C@@@A@@@@@@@@@A@@@AB@@@@A@A@@@A@@@A@@BA@A@@BAB@@@B@B@D@@@B@B@@@BA@@BAB@@A@ABABA@@BABABA@@BC@A@A@C@A@C@A@C@A@AAA@A@A@C@A@A@@BA@A@A@@BA@@@@@AB@@@@CBC@ABCBAB@BBB@@@BCBA@G@@@AAA@A@A@C@A@A@A@A@CBA@A@ABA@@A@@@@AC@A@AA@AA@@AA@A@A@A@@AAA@@@CA@AA@CACAA@CA@@AA@B@B@BA@@BA@@BA@ABABA@@B@@@B@@BB@@@B@@ABADCB@BAB@@@@@@A@@@@@@B@@@@@BBB@@@B@B@BAB@@A@ABA@A@@@AB@@@B@@@BB@@B@BA@@BAB@BAB@@@B@@@BBB@@@B@@@BA@@BABA@A@ABA@A@@BA@@B@@A@@@@BA@@B@B@@AB@@@B@@AB@@@B@@@@@BCAA@CCA@CA@AA@AAAA@AAAAAB@@@B@@@@ABABCBC@@@A@C@@CEA@@@@A@@@AB@@ADABA@@BAF@B@@A@A@ABC@CB@@ABA@A@CBEE@@@AEEK@C@ABI@A@C@AA@@@@@C@A@@A@@@@@I@GAE@G@A@I@@CEAA@AAA@@EC@@AG@AAACC@@AC@@IK@@@C@@BC@AB@@A@@A@@@@@BA@@@@AA@@@@@A@@B@@@AC@A@AAC@ABA@CB@@ABABE@A@@B@BAB@@A@@AAAC@AB@@@@A@@@@@A@@BA@AB@BA@ABA@@BA@@BA@A@@A@C@A@A@A@A@@@A@AB@@@@CA@@A@ABA@@@@@A@ABA@@@@B@@AB@@@BAB@BA@@B@B@@@BA@@@@B@@A@@@@@A@@@A@@AA@@@A@C@@AA@@@A@A@"],["@@KAGC@BOZ@P@@A\\@FAJ@JA@ANJHNEJ@@BL@T_JMWIBOBG_GLMD@DG"],["@@NCJE^sJBNaKGBALGEMTMTOAcZAHADUEGJABS^^JGFGHCVFHNNP@TAT@^KTMIIEAHKDECANU"]],encodeOffsets:[[[133304,47607]],[[133152,48189]],[[133281,48194]]]}},{type:"Feature",id:"230826",properties:{name:"桦川县",cp:[130.71908,47.023001],childNum:1},geometry:{type:"Polygon",coordinates:["@@BAB@BAB@BAB@@ADABA@A@A@C@AAA@AA@@ABA@@BC@@@AAACAA@AA@@A@A@@@A@@AACAA@ABA@A@A@A@AB@BA@@BAB@@A@ABABAB@B@BAB@DADAD@DAB@B@B@BA@AB@@A@@@CAA@CBA@A@@@A@AA@@@@AA@A@@A@@@A@@AAA@@A@@A@@@B@@A@@@@@@@A@@A@@A@@CA@@A@@AA@@@A@A@AA@@@@@AAA@@@AA@@AA@@AAA@@@A@@@@@A@@A@@A@@@@@@A@A@A@@@AA@@@@@A@@@ABA@A@@@A@@@@BA@@@@@AA@@A@@AAA@@A@@@ABA@@@A@@@@@@@@AA@@AA@A@@@@BA@@@CA@@A@@@@@AA@@@@A@A@@@A@A@@@@@AB@BAB@DA@@BA@@B@DA@@BAB@BA@AB@@A@@@@AA@@A@@A@@AA@@AA@A@@@A@@@A@@@@@A@@B@@AB@@A@@@@@@@@@@@@@@@A@@@A@@A@@A@@@@AA@@@@@@@A@@A@@@@A@@AA@@@@A@@A@@@@@@@@@@A@@@A@@@A@@@@@@A@@@A@@AA@@@@@A@@@@@@A@@@A@@AA@@@A@@A@@@A@A@C@@@@@@BA@@B@B@B@@@BA@@BA@@@AB@@@@A@AB@@@@A@@@@@A@A@@@A@@@A@@@A@A@@@AAAAA@AAA@@@@A@@A@@A@@@@A@@@A@@@AAA@@AAAAA@AAA@@@@A@@A@@@A@AA@AA@@@@AAA@@@AA@A@@@@AA@@A@@A@A@@A@@@@@A@@BA@@BA@A@@@@@@@@AA@@A@A@@AA@A@AA@@A@@@A@@BA@@@@@@@A@@@@BA@@@A@A@@@A@@B@@A@@@AA@@A@A@@@@AA@@AA@@@A@A@A@@@AA@@@@@@@@AB@@@@A@@B@@@@A@@@@@@A@@A@@@A@A@A@CA@@A@@@A@@@EBAB@@A@@@ABA@@@AB@@A@@@AB@@A@@@ABA@ABA@ABA@ABA@@BABA@A@@@A@@@AB@@ABA@@BA@AB@@A@@@AAA@AAA@AA@@AAAAAACAAAA@AAAAAACAACA@@A@@A@AA@@@@@AA@@@A@A@A@AA@@A@@@AACA@@AAAAAA@AA@@AA@@@AAA@A@@AA@A@@@A@A@C@ABA@@@A@A@A@AAC@C@A@@@@@A@AAA@A@@@AAA@AAA@A@AA@@A@A@@@A@@@A@AB@@ABCBA@@@A@AA@@@@@@A@@@@@@@A@@B@@@@A@@@@@ABA@ABA@@@@@A@AB@@A@A@AB@BA@@BA@ABA@ABA@ABABA@ABABCBABA@@@@B@@A@@B@@@@@BAB@@@@@B@@@@AB@@@@@@ABA@AB@@@@@@@@@BBBB@BB@@@@BB@@@@B@B@@@B@@@@BB@A@@B@BA@ABAB@@AB@@A@@@@BB@@@BB@B@@@@A@A@ABA@A@AB@@ABAB@B@@@@@BA@@B@@A@A@@@AB@@@@B@@@@B@@B@@B@B@@@@@B@@A@AB@@C@A@A@A@CAA@AAA@@@A@@@A@@B@B@B@BAB@@@@@@@B@@@@BBBB@@BB@B@@@@@BA@ABA@A@A@@BA@AB@@@B@BAB@BABA@ABCB@@A@@@A@A@@@A@@BA@@@AB@@A@A@@@A@A@A@@@@@AB@@@@AB@@@@@B@@A@@B@@@B@@A@@B@@@@AB@@@@AB@@AB@@@@@@@@@BA@@@@@@B@@A@@B@@AB@@A@@@A@@@@@AB@@@@@@@B@@@B@@@BB@@B@@@@@@@@@@@BB@@BA@@@@@@BA@@@A@@B@@A@@@AB@@AB@B@@@@@B@@@@@B@@@@@@B@@@B@@@B@@@@@B@B@@@@@B@@@BAB@@@B@B@@B@@BBB@@@@@@BBB@@@@@BB@@@B@@@BBB@BB@@@@BB@@@BB@@B@@@B@@BB@@@@@B@@@B@@@B@@@B@@@@B@@BBB@@@@@BB@@B@@@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@@@@BB@@@BBB@@BB@D@BBB@@BB@BB@@BBD@@@@BBB@D@D@D@BAB@@AB@@@@AB@@@BA@@B@BAB@B@@@BFBD@DBFBJDDBLDLFFB@@@B@@B@D@FBB@D@D@@B@B@DADAH@B@FAB@FAF@BAD@B@BEBCBIFCBGFID@@@@BDDDDFDHDFBFBDDFDD@BBDBB@@ABCDCDGFEFCDABGHCBABABABCBCDGFGHED@@A@ABA@@BAAI@IAI@AAE@@BA@@B@@A@@B@A@@@@@@AB@@A@@@@@@@A@@@@@@B@B@@@B@@A@@@@@B@@BA@@@@DAB@@@B@@BDBB@B@BBFABEL@@@@ABBB@BB@BB@@B@@@@BB@@@BBB@@@B@DB@@B@BBB@B@@@BBB@@BB@@@BB@@B@@@B@B@@BB@B@B@@@B@@@D@B@@@B@B@@@B@@@@@BB@@DB@@B@@@BBBDBBBBB@EMD@B@BAD@D@BBDABBB@D@B@B@F@B@FCBA@AD@B@BBD@DBDBFBBB@B@B@D@BAD@D@BABADFBB@B@F@HB@@@@DADA@@@@BBB@B@@@B@@@BAB@F@J@L@BBB@B@BBB@@@B@@@B@BB@@BBB@B@@@DB@@BBB@BBB@BBDBB@BBBB@@BB@BAB@BCDADAFCDADAD@BA@@BA@CBABCBCBA@@@@@A@A@ABA@C@A@AB@@A@A@CBA@A@AB@@AB@@AB@@AD@@AB@BABABAB@@ABAB@@ABA@AB@BA@@BA@ABCA@@ABADCFPBHBB@@@@@B@BB@@B@@@BBB@@@DBB@BBB@@@BBB@DB@@DBB@DBB@BBFBHBD@@B@D@B@@@D@D@D@BB@@@B@BBB@B@BBB@B@BBB@@@B@FBGBCBCDADEH@B@@@BB@@@gLLXXH`J@@AB@@@@@@@B@@A@@@@@@@@B@@A@@@@@@B@@A@@@@@@B@@@@@@@@@@@@@BA@@@@@@@@@@@@@@B@@@@@@@@A@@@@B@@@@@@A@@B@@@@@@@@@B@@@@@@@@A@@@@B@@@@@@@@@@@@@B@@@@@@@@A@@@@B@@@@@@@@@@@@@@@BA@@@@@@@@@@B@@@@@@@@@@AB@@@@@@@B@@A@@B@@@@@BA@@@@@@B@@@@@B@@AB@@@@@BA@@@@B@@A@@B@@@@@BA@@B@@AB@@@@@B@B@@AB@BAB@@ADB@BBBBBD@@DBD@B@@BABAB@B@@BDDDDD@@@B@BCB@B@@B@D@B@@BBBB@DBB@BDG@IDIBIDDDFD@B@@DD@BHDD@LDDBDBPFrPLBL@LEVQBABAHCLAH@D@FBLJJFDBJD@@BBBB@@BDBD@RADBDDLBB@BFDNVFJB@LHLFNDB@DBDDDDFH@HALAH@HDFHBHAHAFAJ@JBLBJDHBDBDDDBBD@BBFAF@BABABCDEDCD@F@FBH@ZBFBDFDLDBBF@RDVAD@HB`H\\BD@F@L@JBFBBDBDBBDBDBB@D@JCPCPBXNXLJDDDJDHDFDLJDBJDDBF@JALAREdC^C^@L@XGFABARGNGPKLEL@F@LBRFDFDJBLDFFFPDLBHBFDTDV@P@L@Z@TG@ANEDCDAFCHAHCDEBABAD@HCB@B@F@J@H@FAFAHAFAFCHGFCB@DAHAB@D@FBF@B@D@DBB@JAJ@@ADABCB@BADAD@B@DAB@DAF@@@J@ZBVF^JJHFFJL@@BFFHDBJJDDDB@BD@JDNHD@DBB@@@L@F@B@N@D@DBDDDDB@@@BCB@@ABADAFABAD@DADAB@BAD@B@DAH@D@B@B@@BB@B@B@@@B@BAB@BAB@@@B@B@B@B@B@@BB@B@B@B@BBB@@@B@B@B@B@BBB@@AB@B@B@B@@AA@BA@@BAB@B@B@@@B@B@BAB@B@@@BAB@B@AAB@B@B@@@B@B@B@B@@@@AB@@A@@AA@@@A@A@A@A@A@@AAA@@@AAAA@@AAAA@@@AAC@A@A@A@ABCBCBE@CB@@A@@AA@AACAAAA@AAA@@@ABA@A@@BADCDC@@@A@@@@@A@@@@BA@A@C@A@C@C@@@A@A@@B@@ABAFCFCDADCFAFCDAHCDAFAB@BAHCDADABABC@A@@BA@A@@@C@C@A@@@AB@@AB@@@BAD@DAB@@@BABA@@BABABCDC@A@AB@@AAA@A@AACAA@@CC@A@@AACMAG@@@@@@A@B@BA@@@@@AEOAEAE@A@@KBIBGB@@A@@A@@K@KAMAG@A@A@IA@@A@C@C@A@A@CAA@C@@@C@C@@@EAE@C@A@C@A@EAC@@@A@@@C@A@CAC@E@E@CA@@ABI@C@I@C@C@A@E@E@G@E@E@E@G@C@C@E@C@G@@@@A@@@@@A@@@@@A@@@@@A@@@AA@@@@A@A@A@A@@@A@A@A@A@@@A@@@@@A@@@@@A@@@A@@@A@@@@@@@A@@@A@A@A@@@@@A@@@A@@@C@@@A@@@@@A@@@@@A@@@A@A@@@@@C@@@@@A@@@A@@@A@@@A@@@A@@@@@A@@@A@@@@@AA@@@@@@AA@@A@@AAEAUEUEMCWGECWEQEGAICEAYGUEOEC@CAAAC@EAWGWGEA]GGCMCGA]IEAA@U@_BE@@AE@AAI@@@I@GAG@EAG@_CyEqC@BADAB@BAB@BAB@BAD@@AB@B@@AD@BA@@@CAAJAF@BEDGHEDGHGAA@AACC@AECIICCA@AA@@GCKG@BA@ADCDADADA@@B@@@@A@@@@@A@AAA@@BADABADABABCD@@AAECA@AA@@CAKCAA@@A@AACACCCACACAAAIEC@AACAAA@@A@SKMGA@@AA@A@AAA@C@A@AAA@@@A@@@@@AA@AEGCBC@ABABABA@AAA@A@@@A@AAA@A@A@AA@@A@AAA@A@A@@AA@A@A@AAA@@AA@AA@AA@A@CEI@G@E@E@G@G@_@ABI@@BGBEBC@@@A@A@CBA@A@ABA@A@ABEBCBCB@DBD@J@JBD@@@@A@CBI@CBAA@IAG@GAB@PELC@@@A@C@AC@C@E@GAC@A@C@A@@@A@A@C@AAA@C@C@A@@ABA@C@A@CBA@C@A@@G@C@C@AAA@A@A@A@C@A@@C@ABE@C@AB@@@@AB@@A@@@@@@@CBA@EBG@A@@CAAAA@@@C@A@E@A@A@A@AAA@A@@@AB@@A@CAA@A@@@A@A@A@C@@AC@A@@@A@@@@@@@@@GJ@BABAB@BAB@B@B@B@B@@A@@AAAA@AA@@A@AAAAA@@AA@AAA@CAAAGCEACACAAAA@ACAAAA@A@A@@@A@@@AA@AA@@A@@@@AA@@@B@AAAA@@AAA@AAA@A@@@AAA@@@@@@@@ABA@A@@CACAC@AAGAC@GAA@CAC@A@EAA@C@CAA@EAEAAA@B@@@@A@@@@B@B@@@B@B@B@@A@A@C@A@C@A@C@@@@@AB@@@B@@@BA@A@C@@@CAE@C@EDEDCBABGAICE@GC@C@CBE@C@A@CAA@E@CAC@A@E@AAC@A@ABABA@CBC@CBA@@@A@A@A@AAA@ACACCAAA@CACA@AAA@@@A@AB@@A@A@ABCBA@A@@BA@A@@BA@@BA@A@CB@@CBA@A@ABC@CBE@CBC@A@CBC@@@@@@B@B@H@B@@@B@@@J@FAN@H@D@L@B@D@D@D@@@@@@ABA@CBA@A@ABA@ABC@C@C@A@@B@@ABABAB@@ABA@@B@BAD@"],encodeOffsets:[[133860,47843]]}},{type:"Feature",id:"230822",properties:{name:"桦南县",cp:[130.553343,46.239184],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@AB@@@@A@@@AA@@@@@@@@A@@@@@A@AB@@@@AB@@@@@@A@@@@B@@@BA@@@@BAB@@A@CB@@A@AAC@@AA@A@@A@@A@A@@AAAAACA@@@@AA@@AA@@ABA@A@@@A@@@A@@@AA@BA@A@@@A@A@AA@A@@ACBA@A@@@@BB@@@BBA@@B@B@@@@A@@@A@BA@@AA@@A@@BAB@@@BBBA@ABAA@@AAA@A@A@A@@BA@@BA@AAAA@A@AA@@@A@A@AAA@A@CB@@A@EAC@A@A@A@@@A@A@A@@BA@@@ABA@A@@BABB@@@BBB@B@@BABA@ABA@@B@B@@B@B@@@D@@@@@BBA@A@ABA@A@@B@@@BBB@@@B@@ABC@A@C@A@AA@@BA@@@@@AA@ABA@@BA@AAA@A@@@@B@@BB@@BBAB@@A@A@@@AAAA@@ABC@@BA@@D@B@BA@ABA@A@A@A@A@A@@BA@@B@@A@A@ABABAB@@A@A@A@@BB@@B@B@@A@AAC@@BA@AB@@A@@@@A@AA@@@A@ADABA@A@ABA@ABA@@@AAC@A@A@@@@B@B@@A@A@A@C@C@A@A@C@A@ABA@C@A@AB@@@B@@B@BBB@@BB@B@@B@@AB@@@BB@@@@@@@@BA@C@A@A@@BA@A@@@@ABAA@A@@BA@ABA@C@C@ABA@@BA@BB@@B@BB@@@@@@C@@BA@A@@BAD@@A@A@A@A@@B@B@B@BA@A@@@AAA@ABB@BB@@BB@BAB@@BBB@BA@@@@B@@B@@AB@@A@A@@@@BB@@B@@A@A@@AA@A@@BAB@@@BB@BB@B@BA@A@AB@@BBA@AB@@A@A@@@@A@A@@CAA@A@@@@B@B@@A@@AA@@@A@@@ABA@A@AAC@A@CBCBA@A@@B@B@BBBBBBBA@@BA@AAA@AAA@A@AB@BA@A@@BABA@A@A@CBCBC@AB@@A@@AA@@@AAAB@@A@@BA@@@A@AB@@BB@@B@B@BA@BB@@BA@A@@BA@@@@B@@BB@@@BCD@BAB@@AAA@@B@@ABA@@@A@@A@AA@@@A@AB@@A@A@AB@@@BA@@@CA@@@B@@@@BB@@@BC@@@@B@@AB@@@@AAA@A@A@ABA@@@A@@A@@AA@@ABA@@@@@@A@@BA@A@@@A@@A@@@A@@B@BA@@@A@A@@A@A@@AA@@C@A@@BA@@BA@@B@@@B@@BBB@@B@@@@A@A@A@@AAAA@A@A@A@A@@@@@@A@A@@A@@BA@@B@@C@AB@@@@@@BB@@BB@@@@ABCBA@ABA@@@A@@BB@B@@B@@A@ABA@A@AB@@A@@@@AA@@@C@A@A@A@@@@B@@@B@BA@C@@@AA@@A@A@AAA@@@AB@@BBBBB@AB@@@@A@A@ABA@@BB@@@BBB@B@@BA@A@ABC@@@@@BB@@@@@B@@A@A@@@@@BBB@B@BB@@B@AB@@C@@@@@@BB@@@@@@B@@@B@@@B@@@@A@@@@BA@@@A@@AAA@AA@@@@BA@@BB@AB@@A@@@@AAB@@B@@@D@BB@@@@BBA@@BA@@@A@@@@@BB@@A@A@@@@@AB@@A@@@@AB@@@@@@AA@A@@@A@@@@A@@@@A@@@AB@@@BA@@A@@@@@A@A@@AA@@A@A@@@ABA@A@AACA@@A@AB@@@BB@@B@@@@AB@@@@@@@@BBB@@@@@@BA@A@A@A@A@AB@AA@A@@@AB@@@B@@AB@@A@@@@A@AA@@@@@AB@@@BB@@@@B@@A@A@@@BB@@A@@@@@AA@@A@@@A@@B@@BBB@@BA@@@@BA@A@@@A@@@@@A@@AA@A@@@AB@@@BA@@@@@A@AA@@@AA@A@@BA@@BA@@@A@A@@AA@ABA@@@AAA@A@@@A@A@A@ABA@@B@BBB@@@@@@ABA@@@A@@BA@AA@@A@@BA@A@@@AA@ADA@@@@@ACAAAA@@@ABAB@@AAC@AAA@AB@@@@@B@@ABA@@@A@@BBB@@ABA@@@AA@@@AAA@@A@@B@@BB@BA@ABA@@AAAA@A@@@@@@BBD@@A@ABA@@BA@@BB@@BA@@@A@C@@@A@@@A@@BA@@@A@A@@A@AA@AA@@B@BA@@@@@@AAA@A@@@AB@@@BB@@B@@ABA@ABA@@@AA@@AA@@@@C@A@CBA@@@A@@AA@AA@@A@ABA@A@@@ABA@@@A@CAA@AA@@A@CB@@@@A@@@CB@@A@AA@@A@A@@B@@@B@@AB@@@BB@@@@@@BA@A@A@@BA@@BA@@A@AA@@@A@@@@@@B@@@B@@BB@@@BABABA@@@@@AA@@AAA@@@A@A@@@ABA@A@A@@BAB@B@@@@B@@@B@@@@@@B@BA@A@@A@@AA@@AB@@@B@@@@A@@A@@A@@@@@@BA@@@A@A@A@A@A@@@AA@@A@A@@@@@@BB@@BA@@B@@A@A@@A@@@AA@@@@@@@A@BB@B@@@B@@A@@@AAA@@@A@@@@AA@@@A@A@@@@@@BBBBB@@A@AAA@AB@@@B@BA@@@CBA@ABABA@AB@@@B@@BBBBB@@D@@@BA@CB@@@@@B@B@@@B@@A@A@A@A@@@AA@A@AA@@@A@@BAB@B@@AB@@ABA@@@@@A@AAA@A@A@@@@@@B@B@@@@A@A@AB@@@@@B@@B@@@B@@@B@@@@@@B@@@BA@@@A@CAAAA@A@@AA@@@A@@BA@A@@B@B@@@@@BB@B@@A@@B@B@@@@@AB@@AB@@AB@@AA@@A@A@A@@BA@@B@@BB@B@B@BA@@BA@B@@BB@B@B@BBAB@@C@CAA@A@@BA@CB@@@B@BA@@BC@A@@B@@@B@B@@@BB@@@@B@BA@A@@@AAA@@@@B@@B@@B@BA@@B@@@@ABA@@@@B@@@@@B@@A@AB@@@B@@ABA@AB@B@@BBB@BB@@ABA@@@A@@AA@AAA@@@AB@B@@@BA@@@@@@AA@@@A@ABA@@@AB@@@BB@B@@BA@@BA@AA@AA@AB@@AD@@A@@@A@@A@AA@@@@B@@@BBB@@A@A@A@@BA@ADA@CBC@ABCBA@@B@@@BB@@@BBBAB@BB@@@@AB@@C@A@A@@BB@@@BBB@@@@D@@B@@@B@B@@BB@@BB@B@@B@@@BA@@AA@AA@@A@A@@BA@A@@B@@@B@B@@BB@@@BA@@AA@A@A@@@AB@@@B@B@B@@A@@@A@A@AAA@A@@@@B@@@@@B@@B@@@@BB@BBB@@@B@@BA@A@@@AB@B@@A@A@@B@@@@@B@BA@A@A@A@AB@@@@@BD@@B@@@@ABA@@B@@@@ABA@@@@BAB@@AB@@@B@BB@AB@AA@AACAA@A@@@@B@@BBB@BF@@BBA@@@@@A@A@A@AAA@@@AB@B@@@BA@AAA@@B@@@@BD@@BBB@BBB@@@@B@@A@@@A@AACACCA@A@A@CB@@@@A@@ABC@@@@A@@@AB@@@B@B@@DDB@@@BB@B@@AD@BA@@@A@A@AB@@@B@@A@@@CA@AA@@B@@BB@@ABA@A@CBA@A@@@A@AA@C@@A@A@A@@B@BABA@C@AAC@A@C@A@ABA@@B@B@B@D@@@BD@@B@BA@A@A@C@ABA@@BAB@BA@@@C@A@A@ABABABCBAB@B@B@BAB@@C@A@ABABAB@@AB@BA@ABC@ABABCB@B@BB@@BD@D@@BB@AB@D@BA@ABA@E@C@A@@B@@BDBBDB@@@BA@@BABA@C@A@@@@B@@@BB@B@F@@B@@@BCBCBCBE@EBA@G@CBAB@B@BAD@@ABA@@@CACAAAABA@@@@B@@BBBBBB@@ABA@AB@@@@@BB@@BB@BB@@AB@BC@A@C@A@@BA@@B@BAB@BA@AB@@@B@@@BA@A@@@@B@@A@A@A@AB@@@B@@BB@B@B@BABABAB@B@B@@@B@BA@@@A@@@CAAA@@@AB@@A@@AAA@A@C@A@ABABCBCDCBA@C@A@A@AB@@A@AB@@BD@BA@ABA@A@A@AAAAAAA@@BA@@BABA@A@@A@@@C@@AAA@ABA@@B@D@@@@A@AAA@A@@@A@@BBB@B@@AB@@A@A@A@A@A@A@CBA@A@AAC@AACBA@AB@B@BBD@B@BCBADAB@B@B@BA@@@A@AAA@@B@B@DAB@BA@A@AAA@CAAA@@A@AB@@@B@@BBB@B@DB@@@B@@@B@BA@ABA@C@A@C@ABA@CBA@A@@B@@@B@@ABA@@@@B@B@B@@BBB@B@B@B@@@@B@BABB@@BB@@@BA@@B@@@@BB@@@@@@@B@@@@@B@@BB@@@@@@@BB@@@A@@@@@@BA@@B@B@@BB@@@@A@@B@@@@@@@@B@@@@@B@@BAB@@@BA@@B@BA@B@@@BB@@@@A@@@AB@@@@B@@@BA@@@B@@@B@B@@@@B@@B@@@@@@@B@B@@@@@AB@@@@BB@@@A@@@@B@B@@A@@A@A@@AB@BB@@@@@A@@B@@@@B@@B@@@@@@@@AB@@@@B@@@@B@@@B@@@@@@@@B@@A@@@A@A@@B@@B@@@@A@@@BB@@@B@@@@@@B@@@@B@@@@@@@@BABB@@@B@FBB@@@@A@@@@A@@@@AB@@@B@@B@@DA@@BB@@@B@@B@@@@@@AB@@@AB@@B@@@B@@BB@@AA@@@@A@@B@BBBA@@A@@@@A@@@@B@@@BB@@B@FCDA@@@@B@@BA@@BA@@@@@@BB@B@B@@@B@B@B@@A@@@@@@A@@@BA@@@@@A@@@BA@A@BA@A@@B@BC@@BA@@@@@@@@@@@B@@B@@@B@@@A@@@@A@@B@@@@@@@@A@@@@BB@@@AB@BB@@A@@@@@@B@@@@B@@@B@@@B@@A@@@@@@BBB@@@@A@@@@@A@@B@@@B@@@AB@@@@@@B@@@@@B@@BB@@@@A@@@@B@B@BBB@BBB@@A@@BAB@@@@@@B@@@@@A@@D@BBB@@@@@BA@@@@BA@BA@@@B@@B@@@@D@@@@BB@B@@@D@@@@@@BA@@@B@@B@@@@B@B@@@B@@@@BA@@@@@B@B@@@B@@@B@@@@@B@B@@@@B@@B@@@@A@@B@@@@@@@BA@B@@@@@@D@@@@A@@@ABABAB@@AA@@@@A@@B@@@BBD@@@@@@A@@A@@@A@@A@@B@B@@@AA@@B@@@BAA@@@@@A@@B@AA@@C@@A@AB@AA@B@BAB@BB@@B@@AB@B@B@@BBA@@BA@@@@B@B@@@@@@A@A@@@AB@@B@@B@@@F@@@B@@A@@A@A@A@A@@@@A@@B@@@BB@AD@B@@@@A@@A@@@A@@@@@@A@@BC@@@@@A@@A@@BABA@A@A@A@@A@@@@B@@@B@@@B@@AB@@A@ADAB@@@@B@@@BA@@@@@@@@@D@B@B@BAD@B@@@ABA@@@AB@@@@@@D@@@B@@B@@B@BAB@B@BA@@B@@BB@@@CBA@AB@@B@B@@@@@@B@@B@@BA@@B@@@@DA@@B@@@@@ABA@@@@B@@@BB@@@BA@@@ABA@AB@@B@@@@@@A@@B@@BB@@@@B@@A@@BB@@DA@@B@AB@@A@@BB@@B@@B@BAB@@@@B@@AD@@E@@@@@@B@@@@BAB@@@@B@@@B@B@@@@A@@@AB@@A@@AA@@A@B@@AB@B@BB@@@BBBB@@@@FC@@@@@@@D@@@@D@@@BB@@@BA@@@@@@BB@@BB@@@@BB@@@@BD@@@@@AB@@@@@BB@@@B@B@@@@B@@@BB@@@BA@@@@@@BB@@@@B@@@B@@@@C@@B@@BB@@@BA@@@A@@@@B@B@BA@@@B@@B@@@@B@@B@@@BB@AB@@@B@@@B@@AA@@A@@@ABB@@B@DC@@@AB@@@B@B@B@B@B@@BAB@@B@@@@@B@@@B@@@DA@@B@@BAB@B@BB@@BB@@@@@@A@@@A@A@@BBB@BB@@@@AB@B@@@@B@@@@@@AB@@@BB@@@@BAB@@@@B@@AB@@@B@@B@@B@@B@@@@@BB@@@@BA@@@@@B@@B@@@@AB@@@@@@B@B@@@@@B@@@@@BB@@@@B@@@B@@@@C@@B@@@@B@B@@@ED@@B@@@@B@@@@A@A@@@BB@BDABB@DD@DBB@B@@AAABAHBBB@@ADB@BB@A@AB@DF@@A@AB@@@B@BB@BABBB@AB@@C@C@@B@BAB@D@@@BB@D@@BCH@B@BDDB@B@B@BAB@B@BBB@B@B@@A@@D@B@BDB@D@DB@@@B@@@@AD@@BBB@D@@@BBBD@DBDBD@BAB@BBBAFBD@DDB@BAFBD@BA@CBABAFIFCBCBABABADCBAB@B@@DFB@@BA@@HA@@@A@ABA@@@A@AB@@@@@B@BDA@@@@B@@@D@@@B@B@B@@@B@B@BBB@B@@@DB@@B@B@@@B@@@B@@@@@B@@@DAB@B@DAD@B@BAB@B@B@B@B@@@B@@BD@B@@@B@@BB@@@B@@@@@B@@@@A@@B@@A@@@A@AB@@ABA@A@@BA@@@A@@B@BAB@@@@@B@BAB@@@DB@@@@BAB@@@B@B@B@B@D@B@@@@BAB@@AB@@AB@BABA@@BAB@@@B@@@@@B@@A@@BBDBB@@@BA@ABA@@@@BAFAB@BA@@DAB@BBD@B@BBD@@A@@@@B@@@@BB@@@@AB@@@@B@@@@BA@@BAD@@@D@@JL@@BD@@DDBB@BBH@@FD@@BB@BBBDF@@@J@B@HBF@H@J@@@@@BB@D@@@@@B@@B@D@BAJ@B@DFLBF@@F@AF@D@BAB@BA@@DAD@B@B@BA@E@AB@@ABCB@BA@@B@@@B@@B@DF@@@D@B@@ADADAB@B@@A@@@A@BBBB@BBBBBB@@BDBB@DDB@DB@A@@@@@A@@BA@@@A@@BA@@@A@AB@@A@@B@@@@AB@@AB@B@BAB@B@BA@AB@@A@@@A@@AA@A@@@A@@BA@ABA@AB@@A@AA@@A@@@A@@BA@@B@B@BAB@@@BA@A@A@A@@AA@A@@@@@A@@@@B@@@@@@@BA@ADABCBA@@@A@@AA@@@A@@@AB@BABAB@@AB@@AB@@A@A@ABB@@DBB@DBDBB@@BDB@@B@BB@@@B@B@BBB@@BBB@@B@BBD@@@@@BB@BAB@B@DAB@B@B@B@D@B@B@B@BB@@H@B@DA@A@@AA@ABADABAD@DA@@@@BA@@@@B@@AB@B@B@@AB@B@D@B@B@B@BBB@D@B@D@B@D@B@B@D@@AB@BABA@AB@BABAB@@@BA@AB@@A@@@A@A@@@C@A@A@@BA@AB@B@@AB@@@B@@@B@B@@@@@BA@@B@@@@@@@@@B@@@D@@@BA@@B@@A@ABA@@@@@@@@@A@@@@BA@@@@B@B@@@BA@@@@B@@A@@@@B@@ABABA@@B@@@B@@@@B@@BB@@@@B@@@B@B@B@@B@@@@@BB@@BB@BBB@BB@@BB@@@BBB@@@@B@@@B@@ABA@@@@@AB@@A@@@@B@@@@A@AB@@@A@@A@@B@@@@@B@@@BA@@B@B@@B@@B@@@BB@@@@B@@@BAB@BB@@B@@@BB@@B@@@B@@@B@@@B@@@B@B@B@B@B@@BB@@@BB@BB@@BBBB@@@@B@@@@@@BB@@@@BB@@BB@@@B@B@@@@@@@@B@@@@@@@@BBAB@B@@BD@@@BBB@@@@BB@@@B@BAB@B@B@@@BBB@@@B@B@@@D@@@BB@@B@B@@@DA@@B@B@B@B@B@@@@@BA@@@@BA@@B@@@@@B@B@@@BA@@BA@@B@@@@@B@@@B@@@B@@@B@@@B@@@B@@@BA@@BA@@B@@@BAB@B@@AB@@@@@@AB@@A@A@A@@@AB@@A@A@@@C@@@A@@@@B@@@@@@@@@@@B@B@@@BB@@B@@@B@B@@BB@B@B@B@@@B@BB@@B@@B@@B@@BB@@@B@@@B@@AB@BA@@DA@@B@@@B@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@B@@@B@@@@@B@@@@BB@@@B@@@B@@@@@B@B@B@@@@B@@@@@B@@@B@D@B@B@@@B@@@@@@@@@@B@@B@@BB@B@@@B@@BB@@@B@@BBB@@BB@B@@@@@B@@@@@@@BA@@@BB@@@@@BA@@@ABA@AB@@@B@@@B@@@BB@@@@@BB@@B@B@B@B@B@@@B@@@B@@B@@BB@@@@BB@@B@@BB@@@B@@@B@@@BBB@BB@@B@@@B@@@BAB@B@@@B@@@B@B@@@B@B@B@BAB@@A@@@@@AAA@@AAAA@@@@@A@@@@@@BA@A@A@A@AB@@@B@@@B@BBB@DBB@B@B@D@@@BAB@@@@A@@@@@A@AA@@@@A@@A@@@@@BA@@B@B@@@@AB@@A@@@@@ABABA@@BAB@B@BAB@B@@@@@@AAA@@A@@A@@B@@@BA@@BABAB@@A@AB@A@@A@@A@@@A@A@@@@@AA@@@@AAA@AA@A@@@@@@@@BAB@BA@@@@@@BA@@@@@A@@@@BA@A@@@@@AB@@@@A@@B@BADABABAB@BABAB@BAB@BAB@@AB@@ABAB@B@@@BAB@@@@@B@BAB@BA@@@@B@@@@@@AB@@@@@@@B@@@@@@@BBB@@@B@DABA@@BAB@@@B@@@B@B@@@BBB@B@BBB@BB@@B@B@BBB@@@@@B@D@D@BBB@B@B@@@B@BAD@B@B@@@B@B@@BB@B@BB@@B@@BB@@BBBBBBB@@DBBB@@B@@@BBB@B@B@@@B@@B@@@@BBB@@@@BB@BDDBBBBBBBB@BBDBBBBBBB@@BBB@BBB@BB@@B@@@BAB@@AB@BA@@BA@@B@@@B@B@BA@AB@BAB@BAB@BAB@BA@@B@@@BA@@B@@@BA@@B@BA@@B@@@BAFA@@B@@@B@@@DBB@B@B@@@B@@@@B@@@@B@@@@@@AB@@@@@BA@@@@@@@@BB@@B@B@B@@@B@@BB@@B@@B@B@@@BB@@B@@@@AB@@@B@B@@@B@@A@@B@@@@@@@B@@AB@@@B@@@BBB@B@@BB@B@@@BB@@@@@@@@@BAB@@AB@@@B@@@@@BB@B@@@@BB@@B@@B@B@@B@@BB@B@@B@@BBBB@@@B@@@@B@@B@BBB@BBBB@@BB@B@@@B@@@B@@B@@@@BB@@@@@BB@BBBBB@B@@@B@B@@@B@@@B@@@B@B@@@@@B@@A@@B@B@@A@@B@@AB@@AB@@A@A@A@@@AB@@@@@@@D@B@B@@@BB@@@B@@BB@@@B@@@@@@@@B@@@@BB@@@B@@@B@@@@B@@@B@@@B@@@@@@@@@@@@BB@@@@@BB@@@B@@B@@@@B@@@@@@BB@@@@@BB@@@@B@@@@B@B@B@@@B@@AB@@@@A@@@ABA@@@AB@@AB@@@B@B@@@B@@AB@@@B@@@B@B@@@B@@BB@@@BBB@@@B@B@@B@@BB@@@@@B@@BB@BB@BB@@B@@BB@@@@@@AB@@@@A@@B@@@DC@@@AB@@@B@B@B@B@B@@AB@@@@@BB@@@@BB@@@B@@BB@@@B@B@@@@@B@@@B@@B@DBD@@@B@@@B@@@@@@@B@@@@@B@@@@@@@@@@@@@B@@BB@@@B@B@@@B@@@BA@@B@@ABAB@@@B@@@@@B@B@B@BAB@B@@@BB@@B@@@@@BA@A@@@AB@@A@A@A@A@@B@@AB@B@@@BA@AB@B@@AB@@@@@B@B@@@BAB@@@B@BA@@B@@AB@@@@@@A@@@@BA@@@@@@@AB@@AB@@@BADAB@BA@@@@@@B@B@@@B@B@BA@@D@@@B@B@@@D@B@@@@@BA@@B@BA@@B@@@DB@@@@BB@@@@B@B@@BB@D@BBB@B@BB@@B@B@B@BB@@BB@@B@@BB@@@B@BBD@BBD@DB@@B@B@B@BAB@B@@@B@B@@AB@B@@@B@B@B@B@@@@B@@@B@@@B@@B@B@@BB@@@@@BAB@B@B@BAB@B@B@B@B@DAB@@AB@@@DAB@@@B@@@@@B@@@@@@@B@@@B@@@B@@@B@@@BBD@BB@@B@B@DB@@B@@@@@B@@@B@@@BA@@BA@@@AB@@A@@@@B@@@@@@AB@@@@@B@@@@@@@@@B@@@@@BA@@BA@@BAB@B@@@B@B@BA@@B@BADAB@@@B@D@B@@@BAB@BAB@@AB@@@BA@@BA@@BA@@@@@@@A@AA@@AB@@@BA@@@@@A@@B@@A@@BA@@@@@@BA@@@@@@@A@@@@@AB@@@@A@@BA@@@@@A@@@@@AB@@@@A@@@A@@@@@A@@B@@@@@BA@@@@@@BA@@BA@@@@B@@A@@@@@A@@@@BA@@@@@@BA@@@A@@B@@@@@@A@@@@@A@@A@@@@AB@@@BAB@@@BA@@B@@AB@@@@A@@@A@@@@@A@@@@@AA@@@@A@@@@AA@@@@B@@A@@@@@AB@@A@@B@@@@A@AB@@@B@BA@@B@@@@A@@@AA@@@@A@@@@BA@@B@@@@AB@@@@@@@@A@@@AA@@@AA@@@@@A@@@A@A@@A@@A@@C@A@@@A@@A@@@A@@@ABA@@@AB@@A@@@A@@B@B@@@DA@@B@@@BA@@@A@@@A@@@A@@@A@@@A@@@A@@B@@A@@BA@@@@@A@@B@@@B@@@B@B@@A@@@@BA@@@@BA@@B@@@B@@@B@B@@@B@B@@AB@DA@@@@@@B@@@@@BAB@@BB@F@B@BA@@B@@@BA@@BA@@@@@@B@@@@@@@@@B@@@B@B@BAB@@ABAB@@@BAB@BAD@B@B@@@B@BAB@BAB@BAB@BABABABABCBAB@@ABA@@@A@A@A@@@A@@@A@@@A@A@@@A@@AA@@@C@A@CAAAC@@@A@A@A@@@A@A@AA@AA@@@AAAAA@@A@@@@A@@AA@@@@@@@A@AAA@AAA@AAA@@@@@@@A@@@@@@BAB@BAB@@AB@@A@A@@@@@@@C@@B@BAB@B@D@B@BAD@BAB@DADABADAD@DAB@B@@AB@BAB@B@B@DADAFAB@DAB@BA@@@A@A@@@A@@@A@A@@@A@@A@@@@@AA@@A@A@@A@@A@AAA@@A@@B@BA@@BA@@@A@C@CBA@AB@@A@A@@AA@A@@@ABA@@BAB@B@@@D@B@B@B@B@B@DA@@BABABA@@B@B@@@BA@@@@DAB@@A@ABA@@BA@@B@BAB@@@BBB@@@@BB@@BB@@@BBB@@B@@BB@@@BB@@BB@@@D@@@B@@@B@@@B@B@@@B@@@BA@@@A@@@@B@@@@@B@DAB@B@@@B@DB@@@@@@BA@@@A@@BA@@BAB@B@@@BA@AB@@@BBB@@@B@@@B@B@BAB@@@@@B@@AB@@@B@B@B@@@@@BBB@@BB@@@BB@@@@BB@@BB@@@@@@B@@@B@B@@@B@@@D@@@B@@@B@B@B@@@@@B@BAB@B@@@@@BA@@@A@A@A@@@A@@@@BA@@B@B@@A@@@@@ABAB@@A@@B@@AB@B@@@B@@@B@B@@@@@B@@@@@B@@@@AB@@A@@@ABA@@@AB@@@@A@@@AA@@A@A@@@@@@B@BAB@@@B@@BB@@@BBB@B@@@B@@@B@B@@@BAB@@@@@B@@@B@@AB@B@@AB@@@@AB@B@@ABABA@A@AB@B@@AD@@@BBB@@@@@B@@@B@B@BB@@B@@@B@BABA@@B@@@B@@@B@BB@@BBBB@BB@B@@BB@B@BBBBB@B@@@B@B@@@@AB@BAB@@AB@@@B@@@B@BB@@BB@@BBBB@@@@B@@B@@@B@@B@@@B@@@BAB@@@B@@AB@BAB@@@B@B@@A@@B@BAB@BAB@BA@AB@@@B@B@@@B@@@BA@@@@BABA@@@@BA@@@@B@B@@AB@B@@@@AB@@@BA@@@@B@@@@@B@@@B@@@@@B@@@@@B@B@@AB@@@B@B@@@B@@AB@@AA@@A@AA@@@@A@@BA@@@@BABA@@B@@A@A@@BA@A@@B@BC@@BA@@BAB@@AB@B@@A@@@@@A@@AA@@AAA@A@A@@@A@BA@@@A@@@@@@B@B@B@@@B@@@B@BAB@BA@@D@B@P@DABABAACGEOCCEAA@CDE@GDAFARCBANCJ@ZBJAJCNEFCLMBEBEFCNIFAJAJ@FAFCDEDETIFCDEBA\\MWcv[GCEEIKMUKS]GAKAEG@GAAASNSBKO@MBKVHJFLBF@@YLSCI@WuB@EE@CGHUDCFADKHOGMHQDKMOcDQCKIDIFECEECWEIGCQ]UAFAHBJCDDJBLDNELCRHDBD@JEN@HEJEFCPIBMBGHCFKAEBIAQCIEIECECEICAC@GDGEEGGIBODGDIBIDM@Q@MCK@GG@E@IEGECCKã¼AAA@@@AAA@A@@@@A@@A@@A@A@A@A@@AA@@@@@A@AA@@A@A@@B@@A@@@@@A@@@@A@@A@@A@A@@@A@A@@@A@@@A@@@@@A@@AA@@A@@A@@@@A@@AA@@B@@A@A@AB@@A@@AA@A@@AA@@@@AAAA@@AA@@AA@@@A@A@A@@@A@@@A@A@@@A@@@@A@@A@@AAA@@AA@@AAA@A@@AA@@AC@@A@@AA@@@C@A@G@C@A@@@A@A@A@E@A@A@ABAAA@@@AAA@AB@A@@@@@@A@@A@@A@@@A@A@@@A@A@A@@@ABC@ABA@A@E@ABC@C@A@A@@@A@A@A@@@AB@@A@@@A@@@A@A@A@A@A@CAC@CACAA@@@A@@@@BA@@BA@AB@@@@A@@@A@@@A@@@@@A@@AA@@@A@@@@@A@@B@@A@A@@BA@A@A@@@A@@@A@@@AA@@A@@@@@AAA@@A@@A@@@A@@AA@@@A@@A@@BA@@@@@@@AA@@@@@@@A@@@AA@@@@@AA@@@@@A@@@@@A@@@AA@@@@@@A@@@@AA@@@A@@@AA@@@@@@A@@A@@A@@@A@@@ABA@A@ABA@ABA@@@A@AB@@A@@@@@AA@@@@@@A@A@A@@@A@@@A@@BA@@@ABA@@B@@@@A@@@A@@@A@A@A@@@@@A@@BA@@B@@@B@@@B@@@@AB@@A@AB@@A@ABA@A@@@AB@@A@@@A@@@A@@@AB@@A@@@@@A@@BA@@@A@@@AA@@@@A@@@@@AA@@A@@AA@AA@@A@@@@@@@AA@@@@@@AB@@A@@@AB@@@@A@@@@@A@@B@@@@@@AB@@@@A@@@A@A@@@A@A@A@A@@AA@@@@@A@@A@@A@@@A@@AA@@@@@@@A@A@@@AA@@@@A@@@@@A@@B@@A@@@A@@@@AA@@@@@AA@@AA@@@@@AA@@@@@A@A@A@@@A@@@AA@@A@@@A@@A@B@@@@@B@@AB@@@@AB@@A@@@@BA@@BA@@@@@A@@BA@@@A@@@@@A@@@@@AACA@@@@AA@@A@@AAA@@@AA@@@@AA@@@AAA@A@@@A@@A@@A@@A@@A@@A@@A@@AA@@@@@@AA@@@@@A@@B@@A@@@@@AA@@@@@@A@@@A@@B@@@@A@@@A@A@A@@@A@@@@@A@A@A@A@A@A@@@A@@@A@@@A@@@@@@B@@AB@BA@@B@A@@A@@@A@@AA@AA@@@@AA@@@@@A@@@@@@A@@@A@A@A@A@@@AB@@@@A@@@@@@@AA@@@@A@@@@@@@A@@@AB@@@A@@A@@AA@@@@@A@@A@@ABA@C@A@A@@@A@ABC@A@AA@@@@@@@@BA@@@A@@@@@@@AA@AAAAA@AAAAA@@@@AA@@@AA@@AA@@A@@A@A@@@A@@AA@A@AAA@AA@A@@@A@A@@@@@@@A@ABA@A@@@A@A@@@@@A@@@AB@BAB@@@@@BA@@@ABA@@@ABA@AB@@AB@@A@A@A@A@ABC@G@I@G@A@CA@@A@A@A@@@A@@@A@CAA@A@A@@@A@A@A@A@A@AA@@AACAA@AA@@AAA@AAA@AA@A@ABA@A@@@A@A@AAA@@@AB@@@B@@AB@@@@A@@BAB@"],["@@@EHu@A@CACIGKCQCEC@IAKGCKA]CMTGPER@LBJHDv`JNCLEDEDEDKHMHENCLIDE@BMGCAECMEGIGI@EBAHJdP|LMFGHCHCF@HBJ@D@D@@ADEBADCDAHIDAB@D@JBDDJFHDD@F@FABADABCFEDAD@H@L@H@HDFDD@D@LAJBFAD@BABC@EGCGCGCGEECIE_EEAKEOIAEBC"]],
+encodeOffsets:[[[133832,47087]],[[134733,47297]]]}},{type:"Feature",id:"230828",properties:{name:"汤原县",cp:[129.905072,46.730706],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@A@SAA@C@E@C@E@GAA@@a@@E@E@AF@F@D@D@BBDB@@BA@@@@DABCHA@@@EBABIDQBQA@B@D@@AB@B@@@B@B@B@DAB@D@BAD@FB@VBHBXB@@B@D@BB@BAD@FAFAFAD@B@FA@@FAB@B@@@DAB@DAD@B@BC@A@EAQAGACAOAKAEAE@A@AA@@A@@@@@@@@@E@G@BC@@@@@@@@@A@ABA@C@ABA@A@A@A@@BA@@@A@@@@@@@@@@AA@@A@AA@@A@@@@@@@AAGCGAEAC@AA@A@ADA@A@AAAECAACAAAC@C@GBCBBA@A@AB@@@@ABA@@BA@AB@BA@@@@BA@ATMB@B@JBB@DCFEBA@@AA@@@@@@@@@@A@@@@@@@@AA@@@@@@@@@@@A@@@@A@@@@@@A@@@@@@@@@A@@A@@A@@@AA@@@@AA@@@@A@@AA@@@AA@@@@A@@@@@CA@@CAKECAAAA@@B@@A@@@@@AB@@@@@@@@@@@@@@A@@@@@@@@BA@@@@@A@@@@@@@@@AB@@@B@@@@@@A@@@@@ABA@A@@@A@AAA@A@A@A@A@AAC@A@A@A@A@A@@@A@C@@@A@@@@@@@A@ABABA@@@ABA@A@C@A@@@@@@@CBA@A@A@AB@@A@A@A@@@C@A@A@A@AB@@A@@@@B@@A@@B@@@@@BA@@B@@@B@@AB@B@@@B@@@@@@AA@@@@@@@A@A@@@A@@@@ACAA@A@@A@@BA@@BA@@BAB@BA@@BA@@BA@@BCB@@CDCDA@@BCDABCDABA@@B@@AB@@@BA@@@@BA@@BA@@B@@AB@@@BA@AB@@AB@@@B@@A@@BA@@@@B@@A@@B@@@@A@@@AB@@A@@BA@@@ABCB@@@@AB@@A@ABA@@@A@A@@@@@A@@@@@A@@@@@@@@@@@@@A@@@@@@@@@AB@@@@A@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@AB@@@@@@@@AB@@@@A@@@@@@@A@@@A@@@@BA@@@@@@@@@@BA@@@@@@@A@@BA@@@A@A@@B@@@@A@@B@@A@@BA@@@AB@@@@A@@B@@A@@@@@A@@B@@@@@@A@@B@@EDABEBEBA@CBCDCDCDABA@@@CECCCCACA@@@DCFE@@AAEA@AA@AAA@AA@@AA@AAAA@CB@@A@KFeNGDMFA@C@CBC@C@@@ADCBCBA@CBB@@B@@@BEDEBEBC@A@@A@@C@ABC@GBC@IDE@ABG@CBA@A@@HhCBHLBGF]BHFWHdCGFHBABDJAHL@JDGJXEHCFIHGRIVAHFIFOJOJHDLBDCNGHCBAPGRFFC@CLE\\G@CLCJC`PSJ\\LBGdYPBNSNSHFBKHALMbIA]tIFMDMVDBCFGLFBJJSN]LT@RVIFCHbDFDA@A@A@BB@BB@@A@@@@B@BA@@B@B@@@@@@@@BB@@AB@@@@@B@@@B@@@B@BAB@@@B@@A@@@A@@@@AA@@B@@A@@@@B@@@B@@@@@@AB@@@@A@@@@@A@@B@B@B@@@@@@A@@@@AA@@B@B@@@@@@@BB@@@@B@@@@A@@B@@@BA@@@@@@B@@@@@@A@@@@BA@@A@@A@@@AA@B@BA@@@@@@BA@@@A@@@@B@B@BA@@@@@@@A@@@AB@@@@A@@D@@@B@@@@@@A@@B@@@@@B@B@B@@@@@@A@@A@B@@AA@@@@@BA@@B@@A@@DB@@BA@@A@@AB@@@@@@A@@@AB@B@@@B@B@B@@A@@B@BA@@@@@@BA@@B@@@B@@@@AB@BBB@BBB@@@B@@B@@@@A@B@@@B@@@B@@@@@A@@A@@B@@@@@B@@@B@@@@@BC@A@@B@B@@@@@@B@@@@@@@AB@@@B@@A@@B@@B@@@@B@@@B@AB@@@@B@@@@@@@@@B@@@@B@@BA@@@BBA@@@@@@BB@@@@@@BA@@@@B@@@BA@@@@@@B@BA@@A@@@@@@A@@@@@@B@@@@A@@@@@@B@@@@@@@@@@@B@@@@@B@@@@@@A@@@@A@@@@@@A@@A@@@@@BA@@@@BA@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@B@@B@@@A@@@@@@B@B@@@@B@@@@B@@@@@@@@@@@@@@@B@@@B@@@@BB@@@BA@@@@BAB@B@A@B@B@@@@A@@@@@@BA@@@@@@@@@A@@B@@@B@@@@@BA@@D@@@B@@A@A@@@@@AB@@@B@B@BB@@BB@@@@DA@@B@@@B@B@BB@@@@BB@@BA@@BAB@@@B@B@B@@@B@BC@@BAB@@@B@B@@@@AB@B@@BB@D@@A@@B@BA@@@AB@@@@@DA@@D@D@B@B@@ABC@@B@@@B@BBB@B@@@@AB@BA@AB@@AB@@BB@@@BB@B@@@@HAd@B@DA@@@@F@D@DAB@B@FAH@BAD@B@D@B@BAB@PB@@B@BBD@@BDBB@@@@BB@D@@@LAB@B@@B@FAD@B@DABABA@ABABA@ABABABCB@@AB@@@D@@@DAB@B@BAB@BBB@DBD@@@BBB@B@B@B@@BBDDBBBBBB@B@@@B@@@B@B@BA@@@@@BBB@PC@@@@B@D@HAF@DALAD@D@JAD@B@@@@@B@@@@@@@BB@@@@@@@B@B@@@B@B@B@B@B@B@BCBAB@BABABA@B@TMBOJC@UX@HAB@BCG@ADY@@GBK@E@CFGbBFQ@IFA`KANjBHat@NXJCTAn@l@b@h@LPCLTHJBNFBFEF]FE@AD\\NDBEB@BB@UBMPINSX]fB@@@@BC@@@@@@BA@@@@@@@DBD@CB@@@@B@B@B@@@@BC@@BB@B@@A@@B@@@B@@@AA@@@@BB@@@@@B@@@@B@@@B@@ABAB@@@BB@B@@B@@BA@B@@@@@BA@A@@@@B@@@@B@@A@@B@@@@B@@@@@B@@A@BB@A@ABA@@@C@@@@@@@@BB@B@@@ABC@@@@@@@B@B@B@AB@@A@@@AAA@@@A@PJDAB@@@@@AA@@@@B@@@@@@BB@@@B@B@B@@B@@B@@A@@AA@@@@@@B@D@@@@@ABB@@@@@@A@@AA@A@@B@BB@@@B@@D@@@@@@@@@B@AA@@BA@@@@BBB@B@BA@@@@@@@BB@@B@@DA@@@AA@A@@@@@BABB@@@@B@@@@@@@@A@@@@B@@B@@@@@B@@B@@A@@@A@BB@@@@BA@@@BBB@@@@@BA@@B@@B@@AB@@@@B@@@BAB@@@@@@B@@A@AB@@B@BB@@@@A@@@A@AAA@@@@BB@@BBA@@BB@@AB@@C@@@B@BB@@@BA@A@@@@@DB@@@@@@@B@@@@DAB@@@@B@B@@@@BA@@@B@@@@AB@B@@B@@@@@@@@BA@B@@@B@@@@@AB@@B@@@D@B@@@@AA@B@@@@B@@@B@@@@@B@@BA@@B@@@B@@B@@A@@@@@D@@@@@BB@@A@@@A@@B@@@B@@A@@@@@@@@BB@@@B@BAB@B@@AB@@@@B@@@@A@@@A@@@@BB@B@B@@@@@@@B@@@@@@@@BA@@B@@A@@@@BB@@@B@@@B@@@@@@@@B@B@@AA@@A@BBB@@B@@@BD@BB@@D@@B@@@@@BBA@BB@@@@B@@A@C@@AAB@@AF@@@@A@@@@A@ACBA@@@@@@@@B@@A@@@@@B@B@@@@@@@ABA@@@@@B@@@@BA@AA@@@B@@B@@B@@@@AB@@@@D@@@@B@@A@@@@@AA@@@B@@@BB@B@@@@@@BA@A@A@@@@@@BB@B@@@B@@@B@BB@@@A@@@@@A@@@@BBB@@@@AB@@@B@@@B@A@B@@AB@BB@@@@@A@@B@@@@B@@@B@@B@@@@@@AB@@@B@BD@@@AB@@BB@@@@B@@@@@@BB@@@@A@@@@BD@@@BB@@@@B@@AAAA@@A@@D@B@@A@@@@@ABB@@@B@@@@B@B@@@@AB@BB@@AB@@@@@@B@B@@@@BA@@@@B@@B@BAB@B@@B@@@@@BB@B@A@@@A@@@@BD@@@B@@@@A@A@@@@DBB@@@@@@@@@AA@@@@B@B@@@@BB@DBDBB@@@ABA@A@@@@B@@BB@B@@A@AAA@@@@@@@@BA@@@@B@@C@AB@@@B@@BB@@@@A@@B@@@B@B@@@@A@@@CAA@@B@@BB@@A@@@A@@@A@@@A@@F@@@@BB@@@@ABA@@@@C@@@@A@@@@B@@A@@B@@@BB@@B@@A@A@@AA@@@@@@BBBB@A@@B@@A@@A@@A@A@@@@B@@A@@@A@A@@@BB@@AB@@@@AA@@A@@@CC@@@@AA@BA@@@@@B@BB@@@@A@A@@@A@@@@@B@BB@@@@@@@@A@A@@@A@@@@@B@@@@B@B@@@B@@A@@A@@@@AB@@@@@@A@BA@A@@A@@@@@@B@@@B@@@@@AA@@@A@@@BB@BA@@@AB@@@B@@A@@@@@AB@@A@@AA@@@@@@@@@@@A@A@@B@@@@B@B@@@A@C@@@@@@BB@@B@@@@@@A@A@@@@@@@@B@@C@@@@BB@@@@@B@B@@@@@@BA@A@@BDB@@C@@B@@@@D@@@AB@B@@B@BB@@@@A@A@@@@@A@BDB@@@BB@BA@@@AAA@@@@@@B@@AB@@@@B@@B@@@@A@@BAB@@AA@@@A@@AA@@@@@@A@BB@@A@A@C@@B@@@B@@@@@@@@A@AA@@@@C@AB@@@@@@BB@@@B@@@@@@A@A@@@A@@A@@@@A@@B@@@@AB@@B@BBB@@BA@@@@@@@AB@@C@A@@@B@@@@@B@B@@B@@C@A@@B@@@BC@@@A@@@@@@@AAA@@BB@@@AB@@AAA@@@A@@@@@@@@@B@B@@B@@A@AB@@B@@@BB@@B@@@@@A@AB@@@@@BB@BAB@@@BB@@A@@@A@@@@@@@@B@@@@A@A@@@@@@B@@@@B@B@DA@B@@@@BB@@@BA@@@@@@B@@A@A@AAA@@BB@@@A@ABAB@@A@@@@@AA@@@@@@A@@BA@@@@@@AA@@AA@@@@@@BB@@@@B@@AAA@@@A@@@B@BB@@A@A@@@B@@@ABA@@BA@@B@@@D@@@@@@AAC@A@@@@@@BB@@@@BA@@@@@@@A@@@@@@B@@@@BB@@@B@@EA@@A@A@ABA@@@A@A@AA@@@BA@@B@@@@@B@@B@AB@@B@A@BB@@@@@B@@ABA@@B@@@B@@@B@@A@AB@@A@@@@BA@A@@@ABA@ABA@@@A@@@A@@BA@AA@@ABAD@@BB@@@DAB@@@@AB@@@BB@BB@@AB@BA@EB@@CB@BCB@@@@BB@B@@ABA@ABE@A@A@EB@BCJ@B@@BB@@@BA@C@@BA@BB@@@@B@B@@AB@@B@B@@DB@@@BHBRB`FZDFBF@@@BBB@B@@@B@BBB@B@B@@@B@B@B@B@BBB@@@F@HAF@LAFAF@DAN@B@TAPAD@HAJA@@DAJEPGPIBADALA\\GZEHCNAPGB@@@JED@F@HAHAFAHCREF@XDL@ZBLBF@B@F@FBH@F@DBD@F@FBB@F@DBF@FBD@D@FBD@FBH@DBF@FBD@JBD@HBF@FBH@FBH@FBD@B@DBJBF@FBD@D@DBF@B@DBF@DBB@D@DBD@D@FBF@DBD@D@DBD@D@@@BBB@BB@@@@BA@@@@A@@A@@@@@@@@A@A@@@@@@@BA@@@@@AA@@@AB@@@@A@BA@@@@@@BA@@A@@@@@A@@@@@@@AA@@AAA@@BB@@@@@A@@@@@A@@@AA@@@@A@@@A@BA@A@A@@@@A@A@@@@@@@@A@@@A@@@@B@@@@@@@A@A@BAB@@@@@@@@A@@@@@@@@@@B@@@BAB@@@@AA@@@@@BA@@@A@A@@A@@BA@@@@A@@@A@@AB@@@@@ABA@@BB@@BA@@B@@@BA@@@@A@@@A@A@@@@AB@@A@@A@@@@@@A@@@@ABAA@@B@BA@@@@B@@@BB@@@@B@@@BA@@@A@@@@@@BB@@B@@A@@@@@@A@A@@A@@@@@@B@B@B@@@@AA@CA@@A@AAA@@@BA@A@@@@@@C@@@A@@@BC@@B@@A@@A@A@A@@A@@B@B@B@@@@A@@A@@AA@A@@@A@@B@@@@@@A@BB@@B@ABA@@@A@@@@A@@A@@@@@@A@@B@@@@@@@@@AA@@A@@@@@A@@@@@@@@ABA@@@@AA@A@@B@B@B@BBB@B@BA@@@AB@@@B@@@BB@@@@B@@@BAA@@@A@EAAA@@@@BAD@B@BA@@B@@@@A@@B@@@@@B@B@@@@AB@@@@A@A@@@@@A@@B@@@@@B@@B@@@@@@A@@BB@B@B@@@@@@@@A@@A@@A@@@A@A@@@@C@@@@@@@AA@@@@B@@@@@@@@@@A@@@AC@AAA@A@@@@A@@@@@@BA@@B@B@B@@@@@@AA@A@@@AAB@@@B@B@@@@@@@@A@@@@@AB@@@@AA@@BA@@@@@@@@A@AB@B@@@B@@@@A@@@ABA@@@@AAA@A@@@A@A@@@@@@@AA@@A@A@@@A@A@@@A@@@@@A@AA@@A@@@@@@@@A@@AAAA@@A@A@@@@@@A@@@@@@@@AA@B@@@@A@@@@@@A@@@AA@@BA@@AA@@@@B@@@@C@A@@A@@B@@@@A@@A@@@@@@@B@B@@@AA@@@AB@@@@@@@A@@@@@AA@@@@@@AB@@@@A@@@@@@@@@@BA@A@@@@@@@@@AA@B@@A@@@@@C@@A@@@@@@@A@AB@@BBA@@@@A@@@@A@@BB@@B@@B@A@@@@@A@@A@C@@@@ADADADCFGFKBACAKIECGCICCCICWKWMOAODIDC@A@CACAAAACACEAIAK@E@C@[A_GGAC@UBQCE@AAKCECACAE@YAG@E@EDCFCDCBABA@ABEAE@AACCACCCAGAICKAIAI@EBGBGBGACE@GBGBK@GEGCCCCCAA@MCKEKGA@EIMUEC@AAACKACBC@QACAC@@AAAA@@ICCAIEKIEAC@G@KBGDABABURKFK@KAqOOECACAKCC@GC@@A@A@A@A@@BA@ABA@@@AB@@A@ABC@@BA@@@A@@@@BA@A@AB@@A@ABA@@BA@A@ABA@A@@@A@ABC@C@C@C@AAA@C@A@CACAA@A@AAEAA@@AA@@@CAAA@@AA@@@A@A@@@A@@@ABADAB@BA@A@A@A@@@@A@AA@@CA@AEFABAB@@A@@@@AA@@AA@@AA@@@@@AAA@A@A@AAA@@@A@@@AAA@@AA@A@AAAA@@@@AA@AICKLAACA@@A@A@AAA@ECA@AAAAC@@AA@CACACACACA@@@@@A@@@@@@@@@A@@@@@@@A@@@A@@A@@@AAA@A@AAA@AAA@CAA@@@AAA@A@CA@@CAA@A@AAC@AAC@@A@@A@@@@@@@@A@@B@@@@@@@@@@@AA@@A@AA@@@@@@A@@BA@@@AA@@@@BAB@@@@AA@@@@@@B@@@@A@@@@@@@@@@A@@A@@@@@@B@@@@@BCA@@@@@A@@@@@@@A@B@@A@@@@@@@@@A@A@@A@@A@@@@@@AA@@@@AB@@@@@@B@@@@B@@@@ABAB@@@AA@@@@AB@@@@@@A@A@A@@@@@AA@@@@@@B@@@@@BA@@B@@@@@@@@AA@@@@@B@@@B@@@B@@@@A@@@@A@@@@@@@BA@@@@@@@@A@A@@@@@@A@@@@B@@@@@B@@A@@@@B@@@@@@@@@B@@@B@@@@@@AA@A@A@@@@@@@@@B@@@B@@@@A@@A@A@BAA@@A@@A@@@@A@A@@@@AB@@@@@@@A@@@@A@@@@@@@A@@@@@A@@A@@AB@@A@A@@@@@@B@@B@@@@@A@@@@@@@AA@@@@A@@@@@@@BA@@@@A@@@@@AA@B@@@B@@AB@@A@A@@@@BA@@@@A@@A@AB@BB@@B@@@B@@@@BB@@A@@@AB@@@AA@@@@B@@@@@@@@A@@@A@@@AB@@@@A@@A@@A@@@@@@@@@@@@@A@@@A@@@A@@@B@@@@@@B@@@@@B@@@@AB@BA@@@A@A@@@A@A@@@A@AB@@@@A@A@@@A@A@AAA@@@@@AB@@A@@@A@@@@@AAB@@@AA@@B@B@@@B@@@AAC@@@@@@@@AB@@@A@@AA@@@A@@@@@A@@B@@AA@@@BA@@@@@A@@A@@A@@B@@@@A@@@A@@@@@@@B@@@@AA@A@@@@@@@@@BA@@A@@@@@A@@@@@AB@@@@A@@@@@@@@B@@@BA@@@A@@@@@A@@@A@@@@@A@@@A@BA@@@@@@@AA@@@@@A@@@@B@@@AA@@@@@AA@@@B@@@@B@@@@@@@A@A@@@BB@@@@A@@@@@A@@@@@@@A@@@@@A@@A@@@@@@A@@@A@@B@@@@@@@@A@@@A@A@A@@A@@B@@@@@A@BA@@@@@@AAA@@@A@@@@@A@@@@@A@B@A@@AA@@AA@@A@AA@@@CCICAAA@A@@@@A@C@C@@@@@@@A@@@C@A@@A@A@@@@@CB@@ACAAA@@@CBABAAA@A@@@BACAC@A@"],["@@ECB@B@@@BAB@B@@@@@@@@@B@@@@@@@@@BAB@D@B@@@@@@@@@@AA@@@B@@@BBB@@@@AB@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@A@@B@@@@@B@@@@@@@@A@@@@@@B@@@@@AA@@@@@@B@@@@@@A@@@@B@@@@A@@@@B@@AB@B@@@@AB@@@@@@@@@@@@@@A@@@@@@B@@@B@@@@@@AB@B@BA@@@@@@@@B@@@@@@B@@@@B@@@@@@A@@@@B@@AB@@B@@@A@@@@B@@@@@B@@@@@A@@@@@@BB@BA@@@@@AB@@BB@@@@@@A@@@@@@BA@@B@@@@@@@@@@A@@B@@@@@@@BA@@B@@A@@@AB@AAB@@@BAB@@ABA@AB@@@@@@AAAB@@A@@B@@@@@B@@A@@@@A@@A@@@@@@A@@@B@@A@@@@B@ACB@@@@@BA@@@@B@@A@@B@@A@@@@@AB@@@BB@@@@@A@@B@@@@@AA@@@@@@@AA@@@B@@AB@@B@@@AB@A@@A@@@@BA@@@@@@B@@@BA@@@A@@@@A@@A@@@@AA@A@@@@B@@@B@@@@@@B@@BA@@@@@@A@@AB@@AAA@@@@BA@@B@@AB@B@B@@@@@@B@@@@B@@AB@@@B@@@@@B@@AB@@@B@@@@@BADABAB@@AB@AAA@A@C@@AA@@AB@@ABA@A@@@ADADCBA@@AAAA@@AAAA@A@@@A@AB@@@AC@ABADAB@@@BA@@@A@@BA@@@AA@@AC@@AB@@ABA@A@@@CBE@@AAA@@ABA@AB@@A@@AArJFSBUAQqK@ABA@@@A@A@AC@A@@A@@AA@A@A@@CA@@@ABA@@@A@@@AB@BB@@@AB@@@@@@AA@@@@A@AA@@@@A@@B@B@@@A@A@@@BA@@@@@@@AB@B@@A@@@@A@@A@@@@@A@@B@@@AA@@B@B@@@@A@@A@@@@AB@@@@@B@BA@@@@@A@@@@@@BAAA@@BA@@@A@@@ABABADBD@@@B@BABAB@DAXDFB@@@A@@@@@@@@B@@A@@@@AAA@A@@@@@@C@@@@B@BA@@AA@@@ABABA@@@@@A@@DA@@@@@ABC@@@CBAB@@ABA@AB@BA@@@@B@@@B@@AA@@@A@@A@@BA@@@A@@D@B@@@@AA@@A@@@ABA@@@BB@@A@@@@B@@AA@@@@A@@@@@@@AB@@@BB@@@@BB@A@@@@@AA@@AB@@@@A@@AA@@D@@AA@@AA@@AA@AA@@@A@@@A@@@@@A@@@AA@@@AA@@B@@A@@@@@A@@@@B@BB@@FA@@@A@@B@@@@A@@@@B@@BB@@@B@AAB@@@D@@@BAFC@@C]BEDIRONODCDE@EAGACECGEWAO@UFGDGFAB@BA@AB@@ABA@ABA@ABA@@@ABA@ABA@CBABA@ABA@ABA@AB@@A@ABA@A@AB@@A@A@ABA@A@A@AB@@A@A@@@A@A@A@A@C@A@A@@@A@AAA@@@A@A@AA@@@@SGQAA@@@UAA@A@@@C@ABA@A@ABC@A@A@A@ABC@A@C@C@A@A@AAA@@@AA@A@@A@@A@AA@@A@@AA@@@A@AA@@A@AA@@A@A@A@A@A@A@A@@@A@ABA@A@A@A@@@ABA@A@@@@@A@A@@@@@ABA@A@@@A@@@A@A@AB@@A@@@A@A@@@ABA@A@A@@@A@A@@@A@AAA@A@A@@@A@AA@@A@A@@AA@@@A@@AA@A@@@AA@@A@@@AGK@@IK@@AA@@@AAAA@@AAAA@@AA@@AAA@@A@@AA@@AAA@@A@@AA@AA@@AAA@@@AAA@AA@@A@@AA@AAA@AAA@AA@@A@AA@@AAA@@@AAAAA@@@AA@@EGCC@CBG@GDU@CAK@AAC@CCK@@@@@AAA@@CGCC@AA@AAAA@AAAOOQIOEaIEAGACAGCECCAAAAAAAAAAAAA@@KMEEGGKIOIKE@@AAQEeOMEICEAGEKGCAA@@@E@IBA@CCA@AFB@B@@BCBA@AAE@ABBBA@A@CA@@@AAACBADB@KAEB@@E@EBI@EBGDAB@@ABA@A@@BCAABCAA@@ACAAAEECBIAG@A@EBIFCBEBCBKFABG@ABA@ABBFCBIDA@@B@@ABEBABAD@BFJ@@ABA@A@@D@B@BBBBB@DCB@BB@@B@@CDA@A@@AA@ABCBCACBCBCB@BABADCBA@ABCB@B@DADA@@BABA@ABA@EACACBCBCDCBCBEFCB@@ADBBKBEBIBODODKBABC@@@@@A@ABA@A@@@A@@@AAEA@@@@A@AAA@ECA@@@C@G@C@A@A@@@A@C@A@A@AB@@@@C@C@A@A@ABC@@@C@A@ABA@ABAB@@A@@@CBA@A@@@A@A@B@B@BBB@BBB@BB@@B@@@B@@@@@@BA@@B@@@B@B@@@BB@@@@B@@@B@B@@BB@@B@@BB@@@@@@B@@@@@@@@@BA@@B@@A@@@@B@@@B@B@BB@@@BB@@@BB@@B@@@BB@@B@B@B@B@B@@@@@@@B@BB@@@B@B@@@D@B@DBF@B@@@@BB@@BBB@B@@@B@@@BB@@@@B@@@@BB@@@@AB@@@@AB@@ABAB@@@BA@@B@@@@@@@@BBB@D@B@BBB@DBB@B@BB@@BBB@BBB@BBB@DBB@B@BBB@@@BB@@B@@BB@DB@B@@B@@@@@@BB@B@@@B@@@@@BB@@BB@@DBB@@B@@B@@@BBB@BB@@B@@@B@@@B@@@B@BBB@@BBBBD@DBB@B@@AD@B@@AB@B@@@DAB@B@@@B@@@@BB@@BB@@BB@@B@@@@@@@B@@@@@B@@@B@BBDB@@DBB@@BB@@BDDBB@@@BB@D@DAF@B@B@FAD@B@@@@@D@B@B@B@FF@B@@D@FDF@JBJDH@B@D@B@@@BABA@@BA@@DAB@BAB@B@B@DBB@@@D@B@B@B@B@BB@@B@@BD@@@D@B@D@DAB@DA@@B@B@@@B@BDB@@@@BB@B@B@BBB@@@@BB@@BB@D@D@D@B@B@B@B@@@BB@@BBBB@@@B@BAB@@@BB@@@@BB@DB@@BBB@@@B@@@@BB@@@AB@@B@@@@@@BB@FBBBBB@@B@@D@D@@@@@BA@AB@B@B@@@B@B@@@B@@A@AB@B@@AB@@@@@B@@@@AB@B@B@BAB@@A@@@AB@@CDAB@@@B@BB@DB@@B@B@@@@B@@@@@BBF@B@@@BABAB@@@BBBBB@B@@@B@@@B@@@@@BA@@@@B@B@@@B@B@B@@@B@B@@@B@@@@@BA@@@@@@B@@@DBBAFB@@BDDBBBBB@@@@B@@@@@@CB@@A@ABC@ABC@@BA@A@A@@@@@@@A@@AA@AAA@AAA@@@@@A@@@E@@BA@A@A@@@@AA@@A@@AA@@@@A@@@A@@@EDA@AB@@A@A@@@AB@@A@@AC@@@A@@@A@A@@@CB@@A@A@A@AAA@AA@@A@@@@@ABA@@BAB@BA@AB@@@B@B@@@@@BAB@@A@@@A@AB@@A@CB@@@@A@@@A@EAAA@@A@@@@@A@A@ABA@ABA@AB@@ADADCFCFABEHCFABAB@B@@@BEHEHADCDAD@@@@@B@@ABABABCB@BA@@@@@AB@@@@@BAB@B@@@D@B@@A@@B@@A@@@@B@B@BAB@BAB@D@D@@AB@B@B@BAB@B@B@B@@@BA@@@A@@BC@ABA@ABAB@@A@A@@@A@@@@B@@AB@BA@@B@@AB@@@@@B@@A@AD@@@@A@@B@@@@@B@@AB@@AB@@C@@@@@CD@@A@@@A@AAA@CAA@A@A@CBA@AB@@@@@@BD@BB@BD@BBB@@@@@BA@@B@@@BAB@BA@AB@@AB@@@@@B@BB@@@@@BB@@@@@@@@@B@@A@@@@BB@@B@B@@A@A@AB@@@@@B@@@B@B@@@B@@BB@@@@B@@@@@BBBBDB@B@@@@B@@@BB@@B@B@@B@@DB@BBBB@BBB@BB@@A@@BA@@B@B@@ABA@@@A@C@@@A@@@@BA@@BABABCDEFA@AB@@C@C@A@CB@@A@A@C@A@A@@@C@AB@@@@AB@@@BB@@B@B@@@@AB@BAB@@AB@BA@@@ABA@@@@@@@@@DBDB@B@@@B@@@BADAB@@@D@@AD@@@@@BB@@B@DA@@@@B@D@@@@@@B@@@D@B@B@D@B@B@DAB@B@B@B@BA@@BABAD@FCB@BABAD@@@DAB@B@B@B@BA@@BADA@@B@F@B@D@FBB@B@@@BB@@BBBD@@@B@@BBDBB@@B@@@B@@AB@B@@@BB@@BBB@BB@@@A@@BAB@B@B@@@@B@BB@@@BB@BBBB@@@B@@@@@B@BABAB@B@B@B@@@B@B@@@@@@@BA@ABA@@BA@@@@B@@ABEDABABA@ABA@ABAB@@@B@@B@BDBB@@@B@@A@A@ABA@@BAD@@@D@@AB@@A@ABA@ABA@AB@@A@A@ABA@A@@@@@@B@@@BB@@B@@@@B@DAB@@AB@@BD@@@B@B@@@BAB@@@D@D@@@B@B@D@@AFBB@B@BB@@B@BBB@B@B@B@B@B@B@BBB@@@BBBBDD@BBB@@B@@@D@F@F@F@D@B@BBBBD@DB@@B@B@B@@@B@B@@BB@@AB@@@BA@ABAB@@AFAB@BAB@B@B@BBD@DBB@BBBBBB@@BBB@@BB@B@@BD@@@B@B@BA@B@@B@B@B@@@B@@@D@@BD@DBB@B@B@BAB@BBB@@@BB@@B@BBB@@BB@BB@@BBB@B@BB@@BB@@@B@BAB@B@@@@@BBBB@@@B@@@B@B@B@B@BBB@B@@@B@B@@@B@B@@@DBB@B@BBB@BB@@BB@@@BBB@B@B@@DBBDBBBBBBBB@@@BB@@F@@@B@@@@@@A@@@A@@@A@@B@B@@ABABABABAB@@@B@B@@BB@BB@AB@@@BA@@B@BA@BB@B@@@B@@@B@@AB@B@F@@@@A@ABA@ABA@ABABA@@BA@@DABABAB@B@@@DAB@@ABA@@@A@ABA@AB@B@@B@@BB@B@@@B@BBB@B@BBBB@@@@BBB@B@B@B@B@@BD@@@B@@@B@B@B@@@D@@@B@D@B@@@BAB@B@B@DA@@@@B@B@@@@AB@B@B@B@@@B@@@BBB@B@@B@@A@@B@@@@A@@B@@@B@@BBBBB@@B@@BB@@BBB@BB@@B@@B@@@F@@@B@@B@@@B@BAB@@@B@B@B@B@BBB@B@B@BB@@BB@@@B@@BBBB@@B@B@BB@@BB@B@B@@@BADABAD@@A@A@AB@@@@AB@BBB@BA@@@A@A@A@@@A@@@@B@B@@AB@@BB@BBBBD@B@@@B@@B@@B@@@@@@@B@@@@@BAB@@@@@B@@@@BB@@B@@@B@DA@@B@@@BBB@BB@@B@@@@@BBD@DB@@B@BB@@B@B@@@B@@@@@B@B@B@@BB@@@@@@@B@H@B@BBB@B@@@BB@@AB@B@@@DA@AB@DAB@@@@@BB@@@@@BBBB@D@@@B@@@@AB@B@BAB@@@@@@@B@@@@BBB@@@B@@BB@@B@@@B@@B@@@BBB@@B@@AB@@ADA@@BA@@@AB@@@@@@@BB@@B@@B@@B@@@B@@A@@@BB@@@@@@B@BBB@B@@@B@@B@@@@@B@@@B@@@@BB@@@@@B@@BB@@BB@@@B@@@B@@AB@@@@@B@@@B@B@@@@AB@@@@@B@@@B@@B@@@BB@@@@@@@B@@A@@@@@@B@@@B@@AB@@AB@@AB@@@B@@@@@B@@@BB@@B@@@@BBBBDB@@@BB@@B@@@B@@BB@@@@@@@@ABA@A@@@A@@BA@@@@@@B@BB@@@@B@@BB@@BBBB@@@B@@BB@B@B@@@@@BBB@@@B@@B@@@BB@@BBB@@@@B@@B@@B@@@@BB@@B@B@B@B@BBB@B@BB@@B@B@B@BA@@@@BAB@B@BABAB@DAB@BADAB@B@@AB@@@B@B@B@B@BA@@BABA@@BAB@BA@@B@B@BA@@B@BA@@@@B@@@@@BBB@B@@BB@@@B@BB@@B@B@B@@@B@@@@@BBB@@@B@@@BBB@B@B@B@@@B@@@@B@@@@@B@@@B@@@@@@@B@@B@@@B@@@BBB@@@@BB@@@@BB@B@@@B@@B@@@@@@BB@@BB@@@@BB@@@B@@@BB@@B@@@@@@@@BB@@B@B@@@B@@@B@@@@@@B@@@B@B@B@B@@@B@B@@@@@@BB@@@@@BB@@BB@@BBBB@@B@@@@BB@@@@@@@B@@@BB@@@@B@@@B@@@B@@@B@B@@@@BB@@@BB@@@B@@@B@@@BBD@@@B@B@B@@@B@@@@B@@@@@BB@BDB@BB@B@A@@B@BA@@@@BA@@BA@@B@B@B@B@B@D@BAB@D@B@B@D@B@@@BB@A@@B@@@@AB@@A@@B@BAB@@@@A@@B@B@BAB@B@@@BA@@B@@@BA@@@A@@@A@@@A@@@C@A@C@AAA@@@A@@@A@A@@@@@A@C@C@AB@@A@A@@BC@@@A@@@@@A@@BA@A@A@@@@@ABA@@@C@ABA@A@@@@B@BAB@B@BAB@B@BAB@B@@@B@B@B@B@B@@@@@B@@@B@B@@@B@BB@@B@BB@@BB@@BBBB@@@@B@@B@@B@@@BAB@B@B@BAB@B@B@B@@@@@B@@@B@@@B@B@@@@@B@@@@AB@@@@A@@BA@@@@B@@@B@@@BA@@@A@@B@@A@@@@BA@@B@B@@BB@@@B@@@BA@@B@BAB@@@@@@@BA@A@@@A@AB@@@@AB@@@@A@@@A@@@@BA@@@@BA@@@@@@AA@@@A@@@@@A@@@@@@@A@@@@@AB@@@@@B@@@@AB@@@@@BA@@@@B@@@A@@@@@@A@@@@@A@A@@@@BA@@B@B@B@@@B@@@B@@@BAB@@@BB@@B@@@@@@@B@BB@@B@@@@@BA@@@@BA@@@@@A@@B@@A@@@@@@@@BA@@@@@A@@@@@AB@@@@AB@@@B@@AB@D@B@B@@B@@B@@@BA@@B@@@@AB@BB@@B@B@B@@@B@@@BA@@@@B@@@@@B@BB@@@@B@@@@@B@BA@@@@B@@@B@@BB@@@BBB@B@@@B@B@D@BAB@B@B@D@D@B@B@@@B@B@@AB@B@BAB@BAB@B@B@DAD@BAD@B@DAB@DAB@DAB@B@BAB@B@@AB@B@B@BAB@B@@@BAB@B@B@BAB@B@@@B@BAB@B@@@B@B@B@@@B@@@BAB@B@B@B@@@B@B@B@@AB@B@B@@@B@@@BAB@@@B@@@BA@@B@@@@@BA@@BA@@B@@@@AB@B@BAB@@@@@@A@@@@@@A@@@@@@A@@B@@@@@@A@@@@@AA@B@@A@@@@@@@@@@@@B@@@@A@@@@@@B@@B@@@A@@B@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@AB@@@@A@@@@@@A@@@@@@A@@@@B@@@@@@@@@@@BA@@@B@@BA@@@@B@@A@@@AB@@@A@@@@@@@@@@@@@@@A@@@@@BA@@@@@@B@@@@@@@@@B@AA@@@@@@@@@AB@@@@@A@@@@@@@@@B@B@@@@AB@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@A@@@@A@@@@@@A@B@@@@A@@@@@@@@A@@@@A@@@@B@@@@@@@@@BA@@@@@@A@@@@@@A@@B@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@AB@@@@@@@B@@@@@@@@@@A@@@BB@@@@@@A@@A@@@@@@A@@B@@@@@B@@@@@B@@@@@@@@@@BBA@@@@@@@@@@A@BA@@@B@@@A@@@@@@@@A@@@@@BA@@@@B@@A@@B@A@@@A@@@@@@@@@@A@@B@@@B@@@@@AA@@@@@@@@B@@@BB@@@@@@@@@A@@@@B@@B@@@@B@@@@A@@@@@@@@@A@@@@@@@@A@A@@@BA@A@@@@AA@@@@@A@@@@@@A@@@@@AA@@A@@A@@@@BA@@@@A@@@@@@@@AB@@@A@@@B@@A@@A@@@@@@B@@A@@@@A@@A@@@@A@@B@@@@A@@@@@@@@@@@@BA@@@@@@B@@@B@B@@@@@AA@@@@@@@@@A@@@@@@A@@@@@@@@A@B@@A@@A@@@@B@@@BA@@A@@A@@B@@@@@@@@@B@@@@A@@@@B@@@@@@@AAA@@@@@@@A@@@@A@@B@@@@@@@A@@@@A@@@@@@@@@@@AA@@@@@@@@A@@@@@@@@@@@@@@@@BA@@A@@@@@@@@@A@@A@@@@@@B@@@@A@@@@@A@@B@@@@A@@@@B@BB@@@AA@@@@@@A@@@A@@@@@A@@@@@@BA@@A@@@@@AB@@@@@@@@@@@B@@@@A@@B@@@A@@@@@@AA@@@@MNOT{PDcod]NCS]F@JC@EECGBEMCKEOFKAACKCIFIICCGPG@EQEOHABGDMHCDCHC@KN`HAHAPXJINS`K@@AI@MFIGBMB@@IBI@EB[@@@OPY@APIPIJEGEUBQJGHEJGDWFHIICK@BGCIBAGAHEcDXGGE^AHEKAAGgD@G"]],encodeOffsets:[[[133473,48080]],[[133091,48153]]]}},{type:"Feature",id:"230881",properties:{name:"同江市",cp:[132.510919,47.642707],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBCAAC@@AA@@@A@@BABG@@@A@CAE@@AA@@@AEAC@C@A@A@AB@@ABA@A@A@A@@@@A@@AABC@@B@@A@@@A@AA@@@@AE@C@@@ABA@@BA@AB@AA@CACC@@AAA@A@@@EA@@A@@AA@@@A@A@A@A@A@A@A@C@C@A@@BA@@B@@AB@B@@A@@B@@@B@@A@@B@@@@AB@@@BA@@B@@ABA@C@C@A@A@ABC@AAA@@@A@@AA@@@@@@@@A@@A@@@AAC@CAEAC@AA@@C@A@C@A@A@C@AAA@@@AAAA@@A@@AAA@AAA@@AA@@AA@@A@@@@@AAA@@AA@@@@@AA@@@@AA@@AA@@@A@A@@@@@ABAAA@@@@@@@A@@@CAA@C@@@ABA@@@@BAB@@AB@BA@@@@@@B@@@@A@@@A@@@A@@AA@@A@@A@@BA@A@@BA@A@@@A@@@AA@AACAA@CAEBA@C@E@C@ABA@A@@@C@A@A@EBA@AB@@A@A@@BA@@@AB@BA@@B@@AB@BA@AD@@@@@B@B@@@B@@B@@B@@BB@@AB@@AB@@ABAB@@ABABA@AB@BA@@B@@A@@B@@C@@BA@@@A@AB@@@@AB@@A@@B@@A@@@A@@BA@ABA@@@A@ABA@@@A@@@C@A@A@A@AAC@@AA@C@C@A@A@ABC@A@A@A@@@A@ABA@A@@BA@A@@BABA@AB@BABA@AB@@ABABA@ABCBABA@CBABABC@ABC@ABA@ABA@A@A@@DAF@@ABB@DDDHHDNDZLPJJJDBBD@DBFBFBFDFFHHHHFTJHDJBHBP@L@HBDDDDBFAFAJBBJPBDFHBJ@D@D@DBBFHDFDBDDBB@D@BAD@HAHADBDHFFBHBNFLDDDDDJNHL@B@D@B@BABAD@@@BA@@BAD@@ADABABAFCDGJGPCH@D@FBBBDDBDDRDRB`DH@FBLBB@DJ@BDBBB@DDDDFDBBFFFDHXBX@VBZFLF`NLLDHBPAPA`@T@VDNHNRXHLHJVRjVTLVXNRFHFHBFAFCJalIPEVDXTVhPdB^AXILEZQbOXEZAT@^DRJNHFHPP\\bLLNPFLFFHHBD@@B@^LbJZF\\HjLTFRFXHVJLJPRDRHVJXNRPPXP\\NRHPFhLhJPBXBXBfAVAXC\\C^CbETGPENETCP@FCPITIH@VDTH~RNBjHXBXBXENGTEPAPA\\FTDZ@nEX@RDLBTHNLNTFRDPFN@HBNHVNLLFXHp@lK\\GXCtHRLRNNXJRDPBX@HFHZNjHZ@dFZLZPNDhFJ@T@dF\\HjJjPVF`@\\AtDNDN_FK@CB@DEBCBCBCBCBA@@@@B@B@BBB@B@@@BA@@@@BA@@@@@@@@@@@AB@@@BA@@B@B@B@BBB@B@BB@@B@B@BBB@BBB@@@@@B@@@@A@AB@D@@A@@@AA@A@A@A@@AA@A@A@A@AB@@CA@@@A@@@AB@B@@AB@@@D@B@@AF@B@BCBAFAD@FADABC@A@AAAA@CA@AA@@AA@@AA@@C@A@CDCBAD@BAFA@@DCHIJKHKDCDCBCBADB@@B@@@B@BA@A@A@ABA@@B@B@B@BAB@@@B@B@DBBB@@BBB@B@BA@@BABCBABA@@@@@@@ABAB@B@@@B@B@@A@CBADABAD@B@C@@BB@D@B@BABAD@BA@@@@@@A@A@A@BA@AB@B@@A@@A@A@@A@@B@@@@A@A@@B@AAAA@@A@@BB@BBC@ABA@@AA@@AA@B@AAB@@AD@@A@@AABAD@@@@@AA@@@@BAB@@A@@ABA@@@@AB@@@@A@@@@@A@@AAC@@@@@@A@@B@@@@A@@DA@@A@@@@AB@B@@@D@@A@@@A@@@@A@@A@@B@@AB@A@A@@@@A@@@@A@@@A@@@BAA@A@A@@@A@@@@@BA@@AAA@A@@@@@@C@A@@@AB@BBB@@@@AA@A@@A@@B@@@B@B@@@@AA@@@A@A@@@@@@@@AA@AB@AC@@@@@BA@ACA@B@@A@@@AAA@@DAAAA@@@A@@B@@@@A@@CBA@@A@@BA@@E@@A@@@@@A@B@@B@BA@@B@@@@@AAAA@@@@D@@@@@@@A@AA@@B@@@@AA@A@A@B@B@@@BA@@@@@A@@AB@@@@@@A@AB@@@@@A@A@@B@B@AA@A@@AB@@@@@@A@@A@@@@@@B@@A@@@@A@A@@@ABAA@A@@B@B@@@@@B@@@C@@A@@B@@@B@@@AA@@AA@@B@B@@BB@@A@@@@C@@@BA@@A@AA@@@@BA@@@@@AA@@@A@B@@BA@A@@@@@BA@@A@@@A@@@@@@@@@B@@AA@@A@@A@@@@@@@A@@@@A@A@@@A@@@BA@@@@@@@AAB@@@BA@@@@AAA@@B@BA@@AA@@A@AA@@B@@@AA@@@A@@@@@@A@A@AB@@B@@@@B@@AB@B@@@@@@AAB@@@@AAB@B@@@@@AA@@B@@@@@@@@C@@B@BA@@@@AA@@A@@@@@BA@@@@ABAAB@@@@@@@A@A@@@@@@AB@@AA@@@@@@@@A@@A@@@@@ABA@@@@AB@@@AA@B@@AB@@@AA@BA@@A@A@E@AAA@@@B@B@B@@A@A@@AA@@AA@@@A@@BA@@AA@AB@@A@@B@@BBB@A@@BA@@@A@@A@AAA@A@A@@@BA@@@AA@@@A@@BAB@@ABA@@A@AA@A@@B@@@BAA@@@A@AA@A@AB@B@@@BA@@@CA@@@AAA@@A@A@@B@@@BAA@@BA@AAA@@A@A@@@@B@BA@CC@@B@@A@@@@C@@A@@B@@@@AA@BAAAA@@@A@@BB@BB@@A@A@C@A@A@AAAAB@@@B@@@@AA@@@@A@A@@AABA@@B@B@@B@@A@@B@@@B@@BA@@@@@ABA@@AAB@@AB@@@@A@@AA@@@@ABBB@@A@@@@@@A@@C@@@A@@BA@@@A@@A@A@AA@@ABAB@@@@BBB@@B@@@B@@AA@AAA@BA@@D@@@@AA@@AB@@@@@AA@AA@CB@@A@@ABA@@A@@@A@@@@BA@@@@BAAA@A@A@A@@AA@B@B@@@AAAAA@AAA@A@@AAB@@B@@BB@@@@@@@ABA@@@@BA@@@AAA@A@@@@@@BABA@@@@@@AAA@@AB@@@@A@@@@A@@A@A@@@A@A@@A@AA@ACA@@@A@A@@@C@@@@BB@@BEBCDA@@@AAA@A@A@ABA@@AE@A@A@@@@B@@DB@B@@A@A@@B@B@BA@@@@AA@@AC@C@@B@@@BB@A@@BC@A@@@@@DE@A@@AAA@ABA@@BB@@B@@@@A@A@@A@A@AA@A@C@A@CAA@AA@@@@A@CDA@@@A@BAB@@A@@@@A@ABA@@@BAA@ACAA@@BABCB@B@@@B@@@@A@@B@B@@@@@AAC@AA@@@@@AB@@AB@@@@D@@B@@@@A@@BAB@@@AA@@A@AAAA@@BA@@AA@@AAA@DA@@@AD@@A@@@A@AAAAAA@CBCBA@@B@@B@@B@B@@A@A@@@CA@@A@AB@B@@@@@BDB@B@@@B@@A@A@@A@@@AAAA@ABCDA@A@@@A@@CAAAAA@A@A@C@@@@@C@EAC@A@ECA@A@@BAB@@A@A@AA@@B@B@@@BAB@@@AA@@A@ABAB@@AA@@B@@ABC@AA@@@A@CB@@A@AAA@@@BAF@B@B@@@AAA@@@AA@A@@B@DA@@B@@@AAÕGIeSU_U_KaAUK_mKCMQKIÙ@C@E@ACC@A@AFCBA@CBCFEDCFC@A@A@A@EAC@ABABC@@@A@A@A@K@MBM@M@K@UBY@a@[Bc@[@@@O@CBsBm@K@E@A@G@E@@@A@C@C@A@A@A@C@A@C@A@C@A@C@ABC@A@C@C@C@A@@@C@C@C@E@C@C@E@E@A@AA@@@A@AA@@AA@@A@A@ABA@A@A@@AA@A@A@A@AAA@@@A@A@AAA@A@@B@BB@ABA@AA@@A@A@ABA@A@@AA@A@AAA@A@A@@AA@A@A@AA@A@@AAA@CAC@AACAAAA@A@CB@@A@AB@@A@AAA@A@A@A@@@AAAA@@AAAACAA@@@C@A@CBEA@B@AA@@AB@@@BC@@AAA@A@@@A@A@AAA@A@@AA@A@@AC@A@AA@C@AAC@CA@AA@A@@@A@@@A@@@A@AA@@@A@@@@CACACAC@@@AAAACAACECEACCC@ACCACAA@A@AAE@G@AAC@C@AAA@AAC@AAACCCECEACGKCCAAAAAAAA@@A@@@A@A@EAA@A@CAA@ECC@CAEAA@C@A@A@C@C@A@CBC@GDKDCBGDKDC@GBCBEBC@CBA@A@CBA@A@A@A@C@A@A@@@A@C@E@EBC@A@EBA@EBA@A@CBA@CBABC@A@CBCBG@CB@@@@A@ABA@A@ABA@ABA@@BC@@BCBABA@AB@@A@ADCBABABCDADABABCDCDABCD@@CBA@C@C@@BA@A@CBA@EBC@E@G@I@G@C@A@A@AACAEAA@A@ECCACACAAAEAAAA@CAACAACACAAAECA@AAA@A@@@EAK@E@CAC@GAIACAA@QBQDIB@@A@A@C@ABC@@@A@ABC@@@A@EBA@A@A@A@ABA@IBIBA@A@A@CBA@A@A@ABMBG¥ËsiDHAI@ACďG}DMº@BHR|NÌ@BA@ABA@ABA@@BCBEB@@A@AB@@@@A@A@@@EBEBA@C@A@C@E@@@@@A@CBA@@@EBG@ABE@E@C@C@A@A@C@C@C@A@A@C@A@A@C@ABA@A@@BA@C@A@@@A@@@@AA@@@A@@BA@C@CBCD@BCDEB@@EBE@EAC@ECAC@EBADAFGBA@C@ACCACCE@@@AA@@AA@AA@@AAAAA@AA@@AAA@@AA@AA@AA@AA@@AA@A@A@A@@AAAAA@CC@@@@A@A@AAA@A@A@@@A@A@AAA@A@AA@@AAA@AC@@AA@@@A@A@@@A@@@A@@@A@AA@AAA@A@AA@@EA@@@@AAA@AAA@AA@@AAA@A@AAA@@A@@@A@@@A@A@@@A@@@ABA@@AA@@A@ABA@@B@@@B@BA@@BA@A@A@AA@@@AB@@AB@@A@AB@@A@@@AA@AAA@ABA@@@A@A@AA@@BA@@@A@@@AA@A@AAA@@A@@@A@@BAB@@AB@BA@AA@@AA@A@A@@@@BAB@@AB@@ABA@A@A@A@@@AAA@AAA@A@A@A@@@A@AAA@@AA@@A@@ACAECGCGACCEEAE@CAE@E@IBG@M@@@GAA@@@CCIIEEAACE@C@EACAACAC@@@CBABADCB@@@@A@@@@@@A@@@A@A@CAAAA@@@A@@@A@@ACA@A@A@@BA@A@@@Q@IBMAGBE@"],encodeOffsets:[[135720,48628]]}},{type:"Feature",id:"230883",properties:{name:"抚远市",cp:[134.307884,48.364687],childNum:1},geometry:{type:"Polygon",coordinates:["@@[aP{q@@DuA@@@CBA@CBABABCDC@GDABABC@@BAA@@CB@@AACAC@AAAAABA@AAA@AAA@@AAAAAA@CAC@@@AC@BA@A@A@A@CAAAA@A@A@A@@@@A@@AAA@A@A@CACAA@ABCA@BA@CAABA@@@ACA@C@C@AAA@A@A@@BA@@BAB@@A@@@@B@@C@ABA@GFIAAFCBA@CDCBEBABAB@DA@EDA@@@AAA@A@AB@@A@AA@AC@CACAAAA@A@A@A@AA@BABA@E@CA@@ABA@CBA@AA@AA@A@A@C@CAA@A@C@A@ABAAA@A@G@A@C@A@ABAB@BABC@CBA@AAA@A@CBA@C@A@@@A@AB@@@@A@@BA@ABC@A@ABAB@@C@@@AB@@A@A@C@A@@@A@ABA@A@@@C@C@@AA@@@@@A@@@@@AAA@AA@@ACA@A@AAA@@@AAC@ABA@@@@@@@ABA@AA@@A@A@A@@@A@A@A@@@C@@AA@@A@A@@@AA@AAA@@@@@AAAAA@@@C@@@A@AA@@CA@@C@ABA@@@A@@B@@A@A@@@A@EBA@AAA@@@@@A@@@A@AA@@A@@B@@A@@AA@C@E@A@AAC@@@@@ABA@A@C@ABA@AB@@@@BB@@C@@B@@A@A@@@A@@@A@C@A@A@C@AB@@AAC@ABA@@@AAA@E@C@@@A@@A@@A@CAC@@@A@@BAAAB@@A@@A@@@@A@@@A@I@@BA@@B@BA@@@A@AAE@A@@@@@A@@B@@@B@@@B@@AB@@DD@B@BA@A@@B@B@F@F@D@D@F@D@D@D@@@B@D@D@D@BAD@B@D@B@D@B@D@B@D@B@B@B@D@D@B@@@F@H@B@F@LAnAt@D@P@@@\\Ad@\\@bAZ@V@L@NAN@N@L@B@B@B@@ADAB@BBD@F@B@B@BEDCDEFAD@DABED@B@BDD@B@F@DÚLJNRLDn`VLbB`L`VTVfHJÖBB@@A@@@CBA@@@@BBB@@B@BB@@A@A@E@AB@@B@BBB@@@DAB@@@B@@BAD@BA@@@BB@@BABAB@@@BB@@A@AB@@A@A@@@BBB@B@@@BA@AB@B@FDB@D@FBD@@@@@D@B@B@B@BBBB@DB@@@B@B@DCBAB@BB@B@@@BB@B@@@@A@@@ACA@A@@@@@ABAB@@@DB@@B@B@@@@A@AA@@@@AB@DADAB@BBBB@B@B@@@BC@@B@@CBB@BB@@BB@@AB@@BBBBB@@@BB@@A@AB@@@B@@A@@@@C@@A@@BA@@B@@@@BBD@BB@@@@A@A@@@@B@@A@@@A@A@ADAB@@BBBDB@AB@@B@BAB@@@@@@BA@ABB@@@B@DCB@@@@@BBB@DBB@D@B@B@@B@B@BB@B@@@@@@AA@@AB@BAB@BB@@@BCF@@@@B@D@@AB@A@@A@@@AD@D@@BB@@B@@B@@A@A@AB@B@@@@ACA@@@A@@B@B@F@@BB@BAB@B@B@BB@@B@DCFA@AA@@A@@D@@@B@B@@@B@BDB@@B@BB@B@@@B@B@@@@B@@B@@@@@BA@@BB@B@@@@B@BA@A@@@@B@B@BB@@B@@A@@B@BA@@@@@@A@@AA@@@BA@BB@B@BBB@BBBB@@A@A@B@@BB@B@B@B@BB@A@@B@@A@@B@@@B@@@AB@BB@@@DAB@@BBB@@@@A@@BB@@B@@C@@@ABB@BBB@@BA@@@A@@@AA@A@@A@AB@BB@@B@B@BB@@@B@@AB@@@D@@@@B@@@@B@@@AABA@@@@BB@@@B@@A@@BA@BB@@AB@B@@@@AB@@@A@@@AB@@@@AA@A@@@ABBB@@@B@B@@B@@B@@A@@@A@BBBBB@B@D@B@B@@@AAA@@AB@@@B@BBABB@@B@@A@@@@BD@@@@@@BA@@@DDB@@A@A@@B@B@@@BB@BAB@@BB@A@@@AB@B@@@BB@B@@DB@@B@@A@@@ABAB@B@@B@B@@BB@A@@@AB@B@@B@BB@BA@@BA@AB@@@B@@B@@AB@@B@B@B@BBB@@@@B@@AB@@@BAA@AA@@@@BA@@BBB@@AB@@@B@@BB@@BB@@@B@BA@A@A@@@B@BBF@B@B@@@ABB@@B@@BA@@@ABB@@A@@B@@B@BA@@@@B@@@@B@@@@@@B@@BA@@B@@@@B@B@@@@@@@A@BBBA@@@@AB@@@@B@@@BB@@@@ABA@@@@D@@@@@@A@@@BB@@@@A@A@BB@@@@BA@B@@@@A@A@@BA@@@@@@AA@@B@B@B@@@@B@@@B@@B@@@AB@@B@BB@@BB@@A@AB@BB@@@@AB@@A@BB@@@@@@B@@A@@@B@@@B@B@@B@@@@@@@B@@@@BB@@BA@@@@@@@@@B@@@B@@@AB@@@@B@B@@AA@B@@@B@@B@@@@AB@@@@BBB@@@AB@@D@@@@@@BA@@AA@A@@@BB@@BB@@A@@@A@@@@BD@@@A@@@@@A@A@@@@BBBBA@@B@B@@@@@@BA@@@@@@@@BB@@@@@@@BA@@@BBBA@A@@@@B@B@@@@BAB@@@@@@@BA@@@B@@@@AB@@A@A@B@B@B@@B@@A@@@BBB@@@@@@@C@@@@@BBBB@@@@A@@@ABA@@@@A@B@@@@@BF@@@AB@@@BB@DA@@@B@@A@@@@B@@BBBB@CB@BB@@B@@@@ADB@BAB@@@@D@@BBAB@@B@@@@@@B@B@@@B@@B@@A@A@@@A@@@@BB@B@@B@@A@AAA@@B@@@B@D@@@@B@B@BB@@AB@@@@B@@@B@B@B@AB@@B@@@B@@@@@@B@@B@B@A@@BA@@@@BB@@@@@@B@@@BC@@@A@A@@B@@B@@@CB@@@B@@A@@@@B@@@@D@BB@@@B@@@@@B@@A@@B@@B@BA@@@BA@AB@@@@BB@@@@C@ABBB@@@BC@@BA@BBA@B@@BB@@BB@BAD@AAA@@AB@@@BBBBA@@@@B@B@@A@@@@BB@B@@@@BA@A@@BABB@B@B@@@@@@@ABC@ABABA@C@A@@AD@A@C@ABCBAB@D@BA@A@@@A@A@AB@B@@@@@@ABABADAB@@ABA@A@AA@@AACAA@A@@@A@ABA@A@A@@@AB@B@B@BABA@@@A@@@CAABADCDCDGLILGJCD@@EBABC@ABCD@D@B@DB@@BB@@BB@@BDBB@BB@B@BADCBEBC@EBABADA@E@@BA@C@@@A@@BA@A@@B@@@B@@DB@@BAB@B@B@B@@BB@B@B@B@@B@@@BC@A@@B@B@@A@@@@@A@AAA@AAA@A@@@AAA@A@AAA@A@A@@@AB@@A@@B@@@@@@@@@@AB@@@@AB@@A@A@AAA@A@@@@@ABADADADADCFA@@DELM`B@PJfd^NZFXB`ElETB`DhVVT^\\XN`L\\BnARGNANDbHfBhELATCd@hJdN`JtNTHVD\\D`FRD^HTBV@XCRCdKdGX@PBbBvD`CTCdB\\@PCZOx_ZMNI`@rDbHZN^XPZPVNP`ZRJ^JRDRD^AT@dKxIdAV@VDVJRRNHNBTGRKNQBE@SCU@KAIAEQOSIWGQGWEQE]MOIUEWEQCWAMCMCKGGCWA_@WGA@SqQGACAgSIIY_QEa@_KCCGCKAG@ICGCEEIGKAA@A@Q@WB_UC_AMDKCqNYKQ@C@@GOACLOBC@C@EEGECKEOGGEGGIIIKKSEKAUQO[MOQocCMCMACeIGIDS@CBGDE@CBEBAAEAEAAAAAAAA@AAC@AAC@A@@@CBCBCBCBCJIDADADCBADABABALCDCJGTOPKFIJKDK@UCURGdA\\APCD@BADABAJGNMHEAMAEEICK@OBABGBCBGBE@EBCAOACBIFInUNGFAPGFCFCDC\\]rQRMFIRe@KIcEEKIEEECIICCACAAACEACEIGCACAA@AA@A@@@AAAAAAACAAACACACAACIKIKMIECCCCCCAEAAACC@AGCCAAAIICSBEBGBO@IM]ME[KY_QMQUMGCCEAGEEAECCACAiMIKBk@AIUCGAAAC@AA@AACAEAA@C@C@C@C@Q@CAA@CAC@MEEAIAqeBlHæBfDFÒB\\@FBdBnA@@N@DD²©DEUHQWCCa]M@GiBSIMCGOYFEKEGMWMYIEJGIORGRCFM@OKEKWLI@OMIYMGCIO@CBSAKQCUAMMCCTOOQQAIIGAIKEG[NMOU@Y@DK@AcGaKJQQMIEOFEUAOKMMA[De`X"],encodeOffsets:[[137328,48760]]}},{type:"Feature",id:"230882",properties:{name:"富锦市",cp:[132.037686,47.250107],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@F@FBDBHJLJ@@RLFFJFLDDBFDDDDDLLDDFDFBHBFBF@N@F@FBFDDFBD@D@DAD@B@B@FBBFDHDLFF@D@BBBA@@BE@CB@B@B@BAB@BAD@BAD@BABADAB@BADABAB@BABA@@BAB@BA@ABAB@BA@AB@B@@AB@B@BAB@@@B@B@B@D@BAB@B@D@D@B@@BD@BBB@B@B@D@@@B@@@B@BAB@@@B@BAB@@AB@@@B@@@@AB@@@BA@@@@BAB@@@B@@AD@@@@AB@@@@AB@@ABAB@BABA@@BABA@@BA@@BA@@AA@@@AA@@@@A@@@A@A@@@@BCB@@ABA@@@AB@@ABA@@B@@AB@B@@@BAB@FAB@B@D@@@B@B@BAD@F@D@B@FADBB@DBBBB@@B@@@B@@@BAB@@@BAB@@@BB@@@BB@@@B@@@B@@@B@@A@@@@@@@ABA@@BA@AB@@@@AB@B@@@DBB@D@@@B@@@@@@BBAB@B@@@@@B@B@@BB@@BB@@@@BB@@@@B@@BB@BB@@@@B@@@BB@@BB@@BB@BBB@BB@@@BBBB@@B@BBD@B@B@D@B@D@@@BBD@FBDBD@BB@@B@@@@B@@@@@@B@@BB@@@B@BBD@BAB@B@D@D@B@BA@@@AB@@A@@BA@@@@@AB@@@@A@@@AB@@@@ABA@@@AB@@AB@D@D@B@B@B@B@B@B@B@@@B@@BB@@@FB@@B@B@BB@@DDDBB@@BBAB@@AB@BA@@D@F@@B@@B@@B@B@@@BA@@@ADBB@@@B@@B@B@B@B@BA@@BAB@B@D@D@FB@B@@BB@@BF@D@B@@AHAB@@@B@@BB@@BDDBDAF@HANBJAR@@@B@B@@AB@B@B@BD@@@B@@@B@@BBBB@D@B@B@@@B@@@@B@@@@@DABCBADA@@D@DBBBBD@F@DDFBBFFJJDD@@B@HB@@N@H@JAF@F@DBF@FBDFBDDHDHBFBD@@@BB@@BB@BBB@@@B@B@B@B@BBB@BB@@B@B@B@B@BA@@BA@@BA@A@@B@B@B@@BB@@BABA@@BA@AB@@@B@@@BB@BBB@B@@B@@@B@@AB@@BBB@B@@@B@BAB@BBB@@B@@@BA@@B@BA@@BA@@B@@BBB@B@B@@AB@@A@A@@@AB@BAB@@@BB@@AB@B@@@B@@@B@B@@@B@@@BB@BBB@B@BB@@BBB@BBB@BB@@@@FB@@BBB@B@BBB@@B@B@@@B@@@B@@@B@B@@BB@@BDB@BB@@BBB@B@BBB@B@@@B@B@B@BBB@B@@@@@DDB@BBBB@@@B@B@BBB@@BBB@@BBBB@@BB@BB@@BBB@BBBB@@BBB@@BB@@B@@DFBDDD@B@DABEHCBAB@FBDFDD@FBF@FA@@FADC@ADCDAD@B@@AB@@@B@@B@@B@@@B@D@B@@AB@B@BAD@B@B@D@B@B@D@D@D@B@B@D@D@F@F@BAH@FA@@B@DAB@@@@@F@D@B@D@B@FAFA@@B@B@@@@@BAB@@@FADA@AB@BAB@BAB@@AMËQ{GA@N¹~CĐHDBJ@BGjCÌtXYxBHqwAJF_J@C@EBE@E@E@E@E@C@@@EBE@C@E@E@E@E@EBE@C@G@G@E@EBE@E@C@E@EBE@C@A@@AA@@@@@AB@@@B@DABADADA@A@C@@AE@@A@A@ABABA@@@@A@@@A@A@@AAAAAC@@A@A@A@AAA@@BA@ADCBC@@@A@@@@@@A@AAC@AAE@AAE@A@AA@@@A@@@@B@B@B@B@B@BABADA@A@ABAAA@AA@A@@B@B@D@B@@ABA@A@AACAEAI@AAC@@AACCCAAA@@ABAB@@A@@A@@@A@A@@AAA@ABA@A@@@A@AA@C@A@A@C@@@AABA@ABA@@AA@ACAA@A@ABAD@BAB@@ABA@A@@AC@A@C@A@A@AAAA@@A@A@ABCBA@@@@AAABA@@@A@AAAA@@AAAA@A@@@ABE@A@C@@@AA@A@@@CBAB@@ABA@C@A@C@AAA@@A@@@AB@B@D@B@B@BA@@@A@@CAA@AAA@@A@@@@B@D@B@B@@@BA@@@CAAAC@AAA@A@A@AA@AA@B@@ABBD@B@B@BAB@@ABA@A@A@A@@AAA@@@A@@BABABABA@A@@@A@A@@A@A@A@C@@@A@A@A@@@AA@@B@BAD@B@BA@@@A@@@AA@A@C@C@AA@@@@@A@E@E@CBA@C@@@AA@@@AACA@@AAC@A@C@AAA@AAA@AA@AB@B@B@B@B@D@B@DAB@DAD@B@B@BABA@ABA@AA@@AA@A@@@AB@BABABA@@@ABA@A@CA@@@A@AB@DABAB@@AA@A@CAA@A@AACAAAC@AAA@A@@AA@@ABA@@B@B@DBB@BBD@B@D@B@BA@AB@@AA@A@EAEACAA@AAA@@A@A@C@A@CBAB@DADBB@BBB@@B@B@B@D@D@@@BBBB@BBB@B@B@BABABCBCBAB@DAB@D@B@BAB@@CBCBA@A@CA@A@A@EBA@A@A@AA@@AA@A@ABA@ADAB@DADA@@@A@A@A@AAAA@AAE@E@A@CAA@A@AAA@AB@@ABBB@B@BABCBABA@A@AAA@@AACAAA@CAA@C@A@AA@@AA@@@AB@DB@@B@BABA@A@AB@B@@A@@EAE@AAA@@@AA@AAAB@@AB@B@FBD@DBB@B@BBB@BAD@BCBABAB@@AAA@C@@@AACAACACAAAA@AAABC@ABABADABA@E@ICA@CCA@C@C@C@C@A@AAAA@CBA@ABABBDBDBB@B@B@BA@@AAA@CA@AAA@A@ABA@C@ABA@ABA@@B@@@B@@B@@ABAB@B@B@@@BBB@@B@@BB@BA@@@A@@AA@@@@A@@A@@AA@@@@BA@@BA@@BBB@B@BBBBBB@@B@@AB@B@B@B@BBD@BBD@B@DAD@BAB@BAB@@@@A@@A@@@A@C@A@A@A@@@AA@@B@@A@@B@BBB@B@BAB@BA@@@AA@A@C@C@A@A@AA@@AAAAAC@CAAB@@@BADABADA@AB@@A@@@AAA@@A@AAC@CAA@C@CAA@@BA@@@AB@@@@BB@@BB@B@@@BA@A@ABC@ABA@A@A@AAA@A@A@A@C@@@A@AAA@AA@@@AAA@@@AB@@@B@B@@@BBFBB@B@@@BABABAB@BABAB@BAB@BA@@BAB@@A@@@A@C@@ACAA@@AAAAA@CAAA@@ABA@@@@B@B@B@@BBBBBBD@BBBB@@@B@BA@@BC@A@C@CAC@A@CBG@A@A@@@C@@@AA@@@ABADC@ABA@@@AAA@@A@@@A@ABA@AB@@A@A@@@AA@@@A@A@A@@AAA@@@A@@@@@@@@BB@AB@B@@@BAB@@A@@@@@@A@A@AA@@@@@A@@@@@@@@B@@AB@@A@@@@@@AA@AAABC@A@CBA@EA@@A@@A@@BA@@BB@@BBB@BAB@@A@@@@AAAACAA@@A@A@@@AB@@@B@@B@@ABB@@@D@D@D@B@D@B@D@B@@AB@@AB@@AA@@@A@@@CBA@ABA@A@AAA@AAAA@@@A@AB@B@B@@@D@BBB@B@@@@@B@AA@@A@@A@@@A@A@@A@A@AAA@@AA@AA@A@@A@AB@@AA@@B@@@B@@ABA@@@AA@@@A@@@@B@@@BA@@@C@ABC@@@AA@@@@BAB@BA@@BABA@ABAB@@AA@@AAA@@A@CBAB@@@@@BBB@@@B@@AB@@A@A@A@AAC@AAA@A@@AA@AA@A@A@@BA@@@@BB@@@B@BB@BB@@B@@@@@@@@AA@AA@@@A@@@@B@B@B@B@F@D@@@@@B@A@@@C@AAA@AAAAA@AAA@@A@@@AB@BA@@@@B@@B@@@BBB@@BBBBB@B@@@@@@@@AA@A@@A@@@@@@@AB@DAD@@A@@@A@@@AAA@@A@@@@BA@@BA@C@C@ABA@@@AA@@@A@@@@B@@@BB@@B@@@DAFA@@BA@@@@A@@ACBC@A@A@C@AA@@ACA@AA@A@A@@@@BA@@@B@@B@@B@@@@@@B@@@BABA@AB@@@B@@@BBB@BB@BB@B@BBD@B@B@@AB@@A@@A@AAC@C@@AA@@A@@@AB@BAB@B@BA@@BA@ABA@@BA@@AA@AA@@@@@AB@BAB@@A@ABC@A@A@@A@@A@@AB@DABA@A@@@A@@AAC@@A@@@A@@B@BA@AB@AA@CAAA@AA@@AB@@@@@BB@BB@B@@ABCBA@CBA@A@A@@A@@@@@AB@DABABAB@@AA@@AAAA@AAA@@@A@@@@BBB@@BB@B@@ABAB@@@@A@A@@A@@@A@AB@@ABAA@@AA@@@@BA@@B@BA@AAAAA@AAA@A@@@A@@@AB@@@BB@@B@BB@@BA@A@AB@@A@@@@AA@@C@A@@@AA@A@@@C@A@@@A@@B@@@BAB@@@@A@CBA@@@A@AAA@@A@@@AB@@B@@B@@BB@B@@@@@B@@AAA@@@A@@BADAB@@A@@A@AAAAA@A@@@@@A@A@@@@@@@@@@B@@@B@@ABABABABA@ABA@@@A@@@@A@@AA@A@@@AB@B@BAB@@AB@@A@@AA@@@A@@@ABA@@@AA@AAAA@@A@A@A@C@@@A@@@@@@A@@@AA@@AA@AA@@A@@B@@@@@B@@BB@@@BA@@@@@@@@@AA@@AA@@@AA@AAA@A@A@@BA@@@@B@@@@BB@@BB@@@B@@@B@@@@@@A@@@@AA@@@@@A@@@@@@@@B@@A@@B@@@@@AA@@A@@@AA@@@@BA@A@@@A@A@@@A@@@@A@AB@@A@@BA@@@A@@AA@@@@A@@@@BA@@B@@ABA@@@@@@@@@@A@@BA@@@A@@@A@@A@@@A@@@@@@B@@@B@@A@A@A@A@AB@@A@@B@@@BB@@@@B@@A@@@A@@@A@AB@@@@A@@@A@@AA@@@BAB@@AB@@@@A@@@@A@AA@@A@@@@A@@@A@@@A@@A@@BA@A@A@@@A@@@@@BA@@@A@@@A@@@AA@@@AB@@ABABA@@@A@@@@@@A@@BA@@@@@A@@@@@AA@A@AACAA@@@A@AB@@ABC@AB@@A@@A@@@@@AB@@ABA@@@A@@@@@@A@C@A@ABC@@BA@A@A@@@AA@@@@@A@@BA@@@A@@A@A@AAA@A@@@@AA@@A@@@A@@@@BAB@B@D@@@B@BAB@BAB@@A@@BA@@@AA@@@AA@@A@@B@@@B@@@B@@@@AB@@C@C@@@AA@@@@@A@@BABAB@@A@@@AA@@@AAA@AAAA@@@@AA@@@B@@@@@@@B@@@@A@@@A@@AA@A@A@A@A@ABA@@BA@@@@@@@A@@@B@@A@@@@@AA@@@ABC@A@@@A@A@AAA@@@@AA@@@@AB@@@@@@@@@DB@@DBB@B@@@BA@@@@@@@AA@C@AAA@@A@@@@@@@@B@@@B@@@@@B@@@@A@A@@@@A@@@A@AB@@@@GBA@A@A@@@AA@@@@@A@@@@B@BBB@@@@@BAD@BA@@@A@@A@@@C@AAA@@@@@@A@@@@B@@@BA@@@@@@@@B@@A@@@@@@A@AA@@AA@@@@BC@@@A@@@@AAA@@AA@@@@@@@@@A@AB@BA@@@@@A@@@@@A@@@C@A@A@@@A@@@A@A@@@@@@@A@@@@@AA@@A@@@@@A@@@A@A@@@A@AB@@A@@@@@@@ABABAB@@@@@AA@@@@@A@@@A@AB@@A@ABA@A@A@AA@@A@@@BA@@@@DBB@@@BA@@A@@A@@@@@A@@@@@@@A@@BA@A@@@@AA@@@@@@@@@@A@@@@@A@ABABA@AB@@@@A@@AB@@@@AA@@@@@AA@@@A@@@@@A@@@AAA@@@@AAA@@@@@AAA@AAA@@@@@AA@@@@@@@@BA@@@@@@@@@@@@B@BBBBB@@@@@B@@@@B@@BA@@B@@@B@BA@@BA@@@A@@@@@AA@@@@@@@A@@@ABABC@@@A@@B@@AA@@@@@@@@@@DAB@DA@A@@B@@A@@@@A@@@@@@@@AA@@B@@@@A@@B@@@@A@@@ABA@A@@@A@A@@@A@@@@A@@@@@@@@@@B@@@B@@@@@@@@@BABA@@BA@@B@B@@@@AB@@@@@AA@@@A@@@@@@A@@@@@A@A@@@@@A@@@@A@A@@@A@@@A@@@A@@@A@@@@@@A@@@AA@@C@@@@@AA@@ABA@A@ABA@@@@@@@@@@@@A@@@@@A@@@@@@AA@@@A@@AA@@@AA@@@@@@@@@AB@@AB@BA@AB@BAB@@@@@@A@@@@@@A@@@@@@BA@@@@@@@AA@@A@@@@AA@@@@ABC@A@A@@B@AA@@@@@@@@@@@@A@@@@@@@A@@@@@@@@A@A@A@A@C@@@@@A@EBC@C@C@@@@BAA@@@@A@AAA@AA@AAA@@@@A@@@@@@@@@A@@@@@@B@BB@AB@@@@@@ABA@@@@@A@@@@@@@@@@A@@BA@A@@@@@A@@@@@@@@@@@@@@A@@@@@A@ABABA@@@@@@@@@AA@@@@@@@A@A@@@A@@@@@@A@@@@@A@A@A@@@A@A@A@@@C@@@@@A@@@@@@A@@AA@@@@@@@@@@A@@@@@C@ABA@@@A@A@@A@@A@@@AAA@@A@@@ABAB@@AB@@@BAB@B@B@@@D@@AB@@AB@@A@@@A@@@AA@@@@A@@@A@A@@@@@@A@@A@@AB@@A@@@A@A@@@A@A@@@@@AA@@AAAA@@@A@@@AB@BA@@@@@@@A@@@@@@@@@@@@@@AAAAAEAA@AAAA@@@AB@BA@@BA@@AA@@A@C@AA@@@A@@@A@A@A@A@A@A@ABAB@@@BB@@@FB@@BBAB@@A@A@A@@@GAAA@@@BA@@@B@BD@B@BA@@@@@A@@@ACAA@@A@A@A@@@A@AA@BA@@@B@DDBDBB@BA@@@@@A@@@AAAAC@E@A@CA@@@@CEAAA@KEA@@A@A@AA@AA@@EE@@CAA@EAGA@A@@@A@AA@@@@@IA@BA@@B@@BB@@@B@@@BA@C@AB@@@@@@@BFB@@BB@BBD@@@B@BBB@@ABA@AB@@B@BBB@@B@B@@ABAB@@@@A@@ABAA@@@A@A@A@C@I@CB@B@@@@H@D@D@B@DBBB@B@@@B@@@BA@A@A@A@IEA@C@C@A@CBCB@@@@B@BBF@B@B@BBBBBBAB@B@@A@A@@@AACACACAIE@AA@E@G@A@A@ABAB@@@@B@@BD@B@F@FBF@DB@@DDB@@B@@@@ABC@C@A@@AA@AA@A@@AAG@IAA@CAA@@@CBCB@@@@@B@@DB@@@B@@@@@@A@@@CCC@A@@@A@C@@BA@@B@@@BB@@@J@B@@@BB@B@B@B@B@@@@AB@@A@@AA@AC@AA@@@A@@@CDA@@@A@A@@@@@@A@A@AA@@A@@A@@@@@@@@@@@@B@@@@@B@@@@@@AB@@A@@@A@A@@@A@AB@@@@@@@@@@B@BBB@B@@@@B@@@@@B@@A@@@A@E@A@A@@@A@@@@@@B@@B@BBB@DB@B@@@@@B@@@@@B@@A@@@@ACAAAA@AA@@A@A@@@A@@@A@@B@@@@@@@BB@@@B@DBB@B@@@BB@@@@@B@@@@@@@@A@@AA@@AA@A@@@AB@@@@@BA@@B@@@@A@@@A@CAAA@@@@A@@BC@@BA@@@@B@B@D@BAB@BB@@B@@@B@@A@@@AB@@@@@B@@@@@@ABA@@@A@@@@@A@@@A@C@@@AB@@@@@@@B@@@@@@@B@@@@ABA@AB@@@@A@A@@@A@AAAA@@A@@@A@A@C@@@A@@@A@@@@@@AAA@@@@@@A@@@A@CBA@ABA@@@A@@@A@A@A@A@@@A@AAC@A@A@@@@@AA@@A@@@A@A@@@@AA@@@@@A@@@A@@A@@AACAA@CBABA@C@@BA@@A@@A@@AABAAA@A@A@@AA@@A@@@AA@A@AAAA@@A@@@A@@AC@A@CAA@AAA@A@@A@@@@A@@@@@A@A@A@A@ABA@@@@@@@@BA@@@@@@@ABA@@@A@@BAA@@C@AB@@A@A@@BCB@@@@@@@BA@CD@@AA@@@B@@@@@@@@A@@AAB@AA@@@AAA@@@A@A@@@AAC@ABC@A@C@CAA@@AA@A@@AA@@AA@A@ABA@AAC@AA@@@A@@A@@@@AA@@@@A@@@@@AA@@A@@AAA@@@C@A@AB@@AAA@AA@@@@A@@AA@AB@@CA@@@@@B@@A@AB@@A@AA@@@@AA@@@AA@@AB@A@@AA@@B@@A@@@A@@AC@AB@@AA@@A@@B@AA@@@A@@@@@@@@AA@A@@@@@@@@A@@@@@@@AA@@@@A@@@AA@@@A@@@AA@A@@@@A@@@A@@@A@@@AA@@@AA@@AA@@@A@A@@@AB@BA@@@@B@D@BA@@@A@@@@@@@@@@B@@A@AAA@A@@@ABA@@@AB@@A@CA@@A@@@A@@@A@AA@@C@C@A@A@A@A@@B@@ABEBA@A@@@A@@BA@@AA@@@AB@A@@@@C@@@A@@@A@@@@@@B@@A@@@AB@@@BC@@@A@A@@@AB@@@@CB@@ABAB@@AB@@@@ABAB@@@@B@B@@@@@@B@@@BAB@@@@@@D@@@@B@@@@@B@@A@A@@BB@@@@B@@DB@@@@B@A@BB@@A@A@@@BB@@ABA@@@@@@DAB@@@@@@AB@@A@@BB@@@A@AB@AA@AB@@A@AB@@@@@B@B@@@BA@@@@@@BB@@@@BA@@@A@@@A@A@@@A@@@@@AA@A@@AAC@A@A@@@@BC@A@@@A@A@ABA@@@@BA@ABA@@@A@@AA@@@CAA@EBABA@@@A@@@A@A@@@A@A@A@@@@@@A@A@@@@A@A@@@@A@@@@@@GAAB@@AB@@@@@@A@A@A@@@@BA@C@A@A@A@@A@@ABA@@AA@A@@@@@ABBB@@ABCDABA@@@A@@AB@@@A@A@@@A@@@AAC@@@A@@@AA@@CAA@ABA@A@C@AA@@ACAA@@A@AB@@@@@B@@@@ABAAAAA@A@C@A@AAA@@A@C@A@@A@@@A@A@A@@AA@A@A@CA@@AAC@@@AA@@AAAA@@B@@@AA@A@@AABA@@BA@@A@@@BA@@AAAAACA@BA@@BA@A@A@@AA@A@@B@B@B@B@@@@ADAB@BC@ABG@EBA@@@A@@B@@A@@@AA@@@DA@AAA@@BA@ABC@@@@FAB@@A@@BA@A@A@A@AA@A@A@CA@@@@@@@AB@B@@@AA@AB@@AA@@BAAAB@@A@ABA@ABAA@@@@A@A@C@A@@@@B@@A@CBA@AB@@A@CA@@ABA@@BA@@AA@@@A@EACBA@AAA@AA@BA@@@@B@@A@@BA@CA@AC@@@C@A@CA@@A@@@CB@@AB@@@@BB@@A@CB@@@@@@AB@@A@@@@@@@@B@@ABA@@@@@@BB@@@@@CBAAA@@@A@A@A@A@@@A@A@ABA@A@A@@B@@A@C@@@@BA@@B@B@@B@@@BA@@@B@B@@@@@@@BB@@@@@@@@@@B@@B@@BBB@@@@@@A@ED@@ABGBA@@@@BCDA@A@@B@@@@ABAB@@A@@@@B@@ABA@@@A@@B@@A@A@@@AB@@A@@A@@ABC@@@AB@@@@@B@@AB@@BB@@A@AB@BA@AB@@CBC@A@AA@@@@A@A@CACAA@A@ABA@CBC@@B@@@BC@A@@B@@@@C@A@A@@@@@@A@A@@A@A@A@@@CD@@C@@@@BAB@B@@ABE@@AA@@BC@E@@@A@@@@@@@@@@@@@@@BA@@AA@@@@A@@@@@@@@@AB@@@@@@@@@AA@@@@@@@A@@A@@@@@@A@@@@@@@@@A@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@A@@@@@@A@@A@@@@B@@@@A@@@@@@@@@@@A@@@@A@@A@@@@@A@@@@BA@@@@A@@@@@@@@@@@@@A@@@@@@B@@@@@@@@BB@@@@@@@@A@A@A@@@@@@@@A@@@@@@B@@@@@@A@@@@A@@A@@@AB@@@@AA@@A@@AA@@@@@@@@@@B@@@@@@@B@@@@@@@B@@A@@@A@@@@@@@@@@@@@@B@@@@@B@@@@@@@@@@A@@@A@@@@@@@@@@@@@B@@@@@@@@B@@A@A@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@AA@A@@@A@@@@@@@@@BB@@@BA@@@@@@@@@@A@@A@@@@@@@@@@@@@@@A@@@@@@@A@@B@@@@@@@@BB@@@@@@@@@B@@@@@@A@@@@@@@A@@A@@@@AA@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@BA@@@@@A@@@@@@@@@@A@@B@@@@A@@A@@@@BA@A@A@@@@@@@@@AB@@@@@A@@A@@A@D@@AB@@@@AB@@@BA@@@@@A@A@AB@@@@@B@@@@A@@B@@@@B@@@@@@@B@@@B@@B@@B@@AB@@@B@@@@B@@@@B@@@B@@@BB@@B@@@B@@@@@BA@@@@B@@@@@BB@@BB@B@B@@@B@B@@@BAB@@@B@@@B@@AB@@A@@@AB@@A@@@@@@@BB@@@BB@@BA@@@@B@@@@@B@BB@@@@@AB@@A@@B@@@@@B@@@B@@BB@@@BA@@BA@A@@B@@@BB@@B@D@@@B@@AB@@@@@@@BA@@@A@@@A@@@@@A@@@A@ABA@@B@@@@AB@@A@@B@@@@@@@@@B@@A@@BA@@BA@@@A@@@@B@@@@A@A@A@@@AB@@B@@B@@A@@BA@@@@BB@@B@B@@AB@BA@@BB@@@@@BB@@@@BB@@BB@B@BBBAB@@@B@@AB@@ABCBA@@@@BA@@@BB@@@@@@B@B@@B@@B@@@@@@@@B@@DBB@@@@B@@@@@@BB@@@@@B@@A@@@@BB@@@B@@@@@B@@B@@B@@@B@@@@BB@@@B@@@@@@@B@@@@@@@@BB@@@@@B@BA@@B@B@B@@@@A@@@@B@@@@@@@@@B@@@B@B@@@B@@@BB@@@@@B@@B@@@B@@BB@@B@BB@@@B@B@@@B@@@@@@@@@@B@B@@@BBBB@@@B@B@@@BB@@B@B@@@B@BB@BB@@@@B@@B@BB@@@@BBD@@BB@@@@BB@@@BB@@BB@B@@@B@@@@DBB@B@BB@@B@B@@B@B@@@@@BA@@@@@@@@BA@@@AB@@BB@B@@@@@@B@@A@@B@@BB@@@B@@B@@B@@B@@@BMPEHHFFDDBBBBBDBB@NJ@@KDCBEBIFMDEDK@O@O@O@KAAAA@ODOBODOBEBIBQDMBQDMBQDMDABCBI@QBOBO@OBQBM@EB@FAJ@F@D@@W@OAS@I@G@W@@@C@K@S@O@OAE@O@Q@Q@E@@@I@SAA@ECMKAAEEA@EAKAYEIAEAMCC@aEeIC@AeA@C@@@@@@@@@@D@J@B@D@F@D@B@B@D@@@@A@@@A@@B@@@@@L@@@@AB@@G@ABK@C@C]@A@G@C@K@G@A@C@A@C@A@@@E@A@E@A@C@@@E@A@C@A@E@@AGBC@BC@AI@I@G@@@@@BDA@@B@HBN@LQ@U@KB@GAM@EAAAAA@AA@@KGCCAAA@II@@CCOK@AA@CACAG@BH@HBH@F@FBF@BC@G@KBG@G@K@K@M@O@G@I@S@QBA@O@Q@I@I@O@KBM@O@G@ABEAA@S`OfGXHpDTARBN^VnNbC\\ARGhCX_HPN^D~NHYLURE`L\\^AJEHKNVL^LNBiZiES@oCyAU@]KaBCDAXIVJNBDB^F@N@H@LBF@B@P@P@F@B@LDjHBBJBJDD@FBD@F@NAB@JA^GB@BALAD@B@B@F@HBFBDBJHBBDDB@@D@B@@B@BBFDFD@BB@B@B@HBD@BBBBBBHHBB@@B@HDDB@@DBF@B@HCD@J@VALANBH@NBLBHBNB@@TBJBDBLDPDR@JCVOVS@ADEBCHIJERCPBRDTBBBLBBDDDB@D@B@L@D@H@F@JBF@DAD@HABAB@D@B@BBBB@@DB@@@@PBB@BBFBFNFDFHBBBB@@BBBBBBBB@BB@@R@D@HBBHX@F@BBDDBJ@F@BBB@@@DB@@BBBD@@@D@B@B@BABABQFGFABAL@V@NBJFJDDFFDBJDPBZH@@B@DBB@B@@BFB@@DBBBDBBBBBB@DBB@NDfHFBDBBFBBDFJFHBJBbFNBRBVARCBBDAN@"],encodeOffsets:[[135380,48545]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
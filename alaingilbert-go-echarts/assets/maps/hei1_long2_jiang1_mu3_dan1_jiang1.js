@@ -0,0 +1,6 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("牡丹江",{type:"FeatureCollection",features:[{type:"Feature",id:"231002",properties:{name:"东安区",cp:[129.626641,44.58136],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AD@@AB@@AD@@AF@@@@@@A@@@@@ADB@B@BBD@DBB@B@BBB@@@@@BA@@@@@AB@@ABCBA@@BCBA@@@@@@@A@@B@@ABBB@B@@@@@@@FB@@BBD@@@B@BBD@BB@@@@@@@@@@F@B@JAACCGAA@A@@@@@@@A@@@@DADAJFJDH@DAD@DA@@@A@@AEAA@A@CAA@A@@BA@A@@@@@@@@@@BA@ABC@CBA@@@@BA@BD@B@@@@@@@@@DCBA@@BC@ADE@A@@BA@@BAB@D@F@F@BBB@@@BA@@@@@@@AB@@@@A@@BABABA@@BABA@@@AB@BABA@@B@@@@@B@B@BAB@B@@AB@@@BAB@B@@@B@B@@AB@@AB@BA@@@@@A@@BA@@BA@A@A@A@AB@@@@A@@@ABA@@@AB@@A@@BA@AB@@@D@D@BA@@B@B@BABA@@DCB@BA@ABAB@BCBC@@BCB@@AB@@@BAB@@@BA@@B@B@@@BA@@@@B@@AB@BA@@DABABA@@B@@A@@B@@@B@@@B@@@@@@@B@@@@@B@@B@@@@B@@@B@B@B@@AB@B@@A@A@@B@@A@@B@BA@@BA@@B@@@BA@@B@B@B@B@@@B@@A@@B@B@@AB@B@B@@@B@@@@AB@@@@@@@BA@@@@@@B@DA@@@@B@@@B@@@@@B@B@@@@@B@@@B@@@B@@@BAB@B@BAB@B@@@BA@@B@@AB@@@@@BA@@@@@A@@@@BA@A@@@@@A@@@@@A@A@@@AB@@A@@@AB@@AB@@A@@BA@@@@BAB@@AB@B@@@B@B@BA@@B@@AB@@@BA@@BAB@@ADABAB@B@DA@@D@B@B@B@@BB@@@@A@@B@@@@AB@@@@@@AB@@@BA@@B@@AB@@@@@@A@@B@@@@A@@B@BA@@BAD@BAB@DADADAB@B@@AB@B@@AB@@@B@B@BA@@@@B@@A@@@@@AB@@AB@B@@A@@B@@@@@B@@AB@B@B@@AB@@@BAB@BA@@B@@@B@BAB@B@B@@@B@@@B@@@BA@@@@BA@@@@@AB@@@@A@@@@@A@@@A@@@A@A@@@A@@@@AA@@@AA@@@@@@A@@@@@ABA@@@@@ABA@A@AB@@A@@BA@A@A@@BA@@@@AA@@@@AA@@A@@@AA@@C@AA@@@@A@@A@@@@@A@@@A@@@@@@BA@@B@@@@@@AB@@ABA@ABA@@@A@@B@@CBA@@D@BAB@B@BAB@@@B@@@B@B@J@@@D@BABB@@BD@@BB@@BB@BB@BB@BBBBB@B@B@BBB@@@@BB@@B@B@B@BB@@B@@@@B@BAB@@AD@@AB@B@BB@@DBB@BBFBBB@@BBBBBB@BD@@@@@@B@D@B@@@B@@@B@@@BA@@B@@@@@@@@@B@@@B@@@B@@@B@@@B@@@B@BA@@B@@AB@@@B@@@BAB@D@@@@BB@@@B@@@B@@@@@@A@@@@@AA@@A@@@A@A@A@A@A@@AA@A@@@AAA@A@@@A@A@AB@@A@@@A@@@@@A@@@@AAA@@AA@@@@A@@@A@@AAB@AA@A@@@A@@AA@@@A@@@@@A@@B@@@BA@@@@BA@@BAB@@ABA@@BA@@@@@AB@@@@AB@B@B@B@B@B@@@D@B@@AB@B@@@B@@@BA@@BA@@@@B@B@BAB@@AB@@AB@@AB@BAB@@@BABAB@@@B@BAB@BA@@DAB@@@B@BA@@BABA@ABCBABA@ABA@A@@B@BABABA@AB@BABABA@@BABADABABABA@@@@B@B@@@BAB@BABAB@BABABAB@BA@@BA@@BABABA@ABADCBA@@@@BA@@BA@@B@BA@@@@@@BAB@@AB@@A@@B@BADADAFC@@B@BAD@BAB@B@B@D@B@D@BAB@B@DADABABABAB@@@B@@@B@@@B@B@@@B@@AB@@@@@BABA@@@@B@@AB@@@@AB@@@@A@@B@BA@@B@@@BA@@@AA@A@A@@@A@AA@@A@@@@@AA@@A@@@A@@AA@@@@@AA@@@AA@@@B@@A@A@@BA@@@@@A@@BA@C@@@A@@@@@AACA@@A@@@@@@AA@@@@A@A@A@AA@@A@@@@@@@A@@@@BA@@@@@@B@@@D@B@B@DAB@D@@@@A@@BA@A@@@@@@@AA@@@@@@@A@@@A@AA@@A@@@@@@AA@@AA@@A@AA@@AA@@@AA@@AAAAA@@AA@@@@A@AA@@@@@A@@@@@A@@@@@@BA@@@@@@@@BAB@@@B@B@@@B@@@@@BA@@@ABA@@@A@A@@BC@A@@@@@@@ABA@@B@@A@@@A@@@A@@@@AA@A@@@@A@@@AA@@A@A@AA@@A@@@CCCAAA@@@@@@@A@@@@@@@@AA@@AAAAC@AACA@@CA@AAAAAAA@AA@@A@@AA@@AA@@@AAA@@@AA@A@@@@@@@DA@@@A@@A@@@@@@A@@@@@@@A@@@@@@AA@@A@@@@@@ABC@@B@@AA@BC@@@A@AAB@@@@AA@@@A@ABA@@@AA@@@@A@@B@@AA@@C@@A@@@@A@A@@AA@AA@@@@A@@A@AA@A@AA@@@A@@A@@@AA@C@A@AAA@@B@AA@AAAA@@A@A@@@A@A@@A@AA@@AA@@A@@A@@@A@@A@@@@@A@@@AA@AAA@@@A@@AA@@A@@A@A@@@@@A@A@@@A@A@A@@@A@@@@@@AA@@AA@@AA@@@CA@@A@A@A@A@@@A@@@A@A@@@@@AB@@@@@B@@A@@@ABA@@BA@@B@B@B@@@@AB@@AB@@A@@@@@A@@@A@@@A@ABA@@@C@@@A@@@A@A@A@@@A@@@A@AA@@C@A@A@ABA@A@@@A@A@@@A@A@A@@@@@AB@A@@A@@AC@@@A@@AA@A@EBC@CBEAE@E@A@@@EBEBCB@@@@A@A@@@A@AA@@@@A@A@AA@@A@AA@@A@AA@@AAA@@A@@@@A@A@@@AB@@A@CBA@CBA@GD@@@BA@AB@@@@AB@B@@B@@BBB@@FD@@@@@BB@@B@BA@@B@@AB@@ABABCB@@AB@@@@AB@@AB@@@BAB@@@B@@A@@BA@CBABA@@@@@@@@B@@@@@B@@@DCDABABA@@BCBA@@BA@@BABAB@@@B@B@@@D@B@@@@@D@B@D@B@B@B@@@B@FBB@@@BAB@@ABAB@BA@@B@B@B@@@B@B@B@B@@@D@BBBBBBBB@BBB@BB@@@@BBBB@@@@@D@@@B@@@@EDCBCD@BA@ABABAB@@ABABABC@@@A@@@@B@@@@@@@@@@BD@@B@BDDDBD@B@DBB@@@B@B@@@B@@@@AB@@@B@@@@B@B@D@D@@@B@@B@@@BBB@F@F@B@BA@EDCBA@@B@@A@@BABA@@@CBC@@@A@A@ABA@A@A@ABC@A@A@@B@BA@ABA@AB@@A@A@ABAAA@A@C@A@A@A@C@AAABA@C@ABA@ABCB@BA@ABA@A@ABA@@BA@@BAB@@A@C@A@C@A@ABA@A@ABC@@BA@A@A@@BA@@BA@EDCBCDA@ABABA@ABA@ABA@A@@@@BCBAB@@ABA@@@A@@B@@AB@@A@AB@@@B@@@B@BA@ABCB@@ABABA@AB@@@@A@@@AB@@A@@BA@A@@@@@@@A@@@A@AAA@@@CBA@@@ABEBA@CBA@ABA@@BA@@@@@ABAB@BA@@BAB@BABA@ADA@ABAB@BA@ABAB@B@@AB@@C@ABAB@@C@@BA@@@A@@BA@ADCBAB@BA@A@@BA@@@@BA@@@@@@B@@BD@D@B@B@BAB@BA@ABA@CBC@EBGDEBGDA@A@@@ABA@AB@BA@@BA@@BAB@BA@ABA@ABABA@@@CDA@A@@@@@A@@BA@@@@@@@A@@@@B@@@@@@@@@B@@@B@B@@@B@@@@@@AB@@@@A@@BA@AB@@A@@@A@A@AA@@AAA@CAA@@@A@A@A@A@AAA@C@@@A@A@@@A@AAA@A@@@A@@@A@A@@@A@@@A@A@AB@@A@A@@BA@AAA@@@A@A@@BAAA@CBA@AB@@ABA@@BA@A@CBABA@@@A@A@A@AA@@A@A@@@A@@@A@A@@@A@AB@@ABAB@B@B@@@@@B@BBB@BBB@BBD@B@B@B@B@@@BA@ABC@A@ABC@CDA@@BAB@@@@@@B@BB@@BB@@@B@@AB@@ABA@A@A@AA@@A@@@A@@@A@@@AB@BA@AB@@AB@B@BAB@@@B@B@B@DAF@D@B@B@B@BABB@@@@F@D@@AB@D@@@B@D@@@BBDDBBBBBDDBBBBDDBBBB@@BB@@@BBBB@BBBB@@@@BB@@BBB@@B@@@@DF@@BD@@B@@B@@@@@@@@@B@@@@@@@BABA@A@@@A@@@@@C@A@A@A@A@@@@@A@@B@@@@@D@@@@@@@BL@F@B@J@D@JB@BHBDDB@BBHHBB@@BB@B@@@@BB@B@@@D@@@B@B@B@@@B@@@B@@AB@@AB@@ADAB@B@@@@AB@BAB@@AB@@AB@@AD@@@@"],encodeOffsets:[[132729,45652]]}},{type:"Feature",id:"231003",properties:{name:"阳明区",cp:[129.635615,44.596104],childNum:1},geometry:{type:"Polygon",coordinates:["@@G_GUYm][YQUKECsICiIkGm@A@DF@@@BGLADABA@C@A@A@MIGE@AQ@A@CBCBCBEDABCF@@ABEA@A@@BA@ABABC@@@ABAB@BA@@BABABA@A@ABA@A@@@A@A@@@A@@@ABA@A@@BA@ABA@AB@BA@A@A@@BA@@BAD@BABABAB@@ABA@@BA@@BAB@@A@A@A@@@AAAAA@A@@@@ACA@AAAA@AA@AAAE@AAA@CA@@A@ABA@@BC@@BA@A@A@@A@@@AAA@A@A@@@AA@@@@AA@A@A@AAAAA@AAAA@@AAA@@AA@@AC@@AAABC@@@I@A@A@@@A@@@A@ABA@A@ABC@@@AB@DA@@@@B@@AB@BAB@BA@@B@@@@A@@@AB@@@@@@@B@@@B@@@@@BB@@@@@BBD@@@BB@@B@@@BB@@@@BB@@@@AB@@@B@BAB@@@BA@@B@BAB@B@@@@AB@B@@@@@B@@@@B@@B@@BB@@@@@B@@@B@B@@@B@@@B@@@@@B@@A@@B@@@@AB@@@@AB@@A@@@A@@@A@A@A@ABA@@@A@@@ABA@AB@@A@@BA@A@A@@BA@@@@@A@@@@BA@A@@BA@@B@@@@@BA@@@@@ABA@A@@@A@@BA@A@@BA@A@CBCBCBA@ABC@AB@@ABA@@@@B@@A@@@@B@@@@A@@BA@@@AB@@A@@B@@@@A@@B@@A@@@@B@@A@@AA@A@A@C@@@CBA@A@ABCB@BA@AB@@AB@@A@@BA@@@ABA@A@@@A@A@@BA@AB@@@@AB@@@BA@@BA@@B@@@BA@@B@@@B@B@@@@@B@@@@@BAB@@@@@B@@@@AB@@@@A@@BA@@@AB@@A@A@ABA@A@AB@@A@@@A@@@A@@@@@A@A@@@@@A@@@A@@@@@CBA@@@@@@@AB@@@@@@A@@B@@A@@@A@A@A@@BA@A@@@@BA@@@A@A@A@A@@@AB@@A@@@AB@@ABA@@@@BA@@@@B@BA@A@@BA@A@A@@@A@@@@@@AA@@@@@A@@@@@@@A@@@A@@@A@@@@BA@@@ABABCB@@ABA@@BA@@@@@AB@@A@A@@@AB@@A@AB@@A@@BA@AD@@ADADA@AB@BABA@CD@@ABABA@A@@@ABC@C@@@A@@BAB@@@BA@@B@@AB@B@@@B@@A@@B@B@B@BAB@@AB@@@B@@@@ABA@@BA@@BA@A@@@A@A@AB@@A@@BA@A@ABA@A@@@@@A@@@ABABA@@B@@ABAB@@ABABAB@@@B@@A@@B@@@@@@AB@@A@AAE@E@C@A@AB@@AB@@@BCF@BAD@@ABCD@@@@@@@@A@C@@AAB@@@@AB@DAD@BAB@@@@@@@@@@@BAB@@@BBB@D@BBBBF@@@B@@CBC@CBG@ICIECBCB@@@@@B@@@@@@@BBBDHBDIBA@E@@@@@@@@@@@AAC@AAA@@@C@AA@@EA@@@@@@A@A@AA@BA@@@@B@@@@@@ABAD@@ABAD@BA@@B@@@@AB@@@@B@BB@@B@B@B@@@@@@@@@DB@@DBBBB@@@@B@@B@@@BDBBBB@@@BDBBB@B@@@@@@HHHLGB@@IDFLBBB@LB@FNB@B@BBB@B@BBB@BAB@@AD@@AFABABAFAD@BA@@B@@@BBBBDBFBDFJ@@BDBD@B@@BB@@B@@B@@B@D@@@FB@@@DBH@DBFDFBBBBD@D@F@FB@@B@DBHDDBDBBBBFCHCHCHMVEN@@AB@B@@B@@BB@@@BBB@@@BB@@BBB@B@BBB@BB@@@@B@B@BA@@B@B@BB@@@@B@B@@@BAB@B@BB@@@@@B@BB@@B@B@@@BB@@B@@@@@B@@@@@B@@@@AB@@A@@@@@@B@@BB@@@BA@@@A@@@A@A@@B@@@B@B@B@BB@@@BB@@B@DB@@@@B@B@B@B@B@B@B@B@@@B@@@B@@@B@@@@BB@B@BBBB@@B@BB@@BB@@B@BB@@@B@@B@@B@@BB@@@@@@@B@@AB@@@B@@@@@@AB@@@BB@@B@@BB@B@@@BB@@BB@@@@@AB@@@@AB@@A@A@A@@@@B@@@B@@@@@B@@@B@@A@@@A@@BA@AB@@AB@@@@BB@@@@@@@B@@@@@BA@@BA@@@@@@B@@@@A@@B@@AB@B@@@B@@@@AB@@A@@BA@@@@BA@@@AB@@ABA@@B@@@@@B@B@@@B@@@@@BA@@B@@@@BB@@@B@@@B@@@@@B@@@B@B@@@@B@@@@@B@@@B@@B@@B@@B@@AB@@@@@B@@B@@@@@@B@@@@@@AB@@@B@@@@@B@@@@AB@@@@@@@B@@@@@B@@@B@@@B@@AB@@@BA@@@A@A@A@@@C@@@A@@@@@@@@@A@@@@B@@@B@@@BA@@@@B@@@@@B@@@BA@@B@@@B@@@@A@@@@@A@@@A@A@@@A@@@A@@@A@@@AB@@A@@B@@A@@@A@AA@BA@@@ABA@@B@@@B@@@@@B@B@BB@@B@@@B@@@@@B@B@D@B@@@D@B@@@D@B@@@@A@A@@@@@ABA@@@A@A@@@A@B@@B@@B@@B@@AB@@AB@B@@@B@@B@ABB@@@BB@@@B@B@@@@@@B@BB@@@@@BA@@@@BB@@@B@B@B@@@@B@@@B@@B@@@@@@@@B@@@B@@@@@B@@@@@B@@@B@@@@AB@@@@AB@@@D@B@@@@@@B@@BB@B@B@B@@@@@B@@@B@B@@@BB@@B@B@BB@@B@@@@@BB@@@B@@@@@B@B@@@B@@@B@@@B@@@BAB@@@@@BB@@@@@@BB@@@@BB@@@@@@B@@@D@@@DA@@B@@@@@B@@@@@B@B@@@BAB@B@B@@@@@@@BB@@@BBB@@BB@@@@@@B@@@@@BA@@@A@@BA@@BA@@BA@@@A@A@@@@BA@@B@@A@@B@@A@AAABA@AB@@@@@B@@A@@B@@@B@@@@A@@BA@A@@@@@@@@BA@@@@BA@@@A@@@A@@BA@A@@BA@A@A@@@ABA@@@ABA@@BA@@@A@@BA@A@@B@@@B@BAB@@@BA@@B@@@@@@B@B@B@@@B@BA@@BB@@B@B@BB@BB@@@@B@@@B@@@B@@@@@B@B@@BB@@@B@@AB@@@B@B@@@@@B@@@@@B@B@@@@@BA@@B@B@@B@@@B@B@@@B@B@B@@@BBB@B@@BBB@@@@B@B@B@@@B@B@@@B@@@B@B@B@@@B@@@@@B@BB@@BB@@BB@@@BB@B@@@BA@BB@@@@@@B@@@BB@@BB@@@B@B@@@B@@@BB@@@BB@@B@@@B@@B@@B@@B@@BB@@@B@@@B@BA@@@@B@@@B@@BB@@BB@@@BB@@@BB@@B@@BB@@@BB@@@@B@@@B@@@B@@@@BB@@@@BB@@@B@B@@BB@@B@@B@@@@@@ABA@@B@@AB@@@BAB@@@@@BB@@@RGXIJKLMCIQGIMES@OVIfUNETIJGDAXQ@@TM@@DALK@AXWNKBCXWZO\\KVBbJR@@ENILAHDZIjGZALDf_FIDKFYBSCO@E@EHINKFIHMJMLSXeJKNMnoDKBCZMDLJFRHlQ\\G\\MHKBAAKQEeAeCEEFmZ{Dg"],encodeOffsets:[[133256,45626]]}},{type:"Feature",id:"231004",properties:{name:"爱民区",cp:[129.591537,44.596042],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ACCCCAA@@CAOCA@@@@AA@A@ICC@EA@@@@AA@@A@A@CACCEACA@AA@A@AAC@CAA@C@A@A@A@C@ABC@A@C@@@@B@@@@@B@B@BBBA@@B@B@BAB@BA@AB@@ABA@@@A@A@A@A@@@AB@@AB@@@BBB@B@@BB@B@@A@@BA@@AA@AB@@@BA@@@A@@@A@A@@@@@@@C@@@A@A@@B@@AB@@AB@@ABB@@@@@A@@@@@@@@B@@AB@B@@AB@@@B@@@B@B@@@B@@AB@@@B@BB@@B@@BB@@@BBB@@@B@@@B@B@@@B@BB@@BBBB@@BB@@B@@@B@B@@@B@@ABB@@B@B@@BB@@BB@BB@@B@@@B@@@BBB@@BB@@@BB@AB@B@@BD@@@@A@ABA@A@A@@@A@A@A@@BA@ABA@ABA@@@A@@@A@@BAB@@@B@@@BBB@@@D@B@B@@@B@@@BAB@@@@@B@@@B@@B@BB@@B@A@@BCBA@@BA@@@@BAB@@@@@BB@@@B@@@B@BBB@BBB@@@BB@@B@@@A@@@@@@BA@A@AB@@A@A@A@A@A@CB@@A@A@@@AB@@@B@@A@@@AB@@A@A@A@@BA@@@@BA@@@AB@@A@@BA@@@A@@@ABA@@BA@@B@@AB@@AB@@A@A@ABA@A@AA@@A@A@@@A@@@ABA@ABA@C@ABA@@@A@@@AACAA@@A@BA@@@ABA@A@@@AB@@A@AB@@@@@BA@@@A@@@A@@@C@A@@@@A@@A@@@AB@@A@@@@@A@@@@@AA@@AA@A@@@AAA@A@A@@BA@A@@@A@AA@@A@@@A@A@A@@AA@AAA@@AA@@A@@@A@A@A@A@A@AB@@@@@D@BA@@B@@@B@@@B@D@B@B@@@BAB@@@BA@@B@B@@ABA@@@@@@B@@@BA@@@A@A@A@AB@@@@AB@@@@@BCDCD@BA@@@AB@BA@A@AB@B@@A@@BABA@@B@@@B@@B@BBB@B@B@@@B@@@B@B@B@@@B@@BB@@@B@BB@@@B@@@B@@BB@@@B@@@B@@@B@B@@B@@B@B@@@BA@@B@@@@@B@@@B@@@BBB@@@BAB@BA@AB@BAB@@@B@@BB@BBBB@@BBBB@@B@@@B@@@BB@BB@BB@@B@@BBB@@B@@@BABA@AB@@AB@B@@@B@@BB@@@BA@@@@@A@@BA@@BA@AB@B@BA@@B@@@@AB@B@B@@@@ABABABAB@@@@@BA@@BA@@BA@A@@@CBA@C@ABA@A@CBA@ABC@@@@@ABA@A@A@ABA@A@@BA@AB@B@@AB@BA@ABABAB@@@BAB@@A@ABA@A@A@A@ABA@@@A@AB@@A@@B@@AB@BAB@@@B@B@B@@@B@@@@A@ABA@C@A@CBC@ABA@ABGBABC@A@A@ABE@A@A@AAA@A@A@A@A@A@@BA@@B@@@B@B@B@DBB@B@@@B@B@B@B@@BBBBBB@BB@@B@@@B@B@B@@@B@BAB@B@B@B@@ABABABA@AB@@ABB@@@@@B@B@BBB@D@BBBB@@B@B@B@B@B@D@B@D@D@D@F@D@B@BAB@BA@ADA@AB@BA@@BAB@B@B@B@@@B@@BDBBBD@BB@@FBDBB@DBDBBBB@@B@BBB@D@B@BBB@B@BBB@@B@@@BB@@@@B@@@BB@B@@B@@@B@B@BB@@@B@@ABB@@@DBB@BBB@BBB@BBB@B@@A@@B@@A@@B@@@B@B@B@B@BBB@@@B@B@BAB@B@BA@@D@@@BBB@FDDBB@DBDBBBD@DB@@DBB@BAF@@AB@B@B@BAB@@@B@BA@@@AB@BAB@D@B@D@D@BAB@D@BAB@B@B@D@BBB@B@B@B@@@BBB@B@BBB@B@@@@@@A@A@@@A@A@A@@BABAB@BA@@@A@@@A@AB@@@BA@@B@@@@@BA@@B@B@@@B@B@@@B@B@B@D@B@B@B@@@@@@AB@B@@AB@B@@AB@B@B@BB@@@@B@@@BA@@@@B@@@B@BAB@B@B@@@DBD@BBD@@@B@@@BAB@@@B@B@@@B@B@@AB@B@@@B@@@BBB@@@BBB@B@@AB@B@BA@@BA@AB@@AA@@A@AB@@A@A@ABA@ABABA@@@ABA@AB@@@B@@AB@BAD@BA@@@@@A@@@AB@B@@A@@@A@@@AB@@@@A@A@@B@@A@ABAB@B@@@BA@@@AB@@ABAB@BAB@DABAB@B@BAB@D@@@D@B@B@BBBAB@B@B@BABAB@BABAB@@AB@@AB@BAB@@@@@B@BAB@@@@A@@@C@@BA@AB@B@@A@ABA@@AA@@@C@A@AA@@A@@@@@A@@@A@A@@@A@@@A@A@@@A@A@A@@@C@AB@@A@@AA@A@@@A@@@AB@@AB@B@@@BA@@A@@@A@@A@@@A@A@AAA@A@A@@@ABABA@@B@@@B@@BB@BB@@B@@@@AB@@@@AB@B@@@B@B@@@B@B@@AB@B@@@BA@@BBBBB@@@B@@@B@B@@B@AB@@@@ABA@@BA@@@@B@@ABA@@@A@@@AA@@A@A@@AA@A@A@@B@@@@ABA@AB@@ABA@A@A@A@ABA@@BA@AA@@A@@@A@A@A@@@A@@AAB@@@@@@@B@BA@@B@B@B@B@@@B@@@B@@@B@B@BA@@B@@@B@BAB@@A@@BB@@B@@@@@B@@@@@@@@@@ABABA@@B@B@B@B@@@B@B@@@B@B@B@@AB@@@B@@B@@@@BB@@B@B@BAB@B@@ADA@@BAB@B@@ABBB@B@BA@@B@B@B@B@B@BAB@@@B@B@@@@@@BB@B@BB@@@BBB@@@@@BB@B@B@B@BAB@@@BACAGCCAA@@@EAE@C@C@AAAACEAE@CAG@C@@EA@@C@A@@@@AA@@@AA@@@AACAC@@EIACAEACAA@A@@@AB@@ABCBEBABABE@@BC@@BA@AAA@A@AAA@A@AMA@EKAA@AAEKJC@@HAGKGG@@@@@@@AAACA@A@@AAAAAC@@A@@@@A@@A@AACA@@CA@@@@@@@@A@A@A@@@AAA@@@@@A@AAA@A@CAC@AAA@A@EAAACA@@A@C@@AA@CCA@CCCC@@ECAAIEAA@@@@A@"],encodeOffsets:[[132695,45645]]}},{type:"Feature",id:"231005",properties:{name:"西安区",cp:[129.616058,44.577625],childNum:1},geometry:{type:"Polygon",coordinates:["@@BAB@@@B@B@@@B@@@B@B@@@BBB@B@B@@@B@BADAB@B@@AB@BA@@BAB@DAB@BB@AB@B@@@B@BBB@@AB@B@@@BAB@B@@@B@@@B@B@@@B@@@B@B@BBB@@@B@B@@@D@B@BBB@B@B@B@@@B@DBB@BB@@BBB@B@@@B@@@BAB@@AB@@@@@BA@@@@@@@A@@@A@A@@@A@@@@@@@@@A@@B@@@@@@@B@@AB@@@@@B@B@DC@@B@BABAB@BAB@@ABA@AB@@AB@@ABAB@BA@@B@B@HCFAHCFAD@DAB@BAB@@ABA@A@A@A@CAC@@@A@@@@B@@A@@B@@AB@B@@ABADABCB@@AB@@@B@@AD@@@BABAD@@@BA@@@ABABAB@@ABABAB@BCB@BA@ABA@AB@@ABABA@@@@B@@AB@BAB@DAB@FABA@@B@DAA@@@C@AAA@A@A@A@@@A@@@AAA@@@A@ABA@@@A@@@C@@@CAA@A@A@@@A@ABA@ABA@ABC@ABABA@@@@@A@@@@B@@A@@B@@A@@@A@@BA@@@AAC@C@A@CBA@A@@@A@AAA@A@AA@@@@AAA@A@A@A@A@C@AAA@A@@@A@A@C@A@A@A@A@ABC@A@E@A@A@A@A@AAA@A@@@A@C@@@CAA@C@C@C@A@ABA@@@A@A@A@ABA@A@@@A@A@A@C@E@C@A@C@A@G@G@A@C@ABA@A@C@C@C@A@A@A@A@A@A@A@C@CAC@C@C@@@CAA@A@@BAAA@C@AAA@@@A@A@A@A@C@A@A@A@@@A@@A@@AAAA@@A@@@A@@BA@@@@@A@@@A@@@AAA@@@@@A@ABA@@@A@@@CAA@@@A@C@@@A@A@E@C@A@A@A@AAA@AAAACAAAA@@@@@A@@@@@@@@B@@@@@BA@@BB@@BA@@B@@@@ABA@ABA@A@@@A@@B@@AB@BA@@@@BA@@@AB@@@@@B@@@@@B@@@B@@@@@@A@AB@@@@C@A@C@A@A@A@@@@@A@@BAB@@@@A@AB@@GDCBCB@D@BBBBBB@D@D@D@DBDBBDBD@B@B@B@HG@E@I@@@C@E@ABA@E@EBE@KBA@C@A@EBIBEBG@G@GBQBCBC@ABA@ABA@Y@CB@JRJv@LBDBD@AFCF@B@BA@@BA@@@@@AB@@A@A@@@ABA@AB@@@@A@@@A@A@A@@@ABA@ABA@@@CB@@@@AAA@@@A@@AA@A@@BA@@@AB@@@@@@CB@BAB@@A@@@@@A@@@A@A@AB@@A@AB@@AB@@A@@@A@CB@@A@A@@@A@A@@@A@@@AA@@AA@@A@@@A@A@A@@@@@ABA@@@@@A@C@@@A@AB@@A@C@@BA@@@@BA@@@@@A@@AA@AAA@@AA@@@A@@@@@AB@@@@A@AB@@A@@@@@@@A@@@@@@@@@@BBB@@@@@B@B@@@@AB@@@B@@A@@B@@@B@@A@@@@@A@@@@BA@@@@@@B@@@@@@@BA@@B@@@B@B@@@BBB@@@@AB@@@@@@@@@B@@BB@@@BB@@@AB@@@B@@@@@B@@@BB@@@@@@@B@@@B@@@@@@@B@@@@B@@BB@@@@B@@@@@@@@B@@@@@@@B@@@@@@@@@BB@@@B@B@@@BB@@@@@@@B@@B@@@B@@@@@B@@@@@@B@@@@@@@@A@@B@@@@@@@@@BB@@@@@@B@@@@@BA@@B@@@B@@@@@B@@B@@@B@@@B@B@@@BB@@B@@@B@MNENIPAL@DADFPBJDFHH@@DDVRBBBBNHNHTJFLB@@BHLFLBPB`ElMZHFFHFFFBB@BA@@B@@@B@B@@@BBB@B@BAB@B@@@BA@@BA@@@AB@@AB@BA@@B@@@B@@AB@@@BA@@B@@A@@B@@AB@B@B@@@BA@@B@@@@A@@BA@@B@B@@@DAB@B@B@B@B@@@BAB@B@@A@@@@B@@@A@@@AA@@A@AAA@AAA@@@A@@@A@@A@@@@BA@A@@B@@AB@DA@AB@A@@@AAA@@@@A@@@A@@@@BA@A@@@A@@@A@A@C@@AA@A@@@A@@BA@AB@@@B@@@B@BAB@BAB@@AB@B@B@@@B@B@B@BAB@@@@@AC@@@ABAA@@A@@AA@@AA@A@@@A@@@AA@@AAA@@AA@@@A@AA@BA@@@A@@@A@A@@@AA@@AA@AA@AA@@A@A@@@A@A@@@A@@AA@A@@AA@@@AA@@A@A@@BA@@@A@@@A@A@@@A@@BA@@@ABA@@@A@@@@@@B@@@@@A@BA@@BA@@BA@@@AB@B@@@D@@@@@@@B@B@@@B@@@B@@A@@BAB@@BB@@AB@@@@AAA@@@AAA@A@@BA@@BA@@B@B@B@B@@@B@BA@@BAB@@ABA@A@A@AB@AA@A@A@A@@@@@A@@D@B@D@BAD@B@B@B@D@B@DBD@BBB@B@@BDBFBDDDBB@B@@@BB@@@@FBD@JDB@B@@B@@B@PDDB@@BBDDDD@BB@@@@@BBJFBBFD@@DDDDB@DDB@@BD@B@@@DBBBFBBC@@@@B@@@@@@@BE@@BC@@BA@@BC@@@@@@BC@@BA@@BA@@BA@ABA@@@@@ABABC@@BA@@BA@@@A@@@A@@@A@A@A@@@C@@@AAA@@@@@AAA@@AAGGAAA@CCGA@AIAC@I@A@E@K@@A@@@@@@@C@@@@@AB@@@@@B@B@B@B@D@@@@@B@@@B@B@BA@A@@@@@@@A@@@@@@@@@AA@@@AC@@CE@@@@@AA@AA@@AA@@@@AAAAA@AA@A@@AA@@AAAACCAAAACCAAAACAAC@A@@@C@A@@@CBA@@@C@E@@A@BA@A@A@A@A@CBE@C@A@A@A@@BA@A@ABA@@BAB@@ABA@@B@@@B@@@B@@@BBB@B@B@BA@@BA@@@A@@AA@@AAA@@@@@@@BA@AB@DCD@BAB@D@BAB@@A@@@A@A@A@AAC@AAA@AAA@A@A@@@@@A@ABABA@@"],encodeOffsets:[[132711,45522]]}},{type:"Feature",id:"231081",properties:{name:"绥芬河市",cp:[131.152545,44.412308],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@A@@@A@SHA@A@@@GCC@AAE@ECA@A@AC@@AAACA@@@@@A@ABA@AB@@AF@@ABA@@BCBCBGB@@ABCAA@E@A@@@CBABABABAB@@ABA@EAC@CAE@C@C@CBA@@@@BAB@BA@A@G@C@CAA@IBA@ABA@@@C@@@@BA@@@@BB@AB@B@@@B@B@@@B@BB@BB@B@@@B@B@BAB@B@@@BBB@B@@BB@@@BB@@B@@B@BBBBBB@@@@BBB@F@D@BB@BB@BBB@@BB@BBB@FDDBB@DBD@DBB@@@@B@@@BBB@BB@@BA@@@@@ABABCBABADABA@@@A@@@A@A@A@C@ABCBC@CBABA@C@ABA@C@@BC@A@C@A@@@A@CBABC@@BABA@C@@BA@ABA@AB@@A@@@@B@@A@@@@B@BAB@B@@@BA@@BAB@BCB@@ADAB@B@D@@@@BBB@DBBBD@DBB@@@DBB@B@@@B@BB@@B@@@BB@@BBB@BB@@BB@@@@@B@B@@@B@B@@A@A@AB@BAB@@@B@B@@BBBBB@@B@@BB@@@B@@@@BBB@D@D@D@BAB@B@D@D@B@@BB@@@BB@BB@@B@B@@@BBB@@@B@@AB@@@@@B@B@B@DA@@B@B@B@@BB@@@BB@@@@B@@BA@@D@B@@@@@@@@B@B@@@BBB@@BD@@B@BBB@DBD@BAB@@@DBDF@BBBBBAB@@@@@BA@CBABA@@B@B@B@@@BFDBBB@BBBB@B@D@B@D@DB@BD@@@B@BBBB@@B@@@B@D@@ABA@@B@@@B@@@DBB@BDD@B@@@B@BAB@@BB@@@@B@DBDBB@@@B@BABAB@@@B@BAB@@@B@@@BB@@B@@@B@@@D@@@B@@@@B@@BD@D@B@B@BB@@@@B@@BB@@@@BBBBBBBB@@@BB@@B@@@@@@@B@@@@@B@BAB@@A@@@@BB@@@@BB@@@B@@@B@@@@BBD@@BB@@FBBBFBDBHBB@@BBB@@H@B@B@DBB@FBDBFBJ@B@@@BB@@DBB@BBB@@@BAB@B@D@BBB@@@BB@@B@B@@B@B@@B@@@B@@A@AB@@AD@@@BB@@@@@@B@BAD@BAB@@@@@BB@@AB@@@B@@@B@@BB@@@@AB@@@B@@B@BBB@B@@@DBBBFBB@BBFB@@B@B@F@B@BAH@B@B@DAB@DB@@B@BAB@B@B@B@BBD@@BD@B@B@DA@@B@D@BBBAB@B@@@B@B@BAD@D@BEBE@CBEBCBGBGBEBCBE@CBC@@@CBEBCBEBE@CBA@EBCBC@ABA@EBA@A@CBABE@A@@BC@A@CBABCBG@CBE@ABCBEBC@A@ABE@ABC@ABC@A@@@ABABE@CBGBEBC@ABABG@CBEBC@ABC@ABC@CBC@CBCBC@CBA@C@ABEBABEBGBEBE@A@CBABE@CBEBEBGBA@CBEBG@@@ABA@CBCBC@A@CBEBA@ABC@ABC@CBC@CBA@A@ABA@A@ABE@@A@@@A@ABA@@@AA@@@@AAAAA@@@@AA@@B@@ABA@@@@@A@@@A@A@ABC@A@A@@@ABA@@@AB@@A@@@AB@@@@@@AB@@@BB@@B@B@@@BA@@A@@A@CA@@AA@@A@A@@@@AA@AA@@C@A@A@A@AAA@EBE@ABCA@@EAAAA@A@E@C@EACAC@G@E@K@G@G@E@G@O@O@A@IAG@@@@@AB@@AB@@A@@@AB@@A@@@A@A@@@A@@B@@A@@BA@@@A@A@A@@@A@@@A@A@A@AA@@A@@@A@A@@@A@@@A@@@A@A@@@AAA@@@A@A@A@@@A@@@@@A@@@A@@@A@@@@@A@@@@@A@@@@@A@A@A@A@AB@A@@A@@@@@AA@@A@@@@@A@A@@BA@@BA@@@AB@@@@@@A@@@A@A@AA@@AA@@"],encodeOffsets:[[134210,45365]]}},{type:"Feature",id:"231025",properties:{name:"林口县",cp:[130.284033,45.278046],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@A@AAA@@@@@A@A@@AA@@@A@A@@@@@@@@@@ABA@@@A@@A@@A@@@@@A@@@@AA@@@AA@@@@@@@@A@A@@BA@@@AB@@A@A@@@ABA@@@AA@@A@@@@A@@@@@AA@@AA@@@AAA@@A@@AA@@@AA@@A@A@@@A@A@ABA@A@A@A@A@@@A@@@AAA@A@A@ABA@A@@B@@@@AB@@@@@@A@A@@@A@AB@@A@@@@BA@@BA@@B@@@@@@AB@@@@@B@@@@@B@@A@@B@@A@@@@@@B@@A@@B@@@@@BA@@@A@@@@@@@A@@@A@A@@AA@A@AA@@@@@@@@A@@@@@@@@@A@@@@@@BA@@@@@AB@@@@@@A@@B@@@B@@A@@@@B@@@@@@@B@@@@@@AB@@@@@@A@@@@BA@@@AB@@@B@@@@@BA@@B@@A@@B@@@@AB@@A@@BA@AB@@@@A@@BA@AB@B@@@B@BAB@@@B@@@@AB@B@@@BAB@@@BA@@B@BA@@@@@A@A@A@@AA@@@A@@@AB@@AB@@@B@@@B@@@@@@A@@BA@A@@BA@@BA@CBA@CBA@A@A@@@C@A@@@A@A@@B@@@B@B@@@B@B@@@@@B@@AB@@AB@@@@@B@@A@@BA@@@A@A@A@@@A@@BA@A@@BA@AB@@@@@BA@@@AB@@AB@@ABAB@BABA@AB@@AB@@A@AB@@@@A@CBABA@A@AB@@A@@AA@@@@@@@@@@A@@@@@@@A@@@@A@AACAA@CA@@AA@@A@A@AAA@C@A@CAA@C@CAA@A@A@A@ABA@A@@@A@@@A@A@A@AAA@A@A@A@AAA@A@@A@@A@@@@B@@@@@@@BA@@@@BA@AB@@@@A@@B@@A@@@@@@BA@@@AB@@AB@@A@@BA@A@@BA@@@@@@@@@@B@@@@@@@B@B@@@BA@@BABA@@@A@@@A@AB@@A@ABA@CBA@ABABCB@@@@A@@@AB@@@BABCD@@ABA@@BA@AB@@A@A@A@@@ABA@A@A@@@A@@@@AA@@AA@@@AAA@@AA@@@A@AAA@@@@@AA@@A@@AA@AA@A@@@@@@A@@@B@@@@@@C@AB@@A@A@A@@@ABA@@BA@A@@@@@A@@@@@@A@@@AA@@@A@@@@@@@@AA@@@A@@BA@@@A@@AA@@AAAAA@AA@AAAAA@A@A@@@@BA@AB@@A@@AA@@AAA@@AA@BA@A@@@AA@@AAA@AA@@ABA@A@@BA@A@@BABA@A@@@@@@A@A@AB@@A@AB@@A@@@AAA@A@A@AA@@A@@AAAA@AA@@A@A@A@@@A@AAA@C@A@A@@@AAA@A@@@AAA@@AA@AAA@@A@@AB@@A@@B@@AB@@AB@@@B@@AB@@@B@@AB@@@@@B@@A@@BA@@@A@@BA@@@AB@@ABA@@BA@@@AB@@A@ABA@ABA@@BA@@B@@AB@B@@AB@@A@@B@@A@A@@BA@AB@@A@AB@@AB@@AB@@A@ABA@ABA@ABC@A@ABA@@@A@@@A@@@A@@@A@@@A@A@@AA@@@A@AA@@A@A@@@AA@@A@@@A@A@@@A@@@@BA@@B@@AB@@@BA@@BA@@BA@@BA@@@A@AB@@ABA@ABAB@@A@@@@BA@@BA@@DA@CBC@A@GBC@C@E@A@A@A@ABCBA@ABA@@BA@AB@@AB@@AB@BAB@BAB@BA@@BA@@BABABCDABA@@BA@CBCDCBA@ABABA@ABCBABA@CBABA@A@ABA@@BBB@@@B@D@D@BAB@@@@@@@B@B@@BD@D@BBB@BBD@BB@FD@BBBAB@BAB@@A@ABAB@BA@C@C@A@C@CAEAA@A@C@A@CBA@ABAB@@ABA@ABA@AACA@@CAA@@@A@@@A@@@@@@@@B@@@BAB@BA@@BA@ABA@CBABA@CBA@ABA@A@A@A@AAA@A@C@@@C@AACC@@@A@@A@@@@@@@@@A@@B@@ABADABADA@@@@B@B@B@BBBBD@BBB@B@@A@A@AAE@A@A@A@A@E@A@EACBC@C@CBABA@AB@@A@CBA@C@CBABAB@@CB@@@BA@@@@@AB@@CBA@ABCDCBAB@B@@A@@@@@@@@B@@@@@BAB@BCBCBC@@BA@@@A@@@@@A@@@AB@@@@@B@@A@@BADCD@BA@@@DB@@BB@@B@BBB@BB@@@@DBB@B@@@B@@@@B@@@@@B@B@B@@@@@BAB@@AB@B@@@@AB@B@@B@@B@B@@@B@@BB@B@@@B@@@BBB@B@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@A@@@@@C@A@C@@BAB@@A@@@@@@BA@@B@B@@AB@@@B@@@@@BAB@B@BB@@B@@BBB@B@@@BB@@BB@@B@@BBBBB@@@@@BAB@B@B@@@B@@@@@B@@@@A@@B@@BD@@@BF@D@@@B@@@BA@@B@@@B@@@BBB@H@FDHBDB@@B@@@B@D@@@D@FBD@BBBB@BB@B@BBFDDBDDBBAB@@C@EBABCB@@ABABABAB@B@DAB@BABAB@@@B@@@@@BAF@B@BBDBBB@@B@@@B@BAB@@A@@B@@@@@B@B@BABBD@DBB@BDDBD@BBD@B@B@@BBB@D@DAD@FCB@DAB@DBB@BBDBBBDB@@DBB@@@B@F@H@D@D@D@B@B@HBJBD@D@DAB@HAB@B@BDDBBBDBDB@B@BADAB@BA@ABA@A@AB@@@BADADADADAF@DABA@@B@@BDFDDDDBFBDBDBD@B@DADBD@F@DBDB@@DABADAD@BAB@@@DABABADAB@DABC@@BA@ADA@ABAB@DBHDDBBB@B@B@@@B@@BD@DBDBFBBBBDBDD@B@B@@ABABABAB@@A@BB@@DBB@BBD@@@BAB@B@DCBAB@@@B@B@@@DBBBB@@@@@B@B@@@B@DAB@B@F@F@@@B@B@BAB@BAB@@@B@@@@@@@B@@@B@@B@B@BBBBD@B@D@@@@@B@B@@@@@B@@@@@@@B@@@@B@@@@@@@@@@@BA@@@@B@B@B@@@B@@@@@@@@@B@B@@BB@B@@@@@B@B@B@@@@@B@@@BB@@@@@@@@B@@@@@@@@AB@BB@@@@@@B@@@BBB@BBBBBBD@BB@@@@@@B@@B@@@BA@@B@B@@B@@@@@@@BB@@BBB@B@@@@@BB@@@@B@@AB@@@@@B@BA@@@@@@B@@@@@@@B@@@@@@@@B@@B@@BB@BBB@BBB@B@@@B@@B@@AB@@@@BB@BBB@BBB@@BB@BB@@BB@@BB@@@@@B@@@@@@@@@B@@@@B@@@@A@@BA@@B@@A@@B@@@B@B@@@B@@@@@BA@@@@B@BABADCD@@AB@@CBCBCBAB@@@@@DBBBB@BB@B@D@@BB@B@D@@@B@DBDBBBDBBBB@B@B@B@@@B@@B@@@@B@@@BB@@@@AB@@@B@@@B@@B@BBBB@@AB@BA@ABC@ABCB@@@BBBABA@ABCBAB@B@@BBDBDBDD@@BBB@@@B@B@BBB@B@DBBBDBBB@@D@B@@@BA@AB@BAD@@AB@BA@AB@DADAB@DAD@B@BAB@@AB@@ABBB@BDBDBBDBD@BBB@B@BBB@@B@BB@BB@@@B@B@BB@BBBB@@@B@@B@@@@@BA@@B@B@DA@AB@@@B@@@B@B@BAD@D@B@B@BA@ABA@@@AD@B@B@B@BA@@BA@@B@BAD@B@@A@@@A@C@AB@D@BAB@BA@ABA@@DAB@BBDBDB@B@D@BB@B@DBBBDBBBB@@@B@BABBB@B@DB@@BAD@B@B@BBFBBBB@BBD@B@B@B@@@BBF@DDD@DDDB@BB@B@B@BBBB@@DBB@B@@ABAB@FAB@BAB@BA@@@AHBDBD@BBB@B@@@@@B@@@DAB@@@BAB@@@DAB@B@B@DAB@@@@@B@B@@@@@@@@@@@B@@@@@B@B@B@@AD@@AB@@@@AB@@@B@B@@@B@B@@A@@B@@@@@@@@@@@@@@A@@B@@@@@@A@@@A@@@@@@@AB@@@@@@@B@@@@@@AB@@@@@BBBBB@BBBBB@B@BBB@B@B@@BB@@@@@@@B@@@@@@@@@@@@B@@@@@BB@@@@B@BB@@B@@B@@@B@@@@@@@@B@@BB@@@@@B@B@@BB@@@@@@@@@@@@@@@B@@@@@@AB@@@@@@AB@@@@B@@B@@@@@@@B@@@@@B@@@B@@@@AB@@@@@@@B@@@@A@@B@@@@@BA@@B@@AB@@AB@@@BA@@@@BA@@@@B@@@@B@@B@@@@AB@@@B@@@@@BA@@@@B@@@@@B@B@B@B@@@@AB@@@BA@@@@@@B@@@BB@@@DB@BBB@@BBBB@@B@@BB@@@B@@@@@B@B@@@B@@AB@@B@@@@@B@@B@@@@@B@@@@@@@@B@@AB@B@@@@@B@B@B@@@BAB@B@@AB@B@@@B@B@B@@@@AB@@@B@B@B@B@@@B@BAB@@@BA@A@@@ABA@A@AB@@@@@B@@@@@@@@@@@@@@AB@@@@@B@@@@@B@@@B@@@D@B@D@B@@@B@@A@@B@@@B@@B@BBB@@@@@B@@@@BB@@BB@@@BB@@B@B@@@BAB@@@B@@@@BB@BBB@BBB@BBB@@@@@@B@@@@@@@B@B@@@@AB@@@BBB@BB@@BB@DBB@@@B@@@B@B@@@B@@@B@@@B@B@@@B@B@@@B@BABAB@@@BA@@B@@@B@B@BAB@@@B@@@@@B@@@BBB@B@B@@BB@@B@@@@B@@@B@@@BA@@B@B@@BB@B@@@B@@@B@@BB@@@B@@@BB@@B@@@@@@@@@@@@@@A@@B@@@@@@@@A@@@A@@B@B@@A@@BA@A@@@@BA@@B@@A@@@@BA@@@@@@@A@@@@@@@@@A@@@A@@B@@A@@@@@@B@@@@@@@@@BA@@@@@A@A@@@@@@BA@A@@B@@@@@@@B@@@@@@@@AB@B@B@@@BA@@B@@@BAB@B@@@@@@A@@@@@@@A@@@@B@@@B@BA@@B@@A@@@@BA@@B@@@B@@@B@@@@AB@@@@@@A@@@ABA@@@A@@@@BA@@@AB@@@@@@@BA@@@@@@B@@@@@B@@@B@B@@@@@B@@@@@B@@A@@@@@@@A@@@@@AB@BAB@@A@@@A@@@A@@@A@@@@B@@A@@D@@@BA@@@@@@@@@A@@@@@A@@@@AA@@@@@AB@@A@@@@@A@@@@@@@A@@B@@@@@B@@@@@B@@@B@B@B@BAB@B@@@B@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@@@A@@@AAA@@@@@@@@@A@@@@@@@@B@@@@@@A@@@@@@@AA@@A@@@A@@@@@@@A@@@@@@BAB@BA@@B@@@BA@@B@@AB@BA@@@@B@@@B@@@@BB@@@@@@@BB@@B@B@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@BA@@B@@@@@@@B@@@@@BB@@B@@@@@BB@@@@@@B@@@BB@@B@@@@B@@B@@@@@@@@@@@BB@@B@@B@@@@B@@@B@@@@@B@@@@@@A@@B@@@@@BA@ABA@@@@B@@A@@B@@@@@@AB@@@@@@A@@@@B@B@@@B@B@@@B@@A@@@@@@B@@AB@@@@@@@@@@A@@A@@A@@B@@A@@@@@@@@@A@@@@@@@AB@@@@@@A@@@@B@@A@@@A@AB@@@@AB@@@@@@@@@B@@@@@B@@BB@@@@@B@@@@@@@@BB@@BB@@@@@@B@@@@BBB@@@BB@@@@@@@@@B@@B@@@B@@@@@B@@@B@BA@@BA@@B@@@BB@@@@B@@AB@@@@@@@B@@@@B@@B@@B@@@@BB@BB@@@BB@@BB@@@@@BB@@@@@B@@@B@@@@B@@B@@@@@B@@@B@@@BA@@B@@@BBB@@@B@B@B@B@B@B@B@@@@@B@@@@@B@@@@@B@@@@@@@B@@BB@@@B@B@@@@@BA@@B@@@@@B@@@B@@@B@@B@AB@@@B@@@@@@@BBB@BB@@@@B@@@B@@B@@BBB@@@BB@@B@@@@@B@@@@@B@@@B@@@BAB@@@BAB@@@B@@@B@BB@@BA@@B@B@B@@A@@B@@@BB@@B@BB@@B@@@B@@@B@@@@@@A@@@@@A@@@@BA@@@@@@CA@@@A@@@A@@@@@A@@B@@@B@B@@AB@@AB@@A@@BAB@BAB@@@BA@@@@BA@@BA@@@A@@B@@@B@@@@@@BB@BBBBBBB@@@@@B@@@BBB@B@B@@@B@BB@@BBB@@BB@@@@B@@B@@@@@B@BA@@B@B@BAB@@@B@BA@@B@@@@@@@B@@B@@B@@@@@@@BBB@@@B@@@@@B@B@B@@@@@BB@@@B@@@B@@B@@@@@@@BAB@B@@A@@@@@@BABA@@B@@@B@@@BA@@@@@@B@D@@BB@B@@B@@@@@B@@@B@@BBBB@@@@B@@@BB@BB@@@@@BB@@B@@@BBBB@@@B@@@@@@@@B@@@@B@@B@BA@@@@B@@@BA@@BA@@B@BA@@@@BBD@B@BBD@@@@@@@B@B@@@B@@A@@@@@@B@@@@@@@@@@A@@@@B@@A@@B@BA@@B@@@@@B@@@@AB@@@B@@@@AB@@@BA@@B@B@BA@@B@@@@@B@@@@@@@BA@@B@@@B@@@@@B@@@B@@@B@@@B@@A@@@@B@@@B@@@@AB@@@@@@@@@@AB@@@@@@A@@B@@@BAB@@@B@@AB@@@B@@@B@@@@@@@BA@A@@B@@@BA@@BA@@B@@@@AB@@@@@@A@@@@BA@@@@@@@@B@@@@@BBB@@BB@@@@@@@B@@@B@@@B@@@@@B@B@B@B@B@B@@@B@@@B@@A@@@@B@@@BA@@@AB@@@B@@@@@@@@@@A@@B@@@@@@@@A@@@A@@@@@A@@@A@@@A@@@@@A@A@@@A@@BA@@@@@@@A@@@@@A@@B@@A@@@@B@B@@@B@@@B@@AB@@A@@@AB@@AB@@AB@@@@A@@@@@@BA@@@@BA@@@@@A@@B@@A@@B@@@B@@@@A@@@@@A@@@@@A@A@AB@@ABA@AB@@A@@@@@@@@@@@@A@@@@AA@@@AA@@@@AA@@@@@@@@A@@BA@A@ABA@A@A@@@@@AAA@@@@@@@@@B@@A@A@@@@@@@@@AB@@@@A@@@@@@@@A@AA@@AA@@A@A@@A@@@@AA@@@@@A@A@@@@@AA@@@AA@@@@@A@@@@@@@A@@B@B@@AB@@@@@@@@A@@@@AA@@@@AAA@@@@@@@@@@@@A@@@@@A@@@A@@@@@@@A@@@@@@BA@@@AB@@@@A@@@@@@@@BA@@@@BA@@@A@@B@@@@AB@@@@@@@@@@@@@@AB@@@@AB@@@B@B@BA@@B@BA@@@AB@@@@@B@@A@@B@@@@@@A@@@@@@@@@A@@@@B@@@BAB@B@@@@@B@BA@@B@@@@@@A@@A@@@AAA@@AA@@AA@@AAA@@@@A@@@AA@@A@@@@@AA@AAAAAA@@A@@A@AA@@AA@@A@A@AA@@A@@@@@@A@@@@@@BA@@@AB@@@B@B@@@@@B@@@BA@@@@B@@@B@@@@@@A@@B@@ABA@@@A@@BA@@@AB@@ABA@@B@@AB@@@BA@@B@@@@@@@@@@AA@AA@@AA@@A@@A@@@@A@@A@@@A@@A@@A@@@A@@@A@@@A@@@@@A@@@A@@B@@AB@@@@@@@@A@@@@@A@@AA@A@CAA@AAA@AAA@A@@AA@@@A@@@@@A@@BA@ABCBCBC@@BCBCBC@ABA@@B@@@D@BA@@@@B@@A@@@A@@A@AAAACA@AA@AACAA@AAAAA@A@@A@@@@@@@@@@@@BAB@BAB@@ABA@@@@@A@@@A@A@@A@@@AA@@A@@AA@A@@@@AA@@A@@@@AA@@@@B@@@@@@A@@@@@A@@A@@AA@@@@AA@@A@@@@B@@A@@@@B@@A@@B@@@@A@@@A@@AA@@@A@A@@A@@@@A@@A@@A@@AACA@@@@@@AA@A@@@@@A@@@@@@@@AA@@CA@@AA@@A@@A@@@AA@@A@A@@AAA@@A@@A@@@AA@@A@@@@A@@AA@@@A@@@A@A@@A@@A@AA@@A@AAA@AAA@@AAD@B@B@@@B@B@@@BA@@@@@@@A@@@AB@@@@A@@BA@AB@@@@@@A@@@@@@@@@AB@@@B@@@B@@@BA@@@A@A@@@AB@@A@A@@BA@@@@@@B@@A@@BB@@BA@@@@B@@@@AB@@A@@@AB@@B@@BBB@BB@@BB@@BBB@BB@@B@@@@@@@@@B@@@@@B@@A@BB@@@B@@@BB@@@@B@@@BB@BB@@BBB@@B@@@@@B@B@@@B@@@@@@@@@B@@@@@BBD@B@BBB@@@@@@@@A@AAA@@@A@@@A@A@A@A@@@@@AA@@A@@@AA@@A@A@@@A@@AA@@@A@A@@@@@A@A@@@A@@@AA@@A@@@@@A@@@@@@B@@A@@B@BABA@A@@BA@@@@AA@A@@@@@A@@@@@A@@@A@@@A@C@A@A@AB@@@BAB@B@H@FBD@B@B@F@B@@@B@B@B@BBB@@@B@D@D@B@@@B@@@BAB@@@B@D@F@B@BB@@B@@@BB@@@B@@@B@B@F@BA@@@@@@B@@@@@@@@@@@@B@@BB@BBB@@@B@@BB@BB@@B@@@DBB@B@B@BB@@@@@@B@@@@@@@@@@A@@@@@AB@@A@@@AB@@A@@BA@@BAB@@AB@BAB@@@BAB@@@@@@@@@B@@@@@@@AA@@@@@A@@@C@@@@@A@@@ABAB@@A@ABA@@@ABA@A@@@@BA@@@@@AB@@@@@BA@@@ABC@A@ABA@@@A@@@@B@@A@@B@B@D@B@@@B@@@@@BA@@B@BAB@B@BAB@B@BAB@B@BAB@@@BA@@@AB@@@@@@A@@B@BA@@BAB@B@B@B@@@B@B@B@@@BA@@B@@@@@B@@@@@B@@@@@B@B@B@B@B@DAD@D@BAD@BAB@B@B@@AB@BA@@BA@@B@@@B@@BB@@@B@@B@@B@@@@@@@@@@@@B@@@BA@@B@B@B@D@@@BB@@BB@B@@BB@@BB@@B@@@@@BABB@@@@BBB@BBB@@B@@@BBB@B@@@BB@@BB@B@BBB@@@BB@@BBB@B@@@B@B@@@@@@@@B@@@@@D@B@B@BB@@B@@@BB@@@B@@@B@@@B@@B@@B@@B@@@@B@@@B@@@B@B@@B@BB@@B@B@BBB@B@BBB@@@BBBBB@BB@@@@@@@@BB@@@D@@@B@@@@@@@@@BB@B@@BBBBBB@@B@@@@D@B@@@DBB@D@B@BB@@B@@@@@B@@AB@@AB@@@BA@@@@B@@@B@@@BB@@@@B@@@BAD@@@BA@@@@@@A@@A@@@@@@@A@@@@@AB@@ABA@A@@BA@ABAB@BA@@BAFAHAB@BAD@B@B@BA@@BAB@@@@A@@@A@@@A@A@A@A@ABA@AB@BA@AB@@@D@B@@@BAB@@@@@BABA@@@@BA@@BA@AB@@@@ABABA@@BA@@B@B@B@DAB@@@@@B@@@@@@@@A@@@A@@BA@@@A@@B@@AB@B@BAB@B@B@@@@@D@B@@@@@@@@@@@@A@@AA@@@A@ABA@@BA@@@A@@@@@AB@@@@@@A@@B@@A@@B@@@@@@AB@@@@A@@@@@A@A@@@@@@@AB@B@@AB@@@@@BA@@@@B@BA@ABCB@BAB@@A@@B@@@B@@@@@@@@@B@@@@@BBBB@@B@BBD@B@B@DBB@BBB@D@BBD@B@BBB@B@B@BBB@@@B@@@BBB@@BB@BBB@@@B@BBD@FBB@DBB@DBD@BBB@DBB@@@B@@@B@B@B@@@BB@@@@B@@B@@@B@@@D@B@B@@@@A@@@@BB@@@@@@AB@@@B@B@@@@@BA@@B@@A@@B@@@B@@@B@BBB@@@@@B@@@B@@@BAB@BA@@BA@@@AB@BAB@@A@@B@@AB@@AB@BA@@BA@@B@@AB@@A@@@@B@@A@@B@@A@@@@@@@AA@@@AA@@@@B@@@@@@AB@BABAB@@@BA@@@A@@AA@A@@A@@@@@@@@A@@B@@@@@BA@@BC@A@@B@@A@@B@B@@@D@@@B@BAB@B@@@@@B@B@@@B@@@BAB@B@B@B@@@B@@@B@B@@@B@@@BA@@B@@@@@@@B@@AB@D@BAB@B@BAB@@@B@@@@AB@@@BA@@B@@AB@@@BA@@B@B@@@D@B@BA@@B@B@B@DAB@B@D@B@D@BAB@B@D@B@B@D@D@DBD@D@D@D@B@B@B@@@D@BBB@@@@@BB@B@@@@@B@B@@@BBD@B@@@BBB@BA@@@@B@@@B@@@@BB@@B@@@@BB@@@D@@BB@@@@@@@@@@B@@@@BB@@BB@@B@@@BB@@@BBB@@@B@@@BA@@@B@@@B@B@B@B@B@B@B@@AB@D@@@B@@@B@@BB@@@B@B@@@B@@@B@B@@@B@B@@@B@@@BB@@@B@BB@A@BB@@@@@B@@@B@B@@@@@B@@A@@@ABA@@@@B@@@@@@@@@@B@@BB@B@@@B@B@B@DB@@DBB@@@BBB@BBBB@@@BB@@B@@@B@B@@@B@@@@@B@@@@A@@B@@@@BB@@BBB@BB@@@B@@@B@@BB@@BB@@@@BB@@@B@@BB@@B@@B@@BB@@@@@@@@@B@@@@@@@@@BB@@@@B@B@@B@@BB@BB@@B@BBDB@@@BB@@@@@@@B@@@@A@@BA@AB@@@@A@@@@@AB@@A@@@@B@@@B@B@@@B@@@B@BB@@B@BBB@B@B@@@@@@@@@@@@@@@@A@@@@@AB@@AB@@A@AB@@@B@@AD@B@@AB@@@@@@@@@@A@@@@@A@@A@@A@@B@@AB@@@@A@AB@@@BA@@BA@@@A@A@@B@@@@@B@BB@B@BB@B@B@BBB@@@@@@@@@@@@@BA@@@@@A@@B@@AB@@@B@@@@@@@@AAA@AAA@@@A@@@A@@BA@@@AB@@@@@@AA@@@@A@@@@B@@@@A@@@@BA@@@@B@@@B@@@BA@@B@@@@A@@B@@@B@@@@@B@@@BBB@BBB@B@BBB@@@B@B@@@BAB@B@BA@@B@@A@@@@@@@@@A@@AA@AA@@A@@@@@AA@@@AAA@@A@@@A@AAAAA@A@@@@A@@AA@AA@@@AAA@A@A@A@ABA@A@A@A@A@C@@A@@A@@A@@AAA@@@A@A@@@@@AA@@A@@@@@AAC@@@@AA@A@@AA@@@@@C@@@A@@A@@@A@AA@@A@@@A@A@AA@@A@@A@@@A@@@A@@@@@A@A@@@@@AAA@@@AA@@A@@@@A@@AA@@@A@@@@A@@@@@@@@A@@A@@@A@@@@@AA@@A@A@A@@AA@@@A@@A@@A@@@@@@@AB@BA@@BA@@B@BA@@@@@@@@@@@@@AA@AA@@@A@@@AB@@A@@B@@AB@@A@@@@@A@@@@@A@@@@@A@@@AB@@A@@@@@@B@@A@@@A@@B@@A@@@@@AA@@@B@@A@@BA@@@@@A@@@@@AB@@A@@@AB@@A@@@A@@@A@A@AAA@@@A@A@@@A@@@A@AB@@A@@@A@@@@@@@@A@@@@@@@AA@@A@@@A@@@A@@@@@A@@A@@@@@A@@A@@@A@@AA@A@A@@@@@@A@A@AAA@@@@@@@@@A@@@A@@AA@@@AAA@@@@@@A@ABC@ABC@CBA@ABA@@@A@A@@@A@@@A@@@@@@B@@@@@BA@@@@@A@A@@@AAA@@@@@AA@@A@@@@@AA@@A@@@@A@@A@A@A@A@A@A@@@@@A@@B@@@@@@@@@@@@@@A@BA@AAA@@A@@AA@@@@@@A@@@A@@@@@@@@A@@A@@A@A@@@@@@@@@AA@@@@@@@@A@@@A@A@AA@@AA@@@@@@AA@@AA@@@A@@@@@A@@AAAA@@@@A@@@A@A@@@A@@@A@AB@@A@@A@@BA@A@AA@@A@AAA@@A@@A@@@@@@A@@@@@@A@@@A@@@@AA@@@@@@@@@AA@@@@A@A@@B@@@@AC@A@C@ABA@@@CAA@A@@AA@A@@AA@@BA@A@@@@@A@A@@A@@A@A@AAA@A@A@@@A@@@AA@@@@BA@@@AA@@@@@AA@@@A@@@AAA@@@@@@BABA@@BA@@B@BA@@BA@@@A@A@@BA@@@@@AB@@@@A@AB@@A@@@AB@@@@AA@@@@@AA@@@@@@AA@@@@AA@@AA@@@@@A@@BA@@@A@@@A@@A@@A@@A@@AA@@AA@@AA@@@A@AA@@A@A@A@@AA@A@@A@@A@@A@@@A@@@A@A@A@@@@@A@@A@A@@@A@A@@@CA@@A@AA@@A@@AA@@@@AA@@@@@A@CAA@CACAC@A@AA@@@@A@@A@@@@BA@@@@@AAAAAAA@A@@@AAA@@@@@@AAA@@@A@A@A@@@A@@@@@AAAA@@@@@AA@@@@@A@@@@AA@@@@AA@@@@@@@@A@AA@@A@@AA@AA@@@@@@A@@@@A@@BA@@@A@@@AA@@A@@@@@@@A@@@@@A@@AA@A@@@A@@@A@@A@@A@@@A@C@A@A@@@AAA@@@A@@A@@AA@@@@@A@@@A@@@@@@@A@@@AAA@A@@A@@@@@A@@@AA@@A@A@@AA@@@A@A@@@ABA@AB@@@@@@A@@@@@A@@A@@A@@AAA@@A@AAA@AAA@@AA@A@@@A@A@A@A@A@@@@@A@AAA@AAA@AA@@@@@A@@AA@@@AA@@@AA@@@AA@@A@A@@A@@A@@@@A@@@A@A@A@@A@@@@A@@A@AAA@A@CAC@A@@@A@@B@@A@@@A@A@@@@@AAA@A@@@@AA@A@@@AAA@@@@@@@AA@@@A@@@@@@@@@@@@A@@@@@@@@@A@@A@@AA@@@A@@@A@A@@@A@@@@@AA@@@AA@@@A@@@A@@@A@@@ABA@AB@@A@@@@@A@@AA@@AAA@A@@AA@@@@@@A@@AA@@@A@@@A@@@@A@A@@A@@@A@AAA@@@A@@A@@@A@A@@@AA@@@@AA@@@@A@@@@@@@A@@B@@@@A@@@@@@AAAA@@AA@@AA@@@@@B@@A@@@A@@@A@@@A@@AAA@A@A@@AA@@A@@@AB@@AB@@A@@@@@A@ABC@A@A@A@@@AAA@AA@@@@@A@ABA@A@A@A@A@@@AA@@@@@AA@@@@CE@AA@@AA@@A@@@A@@@A@@@A@@A@@AA@@A@@@A@@@A@@@AA@CAAA@A@@@A@AAA@@AA@@@A@@@@BAA@@@@A@@@A@@@@AAAA@@BADC@A@@@AB@BAB@@A@@B@@A@@AA@@AA@A@C@@B@BA@@B@@AB@@A@@@A@@B@@ABCBABA@@BAB@B@DA@@B@@@@@C@CA@@A@@A@A@AAA@@@A@@BAB@@ABA@A@A@@@@@A@@@A@A@@@A@@@@A@A@A@A@A@AA@@AAACCC@C@@@@@A@@@A@@CC@@@AA@@@@@A@A@@@A@@@@@AAA@@@A@A@@@A@@@@@A@@EAA@A@@A@@A@@@@A@@@@A@@AA@@@@A@@@ABA@E@A@@@@@@@@@@A@@AA@A@ACA@@AACCA@@@@C@AAA@CAAA@@AAAAA@@AACAA@AAA@A@@A@@@AB@@@@AB@AA@C@C@A@AA@@@@AGCA@@AAA@@@A@@@A@@@ABA@@BA@@@A@@@ABE@CBA@@BA@@DAD@B@@A@@@@@@@@@@B@@A@ADAF@DAF@FA@@B@B@@@BAB@DABA@@BA@@@ABA@A@A@A@A@@AAB@BA@@@ABA@A@CA@@@@@A@@AC@@@A@@@A@A@A@A@@@C@A@@AA@@@AA@AAAAAAAAAA@AAA@AAA@AA@@CAC@@@CAAAAAC@@@AACAAACA@@@@A@A@@@A@@AB@BABAB@@ABA@@@A@@@@@AA@AAA@AAAA@@@@AA@@@ABAB@@AB@@AA@@AC@CAA@A@C@A@A@A@C@A@C@E@A@C@@@C@@@A@A@@@@@AAA@@A@A@@BA@A@AAA@@AA@A@@@A@@@@AA@@A@@A@@AAA@@@A@@@A@@@A@@@AAA@@@@@A@@BA@@@A@@@A@@AA@@@A@@@A@@@A@A@@@A@@@A@@@A@@B@@@@@@A@@@A@AB@@A@C@@@A@@@A@@AA@@@AA@@A@@AB@@@@@AA@@A@A@AAAAAAAA@A@@A@CA@@A@A@@@@@AA@@A@@@A@@AA@@@@@@@AA@@@@A@@@AA@@A@@BA@A@@@A@A@@@A@@@@@A@@@A@@@A@@B@@A@@@@@A@@@@@A@AA@@A@@@@@A@A@@@@@AA@@@@A@@@A@A@@@A@@@@@A@@@A@@@@@A@A@A@A@@@@@A@A@A@@AA@@@@@@@@A@@AA@@@@A@@@A@AAA@@@AA@@AA@@@A@A@@@A@@AA@@@AA@AA@@@@C@A@C@A@A@A@A@A@@AC@A@A@@@A@@@A@A@A@C@ABA@@@A@A@A@A@C@A@A@@@AB@@A@A@@@A@A@A@@@A@CAAA@AA@A@@@@@A@@@A@@@@BA@A@ABC@@@A@A@A@@@@@A@@@A@@@A@A@A@@@A@ABA@A@@BA@@@A@C@AAA@@@A@AAAA@@AAA@@@A@@A@@@@@A@AA@@A@@AAA@@AAA@A@AAA@A@@@C@@AA@@A@@A@@AB@@A@@@A@@@@@A@@AA@@@@@AA@@@A@@@AAA@@AA@@AA@A@@@A@C@A@A@@@AA@@A@@AAA@@A@AA@@A@AA@@AA@@A@AA@@A@@A@@@A@@@AA@@A@@A@@@@@@A@@@A@A@@@A@A@AB@@A@@@CB@@A@@@A@@B@@A@@@A@@@A@@@A@@@A@@B@@A@@@A@@@A@@AA@@@CA@@@@@A@@@A@@BA@@B@@@@A@@@@@@AA@AA@@@AB@AA@@@@A@A@@@@BA@A@AB@@A@@@A@@@@@@@A@@B@@ABA@@@@@ABA@@AA@A@@@A@@@@@@@AA@@@@A@@@@@@@A@@@@A@AA@@@@@ABA@@@ABABABADAB@@AB@@AAA@A@@@A@@BA@A@@@@BA@@A@@A@@@A@@A@@A@@@@@@@A@A@A@ABA@@@A@@@@A@@@A@@@@@@A@@@A@@@@AA@@A@@@A@A@@@A@@@A@@@@@A@@@@@AB@@@@@AA@@@@@@AB@@@AA@@A@@@@ABA@A@@@A@@BA@@A@@@AA@@@@@@B@@AB@@@@@@A@@B@@A@@@A@A@@@@@@@AA@@@@@BA@@@A@@B@BA@@@@BA@@@@B@@@B@@AB@@@BA@@B@@A@@B@@AB@B@@@B@@@@A@@BA@@@A@@@A@@@@@@BA@A@@@@AA@@@@@A@@@@B@@@@@B@@AB@@A@@B@@@@@@@B@B@@@@AB@BABA@@@A@@@A@@A@@ABA@@@@BA@@@A@AA@@@@AB@@A@@@@A@@@@@A@A@@AA@@A@@AA@@@@@A@@@A@A@AA@@AA@@AA@A@@@@A@@@A@A@@@@AA@@A@@@A@@@@A@@B@@ADA@@@@B@@@BA@@@@B@@@B@B@@@@@B@@AB@@@@@A@AA@@AAA@@AAA@A@@AA@@@@AAA@@@@@A@@AAAA@@@@A@@@AA@@@A@A@@@AA@@A@@@A@AAA@AAA@A@@AA@A@@@A@@@@@@A@@@AB@@A@@@@BA@@B@@@@A@@BA@AA@@@@@A@AA@@@@@A@@@@@A@@B@@@@AB@@@@A@@A@AA@@@@@A@@@A@@AA@@A@@A@@@AB@B@@@BAB@B@@AB@@@@A@@@@@AA@A@@A@@@@@@@AB@AA@@@@AAA@@A@@@@BA@AB@@AB@@@@AA@A@@@A@A@A@A@@@@@A@A@@@A@AA@@@@A@@BA@@@A@@@AA@@@@A@@A@@A@@@@@A@@@A@@@AAA@@@A@@@AA@@B@@@@AA@@@@AA@@A@@@@AA@@BA@A@@BA@@@@@A@@@@@A@@B@@@@A@@@AB@@@DA@@BA@@B@@B@@B@B@@AB@@@B@@A@@B@@@@@BA@@@@@A@@@A@@@A@@@A@@@@@@B@@A@@@AB@@AB@@@@AB@@@B@@@@@@AAA@@@A@@A@@A@@BA@ABA@A@@BA@A@A@@AA@@A@A@A@@@AA@@AA@@A@A@@BA@@@A@@@A@A@A@@@AA@@A@A@A@A@A@A@C@@@C@@BA@@@A@@AA@@@A@@@C@AAA@A@A@AA@@A@A@@BA@A@@AA@A@@@@AA@@AE@@@A@@@@@A@@@AAAC@@AAA@@@@AA@@@A@CA@@A@@@A@@AA@A@@AA@A@@@@@@@@A@AB@@ABA@A@AB@@A@A@@BAB@@A@@B@@A@@BA@@@AA@@AA@@@@@@@@@@A@@@@B@@AB@@@@AA@@A@A@@@AAA@@A@@AA@A@@@@@@@@A@A@@@A@A@@@A@A@@A@BA@@@AB@@A@@B@@@@A@A@@@A@@@A@@BA@@BA@@B@@AB@@AB@@AB@@@@A@A@AA@@AA@@@@AA@AA@A@@AA@@@AA@@A@@@A@A@@BA@@@ABAB@@AB@BA@@@@@A@@@A@@@A@A@@@AB@@AB@@@BA@C@@BA@ABA@ABA@A@@B@@A@@@A@@@A@AA@@@@AA@@A@@AA@A@@@AA@@A@@@A@AA@@AA@@AB@@A@@@AA@@A@@@A@@@ABA@@B@@A@@@A@@@A@@B@@A@@@A@@B@BA@@B@BA@@BAB@B@@@@@B@@@B@BBBBB@@BB@@@@ABAB@@ABABA@@BA@@@A@A@@@A@@@@BA@ADADA@@@@BAB@B@@@@@B@@@B@@@@AB@@A@A@@@A@@BA@@B@@@B@@@BA@@@A@ABABAB@B@@@B@@@@ABA@@@@BAB@@@BAB@@@B@@@B@@@B@B@@@B@@AB@@A@@@A@@@@@A@@@@@AA@@A@@@A@@@A@@@AA@@@@@AA@@@A@@A@@A@@@@BA@@@A@@A@@@AA@@AAA@C@AA@@AAA@A@C@A@@@A@@@AA@@A@AA@@@@AA@@AB@@A@@AA@@@AB@@AAA@@@@AA@@@A@@@@@@A@@@A@@A@@@AAA@A@@@A@@@AA@@@AA@@@@AA@@A@@A@@A@@@A@@@A@@@A@@BA@@@@@A@@@A@@@A@@@A@@A@A@@AA@@@@AA@@@@AA@AAC@A@@@A@A@A@A@A@@@@@@@@AA@@AA@@A@@@@AA@@@A@@A@@AA@A@@@A@A@ABA@A@A@@AA@AAAA@@@AA@A@CAA@C@A@@AA@@@@A@A@AAA@AA@@@A@@@AAA@@@A@@@AA@@A@A@A@A@@@A@A@A@@AA@A@@@A@A@@@A@A@AAA@AAA@@@A@@@A@@@A@AA@@A@A@@AA@@A@AADCLmpMNILWfKTINGNEJMLGJ@F@FDPATEZCLEJe`KCYBiHYJGCKBMJ@FQ@aIUA[LYPWXADMLWX@BKLCB@@SN@@WRCBIHSJMFeVUJ@PFTJNRHDJKNILWJQH@@B@@@BB@@@@@@BB@@@@@@B@@@@BB@B@@@@B@@@B@@@@@@@@@BB@@@B@B@@@@B@@@B@@@@@B@@B@@BB@@B@@@@@BB@@@@@BB@@@@@B@@@B@@A@@B@@@B@@@B@@BB@@@BB@@B@@BB@@@@AB@@@@@B@@@@@@@@DB@@BB@@BB@@BB@@@@@@B@@BB@B@B@BBB@@@B@B@@@B@@@B@@B@@@@@@B@B@@@@B@@ABA@A@@B@@B@@B@@@B@@@@@@BBB@BBB@@@BBB@@@B@@@BB@@@@B@B@B@@BB@@B@@@B@BBB@@@B@@A@@B@@@@BB@B@B@@@@@D@B@@BB@B@@@@@B@@@@B@B@B@B@B@BAB@@@@@@BB@@B@@B@@@B@B@B@@@BBB@B@B@B@BBBBB@@BB@@@@@B@@@B@@@B@@B@@@@BBB@B@@@B@D@@@@BB@@B@@@B@@BBB@@@B@B@BB@@@@@@@B@@@@@BB@@B@@BB@@@@B@B@BBB@BB@@B@BBB@B@@AB@@AB@@@@@BB@@@B@BB@@@@@B@@A@@@@@AB@B@BA@@@@D@B@B@B@@@B@@@B@@@B@@@B@D@D@BBB@B@B@B@@@B@B@B@@@@@@@B@@@@B@@@B@B@@@@@@BBB@BB@@B@@BDBB@@BB@@@B@@BB@@ABBB@@@B@B@B@@@@@@AB@@@B@B@B@B@@@B@B@@@@BB@@@B@B@@BB@@@BB@@B@@BB@BBB@@@B@@@B@@B@@BB@@@@B@B@B@@@B@@@@B@@@BA@B@@B@BB@@@BB@@@@@B@@@BA@@B@@A@@D@@@B@@@BB@@@@B@B@@@BBB@BA@BB@BB@@BB@@@BBB@@@@B@B@@BB@@@BB@@@@@@@B@@@B@@@B@@BB@B@@@B@@@BB@@B@B@BA@@B@BBB@@@@@B@@@@@@@B@@@@BB@@B@@@BB@@@B@@@B@@@B@B@BBBB@@BB@@B@BBB@BB@@B@@@@B@B@B@D@@@B@@@@ABA@@@ABA@ABA@@@A@ABA@@@A@@BA@A@@@A@A@ABA@A@@@A@A@A@A@A@@@A@@@AA@B@@AB@@ABA@@@A@@BA@@@@B@@A@AB@@CB@@@BA@A@AB@@@@A@@BA@@BBB@@BB@@BB@@@B@B@@A@AB@B@@BB@@@@@BA@@B@@@@@BB@@BBB@@@@@B@@@BB@@BB@@B@@B@@@B@@@B@@@BBB@B@@@B@B@@@BA@@@@B@@@@B@@BBB@@@B@@@@@B@@BB@BBB@@B@BBB@@B@B@@@B@B@@@B@@@@@BB@@B@BBBBBDB@ABB@AB@@@@BB@@@BB@@B@@@B@BB@@B@@BBB@@@@B@@@B@@@@@BAB@@@B@B@@@B@BBB@B@@@B@@AB@@@B@@AB@@@B@B@@@BA@@B@@AB@@@B@@BB@@@B@@@@@B@@@B@@@B@@@@@B@@@B@B@@AB@@BB@@@B@B@@@B@@@@@B@BA@@B@@AB@@AB@B@@@B@@AB@BBB@B@@@B@B@@@@AB@BA@@BBB@@@@@BBB@@BB@@@BB@@@@@BB@@BB@@@@@B@@@@@B@B@@@@BB@B@@@B@B@@BB@B@B@B@B@@BB@@@@@B@@@BB@@B@@@B@@@@AB@@AB@@A@A@@BA@@@@B@@@@BB@@@B@@@BB@@@@B@@@B@B@@@B@B@@@@@B@@@B@@@@AB@@@B@@]@aBKNATVL^ClQ@BB@@BB@BBB@B@@BB@@B@@BB@@BB@@@B@BB@@B@B@@@B@@AB@B@@@@A@BB@BB@@B@B@@@@BB@@@B@B@B@@@D@BB@@B@@@BB@@@@BB@B@@B@@@@@B@@@@@B@B@B@@@B@@AB@B@@@@@BB@B@@B@@@@@BA@@@AB@@A@A@@@AB@@AB@@@B@@@B@@@B@BBB@BA@@B@BB@@@BB@@B@@B@B@@@BBB@@BB@B@@@B@@BB@B@BBB@B@B@@@B@@@BB@@B@B@B@@@B@BA@@@@@@B@@AB@@@@AB@@A@@@@@@B@@@@@BB@@B@BB@@B@@@B@@B@@B@@B@@@@BB@@BB@@@B@@@@@B@@@B@@@@@@@@B@@B@@@@@@B@@B@@BB@@@B@@@@B@@B@@B@@@@@@@BB@@B@@@B@@B@@B@B@@@B@B@@@@@B@@BB@@@@@@@B@@@B@@A@BB@@@@@B@@@BB@@BB@@@@BB@@@@@B@@@BAB@@@BB@@B@@AB@@@@B@@@BB@@@@B@@@@@B@@@@@B@@@B@@@@@BBB@@@B@@@B@B@B@@A@@BA@@BA@@B@@A@@BA@@BA@@@A@@B@@A@@@@B@B@@BB@@BB@@@@@@BB@@@@@B@@BB@@@B@@@BA@@B@@A@AB@@@B@@@B@@@@@B@@@B@B@@@@@@ABB@@@@B@@B@@@@B@@BB@@B@@@BBB@@@B@BB@@B@BBB@@@B@@@BB@@@@BB@@BB@@@BB@@B@B@@BB@B@B@@@@@B@B@@@@B@BBB@@@BB@@@B@@@B@@@B@B@B@B@B@@@B@B@@@BBBA@@@A@@@ABA@@@A@AB@@A@@@AAA@A@A@@@AB@@@@AB@@A@A@A@ABA@AB@@@B@@@B@@@B@@@@@@@BB@@B@@BB@@BB@B@@B@@@BB@@B@@B@@@B@@@BB@@BB@@@@@@BB@@@@B@@B@@B@@@@A@@B@@@B@@@@@@@B@@B@@@BB@@BBB@@BB@@BB@@@B@@@B@B@@@BA@@@@@@BB@@@B@@B@@B@@BBB@@@@B@@@B@@AB@@@B@@BB@@@@BB@@@B@@@@@B@@@@@B@B@@@B@@A@@B@@@B@@@@@@@B@@@B@@@@@B@@AB@@@BA@@@@@AB@@@@@B@B@B@@@BA@@@@BA@@@@@@B@@@@@B@@@@@@BB@@@@@@BB@@@@A@@@@@@BA@@BAB@BA@AB@BA@@BBB@@@B@@@@@@A@AB@@@@AB@@@B@@@B@@AB@@@@A@@@@B@@A@@B@B@@@@BB@@@@@@@B@@@@A@@B@@@@AB@@AB@@@@AB@@A@A@@@A@A@A@@@A@@@A@@BA@@BA@A@AB@@@@A@A@A@@@A@@@A@@AA@@@A@A@A@@@@@@@@AA@A@A@@@A@@@C@AAA@C@@@A@@BA@A@@AA@A@AA@AC@@@@@@@AACB@@A@@@A@AB@@A@A@A@AA@@AB@@@@AB@@A@@@A@A@@@@@C@AAA@@@@@@@AB@@A@@@@@A@A@A@A@@@A@A@@BA@A@@@@@@@AA@@@@@@@@@@AA@A@AAA@AA@@AC@@@AA@@@AA@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@A@A@A@@@A@@@C@@@@@@@@@@@@@@A@@@AAA@@@A@A@AA@@@@@AAAAA@@AAA@@@@@@@A@@AA@A@@@A@@A@AA@BA@@@@@A@@@A@@@A@@@@@@@@@@@@@@A@@@@@A@@@A@@AA@@@@@@@@@AB@@@A@BA@@@A@@@@A@@A@@@A@@@A@@@@A@@A@@A@@@@@@@B@@@@@@@@A@@@A@A@A@@@@@AB@@@B@@@B@B@@@B@@AB@@@AA@@@A@A@@@AB@@AB@@@@A@@AA@@@@@A@@A@A@@AA@@AA@@A@@@AB@@A@A@@@@@@AA@@A@@@A@AAA@A@@A@@A@A@@@@@AAA@@@AA@@A@@@AA@@C@A@A@A@@@AB@@@@@@@BA@@@@@@@A@@B@@@@@BA@@@A@@B@@@@AB@@@@@@A@@@@@A@@@A@A@A@@@AAA@@@@@A@@@A@@@AB@@A@A@@@A@A@@@A@@@AAA@@@CA"],encodeOffsets:[[132943,46547]]}},{type:"Feature",id:"231083",properties:{name:"海林市",cp:[129.380481,44.594213],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@AA@@A@@@A@@@@AA@AA@@A@@@@@AB@@A@@@@AA@@@ABAB@@@@A@A@@AA@@AA@@AA@AB@@AB@@@@@BAB@B@@A@@DA@@BAB@@@@A@@B@@AB@@@B@BA@@BA@@@ABB@@B@@@B@B@B@B@B@@@B@B@BAB@B@@@B@B@@AB@@@B@BAB@@@B@BAB@BA@@B@BA@@@@@A@@@C@A@A@A@@A@@@AAA@AAA@@@AA@@AAAAA@A@@@A@@@A@@@A@@A@@@AA@@A@@A@@@@@@@A@@@@@A@AAA@@@ABA@A@@@AA@@A@@@A@A@@AA@@@A@@@A@@@@@@@A@@A@@AA@@@A@A@@A@AA@@A@@AA@@AAAB@@AAA@A@@@A@A@@A@@A@@@A@@@CB@@@@AB@@A@@@A@@@@AA@@A@@A@AA@B@@A@@@A@@A@@@A@A@A@@@@@AA@@@AA@@@A@@@A@AAA@@AA@@@AA@@A@@AA@A@@@A@@A@@A@A@@@A@A@A@A@@@A@@B@@@@A@A@A@@@A@AA@BA@@AA@@@A@@AA@CA@AA@@@AAA@AA@@@@@@@A@A@@@A@@A@@@@@@@A@A@A@@@A@A@A@A@AAC@C@A@@@A@@@A@@@A@@@A@A@A@C@@@@@ABA@A@@B@@@@@BA@@@@@A@@A@A@@AA@@@@A@@BA@@BA@A@AAA@@@AAA@AAA@A@@@@@AA@@@AA@@A@@@@@A@@@@@@AAA@A@@@A@AA@@@A@@@AA@@A@@C@A@@@A@A@AA@@@@@AA@@@A@@@A@@@@@A@@AA@AAAAA@A@A@A@AA@@A@A@A@@@A@@@@AA@@A@@@@A@ABA@A@A@A@A@@@@@@A@@@@@AAA@@@A@C@@@@@A@AAA@@@@@AB@@@@A@@AA@A@A@@@AA@@AA@A@A@@@@@AA@@A@@@A@AA@@A@AAA@AA@@@@@@@A@@@AA@@@@AB@B@BA@@@A@@A@A@@@@@@@@AA@@@A@@@A@A@@@A@AAA@A@A@@AA@@@@@@@AA@@AA@@AA@@CA@@@@@@@@@A@@@@BA@@@@AA@@@AA@@A@@AA@@@A@@@A@@@AB@@@@A@@@A@@@@AA@@@@A@@A@@@@@AA@@AA@@@@A@@@@@A@@@A@@A@A@@@A@@A@@@@@@@@@A@@@A@@A@A@@A@@A@@@@@@@AA@@@@@@AA@@A@@@@@A@@A@@@@BA@A@@BA@@@AB@BA@@@@@@@AA@@@AA@@@A@A@@AA@@@@AA@@@@@A@@@A@@@A@@A@@A@@AA@@@AA@@A@@AA@@A@@AA@@AA@@@A@@@@@ABA@@@A@@@A@@AA@@@@AA@@@@A@@@AA@@A@@AA@@A@@@A@A@@@A@@AA@@A@@@A@@@@A@@AAB@@A@A@@A@@AA@@AA@@AAA@@@@@A@@@A@A@A@@@A@@@A@A@@@A@A@A@@@@@AA@AA@A@AA@@A@A@A@@@A@A@@@A@@@@A@AB@@A@@@@@A@A@@@@@A@@@@@A@A@@BA@@@A@@AA@@@A@A@@@@@A@@@A@@@A@@A@@AAAA@A@@@AA@@ABA@@@A@A@A@@@@@@@@AB@@A@@BA@A@A@@@AB@B@@AB@@@B@@AB@BA@@B@BA@@B@B@B@@AB@B@@AB@@@B@@@B@@A@@B@@A@@@@@@B@B@@AB@@@@@@A@@@AB@@@@A@@@@BAB@BABBB@@@@AB@@@@AB@@A@@B@B@@@B@@AB@@AB@@AB@@@B@@A@@@@@A@@@@A@@AA@AA@@A@@A@@@@@@@A@ABA@A@@@A@A@@@@@A@@@@@AB@@C@@@C@@@A@@@@A@@A@@A@@A@@@@A@@A@@@@BA@A@@@A@@@A@@@A@@@A@A@@@@@A@@AA@@@@A@@@AAA@A@@@AA@@A@A@@@A@@@@@A@A@A@A@@AA@@@@@@@@A@C@@BA@@@@BA@@@@@A@@@A@@@@@A@@@@@A@@@A@@@@@@A@@@@A@@@A@@A@A@A@@@A@@A@@B@@A@@@@AAA@@@@@@@@A@A@@AA@@A@BAA@@@@A@@@ABA@@BA@@@AA@@@@AA@B@@@B@B@@@B@BA@@@@B@B@@@@@@A@C@@@A@C@@@A@C@A@A@@@@@A@@@AA@@A@A@A@@@@@A@@@AB@BA@@B@@ABBB@@@B@@@@AB@@@BA@@B@@@B@@@B@@@B@B@@@B@@@@@B@@@@@@A@@@AB@@A@@@A@@@@@A@@B@@A@@@A@@@A@@B@@@@@@@@@B@@@D@@@B@B@B@@@B@@A@@BA@@@A@@@A@@@A@@@@@A@@@@@@BA@@@@@A@@@@@A@@BA@@@@@@@A@@@@A@@@@A@@@@BA@@@AA@@@@AA@@@A@@@@@A@@@@@@A@A@@@A@@@@@A@@@A@@AA@@@@@AB@@A@@@@@A@@@A@A@@@@@AB@BA@@BA@@B@@A@@B@@AB@@@BA@@@@@A@@@ABA@@@AB@@@@@@A@@@@B@@AB@@A@@@@@A@@@@@@AA@@@@BA@@BAB@@AB@@@B@@@@A@@@A@@@@@A@@@A@@B@B@B@@@BA@@@@BA@@@@A@@AA@@A@@@AAA@@@AA@@A@@BA@@@@@@@A@@BA@@@A@@@@@@AA@@@AA@@@@A@@AAA@@@AA@@AAA@@@AAAAA@A@@A@@A@@@A@@@A@@@A@A@A@A@A@A@A@A@@@@@CAA@@@AA@@A@@A@A@A@A@@@AB@B@@@B@@@B@@A@@AA@@@A@@@@B@@@BA@@@@@A@@@@@A@@@@@AA@@A@@@A@AA@@A@A@@@@AAA@A@AB@@A@A@@@@@AAA@A@@@ABA@A@@@@@AAA@AAA@A@AA@@AA@@A@AA@@A@@AA@@@@ABA@@FMNUDGDGDGAEAACAAB@@A@ABA@A@A@A@@A@@@@AA@A@@AAA@A@@A@@@@A@A@@@A@ABA@A@A@A@A@@@ABA@A@AA@BA@A@AB@@CB@BA@A@ABA@A@@@AA@@@@@AA@@@A@@BA@A@A@@@A@A@@@A@A@A@A@@@ABAB@B@@@@@@@@A@@@@@A@@@AA@@@BA@ABA@@@A@@@ABA@A@@@A@@@A@@@A@A@A@A@@@ABA@@@@@@@A@BB@@@B@@@B@B@B@@@BB@@BAB@@AB@B@B@B@BAB@BA@@BAB@B@@A@@@@B@BBB@@@B@BB@@B@@@B@@AB@BA@@@@@AB@@AB@B@@A@@B@AA@A@@@A@@@A@AAAA@@AB@@A@A@@BA@A@@@A@A@@@A@A@@B@@A@@B@@A@@@AAA@@AA@@@A@@@ABAB@B@@@B@BBB@B@B@B@@@BB@@@B@@@AB@@A@A@@BA@@B@@@B@@@BBB@@@BA@@B@D@@@B@B@B@@@B@B@@@B@@@B@B@@@B@@@@@BB@@B@B@D@@BB@@AB@B@BA@A@@BAB@@@D@@@B@@A@ABA@@@@@A@ABA@@BA@@BA@ABABA@ABABA@A@A@ABAAA@A@C@@@C@A@ABA@A@ABCBA@ABA@AB@BA@@B@@AB@@A@A@AB@B@BA@@@@B@B@@A@@B@@@B@@@BA@A@@B@@@B@@@@ABC@ABA@@BA@@@A@@BAB@B@@ABAB@BAB@B@B@BA@@B@BB@@BA@@BAB@@ABA@A@@BA@A@AA@@A@AA@@A@@@A@A@@BA@A@@@A@A@@@A@AB@@A@@@C@AAC@CA@@A@A@A@ABA@@@A@@@@@AB@@A@@@@@AAA@A@A@@BA@A@@BA@A@@B@@@@A@A@A@C@A@A@A@@@A@A@@@A@A@@@AB@@@@A@@@AB@@A@@B@B@@@B@@ABA@ABAB@@@B@B@B@@@B@B@@@@A@A@AAA@A@AA@@A@A@A@A@AAC@A@A@A@ABC@A@ABC@C@A@C@A@ABA@@B@@ABA@@@A@ABA@A@A@@BE@ABA@CA@@CAC@AACACAA@CAECA@AA@@C@@@ABA@A@ABA@A@@@A@AAA@A@A@A@@@A@@@@BA@@@@BA@A@AAA@AAA@AAA@CA@@A@BA@@@A@@AAA@A@@@A@@@@AAA@@A@@@@@AA@@A@@@AA@A@AAA@A@A@CAA@A@AA@AACACAA@CAEA@@AAC@AACA@AA@@@A@A@A@A@AB@@ABA@@BCB@BABA@ABA@C@E@C@C@C@A@C@A@A@A@A@A@@@AAAAC@A@AAA@A@@@@@A@BA@@BAB@BABABA@@@A@A@ABA@A@A@@@A@A@A@@@AA@@AAAAAAA@@@A@A@A@A@@@AAA@C@A@A@A@@@AB@@AB@B@B@B@B@B@BBB@B@F@BAB@B@D@BAHABAB@BAD@DAB@D@B@BAB@@@@@@A@@@A@A@A@@BA@ABA@@@AB@@@BAB@@@B@BAB@B@B@B@BAB@@@BA@A@@BABABAB@@ABA@@@ABAB@@AB@B@BAB@B@B@BA@@@@D@BAB@DAB@B@BAD@B@DA@@B@B@@AB@@AB@@A@@@@BABABABA@@@@@A@ABA@@@@@AB@@A@ABAB@@AB@@AB@@@@@B@@A@@AA@@@A@@@ABA@@BAB@BA@A@@@AA@AA@@@AA@@AAAA@@A@@@A@@@AA@AA@AA@AA@AAA@@@A@@BA@ABAB@@ABA@A@@AA@A@@@A@@@A@@@@@AB@@A@@@A@AA@@@@A@A@@@A@@@A@@AA@@@A@@@A@@AAA@@@A@@AA@@@A@A@A@@@A@@@A@A@A@AAA@@@@A@@@AB@BA@AB@@@@ABAB@B@@ABA@@B@@ADCDC@A@@@@BA@@@@BAB@B@B@@@B@@A@@@A@@@@B@BA@@@A@AB@@A@@BA@A@@@A@A@C@A@@@A@@@AB@@A@C@@@@BAB@B@B@B@B@@@B@@@BB@@BB@BBB@@@B@B@B@@@BB@@B@B@@@BAB@@@B@BBB@B@@@BBB@@BB@@@@B@@@@@B@@@BA@@B@@@@B@@B@D@@@B@@@B@@@B@@A@@@@BAB@@@BA@@B@B@BA@@B@@A@BB@DBBB@@B@@@B@BAD@B@BAEAEEEGGENYFkA_AOEKGK@AA@EKSIMGMGAAAAUQCC@@GGCEAIEOBC@CBKJOFMNMA@@@A@@@AA@@A@A@@@A@@@A@@@@A@@@@@A@@@AB@@A@@@@@A@@@@A@@A@@@@@@@@@AB@@@@@@@@@@A@@@@A@@@@@A@@@A@@@@A@@@@@@AA@@A@A@@@A@@A@@@@@@@@@A@@@@@@@A@@@@@@A@@@@@AA@@@A@@A@@@@@@@A@@@A@@@@@@@A@@A@@@A@@@@@A@@BA@@A@@A@@AA@@@A@@@@@@@@BA@@@@AA@A@@@A@A@@@AB@@A@@@@@@@A@@@@B@@A@@B@@@@@B@@@@A@@@AB@@@@A@@BA@@@@@A@A@@@@AA@A@@@@@@@@B@@@@@@@B@@@BA@A@@@@AAAA@@@@@@@@@ABAA@@@@AAA@@@A@@@A@AA@@A@@CA@@@AA@@@A@CBA@@AA@@@@AAA@@@@@@@A@@@@@A@@AA@@@@@A@@A@@@@A@@A@@@@@AA@@A@A@@@@AA@@@@@A@@@AB@@@@@@@BA@@@@@A@A@@@A@AB@@A@A@A@A@@BA@A@AA@@A@AA@@A@@@@@@BAB@BAB@B@@@BA@@B@@A@ABABEBEDABCBC@ABC@A@A@@@@@A@AA@@A@@@@A@@A@@A@@@A@A@@AA@A@@A@@@A@@AA@@@AA@@@@A@@@AA@@@@AA@@AA@@@A@A@AA@AA@@@@AA@CA@@AA@AA@@@@A@A@A@@@A@@@B@@@@AB@@@@A@@@@BA@@B@@A@@@@@AA@@A@@@A@@B@@A@A@@@@@A@@@@A@@ACAA@@@AA@@A@A@@@@AA@@@A@@@A@ABA@@@A@@AA@A@A@A@A@A@A@@@@@A@@@@@A@@@A@@BA@@@A@@@AB@@@@@BA@@@@@A@@@AAA@@@@AA@@A@@@A@A@@AA@A@@AAAAA@AA@@A@AAA@@@A@@@EA@@A@A@A@@@AB@@AB@@A@ABA@@@A@@@@@@AA@@@B@@A@@BA@@B@@A@@A@@@@A@@@@@A@A@@@AB@@A@@@A@@@A@@AA@A@@@@AA@@AA@@@@@@A@A@@@@@ABA@@@ABA@A@@@AAC@@@A@AA@@AAA@@@AAA@A@@@@@A@@B@@@@@BA@@@A@@@@BA@@B@@@@@B@@@B@B@@@@@B@@@BABAB@BA@@B@B@@@@A@A@A@A@@@A@A@C@A@A@@@AAC@@@@@@A@@@@A@@@A@@ACAA@@@@A@@@A@@AA@@A@AAA@AAA@@AAA@@A@@AA@@@@BA@AB@@@@A@A@AAAAA@A@@@ABA@AB@@A@@@A@@@A@A@A@@@A@AAA@A@AAA@AAA@@AACAA@A@AAAB@@A@@@ABA@@AA@@AAAAA@AAA@@@A@A@A@@@AA@@@@AA@CACAAACCACA@A@A@A@A@C@@A@@@@@A@AACAE@@AE@EAA@AAA@A@AA@@EAA@A@A@@@A@@@A@AEAGCE@A@@C@ABC@@@A@A@@@AACAA@AAA@@@A@@@A@@@AACBAAC@C@A@A@@@C@CBEBA@@@@@@@@@A@@A@@@@@@A@@@@@@@A@AB@@@@@@A@@@@@@@A@@@A@AB@@@@@@@@AB@@@@@@@@A@A@A@@@A@@@@@AB@@@@A@@@@@AAA@@AA@@@@@A@@B@@@@A@@AA@@@@@A@@BA@A@A@A@A@C@ABA@@@AAA@A@C@@@@@A@@@C@CBA@A@A@A@A@AAC@C@CA@@@@C@EAA@@@C@AAA@CBAAA@A@@BADA@ABA@CBABA@A@C@A@CAC@A@@@@BAB@BA@ABA@ABC@ABA@A@A@@@A@AAA@CAAA@@@@@@@AB@@A@A@A@AAA@@AACAAA@@A@@@B@@A@A@AAACA@@@AB@AA@CAA@AA@AA@B@@@AA@@A@@@@A@A@@@A@@@A@A@ABA@A@A@ABA@ABA@CBA@@@AAAAAAA@@@A@C@A@C@@@CBC@A@A@C@A@A@A@C@A@E@C@A@C@A@E@ABC@@@A@@@A@A@A@ABA@A@AAC@A@A@C@C@C@EBA@A@E@A@@@A@AB@@A@AB@@A@A@AAA@A@A@A@CAA@A@C@A@A@A@C@E@A@AAA@A@AAA@A@A@AA@@A@C@C@ABA@@@@AA@@@AA@@A@@@A@A@C@A@@@A@@@@@A@@@@@AAA@A@A@@@AA@@A@@A@@@@AA@@A@AAAA@AAA@AAA@A@@@A@A@@@A@@@@@A@A@@@ABA@@@@@@A@@A@AA@@@AACAA@@A@@@AA@@A@AA@@@A@@@A@@@@@A@A@@@@@@@A@@@A@A@@@A@A@@@A@@@@A@@AA@A@@@A@@@@@A@@@@AA@@AA@@AA@A@C@AAA@@@A@@@@AAA@A@AAA@A@A@@@A@C@A@A@A@@@@@ABA@@@A@A@C@C@A@@BA@A@A@@BA@@@@BB@@BB@@B@@@B@@A@@BA@@BA@A@@@AB@@@BA@@B@@@B@@@@@B@@@@@BA@@@A@@@AB@BB@@B@@B@@@B@BB@BB@@B@@@@AB@@A@A@A@A@ABA@A@A@A@A@C@A@A@CBA@@@AB@@A@A@@@A@ABA@@@A@A@A@A@@@A@A@@AA@AAA@@AAACAAC@AA@@@A@A@A@CAA@A@A@A@AA@@A@A@CBC@@@@@AB@@A@A@A@AB@@@@@BAB@@@@@@ABA@@BA@@@A@@@AB@@@B@@@BA@AB@@@B@BB@@BB@B@BB@@B@BB@@B@@@AB@@ABA@@B@@@@@@@@@B@@B@@BB@@@BB@@BB@@BB@@@B@@A@@@A@@@@B@@AB@@@BA@A@@A@@A@@@@BA@@@A@@@A@AA@@A@A@@@AB@@A@@@AAA@AAA@@@@@@@AA@@@@@A@A@@AAAA@AA@AAAAA@@@AA@@AAAAAA@@AA@@@@A@A@A@A@A@@@A@A@A@A@@@@@A@@AA@@AA@@@AA@@@ABA@AA@@AA@A@A@@@A@@@A@@@A@@@A@A@@@AB@@A@@B@@A@@B@@A@@@A@@@@BA@@@A@@@AB@@A@@@A@@B@@@BAB@B@@@@AB@@A@@@A@AAA@AAC@A@AAA@@@A@ABC@@@A@A@A@AB@@A@ABABABA@ABAB@@A@@@A@A@C@A@ABC@A@A@A@@BA@@@A@@@A@@@@@AA@@A@A@AAA@A@C@C@E@C@CAC@@@A@@A@@A@@AAAAAA@AAA@@@@@@A@@@AB@@@@A@A@A@CBA@A@ABA@A@AAA@A@CAA@AAC@@@AA@@@A@@@@@A@ABA@ABA@@@CBA@@BA@AB@BAB@B@B@B@BABAB@@AB@@@@A@@A@@AA@A@@AAA@AA@@A@@AAAA@@C@A@@A@@A@AAC@A@@@AAC@@A@CA@@A@@@CA@@AA@@AAAC@AAA@CAAACAACA@@CAA@@@@A@A@A@A@AB@@@@@@A@@A@A@C@AAC@ABA@A@CBA@C@@@A@@@A@A@@@@@A@@@AA@@@A@@@A@@AAB@@A@A@A@AA@AA@AA@@AA@A@AAAA@@A@@A@A@@@A@A@@@AAABA@A@ABABABA@@@@@A@A@@B@@AD@BAB@@A@AAA@A@C@A@ABC@A@C@A@@@AA@A@AAA@A@AAA@A@A@AAA@A@A@A@A@A@@AA@@@ACA@A@@@AAA@CAE@CA@@@@AA@ACEAAAE@AAAAAAACAAAA@A@A@@@ABC@ABA@AB@BA@A@C@ABA@CACBA@C@EBCBA@A@A@@@CACACCACA@@@AAAAA@AAA@AAA@CCA@CACA@@OBA@@AAA@AB@@A@AAA@AA@AA@@C@CACA@@C@@@@@@@@BA@@@@BABAB@@AB@@@@ABC@@@A@C@ABA@A@@@A@A@@@@@A@@@BB@@@BB@@BBB@@@BB@BBBBB@@BB@BB@@BB@@@B@B@B@@@B@@@DB@@B@@@BAB@BABAB@@@B@@@@@@BBB@F@@BB@B@@BB@BB@B@@BB@B@@@@BB@@@BB@@BB@@@B@@@@@@B@@@@@BA@AB@@@@@BB@@@@BBB@@A@@B@@@@@BBB@@@B@B@@BB@@@@@@AB@@@BAB@@A@@B@@@@@@A@AB@@AB@BA@@@C@A@A@C@A@ABA@@@AB@@@@@BA@@@@@AB@@@@@BBB@B@B@@BB@DBB@B@@@B@@BBB@@B@@BB@@@B@BB@@BB@@B@BB@@B@@@@@@@BBB@@@B@@@B@@B@@B@@@@BB@@@B@@BB@B@@BBB@@@@BB@BB@B@@@@@@@B@@@@@B@@@B@@@@A@@BA@@@@B@@@@@D@B@@@B@@@BB@@BB@@@@B@@@B@@@BB@@B@@BB@@BB@BB@@DBB@@@B@@@B@@@B@@@B@@AB@B@BAB@B@@AB@@@@ABABA@@@ABA@ABAB@@ABA@@BA@@@@BA@@@@@AB@@A@A@ABA@@@AB@@@@@BAB@B@B@B@BA@@@AB@@A@@@AB@@A@ABC@@@@@@B@@B@@BB@B@BB@@B@BBB@B@B@@@B@@@B@B@B@@@BAB@B@@@@@B@@BB@B@@@B@B@B@B@@@B@@BB@@@B@BBB@@@BB@@@@BB@B@@@B@B@@@B@@@B@B@@@B@@@BA@@B@@BBA@@B@B@B@@@B@@@BB@@B@@@B@@@@@B@@@@@BBB@@@@@B@@B@@B@@@@@B@@B@@B@@@B@@@@@B@@B@@B@@B@@B@BB@@@@@@BA@@@@@@BA@@@@B@@@@@BA@@B@@@BA@@@@B@@@@AB@@@B@@AB@@@@ABAB@@A@@B@@@@@@@B@@@B@@AB@@ABA@@BA@@@A@@@A@A@@B@@A@@B@@AB@@@@A@@B@@@@@BA@@@AB@B@@@@@B@@@B@BA@@@@B@@@BA@@B@@@BAB@@AB@@@@@B@@@B@@A@BB@@@@@BBB@@@B@@@@AB@@@BA@@B@B@@@B@B@@BB@@@BB@@B@@@B@@BB@@@@@BB@@@BB@@BB@@@@@B@B@@BB@B@@AB@B@@@@ABA@@@ABA@@BA@A@A@ABA@@@@@@@@B@BA@@B@@@@A@@@@BA@AB@@A@@B@@@BA@@BA@@B@@A@A@A@A@@@A@@B@@A@@BAB@@@BA@@@ABA@@B@@@@@B@@@B@@@B@@BB@@@B@@@@@BB@@B@@A@@@@@A@@BA@@@A@@B@@A@@B@@AB@@@@@BA@@B@BA@@B@@@BA@@B@@@B@@AB@@@BAB@@ABA@A@C@A@A@@@ABA@@@ABAB@@ABA@ABA@ABA@@BA@A@ABA@@@A@ABA@C@A@C@@@ABA@A@ABA@@@ABA@@@AB@@ABA@AB@BA@@@@B@B@B@BAB@B@@ABABA@@B@@A@@B@B@@@B@@@B@@@BA@@B@@@@AB@@@@A@AB@@A@@BA@@@@@@BA@@BAB@@A@@B@@@@@BAB@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@A@@@AAA@@@A@@@A@A@@@@@A@AA@@CA@@A@@@@@@@A@A@@@A@@@A@@@@@@@C@@@A@@@AA@@A@@@A@@AA@@@@@A@A@A@A@@@@@A@@@@@AAA@AA@@A@AA@@A@A@@AA@@@A@@AA@@A@@@@@@A@@A@@@@@@AC@@AA@AA@AA@AA@AAAAC@AAAAA@A@A@AAA@A@A@AAA@@@AA@@AAA@AAA@A@A@A@AA@@A@AAA@AA@@A@AAA@AAC@@@AA@@AA@@@A@@AA@AAA@@@A@A@A@@AA@AAA@AB@@@@A@@BA@@A@@A@@C@AAA@CAA@A@@@AAA@A@@AA@A@AAA@CAA@A@A@@AA@@@A@A@AAA@A@A@C@C@A@A@@@@A@@AACA@A@@@@AA@@@@@A@@@@@A@@@A@AA@@A@A@A@@@@AA@@@@@@A@@@@@@@@B@BBD@@@B@B@B@BA@ABABABA@ABEBEBA@CBA@@B@BA@@BA@@@@@AB@@CBA@@BA@@B@@@B@BAB@B@@@B@BB@@@@BBBBB@B@B@@BB@@BBD@DBD@@@B@D@D@@@BB@@@@@BAB@BCB@BA@@B@@@BBB@@DB@@BBBBBBB@BB@B@BBB@@@BBB@BBB@@@BBB@@@@BD@BB@@B@B@@@B@@@B@BA@@@@@AB@B@@@@@@BB@@BB@@BB@@@@BB@BB@@B@@B@DB@@BB@@BBBBBB@BB@@BB@BB@@BB@BBB@@BBBBB@@@BBFBD@BBDBD@B@@@DBBBB@B@B@B@B@D@B@@@@BBBAB@D@BCBC@@B@@B@BBB@DB@@BB@B@@B@@@B@B@B@B@@B@@B@@B@@@@@BB@@BBB@@B@B@B@@@B@BB@@BDB@@B@@@@BB@@@BDBBD@DBBB@DBLDFDB@@BBB@@BBBBDBDB@@BB@B@@@@@BB@B@DBBBBBB@@BB@@BBBB@DBFBD@BB@@B@D@@@BB@@@B@@@BA@AB@BC@AB@BAB@BB@@BBBBBB@B@B@DBB@BDB@BBBBB@BBBBB@@@@B@@@B@B@B@@BB@B@B@B@B@@@@@BA@ABA@@BA@@B@BA@@B@B@@BB@@@B@@@B@@@@@BBB@@@@@BAB@@@B@B@@BB@BBBD@@BDDBBBB@@B@BADADADCB@B@@@BBDBBBBBBBBFBB@@@B@@@B@D@BBDBB@BB@DB@@FBBBB@@B@@AB@B@@@@ABA@A@@B@@@B@@@B@@@B@@@@@@@BB@@A@@B@@@B@@BBB@BBBAB@@@BB@AB@@@B@BABAB@B@@AB@BA@@B@@@@@B@@@@@@@@B@@@B@@@BA@@B@@A@@@@B@BAB@B@B@@@B@B@B@B@B@B@@@D@B@D@B@@AB@B@@@B@B@BA@@D@B@B@B@BBB@BBBBB@@BBBB@@BB@@@@@B@@@BAB@B@@AB@D@@@BAB@@@B@@AB@@@BAB@B@@BB@B@B@BB@@B@BA@@B@@A@@@@@A@@B@@A@@@@BAB@@A@@B@@@BA@@B@@@@AB@@@B@@@B@@AB@DADAB@@A@@@AB@BBB@BBB@@@D@BAD@B@B@@@BBB@BBB@@BB@B@B@@B@@B@@@B@@BB@B@B@B@B@B@B@@@@BB@@@@@B@@BB@@@B@B@@@B@B@@@B@@@@@B@@BB@B@@B@@@@B@@@@@B@@@B@B@@@B@@BB@@@BB@@@@B@@@B@@@B@@B@@BB@@BB@@B@@B@@@@@@@@@@BBB@B@B@BBB@@@B@@BB@B@B@B@B@BA@@@@BA@A@@BA@@B@BA@@B@@@B@@@@@B@@@@@@@B@@@B@@@@@@@@@B@@@BA@@BA@@B@B@B@@@B@BAB@@@B@@@@@@@BA@@@A@@BA@@BA@@B@B@B@@A@@B@@A@@@@BBB@@@B@B@@@B@BB@@@@B@@B@@B@@@@@@@@@B@@@@@B@@@B@B@@@@BB@B@@@@@BB@@@@@BB@@@@@B@@AB@@@@@B@@A@@BA@@@AB@A@@A@@BA@@@AB@B@B@@@D@@@B@@@BBB@B@@@@@B@@@BA@@B@B@@@BB@@B@BBB@@@@@B@B@@@BAB@B@B@B@@A@@@@@AB@@@@A@A@@@A@A@AB@@AB@@@B@B@B@BAB@@@B@@BB@B@@@B@@@B@@AB@@AB@@@BA@@B@BAB@B@DA@AB@B@BA@@B@B@@@@@B@@@B@B@B@@@B@BBB@B@B@B@B@BBB@B@B@BBBB@@B@B@BB@@DBB@@@BBB@@@B@D@B@D@B@B@@@B@B@BAB@B@@@B@B@@@B@B@B@DBB@@@D@B@B@B@B@@BB@@@B@BBB@B@B@B@@@B@B@@@B@B@BBB@@BBB@B@@BB@@@@BB@@B@@BBBB@BB@@BBBBBDBBB@BB@@BB@@BB@@@B@@BB@@BB@@@@BBDBBBBBB@BB@@B@BBB@@@B@@@B@@@BBB@@@@BB@@@@B@@@B@B@@@@BB@@B@DB@@B@@@B@@B@@B@BAB@B@@@B@@@@@B@@@B@B@B@@BB@BBB@BBDB@@B@@B@@@@@B@@AB@@@BB@@@@B@@@@@B@@@B@@@B@B@@B@@B@BB@@B@@B@@B@@BB@@BB@@BB@BB@@@@B@@@@@@@@@BB@@B@@@@@B@@@B@@@B@@AB@@@BB@@@@B@@A@@BA@ABA@ABABABA@A@CBABA@A@A@AAA@CAA@A@@@AB@@@@A@@B@@@@A@@@AA@@A@@@@@ABA@@@AB@@A@@B@@@@@@@BB@@B@@BB@@@@BB@@B@@@@BB@@@BB@@@@B@@@BB@@B@@AB@B@@@B@@@B@B@@BB@@@B@@@@@BA@@B@@@@@B@B@@@B@@@B@@@BB@@@@@B@@@B@B@B@@@B@@@BB@@@@@@B@@@B@@@B@@B@@@@BB@D@B@B@@@BA@@B@@@B@B@@A@@B@@@@@@B@B@@@@@@BB@@B@@@@BD@@B@@BBBB@@@B@@B@@B@@B@@@@@@@B@@@B@@BB@@@@@@BB@@B@@@@@B@@@@BB@@@@BB@@B@B@B@BB@@@@B@@@BB@@@@B@@B@@@BB@@B@@@@@B@B@B@B@B@@@B@@@@@BB@@@@B@@B@@@@@BB@@@@@@@B@@@B@@AB@@A@@B@@@@@B@@@@@@@@@@@BB@@@@@B@B@BBB@B@D@B@B@@@@AB@@@@@B@@@B@@@B@@@B@B@BB@@@BB@@@@BB@@@BB@@@BB@@@@@B@B@@@@@@@B@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@B@B@@@B@@BB@B@@@B@B@@@B@@@BB@@B@BAB@@@B@@BB@@@B@B@B@B@B@B@B@@@B@B@@@B@@@B@B@@@@@@@@@B@@A@@B@@@B@@@B@@@BA@@B@BAB@@@B@@@@@@@BB@@@@B@@BB@@B@@BB@B@@B@@@@BBB@@@@BB@@B@@BB@BB@@B@@B@@BB@@@@@BB@@@@@@B@@@@A@@@A@A@@@A@@@AB@@ABA@@BAB@@AB@@AB@@@@@B@BBB@B@@@B@BB@@B@@BBB@@@BB@@@@@@@B@@B@@B@@@@BBBBB@@@BDBB@@B@@@@BB@BB@@BBB@@BBBD@@BB@@B@@@B@@@@A@@BA@AAABA@A@A@A@@@ABA@@@C@@@C@A@@@A@@@A@@BB@@@BB@@B@@BB@BB@@@@@@@@BB@@BB@@BBB@BBB@@@@@@BB@@BBB@BB@@B@@B@B@@@B@@@B@B@B@B@B@D@@@B@@@B@@BBB@@@B@@BB@@B@@BA@@B@@@B@@@@BB@@B@BB@@@@@BB@@BB@@@BBBBB@BB@@BB@@@B@@@@A@@BA@A@@@A@@BA@@@AB@@@BA@@B@BA@@B@BAB@@@B@@B@@@@AB@@AB@B@B@B@B@B@@@BB@@B@BB@@BBB@@BB@@B@@@B@@BB@BB@@BBBB@@BB@B@@@BBB@@BB@@@@BBB@@B@@BB@@@@@BAB@@AB@@AA@@A@AB@@@BABABA@@BAB@B@@BB@@@B@@@B@B@B@@@B@B@BAB@B@D@B@BB@@B@@@BBB@B@B@@@B@BBB@@@BBB@B@B@@@BB@@BB@@B@B@B@@@B@@@@B@@@B@@B@@BB@BBB@@BB@B@B@@@B@@AB@B@B@B@BB@@B@BB@@B@@AB@B@@AB@@@B@B@@@B@@@BBB@@@@BB@@B@@B@@B@@B@@@BB@@BBB@@@@BB@@BB@BB@@B@BB@@BB@BB@@BB@@@BBB@@BB@B@@B@@@BB@@@BB@@B@B@@BB@@@BB@@BB@@@@@B@@BB@@@B@@B@@@B@@@BABAB@@@BAB@B@@@BB@@B@BBB@@@BB@BB@@@BB@@DAB@@@BBB@@@BB@@@BB@@BB@B@@@B@@@@@@@D@B@@@@@B@@B@A@@B@@A@@@AB@BA@@B@@AB@@A@@@@B@@@B@BA@@B@@@BAB@B@B@@A@@B@@AB@@@@A@@B@@@BA@@@@B@BA@@@@B@BB@@B@@BB@@@B@B@@@B@B@BB@@B@B@@B@@B@@@@@B@B@B@B@B@@@D@BA@@B@@@B@@@@B@@AB@B@@@@@B@@AB@@@BA@@@AB@@AB@@A@@@A@A@AB@@ABA@A@@B@BA@@B@@@@AB@BAB@B@@@B@@AB@@@B@B@B@@@B@B@@ABAB@@A@@B@@@@@BB@BBB@BB@@B@B@B@@BB@B@@@@@B@@BB@@@@@@BB@AB@@@@A@A@C@A@ABA@@BA@@B@BA@@B@@@BB@@@B@BB@@BB@BB@@B@BA@@BA@@BABA@@B@@A@@AA@A@AAA@A@A@A@A@@@A@A@A@AA@@@BA@A@@BABA@@BA@@@ABA@A@@@A@AB@@@@A@BB@@@@@BB@BBB@B@@BB@@B@@AB@@@@AB@@@@@BA@@@A@@B@@A@@B@@AB@@AB@@@@@BB@@@@BB@@B@B@@@B@@@BA@@B@@B@@B@@B@BBB@BBB@@@@@B@B@B@B@B@@@D@B@B@BABBB@B@@@B@@B@@@@AB@@A@@B@@AB@@@BA@@B@B@B@B@@@BBB@@@@BB@BB@@@@BB@@B@@BB@@@B@@@@@@@BBB@@@B@@B@@@B@BB@@B@@@BB@@B@BB@@BB@@A@@@AB@@@B@@@B@@@B@@@B@@@B@BA@@BAB@@@@@@AB@@AB@@A@@@A@ABA@@@A@@B@@@B@@A@@B@@@BA@@B@B@B@@@BA@@B@@@B@@@@@BA@@B@@AB@@@@@B@@@@@@ABB@@@@BB@B@B@B@BB@@B@D@BBB@@@B@@B@@@@@BA@@@A@AA@@A@@@AB@@A@A@@@@@A@@@@BA@@@AB@@@@@BA@@B@@@B@@@B@@@B@@@@@B@@@@@B@@@BA@@B@@@@A@@@A@@BA@A@A@@@A@A@@BA@A@@@A@@BA@@@@B@@A@@@@B@@A@@@A@A@@@A@@@A@A@A@@@A@@@A@@BA@A@@BA@A@@@A@A@@@B@@B@@@B@@@@@BA@@BA@@B@@@@C@@@AB@@A@@B@@@@@B@@@BBB@@@B@@@BA@@@@@@B@@A@@@AB@@@@AB@@A@@@AB@@A@@@AB@@@@@B@@@@BB@@B@@BB@@@B@@@@BB@@@@B@@@@@B@@@BB@@B@@@@BB@@B@@@B@B@BA@@@@@@B@@@@@B@@@@B@@@B@@@@B@@@B@BA@B@@@@BB@B@@BB@@@BB@BB@B@@B@@@B@BAB@@@B@@A@@@@B@@@BAB@@@B@BA@@@@BB@@@@BB@@BB@@@@BB@@B@@@B@@@BA@@@@BA@@B@@@D@@@B@B@@@@@B@@@@@BA@@B@B@B@@@B@B@@@BB@@@A@@B@@AB@B@@@B@B@@@@A@@@@@@B@@@@@@@@@B@@B@@B@@@@@B@@@B@@@B@@@@A@@@@@A@@B@B@@@BA@@@@@A@@@@@AB@@@@AB@BA@@BA@@@@BAB@B@B@B@B@B@B@@BB@@@@@B@@B@@@@@@@B@@AB@@@@@@@B@@@@@@@B@B@@@@@@@B@@B@@@@@B@@@B@@@BB@@@@BB@@@@@B@@@@@B@@@B@@@@@B@@@B@@@BB@@@@BB@@B@@@@B@B@B@@@B@B@@@B@B@@@B@@@@@@B@@@@AB@@@B@@B@@@@BB@B@B@@@B@B@@@B@B@@@B@@@@@BB@@@@BB@@@@BB@@@@@BB@@B@@@B@@B@@@@@B@@@B@@@B@@@B@@@@B@@@@@@AB@@@@AB@@@@BB@@@B@@@@BB@@B@@@B@@@BB@@B@@AB@@@@@B@B@@@B@@@B@B@BBB@@@B@B@@@B@B@B@B@B@D@B@@@B@B@B@BA@@B@@@@@BB@@@@BA@B@@@@@@AB@@@B@@B@@@@B@@@@BB@@@@B@@@B@B@BBB@B@B@@@B@@@@@@@@@BB@@@@@B@@@@@BB@B@@@B@BBB@@@B@@@B@@B@@B@AB@@@@A@@B@@A@@B@@AB@@AB@@@@AB@@C@@@@@A@@@A@A@A@@@A@@@A@@@@@AB@@A@@@AB@@A@A@A@@@A@AA@@A@@@A@@@AB@@A@@@@@A@@B@@@@B@@BA@@@@B@@@B@@A@@@@B@@@@@@@B@@@@@BA@@B@@@@@B@@@BA@@B@@@BB@@B@@@B@@@B@@@@AB@@@@ABA@@@A@AB@@@@A@@@A@@@A@@@A@@AAB@@A@@@ABA@@@A@@@A@@@A@@@CBA@A@A@@@@BA@@@@B@@@B@@@@@@@B@@@B@@@@@B@@@@A@@B@@@@@BB@@@@@BB@@@@BB@@@B@@@@@B@@@@@B@@@@BB@@@B@@@@A@@B@@@B@@A@@B@@@@@@@BB@@@@@BB@@@B@@@B@@B@@B@@@B@@BB@@B@@@B@B@BB@@D@B@B@@@B@@BB@@BBB@@@@@@B@@BB@B@B@@@@@@B@@@@@@B@@BB@BB@@@B@D@BAB@@BB@@BB@@B@@@@B@@@BB@B@@@B@@@BB@@B@@@D@@@B@@B@@@B@B@@BB@B@@@BA@@@A@A@@B@@@@@BA@@@@BA@@@BB@@BBB@@@B@B@B@@@B@@BB@@@B@@@B@@B@@@B@@B@@@@BB@@@@@@B@@@@@BA@BB@@@@B@B@D@@@B@@@BA@@B@DAB@@@@AB@@ABA@@@@B@B@@@B@B@D@B@B@B@BB@@B@BBBB@@B@B@BBDBD@BBDBB@DBBBDBB@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@BB@@B@@@BB@@B@@BB@BB@BB@@B@B@@@B@@BB@BB@@BBB@@BBB@B@BBBBB@@@BBB@BBB@B@D@B@B@@B@@BB@BA@@B@@AB@@@@ABA@@@AB@@@@AB@@A@@B@@A@@B@@@B@B@@@BAB@@AB@BB@@B@BB@@BBB@@@B@@BB@@BB@@BB@B@@BB@B@@BB@B@@@B@B@B@BBB@B@BB@@B@B@@BB@@@BA@@@@@A@A@AB@@A@A@A@A@@@C@A@C@CBA@A@A@A@@@A@ABA@A@@@@@A@@@A@@B@@@B@B@B@@@BBB@B@@@B@B@@@BBB@B@BB@@B@B@@@@AB@@AB@BAB@BA@@BABA@@@AB@@A@@@A@ABA@@BA@AB@@AB@B@@AB@@@BAB@@A@A@@@A@@@A@A@@@A@A@AA@@A@@@A@@AA@AAA@AAAAA@@@A@A@AAA@@@@A@@AA@AAA@AA@@AA@A@A@@@A@A@@BA@@@A@AB@@A@A@A@A@@AA@A@A@A@AAA@ACA@@@A@@AAAAA@@A@@AAB@@@B@@A@@BA@@@ABA@A@A@A@A@@@A@ABA@A@AB@@A@@B@@@B@B@@@B@B@@AB@@@@A@AB@@A@@@AB@@A@@BA@@@A@ABA@A@A@@@A@A@A@ABA@A@A@A@@@@@@B@@@B@@@BA@@B@B@@@B@B@BBB@B@@@BBB@BB@@B@@@B@BB@@B@@@B@@@@@BA@A@@BA@ABA@@B@@@B@B@@@@@B@B@B@B@@@B@@AB@@A@@BA@@BA@@BA@@@A@@BA@@B@@@@AB@@@BA@@@@@AB@@A@AB@@A@CDA@ABA@AB@@A@A@@B@@A@ABA@@@ABA@A@@@ABA@A@A@@B@@@@BBBB@@BB@@BBBB@@B@B@BBB@B@B@@B@@B@@BB@@@@BBBBB@BB@BB@BB@@BB@B@@@BB@@B@BBBB@BBBB@@@@@BBB@B@@@B@B@B@B@B@D@@@B@B@@@B@B@@@@@BBBBBBB@BBB@@B@@BBB@@BB@B@BB@@B@B@B@D@D@D@B@@@BBD@@@BBB@@BB@@@D@B@B@B@@@B@B@@BB@BBB@BBBB@@BB@@B@@@B@@@BAB@B@D@B@B@@@BAB@BABAB@BA@@B@B@B@@BB@B@BBB@@@B@@BB@B@B@BBB@B@BBD@BBB@BBBB@@BBB@B@@@B@@BB@@@@@@@B@@BB@@@BBB@@B@@BBBBBB@@BB@@B@BBB@B@BB@@B@@B@@@B@B@@@B@@@D@@@B@@@BB@@@@BBB@BBB@@BB@@@B@@@@@B@BA@@@@BA@@BA@@BA@@B@@@B@B@B@B@@BB@B@BB@@@@B@@@B@@@BB@AB@B@@BB@B@@@@@@@B@@BB@@BB@@@B@B@@AB@@A@@BA@A@A@A@@@A@ABA@A@ABA@A@@@@@ABA@@@A@AB@@A@@@C@@@@@A@A@@BA@A@A@@@ABA@A@AB@@@@@@ABA@A@@BA@A@@@A@ABC@AB@@A@@BA@A@ABA@CB@@A@A@A@@@AB@@A@@@ABA@@BAB@@ABAB@B@@AB@@@BA@@B@@@@ABA@@B@@@B@@@@@@B@@B@@@@BB@@@@@@@B@@@B@@@B@@@@A@@BA@@@ABA@ABA@@@AB@@A@@@@@AB@@A@A@ABA@@@A@AB@@@@A@AB@@ABA@AB@@@BA@@@AB@@@@AB@@AB@@@@AB@@@@@@@@@@BB@BBB@BB@BBBBB@BB@BDB@@@BB@@B@@@@@BB@AB@@@B@@@@@B@B@@@B@B@@@@@BB@@@@B@@BB@@B@@B@@B@@@@BB@@@DB@B@@@D@B@@@B@@@@@@@B@@@BBB@@@@@BB@@B@@A@@B@@A@@B@@@B@@@@@B@@@@B@@B@B@@@B@B@B@@BB@@@B@@A@@B@@AB@@A@@BA@@B@@@B@@@@AB@B@@@@@B@@@BB@@@@B@BB@DDBBBBDDBB@@@B@@@@A@AB@@AB@@@@@BA@@BA@@B@@AB@@@BA@@@@@@@BB@@@@BBB@@B@@@@@@@@@B@@AB@@AB@@@B@@@B@@@@@B@@@@@B@@@@@BA@A@A@@B@@A@@@@@@B@@@@A@@BAB@@@@@BA@@@@@@B@@A@@@@BA@@B@@@@@@@B@@@@@B@@A@@B@B@@@@@@A@@BC@A@C@A@C@A@A@@@@@A@@@@@@@@@A@A@@@A@@@A@@@AA@@A@@@A@A@@@AB@@@@A@A@@@@B@@@@@D@B@@@BA@@B@@@BA@@BA@@BA@@@@B@@@B@@@@BB@@@B@BA@@B@BA@@BA@@BA@@@@B@@@@@@@@BB@@@BB@@B@@@@@@@@@B@@@BA@@B@BBB@@AB@@@BA@@B@BAB@@@B@@AB@@AB@@@B@B@@@B@@@BB@BBBBBB@@BBBBBBB@@@@@@@BB@@@@@@@@@@AB@@AB@B@@@B@@BB@@BB@@BBBBBBBBB@@B@@@@B@@@@B@@@@B@@@@@@B@@@@@@BB@@B@D@B@B@B@DBB@BBB@B@@@B@B@BABA@@B@D@@@B@BA@@@@BABAFADABAB@B@BAB@B@@@@@B@@@@B@@B@@@@@@BDB@@B@@BB@BBB@@@@@B@@@@@B@B@@AB@@@@@B@@AB@BA@@BAB@BA@ABA@@BA@@BA@@@AB@@AB@BA@@B@@AB@@AB@@@BAB@B@B@B@@@BA@@B@BABADA@@BAB@@@B@B@@B@@B@BBB@B@BBB@@@@@BAB@@AD@BA@@@AB@@@B@@AB@B@B@@@B@@@@A@@@@@C@A@A@@@@@@@A@@@@@@@B@@B@@@B@@@B@@@B@B@DAB@@@B@@@B@BAD@D@D@@AB@D@@@B@@@B@@@@@B@BB@@BB@@BB@@BBB@BBBBBB@@@@BBB@@@@@BB@@BB@@BB@@@@@@@@BB@@B@D@B@B@B@BBB@B@B@@BB@B@@B@@BB@B@@@@@B@BB@@@@BB@@@B@BB@@BB@@BB@@@@B@@B@@B@B@B@@@@@B@@@B@BBB@B@@BB@FBB@@@BBB@D@D@B@@@B@B@BAB@D@B@@@F@@@F@B@D@@@D@@@B@B@@@DAF@@@@@@@B@@ADCBC@AB@@@@A@@@@BA@@B@@@@@B@@@B@@AD@DADA@ABA@A@@@@@A@@@@@@B@@@@ABADADCBAB@DA@@BA@@@@B@@A@@DA@@BABADAD@B@DAB@@@BAB@BADAD@D@DAFBB@F@B@B@B@B@F@BBB@B@@@@AAA@AACAA@A@A@A@A@@B@BCBABCBA@@@AB@@@@@@@@@B@@@@B@@DDBBD@@@D@B@B@BBB@B@B@B@BAB@DAB@BADAB@BAB@@AB@@ABA@A@@@A@@@@@@B@@@B@@@B@DB@@DBBBB@BAB@BA@@BABAB@DAB@D@B@B@FBDBD@B@D@D@B@@ABABAB@@@BA@ABAAA@AECA@@AAC@AAA@A@CAC@@@A@A@@@@@@BA@A@C@C@A@@AA@AB@BAB@B@BADAB@BADABAB@BABAB@DADCDAB@@AB@BADCBABA@AB@@AB@@ABA@ABA@ABA@@BA@@BAB@@AB@BAB@DABAB@B@B@F@D@D@HAB@D@DAB@@CB@@AB@@A@@B@@@BABAB@BA@@BAB@@@B@@AB@@AB@@AB@@A@@BA@@@AB@@A@@B@@@B@B@@@B@@@BB@@B@B@@@BBB@@@B@@BB@B@@@B@@@B@@@B@@@B@@@B@BAB@D@BAB@BAB@BAB@@@BA@@BA@@BAB@@@BAB@@AB@B@@@@AB@@@BA@@@ABA@@@AB@@AB@BAB@BAB@@@BA@@B@@AB@BA@@BA@@B@@AB@@@B@@AB@@@@A@@@@BA@@@A@@BA@@@A@@BA@@BA@@@AB@@@BA@@@BB@BBB@@BB@BB@@B@B@BB@@B@B@D@B@BBB@@@B@B@B@@@BBB@BB@BB@@@BBB@B@B@BB@@B@@@BAB@@@BAB@B@@@@@@@@BABAB@@@BAB@@@BAB@BB@@BBB@BB@@B@@@BABB@@BB@BB@@BB@@@BA@@BAB@@@@@B@BBBBB@BBBB@BBBB@@BB@@@B@@AB@@@B@@BB@@@@@@@@@B@@BB@@B@@@@@@@@B@@@@@BAB@@AB@B@@@B@B@BA@@B@D@@@@A@@@B@@@@@@@@BBBB@@BB@@@BB@@@@B@BBB@@@B@@BB@BB@@B@@BB@@B@@B@@@B@B@B@BA@@B@B@B@@@BAB@@AB@BA@@DCBA@A@@BA@@B@@@@@DABABAB@DAB@BAB@@@BAB@@@B@@@B@BA@AB@@A@@@A@A@@@@@@@A@@@@@@@@B@@AB@B@@AB@@@BA@@BA@@B@@A@@@@B@@@@AB@@@@@BAB@@A@@B@@A@@@@@@@A@@B@@@@BB@B@BBB@B@B@B@BBB@B@B@@@B@@@B@B@BAB@B@B@B@DBD@B@DBB@D@B@BBB@B@@@BB@@DBB@DBBBB@@@@@@B@@@@@@@B@@@@@@@@B@@BB@@@BAB@B@BADAB@@@@@BAB@@@BA@@BAB@BA@ABABA@@BA@@BA@@B@@A@@@@BAB@@AB@B@@AB@@@B@B@B@@@B@@AB@@@@A@@@@BA@@BA@@@A@@@@@A@A@@@A@A@@@AB@B@@@B@D@@@B@B@B@DAB@DAB@@AB@@AB@B@@AB@@@@@@@@A@@@A@@BA@@BA@@B@@@B@@BB@B@B@@@@@B@@A@AB@@A@@BA@A@@@ABA@@@@@A@@BA@A@A@@@ABAB@@AB@@@@@BAB@@AB@@@BA@@@@@AB@@@@AB@@A@@@@@A@@BA@@B@@A@@B@@@@@@@BA@@@@@@@A@@@@@@@A@@B@@@@A@@@AB@@@@@@@BA@@@@B@@A@@@@B@@@@@@@@@B@@@@@@@@@BBB@B@@BB@B@@@B@@@@@@@B@@@B@@A@@@@@AB@@@@A@@@@B@@@@AB@@@@A@@@@@A@@@@BA@@@@@@@AB@@AB@@A@@B@@@BAB@@@B@B@@@@@@@BA@@@@@AB@B@BAB@B@B@BB@@B@@@B@B@B@B@B@BAB@B@@@B@B@@@BB@@B@@BB@@@BB@B@@BB@@BB@@@@B@@@@@@BB@@B@@AB@B@@@B@BA@@B@@AB@@@B@B@@@@@@B@@B@@BB@@@@@B@@@@@BB@@@@B@@AB@B@@@@@@@@B@B@@@B@@BB@B@@@@@B@BBB@@@DB@@B@BB@@B@@@B@B@@@B@B@@@BA@@B@@@B@@@@@B@BB@@B@B@B@@@B@@@@@B@@@@@@@BA@@@@@AB@@@B@@A@@@@@AB@@@@@@@B@@A@@@@@@BA@@B@B@B@D@@@BB@@B@@@BB@@B@BB@@@@B@B@@@@BB@B@BBB@@@B@@@BB@@@@@@@B@BA@@B@@@BB@@BB@@BB@B@@@@B@@@@BB@@@B@@A@@BA@@B@@@B@B@@BB@@A@@BA@@@A@A@@@A@@@A@@B@@@@@B@B@B@@@B@@@@@@A@@@@@@@B@@@@BB@@@@@@B@@@B@@@BB@@@@@@B@@ABB@@@A@@B@@@@@@@@BB@@@B@@@B@@@@@B@@@@@@@@@@@@B@@@B@@@B@@@@@B@@ABBB@@@@B@@@BBB@@@B@@@@@@BB@BB@BBBB@@@@B@@B@B@B@@BB@B@@@B@@@@@@@@@@@@D@@@B@@@B@B@B@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@B@@B@@BB@@D@@BB@@BBB@B@BBB@@@@@@@@@@BB@@@@@@B@B@@AB@B@@@B@B@B@B@@@@@B@@@BA@@@@@@B@BBD@@@@@B@B@@@B@@@BA@@@@BA@@BBB@B@B@@@BAB@@@B@@@DABB@@@@@@D@@BBBB@B@@BB@B@@AB@@@D@B@BBD@@@B@@@B@B@B@@B@@@@@@B@B@B@@@B@@BB@@@B@@@B@B@B@@@@@BAB@B@@AB@@AB@@@B@@@B@B@B@@@B@B@@@BA@@@@BA@@BA@@@@@AB@@@@@@A@@@@@@AA@@@@@A@AB@@@@A@@B@@@@@BA@@@A@@@A@@BA@@@@BAB@@@@@@@@A@@AA@AB@@ABAB@@ABA@AB@@A@@@@B@@@@@AA@@@@@@AA@@@@@@@A@@@@@A@@@@B@@A@@B@@A@@@A@A@A@@@@BA@@@@B@@A@@BA@@@A@@@@@A@@@A@@@@@@@A@@@AB@@@@A@@@A@A@@@@@A@@@@@A@@AA@@@@AA@@@A@@BA@@@A@@@A@@A@AA@@@AA@@@@A@@AA@@@@@@AB@@A@A@@@A@@@A@@AA@@AA@AA@@AA@@A@@@@A@@@@@@@A@@@AB@@@@@@AA@@@@A@@A@@A@@@@A@@AA@@A@@@A@@@AA@@@AA@@A@@@@AAA@@AA@@@AA@@A@@@@@@@A@@@A@@@A@@BAB@BAB@B@B@@@BA@@@@BA@@B@B@B@BB@@B@@@BAB@@@B@BA@@B@@@B@AA@A@@@A@A@@@A@A@A@A@A@@@A@@@A@@AA@@A@AAA@@@@@@A@A@@@@@A@AAA@@@A@AA@@A@@AA@@AA@@@@AA@@A@@@A@AAA@@@AAA@@@A@AA@@A@@@AA@@@A@@A@@@@A@@A@BA@@@@@@@A@A@@@A@@@@@A@@@A@@BAB@@@@AB@@A@@@A@@AA@@@A@@@@AA@@@@@@AA@@AA@@@A@A@@B@@@@AB@@@B@@AB@@AB@@@@AB@@AB@@AB@@@@A@A@A@@@A@@AA@A@@@@@A@@@A@@@@@A@@@@@A@@A@@A@@@A@@A@@BA@@@AA@@A@AB@@A@@@@@A@@A@@A@@AA@@A@@@A@@@@AAB@@@@A@@@A@@@@@@AA@@@A@@@@@A@A@@@A@AA@@@@A@@@AA@@A@@@@@@@AA@@@@A@@A@@@A@@AA@@@@A@@@@A@@@@A@@@@@@A@@@A@@@@@A@@@A@@AA@@A@@A@@@@AA@@@@A@@@AA@@A@AA@@A@@@@@A@@@@B@@@BA@@@@BA@@@A@@@@B@@A@A@@@A@A@AA@@A@@@A@@@A@AAA@A@AAA@@@A@@@AAA@@AA@A@@@A@AA@@@AA@@A@@A@AB@@AAA@A@A@@@A@@@A@@BA@@BA@@B@B@@@BA@@B@@A@@@@@AA@A@@A@@@@@ABA@@@A@@@A@A@A@@@@@A@@@@@AA@A@@A@@A@@A@@@AA@@A@C@@@A@A@A@@AA@@@@@A@AA@@AAAB@@@@@@ABA@@@A@@@A@AA@@A@A@@AA@@AA@@@AA@@AA@A@AAA@@AA@@AkR]DUKBSLMbA^@@@@A@@BA@@@@@A@@@A@@@@@A@A@@@A@A@@@A@@A@@A@@@A@@AA@@@@@A@@B@@AB@B@@@BA@@BA@@@@@A@@@AA@@A@@@A@@@@AA@@@A@A@A@AAA@@@A@A@@@AAA@@@@@A@A@@@@@A@@@@AA@@AA@@@@A@@A@@AA@@AA@A@@@@AA@AB@@ABA@@@@@A@A@@@AAA@ABA@@@A@@@ABA@@BA@@@AB@@A@A@@@@@A@@@A@A@@AA@@BA@@@A@A@@@A@@@@@A@@@A@@@A@@@@@A@@AA@@@A@@BA@@@AB@@A@@@A@A@@BA@@@A@@BA@@@A@@@AAA@A@A@@@A@A@@BA@A@@@@@A@@@A@@A@AA@@@AA@@A@A@@@AA@@A@@AA@@@@BAA@BAA@ACAAAAA@@@AA@@@@A@@@A@A@@@A@A@@@AA@A@AA@AAA@@AA@@@@@A@@@A@AA@@@A@@A@@@@@AB@@A@A@@@A@A@AA@@A@@@A@@@A@@@@AA@"],encodeOffsets:[[132998,46229]]}},{type:"Feature",id:"231084",properties:{name:"宁安市",cp:[129.482851,44.34072],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@EACAE@@AA@@@AA@@AAAAAA@AAA@@@@AAA@A@AAAAA@AAA@A@C@A@C@E@@@AA@@C@@@A@CAA@AAA@@@A@GB@@@@A@A@C@@@C@A@@@A@@@AAA@A@AA@@@B@@A@@@@B@@AD@@AB@BCD@B@@AB@@AB@BABAB@B@@AB@DA@@@AB@@@@A@A@A@AAA@A@A@A@C@A@A@@@A@@B@@@BA@@B@BA@@BAB@B@B@B@@@BA@@B@@B@@B@B@@@D@@@B@B@B@B@@@@@BA@@@CBEBA@CBABABA@ABCF@BABA@@B@B@@@B@D@B@@@BA@@D@@AB@@@B@@AB@@@@@B@B@@@@AB@BA@@@@@@B@B@B@@@@@BAB@@@@@@@@@B@@@@@B@@@B@@@BAB@B@BA@B@@B@@@B@@AB@@@@AB@@@BA@@B@@@@@B@D@B@D@@@BABAD@@@@@B@@@@@@@B@@@B@@@@@@@B@@AB@BA@@B@@@@@@@@@@A@A@A@@@@@@@A@AB@@A@@@@@AB@@A@@@@@@@@@@B@B@@@BB@@@@BB@@B@B@@@@@BAB@BAB@@@@@@@@@@@BA@@@@@@B@@@@@@@@AB@@@@@@A@@BA@@@AB@@@B@@@BBB@BB@@B@B@@@@A@@@@B@@@@@@@B@@A@@@@@@B@@A@@@@@@@A@@B@@@@@@@B@@@B@BB@@@@@A@@BABAB@@@@@@@@@BA@@@@@@BA@@@@@@B@@@@A@@B@@@@@@@BA@@@@@@B@@A@@@@BA@@@@B@@A@@B@@@@@@@BA@@@@B@@A@@@@@@@AB@@@@A@@@@B@@@@@B@@A@@@@@@@AB@@@@A@@@@@@B@@@@A@@@@@AB@@A@@B@@@@@@@@@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@@@@BB@@B@@@BB@@@@@@B@@@BA@@B@@@@@BA@@BA@@@@B@@@@@BA@@@@@@@A@@B@@A@@@@B@@@B@@@B@@@@@@BB@@@@@@AB@@@@@B@@@B@@@B@@@@@B@@B@@@@BB@@B@@@@@@@BAB@@AB@@@@A@@@A@A@@@AB@@A@@@@@AB@@@@A@@@A@@@A@@B@@A@@@@@A@@@A@@B@@@@@@@@@@@B@@@@BB@B@@@@BB@@@@A@@B@@@@@@@@@@AB@@@@@B@@@@@@@@@B@@@@B@@BA@@@@@@@@BA@@BCB@@A@@@@B@@@A@@A@@@A@@@@AA@@@@@@@A@@@@@A@@@A@@@@@A@@@@@A@@@@@AA@@@@AA@@AA@@A@A@@A@@@BA@@@A@@@@@A@@@ABA@ABA@@BC@AB@@A@@@AB@@A@AB@@@B@@BB@@BB@@B@@B@@@B@@@@ABA@A@AAA@A@A@@@A@A@C@A@@AA@@AA@@@A@@@A@@B@@@BA@@B@@@B@@@@@B@@@@@BB@@B@B@@@B@@@BB@@BB@B@@BBB@@BBBBB@@BB@BBB@B@B@DB@@B@B@B@@B@@BB@@@@HBJAJAD@FBDDABI@A@@B@@AB@@@@@@@@AD@BAB@BADAF@@ADAF@BAB@BAD@B@BA@@@@B@@@@@BA@@@@@@@A@@B@@@@AB@@@BA@@B@@@B@BA@@BABA@AB@@@B@D@@@DA@BB@BA@@@BB@@@@@@@BA@@A@@@@@B@@@@@@@@@@@@@@@BA@@@@@@B@@@B@@A@@@@B@@@@AB@@A@A@@@@@A@@BB@@@A@@@@@@@A@@@@@@@@@AB@@AB@@@@@B@@A@@@@B@@@@AAA@@@@BA@@@A@@@A@A@@@A@@@AB@@AB@@A@@BABA@@BABA@BB@B@@BB@@@@@BA@@BBB@@@@BB@@@@BB@@@@@BB@@@BB@BB@@B@@B@@B@@@B@@BBBBB@B@@BB@@BB@@BB@BBBB@@B@@@@B@@@@@@@@@@@B@@B@@@@@@@@B@B@@B@@@@@B@@@@B@@@@B@@@@B@B@@B@@@B@@@@@@@BB@@BBB@@BB@@@BB@B@@B@B@@BB@B@@@@@@@@B@@B@B@@@@BB@@@@BB@@@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@B@@B@@@@B@@@@B@@@@B@@A@B@@@@@@@@BB@@@@B@@@@@@@AB@B@@@@AB@B@@@@@@AB@@A@@B@@@@@B@@@@A@@@@@@@AB@@AB@@A@A@A@@BA@AB@@A@@B@@A@@@A@@B@@AB@@AB@@AB@@@@@BABA@@@ABAB@@@@AB@BA@@B@@AB@B@@A@ADCD@@AB@@AB@@AB@BA@@BA@ABA@@BA@ABAB@@A@ABA@A@@@A@A@A@A@@@A@A@A@A@@@ABA@A@AB@@A@ABA@@BA@@@AB@@A@A@ABCBA@@@@@A@@@@AAA@A@@@A@@@A@A@@@@@AA@@@@AA@A@A@A@AAA@A@@@A@@A@@@@@@@@@@@BA@@@@@A@@@@@A@@AA@@@@@@@AA@@A@@@A@@@@@ABA@A@@@AB@@A@A@@@A@@@@@@@@BA@@@@BA@@BA@@@@@@@@@@@@@AA@@AA@@@AA@CA@@@AA@@@AAAA@@A@@@A@@@@@A@A@A@AB@@C@@@@@A@@@@@@@@B@@@@@B@@@@@@@@CBA@CB@@A@@@@AA@@@A@@AA@@@@AA@@@A@A@@@A@A@AA@@@@A@@@A@@@@@@@@B@BA@@D@B@@@@B@@B@@@B@@@@@@AB@@@@@@@@@BA@@B@@@@@B@@@BB@@B@@@@B@BBBB@@BB@@B@@@B@@B@@A@@@@@@@ABA@A@@@ABA@@@@@AB@@@@@@AB@@@B@B@@@@@B@@@BB@@@@@@B@@AB@@@@@@AB@@@@A@@B@@@@@@AB@@@@@B@@@@A@@@@@@@BB@B@B@@@BB@BBBB@@B@BBBBBB@@BDB@@BB@BBB@@@B@B@@@@BB@@@@B@@@@@@ABA@@BA@AB@@AB@@@@BB@@@@BB@@@@@@AB@@@B@@@B@BAB@B@@@@ABA@@@C@A@@@@@E@A@A@@@ABC@A@AB@@A@@@@@A@AB@BA@A@ABA@@@ABAB@@EFCBABCDA@@@@BABEFABADAB@B@@ADAB@BABC@CB@@@@@@@BB@@B@B@@@BA@@@@@ABA@@@ABABCB@@CDAB@@@B@@@@@@AB@@@@@BB@@B@@@B@@AB@B@@@B@D@DBB@B@@A@@B@@@BAB@@@@AD@@@@@@@@@@@B@@AB@B@@@@@B@@@@@@@@AB@@@@@@@@@@@@A@@@AB@@@@@@@@@@AB@@@B@@@B@@@B@@@@@@@@@@@B@@@B@@@@@@@B@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@B@@@B@@@@@@@@@@@B@@B@@@@@@B@@@@@@@@@@@@@@AB@@@@@@@@@@AB@@@B@B@@@B@@A@@B@@@@@B@@@@@@@@B@@@@B@@@@@@@@BB@@B@@@@B@@@@@@@@@@@@@@@@A@@B@@@@@@@@@B@@@@@@A@@B@@@@@B@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@B@BAD@@@@@@@B@@@@@@@@@@@@@@@@AB@@A@@@A@@@A@@@A@@@@@@@A@@BA@@@@@A@@B@@@@@@@@A@@@@@@B@@@@@@@@@@BD@B@@@@@@@@@@@B@@@@@@A@@@@@@BA@A@@B@@A@@@AB@@@@A@@@@@@@@@@B@@@@A@@@@@@B@@@@@@@@@B@@@@@BA@@@@@@@@B@@@@@@@@BD@@@@@B@@@@@B@@@@@BA@@@@B@@@@A@@BAB@@@@@@ABA@@@@@@B@@ABA@@B@@@@AB@@AB@@@@@@@@A@@B@BA@@@@B@@AB@@AB@@@B@@@@@@@@@@@B@@@@A@@@@@@@AB@@A@@@@@@@@B@@A@@B@@@@@@AB@@@@@@@B@@A@@@@BA@@BA@@@AB@@@@@@@@@@@@A@@@A@@@@@A@@@@@@@@B@@@@@@@@A@@@@@@@@@@B@@@@@@@BB@@@@@@@@B@@@@BB@@@@@BB@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@A@ABA@A@@@AB@@@@A@@@@@@@@@@@@B@@@@AB@@@@@B@B@D@B@@A@@B@@@@@@@B@@AB@@@@@@@B@@@@A@@@A@@@@BA@@@@@A@A@@@@@@@A@@@@@@@@BA@AB@B@@@@@@AD@@@@@B@@A@@@@@@@@@AB@@@@@@@@A@@@@@@@AB@@@@@@C@@@A@@@@@@@@@@@@@A@@@@@@@@BA@@@@@A@@@AB@@@@@@A@@@AB@@A@@@AAA@A@@@A@@@@@A@@@@B@@@@@BB@@B@@B@@BA@@@@B@@@BA@@B@@@BA@@@@B@@@@AB@@ABABA@@@AB@@A@@@A@@@A@@@@B@@ABA@@DAB@@AB@@@BA@@B@@@BAB@@AB@@@B@@@@A@@B@BA@@@@BA@@B@@ABA@AB@@ABA@@B@@A@A@@@A@AB@@A@@@A@@B@@@@@B@@@@@B@B@@@@@B@@@BA@@@@B@B@@AB@@@B@@@BA@@BAB@@@@@D@BBB@B@@A@@B@@ABAB@@@B@@@@@BAB@BA@@@@BAB@B@DA@@B@B@DBB@B@B@@@B@@BB@@BB@@@@@@@BD@@@DBDBD@@@BBB@@BBB@B@BA@@BBB@BB@PA@@DBDBB@DDB@BBB@BBB@BBBB@@B@BDDDDBDB@@B@B@B@DAFAD@B@DADBB@BAD@B@B@@ABAB@BAD@BA@@B@B@B@BBDBBBBBBB@BBFBBDF@BBB@@@@DBF@DBB@BB@@B@B@BD@@B@@BB@B@B@B@B@B@BBB@B@B@BBB@B@BBB@B@@B@@@B@D@BAD@B@B@D@BBB@B@BA@ABC@@BA@@@@B@B@@@@ABABAB@B@BABBB@B@@@B@B@@@B@BB@@@BBBBB@B@@BB@@BBBB@@B@B@B@BA@BB@@@B@@@B@@BB@@B@@@@@B@B@@@B@@@D@B@DAB@B@BAD@BBD@B@B@@@@B@@@@A@@B@B@B@B@B@@B@DB@@DBBBBDBB@DBB@BBDBB@@BB@@DB@@B@@@DBB@@@BD@B@@@BBD@B@BB@@@@B@DB@BB@BB@@@BBB@BB@@@BBB@@@BB@@@@@BA@@BABA@A@A@A@ABA@ABAB@@AB@DA@@B@BAB@BAB@@@@@B@@@B@@B@@BD@BBB@D@BBB@B@BAB@B@BAB@D@B@B@B@@A@@B@@@B@@@@B@BBB@BBBB@BB@@@@BB@@@D@DBD@F@D@D@B@B@BBB@B@@@BB@@@@B@@@B@@@B@@AB@B@B@D@BAB@D@B@B@@@B@@@BABAB@BABABAB@@@BAB@B@B@@@D@BAB@@@B@BBB@D@BBB@BBB@@@B@@@BA@@@@@ABA@A@@@AB@@@B@@@BA@@B@@@B@@A@@B@@@B@@@@AB@@@@AB@@@BA@@B@B@@@B@@@B@@@B@@@B@B@B@@BB@@BAB@B@@BB@@B@@BB@@BB@@@@@B@B@B@B@@@B@B@B@B@B@@@@@BB@@BBBBBB@@BB@@B@BBBBB@@BBBBB@@@B@B@@@@BB@@@@@@B@BBB@BB@@B@@@BA@@B@B@@@BBB@@@B@@@B@@A@@B@@@@BB@B@@A@@BA@@@A@@B@@@B@@@@A@@AA@@AA@@AA@@A@@AA@@@@A@@@@@@@@@AB@BA@@BA@@A@@@AAA@@@AAA@A@@AA@@A@A@@BAB@@A@@@A@@BA@@B@@@B@@AB@BA@@@@@@BA@A@@@@BAB@B@B@@@BA@@@@D@DAB@B@@@BBB@B@B@B@DBB@B@B@@@B@@BBDDBBB@BB@BBB@@BB@B@@@B@B@B@B@@@B@BAB@@@B@B@@@BA@@B@DAB@B@D@B@B@B@B@B@BAB@B@B@B@@@BA@@@@@AA@@AAAA@@@A@@@@AA@@ABA@@B@@@B@@A@@@@@A@@@@@A@@@AB@@A@@BA@@B@B@@AB@@AB@@@@A@@@AA@@AA@@A@@B@@AB@B@B@@AB@D@D@B@B@@@B@BA@@@@B@B@B@D@B@@@B@B@B@BBB@B@BB@@@@@B@@BB@B@D@BBB@@BB@@BB@@@@@B@@@@@B@@@BBB@@@B@@B@@@B@B@@@B@B@@@B@@@@@@@B@B@@@@@B@@@B@@@B@@B@BB@@B@@@BB@DBBB@@B@@B@BB@@@@@@@AB@B@@@B@B@@@@@B@@@B@B@@@BBB@BBB@BBBBBB@@@BB@@@@@BB@@@BB@@B@B@B@BB@@@@B@@@@@B@@@B@D@B@B@@@B@@@BB@@B@@B@@B@BAD@D@B@@@BBB@B@B@BBB@B@BBB@F@D@B@B@B@D@B@B@DBB@B@B@B@BBB@B@@@BAB@@@BAB@@@B@F@B@B@FAD@D@D@B@B@D@BBB@B@BAB@B@B@@@B@@@D@BAF@B@D@B@D@F@B@D@B@B@B@D@B@B@D@DA@@D@B@D@B@@@B@BBBBBB@@B@DAB@BAB@BAB@B@B@BAB@B@@@B@@@B@B@@@@@@BB@@B@@@ABBB@@BBB@DBBA@@B@@DBBB@B@B@BA@@@B@@@BBDBBB@@BB@B@B@B@BA@@B@@@@@@BBDBB@BBB@@@B@B@B@BAD@BAB@BAB@@ABA@A@@B@D@DBB@D@B@B@BADAB@BAB@BC@AB@B@BBDAB@BBD@@@B@FBD@@@@@DBD@D@BBB@B@B@B@B@DAD@@@B@@@@@D@B@B@BB@@B@BAD@B@B@B@B@B@@AB@@@@@B@@BB@@@@@@AB@@@@@B@@BB@BB@@@@B@@@@@BA@@@@B@@@B@B@B@@@@@@@@@BA@@@@@@@@BAB@@@B@@@@@@@B@@@@@@@BAB@@@@@@@B@@@@@@@@BB@@@@@@@@@B@FADAD@@@B@B@D@D@BBDABB@@B@@@B@@@B@BBB@DBBB@@B@B@@@D@BAD@@@@BDFBHBFB@@@B@@@B@B@B@FB@@BBB@B@BBB@FBF@@BF@DBBBB@@@@@B@@@@D@B@B@B@BDBDBBDBBBD@DBB@@@@BB@@B@B@B@@@B@BBB@BBBB@@BB@@AB@B@@@BA@BB@B@BBBBD@BB@BBB@BBB@B@BBB@@@B@B@B@@@B@@@B@@@BAB@BA@@B@B@BBBBB@B@@@@@BAB@@A@@B@@BB@@@BB@BB@BBB@BBB@@@BB@@@B@@@B@@B@DB@BB@@@B@@@@@@B@@@@D@BB@@B@B@D@B@B@@@B@B@B@B@@@@@@A@AB@@ABABA@A@@@A@@@@@A@A@@@A@@@@@AB@@A@@B@@@B@@A@@@@@AB@@@@@B@B@BB@@B@BB@@BBB@@@D@BB@@B@B@BA@@B@BA@@@@B@B@@@@@@@BB@@BB@@@@@BBB@@@B@@@B@@@BA@@B@@@B@B@@@@@B@@B@@@@BA@@@AB@@@BA@@@B@@B@@@@B@@@B@BAB@@@BA@@BA@@B@B@B@@@FB@@B@@@B@BBB@@@BBB@BBBB@@@BBB@@@B@B@@@BB@@B@@B@BB@@B@@@@@B@@A@@@@BA@@B@@@B@@AB@@@B@@@@@B@@@@@B@B@B@B@B@B@B@@BB@@@B@BAB@@@B@@@B@@B@@B@B@@@BB@@B@DB@BB@@@@@@B@@@@@B@BA@@@@B@@@BB@@B@@@@@BA@@@AB@@@@@B@@A@@B@@A@@@B@@@B@B@B@@@@@BBB@@BB@@DBB@@@@BBB@@B@B@B@@BB@@BB@@@@BB@@B@@@@@BB@@B@@BB@@@B@@@@BBB@@@B@B@@@BB@@@@B@@B@@@BBB@@@@@B@B@D@BAD@DABAFCFABABAB@@@@AB@@A@@@ABA@ABA@A@@@@B@@@BBB@@@BBB@B@@AB@B@B@B@@@BAB@@@B@B@@@@@B@@A@@@@@@BA@@B@@@@@B@@B@@B@B@@@BB@@@@B@@@@B@@B@@@@B@@@@BB@@@B@@@@@B@@@@@@BB@B@@B@@BB@DAB@@@B@@B@@DB@@@BB@@B@B@@@B@@BB@B@@B@AB@B@@@@@@@@BBBB@@@@@BB@@@@@BA@@@@B@@@B@@BB@BBB@@BB@@@@@B@@A@@B@@AD@B@@@BAB@@@D@B@@@@@B@BA@@@@B@B@B@@@B@@@BB@@BB@@B@@@B@B@@@B@B@@@DAB@@@B@@@BA@@BAB@@@BAB@B@@@B@@@@@B@@@BA@ADA@@@@@@BA@@B@@AB@B@@BB@@@B@BB@@@@DA@@B@BAB@BA@@B@B@B@@@B@@@@@BAB@BA@@B@B@@@BA@@@@B@@AB@@A@ADEBEC@CAKAu@QI@IDAZ@B@BAB@BAD@DARAHAH@H@FAJAFAB@D@B@LAF@FAF@B@BAF@D@@@J@F@H@@G@A@A@AACACCACAC@C@C@A@AAAA@A@CDADAHC@@BAB@@@@@BA@AB@@@@@B@B@B@D@B@D@@@@@BAB@@@@@@@@A@@@A@@@@@A@@@@BA@@B@@A@@B@@ABA@@@AB@@@B@B@BAB@BA@@@@@AB@@AA@@AB@@A@@@@@A@@@@@@B@@@@@B@BBDBBBBBB@BBB@B@B@D@F@B@B@@@D@B@@@B@DB@@B@@@B@BAB@@@@@B@BB@@B@@@B@@@@@B@@AB@@@B@@@BBBB@@@BB@@@B@B@B@D@B@B@B@B@@@B@BBD@B@BB@AB@B@DB@@D@D@D@DBD@B@B@B@B@B@B@B@D@D@D@B@B@BAD@B@H@H@B@D@B@D@F@D@B@B@B@@@B@B@BAB@B@B@@@B@BAB@D@D@D@B@DB@@D@B@@@B@B@BBB@B@B@B@F@B@D@BAB@B@B@B@D@B@B@@@B@B@BBD@B@B@B@B@B@BB@@@@BBB@B@BBB@@@B@B@DAB@D@D@BB@@B@@AB@@@B@@@@AB@@@@A@@B@@@@@B@BABAD@BAB@BAB@BAB@@@B@B@B@DB@@D@@@B@@@B@BAB@@@B@BB@@B@@@B@B@B@B@BBD@@@B@@@B@BBB@@@B@@@@@@@B@B@@AB@@@BA@@B@@@@@BAB@BABA@@DABAB@@A@A@@@A@@BAB@@@BA@@@AB@@@B@BA@@BADA@A@@B@B@BAB@BAB@BABAB@DCDAFCB@@AB@@AB@B@B@@AD@BAB@B@BAB@D@B@D@B@@@BA@AB@@AB@BAB@B@BAB@@ADABAB@BAD@B@BABBD@B@B@B@D@B@B@BBBAB@B@@@BAB@BAB@@A@AB@B@D@BAB@B@B@BAB@B@@@D@DA@@B@BA@AB@@@@AB@DAFCB@@A@A@E@EAA@A@@@AA@@@C@C@A@A@@@@@@A@@BA@@@@@A@@@A@A@@AA@C@AACCCACA@@@AC@@@@@@@@@@@A@@B@@@D@BABABA@@BABABAB@@ADCDAFC@@@@@A@@@C@@@@AAAA@@@@AAA@AAA@AAAAAA@A@C@@@A@A@A@A@@@A@A@AB@@ABABA@@BA@A@@AA@E@A@@@A@A@A@C@A@C@@@@@A@C@@@A@A@@BABA@AB@@AB@DA@AB@BABADC@C@@@A@@@@@A@@@@@@B@BADAB@@AB@@@@A@@BA@A@@BA@@BA@@@@BA@@DABABA@@BA@@@AB@@A@AA@@A@@@@EC@@AA@AA@@@@ABA@@@@BAB@@A@@HCB@DAB@DAB@@@BA@@B@B@FCFCBAB@@@BAB@@@B@BABAB@BA@ADA@@DABAB@BAHEBA@A@@@A@@@A@@AA@A@C@@@@@A@@@@B@@AB@D@DAD@DAD@B@D@DBD@FBB@BBB@D@D@B@D@B@@@BA@@BABA@@BA@@@C@A@A@ABA@@@ABA@@@AB@BABAB@DADADADA@@BA@@BCB@@@BA@@B@D@F@H@DBFBD@F@D@HBD@BB@@B@BBBBDBB@FBBBF@BBB@@BB@BBB@B@B@@@B@@@@@@@@ABA@@@@@A@@BABABAB@@@DADABABA@@BABAB@BAB@B@B@F@B@@@BA@@B@BABC@A@@@A@@BA@@@@@@@@@A@A@@A@@AAA@AA@@@AA@@@@AC@A@@AA@A@@A@@A@@BAB@@@@A@A@@@AAA@@AAAA@@@@@@@AB@@@@A@@B@@@@@AAAAAA@@@@@@@A@@AC@A@A@@@@A@AA@@AA@@AA@@@@@AB@@A@@@@@@@@C@AAA@AA@@AA@AA@@A@@@@A@@@A@@@AA@@AA@@@AB@@A@@@A@@@AA@AA@A@A@@@@@AB@@A@@@@@AAA@@AA@AA@AAAAA@AA@@AAA@@AA@@A@A@@@A@@B@@AA@@@AAAAA@AAA@AACAA@@@AA@AA@@A@@AA@@AA@@@A@@AA@@CA@A@@@@@A@@@@@A@@@@A@@@AAA@@@AA@@@A@@@@AAA@@ACAA@AAB@@@@AB@AA@@AA@@A@AAA@A@@@CAA@AA@@@A@@@@@A@@@AA@A@@A@@@ABA@AAA@@@A@@@@A@@@AB@@AB@@A@@AA@A@@@A@A@A@ABAB@@A@@@A@AA@@@@@@@A@@@AB@@A@@@@@@@@AA@@A@C@@@A@@A@@@A@@@A@@@A@@BABA@@@AA@@@A@@AAAA@@@@AA@@@@@A@A@A@AAA@AA@AAA@@@C@@@@@A@@AC@@@A@@@ADABA@@@@@A@@A@@AA@@@CAAAAB@@@BA@AB@@A@@@A@@@A@A@@@A@A@A@A@A@A@@@A@@@AB@@A@@@A@@@A@A@A@A@@@AA@@AA@@AA@@A@@AA@@@AA@@@A@@A@@A@@@@AA@@@@@@@A@@@A@@@@A@@AA@@@AA@@A@@A@@@@@@A@@BA@ABCBABAB@@@@@@@B@@BB@@@B@@@@A@@BA@A@@@A@AB@@A@A@AAA@@@A@@@A@@@@@@@@A@A@@@A@@@@@@AAA@A@@@@A@@@@@A@@A@@A@@A@@@@A@@A@@A@@@A@@B@@AA@@A@@@@AA@@@BA@AB@@A@A@@@@@A@A@@AA@A@@@A@@@@@AACA@@@A@@AA@A@@@@A@@@A@@A@@A@@@@A@@@@@@AAA@@@AA@@@@@A@@@AA@@A@@@@A@@@@@@@@@@@A@@@@@@@A@@@A@A@A@@@AB@@A@C@A@AB@@@@@@@B@@@@BB@@@@@@A@@@@B@@@@@@A@@@BB@@@@@@@@@B@@@@@B@@@@@@@B@@@BB@@B@@@@@@A@@B@@@@@@@@@@A@@@@@@@A@@@A@@@@AA@@@AA@@AA@@AA@@A@@A@@@@AA@@@A@@@@@@@@@A@@@@@@A@@A@@@@A@@@A@@@A@@@A@A@A@@@@@@@@@@@@A@@@@@@@A@@@@@A@@@@@AA@@@@A@A@@@@@@@@A@@@AA@@A@@@A@@@@@A@@@@@A@@@A@A@A@@@@@@@@@@@@@A@@A@@@@ABA@@@@@A@@@@@@@@@@A@@@@@A@@A@@@@A@@@@@A@@A@@AA@AA@@AAA@@@AA@@A@@@@@A@@@@@@A@A@@@@@@@@BA@@@@@@@@A@@A@@A@A@CA@@A@A@CA@@@@A@@A@A@@@A@@@@@AA@@@AA@@@A@@AA@@@@@@@@@@AA@@A@@A@@@@@@AAA@AAA@AAAAA@A@@A@@@@@@@@@@BA@@@@@A@@@@@@A@A@@@A@@AA@@@A@@@@@A@@@@@A@@@@@@A@@A@@@@@@@@@@@A@A@@@C@CB@@A@@@@@@@A@@@@AAA@@@@@A@@@A@@@@A@@@@@A@@@A@@@A@@@@B@@@@A@@@@@@@@@A@@@@BA@@@A@@@A@@@A@@@A@@AA@AA@A@@@AA@@A@@B@@@@A@@BA@@@@AA@@@A@@@@@@@A@@@A@@B@@A@@@@B@@@@A@@@@@A@@@@B@BA@@@@@@@@@@@A@@@@@@@@B@@AB@B@B@B@@@@A@@@@@@@@@A@@B@@A@@@@@@AA@@@@@@BA@@B@@AB@@@@AB@@@@A@@@@AA@@AAAA@A@@@@@@@A@@@@@A@@AA@@@@@@@@@@@@@@A@@BA@A@@@A@AB@@@@AAA@A@@@AAA@AAAA@A@@AA@@@A@A@@@A@ABA@@BA@@@AB@@@@@@A@@@@@@B@@AB@@@B@@@BA@@B@@@@@BA@@@A@@@@@@@@@A@@A@@AA@A@@AA@@A@@@@@@@@@A@@B@@@@AB@B@@AB@@@@A@@@@@A@@@A@@@A@@@@@@@A@@@A@@@@@@@@A@@A@@@@@@@@@ABA@@@@@@A@@@@AA@A@@@A@AA@@@@@A@@AA@A@@@A@@@A@@@A@A@@A@@@@@@@@@@@A@ABA@A@A@A@A@A@A@A@@@A@@@CA@@AA@@@@@@AB@@A@@@AB@@@@A@@@A@@@A@A@AB@@@@@@@@A@@@@BA@@@A@@@@@A@@@ABA@@@A@C@@@AAA@A@A@A@@@A@A@A@@@@@A@@@@@A@@@@@@A@@A@@A@A@A@@@A@A@A@@@A@A@@@A@A@@@ABA@A@A@@@AB@@A@ABABA@@@@@@@@@@A@@@@@A@@BA@@@ABA@@@ABA@A@@@A@@@A@A@@@A@@@A@@@AAA@@@A@A@@@A@@@A@@@@@A@@@A@@@A@@@ABA@A@A@@@A@@@@@ABA@A@@BA@@@A@@@A@@@@@@@A@@@A@@@A@A@@@ABA@A@A@AB@@A@A@@@A@@@@@@@A@@@@@@A@@A@@AA@@@@@AA@@A@@@A@@A@@A@@@A@@@@@A@@@A@@@AA@@A@@@@@A@AA@@A@@@@@A@@@A@@@A@A@@@A@A@@@@@A@@@@@A@@@A@@@A@AA@@A@@@A@@@A@@@A@@@@@A@@@@@A@@@@@@@@@AA@@A@@@@A@@A@A@@AC@@AA@A@@@AAA@@@A@A@A@@@A@@@AB@@A@A@@@@BA@@@@B@@@@@BA@@@@B@@@B@@@@@@@B@@@@BB@@@@@B@@@@@@@@@@@@@@A@@@A@@@@@AA@@@@A@@@A@@A@@A@A@@@A@A@@@A@A@@@C@@@A@A@A@A@@@@@A@A@@@A@@@A@@@A@@B@@A@@@A@@@A@@@A@@@A@@@@@A@@@A@@@A@@@A@A@@@A@A@@BA@@@A@@@@@@@ABA@@@@@@@A@@@@@A@@B@@A@@@A@@@@@A@@A@@A@@A@@A@@@A@@@@@A@A@@@A@@@A@AA@@@@A@A@@AA@@@AA@@@@@@@A@@@@@A@@A@@A@@@@@@A@@@@@A@@@A@AA@@A@@@@@A@@@C@A@C@EAA@A@@@A@@@A@@@@@A@@@@@A@@@@@A@@@A@@@A@@@AAA@A@A@@@A@@A@@A@@@ABA@A@A@@@A@@@A@A@@@A@@@A@@@@BA@@@A@@@@@A@@@@@A@@B@@A@@@A@@@@BA@@@A@A@A@@@A@@@A@@@AA@@A@@@@@A@@@A@A@@@A@@@@@@@A@@@A@@@A@@AA@@@A@@@A@@@AAA@@@@@A@AA@@A@A@@@A@C@@@A@@@@@AB@@A@@@A@@B@@A@A@@@A@A@@@A@A@ABA@A@A@@@A@@@A@@@@@AB@@A@@@@B@@A@@BABA@@BA@CBCBC@@@@@A@AAAAAA@@AA@AA@@@AAC@GAA@C@E@@@E@CBA@A@A@A@A@A@@@A@@C@K@@@AA@@@ECA@ECAAAAAACAGKAAAAA@C@AAA@E@A@AAIAECAAEAC@A@C@G@A@A@@@@AA@@C@@AAEE@@CA@AA@ACAA@E@@@A@@@@@@@A@@@@@@@@AE@A@@@@@A@@@@@A@@@@@A@@@@@@@@@@@AA@E@@@A@@@A@@@A@@@A@A@C@@@A@A@@@A@@@@@@@@@A@@@A@@@@AA@A@@@@@@@A@@@@A@@@@@@@@@@@@@A@ABA@@@@@@@A@@@@@@@@AA@A@@@@@@@@@@@AA@@@@@@@@@@AA@@@@@@@A@@@AA@@@@A@@@A@A@@@@@A@A@A@A@A@@@@@@@@@A@@@@@@@@@A@@A@@@@@@@@A@@@@@@@@AA@@@@@@@@A@@B@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@A@@@@@@A@@A@@@@@@@@AA@@@@@@@A@A@@@@@AAA@A@A@@@@@C@@@@@C@A@@A@@@@@@A@@@A@@@@A@@A@@@@@@@@@A@@B@@A@@@@@A@A@@@A@A@@@@@AA@@@@@@A@@@A@@@@@A@@@@@@@@@ABA@@@A@ABA@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@B@@@@A@AB@@@@A@@@@B@@A@@@@@@@@@A@@@@@@AA@@@@B@@A@@@@@A@@@@@@@@@@@@BA@@@@@@@@@@@@@@B@@@@@B@@@@@@A@@@A@A@C@@@A@@@@@@@@@A@@@@@@@A@@B@@@@A@@@@BA@@@@@@BA@@@@@@B@@@@A@@@@@@@@@@@@@A@@@@@A@@@@@A@A@@@A@@@@@@@A@@@A@A@@BA@A@A@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@BA@@@@@A@@@@@@@A@@@@@A@@@A@@@A@@@@@A@@@@@@@@@@@AB@@A@@@@@@@@@A@@@@@@@@@@@@AA@@AAAA@@A@@A@@@@@@@@A@@@@@@@A@A@@@@@@@AA@@A@@@@@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@AB@@A@@@@@@@A@A@@@@@@@A@@@A@A@@@@@@@A@@@@@@@@@@@@@@@A@@@@B@@A@@@@@@B@@@@A@@@A@@@@@@B@@@@@@@@@BA@@@@@@B@@@@@@@@@@@@@@@BA@@@@@@B@@@@AB@@@@@@@B@BAB@@@B@@@@@@@@@@@BA@@@@BA@@BA@@@@@@BA@@@@@@@@B@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@A@@@@@A@C@A@@@@@@@CB@@A@@@@@@@@@A@@@@@A@ABAB@@A@ABC@@BA@@@@@A@@BA@A@@@@@A@@@@@AAA@@@AAA@@@@@@@AA@AA@AAA@@@AA@@A@@@AA@@@@A@@A@@AA@@@A@@AC@@@AA@@@@AA@@@AAA@@@A@AAA@AA@@A@@@AAA@@AA@@AA@@AA@AAA@@@A@@AAA@@A@@A@@@AA@@@@A@@@@@@CAAACA@A@@A@@@@AAA@A@@@@@@A@@@@AA@A@@A@@@@@@A@@A@@@A@@@A@@@A@A@@@A@@@A@@BA@@@@BC@@@@@@@A@A@A@AA@@A@@B@@A@@@A@@BABA@A@@@@@C@@BABC@A@@@A@@BA@@@@@@@A@@@@@A@A@@@@@@@A@@BA@@@@@@AA@@@@@@@@@@@@A@CAAAA@@@A@@A@@@@A@@@@@@A@@@@@@@@@@@@BA@@B@@AB@B@B@BAB@BABA@@B@@@@@@A@@@@AA@A@@@A@AAA@A@@@@@A@@A@@A@@@@@A@@@A@@@@AA@A@@A@@A@@@@@CB@@@AA@A@A@@@@@A@@B@B@B@@@@@B@@@@@@A@@B@@A@@@@@ABA@@@@@@@@@@@@BA@@@@B@F@@@@@@@BA@@@@BAB@@@@AB@@@@A@@@@AC@@@@B@@@@ABA@@@A@@@@@@@A@@@@@@A@A@AAA@@@@@@@@@AA@@@@@@@A@A@A@@@AA@@@@@@AA@@@A@AA@@A@@AA@@@A@@@CA@@A@@@A@@@@AA@@@@A@@A@@@@A@@@@@A@@BABA@A@@BA@@@@@CB@@A@@@@@A@A@@@@@A@@@@BA@@@AB@@@@@@A@@@@A@@@@@A@@@A@@A@@@@@@@@@AA@@A@@@@@@BA@@@@@@BA@@@@@A@@@@@@AA@CA@@A@@@ACC@@@@@@@@@@AA@A@A@@BA@@@A@@@A@@@AB@@A@@@@BA@A@@@A@@BA@@@A@@@A@@@A@@@A@@BA@@@A@@@AA@@@@A@@@@@A@@@@@@@@B@@@@ABBB@@@B@B@@@B@B@@@@@B@@A@@B@@AB@@AB@@A@@@AB@@A@@@A@@@@@A@@@@A@@A@@@@B@@@@A@@@A@@@@BA@@B@@AB@@@@@@A@@@A@A@@@@@A@@@A@@@A@A@@@@@A@@@A@@@A@@@@@AB@@A@@@@AA@@@A@@@@@A@A@@@@@AB@@@@@@A@@@@@@AA@@@@A@@@@@@A@@@@B@@@@A@@@@@@@A@@@@AA@@@@@A@@@@@@@@@AB@@@@@BA@@@@@@@@@A@@@@@A@A@@@@A@@A@@A@@@@A@@@@@A@@@@@@@A@@@A@@@A@@@AA@@@@@@A@@@@@A@@@A@A@@@A@A@A@@@A@@@@AA@@@@@AA@@@@@AA@@@AAA@@A@@AA@@@AA@@A@A@@AA@A@C@A@@@A@@@A@A@@@A@A@@AA@A@@AA@@@AA@AA@@A@@@A@@@@AA@@@@@A@@@A@@@A@@@A@@@@@@A@@@C@@@AAA@A@A@A@@@@@A@@@@@ABA@@@AB@@A@@@@@@@@@@@A@@@AAA@@A@@A@@@@A@@@@@@AA@@A@@AA@@@@A@@@AA@@@@@@@A@@AA@@@A@@@A@AA@@A@@A@@A@@AA@A@@AA@@@A@AA@@@@A@@@@@A@@@A@@A@@A@@@@A@@@@@@@@@@@B@@@B@B@@AB@B@B@BAB@B@@@@@B@@@@@@A@@@A@A@A@A@@@A@A@A@@@A@A@A@@@A@@@A@@@A@@@A@@@@@A@@@A@@@A@A@@@A@A@@@@AA@@@A@@@@@@@A@@@@A@@@@AA@A@@@@AA@@@@@AA@@@@@@AA@@@A@@@A@@@AAA@@@A@@AA@@@@@@AA@@AA@@@AA@@A@A@@@@@@@A@@@@@@@AAA@@@A@@@@AA@@@@@AA@@A@@@AAA@@@A@@@A@@@CBA@@@AB@@A@@@@@A@@@@BA@@@AB@@A@@@AB@@AAA@@@@@A@@@@@@@AA@@AAA@@@AA@@@@A@@@@@A@A@@@A@@@@@@@@AA@@A@@@@AA@@@A@@@AB@@AA@@@@@@A@@AA@@@@A@@A@@A@@@@@A@@@AB@@A@@@A@A@A@@@A@@@A@A@A@A@@@AAA@@@A@@@A@A@A@@@A@A@A@@@A@@@@@@@@@@@AA@@@@@AA@@@A@@@A@@@AAA@A@A@@@A@AB@@A@@@A@A@@@@@A@A@@@A@@@A@@@AA@@A@@@A@A@A@AA@@A@@@AA@@@@A@A@@@A@A@A@@@@@AAA@@@A@@@AA@@@@A@@@@@A@@AA@@@@AA@@@@@@@@AA@@A@@A@@@A@@AA@@@@@A@AA@@@@@@@@A@@A@@A@@@@A@@AA@@@@@AA@A@@@AA@@@@A@@AA@@@A@@AA@@@AA@@A@@A@@A@@@@AA@@@@AA@@@@@AA@@A@@@A@C@A@@BA@A@AB@BAB@@ABA@ABCBABABA@A@A@CBC@CBA@@@A@ABA@AB@BA@A@@@A@A@C@EBA@A@A@A@@@A@A@@@A@@BC@C@A@A@@@@BA@@BA@@BA@ABA@AB@@A@@@@B@B@@AB@BCDA@@BCFAB@@@@A@CBCB@@A@@@A@A@A@A@E@A@A@@@A@"],encodeOffsets:[[132029,44602]]}},{type:"Feature",id:"231085",properties:{name:"穆棱市",cp:[130.524436,44.918813],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@A@AA@@A@CA@@@@@@AA@@@@@C@@BA@@@A@@@@B@@AB@@@@@@A@@@@@A@@@@A@@A@@@@@@@@@@@A@@@@@@B@@A@@@@@@@A@@@A@@@@@@@@@AB@A@@@@@@@@@AAA@A@@@@@@@AA@@@@@@@A@@AA@@@@@A@@@@@AA@@@@@@@A@@@AAAA@@A@@@@@@@@@A@@@@@@A@@@@@@@@@AA@@@A@AA@@A@@A@@@A@A@ABA@@BA@@@@@A@@@@@A@@@@@@@@AAA@AAA@@@@@A@@@@@@@A@@@A@@@@@@@A@AAA@@@A@@A@@A@@AA@@@A@A@@@@@A@A@@@@@AA@@@@@@@A@@@@@@@A@@A@@@@@A@@@@@AA@@A@@@A@@@A@@@@@@@@@A@@@A@@B@@@@A@@@@B@@@B@@@@@@@B@@@@AB@@AB@@@@AB@@@@A@A@@B@@A@@@A@A@@@@@A@@@A@@@A@@AA@A@@@AA@@@@@@@@AA@@@@@@@AA@@@A@@@AA@@@@AB@@A@A@@@CB@@@@A@ABABA@@@A@@BA@@@A@@@AA@@@@A@@A@@@A@@@A@@@@@@@A@@@@B@@@AA@@@@@B@@@@A@@@@@@@@@A@@A@@A@@@A@A@@@@@A@A@A@@@A@@AA@A@A@A@AAA@@@@@@@AA@@@@@@@A@@@@@@@A@@@@@A@@@@@@@ABA@@@@@AA@A@@AA@@@@@@A@@A@@AA@@@@@A@@@@@@@A@@@@@A@@@A@@@ABA@A@@@A@@@@@A@@A@@@@@A@@@@A@@AA@@A@@A@@@A@@@A@@@A@@A@@@@@@@@@@@A@@@@AA@@@A@@@@A@@@@AA@@AA@A@@A@@@@@A@@@A@@A@@A@@@@@@@@@@@@AB@@@@A@@@@@@AA@@@A@@@A@@@AA@@A@@@A@@@@@A@@AA@@AA@@@@@@@@AA@@A@@@@@A@@@A@@@@@A@@@@@A@@@@@@@AB@@AA@@@@@@A@@@@AA@A@@@@@A@@@@B@@@@@@A@@@@@@@A@@@@@@@A@@@@@AB@@A@AA@@@@@BA@@@@@@@A@@@@@A@@@@@A@@@@B@@@@A@@@@@A@@@@@@AA@@A@@@@@A@@@AA@@@@@@AA@@@@A@@@@A@@A@@@A@@@AA@@A@@@@A@@@@@@@AB@@A@@@@@A@@@A@@@A@@@@@@@@A@@A@@AA@@@AA@@@A@@@A@@@A@@@A@@@@@A@@@ABA@@@@@A@A@@@AA@@A@A@CAE@@ACBA@AB@@C@E@A@@@@A@ABAA@@A@ACCGA@@AA@@@@BCBA@@CC@AAAB@@@@A@@@ABA@AAAA@AAA@@@BA@A@@DABA@AAAAACAGAAA@@AA@CA@C@CAAA@@ABA@@AA@A@A@AAA@A@A@ABA@@A@AA@A@@@AA@AA@AA@@@A@@@@@A@@@@@@@@@@AA@BA@A@@BA@@@@@@BC@@@C@C@AA@@@@@A@@A@@@@@A@@@@A@@@@@@A@@@@@BB@@@@A@@B@@@B@@ABA@ABA@AAA@C@A@ABA@@BABCBA@@B@@@B@@@@A@AFAB@D@B@@CBABE@A@C@CBCB@@CAAAC@A@CA@@A@@B@B@B@@AB@D@@AB@@CBABABADCDAB@B@@BD@@@B@B@@A@CBABAB@B@DABBB@B@@@@ADA@@B@D@B@@CD@B@@@@@@B@B@HDB@@B@@CAA@E@@B@@AB@@BD@B@BB@DD@@@@@B@BB@@@D@B@B@BBB@B@@@@BE@CB@BCB@BBBB@@@@BAB@@DFBB@@@BB@@@FB@@BBB@@B@@@@A@@@@B@@@B@@@@@@@B@@@B@@@@@@AB@@@@@B@@@@@B@@@@@BB@@@@@@BB@@@@@@@@@AB@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@AB@@@@@@@@@B@@@B@@@@@@@BA@A@AB@@A@@@@B@@@@A@@@BB@@@@@@@@BB@@@@@@@@@@@@@B@@@@@@@@@BB@@@@@@@@@B@@BB@@@@@@B@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@B@@B@@@@@@B@@B@@@@A@@@@B@@@@@@@@A@@B@@A@@@@@AB@@@@@@@@@BB@@@@@@@@@@B@@A@@@@@@@AB@@@@@@@@@BA@@@@@@@@BA@A@@B@@@@@@@@@@@@@B@@@@A@@BA@@@AB@@A@@B@@A@@@@@@@@@B@@@@@@@@@BB@@@@B@@@@@@@B@@B@@@@BB@@@B@@@@@B@@@@@@@@@@@@@@A@@BA@@@@@@@A@@@@@A@@A@@A@@@@@@@@@@@A@@BA@@@@@@@@B@@@@@@@B@@@@A@@@@@A@A@@@@@@@A@@@@@AA@@@@@@@@A@@@@@@@AB@@A@@@@@@@@@@@@@@@@B@@@@B@@B@@@@@@@B@@B@@B@@@@@B@@B@@@@@@B@@@@A@@@AB@@A@@B@@A@@@A@@@AB@@@@A@A@@B@@@@@@@@@@@@@@BB@@@@@@@@@B@@@@@@A@@@@@A@@B@@@@@@A@@@@@@@@B@@@@@@A@@@@@@@@@A@@@A@@@@@@@A@@@@@@A@@@@@@@@A@@@@B@@@@@B@@A@@@@@@@@@@B@@@B@@@@@@A@@@@B@@A@@@@@@@@B@@@@@@@@@@@BB@@@@@@@@@B@@@@@@BB@@@@@@@@@B@@A@@B@@@@@@@B@@@@@@@@@@@@B@@B@@B@@@@@@@@@@B@@@@@BBB@B@@@B@@@B@@@@B@@@@@@@@@@@@B@@BB@@@@@@@@@@@@@AB@@@@A@@@A@@BA@@@@@@@A@@B@@@@A@@@@B@@@@@@AB@@@@@@@@@@A@@@@@@@B@@@@@BBB@@@@@@@@B@@B@@@@@@@@B@@@@@@@@@B@@B@@B@@@@@@@@@@AB@@@@@@@BA@@@@@A@AB@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@@@AA@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@A@A@@@@@@@@@A@@@@@@BB@@@@@@@@@@@@B@@@@@@@@@@AB@@@@@@@@@@@B@@@B@@@@B@@B@@@@AB@@@BA@@B@@@@@@AB@@@@@@A@@@A@@@AB@@@B@@@@BB@@@@@B@@@B@@@B@@@@AB@@A@ABA@@@A@@@@@ABA@ABA@A@ABA@EB@@A@@@A@A@@@A@@@A@A@@@@@AB@@@BA@@B@@AB@@A@BBDB@@B@@BB@@@@B@@@@ABCB@B@@@B@@BD@@@B@@@@@D@@BB@BBBB@BBB@B@B@@@@@B@@B@@B@BB@BB@@@B@@B@@ABAB@@@B@@@B@@@B@@@BB@@@D@B@@@BB@@@@@@@@@BA@@B@@@B@@@@@@BBB@@@B@@@BABAB@B@B@@@B@B@@BB@@@BA@@BA@@B@@@@@@B@@BB@BAB@B@@@BB@B@@B@@@B@@@@@B@@BBB@DB@@B@B@BBB@@@BB@@BBA@@B@@A@BBB@DB@BB@BB@@@@@B@@BB@@B@BB@@B@@@@@@B@@@@@B@@@@@BDB@@BB@@@B@@BB@@BB@@@BB@@BBB@@B@DBBBB@BBB@BBBB@@B@@BA@@@@B@@@B@BB@@BB@BB@@BBB@BBBBB@@BBB@@BB@B@@@@@BA@@B@@@@@B@BBBB@@B@@@B@@@BA@@B@@BB@@BB@@B@@@B@@@@@@BB@@BBB@@BBB@BBD@@@@@@@@@@BA@@B@@@@BB@@BB@@BBB@@@@@@B@BBD@@@B@@@@@@BBBBBB@@@@A@@@@B@@A@@B@@@@@@BBBB@@BB@B@@@B@B@@A@AB@@@BB@@@@BBB@@@BBD@@@@BB@@B@@BBB@BB@@@@B@B@@@@@@@@AB@@@B@@@BADABA@@@AB@@A@E@A@A@A@ABA@ABAB@@ABABCBCB@@A@ABABAB@@@B@@@@AB@B@@@@@@A@@@A@A@A@AAA@@AA@AAE@AAEAA@CAAAAAA@@@AAC@GAC@E@C@EACAG@E@C@A@@@AB@@A@AD@@AB@@CBCBCBCBA@ABABA@@B@@AB@B@@AB@B@B@B@D@@AB@@ABAB@@AB@@A@C@A@C@C@A@AAA@EAC@CAC@A@C@CBC@CBC@A@@BA@@@@@@B@@@@@D@BBB@@@B@@@B@@@BABGFABA@ABCB@@CB@BABA@ABABA@@@A@AB@@A@ABEDED@@@@@BB@BB@@BBB@@@BBB@@@BBB@B@@@@@BBB@@@B@B@@@@@DAFAFA@@B@F@F@FBDAD@FAB@B@@BB@@@D@@BB@@@@BBA@@@@B@B@B@@@B@B@@@B@B@BAB@B@D@@@BBB@@@B@@@B@B@B@@@B@@@D@@@B@BAB@@@B@@@B@@@@@B@@@BA@@BA@@@@@A@A@AB@@AB@BA@@B@@@@A@@@@BA@@@@B@B@@@B@@@B@B@B@B@@@DB@@B@@BB@@BB@@B@@@@B@@@B@B@B@@@B@B@@@@@B@B@@@@BB@@BB@@@B@BBB@@B@@@B@@@@@BB@@@B@@@@BB@@BB@@B@BB@B@@@B@B@@@BBBBB@@B@AB@BBB@D@B@@B@@@BB@@@B@@B@BBBB@@@@B@@B@@BBB@@@B@B@@B@@@@DB@@BA@@@@B@@B@@B@@AB@B@B@@BB@@@@BA@B@B@@ADB@@BA@@@AD@B@@@@B@@@BB@@@@@@@B@@@@@@@B@@@@B@@@@B@@CB@@@@@@B@B@@B@@BB@B@@BB@@BB@@@BB@@BBBBBBB@BDB@@DBBBD@BBBB@@BB@@@@@@@@@B@@@@@@BBDBDD@@B@@@BBB@B@@@BB@@B@@@@@@BBB@@@@@B@@@B@@@BA@@@AB@B@@@@@@@BAD@@@B@B@@AB@B@@AB@@@@A@@@A@A@@@A@AB@@@@@@@@AB@@@@@@@B@@@@@B@@@@BBB@@@@@BB@@BBBB@BB@@B@@BB@@BBB@@@BB@@BB@@@@@@@BB@@B@B@@@B@@@@@@BB@@@@@@B@B@@AB@@@@@@CBA@C@A@A@C@@@A@@@@B@@A@@B@@@@@@@B@@@BBB@B@B@@@@@BB@@@@@@@BB@BD@B@@@@@B@@@DAB@@@B@@@@AB@@@B@BA@@@B@@B@@BB@@@@B@@BB@@@B@@@BB@@@@B@@@BBB@@@B@B@B@@B@@AB@@A@@@ABA@@@@B@@A@@B@@A@@BA@@@@@ABAB@@@@A@@BA@@@A@A@@@A@@@A@@@A@ABABABCBCBA@A@ABC@A@C@A@A@A@ABC@ABA@@@EDCBCBABA@@@@BA@@BA@AB@@@@@@ABA@@@AB@@AB@@@@ABCDAB@BABABAB@@AB@@ABA@ABABABA@ABABA@AB@@A@A@@@@@ABABABCBABAB@@ABABABA@@BABABABA@@@@BAB@BABABAD@BABAB@@ABA@@@A@CB@@ABA@ABA@@@A@ABAB@@A@ABA@@BA@@BA@@BA@ABA@A@@@@@AB@@AB@@A@@@A@A@@BA@C@@@A@A@A@A@A@A@@B@@A@@B@@@@AB@@AB@BA@AB@@AB@@@@AB@@A@@@@B@@@@@B@@BB@@@B@@@BBBA@BB@@@B@@@B@@B@@BB@BB@@@@@B@@@@@B@@@BA@@B@B@B@@@BBB@B@@@BBB@@@B@B@B@B@B@@@BB@@B@@@@@B@@@@A@@@A@@@A@@A@@C@A@AB@@A@@@A@@BA@@@ABA@@@A@@@A@@@A@@@A@@@A@@@@@@@@@A@@@AB@@A@@@A@@@A@C@A@@@@BBBBB@B@@@B@B@BA@AB@@AB@@AB@BA@ABABABC@AB@@AB@@@B@BABA@@BAB@BAB@@@BAB@B@@@B@@@B@B@@@BAB@B@BABABAB@@ABA@AB@B@@ADAB@BAB@@@BFBBA@@DEBAFCDADADAB@R@@BHFNJB@B@D@B@BABCHK@A@@CEB@n@lHjJDtJFDVLZR^\\ZnHVH`ChY|EnFFfDfBRFBLABGL[N[HkRQGIECKYN@B@BB@@BB@B@@@BBB@@@B@@@B@@@B@BBB@BBB@B@@@B@B@@@B@B@@BB@B@B@@@B@B@B@B@@@BB@@B@@@B@BB@@B@@@B@@BBB@B@B@B@@B@@BB@D@B@DBB@B@@B@@BBBBB@@BB@B@B@BAB@B@@@B@B@@BB@@@@B@@BB@@@@@BB@@BB@@B@@@@@@B@B@B@B@B@@@B@D@BBB@@B@@B@@B@@B@@BB@B@@@@B@@@B@@@B@@@B@@@@AB@@@B@@@B@@@B@@@BB@@@@BB@@B@@B@@B@@BB@@B@@@B@B@BB@@B@@@@B@@@B@@@@B@@@B@@B@@B@BB@@BA@@B@@BB@@@BA@@BB@@@@BBB@@@BB@@B@@@B@D@B@B@BB@@BBD@B@BB@@BB@@B@@@@B@@AB@@@@@BB@@@@B@@BB@@@@B@@B@@@B@@@B@@@BB@@B@@@@@B@@@@@B@@@BA@@BA@@@A@A@@@A@@@A@@@A@AB@@A@AB@@@@AB@B@@A@@@A@A@ABAB@B@B@@AB@@A@@@A@@@AB@@@B@@@B@BA@@B@@A@@@A@@@@@A@A@AB@@@@CBCB@BAB@@@@@B@@@B@B@@AB@@ABAB@BA@AB@B@@A@@AA@AAAAA@@@A@@@@@A@A@AB@@ABA@@@ABA@@@@B@@@BA@@@@B@@@B@@@BA@@@AB@B@@@B@@@BB@@B@@@BA@@BB@@BB@@B@B@@@BB@@B@@@BBB@@@BB@@B@@B@@B@B@@@B@@@BA@@@@BAB@BAB@BAB@@@DAB@@A@@BA@@B@@@B@B@@@B@@@B@@@@ABA@@BA@AB@B@@AB@@@B@B@@@BB@@B@@BB@@@BBBB@@B@@B@@BB@@B@B@B@@A@@BA@@BA@@BA@@@AB@@AB@@@B@@@B@@@B@B@@A@@@@BA@@B@@ABB@@@@B@B@@@B@B@@@B@B@@@@@@B@B@@BB@@@BB@B@@@B@BB@@B@@A@@BA@@@@@@B@@@@@@@@B@@BB@@B@@AB@@@BA@@@@BA@AB@@@B@BA@@B@BAB@BA@@B@B@@@@@@B@B@@BB@B@@BB@@@B@@@DBB@@@B@@B@@B@BB@@BDBB@@B@@@@@B@@@F@@BB@@B@@B@B@@BB@B@@AB@B@@@BBB@B@B@BBD@@@B@@@B@@BB@@@B@@AD@@@D@B@B@B@B@B@B@@@BB@@B@B@B@@@B@@@B@@AB@B@@@BB@@BB@@B@B@B@@@BB@@@B@BAB@@@BAB@BAB@@@BB@@@@B@@BB@B@@@@A@@@A@@B@@A@@BA@@B@@@BA@@@@@@@@B@@@B@@@B@@@B@@@@AB@@@@A@@@@BA@@@A@@BA@A@@@@AA@@@AB@@CB@@A@@B@@@B@@A@@@@B@@@@@B@@@@AB@@@BAB@@BB@@@@@BB@@B@@B@@B@@A@@@BB@@B@@@B@BB@@B@@@B@@@@@B@@@@BB@@@@@BB@@B@@@B@@AB@@@@@BBB@@@B@B@@@@@B@B@B@B@@@B@B@@B@@A@@BA@@BAB@@@@@BB@BB@@@@BBA@@B@@@@@@@BB@B@@B@@@@@B@@A@@BA@A@AB@@A@A@@B@@@BB@@@BB@@@B@@@B@@@@BBB@@@@B@@A@@B@@A@@@@B@@@@@B@@@@BBB@@@@@B@@BAB@@@B@@A@@@AB@@@@@BA@@B@@@B@@@@B@@@B@B@@BB@B@BBB@BBB@@@B@@@BB@@B@B@@@B@@B@@@B@@B@BB@BB@@@@@B@BB@@@@BB@@@BBB@BB@BB@@BBB@@@@@A@@BA@@@@@A@A@@@A@@@@@AB@@A@@@@@CB@BA@@@@B@@B@@@B@@@BB@@@@@B@B@@@B@@B@B@@BB@@BB@@B@B@B@@@B@@@@BB@@@BB@@BB@B@@@@@B@@@@@@BA@@B@@B@@B@B@@AB@@@@AB@BB@@@@B@@@B@@ABABA@@B@@A@A@@@@@@@A@@@@BA@@BA@@@@@A@@@@@@B@@@@BB@@@@@BAB@@@@@@@B@@@B@@AB@@@B@@A@@@A@A@@BA@@@@BA@@@AB@@A@@BA@@@A@@@@@AB@@@@ABA@@@@B@@AB@@@@B@@B@@@@@@@B@B@@@BA@@@@B@@@@A@@BA@@@@@@@BB@@B@@@AB@@@B@@@BAB@B@@B@@@BB@@A@@B@@@@B@@B@@@@BA@@@@B@@@@@B@@@B@@@B@B@@@B@@@BB@@@@@B@@@B@@@@B@@@B@@@@@@B@@AB@B@B@B@B@@@@@@@BB@@@@B@@@BB@@@AB@@@@@BAB@@@B@@@BBB@BA@@BA@CBABABAB@B@@AB@B@@@@BBB@@@@@@B@@@@@@@B@@B@@B@@@@@@@B@@@BBB@@AB@B@@@@AB@BA@@@@B@@@@@@@B@@@BA@@B@BAB@@@@@B@B@@B@@BBA@@B@@BBB@@@@@@@B@@A@@@AB@@@B@@@B@@@@DB@@B@@BB@@@B@@@B@@@@AB@@@B@@@B@@@B@@@B@@@@AB@@@B@@@DA@@B@@@BAB@B@@@B@B@@@B@@@@@@@@BB@@@BB@@B@@@B@@@@BB@@B@BB@@BB@@B@BB@@B@BB@BB@@@BB@@B@@@B@B@D@B@@@BBB@@BB@@BB@B@@@B@@BB@@@@B@@BB@@@@@B@@@B@@@BA@@@BB@@@BB@@@D@@@BBB@B@BBB@BB@BB@@@BB@@B@B@@@@@BB@@@B@BB@@BBBBB@@@B@BBD@B@@@B@@AB@B@BAB@@@B@B@B@@@B@@@B@@@@@B@B@B@@@D@BAB@B@@A@@B@@@B@@@@@B@B@@BBBDBB@@@B@B@B@@@B@B@@@BA@@B@B@D@B@B@B@B@@@B@BAD@B@B@B@@@B@@@B@B@D@@BB@B@B@B@B@D@B@D@@@@@BBB@@B@@BB@@@B@@@B@B@@BB@@BB@@B@BBB@@@B@@@@@BB@@@B@@@@@@B@@BB@B@B@@@@@B@B@B@B@@@@@B@@@B@@@@@B@@@B@B@@@B@@@@@BB@@@@B@B@@@@@B@@@BBB@@@@@B@@@@@B@@@@AB@@@B@@@B@@@@@B@@@B@B@@@B@B@@AB@@@BB@@B@@@@@BB@@@@@@B@@BB@@@B@@@BB@@@@B@B@@@DBB@@@@BBBBBBBBBB@B@@@BB@@@@A@@BB@@@BB@@B@@BB@@@B@@@D@B@@@BAB@@@B@@@@@@@@AB@@@B@@@B@@@B@B@@@B@@@B@@@B@@BB@@@B@@@B@@AB@@@@@B@BB@@B@@@B@@@B@@@B@BB@@@BB@@@BB@@BABA@@B@@A@@@@@A@@BABAB@D@@@B@D@B@D@@@@A@@@AAAA@@ABA@CBA@@AAA@AA@A@@@A@@@A@@BA@@@@B@@@BAB@@@B@@@B@@A@@@@@A@AB@@A@@@@@@@@@A@@@@@AA@@@@A@@@@@A@@@@@@@A@@@@AA@@@@@A@@@@BA@@@AB@@@B@@@@A@@B@@@@@BA@@B@@@@@B@B@B@@@B@@BB@BBB@B@@B@BB@@@@@B@@A@@B@@@@@BA@@@@@AB@@@@@@A@@@A@@@@@@@A@@@@@@A@@@@@@AB@@@@@@@@@@@BA@@@@@@@@@AA@@@@@@@@@@AB@@@BA@@@@@A@@@@B@@AB@@@@@@@@@@A@@@@@AB@@@@@@@B@@@@AB@@@@@@@@@@A@@AA@@@A@@@A@@@AB@@@@@BAB@B@DAB@B@@@B@B@@AB@D@@@B@@@@@BA@@@@@A@@@@@A@@@A@@@@@AB@@@BAB@@@@@B@@@@@@@B@BB@@B@@@@@B@@@@@B@B@B@B@@@BBB@B@@@@@B@@@@@@A@@@@BA@@B@B@@A@@B@@A@@@@@A@@@A@@@@@A@A@@@A@A@AB@@AB@@@B@@@B@@@B@@@B@@@BBB@@@B@@@B@@@B@@@@@B@@@@@B@BBB@@BB@@@@B@@@@@@AB@@@B@@B@@B@BB@@B@@@@B@@@@@B@@@B@@@@BB@@@@@BB@@B@B@@@BB@@@@@B@@@B@B@B@@@B@BB@@B@BB@@@@B@@@@@@B@@B@@B@B@DB@@B@@@@@B@B@@@@@@@BB@@BB@@@@B@B@FB@@B@@@@@BBB@@BB@B@B@B@BB@@@@B@@B@B@@@BB@@B@@B@@@D@@@B@@@@@@@@BB@@@@@BBB@@AB@B@@B@@B@@B@@@B@@B@@@B@BB@@B@@BB@BBBB@@@BB@@@BAB@D@B@@@B@@B@@@@@D@@@@B@@BB@B@BBB@B@BBD@BB@@@@@@B@BAB@B@DAB@FAB@@@@@@@@@@AAA@@@ABABA@ABA@A@@BA@@BAB@DABA@@BA@@BAB@B@@@D@@AB@B@@AD@B@B@D@B@BAB@@@BA@@BA@@BA@A@@@A@@@AAAA@@A@A@@@AB@@@@@D@BAB@B@FADADAD@DAB@BA@@@@B@@@@A@@@A@@@AB@@CB@@ABA@@@A@@@@@AB@BAB@@@B@B@F@D@D@@@DA@@@@@@BA@C@C@@@A@@BC@@BABA@AA@AA@@AA@@@@@@@ABAB@@@D@@A@@@@BA@@B@@AD@@@B@@AB@DCBA@@BA@A@@@@AA@@BA@@@@BA@A@A@@BABCB@BA@@@@@@BA@ADABABA@@@@@AAAAA@@AAA@@A@@@AB@@AB@BABA@@@A@@@@BAB@B@@@B@@A@@@A@@@A@A@@@A@@BA@@@A@A@@@@BA@@B@@@B@@@@@BA@@B@@AB@@@DA@AB@B@@@BA@@B@@@BAB@B@@@@AB@@AB@@A@AB@@A@@@@B@@@@@B@DBBBB@B@@B@@B@BBDBBB@@FBBBF@B@BAD@@@@@BA@@DABA@A@@BCBABG@@@AB@@@B@@@@@@@@@B@@A@@@@@A@@@@@@AA@@A@@@@@@AA@@@BA@A@@@AB@@AB@@A@@BA@@@@@@@A@@@@@A@@@@@@B@@@BA@@@AB@@@BA@@BA@@BAB@B@@@BA@@B@@@@@B@@@B@B@D@@BB@@@@@B@@@B@B@@@B@BB@@B@@@BB@@B@@@B@B@B@B@B@B@@@B@B@@@@@D@B@B@@@B@B@BBD@B@B@@BB@@@BBB@BBB@B@@@B@@@BB@@B@FBB@B@B@B@@@BB@@@@@@@B@@@@@@AB@@@BA@AB@@@B@@@@@@@B@BBB@@@B@B@@@BB@@B@@@B@@@B@@BB@@@@@B@@BB@@B@BBB@BBB@BBB@DB@@@@@@BB@@B@B@BB@@B@@B@@B@@BB@@@@@B@@@BBB@@@B@DB@@D@@@B@B@@@@@@@@@BBB@@@B@@BB@@@@@B@BA@@@@B@@@@@D@D@B@B@B@B@B@BB@@B@BA@@B@@@D@B@@A@@B@@@B@@AB@B@@@@@BA@E@@BA@AB@@@DAB@B@DCB@@AD@@@FCBAB@FBD@B@B@B@DBBBB@B@BAD@BAB@DBD@B@@@DB@@BBB@F@FCBAB@B@BAB@B@DBB@B@BA@@@@A@CCCAA@QA@@AA@A@@BC@ADA@A@ADA@A@@@A@EBABAB@@ADAB@DABADC@@B@BABAB@BAB@@@@@B@@@BA@@B@BAB@BAB@@@BABA@@B@@@DABABABAB@BCDABABA@@B@BA@@B@@AB@B@@AB@@@B@@A@@@@BA@ABA@@@@@AB@@@B@@@BAB@@@B@@@@@BABAB@@A@@@A@@@A@@@AA@@@AEAEAA@CBEBCAO@ABAB@AEBABCBADE@C@ABAAA@AAADC@A@@HCLEDAF@DAF@D@@@BC@G@ABCBCBCD@B@@@BA@@@@B@@@B@@AB@BAB@@@@AB@@@@@BA@ABCDGBA@AB@@@AA@@@A@AA@@A@@A@@@@A@@@A@@@@@A@@@A@@@@BA@AA@@@@A@@@A@@@A@@@A@@BA@@B@@AB@B@@A@@@@BABA@@B@@AA@@@AA@A@@@@@AA@@@@AA@A@@A@@@A@@AA@@@A@A@@BA@@@A@@@AB@@@BA@@B@@AB@@@@AB@@@@AB@@@B@@@BB@@B@@@B@B@@@@@B@@BB@B@BBB@B@@@D@@@B@B@@BB@B@@@@B@@B@@B@@B@@@B@@@B@@@@@BA@@B@@AB@@AB@@@B@@BBA@@B@@@B@B@BA@AB@B@@AB@B@@AB@@A@@@@@A@@BA@@@@@@@@B@@@B@@@B@@AB@B@@@B@@@@@B@@A@@@A@@@A@A@@@C@@@AA@@@@A@@AABA@@@AB@@@BAB@@@BAB@@AB@@@@AB@B@@@@AB@@AA@@@@A@@@@@A@@AA@@AAA@@AAA@@A@AA@A@@AABA@@BA@AB@@AB@@AB@@@BA@@B@B@@@BA@@B@B@@@BAB@B@B@B@BB@@B@B@B@B@@@BB@@B@@@BA@@B@@A@@B@@@B@BA@@B@@@B@@AB@B@@@BBB@@BD@@BD@@BB@@B@@BB@@B@@BB@@@B@B@B@@@B@B@B@B@@@B@@@@@@AB@@A@@A@@A@@@@@@@A@@@@BA@@BA@@@@@A@@@@@A@@@A@@@A@@@@@@B@@@@@BA@@BA@@@AB@@A@@@A@@AA@@@A@A@@@AAA@A@A@@@A@@@ABA@@@A@@@A@A@@@@@@B@@@@AB@@@B@@@BA@@@@@@@A@@B@@@@@@AB@A@@A@@@@@A@@@@B@@AB@@@B@@AB@@@B@BA@@B@@@BA@@B@@@B@B@B@@@B@@@BA@@B@BAB@@A@@B@@@BA@@@A@@@@@A@@@C@@@@@A@@BA@@@@@AA@@@AAA@@@@@BA@@@A@@@A@@@A@@@AA@A@@AA@@@@A@@BAAA@@@@@@BA@@B@@A@ABA@@@@@A@AA@@A@A@A@@AA@AB@@A@@BA@@BA@@B@@ABA@@BA@A@@B@@AB@BA@@BA@@B@@@@A@@@@@AB@BAB@BBBBBBB@@BB@BB@@B@@@B@@A@A@ABA@@@A@A@@BA@A@A@@@@BA@@@A@@@A@@@AB@@A@ABC@A@ABA@@@A@ABA@@@A@@@A@AB@@A@A@ABA@@@A@A@@@@BC@ABA@C@AB@@C@A@@@ABEBCDMBE@ABGBEDIBGDI@CBGDKBGDG@CBCBE@CBEBEBCBE@ABC@CBA@CBC@ABE@@G@EBG@E@EBC@C@G@A@E@I@CBGBGBE@EBC@C@E@C@C@GAA@C@C@G@A@C@C@E@G@K@K@E@E@C@C@CBCBC@ABA@E@CBA@CBCBAB@@ABAB@B@B@B@BADABA@CAA@AAACA@@AAEACAAAAAAA@A@@AA@A@A@AAA@A@AAA@@@AAA@A@A@A@A@A@@@A@A@ABA@C@A@A@A@@@ABA@A@A@@@A@A@@@@@A@A@AAA@@@A@A@@@A@A@AA@@A@A@AAA@@AA@AA@@ECAA@@@@AAA@AAA@A@AAA@A@AAA@@@A@A@@@A@A@A@A@CAA@AAC@A@AAA@@@A@@@@@A@ABCB@@A@ABA@CBA@AB@@A@@@ABCBCB@@ABCB@@CBA@ABABA@@BABABABAB@@ABABA@ABA@@BA@A@A@A@ABC@A@ABA@ABA@CB@@@@ABABEBA@CB@@A@ABA@AB@@AB@@AB@@@@@@@BBB@@@B@B@B@BB@AD@@@BAB@@@@AB@@CBCBABCB@@CB@BCBA@@BA@@BA@@@@B@@@@B@BBBBB@@B@@@B@@@@ABA@AB@BA@@@@B@@A@BB@@@@BBBDB@@@@B@@@B@B@@@B@BBB@B@B@BB@@B@B@B@@BB@B@@BB@BBB@@@B@@BB@@@B@B@BAB@B@@@BCB@@ABA@A@A@A@CBA@A@@B@B@B@B@B@@@B@@BBBDBBDDBB@@BDBDBB@@BBBB@@BB@B@@BBBBDBBBBB@@@BBB@B@B@@@@A@@@@@CBAB@BAB@B@D@@AB@@A@@@AB@@@@ABAD@@@B@@@@@@DFBBBDBDBBBBBBABCDAB@@A@AB@@A@@@A@A@A@@@A@A@@@AA@@AAAACC@@@AA@@@AAA@@@A@AAA@A@A@AAA@A@AA@@A@A@A@AAA@C@A@CAE@@AC@A@C@A@EAA@A@A@@AA@A@AAAAAA@CACAC@A@A@C@ABC@AAC@AAACACA@@A@E@E@A@AAC@AACAC@GAE@C@A@C@AAC@CBC@C@C@@BC@CBC@C@A@AB@AA@A@@@A@A@@@AB@BAB@BABA@ABCBC@ABA@A@ABC@C@ABC@CA@@A@IBCAE@C@CAC@AAA@AAA@@@AA@AAA@@@@@A@@A@AACAAACAAAEC@AAA@@@A@@@A@@@A@A@@@C@A@A@@BABE@A@@@CB@@A@@@CAA@A@@@AA@AA@AAAAAAAACCACAEAECCAC@CAA@C@A@A@A@A@@@A@ABC@A@CBC@A@@@AAA@E@C@ABA@C@CBABA@ABCBCBCBCBA@AB@@@@@@A@@@@@A@@@@@@A@@@A@@AA@@@@AA@AAA@CA@@@AAAA@AAAA@AA@@@A@A@@@A@@@AB@@ABABA@AB@@A@@@C@ACA@AA@AA@AA@@A@A@@AA@A@A@@@A@A@A@A@@BA@@BA@A@A@@@AAACCCAAAAA@AAA@@AA@A@AAA@CAC@@@A@@@AA@@A@@@@AA@@@AA@@@AA@@@@AAA@AA@@AAAAA@AAA@@@A@@AA@@@A@@A@@@A@@@A@@@AAA@@A@AAAAAAAA@A@AA@@A@AAA@A@A@A@A@@AA@A@@@AAA@A@A@@@A@A@A@A@@@AA@@A@@@A@@@@@AAA@A@A@A@AAA@ABA@A@@@@BA@@@AB@@A@@@@BA@@@A@A@@@A@A@A@@@AA@@@@A@@AA@@AAA@AAC@C@AAA@@@AAACAA@@@@@A@@@C@A@A@@AA@E@A@AA@@@@@@@A@A@@@AA@@@@@@@@A@@A@@@@@@A@@A@@@@@@@A@@@@@@@A@A@@@A@@@@@@A@A@A@C@@AA@@@AAAC@@AAA@@AA@@@@@@@@A@@@@BA@@@AB@@A@@B@@AB@@@BABAB@@@@A@@@A@@@@@A@@BA@@BA@@B@@A@@@@@@@@A@@@A@AAAAA@A@AAA@AB@@@@A@@@AA@@A@@AA@@@A@AA@@A@A@A@@@@@A@@A@@@AAA@@@@@A@A@A@@@A@@@@@A@A@@@A@AAA@@AACA@@A@AA@@A@A@@@A@A@AAA@@@A@ABA@C@@@ABA@@@AB@@@B@@@BA@@@@@@B@@@@A@@@@@@AA@@@@A@@@A@A@A@@@A@A@@@@AA@AA@AA@A@@AA@@@A@A@@AA@A@@@@@@@AB@@AB@@@@@@@@@@A@@A@@AA@@AA@@AA@AA@@AAA@AAC@@@AAA@A@A@AA@@@@@A@@@A@@@@BA@@B@@A@@@@@ABA@@@A@@BA@A@@BABA@@@@@@@@AA@@@AA@@@@A@@@A@@@A@@@A@A@@@A@@AA@AA@@@@A@@AA@@BA@A@@@A@@@ABA@A@@@A@@@A@@@AAA@AAA@@@A@A@@@A@@B@@A@@@@B@@@B@@@B@B@B@@@B@B@BA@@@@B@@@@A@@BA@@@A@A@@@@@@B@@@@A@@@@@A@@@A@A@@@A@@@A@@@A@@@@AA@@@A@@AA@A@A@A@A@@@ABA@@@A@A@@@@@@@A@@@@B@B@DAFADABA@@B@@@BA@@BA@@@A@@BA@A@C@AA@@A@@@AAA@@AA@@AAA@@@A@A@@@AA@@A@A@AA@@AAA@AA@AA@AAA@AAAA@@AA@AAA@@@AAA@A@A@@@AA@@AAA@AAA@A@C@A@A@C@A@@@A@A@ABC@A@@@A@@@A@ABA@A@@@A@@@@@@AA@@@A@@@A@@@A@@@A@@@@A@@AA@A@A@A@A@@@@AA@@@@AB@@A@@@A@@@A@C@@@A@@BA@@@ABA@@@A@A@A@@@AB@@AB@BA@@B@DAB@@@B@D@B@B@D@@@B@D@B@@@@@B@@@@A@@@@BA@A@@@AB@BA@@BA@@BA@@@A@@AA@@@@@@AA@@B@@A@@@@@A@@@A@@A@@A@@@A@@B@@AB@B@@A@@@@@@AA@@@A@@@@@A@@B@B@@@@@@AA@B@@A@A@@@@@A@@AA@A@@@A@@@AA@@AA@@@A@@AAA@@@@BA@@@A@@@@BA@A@AB@@A@@@ABA@ABA@A@A@@A@AAAACAAA@@@A@@@A@@@ADABA@A@@B@BA@@@@@@@A@@@A@@@A@@@@BA@A@A@@BA@@@@@A@A@A@AB@@ABA@AB@B@B@B@BAB@DA@A@@@A@CA@@@@A@A@@AA@@@AAACC@@AAA@A@@@AAAA@@@A@A@@@@@@BA@A@@@@@@@A@@@AAAAAAC@@AA@@@A@@AA@@@ABC@@@A@@A@@CAA@CAA@@AA@A@@A@@@A@C@E@C@A@C@C@C@C@A@A@@@@@@@AA@@AA@A@AA@@@AAA@AACA@@CACAAAA@AAAAAAAAA@A@@@@AAA@@AA@EBCAAAEAAAA@AAA@@@A@@@ABA@A@CBAAA@C@AAC@@@A@A@ABADA@@BA@@B@@@@A@@@@A@@@@@@@AA@@AA@@CCACAA@AAAAA@AAA@@AAA@C@A@@C@@@@AA@@@A@@AAA@@@A@A@@BA@A@@@A@@@E@C@C@C@A@@A@AACAAECACCACACC@@CAAAAAA@@@A@@A@@@@@A@@BA@A@@@@@A@@AAA@A@AAAAAA@A@@A@@@@BA@@@CAEAAAC@A@AA@@@@AA@A@AA@@A@@AAB@@A@A@CBE@EAA@A@@CEAA@AAAAA@A@@A@@AAC@AAA@@AA@@AA@@@AA@@@@AA@@AA@AA@@AAA@@@AAA@A@AA@@A@@A@A@@AAAACE@AAAAAAA@AAA@ACAECAACAA@@ACA@@@@AA@@A@C@C@A@A@AB@@@B@@AB@@CA@@A@@BC@A@@A@@AA@@@@AC@AA@@A@BC@ABC@A@@B@@A@@@@@AB@B@@C@@@CB@BA@@@A@ABA@@@A@@@A@A@@@A@A@@@@@@@@@@A@@@@@@@@@A@@@@@@@AA@@@@A@@@@@@@AA@@@@@@@A@@@C@@@A@@@@@@@@@@@A@@A@@AA@@@@A@@A@@@@@@@@@A@@A@@@@@@@A@AB@@@@@@A@@@@AA@@@@@A@@@@@@@A@@@@@@A@@@@AA@@AA@@@@A@@@A@@@CBA@A@@@A@@@A@@A@@@@A@@@@@@A@@AA@@@A@AA@@@@A@@@@@@@A@@A@@A@@@AAAA@@A@@@@@@@@AA@A@@AA@@AA@@A@@@@@@A@@A@@A@@@@AA@@@A@@@@@A@@@A@@@@@ABA@@@ABA@@@@@@@@@@@A@@@@AA@@AA@@@AA@@A@A@@@A@A@A@A@@@@@A@A@A@@@@@@@@BAG@EACA@@AAA@AAAAAAACA@AC@@A@AA@@@@AAA@@AC@ACA@CAAA@@@@@A@AA@@@@@@@@@@@@@A@@@"],encodeOffsets:[[133406,45040]]}},{type:"Feature",id:"231086",properties:{name:"东宁市",cp:[131.122915,44.087585],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@BA@A@A@@@ACC@AAA@C@@@A@@@AB@BA@@@C@A@@@AA@AA@A@A@@ACA@@C@C@A@C@AAAAAA@AAEC@A@@@A@A@AB@BADAB@@A@@@@BAAAAA@ACECA@@A@ABC@CAA@AAA@@@AC@@AA@A@@@A@A@@@@@@A@C@@@AB@@@A@@A@@A@@AA@@@A@A@AB@@C@A@A@A@@@@BA@@@A@@AA@A@@@A@AA@@AAA@@A@@AA@C@C@A@A@ABC@C@C@A@AA@@@@@A@@AA@@@AA@AAAA@@@A@A@@BA@ABAB@B@@@@A@A@@@A@A@@@@AA@@AAA@AA@@AA@@A@@@AAA@@@A@A@CA@@A@CAC@AACAA@AA@@@@@C@ABABC@@DA@ABA@AB@@A@@@ABA@A@A@@B@@@@A@@B@@@BAB@BAB@@AD@B@BA@AD@BADAB@@@B@D@B@D@@AD@B@BAD@B@BADAD@DABAD@B@B@B@@@B@@@B@BABCBADABABA@@@@B@@AA@@AAA@A@@@A@@A@CAC@CAA@CAECA@AAA@@AA@AAA@@AAAC@E@A@AA@@@@AAAAAAA@@@@AA@@A@@AA@@@AAA@A@@@ABA@A@A@A@@@AAAA@@A@A@@@A@A@@@ABAA@@A@@B@@A@@D@@@B@BAB@JAB@DBD@H@B@B@@ABA@A@@B@DAD@D@F@DBD@FBB@BA@@BABABABADA@@B@F@B@DBBA@@HADADA@AB@BA@@BE@@BAB@BAB@@@@@B@BDBB@@BDB@B@FDF@BBD@HD@@B@B@TGB@@@B@@@@B@@@@BB@@BBB@B@@@B@@@@@@@BA@@B@@AB@@AB@B@@@@@B@@@BB@@@@B@@@@BBAB@B@B@B@@@@@B@@@@@B@@@@@B@@@B@@@B@@@@@B@@@B@B@B@@@B@BB@@B@B@@@B@@@B@@@B@B@@@B@@@BBB@B@B@@@B@@@B@B@B@@@B@@AB@@@@AB@@@B@B@@@B@@@BA@@B@@@BA@@BA@@@@H@JBB@P@P@H@F@H@H@L@F@H@D@DBFBD@F@B@B@BBFB@@DBBAF@FAB@BBB@B@B@D@@@BBB@@B@@B@B@@@BB@@DBB@@@@BB@@A@@@A@AA@@A@@BA@@@@@@BA@@B@@@BA@@B@BA@@B@B@D@BAB@B@@@B@@@@@B@BA@@@AB@@B@@B@BBBB@@@@BB@@B@BAB@@@B@@ABA@ABE@ABC@ABC@ABC@ABE@A@ABC@ABC@ABA@C@ABC@CBC@ABCBE@A@@BC@ABCBEBIBC@CBA@C@ABA@CBA@CBEBC@CBA@ABC@@@ABE@ABCBE@CBC@ABC@A@ABA@A@ABA@CBCBC@A@CBA@ABE@@@CBA@ABC@EBA@EBC@A@ABA@A@@@ABA@@@A@ABA@A@A@@BA@A@A@@@AB@@A@A@A@ABA@ABA@A@A@ABABE@A@A@ABA@A@AB@@A@A@ABC@@@ABA@A@CB@@A@CBA@C@AB@@A@A@@BA@CBC@A@CBA@A@ABA@A@ABA@A@@@A@AB@@A@ABA@A@@@A@AB@@A@@@A@@@A@@@ABA@A@ABA@@@A@A@@BA@A@@@A@@@A@@BA@@@A@A@AB@@A@@@A@@@AB@@A@@@A@ABA@@@A@@@AB@@A@@@A@@@A@@BA@@@A@@@A@@BA@@@A@@@A@@@AB@@A@@@A@@@ABA@@@A@A@ABA@@@A@ABAA@@@CAA@A@A@ABA@A@ABA@CAA@A@@AA@AA@@AAAAA@A@AAA@@A@@@A@ACA@@A@ECA@AA@AAAC@C@C@A@A@@@ABA@@@ABC@A@AAA@AA@A@@@A@@@A@A@AACAAAAAA@A@@@A@C@@AA@AA@A@C@A@AAA@@AAABADCBABA@A@CBA@E@A@C@@@A@A@@@CBA@A@@@AA@ACAAA@@AA@A@@BABA@A@AAAAAAA@AA@@@AB@B@BA@@@@@AA@@AA@@@@AB@BABADA@AB@@A@AAA@@A@@AA@AA@A@@A@CAAA@@AA@A@A@@BA@@DABABA@AB@@@@A@AAA@AAA@A@A@AB@BAB@@@BA@@B@@@B@BB@@DA@@BABCBAB@B@BAB@@BB@@@B@@ABA@CBCBAB@@A@A@@BA@@A@@AA@@AA@AAAA@A@@@ABA@A@@AA@@@A@A@@@A@A@@BA@@@@@AA@@@ABA@@A@@@A@AB@@A@A@@AAAA@ABA@@@@B@@@@A@@AAA@A@AA@@A@@A@@@AB@B@@@@A@A@@@AA@AA@@A@@A@@@ABAB@@A@A@@@AA@A@A@@AAAB@@@B@@A@@@A@@A@@AAA@@@A@@@AAAA@A@A@AAAA@A@@@@CA@@CACAAAA@@AB@@A@AAA@AA@A@A@AA@AAA@@@AB@@A@@@AA@@ACC@A@@BA@ABAA@@AACA@@A@ABAB@B@B@BA@A@@@AAAA@@@@AAC@A@AAAAA@A@A@A@@AAA@A@A@AAA@AA@@@A@A@AAAA@ACCAAAA@AAA@@A@CAAAC@A@CA@AAA@@AAA@A@@BAB@D@FBD@@@B@@A@AA@@A@A@ABA@A@A@@@A@AB@BA@A@CBC@A@C@ACAAAAAA@C@A@AAA@A@@@@A@A@ABABAD@BABABA@A@A@AAAA@CAEAC@@AA@BABC@CB@D@B@DAB@DA@@B@AA@@A@CAA@@ACA@A@ABA@@D@D@DAD@BA@ABA@A@A@@AAA@GAA@AAA@AA@@@A@AB@BAD@B@BBB@DBBBD@B@B@BA@@AA@AAA@@A@EAA@A@@@@A@@@A@@B@@@F@@@B@@@BA@AAC@ABA@CBA@ABA@ABA@@@A@A@@AA@@A@A@A@CACCCCA@A@AAA@A@@@AC@AAA@C@ABA@AB@BABAB@FADADABA@A@A@AAAAAAAA@A@ABA@CAC@@@AA@AB@BCD@BADAB@BA@@@A@AAAA@A@AAA@@@CAAAAA@A@@@ADA@@@ABA@A@@AA@A@A@AB@BA@@BAAA@@AA@@@A@ABABCBAFAD@BAB@DAB@BAB@BABADEBC@A@@@@@@@A@@@A@AAA@AC@@@A@A@A@@A@@AA@@BE@A@EBA@ABAB@@@@AAA@@AAAAAA@A@A@@@A@A@@BA@@B@@AA@AA@@@A@@@ABAB@@A@@@AA@AAA@A@C@AACAC@A@A@A@@A@A@A@AAA@@AA@ABA@ABA@AB@@A@CAA@AA@ACAAAA@ABABAB@B@DA@@DABAD@HAB@B@DAD@F@BAF@BAB@BA@@AA@AA@A@AAC@AAAACA@@@A@GAAAC@AA@B@@ABABA@@@A@@AAA@A@AB@@ABAB@BEFEBABCBC@E@A@AA@@@A@ABA@@BA@@BAB@BAB@BA@@@AAAAAA@AAA@EBABC@ABA@A@A@A@AAA@@A@ABABAFABABA@A@I@A@EBA@CAA@@ACA@A@A@CBABA@C@EBCAA@A@AA@@@A@@BAFADCHABABABABA@A@CAA@A@@@A@A@@@A@@AA@@A@@AA@BA@AA@@A@A@@ACACAA@A@@@@@A@@B@B@DBB@B@B@BAB@@@@AA@AA@@A@@@A@@A@@BA@@B@B@@BDBB@@@B@B@@@B@@@@A@@@@AA@A@A@@@AB@@AB@B@B@B@@@B@B@@@BA@A@@B@@@AA@@@@BABAB@@AB@B@@A@@B@B@@ALBB@B@BABAB@@A@@@A@@@AB@DA@@B@@@B@DBB@@@B@@AB@B@@A@@B@BAB@@@DE@A@A@@B@B@BAB@BAB@B@@AB@@@@B@@B@@@B@BA@@BAB@@A@ABABAB@@@B@@@@AB@@@B@@@B@@@B@B@B@BBD@DBB@B@BBB@DBB@DBDBF@DBD@B@BBB@@@B@BA@@@ACA@@AAA@@@AA@AA@@A@@AA@A@@@@@@@@@@@ADA@@FEB@FE@@@@@@@E@E@@@A@@@A@@@@C@MAA@G@A@@@@@@@A@@AA@AA@@@@@@@@@@@@@AB@B@DAB@@@B@@AB@@A@@A@@A@AA@@AA@A@C@C@AAA@A@@@A@A@A@C@AB@@A@C@C@AAC@A@C@ABA@@@A@A@ABCB@@A@@@A@@@AB@@@@@@A@@B@@ABAB@@@B@@A@@@@@A@A@A@A@@@ABA@A@A@@BA@A@@@@@AAA@A@CAC@A@A@@@A@@A@@@@@@@A@@@A@@@A@@AA@@@@@@@@@@@A@@@@BCB@@ABA@@@@@A@@@@@@AAAA@AA@AA@AA@AA@AA@AAA@A@A@C@@@A@@@C@@@A@@@A@@@CA@@A@AA@@A@A@E@@@A@A@AAA@A@A@@@@@@@@@A@@A@@@@@@@A@@@@@ABA@@@AAA@CAA@@AA@@@@@A@AAA@@@@@@A@@@@@@@A@@@@@A@@@@@A@@@A@@@@@@@A@@A@@@@@@@@A@@@@@@@AA@@@@@@@@A@@A@@@@@@A@@B@@@@AB@@ABABA@ABA@CBCB@@@@A@C@A@AAA@@@@@AA@@@@@@AA@AA@@A@@@@AA@@A@AAAAA@AA@A@@CAAAAAA@AA@@AA@@A@@@@@@A@@A@@@@@@@A@A@@@@@A@@@@@@@@@@@AA@@@@@A@@A@@@@@@@@@A@@@@@@@ABA@CBA@@@@@@@A@@@@@@@AAA@AA@@@@@@@A@@@A@@A@@@@@@@@@A@A@A@A@@@@B@@@@A@@@ABA@@@@@A@@@@@@@ABIFCBABAD@B@BBD@DAB@@CB@BAB@B@BA@@@GDEBA@A@A@C@A@@@A@CBEBKF@@CB@BC@ABEDEDKLCDEDAD@BCDCFAFA@CBABC@CBC@AB@@MFGDADEFEDCD@BC@ABA@OLA@@@A@@@A@C@C@A@@@@@CA@@A@ABC@A@CAC@@@A@CBC@CA@@A@AA@AA@@@BA@@@@@@@@@C@@A@@@@@@@AACAAAA@AAA@AAAAA@AAAA@@AAA@CAA@CAC@AAA@A@A@AA@@A@@AA@@@AAA@A@@@AAA@A@A@A@A@A@AA@@A@@@AA@AAA@@AA@@@@@A@@@@@A@@@@@@AA@@@A@@@A@@@A@@@@A@@BA@@BA@A@A@A@@@A@@@AAC@@@@@A@@@@A@@@@A@@@@A@@@A@@A@@@@@@@@@A@@@@@@@AA@@@@A@@@@@@A@@@@A@@A@@@@@@@AA@@@@@@@@@@A@@@@@@A@@A@@@@@@A@@A@@A@@@AA@@@@A@@A@@A@@@@@AA@@@@A@@@@A@@A@@@@@@AA@@A@@@@@@@A@@@@@A@@@@@@@@@@@A@@@@A@@@@@@AA@@@@@@@AA@@@@@@A@@@@@A@@@A@@@@@@AA@@@@@@@@@@@@A@@@@@@@@AA@@@@@@@@@A@@AA@@@@@@@@@@AA@@@@@@@@AA@@@@@@@@@AA@@@@@@@@@@@@AA@@@@@@@@@@A@@@@A@@@@A@@A@@A@@A@@@@@@A@@@@@@AA@@@@@@@A@@@A@@A@@A@@@@@@@A@@@@@@AA@@@A@@AA@@@@@@@@@A@@@@A@@A@@@@ABC@AB@@C@CAA@CAA@C@C@@@E@A@EBC@E@ABYBABC@C@@@A@@BA@@@@@AB@@ABAB@BA@@BAB@BA@@BABAB@@AB@@A@@BCBAB@@C@ABA@CB@@A@@B@@@@BB@@@B@B@B@B@BAB@B@@@BAB@BA@@@@B@@A@@B@B@BAB@DAB@@CDABAB@@AB@B@@@B@BAB@B@BAB@@@B@BA@@BABCD@@AB@@B@@B@@@@@B@@@B@B@B@B@BAD@B@@@@AB@@@@A@@@A@A@@@C@@@A@@@@@ABA@@@CB@@@@A@@@A@C@@@A@@@A@ABA@@@ABA@AB@@ABA@ABAB@BA@@@@BAB@@@@@@@BA@@B@@@@@B@@BB@@@@A@@B@@@@AB@@A@@BA@@B@@@BA@@B@@@@@@@B@BB@@@@@@B@@@@@B@@@@@@@@@@A@A@@@A@A@@@AAC@C@CACAA@AA@@A@ACAA@@@@@@@@@B@@@@@B@B@@@@AB@B@BAB@@@@ABA@@BA@@@CB@@@@A@@B@@@@BB@@@@@@AB@@@@A@@@A@@@A@ABA@@@@@A@@@AB@B@@@BA@@B@@@@@B@@@D@B@@@@@@@@A@@@@@C@C@A@C@C@A@A@@@ABA@A@CBABA@@@C@A@A@@AA@@@@B@@@B@@AB@@AB@BAB@B@@@B@B@B@@ABABADAB@@AB@@@@A@EBC@C@A@C@@@E@C@A@C@@@A@CAA@A@A@@@A@@@@@A@AB@@@B@@@@BDBD@@@BA@@@@@@@A@@@@BA@@@A@A@C@C@ABA@A@AB@@A@@@AAA@A@A@A@A@C@@@@@@AA@@A@AA@@@A@@@A@@@A@@@A@A@CAA@@@@@@@@@@@@B@@BB@@@B@BAD@BAD@D@B@B@B@@A@@BA@@B@@AB@@@B@B@@@@A@@@A@@@CA@AA@@@AA@@A@A@A@A@A@@@AB@@@@A@@@@BA@A@@BA@@@A@@B@@ABAB@@@B@@@@@@@@A@@AA@AA@@AA@@A@@@@@@@A@AB@@A@@@A@A@AAA@A@@@A@AAA@A@A@@@A@@@@@ABA@@BA@@@A@A@@@@@@@@B@@@B@@AB@@AB@@@B@@@@BB@@@@BB@@@B@@BD@B@B@@@BB@BB@@@BBB@B@@@B@@@@@B@B@@@@BBB@@B@@@@@BA@@@@BBD@@@@@BB@@B@@B@@@@@B@@@B@@@@B@@@B@@A@@@CB@@@B@@@@@@@BA@@@AB@@@@@B@@@B@@@@@B@@BBA@@@A@@AA@AA@@ABAA@@@@@BBB@@@@@@@@@@@B@@A@AB@@A@@AAA@@@@@@@B@@@@@@@@A@@@ABC@A@@@@A@@@@@A@@@@A@@@@@@@A@A@CA@@@@A@C@@@@@@AA@@@A@A@@@@@@@@@@@@@@@AB@@@@@A@@@@@@A@@BA@@@A@@@@@@B@@@@A@@@C@CAA@@@A@@A@AAC@@A@@A@@@@@@@@AA@@@@@@@@@@@@A@@@@@@@A@@C@@A@AA@@@@@B@@AB@@AA@BA@AA@@@@@B@@B@@B@@@@CB@@@BAAA@@@@@@B@B@@@B@@A@@@@@A@@B@@A@@@A@@@A@A@A@@@@@A@@@@BA@@@@@@@@@BB@@@@@@@@ABAB@@@@@@@B@@@@ABA@@@@@A@A@A@AAA@@@@BAB@@@BA@@@@@A@@@@@@@ABA@@@@@A@@@@BA@@@A@@@@@@@@A@@@@A@@@@@@A@@A@@@@@@@A@@@@@EC@@@@@A@A@A@@@@AB@@@@@@@@A@B@@@@@A@@B@@A@@AAAA@A@A@A@@B@@A@@@@@AB@@@@@@@B@@@@A@@@@@@B@@@B@@A@A@CBA@@@@@@B@@C@ABA@A@CBA@AA@@ABAB@@A@@A@A@@@BC@@@@@@@@@@BA@@@A@@AA@@B@@@B@@CB@@A@@@@@@@@B@@A@A@A@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@@B@@@@@@@@A@@@C@@AA@@@AB@@CAA@@@@B@@BDB@@B@@@BA@@@AB@@A@A@@BABA@@@@@@B@@A@@BA@@@@BAB@@@@@D@@@@@@A@ABA@@@AB@B@@@@@D@@@@@@ABA@A@@@A@AA@@@@A@@B@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@B@@A@@@@@@@A@@@A@@BA@@@A@@AA@@@A@A@A@@@A@@AA@@AA@@A@@@@@@@@A@@@A@AAA@AAAAA@@@@@A@@@AA@@@@AC@@A@AA@@A@A@@@A@A@@@A@@@@@A@@@@@A@@BAB@B@@@B@@A@A@@BA@@@@B@@AA@@@@@@AC@A@A@@@@A@AAA@AA@@@@A@@@@BA@@B@BA@@@@@@@@@A@@@A@AA@@A@@@@@A@A@AB@@@@AB@BA@@@@@A@@@A@@@@@@@A@@@@@A@@@@@A@@A@@A@A@ABA@@@A@@B@@A@A@@@A@@@@@AB@@@B@BAB@B@BAB@@@BA@@@A@@@@B@@@B@B@B@B@@@@@@@@@@@@BB@@@@@B@BBB@@@@@B@@@@@@A@@@@BA@@@A@AB@@A@A@AB@@AB@@A@@@@@A@@@AB@@@@@@AD@B@B@@@B@@A@@@@B@@@@@BB@@B@B@@@B@@AB@@@@@@@B@@@@@B@B@@@@@B@@@@@BA@@@AB@@@B@B@@@B@B@BBB@@A@@B@@AB@@@B@@@@AB@B@@@B@@@BBBBB@BBBBBBB@@@@BB@@@@B@@@@BB@@B@@B@@@BB@@@@@@@B@@@@@@B@@BB@@@@@BB@@@@B@@B@@B@@@@BB@@@@B@@@@@@@B@@@@@B@@AB@@@@@@BB@@@@@BBB@@B@@BB@@@@@B@@B@@@@@@@B@@@@BB@@AB@@@@@@@B@@@@@@@@@@@B@@@B@@B@@@@BB@@@@B@@@B@@@B@@B@@@@@@@B@B@B@@@@@BB@@@@B@@@@@@@B@@B@B@@BB@@@BA@@@@@@B@@B@@@@@@B@@@B@@@@ABABABA@@B@@ADABAB@B@@A@@@ABAB@BA@ABA@A@A@A@@BA@ABA@@BA@@@@@AB@@@@A@@@A@@BA@AB@@A@@BA@@@@BA@@@A@@@ABA@@@AB@@@@@BA@@B@@@BA@@@AB@@AB@@AB@@@B@@AB@@A@ABA@@B@@A@@B@@@B@@A@@BAB@@AB@@BB@B@@@BB@@BBB@@BDB@@@@@@BA@@@@BAB@@AB@@@@BB@@@B@@@B@@@@@B@@AB@@@@@@BB@@@@@B@@@B@@@B@@A@@@@B@D@B@B@B@@AB@@B@@B@@@B@@AB@@BB@@@B@@@B@@@B@@@@BB@@@@@@@@@B@@AB@@ABA@AB@@@@@@@B@B@@@B@B@B@@@@BB@@@@@@@@@@ABA@@BA@ABA@@@A@@@@@@@@@@@@BA@@@@B@BAB@@A@@@A@A@@@@@A@@B@@@@@B@@@BA@@@@BA@@B@B@@@B@@A@@BABAB@@@B@@@@@@@B@BB@@@@B@@B@@B@@B@BB@@BB@@B@@@B@@@B@@@BB@@BB@@BB@@@@@@@@@@B@BAB@@@@@B@@@B@@B@@BB@@@BB@@@@@B@@@@@B@@@D@B@@@@@B@@@@@@@@B@B@B@BA@@B@@@BAB@BA@@@AB@@A@@@@@@BAB@@@@A@@BEBABA@ABABA@@B@@@@@@@B@@@@@@BB@@@B@@@B@@@B@B@BA@@@@B@B@@@BA@@@@BABCB@B@@@@A@@B@B@@@@@B@@@B@@A@@B@@@B@BB@@BBB@@@@BB@@@@BBB@@@BBB@B@@BB@@@@B@@@B@B@B@@@B@@@@@@@@BBDBD@DBB@@BB@@@@@@@@@@B@@@@AB@@@@@@@B@@AB@@@B@@A@@BA@A@@B@@A@C@EBCBA@@@A@@@@B@@@@@B@B@@@BBB@@@@@B@B@@@@ABADA@ADAB@@ABA@@@@B@@@@@@@@@B@@@@@B@@AB@@CB@BA@@@@BA@@B@@@@@@A@@@@@A@@B@@@@@@@@@B@@@B@@@B@B@@@@@@A@@B@@A@@@ABA@A@@@A@@@@@A@@@@A@@A@@@@@@@@BCBABABA@@@A@C@A@AB@@@@A@@@@@@B@@@@@@BB@@@@@BA@@@@@@@BBBBBB@B@@@@@@@@@BA@@@@@@@@@@@@B@@@@@@@@AB@@@@@@@@@B@@@@@@@BA@A@@@@B@@@@@@@@B@@@BB@@@@@B@@@@@B@B@@@@@B@@@@@@@@B@B@B@B@BAB@B@@@@@@@@B@@@B@@@@@@@@@@BB@@B@@@@@@@@@@B@@@@@B@@@@@@@@@@@B@B@@B@@@@@@BB@@@@B@@@@@BB@@@@@@@@@AB@@@B@@B@@B@@@@@@BB@@@@BB@@B@BB@@@@B@@@@@B@@@@@@B@@@@@B@@@B@@@@@@@BB@@BBB@@@@@@@B@@@@A@@BC@A@@@@@A@B@@@@@@@@@B@B@D@B@@BB@@@@@@@BB@@@@@B@@@@@@B@@BB@@@@@@@B@@B@B@@@@@B@@AB@BABA@@B@@A@@B@@@B@@@@@@@@B@@@@@B@@@@@@B@@@B@@@B@B@@B@@B@@@@@B@@@B@@@@@@@@B@@BB@B@@B@@B@@@A@@B@B@@@B@@@@@@@BBBBB@@@@@@@@AB@@@@A@@@@@A@@@@BA@AB@@AB@@@BA@@B@@@@@B@B@@@@@B@@@@@@A@ABA@@@@@BB@@@B@@BB@@@@@BBB@B@@@@@BB@@@@@BBBB@@B@@@B@@B@@@@A@@B@BAB@B@@@B@B@@@@BBBB@@BB@@@@AB@@@@@@@BB@@BBD@@@@BB@@@BB@D@@AB@@@DB@@BA@@@A@@BAB@B@D@D@B@@@BB@@@@DB@BB@DBBBFDDB@BBB@BBBBBBB@BDFBBBB@@@B@BB@@@BBB@B@BB@@B@BB@@BBB@@BB@@B@@B@@B@@BB@@BB@@BB@BBD@BB@@@@BBBBB@BBBDF@@@BBB@FAF@D@B@BA@BB@@@BB@@B@BBB@@@@BBB@D@BBFBDB@@B@@A@@B@@@@BBBBBBBB@B@BB@@@B@@@@@BAB@@@B@@@@@BB@@@B@BBBBDB@@DDDBDBBDFDBBBD@B@BB@D@D@D@F@@@B@@@B@B@@AB@B@@@B@BB@@@B@@BB@@@@@DB@D@B@BB@@BB@BBBBB@BBBBDDD@@BB@@BB@@@@@@B@@@@@@B@@A@@@AB@@CBAB@B@B@B@@BD@B@DBBAB@D@BAB@B@@@B@@BB@BBBBBBFBBAD@FBB@@BB@B@@B@B@BBBBBBBBB@BBDBDB@@DBBBB@BB@@B@@B@BBB@@BB@@@@@@B@B@D@D@D@D@B@D@F@D@B@@@B@@@@BBB@@BB@DBB@DB@@@@B@@AD@B@@BB@@@B@@BB@@BDBBBB@B@@@B@@@@@@@BAB@@@@@@@B@B@@BBBB@@B@B@BB@@DDBB@B@@BB@@@B@B@@B@@D@B@@@BCBA@ABA@A@A@A@@BAB@BA@@B@B@B@B@@@@AB@@@B@BAB@@@@@B@@@B@@@B@@@@@@ABA@@@@BABCB@B@@@B@@@B@@BBDBBBBBB@@@@B@BAB@BAB@B@@@BA@@B@BAB@@@@@B@@AB@@@@BB@BB@@@B@@BB@@B@@@B@@@BBB@@@B@@@@@B@BA@B@@B@@@@A@A@@@@B@@@@@B@@BB@@@@@@@BA@A@@BA@@@@B@@@BB@@@@B@@@B@@@@@BA@@@BB@@@@@@BB@@@B@@AB@@AB@@ABA@@B@@@BAB@@@@@B@@A@@@@@A@C@A@@@C@A@A@C@A@@@A@CBA@@@ABA@@BA@@B@@@B@B@B@@AB@B@@AB@@@B@@@D@B@@@B@@@BA@@B@@B@@B@@B@B@B@B@B@@BB@@@@@@B@@@B@@@B@@@B@@BB@@@@@@@B@@@BAB@B@B@@@B@@@BAD@B@B@B@@@B@D@B@B@D@BBB@BBB@BB@@B@@@B@BBB@B@@BB@BBB@@BBBBB@BBB@@BBBB@BB@@BBB@B@@@BB@@B@B@@@B@BB@@BB@@BB@B@@@BB@@B@D@BAB@@@B@@AB@@AB@@A@@@ABCBEBCBA@A@@@@@@B@@@@@@@B@B@@AB@B@@@B@B@B@BBB@@@B@@BB@@@@@B@@@B@@@B@@@B@B@@@B@@@@@B@@A@@@@@@@@B@B@@AB@@@B@@A@@@@@ABA@A@@@A@A@A@@@A@@@A@@@@@@BA@@@@B@@@B@B@@BB@BBB@B@@@B@@@B@@@BAB@B@@@B@@@BAB@@BB@@@B@@B@@BBB@@@B@@@B@B@@@B@@@B@@@B@@B@@B@@BB@@@@@@@@ABAB@@@BAB@@@B@@AB@B@@@@@BA@@@AB@@@@@B@@@B@@@@BBB@B@B@BB@@D@BBB@BB@@BBB@@BB@@BB@@BB@@@@B@@@@@@@@A@@BA@@B@@@@@@@BBB@@@B@B@@BB@@@BBBB@@BBB@@@@@B@B@@@B@B@B@@@B@@B@@B@@@@B@@@@@@A@@@@B@@A@@@A@@BA@@B@BA@@D@B@BAB@@@B@BBB@B@@@B@B@@@BBB@@@DBBB@@BB@B@B@@@B@B@@@@@B@@@B@B@B@@@@BB@B@@@BB@@@@@B@B@B@@@BBB@@@B@@BB@@@BB@@B@@@@@BAB@BBB@B@BBBBB@@@B@@@@@@@@@@BA@@@AB@@AB@@@B@@@@@B@@@B@@A@ABAB@@A@@BA@@@@BA@@B@@AB@@@@@B@@@@@@B@@BB@BB@@BDBB@@B@@BD@B@B@B@@@@@@@@B@@@B@B@@@@@@@B@@@@@@@BB@@@@@@@@BB@@@@@@@@@BB@@B@B@@@@@@@B@@B@B@FBB@@@B@B@D@@@B@@@@BBBDBB@@B@BBD@D@BBB@BB@@BB@@@B@@B@@B@@@B@B@B@@@B@B@@AB@@@@@BA@@B@@AB@@@@@BAB@BBB@B@B@B@BBB@B@@@@@B@@@BB@@B@@@B@B@B@B@@@B@BBB@B@@@BBB@@@B@B@B@BBB@B@BB@@B@BBBBBBBBBB@@@BB@B@@@B@@@B@@@BB@@@B@@BB@@@B@BBB@BBBB@@BBB@BB@@@@BB@@B@@B@@BB@@@@@BB@@B@@@B@@BD@DBB@B@BBB@@BB@BBBBBDBDDBB@B@@@B@BAB@@AB@@@B@B@B@B@@@B@BBB@@@B@BB@@BBBB@@BDB@B@D@@@BA@@BABAB@BA@@B@@@B@@@B@B@@BBB@BB@BBBBB@@B@@DBB@BBB@@@@BB@@@B@@@B@@@@B@@@@@B@@@@@@@BAB@DADADADABAB@BADAD@B@BAD@F@B@BB@@B@D@DAB@D@BAB@@@B@B@B@B@D@B@DBD@DBFDFBDBDBBDBBBBBB@BBBB@@B@@@BBB@D@@@BA@@D@@@BAFAB@@@B@B@D@@@B@B@@@B@@@B@@BB@BFDBBDBBBDBBBB@@@@B@@@@BB@BBB@@B@BBB@BBD@DBD@F@DBJAB@@@DBD@BAD@D@BAB@B@BAD@DABAB@BA@ABA@ABA@@B@B@@@B@B@@BBAB@D@D@DAD@@AD@D@D@DAD@BBD@B@D@F@HBD@DBBBD@BBB@F@F@B@@@DBDBBB@BBD@BAD@B@D@B@BBDBD@DBBBBBBB@B@@BB@B@B@FBB@D@B@D@@BF@DBB@D@B@BBB@B@B@@@BBB@B@BBB@B@B@BBB@@@B@BB@@B@@B@@DDBBBB@@BB@@B@B@@@B@B@B@@@B@@@BAB@@@BADCBAAAAAAAACACAACE@@@@@@@A@@BCBA@@@@BA@@B@@@BA@@@C@ABA@ABADA@@@@B@@@@@@A@AAA@A@@AAAACAAAAA@@@AAA@@AAAA@@AAACAC@@AACCAAACAA@@@A@@@A@A@A@A@AB@B@DAB@B@B@B@BA@@DA@A@@@ABA@A@A@A@@AA@@@A@@AA@AAA@@@AAA@@@A@A@AA@@A@A@AAA@A@A@@@A@A@@@A@@A@ACAA@@@@AAB@@@@A@@B@@ABAB@BA@@@@@A@@@AA@AAAAA@@@@@@A@@B@@AB@@AB@DA@ADA@@DABADADA@@BA@@@@BA@A@@BCA@@A@A@A@A@@AA@A@@@@@@BA@@BA@@BAB@BAB@@@DAB@FABABA@@@@DAB@BAB@BAB@D@BAB@B@B@B@@AB@BAB@BABA@@BABABABA@AB@BABAB@DA@@DABA@@DADABA@@B@@@BAB@DAB@BAB@@@DABAB@@@@@B@@@B@BBB@D@BBB@DBB@B@B@B@@@B@B@@@B@BBB@B@BBB@B@BBB@BB@@@@BBFD@@BBB@@BB@BBB@B@@@BBB@B@@@B@B@@@B@BBB@B@@@@@B@B@@@B@B@B@BA@@B@B@B@D@B@BAB@B@@@B@B@B@B@B@B@BB@@B@BBB@B@BBB@B@B@@BB@B@BBBBBBBDBF@BB@BDBBB@DBB@BABC@A@A@A@ABABA@@BADADAB@DAF@B@BAD@DADAD@D@F@F@L@L@H@F@D@D@B@H@D@D@B@HBD@D@F@D@D@FAF@HAHADAJ@F@B@H@D@D@FAF@H@FAH@BEBE@ABEBC@CDIBE@EBCBE@CBA@ABEBC@CBABE@AC@C@ABA@A@@@A@A@ABAAC@A@@@CBA@A@C@@AC@AAA@A@A@A@ABA@@@CAA@CBA@A@G@ABA@E@A@A@@@EAAAA@EAAACA@@A@A@AAA@@@@A@@BA@@@@AA@@@A@@@A@@BA@@AA@@@@A@ABC@ABA@@@@@@@AA@@C@@BA@@B@BA@@@A@@@@A@AA@A@@@AA@@A@AAC@A@A@AB@@A@AAA@CA@@AA@@A@I@EACAEAA@CAA@A@G@@@AA@AA@GACAEAAAEA@@AA@@AC@A@@A@@@A@@@A@@A@@A@@A@@B@@@BA@A@A@@@@@A@@@@@@@AA@@A@@AAAAAAAA@@@@AA@@@A@@A@@A@A@A@CAC@@@A@@A@@@C@@@A@@@A@@@AA@@A@@@A@ABA@@@A@ABABA@@@A@CACAA@@@@@"],encodeOffsets:[[134153,45554]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,5 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("齐齐哈尔",{type:"FeatureCollection",features:[{type:"Feature",id:"230202",properties:{name:"龙沙区",cp:[123.957531,47.317308],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CAAAEAA@A@AACAAAC@@@AAA@CACAAACEEECEEEECCA@AAC@C@A@A@@AA@A@@@A@@@A@@@@BA@ABCAEAA@A@@DADA@EBM@EBA@AJ@B@B@DAB@BAB@DAB@BABHDABA@AB@@CDA@@BA@@B@@@@A@@@@@KHCDABA@@@@@A@@@@@@@@B@@B@@@@@@@@B@@A@@@A@@@A@@@@@A@@@@@A@@@A@@@A@@@@@@BA@A@A@A@AB@@@@A@@@A@ABA@@@A@@@ABCDABA@@BA@AB@@@@@@AB@@@@CBABABABCDABHDBAJANBLBHFDHFJFNHPHHBBLPBDAPAHADAHAD@B@@@B@@@@@B@BB@@B@@BB@@@B@@BB@B@@BB@B@@BB@@@B@@BB@@@BBB@BB@@B@BBBBB@B@@BD@BB@@BBB@@@BBB@BBD@@BBDJBDBDDFDHDFHPFFAEAE@@@CBGL@HBH@D@B@F@FBB@F@D@D@B@BA@@@@@@BAD@B@D@@@DAD@B@@@@@@@@A@@BAB@F@F@FBB@B@@@@@DA@@@A@@@@@@@C@A@EA@@A@@@C@C@AB@@AB@@@@@@A@@@ABAA@@A@AAA@@@A@@@A@@@@@@B@@A@@@@B@@AB@@@@@BA@@@@@@@A@@@@@@@@@A@@@@@@@AB@@AAC@@@A@@@A@A@A@A@C@GAAB@@@B@B@BAB@@@@@BBBD@@HAB@DIFGB@BAB@@@BCB@@@@@@AB@@@@@A@@A@@B@@@B@DBB@BBBDBACAAEB@AA@@BAB@@@@@@@BA@@@@B@@A@@BA@@BA@AA@@A@@@AAE@A@@@AA@A@A@@A@@A@@AACAA@@C@@@E@A@IIEC@@ABCBAB@@@@@@A@@@@@@@A@@CAA@CACI@I@@@AA@@AC@@@AAA@A@@AECICE@ACIAAAEAAAEAA@C@@ACAEACCE"],encodeOffsets:[[126920,48351]]}},{type:"Feature",id:"230203",properties:{name:"建华区",cp:[123.955464,47.354364],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@@@CB@@@@A@A@EAE@E@A@AB@@@B@@@@@@A@C@CB@@C@A@C@AB@@@@@@ABA@C@C@E@A@EAE@A@C@G@GAK@AH@D@@BFBFHPLLDF@F@F@B@FDPEBIDG@KAKCGAE@EACDABCF@B@HFHBHBBAD@DAF@D@FFLFBLDL@AAAACEAGBCBADCD@FAF@B@NELCFCBC@GCCBC@AD@F@RAFAFBFBJFDDBD@DCDGDEDCBABABBD@BBBDDHDB@DBD@DABA@ADABCBAB@D@B@DBDBBBDBBBFHDDBB@B@BAH@B@BDDFBDBF@H@DAD@BBB@D@B@@@B@B@L@H@BB@D@@BBBB@B@@D@@@B@@@B@@B@@@@BB@@B@B@@@BBBBB@BBB@@@B@@ABABE@@B@F@D@B@B@B@BAB@B@@AB@DABAB@@@@@BAB@@@@@B@BBBD@BB@@@BB@@BB@@BBDBBB@@B@@BB@@@B@B@B@@@DABABADAB@B@BDB@BBF@FBB@BB@A@@@@BA@@@@@AAABBDBB@BB@@B@DB@@B@@@@C@@BADADABB@@DEDE@AB@FADCB@DCBCBA@A@@AA@CBA@A@@B@@AB@@A@A@@@A@@@AB@@A@@BA@@B@@A@@B@@@B@@@B@@A@@B@@@@AB@@@B@@A@@@@@@B@@A@@@@BABC@A@@@@CAKEA@GCIAICICA@C@@@CAC@EAEAIAC@E@K@BC@@@@@ABA@@@A@@@@@A@@@A@@@A@@@AB@@CB@@CB@@A@ADA@@@AA@A@A@@@A@ABA@@@@@@@@BA@@@A@@@A@@AA@@A@G@E@@@@C@GAG@A@G@A@@@G@G@I@@@A@E@IAG@I@C@AA@@CCCAACCA@@@@@@@@EE@@AA@@AAEDCC@@CC@@C@A@@@@@@BA@@AC@BA@@@C@@@@CA@@@@@@C@E@@AAA@@ACAA@AD@ACC@CBAEAAACC@I@CBE@@@E@"],encodeOffsets:[[126946,48482]]}},{type:"Feature",id:"230204",properties:{name:"铁锋区",cp:[123.978293,47.340517],childNum:1},geometry:{type:"Polygon",coordinates:["@@MIMGCAKGIEGEECA@AA@A@ACCAAEECCAAA@CACAEAGACACACAA@A@AAA@C@A@C@E@@@A@MBSBIBI@IBIAOAQ@AASASAI@A@G@UAWAUASAEAOAG@@@@NAZ@\\@H@@@@A@@@AB@@A@@B@@A@@BA@A@@BA@@@@BA@@@A@AB@@A@ABA@AB@@A@@@@@ABA@A@@@AB@@A@A@A@A@ABA@A@A@AAA@A@A@@@@@@@AA@@@@AA@@@@@@@@AA@@@@@@AA@@A@@@@AA@@@AAA@@AA@@@AA@@A@G@AD@FAF@D@FABAF@B@D@D@D@D@B@D@DAD@DAD@B@B@B@F@F@D@D@BAB@B@@CFEHAD@@ADEHADABA@]BA@O@G@C@A@@@A@CAA@@BA@C@A@A@ABA@A@@@A@@@A@@@A@@@A@A@A@E@A@C@A@E@A@C@A@C@CAC@A@@DBD@BAD@BAF@D@D@B@@@B@@@B@@AB@B@B@BBBDDJHDBBB@D@B@FBDBHA@@@@@@@@@C@AAA@AA@BAB@DADCDCBCD@@@BIACBE@@@A@@@AB@@A@ECCCBA@ASEABEAECE@@ACBEDA@CBCBEBCB@@@@@A@@A@@@@@@@A@A@C@A@A@A@@@A@@@@@A@AB@@BBA@BFDBABACAAA@CAA@@@A@@@@BB@@@@@A@@B@@@@A@AD@@A@ABA@EHCJA@GB@@ACAA@@@@A@ABA@A@@@A@BB@H@D@B@B@B@B@@@B@@BD@BA@@B@@@@@@@B@@@@@@@@@B@@@@@@AB@@@@A@@BA@@@@@@BA@@@@@@@@B@@@B@@BB@B@BB@AB@B@@@B@@@@A@@BA@@B@D@D@@@BB@@F@B@D@@@@@@@BD@F@@@F@DAJ@D@BDBBBFDAD@BDC@@BBBBD@@BB@BF@D@@@@@@@DB@@@@@D@@ABD@@BB@@A@@@@B@D@@@DD@@DDFCBB@@BB@@FF@@@@@@@@DBBDDBDD@@BBD@J@H@JBF@B@@@J@H@H@@@B@H@B@H@HBD@@@@@@F@H@BB@@BB@@@B@@@B@@A@@@@@@B@BAB@@@B@B@B@@B@@CB@B@BA@@DA@@DA@@B@@@B@@@B@@@B@@@@@B@@AB@B@@@@ADL@F@D@JBFBFBD@DB@@D@B@JDJDJBHDB@LFDBBAHEHEDA@@BADCHEDAHCFCFAJ@L@R@L@J@L@L@L@L@J@Z@N@T@L@F@B@J@R@P@R@P@PBP@R@R@N@B@RBP@N@P@R@P@B@lBDADAD@JADAB@BBH@N@R@DBD@BBF@N@B@H@B@BAH@F@F@B@B@@@@@@@A@AAA@AA@@B@@@D@D@B@B@@A@@@A@@B@@@@A@@AA@@AAAA@@@@@B@@AB@A@@@@@A@@DABADA@@@A@@A@A@@BABABA@A@@@@A@@@A@@BAJC@@BA@@A@C@@BCB@@A@@A@@@@BAF@@@@A@@E@@@@@@@@@BAB@@@DBB@BA@@@@@A@@A@A@A@AB@@A@@A@@@@@AB@@@@@@@A@@@AC@A@@@AB@@@@AB@BA@@@@@@A@C@CB@@AB@@@A@A@@@AB@DCBA@AA@AAABA@CBA@A@@@@@@AB@BA@AB@DBB@D@@BB@@A@@@AAACAA@@@B@BA@@@@BA@@B@@BB@BB@@BA@ABAA@A@@@AAA@A@@B@@@@AB@@A@AAAA@@BA@@A@@@@@A@A@A@@@@@@@AA@@@@A@@@CB@@@AAAA@@A@@@@D@@@@@@AB@@A@@@AA@@@@@BA@@@@A@@A@@@A@@A@AAC@@@CB@@@B@BA@A@@@AB@B@@@BA@@@C@A@@@@@@A@@@A@@@@@@ABA@@@@@@ADAB@@@@@@AA@@A@@A@CBA@AB@@@@BB@BB@@@@@@@ABA@@AA@@C@@AA@@AB@@B@BD@@A@@@GG@@@@@@@@B@B@@@@@AA@@@A@A@@A@AD@@@A@AAC@@@@@@C@A@@@@@@@@AB@B@B@B@@A@@@@@@E@A@A@@@@B@BAB@BA@@@AAA@@@A@@BA@A@@@AAA@BAA@@A@@@@DC@@@A@@@@A@A@@@@AB@B@BAB@@@@B@B@@B@@A@AA@A@E@C@@@A@A@@@@@DB@B@@A@AAA@@A@A@@@@B@B@B@@@@@@AB@@A@@A@A@ABA@A@@@@@@@DAF@BA@@@@AA@@@@@A@@B@@@@BBB@@@@D@B@@@AACAA@@A@BA@A@C@@B@@@@AB@@A@A@@@BC@@@@AA@@@@BAB@B@@@@BB@@@@@BA@A@@BA@@@@FBB@@@@@AA@@@@@AA@@@AA@@B@BBBBB@@@@@@@@A@@@@F@@@CA@@@@B@B@B@A@AC@@@@@ABBB@@@@@@@AA@@@AB@@@@@@@C@A@@BAA@@@@@@@@C@A@@A@AA@@AC@A@@@@A@@BADA@@@A@@@@BA@B@@BB@@BAD@@AB@@@AA@@A@A@@@@B@@@@@@@@A@A@AA@@@A@@A@A@@AB@F@BA@@@@A@@A@@@@@A@A@@A@ABC@@@@@A@C@AB@@@BBB@@BB@@@BC@A@@A@A@@ACAAAAA@@A@@@@B@B@@@@@AAA@@@AA@@@@A@@D@@@@CAA@A@C@@@@A@AB@@AB@B@B@@A@@@@D@@@@A@@ECA@@A@@@@B@@@@@@@@@C@@@@A@@B@B@B@@@@@@A@@B@BA@@@@A@A@@AA@@A@@B@@@B@@B@@B@@@B@@@AACA@@A@A@@@CBA@A@@@@@D@@B@@A@@@A@A@@CBA@@BCB@@@F@@@@@@@@AA@A@@@A@CB@@C@@@AB@@AB@A@@@@@@@A@@BA@AB@@AAA@@A@@@A@AB@@A@@@A@BA@@BA@@D@@@@@BA@AB@B@@@@@@BA@@@BB@@@ADAB@@@@AA@@@@CA@@@A@@@BBA@@@A@@@@@@@B@@B@@@@A@A@@@@AA@@A@@@@@@@AB@@AHBB@@@@@@A@@A@A@A@A@A@@@@@@A@@DA@@BAA@@A@A@@A@@@A@@@@@@AB@@@B@@@@B@@BBBA@@BA@A@@B@@B@@B@@@B@@AB@@@@A@@A@AA@A@@@@AB@B@@@BA@@A@@@A@@@@@@A@@BA@@@AC@@@@A@@B@@@@A@AAAA@A@@@ADA@A@@@@A@A@@@AB@B@@@@C@A@@@@@BB@BA@CCC@A@A@@@ADAB@@AAA@@AB@@@B@@@B@BABAB@B@D@B@BABAAA@@A@E@AAA@ABGB@@@@A@AGVBHKBAHKJMFGDCHKFGAKAM@MAMAMAMOI"],encodeOffsets:[[127397,48312]]}},{type:"Feature",id:"230205",properties:{name:"昂昂溪区",cp:[123.8224,47.15516],childNum:1},geometry:{type:"Polygon",coordinates:["@@e@C@A@A@A@@BA@A@@@ABA@A@ABEB@@A@ABA@ABC@ABA@A@ABA@CBABA@A@ABA@@@@B@@@BA@@@AB@@A@@@@@A@@@@B@@@@@@A@@@@@@@@BA@@@@@@@@@@@ABA@@@@@A@@@@@@BA@@@@@@@A@ABA@@@@@A@@@@BA@@@@B@@@@@@@B@@AB@B@B@@A@@B@@@BAB@B@B@@@B@@AB@BB@@@@B@@@F@F@D@F@D@F@D@B@BA@@BAD@D@D@B@DEBKBK@MD@@AJAD@B@@BBDBBBADABABA@ABC@C@CAAA@@A@@@@AA@@B@@@@A@AAA@@AA@@@A@@@A@A@A@C@CBGBABEF@BCD@BEB@DE@@BADADA@EBCBFDABIF@@EAABAB@@IJEFCDABABA@ABCBA@A@ABABCBCBEBEBEDA@E@E@C@C@ABC@E@C@G@E@AAA@CAEAECAAI@G@G@@@@@C@E@E@C@@@@@CBC@GDCBAB@BA@@BBB@BBB@BB@@B@B@@@BBBBB@@@@@BAB@@@@@BBB@BBB@@@@@@B@@@F@F@B@D@BBDBDB@B@@B@@@DAD@BAB@B@@@BA@@@@@@B@@BB@@@BB@@@B@B@@@B@@@@B@B@B@@@B@BAB@@@B@@@B@@@@BB@B@BB@@@@@BB@B@D@F@@B@DAB@B@D@BCDABCBIFIFCBA@@B@@@B@@@@AB@B@B@B@B@@@B@@@@B@@B@@@@@@@@B@@BB@@@@@@@B@@A@@@@@@@@B@BAB@@@F@DA@@B@@@B@D@FAB@B@BAF@@@B@BAD@B@B@DAD@D@@@BAF@@@@@@@@@@@@@@B@@@@@B@@B@@@@@@@@B@@A@@BA@@BA@AB@@CD@@A@@@A@@BA@A@@BA@@@C@@@A@AB@B@@A@@@A@@@@@@B@B@@B@@BB@BDBBDDBBBDBBB@@B@@B@@B@@@@BB@B@@@B@@@@AB@@B@@@BBB@BB@@@@BBB@@@B@@@@B@@@@@BB@@@A@ABA@ABCBA@ABCBA@ABA@@BA@CBABA@@@ABA@@@ABA@@@AB@@@@A@@B@@@B@@@@@@@B@@H@PB^FD@LALARAL@RDPDDDFFBHAFCN@JDDBDFBF@HBJDHBFAHAHADGFGFKDAGCBADCBABABADA@@@@BA@@@@@@BAB@@AB@BADCBA@@B@@@B@BAB@@@B@@@@@BAB@B@B@B@@A@@@@B@@@B@@@B@@@@@B@@@@@B@@@B@@@B@@@@A@@@@@@A@@@@A@@@@@@B@@@@@B@BADCLG@@@@B@@@@@@AB@@AB@DC@@BAB@BAGCBA@ABA@CBA@ABA@C@A@ABIB@FAN@FAB@BC@CB@B@FBDBBAB@@A@@B@@@B@@@B@B@@BB@B@D@D@BBB@DDFFFFFDFFBDBBBD@DBB@B@@BDBBBD@B@BBBBFBB@DDFBDBFBD@@@DBBBFBBBFBBDJ@BDFDJBF@@@BBB@B@@BD@@BB@@J@J@BD@DBB@DB@@@@@@@B@@@@@@@BADABA@@FDJJB@F@@@D@@@BBBD@BB@@@@BB@B@B@@B@@@BBF@B@@@BB@@BAB@@AB@@@BA@@@@@AB@@B@@@B@B@B@D@B@B@@@@@@@B@@@@B@@@@DAFADADAB@FCDA@BF@FDFBBATF@BABDDFDB@@@BA@@B@@@F@DAJB@A@@DCDADCBC@CBA@ABBB@BBD@@@@@@@@@B@AGAC@E@A@CAACAIGCCAA@A@A@ABA@@@A@@@A@@@A@C@CBE@ABC@AAC@CB@D@DBD@B@D@B@F@B@D@B@F@B@B@B@@@B@@@B@@@B@@@B@B@BAB@B@D@B@@AB@DBB@@@B@D@H@P@B@^AB@BABCFGBC@@BCFGDE@@@ABA@A@C@C@E@E@A@A@ABC@CBC@C@C@A@C@C@C@C@ABEBA@E@CBE@EBCH@B@@@BB@@B@@BB@BB@@B@@B@@B@@@BB@@@@@@BB@@@@@@@@BB@@@@BB@@@@@@B@B@B@BBB@B@B@BAB@B@B@B@@@BA@@B@B@BA@@@@B@@@BAB@BAB@@@BAB@@@B@@A@@B@@AB@B@@AB@@@@AB@@@BA@@B@@@@@@G@[BY@MCAQIGCGEIEEAGCIEMGAAAAGIGEIIAAACCACEKKIKKKIGKEUGUIOEICA@@@@@DADABAB@DADABAFABADAB@B@@@CCAECCEKCIEQGO]@[@Y@W@EAM@CAKAC@BCDEBCBC@A@AE@IFAHCBEBA@@@@@@@ECCCCAAAAACAAAE@EBE@EBE@EBG@C@EB@FC@G@C@E@E@C@E@E@E@E@C@E@"],encodeOffsets:[[126895,48127]]}},{type:"Feature",id:"230206",properties:{name:"富拉尔基区",cp:[123.629189,47.208843],childNum:1},geometry:{type:"Polygon",coordinates:["@@BC@ABCDC@@DCB@BAF@D@D@D@D@B@@@@@B@@@B@@BB@@@BBB@@@@@B@B@B@B@@@B@BBB@@@B@B@BBB@@@B@D@B@@@B@B@@A@@@A@A@@@A@@@@@@@AA@@@AA@@AA@@AAA@@AA@@A@@@AA@@A@@@AB@@@@A@@BA@@@@@A@A@@@A@@@@AA@@@A@@AA@@AA@A@@@A@@@@@@@A@@@AB@@@@@BA@@B@BA@@B@BA@@B@BADAB@@AB@BAB@DABAB@DABAB@BAB@@@A@@A@@@@@A@@A@@@A@AA@@@@AAA@AA@@A@@@BA@@@@@A@@@AAA@@@@@AA@@@@AA@AAACAACCAAACA@@AA@@@@A@A@@@@B@@@B@@@@ABAB@@@D@@@B@@AB@B@@AB@@@B@@@DC@@BAB@@AB@@AB@@@@A@@@@@@A@@@@A@@@@@A@@@@@@@@@@@@E@AB@@C@C@CBA@A@C@ABA@@@E@ABA@A@EBC@A@@@A@@@CBE@@@A@ABA@@@@@@@@@@BA@@@@@@@A@@AA@@@@@@@@@@AA@@@@@@A@@@A@A@A@ABA@@@@@A@@@AB@DAJEJEDABADC@A@C@ABA@C@AE@C@A@A@@A@@@@AAA@A@@A@@A@@@A@@@A@ABA@@@A@A@A@@@@@@A@@@A@A@@AA@@A@@AA@@@@@@@AB@@A@A@ABC@CB@@A@@@@ACACAAAC@A@E@E@@@A@@@@@@@AA@AAA@A@@@@BA@A@@@@AAAA@A@@@A@AA@@AAA@AAA@AB@@ABADAHCD@DAA@CBC@E@A@A@A@@A@@@@@A@A@@@A@@AAAACC@AA@@@AAA@A@@A@@AC@@@A@@@@A@AAA@@@@A@A@@AA@@@A@AAA@A@@AAAAA@AA@@CAC@GACAA@@@@A@@C@E@K@E@C@@ADG@EAEGGKKAA@AKHIHGL@@A@@@A@@@A@AAA@@@A@@@A@@A@@@@@@A@@A@@@@@@@BA@@@A@A@@@@BA@@@@@AA@@A@@@CACAA@C@ABA@A@ABAAC@A@@@AA@BA@A@@@@BAD@BA@A@@@A@A@@@AAAA@@CAA@AA@@A@@@CDA@ADAB@B@B@@@@@B@@A@@@@@@BA@@@@@@@@@A@@@@BA@@@@@@@A@@@@@@@AB@@@@@BA@ABAB@B@D@B@B@BCBABABCAA@AAA@@@ABA@A@@B@BB@@BA@@BA@C@C@@BA@@B@B@@@@@B@@@@@@@@@B@@@@@@@BA@@@@@@@@@@@@@A@@@@@A@@@@A@@A@@@@@@@@@@BA@@@@@@@AB@@@@@@@B@@A@@@@@@@@B@@B@@@@B@@@@@@A@@B@@@@@@@BA@@@@@@@@@A@@@A@@BA@@@A@@@@@A@@@@@@@@@@B@@@@AB@@@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@A@@B@@AB@@@@@BA@@@@@@BA@@@@@@@@B@@@@@@@B@@@@@@AB@@@@A@@@@B@@@@B@HBBBPDDBNDJDD@B@@B@@@@@BA@@BA@@BA@@@A@@@AA@@AA@@@@A@A@@BEBAB@@@B@@@BBB@@@@@B@@@@@B@@@@@@@@@BB@@@@@BA@@B@@@@@@@B@@@@BB@@@@@@@B@@@B@@@@@@@B@@A@@@@@AB@@@BB@@@@B@@@B@@BB@@@B@@@B@@@@BB@@@@@@B@@BB@@@@@@@BA@@@@@AB@@A@@@@B@@@@@@@@@B@@@@@B@@B@DBB@B@B@@@@B@@@@@@AB@@@@AB@B@@@@@@A@@@A@@@AA@@A@@@@@@@@B@@@@@B@@@@@@@@A@@@@@AA@@@@A@@@@BA@@@@@@BB@@@@B@@@@@@@@@@@B@@@B@@B@@B@@B@@@@@@@@@@B@@@@ABA@AB@@@@AB@@@@@B@@B@@@@AB@@@B@BB@@B@@@@@@B@B@@@@@@@BC@E@@@@@@@@@@@@@@B@@B@@B@@AB@@@@@B@@@BA@@@B@@@@@@@@@B@@B@@@@@@@@@BA@@@@@@@@@@@B@@@BB@@B@DBB@@BB@@@@@AB@@A@@@@@@B@@@@@B@@@@B@BAB@@@B@B@D@BBB@B@@BB@@B@BB@BB@B@B@@@@A@@@@@A@@@@@@B@@B@@@@B@@@@AB@B@@@@@B@@A@@B@@A@ABA@@@A@@B@@A@@B@@@BA@A@@@AB@@A@@@@B@@@BB@@B@B@@BB@BB@@@@@@@B@@@B@@@@B@@@B@@@@@@@@@@A@@@AA@@A@A@@@@@AB@@@B@@@@@B@@@@@@@BB@@@@BB@@@@B@@ABA@@@@@A@@@@@A@@@@@@B@@AB@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@A@@@@@A@@@@@@@A@@@A@@@A@@@@@@@B@@@@@@@BB@@@@@@@@@@@@A@A@@@A@@@@@AB@@@@@@@BB@@@@@B@@AB@@@B@@@@@@B@@@@A@@@A@@B@@@@@@B@@@@B@@B@@B@@A@@BA@A@@@@@AA@@A@@B@@@@@@@BB@@@@@AB@@@@A@AA@@A@@@@@@B@@@@@@B@@@@B@@@@@@@@@@A@@@A@@@@BA@@@@@@B@@B@@B@@@@B@@B@@@@B@@@@@@@@AB@@@@@B@@@@@@@@@@B@@AB@@@@@@@BB@@@B@@@B@@@B@@@B@@@@B@@@@ABAB@@A@@@AB@@@@A@@@@@@@@BB@@B@@BB@@@B@@@B@@A@@@A@A@@@ABA@A@A@@@C@@@A@@@@B@@B@@@@B@@@@@B@@A@A@@@A@@@@@A@@@@B@@@@@@@@A@@@ABC@@@E@ABG@CBA@A@A@C@ABA@A@C@C@C@C@CBC@C@C@@@CFEHEHADABAB@@@@@@@B@@@BBB@D@BBDBH@DBB@B@@AB@@@AA@@@@AECCCA@@A@@A@@@AA@@A@A@A@@@@@@@@@@DAHG@E@ADAL@DAJALAHABKFIHEDEDEDGDGFIFCBCDAB@@@B@@@B@@BB@BBB@@B@B@DBB@B@B@B@BAB@@AFBH@HBH@HBD@J@FBB@F@JBF@HBNDD@JBFBJBB@@@BA@@@@BA@@B@@A@@B@FCDA@B@@@BB@@B@BFAD@@AB@@@D@@@@@@B@DAF@F@@D@DCDCHBRFBBH@B@BBDDDBJHBBFAH@PCB@BAD@F@FAF@@@B@PEJADBJIHFBD@@B@@@BA@@B@@@B@@AB@B@@@@@BA@@@@@@B@@@DABACENE@@AA@@@@B@@@@@@BBA@@@@@AA@@C@AF@@AC@@@@@@@@@@@ACA@BAJAD@@@AA@A@@AAAABACCEC@E@@EB@BC@@@@@AE@GF@@@AAIG@@BCB@@A@@@AB@@ABA@@@AA@ECA@A@@@A@@B@A@C@@@ABCBG@ADGBIDGBC@@BE@ADGDEB@@@BADCBA@A@@A@@AB@BCBC@@DBB@FBD@@@BBB@BBB@@@B@@ABA@@@ABA@@B@@@@@@A@ABA@AAA@@@@@@BA@ABA@@@@A@@A@@B@DEBA@AB@DC@@@@B@@A@AB@B@B@@ADBFB@@BADB@@BBBBFB@B@@@@AB@BA@@@@@AB@@@B@@@B@@@@@B@@@BB@@@@@B@@@B@B@@@BAB@B@B@@B@BAD@BABAD@FAB@BD@BBB@@@@@@@@BB@@@B@AB@@@@BB@@@@B@B@@@BBB@@@ABB@@@B@@@A@@BB@@@BB@@B@BB@@B@@@BDBDDDD@DCFAD@HCJA@@DADCBADAHGFEFIs["],encodeOffsets:[[126658,48381]]}},{type:"Feature",id:"230207",properties:{name:"碾子山区",cp:[122.887775,47.516872],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABCBA@ABABAB@@A@@@A@@@ABA@ABCDABAB@@@@CBAB@B@@@B@@A@CBA@C@A@A@ABA@CBADABA@@B@@@BB@@BBB@BABAB@B@B@B@@ABA@ABA@A@CAC@@@ABADCDCDCHADAB@D@B@B@@BB@B@@@B@DAB@@@B@B@@@D@B@BA@@B@B@D@@BBBBBB@BBBDD@@BB@BB@@BB@BBBBDBAB@@@@A@A@@@A@@@@@A@A@AB@@CDCBA@@BA@BB@B@@BBB@BB@B@@@BABA@A@@@@D@B@D@B@B@B@@@BBD@DAB@@@B@@AB@@@B@@@@@BAB@@AB@B@@@B@@@@AB@@B@@BB@BB@@@@BB@@@BBB@B@@@@@@@B@@A@ABAB@@ABCBC@@@ABA@A@@@A@@B@@@@AB@@@B@@@BAB@B@BAB@B@B@@BB@BB@@B@@@BAB@F@@@@@@BB@B@@AB@@A@C@@@@B@@BB@@@BCDCB@B@@A@@@@@A@@@@@@@A@@@@@@@@@@BA@@@@B@@@B@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@AB@BAB@@@@@@A@@B@@A@@@AB@BFBBBBBBBB@BBBBBBB@DB@BBBDBBBB@@@BB@@BBB@BBBBB@@@DBDBBB@@BBFDBBB@@@BBB@@@@B@@DBDBFD@@BBDBB@DBB@BB@@@@B@B@RD\\J^HZHFBCOAI@AVINIBABADABA@C@ABAHEFEFE@@@@@@@@@@B@@@B@@BD@@@B@BBB@DBBDBB@DB@BBB@@@@@B@@@BB@@@@B@@A@@@@B@@@@@@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@B@B@@B@@B@@@@@@@B@@@@@B@@@@@@B@@@B@@@@@@@@@@B@@@@@@@@B@@@@@@B@@@@@@@B@@@@@@B@@@@@@@@B@@@@@@@BB@@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@@A@@@@@@@@B@@@@@B@@@@@@@@A@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@A@@BB@@@@@@@@BA@@@@@B@@@@@@@@BA@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@B@@@@@B@@@@@B@@@@@@@@@@@B@@@FAFAJCHCDABA@A@@@AB@@@DABCBADCBADABADCDEBCDA@AB@BCDEBA@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@B@@@@@@@A@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@A@@B@@A@@@@@@B@@@@@B@@A@@B@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@A@@@@@@BA@@@@B@@A@@@@B@@@@@@A@@@@@@@@BA@@@@BA@@B@@@@@B@@@@@@@@AB@BBB@@B@@BENDJBHB@DBD@B@B@@D@B@BBH@HANAD@@C@E@A@@HEB@AACCAAEE@ABIBI@I@@@@@@A@CAA@A@@AA@ABC@EAC@A@AAA@A@AA@B@@@@@BA@@@@@@B@@@@@B@@@@@@D@FBB@AD@@C@CA@AA@@B@@A@A@CBA@@A@@@AA@CACAA@A@A@A@C@AAC@CAAHE@KA@CAA@CBAA@ADC@@C@A@@CAAAA@@A@A@A@CBE@A@@B@B@@@B@A@@AA@EBA@@@AA@@AA@@BAA@@@@@A@A@A@@AA@A@A@@@@@@@@BB@@@@@AB@@@@A@@@@AA@@AA@ABA@AA@@@@@@@A@@A@@@@@@B@@A@@@@@A@A@@BA@@@@@A@@@@@A@@@@@@@@B@@@@@@@B@@A@@AA@A@A@C@C@A@AAA@@@A@@@@@A@ABCBAB@BA@@@A@@BA@@B@BA@A@CAA@@A@@A@A@GBEB@@A@A@CAGCAAAA@@@A@ABA@A@C@ADEFG@ADAAA@AAAA@@@B@@AB@@A@@@A@@@A@A@@AC@A@@AAAAEACA@@CEA@@A@@BCBA@A@@@@@A@@@A@@BA@@BC@CBCBEB@BBB@BAB@BAB@BA@@@A@@ACCEAC@@@A@A@@A@@A@@@A@A@@BA@A@ABA@ABA@@D@HBBAAA@@B@@@BAHCFA@@B@DAB@@@@AB@CA@AA@AB@@@A@@@@A@BCBCF@@@@@@AB@@@F@@@@A@@@@A@@AA@@@B@B@@@B@B@B@@@BB@A@@@A@@@A@@@@@AB@@AB@@AB@@A@A@A@ABA@@BAEAC@I@A@E@CBA@@AEAAAA@@@C@@AA@@@AAABA@A@A@C@EB@@@@@@C@A@AB@@@@@AA@@AA@@@@@@@@@@@@A@@@@@@@@@AB@@@B@@AA@ECCAFCDADABADAB@@@@A@A@A@@CAFADABAGABA@AA@@@@@C@ABA@A@AA@@A@@@C@A@@@@@A@@AAA@AAAAAA@A@A@@AA@A@AAA@A@C@A@AEAACCAAAACCA@CACA@@@A@@@AA@@A@@@@@@@@C@GAC@C@G@A@A@BB@@@B@@@B@@@@B@@@@@BB@BB@@B@@@@B@@B@@@@@@@BA@CDAD@@@@@@A@@@@@@@A@A@A@CBABAB@BABABABABABB@B@BBD@B@B@B@BAAB@@DB@@@BABA@ABC@C@A@EBABADABADB@@B@BABCBABCFADA@@DBBBB@B@BAD@BABCBA@CBC@CB@@"],encodeOffsets:[[125835,48624]]}},{type:"Feature",id:"230208",properties:{name:"梅里斯达斡尔族区",cp:[123.75291,47.309537],childNum:1},geometry:{type:"Polygon",coordinates:["@@ICGAE@EAACCC@IDMBEAGEECCOCQCK@QBKBKBC@]EOAG@@BBB@@BB@@@B@@BB@@@@@B@@@B@B@@@@AB@@@B@@A@@B@@@BB@@B@@@BB@@BB@BB@@BB@@BB@@B@@B@@@@@@@B@@@B@B@@@BA@A@@@A@C@A@@@A@AAA@A@@@A@AAA@@@A@A@A@A@@@@@A@AA@@A@@AA@@@A@@@@@A@C@C@C@C@E@ABA@CD@@CDAD@BADt\\EJEFGHCBABCDCB@@IBGDC@EBCDC@CCACAC@@A@@@AAA@@@AA@@A@@AB@@@A@@@A@BA@@A@AA@@A@A@@@@@AA@@@@BAA@@@A@@A@@@@@@A@AAC@@ABA@EBCBA@ABC@A@AA@A@A@AB@@A@A@@@A@@@@@A@@A@@@A@@@@@A@@@A@@BA@@@@B@@ABA@@@@@AEAAAAA@@CAAB@@EACA@BA@A@A@@B@BA@@@@@CDA@@BABCFA@@@@BB@@@@@AB@BAB@@@@@@BB@BAB@B@B@@@@A@@@AB@B@@AB@BA@@@A@AAA@AA@@C@EAA@CA@@ADADA@@BB@@@@BABCDAB@@A@CFCH@BAF@@ADCHAJCH@BAHAD@B@@@D@B@AB@@@B@B@FDB@@B@@AB@BA@@B@@@BA@AD@@JHBB@@E@@HBF@@@@D@@AFA@@@FFDDDABBBBB@@@BBB@@C@IBABB@BD@@@@@@@@@@D@@BE@@B@DB@@B@@@@AB@A@@@@A@@@@@BB@@MFDFABCB@@A@@@@@@@AB@@@@A@A@@BA@@@A@@@AB@@A@@@ACGEIJCAIBOFA@@@E@EBE@C@@D@DBJBF@F@FBB@B@B@@AB@B@B@@@B@B@@@DBB@@@DBB@@BDBBBBBB@@DB@BB@BBBBB@DBBB@@B@BB@@BB@@@D@@@B@B@B@B@D@F@DAB@D@B@DAF@F@F@F@B@B@BABBBAF@B@@DHBFDD@BBBBBDD@B@@CF@@CHCHABCF@@@@DBB@D@B@DBDB@@@@@B@D@B@B@B@B@DB@BBBBB@@D@BEDCDEFGDCD@@@@@@@@@@A@@@@BA@@@A@@BA@@@@@ABAB@@CBCBIDEBEB@@A@A@CAA@@@C@AB@@@@B@BBBBDB@BBBDB@@@BDBBBB@@BB@@BDBHF@B@@B@@B@@@@BB@@BBBBB@DBBBB@B@DBB@@@BD@B@D@D@BBF@@@D@BD@B@B@B@DBBB@@BBB@@B@@B@@@B@@@BB@@@@@@B@@@@@B@@@@@B@@@@@BB@@B@@@B@@@@@B@@@@@@@B@FBLBD@JBJBD@ADAF@B@@B@@@B@@@B@@@@@@@@@BB@@@@@@@@AB@B@@@@@B@@@B@B@@AB@B@B@B@@@@@B@B@B@BA@@BABABEHEDADEBGBQHQFYJEAa@CECE@CIAI@MEECAAGIDE@EIGGBGGM[E@@DG@CHQNA@UKWKEIEAAEBIdIBG@AACACMEIGBGBC@ACGEC@ACEGAIESEUG@KEI@ECEAAKG]OMEGE@AGBIDGCCCOCBACAGCA@BE@AC@C@MAQJSBCBLXLPBP@V@BAjAHA`BLDPFJHNHNDFHLFTN`JXBJHRAHCFEBMDOBGBMHGHAJDLLLDDB@@BB@BA@@@@B@B@@@DBB@D@@@@@BB@@B@@@@@@@B@DAB@B@B@BB@@B@@@B@B@B@B@B@BAB@D@B@B@D@@@B@@@B@B@B@B@@@B@@@B@@@B@@A@@B@@@@@@@@@@A@@B@B@@AB@@@B@@@DA@@BAB@BAB@BABABABAB@DCBAB@@A@@BA@@@ABAB@@ABCBA@ADC@A@ABA@A@@BC@A@@@ABA@ABA@ABA@ABABABABABABADABADCFABAB@BADABADABAB@BA@@B@@@@@D@B@DBJDDBDBD@FBFDFBFBDBFBDBB@FD@@@NBFDZ@N@JMTBDAFHFL@D@BL@@F@D@@DBD@D@J@DBD@F@F@FBF@H@D@Cf^ZILRJFIHGJQOY@GBKD@DBD@D@D@HBH@F@F@B@CCGAUEHIN@RDD@F@JBDAFAHEB@@@BBDDDFDDDFDFBDDDDDDFBDDFFFBB@B@B@B@@AB@@AB@@ABAB@@@B@@BBDBDBDBHBJFF@JFJDDBB@BBB@BBB@B@B@B@BBB@B@D@BBD@D@BBB@B@B@B@DB@@B@@@DAB@@@B@DBD@B@B@DBD@@@D@B@B@DAB@B@BBB@B@DBDBDBHBHCLOhUdmNjYXSZJF@DLJSJcPHDFBDBD@FBHDDBDBHBHDF@DBDBDBFBFBFBFBFBFBHDB@DBHBFDD@FBDBDBDBHBFBBBDBD@DBDBDBD@DBDB@@D@BBDBDBHBDBFBB@B@BADCJCBAFCBAFCB@@AB@FABADCFABABAB@DBD@DBD@FBB@BBD@B@BBFBB@@@DBFBJBJBLDLDD@DBB@B@BB@@@DAD@BAF@BAD@DAF@DADAF@DAB@@@BD@D@D@F@D@D@D@D@F@D@D@D@F@D@D@F@D@B@D@D@B@F@D@F@D@B@@A@@@ABA@ABC@A@ABC@ABC@CBC@CBCB@FBF@H@H@FBH@F@B@D@HCJAHA@@B@@AB@@A@ABA@ABA@ABAB@@AB@DBD@@@@AB@@@B@B@F@HBB@F@F@B@@@B@@@B@B@B@B@D@BBB@F@@@D@@@B@@@@@FDBDFDDFFFFFDFBBBBBBFFDDDD@@BB@@BB@@@@BB@@BB@@BB@@@BB@@@@BB@@BB@BB@BBBBBB@BB@BB@@@BBBBBBBB@@B@@BB@@BB@@B@@@@B@BBBB@@@BB@@@BB@@@@BB@@@@BBBB@@BB@@BBDB@BBBB@@BB@@@BB@B@@B@@@@BB@BB@@BB@@BBBB@@B@@BBAF@B@D@B@B@@@B@@@@@BB@B@@@@@@B@@@B@B@D@D@B@B@BAD@D@D@F@F@D@DAD@A@@A@@@C@@BE@@@@@A@AB@@@BABAB@B@BAD@B@D@D@B@HBF@D@FAACEGCECEAACAEAEAAAEAGEGCEGAC@ABABCDE@ABIBEBABCBCDADAFAB@D@DBBBDDB@DBD@D@BABADA@C@C@CCCACEAG@E@E@G@GBGBA@EBA@CAEACCEACCCCACAEAE@C@CAAAACCEAECGCECACAA@C@ABCDEBEDCBAHGHG@CCGAACCEAEAGAEACCCCAC@C@ABCDA@C@CAAACC@E@C@EAC@EAEAEAECAACC@C@A@CDCJGFEBADCBE@@BABEBABA@A@A@AAACAAAAC@ABABCBADC@ABA@A@A@AAAA@CAC@CAI@IAICCAE@CAE@CBCBADCBEBE@GAC@CACCE@C@IDGDE@E@C@E@IBE@EAEACA@ABE@EAACCEEIG[]GKISGCEAGCGCCA@A@@AABGBAD@F@FABABABC@ACE@ABEDEBCAEACAACCE@E@G@E@EAGACACCEECCAEECECEAGCICGEGEAGACAEBE@E@EACCACAC@CBA@GDIFEFEDCBEBE@EACAAAAA@C@C@CAAAACAECCAEC@C@CAC@ACCGECCAACCEAEEBAB@B@@@BAB@D@D@@@B@B@B@BBB@BD@@B@B@@@@@@@@@@@@AA@@@@A@@@@@@@@B@@AB@@@B@@@@@@@B@BABADCBCDEDCDEDC@@@@@@@ABA@@CECE@@AK@E@CAI@CBABCHCDABC@EAAGEAAC@G@E@CAEACC@A@ABG@A@AAACCEGAACAAACACAA@C@A@ABADCB@BABCBC@CAA@GCCCAA@AACBABADAFCHCDC@CACCCIEEAEAEBQBE@C@@BADDD@HADEDKDMFA@E@EBC@CDABADBHDFBBBBK@KCEAEK@E@CBE@CBCAAAGEG@G@ADEBADCFBF@HBLDLBH@JCFACO@E@A@E@ECEKKGOEEGOCECGCEACACCIAA@@AC@AAA@A@@AA@AA@@AAC@@@AAAAA@A@AA@@AAA@A@@AA@@@A@@AA@@@AAA@@@AAA@@@A@@AA@@@AA@@A@A@@@@@A@@@ABCBGBCBGBOACKOAAGGGOEMEICGGEKAMAIBABCBELEHCHGBGBEBGA"],encodeOffsets:[[126786,48389]]}},{type:"Feature",id:"230223",properties:{name:"依安县",cp:[125.306278,47.893548],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@DK@GBC@@@A@@BA@@@@@E@ABA@A@A@@@@@A@ABA@CBEBGD@B@D@B@B@@@HBF@B@D@FBB@H@H@FBH@B@B@B@BBD@H@H@LB@CDI@EBGBEBGBC@A@EBC@A@A@@A@AAG@E@GABA@C@CBA@A@ABC@EBA@A@A@@@@BA@@@A@@@A@@BC@A@@@CBA@A@@@A@@@ABA@C@@@A@@BA@CBC@C@@@ABA@A@A@ABC@A@A@@@A@ABA@A@C@ABC@A@A@@@ABC@C@ABA@@@@A@@@@A@@@@A@AA@@AA@@@@@@@@@@@@AA@@@@@@@@@A@@@@B@@@B@B@@@DAD@BA@@B@@@@E@@BC@A@C@A@A@@BA@A@@@C@A@CBA@CBC@@@@B@D@BBB@BA@@@@@A@ABC@A@A@ABABC@A@A@A@CBE@CBC@C@CBA@C@C@AB@D@B@D@@@F@HBH@DB@ABABA@@FCBADABADC@@BA@ALEB@@@FCDC@@@EAE@AA@@EBC@A@@@A@E@A@@@@@A@ABA@C@A@ABC@A@ABA@@@A@A@ABA@A@A@ABA@A@A@@AAA@AAA@AAA@A@@AAA@A@A@A@@BCBC@@@A@A@@DA@@B@D@@@D@D@B@@ABA@A@ABC@A@@AA@@AAC@I@IACACAACBEBKBIDE@A@@@@B@B@F@@@B@B@@@B@DBD@@@@ABA@EBC@C@ABI@ABA@A@@@@BOB@BME@WA@@@@@@BC@C@A@@@@A@@AB@@A@A@@@IBE@E@G@CBC@E@A@@A@C@AAG@A@A@E@AAC@C@CAC@C@A@A@@@IAA@A@@@C@E@CAE@@@A@G@CA@@@A@ADE@ABCBCBEBA@ABAA@EA@ABA@A@ABC@A@A@@@@BA@A@@BC@@@A@A@@BC@A@A@@AACA@@@IBGBEBEBC@E@E@CB@@A@C@C@@@@GAMA@@@@A@@@C@C@@AC@C@A@@IBGAA@@@@@ABC@E@A@A@A@A@C@A@AB@A@@G@IE@CAC@A@@@A@A@A@AAA@@@C@A@AAA@A@A@@@A@E@AAC@C@A@A@@@@AB@BA@ADA@ABABA@@@ABABA@@@@B@BABADAB@@A@A@A@A@ABA@A@ABG@CBE@A@A@AB@@C@AK@SCBIBK@@@@BA@@@@@A@@@A@AB@@A@@@A@@@A@@@ABC@A@@@@@@@@@@@@@@@CDM@C@@GAE@E@A@A@CAC@C@EAA@C@@@A@@@C@A@CAC@A@AAE@G@A@CAE@A@@@@@@@@@A@@@A@A@S@E@EA@@C@C@C@@@E@C@EAE@I@C@C@C@C@C@CAWAUAOAC@A@E@E@EAM@KAGAE@@@M@K@G@G@I@Q@Y@_BC@C@C@M@B@BHFHCHCNCLCNCL@BGV@B@@@BAPAJAFCTCRCTI@K@E@c@U@]@A@_@W@E@K@E@K@S@S@S@I@Q@@N@JU@O@Q@O@G@U@U@S@S@S@@@@@@@@B@@@@@@@B@@@@@@@@A@@B@@@@@@@@@@@B@@AB@B@@@BAB@@@@@B@@A@@@@B@B@@@@AB@@@B@@A@@B@@@@@@@B@@@@@@@B@B@@@@A@@B@@@@@B@@A@@B@@@@@B@@@@AB@@@B@@@B@@@@@@@BAHAFCHAHAFAB@DCFAF@BADAFAF@BAFADCFAFAFADAB@DCF@DADSEICAAA@C@@@ABA@@@@@@B@B@D@F@B@BBBABABCDAB@@AB@BAB@B@D@@@BAB@BAB@BABADA@@@ABABAB@@ABAB@@@BB@@B@@@B@B@B@DAB@D@@AD@B@B@BAB@B@BAB@B@@B@@B@D@JA@@@@@ABA@@@@BA@@B@B@D@B@D@B@B@BB@@@BBB@B@@@AD@BA@@@@B@@@B@B@BA@@B@@A@@@@@A@@B@@@B@B@@@B@BA@@@@@A@AB@@@@@@@B@@@B@BA@@B@@@B@@@@B@B@BB@@B@BBBBAB@B@D@B@@AD@D@DAB@B@DAB@D@@AD@@@D@B@B@BAD@D@BAB@D@B@DA@@BC@E@@@AB@BA@AB@@AB@@ABAB@@ABABC@C@C@A@A@@@A@CDABABABCBABABABA@ABAB@@AB@@@@A@@B@@A@@BA@@@@BA@@@@@AB@@@@AB@@ABAB@@@@AB@@A@@B@@@BA@@@@@@B@@@B@@@@@@AB@@@@AB@@@@AB@@@@@BA@AB@@@@AB@@@BA@@BA@@@@@AB@@AB@@A@@@@BA@AB@B@@A@@BA@@@@BA@@@@@AB@@AB@@@@@@AB@@AB@@@@AB@@A@@@@BA@@@@@AB@@@@@@AB@@@@A@@@@BA@@@A@@BA@@@@BA@@@@@AB@@A@@B@@A@@@@BA@@@A@@B@@A@@@@@A@@B@@@@A@@@@BA@@@@BA@@@@BA@@@@BA@@BA@@@@@AB@@ABA@@BA@@@@@AB@@AB@@A@AB@BA@AB@@ABAB@@AB@@A@@@@BA@ABA@@B@@CB@@ABAB@BA@@@ABA@@BA@AB@@AB@@A@ABAB@@ABABA@@@@BAB@@A@ABA@@DBBA@A@@A@@A@ECEA@@@@A@@B@B@@@@@B@@@B@@AB@@@@@B@@@BA@@@@B@@@@@@AF@DA@AFGHADCFA@AAA@A@CAA@ABABABB@DBFBB@EHCFC@MBGB@BBFB@@DDD@BAFCHAD@@@@B@@B@@@B@@@@BAB@BA@@B@@B@@@@B@@@B@B@@@B@@@BB@@BB@@BA@@@@AA@@@A@@@A@@B@@ABAB@B@@@B@@@@@CB@@@@@@@@B@@@B@@@B@@@@@@@@@@BA@@B@@@B@@B@@@@@@@BA@@@A@@B@@@B@@@@B@@@B@@@@B@BBB@B@@@B@@BB@@@@B@@A@@@A@@@@@@@@@BBB@@B@@B@@B@@@@@@@BAA@@@@@@A@@@@B@@@@@BB@@@BBAB@@@B@@B@@BB@@@F@@@B@@@@@ABA@@@BBB@B@@@BB@@@A@@@A@@@@@A@@@@B@B@BAD@@@B@@@B@@@B@@BB@A@@B@@B@@@BAB@B@@@BB@@B@@@BB@@@@@B@@@@AB@@@@B@@BB@BB@@@BB@B@JB@@BBB@@BB@@B@@AB@@@@@B@@D@BB@@A@A@A@AB@@@B@@BB@@BA@@@A@@@@B@B@@@B@@@@B@@CB@@AB@@@@B@B@B@BAB@@B@@@@@@@@AB@@@@@@B@@@@@B@@AB@@@@@B@@@@@@@@B@@@@BB@@@@B@@A@@@@@A@@@@@@@@@@@@BB@A@@B@@@@B@@B@@B@@A@A@@@@@B@@BB@@B@@@@AB@@B@@@BA@@BA@@@@B@@BA@@@@@B@@@B@@@@ABB@@@@@@@B@@@BB@@@@@B@@@@@@B@BB@@@BBB@B@B@@@B@@@A@@B@@@@@B@@@B@@AB@@@@@BB@@@B@@@@B@@@B@@B@@@B@@@@@BB@B@@@@@B@@@@B@@@@@B@@@@@@AA@@@@@@@@@B@@@B@@@@BB@@@@BB@BB@@@B@@A@A@@@@@@@@B@@@@A@@BB@@@B@@@@@B@A@@B@@A@@@B@@B@@@@B@@AB@@@D@@@@@@A@@@@@@@@@@B@@@B@@@@A@@B@@@B@@@@@@@A@@B@@@@@@@@@@B@@@B@@@B@B@@@@@@@A@@B@@@@B@B@@B@@A@@@@@@B@@@B@@@B@@@@@B@@@@@B@@@@B@@@@@@@@@@AB@@BB@B@B@@@@AB@@@@@@@@B@@B@@@@@BA@AB@@@BBA@@BB@@@BB@@@@@BB@@@BBB@B@@@B@@@@@B@B@B@B@@@@@@A@@@@B@@@@@B@B@@@B@@@BA@@@@@A@@@@BB@@B@@@B@@@@@B@@@B@@A@@B@@@@A@BB@@@@@AB@@@@@@BBB@@@@B@@@B@@B@@BB@@@@@@@@A@@AA@@@A@@@@B@@@@@@@@@@@@B@@@@@@B@@@B@@BB@@@@@@@@@B@@@@@@@@@@@@B@@@@B@@@@@B@@@BBB@@@BA@@@@@AB@@@B@@AB@@BB@@@@@@A@@@@@AA@@@@@B@@A@@@@B@B@@@B@@@@@B@@@@@AA@@@@@@@AB@@@B@@@B@@@@@B@@@B@BB@@B@@@@@@@@A@@@@AA@@@A@@B@@@B@@@@@AAB@@@@@B@@@BAB@B@B@BB@@@BB@@@@@B@@@@@B@@@@@B@B@@@@@@B@@@@@@@B@@B@@A@@B@@@@@B@@@B@@B@BB@@@BB@@@@@@@AB@@@@@B@B@B@@@B@B@B@@@@AA@@@@@@@@A@@B@B@@@B@@@@@@BB@@@BB@@@@B@@@B@@B@@@@@B@@A@@@A@@@@@BB@@B@@@@@B@@@BB@AB@@@@@@A@@@@@@A@@@A@@A@@@@B@@@B@@@BA@@@@@@@AA@@@@@@@@A@@B@@@BBB@@@BB@@@@B@@@@@@@B@@@B@BB@@@@@@A@AB@@@@@@@@B@B@@@@B@@@@@@@@A@@@@@@@@@@BB@@BA@@@B@@@@@B@@@BB@@A@A@@@@BA@@@B@@B@@@@@@B@@@@A@@@@@@B@@@@@@@@B@@@@@@B@@@@@@A@@@@@@BB@A@@@@@@DA@@B@@@@@@B@@@@@@@@B@@@@@@A@@B@@@@@BB@AB@@B@@@@@@B@@@@@@@B@@@B@@@@@@@@@@A@@@@@@D@@@@@@B@@B@FCBA@@@@@A@@@B@@@@B@@BAB@DAD@D@BAD@F@DAD@B@@@BAF@@AH@D@@@B@@@@@B@BAB@D@DABB@HDAD@DAB@D@BAD@B@@@BAF@@@B@@@@CBC@GBA@GB@@A@IBA@GBEBEBA@C@EBA@EBA@A@A@C@GAG@E@GAG@GAC@K@CAG@E@@@IAG@GAI@C@GAA@E@G@GAI@GAA@@B@BAD@BAB@DAB@FA@@B@BAB@B@B@BAD@B@BAB@D@@AD@B@BAB@B@BAD@D@BAB@@BB@@B@B@D@D@BBD@B@D@DBD@@@D@@@F@D@B@JAB@D@DAHAB@@F@DAF@HAB@HAD@DAB@BAH@DAF@DAD@DAF@BAHAHAD@FAFAF@DAB@D@BAD@BAJ@BD@B@H@@DAFCL@B@BB@@B@B@BAD@BAB@FBFAB@B@DAB@DAB@B@@@@IFCBGDK@E@@@A@A@C@@@@@C@@@KAI@G@E@@@@B@B@@@B@DAD@B@B@D@@A@@DAJAH@DF@B@H@L@L@B@F@B@N@RBR@T@@@F@H@J@D@F@F@B@J@J@H@LBD@F@JBH@D@F@F@DBF@B@@@NBH@RBH@PBH@NBD@F@J@FBF@N@@FADD@TAT@DAFALED@H@RAH@P@B@FAT@D@L@J@PAJ@@@N@J@J@B@H@RAL@L@D@D@@@@@B@@@B@@@B@D@J@D@F@D@D@@@F@B@@@@@@@D@D@B@@@@@D@@@H@LBB@F@F@F@B@FA@@@@B@B@B@D@B@D@@@B@D@B@@@B@@@B@B@B@F@@@B@B@B@B@@@B@B@D@B@B@B@B@B@H@FBD@@AD@J@@A@ADBFB@@@@D@J@L@LBL@L@J@B@D@D@BBB@D@B@D@@@B@@B@B@BJBBBDC@A@@BCN@N@RBB@B@F@R@H@D@L@XBL@L@@@J@D@B@BQ@ABCDYDW@ABA@E@C@A@@BA@CBE@A@ABA@@@@@A@A@A@@@ABA@A@A@AB@@A@@@A@@BA@@@@@A@@@ABA@@@C@A@ABA@@A@@ABCBC@A@BF@D@B@B@D@B@D@BBF@B@NBF@J@@@HBH@J@D@H@@@@ABC@A@A@A@@@ABA@A@@@A@ABAA@@@@@A@@@@@A@@@A@@BA@@@@@A@@@@A@AB@@A@A@@@A@@@CB@@A@A@A@@BC@A@A@@@CBA@@@A@@@A@@@A@@@A@@BA@@@@B@D@B@B@D@B@@@BBB@@@@@B@B@D@@@B@B@B@@@B@BBB@@@B@B@@@@@@@@@D@@@@@B@@@B@BBB@@@B@@@B@@@B@@@B@@@@@@@B@D@F@DBF@@@B@B@@BD@B@B@D@B@B@@@@@@A@@B@@A@@@A@@AA@@@ABA@@@ABC@A@@BA@@@@@A@@@A@@BA@@@AA@A@@@A@AA@@@@BAB@@@@@B@@@@@B@@@@@CA@@@@@A@@@A@@@@@@@AAA@@A@@AA@@@A@AA@@@@@@BA@@B@@A@@@@@A@@B@@@@A@@@A@@@A@@@A@@@A@@@A@@A@@@A@@@@@@AB@@A@A@@AA@@@@@@@AB@@@@@@A@@A@@@@@@@@@BA@@@A@A@@BA@@@@@@@@B@@@@@@@BA@@@@BA@@@@@@@@A@@@@@@A@@@@@@B@@@@@@@B@@@B@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@AB@@@@@@@@@@@A@@A@@DAB@B@B@@@BA@@@A@@B@@@BBBB@@B@B@B@B@@BB@@@B@@AA@@@A@@@@A@@B@@@BA@@@@A@@@A@@A@@@@B@B@@@@A@@A@A@@A@@@A@@@@@@B@@@B@@@@B@@B@@@B@B@@B@@@@@BA@@@@BB@A@@B@@@@@BB@BAB@B@BABA@@B@@@BB@@B@B@B@@@BAB@@@@@AAAB@@AAA@@@@AB@@@B@@@B@@BB@B@@@BA@@@@D@@@@@@@@BA@@@@B@@@BB@@@B@B@@@@A@@@@BA@@BB@@B@@B@@@BB@@@@@@B@@@@A@@@@@@@A@@@A@@@@@@@BB@@B@@@@BB@@@@@@@A@@@@B@@@@@@B@@B@@A@@@A@@@@B@@@@@@B@@@B@@@B@@@@@@B@@@@@B@@A@@B@@@@@@BB@@@@B@@@@@@@@@BB@@@@B@@@B@@@@@B@@@@@@B@@A@@@@B@@B@@@B@B@@@BB@@B@@@@BA@@@@@@@@B@@B@B@B@@BBA@@@@@@@@AAB@@@B@@AB@@@@AD@@@@@@@@@A@@@@@@A@@D@@@@@@@@@A@@A@@@@@AB@BA@AB@@@BB@@@BB@@@B@B@@@@@@@@B@@B@@@B@@@@@@@@@BAB@@@B@B@B@@@BBBB@@B@@@B@@A@@@@C@@@AA@@@@@@B@@@@@B@@@B@@A@@A@@@A@@@A@@@@A@@@@@AB@@BB@@@@@B@@A@@@A@@@AB@@@BA@@@@@@@@@@@@@@@@@AB@@@@@@@@@BA@A@@BA@@@@@A@ABA@A@E@EBA@A@CBA@A@GBABADAFBD@D@D@D@D@FABBB@FBBABA@@DAD@@@DA@@@@@ABA@A@@@A@AB@@A@@@A@@@A@@@@@AB@@A@@@A@@BE@ABA@@@A@@@A@AD@@A@@BA@@@A@@@@@A@A@@@A@AB@@@@A@@@A@@@A@@@A@@B@@AA@E@EAI@I@EAE@IAG@@@@@A@@@@@A@@@AAA@A@@@A@A@A@A@@@AA@@A@@@A@A@@@A@A@@@A@C@AA@@C@A@@@@@A@C@IAOASAQAE@@@@@A@A@@@@@CAG@C@GAK@IA@@@@A@A@A@@@A@@@@@A@@@@@QAC@C@IAMAIAE@G@E@@@A@@@A@@AA@@@A@@@@@A@@@A@@@@@A@C@C@CAC@E@A@C@A@@AA@A@A@A@A@GAE@A@E@A@AA@@AB@@@A"],encodeOffsets:[[128525,48976]]}},{type:"Feature",id:"230221",properties:{name:"龙江县",cp:[123.205323,47.338665],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@AAA@AA@AACA@@@C@A@A@@@AA@@BC@@A@CBA@A@A@@@@B@@BD@@@B@@A@A@A@@@@A@AA@@AA@@@ABA@@@A@@@@A@@B@B@@A@@@AA@@@E@A@E@@@@@AB@@@@BD@@@@A@@@A@A@A@@@@@@B@@@BA@A@A@@@AA@@BA@A@@@@A@E@A@A@@@@B@@@@DB@BAB@@@@BB@B@@@BA@A@A@@@@A@@BA@@@AA@@@A@@@@@AB@BA@@@A@@@@AAA@@BA@@BA@BD@@@@A@@@@@@AAAA@@A@A@@@AAA@@@@@ADAB@BA@@A@@@@@C@@@A@A@@AACBAAC@A@@@A@A@@@AAAC@@A@@AI@C@AACGC@ICEAAAA@C@A@EAAAC@A@A@AAA@CAA@E@A@EBABCAABEBEBC@C@YF@@AF@FADAFABADEDCBCDGBADCBGFGFIFIFCDABABE@CDGHABCBABC@ABA@EBEBGBA@@@EAIBABKDCD@@OFBC@AAAEGBEDOFaPBNBHBF@B@JBVBTBLBD@B@BABABAB@BAFCDCFCDCFCHCDABABABABABGBA@E@C@E^E@@B_BYOg@ACE_KWAEE@AB@@AAA@C@@@C@ECA@A@C@C@@@A@@@@A@@CA@AA@AA@@A@ABA@@@A@AAA@@@AC@@A@AA@AAA@@A@@@GDA@@B@@@B@B@@@@BBB@@@@@@BEBABA@@BB@BB@BBB@@@@AB@@A@AACAA@@@AB@@@@@B@@BBD@BBB@@D@@A@AB@@A@@@@AA@@AA@A@ABA@E@A@@@A@@@@BD@@@@B@@@B@@B@D@@@@@B@@B@BA@AB@B@@AB@BA@@B@@CAA@@AA@A@@BA@AB@@@@BBB@@@BB@B@@@B@@C@@@@@AC@@@AA@@@A@@BB@@B@@C@@@@DA@@BA@@AA@@AA@@@@@A@BB@@@B@B@BAB@@A@CC@@A@A@AB@BA@ABABEB@@AAA@CAC@AAAA@@@@BA@A@@@AC@ACA@@@A@A@@B@@BBAB@@A@@@C@C@A@EBCBA@A@@@AA@A@A@@A@A@A@@BCBCB@@@@A@A@@A@@@ABA@@@@A@@@A@AB@@A@CCCAAA@AA@A@@@@@@BBB@@@@@BA@@@AAA@A@C@ABEBC@A@CAA@A@@@@B@@@B@@BB@@@B@B@@A@AB@A@@@@@A@@@@@@@AA@C@AA@@@A@@EA@@CC@@@@@A@@B@@A@@@A@@AA@@@BA@@BA@@@@AA@@AA@A@A@AB@@A@A@@A@@@A@@@A@@DBB@@@BAB@@@@@AAAA@@@A@@@@@A@AA@@A@@BA@@CC@@@@BA@A@@A@A@@@@AB@B@@@@AA@ABAA@@@@BAB@@AA@A@@@@@DABC@@AC@ABAB@BAB@@@@A@A@@AA@@BA@@B@@@@A@A@@CCECAAA@A@ABA@@@CAA@@@@AB@HG@@@AA@@@A@AB@BA@@@AAA@AEA@AAC@@AA@@AB@@@B@B@@@B@@AA@AACC@@A@ABABAAA@@A@@@@@@@A@@@@@A@@@@@AA@A@AAAA@ABAA@AACAAACEEEAC@CAABC@CBA@AAAAAA@A@@@AA@C@@BA@C@AAACAAAA@A@AAAA@A@A@@@A@@B@@@DA@@@@@AAABABC@@AAA@A@AA@A@AAAAC@AACC@CC@@CAA@AAA@@B@@C@A@A@CAE@CBA@A@@@@@CB@@ED@@@@A@C@AA@@AAAAAAA@@@@@ABA@@@A@@AA@@A@AA@C@AAC@AAA@EBEBA@C@A@A@@@A@AAA@@@C@AAA@@@EAA@A@CBA@CBC@A@A@@@@B@B@BABABA@A@A@CAA@AA@B@B@BA@@@CAA@ABADA@A@@@A@AAA@@AA@AAA@A@A@@BA@A@A@AA@@@@BA@@@@@@AA@@@@BA@@@A@@@AA@A@ABCBA@A@ACACA@IAC@AAAAAAAAA@A@AAC@A@A@@@@@A@@@GAE@A@AA@@@AB@BA@ABA@@AACACCA@A@C@ADE@A@E@A@@@ABCDCDA@@D@@ABE@A@@BAB@@@F@B@DAB@B@@CFCDCBEDAB@B@@@BB@BBB@BB@@@B@BDLAB@BA@ABEBEBOFIBGDEBA@@B@@@B@BABA@E@CBA@CFC@EBABCBABG@C@ABCB@BAB@BABA@A@A@AACCAAEAA@AACB@@@D@B@B@B@@@@BB@BAH@DABAB@@C@CB@@@B@BAB@B@@ABA@C@KDEB@BBD@FBH@BAB@DEBA@@@ABCDCD@B@B@D@H@@@@@BA@@D@@DB@B@@BB@@@B@B@@@BA@@B@@DF@B@BAD@@@BA@CD@@@BFBANKPGHABADA@ADADA@@FGP@B@@D@BBVBAB@D@B@@DLDL@@DN@@BB@JABA@CBAB@B@FBB@DBB@BDFBD@D@BAB@BAD@@@DBD@D@@@B@@CDAD@@A@A@BBB@ADOECBCAI@HGAAAA@@KLCC@A@A@@CAECKEA@@@@BAAECB@CCAB@ACACCAAA@A@CAA@ICA@C@MD@@IAG@AAC@E@ABC@A@E@I@CA@@@BA@@D@@A@@@A@@@AAAAA@@@@BAB@BA@BBG@BPFFDDBF@@YHDBGD@@@@@D@@ED@@CDCDCD@DBF@TNBABAD@BAF@DAF@@@BA@d\\FD@BB@B@@@@DB@B@@B@@@@BBRNhTFBHFBBD@HDB@@B@@@B@D@D@@@BB@@@FDHDBBB@@B@B@DAFADAB@F@BA@@BAAAAAAA@A@AAC@@@A@A@A@IA@@A@@AC@C@A@C@GB@@A@AA@@@AA@A@ABA@CBA@CB@@@@@@@B@@@B@@@@ABA@CAA@@@A@BBA@@B@@ABA@@B@@ABA@@@AA@@AAA@A@ABCB@@CBAB@AC@A@@@CBA@@@A@@@@A@AA@@@CBA@G@A@EBCBC@EAGA@@A@A@A@@@ABEDA@@@A@A@C@A@A@AAA@@@C@@@ABBB@@@BA@E@A@GB@@B@@@@@@B@@BB@@@@@@@B@B@BA@@@AB@@EB@@AAA@AB@A@@A@CB@@C@ABA@AB@@CB@@A@A@A@AB@@@@@B@@AA@@@A@@C@@@@@@@A@@@@@@A@@A@@AE@@B@@@BB@B@@B@@@@@@A@@@@@@@@B@@A@@@@A@@A@@AA@@@A@@B@@@@B@B@@@AB@@AB@@A@@@A@@@@@@@@B@@A@@@@AA@@A@@A@@B@@B@@BB@@@@BC@@A@@@@A@@@@B@@@@AB@@A@A@@@@@@@A@AA@@@@@A@@@@A@A@EBA@@@@@@AB@B@@A@@@@A@A@@B@@A@AA@@@@@@@@@AAB@@E@C@A@EA@@A@A@@BABAD@@@@A@AA@@A@@BA@@BE@CBADAB@@A@@@@@@BAB@@BB@@A@@@A@A@ABA@@@A@B@@A@@@@A@AAA@@@ABA@ABA@A@@BA@@@@@@B@@@@B@@@A@@@BB@@@@@@@@B@@BA@@BA@@A@@@A@@@@A@@@ABB@@@BB@B@@A@@@AAA@AB@@@@@ABA@@@@A@A@@@@@@BA@@@ABA@@@AAB@@@@AB@@@@AA@ABABA@@@@@@@@@@B@@@B@B@@A@A@@@A@AB@@A@AB@@A@@B@@@@B@@@@@@BBB@@@BA@@B@@@@AB@@A@@@BAA@@@@@A@@@A@C@@B@@@B@@B@@@@@@BC@AAA@D@@A@@@@@@A@@@AA@@A@@B@@A@@A@@A@@@A@@@@@@BB@@@A@A@@BBB@@@BA@@@@A@@@@C@@@A@B@BB@@@@@@@BA@@@AAA@AAA@@@A@@B@@@@@BB@B@@B@@@@A@ABA@@@@B@@C@@@@BB@@@@@A@@B@@@@B@@B@@@@@BA@@@@B@@@B@@D@AB@@B@@@B@@@@B@@C@@@@@@B@BA@@@A@A@@BA@@B@@A@@@@@B@BB@@A@A@@@A@@@A@A@AA@@A@@@BB@@@@A@@B@@A@A@@A@B@@A@@B@@B@@@D@@@B@A@AB@@B@B@@@@B@@@@A@@@@B@@@@A@@B@@@@@BAAA@@@@@@@AA@@@B@@@B@@B@@@@BA@@@@@@@@BB@@@@@@BB@@@@@@@@BA@A@A@@@B@@@@@ABA@@BB@@B@@@@A@@AAB@A@@A@A@@@B@@ACA@@A@@BABA@EB@@A@@B@@@B@@A@A@A@A@@@@B@@B@@@@@@@@@@B@@@@AB@@@AA@@@A@A@@@@@A@@@@B@@@B@@A@@B@@@@@@B@@@B@@B@@CB@@@AA@@@ABA@@@@@B@@@@B@BC@A@@@AA@@A@A@@@@B@B@@@@@@@@BB@@@@A@@B@@AA@BA@@A@@@@@A@@@@A@@@A@@@AB@BB@@B@BA@A@@@@B@@AB@@AA@@AB@@@@A@@AA@@AA@@BA@@@BB@B@@@@@BA@@BCAA@@@@@AB@DA@@B@@C@@B@@@@@BB@@@@@@@AB@@C@AB@@@@@@BB@@B@@BA@@@A@@@@B@@A@@@A@A@@@@BA@@@AB@B@@A@@B@@B@@@@@@@BB@@A@@@@BA@@@B@@@A@@BA@@@AA@@@@AB@@A@@@@@ABB@A@A@@B@@@@B@B@@BA@ABA@@BA@@@@B@@AA@@@@@@@@A@@@BB@@@@BB@@@@@@B@@BDB@@@BA@@@@BAA@@ABA@@A@@@@A@@@@B@BDB@@@@AB@@B@@BA@@BA@@@@@ABA@@@A@AB@B@BA@@@@BB@@@B@@@B@BB@@@BA@A@@@AB@@A@A@@@@@@B@@B@@@@B@@@@@BAA@@@@@@@B@@A@@@@@A@@@@BB@BB@@@AB@@@@@B@@@@B@@@@ABA@@@@BA@@AA@C@@@ABAB@@B@@BB@@A@@@B@@@B@@@@AB@A@@@@A@@@@@@@@BA@@B@@B@@B@@BB@@@B@@A@@@A@A@@@@@AA@@A@AB@@@B@@A@@B@@@@B@@@@@@@BB@@A@ABAAA@@@@AAB@@@@@@@B@@AB@@B@@@@@B@@@B@@@@@@B@BA@@AA@@@@B@@A@@@A@@B@B@@@BB@B@@@@B@B@@ABC@@@@B@@@BA@@BA@AD@@A@@@A@A@@@@B@BBBA@@@A@@@AB@@A@@BA@@BB@@B@@A@A@@B@@A@A@A@A@A@CB@BA@CDC@EDC@@BA@CD@@@@@D@@@BA@GD@@@@A@ADABAB@B@@@@@BC@GF@@@B@@AB@F@@@BBB@B@@CDAB@BA@ABB@B@@@B@@@@B@B@A@@AB@@@@@B@@A@@B@@@@@@B@@@@B@@B@@@B@@BA@@@@@A@@@BB@@@@@@A@@@@@@B@@@@C@@@BA@@@@A@A@@@@B@@B@@@A@@B@@BB@@A@A@@B@@@@@@B@@@@B@@@@@B@@A@@A@@A@@BA@@@A@A@@@A@A@@A@B@@@B@@AA@@@B@@@AA@@B@@B@@@@BAB@A@@C@@@AA@@ABB@B@@@@BAAA@@B@@@B@BAA@B@@@BAA@B@@@@@@A@@@A@B@@B@@@@@@@B@@B@AB@@A@@A@@@@A@@@BB@B@@A@@@@A@@@AA@@@@@ABA@A@B@@@B@@@AB@BA@A@@B@@B@@@@@@@BBB@@@A@A@A@A@@B@@B@B@B@@@@BA@BB@@@@@BA@@@C@@@@@BB@@A@@@@B@B@@@@AA@B@@@@B@@@@@@B@@@BA@@@@@@@@@A@A@@B@@@B@@AA@@@@AB@@@@BBB@@@@@A@@B@@A@@AA@@@@BB@B@@@@B@@C@@@@@@@C@@@BA@@@@C@A@A@@@BB@@@@@@A@@@A@A@A@@@@@BB@B@@@BADAB@B@L@N@@@@FDBBB@BB@@DD@@FDDBDBFDB@@BB@@@BBB@BDD@DDB@@BDB@@FB@@@@BBDBB@BBBB@@DBB@BBDB@@BBB@BB@@B@BBBB@@B@BB@@@@@@B@FDB@BBDBB@DBBBDBBBDBB@BBD@@BB@BBB@BBD@BB@@@@@@B@@BD@BBB@@BDB@@B@@BB@@@@@@BB@@@@B@@@@BB@@BB@BBB@@BB@B@@BB@@@B@@@B@@@BB@@B@BBB@@@@@@@BB@@B@@@@@B@@@@@@@@@B@B@@@B@D@BBB@B@BBB@B@B@B@@@@BB@B@B@B@B@DBB@B@BBB@@@B@B@@@@@BBB@@@@BBBB@@@@@@@B@@@@@BB@@@BBBBBD@BBBB@@BBB@BBBBB@BB@@B@@BB@@@@BB@BBB@BB@@B@BB@@B@B@B@B@B@B@B@DBB@B@B@B@B@@@BBB@B@B@BBB@BBB@BBB@B@@@@@@BB@@@@@@@B@@BB@BB@BB@@@BBBB@@B@@BB@@DBBDBBBB@BBBBDB@@BB@@BB@@BBDBBBB@B@@DFBDJLBB@BB@DFBBB@BBLHB@@@BBRLB@B@BBTHDBD@HDB@FBVHBBDBDBD@BBHDB@HDB@DBDBFBFBDBFBFBFBB@@@@@BBD@DBHBB@FBFBLDHDFBNFBBTHB@XJVJFBDBLFTHB@@@B@B@@BD@BBB@BBB@@BBBBBBBB@@@B@BBBB@@@@@@B@@@DBDDB@@@BBBDBBBBBB@@BBB@@@HFDBBBDBDDDBDDB@BBBBBBBBB@BA@@B@@@@AB@@@@@@@BA@ABA@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@A@@@A@@B@@A@@@@@@@@B@@@@@@@B@@@@@B@@@@ADADC@A@@AA@@@A@@D@B@@@BA@@@AAA@@@@@@@EBA@A@@@AA@@AAA@@@A@ABA@A@ABA@A@@@A@@BA@@@@@AB@@@B@B@BA@@D@DABA@@BABAB@@@@A@@@@@@@AAA@A@@AA@@@@AAA@@AA@@@BA@@@@@A@@@ABA@@BA@A@@@@@A@@BA@@@A@@BA@CAC@A@@@A@A@A@C@A@C@@B@B@BA@A@@@AAAA@AA@@@AAAB@@AB@DADC@@BAB@B@@@@@B@@@B@B@@@@@BACAAAAAA@@AA@@AAA@@CCAA@AAAAAAA@@@C@A@AB@@A@A@C@@@A@A@@BA@C@A@@@AAA@@@A@A@CBABCDGDCDCBCBA@@D@DBB@B@BAB@BA@@@A@A@ABABA@AAA@AA@@A@@@AB@BABCDAB@BAB@B@D@B@DAB@@@@A@@@ABADA@@@@BABADCBAB@BA@@B@@@B@@@BABABAB@DABA@@DAD@DAB@DABA@ABC@A@AAAAA@CB@BCDEBADABA@A@AA@BCBABCBAFAB@D@D@BAB@BA@A@@CA@@BAABA@A@A@C@AAA@A@BABABABABA@ABABADAB@B@B@@@@@@@B@@@@@@@BCDCB@@A@@@@@@@AA@@@@@@AA@@AAA@@@@A@@@@@@A@@@A@@AAB@B@H@D@D@HBD@@@@@@@@@@BB@@B@@@B@@DBDBB@DDBBBBDDBBBFB@D@B@B@BBB@B@@BB@B@B@BBBB@BBB@BB@@@@@B@D@@@B@@@BBB@B@BAD@@@@@B@@BABHBABCBEBDB@@@B@B@B@@A@CBABCBCBEDDBFDB@@BA@@@A@@B@@@@@@@@@B@@@@@@@@@@B@@BB@@B@@@@BAB@D@@@@@@@FAD@B@B@B@BABB@@B@@BD@@@B@BBFB@BB@DAF@B@J@D@FBAB@@AB@B@B@B@BA@@BA@@BA@@B@@@@@B@@@B@@@BAA@@A@A@A@@@A@A@@@B@@BB@@@@@@B@@E@@@A@@B@@@@E@ADADB@@@@@@B@@BAB@@BDBA@@B@@A@CBA@@@EBGDAB@@A@@@BBABGAC@@@AB@BAB@B@BAB@@@B@B@@@BB@@@@B@B@@BDDFBD@@@B@@ABA@ABA@ABA@AAA@AFAD@DAD@@AB@@@B@@@B@@@@@BABAD@@@BB@DF@@DBFBBBBB@@@BBD@@@B@B@@@B@@@BA@@BA@@@B@BB@BBBCB@BEHCF@B@D@BAB@B@B@@BBBBHDDBB@B@@@FAHAB@B@@@@BB@DBB@B@@A@AB@@AB@@@B@@ABADABAB@@@@@B@@@B@BBB@D@D@B@B@B@@BB@@@@A@@@@@@@A@@@@@@B@@@@@B@@@@@B@@AB@B@@@@@B@@@@A@@@@B@@@@B@@@@@@BBB@BAB@@BB@@B@@B@@@@@BA@@@@A@@A@@@@@@B@B@B@@BB@B@B@@@@@B@AB@@BB@@BB@@B@FAB@@BB@A@@@A@A@@@@BAF@D@B@B@BB@BBDB@@@B@DD@BCB@AB@DBB@DLBF@BGDBD@BBD@B@B@B@B@DBDBB@@B@@@BB@DAB@B@@@@AB@@BDBD@@@BCA@EAC@@@@@@@@A@@@@@A@@@@B@@A@@@@@ABBB@B@BBB@D@FBD@BAB@@BB@B@DBB@@@@@B@D@B@B@BE@CBBD@B@AB@B@@D@D@DBD@@@BBB@@BB@B@B@B@B@BB@@B@B@@@@@@@B@@@B@@@@@@@B@@@@@@@B@@@B@@@@@@@@@BBB@B@@@B@B@@@B@@@B@@@B@@@B@@@@@B@@@@@@@B@@@B@B@@AB@@@B@@AB@@@B@@@BA@@@@@@B@@@B@B@@@B@@@@@B@@@@AB@@@@@B@BA@@@@B@@@@@B@@@@@BA@@@@@@B@@@ABAF@@B@B@B@A@@B@B@@@B@@@@@@@@@@@B@@B@@@@B@BA@@@@B@B@@@B@@AB@@@@A@@@AB@B@BAB@@@B@@@@@@@BA@@B@B@BAB@B@B@@@BAB@B@@@BA@@@ABA@A@ABA@C@AAC@A@AB@JAF\\@@DB@B@BD@B@BA@ABAB@@@BABD@BB@B@@@B@BAB@BD@HBF@F@HBD@B@B@@@B@@@@@BA@@B@@@BAB@@@@@@D@BB@BC@A@AB@@@BB@B@BAB@@F@DBD@D@B@DB@E@A@EB@@@BA@@@A@AB@@AB@@@D@DBB@@@@B@B@BB@@@@D@B@BB@B@@@B@@B@B@D@@@@DBJ@FBF@FBBAFBF@RBD@JBF@LBF@N@FBD@@@B@B@B@@@@@BB@@B@@@B@BAB@B@B@@@B@@B@@@@@@B@B@B@@@B@@@@@B@FBBA@@BBD@B@F@B@@@D@DBD@B@B@B@B@B@D@D@B@D@B@B@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@BBB@B@F@@@B@B@B@B@D@B@BBD@@@D@B@@@F@B@B@BB@@F@B@B@B@@@B@D@BB@@B@@A@@@@@@@A@@B@B@D@@@B@@@DBD@@@B@B@B@B@B@B@@@B@BA@@@@B@@@@AB@B@B@B@@@F@B@CCKKCKBIHGNGHAPANCFADEBGGQAIIWM_ESGKCEGMGMEICOAKB_BGBi@A@UAOKOKWDATARINBD@D@@BAFB@HDDBABPDDDHDJCHA@BHFNF^PLHBBDF@FFJ@LVHTFJFHBDF@BFDDH@BADAHJHNFBDBD@BAHcJAJBFFBFJXLVLB@RMDGH@@CF@N\\HHHAJH@FCFHJBBFDNFJ@JB@DDFDFb@FBZIRERGHAFABCFCFGBABA@AB@@A@A@A@A@@@@@A@A@ABA@@@A@A@@@A@@@@@ABA@@@@@@@@AA@@@@@@@@A@@@A@@@A@@@@ABEBCC@IAIAC@KAEAA@@@@@@@A@@@@@A@@@A@@@AA@@@@A@@@@@A@@@@@A@@@@@@@AA@@A@@@A@@@@AA@AA@@AACAA@A@A@C@@A@C@@AE@A@C@C@AAC@@A@CAA@A@AACAA@AAAA@@AA@@@@@AA@@@@AGECA@AA@@AA@AACA@A@@CAAA@ACAAAAAA@@@@@BAD@@@B@DBB@B@@@FAFAJCDADA@@BABA@@@@B@@AB@@@B@@A@@B@@@@@@@@@@@DCHCFEDCFC@A@CA@AAAAA@@C@A@A@A@A@C@A@@@@CACAA@C@A@CA@@@@DEBADGDG@@DE@@@ACCAAAA@ACCAECG@@@ABEAABA@A@A@A@E@E@EBE@C@A@CBA@C@E@C@A@A@A@A@@@C@@AA@@AAA@@@AACAA@AAAAA@@ACA@@AAAAAAAC@@AA@C@@AA@C@@@A@A@@@A@ABA@@@A@AAA@E@EAEAI@C@CABA@ODG@EBAAIGCACCAAA@G@AAQEGACDCDC@@@@EBE@C@A@@@@C@@@A@@BC@EB@A@AA@@A@@@ACBEDA@@@@BA@@@AB@@@@AB@@A@IAEAIAC@MCGAE@IAE@A@EAI@C@GAG@GAG@EA@BA@ABA@A@A@A@CAA@A@@@AA@AAA@@@A@@@A@@BADCDAJEHEHCFCFCFCJGLEBABGBKBI@CBKBCF@H@BG@C@@@@@@@@B@B@B@@@BB@@B@@@@BB@DDFD@B@@B@@B@@BA@@@AAA@CAGAC@A@CAA@A@@@A@@@@@@BABABCFGFGDE@@D@D@D@DAD@D@D@D@B@B@BAD@B@B@B@DAH@BAF@@@D@BA@@B@@@@@@@@@@A@@B@@@@@B@@@B@B@@@@A@@@@@A@@A@@@@A@@B@@@D@@@B@B@B@BA@@B@B@@@B@@@@A@@@A@@AA@@@AA@@A@@@@@@B@@@@@BA@@B@@@BABA@@@@@A@@A@@@A@@@A@@@A@@@A@@A@@@@@@BA@@@A@@@@@@@@A@@@@@A@@B@@@@@@A@@@@@@AA@@@@@@AA@@@@A@@@@B@@A@@B@@@B@@@@@@@@@@@@A@@A@@@@@@@@A@@@@B@@@BBB@@@@@BA@@@@A@@A@@@@@@@AB@@@BB@@@@B@B@@AB@@@@AA@@@@A@@A@@@@@@@@AB@@@B@@@@@@A@@@@A@@@A@@BA@@@@@A@@A@@@@@@BA@@@@B@@@B@B@@@@@@@@@@@@@AA@@@@@@A@@@@@@@B@@@B@@@B@@@@@@@B@@@@@B@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@BA@@@A@@@@B@@@@@B@@@@@B@BA@@@A@@A@@A@@A@@A@@@@@@@A@@@@@A@@BA@@@@B@B@@@BB@@B@@@@@@@@@@@@A@@@A@@A@@@A@@@@@@@A@@AAA@@@A@AA@@A@@@A@@B@@@BA@@B@B@@A@@@AB@@@@AB@@@B@BAB@@@@AB@@@@A@@@@@ABA@@@@@A@@A@@@@A@@@@B@@@@@B@@@@@@A@AAAA@@A@AA@@AA@A@AAC@A@A@@@A@ABA@@@@@@A@@@@@A@@@@B@@@BA@@@@A@@AA@CAA@@@AA@@A@@@@@@@@@@@B@@A@@@@@@@@@AA@@@@@@@@@A@@@B@@A@@@A@@@@BA@@@AA@@@@A@@@@@@@@@@@@F@D@@A@@@@@@@A@A@@@@A@@@AAA@@@A@@B@@A@@@@A@@@@BA@@@@BAB@BA@@@@@A@@@@@@@@A@@@@AA@@@@A@@@A@@@@@@@@@@@A@@A@@A@@@@B@@A@@B@@@@@BB@@@@B@@@@@@@@@@A@@@@@A@@@@@@B@@@BB@@B@@@B@@@@@@@@ABA@@@@BA@@@@@@@A@@A@A@A@CAA@@@@A@@@@@A@@@@@@@@@A@@B@@@BA@@@@B@@A@@@@@@AA@@@A@@@@A@@A@@A@@@A@@@A@@AA@@@A@@@@@AA@@A@@B@@@@@BA@@@@@@@A@@@A@@@@@@@A@@A@@A@@@@@@@A@@@AB@@@@A@@A@@@@@@@@@A@@@@@A@@@@AA@A@@@A@@BAFA@AB@B@@@@@BB@@BB@@B@@@B@@AB@@AB@@A@@@@@AA@C@ICMCCAOCAAGAA@@@@@@A@@B@@@@@BA@@@@@@@A@@@@@@@A@@@@@@B@@A@@@@B@@A@@@@BA@@@AB@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@@@BA@@@@@A@@@@@@@@B@@@@@B@@@B@@AB@@@B@@@@@@@@@B@@A@@@@@@@AB@@@@@@@@A@@A@@@@A@@@@@@B@@@@A@@@@@@BA@@@@@@B@@A@@@@@@@@B@@@@B@@B@@@@@B@@@@@@@@@@@@@B@@A@@@@@@@A@@@@@@@@@A@@@@@A@AB@@AD@D@B@@AB@@AA@@A@AB@B@BA@@B@BBB@DBBABADA@A@A@A@C@ABABAB@@A@@@@BA@@@@@@B@@@@@@@B@@A@@B@@@@@@@@@B@@A@@@@B@@@@A@@@@@A@ABABCB@DC@@B@@@BBB@DB@@BBBB@@B@B@@@B@B@@ABC@A@@B@B@@ABB@@B@D@BBBAB@B@BAD@B@DBDB@@B@@@BB@@@@B@@A@@B@B@@@B@@A@@@@@@@BB@@@@@@@@BB@@@B@@@B@BBB@@@B@@@B@@@HKJGLG@CBEBI@AAG@O@K@EAEAEACEAAAAEAEACEAECC@EBEDEBADCF@DCDA@E@CAECCAAAGIKOEGEI@CAA@@@A@@@A@@@@@@@A@@@@A@@A@@@@@@@A@@@@@@@@AA@@AA@@A@@@AA@@@@A@@@@@A@@@@@A@@AA@@@@@@@A@@@A@@@@AA@@@A@@@@AA@@@@@@@AA@@@@AA@@@@@@@@@A@@A@@@@@@@@@@A@@@@@@@@@@A@@@@A@@@@@@@@@@AA@@@@@@@@@@@A@@@@A@@A@@@@@@@@@A@@@@@@@@@@@A@@A@@@@@@@@A@@@@@@@@@@@AA@@@@A@@@@@@@@@A@@@@@@@@@A@@A@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@AA@@@@@@@A@@@A@@@@@@AA@@@@@@AA@@@@A@@A@@AA@@@@AA@@@@@A@@@@@@A@@A@@@@@@AA@@@@@AA@AA@@@@@@A@@A@@AA@@"],encodeOffsets:[[126492,48087]]}},{type:"Feature",id:"230224",properties:{name:"泰来县",cp:[123.416631,46.393694],childNum:1},geometry:{type:"Polygon",coordinates:["@@CFCFCDMV_jOVCDQR[ZEFKJGHEDGHEFEFCF]`KNKLKNABwCBMPSV@@@@AB@@@BADADABAF@@AB@B@@BB@@DB@B@B@DBD@B@@@@AF@HB@B@@B@\\@R@b@@@B@B@Z@N@BCX@DAF@BGb@FABBB@D@B@@@@AP@@BBD@DBF@H@JBB@B@J@D@XDX@DBAL@DAD@J@@CN@@AJAB@HCL@@D@@@BB@BF@HBD@H@D@JBF@D@FBB@B@@@DU@A@EBA@AFQB@@ABG@@@@BAB@^DGTTBtHD@L@^FD@PB\\D@@D@@@DCDAFEBABA@AVOJKDBDEB@DBB@DC@@B@BBB@@BA@DBFBHCDCD@@BBBB@DBDB@@JDB@B@@@B@B@@BA@@B@@@B@@BB@BCJD@FBFBLD@@BBJDJBDBFBNFBB@FAF@DAL@B@DAJ@D@DB@BB@BED@@AB@D@@A@A@A@AB@@@B@@BBBBBB@B@B@B@@@BB@BB@@BB@BB@F@@@ZDH@B@PAX@DBTHFBL@DBD@BBPB@@BBFDVDBDFfDB@F@RBtDJ@B@@@@BB@@@B@B@FC@@B@@@B@@@B@@@@BABB@@@t@d@RBB@B@D@^@jB\\@V@J@D@@BB@BHHPFLFN@BDFBD@BBBB@BBD@BBFBDDB@BD@@@D@B@BAD@BADAHAB@@A@@B@BBBALAF@HAJ@BB@L@CN@HCRAD@FBBB@@@@@AD@JAN@@AB@B@B@BB@@@B@BBBBB@@@@B@B@DABC@K@S@U@G@CBE@ABC@CBADCBCDADAD@D@@@BDBNDD@LBF@FBHBB@FBDBJDLJDF@D@BCBEDIDCBMDABGDADCD@B@D@DBDBBDDDD@BDFBFBD@HADCBEBE@E@EAE@G@ABEBA@CBAAAAAAAA@ABG@MAA@E@A@@@A@AA@@ACACAACAAAA@A@A@CBCBCBABCDE@ABE@C@AACACCI@AAECCAACACAAAE@C@C@E@MBGBE@O@Q@KAEAGBG@CBABGDGFCDA@CDCBABCFCFAB@BBBAJBF@BDHBDFFHHDDBDBDBRDJHJBBDD@@BDBHDJ@BDD@BBBNPBDBBBD@D@F@FFLBF@F@B@@@BAB@@BHBDAJ@@A@@B@@BB@@@BB@@@@@@@BBB@@B@@@@BB@@@@@@@BB@@@@@@@@B@@@@BB@@@@BB@@@BB@@@@@BB@@@@@@BB@@@@@@@B@@@B@@@@@@BB@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@B@@@@B@@@@@@@@@B@@@@@@@@@B@@B@@B@@@@@@@@@@@B@@@@@@B@@@@B@@@@@@@@@@@B@@@@@@@@@BB@@@@@@B@@@@@@@@@@BB@@@@@@@@@@@B@@B@@@@@@@@@@@@B@@@@@@@@B@@@@B@@@@@@@@BB@@@@BB@@@@@@B@@B@@B@@@B@@B@@B@@@B@@@@@@@B@@BB@@@@@B@@@@@B@@@@@BB@@B@@@BB@@BB@@@@@@@@@B@@@@@@@BB@@@@@@B@@@@@@@B@@@B@@BB@DFJFHLPHJBBDBFDDBF@B@DC@CDEBCFAFCFAD@FDFBBDBFBFBBFBBDBFBF@F@L@PBH@BAJAF@D@BBBLLHHBF@FCH@BD@F@L@F@D@@@@B@@B@DBHBD@DB@@BBB@BBBB@@@BBB@B@B@@BB@@@B@B@@B@BBB@@@@@@B@@BD@@@BB@B@BB@@B@@BDDBBBB@@@B@@@B@B@@@@@BB@B@B@F@D@DAB@@@@@D@F@F@D@@@@@H@H@J@BBFDFBDBB@BBF@H@D@F@D@BAD@D@F@F@B@FCFAFADADABABAB@B@DABAB@BABADCFEJI@@BABAFB@@JEBAECDAFAB@BCBC@AF@@CFA@ADC@AFEBAHADAD@B@B@B@@@B@@@B@@BB@BBB@@@@@@AB@@B@@B@@@BBDBD@D@BAB@BABABCAACAAA@@@ABCBI@@NCL@LAFA@C@A@C@CBC@AB@@A@A@C@E@C@E@C@E@E@@@A@@A@@ABA@@@A@@@A@ABA@A@@@AB@@@@A@ABA@@@A@@@@@@@A@@B@@A@@B@@@@@B@BAB@@@@@@@B@@A@@@@B@@@@@B@BA@@@@@@@@@@B@@A@@@@@@B@@@@@@@@A@@B@@@@@B@@@BA@@B@@A@@@A@@B@BAB@B@BADAB@BAB@B@BAD@BAB@BAB@@@FABAB@B@BA@@B@B@@AB@B@B@D@f@F@D@F@F@F@F@D@F@F@D@H@D@@EFAD@H@FAF@FAF@FAF@BBDBBBBBDBDDFD@@@@@@B@FADABGJEF@@@DI@@@A@@B@@ABA@CBABA@CBA@CBA@ABA@@@@@AAA@A@@@A@@AA@C@AAA@A@@@AAA@A@CAC@CAC@@@A@@AA@@CC@ACA@@A@CAAACAAA@AAAAAA@@AA@@AA@@AAA@@@A@@AA@@AA@AA@@AA@AA@@AA@@A@@@A@AA@@C@A@CAC@C@AAC@@AAAAAAAAAAAACAA@@@@AA@AA@@AAAAA@@@@AAACAACAACAAAA@AAACCAAACCAAA@A@@AA@C@AAC@AAC@C@AAC@A@AAC@A@@@ABC@A@A@AA@AA@@C@AAA@@@AAA@AAA@@AA@@AA@@@AA@@@A@AA@B@@A@@@A@@@@@A@@@@A@@@@AA@@@@@@@@@@A@A@@@A@A@@@A@@BA@AB@@AB@BA@AD@F@D@NCHADABA@ABC@@@ABA@@B@@AB@@@@@@@B@@@@@@ABA@A@@@AB@@A@CAA@@@AA@@A@@AAAA@@A@@@B@@@@A@@@A@@@@@AB@B@DAB@B@BAD@B@D@@@B@BA@@B@DAB@BAB@BAB@B@BAB@BAB@@@@@BABAB@B@@@@AB@@@B@BA@@@@B@@@BAB@@@B@BAD@BA@@@@B@BACAAA@CA@A@C@A@CA@@DCDCDCBA@@@AA@@C@ABA@A@@BA@ABAB@CC@A@CBCDCDADAH@F@DBFBF@DAHCDA@C@AE@E@E@I@EAEAACAC@CBADEBAAACACACC@E@E@CDEBCDC@@C@A@A@A@A@A@@@AAA@@@A@CAA@CACACAC@AAC@AAAACCAACAACCAAACACAAA@@C@A@@@AC@CACAG@A@@A@@@CBC@CBE@CBEBC@ABA@A@AAC@CAC@C@EACAC@EAA@A@@@AACAAACACAACCAECCCAACACACCCACAACCACACBC@ABGDGBIDEBGDGB@B@F@HAH@H@HAH@H@H@HAH@H@LA@GAGAEAI@GAQCIAE@A@@@@B@@A@@B@@@@AB@@@@@@AB@@@@AB@@@B@@A@K@M@GBM@K@G@A@C@AAC@GAC@AAA@B@BC@@BABA@@LQJKFGDE@E@@CCCECAECICGAAACAGECCAAAAAA@A@CBE@GBC@CBCDG@CDC@AFC@ABA@EAGGCEECCCG@AAC@CBALIJENADAFCNKJMDI@C@E@CDCF@JAJCDADCBG@E@G@CBCFCJGHEDCBEBEAA@C@CAA@AACIKIIGGEEAC@CCEEGEACC@CDEBCDEDCHAH@L@F@DADABC@E@EAEACCEKGGECC@CDG@ABABABAB@DAD@LAL@FADADCBCACCGIMCCAAEAACAA@CDCBCDADAD@HAHALAB@DAJCHEBCBG@CBAHCBCDABAA@A@@@AAACACCCACAC@A@ABADCHAD@BA@CBG@C@AAAACGCCAC@IACAA@GECEEEAG@E@A@CBCBCFCFCFA@AFEDG@@BG@CAECEACACAEAGMW@@AC@A@C@C@@BC@ADADCAAA@AAA@AA@@AAA@CAAACAAACAECA@@A@@@@A@AB@BA@@@@@A@@A@@@@@@@@@A@@@AB@@@@A@@@@@A@@A@@@@AA@@AA@@@A@@@AAA@A@@@AA@@A@@@@@A@@@@A@@@@A@@@A@@@A@A@@@A@AB@@A@@@@@A@@@@@A@@@A@@@A@A@@@@@A@A@@@@A@E@A@A@A@@AA@@A@A@A@A@@@@A@@AA@@C@AA@@A@ABA@@@AAA@@@AAA@AA@@@@@@@A@@@@@AA@@A@@@@@@@AA@@@@@A@@@A@@AA@@@A@@AA@@@@@A@@AA@@@A@@@A@@@A@@AA@@@A@@@A@@@A@A@@@A@@A@@A@@@@@A@@@A@A@@@A@A@@AA@A@A@@@A@@@A@A@AA@@@@@@A@@@A@@@@@@@@@A@@@@BA@A@@@AB@@A@@@A@@@@@@@@B@@@@@@A@@BA@A@A@A@@@A@@@A@@AA@@@AAA@@@AA@@A@@AA@@@A@AA@@A@AA@@A@A@@@AAA@@@A@@AA@@@A@A@@AA@@@@@A@ABA@A@@@A@@@A@A@@@@@A@A@@@ABA@A@@@A@A@A@A@@@A@@@@@A@@BC@@@A@A@@AA@@@A@@AA@@@A@@@A@@@AA@@@@@A@@A@@@A@A@@@A@A@A@@@A@@@A@@@A@@@@@A@@@AA@@A@@@A@A@@@A@@@@@A@@@A@A@@@A@@@@BA@@@@@@BA@@B@@@BA@@@@@AB@@A@A@@@A@@@A@A@A@A@A@@@@@A@A@@@A@AAA@@@A@@@AAA@@@AB@@A@@AA@A@@@A@A@A@@@A@@@A@@AA@@@A@@@A@A@@@@@A@A@@@A@A@@AA@@@A@@@A@A@A@@@A@AA@@A@@@A@A@A@@@A@A@@@AAA@A@A@A@@@A@A@@@A@@@A@@@A@A@@A@B@@A@@@@@A@A@@@A@A@@@A@@@A@@@@@A@@@A@A@@@@@A@@@A@A@@@AAA@@@@@@@@@@@@@@A@@@@@A@@B@@@@@A@@@@@@A@@A@@@@@@@@A@@@@@@@@A@@@@@AA@@@@@@@AA@@@A@@@A@@@@A@A@@AA@@AC@@@A@@B@@@@@@@BA@@@@@A@@@@@A@@@AC@@@A@@@@@@@@A@@@@BA@@A@@@A@@@A@@@A@AAA@@@A@@@@@@@A@@@@@@@AA@@A@@@A@A@@@@@@@A@@@A@@@A@@@A@A@@@A@@@@@AA@@@@A@@@@@A@@@A@@AA@@@@@A@@@@@@@@A@@A@@@@@@@A@@@@A@@A@@@@A@@@@A@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@@AB@@@@A@@@@B@@@@A@@@@@@B@@@@@@A@@@@B@@A@@B@@@@AB@@@@@BA@@@@@@B@@@@@B@@@@AB@@@@@@@BB@@B@@@@@B@@A@@B@@A@@A@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@@@@A@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@A@@@A@@@@@A@@B@@@AA@@@A@@@@@AB@@@@@@@@AB@@@@A@@BA@@@@@@@@@A@@@@@A@@@@@@@@@A@@@@B@@A@@@@@@@AB@@@@@@@@A@@@@@@BA@@@A@@B@@A@@@@@@BA@@@@@@BA@@@@BA@@@@BA@@@@B@@@@AB@@@@@B@@@BA@@@@@A@@@A@@A@BA@@@A@@@@@AB@@A@@@@@A@@@@@A@@B@@CB@@@@@B@@@@A@@@@B@@A@@@A@@@AB@@A@@@@@A@@@A@@@@@@@AB@@@@A@@@@@ABA@A@@@AB@@@@A@@@A@@BA@@@A@@@@@@@A@@@@@AAA@AA@@@@A@@AA@@@CAA@AAA@@@@@A@@A@@A@@@@@@A@@A@@@@A@@@@AA@@@AA@@@A@@@A@@@A@@@A@@@A@@B@@@AA@@@@@@@@@BA@@BA@@BA@@@@@@A@A@@@A@@@@@@@@@@AB@@@@@@A@@@@@@@@@@AA@@@@A@@@@A@@@@@AA@@@A@@@AA@@A@@@A@@@@@ADA@@AA@@AA@AA@@A@@@@AAB@@@@@@A@@@A@@AA@AA@@@@AA@@B@@@@@@AA@@@@@AAA@@AA@@@@@A@@@@@@@A@@@@@AA@@A@@@@@@@A@@@@B@@@BA@@@@B@@AB@@@@A@@@A@@BA@@BA@@BA@@@@@@@A@@@@@@@@B@@A@@@@AA@@@@@A@@A@@@@A@@@@BA@@@AB@@@@A@@B@@A@@BA@@@@@A@@@A@@BA@@@A@@@A@@@@@A@@@@@@@A@@@@A@@A@@AA@@A@@A@@@A@@@@@A@A@@@A@@@A@@@A@@@ABA@@@@@@@@@AA@@@@@@@@A@@@A@@@@BA@@@@BA@@@A@@B@@A@@@@@@@ABA@@@A@@A@@@@@A@@@A@@@@AA@@@A@@@@A@A@@@A@@@@@A@@B@@A@@B@@A@@@AB@@A@@@@@@@A@@A@@@@@@A@@@@BA@@@@BA@@@@B@@A@@@@@A@@@A@@A@@@@@@@A@@@@@@@A@@A@@@A@@@A@@@A@@@@@@AA@@A@@@@@AB@@@@@BA@@@@B@@A@@@@A@@@A@@AA@@@A@@@@@A@@@@@@A@@@@@A@@@@@@@@B@@A@@B@@@B@@@B@A@@A@@@@@A@@@@@A@@@@AA@@A@@@@@@A@@@@@@A@@@@@@@A@@@@@AB@@@@A@@@@@@@@@@@AA@@@@@@@A@@@A@@@@AA@@@@A@@AA@@@A@@@A@A@@AA@@@A@A@@@@@@@A@@B@@A@@@A@@@ABA@A@@@A@A@AB@@A@A@@@A@@BE@@BA@@@C@@BB@@@@@B@@B@@B@B@@B@@A@@@@@@@@@AB@@B@@@@@A@@@@@A@@@@@@@@@A@@@@@A@@@@B@@B@@@B@@B@@@@@@@@@B@@A@@BA@@B@@A@@B@@A@@BA@@@@B@@@@AB@@@@@BA@@@@@@B@@@@@@@@@@@B@@A@BB@@A@@@@BA@@@@@A@@@A@@@AAA@AAA@@@A@@@@BA@@@@@@BA@@@@@A@@@@AA@@@@@A@@@@@A@@@@@@@@B@@A@@B@@@@@BAB@@A@A@@@C@@@@BA@@@@@@BA@@@@@@BA@@@@@@@A@@@@@A@@@@@A@@B@@A@@@@BA@@@@@@@AB@@@@@@@@A@@@A@@A@@@@B@@@@@@@@AB@@@@@@@@A@@A@@@@@A@@@@@AA@@A@@@A@@@A@@@A@@@A@@@@@A@@@A@@@@AA@@@A@@@@@A@@A@@A@@@A@@@A@@@A@A@@@A@@@@@@@A@@@@B@@A@@@@@A@@BA@A@@@@@A@@@@BA@@@@@@@A@@BA@@@@@A@@@AA@@@@A@@@AA@@A@@@@@@@A@@@A@@@@@@@@@A@@@@@AB@@@@@@@@@@AB@@@@A@@@@@AB@@@@A@@@@BA@@@@@@@@B@@B@@@@@B@@@A@@B@@A@@@A@@@@BA@@@@@@@@@@B@@@@@BB@@@@B@@@@@@@B@@@@@B@@B@@BA@@@@@@@A@@@@@AA@@@AA@@@@@@@ABA@A@@@AB@@A@A@@@ABA@@@A@@@@@AA@@A@@@@@A@@@@@A@@@@@@B@@@@@B@@@B@@@@@B@@@@A@A@@@A@@AA@@@A@@@@@A@@@@@@@A@@@AA@@@@A@@@A@@@@@@@@B@@@@@B@@@@@@@@@BA@@@A@@@ABAB@B@@AB@@@B@@A@@@@@@@A@A@@@A@@@A@@@A@AAA@@@A@A@A@A@A@A@@AA@A@@@@@A@@@A@@@A@@@A@@@@@A@A@@@A@@@A@A@@@A@@AA@A@@@A@@@AA@@@@A@@A@@A@@@@A@@A@@A@@A@@AA@@@@AA@@@@AA@@@@@AA@@@@A@@AA@@@A@@AA@@@A@@AA@@@A@AAA@@@AAA@@@AAA@@@A@@@AA@@A@@AA@@@A@AA@@A@AA@@@@A@@@AAA@@@AAA@@@@@MCA@"],encodeOffsets:[[126135,47359]]}},{type:"Feature",id:"230225",properties:{name:"甘南县",cp:[123.507429,47.922405],childNum:1},geometry:{type:"Polygon",coordinates:["@@GEBEACNS@I@MCYAE@M@@ECA@CAEACAEAEAECEAC@CACAICCAA@C@@@@@A@@@ABA@ABCBABCBABA@ABEBCDABCBABABABABABAB@BAB@BAB@BAB@B@@@BAD@@@BAB@B@BCD@BABAD@BA@AB@B@@AB@@@BA@ABCDA@ABABABABA@ABA@AB@@CB@@A@@@A@@BA@A@@@@B@@@@@@@@A@@@@BA@@@A@@@A@@@A@A@A@A@@@A@@@C@A@A@C@A@ABA@A@A@A@A@@@A@@@AAA@A@A@CBA@@@@@@@A@@@AA@@@@C@A@CA@@A@A@@@@@ABA@@AA@A@E@@@A@A@A@A@@B@@A@@@@@ABA@@@A@A@A@A@A@A@@@C@CA@@A@@@C@A@A@@@@B@@@@@@@BA@@@AAC@A@@@A@A@A@E@@@AAA@A@E@@@A@C@@@C@AAA@C@A@A@A@A@@@E@A@A@AA@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@A@A@C@A@C@C@A@A@A@A@A@C@CAC@@@A@E@A@C@AA@@ABEAA@@@@@A@@@A@A@A@@@@@@@@AA@@@A@A@A@ABA@@@A@@@AA@@@@A@A@A@@@C@EAM@E@KAE@IAC@QAE@EAABEAE@EAI@CA@@@@@C@A@AA@@@A@A@@A@A@C@@A@@A@A@A@@A@CAC@@@A@@BA@@B@B@@AB@@A@@F@B@FCAA@C@C@CAE@@@BA@A@AAA@@A@@B@BADA@@A@C@@@@A@AB@@A@@@AB@@@@A@@@A@A@C@GAE@E@GAC@@ABA@A@A@@@AAAC@BA@A@@BABAB@@A@AACA@A@@C[@BE@IBAB@D@BBD@B@BAB@B@BA@@B@@A@@@ABA@A@@@A@ABA@A@A@AB@@A@@@@@@@A@@BA@A@ABA@@B@@@@@BA@@@A@@@A@A@@B@@A@A@@A@@@@A@@@@@@@@@@@A@@@A@AB@A@A@A@@@BEBA@@A@@@@@@@AB@@@@A@@@@@A@@@@@ABA@@@@@A@@B@@A@@@@@A@@@A@A@@@A@@@@@@@AB@@A@@@A@@BA@@@A@@BA@A@@@A@@@@@@@A@@@@@A@@@A@@@A@@@A@@@A@A@@@A@A@AA@@@@@@@@A@@@A@@@@@@@A@@@@@@@A@@@A@@@@@@@A@A@@@AAA@A@A@A@A@@AA@AA@@C@CAC@C@@@@ABAA@C@AA@DAFA@A@C@A@@@@JAJAJ@BFFBBDDBBA@GF@@@B@F@DC@MBGBG@AAA@C@@@@A@AAC@CGAIAMCAF@@@AA@AAA@@B@@@@@@A@@@@@A@@@AB@@@@AB@@@@@@@@@B@@@@A@@@@@@BA@@@@@AB@@@@@@@B@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@BA@@@@@A@@@@@@@@BA@@@@BA@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@B@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@ABCFADA@@BCBADCFCDABCBABCDABADCB@@A@@B@@@BABCBGDIDEBEB@@A@@@@@@@@@@@A@@@@@A@@@@@A@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@AB@@@@@@@@@A@@@@AB@@@@@@@@AA@@@B@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@B@@@@@@A@@@@@A@@@@@@@@@@B@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@@@AA@@@@@@A@@@@@@@@@@A@@@@A@@@@@@@A@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@@A@@@@A@@@@@A@@@@@@@A@@@@AA@A@@@@@@@A@@@@@@B@@@@@@@@@@A@@@@@@@@@A@@@@@@BA@@@@@AA@@A@@@@@A@AAA@@CAAACCAA@AAA@@@C@@AA@@@A@@@@@@@@@@@EFEFGFAB@B@DABCBABABMJUJ@BBJDPJBTFVHTF`LTHRFPFBB^LFDFBBBBBDBBBBBD@BBDBB@DBBBDBB@BBDBB@@@BBFBJD@BD@DBB@DBD@D@B@D@DBBBB@B@BBDBB@DBDBB@DBB@DBBBD@BBD@HDJBLDFBB@JBF@DBB@DBD@BBDBD@BBD@BBD@DBB@DBD@B@DBD@BBD@DBB@DBD@BBD@DBB@DBD@B@DBD@BBD@DBB@DBBBD@BBDBBBBBBBD@BBBBBBBBBBBBBBBBBBBBBBBBBBB@BBBBBBBBBBBB@BBBBBBBBBBBDBBBBBBBBBBBBBDBBBBBBBBBBB@BBBBB@@DB@BBBBBBBBBBBBBBBBBBDBBBB@BBBBBBBBBBBBB@@BB@BBBBBBBBDBB@BBBBBBBBB@BBBBBBB@DBBBBBBBB@BBBBB@BBDBB@BBBBB@B@@BB@DBBBB@BBB@B@DBB@BBBHPBDDFFHFHDHHPDHBD@FDJBBJLFF@@@@@@FDLDHDDBH@BBBBBBDBB@DAD@D@B@D@DBD@D@B@DBD@D@BBD@D@DBB@DBD@BBD@DBB@DBB@DBDBB@DBBBD@BBDBB@DBD@BBD@DBB@DBD@BBD@DBB@DB@@D@BBD@BBD@DBB@DBD@BBD@DBBBD@BBD@B@@@BBB@DBH@BBD@DBB@DBD@B@DBD@BBD@DBD@B@DBD@BBD@BBDBB@B@BBB@DBFBD@BBB@TDDBB@BBPDLBNDNDDBTFNDTJHD@BhVNHBBB@@BB@BBBBJHLJNLLHLJJJDBPPDBBD@@BBZXFDRTDBZVB@FFTPFDDBDDDDBBDBDB@BB@@BFBBDB@FDLRDFDDFH@@BBDB@BHFDBBDBBFD@@FFHJBBFJHF@@JFB@DBBBDBB@DDLFBBB@FDHDTLDBNJFDLJB@@@FDHHHF@@VP@@B@@@B@B@@B@@B@@B@@B@BBBBB@DDDBDDB@@@BB@@B@@@BBDBBBDBBBDBDBBB@@DB@@BBB@@@FDFD@@BBDDLJB@BBBDBBBBB@@@@BDB@@BB@@DDDB@BDB@BBBB@DDB@BBBB@BB@FHB@FB@@D@@@BBHBJHRNBBDDDBBBBBDBDD@@@@FD@@DDHDBBFFB@@@DDJJFDBB@@DD@@FD@@B@DDDDFDBBBBB@DD@@JHBBHFJHLHDDNHJH@BFBBBBB@@BB@@@@BBB@@BB@@@DDFDFDBBB@BBB@@B@@FBDBBBDBDDFB@BDB@@BBDBDBDDDBBBBBHFB@BBBBB@DDBBDDDB@BB@DDBBBB@@B@@BB@DDDBBBB@@BB@B@BBDBDBDBB@B@DBDBD@DB@@DB@@DBB@BBB@BB@@DB@@DBDBDBBBD@B@BBB@@BB@DBBB@@DBFBDDB@DBB@@B@@B@@@B@BBBBB@BBFDDBDBJFDBFDDBBBDBB@@@BBDBDBDDFDFBBBB@BBBBFBJF@@B@BBB@BBB@@@FDDBBBDB@@FB@BB@B@LFHDHFBBB@NFFFDBD@BBDBFD@@@@@@B@BBBBHFBBJH@BB@DDBB@@@@BB@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@B@@@B@@@@@BAB@D@FCLGHGDAJAFA@@HAHAD@FEBCBC@CDCDCDADCBC@AACAAAC@AA@@CECEC@C@ADCJ@FABCBCACAAAACAG@EAA@AA@CBE@E@@BA@A@AAA@@@@@CAA@@BEDAHAF@B@BA@A@A@A@ABE@A@A@A@ADALCJEDADE@AACAACCAEBABE@CAAA@CBCBEBC@C@CAACACAAAA@A@ABADADABA@@BE@AEAAACAEAC@@AAABABADCDAB@D@BBD@B@D@B@BADE@ABA@@BA@@@A@A@AAA@@A@A@A@@@AAA@@@B@@A@@B@B@B@@BB@B@BADAB@B@BAB@@AA@AAAA@BCB@@A@A@CACAAACA@A@A@A@AA@@@A@@@ABA@@@A@@A@AD@BA@A@@AAA@BABADCBCDABAD@BA@A@A@AAAEAEAAA@A@A@ABABAFADABA@A@A@A@ABABABAB@BADABCD@@ADBB@B@@@D@@BB@B@B@@AB@@ABA@AAC@@@A@@AAA@A@AAA@AAAA@@BC@ABAFCFAF@D@D@B@DA@A@AAAACAAAC@CBCBCBADADADAD@@C@ACACAC@@@@AACAA@C@@BAB@B@B@B@BBBB@B@BB@BBBBB@BABA@AACAAAABABAD@FADADCBA@@DAFAFABADAB@@@B@B@B@@@B@@@@CB@@@B@B@B@@@DADCB@@A@AB@B@BAB@@AAAAAA@C@@AAA@ABAB@BAB@@A@A@@@A@AA@@ABABABAB@D@BBD@BAB@@A@ACCAA@ABAB@B@B@BBBDBBBBB@B@B@BABA@AAA@ABA@A@AA@CC@@@A@AB@B@BBB@B@D@@AB@@A@@AA@A@ABAB@@@@A@@AAC@A@AA@@@AB@BAD@BA@@@A@AAAA@@@A@E@E@CBAAC@AA@AAABABADABADA@@@A@A@AA@CAA@AA@@AA@@BA@@@AB@@@@AB@@@AA@A@@@A@@BAB@BAB@B@@A@@@A@@@AA@@@AACBA@A@CA@@@@A@@A@@BA@@@A@@@@AAA@A@@A@@@@@ABAB@B@BAB@BB@@BB@@@B@@@BB@@@B@@@B@@@BAA@@@@@A@@@AA@@@AB@@ADA@@BAAA@@AAAA@ABABA@@D@DBB@B@BA@ABA@@BAB@@@BBB@B@B@B@@AB@@AB@BAB@BABBB@B@BDBD@@B@BBD@@@B@B@BAB@@AAAAA@A@ABAB@BAB@B@B@@BB@@@DBB@B@@B@@BBA@AB@@@@BBA@@@@@@BD@BB@AB@BAB@B@B@@@AA@@@A@@@AAAA@@A@@@@BAB@B@BBBBB@B@BADAB@@CBABA@C@A@AAAAACAAAE@C@CACAACAA@CBABC@EBCBADADABAD@BA@A@C@A@AACAAAAA@AAC@GAKAIAEAAAC@C@G@C@C@C@CAAAA@AA@A@C@ABADAFEDAB@@A@AAAAA@ACAA@CAA@G@CAA@AAAC@@@C@C@CACACACACCCECGAGEKCECECGEECEAEAEACAAAAC@A@AFEBC@EACEMEGCECCECCC@E@C@A@ABA@AFAJABA@ABAACACAAEAG@I@CAA@C@AACAECAC@C@EACAAACAAC@A@CBGBCBABC@EAEAAAAA@ABCBAHCHCDADC@@BC@EAAKCIAGAEAGCCCAA@A@A@AD@F@FALAFABADA@A@A@AAACAAAC@A@CAE@C@@@A@@AA@AAA@C@A@ABC@ABA@@@A@@A@@@A@@@@@@B@B@@A@@@A@@B@@A@@BADABA@C@AAA@ACAC@EAC@C@C@GDEBC@A@C@AAAAAA@CAEAG@GACACACBCBADADAHAFAFADCFCDCBCBC@CAAACEECCAC@C@CBCDAH@JABCFADCBEBE@C@CAECCAAEBC@E@GAA@C@C@A@C@ABA@A@ABAB@@A@@B@B@@@@AF@@@D@@@BB@C@CBC@E@E@C@C@C@ABA@A@C@C@A@A@@@A@@@@@@@@AAA@@@@A@@@A@A@C@A@E@AB@AA@@@AAAA@@AA@@AAA@@A@@A@@@@AAA@@A@@AA@AA@ACAAA@@AA@@AAAA@@@@AA@@@@AA@@A@@A@@AAAAA@@@@@@AA@@AA@@AA@@@AAAAAAAA@@A@@AAAA@AAAA@AAAA@@AA@@A@@A@@A@@AA@@AA@@AA@@@@AA@@AA@@CCCCEEAAAAAACEEEEECEECACEC@@@@A@@@C@@@E@A@AAC@A@A@A@A@@@A@@@A@E@E@A@GAE@A@A@@@A@@B@@C@CAA@@BA@AB@BAB@BAB@B@BA@@BA@@@GBIBGDC@A@E@G@EAG@G@E@EAA@AD@DAD@DAD@BAD@B@BAD@BAB@B@@@BA@C@E@C@E@A@C@C@A@C@E@C@C@E@C@C@C@E@C@C@C@C@E@C@C@C@@A@@BA@CBEBC@CBE@CBC@ABE@ABC@C@@AAA@A@CAC@KCKCIAIAEACA@@A@EAAAA@C@AAA@EAC@CAC@CAA@ABABEBCDABEBA@@BA@EDABEDABIDCDABA@A@EACAGACACAAAC@@@CACAC@CACACAC@CAAAEAGACACACAEAC@ECGACAA@GCEAEAEAEAEAEACACACAE@GCGACACAGCEAC@CAEAGCdOTIKI@CIETYZWiMcngVKPGDGACACACAA@A@AAA@A@CBA@A@C@@@C@CAA@A@C@CAA@@@A@CB@@A@@@CAA@A@A@A@AAC@C@AAC@A@A@AAA@A@A@A@AAA@AAA@CAICIEE@IEGACACACAAA@@@A@@BABA@@BA@@BA@@@A@A@AAAEECEACCECCCCACCECECCCECCAA@@A@GFEBCBIAE@C@QCM@GJVFHBDDA@E@E@G@GAC@C@C@CAC@AL@HPZIRGHEJQIJK]YDeC@G@E@EAE@E@C@CAI@C@C@CA@@@C@EK@AC@K@"],encodeOffsets:[[126463,48819]]}},{type:"Feature",id:"230227",properties:{name:"富裕县",cp:[124.473793,47.774347],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@OEIC@AA@A@EACAGCKC@@@@C@CBG@GBABC@A@A@EDEDEBEDEDEBEDEDEDEBAB@B@DBD@B@B@@@@@BBFK@UBM@G@E@M@MBK@M@K@A@E@E@G@O@A@A@C@oABR@VB@AB@BAB@@AAA@@AA@@@AB@@@@ABA@@@B@DBBB@B@@@BA@@AC@A@CAA@@BABA@@B@@@@B@B@DAB@BABBB@@BABCDA@@B@@@B@B@@BA@@DAD@B@@@@@@@ABA@@B@@A@@B@@@BBD@@B@@@@@@@A@@B@@@@@BB@@@BAB@B@B@@@@B@@@@ABA@CA@@A@AB@@@@@@@@F@@@@B@@E@AB@@@@@BB@@@DA@AD@B@@@AB@@IDAB@@@B@@@B@@B@B@BABA@AB@B@@@@B@@CBABCB@@@B@@@@@BBA@@@A@@@@BBBB@@BB@@@B@@A@@@@B@@@BA@A@C@C@@@A@@@BBB@BBB@@@@@@@A@A@E@E@G@ABA@G@A@M@E@AAC@CAQ@M@G@AAA@CBIBC@CBCBkAA@O@Q@O@M@O@QAA@M@Q@Q@O@OAO@Q@O@Q@I@A@E@K@S@M@Y@I@K@K@K@K@I@K@Q@K@I@EBEDGDCBGFCDAB@@CBGFGFAB@@@@@BADAB@@@@@BA@@@@@@@@BA@@@A@@B@@A@@@@BA@@@A@@@A@@@@BA@@@AB@@@BA@@B@@@B@@@B@BA@@BA@@@@BAB@DBB@@@BABADCDA@CDEBA@@BCFCF@@AACBCBAB@@@D@@A@@@CAA@@@AAA@CAAABB@B@@@@AB@@@@@BAAA@EAE@AAA@ACA@A@CBABABCB@@A@A@A@@@A@@AA@@@AACAAA@@AA@@AA@@A@@AACAAA@@@@@A@AB@@@@A@ABCBA@@BA@A@ABA@A@A@C@E@A@@@AFAB@BA@@@A@AAA@AAAA@@A@A@@@AA@@@@@AA@@@A@@@C@@@@AAAAA@@@CAAG@K@A@A@@@A@C@A@AAC@CBD@BBHFBB@FADCBGDADAB@DBJ@D@FBL@@DFDF@@AB@B@@@@@@CDCFCDCFADCDABABA@@@@@@@A@@@A@@BA@@@@@@@@@@B@@B@@B@@@@@@@@@@A@A@@@ACA@AAA@A@A@@@C@C@A@AB@@A@A@ABFFFBDDBBDDHFDD@BBD@D@DFDDBFDDBBBBB@D@D@DBBBBDBFBF@FADAFCFEJEHCB@DAD@DBDBBD@F@FAFBFBDBHHFHFJDHDFBFDFDBFDDFFDDDBHBFBF@H@F@F@DDBBBDBFADCFAF@BDF@BADABABEBE@C@ABAHBB@@@BDBHDHDFBHDJTHL\\^JHFFDDBB@FAF@BDBFBFBF@JAF@D@F@F@HCJCD@F@DDDBD@HBF@FADABCDADAF@DBF@DBJDJBJ@DBD@DBB@BB@B@B@BAB@BCDABADAB@BBDBBDBBB@B@B@BABABAFAB@@AFCDABEFIHCD@D@B@DDDBBFDFBFBFBD@FBD@F@D@BDBB@D@DCBAD@B@DBDDDDDFBHBFBFBDDBBDH@DGHGHABCDAFCFAD@B@DBBBDFDHDFDFBDDBBBB@D@DBFBFBDDDDDFBDDFBDBB@FAB@HAHAH@F@F@H@FBBDDD@D@D@DCBABABC@C@CAA@CCAACAC@A@EBCBCBADADABAFAJ@BCFADAB@BBDFHHDHFFBBBFBFBDBBBDFDFFHBDBBDDBF@D@DAFAFCDEBADIBG@CBAD@D@DBDDDFFBDBB@DADADCDEDCDEBEBGBCBCBABADBDBDBD@HBHBF@DBBBBBBD@B@D@FAHCD@D@D@FBD@DB@BBB@B@DABCBAB@@@BA@@@@B@@@BA@A@@@@@@@@B@@@BB@@@B@BAD@BAB@D@B@BBB@@BB@@@D@F@DBB@D@BBDBBB@B@B@BCBABEBb@@@B@B@@BF@BBJ@D@B@D@J@FBF@B@B@F@B@H@H@D@F@D@FBB@F@F@D@F@D@D@D@B@BB@@@@JDFBHBD@\\HFBD@HB@@DBHBD@PFF@DBLDB@B@B@FBD@DBDBB@DBD@DBB@B@DBB@B@BBB@B@BBB@D@BB@@D@@@@@HBJDHBHBB@@B@B@BBB@@@BBB@BBFBB@@JBT@R@F@D@H@@B@B@B@BDAD@B@@@AAAA@AH@@@B@H@D@F@DBH@D@D@D@B@B@DBB@@BB@@A@@D@@BB@B@B@B@@@D@B@B@F@BBB@RBTBNBPBD@D@B@@BA@@BB@FBH@H@TB@A@A@A@AAA@E@A@A@A@A@@@CA@@@AA@A@A@A@@AC@E@A@CBA@A@ABEBEBC@CBCBEBC@C@AD@AC@G@A@BD@D@H@FBF@D@B@HADABBF@BBHB@A@ADBB@B@B@D@FBB@HAH@F@B@@B@@F@H@ABADAD@@@BB@D@D@D@B@D@DBD@D@BAB@@B@BAD@B@DABB@F@@D@B@BAB@B@B@BAB@B@D@B@@@B@@AB@B@@F@D@B@BBB@B@D@BB@@B@A@@B@@@B@@AB@@@BB@@@F@@@BAJBL@FBB@B@D@B@DBB@D@HBF@B@FBB@@@@D@@@@@D@B@BD@H@D@D@DBF@D@D@F@B@B@B@D@B@BBB@B@DAB@B@B@FB@@B@D@B@DA@@B@D@F@HBF@F@H@DBB@@@@B@@FBD@J@B@F@@CD@F@D@@BF@B@DBD@@B@A@A@@F@H@J@LB@@D@@@@@D@B@B@@@F@L@HCDAJE@@@@@ABA@CBA@C@ABAAE@EBA@ABC@A@A@AA@@A@ADKBE@CG@A@C@@ABI@ABC@A@CBA@CBEBE@EBCBGBG@ABE@CBC@CBE@CBG@ABA@CBC@GBA@GBE@C@EA@GBCBC@A@IBA@C@E@@@C@@@C@CAC@A@C@AAC@C@A@A@@@AA@@BA@A@CBC@A@ABA@A@ABC@@@CBA@A@ABC@A@A@ABA@A@AB@@EBA@CBA@ABC@A@AB@HBJ@HBH@F@B@HBD@J@HBH@JB@@F@H@DBL@D@HBH@HBF@H@HBD@B@B@B@FAB@FAD@B@FAFAHAB@JAB@@@HAB@HAD@DA@@@@@A@@BE@A@@@ABC@A@CBA@CBCGCA@BA@C@CBA@A@A@@@@@A@@@CBG@@BE@A@@@ABC@C@EBC@A@CBC@CBA@AA@@@@@@A@@@B@@@@ABEDA@@@@A@@@@C@@@@@@@@B@@@@@@@@A@@@A@@@@@@@A@@@@@@@@AA@@BAA@@@@A@@@@B@@@@A@@@@@@@@@@A@@@@A@@@CB@@@@@@@BAA@@@@@@@B@@@@A@@@@@@@@A@@@@@@A@@@@@@@@B@@A@@@@@@@@AA@@@B@@A@@B@B@@@AA@@A@@@@@A@@@B@@AA@@A@@@@@@@@B@@@@@@@@@@A@@A@A@@@@@@@@@BAB@@@@@@@AAA@@@A@@@@@@@A@@@@@AA@@A@AA@@A@@@@B@@@@@@B@@B@@@@@@AB@@A@@@A@@@@@@BB@@@B@@@@@@@@B@@@@A@@BAA@@A@@@@@A@@@AA@@@@B@@@B@@@@A@@@@@AA@@@A@@@@@AA@@A@@A@@@@A@@@A@A@@@@B@@@@@@B@@B@@A@A@A@@@A@A@A@@@@@A@@B@@@@@@AA@@A@@A@AA@@@A@@@@@A@@@@BA@@@@A@@@@@@@A@@@@A@A@@@@@A@@@@@A@@@@@A@@A@@AAA@A@A@AB@@A@@@@@A@BB@@@@A@@@A@@@@B@@BB@@@@@B@@@@@@A@@@AAA@@@A@@@@@A@@@A@@@A@@B@@@@@@BB@@@@A@@@@@A@@@A@A@@@@@@BA@@@@@B@@B@@@@@B@@@@A@@AA@@BA@@@A@@B@@@@AB@@A@AA@@A@@@@@A@@@@@@A@@@@@@@@@@A@@@@@@@@@A@@AA@@@A@@@@@@@@A@@@@@@@@@@A@@@@@@B@@BB@@@B@@@@@@A@@AA@@@@A@@@A@@A@AA@@@@@@BA@@@@A@@A@B@@A@@@@BA@@@A@@@@@A@@@A@@@AA@@@@@B@@@@AB@@A@@@A@A@@@@@A@@@@@@B@@@@A@A@A@A@@@@@A@@@A@A@AA@@A@@A@@@@AA@@A@@AB@AA@@A@@BAB@@@@A@@@@A@@@@@@A@@B@@A@A@A@@AA@@B@@@@@@@@A@@@@@@A@@@@@A@@@@@A@@@A@@@A@@@@B@@@@AA@A@@@@@@AB@@@@@@@A@A@@@A@@@A@@@@@@@@@@@@AB@@@@@@@A@@@A@@@@B@@A@@@A@@@@@@@@@@@@B@@@@C@@@A@@BA@@@@@@AA@@@B@@@@AB@A@@@@@A@@@A@@AB@@@@@@A@@@@@@B@B@@@@A@@AAA@@A@@A@@A@@A@@@A@@@@@@@@@B@@B@@@@A@@@@@A@@@@@@A@@@@@AAA@@@@A@@@A@@@@A@@@A@@A@@@A@@A@@@@BA@@@A@@@A@@@@@AB@@@A@@@A@A@A@AA@@A@@A@A@@@@A@@@@@A@@A@@@A@@@@@@AA@B@@A@@@A@@@@@B@@AA@@@@@AB@@AB@@A@@@BA@@@@@AA@@AA@@@@@B@B@@@@AA@@@@A@@A@@@@BAA@@@@@@@@@@@@@B@@@@@BA@@@@@AA@@@@@A@@@@@@A@@@@@A@@BA@@@@@A@@@@@@@BA@@@@@@@@@AA@ABA@A@A@@@@@BA@@DA@@@A@@A@@@A@A@@@@@@B@@AB@@AA@@@A@@BAB@B@B@@@AAC@@@@A@@@@BA@@@AA@@AA@AA@@IAA@A@@A@@AAA@@AA@@@@@BA@@@@@A@@@@AA@@A@@@AA@@A@A@AB@@A@@@@AB@A@@AA@@@A@@@A@@@C@ABA@A@@@@@@B@@@@@B@@@B@@AA@@A@A@AA@@B@BA@@@@A@@@E@@@A@@AA@@@@A@@BAAA@@A@@A@@@@@A@@B@@@@@@@BB@A@@@@@@@AA@@@@AA@AA@@@@@@@@B@@@B@@@@A@@A@@AA@@@A@A@AAA@@@@@@A@@@A@@A@@@A@@@@B@@AB@@@@@@A@@@@A@@@AB@@A@@@@@@@@A@@@A@@@A@@@@@@@@@DA@@@@A@@@A@A@AB@BA@@@@B@@@B@@BB@@@@AB@@AA@@AA@@A@@@A@A@@@A@@@@@@AA@@@ABA@AB@@@@@A@@@AA@@@@@BCDGBE@ACC@CA@AE@AHANAD@DEFGA@EACAA@BABABAB@DBB@B@BBB@DEBCHGBEB@@CBE@@@@@@@A@@B@@A@@@A@@@@BA@@@A@@@A@@@@@A@AB@@@@@FBFDB@@@@BB@B@AA@CB@BAB@@@BA@A@@B@BABA@@BABAB@@@BA@@BAB@@AB@BA@@B@@ABABA@@DA@@@AB@BAB@@A@@B@@@BA@@BABA@@BAB@@ABAB@@@BA@@BA@@@@B@@AB@BA@@BA@@@@B@@AB@@A@@B@@A@@B@@A@@B@@A@@B@@@@@@AB@@@@@B@@@@AB@@@B@@A@@B@@@@AB@@@BA@@@@B@@A@@B@@AB@@@B@@A@@B@@@@@BA@@@@@@BA@@@@B@@A@@B@@@BA@@@@BA@@BA@@@@@@BA@@BA@@@@B@@A@@B@@AB@@@@ABAB@@A@@B@@@BA@@BA@@@@B@@AB@@A@@BA@@@@BAB@@A@@@@BA@@@@BA@@@@BA@@@@@@@A@@@A@@@@B@@A@@@AB@@@BA@@@@BABA@@BA@@@@BA@@@@B@@A@@B@@AB@@@@AB@@@@@BA@@BABAB@BABABADABABABADCB@@@B@B@D@D@D@BABA@@BABA@@BA@@BAB@@ABA@@F@D@@AB@@C@A@CBA@A@CBC@A@A@A@C@@BC@@@CBA@C@ABA@C@CBC@@@A@C@ABAAAAAA@@@AAA@A@@@@@@A@@@AB@@A@A@@@A@@@@@@BAB@@@@@B@@A@A@@@A@A@@@AB@@@@@B@@@@AB@@A@A@A@@@A@@B@@ABC@@A@A@AA@@A@@A@A@A@C@A@C@A@AB@@A@@B@BA@@@@B@@I@C@AA@@@@ABA@A@ABA@A@A@ABC@@@CBA@C@A@A@A@@@AA@@A@@BABA@@BABABA@@B@BCBA@ABA@ABA@A@@@C@ABA@ABA@@BADCBABAAA@A@A@E@C@A@A@@@@B@BA@@GAKCICICA@@@C@AAE@AAE@AAE@EA@@A@C@EAE@GACAC@CAE@CAC@A@AA@@@@@@@@@@BA@@@@@@B@A@@@@@@@@AA@@@@@GAECGACAGAEAAAEAEAE@AAEACAA@EAEAGCGAEAE@CAC@EAGCGAEAC@@AA@@@@@AAA@AA@A@@AA@@AAA@@AA@@A@@AA@@A@@A@@@@A@GAIAG@A@AAC@A@AAA@A@A@@@@@A@A@@@A@EAE@CAC@A@@@A@@@AA@@@@A@A@C@A@@AA@A@C@EAC@A@A@A@ACAECCAEACCEACCEACAEAAAAC@ECA@ECGCCACAC@CACACAA@AACACAEACA@AC@AAGCECEACACA@@AAEAIA@AEAICICGACCC@ECECAAG@I@GAEAAAC@AAA@AAGA@@ICAAA@GCAEAA@@@@EAEAA@@AA@CAEACAAAA@A@AAEC"],encodeOffsets:[[127655,48456]]}},{type:"Feature",id:"230229",properties:{name:"克山县",cp:[125.875705,48.037031],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@IAC@A@@@GAC@@@A@@@@@@@@@@@A@@@@@@@@A@@C@A@A@A@C@A@C@CAA@A@C@C@A@AAA@AAEAEAC@A@C@CAA@G@AA@@@BA@@@@B@@@B@@@B@@@B@@A@@B@B@@@B@B@@@@@B@@AB@@@BC@@B@B@@@B@@AB@BAF@@@B@@@BA@@B@@@@@B@@@B@@@BA@@B@B@@@BAB@B@@@@CB@@C@CB@@ABABEAA@AAEBC@C@C@C@C@EACBABAB@H@BAB@D@BAB@F@F@BAB@B@B@@@@AB@@@BAB@@@@@@@@A@@B@@@@@@@@@@@@@@@@AB@@A@@B@@@B@@@BA@@@@@A@@AA@@B@@@@@B@@B@@@B@@@B@@@@BA@@@A@@@@@A@@@@@@@BB@@D@@@@@@BA@@@A@@@AAAA@@A@A@A@@@A@AB@@@@@@@@A@@@A@@@@A@@@@@@A@A@@@A@@A@@AA@@A@@BABA@@B@@@@@BB@@@@@@@@@C@@@@B@@@@B@@@@@@@@@C@@B@@A@@BA@@@A@BB@@@@@@@@AB@AA@A@A@@@@A@@@@@@B@@A@@A@@@AA@@A@A@@@A@@@@A@@B@@@@A@@@@A@@@@@A@@@A@@@@@AA@@@@@@@@A@@@@@AA@@@@@@@AB@@@@A@@@@@A@@@@A@@@A@@@A@@@@@@@@A@@B@@@B@@@@AA@@@@@@@@A@@B@@@@@@@A@@A@@A@@@AA@@@@@@B@@@B@@@@@@@B@@@@@@A@@@@A@@A@@@AA@@@AA@@AB@@@@@B@@A@A@@@A@@A@@@A@@B@@A@@@@@@C@@@@@AB@@A@A@@AA@@@A@@@A@@B@@B@BB@@BABB@@@@A@AB@@A@A@A@@@AA@@A@@@ABABA@A@ABA@@A@@@@@AB@A@@A@@B@@A@@@@@AA@A@@@A@@@@A@@A@@@A@@@@@@@@B@@@BB@B@@@@B@@A@A@@@@@@BB@@@B@@@@@AB@@A@@@@B@@B@@@B@@BA@@@A@@AA@A@A@A@@@AAAA@@A@@@@B@@AB@@A@A@A@CB@@@BB@@@@@@@@@@@A@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@A@@@A@@@@@@@A@@@@@@@@B@@@@B@@@@@@@@@AB@@@@AB@@@@@@A@@@@@@@@@AB@@@B@B@@AB@@@@@@@@B@@@@B@@@@A@@B@@@@@@BB@@@B@BA@@B@@@@B@@@B@@@@B@@@B@@@B@@@B@@@B@@@B@@A@@@@B@@@@@BA@@@AB@@@@@@BBB@@@B@@BB@@@BB@B@@@@@@@B@@@B@@@@DB@@@@A@@@@@A@@@@@A@AB@@@@BBB@@@B@B@@B@@AB@@@B@@@B@@@@AB@@@BAD@B@@AB@B@@BB@@@B@@@BA@@@@B@@@@A@A@C@A@A@C@@AA@A@@@E@CAE@C@A@@@@@@@A@@@A@@@A@@@A@@@A@AAA@@@A@@@@@C@@@@@@@@@A@A@@@A@AAA@@@A@A@A@@@C@A@A@@@@@A@AA@@A@C@A@A@C@A@@@@@AB@@@B@@@B@@@B@@@B@@AB@D@@@B@BAD@@@B@B@BA@@D@@@B@@@B@BA@@B@B@@B@@@@@B@@AB@@@B@@@@@B@@@@@B@AB@B@B@@@BAB@B@@@B@B@BAD@B@@G@C@I@G@GA@@I@E@MAA@E@AAC@A@C@A@A@C@E@@A@BADAD@BB@@@AB@B@B@D@@AB@B@@@B@@@@AB@@@B@@@BA@@B@B@BAB@B@@@B@B@B@@@@AB@B@BAF@DAB@@@B@D@FAB@BCXCZAD@BARA@C@I@@@K@K@WAK@C@G@Q@E@A@A@QAM@M@AD@@@BCDAAIA@A@A@AA@@@C@A@C@A@AAC@C@A@I@K@K@KAK@I@C@@@@@EACA@B@BI@C@@BC@EAG@A@A@A@A@A@C@A@A@@@A@A@A@A@@@E@A@A@A@@@A@@@A@C@A@@@C@A@C@A@A@A@@@@@EBA@E@E@E@A@KAG@@@C@@@@@A@C@C@@@@@@@A@E@@@C@C@E@C@I@C@A@@@A@@@A@@@@@AB@@@B@D@B@@@@@@AB@@@B@@@@@B@@@B@B@@@@@@@D@@@@@BAB@B@@@B@@@B@@@B@@@@@BA@@@@B@B@B@B@@AB@B@B@B@B@@@B@BAB@B@@A@C@C@C@A@@@@B@B@@@BAB@@@B@B@@@@@B@@@@AB@B@B@@@B@B@@A@@@@B@@@B@@@BAD@@@DAB@D@@@@@B@@ADAH@B@BA@@@@@AB@B@B@B@B@BAB@B@BBA@B@B@BAD@BAB@@B@@@@@@@BB@@D@B@D@@@@B@BAB@B@@@B@@@B@B@@AB@B@@@B@@@B@@AD@B@B@BA@@@@B@B@@BB@B@@@B@@@B@B@@BD@@@B@B@@@B@@BD@B@@@B@B@B@BBB@BCAA@BB@B@D@B@B@B@B@B@D@@A@A@A@A@@@A@@@@@@B@B@B@B@B@B@BA@@D@@F@@@@B@B@B@B@D@BA@@@@@@@@@@@A@A@@@A@@@AB@B@B@@@B@B@B@@@@AB@B@B@@@B@B@BAB@B@B@@@B@@@@D@B@@@@@@B@@@@@@@BAB@B@DAD@B@DADAD@D@B@B@@@@FBAD@@@DAD@D@DAB@B@@FBH@BB@B@@@FD@H@@DAP@FMAK@AFQABEA@MABEDGBIOABEBKG@G@@DAJU@A@ALC@CDAVAbBBHPHFJFHBF@NBlBRBbBVDNDFDFFFHFJFHFBTBJDFJ@LGVKVRPFBXBV@PDHBDFBBLL`JNDP@HA^GpAd@N@FbNZRPHV@ZG\\ML@bCT@LB@DAB@B@B@B@B@@@DAD@D@B@D@@@BEA@@@B@@@@@BAD@@@B@DAD@@@B@BAB@B@@@D@D@@@@A@@@@@@D@@@D@@AB@B@B@B@BA@@B@@@DAB@B@B@@@B@@AD@D@B@BAHCN@D@B@@CA@BB@@@@@@B@@@@@@@B@@@BAD@DAB@B@@@B@@@DCPAB@B@DAB@B@DAH@@@B@@@BA@@@@B@@@@@@@B@@AB@D@DA@@D@@@DAD@AA@@BDB@@A@@B@B@@@B@B@BA@@@@@@B@@@@@@@@@@AJCJ@DAFAB@B@B@F@B@L@@@BB@B@B@BAB@B@B@@@BAB@@A@A@A@A@A@@BA@ABABA@AB@@@DBB@@@BAB@DAD@BBB@@B@D@B@BADA@@BBB@BB@B@B@D@B@@@@@@BA@@B@B@B@@BB@@B@B@BAB@BB@@BB@@B@BBF@D@BBDB@@BBBAB@B@FAB@D@B@@BB@@B@B@BDBB@BBB@@BB@@B@B@D@D@@BB@@B@B@B@@@BA@@AA@@@AB@B@@AB@@AB@@A@@@AB@B@B@B@@AB@@ABAB@BABBB@BBDD@@B@@@B@BAB@B@B@D@B@B@@@BB@B@B@@BBBBB@DBB@B@B@BA@@@A@A@A@A@@@AB@@@B@B@DB@@B@BA@AB@B@B@@BB@D@B@B@BADAD@@@F@BA@A@@BA@ABADAD@BAD@BAB@BA@@D@B@@BB@@B@BBB@@B@B@@@BAB@BBB@BB@@B@D@@@B@BAB@D@B@BBB@@B@@AB@B@@@BBBBDBDBB@B@@B@@@D@@BB@@BBB@@@B@@@BBB@@BDBFB@@BB@B@FBF@DBFD@BBB@@BB@@B@B@B@BA@@@A@AB@BAB@D@B@BABAB@B@B@@AB@@A@@BA@@@@@@@@B@B@B@BBD@@@B@@@B@@@B@B@B@@@@@@@@BBB@@BB@@B@B@B@B@B@B@BAB@B@@AB@@AB@B@B@@@B@@@B@@BB@B@@@B@D@@AB@D@DAB@B@B@B@B@BB@BBB@@@@AB@@@B@@BBB@B@B@@@B@BA@@@@@A@@A@AAA@@A@@@AB@@AD@B@@AB@@@DA@@B@B@B@@@D@@@B@@BB@B@B@@UBE@I@E@E@C@E@C@A@C@E@C@C@C@A@C@@@A@ABEBEA@@@@@@A@@@@@@@A@@@@@AB@@@@@B@@AB@BA@@@ABCBEBCAB@BA@ABAB@@CBA@CB@@C@CAC@@@AA@@A@AA@@AA@@@AA@@A@A@@@ABA@AB@BADCDCLGBC@CBC@ABABG@C@@B@@A@@@EBEBG@A@AAA@@A@@ABC@A@CBA@@@C@@@@BA@C@ABA@C@@@ABA@A@A@A@ABA@A@C@@@@BGBE@CBC@CBGBCBA@CBCBC@E@CBA@@@AB@BABAJM@ABADEBC@@@@@A@@BA@@@@B@BA@@@@BA@@@@@@@@B@@@@@@A@@@A@A@@BA@A@@@@@A@@@A@@@@@A@@@@BA@@@@BABABA@@BC@@@@@A@@@@@@HAD@B@DAB@BA@@B@BA@@B@B@BAB@B@B@B@@@D@B@D@D@D@B@D@B@D@B@B@B@B@BA@@@@B@BA@@HAHAHCD@FAFAFABADADC@@BA@@F@B@BBF@F@F@@@BBB@@@B@B@B@B@T@F@H@@C@E@AAE@C@E@E@GACFCB@FAHAJCF@FAD@BAB@BAB@DAB@DCB@BAD@B@BB@@DBB@B@DAB@B@F@F@D@B@B@DAFEB@@AB@@@FAH@@@@@BADABABAB@BABAFABA@@B@B@B@@A@@@A@AB@@A@AAAA@AA@@@A@A@@BA@@@ABA@C@@@A@A@@BAB@@A@@@A@A@A@@@ABA@A@@@ABC@A@@@@CAA@A@A@@@@AAA@A@A@AB@@A@@BA@@@@BA@A@@@@@C@AB@@A@@@A@@BA@@@A@@BA@A@@@AAA@@@A@@BAB@@@BA@A@@@@@ABA@@@A@@BA@ABABABADCBAB@BABA@A@@@A@A@A@A@A@C@@@A@A@A@A@A@AAA@@@A@@@A@A@A@C@@BA@A@A@@@ABA@@@C@ABE@C@A@A@@@A@ABC@A@@@A@@BA@A@@A@AC@A@A@@@C@C@A@CAA@@@A@A@AB@@AB@B@@@BA@ADAB@B@@A@@@AB@@ABAB@B@B@D@D@D@D@BBB@D@D@B@B@DBF@F@D@DBD@B@B@D@B@D@DADA@ABA@@B@@A@@@@@@@AA@@@@A@A@C@A@A@@B@@@@A@@@A@@@A@@@ABAB@@@@A@A@@@A@@BA@A@@@@@A@@@@@C@AB@@A@A@AAA@AA@AAC@C@C@A@C@AAA@A@A@@@A@@@A@A@A@@AC@A@A@A@@@AA@@A@C@A@@@A@AAA@A@@@A@C@A@A@A@A@@@C@@@A@A@@@A@@@@@@C@A@C@@BA@A@A@A@@BA@@@A@A@A@@@ABA@A@A@ABC@CBA@A@A@ABA@A@@@A@@BC@A@A@A@@BA@A@@@A@AB@@A@A@A@@@A@@@ABA@@AAB@@A@A@@BC@@@C@@@@@A@@BA@C@@@A@@@@@@BA@@@A@A@ABA@A@@@A@@@A@A@@BA@C@A@A@@B@@@@A@A@@@C@A@ABA@A@@@C@A@A@A@@@AB@@A@A@@BC@A@C@CBA@A@CBC@A@A@A@@BA@@@@@A@@@ABA@@@A@A@@@A@AB@@@@A@@@A@@@A@@BA@A@A@@@CBC@A@A@A@@BC@A@A@ABC@A@@@@@@B@@@@A@@@@@@@@@C@A@@@A@@@A@A@@BA@A@A@@BA@@@A@@@A@@BA@@BA@AB@@C@AA@@@@A@A@@@A@@@ABA@A@@@A@@@A@A@@BA@@@@@C@A@ABA@@@A@@@A@A@@@@@@@@@A@@B@@A@A@@@@A@BA@ABA@C@ABC@A@A@ABA@@@@@A@A@A@A@@@A@@@A@AB@@@@CBE@A@EBA@A@@@CBEBG@@D@T@H@J@JBF@@C@ABA@EBC@ABA@GBA@CBC@EBEBC@A@A@@@ABA@C@ABC@A@@@CBEBC@@@@@ABA@@@C@E@C@@C@E@EAG@E@E@E@E@I@G@GAC@C@G@E@I@E@KAG@G@G@G@C@A@GAA@E@E@C@C@E@GAC@C@E@E@E@A@C@E@A@CAC@C@C@C@A@C@A@C@A@AAA@C@E@C@E@E@E@CAE@C@C@E@C@C@E@C@E@CAE@C@C@C@A@A@@@C@C@C@C@A@A@@@A@AAE@E@E@E@E@G@E@C@E@C@E@C@EAG@A@C@A@A@A@C@@@A@@@C@C@EAA@E@A@C@C@CAE@E@G@@@CAC@E@A@@@E@A@AAC@C@C@C@E@@DA@A@A@CAA@C@E@A@A@C@A@A@EAA@A@E@G@@@C@A@C@EAE@@@C@C@C@A@E@CA@DA@A@E@A@A@C@C@A@A@A@A@MAA@E@"],encodeOffsets:[[128787,49008]]}},{type:"Feature",id:"230230",properties:{name:"克东县",cp:[126.24872,48.04206],childNum:1},geometry:{type:"Polygon",coordinates:["@@AD@D@BA@@@@@@@A@@@A@A@A@@@E@A@A@A@CAA@A@A@E@C@@@@AC@A@A@A@EAC@A@A@C@AAA@@@A@@@A@A@A@C@C@A@@@A@EAA@C@C@E@EAC@A@C@C@C@C@@@A@AAC@C@C@C@A@A@CAA@E@A@E@@@C@AAA@@@C@A@A@@@A@@@@@A@@@A@@@A@@AA@A@@@@@A@C@@@A@AAA@C@A@A@A@A@C@G@CAG@E@C@CAK@G@CAI@@@@@AB@@@@A@A@@@A@A@A@@@@@C@@@A@A@@@A@@@A@@@@B@BA@@B@B@B@F@DAB@@@DAB@@@B@B@@@@@B@B@D@B@DAB@D@D@B@@A@@B@@A@@BC@@@A@AB@@@@@B@@AF@F@F@@@@@B@DAF@B@BA@@@@@@BA@AB@@@@@@AB@@@@@@A@@@A@A@A@@@@@@@A@@@@B@@@B@@@@@@@B@BD@D@@B@DAD@BABAB@B@@D@B@D@BBB@D@B@B@D@B@B@@@@@@B@@@B@B@FAD@B@@@@ABA@A@A@ABA@@@@B@@@B@B@BAD@DAB@@A@A@A@AA@@A@@@A@DDBD@B@B@D@BDB@BBB@BB@@BBDBBB@DB@BBBAB@BABABDBB@DH@B@BB@@B@DA@ADA@@J@DFFBB@BBB@BCBA@ADFDBFA@@@@@A@A@E@C@AAAH@D@DAB@F@B@DAD@@@BB@@@@B@@@@@B@@@B@BA@@B@@@@@@@B@B@BAB@B@B@B@BA@@@@B@@@@AB@@@@@B@B@@@BA@C@G@EAC@A@O@E@KAE@I@MAA@AB@B@@@@ADAF@D@@@BAD@B@DAB@B@@@B@BADAF@FAD@DAB@HAB@BAD@FAB@B@DE@IAI@G@S@C@@@AHAF@D@@@BAB@F@BAF@D@@A@@B@B@@@B@@@B@B@B@B@@@@AB@B@B@BAD@B@DAB@BABB@@@@@@B@BA@@@@B@@@@@@@@@B@B@@@B@@AB@B@B@D@@@@AB@@@B@B@@@B@@@BAB@B@@@B@@@B@B@@B@@B@DA@@BAB@@AB@@@B@@@B@@AB@@@B@BAB@@@B@B@@@B@@@B@D@@@@@@@@@B@@A@@@@@@@@BAD@B@B@BAD@@@B@B@BAD@D@@@B@BAB@@@B@@@B@@@B@@A@@B@B@@@B@B@@AB@B@@@B@@@@AB@@@B@B@BAD@D@BAB@D@D@BAD@@@B@BA@@B@@@B@B@B@D@@@BAB@B@B@D@@@B@B@@A@@@@B@B@DAB@@@B@B@@@B@@@BAB@B@B@B@@AB@@@@@@@B@@@DAB@@@B@@@@@D@@AD@@@B@BA@BB@@AB@B@@@B@@@B@B@BA@@B@B@@@BAB@@@B@B@BAD@@@B@@@BAB@B@B@BAB@DAD@B@B@BAB@B@@@B@B@B@@AB@@@B@B@BAB@@@D@B@D@@@@B@@@B@B@@@D@@@B@B@B@B@D@B@@@B@B@BBB@@@B@D@B@@@BB@@B@B@B@D@@BB@B@B@@@B@@@B@B@B@BBD@B@D@D@D@BBB@@BBB@B@B@BA@@B@D@@@@@B@@@@@BAB@@@B@@@B@B@@A@AB@B@@@B@@@B@@@B@@A@@@@B@B@D@B@B@@B@@B@@@@@@@BA@@@AB@BCBCBC@A@C@A@A@C@CAC@E@E@CAA@A@C@C@A@AAC@C@C@C@A@A@A@AB@BA@@B@@@BA@A@CB@BAB@@A@A@@BA@@B@B@B@@BB@D@B@D@D@@@B@BBDB@@@@BAB@@@B@@@BAD@B@B@@@B@B@DAF@B@D@@AB@B@@@B@BAB@@@D@B@B@B@@@B@@BB@B@B@B@B@B@B@@@D@B@B@B@B@B@@@BABABA@ABCDABABAB@BAB@@@B@@AB@B@@@@@BAB@@A@AB@@@B@@BB@B@@@BAB@@@B@@AB@@@B@@@BA@@B@D@@@@@BAB@@@@AB@@@BA@@B@B@BBB@B@@B@B@B@DB@@@@@BAD@B@@@BAB@B@@@B@B@B@@@BA@AB@@@B@B@@@DAB@B@@AB@@@B@B@@BBB@BB@B@BA@@B@B@@@B@@@@D@D@D@@@@@BA@@@AB@@A@@B@B@B@@A@@BA@@@A@@BA@A@@@@@AB@B@@A@@B@@@BABA@@B@@@BBB@B@D@D@FBD@F@F@F@F@FBF@D@F@F@F@FBF@F@F@F@F@F@FBF@D@DBD@F@D@FBD@F@D@DBF@D@FBD@D@F@DBB@@AB@@@BA@@@@@@@@@@@A@@B@@@@@@@@@B@@@@@@A@@@@@@BA@@B@@@@@@A@@@@B@B@B@F@DAF@BAD@DABAD@DAB@DBBABA@A@@@AB@B@@@B@B@@A@@BAH@B@@@DA@@B@B@@@BBB@D@@@BB@BB@D@BB@@@BB@@BB@@@B@B@B@F@BB@@B@B@@@NBH@B@L@BBB@@@B@B@D@B@B@BA@@BA@@B@BBB@B@@@B@@@@@@AB@@AB@@@B@@BD@B@@@B@@A@A@@@A@@BAB@B@FA@@BA@@@A@@@@BAB@B@B@B@B@@@BAB@B@B@BBB@B@@@B@BAB@@@BB@@DAD@DABABAD@BABA@@@ABADA@ABA@@DA@@@A@A@ABA@@BA@@@@@@@A@@@@@@@A@@@@@A@@B@B@@@@A@A@A@A@@@AA@@@B@@@@@BAB@B@@@@@B@@@@@@@@@@A@@B@@AB@B@BAD@BAB@B@@A@@@AB@@@@@BAB@@@B@BAB@@@@@@AA@@A@@@@A@@@@A@@AA@@B@@@@AA@@@AA@@@A@@@@B@@@B@@@@@B@B@@@B@@@@A@@BA@@C@@@@@@@@A@A@@@A@@B@@@B@@@@A@@@@@AA@B@A@@AA@@@B@@@B@B@@AB@@@@@@@@@BA@@@@@@@A@@@@@AA@@@@@@@@A@@@@@@@A@@@@B@@@@A@A@@@@A@@@@A@@B@@@@@@A@@@A@@@@@@@A@AA@@A@@B@@@BA@@@A@@@@BA@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@A@@@@@A@@@@A@@@@@@A@@@@@A@@@@@@A@@@@@@AAA@@@A@@@@@@@A@A@A@@@AAA@@@@@B@@@@@@A@@A@@@B@@@@@@A@@@@@A@@@A@@@@@@A@@@@@A@@A@@@@@A@@@A@@@@@@B@@@@@BA@A@A@@BA@@@@@A@@@@@@A@@A@@@@B@@@B@@@@@@@@@@A@@B@@@A@@AB@@@B@@@@@@@@@@@@@A@@@@A@@@@ABA@@@@@A@@@@A@@@@@@A@@@@AA@@@@@B@@@@@@@@A@@A@@@A@@B@@A@@@@A@@A@@@A@@@BA@@@@B@@@BA@@@@@@BA@@@@@@@@B@@@@@B@@@@A@@@@@@@A@@@A@@@@B@@@B@@@B@@B@@@@@BB@B@B@B@D@@@BA@@@A@A@@B@BA@@@@@@@@@A@@B@@@B@BB@@B@@@AB@@@B@@@@B@@A@@@@B@@@B@B@@@@A@@A@@@@A@@B@@@B@@@B@@@@@@@AA@@A@@@BA@@@@BB@@B@@@B@@@BA@@BB@@B@@@D@@@B@@@@B@@A@AB@@@@@BB@@@@@@AB@@@B@B@B@@BB@@@@@BB@@B@BB@@BB@@@B@@AA@@@@@@A@@@AB@@@@@@A@@@A@AB@@@@BB@@BB@@@@AB@@@@@@@@@@B@@@B@@@B@D@@@@@B@@AB@@@B@@@ABA@AB@@@@B@BBB@@@@AB@@@B@@BB@@@@B@@B@@@@B@@@@@B@@B@@@@@@@BA@@@@BA@@BB@@A@@@@B@@@@B@@@BA@@B@@B@@A@@B@@B@BB@@B@B@B@BB@@B@B@@BB@BB@A@@AA@@@@@AB@BB@@B@B@@@BB@@BA@@B@B@@@@BB@@B@@B@B@BB@@BA@@B@@@@@B@@B@@@@B@@B@@@@BB@AB@@@@AB@@@B@@A@@BA@@B@@@@@B@@@@@AA@@B@@A@@B@B@@@@AB@BB@@BA@@B@BB@@@@@@@B@@@@@B@@@BB@@@BB@@@BB@B@@@@A@@A@@A@@@@B@@@B@@BBAB@@@@@B@@@B@@@@@B@BAB@@@BAA@@@AA@@A@AA@@@@B@@AB@B@@@BB@@BA@@@@B@@A@@B@@B@@@@AB@@@@B@@@B@@@B@@B@@B@D@@@BB@@@@B@@@BA@@@BB@@@@BBA@@B@@@B@@@B@@AB@@@B@@@@@@A@@B@@@BA@@B@B@@@B@@@@@@AA@@@@A@@@@B@@AB@B@B@@BB@@@BB@@@B@@B@@B@@@B@@@BBD@B@B@@BB@@B@BBB@B@@AB@B@B@BBB@B@BA@@B@B@@@BB@BB@B@@@@BA@@B@@@@BB@@BABAB@B@B@@@@@@B@@A@A@@@@B@@@@B@B@@@B@@@B@B@B@@@B@@AB@@@B@@@@BA@@@B@@B@@@@DBB@B@@@BA@@@@B@@@@@AA@A@@BAB@@@B@@@@@BB@@B@@@@BB@@@B@@@@B@@@@B@BA@@B@@B@@@B@@BBB@@@B@@@@AB@@B@@@@@BA@@@@@@B@@@BA@AB@@@@@BB@B@B@@@@@@@@@A@@A@@B@BABBB@D@DBB@@BB@D@@@B@@B@@@B@@B@@BB@@@@BB@B@@@BB@@@@B@@@BAB@B@BB@AB@@@B@BBB@@@@B@@BB@@@B@@@@B@B@B@B@B@B@@@B@BA@@B@B@B@B@@@B@B@@@B@@A@@@A@@@AB@@@B@@BB@@@B@B@@@BAB@BAB@B@@@@A@@BA@@BA@@B@@B@@@B@@@BB@B@B@@@B@@@B@@@B@BB@@B@@@@@B@@@@@@@@A@@@@A@A@@@@A@@@@BAB@B@B@B@BA@@BBB@B@BB@@B@@@@B@@@BB@@@@@B@@A@@@@@@BA@@@@B@@@BBB@@@BBB@@@B@@@@@@A@@B@@A@@@@B@@A@@@@B@@A@@@@@@BA@@@@@@@@B@@A@@B@@@@@B@@@@@@@@@@A@@B@@@@@@@@A@@B@@@@@@A@@@@B@@@@@@A@@@@@AB@@A@@@@@A@@@@@@@AB@@@B@@@@@BA@@B@@@@@BA@@@@B@@@BA@@@@B@@@BA@@@@B@@A@@@@BA@@@@@A@@@A@@@@@A@@@A@@@@@A@@@A@@@@@A@@@@@@A@@@@A@@@@@@@A@@@@@A@@@@@@@AB@@@@AB@@@@A@@B@@@@@@AB@@@@@@A@@@@B@@A@@@A@@@@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@@@@AB@@@@@BA@@@@BA@@B@@@@AB@@@BA@@@@@@B@@A@@DADCD@BC@C@@BA@A@@AA@A@@@A@A@ABAEAWB@GBIDKBIX@DC@EVD\\BNBLBH@BA@@BA@@BAB@B@B@B@B@B@BA@AAAAA@ABA@A@@@@AA@AA@AAA@AAA@A@AAA@A@@AA@@@A@A@A@@@AAA@@@A@@@A@@@A@A@@@CA@@A@@@A@A@A@@@A@@@AAA@A@@@A@A@A@@@A@A@@AA@A@@@A@A@@@A@A@A@@@A@A@@@AA@@A@@@@@A@@@A@A@@@A@@@A@@AA@@@BC@GBCDA@@BA@@@A@ABE@A@@AAC@E@C@EAC@A@FOM@EAAB@ABCBCBC@AAA@@A@A@@@BCBCDEBCBAEAOCIC@@@@BAFEFEBADCBAyKaADUC_LSn@rEhC@@@A@A@@@A@@@@@@@@@A@A@C@E@A@A@@BA@@@@@@@A@@B@@A@@@@@A@@@A@@A@@A@@@@@A@@@A@@@@@AA@@A@@@A@@@@@A@@@@@A@@@@AA@@@@@@@A@@@A@@@A@@@A@@@A@@@A@A@@@A@@@A@@@AA@@A@A@@AA@@@A@@@@@A@A@@@A@@@A@@A@@A@@@@@AA@@@@A@@@@A@@@BA@@@@BA@@@@BA@@@@B@@AB@@@@AB@@@@A@@B@@@@A@@@@@@BA@@@@@@BA@@@@@@B@@@@@AAAAA@AAAA@@@@AAA@AAA@AAACECA@A@CAGCEACAA@AA@AA@@AA@@@A@@A@@@@AA@@@@@A@@@@AA@@@A@@A@@AA@@@A@@AA@A@@AA@A@AAAA@@@AA@@C@@@@@@A@@A@@@@@A@@A@@@AAA@AAA@@@AAA@@A@@AA@@@A@@@@@A@@@@@@@A@A@@@A@@@A@@@A@@AA@@AC@@@@@AA@@A@@B@@A@@@A@@@@@@@@@A@@@@@@B@@A@@@@@@@A@@@@@A@@@@@@@AA@@@A@@@A@@BA@A@@@A@@@A@@@@A@@@@@@@A@@BA@@@@@A@@@@@@BA@@@@@@@A@@@@BA@@@@@A@@@@@@@@BA@@@@@@@@@@@A@@@@@@@@@A@@@@A@@@A@@@@@A@@@@@@@A@@@@AA@@@A@@BA@AA@@A@A@@A@@@@@A@@@A@@@@@@@A@@@@@@BA@@@@@A@@@@@@@@BA@@@@@@@A@@@@BA@@@A@@@@BA@@@@@@@@@A@@@@B@@@@A@@@@BA@@@@BA@@@@@@@A@@B@@A@@@@@@@@BA@@@@BA@@@A@@@@@@B@@@@A@@@@@A@@@@@A@@B@@A@@@@B@@@@AB@@@@@@AB@@@@AA@@@@@A@@@@@@@@A@@@@@A@@@@@A@@@@@A@@B@@@@@@@B@@@@@B@@@BA@@@@B@@@@@@A@@@@@@@A@@@A@@@A@@@A@@@@@A@@@@@AB@@@@@@A@@@@BA@@B@@A@@B@B@@@@@BA@@B@B@@@B@@@B@@@@@B@@@BA@@@@BA@@@@@A@@@A@A@@BAA@@A@A@@AA@@@@@A@A@A@A@@@AAA@@A@@AC@@AAA@@@@@A@A@AA@@AA@AA@@A@AAA@A@@A@@A@@@@@BA@@@A@@@A@A@AA@@@CAA@@@A@@@A@@@@@@AB@@@@@@A@@@@@@@@@A@@B@BA@@@@B@@@@A@@@@@AA@@@@@ABA@@@A@A@@@AAA@AA@@CCA@AAAA@@AAA@@AA@@AA@AA@@@@@@@A@A@C@C@C@A@A@@@AA@@@A@A@@AA@AA@@@@@@B@@A@@@@@@@AAA@A@@@@@@@@@A@@@A@@@ABA@@@CBA@ABA@@@A@A@A@A@C@@@@@@BA@A@@BA@@BA@A@@@AB@@A@@@A@@@@@A@@@@@AA@@A@@@A@A@A@A@@@A@@@A@@@@@@@A@A@@BA@A@@@A@@@CBA@@@A@ABA@A@A@@@@@A@AAA@A@A@@@@@A@@@A@@A@@@A@@A@@@@BAA@E@@@A@C@GB@@C@C@EC@@C@A@A@CB@@A@A@A@C@C@CA@@A@AAA@A@A@CA@@A@C@@@CB@@A@A@A@A@@@CBC@@AA@@@A@A@A@@@A@A@A@A@@@@@C@AAA@A@A@ABA@E@IAG@G@E@C@E@C@C@A@AAA@A@CAC@A@C@CAA@@@@@E@C@@@@@C@C@A@A@ABA@@@A@A@@@A@@@C@@A@@@@A@A@A@A@A@@BA@@AA@A@@@A@AAA@A@@@A@ABC@A@A@A@A@@@C@C@EAG@C@A@A@E@A@@@A@@@C@AAE@@@@@E@E@CAG@C@C@A@@A@@C@A@A@E@"],encodeOffsets:[[129406,48863]]}},{type:"Feature",id:"230231",properties:{name:"拜泉县",cp:[126.100213,47.595851],childNum:1},geometry:{type:"Polygon",coordinates:["@@IAIAA@E@G@KAA@IAC@C@G@GAC@I@C@C@AAC@C@@@@@@DCN@D@@@@@@@@@@@@@@@BAD@B@@@B@@@B@@@BA@@B@B@@@B@@@@AB@@@@ALAJTDL@@B@DA@@B@B@BAF@DAH@B@BAB@B@B@B@B@BA@CBABABA@@@@@ABAB@B@@ABAB@BCB@BABA@@B@@B@B@D@D@BBF@B@@@B@B@B@BBB@D@@@B@BBB@B@B@@@B@D@DBF@@J@HB@A@@B@B@D@B@B@B@B@FAD@B@@@@BBAH@JB@D@D@@BD@D@@@B@@@@@NBHB@@@@@D@D@BA@@D@F@FADAFAFAH@J@@DBBB@@@B@BAD@@@B@B@@AD@@@BAB@@@@@B@BAD@B@BAB@BFBB@AB@BABAFADAD@BCF@B@B@@DBH@B@@@F@DBF@D@@@B@B@JB@@B@B@D@D@DBD@D@BBF@B@B@H@BBD@B@@@@B@FAD@D@H@FAF@J@@@B@BA@@BB@@@@@@B@DAD@@@@@@XBF@ANA@AP@@@@@BAB@BAJ@B@DAD@FAB@B@@C@CAA@@@A@A@@@E@A@A@@@@@@BCFAJALAFBDDBDBJBJ@D@BB@@BB@@@BAD@B@BAB@BA@C@C@@@C@A@@@CB@@@B@B@@ADAD@@@B@B@BBB@BB@B@BBB@BBB@BB@@@B@BAB@B@B@BAB@B@B@B@@AB@B@BAD@B@B@DAB@B@B@@@@@B@F@B@@@BAD@FB@@BBF@F@@CDED@@A@KF@BAB@@CDABCBABED@@ABAB@BCAG@GAE@@@C@A@C@A@@B@D@DAB@D@DAD@DAF@D@B@B@BADAB@B@B@BAD@B@B@@@@ABA@AAC@A@@@@@AD@DAB@D@B@D@@@BAB@@@B@B@D@BAD@@@F@@A@@@ABC@CB@@A@A@@@A@@@@@@B@@@@@@@@BB@@@@@@@@@@@@BB@@BBB@@@@@@B@@B@@@@@AB@B@DAD@B@@@B@BAD@B@D@BAB@B@B@@@B@BAD@B@B@BAB@B@@@DAD@DAB@@@B@@@DAB@B@@@B@@@BAB@D@@@BAD@@@B@@@B@@AB@@@@@B@BAB@FAD@B@BAB@D@DABHBF@H@BBB@@@@B@BAD@F@BADAHAFAH@FCJ@DKAG@G@C@AAA@A@A@G@EAG@G@A@EAC@A@E@GA@@A@A@C@A@C@AHAF@DAB@B@B@@@@@B@BAB@B@F@@@@AB@@@B@@AD@HCLB@@B@@BA@@BBB@F@B@F@HBB@B@B@B@B@@BB@D@B@F@D@DBD@D@B@@@@@B@@@B@@@@@B@@@B@@BB@@@B@@@F@H@F@JBNBJBD@D@RB@@@@B@@@@@B@@@B@B@B@@@@@JBL@HBD@H@DB@@@@B@B@@@@@F@RBTBPBJBD@B@@@@@B@D@@@BBD@B@@@B@B@@@B@B@@@B@@@BB@@B@B@B@B@@@B@B@BB@@B@@@@@B@@@@@H@JBF@FBJ@J@FBF@B@@@BBH@B@DBD@B@D@FBFBBBB@BBB@D@D@B@B@DBD@B@D@B@B@B@D@@@@B@@@@@@B@@@@@@@@@@@B@@@D@HB@@B@D@JBB@B@F@B@NBB@B@B@B@D@D@B@B@F@B@B@@CDBF@B@D@D@D@@@F@FBD@B@D@@@H@F@B@B@FBB@B@D@B@B@F@D@B@DBB@B@B@@CF@D@D@D@D@BBB@F@@@B@F@D@DB@@H@F@F@DBD@D@B@F@B@FBD@D@@@B@@@D@B@B@B@D@B@H@FBD@F@D@F@D@F@H@F@F@F@F@F@BBB@@@B@B@D@D@D@D@@@B@B@D@D@D@F@DBF@D@F@D@D@F@D@D@F@DBF@F@F@D@F@D@B@BBB@D@B@D@B@D@D@D@D@DBB@F@D@B@F@F@F@D@D@HBF@D@D@F@F@B@HBB@D@H@H@H@H@LBF@J@F@H@D@D@HBH@J@F@F@F@F@H@FBF@D@@@@D@F@D@@B@NBJ@F@LBF@P@B@D@FBH@D@B@@A@@@A@A@@@@BA@@@@@A@@B@@A@A@A@ABA@A@A@A@@@@@@@AB@@A@A@@@A@@@@@A@@A@@A@@BC@C@A@EBA@C@CBGBBD@F@B@B@@@@@B@AEECBCB@DA@AAA@AAAEE@C@IB@BCB@@C@AA@@A@ACGA@CABABA@ABAAA@ACAA@AAAC@AA@@AAA@ACA@A@C@A@AACCCB@@@B@@@BBB@B@B@@@BA@CBC@A@A@A@@@A@@B@BAB@B@B@BA@@@@@ABC@E@A@A@@@A@@@@A@A@C@A@A@C@A@AAC@A@C@@@@ABABA@ABC@C@AC@C@@A@A@@@@@@@A@@@A@@B@@@@@@@B@B@B@@@B@@@@@@@BA@@@@@@BAB@@A@@@@B@@A@ABE@C@A@@@@@E@EBE@@@A@@@@BAB@@@D@@AB@@@@AB@@@@A@C@CBA@C@A@C@A@A@@@@@A@A@@BA@C@@BA@C@E@A@A@AB@@A@A@@B@@@B@@@B@B@@@D@@@@@B@B@B@@@B@B@@@@@BA@@@@J@DBH@L@DBD@F@H@DBH@D@B@B@B@B@D@B@BBB@@@D@B@@@@@B@B@@BB@@@B@@@B@@@@@B@@@B@B@D@@@B@BBD@@@F@B@F@B@DBB@B@D@D@D@D@BBB@@@D@D@D@D@B@D@FBF@D@D@B@FBB@@@B@D@D@B@B@B@@@B@@@B@BBD@B@B@D@FBB@B@B@D@@B@@D@F@B@B@B@DBB@B@B@F@@@B@B@B@@@B@@@@@@@B@@A@CBCF@B@B@D@@@@BB@D@D@H@DBF@F@@@@@F@BBD@@@B@@@B@F@B@B@D@H@FBD@D@@@B@B@B@B@D@BAB@@@B@B@BBB@@@B@B@@BB@@AB@B@B@B@B@@@@@@BD@@@B@@@B@B@@@B@BAB@B@D@D@@@@@D@F@@@@@B@DBD@B@D@DBB@B@BBB@D@D@F@D@F@H@H@JBF@B@BAB@B@B@BBD@@@@@B@B@B@B@@@B@B@B@@@B@@BD@DA@@@@@A@@@@@A@@@A@@@@@A@@@@@@@A@@@@@@@A@@BA@A@A@@@@@A@ABC@A@A@ABC@A@A@@@A@A@@@@BC@A@A@@@@@A@@@CB@@A@A@A@A@@@A@@A@EAA@A@@@BA@@@A@A@CB@@A@@@A@@@@@@@CBAA@@@DWBA@AB@@A@A@C@E@C@CBE@C@C@E@CBE@C@C@E@AA@@@@ABC@C@EBA@AA@@@@@A@C@AA@@@@@@@ABC@EBA@A@@@A@@@CBA@A@@@A@CBA@A@A@CBADABCBABABADA@ABABABCB@@AB@BA@@B@@AB@B@@@B@@@@@B@B@@@@@B@B@DBB@@@B@@@B@@@B@@@@@@@@@@@B@@@B@@@@@@A@@A@@@B@@@@AB@@A@@B@@@@A@@A@AAA@AA@@A@@@@@@AB@B@@@@AB@BA@@@@A@@@A@A@@@AB@@@@A@@@@A@@AAA@@@AA@@A@A@A@@@@A@@@@B@@A@@@@AA@@A@@@BAA@@A@@AAA@@B@@@BA@@@@A@@@@@AB@@AB@B@B@@@@AA@@A@@A@@B@@A@@@@@@A@@@A@@@AB@@AA@A@@@@AB@@@BAB@@A@@@@@AA@A@@B@@A@@A@@@A@A@@@@A@@@A@A@@@AA@@@@BA@@B@@@@A@@@A@@@AAA@@@AA@A@A@@@@A@@@@@AB@@@B@@BB@B@@@@A@@@@@A@@@@@A@AB@@AB@BA@@@@@@AA@@@@A@@B@@A@@AB@@A@@AAA@@@@@@AAA@@@@B@B@@@@@@AA@@AA@@@@A@@@@A@@@@@@@BA@@B@@A@A@@@AA@@A@@@A@A@@BAB@@@@@@AA@@AA@@A@@AA@@@A@@A@@@@@A@@@B@@AB@@A@A@@B@@A@@CAA@@A@AA@BAAA@@@@A@A@@@AB@@@@@A@A@ABA@ABA@A@@@AA@@BA@A@A@@@@AA@@AA@B@@@BA@@A@A@A@@A@@BA@AA@@AA@@BA@@@CA@@@@@AA@@BA@@@A@@AA@BABA@@@@@A@AA@@A@@@@@AB@@@@A@@AAAA@@@@@@B@@A@@@A@@B@@@BA@@@@@@B@@@@@@@@A@@@A@AB@@@BA@BB@B@B@@@@@@A@@@A@@A@@AB@@@BBB@@@@A@@@AB@BA@@BA@@B@@AA@A@@AA@AA@@@@@AB@@AA@@AA@A@@BA@A@@@AA@A@@A@@@@BA@@AA@@AA@A@@@@@A@BB@BA@@@@@A@@@@@@AA@@@A@AB@@A@A@@@A@@@@A@@BA@@@A@@A@@@A@@AA@@A@A@@BA@@AAA@@@AB@@A@@@@@@B@@A@A@AACCAABA@A@A@AA@AA@A@@B@@@@@@A@AD@B@BA@@B@@@AB@BB@@@BA@@@A@@BA@@@A@@AA@AAACA@@@A@A@@AAA@A@@A@AA@AAA@A@@A@@B@BAB@B@@A@A@A@@A@@@A@ABAB@@A@AAAAA@A@@@AAB@@AB@@A@A@@A@@@A@@BA@AB@@A@@@@@@A@A@A@A@AA@A@A@@ABA@@@AA@@@C@A@A@@A@@B@DAB@@@ACAA@A@A@A@ACAAAAA@@@ABA@A@A@@AA@@A@@@ABA@A@AA@A@@@A@AB@BA@@@AA@@@A@ABA@A@CAA@AA@@FA@A@AA@A@A@@BA@@AA@A@@A@@BA@A@@AA@@C@@@A@A@C@@AC@@@A@A@@A@@@ABC@AA@A@@@AB@B@B@@ABA@@AAAA@A@AA@A@A@ABA@AAA@@C@AACCA@@A@ABA@@@@B@B@@@@@@A@@BA@AA@ABA@@A@@@@D@B@BA@@AA@@@A@@@@B@A@@AA@@@A@@@E@C@CAC@E@E@CA@@A@C@@@C@G@E@KAK@GAA@C@A@A@@@CA@@G@KAE@C@EAC@C@C@EAA@A@C@A@C@@@A@AAA@@@A@E@C@@@A@A@C@EAE@C@A@KAK@GAA@@@@@A@@@A@A@@@A@A@CAA@A@C@@@A@A@A@@@A@@AA@@@A@A@@@A@A@C@E@G@EAE@C@C@GAG@E@EA@@E@I@CAC@A@A@@@A@C@A@@@AA@@C@C@C@A@A@A@C@A@AAC@A@A@@@C@AAA@A@C@A@C@A@A@A@AAA@A@A@A@A@A@A@A@A@A@A@@@C@A@A@A@AAA@A@C@A@A@AAC@@@A@@@A@C@AAA@A@@@A@A@C@C@C@@@@@A@@AA@@@A@A@A@A@@@A@A@A@@@A@@@A@C@@@A@A@A@A@A@@@A@A@@@A@@@@@@A@@@@@@A@@@@@A@A@@@A@A@CAA@C@C@E@CAE@C@C@C@CAA@C@A@C@A@A@CAC@@@E@C@EAC@C@A@C@E@C@EAC@C@A@G@A@CAE@C@E@C@EAE@C@C@E@CAE@E@C@CAE@@@@@A@A@E@E@CAC@A@A@C@C@CAC@A@A@I@EAE@OAA@@@G@E@C@QASAOAIAE@I@MACAI@MAIAC@C@GAM@KAA@A@A@CAC@A@C@A@EAM@KAWAKAOAK@OAE@AAC@C@C@MA@@A@A@A@A@@@C@AAA@@@C@A@A@A@C@A@@@CAA@A@E@A@A@A@A@@@CAA@A@A@A@@@A@A@C@A@A@@@A@AA@@C@C@A@A@A@@@C@A@AA@@M@SAK@@@E@E@CAC@C@E@C@CAE@C@E@AAC@A@A@E@E@CA@@A@A@A@@@@@@B@@@B@@@DA@A@@@AA@@@@A@E@E@C@A@@@C@E@A@C@@@AAA@A@@@A@A@C@@@A@A@A@A@A@CA@@@@A@A@A@A@@@A@A@AAA@@@A@A@C@C@@AC@A@C@A@@@@@@@@@A@@@AAE@E@CA@@E@C@E@@@A@@@A@CAC@C@A@C@AAE@E@C@A@"],encodeOffsets:[[128640,48442]]}},{type:"Feature",id:"230281",properties:{name:"讷河市",cp:[124.88287,48.466592],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@GAEAIC@@@@AAA@C@C@C@E@C@E@E@A@EAC@E@C@G@G@A@E@A@A@E@EAI@C@A@C@I@AAE@@AA@A@@@a@KBEBE@C@@B@B@BBBDDHDFBHBJBLDBB@FAD@@CDCBGDGDABAD@BBBBBFBFBD@BADAHADAB@D@BBBDBBBD@F@DBDFDDBBBD@B@DBJ@H@FBBBBDBDAB@BABIBEB@BAB@B@B@D@FDDFDDDDFFHFNBD@FADEF@B@BBDBBDBFBFBFBFDHFFDFDLDHFHBFDF@F@D@HCFAF@DBDBBD@BADADBBBBDBF@FBHBD@FAD@FAD@DBD@BBDB@D@B@DBB@B@BBBB@DBDBB@DBBD@BADABAD@B@BBDDBDBDBD@F@DAJCD@DAD@DBDDBBBDAD@DBDBBFDHDDDHFDBDBF@DBBB@B@BA@AB@BADBBBBB@@D@BAB@@ABCBI@A@CBEBGBABAB@@@BBBDBBBDBN@FBBBBB@B@BAB@@@DC@CDABAB@B@BBDBBNHFDLFJDHDBD@BAFBB@BFBDBDDDBBB@BADABABABBDBDBBB@HBDBHDBDBDAB@DEDCD@D@DBDFDPFB@DDDB@F@B@B@DCDCDEDGBABC@I@E@CBCD@DBD@DB@BBH@FBB@@D@BCFAD@D@BBBFDBBB@LBFBDDBD@FADGDCDGDEBAFENCDCBEDIBCBCBCBCFCFAD@DBDDDDDDF@H@D@HADCDABCDGFUFGBEFAF@HDDDDDBBDBD@FCDCFAF@D@BBHBHDJBFAD@FAFABABABEDCBABCDCFEFAF@B@B@DDBD@DDF@F@FBHDJFHDNFRDDBBBBD@BADCBCDKFCDCJ@@AF@JABADAB@F@DAFAHAJ@FBFDFDDFBFDBB@@DDDF@@@DADADEDA@IFIDMBGDEDAF@DDDJFRHHD@BJJFJDDFDD@HAJCFCB@DBBBBB@DABADCBAD@D@DDDFBHDJDDB@BBF@BADCDDJBJBDBDDBDBDFHFHDJFBFBN@DAR@JBBBDNJFFBB@B@D@B@@@DBD@B@B@BBBBB@@@BB@@@BL@B@D@F@DBBFB@D@BADCHAHALBFAFCFALAHCF@B@BAD@JBHPJJHJFLJPHB@PJBB@BBD@FADCDEJABCJET@H@FDJHNDJDFHDD@LDD@FBBBB@DBDBD@D@DA@@JCF@HBFDBD@FCFAHDFFDFDFDHHDJDL@@BDBB@@@BBBAB@D@BAB@@OHEBCBCBAB@B@BBBFBH@JDDBBB@F@F@BADABCBCDAB@DDDDB@@F@HAH@^@P@JFBBD@@@L@@@H@F@D@D@BBB@DBF@FBJBTYXGbAjEXI\\KJCHLF@@@DABAB@F@B@B@@@BAFA@@B@BAB@@@F@F@B@@AB@D@B@B@BB@@B@B@@@DB@AB@@@B@BAB@@@F@F@B@@@DCB@@@DBBB@@BBB@B@BBB@@@B@@@@@B@@@B@B@B@B@B@B@@@@@@B@@B@@@@@BA@@B@@AB@D@@@B@@AB@@AB@B@B@F@B@D@B@B@B@B@@@@@@@BABAF@J@D@HCLAFCFADAB@B@BBHBD@HDD@FB@@HBB@@ABCBC@@@@@A@ABCBE@ABEBCBC@EBCBEBCAAACACCEAC@@AAC@@@@@@A@@AA@@@A@@@@@AA@@@@EBA@A@@@@@A@ABE@A@@@@@A@@@CBC@@@M@@K@AA@@@@A@@@A@@@A@@@@@@A@@@A@@A@A@@@C@@AC@@@@BA@A@@@@@@@@@A@@@AA@@@@@@A@@@@@A@@@@@A@@@@@@@@BA@@@@B@@@@@@@@@@@@@@@@A@@@A@@@@@@@@@A@@@@B@@@@A@@B@@@BA@@@@@A@@@A@@@@@@@A@@@@@@@A@@@@@@@AA@@@@@@@@@@@@BA@@B@@@@@@@B@@A@@@@B@@@@@@@@@@@@@@@A@@@@@@@@A@@@@B@DA@@@A@A@A@@B@B@B@BA@@BA@A@@@A@AB@@@DA@@DAB@@@DA@@B@BADA@@DAB@BABADA@@@AB@B@D@D@D@@@B@@ADA@@@A@AB@@AB@B@@@DA@@BA@@F@@@BAB@@A@AB@@A@@DA@A@@B@B@BA@@FB@AA@@ABA@A@A@CB@@A@A@AAA@A@@@C@@@C@@@E@A@A@CBAAAB@@C@@BC@@@A@ABA@A@A@ABA@C@A@@@CBCAABADCD@BAB@B@FAH@DAB@DABABAB@@@@BB@@@BBB@D@B@B@DBB@@@B@@B@@BBB@B@@BB@B@BBB@B@@BB@BB@@B@B@B@B@BB@@@@B@B@@@@BB@ABABABEFAD@B@BB@@CB@@A@@BADAFEB@@A@@@@B@@AB@B@@A@@B@@@B@@CDEDCBABA@AB@BADAB@BAB@@@B@B@@@B@BAF@F@D@@A@ABA@@@C@@@@@GB@B@B@B@JBJ@F@@@LBJ@FBD@B@F@HBJ@D@HBH@JBD@F@@ABA@@@A@@H@D@B@B@@@DBB@DBDBDBBBBBBA@@B@@ABA@@@AB@@@@@@@DABA@ADCFCBCDEBABCB@D@D@JB@@BCB@B@BAD@DAHA@J@D@D@D@FF@F@D@B@B@F@B@L@JBJ@J@L@J@N@J@D@B@@BB@HDBBDDDBHDBBDBDBBBHFJDHHJFJDJFDDDBBBFDHDFDDBFFFBDDFBJHJDHFHFDBFDJFDBDAD@B@N@@ADADCBAB@B@PASQCkIUAAACQDIMMDUWIEOKKFGEIIU@@EMMBGMEEEOBEBKKE@O@GADOUBOGMBEG_IECACKAQDE@@CKBICDOKAI@KGDSVBBBR@@NLCHEDCDCFEPBR@L@J@BF@HJ@JBF@P@FGF@HFNFPJF@XEJJRDZJ@DT@BXNH@@GLALN@XILGXLJOPKRAEHhDT@N@@@B@LAHELILIFBfFlHrPFCPBD@B@NAD@B@D@TGP@NHZHPGVBHJEDXLIFJ@JFLLDJD@NBD@FCNAHHKJCFCLCFMHBBFHXFXJ@HKFBBRNMHENQNCJNLCLSAIASEQFKAS@K@OFE@MHVZQ@EJIB]BM@SCEDIA@]EIU@CGOAO@MBM@K@OAGHK@I@MIG@AFEBGBKCGEQCIKOEIFF@D@DAB@BBB@BBB@@B@BBH@FHF@D@V@D@B@BB@B@BB@@@@B@@@A@@B@B@BBF@D@@BDBDF@F@@@D@B@D@BBB@BBD@BBB@DDDBFBBBBB@BBDBBBB@@BBBB@BDBDD@BB@@BBBBDBBDB@@@BBB@@DFBBB@@@@@FB@@B@@@B@@@@@B@B@@@B@@@@BB@A@@BB@@@@@B@B@B@@@B@BBDBFDFBFGHDLBCH@@FBF@\\DZHtNB@RHRH@@@ABC@ABEBEBE@EBA@EBA@EBADO@C@AD@BALCDCBAHEHGHEHG@AB@@@DCFCDCFEDCFCDCFEFCFEDADCFCDCDABABAD@F@B@H@F@H@H@F@BBFBFDHDHDHDFBHDFDHDFBB@BB@@@@RDNBLB@@F@FBH@@@B@BB@@@@@BB@AB@@AB@@@@BB@@@BB@BB@@BB@B@@B@@B@@BB@B@BBB@@@B@BBB@@@BBB@B@@BBABBD@@@@BB@@DBBB@@B@B@B@@BDB@@@B@BBB@@DDB@@B@@BB@@@BBH@@@BB@BB@@@@PCB@HBB@L@B@B@@@B@@C@AAC@ABABCBEDAFCFA@@BEBC@@@@BA@ABABABEDC@@BABAB@@A@@B@@AFCDCFAFCFCDADADADAB@DAJCLCDCDCFAFCFADCBABAHGFE@ABA@AB@BABAD@DAHCFAFCHAFAHCHAHABAF@BAFEDAHGDAJGJGFEFEJGJGBABA@@@A@@@A@@@A@A@A@A@A@A@@@A@@@A@AAQ@A@A@CC@AA@@GC@AA@@@@A@@@C@@@E@@BC@AOEA@@@C@@@E@A@@ACAAA@@@@A@@BA@@B@@A@@@@@C@A@@@A@ABA@@@EBKBA@ECECEAECEAECGCCACACAECEAGCECGCCACACAAAC@ECECCACAA@AACAEAECEAGC@AC@@@@@BCDCBEBCBADGDEBCFGBEDEDEEAE@C@IBG@GBE@A@AAAA@@@@@AAAA@@A@AAA@@@A@A@ACE@AAAA@@@AA@@A@A@CBEBCBC@A@A@A@A@A@@@A@@A@@A@@A@A@A@ABC@ABA@ABA@@AA@AA@@@A@G@@@CAGAA@A@AAA@A@@AAA@AACECAAAAC@AAA@A@CBA@CBEBA@@@A@A@A@C@A@C@AA@@@@A@A@ABE@A@@@A@CA@@@@@@@B@BAB@@A@A@@BA@@@@@A@CACC@AAA@@@AA@@@A@@@AB@AEGACCE@AACAB@@C@CBA@A@ABA@@@A@EDEDEBC@GBE@E@C@EBE@E@DFFHBDCHC@MAK@AM@EAEAG@EAEAI@CAC@EAE@C@EAC@A@AAC@A@@B@@C@@A@@AA@AEAC@@AA@A@@@A@@AA@AA@@AC@@@A@@@@AAAACACAA@A@@@ABA@@@AA@AAA@C@A@ABA@@@C@A@@@AAA@AAA@AB@@A@A@@@AA@A@AA@@AA@C@@@ABA@ABC@ABC@CBAB@BAB@@@BABE@@@C@CBABA@A@C@A@@AA@A@A@@BABA@@@CAA@A@@@A@@B@@@B@B@B@B@@ABA@A@A@CAA@AAAA@@@A@AAA@@A@A@C@A@A@A@ABA@@@A@@@CCAAA@AAABA@AB@BA@@BA@A@A@A@@B@@@BA@@BA@@BA@A@@B@@BB@@AB@@A@A@A@@@AA@@@C@C@A@AA@@AA@AAA@CA@A@A@AA@@AA@C@A@EBA@A@ABAA@@CAAAC@E@AAA@@@AA@@AAA@ABA@A@@@AA@@@A@A@AB@@A@@@@A@C@A@A@A@@AAA@AB@BC@A@A@C@AA@AAC@CBA@AB@@A@CA@@A@@BABAB@BAB@@@B@B@B@B@BA@AB@@A@A@A@ABA@A@A@@A@@@K@A@E@A@ABABE@CDIBI@@@@@@@@@@@A@@@@B@@A@A@A@@@A@AB@@@CA@AB@@BBC@C@@@CB@@C@CBA@@@A@@@@@@@A@@B@@A@@@A@@BG@C@ABA@C@ABADO@C@@@A@@@ABA@CBC@A@@@A@@@@@@@A@@@@A@@ADB@@@A@CDMBG@A@A@CBC@@@A@@@A@ABA@C@@@AB@@A@A@A@ABA@@@C@@@C@@@@B@@@@@@C@C@@@ABA@A@A@@BC@C@A@@BC@A@@@@@A@@FB@A@@@C@A@CBC@C@@@A@A@A@ABA@CKAS@aDK@[NYHU@OGYQaMEM@c@oB]HGBO@MC_IKKAACEGAOCU@WAEAQOLUHU@KEIICSAEAEGEIEGEEECMCUCaAQAkAMAE@GAIEGEGOAABaBUDCD@BKB@V@BI@CH@H@ALAFPBAJCHAFNBB@AFRBBEL@NB@EBO@CG@C@@E@@@AAAG@EA@@@ABA@C@CBC@C@@BCEA@@@@@A@A@CBCBC@C@ABC@C@ABA@A@@@@@@@A@@@@A@C@@@@@@A@@@A@ABA@A@A@A@@@A@ABA@@@@@A@A@A@@@A@ABA@@B@@@B@B@@@@@@@@@@@B@@A@C@A@A@A@A@@E@@@@CB@@A@A@A@A@A@A@A@@@@B@@@B@B@B@B@@@@C@A@A@A@A@A@C@AAAB@DB@AAA@A@A@A@A@@@AAC@@@A@@@A@A@@AC@@@A@A@@@A@@@AAA@@@A@A@@B@@A@A@ABC@@@A@@@A@@@ABA@@@A@A@@@A@@@ABA@A@A@@C@A@C@@@AA@@@@@@A@@@BA@ABC@A@A@AAB@A@ABA@A@A@A@A@ABA@@@@B@@A@ABGBC@@@A@@@@@CBA@C@@BC@A@@@A@@@A@@B@@@@A@A@@@A@ABA@@@@@A@@@@@A@A@@BA@A@@@A@A@@B@D@D@D@B@@@@ABA@A@A@@@A@A@A@ABA@@@A@A@A@A@@B@@A@@@@@A@@@A@@@A@@@ABA@A@@@@@C@@@@@@@A@A@@@A@@@@@A@@BA@@@@@@@A@C@A@@BAC@C@K@K@QBG@A@I@I@M@@@I@OBI@K@C@S@EBA@O@G@QBG@C@KFEBCBS@SBC@BC@EM@E@EAI@E@C@MAG@OAG@QAG@MA@@A@E@CAE@E@C@G@IAE@C@KAG@I@I@A@E@E@C@I@G@E@@@S@Q@QAM@A@E@A@K@K@G@A@E@@CBGBI@CB@@@@C@A@ABC@C@A@@@AC@CAA@E@@AC@E@C@@DE@A@I@C@EA@@@A@@A@CAG@E@E@GAE@C@A@@@CBA@C@A@@@EAA@A@A@CBA@A@AAA@C@A@A@A@E@C@C@E@CAC@C@G@C@@A@A@C@@@@@C@@A@EAA@E@GAC@A@CAA@C@A@A@EAK@IAAB@@E@@@A@@A@@BA@@@A@@@AB@A@@@AAC@A@A@AAA@C@E@@@@ABA@@@A@@@A@C@ABA@A@A@ABA@A@A@CE@A@BA@C@ABC@A@AA@ABC@C@CAC@A@C@C@C@A@@A@@BCBCBAG@E@@@@AA@E@G@GBA@EAC@A@A@A@CA@B@BGAAAE@AACBGBA@C@E@EAG@C@C@@A@B@HBDC@@B@DADAFAD@DADAFAF@B@BAB@D@B@FBD@@@B@B@BBB@@B@@D@@@B@B@B@B@FBB@B@B@B@BSAG@G@EAA@@AB@@AA@C@C@OAMASAQAA@AAE@A@A@C@@@A@A@A@A@@AC@@@@BA@@AA@CAA@A@C@C@C@G@CAE@C@G@A@@@G@@BBBBB@@A@C@CB@A@A@A@AG@C@E@Q@S@IA@@AAAE@AAA@A@@AA@A@A@AA@GAGAICGA@@@@C@@@AAC@A@AAA@A@AAA@A@CAA@A@CAC@CAA@CACAC@EAA@A@A@KCCAE@OEC@GACA@@GAC@EA[GC@"],encodeOffsets:[[127409,49126]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("双鸭山",{type:"FeatureCollection",features:[{type:"Feature",id:"230502",properties:{name:"尖山区",cp:[131.158415,46.64635],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@AA@@AA@A@@AA@@@@@A@@@AAA@@@@@AAC@AAA@A@@CC@A@@AA@@AAECAC@BA@AB@@@@A@@@@BC@@@A@@BA@A@@@A@@BA@@@@BA@@@@@C@A@C@@@@@@A@@CAAA@@AAAAAAA@@@@@@@A@ABA@@@@@CB@@AB@@A@@@C@@@C@GB@@A@C@A@@@A@@@C@@BA@@@A@A@E@@@A@E@A@EBA@E@A@@@A@@@@A@@A@@@A@@@@@A@@@AB@@@@AB@@@@AA@@@@@A@@A@@@@BA@@@A@@B@@A@AAA@A@@@A@@@A@C@@@@@A@@@ABA@A@@@@BA@B@@@@BB@@@@@@BB@@@@BA@@@@@@B@@@@@@@@@BB@@@@BA@@BBB@@@BAB@@@B@@@B@@@B@@@B@B@@@B@@@B@@@B@@@B@@@@BB@@@@@@@@DBBB@BB@BB@@@@@@@B@@A@A@@@A@A@AB@@@@A@@@@@@@A@@@A@A@A@I@@@A@A@C@A@@@A@A@@@A@@@EA@@A@A@A@AAA@@@@@A@@@CB@@@@BBABC@@@AA@@AAC@G@C@CACAAAAACCA@GBA@C@ABA@A@A@A@A@CB@@@@@B@@@@@B@BAF@@AB@@@B@@@@@@@@@@ABA@@@A@A@A@A@@B@@A@@@ABA@@BA@@BA@@B@@@BA@@BAB@@ABA@@BA@@B@@@BB@@B@@B@@B@@@@D@BAF@B@BB@BABGDAB@FBD@D@D@DNL^VTNLFF@JAFEHALH\\NACB@@@@AB@B@@@B@FAB@@@B@B@@@BB@B@@BBB@BB@@DBBB@B@@B@@@BBB@B@@@BBB@@BB@@@BBB@B@D@@B@@@@@B@@@@@B@@@@B@DB@DAF@BH@B@B@B@B@B@B@D@B@B@BAB@B@@@@@@AB@@@@A@@@A@@@A@@@@@A@@@@@ABB@@B@B@D@FB@@@@B@B@@@B@B@B@@@BB@@@B@B@B@@@BB@@@B@D@@@B@@@@@BB@@B@@A@@@@B@B@@A@@B@@A@A@@@A@@@@AA@@@@AA@@@@@A@@@@@A@@BA@@@A@A@@B@@A@@@A@@@@@A@@@A@A@A@@@C@@@A@@@@@A@@@A@@@@AA@@AA@@@@@A@@@@@A@A@@BAB@@@B@@AB@B@BA@@B@@AB@@@BA@@BA@@B@@@BAB@B@@@B@@@B@@@BAFAB@B@B@@@B@@@@@BB@@@@@B@@B@@@@@D@@BB@@@@@B@@@BB@@B@@@B@B@@@@@B@B@@A@@B@@@@@BA@@@@@A@@@@@@@A@@B@@A@@@@@A@@B@@@@A@@B@@A@@B@@AB@B@@@B@B@B@@@B@@@@@B@B@@@BAB@@@B@@@B@B@@A@@@@@AAA@@A@@A@@@A@@A@@AA@A@A@@@AA@@@@AA@@AAAAA@AAAAA@AA@@@AA@@AA@@@A@@AA@@@A@@@A@@@AA@@@@@A@@AA@A@A@C@AB@@AB@@AB@B@@@@AB@@@D@B@BAB@@@BAB@B@@@B@@A@@B@@A@A@@@A@@@@@A@A@@@AA@@@AA@@A@CC@@A@@A@@AA@@@@@A@@@AA@@A@A@@A@@A@A@@A@@@@@A@@@@A@@@@@@@@@@AA@@@A@@@A@@@@AA@@@A@@@AA@"],encodeOffsets:[[134395,47731]]}},{type:"Feature",id:"230505",properties:{name:"四方台区",cp:[131.337592,46.597264],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@BB@@@DDB@@@BB@@B@@B@@@B@B@@@@@B@@@B@BA@@@@BA@@@A@A@AB@@A@ABA@C@@@A@@B@@A@A@@BA@@BA@@B@D@B@BBB@@@B@@@@BB@@B@@@B@@@B@@BB@@@B@@BB@@B@@BBB@BBBBB@BBBB@@BB@@@@BB@@B@B@B@@BB@@@@B@@@BB@@@BB@B@@@@@BA@A@@@A@@@A@AB@@A@A@@@@@A@@@A@A@A@@@A@A@@BA@@@@BA@@@@B@@A@@@@B@@@@@BA@@@@B@@@@@@@B@@@@AB@@@@A@@@@BA@A@@@@@A@A@@@A@@@AA@@A@@@@@A@@AC@@@@@A@@@@A@@@@AA@@@@A@@@A@A@A@EBAB@@A@@@A@@@A@A@AB@@A@@@AB@@AB@@A@@BA@@@ABA@A@@BA@@@A@AB@@@B@B@@@@@B@@@@BB@@BB@@@@@B@@@B@@@@@B@@@D@@@B@B@B@@@B@@@@@B@@@BA@@@@B@B@@AB@@@B@@@@@B@@@@BB@@@@BB@@@@@B@@@B@BA@@@@BA@A@@@@@@BA@@B@@A@@B@@@BDDB@BFFHNXQC]CwS@NAT@R@FAhB@|A\\@@@Z@P@@@RJDBHFFBHFAB@B@@@BAB@@@@@B@@@B@BB@B@DBB@B@@@BBB@@@B@B@DBB@D@FBDBD@B@DBB@@@@A@@@A@AB@@A@@@A@@@A@@BAB@B@@@B@@BB@D@D@B@B@BBB@@@@A@A@A@@@ABA@@@A@@@@BC@A@A@A@A@C@A@@@@BA@@@@BAB@BA@@@@@A@A@ABC@A@@@C@@@A@A@@BA@@@@BA@@BAD@@AB@D@JB@A@A@@@A@A@A@A@AAAAAA@AAAA@@AAECGAEAG@C@CHA@AB@BAB@B@BAD@B@@G@GAC@C@A@@@A@@BABAB@B@BAB@B@@@@A@@@@@A@@A@@A@@@A@@@@@ABAHA@@@@@AAA@@AA@@@@@@A@@A@EECA@AA@@@AA@A@@@ABA@@@@@ABCBA@AB@@@@A@@@A@A@ABA@@@C@@BC@ABABA@A@@DAF@BABA@@@@@A@ABABABA@ABA@A@@@A@@AA@@@AA@@@AA@@@CA@@AA@AAA@@A@@@A@@@ABA@A@ABC@@@@BAB@DAB@B@B@B@B@BBB@B@@BB@@B@@BBBB@B@@@@AD@B@@@B@@@@D@B@BBD@B@@AB@@@B@@@B@B@B@BBB@BBDDDBDDFDBAXGBABB@@@@@B@@BB@DBB@BBB@@@@B@@B@@@AD@BAB@@@@@B@@@B@B@B@D@B@B@@@B@B@B@@@B@B@B@B@B@B@@@B@@@B@B@B@BBDBB@BBFD@A@@@@AA@A@A@A@A@@@@A@@A@@@@B@@@@@A@@@@@@@BA@@@@@A@@@@@A@@@@@@@@@A@@@@@@@@@A@@B@BAB@@A@@@@@@@A@@@A@A@AA@@AB@AA@@@A@AB@A@@A@@@A@@@ABA@@@AA@@@@@@A@@AA@@@@@A@@AAAC@@@A@@KKGEEE@C@E@@BE@CAK@ABCJGHIDGDMAEEG@G@CFANAHAVQHCDCDCLDFFBFDDB@F@BAB@@@@CBA@@BA@AAACC@A@@@@A@ACA@A@AAA@A@@ACAAA@@AA@@A@A@A@@@AAC@AA@@@@A@A@ABA@@@AB@@A@@@ABA@@@C@A@BA@@BAB@@A@@A@@AC@AAC@AAA@@@@A@@@AB@B@BBB@BBD@BBB@BB@ABA@@BA@@GCQE@ABA@@@A@@@A@ANCNEDAB@@@A@CAA@C@A@A@C@C@A@C@A@A@A@A@BA@A@@@A@A@@@A@@AA@@A@@AAB@@@@@B@@A@@BA@AB@@ABAB@@A@@@A@@@@@@A@@@AB@@@@AB@@A@A@AAA@A@@A@A@CAAAA@AACC@@@A@A@@@@@@@@@@@AB@@@C@A@G@GBA@@@@@A@@@CBA@@B@@@BA@@B@B@@@B@@BB@@@B@B@@@@BFAB@@@@CBCBCBA@ABCBA@@DA@@@A@AB@@@BA@@B@@@BA@@@@@AB@@A@@BAB@@@@AB@@@@@B@@E@CBG@A@A@@@A@CA@@AA@@AAAA@@A@@@A@@A@@A@@A@@@@@AB@@ABA@@@@@ABA@@B@FB@@B@B@B@@@B@@A@@AA@@AAC@AACAAA@AA@AA@@B@@@BAB@@A@AA@AAA@@@C@C@C@A@A@A@A@CBCA@@@@A@@@A@A@@@A@ABC@A@C@C@E@C@A@A@@@A@@@EBABABA@CBCBCBA@ABA@A@A@A@@BA@CDA@EDA@CB@@A@BDFDBB@@BB@@@BDD@@@BBB@BBD@B@@@@BB@B@@@B@@@@BB@@@BBB@@BB@@@BB@@B@@@B@@BB@@@@@B@@@B@@BB@@@@@@@@@@@B@@B@@@@@B@@@@B@BB@@@@B@BB@@B@@@B@@@@BB"],encodeOffsets:[[134403,47745]]}},{type:"Feature",id:"230503",properties:{name:"岭东区",cp:[131.164723,46.592721],childNum:1},geometry:{type:"Polygon",coordinates:["@@BBB@@@@AB@@@B@@A@@B@@@@B@@@@BB@@@@BA@@@@BA@@B@@@@@B@@@B@@@@B@@B@@@B@F@B@FAB@F@B@@@F@B@B@@@B@@AD@@@B@@@B@D@B@@@HAD@@@D@@@B@@@BA@@DA@@@@B@BAB@@@@@@@B@BBBBBB@@BBDB@@@B@@@@D@B@D@@@@@B@@A@@B@@AB@@@B@B@@AB@@@D@@A@@B@@@@@BAB@@AB@@@DAB@FCB@DCB@@AB@B@B@B@BAB@DADADAB@BABAFA@@B@@@B@B@D@F@D@D@B@D@BAB@@@B@B@@@B@@@@@DBDAB@B@B@B@D@D@D@@@B@BBB@BA@@@A@A@A@A@@BA@A@@@@CAA@A@@A@@@A@@@A@@A@@A@@@A@@@@AA@@ACCC@@@AD@B@BADABAB@DAB@B@B@B@DBB@@@@A@@A@@A@@@@@A@@@A@@@@@@@AA@AA@@A@CA@@CAAAA@A@AA@@B@@A@@@@BA@@@@@AB@FBDBF@B@@@BC@AB@@@B@BBB@B@B@BAB@@@B@B@B@B@@@BB@@B@@@@A@@@@@C@@BAA@@A@@AA@@@A@@AAAA@@AA@@@@@A@@@@AA@@A@A@A@A@AAA@@@A@A@A@A@A@@@AAA@A@@@@A@@@A@C@A@A@A@AJAA@@A@@A@@AA@@A@@@A@@BA@@@A@A@AACA@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@BA@@@@@@@AA@@@@@@@@@@A@@@@A@@AA@@AAAB@DC@AHCBAD@@@B@B@B@B@B@@@B@B@B@B@B@@@B@B@B@B@B@B@F@B@B@@@@A@@B@A@@A@@@@@@@A@@@A@@@@@@@A@@@A@@B@@@BAB@BAB@@@BA@@@@@A@@B@@@@@B@@@B@B@@AB@B@BABA@@@A@@@A@AA@@@@A@@B@@@B@@AB@@@B@BA@@B@B@D@B@B@BAD@B@BBB@BBB@B@B@@@B@B@B@B@BAB@B@B@@@DB@@B@BBB@B@B@@AB@@@@@B@B@B@B@B@BAB@B@B@B@F@B@B@D@B@BAB@D@@@B@BBB@@B@BB@@@B@DBB@B@D@B@D@BBB@D@BBB@D@B@BBB@B@BBB@B@@@B@B@B@B@B@B@@@B@B@@BBB@@@@@@@B@@@@@@@B@@@@BBABB@BB@BB@BBB@@@B@@@B@@@B@BBBAD@BAB@DA@AB@BA@@B@@@@@@ABA@@@A@@@AA@@A@AA@@@@A@@@@AA@@@@@@@A@@@AA@@@@A@A@@@A@@A@@A@@@@@@@A@@@@@@@@@AA@@A@@AAACAA@AA@@@AA@@@@@@@A@A@@@@BA@A@AB@@A@@BA@A@A@ABA@@BABA@@B@@A@@B@@@@A@@BAB@@AB@@@@@@@@@@A@@A@@A@@@A@ABABA@@BAB@@AB@B@@AB@@@B@B@@A@@A@@A@@@@@AA@@AA@@AA@@@@A@A@@BA@A@@BA@@@AB@@AB@@AB@@@@@@A@@B@@@AA@A@@AA@@@@@AA@@A@@CA@AA@AAA@AAA@@A@@@@BA@@@@@A@A@A@AAA@@@@@AA@@@AAA@AA@AA@@@@A@@@@@A@A@@AA@A@@@AA@@@B@@@@@D@@A@@@@@@@A@@@A@@@@AA@@@A@@AA@@@@@A@@@@@AA@@@@A@@BA@@@@B@@A@@B@@@@@@A@@@A@@@A@A@@@@@@@A@@@A@@@@@@@AB@@@@@@@B@@@B@@@@@B@@@B@@@B@@@B@@@@@BA@@B@@@B@BA@@@@BAB@@@B@BA@@B@@@DAB@@@@@B@@@B@@@BAB@@@BA@@B@@AB@@@B@B@D@B@B@@@B@@@@A@@@@@@@@@@@A@@@@A@@@@@@@@@@A@@@@@@@@A@@A@@@@A@@@@@@@@@@A@@@A@@@@@@@@@@@A@@@@@@@AAA@@AA@A@A@@@@@@A@@@@@@@A@@@@@@A@@@@@A@@@A@@@@@@@A@@A@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@BA@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@A@@@@@@A@@@@B@@@BA@@@@@@@A@@@AA@@A@@A@AA@@A@AAA@AA@@AB@@@@@BA@@@@@AB@@A@A@@@@@AB@@A@@@@B@@@@@@@@A@@BA@@@A@@@@@@@A@@@@@A@@@A@@AA@@@A@@BA@C@@@A@A@@@ABA@@@@@@@@@A@@@@B@@A@@@A@@@@@A@AB@@A@@BA@@@@@A@A@A@@@@@@@A@A@@@@AA@@@@BA@@@@@A@@@@@@@A@@@@@A@@@A@@@@@@@A@@@A@@@@@A@A@A@@@AB@@@@@@AA@@A@@@@@A@@@@@@@AA@@@@A@@@@@@@A@@@@@@@@@A@@@@@A@@@@@A@A@@@AA@@A@@@@@@BA@A@@@A@A@@@@@A@@@@@A@AB@@A@@BA@@@A@@@@@ABA@@@@@A@@@@@A@@@@@@@A@@@@@@@@BA@@@@AA@@@@B@@A@@@@@A@@@A@@B@@A@@@@@@@@@@@@@@@A@@BA@@@AAA@@@@BA@@@@@A@@@@@A@@@@@A@@@A@@@AA@@@@@@@@A@@@A@AB@@@@A@@@A@@@A@@@A@@@AAA@@@@@A@@@A@@@@@A@@@A@A@@AA@@@A@@@@@A@@BA@A@A@@@A@A@@@A@@@A@@AA@@@@@AB@@A@A@@@A@@@A@@@A@A@@@A@@BA@@@A@@@AB@@A@@@A@A@@B@@@@A@@BA@@@A@AB@@A@ABA@@BA@A@@@@@AB@@A@A@@@AB@@A@A@A@@@AB@@@@AB@@A@A@@BA@A@A@AB@@@@@@A@@B@@A@@B@@A@@@@@@@A@@BA@A@A@@@AB@@@@AB@@@@A@@@@BA@@@A@@B@@@@A@@B@@A@A@@B@@@@A@@@@B@@@BA@@@@@A@@BA@A@@@AB@@A@@@AB@@@@A@@B@@@@A@@@@B@@@@AB@@@@@BA@@@@@@BA@@@@@@BA@@@@@@BA@@@@@@BA@@@@B@@@@@@@@@B@@@@@@AB@@@@@@@B@@@@@@A@@B@@@@@@@@@@AB@@@@A@@@@BA@@@@@@@@@AB@@@@@@AB@@@@A@@@@@AB@@@@A@@@A@@@@@A@@@E@C@C@A@C@A@CA@@A@A@AAAAAAAAA@AAAAA@AA@ACA@@@@AA@@@A@A@AAC@AAA@@@AAA@@@@A@A@@@AA@A@A@@@@BA@@@@@AB@@AB@@A@@@A@@@@@AA@@@AA@@@AA@@A@A@@A@@AA@AAA@@AA@@@A@A@@@A@@BABA@ABA@ABA@@@@BA@A@@@A@ABA@@BA@@@A@AB@@A@@@A@@@@A@@@AA@@@@@AAAA@@AA@@AAA@@@A@@@A@@BA@ABA@@B@@A@A@@@A@A@AAAAA@A@@AA@A@@AAAAA@@AAA@@@A@@@A@@@ABABA@@@A@@@AAA@A@@@A@@@@@A@AA@@C@@BA@A@@B@BABAB@BA@A@@B@@A@@BA@A@@BA@@@A@@@@@A@AB@@A@A@@@A@@@A@A@AA@@A@@AA@@@A@ABA@@@@@@@@B@BB@@B@@@B@@A@@B@@AB@B@@@BA@@B@@A@@@@@A@@@@@A@A@@@A@@@A@A@@BA@@@@BA@AB@B@@@@@BA@A@@@AB@@@@@B@@@B@B@B@@AB@@@@A@A@ABA@@@@@A@A@A@@@A@@@C@@@A@@@A@A@@@A@@@@@@@AA@@AA@@@@AA@@A@@AA@AA@@@@A@A@A@@@A@@BA@@@@@A@ABA@A@@@A@@@A@AA@@A@@BAB@@A@A@AB@@AB@@@B@@AB@@@@@@CAA@@@A@A@CBA@@@@@A@@@@@@B@@AB@@A@@@A@A@@@A@@@A@@@C@@@A@@AA@@A@@AA@@@AA@AA@@A@@AA@@A@@A@AA@@A@ABA@@@AB@@AB@B@BA@CB@@@@AB@@A@A@@@ABABAB@@CBA@A@A@A@A@C@@@A@A@AB@@AB@B@@@BBB@@@B@BA@@BAB@D@D@B@@AB@@ABA@@@@BB@BBB@@@@BB@B@@@BB@@@@B@@@@B@@@B@B@@@B@@@B@B@@ABA@CBA@EBCBCBA@A@A@ABA@@BA@A@CBC@CBABCBCBA@ABC@ABA@C@A@A@ABA@@@@D@@@@@@@B@BA@@BA@ABA@AB@@@@@@@B@@@@@@BB@BBB@BBB@B@B@@@@@@BB@@@B@@B@@@BBBB@B@@BBB@@B@B@B@@@B@B@B@@BDBB@D@B@D@@BB@@@B@@@B@B@@@B@@@B@@@B@B@B@@AB@BA@ABADABABABABA@ABA@ABA@ABA@@@A@A@C@ABA@AB@@A@AB@BA@ABA@A@@@A@@@@@@@@@A@@@@@@@AB@@AB@@A@@@ABA@E@A@@AA@AB@@@@A@@@@@@@CBA@@BA@A@@@A@A@@@A@@@A@@@AB@@@@AB@@@@@BA@A@@@A@@@A@@@@B@@@@AB@@@BA@@@@B@@@B@@@B@@@B@@@B@@@B@@AB@@A@@@CB@@A@A@@@@B@@@BA@@B@@AB@B@@@B@@@BB@@@B@D@@@@BB@@@@B@B@@@B@@@@BB@@B@@B@@@B@@@@@@A@@B@@A@@@AB@@@@@B@@B@@B@@@B@@A@@@ABA@@@A@@B@B@@A@@@@BA@@B@@@@@BA@@@@@BB@@@@@B@@B@@B@@@@@B@@@@@B@@@B@@A@@BA@@@AB@@A@AB@@A@@B@@B@@@@B@@@@@B@@@@A@@@@B@@AB@@@@@@AB@@@@@B@@@@@BA@@@@@AB@@AB@@@@@@AB@@@@A@@@@B@@@@@B@@@B@@A@@B@@@@@B@@@@AB@@@B@@A@@B@@@@@B@@@@@@AB@@@@@@AB@@@BA@@@@B@@@@AB@@A@@BB@@B@B@@@@@@@BB@@B@@@@@@BB@@@BB@@B@@@B@@FHBNDHFFD@FBFBVXTPLFR@TFDBBFBFBHHHFNDJDBD@LAFEFIFAHAD@F@D@DCB@B@DA@@B@D@@@DAB@B@@@FAB@@@@AA@@@@AA@@A@@@AB@@AB@BA@@BA@AB@@A@@@AB@@AB@@AB@BA@@B@@@@AB@B@B@B@@@B@BA@@@@@@@@@@@A@@BA@@BE@A@A@@@@@A@@@@DAB@B@B@B@B@BAD@B@HAB@DDBBBBDBDBD@H@D@BB@@BB@@D@BAAA@@@@DA@@B@@@@@B@BBB@B@B@@@FB@@B@@@B@B@@@B@D@B@B@@@J@B@B@B@@@B@@@@@@@B@@@@@BAB@B@@@B@B@@@@A@@@@@@AAA@@AAACA@@@@@@@@AA@@@@@A@@@A@@@A@@@A@@@A@A@@@A@@@A@@@A@@BA@A@@AA@AB@@A@@A@@A@@@@@@@@@A@@@@B@@A@@A@@A@@@@A@@A@@A@B@@A@@B@B@BA@@B@@@@@D@B@@@B@@@B@B@"],encodeOffsets:[[134305,47714]]}},{type:"Feature",id:"230506",properties:{name:"宝山区",cp:[131.401589,46.577167],childNum:1},geometry:{type:"Polygon",coordinates:["@@CDA@BB@BB@@BB@@@@@@B@@@@@@@@B@@B@@@@@@AB@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@B@BD@B@B@B@@AB@@@B@@@BB@@BB@@@@BB@IB@B@B@B@B@D@B@@@B@@B@B@BB@@B@B@B@B@B@@@B@BBB@B@B@B@@@BB@@@@@B@@@@BB@@BBBB@@@B@@BB@@@BB@AB@@@D@@@@@B@@A@@@AA@@A@A@A@A@@@A@ABA@A@A@AAA@@@A@@BAD@@A@E@CAEAA@@B@@@@AB@@@@@BA@@@BBB@B@BBDB@@DBB@@@BBB@@B@@@@@@@B@@@B@@@@@BB@@@@B@@A@CAA@A@A@A@CBA@ABCBABA@C@@B@@DDBD@@BB@@@@@B@@@BB@@@@B@@@B@@@BB@B@DB@@@@@BAB@@@B@B@B@B@@AB@B@BA@AB@@A@@@BBB@@BBBDBBBD@BB@@BB@@@BA@@@A@A@A@@@EAA@@@AB@B@@@@AB@BA@@B@@@@@BB@@@@BB@@@B@@@BBBB@@BB@@DBB@@@B@B@H@DAF@@@@A@@@@BA@@@@BA@AB@@@BA@@@@B@@A@@@AB@@A@@BAB@@@B@@CB@DABAB@DADADA@@@@BAAE@@@@@A@A@@AA@@@A@@@A@AB@@A@@@AB@DA@@B@@@@@B@HAH@B@D@@@A@@B@@@@@@@@@@@B@B@@DDBBB@BBDBB@B@@@@BBB@B@B@BA@@B@@A@@B@@@B@@@@B@@@B@@@BABA@@BAB@@AB@@@@A@@@@BA@BB@@@BB@@@B@@@B@B@@@BABB@B@B@B@D@B@D@D@B@B@D@B@DBB@@@A@CBMFMD@B@B@@@B@@AB@BRFHD@@AB@@AB@BAAA@AAC@AAA@AAA@A@@B@@@B@@B@BBD@BBD@@BB@@@@BA@AB@@ABB@D@@@B@BA@@B@@@BA@@B@BAB@B@@@@@BBD@BB@@B@B@B@@@BB@@BBDB@BB@B@BBB@B@BDB@@@@@@BDDBB@BAB@@AB@DBA@@B@@@FAVCHCLEFALDJBH@HAHGJINGFAD@FDHFJFRBFAFCDGHADC@EBCBATDF@DABCBETKFADCDEDEDE@GCM@OFWJMJIZOH@J@F@DABAB@HBHDLDJBJDJBB@ABA@A@@B@@FB@BCD@@@B@B@@BB@@@BF@B@DA@@LCLCBAJFB@DC@CDE@EJ@B@@C@@F@DAB@N@L@DCDC@ACCAABACAAAAAC@AA@AA@@AAA@AACAC@A@AAAAAACA@@AA@A@A@@@A@@@A@A@@@A@@@BA@A@@A@@@A@ABA@@@@AA@@AA@A@C@@@@@A@@AA@@@A@BA@@B@@@BA@@A@AA@@@@@@A@@@@AA@A@@@@@@@@@@A@@B@@@@@@@@@@A@@AA@@@@@@@@@AB@@@@@@@@@AA@@@@@A@@B@@@@@BA@BBA@@@@@@@@@@@@@@AA@@@ATU~TFRXA@S@W@OiaDvu@Q_H@M]JAlA@@@BA@@@@@@@@@@@@@@@A@@@@@@A@@A@@@@@@@@@@A@@AA@@@@@@@BA@@@@@@@@@@@@A@@@@@@@@@A@@@@B@@@@@@@@@@@A@@A@@@@@@@BA@@@@A@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@AA@@@@@A@@@@@@@A@@@@@@@@@@@@@@@BA@@@@@@AA@@A@@@@AB@@@@@A@@A@@A@@@@@@A@@@@@@@@@AB@@@@@@@A@@@@@A@AAA@@@A@@@AA@@@@@@AA@@@@@A@@@@AA@@A@@@@AA@@@@@@AB@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@A@@@@@@@A@@@@@@@@A@@@@B@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@A@@@@@@@@@@@@AA@@@@@A@@@@A@@A@@@@@@@@@@AA@@@@AA@@@@@@@@@@@@@@A@@B@@@@@@@@@@A@@@@AB@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@A@@A@@@@@@@@@@@@BA@@@@@@@@@@@A@@@@@@@B@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@A@@@@A@@@@@@@@@A@@A@@@@@@@@A@@@@@@AA@@@@@@AB@@@@@@@A@@@@@@A@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@A@@@@A@@@@@@B@@@@@A@@@@@@@@AB@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@AA@@@@@@@AA@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@A@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@AB@BA@ADADABAAC@A@AACACCC@CCA@A@ADBD@F@D@D@DABCDABCFBBBFABC@@E@C@@AB@BABACACDCBEFADCBCBE@ACAABADADCDADA@AGB@@ACAAC@CBABADGBA@CGCCCE@C@C@CD@B@@BD@@ABAF@JAHBH@JEHCJABACCK@M@WBQFGDAF@DAD@FAH@FAFA@@@@@@@@@@@@@A@@@@@A@@@B@@@@@@@@@@A@@@@@@@@@@A@@@@@A@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@AA@@@@@A@@@@@AB@@A@A@@@@@A@@@@@@@@@@@B@@B@@A@@@@@@A@@A@@@@@@@@@@@@B@@@@@@A@A@@@@@@@@@@@B@@@@@@@A@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@A@@@@@A@@@@@A@AB@@@@@@@@AAA@@A@@AA@@@@@@@A@@A@@@@@@@A@@@@A@@@@@@@A@@AA@@AA@@@@A@@@@@@@@@AA@@@@@@@@AB@@@@@@@@@A@@@@@@A@@@@A@@@@A@@B@@@A@@@@@@@@A@@@@@AA@@@@A@@@@@A@@@A@@@A@@@@@@@@@@A@@A@@@AB@@A@@@AA@@A@@@A@@@@@@@A@@@A@@@@@A@@A@@@B@@A@@@A@A@C@A@A@@@A@@BA@@@AB@@A@AB@@A@@@A@@@@@A@CB@@A@@@ABA@@@A@AB@@@@ABA@@@A@@@AB@@@@A@@@A@@@A@@@A@@@@@A@@@A@@@@@@@A@@B@@@@@@@B@@@B@@@@@@@B@B@@@B@@@B@@@@A@@@@BA@@@@@AB@@@B@@B@@B@@@@@B@@@@BB@@@B@@BB@@@@@B@@@B@@@@@@@BC@@@@@A@@@B@@B@@@BBB@@@B@B@@@@@B@@B@@BBBB@BB@@B@@B@@@@BB@B@B@B@B@@@@AB@@@@@BB@BBB@BBB@@BDB@@@BB@@B@@@@BB@@@BBB@@A@@@@B@@@@A@@BA@@BA@@B@@AB@@@BAB@@@B@B@@B@@BB@@BB@@B@@@@@BB@@@@BA@A@@@A@@BA@A@@BA@AB@@ABAB@B@B@@@BB@@@@B@@@@@@@@A@@BA@AB@@@B@@A@@@@BA@@@ABAB@@AB@B@B@BAB@@@BA@@B@BAB@@@@@B@B@@@@@@BB@@B@@BBBBDBB@@@BB@@B@@@@@@@@@B@@@@@@@BB@@@@B@@@B@B@@B@@B@@@B@@@@@@BB@@@@@B@@B@@B@BB@@B@@@B@@AB@B@@@@A@@@ABA@@BCBA@ABC@ABAAA@@@A@@@A@@@A@AAA@@AAAA@BAAA@@@@@A@@@@@@@A@@@@@@AA@AA@A@@@A@A@A@A@A@A@@@A@A@AAA@A@AAA@C@A@AAC@A@AAC@A@C@A@A@CAA@@@A@@A@AA@AAA@@@C@A@ABA@C@A@A@E@A@A@A@A@ABA@A@A@A@A@@@@@A@@BA@A@A@AAA@@@CA@@A@A@A@ABA@A@A@A@@@A@A@A@AAA@AAA@C@ABA@A@C@A@A@@@ABA@@@A@@BA@@@A@@@@B@@B@@B@B@@@B@@ABABA@A@@BA@A@@@A@@@@@A@@@@B@@@@AB@@A@ABA@AB@@A@@@@B@@@B@@@@@@@B@@@B@@@@@@@BB@A@@@@B@@A@A@E@A@A@A@A@A@A@@@A@A@A@A@A@@@A@A@A@A@A@@@C@ABGD@B"],encodeOffsets:[[134454,47612]]}},{type:"Feature",id:"230521",properties:{name:"集贤县",cp:[131.141311,46.728412],childNum:1},geometry:{type:"Polygon",coordinates:["@@SOUWEAEAC@EECGAMEG@@@A@@@AA@@A@@AA@@@@@@@AA@@AAB@@AB@@AB@@A@@BA@ABA@AB@@A@C@A@@@A@CBABA@@@ABA@A@@@A@A@AB@@AB@@AB@@@@A@@@@@@@@@A@@@@@A@@B@@@@A@@@@@@BA@@B@@AB@@AB@@A@@@A@@@@@A@@@CAA@A@@@AAC@AA@@A@@@A@@@A@@@A@@@@@@@A@@@@@A@@@A@CB@@A@@BA@CBA@A@A@A@A@ABA@A@A@AB@@@@A@@AA@A@@@@A@@@A@@@A@@A@A@A@A@@@A@A@@BA@A@@@A@A@ABA@A@A@@@CAC@AAC@AAA@@@A@@AA@@@AA@@AAA@A@A@@@AAA@A@AAC@A@@AA@A@@@@@AA@@@@CA@@A@@@ABA@@@AB@@@@A@C@@@A@A@@@C@@@ABA@A@@@A@A@@@@@@@ABA@CBAB@@A@@BA@@B@@@@@@AB@@@@@B@@@@A@@BA@@@ABA@@@A@AB@@A@A@@@@@A@@BA@A@@BAB@@A@A@@BA@@@@B@B@B@BA@@B@@@BAB@@@@A@@@AA@@A@A@ABA@A@A@@@@@A@@@A@AB@BA@@@AB@@A@@@A@A@@@A@@AA@@@@@@@@@@@@@A@@@@@A@@@@@@@A@@@A@@@C@CAA@@@@A@@@A@@@@@A@A@@AA@@@A@@AA@@@@AA@@@@A@@BA@A@A@A@A@@@A@@B@@CD@@A@@@@B@@A@@B@@@@A@@AA@@@AAA@@AAA@@@A@@@@AA@@@AA@A@@@A@AA@@A@@AA@@@A@A@@@A@@@A@@BA@@@A@A@@@A@@BA@@B@@AB@B@@@B@@A@@BA@@@A@A@A@@@@B@@@@@@@@@@@@@@@BA@@BA@@@@B@@@@@B@@@B@@@BBB@@BB@@@BB@@@BB@@@@@BA@@BABA@AB@@CBA@@@AB@@CBA@ABA@@B@@@@@B@B@@@B@B@@B@@B@@@@@BB@@D@@AB@@@@@BBB@@BB@@@@@@@@@B@@AB@B@@@BB@BB@@@BB@@B@@@@AB@@@@@B@@@BAB@B@@@B@@@@BB@@B@B@B@@@@@@@@BB@@@@B@@@@@B@@BB@BB@@BB@@B@@BB@B@@@@BBB@B@@@B@@BB@@@DB@@@BB@@@@B@@@@@@@BA@@@B@@@@BB@BB@@@B@@@BB@B@@B@@B@@B@B@@@BAB@DBB@D@@@BA@@BABA@A@A@CBC@CBCBA@ABA@A@ABAB@B@BA@AB@@ABA@@B@B@B@BAB@BBBBD@BB@@@B@B@@@BBB@DBBB@B@@AD@@AB@BB@@BBB@B@D@B@BABCB@BA@ABA@ABA@ABC@ABA@@@AB@BA@ABAB@BA@@@@B@D@DAD@BAB@B@BAB@DAB@B@@@@C@C@C@A@K@C@G@M@EBI@@@A@@@A@G@A@A@@@@@@@AD@D@BAD@DAF@DAD@B@BAB@DA@@D@BAB@@AB@@@BAB@@@BABAD@B@B@BA@@B@B@@BB@BDBDBB@BBDDDB@BBB@B@B@B@B@@AB@DAD@DABAB@B@BBD@B@F@BBD@D@FBB@D@B@DAF@D@DHDF@JDHBBADAFCFCD@F@DB@@D@B@B@@A@@@A@@BA@@@@D@B@D@B@D@B@B@@@@A@A@A@@@A@A@@B@@@@@@ABBFBFBB@DBD@B@FBB@D@DBB@HBD@HBBBD@DBDB@@@BAB@B@@@@@@B@BB@@B@B@BBB@BB@@BBBBA@@@B@@B@@B@@@BBB@@B@@@B@@@B@BBBBBBDB@BBDBDBFBHDBBDBB@BBB@@BB@BBBBB@@@BBB@BB@BB@@@@A@A@A@ABA@ABABA@AHI@@@@@@@@B@@@B@D@@BD@B@B@B@@@B@B@DBB@@@BA@@B@B@BBB@B@B@F@B@D@@@B@BBDB@@@BAH@FAB@D@@@@@@@BA@@B@@A@@B@DAF@B@DB@D@B@B@B@B@BBD@D@H@@@@B@DAB@D@B@DAB@BB@D@D@B@BBD@B@B@@@B@D@B@D@HBF@D@D@@B@D@B@@KDOFA@HBH@JBB@AB@DAJ@D@B@@C@IAI@C@CAA@ADADAF@B@BAB@B@BAB@D@B@B@@ADAFAH@@AJB`@H@H@F@F@H@J@DFB@B@@BBBB@@BB@BBB@B@B@@BB@B@B@BBB@@@BBB@B@B@BBB@@@B@B@BBB@BABABAD@DAFH@BBB@@@@B@@@B@BBB@D@B@BBB@B@@BB@NHTLB@@@BBDBBBD@JFBBDBDBDBDDDBBBB@@@BBLDDB@@BBB@FDBB@@DCBABABCBABC@AB@BBB@@@@@B@@@@@@AB@BCBCDCBCB@@ALHHD@@BBB@DDJJFD@BDDBBB@HBHGFCHGFC@ABEBIDB@@B@@ABC@@@ABA@@BC@ABA@ABA@ABABC@ArDzF`DH@FBH@HBJ@@@J@BBF@@BF@`AV@B@FB^JHBNDHD^HFBXHXHFBD@BBDBD@PFVFZHFBJDHBRFXFFDXHNDVFVFFBBB@@@BB@@B@@@@B@@B@@@@@B@@@B@@@@@B@@@B@@@B@@@B@@@B@@@@@D@@@@@B@B@@@B@@@@@B@@@@@B@@@D@@@B@@@B@@@@@B@B@B@@@B@@@@@@@B@@@B@@@B@@@@@B@@@@@B@@@B@B@B@B@@@B@B@B@B@@B@@B@@@B@@@@@B@@@@@B@@@@@B@@H@D@F@D@D@H@F@F@F@H@F@F@B@D@D@J@D@J@BA@@DBF@F@D@DBB@D@@@B@@@D@FBB@D@B@D@F@FB@@D@D@@@D@B@DBB@B@D@D@B@@@JBB@B@H@NBLBL@@@@BB@@@HAJALA@@@BBFBFFP@B@@@@ABA@B@@@@@@@BHDNBB@@@BDD@@BBBD@B@BBB@BA@@B@BCDADABAB@@ABAB@@A@CBC@AB@@A@@BA@@B@@@B@D@D@@@BAB@@@BADABCBCBGDABA@EBCBGDCBEDEBCDCBEDEDAB@BA@@@@B@B@@@D@D@B@D@BAB@@@@@B@@@@@B@@CDCDAB@@@BAB@B@@BB@BBBBBBD@BBB@@@BA@@DAFADAD@B@B@B@BBD@B@@BBBB@@BBBB@@B@BB@@@B@B@B@B@B@@BB@@@BA@@B@@A@A@A@A@@@A@A@A@BBA@A@AB@@A@A@ABA@A@@@A@A@A@AB@@ABB@@BA@A@A@A@@BA@AAA@A@A@A@@@A@AAA@A@A@A@@AA@A@A@A@A@@@A@ABA@ABA@@@A@A@A@@AA@A@C@G@CBA@C@ABA@CBCBC@ABEBCBAB@BA@AD@@@@D@L@@@PBNFNFBBHFDDFDFHHHHDB@B@B@D@DAD@DA@@B@DA@AB@DADAH@DAH@@@B@BAHCB@B@B@B@BB@@@DEF@BAB@BB@B@B@@@D@B@PGB@DAF@@AFADCD@DA@@LCFATA@@HAL@NAHCJ@F@A]ACIMJUBWDCbA^LV@zBpDT@jFjYMA]KUKLMFGBI[]_KQFKVGZ}M]CMGO`DWHgBQD[MaUmM]QABCSGoHWPeT_B@FBBAH@P@N@LAP@J@AG@K@K@IAKAIAGCCu_MIgKmKsO]GGMCEKUAG@EBI@CACGAKCAA@EBK@AAAQCCWAWCI@EBG@EAECGAEAGBG@C@AAACCEMEEAA@A@@HCNCVGBA@AEMAEACACGCAA@C@EDCPGDABEDKDE@C@ICEKIKQIEGEECC@EBMHIJGHGBG@IAKCEBKFGDUDEB@@A@@@AB@@A@ABE@A@CCAEEEKCCDCDGDURGBMBEB@D@HFHBFCNCHGJIHAD@BBL@DAF@@@F@DFFHFLL@@@B@@BDBB@@@B@@@@BB@@@B@@@@B@@B@@AB@B@@@B@@@BB@A@@B@B@@BBA@@BB@@B@B@B@@@B@@@@@@@BA@ABA@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@B@@@@AB@@@@@@B@@@@@A@@@@@@BB@@@@@@B@B@B@BBB@@@@@BECAAA@CAAAA@A@A@@@A@@@A@A@A@A@A@A@@@A@A@A@@@A@A@C@A@A@A@@@A@@@@@A@ABC@@B@@@AA@@@@@AA@AAA@CAA@@@A@@@@AAABWHABECCCCACCAAA@AAA@A@A@@@A@@@A@@BA@C@AAA@C@@@@@@A@@@ABC@@@@@AAAAA@@@AA@@AA@A@AAA@A@A@A@A@CBA@AB@@@@AD@B@BAB@B@@@B@@@BB@BBB@@BB@@D@@BB@@B@@B@@BB@@@B@@@BAB@BABABAB@B@B@@@@ABABE@CB@@@BABAB@BAD@@@D@@AB@B@B@B@@@B@@A@@BABAD@B@@@@AB@B@@@BBB@@B@@BDBFFB@@@@B@@@@B@@BB@BB@@@@B@BGBA@@@@B@@@B@@@@BB@@@@@B@@@@@@ABA@A@ABABA@AB@@@B@D@D@HBH@@@@ABC@A@ABA@ABAB@DGD@H@FBHBFDBB@@BBBBB@BBBB@B@B@B@B@B@@@B@BIAC@A@@BC@AB@@AB@@@@AB@@@B@B@@@D@@@BAD@B@B@B@@@@ABA@AB@@@@AB@@@@@B@D@B@B@B@BAD@@@@@B@@AB@B@@@B@B@B@@A@AAA@A@C@C@A@@AA@@@A@A@AB@@@B@@@B@@@BA@@B@B@@@B@@A@CAA@C@CAEAC@A@CAA@A@@@A@AA@@A@A@CAA@A@@A@A@@@A@@@@BA@A@@@ABAGEEAGECAQI@@O@Y@@@[@{BA@Bg@E@QBS@MxT^DRDMWEGAEA@CC@A@@@AB@@@@A@@AA@@@@A@@@C@A@@@A@@A@@@A@A@A@@AA@@A@A@A@@@A@A@@@@@EAC@A@A@@@AA@B@@@@@B@@@@@B@@@B@@@B@@A@@B@@@@A@A@ABA@A@C@A@A@A@A@A@A@G@@ABE@CCAA@@@@@@A@@@@@A@@@@@AC@A@A@AA@@A@@AA@AA@@A@A@AA@@A@@@@AAACA@@AAA@AA@@@AAA@@A@A@@@A@EBA@@@A@A@@B@@A@BD[MKGGBEFIBE@KESM]UMK@C@C@CAC@EBAHCBA@AAAA@E@ABC@@@A@EB@@A@A@CB@@C@A@@@CBA@A@CDC@E@C@GBEBEJEFKBC@CACIEMGGAGAEAECASEQ@KE"],encodeOffsets:[[134130,47743]]}},{type:"Feature",id:"230522",properties:{name:"友谊县",cp:[131.808063,46.767299],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@AAA@@A@@A@BA@@BA@@A@A@@@A@@@A@@@C@@@AAC@@@A@AAA@C@AAA@C@A@A@@@A@A@C@A@A@@@AB@@A@@@A@@A@@A@A@@@A@@@A@BA@@@@@A@@A@@@AB@@@@@@A@A@C@AAA@AAC@AA@@@@@A@@AAECAB@@A@@BC@A@@@AA@@A@@@A@@AA@@AAB@@A@@@@A@AAC@@A@A@@@@AA@@AAB@@@@@BA@A@CA@@CCAA@@AA@@@@BAB@@@AA@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@A@@@@@@@@@B@B@@@@AB@@@@A@@@@A@@@@A@@@@@AA@@@@@@A@@@@@@@@A@@AA@A@@AA@@@@@@@@@@@AA@@@@AAAA@@@A@@AA@@@@@@@@AA@@A@@AA@@@A@@@AA@@A@@AA@@@@@A@@@@@@@@AA@@@A@@AA@@@@@AA@@A@@@A@@A@@@@@@@@@@@@A@@@@A@@@@@@@A@A@@@@@A@@@@@@@@BB@@@@@A@@@@@@BA@@@@@AAA@@@A@A@A@@@A@@@@A@AAAA@@@@@@AA@@A@@AA@A@AA@@AA@@@@@@@AA@@@@@@@@@@@@@A@B@@@@AA@@@@A@@A@@@A@@@AA@@A@@@@AA@@@@@@@@@@@@@@@A@@A@@A@@A@@@@@@B@@@@@@@@A@@@A@@@@@AA@A@@@@@@@BA@@@@B@A@@A@@@@@@@@@AA@@@@@@@@@BA@@@@@@@@@A@@B@@@@@A@@A@@@@@@A@@@@B@@@@AA@@@@@A@@B@@@@@@BB@@A@@B@@@@A@@@A@@@@A@@A@@@@@A@@@@A@@@@@A@@A@@@@@@@@A@@@A@@@A@@@A@@@@@@@AA@@@@@@@@A@@@@@A@@@@A@@A@@@@@@@AA@@@@@@A@@@@@A@@@@A@BA@@@@@@@AA@@A@@@@@AAA@@@@@@@@@A@@@@@A@@@@@@@A@@A@@@@@@A@@@A@@@@@A@@@@@@@@AA@@@@@A@@@AA@@@@@@A@@@@@@@A@@@@@@@A@@A@@@@@@@@@@@@@A@@A@@@A@@@@@@@@@@A@@@@@@@A@@A@@@A@@@@@A@@@@@@A@@B@@A@@@A@@A@@@@@@@@@AAA@@A@@@@@@A@@A@@@@A@@@A@@@@@A@@@@@BBBB@B@BBDBD@BBB@BB@@BBBD@BBBBDBABBBDD@BCDCDK@M@A@CBE@@@@DA@I@@FCF@DCDA@IEABKDKD@@CBA@E@@A@@AA@@@A@A@@DC@AEA@@@AB@B@BAA@IAICIAKCGCGAA@ABCBE@I@G@YPIJINEX@PDN@HCFCFCFCDEBSLAFADCBE@SCABAD@FCDGBCHEDEBQALRLJDF@J@DCFCLAFCBOHCD@F@DBBHDBDBDBFFN@BABUHMDGD@@@BBBFFFNDDBB@B@DAHBHBFDHBF@FAH@FDJBXDXRDBB@BAL@FBBLDHBBD@DAJ@FBHLVDFHN^HtPnLhLNJv`DDBHBJBL@J@L@LBHJ@R@P@B@RAT@J@H@P@N@L@L@H@H@LAH@D@@AAE@E@EAG@GAGH@DBDBB@@BPLDD@@JJB@BBDDLH@@BBB@BBBB@FBN@HLAV@R@@KAM@G@AB@AC@@@@H@J@J@@BADD@HA@BF@B@D@B@F@@@D@B@F@B@F@@@B@D@B@D@B@H@L@D@H@B@^@@D@DAL@B@HA@@B@@K@@@@@A@@@@B@@@B@@C@A@A@C@E@C@A@I@C@@@@@@@@@@@@DBBfD@fJbFD@NDFBJBZFLBFBB@FFBBNLFDB@TBJ@@@F@R@R@P@F@PBP@T@L@D@@@X@H@J@T@PBX@@@@C@EBI@EFAN@RAPAP@PARAJ@DABANCRCNARCNARCJAFAPAPCPAPCB@BBLBP@P@P@L@FCNCJEFADALC@@MIA@CAAAAACAECGEFGNO@A@@@AA@@@@AA@@@A@@AA@@@@BA@@@@@@@@AAA@@BA@@B@@A@@@@@@B@@A@@@@@A@AA@A@@@AAA@A@CA@@@@@A@@@AAA@@AA@@A@@A@@A@@AC@AA@@@@AAA@@@@A@@A@@AAAA@@@A@A@@@AA@@A@A@@@A@AA@A@@@A@A@@@@@@@@A@@@A@A@@@A@@A@AA@@AA@@@A@@@@A@@@@AA@@A@@@A@A@@@A@@@@@@@@@A@@@@@@B@@A@A@A@@@ABA@@@@@A@@A@@@@@@A@@@@@@@A@@@A@@A@@A@@@A@@@@AA@@@@@A@@@A@@A@@B@@@@A@@@@AA@@@@@@@A@@A@CA@@@A@@@@@@A@@@@AA@A@@@@@@@AA@@B@@A@@B@DABA@@BA@@@A@@BAAA@A@AAA@@AA@@@@AA@@@@A@@AB@@ABA@@@A@AA@@A@@B@@AB@@@@AA@@@BA@@B@B@B@@@@@@A@@B@@@B@@AB@@AB@@@@A@@@@@@@@@AB@@@BA@@@@@AB@BAB@@@B@@@@@B@@@B@@@B@@A@@@@@@BA@@@A@@@C@AA@@A@@@AB@B@@AB@@A@@AA@@@A@@@A@@@@@AB@@@BA@@@@A@@A@A@@@@@A@@B@@AA@@A@@AA@@@@@@B@@@BA@@B@@@BA@@@A@@@A@@BA@A@@@A@A@@@A@AAA@@AA@@@@A@@@@@AB@@@@A@@@A@@@AA@@A@@@A@@@@@@A@@A@@@A@@BA@@@@AA@@@A@@@@@@@A@@@@@@AB@@@@@@A@@@@BAB@B@@@@@B@@A@@BA@@@@BA@@@CA@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@A@@@@B@@AA@A@A@C@@@AA@CAAAAAAC@@A@@@A@A@@@@@@CB@@A@@@@A@@BA@@@A@@@@A@C@@A@@AC@@@@A@ABAA@@@@@AB@AA@@IAAA@AAAA@C@A@AA@@BA@AA@A@@@A@BA@@@AA@EBAA@@@@@@BABABAAA@@CAAA@@B@BA@@@A@@CAA@C@@@@@@@@A@@BAB@@@@@AAA@A@A@A@@@@ABAA@@@A@@@A@A@A@A@@AAAA@@AAB@@@@BB@BA@@@@@AAA@@@A@@@A@A@@@AA@@@@@AD@@A@@@@KE@A@@@A@A@@A@A@A@@@A@@A@AB@B@BA@@@A@@@@B@@@HB@@B@@AA@ECA@@ADAB@@AA@@@CAA@AA@@B@@@D@B@@@BA@A@@@@ECA@@@A@A@@@AA@@A@A@A@A@@@@@BC@@@AA@@AA@@@A@AD@@A@C@AA@@@ABA@A@@@AA@A@A@@@@DA@@@A@A@@@@A@@BAB@@A@@AAA@AA@A@AB@@AA@A@@BA@@@A@E@@AA@@@@A@@BABA@@A@@AABA@A@@@CAAA@@B@@ABBD@B@B@B@@A@A@@A@@AABA@A@@A@@@@BA@@@A@@A@A@@@@@@A@@@A@@C@@@C@@@A@@@@@BA@@BAB@@@AA@@A@A@A@A@A@A@@@@AAA@@@@A@A@@@AAA@A@A@ABAB@B@@A@@AAA@@@@@@A@@D@@@@A@A@A@@A@@@A@AA@A@@@A@AB@BA@@@C@A@A@@@@@@ABABA@@AA@@@@A@@@@@@BA@@B@@A@A@AA@@@@A@@@ABABA@@@A@@@@@BC@@@@@AA@A@A@@@C@A@@@ABCBC@ABAAC@@AA@@@BABA@@A@@A@@ABAB@@A@@@@@@A@@@A@@A@ABA@A@@@@@AA@@@@@@A@AB@@A@G@@@@@@@@AB@@A@@@@@AA@A@A@ABA@A@A@AA@@@@CBA@@@CA@@@A@@@@B@BA@@@A@@A@@AA@A@AAA@@@@@@B@B@@@@A@@@AAA@@@A@ABAB@@@B@@@@@@DA@@B@@@@BA@ABAB@@@@@BB@@B@@@@@@A@@@A@A@AB@@@@E@A@CBA@@B@B@B@@A@ABA@@DA@@@@@A@@A@@A@@@@BAB@BA@A@AAAAAC@AA@@@@@ABB@@B@@@BA@@@A@A@CEA@A@@@AB@@A@A@A@@AAA@@AAA@ABABA@A@CAEACAAA@@@A@A@@@ABA@@@A@A@ABA@A@@AA@AAA@ABA@ABAA@@@@ACAA@@A@A@AB@B@DCBA@@@@@AAA@A@@@ABA@A@@@AA@@@@BA@@B@@A@A@CC@@A@A@A@ABAB@BA@A@CAECC@ACA@A@A@E@A@ECAC@A@@@AF@@@@A@@AAA@AA@A@@DA@@@A@AAAAC@@@A@A@AAAAAA@CAA@A@A@ABA@@D@@A@A@@@AA@@@A@A@A@A@@A@@@A@@@AB@BA@A@AAA@@A@A@A@@A@CBA@@@ECAA@A@@@AB@DA@A@@@@CAAC@@A@A@@@ABA@AB@AC@CCAACAACC@EAEACCA@@A@@BAB@@A@@AAACA@@@E@AA@@@@BABAA@@@AAAA@@@E@A@AA@@@A@A@C@A@@@A@@@@A@@BEB@AA@AAAA@CAC@A@AA@@@A@E@A@@AA@@A@CBC@@@A@A@@A@@@C@A@@A@@@GBC@A@A@@A@@@A@CB@AAAACAAAA@@@@@ABA@ABA@A@AA@@@A@AA@@@A@A@CBA@@BADA@@BA@@@A@AAA@@@C@A@@BA@@B@B@@ABA@C@CBEDA@A@A@CA@@A@CDE@A@ABA@@B@@A@A@A@ACA@@@A@AB@@@@A@AAA@@@AAA@AAA@A@@AA@A@E@G@@@ABA@"],encodeOffsets:[[134960,47722]]}},{type:"Feature",id:"230524",properties:{name:"饶河县",cp:[134.013872,46.798163],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AB@@AAABA@@B@@A@@@AB@AA@@@A@@AAA@@AAEAA@@@A@@@A@@@A@@@@@A@@@@@A@AAA@@AA@@@@@A@@@@@@A@@AB@@@@@A@@@@@@@@A@@A@@A@A@@@A@@@A@CA@@@A@@C@@@A@@@@AA@@@A@A@@@A@A@@@@AA@@@@@@@A@A@AAA@@@A@@@A@@B@@A@@@@@A@@@A@@@@B@@@BA@@A@@@@@@@@@@A@@BA@@@A@@AA@@@@@A@A@A@@@A@A@A@@B@@@@A@@@@A@@@@A@A@@@A@AB@@@@@@A@@@A@C@@@A@@@@@A@@B@@A@A@ABA@A@@BAAA@@@A@@@A@@@A@A@ABA@@@@BC@AAAB@@A@@@A@A@@@@A@@@@@@A@@@CBA@@@A@@@@@AB@@@A@@A@@@@@A@@@A@C@@@AACAC@@@A@AA@@C@E@GAAAA@A@A@A@A@@@A@A@@@A@EBA@C@A@A@A@CAA@AAA@CAAAA@A@AAA@@AAA@@C@AAA@AA@@AACACCAAAACA@AACA@@A@@AAA@@@E@@@C@A@A@A@ABA@@B@@@BA@@B@@@B@@@BA@A@@@@B@@@@@B@@AB@BAB@@ADA@@B@B@@@BBD@B@BBB@F@D@B@B@BAB@B@B@BADAB@@@BA@@BA@@B@@@BCB@B@B@@AB@BAB@BA@@B@B@@@BCFAD@B@DB@DBNB@B@@@@CFAFAB@JABAFEF@@@D@BDB@B@BBBAB@B@BD@FBBBHFLJDFFJBBADABIFEDCBIDCBA@CDCDCDGHCFC@GBEBA@ABEFKHQJGD@BBBFF@B@BABAB@B@@@B@@BABBB@BB@@@BAB@@BBBBBB@BFBDBBB@B@BADEHAD@H@BBJ@D@B@BA@A@EAA@AB@@@D@@A@ABA@A@@BAB@BABEBA@A@A@CBEDGBcCaDC]qAJJPcCqCY@S@[EeJåxop[hyTsdKJ@@AB@@ABA@@@A@@@AB@@@A@@A@@@@@A@AAAAA@@@@@@@@@@@@@AB@@@@@@@@BB@@@@B@BBB@BB@@@@B@BB@@@@BB@B@@@B@@@@@B@@BB@@@@B@@B@@A@@BB@@@@@BAB@BABAB@@@@@B@@@@@@@@@@@BB@@@@@BAB@@@B@@@@@@@B@@@@@BB@@@AB@@A@CA@@@@AB@@B@@@BBB@B@B@BAB@@@BAB@@@B@@@@@B@@B@@@@BABABA@@@@@@B@@@BAB@@@B@B@@@B@@@@@B@@@BB@@@@B@@@@@@@B@B@@@B@@@B@B@D@@@B@@@@@B@@@@@B@@ABAB@@@@@@@@@B@@BB@BB@@@@@B@@AD@@@@BB@@BBB@@@@@@@@BA@@@@@@@@@AB@@A@@@@@@B@@@@B@BBD@@@B@@@@B@@ABC@AB@@@@A@AAA@@@@@@B@@@@BB@@B@B@B@HA@@@@BAB@@@B@@@@@@B@B@@A@@@@@A@@@A@@@@@@@@@@BB@BBD@B@@B@@@@@@AB@@A@A@CA@@CA@@@@@@@@A@@B@@B@@B@@B@BBB@B@@@B@D@BA@@B@@B@@@@@BA@@@B@@@@@@@B@@AB@BAB@B@B@B@B@@BB@@@B@@@@@@A@@@@@@@A@@BB@@@@BBBBB@BB@@B@@B@@@BA@ABAB@@@B@@@@BB@@D@D@@@BA@@@@@A@@@A@@@AB@@@BB@@B@@B@@AB@@@BA@ABA@ABA@@@C@A@A@AB@@@@@B@@@BB@@B@@B@B@BBB@B@@@@B@@AB@@@B@@@@BB@@B@B@B@@AD@BAB@D@B@@@@@@@@B@@AB@BA@@B@@@@@BB@@@BAD@BA@@BAB@@@B@DBBBB@B@@B@@@@@B@@@@AB@@@B@@@@B@@@B@BABA@@BA@@B@@B@@@B@@@B@@AB@@@@B@@@B@B@B@@AB@@@@B@@@B@@@B@@B@@@BBB@@@@@@B@@A@@BA@AB@@B@@BB@@@B@@@@@BAB@@@B@@@B@@@@A@@A@@A@@@AB@@@BAB@B@B@B@@@@A@@@A@@@@B@@@B@@@@B@@@B@@AB@@@B@@@@@@@@B@BA@@@AB@@A@@B@@@@@BB@@@B@@AB@@@BA@@B@B@@B@@@B@B@@@B@B@@A@@B@@B@@@BB@@B@@@@@AB@@@@A@@@@@@B@@@@@B@@B@@B@@@@@@@@A@@@A@@AA@@AA@@@@@A@@B@@AB@B@B@BBB@@B@@BB@@BB@@@@@@@@B@@A@@AA@@@A@@@@@AB@@@BBB@@BB@@B@@@B@@@@B@@@D@B@B@B@@@BBBBB@@B@@AB@B@@@B@@BB@@@BA@@BA@ABA@A@@B@@@BBB@@@B@@B@@@B@BAB@BABABABA@@@A@@@A@@@@@@@@B@B@@@@@B@B@BBBBB@@@@BA@CBAB@@@B@@BB@BA@@@@@A@A@@AA@@@@AA@@B@@@BB@BBB@@@B@DAB@@@@@BA@A@@@AB@@@B@D@@@B@B@@B@@@B@DB@@B@@B@@@BAB@B@@AA@@A@AA@@A@@BA@@B@@@B@B@BBB@BBBBB@@A@AB@@A@@B@@BB@AB@BA@@B@B@@@BB@B@@@@@BABA@@@AAA@@AA@A@@B@@@B@BBB@BB@BB@@BA@ABABCBA@@B@@@@@BB@B@B@DAB@DABA@@@AAAA@@A@@@@BA@@BBB@BB@DBBA@@BABA@@@@B@@@BD@BB@@@B@@@BABCBA@@BB@@@@BB@B@D@BAB@@@BA@ABA@@@@B@@BBB@@AB@@AB@BAB@@ABA@A@ABA@@B@@@BB@@BD@D@BBB@@@@BA@@BA@A@C@AAA@A@@AAAA@AA@@A@@@A@@BABAB@@A@@@@@@@@AA@@@@A@@AB@@@@@B@BBBB@BD@@BBD@B@B@D@DA@BB@@@@@AB@@EBCB@@A@@@AA@@A@@@@@@B@@BB@@B@BAD@D@B@@AB@@A@@B@@@BB@B@@@B@@@BC@CBA@@B@@@@@@@BB@B@@B@@@@@@A@A@AAAA@@AA@A@@@AA@@@@@ABA@@B@@@BB@BBB@BBBBB@BBD@@@B@A@@@@@C@E@A@A@A@A@@@@@@B@@BBB@@B@@@@@@A@@@AAA@@A@A@@AA@@@@AB@@@B@BBBB@@BB@B@BBD@BBB@B@B@@@BA@@@A@@AA@A@@@@BADAB@@@BB@BB@@BA@AB@BABAB@@ABA@AB@@@@BB@@D@BAD@@@B@@A@@@A@@B@@@B@@B@@AB@BA@@@A@@@BB@@BAB@@@@BBBB@@BB@BBB@B@@@@B@B@@@BB@@@BBA@@@@@A@A@AAC@@@A@A@A@@B@B@@BBBBB@BBB@B@BAB@DA@@B@@@B@@BA@@BA@@BA@C@A@C@A@C@C@C@@@A@BA@@@AA@@@A@@B@@@B@BB@DBBBBB@@@@@BA@ABA@AA@@AA@@AB@@@BB@@@FBB@DAB@D@BABBB@@B@@@@B@@@BA@@@A@@@@@@B@@@@@B@@B@B@B@@@@B@@@BA@A@@@ABAA@@A@@@@@@B@@@B@BB@@@B@B@B@@BB@@B@B@@@BAB@BAB@@@B@@@BB@B@@AB@BCDAB@B@@BB@@D@@@B@B@H@DAB@D@DBD@B@D@@AB@@A@A@@AAAAC@AAAAAA@@@A@A@A@@B@BA@@BBDBB@BBBB@@BBBD@@@D@B@@@BA@AB@@ABA@ABA@ABABA@ABABAB@@A@A@EAAA@@A@A@@@A@@B@@BB@B@@BBB@BBB@@@D@B@B@B@B@BBB@B@B@BAD@BAB@B@@A@@@AAA@@AA@@@@BA@@B@@AB@DBD@B@DBD@BBB@@@BB@B@@@BA@@BCBABCBAB@@A@BB@DBDBBBB@@BBB@B@D@D@B@B@@B@@ABA@ABA@A@AAA@@@@BA@@@BB@@B@B@B@D@B@@@B@@@@B@@A@ABA@ABC@CBA@C@AAC@AAA@A@A@A@@BA@@@AAAAAAA@A@AA@@AB@@AB@@@@BB@@@BB@@@@@BB@@@B@@ABA@@AA@@@AA@A@@@A@ABABA@@@AA@@@A@@@AB@BAB@B@DAB@B@BBB@BDBB@BB@@ABA@A@A@CACAAAAB@BAB@DBBBBB@D@D@D@D@B@DDB@JDF@B@BABCBABAD@BABBB@BBDBDBBBBD@B@@@DBB@BA@ABABADC@ABA@AAA@A@CAC@EAA@A@@BA@BB@BBB@@B@BBF@FB@@@BA@A@@B@BABABA@@@CAA@@B@@BB@@BBB@D@B@DBB@BBBD@BB@BBB@B@BADABA@A@AAABA@@BAB@BBB@B@DBB@F@F@BBB@BB@B@B@B@B@@CBCBA@CB@BAB@B@BBB@@BBB@B@B@FAB@B@B@@D@BABAD@DA@ABA@C@A@CBA@ABADADABABA@A@A@AAA@AA@A@@@C@C@A@A@AA@AAA@CACBA@AB@D@B@D@B@BB@BBB@DBFBFBB@B@@BA@@BABA@C@A@C@AAA@CAA@A@@@AB@BB@@BB@B@BBD@BBDBBBB@B@DBB@B@@BA@ABCBA@@B@B@@DBB@B@BA@@B@BABA@ABA@@B@B@@BB@@BAB@BABABA@A@C@CBA@CBA@C@A@A@A@A@A@@BBBB@BBB@BBD@B@D@BB@@DBBB@@B@@B@@@DAB@D@F@F@B@@@@BBD@D@B@B@@B@@@B@@ABA@C@ABA@@@BB@@B@B@B@@@D@B@B@B@@@@B@B@@@BABABABAB@@@B@@BB@BB@B@B@B@BA@@BA@A@A@AACBA@@@ABBB@@B@B@BBB@BBDBB@D@@AB@@A@A@C@A@@@@@@BB@BBB@DB@@@B@@ABA@A@C@A@A@@B@@@BB@BBD@B@D@B@BA@@BADA@@B@B@@B@@@D@BAF@B@@@BBBBB@@BBBBB@@@B@BABB@@@@ABAD@B@B@BB@BB@B@B@B@D@BBD@@@BAB@BA@ABC@AB@B@BBBBDB@@BB@BAB@BA@B@@@D@B@B@DBBB@@@B@B@BAB@BB@@@B@B@@@BB@@@BABA@@BBDBDDBB@@BD@BBJBFBD@B@BAB@BA@C@A@A@@@@BBBB@BBBAB@B@BCBA@A@A@A@A@A@A@@B@@@B@@B@BBF@BBF@BBD@B@B@@@@B@@@D@DABCB@@AB@BBB@B@B@@@BDBBBB@@@B@B@@@B@@B@BABAB@B@@@BF@@@D@BCBCBABCBA@@@A@@B@@@@BB@@@B@DAF@F@F@D@FAF@F@F@H@H@DAF@F@F@F@F@F@DAF@F@@@D@F@F@F@FAF@F@DIE`IxBGrwAWZH¦NABAB@B@B@DAB@B@B@JAJAB@BAB@B@B@B@FAB@@@D@BAB@@@D@BAD@B@B@@@JARCRAB@DBJBHBD@DBF@L@FB@@B@B@BBB@FDBBDBDBBBBDDBB@BBFBBBDBDBDBFDB@B@FBDBBBB@B@D@H@J@H@F@D@FAB@DAB@B@@AD@D@B@DA@@DCBADCDCBABABCDCBABADABCB@@@BAB@BADA@AD@@AB@BAB@BAB@B@BAB@@@@@DAH@DADAB@D@BADAB@DAB@B@FAB@FAB@D@FAF@D@B@@@B@B@D@B@B@B@B@DAB@B@DAD@FADAHAD@LCHCDALCHCD@DAB@D@D@B@B@D@B@FBDBD@FDB@DBB@B@FBB@B@@@B@@@BBBBBBBBDDHLBDDFDFDDBB@BBD@BBB@B@DBD@B@HBF@B@BBBBDDD@BDDBDDFDFBBBDBB@B@@BDBDBD@D@@B@@@B@@B@B@@@B@@@B@@@BBBB@@DBD@B@DBBB@D@@BB@B@@BB@B@BBB@B@@@B@B@BB@@AD@@A@@BB@@B@AFBDAB@D@@@B@DBBBBB@@BBBB@@B@B@B@B@BBB@@@BAB@@@DAB@B@BBDBBBD@DBB@BB@@@BBBB@B@B@@BB@B@B@BBB@B@@BB@B@BAB@B@@@BBB@BAA@@A@AB@B@BBB@B@@@B@BBB@B@B@B@@BB@B@B@BAB@B@@@BB@@BBB@@@B@B@B@@A@ACC@@BA@@@A@@@A@@@AB@@@@@B@F@BBB@@@B@@A@AB@@AJ@B@@@B@@@@@@BB@@@BABB@AB@@@D@DBB@@@@BB@@@D@F@B@BB@@B@BAD@BB@@BAD@B@B@D@B@@@B@@@B@B@@@@AD@@@AA@@@@BAB@BAD@B@B@BA@@@@D@BBB@F@D@B@@BB@@@@AB@@@BBB@@@B@@@@@B@BBB@FAB@@@B@B@@@@AB@@@B@BAD@@@DB@@BBB@@@D@@@B@BBBB@@@@B@BBB@@B@@@B@BB@@BD@@@B@B@B@@@B@B@B@@@BBB@BA@@@@@@B@BAD@BB@@B@BBB@B@BD@@BBB@BB@@@@B@@@@@B@@BD@D@@@B@B@BAB@@@B@D@B@B@@@BA@@D@@@BABAB@D@BAB@@AB@@@@@BAB@@@B@D@B@DAB@B@BBB@DAD@BA@ABABAB@D@B@H@B@B@BBBAB@D@B@B@DBD@B@B@B@@BBBB@DAB@BA@@DBF@B@BA@ABBB@B@B@B@BBDBDBD@@BBBB@@@BAB@B@BB@@B@FCB@@CBABAFADADCB@DABEJBHEB@BAD@@@@A@@B@@@BA@AB@@AB@B@B@BBD@D@B@BD@@B@BADBB@@ADBBAB@DBDBB@B@B@BB@@@B@@B@B@B@B@BBDBB@B@B@B@@ABD@@D@DBB@BBBB@BB@BBB@BBB@BABBBBD@DBBB@@DA@@BB@AD@BABAHCD@DCBABADAB@DA@@@vB@Cr@O|\\bWf_\\CNBLNBPFVPEJFRNIRbLdH@BCLZ@V@NP\\MFHJLHBJJRBPRSPDDNNVBRDBLAT@DJPHDZNNJ@PKJLXLF@PENQDQHJPIHJFNZNXFHFLZEHPNDTJjA@H^NDbXDGRFVªCC±C@@M@BAmAc@EA[EÑCAeGåAkCACAAAA@C@GAEBM@MDI@U@MAIAMCMAKCA@GAEAG@QAI@G@e@O@M@]@MBIAMAYIOGa]__QMAECcAE_WMEICwEICmcEE@C@G@MGQIiGMESIQ@CBQDENMRKdOd]VYR_DQAUAKCK@EFKDMAGSuEGCAUEKAC@GBM@Y@eBQCAAGCCCWcIEGCCG@E@C@ABC@C@C@CBA@G@CAAAE@AAAAAACCCCCCCEEAAAACC@EBCAQ@A@A@AAAKEIESK_WCCCMBCRQBCBEBKDYAECCMOQWCS@M@AACAAAACAAACACACACA@KDOE]BMAGEEMGIGIO@E@SFKTIDAFI\\iAEEKIGOGGGOu@QJYBEE]UCQ@MCGCCUK[MUIGCWEQGgOKEKIS]AQAIACKKAC@CBOAAKQAECIAKIEUKECACAGELADCJAF@HCAA@@A@AAA@@A@A@A@AB@@A@A@@A@@@ABA@@CB@@A@A@A@@@A@@@AA@@A@@@A@@B@@@@@@@BB@@@@@A@@BA@@AA@@@A@@@@@@@@BA@@B@@@A@@@@A@@A@@AA@@A@@@@@@A@@@@@@B@B@@@@A@@@@@@A@AA@@A@@BA@@@AA@@@@AB@@@@@@B@@@@@@@@B@@@@A@@@A@@@@@@@@@@BAB@@@@@@@@@A@@@@A@@@A@AB@@@@@@@@BB@@@@@@@@A@@@A@@B@@@@@B@@@BA@@BA@@@AB@@A@ABA@A@@AC@A@A@AAA@A@AAA@@@@@@@A@@@A@@@@@@@@@@@@B@@@@@@@@A@@@@@A@@@@@AA@@@@@@@@@@AB@@@@@@@@A@@@A@@@AB@@@BA@@BAB@@ABA@@@@@@BA@A@@@@@@B@@@@@@@@A@A@AB@@@@A@@@@@@@@A@@@@A@@@@@@@@@A@@A@B@@A@@@A@@A@@AB@@@@B@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@BA@@@@@@A@@@@@@@@@@A@@@@@@@@@A@@@@@@@B@@A@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@AB@@@@A@@@@@@@@A@@@@@@A@@@@B@@@@@@@@A@@@@@@@A@@@A@@@@@A@B@@@@A@@@@@@@@@@A@@@AB@@@@@@@@A@@@@@A@@@@@@@A@BA@@A@@@@@@@A@@@@@@@@@AB@@C@E@@@@@@AA@@@@@@@@@A@@@@@@@@@@A@@@@@@@@A@@@@@@@@B@@A@@@@@EA@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@A@@A@@@@@@@@A@@@@B@@@@@@A@@@@@A@@@@A@@ABAAA@@@@@@@@A@@A@@@@@EB@@@@@@@B@@BA@BB@@AB@@B@@A@@@A@GB@@E@@A@@@@@@A@@@@AA@@@A@@A@@AA@@@@A@A@@@@@@@@A@@@@A@@@BAA@@A@@@@@A@@A@@AB@@@@@B@@@B@B@@@@@@AA@CAA@A@A@@AB@@@@@AA@@@@@@@@@@AA@@A@@@AB@BA@@@@@@@A@@@@@AA@@@B@@A@@B@@A@AAAB@@@AA@@@@@@B@@@@@BA@@@@@A@@@@@A@@B@@@@A@A@@B@@@@C@@@@@@BA@@@@B@@@@BA@@@B@@@@A@A@@B@B@@@@BBA@B@AB@AA@@BB@A@CBA@@@@BB@@@A@ABA@@@@A@@CB@@@@@@@AAB@@@@@@AA@@@@A@@@@@A@@@@@AA@@@@A@@@A@@@@A@BA@@@@@@@@B@@A@@@@@@BB@@@@@@@@@AB@@@@A@@@@@A@@@@@@@@@A@@BA@@@@@AA@@@@A@BB@@A@@@AB@@@@@@@@@@A@@A@@A@@@@@@B@@@@A@@@@A@@A@@BAA@@@@@B@@@@@@@@A@A@@@@@@@@@A@@B@@A@@B@@A@@@@B@@@@@B@@AB@AA@@@@@A@@@@@A@@AA@@@@@@@A@A@@@AB@@@BA@@@@B@@@B@@AB@@@@@BA@@@@@@@A@"],encodeOffsets:[[136879,47663]]}},{type:"Feature",id:"230523",properties:{name:"宝清县",cp:[132.196853,46.327457],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@@@@@AB@@@@@BA@@B@@@BA@@B@B@@AB@@@@@B@@A@@@A@@B@@@@@@AB@B@@@@AB@@@@@@AB@@@B@@A@@B@@@@@BA@@@@BA@@B@B@B@@AB@@@@@@@B@@@@AB@@@@AB@@@@@@@BAB@B@B@@AB@B@@@BA@@@@BA@@B@B@B@@@BA@@B@B@@@BA@@@@B@B@@AB@BAB@@@BAB@@@B@@AB@@@@@@AB@B@@@B@@@BA@@B@@@B@@AB@@@B@B@@@B@@@B@@@B@B@@@BA@@@@B@@BB@@@B@@@B@B@@@B@B@B@@AB@@@@@B@@@B@@BB@B@@@B@@@@@B@@@B@@@@@B@BB@@B@@@B@@@B@@@B@@@@@BAB@@@B@@@@@@@@@BB@@B@@@B@@@@@B@@@@@B@@@@@B@@A@@B@BB@@B@@AB@@@@@@@@@@@@@@@B@@@@AB@@@B@@@@@B@@@@A@@B@@B@@B@@A@@@@@@B@@@@@@@B@@@@@B@@@@@B@BA@@@@B@@@B@@AB@@@BAB@@@@@B@@@@@B@B@@@B@B@@A@@@@B@@@BB@@B@B@@@@@B@@@@@B@@@@@@@@@B@@@@@@@B@@@@@BB@@@@@@B@@@@@B@@@BB@@@@@@BA@@B@B@B@@@@@B@@@B@@@@@@@B@@@B@@@@@B@@@@@@@B@@@@@B@@A@@B@@B@@B@B@@@@@@@B@B@B@@@@@B@@AB@@@BAB@@@@@B@@@B@@@@A@@B@@@@@@@@@B@BA@@B@B@@@D@B@@AB@@@B@@BB@@@B@@@@@B@@@@@@@B@@@B@@AB@@@@@@@@@@A@@B@@@BA@@@@B@B@@@BA@@@@B@@A@@@@BA@@BBB@BBB@@@BBB@@@@BB@@B@@@B@@@@@@AB@@A@@@@@@B@@@@B@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@AB@@@B@@@@@@@@@@@@@@@B@@@@@@@@@B@@B@@@@B@@@@@@@B@@@B@@@@@B@@@@B@@@@@@@B@@@@@@@@B@BBB@@BB@B@@@@@@@B@@@@@@@@@@@B@@@B@@@@@@@@B@@@@@@BB@@@@@@@@@@B@@@@@@@@B@@@@@@B@@@@@@@@@@@@@BB@@@@@B@@@B@@@@@@@B@@@B@@@BB@@B@@@BA@@B@@@@B@@@@@@@@B@@@B@@@B@@@@@B@@@@@BB@@@@B@@@@@@@@@@B@@@AB@@@@@@B@@B@@@@@@@@B@@@@@@@@BA@@@@@@@@BB@@@@@@@@B@@@@@BB@@BB@@@B@@@@@@@B@@B@@@@@@@B@@@@B@@@@@@BB@@@BB@BB@@@@@@@@BAB@@@@@B@@@@@B@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@B@@@@@@@A@@@@@@@@@@@B@B@@@@@@@@A@@@@@@@@@@B@@@@B@@@@B@@@@AA@@@@@@@@@@@B@@@@@B@B@@@BA@@@@B@@@@@B@@B@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@B@@@@@B@@@@@@@@@@@@B@@@@@@@@A@@@B@@@@@B@@@@@@@@@@@@@B@BE@EBG@EBC@CBEHCREXAN@L@DDABIBGDIFG@GAIBE@AB@BC@@AA@C@@D@D@DDFDDDHB@HABCBADAD@BBBD@@HA@BCBCBCDCBABBBBDF@DADABCFEDADCDBABABA@@BD@F@@@ADEBAAEAADCBADCBC@C@E@C@CA@B@BDB@DDDBDBD@B@BBDABCBCB@BABA@@B@@@@@@@@@@@@@@@@@@@B@@@@A@@@B@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@B@@@@@@BB@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@BA@@@@@@@@@B@@@@@A@@@@B@@@@@@B@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@B@@@@@@@@B@@@@@@BA@@@@@@BB@@@@@@@B@@@@@@B@@@@B@@@@@@@@@B@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@A@@@@@@@B@@@@@@@@@@@B@@A@@@@@@@@@@B@@@@B@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@A@@B@@B@@@@@@@@@@@@AB@@@@@@@@@@@@@@@BB@@@@BB@@@@@@@@@@@BB@@@@@@B@@@@BB@@@@@@@@@@@@@B@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@A@@@@@@B@@@@@@B@@@@@@@B@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@A@@B@@@@B@@B@@B@@@BB@@@@@B@@@@BB@@@@@@BB@@B@@@B@BBB@@@@@B@@@@@@@A@@B@@@@@@@@@B@@@@B@@@@BB@@@@@A@@B@@B@@@BB@@@@@@AB@@@@@@@@@@@@@@B@@@@@@@B@@@@@B@@B@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@B@@@@@AB@@@@@@B@@@@B@@@@@@@@@@@A@@B@@@@@@@@@B@@@@@@@@@@@@@AB@@@@@@B@@BB@@@@@@@@@@@@BB@@@@@@@@B@@@@@@@@@@@@@@AB@@B@Bk^I@N`G@RuvbCPjX@T@@WBEQ}SSV@B@@BB@@@@@@@@@@@@@@AB@AAB@@@@A@@@@B@@@@BB@@@@@@@@A@@B@@@@@@@@BB@@@B@@@@@@@@A@@@@B@@@@@@@@B@B@@B@@B@@@@@@@BBB@@@AB@@A@@@ABB@@@B@@BB@@@@@D@B@B@@BB@@B@@B@BAB@@@B@@@@BAB@@B@@@B@B@@@B@@@B@B@B@@BB@BD@@@@B@@@@@B@@@B@@@@@@BB@@@@@@@@BB@BB@@@@@@@@B@@@@B@@@BA@@@@B@@@@B@@@@@B@@@B@@@@B@@@@@@@B@@@@@@@@B@@@B@@@@B@@@@@@@@@@@@@BB@@@@@@@B@@@@@@@B@@@@@@@BB@@B@@@@@B@@B@@@@@@B@@@@@B@@@B@@@@@@@@BB@@@@@@@B@@@@@B@@@@@@@@@B@BB@@@@B@@@BB@@@@@@B@@A@B@@B@@@@@B@@@@@@@BB@@@@@@B@@@@B@@B@@@@@B@@@@@@@@@BB@@@@@@B@@@B@@@B@@@B@@@@@@@@@@BB@@@@@B@@@@@@B@@@@@BB@@@@@@B@@@B@@A@@@@BA@@A@@@@A@@@@B@@@@BB@@@@@A@@B@@@@@@@@BB@@@@@A@@@@B@@@@@@@@AB@@@@@@@@B@@B@@@@@@@@@BB@A@@@@@AB@@@@@@B@B@@B@@@@@B@@@B@@@@@@A@@@@@@@@BB@@@@BB@@@@@@@@@@@@@@@B@@B@@B@@@BB@@B@@@B@@@@B@@B@@B@@A@B@@@@@@@@@@@@@B@@B@@@@@@BB@@BBB@B@@BB@@@BB@@@@@@BBBBB@@@@@@B@@@B@B@B@@BB@B@@@@AB@@@@@@@B@@@@AA@@@@@@@@@B@@@@@B@B@@@@@@@B@@B@@@@@@@@@@@@@@BB@@@B@@@BB@@@@B@@BB@@@B@@B@@@@@@B@@@@@B@@BB@@@BB@@B@@@B@@BB@@@BB@@@@@@@@BB@@@B@@BBBB@@@@BB@@@@@@@@@@B@@BB@B@@BB@@@@@@@@@@B@@@@B@@B@@@@@B@@B@@@@@@B@@A@@B@@A@A@@@@@@@@@B@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@BB@@A@AB@@@@BB@@BBDD@@DBB@B@@A@@@@BA@BB@@B@@B@B@@@BD@B@B@@B@@@BA@BB@@BB@@@B@@@BB@@B@D@@AB@@@BAFDBB@@@B@@@@BBD@BBB@BBD@B@B@@@@@@@BA@@B@@@@B@@@@ABB@@@B@@@B@B@@@@BB@@@B@@@BA@@B@B@D@B@B@@@B@B@D@B@BBD@B@BBB@@@D@BB@@D@@@B@@@B@@@B@B@@@AB@@ABB@@@@BB@BBB@@@B@BA@@H@F@B@B@@BB@B@BBB@BB@@B@BBB@@@@@BAB@@@B@BDB@B@B@@@@AB@BAB@F@DCB@@@DBB@B@B@FCDAD@B@BA@@@A@AB@@AB@D@@@B@BBB@@@B@@AB@BC@AB@DAB@B@@@B@@B@B@@BBB@B@BAB@BA@@@@B@BBDBBBBBA@@D@B@@@BB@B@D@HA@@B@@@@B@D@@@BB@B@@@D@DAB@@@BB@@@B@F@B@@BBB@D@DBB@BB@BBBA@AF@@@B@@B@@@B@D@B@B@@@B@@B@B@F@@BBBB@@B@ABAB@@@@BBF@@@B@BDBB@@@BA@AB@@@BB@DDFBFBD@BDDBBBDDD@@BBAB@BA@@B@B@@@BDDB@@@@@BCBA@@B@@@BBBFD@@B@DAB@@@@B@B@BB@BBB@B@@ABA@@B@@@B@@@@B@B@B@B@@BB@@B@B@@@@CB@BAB@B@B@DBB@BBBB@B@B@B@@BDBB@B@B@@CB@@@BBBB@BB@@@B@@E@@B@@@BBDFDB@F@B@B@B@BDD@FDDBB@B@@ABABAB@B@B@@@DDB@B@@@@AB@@A@@B@@B@@@BAB@B@@@BBB@B@@@@ABCDA@A@@B@B@BB@DB@B@@B@AB@BAB@BBB@BBB@@@BAB@B@B@B@@AB@B@@@B@B@@BBDBFBDBB@B@BABAB@BB@@BB@BB@B@B@@@BA@@B@B@DFB@B@@@B@@A@@@AA@BA@@@@B@@BBDBBBBB@B@@ABA@A@@B@@@@BB@@@@@B@@CB@BAB@@@@A@A@AB@DAB@F@@@@@BAB@B@@@B@@@@@@@@AA@@A@@@@BABAB@@A@@A@@@CB@@@@@@@A@@BABAB@@@B@BB@@B@@@@@@A@A@@@@B@BBB@B@@BB@@@@B@@ABA@@@@@@B@@DB@@B@DA@@@@BBB@B@B@BAB@B@B@@B@@@@@BA@@B@@@@@@H@B@@@BAB@@@@@@@BB@@@@B@B@BAB@@@@B@@@B@@@@B@@@BABA@@@BB@@@ABAB@@B@@BD@BBBAD@DABA@@B@D@@@B@B@B@@B@@@@AD@@@@B@@@B@BABA@@B@@@@@BBB@B@@@@AB@@A@@@@B@@@@@BB@@ABAB@B@@@@B@B@D@@@B@@ABAB@@@B@B@@B@B@@@BB@B@B@@@@@@CB@@@@@@@BB@BB@@@@ABABAB@B@B@BB@@B@B@@@@@BB@B@@B@B@B@B@B@B@@@BB@@A@AB@@AB@@@@B@@@D@@@D@@@@B@@@B@@@@B@B@@@@B@@AB@@@@@BB@B@BA@BB@@@@B@BA@A@A@C@AA@BA@@@BBDB@@B@B@BA@BB@@@ABAB@@@B@@B@@BF@B@@@B@@AB@B@@BA@@B@BBBB@BB@@@BA@AB@@@B@@B@B@@@B@@C@@B@B@B@@B@@@BAB@B@@BBD@B@@@BCB@@@B@@BB@@B@@AD@@@@B@B@B@B@@@BB@@B@B@@@B@FD@@@@@BAB@@A@C@@@A@@@BBB@DB@@B@@BA@CB@BB@FDB@@BA@@@GA@@A@@@@@@B@@ABA@A@@B@BB@@@B@B@B@@@@B@B@@@BLF@@@@@BC@@B@@@@BB@@B@B@@@B@@@B@BB@@@@B@@AAA@@@@BA@BB@BB@BB@B@B@B@@@B@@@B@AB@B@@B@B@B@B@BB@@@@A@AB@@@B@@@@@@D@B@DB@@@B@@ABA@@@BBDB@@BBABABAB@@@@@@BBFAB@@B@@ABB@@@B@B@@BAB@@BBB@D@B@BB@BBBJB@@BBA@@B@@@@BBBAB@@@@@BD@@@BD@B@@@@@@B@@AB@@@B@@B@@@DA@@@@@@@B@B@@@BD@BBBBBB@DBB@@D@B@B@B@@BA@@@@@@B@@@@@@@@@@@@A@@@@@@@@B@@@@@@B@@BB@@@@B@@@@BA@@@@@@@@B@B@B@@A@@B@@@@B@@A@@@@B@@@@@@@@B@@@@@B@@A@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@BB@@@@@BB@@@@@@@B@@@@@@@@A@@@@@@@@AA@@@@@@@@@AB@@@@@@@B@@@@@@@@@@@@@@@B@@@@B@@@@@@@@B@@A@@AA@@@@@@@@B@@@B@B@@B@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@B@@@@A@@@@@@A@@@@@@@@@@@@@B@@@B@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@B@@@B@@@@A@@@@@@@A@@@@@@@A@@@@@@@@B@@BB@@@BB@@@@BA@@B@@@@B@@B@@@@@@@@A@@@@B@@@@@@@@@@B@B@B@@@@@@A@@A@@@@@@A@@@@@@@@B@@@@@@@@@@@@@B@@B@@A@@B@@@@@B@@@@B@@B@@@@@@@@@@@B@@@@@@A@@B@@@@B@@@@B@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@B@@@@@@@@@B@@@@@@@@BB@@@@@@@B@@B@@@@@@@@BA@@@@@@@@B@@@@@BB@@AB@@@@@@@@@@@@@@B@@@F@D@@AB@@BF@BA@@@ABA@A@@D@@@DC@@B@B@B@@@@B@B@@@@B@B@D@@@@@@AB@D@@A@@@AD@DAB@BAB@B@DBDBB@B@@@@@BBB@D@DA@@BAB@@ABAB@@@AA@@BA@@@A@@@@BA@@D@BA@@@BB@@@BA@@B@B@@@@AB@@@B@BA@@@A@@B@@@BABA@@@@@AB@B@DC@A@@B@HABA@@FCB@@@@@@@AA@AA@@@@A@@@@@@@@A@@A@@@@@@@A@A@@AB@@A@@@@A@AB@@A@@D@B@@@@AB@B@B@BAB@B@@@B@B@B@B@@@B@BBDA@@@@A@@A@@@@B@BA@@@A@@@@@@B@@@BA@@@@@@DAB@@@AA@@@@BA@@DA@@B@@@DBB@D@@@D@@BDBB@@AB@@@@A@@B@@ABBB@BBB@DAFBB@@@B@@BB@@AB@BA@@DBB@@@BAB@DAB@@@@A@@B@D@B@B@@@@@BBBAB@BAB@@@BABB@AB@@BA@@BBB@@A@A@@B@@@@@@DBB@B@B@@B@B@B@BAB@@@BA@EB@@@@AD@BAB@@BB@BCB@@B@@B@@@BA@@@@B@@AB@FAH@BADA@CB@B@@A@A@A@A@@@@BBB@@@B@BAB@@ABB@BDBBBB@@AB@@B@@@AB@@ABBB@@@BBB@@A@@@BBBB@@BB@@D@BB@@DBB@B@B@@BB@B@B@@@B@@@@B@D@BB@BBB@D@B@B@BBBBBA@@@@@A@@@@BAB@@@BBBD@@BBD@B@B@BAB@DB@@BB@@B@@@D@BB@@B@@@B@B@@@A@@BB@@@B@BADCBA@@AABA@@@@B@B@@BB@BA@@@BB@B@B@D@B@@A@@B@B@B@@@@@@@BA@@BAHB@@@@@@@B@@B@B@@@@@@B@B@@@@B@B@B@@@B@B@@@B@@@B@BAFAB@DB@@B@@BB@@@B@BAB@@A@@B@BAB@B@@@B@D@@A@@B@B@D@BB@@@BBB@@@@B@@@B@B@@@B@@@B@@A@@A@@A@@@@B@@A@@@A@A@@@@BAB@@@BAB@@BBAB@@@A@@AB@@@BA@@@@@@BA@C@@@@B@BA@@AA@@B@B@@@AAB@A@@@@@CA@@@A@@A@@AB@B@@@@A@@@@@A@@C@@@@@@@BA@A@@@A@@@@A@A@@@@@BABA@@@@BA@@BABA@@DA@@@@BA@@B@B@@@D@@A@@BA@@B@@@@A@@@@B@@@B@@@D@@@@@@BBA@@B@@BB@@AB@@@B@B@FABA@@@AB@B@B@B@D@D@@@BBB@@@B@@@B@@@DBB@@@BA@@B@BA@@B@B@BBB@@@@A@@@@@@@@B@@@B@@A@C@A@@B@@ABA@@B@B@@@B@@BB@@B@@BB@@B@@@B@@@B@@@@@@BBB@@B@@@B@@B@@@B@@B@@B@@@@@@@B@@@@@@B@B@@B@@@@@@B@@@B@@B@AB@@@BB@@BAD@@BB@@@B@@@@AB@@BB@A@@BB@@B@@BB@@@@BBB@@@BAB@@@@A@@@@DB@@BAB@@BB@@@@@BBB@BB@@BAB@D@@@B@BB@@@BB@@B@@@@@B@@B@@B@@B@@@@B@@BBD@BBB@BAB@B@@BB@@BB@B@@BB@DBD@B@D@BAD@BB@@B@B@@@B@BB@@B@@BBA@BB@@@@@@@@@@A@@BB@@DCB@@A@@@@@@DA@AB@B@@@BAD@@@BB@AB@@@B@BA@@@@@@B@@A@@@@@@B@BAB@B@B@B@@@@@B@@@@@@BB@B@BBB@DBB@D@@BB@@@B@@@BBBBB@B@@B@@@BB@@BB@B@B@BBBA@BB@@@@BB@@AD@B@BADAB@DBBB@@@BB@@@B@@@@@B@@B@@B@B@@@B@@@BB@@@@B@B@D@BBB@@@B@B@B@B@@@B@@@B@BAB@DAB@@@B@@@@@@@BB@B@@@@B@@@B@@@D@B@B@@@B@@@BBBBB@@@B@B@@@@@BAB@BA@@@@@A@@@@@@@A@@@@@@BA@@D@B@@@B@@@@@B@@@B@BA@@@@@@@A@@@@BA@@B@@@@A@@@AA@@ABA@A@C@A@A@@B@@AD@@AB@@@@@BBDBB@@@B@@@@@@AB@@A@@@@BA@@B@B@@BB@@BB@@@@@@@@@@A@@@@AA@@A@A@CAA@@@A@@A@@@@@@@AB@@@B@@@B@B@@@BBB@BBDB@B@@B@@@@A@@@@@A@@@@@ACAA@AAA@@@@A@@@@B@@@B@B@F@B@@@B@@@@A@@@@@A@@A@A@AAA@@@@@@@@@@@BAB@@@B@B@@@B@@@BA@@@@@@@A@@@@@A@@@@@@@@@@B@@@@BB@@B@B@B@@@@B@B@@@B@DC@@B@@@B@@BBDB@@BB@@@BA@@@@@A@A@A@AAA@@A@I@@@A@@A@@@AB@@AD@B@@@B@D@DD@@B@@@@@@@@A@@CA@@@A@@@@DADA@@B@DBB@JBH@BB@@@BBBB@@BB@D@D@BA@@@@@AA@CC@@CAE@EAE@A@C@@AA@@@@@BABAB@B@H@F@B@@BJFDBDBDBBB@@B@B@@@@ABAAAAAAAA@A@E@AAA@@@@@DADAB@D@D@B@JFB@B@B@B@@A@@@A@@@AAACAA@C@C@G@@@@@@ADAJ@D@B@B@B@@@B@AB@BB@@@@@BABA@@@A@AA@AAA@@@BAB@BA@@AA@A@A@@AC@AAA@@EA@A@@@@@@BAD@B@@A@@@A@@AA@@@AB@@AJB@@@@B@@B@B@@@BHBFBB@DB@@FF@@BBB@@B@B@BB@LFB@BBDF@@@@DBB@F@D@BBBB@@B@@@@@B@@AAAACCCA@@@B@@ABBB@@@B@B@B@@@BBBD@@B@@@@@B@@A@AACA@@@B@@A@@BBHB@@B@B@B@@@BAAA@@EA@@A@@A@@BABAB@B@B@B@B@B@@@B@@@B@@B@D@BB@@BB@@AB@@ABA@@B@BB@BBBBFBBBB@@@@@@@@@@@@@@@B@@@@@@ABA@@B@@@B@@BBBB@@BB@@@@B@B@@@B@B@@@B@@@BA@@@BB@@@@@@@@B@B@@@B@@B@@B@@@B@@@BA@@BA@@BC@@@A@A@A@AB@@A@@BA@AB@B@@@BB@BB@@B@@@@BB@B@@@B@BAD@@@@@B@@@@@@@@@@@BB@@@B@@@@B@@@@@D@@@B@B@B@@@B@B@B@@@@@B@@@@@@@@B@@@B@B@@@@@@BB@@@@@@@@B@BABAB@@@@@B@@@@@@@@@@@@@@@@B@@@@@BAB@@@B@@@@@@@@B@@@@@B@BA@@@@@@BAA@@A@A@@@@B@@@@@@@@@B@@@@@BB@BBBB@BBB@@@@@BB@A@@D@D@D@FAB@@@@@D@B@B@B@B@@@@@@@@@@B@@@@@@@B@@@@@@@@@@B@@B@AB@B@D@BA@@@@BB@@@@@BB@@B@@@@@@AB@@@@@@@B@@@@B@@@@@@@BA@ABAB@@ABA@@BA@@@@@@@@B@@B@@BB@@@B@@BB@@@@@@@B@@@@@B@@@@@@@@@@B@BAB@B@BA@@BB@@@@D@@@BB@@B@@@@@@@@B@@@B@@@B@@@B@@@B@B@@B@@@@@B@B@@@@@B@@@@@@@@B@@BB@@@@A@@B@@A@A@@@AB@@ABAB@@@@@@@@A@@@A@@@@@@@@@@@@B@@B@@@B@B@@@B@B@BA@@B@@@@@@AB@@@@@@AB@@B@@@@@@B@@@@@@BA@@@@BCBA@CB@@@@@@@@@@BB@@@AB@@@D@BABA@@B@@@@@@@B@@B@@@@@BLItczS\\gpoæwfI\\FT@Z@rDdDO IIrBD^bCdDHAFCDAB@B@B@FABA@ABA@AB@B@BAB@@@@C@@BAB@FBB@B@@A@A@CAI@A@GBCFGBC@A@AAACAEA@AAAAAAA@@BA@A@@AAA@AAAB@@@A@@@ABABA@A@AEEAA@AHCRILGFEBAB@FAHAD@DEHGDCDCDCB@DAJCDAFCJEBABCAAEICEKIGEAAEAC@@A@ABAAA@A@ACA@A@C@@FEBEBA@IBABEDE@@@@@AMACAA@@C@ABCDE@A@@@A@A@@AA@ACA@@@@A@@@@@A@@@C@A@@@A@A@A@C@C@A@A@@@A@@@@@AAA@@@A@CA@@A@A@@@A@@@@BA@@@AA@@A@AAA@@@AAC@@AA@A@@@@A@@AAA@AAA@A@AA@@AAA@CCA@CAA@@@AAAAA@@@AA@CA@AA@@@A@@A@@@A@A@@@A@AB@@A@A@@B@@C@C@@B@@@@A@@@@@@BB@@@@@AB@@@@@B@@@BBBA@@@@BA@@@@@@BAB@B@@@@AB@@A@@@@@@@AB@@ABA@@@AB@@A@A@A@@@A@@@@@@@A@A@@@@@A@AAA@C@AA@@C@A@A@@@AA@@A@@BA@@@A@@@@@A@@@A@@A@@@@A@@@@@A@A@@BA@@@A@A@A@AB@@@@AB@@A@C@@@C@A@A@CB@@A@@@@BA@AB@@@@@@@@@@A@@@@@A@@B@@C@@@EB@@A@C@A@@@@@E@@@@@A@@@@@@@@@@@AB@B@@AB@B@@@@@B@@AB@@@@B@@B@@BBBDB@BBBB@@BB@@@@BB@@@@@BBBBB@@@BBB@@@@@B@B@BABABA@@@@B@BA@@@A@AB@@@@@@AB@@@@@B@@@@A@@@@B@B@@@BADA@@@@BAB@@@@A@@B@@AB@B@@A@@@CB@@A@@@C@CAABC@C@A@@BA@CBA@@BA@ABAB@@AB@@@BA@ABA@AB@BA@@BA@A@E@A@C@@@@@@BAAA@@A@@ACA@@A@@@@@A@@@A@@@@@@A@AAA@A@@@AA@@@@CA@@C@AA@@A@AAA@@@AAA@@@A@AAA@E@A@@A@@@@@@@@@A@A@A@@@@@A@@@@CB@@AAE@@@A@@AA@@A@@AA@A@A@A@A@A@@@A@@@@CAA@A@AA@@C@AAA@C@@AA@@@CAC@AAA@A@AA@@@@@@@@A@@@@@@@@B@@@@A@@BA@@@@@ABC@A@A@A@A@@@@@@@@@AA@@@@AAA@@@@@AA@@AAA@A@AACAA@A@@@@AA@@@@B@@ABA@@@ABC@@@A@@@@@A@@@@@@A@@@A@@@@@@@@@@AAAAA@AA@@@@AAAA@@@AAA@@@@@A@@@@@@@@@@@@@@BA@@B@@@@@AA@A@@AA@C@@@@@@@A@@@@@@BAB@BAB@@@@A@@@@@@@@@@BAB@@A@@@@B@@A@@@A@@B@@AB@@@@@B@@@@AB@@A@A@@ĹpgiosQSeiGQWG]IIiYdOYcW]SGDQCI@_KMYGYS@IA}_@o½Â«CCCAA@@A@@A@@A@@@@AA@@@@@@@@A@@@@@ABA@@@AD@@@@@B@@ABA@CBCBA@@@@@A@@@AB@@@@@@@B@D@@@@A@@@ABA@CAA@A@AAA@C@A@ABA@@B@@A@@@@@@B@@@BAB@@A@@BA@A@@@AB@@A@A@@@A@A@C@AAA@@@A@A@@@AA@AACAAA@A@AAA@@A@A@A@ABA@@@@@A@@@@@@@@@@A@A@A@AB@@@@EBA@@BA@@@@@ABA@@@A@@AA@@@@@A@@B@@A@AD@B@@@@A@A@@@@@C@AA@@@@@AC@AAA@@@@@@@A@@@@@@@@@ABA@@@AB@@@B@@@B@B@@@@@BA@AB@@@@@B@@AB@@A@@@A@@@@B@@@@@@@@BB@BBBB@BB@@BB@B@@ABABABAB@B@@CDA@@BAB@@@@ABA@@@A@@B@@AB@@@@ABA@@@CBA@@@AB@@@@@BA@@B@@@@A@ABC@ABA@ABA@A@C@C@@@A@C@@@A@@@@@ABA@@@CDABADA@@B@B@HAD@@EBCBG@A@CBE@A@A@A@@AA@AACCCAACA@AACAAACAAACAA@AAA@C@CAA@C@AAC@A@C@C@CAA@CA@@A@CAC@A@A@AAA@@@AAAA@@@@AC@@@A@A@A@E@AAAAAA@A@A@C@A@@@AAAA@@@AA@@A@A@CAAAA@A@@AA@@@@@A@CIBLN]MSSqQ_WHD[ A@@@@@@A@AA@@AA@@@@A@@@@@A@@@A@@@A@AA@AA@@AA@@@@A@@@@A@@@@@@@@@A@AA@@AA@@@A@AAAAACAAA@AAA@@A@@A@A@AAA@AA@@@@AA@@@@@A@@@@@@@@AA@@A@@@@AA@A@@@A@@@@@@A@AAA@@A@AAC@A@AACA@AA@@@AA@@A@A@CAA@C@ABC@@@A@@@@@AA@A@@@@AA@@A@@@C@A@@@A@AAA@@@A@@@CBA@@@@@@@A@@@CAA@CA@@@@A@@@A@@@A@@@@@C@@@A@A@@BA@@B@@CBABA@@@@B@@@BA@@@ABA@A@@BA@ABABCBAB@@@BAB@B@@AB@@@@@@A@A@@BA@@@AA@@A@@@A@A@@@A@@@A@AB@@@@A@@B@@@@@B@@AB@@@BA@ABC@CBA@@@@BA@@BAB@BA@AB@@AB@@ABABE@CBEBABABA@@@ABABA@@B@@CBABC@ABAAA@A@AAA@A@A@A@C@E@AAA@A@A@A@CBCBABA@A@A@@@@BA@@@@@A@A@A@@BA@A@@@A@E@A@C@@@@BA@@@A@AB@@A@A@AAA@A@AAA@C@A@C@C@C@AAA@AAA@A@A@EA@@@@A@@BA@A@ABA@ABA@@@A@A@A@ABA@AAA@A@@A@A@@@A@@@A@@@A@@@@@@@A@@@@@@@@@AB@@@BCDA@@@@@ABA@A@@@@B@@@@A@@AA@@AAACAA@@AC@AAAAAAA@@A@A@@@A@CB@@A@A@A@A@AA@@A@A@@@A@A@A@@@A@@A@@@A@@@A@@@@@A@@@@@A@@@@@A@CA@@AA@@A@@A@AAA@A@@@AAA@CA@@A@A@EEAAA@@@A@E@A@C@@@A@@A@@@@@A@@B@@@BAB@@@@@BAB@BABA@AB@@A@@@@BCBCFABABA@A@A@A@A@@@A@A@@@@@A@@@@BA@@@@B@DA@@@@B@@@@@A@@A@@CCAC@@A@@@AAEA@@A@A@A@AA@@AAAA@A@@CA@AAAAA@A@@@@@@AA@@CC@A@@@A@@@A@@@@@@B@@@BAB@B@@@B@BA@@@A@@@@@@@A@AB@B@BA@@B@@@@ABA@A@@@@@A@@BA@@B@DAB@B@BA@@BA@@DA@@@ABA@A@@@ABA@@@@@@@A@@@A@@AA@AA@AAAA@@@@@@@@@A@@BC@@BA@@A@@@@@@@@@A@@@A@A@@@ABAA@@@@@@@CBC@C@@@@@@@C@A@@A@AAA@@@AA@AAA@@@A@C@A@A@@@ABC@@@@@AB@@@@AAA@AAA@AA@@@EAA@CAAAA@@A@@@@@A@A@@@@@A@@@AA@AAA@@@@@AA@AAA@@@@@A@@@A@A@AB@@ABA@@@A@@A@@@A@A@CA@@@@A@A@@@@@A@A@A@@@A@A@@@A@AA@@A@A@AB@@C@ABABA@@@A@@@@@@@ABABA@@@@BA@A@@@A@A@C@A@A@A@@@A@ABA@A@@@A@A@@@A@@@AA@@A@AA@@@A@A@A@@@ABA@@@CB@@A@@@A@@@AAA@A@A@@@@A@AAA@AA@@A@@A@@@AA@AA@@@A@A@@@AA@BC@@@@@@@AB@AA@A@@A@AA@@@@@@A@A@A@A@@@A@@@AAA@AB@@@@A@@@A@@@C@AAAAA@@AA@ABABAB@@ABA@@@CDEDCBABCBEBCB@@CBA@CBA@C@A@A@AA@@CACAAA@CC@AAA@AA@@A@A@AB@@A@A@A@C@AAGAIECAAAAAAA@@A@C@C@E@E@CAA@A@E@A@C@A@A@ABA@A@CBA@E@E@G@C@A@EACAA@A@A@@@AA@@@@@@AA@C@C@A@A@@@@@@AA@@@@A@A@@@C@E@C@@AA@AA@AA@@@@@A@@@@@A@A@C@@@@@@@A@@@AA@@@@A@@@@@A@@AA@CAA@@A@@A@AA@@A@@@A@@@@@@D@@A@@B@@A@@@A@@B@@@@@@@AA@@@@@@@A@@@@AA@@AA@@AC@A@AAA@ACA@AAAAA@@@C@A@A@C@A@AA@@@@AC@@A@AA@BA@C@ABC@ABA@ABA@@@A@A@@@A@@@A@A@@@A@A@@@A@@@@@@AA@AAA@A@A@A@E@A@@@A@@@@@A@A@AB@@A@A@ABA@@BA@A@A@A@@@C@A@A@A@@@A@A@A@A@A@A@A@AAA@AAA@@@@@@@AB@@@@@BBB@B@B@@@@AB@B@@@@@BB@@@@B@@@B@@@@@BA@@BA@A@@@@BABAB@BAB@@A@CB@BA@A@A@AB@@AB@@@@@@AB@@@@@BA@@BA@ABCB@@A@A@ABA@@@A@A@@B@@A@@BA@@BA@A@CB@@A@@@@@@@@@@@@@@B@@B@BB@BB@@B@B@@@BBB@B@@@B@@@BA@@@@@@@AB@@@@@@BB@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@AB@@A@AB@@A@@B@@@@AB@@@@@@A@@BAB@@AB@@ABABABABABA@@@@@@B@@@@@B@B@@AB@@@@@BA@@B@@@@@@@@@B@@@@AA@@w`k]}\\_gM@@A@@B@@@BBB@@@B@@BB@@@BB@@B@@B@@B@@A@AB@BA@@BA@@B@@@B@@@BB@@B@B@B@@@B@B@@BBBD@@BB@@@B@@@B@BA@@B@B@@@B@@B@@B@@B@@B@@@@BB@@@B@@@B@@@@@B@@@@B@@B@@@BB@@@B@@BB@@@FBHDJL@NBL@BDDTJH@FAJAD@FBFDDJFFFFNFBB@DBBBBF@BDBDDFDBFFJN@DBHBDDDRHBBFBLFDBPJH@b@DBFDDBBD@DABEBG@SBC@KFGHAD@@AD@FBHHFDD@JBDDFBD@BADAF@D@DBDVNJLBF@DADCDaNO@Q@eOAAMEIEAEAAA@IBK@E@CCEGAAG@]DA@A@SBC@@@E@EBCAC@EAEGEECAA@@@C@A@A@A@EBCFGPGFCBC@A@mPIByTEBAFBLAHELANAn@X@N@FBDDBdNLFDBDDBJAHEREHCDE@C@IAG@C@QFaFYFKFIDGAGAEEGGECE@I@AB@FALBJ@FADABC@EBIAKBC@C@ECGCG@K@G@C@CBEFADCBABEBE@C@GCIECCIAC@A@CBGJCBCDABCF@BC@C@I@GAE@GDGDEHKNCBE@E@I@[CG@I@G@A@CEC@EACF@@@@@@EBA@A@ACCCGACAKAC@MDSHQD[NABCFEDSJCFCFEDEBI@IBEBMJEDAFAFKNEDMFIDIBYAI@MDABQDEBCB@HCF@DBBDFPDHFBDABABCBO@A@C@@@ABA@ABA@@@A@@@A@A@A@@@@@@@@B@@ABB@@@B@B@B@BB@@BB@@@B@@@@@BA@A@@BA@AB@@AB@@ADA@@@@BAB@@@B@BA@@@ABAB@@@@AB@@@B@@B@@B@BB@@BA@@BA@@@A@A@@@A@@BA@A@@@@@A@@@@@A@@@A@@@@@A@@@@@AB@@A@@B@@A@A@@BA@A@@@@@AB@@@@ABAB@@@@AB@@A@B@@BB@BBB@@BB@@@@B@BB@@B@@BB@@B@@B@@@@@B@@@BA@@BA@@B@@@@AB@@@@@B@BBB@@B@B@@@@@BB@B@@BB@BBD@B@B@B@@BB@@@@DB@BBBB@BBBBB@BBBBBBBBB@B@@@DBB@D@B@D@D@F@@@B@@@@@B@@@B@@@@@BA@@@@B@@@@@BA@@@@@@BA@@@@@@@@B@@A@@B@@@@@BA@@@@@@@@@@@AB@@@@@@@@A@@@@@@BA@@@@@@@A@@@@@@@@@A@@B@@A@@@@B@@A@@@@B@@A@@@@B@@A@@@@B@@A@@@@BA@@@@@A@@"],encodeOffsets:[[134454,47498]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
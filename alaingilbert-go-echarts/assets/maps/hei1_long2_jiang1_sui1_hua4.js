@@ -0,0 +1,6 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("绥化",{type:"FeatureCollection",features:[{type:"Feature",id:"231221",properties:{name:"望奎县",cp:[126.486075,46.832719],childNum:1},geometry:{type:"Polygon",coordinates:["@@FAHABA@C@@@AAAA@C@EAAAACAEAC@ABADCDEDC@A@CBAACACAACCCC@@C@E@C@GAEAAA@ABA@ADCBA@CAACAC@E@C@AACA@A@ADADCBA@AAAAAA@EAE@G@C@CAGCCCCAAAC@EBC@A@AAAA@@@ABCBC@A@A@AACA@A@E@G@CBE@C@AAA@@AAAEC@D@B@@@BEAA@ABAB@B@@@BBB@@@BADA@ABCAC@A@A@A@C@@@@@BBB@@B@@@BA@C@AB@@AB@@BB@@@@@BA@@@AA@@AA@@A@@BB@@BA@AB@@@@@BB@@@BAB@@AB@B@@B@D@BAB@@A@@@A@A@@BA@AB@@@B@@A@A@A@@BB@BB@@@@@B@AA@AB@BAB@B@F@BBB@@@BB@@B@@@B@@A@A@A@A@A@A@@BB@@@@B@@A@@@AB@BB@BB@@@BA@CBCB@@@B@@@B@@@@@@A@@@AA@@@AA@AB@@AB@@ABA@@@A@@@BA@@@@C@@@@@@@@A@@B@BA@@@@@A@@A@A@@@AB@@A@@@@AA@@@A@AAA@@@@@AA@@@@AB@@@@A@@@@AB@A@@@A@@@AB@@A@@A@@A@@@A@@B@@A@AAA@A@AB@@@B@@@B@@A@@@AAA@@AAB@@B@@BA@ABA@@@A@@@A@A@@@@BB@BB@B@@@@A@A@A@@A@@A@@B@BABB@@BB@B@B@@B@@A@B@@B@@@@AB@@@@BB@@@B@@BB@@A@@@A@@@A@A@A@@@AB@@@B@@A@A@A@@@@B@@B@BBB@B@@B@@@BAA@@@@AA@BA@@@A@A@@@AB@@@B@@B@@AB@B@B@B@@BAB@@A@@@@@A@@@@@@@@B@@ABAA@@A@ABABABB@@@B@@@B@@@@B@@@B@@@@ABA@@@@B@@BB@@@@@AB@@@@BB@B@@@@@B@@@@@AB@@@@CBA@@@@B@@@@BA@@@@BB@@@@B@B@BB@@@B@BA@A@A@A@@B@@B@@B@@@@CB@B@B@B@@@@B@@@B@B@B@@B@@AB@BBB@BAB@BB@@@@B@@@BAAA@@B@@@B@BA@A@@@@@@A@@@AAB@@@BA@@@A@@@@AAA@@A@@@@B@@@BA@A@A@AA@B@@BB@@@@A@ABA@@@@@@BBAB@@B@@@B@@D@@@@@AB@@@@BB@BA@C@A@AA@@A@AB@@@B@BA@BBADBB@B@@A@@BB@@B@@@B@@ABA@A@@@A@AD@B@DABA@ABA@@@@@AB@@@@@@@@BB@@@@@A@@B@@@@@@@B@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@B@@@@@@@B@@@@A@@@A@@@@@AB@@@@B@@@@@@@@B@@B@@@@B@@@@@@@@@B@@A@@@@@@BA@@@@BAA@B@@@@@@@@@BB@@@@A@@@@B@@B@@@B@@@@@@BB@A@@@@@@@@@@@@@@B@@@B@@BA@@@@@@BB@@@B@B@@@B@@@B@@@@@@@@@@@ABA@A@@@A@@@@@@@B@@B@@@@@B@@@@B@@@@B@@@@@@@B@@@@BA@@@@@@@@@@@B@@@BB@@@@@@@BA@@@A@@B@@B@@@@@@A@@B@@@@A@@B@@@@@@@@AB@@@@B@@B@@@B@B@@@@@@AB@@@@A@@B@@@@BB@@@B@@A@@@A@@B@@@@@@@@BB@@@@@B@@@@@BA@@@BB@@@@B@@AD@@@@@@@BA@@@@@@@@B@@B@@@@@@@BA@@@@B@@@@@B@@@@B@@@B@B@B@@B@@@@@BA@AB@@@BB@B@BB@B@B@@@@@@@@A@@@@@@@A@@@@B@@@@@@AA@@@@@@A@@@@@@B@@@@@@@@AA@@@@@@@@A@@@@@@@AB@@@@AB@@@@@A@@A@@@@AAA@@@@@@@@@B@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@B@@A@@@@@@@@A@@@A@@@@@@@@@@B@@@@@@A@@@A@@@A@@@@@@@A@@@@@@@@@@@A@@@@@@@AB@@B@@B@@@@@@@@@B@@@@B@@@@@@@@@@B@@A@@@A@@@@@@A@@@A@@@@A@@@@@A@@B@@@@@@@@@@B@@@@@@@@@@B@@A@@@A@@@@@@B@@@@@@BB@@B@@@B@@@@@@@@B@@A@@@AAA@@@@@A@@B@@@@@@@@AA@@@@@@@@A@@@@@@BA@@@@B@@@@@@B@BB@@@@B@@@A@@@A@A@@@A@@@A@@B@@@@AB@@@@@B@@@@@@BB@@@@@@@@@B@@@@@@A@@@A@@@AAA@@@@B@@@BB@A@@BA@@@@@@@B@B@@BB@@@@B@@AAA@@@@@ABA@@@@@BB@@@@@@@@A@@@@B@@@@@BA@@B@@B@@BD@@@@B@@@@A@A@A@AB@@AB@@@B@@@@@@A@@@@B@@@@B@BB@@@@@B@@@@A@@@@A@@A@@@@@A@@@@@@B@@@@@@B@@B@@@@@@@@B@@@@@@B@@@@@B@@B@@@@@@@@ABB@@@@@@@B@@A@@@@BA@@@@@@@B@BB@@@B@@@@A@@@@@@B@@@@@B@@@@@A@@A@@AA@@@@@A@@@@@B@@B@@@@@@@@A@@@A@@@@@@@@BB@@@@@@@A@@@@@ABA@@AA@@@A@@@AB@@@@BB@@@@A@@@A@@@@BB@@BBB@@@B@B@@@@AB@@@@@@@@B@@@B@@@BB@@@@@@@@A@@@@@@B@BA@@@@@A@@@@@@BA@@@@@@BB@@@@@@B@@@@@@@@@B@@@@A@@@@@@B@@BADBB@@@@@@@A@@B@@B@@@@@@@AB@@@@@@A@@@@BA@@BB@@@@A@@B@@@@@@@@B@@ABB@B@FBBBD@@@@BA@@B@@@@B@@@@BABB@@@BAB@@BA@@B@@C@AB@@@@BB@@AB@B@@CB@@A@@@A@@@@@A@@A@@@@@A@@A@@@@@@@A@@@@@A@@@A@@BB@@@@@BBA@@@@@@@AAA@A@@@@@@@@@@@B@@B@@@@@@A@@@A@@@@@@B@@@@@@@@@@A@@@@AA@@@@@A@@@AB@@AA@@@@A@@B@@@@A@@@B@@B@@@B@@B@@B@@@@@@A@@@@@@BA@A@@@@@@@@BB@@@@@B@@@@@@ABBB@@@@@@@@@@@@@@BB@A@@@@@@@A@A@@@A@@@@@@@@B@@B@@B@@@B@B@@A@@@@@AAA@@AA@A@@@A@B@@@BB@@@@@@@@@@A@A@@@@BA@@@@BA@@@@@@BB@@B@@A@@@A@@A@@AA@@@@A@B@@B@@@@@@@BA@@@@@@@@B@@@@@B@@A@@AA@@AA@@@@@@BB@@B@BA@@@A@@@@@@@@@@AB@@@@AA@@@A@CD@@@@@@BB@@@@@@@BA@@@@AA@@@@@@B@@@@@BB@@B@@A@@B@@@@B@@@B@@@@@B@@@AB@@A@@@A@@@A@@@@@@B@@B@@@B@@@@@A@@BA@A@AB@@@@@@B@@@B@@@B@@@A@@@@BA@AB@B@@@@@@@@@@B@@@BA@@B@@B@@AB@@@BA@A@@@ABA@@@@@B@@B@@@@@@ABAAA@A@@BA@@@AB@@B@@@@@@@B@@@@@@@@@@B@@@@@@@@@@B@@@B@@BB@@@@@@@ABA@@@@BA@@@@@@A@@@@AAA@@@A@@B@@@B@@@BA@B@@@B@@@@@BA@@B@@B@@B@B@@@@@B@@@@B@@ABA@CA@@@B@@@B@@@@@B@@B@@@B@@@B@@@@BCB@@AB@@B@B@DB@@@@@@A@A@C@A@@B@@BBB@B@@B@@@@@B@@@@@B@@B@@B@@AB@@@@B@@BB@@@A@@B@@@BBB@B@@@B@@A@C@@B@@AB@@@@A@A@A@@@@@@BB@@B@@@@A@A@@@@@A@@@@@@@@B@BB@@B@@AB@@A@@AA@@@@@A@A@@B@@@@@BB@B@@B@@@@A@AAA@@@AAA@@B@@@@@@B@B@@B@@@@A@A@A@@@@B@@@@@@DB@@@@@@@@@BA@@BB@B@B@B@B@@@AB@@A@A@AB@@@@@BB@B@@@@B@@A@A@@@@B@B@@@BAB@@C@@@A@ABA@CBA@@@@B@@B@B@@@BB@@A@@B@@B@@B@@ABA@A@@BAB@B@@@BB@@@B@AB@B@B@BB@@@B@@@B@@@@BB@@@DA@@B@@B@@A@ABAAA@CB@@A@A@@B@@@BB@@AB@BA@@@BBB@B@@@BA@@@C@A@ABA@@@@B@@B@@@BAB@B@@@@B@BA@@BC@@B@@@BB@B@B@B@@BDFB@@BA@@BA@@@@B@@B@@@BB@@@@A@@@@B@@@@A@@@@@@@@A@@A@@@@@@@A@@@@@AB@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@B@@@@BA@@@@@A@@@A@@@@@@@@B@@@@@@@@@@@AB@@B@@@@@@@@@@BB@@@@A@@@@@@@@@@@BB@@@@@@@@@@A@@BA@A@@@@@AB@@@@@BA@@@@@@@@BA@@@@@@B@@@@@B@@BB@@B@@@@@@@B@@@@@B@@@@@@@@@@B@@@@@@@@@@@@BB@@@@@@@@@@@B@@@@@A@@A@@@@@A@@@@@@BB@@@@@@B@@B@B@@@@@@@B@@@@@@@B@@@@@@@@B@@B@@@@@@@@@@A@@@@@@B@@@@@@@BA@@@@A@@A@@@@@@@@@@@A@@@@@@@@@@BB@@@@@BB@@B@@@B@@@@@B@@@B@B@BB@@BBDDD@D@@BBB@B@@B@BD@BBBB@@@BB@@@BB@@@BB@@B@@B@@BBAB@@@@B@@@BB@@@B@@@BB@@BBB@@B@B@@BBB@@@A@A@@BA@@BBB@B@BABA@A@@@AA@@@@A@AB@@@@@B@B@@@B@@@B@@B@BA@@B@@B@@CBA@@@@BB@DB@@@B@BABABA@@@BB@@B@B@BBBBB@B@B@@@@A@@B@@@D@@@@B@@BB@BB@@@@B@B@@@@B@B@@AB@@@B@@@AB@@ABAB@@@@@B@@B@@@BAB@@@B@B@BBB@@@BBBA@@BAB@@@B@@@@@@BB@@@A@@@@@A@@B@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@B@@@@A@@BB@@@@@@@@@@@@@@@B@@@@@B@@@HBH@D@FBH@HBD@B@D@B@B@BBD@B@F@B@D@B@DBD@D@D@B@F@DBH@J@H@DBB@D@F@BBF@DBD@B@H@BBH@H@DBB@D@B@D@BBB@H@B@F@B@FBD@F@B@B@BBB@B@B@D@D@B@B@FBF@D@H@BBD@H@B@F@DBF@D@D@B@D@DBD@D@B@D@F@B@FBB@D@B@D@F@BBD@D@B@F@D@DBD@B@D@D@FBD@B@B@D@FBD@D@D@DBF@B@B@B@@BB@@B@B@B@B@BD@J@D@F@D@D@B@B@F@B@F@F@FBF@F@H@B@D@B@D@D@@@D@D@BB@@B@B@B@B@B@B@@@B@DB@@@B@B@DAD@BD@B@F@D@B@B@B@B@F@B@B@D@D@@@DAD@B@D@@@D@B@B@F@B@B@D@D@BBB@D@B@B@B@D@@@@B@@@D@HAF@H@F@FAF@F@F@HAF@F@F@L@BAB@J@F@FAJ@B@F@DAD@D@D@D@@@@@@AD@HAHAVDBH@FB@@D@F@D@D@@@B@B@D@B@F@F@F@D@DBF@D@H@F@F@FB@C@AB@B@F@D@D@D@F@FAB@D@B@D@B@@@D@B@D@D@D@B@BAB@B@D@@@B@D@B@B@B@@@B@F@B@B@BAD@B@D@B@D@B@B@D@B@B@D@@AB@@AB@N@H@F@F@F@H@B@FBF@D@@AB@D@DBD@B@@@D@D@D@D@D@FBD@B@@@@@B@F@F@H@@@B@B@B@H@F@D@B@BABAB@B@H@F@F@B@B@BB@D@H@DBDBF@@@@D@LBJ@LBRBN@FBLBH@J@F@@@D@@@D@D@B@D@F@DBD@D@B@F@D@DBB@H@F@D@DBF@N@J@ADAT@FD@JBVDJ@HBB@D@DBVBF@BBD@D@JBJ@PBPBH@F@H@AAA@A@AA@@B@B@@AA@A@@C@@B@@@BA@@AA@@AA@A@@@@BCB@@A@@CA@@@@@A@@D@@A@A@A@@B@B@B@@@BAB@@@@A@@@@@A@@B@B@DCB@@@@@@A@A@@@@B@B@@@AA@@@AD@BABA@A@A@@@@A@A@@@@@@A@@@@A@A@@@@A@@D@@@@@@AA@A@@A@@@@@AA@@B@@ABA@@A@@@@@A@A@@D@@AB@@@AAA@@A@@@@ABA@@@@@AA@AB@@@B@@A@@@A@@@@C@@@@AA@@C@@@@A@AA@@B@@@BAB@@@B@@@@A@A@@@@B@@AA@@@@@A@A@A@A@A@@@@@@A@@C@A@@@@A@ABA@@B@@@@AA@AB@@@A@@@@B@@AB@A@@@A@@A@@@A@@A@@@@AB@B@@@@@@@CAA@CB@@AA@@BA@@AA@@@AAA@@@@BAB@@@@A@A@@@A@@@@DAB@B@@@B@@@@@@AA@@A@@@@D@@ABA@@@@A@AAA@@@B@@A@@C@A@@A@@@@C@@@@@@AB@@A@@@AA@@@BA@@@@@@A@A@AACA@A@@@@BA@@B@B@@AA@@A@@BA@@@@BB@@@@@@@AB@@A@A@A@@B@B@@@B@@@@@B@@@@A@@A@E@@A@@B@BABA@@@AA@C@@@@@BA@@@CA@@@A@A@CBA@@AA@AA@@B@@@BA@@@@@A@@@@@CBAA@@@@@@AB@@@@A@@@@A@@AA@@@@C@@A@@@@AD@@@B@@A@@@AA@AA@@BA@@@@AAABAA@@@A@@C@@@A@@A@@A@A@A@@@@@@AB@B@@@B@@@@@@A@A@@D@@@@@@@AAA@AA@@@@@@B@BA@@AAB@@A@@B@AG@AB@@A@@D@B@@@@@CC@@@A@@B@DBBBBA@@@@@@AA@@A@@@A@@A@@B@BAB@@@@A@@CAA@@A@@@A@@B@B@B@@@@@@AAAA@ABA@@A@@@A@@CA@@@@B@BA@@@@@@A@@@A@@@@@@@BA@AB@@AD@@A@AB@@@@BB@@@B@@@AA@@A@@@BAA@@AB@@@@A@@A@@@@@@AA@@AABAACA@@B@@A@@@A@@B@B@@@@A@@A@@@AB@@A@@@@ABA@A@@B@@@@@@A@@A@@A@@@@@@A@@@@@@@@A@@B@@A@@@@A@@@AB@@@A@AA@@@AB@@@@@A@@@A@@@@CAAA@@@A@@@@@AB@D@BB@@BA@@@@AA@@@@DA@@A@C@@@@A@@A@@BB@@B@@A@@@AA@@@@A@A@@@@AB@BA@@A@@@A@@@@@AC@@@@A@@@A@@@@AA@@@@@B@@A@@A@A@@@@@@@BAB@@@@A@@AA@@@@BABA@@AA@@BAB@@A@@AA@@@@@A@@@@A@A@@A@A@@B@@@@A@@AA@@@ABA@@@@@@C@@@@@BA@AD@@A@@C@@@@@@@BA@@@@@AA@@@@BA@@@@@@@BA@AA@A@@@@@@B@@@BA@@A@@@A@@@@@@A@AAA@@@@@B@@@B@@@@AC@A@@A@@@A@@@@C@AA@@@B@B@@@BA@@@@@@BA@@@@@@@@AA@@A@@A@@BBB@B@@A@@AA@@A@AC@@@@BA@@@@@AA@@@AA@@@A@@@@A@@A@A@A@@@@AB@B@@@@AA@@A@@A@@@A@@@A@@@@@BA@@A@@@AB@@A@@A@@AB@@AA@@@@BA@@A@@@@A@@@@@@E@@@AA@@@@B@@@@CB@@@@B@@@@A@B@@@B@@@B@@@B@@AA@A@@AC@A@A@@BB@@@@@C@@AA@A@@A@@@A@@@@BAB@@@@BB@@@@@@@@A@@AA@@B@B@@@B@@@@@B@@@B@@@AA@@A@@A@@@ABAB@@@AA@@AA@@@@@@CCAEAC@@@@CAA@@@GAA@@@@@@@@@@AA@@A@@A@@A@@@@@AA@CA@@BA@AA@@@@@@AA@@A@@@@@A@@@@@@@@BADC@@B@@@D@@@@@A@@AA@@@A@@@@@BA@@@@DBB@@A@@@@A@@A@A@A@@A@@BA@@@@@@AB@@A@@A@@@@@BA@@@A@@@@@@A@A@BA@@AA@@@@B@AA@@B@AA@@B@@@@@@AA@@@@@@@B@B@@A@@BB@@BAA@@@@@@AB@B@@A@@@@@A@@A@A@@A@@@@@A@BB@B@@@BAB@@@B@@@BA@@B@@@@AAAAAA@@A@A@@@@A@@AAA@@@A@AB@B@BAB@BA@A@@A@@@A@BA@@AA@@@AA@AA@@AA@@BA@A@@@@@A@@@A@@@@@@@AA@A@@@@@@A@@@@A@BA@@@@A@@@AA@@@@@AAA@@@@@A@@@@@@BA@@A@BA@@D@@@CA@@@A@@B@B@D@B@@@@A@@@@BA@@BB@@@@@B@@@@D@@@@@@A@@@A@AB@@AF@B@@@@A@@@AA@AA@@AB@@AB@@@@A@A@@@AA@@@AB@BA@A@@BABC@A@A@@AAA@AAAA@AB@BAB@@A@@AAA@@A@@BA@A@@BAB@D@@@@A@@A@AACBA@AAA@@@@ABA@@B@D@B@DBB@@A@@@A@@AAA@A@AB@@AA@@@A@@BAD@HA@@@BB@@@B@B@BA@A@AAAACAC@@AA@@A@ABA@A@@@@AA@@A@A@AC@A@A@@@AA@@@A@A@@A@A@AAA@AAAABA@A@A@@A@A@@BABABA@@A@@A@@A@ABA@AB@BAF@B@BAB@@AB@DAB@B@AA@@@ABABAB@@AA@C@A@CBA@@@A@@ABA@AB@@A@@@@AAA@AB@@AB@@A@@@@@@ABAB@BAD@DBBBB@BBB@@ABA@AAA@@A@A@A@A@@A@@BAF@@@BAA@@ACA@ACC@@C@E@CBAAA@EGGEAAA@A@KDE@E@C@@C@ABAB@HADABCDCDABA@C@AAAAAA@A@C@A@CDCBA@A@EACACAC@CBCDABGDC@C@CACCCCAAACAAAAC@A@AAAAAAC@A@A@CDABCBABC@C@A@AB@@@B@B@D@B@@CBCAA@AAAAA@A@A@A@AAA@@A@AB@BAD@BA@@BC@A@A@ADC@A@@@AA@A@C@C@CBEBCBCAA@AAAAACACC@EAC@C@EBA@C@CCCACCA@C@A@GBA@A@CAAAA@CACCA@CAA@E@C@C@CAAACAACCAC@C@EAC@E@GAGCGACACAAA@A@ABA@C@@@AA@AAA@CBE@C@A@A@AA@AAA@@BABAB@D@D@D@B@B@BA@A@AAAA@A@G@C@@@CAA@A@CCCECAC@@B@@@DBDBB@BAB@BABC@@@ABCAC@AA@@AAAA@A@ABABADAB@BADA@ABA@A@C@AAAA@AAA@A@CBABCDC@CBAAC@CAC@E@C@AB@BABCBA@CBA@E@EAC@CBCAC@AAAACA@A@A@ABC@A@@@AAAC@C@C@CBABC@CDCBABA@A@A@@ACACACCCAA@A@AB@FCD@@CBA@C@C@A@AACAACCCCAEAEAICCA@@@A@ADCB@DAB@@A@AAAA@A@AAA@AAAAA@A@@B@@@D@BA@ABC@AAAA@ACCEAKECAC@EAA@A@@@CAA@CACACBE@EBA@E@EAK@GCCA@AACCACBC@AA@A@ADEBC@AAAAAEAC@CAAA@CAAAAE@C@ABAB@DDDB@@B@BADA@C@A@AAAC@AAAC@AB@B@DBBDDBBABA@CBC@C@ACC@A@EBC@C@CA@A@ABC@A@AAACCAAC@C@GBCBA@C@A@AAAA@ADCDCBA@AAAA@C@E@A@EBA@C@AA@A@ABABA"],encodeOffsets:[[129488,47741]]}},{type:"Feature",id:"231202",properties:{name:"北林区",cp:[126.985504,46.6375],childNum:1},geometry:{type:"Polygon",coordinates:["@@D@HCBADCDAD@DBDBFBB@B@DADCB@D@B@B@BBBB@B@DABCBCDADCBGBA@AB@B@DD@F@F@LCB@B@BBHFFHB@BBDAF@D@@@DD@BDB@BB@AB@@E@AB@@@BB@B@B@B@@@BB@BAB@BA@AAA@AACAC@ABA@AB@B@@@@B@@@BA@@BAB@BB@@@@@BA@@BAB@BB@@@B@DAB@D@B@@BA@ABAB@B@@BBA@A@CBA@@BA@ABA@E@ABA@@BAB@B@BB@@@@BB@BABA@AB@B@@@@B@BABBBBBB@BBB@B@@@@B@B@@BB@@B@B@D@@B@B@BB@@B@@B@B@BAB@@@BB@@BDBDBB@B@BABA@A@@@A@@A@@GBC@AB@@@B@@BB@@BAB@B@BB@@@B@@@BA@CAA@C@A@@@AB@B@@B@BBB@DABBB@@@@B@@C@A@AB@@@BAB@@@BB@BB@@@BA@ABA@@BBBBBB@BB@@@B@BADAB@@@BABA@@B@@BB@@B@B@@@@@BA@@BA@@BBB@@B@@@B@@A@E@@BA@@B@B@@@B@@@@C@@@@@@A@@@@AA@@AB@@@@@B@@A@C@A@A@@@@B@@DB@@C@@@ABB@@@AB@@@@@@@B@@@@BB@B@@@@BB@@B@@@@@ABB@@@@@@B@@@@B@B@@B@@@@@@@B@@@B@@@@@BAB@@BB@@BBB@@B@@BB@@ABB@@@B@@@@BABA@ABA@A@@B@B@@BB@BB@@@@@@B@BB@BBBB@B@@A@@@AB@@A@@@A@AB@@A@A@@A@B@@@@@BB@B@@@@B@@@@@BA@A@@B@@@@B@AB@@AA@@@BA@A@@@@@@@B@@B@@@@A@@@BBA@@@BBA@@@@@BB@@ABB@B@@@@@@@@B@@AB@@@@B@@@@BA@@B@@@@B@@AB@@@@B@B@BB@@@@@@BA@CA@@@@AB@@@@B@@@B@@BB@@@@@C@@@A@@@CDAB@@@@@@@@@B@@@@@BB@@B@@@@B@@BAB@@DBB@@B@@@@@BB@@@@BB@@B@@@@@@@@B@HB@@B@DB@@@@BDBFDD@@@@@@BB@@BB@@A@AB@B@@@BB@@@BB@@A@@@A@@@@@A@@@A@A@@@BB@@@B@@@@@@A@@A@@A@AB@@@@@B@@@BB@B@@BD@@@@@A@@AB@B@D@@BB@B@@BA@@@A@@@A@@@A@B@@@@@@A@@A@@D@@A@@@@@BB@@F@@@@@@@@B@@B@@@AB@@@@BB@@BA@@@BB@@@BA@@B@@@AB@@@@B@@@B@@@B@@@@BB@@B@@A@A@@B@@B@B@B@@@@B@@B@@@B@@B@@BB@@@@B@@A@@D@@B@BB@@BB@@@@AAA@AB@@@@BB@@B@@@@@@B@@A@@@@B@@A@@@A@A@@BBD@@@@@@B@@@BB@D@@B@@A@@@A@@@@@B@BBB@@@@@@@@B@@@BB@@A@@@A@@@@B@B@@BAB@@@@@@B@@A@@B@@B@@@@AB@@@@@@D@@@@BC@@BAB@@@@D@@@@@@@AB@B@@BB@@@B@@A@@@@B@BB@B@@@@@@B@@@@BB@@@BA@AB@@BB@@ABAB@@@@BB@@@B@@A@AB@@@@@@B@B@@@@BA@@@@@B@@B@@B@@@B@@@@@BD@@@@B@@@B@@@ABA@@B@@B@B@@@@@BB@@B@@@@AA@@AB@@@@B@@D@B@@@CB@@@@BB@@@@AB@@AAC@A@@B@@@@@B@@BBDB@@@@@B@@@B@@@@BA@@B@@B@B@@BA@@B@@@@@@BA@@@@B@@@@@@B@@@@@@@@BB@@@@B@@@@A@@@@BAB@B@@B@@@BA@@B@@@@B@@A@A@@@@B@@@BA@@@DBBBBA@BB@@B@@@@B@@@@B@@A@@BB@AB@@B@@@BB@@A@@@A@@A@@A@@B@BC@@BA@@BAB@@@@@@B@@@B@@@@@@@ABA@@@@@DB@@@B@@@BB@BAB@BB@B@@@@A@A@A@@@@B@@@BB@DB@@@B@@A@ABA@@@@BB@@@B@@@BB@@@@@@ABAACAA@@@@B@@DD@@@@A@C@@@@BA@@BBHA@@@@BA@BB@@ABA@@@@@@@BBB@BB@@@@@@C@@@@B@B@@@@A@@@A@A@@B@@@@B@B@B@@@@BB@@@D@@@@B@@BBBABB@@@@AB@@BBB@@B@@@BA@@@C@@B@@B@@@@D@@B@@BB@@@@@@B@@A@@B@@@@B@AB@D@@@@@B@@@@AB@@A@@@BBB@@BB@DAB@B@@@B@@D@@AB@@@@D@B@@B@@ABABA@@@@BF@B@@@@B@@A@@@@@A@@@A@A@@@@B@B@BA@@B@@@@@@AA@@@@AB@@@BB@@BA@A@@@AB@@@@@BDBBBB@B@@@@@@@AB@@B@@B@@@BA@@B@@@@D@@@@@@BB@D@@@@BA@@@B@BBB@@@@@AB@BC@@@@@@BB@@B@@@@A@@@A@A@CB@@@@@B@@@B@B@@A@AB@@@@BB@B@@BB@@AB@@BB@@DAB@DB@@@@@@A@A@@B@@B@@@@B@@@BB@@@B@A@@BA@@@@@@B@@BAB@@B@@A@@@AB@B@B@@B@D@@@@B@@@@B@B@B@B@B@@@@@B@@BA@@@@@@B@B@@A@@@A@AB@@A@@@BBB@@@@@@DB@@B@@D@@@@@@B@@@BA@@@A@@BBB@@@@B@BA@@@@@BB@BB@@A@@BC@@@@B@B@@@@@BB@BA@@@AB@@B@@@@@BB@B@@B@@@@C@@@@B@@B@B@@@@@@B@@@@B@B@@@@@@B@BABABC@@B@@BB@@A@A@@@@@@B@B@@@@A@CDA@A@@@@B@@@@@B@@A@AB@@A@A@A@@@@B@B@BC@@@@B@@@@DB@@@BA@AD@@@@@BBB@@BB@@AB@@A@@@@DB@B@@BA@A@@@BBB@B@BB@@@@B@@AB@@BA@@B@@B@@BB@@@BD@@@BB@@@@B@@ABB@@@AB@B@@@@@@D@B@@@@@AB@@@@DB@@BB@@@@BA@@B@@@@@@BBAB@@BDB@B@@@@C@@@@@@BB@@B@@B@@BBB@@B@@@@@A@@BA@B@@@@BBA@@B@BB@@B@DAD@F@@@@@BBB@@@@@BB@@@@@@@A@@@@@@@@@@B@@@@@@@B@@@@@BB@@B@@@B@@@@@D@B@@@BB@@B@B@B@B@@@B@@B@@@BB@BBD@F@F@@@@EBC@@@@B@@@B@@@@@BB@@@@B@@@@@@@B@@@@@@@B@@@@@B@BBBB@@@@@@BBD@@FB@J@H@HAH@H@D@BBFAB@FBB@DA@@D@B@@B@BB@DABAF@FBDDD@F@B@DBB@@ABA@CBGBABG@@B@F@N@NBN@N@NBN@@ABA@@@C@A@@BC@A@@@ABA@A@@@C@AB@@A@A@@@C@@BA@@@A@A@@@@@A@@@@@A@@BA@@BAD@N@L@L@J@H@B@B@@@B@JAL@RAL@HAD@B@DAFCA@C@A@@@AA@A@A@CAAAAAACA@@@@@@BAD@B@BA@@BAAAAA@AAA@@BAB@@AB@DB@@BA@@B@AA@A@@@A@A@ABA@ABAB@@A@@A@CAABAA@@AA@@AAAA@A@@@A@@BAD@@@B@@ABA@@B@@@B@@@BBA@@@@@AB@@@B@@@BB@B@BA@@@A@AAA@A@AB@@AD@@A@@@A@@A@A@C@@@A@AC@AA@@@C@@BABA@@@A@@@@A@ABAB@@A@C@A@AA@AAA@@AA@@ABA@@@AAA@A@AA@@AA@A@A@@BB@@BABA@A@@A@@@A@A@AA@@AA@A@@@A@AB@@A@A@AAA@@A@@@ABA@AB@@@BAB@D@BBB@@@B@@@B@BA@@BABAB@B@@@B@B@BAB@@@@@B@@@B@B@D@D@D@FAF@H@B@BCB@D@H@B@@@DBF@BADABCF@J@JAH@DADAD@DA@@B@@@LEF@B@BA@@@AB@@@B@@AD@BAB@B@D@D@D@B@B@HDB@B@B@@@@@B@F@D@B@@@B@B@D@JBHB@@@B@@@BB@FBDBD@@B@@CH@@HD@B@B@BBBD@B@FDCD@B@@JDB@HCD@F@DAD@H@@@B@@@B@@A@@@A@A@@A@@@@@BA@@AA@@@@@@@A@@BA@@B@@@@AAA@@@@DA@AB@B@A@@@AA@@B@B@@@@AA@@@@@B@@AA@@@@@A@A@@@AAA@@@@@@A@@@@@@BA@@A@A@@@BA@@@@A@@AA@A@AA@@@AAB@A@@@A@@@@@A@A@@@@@AA@@AA@@AA@@@@@A@A@@@A@@@@A@@@A@@@@A@A@AAA@A@@@C@A@@AAB@@A@A@@ABAA@@@@AEAA@@@@A@@@A@@@@@AAA@A@A@A@@BA@A@@BAAA@@AA@A@@AC@@AA@@@@@A@@@AA@@@B@@@@AB@@A@A@AA@BAA@@A@@@@AA@@@@AA@@B@@@A@@@BA@@@AAB@A@@@@AA@@@@B@@A@@A@B@@AA@BA@@@@@@@A@@A@@A@@@@@@@@@AA@A@@@@@AB@@@A@@@@@A@@B@@AA@@@A@@@A@@ABA@@A@@@@A@@A@@@A@B@@A@@BA@@AAA@A@@@@@@A@@@AA@@A@@A@A@@@@A@A@@@AC@@@@A@@@@B@@B@@BA@@@@@@@A@@AA@@@@@@A@@AB@@@@A@@@A@@B@@@@@@@A@@A@@B@@@@A@@@@B@@@@AA@@@BAAA@@@@AA@@@@@@@ABB@@@@@A@@@AAA@@@AC@@@CA@@AA@@A@@AA@@@@@@BA@@@A@@@@@@A@@C@@A@@@A@@B@B@@@@@@@A@@AA@@BA@@@A@@@AA@@@@AB@@@@@AA@@@@A@@@A@@@@A@@@@@B@B@@@@@@A@@@@@AA@@@AA@@@B@@AA@@A@A@@@@@@@@A@@@@@A@@@A@@BA@@@@BB@@@@@@@A@@@@B@@@B@@@@@B@A@@AB@BB@@@@B@AAB@@@@@@@A@@@BAA@@C@@@@BA@@A@@@@@@A@@A@BA@@AAB@@@B@AA@@@A@@A@BAAAA@@@BA@@@@AA@@@@@A@@@A@@@@BB@A@@@@AA@BA@@@@AA@AA@BA@@@@A@@@A@@CA@@AABA@@A@A@@@AA@AA@A@@A@@@@D@@@AA@@@@@ADA@A@@@@@BA@@@@AAAB@@A@@BA@@A@@AA@@@@@@A@@@AEA@@@@B@@@@A@AB@@@@@@A@@@@@A@@@@@@@@A@@@@A@@B@@@@@@@@AA@@@@@@@@@@@@AB@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@B@@B@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@B@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@A@@@@A@@@@@@@@@@B@@@@@BB@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@A@@@@@@@B@@@@@@@B@@@@@B@@@@@@@A@@BB@@@@@@@@@@@@@BA@@@@@@@A@@@@@@@@B@@@@@@@@@@@@AB@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@A@@A@@@@@@B@@@@@@@@@@@@@@A@@@A@@@@@@A@@@@@@B@@@@@B@@@@@@@@@A@@@@@@@AA@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@AA@@B@@A@@@@@A@@AA@@AA@@@@A@@@AAA@@@A@@A@@A@@AAAA@@@@AAA@@@AB@@A@A@AAAA@A@A@A@AA@AAE@EBCA@@AA@A@C@CAAA@AAA@CBE@C@CAA@AA@AAA@A@CA@@AC@GCEACAEAE@A@EBCBCBC@C@AAA@AAA@@AAA@@@@@@@@@A@@A@@@@@A@@AB@@A@@@A@A@@B@DABAB@@ABADABA@@B@@AB@@AB@@@@@@@@@@@@@B@B@@@B@@@@@@@A@@A@@@@@@@@@@B@@@@@@@@@@@@@@AA@@@@@@@@@@@@@B@@@@@B@@@@@@@@@@@B@@A@@@@@A@@B@B@@A@@B@@A@@@@@AAA@@@A@@@@@@B@@@@A@@@@@A@@BA@ADADALCDAJC@AD@@AB@@@@ABC@@BCBA@ABA@A@@B@BGBIBAAABC@AA@AAAAA@@CB@@@@@@@BA@@@@BA@@BA@@@A@@BA@@@@@A@A@@@AB@@A@@@A@@@@B@@A@AB@@A@@BA@@BAB@@A@@B@BAB@B@BAA@@@@A@AA@BA@ABAB@B@@@B@BCDCB@@@@A@@@@@@@AB@@@@ADA@@BCB@BA@@@@@@BA@@@@B@B@@@B@@ABA@@BA@@@AB@B@@@B@@A@@@@B@@@B@@A@@B@@@@A@@@A@@@@BA@@@@B@@@@A@@B@@@@A@@B@@@B@@@BAB@BABA@@@AC@C@EAC@AAA@A@AACA@@A@A@C@A@A@@ABA@CB@@A@@@@@AFABABA@A@@CAAACAAA@AAA@C@CA@@A@@A@@@@@A@@@A@@@A@@@BA@@@@@AB@@@@@B@@@B@@A@@AA@AA@ICA@@@DABCHC@@D@F@D@B@BA@@B@BB@BDB@BBB@DBB@DD@B@B@B@B@DCB@@A@ADC@@@A@ABC@@BA@EB@@AD@BA@@@@@@B@@A@@@AA@A@A@@AC@A@AACAB@@A@@BA@A@@@AD@F@AC@E@CLABFD@@@@BBB@BBB@BB@@@B@@@AA@@@CAC@AB@@C@CB@@A@@CABABC@ABADCB@CACDCBAB@@AA@AAA@AAA@AE@C@@@BC@AA@@@@C@A@@@C@AAABA@E@A@AAA@@@@@@@@A@@@@@@B@@@@@@A@@@@@@@@@@A@@@BA@@@@@@@A@@@@@@AA@@@A@@@@@@@A@@@@@@@A@@@@@@A@@@@A@@@@@@@@@@@@A@@A@@@A@@A@@AB@@@@@@A@@A@@@@@@@A@@@@@@@@AA@@@@A@@AB@@@@@@@@@@@AA@@@@@@@@@AA@@@@@@@@@@@A@@@@@@@@A@@@@@@@@A@@@@@@@@@A@@@@AA@@@@@A@@@@@@@AA@@A@@@AA@@@@@@@@@AA@@@@B@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@A@@@@@@AA@@@@@@A@@@@@@@@@@@A@@@@@A@@B@@@@@@A@@@@@@@A@@@@@@@A@@@B@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@A@@B@A@@@@@@@A@@@@A@@@@@@@@@@B@@@@@@A@@@@@@@@A@@@@B@@AA@@@@@@@@@A@@@@@@A@@@@@@B@@@@@@@@A@@@@@@@AA@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@A@@@@@@A@@A@@@@@A@@@@@@@@@@@@@A@@@BA@@@@B@@@@@@A@@@@@@@@@AA@@@@@A@@@@@@@A@@@@@@@@AA@@A@@@@@@@@@@BA@@@@@@@@@@@@A@@@@@@@AA@@@@@@A@@@@@@@@@A@@@@B@@@@@@@@@@A@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@A@@@A@@@@B@@@@@@@B@@@@@@@@@@B@@@@@AB@@AA@@@@@@@@A@@BB@A@@@@@@@@@A@@@@@A@@@@B@@@@@B@@@@A@@@@@@@@@@@A@@A@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@AA@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@A@@@@@@AB@@@@@AA@@@@@@@@@@AA@@B@@@@A@A@@@@@A@A@@@@A@@@@A@A@@B@@@B@@A@A@@B@@A@@A@@A@@BAAABA@@@@@@@@@@BA@@@@A@@@@@A@@A@@@A@@@@@@@@@AB@@@@A@@@@AAA@@@@@@A@@B@BA@C@C@@@@A@@@AABAA@@@@@AA@@@A@@@AA@@@@@@B@BA@@A@@@A@@@A@@@AB@@@A@@@@A@A@B@@@AB@@@@A@@@@A@@A@@A@@C@@@A@@@A@@@@@@B@@A@@@A@@@@B@@CA@@AB@@AA@@@@A@A@@B@@@@AA@@A@AB@@CA@@@@@@A@@A@@@AA@@@@B@@@@@B@@A@@@@@ABA@@AA@@A@@@A@@@@ABA@@AB@A@@@@@@BA@@@@@@A@A@@@@@AA@@@@A@@A@@B@@@@AAA@@BA@@@@@A@@@@AA@@@@@@@A@@@@@@AA@@A@@AB@@@A@@@@@A@@@A@@@@@@@@A@@A@@@A@@A@@@@A@AA@@@@@@@A@@@@B@@@@A@@@@@A@@AA@A@@@@@A@A@@@@@@AAB@@@AA@@@A@@@@A@@@A@@@@A@@@A@@@AA@@@B@@A@@@@A@@BA@@@@B@@B@@@CB@@@B@@AAA@@A@AB@@@@@A@@BAA@@@@@@@@@@@@A@@@AAA@@@A@A@@AAA@A@@BA@@BA@@@@@@BA@@@@A@AA@@@A@@@@@A@@A@@@A@@A@A@A@@AAA@@A@C@AA@@@@@@BAB@@@AA@@@@AAA@@A@@B@@AA@@@@@A@A@@@A@@@@BC@AA@@A@@@A@A@@@@BB@@@@@@@A@A@@@@A@@A@@@AA@@A@@@A@@@A@@B@@BBA@@@A@AAA@A@@@A@@@@@@@@@@AA@@B@@AA@@@@@@A@@@@A@@@@@@@@A@@@@@@@@A@@@@A@@@@@@@@@BA@@A@@@@@@@@@A@@@B@@@A@@A@@A@A@@@@B@@@@A@@@@@@@@A@@@@@@BA@@A@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@BA@@@@@@A@@@BA@@@@B@@@B@@@@A@@A@@@A@@@@@@@@@@@B@@A@@B@@@@@@@@@@A@@B@@@@@@A@@@@A@@@@@BA@@A@@@@@@@A@@@@@@@A@@@A@@@@@A@@@@@@@A@A@A@@@@BA@A@@@A@@@@@@@A@@@@@A@@@@@@A@@@A@@@@@@@@@@@@A@@@@@A@@B@@@@A@@@@@CA@@A@@@@A@@@@@@@@@@B@@@@AB@@@@@@A@@@@@@@@B@@@B@@@@@@@@A@@@@A@@@BA@@@@B@@@@@A@AAA@A@A@@@@A@@@@A@@@@AA@@@AB@@@@@@@@@@@A@@@@@@@AA@@@@@A@A@@@@B@@@@@@@@@@@AA@@@@@A@@@@@A@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@A@@@@@@@AB@@@@@B@@AB@@A@ABA@@@A@@@@@A@@@A@A@@AAAA@@AA@@@AB@@A@AA@@AA@A@@A@@@A@@@@@A@@@AAAA@@A@@@AA@@B@@@@@@@B@D@@AB@@@@@@AA@@@A@A@@@A@A@@@@A@@@@@A@@@@@@@@@A@@A@@@A@A@@@A@ABA@@@AB@@A@A@C@A@@@@@@@AA@@@A@AA@@@@AA@@@@@AB@@BB@@@@AB@@@@A@A@@@A@@@@@@A@@@@@@@A@@@@B@@A@@@@@@@AA@@B@@A@A@@@@@A@@@@@A@@@A@A@@@@@@A@@@@@@A@@@@@@A@@@@AA@@@AB@@@@@@@B@@@@@@@B@@@@@@AA@@@@@AA@@@@@@@A@@@@B@@@BAB@@@BAA@@@AA@@@@BA@A@@A@A@@@@B@@A@@@AA@@A@@@A@A@@@A@@@A@@A@@@A@@A@@@@@@@@@AB@@@@@@@@@@@@AAA@@@A@A@@@@A@@@@@A@@@A@@@@@A@A@@@@@@BA@A@@AA@AB@@@@@BA@@@@@AA@@@@@A@@@@@AAA@@@A@@@@A@@@@@@@AA@@@AA@AA@@A@@A@@@A@@@A@AA@@A@A@@@A@@@AA@@@@@@AAA@@@AAA@A@@@A@@@@@A@@@A@@@A@@@@@@AA@A@@@A@@@A@@@@@@@A@@@@@@A@@@@@@A@@A@@A@@@@BC@@@A@AAA@@A@@@@@@A@CAAA@@A@@A@@@A@@A@@@A@@@@AB@@@@AA@@@@@A@@@@A@@A@@@@@@@@@@@@AA@@@@@@@@@@A@@@@@@@@@A@@@@@@A@@@@AA@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@A@@@@@@A@@@A@@@@@@@A@@@@@@@@@@B@@@@A@@@@@@@@@@@@@A@@@@B@@@A@@@@A@A@A@A@@@@@A@@@@@@@AB@A@@@@@@@@AB@@A@@@@@A@@@@A@@A@@@A@@@A@@@A@@@AAA@A@@@AA@@@@@@@@@@@@B@A@@A@@@@AB@@@@@@A@@@@@@A@@@AA@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@AB@@@AA@@@A@@A@@A@@@@@@@@A@@@@@@@A@@A@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@B@@@@@@A@@A@@@@B@@A@@@@@@@@@@@A@@A@@@@@@@@AB@@@@@AA@@@@@@@A@@BA@@@@@@@@@AA@@@@A@@@AA@@@@@@@@@@@BA@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@A@@@@ABA@@@@@@@@@A@@@@@@@@@@@BB@@@@A@@@@@A@@@@@A@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@B@@@B@@@@@@A@@@@@@@@@A@AA@@@@@@@@@@@@@AB@@@@@AA@@@@@@A@@@@@@@@A@@@@@@A@@@@@@@A@@AA@@@A@A@@@@A@@@@@@@@@A@@@@@A@@@@@@B@@@@A@@@@A@@@@@AAA@AA@@@@@@A@@A@@@@@@@@A@@AA@@@CA@@@@A@@@@B@@B@@@@@@B@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@A@@@@@A@@@AA@@@@AAA@@@A@@@AB@@@@@@@@@@AAA@@@A@@@A@@@A@@@@@@@AA@@@@@@@@A@@AA@@@@A@@@@A@@@@A@@A@A@A@@@A@AA@@AA@@@AA@@@@@@A@@@@@@A@@@@@@@@A@@A@@@@BA@@@@@@@@@@@@@AB@@AB@@@@A@@@@@A@@@@@AA@@AA@@A@@AA@AAA@AAAAA@@@A@CAAAA@A@A@@A@@DC@@@A@@@@@AB@B@@A@ABC@@@@ACAAAA@@@@A@BB@@A@@@A@@@@@@AA@@@A@A@@@A@AA@@C@A@@AA@@@BA@AA@@@A@A@@@@@@@@A@@ABA@A@@@@AE@@DABA@A@@@AA@@B@@@@AA@@@@A@@A@@@@@@A@@@AB@@@B@@@AAA@@@A@@@B@@AB@@A@@@@AA@@@@B@@A@@BB@@@@@AA@@A@@@A@A@@AB@@A@A@A@@AAA@@@@A@@BA@@@A@@@BA@@@A@AA@@@A@@@@A@@ABA@@@@@@A@@BA@AA@AA@@@A@@BA@@A@@A@@AA@AAA@A@@@@B@B@@@@AA@A@C@AB@@A@@@@ABC@@@@B@@@B@@A@@@A@@@AB@@@B@B@@@AA@@@A@@B@@@@A@@AACA@AB@@@@@AA@@@@BA@@@@@@E@@A@@@@@@B@@@@A@@@@@@@@@@@@@ACBA@@BA@@@@@@A@A@@@A@@@@AB@BA@@@@@A@@@@B@@A@AB@@@@AA@@B@@@B@@@@@@AA@CBA@BB@@@@A@@@AAB@@ABAB@@@A@@A@@B@B@@@A@@@@A@@B@B@@@@@A@@ABAA@@@AAC@@@@@ABB@@@A@@@@@@A@@@@BA@@A@A@@B@@@B@@A@@@@A@@@@BABA@@@@@@@A@A@@A@@@@BAB@@A@@B@@E@A@A@@@@C@@@@A@A@@B@@@@@B@@B@@B@D@@B@@B@B@@@BAAC@A@@AB@AAA@BAAA@@@@AB@@@BA@@@@@@AB@@AA@@@BAB@@@@@@@A@A@A@@@@AA@@@@BA@@@@@AA@@@@B@@@@A@@AA@@@@@@D@@A@@AA@@@@AB@@A@@A@@DA@@@@A@@ACB@A@@@A@@A@@@AB@@@@@AB@BA@A@@@@@@A@@@A@@BA@@A@@@@@@AB@@@@@AA@@@@AB@@@@@@A@@A@ABAB@@BBB@BB@@A@A@@BAA@@A@@@@B@@A@@@@A@@A@@@@B@@BBBB@@AB@@@@@@B@B@@@B@@@A@@BA@A@@@@B@@A@@@@C@@@@A@@@@BA@@@@A@@A@@@@B@B@@@@AA@@@AB@B@@@@AA@@@A@ABA@@@@@@@@@BA@@@@A@@@@@BCBA@@@@C@@@@@BABA@@A@@A@BA@@@A@@@A@@@BB@@@@A@@@A@@@@@B@@@@@@B@@C@A@@@@@A@@@@@@B@@B@@@BB@@A@A@A@AB@A@AA@@@@@ADA@@@@@AA@@@A@@A@@@@@@ABBBA@@@@@@@@AAB@@A@@@A@@@@A@@@@@@@B@B@@AA@@@@A@@BB@@B@@AA@A@@@@@@AB@BBB@@@BA@@@@@@@@AAA@@@A@@@@@A@@@@@@B@@@@@@A@@@@@@@@@@@@A@@@@BA@@B@@@B@@@@@@@@@@@@@A@@A@@A@@@@@A@@@A@@B@@@@@@@B@@@@@@@@@@@@A@@@@A@@@@@AB@@AB@@@@@@@B@A@@@@@@@AA@@AB@@@@A@@@@B@@@@@@@B@@A@@AA@@@@@@@@A@@@@@@B@B@@@@A@@@@@@@@@A@@ABA@A@@@@B@@@@A@@@@@@A@@@@A@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@AB@@@B@@@B@@A@@@@A@@@AA@@@@B@@@@@@@B@@@@BB@@@@@@A@@@@@@@@A@AA@@C@@@@@@@B@@A@@@@@@@A@@A@@@@@@@@@A@@BA@@@@A@@AB@@@@@@@BA@@@@@A@AB@@@@A@@BA@@@@A@@A@@@@@@@AB@@@@@@@@BB@@@@@@A@@@A@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@ABA@A@@B@@@BA@A@C@@AA@@@ABA@C@A@A@@A@@@A@@A@A@@A@@@A@C@@ABA@A@@@@@@@BAB@@@@AA@C@A@@@A@@@@A@AA@AA@A@@@A@A@@A@@@@B@@A@AC@@AA@@@@@A@@@@CBA@AA@@@A@@@@A@@AA@@@@A@@@@@A@@CA@@ABA@@BA@@@@A@@B@@AB@@AA@@@@A@@BB@@B@@@B@@A@@AAA@@BA@@@BABAB@@@A@@@A@AAABA@@@A@@@A@@@@@@AB@AA@@@@@@@@D@@@@@@A@@@@A@CBA@AAA@@@A@BAB@@@@@@AA@A@@@AAA@A@@@@@@AA@@@A@AA@@A@@@AB@BA@@@@@AC@@A@A@AAAAA@@AA@@@A@@@@A@@AA@A@@@@@@AB@@A@AA@@@A@@BC@@A@A@C@@@A@AA@@A@AB@@AA@@@A@A@@DAB@@A@@A@@@@@@@B@@A@@A@@AA@@B@@@A@@BA@@@@A@@@A@@@@@BA@@B@A@@@A@@@BA@@B@@@BB@@@@@@@A@@@AA@@@A@@BA@A@@AB@DA@@BB@@D@@A@A@@B@@@@AA@@BA@@@@@@AA@@@@@@@@B@@@@A@@@AA@@@AB@B@@A@@A@@@B@@A@@A@@@AB@@A@@@BA@@B@@A@@@@A@@@C@@BAA@@B@BA@@A@@@A@@@AB@@@@@@@C@@A@AD@@B@@@@@B@AB@@A@@@@@@B@@@@@@A@@A@@@@A@@@B@@B@@EA@@BA@@@@A@@@AB@@@A@@AA@@@@B@@A@@C@@@A@@@@A@@DBB@@@@AA@C@@@@AB@@@B@A@@@CBA@A@A@ACC@@B@@A@@@BA@@@A@AA@@@@A@C@A@@A@@@A@@BA@A@A@@@@@BA@A@ADAA@C@A@@AA@@A@@@A@@A@@@@B@@A@@@@AB@@@@AAB@AB@@@B@@@B@@@@@@A@@B@@@@@@AA@@@@@@@B@@AA@@@AB@B@@@@A@@A@A@@A@@@@A@@@@A@@AB@@@A@A@@@AB@@@@BB@@A@@@A@AAA@@@@@@ADAA@@@@@@A@@@@B@@@@@@@@@@@A@@@@AA@@@@@B@@@@@@@A@@@A@@@@A@@@@A@@A@@@@@A@A@A@ABAA@@@GBEAEA@@A@E@A@CAA@C@AAI@GACB@@AD@F@DAF@D@@@D@B@@@B@B@@@B@B@@@D@@@B@@@@BB@@@@AB@B@B@B@D@B@B@B@B@B@B@B@B@B@B@B@@AB@@CAA@@@A@CAA@A@AAA@@@A@@@A@ABCBA@@@A@A@A@AB@@AD@BAB@BAB@D@B@@@BA@@@@BAB@B@BAD@D@@AB@B@@@BAD@B@@@BAB@@@@@B@@@BAD@@A@AA@@A@@@A@A@@@A@@AA@@DAB@B@BAB@@AB@B@BA@@DAB@B@@@@@BA@@B@@@BA@@B@@@@A@@@A@@BAA@BA@@@@@AB@B@B@DAB@B@@@B@@@@@@B@@BB@D@B@B@B@@@@@@B@@ABABABAB@@ADA@@@@B@BA@@BA@@DCB@BABA@@BABCBCBADCDCDEDCFCDAB@@A@@B@@CBABA@@@A@ABCBA@CBAB@BA@@BA@@BAB@@AB@@AB@@A@ABA@A@A@@AA@@BAD@@AD@BB@LBADAD@@@@@@A@@B@@A@@B@@AB@@@BAB@@ADAB@@ABAB@B@@@BA@@B@B@@@BAB@@@B@@AB@@@@@BAB@@ABA@ABA@A@A@A@@@ABA@@@DFBB@B@B@@@B@@@@@@@BAB@BAB@@AB@@AB@BAB@@AB@@AB@BA@@@@@AB@B@@A@@B@@@B@@ABA@@BA@@@@AA@@@A@@BA@AB@B@@A@@BAB@@AB@@@@AB@@@@@B@@AB@@@BA@@B@BA@@@@BA@@BABABAB@BAB@@@B@@@@@@@@@B@@@@@@A@@@@@@B@@@BBBBB@BA@@@A@CB@@CBC@CB@@ABA@C@GD@@A@@@EB@@IDEB@@CBE@CBGDC@CBA@@@AB@@C@@@AAA@AB@B@@F@FBB@BBBB@BABCDCB@B@BDBBBD@F@D@DBBB@DABCD@BAB@BBBFBHBD@F@D@@@DDDDBBBDBDAB@D@BCDCFCDAB@BBDBFBDBBFBD@B@BB@B@@@DABGBEBABAB@B@BBBD@B@FAB@F@D@B@BB@BABCDCD@BBBBBB@D@B@DAHAD@D@BBDDBB@B@BAD@B@BDBD@D@FAB@D@BDD@D@DAB@BAAACCAA@C@ABAD@BB@BBDBBB@D@B@BC@A@AA@CC@CBABAD@F@BBBB@DBBDBD@FBBBBB@BADCF@B@BBBD@DADBBD@BDBHDL@FBF@B@FAF@DADBDBB@DB@@B@B@FBD@DBLFFBDD@BBBBBD@BAB@@A@C@@@AB@B@BBBBB@BBB@B@BB@B@BA@CBA@CD@B@B@@DBJDFBFBDBDDBDDBBBB@D@D@B@DA@@DC@EBAB@B@DBDDDBDB@BB@B@B@BADADCD@BADAD@D@D@BB@B@@@BAD@B@B@BDBBBBBD@DBDAD@FBF@B@DAB@DABA@ABAD@F@D@DBD@BBDAD@DCBADAB@B@BBB@BB@B@D@BAB@BCBABA@CBABAB@B@BBBBB@@BBD@DBBA@@D@BA@ABA@AAAAC@C@@@AD@DBDFDDB@B@DB@@D@H@B@B@BB@B@BABA@A@C@C@C@A@ABAB@@BB@BBBB@B@D@F@DAB@BBB@@B@@@DAB@B@BBBDBDBHBHDHBF@D@FBD@D@DBBDDBBBDBD@D@F@B@DBB@DDDBB@BBDBB@B@HAB@D@B@DDDBDDD@B@FAD@D@FBD@BDBDBBBBB@DBDAFADAD@D@B@B@@B@@@BCD@B@B@BAD@@ABC@ABA@@B@BB@BBB@B@B@B@BBBBB@DBDA@@@A@C@A@A@@BAB@D@D@BADABADCB@B@D@BBBBBBB@D@BBBBBDBBDDDDDBD@"],encodeOffsets:[[129909,47878]]}},{type:"Feature",id:"231222",properties:{name:"兰西县",cp:[126.288117,46.25245],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@AB@@@@AB@@A@@@A@@@@BA@A@AAA@A@AAAAAAAABCBCFBH@D@BABC@CBA@@@A@@C@CAA@@ABAC@@@C@A@E@A@C@C@@AA@@@A@@@@@@A@C@C@E@ECAE@@B@D@B@B@B@@A@A@AAA@@@A@A@CBEFEDCB@BA@A@ABCDCBEDGFECEAEAEAOG@BA@@@AB@BABAB@@AB@BA@AD@BA@ABAB@BAB@BA@AB@BABAB@BA@@BA@@DA@@@@@@BABABABAB@@@B@@A@@BABAB@@@@ABABABAB@@ABADA@@B@@@@@@@B@@@@ABADA@@@@@@B@@ABABABABABAB@BCDAB@@AB@BABA@@BAB@@AB@BA@AB@BA@@BA@@BA@@@@B@@A@ABABADABA@ABCD@B@@A@AB@BCBABADABABAB@@AB@@@@@@A@@B@@@@ABA@@BABA@AB@@@BABABA@@BA@ABABA@ABABAB@BA@@@@B@@ABA@AD@@@BABA@AB@B@@AB@@@@@@@@@BABADAB@@@@@@AB@B@B@DAB@@AF@B@BABCFABADEJABADAB@@CDCF@@EFADAB@@@@CFCD@BCFCBADADABABADA@@@CDEHABCFEH@BA@ADABCFAD@B@@A@@@AB@@@BAB@@ABAB@DA@@@ABCF@BCDADAB@DA@AD@BAB@BCBAD@BABGLAFEH@B@BIP@@CH@BEHITCF@DGNCF@@AB@@KH@@ABKF@@A@IFEDEBA@OHMFABEDC@ABUJQJOHEBIF@@GBEDA@ABGDKFEBEDEBEDCBEBABA@CBEDCBCBA@CBKFKDQH@@E@@@OFA@@AA@CHGBCBEBUJQFABCA@@A@@@@@A@AAA@A@A@AA@@A@@@GA@B@B@@AB@@@BAD@@@B@@@BA@@@ABAB@@AB@BA@ABABCDABA@@B@@@@A@@@ABADCBABAB@B@@AB@BABAB@@AB@@@@@@A@@BB@@@@@@@@@B@@@@@@@@@@@@B@@AB@B@@@BB@@@B@@@@B@@B@D@B@B@DBB@D@@BB@D@B@@@@B@@@B@B@@A@@B@B@B@@@B@DAB@B@D@@B@AD@B@BAB@@@@DBABABA@@BABABABA@ABCAA@AAAB@BABAB@BABAB@BABAB@@DBBB@@AB@BAB@BA@B@@@BB@@B@A@@B@BAB@B@@@B@B@B@@@BAB@@@B@BBBB@@BA@@B@B@@@B@B@B@@@B@B@@@BA@@@A@@B@B@@@B@B@@@B@B@B@B@@@@BB@@@B@@@D@@@B@B@@@BAB@B@DAD@@@@@B@@@B@@@B@B@B@@AB@B@B@@@BAB@@AB@BAB@@@BAB@BABAB@BABAB@BAB@BAB@@AB@@@@@B@@A@AB@BA@AB@@@@A@A@@@A@@@B@BBB@B@@@@B@@@BB@@@@BB@B@@@BBB@@@B@BB@@B@B@@@BB@@@@@@AB@@ADA@@@@@B@BBB@@@DBD@BB@@B@B@@BB@@@B@@@DB@@B@BB@@B@BBB@@@BBB@@@@@B@@@B@@BB@@@B@B@@@B@BB@@B@@@B@@@B@@BB@@@@@B@B@@@@B@@B@@@B@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@DBB@@@B@@@@B@@B@B@B@@BF@@@B@BBB@@@B@B@@@BBB@@@B@@@B@B@B@BBD@BBDB@@B@B@BBB@@@B@@@BBB@B@@@B@@@B@@@BBB@@@DBB@BBB@@@ABB@B@FBDB@@BABA@A@@@@B@B@@BB@B@@@B@B@@BB@D@FBDBB@B@@BB@@@B@@BB@B@BBB@B@@BB@@@BBB@@@B@@@@@B@@@B@B@B@B@DBD@@@B@B@B@B@B@@@B@D@@@D@B@B@BAB@@@D@B@B@DBB@B@BBB@@@F@FBB@DBD@B@B@B@BBF@BBD@BBB@B@BBB@DBBBB@@@@@BB@B@@B@DBB@DDDBBDDBB@DBDD@@DBFD@AD@BABADABABAD@DCDAFCDADCB@DD@@DBBBB@DDBBDBDDDB@@@@DDDB@@@@@@DAF@BA@@@@DK@@AACAACCACCAACCCAAAAAFCDAJEB@DCHGB@HIFE@A@@@AB@BA@@B@BE@A@@B@@AB@@A@ABGBA@A@CB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BAB@BADAB@@AD@BAB@BAB@DA@@BABAFABADABADABAD@@AB@@@B@BAB@BABAB@DABAB@BA@@@@B@@@@@BAB@FAB@B@B@B@DAD@B@BA@@B@B@@@@@B@@@B@FAD@FAD@B@D@BABAB@DA@@B@@@DABADA@AB@@AB@BA@@BA@@@@BABA@ABAB@@A@@B@BA@AB@BABA@@BA@@B@@ADABABABA@@BABAB@BABABABAB@@ABABABABADAB@BA@ABABADA@AB@BADA@@B@@A@@BABC@@BA@@@@@AB@@A@@BA@@@@@AB@@@@@BABAFCJEBADCFCFCBABADABADCBCB@D@D@D@BBD@F@@@B@@@@@BB@@D@@@@@D@@@DB@@B@B@B@@BB@@@B@@BD@B@@@BBB@B@B@BB@@B@B@B@@@BBB@@@F@B@@@B@DBB@D@B@B@B@@@B@D@D@B@B@F@@@@@F@D@B@F@B@@BB@@@B@B@B@B@B@@@B@@@B@B@B@B@B@B@B@B@D@B@B@@@B@@@B@B@B@B@B@D@B@F@B@@@FAB@@@B@B@B@B@B@B@@@B@B@@@@@B@@@@@B@B@BAB@B@BB@@B@@@D@B@@@B@@@B@B@B@B@@BB@B@@@@@B@@@@@B@@@B@@@B@@@BBB@@@B@@BB@@@BB@@@@B@@@@@BBB@@@D@@AB@B@@@B@B@@@BBB@B@@@B@@@B@@@B@@@B@@@@@B@@@@@B@@@@@B@BB@@@@B@@@B@@@B@@@@@B@@@B@@BB@B@@A@@B@@@@@@@B@@B@@B@B@D@B@@@@@B@@@@@BB@@@D@@A@ABAB@@@@@B@@@@B@@@B@DBB@B@B@BAB@@@BB@@B@@B@@B@B@B@B@@@B@@@B@B@@@BB@@B@@@@B@@@@B@@@BBB@@BB@BBB@@@BB@@@@B@@@B@BBB@@@@@B@@@BB@@@@B@@@@@@@B@B@@@BBB@@@@B@@B@@@B@@A@@@BB@B@@@B@@@B@@@@@@@@@@@@@@@@@@BB@B@@@@BFDBDFJDFDDBHDFDDFDBB@BB@BBD@F@DAH@F@B@B@BD@B@B@BADAD@B@@BBBBB@D@FAD@BBDBDDHDDBD@H@@@@ABAB@BB@@D@@@BA@@B@DAD@HCDAF@DA@@FAJC@@FA@@B@@@HCD@B@BA@@DAD@DA@@DAB@@@B@@AAAAA@A@@@A@@@@B@@@@@@@@A@@@@@@@@@A@@BA@ABABABA@AB@@A@@B@@A@AB@@A@@BA@@@A@@@@BA@@@@BA@@BA@AB@@@@ABAB@@AB@@@B@@B@@B@@AB@BA@@@A@@@AB@@@@ABA@@@@B@@ABA@@BA@@BA@ABA@@BA@@BA@ABA@A@@@@@@@A@@@A@AAACE@@B@BA@@B@B@B@B@BAB@BA@@BA@A@@@@BA@@@A@@BA@A@@@A@AB@@A@@@ABABA@@BABC@@BA@A@@BA@@@AB@@@@AB@@@@@@@BCBCKAA@@ABC@@BC@AB@@BB@B@B@BAB@@@BA@@BA@@BA@AB@@AB@@ABADAB@DABAB@@@B@BADA@@@AB@@@BADCDEFCDCDCBCDADABA@AB@BA@ADA@CB@@AB@@A@A@@B@BC@@BABABABA@@@A@@@@A@A@A@C@A@@AA@@@@@@@@A@@@ABA@C@A@ABA@@@@B@@ABB@AB@@@B@@@@@@AB@@A@@@AB@@A@@@@@ABA@CB@@A@ABA@@BA@A@ABA@CB@@BB@@@B@B@@@B@@@BBB@@@BC@A@@@A@@@@BA@A@@@ABC@A@@@ABA@@@CBC@A@ABA@A@@B@@A@@@A@CBA@ABA@ABC@@BAB@B@B@@@B@DABAB@@@B@@@B@BBB@B@DBB@@@B@DB@@BA@@@A@A@A@A@A@A@A@A@A@A@A@C@A@A@ABA@@@@AA@@@@@A@@@C@@@A@A@@@A@A@@@A@C@@@CBE@C@EBC@@DAHBJ@BBD@B@DBB@F@B@@A@ABA@C@CBC@CBE@CBC@CBC@C@ABC@A@CBE@@AAAECAACCEACCECCACCCAECCAA@ACC@ACCACAC@@A@AA@@@A@@@@B@@AB@@A@@@@@ABA@@@AA@A@A@@A@@@A@@AA@@AA@@@A@AAA@A@@@AB@@@@@@A@AB@@@@@@A@ABA@A@@AACCEEEEGGGGIICCCCECAAMCIACACAWEICGAEACAC@AAIACAMCA@AAECCAWQIEECIEEEGEECECGEGEKIUMCAAA@ACG@CAGAG@@BABA@@@BB@@A@@@AB@@@BABABA@@@@@@A@AB@@A@A@@@BA@@AAA@@A@AAAC@A@@AC@A@@@A@ABA@A@ABA@@@AA@ABCAAA@BA@ABEACAEACECCAA@@@AAAA@ABAB@BAAAA@A@A@CAA@C@A@@@@@A@@A@@AA@A@@@A@A@@@A@@@@A@@@@@A@A@C@C@ABA@@@A@AB@@@@@@@@@@@A@A@@@@@A@@A@@@A@C@A@C@A@A@A@A@A@@@CBC@C@C@CBA@ABA@ABA@@B@B@@A@@@@@@@AB@@@@@B@@@@AB@@@@@@A@@@@@@A@@@@AA@@@@@@A@@@A@@@@@A@A@@@@@@@@B@@@@A@@@@@@@@@@@AA@@@@@@@@@@@@A@@@@@@@A@@@@B@@@@A@@@@@@AA@@@@@AB@AA@@@@@A@@@@@A@@B@@@@A@@@A@AA@@A@@@@BA@@@BB@@@@A@@@A@@@A@@B@@@@A@ABA@@@@@@B@@AB@@A@E@C@A@@AAA@AAC@@@A@@@@@@@A@@A@A@C@@@A@A@A@AA@@@@A@@AAA@@BA@@@C@E@@AA@@@@@AA@@A@@A@@A@A@@@@@@@@A@@@A@AAE@A@@@@A@@@@@@@A@@@A@@@C@A@@@A@A@A@@@@@@A@AAA@A@C@A@AAA@C@AAA@C@C@A@EAG@EBA@A@ABCDAB@@A@ABC@A@C@A@A@EAEAC@A@A@A@A@C@CBABA@@BEDCDAB@BCBEDA@@@@@@@A@E@CAA@@@AAA@C@E@E@"],encodeOffsets:[[129272,47182]]}},{type:"Feature",id:"231223",properties:{name:"青冈县",cp:[126.099195,46.70391],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@A@@B@@@BB@B@@B@@@B@@A@@BA@B@@@B@@@@B@B@@A@A@@@AB@BB@A@@@A@@A@@ABA@AAA@ABA@@@AA@A@A@@@A@@@@@@A@A@ADA@@@@@AA@@@@AB@B@B@B@@A@A@@AAA@A@@@@@AA@@@@AB@@@@@A@@B@DA@@@@BA@@@@A@@@@@A@A@@A@@A@@B@@@@AA@@@A@@B@BA@@@@@A@@@A@@A@@@A@@@A@BABABAB@@@BBBA@@@A@@@@@@B@@@@@B@@@BA@AA@A@A@A@@BA@@@@A@@BA@@B@B@@@B@@ABB@@@@BB@A@@@AA@A@AAA@@@@A@@B@B@B@@@@A@@BA@@B@B@B@@@B@@@B@@@AA@@@A@@AA@@@@BA@@@@@AA@B@@@@AA@A@A@@AA@BA@A@AB@@@@BB@B@B@@@@@@AAAA@@A@@B@B@@@B@@@B@BAB@@AA@@@BA@BB@BB@@B@@@@A@@@A@@BAB@B@BBB@@@@AB@@@B@@@@BB@@@BA@@B@@@B@A@@B@@B@@@@@BA@@@@BB@@@@B@BBB@@@B@@B@@B@@@BA@@B@B@@@@B@@@@ABA@@@@B@@@@@@D@@@@@AB@@B@@@B@BA@@BA@@BAB@@B@@BB@@B@@@@@@@@A@@@A@@DADAB@@A@@AAA@@ABA@@B@@@@A@@A@@AB@B@B@B@B@B@@@@A@@@AA@@A@@AA@A@E@ABA@ABAB@@B@A@@@@AAA@@AB@B@B@@@@A@@BAB@@AB@B@@@B@@@BA@A@C@AA@A@@BA@AB@@A@@A@@@@BAB@@AA@@AB@@@BB@@BB@@B@@A@@@@AA@@BA@@BAD@B@@A@@@AA@AA@@@@D@B@B@B@D@DBBAB@BC@A@@AA@A@@@ABABAB@FB@A@@@A@CCCCEAGCCCEEIACEC@A@@A@A@@A@@@@@@@@@@@@@@@@A@@@A@@@A@A@@A@@@BA@@@A@@@@A@@A@AA@@A@A@@@@@@@A@@@@@AA@@A@@@@@A@AAA@@@A@@@@@AA@@A@AAA@@AA@AA@@A@@@@@@A@@A@@@AA@@A@A@@@A@@@A@A@A@A@@@@AA@@@AA@@A@ABA@A@A@CAA@@@A@@@@@@A@@@@BABAB@@@@C@@AA@@@@A@@@@@A@C@A@A@@@@AA@@@@@@@A@@@@BA@A@@AA@@@A@@@@@A@@@A@@@A@@@@@AAA@@@@@A@@@@@A@@@@@A@@@A@@@A@@@A@@@A@A@AA@@A@A@@@A@A@@BC@@@A@AA@@@@A@@@@@AA@@A@@AA@@@A@AA@@A@@@A@@@A@@@@@A@@@@@A@A@@AA@A@A@A@@@A@@@A@C@@@A@@@AAA@A@ABA@A@@@@@A@@@@@A@A@@@A@A@A@A@A@A@@@A@EB@@A@E@A@C@A@A@A@A@A@@@A@@@A@A@C@A@A@A@A@A@A@A@A@@@A@@@A@A@A@A@A@@@A@@AA@E@A@C@E@@@@@E@A@A@C@C@A@@@A@A@A@C@A@CAA@@@A@E@@@A@AA@@A@A@A@@@AAA@A@A@AA@@A@C@@AA@@@A@@AA@A@A@@@CA@@C@@@@@C@@@AA@@@@A@@@E@C@AAC@C@C@A@ADCDABCBABABEDEDCDABIFEDABAB@@@@A@@B@@@@AB@@@BA@@B@@@@AB@@ADAB@@@BA@@@CBABA@@BCBABAB@BABA@CBABABABAB@BA@ABABABABA@ABAB@@ABABABCB@BA@@@AB@@ABABA@@BABA@@@@BA@AB@BABAB@@@@AB@@ABA@@BA@@BCBABCB@@A@@@CBA@ABABC@A@C@EBC@EBA@@@A@@@@@A@A@@@ABA@C@CBA@A@A@A@EBA@AB@@@@A@@@@@ABA@ABCBA@ABABA@ABA@@@A@@BC@ABCBABCBABEBABAB@@CBA@ABA@ABC@@BA@CBABA@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@D@BABAH@B@BA@@BA@@@@BAFA@@@ABA@@B@@@BEFGJA@GHCDA@IFCBEDBBBBDBDDBBDDDBBDDBBB@@CL@@@@ABE@CB@@@@@@CACC@@@@CACCCAAACCA@AACA@@CCA@CDCBEDCBCDC@ABAB@BABCFAB@@ABHB@@A@EDID@BGAC@A@IHA@ABEDABABIHMJEDCDCDCBCBEDCBEFA@EDCDGDIDCBKFIBABGDA@@@CBA@EBA@A@CBGBKFGDA@ABC@A@C@@@ABABCBA@IFIDABCBCBEBEDEDABEDADCBCFABKLABABABEFAB@B@@@@@@@B@@@@ABA@@B@@A@@BA@@@@@@BA@@@@@@BA@@@@BA@@@@B@@A@@@@BA@@@@@AB@@@BA@@@ABA@@@ABA@@B@@A@@B@@@@@@A@@@@@AB@BCBA@@BA@@BABABABABA@AB@@AB@@CBAB@@ABABA@ABAB@@@@A@@DA@AB@B@@@@AB@A@@@@A@ADABEFABEFABCBABCBCBGBABGDCBEBEBGDCBGDGDEBEDGDCBC@CDEBGDCBEBEBEDA@CBA@EDEBGBGDC@ABCBCDCB@@@@@@@@A@@@ABA@A@A@@BA@A@@@ABC@@@A@ABA@@@A@AB@@A@@@ABC@@BC@ABCBABABA@ABA@A@CDEDGFCBCDEDIFCBABABCBCBKFGFEBCDEBCDGDGDEDEDEBABA@@BA@CBaTGAICAB@@CDCBABKFIFADEBKJA@CBGFEDEDCBMJWPBD@HDDOJGFIFKHCDABA@EDED@@@@@@@@@@@@@BAB@@@B@BAB@B@@@B@BAB@B@@@BAD@BAB@D@DAB@DAB@B@DABA@A@A@EBE@GBIBG@E@ABA@[DBH@@@@@@A@@@@@@@@@@B@@B@@F@L@BMHIFGFKHGD@B@@B@@@@@D@D@D@N@F@D@D@F@B@B@@@B@B@D@B@D@N@F@D@B@B@F@N@B@@@B@B@B@B@B@B@D@B@F@L@L@D@H@H@F@D@D@F@T@B@D@H@F@L@B@F@B@B@H@H@D@B@D@H@P@d@`@B@@@@@B@D@B@D@F@H@H@H@N@L@J@R@H@J@LBF@J@J@HBF@J@J@J@HBP@PBR@TBR@LBP@H@RBP@LBJ@L@J@HBB@F@H@L@FB@@L@PBB@@@H@F@F@B@B@@@CJ@B@BF@D@D@NBN@L@D@B@B@D@F@D@F@FBFBF@H@B@@@@@BCBC@AB@BAB@B@D@H@@@DBB@D@D@D@D@D@DBF@D@B@F@D@FBB@B@D@D@D@B@F@LBPBN@NBL@NBL@H@N@HBJ@L@J@H@L@J@@@H@D@F@@BB@@@B@@@@@B@@@@@@@B@B@B@B@D@BBB@D@B@BB@@B@@@@@@@DBFBBBCJAF@@B@@@@@B@B@B@B@@@B@B@B@@AB@@@B@@@D@@@B@B@@@D@BAB@B@B@@@@@BB@@@@@B@B@B@@@B@@@B@BA@@@@B@@@B@BA@@B@@@B@@@@@BA@@B@@AB@B@@AB@@AB@@@BABABAB@@@B@BABABABB@D@D@B@B@D@D@@@B@B@HBD@@@B@D@B@B@@@@@B@D@DBB@D@D@D@B@@@B@@@B@D@B@BBD@B@B@@@B@B@D@@@@@B@@@D@@B@@B@D@B@B@D@B@D@BBB@B@B@B@@@B@B@B@B@B@BB@@B@@@B@B@B@B@BB@@B@B@@@B@B@@BB@B@B@B@@@B@B@@BD@B@BBB@@@D@B@@B@@DA@@@BB@@@D@@BD@B@B@B@B@BB@@@@B@@@@@@@@@@@@@B@@@@@B@@@@@B@@@@A@@B@@@@@BB@BB@@@@@B@@@@@@@@B@@@@@@@@@@@B@@B@B@@BB@@@@@B@@@@@@AB@@@@@B@@@@B@@B@@@@@@@@@@@BA@@@@B@F@BBD@D@B@F@F@D@B@B@B@@BB@@@B@@@B@@@B@B@B@@@@@B@B@B@B@@@B@@@B@BBB@B@@@B@DB@@@@B@@@@@BBB@@@B@@@@B@@@@@@@@@@B@@AB@@@@A@@@@@@@AB@@@BA@@B@@@@@B@@@@@@@@@@@@B@@@@@B@@@@@@@BB@@@@@BB@@B@D@B@D@D@F@BB@@BA@@@@@@@@B@@@B@B@D@B@FBD@B@D@B@B@D@B@@@@@@@@@@B@@@@B@@@@@@@BBB@B@@@BBB@B@@@B@@@B@B@B@BAD@@@@@@BB@@@@@B@B@D@D@@@@@B@@@@@@@F@B@B@@@@@@B@@@@@@@@B@B@F@B@DAD@B@B@B@@@B@@@@@@@@B@@@B@@@@B@@@B@B@@@@BB@@@@@B@@@D@@@B@@@@@B@@@@B@@@@BB@@B@@@B@B@B@@AB@@@B@B@DBD@B@F@FB@@DARBPBHBB@F@F@B@@AB@B@BB@@BA@@@@@A@A@@ABC@@@@A@@@@AAAB@AA@@B@@A@@@A@@A@@@AD@@AB@@A@A@@@@D@BAB@@@A@@@@AB@DB@@B@@@B@@@AAC@@A@@B@@@BAA@@@A@@@@@BA@@@@AA@@@@AB@@@A@@@AB@@@A@@A@@@@B@@@@CBAB@A@@AA@A@C@@@@@A@@BA@A@@@C@AAA@@@@A@@DA@@@@AAA@@AB@D@BABA@@BEAA@@AAA@@A@AAA@@@AA@EAA@@B@@A@@@@B@AA@BAA@@A@@A@@@@@A@@B@@A@@@@@@A@AAB@@@@@B@@A@A@@A@@@@@C@@CA@@B@@AB@B@D@@@@@B@@A@@A@@@@AB@@@BBB@@@B@@@@@@AC@@@A@@A@@B@@@@@@AAAA@@B@@@@BBA@@@A@@A@A@@A@A@AAA@AA@@@@@A@@@@AAA@@@@ABAB@@@A@@@C@@@@AB@B@@@@@@@CC@@ABABC@@@@@@A@ACAA@@@@@@AA@@@@@@@A@@@A@@@@@@@@@A@@B@@@@@@A@@A@@@@BA@@@@@@@A@@@A@@@A@@@@@@@A@@@@A@AB@@A@@@AA@@A@@@@@@A@@B@@@B@@@BA@@B@@@B@@@@@@@BA@@@@@A@@@@@@B@@@B@@@B@@@@A@@@@@A@@A@@@@@@@A@@@@A@@@@@@BA@@@@@@B@BB@A@@B@B@@@@@A@@ABA@@B@@@@@B@B@@@@@@A@@@A@BA@@@A@@A@@AA@@D@@@BA@@A@@@A@@A@BA@A@@@@@A@@A@@@@A@AA@@@@A@@@AB@@@@@A@@A@@A@@@@@A@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@AA@@@@A@@A@@B@@@B@@A@@@@@@@A@@@@B@@@@@BB@@B@@@@@B@@@B@@@@@B@@A@@B@@@@@B@@A@@@@@@B@@BB@@@@@@A@@@A@@@A@@@@@A@@A@@@A@BA@@@AB@BA@CA@@A@@@@@@B@BB@@BB@A@@@@@A@@CA@@A@AA@@B@@@@AA@@@@@D@@@@A@@@ABA@@@@A@AA@BA@A@@@@@BA@@@A@@@@A@A@@@@AB@B@B@@@@AA@@@B@F@@@@@@B@@@@B@@@@@@A@AA@AA@@B@B@@@B@@@@A@@@@A@A@@@A@AA@A@@DA@@@A@@@@A@AB@A@@B@DAB@B@B@@@BA@@BB@AB@B@@A@A@@B@@B@@@B@@@@B@B@@@@@BAB@BB@@@@BBB@@@BA@@@@AAAA@A@@A@C@@@@@@AB@B@B@@@@@@@@AB@@AAA@A@@CAA@A@C@A@@B@@A@@@@A@@BA@@@@@@@A@@B@@@B@@A@@@@@@@@@@AA@@@@A@@A@@A@@@C@@@@@A@@@@BA@@@@@@@A@@@@@@@@A@@@A@@A@@A@@@@A@@@@@AB@@@B@@@@A@@@@@A@@@@@@@@@@@@@@AB@@@@@@A@@@@@@@@A@@@AA@@A@A@@@@@A@@B@A@@@@A@@@@@@@@@AB@@@@@@@A@@@@A@@@@@@@@B@@A@@@@@@@@@AA@@@@@AA@@@@AA@@@@@A@@@A@@BA@@@@@A@@@@@@AA@@@@@@A@@@@@AB@@@@@@A@@@A@@@@@A@@B@@@@@BAB@@@@A@@A@@A@@@@AAEC@@@@A@@@@@@B@@A@@@@@@@A@@@@@A@@@@A@@AA@@@@AB@@@@A@@@@@@@@A@@@@@AB@@AB@@AB@@AB@@@@A@@@@@@A@@@A@A@@@@@@@A@@@@@@AABA@A@@AA@@A@@@A@@AAA@@@@@A@@@@@@A@@A@@@A@@@@@@AD@DADABA@@B@@@AB@@@@B@BB@@B@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@B@@@@@@BB@@@@B@@@@@@@@@B@@B@@@B@@@@@@A@@B@@@@@@AB@@@@@@@@@@@A@@@@A@@@@@@@@A@@A@@@B@@A@@@@@@@A@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@AA@@@@A@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@A@@@@@@B@@A@@@@@A@@@@@@@@@@B@@@@@@@@@BA@@@@A@@A@@@@@@@@A@@B@@@@@@A@@@@A@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@A@@A@@@@@@@@A@@A@@@@A@@@@@A@@@@@@@@@@@@@@@A@@AB@@@@@@A@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@AB@@@@@B@@@A@@A@@@@A@@@A@AB@@ABAA@@A@AAA@A@@@A@AB@@A@@@@A@@@@BABA@@BA@@A@@@A@@BA@A@@@@@@A@A@@A@@AAA@@@A@@C@@@A@@@@B@@A@A@A@AAAAA@A@@@AA@@B@BABA@A@A@@CAA@@A@@B@DA@@@AA@@@ABA@@@@A@@@A@@@A@A@@@@BAB@@@@@BB@@B@B@BA@A@AAA@AB@@AB@B@@@A@AA@@@A@AA@AA@@AA@@A@@@A@@A@@@A@@A@AB@AA@@@@AA@@A@@AA@@A@@A@@AAAAC@@A@AA@A@AA@@@CCCAC@AA@@A@A@A@@@A@@@@@A@@@AA@@A@@@@AA@@@@@@@@@@@B@@@@@@@@@@@BB@@@@@AB@@@@@@A@@@@@@@@B@@@@@@@@A@@@@A@@@@@@A@@@@@@@A@@@@@@@A@A@@@@A@@@@A@@A@@@@B@@@@@B@@@@B@@@@@A@@@@@@@@@@AA@@@@@@@@@@@@@A@@@@@@@@A@@@@@A@@@@@@@A@@@AA@@@A@@@@@A@@@@B@@A@@@@@@B@@A@@@@BA@@@@B@B@@AB@@@@@@@@@@@AA@@@@@@@@@@B@@@@@AA@@@@@@@@@@@AA@@B@@@@@@@@@@@A@@@@@@B@@@B@@@@@B@@A@@A@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@BA@@@@B@@@@@@@B@@@@B@@@@@@B@@@@@@A@@B@@@@@AA@@A@@@@A@@B@@AB@@AA@CE@AA@A@A@A@@A@@@AD@@AB@@A@A@@A@A@AB@@A@@@@A@@B@BAB@D@@@B@@A@@@AAA@A@@ABA@@BA@@A@@@AB@B@@@DAB@BBBAB@@@@AA@@@CB@@A@@A@@A@@@A@@@A@@A@A@ABAA@@@A@@A@@@ABA@AB@B@BA@@@AA@@@@AB@@@AA@@A@A@@@@A@@B@DAB@BAB@@@D@@@BA@A@@@A@A@@B@B@@@@A@@A@A@@A@@@@BAB@B@@@BA@@A@A@A@A@A@@AB@@A@@@@@@@@CA@@@@@@@A@@B@B@B@@@@@@AA@A@@@@@@@@AB@BB@@B@BBB@@@@@@AA@A@@A@@@@@AB@B@@@@@B@@BB@@@BA@@@AA@@A@A@@@@@@B@@@@@B@B@@@@@@AA@@A@@@@B@B@B@@@@@BA@@@AD@B@@@@A@@@AAA@A@@@AB@@@A@@AA@@@@@BA@@@AA@@@@A@@@@@A@@@@@AA@A@AA@@@AB@D@B@B@@@@@@@CAA@A@@@BA@@DA@A@@A@@@A@@@A@@@@A@@@@@A@@@A@@DBB@BA@@@A@@A@@@@@A@A@@@@AA@@@AB@@@@A@@@A@B@@A@@@A@@@AB@@@B@BB@@@@@B@@@@B@@A@@B@BA@@@@@@A@@AA@@@A@@@@@@@@@@@@A@@@@@@@@A@@@@@@@A@@@BA@@B@@AB@B@BBBA@@@@@@@AA@@@@@B@BA@@B@B@@A@@BA@@@AA@@@AB@@A@@@@@@@@@@@@ABAB@@A@@B@@@A@@@A@@@A@@@@@@@BAB@B@@AB@@@@@A@@@A@@@@A@@@@B@@@B@@@B@@@BA@@A@@@@@A@@@A@@@@@@AB@@@@AA@@A@@@@@A@@@@B@@B@@B@@A@@@@@@AA@@@@@@DCB@@@B@@B@@A@@B@@@@@@@@B@@@B@@A@AA@@A@@@@B@@BB@@BB@@@@A@@@@@A@@@@@@B@@A@@@@@@@AA@B@@@@@BB@@@BB@@@B@@@@AA@@A@@@@B@@A@@B@@A@@B@B@@@@@@@@@@@AA@@A@B@@@B@B@@BB@BB@@@@B@@@@A@A@@@AA@@@@A@@@@@@B@@@B@B@@@@@@@B@A@@A@@@@@@@@@@@@A@AA@B@@@@A@@@@@A@@A@@@@@@B@B@@A@@@@B@@@@@@@@AA@@@@A@@@AA@@@B@@@@@@AB@@@@@BB@@BA@@B@@@@@B@@B@@B@@@@@@@@@@@@A@@@@B@@@B@@@@@@@@AA@@@@@@@@@@@B@B@BB@@@@@@B@AA@@@@A@@AB@@@B@@@@@B@@@@@@@B@@@@B@@@@@BB@@@@@B@@@B@@@DA@@@ABA@@AA@@@@BAD@@@@AB@@AA@AB@@A@BA@A@@A@@@@@@AB@@A@@C@AAEAA@A@BA@@@A@@@@@@A@@@@B@@A@@AB@@A@@B@@@@@@@BA@@@@@@A@@@@AB@@@@@@@A@CAAB@@@A@@@@B@@@@@@A@@@@@@@@@A@@@@A@@A@@@@B@@A@@@@B@@@@@B@@A@A@@@@B@@@@@@@@@AA@@A@@@A@@@@@@@@@BA@@@@@A@A@@AA@AA@@A@@B@@@B@@@@@AA@@@@BA@@B@@@B@@BB@BA@@@@B@@@@@@@A@@A@@@@@@B@@@B@@@@@@@@@@AA@@@@@B@@@@@B@@BB@@@@B@@@@@A@@@@@A@@@@B@@@@@@A@@AAA@@@@@@@B@@A@@B@@@@A@@@@@@AA@B@@@@@@A@@@@A@@@@@A@@@@A@@@@@@@@@@AA@@@@@@@@A@@@@B@@@@@B@@@@B@@B@@@@@@A@@@@AAA@@@@@@A@@B@@@@@@@@A@@BA@@BAB@B@B@@@@@@A@@C@@AA@@@@AB@@A@@@@@A@@B@@@@@@@@@AA@@@@B@BA@@@@B@BB@@@A@@A@@AA@A@@@@@@@B@@AB@A@@A@@@A@@B@BB@@B@@@B@@@@@@@@A@@@@@@@@AA@@@@@@@A@@@@BA@@@@@AB@@@B@@@B@B@@@B@@@A@@@@@AAA@@@@@@@@A@@B@@A@@@@B@@@@@@@@@BB@@@@@@@@@AB@@@@@B@BB@@B@@@@A@@@@@@A@@@A@@@AA@@@@@@@A@@@@B@@@B@@@@A@@@@@@@@A@@@@@@@@@@@@AB@@@@@B@@@@@@B@@@B@@@@B@@@B@@@@A@@@@@@@@A@@@@@@A@@@@@@@@@AA@@@BA@@@@@@B@@@@@@@@@@@B@@@@@@@B@@@B@@@B@@@@@@@@A@@@@@@@@B@@@B@@@@@@@@@@BA@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@@A@@@@@@@@BB@B@@B@@@@B@@@@BA@@@@BA@@@@@@B@@@@@@@@@BB@@@@@@@@@A@@@@B@@@@@@@BB@@@@@@@A@@B@@@@@@@B@@@@@@@@@@A@AAAA@A@@A@@BAB@@A@@@@@AA@A@A@@@A@@@@@@A@@@@@A@@B@@A@@@@@@@AA@@@@@@@@@AB@@@@@@C@@BA@@@@@AA@@B@@A@@@@@A@@@@AA@@@@@@@@@AB@@@B@@@@A@@AA@@@@@AB@@@@@BA@@@@@@@A@A@@@AA@@@@@BA@@@@@@@@@AB@@@@@@AB@@@@@@@@AA@@@@B@@AB@@@@@@A@@A@@@A@@@@@@@@@@AB@@@@@A@@@@@@@A@@A@@@@@@A@@@@@AA@@@@@@@B@@@B@B@BA@@@@@@@@@@A@@@A@@@A@A@@@A@@A@@@@B@@AA@@@A@@@@@@@@@@@@@@@@BAA@@@@@@@A@@@AA@@@@@@B@@A@@A@@@@@@@@@ABB@A@@B@@A@@@@B@@@@A@@@@@@@@@A@@A@@@@A@@@@@@A@@@@@BA@@@@B@@@B@@@@@@A@@@@@@@A@@@@@@@@@@A@@@@@@@@@@A@@@@@@A@@@@@@@A@@@@B@@@@AA@@@@@@@@BA@@@@B@BAB@BA@C@ABCB@@@B@B@BA@@@A@@@AA@@AB@@@@AAABCAAB@@A@A@@BAB@@@BBB@D@B@@AAA@@@@BA@@@@C@@@@A@@@AA@AB@A@@@@B@BAB@@@@@AA@@@ABBB@B@B@@A@@"],encodeOffsets:[[129320,47756]]}},{type:"Feature",id:"231225",properties:{name:"明水县",cp:[125.906301,47.173426],childNum:1},geometry:{type:"Polygon",coordinates:["@@MAC@C@E@@A@ADI@@A@A@E@E@G@@@A@OAK@@@EAK@G@E@A@GAI@K@I@KAO@QAG@O@KAQ@SAQ@OAO@GAI@I@I@E@GAI@I@E@KAI@G@Q@I@K@M@G@G@G@E@C@A@C@A@@@@@A@_@c@O@G@C@A@C@G@G@A@A@E@A@K@E@G@C@A@S@E@C@C@E@G@G@C@K@K@E@A@C@A@A@A@A@A@A@@@A@M@E@A@A@C@E@M@C@A@C@A@A@@@A@A@E@C@C@E@M@C@C@C@@@@@A@@@@@@@AB@BABABAB@BABABAD@@@BA@@B@@@@AD@@A@@BAB@BA@AFABCF@BGL@DA@CFIPKT@@@B@B@@@BDD@B@@DJBDFLBDBF@BBDFLBB@BBBBBFJHJBDBBDDLPNFLF@P@P@L@B@@@B@B@J@F@H@F@H@H@@@F@D@F@DBF@F@@@D@F@F@H@F@F@D@F@F@D@F@F@D@F@D@J@J@F@F@D@F@D@F@D@F@F@D@B@BN@D@D@D@`AZ@R@J@H@H@L@N@@@F@HBLBN@FBF@F@B@D@PBVBXBDBD@D@D@D@D@J@F@FBD@F@@@D@D@D@@@FBF@T@B@B@@@B@@@@@@@@@B@F@DBB@H@F@BBB@D@DBB@D@@@B@@@D@B@FBD@D@DBB@B@F@F@HB@@D@D@BBD@D@J@D@HBH@D@D@JBB@LBH@F@B@JBJBB@D@F@F@BBD@B@D@D@DBB@@@B@@@F@D@F@@@DBF@F@BB@@B@@@@@@@@@B@D@B@D@@BD@D@B@B@@@B@BBB@B@@@B@B@B@B@@@@@DBB@B@B@B@B@@@D@B@B@@@B@B@BB@@D@B@F@D@@@B@D@F@F@B@@@@@BB@@B@B@@C@@@A@@@A@@@@B@B@B@@@DBF@F@B@B@D@BBF@D@F@DBD@F@D@D@DBF@F@@@L@TBN@@@BBB@D@@@B@B@B@D@D@@@BBB@@@B@B@D@B@B@@@B@B@B@B@DB@@B@B@B@B@F@B@B@DB@@B@D@B@B@B@D@@@B@BBD@@@B@B@B@B@@@NBD@D@D@BBF@PBL@PBLBXBLBN@FBB@D@B@D@DBB@B@B@LBN@HBD@D@JBNBJ@DBNBJ@F@JBPBTBRBD@F@H@@@B@PBF@FBJ@B@B@D@DBD@D@B@B@D@DBF@F@B@B@@@@@F@DBD@F@F@DBF@D@D@F@FBD@F@D@F@DBB@H@B@D@D@FBD@F@D@B@D@D@FBD@F@@@D@DBB@B@D@B@D@B@DBD@D@D@F@DBF@D@D@B@DBB@B@@@B@B@@@@@B@@@@@@@@B@@@@B@@@B@B@@@B@B@B@B@B@@@D@B@@@B@@@B@B@B@@@B@B@B@B@@@B@@BB@@@@@D@D@D@B@B@@@B@B@BBD@B@@@B@@@D@BBB@B@D@B@B@BBB@B@B@D@@@B@B@B@B@B@B@B@B@B@B@B@BBB@B@B@D@B@D@B@B@BBD@@@B@B@D@BBB@D@B@B@B@D@D@D@@@BB@@B@D@B@@@B@B@D@DBJ@F@@@FBF@H@HBD@D@F@FBH@F@D@B@B@@@B@B@@@B@@BB@@@B@B@B@@@D@B@B@DBB@B@@@B@B@@@B@@@@@B@HBL@LBB@D@F@FBD@B@B@@@D@F@B@@@B@BBB@@@D@B@D@B@B@FBD@D@D@FBD@F@LBH@@@DB@@B@B@D@B@HBL@LBF@H@D@@@D@B@@@DBF@F@D@DBD@F@@@B@@@@@@AB@@A@AB@B@@@@AAAAAA@@@@AB@B@BA@@B@AA@A@@@C@AA@@@A@BBABA@@@A@@@@A@AAAAAA@@AABA@ABCAABA@A@CAAA@@@CA@@A@A@@BADABAB@@A@@BA@@@AA@@A@@@AB@@AA@@@C@@@A@AA@A@A@@A@A@@@AB@@A@@AA@@AA@@@BABA@AB@@AAA@@B@@AA@@@A@AB@@@BB@@BA@AA@@A@@B@@A@@A@@AB@@@B@@A@A@AA@@AABA@@BA@AB@@AB@@B@@BAA@@@A@A@@A@@@@@A@@A@@A@@@@@A@@@@AA@@AB@@@B@@AB@@A@@BA@@BAA@@C@A@@@AA@@@B@B@@A@@AA@@@A@@BAAAA@AA@@@@B@B@@A@@A@A@@@AA@@BA@@@AA@@@A@@@AB@@@@@AAA@@AA@@@@@A@@@@A@@@A@@A@A@@@@B@@A@AB@@A@@A@@@AA@A@A@@A@@BA@@@@AA@@B@@@@A@AB@A@AA@@AB@@ABA@A@@A@@@@@@A@@A@@AA@@@@A@@@@@B@AA@@@@@AB@B@@A@@@@A@@A@@A@@@@AA@@BAB@@A@@@AAAA@@@@AB@@@@A@@A@@@A@A@@@A@@@@A@@@@A@@D@@@B@@A@@@A@@@@B@@@B@@@B@@AB@@AB@A@@@A@@@@A@@B@B@@@@A@@@@A@A@@@A@@@@@A@@BA@@AA@@@BA@@BA@@BA@@B@BB@@@AB@B@@@@@@AB@@@BB@@@AB@@@@A@AAAA@@AA@@@B@@A@A@@A@A@A@@@@@AAB@BA@@@@AA@@AA@@A@A@A@@A@@@A@A@@@AA@@AACAAAAAACCAA@@@A@@B@B@@@@A@@@AB@@@@@@AC@@A@@@@@AB@@ABAB@@AB@@@@AA@@@BA@@B@@A@@B@@A@@A@@A@@@A@A@@A@A@@@A@@@@@BAB@B@BAB@@@@B@@@B@@BA@@@@@A@@@@AA@@BAB@@@AA@@A@@@A@A@@@A@@@@BBB@@A@@@AA@@A@A@AA@@B@BA@@A@@A@@@AA@@@@@ABA@AA@@@AB@BAB@@A@@@@@A@@B@@AB@A@@AA@C@@@A@@@@AB@BA@@B@AA@@A@A@AA@@A@@@BABA@AB@@AA@A@@@A@@AAAA@@@A@@AB@@A@A@@B@B@@@BA@AB@@@BA@@B@@A@A@@@@B@@@BAA@A@@@CAAAAAA@@AA@@A@A@@AA@BA@@@A@@@A@@ABA@@A@A@@A@@@AB@B@B@@@BA@@AA@@AA@BC@A@@@A@@AB@@AB@B@B@B@@@DB@BB@@@@A@@@AA@AA@@@@@A@@B@@A@@@@A@@@AA@@@@A@@A@@BAB@@A@@@@@@A@C@@BA@@A@@@AA@AB@B@@AA@@@@BA@@B@@A@@@AA@A@@BA@@@A@@A@@@AA@@@@A@@B@@@@AA@@A@A@@@@@@A@A@@BA@@@A@@@@A@@BAAA@@A@@ABA@@@@BBB@@@@@@A@@AA@@@C@@@A@AB@BA@@@A@@AA@@@@@A@@@A@@@AA@@A@@A@@AA@@@AA@@A@A@ABA@@BA@A@@@A@@A@@BA@@@@@A@@A@CAC@@@@A@@@@@@A@@@@@AB@@@@A@@@BA@A@@@@A@@@A@@@@@@A@@@AA@AB@@A@@AA@@AA@@@A@@A@@B@@@B@@AB@@BB@@@@@@@B@@@B@@A@@@@@@@@@@@@@@C@@@@@@@AA@@@@@@@@@A@@@@B@@@@@@@@@B@@@@@@@@@BB@@@@@@@@B@@@@@B@@@@@@@@@@@@@A@@@@A@@@@B@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@A@@@@@@@@A@@@@B@@@@@B@@@BA@@A@@@@@@@A@@@@@@@@@@@@A@@@@@A@@@@@@@B@@@@A@@@@@@@@@@A@@@@@AB@@@@@B@@@@@BB@@@@@A@@@@@@@@@A@@A@@@@@@@B@@A@@@@@@@@@@A@@@@@A@@@@@A@@@@@@A@@@@@@@@A@@@A@@@@@@A@@B@B@B@@@@@AA@@@@A@@@@BA@@@A@@@@A@A@@A@@B@@@@A@@AAA@@@A@@@@AB@@@A@@@@BA@@@AB@@@AAB@@A@AA@@AA@@@BA@@BBB@@@B@@A@@@@B@@@@AE@@@BA@@D@B@A@@@AA@@@@@ABA@@@@@@EA@A@@A@@A@@ABA@@BA@C@A@@@AA@A@@BA@A@@A@AB@BA@@@@A@@BABA@@@@AACC@AA@AA@@@@@AB@B@@AB@BBB@@A@@@@@@AAC@@@AA@AA@A@@@@A@AAB@A@@BA@@@@A@@A@@@A@@@@AAA@CBA@@@@@BB@@@@A@@@A@AAA@@@@@BBB@@@@B@@@@C@@AA@@A@@A@@@@@@B@@AA@A@@B@@A@@CB@@@@@A@@BA@@A@A@@@@@@A@@@@@@A@@@@@A@@@A@@ABAB@B@@@@@@@@A@@@@A@@@@@@@A@AA@@@@@@@@B@B@@@AA@@@A@@@@@@@@@@A@@@A@@A@@AAA@@@@@@BA@@@A@@B@@A@@@AB@@AAA@A@@BA@E@E@A@GAOAQACB@@EAE@A@C@CAA@A@@@A@@BA@A@A@@@A@@@AA@@@@@A@@A@@@@@A@@@C@@@A@@@@@A@@A@@A@A@@@A@@@@@@A@@@A@@@@@@A@@@A@A@A@C@CBA@E@A@A@@@@@@@@@@A@@@@A@A@E@@@@@@@A@@@@@C@C@A@A@@@@@A@@A@@@@C@ABA@A@A@@@A@@@A@A@AA@@A@A@AA@@@@@@A@@@@@@A@@@@@@@@A@C@A@A@C@A@C@EAA@C@A@A@@@A@@@@@@@@@AB@@AAE@C@C@A@C@A@@@AA@@@@A@@A@@@@@@@A@@@@@A@@@@@@@@@@A@@@@@A@@@AB@@A@@B@@@@@@@B@@A@@BA@@@@@@@@@@@@A@@A@@@A@AA@@@@A@@@@@CAA@@@A@A@AAA@@@A@@@A@A@A@A@@@@@A@A@A@@@A@@@A@@@A@@AA@A@A@C@E@E@A@C@C@AAE@A@@@@@AB@@@@@@@@@@A@@@@A@@A@@@@@A@@B@@@@A@@@@@A@@AA@A@@@@A@@@@@@@@@@@A@@@@@@A@@@@@A@@AAA@@@@A@@@@B@@A@@@@@A@@@@@A@@@@@@@@@@@@@A@@@@@AAA@A@A@A@C@@AC@@@A@@A@@CB@@@AA@C@@@A@AAA@C@@AA@A@@@A@A@A@A@@AA@A@@@A@A@@@AAA@A@A@A@@@A@@@AAA@A@A@A@A@@@A@A@A@A@AAC@A@C@A@A@C@A@@@@AC@@@A@@@@@C@A@A@@@A@A@C@AAA@C@A@@@A@@@A@C@C@C@A@CAC@A@@@@@A@A@C@A@@@C@GAA@A@@@C@C@A@A@C@C@A@BABABA@A@A@@BABABA@A@@BA@@BA@@@ABA@@@AB@@A@@@@@A@@@AB@@A@A@@@A@@B@@A@A@@@A@@@A@A@A@@@@AA@@@@A@A@A@ABC@@@A@A@@@C@@@A@@@A@@BA@A@A@@@A@A@A@A@@@@@A@@@BEDIAAEACA@@@@@@A@@@AAA@C@A@AAC@A@A@A@A@@@@@@@A@@@@@A@@@A@@AE@C@G@@@I@K@G@I@K@I@GAM@G@K@MAK@MAM@OAKAE@A@C@C@C@A@A@EAC@E@A@C@E@CAC@C@C@C@C@A@CA@@G@C@A@A@ABA@@BADAD@@@@A@G@E@EAEAE@C@E@C@A@A@C@K@M@"],encodeOffsets:[[128784,48178]]}},{type:"Feature",id:"231281",properties:{name:"安达市",cp:[125.346156,46.419633],childNum:1},geometry:{type:"Polygon",coordinates:["@@BBB@BD@B@@BB@@@B@BBB@BDB@BB@@BAD@F@BAB@DEDKHABABA@ABCBABA@ABA@CD@@AB@@@@AB@@C@@@CBA@@@A@AB@@@@AB@@@@ABAB@@@@ABABA@@BCBAB@@A@ABABA@CBAB@@@@IBEBC@A@C@A@A@CB@@@BCFAF@L@B@D@B@DIBIBOEC@CBEH@BCB@BAB@@@@AB@BA@@@A@@B@B@F@BAH@BABABE@C@MBCCA@CBCFCB@@CDECEDGDCDABCBDDB@FFBBBBBDDBBBBBDDFHDD@BDBFDBBFHFFHHFAPEHADAH@@B@@DB@BA@CBCBA@ABGDIDABDFDD@B@@DBDF@R@F@JCBQLOBIBE@Q@@@BB@@@D@B@BBDBD@B@@BB@BBDBBBDBBBDBDBBDD@DDBBDBB@BBBBDBDBDBDDBBDBDBBBDBDBDBBDFBBBDBBBB@BBB@BBDBDDBBDBDBB@BBB@BBBBB@@BD@@BB@BBDDDBDBBBDBDBBDD@DBBDFBBBDDFBBBB@BBBDF@BBDB@@@BB@@B@@B@@BB@BB@@BBB@B@@@BB@@@@BB@@@@B@@BB@@@BB@@@BBB@@BB@BBBBDBBBB@BBDDBBB@DDB@BBBBDB@BB@@@@@@@B@@@@@@@@@@B@@@@@@B@@@@@@@BB@@@@@@@@@@@@B@@@@B@@@@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@B@@B@@@@B@@@@B@@B@@@@@@@@BB@@@B@@B@@@@@@@@B@@@@B@@@@B@@@@@@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@B@@@@@BB@@@@@@@@@@BB@@@@@@BBB@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@BB@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@B@@@@@@BB@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@B@@@@B@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@B@@@BB@@DBBBBBB@BBBBDB@BDBB@BB@@BB@@@AB@BAPENCPEB@DA@@@@B@DADAB@DAB@FA\\CB@BAF@H@JAHAF@FAB@B@B@BA@C@ABA@CBA@C@CBA@ABC@A@@@ABA@A@A@@@ABA@A@A@@BA@A@@@@@@@@@@@@FCFCB@BADCLGJEHEPICC@GACXONIDAFCFCHEDAB@LIFABCJELEBADADC@@BAJDHBbSDAB@@AB@BAFAFCFCHCHCDCFADCFAHELEDADABABADAJEFCDCDAHEFCDCB@B@BAB@BABADABAD@@AD@BA@@B@@@BAB@@@B@BAB@@@D@BA@@B@B@@AB@B@B@BA@@B@@@@@@@@@DADCDABAD@HCHAFAFCB@DAB@FCFAFADAHCFADCD@DAHCFCFAHCHCDAHCFAFADAHCBAHADADABADABAFEBAFEBABCB@@@@@@BBA@@@@@ABAB@@CB@@@@@BABAB@BABA@@BADA@@BA@@BAB@BABABABA@AB@@AB@DA@ABA@@@@B@@@@@@@@AB@@@@AB@BA@@B@BA@@B@@A@@BA@@@@B@@A@@B@@@@A@@B@@A@@B@@A@@@@B@@A@@@@B@@AB@@@@AB@BA@@@@@A@@@@@@@ABAFEBABABALKBADEDABCFCBAFCFCFADADABAJCJEB@DABABA@@D@B@D@BAB@HCLEHADAB@B@FAB@DA@@B@HCBAJALEDAJCHCDCFCB@FEDAFCDADADCDCFCNIJGBABAFCBAB@JGB@D@HB@AJCFCB@@@GABA@@BADEBA@ACBABABC@@BECCA@@CCCAA@CAACCACCA@CAA@@@@AAA@@@@A@AACAA@AAA@A@AAC@AAE@AAA@A@A@C@CAA@EAE@@@A@AAA@A@CAA@A@C@@@A@ABA@A@C@@@C@A@@@A@A@A@A@A@@@C@CAA@A@A@A@@@A@@@@@A@@@A@AA@@A@@AA@A@AAA@A@@AA@@@A@@AA@A@CAEAC@A@@AA@A@@@A@A@@AA@A@@@@@@BABAB@@CAEAA@A@BA@@A@AAA@CA@@A@AA@@A@@@A@@@A@A@AA@@A@@@A@AAA@A@@@CAAAC@AAA@A@A@@@A@@@A@AA@@A@A@@@A@AAA@@@E@@AA@A@A@@@@A@@A@@@A@CA@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@A@@@A@@@@A@@A@A@@@@@A@@AA@@@A@@@A@@@AAA@@@A@A@@@A@@AA@@@A@@@@@A@AA@@A@AAA@@@AAA@@@CA@@A@@@A@@AA@A@@@AAC@CA@@A@AAA@@@@@B@BC@@BA@@@@@@AA@@A@A@@@AAA@@@A@AA@@A@A@@A@@A@@A@@@A@@A@A@AAA@@@B@@@B@B@@@@@BAB@@ABAB@@@@A@@@@BA@@BA@ABA@ABABA@ABABA@ABA@A@@BA@ABA@@BA@A@@@A@ABA@@@A@A@A@@@A@@@A@@@@BC@C@ABA@A@@@A@A@@@C@@@A@@AA@@@@@A@A@A@A@@@A@A@@@A@AB@@@B@@A@@@A@A@@@A@A@A@@@A@AB@@AA@AA@A@A@@BA@A@@@A@A@A@@@ABA@A@AB@A@@@AA@@A@B@@ABA@ABA@@AACA@@BABA@ABABA@ABABA@ABABBB@DBBAB@BABABA@AB@BABACA@@@@BA@A@ABCA@@@@C@ABA@C@A@@@A@A@AB@@@@A@A@@@A@@A@C@A@@AC@A@CAA@A@C@A@@@@A@@A@@@A@@A@@@ABA@@@A@@@@@@@@@@A@@@@@@@@@A@@AB@@@@@@@BA@@BABA@ABA@@@ABABADABCBA@@B@@@@@@AB@BADCBABAB@@ABA@@BABA@@B@@A@@@A@@BC@A@@BA@@@A@AC@A@@AA@A@AAC@AAAAC@AAA@A@CAC@C@A@AAA@CA@@A@@@A@@@A@@@@AA@@@@@A@@@@@A@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@@A@@@@@A@@@@@AB@@A@@@@@A@@@@@@@A@@@@@A@@@A@@A@@@@A@@@@@A@@@@@AA@@A@@@A@@@@@A@@@@AA@A@@@@@A@AA@@A@@@A@@@ABA@EBCBGBCBCBGBEBGBEBCBCBEBC@EBCBCACAAACACAA@AACAA@CAAACAA@AAA@AAA@CAA@@AC@AAAAA@CACA@@CAC@A@C@GAGAGAG@GAGAGAC@E@A@@AAAAA@AAACCAAAAAAAA@AA@AAAAACAACAACAAAAAAAAAA@@BADCFCDCDEHCFEDCDA@AA@@AAAAAAAAAAAACCCAACCCCAAACCCCCACAAA@ACCCACAA@@EECECECCCCCCCCACACCAACAAAAACAACECAACAA@@AACCACEECCAAACAAAACCAA@@@@CB@@AB@@AB@@CB@@A@@@AAA@CAAACAAACACACAAAC@CCCACACAAACAAACAA@@E@C@@A@@@AA@@A@AAA@A@A@@@AAA@A@CFA@@B@@@@@BA@@@@A@@@@@@@A@A@A@@AAAA@A@@A@A@A@@@A@@@AAAA@AA@A@A@AA@@A@A@A@A@A@A@C@AAA@A@A@A@A@C@@@C@CAC@A@C@AAA@A@@@A@A@C@C@C@AAA@A@A@A@@@A@@@A@C@A@A@EAC@A@C@C@A@A@CAA@C@C@A@A@A@AAG@C@CAE@E@C@CAC@A@A@C@C@AAC@A@A@@@A@@@A@@@A@AAC@C@A@A@A@A@@@A@A@DO@@BC@@BEBG@GDKBMBEBIDO@ABE@A@@BG@A@ABA@ABKBCBOBGDQC@GAE@GAG@GAI@E@CAE@CAG@EAIAGAGAGAIAC@AAIDEBEBCBGBGDEBA@CBEBEB@@A@@@AB@@A@CAC@G@GAE@AAC@I@KAGAG@AD@FAD@B@FAD@@@BAB@FAHAF@B@@AB@BA@CBABA@CBEBABC@CBIAA@E@CA@B@B@B@@@@A@@@I@@B@BAD@BEBA@EBA@C@ABAF@BABABADAD@BA@A@GAiIkG@B@B@B@B@@@BAB@@@B@@@B@B@B@BAB@D@B@B@B@B@@A@@B@B@D@D@BA@@D@B@BA@E@A@EAA@I@ABCBCBAB@@@@A@EAC@E@EA@CAE@E@E@@@E@G@EAC@G@E@E@G@E@GACDEDCBA@@DCDEBAL@R@A@G@EBC@A@@@@@@F@DH@FBL@JBB@B@@@BACCAAAA@AAAA@H@JBLBNBJBD@BABEDEDEBEDGDG@ABCDEBCBCBA@A@ABC@@@@BA@@@@BB@@BB@@@@@BA@@@BB@@B@@@B@BA@A@ABA@@F@F@BBJ@HB@@F@F@HBJBH@HBHBJBJ@DBH@FBB@D@CECECGEIEGCECECGEGEGAECEAAEACAGAKCIAA@GCGCKCAA@AAA@ACECGCEAGA@@AAE@E@AAGAEAE@CAAEEGAECECIEKEA@QCEAKIGGECAA@@@D@F@FABCBEBCBGFHDLFJF@@BBFFDDEFCFCFCF@@KAOCQCQCG@A@@JBDAF@FDDBBB@LDF@GLADGJCH@@EBG@I@I@G@OAKAE@OCEAMEKC@AA@E@CBEAK@IAK@IAOAI@C@UGSESEBESEMEA@GCC@KHUNED@@@B@@A@ABA@CBCBABCBNBTDHBD@BBB@DBB@BBB@B@BDB@@@AH@BAD@B@BD@PBHBD@B@CLFB@B@HAFABRJ@@@@@BB@@B@@@D@DAFAJ@FABCHABEJABADA@@@CDCDBBAF@BCHAFAB@B@BAF@BADAD@BAB@B@B@BBHBH@D@JBJDJ@BDL@BBDDHLBF@@@VD\\DTFNBDBAHEN@BA@APDDBBDBFBBBB@FDFDDBHFF@VBLBF@@@B@B@B@D@DBF@B@B@D@@@DBD@F@D@D@DBF@@@D@D@DBF@D@DBF@@@@FCHAD@@B@D@D@FBD@B@B@B@F@F@@BE@G@@@@@A@@B@BAB@DAB@B@DAB@@@@@B@B@D@B@D@B@DBB@D@B@B@D@B@D@B@B@D@B@D@B@B@D@B@D@B@B@D@B@D@B@D@B@BBB@D@B@D@B@D@D@B@@@@@B@B@@A@@@G@E@A@C@E@AAI@G@G@@@E@@@E@A@CA@@A@C@C@A@@@@BA@@B@BA@@B@@@@@BA@@@AB@@@BCDB@L@LB@BF@@F@FBAHALE@BAFAJAHEJEHCFFJ@@@@DFHHFF@F@J@DAHAH@FKHEFCBK@KB@@@BBBBHA@CDC@E@A@@BA@I@E@BD@@@@@DKBMBADJAVFLDBBJBDBCFGHF@B@@BFBBABABAH@HBF@NAFHHJFFFJDDCJAHFFBBFFDFHHBBDBH@HBFBB@FCDALELGF@HBF@F@N@L@L@HBL@P@F@J@J@JBF@L@F@D@@@PIAAICIECAIEIECAICGEEAGCIECAGCCACAXAP@N@J@BEBC@ABGBE@GBEFBHBBCBCBAD@JALCPCDBFBJBFBLBHBDBB@B@D@FAD@DADCFA@@@AA@AAAA@@BAHEHEDCHHFDJHFDB@FDBBBB@@@@@@BB@@B@BBB@@@BB@@@B@@@@B@B@@@DF@BBB@@BBEDCBABCBCBCD@@CDKHMHGF@@BBJHDBCDIFGFJFNIHCFDKHIFDDLGJEBABB@@@@B@@AB@B@BA@@BA@@LBB@@BBB@B@@@BA@ABA@AB@@@B@@BB@B@@@B@@@BA@@BCBABABA@@BA@A@ABAB@@@BA@@@@BA@@BABABCBCDABA@C@EAE@CACAEAA@IDODEAICMEGDIHIFKJA@@@A@@@GFIHGDIHGFEDBBFBNFNFFBNDHDLFHBHIJMDCHKJKNQBAHILJFDLBDBPD@@B@B@DBD@BBB@@@KRABABC@ODGFIF@@PFBBB@BBBB@BLR@B@@CDCBADCFAD@@AHAHA@@BA@@@@DABAF@DAB@BABAB@@C@@@A@ADABAB@BBBBBF@BBDBDBDBDD@BBBAD@D@BB@@@@@ABCFWJA@GDEFILABQRKHCDKFGFOTMPFDB@@BB@HFFBB@DBD@F@B@B@B@D@DBB@BAB@@A@@@A@A@A@AB@@CB@BABA@@B@B@F@@@@BB@FBHBB@B@D@D@@@DBB@HADCDCLFFCJDDBHD@BPEB@"],encodeOffsets:[[128278,47775]]}},{type:"Feature",id:"231226",properties:{name:"绥棱县",cp:[127.114832,47.236015],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@GAIAC@A@A@@@A@C@E@A@@@@@A@A@A@GCA@A@C@C@C@A@A@ABC@@BA@@@A@@B@@ABA@E@KF@@A@@@CBC@CBCBG@IBI@E@ADCBABE@CA@@A@G@C@A@ADA@G@E@EBC@C@C@A@A@@@A@@@@@A@ABA@A@@@A@A@ABAB@@ABA@@@A@@@A@AAC@A@AB@@A@@BAB@B@@@BB@BBB@B@@@BAB@@@B@B@@BB@@B@B@B@@@BB@B@BA@AA@@AB@B@B@@BB@@B@BBB@B@@AB@BB@@BB@BBB@@B@B@D@BA@AB@B@B@@B@@@B@BA@AD@@@B@@BBDB@@@D@B@B@@@@B@@@BC@@BA@@B@BBB@B@B@@ABA@A@@A@@@A@@BA@@@@B@AA@@A@@@A@@@AB@BA@@@C@AB@@@B@@@BBBBB@@BB@@BBBADBB@@@@BA@AB@BAB@B@B@B@@@BBBA@@@AB@@CAA@@BA@AB@@BB@BBBBBAB@@ABA@C@AB@@@@@@DBBBBBBB@D@B@BBB@@B@D@B@EDCBA@C@GBK@QBK@IBA@@@A@A@G@I@K@K@M@C@AB@@AB@@@B@@@@@B@@@@@B@B@@AB@@@D@@@B@BA@@B@D@@@BAB@B@@@BAD@@@B@D@@AB@BM@MAM@M@MAM@E@A@@@AHABAH@DAB@BA@CAA@E@C@CCEAE@ABCBA@@A@AA@C@@@CBA@EAA@EBAAC@G@G@GBG@I@A@@EC@AA@@@@@@AAAAA@@@@@A@@@@@@@A@@@@@@@A@@@@@AA@@@@A@@@A@@@@@AD@F@@E@E@C@AAA@@A@@@AA@@@A@A@A@A@@@AA@@A@C@@@@@A@@@A@@@AA@@@@A@@@@@@@A@@@@@@@@@@@@B@@@@@@AA@@@@A@AA@@@@E@C@CBA@@@AAA@@B@@AB@@@@BB@@ABA@@BB@@B@@D@@@@BB@@B@@C@A@@@@B@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@BB@@A@@@@@@@@@A@@B@@@@A@@@@@@@AB@@@@@@@@B@@@@B@@@@@@B@@@@A@@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@B@@@@@@@@A@@@@@A@@@@@@B@@@@@@@@@@A@@@@@@@A@@B@@B@@@@@@@@@B@@@@B@@A@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@B@@B@@@@@@@@@@B@@B@@@@@@@@A@@@@B@@@@@@@B@@@@@@@@@B@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@BB@@@@@@@@@@@@@@@B@@B@@@@@A@@@@@@B@@@@@@@@A@@@@@A@@@@A@@@@@@A@@@@B@@@@@@@BB@@@@@@@@@B@@B@@@@B@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@B@A@@B@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@B@@@@A@@@@@@@A@@@@@@@@BB@@@@@@@@@@B@@@@B@@@@@@B@@@@BA@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@A@@@@@@@@@B@@@@B@@@@@@B@@@DB@@@B@@B@B@BAB@@@A@@@@@@@@@B@@@@@BA@@@@@@@B@@@@D@@B@@A@@B@@B@@A@@BB@@@B@@BA@@B@@B@@D@@@@@AB@BB@BA@@@@@BA@A@@@@BB@B@B@@BB@A@@@C@@@@@BB@B@@BB@@D@BBB@BB@@BA@@BBD@D@@BA@A@@BA@B@@@@BD@@@@B@@B@@@BA@@@@@B@@@BD@@B@@@@@BB@@BD@@@B@@AB@BA@@B@@@@B@@AB@@@@ABA@@@@@@@@B@@D@B@@@@@@@CB@@@@@@@B@@@B@@@B@@BB@B@@AB@D@@A@A@@@A@@@@@BBF@@B@@@@@@BBB@@@@@A@A@@@BB@@A@@BB@BB@@@@@@@@@@B@@@ABB@@@BA@@@@@B@@B@@@@B@@A@@@@@@B@@DA@B@@@@@@@@@B@BAB@@@BB@@@B@B@@@F@B@@BB@@B@@@@@@@B@BBB@B@@B@@@B@B@@@@@@B@@B@DA@B@@@BA@@B@@D@@@BBA@@@@@@BB@B@@@AA@@B@@@@@BB@@B@BBB@@BB@@A@AB@@@BB@B@@A@@B@@@@@@B@B@@@B@@BB@B@@@BBBB@@B@@BB@@@@@@AB@@@@B@@@@@@@BBB@@@BA@@@@@AA@@@@@@@@@B@@BB@@@@@@A@A@AA@@A@@BB@@B@@@B@@@@@BA@CB@@@@@BB@B@B@@@@B@@B@@@@AB@@@B@@B@@ABC@@@@@BB@@@@@BBA@B@@@@@B@@@B@@@B@@B@B@@BB@@A@BB@@@@BA@@B@@@@@B@@@@@@A@@@BB@BB@@@B@@@@A@@B@@@BD@@BB@@@AB@@@@@B@BB@@@@BBB@@@@B@B@@@B@@@B@@A@@B@BBB@@@@@B@@@BBA@@@@B@B@B@DB@@@BBB@@@B@@@BC@@B@@B@@BB@@BB@@BD@@B@@B@@@@B@@@@BB@@@@AB@@@@B@@@@@BA@@@@BBB@@B@@@BA@A@@B@@@@DB@@@@A@AB@@@@@@@@F@@@BB@@A@ABA@@@@BB@B@ABB@@BA@@@A@@@@@@@@@BB@@@@B@@@@@@B@B@@B@@AB@@@@@B@@@@@AB@B@@BB@@@@@A@@@@B@@@@B@BB@B@@@@@A@@B@@D@B@@@@@BA@@BB@@A@BB@@@BBBB@@A@@DBB@B@@@B@@@A@@@BB@@B@@@B@B@@@@@@@@@@B@@@BB@DB@BA@@@@@B@@B@@@@@B@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@AA@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@ABB@@@B@@AB@@@B@@@@B@@@@@@BBDA@B@@@@@B@@@@AB@@@BB@BBD@B@@B@@BAB@BB@@BBA@@@AB@@A@BB@@BBA@@B@@@@@BBB@@AB@B@BBB@@BBB@@B@@@@@BBBDBBB@@@B@@A@@@A@@@@@A@@B@@BB@@B@A@A@@@@@@BB@BB@@B@B@@@@@B@@@@@B@BBBBB@B@@BB@@BA@@BB@@@B@@@@B@B@@B@@A@A@@B@@@@@@B@BA@@BBB@D@@B@@A@@@@BB@@B@@@@B@@@BBBBB@@@B@@A@@BD@BB@B@B@@B@FBBB@@@BC@@@@@@B@@D@BB@@A@@B@@A@A@@@@BAB@BA@A@AB@@A@@@@@@DA@@B@@@@@BB@@@@BA@A@BB@@B@BB@@BB@@@@A@@@@BB@@B@@A@@@@@A@@B@@@BB@B@@@@B@@A@@@@@@BB@DA@B@@@B@@@@@@B@@@@B@BB@@@@@B@@@B@@@@@AB@@BB@@AB@@@DBB@@BBBBB@@@@@AB@@@@B@@B@B@@B@@BA@@@@@BB@@@@@@@BB@@@@B@@@@@@@@@B@@B@@@BB@@B@@@@@B@@@B@@@AB@@@@@BB@D@@@@@ABB@BB@@BB@@BB@@@BB@@@@@AB@@@B@BB@@@@BB@@@B@AB@@B@@B@@C@A@@@@@B@@@BBB@BB@@B@@@B@@@DBB@@@@@BBB@@BD@@@@@A@@@@@@BB@A@@@@@A@BB@@@@@@A@@B@@@@B@BB@@@@@B@@@@B@B@@@F@@@@BA@@@@@@BB@@B@@@@D@DBD@B@@@@BB@@@DA@@BBB@@B@@@@@BA@A@@B@@@@@@@@@B@@A@@BB@@@@@@B@@@@@BBABB@@@@@@@@@@BB@@@@@@A@@B@@@@B@@B@@B@@B@@A@A@@@@@@B@@B@@@B@@@BB@@A@@@@@A@A@@B@@@@DB@@D@B@BB@@@B@@@BB@@@@@@B@BB@@@@B@@B@B@AB@@B@@@B@@@@@B@@B@@@@B@@@F@@@@@@@@@@BA@@@@BB@@AB@@BB@@@AB@@B@@@BB@@@@@@@B@@B@B@@@A@@B@@@BB@@@@A@@BA@@@B@@@@BB@@@@B@@@B@B@@@@@@BA@A@@@@B@@B@B@@AB@@BB@@B@@B@@@B@@B@@@BB@B@@B@@A@A@@@@@@B@B@@@B@@@@@@B@B@@B@@B@B@@B@@@@BB@@@@BBB@@B@@@@A@A@B@@BBA@B@@@@@@@B@@@@B@@@@@@@B@@@@@@B@@@AB@@@@@@@@@BB@@@@@@@@@@A@@@@@@B@@@@@@B@@BA@@@@@@B@@@@B@@@@@BBA@@@@@@@@@@@@@@@@@@@@BB@@@B@@@@@A@@@@@@@@@@B@@@@@@B@@@B@@@@@B@@B@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@BB@@@@A@@@@@@@BB@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@BA@@@@@@@@@@@B@@@@@B@@@@@@AB@@@@@@@@@@@@@@@A@@A@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@B@@@@@@B@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@A@@@@@@B@@@@@@@@@BB@@@@@@@BBB@@@BB@@B@@BB@B@BB@@B@@@B@B@@@BBB@@@@@B@@B@BBB@@@@B@@B@@@@BB@@BB@@B@B@@@B@@@@BB@@@B@@BB@@@@BB@@@@@@B@@@@B@B@B@@BB@B@B@@@B@B@BB@@@@@BB@@B@@@@BB@@B@DBBBB@BBB@@BB@B@@BB@@B@@@@@@BB@@@@B@@B@@@@@@B@@BB@@@@@@@B@D@@@B@@B@@B@B@BB@@B@@BB@@BB@@@BB@@B@@B@@@@@@@BB@@B@@@@@BB@@@@@@B@@B@@BBBB@@BB@@@@B@@@@@@@@@BB@@@BB@BB@@@@B@@DBFDB@DD@@@D@BBBD@@@F@CDBDBBADADBBDBABAD@BDBD@FDDBFBBDFBBB@BCDBBB@@DFF@@BBDFBB@B@DBBDDDDBDB@F@D@HBB@H@FBD@F@FADAB@F@D@DBBBDFBDBDBBBB@@DH@B@B@@BB@@@DB@@B@@@BBB@B@@BB@@@B@B@@VNHBFPRJLB@@@@@B@@@@@B@@@@@B@@@B@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@A@@@@B@@@@@@B@@B@@@@@@@@@B@@@@@@@@@B@@B@@B@@AB@A@LAB@DAFCB@@@B@BBB@BDB@B@FABAD@FBDBFAD@BBDBD@BBD@L@D@D@DAB@H@L@D@B@BABCBAB@DAB@FBD@D@B@HBDBD@D@BAD@DBD@DAD@F@HBD@@BFBFBB@B@B@BBB@D@B@B@BDBBBBBBB@B@BBFDBBBDFFFBD@BB@@ADAF@BABADADAB@B@BKHCDGFAD@BBB@@@B@B@BAB@B@@@@@@B@@@@@B@@@B@B@@@BBB@@BB@@@@BB@B@@@BBB@@@B@BBB@@BB@BB@BB@@B@@@BA@@B@@@@@@B@BB@@B@B@B@B@B@BAB@B@@@B@B@@@DBB@B@@@B@@@B@BABAFABADCD@@AB@@@@BB@@@BBDB@@B@@@B@B@@@BA@@B@B@@@B@@@DAB@BA@@D@@@B@B@@@@@BA@@@A@@@A@C@@@@@AB@D@B@D@DA@@B@BB@@B@B@BB@@B@BB@@@@B@B@FAD@R@BBB@@@BBBBBBDBFFDDFDBBBBBBBB@BAD@HBH@BFD@B@@BBBD@B@@@B@BCBABFBB@@@@F@@CF@@@BBB@BAB@@BBBB@@@B@BABEBA@A@CAA@AAAACAGAAAC@G@A@A@AB@@AD@B@@C@EBC@ABA@CACAC@A@A@E@CBAD@@@BA@CAA@CBA@A@EB@@AB@@FDDB@BFF@B@BBBBBB@@DDDBDBDB@L@D@HBDBBB@@DBDDB@@B@@BDB@@@DDJBD@B@BBDBD@DBHHHDDBDB@BB@BBB@BBDBB@@B@@@D@B@B@B@B@@AB@@AB@@BB@@@BB@@BAB@@@@AB@@@@@@@B@@@@@@@B@@AB@@@B@BB@@@BBD@B@DBB@B@D@B@B@@@B@@@@BB@@@@@B@@@@@@@B@@@@@BB@@B@B@B@B@BBB@BBB@@BBBB@@B@BB@@@BB@@BBB@@BB@BBBB@@@B@@BB@@B@@BB@B@B@@@@BB@@B@@@@@BB@@@BB@@@BB@AB@@@@BB@@@@B@@@D@B@B@B@@@BB@@@B@@@@@B@@A@@BAB@@@BA@AB@@ABA@@B@@@@AB@@@B@@A@@B@@@B@@@@@B@@@B@@A@@B@@BB@@@B@BABB@@@@B@BB@@B@B@@@BA@AB@B@@AB@@@BBB@@@B@@B@BB@@B@DBB@@BD@BBB@B@@B@@@B@B@@@@@BB@@@@@@B@@BB@@BBB@@B@@@@@@B@@@@@AB@@@@@@A@@@@@A@@B@@@B@@@B@@@B@B@@@@BBB@B@@@B@B@B@@@B@BB@@@@@@B@@@@@B@@@@@@@@@B@@B@@@@B@@@B@DBB@@@@@@@B@@BB@@@B@@@@@B@BBB@@@B@B@@BB@B@@@B@BBB@B@@@B@B@BB@@B@@@BB@@BB@BB@@B@B@B@@AB@@@@@@CB@@ABAB@@@B@@@@@D@B@@@B@B@@@B@@@BB@@BB@@BB@B@B@B@B@B@@@B@D@BBB@BA@@B@BAB@@@B@@@BB@@@B@@@B@B@@@@@B@B@@@@@BBB@BB@@BB@BBB@BBB@B@BAB@D@B@BAB@BAB@B@@BB@BBBBB@@@DB@BB@B@BB@@@@@B@@A@@BCBAD@@AB@@@B@B@@B@@BB@@@B@DB@@B@@@BBB@@@B@DBB@BB@@@B@@A@@BA@A@@@A@@BA@@@A@@@AB@BA@@@@B@@@BAB@@@B@@@B@B@B@@B@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@BA@@B@@AB@@@@AB@@AB@@ABAB@BAB@@A@@BABA@@@@BA@@@AB@@ABA@ABA@A@@B@@@B@@@@@B@@@B@@@@@@BB@A@@B@@@B@@BBB@@@@BB@@BBBBB@BBB@BB@@@BB@@BB@@B@@@@@@@B@@@@@B@@A@@B@B@@AB@@A@@BA@ABAB@@A@AB@@@BAB@B@@@B@B@@A@@B@@A@AB@@@BA@@@@B@@@B@@@@@@A@A@A@E@E@C@A@ABA@@@AB@B@B@BBB@BB@BBB@B@BB@@BB@@@B@B@B@B@B@@@@AB@@@BA@@B@B@@@BB@@BB@BBB@BBB@@@BB@@BBA@@BB@@BB@BB@@@BBBB@@B@BB@BB@@D@@@D@BBD@DBB@B@B@BBB@BBBB@B@@@BB@@@D@B@D@@@BBB@BBB@BBDBBBB@B@B@B@B@DBB@B@@@BBBBB@@@BBDB@@B@B@BBD@BBB@B@B@B@@@B@@B@@BBB@BBB@BBB@B@D@BBB@B@B@@@B@B@@@B@B@BBDB@BBBB@BBB@BB@@B@BAD@DAB@B@B@@@BBD@BBBBDBBB@@BB@BBB@@BBB@@@BB@AB@B@@@B@B@@@BA@@BA@A@CA@B@@A@A@@BA@A@@@@BAB@BAFAFADABABABADA@ABA@AB@@AB@B@BAB@@@D@BAB@B@B@@BB@@@B@BBB@B@BBD@B@B@DAB@@@BABABABA@@B@@@BAB@@@FBB@B@B@D@D@B@DAB@B@@BB@@@BB@@BB@BBB@B@@@@AB@@@@@B@@B@@@B@D@@@D@BADAB@BADCB@@@DABAB@BAB@@AB@B@@@B@@A@@BA@@@A@AB@@A@@B@@@DAD@B@D@B@BA@@B@@AB@@AB@B@@@BBD@B@BBB@BB@@BB@@@B@@AB@BAB@B@B@B@B@B@@@BB@@BBBBBBBB@BB@@BBD@BBBBB@DBBBB@@@B@@B@@B@@@B@F@B@BADABAB@BA@@BAB@BAD@BAD@@@BADABAFADAB@B@B@@@B@@@B@@@@A@@B@B@@@BAB@B@BAB@B@B@B@B@B@@ABA@A@@BAB@@AB@@A@A@A@AAA@A@@@@@ABABABA@@BA@@B@BBD@BBD@BBD@@@BAB@B@BA@A@@@A@@AAAA@AA@AA@@A@A@A@AA@@A@CAAACACAACCCAAA@@AACAA@A@@@A@A@ABA@@BABA@@@@@AAA@A@@BA@AB@@AB@BAB@BA@A@AB@@A@AAA@A@@@@@AA@A@AA@A@AA@@AA@AAA@@A@@@A@C@A@ABA@@@A@@BABABABABAB@@A@@BABA@@@@BA@@@A@@AA@@ACAACCAAAA@A@@@A@@@AB@@AB@@A@@@A@@@AA@@A@@B@@A@@@@BAB@BA@@@AB@@CAC@A@AAA@@@A@C@@@C@A@@@A@A@@@A@@A@@@AA@@AA@@@A@A@A@@@AB@BA@AB@@@BA@A@@@A@A@A@@A@@AB@@@@@@@@@BB@@B@B@D@B@B@@@@BB@B@@BB@@BBB@@BBB@BBB@BBB@B@@@BBB@@@@B@@B@@AB@BAB@BAB@BAB@@@B@@@B@B@BAB@@@B@@@DB@@B@B@@@B@B@D@B@D@D@B@@@B@B@B@@@B@B@@@B@DADAB@@@B@B@B@B@@@BA@@@@BAB@@@BABAB@@@@AB@@AB@@@@A@@@AB@@@@A@@BAB@@@BAB@@@@@B@@@AA@@@AB@@@@@BABABADCDABABAB@BA@AB@@@B@@@B@B@@AB@BAB@@@@@@@B@@@@@@@B@@BB@@@D@B@B@BBB@@@B@B@@@B@@@B@@@B@BA@@@@DBB@F@BBD@B@DBDB@@@@B@@@B@BABAD@BC@@B@@AB@BA@@B@@@B@@@B@@@@@@@B@@@@@@@@@B@@AB@BABABA@@@@BA@@@@BA@@@@B@BAB@@@@@B@B@@@B@@AB@@@B@@@BA@@B@@@@@BA@@@@@AB@@A@@@ABA@C@@@@@A@@B@@@@AD@@@BAB@BAB@BAD@BABAB@@A@A@A@@@A@@BA@@@@BA@@B@BAB@B@@@@@B@@@B@@@@A@@@@A@@@A@@AA@@A@@@@@AA@@A@@@AB@@@@A@@BA@@@@@A@@B@@A@@BABA@@@@BA@@@A@@@@@AA@@A@@@@@@@@@@@@@A@@@A@@@A@@@A@@@@BA@@DABAD@@@B@@A@@B@@A@@B@@A@@@A@@@@@A@@B@@@@A@@@@@@@@@@@A@@@AA@@@@@@AB@@A@@A@@@@@@A@@A@@A@@@@B@@@@AB@@A@@@AB@@@@A@@@@@@@AA@@@AA@@AA@@A@@@AAA@@@@@@A@@@@@A@@@@AA@@@@@@@A@@@@AA@@@A@@A@@A@@@A@@@A@@@@@A@@@@@@@AB@@@@AB@@@@AB@@AB@@@@@@A@@@@@@@AB@@A@A@A@@@A@@@A@A@@A@BA@@@A@@BAA@@A@@AAA@@@A@@@C@@@A@A@@@@@ABAB@@ABA@AB@BA@ABAB@@@B@BA@@@ABA@ABA@A@AB@@ABABABA@A@A@E@C@@@A@C@AAA@@@A@C@A@C@A@C@A@CB@AC@A@CAA@@AA@@@@@@A@@A@CAAACA@@A@@AAA@@AA@A@AAA@@@A@@AABA@@A@@A@@AAA@AAAA@AAA@@@@@A@@BAB@@AB@@@BA@CB@@A@@@@@A@@AA@A@@@A@@B@@ABA@A@@BA@A@@@A@A@A@A@@@AAAA@AA@@A@AACAA@AAA@AEAA@AA@@A@@A@AA@@AAAA@@AAAC@@@AAA@A@AAC@@@A@@@A@A@A@A@A@@@CB@@@@A@A@@AA@A@@@@@A@A@ABA@A@@BAA@@C@A@@@A@@@@@@@@@BB@@BB@BBB@DBB@B@@@B@B@B@DABAB@@@BA@@BAB@BAB@@@B@@AB@B@DAFAFADAHAB@B@BAB@B@B@B@B@BA@@B@@AB@BA@AB@BADA@@BA@@B@@ABABA@ABA@A@C@A@@@ABA@@@AB@@A@AAA@A@@@AB@@ABA@@@@@A@A@@@AAA@@A@@AA@A@A@AAA@A@@@C@@AA@A@A@AAA@A@AAAAA@@AA@ACAA@@AC@@@A@AA@@AA@AAA@AA@@AA@@@A@C@A@A@@@ABA@A@@B@@@@@@@@A@@@@@@A@@@AAA@AAA@AAAAAA@@@A@@@@BA@AB@@A@@@@@@@A@@AAA@@A@A@@BA@ABAB@BAB@BAB@B@@A@@B@A@@@C@E@A@A@@@GAAAA@A@A@@@A@@@@AA@@@AAAACC@AA@@A@@@AA@@A@A@A@@@A@@@ABA@@B@@AB@@A@@AA@@@A@A@@@AB@@A@@AA@A@@@A@@@@@AB@@@B@@AB@@A@@@A@A@A@@B@@ABAB@DADCDAB@BAB@@@B@@A@@A@@@@AA@ACA@ACAA@@AA@AAA@A@@@@@A@ABA@ABC@@@ABA@@BAB@BA@@@@@A@ABA@A@ABA@AB@DCBA@@@@@@@AA@@@AAA@A@A@A@A@@@A@A@A@A@A@@@A@A@A@AAA@@@A@AA@@A@@@A@@@A@A@CBA@AA@@A@@@A@@A@@AA@@@@AA@@AAA@@A@@@A@AAA@@AA@AA@AAA@@@A@@A@@A@A@AAAAA@AAA@@AA@@AA@@AA@C@A@@@A@A@CBA@CBA@@@ABA@A@@@A@A@AA@@@@A@@BA@@@A@@B@@AB@@@B@@A@@BA@AB@@A@A@@BA@@B@BAB@@@B@BAB@@@@@BA@@BA@@@A@@@A@A@CBA@@@A@A@@BA@@@@@A@@@@@@@@B@@AB@@@BA@@B@B@@@B@@@@@@ABA@A@A@@@A@C@AA@@A@A@CAA@A@@@AB@@AB@B@B@@@@@@A@@@@@@@A@@@@AAAA@AAAAAAA@@AA@@A@AA@@A@@AA@@@A@@@AA@@@@@@A@@@@@A@A@A@@AA@A@@A@@A@@A@@@A@A@@A@@A@@A@AAA@@A@@@AAC@@@C@ABA@@@A@A@A@@@A@AAA@@A@CAA@A@A@@@AAA@@AA@@@A@A@A@@@A@@@@AA@@A@A@@@AAC@A@A@@@AAA@AAA@AAAAA@AA@@AA@@A@A@@@A@A@@@ABA@A@A@@@A@A@A@ABA@A@ABA@@@A@C@AA@@A@@@A@CAA@A@AAA@@@A@A@@@ABA@CB@@AB@@A@A@A@CBA@A@@@A@A@AAA@C@AA@@A@@AA@AAA@A@AAA@@@A@A@A@A@C@@@A@@@@@A@@AA@AAA@@@@A@@@@@A@@@A@@@A@@@AA@@AA@@AA@@A@@@@@@@A@@@@B@@A@@@@@@A@@@@@A@@BC@A@ABA@@@A@@@A@AA@@CAAA@@CAA@A@A@AA@@A@A@@@A@@@A@A@@BA@A@A@ABA@A@@@A@@@@@AB@BA@@B@@@@A@A@A@A@A@@AC@A@@@CAA@CAA@A@AA@@ABA@@@@@A@@@ABABA@ABA@ABA@A@A@CBAB@@A@CBCBA@ABABA@@@A@A@A@@@A@AAA@AA@@A@A@E@A@A@CBA@@@A@@@A@AA@@@@@A@@@@BA@@@@@A@@@A@@@@@A@@B@@AB@@@@A@@@@AA@AA@AAAA@@AA@@@A@@AA@@AAAAA@@AA@A@A@@@@@@@@@@A@A@@@@AA@AA@AACAA@@AAAA@@AA@@@@A@@@A@A@A@ABA@@@@@A@@@@@A@@AAAAA@@AA@@A@AAA@@@@@@A@@BA@CAC@C@@@A@A@@@@@@AA@@AAA@A@@@A@@@ABA@ABAB@@A@@@A@@A@@A@@AA@@@A@@@@AAA@AAA@@@A@C@A@@AA@AA@@A@@A@@@@A@@A@@@@@@@A@@A@@@@@A@@AA@@@A@ABA@@@A@@@@@@A@@A@@@B@@@@@@A@@AA@@@@AA@@@@@A@@@@BADABA@@BABABABA@@@@@A@@AAA@A@@AA@A@A@AAA@@@A@A@A@@@ABA@C@A@AAA@A@A@@@@AA@@@@@@AB@@@@@@@B@BA@@B@BA@@B@@@@A@@AAA@@A@@A@AAAAAACACCCAAAAAAAAA@C@@@@AA@@@@BA@@@AB@@AB@@@BA@@B@@A@@B@@A@@@AA@@@@A@@A@@@@@A@@@A@@A@@AA@A@@BA@@AA@@A@@A@A@@@A@@@A@@AAA@AA@@@AA@@B@BA@@BABA@@BA@CB@BA@CBA@A@A@A@A@@@@A@@@A@@@@@AA@@@@@AA@A@A@@@A@@AA@A@AAA@@A@AA@@@@@@A@@@A@A@A@A@@B@@A@@@@BAB@@@@ABA@A@A@AAA@@@ABA@C@@@A@A@A@@@A@A@@@@B@@AB@@@BA@@BB@@@@BBB@B@B@@A@@BA@@@@@A@@@A@@@@@A@@@@@A@@@@@@@@@A@AAA@@@A@@@A@A@@@@@A@@@A@@@AA@@@@AA@@@@@@@@AA@@C@@@A@AAA@C@A@A@A@@@A@C@@BA@@@@@A@@@@B@@ABA@AB@@A@A@AB@@ABA@@B@@@@AB@BAB@@@@AB@@A@CBGBC@A@CB@@A@@@@@A@AA@@AAA@@@@@@A@@AA@@@@AAA@C@A@A@@AA@@@AA@@AA@@AA@@@@AAA@@@@A@@@AB@@A@AAA@A@@AA@@@A@AA@@@A@AAA@A@@@A@@A@@@@@A@A@@@@@@A@@@@@A@@@@@A@ABA@@@@@A@@@@@@@@A@@@@@A@A@@BA@A@@BA@@@@AA@@@A@@@@@A@@@A@@@@A@@A@@A@@@@@A@@@@A@AA@AA@@@@@@A@@@@@A@@@@@A@@@A@@@@@A@A@@AA@AA@@@@A@@@@@A@A@@A@@A@@@A@@@AAA@A@CAA@AAA@A@@@A@C@A@A@@@A@A@@BA@A@CB@@CBCBA@A@@@C@A@C@C@A@A@GCCAC@E@AAC@CBA@E@C@A@A@@@A@A@@@A@@@A@AAA@@B@@A@@@AAA@A@A@C@A@A@@@A@@@A@@@A@A@@@AA@@@@@@@A@@A@@AA@@@@A@@@@A@@@A@@AAA@@@A@@@@@@B@@@@@@A@@@@@@@@@@@@AA@@@@@AA@@@A@@@@@A@@@A@@@@@@@A@@@A@@@@@@A@@@@A@@@@@@@@@A@@@A@A@@@@A@@@@A@@@A@@AA@@@A@@A@@@@B@@@@@AA@@@@@A@@A@@@@A@@@@AA@@@A@@@@@A@@@@@@@@@@@A@@@@@@@AA@@@@@A@@@@@@@@@@A@@@@@@@A@@@@AA@@A@@@@AB@@@A@@@@@AA@@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@A@@@@@A@A@@@@@A@@@A@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@@@@@@@AB@@@@@@@@@@AA@@@@@@@B@@@@@@@@A@@@@@@@@A@@@A@@@@@A@@@@@@@AA@@@@@@@@AA@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@AA@@@@@@AA@@@@@@@@@@AA@@A@@@AA@@A@@@@BA@@@@@@@@A@@A@@@@@@@@@@A@@@@@@@@@@A@@@@A@@@@@@@B@@@@A@@@@A@@@@@@A@@@@A@@@@@@@A@@@@AB@@@@@@A@@@@@@@@BAA@@@@A@@@@@AA@@@A@@@@@A@@A@@@@@@@@A@@@@@@AA@@@@@@@A@@@@A@@@@@@@@A@@@@A@@@@A@@@@@A@@@@@A@@@A@@A@@@@@@@@@@B@@@@@@AA@@@@@A@@@@@@@B@@AA@@@@@@@A@@@@AB@@@@A@@@@@@A@@@A@@@@@B@@A@@@@@@@@A@@A@@@@@@A@@@A@@@@@@@@A@@@@A@@@@@@@@@A@@@@@@@@@A@@A@@@@A@@@@@@@AA@@@@@@A@@@@@@@@A@@A@@@@AA@@@@BA@@@@A@@@@A@@@A@@@@A@@@@@@@@@A@@A@@@@@@@@@@A@@@@A@@@@@@@@@@AA@@@@@A@@@@@@A@@@@A@@@@B@@@@@@A@@@@@A@@@@@@AA@@@@@@@@@@@@BB@A@@@@@@@@@@@AA@@@@@A@@A@@A@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@B@@AA@@@@A@@A@@@@@@ABB@@@@@A@@@@@@AA@@@@A@@AB@@@@@A@@@@@B@@A@@A@@@@A@@@A@@@@A@@@@@@@@A@@@@A@@@@A@@@@BA@@@@@A@@@AA@AA@A@A@AA@@@@@@AB@@@AAA@@@@@@@@@@A@@A@@A@@@@@@@@@A@@@@@A@@@A@@@@@A@AA@@B@@@A@@A@@@@@AA@@A@@@A@@@@@B@@@CA@@A@@@A@@A@@@@@A@@A@AAA@@@@@A@@B@AA@@@@AB@@@A@@A@@@@@A@A@@AA@@B@@A@A@@@@@@@AB@@@@AA@B@B@@@@AA@@@BA@@@AAA@@@BA@@A@@B@@@@@AAA@@@@@@AAA@@@@A@@AA@@@@A@@@@@AA@@@@A@@@@AAAB@AA@@@A@@@A@@@@@C@@@@@@AA@@@A@@@AA@@@AA@@@BA@@A@@AA@AA@@B@@@@@@AA@A@@@@AA@@A@@B@@A@@@@@@@AA@@@@AA@@@A@@@@AAA@@@@@AB@@@@@@AA@AA@@@@@A@@@A@@AAB@@@AAA@B@@A@@@@AA@@B@AA@@@BA@@@AA@@A@AAB@@@B@AA@AA@@AAAA@AA@@AB@@@A@@@AA@@@@@@@@@AAA@@A@@@A@@@@@C@@@AC@@@A@@A@@A@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@AA@@@@@@@@A@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@A@@@@@@B@@@@@@@@@AA@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@@@@A@@@@@@@@@@A@@B@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@A@@@@A@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@B@@@@@@@@B@@A@@B@@A@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@A@@@@@A@@@@A@@@@A@@@@AA@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@B@@@@@@A@@@@@@@@A@@A@@@A@@@@@@A@@A@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@A@@@@@A@@A@@@@@@A@@A@@@@@@@@@@@@@A@@@@A@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@AA@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@A@@@@A@@A@@A@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@AA@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@A@@@@A@@A@@@@@A@@@@@@@@@A@@@@A@@@@B@@@@@A@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@A@@@@A@@@@@@@@@@@@A@@A@@@@@@@@@@@A@@@@A@@@@@@@@A@@@@B@@@@@@@@AA@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@B@@@@@@A@@@@@AA@@@@@@A@@A@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@AA@@@@B@@@@A@@@@@@@@@A@@@@A@@@@@@@@@@A@@@@B@@@@@@AA@@@@@@AA@@@@@@@@@@@@@BA@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@B@A@@@@A@@@AA@@@@@@A@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@A@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@A@@@@@@@@@@A@@@@@@@A@@A@@@@@@@@A@@@@AA@@@@@@@A@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@A@@@@A@@B@@@@@@@@@A@@@@A@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@A@@A@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@B@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@AB@A@@@A@A@@@@A@@@@A@@B@@@@EC@@@B@@A@@CAA@@A@@@A@@@@AA@@@@A@@@@A@@@AAAAEA@@@@@A@@@@@A@AA@@@@AA@@@@@BA@@A@@A@@B@@@@A@@AA@@@A@@A@@AA@@@@A@@AA@@A@@@@@@A@@@@A@@A@@ABAA@BA@@@AA@A@@CB@@@A@@@@@A@A@A@@B@@@@@@A@AAA@@@@@@@BA@@@@A@@@A@@A@@B@@AAB@@@AAAAAA@@AA@@CA@AAA@@A@@@AAAAA@@A@@@A@@@BA@@AAA@@@@@BA@@@@@A@@AA@@A@@B@@@C@@A@@B@B@@@AA@@@@@@@A@@@@A@@@@@@@A@@@AA@@@@A@@B@@CA@@AA@A@@@AA@@@@@A@AA@@BA@@CA@@@AA@@@@AA@@A@@A@@@@A@@AAC@@BA@@@@C@@@@AA@@A@A@@A@@BAA@@@@@@A@@@@@@@AA@@AAAA@C@@AA@@@@AAA@@BAAAB@@@AAC@AAA@@@AA@@@@@AA@A@AA@@@@@ACB@@AA@A@ACA@@@@@@AA@@@@@@AA@@@@B@@@@A@@B@@@@@@A@@@@@AA@@@@@B@@@@@B@@A@@@@@@@@@@@@A@@@@AA@@@B@@AA@A@A@@@A@A@A@@@@A@@B@@@@@@@AA@@@@BA@@@@@@AA@@@@@@A@@A@@A@@@@C@A@@AA@@A@@ABA@@@A@@AA@@G@C@CBE@C@GDA@IC@@@ADCECA@C@AA@A@A@AGC@@DG@@@AC@CAEAA@@A@@@A@@"],encodeOffsets:[[130392,48180]]}},{type:"Feature",id:"231224",properties:{name:"庆安县",cp:[127.507824,46.880102],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@@@@B@@@@@@@@B@@@@@@@@B@@@B@@A@@BB@@@@B@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@A@@@@B@@BB@@@@@@@@@@@@@B@@@B@@@@A@@@@@@@@B@@@@A@BB@@@@@@@@@@@@@BB@@@B@@@@@BB@@@BA@@@@@@B@@@B@@A@@@BB@@@@A@@@@@@B@@@@@@A@@@@@@@AB@@@B@B@@@BA@@@@B@@A@@@@@A@@@@@@@A@@@A@@@CBAB@@AB@@@@@B@@@@@B@@@B@B@@@@@B@@@@A@@@@@@@@BA@@@@BA@@B@@@@A@@BA@@@@BA@@@@B@B@B@@@@@B@@A@@AAB@@@@@@@@@B@@@BA@@B@@@B@@A@ABA@@@@@AB@@AB@@@BA@@@@B@@@@@B@@@B@@@BA@@@@@A@@@A@@@A@@@@@@AA@@@@@A@@BA@@@@@@@@@@BA@@@A@@@@@ABA@@@A@@BA@@@@@A@@@A@@@A@A@ABA@@@A@@BA@@BA@@@AB@@ABAB@@A@AB@@A@@@AB@@@@AB@@@B@@@@AB@@@@BB@@@@AB@@@@@@@@@@A@@@@@@BA@@@@@A@@@@@AB@@@@@@A@@@@BA@A@A@A@@@A@@@A@@@A@A@@@AA@@A@A@A@A@@@@@A@@@@@@A@@@@A@@@@@A@@@@@@@@B@@A@@@@@AB@@@@@@@@@B@@@@AA@@A@@@@@@@@B@@@@@@A@@@@@@B@@@@@@A@@@@@A@@@@@A@@@@@@A@@@@A@@@@@@@@@A@@@@@@@@B@@@@@@@B@@@@A@@@A@@@@@@@@B@@@@BB@@@@@@AB@@A@@B@@@@@BB@@@@@@BA@@@@@@BA@@@@@AB@@A@@@@@@B@@B@@@AB@@@@@@A@@@B@@@@@A@@B@@AB@@A@@@@@A@@B@@A@@@A@@@@@@@@@@@@B@@@BA@@@@@A@@@@@@@@@@@@@@BA@@@B@@@A@@@@@@@BB@@@@@@@BA@@@@@A@@@A@@@@@@@@BA@@@A@AB@@A@@@A@@@@AA@@@@@A@@@A@@@@@@@ABA@@@@@A@@@@@A@@@A@@@A@@@AB@@A@@@@@@@@B@@AA@@@@@B@@A@@@@@@@A@@@A@@@@@A@@@@@A@@@@@@@A@BA@@@@@@@@@@@A@@A@@@@@A@@@A@@@@@@@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@@@@@A@@A@@@@A@@@@@@@A@@B@@@A@@@@A@@@@@@@A@@@@@@@@B@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@AB@@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@B@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@A@@@@@@A@@B@@@@@@@AA@@@@@@@@B@@@@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@ABA@@@A@A@@@A@@@AA@@@@A@@@@@BB@@A@@@@@@@A@@@@A@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@BAA@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@B@@@@@@AB@@@@@@A@@@@A@@@@A@@@@@@@@A@@@@@@@@@@ABA@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@A@@@@@@@@@@@@@A@@@@@@@@@@A@@@A@@@@@@@@@@@@@A@@@@@@@A@BB@B@B@FABBB@B@D@@@B@D@@B@@BAD@@D@F@@BBB@BBB@BBB@@BADADCDBA@CDAB@BADABDB@@@BA@@D@DA@@BBD@D@@BB@@A@@@A@@AAA@AAA@A@@C@AEKB@D@FBDE@C@@B@@@BAB@@@BA@DBBBB@D@@BB@B@B@@B@@@BA@@@@@@@ABC@@BA@@FAB@@AD@B@B@@CD@B@BA@CDA@A@A@A@C@@CAA@CAA@ACA@AAAA@@@ABA@C@E@C@@@GDADCB@@B@JDB@@BBB@@@BA@@@A@@@@@A@@B@@@@AB@@B@@@B@@@B@@@@@B@@@@BB@@D@DBB@BBBDBBBDB@@@BABABEB@B@@@@@BA@@DAB@BB@B@D@B@B@@@DBBBB@B@BBD@FBD@D@@B@@ABABA@AB@@A@@@A@@@@B@@A@@@@B@@A@@@@@AB@@@@@B@@@B@@A@@@@BA@@@A@@@@@@BA@@@A@A@@B@@AB@@AB@BA@@@A@A@@@@@AB@@@@@@ABA@AD@@CB@B@@A@@B@@@@@@@B@@A@CDADA@@@A@A@AB@BABB@@B@B@@B@ABA@A@ABA@@@@BA@AB@@AB@@@BA@@B@BA@@@@@@B@@@BA@@B@@@B@B@@@@AB@@@B@@AB@@AB@@@@AB@@@@@@A@@DB@BBBBB@@BADBBABAJAHA@@@@BAB@BABAD@@AD@B@@A@@BC@@BIDCBKDCBCB@BAB@@@B@@@@@B@@A@@@@@@@@B@@BB@B@@@@@BA@@@@BA@A@@@@B@@@@@BA@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@B@@B@@@@@@@@@@@@A@@@@@@@@@@@@BB@@@@@@@A@@@A@A@@@@@@@@@@@@@A@@BA@@BA@@@ABCBAB@BA@ABCBA@@@@B@B@@@BA@@BB@@@@@B@@@@B@@@@@@@@BB@BB@BBB@BBD@D@DADAFAB@F@FBDBFBHDD@@BB@@D@BBB@BBBB@DBD@F@DAB@BBB@BB@D@D@BBB@@DBFAF@BBB@@B@B@B@BBBBBB@B@@@BA@@B@BB@@@@BBBB@@@BB@@@@B@@BB@B@@@B@@B@@BB@@BB@@@@@B@@@@AB@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@BB@@@@@@B@@@@@@@@@A@@@@@A@@@@@@@@B@@@@B@@@B@@@@@@@@@@@@@@@@A@@@@B@@@@B@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@A@@B@@@@@@@@@@A@@@@@@@@@@B@@@@@@AB@@@@@@@@@@@@A@@AB@@@@@@@A@@@@@A@@@@@@@A@@@@@@@B@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@AA@@@@A@@@@@@@@@@@@B@@B@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@B@@@@@@@@B@@@@@@@@@A@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@AA@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@@B@@B@@@@@@A@@@@B@@B@@@@@@@@@@B@@@@@B@@@@A@@B@B@@A@@@@@FB@B@@@B@@@@B@@BB@@@AB@@@BA@BB@B@@B@@A@@@@@BCB@B@@@@BB@@C@@@@@@BB@B@@BBB@@B@B@@@ABBB@@DB@@@B@@@B@@B@@ABBB@@B@@B@@ABB@@@@@BAA@@@@@B@@@B@@@@BB@@@@AB@@B@BBABB@@@@B@@BBA@@@A@BB@@ABB@@@@B@@@@B@@@AB@@@@@DB@AB@@B@@@@@@@A@BBA@@@@@AAA@@BB@A@@@@@A@@@A@@@@@@B@@@@@@AA@@@@AB@@@B@@@B@@@@@B@@@@@@B@B@@@BB@@@A@@BB@@B@@B@@@@@B@@@@A@A@@@@@B@@@@@@B@@@B@@B@@B@@@@BA@@@@BB@@B@@@B@@AB@@BB@@@@@@@A@A@@@@B@@@BD@@@@B@@@@B@@@B@@A@@@@B@@BB@@@BB@@DB@@D@@B@@BB@B@@@B@@@@AA@B@@@@@@BB@@@@BBAB@@B@@B@@A@@@@@@B@@A@@@@BB@@@@@@@A@@@@B@@@B@@A@@BB@@@@@@@BB@@@B@@@@@@AB@@@AA@@@@@@B@@D@@B@@@B@B@@B@B@@@@BB@@B@@@B@@@@B@B@BB@@AB@@@BA@B@@@B@@@@B@@B@@@AB@BB@@@B@@@B@@BA@@@@B@@@@@B@@BA@@@@B@B@@B@@@@@@@@@BB@@@@B@@@@@@ABB@@BA@B@@@@BA@@@@@BB@@@@@BBA@B@@AB@@B@@@A@@@BB@@@@BB@@@@@BB@ABB@@B@B@BA@@B@@A@@@B@@B@@@B@@@@BB@@BD@@@BBB@@BBAB@@@BAB@@@B@B@BBB@B@@@@@B@@@B@@B@FB@B@@B@AB@BB@B@@@BA@BB@D@@@B@B@BBB@B@@@@@@B@@@B@@B@@@B@B@@@@@B@@BB@@BB@@B@@@@@B@B@@@@@B@@@BBA@B@@BBB@B@@BB@@@@@AB@@B@B@@@AB@@@@@@@B@@@@B@BB@@B@B@@@@@B@@BA@@@@@B@@B@@A@A@@@BB@@B@A@A@@BCB@@@@BB@B@@A@@@AB@@@B@@@@@@BB@@AB@@@@B@@@@B@B@@@BA@@@A@@@@@BB@@@B@@AB@BB@@@BB@@@B@D@@B@@@@BB@@@@@@@BB@@@@@@AB@@@@BB@@@@@@A@@@@B@@B@B@B@@@B@B@B@@BA@@@B@@B@@B@@@@@@@@@@@@@@BA@@@@@A@@@@@B@@B@@@@@B@@@@A@@@@B@@A@@@@@BB@@@@@@BB@@@@@@DB@B@BBB@@DA@B@@@@BBB@B@@B@@@@BB@@B@BBD@BB@@A@BBAB@@BB@B@@B@@BD@B@BB@BB@@B@@@@@@@B@@@@B@AB@@@BB@B@@@BB@@@@@D@@B@@AD@BB@@@B@@B@@@@BB@@B@@B@@B@@DB@@AB@@BBB@@@@@B@@B@@@BBB@@DB@@@AB@@@@@BB@@B@@@@@@@B@@@@@@B@@@@@@BB@@A@A@@@@BD@@@A@@@@BB@@BB@@@@@B@@A@@@@BB@BB@@A@@@B@@@BB@BBBB@@B@@@BB@BDB@@BB@@BBBBBB@@A@BB@@@AB@@@@B@@@B@@B@@A@@@@@@BB@B@B@@@@A@@@@B@B@B@@@@@B@@DA@@@BBB@@B@@ABBBA@@@BB@@@@@@B@@@@B@@@BB@@@B@@B@@BB@@@@B@@BB@@@B@@A@@@@BB@@@AB@@@@B@@B@@B@@B@B@@@@@B@@@@FBBBBB@@B@@@@@@B@@B@@B@@B@@@B@@@BB@DB@@@@A@@FD@@@@@AB@@@@@@B@@B@B@@@B@A@@BB@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@A@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@B@@B@@@@@@@@@A@@@@B@@B@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@B@@@@@@BB@@@@@B@@@@@@B@@@@B@@@@@@@B@@@@@@@@B@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@BB@@@@@@@BB@@B@@@@@@B@A@@@@@@@@@@@@@@B@@@@@@@@@@B@@B@@A@@@@@@@@@@@@BB@@@@@@BB@@@@@@@A@@B@@@@@@@@@@@@B@@B@@@@@@@@@B@@@@@@A@@B@@B@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@B@@@@B@@@@B@@B@@@@@B@@@@A@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@BB@@@@@@@@@A@@B@@@@@@@@@@B@@B@@@@@@@@@@@B@@@@B@@@@@@@@@@B@@@@@@BB@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@B@@@@@A@@@@@@B@@B@@@@@@@@@B@@@@@B@@@@B@@B@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@@@B@@@@B@@@@B@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@BB@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@BB@@@@B@@B@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@BB@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@BB@@@@@B@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@BB@@@@@@@@B@@@BB@@@@@@@@@@B@@@@A@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@BB@@@@@B@@B@@@@@@B@@@@@B@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@BA@@@@BA@@@@@@@@@@A@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@B@@@@@@B@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@A@@@@B@@@@@@@@B@@@@@@@@@B@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@B@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@B@@@@@@@@@A@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@B@@@@@@B@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@B@@@@BB@@@D@@B@@@D@@@@@B@@@BB@BB@@@@@@@@B@@B@@@B@@BA@@BBB@BB@BB@@BBBA@@@A@BBB@@@BB@@B@@A@@BBA@@@BB@@@@@BA@B@BB@@A@BB@@@B@@@B@@@@BBB@@B@@@@A@@B@@@@BB@B@@B@@@B@@B@@B@@B@@@@@@@BA@@@@BB@@B@@B@B@@B@@@@A@@@BBB@@BB@@@AB@@B@@B@@BB@@B@@@B@@B@@@@D@@@@@B@@@B@@@B@@BBABB@@@@@B@@B@@B@@@@@B@@B@@BB@@@@@BB@B@@@@B@BB@@@@A@@@@BB@@A@@BB@B@@AB@@B@@B@@A@A@B@@B@@A@@B@@@@@@@B@BA@@@BB@@@B@B@@@@@BB@@@A@@B@@B@@B@AB@@@@@B@BBB@@@@B@@@@@BB@@@B@@@DB@@A@@@@@B@@@B@@@BB@@@@B@@@@B@@@AB@@B@B@@@@@B@@@B@@@@@B@@@@@@@@@BB@@@@B@@@@@@@@B@BB@@A@@B@@@@B@@B@B@BBBB@@B@@@B@@@@AB@@@@@B@@B@@@@@@B@@@@@@B@@@@@@B@@@B@@B@@@@BA@@@@@B@@@@@A@@BB@@@@@BB@@@@@@@B@@@@AA@B@@@@B@@@@B@@B@@BA@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@BB@@@@B@@@@BB@@@@@@@@@@B@A@@A@@@@@@@@@@B@@B@@@@B@@@@@B@@@@@@@@A@@B@@@@@@B@@@@B@@@@@B@@B@@@@@@@@B@@@@@@B@@@@@@@@B@@@@B@@@@@@@@@B@@B@@@B@@@@@@B@@B@@A@@B@@B@@B@@@@B@@@@@@B@@@@@@@BB@@@@@@B@@@@@@BB@@@@@@@@@B@@@@@@@@@B@@@@@@B@@@@@@B@@@B@@@@@@@@BB@@@@@@@@@@BA@@@@@B@@@B@@@@@@@@B@@A@@B@@B@@@@@@@B@@BA@@@@@@@B@@@@@B@@B@@@@A@@@@@@@@@@@@BB@@@B@@@@@B@@@@@B@@@@@@B@@B@@@@@@@@@@B@@B@@@@@@@B@@B@@@@B@@@@@@@@@@BB@@@@@B@@@B@@B@@@@@B@@B@AB@@@@@@@@@B@@@@A@@B@@B@@@@@@@B@@@@@@B@@@@B@@@@@@B@@A@@@@@@@B@@@@@@B@@@@@@@@B@@@@@@@@@@@@BB@@@@@@@@@AB@@@@@BB@@B@@@BB@@B@@@@@@@@B@@B@@@@B@@B@@@@B@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@BB@@@@@@@@BB@@@@@@B@@@@@B@@@B@@@@@@@@@@B@@@@@@A@@@@@@@B@@B@@@@@@@@A@@B@@@@@@@@@@B@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@B@@@B@@@@@B@B@@@@@B@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@@BB@@@@B@@@A@@B@@B@@@BB@@@@@B@@@@@@@B@@@@@@@@B@@@@@B@@B@@@@@@@B@@@@@@@@@@@B@@@@@B@@BB@@@@@B@@B@@@@B@@@@BB@@@@A@@@@@@BB@@@B@@BB@@@B@@@@@@B@@B@B@@@B@@@@@@@@@B@@@@@@B@@@@B@@@B@@@@@@@B@@@B@@@@@B@@@B@@B@@@@BB@@@@@@@@@@@@@B@@@@A@@@@@@@@B@@BB@BB@@@B@@@@@@B@@B@@BB@@@@B@@@@@@BB@@B@B@@@B@@@B@@@B@B@D@B@B@B@BB@@B@@@@AB@BBB@@@B@@@B@B@@@B@B@D@F@B@DAD@BBF@D@DBHDB@B@D@D@B@D@@@B@B@DADA@@DAB@B@@AB@B@@@B@B@D@B@@@B@B@BBB@DBB@B@BB@@B@@@B@@@@BB@B@@@@@B@@@@@BBB@@BB@B@@@@@B@@@B@@@@@B@@@@@B@@@@@@@BBB@@B@B@@B@@@@@B@@@@BB@@@@@@B@@@B@@@@@B@@BB@@@@AB@@@BAB@@@B@B@@@@@B@@@@@@B@@@@@B@BAB@@@@@B@@@@@B@@@@@@@@B@B@@@@@BB@@@B@B@BBB@@@B@@B@B@@BB@@@BBB@B@BA@@B@@@B@@B@BB@@@@BB@@BB@@BB@@B@@BB@B@D@B@BB@@@@BB@@@B@@@@B@BB@@BBB@@@@@B@@@DAB@D@HADAB@@@BA@@@@BA@ABA@@@@@AB@BA@@BAB@B@@@BAB@BA@@@A@@B@@@@@B@@AD@B@@@B@B@B@D@B@BBB@@@D@@@BB@@@@@@@@BB@@@@BB@@B@@@B@@@@@B@B@@@B@@@B@BBB@@@@@@@@@B@@@@@B@@@@@B@@@B@@@@@B@@AB@@@@A@AAA@A@@A@@AB@@A@@BA@@@A@@B@B@@@B@B@B@@@D@B@BA@@B@BBB@B@B@BA@@@@BA@A@@B@@@@AB@B@B@B@@@B@@@@@@@BBB@@@BB@B@BBB@@@B@@@B@BBB@@@@B@@B@@@@@B@@@B@@B@B@B@B@B@DAB@@ADAB@@AB@BA@AB@@A@AB@@B@@BBB@BB@@@B@@@B@@@B@BB@@@BB@@AB@@@BBB@@@BB@@@B@@@@@B@@@@B@@B@@B@@@BA@@@@BA@@@AB@@A@@BA@@B@@AB@@@@BB@@@@@DBBBBBBBBDBDDDBBBBBBBB@@@@BB@BB@@@B@@A@@@ABA@@@ABA@@@@@@@A@@B@@@@B@@B@@B@B@B@BBB@D@B@BA@@B@B@B@@@B@BBB@B@B@@BB@B@BB@@@B@@@@ABABABAB@@ABCBAB@@@@@B@@@@BB@@@@BB@@@B@@@@A@@@B@@@@B@@@@B@@@B@BAB@@@B@@BB@@@@@B@@@@B@@@@@@@BB@@@@@@BB@@@BBB@@BB@D@B@@@B@BBB@BB@@@@@B@@BB@@@BB@@@@B@@@BA@AB@BAB@B@@@B@@@BBB@BB@@B@@@@B@B@@@D@D@DBB@@AB@@@@@@@BB@B@BB@@BB@BBBB@@@B@@@@@B@@@@AB@B@B@B@B@@@B@@B@@BB@BB@BB@DBBBB@@BBB@@@@@B@B@@@@@@@@B@B@B@@BB@BBBB@@BB@@@B@@BB@@BBBBB@@BBB@@@@@B@@A@@BA@@@@B@@@@@B@@@B@@@@AB@@@@@B@@@@BBB@@@B@@@B@DAB@B@F@B@B@@@BBB@BBB@@@B@B@B@@@B@BABAB@DADAB@@@BADAB@B@B@BAB@BAB@BABA@@B@@@@@B@BA@@BBB@B@DBB@DB@@B@D@@BB@B@B@B@B@@@@@@AB@@ABA@@@@B@@@B@B@BAB@B@B@@AB@B@@@B@@@B@B@@@BBB@B@B@DB@@BBDB@@BBB@@@B@@@B@BAB@D@@AB@@@@@B@@@@@@@@BA@@@@@@B@@@@@@@BB@@BB@@BB@@B@@@B@@@B@@@B@@@@@B@@B@BBB@@BB@@@@@B@@@D@B@B@B@B@@@B@BBB@B@BBB@@BB@@@BBD@B@BBB@B@@@B@B@DAB@B@B@@@BA@@DAB@BA@@B@B@@@B@BBB@B@DBB@@@B@@@BBD@B@@@B@BAB@B@BAB@B@B@@@B@B@B@BA@@B@B@@@B@B@@@BB@@BBB@BBBBB@BBB@BB@@B@B@D@BB@@B@B@@@BB@@@@@B@@@B@B@B@@BB@@BB@B@@@B@BBB@D@BB@BBB@@@B@B@B@@@B@BAD@@@D@BB@@B@@@BB@B@BB@@@@BB@B@@@B@@@@BB@@@@BBB@@@B@B@B@@@@@B@@@@B@@B@@@B@@BB@@@BB@@B@BB@@BB@BBBBBBB@BB@B@@B@@@@@@@B@@@@@@@@A@ABA@@BA@@B@B@DBB@B@@@BBD@B@@@B@B@B@BA@@@@@@@A@@@A@AB@@A@@BA@@@A@@@@@@B@@@@@B@@AB@B@@@B@DAB@B@@@B@@@B@@AB@@A@@@@BA@A@A@@BA@A@AB@@AB@B@@@BAB@@AB@@@@A@@BA@@@AB@@@B@@AB@@@@@BBB@B@@@B@B@BA@@B@DAB@DAB@B@@@B@D@@A@@AA@@@A@@@@@A@A@@@A@@@@@AB@@@B@BAB@B@B@BA@@B@B@@@B@@@@A@@@@@@@A@@AA@@@@AA@@@A@@@@@A@@@@@@@A@@B@@A@@@A@@@@B@@A@@@@@@@@AA@@AAAA@@A@AA@AA@AA@@@@@A@@@A@AA@@@AA@@A@AAA@AAC@A@AA@@A@@@@@A@A@A@@@AAA@@AA@@@@AAA@AA@AA@@AAA@@@A@A@A@CAA@@@@@@@@@D@@@B@BAB@B@@@B@@@BA@@@@BA@CB@@A@@@@@AAA@@@@@A@@BA@@BA@AB@BA@A@@BA@AB@@AB@@AB@@@@@B@@@B@BB@@B@@B@@B@@@B@BBB@B@D@D@@@B@D@B@B@B@@@BA@@B@B@BA@@BA@@@AB@@AB@@@B@@@BA@AB@@AB@@ABAB@@AB@@ABA@A@@BAB@@@B@B@D@D@B@B@B@BAB@B@B@@@B@@A@@B@@A@A@@@@@AB@BABADADABA@@@@BA@@@@BA@@@A@@@C@E@A@@@A@@@A@A@ABA@ABA@A@AB@@ABA@@B@D@B@DBB@B@B@BA@@@@@AB@@A@@@@@A@AA@@A@@AA@A@C@A@@@@BA@@B@DADABA@@BAD@B@B@DAD@F@B@BAB@@@BA@@BA@@@@BA@@B@D@B@D@B@D@D@BA@@B@B@@AB@@ABA@CBC@@@A@@@AAA@@@@@AB@@@@A@AB@@A@A@@@A@AA@@AAAAA@@@A@@@@@A@ABA@@@A@A@A@@A@AAA@@AA@@A@@@AA@AAAA@@AAA@@@A@AA@@@@@@@A@ABA@@BABA@@@ABABABA@@B@B@B@B@B@BAF@BA@@B@DABA@AB@BAB@B@B@B@@@B@@A@@@A@@@A@@@AB@@@BABAB@@@BA@@@@BAB@B@@A@@@@@A@@@@B@@@BAB@B@@@@@B@@B@@@B@@@B@BBB@@BB@@BBDBB@BBB@@@@@B@BAB@B@BAD@DADABA@@@AB@@A@@@A@@B@@@@AB@B@B@B@B@BBB@B@B@B@B@DAD@B@BA@@@@BA@@@@@A@@B@B@@@B@B@@@BB@@B@@@@@@@BAB@B@B@@@D@B@@@B@D@@@B@B@B@F@DAF@B@D@D@@@@@@@B@@@AA@@@CAABA@A@@AA@@A@@A@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@B@@A@@@@B@@AB@@@B@B@B@@@@@@@@A@@@A@@@A@@@@@@BA@@BABAB@@A@@@@B@@@@A@@B@@@@@@@@A@@A@@AA@@@@A@@@@@A@@@@@@@@@A@@@@A@A@A@A@A@A@@@@@@@@A@A@@@@@@BA@@@@BABAB@@@@@@A@A@@@@@@A@@AA@@@AA@@@@@A@@@@@A@@@@@@@@@A@@A@@@@@@@B@B@@A@@BA@@B@B@BAB@@@B@@A@@@A@@@@@AA@AA@@AC@AAA@@@A@A@A@@AA@@@A@@B@B@B@B@B@DABA@@DA@@@@B@@AB@B@@@B@B@B@@@B@@@@@@@@@@B@@DBB@@BDBBBBBB@@@BB@@BB@BBB@@@BB@@@B@B@D@BB@@@@@@@@BB@D@B@B@@@B@B@@B@@@@BB@B@B@B@@BB@@@@@@@@@@B@@@@@B@@@D@B@B@@@BB@BB@@@@@BB@@B@B@@@B@@B@@BB@@BBB@@BBB@@BB@B@@BB@@@@B@@@B@B@B@@@@BB@@@@@@B@@@@@B@@@@@BB@@B@B@@B@@B@@B@@@@@B@B@@@B@D@B@@@B@B@@B@B@BBB@D@B@B@B@@@B@B@@B@@B@@B@@@@@B@@AB@BA@@B@@@B@@@BA@@B@B@@@B@@@B@B@B@@BB@B@@@B@@@B@BA@B@@@B@D@B@B@B@B@@@B@@B@@BBB@BDB@BB@@B@@B@@@B@BBB@@@B@BB@@@@B@@B@@@@ABABABABCBA@@BA@@AA@AB@@@@AB@@A@@BA@@BA@@@@DA@@@AB@BA@ABA@C@AACACA@AACACAAAA@AA@@@AAA@@BA@A@@BA@@@A@ABA@ABA@A@@BA@A@@@AB@@CB@AE@A@A@A@ACCAA@@CCAAA@@A@A@C@AAA@AA@@A@C@A@AAA@ACACA@@EC@@C@A@AAAAAAAAA@A@@AAAEAACAA@@AA@AC@AAKGAAAA@ACC@@A@@@A@@AAAAAAA@@@A@@BA@@@AB@@A@@AA@A@@@AB@@@@A@@@@@@@AA@@@@A@@@AA@@@@@A@@@@@A@@@@@@@@@@A@@@@A@@@A@@@@@@@A@@@@A@@@@@AA@@@@@@A@A@@@@@A@@@@@@B@@A@@@@B@@@@@@@@@B@BA@@@A@@B@@@@AA@@@@@CA@@AA@@@@@A@@@A@@@@@@@@@@AA@@@@@AB@@A@@B@@@@@B@@@B@@@DAB@@@B@@@B@@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@BA@@@A@@B@@@BA@@@@@@@A@@A@B@@A@@@@@@@@@AB@@@@A@@@@@A@@@@A@@@@@@@@A@@@@B@@@@A@@@@@AB@@@@@@@AA@@C@@@@@@@@@@A@@A@@@@@@@AAA@@@AA@@@@@A@A@@@@A@@AA@@@@A@@B@@@@@B@@@B@@BB@B@@@@A@@@@@A@@@@@A@@@A@@@@@A@@@A@@@@AAA@A@AA@@@@A@@A@@@A@@@A@@@@@@@@A@@@@A@@@@@@@A@@AA@A@@A@@@C@@@@@A@@@@A@@@@AAA@A@@BA@@@@@A@@@AAAAAA@@@A@@@AA@A@@A@@B@@A@@@CAA@@@AB@B@@A@@@@@@@A@@D@@@@ABC@@BA@@@AAA@@@@A@@B@@@@A@@BCAA@A@AA@A@@@@@AB@@A@@@AB@@@@AA@@@A@A@C@@@@@@@@A@@A@CACAA@@B@@A@AAAAAAA@A@@@A@@AB@@@@@AAA@AAAA@@B@@@BAA@@@AA@@AA@@@A@A@@A@C@AA@@ABC@A@A@@@A@@BA@@@A@@A@@@A@@BAA@@@A@E@C@AA@@A@@A@@AA@@A@AAA@A@A@AAC@A@AAA@AA@@A@A@A@AAA@A@@@AA@@A@@A@@@@@@A@@AA@AAA@A@C@A@A@A@@@A@@AA@@AA@@A@@@A@@AA@@CCA@@AC@A@CAA@CAAAA@AAAAA@@A@A@A@@AA@@A@CBA@A@A@A@A@@@@A@@@@A@A@CAA@CAA@A@AAA@AAA@CAC@A@A@@AAA@@@A@@@A@A@A@@@AA@@@A@@B@@AB@@A@A@A@@AA@@A@@AAA@@A@A@A@A@@AABA@CB@@AB@@AB@@A@A@@DABABA@A@A@@@A@A@AA@AAAAA@ABAAA@AACAA@BA@@@@AAA@C@A@A@ABC@ABA@ABEDA@A@@@@A@@@A@@@@A@C@C@A@A@@@AB@@A@ABA@A@@B@@@@A@A@@@@A@AA@@@CBA@@@A@A@@B@@@BC@A@C@AA@@@A@@BAA@AAAAA@A@A@A@@BAA@@@A@AAAA@@@@AD@AAA@A@@@C@A@C@AA@@@A@@@A@@A@A@@@@B@@ABCBA@AA@@@A@@@A@@@A@ACAA@@AAA@@BA@@@@@CA@AAA@@@@DA@@@A@A@ABAB@@CAA@A@A@BA@A@@@A@@CBA@A@@A@@AA@C@@@AA@@@A@AA@@@AAAAA@@@AAA@AA@AAA@ABC@@@@BA@A@ECAAAA@AAA@A@ABCBCBCBA@A@AACCAA@@A@AAA@CAAAAA@ABEBA@C@A@@AAA@A@A@AA@CAA@@B@@BB@@@BAAC@ACAAA@ABA@A@C@E@A@ADA@A@A@CAAAA@A@A@ABABC@A@A@@A@@D@BADA@A@@A@A@@BA@A@@AAA@A@@A@C@ABC@AB@@AB@BC@A@@@@B@@BBBA@@@@BB@B@BABC@A@AACAA@@A@@B@@A@@@AAAA@ABA@A@A@@A@CAAC@AAA@A@@AB@@@@AAAA@CB@@AB@@A@A@@AA@AAADA@A@@A@@BA@@@A@@CCGAGCA@G@CAC@AAC@A@C@C@A@AA@A@AA@A@ABA@A@AAAA@@@A@AA@A@CBAAA@CAC@AAAA@AAA@@A@A@A@@BBB@@C@C@@@@@@A@A@AA@ECA@A@A@@BB@@B@BABA@@@AAECA@A@@@ABA@C@C@A@@B@@@BA@@BABA@@BA@@@AA@@AAA@A@A@@A@@AAA@@BA@BBA@AD@@A@A@AA@A@@B@BA@@A@AAC@CBA@@BAD@BBBDBBBA@@BA@A@@@AAA@@ACA@AABCBABA@@BBBB@BBB@A@G@A@AAA@A@BA@@DA@@@@AAC@@@ABABA@@AC@ECAB@@@@BB@B@B@B@@ABA@A@@A@@C@C@A@BA@@DA@@@A@@CAE@@@@@@AAAA@@@ABA@@@@AA@AAAA@@A@CAA@A@AAA@E@ABA@@BA@A@C@AA@@@AB@B@B@@BBAA@AAA@A@AAA@A@ABCB@@@B@@B@@BB@ABA@ABA@CBABA@C@A@A@@@@BBBB@B@B@@@@BA@ABC@ABA@A@@AAAA@A@@BADA@@@A@@@AA@@AB@@ABA@A@A@@@@A@A@@A@ABCBABC@@@AB@BB@BB@@@BA@A@@@AAA@AA@AA@AB@BAB@@@B@@BBA@@@A@@@@A@AAAA@A@CB@AA@AAAAA@@@AA@@BA@@D@BA@@@AA@@@A@CB@@A@@@ACCA@AABADA@A@A@AA@@C@@AA@@@A@@BA@@@A@@A@CAE@A@@@A@A@AA@ACAA@AAAAAAAA@ABC@@@C@ABC@A@A@@@@@@ABA@A@@A@CAA@@B@BA@A@A@@A@A@@@AA@A@A@@B@@BBB@@@@@@BA@@B@@ABA@A@ABA@C@@@@BB@BB@@@BB@BB@@ABABC@A@A@AA@@@AD@B@@A@AA@EBCBE@ABA@A@A@@@@AB@@A@@A@AA@BC@A@@@A@@A@@C@@@A@@@@@BAB@BAA@AAA@A@@BADABB@@B@@ABAAC@A@A@@@@BA@@@A@A@@@A@@B@@ABA@AA@B@@@B@@@@A@@@A@@@A@@@@@@B@@A@@@AAAA@AAAA@AA@@@AB@B@@A@@A@C@A@AAA@@@ABBBB@@BA@ABA@@@AA@@@A@A@@AA@@@A@AA@ABA@A@@@AA@@@AB@B@B@@A@@AAA@A@@BC@@BA@@A@@@A@ADA@@AAA@@@A@A@A@@BB@AB@@ABAA@@AA@A@@@A@@D@@@BA@@@AA@A@A@@BAB@@CBABA@A@C@A@A@AA@@@A@AA@@@A@A@A@@@A@@@ABA@A@@@A@@AB@@@DA@@@A@@A@A@A@@BABA@A@@@AA@@AAA@A@@B@BA@@@A@AAA@A@@@@@@A@@BA@@@@AA@@@AB@@@@AAA@A@@AAA@ABAA@@A@@ABA@@B@B@B@@AA@@@A@@@A@A@A@@@A@@@@B@@@@@B@@@@A@@@A@A@@@@@A@@@@@@A@@@@B@@@@A@@AA@@A@A@A@AAA@A@AAA@@@ABA@@BA@@@AA@@@A@@@@A@@@CB@@@@AAA@AA@@@ADADC@A@@@A@@C@A@A@A@AAA@AA@AAC@AAA@@CACBA@AA@@AA@@@AAAAAA@@@@A@@@A@@B@BA@@@A@A@@AAA@A@A@ABADA@A@AA@@AA@A@AA@EAE@A@@A@@@AB@@ABA@A@@AAA@C@AB@@ABA@A@@@@A@A@AA@AAA@E@CBE@A@C@A@ABCBC@AAA@CA@@@AB@DA@A@AAAA@C@CBAAC@CBA@C@AACAA@A@A@@B@BBBA@ABA@AAAA@A@ABC@A@AAAC@A@C@ABAB@BBD@B@B@BABA@CAAA@AAAAAAAEAA@C@AAAA@ABA@CAAAAA@CB@BABABA@A@C@AAA@A@CBA@A@AB@B@B@BC@A@AAAAC@A@@B@BDBDB@B@@@BCBC@AAA@@A@C@@@AAAC@C@A@@A@AAAA@ABA@AA@AB@BABA@@@A@@C@A@A@ADED@BA@C@AA@AAAEAAA@ABAB@B@F@B@BA@@@AAAC@C@C@C@A@A@@AA@BABAB@B@@@DA@A@@@@AA@@B@D@B@@@@@@A@@@@B@B@@B@@BA@@@@B@@@@@@@B@AA@@D@@A@@@A@AB@@@@@BD@@B@@@B@@B@@@@B@@B@@@@@@@@BA@@B@@@B@@@B@@@A@@A@@@@@AB@@@BA@@B@@@@@B@@B@@B@@@@A@@D@@@@A@@@A@@@@@ADB@@B@@@@@@A@@@@@@A@@@@AB@B@BAB@@@@@BABA@@B@AAA@@@BA@@@@@A@@@AB@@BB@@@BCFC@AB@BBB@B@BA@@B@F@D@B@B@@A@AB@B@B@@@B@B@BA@@AA@@@@@@A@@BA@@@@AAA@@A@@A@@@@@@B@@@@@AA@A@@A@@@@@@AA@@A@A@@@@@@A@@@@@@@@AA@@A@@B@@@@@@AA@@A@@A@@@@@BA@@@@BA@@@@A@A@BAAAB@@@@A@@A@@@@@@@B@@A@@@@@@A@@@@@A@A@@A@@FC@@@AB@BCB@DAH@JADAFGBCB@B@F@FBDBBBB@B@BAF@F@BABA@BB@@@@@@@@AA@B@@@B@@@@A@@@@C@@@@AB@@A@@BA@@B@@@B@@A@@B@B@@A@@@A@A@@@@@@B@@@DABB@A@@B@DA@@B@@@@B@@@@B@@@B@@@B@@B@@@@B@B@@@B@@@@@@A@@@@@@B@@@B@B@@@B@D@@@@B@@@@BABA@A@@B@@B@@B@@@@@AA@@@AB@BA@@B@@@AA@@@AA@@@@@B@@@@A@@A@@@@@@@@AB@@@@@BA@@@@@@B@@@@A@@@@@@B@@@@@@AB@@@@@@@@@BB@A@@@A@@@@AB@@@@@@@@@A@@@@@@@@@A@@@@@A@@@@@BB@@@B@@@@@B@@@B@@A@@@@@A@@@@BA@@A@@@@AB@B@@@@@@@@@@@B@@@BA@@@@@@@@@AB@@@@AA@@@@@D@@@AAB@@@B@@@@ABB@A@@@@B@@@@@@@@B@@B@@A@@B@@@@@B@@@@@@A@@A@@@BA@@@@@@A@@@@@@@@@B@AA@@@@@@B@B@@@@@A@@@@A@@BA@@@A@@A@A@@@@A@@@@@@@@BAA@B@@AB@@@@@@B@@@@B@@@@@B@@@@AA@@@B@@@@@B@@@@@@@@@@@@@@AB@@A@@@@@@BB@@@AB@@@B@@@@BB@@@@@B@@@@@@@@@@A@@@@B@B@@@@A@@@@@@@@B@@@@@@@A@@@BA@@@@B@B@@@@@@@@@@@@@B@@@@@B@B@@A@@@@@@@A@BB@@AB@@A@AB@@@@@B@@@B@@@@A@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@@AA@B@@@BA@@@@@@@A@@@@B@@@@A@@B@@@@@BA@@B@@@BAB@@@A@@A@@@@@@@A@@B@BB@@@@@@B@@@@@B@@@@@B@@@B@@@AA@@A@@AB@B@B@@@BA@@BB@@@@@@@@@@@@B@@A@@DA@@@@AA@@@@B@@@B@@BBA@@@@@@@@@@BB@@@AB@@@@BB@@A@@@@@@@A@@B@@@@@@A@@@@@@@BB@@AB@@@@@@A@@D@@@@A@@@@@@B@@@B@@@@A@@@@BA@@@@B@B@@A@@B@@@@BA@@@B@@@BA@@@A@@B@@@@@@@@A@@@@@A@@B@BA@@@@@AB@@@@A@@@@BAA@BAA@BAB@B@@A@@@@@A@@@@@BB@@@@@@@@A@@@@@A@@@@@@@A@@@@BA@@@ABB@@BA@@B@@@BA@@A@@A@@B@B@@@B@AAB@B@@@AAB@@AB@@@@AB@@@@@A@@A@@@@@@B@@@@AA@A@BA@@B@BA@@B@@@B@B@@@@@@A@@@@BA@@@@@A@@A@@@B@BA@B@@BA@@@@B@@@BA@@@@B@A@@B@@@@B@B@@@@A@@B@@AB@@@@@AA@@@@@@B@@@@@B@@@@@@A@@@@@A@@@@@@@@B@@@@@@@@@B@@@@@@@@@@AA@@@@@@@B@@@@A@@@@@@@@B@@@@@BA@@B@@A@@B@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@A@@@@@@@@@@A@@AA@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@A@@BA@@@@@@A@@@@@A@@@@@@@B@@@@@BA@@B@@@B@@@@A@@A@A@@@@@@@@@DA@@@@@@@@BA@@@@@@@AB@@@@@BB@@@@@@@@@A@@@@@@@@BB@@B@@AB@@@@@@A@@@@@@@@@@@@@ABAB@@@@@@@@@B@@@B@B@@@@A@@B@@BB@@@@@@AA@@@@ADB@@B@@A@@@@@A@@@A@@BB@@@@@@B@@@A@@A@@BB@@@@@@@AB@@@@A@@B@@@@@@@@@B@@@@@BA@@@@@@BB@@@@@@@C@@D@@@@@AA@@@@@A@@B@A@@@A@@BA@@@@A@@B@AA@@@@@@AA@@@@B@@@@@BA@@@BB@@@@@@@@@@A@@BA@@@@A@@B@@@AAAB@A@@AB@@@@@B@@AB@@@@@@A@@B@@@@@@@A@@@BA@@@@A@A@@@@@BA@@B@@@B@@A@@BA@A@@B@B@@@@@B@@@B@BA@@B@B@@BB@B@BC@ABA@@B@@@@ABAA@@@A@@@@AB@BC@AB@B@B@@@@@AA@@B@B@@A@@@@@AB@@B@@@@@@@B@AB@B@@@B@@A@@B@@@@@@@B@A@@A@@B@@@@@@@AA@@B@@@BB@AB@@A@@@BB@@@@AB@@@@@@@B@@A@@@@@A@@@@@@@A@@BB@@@A@@AA@@@@B@@@@B@@@A@@B@@@DAB@@@A@@@@@AA@@B@@A@@B@@@B@@A@@B@@BB@@@@@@A@@@@@@B@@@AA@@B@@@@@@@@@B@@A@@B@@@@B@@@@B@@@@@@@@@@A@@@@AA@@@@@@A@@A@@@@B@B@@A@@B@@A@@@A@@B@@@@A@AA@A@@@B@@A@@@@BA@@@@@@BA@@@@B@@@@@@@@@A@AA@@@@@@B@B@@@@AB@@@@@@@@BBA@@@@B@@@@@@AB@@AB@@@BA@@A@@@@@@AB@@@@A@@B@@@@@@A@@A@@@@@@@B@@ABAB@@A@@@@@@@@@@@@@AB@B@@@@@@A@@BBB@@A@@@A@@@BB@@@@@BC@@B@@@BA@@@@B@AAB@@@F@@@@@@@@AAA@@@@ABA@@@@AB@@@@AA@@@@AB@@@A@@AB@@@@@@@@@AA@@AC@@@@BB@@@A@@@@@@@@B@@@@A@@@@@@BA@@@@A@@@BA@@@@B@@@@@@@@AB@@@@AB@BAB@BAB@@@B@@@@A@@@@@@@@B@@@@BB@@BB@@@@@@@@AA@@A@@@@B@@@@@B@@@@@@@B@@@@A@@@@B@@@B@@@@@@@@A@@@@@@@@B@@@@@@@AA@@@@@ABB@@@@B@@@B@@@@AA@@@@@B@@A@@@@@@@@A@@A@@A@@@@A@@B@@@@@A@@@BA@AA@@AB@@B@@@@B@@@@@B@@@@A@@@@@@@@B@@@@@@@@A@@@@B@@@B@@@@A@@B@@@@@BB@@B@@AB@@@@@@B@@@@@@D@B@@@@@@@C@@@@@@A@@B@@@@AB@@@AA@@@@B@@@@@BB@@@A@@@@AA@@@@@@A@@@@AB@@@@BB@@@@A@@A@@@@AD@B@A@@A@@@@B@@@@@A@@@@@@@DA@AA@A@@@@@@A@@BBB@@@BB@@@@B@@A@@A@B@@@B@@@@A@@A@@@@@@@D@@@BA@@@@A@A@@@@@@@@@BA@@@@@@@@@AA@@@B@B@B@@@@@@@@ABA@@BB@@@AAA@@@A@@B@@@B@@A@@B@@AB@BA@@@@@@@B@@@@@@@@BB@@BA@@@@@@@@BBA@@@@@@@@@A@@@@BB@@@B@B@@@@@@@@A@@@@B@@@@@@@@@@AB@@@@@@@B@@@@@@@@B@@@@@@B@@@@@B@B@@@@@BA@@B@@@@@@@@A@@@@@@@@@@@A@@A@@@@@@@A@@@AA@@B@@@BA@@B@@@@@B@@@@@BA@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@B@@B@@@@@B@@@@B@@@@@@A@@@@@@B@@@B@@@@@@@@@@BA@@B@@B@BAB@B@BAB@@@@@AA@@B@@AB@@@BA@A@@@@@ADA@@B@@@B@@@BA@A@@@A@@@@B@@AB@@@@@@AA@@@@@@A@@B@@@@A@@@@@@A@@AB@@@B@BA@@B@@@@A@@@A@@A@@@@A@@@@B@@@B@@@@AA@@@@@@@@@B@@@@@BA@@@@@@@@@@BA@@BAB@@@B@@@@@BAB@@@@@A@@@A@@@A@@A@@BA@@@@@A@@@@@@@A@@@@A@@@AB@@AAB@@@@AB@@@@BB@@@@ABABA@@@@@CBA@@B@@AB@B@@A@@@@@@B@@@B@@@AA@@B@@@BB@@@@B@@@@@@A@@@@A@@@@AB@@@@@B@@@A@@@@@@AA@@@@@A@@@@AB@@@B@B@@A@@@@@AA@@AB@@@@@@A@@@@A@@@@@A@@@A@@@@A@@@@BA@@A@@@@@@@CB@@@A@@@@@@@A@@@@@@@A@@@@A@@@@A@@@@B@@@B@@A@@@@AA@@@@@A@@B@@@@A@@@@@@@ABAB@@@@@@@BA@@@@@@B@@@@@@@B@@@@@B@@@BB@@@@@@@AB@@@@AB@@@B@B@BA@@@AB@@A@@B@@@@AB@B@@@@AB@@@BA@@D@BAB@B@B@BAB@BABAB@BA@A@@@@@AB@@A@@@@@A@@B@@@@AB@@@B@@@@A@@A@@AA@@@A@@@A@@@@@@A@@@@@A@@B@@@@A@@B@@A@@@AB@@B@@@@B@@@@A@@@@@B@@B@@@@@B@@@@AB@@AB@BA@@@@@@B@@@@@@@@A@@@@@@B@@AB@@@@@@@@AA@@@B@@AB@@A@@@@@@B@@@B@@@@@B@@A@@@@B@@@BA@@B@@B@@B@@@@@@@B@BA@@@@@AB@B@@@B@@@BA@@B@D@@@B@@A@@@@@@@@B@@@@@@@B@@@@A@@@@B@@@@A@@B@@@@B@@B@@@@A@@@A@@@@@@B@@@B@@@B@@@B@@@@BB@@@DADAD@DBDBF@JCBCBABAD@BAFCD@D@D@FAPBJ@LAHAJAJCLIJAHALIFADAJ@JBJBB@@@DBB@@@D@B@B@BB@@BB@C@@@A@@A@EACAAA@@@@A@@@AAC@A@@@A@AA@@@@AA@@@AA@@B@@A@@@A@@@A@@@AAC@C@@@CAA@A@A@@AA@@@@C@@AA@@@@@@@A@@@@@A@C@@A@@@@@A@A@A@ABA@@BADAB@@@@A@@@A@A@@@A@@@A@ABA@@@@@AA@A@AAA@CAAAEAA@@@ABA@@@A@@@C@AA@@AA@A@@@@@@AD@@@BA@@@A@A@@@@@A@@@@BA@A@A@AB@BAFABAD@DA@@@@@AA@@AA@@A@A@AA@@@A@@@@@CBA@@@A@@@@@A@@ACAAAA@AA@@C@@@@CAA@@AAA@@AC@@@A@A@A@@@A@@BC@@@AAA@A@A@@B@@AD@DAD@BABABADAB@@@BAB@B@@AB@BA@ABA@A@A@@@C@@@CA@@A@ACACAAAA@@A@AA@@AAAA@@AA@@@@A@A@A@A@@@AA@@AA@@A@@A@@@@AA@B@@A@@@C@@@@@A@AA@@A@@@AA@@A@AB@@@@A@@A@@A@@@@A@@@@A@A@A@@@AAA@A@A@A@@@A@@@@A@A@@@@BAB@BAB@@@@A@@@@@A@@@A@@@A@A@@@AA@@A@@@A@A@@B@@AB@@@@A@@A@@@@@A@@@A@AAA@@@AAA@@@C@A@A@@@A@@A@@C@AAA@A@A@@@A@ABA@@@A@@@@@A@@@A@ABA@A@@BCAA@@@@@ABA@A@CBA@A@ABA@@BAB@@@B@@AB@@@@@B@@BB@@@@@@@@@BA@@@@@@B@B@@@@@BA@@@@@@@A@A@A@CAA@@@C@A@A@@AA@A@A@@@A@@@A@A@A@@BCBC@@@A@AB@@CDA@@B@@A@ABA@@BAB@B@BA@ADABA@@@C@@@@@A@AA@@A@@@@@A@@@A@A@@@A@A@C@@@A@A@@BA@CBAB@@A@C@A@@@C@@@A@@B@@@@AAA@A@AA@@C@AAA@@@A@ABABEBAB@@A@@BA@@@@@@@@B@@@B@B@@@@A@C@@@A@A@A@AB@@AB@@A@@@A@A@@AA@ABA@@@C@A@A@@@AA@@@@A@A@@@@@A@@@ABA@@@A@A@C@AB@@@@@@@@@BBB@@@@AB@@A@A@A@ABA@@@@B@@@BAB@@A@CBA@AA@@A@@@@@A@@@@B@@@@@@@BA@A@@BA@@BA@@B@B@@A@@@A@A@@B@@@B@@@@AB@BA@AB@@A@ABAB@@A@C@C@A@A@A@A@@B@@AB@@@@AB@@A@A@AB@@@@AB@@@@BB@@@B@B@@@BA@@BA@@@ABA@A@ABA@A@A@@@@B@@@@AB@@@@@@A@ABA@@AC@A@A@A@A@@@AAA@@@@@AAAB@AC@A@AAA@@@@@A@@@@BA@@@@BA@A@ABA@A@@B@@@@@@@B@@@@@BA@ABA@A@@@A@@@A@@@@@AA@@@@AB@@A@@@A@@@CAAAA@AAA@@@C@A@ABC@@@A@AB@B@@A@@@A@@AA@@A@@@@AA@@A@A@@@C@AA@@A@A@A@@B@@@@A@@BB@@B@B@@@@@@A@A@@@A@@@AB@@A@A@A@@BA@@B@@AD@@AB@@@@@@@@A@AAC@CAA@A@A@AAA@A@AAAA@AAA@A@@A@@@@@@@AB@@A@@@ABA@@@CAA@@@@@@@@B@@@BAB@@@B@B@@AB@B@@A@ABAB@@AB@BA@@BA@A@@BA@@@AB@@@B@BA@@D@BAB@@@B@@AB@@@B@B@@@@@BC@CDABA@@@ABA@A@@@A@CACA@@AACAAAAAAA@@A@A@ABA@@@ABA@A@A@A@A@@A@@A@@@AAA@@@A@AAA@@AA@AA@AAA@@AA@@AAA@AAAA@AAA@AA@@@@A@@AA@A@@@@AA@@@A@@A@@@A@@@@@A@A@@@A@A@@@AA@@A@@A@@@@A@AA@@ABA@A@A@A@A@A@AAA@AAA@@@AACAAAC@AAA@AAAA@AA@@@AAC@AAAACAA@@BA@@B@BA@A@CBC@A@@@A@@@AA@AAC@AAA@A@@@A@@A@@AC@@@ABA@AA@@@@AA@@A@@BA@C@C@C@@@CA@@@A@@@@@A@@@A@@@@@@@A@@AA@A@@@AA@AAA@AA@@C@ABA@A@@@C@A@@@@@A@@B@@@@AB@BA@@AA@@@A@@@A@@BA@@@AB@@A@A@@@A@@@@B@B@@@B@@@BA@@B@@A@@@ABA@@@A@@@A@@@AAC@@@AAA@@A@@AA@@@@@BA@@@AB@@A@@@A@AA@@A@A@@A@@@A@@@@@@@A@@A@@@A@@@@@@A@@@@@A@@@@CCAA@@AA@@AC@A@A@@AACA@BA@ABA@AB@@AA@@A@@AA@A@A@@@A@@AA@@A@@AAAAA@@@AAA@@@A@AB@@A@@@A@@@@@AAA@@AA@A@@@A@@AA@@@AA@@A@A@@@A@AAA@@@AA@@@ACA@@AAAAA@@@AAA@@@@@A@A@A@@B@@A@@@@A@@@@@@@@A@@A@@@@BA@@@@AA@AA@@@@AA@A@A@@@A@@@@@AA@@@A@@A@@@@@AA@@A@@@A@@@@A@@@A@@@@@@A@AAA@@@@AA@A@ACA@AA@@@@@@A@@BA@@@A@@@A@@AA@@AAA@A@@A@@@@AA@A@C@A@A@CAA@C@A@C@G@CAAB@@AB@@GH@BA@@B@@ABA@@BC@A@@@@B@@A@ABA@A@@BA@A@AB@@A@@@AB@@CAA@A@@@@BA@@@A@ABA@C@@@A@@@A@@@A@@B@@A@@@@@A@@@@A@@A@@@@@A@@@A@@@A@C@C@@@A@@@AB@@C@@@A@A@AB@@A@A@@@@@@BA@@@@@@B@@@BA@@@@B@@AB@@A@@B@BA@@BA@AB@@ABA@@B@BAB@B@@BBB@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@B@@@B@@@@@B@@@@@BA@@@@@@BA@@@@@A@@@@@@B@@@@@B@@A@@B@@@@@B@@B@@B@@@B@@@B@BB@AB@BA@@B@@@@@B@@@@@@@B@@@@@B@@@@A@@@B@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@@@BB@@A@@@@@@B@@@@@@@BB@@@@@@@B@@@@B"],encodeOffsets:[[130569,47694]]}},{type:"Feature",id:"231282",properties:{name:"肇东市",cp:[125.961814,46.051126],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@AD@@@BAB@BAB@B@@A@@@A@A@C@AACAA@AAAD@DAB@B@B@@A@@B@@@@AA@@A@@@A@@@@@@BABABADAB@@A@@@@@ABA@@@AB@@ABEA@@I@AAE@C@EAC@C@A@AAA@C@A@@@AD@B@B@D@@@BAB@B@D@B@@AD@B@D@B@@@B@BBF@BBBB@B@D@B@@@B@B@B@D@@@LAFABC@AFB@ABB@@@@@@L@@@HBFBB@B@B@@@DGBC@AJBJB@B@@@D@B@BAB@B@@@B@B@B@@AB@@FBF@J@BBD@B@@B@BAB@@@B@@@B@B@@AD@B@@@B@B@@AB@@@B@@@B@B@B@B@@@BAB@B@B@@@D@@ABQAO@A@EAC@I@A@@BA@CAO@E@@@@B@@@D@@@BA@@D@@@@A@CAA@AAEACAA@AACAA@@B@@@BABCDCDEF@BCB@BAD@@AB@B@B@@@BB@DDDFBBABB@B@@@BBB@@B@@BB@@@@A@A@@BAB@B@B@B@B@B@BAB@B@BE@@AG@E@@@@@@BAD@@@B@F@BAD@F@@@DAB@@@H@B@@@B@B@@AF@FAF@B@@@D@@AF@F@HAB@DAD@B@@@B@B@@@BAF@FA@@H@@@@A@@B@@@B@BANA@A@@@IA@@A@@@@@A@E@C@EAI@C@EAC@E@C@G@C@IAC@@@C@C@GAA@I@A@IAC@A@K@EAE@C@A@@@A@A@A@EAA@E@C@E@CAM@C@C@EAK@C@C@AAC@C@C@E@IA@@C@A@A@E@A@CAE@A@@@A@A@C@A@KAE@E@A@GAA@A@A@C@SA@@A@C@@@E@GAE@E@A@@A@A@@@@AA@@A@A@A@A@@@AAAA@AA@AA@@@AA@@AA@I@A@A@A@@@AD@D@DAB@@@@C@E@CA@@A@@BA@@@AAG@E@A@C@A@CAA@E@A@C@C@EA@@A@AB@@AAC@C@A@G@A@CAA@A@AF@BAF@@@@AF@DAB@D@D@@AD@DAD@@@DAD@@@BAD@D@DAD@D@@AB@B@B@DAB@B@BAD@DAD@D@DAB@@@DAD@B@BAD@D@@@BAB@B@D@BAB@DAD@D@@@BAB@@@B@@@DAD@DA@@D@BAF@B@DAB@@@@@DAD@D@B@@@BAD@DAD@@@DAD@D@BA@AJ@@@DCJCRCNALAH@BAJCNAHCP@@AJ@DCNCR@BAFANCV@DAJAHAHABADABAHCHAHCR@BCRAHAPADAL@BAB@B@BAH@@@BAF@BCPAJAFANCL@HAHAF@@AD@@CPB@B@@@B@B@B@B@D@D@BBB@@@B@@@B@@@B@B@D@BBD@D@B@B@D@DBD@F@F@DBD@H@BBB@B@B@D@D@B@DBB@B@D@D@B@D@FBB@B@D@B@@@B@@@B@B@B@B@BBD@D@D@B@B@@@B@B@BBD@B@D@DBD@@@D@B@B@B@B@B@BBD@B@B@B@B@B@B@@@BBB@B@B@@BBBBB@@B@@@B@B@B@@@@BBBBB@@@B@B@B@@@@@@@B@@B@@A@@@@@AB@DEB@B@BB@@B@B@B@BBB@@@BB@@B@@@@D@FB@DBBBDBBBDBDBDBDDD@BBDBDBDBBBDBBBDBB@BB@@B@@@DA@@BA@@BA@@DA@@@@BBDDBBBBBDBBDDFFBDDDBB@@BBBDDBDFBBBDBBBBBDDBBDBDDDDDDDDDDFDFFF@@BBBDDDBDB@BBBDDDDDBDBBDDDDBBDDBDBBBBBBBBBB@BB@@BCBCDEFGDCFCDEDCDAB@@BBBBBBBBBBBDDBBBBDBBBBB@@BBBBBBBBBDDBB@BBBBB@BB@F@D@HBHBHBH@HBHBHBD@B@D@DB@@DBDBB@BBBBD@@BB@DBB@BBB@BBB@DBBBDBB@DBBBB@DBDBBBDBDBDAFAD@FADADAFAHAFAHADADAHADAFAB@BA@@B@@@B@@@BBB@@@@@B@B@@B@@B@@@@@B@@@B@@@BB@@@@B@@@@@B@@@@@@BB@@@B@@@@@B@@@@@@@B@@@@@B@@@BA@@@@B@@@@@B@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@B@@@@@B@@@@@B@@B@@B@@@B@@@B@@@DBB@BBB@D@D@DBB@B@BBD@BBBBD@BBB@B@@BB@D@HB@@B@@@BBB@B@B@BBB@@@@@B@@@DBBAREVIFADAHADGB@@BB@PE@@F@@@RGLCLEDAB@DADAFCDAB@BAFADAFCFAFCFALEHCBAB@FCHA@@JEFAPGRIVIBAD@FCBANEPGB@FAFCJEB@@@LEBA@@LG@@BA@@DEHM@CDEJSFG@ADG@@JO@A@AFGBEHKBA@ABCDA@ABA@ABCB@@CBABCDC@ADEBA@@B@@CBABA@@BA@A@@BA@@B@@@@ABCDEBABCB@@AFGDEBAFGDC@@B@BCBABABCBCDADE@ADCDE@@@@BABCFE@@DEDC@@BABCBAFIBCBADEBA@A@ABE@@BA@C@A@ABA@@@@@@BABCBA@A@@@@@@@@BA@@@ABAB@BA@A@@BCB@BA@@@A@@B@@ABABABAB@BABAB@@AB@BABA@A@@BAB@BA@AB@BA@@@@@AB@@@@@@@BA@@BABABABCBADA@ABAB@@@@ADCBAB@BABCBABAB@@@@A@@B@@AB@@AB@@ABAB@@ABA@@BA@AB@BA@ABA@@BADC@ABABABABABABA@@@A@@@@B@BCBA@@@@@A@@@@@@@AB@BCBA@@BABABABA@@@@BABA@AB@@@@A@@BABABABA@A@@@@B@@CB@@AB@@ABABA@ABAB@@ABA@ABABAB@@ABCB@@ABA@@BABA@ABA@@@@DC@ABA@@DCDE@@@@@@@ABABA@@BC@@@@B@@@@@BA@ABADCB@BABABABC@@BABADC@@@@DABCB@BABABA@AB@BADC@@DA@AB@BABAB@DABABADABABABABABAB@@A@@BABA@ABA@AB@@@BA@AB@BA@A@@BCBADC@@BCB@AABA@ABA@A@@BA@A@C@C@A@A@@AAC@@@@@@@@A@@D@BA@@@CEB@I@ED@CGCE@AF@DAH@F@J@@@B@BBB@@B@B@DBBLAB@@@@BBBDDBDD@FABBBAB@@@B@@@B@@@B@B@B@B@@@B@@@B@@@DA@@B@B@B@@@B@@@DA@@B@B@@@@AE@@@G@@CH@HAB@B@@@BB@BBBB@@@D@B@@BB@@@B@B@B@B@B@@@F@H@B@B@@C@E@ABABCBC@A@@@A@A@A@@@ABA@ABCBA@ABEBCBCAA@@AC@AA@@AA@@BA@@@@AAACA@AF@AC@A@@@@BCBABABA@ABA@ABC@A@C@A@C@ABA@CBABABCBC@ABABA@@B@BADAB@DAB@B@B@BAB@BAB@BADADAB@DADADAB@BABAB@BA@@NBB@@ABI@G@@@@BA@A@@@AA@@@EEGEGIC@BG@EB@DA@@@AACAAG@@@@CBE@A@A@A@@AAA@A@A@@@AAA@AA@@@A@A@@@@@@@@@A@ABA@@AA@@AAA@@A@@@@@A@@@AA@@@BAA@BA@@@A@@@A@ADABAB@BAB@BAB@DAFAD@DABAB@BABA@ABA@A@@@AAA@@@A@@AA@@BA@C@@@A@AB@@AAA@CAAAAAA@AA@CAE@CAC@AAA@@AA@@A@@@A@@@@B@D@BA@@@A@ABE@CBA@@@ABAB@BABC@@@AB@@A@ABA@A@@@A@A@A@@@C@CAC@C@AAA@A@A@@AC@A@A@A@@@@@A@A@AACACBCDG@@D@BA@@@A@@ECAA@C@A@A@A@@@A@@@@@A@@BA@@FCBA@@@A@A@@AA@@C@A@GFCBADA@@@ABA@@BA@ABA@A@@@A@A@A@AAAEACAAC@AAC@A@A@A@@@A@AB@B@B@@AB@@@@@BA@@BA@@B@BA@@B@BAB@B@@@B@B@@@B@@@B@B@B@@AB@@ABA@@BA@AAA@A@A@AA@@A@A@@@AAC@A@@@EAA@A@AAA@C@A@AAC@A@AA@C@AB@BAB@BA@@B@@AB@BA@ABAAA@@AAA@A@A@A@AA@@A@AAA@AA@AA@@A@@AA@@@ABAB@B@B@@@B@B@B@B@B@@BB@@@BB@B@@BB@@B@@BBBB@@A@@@@@A@A@C@C@ABCBABCBA@A@@@A@A@A@E@C@CECKAKGC@GAE@EBIHMFG@G@K@C@M@E@EAG@I@EBABCBEAA@AACEECGEEECAEACACACAGAE@GAGBCDEHCHCFEDEDG@G@GCECAEAC@E@E@CBCAEACAAA@AAGASAGAE@E@CACDEBGBIHGDCDGBGBGBIBODEBA@@BCFEJADIJCBCBABGBKBE@E@C@O@GAA@C@A@C@CAGCC@CACCAC@EBIBG@CEGCCAAA@CCECCAGCECA@AAABE@A@A@C@AA@@AA@AA@BA@ABAD@BA@@@AA@AACC@@AC@C@A@@@ADEDEBADABAHCFEDC@C@C@CCCCCECGCGCE@EAE@C@A@CBEDCDKBKDE@G@UAE@ICCEAG@EBCBC@ADAFEBADABA@A@GEEAEAC@C@ABEDEBC@AACIGOCE@CACCKGIICECICGAECIEGIIYKKEGCIMAE@CGGKAIFGFEFEDW@gMSGGAG@C@EBEDEHGNEHEDADCDADCD@@AB@@@@@B@DAB@@@B@@ABABAB@BA@ADAB@@@B@BA@@BAD@B@@ABAB@@@B@B@B@B@BA@@BB@@@@B@@@@BB@@@@@BB@BB@@B@@@@@@B@@@B@@@@@@B@@@BBB@B@@B@@@@@B@DAB@B@B@FAB@DAB@BADAB@@ADCDADADADA@DBHBHDFBDB@BABBBBBBBDDB@B@B@@@@B@FA@GBA@CBWDYHBDFFSXEJ@HC@BLJBFBORC@@BI@@@ADCF@B@@@B@B@@@B@BA@@BAD@BABD@H@@@DE@ABD@B@ABC@C@C@A@A@EH@D@DAD@D@B@@@B@AH@B@@@@@@@@@BA@@B@D@H@BF@@@@@@@@BAD@@@BAD@@@B@@@D@@BBB@D@B@BBB@B@@CHBHGDEFEBDDDDDBB@BBB@@DADAD@DABBDFFACE@@JCJCFAJHFFD@HDBB@BA@ABAB@@@@DBFFFDFDDAB@EHAB@BIFIFDBGDABAAA@ABABABABA@ABAB@@CDA@CHAB@@AB@@@DA@"],encodeOffsets:[[128795,46827]]}},{type:"Feature",id:"231283",properties:{name:"海伦市",cp:[126.930106,47.45117],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@A@A@A@A@AA@@AA@A@A@E@CAC@C@C@EAC@A@A@C@EAC@C@A@C@CAC@E@A@C@C@AAE@C@A@C@A@EAA@E@C@A@C@C@CAC@A@C@C@E@CAE@A@G@C@AAG@C@E@EAA@A@C@C@A@A@A@AAA@A@E@C@EAA@E@A@G@A@AAC@A@C@A@CAG@G@AAG@A@C@CAE@AAE@C@A@CAG@I@G@CAE@A@C@C@C@CAA@C@A@E@A@C@AAA@A@C@A@C@GAG@EAC@G@GA@@@BB@@@@@@@@@@BB@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@B@@@@@@@@@@@B@@B@@@@@@B@@@@A@@@@B@@@@@@B@@@@B@@B@@A@@@@@@@@@A@@@@@@@@B@@@@@B@@@@A@@@@B@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@B@@@@@BB@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@B@@@@@@@B@@@@A@@@BB@@@@@@@@@@B@@B@@@@@@@@@@@A@@B@@@@A@@@@B@@@@A@@@A@@@@A@@@@@@@@@A@@A@@A@@@@A@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@A@@@AAA@@@@@BA@@A@@@ABCBCBC@@B@@@@B@@@B@@@@B@@@@B@@@@@B@BB@@@B@@@BB@@BB@B@BA@B@@@@B@@@@@@@B@B@@@B@@@@@@@@B@@A@@BA@@BA@@BA@@B@@@@@B@@@@@@B@@@@@BA@@@@BB@@@B@@B@@@@@B@@@@@@@B@@@@A@@@@B@@@@@FDBB@@@@@BB@@@@B@@A@AB@@@@A@@@@B@@@@@B@@@B@@@@A@@B@@@@@B@@@@B@@B@@@@B@@@@@B@@AB@@@B@@@@@B@@B@@B@@B@@@@BB@@@@@@@@B@@@@A@@@@@@B@@@@@@B@@@@@@BA@@@@@@@@B@@@@@@B@AB@@@@@B@B@@@BB@@B@@@@@@@@@@B@@@@A@@B@@@@@@@@@@@@B@@@@@B@@@@@@A@@BA@@@@B@@@@@@BB@@@@B@@@B@@@@@@B@@@@@@@B@@A@@B@@@@@D@@@B@@@@BB@@@@@BB@@@@@@@@@@@BA@@@A@@@@B@@@@@@AB@@@B@@B@@@@AB@D@B@B@DB@@@BBB@BA@@B@@@@@@A@A@A@@B@@@@D@B@@@@BBBBB@@@@AB@@A@AA@@@@AAA@AB@@@@A@A@@@@@@A@@@AA@@@@B@BA@A@@BAA@@AB@@A@A@A@CBA@@@@BBAB@@@@@@B@@CB@@@BBBB@@@B@B@@@@@@B@@A@@@A@A@@@BBB@@B@B@@@@A@@@@@@A@@@@E@A@@@B@@B@@A@A@A@@B@@B@B@@@@@@B@@AB@@@@B@B@@ABBB@@@@@AB@B@@@B@@C@@@@@B@@B@@A@@@BBB@@@DB@@@B@@@@@BAA@@AAA@@@@@@@@BB@@DABA@@B@@ABB@@@B@@@@B@@@@@B@@@B@@@B@@@@A@@AA@@@@@@@@BA@@@@@A@@@@BA@@@@@A@@@A@@@@@A@@@AA@@@@A@@@@@@B@@@@@@@BA@@@A@@@@BB@@@@@BB@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@B@@@@@BB@@@@B@@@@BA@@B@@@@@BBB@@@@@@BB@@@@@B@B@@A@BB@@@B@@@AB@@C@@@BB@@@BB@@@B@@A@B@@@B@@@@A@A@@@@@A@@@AB@BB@@@@@A@A@@@@BAAA@@@@@@@AB@@@@@@@B@@B@@@@@@@B@@@@B@@@@@B@@A@@@A@@@A@@@@@@@@B@@@@AB@@@@@@A@@@A@@@AB@@A@@@A@@@@B@@@@B@@@BB@@B@@@BAB@@@@@@B@@@@@@@B@@@B@@@B@@@@@@AB@@@@@BB@@@@@@@@AB@@@@@@@@@B@@@B@@@@@@@B@@B@@@@B@DB@B@B@@@@D@BABA@@DD@@@@@@A@A@@B@@D@@@B@@@A@AB@B@@B@BB@@@@@B@@@@BBB@BBB@B@@@@B@BB@@@B@AA@@@@@AB@BB@B@@@@A@@@@BB@@@D@@B@@@@A@@@A@AA@@A@@B@@B@@@@BA@@@@@C@A@A@@BA@@@DB@@@D@@@@@BB@B@@@@A@@@@BA@B@B@@@@B@@@@AB@@@@@B@@@@BB@ABB@@B@A@@B@@@@AB@FBB@@B@@BB@B@BB@BB@@BBAF@@ABABC@A@@BB@BB@@@@CB@@@B@@B@BBD@@@B@B@@AB@@@@@D@B@B@@BB@A@AB@D@@A@@@@@@BB@@@A@@B@@@B@@BA@@@@BB@@@@AB@@@@B@@@B@AB@@A@@@@BD@BB@@A@@@A@@@CAA@@B@@B@@@A@ABC@@@@@@B@BA@@BC@@B@@@BB@@@B@@@@AB@@BBABB@@@@@B@@D@BA@@@B@B@@@@@@B@@@@AB@@@B@@A@@@@B@BB@@@BB@@@B@@@@@@@@@@@@B@@BB@@A@A@@@@@@@@@BBB@@@@@@@B@@@@@@B@@@@@@A@A@AB@BB@@@B@@@@@B@@@@@@@@B@@@@B@B@@@AB@@@B@@@@DA@@@BA@@@@BBB@@@A@@@@B@@@@BB@@BD@@@@@@A@@A@AA@@@@B@BBB@@@B@@@@@AA@@@@B@DAB@BB@@@@@B@@@BB@@@@@AB@@@BBA@B@B@@B@B@@BBB@@D@BB@@@@@@@BA@AAA@@BA@A@@B@@@@BBB@@BDDBB@@@@ABAB@@@B@@B@@ABAB@@@@BAB@@@BBB@@B@D@B@@AB@BA@@@BB@@@@BFB@@@@@@AB@B@@@@BB@@B@A@C@@@AB@@F@@B@@A@@@@@@BA@@@A@AA@@AB@@B@@BB@@B@BA@BB@@A@@B@@AB@@@@@B@@BA@@@@@B@@BB@BB@@@@@@AB@@@@B@B@@B@@@B@@A@@B@@@@@BB@@@@A@A@A@@@@B@@@@B@@@B@@@@@@@@@@B@@@@B@@@@@B@@@@@B@@@@@@@@@@@@BA@@@@@@@B@@@@B@@@@@@@@@B@@@@AA@@@@A@@@@@A@@B@@@@@B@@@@@@@@B@@@@@@A@@@@@@@B@@@@@B@@@@@@@@@@B@@@@@@@B@@@AB@@A@@@@@A@@@@@@B@@@@@@B@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@A@@@@@@B@@B@@@@@@@@@@@@@A@@@@@A@@@@@@@@@AA@@@@@@@@A@@@@@@@@@A@@@@@@B@@@@@@@@BB@@@@@@D@@@@@@@@@@@@@@@@BA@@@A@@@@@@@A@@AA@@BA@@@A@@@@BB@@@B@@BB@@BB@@@BAB@@B@@@B@@@@B@@@B@@@@@@BAB@@B@@@@@BA@@@@B@@@@@@@@B@@D@DBB@@@@B@@@@AB@@@BB@@@B@B@@AB@BAB@B@@@BB@@B@@BB@@@@BB@@B@@@B@@@B@@@@BB@@@B@@ABA@@B@B@@@D@@BB@@@B@@@@A@AA@@@@AB@BB@@@BBAB@@@B@@B@@@B@@AB@B@@@@@@@@B@BB@@B@@A@@@@B@@B@@B@@@BB@@@B@@AB@B@@B@@@BA@@@AB@@@@BB@@@ABAB@@B@@@BB@@AD@B@@@@@@@@BA@AB@@@BB@@@@@BB@@B@@@@@@BA@@@@B@@@@BBB@@B@@@B@@A@@ACA@@A@A@A@A@@BA@@BB@@@B@D@@ABB@@BB@@AB@@A@A@A@@B@@@BB@B@@@AB@BB@@@B@@@B@@ABB@@@B@BB@@BB@BBBBDB@@B@B@AB@@A@@@@@@B@BA@@@AB@@A@@BAB@@A@A@@@@B@BA@@BB@@@B@BB@BB@@@B@B@@BA@@BABAB@@B@@@BBB@B@@@BBA@@@ABA@@B@@B@@@D@B@@BB@A@@BA@@@@B@@@@@BA@ABA@@B@@BBB@BA@@@@B@@B@@@BB@@@ABA@@@BBB@B@@@BB@@B@@@AA@A@@B@@@B@B@@@B@@@BB@@A@AB@@BB@@@@@B@@@@AB@@@A@@@A@@A@ABA@A@AB@@@@B@@@B@B@@@@B@B@@@BB@@@@BA@@@@BA@@@AB@@B@@B@@A@@BA@AB@BA@@B@@@@@BD@@B@@@@A@@B@@@B@@A@A@@@@B@@BBDDBBBBBBBD@BB@@B@@@B@B@@@BB@B@B@@@BB@@BB@@@@ABA@BB@@@@B@B@B@@@@B@BA@@@B@@BB@BB@B@B@@A@@B@@AA@@A@@B@@@@A@A@@B@@AAA@@@AB@@AB@@AB@@B@@BB@@AB@@@@@B@@@B@B@@@@@@B@@A@A@@@@B@@B@@@B@A@@BA@@BA@@@A@@@A@@@@@@B@@@BA@@@C@@@@B@@B@@@@@@B@@@B@B@@@BB@@@@@BA@@@@BBBB@@B@@@BA@AB@@B@@B@@@@BB@@@@@@BA@A@@B@@@@BBA@@@@@B@@@@@BB@@@BB@@@@@@@@BB@B@BA@@BA@@BBB@A@@B@B@@A@@@BB@@@@AB@@@BB@B@B@@B@@@BB@@@BAB@@@@A@@B@B@@@@B@@@B@@B@@@@@B@@BB@BB@@@@A@@B@@@B@@BB@@B@@AB@@B@@@B@BB@@@@A@A@@B@@BBBBB@AB@@@B@@BB@@@@A@A@@BB@@B@D@@@BB@AB@@AB@@@BA@@@A@@BA@@BB@@@@@B@@@@@BB@@@@B@@@@@BB@B@@@B@AB@@@AA@@BA@@BAB@@ABBB@@BBB@B@@@@A@@BA@@@BB@@@@AB@@@BBB@@AA@@A@@BAB@@@B@@BA@@@BB@BA@@BABAB@@B@@BB@@BB@@@BA@@B@B@@@@B@BBBB@@@D@@@B@@BA@@B@@@BB@@B@@AB@@@BA@ABCBAB@@@B@BB@@D@@BBDBB@B@BADBBAB@BA@BB@BBBB@B@B@@B@@@B@BAAAB@@@B@@B@D@@@BBBA@@@ABA@A@@B@@B@BBBB@B@@A@A@@B@BA@@B@@B@@BB@A@@@@@@B@@BB@@ABA@C@@@@@@BB@BAB@@BAB@@@B@@@@A@A@@@@@AB@B@BB@DDBBD@@@BB@BAB@B@B@BBBB@B@BB@BB@BA@@@A@ABA@@B@B@@BAD@B@@@BB@B@@@D@@BD@B@B@@@D@@@BB@@@BAB@@@BB@B@@BB@@AB@B@B@@B@BEB@@BBB@DBB@B@BAB@@@B@@B@@ABA@@B@B@@@BBBB@B@BA@@B@@@BB@@@B@BAB@B@@BBBBDB@B@B@B@BBBBD@@A@CBA@@@@BB@B@D@@@B@@B@@AB@BB@B@B@@B@B@B@B@B@@@@B@@@BAB@@AB@@@B@@@@B@BA@@BA@BB@@B@B@BBBBB@@@BABAB@@@B@@@@B@B@BA@A@ABA@@@@BB@B@BBB@@B@BB@B@BB@@@B@B@@DBBB@BBB@@@B@@AB@@@B@@AB@@A@@ABA@@A@@@ABA@C@@B@B@@@@A@@@@BBBB@@B@B@BABBBDDBBB@B@@@@A@@@@B@@@BA@@B@BB@@AB@@@B@BB@@BB@@@B@@@@B@@AB@@@B@@B@@@B@B@@@BAB@@@B@@B@@@@B@@@@@B@@AAAB@@@@@B@B@@BB@@BB@@A@@B@@@@BBB@@B@B@@AB@B@@BB@@BA@@B@@@@BBB@@BB@B@@BA@@@AB@@ABA@@B@@@B@@A@AA@@A@@BB@@@@B@@@B@@@@A@A@A@@AAB@@A@@B@B@@@B@@@@@@A@@@@@@@AB@@A@@@@B@@@BA@@@@@@@BBBB@@@B@@A@@B@@@@@BB@@B@B@@@@ABABB@@BB@@@B@@AB@@B@@@@DB@@B@@AB@@BB@@BAB@@@BB@B@B@@@AB@@A@B@@BB@@B@@B@B@B@@AB@@B@@@BAB@BAB@B@B@B@@@@BA@@B@B@@@@@BBABB@@B@BB@DB@@@BA@@@@B@BA@@BA@@@B@@@@@B@@@@B@@BB@@@BB@@BB@@B@@@@A@AB@@@B@B@@@BB@@B@@@B@BA@@@AB@@@@@@B@@@@@@B@@B@@BB@@B@@@@A@A@@@@@BB@B@@@@B@BB@@@BA@@BB@@@@AB@@@@@BB@@@@@@ABA@@BA@@B@B@@@@@B@@@@@B@@A@A@@AA@@@A@@B@@@@@B@@B@B@B@@B@@BB@B@@@B@@@B@@A@@@AB@@@@BB@@B@B@@@B@@@@@@B@B@@@BB@@@@AB@B@@B@@@@@BA@AB@@A@@B@@B@B@@BA@@B@@@B@@@B@@@@B@@@@AB@@@@BB@@B@@A@A@A@@BA@@B@@@@@B@@B@@A@@@AB@BB@@@BB@AB@@B@@@BB@@@@@BA@@@@@@B@@B@B@B@@@BB@@B@BB@@@B@@B@@@@@BA@@B@B@@@B@@@@@ABA@@B@@A@A@@B@@@@B@@@BBB@BBB@@@@B@@A@@@@BB@@@@BBBBABB@@B@@B@@@B@@B@@@@BBB@BBB@@@BB@B@B@@AB@B@@@@BBB@@D@BAB@@B@BBBAB@BB@BD@@B@B@BABBB@@@CB@@@B@BA@AA@BA@@@BB@D@D@@BB@@BA@@B@B@@DB@B@@BAB@@@BDABB@@@B@@A@A@@B@BB@AB@@@BBBBBB@@BABC@@B@@@@@B@B@BBB@@B@D@@@B@@BAB@@BB@@D@@B@@@@C@C@@B@@@B@@A@@B@BB@@BBBBBD@@@B@D@@@@@AB@@BBBBBF@BB@B@@A@A@@B@@BB@BBB@BAB@BABB@@BBB@B@@AD@BB@@A@@B@@DB@@BBB@AB@@@B@@B@@@B@B@B@B@B@@BDBAB@BB@BB@@BD@@@@B@B@B@@@@@AB@@A@A@@BB@BBFABBBABBB@BABBB@@BB@DBBBB@BAB@BDBB@B@DB@DBB@DBBDB@BB@@B@BA@@AA@@BA@BB@B@@AB@@@DBBB@B@@@@B@D@D@@@BB@@BAB@@BABB@B@@BBAB@@B@@BB@@@B@@BBB@@B@@@@B@B@@@BB@B@@@B@@AB@@BBB@@AB@BABBBA@@@AB@@@B@BB@AB@@@B@AB@B@@BBB@ABB@BBB@B@@@B@BA@@BA@B@BB@BBB@@BB@@@DA@@DD@@A@AB@@ABBB@@B@@AB@@B@B@B@@B@BB@@BBB@@@BBB@B@BB@@B@BAB@@@B@B@BBBBB@@B@@@B@BB@B@@@@@AB@AA@@@@B@@AB@BB@B@@BA@A@@B@@AB@@@B@BBB@@A@@@@@@A@@AA@@@B@B@@AA@@A@ABBBABB@B@BBB@B@@B@@@B@@B@@A@@B@B@@B@@C@@@BBBBBB@@B@@@@B@@B@@@BA@@B@@BA@A@@@@BB@@@B@@@B@@@AB@@@B@@@@@@B@B@@@AA@@@@BA@@@@@@@@BBB@@@@B@@B@@@@A@@B@BA@@B@@@B@AB@@@@A@@B@@@@@@B@@A@BB@@B@@@@@@B@@@@A@@A@@A@@@@B@BBB@@BAB@@@@B@B@@B@@B@B@B@@B@@A@A@B@B@@@BB@@@B@@@@B@BA@@@@@@@BB@B@DAB@@@B@@@@B@@@@B@@@@@@BB@B@B@@@BB@@@AB@@@@@BD@B@@BA@AB@@@@B@@@@@B@@@BB@B@@@B@B@@@B@@@B@@@@@@@@A@@@@B@@@B@@BB@@A@@@@@A@@BBB@@BB@B@@@BB@@B@D@@@@A@@@@@AB@@@@A@@A@@A@@@@B@@@@@BB@@@@@@@B@@@@B@B@@@@@B@@@B@@A@@@@@A@@BB@@@AB@@@@@B@@BD@@AB@@@@@@BB@@@@AB@B@@@@B@@@@B@@@@A@@@@@AB@@@@B@@B@@A@A@@B@@@@@B@B@@@B@@@BB@@B@@@B@@@A@@BB@AB@@@B@@@@B@@BA@@@@@@BB@@@@@@@B@@@B@@@B@@@@@A@@@@@BB@@@@A@@@@B@@B@@@@@@@@B@@@@@@@B@@A@@@@@@B@@@@@@BB@@@@@@@@B@@@B@@@@@@@BB@B@@B@B@@@B@@@@@@@A@@@@@@A@@B@@@@@B@@@B@@@@@@@A@@AB@B@@@@@B@@@@BB@@@@@@A@@A@BA@@@@B@@BB@@B@@B@BA@BB@B@@AB@@@@@BB@@@@@B@@B@@@@@B@@@@BBA@@B@B@@@@@B@@@B@@@@A@@@@@ABB@@@@B@@BB@B@BA@@@@@@BB@@@BB@@@@A@@@@@@@AB@@@@@B@@BB@@@BB@AB@@@@AB@B@B@BB@@BB@@B@B@@A@@A@@@@AB@@@A@@@AAB@@@@@@AA@@@D@@@@@@A@@@@A@@@@@@A@@@@A@@@@A@@@AB@B@@AA@@@A@@@@@@@BA@@B@@A@@@AB@@B@@@@@@@@@@B@B@@A@@BB@@@@@@@A@@@@B@A@BA@@BB@@@@B@@A@@B@B@@@B@B@@@@@B@@@B@@@@@@BA@@@B@B@@@D@B@@@B@@@@AB@@@@BB@B@@@@@@A@@B@@@@A@@A@@@@AB@@@@BB@@@@A@@@A@@@@@@B@@@@@BA@@@@@BB@@@B@@@@AB@@@@@B@@@BA@@BBB@@@B@B@B@@@B@@@B@@BB@@AB@@@B@@@B@@@@@@B@@B@B@BB@@B@@@B@@@@@B@@@@A@@@A@AB@@@B@@@@@BA@@@@B@@@@A@@@A@@@@B@@@@@@@B@@@BB@@@@B@@@@AA@@A@@@@B@@@B@@@@AA@@A@@@@@@B@@B@@B@@@@@@A@@@@@AB@@ADA@@BAB@@@B@B@@@B@@@@@BA@@@B@@@BB@@@B@@@@A@@B@@@@@B@@@BAB@B@@@BA@@BA@@B@B@@BB@@@@B@@BB@@BA@@@AB@@AD@B@B@D@@@B@@@B@@@@@@@@B@@@BB@B@D@@@BB@@AB@@@@B@@@@AB@B@@@B@B@B@@@BA@@@@BB@@B@@@BB@@B@@@@@@@AAB@@@@@B@@A@@B@@B@@@B@@@@ABA@B@@B@@B@@@@@@@B@@@B@@@@@@AB@@@@@@@B@@@@@B@@@@BB@B@@@@B@@BB@@@@B@@A@@@@B@@B@@BA@BB@@@A@@BA@@BB@@@@AB@@@@BB@@@@@@A@@@@BA@@@@@AB@@@@BB@@@@@@B@@B@B@B@@@@@B@@B@@BBB@@@@@@@@B@@B@@@@A@@B@@A@@@@B@@@@A@@@@@@@@@AB@@B@@@BB@@@B@B@@@B@@AB@@@B@@@@B@@A@@B@@@@B@@A@@B@@@@@B@@@B@B@@@B@@@@@B@@BA@@@@@@@A@@@A@@@@@@@@@@B@@@@@@B@@@@B@@@@@@B@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@B@@@@@@B@@@@A@@@@B@@@@@@@@@@B@@@@B@@B@@A@@@A@@B@@@B@@@@@@A@@@@B@@@@BB@@B@D@@@B@B@B@BBB@@@B@@@@AB@@@B@@B@@@B@@@B@@@B@B@B@@A@@@@BBBB@@@BBB@B@B@B@@@@@B@@B@@B@@@@@B@@@@@@@@@@@A@@B@@@@@@BB@@@@B@@@@B@@B@@@@@BB@@B@@@B@@BB@B@@@B@@@@B@@B@@@B@@@B@@@BB@AB@@@BB@@B@B@@@@@@@B@@@@@@@B@@B@AB@B@@@@@B@B@@@B@@@@@B@@@B@B@B@@AB@B@@@@@@B@@@@BB@@BB@@B@BB@@B@@BB@@B@@BBB@B@@@B@@@@@@A@@@@B@@@B@@B@B@@@B@@@@@@BA@@B@B@@@@BB@@B@@A@@@A@AB@@@@AB@@@@BB@@BA@@@@@@@A@B@@B@@@B@@@@BB@@@@AB@@@@@@B@@@@@BB@@@B@@@@A@@A@@@@@@@@@B@@A@@A@@@@A@@B@@@@BB@@@B@@@B@B@@@@@BA@@@@BB@@@A@@@@B@@@@@@@@@@@@B@@@@B@@@@A@@@@B@@@@@B@@@@B@@B@@@@B@@B@@@B@BA@@B@@BB@@@@@@@@@A@@@AB@@@@B@@@B@@BB@DB@@@@@@@A@@B@@@B@@@B@@@@A@@@AB@@@B@@BB@@@@@B@@@@BB@@@@@@@B@B@@@B@@@B@@@B@@@B@@B@@B@@@@@BB@@@@@@@@A@A@@B@@@@@@A@@@@@@BB@@@@BB@@@BB@@B@@A@@A@@@@AB@@@B@@@@BB@@@@@@@B@@@B@@B@@@@B@B@B@@B@@A@@@@@@BB@B@@@@@@A@@@@B@B@@@@@B@@@AAB@@@@@B@@@B@@@@@B@@@@BA@@@@@@BB@@@@@B@B@@@@B@@@@A@@@@@@B@@B@@A@@@@@B@@@@@@@@B@@@B@@@B@@@@@@@@@@B@@@AB@@@@@@B@@A@@@@B@@@@B@@@@B@@@@A@@AA@@@@@@B@@@@@@B@BBBA@@@@@@@@@@B@B@@@A@@BA@@@BB@@@@@A@@@@D@@@@BBB@@B@BB@@@ABA@@B@@@AB@@B@@B@B@@@@BAB@@B@@B@@@@@@@@A@@@@B@@@B@@@B@B@@AB@@@@@@@B@@@@@B@@@B@BAB@D@@@B@@@B@@@@@@@@AA@@@@@B@@@@@@@B@@@@A@@@@B@@@@B@@@AB@@@@@@@@B@@@@B@@B@@A@@@A@@@@@@@AB@@@@@@BB@@@@B@@@@@BA@@@BB@@@@@B@B@@@@@@@@@A@BB@@BA@@B@@@@@@@@BA@@@@@@BB@@A@@BB@@@@@@@B@@BAB@@@B@@@@@@@AB@@A@@@@@B@@BB@@@@@@@B@@BA@@@@@@@@@B@@@BBA@@@@@@@A@@B@@B@@@@@@@@@@@B@A@B@@@@@@@B@@@@@A@@B@@@@BB@@@@@@B@@@@@@@B@@@@@@A@@@@BB@@@A@@B@@@A@@@@@@@@@@@@@@AB@@@@@A@@AB@@@@BB@@@@@@@@@B@@@@A@@@@B@@@@@@@B@@@@B@@@@B@@@@@@B@@B@@@@@@@@@@@@A@@@@B@B@@@@A@@@@A@A@@@@A@@@@B@@@B@@@BB@@@@@@B@@@B@@A@@@BB@@@@@B@@@@@@@@@@@B@@@B@@@A@@A@@@@B@@@@@B@@@@B@@@@@@@@@B@@@@@BB@BA@@@@BA@@@@B@@@@A@@BA@@@@A@@@@AB@@@@@B@@@@B@@@BB@@@D@@@@@@@@AB@@@@@BA@@@@A@@@@@@@@A@@B@@B@@B@@@B@@@@@B@@@@A@@@@A@B@@@BA@@@@A@@@AA@@@@BABAB@B@B@B@@@@B@B@@B@B@@@BB@@@@B@@@B@@@B@B@@@B@B@@@BA@@B@@@@A@@@@@AB@@@@B@B@@@@B@@@@@@@@AB@@@@B@@@@@@@@@@B@@@@B@@@@@@@@@@@@@B@@@@@@B@@@@A@@B@@@B@B@@@@@@A@@@@@BB@@AB@@@@B@@B@@@@@A@@@@@@@@@@@@@B@@@@@B@@B@@B@@@@@B@@@BB@@B@@@@@@@@A@@@@B@@@@@@@@@B@B@@@@BB@@@B@@@@@@@@@@@@@B@@@@AB@B@@@@@B@@@B@@@@@B@@@B@@@B@@@@@BA@@B@@@@@@@B@@@B@@BBA@@@@@AA@@@B@@AB@@@B@@@@@B@@AB@@@@@@@@@@ABB@A@@@@@@@A@@@@@@B@@@@B@@@@B@@A@@@@@@@@B@@@@@@@B@BA@@B@B@BB@@B@BA@@B@@A@@@@@@A@@@@@@@B@@A@@@@A@BA@@D@DBD@B@B@@@B@B@B@@@@A@@BB@@@BB@B@BBF@@@@BB@BA@B@@@@@BB@B@B@BBB@@@@@@B@@@@BBBA@@AD@@B@@@@@@@B@@BB@@AA@@@B@B@@@B@@@@@AA@@B@@@@@BB@@@B@@B@@@B@ABB@@@@@BA@@@BB@@@@BA@@@B@@B@@@@@B@@@@@BB@BB@@@@@A@@B@@@BB@@@@C@@@@B@@@@BBD@B@B@@@DBBBB@@@B@@@@A@@DA@@@@A@@@@@@@DAB@D@@@BB@@BAB@BBB@@@A@BB@@@@B@@@B@@@B@@B@A@@@@B@@@@A@@@@@@@A@BB@BB@@@@A@@@@BB@@@B@@@A@@BBB@@@B@@@@B@BA@@@@@@@@@@A@@@BA@@B@DA@@@@@@@@@@A@@@@@B@@@@@@A@@B@@@B@@AB@@@@@@@BAABB@@@@@@@@@@@B@@@@B@A@@BAB@@@@A@@@@BB@@B@@AA@@@B@BAB@@@@@B@@BA@@@B@@@@@@@@B@@@@@@@@BB@@@@BA@@@@B@@@@@@B@@@@BBB@B@@@D@@@A@@@@BB@BA@@@@@@B@@A@@A@@@B@@@@@AA@@@@A@@@@@@@@@@@@@@@@A@@@@@A@@BB@AB@@BB@@@@@@@@@B@@@B@AB@@D@@@A@@AB@@@@@@@@A@@A@@@@AB@@@B@@BB@B@B@B@B@@@D@@@@@B@A@@@B@BA@B@@@@@@@@@BBABB@A@@B@@@@@@@B@B@@@@@@B@@B@@@B@B@@B@@@AB@@@@A@@B@@@BB@@F@@@@A@@@@@@@A@@@@@@B@B@@@@@A@@@A@@A@@B@@@B@D@B@@@@@@@B@BBB@@@D@DA@@B@@@@@@AB@@@@@@B@@@@D@@@B@@B@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@A@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@AB@@@@@@@@@B@@B@@@@@@@@@@A@@@@@@@@@@@B@@@B@@@B@@@@@@@@@AB@@@@A@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@AB@@@@@@@@@B@@@@@B@@@@@@@@B@@@@@@B@@@@@@@@A@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@B@@@B@BBBBHDBBB@FBFBDDBBBBB@D@BBHBBBD@D@D@DBBBB@BBBAFDB@DAB@@A@@B@@@@@B@@@@@DBHB@AF@DAB@BBA@ABA@AB@BDBD@DBB@B@B@@BF@BBBBDDDBJ@D@BBB@@B@@ADBD@@@BB@@@@@B@B@DBDBDBD@H@B@BBF@BBDBBB@@@D@@BBD@DBD@D@F@D@@@D@HDBBBAB@D@@BD@BBBAB@D@DADAB@D@H@F@BAB@DAF@FAD@F@BAH@D@B@D@D@FBDBHBBBD@@@B@FBD@D@BBD@HBB@D@B@F@D@DBDAB@DBD@@B@BDB@@D@@@@@B@@BBA@@@@@@B@B@@@B@@@B@@@B@@@BB@@B@@@D@BBB@B@@@B@B@B@D@D@F@B@@@B@B@F@B@@@@AB@BAA@@AB@@@@@@@@@@@@A@@@A@@BA@@@A@@@@@@@A@@A@@A@@@@@ABA@@@@@A@@@@@@@@BA@@@A@@A@@AA@@A@@@AB@@A@@A@BAA@BA@@AAB@@AA@@@B@@AB@A@@A@@@@@AA@@AB@@A@@@A@@@@B@B@@@@@@A@A@BBA@AB@DA@@@A@@B@@@B@@@@@@A@@@A@@@@BAB@BAB@@@@@B@@@@@@A@@@A@@BAAA@@BAAA@@@@B@@@B@@A@@@@@A@@@@@@@A@@@A@@@@@A@@@AB@@@B@@A@@B@@AB@@@@ABA@@@@AAA@@A@@@ABBBBD@@BB@@@@@@ABA@@@AB@A@BA@@@@AA@@@A@@@@B@@ABBBA@A@A@@@AB@B@@A@@BA@@FAB@B@DAB@DBB@@A@@BCDAF@B@B@D@DBDBB@BAD@FAD@@@@ABC@@BAB@B@H@D@BBHBDBBBBBB@DBB@B@FABA@A@A@@AAAA@@BA@AAA@A@@DE@@@E@@A@EABADA@A@A@@@AACAA@@@AEC@AAG@GBC@AAAAAAAAAECCCEECAAAAAAA@@A@AAQ@C@EBA@A@@@@@AAA@@@AAA@A@@@AAA@@@CBC@A@C@A@@B@@@@@D@B@@@B@@AB@@@@A@A@@@C@@@ABA@CB@@A@@@A@A@@@AB@@A@A@@@A@@@CAAA@@A@@A@@A@@BC@CDABEBABABA@@@A@@@A@A@CA@@A@A@@@A@A@ABA@A@A@A@A@@@AAA@@@@@@@@AB@@A@@@AA@@AAAA@@AA@AAA@@@A@AA@@A@A@@A@@A@@AA@AA@@A@A@@@A@@@@@A@@@@@@@@ABA@A@A@A@@AA@ABCHEDCLG@A@ABABCBCBA@ABEBC@@AAC@EAEEACAAECAAA@A@AAAAAAACA@A@C@A@AAA@A@A@EAEA@AC@GAE@C@CBC@CAC@ABC@C@CAGAA@C@C@EAA@CBA@ABADABA@C@K@G@A@CBC@C@K@C@AAC@CAAAC@EBCAEAC@ABEBA@A@ACA@AAA@@@A@EDCBA@KBB@A@@BA@@@@AA@@@@@@@@@A@@@@@@@@@A@@@@A@@@@A@@@@@@B@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@A@@@A@@@@@A@@@@@A@@@@@A@IKOQAEGUM@@@A@A@@AA@@@AAA@A@@@AA@@C@@AA@@@A@ACG@@AAAAACACCEAACAC@E@A@CBEBE@C@EAG@A@GAC@E@A@ACCCCCAA@C@AAACEAA@@EE@CA@AADC@AAAEAACEACAECC@CA@ABCBACAAABCBCAAACDCE@@@C@AA@A@C@@CCA@ECCA@@@A@@A@@AAA@@A@@A@@@@@@@@@A@@A@@AA@AA@AA@@@@A@@@@A@@A@@@@@AA@@A@@@@@@@AA@@@AA@@A@@AA@@AA@@@AAA@A@@@@AA@@@C@A@@@@@@@A@@AA@@@@@@@@AA@@@@@AA@@@@@@@AA@@AA@A@@AA@AAA@AACAA@@@AA@@@@@AA@@A@@@@AAA@A@@@A@A@A@@AA@A@A@@@@@@A@@@@A@@A@@A@@AA@@@A@@A@@A@@@A@A@@@AA@@AA@@@@@AA@@@@@AA@A@AA@@@@@A@AA@@A@A@@@A@@@AAA@A@@AA@@@AA@@A@AA@@@@@@A@@A@@@@@@@@@A@@@@B@@@@@AA@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@A@@@@@@@@A@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@BB@@@@@@@@@@@@@@@A@@B@@@@A@@@@@A@@@@@@@@@@@B@@A@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@AA@@@@@@B@@@@@AA@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@AA@@@@@A@@@A@@@@@@@@A@@@@@@@@B@@@@@A@@@A@@A@@@@@@@@@@@@@@@@@@B@AA@@@@A@@@@@@A@@@@B@@AA@@@@@@@@A@@@@B@@@@@@@@@@@AA@@@@@@@@A@@B@@@A@@@@A@@@@@@@A@@@@@@A@@@@@@@AAB@AA@B@B@@@@@@AA@AA@@@@AA@@@@@AA@A@@@@AA@A@@@@@@@@A@@@A@A@@@@B@B@@@@AA@A@@A@@@AA@@@A@@@@AA@@AA@@BA@A@@@@A@@B@B@@A@@@@A@A@@@A@@@@@AA@@@@@A@@AB@@@B@@A@@A@@@AB@@@A@A@@@@A@@@@@@AA@@A@@@BA@@A@@AA@@BA@@A@@B@@A@@@@@@@@E@@@A@@@@@@AA@@@@@A@@@A@@@BAA@A@@@@A@@A@@A@A@@@@A@@A@@@A@@AAA@C@@@CA@@@@@AB@B@@@@@B@@@AA@@A@@@A@@@@A@@@@B@B@@@@AA@@@@AA@@@@@@AB@@@@@@@AA@@@@@@@@@@AAAB@A@@@@@A@@@@A@@AB@@@@A@@@@@@@@@AB@B@@A@@@@@AA@AA@@CB@@A@@A@@A@C@CAC@@@@@@AA@@A@@@@B@@A@@E@@@A@A@@@@@@A@@@@AAA@@@@@@AB@@@@@@@AAB@@@@@B@A@@A@@@@B@@@@@C@@AA@AA@@@@A@CA@@A@@@A@@@AAA@AA@@A@@@@@B@D@@@@AA@@@BAA@@@A@@A@@A@@A@A@@BA@@@@A@@A@@AA@@AA@@AAA@BA@@@@C@A@@A@@@@BA@@A@@@A@@@@@A@@@AA@@A@@@@A@@@@@@@@@A@@A@@A@@@@@@AA@@@@B@@AA@@@@A@AA@@@@@BA@@@@A@AAAA@@AA@C@@BA@@AA@@BA@@@@A@@@A@@@@@A@@A@A@@A@@@@@@@@A@@@ACBA@@A@@@@B@@@@A@@A@A@@A@@@AB@@@@@B@@@@AA@@A@@B@@@@@AA@@AAA@@@AAB@B@@A@@A@@A@@@@@AB@@C@@@@B@@@BAB@B@@ABA@A@@B@B@@@@AB@@@AAC@@@@A@@@@D@@A@@AAEAA@@@@A@AAAC@@AB@@@@A@@AAAA@A@@@A@@A@@@AA@@@@@BA@@@@CAA@AB@@A@A@@@@A@@@@B@BA@@@@A@A@@A@@@A@@AB@@AA@@AA@A@AAAAA@@@@@A@@@@@A@A@@@AAA@@A@@@@B@B@A@@@AA@@@AB@@@@@B@@@B@@@@A@@AACAAA@A@@@@@AA@AA@@AA@A@ABA@@AA@A@@@@@AB@AA@@AAB@@@BA@@B@AA@@AAA@AB@@@AA@C@AAA@@A@@BA@@@@@A@@@@@ACBAA@@@@@@@A@@A@@@A@@BA@@@A@BA@@A@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@B@@B@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@AA@@@@@B@@ACAA@@A@@@A@@@@@@@@A@A@@@A@@@AA@@B@@@A@@@A@A@CA@@@BA@AA@A@@AAB@@@AA@@AB@@@@A@C@@@@AB@@@@@A@A@@A@A@@A@@@@@@B@@@@AA@@@ABA@@@@A@@@@@A@@BA@@@@A@A@@@@A@@@@@AA@@@@@@@@B@@@B@@AA@BAA@A@@A@@B@BAB@@@AA@@E@@@@@@@@@BAB@@@@@CA@@@@@AB@B@@A@@@AA@AA@@@@AB@@@@A@@@@@BA@@@@AA@@@@@A@@A@@@@AC@@AA@@AA@@AA@@@@AD@@A@@@A@@AA@A@@CAA@A@A@@@@@AB@A@@@A@@@@AA@A@AB@@@@A@@@A@@@A@A@@A@AA@@@@AAA@@@@@A@@B@@AA@@AC@@A@@@@B@@A@@@A@@AAA@@B@@@@@@@@A@@@@@AB@@A@@A@@A@BA@@AA@A@@@@A@@@A@@@A@@@@@AAB@A@@@@AA@@@@D@BA@@@AA@@@A@@B@@A@@@@A@@A@A@A@@A@@@@DAB@@A@@@@@A@@@AA@@AB@@@BBB@B@@@@@@@AA@@@A@@@@@@@@BB@@@@B@@A@@AA@A@@@@@@@A@@A@@B@@@@A@@AA@@@AAAA@@A@A@@AA@@@A@A@@@@@@@@AB@@@@AAA@@A@@B@BA@@AA@AAA@@@AA@@@@A@@@BB@@A@A@@A@@@@B@AA@@C@@@@AB@@A@@@ACBA@@@@A@@@@A@A@@@A@@@@AAA@A@A@@@@@@@AA@@AA@E@@@A@A@@@A@@A@@BA@A@A@@@@@@@@@ACB@@@A@@@@B@@@@A@@A@@@@A@@@@AB@@A@BA@@A@@@@@@@@@@@AAA@@AB@@@AA@@B@B@@@@@A@AA@@@@@@@AE@AA@@@@B@@@B@B@@@@CBA@@@AAA@@@A@@@A@@@A@@@@@@DA@@@@@@A@C@@@@A@@@@@@B@BA@@@@BA@@@A@@A@@@ABA@@BA@@@C@@AA@@A@@@@@AC@@A@@@A@@@@AB@@A@@@@A@@C@@A@@A@B@@AB@B@@AC@C@AA@@AB@@AAA@AAC@@@AA@@@AAA@@@@D@@@B@A@@AA@A@A@@A@@B@B@@A@@@@ABA@@ABA@@@@C@@@@AA@@@AB@@@A@@AA@@@BA@@@@AB@@@@AC@@@@@@A@@@@@@AB@@@@A@@@@@@@@@B@@@A@ABA@A@@@@A@@CA@@A@@@@@@@@A@@A@@@@@@@@@B@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@AB@@@@@A@@@@A@@@@@@A@@@@@@@@A@@A@@@@@@B@@@@@@@B@@@@@@A@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@AB@A@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@A@@@@@@AA@@@@@@@@@A@@A@@@@@@@A@@B@@@@@@@@B@@B@@@@@B@@@@@@@@@@A@@@@B@@@@@A@@@@A@@@@@@@@@@@@@@AA@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@B@@@@@@A@@@@A@@@@@@@@A@@@@A@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@B@@@@A@@A@@@@@@@@@A@@@@AB@@@@@@@B@@@@@@@@@@@@A@@@@B@@@@@B@@@@@@@@@@A@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@B@@A@@@@@@@@A@@A@@@@@@@@@BA@@@@@@B@@@@@@AB@@@@@@@@@B@@@AA@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@A@@B@D@@@@AA@@A@@C@@@@AA@@AB@BA@@AA@@@@BA@@@A@@AB@A@@A@B@@AB@@@@@A@@@AA@AA@@@@AA@@A@@@@D@@@@@@ACA@AA@AB@A@@@@A@@@AB@@@@AA@@CA@@@@BA@@@@A@C@@@@@@@@ABA@@A@BA@@@A@@A@@A@@AC@@A@@AA@@@@AB@@AA@@BA@@@@@G@E@G@OAOAI@IAC@C@AAE@UACAC@A@GAI@UCIAC@@EBSBCI@M@E@CAC@E@G@A@CAC@E@A@C@C@CAE@C@A@C@C@@@C@@@E@I@G@KAEAM@QAKAI@KAC@@@@@AEAC@C@G@CAAA@A@E@E@G@A@A@ABABA@C@E@G@A@A@A@@@G@E@E@A@@@@@A@C@EAC@C@C@C@C@@@A@C@CAC@A@@BC@E@EAA@G@E@E@E@G@M@A@@BA@@BC@A@A@C@A@A@C@A@C@A@C@ABA@A@E@A@@@A@A@A@C@A@@@C@A@A@ABA@C@C@C@A@C@@@A@C@A@C@A@EBE@C@C@C@E@A@A@@B@DEAE@E@G@C@E@CAC@E@E@E@A@C@A@A@@@C@C@E@C@@@EAG@CABUBG@GBC@@@@@@@C@C@CBC@C@E@ABI@E@E@IBA@A@K@E@EBE@G@E@EBE@E@E@GBE@G@C@@@A@@C@A@A@A@C@A@AAC@C@A@A@E@A@A@C@@@C@A@C@CB@@C@C@A@A@E@A@A@A@A@C@E@A@C@@ABC@C@A@A@@CAA@@@A@A@A@A@A@A@@@AAC@C@@@C@C@A@C@A@G@E@E@EAE@E@A@E@A@A@C@C@E@C@I@C@"],encodeOffsets:[[129504,48120]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("伊春",{type:"FeatureCollection",features:[{type:"Feature",id:"230702",properties:{name:"伊春区",cp:[128.907257,47.728237],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCUKECOG_KOCK@IDKJSVCJQ^@JAHANAB@@@BBP@NH`JVJPLPJLHDDBVBN@HDDDBBBBB@JCFCJ@LFHDBEDCJEBCBIHEHEDCLAFDLHDDFJHHLAAMISKGAEDELAJ@FCBIIEGEQCGEII@@JMH[AM@CKKUKUO"],encodeOffsets:[[132013,48837]]}},{type:"Feature",id:"230704",properties:{name:"友好区",cp:[128.836291,47.841032],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCGCM@UACAGCIKKOIOYL]NmPiNeJEBWB_@a@A@WDEDKFILGTEZC\\QzOpWhAFSZWZ]Z[PSP]ZSJSBSAYGKIOUEKMCI@[FSDOAGGEKA]CIGEMCQ@YAOEIGEIAMAOAIGGABABC@ABA@ABA@AB@@C@@B@@A@@@@B@@@@@DAB@B@@@BA@@B@@@@AB@@@@A@@@AB@@A@@@A@@BA@@@A@A@@@@@A@ABA@@@@@AB@@@@AB@@@@ABABABA@@BA@@@@@@@@@A@@@@@@@A@@@A@@@A@@@ABA@@BA@@@ADC@ABABA@@@A@@@@@CACAA@C@AAE@A@CA@@@@ABA@@@A@@@A@@@A@A@@@A@AAA@A@C@@@A@@AA@@@@@@@A@@@@@@@A@ABA@@BA@A@@@A@@@A@@BABA@ABABCBCDABABAB@@@@A@@B@@BB@@A@@@@@A@AB@@A@AB@@@B@@A@@B@@@B@@A@@BA@@B@@A@ABAB@@A@AB@@@@AB@@A@A@A@A@@@A@CBCBA@@@A@A@@@A@A@A@@@A@C@C@A@C@A@A@@@A@A@@@CA@@A@@@A@ABA@A@@@A@@@A@ABA@ABA@ABA@@BA@@@@A@@A@AA@@A@A@AAA@AAA@AA@@AA@AA@@AA@A@@A@@A@A@C@A@A@@@AA@@@@@@@@A@@BB@@@@B@B@B@@@BAB@@A@@BABA@@B@@@B@B@B@@BB@@BB@@B@@@@B@@@B@B@@@B@D@@@D@B@@BB@B@BBD@DA@@B@@ABA@AB@@@@@BA@@@@BB@@B@@@B@@@BA@@BA@@B@@@B@@@BBBBBDDBBBD@@BB@@@B@@AB@@@@ABAB@@@BA@ABABABABAB@@@B@@AB@B@B@D@B@@@BB@BBB@@BB@@B@BBBB@B@@B@@@@@BBB@B@BA@@B@BABA@ABA@@BA@@BAB@@@BBB@B@@@@ABAB@@AB@B@B@B@@@BBBBD@BB@BBDDBDDBDBBBDBB@@@BBB@B@B@@@BBB@@BBBBB@@@B@@@BABA@A@AB@@C@AAC@AAC@AAA@@@AB@@ABABAB@B@@@@@BBB@B@B@B@BA@@BA@AB@@@BAB@BA@A@A@A@A@A@ABA@A@AB@@A@A@@@@B@@A@@@A@@@A@A@A@CBEBABCBAB@@DBB@BBB@DBDBDBBBBBB@D@DBB@B@B@@BB@@BD@@B@BB@ABB@@B@@B@DAB@B@@@BBD@FDDBB@B@@B@B@B@B@BBB@D@D@B@BADAD@@@B@B@BB@@BBD@BB@@BB@BBB@BBB@B@B@BBB@BBDBB@BBD@DBB@B@BB@@B@BBBB@@B@BB@@FDDBBBD@DBD@BBB@B@F@B@DAB@B@BBB@D@B@BBB@B@B@B@@@B@@@@BBB@B@D@B@BB@@@B@BBD@BAB@FAB@DA@@B@B@B@@BB@@B@B@BBB@@BB@BBBBB@BB@B@BB@@D@@@@@@@B@BAB@BA@@B@@@B@@@B@@@BB@@BBBBBBBBB@BBDBB@DBB@B@B@DAB@BAD@DADA@@DA@@DABAFAB@B@BAB@@A@@B@@@@AB@@BB@B@@BB@@@B@D@B@B@@@B@B@@@B@B@D@BAB@D@B@B@F@B@B@D@F@B@B@B@@BBBB@@DBB@B@BBB@B@BB@BBB@B@BBBBB@@BBB@BBB@BBB@BBBB@BBHB@B@@@@@BABABA@ABCD@@@B@@@B@BB@@B@@ABA@@@AAA@A@CBA@@BA@@B@B@BBB@@AB@B@B@@@B@@@@BB@@BB@@@B@B@@@@BB@@@BA@@BCB@BAB@@@BB@@BB@@B@@@B@@BBBBB@BBBBB@@BB@AB@BABA@ADAB@BAB@B@@@B@B@BBB@@BB@@BBB@BBB@@AF@B@B@@B@@@B@@@BB@@BB@BBBB@@@B@BBB@F@BB@@@BB@BB@BBBDBB@@@B@@ABAB@@@@BB@@BBBB@@@B@@@B@@BB@@BBBB@@BB@@@B@BB@@D@B@@@BBB@@B@BBB@@B@BB@BBB@B@D@B@B@BBB@@@D@D@B@B@BBB@BBDBBDB@@B@@ABA@C@A@@BA@@B@B@B@@@B@B@B@@BB@@@B@BB@@B@BB@@B@@@@ABA@A@@@AB@@A@@B@@BB@@BBB@BB@@@BBD@@BBBBBB@@BBD@BBB@D@D@BB@@B@@B@@@BAD@BB@@@@B@B@BB@@B@B@B@B@@BB@BB@@BB@BBBBB@DB@BB@@@DBB@@@B@@BB@@D@B@DBB@@@BB@BBDBD@DBB@DBB@BBBB@BB@@B@@@B@@@B@@BBB@BBBB@BBBBBBBBD@BBB@B@@@BBB@@@BBB@D@BBD@DB@BBDBDBDBHBD@B@D@DBD@DBLDD@BBF@FBDBB@BB@@DD@BB@BBBBB@D@B@@@B@BAB@BAB@B@BBB@@@@@B@@@D@FAFAD@D@FAB@D@@BB@DDDFBDBBBBBBB@BBB@DAF@BAB@B@D@D@B@DAHAB@BAB@B@BBDBB@B@D@B@@@B@@@@B@BB@@BBBB@D@B@B@@@BB@@BBB@B@BAB@BABCB@BA@@D@B@B@B@BB@BB@BBB@B@@B@B@@BB@B@BB@B@B@B@BABAB@@@B@B@D@D@DBBBB@BBBB@@@BAB@B@@@B@@@@B@BBB@BBDBDB@BB@@@B@B@B@B@B@D@DBBBB@B@BBB@B@D@B@B@D@DAB@BA@@B@FBDB@@DBDBB@@@B@@@D@B@B@B@B@BB@BB@BBDBDBDBDBDBB@@@BAB@@ABAB@B@@@D@B@B@D@@AB@BAB@B@B@DBHB@@B@B@DADAFADADADADAB@BAB@B@B@BBBBB@BB@BB@BBB@D@D@B@B@B@BBD@D@B@B@BADADADA@ABABABABC@AB@@@BA@@@A@C@A@A@AAA@A@ABA@CBA@@@@@A@@AA@@BA@AB@BA@@B@@@BAB@BAB@@A@ABA@C@ABABA@@BAB@D@D@@@BAB@@A@@@AA@@@CAE@AA@@AA@A@A@A@@@@B@DAB@D@BAB@@A@C@CBC@AAA@@@A@ABA@C@A@C@A@@@AA@@@EAA@@@AA@@@@@A@A@@@AB@@@BABABABABA@ABAB@B@B@FBB@B@B@@@@@@@C@EACAAA@A@A@AB@@A@A@AB@BADABA@@@A@@A@@AA@@ABA@C@A@C@C@AB@BCDC@@@A@E@A@AB@@CB@@AB@BAB@D@B@BABABABA@AAA@A@@@@B@@@BAB@@@BC@CBA@AB@DC@@@@@A@A@@BA@@BA@A@@@@AA@@@A@@BA@A@AA@A@@A@@@@B@B@D@B@B@@@BAB@D@B@B@@@@A@A@CAA@A@@BABADAFAB@B@DAD@B@BA@@BAB@D@B@@@B@B@BAB@@@D@B@B@B@@@B@B@B@BAB@B@B@@@BDB@BBBBBB@BRYIUU[WWGO@KFMHMBQGOOQIOCOAw@qEQKQ@WJMVKTEbCVCXIDARSHUBUGSOMSUCUBUHORSBCJMFKNYRSXMpGpChEZGPILAGQKOQOQGG@_Ae@OCKCUIWSWWMUAACGAAAA"],encodeOffsets:[[131980,48943]]}},{type:"Feature",id:"230705",properties:{name:"西林区",cp:[129.312851,47.480735],childNum:1},geometry:{type:"Polygon",coordinates:["@@qYoWUMWOYESKaiQHODOAQAUIaA[BUDWBBPAPGLMLUN_PONEPANAT@RDRJRNN\\P\\JLHBBFHDLBLAb@JFHLBR@ETDPHHHDBhfHHRPTPTLHFPFDBXB\\AfKf@XB\\JXNTVFmDUJOBCPWHMFMBUBk@[AKCEICUBUBGBG@ECCE@K@O@E@G@EBECMCAEAAAGC[OME"],encodeOffsets:[[132401,48574]]}},{type:"Feature",id:"230703",properties:{name:"南岔区",cp:[129.283467,47.138034],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@EBA@A@E@CBC@A@@A@@AACC@AA@@AA@CA@@CAAAA@@@A@@@@@A@@@@@@@A@@@AA@@AA@@AA@@@@@A@@@ABA@C@@@ABA@@@ABC@@@AAA@CACAA@AA@AAA@@@A@@@A@@@A@@@AAA@AA@@A@@@@AA@CA@@AA@@AA@@@@A@@@A@A@@A@@@@A@@@@ACAA@@AA@@@AA@@A@AAA@A@CAA@AAA@AAA@AA@@AAA@A@CAA@AAA@C@A@AA@@@@@@@@@AB@@A@@BABA@@BA@@@@BA@@@@AA@@@@@A@@A@@A@@@A@@@AAA@AA@@A@@A@E@CAA@C@@@A@A@@@A@@A@A@@@@@@@A@A@A@A@AA@@A@@@AA@@A@@AA@@A@@A@A@A@@@A@@B@@@@AB@@A@@@@@@@@@A@@@@A@@AA@@@AA@@@A@A@@@A@@A@@A@@@A@A@@@AB@@A@@@@A@@@A@@@AAA@AAA@AAA@A@A@@@A@CBABA@A@A@@@A@@BA@A@@@A@A@A@A@@@A@A@@@ABA@@@A@@@A@A@@@@@A@@@@AA@@@CAAAA@@AA@A@AA@@A@A@@@A@C@A@A@@@AAA@@@AA@@@A@A@A@@@@CA@A@@AA@@AA@@@@A@A@A@A@CB@@A@AAA@C@AAA@AAAAAAAA@@A@@A@@A@@B@@@@A@A@@BA@@BA@@@@@@@A@A@AA@@@@@@ABA@A@ABA@@@A@AB@@A@@B@@ABCBAB@BA@@B@@@BB@@B@BB@FDB@@B@@@@@@@BC@AB@BA@@B@@@@@@AB@@AB@@ABA@@@ABABA@@@@B@@BB@BB@@B@B@@A@AB@@A@@BA@A@@@A@@B@@AB@BABABAB@@@@@B@@@@A@C@A@CBA@ABA@@@A@A@A@A@@@C@A@@@A@A@ABEBA@A@@@AB@@A@A@A@@@A@ABA@@@A@A@@@ABC@@@A@ABA@A@A@C@@@ABA@A@C@A@CBA@CB@@ABA@@BA@@BA@@@AB@@A@A@@@A@A@C@BB@@@@B@B@B@B@@@BBB@@@BFJ\\HRHLDFFFJHdTHDHBJJDJ@NEJGFE@IBE@UJCDADQTCBG@K@YFSJGJCJAJCTALCFIHSTMLGPCDCB¡|GDI@K@CAMQGOGAHZHRPXTVHJ@LAJOTclUfU`OnKdKTEPYlUfGJGDKBUASADIB@BKI@CBKAAAGCABEDFLHBFBFBAF]D@@@@C@kF[HSHIFEL@JDFHFHJALCJGFE@K@UAA@QDOJGNANBPNRDJ@LCNGJUPORGLCHAL@V@PGPKNGH¥¤onQTGLCNAP@j`FrDr@VFZ@\\C\\AjB\\CVIXCp@lAXAVC\\AbBVJRBPBPCRGcjUpUhK^CR@VFZLfZbPVH`DfDtBF@N@ZALKFSE[OWGOBI@ILKVKjSTIJMBMEMCK@IFINET@dHnRPJJNFVD`DLHFN@PGPCPANDZNfJXH`LPJJBN@LGHKLQDGFEJARB|NjN|NnN`HFEXC|U@@F@B@B@@AAAA@ACA@@A@@@@@A@@A@@@A@A@A@@@C@AA@@A@@@A@@@A@@A@@AA@@@@@A@A@@@A@@@A@@@A@@A@@A@@@A@@@@@@AA@@@@@AA@AA@AA@@AA@@A@@@@AA@@@@@@@A@A@@@A@A@A@A@@@A@@@@A@@@A@@@A@A@@@AA@@@@@@@@@AA@@A@@@A@@AA@@@@AA@@AA@@@@@@@AA@@@A@A@@A@@A@@A@@A@AA@@A@@@A@@@@A@@@@@@@A@@@A@@@@@A@@A@@@A@A@A@A@AA@@A@@@A@AA@@@@A@@@A@A@A@@@AAA@@@A@@AA@A@AA@@@@A@@@@@ABA@@@ABA@A@@@ABA@AB@@ABAB@@ABA@A@A@A@@@A@@BA@A@CBABA@CBA@ABABA@A@AB@@@@ABA@A@A@@@AAA@A@AAA@A@A@A@@@AA@@@@@AA@@@@A@@A@AA@@AA@@A@@@@A@@AA@A@@@@@A@AAA@@@A@@AAAA@@AA@@@A@@A@@A@A@@@@B@@AB@@@B@B@BA@@@@@@@@AA@@@A@@@AA@@A@@CAAAAA@@@@@AA@@A@@@A@@@@@A@@BA@@BA@@BA@@@A@@@A@@@@B@@@@A@@@@@@AA@@A@@@@A@@@A@@@@BA@@@@@A@A@@@A@@@@BA@@@A@@@A@@AA@@AA@@@A@@@@AA@@@@@A@@@A@@@@@AA@@@A@A@AAA@@@@@@@AA@@B@@@@A@@@AA@@@@AA@@A@@@@@@BA@@B@@AB@BC@@BA@@@AA@AA@@A@@@@A@@@AA@@AA@@@A@AA@@@@@A@@@@@@BA@A@ABA@@@@@A@@@CAAAA@@@@A@@A@@@@BA@CBAB@@C@@@ABA@@AA@@A@A@AAA@G@A@@@@@@@A@@AA@A@A@@@@@A@@@A@A@@@AAA@@@CAC@AA@@@@A@@@AAA@AA@@A@@@CBA@@@A@@@AA@@@@@A@@@@BA@A@@@@@A@@@@@@@AA@@@@A@@@AACAA@AAA@@BA@@@A@A@@B@@@B@B@B@@@B@@AAA@ABA@@@@BAB@B@@@BCBABC@A@@@A@AAA@@AAA@A@@@AAAA@@@A@@AA@@AAA@A@A@AAA@A@A@A@@@A@ABA@@@A@@@@A@@@E@@@AA@@@AAA@AA@@AA@@@AA@AAAA@@@A@@@AB@@@@@@AB@@@@AA@A@AA@@A@@@A@A@A@A@@B@@A@A@@@@@CBA@A@A@AB@@A@C@A@@@C@@@A@A@A@@@A@@@C@@AA@A@A@A@A@AA@@@@AAAAA@A@AAA@@@A@A@@AA@@@@ABAB@BAB@@@B@BA@@BA@C@@@ABABABA@CB@@AB@BABAB@BAB@BAB@@@@@@E@ABA@@@A@@@A@@@AAAB@@A@AB@@A@@BAA@@AAA@@@A@A@@BABABABABA@@@A@AB@@@B@@@B@@@@@@@@A@@@EA@@A@@AAAAAAAAACCA@@@A@AAA@A@@AA@@AAA@AAA@A@CA@@A@A@@@A@A@@@A@A@AAA@A@A@A@@@A@@@A@AA@A@@@@@ABA@A@A@@AA@@AAA@A@AA@@AAA@@AA@AAA@@@AA@@A@AAA@ABA@A@A@CAC@@AC@@@A@@@A@A@A@@@@AABA@A@@@C@@AA@A@@AA@AA@@AAAAAAA@CAC@AAA@A@A@ABA@EB@BA@AB@BAB@@A@@BA@@AA@A@@@A@A@A@@@CAC@AAAAA@C@E@E@E@C@@@A@@@AA@ACCAAAA@@A@AAA@A@A@A@A@A@A@AAA@@@AAA@A@EA@BC@A@A@@@C@C@@@A@AB@@A@A@@@C@@AA@@BA@CBA@@@@@@AA@@A@@@A@@@@B@B@BAB@B@@@BAB@BAB@BAB@@@BA@@@C@@BC@AB@BAB@B@@@@A@@AAACA@@@@A@@BABAB@BAB@BABAFCBA@@@A@@B@@AB@BAB@@A@@@@@@@A@A@@@A@A@ABABA@A@A@@@@@A@@AAAAA@@A@@AAA@@@@@@A@ABA@AB@@@A@@AAA@AA@@A@@@ABA@@@A@@@AA@CAAA@@@A@@ACAA@@AA@@A@A@EAC@A@E@A@@@CBAB@@ABA@A@A@A@CB@@C@ABABA@EDC@ABAB@@ABA@A@A@A@CBA@A@C@A@A@C@@@A@@@@@@@@C@A@@B@@C@AA@@A@@@@BC@@@C@@BABC@A@@@A@@@ACACA@@@@@@@@B@BA@@B@@ABA@@BA@ABA@@@@@A@AA@@A@@BA@@@@BAD@@@B@B@D@B@B@@@DAB@D@D@@@BAB@FEDCBABA@AB@@A@@B@@@D@B@@@B@BA@@@A@AB@@AB@@@AAA@AAA@AA@ACA@@@AA@A@@@AA@@A@@@@@@ACAAAAA@@@@A@@@@@AA@@@A@@@A@A@@@A@@@@BAB@B@@@@A@AA@@A@@B@@@@A@@@@@@@@AA@@@@A@@A@A@@@@BA@@BAB@@ABA@A@@@AB@@A@@@@AA@AACA@@AAC@@@@@@BAB@DAB@B@B@DBB@BBB@@@B@@@DC@@@@D@@@BA@@BA@@@A@@@@@AB@@@@@BCB@@@@A@@@@BA@@@AB@@ABA@@@A@@B@@@B@B@@@BABAB@BAD@@AB@@@B@@A@@@A@A@ABA@A@A@ABA@@@C@CBA@ABA@A@A@A@@B@@@@AB@@@@A@C@@@ABA@A@@@@BA@@@@B@@ADABABABA@@@A@@@@BCDCBCFGFG@A@@@ABABADEFGBADEDEBCBC@@BAB@BAB@BAB@B@@@@@B@@@BBFBB@@@B@@@@@DAB@@@BAB@@@B@@@BA@A@@@@@A@A@@BAB@@ABA@AB@BA@@@@B@@@BBB@BBB@B@B@@@DA@@B@B@@@B@@@D@@BB@@@BA@@B@B@@@BAB@FC@@B@@@B@@@@@BB@@@BB@@B@@B@B@B@@AF@@@B@@@@@B@BBB@BBB@@BB@@@@@@@B@B@B@@AD@BAD@BAB@@@DA@@@@@@@A@@A@AAAACC@AA@BEAA@C@@@A@@@@B@@A@@@@@A@@@A@A@@@A@A@A@@@A@A@@B@@A@@@@@A@@@A@@@AAAAA@A@@BABA@A@@@AAE@A@@@@@A@@A@A@@@CAA@@A@A@@BADC@@BA@@B@@@BA@A@A@ABA@@@@@A@@@@BA@@@ABAB@@@@A@@@A@A@@@A@ABAB@@A@@@@@C@CA@@@AAAAEAA@@A@@@@A@@@BA@@A@@A@@A@@@A@AA@@CAA@@A@@A@@A@@BA@A@A@@AAAA@@AA@@A@A@A@A@C@C@C@A@@AA@@A@@A@AAA@A@A@@A@@A@ACA@@@A@A@@@CBA@CBC@A@C@@@C@@AA@@@AAA@A@A@A@C@@@A@CAA@A@A@ABA@CB@@AB@@ABAB@@A@C@A@G@ICIAE@ECC@@@@AEEA@A@A@C@@@@@A@"],encodeOffsets:[[132715,47834]]}},{type:"Feature",id:"230706",properties:{name:"翠峦区",cp:[128.669754,47.726394],childNum:1},geometry:{type:"Polygon",coordinates:["@@HSJKBGFGFSDSOCIEGGCGIIGGMAKBIHIHIDI@EAEEIMEK@AA@A@AA@@@@AAA@@AA@@A@@@A@@A@@A@A@@AA@A@A@@@AA@@@AAA@ACA@AA@@@AA@@@A@A@A@A@C@A@@@A@B@@A@A@@@A@@@AAA@@@A@A@A@@@A@@@A@AB@@A@@@A@@@AB@@ABA@@@A@@@@@AA@@@@AA@A@@@A@A@A@C@A@AAA@A@@@@A@A@@@A@C@A@@@A@A@@@@@AA@@@@AA@A@@@AA@@@@A@@@@@A@@@@@@@AA@@@@@A@A@A@@@A@@A@@AA@A@@AA@AA@@AA@AA@@AA@@@@A@@@A@AA@@A@@@@AAA@@A@@@A@A@C@@@A@@@@@A@@@@@@@@A@@AA@A@A@A@@A@@A@@@@A@A@@@A@A@CAA@@@@@@@@AAC@A@A@@@A@@A@@AA@AAA@@AA@@A@AAAACA@AA@CA@@@A@@@@@@@@A@@@A@A@A@@@A@A@@BA@@@@@CB@@ABCBA@A@A@A@A@@@@B@@BB@@@B@B@B@@BB@BBD@@BBB@@B@@@@@B@@@BA@@@A@ABA@A@@@AB@@@BA@A@@@@@@@B@@@@B@@@@@@@@@B@@@@@B@@@@BB@@B@@BB@@@@@@@@B@B@@@@A@ABAB@@@@AB@@@@@@@B@B@@@@@@B@B@B@B@B@B@@@@@@B@@@@@@@@@B@@@@@B@@B@@BB@@@@B@@@@@@A@@@@B@@A@@@@@@BA@ABAB@@AB@@@@@@@B@@@B@@@B@@@@@@A@A@A@@@A@@BA@@@@@@BA@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@BB@@@BB@@@BABBB@D@@BB@@A@@@@@@@C@C@A@E@CBE@A@A@A@@@C@A@@@A@C@@@A@A@A@AB@@@@@@A@@@AA@@A@A@@@A@A@@@@B@@@@AB@@@@ABA@C@CBA@A@A@A@A@AAA@A@A@A@A@@B@@A@@@@B@@@BA@@B@@ABCBCBC@ABA@A@ABA@@@@@A@AAA@CAAA@@AA@@AA@A@A@@@A@@@AA@@@@@A@A@AB@@A@@@@@@B@@@@@BA@A@AB@@@@AB@@A@ABAB@@A@@B@@@B@@@B@@@BA@@@A@A@A@A@ABA@@BABCBA@@@ABE@ABA@A@A@A@A@@@ABABAB@B@@ABAB@@AB@B@B@@@@@@BBB@@@B@BB@@BBBBB@@B@@@BB@@BB@BBB@@@@B@B@B@@AB@B@B@@@@@B@@BBBB@BB@@B@B@@@B@BA@@B@B@@A@@B@@@@BB@B@@@B@@AD@DAB@BA@@BA@A@@@ABC@C@A@C@A@C@A@@@AB@@@@AB@@AB@@A@ABA@E@C@CBA@A@C@AB@@ABCBCBA@@@AB@@@@@B@D@BBB@@@BB@@B@B@@@@@BA@@B@@@@ABA@A@A@CAA@C@A@@@AB@BA@@B@BAB@BAB@B@B@B@@@B@@@B@F@D@@@B@@AB@@@@AB@@@@ABCBCBABABA@@B@@@@@B@BA@@@@BA@@@A@A@A@ABA@A@A@C@C@A@A@@@A@AB@@@BAB@BA@@BA@AB@BA@@BA@@BAB@@A@@@A@@BA@@B@@AB@@ABA@A@@@AB@@A@A@A@C@A@@@C@C@A@A@AAA@@@A@@@@AA@@@AAA@@@A@@@@@A@@BA@@BA@@BAB@@@BABA@@BAB@@AB@@@B@@@@BB@B@@@@@BA@@DAB@@@@AB@@A@@@A@A@ABA@@@C@@@@@@@@@B@DBB@B@B@@@B@BB@@BBB@@BBB@B@@B@@BB@BB@@B@B@B@@@@@B@@@BBB@D@BBB@BBB@@@BB@@B@@B@B@@@B@@@@BBB@@BBBB@@@BBBB@@BB@@@@@@@@@BA@@@@@@B@@@BA@@@@B@@@@@@@B@@@@@B@@BB@@@@BB@@@B@@@@@@@B@@A@@@A@A@@@ABA@A@A@ABA@@@A@@B@@@@@B@@@B@B@@@@@B@@BB@@@BB@@BB@@BB@@BB@BBB@BBBBB@B@@@@BB@@@B@BBB@@BBB@@BB@B@B@@@BB@BB@@BB@@@@BB@@@BB@@@B@@@BBB@DAB@B@@@B@@@B@@@BBB@@@B@BBB@B@B@@@B@B@B@B@B@@@B@B@B@B@B@BB@@B@@B@@@@@@ABCDA@@BAB@B@BAB@B@B@@@@ABA@AB@@AB@B@@AD@BAB@B@B@@@@@BBB@BBB@@BBBDB@BDB@@B@@B@@@@BA@@@A@ABA@CBCDCBA@AB@BA@@@@B@B@B@@@BA@@BA@@@A@@B@@@@@B@@@BBB@@@BA@@B@@@B@B@@BB@@@BA@@BA@@@AB@B@@@B@@@B@B@BB@@B@@@BB@@BDDBBBB@@B@@B@@B@@@B@B@B@BBHB@@B@B@F@D@@@B@A@@@@BA@A@ABA@ABA@AB@BAB@@@B@BB@BB@@@B@@@@@@@BA@@BAB@@@@@B@@BBBBBBB@BBB@BB@@B@@@@@@@@B@@@@@@A@@@@BAB@B@@@B@B@D@B@@BB@@BBB@BBB@@BB@@B@B@@BD@@BBBDB@@BB@BBBBB@B@BBB@B@B@@BD@@@B@B@BBB@B@B@@BB@@@BB@B@@@B@B@@@@AB@BA@@B@@@BBB@B@BA@@B@@AB@B@@@B@D@BAB@BABAB@BA@@@AB@@CBABA@@BABA@@BA@@@ABA@A@A@A@A@ABA@A@GBCBEBEBCBA@A@@BA@@@A@ABA@AB@@AB@@A@ABCBA@A@A@@@A@A@CAA@AAA@@AA@@A@@@@@@@@@B@@@B@DB@AB@@@BAB@B@B@B@@@@@BBB@@@B@B@@A@@D@@@B@B@B@B@B@@@B@@BD@B@BBB@B@@BDBB@@BBBB@@BBB@@@@BB@@BBBBFB@BBB@DBBBB@@@BBB@BB@B@@@B@B@B@B@@@BAB@@@BAB@BA@@@@B@@@BBB@@@B@@@@@BA@@DAB@@A@@BA@AB@@@B@@@@BB@BBBBBB@BB@@@BB@@@ABBB@@@B@@BB@B@BBB@@BB@BB@@@DBBBDBB@@@@B@@@@B@@BB@DBB@D@@BDAB@D@B@D@B@D@B@@@B@BBD@B@@@D@F@B@B@B@BABABA@@BAB@B@BAB@BA@@B@@A@A@@BABAB@@ABAB@BA@@BABA@@@@B@B@@@D@@@B@@@B@BB@@@BB@AB@@@B@@ABB@@@@B@B@@@B@@@B@B@BA@@B@@@@@@@B@@@@A@@BA@@B@@A@@B@@A@@B@@@@@@@B@@@@@B@@@B@@@BB@@@@B@@BB@@@@@B@@@@@@BB@@@@@B@@@@@B@@@@B@BB@@B@@@BB@@BB@@B@@B@@@@@@@B@@A@@B@@@BA@@B@@A@@@@@@BB@@@@B@@@@B@@@@BA@@B@@@@B@@B@@@B@@@@@@@@@@@B@@A@@@@B@@@@@B@@@BA@@@@BA@@@@BA@@@C@ABCB@@AB@@@@@B@@@B@@@B@@@B@@@@@@@@@@@@@BB@@B@@@@@B@@AB@@@@ABAB@@@BA@@@@B@@@@AB@@@B@@A@@B@@@BB@@B@@@@@BB@@BB@@@B@@@@@@B@@A@@@A@@@@@A@A@ABA@@@AB@@@@AB@@@B@@@B@B@BA@HHBJBPBNFJJHPFZBR@NDHFDJB^FLHHPBTC\\EJ@NDFLPVLJZHTBTATI^YTO\\O^YXYTYBEXgPoRyD[FYHSJKLEFCXC@\\UTIVERGTITSNQHIJO@ABE@A@EACAK@K@@ACWKGE_E[Oe]IIAIBKBGBCBICGEIKGYE[OSOQQIQAYFw@YCUKUOUGGCI@KBG"],encodeOffsets:[[131686,48645]]}},{type:"Feature",id:"230708",properties:{name:"美溪区",cp:[129.129314,47.63509],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAKG[I[OMMIQCQ@QBSBMFOPM`OVMNKHKBOAOkBo@WDUJ[DiA[B[DY@UEq@qC_E@\\ALMPQNUH]FOFEF@JBHJH\\J\\LLLBJCNMHMJILADEL@PBZDnF\\HZJVNNNJRFZBTFLHBBHFTZ²BD@B^v`tDJZ^VP\\H^B`C\\KPQXcNMNEP@@@NHV^HLNXBN@R@TCbAPDH@DNNxjLNJPLXLTR\\LXF\\Fd@vrPdTj`\\bZpNZ@nJpHlBr@^AXB^HfPTHZDZ@VG\\GNENCRARDLJFRLhhcTaLYFQLKdMlK`ONILKIAMEM@KBQDI@MAEC@EFQDOBEJCHCBEAEGEAEDO@@FEJ@R@PAHEF]@EEEGEOKAE@IJOPOLCL@VFTHF@FABC@E@@A@@@@A@@@C@C@A@@@AA@@@A@A@@AE@EAAAA@@@AAA@@@@@@@@A@@B@@@B@BAB@BABABAB@@A@@B@BA@@@@B@@@B@@AB@B@@@@EAgDIDIPGH@H@FBBADEDO@EK_@EBAL@fCLCDG@KJMFCÀ_JDÃFUAKCCAIKEK@QBCKCKIEOCw@WB[J_NUNSFW@UCWI_IMCKBOBCB[D_BW@O@WHOBQB[AaGQGKCaM_EKGGIIaGcKcIUSUWM[IWAe@eL[BWACAOEGESKSOQOGGgeCAGGOGSCFQ@KAEG@IBaAKCKEG"],encodeOffsets:[[132188,48633]]}},{type:"Feature",id:"230707",properties:{name:"新青区",cp:[129.533599,48.290455],childNum:1},geometry:{type:"Polygon",coordinates:["@@FARCZMBCFEFCRCDABOAAIEOKs[GAG@ULE@KLMJ_PkLcNKLERKZSbgdi`SNANDZDVAN@NI`SnIhIzC\\OT[LeRWJOFABIDCBA@ABmRA@_Z]`ABQ^_lGPgzLBvNrF\\@VCTERCZBnJvTfFjAB@H@AbDhH\\HdNXH\\DjFbBVAVKPILEL@JHTP^JX@`ERARDBqBCHCHErFFACiCEECICI@OWAM@KBEPKDCFE@AIKQMGCAEACBCBAEEEAIEEGAMFIDMAGMMIOKIIEMCMAKDO@SCgUCC@CBCJGZMLKFIBWCKGMAG@EBGJIBGCC_SACBEDEJA@CACQIIOUQOGIEcEE@EEIQ@GBEFGNEHA@C@AEAIODEBK@GCGAEDEFUBSAGECIIAAB@"],encodeOffsets:[[132852,49180]]}},{type:"Feature",id:"230710",properties:{name:"五营区",cp:[129.245343,48.10791],childNum:1},geometry:{type:"Polygon",coordinates:["@@O_[{MYYo[ai_cSqOKAUA_HUN[TRZT\\^`L\\FXLVNTDX@ZCHIFKBOBSHWHO@IIASAYISUIWAYHaPeZk^a`cd]PiTydudxifUbQZQZDD@B@@@B@BAB@B@@@B@@BB@DDBBB@BB@@BBB@@BBB@@@DBD@@@FBB@DB@@BB@@@BBB@@@@BABAB@FADA@@B@D@BB@@B@D@B@B@@BB@@@@@BDBBBBBBB@@@B@@ADABAD@BA@@B@DBD@B@BBB@ADAB@B@B@B@B@@@BDBB@@B@@AB@B@B@D@@AB@@@BBD@@@BBB@@BD@@@@@BA@ABE@EBABABAB@@@B@B@D@B@BBBBB@@@@@B@B@B@BAB@BA@@B@@BB@@BBD@F@F@D@@@B@D@H@D@D@DBB@FBBB@@BB@@B@@B@DA@@BA@CBA@A@ABAB@@AB@@@B@@@BBB@@@@@BA@@BA@C@ABA@@@@B@B@B@B@@@@BB@@BB@@BB@B@@@B@B@B@@@B@@@B@BAB@@AB@@AB@B@B@D@@@BB@@@B@BB@@B@@BB@@B@@BBB@@B@@BBAB@B@BABABA@CB@@@@BBB@@BBBBB@B@B@BB@@BB@D@FBFBB@B@BBB@@BBB@@@BB@@@B@B@@@BBB@BBBB@B@BBB@B@BB@B@BBDBD@DBB@BBB@BBBB@B@BB@@@BB@@@@@@@B@B@@B@@BB@B@B@B@@@B@B@D@B@@@BAB@@@@BB@BB@B@@BB@BA@JAB@LCXKlWXKXGB@ZCfBXHXDLCDATKV[BA`g\\_baNKLIXM`MZCPIJQBQBCDSTMZMRGPOJWBO@AHSNUBARJB@DB@@FB^P^IBAHADAAC@@@CAEAA@CACACAE@@@C@@GWPOJ_HmCeMSQOSQ"],encodeOffsets:[[132439,49224]]}},{type:"Feature",id:"230709",properties:{name:"金山屯区",cp:[129.429117,47.413074],childNum:1},geometry:{type:"Polygon",coordinates:["@@HPPXF\\ETKLYBM@E@sAeC_CUGaOeYYKUEQ@]DgLoViVdbjTLZFXPVNpXrZNF\\PHDBBFBDBDNAF@F@H@F@P@LDFFDH@HAVAVAJDDFBL@\\AlAVENGNOXADIPCVEnJVLdHdJbHJLH`FbNLDRHbH\\BRAPAXGP@X@`A\\CDAPALAND`JXJVDX@TEVM`M\\IXAx@PDJFDLDLA@RFLJLDBLDVBÄEC Ix]LGHM@CAEKOKKACFUAECCACBCDCLCb[LO@CAEIIKEAE@CDG@ICKEGKGSECAAIAWHYDKBEFCH@F@HDF@B@D@B@B@BAD@@@BAB@@@BA@AB@B@BAB@B@BA@@D@BBB@B@BAB@B@@@@@@A@@B@@@@@@@AA@@@@@@@@@@@@EBA@@BA@A@AA@@A@@A@@@AAA@A@@@@AA@@AC@@AA@@@AA@@@AA@@A@@BCBA@A@IBKHEBG@GCMCEEIK_QCC@ABCJEDCDEBGAGAKKe@CBCBAFC^IPADABC@CAAEAGBK@ECAEACBEBEBCHE@@BA@@B@@@B@B@@@@AB@@@@@@C@A@@@@BA@@@@@A@@@A@@@@@@BABAB@@AB@@@@AA@@@@A@@A@@A@@BA@@@AB@BA@ABA@@@ABA@@@@AA@@@@@@AB@@A@@@ABA@@@A@CBA@A@A@EBC@C@@@WKOGQEG@IBMCEAACAEAQ@CFCBCACAABCTSTG^I`UHMHGF@H@LBJABCACOCOI@GFIDEEQG@yBAAS@GCAAAGAI_GmM{MiM{MQAIBEFCHKRGLKHM@IAOI_KWGeIYMMCOBODOHM@GECKC_EUIMOImQcGS@MFEJ@JDLFNANINSJiTULKL@JAJ"],encodeOffsets:[[132560,48444]]}},{type:"Feature",id:"230711",properties:{name:"乌马河区",cp:[128.799477,47.727687],childNum:1},geometry:{type:"Polygon",coordinates:["@@ICUKUOEBKBMBMDMDKHMBM@IFEFGH]^CH@H@L@DADOLKHCDINERATHVBDRlBTAPGRIFCTETEHAHILGTAH@LDJHHPVLVDV@ZExBZJRRRTP\\PZFLHFJDHAJADAHALBJJJf^\\P`FHFXLBD@@@LBLBD@F@BAF@BIPGJMRSTSJQHUFSJ[VBb@`@XAFAfIjMnO^MZKIUG_@MAO@A@@BABMBG@IR]DITULIJCL@PD`LPHFDVLDDVPVLLL@DBNG\\IN@@JJHFRDHFJFAJEDI@KBCFBFLHJTBNKBGGEICCKGECKBCDGFGFAJADIFCDAFGCKEI@EDIDA@DHBBNVXXXTVJLDPDf@`BH@RHRPLPHRLBNH\\N^L\\HPLV\\PPTJPDPBVB\\LPNV^\\SVM`GVBLB@uEcE[KWQ[KSKWIOKMwiMM@CCGBODa@S@QAMMWGKU]MG@@O@MFMNWdOR[L_D]A[GUOY]CI_s]u@AAC±SYGEAAKGSEYAQEMIMMIUGYE[CmAY@OFKBCJKNINGDMAIKK[K[IIGAG@IFEPE^EVGRMNOBK@[QEWGkW_OYE_MYGaGYEKE"],encodeOffsets:[[131831,48459]]}},{type:"Feature",id:"230712",properties:{name:"汤旺河区",cp:[129.571108,48.454651],childNum:1},geometry:{type:"Polygon",coordinates:["@@iBeEuSmIYAQDSFUD[@qEuMKAgEyAiE]GoAABE@UDOJIRE^GzKXSLcHGDOFCDGFGNMRQFY@GqI[GUIW@SBaLaHeH@B@@ABCDABCB@BAB@BAD@B@B@B@B@BBBBBB@BBBBB@@BB@@@@@@@@@BB@@@@@@@BAB@@@B@@ABBB@B@@BBB@@@BBB@B@D@D@BB@@B@@@@@A@@BA@A@@B@@AB@BBD@B@@AD@B@B@@@BAB@@AB@@A@@B@B@@BBDBDBBB@@B@BBD@@B@@B@@@BB@BBB@@BB@BDDBBDBBD@@@B@B@B@B@B@BA@@B@B@BA@B@@BD@B@B@D@B@B@B@BBB@B@DBB@BB@@BBBB@@BB@B@B@B@B@B@BA@@B@B@B@@ABABB@BBB@DBD@DBFBBBB@BB@@B@H@D@B@D@DBD@D@BBD@B@BBDBBBDBBB@BBB@@BBBBBB@@BB@BB@@B@@@B@@@B@B@D@@@BBBBBBB@@BB@BBBBB@B@@B@B@@@BBF@D@BBB@@BB@BBBBBBB@BBFBBBD@BB@@B@@@BAD@BAB@@@BAD@D@BAD@BAD@DAD@B@@ABA@@B@B@B@B@B@@B@@@BBDBBBBBBB@B@BBD@BAB@B@B@B@B@D@F@F@BBD@DDB@BBB@B@D@B@B@B@H@B@B@B@B@BBBADB@@B@BB@@@DB@@BD@B@DBD@B@DAB@B@B@B@@B^EXKRSNGRElEG~O^@ZF\\@jMlW@@bK^CTApB\\DdVdbLNZL\\@^JTTHXBVCFPLLXBh@b@V@RBRBB@@@@AB@@@@@@A@@@AB@AA@A@@AC@@@A@@@AB@@ADEBA@A@ABC@@@AAA@@@A@A@AFA@AB@@ADAF@D@BABABC@A@ABA@A@@@@@AA@CA@C@C@@HGBABA@AB@BADA@@FAF@B@BA@@BADADADAD@B@\\EVGjIZIVCJFFBHA`pTlNfOIKSIWU{USaHAHABE´H®DGiS·P[PUHKBGAEEECECACDChWFIRMFIAI@OBOHONIXOHAXGLGPKEYGWDW@OAICSEUAGQCQB_FW@]ISOIGK@KFOJULUBaAiE[CWGcM[GgGaCBG@A@"],encodeOffsets:[[132693,49571]]}},{type:"Feature",id:"230713",properties:{name:"带岭区",cp:[129.020888,47.028379],childNum:1},geometry:{type:"Polygon",coordinates:["@@V_VedkPSBI@KGISUOWGQGYGDMHKFO@QBWBELGFORKDC@KAs]ICMCS@IBQFSJEJELIDSJUDGDIHGJ@BKTGRWr@BABATCPIT@BC@A@ABA@A@AB@@@@@@A@A@@@A@@@ABC@CB@BA@@BAB@B@@@@@BA@@@ABA@A@@@ABA@AB@@ADABAB@@A@@B@@A@AB@B@@AB@@ABA@AB@@@@@BBDB@@B@BBB@@@@@BBB@@@DB@@@@@H@BBDB@F@B@@@B@@@B@AC@CAABB@DBBBBBBBFB@@B@B@HBJDHBF@@@@B@@A@A@AB@@@@@@@B@@@@@BAB@@AB@BAB@@AB@BA@AB@DA@@D@B@BA@@FGPEFIHYL]LeTkX]JiNcNUFQDKCKAUGK@E@OBG@EAUYACEECAK@CBQNEFCFILADIDQDUFMBKAGGQKICQAEDGFMLOLKNADGLCFCHCFCH@FAHAP@F@HH^DFNLPNDN@D@L@FCHGLBNFRfzBF@LARDLJRNHTDVDJF@DVPVLJDLFZFbHZH`NZF`PlXXHRF@iBODMHKRSpm¦£HGLMHO@O@UBKDGHKPQVOHIDM@KCIMQAOBMHMPIRCB@VBL@F@HEDIBKGIGECE@IFKJETG\\GlED@@@@@^CBEEAEAGAEKFCBAHDBBLBDAJ@ALA@CJTBVBLAHCHIVeZkFOLSLcPm"],encodeOffsets:[[132294,48095]]}},{type:"Feature",id:"230716",properties:{name:"上甘岭区",cp:[129.02426,47.974707],childNum:1},geometry:{type:"Polygon",coordinates:["@@OOU[OK[G]K[MMGKAKBOJYHgFoDoHWNQTMZELINADQTGPAVDVTVPNHTAVGVQTCBWJUDaDSFULIN@XLRFR@rBxDPJPPRHPARGNEN@LHPXXV\\JVRYVajewvczcjS^Odcb_l]fYbOZGXBVJJTBZBTJJP@XGTGPALAJEDG@YCWMSKUEWK[]_S[QYU]OM[KUAOAOCSI"],encodeOffsets:[[132191,49072]]}},{type:"Feature",id:"230714",properties:{name:"乌伊岭区",cp:[129.43792,48.590322],childNum:1},geometry:{type:"Polygon",coordinates:["@@kXiN[@YE]@}PHkFQFMHQTWL]F@B@B@BAB@DA@@B@BB@@B@BB@@B@B@B@D@BBB@B@B@@AB@BCBABA@@@AB@@@B@BCB@@A@BB@BB@BBBB@BD@BBD@B@BBB@BBBB@BBB@BBBBDBD@B@B@@BB@@@@@@B@B@B@@BB@BBBBB@@BB@B@B@B@D@B@B@D@B@DBBB@@BB@B@B@B@BA@@BABABA@CBADABCBAB@BAB@BA@@B@@ABABA@ABA@ABABA@AB@BA@@B@BAB@BAB@@AB@BCBA@@BCBAB@@AB@@ABEBCB@BA@ADABAB@BAB@@ABCBABABABC@@BAB@@AB@@A@ABA@@B@BABAD@BA@@@CBE@CBA@AB@@@B@B@@BB@B@BBDBB@@@B@B@BB@@@@@B@B@B@D@B@B@B@B@HBB@BBBBB@@B@B@@ABA@AB@@A@ABADABA@@B@BA@AB@@A@A@@@@@@@BBD@DBDBD@@BB@@AFABADAB@DAD@BAD@D@B@B@D@B@B@@AB@BAB@@AB@BABA@@BAB@BAB@BA@@@@D@@AB@@@B@@@BAB@@@B@BA@@@@B@@@B@B@@@BAB@B@@@B@B@@@B@@@BA@@@@B@@@B@B@@AB@@@B@B@@@BA@@B@D@B@@BB@@@@@BABBB@@@B@@@B@@AB@@@BB@@B@B@@@B@@@BB@@B@B@@BB@B@BBBBB@DB@@BBDB@@@@@B@B@@ADCDAB@@AB@BABAB@BA@@BBF@BAB@@@B@BB@HFFDB@BB@BBBAD@@B@@@@B@@@BBBDBBB@@BBB@BB@@B@BBB@@@B@@B@@@@@B@@@@B@@@@@B@D@BBD@DB@@@B@@B@@@B@@AD@B@BAD@B@@@@@@@@@B@BB@@@B@@@@BB@@@@@ABB@@A@@@@BB@@@D@@@@BB@@@BAB@B@B@BAB@@AA@@AB@BA@@@A@@@A@AAC@A@@@A@@B@BA@B@@B@B@B@BA@@@@DBDB@B@@BBBD@@BB@@@@A@@@AB@@@@D@@B@@@BBB@B@D@B@@DB@@@BB@@@@ABB@@@@@@@BD@B@@@@A@@@@B@B@@@B@@@DBB@B@B@@@@B@@B@@@BA@@D@B@BA@@@@B@@@@@B@@AB@B@@@@BB@B@@@@@B@@@B@@@B@B@FA@@@B@@DAB@@@@@@@@B@@B@@@@@B@@@AB@@B@B@@B@@@@A@@@BB@B@A@B@@@@AB@@B@@B@AB@@@@@@@@@@B@@B@@@@@ABAB@@@@@@@B@@@@B@@@@@B@B@@@@BA@B@B@B@DA@@@BB@@AB@@@@@B@B@@@@@@A@@@A@@BAB@@@@@@AB@BB@@@@@@@B@@@@@@B@BB@@DABA@@@@@@B@@@@@@@B@B@@@@@B@@@@@@@@@@B@B@@@@@@@@@@@@@B@@@@A@@@@B@@@@B@@BB@@@@@B@@@@BB@@@B@@BB@@@B@B@@@@@B@BAB@@@B@@@BA@@B@@A@@@@B@B@@@@@@@B@@@@A@@@@@@@@BA@@B@@@B@@@BA@@@@@@@@@A@@@@@@B@@@@@@BB@@@BB@@@@@@@@@@B@@@@BB@@@@@B@@@@@@@B@@@@@@@B@@A@@B@@B@@B@@@B@@A@@B@@A@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@B@@@@@B@@@B@@@BA@@B@B@@@B@BA@@@@B@@@@@B@@@B@@A@@@@B@@A@@@@@@B@@@@@B@@@@@@ABB@@@@@@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@@B@@B@@@B@@@@@@@B@@@@@@@@@@BB@@@@B@@A@@@AB@@@@@@@@@BB@@@@@@@AB@@A@@BA@@@A@@@A@@@AAA@@@A@@@A@@B@@@@@BB@BBD@@@@@B@B@@@@@@@B@@@@@@@A@@B@@@@@@@@@@B@@@B@@@B@@@B@@@B@@@B@BA@@@@@@B@B@@@@AB@B@B@B@@@B@@@@@B@@@@@B@B@BA@@@@B@@B@@@BA@@@@BA@ABAB@@ABAB@@@B@B@B@BB@@BB@@B@@@@AB@@B@@BDB@@@@@@@B@BBB@@@@@@@BB@@@B@@A@@B@@@@@@@@@@@@@B@BB@BB@@B@@@@B@@B@B@@BB@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@@@B@@B@@@B@@@@@B@@@@@@@@@B@@@B@@@BBD@B@@@B@@A@@@@B@@@@@@@@@@B@@@@B@@@BB@@@@B@BAB@@@BBB@@@@@@@@@@@@B@@@@B@B@@@B@@@@@@@BA@@@BB@@@BB@@@@@@B@@@BB@@@@BB@@@@B@@BB@@@@B@@BB@@@@BB@@@@@BD@@@BB@BBBBDBB@BA@@B@@@@A@@@@@B@@B@@@@DBDBB@BBB@BBB@BB@@@B@@@@@@@@@B@@@@B@@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@BA@@BAB@@@BB@@@@@BBBA@@@@B@@@@A@@@@@A@@B@@A@@@@@@@A@@@@@A@@@@@AB@@@B@@AB@@@B@@BB@B@@@@@B@BA@@B@BBB@B@B@@B@@B@@B@@B@@@@B@@@B@@@@@B@B@@@BA@@@@BA@@@AB@@C@AB@@C@A@ABA@A@A@@@@@A@@A@B@@A@@@@@@B@@@D@@AB@@@B@@@B@@BB@@@B@@@BB@@@BB@@BBB@@@B@@BB@B@@@B@B@BBB@@@@@@B@@@@@B@@@@@BA@@@A@@BA@AB@@@@A@A@@B@@A@@B@@A@@BA@@@A@@BA@A@@@AA@@G@EAA@A@@BA@AB@@@@@BBB@@@BBBDBB@D@D@B@B@B@@@B@B@@@B@@@BBB@B@@B@@@@@BB@@B@@@@@@BBB@@@B@@@B@@@B@B@@@@@B@B@@@BBB@@@@@B@@BA@@B@BAB@@A@@BA@@BA@ABA@ABABABABA@CBCBA@A@@@A@@@A@A@A@@@@B@@BD@BB@BB@@@@BBBBBBBBB@B@B@B@B@BBB@@@BB@@BBB@BBB@B@B@@@B@BBB@D@B@B@BB@@BBBBB@BB@@D@B@B@@@B@BBB@@@@BB@@@DBB@D@B@B@D@B@@@D@@@B@B@B@@@@@B@@@A@@A@@@@@A@@@@@A@@B@@@BB@@B@@@BBB@BAB@@@@AB@B@@@B@@@@@B@B@@B@@B@BBB@B@B@B@@@B@@@B@@@B@@B@@@@@@@@@@@@@@@@AB@@@@@@@@@BB@@@BBBBBBB@@B@B@@A@@BB@@@@@B@@@@@@B@@@B@@@BBBBDDBDD@@B@@@@@B@@BB@@A@@B@D@D@@@B@@@B@@@B@@@@AA@@@@@@A@@B@BAB@@@@A@@BA@A@@AA@A@@@AA@@A@A@@AA@@@A@@@A@@BA@@B@@@B@@@D@B@D@D@D@B@B@BA@@B@@@AA@@@@AAA@@@@A@@@@BA@@B@@@@@@A@@@@@AB@@AB@BA@@D@B@@@@@BBA@@@B@@B@@@@B@@@BAB@B@@@@@@@@@@@@BB@@AB@@@B@@@@@@A@@BA@AB@B@@@B@@@@@@B@@@@@@@@B@@@@@B@@@@@@@@@BB@@@@@@@@B@B@@@B@@A@@@@@A@@BA@@B@@@@A@@@@@A@@A@@@A@@A@@B@@AB@B@B@B@@A@@B@@AD@B@BBB@B@BA@@B@BA@ABA@A@ABA@@@AB@@A@@@@@A@@@@A@@@A@@@@@A@@@@@BABA@@B@B@B@B@B@BBB@@@BA@@@@@AB@@@BA@@BAB@@@B@@@DB@@B@B@@B@@B@@BB@@@BBBBB@B@@@BBB@B@@@B@B@@@@@DBD@B@D@B@B@F@D@B@B@B@@@B@@AA@@@AA@@A@@A@@@@@@@A@@@@@@@AA@@@@AA@A@@@@@@AA@@@@@A@@@@@A@AA@@AA@@@@@A@@A@@A@@@@A@AA@AA@@AA@@A@@@@@@@A@A@@@ABA@A@A@A@@AA@@AA@@@@A@@A@@@@@A@A@@BA@AB@@@BA@@@@@A@A@@@A@@@A@@@A@@A@@A@@AA@@@AAAAA@@A@A@A@@@@@@@ABA@ABA@A@AB@@AA@@@@AA@@B@@A@A@@BABA@AA@@@@A@@A@@@ABA@A@A@AB@@AA@@@@@@BA@@BA@AB@@ABA@@@A@A@@A@@AA@@A@@@@AA@@AA@@@@@A@@@@@@@@@A@@@A@@@@@AA@@AA@AA@@A@@@A@@@@B@@@B@@@@AB@@@BA@@@A@A@@@A@A@AA@@A@A@@AA@@AA@@@@A@A@A@@@A@@@AB@@ABA@@@A@@@A@@@@A@@A@@C@A@A@@@A@@@AB@@@B@@@BA@@B@BA@@B@@A@@BA@@@A@@A@@AA@@@A@@@A@A@@@A@@@A@@@@@AA@@A@A@@@AA@@AA@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@A@@AA@@@@@@@A@@B@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@A@@B@@@@@@@A@@A@@@@@@A@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@B@@@@@@@@@@@@@AA@@@@A@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@A@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@A@@A@@A@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@BB@@A@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@A@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@@@A@A@@@A@A@@@A@C@@AA@@@A@@@A@@A@@@@@@@A@@@@BA@@@AB@@@@@BA@@@@@A@@@A@@@@@@A@@A@@@@@A@@@@@@@@@AB@@@@A@@@@A@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@@@@@@@@@@@@@AB@@@B@@@@@@@BA@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@A@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@B@@@@@@AB@@@@@@@@@@@@@@@B@@@@@@@@A@@@@B@@A@@B@@@@@@@@@@@@@@@@@@@BA@@@@@@@@B@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@A@@@BA@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@A@@@@A@@@@@@@@@@A@@@@@@@@@@@@AA@@@@@@@@@@@@@@@B@@A@@@@A@@@@@@@@@A@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@A@@A@@@@@@B@@@B@@@B@@@@AB@@@B@@@@AB@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@B@@@@A@@B@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@A@@@@A@@@@@@@@@@@@A@@@@@@@A@@@@@@BA@@@@@@BA@@@@@@B@@@@@@A@@@@B@@@@@@@BA@@B@@@@@@@B@@@@@@@@@B@@@@@B@@@@@B@@@@@BA@@@@@@@@B@@@@@@@B@@@@A@@B@@@@@@@@A@@B@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@AB@@@@A@@B@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@A@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@AA@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@B@@@B@@@@@@@@A@@B@@@@@@B@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@@B@@@B@@@B@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@A@@@@A@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@B@@@@AA@@@@@@AA@@@@AAA@A@@@@@@@A@@B@@A@@B@@@B@BA@@@@B@@@@@@@B@BAB@@@@AB@B@@A@@BA@@B@@ABA@@B@@@B@B@@@@@B@@@@@B@@@B@@@B@@@B@@@B@@A@A@@@@@A@@@@@@@@@A@@@@@@@@B@@@@@@A@@@A@A@@AA@@@A@@@@BA@@@A@@@@@A@@@@@@@@@@@@@A@@@A@@@@@AB@@@@@AA@@@@@@@@@AA@@@@@@@@@@AA@@@@@A@@AA@@@@@@@A@@@@@AA@@@@A@@@A@@A@@A@@@@@@@@AA@@@@@A@@@@@A@@@@@A@@@@@AA@@@B@@A@@@@@A@@A@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@A@AA@ABC@A@@@A@@@A@@@A@@@AB@@A@ABA@@@A@@@A@@@A@@@A@A@A@@AA@@@A@@@@@A@@A@@A@@@@@A@@@@@A@@@@BA@@@@@@@A@@B@@@@AB@@@@AB@@@B@@A@@B@@@@@@A@@@@@@@@@A@@B@@@@AB@@@BA@@BA@@B@@@BA@@B@BA@@B@@AB@B@BA@@@@B@BA@@@@B@@AB@@AQAQAU@a@g@WAKKEODAUGWSS]I[@YKKMcacU[CoASB]DaL@@"],encodeOffsets:[[132532,49734]]}},{type:"Feature",id:"230715",properties:{name:"红星区",cp:[129.390983,48.239431],childNum:1},geometry:{type:"Polygon",coordinates:["@@eO]GWA]Bq@kAoGmI@\\|P`TRRPNTDfGnI`OPHX@@@D@@BFBDBD@DBBBF@D@@BDCBGBAB]J]OEA@@CAA@QIABMVGT@BAPIXOPQHYNSNCTADARIROJYD_NWNKJMLab[`_hABU\\SLCBKDWCWGeAYDA@WHWLkXWLKDA@IB@BAB@@ABC@@BA@@@C@A@A@ABC@ABC@ABA@A@A@A@AB@@A@CBA@@@@B@@@@@B@@@@@B@@@@@B@@@@BBB@BBDBB@BBB@D@B@B@B@BB@@@B@@@B@@BBBBB@BB@BBBBD@@@@BBDDBB@DB@@BB@@@BBB@DDBBDBBBBB@B@BBB@B@BA@B@@B@BBB@B@BBB@@@B@B@@@B@B@@@B@BBD@B@BBB@@@B@@@B@@@B@BBB@BBB@BfGbGbKTAX@VJ\\HrJHZ@RENQHMHEDCPEHCdGTKLWHyF]JQPIVCF@BApB^HjFzBhFhyHO`kR]BA^_`YB@nQBAB@DAJCBAPEXIfQ\\KPSD[JyJgTmJ_@MBMCUCYBMTMj_KgEQKIQCQBMDMF[HUHY@YCSG"],encodeOffsets:[[132612,49200]]}},{type:"Feature",id:"230722",properties:{name:"嘉荫县",cp:[130.403134,48.888972],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@CICGAAA@A@A@CBGBCBCBEFGHCBA@A@A@A@ECAA@AAEBG@K@C@@AAA@A@CBKFEBCBA@AAA@AAAAI@CA@@ACCGAA@C@A@C@A@AAAGAGACAA@CBEBEDEBC@C@G@M@A@A@EDA@C@K@WAC@]CG@A@@AAA@CACAAA@MCIAA@AAAAA@@@@@AD@@A@C@C@A@@AA@@A@@BA@ADAPIFEBA@A@AAAIIGGCASIC@AA@@ABEBAB@@A@@ACGB@@ADAFAFABAJ@B@@A@A@A@OCU@AEOEEGEAA@@@C@@DCFE@A@AA@CACAAAAC@C@CBABAHCDAB@LAH@B@BABA@@AA@AA@GAMEA@AA@AAA@ABM@@AA@@A@A@GBMFCBC@EAC@CBA@IBC@A@@@@@AA@@@AE@A@GB@@A@EAA@C@CBCBABA@@@A@CCAAA@A@A@CBIFEBA@A@C@IEGA@@AA@A@A@AAAAC@@A@C@E@AA@@@@BA@@@A@@CA@@AEAAA@A@AD@@A@_QA@@@@@@@A@@@@@@AA@@@@@@@B@@A@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@EA@B@@@@@@A@@@AA@@AA@@@A@@A@AAA@@@@B@@A@@@@@AAA@@@@B@@@B@@@@A@C@@@@BC@@@A@@@@A@@A@@@@@A@AAA@@@ABABA@C@CAA@CAE@@@ABABA@@@@B@@@BB@B@@@@B@@@@A@A@@@@@BB@@@@A@@@A@@BB@@B@B@@@@CA@@@@AB@@@@@BD@@@@@ABA@@A@@@@A@@@ABBB@@@@A@@@AB@@@B@@A@@@@@@@@B@@@@@B@@@@@BAB@B@@AB@@@@@B@@@@A@@B@@@@B@@@@@@B@@A@@@A@@@@AA@@@AB@@@@@B@@@BA@A@@@@B@@AB@@ABAB@@A@A@@@@@@B@@@@A@@@A@@BA@A@C@A@@BA@@@B@AB@@@B@@@@B@A@@B@@@@ABA@@@A@@@A@@BCA@@@@A@@@@@A@AB@@@@@D@@B@@@@B@@@@@@@@A@A@AB@BA@C@@@@@@B@@B@B@@BA@@@A@A@@B@@A@@@@B@B@@@BC@@@@B@@A@A@AB@B@@@@@@@@@BA@@@@@B@@B@@@@C@@@@B@@@@A@A@@@A@A@A@A@@B@@@@@@G@A@@BEHAB@BC@C@CBA@@BBB@@ABE@IDG@AB@@ADABA@MD@@@BA@BB@B@@CBGDG@@@AB@BABA@GB@B@@@B@B@B@D@D@BEFCDAB@FBL@B@BMJKLOPKHC@I@CBCBAFURQLUJCHKLCDEBC@EAEAC@C@CBCLKDIAABADBDBFHDRNJL@BEFCDOLAF@LBNPXJ@JDFDDFDjEBqEGFGDADArBHFVDTBJ@PCXHXFZOLKHWHGBWPMJGPAP@PBJEJQNEJgXCDBDFDFDFFHBLAVG\\O¸OTHj­C³GAFGBGBTb|VXVTJJLePMSk_oGBEAIEUDYJiJUH[FA@C@CBCBCBAB@@ABA@E@EB@@CBABA@@BABABGH@@@D@DDBB@@B@@@@@BAB@B@BADABABC@E@CB@BA@@BEB@B@B@B@@BB@B@@AD@B@BABCF@BA@@B@@@B@@BD@@@BBBA@@B@@@B@@@@A@@B@@A@@BA@@BA@@@@@ABA@@@@@ABA@A@@BA@@@ABA@@@AB@@A@@@AB@@AB@@A@@B@@A@@@@B@@@@@@@@@B@@@@A@@@@BA@@@A@@B@@A@@B@@A@@@@B@@@@@@AB@@@@@B@@@@@B@@@@@BB@@@@B@@@@@B@@BB@@@B@B@B@@@B@@@B@@@B@@AB@B@BA@@B@@@B@@@B@@@B@@@BAD@BBBB@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@BB@@@@@B@@@@A@@BB@@@@B@@@@@B@@@@@B@@@@@B@@B@@@@@@B@@@@BB@@@B@@@@@BB@@@@B@@@@@@@B@@BB@@@@@B@@B@@@@@@@@B@@B@@@@@@@@BB@@@@A@@B@@@@@B@@@B@@@@@@@@@@@@@B@@@@@B@@AB@@@@@B@@BB@@@B@B@@@B@@@@A@@@@@@@@@@B@@@@@@@@@B@@@@@B@BA@@@A@@@A@@@A@@@A@@@@@A@@@@@A@A@@@A@@@AB@BA@@@AB@@@BA@A@@B@@A@ABA@@@@@@@A@@@@@ABA@@@A@@@@BA@@@@B@@@@@@@BBB@B@@B@@B@@@@B@@B@@A@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@B@@@@@@B@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@@A@@@A@@@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@A@@@@A@@@@B@@@@@@A@@@A@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@BB@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@B@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@A@@@@B@@A@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@A@@@@B@@@@@@A@@@@B@@A@@@@@@@A@@@@@@@@@AB@@@@A@@@@@A@@@@@A@@@@@@@@@A@@@@@@@A@@@AB@@@@@@A@@@@@@B@@@@A@@@@@@@AB@@@@@@AB@@@@@@@B@@@@@@@B@@@@@@@@@@B@@@@@@B@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@A@@@@B@@A@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@B@@A@@@A@@B@@A@@@A@@@A@@@@@@@@BB@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@B@@@@@@@@@B@@@@@@BA@@@@@@@@@@@@@@@B@@B@@@@@@@@@@B@@@@@@@@@@@@B@@B@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@AB@@B@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@A@@@@@@@@@AB@@@@@@@@@@@@@@@@@@A@@@@BA@@@@@@B@@@@@@A@@@@@@@@@@@@@@@A@@B@@@@A@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@B@@@@@@@@@@@@@@@@AB@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@AB@@@@@@A@@@A@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@BB@@@@@@B@@A@@B@@@@@@@@@B@@@@@BB@@@@@@@@B@@@B@@@@AB@@@@A@@B@@AB@@@@@B@@@@@@@BB@@@B@@@B@@BD@B@@@B@B@@@B@B@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@@@@@B@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@BA@@A@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@B@@@@BB@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@B@@@@@BB@@@@@@@@@@@@A@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@B@@@@B@@@@B@@@@@@@AB@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@AB@@@@@@@B@@BB@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@B@@BB@@B@@@B@BB@@B@@@@@B@@@B@@@B@B@@@B@@BB@@@BB@@@B@@AB@@@@AB@@A@AB@@A@@@A@@BA@@B@@@B@B@D@@@@BB@@@@@@B@@@B@@AB@BA@@B@@@B@@@B@B@B@@B@@BB@@BB@B@@@BBB@B@@@B@B@@@B@@A@@BA@@@@@A@@@A@@B@@@B@@@BBB@@BB@@B@@@@@B@@@B@@@@@@@@@B@@@@BB@@BB@@@@@BB@@BB@@@@B@B@@AB@BA@@BAB@@AB@@@@@@BB@@BAB@B@B@BA@@B@@@@B@@B@@BABAB@@@B@BA@@@BB@@@@BB@@BAB@B@BAB@BA@@@@@@B@B@B@@@BBBB@B@@BB@@@BB@@@@B@@@B@@@B@@@B@B@@@@AB@@A@@BAB@@@B@B@@@@@BB@@@@@BB@@BB@@@B@B@BAB@B@@@B@B@@@@@@@BB@@BB@@BBBB@@@@@@BB@@@@B@@@@BB@@BBB@@@@@B@@@@@B@@B@@@@B@B@@B@@B@@B@@@@@@@B@@@@@@@BB@@@BB@@B@@BA@@@A@A@A@C@E@A@A@C@A@C@CA@@@@A@A@@@A@A@AA@@A@A@AAAA@@A@@AA@@@@AA@A@@@CA@@A@@@A@AB@@AB@@A@@B@@@@AB@@A@AAA@A@A@A@A@@@ABAB@@@@B@@@@@B@@@B@@@@@@B@@@@@BA@@B@@AB@B@BAB@BABA@@@ABA@A@AAA@C@@BA@@@@BA@A@A@A@@BA@@@@BB@@@B@@@@B@@@@@B@@A@@@AB@@@B@@@@@BA@@@A@A@@@@@@@@@AA@@@@@@@@A@@@@@A@@@@@@@@@@A@@@@A@@@A@A@@BAB@@@B@@@@A@@@A@@BA@@A@@@@@@@@@@A@A@AB@@A@@@@@@AA@@@B@AA@@@@A@C@@@ABA@@BA@@B@@@@@B@@@@A@@@AB@@@@@B@@B@BB@@@@BB@@A@@@ABA@A@C@C@C@A@C@@@A@@@A@@@AB@@@B@@@B@@BB@@@B@BB@@B@@@BBB@@@BAB@@@B@@A@ABA@@@@B@@@@B@@B@@A@@@A@@@A@@@C@C@A@@@@BA@@AA@@@@@A@@@CCCAACAA@A@@@A@@@A@@@@A@@@@@A@@AB@@@@A@AA@AAAAAA@@A@@A@@@@@@@@BA@@@@@@@@@@@@@@@@@AA@@@A@@@A@@@A@A@A@A@AAA@@@@AA@A@@@@@A@@@A@A@@B@@A@ABA@AA@@A@@@AA@@A@@@@B@@@@@B@@@@@BB@@@A@@@@@A@A@A@@@C@@@A@C@A@A@C@A@CA@@A@@A@@A@AAA@@@A@A@C@@@AAA@AAAA@@AAA@A@C@A@AAA@@@@@A@AB@@A@@@A@A@@@@@A@@@@@A@@@@@AA@@@@@@@@A@@@@@@@@BA@@@@@A@A@@@A@@@@@A@@@@@A@@BA@@@@@A@@BA@@@@@@@A@@B@@@B@@@@@@A@@AA@@@@@@@@BA@@@@@@@@@A@@@A@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@B@@@@@@A@@@@@@A@@@@A@@A@@@@@@@B@@@@@@AB@@@B@@@@@@@B@@@@@@@@@@@@@BA@@@B@@@@@@@A@@@@@A@@@A@A@@@A@A@@BA@@@@@@AA@@@A@@@@@A@@@A@@@AB@@@@AB@@AB@@@@@@@@@@@@@@A@@@@@@@@@A@@@@B@@B@@@@@@@@@@B@@A@@@A@ABA@A@AAA@A@@@C@@@A@@@A@@@@@A@@@@@AB@@@@A@@@@BA@@@@AA@@B@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@A@@@@@@@A@@@AA@@@@A@@@@@@@A@@@@A@@@@A@A@@A@@AB@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@BB@@@@@@@@@B@@@@@@@@A@@@@@A@@@@@@@@@@@ABA@@@@BA@@@@@@B@@@@B@@@@@B@@B@@@@@@@@@@@BA@@@AB@@@@@B@@@@@B@@@@@@@@@@BB@@B@@@@@@B@@@@@@@@@@@@BBB@B@@@@@B@@@@@BB@@@@@@@B@@@@@@@B@@@@@@BB@@@@@@A@@@@@A@@B@@@@@@@B@@A@@B@@A@@@@@AB@@@@@@@@@@@@@@BB@@@@B@@@@@B@@@@@@@B@@@@@A@@@@@ABA@@@@@ABA@@@ABA@A@ABA@A@@B@@@@@@A@@B@@@@@BA@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@BA@@@@@@@@@B@@@@B@@@@@@@@@@@B@@@@@@@@A@@@A@@@@@@B@@@@@@@@BB@@@@@@A@@@@@@@A@@@@@@@@@@@@B@@@@@@@@BB@@@@@BA@@@@@@@A@@@@@@@@@@@A@@B@@@@@@A@@@@A@@A@@@@@@@@@@@@@A@@@@B@@@@@B@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@B@@@@@@B@@@@@@@@@BB@@A@@@@@A@@@@B@@@@@@@@B@@@@B@@@@@@@BB@B@@B@@@@@@@@@B@@@@@@A@@@@@@@@@@BB@@B@@@@@B@@BB@@B@@@@@@@BB@@AB@@@B@@@@@@@@BB@@@@@@AB@@@@@B@@@@A@@@@@A@@B@@@B@@@B@A@BB@@@@BA@@@BBB@@@@@@B@@@@@@B@@@@B@@@@B@@@@@@@B@@@@AA@B@B@@@@@B@@@@@@@B@@@BB@@@@@@@B@@@@@@@B@@@B@@@BA@@@@B@@@@@B@@@@A@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@A@@@A@@@@@@@ABB@@@@B@@@@@@@@BB@@A@@@@B@@@@@@AB@@@@@@@@@D@@A@@@@@@@@@@@B@@@@@@B@@@@@@@@@B@B@@@B@@@@@@@@@@CB@@@B@@@@@@@@A@@@@@A@@@@@@@@@A@@B@@B@@@@B@@@@@@@B@@A@@@@BA@@@ABA@AB@@@@ABA@G@C@C@ABA@@@CB@@E@@BA@@BAB@@A@CDA@G@@@AA@@A@A@AAA@A@@AG@@@A@@@AA@@CAE@C@C@A@A@AAC@EB@@A@ABA@A@@@C@A@C@E@C@A@AA@@A@A@A@@AC@A@@BA@A@A@CCG@A@@BA@A@A@@AA@@@@@A@A@C@@@A@@@A@ABA@@@A@@BIBA@GBE@A@A@A@A@A@@@EDC@A@A@AA@BA@A@A@@@CA@@E@@@A@AB@@A@A@ABA@G@@@A@ABABC@IHABABABA@A@CBG@C@@@ABA@A@ABABA@AB@@@BA@@B@D@BA@ADA@A@@BA@A@ABAB@@A@@B@@@BAB@F@@@BA@CBADA@@@AB@@@BAB@@@@AB@@A@A@A@A@@@C@A@CBA@A@AAC@A@EAGACAA@A@@BB@@@BB@B@D@@@BDF@@BBB@JDBBBB@@@B@D@D@FAHAF@D@F@D@DDD@@BF@B@B@@@BA@@B@F@B@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@A@@B@@B@@B@@@D@@@BBB@@@@@B@@@@@B@B@B@B@@AB@@@B@@@B@BA@@B@@AB@@@@DB@@B@@B@@BD@@@B@@A@@@BB@@@@AB@@@@@BB@B@@BB@BB@@BB@B@B@@@BB@@@@@@BB@@@A@@@@B@@B@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@B@@A@@@B@@BA@@@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@B@@A@@@@@@@@BA@A@@@@@B@@@@@AB@@@@@@A@@@@@@B@@@@@@A@@@@@@@A@@B@@@@A@@@@@@B@@@@@@@B@@@@@@@@A@C@@@@@A@B@@B@@@@@@A@@@@@@@A@@BA@@@@@@@@@AB@@@@@@A@@@@@@B@@@@@B@@@@@@@@@B@@@@B@@@@B@@@@@B@@A@@@@@A@@B@@@@@@@@B@@@@BA@@@@@@@@@@@A@@@@B@B@@A@@@A@@@@@@B@@@BB@@@@@@@@@@B@AA@@@@@@BA@@@@@@B@@A@@@@@AB@@@@@@@B@@@@@B@@@@@@@@@BA@@@@B@@@@@B@@@@@B@@A@@B@@AB@@A@CB@@A@@@@B@@A@@BAB@@AB@@@BA@@@@@BB@@@@AB@@@@@@A@@B@@@@@@@BB@@@BB@@@@@@AB@@@@@@BBB@@@@@B@@B@@B@@@@BA@A@@@@@AB@@A@@@@@@@A@@@@B@@@@AB@@B@@B@@A@@@@@@@@B@@A@@@@@BB@@A@@BB@@B@@@B@@@BAB@@@@AB@@@B@@@@A@@@ABA@@@@@AB@@AB@@@@AB@@A@@@@@CB@@A@@@@@@BA@@B@B@@AB@B@@@BAB@B@@@B@@A@BB@@AB@@A@@@@@AB@BA@@B@@@@@B@@@@@BA@A@@B@@@@BBA@@@AB@@@B@@@BBB@@@@BB@@@B@B@B@@@@@@AB@@A@@BA@A@@@A@@@@@@@A@@B@@@@A@@@AB@@@BA@@B@@@BB@@@@B@@AB@@@B@@@@@B@@A@@BA@@@@@@@AB@@@@AB@@A@@B@@@@AB@@@@@B@B@@@@@B@B@@@B@@@B@@@@BB@@@BB@@@@B@B@@@@@@AB@B@@@B@@@@@@BBB@@@BB@B@@B@@@B@@@@B@B@@BB@@@BB@A@@@@@@B@@B@@@@B@@@@@B@@A@@@@@@B@@@@@BB@@@@@@@BBB@@@@@@BA@@@AB@@@@A@@@AB@@@BA@@B@@@B@@B@@B@@@B@@@@@B@@BB@@@B@@BB@@B@@@D@B@B@@@@BB@BBB@@@BB@BB@@@B@B@D@B@@@@@@B@@@BA@AB@@@@@B@@@@AB@@AAA@AA@@A@@B@@@@@B@B@B@@AB@@@B@@@@BBDBBB@@B@BBB@@B@@AB@B@@B@@BB@B@@@B@@@BB@@@B@B@@@B@BBB@@@@B@B@BBB@B@@B@B@B@@@@@BB@@@D@@@B@B@B@B@@BB@BBB@B@BBD@BBBB@BB@@@BBB@BA@@BA@@BA@AB@@A@@B@@BB@@BBB@BA@@B@B@DA@@B@B@BABBB@@BBBBBBB@DB@BB@@B@@B@B@D@@@BBB@BB@@@B@BAB@B@@BB@@B@B@@@@@@AB@@B@@B@@@@@@A@@@@@@B@@AB@B@DA@BB@B@@@BA@@AAB@@@DBBB@@B@@A@@@A@@B@BAB@B@@BD@@@B@B@@@@A@AB@@AB@BAB@B@B@@@B@@@B@@@BA@@@AB@B@BAB@B@BA@@B@@B@@BAB@DABAHCDCFAB@B@@@BBABEFAB@BAD@@B@VBhWJC^CdBZHLHFFBJ@JAJENEP@LBZJRHFNNFBPFlJVB`COSlaNWFqN@SN_JILMHCNEDAHALARAfHzNlLBBnDdCXKHCf_\\_@USgCO@GBEDGLINMRMTKPC^C|DVANEPKFS@GEMMeBQHKNIGVARIPMLeEGWWCCBKDGPKPISSLCJGdqTWXMhOnOqqbMPMPYFIPOPCpAVBjL~RXZFVFPDRFHBRDrF^CXC`YVmHIfM^ArFxVnTpFVDV@\\CZELIJMFKAMEMs{meGQoWaEGCO@QDMHGHAPEGjKTeD@UCgL]FCnQVFjLdfH^@nMX[BEAKCK}EKEcNih{deGEWIEACCAAAC@CDGAEE@E@A@EDAB@@C@AAI@UBE@A@A@A@@AAICIACAAC@E@CBGDCBC@@@A@AAIEGCCAA@E@I@A@A@@@@A@ABCBA@A@AAAECOIA@C@@@ABCDEHC@ABE@G@CAEAGCAACCAAAAA@C@E@MBEBCAGAGAGCAACACBK@MDMFE@CBCASEGC@@AA@A@A@AA@A@E@E@C@EAKAAAA@ECAAE@OAIAK@A@A@ABADCFADA@CBA@AAICA@@@@B@DA@@BIBQBCBA@ABADITGL@BA@A@CBG@I@@@AB@BADCDABC@A@A@AAACCE@AA@AAA@A@EDABA@A@@@AA"],encodeOffsets:[[133571,49484]]}},{type:"Feature",id:"230781",properties:{name:"铁力市",cp:[128.032424,46.986633],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@A@A@A@A@A@A@C@A@CAA@@@ABA@@@A@@@A@AAA@A@A@A@AAA@A@A@@@ABA@@@@@A@@@A@@AA@@@A@A@A@@@@@@AA@@A@A@@@A@@A@@@@@A@A@A@AAA@A@@@A@@@@@A@A@AA@@@AA@@A@@@AAA@@AA@AA@@A@@@@A@@@A@@@A@AAA@A@EAA@A@A@@@AA@@ABA@@@@@A@@@AA@@@AAA@@@A@@@@A@A@@@@@@@@@@A@@@A@@@@@A@A@@@@@A@@@@@A@@@A@A@@@AA@@A@@@@@AAA@A@AA@AA@@@A@@AA@@@AAA@@@@@@A@@@A@@@C@ABA@C@ABA@C@A@A@A@A@A@@@@@@@ABABABABA@@@A@A@A@A@A@@@A@A@A@A@A@A@A@A@CBCBA@A@@BA@A@A@ABC@CBABEBAB@@CAC@AAA@CAE@CA@@A@@@AA@ACAACA@@AAA@AA@@A@A@@@AA@@@@@@@A@@BA@@BAB@@A@A@@@A@@@A@@@@@@@A@C@A@E@A@A@C@C@A@@@AAA@@@AAA@@@AA@AA@AAAAA@AA@@@@A@A@ABA@A@AB@@A@A@A@CB@@E@A@@@A@A@C@A@A@A@A@AAA@AAA@A@@@CA@@@@AB@@@@@@@@@B@B@@@@BB@B@@@@BD@@B@@B@BB@@B@B@@@BA@@BAB@@A@AB@B@@@B@@@BBB@@@@@BAD@@@B@@ABADCB@BAB@B@@@B@D@B@D@B@@@D@B@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@BBDBB@DB@BB@@B@BBB@F@D@B@BA@@@@BA@AB@@A@@BA@@@@@AB@@@B@BA@ADA@ADA@@BA@@@@B@@@@@B@@@BBB@@@BB@@BB@@@BD@@BB@B@@A@@B@@A@@BA@CBC@ABA@A@@@A@A@EBA@C@A@@@A@@@@B@@A@@B@@A@A@@B@@A@@@@B@@@@@B@@@@@@@B@@BB@@@BB@@B@B@@@B@@@BA@@BA@@@A@@@A@A@@@ABA@C@C@@@C@@@A@A@@A@@A@@@A@@BA@A@A@C@C@A@A@A@@@@@A@A@@@AA@@@@A@A@@@A@A@A@A@@@A@@@A@@@AA@AAA@AAA@@@@A@A@A@@@A@A@@@@BA@@@A@@@A@@@A@@@A@@BA@A@A@@@A@A@A@A@@BC@@@@@@@A@@@@B@@@@@@ABAB@BA@@BA@@@@B@@@B@@AB@@AB@@A@@BA@A@A@@@CB@@A@A@GBEBA@C@A@@@A@A@@@@A@@@@AA@@@A@@@AAA@@@@@A@@A@A@C@A@A@A@AAA@@@A@@AA@A@@AA@A@@@A@@@A@A@A@@@@BA@@@ABA@@@A@A@A@AAA@@@A@A@@@@B@@@@@B@@AB@@@B@@@B@B@@AB@@A@AB@@A@@@@B@@AB@BAB@@@@ABA@@@@BA@@BA@A@@@AB@@A@@@@BA@@@A@A@@BA@@@@@@@@B@@ABAB@@A@@BA@A@@@A@A@@@A@@BA@EBABA@CB@@A@@@A@@@A@@@@@AB@@A@A@@BA@@@A@@@AB@@A@@@ABA@A@A@@@AA@@@@A@@@A@A@A@@@@@A@@@ABA@@@A@@@A@@@A@@@@@@@@AA@@@@@@@@@ABC@A@AB@@ABA@@@CBABABAB@@A@A@ABA@A@A@A@A@@@A@AB@@A@@B@@@@@B@@AB@@@BA@@@A@A@CBA@A@A@A@A@A@A@@BC@@@@BA@@@@B@@@B@@ABA@@@A@@@C@A@@@AAA@AACCA@@@A@@B@@A@A@A@C@C@AAA@A@C@C@A@C@A@@BC@@@A@@AE@A@A@AAA@@@A@@@AA@@@@@AAA@@AA@A@@AAA@@AA@A@CAA@CAAA@@AAAAA@CCCAC@@AC@A@E@@@CAA@@@AACAAACC@AA@@AB@@AB@BA@@BA@@@A@@@ABA@AB@@ABABCBA@@@@@A@A@@BA@A@@@@@@AAA@AA@@AA@@AA@@@A@A@A@@A@A@@@A@EAAA@@@@@A@@AAAACCCCAA@@A@A@@@@@A@@BA@A@@@ABABC@ABCBCB@@C@GBE@A@A@@@A@CAA@C@C@A@A@@@AB@@A@C@A@@@A@A@A@A@A@A@@@A@@BA@@@A@AA@@A@A@@B@@A@@BA@@BA@C@G@E@A@AAC@AAAACAA@A@C@A@A@@@E@C@G@G@C@A@@AA@@A@@@AA@@@@AAB@@EBA@@B@@ABABABA@A@@BA@@@A@@@@BA@G@@@A@A@AB@@A@CB@@AB@@A@@@A@ABC@A@A@@@@@A@A@@@@@@B@@@@@B@@@@@@ABA@A@A@@@CBA@@@A@@AA@AAA@@AA@@@AAA@A@A@AA@@A@AA@@AAA@A@C@@@A@CA@@A@IAIAI@CBEBKJGBIBKJIDIBGBKBI@OAEBC@C@C@EDABC@ABABADIDE@CACAC@CBCB@@A@@A@@A@@@A@@@A@@@A@@@@@@@@B@@@B@@A@@@@A@@A@@@@B@@A@@@@@@B@@A@@@@@@@A@@@@@@@@@@BA@@@C@A@@@AB@@A@@@A@A@@B@@@@ABA@@@@@@@A@@@@AA@@@AB@@A@@@@@@BA@@@@@A@@@A@@@@@@@@BA@@BA@@@B@@B@@@@@@A@@BA@@@@@@@@B@@@@@@A@@@@@@@ABA@@BA@@B@@A@@@@@A@@@@A@@@@@B@@A@@@@@@AA@@B@@@BA@@@@B@@A@@@@B@@@@@B@@@@B@@@B@@@B@@BB@@@@B@@A@@@A@@B@@A@@@@B@@@@@BA@@B@@@@@BABA@ABABA@ABA@A@A@ABC@@@AB@@A@@B@@A@A@@B@@A@@@@BA@@B@@ABA@A@@@A@@B@@A@@B@@@@@@AA@@A@@@@@A@@@@@@@A@@@@@@@AB@@@@@@A@AB@B@@@@@@@B@@A@@@@B@@@@BB@@@@@BA@@@A@@@@@@B@@B@@@@@@B@@@@@@@B@@@@@@@B@@DA@@@@@@B@@@AB@@@@@B@@B@@@B@@@@@B@@@@@@B@@@@A@@BB@@B@@@@@BA@A@@@A@@B@@B@@@@@B@@B@@@@B@@@A@@@@@A@@B@@B@@@@@@B@@@@A@@@@@AA@@A@@@BB@@A@@@A@@@@@@@@BA@A@@BA@@@AB@D@@@@ABAB@B@@A@@A@@A@@B@@A@BB@@BA@@B@@@@@@B@@@@@@@B@@@@AB@@@BB@@@B@@@B@@@@@A@AB@@@@A@@@A@AB@@AB@@@@@@@@@@AB@@@@A@@@@@@@@@B@@B@@A@@@A@@@@@@B@@B@@@@B@@@B@@A@@@ABA@@@A@@BB@@@@@@@@B@@A@@@@B@@@@B@@B@@@@A@@BA@@@@@@B@@@BAB@@A@@@A@@@CB@B@@@@@BAB@@A@@BA@@@BB@@@@A@ABA@A@ABA@@@@AB@@A@@@@@@@@A@@@A@@@@@@@@B@@@@A@@@@@@A@@@@@AA@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@AB@@@@A@@@@@A@@@AB@@A@@@BB@@B@@@@@@@B@@@@B@@@@@@@@@@@B@@@@@@A@@@AB@@@@A@A@@@@@A@@@@@@@@A@@@@@@A@@@@@@@A@@B@@@@@@@@A@@@@@@B@@@@@@A@A@@@A@@A@@B@@@@@@@@@B@AA@@@@@@@@AB@@AA@@@@@@@@@A@@@@@@ABA@@BA@@@@BA@@@A@@@@B@@BB@B@@AA@@AB@B@@@@@@A@A@A@@@B@@B@@@@@@@@AB@@@@@@@@B@B@@@@@AB@@C@@@@@@@B@@@@B@@A@@@A@B@@@@BA@@@@@AA@@A@AA@@@B@@@@B@B@@BCB@@@@@@B@@@@@A@@@@@@BB@A@C@@B@@B@@@@B@@A@@A@@A@@B@@B@@@@@@@BB@@@@@B@@AA@@@@A@@@@@BB@@A@@B@@A@@@@B@@@@D@@@@@@@A@C@@@@@@@@A@@@@A@@BA@@@AA@@@@A@@@@B@@A@@@A@@@@@@B@@@@@@A@@@@@@@@@@B@@A@@@@@A@@@@@@AA@@BB@@BAB@@B@@@@@A@@@@B@@B@@@@BB@@@@@@@@@@BA@@@@@B@@B@@A@@@A@@@@@AA@B@@@@BB@@@@@@A@@@@@@@@@@B@@@@@@A@@@A@@@@@@B@@A@@@@@@@A@@@@@A@@@@@@BB@@B@@@@@@A@@AA@@A@@A@@@@@@@@@@B@@A@@@A@ABA@ABA@@B@@A@@B@@@@@@A@@@@@AB@@B@@@@@AB@@@@@@@B@@A@@@@@@@@@@B@@AA@@@@BD@@BB@@@@@@@@A@@BB@@@A@@B@@B@@B@@A@@B@@B@BA@@@@BB@B@@@@@@E@@@A@BBA@@@@@AB@@A@@@AD@@@@A@@A@@@B@@@BA@AA@@@B@@@@A@A@@B@@@@@@@@@@@@@BA@AB@BA@@@@@@@B@@@@B@@@@A@@@@B@@A@@B@@@@B@@@AB@@A@@BA@@B@@@@A@@@@@AB@A@@@@@@A@@B@@A@A@@@@@@@BBB@@@@@@@@@A@@@@@AB@@@@@@AB@@@@@BA@@@B@B@@B@B@@A@@@@B@@@BA@@@@BA@A@@@@@@BB@@@@@@@BB@@@@@B@@@@@@@@A@@@@@@A@@A@@@@BA@@@@@@@@@A@@@BB@@A@@@@@@@@B@@@@A@@AA@@@@BA@@@A@@@@BA@@@BB@@@@B@@@A@CB@@A@@@@B@@@A@@A@@@@@BB@@@B@@AA@@@B@@@@@@@B@@@@@BA@@@@@@@A@@B@@A@@A@@@BA@@BAA@@A@@@BB@@@@@@A@@@@BB@A@@@@@@@A@@@@BA@@@A@A@@B@A@@@@@@@AA@@B@@@@@BA@A@@@BB@@@@A@A@A@@BADA@@B@@B@@@B@AB@B@@A@@@AB@BADA@A@@AA@A@@@ABA@@@A@@@@@A@A@@@@BAB@@@BA@@@A@@@AB@@@@B@B@@@@@AB@@B@@@@@@@A@@@@B@@@@A@@BA@@@@@A@@BB@A@BB@B@@@AB@@@@@AB@@@B@@@@@@@@A@@A@@AB@@@@A@@@@@BB@@@@@@BBA@@@@B@@B@@AB@@@B@A@@@@B@@@@BB@@@@C@@@@D@@@@@@AA@@@@@@AB@@@@A@@@@@@@@@A@@@@B@@A@@B@@@@@@AA@@@BB@@@A@@@@@@@AA@@@B@@@B@@@@@BA@@@CA@B@@B@@B@@@@A@@AA@@@@B@@A@A@@@A@@@@@@@@@A@AB@B@@@@@@@@@@@@@B@@@@A@@BA@@@AA@@@@@@@@@B@@@@@@@@AA@@@@A@@B@@@@@@AB@@@@@@@@CB@@@@@@@@B@B@@@@B@@A@@@A@@@AB@@@@A@@@@@@@B@@@@@B@@@@@@@AB@@@B@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@BB@@@B@@@@@@@@B@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@BA@@@@BA@@@AB@@@@A@@@@@@@@@@B@@A@@@@@@@B@@B@@@@@@@@A@@@@@@@@@A@@@@@@@@@@B@@@@@B@@@@A@@@@@A@@@@@@@BB@@@@A@@BA@@@@B@@A@A@@@@@@AB@A@@@@@AB@@A@@@@@AB@@@AABA@@@B@@@@B@@@@AB@@@@@B@@@@A@A@@@A@@@ABA@@@ABB@B@@B@@A@@@@@@@@BB@@@@@A@@B@@A@@BA@BB@@A@A@BBA@@@A@A@@@@BB@@@AB@@A@@@AB@@AA@B@@AB@@@@@B@@@@@@@B@@@@@B@@@@@@A@@A@@@@@B@@@@@BA@A@ABB@ABB@AB@@@@@B@@A@@B@@@@ABA@@@@B@@@@@B@@@@@@A@@@@B@@AB@@A@@@B@@A@@A@@@@BA@A@@@@@AB@@@@@B@@A@@@A@@@@@@@@B@@C@@@@B@@@@A@@BA@@A@@@@@@@B@@@@A@@@@B@@@@@@@BA@@A@@A@@B@@AA@@@@@@@@@@AB@AA@@@A@@@@@BB@@@@CB@@@BA@@@@@@@@@@@@@AA@@AB@@A@A@A@@BB@@@BB@@A@@@A@@@@@A@@@@@A@@@@@@@AAA@@@@B@@@@@@@BB@@@A@AB@@A@@@AB@@@@A@@@@B@@A@@@@@@B@@@@@@AB@@A@B@@B@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@B@@A@@@A@@@@@A@@B@BA@@BA@@A@B@@@@@@@BA@A@@@@@A@@@@@@@@@@@@@A@A@@@@@AB@@B@@@@@@@A@@@@@@@@@@B@@A@A@@@@@@B@@@@@@@@A@@@@@A@@A@@A@@@A@@B@@AA@@@@@@@BA@@B@@@@@@@@@@@@A@@@@@A@@@B@@B@@A@@@@@A@@@@@@A@@@@A@@BA@B@AB@@@@@@@@@B@@B@B@@@@B@@AB@@@B@@B@@@@@A@A@@@@@@@BBA@@@@@@@@@B@@@@@@@AB@@B@@@@B@@@@A@@@@@A@@@@BA@@@@A@@@@@@A@@@@@@BAA@B@@A@@@A@BB@@C@@@@@B@@B@@A@@B@@@@@@@@AB@@A@@@@@@@@@@@A@A@@B@@B@@@AB@@@@@B@@@@@BA@@@A@@@@@A@@@A@@A@@@@@B@@@@@B@@@@@@@@@B@@@@@@@@BA@@@@@B@@@BAA@@@@@@@@A@@B@@@@A@@@@@@@@B@@A@@@@@@@AB@@@@A@@B@@@@@@B@@@@B@@A@@@@@B@@B@@BB@@A@@@ABA@@B@@BB@@@@A@@@@AA@@@@BABAB@@@@@A@@C@A@@@A@A@@@A@@@@@@@@B@@@@A@@@A@A@@@@@@AA@@@A@@@A@@@@@@A@@A@@@CBA@@@@BAACB@@A@@@@@@@@B@B@@@BA@A@@@@BA@@@A@@@AB@@@BA@@B@@D@@@@@@B@@A@@@A@B@@B@@@@@@A@@AABABE@E@ABA@A@AACAEAE@A@A@ADEHCBIBG@CBA@ADA@@B@@ED@@@BB@B@@@@@B@@@@@@@@BA@@@@@@@B@@@@B@@A@BBABB@B@@@@@AB@@@@AB@@@@B@@@@BB@@B@@@@A@@@@BB@@B@@@@@@B@@@@@@@@B@BB@@B@@@@B@@@@BBB@@@@A@@@@@@@@BB@@@BB@B@@B@@AB@@@@@@@BB@@ABA@A@@@A@A@A@@B@BA@A@C@E@A@@@ABA@A@AAA@@BEDAD@@A@@AA@@B@@@B@@@@AB@@B@BBA@@@ABAB@@@@A@ABA@A@@B@@B@@@@@@@@B@@@@A@@@CA@B@@@@@B@@@B@@C@@@@B@@A@@@@AA@@@@@A@@@AB@@A@@B@@@@@BB@@@A@@@A@@@A@@@AB@@@@@@@@@AA@@@@@@AA@@@A@@@AC@@@@A@@B@B@@@BC@@@BBA@@@@@@@A@@@@@AB@@@AA@A@@@@@@B@@@@A@C@A@@@BB@@@@@BCB@@A@A@ABABB@@BB@B@D@D@D@D@BB@B@@ABA@E@A@A@AB@BBBFBBB@BBBD@B@@AFCBCB@B@D@@@@B@@ABABA@@BBBB@BAB@BB@B@BB@D@D@BB@B@@@D@BB@BBD@DA@A@@@ACACA@A@AB@D@BBBBB@D@@A@A@ABAB@B@DAB@B@BBD@B@B@BABA@ADAB@BBBB@DAB@BBBBBD@B@FBBBBBBB@BBBDBB@BA@A@A@AAC@ABABAD@B@D@BB@B@BAD@B@BBBBBB@BAB@AA@A@AB@B@B@DBBBD@B@DAD@BBDAD@B@BB@B@BCBA@@B@@DBB@BBD@DABAB@D@B@F@DAF@B@BBB@@B@B@B@@B@B@BA@@BAD@B@BB@@@BAB@BA@@B@@@BB@F@FBB@@B@BBB@@BBB@B@BCBAB@B@B@BB@@@B@B@@ABA@@@@B@@@B@@B@BBBB@B@@BB@@BBB@DADB@@BB@BBD@BBBB@BBB@B@B@D@@@@B@@@BCDCB@B@@BBB@BB@@@@DA@@B@@@@@@B@@BB@@B@@AB@BA@@B@BBB@B@BBB@B@B@@@BB@@@B@@A@@@@@@B@@@@B@@@@@B@B@@@B@@@@@@A@@@@@A@@B@@@B@B@B@@@B@@@B@@BA@A@A@@@AB@BB@@@BBBAB@BB@@@BBB@B@@A@@B@@BB@@@@AB@@@B@@@@B@B@BBB@@@B@@A@AB@B@BB@@BB@@B@B@BA@AB@B@B@@@@B@@CB@@A@@BB@@@B@B@BA@@B@@@B@B@B@@@B@@B@B@@BBB@B@D@B@B@BADA@@BA@AB@B@B@@B@@AB@@C@@@@B@@@BBB@@BBBA@@BAA@@AB@B@B@@@B@BB@@CB@B@B@@@BB@@AD@@AB@B@BB@@@BA@A@A@@B@@BB@@B@B@BAB@@B@B@@BB@@@B@B@@BB@@B@BAB@@AA@AABA@@B@BBB@D@B@@@@BA@A@@B@@BBB@BB@BBBBB@@B@@@@A@@@@B@@@B@@@B@@@@@@A@@@ABBB@BA@@@AB@@@B@B@@@B@@A@@B@B@D@BBBA@@@AA@BABC@AB@B@BBB@ABA@AB@@@@B@@@D@@@@BB@@@B@D@@ABBB@@@@BA@@B@@B@B@B@BAF@DAFAB@@B@BA@C@@B@@BBB@B@D@BABA@@AAA@@A@@AAA@@A@@D@B@BAB@B@BA@@@AB@@A@@@@A@AA@@@AB@B@B@@B@@@B@BB@B@B@@A@AB@DBB@@@@BAB@B@@@@B@B@D@BAD@@@D@BAB@BBBBBB@BBBBDB@@B@B@B@@@BBF@D@BB@@@B@@AB@@@B@@BD@@@BBB@B@B@BCBA@BDBBD@@B@@@DAB@@@B@@B@@ABC@@@AB@@BB@@B@BBBBB@@BDAB@B@BB@B@B@@B@@@B@AA@@@A@@BA@ABAB@@BBBB@BB@@B@B@@A@@AAA@@ABA@@D@BADABAB@@@@B@B@@B@B@B@BA@@BA@@BB@@B@@@B@BC@AB@B@BB@BB@B@BAD@BAB@@A@@A@A@A@AA@A@@B@B@D@B@BADAB@BAB@BAA@@AA@@@@A@@DABAB@B@BBB@B@BBB@AB@AA@A@A@@B@@BBD@B@B@@AB@BAF@B@BBB@B@DBB@@@BBBBB@@B@@B@BA@@B@BB@B@@@@F@DB@@@B@@CB@@ABB@D@D@@@@BB@B@BA@@@A@A@AAA@@@@BAFDD@@BB@BABA@@D@BB@@@@CB@@ABB@B@BBB@H@B@A@AAA@AA@AB@BADABA@BDB@BB@BB@@B@B@@AB@AACAAA@ABC@AB@DAD@BBB@@@ABA@@@@BBBB@B@@@BCB@AAB@@AB@BB@@@BB@B@B@BB@@BB@@B@@AB@BA@AB@@A@@@AB@D@D@B@BA@@B@B@FDBB@@B@BA@A@AA@@AB@B@B@FDB@@B@B@B@@@@D@D@@@AA@AB@B@B@@@BB@BBBBBD@DBB@BBDAB@B@@B@B@@BBBBB@B@BAB@B@@B@BBBB@D@D@B@D@BBD@DBH@B@HDHBDD@@@B@@AB@@@BB@B@BCBBB@@BB@B@@@BA@@DAB@BB@B@@A@@BB@B@BBD@BB@D@BB@B@B@BAB@BB@B@@@BA@@@@BB@DBBBB@D@BA@A@AAA@@@@ABAA@@@A@@B@D@@ABA@@BAD@BAD@B@@@@BBB@BB@B@@AB@B@@@@BCBABC@@@@BB@B@D@BABAB@B@B@BBDBB@B@B@BCB@F@D@B@B@BAB@BBBDD@BB@A@@AA@@@AB@DBB@@B@B@BBB@BB@D@B@FABAB@BBBB@DBB@B@BB@DBBD@B@BABADADAD@B@BBB@BBBBBFDB@B@@A@@D@BAB@BBB@@BBB@B@@BBBB@B@@BBB@@@B@@B@@@DBB@@@BB@B@DA@@@B@@@BABB@B@B@DB@@BABAB@B@@@B@@C@@B@BBB@@D@@@@AB@@BB@BB@DB@B@B@@@B@@@B@@BBB@DABA@@@A@@B@B@@@@B@@@B@@BBD@B@D@@@B@B@BBC@@B@@B@BB@B@B@@BB@AB@B@B@B@BBBBB@AB@@@B@@BBD@B@D@@A@@@AB@B@@@B@DA@@B@@B@B@@B@B@@@@@@AB@B@BAB@@@BA@@B@B@D@D@B@@@@@@B@@@B@@B@B@FCBAB@BAD@BAB@B@D@B@BB@@@@ABB@DBBBB@BBBAB@BBBBB@@B@B@B@@@B@BABABCB@@@B@BA@@BA@@BA@@DABBB@@@B@B@B@BB@BB@@@BB@@BB@B@B@@@BA@@@AB@@@B@@B@@@B@B@B@@@B@@BB@BB@B@D@DBB@BBB@BBB@B@DBB@DBB@B@@@@@@B@@B@B@B@B@B@DAB@@@BB@@@B@B@BB@BBBBB@BBDBB@DBB@D@@BB@DD@@BB@@@B@@@BB@@BB@@BB@@@B@B@B@D@B@B@BBB@@BB@@@@@@@@BB@@@BB@@B@B@BBB@B@B@@@BBB@BBB@D@BBB@B@B@BBB@@@BB@@@BB@@@BBD@F@B@@@B@AB@@@B@@@BB@@@B@@AB@@@B@B@D@BA@@BBD@B@@@@B@B@@BB@@BB@@B@AB@@A@@@BBBBB@BB@@@@A@@BB@@@B@B@BBBBBBB@@@@AB@DBDBB@@@@B@@@@@@D@B@B@@@B@@B@@A@@B@@@BA@@B@@@@@BBBB@B@DB@AB@@@@@@AB@@@@@BB@B@@AB@@AD@B@@C@@@@B@@@@@@@BA@A@@B@@BB@D@@@BA@@@@BB@B@@B@@@B@@BBBBBB@@B@@@@@B@@AB@B@BB@@@@@B@@B@@@@@D@@@B@@@@BBB@@@B@@@@@@@B@@B@@@@@@@@@@B@@@B@@@BB@@@@@BBB@B@BB@@@@@B@@@B@@@@@B@@@B@@@@@B@@@@@B@@A@A@@AA@@@A@@@@@A@@@@B@@B@@BB@@@@@@B@B@@@@BB@@B@BB@@@@@@B@@@@B@@@@@@@@D@@@BB@@@@@@A@@B@@@@@B@@A@@@@@@B@@@@@@B@@@@@@B@@@@@B@@A@@B@@@@@@@@@BA@B@@@@B@@@@@@AB@@A@@@@B@@AB@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@A@@@A@@@A@CB@@A@@@A@@@@@A@@@@BA@@B@@@@BB@@@@@@@@@@@B@@@B@@@@BB@@DB@@@@B@@B@@A@@@@B@@ABA@@@@@@@@@A@@@@@@BA@@@@@@@@B@@@@@B@B@@@@B@@B@@@@@B@@B@@@@@@@B@@@B@@@@@@B@@@@@@@@B@@@@@B@@@@@B@@B@@@B@@B@@B@@@@@@@B@@A@@B@@@BBB@@@BA@@B@@AB@@@B@@BBBBBB@BB@@@B@@@DD@BBBBBLHBBD@@BBB@@BBBDFBBB@BB@B@BBBBBBBBB@D@@@FD@@DBDB@BBB@B@B@D@BB@@BBB@B@D@B@BB@BBDD@@BBDD@B@B@B@BBFA@@DA@@B@@@BAB@@@BAB@BAB@B@B@@AB@@@BAB@@BB@B@@BBB@BBDBDBBBB@BDBD@B@DAB@BABA@@B@@CB@@@@AB@@AB@@@BA@@B@@A@@BBB@@AB@@ABADABABAB@B@@A@@BB@@BB@BB@@@@BBB@B@@BFLJNFFFBJ@JCJGJGLANBHHJJDHHHJFPDJEHQBOASQkACGUBSFQJMDCLGPKBC@C@K@GDG^]HGFEJEN@NALGNCNCNALAFA@CIEUCSCMGIQCKBQ@KAEeyEQAMHKDG@E@K@CCMOMMKCEG]@G@EBOBG@EDGDEDGDEHKBCLMPKNKHEFCRBJDRLHHLBNAVERCJCBCJKDEFERMDAL@DBFFBDVZFBH@PAF@L@VHLBLDRCVEdMjM^IlWfS^KZKJGFEHO@EB@@A@A@CB@@CBAB@@ABA@@BA@ABA@@BA@A@@@@@A@@@@@@BAB@B@@@@A@@E@GAICGAA@A@@@EAAAAAAACAA@BADBD@@B@A@@@A@@@A@ECAAAG@@@@@A@@C@@AA@A@@@@AA@A@AA@AC@A@@@@BAB@BA@@BA@@@ABAB@@@@AB@@@BABABC@@BAB@BA@@B@B@BA@@B@@A@@@@@ABA@AB@@ADAD@BA@@B@@@B@B@@@@@@@BAB@B@BAB@D@@AJSDOBSBA@AXqHQLS@AHIJGHCVCTIJCFKFITIREJAT@NDJDt^LBD@LCPQHEFKXARAP@LENGHCHBHPNRDBL@J@HC¢{DADCHONKTSJGDEBKDSBIDIHITIZEL@H@DARSBCDCVIF@JAF@HEFI@MCIIIGAGCcSIGEECEGKGQI[AE@@A@AA@@A@A@A@A@@@@@AA@@@C@A@A@@@AAA@@AAAAAA@@CAA@CACAA@C@AAA@A@@A@@A@ABC@CDA@@@ABA@C@A@@AA@A@A@@@A@A@A@AAAA@@@@A@@@@@A@@@ABA@@@A@AAA@A@A@A@@@AA@@AAAA@@@AAA@@A@@@A@@@A@@@A@@@@@AD@@A@@@@@A@C@C@A@@@A@AA@@@A@AAA@@@@A@@AA@@A@@@@@@@@ABAB@@A@@@@@AA@@@@@@@A@@@@AAAA@@@@@@@A@@@@@A@@@@AA@@C@@A@@@@@AA@@@@@AA@@A@@AAA@A@@A@@@@@AA@AA@AA@AA@@@@A@@A@@@@@A@C@@@AB@@AAAA@@AA@@A@A@@@CB@@A@CBA@@@@@A@@@@@AA@AAA@@@A@A@@BA@@@@A@B@@@@A@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@AA@@@@@@@AAA@A@AAAACAA@@AA@@AA@@A@A@AAC@A@AAA@AA@@AAA@@CA@@AA@@@AA@AAAA@@@A@A@@AA@A@@A@A@ABA@A@A@ABC@C@A@A@AA@@A@@A@@A@@AA@@A@@A@@A@@AA@@AA@CA@@A@AAAB@@A@ABA@ABABCBABA@ABCBCBA@A@@AA@@AAAAAA@@@A@@@@@A@AB@@A@A@A@A@@BA@A@AAA@A@@BA@A@A@A@@A@@AC@AAC@@@A@@AA@@AA@A@@@A@A@ABC@ABCB@@A@@@C@A@A@C@A@ABA@AB@@ABC@@BA@ABABAB@@ABA@A@A@A@C@CAA@C@C@@@@@@@AA@AAA@@@@AAA@A@A@@@@@CACACAAAAA@@AAA@C@A@@@AAA@ECCAEA@@@@@AAB@@A@A@CBA@C@ABCBA@C@AB@@A@C@C@@@@@AB@@A@@@AB@B@B@BBBBBB@@B@@@@AB@@@B@B@@A@@@ABA@@@@BC@@@@@ABCBABABABAB@@@B@B@B@@@@A@C@A@C@A@C@A@@@ABA@A@AB@@@@@@@AAA@A@ACCCEAAA@A@@@@@AA@@AA@@AA@@@@@A@@AA@@A@A@@@A@@A@@@@@@@@@A@@@@@A@@@A@A@@@A@A@@@AAA@@@@A@C@CAA@@@A@@@AA@@@@AA@@AAC@@@@@@A@@@A@@@AAA@@@AA@@@A@A@@AA@AAA@@@AA@@A@@@@@C@@@@@@@AA@@@@@A@@@@@A@@@@@@BA@A@@BA@A@@@AAA@@A@@ACA@@AA@ACA@@AA@@A@AAC@CCCCCA@AA@@A@@@A@@@A@@@A@@@@@A@@@@@AA@@AAACCAC@AA@@A@@A@A@AAA@A@A@A@@@CBA@@@A@ABA@@@@@@B@@@@A@A@@@A@A@CAA@@@AB@@@@ADABAB@B@@ABEBA@CBA@C@CBC@AA@BA@ADAB@B@@@@ABA@@BA@@@@@A@@@ABA@A@A@C@@@ABA@ABABA@A@A@AB@@@@@@AB@@@@ABA@@@A@@@AB@@@@@BA@A@A@C@A@@@A@@@AB@@A@@@A@@@C@C@@@C@@@A@@@AA@@@@A@@@A@A@@BA@ABA@C@@BC@@@AAA@CBA@@@A@A@A@CB@@@@A@AB@@A@A@@@@@AB@BAB@@@@@@AB@B@@@B@@@B@B@@@B"],encodeOffsets:[[132008,47644]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)};A?A.registerMap?A.registerMap("黑龙江",{type:"FeatureCollection",features:[{id:"230100",type:"Feature",geometry:{type:"Polygon",coordinates:["@@LKT]LEL@HB|VX@TOJELBHHBJJNnVJJFHDJJXDFJJTNVDJHBD@BEJAHDNFF@HQPAFAJFNPD^B^EJGPALBPHJHDH@HIJOJGLBLHHGD@DBDFBLADB`TJL@DCXFHTHhBXAJCHCJILUHAbGPC^QJElFJDFFBFAH@LDJFDPDH@FCJIJODCHANBZJ`ZFBFCPAzBVEPIF@LBLHLBFD@N@FGP@NGEACEAI@CB@BDFHDJBDDADKHABBFF@fJH@DADSFIBEDAJ@FBHND@LCRMF@@@BFGFAD@LHHEBELDJ@HDNBTCLGJCPGBBDBBVFFHBHCJDJGHUHMHAJBFFDAJNFAPH@DFEDAND@XVCZQ@GD[NSFGHIRATINBFE@FHD@DF@BI\\@FEL@LU@GAG@EEQB@DN@@BsFGKMBAIEAW@IBHPC@@PFA@DEB@BFB@NELW`@Bi`WVSTS\\BAhR\\STMLB@KJB@VTBB@H@ADJBENQACHHHHBJETBNBLGNKJCF@ZDJAHGRARBXF@D@LNDFJALDDPBBJDDL@BCFCF@@CHBBAHBFABBBAD@D@DDJKJChA@FFANADLPBFBEDBDNHBDDJAFADDB@HLCF@HD@DDBABHACDABABA@CDH^|~jºfxvBDELFPFBAD@BCBDDrMFBN@B@AD@FFD@@BBCFFBB@AADADHFCABBBA@@BC@A@BBABB@BA@BBDH@CBAFJABJADFAFDFAABFBEA@BF@ABBDDAABFBAADB@AB@AABCB@@DDAABH@CDFAABBBCBBBB@@ABBD@ADEAEBHB@BAACBD@CBD@ADBABBABBBEB@DF@DBF@@FDBBABFNFBDB@DCDBBBD@BBDBA@@BFBFA@BC@BBABL@CBD@BDA@CABDCDFCDB@DDB@BH@FHBA@DDD@DJ@CDFA@FDB@DH@DABBF@BCHA@AD@AAF@FCBAFABBABHBC@BBEB@BBADBFC@DC@@BD@BAD@@@CD@BB@@ADADBEB@BDAFBABE@DBCABDC@B@ADBBCBB@BDDCBDFAB@CA@AH@AAD@AAFABBCDD@CDBBABHACDBB@CBBDABD@AFAE@BACC@AB@BB@ADBDAECFC@BABBBBABBBAB@CFBABBFBCBD@BBCBBB@BA@BBBABBF@CB@DB@BC@DDB@BF@@CA@@CAA@CD@@DJ@FE@DCFB@@ADAADB@@AFBBDC@BBC@BBCD@BB@@AF@C@BBDA@AD@BC@FDAD@@@@@AB@@FBABBBABFDABBDE@@FC@AFHAD@CBFJADDBAFDA@BD@@DABFABDD@DA@FBBAAABBBCDFBC@@BDD@DD@BEF@BBDA@BD@@DBBNBBBB@BABBDFAHCBCHPDPLD@HEFHJBHHF@DBDAJDBAAA@CAAJDJFAB@FJB@BDBABDBBCBBD@@AFA@BB@@BBAFDHABBDAADB@@AB@DFJ@BDDA@BDBNDJAFBHCDBH@FFDFFBADD@@DJDDDHABDF@FBLBFDNBFJHBBBCDBDAJDBDBBAFDN@ADBDEDDDE@BDBDNA@BADDBBA@ABADFDBF@JCF@@B@DF@DBGB@BFDF@BDH@FFJADABCBA@CFB@AF@@DDADDHBCBBBC@ABABBBDBFA@BDBJAJ@BDEDDBC@@BAB@D@BB@B@ADFAFHNDB@@ABAD@FDB@AAB@FBJA@DDBBBCBHBBDF@BDB@BDBAB@DCDB@DBD@BB@ADDABBCFC@@BBABBD@BDHBHBBADD@BBB@FBABDB@DBBADBBA@BBB@D@@BA@BDA@DDBDA@CHFDABBBADBF@@AD@@AH@@BB@@BB@BABBDAD@CBFB@BDB@BH@DCDDBAB@B@@BBB@AHABBDA@CD@BBB@D@BABBDBHB@CDBBCJAAADAAAFADBABBDFBAB@BB@DBBADBBA@BD@ABDBADD@AAD@@@BB@DB@DAD@DDD@@ABBF@BAFFHBDDBBABFFB@FDNB@AJ@@B@@BBJA@BDBBA@ABBB@BBD@DB@AJBHADCFB@AD@LEJBP@F@@DLADAH@FAFBFABAFAAADABCHABCDAAAFC@CDCACD@@CJBBCDB@AFCBBH@DBN@FCFA@CDEHCLINALGLB@GBAJEBGDA@ELI@G@AHEHABEFCAADEGEDCACDKEE@K@AAABEBCAMBCD@BC@G@A@CCABGNKDGNABAVBREJ@ZKNOVBHBJ@HJFAJHFB@DD@FFH@BBBJL@LJJBNHFAFBHHHBDBHEDBDJHH@BDB@DDBFBDCFFHBJCBGD@FCFBDER@FDBF@@@FDBD@LADDJ@FPNAFENHFFXLF@HADBBBDBJ@HNRRLFRCLJJDFALGFSHEJGDMHBFCDFDDXFFIJAD@@EDALBBDDBFCLADBHDFADBHABENENDFBN@DCJAFC@GDCFAFCN@FCDCBEF@BEFA@CN@BA@CJA@EJAHADBNADBFCL@@A@CPIFBLDFAH@LEL@B@JBHEBEPKPCZDBA@CBAAABEFENCF@HALAbHCFBNGF@DRBBDFAHDH@BBDDJBHFHLFBJ@FEDGFIDKBAT@FDBFHBHFJAFJSHAH@FEDFD@DAFJVFAH@FGHAB@@FBDBDJDLBF@DDJBB@NFBFLFHDLABEF@LANEJ@HCFGJCFHf@HBJFH@N@FEFB^CPBVATIHANPNDBHHFAHGJAHGFFHFDHDL@NHFFNDBBHLRDTANBHALHF@FABE\\CDGTCPKHAFBJAJBFANEJALEJ@HGFABABAJEDGHCBKB@LBFCH@JDRBDLF@bGFC@CJI`EF@HJN@FBrADECIBCHETAPE@EEGAGHEFIFCDAAQOKAC@QJMBGAEFEBC@CGOF@LDJ@PARCPLDB`@D@FEDHLN\\HNGTELAR@JGH@ZCHJDL@PD@DHFAZFHNFBNBDHJBHARDFARBH@@GFIRCNANCFB^ADCXKPCJIJALAHCHKLBNEH@FBLPBNLJHBHHFDBDCJBDF@NFBF@DFBDDNBBPFBFHHDHJBHHCT@@EFERIBGEGBCNA\\GVJJBDDHDLDDFTBF@HGLEPANEF@RH@PCNHJELGLAH@FFHHHP@JABBBFLJDFFPLJFBBDALDFB@HAHAFFHALJDFJH@DDFDBDCFJDBL@DCD@LJLBFAFDRBNEDAVHFDFF@JL@JGFA^EdIP@TCHMLCCGBAJGDCFEIEACBCJILCH@HEDBHHDBHBNAHH@FBBTDPHTAVEPAHE\\C\\@XJS@EZQDALDDAFG@ELEHIREFBDCACDAAEAC@ED@BAEIBEBAHEPEAEDALCLEJCTIV@NLLBPIbELBA@BCBADDFBBCJBDCC@BEHDRA^Rh\\T\\^NLNHtPPFRJPPLNFJFGNMNEFG@EH@PGDDF@D@AKFGBEDEBEVMACFEHMHGFAHFRCHVF@HFFBJADFRDBJCN@DJ@VGPOLBFDFLNNEBCJFBBFF@J@FCL@AKACCACGOO@CK@DG@WEYSMAADEGCgK@CFECGNCAEBA~@@CCEDABEB@NFLBJFD@DCBCDCFBZCFGLA@CBCD@J@PGB@ALGNCJ@FADC@ADAHDB@BGFAF@JHHGL@FGFCFCLEJBJBFBJDFFFDFDDF@DBFCLAPFPHNVZJP@JFBHDAJGDEAEIKACGGAIGECGECEAAABC@EEE@GCGEECE@GEC@EGEBEEG@CEMDCAABKCCBCCCHGACBEEGDGAIAEACACGE@CLCBIEE@AFCFGHCJEBEHEACBGCAAGBMCGAGAAC@CABEIAACA@C@ABGAEBGC@CDGEA@ABIH@ACABAADCCEA@@DAAAEGA@FC@GA@CAAC@AAD@AAFCC@DAAG@CABAAABAAA@AABECBAACBBCCAA@@DAAAGCBE@@CI@CFC@@DG@@AAAABC@@BABCA@DC@AACB@CC@AABAGEAABAAAC@@CEDEC@CBADBDA@ECB@AF@BEEBAABA@ACBAAABAABAAACHAADCEB@EC@FCKBAAC@BADAA@MBBA@AEBCFC@FBFCAF@BB@BBI@HDKA@FC@CDCACBCAAB@@D@BDB@ABBBC@DDC@CABDED@B@BC@@ACDAAK@BAE@AABAG@ECBACDGA@B@AEDICABAAA@BAA@AB@BCACBA@BAEA@BA@@BA@@A@@GJDA@BABB@@BK@@ADAAAKFCA@@BBE@BDEABACBACCBAAABBBA@GA@CA@@AA@AAABBAD@@AC@AA@BA@@AABAAABDBCB@CC@AAC@@A@AADAA@AIF@A@A@@ABEAADECA@AAEB@GG@AA@EDA@CLCJ@FA@EIC@ADALFBABIHB@EHIAGDCF@HBJAH@BAAED@FB@@@EDADB@FD@DCBAHFD@DAAEBAB@DAH@DALIJ@@FFDD@@GDCBCAGFABAD@HOLABAEEDABAEGF@JDDIBAZCLE@CG@AA@AH@DCEEACBANADGICACHEHAFGHABGJGJAFELA@CCA@GBAHAHBF@BED@B@BFB@LA@EHDBCF@BE@AGE@CBAB@@FHALHD@@AGCACF@BEJBAGCCB@JF@ELKCCJA@ED@BCD@GEACJBB@@EBAHBAGDCD@DDBA@AECE@@CCA@AF@BAGAACPEFED@@EB@BDB@BEFDBABACABAFABBB@@CBAHDDAAACAFE@ADA@CDABCACDBD@AIFCBCFCJBDAICDCAEBADADBFEA@EB@A@CFAHDFBBCC@AAFA@CJBFCDEB@BFH@@A@CFC@CECDEI@FEAAaKM@AEEGKCAASQEIGCGEQ@IGSI_IG@CJUAOCECAQCCAG\\IDG@ICG@QCECCMAAABIHG@EEGFEGCCCACDC@EAGCC@CMIBADA@EDGACECAAHIHEAMDKEEBGFC@GFMMCGFE@EEIECC@CGCGBEFCBAAACE@ICQFIGKAKGGHGDCDEB@FDBAD@BE@GCGDEAMDQII@GBEA]@EAAAIKI@EDC@KKGAGL@HEDGBKLEASIEBC@MCOJ@BBFADBH@DSBAFBHGD@FEF@BKBCDCJGBCDEB@DQFCD@DCDCHCDADCBAFOCI@ACIAIBKFQ@GAACACBE@AMGIBMIBC@OBELEDUDI@EBCCA@CK@MK@ADC@GDGACLOBEACDECIEAAGMCKGEDAFKBGFYDKDIAKAQJC@MEGGOEMAIEIBGAG@MGAGGCAAEBCFIB@H@BIBCDIBEFI@CBC@KFGE@EEEOEIKEFIBIDCDIDADE@GEQEMIJG@ENGBAEEBCECG@QIE@GAEE@CNGHIDG@EFEAAEACECGAEGAEEIDEAGC@GQIE@SCIB@KEMODWBKCOHKCAAHEBCKKCIAIAEQGINMHOFEDMCQ@MEGEGGN_JCDG@AQMSDWC[@YKEBKDK@AIEIAE@IDIEIBKJQDCRGEEWMQAYGUA@EBKEEIEACDIDEBCLAEWFI@GIAMG{DUGG@KIACCEKCKACCWQeFEA@LG@MJMEC@KHKDIHKLEDGDEAIECAMFKHG@GDYEQQDGOMAC@CJO@ICEFEBEAGFEBKHANBXABIHGDEFA@EDGEEHIFCMOCEBGHGCODECI@GKIAI@EACMMCEPMTGNG@CCGLOHENCXILEPA`IBECEAGCO@CFGBCGM@KCCICMKGAMG[ICBIFM@CBCCKEI@KEQ@EASMYAIGGCIMAAKAIINE^ODELGBC@MJEAGEKBOXCNEBIDCTCFEPNPBLCDBHJFBLFJDF@D@HKPGFIBCES@GPAVALAEM@GOYDMLGBCBAACK@QIEGAEGGKAeQSAEFIDG@@ECA@CGAG@CCDCFCAIOA@CECBIEEGAEEOACICCDGAGEEBC@CBENAB@HCDBLCBEACHUFAHBNCL@JI@AMEACOACCBE[BOAGBEACEDEG@CEEEOAA@BEOACC@ICCE@@GBCCCOBGH@BEDABIA@BE@CDGAIBG@ABCBIGIDABGBCECEE@ACGBCAAGIE@AJEFCAIJEBCACH@HCL@HERCDQFCL@BACASEFIDMDEJCFG@GDCGEGACIEG@KFG@AGAOBIAECBC@CCENMAAECAA@AJCJGPBJDFEBECCEABEDCLA@COEECFE@IHOFCHAFEDISBEAIAGCKJODIJA@K@CEI@MIEBIDAEKGACGCUQGEGAGDGCIBIEACA@E@QGQCIBMAGHABBDEDGGICGMIEG@GD@AFIDCHC@ASOBECAAEW@GIECMIbEACWSKKAKJIJAEGKICECCC@IDCBM@I@_CI@GGEAQBICDGEG@ICGMECGGAEGGE@CIDIACG@IAAG@EBEAGBKGCC@CHCFBBADANDLCLI@MMS@QQGC@IBICCGKEOIGIUSCGEAM@CAYCMBO@UIWCG@QHGFEAMBALABCBIBGCGBGAG@ABADAFEBIEE@ECIAIFEFKBEDE@GBEHI@KCIIICE@GCI@GCMAOGMBGCCDOFEDCDAFCBMCWJMIGAUDQ@OF@AHOBECGE@@EFCBEOGCEAKCGIGSJOOBGACAGBEHEAK@EQKIACC@EJG@CYGEEKGACKGACYICIKMG@GIG@EEEAHEBEACO@]KECCEKMGAIKDC@EEMACCIMIACHI@AKAMCEKACBILGBCTELG@GAGM@SCMBEAGCEECGKGESEIGMAKGMBKGKEQCE@MEIKKOQKGE@GFCHGJG@IDQ@UQC@C@AFQJSAKCGDQNEJKBALE@IG@CBEGIAIKAMIBEHGRCKQCEDCJGDQDGCG`@@INQJALFFABAAEAEEEECIMGCWGKIGIBQDEHAPCTFHA@CDGKAAAPKHABCHA@MBI@ECCI@KDCADIAG@KHEGEBYKA_D@GQGCIDCNEFEFENDJ@BCCUDIHGVEWGYOGGGCIEEEAEI@GEG@ACGAUHK@MEKMMGYAC@ECOA_BGGC@UB_UAEEBKEGAECCGEAK@cJOGMASEGDKLCFIDEPGBKCUBECQHCDE@@CEAACMIKEOCKHMFGHKBI@CFIBEDOFCBAFEBGDJR@JDBAH@DCLDJGFBFADEFABDJGDEJCRDF@VDP@LBDAJILECEAGIEEAECA@KIKECKCQKKDMH@HABMDMNOFIJEKAAEAABMBGJCHOHADCFCDCF@DGFCNCDOHJFFJDDD@FLADRNBDFBJADB@FEHCBAFEHLJFFCF@DOJAHGFWHGFBBEHK@KH@HEBAJGDBFAHMBEHEDEJSLCD@BE@IJCLKFMCBLAFDFAFCDAFONCDC@AHGBEDC@CBC@CBI@EDADBB@BBJJJFDBDPLBDAJHLB@BDAFEFGN]LI@CB@LE@EHGL@FBDG@CH@JVXELGDETRBFDBDADDDFBDDDBH@DAFALDHAJF@CDEFER@PAJ@BBDFJ@ADFJDBAJFBNFDFH@FFJDAJCBBHA@@FIDKVA@AAGBAFC@@A@AGBIAIF@DCBIEGDEAMDEDEH@DBDCDSHBDA@E@EDEBECG@A@@FGDAFA@OAIDE@@DKFKBGCO@CDBBGDIDK@EDI@GDGHDBADGFG@ICÅFIBADK@KBDCAACEE@CAEBECGGOGEKDGFCCADE@CDE@IAKJOCOFABBHBBFB@HL@JHBDCBEBMAGFEFC@I@GEO@MAABGAKGKFGA@ABCFADAAGIGEBC@AE@GICAEGADAAAE@KABA@AICBAD@CCM@MDQLK@CBKAS@KS@CDCAI@GKCKGOMGEKMKGI@GJ@LCJ@HCD@FE@IF@DG@ABAD@FLBAHBBJFFBBBARDLCHARFHBNAHGFEDBHE@ADBHCDCF@DFFBH@FDH@ZJTAFGNMJCHMNCB@H[^EJIFAFBNGJBHI^@FENAZCHJRJF@HDRPLAF@PAHCFHHHTHHLHHB@FBBTBHDBDRFV@N@@ARBDBAFDLAD@BF@FGD@D@B@DLDFBLGX@DNBB@@BILAJADE@ICEB@DHHADABMDADBJEHCBEJKD@BBBAFMBCBCDA@CGG@C@@H@BNJLFADCBS@AD@FAHEFWHMH@DBH@DIFAB@JGBGDADCNKHINIFO@GDEHDBEN@DFF@JFJCLGLFNGDIBID@DDJLJADEFaDGEACAAKEC@CBERKFI@WEI@SDE@QGCAOFIBKJEAGMEAGEECE@CBCF@RABKDGCIOGCEBIDE@KGG@CFAHAD@NCP]NMTQFaEMDADBNABGDSAK@MCKBCBIAEBG@GHADCDCBKAC@KHCJCDIBIHQDAD@DNHBBADCBSACBEBCHEBCJ_DEBILUFIDKBKD_AQWKCCB_REBICCCAGAAK@IDI@CAACUCSEK@GDC@CAGMGGY@KJCLGBEAICGK[IGCEEMGSMCIWECECKCCGCEAK@CDCJABC@C@KKMECIC@KDCBMGE@EFBNCDEDI@ECAC@EJI@CECQAAA@CVIBAACCAIAOBMHIBGCEE@GBCHCBCCCIAMDAAAA@CFC@CGCKBKGE@ABBJADCFIFGAEIAACAGBCBEJCBCAIGG@AD@FCDIGGMG@IBC@CA@CFIACECCAGBEFILKBGFCBKAEBC@GGCBGDEHC@CCAIAAEAGFWDCDA@HB@BABCBIBALCFcHUAGHKBG@GCSBACBEKCM@IFE@MEG@CBBDGL@D@BJBDD@BEBE@GCGAKFCAGEE@ABBDJFP@@BGFBDD@@CD@B@@FH@FDAFGDEBOGM@IBAFGBIJE@IES@gEGBGBEFGPCBUFCD@LCBE@GAI@IBCDAHADGBMACDALCBEBG@cAMBEDEFEL@RBHFF@BADIBIBcRAFFHCFIBQHAAIEG@CDC@@CDECGC@EDGFAD@HFJADGDBJABKBEDIL@FGFIBGAEBEFBBHDDDFJAB[DIDUT@BBFBBL@@B@FMH@DJNDNLLFHH\\@J@X@DQFGR@JHH@FABIBIF@HJDN@DBDF@HCBG@ECE@CDBL@DLDLFCNEFE@KAKDAB@DBBFD@DNFBDABGJONCBXDTBZAXBTDJFZT"],encodeOffsets:[[128780,46625]]},properties:{cp:[126.642464,45.756967],name:"哈尔滨市",childNum:1}},{id:"230200",type:"Feature",geometry:{type:"Polygon",coordinates:["@@C@EAC@ADEBCDE@CAHCAAFA@EF@DC@E\\KJERCDABEPCDA@AFGFAFBACDMAE@OH_BQDCNCNALEDDPBDDNFDBF@HD@BIJAFBBBCNMJCLSLEZABI@GÄLBETBJDHFRQRSRBDCTE@\\¸BĖÆHAP@LIhCJ@DDBPHF@^@DHBAFFRP@HB\\NJNJJVZNBBBBBH@\\NtHTfRRRE`YrBdlHVBDDCDJLJV@FBBPHBFHHBJDDRC\\BAKFKJEFAFKJONKVMlQZOFERUJCfO`IBCLEDGDCAAEE@QSCGEAKBEQEK@EEEDE@KDKBçfOAaDGKAEEKGAMFO@AC@CFMACOAMCCACGMGI@MDK@EAMBEAAFEBIEAEC@ABMYSFQJmDLRCH]AQ¡BERDFFDDB@FABCJAJCDELBJ@BDFBJAJCLBTCFBBDADFBFA@AEC@ABARCHBD@B¡BE@KHCFOGHMDGAECCC@CBEHEPKJ]DS@CCATFMDOPSJQHCDQHKNAVGh@vQLIdDf@CmVG^GRIHBFB^ALIPAXOR@DEFADIJEHB¼HJNKJ@VGPABA@CJCH@FALBBBFBDFD@J@FBrDFCJBDCHBBELCBCBAH@FAJBFABBDAJCJEBCJG@EDE@ADCAGJABAPEDEHAACCCBACCJ@BCC@@CDCAAAAHABCACBCACBCAEDABE@A@@CEC@@AOCB@AABA@CCA@CB@DGACD@BCB@AACBAACABAEBCAFCBAD@@EF@@DD@H@BEDA@AHBAB@BBAF@ACF@CABADBDAHBBBCB@BHALH@BCAABEBDDAB@@D@F@DA@BCBFBDADDBDDC@DFA@DFBJDB@ACJBF@DDH@FFHGD@@CDAJB@@@D@BHDACL@FAEEBAH@DAADD@JDDADBJA@ADAF@ACHAFHBLBBFBDAHBHAFDADBBJC@BC@@BL@DABDFBFAACFABBFDFA@DHBCFAB@BF@AADATFBDJFFAJBDD@BL@HAH@DEHBJCBCBABB@DPBBAC@@ADAHAHDDDB@BCJDJIFADEDGTKBMAA@EJK@KNKLEBCBGAEDGFAFDDBLAHK@APCBCDAVENOJ@DA@ACCBEAAMGmGiEDODADM[CFOU@FOEALS_IVU`BFUAER@BGKCL{@E@QDGEU@OCG@IEETQSMACaMGGEGOICIMGAC@KEIDKAAIBAAHQEAAAG@CA@EHOLOBEHECC@EJA@EBGFEPCBCBECG@GGEAGCAKEBC@EKABEFA@CGBEAUQ@SIEBAAGFO@KHOAEBQDQAMCABCM@KBMCIB]EQBJ@AKAHkDCDA@DQICHi^]FAVBD@BCBAIEDA@ADAA@A@EB@AECE@BCAAACAAADAABAFAACCBBECAFE@AEB@EGA@@DCAEECBAFBBGDCAAAB@CCAACD@@ACCA@BABCAGDAEIC@FGEAAGC@ABDMG@CCBAC@BEAACBECCBAADCAEBCCCBCDAB@@CBAH@AEFB@CHCGEBCCAEBACCBECADA@AAGBAABCCAAA@EEB@BA@IGBA@CCEB@@AHC@DB@DEAEEC@CEAACEA@AHA@EA@EDECC@BCACEDA@@E@CE@BEI@@AHACE@GGEBGAAEBCC@ADEA@EBICFA@CC@ABEA@ABCAAC@OA@CBCAAA@AFABIE@KEAEEBCD@BEE@HAAABAAA£GGUAIčOaEuASCEŗWG_CđUġSAB@FE{GíOKCÉMÙBJP[Qũ@@X÷@IXGV@B[SnYQAABAkQQCMEWSS¯Q[s«ssSUMQ@wUCG§k_FdDVÇDABhEAABCBCC@AE@AAE@CCD@BCAABAEEC@CB@DC@ADABE@@CCBFCACEBCBDDABAAACAABFA@GGD@AECDAGE@HCI@AHEAEBAAACDC@AC@HC@DBCA@K@C@DDA@CA@CF@BCIBLC@@AA@ABBBBF@GEGBADC@BCAADADBDEHBEEHD@AF@CAF@CCD@AABAEBGAACEA@AFC@ADBHCEA@BA@CA@AC@JCAEODDFCBAA@AGIB@B@ECADKABCJEGEB@CAF@@ADACAAAFBB@ECC@GBDBC@ACDEH@CAIBCB@ADCACEBC@DCD@BCD@ABBBFCAA@CC@@BA@BB@@C@AABEJBAAG@FEACC@@AD@@BD@BCDBDCCCAFACABCCCBCE@ADCA@A@ADE@BDKCEFACB@HCH@DCAAIAKDCGVBtIuEIMMICMCOEUAHII@µKoafQOYQk«@gE\\}MmUOGIIKCC_GuIgBGCCGAKGCEBECA@C@EHIJ@ZEFCBEFEFGAIGEBELAPCTGTITGCAAEMANM@IFGCC@EBCDCHCN@JDF@PG@E[@KCACAGHIAAGCCC@KDIFGK@cKUSGCGAEUeJkImyVE¤MILB[EHGLQPSDE@ECCGG]MMKCC@EDUJSFGBGAGQMCGAINKJERCTONW@MJCTEDAFK@QBCXQDCBMCOIKWWEMOO@AJODCPAR@DAFE@KCICESMCC@CFKJE\\ANGBCEKMQIGAEDCBCLC^ENEJIDMPKA@A@CEGKAE@AFENCBOACIGQCEAQQAG@GBGHGLGFEFOAIEIEQO]BKHGeWCDCABGECGAEC@@QBI@AOG@ACEAG@GCAGOEoGKD@BKBOGWIgFMCCCM@KAI@GJM@OCI@sEUCSBIABCCA@AIC@CCE@AB@BE@AE@BCQCO@KCIE@GEFGHAF@HMAEAO@CDCBC@MHGHCHC@KBGHIBSHIEOEEGK@FEE@BCCA@CIAADIEC@GEBACAKCEHC@EFA@ABECGFIDE@CEK@EBAAKFCBCIE@IFCACFC@AA@CG@ACFEIA@CHAAA@CECEBCGOCcJHFCBE@DDIJCH@DCBKCEDGACHG@EFC@GFCADAAAQAGCI@QHMCKHA@BB@BEBBJ@BA@CCOFGAAHSC@DCBEJyEQOsWOCªíĀIVBBDDBLCLFB@B@¾C\\KrBF@BAPJDHC^KnFD|FFaL]`DGTJ^FtFrmDBFEFBDCDB@BJDHCDCHDRHH@@JCJ\\HpTALC`DLEDAFE@ABFH@HHFhDjAXJlHHFVDBDĚPBBLCD@ADB@ĶDDB^RHHHEZCDBDEdBBL@Il@FDBEb@BD@FD@DAF@KDIFGFADAHDDNDVBZHJDPP@DCDaNIFEH@JDFLPDJ@HEFEB_AMFEE@KAYMMAEBGJOBMIYIGECI@eDa@QCOBMHWRINBTDJHJLLDHBRDJHJFFHXTXDFBTFLBLAFDLCLEAEGG@AABCKBBFCBCEGB@ADAAAM@ABBDG@@BABEABC@@K@@BDD@FEB@ABAAAA@CDA@@EFACCGAEH@A@C@CQAEEOCCGMCIC]GI@{NCPELULURSLGHE@QPIDSFGAIBaPBEGILw´N^WVMFKDQ\\EDyOgq¥GBAAE@KCI@AACACCEBEAGIA@IF@DDBIFDFBBCBECCB@BHDBDEBACGBG@ABD@@DH@EJADGCEDBBDB@DC@ACAAABBBC@AFEC@@AJGCIHG@MEBCGEC@@FA@G@MDA@AEC@IFC@BEEBA@KIA@BDABEAODIA@DBDABA@@AGC@AEACCBC@AA@CBCCEBCA@CFBDACCAGBACCBCC@DAEADCC@FEAEHC@CAADC@AKIGBEA@AJG@AGDGGEC@AD@BAGEEDAAAC@AGE@CGEIKCIDKCCEA@CBGECEACEBCDAACDEAAEA@CEKOGEBGAIBOFEAEEEBCEKAYBEAOCKDG@@FCDMCAFEAEFA@IEC@EBCABAAABA@CC@GDEGOAGGIAE@OAACDCBAIGC@EDO@IJADIDANEJONBBFDCJCB@DFDGDEHK@YBCD@DBBADMBCFQHMBCBAFCBC@GGMA@JBDAHCHGBCJWFDTAD@DGBIN@LAB@DDDBFADDHAFEFFDAN_fGXFBVBAHNh@JIF@BBHHR@DCJBLEJC@DBADOECBCAI@HGCCKLCGUKABAASOSESDWCKBUACFA@ECAD@DG@BPJJBFYHDBGD@DQP@DBF@TNBCFETd\\JF@DD@@BTPhTPJND@NPHDDGZKEI@SCOBACA@OF@B@BI@ADEFICKHEAEBCCYFWCIFI@IAABBDI@GBB@BHCDEBGAGBIFE@ADACC@A@@AAAE@@DD@@BA@ABACC@@BD@CDC@@BA@CCDFEAADC@A@AA@AKB@AD@@AEBAA@AGBMAEHEAKFCFA@AFGBA@BAEAKF@BB@A@DBADACA@BFCACBBCC@CDCADA@AGD@FC@IDBBBDCFA@BAA@G@@DB@CBCADACAABEABBCBBDAAC@BB@BGCC@@BDDEB@BC@BBABBBADFFC@ADA@EDDBE@EA@BABCAABBBD@ABD@@BA@@BADCAAA@BBBABDDEBB@CDBBGABAEAADIB@DG@BB@BG@A@@DABB@BBGB@BCBGA@DBBABC@@CC@ABBDCB@BEBECABBD@BABEACHCBBB@@GDDDKDADA@@BDBABCBE@@BCBD@ABEFCAJJEBCA@DDBABBBCBEBAFA@BBB@DDEBC@@BB@@BCBA@DDDA@BCDGAAB@BBB@BC@ABDHC@CAEFB@@BG@ADF@@DCACD@DD@@DEB@DEFE@@BBDGB@FC@@BA@G@IHMDEF@FIDGH@DC@GFAJBFIJFB@B@AADABB@@BB@BBC@BBA@@BEA@BB@ABBBCBBB@BI@CA@DCA@BB@ADECBBC@AHAA@BA@BDC@@DA@ACEBD@ADCBB@DBGBF@ABBB@BE@BBA@@DA@B@@DE@@DAAABDBABCA@BDBC@C@BAG@BBA@E@BB@BCJ@Zvdr\\bJHLPFP@HJnFH@BNTPVB^DLFL^dXbJLfX^lRXÚJJTJJL`TxjPJ^TRHTD¸db^RLvX\\FnRvN¸dRHPLjjJHTRrz`lNZDNLVPVRdDPRT^NH@FFDBNAnHZH^L ^RTHÌhp¤\\fZVV\\HFz`XNh\\PNVPF@XRp^VF|TRrh xfxlpRbrÂd^J@HARKLIdGFEBCBGRQAEAACIKG@CDEJ@FADGACCCQCCABOBCCIBEDANADA@C@CBE@E@AZKDADEAEEEAEDKAAA@GDIBGAGMBCJEBGKEIAACDCHENBF@JM@AAEIAL@NEAACCCDC@IECC@CAAEBA@@CFACCJKJCBC@CMEAEBCLEBC@ETMPBDABCAGICCCDGLEF@H@FA@CEGACBGDEPE@EKCCKFAD@FHFDBABCEGDCJAJGJCLEH@DCJAFGHAACGCACHCAGDEDAJBDA@CEEBCF@FHDBFABKEE@ABAHBDABAACDEGAAABAHC@AACC@Q@EC@CBAJE@EGACCFEAEJC@CAAMAAABCEA@ADCFABBBFF@BAC@ACHEAACCBCBALBFGJBJEF@FHHBHACGDCDANFBBCB@DFBHCB@EI@@FAHDHCDG@EACGEMAGGFSRI@GCGECWCUEW@GCAC@ERK@CCEEAQCCAAC@GCKGKL@REF@DBFFCJDDXFXCHBFDBPNFFF@BEL@BFFHDJ@REHADBFF@LDF^TNDBB@DCBAFFDAHEDI@SFCD@BHFRBHDBDADKLBHPJdRBD@JTNAFCDABDHBBVHBDBDAFIH@HHHPFFDDB@F@HGHOHS@GF@DDHRD@FEJ@DBDFDPDFBDDBDAJYNGTGFWJGHCFAHBDHHDF@LALIJGFUFGBEFAF@HDDHFDH@FGJAFBNFR@JALCDMJMPAF@D@DHBVFhTVFDFAFSLCDEPALCFAPCR@FFLRLHJAHGHKFWFGDGJ@DDDJFRHHDJLJNFDLAPGFBBBBFABGJ@DDHXJDDBFAFCDDJDNVTRJBFBNAV@JDFNJFFDVDHDL@PHHGVAXCFCTCHAPBHpbRHRNBD@FEHGLCJET@NDJP^rPD@NEF@HBFDBDCLAHJJLHHHHVDH@HADOHIDED@DBBXFFD@F@HCFGFAB@DDDDBALHb@vLLD^@fEDC@MJEPALEDCF@HGHCFEVCHCH@PETANDHCN@FCHFHBJ@HBNGR@H@DCT@fMlLT{MUCAACAAFmSA@CK@"],encodeOffsets:[[128e3,49994]]},properties:{cp:[123.95792,47.342081],name:"齐齐哈尔市",childNum:1}},{id:"230300",type:"Feature",geometry:{type:"Polygon",coordinates:["@@@BD@B@BA@BDABBABDBHA@AD@BBDBFA@AHCFGD@BAFB@APDJBHCBEDAAA@@FA@BBCD@@AA@HADBCDB@DFBCH@AABAFBJAADBBJABFFBBMLYAS[]IKCEB_DSDAPCP@`FL@XGHCrgPaTMBC@GCKMOmUeIY@UEEEI_@GBCRIhKHB^LF@HC@C_mCIBMDETYBE@KCMOUIE[CoBKA@GBAZKHE@CACECQCGGEECSEGKGGAI@IFCDC^EFOJKBGAKGIMGkCGIKMIeSµSKEMGCEGSBIDKHIVeHGbUhgBI@KKaQUicYMW]IWAMB_FICEKI@CAMGEIB]NE@IQOCEAGDGJEPOBOACAEECMCSMEIFQCGEEIAG@MDWRILAHOLKDOBIAWIQIEWBQ@ACAUHG@CCIS@CPYCEIC[BE@EEAELU@GGSBQDEFED@h@JCFEBEAKAEMCC@WPIAOGDK@E@CIM@GBAdMBCBIACCAGAOBeMSWEQIKIGCE@CBCN@DBLTN@FCHMFENATBLCAWDCJEBEAQEGEAKAYBKAICGEEKDIDCVIPGJIBCAIKCKBGDKLQHUACACGAQACE@KHEBGA@CJG@A@EIK@G@ETWAKACECMCCEABBHK@K@BFKHIAAGAACB@CEAC@AFEBEBAADAAEDC@AKAGBGGCACB@DCBI@@CJC@AI@AADEG@KKE@BDEDCHC@A@@EGC@ECEE@AFA@AA@GAACBBFA@MEAADGACE@BFM@A@DEI@KD@ADGACAAEBEHMC@AFECE@GA@CBAHCBC@CCGACEG@MG@CBEAAMADJABCACGAACBBFKDGCACDIAAQBEBC@GEGHOA@CDENBBA@CCAGBAGICAEM@AAAC@CB@HBD@AACAOCAAFC@EHG@AICKBDK@CKDMGAI@AN@DA@AC@QA@ABAL@B@AEI@AABCFE@ACCE@CFEBCG@ERABCOKEBIAM@@ABARIACGACBMFEE@ADC@IGGJC@EDEACI@CC@CFAFFD@BIDCGCAA@CBEBKMAAAFCJABA@AEG@ICCIA@BF@@DCFIFCA@CBQC@M@@CHC@AKCECAA@EBAD@DACGJBD@@IBEUK@ADCAAK@@ABEOCBAJABAA@ICAEBCD@FDB@LA@CCEQ@GCBCLD@AGCHA@@EEACAAOECADANBDEAAEBE@EIAEDAHBH@FCUIAC@ABCDB@DBDB@BEHDBEDGAAQBG@DE@AIE@G@AF@@KDBFFHBDB@AGEFG@AKBG@CABABEFALBHA@AKADCN@AAIADGD@DFD@@C@MD@@F@@FA@AAGFBFCEEHBDAAAGACCB@DBD@BEFCCE@CDCGKB@D@DFF@BACG@CLAACECHWA@G@C@EEBAJDBAICCGFECID@FFF@DEACMGAAP@DCGAEC@A@CIKAE@CD@DJFFJ@D@@CAAIABGHACGBGKCE@@BDHABA@EGC@EAEDEAAGOBEKFCACKAACFI@CC@CDIA@AFCMBCAACFCBAICC@@FGBA@@CCAQACABEGEAALABASA@AFA@AG@CCGBE@EC@AJ@@AEEEEGBEAACBAHBDA@GICCC@AJA@AIAEEEDEAACK@CEUCAADEGCCJCIEAED@FEDEA@ADC@AK@@ABCACMA@A@ABAND@@CIEAKDEA@AFG@ACCA@AHAGC@AD@FABGCI@KE@CJCCEG@CFIAEF@DD@FEFB@DCDQ@@BHB@DABIAABMAE@ABBF@BMEKA@BDF@BC@ECC@@DFB@DG@ECCFMA@@@DOB@BBFUD@DJ@BBKBADG@ADGFAFOAćjǝƗȁ®ƇEBO@QJBJILOFKHQBMDQAK@WJEHBHGJ@PEJ@LEL@LCF@DGPYFGE[FMCICCCFCEAYOMKQAMGEEK@HMDCCCAADI@CEAMAAECCCAEIEACEAKKIECKMEKIKAEEKBOFKJICCDIS@SBEDWAMNaNOHEDGDMECGGCBCIE@CCEG@Y@GACGEEAEEGAOKIAIDGKGGA@MGIBCGCFEBCJATGLAXDBC@GAEBE@CFEFADACE@EGGIMKGUOCCACMKMCQGOCCI@EAGGAKIMAEEMGACG@]KSQODCACE@MOABIG@IIGBAKCAOCKIC@KFEGIACEYIEEUG@GDC@GF@BEDBJAFCBCAIEGGCI@KCM@KEK@CHIDEBGAIBIBMCGTKDQBADGDgAOGEEMDKJIDCDEDEA@EAGEAEBECIIAEKEKAIEQ@AOBGAIAEGEGIICIQOCWMSPCFQ@EEAGECOCW@ECGAI@AFEA@ACEEAADAFBHGFDFCDBFMFKA@DEHCBGKIBCAE@ADE@EJE@CJIAEBC@GEG@ICC@IQGCGBAFEBC@C@IAEDIEAEGEC@IGC@BECEBAJEFMAEFCBEDCAECCAEMBOAISGAO@KMEDCAEEM@GBINQBAE@IGCKCM@KEC@CDIDICI@QUCAGBCACAKTIFEBEDEVSBWLCFDFAHINBFCDBPCJHV@DOHEHKFUR]PQLED@HGJAHTDLF@@OBKFE@MCEAIDICQ@OJGBCDAHGBGBS@KBECSCEC[MEUFG@AWEIEMAU@MCMAEBEBIHGPFD@BABCBENGHGBGAEAGEMEGJIDMHEBANABEBADEFHH@BQR@DCDBBADGHEBKHFFEHALWBCDEPeLABDJCFCBOBKDKHIPBDUFQGCEKBGGEAAEG@AAG@CGIACCKACCOCCG@IIEE@CEBECACAG@KACB@NGHYCED@FABYFADBFCBADCBCFBBCDBBCHCBIGE@KDAFDLAFABGBAD@BFFCJDFQ@CD@BEF@BDFAJHB`@PDBBED@BBBJF@BAFGFF@LHTHLFLLPBHBAJCBBDAJKH]FADKFAFAJCHBFJFDNCB@BNHHLBHLHBHLADBDFDHRBDF@DHNDBH@@LEHBHJBKDGJADED@FBFCDADEFDD@HBBBFHF@HDB@HHJDDANJDJAHCFJFAHHADDD@HHBBDHBFJNCB@DDJBBDDBFL@FADFRJDJPHDP@JFFFJCLDDLDDNBRFFADFBFLJLBBDFJ@DXHNHH@@JBBDDJBHHBBAFJHGRKJDH@DBBH@JDF@LDNAAFDHFH@HNADD@DFFPF@DDBBFBAJ@PHD@@CH@XGFL@FJBBDJBALBJEPAFCF@FEH@BDDIHBDDLBDFBBDDNNDHZFFAVBDFFDHDDB@HCD@FFDBJADCD@JDBFNHH@BBLCXBTARMT@CHFJHFBFFABGLKXGFIBGHANDJFDFPJnFDJ@HHJBNHDERIJHLZFN@JFBDD@R@XHJAHDTBBFNFDAJE@QFCJA@SLQDCVCLBVAVED@HDFCV@BKFIDBVFP@DDbLNAHDDCDGJEDSPCRKFFARJDDDJDNGJ@HGHAFIBIJAHD`DP@F@BDR@DBdFLEBAEGAEBIEC@CJAJE@GDATCFDJPHDAJBDFFPJJJHFDFAFBDBLLHL@NPAHBDNHLBDFADKBEBSBMDE@EHCD@DJLHBDDBBADBDFDFBDFNDRJLBPFHHJFFAHCPAFABAPHJBJDBBHCFBDAHDLABB@BJDDAFBNGFBDAJB@FBFGFALBDEHDDCN@FHDIFAJELDFELQJEHAF@HCJ@PADNFF@DA@DNHAL@BNDJ@FDVJ@HLD@FCDNJBBADBBDHIFADFDN@JH@DABFDBJBBDBJBFCHDLCNATBHDADJHFBANDRDF@HDFLFPALAL@DBPTLGBGDCLGbAJAFBJ@\\FHHHBLCBMJG`HDDFBZFFECCBCEGAABCFCCGXIHFPBJLJANCFDHDD@FCDFHBL@FEHD@CBEEKAKHIEEAEHKJEBCCGHIACCEBGCCBCEGME@GBEHC@GH@LEAIFU@EECQE@EAKDCLBHABDBFBBFED@H@FBAHBHFDLDFH@HJEJABEPSH@J@HAFBFHFBDB@NANHV@PZRLBDLDD@FBHCBGBAB@DDDBDDHDBHAJBJFDHFFP@LHFMZY@C@CAADAAIEIJAFEJAHEFENEFIFCAEBEAEBALDT@PCRANDL@XEBDPBLHHDHDD@BEB@TJJ@HFTBBNFBRDT@HANAdBJHTJT@FBBFLFNANE^UHFX@FFAB@JFFHD@BBHCLBFLBFAN@HCRGHBJ@JBBBCD@HDFFD@FHFJBBFAVDFFB@BAPJ@AHHH@DABAHGFIBCJGB@FCBGB@DFHBDLNPDHJGBAPIFGNKF@BBBN@LHRFDD@PRBLAFDLTCDGJ@D@FBBN@LEJBJDR@LDHC`CLEdDFAPKREHGDENCDGFAHBFAFIDAHEFABCHGN@LDHAFBH@FFFAJBNHJBBFHBDFDBJFHHFDBDHF@D@DBDBDJBDDH@BDHFAHBFLLF@VHBHDDBJADDF@DBFD@FDDBfCPCLBLBDHCFAJH@JL@PCDBFRLAJCFHHXARBVNF@DCDDJ@BHDBMLAJFHBJDDJ@DBBDBPBBXHZDPDPJTPPARCBCBKHIDAT@NEBCHCLGPQIKFC@ADC@EFCB@PFB@DEH@LEHAAF@FJDDHRBJCBEFCH@JDFABGLCFGDAB@DFVPJAHDHGHBJHDBCH@PCH@BL@N@DBBHHB\\FLFF@LCPFIH@JCFVHNPJFBHFFALGFFHAFBHBH@FDDDFGL@HRZCDDFBBDBADBFPPDFFBDDJBDDHF@DFHBDJHNNHDGNCDADBB@DCBDF@H@DC@FBDDBDFDEJMAG@ECcJFDJLJ@FBFHCFL@RJDJJFJ@FAXABFFNHJHBLAFFFBDFHD@FBFCBCDADGFGJEBAD@FHB@DBBABBDLF@HLBNABHADLHDBDJ@HGFDHORHAVHDBDHHBNLJCJELABEHEFIF@HGNBHATFHCNFHDJ@JBNALFBJFB@HFDFBFFFD@FIHBDABGFCDCBFD@FBHCBDBBJXDAHBBAFABBHJFCJDBBJDB@DGDAFEDEDDLCDDFJHBDF@HEPHHFNAFChN@B@HDFNB@HBBLB^L@FFFP@ROJCLCJBFAJMBGFCHI@CGKGEEGFKP@NCFCRADCJAHBDAHBRDJ@LGBCBCBIRED@LNXLNHHDHAJDN@F@F@HBBDNUFOC]HI@CDABGDAP@LLNLdRJ@TAXDNFJBLCBBXCD@LCJ@DAD@BBP@DABB@CF@BA\\F@BDBJB@BBA@BD@FDH@PJDABAD@FABE@ADCD@HDBA@CFCD@@@@ABBBABB@ADBDCBBDA@@B@DAAAB@@ABAFDH@@BDABBDAAAFA@AD@ACDAAABALCBCBBF@BCDBBABADB@@BBABLDG@DJB@B@BDFB@BPABAE@FAFDF@BADDA@@@FBDA@BB@BBJ@DAB@@BHA"],encodeOffsets:[[136991,47651]]},properties:{cp:[130.975966,45.300046],name:"鸡西市",childNum:1}},{id:"230400",type:"Feature",geometry:{type:"Polygon",coordinates:["@@_VUZgFUAGGISMS]GIQG[COESQQaESAMDgAK±yIQCQ@KDKJKZ[DcDOHKLGRG|MFAHGVa^[xWØh_·HWEH¥HM\\Y^OU¦I@¨PbJp\\NLÀGpGF\\JvPfBMCTERKNIH[FqDInkCfJnb^`XVLFdDzI´kxOTAV@°TNARG~OpCNDHFRRTvBBrFVCpOxCpB BZDxNVBbAfG|PV\\LTjfJl@NCgªg°S]iCIMAGQCGEACAGDKHOLMJQFK@GGKMQCCgMGEACFWAGKKEGAE@GAIS]DOEICCGAcAQESIOMIMGYMKOIYKMCGCCGCCMAYMAA@GBE@GEIKEY@MCEAIGYWYKWQKIGICA_AgFQAyIQGGMCAyM[MsMMEEECCEIAI@cBKBAHETGBC@GCECASACAAKIY@]CCUUEMGCQAIEE@QF]AQ@C@GGKAgGOAQDIFMTUVUPIDQ@_IK}BIDIAMEMMSEOI@EECKIQEM@yNWBSEOALOFMHKBQHE@C@BEFIEAMFUBSJOBICSSMKMEMEOAQ@GGGAgA_KCCWWAEIKOM]IUEiAKDGBIHIBKBYCIBEBMLYJMBQ@MDGJOFMJaP@SCECSCOCEEI[CEQEQAK@KFOLaR]Ji@HQFUDIAICMVCDGD@BD@BB@@CABBC@@DDBBABBDA@@B@BADB@DC@B@ADLA@DD@@BD@DD@BDBF@HBJBAFE@@B@BD@AB@BC@DDE@ABJF@BA@BBD@@DBDC@@AAAAFABC@ABKDBBHB@BA@CAEDGAABBBADB@DB@@ABBBFACADAFB@BE@FBCFF@ADLDEBDBABAAADA@CACBD@@BB@ADF@EDAAABB@@DDA@DABBBCBA@@BCBD@A@@DD@@FFB@ADBBBB@ADFAADD@BBABEC·SÕW¹KoPI@[NVs^eFcBiH]CCAOCC@CEBAAHCACDIHCLABAACPGBCCCBABEAABCJARG@EDCAABAA@@CH@HAFBAC@ADAAAHB@EDABAA@D@CAFBACBBBBDABBFC@AHB@ABAAAG@@AD@@AB@B@CACB@ADAEADACAFB@CDBF@@CF@EAF@BA@@@ACADCBBD@ACFADB@AHA@ABDBBDCAABA@ADBBAEEB@D@CABCC@DACADCC@AAD@@A@@D@ACF@C@DA@@DBBAB@@ABA@CB@BB@@@CB@@DBABB@EA@B@D@@@CAF@CAB@B@HFBAAAF@DABBBACCFBACBCBDDAAABED@@CHB@CBAAA@AFABA@AFBAEFAICCAA@BBEA@DE@BAAA@AGBBACABAAAEBACADC@DDABAAC@BAAA@AAAABCCABACABCAABC@@BCA@DCAA@C@@AF@@AC@@CBBB@CABAAADAA@DAC@B@@AFA@DB@BEH@@ACA@AIAACB@@C@@A@C@DEA@EAD@@AE@CDAAB@BEC@B@AAC@@A@AABE@BAC@BAA@BCA@@CEB@ACA@@DACAFAAAA@AAHBCADA@AEBBCEBAABAAA@DA@AC@BE@DBCBACCBCA@DA@@@C@CCBD@B@AE@BDGACABBEBOIHBCAFAC@@AD@DA@AABA@BCA@ADA@BAA@@AAACBABA@@AAABBGBDCE@DAGAFCCACBBAACMAAD@CCB@ACBEAK@@A@AFACCEB@ACBAA@BAAC@@EIGA@ABI@EAC@@AE@BAC@AEC@A@AA@BA@@BC@ACCBCB@BABE@@BEACDA@GBC@KDI@ICGBGAG@C@IDI@ABO@GHEBEAMGKAoAMAKBEDE@CAACHID@HDLGJI@E@KAAC@JBGKMEUBEBABILGHEDSSBMGQAIBmHkBACCAEHIACFMBEFM@CBCDE@CBC@EAEBAAEAA@AFC@ABC@@BAB@BIDCAA@AAC@@BAA@BA@CDDDC@@@A@AB@DGDAACBCA@AA@AACB@B@AC@AFC@A@BBC@A@BAKCKHG@@FE@CDB@@DC@A@A@@BE@@BAB@BCBA@ADCBDDADA@EDAAC@BB@BE@@DABC@ABBFGBA@A@CB@AEBABBFA@AA@DAACDE@OJBBADBDBA@ADAAB@B@BBDCA@BA@@DA@DBADDDABB@DBCBA@@DA@BB@@B@BDGB@@ADBBDAADB@ABFD@HD@@DCA@BCABBCBB@CDB@ABB@G@BDE@BBF@ABB@ABA@BBABB@BD@BEBB@AB@BA@ABD@ADGBBDCHCBABBBADB@@BYLiFZ[BEACAEBEAEBIACDK@CBEJCDICOD@DBBIFBHEJCHGDGAEHC@CFQ@KDGGICO@QFEVCJ@PBP@FSJEDaBGHI@{VWDEFDRBBHDVBzAH@FRIP@HPJPDBDADIBKAM@GHGN_VqRSTADDFGHD\\BDFBNDRARFhTZCHCBBADEHADDDGFALIDGFCJAFDJFDTAFBBB@DADCBmLGFAHLfDTAHCFCDIFAD@BDD`RJLFFNDPDFALGTEFFHNDBLACBOBGBGFEBO@MCG@EDMlDbXHLHFHDL@JCLBFLFLP@DKPa\\OHADBDDDBFEVBDLLLPBF@DGNf¿`EDIN@LCHKDqDAB@FL`@FCPEHMAG@OHGTBnGBKJGDZH@LBBAJKBiMK@KDOPIP@JBFXRFF@FE^GFOB[@EFCPBFHFBFAFQHAFIb@FFDXBRCLAN@XHF@VKH@|^\\TAPUFEDGJYNWFA@RPBHATI\\FNATCFJPFB@B@DUHEHAF@HJRFFjFZNVRJPRJBD@DIBELBDdXAHIJAH@FJVDLAXEJKLYNIHADDHhVTDP@LC\\FJFLJX^BHIXBNFHPHFFJBLCDKDAD@PDJATWVIRKVQFGDAN@LGPOLKNIAUBAJK@G@EJCDETE@EPCDEZEBELCHKJ@@AF@JAAABCJE@CB@FACCF@BCD@@AA@@CDAFBBAFABAAABAA@BAF@DAD@@AD@FGDABCDBB@@AA@BA@ADG@CB@BCB@AABADBBACABADB@EB@AAD@@ACABADCTDFCFBB@@BF@FA@CDBDADBDFJ@@@@DdTB@BCD@DHDBAD@@NBDH@DXJD@VID@HFHCHAJBPABDB@TCR@NELABBAPBDDBVHBBBBABCBUBKFCD@HBDLHIL@DBBNLFRDh@DMBOFABDJLEFBTJVTBDADYRADBBJBDCHDXFDBBHDDFJC\\@VGJ@RFBPFLNBFDDBZKFB@D@ZBDFDH@VQREFBJTD@HED@DDFJFBFAHKVAD@VgFA\\CBGNFFAJMDAnDLHRDR@DH`LJA\\IPA^LdAHHJFJDH@F@FALMD@XNBDCH@DV@ZND@PGJ@BBHXDB^APBBAHCL@BFCLDFbPHF"],encodeOffsets:[[133924,49518]]},properties:{cp:[130.277487,47.332085],name:"鹤岗市",childNum:1}},{id:"230500",type:"Feature",geometry:{type:"Polygon",coordinates:["@@ADH@DFGFEFAHGFDFADKBG@KFIHE@KJQFMKC@EFC@KCKIIDEAKACBCJIFMBICEBBHEJI@EBCFEDAJGBS@IGEAMDGAADEBCFEAC@MFKAEEGEAAEBCHEBEBEFCBM@EBADBDCH@JEDNH@L]JePMDCHGFHRJN@HDFBN@F@HMPMHKBKHG@EDOBKDG@GFC@CH@F@DCBGBADAFBDHB@FDFEDBFGBEJDH@BKHBDEH@DIHEPGJAHKHIBKFG@CDIDADEDUEG@QFQDEA@CAAYDQEIEUEECGDI@ODIFCFCBKDMH@HCFQ@EDCBC@CAG@IA@GACCCKBIJGCCGOEKBELG@@BCD@DBFFF@BCDSJABDLAFDFAFFHCLHBJRNF@FLJ@DAHBFCFMBOFEHEFAJDHJBFDCHDF@HEHSHIJ@JGVBGbEPADGR@BBDJDLL@FGVFfAR`JRKTBDET@@GBCXH\\FZHAFJDFDBDFBBJDFdRRLBILQRB`BLDCVCDAR`BCVxD@H]J\\DCVaCIZGdØBPN`HDBFEHAHLTDrRHLHFEHMHBNSXNZZJB\\WDQFBN_ĆT@B~AǊ¼FHB¬AàR^EAWOUIWCKEYDEC@CKIķǚIEkLBldTNB\\tAAaBAAC\\@AFÂ@C`K@ADG@]@D°Ô`HB^XňFBY¶KBAòcBBBtUi[TYACGA@CDC@CMC@CEEGCIGKAAC@CE@IIG@ABIBAAC@KCBCACMGBAHCBC@GGEDEAEDAAABAF@FCBA@CDADCH@DC@EACFCAGDCACBCCCFABEBC@CCGEBGA@AEBEABCHABCBEBDJCAD@@DAD@DDBAAADABB@CFBB@FAA@D@@CD@@EFDHA@FC@@BDADBDADD@@DAFDDABBABT@DGD@DCF@@DH@BAD@@CNCLDJBJG@ABCHCBBFABAFCDCHGTGCEAA@@@CCBBEF@BAJAP@AADA@AJC@CDATFDA@ABAFDN@DBDCFBJC@ALBHCFA@FCBJB@HCDEBCHBDCBBBCFCTEDG@BDAHFHCFBB@DFFRFD@DHDBF@FDBA@ADAFHP@BBJBD@ABB@HE@CBAFBL@BAF@DCHBBBB@@DF@PCFBDBLED@FAF@DFF@DAAABA@CFCF@DIDAAAD@@AEAAADA@CC@BA@CC@JIFCD@HENBLCBCD@ZDFCHB@AD@@GDCD@DDHBBDDBBD@BDBN@BBDABFDDB@DCDBDAHFJADHFFFBHABDJDR@NDFCBADCNADABAFAF@XHBBDBDFJ@BDPEFD@BJBDBTBPCBDL@HDD@FC@CNABCDAACDMFCJDBCBAHDACKA@CH@JHBA@AIGP@@CGALC@CB@DHB@HCFFBBBCAGA@K@BCHAJD@ACCHC\\FBDBBJ@BAAACCWCEAFCR@ZPB@BCCCCAKAHCF@RFF@@CEEQ@DCJ@J@B@ADB@BABCECFCAECGECHC@AAABAJ@BFTFFFDB@FPFFHPBDDD@ACEGD@F@FFBAAGJDF@@CGCDCN@BHBBBADCDBDJDDCF@BFFN@BH@BCDKDED@DDBFBJABDB@P@DHJC@@AHFA@EB@LHVAL@@DN@BDABBBJKFH@DJCHDB@@LH@BDKHC@FBFADAB@@BIFBBJED@AFEBC@GCABNHFNJEB@@HBDG@@BHBJCBBFEHAJBP@JCDDAFFDEFJBGFEA@BFBJAFA@@@DE@JD@BEBGC@BHDJA@DFCF@FBBCLJEFBDJA@EDBBBADGDI@ABBFJBAFFBPC@BCD@BPCJFAD@BLE@DADJA@FFB@BEDDBFAD@@EJABCBBAFB@FEBDCHF@FABFBCDBB@@CCCBCD@FBDFBAACBADDBDJ@HDAFBFIFBFD@HEDC@CD@JDGF@FGCBDDBHABEH@DBBHB@FACGDALFDEB@@DAF@BD@DCCGB@FBDDCDGF@BD@FAHCCEF@BHCFFFGHBBH@DADEB@BDABCFGDABBBLDADG@MGGFAAA@@DFFFBLABBKFEA@BN@DCDB@B@DIDDD@@GCAEEDBBJFFBQ@@BDD@@CACEAB@DDDPDDAAEHCDFMLL@BCB@@DCBF@FFFB@FDBA@KAC@@DHFLCDBCDG@Q@@CA@AB@BJHCDECADHBNCDDDABABB@DB@BE@CB@DB@FBBNCBDGLBBD@PATBBA@CMI@EDALHDF@HKFKJOCA@BDFFJ@JBNC@CCCDAF@RDBDABKJ@BFLDBL@BBEDGA@DD@H@BBGDMBICGBEEEACDFFADCAACDGAACDCLBDHFEBKEABADBFDBP@HDPDDAFGFADBLHDNGHEBKCMAABDFPDCBCHGA@BBBPDFHDBJE@GBANDNBDB@HMFAFBDDBLAD@@FEJMDCBEJEBGC@KACCAG@CB@F@HXHBBCDE@QCABBBTJHB@BGD@DFBDAHGDBBBGHUDE@CBHDNDHDAL@N@@LBBB@BIDA@DBL@BBAFEFBDDBFABC@I@ADBFTABI@@@JHCBI@@DDBF@F@HED@@FAH@DHHH@CJDFBPCDGD@DFFBBFABNB@HABB@FFCLJH\\AFI@BDDBFADGBIBBBHDRF@HGJBFH@FDADADF@DCDMHBBIì¼|¢Ü®¶\\MTEXGVCbAfItSR@^JLBDBFF\\hFTBR\\jFPDB@FDDDPF@HDJB@FA@BDRALJF@FBJCHDJDHFPFN@BAAADATFJABBHBBCCCDGLBDEN@BBFAHBDBh@F@FELCHBNBBAJDNABAL@DBHBBBFDBFN@DBDAHAHDDDFDLBHAPATIXAD@FGH@NBJAPBDDHCV@LFD@DDDAFBFCFGJCJEBEJBHEHAHGNBDDRANDBDF@HDD@DABDJBDDBBFDDALFHBZOBAFAPCBEPED@DCHDHGDADCFA@AFAPALGFDBAJ@HFFBFHP@@B@BJDBDCDBBHBDDCFD@DDD@DBDBFFFBBBDB@BBBBABDJAHDAFB@HB@ABBB@@B@@ABBB@@DB@@B@ADAA@DD@A@BDA@@DLDEHJ@B@CB@BD@@@EDBDDDCDG@@BDB@BD@@AFBFMÍÍDAFBBBFEF@F@BDHBBAD@ACDAHA@FCBFDD@BBFBCFDB@A@@DDDAC@BADBBBLACBBDCDHB@DD@DCL@FBBEHATJHDH@CEFA@KW@MAYIOGa]__QMAECcAE_WMEICwEICmcEE@YGQIiGMOiBQRSRKdOd]VYR_DQAUEW@EJYAGSuEGCAaGYBBQCIE[gQICG@EBMBUCIEGYYBIAWkYc[CMBCTUDQDYAEQSQWEgGEMGCAD[E]BMAGEEMGIGIO@E@SFKXKFI\\iAEEKYOGGOu@QL_E]UC_GKUKqW_IyWKEKIS]E_MO@UMWEUIE[OCKKZANEAAEIBAABCIBEAABBBG@ADCEA@DCCAGBB@@BC@AD@AEB@@BBCBADEDK@OC@BA@CAABC@EHGD@BGBCCC@@BGBCABAAACB@AABA@C@@AGB@AA@CBI@AAA@@ACBEA@@B@CCABE@ECEBF@ABOB@AEAACA@A@CIH@KCBAAA@@CAABABCAADE@AAADKDABBBCBBDC@@BEBBBCBAACB@AG@ECAB@BA@BBCBA@@@CBAACDCA@BAAABAA@B@@C@ED@DABGCC@CD@BAFEBC@ABCBOIG@ECC@@AAB@AIACA@A[EABE@@DAACBO@AAC@CBI@KDC@WDAAKDIAMEWCSBI@cQMKKKO@CBAHCB@DGJD^EPMVACGAE@E@M@ICGBGCMGWKKMC@QFAJADADKHI@QCGACBGAIBCDQBEDMDO@ELFHHFHL@DGJEDAHINEBIAKDIDQPO@EE@E]KKAAA@GMACE@G@AgMEDMBGEOGGFE@ACIGCEDCCKFCFCBEHC@CCAAICADIIEAGBABEAABGWCAICADAAG@EECDADCHEBAACJG@EECEEEAEC@GEAAIKEMBIAI@GCMEGDSEGBMAGHE@EJGFAFKBIFIDMKGACGCAUGGBPQCGHE@GCICAKGBCAGMBKA@GKEACBAAA@CGA@EBCFAHIHEBCDCDAAE@EGCCEEAEEKBGAGIEMAEWBEBI@IECIQIK@DEEGEAI@IKECdIFDH@NBFIECACCCEAD@@C@GCEDA@CAABCDCHMGCMMIGACEG@CGECCIACCEACEOOAEBCCAAACEDCQY@GHKCECC@EAGAGBEEGHEBKEEAGIEMOUGDE@IJGOEKDE@KE[EGAAGCAM@K@@ADG@ODGCAIGGAGHGCIBUOCEA@CBEHKDAHEBICG@EDAFIDQACGIC@EBEGBKFG@CFA@OEA@ED@FCD@BEDJLORKHGDADMFS@CBGJALADQDOBSOOIOCYCWGAAAOACCAI@CCAIEGBINKCAAGI@CCCDE@UMQAWBGGDEBIQKAEDC@OIKG@BIDECGKAKAODeDCAECC@AE@CCEBCAICCAGUGE@KKAEBGGEACG@CCIAACAC@C@CGEACECGGIECACEGAAEIAMGIAEBEEG@EAGBKCM@GHADEBGFCBEJEBGAEBCHMDCFGHQFOLEBcCKF_DGDKCQ@ICIAKFM@AA@E@CHIDCKSECKBQA@OCCQEKGM@AA@ALEHMJEBOHAGIOCKMACEG@CHADA@EHADIJAHEBGBA@CGGBGI@BO@AEACEBUAEIAGE@EECCE@GDCAAIAI@GAQHGDM@EBKAAEDKAG@AGCEE@IBAEEW@GE]VMFMBKEAEEAS@SIIGcAMBGBS@QCEAAMSAGEI@SIA@AFC@GCGCKGOAACWFK@MCQBODS@KCABBFAFBFEDEJMFEFGFIBEFIBFJBJCBBB@D@DYZENKGO@EECGIEIAGBCACGACCC@CBAHADAAG@ECCCKKAYQ@OGUBM@MCAEAEGEAGBI@G@OTAFIBIF@GEGKCECAGBGEAG@C@EFAAAGAAGBM@ABBL@FRFFD@FEVBJKFG@@HGDAF@HNFFHADDDAHDFBDGJDHADIFGLBFFFGJBLFLAJDDDLTHJLB\\DDTJH@PCJBJNLLNFBBBFHBHNJHJNBLBD~bj@DBJFBHGD_BKFGHCH@FBHLJBNFJCL@HXRLRAHCDaNO@Q@uWIECG]BKMHMCKMIAKBCFGPGFIB±fEBAFBLAHELANA@FFFtVDDBJGZILI@QAUF{LKFIDOCMMECO@AH@\\CFcBQG[@GBEFGHKBYMIAE@KLGFEHGBQAMDGDQVIBsCQ@EEIACFGBGGGAOCC@aLQD[NEHYNCFIJYDEBSNCLKNEDWJIBcAgLCBCRFHXJBDABEDQ@MDE@"],encodeOffsets:[[134425,47448]]},properties:{cp:[131.157304,46.643442],name:"双鸭山市",childNum:1}},{id:"230600",type:"Feature",geometry:{type:"Polygon",coordinates:["@@AŝYKcoOaOa±t_A]ZEDÉ¥KQCCGKÅďCMAEX@ZCVM@aCECAGKbOCCGAaLMMGIIGMOCCUSXOFDPMFDZCBABI@IDCNQDAD@PFTC@WFMNATCTKNMNCFE`SFGDOEECIEEQFUKEDKEGHGBOAQEI@EDANEB[CYM^cHELEPKdebMFGA@BIACOIICACFGHAHUDADOFIJIMWECOERKTCPUoKECKIa^LZGVFLBLIHIRK@CAEHC@AAEMAIFAAWPCCVMECUNIEVOOK~iGKC@ACQKA@]WURFFQHKBsMeJC@EJMCE\\CLB°|OJãEaT[E[]FQ]eSBOAEFEA@AG@LM[IUEIBBCZCAG^AFCCKXAVODY@OQSEIPWFYUJ@KE@@AYAHIDGDC·HSNAM@WAFK@E@@IoCk[BOB@HWQQAEKGYAI@MCOH]HOBGAANMLSF_AA@AQIDQEADKaCDQECMEmGTIh[pPAFTHLAZLVFbDd@FAVaQCCACCBYNRWKK]OHENEBQ\\XXFdTHBFFJjRb`NhLxKsE@@CHEABACCeyGLLCBeC@IVA]@SXFXDLG\\BHy TNWTCBIL@@EVD^KBCLsJYP~HJcJWT¡ƻ¨HDMR@JLJB@DǢbJwNTBDUD@CCEABAKMHMPQ@C^LBKDJo]CDUSCENgEAFQDUAAG@CFcvHJEHKFBFORFJSNOPKBBJECATKHKA@CBUOFEMEOMYJDFEBEGOFMQQTGA@DOCDQE@BSBG[BAXAECHK@DIBIFIJ@@AD@PQOCAKD@@GFITWGIZGhG@GE@IIBC]KRYBGDSGC@CECCCBKDCDKLMBIFEBCYSIESCWAYBSAMAOKBMFSRQDKAKEokUIYEgC«cG@IDEFKZGBSBoGcBCACC@GFGLGFGBG@IkaOIOCO@KDMJ[dKHIDGCMG@CDM@EEEGAWJ[TUBa@QC]GaOgII@ODSLSHODMAICqgOISEeFIAC@CFCH@PDDPDPHJHFH@FEFGFK@]AODKHMdO\\CR@RCFATCFEBM@QAw@kFYJEDGN@ZCPKNEDOFQCGGCEAGBSKKGO]SCMBUNk@OGKGEKCSFcTQJMBIAMGaUYGK@OHCH@JFXAHEFOBOAOIWWKIIEOAcL[HOAQEWQKCcEM@MDmXSHOBG@KEAG@EDEJIDG@CCECE[KUMK@I@yRQHUVU`ILMDaA}MIEECEIECQ@KDMFOJURG^CLEHQJEFAF@HDDVPFHBF@ZETEJGHIDIBSC}G[CO@GDe\\INBNX`@FCDEFQDI@QEM@OJGFCH@LFHNNDF@FAFGFIDK@QE[QWKIA]BKBMFOHMJKNIDAD@FDD`L@DCJBBHH@DCDC@a@KBAB@DBBNFPLDLADEHGDKD[DSHEDAH@Z@FGFIDQVUJ_R_@_EK@CBKVMV@JFHHDtF^TJDR@DBBB@DCDaTIFILELCNHJNDH@bAFBFDBF@TCL@PCHGJCREFQHUBaJ[FWJ[^WVGRC\\@LDLFJNJLD|HNHBF@FCHEFmTEBAFFJLFPBTEZCVDDD@D@FALK\\CJUJUFEDEJ@FBDXPDHAHKLQJGBGFAD@FDFHFXJJH@FAFi\\EDAF@DDDNFRJBFBHKHEBQAOCWDCD@FHN@FAHGJSLGNAJLJFD\\JFFBF@DCDWNCDKJE@EF@HEN@JDFPLHL@DAHMRKBAAKEO@EAKKAWAGSOOBKDCBGHADDHLFFH@DCH@FCFAFLH@BEHGFK@uG[BKDIL@JP\\FRFJBJEPEFKHGHAH@HBHRRFBRDJHBDAPMDEF@BBFHLDFB@B@OLCNIJMF]FKDADCDBFJHNRFLADMH[BIFEL@DDDTNDFDJ@LEFCBQ@OBCDIP@BPPFNXXJLDPANCDWRAD@RELCBSFID@NMXSPQDIFMLBJDHRNBHAHEHITCV@FDDNL^NHHDD@FCFOTKRGH\\FAJKNF£zUnlJfIFVHBHDVTdLL@EHCJ@LDDHDBBGJBHBDLD\\@@FOHE@ICM@GDCDAD@FDDEH@JMNNBBFDBSHSJSHODKBAFHFBJEHEFAFEDYFI@GJ@F@DDBAFDFLHHBDDAHJhv`HDDJLHJVPNn[~hF¬@RlPZeRbp¶LJ@ JGVBPFNDJDNNFJvJsUADHLCJBBBCDG@GDA@BDFELDACF@BCB@B@CD@BDFDADDBABDBEDDCDCAADC@@AC@@BD@BDEFH@BBIAAFBBD@@@AAB@@AD@@DBBEDAABAC@ADC@CDD@FABDCD@BDAJADBG@CFBDD@CAHAD@FDA@EABBDBCB@BE@DBA@HFIFADLBBCFDA@A@HJ@BBBDACEPCBFIDD@@BDBB@@AFBGDCA@BED@BFBBDHBFAABBBC@DDE@DBE@@BGCFFGACFCACBBBADD@BCHAHFE@AAAA@BBB@@KDJAADE@@DDBB@CCD@L@B@AD@CGDD@@BCDBDBBFAFBBGJ@GDF@BHDCBFC@HHB@AEBBBDBBBACCDAFABDEDDA@DF@BABCD@@CDAD@FFABBBADC@DDF@BBF@@BDDDABAFBAgBÈCCUc`E¨lDHxVR@VNtT¬t\\t°RTTXNFRDlRABBBRZTm\\@AHUJWø@@WŪ@R\\IO"],encodeOffsets:[[128341,48422]]},properties:{cp:[125.11272,46.590734],name:"大庆市",childNum:1}},{id:"230700",type:"Feature",geometry:{type:"Polygon",coordinates:["@@hWJC^CdBZHLHFFBJ@JAJK^@LBZJRVTFB|PVB`COSlaNWTÏ@SN_VWVIXERAfH¨\\nDdC`Of_\\_@USgCO@GFMleTKPC^C|DVANEPKFS@GSsBQHKNIIRIPMLeEGWWCCBKDGPKPISSLCJGdqTWXM_è£bMPMVcPOPCpAVBŎJ^CXC`YVmHIfM^ArF¦jJV@vILIJMFKAMEMs{mm©­CO@QDMHGXGGjKTeDCgL]FCnQVFjLdfH^@nMX[BEAKCK}EKEcNih{deGEaOCEDKAEK@GDABOA]BCAGWAAI@OHC@YMU@@CDGACWMC@KNEBE@G@ICIEGGcB]KOB[JIB_KCGQ@QCKGmCCBINEBMEAH[DEBUhC@UBGLEBEAEICCC@GFC@ISEAQFURG@ECAC@Y@CEAYLCAECMAEKAOQEI@UH[@IDECCAGCAWEGCCDIAAABCZQBCACUSSIEAKFCIBAPENA@CCgEQMKAA@CJKKGAC@GDCLEVADABAAAAAUGCAACBOAAKBMFQ@SDA@ACOBIAGBGDGEC@UJC@WI@CCGMA@@BCCACGC@ADA@cS@C@@I@CECACBCA@DEBE@@AA@EAEDSCCDABDB@BC@BBA@@FCAABDBABCAABBBA@ADA@@DCH@BABB@@BA@CAADCBEHC@@BC@CBE@ABB@ABBBABEBABEACB@DB@@BC@ADE@DDEBA@@DIFADBBIBE@@BI@GLKDAFYFCFOD@FSFCFID@F@HILABBVMJKLOPKHM@CBEHURQLUJSXIBOCC@CBCLKDIAEEOGEGAMJWAGW]KIIE[EKDO@SCgUCC@CBCJGZMLKFIBWCKIU@EBGJIBGcWACFKJA@CACQIIOUQYMiEEEIQ@GBEFGVG@C@AEAIODEBSEMJ[BSAGQOB@XEZMHIFCVEBO[S{]G@ULE@WGM@KBQDWAEC@EJaBERGBEAEGEAEDOFE\\@PAHEF]@EEEWQAE@IJOPOLCL@jNLABIAA@KYGHCLIHAAmHSPGH@NBFGDO@EK_@EBArCLCDG@KJMFCÀ_eHM@CMUKKACFUAECCACBCPGb[LO@CKOKEAEDK@ICKEGKGWGCaNkFCH@NDP@FAHEHAPADAKBCAGMEESFKHEBOCMCEEIK_QCC@ABCJEDCDEBGCSKeBGHEnKDABC@CAAEASBECCIBEDIHEJCBKHECCBCFGBCAAGDYDgSQEQBSEACAEAUHGCEVWrQ`UHMHGN@LBJABCACOCOI@GJOEQG@yBUAGCAAAGAIFEXC|UJ@GKSCAA@EAGKM@MIACGCCOG@E@AKAQCICIDKJKBYLEBQCCEGCAEAEEIACJCCEGGAEHKCEDMCGAEICAEACHGBCEAACEEDGEKFIBEEAMAEAG@UIIBAABEGQBEJ@@GFAFKAEEAGIOAGB@IEACEECDECAGASFK@E@OAGEGACADCHCBGHKPGBK@EDGCGJI@CF@AIMMAGAAMEWACCBEACSKK@UCIAGCEEIAEAEBEDEFCBIAMEY@KKEAMAGA[BGAGDAC@AJALEDKHAACCC@ANIHGHE@GDIIIDGEGBEGEAECCUAGDMDYNSBI@BIACFO@CGCDAFGBI`CPMDEH@HGaW@GFC@ECA@CFEDGEGAELCLDFCD@PWF@PG@GDI@GDKDABKLIrFCFANDLCDA@EFGDALDHAJBRGDBBDNALFXGIKAMBC@CBKCKDEAIGCACLKDIDC@IDCAGKEAEKE@GCCUACCICECIBMBICSAEBIHG@aEICEGC@eBGGKGG@EEFSCKACCAG@QKEAMGKCKGUEJKCICESAC@@KGK@CDEEEAKBCQEWFSBOGSCAA@EGGMBGAECGGIFG@KDIHADBFJFEFCDIHABDHKDGNSDO@cJ]FEBGFEBG@@IGGYGC@IDGBMAECEBKAKIC@CDK@IKCDCACE@CIGCEKIGBEEGBGBA@CEBKACEAKIEOCEKIAGC@IDK@IIEG@EBGHKFKGGDQ@MAASEOFOBUNWACEKCGCCCIAUI[HMBADFHAHQJEF@FS@GDAGGIGCEGEAAOMACCEA@CAEMEE@ACDIACECGGGAKIAMKOIAGBIDKAGLGDKBIBIJODWLCD]BEAMDMBQDEJ@HG@QAEBQCGBIACGMAEAGMYEEBCGC@@OCKGIYDG@IHQ@KBSFMH[GKMCGEFC@_@CAOKQDOB[C@@HP@DADEFBFAHIN@RBDPLBRCBEDEJGFBHFH@FOFSBGFADDJCFqBEAM@GIE@_FIJ@DEDaHE@CKQAICG@EDKAA@ALGDCHIFABABEBGHI@KFIBMFEBIAIBEAGBOLSDCH[DAFEBE@KGGBMASBQCGKAAMCEEMGK@GCECEGHEBGHIBGGEAGMCCEIIGBSJUBOA]DEAEFM@G@IEGAe@EGIDEHGDI@MFKBE@AFKBGCOGOCKASAIDKJQDKJSFSDYAQBEDGDCFIDE@GCKDEAC@@DCAEFKBGDCAEDC@CDBBCB@BIFE@ADA@@DD@DDCBEJIFKBGFCDGBADCAC@EFABBDCBBB@DD@@DF@ADBBEDFBCBBBA@CA@BA@@BEBEJC@@B@BDA@DABFBOH@BA@BFGBBDABBBCBAFC@CD@BEDBBKDBCC@@BAAA@@DIDFDABGBAACDC@AABAC@@AIFBFAAADE@BBABD@EBBBA@ABAAC@D@CDB@A@@BC@BDCADDC@BBCBDBE@@ACBAAABC@@BA@@BA@CA@FB@ABB@BB@@ABBBA@C@BBA@@BC@@BE@BDCCA@@BC@EDCDA@AFC@DFA@BBABBBABDABDE@ABCBADAA@DCA@BCB@BEDBBCB@BB@ABGF@ACBA@DBCBCDD@@DA@AFC@DD@BA@AAABA@@DCAABA@ABDBGB@BAA@@BBABADC@ADCABBCDEBAC@DC@BBE@CHD@GLGAABG@CFCBD@ABB@GDBFBAADAACBDDABFAABBBC@@DAAC@@BA@ADAA@BAAAFCABDI@EFC@@BAAGFDBGBD@CD@@C@BDBB@DEDA@@DC@@DC@BBCB@BC@BAA@IDBBAB@BC@EBBDA@BBCB@DA@@BE@BBABE@AD@FAA@BCBAHED@DA@ADA@BAA@ED@BC@@BC@ADAAAFAAABAAABCABBCBABAAGBDBCBGABDKF@BCBBBA@@@IBAFA@@BI@ABB@A@CDIAAD@@C@BBC@@AA@AD@BD@@BADB@C@BBA@B@ABBBC@@BAAABC@@BC@BBABCB@@C@BBADCBCA@FBA@BEBEFBBA@BBBBEBBDCACF@AG@C@@BE@AAC@AAMD@FGBCDDBCBBBAACDOBMEG@MNWDIJFBABB@AFD@CDDDABBBB@BFB@BDA@BBBDDABBIBCDK@CBG@GHC@@BABBBCDGBBB@BEA@FCBCAEB@DB@E@ABCCCCADCB@BCBCA@BE@@BIF@BDBL@FB@BABI@CBBDFBDFF@JIF@@BCDABH@BFLB@HDBHA@A@AGC@CF@DDB@D@@EBAJAJBJGDBBBAFBDPFDFFDDCAG@ABAFAHB@DAFDFDA@CBANDTAB@BDEFHDLCVAFB@FB@FCF@BBCH@BPBBFBBD@DEHB@DCDDDDFLBFLNB@DGFBDDBFA@BBBFCLDD@BDA@BBB@D@@CJ@BBE@ADB@HBBDADBBAB@BHBDCHDHCBBCBABB@FAH@DFH@JCBCF@ADCBBDD@@CB@F@AHBBHCBBEB@BBBHA@DBBBFDAACBALBCDFDDFB@@AF@@AD@DCD@BAL@AADEBAFBED@@F@BBH@B@ADB@XE@DE@@BFBHCGGNCBCA@AADAB@BFD@BCFBAF@@JAN@DFFFBTB@DABBJBFEFHHABBEB@DHDH@BFB@B@ACBCDABDHB@ACABCLEB@@DF@DCDBFEFDLC@AE@ACLAJC@AAA@AHCJD@BCAABFBD@DCH@DBHBFFFABDJBEFJ@BBDAAGHBDBFCD@BBCB@BFBH@CAACHCB@HFDA@AECBEBAL@ABA@DDB@DGPHFIL@FAHFDAAED@JD@B@DH@ACF@FHJBH@B@@DDDJA@DBBL@`HLFAD@BFCHDBAFABDABJBDHFADB@BABHFHAAEC@@AF@DEJAB@BFD@BAB@CDEBBBF@DCD@LDFCPALHAEFBB@@FBDH@HCFDBJHF@BGPBHDDFDB@BAHADBFLFBBJHA@FHBFCB@BCDBBDABBDFD@FJABAB@@DBBN@ADDFH@DBDBABBDJ@@CJAFDBAHCN@@DB@LEHCLBABB@TH@JKNCHBB@FDBBDF@DCB@@B@HBDLBPFHBDBNADHZLLNL@HDDDdJDDNBB@ADBBDAR@@DDDABHFABLFDAHDBBH@BBCFBDHBBA@AB@ALC@@BCBBHABJLHABBBBF@BD@DB@BFHD@LGAABFHFB@BFBCDBDCFBBCBAF@DMBCB@BBB@DHFCDEB@FDDD@@BD@DFABBHCDFJD@HJRLDDHFBDFBDDHBNHBF@FDF@HNP@DBHKX@FDDNHDH@FEHGFAD@DCDILCMMIO@@OFQECQA@OECEAAIKIEICE@IKCAMKCEGOI@AI@OHG@DLFJADMFBB@DFD@BEFADBBJ@@FDDKJ@FG@CDDJ@LoBCBGAC@ADGBW@CBAFABMFGBKEAACIEBADEBGFCHG@MJQBILAFHDNNAJFH@DCJBFELCBWBGFYDMHAB@HDHCFOAA@GR@PCFOJAHKBQ@MREBCFEBYBMEORBFCHCBKBPDLLXFDBBHLJCJDHSH@FBDFHVJBDBHJHFBJAPDP@HDGHCLGFCNDHJLBBSJCDAHEDBFAHBDED@DDJHJTFP@MFCF@DDDCF@BDDLFCH@JLHBHHHHDXFDFCHBJCFAJCHQLIBcLYLC@IAEEBHCJBFARBJFJHFFLPHDDALCFBJILJJBFFLLHDDLDPA`BHELEBCPMNBBBCFBLABGJ@BFRHDDDEHDLEJGDED@HBFEFEHBFFFMDAB@FABWLAJCFQFMLI@QLKCMAK@SCGBEBWTADGDEJIFIBKD]@IAODIFMGKGIACA@HADED@HFD@RBDBJGH@FCHLPBDGHGHCF@H@DFDDFDBBHADIH@HCDAHDHHHJHHDJDFHABEDQECBEHBF@FEDAFK@MFG@IDGFZNPDFDBFJARHDTCPHL^LJDJFTJHBRAVDBLBBJ@LCH@BBDLDFFBD@HCF@LJNFTE^OHDJ@D@bALBFHBHLHHNNHKLBHCBCAEBCBBHAFDFBFEHADDD@BJHDBINAJHFL@BB@DHFBNLL@BCDFF@DHHDNLHH@FBL@LJBBIBAB@JFNEBCB@BHFBFFFVFAJFPNLJBBLBDHDNDFDBHFDLRFTBDNHZDfLPLF@HCNB\\EFBLPHFPCPANCJDH@FHJ@FDFAHGH@LFBFBDF@HCF@JDFDAHFBLHJ@RFN@JCTHN@FFVJBAFCN@FCTD^MHAFDHF\\BPGJMBAAQDG@ENIFMBAJ@DCAAKCAE@ANCBEB]AAIC@CNORBMCACDIHECCBI@IHG@IDEPGDCAEFADKFC@CDCACBC@CCAD@H@DAH@@CAGPG`GVALJDF@DAHLPXHDFDCLCRDLJNGNDB@CF@FFHCLDJBDCDKBEF@JDF@HCFHDf@RHAHIBEF@BBFKD@HBBDD@LCFAJHDHJCHGDDDFJTFFBBFJBDDBJXHHJBDHBNADD@FADGD[FKD@B@FNHNB@DFDFJJNTNBFADFN@FH`KLCJ@FDFLHAF@FFDPBEDARCFADVLFHLN@DCNXDLFDHANCDDBZJ\\BNDLHLN@LLRB@PDLHPHB@JC^GFCD@D@DJHDR@P@PHT@PBFHF@T@BB@DCJDHBPIHAFEBHJNDDBLXFBHFT@HDBFAFQNGLOLGLMLKFINSPC@GNQFBFFPR@JBFF@BEDKLEBTHLGJAVARMTG`E`@ZNAHMPBHAD@DTL@HB@@DHFLFDDHBJDJCF@DBBDB@ABF@BBFAFAACDCAG@CLAHDFJCBDBBLHD@BF@@AD@NDRE@BH@JA@BFA@BD@ABDBA@BDABD@BBCFB@D@ABPAB@@A@ADA@ADB@BDBFCF@BDADLFF@JED@BAHC@ALFDAF@BA@AD@RCDCL@JDCDBBEDIAADHBD@@BF@LATCADEFCF@FDDABFDBFF@FFDH@@ABBBPBDADDJ@@BD@DANJDFFFFHADTL@DB@@DCHBBD@DE@CDCPBFBDB@DAHIDOBCHBHBBHFJBBDKHGDWACDDHZBFDBDRDD@CHSNIDI@@BBFJHPDDDJBIBGAEBKDADCAGDE@ABACAJMBEAGFA@A@BBABU@IDBBA@MCCBBDC@CDABDBCD@DDB@BHBBBBFCBADCBFBSHAFC@@BBBABBDC@BDC@@FCBCAAB@BABDDCBFFABBFDBBBADBBADCBBDABHFBAF@BB@DCL@B@BEBDDEHBD@DCDC@@FCBIFO@MFCDEDI@KEG@GCM@IAMDI@WCEBECMBCAC@WFSBKDIAEBIAIDI@IDONSBKFCDAHADKFADAHKJADWD]GDD@FDHNFDFCjFN@BAD@RADBFBFALCHFDBDAB@DHFDJ@BBBCJMJ@DE@@BID@DBB@DCBBBGDBDA@EF@D@@AB@DCFGBGJBBCDDDABHDID@FCBBHCHEBEFGBER@BCBCF@BCB@DABDH@FOHABBFAFKJ@LDHAFFFD@DLAB@DFBABGFBHDHJ@JHJ@EJGA@FADNHAFHBDLJB@HL@FDJBFFF@FGBBDFCL@FNLLBBBAHBBD@@ADB@AFAH@BCFDBABADAHBFEJAFCJCBBVMJABBIJAF@@XB"],encodeOffsets:[[132405,50556]]},properties:{cp:[128.899396,47.724775],name:"伊春市",childNum:1}},{id:"230800",type:"Feature",geometry:{type:"Polygon",coordinates:["@@@LEBDFI@ICOIGBAFEAK@CDC@@CGADCACDAKBAACAABD@CBCC@@@BCADEEAAAC@ECDA@EGBCBBDC@ABGAACE@E@EFAAEACBÎÎENEA@BC@@ACA@AH@DCCCACFC@@C@@ADAA@I@FGKC@CB@ACB@C@@CBBBCA@@@CA@@AABA@@@AA@AA@BGAA@BEGCIBACABAA@ACAAAEAEECACAC@CCC@DECCGAAADCACIC@A@AO@EGEAGEI@ABECKHOBEB@BEBCDCBGHGCCDC@OFAFODEBABYPGAKECBECAACCIAACCBC@GCE@ACMCQBCCMAGHGBGFIAAFIFIDEHEDEACBCCC@KEU@GDCCOAIBMAG@EHC@WBSJOBGBKAECCCGCGBCBCAM@AEECAAGACAK@ABMBICABMAGAKDEFE@g@CAGAEBAAM@CFKACHDDADGAAAIBSECBBBABM@OEGEICGCIDEAE@KIQBACB@@EIAGCE@COCC@ECAEO[iAQES[gEECAKA]IQ@sTeJaBUDWHSF[N­µ¡Û»{JëAANGDC@CCECBCB@EEGIAGHE@CQAGAAAJCHEBCAACJ@BEG[KIED@EAAGBA@AMEBAAEE@CHCDCAOCEDIG@GG@CBG@EC@GFE@E@CA@CJ@DAIG@@J@BAESCA@B@JADEBCAACFEBEAAK@CAB@JC@AAAKA@@@MBKGCMCGCDAF@VCHGAACAGHCBEA@CHC@AGASIAABARDF@DCAAWG@G@EDAH@DBBD@LHDFAFIDANCFI@EC@KBCAACBENE@GCAMAMCAB@HIFCAEGOCAA@AHBDGDAOCCEBANBLDFAHGCMKGCAEBEHCBOCGCO@CAAEBCBALFFAGEACDKDCBBCHBDDBBCEEDCFBFFHAJDNAHCAAG@C@@CHBFCAAK@CAEK@ALIBAACQCE@CBDD@DMDIAI@EEACB@PDLILE@GCEKGCB@FNJ@DABSAOBC@AAHKACMDAA@ECAA@@DAFA@@CAAABCBCCMDGABCFDDCIG@ABAB@@DR@H@DCCAKDGE@CD@LBB@CA@EEAEEE@DA@CA@ADK@NKCEGDBFCBOCCC@CBADFDB@@CC@AR@EAIEAAFCBFHD@@CCJC@C@ACACDM@@AFBLEAAKBEAEE@CB@BBHENHH@BCKCAABAHCDEBAACA@CFCBG@AAHGEEDEAGE@DFGDEBC@@AHEDCCCEAA@DHCDC@@ABE@CA@CFKECBDHEBA@AGCAG@AFGBCAACHD@EHEICC@@DCDGFC@AEJEAEBEGCI@ACCCABBDABCEEAC@ADDD@DA@CAADAEEBE@DGACEFA@BEAAADIB@FC@EBCAFC@AEA@EIBBC@CKF@ABCIEOD@ADC@AODEABEIAAEBAJ@HCBCAACA@FIBACFEKIADEAE@ED@CIBGC@AHDFA@AICF@@C@@EBIBEA@AFBHEIAFEECBECCIDO@IAGBEFAAIDGA@AH@AC@GA@IFEMMGBAHDD@FABEC@IFAAJE@AA@CBEBEAD@LGACG@@KA@GCID@CEGILAABAACM@@CK@UBKGA@@FEBBG@@IDCGO@A@ACIBEACA@CFCLCDC@AAGM@EE@ADECCCICACDABAAAGM@CDHD@DE@ICBHABEEE@C@FHBDC@CCOAEGOE@ECAEESEAEI@ABBB@BGDFDDHBFEDFDADABA@BCA@I@I@CDR@FF@DE@QEE@GDLBDBDDADA@YOQ@EDFBXDDDBBABI@AAAC[EGDDD@BICGBADL@B@BHADAAEEGDA@CGA@@DKDHB@DO@JH@BABIGG@@DLBBDGCABADICEDCNBDCBADMB@DEDC@GCK@ACODSACAIA@AECOFACI@CECAAAWGE@EBABCBMBCDABEDMCQ@ICACGBEAEECGIBGECBCACDA@CCAECBAAM@CA@AACCAACGACCC@CD@HC@@BGAEDYCC@ADKDMAGFC@EDIJD@@DABD@@DCBBBFB@BC@BBCBCJE@ED@DABBBCBE@CEE@EBC@KFCAEAODE@@CA@AAGACDE@ABK@EAAB@DGFA@BAC@IAAAO@EGCB@BABECE@CACGC@QEEE@CAADEEGBGACH@FCDSDEAADAACDGFADC@GIADA@EEBGDKA@BIDEACDCAM@ECAB@BCBSECB@DID@BCBBBO@IBABE@AFDA@D@@BBDFSHGHCDEDABEBAAGDAD@BIHIAKCMD@DC@ABG@@CE@CDC@CHS@BAAACBECCB@@CCCBCACB@AD@@EGBEC@FC@@DC@B@EBA@EA@DAACBBBABCCC@CB@@BCIDACAFADGBADFBFA@BHBFADH@DADAFEBDDADBDCDBHEDBD@FCDG@CDCB@DABEDE@ABBBCBBFCFHF@HADGDABNHBDADLDD@BBJABAH@JJF@@DBDLBJHHDFF@DND@DCD@DHBBDSZj\\sVAAAñdABµLAZŇE]WGAÓ_C¯^@H@BCL@D_Á@BE[@BDABBbsBA[SMkcKAFlǙJJĸDLD@CFFZDLJXPVBX]FßQ«BAEGǉ»}B@AąSM`EACR[XIAYYWMMTGAGNEFKGQGqSCGKGBEFCA_GOM×AHcJYbDDU[C^I@GwCDU_ABQDCDUKC_AQAKRAJQKcQCEAIEAACECICBEYG[EWGAD@HS@CFSAQL_IBQEeHU@EKKICAC@AHQBCFOHaAHU@IJITGFG@GCEDGECIACGBIFEFGPENADEAEBG@CKI@EMEIQGADKEGBECEBECKBATIDC@AEEAE@CDC@AH@FKLAPFDHHDJILADDBD@HJBH@DBD@DAFCR@DE@GNGLCDADEJEPCJ@HCFDVFJFRFZCBB@DFBRCREH@VFFCBCJCDCH@LEJALGBGHIFOJG@CFGACLG@ACGFIHAAEFCCE@EGAACBEBCHADA@C@EDGD@HEH@LCPAFCH@LGLANGNO@G@EAMCE@GIMGQHEDGNCfO^I@KMGFC@IDGACBCFAN@DAFEFAFADGFABBHFFFLBNED@FBDEFABCHBNCFBJHT@HABIFCDEFAJ@FIAGFAJDNAJEDIDALBFBJCLJLDD@FED@NLRELIF@JGLEH@LABCCEHEBGFEHECEG@BCAAICAEEIGCE@EA@C@GCBKFMLEAKGGBOAACBAAEECIKICJADCCC@ADEDSQIAC@CIGO@AA@IACIBKASIGBCDEBEGC@GCIAMBKCMBIBG@ICEGG@ECBCDEAEGCDIKAGEAABCACFCHATMLCFGBMIKAGEAAAAIK@CCEBO@CA@ACCBE@AMGACACDECEDGDCHC@IFIBGDCBG@ENBLCFK@QICCE@GGGDGMOSAIGADEFC@EEG@KE@CFEEEBGH@DCBC@ECCOIEMBGMAECACDGKM@MGGIKeBQEWDQBGB]EEFKAMDOG@CEEC@OGSHKAGFI@CD@DABYJEAMGGDCDMAICGAEBGGG@ECAFEFIBGEGGGCIGCBCACBW@EHECEEIBI@EEWBBCUOAICESBCHKFODQ@OAYASKCCBEAIFAFEAILGBGAECGIK@GACBECCG@@BDBADA@ACA@ADBDCBICEDCCACEAGBUAIF@BDBBBGD@DB@F@BBIDBD@BGBE@@EEDGA@BDDABC@CCEBABAHMBADEBCDC@ABBB@BGACDACA@EFIDIAA@@DA@S@KBADJDADBB@@EBEBA@@CEDKBCBFDEBCFG@AHEA@BDDADDBBABBEB@BB@@BGAADDD@DEB@BEB@CEAA@@DCACBKAKD@DDHABECC@GHSFCAC@GDBBFABBEDBFEHCAADA@CCIDABCABDCBADEAEBCCCB@ABC@AA@ADC@AAACC@ABCDDD@BC@GCE@@CA@ADEBBBBBKFABDBA@GDCAI@AFMCABDF@@EBA@HFIBBBCBHD@BC@BB@B@DCBCEA@AFC@HBBBABC@BBEBA@BCC@AACDAAAEGBGCCBBDABDB@BKBCACFCCABBDC@@BAAC@DFABE@EACDECEDCAEBCAG@CBBFEDCACBA@ACDA@AGCCDKA@BEB@FA@CEABBDCBGC@@BFEDADG@CBC@CEDACACBBDGDCCKBECKDICKDEAABADBBGFCCA@@DBBCFA@AACAKBAFD@@DC@ACADCAABI@EA@@BDABCAAA@@@DABEAAAC@@@DFEACFMHFJ@BEB@FG@AEA@CFCDKA@DEBBBD@ADEAGCEB@D@BFAB@EFCACBABBFCDJDCBIAEDADEDBJC@CABDADCB@DCB@BEFDBBBCBGCAB@DA@AAEBABDBABABECAFA@ACA@@FC@EFOFBDHBABE@@BDB@DF@FD@BABCCC@CDBHGAAB@FA@IABDHFC@ADC@@FIBDDKL@FIEA@DDBHIAAFE@BDHD@BC@KGGB@EA@AB@DHF@BAFE@ADGC@FKBA@AEA@C@AFE@GAGBAB@HDB@DKBEFIBIHAHGBEHGBGFBDJDCHMBABBDFFCDG@@BBBH@@DKFYDABCJICE@FHABCBFFABKBGPC@ABEBBHADCD@HC@EC@EI@KJCBG@CBA@ABBFCBC@GEABCDC@@ECACB@F@@EAC@BFABG@IBGAE@CDBHGJ@FGAALEAGCCB@BJDAFMBKD@DCB@FJBAFBBFABBB@FDBCFBBA@@@B@BJE@BBBBC@B@BD@BBD@@DDACABABBBA@BB@@ABBD@@BC@ABBABBB@@BB@@DHBB@AABABBDABDDAABFBACF@AA@@DBLEBBCB@BL@@AA@BA@ACBHI@@@BB@@AB@@AFBABB@DADB@ABAB@ABB@BBBAJDFC@B@AHBDCABFDH@ABBBF@ABL@BBDC@BD@@A@AFCACDBD@CCD@AABAA@ACC@@@BADBDADBDCD@@ELBGCJ@AAA@@ABEEDEAD@DEFA@BABNAB@CBABD@BBLAEDD@@FFACDBBDGBBABBBBABBDA@BABBBFAAFE@@BDA@FCBCAAB@DFDFC@DD@BBABBBHFABBBD@@DDABBD@@CDBBA@AD@BABB@BH@@CD@DEJ@@DF@DABHBB@CB@DBADDABBDAAFBBB@BBBABBBA@DBHCBD@EDBBC@BBD@BB@DHBD@@EHBBFBB@CB@DFCDBBBABDG@AJ@BFBCH@DHDFAHBBAD@B@BDJBAFDBD@BBBHDHANBHDBAHBDGFAFIFGDEHED@BFFAJIBADBFFBFNBJCHFFAHBDGHDDADDDALBBCDFN@DFHAFHF@FFD@HDFFFDH@HFF@FADBBFBFDDHHFBJHHBDJLBD@DMLCBAGIEO@WIOUOGOEKBEDCAE@CCCEEECEAIAEAIFIDKDEHE@EHKGG@IBEHE@ACABGBCD@BC@EDIHMBKA@OHI@C@AD@DKBEHYDEACDADCDC@IEKAMEA@AFCBDF@D}@ABBFMDDHEF@DhLHDCFBBTNFZ@XCHL@@DPPDHDBBDBLK@EDI@E@AEEADIFAMMEKECKAOPUHI@@CDMAIQCCEIBEAGEE@GUQDGEEBGHGNEFBDUNAFCFAFEHBLC@E@CCOHG@@FEHMFMNEHEIKMOOQIOEsOMGMK[][Sg]QQBECA@AFD@CDIAADEACCABADB@KAaFOJKAMKU@SJIDKFKDCBBFOFGFABAFFJABC@@FBDBFCBBDCDEAQFGJKF@FEHCBKCCBYR@FTWIO@eDEBCDMBRFADBLFFCF@DHL@LD@RBBBBBFLILVFLHLDNHFBRLH@DBBDDLETFFH@LHHHfAD@FHJDbFH@JGFATBJDNAJAFDJDDDVBDD@HLFBFLFBHCD@JCDCJKLBDHDBJCFDLAL@DADBNJLWHKEMBACCAQHIAGBKCCBEH@FCBKDMCEBEDqKJALCBCL@HCJ@HOHE@OXC@EDKCKDBFFHCHEF@DDB@FED@HbXGHG@CFON_DAJEHCBHD@DEPBDAJJ@TAZMNCHCVBDDBFHFAFFHCHJJCJ@HGFGHMJ@BDDBDGBCLKFIB@BBDHCHB\\AHBNBFBLLZ@NFJBDAFEFCFAFBJBFFHDJBVDL@TLBDAFDDXBNFBBBHNNBJE@@DIJDHCH@FALOHGLAHGDCDDBHBHFPBF@L@TEHBDBCFFDDFFB@JHAPBHJFBBFELEB@HI@AFHRAFBBJAVJH@FBNBFBAFEJFLCHFFBDFBADGHBDBFJDBFDHCNDFGLBFHHDFIDBDFJBFBFHDDFRDFAZKLALIJCJDRDLB@B@FPHDDDHJB@NLNBH@FBBTDHLHGbAFCTI@EAO@OJ_TEN@JDHHLCR@DED@FGHBHCDGFIAGJEAA@CPCJDDCFIDAL@DCJBFAFBFADBFB\\AYjEZK@AA@BCAABADADGACHABCC@BAB@@ABAA@FA@AACA@BAAAB@BAA@BAE@AAF@ACH@A@BAA@DCA@DAAADB@ADB@CC@@GECBAA@BCCBAABC@@HAACA@@@AAB@@CB@DACAA@BACCBCCAB@@CB@@ADBAC@A@ABACB@BABACBCAAPIF@DCBB@CBBB@AEBAFA@BDAB@B@HAAEBAD@BA@CF@@AAAD@BBFCB@BCCCDABCB@DA@ABA@AF@@AB@B@D@@CA@DCF@@EH@LGLDABB@D@AAB@D@BED@@B@ADABBB@@BDBDABBHC@CBAB@@@D@CCDCB@@ABB@AD@BBB@DBJC@ABA@AD@BAD@BEB@FBBBFAFBD@DAF@DCDAN@FENADEJBFGDBBDlAnGJARBNHTACTGFKHAJABAFFVLNAHID@BB@L@FIJKHGCC@GJBDDBF@FCLANBpBLBNHFBFAHGP@BAJ@JCD@H@HBHAJDJ@LCD@HAB@DCFB@AF@BA@ADADABDD@@AB@@ABBB@D@BFD@ABF@@BD@FBJ@BAB@JH@FD@BB@ABBDA@BFADDEB@B@BL@FBDA@BDA@DBCNBBDABDADBEDHBCBF@CDHAAABB@BB@BADABB@BB@ABB@BCB@ADB@BA@BCBC@@BD@EBDBGAPJFAAADBHBACF@@B@AACDDD@@@B@@CDBDABDDACAF@@ABDB@@CBBABBBFAADFA@BCBDBGABBB@BBEBDBCB@@DB@BFA@DB@ADB@ABD@ABF@BA@B@BD@BBA@D@AFA@BBDCF@@BC@FBB@CFD@B@@@@DA@BDJB@BDB@BG@AFA@@CEB@BA@D@CBB@CBBBABDBA@AA@DD@@BE@@BD@B@DB@CDB@AD@BADBBABDBADDBABB@BBBABD@BBBACCD@BCBDFABBABDBABHA@BBBABF@@CFBAAB@DBJDEBBFEA@BABEB@BBBAB@DGA@DC@AFBBCBACADBDEADDABAACBE@BBABGEA@A@DBE@DB@@C@A@B@@FAAAB@CA@@D@@AAA@@DAB@BA@ABCA@@CBD@E@BDC@@@@BC@BBD@CDDBCBD@ADDBC@A@FFABCA@BABBBCDAAAC@BGB@BCAEBBDC@AACDDB@B@@ABE@FBE@@DE@CA@DEADBCBFBCB@BDADBA@A@@BC@@BH@BBAB@BGA@BEDAACBAAAABDEADBC@B@ABCB@FGABBCB@BBDEAGBG@@DB@ABBBCD@FQHIBADBBAFABDDADOHBDABKBGDCJBDGDBBFA@DDD PDB^DjGdAfEt]U\\MJ@pOºLÖX¸TFDBAAAC@BCEBBCA@AACA@BEA@EC@@CB@C@DA@AB@DAAABA@CCB@CA@BABBFCE@BCA@@AC@DADBB@BCBBBACAFAKCBCE@DEEAF@@AEACBDBEBAABA@@CAA@BCAABAHBFCDBB@@AGAAALCBAD@BABEBB@BD@AC@CC@AAB@@AIEBAF@CCD@@ABAC@@A@AF@BEIAGAE@CA@ACCC@@AC@@CKBBCA@D@@CCAABA@@@CBAAABCA@CD@AADB@@AAC@@AHCDCNUJDJBVCREGj@^IbQPKLEL@RBRFDFJ\\FFPDTDFDTD@bONIPEHINCR@NAZINKFAJAZDLAJAJGHALCjBVF^JPNJLBFXXDD`LhBHBHHR@PBNFNFNLTTJDPATIVANEFBEJAFD@F@RGLANGPEKBPTFXAzMN@RFLJFD@FPJTFNNNFJBJC~AL`JR@JCVOVUNSJERCPBhHLBHHD@R@^BREF@JFRBHDFNVVDD@^JZBLDBTBDBDF@HADSHGFABAL@dBJFJDDFFNFtN\\NzNDBHNRHzJRBhE`BDBHJLJXRZLZXJHFBNDZ@LFFJ@HAF@HBBZNNBDDDHHDNDZLPJNLHZJNPNTJRFdBHBDDFJCPT^BJ@HBFFHLLBHEXBDHFhNDDNRHL@HELIRKNGPCLBHBDHFRDJFJ^jDZFlTLLDHBPC@VDNHNbnVRjVTLVXTZHNEPalIPEVDXTVhPdB^AdOZQbOXEZAT@^D`RVX\\bZ\\LRJL`LX¨`VJLJPRLhJX^bXP\\NRHxRhJF|C´QdMbIP@FCPITIH@VDTH~RxJpDXENGTEPAPApJZ@nEX@RD`JNLNTPpBVHVNLLFXHp@SXCtHRLRNXjDPB`FHZNjHZ@dFZLZPNDhF^@dFRjPVF|AtDPDPJfd^NZFXB`ElETB`DhVVT^\\XN`L\\BnARGNApLfBKd@hJdN¨`NRD^HjBXCvOdGX@¨HtGdB\\@PCZO w`@rDbHZN^XPZPVNP`ZRJ^JdHrAdKxIdAV@VDVJRRNHNBTGRKNQBE@SCU@KAIAEQOSIiOiK]MOImKiE[GSKwA©[}UgSIIY_QEa@kS]EGCOMKAmB_UEmDKCqNYUiNS@IKKcSQQU_EKAUQO[MOQocI_eIGIDWJYCKIMAGHS^WNErgPUDK@UCURGCTCFCb]CSEICK@OJa@SACBIFInUjUferQRMFIRe@KIc]YIIGIUSICCGCCMGY]yiIICSDMBYM]iQY_QMQUo[mOIKBmSeECGAa@EAeKqeKE_@WDU@QA{@"],encodeOffsets:[[137571,48578]]},properties:{cp:[130.361634,46.809606],name:"佳木斯市",childNum:1}},{id:"230900",type:"Feature",geometry:{type:"Polygon",coordinates:["@@@FCDADFDH@FHHDH@LANALDNAJBHDD@FHFADCHATJLBJABD@JBBP@JH@DBDRJCTCF@BDDCDIBJDJLFDBFABBDPBHALHFBNKLEDA@H@DFBF@HDFJBFJBBDF@NCVADCACWIEGDQDAhKdBJAXIFCLMDKFCNIFAZCJIDEZMFG\\MRCbKD@XFHHHADEJBFFR@tDJARUHCNCRBHAFGHELKF@JBZNLAHGFEHA\\@RHdADE@[BGP@FDNNPDJCLE|KVERBJ@JKHYAICCsUEE@EBBMFKBGAKBEFA²eJAHEHODELAJBLNNDGLN^ADHJFvXR@P@bMDCBGKQWQ@GDKEIAMKIAG@EDGHGLE`AFABEEEIEi@}aACAKIMIGGMGAAEAAMEKKIMIAODG@SICCA[IKQGACCGIGCBCDC@OAAAACEDGAIEMDIBIKOAGEWJDHEDADBBFHADDDEFYEEACC_GIHANKDGAGG[EI@EAIBaBMJCJKHOSCAK@KBMBKCEE@ICECQBMEAIGBCIEQ@MBKDGCEDIAECAIECBA@CIGM@ECBCJECGAABCAAMIDC@EKC@GUIECI@MC@ABKMG@CCBE@MEBC@ODI@GBEFGRIFKCEFKBIJEGC@EDMCCFGACBKHEAE@EIACBEAMHEACBIC@AAAKBGCCBEAGDAAICIAOGABEBOBGDEBIEGGOEKAQIMCCEEAECACBCAACCGAIK@CDCFGF@NCTAFALABCCEKAMGACBGMOK@KGAKACBECEGEIIOIEEACBIGCIOECSDCB@HIFIB@DFDAJBFFHABKFcECAQ@ACE@O@_CGCIBAJEJGBGHI@MHICCCICBQEEQLODCTIFCHCDGCMBaKCCO@UECAEJALU@EDGCC@UFUBKAUDCDKR@TIBED@RIFCBMEAESAGCIBWGQ@C@ACIEM@YEGKJIFQGCAMGI@GCImEOICEIEMCGBAHEJWHKLAHEBAEGEEIDGS@QNSBWAKDAAG@MGAEICC@CDIBCACEE@GDDH@BIFC@QEKFABBDCFDJCBCDIACEKNBFIDEF@DOCE@GLMIEICCEKEC@KAAGGUE@AFCAKWCSB@CACECACGACCBECOACKAiAeFKHYHI@YDCHCDBBBDKHDDCDYVEBGAGDEBBKAAGAeIOGiIIEC@ILIF@FEFEH@DBDG@GBCDAFMBORCBG@EJ@LED[HEDEL@BBBGDGAGFUCIGAIIEOE@GCAAGMA@EGMKECEBCIGBOCAEAGFWFK@IBI@KJWJMBEPCDGPBJOHELOCMGBC@ICCMAGBSAcACB@D@N@FGHBPFNBPMCG@GGEAE@IGECAFGBCLCBCB@BCDAFEBG@JPBJJFDHHFBFJHBHEHFDADDFFBDFADGF\\RADGBObJX@DCDBJBDHHGDINKDCD@BHBFDHJFBLLEBEBCDEFKDKP@FFFEFDFBFJFCHJBHCHFEL@FEBKRIBAH@FAHIHS@GCMNGBEBAD]HOHCDEBEN@HGJ@BFFCLBNGFGJBBFDBDCH@FCBABNJ@DDDBH@FCDBDDDHDEFFH@FGHAJBBNBDDDF@RDJAJAF[JBHDDBRDDRDVBDIH@`JTJJHR@HFHDFJTRBBLDFHBFN@bLBBEFJBJEDEFBCEB@LD@A@C@@BBDBBAAC@@FBJ@BADBBCBDD@@CC@BCJCFBBBB@DEAA@CB@DDHEAABCBAFBLCJDLCFDLADDHCACDADBCBDFD@DAH@BCFCAE@@HDDAACBADFB@@ED@BCBAJDDCHD@BCBBDFADBFCAEDAH@DBFADBFCFDDCFBFABACCD@BB@AD@ACBADDDEDBLA@ACABAACDAHDHABFBBDCBBD@ADB@FAAAD@BAAAGAD@BEB@DFDA@C@AAAD@@AGCDAAAJAGEB@FA@@CEBANDBEJ@DBHCB@CABALEAAAAFABCB@@DF@HDD@@ACCDCBAD@BDBBD@BCB@@BAD@BDADDFAFBBCDAACDBBAJCDDB@BCDBFGAEFCAAEBAAHCD@DBTEHGD@FDBACG@CLCLBDADB@CB@FB@DFA@AFA@CCCBCHB@AA@@AFAAAABCABCCC@AFBBGH@DEFAECDALAFC@DB@FAFA@@AABCICBCLAT@B@@CB@JBJCFEB@BDDCHB@AAABAD@DCFABCNABGBAFADDD@BACC@AHBFC@FF@HA@AACJCAAE@A@@CHCAACA@AJEVBHAFBBDDDFCJDDAACBCB@BDB@BCCA@AH@DDAFBD@HJLDHBFAHKHBJEFEBBJAFDDTLZBPBR@PCLEDGTADFBJVPADXAFFJ@JAFFFDFGX@DADBDAJHHDHHHFJAFEBEFDH@HHFAHBJDNBDCHCNHFBZIBA@CDCJ@HELBTGPHD@FF@DPHNCLBFE^FHARAXCRFfAJLHH@NLNCHBDFDNBAHFNPJDD@FADCDG@AHFFEF@DLFH@FFD@FEBCJHTBNPCHHH@HDFJD@RELKDMA@FAHCDAHEJ@JGDCDCHDFCFBDBDNH@BAFDD@BDBP@FADDL@BJBBFBBHHL@NEHKDSNGBEDBDADBBHFLBCJFBDD"],encodeOffsets:[[134254,47355]]},properties:{cp:[131.015584,45.771266],name:"七台河市",childNum:1}},{id:"231000",type:"Feature",geometry:{type:"Polygon",coordinates:["@@GEEIRO@M]CEC@ALC@AAESAKBU@QFGHSDQCAA@CACFI@AKMGAK@MCSAAC@GEMG@IEMJGDG@CAGIYQGAECKDECACODQLAFBJILOFI@EB[NKHUTMViRQRWPO@cACC@E[QYGICQCEGAKEDE@ICACGAEIOICIKECGMOISGDWC}HIJKNGFMDBJAJEFCNIJERGJBBARCBI@GDI@KFIJCFBDGJ@JUCGCEECNIF@DMDCH@HS@QFIAELAJGJQBaACDBJCBMBEBGAI@CEE@IABFCTCFCFIEI@IFEHIEEBSCGDC@@DCFBBDLDFBHDFABBFBDFBIJBHKCBDCDCCABEBAA@AC@GAC@AAC@EBA@ABIAEICAACCAADEABDCBC@@FCBK@ABBBEHKACFGBABMG@ECDGCGDAFGBGDK@CDACEDCA@DGBBBA@M@ABEAG@DHABEBEHCD@DEBAD@DCBEACBK@ABCBKACAACQGCCKAC@AHEDAAAGGCABCDGAEBCDE@EAMDENCD@HBBBHCBEBKFCLBFAF@FCFBJCFAFDHFFJH@BJHBBAFDFFD@LDB@FNBBFABBDGHELEFKD_RINGFGJBFHHEHBDAFBDADANBNBBADED@JBBEDEBCHG@CNEHBFHFHBDDHAFFL@AJAFIFKHCDBF@FCHEHCJBFDDLF@JRH@BCHCBUHBLMPAFEDADCBAHGDEAGHKBAB@DFHABAFCDDB@FN@@DDB@HFF@FDDHD@DDHGBLBBDFB@FGLD@@FBD@DHDAFDFEBEFAD@FEBHNHFCLDD@DMFADC@EDKBC@AGAAG@GGI@EEMBCACKGKGEAE@CDGCEAE@GBGSEMMUMACC@IC@GDCAEBC@EEACGKE@CGA@EDE@CGCC@GAICC@AEKACBAFEFIBKCAAECSHCBCA@IO@MCACBGGGC@MBEGGACEEEAECBKCECAIBECEBC@IDKKEEC@KCCCAOBOEGDK@BEACCCICDEEGBABCACEABCFCACECICCEC@ECI@CAGB@CC@ACCA@CKDIACCAFABM@CBCDCBADA@AFAHEDG@KDQE@FABAFEDINBHEBCD@LCD@HCFD@JFKAADBHFD@DH@HBMHDDADFJHBDDA@@HADDFCH@DGDABDD@BFD@DD@DDGFBDIH@BIFHDBFCBEACBADC@EACBFLOHBDEDC@EAAHCB@BDDHABDJBFDIFEDFBDHCDEBC@G@AA@CG@BBAFBDCFGDBHAD]JI@CFKH[BE@IGCEAEGEMJBDABGDKA@EEA@CCCAGIBIAECAECAGECEUDBDCBDLABA@MGACAEK@AKGAG@CAEBCGGEEA@EOEAEEGCCOIBCMAAAOBAGC@KDC@CACEDEAC@CDA@CDA@AJC@EHGEGAEGBG@ECCG@CFIJCBCGGHG@E@CAABCICCMEBI@KCYJ[AAG@KDKDGOFSAOBIDMBMBGESEaAMEICGBEBAHBFMCK@WBK@UFCCI@GCEGkCKCOBWFOAUEG@IDUBIJMDCAGGEAMA]B@QQISSQCYKU@CGKICE@E@CAG@EKAQ@EAAABGCGIAM@ECBCCC]GS@IDABEDIBAB@DM@IHI@MB@@BE@IBEGEICOBG@GFGRGJUBQHGBWMEMOEOIEIIGCEECAC@GDG@IJYBIOITKCKEM@IHADGJAFCBCACDE@AGACISGCEFIBIDGEAACDEIODMDIBGAGPAFGBCACBCFE@OBEACBGCABCAEDC@CCGAUIMGCK@IAGEAG@EOAIDECIIEAMGGACCCTe@GAGIICIGKAGEE@QHG@GEG@CE@EACCAKBSAI@ECY@aGGCEEGCEGICQKIBEFMJSDIF[BMBCFIDAFKPIDS@QEKKOGQ@QEWBIAWdOAI@ENAHBD@LULKL@NK\\@BAL@DEH@LCJ@DCHE@IDDLELGF@DDHCDCDBFUZQP@JDHELEB@DBDAHDF@BCDWHDHCFBDIHECI@IGCBWLFH@BQ@EACACBAHBF@DPLPDDDHBXCFBDDKBCDQnGH@FGFANBBA@@BAB@DCDGBEHOBKJDF@FHJDFRNBDB@@DFB@DD@JJHB@BJDBBJFCHIJGBIH_dMLCBM@IBYLA@@AAIAAOECBCAEAMDEFCEMGOB@DIDIEMAAJBDADAFHFFDMFAFBDILBFBBLJDFJBBDIHDDCLCBM@MDCDGBONMNIRID@HEDKJADBDAFBNGREDAB@F@FABBHABCLFFCJAPIBED@JMHAHBHADOPGNEBEHEFEB@DDH@DKDETKDGLABIBKFIAA@DHEJGFGDS^IHKDAJEN@JCHGPBNDF@BGJWDFHNLBPEJNDDDDJFDCDBDBJBDEHGFQBEFFRLTDJDHHH@FCD@HDD@FJNAJCJUPKFALEBIDRHNAFBH@LFBD@^FRFHKTEFAFCDGBILAFENBJCF@FDHHJBFADGFIBAFGDAFKBCFEFDNGDMXMBMJMFOBGDMJCLGHAHIHINUE_CQIKMCCMEKCIEKAQGEGEODEA@iOWAEGCOAAA@BH@HKHQFCDIHCHBFFLNDLB@BGJBDNJDJBDFN@FCDJLHBLNDFHF\\JP@BDAFGFFBFFH@HJH@LNDJZJBDLHBDLHFFZH@DIH@FDDJBRL@FBLGFAFBHBDAHPPTIJHDHBLDFPHAFED@FF@DHAFGP@BPER@VCHBNJXINDDABEDCFCPEDCHDNAPHNBHDJ@HDF@JDJJLDJ@FGHAF@FCLAFEJEJBFDF@JFFABEBCBAH@HBHAHDJADABABKNAFBHERGH@XDVJP@NAZDDBN@FBDHVTHJPJLFDHJDJAD@RH@RNT@NKJKDMCCBABEAGD@DDDLHHAFBFAH@BB@JDHJBJC@DHFFHHBDHNFDH@JFHCHJDRAFBHHJ@`DJ@N@DAJCD@DDDFLJFHIBIJBLLLXTBDaFNJFDHJX@BFDBAFTP@BGDCDEJ@BHCH@JFHNJDHHFCACBAHGNBJARDRHF@B@BDJFJAHDHCHBHFVRHDBDLHBFJCFANJJ@DFL@B@JIPCLIHDJBFBTACJEFGBEDGP@JEFFDPF@DKBCDAFFBDDAFEFICOAIHID@BBBFDBBMNDF@DADFDJBPAHB@BEH@LFHDJHBHFCD@HEHIDCFCNEJTFDBABK@EDCRQDGFK@GDG@BDADIFBJEDIF@BJFBHDBHABDF@DFDFHABAJCJHDABAH@JAHBDCF@@AJBBAFC@AHGPADDAD@HF@DD@JDDPBAFB@PBFFDFH@CFDFFBHAPB\\AAFDDPBBDNF@BIJK@MDGAEBGVBDAFKDCAGDA@MBAF@DADFFBHCHDDDJPBFFHBFFAJFD@DPBBJEDCDDDH@HB@DDB@FH@JCFETBfRLBHHBFFHRJL@BDABADKHCNPZ@HFNKBUBOB@HFTADEJOHGLC@E@ICKEEAGICAKDOAOMEFSDCDAJMFWDAPFLBHIF@NADKHCF]PMFJJLBBBJNHDJHZBTNFBR@LFJ@LFDDDAN@JEDA\\JNHHBNLJDDD@LHNADEH@DDPBHDFAF_JOBKFWJMDGFKPDH@DMHSHONDFNNBD@FBJLJ@HDJCFDPGHAHDFNPEDGJFFCH@FEBCFGHAJWBMAGBALEFBHAFEFDF@JIP@DBDPNCHRRZFHCH@LGNEDBJFFBHCFCLKJGLCLGD@NFNIH@@KFBfEXRDDLBLDDFBDLJH@VH|CNHJB@HEJFXKBADCFCJBDJFFFAL@FVBZHRBXNFFQHCDIRALFJCJ@JBFFJBJL@LCFAZL\\@XDTCRN@BCHIDM`HHHFNFR@NDFCPENGJMRHBFBJDJLLADGFBBLDPGLDXAPCFN@LJATDF@RJ@HHDFBJCFFHBBFDHDFFBBBEF@FCHGJMH@DFFHBF@RJH@FDADFFABMH@FIHNJRFHFF@BCJCDCJCJAFEJLPFFF@FHFLED@DAJ@FEJADCJA@A@GJADEFABBHDBHNHH@HBJAJFNBPFHHNFD@RILBJBLCZCHELABEFCLHNDBHFBDJCFBDAFKPBDCH@HCD@BNLL@@DDBAD@FCJCVKFAF@PADNJJANH@BAFBDBDHBR@LEJAJBBDJ@PDBEDABCDCDGDC@CDCRE@CFADCHADIDCLA@AFE@EHCAGBETA@CAGBCAE@APINDD@FATJFBLKHAFC@GHKHBLLD@FCJ@JLBBFB^@FBHAJ@RJNCFBHCHDF@@ABCCA@EFADCHCHGLHLBJHREJDF@BDBBDAHEJADD@DDDJFFHFAHENDFADCPGNCJANGHANMHDT@JGBG@EBGJALQFA@EFKGEGDIADGIEAECEFEEE@ELOLCFEDCFAFAKKEAGIECGA@ADCLCJMHCGGACAIDC@CIWPaHABC[QHEBCCEEACEBCECFGAGIGAEGECGIEAIIOH@FABEDC@ADADADKHABEFDJHF@FBHHH@NDAOEMAOJIAC@K@CBAf@TBHANBDD@JADNHPDFKPGAIHODCHOLAXILIJ@JAL@XEJEHDAPJHADDFLFHN@FNBBHDB@HPFJFBJJHVDHEHBHCAA@AFKFC\\GFC@KFIH@DAPQNABEDCHAH@AC@CFGFE@EJEJKD@JFjJPHfJHBBBALFAHCHBFAZUDCCCLGACAADCDGZCJ@ZGLGfEjBLBBDDPAFDDHBBDFDBD@DTAXDBLED@BVFHHBB@LFDFLDDFJNJHKF@PD@CFEJCAELMDFJBDCDACIDEACDCLCRFJCBACIEACICCACAGBOEEGYMCCMACEAACCKACJGCC@AFG@EDEBEFOAIBKIAACIA@EEKWHG@@DC@OGI@ABAECA@COEEE@CCCMB@GEGCGBEMBKCE@ICG@AA@CCGLIHQIGBEAAGGIACCAA@IG@MGWG@CEIACKAKIAECEEBQEMACCCKKCIDEEIEO@GCIOICEQBC@EEKCAACIACCA@MDEIGAACGA@GCCBCGGEBEIGDIBICBMCCGI@GCA@GGEAEAA@GCCFEBCDCAE@EFCBCHILCIAAGFG@KG@CAGM@CCEQACGCECAKBAGKGAGGKMG@ADACMIEAEDGBIBELEBC^ELGBIACDABIGAOAKKKESGKGE@HEBE@AIEAA@AFCAAOC_@GABICE@AFE@ADCR@CEDIEE@ABCHABABECKBELCF@JHDADGAADCAADEDABCDAAEBCZEBA@EFCZDHG@MDALBH@DBDBAFDFF@JF@JDHPDDDLBDDJBDHH@BBH@BFFBHHLADFRHVEACJOLGLCPADADECIBAfKFODCXABKFGEELGFAHGBCAADC@CRQ@AGGFEBCFABABMFANGJCHINFHFFBHBHAHGFMDABA@AECHOJGFAFANBNDV@NBJFXF@BEHFV\\NFDTDFDLAT@HAHABGDCHAPIR@JDJCFBNDF@LEPA@@KESCBGHI@GFCRK^OVQLEFGPG@CGUDIAODCAEJM@CBCCEDETIJANAFUBCJALGJQCGA@BIAGNKEGCCAEBEJGDARFJ@FFF@FEH@NEDGNA@KAC@CRKAECCGGBEHGNCTDFCLCLFFHR@BAAEDG@CDADEAI@QFADE@ABALENCJE@GBCECBEEE@EDABGAKJMNKNJD@@AŊεÎȗGAKDIAGGGA@EMECEM@KDGC@IGK@ECCG@CAACJIBM@IBG@AGECAEDIGFCCEJEBAAECAACIE@CFCFGCG@CHEFBDAHGDAFBJSIIBEAEBEEABGCCDEAAGC@ADA@AEE@ADC@AECBECC@CGACEOGBCACEACEBCEIDEEGBCF@BCCCAGCG@CCAGAAGOKGQCAAC@ADANBB@AGBE@CDCBOGGMABGLG@CACOCAADINAFAICCCBEPABABEACOCAEHAPFD@BACEKA@ABAH@BKBEDG@CAAE@MIGACG@EDCTIBEACCCGBGAACDCLCBAAEGAGGFGAGFECEDG\\KHMAII@ACDODCACCCAC@EDACEDECCWC@ECEBCDEAGGKFCFAFCfEFCCCGAGE@IEGFE@AG@ILGDGBIA@ADEJEACCACAQFEAAABCHCDCBUAEACC@GDKBGAACVKFE@EAC@ECCACEOJ@FABAGABCJDD@B@AGBABAJ@DC@CJEP@HCBEFAHBDAHCDINEFBFCDGFAJ@tPDA"],encodeOffsets:[[134456,44547]]},properties:{cp:[129.618602,44.582962],name:"牡丹江市",childNum:1}},{id:"231100",type:"Feature",geometry:{type:"Polygon",coordinates:["@@B@@C@@AGBALIVSNE~DZ@¦BH|KXdUpDNz@DBF@RDè@¤PBPDPHDDH@JCFELAZJAFEFAHBLCDBDHDHLHF@DCD@FFFBHFFZFVSVO e^M~wdoXgÀïLKHEjS|OPMDQ@KAKKIKMESAMBiJ_JKHGZ]TQVITafaLUAKIeGSCCIGsUKIM]K]O]KKCIAKFIRKRGRCxCRGLIDK@gFQCIGKMKU[MSMe@IJQjk\\[nWbGSTA^EdIĊgVALCFEFM@WCUwQ[omkaI_AJUbaf[PSHO@McÃAiFeFGTYVSRKfMÒMLAPEJGDGBFNYNO\\W`O_ZKNK\\_LSROHE\\IZBzOzGVBZD\\RVJHBR@pQLB\\Hi_^IPAN@lFfCfOxGjDZJf^LDHV@JCeXCLBTJNJNRHHD\\BDHFZHrD°AbB^DfHZJLBbBOGvWTGhIpEXIJ@XFDFDPJXDDNFnCbGhMFEFIHaCOECUKWQmcQSCO@EFKLOfOZA\\FlPjJpHbCPCibM\\A@^E\\_jRuLOTO\\MdGhAdBHDz`PfJdD`AdGJE~mWA@@BEHGBAAAIBUNAAIDEDIBEFGACBADGCADG@EB@BG@ACBEAAKAMK@EDKCEAAEHE@EEIAECI@AGIACKGABEMGBC@EHBFII@IGI@CGAEJIEA@CBACKC@EEBECG@KLIBEAEBAPG@ECGBA@CDA@ADEDA@AFQHAFEFADGAGDA@EJCGCBACCDCAAHIHADE@CBA@@@CFEB@ACHCAADA@CAA@CJC@AF@@CNIDIAA@ACIGE@CBAACECDGBKAEAEBC@QBC@AEMDiCEMECG@ECC^HXCBCLIBGBCLEBCBGDCLETAPMJCJ@JCJBFAJBLCTAXED@DBNAFDFAXDJ@NCJBN@HDH@LFJ@FCDCNEP@JEDA@ED@DC@CACFGCCFA@A@ADK@CAAE@ABGEBAACDABCAABCAACAAEBAEEDACCBA@ABADBDA@ED@ACD@ACBAAA@AD@BETGEADABCDAAEAAGA@ACA@CDCCABADCD@ACDANDB@AAJCV@BAAAB@B@HEFBNABIBDBAF@HCDBBCLCFAHBJAIACCOCIGAE@AJ@JCTMDGC@QCACECYACGDCXBHCLGACIAGEAAAGDGPAJCBG@CCAEAOACD@DCFC@AADG@CA@@CSKBCEGEECEMICBC@@AI@CCCBOAAABA@@CGEEE@AEECBACC@EDEFEBCSDKBE@@AC@GABCJBFCAADCICK@CDQDC@@BABE@CBKE@BGDABC@IFE@KEBCACE@EDCA@ACA@BCB@B@BA@OBBAC@A@DEAAC@BAACB@CABAC@@AEB@AIBG@@AQFMCC@@BE@@AGCAKCADAEIGCKB@DBHCDBDEBEBAAE@BAA@ACCAE@IDICGACCKEGE@CA@@GSK@CBCAGNOBGYM_@_FSHQNUBIBKHSGFALKFC@AEEIAQ@EOAEREHMD@TOJMLENKHKPKFIJIHCDEAGAAEAS@GEEAKWCAMCGIFABEJGAOCGDG@GU@E@EGOAS@OGO@Q@GCCIC@C@ED]HIDA@OGKGOCA@KQ@KKMKGMC[AYICADCBMCGKEWCDM@CKMEGUKBCDEBQFCOAEC@EBEKGCE@EDILKG_@EEMBCAESMIMEIEC@CMAMG@E@ALC\\EHCBC@ECCMBGAACGIWGAICCIAAEEASEEICCHCDGGIGCBIDE@KCCAA@GLCAE@AFEJABGQGe@GCDE@GCE@IFELADCACCIDKEG@EDEOCMHKIQCKDCDCEWGKOBG@CCEIIWB_HOHBH@DG@CBG@C@DB@DADBDCD@DEDCLEBBFCDOHCF@JGH@JAJDDGFCJBDNDQAMP@DJDBBA^AFMD@BBFLDBBCDI@ABENMJ@FCHBRABILMJC@YAGEECGB]NSCEDM@EDABUIEEM@SGIDM@QEI@KGEABGECICE@GDE@ACAEKEG@GHEBECI@EGG@ICMDOBODGEKOEA[FMAGDE@OKeKYCMGACESKQECAGECMCGCACAKIAMKEOBIUEEEAEGE@ADAFAEM@IBAJAAAKIK@EAG@KGCMGG@CEEDC@AKKAMGE@CAAK@GEBIJMCAIG@ACCBCFGAECEBEAGDAFADBDAAGLKMGGMKGCICGK@aBC@I@GC]PSFMEKIE@GDC@EACECKAAG@KDI@AAAKUCQBGASIIEIC]KGKDOCSQGIBAEECOCYMKDOJK@UKIIACDM@ACCKCC@GFOBEJCBYNEBG@BECECCaBKHKBMEIBGDCDIJUJAHAHIDECEGMGQDSKE@OCIEQCMGQAOII@CEECYEEGCCACIECABEBC@GACIEAGFCX@BEFCBILGDG@ACEKGCCEA@ERKNOBECIDGBCLCCCUG@CJI@AQKSFC@ECEG@GACKDQACAAE@IJIAECEkKKCKA@GDCEEAISGAA@EDECGBODEHGDEACK@ACCEAAGCCEGEIICAMCOAMC@GDECEDE@Ii[]ICEKGKCQ@GOECEIIGCD@DEBBFCD@BIE@DB@BBEHC@@D@FABA@BBAF@DID@DC@@BCBABA@@DC@@DABDDCB@HB@ABBDBBCJBDADAFCD]@MASACEGAY@_E[GQ@_HQ@QFEACACBIE]AAEGEUAGCIAAEBECAM@QKQCBCFAAAKDKCIDGCC@GCK@ICGAGGOEMGKBEACDCADAE@@ACBC@@@A@CD@AABAAC@AAC@@A@@CBGBEAG@A@DBC@@B@BE@CAADEA@AIB@CCB@@K@AAC@BBABBBC@@ACAC@@B@BB@BBA@BBCBAAB@IA@AA@ABCEEB@BAACDC@ABEBB@EBABB@CB@AAABAC@BACC@DABEAAAB@CAOBB@CDA@GCG@AA@AD@CA@BAAA@@C@AACABAAA@ACA@BBE@B@ABAA@@@CC@@AIA@CMACCCBQAABBBA@B@CDGACBA@ABAA@BKFBBE@CBI@EBCAE@@BKCB@CAID@AAA@ACB@CADEBE@C@AAC@@CC@GD@@DBCB@BEA@BB@CDC@ACA@ADCDC@@AAACBKAA@DBABA@@BCCEBAA@BAB@@EBAABAC@@@AABAA@@ACADAEB@ACABACB@AI@AAFAACABAA@BA@A@BBI@EBA@CBC@A@@BE@@EEDCECBAABAA@ABCA@ADBAABCAB@AE@@AABBACAAABAA@C@ABC@@BCABAIAAAC@BDCACCFACCG@C@GCGFICDAAAGBACC@AB@AABC@E@CA@DBBA@ACCBCC@BC@BAFAACEB@EA@A@@BE@EECACC@AGBG@EBAAC@IAC@@AMC@ACABAOCCCBA@E@CA@CBEAI@AABA@ADCAACB@AA@@AA@AA@AD@@AG@CB@@BCEBC@CC@B@BA@ADA@@ACAAAE@@AAACB@DAACBDCEAABACEAGBCADA@CCB@BGCCBE@CBBAIC@AC@K@CFAA@ACAEDIB@BC@@CABG@GF@DCABDC@BDGA@DGB@D@BA@IA@AEBCAE@EACBABCACB@DCABBADCAABK@BA@@I@CBAAADAACB@ACDABD@CD@BB@@BB@@BC@BBA@DBABBBC@ECC@ABEAAB@BCCABEAAA@DC@E@C@ACIBCC@DCAC@BBC@ABB@C@CCC@AA@ABA@AA@BAAAB@E@@@AAB@AABCAAIAADC@@BA@@ACBAAEBAAABAA@DC@C@@ACA@BBBADE@IE@DEA@BG@CA@CCDAEABGAAA@AIBAACB@AEADAIABCCAA@BBABACAB@AB@@AEB@BECABBBC@@ABAE@DCEB@AA@EEDAC@AB@A@AIA@CBADB@CBDB@AEFCCABC@AD@CA@CGCGBOG@ECB@CDACCCBECACCDC@GE@CABCAABGDCABAAEC@@ACAAEG@BCI@E@@AECABCA@DABCAGEGA@ECEEBKGQ@AAFA@@E@ACCABCECI@BCGC@CC@ABECGDCCCDAAAECCBAE@EACEBA@AHA@@CAAEE@AA@C@ADABCECBCAADAACC@BACA@CC@ABAAAIF@@CDACAEBCEBAAEGBACEBC@@AAAAEA@@AAAC@ACAAAFC@UAGD[\\GjJBCTB@CCDGlLDIRA^FJANDLAN@ADDBBNCRARBFGP@LEPBHABJF@TVRFBHA@DEBAFLB@FADLFDBBHHF@HDHAFADODEFAH@FIB@FDDGFAFKPGP@FDBH@BBFBGRBBJABBCLFJ@LBDNHDJPJFHHHbNBDTNSRFF@JDH@PFVCH@R@FK|LDAHQ@BFEV_AUV`JKTFBEPV@EP\\DCNCBCPjFnHNHBBAFDD@BCBI@MPUFCBADOD@BGLKBCAECEBCHBFAHADKFML@LIL@FBBANSLCHCFEBIJICADA@CCGCGBCB@BD@ABOA@CAAABADIDGACFG@GBK@@ACCIAEBIEACSECBBBE@@ABADEGA@CEBECAAEBBDEBEAACCBK@@AD@@AIDAABCECGBGACBEAAAAKEGGBBDE@CB@BIBCACBICC@BCCBG@ABFFEBK@BDGC@A@C@@IACB@DC@GHEEG@CCE@IABDA@ICEA@CEB@CCDACCCCBEADA@ACBE@C@@@BACCFABADB@AKGGB@ADAAAGACBCAABDBE@BDE@AB@ABAGA@BCBAFG@C@@CE@@FC@ABEDDBFAABDBBBDABBA@ADC@BDCHA@@DDB@DABBBA@PD@BD@DF@@@BAFCBBFADBDADBDADGBBBBBCD@DD@ADI@DDABDDBDGBCFOFABIBBHCD@BCF@FIHADIFIDCBAAEBIAEBG@ABADKDAFGACDIAEDqCEAI@C@CEEAAAKAEBG@ID@DABOBUHI@MLI»GGAIFCJEBCFQ@WPOBKJ]BEAGAQJ]HUHDne@cCKJuRg@UHMBGLCRGDIROTCPENSDB@DCTI^OLGFAF@DDDFDHBNCHGEPGD@LAFA¢C@GAQDAB@BFD@BEBEABCACEASDKAIDIBEAACI@KACFIDIBADEBA@CCEEQCAFR¢^BDGKQnCRITENZBAD@BFHFHABEFBNAFBL@NCHAFBJHDHDBNDPBBDEN@DBDP@NEHBFLBFHLbCPBeèLALCF@FCFFL@RFAFBLHFTD@RFFBBCDCHKFAD_JePIDQVEFYPkRUNMLIPELEBIFELBL[AQDCCAIGGAEOGAA@EIUIKDCCCUAkGcqA_ZQFQeQSsG[MG@AAAAMAUYIIIM[MGAO@EQBEGA@C@]GEAOCCI@gDKJO@GBĕÅ·A@[SFCDQAQTQRGEICSAAFÃK@HAJYBKFKTIDMNADAABEJI@AGCE@CAMECCOACCKFQDMFARG`@PBFCNBDEAEBEH@BCBODAFCBQDIF[L@FCDE@@FEBBBGDDBF@DCFABCD@FBDAJBB@@DTBEnBBBDDBNVS|kKeNS@CDG@Q@MHGAI@GAGEEDM@GDMCSBOFG@GDUDEFGDGHE@CDKFOBIF@NCDeF]@KCuKa@HL@HKN@LBHFHDHDRHHJT\\^LFJBPPV\\FXDHJLHDDDCHIL@HHLJFHFALCLet@FBHHFJBP@PBNHNJ\\RJNFZHdLLANCvYTGL@TBXEJBVNLDNBZ@RHF@HADE@EMOAEBEJIbILI^GZCZK`GFBJNJJFDND^BHBDB@HAH@LDFNLPHJRHHJFH@XELBPFDD@JGXBDNJBFDZ@VIDGBIDCF@DLH`BDB@FABQ@GD@B@DJH@FCDQDAB@FHFPFLPJJDFRPTJDDBFBHQHCH@BDBPHDBBDEPJFCFMFADBDJDXADBAFEHADBDHFBDBDCJGH]LCDBFFL@LAJDRGXBBXRDH@HADIJSPIFWTUL@FDFVBDDDFGJCBQ@IDAFATAHOREL@DBDD@D@FALIH@JBDD@DCJBFAJAJCHCBM@GDCB@DBBDBH@TJDDAFIF@DFHDDBDCDMDEAKEWDEDGP_VQR@J@DK@CCAICGECMAKFKHEFALAFKJAJAJCJ@RDJFHJDN@jIRILINEFIBCGOAG@GLEJDHLCPDFfdDDBFGDJDDJPABIF]JCDHNDFXRBNDDBNFJ@FEFEH@BHHBFAFEHOJaPIDKBMJCDAPjHtDVJJH@H@D_TAJDDDB^AFFBFAFCDMVMPQJMN@HBDVPBB@D@@SDQLKBCDCPQJIJADBDLFhDL@bGTXDDCZ@HDDRH\\BXDLDLHNNBJARCLGFMHGBUASDKHCDBDZBTRVHHAFCHKHCVEJ@NF^PBBAFCFEDGB{DODO@IDGFGF@DBDbR@DGFUFMAGBGDCDBBfHLJ@FGJANFDVFVPTBBABE@OBEDEFCNCBBBD@HBDCH@D@DDBJBP@RCPDd@DDBBELEBW@GDSLUHEJ@BHDH@\\EFBRHDDBHFDB@DEDAJ@BBHF@JIF@NBHJJF@bGVBNJXFLDJL@HABWNAD@HBBJDHALCBALCNDAFEDWNBJDJ@DOJCFDFNHHBLCDAHDBB@JGVBRAFFDTAHBRVPHFBLANCLBHBAFABM@QDCD@BHHNBJAD@LDJD@DMHKLADBHDHFBT@NDNL@DABEDMBQBE@EF@F@BLFTFB@AFITILENUF@D@BTDFDBLHBF@PEhWLEPCHBFDBDBD@DURELKNCNBDBDJ@dIDBBBAFGBK@CDBFFDTFtEDIFAX@FBXNDRLVPHHHD@LCF@PHHBNAJF@BADEBICI@IF@FBDFDLBP@FBVPTVHDLHPBXGBCAGCAOBCAACZEJBNHBBCFBDDBJ@FDAHKB@BBHFB^CHBJDHHDJDBLBHJD@N@D@BDCNBDHBFD@BAJIVILCN@HMJCHFFBHDR@B@HEFGBKHIJCJCDBNTNBJADGDCFMHEJBBPF\\FPHFHBJDFBBBDEHIFYFCJEBM@UJCD@DLHPDBD@FIHUJM@KEODAB@BHLLHNLFFdLLBHAHGHCLAFAJGBEBANCJBDFBFFN@BMLCDEBCH@FHF@DABBDJBRENAH@NFTLNDNJABCDIFIFBFFFLDhDLBLHHN@HDFADKFAFQFKDO@ABBBJBP@\\IT@ZBNHFDDH@DYHABPLHX@HDFFDBFH@JAHAFE@CAIAC@KFGFCFG^@BBALHDBDCFIFBHAHDBFAFBDDIFG@@B@FBBD@PCFDDDFDDADGDEBAPBBB@HQNAB@D@FHHLBZGJBFCLCND@BL@NARDP@XBxnF@AFFRALAFIJ@HH@LALBNCBCH@FBDDBDH@NC@@ACDANAJLJ@FDDHRDBDF@DFJDFLJFDDTDHAHBFANAJETEfArBXCLEH@HCAKeyXMVBTBHANG"],encodeOffsets:[[129487,52149]]},properties:{cp:[127.499023,50.249585],name:"黑河市",childNum:1}},{id:"231200",type:"Feature",geometry:{type:"Polygon",coordinates:["@@@EMEA@IACCE@KAICACAC@EA@GBEHG@EBIUBE@CECFC@EBGTGEIIBGEGAAEECS@ABCLEJCHEFI@EAGKGEIACCAAG@GCEBACQA@CHEAMDEcGIBGBE@MDEFAFBBAB@DABYCODOLAFGFIAA@K@KFG@EBKCEAOJ@D@BK@EDCAMBCAGBIB@FIB@DABM@@DEBAFE@AFCDEDM@EDEBCD@HEDIBCDM@EAMCMFAFGBCAEBGCCAKBEDCAACKACB@FC@IBEJWECCCEEDGACNIHGFETKHEBICKIQDKEQQGMI@CAAACAGBE@WKEEMGEFMBEOI@CCKBC@CA@E@@AEECQ@CFEAEDC@AHIDGAEECDEACA@CCA@AGGCICAGFCAGAGGEAEBMGIAKIK@AIAAG@EEC@@CEAIGEBGII@GAUAMPYLI@QFUAABMBCHMLAHDB@D@B@HADC@ADBNADAFBB@B@LFFCLBDCDHFCFBBEDAFGBGF@B@HKJ@FCBAHIFAB@HKAKHMBKJGDCF@DEBEDM@CAG@AAED@BCAADIA@DC@BDCD@DEDBBCBADGBADCBBBEBABEBEAEBG@CBKB@CE@O@IAKFC@@BEACDGBIA@BCAC@AAA@AA@BABCA@AIBAA@@@AI@@BMAECA@EEBAAACCGAEEABE@AA@BC@CCC@CBA@@CAA@@C@BBC@BCCABAC@@AABCAABCAA@@ABAEAACBACAEBBBCBBBIBADCA@DGACAAAABC@A@AAC@@DCBAAID@A@AA@A@ABCCCDG@@ACA@AEADAC@CBAAABA@@AA@@AG@@BC@@BE@CAABAACBGE@DCBCA@CCB@AAB@@@CAA@AABCAABCAA@ACAB@EAA@ACCABGAGAACC@AAAB@AD@DEAACBBCA@@AAC@CCACDA@ABACA@ACE@ACGADAAACA@CIBEAA@BBA@ECC@AB@BA@MCEGEBBCA@A@@A@CBA@AD@CAFCACI@IBCA@AEBCAAABABAD@AADAGACCCB@CE@@BEA@DABADCBIBEEG@ACE@EC@AHACAE@@C@AE@IDE@CACEAB@BABCABC@AMBACACF@CCFCACBCM@ECABCACABIACDCAAGAEIMAECKAEAE@ACGBCCIC@CC@BCEACEEEG@CAGDEAIBMCCA@ACBACI@CEA@@BA@BCCBAAGBECAB@AA@@AEB@BC@AAADCABACA@AIA@EBAIEICBB@DBBABICCBCAE@GGIAEGGFC@OKOCDGDABGCEAAABA@AAMAAA@CC@@ACBAAE@AFC@@CCC@AD@EADCAABABBAA@ECBC@ACEBBA@CC@@ACBBECABCEIDAC@GBBED@@EF@ACBAECBAAABAEA@@BA@@@@C@CB@EADC@@BCBAAD@E@@BA@@ADCAAD@AAD@ACEA@BA@BCCB@BA@DE@CEFI@@CC@@DBB@DB@@DE@@ACA@CADA@@CDAE@AAABAAB@@AAADAAAC@DAEAAAABDEA@ABAAABAABA@AEDFDCBCA@BAAA@@BDDABF@EB@BACCBAA@DAADCGBBAAADCC@DCAAEBBBC@BBG@@BDBA@EBACCDACA@DAAABCA@D@ACDBCAF@BAEACB@AFACACB@BA@@ADC@@C@ABC@@AD@@CEDCAAB@AFAAAD@GABAAAEBABEDE@BBC@@BGBADE@AACBG@@CCA@EEBDCI@@CCC@CABEGG@@ACA@CCAEDDCACDBB@ACC@DAK@BAAAD@@AEBEA@AB@CAAAC@AACACDA@ACMEAEABCA@EE@CAE@@CFAAABAAAABBCC@DAC@DABB@AGAFAFBBCC@AA@BA@AADAAABAEBDCG@BACB@CA@ADBBA@@BCABBEABACBACBAE@@AFBEABAEBECEBBCAIIBBEDAG@AC@AABA@BAAAB@D@@AB@AABAEDCGCBBBA@EADEAA@@EC@EBCA@M@EANqCCDA@ABCEAEOFKACwu¹e}i{G]DCB@BABADCGBBACA@CGCE@KD@GCABCBECIACMGACFCEAOACKMBEB@EgBIDILCCC@C@ABAAEBGAABGA@DE@EDADK@CCAIOACCBKEIMC@K@CWEQAQBGHIBYCE@IDMLKHMASAIFGAGGDGRBFMIABCG@A@SA@UIA@LKASN[TgQABT[TSXUj_@AX_FK@MEA@AFA@CEB@OD@GOJAX@FBBJNAHLtE@AM@@CRAFFH@HBV@@KFK@EJ[@ACEC@EGF@AEJMBSJQHGTE\\MHCR@DYWUC@BMFCCEG@BOMEBIECAEBINGVGHGCIDIAGEGUEAAACHADOHIDKASCM@GCIFKFAGG@KBCHE@CAAE@QNKDC@GMEAI@CBAFEJCTCBG@eIE@AEBALGBCCCIAGCCE@ADAJ@FBBDHF@MHO@E@MECKAKGKAE@OJUFyAOBEDEA_YYIMAGBCDIPIJEDG@OCECCI@KBGAEEEICkEIF]RODaHGBKVIJGDID[B_AWGEGDW@CIK_SCAKBEAAC@CHCGGAKHKPIJI@GCGIGOGKAOBIH]F]AOCEMBIBERO@GEECMBGFI@AACIGUCSMIICEIWCIEGIImUIMAIGGKAIFSPW@{UGAK@KFS^KLADEFAJKNCLCDAJDFFD@DHDCTAHQZ^LADJJF@@HgHYHHJSXEJ@HC@BLPDORC@@BI@EJAJCJL@DGBFBW\\AAHATF@CRPD@CHBRSNRPEFHFACEZIPNNFEFVPDAB@GLSLDBIFAAOLMPITQEEPEAGLIFuGEd@DBHVBRCBEhFFMTDCV^DIpCAL]K@DORGNLNABFBDDC@CVSAMIxǡa@CIAIKQ@CN§GƼS¢IXId}GOZIKtAD]LUC@FK@AJSDMXSGz[AKHWCETW^@UB@JfDDAKKzHfDDABFBDG@@tFLwgK_MQaIiEEGAcSWE[WARMFGF^PLLQXMAZDDDBRDUbEBc@aCUEYKKBGSBEoOg\\SJnHNFFDCRbDCLFBCRRJ@BBBE`KTMNBBAHGPG^DP@NBJHZFLRBRGXA@APl\\pDJ@@@FELXBN@MBGTD¸CCHGJZB@BF@@LVIEZOXFJRT@PCZUPWBDLED]BBHYDADJAVF\\JKNH@@BFBFEPBTA^fER\\^\\FbSäFPI¯{ADKF[NDFID@fItNLARGEEVQ^XB@RLBDD@HL}jPLUPJFVMFDUNDDXOBBJENBBF@BGDBF@DQLGJKJKAUEYH]KbLJFDpLOVSDQLPFFDNXIJEJCPCBGVGBEHBDJDPJBDAJB@EHaNcfOLKFGF]dZN\\DFABMFCJ@RFPBHAHGLFFCVLREFFDJFFCPEH_TEFMDMNSLSDMBEN@XSDOEC@CBMRCD@JAJABYDECONECWPVTDDNPJHHJNNbKHBDDaPHLDBDF@bUNYDW@BFDNÆĐHLDDLRÊ¦FCYB^s`²PbPbdpZLBŞÚAÊND LîP|HF@EBAĢTĒV`DHŘXFTDvBbFĎPJVBH¤HBBABBBGBF@AFC@ADFFFB@LJFBABEB@BBAD@DPBD@BBAD@BFBBAD@@DEBJDFAB@CF@BDDFABBAHHF@HDFGB@BJ@AFF@@D@FB@FCBDADD@FDFCB@@FGB@BFBBDFB@DFDBFCFA@@CGD@BA@DF@DABJHB@@AFA@FBBDBADBBHABBB@BCFDDABDH@BBABHDGH@BEABFG@AB@DA@CBADDDADBFCDBBDAFDDABBAFD@ABDDH@CNBAD@BHFBEHD@FJCBBHADABB@DD@BC@BDDB@DBABBCDAHEAABFDBFCD@@HB@FFA@BEFDBAFDABDEBABBBBCBBBDBBADF@FD@BFAD@CDCBJDABBB@BFBBB@BB@BFBB@BD@FABDHABFABDFFADBCB@DE@BJBBBAD@@DDBABD@BDCBBBADFDADCB@B@DBBF@BFDBGB@BABBDBBFBF@ABDDBFBBDCDDHCFDBAD@@DHDADJ@FDADDBBDF@@@EBBBR@LHFADF@FHBHFDBBA@CDBBAFD@BF@J@ADH@BFDB@BD@BFABDBHCBADBBA@DHFD@DCBDFDDADDCB@DDA@FPHHAHD@DDBC@@BADDBEDBFA@AC@DCAAB@DJB@B@BBAD@CBFFB@@BFACDF@AB@BD@AABAFD@AFA@BA@@BBABDBAAAB@DBADJBCBFB@BDABBJA@BBBHBBABFDC@DDBH@@AFB@CJFF@BCAA@ADB@BD@D@@CBBBABBFABBDA@BB@@AD@BCLBAFBBA@BB@@F@A@BBABB@@BAB@BBBD@BDF@A@BAD@AAD@DB@CDDJABDD@F@D@@CBBFBBADD@ABAFBBAF@FDBAA@BACAB@AAD@@AA@@AA@@ADCC@BADC@BDABBBCBBDAJ@@@ABL@BADBBCAADB@CDADBBADAFBF@DBFA@BJBB@@A@CHA@CHBACD@ACDB@CHEH@BA@DD@@AJAFCDB@BBBDEL@D@@BJDABDAF@DAHD@ADA@DCBDBHAFBBDBAFBCDDABB@CDABB@BF@BBDB@BB@BCB@@A@ADDD@FAAD@@DAH@@BC@@BBBB@@BB@@BDABBCD@BABBBJ@FBDAB@@D@FABDDPDABDB@BND@BD@JBD@BBFAH@HA@BDDDBFFF@@AB@B@@FFABDEBABD@@ADDDABDB@AA@CDBF@D@BA@BBAD@BDHABBCBJDHEHDD@H@DDEBDDDBACD@BBJBABDB@AD@BAD@B@ABBBDBABBA@BF@@BBAADBBCA@BDBBAB@ABBBDADFFC@FF@@AB@D@DAB@FAJ@AAB@B@@ABBBABDEBBBJ@@BDAABDB@BFACBDB@BB@ABBB@@D@ABBBFA@@BA@ABBFADD@AB@BACAB@LBDABB@BD@DCBCB@BDD@DCA@@AFB@ADACA@@HCD@@DD@BBD@F@FABC@DDA@BBB@BJCDBA@LD@AF@DBFAJ@DAF@AALE@ABBBAB@DAHBDCA@B@AABARBDADDNB@DJB@BD@@D@@BBBAA@F@AAB@BDB@BBBABD@B@DB@BB@ADBC@@BBBH@HDB@DCA@PADBA@BBFBBA@CDDABD@ABBB@BDAA@BAFAA@FABAD@DCBB@AFADFBAB@@BJBA@BBDAAAB@AAA@@A@AD@DB@BD@AABAAAD@BBL@@@DA@DJA@BFBBCDBF@@A@AD@CAB@H@FBHADA@@@BD@BBD@BBBA@BDCB@@@D@DA@BF@CBDBDCFBLANHPFHHHBJDL@HDD@HDJCLDLCBBEBADRDRLN@DBAFBFJBHDVBHFBF^BJFDADBFBRER@`GR@\\H`FZ@HBDFTBNB^@DCBEBCACDIAAACBAA@@GDACCBA@CD@@CB@BADA@AD@@CJC@CBEAAB@BA@E@CD@FGAAA@@CJF@ADCAEFA@CDCJHFJFDHPR@LDLHDF^Jj\\@JCFDFCF@HNDPBNDDBJJHFDFHDBBDFBDL@BDCFGHCFAPDHCF@FBBTHBJFFCD@HLBLDlLDFBFIJ@JBFDBRBLCBD@HFHFDD@TERL@BIJ@DVHDDKDADCHDJAFMPQL@FFBDDLHDF@BCHKHAJEDAFW@EDBHLH@JCFBDLHBDLNXDFDDFJ@PJRBNHRDJFPDF@TLRCNHFHDBLABGBGVIJIDCHCJANFLALGbADDDFAFH@FAZMDAFIRAHEJBHF@BCNBDJJVLL@PITIJCH@NEL@BEFC@EAEFGDARFFCBCEEICOGIICIBGDC@GJGBCAGCACEEC@C@GDEHGHGACKODG@EHGAIAC@QEC@GFCBC@GDBJBLHNHJEPCJB\\@NCH@LGFIHCBCXSFAHATDL@NBJDD@PKJ@NKREDEBIXKBA@EBANCEEAEFGFEAE@GFCHCFICKFGCCGCEQ@AHIBIACBECCI@ONADKFEFC@]AOBKCCCMICIAEIIJKAIFG@ICCKEIMGEGSBQAEDIAGDDND\\KdKJARKDGBIDEAIDEAECAWEGEEEAGKG@IDGKECC@ADECCBEPIO@SEGICI@CFCACBGAEFCBGDCTIAAIKCGDMHEDKHGGCO@OCIBEAIGAGACUIEGAC@ETGCGDIKIAGCAWEKKOCLADADGAEPQNFZAFADEFANQR@LABGPIDE@OHQB@PBDECG@GBANGZCHEXADAFKAEDI@CEGBIMMGCBEJKRANIH@DGHEFABCFADJBBLFHANEBABEDAX@HABCD@HBDApA@KCIDCH@@ELICC@EI@AABCFE@AEC@CAANEBCEICKH@PGJ@@BPJFHLDBNLD@JDFFJLJBJFBFD@PRBFDER@PP@NJDNJKDC@CBCHEFG@ECGOGCEN[AG@CMO@GCE@EAEMGGACCEAACGECCQKQQDOCEC@@AC@CC@EFADCGE@CAADCNA@CBEDAAADEACDCEA@AEAEGBAHB@KGCAEA@@CACE@AAAAGBEE@ACABCAGDA@AD@DGACA@@BI@ACDEAAG@AAGCCBKEBAGEBACC@CQ@CBAABCA@MACCcICCGCK@KMYKCGMBCAGAOEKAAC@G@AA@CDE@ACCA@EAADGLM@ISGA@BAKAGDKFA@@CM@GDABECIB@DI@ACBACACAG@CEBCM@AA@CA@ABIB@EECACBAACCAADA@EDGA@EGBAIEAEKCAGBABA@ECCCAGHO@AGEAICCIDG@AC@EGA@BBBA@IEOBEDKCC@CDE@AAFADCA@ABC@AEA@IBCFE@@BD@BFGBGEBA@ACAEBCGIABAACEBABGCED@ABCKE_GK@AA@CIBCC@CA@G@IAEGE@BDG@@C@AICC@BFCBGEEBK@EJOGCHA@CCB@BAK@ABAFFD@BCBGEA@GDBDDBG@EA@ADAAAC@EDCAGABHCBAAI@FEIAACEBEEGACAG@CDC@EABADB@AICGD@BBB@BIDKBBDF@@BKDECEFCACDE@@CA@KFADDB@BGAACCBADBDA@A@AEG@GC@CFAAAGBEGEFIAAACBA@ASEECEM@IB@@BEEAADC@AEA@CBBBB@ADMDHHGDEA@AF@@CWFA@BCA@G@AAE@@@FCEAABCFBBK@ABC@CDC@@BE@@BA@CEECDCKAABBDCBAEAA@CGBAA@AFAAAGDAABGE@A@@DC@ACDABCE@ADIDG@CEG@EBA@BADAAAGDGCCDGA@ABAAABCACGAA@BCF@AAI@@DC@A@AAB@ACC@KCEDAA@AEBCAACHE@CMAEKKACECCDC@CGACFC@AAAEOA@ADGAAE@EDA@@EEAUBKDGCFEACA@SBMCAB@DCBCEBE@CGAEBAB@BBHCDECCEOEACBEAACAIHIAIBAB@FC@A@CCE@@DHD@B@BGBCA@GKAAEG@BADC@AE@IJE@CEEAACDAJ@BA@AEAK@EALE@A@AF@@ADBDA@ADABCDDDDBAF@A@@CFADBDA@EFB@AAAHADCAABA@AD@HGH@DAL@DCJAAACBACAAB@ACA@AEA@AABACCDCC@BEA@BAEAJIXCNMH@NFPADCBBAADACADCHA@ENCBBD@BBF@@AD@H@@BDEDBACFAAAAAB@AAFEFA@AAB@EDBDABCAAD@@@DABAAAD@@AD@BABB@AD@AABAA@B@AAD@A@BC@AC@@ABCB@@BD@AAD@@@BCJBDCB@A@BAJ@@AB@BEJA@@B@AADA@ALEACHBDACAHABBBADAAADBBABBBABBBEBBBCD@@AD@@AFCB@ABB@BCB@@CFCBGDA@ABB@EBCF@BAAAF@@AB@@CDAAAB@ACFAD@@ABAAAJCB@ABD@@ADAAAD@@CD@@CB@FC@CAAACD@@@DCC@HACAHEBB@AD@FEJ@ACDBBEBB@ABBBCB@@AD@BB@CD@AABAEBBACCDABBBCABAEHCA@BAC@DADEH@BAHBHKC@DGF@AAD@@CBDFADCAADBBCD@BCBAAA@@BB@AHACABAB@BADB@CB@BABBB@@ACCD@BEB@@CC@DCDACAB@DA@BHEBAA@@ADAAAFC@ADA@ADB@CBBBCDABAF@ACCBBAAABAAAB@CED@BEB@DCFCD@@AB@DDACF@@AD@@AB@AAD@B@AABA@@AAA@BAA@@EDBB@@AB@@AD@BABBDA@BF@CADAAAD@CCDBACD@@AB@A@DCC@D@BBBAB@AAFAC@BAAAF@BCBBAEJE@BD@ABBBD@DCBBHABAECJC@CB@BB@AD@ADLCAAFC@ADCD@BEDAAABAACHAAEB@@APGEABA@CCB@A@AD@FIFA@AB@@ADBB@AADAEAFCAABCE@@CC@@CAADAACBAFED@DBBCHADCHELAJEFIDACCC@@ADEH@LIAADCD@FCDBHCLAFEDB@CF@DBLCHDF@JCDEHCFCRAPBVARCJCLIRCLIFANAJBLBPDBB"],encodeOffsets:[[131317,47696]]},properties:{cp:[126.99293,46.637393],name:"绥化市",childNum:1}},{id:"232700",type:"Feature",geometry:{type:"MultiPolygon",coordinates:[["@@RAMhEnAFNATCZAXGPILSDCF@H@hJH@JEH@HBNFHFJFLBZDBLCHADEBEAEIi@SDMAKDGLODE@EAEIK@EBKaCKEAC@CJMBKDGT_@EISFINQBE@E[SSAGAECCKCCCAQEK@K@UDQACCEEAO@EMKAC@EGAACF]DEPCbABA@CCEYIOGGGCEDQLIJOLGHIAAQEICCE@_ACKKCG@GFIHGHEFEDE@EMKWKMKMEE@QFI@OH[FEDKP@XILBNJJBHG@]CO@m@KAQGkOK@EBKPFLADEDOFOCCACMECE@]FEBAHEFWFiAEAAE@EJG@C@GEGEEUCICWQIEGAGFGJUBGDY@CBIPIDI@EAMOKAYAIAokICK@WDIBGJINAHMN[DG@MGEAEBCFBHCFGBKHGFCH@JN`@RAFHP@HELELEDI@SDQJGJAPG@W@GBCFAPADWFCFBLIRE@SACD@DPjDREBIICAKBKJ@HJTZ\\@PLV@F@LEFKD@FZXFHHFHBH@\\IHCN[LI@KAOFKRCZAD@HCTEDACKDCTGAKBAhILELGRPBHBNFFLFJDRBJAX@HB^XJLHPJHFF@DMFIHPPABKJ@DFD@HFLALFDBFCPBFAFSFEDCLGJBDRDlDRHDNAXFDh@PB@DDBFEJQPCF@LPFtBG"],["@@jDÜZ^hL\\FPFdVVHNAjGVAT@RD¾r^FXB`FNFXFEJBvAjG~CjB\\HxhplPHjAQfBpNtRNHf\\lRV@XMNKLcFG¶ux@\\xG\\AXBrNHÆKz@tFBIlAf@®NlNpZİr@SP@XPRZPTRZRbPRHxNLB|E|U°WÄEHCBCLaFKZSJCT@HDNL@HkbDbNNhR\\A`KZUAQiaKOJOPCRBbNV^DHJpRHb@PA¢aFKLIjqNE^ChLPB´UdAtDhAzQzetUdQ`KZMJG`mFGDA\\CTBVLJ@TCHCNIJIFYJKRGJEHSPGPEdBx`rRzJdBXETUta`OTC`MNUX[@QDKf]BCDSDGHGU^UHK@GJGKZ@FATSpGClL~DAtCRA[LICQY_NO`OKdAJVIR_HYPMRKJAfFPJHLDLGNGJM\\EHAHEJAF@Lê|`F¬LD¶@IfAfI[^MvcnUZB^A|WnCNERMXCPGJGdGfElGVKDMEOAQ@GFMPMRITDfTXNxZNDPBV@LCJE^[BEFiBCPOZKLK\\WQNA^IDANMKMUMEEBKJ_AGIIOIeGeCSGICMQES@SFQB_HKPEjJPHNPRFvBLBHH\\XB@NBVE\\ApHR@dOBCE[@IFG^]LIqTMBC@EN]JSZGHCHSRUhCLFTRX^DNJH`HXBNAHATKNCXOfMXOBMCEMCyC{MKGAC@CN]FI^]FMBOASCGGEGAs@DQI_aCWAWVcTSmRG\\@LDHFBBLRHVDDV@bI¬upYqNUiEYBINIPETDdLXDh@NCHELMJQZUVSdU^aZeDUEUGSMKUEMEIKCUBMFILO`Kd@@tGRCDCFKBGAKEGQ@WJOBOEqUeIcSMae@GBILM[vICLENGRGQzE\\C¦kVIDEDQAIAGIKGMYyaWGOJOGKECG@uHO@eKUOGO@GBMju@IKa@YRUTMfCHCNITcTQ\\UXUPYdja`qLKfQdMHEFEC[DKPIXExDXIT]EWMQAOBGFGXkDK@KW]ICKAkQYOIKAEBEBE`]LEpO¤UUz[DE@OSYk_QYAYAEccAGPW`SnOLAÂGTC`iLc@UMUgO¥LU@KAKIWUEIGO@IPJO`[lUnGf@JDZZ@NENSV}bEJAHDNDDRH~PnFrBNAPEFEDOAOCUIQeeuCGCKmBIHUBOXDWHÅJKpKkvkfuYEEEAGEE@EDC@CGEGKGCACDCAKBGFEBEYIIBEFKDG@CCOGOCA£Oç@QCE@CAy@MoCcVW{LG¥AY@}CMFUTKJABBH@@@DWJSAUAWNzfBLGDG@KFWDqAeBSFCDGBKBEBGAGBSCCCIEEKICCEE@ACQCCGECI@IKMBCBBD@@MDG@ACCCEAG@ADMDKAKBG@@GJIBEBKEQBEE@wmWAO@QCMBK@@AMCKDEDIAYHKAGGAHDHZNF@FCCI@CFAD@DFBPAHBHLLBJP@BB@DCD@BF@HFP@BBBBCBG@CDDFBFBD@HCBGAEC@CGCCB@FI@ADE@MD@DBBJDBD@BEF@DLBB@BDABGAGBMDALEHBBXNAFKL@FD@DGFAFDBDBBEFG@EBCD@DFBF@LGHADBFFBDCHCDMDQCUJCB@BJF@BABMAKDWA@DD@FFNHDB@DEBGAEAQDGHULYJ@BDBLCJ@DBADABIAGBEFBBH@BBAFBBN@DB@BEDAFHD@DEDBDHD@JCBC@CIEACDBJADGDM@QMUKKGIBCAG@ABHBBBIBKFBBDAHB@BG@@BBJA@GAEB@BFB@DHB@BMFC@GE@B@BCBABF@DDCBGBCDIBAACACCA@CFCBAAFAAAEBOGCB@BFD@BABYAAB@DE@GAABDB@DGBAD@@BBFABBEF@DDABCFDJAAFGDAFBBJABDADIBEF@BFDIAIHJLKHBHIF@DBJKN@BFBBDCBE@CB@DDDABC@ICED@DDBEHG@EFIBECC@@BDB@BIDE@@CKBGAE@CAAAEBEA@DCDAAGDAADC@@EAABCBGAADECCBE@@CAAAB@DO@@FCCE@@DCBCB@BABABTL@BD@ABBBDBBF@@E@DBEBBFCBBBADFDABBFE@@BAAABBBCBEAADIBBFCAC@CHD@BBE@@BEBDBE@ABBDC@BBABEAE@AFBDD@@BG@@BD@ABIDAB@DE@CB@DCBDBGBDBABFDC@A@AACDGAABBBABE@DDJ@DFCAADC@CFBBCBD@AFCACBAACBB@A@BBAFBA@BDBC@ADC@@DB@ABAAABB@ADD@ABAAGBBBA@@BABD@BBE@DBABB@CDG@DBC@EABAE@@@AA@BGBABA@CB@BEA@BGBBDA@B@AB@@C@BBCB@BDAADC@BBAAEB@AID@BCBJB@BBB@BC@GAEDCA@BEB@BCBD@CDCACHBD@BD@ABA@ABAA@BA@@BA@@BA@AAABC@CBBBABBBK@CDBBCBBBB@@BADAAADA@DBA@BDG@CDHBCBDBAB@BFBCBBBABAAABEAABA@@BCAADA@@BABAAA@ACA@@D@BBBADA@ADCA@BA@@BCBDDCBB@ABABAAA@C@@DBB@BMF@F@BC@@BA@CDA@BBCAA@@BC@@AC@@BIDBBBBABE@@BCDBBA@BBA@FB@@BBA@F@ABB@A@DBABB@DADD@BC@@BABDB@DC@EDCAABEACFDBCDA@ABAAGBABDBCBBBCBC@ECEFEBADBBGJBBEB@DIDADCBABEBADBB@@@BEB@BC@@DIDE@EHGB@DKH@DG@AFCDIB@BGBAFG@BDA@ADC@@FEFEBABE@QFEH@BB@ADKD@BMJGHMB@DEB@BCDIFAFIDCDE@CFGB@BC@ADEBIDABE@EDCBABOHEP@JBHEDKTQHQLYC[GSOQJchMFUAGIOAmRQBKCIMEEVQ@IC]EcBAD@XATODWBSEWeQMIECK@QIGKWAEReHYBGAQ[]WQoEgFCAMKMWIEEoMCCCI@MSIDKNCREHILaEKIGOGQAQEGCa_GIAMBU@CQIWGUBRkDQBWCOECAAEKgEKmWOEIEG@gOqBiAKAOIQBCBc^MLIPONYNeJ_C[BUJ_DUAM]IGOA[@YEMGMUGWDKDc@MOMAEMWOOM@SFqJ_OC@EBEHAJCXaGU@KNKBaPWFEVGLGDmd[LM@MAeSKAWDYLIAGCWCEBEFODWCcCQCEEIWGGMCy@gD{@qI}IOIKOQL]N]PghW`]XOBM@_KQCQ@aNYHs^YVQDMAKMic@_GKMEQCIIDaAMeBwMgA[I@YECkG}CYBgFOB[CUEIKEMICUAcFOJ_CmSM@aJ[BYEWIiUOAQB{NaPEJFJIFKBU@UAWFSFUHKNOFsEE@YTUDUBYJQJUBYCmKSGWDqhW^K`@LJ^BJGNOJOLmLO@IBOHINAJHXCLOH]FMHAREHMHILBDRHDDNLJJDP@NYLQPDHHHHBXHJJ@JMTIH]RuXBNBDlbU\\GFiTENBPENQXCbENINILUH@FBBdhFLBNGJCLAJGNMNKTKLSPANFN@RM^CROJWNDRJRXNJJBH@HALITDZDZCHAN[ByKYVANKNAL@ZCNS\\AlSHYDED@BbDN@D_fADBH^D¢DHHLVHDJJLr`DBDJBVA\\ERKRDJlNFLENSJSJIJC@gMG]JBRcNy@IEKCE]EO@sbeHWBWBPIJL\\ITTb@HCFg`IPCRENKNM@UYUKCAIBGDGPKPeFEDIVIJ_COBADG^OLIr_`UF[AeKuASDoEaNKPKHStEDO@[CIEAABCDYIGQ_ACWKEQIK_QWGJMLKSMkIgENcWcwQEEGO_UECGG_IMiYYEKAOAHoCKBbwPoJuFIDAHDP@\\MTSNKPWTUNWLHRbjCDoXKTODEYBIFOPYNSB_EYGODQ\\MLEBAD@FCFoCEBMJGDU@KH[HUBE@AJCDAF@XCDmFCJCBK@YAMFGBCB@HEFCBGJIFMACDAFBBCJANCJCBcDsVGBMGICQ@qGM@SFOJQBKDUP@D@BhT~PJDFFDJJJVJZDdXHBBB@RBD\\HBPDDVFf@RFX@\\BRHHHFNZFdZFFHHTL\\JHDBFDJLNBLDFDHLFDBDPJDlDPFh^NPTDPJRHHBTH\\PT`JJLDRH@DITUNAFPVEPDB`DXRNFTDPJRVJRB`^^HNX^BLFJHFPFbFxPNHXHDDDF@RPL@FJRJDLDJP@HEF[HMHGDAFEDULAD@DRRBD@BGHAFALUPIXGHKLID]G]BIHONVMFONADBLALDFJF`F@BIV@H@LLL@LBHCJmXMNKDK@WEGAMBGBCHEFqfIJCBSBKDGFSRQLuLKJK^"]],encodeOffsets:[[[127123,51780]],[[124416,54618]]]},properties:{cp:[124.711526,52.335262],name:"大兴安岭地区",childNum:2}}],UTF8Encoding:!0}):C("ECharts Map is not loaded"):C("ECharts is not Loaded")});
\ No newline at end of file
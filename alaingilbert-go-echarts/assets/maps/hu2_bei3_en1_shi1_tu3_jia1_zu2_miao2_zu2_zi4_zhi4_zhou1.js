@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("恩施土家族苗族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"422801",properties:{name:"恩施市",cp:[109.479664,30.29468],childNum:1},geometry:{type:"Polygon",coordinates:["@@FBBB@BBDBDDBFD@BFBDDFFBBDDBHDDB@BBDADBBA@@B@B@B@B@B@@@D@@@D@BBB@B@@@B@BAB@BA@@@B@@B@DDBBd\\LHHFBBHDNFFBJFDDFD@@@BA@CBC@CAG@AAA@CAEACAAB@@@D@BBBDDB@BB@@DBJBRD@@HBLFNL^PHHLLPPFFDBD@@A@CAC@CDEHGDGDCDCBBB@DDFFDBHBB@BCACCG@ACIEAGACA@@@ABEHCHCPKLIHEFEHGFIHKHG@ADC@E@AEIA@MMIKEGGK@@AE@@@@@@@A@AFGBC@EAE@EBCBK@ABA@@BAFABABCDCBAB@B@B@BBBBFDFFDFHHHLJPFHRRJHX^LNNPFH@@FJDFDHDLDNDFDJBBBBDBFBFBDB@@DD@BFFBBDHBBBBBBBBHDFBTDHBFBD@BDBBDFBFBDDDBBDDHFDDHFBBB@BBB@BB@BBBDB@BB@BD@@B@B@DBJFB@BBBBBB@BB@B@@@D@BBB@B@B@B@@@@@BAFIBG@EIMEG@A@@@AJAJDPFPDHDF@BA@E@CAICIEKEECM@UJMDCFIBABADA@AB@@BB@@ABAB@F@H@B@B@B@F@B@DCB@B@B@BBB@D@@@FC@@@A@ABA@A@ABA@@BABAB@B@B@DEB@LALALBD@BA@AACBCHAH@L@BABA@C@GIKAE@CFEJEBAB@@ABC@C@A@A@EBA@AB@B@DCHABA@@@@@A@@AA@@@A@A@@B@BDB@@@B@@A@A@@BA@@B@@@B@@CBA@AB@@@@BBB@@@@B@@@@@BA@AB@BA@@@A@A@A@@B@BB@@B@BADCBA@@@A@A@@B@@@@BBB@@B@@A@@@A@@@AB@@@@BBBBBBBB@@@DAD@@@BABA@@D@HBB@BBBBBB@@B@FB@@@BBADAB@D@@BB@B@B@BAB@BAB@@A@AA@@@@A@@D@@A@@AA@@@A@@D@@@BABABADEBABABABABABA@A@A@@B@B@@AA@@@@A@@BAD@BADCD@B@DADCFC@ABBB@@F@B@@BBDDFHDDB@BDBDBB@BHJ@B@D@B@BBBDBDBBBDDB@DDDBHBB@BBB@DBJBF@F@D@RGRAFDBJIHCLANFDJDNCNAJ@NGPAVFF@NDDBH@NCHIFGBA@G@ADM@@@GBC@@@@BA@@F@B@BA@@DCDEBA@CBABA@AB@@E@ACQACBADIBCAC@CACAAC@A@@DABABIBCBAB@D@DADCBIBEFCB@AEACIEMCOAK@IBKDMDGJIDEFG@AD@@ADADEDCBADA@@@AB@BA@@BABA@ABE@A@AB@@A@A@C@A@@@@BABCBABE@CBCB@@C@A@A@A@A@C@A@CBABC@@DA@ABCDCBABABA@ABABADA@CB@BAB@@AA@@A@ACAEC@AAA@@@C@@@A@A@A@AB@@EA@@@H@J@JBD@@A@ADEBCBEBE@ABABC@ABA@ADA@ABADCDC@@BAHIBAB@B@B@B@BABAB@@@@IBMDQ@MAGAGKGMCCEBEDC@E@GEEECC@GAE@EG@EAGAAEKCGCI@EBKAGDEHBLFHCDOHQFGJKFIDMBQ@M@IFIHAHAD@F@HADADABA@@AAAACAA@@@@A@C@@@C@I@@ACAE@A@@@A@CBA@AAA@@BA@A@ABA@ACBSCSGMCCEFEFMDICGAGAEEEEEECCBIFKHEBAJEPCJCLENGJIFEBICEAECGAE@EDEHIJEFEFA@@@IAEBCAE@EACCEAI@EBG@E@EBA@MEA@ACAIAIA@C@E@KFCD@D@BG@AAA@IMCC@@Sa@I@KBEAICGAACCCAMAGDEHCFCJEPCNGNEHCFEDABMHIFOBMBCAOAC@MEKAOAEDAJALGLABKNIDIDQFMHIJCBABBD@D@HBJBH@JDJ@JFJDHB@DHBF@D@B@@BD@B@B@BABCBABAB@BAB@@@B@B@@C@A@CBE@I@A@AAAAAACEAAACAAA@@@A@A@CBA@A@GAA@A@CBEBC@EDIDABC@A@C@C@A@EBIAI@GBA@A@AACA@AAAEIAGAACECAA@A@CBEBG@C@A@G@@@AACE@AA@EAEAE@CAAA@C@ABCBADADABA@@AACAAAC@A@AA@A@ED@L@FA@ECGACDGBA@CEEECAACGCEACCECGCCAC@@I_GOEK@ALIFCDCDE@EEIIKGKCCCIACCGKUEIEGAAKECBC@G@GFKHCJILCPCNIJG@OAG@UGKGGGEAGBOFOFI@K@M@MEEGEMCKEIECEICGEEEEAACCKKIGGGCCAE@@EICIACCKCOAKAG@CCECEMEEEAG@CAIAKKK]UECOGMIGGCGAK@I@I@I@EAGIEG@QAK@I@M@EAGECGCICO@IBI@GACMFOHIFILAHCJAF@FDFFFDFBJKNEFCJ@FDJBHFJBL@JBF@FCFAF@DAB@DBN@H@FBL@DDFBB@B@DCD@BADAB@D@@@B@BAFAB@BBBFD@FADAF@BDD@DBBBDBD@B@BEDA@C@OKAAOGGCI@C@AB@@BDABB@BJDFBF@F@FBFDDBDDH@D@BBFARAD@B@B@@@B@@@B@BEDC@E@E@EACBCB@FAN@BBFB@DJBJDN@HCLBFBFFJDHBJ@JABAH@HDFHDRJLHBFAHGNENGDMBMEEGGIKKEHIJIREJKJCJ@FBDFJBBJFLFLHB@DHFJFFDBJHLDFDH@HFFBJFLFDDD@@@@@DBDBH@J@FCLCD@LDJFHHDDAHAJ@FBHDDJFfRPHFDJJLLLHNHPJ@@@@DBHHPJNLHDDJFDBJ@N@LGFIDGDIBIDEJGJOJOFEDAFEBCH@FCJCJ@@IFGDI@KEIAEAI@OAMBIDG@KC@@GCGCGACD@HEFCHIHEBKBK@MAKBE@ANEJIN@BCFCHBF@B@NBJBHCNALKHIJEBC@A@A@CBA@A@A@@@A@A@A@@BAB@@@B@@@@@BBBB@@BBBDBB@DDDBB@BBDBD@BBDBD@D@D@D@DBD@BB@BBB@D@BAB@B@D@B@@@DBB@BBBDDBDBDBBB@BB@@@@B@J@D@JDBBCF@DGACB@J"],encodeOffsets:[[111765,31254]]}},{type:"Feature",id:"422822",properties:{name:"建始县",cp:[109.722109,30.602129],childNum:1},geometry:{type:"Polygon",coordinates:["@@BB@@FBD@D@DBHBJBHDF@DBDBDFDHBDDDJDFB@@DABCACAEAAAC@A@ABAB@FDHHRFBBBBBB@DABEDCHBD@@BB@B@B@FAFBFDFHDBBDDBBDDB@TJPLHDPJVRVNPFRJNB@BRBNB@BFDDBHB@@JDLHbTRHNDNFTNLH@@B@F@FBD@FAHEBADCJAH@F@DADADCDCB@FBDBDBB@DG@GBA@CBAB@J@FAD@F@DDFFFDHBHDBBB@B@D@BADALBLBB@BABC@ABAB@DBDBD@D@BABA@A@@@A@@AA@EA@@A@@@AAA@A@C@A@@@A@@@AB@@ABA@@@ABA@@B@@AB@BA@AB@B@@A@@BA@@@A@AB@@A@@BA@@B@@@BA@C@@BEBG@GAEBIAE@ABAFCFABABC@AD@DC@@D@F@D@B@@@@AAC@A@ABCDADA@@@A@A@AAA@A@@BCBAHGD@FAH@HBB@D@D@D@D@BBD@DB@@@@D@DCDA@@@@B@B@BD@B@@@B@@BB@@@B@@@@BB@@@@@BB@@B@@@@@B@@B@BB@@@@B@@@@B@@@@@B@@@B@@@@@@AB@@@@@@B@@B@@@@@@@@@B@@@@@@BB@@@@B@@@@@@B@@B@@@@@@@@@@BB@@@@B@@@@@BB@@@@@@@B@@@@@BB@@@B@@@BA@@@@@@@@@@BAB@@@@@BB@@@@B@@@B@BB@@@B@@@@B@@@@B@@@B@BBB@BBBD@BB@@@@@@@BBBB@@BBB@B@@BB@BB@B@@BBDB@BDAFCDABCBABED@B@FBDBFBBBB@@@D@@@H@PDNBNCNKHGJCPAT@HAB@NCPCRIDIAKCGCKGGCC@AAGAMA@A@AA@@A@A@A@A@A@@@A@AAAAA@A@AA@@AAA@A@AAA@@AAA@@AA@AAAA@AAAA@@AAAAA@A@AAA@@AA@AAA@AA@A@A@@@A@ABA@@@A@A@A@@@AC@AA@AAAA@AAAAA@@AA@A@AAA@A@A@@@AAA@A@AAA@@@AAA@AAKM@@GOGMCIAM@KAECEGGEGGECAECE@E@C@A@EE@GCCAEEE@@@@@@ECECECCE@@AAAEAA@CFEFEBC@EAE@K@EBABCJEDE@E@IBEDIDGBGBE@EBC@@BGDIDAFEDIDEDG@ICICIAE@GDGBI@G@ICKGMAGAIBAHCHAHCDABABKBK@E@GCEGIIIAE@A@GBKFI@I@I@@AGAECIAGBM@ABI@ABE@A@AAGCEGGEEAC@ABC@CFA@GCCAAGEACAC@ALWBKCSCKCKHQBMBI@GEECGCEEEAG@G@GHKDAFBLFBBBBFD@@FBHDBDDFFDBBBDBDDDD@@BB@FDDB@@B@B@D@BB@@B@BBBBBL@@BDDLDBBABA@ABABC@AHCDAFADA@CACAE@EBEBE@ECGAEAG@CBADBB@FFB@BGDCBADBB@B@@@BCBC@C@A@A@E@ABABA@A@ACCAA@@@CAEAA@C@@CK@AAE@AAE@ABABAB@@ABC@A@CAA@AAAACAA@@AA@AAA@@CCCAAAAAAA@AAA@AAAAAAC@C@EA@GC@@CAAA@A@@AA@@@AA@A@EAAA@A@@AA@CA@ACEAAAC@EA@@C@A@A@A@EACCAAAAA@AAAAA@CAA@A@AAA@A@E@A@EAA@C@C@IEIC@@OGIEGAIEGAICICOIOIQGECKESEGAMBMAIACCIGCCGACECCIAAB@BAB@B@BAB@@BB@BAB@D@B@@@BBFBD@@@J@D@@@D@B@@B@DBBBBB@@ABCBCBGBE@C@GBGBEJ@J@NARCNEJILEHGRCPGDKEGACFBHAL@FDJDHFLBBBH@FFHF@HBD@FDFF@H@FCDAFDFNDLHBHBH@NCRAN@J@@A@ABABA@A@A@A@ABGJAB@@CDCDAB@BCB@BAB@BADAB@BAFAFADCF@B@BC@IAI@G@@@B@@FA@@B@B@B@B@@@D@@BB@BFDDB@B@BB@@BA@ABA@@DCBABAB@BABABABCDAD@BCB@@ADAB@D@B@D@B@B@B@B@DA@AD@DAFABADAB@@@@@B@D@B@BA@@B@BAF@BABAB@@ABA@@B@@CBABCDCFCB@BC@@BEHCFIJCHCNAL@JBLDPFNDJFB@BDAFEJADABC@C@CBADAJABABA@CB@D@BBBD@DBDADCJABBDDR@B@FA@@BABAB@DABCFCD@@ABA@E@@@AB@@@@AD@H@@CN@B@HABEHGJMDG@CAMCE@UEOBMHI@MBMDICECBMDKJGAIECQBQHC@E@E@IACAA@AAA@GACACCA@CCAACACAAA@A@A@C@AGI@AAAACACA@CCEGCCAA@@@A@EA@AA@BEDCDCBA@C@CDABC@AB@@@B@@B@@BA@A@@@@B@BABABABABABABCFABABAB@@C@@@@B@@BB@@@BC@@@@B@@B@@B@BA@ABA@ABA@A@A@@AC@A@CBAB@A@@EAA@@@AAAAAAA@GAC@@@ABAB@@C@CB@@A@AAAAAA@A@@A@@B@@@B@@@BA@@@AA@A@@A@@@@B@B@@ABCDABA@@@AAA@@@@B@B@B@@ABA@@BAB@@@@A@@@@@AA@A@@A@@BAB@DA@@@A@@@AB@@@B@BA@@@A@ACA@@@@B@B@@BB@@@B@@@@ABGBCDA@A@@BAB@F@B@B@DAD@BA@ABIFEF@DBFJL@H@DABABK@G@GBADBD@BABC@KAKBKBA@CFA@A@A@ABAB@@AB@B@BAB@B@B@@ED@@C@A@AAA@A@A@CDA@E@A@A@A@G@E@A@AB@BA@@AA@@BCBABABEJCDIN@VDNFFFLDJBJ@D@FB@H@DBFDBB@FAB@B@BD@@BD@DFDBBBBBBBBB@DABC@CBCDBFBFDDDH@DBDBFBD@FHBPHVDJ@PDHDPLXRFHLRDH@@BB@D@FABBDABAFAH@F@FAB@BBB"],encodeOffsets:[[112290,31500]]}},{type:"Feature",id:"422802",properties:{name:"利川市",cp:[108.936452,30.29098],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@A@ABCFCBAD@B@BA@BB@@B@@@BA@@BCDCBCDCB@BA@@A@A@A@"],["@@@B@BBB@BBBB@@@B@@@@ABA@AD@BA@A@A@A@A@A@@@A@@AB@@CBCBCB@@AB"],["@@@@AB@A@@AA@@A@@@@@A@A@@AA@A@A@A@C@A@@@A@AAAA@@A@@@@@ABCBA@ABA@@@A@@@@@@A@@@A@@@@@@ACA@AAAACAAAA@A@@AA@A@@@@@CE@A@E@@@CAAA@E@A@C@A@AA@@AC@ABA@A@A@@@@@ADE@A@A@CA@@@@A@@@ACAACCAECGCCCA@@CA@@A@@@@CBA@C@C@AACCKOCCACEIAAEIEGACCKIMACCCAI@@ACBABA@A@@BA@@@@BAHAB@RCJEFGBGAAACGCIA@A@A@AA@CAA@EAA@CBA@CAEC@AAAAA@@A@AB@BADADCBA@AA@@@@@AAA@AA@AAC@AAA@@@A@A@@A@A@@B@BADABADA@@BCDEBC@A@AAE@AAAAAKCC@A@GFADEFEHEFCB@@MBQDA@CBCDEFADCF@B@B@BA@C@@AC@IAC@E@GBG@ABA@@@@@@BDJBH@@@DAB@F@B@@B@B@BBDFBDBB@F@D@D@FADABAB@BC@A@CAOEICA@C@AB@@@B@@AB@BADABAJAFBBBDBD@B@H@BBB@BD@B@@BDD@B@H@B@B@B@BAD@@@B@B@@AB@@@BA@@@A@@B@@A@@AA@@@A@@@C@@@@@A@@@ABA@@@AB@@AB@@@@A@@@A@@@A@@@A@@B@@@@AB@@@BA@@@@@@B@@@@@B@@@@@B@@@B@@A@@B@@@@@@@BA@@@@@@@B@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@A@@B@@@@@@A@CDC@C@C@A@AC@@BABA@ABC@A@C@AAC@E@C@AAC@@GA@@ABA@KBK@G@EB@@A@@@ABA@@@@B@@B@@B@@@@@B@@@B@@@B@@BB@@BB@B@@@BBD@B@@@B@@BB@@B@@B@@B@@BA@@BAB@@@B@@AB@@@@BD@@BBFBLFNJB@DDBB@@@BABCBCFAB@@BBDBFB@@B@DC@A@@DCFABADA@@BB@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@B@@BA@@B@@@B@@@@@@@@@@@@B@BB@@B@@@@@@@@B@B@@@@@@B@@@@@@@B@@B@@@@B@@@@@@@@@@B@@@@@@@B@@B@@@@@@@@@B@@@@@@@@B@@@B@@@@@@AB@@@@@@@BB@@@@@@BB@@@@@@@@B@@@@@@B@@B@@@@@@@@BB@@B@@@@@B@@@@@@B@@@@@B@@@B@@@@@@B@@@@@@@@@@BB@@@@B@@B@@AD@@@D@B@@FDL@XKPE@A@@BEBADABAH@@@HAD@HBNDNBF@D@BBBB@DBBDH@BDBBBD@H@B@B@DA@@B@@@@AB@BA@CAA@AA@@@BAB@@ABA@@BABAB@@A@@BA@@@@@ABBBDBD@D@F@BAD@D@F@BABAB@BBB@@B@BBFFBBBD@B@D@BCFAF@BDBB@@B@DBBADCHADIR@D@@@DBLBFBJDHFLHPBDFHDD@BBD@F@FBD@DBD@@DBBBBDB@@BBF@H@D@@AFCBABCBEBA@CAAB@@AB@DALBP@J@LBJBN@BB@@BCBA@C@AB@@@B@BFBFB@B@B@DABCDCBE@CCCCA@@AEAA@ABAFBFBDBD@BFDDF@@DFDBDABCDBFD@H@NFJFJPTHRJX@DDJHZDFDJFH@B@BA@C@A@C@A@CDEBCDCB@@@@@BBNBFBJH`@@BFDJBNJ\\@BBD@@ABCD@DDFBDD@FBJPDLDFAFA@G@EAC@E@CA@BA@AB@BAD@DA@ADA@G@C@CB@@A@@@A@@B@@@B@@A@@@@@@@@@A@@@@BA@AB@BA@A@@B@@@B@@BB@B@D@@@BABABABA@A@A@@@A@A@@@A@@@@@AB@@@BABA@@B@@@B@@@@@B@@@B@BA@@B@B@@@@@B@@@B@B@@@BA@A@AB@@AB@@@BA@@AA@A@@@A@A@@B@@@@@BA@@B@@@BBBB@B@@@@BA@ABAB@@@@B@@B@@@@@B@@B@B@@BABA@AB@@@@@B@B@@@@@@@@ABCBABABABABC@@@A@CAA@AAA@AB@@C@C@@@@@@@@@A@@@ABA@@@@@@@A@@@@@@B@@A@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@B@@@@@@A@@@ABADAB@B@B@@@@@@@B@@@@A@@@A@ABAB@B@@@BA@@@@@@@@@ABCBAB@@A@@B@@AB@@@B@@AB@@@@@BA@ABA@CB@@A@ABAB@@A@ABA@@B@@@@CBA@@B@@@B@B@B@@AB@@ABAB@@AB@@@B@@EH@BADABA@A@EC@@CAAAA@A@EA@@K@MAC@CBA@@DABGJCFABGBEBCBIHCDA@A@AA@@A@AB@@@B@@@@@BBHAF@BCD@D@@@B@@BBBBD@FBN@J@FBDB@@BBBB@B@B@BCBAB@LAFBB@@BBB@BBB@FBB@@B@@@BCDEJEJABEDEBCFCB@B@B@DBBBD@BBBDBDDB@BBB@BB@B@B@BCD@BAF@DDH@DA@@BC@A@A@AB@B@B@B@@@@B@@B@BAB@BAB@@@B@BB@@B@@@B@@B@BB@@@@@B@@@B@@@BBBB@BBB@@@BB@@@@@B@B@@B@@@@@B@@@@@BAB@B@@@@B@@@BAB@@@BBB@@B@B@BAB@@@BBB@BB@@B@B@@@@A@@B@@A@ABABC@CB@@@@@B@@B@B@B@B@B@@BBB@@B@@B@@@B@BA@@@@B@@@@B@@B@BB@@B@@@B@@BB@BB@@BBB@BA@@B@@A@@AA@A@A@C@@@A@@@@B@@BB@BBB@@AB@@@BA@@B@BA@@@@B@@@B@B@@@BAB@B@B@B@B@BB@@D@B@DBB@FD@@BBFFBDDFBBBBB@JB@@JBF@H@BAD@DABADAFGDAJG@ABA@@@AB@@@@@B@BD@B@@B@B@BABADABA@@BA@@B@B@@B@@ABAB@@ABA@AB@@B@DBH@B@D@@A@A@@B@BAF@HA@@D@B@BA@A@@A@A@CAA@A@@@BA@ADAF@@@BAFCBCBABCAC@CBABAB@D@BBBBBD@FB@DBDFBBB@BABBBBBD@FDBBBF@@@F@B@@B@BAF@D@@DBDAD@DA@@BBDBBBHD@@LH@B@B@BA@C@C@@BA@AD@F@@@@B@D@B@D@HDNDBFB@@B@@B@@BC@CBAB@@BBBBDBBBB@DAB@BDDDBBB@DBJBFBBBBBBB@B@@DBB@@@@@@@@BB@@B@D@BAB@@@B@B@BAB@@BB@@BB@@@B@B@D@B@@AB@@@@ABAB@@AB@@@@A@@B@B@@@@@BA@@@@@@BA@@@@B@@@B@@@BA@@@@B@B@B@B@@AB@B@@@D@@@@@@AB@@@@@B@@@@@BDDBBFDLFFDFBBBHBB@F@@@F@N@PAB@D@B@B@DA@@BA@A@AB@@AB@@A@@@AAA@@@A@AB@@A@C@@@AB@@A@A@@@A@@BABC@@@CB@@AB@@ABA@A@@@A@AA@AAAA@AB@BA@A@A@A@A@A@A@A@@BA@@@A@A@@@@@AA@@AB@BABA@@BAB@DA@@@A@@@AB@BA@@B@B@B@B@@@B@@@BA@@@A@@@A@@BAB@@@@A@@@@BCA@@@@@@AA@A@@@AA@@@AA@@A@A@@@A@A@@@AB@@@@A@@@@@@@@BA@@@@@@@@B@@@@@BAB@@@@@B@@@@@BA@@B@@@@@B@@@@AB@@@B@@@BA@@@@B@BA@@B@@@@A@@@@B@@A@@@@@A@@@@@@AAA@@@@A@@A@@A@A@@@@@A@@@ABA@@@@FEFCJE@@LEFA@@D@BBB@DBBBBBBHBBB@DBB@FBB@@@B@DAHAD@B@B@NH@@RL@@B@@@D@DAFGDCB@BCDGBC@E@@BCDCB@BA@@@@HBLFTJRFFBBBFDFDLJ@BBBDF@B@BADBBBBHFHDHBDBDDDFDDLNPTHHBB@D@B@D@B@DB@^^HFLJDBHDDBXLJFNNHH@@FFTRJFHDD@FA@@BCDCDCDBPNDBBB@@B@@CHEB@B@BABB@@B@B@@@B@BBBBB@@B@B@BBD@BB@BBBBBBBDDBBB@@@BB@BBBBDBDBF@DBD@BBDB@BB@@DBDD@@BBA@@@@B@BA@A@@BA@@@C@A@@BAB@@A@@@AB@@@@AB@@@@@B@@@BA@@B@@@B@@AB@@AB@@@@@BBB@@@B@@@B@BA@@B@@@@@B@B@@@B@@@B@@@B@@@B@@@@@B@@A@@BA@@B@B@@BD@BB@BBBB@@@@@@@@A@@B@@@B@@@B@@@DBBB@@@@@BB@B@B@@@@@BBB@@@B@@AB@B@@@@@B@@@BAB@@@@ABA@ABABCBAB@@@B@B@@@BB@@B@B@@@@@BB@@B@@B@B@B@BDB@BB@B@B@B@B@B@BA@@@AB@@@@@@ABABA@AB@B@@@@ADEBCBE@@@AACCCCC@GEICEICC@GAI@GAGEGCACAGBEBADC@@FGBGBC@EBA@ADEDEDCJGBA@ABCDE@@BC@AHKBCHEJGDEB@@AAA@AAC@@@ABABAD@F@B@BB@@DBDB@@BBD@BABA@A@@@E@C@CBCDCB@@@@IDAHB@CDEAAICC@I@A@@@@@AAA@AAACACCCAA@AAA@C@@@A@C@ABA@A@CAA@AAAC@CAC@C@C@C@CAAAC@CAAAA@CACCA@CAAA@AA@AA@A@@@@@A@@BA@AB@B@B@@@B@B@B@DAB@B@D@FAJILGBKDMAGAI@M@AAEDGDE@AJMFIBMF@LANBL@LAFAJGDGFE@GDCHBHDHD@@LDH@JCNAPBJ@FBJBLFJ@HCJE@@DIDI@EDGFABEFCPEPIHIFIJCJAHCJCHE@K@MAIECCIGCMKOIGGCA@@@@OIMGKGKKIIECOGeQIECCAG@EBIBGCCGGIEKCC@KDEDI@G@CACA@@@@C@CCKEIEEAGEG@ECKCIGCAEEEICGA@KGKEIEAAEIAC@EDILIFIJQJIFGLLHJFHNFNAHCFMHMBGAEKGQIGCCE@GBGBA@IAICGEIAEAEDK@GCMAICIA@AE@ABM@EDADAFBF@F@D@FC@A@A@@@A@@ECAACIGCGACAA@EAGAECMKYcGKKGGAIBKFCBGBODEBIAGBE@MCEAIEGCCICEEKACAAEEEEGECCACEGCG@I@EGIEEMAOAKBIDGFGHAFBJCHAF@DEJERBLDJFLDJDTANCLGHIFA@CBE@GAG@EDEDADBH@DEBEAECEAEEOGECC@ECEECICGCGCMEGEGGEIGECECCGCGEGAEAGBGBGHEFCDIDGBK@ECGGICCECGGEGEG@GAI@EAMBKDKDIBKBGC@GBEDEDQHIBI@E@C@EAKEEBEBOBGBGDI@ICEECAACAEGIGBAH@LAHCHCACAEEAEEKAEEGECCCA@EDKHEDCDGHABGHINAHADADEFADIDMBMCI@IBK@GEGAGCKEIAIC@@@@ACDGBCBCBEACEASAE@GAA@C@CAE@C@C@CAAC"]],encodeOffsets:[[[111725,31305]],[[111721,31315]],[[111231,30584]]]}},{type:"Feature",id:"422825",properties:{name:"宣恩县",cp:[109.489926,29.98692],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@GCECGAE@G@OBKAI@GACAEEA@ECCAA@C@C@CDEFGJ@B@B@BBV@D@BA@A@EAEAEACBIBQFCBGB@@EBGFA@A@A@OAC@I@GAIAOCCAAAAA@CBC@C@AAACCEEAAA@CCGCAAA@AAA@ABEDCDCBA@ABI@A@C@A@A@CBA@@@C@@@A@@@@@A@@@@BA@@@@BB@@B@@@@@@@B@@@@BB@@@@@@@B@@@B@@A@@@A@AB@D@BA@@BA@AA@@AAA@@@A@ABA@A@A@C@CBAAAA@A@C@A@C@ABABA@ABABABAB@B@DAB@B@@A@@@A@AA@A@A@ABA@@@@AAA@A@A@A@AAAAAAAA@A@CBCBCBCBEBC@C@CAAAAA@C@ABAD@F@B@B@@A@A@A@C@A@CBCAAES@@AGAEAECCEE@@AA@AAC@A@AAA@C@AA@A@A@A@A@E@C@A@A@@@ABCB@@ABA@C@@B@@AB@BA@AB@@A@@@AACAAA@ACC@A@AKBQDKDGDA@A@EDCBEFAD@B@DBDBB@D@D@B@BBBAB@BA@EFCDC@AAC@A@A@CJ@D@D@D@DADCDABA@CDC@EBE@A@ABADCBC@A@A@GBE@A@CECEAKBGCIEIAAKJEFADCJ@J@BBLANAJELIHKD@BIBSJKLAHFHBJJADBB@BBFFBJCJKJGDCDMHEFCLAJ@BBHFHDH@FADCDGFGFCFADADBDDFDDDDDDFFDFBHAFIDCBEDED@FBJJHJH@BLJHFDDBF@J@F@J@F@FEFE@GFCFAFAHGDE@CBWEOAIAKBIBC@SECAGIIICCKKMGAAKBGBKNGHOFCBIHCHCR@HBD@HAJ@JDPDJDHHFFBN@J@L@RBH@JFBH@F@J@J@JBLDHHHNJPHFD^VLLBLBJ@DBHFFNFDFDF@DBHBLDPDLBDDJFJ@@BFDDHHJHLLDDBBFFFFDHFJFDFJDLFNFHNFN@L@J@PEPEHAFBHHLHVHH@PBH@JIDMDOJKDILGHEH@D@DALFBBFHFJLVDHBDDJDDHLJLFJ@FCFCDEDKJ@BFLHPJ`@@BDDDDHDFBDDFDHBBFDFF@DABCHBDDH@FEBK@C@@F@BBBB@D@BBDBBB@@ABCBCBABAD@B@DBBDBF@FBFBB@@BDFBB@@H@B@D@H@FADAB@B@DBDFBBBHFJBB@BDBBBB@B@HAJ@JBFAB@D@D@B@D@BAJCFCD@FADAB@B@HBB@B@DAB@B@@@B@BBBDBBDFBBBBBBB@J@F@DAB@D@@@@A@A@@BA@ABABADABA@A@A@AAC@@@A@CAECGA@CGEI@ICI@IAGAI@G@CACBADAJINGREJCJCLMBAHKBKBIFCPBLBNFD@PBDBNAPAJENGBAFCDEFGHMDMFODIDEFGHCNBDBDDBBDHBJAF@L@JTb@@DDJNB@BBH@@A@CDCLEF@D@B@BJBJBDB@NFB@FAF@H@FAJ@FBDDFBF@DBFAJB@@B@FEFEJIFGFCF@HBFDFBJDFAJEHIFMDKDIFOBIMCICKGICGEGCGAGAEEGGCIGKOGIECGAKBE@C@OKIKKUKECDALAVFJFRRFBBAFC@@B@BCBABAACAAAAAACEECGCGGIASAM@GAEEDALEDCFAAECECAMGMGAC@A@G@G@CAG@ACECGEGEEKIQMIGIGGCGCIEGA@AKCCCAE@C@CBK@CAE@ECECGAGAGBIDGDGDGJMHGFOBKBMBKCICIEKEICE@GLIHKJEDEKEKEGCGGEG@ECAEAOB@@A@@A@@@@@@A@@@@A@@AAA@@@@A@@@@@A@@BA@A@@@@@A@@@A@A@@B@@@@@B@@A@@B@@@@@BB@@@B@@B@@@@AB@@@@@@@@@AA@@A@@@@@@AB@@@VGDCDC@A@AAACC@@KEECAAAC@C@AFCB@DCBC@C@C@CACACCCCCIEGAGAGCECGCWEEAC@IAA@AEBGHO@EAECEAECCAAIGGEAA@CRIDEDEDEBEBEAACA"],encodeOffsets:[[112349,30324]]}},{type:"Feature",id:"422823",properties:{name:"巴东县",cp:[110.340756,31.042324],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BB@BB@BBB@B@BB@@BBB@B@BBBBB@@@B@B@B@B@B@@@BBB@B@BNBH@BDDHHDLDHBLCJQJODMDA@GBS@OBIDGHMLMDMAOCG@@@C@@@A@AAEACAEAA@C@AFABADCBEDCBB@BB@@AB@@@BABBBBBBDB@@BD@@D@D@@B@@@BB@@AB@@BB@@BD@B@@@@@B@@@B@@@@@BB@@B@@@B@@B@@@@B@@@@BB@BB@@B@@@B@@BB@@@@@BB@@@@BB@@B@@BB@BB@@@@BB@@B@@@@B@@B@@BB@@@B@@@@BB@@@@@BB@@@@B@B@@@B@@B@@BBB@BB@@@@BB@@@@BB@@B@@B@@B@@BB@@BB@@@@BDBB@@BD@BBB@@@BBB@@@BBB@B@@@@BB@B@@BB@@@B@@AB@BA@@@@D@@@BAB@@AB@@@@AB@@@@@@AB@B@@A@@B@@@B@@@B@B@@@@@BB@@B@@@BBB@@@B@@@BBB@BBB@@BB@B@@BB@@@B@@@B@@@B@B@B@@@B@B@@BB@@BB@@@B@@@B@@@B@@@B@B@@@BBB@@B@@BB@@@BBB@@@@@BB@@B@@@@@BB@@BB@@@BB@@BB@@B@@@B@@@B@B@@@@AB@@@BAB@B@@@@@BB@@B@@@BB@@@@BB@B@B@@BB@@@B@@BBB@@@BB@@@BB@BB@@@@BB@@B@@@@@B@BA@@B@@@B@@@B@@@B@B@@@@AB@@A@@@A@@BA@@@A@A@@@@@AB@@@@@@AB@@@@A@@B@@@@@@A@C@CAEAC@GAKAEAA@E@A@@BBBBF@FAF@D@@HL@N@NAFCBAB@DBDB@BB@@@B@BG@MBA@CBE@EA@@@B@B@D@B@DB@DBB@BD@B@D@FAD@@BDBFBBDB@DD@BBBB@B@DABABABAACACB@@@BB@BB@@@B@@@B@@A@A@@@A@@BA@BBA@@B@@AB@@@@AB@@@B@D@@@DAFB@@BBB@B@@@@@@AB@@@@@B@@@@A@@B@@@@@@@@@B@@A@@BB@@@@B@BDFBBH@B@DBBFBDDHBBFBD@B@JBBDALBBFBJBB@B@BAFABAB@@@FBDBDDHBD@DBF@BBDDDBDDBBBB@D@FABAB@B@@@F@F@DBDBBJF@@BBBDFF@@BB@B@B@BADA@ABIDKHADCBCH@D@@@@ABAJ@HCPAD@B@BBFBB@BAL@DAB@@AD@@EFAB@DA@@D@BBBABADBDBB@@B@@B@BBD@FBFBF@@ABADAB@D@BA@@@@@C@ABA@@BA@A@AAA@A@AB@BADABBBBBBD@@CF@@CF@FCH@F@BA@@F@F@BBB@B@@DFDBDB@F@H@FBB@DHAHEHGB@B@F@B@BBBBJ@PFPFHBD@FBDBJFPLNRLLHD@BB@DDB@RJNDB@RFLLBB@@RRNPNNTRDB@BB@DD@BB@BDFJNBNAN@D@@ADEHEFADAFBBD@DBBFAFADAFADDB@DABADABADGDCBCFCFADAB@D@JBH@FCDCHEHCHCDAFALCLCDA@@BA@G@EACAC@E@CDCDAFADABC@IAEAGCC@ACCCAC@GAC@IE@A@@@A@ABADCHEDC@@@C@@@A@I@CFE@AA@@CEAAA@A@ADCDEBCBAD@FCFADCBE@EBC@AB@BAB@BBLBB@B@B@BAFCDCBABCDA@AB@B@DBJDB@BAHABAB@DBLBDAFADC@ACAECCACECCIEECCEAA@A@IBGBGBCDE@A@A@AAEACAAA@EAGBE@AABCBC@CEE@@BADCBC@AAEF@F@D@F@JAH@FADABCACACAC@C@ABM@E@ABABAF@BAD@BAHGDI@AACDCDCB@FCJCREDADCB@@AAMACCCIEACCACCAAAAA@EAAAAAA@AEAACE@EAAIKCAGCEEO@EAA@E@G@@@A@IAIAEACE@GDKDKJSDGDMBK@@OEGAMAQAG@AAAGAC@W@MAI@ADUBK@IAK@A@CACGWBG@CACGICICIAK@@AC@AAA@@@AAA@@@E@A@@@AAAACAAA@@ABAB@F@B@B@BCBC@A@A@A@CBCDCHIBA@C@C@AB@@ADC@C@AA@@AAAA@AAAAIEA@A@AAA@AAA@A@CAC@@AC@GFGDA@EAICEAGEIGEE@ACCACAA@@C@CCCCCAACACCCC@CAE@C@ECAAEGCGACCACAEAK@OCBABC@@CAAAA@AA@CBC@CACBADEHEAGEKACCCCCCC@@GIEMG]BCDCDCFC@@LAFAJ@TBHBFBJD@@BBFFDDBBB@BBFBDBBB@@BBBBDF@@DF@@BFDDBBLBJ@H@X@VALELMBG@C@CBGBGBIDGBEDAFEFCDGDCDCH@F@AM@EBEFCBEAGCCAECAA@AAAC@I@GAG@G@C@EEC@CAC@ABEFCBAN@@ABGBEDGDE@EA@CBEBA@EAC@E@E@E@BGAE@IACAC@CBAAAACCEKEGCAC@A@CBADADCBCB@DGDCDADBB@B@HAJEFCJG@@BEBEBA@CBA@G@ABABAAE@C@A@AA@CCA@@AAA@E@@@AB@NAJ@FBDDB@BAFM@CAAKBA@EAA@@AA@CACAAA@CBCDA@A@EGQGSCACAE@A@CBC@EA@C@CAAAACACCAA@A@C@A@AAC@GACACAAAA@ABA@A@AAAEAC@C@CACAAAAAE@C@C@E@A@C@AAAACBCB@BEDGDA@@C@AJGDABABA@AA@CAGASGECECE@C@IFGDA@ECCC@C@C@CNYDEBC@AEEAC@EBEBK@E@EAAGCA@@A@@AAACAKBE@CDABABANC@@@G@CCEAACCCACAEECAACEKCEACACCCCM@I@OLIHCFEBAKEI@E@CCFIJIHE@@JGDEBADEBGACEOEIEGCIGMCKCG@ACI@KAMACKBODC@OFEBQCGAKCCAIAQ@I@Q@IAMEEA@@CCEAAACDA@EBG@C@A@CAG@GAC@CACCA@@AC@A@A@CA@@A@C@CAEAOCC@C@ABCBA@C@A@E@A@ABAB@BBF@BBF@BDL@@@DBBBF@D@@BBDD@B@BABAB@B@F@B@B@DADAD@@A@A@CAABCDAHA@EEA@CAAB@DBHBFDH@FAFAF@FBFBD@DCBEBCBGD@BADAB@BABABCACKAC@@AKAAAAA@@@AAC@A@A@@@CAECA@@AC@CCACACAAECCEACGCEA@@ECAAAAKEEACBGL@H@HBHFFDFDHFF@HAJANGRDLDLDTALKX@BBDBDHFBBDD@HEB@DAD@BBDFFHHDFBH@B@BAF@BAJ@BANBHDJBFBH@@@J@JEJAL@H@BBFJJHJDF@H@FALALABCBGDGBGDABBJBHHNDL@J@HAJCH@HBFDJDJ@JCHCFCJEFCBCJAH@@AD@FAFAHCHCJAF@J@FCFIFADAB@F@LBF@FADEFEF@DBBBFBB@@DFFDFDFD@@@@@@FFBFDD@HFFB@D@F@F@FDDBHFFHHHDFBF@LBNDJHNHP@@LNBBB@BB@@B@BBB@B@BB@@B@B@B@BBB@B@@BB@BBBBB@BB@BBBD@@B@@@B@B@B@@AB@B@B@@@B@BBBB@BBB@@BB@BBB@B@BBBB@@BBBBB@BB@BBB"],encodeOffsets:[[112840,31510]]}},{type:"Feature",id:"422826",properties:{name:"咸丰县",cp:[109.139726,29.665202],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@B@BCJ@BDDBFBBBDBBBB@F@B@BBB@@B@@@D@B@@B@BAF@JAF@BBBFHBB@FAH@FBFFF@BBD@DA@AB@BB@BB@D@BAD@B@BFHFFFDBB@B@@IJ@HGNELCBEDKDW@CBEBCBA@CBABA@A@CAE@E@E@C@ABEBABAD@BAB@BA@C@AB@@@BAFAF@H@@B@B@@D@@ADEH@BCBA@@B@B@FDNBHBDBBB@H@D@@BB@@B@BA@@@BDBBFBDDBBBBBB@D@D@F@L@BAFABBB@DBB@D@FAD@DA@AB@@ABA@GHAFFLFHFHHFVNBBDHDBFDDBB@BB@BAB@BABCBA@C@@@A@ABEDCF@B@@BDJH@@FFB@AB@@GJCDCHACAA@EA@@A@CAAAAA@AAA@ABABA@AA@@ACCGA@A@ABAB@BA@ABC@ABMFCDAB@BBBBBFBB@@BBDHJHJDFBB@BBBDN@BA@@D@BABABABC@A@AB@@AB@D@@EDMDIECCEACAA@A@ABABAD@B@FADA@ADALAHCBC@EGICECGBCF@B@@@B@BBB@BJHDF@DABEBGB@A@CCQEIACCCCEAG@IBCBCBECAG@EBED@D@BAFE@CAGEKAMAGDAFAF@D@DDDB@BB@@BBBBB@BABAB@FC@B@B@B@@BD@DBDBBDF@@@HAHCHEBG@EF@DDHDJ@FCFIBIBM@S@IC@A@AA@A@@AA@B@@ABC@ABEBC@@DAD@DDFBFAHCHGBC@CGSBCBCDAJ@B@BA@A@ACCC@E@C@CH@@EFGDG@EA@ABADGB@@@CG@G@@@A@@A@@@A@@B@B@HAB@@A@A@@A@A@A@@G@@BABEBKH@D@HCHGHEDKBABEDC@EBA@@@A@ABCBGBCFDFFFHFBB@@@F@@ADED@@E@A@KCIEIEGAG@CHDFHHDH@B@DADKH@@CHEH@BABBBDFBD@DBHDJ@DABAFABABCD@@@@EAA@@@@B@D@P@D@BDDDDBD@D@@CDAD@BAD@BBN@P@HBH@B@BABADABEFEFA@C@AAA@EBEBCBAB@BAB@BB@@@LAF@DBB@BBBD@D@HAB@BA@A@A@@@ABAB@B@BBBBBBB@B@D@B@BADA@@@E@CB@@@@CDCD@BB@FD@@BD@B@@ABA@CBA@EAEACAA@ABABA@EDC@EAC@A@@@@@ABA@@@@@@@@B@@@@@B@@@@@@@@@B@@@@@B@@BD@B@@@@AB@BA@@@@@A@ABA@@@A@@@ABA@@@@@A@A@A@@@BDDBD@D@F@DBD@B@HBF@TBFBBDAFADADCHBD@@@@JDJBLFHDHBHFL@JAJ@NDNAJCBCFEBCBCBGJMHGBAHGDCFCLGFCB@DDFDFHBFFLBFFFDBDBDGBG@KBGHAHJBFBDDBFFJDJ@HCHAPAFAFALFFBD@F@J@JARGFCFCHAD@AHALCJCLALBN@FBJ@HFHFHHHFDDDHJDH@FALCHCJEDGFAHAHBHBFFHDHDHFDFDJHHFFHFHDNDHDHDJFFFDD@FDPHFFFBFDFBFA@CAGBCFCFCH@HBF@DAB@JEHGDKBMCSCIEKCIAKFQFI@CBEDGAIBEHGHEJCLAPBNBFFHJ@F@JDHFHBDDDHFFFFFBBBDFLDFDJHDJFFBNDF@HAJBFAPCHADALEJAHBLHHLZdNLFDHBFBB@DBHBHDDJBBFD@A@ABCBQAE@A@CCGACCCAE@E@EAECEAIA@BAAC@@BAD@J@HDPHBBPLD@B@FC@A@AACACAA@CCC@ABEBC@EECAA@ABABE@A@A@@@CBABC@ADC@C@AAACE@CAK@E@GAM@CBA@CBEDE@EAE@IAKEIAGCI@EDIFELMAICEEECE@EBEDIBGJKJEPGNE@GDQDGJGDAPEHGLMHALABBNHLLDDJJHJDBTFD@JALAJBPBXFDAF@HCBGBEDEHEF@FE@E@E@I@E@IAECCGEKI@AIGIGAI@EFCFCDAJCBEAGCEEECCCCCCCEACBCBCDEHEHEDCBC@ECGEGAG@ABIDKFENGDCHCLIDIAIEEAAA@CAIBIFMBM@GDK@IAGECGCOCICKGIAAEECC@AAAAA@C@C@EAA@@CAEBA@EDEBC@ABA@CDABAHADADA@A@AA@C@C@ABCBCAAA@@@A@A@AA@CBC@C@CAAC@ABIJABA@CBA@C@A@@@B@BABA@ACAA@CAAAAA@ACAIAC@G@AB@C@I@C@AAAACC@EAABA@GBEF@BAF@H@HADABA@C@A@AC@C@G@ACAAAC@AAAA@C@GACAACCGCICA@OGICMGICCAGCCCEGCIGKEEIEGECIGGGGIGICGCEAA@ED[VMBACAEBG@GAGMEGBQBKKGOEMBIHE\\@TGBE@A@AACACACACAAAAACAAAC@CAC@AA@GGKEEE@GDIJIFKHKHIJODC@GACAAACACCGAA@AAA@AAE@@EAA@@@ACKEEGCGAG@K@IBM@A@@A@AAA@@BABG@G@C@IAK@IFGBC@@@AECC@@AA@AAA@A@@A@K@CCAE@C@@DMAIAEA@CAC@G@AAACA@A@G@K@KBE@G@KAKCA@G@A@CBC@A@C@KC"],encodeOffsets:[[111534,30031]]}},{type:"Feature",id:"422827",properties:{name:"来凤县",cp:[109.407828,29.493484],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@E@C@AAC@A@A@EAE@C@E@ABABCBA@ABCBC@ABCBA@@@C@@AC@@A@AAC@CBAB@@ADCBABC@A@ABABAB@B@B@D@DAB@BCBA@E@AACBA@@BA@A@A@CAAAAAAA@A@ABC@A@AB@BA@AAC@C@C@A@AB@AG@CBC@ADADEDADA@@@CBCAAACA@CA@@A@ABA@CBCDIFCBA@CBA@A@A@@@AACAAA@AAACCBC@ABCB@@A@C@EAAAECC@@CCACAA@ABABCBCBABCDADCDCDA@ABA@C@CA@AAABAB@BC@A@CAAAAAIC@@AABADABABAAA@C@A@@BA@@@A@AA@A@@@ABABABIDCBC@CACAAAAC@CA@@ACA@@CAEACAAACAAAA@@A@A@@@A@AA@AA@@@A@@@@@A@@@A@@AA@@@AAA@A@A@A@AB@B@BABAB@BA@AB@@AB@@@BABA@ABABABCBABABCBA@C@CCCAEAA@AB@BAB@B@B@BCDCBABABC@A@@@AA@@@A@C@GDODABCHCBABC@AACAGCA@ECA@IACAECCCAC@G@@AA@K@KAK@AAEAK@AH[@GBG@ECE@CBAF@HAB@BAAC@CBCBC@A@A@@@A@@@@A@@A@@@A@@AA@AA@@AB@@A@A@@@A@A@AAAAA@@AAAAAAAAA@@@A@@AAAAA@AAAA@AAA@AA@ACACCAAAAA@AAACCCAACAAACAA@AAAAA@AAAAAAABAAiOKCCAOIOGMGEE@E@EBADA@@DA@AFEFEHGDEBC@CACAKCIAE@E@EDEBA@A@ACAECEAIMAG@IBCDG@@@ABADC@AD@NIDC@I@C@EECUAID@@C@EEEEECCAEB@@IDGDA@@@@@AFAHABCFEHIFADCFA@@D@@@BA@GDIFGDABEHCDEFCBEBEDEDABADADA@CBA@A@A@ABADAB@BGBEDA@A@CCCACCAAAC@AA@AACBC@GAGCA@A@@@CFGHCHINGH@BDBBB@D@@CFABGFCDAD@@@B@B@BCFABCB@BA@BB@BJPB@DDB@@BAHFJDFBD@@@D@@@D@@BBD@@@B@BDBB@BBF@BBBBBJNHNCFILCBEFKDMDIB@@@@A@A@@@A@@@A@ABA@@@CH@BFJBFB@DNBFBF@D@BCBC@AB@B@BBFFLBDBB@B@BABADABCF@B@@BDBHDH@BADAF@BBB@BABABGF@BABADCFABEDA@EBCBEBKRGHQFABILCBA@EBABEAC@A@DHBDBDBBBD@HCDIPGJGLELIJCJ@HFFLFHHB@@BBD@DBDBBBDBBBBBDBDBDBD@B@BAFSH[@GFAJFNHPLLRAHANFBH@HAHBFBDNA\\UFCB@FBHDJDJHHHHHDJHFJFFFHLDJFHDDHDDBJDNHJDPHB@JDHDDDBBBD@H@DBBBBD@BBDB@B@H@DBDB@D@B@BABC@G@GBE@AFEHAB@BAFBD@BDBB@B@D@J@DBAH@D@JBDB@BBBBBDBB@DB@BABABA@@@B@D@B@DAB@BAJIBAD@BB@D@DAD@DBBB@B@@@B@BBADAD@B@D@DBBB@B@BCBCBGBADCB@BAD@FAFCB@FADB@@BB@F@D@DBBBB@BDDFFBBHJDLDJDPDHHFJBL@HCN@NAJEAIEGBGLKTIJA@ALCJGFKBIBMAK@A@IDIBCFELIBBFJDJAHBLDFDFB@F@HAB@B@D@DABCBAB@F@FAD@DCB@BADCBC@C@C@C@CDIB@B@D@BBD@DCFEB@@ABAAA@A@A@C@CAAAC@C@ABCFEDAFCB@B@HCLCRCLA"],encodeOffsets:[[112089,30262]]}},{type:"Feature",id:"422828",properties:{name:"鹤峰县",cp:[110.033662,29.890171],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAA@A@C@@@A@A@@EA@@AA@@A@A@@A@@AA@@A@EACACAA@AAA@CACAAAA@CAEA@AA@A@AACAAACAA@AAAAA@AAA@A@@@A@@@ABA@A@C@CBA@ABA@CBCDA@@BABCF@B@@@B@@@DBB@BBB@B@BABABABABABCDCBAB@BAB@B@@@B@B@@@B@@C@CBIAKAOCIEKAIBC@A@CB@@EDEDCF@DAH@H@D@PCP@@CFEFGHEFEBKFIHGDGJIHIHA@GDMHGBEDEFCBEDKBSHGBCBG@GBE@GAI@IAG@G@A@CBE@EHCFEFGFADEFGF@@EDGFEDGDABCBKFIDK@MAKAMAIAG@MCQACAMCMCICQCICKCMAMBIAGDG@GBE@GCGAGAE@EDGDQBI@I@A@ADA@@@@@@@@BB@@@@@@@@@@@@BA@@B@@@BA@@@@@@@A@@@AAAA@@AA@@AA@@A@@@@AA@@@@BA@@@@@@@A@@@A@@@@@AB@@@@A@@A@@A@AA@@A@@AAA@@@@AAC@CAMEMEI@ABCFEDCBEAECECE@EBC@ABCBCDEBEDEBMCE@K@MCI@KAG@K@K@OCIAIAIAMCCFIFGLKJ@HDFFJFLDJDJALANALEPGHINCHCHCHAJBHBHDHDF@FBF@DAL@D@DBFDDLD@BHBJFHDHDJHJHRNLJFFFHDHDF@BBH@D@H@H@BBDNHNHDBDFBFEBCDKFCBFFHBN@TBJBHHHDFDDFBBBBBBBDABABADA@@@EDABEAQQIEUEKBCBFDVLLLLJ@P@DAFBLDHJFPHHLDJHHFFHBHBHDHFJDLHJDNDFALGJEDAFDFFFFBFBHDHCJENEFDFNDTHTDDABAJBDDDFHBDDJHDDJBNBNAHBTFLFFDRHPJPJJDJDHBJFHBJFPH@@JDJFD@D@B@FBB@F@B@B@BBB@B@DBB@BBBBB@BBBBDDFBB@B@B@D@@@FBD@BBFBBDB@@DBB@@@BBBFBB@B@@B@@BB@@@BBBDB@@HDB@@F@DBDBBBB@BBB@BBBBBBBDBDD@@BB@BBB@@BBBDBB@BBB@D@BAD@BF@B@D@B@DABAD@D@PDFBDBD@B@@@DBB@B@D@@BB@DDDBD@HBH@DBB@D@H@FAB@DCBCDEDGBCDABAD@DAF@FAD@FB@@BAH@BALG@AHA@@B@DADGDGBCACAAEAEAC@MAIC@@ECAA@AAAAIBEDCDCJCNCXIBM@I@GBCDEDAPELAJEREFCFADCFEBEEGEGGCECMIKIEEACLCVILADGBGAGEEUMIEGKBK@IBABC@ABAAEEGEEAG@I@G@OGIGECE@ACG@EBGHKFM@G@@CGEGEGEG@@BGBAFEPG`KHAH@DADBBBDBFFNNLDTDLCLCR@N@RDVAB@JBHDFFBD@@@@BFFHFNHLLNJJLJLJB@BBBBB@NDBBDBBBBBBDFBFBB@HFDEDCDCHCFCLEJCFCDAH@JBH@H@JAFAFADC@@@ABCDCDADCBC@AAAACEEAAEAIAEAEACACCEEAAECGCGECACCAC@C@C@EACAACACC@AAC@E@EBG@G@E@GCCAACCCCEC@C@A@@BAD@D@D@@@F@BADADABCBADAB@B@@@DBBBDBF@DADCDAFCDCBA@@BC@C@E@CACACACAA@CDCBCDCB@@@BCDCBCBCBA@E@ABEBG@EBCBABAD@D@D@F@H@J@HAD@@CBABCBEBCB@DADBB@FDB@DBD@DBFBB@BA@A@@@ABAB@BB@@B@B@B@B@@A@A@ABAB@DADADADCDCBEDCDGBEBEDEBCBC@I@CDCBCDGDCBABCDEBEBCBC@A@AAC@CCCCEACCECACC@@C@C@C@CACCCCEECC@@OEIEKEIGAAKEECGEEEIIGGECICMEEAEAGEGECAGEMCECA@C@GAG@ABE@CDABIDKHCBGBEDGBOFCD@@ABABCBA@A@CCCA@CAA@CACAACCCECA@ACAAE@AAEBGAC@G@CAEBA@E@CAA@AAAA@AAA@A@CAA@C@AA@@A@A@A@A@A@A@A@A@@@A@C@C@A@C@A@A@A@A@A@A@AA@@"],encodeOffsets:[[113065,30373]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("黄冈",{type:"FeatureCollection",features:[{type:"Feature",id:"421102",properties:{name:"黄州区",cp:[114.880104,30.434354],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@@BEBEBGDKHEBGDKJED@BEFADINMZCJAJAJ@J@D@H@H@JBJBJ@LDN@J@BBJ@B@L@P@L@HAJABBHAJ@@@F@@DJBDBFBHDF@BHDRBHBHDFDBBFDJFJDDBTHB@BABABEDGDCFAH@B@@@B@F@D@D@BABCBC@E@EBE@CAC@A@AACAC@E@C@C@ABADADBF@FBDAFCB@BAHAF@@@FAF@F@D@DBBDBDFBDDD@B@D@H@FDHDDBBB@@@@DDDBHFBBFFDDFDJHH@HAF@B@BBBBDD@DDHAD@BBD@BAH@B@@@@D@F@F@@AD@DD@DFPBH@DDHB@BFB@DJBFFDDDF@B@@AJGFCJIDEBCDGDC@A@E@C@AAAAA@A@@BA@ADCHCHBBACAG@CACCCECKEC@C@E@EBCBU@G@A@ABIBG@EACACCMCCACAAA@C@CBC@KBCB@DCBA@E@GAICGEEGCKEUEMAEACEICIEIACAGAEAGAI@E@A@E@@@@BA@A@AACAA@A@ABCDCDEDADC@C@A@ECE@@AC@EBEDGDGBQJUK@Q@A@ICKAK@KAK@KBE@EBA@IBMBOBKBC@Q@ABK@KB@@ABE@EBCB@@A@IBGB@@A@CBA@AB@@@@"],encodeOffsets:[[117646,31146]]}},{type:"Feature",id:"421121",properties:{name:"团风县",cp:[114.872191,30.643569],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABA@EDCBEAE@CACBAB@B@D@D@FBDBD@B@BBD@DAF@F@FADADABC@C@E@A@@@A@G@EBCDCHAFABABA@SGCAICIEECAAECGCGAQAGC@ACEAGAEACCICXENIR[^IHABSBO@FDRFRDVBDB\\BH@FBLBDD@BBBBB@@B@B@D@@BBB@BAB@BAB@@@BAB@BA@ABA@A@@AAA@@AAA@A@A@@B@D@B@DADCBADABAD@BA@AF@BABEFAB@@ABAB@@AB@BA@ABA@ABA@ABA@@@A@@A@A@@@A@A@AA@@AAAA@A@AAA@AAA@@AA@@AA@A@@@CB@BAB@@AB@BAB@@@B@B@@AB@@@BA@@BA@@B@B@B@BBB@@@B@D@@BB@@BBB@BBBBB@@B@B@@@B@BA@@BAB@BEBAB@BABA@@B@BAB@@ABADAB@@@DCDEHAB@DA@@BADAB@BAB@B@B@B@@BBBDBBBB@B@BDHBDFBD@FEJGDBFFDBHBFBDBFB@@FBHBF@FDFBFDDBBDB@DFDDDDDDHFFBFBDD@@DDDDDJ@B@FBH@F@F@FAF@B@B@D@D@@@BFBD@BBF@D@BAF@DCD@BBB@B@BA@EBA@CBAB@@@DAD@DAB@DAD@DAD@@@B@BBBBJL@@DBHB@@FAFCDE@AB@BCB@DABADA@ADC@ADCDC@@BBDBBB@BDF@D@D@D@DCDABABADA@@BA@A@A@A@ABAB@B@@@FB@BDF@FBF@DBDBDBDBDD@@@@BBB@B@BC@A@A@A@@B@B@@@D@DBB@BD@B@BBF@B@BB@B@B@B@@@@@@A@@@@@C@E@CBA@@B@BBB@DBBBAD@D@FBD@FBBDD@@BBB@D@@@B@BBDDBDADADADADABA@@BCBABAB@D@BBBBDAB@BA@@@HHFFF@@@D@DEBADABA@@BB@@B@@B@@@@@@@B@@@@AD@B@B@@LBHAHC@@B@B@BBB@@@B@BABA@AB@AAAAACAA@@DCDA@@D@D@F@@@BDBBBBBB@@B@@@FDD@B@BA@@B@@@B@@BB@BBB@@B@@B@AB@B@@ABC@ABADBB@B@@B@D@B@DF@@FBBBBBD@@BB@BBB@DBBBJBFBFFBB@D@BCB@B@BADBDADABA@ABA@C@CBA@H@HDD@BB@@BA@@@ABA@CBAD@DADC@ADCBABADGBADGDIBI@ABIPGHBJAJADCFGBGDE@I@K@IBIFIFEHCHCLCNAH@FBLBHDJ@FADADAFEBADEFGHEFEHGFEHCLCFCFAJ@LBCGCO@ACKEICG@EAEQKCAEEABGBA@MDEJOHG@@@IECGAM@C@CBIEK@@GGGAGCIGACGUES@CCSCUAGAEEGIMCAECOI[@C@K@A@AAECACAGCGECACAE@E@ACEEGECABGAGDCD@BAB@@@BBBBB@B@D@F@BCDCHADCFIJEDIH@BA@E@CCECAECIA@AEA@CG@CAGEO@CCCC@@BE@E@C@@@@@@ABG@AAC@ABCCG@CCCAAAAA@E@GBG@IGECCCEEAAGECACC@@@@AACAGCECG@C@A@C@CCEAACACCAC@E@E@EB@@E@GB"],encodeOffsets:[[117695,31294]]}},{type:"Feature",id:"421122",properties:{name:"红安县",cp:[114.618236,31.288153],childNum:1},geometry:{type:"Polygon",coordinates:["@@GACACAEACAA@A@E@C@C@A@@@A@ABADBD@@@B@D@B@B@@@@@B@BAB@BC@@@CDAB@BAB@B@BAB@BAF@D@BAB@B@@@B@BAB@BA@@BA@A@@@GBC@CBIBGBCBGBAB@@A@@BA@CDEFA@GHABIH@B@@GNCB@B@@@B@@@@@B@@@BABA@@@@@@@A@@@@@AAA@@@A@@@@@@@A@@A@AA@@A@@@@A@@A@@A@@A@@AB@@@BA@BB@B@B@@ABCFCDABEFEFEDGBEB@@E@G@E@CBBDADAFCD@BEFCFCH@F@DBBDB@DBFDBFBHDDDABAFBJDH@@BBBBFHDHBLBBBDDFDFBBFJ@FGHAH@F@@BFDDFF@BCDEBCFADAH@D@F@DEBAD@D@DCD@@CBA@CBI@GB@@C@C@G@@BE@@@ABA@CFAFCFCDEDGDEBA@EFCFC@EACAE@AB@B@@AHAD@D@F@BBBD@D@F@F@FBBBBD@DCDEBKDGBGBC@EBABDDDD@DC@CBABEBEBAAE@GAGDADABEBC@@BA@CFABCDCDCDCFGDCB@@AD@D@DAF@B@@AFAH@@AF@DDFDDHFDBDDBDDFDHBHDH@@DHBBFDDFBB@D@HDDBDDBBBDBBB@D@F@@@BB@@@@@BB@@@@@BB@@B@@@@BB@@@B@@@@@@@@@B@@@@@@@B@@@@AD@B@B@@@B@@@B@B@D@D@@@BADAF@BAB@@@DAB@BADBDDBBB@@CDEFABEBEFAH@D@FBFDDDF@FDF@FDHDFB@FBF@DBFBBDDHBD@B@BBFAH@HAF@FB@FDF@BA@@@@BCDCDCFEJAHBDBDFBFAJ@JAH@D@D@F@F@BBHBDBD@BDH@@@@BFBJBFBD@B@BDDFADADCFCHAFBHBBDDHAF@DADAJAFAD@BAFCHCF@BCH@F@B@D@DAFBF@B@DDFDD@@HF@@@H@BBH@@@BBBBDFDBBDBBBBHADCJ@DBFBDD@DA@GBCDC@@H@HAN@D@HDFDDFDHBLFF@GBCBCPIHCB@B@@B@H@@DB@@JB@@L@B@DDFJDN@DB@B@J@F@D@DBFBHL@BB@@@FAD@BABBDBB@B@@@LALCF@DGBCAC@@F@F@DABAFGBC@C@CBADA@@FIPQHEH@@@T@HBLD@@BAB@BC@ECIEG@G@A@@BCBAF@HB@@TDBBDA@AB@BIBADA@@BB@@NDTHBBBAB@FCDAB@@@B@DDLB@@DCDABA@@B@B@B@JCB@DAD@DBB@B@@A@@@AACECAA@C@C@I@EBCFCJGBA@A@AACAA@A@ABCB@DEB@FA@@HA@@L@H@DB@@HAHAFCPM@@FGFOECAGGUCIAE@ABGDQ@IBGBI@GAICECGCC@IAAAGAAAACCGCCCEGAEAEGECECACEGCGEGACEEI@C@C@EBCBKBGBI@G@ABG@C@CBEDGFEJGHCNKDCDADCDG@CAE@ACGCEEM@@@@MMGIEGECGEECECCAGCEEBGBG@IAAAECEEEGGCEEGCEEECICE@ACG@AAC@EAAAAACAAAA@C@ABABABABABABCB@@ABA@AA@@A@@AAA@A@AA@@@A@ABABAD@EI@EDCFCB@FG@EDCBADCEO@@BCBABA@@BADAB@BCDA@A@AB@DEB@@@ECC@EAAACAECCCA@CCA@EACAGAGAEAGCCCGAG@EBKBGBGBK@GCGGEOBKDEBCBCDGJEHCHCBADAAGCGEEGGECEGECEE@IDKDGFGFEHCFCJCDG@ABAB@B@@BB@@@B@D@@@@A@@@A@ACC@@AAA@@@A@@@A@@@E@AAAC@@@@@A@@@@@@@AB@@@BABC@CCCAEB@@A@@@AB@@@@@BA@@@A@@AA@@@AA@@AA@A@@@@ABA@AD@@A@@BA@@@@@@@A@@BA@@@A@@B@@@@@B@BA@@B@@@DA@C@@@AEAE@C@EBED@@@@@BAB@BBBBF@@@@@B@@@BA@A@AA@@@@@A@AA@@AA@@@C@C@@@C@CBA@A@A@@@CAAA@@A@AACAAAA@@A@@@@@A@A@C@@@AA@A@C@@@A@@@A@C@@@A@AACACCAACAA@@@@@A@@@AB@@@BABCD@@@DAB@@@B@@@B@@AB@@A@AA@@@A@@@A@ACCCCACA@EA"],encodeOffsets:[[117391,31675]]}},{type:"Feature",id:"421123",properties:{name:"罗田县",cp:[115.399222,30.78429],childNum:1},geometry:{type:"Polygon",coordinates:["@@I@GCKAEAG@MBKDGDGDEFEJAJ@J@L@JCFAHEHCDIBIBGAOHPNDDDFFFFFHFHFJFFDB@HDJDDFAFAD@BCJ@D@DBFFHFDHBD@HBJBL@DDDDFHFBF@HAJCF@J@HFDFDJBHFFHCFCDCHCFEAE@G@GBMBALBLFFBH@JBFFFH@@BJ@@AFEHCHABAHDFFHBLFFFFFBJAF@FCHAHEHEF@DAH@J@J@F@J@D@F@H@F@JBBBDBFHBFFHDFBF@HBJDJBJDJJP@FBHJ`@@LRDPDLDJDHDHFHFFLH@BFHFFFFBBHDDDBBBB@BBB@HBBBDBB@D@B@B@H@B@BB@MAICE@EDCBAHBFBDFBHFFBDFHHFDFFDJBF@H@HBJBDBFBFEDEBEBEFED@FFFFHFFDDFDFDFFFDDFHFHFHBHDHDFDFDDHBJDNBDBHFJDHFLDHBFFLPBHBHDD@HBH@FAFAHCDAFCFCFGHEDEDCFIBEHIBEBCBEAE@C@CAC@ABG@C@G@C@A@EBE@K@GDGHKFGDEHIDGBEBCB@FEDCHEHCHEJEHAD@LDBBFFBFDFBFBJ@HBHBDDDHBHBHBHBFDJHFH@FDHDHDFDDBDFDDBJBHBJAF@HALALARCJCJAHCDCJIFEDEFCFEDCDCDADCDCDEDIDGDGDEFEFCFADCDADEFCDIBCFMDG@EBEFEFELAFBCQCM@SEKEGEGGCECECIAKAGAIAI@GDGBCBIAA@CCEECGAGAE@CAGCEEE@@CC@CBIFAJ@FAB@HAFCDEAEAEAG@GAEEGEECCKGKEECCAIKMICACAEAIIAAECEEIEIEKEGCKCA@GCKEECECGEGGEEGECCEGEGEGCECICGAIAGCGBG@G@GAECECEEGEGGIMOIKEEIICGACAEAICEAICCCI@G@IBABCFAF@H@JDLHHDHHHFBFFFHH@DFFDBHDDCHEDEFEHAHCFAFAASCOAECCGI@C@AB@TGBCKKBANUBG@CBCBC@G@I@C@@@CCEAEAAACAAACCCAEAEFCFEFEDG@E@CEKGCGEAAIEGCGCGAIAGAEEAE@A@G@GBKBGAGCCEEGCE@IBCAAC@CBIBG@@@AACCECCCECAACK@CAEBG@ABE@I@GDEBEBCBGHEBABGACCCEEEAECEAGAGAGBKCGGCK@KAOCIAI@IBGBILK@SBDH@HMNCH@F@FAFGBEFCFAFABENKNCDG@CAGCCCGEE@IAEDED@FAF@LCJCFCDEACAGCGAKAEBGFEFIDKHEHEDEDAH@HBFDHFFBBDHDDDHBDBBDHDFDHDFBJ@DAFAJBFBFFFDDAF@FCJCBCBGDEFAFABAL@DBPMJEL@J@J@BCBE@S@GCEAOKIAIDC@KBC@I@ICAACACAOICA@@OKAECKKAI@EBEDKDGDEFGHEFGFEHCFABEFCBCBEB"],encodeOffsets:[[117946,31550]]}},{type:"Feature",id:"421124",properties:{name:"英山县",cp:[115.681359,30.735157],childNum:1},geometry:{type:"Polygon",coordinates:["@@IECAEAKGKAELCH@D@HAF@HAHCLEDEFAHBF@H@HDHBJCHGFK@KBGCK@E@I@I@G@E@E@IBEFIJ@H@H@JGHAJDJBDDBDFDDDFBD@B@@AHAJ@DBDDBJAF@HDFFDDBHAHAL@H@H@BBFFFHBJBHBHDHDJFBBHFHDFL@D@FCHEFEFEDBFBFDDBDBBBDBBBFDF@D@@@D@J@HADAD@DAHMVABLLADSHA@@B@DHJDDBFDPBTEBEBGDGBEFCFGFCDGCCAEE@CGGEEAEGEGGGCKGICG@E@EBADAB@J@HDJDDBJDFBJBFBDDHJJFFJLNPHJFHFHDFDFBF@H@HAHDHBHBJDHDJDFFHFHFHDDHFFFHHHFFDFDLFHDB@LDHDLFJFJFFFFDBBJJFBDBDBNJJLDBFDLFLHDDFFFHBF@HBHBFBFCFEDGBA@EBI@EBAJ@DDD@@FFDFBH@DBFBHDHFFDDB@JBDAHAHCJ@JBHBLBJBFDFDHDFHFHFL@TDNDRFBDDLHDJFDHBHBFBB@FBHBHAFAHCF@FAF@F@DBHDFDHJJHHFLFHBFBHAFEHCDKDEDK@K@GBG@IFGDCBEBCBAB@BABABABA@@BA@@@@@@BA@@@@@A@@@@B@@@@A@@@@@@@@BA@@@@B@@@@@@BB@@B@@F@BB@AHGJG@@NKJCFADAHAB@BEBE@IAG@GAEAEDGD@FAVFJDHALAFCF@F@DADAJE@AJEL@HBFADI@GBIBGBEDADAFAF@LDRBD@DBNALAJ@B@B@@EACBGBE@I@CDCBEBMBIDCBGDE@EBGBG@COBM@G@GBG@G@CAACCECCGDE@EBEAGAIAEAGEECEAECCECCECEAG@GAGCCCCC@ICCECICOGCCAAMIGCEBCAAC@CESA@CAGCI@C@ABC@E@CAEACCMAOEKCEEGGCCGCGAECAGAC@C@ACCCAECIKEEAEAE@EDGBCDCHEJGFC@IAEBCFGAGKMCC@EBCBAJCHBB@DC@CCCAEIEGCKCCCACBEAGMAA@GBC@E@ECGCECC@E@CDEDEHCBEBG@KAI@ICECCGMmGSCECCACACAEBQBG@CCACCAA@EDCFCDAFEFI@ICBI@C@A@AC@ABIBCACCE@AACCCCAC@KBCCGEKGEEAAKIKICCCEEE@E@G@E@A@EGEECCCCEAA@IAGAEAGAG@G@IAGAKAG@CEEEIEEICE@KAGCKCGEGEGE"],encodeOffsets:[[118430,31258]]}},{type:"Feature",id:"421125",properties:{name:"浠水县",cp:[115.265355,30.452115],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAIAK@MAGDIFADABCJGJC@CAAKEKCKAG@CBE@KBE@I@C@CCEECICEAGEC@CAI@KAE@@@CAIBI@MHEFEFEFGJIBIAIGCCA@ACEEEGCG@@@AAA@@@@AECC@AACCGCGCGAIAEAEACCG@AAAAEAKAGAKAGAE@AA@@GCGCECACAC@E@G@GFIFIDK@EAKEGEKGAAEAABCBQACA@AAMIAEAKCICECAEACAGAGCIAEGGGCECIIEA@@@@GCC@KAIAOCGB@@IBKAICA@IAEBICC@@@@@AAIAIAI@EBICEAAEAE@EBEFEFEFGAA@CECAACAC@CAGAGA@@ECCAAAEECIAGACAECEEGCGCAECCAGCECA@AACCCC@IBCBIDGAGCGCECEAGACG@EBE@GBABA@C@EBEBCBIBA@EAGAGCAAKG@@GCKAC@A@O@KBOBIBIB@@CBC@GDCBIFMFIJCDABEF@@ADABCHAB@B@B@F@FANBHDZJRBFBP@B@@@H@F@BALCNAJ@DANAFAJAH@DAHCJAFAFADCJCJELGNGJGJGDIHCDGDEDA@MDC@UDA@IVARCHCHAF@FBD@@DF@F@B@DCDCBCFCDAD@B@BBBBD@B@BAB@@@@@F@B@FBJBHBFBHBDFJDJFJBDBFFNFVDLFHHFJDHBF@B@DA@CDALAD@DAD@B@BBBDDDDNBDBD@FAHAJ@B@B@HAVAD@F@F@DFDDLDFDDDBH@DBFDFHDF@B@FBFBDFDDHBHBDFDBBB@L@D@\\@PJFDDBJNFHBFBHDVDT@DFTHVBDJHHDHBHH@@FLAJ@D@DBNDHJF@@H@PGFINCB@HABAFFDBRLBF@FDHFJDL@BDPDHDLBFPL@@DBPJDBDBBBJDJ@D@LAD@JCJBPLFBHDT@F@DA@A@I@IFKNIAO@CBKBABEFEHCDADADI@EBECCEEAEAEBIBE@CAICECGCECGAAACCGCCCGAAEECGAE@GBGFCFCFGLGJCFEHEFALBHBHDDBFBDCDEDI@KBE@EFCFCJBF@HFDDHDDBH@DCLMFMBABEDEFEHABE@E@EDGNM@GCGTAL@JKHAJAJ@JBPDLBL@HDDHALBHBHBHDFBFFFDFDDHBBAFAHGDAFAFAHCJ@F@BAH@FADBL@CIBIHG@I@G@GJIFEJAF@F@H@J@J@F@L@HDLAL@HEDGAICG@G@GAEBGFEFCDKBG@GBE@G@CDGFK"],encodeOffsets:[[118408,31248]]}},{type:"Feature",id:"421126",properties:{name:"蕲春县",cp:[115.437007,30.225964],childNum:1},geometry:{type:"Polygon",coordinates:["@@BDBHDFDFDHBHCHAJAD@JDDDDBBB@FDHDDBFDDBDHFHDFBFBDBHDJFFBBDBFD@@HBHBDBD@DBBBFD@DBBEHEFEFAF@FBFBFFBJDFAJ@JBJBBB@@@@D@JDFAJBB@JDLBJA@@HAPDJBLBD@HD@@@@FBJJFDHDHHBFDJBHBHBDBFFDJDLDFBJBBN@BDBRBDABAFBBBLHHFLFFBL@JCJEHEH@F@D@DBDBDFDH@HB@@BBFBHBLBHBLBFBB@BDHBDBFBFBJDHDHDHBD@BDDBF@@@@BB@B@@DHFHFFBDB@DDJHJBJAHIFEFEFENGJ@JADB@@F@LBJ@DBD@HFFBJDFDDF@D@D@JAF@LAF@DBHDLFLBLDBD@HIDIBABCJEHCNBL@JBDBLBLHFBDBJFHFHFHFLDHDLBF@JDFFFJFF@DBHBLBH@J@HBHBHBFBH@JBBDFDDFDHF@F@B@F@H@FFFDFDDLJLJBBFFLHHFDDLAD@DBDDBD@BDFBDADAJ@BBDB@D@J@DAJ@FCLGF@FCFCBE@E@EFEDAFAHEBCDA@CBA@GBI@EKK@AACFMJMFGFCD@BF@FBDDDFAFAHAD@H@JAHCDIDGBCFAF@H@LGDADABCDCAEEKBCNQ@GDIBG@EDAPEHC@@DGBCDI@CBCDAFEFAD@F@D@BACCEEIGCCCE@CCCCIAEA@AGCGAC@IBCBEBC@EACGCAC@AAA@GBEDCF@FAFCDCDAFAF@DABCBE@GBC@C@@BADAFBFAPARCCGCCGCABGBCBE@GCECEKCIIKCAIAG@CCCEKIECEG@A@CFKLO@AFO@AHILGFEHG@ABADC@C@MCC@AGEGEEEACDG@@JCHCJCHECKCGEEGAGAKEIAIAIACAEAGCGAIEGAKCEAEAGCKAGCGEAGAA@KAIBG@@@CAIAICCACACAAICIAEBGAECCACCECCGGKCEEECAECIAG@IAMAE@IAEACAG@QEICE@OESECA@@IAM@K@C@EBEDI@IBG@IFCDEFEDWRKHKHIDKHEBKDGBKBK@ECC@GECECICC@CAA@@CCIAK@EDGBGDGDABEBIDK@@@CAGCIEA@IEECCGEEEEGGAECI@IDGDIBE@GACAACCAEAGEGCECE@KDK@E@E@GEI@AAECKEMAICEAAACEM@I@@AIAE@EAAAE@E@@AAACCGAEAACC@CACAE@A@EBG@G@CAGAG@ACAEAGBG@GDGDEFABEDEDGBE@G@@@ICECIEEGAGGUCCEAE@@@GAC@EBCDEBGA[OIRGNCFEDCFEDEDCDCBGDEDEDEF@@EDCFABCHCHAHAF@D@H@FBH@JAB@F@H@BAH@DAJCFAJGPIN@@EJCHEHEHABEHEHAB@@ABABCD@BIJAD@@AB@@MPGHIJ@@ADGDKLIHCDKJEHEDABABCBEH@@A@GFEFCDABABEFCFCJ"],encodeOffsets:[[117975,30939]]}},{type:"Feature",id:"421127",properties:{name:"黄梅县",cp:[115.944219,30.070453],childNum:1},geometry:{type:"Polygon",coordinates:["@@EA@@GCCAEACAGAMEIAC@OCG@EAA@S@@@A@iFE@kPoPEBeLKDCB@@YPA@ULMJA@MHcXSJMJCDYT[XKHCDDFBDTLDDF@D@L@NCB@DB@DADCBCBIBEBADGNIJEDEDGLALCHBFDHDBBBBAH@HBJ@B@B@DBR@B@L@DDB@BFMF@@@@CBCBAJ@FDDHHJBB@LFHFBDDFDFD@@BBBBDBBDDFFFFBBDDBBBBFFHHB@DFDBHJBDLNJJDDHH@B@@ABILEDKJAFEHGJCHABGFCBA@GFCAC@I@EDEDAHBB@DDDDDB@FDFFBFCJ@LBDBDHDFDDBDDDBHFBDDBJHFHDHBD@BBD@BABGBABEDAB@DADABA@C@C@EAGDCBKDGFCDABEDCDKLABEHCHCHALAJAJAB@J@B@F@DEH@BKLABCDCDGP@N@LH@DBFBJBF@NBJBH@JBFDDBFFDFHLDHFDDDDBFDHBFAJBJDBBBDBDDDBJBJ@D@@AHBJ@LBBBHHFHDLBHDFBFBLDHBJFHBHDFBDBJBJBJBLFHBHBFFDHDLHDFBTFD@FAJBFAD@JAFAJEB@DAB@JCFAFCFC@ABABE@@@GBIBKBC@IBC@CDEFIFEHCD@J@FAB@BAPI@@HCDCDK@EDCBCF@HCB@DAJ@DCHCFGB@DADEBABCDEDC@ECOEIEGACAC@G@KBCFSBABE@@BGHODE@@DCDEDEHCBADAHEBKBIBK@EAGAEACCE@@@CAE@GDG@@@@@G@EEICIAA@EBIDEBADEHIDI@@BA@E@IACAEGEAAEIEG@G@E@CBCBC@SBI@E@A@ACICCCCCE@E@GDEDGDAB@FEDCHILMJMHIDE`cHKDCBA@CBCFG@ABG@EBC@E@EAEAE@E@M@EAIBM@KBED_SGGCA@UIEASMSMSOCAOM@@][GEAA_M@AGCCAEAOEGCKCCAAAAAGA@@AAEAAAA@CA@@"],encodeOffsets:[[118775,30449]]}},{type:"Feature",id:"421182",properties:{name:"武穴市",cp:[115.561217,29.844107],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAACCAAEEEECCAAACAA@AC@CECEACGEKEA@IAGGCC@EBIDADA@@@@NEAEA@CCK@A@Q@CAA@A@I@GAG@ABAACACGAEDGBKHKFCFCJIHMBCFAJADADABC@CCAA@MDK@C@E@CCSKACCEABe\\OH_N_DIAI@E@@@CAI@EAE@EAEA]CIAE@CAI@C@K@GAC@O@K@A@K@@@KAG@C@IAE@C@A@I@C@OAMBIBA@E@IBE@A@KBcH[NKFCDC@GFGDEFCDEFEHEHCNCJCJEFEHGFGDGBGFMNAFAH@LDJBHBH@HCJIFMFGDEFIHGLABIPEJ\\PHBFADCFAD@HB@@F@FBDDHVBHFHJFFDJD@@H@F@HAFCFCBAFEHCHCH@HAFBDB@BBHBH@D@HAH@F@BBFBD@DDDBBBFDHBDBB@@@FBFBB@FBFBJ@@@JFNBDBBDFBJFNDLBF@BFJ@H@F@FCL@LDFDFFHBHBFDDBBBD@HAFCJCH@JDJBFHHFFFFDHFDJFB@JFHDDB@@L@JCFABAHCHCHAFCL@JBDD@@BB@DDDDJDFHFD@FDL@LAHALCFALGJCLGLGXQFCFEDCJEH@JAJ@FCFAD@L@N@JB@@DBTFPFF@JDRF@K@MHODCDCBALK@AFG@C@E@A@IBABIBIBKDGDGFGBALKDCFCBADCHELCDAHCFBD@D@B@BABC@CBAFCBAHABA@AAC@AACCGEGIGCAACGECACCCAECGCACAC@KDIAEEEECA@CCCC@CAABGFCFCJ@D@DBHEB@DAHEBADGHIFGBELIFCJKBA@@@AGGCCIIKMACGICACEA@GGEE"],encodeOffsets:[[118581,30660]]}},{type:"Feature",id:"421181",properties:{name:"麻城市",cp:[115.008163,31.172739],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@@@@A@A@@@AAAAAA@C@@@A@@@A@ABAB@B@BB@@B@B@@@B@@@@@B@@@@@BB@@@@@@@@@B@@@@A@@@B@@@BB@@@BA@@@@@A@@@@BABA@@B@BA"],["@@@DAB@BAB@@AB@@AD@BB@@D@B@DDFBHDB@AB@@@@@@@BA@@@@@@@@@@@A@@@@@@@A@@@@@@@@@AB@@A@@@@B@@@@@@@@B@@@@@@BA@@@@@AB@@@@@@BB@@AB@@@@ABB@@B@@@@@B@@@@A@@A@@@@A@@B@@@@@@A@@BA@@@@BB@@B@@@BB@BB@@@B@@@B@@BB@@@@@@@@@B@@@BA@BB@@@@@DD@BBDB@CG@GH@LKDOGMA@AA@@@@@@@@@@@@A@@@@@@A@@A@@@@@A@G@C@@@@@@A@@@AAAA@@@@@@B@@@@A@@@@A@@@A@@@@A@@@@@AB@B@@@@@B@@CB@@AD@BABABAAAACA@C@@@A@@@@A@@@@@@A@@@A@@A@@B@@@BA@@@@@A@@@@B@@@@@B@@ABADAFADAB@B"],["@@@@@BCACCCACAECA@AA@@CAAAGBC@C@EBCBA@GAEAC@CCAACAAAECGAAA@AAA@@@A@@A@@AAA@AA@A@@@AAA@@@A@CBAAEBA@@AAA@AAABABABCB@D@DA@@F@B@D@BABAB@BA@@@A@@B@@A@A@AAA@@@AB@@@@ADABC@@DABE@A@@AEECEACIAAAC@@AECCAA@@A@CA@@A@C@A@@@A@A@C@A@C@@@A@A@C@A@A@@@@@AB@D@@AB@B@@A@C@A@A@A@A@ABA@@@@AAA@@C@E@A@@@@F@@AB@B@BBB@BA@A@AAA@@@A@A@CBABABAH@BA@A@@B@B@BA@A@ABCBA@A@AB@@@B@BB@@@@BBB@@B@@BB@@D@BBD@F@BABAB@@AB@B@B@B@B@B@D@B@@@@A@@BA@@@A@@@A@@@AACAAA@@AAAA@@A@@@@AA@@@@@@@A@ABAB@@@@@B@@@@B@@@B@@@@@@@B@@B@@BB@B@@@BB@B@BB@@BB@@A@@@@B@@@B@@@@A@@B@@@@@@@@@@@B@A@@@A@@A@A@@@A@@@@A@@A@@@A@@@AA@@@@A@AAAAAAA@@AAACAACCAAAA@@@AA@@@C@EAC@CACAAACAC@ACG@CAA@@AAAAAAA@@@A@@B@BCDAD@B@@BBBBB@@BBB@B@@@B@@AB@@@DAB@BAB@B@B@BB@@@@BB@@@@@B@B@@@B@@BB@@B@B@BA@A@A@C@CAABC@@B@BBBBBADADC@C@A@C@EAC@CCA@AACAEE@E@@A@CAC@CCCAE@@@A@A@A@A@A@C@A@CAA@AA@@@@A@AB@@AB@@@@@BA@@@C@A@@@A@@AA@A@AB@BCHIDEDGDEFEHCHCL@JFFFDFHFDHHFFDHBHCBABGDGDIFCHADADCFALFPHHHDL@HAHALAFAH@HBDDHDFBHBHBDBFBB@DDB@DDFDDBBBFBD@FD@@A@CFA@@B@BCBADA@CBAB@@ABABAD@@FPCDABCD@FEHA@EDCD@FFJC@ABAB@B@B@@BBB@B@BB@@@BB@@BAB@BA@ADABABABABAB@B@DBBBBBDBBBB@FBD@BDH@BDFDJFFDFFHDFHHFFDFBFBB@JAHAHFFHDDBFDFDHFFDFHHJNN@@@@FNDFDH@BBF@DCHCDCBCDMLGDIHEFCHAF@D@DAH@B@HAJAHALAD@F@D@DFJDFHBHFHDDFDBDFHFBFBFFHDDHDDDBBBBBHBB@JDDDHDFBJ@HAJAH@JCRAH@BBFDJHVBHFDJ@D@FDDDJLBDDHDDD@RDD@LBH@DA@ABK@AB@NAD@HDHDDBJBJ@LDFBDDBBB@BBFAFALKHEBAD@@@BD@FBDDFFBHFJHHDDBBBBBBDDBHBH@D@DADABCBOFIDCB@B@D@BABAFGDAD@B@BDDJ@J@DB@JDFBB@@DADQP@BBDD@LFBBFLBBJPFNBB@@F@F@BA@G@CDALG@@HAD@HBB@B@@@@A@A@@@@DA@@@AB@BBB@@@@@D@BAB@B@DABA@@BD@@B@@ABA@@@AD@BBB@@@@AB@@@B@B@BBBAB@@@B@B@BAB@@@BA@@B@BBB@@AB@@@D@B@BABB@@@BBAB@@@@@B@B@@BB@B@BABB@BBBBD@@@BA@GB@BAB@@@BABB@B@JBFBJDNLBD@BABKJCF@D@FDFFD@B@BABE@EBAD@B@FBHFHPJLFDBHBBBBNAFCJAF@DBBTF@@\\DTBL@FALCB@BADC@C@C@I@IDKBC@@HEFCHAB@BA@AAC@C@ABAFAD@H@D@FBB@B@BCDIBCFAH@NBJBDBBAB@B@@A@@@AECCG@AAE@CBE@AACCEEKAGEECC@CAC@@@C@@@CBE@A@E@AACGKCC@A@EBA@@DGDEBI@K@C@CAICACG@@CE@GAIBGBCDCDCFMBEDAD@JBHBFAHAHCFAF@D@@@BAJKB@DE@EAGOSACAABANEBADAFBDBHFHJJDFBB@HAHAJEFIHKDAHANBHFJD@@B@HFDHFHHJB@B@JAPED@D@JDHBFDF@HBFCVQHCBABCACCIKKACKCAAA@@CPQ@A@EAAQIAAAA@ADA\\ERAFADCDEDAB@B@BDBFBBFDHDHFDB@@BCBCD@D@DBBBB@D@F@BADADABCDID_BEDIDCD@D@DBFFB@B@BA@CBICCCE@E@GAI@CCGAC@EBA@@BA@@@@B@HCFEDA@EFIDCF@EKAECGEKCGEIAGACCMAICGECECGCGCGAGEGECEECEEECECCCEEEGEE@EFCFEFAFAFCAEAEACAI@G@GAECIEEECGGCEEAGEEAACAEBGDAFCF@JDNBA@@A@A@G@A@A@CAAACAA@GAA@AAAAACCGCAAEEEEEG@AKGEEEGCGCGCICKCOKQ@@I_AG@EIOCIAICIAI@GAECEEGAEEGCAAAIAE@G@E@C@I@E@I@I@G@CBE@GFGFGBEDE@IBEAEEEEAKEGCEBGBADGFGBE@@AI@@EGEEIAG@EAKEKAABAN@H@HBFEFGDCDEDGDEEAGCICEGEI@E@IDGBE@EAEGCCCCK@IAGAC@GAECEGAE@C@CDI@ABCBECEICGCA@ECIEGEGEEEEECECCOMAJ@BAJCJCHABCHABABCD@BCDCBC@AB@DAB@B@@AB@@AAC@GCG@CACBG@EB@@@@A@@@ABA@@BA@@B@@ABAB@@@@@B@@@@@@B@@@D@B@@@B@@BBB@@@B@BA@@BA@@@AB@@ABA@ABA@@@A@@@A@A@A@@@A@@@@@ADA@AB@@A@@@@AAAA@@AA@AA@@GDCB@@EDCDCD@B@@@BB@@@@@BBBBDB@@@B@@BB@@BBBB@B@@@B@@A@GDCBA@CA@@AA@@@A@AA@@@@@A@@@ABA@@@C@@@A@A@A@@@@@A@@@AAA@A@A@A@A@@@@@@BABABA@A@CBCFCB@BEB@@@BCFABADCD@@ABA@@@@@A@@@@B@B@@BBB@@@@B@@AFAD@@AB@B@@@BB@@B@@B@@@BB@@@@@@@B@B@@@@@BB@@BB@B@@BB@@B@@D@@@B@@@B@@@@@@@@BADAB@@A@@@A@@@A@@@A@@A@@A@@A@@A@A@@BABABA@AB@@CB@@@@AB@D@B@@@B@@@@BD@@BBD@B@@@B@BBDB@@BBBBAB@@@BABA@@B@B@@@@B@@BB@@B@BAB@B@@@BB@@@CBAB@@AB@@@@@B@@@@A@@@@@@A@@@@@@@@A@A@@@A@AJBH@@@JDF@HCFADCFEBEAAACCACBABCDCBE@CBEBCACCECC@C@@@@@@B@@@@AA@A@@AA@A@AA@@@@@@@A@@@A@@@@BA@@@A@@@@A@@@AAAA@AAA@@@@CAC@C@ABA@A@CB@B@@@@AB@B@@BB@@@D@@@B@@BDBB@@A@@@@B@@BB@B@@@AHNCPKLG@@HDHA@AC@ACCABAB@@A@@B@@@@@B@@@B@@@B@@@B@@@@B@@@BB@@@@@@@B@@@B@@@@@B@@AB@BA@@@@@@@BB@@@@@@@@A@@A@B@@@@A@@@@@A@@@@@@@@@A@@AA@@@A@A@@@@AA@@@@@A@@@@@@B@@A@@@A@@B@@@@A@AB@A@@@@@A@A@@@@A@@A@@@A@@A@A@@@A@@@@AA@A@@B@@@B@AA@@@@A@@@A@@@@@ACAAGCE@C@A@CA@@ABC"],["@@BB@@FCBGCAEA@B@H@D"]],encodeOffsets:[[[117746,31739]],[[117683,31753]],[[117680,31759]],[[117642,31725]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("黄石",{type:"FeatureCollection",features:[{type:"Feature",id:"420202",properties:{name:"黄石港区",cp:[115.065849,30.222938],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BABAB@BAB@@AAA@AA@@C@A@@@@@A@CAG@E@C@A@CDABEDA@ABC@C@A@G@QBK@EBA@ADAB@@BB@D@@@BA@@@B@@DADADC@EBE@EBA@E@A@C@ABCBA@A@B@BB@BB@BB@@@BBB@@B@JBBHBFFFBBP@@@H@B@HBHDB@FDBBFBBAD@@B@@BBB@BD@BDDB@L@B@JAB@JAAGBM@E@E@A@ABADGBABC@@FEBADCJINEGEACAAFC@A@@@AB@AA@@AAA@AAA@AAABAAA@@@@@@@A@"],encodeOffsets:[[117853,30931]]}},{type:"Feature",id:"420205",properties:{name:"铁山区",cp:[114.891605,30.203118],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@@A@@@@@@@@@A@@@@@@@@@@@@@A@@A@@B@@@@@@AA@@@AAAAA@GD@@CAE@@@@AGC@@C@@@CBA@AAA@@BABCBA@A@ABCDAB@BAB@D@B@@@BA@C@ABA@@@A@@AA@A@ABC@EB@@@@A@@B@@@BCA@@AB@B@B@B@@@@DDD@@@@@@B@@@B@@ABB@AB@@A@AB@@ABEDEDA@ED@BCBABABAB@B@B@B@B@BAB@@ABAB@D@B@DDBDD@@@B@D@B@@B@@@H@DBBCFCHCRAHAFADCDAHAHATEHCHAFANAFADABABABCDC@CGG@ACC@EAEAAAG@AA@@AA@A@AA"],encodeOffsets:[[117686,30915]]}},{type:"Feature",id:"420203",properties:{name:"西塞山区",cp:[115.109955,30.204924],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACCCCA@@@A@AA@@AA@AAAAAC@@CCAA@AAABA@@@@@AA@AAA@A@A@@B@@ADAF@B@B@BA@@@ABC@GA@@A@@CA@A@@@@AE@ABA@@@AACA@AA@ABA@CBCBA@G@C@A@CACBCBKDEBEFE@A@A@A@@@@@CAA@CBCB@BAD@BAF@@BB@@B@B@@BBB@FBD@B@@@BAB@B@@@B@@@DBB@B@BAF@D@B@DBBAB@BABAB@B@B@BAF@@@D@BAB@B@D@@ABA@C@A@AAA@AA@@A@A@ABA@AACAIA@AA@@AA@A@AAA@CAA@A@ABCB@@EDADABA@@@A@AAA@@CAA@@A@A@A@@@ABA@GACAA@AC@AA@AAEAC@A@CB@@CBGDA@A@CAA@CACGCA@CAAAA@@@C@AAE@A@AA@AAC@A@C@EBAB@@ABBBADABABEBEBC@I@C@A@CAA@C@GAA@@@EB@B@@CBIBABCBAA@BCBAB@@@H@BBBBBD@B@HCBAB@DAD@DAB@@@B@BBD@B@JJBBBDBDBDDDBF@@BDD@BBDBDBHDBBB@DBDDB@B@D@F@H@DBB@@@@@B@D@@@BBB@BB@@BA@ABABA@AB@@@@@@@B@BBBABBB@BBB@BB@@BBA@@B@@@BEDBBBDHFJEDAHCD@DA@@JAJAPALAP@B@D@LBHD@@LHBBHDHBFBB@JADAFAFAD@B@BAHAF@FAH@DIDEFEBABADCFEHEB@@@FGDABABAFCFGLIDCJGLKHCBC@@JIHGGBG@EBGDCBAAAC@CACAA@@CA@A@@@ABADAB@BC@@@@@A@@A@EBC@A@E@C@CBA@A@"],encodeOffsets:[[118006,30866]]}},{type:"Feature",id:"420204",properties:{name:"下陆区",cp:[114.961327,30.173912],childNum:1},geometry:{type:"Polygon",coordinates:["@@GCC@IAE@GBIBA@A@G@E@A@I@E@A@A@C@EBADABADCBEDABGD@BCB@@CD@B@FBBDDBB@B@@F@DB@@HCB@BBBB@@B@@B@@@@A@@@@BB@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@BBBB@B@@BB@@BBHBBBF@FDD@BHH@DB@B@F@B@BAB@BABADGBADAD@@@F@D@DB@@DB@BBB@FBBBBB@D@BBBD@@B@B@@@D@D@BBFDD@B@BDDAB@@A@@DCFCBA@@BA@@@@B@@BBB@@B@@B@DB@@D@@B@B@BBFBBBB@D@B@CDB@ADB@@B@B@@A@ABA@CB@@@@@B@@B@D@@B@@B@B@DABAD@B@F@B@FAF@FAD@BCBC@CA@@@B@@A@@@CAA@@BABCB@FAL@RAH@B@D@D@BAB@FCBADCA@CCCAA@AAGCCACAAAC@AC@@AECCACACACAAIIA@C@AAA@@@A@CBC@CBA@ABGDA@C@AAAA@A@G@@BADA@AA@@@CBABA@E@C@A@E@E@C@IAE@@@E@C@G@ACAC@AAAAECCCCC@A@A@"],encodeOffsets:[[117732,30887]]}},{type:"Feature",id:"420222",properties:{name:"阳新县",cp:[115.215227,29.830257],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@G@EAG@G@G@EB@@EAC@A@C@ABEDABGBCBGDMFCB@@C@E@CACAC@ABCB@BAB@B@D@BDDDBBBBH@@@B@BBB@@D@B@B@@@@@B@@BABB@@B@@@BABAB@@BBDH@D@BABCDIFCDCBA@A@AA@@AAAAAAA@A@E@C@ABCBA@AAA@@A@A@@A@CAA@A@C@A@CCAAA@A@A@A@A@A@A@A@@@ABE@@AAA@AAA@@A@@BC@ABA@CA@ACC@@A@A@A@AD@@AB@BABABC@@@CDAB@@@@A@A@A@AA@@AB@@ABCDADADAD@BC@GBA@A@@BA@ABAD@BABA@A@A@@@@AACBCACA@A@@AAAC@CAA@C@E@ABABC@A@AAEE@CAECCEAE@UBGDI@GBEHCBEBCDDT@FBF@FEBG@IBGBK@C@C@C@EBAF@HDHAFKLGJEDADAJ@F@D@DDJBLBHADABGHCDEHCJ@D@XADCF@@EHABAB@BCFA@AFADAF@@EBWDOBC@@BAB@BBJ@DCH@BCD@B@B@D@B@HEJEHABA@CBCBC@G@G@ABEBCBA@I@C@EAE@K@ABA@@@A@A@A@@C@A@@@@A@E@A@A@A@@@ABADC@A@EBA@C@A@A@C@A@A@A@@DCBCBE@CBG@AACAGA@ACBEFQLGHEFADAD@FBFJLBJBL@JFHFJ@FAFCHAFBDBLBNAP@HABC@O@I@GBCBABBL@HBDDHBHBBAHBDBJBJ@F@DBDDD@BBB@PBBBBB@PCD@JAL@FCHE@EBCDEDAFAD@FBD@BDDD@B@D@@B@LADAB@HAD@D@REJA@ABA@C@A@AJ@LCDARENALAJAZC@@TC\\ENCDCFAJAD@H@D@FAD@FBD@BBB@D@D@B@B@DBFBD@@@@BBBB@BBFBFDBBFBBF@B@DAH@@AL@D@@@B@B@@@B@B@@BB@B@@B@@@BB@@BBDB@B@@@B@@@@A@@BB@@@@D@@@B@@A@ABA@@B@@@BB@@B@B@B@D@FAHBH@DBBBDDFLHDBDBLHFFJFFDBF@DBHBFAFBHBFHFRBPDJ@L@JBDD@@B@D@FADAB@B@B@BBBDBBDDDD@BD@@@@B@@@BA@@@AAAB@@@@@D@@@D@F@@@BAB@@@D@B@@BBBD@D@@@@BBB@D@B@FEFAB@@ADAB@@@B@D@B@B@BB@@@FB@@BB@FBB@DBBB@DBDBB@@DAB@BAB@B@FDFF@BDFFFB@BBBB@BBD@B@@CBC@EBA@GFGLIPGFGLADCDCFCFADABEJ@BADAFENCJAFBFCFBH@BBB@DDDJDJDN@@@@@LAJCD@FAHCD@D@H@J@H@HAJCDCFCJAJAJBB@L@LAHBJBB@LDJFHDHFHFJJBDDFBD@DCF@D@DDDDBD@DBDBDADBB@D@H@B@DADAB@BAB@@BDBBB@@B@BAF@@B@@B@B@@DB@@@HBD@BA@@B@@A@A@ABEBC@@@AB@B@B@BBB@@B@@@@ABBB@BBBDD@@BDBBBBB@@BB@@B@B@@DBDDBDB@B@DAD@F@B@D@FAB@@@@B@@@@ADA@CBAB@B@@@BDB@@BBBD@DBDBBDAHCFAH@HANO@@BA@@BCJI@ADCBABA@@BAFGFGBAFGFGDGFI@@JMHOBIDEBI@CBG@A@G@EBA@IAG@E@G@CBEBGDGDGBADEFC@@FEFCFCHCDADCFCFCDEFCDEHMJQFIJOBAHKJGFEHCNEJEDI@GAGAGCI@KBGBENMHEHAHCHEFGFEDIDIDMFGFGFEDCFEHCHED@DCLE\\MdGLA@ACI@A@@@AAA@@@A@@@@AA@@@E@AAA@ABCBA@@@@@@ABA@ABOCEACAC@EAGCACGE@@EG@GAIAEAEBG@CCGCGCGAIAGBGFK@E@@DUCEEEGE@AGCGCEEICIEGECCCA@AEEAAGGGGGEAECCCECECCCCICGE@@@A@AGG@@@@A@@@C@AB@@AAA@EECACA@@ICAAEEMMAAAAEA@ABA@AAA@AAAAAAA@@ABCDCBA@@@CA@@A@@@ABABA@AAAACAEBC@C@OEGCCGEEA@C@AAA@AA@@A@@@ABA@@A@@@@A@@@ABCBA@C@@@@@@C@A@@A@CA@@AC@@CCA@AE@@C@A@@@AAA@CA@AEG@AA@@@A@@BA@@BEDA@@@G@EBGHADCFALBHFH@J@HAHEBGBI@MAKCMAIAGCKDIBGBK@MAO@IBGAICG@ABCBIDE@A@I@I@GBIDEFIJCJCDCFKHGFK@EAECAACEAEAE@EBCBGBAFEDCHEFAF@JAFBF@BAB@D@HCBC@A@E@CAGCC@@AAAAA@A@A@@AAAAAAA@A@A@ABC@A@A@AAC@@@A@C@@@AB@@@B@BAB@@A@AAAAAAAA@AAAA@ACE@A@ABABA@@BA@@@@@AA@AAIAGA@AA@BA@ADAB@B@DADADABAB@B@@@@@B@@@@@@@B@@@@BD@BBBBFB@BB@F@H@HBFBFBFBFAHABCB@BE@E@@@@A@A@AA@@A@@AA@@@@@AB@@@@A@@@@@A@@@@@@A@@A@@@@@GCA@AA@@AA@A@A@A@AB@@A@@@A@@@@@AA@A@@@CA@@@@@@@@@A@@@A@A@@@@BABCB@@A@@@@@E@AB@@AEIAI@AAE@I@G@IBGAGDIFI@ICCCC"],encodeOffsets:[[117918,30219]]}},{type:"Feature",id:"420281",properties:{name:"大冶市",cp:[114.980424,30.096147],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAKGCEACAA@CAGBG@E@C@A@A@AA@@A@@@AB@BAB@@@@A@@@C@@A@@AB@@@@@@A@@@ACAAA@@AA@@A@@@@AAA@@@A@A@@@A@A@@@CBK@@BG@C@AAEEAAAECEAAAA@AA@A@@C@EACAA@A@C@C@A@AAC@EAC@EBC@G@C@IBEBCDMD[FSD@@YDIBKBMBQFCBKDI@@B@B@DAB@BIBQFC@C@GBA@CBKBA@@@@C@ACCACC@EAC@EBCBCFAD@FGFEDK@IBC@ODA@AAAAAAG@E@CBCBA@ECEAC@YHIFE@A@CDCBG@A@A@EBA@ADAHABC@MBC@C@I@OFODYHC@SHEB@@ABBBBD@F@FEFEBGBA@ABC@EA@AMAMAMCE@EBCBAB@D@DBDEDEBAACAYIA@CBBDBFDFFBH@HBDB@B@BGLCFA@K@GBEBCF@@@@@B@@BB@@B@B@FDFHB@@B@B@@@B@@AB@@@@ABA@@@@B@@AB@BA@@@A@A@@BA@@@@@@@@@@@@B@@B@@@@@B@@@@@BB@@@@@@BB@@@@@@@@B@@@@@@B@BB@@@@B@@@@@@ABABA@@@@@@@A@@@A@@@@B@@@@@@@@@BB@@BB@@@@B@@@@@B@@B@@@@@@@@B@@BB@@@B@@@B@@@@@B@@@@B@@@@@@B@@@@@BB@B@@@@@B@B@B@@@@BBBABAD@@ABABABCDAB@DBBBB@BABABC@A@@B@B@B@@@BBB@BB@@BBA@@BADADCBA@AB@B@B@B@B@B@@B@BBB@@@B@B@@ABAB@B@@@BB@@B@@BB@@B@BAB@BAB@BADBB@@@@@BABAB@BAB@BBBB@@@BA@ABCBABAD@BABABE@A@ABCFCHCFAFAD@B@D@D@FBDADDCFCDABALAHGDEFA@@DCFABCCAAAEAAC@CDCDCD@B@@@FDBBD@B@BAD@B@@B@FBBB@DBBBBB@B@D@@BBB@BBDAB@DABABBD@@@B@BBFBJBJBHDD@D@@@BADABCBCBABABAB@FAB@AAB@@AB@B@@@@ACB@AA@@@B@BA@@@@@AA@@@@A@AB@@@FB@@@A@E@@AC@ABC@A@@AAA@@A@@BCDE@G@@BABAB@FDF@FBBB@@HBFADCDAFFDDHDJ@FAF@D@BHBFDL@DIHADBDFFFBFBFBDEBEBCBCHAH@FBFBDF@DDBFDFDFBBF@@BDBDCDEBCD@@CD@FAHCDAD@FFFDD@@DDFDFDF@DADADCD@@@@DADCDCFCDCHEFBDANCN@@CFEDCBC@EB@FAH@BADIDIFCBCDCD@D@FFLBBBB@@FDFBD@D@D@@BABABABAD@DBDRHBBBF@@A@IHCBEBC@M@CBCFCDED@B@@@@@@MFEDCFGD@BCD@FCDCDABABA@KF@BAD@N@B@@@DBHAFAHAB@BAD@BAD@BDJFBF@DB@@BB@F@FDDBDFBBB@@HDB@BBHBJFDDBFEFABADABAB@B@@DFBD@FBFDFDDBF@BB@HJJJRLDBD@BBHAB@@@@KBE@ADEBAB@DA@A@CAEBABADCD@DAD@F@F@B@BA@@FE@@B@F@FCJABAJAHAL@H@FD@@CH@@AH@D@DHH@BB@NBL@NDN@J@@@F@J@D@JIDCBA@CAK@C@E@ABABABCHCFCFAFAFAH@HADHFACG@ACA@C@A@A@CLA@CECCCCAAAAC@ADEFG@A@C@EBEBACAG@@@A@@@@A@C@A@@CCCA@C@A@CBABA@@BA@A@A@A@A@ABABABADA@AFCB@FCFCBA@@BAB@@@BAA@BA@@@A@@@A@@@@C@CC@@@@@A@A@ABA@@DB@A@@@AB@@@@@FAD@BAB@B@@BB@@@B@BAD@B@@A@@@A@CBA@ABADCBAB@B@DABA@AB@BBB@DA@@D@@@HDAACCAA@E@ADC@@DA@AHCBAFCDABCBABCFAD@B@B@F@J@B@F@H@B@B@JAHAF@JBD@HDB@B@D@DDDDBFBB@BBDBDH@D@F@@@F@JBD@F@F@B@D@F@B@BADA@@B@BBDABAJADA@@@AFA@@B@HBD@B@DBB@D@J@D@FAFABABABCAABA@@BAFAD@B@D@BBB@@B@BBF@B@D@@BBBB@DDBDHDBB@DBB@B@HCDA@@DAB@D@FBBBB@@BBDB@DBHBB@BA@@B@B@B@@@BB@DB@BBB@@@B@BABCFC@@DABAB@B@DBB@BBB@B@@BB@@BJBDBBBB@BAB@B@@@BBB@BBB@D@B@BA@@@C@ABA@A@C@@BE@A@A@ABABA@ABAAA@C@A@CBE@A@AAA@C@@@A@@@ABA@A@@@AAC@EAA@AA@A@@@AA@@BE@ABC@ADADAB@DB@@@@B@B@B@F@FEFALCDACACAC@CACC@C@CDE@CACCEACIIGEGEGCIEKCA@IAGAKBK@A@IAIBIBEDCDIDGBG@I@G@C@C@GDEBC@IDKB@@@@M@ICICCC@CAA@AAGDEAEBEDIFMBEBC@AFIBABCDEDEDCBCHKHEJOHKHEB@FAD@DA@@@AAC@AAAAAA@EECE@AEEECA@A@ABA@CB@@AAAC@CAACAA@EAA@@AA@@E@@AAA@A@C@A@@@A@CB@BA@EBEFA@C@A@AA@@@@@CACAA@@@A@C@@BA@A@@@E@C@@@C@@@@BABB@@B@@A@@@A@@C@@ACCCCAAACAAA@A@A@CBEBC@A@@@CCIAK@I@OCQAGEAEAGBEAEAG@CAEECIEEEKGCA"],encodeOffsets:[[117715,30646]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
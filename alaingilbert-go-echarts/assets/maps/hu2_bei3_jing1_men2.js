@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("荆门",{type:"FeatureCollection",features:[{type:"Feature",id:"420802",properties:{name:"东宝区",cp:[112.201493,31.051852],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CAC@I@I@AAEAC@@GA@@@DABCF@@CAA@BB@B@FADABA@C@C@AAEAE@CFEDEFE@ICICEB[RQJUPABUTGDKBE@CC@K@EHQBE@GAI@E@GBGBEDCDADCBC@C@AEGCCAABCDEBE@IA@AEACDG@CAAKGAC@ABAFEDDFFDDD@DAFECGACBAHKFCLIBCBIBC@@@A@ABA@@@CBA@A@A@@@A@C@A@ABA@A@C@@BA@@@AB@B@@AACAAAA@A@A@AB@BAB@BA@C@@@ABA@ADAB@BC@AAACCCA@ACAAAGEEAICGAECCGEIEEECE@C@EBA@C@SIEACC@@BMACEMEGCAECAACCACACCCA@ADMPMJEDE@ECCAAA@CBE@CABKLCFELCDABCFORCFABBBBBB@B@B@@B@BBB@D@BB@D@B@@@DFBJ@H@DBL@DBD@DAB@D@BBHBD@D@FAD@@CLBDBDBD@D@BCBCB@BA@C@C@AACA@C@ABABC@C@AC@A@CBCDAD@DBDBF@D@B@@@@AFGJ@D@FCL@B@B@D@@DF@B@DBFBFDHBBBFB@DBBBBD@B@BA@@@A@A@C@AB@@AAA@A@@BABABA@@BA@@B@F@B@@A@C@A@@@A@@@A@@BA@@B@B@BAB@B@@@BD@B@@@BB@@@BA@@@A@AAA@@@@B@B@@AA@@@@@@@D@@A@AAA@@@@@@B@@A@@@@@E@A@A@ABAB@BA@AB@B@BDH@@A@C@MBE@ABBBCBAF@H@DADGBCDCD@@@BBDFHBF@DBDAFADABADCBE@S@K@ICKKA@A@K@E@EB@@CBAHEJCHADCLCDABCF@BBBBDDBD@DCDCDCDAFAD@BADBBBDDBBDF@BBFBFFFBDDHBBBBBBL@@@BDB@BBDB@@@DBDBDDBFBDD@@@DADABABEBABABEDC@G@C@CD@DAFADAD@BADABCBC@CAC@CD@DBDBDBBBFBH@BBJ@B@D@DDH@D@DABEBEAC@EDCJCBCAEECECCAAAAEBCBCFBFDDBBBD@DBDAFADCDEAACAKACAACAEC@AECEACA@@AB@DAFBDBD@DADABCDEDAB@@@B@B@BBBDJFHBB@BAB@BABCBE@A@GAC@AB@B@DBBBBBB@B@DABABC@I@ABABAB@DBBB@B@B@@@@B@FBB@BB@B@@@B@JDB@F@HCDADBBBBFDBFLFFDFDDDBHDJHFBN@FCFED@F@HBDDDDBFBDFHDH@H@HCJCLCFEBG@EBAB@DBD@B@BDF@H@DADA@@BA@ABCDCDEDADA@@B@D@BF@JAD@F@FDDDAF@BDLBH@F@DFH@BCDAD@BJDBB@DABABIBADBD@DBBDR@@FLDL@D@DAH@FDLBB@LBD@@@BAF@BBDDBDLBBBFBDB@BBF@N@D@B@@BDDDBB@@@B@FABABAD@D@BBDDHFDDDBF@D@D@HCFAHGHCDCDEBAB@DAD@FADBBBFNBBD@F@JADAFEJGDCDCFABBDDDDBDADHBDF@BADAF@BFD@BADBD@@FBFAFBB@F@B@DCH@BBFBD@HDJHFBFAD@FAD@D@BAD@DBL@HBBAB@AA@A@ABC@CBS@AA@C@A@@A@@@@@@BABC@ABEB@BADE@AAAAA@@@@@AB@@A@@@ABE@@BIBM@ABE@E@EBG@AGBIBC@A@CBC@AGAEAG@C@EF@DDF@DA@E@C@G@CBCHBDBBBBAB@BAAAAACACCCAAC@AB@@A@AAA@@B@BAB@B@@@@A@A@AA@@A@@BA@@B@B@@AB@AA@@@A@A@@B@@@B@@@@@@CBA@@@@B@@@@@BB@BB@@@B@BABAB@@B@@BB@D@B@@B@DBB@BAB@@@BA@AF@@A@@BAB@BABA@A@@@AAA@@ABA@@AAA@@@A@@BAB@@A@@AA@@AA@@AAAB@@AA@@@A@ABA@A@AAA@A@@@AB@DA@A@@@ACA@A@@@ABA@@B@B@@AB@@AB@B@@AB@BABAB@BAB@@@@A@AAA@G@@@CBA@@@A@@A@@AA@@A@@BADBB@@AB@@ADE@@@A@@@ABA@EDC@@BB@@@@BB@BB@@@D@D@B@@BAB@@BB@@B@@@BCB@@@BBB@B@B@@@@B@@BBB@B@@B@@@B@@BBBB@@@BA@@B@B@BA@@B@@@B@B@@@B@@@BD@@B@BAB@@@B@@@@B@BBBBBB@@B@@AB@@@@A@@@AD@@BB@@B@@ABA@@B@BA@@B@@@@@@@B@@B@@@@@@@B@@@B@BB@@BDBB@B@@B@D@BBB@@@B@BA@@@AB@B@BADA@C@@AAAAC@CA@A@MAAACAABC@C@A@C@ABABAD@J@B@BADEDADCDABABCBCDEDAFAJCDCFEDAHCFALGHELAJADADABCBC@CAABC@C@AAAEBADABABABCAAAACCGGEC@A@AA@EAEDAD@BBF@BC@IAC@A@C@AFCDA@@B@B@D@B@B@@ABA@@BCDABADAHALB@@HAB@DADAB@RQVUHIFEBABI@E@AA@@C@@EECCCC@EACAAA@A@QA[@@@CAAACACAGCCAAC@@AA@A@AAA@C@C@@@EAGCAEAAACC@C@ABCDCBC@CBC@CBEACAIACAEBCBCDCDC@C@E@IBE@GDE@CBABEFABBB@HBDBHBF@D@DFBB@BBBD@DEB@BA@@BB@DBDB@B@B@B@D@D@D@B@BABC@AF@BABA@AACCCCGA@E@@@@ABEDABA@@D@@@BA@A@AB@@@DEFGBG@CACDGBCBCBIBEBC@@@@DEBC@C@CCCCEAAAAC@@BAB@@@BBD@B@BA@ABC@@@CAA@CACCAAABABA@C@A@@@AA@@DC@AAAAACBEDAB@@@@CAACAA@ABAB@AC@@@ACB@@AAAAA@AA@@CIAE@C@ABABA@C@A@AA@A@CBCBHIJMDCFG@CI@CAE@C@I@C@GFCBCDCBE@E@A@@A@AAA@@QBOD@B@@@B@B@B@@@B@BA@@B@B@@@D@@@BAB@BBBBBBBD@BBBBDBB@BBBBD@BBBBB@DBBBBBBB@@@B@B@@@B@B@B@B@@@B@B@D@B@B@B@BABGFGFIDMHG@@F@JA@@FABCBEA@@E@AFAF@FEBMB@FA@AC@@@@@BA@@@@@@@@@ADB@A@A@A@@D@BG@@CC@AGA@@B@@AB@@@FE@C@C@AB@BA@@FG@@B@BAD@B@D@B@@C@AAAC@A@AAE@@@@@@@M@@@A@@@A@@@A@C@@@E@@@G@@@@@A@@@C@C@EDEAEAA"],encodeOffsets:[[114906,31767]]}},{type:"Feature",id:"420804",properties:{name:"掇刀区",cp:[112.207962,30.973451],childNum:1},geometry:{type:"Polygon",coordinates:["@@ECACC@EAMCMAMAMAC@@@A@@@@B@@@@@B@@@@@@A@@@@BA@@B@@@@@B@@@B@@B@@@@B@@@@@@@B@@@@@@@@@@AB@@@@@@AB@@C@@@ABA@A@@@A@@@AB@@@@A@@@AAA@@B@@A@@@@B@@@B@@@@@@AB@@@@@BA@@@@BA@@@@@@@@@@@A@@A@@A@@@@@@@@@@BA@@@@@@B@@BB@@@@@B@@@B@@@@@B@@@@BB@@@@@B@@@@AB@@@@@@BB@@@B@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@@B@@@@BB@@@B@@@@@@@@AB@@@@A@@B@@B@@B@@@@@@A@@B@@A@@@@@@@@@@@A@@B@@@@@B@@@B@@@@@@B@@@@@@B@@@@A@@B@@@@@@@@@@@B@@B@@@@@@B@@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@@B@B@@@B@@@@@B@@@@B@@@@A@@@@B@@@@@B@@@@AB@@@@A@@DA@@@A@@@@@@@@B@@@B@@@BA@@@@BBBBB@B@B@@@B@@@@A@@@@B@@@B@@@B@@@@A@@BA@@@ADA@B@@@B@B@@@@@BB@@BB@@@BABAB@B@BBBA@@B@BAD@B@@A@@@A@@BCB@DAF@DBBDBFDF@FCNINOBCB@DDBDBDDDBBFDDBFHFNBDAN@@DDFBTJD@B@FAD@F@FDFFFJDHFDHBJDFBHFBBDB@BDBDDBB@BADA@CB@BAB@B@@@DABA@ABA@@B@B@BBBBBBD@BA@A@@B@@AB@@@D@BAB@B@B@D@B@@@B@BAB@D@@AB@B@B@@ADAJADKJEDGLABBDDHEFCBC@CCEECCEFAB@BBDLHBB@DCHBDBFB@@JAFCFADBBDDFH@B@DADCDCBCDAFAH@H@FBJ@HAFGR@F@LDDF@LAHCVSBAVORI\\QFAJDJDF@FEFCDEF@FBBBD@D@B@BABC@E@AAAB@DB@@DEBA@C@@HB@@BDBF@B@J@JBD@DBBBFCF@F@D@D@@@B@@@@@H@@@F@@@D@B@@@B@@@B@@@N@@@@@@BF@B@BBDBBD@@@@A@C@ABC@A@AH@@EB@@ABAD@D@F@@E@@BA@@@AB@BHD@@DH@@A@CB@B@B@A@BC@@@@@@@@B@@A@@@@BDB@@ENAFA@EBEBEF@@@FBDABA@EB@@I@EH@NGJCHEHEBA@A@A@A@A@C@A@A@@@A@A@A@A@@@A@A@@AAAAAACAA@AAAAC@AAAAA@CAAAAAC@AAAAAA@ABA@A@@@C@@@A@AB@@A@A@@@A@A@A@@@APCRA@@BB@B@BB@F@F@DADCDAHED@J@D@F@DBJ@@DEHCDINGJDADAB@B@@ABCBADCFCBAHADADAHE@@@@B@BABEDMBCBCBE@A@E@E@GDA@C@ACAA@AEACBCBAFCB@FCBA@@ACA@A@A@C@A@A@AA@@AA@A@@@EB@@C@@BABA@A@@@A@@CA@@AAAAA@AAA@C@AA@@BAAIBA@ABADABABAAKAC@@@@AAA@A@GFCBA@A@CA@@AA@A@A@C@@A@C@AA@@DGB@@AACAA@AAAC@AAA@CBAA@A@@BC@A@@ACDM@AB@D@BABA@@B@@B@@AB@@D@B@@ABAAC@@B@BA@@DBB@B@@@@A@A@C@C@@AA@@@@ABAB@B@@AA@@BABC@@B@B@BCBAB@DAD@B@@B@@@@B@B@@@@A@@@@D@B@B@BB@@@B@AB@@A@@BA@AAG@@A@@A@ACA@@@ABABCAC@@A@C@@A@C@E@@BAB@BABA@A@E@GAAAA@AAA@@A@A@A@A@@@AA@A@A@@A@A@@@@AAA@BA@@B@@BB@@B@@B@B@@A@A@AA@A@@A@@@AD@B@B@@@BA@@@AA@A@A@A@AA@A@ABABABA@@@@A@C@@A@@@ABABEBCBA@@@BA@@BAB@B@@A@ABA@@B@BA@@B@@@DBB@DCB@D@BA@@@A@ACCAABAB@@@B@@CB@@BBD@B@@BA@C@ABABABA@@@C@@D@@A@A@@AA@ABA@@AAA@@AD@@A@AB@@@@C@AB@@A@A@@AAA@ABA@CCA@AAAB@@CFAF@BA@CBC@AB@@@B@@E@@BADAB@@C@@@ABAF@BA@@@A@A@@@@@@B@BBBBBBB@B@BA@ABA@A@@A@CA@@AA@ABC@@A@@AA@G@ACA@AAE@C@E@AAA@CA@A@ABA@ABA@A@AAAAABABC@@@AA@AB@@A@@@AAA@@A@@@A@@AAAB@@AD@@@BA@ABEBC@@@AA@@AABA@A@EB@@AAAA@BCBA@C@A@@BAFBB@BB@@B@B@BBJ@B@D@D@@@@A@A@@FADABABA@A@AB@@@BAB@@ABAAGAABA@ABA@AAAACAA@A@@BBB@@@BC@AB@B@F@BABC@ABA@AD@BA@@@CCAAA@@@A@A@@BAAA@@A@A@@@AAAA@@A@A@A@@AAAAAAA@A@CAA@@AA@BC@@AAC@@@A@AAA@AABA@A@@AAA@AAAF@BA@@B@B@B@@AB@BA@ABA@@BABCDAB@@BBB@@BABA@@B@B@@@BB@BB@B@@BFK@CDA@ABAB@B@BD@@B@@AB@@A@ABADA@A@@@AA@@@@A@ABA@@ACAAA@@@E@A@@AAE@AAACC@E@A@A@AA@A@A@M@@@CAC@A@@@KBE@E@E@AAA@AA@@AC@C@@@AB@@CBA@@D@@CBC@C@@A@AAE@A@A@C@EA@C@AB@B@BBB@@A@@@@@EA@A@@BA@AAAA@A@A@AA@@A@@@ABAB@B@B@@BBAB@@A@A@@@CFAB@@CA@@ABABA@@A@AEA@@A@A@ABA@AB@BA@CBA@C@CAECEAGECACC"],encodeOffsets:[[114796,31500]]}},{type:"Feature",id:"420821",properties:{name:"京山县",cp:[113.119566,31.018457],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@AACGKC@@KAEBC@@@ABE@IB@ACCAACC@C@EBC@E@CAACAC@E@G@CBCBCBA@@DADABA@A@@@AA@@A@@@A@A@AB@FCBABA@CBA@@@AB@@AB@@EB@@@@C@ABAB@BAB@B@F@B@@ABABA@@B@@C@A@@@@BAB@B@@C@A@CAAAC@C@A@@BAB@@@@@B@@BBB@F@B@@B@BABABCDAB@B@B@@@BD@BB@@@BABA@C@AB@@@B@@@BD@B@@@@BA@AB@@@D@B@@A@@BA@@B@@BBBD@BB@@@FA@B@@B@AD@@@DABBF@BABADCF@@@B@@BDBDBB@B@@ABA@A@EB@@@D@D@@@BABABABCBA@@@@B@B@F@BBB@@BDBB@DABAB@BA@ABA@BBB@@@@D@BA@A@@BADAB@@ABC@@@A@@B@B@@@BAB@B@B@B@D@@@B@@C@A@C@@@CBAB@@A@ABA@@BABAB@BEDA@GH@@EFABC@A@CBBD@D@BEFABEDCDGFED@D@B@B@B@@ABADAD@D@HBHBLCNEF@BGFGJEH@F@B@JCLAHAJAFCBCFCDCDCBGBEDDF@D@BABBBE@DDDFDFBF@B@FBDBDBDHJ@BC@@BFBDDDFBDBDBDDDBH@@DFBBFFJFJDJFHDDFDHFHHDJBHBJ@HCD@F@FDDBHDD@LNJJFFLHLHHHFJ@@DHDH@J@L@LDH@JCJKJEFGHEHAHBFAJHBLDDDTHFDNDBJAHCLAF@HBF@BNLFDD@H@J@J@LBD@@H@H@H@HDHDHDJLDJDPFLFBFCHEBKBIDGDEHCFAJAHAJCFGNEFEHEHCHCJAJAPBRBFFNFHJFJDJ@LBLAL@L@DH@D@D@J@B@HDJFHJDJDHBRFNFRDRFPDPDLFB@FH@FCFMFKHFFHJ@B@B@LEJEJELCHAbFDD@NDJBNHFDFHHDF@F@JAB@HEJGFCLELDFDHFJBPDJ@NAJALCDC@@HGDCBE@EDED@DBFBDBBCFADAD@DBDBLHBDBBBDFDBCHCBADC@CF@D@FA@CACKGC@ADCFABCABE@CACBCFAFABCDEDEFAFBBFBBDBB@HCJCDAPEFCFANCLGNMHGDCHKFCLOHKJKFBLFJ@FALANIFCHCFCB@PENC@@@CB@@CBI@C@EFAD@BDBJDBLDHFBBBCDCDABADCDABCDCBCBA@C@AAA@C@ABAB@B@B@D@BB@@B@DAFCFC@CDCBCBC@@@ABBB@@BBDL@BABA@ABCBCBA@@DABA@CAEBE@@BA@@@A@ABC@ABADABAB@BC@C@AB@BAB@@DBBDLBDBDFDFBB@@@BADCBABAB@@BDB@BDBDADBB@BBD@BAB@BCBABA@ABEFAPCDEBC@E@CBE@EDGBCBCFCF@B@@A@@BIDKDIDEFCHC@@LBDBD@J@B@HAB@NCF@LGBK@@BABM@QAMBG@ABK@M@@@@@A@G@G@G@G@G@KAE@@@ACGACCCACCCCC@AC@CC@CBABCJGBAD@DABABAFABA@AEECACAC@A@CBC@C@AIECCAE@AAAA@CBC@E@CAA@E@I@E@KBKCKAKEKAEEIECEE@AEC@@@@GEAACAOKIK@AAE@CACCACACAC@ECCAAECACBE@CCCCAA@C@EIEAACGAAA@C@@@A@CF@@ABCD@@CDA@A@ACDG@A@CBCBA@AB@@@@A@AACBA@CBABAB@FK@@@A@@A@C@EBCBI@CACAC@CBAA@AGGAADEA@AAAAAA@CDE@AA@@@EBA@AAACAA@ADEB@BC@@@E@ABABABA@CA@AAC@EBCBCBA@A@IAA@@BABAHABA@ABCAECACAAE@A@AAAA@ABCD@@C@@AA@@AAAA@C@@DEB@@AB@BBB@@A@AAA@@@A@@@A@@@ACB@@A@@CC@AC@CBCBGBAAK@@ACACAA@A@@E@OFCBC@@BA@ED@@A@ABA@ABABA@A@@AAA@AAAA@A@@@AB@@@@BB@@AB@@CD@@A@A@@@@B@B@B@@A@ABC@A@GAEAMEECA@AC@@CGGCGCAA@E@CAAIECAAECEAACCA@AAC@GBCBC@CA@A@AB@JGB@@CGIC@@@@AAACBEDABA@CDC@A@@AEEAABA@AB@@ACCEIUYAC@@EKIG@AGEG@ICOAA@IAGCGEE@ECC@ECGAKBGBEBCBKHKDOBKBCBA@GBEBCFCDGBGBG@CEAIAKFO@ABKAICKEEICI@I@MAC@EAKCK@IBKDGJCBABCFABGBGAKCICCCACEGCCCEEGGIEC@@CAIEAAKCCAMEMEA@GEEEECKGCIACAA@@@AAEAC@A"],["@@@@@@AA@AAA@@@@AAA@AB@@BB@@BB@B@@BBB@@@@@B@@@@A@@"]],encodeOffsets:[[[115635,31460]],[[115537,31464]]]}},{type:"Feature",id:"420822",properties:{name:"沙洋县",cp:[112.588581,30.709221],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@BC@C@CAG@@ACE@E@A@ABADB@DDBDBDBDDBB@B@"],["@@GGCECKBC@AACFGDAFGJEFABCBGBE@@@AAECEEGAAEEICECMCCD@BAFCL@HEFEFCDIEEIGM@ACGAE@AAG@C@AACBEBE@EBCD@DDDAD@FCBCAAAACCDCBCBC@EACAAAA@A@C@A@A@A@EAC@ECACAACCCCCI@@@MAG@GAIBKCGEGGCAACCKBEDGDIBG@EAIAECEACCBABEDYTEHGFIFOHMHKHGFMFEDI@GBCBG@KCGEACAGCCAEA@E@GAE@GBEDGBO@IBEAE@KAMAQKCAIGIEIEGC@@MEMBOFKHCBABABCJ@@A@C@AB@DA@ABADA@AAA@@@AB@@AB@B@@@BBB@@DD@B@@@BABA@A@A@@B@BABCDA@@D@BB@DB@B@B@BADAD@BB@BBB@JB@@BB@@A@@BA@@B@B@BAB@B@@@BBBBB@B@@@BCB@@ADADABAB@D@FADADADADCDABCBC@I@EAA@IDKHCH@JKDCBC@IAMCI@CAC@E@I@KBG@CB@DADABA@C@CACACCCCAAC@A@EF@AA@AAAC@CACBC@I@I@EBADAB@DBB@BBB@DABABABC@C@CAACBC@CDEBC@C@CAA@AACAAAE@C@CDAB@D@BEBCBEBCBCB@DBF@BBD@@AF@DDPBJBHDLDJAHABAJCFED@@EFEDABABE@GAEAACA@@CDG@EACAECCIBGDEDEHEDCHAJ@JBJBDBHBB@HA@ADAFAHGJEJCLGJAB@BCDCDEDCFAJDFFJBHBBBJ@BABCBKBG@EBABAD@BADAPAF@BCBCBGDCBEBCBGHDBBBBD@HBD@BD@B@@DAD@BAFADC@A@AB@B@D@B@B@@@FAFADABABABAD@B@BABABGJCDAFCDCF@@EDABAFADB@B@B@B@BD@BBDBBDBB@BBBB@@@B@BBB@@@B@B@@BB@B@D@B@@AB@BA@@B@@@@@@@BBB@@@@@BA@@@@BA@@@@B@@@@@BB@@@@BD@NBNBNBNDFBD@BDFDDDDBHFFBFDDBD@B@DAB@@ABAB@BAB@B@@@FB@B@BB@BABA@@DB@@BADE@@B@B@@@BAAA@@@A@ABABA@@B@@@BBB@B@B@BB@BAB@@@BFB@@@@B@@@AA@A@ABAD@B@@F@D@B@BBF@B@BD@D@DA@@@CB@DA@@BA@@D@D@@BB@@BBB@B@F@FAF@L@@@BBD@D@@@N@B@BBBB@B@F@D@BDBBF@BB@@@B@F@@BBDB@BB@BAB@@@@@BB@@B@B@BCBAB@@@BA@@@AC@@A@ABABAB@DCL@AE@@@AAAA@@A@@@A@AB@BA@AA@AA@@BADCBA@AB@BAB@@ABA@@@A@A@AB@@ABEBBB@BB@@@BABBBB@BBB@@@D@BB@@ADB@@BB@DBB@B@BBBBBB@@@B@B@BB@BB@B@@@B@BB@BB@AB@B@@@B@BBDD@@B@@ABCB@BAD@BA@A@E@ABAD@@A@@AA@AB@B@DBBBBBB@BAB@BAHBBBBA@@BA@A@@BAB@B@BABABC@EB@B@@@@@@C@C@AAI@A@A@AA@@AAABE@AB@D@B@DA@ABBBB@@FAB@B@BA@BB@@B@@ADAF@BAB@@C@@BA@BB@BB@@@B@@@BB@B@@@BA@@BBB@@D@BABABBBBB@B@BAB@BAB@B@@DBB@B@F@DBF@BDB@B@HBB@@@BD@BAB@@BB@@D@BB@B@BAB@@A@AAAAAAA@A@A@@@@B@B@@@B@@ABEBA@@D@@@BABC@AF@@@@A@@BAD@DAB@@ABEDE@@BABBB@DDB@BAB@BB@@@B@BA@@B@D@@A@@B@BC@@BB@BB@@AB@BBB@@@B@BC@@@@D@@ABABAB@B@DAB@@@AAC@AA@@DA@@@A@ABBBDD@B@B@@ABC@A@CDA@CA@@A@@@ABA@@@AB@B@BA@A@AB@@AB@@B@DAFABABA@@B@@@@D@B@@B@BABABAB@B@@B@B@B@BBB@@B@@A@@@A@ABC@@B@@@@BBBB@B@@@@A@AA@@@AA@@@AB@@ABB@B@@B@B@@@@B@BBB@@B@B@B@B@@@BB@BBBBB@H@F@BABABA@AB@@@F@D@BD@B@@@BDADAB@B@@DB@B@BB@@@BH@BAB@@@BA@@B@A@@AAA@A@C@@@@@@B@@A@A@@@@@@AA@C@CBA@ABADA@A@@@ADAB@@BB@@@ABABA@@@@BB@@@D@D@B@B@@A@A@CA@@ABA@@@BDAB@BA@C@@@BA@@@AA@@@ABABC@A@@BCNBD@@@BAD@@@BBBDAB@BBD@BB@BBBBD@BA@CH@@BBD@B@@@@D@B@BBB@@DBB@B@DAHEB@B@BB@@@@BDBLABABCBAB@BABBJAB@@BBD@B@BBB@BBBB@@DB@@@B@@@BABAB@@@DA@@F@@@BBB@@BBB@B@D@B@B@B@BD@@DAHBB@@BBBB@B@@A@AB@D@@B@BBABA@E@CBA@E@E@CBAB@DBDBB@@A@A@CAA@A@ABABBFDFBDBDADABABA@ABAHEBA@C@KBCB@BABADABCAAAA@@@AECCCB@BCF@DBD@BBB@@D@HBDBBBB@BAB@B@@BBJCD@@@@B@H@BBBD@B@BABADADG@ABABAF@D@NAF@DAB@BA@A@CDCDAD@FBFBHLBBB@BB@BBB@B@BBB@@BDB@D@DAJCDA@@H@F@BBB@BB@B@BBB@@@D@B@D@B@@BJ@@@BBB@BBDB@BBJDJHBDADQFABABBD@@B@@BBB@BBBB@BBB@FBD@FBDADANIBADGB@@@B@FBBD@F@D@D@B@D@DB@B@BAD@@ABAB@B@@BD@JBJANAH@FAHAHCBIAE@IEE@ICGAECCACAE@C@CBADEDCFC@@DCDCFAHCFCDCHEBA@@DCDAFCFCHCFAHAFAB@HAH@@@HAJ@LEHCFAF@FAD@F@F@FAD@H@FAFAFAFEDCDGBEBEAECCAEEAG@E@GAE@CBGDE@EBG@G@EACAC@CACAA@CC@A@E@EBCDEDCFCHENGFEDEBCBE@E@CAEAECEAACEAACECGECGCGEGECGACAAGKAICEGQAEACCE@EAE@@AC@CCGGMAGAAGQAG@GBEHEPMBADAFCB@DCfMHCF@J@^DVFHBFDNDB@VHHBLBLCNEDCDEBEACCKCCIACBEBCBCBGDABGHEBE@A@EGCCAAE@CBEDA@C@@CAE@I@AAAEAEACBABBDFFBF@BABA@A@CACAGCCAAACIAICCC@EBCBCDA@CCCC@AACC@EBCBA@CC@CBGFEHCB@DCB@FABC@GAEAACACAAACCCECEAGBI@I@EECA@AAECGBAAC@"],["@@D@@EABC@AB@@A@@BABB@FA"],["@@E@@@@@AAC@@BA@@@A@@BAD@B@@BB@@B@@@BBDAACHD@AB@@C@C"]],encodeOffsets:[[[114760,31434]],[[115314,31297]],[[115376,31363]],[[115352,31345]]]}},{type:"Feature",id:"420881",properties:{name:"钟祥市",cp:[112.58812,31.167819],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAGCUSECIE@@IESOGCGC@AA@ECEKOOQQMGGACAA@C@EAC@SBBDHABAD@B@BB@@@BBD@DCBGBE@DFBDBFHRDFBJHLBBBDDHHFHFHDFDDHDFBBDFBBDFBFBF@D@FAFADCFEFMHGFEDCDCFAD@F@F@BDDB@DBDBD@DBFBH@H@FAF@HCDAF@HBF@H@FBBFDDBFAFAFCHCDEFEBEBEBG@C@EBE@E@C@EBE@EBGDKFI@GB@@G@GBA@EBGBEBGDEDEDCBCD@@ABGFCDEDGDEBCDCD@@EDCDCFAB@D@DBFBDDDBFDH@JFF@JBFAJGDGBEBG@MBIBIAC@@AA@A@AB@BC@ABA@A@@C@C@A@C@C@EACEAA@@@A@CHABMJCBCBEAC@EAA@AAA@AA@AAA@AA@@@ACBABAREBCACIGICAAA@AC@AAA@A@@AI@@@A@C@A@C@@AA@A@AAAA@AAE@G@@@CBIDCBC@A@AC@@AA@A@AAA@AAAA@AAGKEAEAC@CBCD@D@BABA@CBE@MBC@E@ABAB@BCHCBABABA@C@AA@A@G@A@@C@IDAA@@@ABA@AAAAAAC@G@CA@AAC@CAE@ADA@DDFD@B@@BBBBADCBABABA@AD@L@DABGFAB@BABABCBCBCAEAECAAAB@B@BBB@D@B@BA@CACAA@AB@D@F@FAB@D@FABAB@A@AC@A@@B@BA@A@AA@AA@GACBABEDA@EDABADBDBFB@DB@B@DCB@H@F@F@BAFADADCNAFABA@@@@@GFCBCBGBABEDCDABAD@B@B@B@DABAB@B@DBFDJ@@BBB@BBBB@@DA@B@@BDA@AB@BBBBDDB@@@@BAFCDABBBB@BCD@@BB@@B@D@B@BABABBDDDBB@DB@@D@BAB@@A@AAC@A@@BA@AD@BBBBDFDD@D@DADCF@@@@ADAFAJADADCHBD@DAHEHCF@@A@@B@BAB@@C@@@ABCBAF@B@@F@B@DHDDBD@BABABE@@BADABA@C@C@C@A@A@A@A@AC@CAA@@ABA@CFC@AA@AAACEC@E@GACAGAA@AAEBAFAB@DCF@HAF@J@F@DCDCDADADBFBDBJBDAF@DAD@DADCDAD@B@DDDBBFBDBBH@F@@@D@DBB@B@BBB@@BDDBHDDBDBBBDB@@\\@RBB@B@BBBD@FDDDDFF@@@DB@@B@FAJABEFGJUVQRA@CBCBA@GB@@KAGBCBABCBAD@@AB@BA@A@C@A@A@@@CBED@B@D@BBD@JADE@AAC@CBBF@FBBB@D@HFDHBDBBDBBABABABCFABB@B@DADBB@DADADCBCBIBKBGFKHEBGDCBEFCDIDEBCBCFADADABCBCDCBCFABA@I@C@ABAB@B@D@B@DADBBBDBB@N@BDBD@BBBB@@@DCBABA@A@@B@@ABA@@@A@AAC@A@@@@AAAAC@@AAA@@@A@@@@@@@A@@@@A@@@@@@@AB@@A@AB@BA@@@AA@@AC@@B@@@B@@A@@BA@@@AAAAAAA@@@@@@A@@BA@A@AC@@A@@@A@@@A@A@@@AB@@A@A@AB@@A@@AAAA@@@A@@@AA@A@AA@@@A@@A@A@A@AA@@A@AD@@A@@@AA@@BA@AA@C@C@@@A@@AAA@@@@AA@@CD@FAB@B@@@B@@CF@BA@@BA@CAAB@@@BB@@BB@@@@B@@AB@D@@@HBB@B@B@@A@ABA@ABABA@@BA@A@@BA@@BA@A@@@AB@B@@@BDB@B@@@BCBA@@B@@@BBB@B@BAB@B@B@@BB@@BABB@@BB@@BB@@@BA@AB@@@B@@BB@BB@BA@@BB@B@@@BABABA@AB@@@BE@@BAB@@A@ABA@CAA@@@@A@CAA@@@AA@ABABA@@@A@@AAA@@@@A@@@@@AB@D@@@@A@@@A@@@@B@B@@BBA@@BA@A@@@AB@@@BB@@B@B@B@@A@A@ABA@@@BB@B@BA@@BBDDBDDDBBBBBABA@ABAACAGAAD@D@H@D@FCBE@CCE@@F@DBHBFBHD@DAB@D@JAHA@BAH@F@FAF@BANAJ@@AF@B@@@BA@@B@@@@BBBB@BCFABA@AF@BADAB@@@@@@@BB@D@B@@BAT@DAD@B@BBBA@HFBBDBBF@BDBFFHDDBJBT@NEHAJIHABR@NBLAPCLBLBHFHFFDHDHFFFBLCLENCH@F@DFDFHFHFJDPDJBL@JBHDFDHCHCNIJKTEH@L@NCLIPIJGD@FBJBFBFBJAVETIHCFARCLAD@@@JAH@B@JDLFLHHLHFJHVJTHLBF@HBFFHHFFHLFBFDHFFFHDB@F@HAF@LCJBHBFBPDX@HAHAFCHEHEJCHCFCFCJCFIJORSHGHEFGJKHEH@F@DFHDFBH@FAHGHAJELAHAFANCJIDCFCHGBAB@LE@AHAD@DFDDBNDFDHBJ@NAHEFCDAD@BEJADELGLEDCJ@BBFBFHHFBHDHBL@J@LAF@D@DDDFDJAF@JBLDPBL@JFN@JFDFDLBHA@@HEDAHGJKPA@ALBRAPAJARCLILMDIFKDIFGFAN@RAB@HANGJIHKHKFKHMHSBEBO@IAIAK@I@GDKFIJALAPBNBFBDBH@H@NAFCB@HGHKJMPSLMLCJCBaDGFKFIFI@K@A@AGIEELGNEDE@EEGA@KEOCOCQEQCMEQEGAICICEGCI@G@A@I@C@CCGK@K@KBKAI@ICIEEGEMAEAQBOBIDIDGFGFGFEHMDEBIBGBIDEFGHCJCLAFADGAEKEOEICKCCICGCG@G@G@G@GC@KAI@I@G@C@ECMK@AAE@GBEDKBGAIMCECSGCCKCGABIAEBGFGHGFELIDI@ICG@K@K@ICGCG@@EIGGKGKGEEIIKMC@GCCAECE@C@GDI@GAIAGCEGCGCEGCIEICIEEEAACE@@AGCCACACACCECCEA@AD@@AGIACACAC@E@AAECECECCF@AABA@A@CCEFCHADADCDCDEDABEBIBGDK@I@A@EFGHIHE@AFEDMAKAG@G@CBCBCBA@@@A@A@A@CFCHEDCFCBAFE@A@CACDAB@D@BAFE@@HGB@FC@ABABAECA@AA@@KGAACCMGSUAAKCAAICOIOIA@ECA@A@AACAOGQKCCIEIEA@"],encodeOffsets:[[115410,31505]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
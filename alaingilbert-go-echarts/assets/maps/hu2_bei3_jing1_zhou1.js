@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("荆州",{type:"FeatureCollection",features:[{type:"Feature",id:"421002",properties:{name:"沙市区",cp:[112.25193,30.326009],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@DE@CCEAAEE@AC@CD@BBBEFBB@BLHADGLABEJ@BCDKEADCDJDADFBBALDL]BCBEBG"],["@@ABABABABCB@@A@E@C@A@E@@@A@C@A@A@@BCBABED@@EDCBCBI@I@GBC@MDA@ODC@KBABAF@@@F@B@F@FADGBMBAAAAAG@E@CCCEBEFI@CBGBBBBLDH@JAR@LITKPMNABABGHCBKFABCBEBEDGFCDGFEDGFCBA@@@ABE@EBBTABAF@B@@AD@B@B@@B@@@BB@@@BBBAB@@A@@@ABAB@@BBB@BADAB@@@B@@@BD@@@BBD@@DD@@BDDDBB@@BB@@B@@@BBFBDBBB@@@@BB@@BD@DAB@D@BBBBDBDDFBH@B@D@B@DBBBBD@DBB@B@FBJBB@@@B@BBB@BB@@BD@@@BBD@JDDF@HAHEB@FEJEJG@@JE@@JCDAJBP@F@FB@@HDHHDDDFJVNF@@HDJFJFJHDBRLNBLBF@FBJAP@HAFCHAF@HBF@B@BFDDBHBDHFLDH@DAHAJ@FCNEHELGNGPGJEHEFGZSFCBADAL@B@JB@U@KK@C@MG@DG@@BO@@FC@G@AAECAA@C@C@C@ABA@@BAB@B@@CDA@CDEDCDADABCD@DC@@BG@A@A@@@@@A@A@CHAJA@G@C@C@CAA@AS@@@EU@OBKBGDO@A@EBA@ABC@@DGBA@A@@B@BE@@@@CAiiACAC@EAEAECEEECAAAEAYIGAE@KAGD@C@EACA@CA[BI@CA@@@E@AA@C@A@ABAFCBC@@@AE@@A@@B@@ABCBG@@@@DAB@@A@AAA@@C@@AAA@@@A@C@ADADBD@B@B@@EBE@C@A@@A@IAAAA@GK^KCABEABCICDCBCC@HMBABAA@DA@A@@@ABA@@B@B@@@@ADC@@BC@AAAFEAA"]],encodeOffsets:[[[115099,30933]],[[115091,30923]]]}},{type:"Feature",id:"421003",properties:{name:"荆州区",cp:[112.190185,30.352853],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAAACAABA@@BHLDDBDBDBDAB@D@DBBBDDDBB@BDF@D@D@FMFC@EBEJ@F@H@H@F@DI@GEEEEEAAEGMCE@GCGCIEEEGEIAGCE@OCKBABKBGFCFBHDFFNBFB@DFFHDFFJFLHJBB@@@BHDFFFDDFDHBF@DDDFDFBLBB@FBDB@FEHCFILBFJBF@HBDHBD@HBDAFAH@B@DCBABABC@CBAB@D@BAB@B@B@BBBFBB@BBBBBF@D@DBDAB@BA@@@EFOBIFIDIHQLABCDMLADMNCDMTEJCHGHCF@DBFBHAL@B@B@P@LDPBNCZAFABAHABENGJGFABCDC@@BE@C@A@@AB@BABABA@@AAA@A@EBCDCBA@A@AA@@BA@ADADAFA@@@A@@AAC@E@G@EBCBIFCBA@A@CAC@C@ABA@ABCDCF@@CBABCDCB@BAB@DBBBBDBBBBABABA@ABADAD@B@B@BBABCFAD@B@BDDDDBDD@BB@B@@ABA@EBGBCBGDA@@B@BDBDB@BBF@DBDBB@DBF@B@DBD@DBBDBD@H@H@F@@@DAXAD@F@HAB@J@PBDADDFPDVDH@@BB@BJNTZDDBD@JCJCD@BABABABCBE@EAA@ABEFE@A@C@CB@BA@BB@@DBHDJHFNBDADAHADABBBDBNFFDB@@BBBDDBBDBBDD@B@B@BBBBB@BDAFBBDDDDBDBRFF@B@BCD@BB@BBB@HDB@B@B@DBB@BBBBBBDBB@F@B@B@BB@D@@B@BDBBB@D@BCAC@ABAJFB@B@FB@@B@@A@AAAA@@AB@BAD@D@@@BBDD@@BD@BH@H@@@HGDAFADAHCDADA@ABEBOBC@ABCBAFAH@LADABA@AAIAAAGEICEBIDEFCDCDC@ABAHIDKFIHIBGBEBCB@@GAAAGACAI@IBIDGFCFGFCHCJADDBFBD@FCH@DB@BDFBHBF@BABAFCFE@@FCDEBIBABGCICKAGAICO@CBE@@AC@AAE@CDADAFADAFA@A@CBADCD@F@BBDBBBB@DBD@D@FADCD@DABB@D@DADABABCBA@AAA@CAA@CBAB@F@J@JADBD@DBDBBB@@BFEB@D@BBDDDDDBDBD@B@BABC@CDAH@LAJ@F@D@DBJ@NDJBD@DALC@IDGLGJCB@FBJ@D@DABADCBCBCBCBC@E@CBABABCBC@@DA@A@@@AAAAA@A@@@ABA@A@A@AB@@AB@@@AA@@IAA@AAA@@ABCBC@A@A@ACAA@@A@CB@DCBA@A@AB@B@B@BA@A@@@ACC@@AA@A@@@ABA@@BA@@B@BBB@BCBAB@@CBAD@B@@@DIBABADALGPENAIUCECCGGGC@@EAE@O@IACBID@@IF@@IHIFEFA@GFGBE@CC@IAC@A@@AC@@AAA@AAA@@@A@IAEAA@A@CAC@AAAA@C@A@C@AAGCEACACAA@A@CBA@CAC@@AA@@@@AACAEAAA@@A@@@AA@@AACCAC@@CC@@AC@A@@AC@@A@@@A@CBABA@AA@@BABA@@B@@@BAAA@A@@AA@@A@@@@A@ABC@@@ABEBAAS_@M@QCKAGAOCMCICEAOE[IOC@EBEDCBGBIAIAKAGCK@M@EBEBEBG@ECOAA@A@AAA@A@GAABEBCDADAD@FBF@D@FC@CBCAAACCAG@CAACAEBCBCHABC@CACCAEACCAC@EBE@C@CAAAAACCE@CBABADAD@DBF@D@H@HCFEDCBC@ACCCCC@SEGCCCCECCCAIAM@ICCC@A@CBE@EAAE@GBEFAHA@@BE@ABCBEHCBA@CA@CAAA@CA"],encodeOffsets:[[114771,30850]]}},{type:"Feature",id:"421022",properties:{name:"公安县",cp:[112.229648,30.058336],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAC@@@@A@C@ABC@A@EBC@CBABEBAB@@A@CAAB@BBB@D@D@@AB@FADAD@@@DAB@B@DBD@@BBBBBDDDDDBB@@DDBBBBBB@B@B@BABABABA@C@A@E@G@A@CAA@EACAA@AACAC@A@A@ABEBEBCBAD@@@@ABABC@A@@BABAHCHABAB@B@@@@A@@@@@A@@@@@A@ABC@A@A@AB@@ABA@A@A@@@A@@@A@A@@@@@A@@BA@@@@@A@@@@@@@A@@@A@@@A@A@@BA@@@A@@@AB@@AA@@@@@@A@A@@@A@ABA@@@ABA@@@A@@DBD@DA@A@@@AC@C@CA@GAA@@@A@CAGAA@A@A@A@ED@B@@CFADCDAD@@CBABIHEB@@ABC@A@C@A@AAA@CC@@A@C@@D@DBBBB@D@F@HABA@C@@AA@@@A@@@A@A@ABCBCBA@IDA@AB@@@BBB@B@B@D@BB@@@B@B@@BBB@@@B@@BBBBB@B@@@B@B@@@@@BA@@B@@@JDH@DBFBD@D@JAJ@NAFAB@JADBD@PABBBBBBFJFFLFHHB@LHFF@B@DADCD@BADALAF@JAH@HAHAJAR@BAJ@D@D@DBD@DDBBBDDFJBFFLFHDDBBFJFHDHHL@BHHHDHB@D@FAJANALCRAJAN@BCJCLEJGHKFIFDF@BDDBFDFDFHFHHFFDJBF@JBJDFHFDDB@@@@@B@B@BBDABBB@@@B@DBDD@BBBDFBHBF@BBBBBB@B@BBFAFB@@F@F@B@D@@@B@F@@EACAAHAJADBJBA@AEEMCEAGDEHELABALAPDF@HDJBHFFFJFHDHDF@NDFHBBFFFFHFJ@@C@E@G@G@EFIFAD@NE@E@C@CCE@AAACCACAA@C@CBAACACACCCGK@AB@BADBBBFBDBB@BB@DDBB@DAFGDABAF@@AB@BGFEHAF@BB@FAF@D@BDDJDN@JBDBDDDFDDHDTFD@DDDD@BADCDEFGDG@C@E@CAC@CBABAB@DDFBDBBBB@D@DAF@FBDDDFBDBBD@DADGBADADBFBDDBH@DBBDBBAD@DEDC@E@EAC@CBCBADAFBB@H@BBB@B@BBBDP@FAHAFAF@F@NDLBHBLBJAJAHCDAF@FPD\\JPFFBJDNDPDHBLBRDN@`@FAF@BA@@B@DAHEFCHEDCHEFCFADABALEDAHGBABANMLOJS@KBQ@ICGAKAACECGAACECCACCACCCCECCCEECAA@CC@@CACAECECEACACCEAECEAAAGCEACAACGCECACCAECAACCCCAAACCCAACEAEAEAE@E@CBC@CBCBEBCBEDCDEBCDEBEBEBCBCDGBABCBC@ABCBEBCDEBCBCBCBCFE@ABA@@DADEDCDCBAFC@@FCDAD@DAFAB@H@H@FBF@FBHDFBHBLDLDH@@@@@FBB@B@H@D@JAFAD@D@FAFANCNAL@PAFAD@DAPCLAFCFAFAFAFAFCDADAFGDCBE@@DEBCDEBA@ADEBCBADEDC@@DCBC@@BCBCBEDCBEBGBCBCBCBEBE@CBGFOBE@IBK@C@A@CBK@@BCCGAMAI@IAAAKAGACCKCGCCCGACAC@AAGCEACACAE@AA@@ACECC@ACCCECCACEEEEAACECE@AACCCAE@CCEAEMBEEICIEEGCECEACACAAEA@@CACAEAAAEAEAGAAACCCEACAEAE@E@EAEBG@E@C@EBGBIBMBI@ADK@KEKA@ABA@@@@@A@@@ABA@E@O@OBE@AB@@CBGDEAGCECGCCGCC@IBIAGAK@@@@CCECECECIEGG@ACCBK@A@CAA@AAC@A@AA@@AAE@C@@AA@AAA@@AC@AAA@AAC@AC@@@A@A@@@C@AAC@AAAAGCCACCIE@@AAAAAA@@@A@@@ABABA@A@@@A@A@CAA@@@ACAAAC@A@A@ABA@CBC@A@CAA@ACCC@AAA@C@A@C@C@@@A@A@@@A@@@A@@GHCBABABAB@F@BABAB@@CD@BAL@FAJCFCFADABCDC@CB@BC@@BA@CB@@C@A@@AA@CC@@AACAAAGAAA@@C@EBABEBC@A@A@A@A@E@A@E@A@A@C@CA@@A@CB@@ADADABA@AAA@AA@@EAA@ADAD@FCF@DABCBADCBGDCFA@ABADAB@BA@A@@ACA@A@AA@CA@@C@@AC@A@ABC@A@A@CAAAA@C@CBCB@@@BAB@DAD@BADADABAD@B@BBBBBB@B@@B@@BB@DAB@B@@ABBD@@@D@@BB@@B@@@BBD@BBBBDDBB@B@B@B@BAB@B@B@H@D@D@DBD@B@BBDB@@DBB@@@B@BABA@CBCBAB@@ADADADA@@B@B@BBD@D@BAB@DAD@B@B@BAB@AACAAAAC@AAAA@AA@AE@@AABA@A@@B@BCFCBABA@A@@BA@ABABA@@@A@@@@@AA@AA@@A@ABABABCD@@AB@@A@@@ABA@A@@@A@@@A@@BA@@B@@@@AB@BAD@BA@ADABCB@@C@CBC@C@ABC@A@CHCBABKFGDEDCDCB@@ABCACAEBABAB@BC@E@A@ABAB@@@@GRAFA@C@E@CA"],encodeOffsets:[[114667,30509]]}},{type:"Feature",id:"421023",properties:{name:"监利县",cp:[112.904788,29.840179],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@A@@B@BBDBBB@B@B@H@BAAAAAC@ECAAAB"],["@@HDDBH@DAD@DA@A@@CAI@aGOEA@AB@@@BDBTDBBJD"],["@@@ACAC@@BBB@D@BB@@CB@BA"],["@@BBDCAA@@CD"],["@@D@BBBBBBB@@ABABABABAB@@B@B@B@@@D@D@B@BF@H@D@D@B@B@B@@A@ABABAB@@AAC@@@A@A@A@A@A@AC@C@@A@CA@A@C@@@@C@A@CBA@E@A@AD@B@B@BAB@@A@@B@B@@ABA@@B@BA@B@@@@B@@@@A@AB@FBBDBD@HBB@DBF@F@HDJDBP@LBF@J@B@H@LCACBCBE@MA@MGGIIOUQ[QGIAECE@EAAACAEEGAEAEACCEAEAE@ACECCECCCCACCCCEAEA@@C@CBCBCDEHAF@N@N@FHdBT@HAN@HAD@FCHAHB@F@FBHBB@@F@FBH@JCHLB"],["@@A@GAEAE@A@CDCBABKHKHUNKDIDQBUAOECAaUQOUSQMYOAAOCICOAUCUAE@KAMBGDIF@LCJ@L@DBB@DBBBBFBF@D@J@PDLFNJHLBNAFBJ@B@B@H@BBDAB@DA@@DAB@D@DAFADAB@F@BAD@BCDGHGFC@CAGAAAEAE@K@@@K@G@IBIBK@A@WCC@KAEBI@E@C@AODKBC@EHSTCBCDA@UPABCAAAA@@@@BFFDJBBBDBBBD@BFJ@LAHAFCF@FDL@BA@ABA@@BGFADAFBBB@BB@H@BF@B@BB@BAJBBBBB@FBBB@D@HBDBBB@D@DD@DAHAF@JBHLPDFBDBD@D@D@DABBFHBLFBHBBBF@H@BBD@B@B@@BBDDA@ABCBA@AB@B@BB@@@DADADAB@FDBB@D@B@@B@BABC@AA@@ABAB@DBBBDBBBB@@@BC@ADGBABAF@JBB@@BBB@BABCFABIJ@BBDFFDBDC@ADC@AB@@@B@@@@@@B@BAB@@B@ABA@@BBB@@A@@@@@A@@A@@CDHDB@@B@BA@@B@BBBB@@@DEB@DBFBB@B@@A@@F@DB@B@HBBDBBB@@@DIH@D@BBBDBBFBBB@D@DAD@D@D@B@BD@D@@RFJDXPT`P@N@F@P@D@F@N@V@P@^@CDABGPABABGFEDIFEDIDAFDDFBN@L@J@DBB@@BDH@@DHDH@F@LCHCFCJCHAJDHBFDDDFLAJAF@LAHDDBDBDHFHBHDDBBDBBBHDJBDF@BFBJCFADC@@@ABA@A@C@EAC@C@ABABAB@FBDBF@F@DANKFABAF@HBHBB@FBFAHAHCJANAFABABABA@AEM@A@A@ABCHCDADADCDABADAD@RFFBD@B@DAHCFCDAD@D@DB@@DB@@D@DBDBDBFFFDFBDBFBBBBB@B@DAD@BCH@BADAD@DDBDDHBFBDD@D@B@@AB@BADAD@D@D@@DDDDDBFBFBHBH@FAHEB@FCFCDEP]DC@ABAHCFCHEPMFAFAD@F@FBDBFBFBHBFBHAHAFADCFEB@AECG@CACCEBC@AFEDABABA@CCAAACAAAAEB@BCBAFAFAPDFBBABABCBA@E@A@ADAB@BA@A@C@@@@DA@@B@BBDDDBB@FAHABA@A@C@AA@CBA@A@AEAEAG@CAC@C@CDABAHCFCDC@@@CBEBCDE@EAGGM@A@A@AHEDAB@DABA@@@A@ACACAA]@EBCB@FAB@BA@C@C@@@A@EAC@@@C@@AA@@CAACNMHEEC@AAACACAAAAAAAC@GAAAA@AAAA@CBA@C@EBEAEAE@@F@DDD@H@B@BCFGHeBE@AF@@CH]`LNFBBD@REZWDA^Y|jÏLmMUYQGIEIPWBCACGSGGCCCECAAAAE@AEMSeAABAHCDAFCDCDA@AFADADADCDADADAFAFCDAFCDADCDAFABAGIOSSWECMMAAQOCAAEACEK@E@AACGGQMCA]SIGMGOKEICE_mKOIMIMAAEIGICAimCC@@IGGCGBAB@@CDABEBCDEBCAC@C@CAE@E@C@CBCBCDA@C@A@CAC@@@BEBKBOCIGGGCEAG@KFCHEZA^@LABAVEXEXCLENGFKD@@OBSAKESIWOKGUMQSQ_AAECIA@@MBC@KHAHCFCTAFABANARCPCTKb@BCJCNCN@HAJGXADI`EL@J@HFHBBLLXVDBFFPND@FDB@BBFDDBFBFFDBBDJLJLhZ@@RPBBJPFJDHFTFRBFDZBHL\\@J@@CFADCBG@CA@NAFADBDKDG@A@I@E@KAO@CACI@G@EAE@CAA@GACACEAA@@B@B@@A@@@@@@AABA@@@AB@BA@A@@@@BA@ABA@A@C@@B@B@FAB@D@B@D@@D@B@B@@D@BD@D@@B@B@B@B@B@B@@BD@BA@ABAB@B@BA@A@A@C@C@G@E@@A@A@C@C@@@A@A@AA@ABABABAB@BA@AAAAAAC@KAEHADMJEDIFQFABM@C@IAIEC@CCAAPCJAFChWPKFCLKBD@E"]],encodeOffsets:[[[115415,30536]],[[115431,30537]],[[115746,30917]],[[115378,30739]],[[115655,30500]],[[115650,30480]]]}},{type:"Feature",id:"421024",properties:{name:"江陵县",cp:[112.424664,30.041822],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@BCD@B@@BB@@@BB@@@@@B@@@AA@AB@BAA@@@BA@A@A@@@@A@@@A@"],["@@BI@AAAA@E@@A@GAAA@AAADABADCFCDABADEBEBCBEBGFADDHGHGDIBCB@@KDODQCIAAAIAE@A@KDC@GAEA@A@C@C@AE@CAC@EAGAAE@ECCIBCBAD@@AF@FABA@CACAIACAGAAAKCYIA@ED@BAB@BCBM@KBBB@JBJBNDHAD@@AL@D@B@DAL@JAFEPAH@DAFAFADADADAHAFCDAFADAD@@ADCD@@CDCFABADCF@BABCFADCF@@AFCDEHCBCBEDEBEBEBEBEDKBODCBC@EBOBK@MBMDEBEBC@C@EBIBC@G@A@A@EA@@@@G@KCKCGAEAGCEAE@EAG@G@A@EBCBC@CBED@@EDABCDCDCFCB@@AB@BEFADADADADCFADAFAD@BADADABCHADADAFAFCFADCFCDAFADAFAD@DAD@D@FBFBFBFDFBBDDBDBBDDDDBBFDDBBDFDHDBDDBFBHDBBFBFDFBDDDBFBFDFDDBDB@@DDB@DBFFDDFDDDDDDBBDDDDFBBDHDFBBAAHADAJ@FEFADD@D@FBHBBBBNAHABC@E@E@A@E@@BEBALAD@PCB@NCD@HAJ@J@DADAFC@@FCBADA@AB@B@D@B@@@F@B@D@F@B@@@DABABABABA@ADCD@@BFFBBDF@DCFAHAFAD@HBBBB@J@BB@D@F@FA@@@A@AACBCBCD@B@@@B@BB@@@DB@BBB@@@BA@C@@H@DABA@@@AB@@@BF@@D@DABEBAB@D@B@@B@F@@DBJ@\\ADBB@BD@F@DHCLBF@HBZJFBBBDBFFDFBFBF@FBDBDjjDB@@DEJEVQDCHMDCLKRSFEBC@CDG@CDKBA@ADCDCECCCAKBO@KDMFGFADABAF@FADCH@BA@C@ACEBE@K@G@EEE@AIIGGEG@E@GDCJGFAFAPALBD@H@LBLALCJELCFCFCJAJAF@B@D@JAFCJGLGB@H@HBN@JEHCB@F@F@VBBBJ@DAD@D@D@BC@E@A@E@C@@B@J@S_WOICQE@@@CACA@C@C@C@CBC@A@AAAECAAA@A@CJG@C@@AACAAA@G@ACAE@@@@BA@A@EACAA@CF@@A@AA@A@AB@@A@AA@GCAACAEEAC@AJIBADEBA@AAA@AA@IAE@ABABCH@BAD@@A@AACAAACAA@AB@BB@@BADABA@@@@A@CAAECA@CBCBCB@@A@@A@ABAB@DABAB@CCAA@@@A@AAC@A@GAEAAAGKEGAAEBA@C@C@CACACCEKOAG@IBEBG@CCCC@A@AAAC@G@CAAEAA@AAAA"],["@@HKBCKGAD@@CD@B@@A@A@@@AB@B@@@BCBB@ABABGND@LFDC@AFIBA"]],encodeOffsets:[[[115382,30733]],[[115309,30639]],[[115091,30941]]]}},{type:"Feature",id:"421081",properties:{name:"石首市",cp:[112.425454,29.720938],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@B@@EA@@F"],["@@H@EEGCFJ"],["@@A@@AAA@A@AAA@AAAAA@A@@@CAE@A@CAC@A@@@@@@@AAA@@@AA@@@@@@BAB@@@B@@AFAB@DAF@BAB@D@BBF@B@J@DBN@@@D@DBD@BBB@@BBB@BBB@B@B@BBDD@@BBBB@B@@BB@B@@BDBBBDBF@B@@BB@B@BBB@BBDBBBD@@@BBB@B@B@@BF@D@B@B@BAB@B@BAD@B@@BB@B@B@@@BA@CDADA@@BADCB@BABA@@@A@A@A@A@AAA@A@C@@BA@@@C@ABA@A@ABA@ABA@CB@@A@CBCB@@CBA@ABA@ABAB@@CBABA@AB@@@BA@@@ABA@CB@@G@A@AAA@AAA@@@A@@@ABABAB@@EDA@@B@@@@AB@BAFABABAB@BAB@BAB@B@B@@@DBB@B@@@DBDBBBD@@BBBDFFB@BBBB@@BB@DBBBDBD@D@BBB@BBD@BBB@BBD@@BB@BBB@@@DBF@BB@@B@BBD@BBB@D@BALDD@BHHJFFDFDFDDD@@@@BLBHAJ@JDDDHHDFDHDFBHCDA@@BAF@PAP@F@B@BA@@B@@@@@B@BAB@FL@LCL@BAJANAJAH@F@D@FAHBF@F@FBFBFBDDFDDBBHBFBFBBBFBDBDB@@FBBBBDBDDFDFFHJFJDFFNABFDF@DBFDDBD@BDFDFBBFFFFBDDDDFDD@BDDDF@BB@@BBFBDBDDFBH@BBDBDDHDDDHDLBDBHBLLAN@DA@ABA@AFCB@ZJLDBBHBDBJBDBDBB@BA@EBE@@BCDAJADD@FBFHBFBD@DBF@@B@D@D@BFBHBD@LCB@F@JBBBJBRDPCLC@@DAJAHCHGCGBCHEFADAFAFABCBADCDEBCBABCBEBCHE@AB@BAB@@ACK@EDEBEBG@KEI@AACAAACAACIEE@A@@B@BBDBBAVOB@DCDATSFGD@LAPCBD@F@J@FALBD@XD@EA@AAAC@A@AB@BABBFDD@BBBBABG@A@@FL@JAJAEIICAASCCA@A@@BAB@PFbHJ@DB@@@BCBC@CBG@CAFFL@@@L@F@FBBBHBDBD@HEHGDC@ABC@A@EBABCBE@C@CBA@CB@@CBAAC@A@G@A@AAIBEAMGKMIKEOCI@C@E@EAAAAA@CAA@C@KDI@KJEHCNALBF@VBVDPBJDBCBABADCDABABADBBABAACACCACACAAACAAACAACBCBCBABCBG@CAECAC@AAC@A@CAAA@C@C@CBABEBCBA@ACCA@CBIBAAA@CACAC@C@AACACAAC@C@C@CBA@AACACA@CAACA@C@@@A@A@ABA@ACCCC@AAAACAAAAAA@CCCCCAC@CBAB@DBDBDABABABEAAAA@CAC@CACCAC@A@C@C@A@C@EACCAAAAACAA@AA@CCCAAACAACEAA@ABC@ABCACAACAC@A@@A@A@CBA@A@AAAC@ABA@@ABC@@@@@AACACACCAAAAACACBADAB@DADADCDC@C@E@A@CAE@C@ACC@AAAA@ACAAAC@ADAD@BADADAB@@CF@BCDA@ABCBA@CDEDBBCBA@CBC@AAEAEEGCAAGAA@AD@B@BABABA@CBCBGBCBCBABCBC@E@EBCBEBC@E@@@@AA@AAC@EACCCA@ACAA@@ACAECCA@C@@CAAACACA@@AA@AAAAAA@E@@@AAA@@@@@ABA@C@EB@BEBCBC@IDA@@@A@AB@BA@@@A@C@A@ABA@@@@@@@AB@@A@ABAB@BAB@B@B@@@F@D@D@@@B@@@@@BAB@@@B@@AB@@A@@BE@CBC@E@CBC@ABA@A@ABE@C@C@A@EAAAC@A@@@A@A@ABABA@ABA@ABA@CBA@A@@@CAC@AACACAGBCBCE@AAAAA@AAA@AAC@@AAAAAAAAAA@AA@AA@@AA@A@A@AAC@A@C@C@C@C@C@AAC@@AAACAA@@AAACAAAA@@AA@AA@ACAAAAACA@AAAAAACAE@A@AA@@@A@@AA@@@CAC@A@AA@AGAA@@AAA@@@AB@@AH@BA@EGCCGGA@AAAEGIAAEIAAAAA@A@A@@@A@C@A@A@@BC@A@A@A@C@C@@@CBC@EBG@E@EBEAA@E@CAA@A@CA@AC@CAACAEAEAG@E@A@A@@A@AAA@CACACAC@AAA@CAEAA@@@C@ABC@@@AA"]],encodeOffsets:[[[115417,30544]],[[115434,30542]],[[114987,30226]]]}},{type:"Feature",id:"421083",properties:{name:"洪湖市",cp:[113.475801,29.826916],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@CCCCCAAAWOCAAAC@CB@BBDFF@BHJBDFDDDDDBB@@FDBBFBD@FDJBBBLBB@JDFB@AB@@AA@CAAAGCOIAAECIG"],["@@[UECECWQIGcOSKEEKGWQEEGEEEEEECMMCAMIEEAAWWCEMOMMUWOSAAMOABEBCBCDCBEDCBEDEBCBCBCBCDCBCBEB@BCBCDEDCBGDABBBTfFN@BBFBBDBDFDDHHHTBDADOXFJHJZRNVKniÐ{]ZCBYXQFC@AAME_KG^@DE@@BAFGfEHADA@G@C@CCE@@@BFBFAF@F@DAB@DBBBBB@BBHBD@BBBBBBDBDBBB@BFDGFMNBDDB@@BB@@@D@@BD@F@B@@@D@DABA@EBA@AD@FB^DBDB@B@B@@ABCBA@CBGF@B@B@BHNBH@FCFADAF@D@@CDEDGDABCB@D@DBD@DBHBFBFB@B@DAB@@B@D@BABGBEBA@CACCAAA@@@CB@@@@@D@BABA@CB@B@B@FABADABABEAOCEBEBABADA@BFBBDBBBDB@DABABCBEF@BADDFBD@DDHBF@@DCD@D@F@PAF@BBDBHBLBLBRFH@F@FBDBB@@BD@HBD@FBJDHBD@D@DADAF@F@FCJCDAH@F@JFF@XAP@R@PBPBRDLBFBJDHFHDHFBBDFDDDFDFDHBD@D@DDDDDFBF@JALA@@H@F@FBF@D@RGBAB@DEDCDCDAFCLEHCFCJGHC@@L@R@FADCHGDCBCLCHCJAFCJGBADCBAA@@AA@@C@@BCBABA@ADCBABC@AB@BABABC@A@A@A@E@AB@@ABANEBADAFEBA@@FABABADABABABABAB@FADABABABABEBA@AA@AA@@AE@C@C@ABCDCFED@BADADAD@FAD@D@@BB@BBBBBBD@BBD@DAHCD@@ADB@@B@B@BABB@@D@DBFDJBBBH@F@DBF@DBDBFDFBD@DBB@F@L@D@HDB@@@DAB@D@LDHBTBNFD@B@DABABAFCDAHCFAFAD@DBBBD@JFB@@BDHB@BDBBBBD@B@B@B@BABAB@FBD@LBD@BBDD@BDB@BDJ@D@D@DBD@DBBBBDBBBD@HBF@B@@AB@FBB@@BB@@D@BADABAB@@BB@BA@@B@BBBDFB@B@B@B@JCDAB@BABABAB@D@B@@ADGBADABAD@DAFAB@DBB@@B@B@DB@@@BBHA@BB@@B@B@BABBB@@B@D@@@BBBBB@@AB@BA@@FBD@DBLJTPPNRPBBBB@BAR@@@BAFAFCDHDDBJ@LDDBDBFBB@BADED@D@HDJDB@@BLD@@FCHCL@NAPBZFD@`NTHDDLBDBHBB@DAJ@DAH@PCPIXQLGDC@@VO@@l_veVIfQDCFEDG@GBCCICMGKMKmUQICAqOyQ[IYGAAUGaIOCWE]OYUGGOUCA[eCEEGMQ@@KOKMCCCEAACCIIWKKCSEeCCMAWBI@UBKBMBIBIAiIME[MICICIGGIQQEE@A[[@@EEIECCEAAAKEUKGCCAECA@KIKGEAIGCAWQ]UYYOQIG"]],encodeOffsets:[[[116650,30734]],[[116217,30502]]]}},{type:"Feature",id:"421087",properties:{name:"松滋市",cp:[111.756781,30.174529],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@D@@JC@AB@KB"],["@@@BA@@@@@@AA@@@A@A@@@AB@@@@A@@@A@@B@@A@@A@BAA@BC@A@@@ABA@@@@@@@BB@@@@@B@B@BA@@@A@C@@@AB@@A@@@@BA@@@@@@A@@A@A@A@@@AAAB@@A@AB@@@@@AAAA@@@AB@@A@@@A@@@A@@BA@@@AB@@@@@@A@@A@@@@@A@@@@A@@@A@@A@@AB@@@@@@@B@@@AA@@@@@@@@@@B@@@@@@A@@@@@@@A@@@@@@@@@A@A@@@@@@@@@@AA@@@@@@A@@@@@@@@@@@@@A@@@@@@@@AA@@@@@@A@@@@@@@A@@B@@A@@@AA@@@@AB@@A@A@@@A@@@@@@AA@@@@AA@@@A@@@@@A@A@@@A@@BA@@A@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@A@@@A@@AA@@@@@@@@@AB@@@@@@A@@@@B@@A@@@A@@@@@@@AD@@@@@BA@@@@@@@@@A@@@@@A@@@@@@@A@@AA@@@@@@@@@@@@@A@@@@@@BB@@@@@@B@@@@AB@@@@A@@@@@@@@@@@@BA@@@@@@@@@A@@@@B@@@@@@@@A@@@@@@A@@@@AAA@@@@@@@@@AB@@@@@A@@@@@@A@@@@@@@A@@@@@@@@A@@@@@@@@@@AA@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@AB@@@@@@@AA@@@@B@@@@@@@@A@@@@@@@@B@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@A@ABA@@@@@@@@@@@@@@BA@@@@A@@@@@@A@@@@@@B@@@@@@@AA@@@@@@@A@@@@@@@@@@@A@@B@@@@@AA@@@@@@@@@A@@@A@@@@@@@@@A@@AA@@AA@CACA@BC@ADAD@DABABAB@@CBA@C@@AEBCBAD@B@B@DCFAB@@ABABCF@@AF@DCDCBC@E@C@A@CAC@CAAAA@CAA@C@CBEAC@CAAA@@ECEAC@CAC@A@C@E@A@@BEBGDCDADCBA@C@CBE@AC@@AA@AAAA@C@CBC@EDA@EFAB@@A@@@AAECCACAAAA@CAACCCAA@AA@@AAAA@AAA@A@CAA@@@A@@@A@@AEAC@AA@@A@ABA@A@A@A@A@ABA@CBA@CBABABABCDABADAB@@@B@DBDBBBBBBBBB@@B@BBF@@@BA@@@CACACAA@@AA@C@@@AB@@@B@@@B@@@BBBBB@B@@BB@@@@BDBD@B@@@B@@@B@B@@A@@BAA@@ABA@@@A@A@@@A@@B@B@@AB@@@@@B@@A@@@A@E@A@A@AA@@A@AACAA@AAA@@@A@@@A@@@@BA@A@A@A@A@@@C@@@A@@A@@@AACBCAA@A@AA@@AA@@@@@A@CBCDA@@B@@@@ABCJAF@BA@@BA@@B@B@@@B@@@D@@@B@BA@A@ABA@A@A@@@AB@@@@@B@B@@BBBB@@@@@B@@AB@@CB@B@@AB@@@BA@AB@@AB@BA@@@A@A@@@@A@@@@@A@@@@@ACCAA@@A@@@@@A@AB@B@@A@@BADABAD@@A@@B@@A@@@ABA@@@A@@@ABC@A@@@AB@@@BABABABADA@@@@BAB@D@B@B@@BBBD@B@B@B@D@@ABCBABAB@@A@A@CDA@@@@B@@CBA@@@@@C@AAA@E@C@@@@@@@AB@@A@AB@@AAA@E@A@@@A@ABAB@B@B@BADAFCF@BABAFAFABABABABC@C@A@C@A@ABADABFFDBB@D@D@DBBAB@HBH@HBTBL@N@J@H@F@F@NDHDBFBBDJBLBLBHDHDFHFNDNDNDFFHFFDLHLDFDBDBFDHFHJJD@J@H@J@FCHIH@JA@@@@B@HBHCFCDCFAH@FBLBF@D@HAFCFCDAJGJAL@D@BAJFHBLFJFH@HAHEHEHEHGDBNFHDFBJHJHLHHDDB@BJBB@@@DBDB@D@D@B@B@H@BBB@BBD@FALAB@D@@@D@BBBBD@BBBDDHBBBBB@B@B@BABLADCB@BA@ADCBABA@ABAB@B@BD@B@D@B@BAHA@@BAB@BCBA@ABC@E@A@@A@C@CIDABCBABA@AB@D@BDNBD@D@BAJD@BBD@D@B@BBBBBB@B@D@BBBBDBB@B@B@DAD@LFBDBBBBBB@BD@BBBDH@F@BDBBBBB@@@BAB@BIFABC@ABC@ABAA@@BE@C@AAAC@A@AB@BABABEBC@CAC@A@ABAB@B@@AB@DCP@D@DJ@IfPB@@@@B@@@XFPQBFB@BBDFBD@BBHFDDHFFDFFJDHBDBDBDHHDDDDHDHDLFHBJDNFJFHDHDHDHDHFLFBBJJDBHFDDBBHFFFHDJFFDB@JDFBB@HBDAB@B@F@H@HCFAHAB@FAFADADADC@ADEDI@E@EBEBEBEBE@CBABGDCBEFEFGDEFEDEHG@A@ABEFEF@BBDBF@FBDCBA@CAC@GDGFAHAHDHBNBPAJAD@H@NEJANALGJADFDJFHFHBH@H@F@BAFBJDHFLHLAD@D@FAFBF@FBHDDDBBBLBF@HDDA@@JGFAHI@AHIBEFIDIJODCJKDCPQFEHGDADCLKNGDAPCDAH@P@P@F@VDDAD@BABADA@C@ABGBEAC@GACCGGAE@IAAEJKDEFG@ECAEAA@KAEAECCC@CAECGCEECEEGC@A@@AAGIEKEICEEGCEIACAIBGBBBBD@FE@A@@@C@A@E@E@@@EAEBAAA@A@AAAA@AAEAGCEAA@ACCCAA@@@A@AACBAAA@A@@@@@A@CCGECEAI@IAECIEEGGGECECEAECC@ACEJELEHGFIDKDI@ABMBIDQBKBMBI@E@CGAGCGG@AGKCGEGEIAACCEGEKAEEICCAACA@CAC@C@C@CBI@ABQBIBG@GBG@IBEBKBC@ADCBC@C@AEEKGA@GGKEEEEIAAAAAAOBC@CAIBA@EBMBI@IBC@C@EACAG@IC@@A@@@AB@@@@A@A@@@A@A@AAAA@@@A@@AA@AA@A@@@A@@@AB@@ABCB@@ABC@A@@@E@@@C@C@CBABCBABEBABAB@@A@@@A@@@@@@@@B@@@@@@@@A@@@@@AA@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@BA@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@A@@B@@@@@@@@@@A@@@@B@@@@A@@@@@@@A@@@@@@@@@A@@@@@@BA@@@@@@@@BA@@A@@@@@@A@@@@@@@A@@@@@@@A@@B@@@@A@@B@@@@@@@@@@@@A@@@@@@@AB@@@@@@A@@@AA@@@@@@@B@@@@@@@@A@@@@@@@A@@B@@@@A@@@A@@B@@@@@@A@@AA@@@AB@@A@A@@@AA@@@@A@A@@@A@@@@B@@@@A@@B@@@@@@A@@@@A@@@@@@@A@@A@@@A@@@@@@BA@@@@@@@@A@@@@@@@@@@AA@@@@@@@@@B@B@@@@@@A@@A@@@@A@@A@@A@@@@@@@A@@@@@AB@@@@A@@@@@@@@BA@@@@@@@A@@A@@@@@@@@B@@@AA@@@@@BA@@@@@A@@@@A@@A@@@@A@@@@@@A@@B@@@@A@@A@@@@@@A@@@@@@@@@@@@A@@@@A@@@@@@A@@@@@AA@@@@B@@@@@@@@@@@BA@@A@@@@@@A@@@@@@@@@@A@@@@@@@@@A@@AA@@A@@@@@@@BA@A@@@@@@@A@@@@@@A@@@@@@@@A@@BA@@@@@@AA@@@@@AB@@@@@AA@@@@A@@@@@@A@@A@@@@@@A@@A@AA@A@@@AA@@@@BA@A@@B@@@A@@A@@@@@A@AA@@@@@A@@@@AB@A@@@@@@@@AA@@@AA@@B@@A@@@@@A@A@A@@A@@A@@A@@@@B@@@@@@A@@B@@A@@A@@A@@@A@@@@AB@@@A@A@@@@@AA@@@@@A@@@A@@@AA@@A@@A@@A@@@@@@@@@@@@@@AAB@@A@@@A@@@@AA@A@@@A@A@@@@@@BB@@@@@AB@@A@A@@AA@@@@@@AA@@@AA@@@A@@@@CB@A@@A@@@@@A@@@@A@@AB@@AAA@@BA@A@A@@B@@@@@@A@@@@@@BA@A@A@@@A@@A@@@@A@@@AB@AA@@BA@@@BBA@@@@B@@A@@A@@A@@A@@A@A@A@@A"]],encodeOffsets:[[[114262,30889]],[[114345,30604]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("潜江",{type:"FeatureCollection",features:[{type:"Feature",id:"429005",properties:{name:"潜江市",cp:[112.899762,30.402167],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@CEAF@@F@"],["@@@@@A@@@B"],["@@EACDD@DB@BABA@@DA@@AADB@BABABE@ABA"],["@@D@BB@@@@F@DAT@B@@R@DTLD@BA@FC@AF@B@JBF@DD@B@FCDAF@BBDDFHB@F@FAHGBAHCDADAFADAJBCCGEKCUEICOKGOAK@@AG@O@EBC@CHMFIPOLMJIHAF@DDFJHLHHLDNDHBHBJAJADAHAHAFAJDLDJFJ@F@FCDGBAFKFQ@AFUBIBIFIHEFKHOJGDCPIRCXEHAHAHCD@H@FDHDJJ@@DJ@D@B@F@NCLADCFELAH@H@LBB@BDHDFBBBBHFHHFDDDBDPFH@JCFCDCHCBA@@DAFAHCLEFIFIDMFK@CBABEHIECGGCGCE@G@@@E@EBGDI@@@ABCFCDCBA@AAEC@CC@ABABA@@HCD@B@BADAB@D@BBBDBBDDB@B@BADADCDGB@@@@@B@@A@@A@@@@@@@BA@@@BB@@@A@@@@BB@BD@@B@D@DBB@B@@A@@@AB@D@BBDB@BB@@AB@FG@ABC@C@A@EAAA@A@KAAAA@@C@GAAAACCEAACAC@ADCDAHEB@DCBCBC@A@C@CEAE@C@@A@ADC@@@C@CAACAE@@B@@@@@BA@@C@@@@B@BE@@AAAAGCCAAEACACCGEGCECECACEAEDIBGDEFGBC@@HGFGLGFEFE@CCCGE@ACEAI@ABGBGDK@@DEDE@@@@FABAJCH@FABADCHC@ABCBCBCDEAEACAEAC@EBGDEDCBG@CACE@CAAG@E@CAC@C@C@CAE@AAC@C@AAE@ABA@CBCDI@C@IFB@DBBBDF@D@DC@A@CCCBEBCBC@EBC@@@GBCBADADBDBB@BC@CAA@ADCCACCCC@@@C@CBCBC@ABA@@@A@CCCEAGACCCA@CBCBC@ADG@ABC@C@AAAAAEACAEAECEECACACAC@@@CA@@CAC@C@CBEDGDCBA@C@EAQEC@CBABCBCDCBCBGDAD@B@B@BFN@BABABABEBMBIBGDGBEBEAA@GAGAE@ABEBMLCBE@E@CAEAA@ABAB@B@DBD@F@D@BAB@B@@CDEBIDEA@ACEIAGCAACAAACCAGEGCGCACAGCKBE@IBKBCECCAECGBIDGDIDEDG@K@ECGCG@@CG@AA@CAI@K@M@EACCBEJCFCJEFCHEBABAHOBADC]@O@U@M@E@C@O@E@M@O@I@A@@@@D@F@B@FADC@C@C@CBI@AAUAE@E@A@GDIFM@GAG@A@KHIHEDIBC@A@E@IBIBEDEDKDIFKDKBKAG@C@KAOBEBEBIHCD@H@FFHHHJJ@BFF@F@H@LAFDF@B@DABG@CDEBE@ABCBEBEHCN@LAPBLDDFDCDCD@BABCL@DCH@DADEFQTKLCDGNCDURIFCF@@AFA@@@@BABCH@@AD@BAB@F@BCPAHAL@PFV@@T@@BBB@D@D@D@HIBGB@D@B@B@@@@@B@BAH@@CDC@ADCBCBCDCF@DCB@DA@A@AB@@AB@B@D@D@DBBFDBBH@D@@EP@@AH@@CNHD@L@@L@VIAA@K@BDDFBFBJ@FAHCJCHAFDLBDDBHHHFLDJAHBH@NB@@J@DDDDBDDBDB@FBD@F@B@B@B@D@BBBBBBD@FADADCDDDBBBBADEDC@CBCCC@AD@FAFAFBD@B@DBH@BBFDH@BHNFJJFDCFEFE@GDKBE@ADCNDFDJDFFBBFHDFBF@B@@AFAHADEBIFEHCBEHBD@BADDLDFHHD@BBHAFDBBB@FD@F@JAJBHDFDFDDBBDBDBBBBF@HAD@DJD"],["@@@AIC@CEBA@CDA@GDEFAH@DDDB@DAFAD@BD@BDDDDB@DCDAFAD@DDBJDJBBDBHDDBDBB@B@BA@AAEEEACBADAFBFBBBBEEBA@BA@AB@@@BASK@C@QA@S@CB@D@DA@@BGCBDCBAA@@A@@@AA@@@ABC@AB@@@B@"]],encodeOffsets:[[[115696,31147]],[[115691,31204]],[[115747,30914]],[[115346,31344]],[[115346,31345]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
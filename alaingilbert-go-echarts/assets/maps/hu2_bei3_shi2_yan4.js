@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("十堰",{type:"FeatureCollection",features:[{type:"Feature",id:"420302",properties:{name:"茅箭区",cp:[110.813719,32.591904],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@CBCEIKEC@CLEA@AAAAC@AHEAA@E@EAEDCDC@ECCCEACEEAAAC@C@GBE@EBEBAB@BDFDDBD@FADABE@A@GACDAFAFCFCDGDEBADADBFBDDDDBBD@B@@@@BBBFBDLBPFDD@HBJ@FDN@HFDJBF@FDBLHFDBB@DAFBB@JAFAHEB@B@JFFBBF@BBD@DDLBDLBHDJ@B@DDFBJ@HBBBBFBBD@F@FDDF@D@D@BBBD@FBDAH@BB@HBBB@DAJBFDFFDFBHBDDD@BDBFBBBDFDBB@DAL@B@@@@BDFBD@B@@@BCAKC@@@@BF@B@@EDADFB@@BBB@D@@BBBA@B@@B@@@@@@DBA@A@ABBDDH@B@B@@@DB@@BBBDB@BD@BB@B@B@B@D@B@D@B@@BB@B@@@@@D@@AB@@@B@@@BBA@B@@B@A@@B@@BB@@@@@@@B@@BB@@B@D@BB@@@B@BBB@@@@@@@@CBBB@@@BB@BAB@B@B@@@@@B@@@BA@BBB@@@@B@@@@@BA@AB@B@B@@BB@DBB@@@@@B@@ADAACAA@@@@@C@A@@B@@@B@B@@@BAB@BABABCBE@@@AB@D@B@@A@C@C@@@AA@CC@A@CHADD@AB@BC@@D@BA@@BE@A@@B@B@JAB@B@D@@BBF@DB@B@BABAB@@@@@@B@B@BAB@BB@@@FCB@B@BB@B@BBB@@AD@@DBDBDB@ABBB@@BBB@@A@@B@@D@B@@@@BAD@BDBDB@H@BBDDFBF@DBFDCJKDEBEBAHCDCDEBGBGAC@ADA@@B@DAHAHJDC@@HCBAA@BAD@@@BBB@@@BCFGDAFCBADCJAD@JBB@B@@E@@CAAB@BCAAAAA@@@@@@@@BCBADAD@DAFADAPEDBD@DABA@AACCA@@@ABEAAA@EDGBAAGE@C@@FCBABC@@@AAA@EBC@CBE@AACA@C@C@A@@ABAAACA@ABCBAAAEIEACDA@A@AAAACBAA@@DCBE@EBABEFADCBC@A@C@EDC@C@EBI@EACACACACC@E@A@CG@@@A@A@C@A@CAECECCACCKAECKCKAEECGCCEECICECGAGCGCEA@AAE@E@CACAAC@GAA@AAAACECEA@A@CACAAAAEAACAEAE@G@CACCAACEACCAC@E@EBCHEBCDAFCDE@C@A@C@G@C@ELCBEBGBE@C@C@CBC@G@C@ADA@AA@CAKCACAACCAACAAA@CAE@AACCCECCAGECCCIEA@AAA@IAC@C@ABCDAFAHAHCJAHCLAHEFCFIPABC@E@A@A@ABAD@BABEDEDGFMD"],["@@DB@@BB@B@ABBBB@@BABA@@@AB@BA@A@A@A@A@@@AB@DABA@A@@AA@AAAAA@@@AA@A@@@@@AA@AA@AA@@@@C@A@CB@B@@@B@@BB@B@@@B@BAB@H@@@@ADA@@@B@@BBB"]],encodeOffsets:[[[113325,33233]],[[113430,33466]]]}},{type:"Feature",id:"420303",properties:{name:"张湾区",cp:[110.769132,32.652297],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BADADCBABAD@F@D@DABAB@FDFFDDFF@HBFDDDDDDBDDDFDFBB@FD@@CDAFADBJBD@D@D@F@BFFB@BAB@BABADCBADC@CBCDC@@@@DE@C@EBEBE@A@CCIAG@C@EBCD@FDFBDBDDF@B@F@B@B@@A@ADABAHAHAFABAB@DC@EDEAE@CBAFEBCDG@C@A@ABADEBEHCF@DABABE@EMI@@BAHAFBFDBBB@B@AAAACE@@@AD@D@BBDB@@BA@ACM@C@@AE@CAECEAC@A@GCACA@ABC@A@@A@C@@@@AB@@@AA@AA@AA@BCACACA@@BC@@AA@A@AAAA@A@ED@@A@@ABA@A@A@A@@@@A@ABABA@A@@CAE@AC@A@A@IBA@A@@@@BAF@@ABC@@@ADA@@BCCGB@D@BDDB@@B@@@D@D@BA@C@A@@B@@AFADABABA@AB@@A@A@@@A@@@@B@D@@@@BBBDCB@BA@@@@@A@CAA@@AA@A@A@@BAB@@@@A@@@@@AA@AAB@@A@@@@B@@@@@B@@@@@B@@@B@@@@A@@@A@@@@@@BA@@@@@@@A@A@@@A@BBA@BB@@@@A@@@@@@B@@@@@@B@@@@@@@AB@@@@@B@@A@@@AB@@@@@@@@A@@B@@@@@@@@B@@@@@@@BA@B@@AB@@@@B@@BBB@@@@B@B@@B@@BBBB@BBB@@@BABCBA@@B@@@B@B@B@BABA@@B@@ABAB@@AAAA@B@AAA@@CA@FCBCBCA@@CDA@CBADED@B@BABCAEBEBCBG@ABCBC@A@CAABC@CBE@A@@DCBAD@HDHADFDDF@BAD"],["@@AA@AA@@@B@BC@@@@@GBA@A@A@@@AAA@@@A@@@ADAB@D@@@@@BB@@@@BA@@@AAB@@@@@@A@@@@@@@@@@AB@@@@@@@@@BA@@B@@@@A@@@@BA@@@@@@A@@@@@@@@A@@@@B@@@@@AAB@AAB@@@B@B@@@@@@@B@@A@@@@B@@@B@@@@@@A@@@A@@@@@A@@@@@A@@@@@@A@A@A@ABA@@A@@AADA@@@@@@@@AA@A@A@@AAC@A@@@AA@@@A@@@@@@AA@@@AB@A@@@@AAB@A@@@A@@BA@@@C@@@@@AAA@@@A@C@A@C@A@A@AAAC@@ACAAA@AA@@C@@@A@ACGACBAB@B@CA@@@@@@@AA@B@AA@AC@A@AA@@EABCFC@@@AAE@@@@LDDB@A@@@AACCE@A@@A@K@CBA@CACEAAEACA@ACCACAGCEEEECIACBA@AA@GAAG@CBEAC@AA@A@C@CCEECE@C@AAAEAAGAI@EACCA@I@GCKAACCK@CAC@AAEEAIEA@A@GFEBIBA@EACBA@CAGEAKECE@IAEC@GCM@EAI@GCCOEKAACAEAA@@A@A@A@ABABG@A@@DA@A@AACAA@@BA@CBAD@@@BA@ABABA@ABCB@B@BDDBD@BBB@@BDABABABA@ABC@GDCBCBIGIFGHEHKDAF@HB@D@LCD@BBABIHGDBDFDD@HALCD@BF@F@FEDOFMDC@CCCAABEHCHGNCJ@@E@ECGC@@E@A@EBEDAB@FB@NFB@GJ@B@@DDJFF@XAXD@@@C@E@@FAF@B@BBADABDF@B@BBDAD@@AB@BAB@@BFADAD@@@BFF@FBFDBBDCPBD@DADAFCBE@IAC@CDCFADADEBCBI@E@A@C@CAAEA@CAC@C@C@EAKGAAC@GBEBE@CAA@A@E@GBAB@B@JAFAHEDEBEBC@E@CAAAA@AB@DBDAF@B@DBFFDFFDDFFFBFDDBDF@HBFBH@FADCDAH@FBF@D@D@@ADAB@BABA@@BABAB@B@B@@FHFDDBFFBBB@BDBD@BBFBDBFD@BD@DDDHDBDDBDBDDBBBBFBB@B@FAHADAHCDCDCDAFAJAH@F@H@F@FAB@H@D@F@LDD@DAFAF@DBDBBADBBFBFBF@HAF@FBBB@DADADCBCLCD@FBD@B@HCH@H@H@FAHA@AF@FAFEFEFCDCH@HAF@FBBBDBJDD@D@FAHMBIBEFCBCDEDCBE@CDEDAHCFCB@F@DBFDBDBFDF@BBBDBF@F@H@H@FABC@ACEECBCCG@GBCDA@CB@F@DAD@BADBB@D@DABAH@DAFAFADBBA@A@AFCBCDAB@DC@@DBDADA@E"]],encodeOffsets:[[[113385,33508]],[[113430,33466]]]}},{type:"Feature",id:"420322",properties:{name:"郧西县",cp:[110.425983,32.993182],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@KAIMGIIBITOJCDABIDIBIBC@EBCBA@GBCBGDOBI@EAA@@@CAA@@@C@GAA@C@A@C@IBC@IB@@E@KBA@C@@@A@@BA@@@@@GBGAGAAAG@G@GAC@A@GAIAEBEBABCBADCNADABCFGHA@GFGDID@BGBOJOFMDA@IBA@EBC@C@IDKFGFCBEDADADABAFADADG@E@GCC@E@IBKDGDCDA@EJABABCDC@G@GAIAE@@@G@ODA@IBIAC@C@CAG@GBC@CAC@CBCBCHA@ADABADEFEDABA@GBCBO@I@EAGAC@E@EDKJEDABABE@EBEAC@CCAEISAECA@A@@E@G@G@EAEAG@ICEAECECGGCAECC@C@C@GBKHGJAB@BABABCDCFCFCDCFGFGDABF^JRBF@F@\\BF@@BJJX@B@BHTBD@BBB@@@BDXBN@B@Z@HB@B\\FVBBBP@@@LAFCDABI@eJIH@@A@ABMDEDI@IDABIFOFCBIFIFA@EBGBGBEHGHEHEBCBCBMAG@SAA@SFU@O@I@MCMGKEIAA@E@E@IFCNEDKCIECGEAKHEFELIFMBODKDGDEBEDOFODM@I@KBKA]@I@E@SFOFGFIB@@EDLLLJFFVPPPJTDJBBDHLHVFNTDZFHHHXHXDXAHAHALCJCXIPC\\FFBFDAZ@RBD@B@B@FDFJFFHFBFBX@D@NIJEDM@SFEB@L@RJPFVALALQFGFC\\KTCJAFBLBRBZDTANAN@XBL@RD^JZHJBNBHAHAHAVKXEVC\\ELANELCBAHGFAZKTIBAPELARDPFFDLDNAJEB@@@HGDIZOTAPDHBXJLBPDLBD@NBVBXANARANFB@JD\\D@@@@@@@@@@NBB@DAPKJI@A@ALK@@@AA@@@@@A@@@@@@@@A@@B@@AA@@A@A@@BA@@B@@@@AB@@@@@@@@@@B@@B@@B@@B@@B@@@@B@@@@@B@@@@A@@B@@A@@@@@@B@@@@BB@@@B@@@@@BAHCLEJMJIHGJERCPBN@HBJDRHLBDBLBPDN@B@BAD@B@B@B@BAB@D@D@DA@@B@BABAB@D@BA\\ELEHBBB@@@@AB@@@@@B@@@@A@@@@@A@@@@@A@@@@@@@@@@@@@BBB@@BB@@@BB@@@@B@@@B@@@@@@@B@@B@@@@@@A@@@@@@@@B@@A@A@@@A@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@A@@@@@@@@@@BB@@@@A@@B@@@@@@@@@B@@@@@@B@@@@A@@@@@@@@@B@@B@@@@@@D@D@B@D@BABBB@@@D@BBB@BBBBBB@BBB@BBBBB@@BBB@D@B@BBB@B@BA@@@@BD@@@B@@@@@B@@@@@@@@@@B@@B@@@@@@@@@@BA@@@@@@BA@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@BB@@@@BB@@BB@@@@@@@@B@@A@@@@@@@@@A@@@@@@@@@@@AA@@@@@A@@@@@A@@A@@@@@@@@@@B@@A@@@@@@@@@A@@@@@@@@A@@@AB@@A@@@A@@@@@@@@A@@@@@AB@@@BA@@@@@@@@@@@@BA@@@@@@@@A@@@@@@@@@D@B@B@BBBBB@RA@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@A@@A@@@@@@@@B@@@@@@@@@@@@@@@@BB@@@@A@@@@@@@@@@@@@@@@BA@@@B@@@@@@@@@@@@B@@B@@@A@@@@B@@@@@@@@A@@@@@@@B@@B@BB@@@@@@@B@@@@@@@@@@@@@A@@B@@@@@@A@@@@@A@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@B@@@@A@@@@@@B@@@@@@@@@H@TGRCJ@FDBB@@D@BBBBB@FBFB@@FBJBB@B@NEDCF@DAB@@AB@B@B@@AB@B@BB@@@B@@@@@@@@@@@@A@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@@B@@@@@@@@AB@B@@@@BB@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@C@@@@@A@@@@@@B@@@@@@@@B@@@B@@B@@@@B@@@@@@B@@@@@B@@@@@@@BA@@@@@B@@@@@@@B@@B@@@@@@@@@@@@@@B@@@@@@BB@@@@@@@BB@@@@@@B@@A@@B@@@@@@@@@@B@@@@@@@B@B@@BB@@@@@@@@@@B@@@B@@@BB@@@@@A@@@@@@@@@@@A@@B@@B@@@@@@@@@@@B@@@@@@@BB@@@@@@@@@@B@@@B@@@@@B@@@@@B@@AB@@@@@@@B@@@@@@@@@@@@@@B@B@BA@@@@@@@@@@@@@A@@@@@@@@@@B@@@B@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@@@B@@@@@@@@@@@@B@@A@@B@@@@@@@B@@@@@@@@@@@@BA@@B@@@@B@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@BB@@B@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@BB@BBBBB@@BBBBBDB@@DDB@BDBBBDDBBBBBBNDNHJFDVALJL@@NFL@dAPA@@LEHMFW@MDGHMHM@MBO@@BQ@A@OCMBKDEHINIRGNCRDJHPJLFNGJQFOHMDEHGCEEIKMGMOOEG@AAAA@@AAAGIEEMGSEGEIKEC@@IEEI@A@@@A@@@A@MAE@A@A@E@A@A@C@A@A@CAA@AA@C@ABABABC@A@@AA@@ABCBCBCBA@@BAD@BABC@ABAB@BAB@DA@@B@B@FAD@DADCBABADAB@BADCBAFECAC@EA@AAC@C@CE@C@EA@EBAHABBF@@EFABBH@BABAFCDAHEDAFC@A@AAA@@AA@@MAGAECCEAG@G@ABEDCFCBABA@C@GAECCEBEBEADC@ADE@CACAC@A@ABCDCBEAEACACDADGDGCACCGCAB@HBBEBE@ICA@GBI@C@ED@BCBCB@BEBA@@@AAAC@CCCAA@AHGBEFC@@DCDC@CCEACAC@CBAFCDCBGAAEACBE@@ECCCAE@CB@FBFDFBDCDC@CBCAMKGCE@@D@FBD@LC@AGAECAC@GAA@EA@CBC@EBA@CBG@E@CAECI@CBCDAFCBC@EAAAAAAICGAMEICAAEAAAAAIGKCIAK@ABI@EBABEBABADGHCDCH@BAD@B@D@B@DBF@B@DBDAD@D@D@BADA@I@GAIPGPADC@C@C@@AAA@EAEACACACGIKAEDABABCB@B@B@DAB@DCFAHAB@@A@A@AA@A@@@A@A@C@A@A@A@@@A@A@A@A@A@C@AACBAAA@C@C@C@A@AACCCCEAAE@E@C@ACBEACAAA@C@CAA@CAC@CBC@A@ABCB@B@BBBBBBBBBBB@BBB@BBB@BBB@BBBBD@BBBDFBN@FABAFA@AB@BC@CDGBGAEG@AAIAEIBQBKQCEACCAQKCAQEE@EASC@AKEA@GCA@A@@G@A@@AA@EEWSGSDC@"],encodeOffsets:[[112938,33582]]}},{type:"Feature",id:"420304",properties:{name:"郧阳区",cp:[110.81205,32.834775],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BKBGKKKKAAOSFGJBTTHHFFDBJFHDBBNBP@HADAHEFIBAJMJELAHCBEBBBBDBHDB@@A@A@AAAAAAA@@@@@A@A@@@@BAB@@ABA@A@MBAJGBAFCVMNEB@D@@@B@BADCB@B@B@HCFCHC@ABCCEAC@AACBC@CBCNKFEFGBCDK@EAGGCMEGAMAGAIEIEMIEG@MJEHBHBPFDBFBHBL@@@ZAR@J@DAPAD@F@RABADADAFEBIEKDMNGBALIJGFIDEBEHK@CDKBEDMCEGKKGEAEAECELALGF@@O@IGAAAEAEEQA]EUWQUGKAUAA@KCA@@@GCIECCGECEECEC@ECEEKMEaa@CCSCM@CECIKQOKQKWCSBMBK@AFKPSD[@@E@CAE@E@E@E@E@G@E@E@G@@LC@@BC@ABCBA@EBEDCBABCB@@CB@@C@C@A@G@GAA@CAA@AAC@C@C@CBA@CACACCAAE@C@A@EACAAAABCAA@AGAA@ACCEAC@@ADMBECAA@C@A@@AA@OCA@CAEAM@AB@C@@ABABCFABCBC@CAAA@ABA@ADADABA@@AAE@AA@A@CAEA@C@GA@EDADAB@@AC@A@A@AA@@@E@CBCCC@ABCB@BAHAB@@AC@E@@@A@@A@A@ABCBA@AACA@@@E@C@CACCAA@ABADE@CAAACGCICA@AB@D@BABA@AA@A@A@ABG@AAA@ACCAA@@@GBA@@C@A@@A@@BA@A@A@CDADCDCH@N@HBB@@ACCCGAE@EA@IBA@AFC@IFEBADAB@@@@AAEBABA@C@A@ACBA@@B@F@BABA@C@@@@@AA@EAC@C@AA@@@CBA@A@@@A@AB@@ABCAA@@@@@@A@AB@DABCBEDC@AAC@AAAAAAAACBA@A@GIGBCBA@@@CB@BBDAHAHCFCDGDABAFCFILCD@@@DDN@BAB@@CAAAC@C@@B@@DFBBBBA@A@AAECEAGBAB@@NJ@FAFABCBE@GDAFCFAB@B@B@DCHADEFAB@DBFCF@FCDA@ABEBGBGBABCB@B@BA@A@E@A@E@CCCAEAECC@AD@F@DBHDJ@D@BAFAF@F@DCF@@@@CDAD@DCDABCDABABA@ABA@EE@A@E@C@CACAIBCBEDC@@ECA@EAECCCACCCCCCCAE@GEECCEEECA@ABCBC@E@C@ABABCDCBABEBG@G@E@E@CAAA@ACEAEACECCAE@A@EDGDCBCF@DAFCDCFADEDAFAJGNEBC@C@ICCAAAEAE@GBG@CDEDEFEFEBE@@BGBEBG@G@G@GDA@C@EAC@KDADCDCBCBA@AA@EBE@GAEAEAECAABCACAE@EBCBC@KCE@C@G@A@EBE@G@E@G@IBEBCBCDCDGDCBGBEBA@A@EAAAAACCCACAACGCCC@CACC@AEACAE@AACACA@AAEECAECEG@@@A@ABABA@AB@BA@ABABC@@@C@CAE@EBGDCBC@EAGAE@GCECAECEAEECCEEECAE@C@ABEAC@CBAB@BBDBF@D@FAFAFCBGBE@I@ABAHAF@B@B@DBF@FAHAD@BBLHFBD@D@D@DBB@BFDBD@B@F@J@DAFABCBCDEDCD@JBF@DABEBC@CACDOACCAAE@EEE@A@@BCBCAE@@BA@ABA@@BCAC@A@ACEBABCAAA@E@EB@@@F@D@@WCWBE@IECC@@@AHIA@MEA@@EBAFCFAB@F@@@HDFDF@@@DIHMDGFGBADBDDD@NCPEFC@E@EAEC@KDGBC@ECACHCJGBAAAC@KDC@A@@GBELCFGHGJECAECEAI@G@G@EAE@EEOEGAG@C@MGQKCEACIODI@@@KAIAQSAMFkYQ@MKQGWAYBYBSBYAMNGHCNCRGVDzCRBRABADAFEFEDO@CAOEGC]BOHSDGJSTEDY\\QJOB@AOEA@@@@@OOKIKCGAC@QBOHCFCD@JFJTTJLFHPVFR@HGJmZPFPHHFBHBDEFUNIFKRERKHEHKLGFGFCNBPHV@BFN@LDR@J@N@PEVABAJEDG@EAGE@@M@GFIHEL@DBFFLFFJNFJCFBFHBH@H@BBHBHBHA@@@@B@@AB@@@D@B@LAF@@@JAD@JAD@B@D@B@HBD@@@B@DB@@B@FBJ@PAHCDAHAB@DAFAD@JAJAJCBADCPIJSJAHJJNLBB@D@TCTHFX@FBB@@@B@HB@B@HDB@LF@BTDFBF@RFDBRLDBBDDFLRRAJABFBJ@BFHHBHADCD@@ABAB@BEBA@EAMCEAA@AACAA@AAA@AAA@AAA@AAAAAAAAAAA@A@ADABAB@D@DAD@DBB@DBD@B@BBBDAFBDD@F@F@BBDFDDBD@B@B@D@D@DBBABBD@B@D@B@B@B@B@B@@@B@B@B@D@B@B@@@BBBB@B@@@BABGDE@CBA@C@A@ADABABAFCLBHJBDBDBDBF@FBB@BD@D@D@BCHOJOHBJ@B@BC@A@C@CBCAC@C@AAE@C@A@C@ABC@ADGDCHGBCBAFABAFAJ@BAL@JBLDJHBBBBFBBBJDNFHBJDBBBBBB@FADEDCBAD@DDJBF@D@FAH@DAB@FAD@DFBB@HBD@DBBFBHD@@KAC@E@CF@HDNLDBDAD@DCACCEAE@EDAF@DBDD@FF@DAFBBBAHCDEDAB@DBDBDDF@DCDCD@@EDAFGH@BBBDD@DBDBB@@B@FA@ADADA@AFCD@J@HAB@JDF@FAAA@GBAHDDDDBCHCHCBBDBDBFAFCDAD@B@BBDBD@DCF@BCDFBFAFADDBF@H@DABABEDCDAF@B@HBHDFFDHBNB@@BB@@BB@B@BEDCBGFCBEDABABG@AAEB@FE@AAGBAB@FFBD@F@@D@DBD@BFBD@DBEFABCDABA@CBABABCDCBC@EBA@A@@@CBA@ABA@AB@BADABC@AB@@ABADADAD@BB@@BB@D@BABABAD@B@@BBB@D@B@B@D@B@B@F@B@BBF@N@B@@@B@@@BFJJF@@FDJLHFTFNHFFHJBB@BB@BB@BFHPPHNLNFJDFVWROPMRAJDHB@JCL@NHRDFHLRLRNPFZFR@PARAHABBB@DBBBBBBDBB@@BBFDDD@@D@@ABE@A@ABCBAB@@AF@B@BBDDBBDF@B@BCD@DABAB@DADADAD@B@BABBDBD@B@BBF@@@DBD@DDDBBBBDDBBBB@DDBB@DBD@FBB@BAD@LFD@D@B@B@BAB@B@DBBBBB@DBB@BB@BB@@BBB@`CPEPGLAVDTPNPNNJLPPNPDDDBLFP@BBDAH@D@DA@@PUFEBCBADAB@DAB@DABA@@B@B@D@BABABABC@ABA@CB@@ABABCBABAD@B@BAB@B@FEDC@CDE@@CACAAA@EACACDAD@D@B@D@B@DAB@BAB@BAAAAA@AAA@A@@AA@AA@@A@@@@BAA@AB@@ABC@A@AAA@@A@@@A@@AA@@@AA@AAA@AA@CA@AAAACAA@CCAAAAB@@A@@F@D@BBD@B@@BBB@@B@D@B@@@B@BD@@@A@@@@@@@@@@@@@@@@@@@A@BD@@@BAB@B@@@BA@@BAD@@@DDFBBBBDBBBFDBBDD@DB@L"],["@@@@@@@BB@@BBB@@@BBB@BBBBBABA@ABA@CBA@C@A@C@C@CBBDBD@FBBDBDBBARE@@HEJCHKBC@KCAC@ACCAAEAAACAAEACC@@C@AB@@AB@@A@A@AB@@C@@A@B@@@@@@@@@@@@@@@@@@@B@@@@@@B@B@@B@@BBBB@B@@@B@@DF@@@B@B@@AB"]],encodeOffsets:[[[113722,33990]],[[113702,33985]]]}},{type:"Feature",id:"420323",properties:{name:"竹山县",cp:[110.228747,32.224808],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@XDLBJEDEACEEA@IASBKCGAGGAGAKCGECGFCJ@JFHFLBBLHLDFB"],["@@@F@F`EAE]@"],["@@AJD@@CAE"],["@@BD@BABABIBA@ABCHADEDCBMRABGHOFABABABAB@B@F@B@BBDHHF@FBBDDDBFFL@BDBBBD@DAF@B@BBBFBD@BADABEFC@ABABABADEF@BAD@L@DBBFBFFBB@B@DADEBO@OAICCAKEKCCCA@C@E@C@E@G@IAG@G@GBE@ABADAB@JAH@D@DBFAFEF@BBBBBB@FBB@@BB@FJ@BBF@F@DBDJHHJJDLHHJ@JDJFJDFHFLFHHDHBHNJ@BLHVHHHAHGLCVFLHDJDPFPLNLBHHPJHPBLBLJJFFLFDFFLBFAD@D@DDBD@DAFCDCF@B@F@DGJCDEFADAF@FCZ@D@BDFHFDA@IBAJEDCFAHDD@D@DAFADC@AAACAC@AACC@ACC@ABIAE@A@CB@DBHDJ@DBDDDDFBF@DDFBDD@DBB@D@FABABCACCA@A@CBAB@FDHFFDDBF@BCFCDCF@@CDCDCBE@ADCFEFCDCDE@CBCFEFGJCBCBA@CAIEC@E@C@ABAB@HBDBD@D@BA@A@EBEBSJIFABCDC@G@ECEAEIAAEAMEA@GECAC@CBAB@HCDCDEF@BAAA@ABCD@FA@C@A@BECCACCCAACBMDEHEBCBAB@BBBDDBDDH^@BA@ABC@A@A@A@AB@B@BAB@BB@@B@@BAD@@@B@BAF@D@D@B@H@@A@@@CCCC@A@A_FBF@LCDGBGDGBE@E@EBEDABCBEDADAB@BBDDDHDFFDBBFADAHCBA@CBCBAFCFAHABA@CACCEAAC@CNEDA@CIECCCAC@E@GDCBABAB@FBFAD@D@BB@LDBBDDBF@BABEDEBECCIOE@EAAC@CDAB@DIHAFDDD@FAD@FBD@BBABA@EBA@AD@F@B@HAHUHSDKBABCDCBCBA@A@GACAC@C@CBEDGDGDE@E@CA@@CACECGBC@ECAAAG@CB@F@F@DBDBF@ABA@A@@D@@BBA@A@@BB@DBB@BBDBBDB@BBBBB@D@B@BABA@C@AACA@ACCAAAAAC@A@AC@ADI@EDDHAFANBF@DADADDHADEFKHEBC@E@EAAAAAA@ADAF@DADABBDFFFFDBBB@DEDABABBF@F@DADCD@@CA@C@CAACAC@AAGKACAACAC@C@A@A@AB@@AB@@CDEBCD@@ABABADA@CBA@EBA@AB@BADAF@BAD@DABAF@F@FAJ@FBDBBDBDBBB@BABCBE@EBEDC@C@CEECAEGIGCCCAAE@OBMFKLCDABCDSRKHKFGDWH]IKC[BOJIHMFCB@@CDSJ@@SD[DHHLN@^FHRL\\FTFNFXNPTGVMNMRCPCLHXFFDBNF\\BX@VBLBX@ZB@@XHLBLAL@DCB@DCBAHENGDAB@XKTAXCTEZG^CT@VB@@XBPFRH`LZBH@ZA\\GRGLGLGT@NHREB@HKRU@@HIPKVEV@B@TBNFPHRHVNNLLJTHBDFFBNAPGJBPJHBBNBH@X@VCRILKPONID@TBLHDHFLRTnYHI@GEQOUEGIKSSEI@IDCDEPGRAD@HBLDLJPP@@@@B@PF@BPARIZ[FCTSHITCPG^AHDPFDBP@FCFEBEBCBAAQDQCyGMAQDQLWHGPGNELCNELEJEFENQDQBMFMROLIDGWaOIOGMKWSU@M@TaKO@@QWEGC@GECWFWFENHPRLJNANCBGHQ@KIGBKLOFCDCDGLEVBRBDACGCCA@SMIGCMJGDI@EGEK@[GKO@OBK@AAA@@ACGA]BIECSFEHE@I@A@KCYMcCIEMCG@@@@EKGOQQ[MYAE@MIMEGBEBW`OHmNcNGBOAOUCGEICEEICKDI@C@CAKAIEKKIUKQCGKIOKKEO@KBGHELCJIFIDIDKJCLAFADEAKAIGMCGFQDQDMBKFK@IAMACEGIGKEMCG@IBI@IAIIMECCMAGAEACAGGCKAIEKC@@@BECAECMAE@EDEBGB@@A@AA@AAE@E@CAAEEAAKIAA@AAA@O@K@AEEGEAAAAAIAG@CBAB@DAR@H@HAFALCDA@@CCAC@@AA@A@A@A@A@A@A@A@@CCAACADIHIHODKBUAKAI@CCIAMCIAE@IBI@G@CEMCGEEAIAI@ICMEMCIECGM@IEMGO@GHCJ@LAFBBMHOGIKAWKIG@CCCGEEAGCACICAACBCBEBEBCBE@C@E@GAEAEACACEECCAGC@BA@ABA@EACACCEACAC@C@E@C@C@EAAAE@C@C@A@@BABABEBCDABAFADCBCBC@IBCDABAD@F@FBJBFBFBFBBBDJJFDHDFFDHCFIFK@KBEBCFEJETCPEP@HHJPJJFDFBFAFCHBJBHDJNPDDDJHNFLDJBDDDDDDFFDDD@@B@@@BBD@FBB@BBF@DBDBB@CDCBKBCDA@CDIRADABCBCDC@ABE@O@MAMAIAOAM@YAE@AAE@G@@BADBBHDHFFFDHBBDBBBD@F@LBD@B@BBBD@FBFBBBBHDHBBBP@B@DBFDFDD@BBN@J@FBJDDB@@BBBD@DFDJDDDBDBDDDDBDDDBBDBDDBD@FBBBBB@DBHBD@@DBDBJBDBDBDB"]],encodeOffsets:[[[113064,33032]],[[112615,33003]],[[112465,33106]],[[112656,32565]]]}},{type:"Feature",id:"420324",properties:{name:"竹溪县",cp:[109.715304,32.318255],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@@BC@AA@@@AAA@ABA@A@@B@B@B@BAD@BABBF@B@BDDDD@@B@@@@G@A@C@CBE@A@A"],["@@CAAAAC@AAA@CAA@@@BAB@AC@@@@BAB@B@D@B@BBDBBBBDD@BDBBBD@B@BA@A@A@CAAAA@A"],["@@ACCCAA@ABADAFAFGNCDABBDDBDDDAFB@D@B@@EDCBAB@BB@AFEDCDC@GBADAD@DBHFB@NFFBBBFJFBFDH@D@DCBAJETIFAFAB@B@@A@CACAC@GBABAD@F@D@JFDBB@DADAHIFEDEDAF@DCDCFEDEBCF@DADCDC@@DEDCDE@AAECCEECG@EBADAB@B@DDDBBABA@E@CAA@CCCEACCE@EACCCCCAI@GCCAA@@D@BBFAJ@BDD@BDDBBD@DBBB@BCDEBCBC@C@GCEBCDIFAB@JCBGECE@A@CDY@EBEBCFEDCHI@C@E@ADEDCBE@CACCCC@C@EBKAEEECEKIEKIKAOAIGGOAGMKOKOEICGCEKDUHKBGGGUGKG@AMIAGCGGGKEGECEEICI@IGIKGICGIIGAC@C@EAE@AEIA@@AA@EAA@AAAA@AFEBEAE@C@CBG@IBABCBAF@HAH@H@JBH@F@D@F@D@B@DDLDLFDBJDPBP@FABC@C@AAAEEEAAA@C@KBC@AFEBCBABABAD@FEBABC@AACAEAAA@E@CBC@AACA@AEKAECCACEAE@GGAC@A@A@E@ABABABABAPEHGBANQDAFCBCDGBAB@JABABA@AACCACACAIACACA@@ACAG@CAAAAEAC@CAACACCACCCACCACACCCICEC@CACAA@@CAICEAI@M@AAC@ECECCAA@O@AAGAGCAAAAAE@EACAAA@C@KAE@C@AACAAACGEEGEGCAABC@AH@F@BBF@ZBN@PBJBNBNBP@F@BAD@DCDABABCJQDCB@DCLADADCA@CACAE@AAA@EAC@AA@@A@@@CCECCECCCCACCIEKGMCICCMOCIAGAIDGBEAECEIEOIGI@GFODOFSFIDEFALAL@JEDECGEEGCECIIACAAAEAEAEAI@E@EBCBADCG@@@A@IAC@KAG@G@G@IDCBGHABCBEBEBA@I@EDCBADCN@@CDCDABA@IHCDIBGDC@E@C@E@C@GAE@GEIEMGIEA@C@A@A@ABCFCH@@CBA@C@E@AAA@CAI@E@C@CBC@A@EACAA@A@EBCAA@C@A@C@E@@@IAC@E@E@GDEFKH@B@B@@@@FF@@BBBD@@BBBHBD@BFDHBDDPDF@@BB@@B@BABAB@BAB@@BF@F@F@F@B@@@BBBB@DBH@FBHBHB@@BB@BADA@ABIBCBCDADCDADGHABCFGDAB@@@B@@@DFH@F@H@F@B@B@BAD@DBDBF@BBB@@AB@B@BAD@@EFCFEDAD@D@D@B@B@DAD@DABAB@@AB@@@B@@HHDD@D@BADA@ABA@C@MAE@A@CBABA@EAA@ADADABABC@GBG@AB@D@DAD@DAB@@ABIBC@CB@@EAICK@GAC@@@IBEBABIDI@CACAAAC@C@GAEAGEECC@@@C@C@EBE@CBABC@GFADA@EBABA@CDABCBC@@@EDB@BB@@BDBDBDDFBBDBLDFDD@@B@BABABCDKBCDA@AB@DBHBJCLAHAJ@HBJBDJNJJFHF@HBFBJBRALCDFDHCPELELCJEJENCFAFENCRIPCJGHGFIBEBIDCH@B@HJTDNBHBDJBHBF@JBJBJBFHJLDLBH@D@B@L@F@FABCJEJGHCFCFINGFEDCBED@@INIPAFETBVDRBFHPJPLNJLFFBDFJDVFNAHGJOTMFILEJEHEVCN@@@JBH@@@BDPJRLVFL@LMNINMFKDKBKCKAABGBMBAHALEHEN@@CDCLGPKRCDIJEFQLOHILCH@BFNBDV`FP@TCRELEJGTANDVB@FLJHP@NBHLAHAT@JARHJVLFLFJB^JPNDLD\\CTC@@TIDC@@DANEJGPI\\ALD^JXGHCLELGTQDCBADCLKNEPAF@BBDDHDHJBFFDDFD@D@FCFAF@DABA@AAACACAAAAC@EBI@E@EBEBA@CBC@ABEBC@ABAB@FAB@DAB@BCBABA@@DCFADC@@BA@@BAB@B@D@D@DBBBBDHLBBD@DBBB@D@DDB@@DCBC@C@EAEBABAFC@CAACAEEEEACBABC@CBEBCB@BBBBFBF@D@FALGFEBCCGBCBC@CAEBMBECGFCJ@BCBIAC@C@E@EDAH@BBDB@FADDHDFDB@@DBF@F@HCHCFCDAD@D@DBHBB@B@DADADCBALATCVGBG@G@A@EBCB@FAB@BAAAC@EAC@EBC@CCBEJG@CBADCD@BB@FPFDJFDFAFCBA@AAECCAAKCA@@A@CBCAE@EBABADAHCF@D@DBDDJF@DCBMF@DBDFBDDDBB@BABGDEBEDADAB@DABGBCAECAEEGCCCAC@ABABCFCDABAFCFAF@F@HAHCHADC@KAE@E@ECG"]],encodeOffsets:[[[112637,32998]],[[112476,33114]],[[112613,32999]]]}},{type:"Feature",id:"420381",properties:{name:"丹江口市",cp:[111.513127,32.540157],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@SGWMSQQMES@CAAAAA@@A@A@A@CB@DAFCBA@AAACECCAAGECAECECIFA@@@C@A@@B@@B@@B@@@BAAABEAA@C@ABADA@A@A@ACA@CAECA@C@@@CDAB@DABA@@ECCAAA@E@AAAAC@A@ABEBCF@B@B@B@B@B@DABADAB@@IHGDGFQHM@KAKAOIGEKO[QMCGCGAIKScEGKKCAQGYAQASFKFGHIVANGRAFA@SHQHA@C@ABC@EBUCSIUEKKMEA@QDIHEHSFeICAEDBJPVCJI@MEKIMEI@KBOFKNENEPChCdABALCTEZC\\CPBFDBDBHAD@FAF@D@DBBDDFBBDDDBH@F@FBDBBBBFBBDBDBB@B@DFDFBBBBB@HBD@BBBD@D@FBF@BFBHDHDHBFDJDFDDFHDFDBFDLDLBFDLBDDDDFBF@D@B@D@B@B@@DHB@F@D@BDBDBDBD@FAJ@F@DCD@F@D@BADCDEBAFAB@FAFCD@@BBDABBBBB@B@DCFBFJBBABAD@BDBBBAB@BB@D@D@B@BD@BAF@DAD@FBB@B@@ADABED@@@DHFBBHAFCB@BBAF@B@@DBBD@BABCBC@CAOFCBEBCBC@CBABAD@@@@@@@@BBBBDB@ABADB@@@FA@A@IAC@IBCDABEDCBEHAD@@A@AA@@C@ABB@ABGD@@CDB@B@DABBBBBBBBD@BBD@FCDABA@CBAB@@@@@@@BBAD@BA@@B@B@@@BAB@D@@BBD@D@FBB@@B@@@@@DABABE@A@@@ABBDB@D@B@BAFABB@@@@BABCFAJED@BEB@JAB@@FBFDHDD@BA@GAM@G@CDCDCB@D@B@BAB@@@BB@D@@@AB@H@@BBDD@BBB@BAH@B@B@BBBB@BA@A@CBAB@JDHDBDBB@DCFAB@BBBDDDBD@F@@@B@BD@BABAD@B@B@BB@@@F@D@@BA@GBABA@AD@BDDAD@D@F@@BBB@B@D@@BA@CBCB@FHBD@B@BF@D@BBBF@BB@@ABCBCB@BAB@BBBDBD@DABADEBABA@@@DBAN@FBDBB@PDB@@BB@D@B@DBAFCN@BD@FBDD@BBBBHB@DBBABBDBFBB@D@F@BBDDDBDBB@DAD@D@D@BBB@DBB@HBH@B@D@D@@@DA@@DABADAFCFAB@DABAD@@AD@@KH@F@F@H@F@F@F@F@F@DBF@@@C\\OTEL@BALANDTLXLRRPJLFD@DDNDT@DbbNFFLDF@FFDFDDFHFDDJFHD@@B@LDB@VBLBVHXRFVB^FRBFBFBBJHP@@@HEBKFKOWCMAMDGDI@ADEBC@ABQEKAEAIBGFE@AL@TPDHFFFFFDB@FBFCFCHIBG@E@CAGDMCWBEBADALEF@NENEJI@@NEHCPARCLAJ@PEPGLCJMDOBCJOFSB@BA@@DEBC@ABADCBABA@C@A@CCC@@BA@AB@BAB@BCDC@@@C@ABGBA@A@ABC@ABBBAFCD@BABCB@BAB@FDB@B@B@B@@AB@B@@AB@B@D@H@DBB@DDBD@B@@AF@@@BBB@@DBF@BAB@B@B@B@D@BBDDB@@BAD@@AB@D@D@B@B@@B@@BB@BADCB@BABA@CBAFGBABABA@A@AAA@AAAA@CAAAAAAC@E@CBA@C@A@A@A@AAACAACCC@ACA@CACA@@AABC@EDCBEDABCFA@@BA@C@A@A@@A@A@A@A@@BAFC@ABA@AA@A@A@EDEDABAB@BA@CBCBC@A@AC@A@@DCDADADAFEBADAD@HAJBBAF@H@BBD@B@FABGDAJBBC@C@@AECCC@@CBCFADAFC@A@@@CBCDCBAFAFAFCFGDAB@BAFALELGDCBEBAFMPQBACMDMBEDQLMRORMLKNGNED@FALFDI@E@A@SEKCGCEIMMOECEEGGAA@EBC@AA@@CA@CEAAAC@AEECCEGACE_MQMNCAIGGACBAA@CA@C@A@AA@@ACAC@A@AAAAA@ACCCC@AC@@@A@CBABCBC@AAAAAACCCEA@AAA@@BC@E@A@A@@A@A@CB@FCDABABC@@@E@A@A@@DCDE@A@AECGGIIMKKGSASCKEUQMEC@O@CHKHIHMJKBGEACHGEIEKIGCA@@KCOIUIOACA@@@@IAGGKMEEAGAIIQKGOEUAOC"],encodeOffsets:[[113949,33125]]}},{type:"Feature",id:"420325",properties:{name:"房县",cp:[110.733181,32.050378],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@B@D@@@FBBBBB@@@BBDBBBDBBB@D@D@DA@@BEBADEFGAAA@ACAACAC@A@A@K@E@CAC@@@E@EAC@@ACA@AHI@AUOEAE@EBA@AABEACCGEAEBCA@@CAE@A@ABAD@B@@HBBBALBFBDBDDB@@NFDH@@@B@D@@@F@B@@@BBB@@@@BB@BBB@BDBDFBFDD@@@@BBB@B@FGDCBADEBABAB@B@"],["@@@C]B@D^A"],["@@ALJHTFEG@IUG"],["@@@@CAEC@ACAGCSIMGCAAAGMGEKGA@MGIAKEEAKBI@GD@HHPFN@JHNFDDJFNDN@JBJBJFFDHFN@D@HAJ@JBFDJBNDJ@DBJBLAVCLGPGJCJDBBBDD@@@B@B@B@B@B@B@BBB@@BDDD@@CBKDEBGBG@Q@CBA@AB@DBHBJBBBBHFFF@B@L@PBB@BBBLJBBFFBB@D@FBF@BBBB@@@HAFAFCF@NBFDDBAF@@D@FLBJDLHHDBFBHBNBDDNFJJJBJ@JAH@NDLFJHFHBDBN@JELALCNCRERDHHNBJBLCFEBKBIDCLCJEJIJKDGFAH@LFPLLJPHLRDVLLJFLBJBL@D@DCJDLFJDFFJDHPVPBHAdMnMPGX_FAHANFNJF@ZB\\NRRHPFL@@@@DHFNDJNdDZ@L@B@JGFEFDTJF^AHBBD@@BB@BAL@PLP\\HL@HF@FCJIHDNJHTNB@DDDHCBQAUAKFCHCDEDKPVHDIHEFDDHBLBHHHHBLDTAJBB@FFBDCFIFKAWCEAKCKGAAEKSE@LGRAHMDMBKIOQMGEFEXDXHFD@FHRX@@LPSbN@V@XTNLPHPJXbCHKJQPENANCRMREFIFKFMFKDMFOHGHKXCRBRHNHUDQDMHGNMZBTAZAZAXBRHNLR@lZNETBBRBJ@L@@CJJPBDDFRLNHD@H@HBPFFFF@FBH@H@J@FBFDDBJHDADAHCD@BAB@BABABAAC@@AA@AACCC@A@ADABAB@BABAB@@A@@BCDAB@@AB@DBBBB@B@@CB@H@BABAB@B@B@@@@AACCACCACAEBCBCFAHCDCDEBEBEDCHBB@F@BABC@EACCCCE@ABAFAFAF@HAD@D@BBFBDFFBDDFDD@DCFCFBF@B@FBBGD@BBBBB@KF@DFDJLDFDANCHEFCFCBA@ABCBAB@B@F@D@BAJODEFEBGDKBGDIBGBGBEDCBAD@D@JBB@BBB@JFDDFDBHDDDFDDBBF@DBB@BBBDDBBDDBDBBL@DBBB@BCD@H@D@DAD@D@F@HAFADAFKD@H@D@B@D@F@DCBEDCFADGC@GBCACAAEDOD[FYDSBKBADcDgFOFMLMPELAJ@NFLJNFJ@DIOUAIFCDBfJTEFGJGRCB@NFLLVFTJVDFAD@BAD@B@RGTGB@BEHQBMJUHGLETERBZBRHDBLLFHTdJLHBHDND\\RLPHFPJLBLBN@RGHEHCJG@@BABCBA@C@A@A@A@A@ADEFABAB@D@BBBBF@B@BBDD@FB@BA@CBADC@@D@B@FDDBB@BDB@B@B@BCBAD@B@FBBABB@A@@@AA@@@@AB@D@@@B@JECEKG@AAABABABAD@B@DAFCBABCD@@@B@B@B@BBBBDBBBD@DAB@F@@ABAAA@AEE@CAE@E@C@C@E@E@CBC@ABABAJADADABABCBC@ABADEBA@@BGBAE]KWCECGEGGMACAAAC@AEKOMCGAACEEEMIKEGESIKG[MWEABGFEBA@C@A@C@AAEACACA@AA@@C@@EECCCCAA@AABC@CBC@AACICCCAIACAC@CCGECCAACCAAEA@@CFEBGDAHADA@AAGICCIAGGEMEKCM@E@@D@FDLFLBL@FABCBA@EAAAG@CACEIGCCAAE@QAC@CDAHEJBBAACAG@CAAE@C@AE@EACAAA@EDC@CAEEACA@@CAE@ABE@A@@@A@@CCA@@@BAFBBA@AAAAA@@@A@AD@BADABA@AB@@@B@@B@B@B@BBBD@BB@B@DBDBBFFB@B@BADAD@D@DEBABGBCB@@A@@@A@AC@AAA@AI@A@CBABC@@LEF@BABADAFQDCBA@C@AA@CCECCAA@A@A@A@ADGFABELGH@BCBABABCDABA@C@CAAACGAA@B@BCT@BCHMNADCJ@PAF@@AH@@CD@BADIHIB@@CAG@IBM@EAA@GCCAC@AAA@@G@CBCBA@A@@AA@@C@C@A@@@AAAAA@EIAC@ADAFCBAD@@@BABABADAFEB@@A@GBCB@FCB@@A@AAAAAA@C@CBC@A@@@@ABABIBAACBA@CBCBADCBEBC@ACCAAC@A@AA@ECK]B@D@BBBB@B@@BBB@B@B@B@BABAB@@A@AA@@AAA@E@@@C@G@C@A@A@A@AB@@ABAA@@@@@@@ABA@AFAFCBAD@@ABCBA@C@@BABA@@AC@ABA@CBA@@B@B@B@B@@B@@@B@F@B@B@D^A@CAG@AACAA@@@@BAB@BADAF@@ADGDCCCKGCCEGA@A@@@A@@A@@@AB@BA@C@EDEB@@@B@@DB@@@BAFAB@B@@ABC@ABADC@A@YAG@EDCBCDCBADABCBC@C@C@CACAAA@C@C@EC@@@ABAD@BA@A@AAAA@AAEC@@EAIAAAAACAA@A@CFEFAH@DDJ@BAB@@CD@@@B@@@BD@HDB@BBBD@D@F@B@B@B@BABCFABABA@CAA@ACAACCECGCGGCCACCAE@AD@DCBADCBEBCBCDABADAD@J@DABA@KBABGHABA@A@A@GCECEAOEG@CA@C@ADC@A@C@CCBEBIBEBEDEDABCDADADABABC@E@G@IAECAAAA@@BABCHCBABA@A@AAAC@C@@@CFCBABABA@AAAC@CDEHG@A@A@@AAA@CAK@MCE@I@A@A@ABCDA@@BA@KAA@A@ABCDA@A@C@AAC@A@GDC@CBAAC@CACAAAA@ABADCDEH@BC@EDE@G@G@EA@A@EDEDO@ACCACCAA@M@C@EAECAC@A@EFCCCAA@AAAA@CBA@ABADAFCFABABABA@SDI@Y@CBEB@@A@KBEBIDEHO@ODMFOH@@EAECAA@CAIAE@AAAAAAAC@C@C@E@CBMHCBA@CAA@IC@AC@ABC@@BEDA@E@CAKGA@AAA@C@A@ABIF@BA@C@C@EAIEC@C@ABEDA@A@GAC@C@G@GBEBEBG@O@@@E@GBA@@AA@AIAA@AAA@@AAC@GAE@CAA@A@CDAB@@DFDF@BBB@BAD@DA@@BA@A@E@CBCBGAWEICKGACBCBAJEBA@A@AA@AAOEKECA@A@@@EBG@CAG@AA@AAA@GBA@AAGAAAECGEA@A@A@ABABEDGBOB@B@@@B@BBBABADABA@ABOBCAA@A@CAE@E@EAAAAA@CCEAECCAACAEAEAAAC@GBIBC@C@CAEA@AA@@C@GAMBM@C@ABABE@C@@@ACAAAECEAKCKCCAA@A@G@IBE@G@KAMAG@A@C@KDC@G@C@I@IAGBIEEEGGIEKCKAKCICGEGGEG@KBGAMCIAEAC@@AC@@EQAA@EAEAEEGEK@AD@D@DAB@DCJEDCFEBCBE@IAMACCCCCICICEACAEACEEEEIAGBA@AF@DAB@DEB@B@D@B@HC@@BAB@BCB@@A@A@@@ADAB@BA@@@A@AB@@@@AB@@A@@BA@A@A@@@@AC@@BA@AAABA@A@AC@KCCAAAAAAA@E@I@ECEAAAAC@ABABIHKHCBC@IBC@@BEHEFCDABABCL@BEDCBCBCBE@A@C@C@EAM@YBS@C@CA"]],encodeOffsets:[[[113793,32807]],[[113672,32697]],[[113031,33005]],[[112771,32362]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("随州",{type:"FeatureCollection",features:[{type:"Feature",id:"421303",properties:{name:"曾都区",cp:[113.37112,31.71628],childNum:1},geometry:{type:"Polygon",coordinates:["@@AEDODODIDM@MDQCMGIECCB@DCDC@E@CA@CAE@CAGAGCEAAAAGEKCCAAIACC@EB@F@DAJ@DA@@D@@MDOFA@ED@P@H@D@\\@JBHBFDDDDBD@F@B@H@F@L@D@BADCJ@@@HAF@BCR@FAD@D@DBBBDBFFFBBJJFFDBBDB@@B@DABADGBKBKBGDULCDONEFADCHAD@DBDBBJDNHNFHFFFBDBDAFEHGJEDAB@D@DBVDTBJ@F@FBFBDJHHFDDBFBF@N@F@PBH@NBL@DBHAFADCBKDOJGDID@@A@CDCDCD@B@DBBBDHDDDDFHFDDBB@DBJ@DBBDFBD@D@@@H@HAFALAJ@D@BBDBBLJDDNLFHDFDHBNDJDLBFBDHFHFFD@@FFLFBDBD@DADADCDGHCDKNGLGHGFJDNBTBRDF@BBbHF@JDBBLHHDH@L@@@V@F@HCJANCNAL@HADBD@DDBDDHBBDDDD@BBD@NAT@F@JAX@XBFBDDDJFRJJF@@t\\DDFDJFDBF@DAF@RGDAFADAD@B@BBB@JLBFDDFBDBFBF@F@D@H@BADCDCBCLWBABADAD@HAJ@D@B@BABABCBAB@D@D@D@DBBBBBDDDBDBR@H@@A@G@ABCDIFCNCFADADADGAGCGA@@AGKIGEEEGCGAEAGAKAECEAAC@C@CBEBE@AACCAAEACIAACCAAGCA@@AAABA@AB@FA@A@KAK@A@@AAA@CCACGCE@ABADCFE@IDC@CACAIKIICECEEIAA@AACBCBAH@HEHGCKAGCEACAGCGFCNCFADA@@HGFCBEFKBGB@@C@IBI@AAA@GEGGKCGCE@EBGDKBADAAG@ECIACAEAM@EBIAGAKEKCI@CCMCEAC@AAA@A@C@ABA@@B@DCDCBCBC@@@@@C@CCG@AAA@C@CBEBEFE@A@A@CAAKEGACCAC@EBC@AD@BADA@@DAJCDCFEDCB@@AHCDADCBE@A@G@C@CBABABAB@DAFADABABEBE@C@@@A@CEG@@@A@C@CBEBABC@CBAHGBC@ABE@A@CAECGEEAG@AACBK@AAECEGEAC@E@CBCBEBCDAFAHCNAFADABC@CAGACA@CAEBCA@@C@@@A@@A@@B@BC@A@A@@@@@AA@ABA@CB@B@@A@A@@A@@@A@@A@@A@@@A@@AAC@@@AAAA@@@A@@BABA@@AA@A@@A@A@A@@AA@ABAB@BA@@BA@@AEAAAA@A@@@@C@A@@BA@@AA@@AC@@@@@ACA@@A@@@CBADA@A@@@AACA@@CBA@@@AA@@@AAA@@@E@ACA@@AA@A@AA@@@A@AB@BA@@B@DAB@@A@AAA@ABC@AACA@@AA@@A@A@C@@@ABA@AB@D@B@B@@EBC@CBCDAB@BAB@BA@@BA@ABA@CBC@ABGHABABAB@BA@EDEBABIJADGJABA@GA@@A@G@C@@AA@@ABA@AACAAAAA@ABA@A@A@GCCAAAE@EBCBCBE@ACEE@AC@G@QF@@A@@@A@GAA@KCGEECIEI@MBEBIJABIFEFGDC@IAA@IAMCGCEE@E"],encodeOffsets:[[116054,32169]]}},{type:"Feature",id:"421321",properties:{name:"随县",cp:[113.290634,31.883739],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@HABEN@B@B@BB@DB@@BAHCDCDCDCB@@ABA@C@E@ACAA@G@A@@@@@@@@A@AB@@@BABA@@BA@A@@A@@A@@A@A@A@@@A@@@A@@@@@@BAB@B@@@BA@@B@B@D@B"],["@@@E@G@A@EACCCCCAEAG@I@[@C@G@OGDEDMJKBEBI@KEEAILGLKPEDGLCDGHMNKHMDEBEDOFCBIDGDA@CAAAAEEAEBCFCFADEBEBADBD@DAFDBBADEBCD@LHBD@DEBC@E@@DCDABGDADECACAAACKGCACAC@CBEBADCAEACAC@CF@FAFCDGH@@CDKDIBMBI@OCIAGEECKCKFEDIHGFA@IBE@E@GCEGECMGIAMCC@ECIDKDKNOTINGLGHA@EDMBG@G@CAEAMAOAKBIBEJCL@H@JBLBJ@JAPAFGTGNELGLGLIJMHGBA@QBM@EBEHCJELCJKNKJQDIBOBQBKA@BOBILGHCBGF@@GBKAECECEDGBEFIBGHCJAHALDHBF@BBH@FALALADADAJBHBJ@F@F@B@JBJDLHFJFFDFFDHBF@LAHAD@FCL@JDDDDJ@HATCHEPGHAFDDB@DDHFF@@HFJBJ@J@H@LBJDBDBDFHJTHNHNJPFLHFHDNBNCHCHGDCLBFDHJHJBLHFLHHHFFDFHJNJFAH@F@FADCHIDADAHADAF@FDHDHJDFFHAHCHCHGFCJCJCLEJ@JAHDFDFDDFBF@DBH@DAHDHBDFDDDFDJDF@HBH@J@@BJ@JBFDDFCDABBBH@DDH@BDDFAJ@DCJBF@FDBH@BAH@@CHIDMFEDMB@@MBK@KD@D@L@PAH@H@@@B@JB^@LA@@D@B@F@HAB@B@VJNPLHFJH@BRT@@HJTTPTJDFH@B@B@FAVOLIHMLGN@TBZDVBBFN@BHPBPAR@R@VCRIVGXFLHFRBP@H@HBXHRPBDNRJLFRAF@JWTKFKHCHCDCTAL@BFDLHZFtIB@D@FHFFDHH@bDJBB@D@DCB@DBB@FF@D@D@FFVBF@DAD@D@F@BRP@@DBFDJ@J@H@HDZNLDF@B@FCFCDCHAD@DABCBIHOFEFCTMB@PKBCJCB@PGFCFGLEHG@@JABCBAAC@EBEBEDEFCFAJAL@HAFAFCHCFCDE@C@@@A@@ECYOAC@C@CDCBAF@LAHABAHILKHKHCFAFA@@H@F@B@NFHBB@LCJCDCJGBE@CBC@ADCD@J@FAD@LCFIDCF@D@BBFBfZVNHBDBBBDFDDDBDADADBFBFBHHBBB@JFNJRHB@B@HDLHDBBD@BB@@BB@BBDDB@F@D@B@BAB@BABABAB@B@BB@@B@BBB@F@B@AGAIAG@EBG@EBEDAFBJDFDAF@HAFAFCHA@@@A@A@ABAB@BA@@F@@B@@BFBBBFDBBBD@B@DB@DBB@D@BAB@BAB@B@D@D@BBBBBBD@D@BBCB@BADAD@B@B@B@F@@DAB@FBBABCBA@AB@@ABBDBB@D@H@D@B@BAB@B@B@@B@B@B@BABABCBC@EBC@A@ABAB@BABBB@@@B@BB@@BD@DBB@B@B@DADADADABCBADAFAB@D@FBDBBB@@BB@B@B@D@F@F@B@D@F@B@BFDHFFBDBDDBDBD@DDDBD@F@B@BDDBBBBBBBBBFBB@BB@B@B@D@B@BABA@ADAB@B@BBDBBBBBA@@BA@ABABABAB@B@@@BDBBB@@B@@ABAB@DA@AD@BA@@DBB@DBBBD@B@F@BAB@DCDA@@BAB@@BDBDDFBB@@@B@B@B@@AB@@C@AB@@@@A@ABC@ABAB@@@B@B@B@B@B@@@BBBB@BBB@BBBB@B@@B@@BARGB@FAD@BABCBE@K@CBCDCBA@@B@D@D@B@BCBAD@B@BA@ABC@CACCEAEA@EAC@@A@@AEBCJKFEDAB@FBBDDNB@BB@@F@DAD@BABEBG@CAACA@@GCIECAISAK@EBILKLEBC@@BCBC@EDAFCBC@CACGAMEGCCAEAC@AACCEICE@CBGJI@ABCBM@CCAGAEAA@CAACBMBKBADADCDCBCF]BGNKDABEFCDABBHDFBDB@@BABC@G@CBADCBIBC@GACKGACGIOWACDSBM@IAE@AIMSUGCGCA@C@ADAJADCBMDGBA@AAFUDQ@C@ABC@ABIAQKDIBC@A@@C@OCEQMIGCEAA@AFABABCAEBEDI@GEIEEEESMOSKMAA@IIKCACCECAAAEACAECAC@A@C@ABA@C@AAE@AACAAEAG@@AEACGAECGBEDEBEA@E@I@A@IAMCI@@AAAEAIDGDM@GG@Q@CACACCAAAACAC@C@C@A@ABADABABA@C@I@GBC@CBABABKXADCDCDABG@C@E@E@EACAEACCAEIKA@AAA@C@CBEBCBQHE@CBE@CAIEECCCs[@@IEQIIECCACAE@WBW@I@EBS@MAC@ACCCCAACGACCCC@CAGBK@MBMDIBGDE@U@@@K@G@GCKGAAICE@aGAAE@QCSAMAICHEHGHKLMDCHGDCBCBC@CACACKEEE@@ECGEGEACAECKCIAMCGCEEGMKCCKIAAAC@A@CBIBKBE@G@G@@@CACCEAA@CAI@CAACCGECECCGCACAA@C@ADCDCDCB@@@JCHCPILCDABCBEAG@CAK@MAG@O@E@MAEAECCGEIGACAE@E@EAICSAU@C@CBAFCHIFGBEACACEEGEMEMGICAAAC@CBCDGBCFEPMDCVKHCLALAHABCBA@C@AA@ACCAEEIIAAEEAEACAA@C@CBC@EDQ@ABE@G@@DIBC@A@C@K"]],encodeOffsets:[[[116489,33052]],[[116003,32158]]]}},{type:"Feature",id:"421381",properties:{name:"广水市",cp:[113.825889,31.616853],childNum:1},geometry:{type:"Polygon",coordinates:["@@GGECMEKAKD@@EDBHADABI@CBC@A@CCAA@@BA@C@A@ECC@A@@@ABC@@@AB@@ABC@I@@@AAA@A@A@@@AH@BC@CAEA@A@ABC@A@@@AAA@@@A@ABC@CD@@CAECCCMEA@AAAABA@A@AEA@@A@@B@B@BAB@@CAAC@@E@A@CA@@A@GA@@AB@B@BABA@A@@@CHBB@HBDFBBBHBB@F@D@DAHBDD@D@@@D@D@D@DGBMBEBKBEBCDEH@BCJAFEFCBKD@@EAGAECAECGAGEI@CACGBEAGDEB@@CFABCJ@D@B@@@@@@AB@@@@@@BNCDABABCFCFGJEBEBICGCAAGEEEIACCIAK@KDGDKEGA@@I@GDIDADGH@@GFKFI@IFE@IBC@A@KDGFIBGBIBC@A@GECAGCIEGEACKIECEGEICICC@ACEECGCG@A@EACC@@CGCEAAAAAA@AGCECAAGECEA@C@E@CACA@C@A@EAAC@GAA@C@E@ABCBA@AHABA@ABABKD@@@B@B@J@BAFAD@BGHABA@ODCB@BA@@B@DBH@DADCBEBMBGDEBCBADAFAD@D@FBDHFDFBF@BALBD@BBHFFDHBF@D@BAF@BADGHAB@DADABAF@D@D@B@@FH@D@B@@@DAFAFABCBEBCBA@ABABAB@D@D@H@BAFCDCBGD@BA@CDEFCDIDCB@@CBABC@@BAD@FBDDDHBLFBB@D@B@BEFAFAF@D@DBB@BDH@D@D@@@@ADADCDCDA@@@AB@B@D@BBB@BBDDFDN@DDJFLBLBHAJ@FBNBFBDDJ@FBHCBABCLAH@FDFDHHLFH@HBB@BAJ@J@DA@AHELAFEDGH@@CBEBMDEDDHBHBDDFBHDLGHGFG@ABADBD@BBBFJDFDFJJJLDBDBD@JCF@DEBCBAF@HDBDDDB@BB@@@BBL@L@BEBA@@BABBB@BB@HDBBDDBBDJFBBBDDBBF@FADAD@D@BBDFBFBLBHBFDHFHFFJHHL@BB@DHBHCHCBCBEBMDEDCJAD@B@H@B@HCNCHBJBFBB@@DJBN@J@B@J@FFBFAFCHAFDHBBDBF@@BHBFDBBBF@BBD@B@BAD@B@D@DBBFBDBFBBFDDDDBJL@JBBLNPTTNFFFFFJ@HCJAFBFADABEB@BBBDFJHRNDF@P@DB@D@JALC@C@@RID@BAH@BABCBEBCFEFENKDCDADCRCNEDCHEDGDG@@ACCEGEAACGEGAAA@A@A@E@A@AAEKGIEEAC@ACM@GBCBADAB@DADEFEB@BADBFBBBDADCLEDAD@@@D@F@F@JCHCFCDAD@D@BA@C@AACCCCCEICGAK@MAE@CBCDADAD@DADEFAJCF@DAD@DA@ABADK@E@A@GACAEBCBC@@@ACKCK@C@GBAFCFE@A@CAG@E@EBCFEDAFAHEHG@@B@@@DABA@@B@B@B@BBDDDB@@B@B@@AB@FCBCB@DBDBPHH@FBD@DDFJDHDHHNBDDBFFDFLDNBHBFABA@C@ACG@A@CJEHERIHEDCBC@G@IAECEEECEEI@EBEBCFEBCBE@GAC@EAIBGDEFCFCJADCB@@C@@@C@CBCFG@C@C@C@EFKHG@@HCFAF@FBDFNJTNLHDBP@F@HAHAD@BBBBBBFBH@F@HAH@FDBDBLDDD@HBB@TALCB@@@@BB@@B@B@@B@@B@@BB@@@@B@@B@@@@B@BB@@@@@@@BB@@B@@@@@FFDHJFF@@DBJFD@FAAGGOAA@@AAAAACAE@AAA@CBAB@DAF@BCBABABCBCDC@@@AAAGCCCGECECCCACECA@EBEBADEFCBAFCFG@CIEGGCE@ACCAAAECG@G@@@GFIDCBCBA@@@@BAHIJIHCHAFABADCBM@IAAAGCG@ACGGIAEAACEAGAEAACE@GAG@G@IBG@CBEAGACCGAEGGCEACCG@@AGGOCGCK@AAE@EDIDEFAD@BAHBHAD@DABE@@AECIGACACAACACAEAA@@AA@@AAGCEAEAECAGBCBEAC@@EEGCAAECEAECCEACAGDI@AFCDCDCDC@C@IAEAGCGCICECEAAAAA@EEI@IFABEDEBEHC@CAABCAGCAECKAACC"],encodeOffsets:[[116756,32183]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
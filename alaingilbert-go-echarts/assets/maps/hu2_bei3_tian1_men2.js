@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("天门",{type:"FeatureCollection",features:[{type:"Feature",id:"429006",properties:{name:"天门市",cp:[113.166078,30.663337],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@F@HADA@CAC@A@@AAA@C@ABGB@@BF@F"],["@@A@@BB@B@AA"],["@@A@AB@B@BBD@@BD@B@B@B@@@@AB@@@@BB@@B@@BBBBBBD@@BDBBBBB@@@@ABA@A@CAC@AAAA@@AAE@AA@GM"],["@@AD@J@L@@@BD@@DAJDA@@R@J@B@B@JD@@N@@@DADADADADCDABA@@BCBCBC@@@C@GACACACCEAC@AACCAA@EA@@A@@@@@@@@@A@@A@@@@CA@CDAF@D@FBD@FAFABCBA@@@G@E@C@C@ECCMAA@@G@C@ABE@C@@@CBK@ABCXQFBDABE@C@AB@BEB@HADA@EAG@CAC@C@CBEAA@AGACAACAAA@A@C@A@C@I@E@IA@@O@KAA@C@KAIGMPIFCBEDODBD@BFJCBYBCCAIEAECEEACCCAACAAAAACAC@GJ@@BHBLBH@BBF@BBD@D@@BDBBDHBHBJ@JEDFDHBLBJ@F@BG@DLBBHDTDJ@@DA@@DEH@@DAFD@@BFBB@FB@B@BAFCH@FBFFBHB@D@BBBDBBBI@KAC@@X"],["@@BBLDBBTVNHDDBBLH@@BBB@FD@AB@BAB@@@BADA@@D@B@D@@@@A@@@C@A@A@ABA@A@@@A@AB@@@D@BA@@BABC@AB@B@@A@C@@A@AAB@BAB@@ABABA@CAAAC@@AA@A@E@A@A@@B@DABABABA@A@@@C@C@@FAB@B@BA@@@AAAACAC@@@A@@DEBCBA@AAEBA@C@@BCA@@@@AEB@@A@@AACAA@@@AB@@AB@@@@A@C@@BAB@@A@@A@C@@A@@@A@@BAD@B@BA@A@@AAC@@A@@@A@ABADCBABA@A@AA@E@A@AA@@@A@@@@BACC@@@@@@@B@@A@@@@@A@AA@@@AAA@@AA@@BAB@BB@@BCDDXL@@@ABA@A@@B@D@@@@AB@BABA@@@A@E@ABA@ABABAD@@@@@FA@@BA@@BA@@B@DAB@BADA@EBAB@B@@@B@@@BB@@B@B@BABC@CB@DADADAH@F@D@DBBB@D@FAD@F@DDDBBDD@BJAF@BA@@D@FALB@@LDDHBB@BBDBF@B@@BBBDDJLHFDFFHFB@NFNFDBLDBBJFDB@@FDHJFHDFDDFHBDDDJDLDHBHABADEBADAHILCJAL@LDFBD@NBJ@J@JDFFDLBJAL@BEPBLBJDFH@HAHADCDEFAHAB@DALAPALCLGDAFAHALAHBFDD@FDF@HFHDJBB@PBJDH@HF@BJHFL@@BDVZFJDD@BA@@BABBBFF@BB@D@DCB@BAFCDABB@B@@D@HJ@DA@IHA@@B@BDBD@DAHAD@BBB@DDBBDFBFDBJFBB@D@FBBHDHDDH@@BDB@FDNFFBHBB@D@BAB@@@@A@A@A@@B@B@@@DC@@BA@@AA@@@@BA@@B@B@BB@BBB@BB@B@BABAB@BAB@@@FCB@@AD@DAPEF@@@@BBBBDBDBCD@LE@AB@AC@AAEBIBCDADCBED@LAHBBBD@BC@@ACBA@AB@F@D@BA@@BA@C@IAA@ABA@CBAB@DAB@BADAFADABAB@DADAD@BCDAJIBABA@AFEDGBADEBCDCD@BADCDCDIBCDCBABABCBAJIDGDCFI@@BCBCAA@@@@A@A@@@@@@@@A@E@AAC@A@C@A@C@AAC@@@A@@BAB@B@@@BAB@@ECCCCCA@CACAGCAEAAAACBEM@@@ICA@A@I@Q@@@CBBI@CC@@@A@@A@@@A@A@Q@CB@B@@WD@LBJ@AACAAA@A@CGAEAAE@EDGBEG@@@IBACE@BE@C@A@ECF@D@F@@A@ACA@@C@@B@CICSAGKA@CAHE@I@KAGAECFC@IAIAGCGAAAC@@@CAC@AAE@AAGAKAG@@HID@DBBBBBDBBBDDBDFFFDFBBJDDZADAEI@AACPCFCDAJENOJHLBD@B@LBP@@@JBF@J@D@B@D@B@B@EE@C@CBADC@CEA@CBCCECIAAACAEAACCAACICIAI@@@E@@AA@M@CBA@A@OB@B@BADAFCB@@ADABCBA@AB@@A@ABEBEEMCIIMAAEMBCDEDC@O@I@CDEHEJCPEBAJA@@@ABE@G@EAI@E@A@@CM@E@GCEAE@CAE@E@C@@BCBEBC@C@G@C@CDEAA@MAGCAEAG@CBEBCB@@@@@BA@INCFCDIDOBEACACAODOLCBADABABGDC@G@IBE@MJ@@CB@@ABABEDABEBABA@A@CBA@MBA@GBOACAICA@A@CAAAQBEB@@CBA@GDIBA@ABA@KBEBM@A@K@E@G@A@U@CAA@G@E@C@I@IAO@KAMAOCA@IBGFAHAD@D@BADADADEFCBEDIBGBECAAEEAEAACE@@AAGGEABBBBDDFF@B@B@@C@@@ACACAAE@@@@A@@@A@A@AGBGLGJMFGDAHA@@BEJGHABAF@BADBHDHBB@FAFCDKDO@AAA@EAAAA@@@CACCECMGA@KCM@GBEFAF@B@B@@@JDHBBDJ@BDH@@BBBF@D@DABCDEBEAK@GAGBKDGFEBKJGFSJG@CBOB@@@@E@MAC@I@]GAAEA@@GAGAIAIDCDGJAFAB@DELCNEJEJKFGDEBCB@@ABGDCDEDIDG@OEACCCECGGGEAAAACECG@AAA@K@GBGFKDEBCDK@M@E@A@CCI@@IIGCECG@C@GDGBGBWFQDOJCDIHGPELGFEJAJAJEV@BERELABCHEDE@I@IEKCICEBGBGBCBIBIBGAGAMCKCGGGKEICCE@GBIJKNOPEJGN@DAD@F@PBH@@BLHPPLJDVFLDHFDDDDDLBDAFCFCDMFKDKAGAUGA@MCECGAUE]CI@E@GDeNCDA@EDCBABONGFAF@HBHHRBBBHHNDH@DTAD@FBD@B@DBHBNHRRPPFLFDB@@BHDHDTPJF@@JFFDVTHDBBB@JFJFDDRLPHDBBBB@B@FDB@PJPJJD"],["@@@AB@D@D@BBDBB@D@WKCCAD@@BB@BBBDD"]],encodeOffsets:[[[115310,31448]],[[115875,31161]],[[116173,31223]],[[116117,31453]],[[115470,31539]],[[115539,31466]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("武汉",{type:"FeatureCollection",features:[{type:"Feature",id:"420102",properties:{name:"江岸区",cp:[114.30911,30.600052],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@@ABABA@AB@BCBA@@@CB@@CBAB@@CB@@CBA@AB@B@BAD@@@@DBDBDB@@BBB@BB@@DDBB@@@@BBBBAB@@@BABA@@BABA@@@@BA@@B@@CD@BAB@@AB@B@@AB@BAD@BC@@D@@@BA@@@@@@@@@@B@@ABA@@@AB@@AA@@@@@@@@@@AB@@ABAD@@FDED@@BBAB@@A@A@@@ABA@@@A@A@@@@@@B@@@BA@@BB@BBB@HFBBFBB@BDDDBBHFFDBBDBFBBB@@FBAF@@BBA@ADJD@@BBB@BBBBBAABEFGJH@DEDE@AD@BABB@AB@@BB@JDDBB@DDBBDFBBBB@@DB@@D@@@DCB@BCDEDE@AB@@@@AB@BA@@B@B@B@B@DB@BBB@@BDBBBDDDLHFB@BB@@@B@DAB@@@@BBB@BB@@BB@B@BAB@@@@@B@DFDHB@@@@@B@NCJADADAFABADCBABC@@@@FIKEMEA@CCKI@@GGEECGAACKACCGAEEGKIMKEGEGU[EEACCEAAAAEEACGKAAACCCACGIGD@@@@@B@AABA@@@@@@@A@ABABABCB"],encodeOffsets:[[117035,31315]]}},{type:"Feature",id:"420103",properties:{name:"江汉区",cp:[114.270867,30.601475],childNum:1},geometry:{type:"Polygon",coordinates:["@@HC@AACEEACEGIFC@A@CACF@@A@@@@@@@@@@B@@@@@B@BA@@@@@@@@BA@@B@A@B@@@B@@AB@AAB@B@@AB@@AB@BADCAA@AAC@@@AAABB@@BAA@@@BADAB@BA@A@AB@@EFA@CBC@@@@@AA@@C@@A@@A@@@A@@CA@@B@@@@A@@BB@ABE@@@@@@A@@@@A@@AA@A@@@@@@AA@E@CBB@EDB@A@A@BD@B@@BBABB@B@@@ABDBABA@A@AA@@@@ABA@@@ABA@@@@@AB@@@B@@B@BBAB@@@@B@@BB@@BA@@B@@@@BB@B@@B@D@@@DAB@DBB@AF@@@@ADAHECA@GECAA@@A@@AC@A@@AA@C@@@D@@@@@@@BBFB@@@@BBD@@BB@@@BAB@@@@@@CF@B@B@@AJ@@@F@BJ@@@BBDBFDDBDBBBBBHBFDB@BBBBB@BBBBB@@BDB@AB@@A@@@A@@@@B@B@@@B@BA@@B@B@@@BAAA@@FCEC@@BCBA@@BA@@@@@@@@@@BB@@BA@@B@BA@@@A@@@@@@@@B@@A@@@CD@@ABC@ABA@@@ABA@@BA@ADC@@@AB@@A@@B@BA@AB@BA@A@@BAAAAA@@@@AACC@@AAA@AA@@CACACA@@@@BC@A@ABAB@DA@@DA@@BADA@@DA@@B@DA@ABAB@BABA@@BADABABABAB@@@@@@@B@BA@B@A@@@@"],encodeOffsets:[[117043,31310]]}},{type:"Feature",id:"420104",properties:{name:"硚口区",cp:[114.21492,30.582202],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@E@ABA@C@@@EBE@GB@@E@@@GDA@CB@BCBCBABA@GB@@A@IA@@@@C@CB@@A@CBC@CBCD@@IFEHA@ABABA@AAEACAIAEAC@CBCBGFABA@ABE@@@A@@FABBH@@BD@F@DABABCBEAA@AD@@A@ABAB@BADAB@@@BCDADCBAB@@A@@@ABA@@B@DC@@B@@@DB@@@@@B@@B@B@@BB@B@B@@B@BBB@@HF@ACB@@@B@@A@@B@@@@AF@@A@@DAD@@AD@AH@BD@B@@@BAHAJAD@BAAA@A@A@@B@B@@@BAB@@@F@R@LBT@N@@A@E@@BI@@@A@ADE@@@@@@BA@A@@AA@@AC@A@@A@AE@A@@@@@@@C@@@DBB@@@BBD@@@BB@DBHFB@FDBGBC@@@@BEA@CAA@CB@@C@A@@@@AAA@@@@@AB@@AA@@AA@@@@@BAAAA@@@@A@@BA@@@@B@BA@@B@BA@@@@BBB@B@BACABA@@A@A@BAAA@@@AACB@B@A@FCA@DAF@B@@B@@@@B@B@@BB@@@@@@B@@@@F@BAA@@AB@@@@@@AB@@DB@@@B@@@@BD@@@BB@@@@D@DAB@FE@@BAB@B@@ABABC@A@@BB@AA@BABB@@D@BBB@DBBC@ABA@@BA@@@ABA@BBA@@@A@@@A@B@AB@@A@@@@@@B@@A@A@@@@@A@@@@@@@@B@@@DE@@AA@@A@AAAA@@E@@@A@A@A@@@"],encodeOffsets:[[117019,31295]]}},{type:"Feature",id:"420105",properties:{name:"汉阳区",cp:[114.21861,30.553983],childNum:1},geometry:{type:"Polygon",coordinates:["@@AACECC@@@@@@@@@A@@@B@@EE@A@@@A@BAA@@AAAA@@@@@AAAAA@BAA@@@A@@@@A@CC@@AA@@AACA@AA@AAA@@@AA@@A@@@@@@@AA@@B@@@@@A@@@B@A@A@AABD@@HD@@B@BBBBBBBBDBHHBBBB@@@@BBBBBBBDFJB@DDB@AB@@@@CBA@@@AAADEDEFGIECA@MJEDCFMNCDGFGD@BA@@BABB@H@BBCBBDBADA@@BADDDABBB@BDB@B@BHCDHJBBA@@HE@BBC@@@@@A@@D@BC@B@CBA@B@AA@AB@@@A@A@A@@B@BA@@@@@@B@@A@@@ADB@@BCA@B@@@@B@@@A@C@A@@@@B@DABABA@A@@@A@A@@AAB@AA@@@@@@@A@ABGB@@A@AEB@B@@AAAB@@@@@B@@@@@@@AA@@@@@AA@BA@A@@@A@@C@A@@AA@GC@@A@@B@@A@CA@@@AA@@B@@A@@@A@AB@AA@AA@@AACAAA@AA@A@@@BA@AA@@@@@@BC@@@@A@@@@E@C@A@@@AD@B@BAB@@@@A@@@A@@@AD@B@@@BB@@B@@@@@@BB@A@@BA@@@B@@BB@B@BBBA@@B@@B@@B@A@@B@B@@@@BB@@@@@BB@A@@BB@@@@BBA@A@A@@B@@@D@B@@A@@D@BA@@B@@BB@@AB@@BB@B@@@@A@@B@BAB@@@@B@@B@@BB@B@@A@@@@@@@@@A@@B@@@@DD@@@@@B@@@@B@B@D@@@B@@@BD@@@B@B@@@B@B@@@@@B@B@B@@B@@@AD@B@@@@@A@@C@@@@AE@@AA@@@@@AB@@@@@BAB@@@@@@@@A@@A@@A@@@@B@@A@A@@@ABD@@@@B@@A@A@@B@@A@@@@B@@A@AA@@@B@HA@A@@BAA@DA@AD@@BB@@B@@@@D@BB@@FHAFEHGFCBB@@BBBB@FBDBB@@DBD@HBFBFDHFJFFBB@FBDABABA@C@EAC@@AGBA@EB@@@F@BAB@BAHEDADAD@FBJBDBFBBBB@BABAB@FGJE@@DCDAD@DAB@@@DAD@@@@@JBB@@@HAB@BADADA@ADAB@HC@@F@@@HAF@FA@@D@B@BAF@D@@@B@B@B@@@F@@@BBBBB@@@BB@@DBB@D@JEACAAEKEEAGAC@AA@AG@AAG@CCIEKIMCCGGAAKIEEOIKMACCEEGAEAAOH"],encodeOffsets:[[116970,31212]]}},{type:"Feature",id:"420106",properties:{name:"武昌区",cp:[114.31665,30.554408],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@@@C@@@@@A@@BB@@@@@@BFA@BA@@@BBA@ABB@@@@@@@AB@@@@AA@@CDAA@ABAC@@@CC@A@@@@@@@A@@B@@@@AB@ABB@B@B@@A@@AA@@@@@@@C@BB@@D@@B@@A@AFDBB@@BADE@@F@DAB@@@BEA@@@@@C@E@@E@@D@B@@C@A@@@C@@@AI@A@KBG@@BB@@B@@@BC@@HBHAD@@@@BD@B@BAAAA@@ACAACI@@AE@AC@ABA@ABA@A@@@CB@@A@AB@@EGCCA@CC@@II@@KH@@MICCAACGCCKIAA@C@A@ACACEEE@AIKCIGG@ACEAAAAG@AAA@E@AAA@CAC@GACA@A@@@A@@@A@AA@@@CC@AA@@@AA@CA@@ACBE@@@@@@B[LFHDDDDBBFFBB@@BBBBB@B@A@@@B@@@@@A@@@BB@@@@@@B@@@BB@@B@BBB@@BDBBB@@BB@@DDB@@@@@@B@@BB@ABBBB@B@@@@BBBB@@BB@A@B@@@BFF@@@A@@@B@@@@@@@@DDDFBBPGBBBFFHDFBDLNPJFFLJBBHHDDJNFLDJ@DBH@BBHB@@BBDBHFFFLBBBDFHBDFFBD@BHJBDDDBDBBHLBDFFBBBBDFBDFFV\\FHFHRKBAFCBCDABABGDEAAIEBA@@@A@@CAAB@@CA@@AAAAAAAAAAAACCAACCDA@ADADCDABABABAB@@@DC@@@@DABADAB@BAB@B@FAD@@@BF@@A@@BA@@BA@@B@@@@@@@B@@B@@A@@@@BA@@BBB@@ABBBB@A@ABAAADABD@@@@CB@@@@DF@BDBBCD@@@@@AA@A@A@@@@@DB@@B@@B@B@@A@ANB@B@@@@BD@BBB@BBBHFB@B@@@F@D@B@BBDCFCBABAB@@A@AB@@@B@@@@A@A@@BAA@@A@@@A@@@@@@@A@ABCA@@BA@A@@@A@AB@@@BA@@A@@@A@@@@@@@@@@B@@@@A@@A@@@AA@@@A@@AA@@@@@A@BB@@@@@@A@@BA@@@A@@@@A@@A@@B@@@@@@AB@@@A@@@@@@@@A@@@AB@@@@@AA@@@A@@@@@@AB@@@AA@@@A@AA@@B@BB@@@A@@@@@@@A@@CC@@@@@@@@@BAA@@@@@A@@@K@@@@AAA@@AAA@A@E@A@@@ACAA@A@@AA@A@A@CA@A@A@A@CA@CA@@A@@@ABA@A@C@A@CA@AAAIB@CAEAAC@@@A@A@C@CBA@A@A@ABGBCBCBED@AA@@@BA@AAE@@AAA@@@@@A@@@@@@@@@@@A@A@@B@@@AAB@B@@@@@@@@A@@A@@@AABAC@AAAAC@@@@@C@@@C@@A@@@@@A@@D@@@@ACC@@A@@A@A@B@A@@A@A@@A@A@@BA@B@A@@D@B@@AB@@A@@AAA@AAAB@D@@A@@@@@@@@@@@@@@B@DCD@AAC@GBAB@@A@@@@@@@A@@@@@@@@@A@@@A@@A@AA@@B@B@@@BA@CB"],encodeOffsets:[[117064,31251]]}},{type:"Feature",id:"420107",properties:{name:"青山区",cp:[114.384968,30.640191],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CCA@CBCBCBC@BA@@BCBA@@@A@@@A@@AC@AAA@A@AACBE@@@CE@A@@@@DA@AB@AAACBB@ABAB@@A@@AAAEACAEBBBE@@@@AACA@I@AA@AAAA@@CFCAABABA@A@ABE@C@ADA@@DA@CB@AA@E@AB@BGCAC@CBA@@BCBBF@B@BB@ABA@@@C@@@@@A@A@@@@A@A@A@@AGB@A@@AA@@BC@@AA@AF@B@BA@AB@BA@AB@@A@AB@@@B@BBAB@BABABBBC@@B@@B@B@BB@@DADBB@BB@BB@BB@AFADCBC@BBCB@BB@D@BB@BAB@BABAAABADA@@@A@@CA@@A@@@A@AA@@@@CA@C@A@A@A@@@BBBBB@@D@@@@A@@AC@A@@@A@@AAB@@AA@@@A@@AAA@@B@D@B@@A@@@ABAAA@A@E@AB@@@@A@@B@@@F@B@@ABDB@B@B@BBDABBBA@@@ABBBB@@@@@B@@FBD@B@F@B@B@BABA@ABA@A@A@ID@@D@AB@@@@AB@@@@A@@B@B@BCHB@@BB@@BABA@AA@B@DBF@D@@@@A@@@@@@@A@AB@@@@A@@@@@@B@@AAAB@@A@@BAA@@@@@@AA@@A@@BA@A@@BA@@@A@@@@B@@@@@BCB@@A@C@A@@@@AAA@@@AABAAA@@DA@BAC@@A@CA@@B@B@BA@@@A@@@@@@@@BA@ACD@@@@AAA@AC@@@D@@@@E@@AA@B@@A@@B@AAB@@@@@B@DA@@A@AA@@@@@A@@DI@@A@AA@@DC@@AA@@AB@@@A@@AB@@@A@@@@@AA@@@@A@@@A@@B@@E@@FA@CABAAAABA@@@A@AACAAABAA@@AGA@@AA@A@@B@BEB@DBBAB@@AA@@@@AC@@@@@@@A@ABGCAB@@@BABAB@@ADAACACFAHABCBADEDABQLNLLJFHBFDHRF\\D^DZF@@NDLDFBHBJDFBTFTHPF@@BIBA@@@ABE@@CA@C@@A@A@@@A@@@A@IAA@@@ABA@@A@@@@@@AA@@BA@@@@@@@A@@B@@@BE@@@E@E@C@CA@@@@@@ID@@AC@@CA@A@BC@@DE@@BA@A@@B@@@@@B@@ABA@@@@@@@@@@B@@A@@BA@@B@@BA@@BBB@B@@@B@@@BBBABGAA@@H@@HBF@L@@@B@B@@@@ABABCBA@@B@FCBCDADAPIVQ@@HHNKJHBBHDHIHIA@BABADEAAAAA@AB@DABEENIDCBCFKB@@C@@BCBBDBDBB@@@BBBBBABC@@DBB@B@F@@@BK@ABCBAGC@@ABAACDA@ABAA@@@BCB@B@A@@AABA@@A@@@@BA@A@A@AD@@@BA@@@AB@AA@A@A@@@@AB@AA@A@BABABIACBC@A@A@BBA@@@A@@BA@A@@A@@AB"],encodeOffsets:[[117273,31321]]}},{type:"Feature",id:"420113",properties:{name:"汉南区",cp:[114.084597,30.308829],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@B@@A@BA@"],["@@BABA@@BA@A@AB@@ADA@@@A@A@@CA@@@A@@@AB@BADAB@B@B@BABAB@D@D@BA@AM@I@C@OFA@ABBB@@BBBBBBB@@B@@DFADABAB@BBB"],["@@A@@@A@@@A@@@A@@@@@@@A@A@A@@@@@@@@DB@H@D@B@LCDAB@LCB@DABADAB@BABA@@@@AC@A@@@A@A@@@@@@@A@@@A@@@AB@@@BCBEB@@AA@AC@A@@A@@@A@@@A@@B@A@BA@@@@@A@@ACBA@@CCB@@A@A@G@@@@AA@@A@@@@@E@AC@EA@A@@@@@@C@E@A@A@AA@@ABA@@BABA@@@ABA@@@A@CBA@A@@BH@@B@B@@@@@@@BA@@@B@@BA@@@@A@BA@@B@@@@B@@@@@@B@@B@@DA@@B@@@B@@B@@@@B@@@@@@B@@BF@@B@D@@@F@@@B@@D@@@@B@@B@@@@@@@@@BB@@B@BB@@@@@A@@@@@@B@@@B@@BAB@@@@@B@@BA@@@BBB@@@@@A@@@AA@@@@AB@@AB@@@@A@A@@@@@@B@@@@@@AB@@@BB@@AB@@@BDH@B@@A@@@@@A@@@ABE@@@@@B@@@@@A@AB@@"],["@@@Q@A@@@E@@@C@AE@@AA@@@@@@@@A@@A@@@@A@@@AB@@CA@@@@A@@@@A@@@@@@AB@@A@B@@B@@AA@@@B@@A@@@@@@@A@AG@@AB@B@DAB@@@B@BA@@B@BA@AB@BA@@BBB@B@F@D@@@@@@@@BFBD@@B@F@@@@@BB@@B@@H@B@B@@@DA@DB@DA@BB@@@@@B@@A@B@AB@@@B@@@B@@@@BBDB@@BA@AFB@B@BBBB@B@@@B@@A@@BA@CBAB@@ABBDBADCDAB@DAB@B@BBB@BBBD@BBD@F@D@@BDBBBBB@D@HCDAHALAD@DAFABAD@@@B@B@DBBBB@BBB@@@D@D@BAFCB@D@BAB@D@BBDBB@BDFD@BBBBBBB@B@@@@@@@B@BBD@B@BDHBAB@BA@A@@BABADABCBADC@@@AB@@@BA@B@@BABB@@@@@@@@AA@AA@A@A@A@CB@AA@@@@@A@@@@A@@@@@@@ABA@@@AB@@@DABABAA@AAAAA@A@FABAACEBACACDAB@BAH@FAB@@@DAEGCGACEIACCE@CCKAE@A@AAA@EAE@GAOAQCUAKKWQ]EIEKWOGGA@@DGBKHEBAF@@EL@@AD@BABCLADKVMVIJEDKJABA@WRSLABE@IBQBKAqMKIGEKKCAIOGIGMCEOYAAAC@@CC@AGKGIGIKQGS@C@A@EFGKC@AA@ICGCC@C@CFABA@EACACAKCI@CAGC@@ADBF@JALCFCHADC@ABEAEAA@G@CBEDOFA@ABEBCBEBCBGBCBGD@B@@AD@BADADABABCBCBAD@B@B@BBDBDBBBBDBBDBB@@@@BDNDDBD@DBD@BBBBDAFAD@B@BBB@@BBBBJB@@@B@B@@@B@@@@B@@DDBBBBBBB@D@D@BA@@BA@@@C@A@@BADBD@@BDFB@BBB@@@BBFB@BBB@B@B@BB@BD@@@D@@@@@BD@@@B@@@B@@@DDD@@BD@DBB@DB@@B@B@D@AA@AQKJK@@BAB@PED@J@N@@BABB@B@DBBB@BBD@BBB@B@BBBB@FBDD@@@B@@@FAB@@ADA@ADAB@@CFB@AB@BBB@@B@BBD@DBD@@BDB@BB@@@@@B@B@@@@A@ABC@A@@@ABA@@B@BBB@@B@F@B@BAB@BA@@BAB@B@@@B@@@BB@@@B@B@B@@AB@BC@ABAB@B@@@DF@BBD@D@BBB@@BBB@BBBB@BB@@D@B@@DD@B@B@D@B@BB@@BB@DB@@DB@BBB@@BBAB@BEH@BCF@DABABAB@BB@@@BB@@BBP@@BBDBBBBDDBBB@B@B@@@B@BA@@B@BBDBDBB@D@@@BBBB@@ABADAB@@@BBB@@B@B@B@BAB@C@A@A@@@AA@@@ABA@@BA@@B@B@DB@@@B@BA@@BBABABADAB@@@@AD@B@@A@BB@BDBB@@B@BBD@B@N@D@D@D@B@H@F@@C"]],encodeOffsets:[[[116672,31093]],[[116577,31007]],[[116718,31090]],[[116711,31090]]]}},{type:"Feature",id:"420112",properties:{name:"东西湖区",cp:[114.137116,30.619917],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABC@C@A@EAC@CA@@GGEEAA@@AAEA@@A@EAGFEDCDCB@@ABA@AAABA@C@EBABCB@BA@@@ADA@@B@BB@D@D@HBB@H@B@D@@@HDBB@@BB@@@BADADCDCDABA@EBE@GAC@GC@AA@@@CCCAAAC@GBOHCDA@ABGFEHABADCDADABAF@B@BAD@BADAFCHABABEFABAB@BA@ANBF@B@B@BEJEHEF@BADA@ABA@CDEHABCBC@EB@A@A@@A@AA@@@@C@@@ABADEC@BEJA@@@EAC@AAA@AAAAAA@@A@A@A@AB@B@@A@@BABED@@@@AB@B@D@@ADAB@B@B@@BB@BBBBB@B@B@@@@AB@@ABA@AAA@@AAAAA@AAAC@EAC@@@A@E@E@A@AB@B@B@B@@BD@DAB@B@BB@DBBB@BABA@CBAD@B@BB@DBD@DAD@BA@CBA@A@ADAD@D@FBBBBB@B@@@BCBABADADADBB@DBD@DBDBD@BDD@DBBBB@B@@@BAD@BC@G@G@AAAD@B@D@DBDDBDBD@@BDBDDDCBA@@@ABEDAB@DABBBBBD@BBDB@BBHBBBB@BD@@@BBBBBBB@@D@H@BBDDB@H@F@LCDABBDB@BDAB@B@@BBB@@@@HE@@B@BBBBFBB@BBB@DBDB@@DBDBDBF@D@D@DAF@B@DAFCHC@@D@DAD@@BB@@B@BBBHBFBNAL@PAR@D@B@`ARA@@B@BAB@@@B@D@PCB@HCHC@A@A@A@@BAFEDAB@@@BBB@BD@BBBB@B@B@D@JCDA@@D@B@L@H@D@D@B@FGFA@@@AFELGBA@@D@@@B@@FBB@B@@B@@@B@B@B@FCHCDABABABA@ABE@A@A@A@K@ABABCBABADE@CBC@C@AAAAEACEICE@A@@AC@A@@@CBABCBCBADA@@DCB@DEBCB@BAB@BAD@LARAF@@@FABADA@AB@BEBCBEB@@@BA@@B@J@FBD@B@@@BA@@@A@@@ACA@@@A@A@@@ABA@A@@BA@@B@@@BA@@DBH@HBBB@BB@@B@BBB@B@B@DBDB@BBBBFD@BD@DDDBFBBBD@F@HBBAD@BAB@BABADCBAG@HIFEBAABAAAAA@AA@@ICBCB@AA@@BEEA@@AAEACAAAECGEAACCACA@EAAAGEA@AAA@CA@AA@AAAAA@AAAAA@ECGAAAAACACAECCAAA@@I@M@S@KAQ@E@@@A@AB@@A@A@@@@B@BBBABC@IBGBAB@@A@C@@ABGC@@BC@CB@@@BE@@B@@A@@@@BA@@@A@BDE@@GA@AAA@@@@A@AAA@@@A@AA@@@@@A@@C@@@AD@@C@AB@BA@@B@@@BADABCDC@A@@BABC@ABAEA@ACACCAAA@AAAAA@@C@@AACAA@A@A@C@CAABA@@AA@CACAA@I@A@@BABA@@@A@E@EDA@C@@@E@A@MA@@@@@@EBA@C@CBE@GAQ@A@CB@@@@CBA@"],encodeOffsets:[[116819,31332]]}},{type:"Feature",id:"420111",properties:{name:"洪山区",cp:[114.343796,30.500247],childNum:1},geometry:{type:"Polygon",coordinates:["@@CD@@A@ABABABCBCDCB@BCBDDBBDDBBBBBBBBBBBB@@DB@@BADB@@@B@@ABJFBBDBBBBC@@BABA@A@@BAHDBAB@@@@@@@D@@B@@B@@BA@ABCAA@AFA@@@@BBB@@HB@BB@ABBBDBBBB@@@B@BABBABDBB@@EF@@@@AB@@@B@@@@@BB@@@@B@@@A@@BB@@@A@@BB@@BD@@CB@@B@BJ@@CB@@@@@B@@B@BB@@C@A@@@@BA@B@AB@@@@ABB@@@F@@C@@@D@@BBB@B@@C@BDB@@A@@@@@@B@@@B@@A@A@AB@@D@BD@ABB@@CB@BBBA@B@@BB@B@@B@D@B@@@DA@A@@@@@A@@B@@@B@@AB@B@@AB@@@BB@@@@@@BB@AB@@@BABB@@@A@@@@B@@@@@BAB@@@@@@@B@@@@@@CAE@C@ABBB@BA@AA@@AA@DG@A@A@AB@@@@@BA@@@@BAC@@@JCB@B@B@BAB@BA@A@A@A@E@AAC@EA@@@@@A@AABA@@B@AABAAC@A@A@ACABA@@@A@E@@@AB@@@@@BAF@B@B@BBBA@@B@@@@A@C@AB@BB@@@B@@BB@@BA@BB@@@B@D@@BB@@@@@@CA@AAAA@@B@B@B@D@B@@D@@B@@B@B@@@BB@@DB@@@B@BCBABBBA@ABA@AAAC@A@@ADAAAD@DABCBEA@@AAAA@@AAABC@CA@@A@A@AA@@@ADAAABABA@AB@A@A@@BAB@@@BAB@@ABAB@@A@ABEB@@BD@@AB@@BB@A@BH@@@B@B@B@@B@B@@@@@D@@@B@BAA@@A@AAEDA@AB@DAD@DBAHA@@B@FBBA@@DCB@@CB@B@DAF@B@BABABBBED@DB@BB@BBBJ@B@BD@B@@F@AAFADBFBBB@BB@@@BABAA@DABB@BBAB@@C@@B@F@@D@@AFBD@B@BBB@BBD@@@B@@@B@@ABAD@@ABD@DADADAB@DD@@BA@@@BB@B@@AB@@@B@AAB@B@D@DAJBBABA@A@BBBA@@B@@B@B@B@@BBA@@B@@A@@BCB@B@B@@A@@B@@@ABBB@@@B@ADA@A@@BBBAB@DCBBBA@@HDABAD@BAL@@E@A@A@CA@@ADABAAAA@@A@CACAAAAD@@@DA@ELADCDMJFFBA@CBAB@BBBBCFABABB@GJGJGCAAIGMLGG@@UROJCBCBADEDA@@@ABADAB@B@@A@A@@@K@E@GA@@@GB@HBBAAA@A@@@A@@@AAA@AB@@AA@@@AB@@@BA@@@@@@@@@@@AB@BA@@@@@A@@@@BAB@@CF@@ADB@B@@DD@@BC@@J@@@@B@@D@D@F@F@@AF@@A@@@@B@@@@@@AB@@BB@@@@@@@BB@BA@@B@JBB@@@B@@@B@B@@@@DDB@@AF@B@@ABAJ@@OESGSEEAICGAEAKCMC@@YE]C[CQEBDDLBBDHFFHH@@LJDDB@NFLFEJ@@@@ADHDHDBAD@HBJBFBHDJBBBB@B@@@HDL@NBD@@@D@B@F@P@F@NAJAH@NALAJAH@JAFAPCTGPCB@DA@BDD@@@@A@@@@@@@BBDDBB@B@@@@@@@BFAACBAB@@EAK@E@ADC@@CA@@DA@@BA@AB@BA@AB@@A@ADE@@@A@ABA@A@@@A@@@@@@B@@CBA@@BA@A@@BA@@@@@A@@BA@@@@@@@AB@@A@@BA@@BC@@@@@AB@@A@@B@@A@@@@@AB@@ABCBA@@@A@A@@@AB@@ABA@ABA@A@@B@BEDE@ABC@@@A@@@@HFBABACC@@@@@A@ABADEBABCBABCBADEB@DCFEBABAFABABABADAD@FADA@@DAB@BAH@@@BAB@HAF@HA@@BAF@DAD@B@BAD@F@DAF@BAFAB@BANCD@BB@@B@B@@@FBBMGAMCOEOGIEQGQBDIBG@@@GBC@AFCFA@A@AAAAAE@A@AE@A@ABA@@B@BBB@@BD@@AB@BCBA@C@CBE@ABIBEBG@AACA@CC@E@@BCDCBE@C@A@A@CA@@CBA@@DCBC@ABA@AACAAAA@@AA@@AAAAC@E@E@A@@AA@ACAAAG@A@CBGBCBA@@AAC@E@CB@@EB@@A@@B@B@@@@A@@@A@@@AB@@A@@@A@@@A@ABA@A@A@@@EAA@A@@@A@C@@@@B@@A@A@ABCA@@A@@@@B@@A@A@@@BB@@A@A@@BA@@@@@@B@@@@@@@BC@A@@@@BAD@B@@@@@@@BB@@@BBBB@@BB@@BB@@@@@B@@@@@BA@A@@@B@B@BBB@@@@BB@@B@@@BB@BB@B@@@D@BB@@@@B@B@@A@@@B@@BA@@@@B@@@BA@A@AB@@A@@@AB@@@@@B@@@@A@@@A@@@BAAA@B@@@@A@A@CBCA@@A@GBCB@@@@AB@BB@@BBB@B@@BB@@@@@@@B@@@@@@B@@@A@@BB@@@@@@B@@@@@B@@BB@@@@@@@B@@B@@@@@@@B@@@@@A@@@@BB@@B@@B@B@@BB@BB@@@@BB@@@@@B@B@BB@@@@@@@@@B@@@@B@@BB@@@@B@@@@@@@@@@@@B@@@@@@@@@BB@@@@B@@@@FFA@@@@@@BB@@B@@@B@@@@@B@@@B@B@@@@BBAB@@@B@@@@@B@@A@A@@B@@A@A@A@CBCB@@ABA@A@CAA@A@@@A@C@@@C@CBA@@@A@@@@@A@A@@@CBABA@GDC@A@E@A@C@AACA@A@@AEAA@@AAAAA@A@A@@A@ACA@@@@B@@A@@A@@AA@@@@@@A@A@@B@@A@A@@@@@A@@@AA@A@@@@AA@CAA@@@@A@@@@@@@A@@AAC@@@AAE@C@C@E@@A@@A@@@@AA@A@A@AA@@@@@A@@A@@@A@AA@@@@AAA@@@@@@AB@B@@@B@@@@CB@H@B@J@@BB@DBB@@@B@@@@A@@@A@@@@@@@@@@@A@@B@@A@@@@@A@A@@@@@A@@@@B@@@@@@@@A@@@@@@@@B@@@@@@A@@A@@@A@@@@@@AB@@@@@@A@@@@@@A@@@@C@A@@@AA@@@@@@@AA@@@B@@AA@@@@A@@@A@@A@@@A@@@AB@@@@@@@@@@AAAB@@A@@@@A@@@@A@@@@@A@@@@@@A@AA@@A@@@B@@@A@@A@@A@@@A@A@@@A@@@AA@@@A@@@@A@B@@@B@@A@@B@@@@@@@@A@@B@@@BC@@@@@@@@@@A@@@@@BA@@@BB@BB@AA@@@AAB@@@@@@A@@@@@@@@B@AAB@A@@ABA@@@@A@@@A@C@@@AB@@@@A@@@CB@BA@@@@@A@@@A@B@@A@@A@@A@@AABA@A@A@@@B@@A@@@BA@@A@@@@@@@@B@@@@A@@@@ABA@@@@@@@@A@@@A@@B@@@@@@@BA@@@AA@A@@A@AA@@@@B@@A@@@@@@@A@@@A@@AABA@@A@A@@@@A@@@@@@@AA@@@@@@A@@A@@@@@A@@@@@@@@B@@A@@@@AA@@@@AB@A@ADB@@BA@@BA@@B@BA@@@@@A@@@@@@@@@@AA@@@A@@@@BB@@@AB@@@A@@AA@B@@AAC@C@A@A@C@@@A@ABABAB@@@@@B@@@@ABA@@@A@@B@@BB@@@B@BA@@B@BB@@@@D@@@@BB@@@D@FABABEBEBGDABA@@AAF@BADA@@@EFGD@@A@E@@@@AA@@@@AA@@CAA@@C@GBACAC@CAI@E@@@@@GKC@A@GA@SA@U@IBOI@@A@@@A@@A@A@@AAAA@A@A@A@@A@@AA@AA@@@A@A@AB@@@A@@@@@A@A@AAG@@AAAAAAAAA@A@@AA@@A@@@@@B@@B@@@B@@@@@@@@@DB@@@ADG@@A@@@@@@A@C@C@@@ABAB@@@@A@C@A@CBA@@@ABE@A@A@A@AB@@@BACAEA@B@@ABA@@@@@@B@BAB@@@B@B@@AB@@@B@B@B@@CB@B@@@@AB@@@@AB@@@BA@@@@@@A@@AC@A@@@C@@@@@AA@@A@@@A@A@@@AB@@A@@BC@@@@@ABA@A@@A@@A@A@@@C@CAC@@@@B@@E@AE@@I@@A@A@A@@B@@A@@@@D@B@@AD@@A@@BB@A@@D@B@@AB@@AB@@A@BB@B@B@@@BB@@@@@BB@@@B@B@@AB@@@@@@A@A@@BAB@@@DBB@B@@@B@BAB@B@BB@@B@@@BBB@B@B@BAB@@A@AB@@CF@BA@@@@B@@@BA@@B@B@@BB@BBBB@@BB@@@@B@@@@@BA@BFA@ABADABC@C@GAE@ABAJ@DFBF@@FAD@D@D@@ABAA@CA@@ACACCCACAIGAAAAAAA@AAA@A@AA@@CEEEAA@AA@EBC@C@A@C@C@C@@@A@ABABCB@@AB@@GDCD@B@@@@ABAB@@@@@@B@@DA@@@A@@@@BA@@B@@@@A@EB@@@@A@ADA@A@AB@@GBCBC@C@ECCACEAACKAA@AAC@CAAAECCEGAA@A@AA@@@A@A@@AA@@A@@AA@@@A@AC@A@A@@AA@A@@BA@A@@BABA@@B@AA@@BB@A@@@@B@@A@@B@@@@CB@@AB@@ADABABAB@@CB@@A@C@A@A@A@AAACAA@AAA@C@C@C@CBI@E@A@EACBC@A@E@@AA@AA@@@@AA@@@@@A@A@@@@@@A@@@@A@@@@@@@@A@A@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@AA@AA@@@@@@@@A@@@@B@@CA@@@@AB@@CBA@@@ABABA@ADELAD@DA@@@ABCB@B@@@@@B@B@@A@@@AD@@AA@B@@CBA@@BAAAA@@@@@A@@AA@@@AAE@AB@B@BC@@@AA@@BCCB@AA@@BA@@AA@@@A@@CB@@@@ABACA@@@MH@BCB@@CB@@@@FHBDBDFLDF@B@FBF@D@@BB@@BBB@BBB@@@BD@B@B@BA@AB@B@@BD@@@@F@DA@BB@@DBB@@B@@BDD@@B@@B@B@@@B@@@BDBHBD@DBB@BBF@B@BBH@BBBBDF@BHHDJJL@BFFDFDB@B@B@DBBLJDDDHBBDDNJ@@LG@@JJ@@DDB@DDFH@@BAB@@@DA@@B@B@BAB@BAD@@BBF@@DJBBBD@@BBBB@A@AAC@@@@BCAG@GD@@A@@@AA@@AH@LAB@J@@B@@@D@@@B@DA@C@@@@FF@D@@@@@B@AF@@A@CBE@@@CFAB@@AAEC@B@BA@@@@CA@@A@D@@@@@@BB@@@BA@A@A@BAA@@B@@A@@@@B@@@@@@@BDD@@D@AB@BBBDC@@BB@@@@BA@@@@@@A@BAB@AA@@B@@AEB@A@@@@A@@AB@@@@@D@@@D@DAB@@A@@@A@AB@@B@BB@@@B@@@@@@@@@B@@@@@@@B@@@BAHAD@BBC@CDA@@@@@@@@@@@@@@@@BC@A@BB@BBB@BB@@@BA@@@A@CB@A@B@@AB@B@@@@B@BB@A@B@B@@@@BD@BD@@@@@CB@@@@@B@@@@D@@@D@@@@BDBB@BBDBA@B@@@BB@@@@@@@@@@ABA@B@@@AB@B@@@@@@@@@@@B@@@@@B@BB@@BF@BAB@@B@@BFCDADAHABAB@B@B@DAD@B@B@@@D@BBBF@DJABB@BDBB@D@B@B@BA@@B@@@DBB@@D@B@B@BDBB@B@B@@BB@B@DB@B@@@B@F@BBB@BB@BB@@@@@L@@@B@@@@BB@A@@@@@@@@DD@@@B@@@@@@@B@@AAA@@@BBB@@@B@@B@@BA@@@@@@@B@@BB@@@@A@@B@@@B@@@@@@B@@@A@@B@@@@A@@@@BB@@@@@@B@@AB@@@B@@@@A@@A@B@@@@BB@@@B@@BB@@B@@@@B@@A@@@@@@@@@@@@B@@@BB@@A@@BAB@@@B@B@@AB@AD@B@B@@@@@@@B@@@BB@AB@@@B@B@@A@@@A@@B@BA@ABABEDCDAAA@C@E@@@A@A@GEAA@AAA@AAC@@@@@AMA@B@BA@A@@@@AA@@C@@@@@B@BBB@@@@C@ADCA@ACE@@@@DA@@@@ACCBBBAB@B@BAAAA@BA@AA@@AB@@@@@BA@@@@A@@@@@@@AB@@AB@@AB@@@AE@@C@EBA@A@ABA@CBABCB@@@@"],encodeOffsets:[[117102,31314]]}},{type:"Feature",id:"420114",properties:{name:"蔡甸区",cp:[114.087285,30.536454],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@@A@@@A@@A@@@@B@B@@@B@@B@@@@@@A@@@@"],["@@B@@A@A@@CAA@A@@@AB@@AB@B@@BB@@B@B@D@@A"],["@@CBC@CACAC@AAC@CAC@CBG@AB@@@@A@ADCBAFAD@B@D@D@@@D@B@@BBB@@B@@@B@@ADABADAF@B@B@@@@AD@D@DB@BDDB@DABCDCBCBAB@@CDCBEBABCD@BAD@@@B@@CHCHBBBBFF@B@@@BAB@FBDFHFFDH@@@DCBMBIA@AAACAGBCBC@EBC@CACBC@IDG@EDAB@B@DBDFD@@BD@B@DEDC@C@AB@DBBHDBDBBDBHFDD@@BBADBDBD@D@@@B@FDBDBDBD@F@F@F@HBF@B@F@H@B@B@DDDDD@BBF@FDFBD@D@F@@@@BDFBLBDBJDDBDLDDD@BBB@@DFBBBBDFDBBDDBBD@DBDDDBBBDBBBB@@DDDDDDFFB@BDD@B@B@B@D@D@@@DB@@@@BB@DBJ@B@BBD@BBD@DB@@DDDDDB@DBDBBBBBB@BBBDBD@@@B@BDBBBDBB@@@B@@BFADCBA@C@CDC@ABCBCDAB@DBFDB@BBFDBBBB@BAFAD@DAF@DBH@B@B@B@@@D@FBD@@@B@@@BB@@BDDBFB@BHDFBFDBDBDC@ADC@@B@B@@@BB@B@F@@B@DAJAD@F@PDJF@BB@BBDDDDDDDB@@DDDDBDBDBD@@@B@@@@@@ABAB@@ABCD@B@D@@@@DF@DBF@@AB@BABCDCBCBEBCBCD@DBB@BB@DBHFFBFFBD@BCDCBKBEBEBAD@FBD@BFFHFB@HFFF@@DFFHDHDDDFDDJLBBCBA@A@E@CBC@A@ABA@@@AB@BEFFDBCBA@@D@@@@@BBB@@@@B@BFAD@DABAFGDCB@BAB@BC@AFEFGFI@A@A@AAEBMB@@ABABAFEBABADGBEBC@ABC@A@ABEBABCDCBCBAFGHEBAB@DCPGHAD@BBDBDD@@B@@BHDD@HBF@FAB@BADCDCBCBC@A@@AA@@AAGC@@C@A@G@A@GAC@C@A@@A@AB@BC@@B@@ADABAFAD@B@BABBB@BA@@DADCFCHEFBB@@@FBBB@@BBFFHH@@DBD@FBB@D@D@BAB@DA@@@@DAB@R@HBF@DAD@B@FA@@@@@@NBB@F@@@D@B@FCF@B@@@B@BA@AB@J@B@DBDBB@@BB@BADBD@B@B@B@DBBB@@@DB@BBBBB@BBDDDB@BFBBAB@@@BCEAIEGEECEAGAC@CA@@AAAC@EAAAA@@AAEDGHEFGB@EA@@A@C@@A@@@AA@@BCB@@CBB@AB@B@@G@A@@BBB@@@@A@@B@@@@AB@B@@@@A@@C@BA@@B@B@@@@A@@B@@@@BB@@@@@@@@@BA@A@@@@BA@@@@B@@BF@@B@@D@@@@B@@@@@ABC@@A@@@@A@A@A@@@@@A@A@@@A@A@@AC@@A@@@C@A@A@@@@@@A@@@@CC@@@@@AB@@@@@@@@@B@@@@AAA@@@AA@@@@@BA@A@AB@@@@@@AAA@@BA@@AA@@@AB@@A@CB@@@@A@C@@@AB@B@B@AA@@@@AA@@@BAA@@@@A@@A@@A@A@@@@B@AA@@@@AB@AA@A@AAA@@@A@@AB@@@BAA@@@@@@@AA@@A@@@ABC@@B@@@B@@@@@BA@A@ABC@@B@D@F@@@@@@B@@D@@A@@@@B@@BAB@@B@B@@BBBDBBB@@BBB@@BBAB@@@B@@@@AB@@B@@DBB@@@@AB@@@HDB@@BB@D@@@@B@@@BABB@@B@@@@BB@@@@@@A@@@@@A@BB@BA@A@BFB@@@HABAB@@@@@@@B@@BBA@BB@B@@@B@B@BABA@C@A@@B@D@B@@@A@@@@@@ADB@AA@BC@@B@@@@A@@@@B@@A@AB@B@B@@@A@@BBBA@B@DAA@D@@A@CB@@@@@D@AAF@@GB@AAGIDCAGA@A@ACA@AACBCCAB@@CBABACDAAAG@A@BA@AB@@AHCHEDCNMDEFCNIB@FDHJFEFCBCBB@@B@DA@@@@BAA@CCA@EIACAAAAAA@@@@AAAAGGCAAAAAAAAAA@@@GC@@ACAA@@AAEEAACCCCEG@@@AEGCEAEACCGGKAEEBCA@@@@BA@@@@@CAAEKCGA@@AEEIMEGIIAAIKEEACEEA@A@B@DCCCEGCAIEGCAACBCD@@AABCCCC@IMA@@@A@EBG@ABA@CBBDBDFABDABEBB@B@BBBBB@ABABCB@@A@@B@@AB@B@@@@@@@B@@B@@@@@B@@BDAB@B@B@B@@BBB@@@@@@@@AAAB@@@AAB@@A@@B@@CDABADCBABAB@@@BABA@ABCG@A@AAC@A@A@@@@@@@AAAAAAA@AECACA@CAAAC@A@ABC@A@EDABC@C@@@A@AAA@AACAA@A@@@C@ABEBCBC@KBGBCBGDC@A@AAAAAC@@@C@EAC@AACAAA@AAA@A@CBA@CBCDAB@@@@ABABA@CBABCBA@KDA@CBKDA@C@G@A@E@G@A@C@C@C@M@A@C@AAA@@@AAACA@@A@BA@C@@B@@A@CBABABABA@ABA@A@A@@@AA@A@@BABCBA@@AAAA@@C@A@CACAAAA@@@ABA@@@A@A@A@AACCAAAAAC@AO@AA@@AA@@A@@ABABABA@CDE@AFG@ABAAA@@AA@ACA@@CAA@@AA@@A@A@C@A@ACC@@@A@CA@@AAAAAA@AA@@AA@A@CAC@ACE@@A@A@AB@BADA@@BA@A@A@@@A@@A@@@A@@@ABA@AB@@ABA@A@A@E@AA@AAA@@@AB@B@@@BAD@B@B@@A@A@@@@@A@@ACA@AC@CAC@AAA@@@AA@ABAA@DE@@BABCB@BC@@BA@E@@@A@@CCEAA@AA@A@AAA@AAC@AAACAA@A@C@C@A@ABABA@A@A@CBABA@@B@@@B@@DB@@@B@B@@CB@BA@@B@BAB@@ABABC@A@A@@@CAA@CAC@@AC@CC@@A@@@A@@@C@@A@@@@@C@@ACA@@A@A@AAA@AEAAA@@A@AAA@CE@AC@CAAB@@@B@D@@AB@@ABC@C@A@AAAAAACC@@@A@@A@@@A@A@@@A@AIAA@AA@AAA@C@EBCBBD@F@F@DBFBHBDBDBDBDBBDDBD@@@FAF@@"],["@@@@ILRLBABABCCE@@@AA@AAAAAA@@AA"],["@@@R@@@@@@B@B@B@@@@@@@B@@@B@@@B@@@B@@@BAB@@@@@A@@@@@F@BA@@B@@@@@B@@@@ACG@A@@BA@@AA@@A@@B@@@@A@@@@@@@@B@B@@A@@BA@@B@@B@@B@@@B@@@@AA@A@@AB@@@A@@@@BA@AA@@@A@@@@@@@@B@@@@AAA@@@AA@@@@@@@@A@@@@A@@C@@@"],["@@BADAB@@AB@@@@A@@@AAAAAA@A@AD@@A@@B@@@B@@@B@@@@@@@B@B@@@BBA@@"]],encodeOffsets:[[[116492,31025]],[[116673,31092]],[[116523,31014]],[[116572,30993]],[[116711,31081]],[[116743,31079]]]}},{type:"Feature",id:"420116",properties:{name:"黄陂区",cp:[114.375725,30.882174],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@@@CA@@AAAACEAACCA@CAICA@@AA@@BAAABC@@BCFCFABCDABABA@ABC@ABGAE@C@AAEACACCC@@AECAAAAA@AC@C@A@AAA@A@AA@@AAAGAG@CA@@AB@@A@@@AB@@@BAB@B@@@B@B@@DB@B@@@B@@AB@@A@C@EAI@A@@@AB@@A@AFADAFA@@BCBABEB@@E@QBKBC@ABA@ABA@ADCFA@CD@@CBABADADAB@D@@@BBD@@@BDFFJBDBFBB@B@DAD@DCFABABADAB@B@L@B@B@BAF@BABABABCBGDEDA@A@A@@@A@@@@AAA@EA@@@C@@@ABKHEF@B@@BCJEDCBABABB@@B@@B@B@BBBD@@@D@B@JCB@@@@B@BB@@HBH@FAHADENCPANBJDXBBBBBBBB@B@@ABOTA@@B@H@F@HAF@H@DAF@@@F@BAF@B@BAD@B@B@B@@@B@@@BAD@B@B@@@@BD@@@@@@AB@@A@EFA@@@@BADCF@B@@@B@@F@@@B@@@@B@BBBBBBB@@@@@@@BE@ABA@@B@B@B@@@BD@BB@@@BAB@@@BA@@B@B@B@@@BA@@@A@B@FD@B@B@@AB@B@@BB@BAB@@@@@@@@@B@@AB@B@BABAB@@@BB@@B@@BBBB@DA@@@@BBD@@@@A@A@@B@@BB@B@@@B@@A@A@@AA@@B@@@B@BB@@BB@BB@@BB@B@B@D@B@@ABA@@B@BC@@BA@@BBB@@@B@B@F@BB@BB@@B@@@@@B@B@B@B@@@@B@B@@BB@@BBD@B@@BB@@B@B@BBD@BB@BBB@B@@@@@@@@B@@AB@BB@@@DB@@@BBB@B@B@@AB@@AA@@AB@@AB@@AB@@@@@BB@D@BBBB@@@BABA@@@A@@@@B@BFBBB@@@B@@ABA@@@@@A@@@@@CB@@BB@BAD@BCD@BAB@BA@AB@@@@@@@@ABAD@@@@AF@B@@@BBB@@BB@@@BD@@B@@@B@@ABAB@BAD@B@@A@@BC@CBE@@@A@@BAD@B@@BB@@AB@B@B@@DFBDBBB@BBB@FD@BBB@D@@@BA@@BA@@B@B@B@B@BB@B@F@DAB@B@B@B@@BBB@B@B@BBB@BB@B@BAD@B@BBB@@BAB@D@B@BBBBBFB@@@BB@@D@BA@A@@B@B@@@B@B@@@B@@BB@@BB@@@@A@@B@@AB@@@B@BA@AB@@ABA@AB@@@BABAB@@@@@BA@@B@@BD@@BBBB@@@DBF@B@D@B@@@BCBAB@B@@@BBB@@@BABABAB@B@B@B@@@BBBDDHDJDFFDBHDPLFFJJHHDBBF@DBFFDDBBB@DBBBFDDBBJFBBDDDJDFBFADCBADA@@H@FCFEFA@CDCFABEBCCEACAC@CDC@GEA@ABBH@DADEBCFCFCFEBEBC@EHIVELERAN@H@DDHBBFBD@D@JAH@DB@D@BCFAFCDFF@BAD@HDHDDBHBFDDH@HAJBB@DFDHFHFHHFJBBD@@@F@HAFBD@BBFJDHBHBDBDDHDH@FDHHDDFDFBDBDBFABABAFCHGFEBE@G@ABEDCH@FBHCBCBAFIBA@AHCB@D@D@FGDEB@FBFDHB@@JDHBD@F@DA@E@CFCDBB@BAAABEBAB@F@HBFBFAR@JAJ@@@DB@BB@@@B@@BB@@@B@B@B@BB@@B@@@@@BAB@@@B@B@@@B@@AB@@@@@B@@@B@@AB@F@D@J@B@F@D@FA@@B@@@@AB@@@B@@@@A@@@@@@@@B@@A@@@@@A@@@@@@@A@@@E@CAACAAACAACCC@G@CAACEECAACG@@CGAGCGCEACCCCAGECCCE@CBE@@BGBE@@@ABE@C@CBC@@DAHCDEDCDCDCBADEB@@AD@FABABCHCHBF@BBFAFABADAD@@CCCCCBAFAD@HAHALCFADC@CACAAEAE@E@C@C@AA@A@E@CBCBG@@@ABAF@DBFBD@DEFEB@FAHCFCDCDEBEDEB@BA@@F@@AH@D@D@@@HAJ@DAB@DA@@DC@C@CBCFA@C@E@CBGBCDEFADC@AEECCAE@@@EBGHG@EEIAACECEACAAAKCGEGAAAA@@CGAIBEBACCGCEACAAE@CCAAA@C@EDGDEFE@ADCBEBCACDAF@H@F@@@FAHAFCFEFEBADCDEBA@@@A@AAAB@@A@@BA@@@BB@@@@BB@@@@@@BB@@B@BB@@@@@@@B@@@B@BB@@@@B@@@@@@@B@BA@A@@@A@@@@@A@@@ADAHM@@@AJGBAHGB@FEDCB@@AB@@@BAHADAHAJADAD@HA@@B@B@@AB@@ABA@A@A@@@ABA@A@CBE@ABA@A@ABA@ABADC@@D@@ABA@A@A@@@@@A@A@C@A@@ACBCBAB@@@AE@A@A@@@A@@@C@@@@@@@AB@@A@E@@@AA@@@@BA@@@@A@@@A@@A@@@@@A@@B@@AA@@B@@A@@B@@@AA@@B@@BD@@@@A@@@@BA@E@AAA@@B@@A@@@@B@@A@@@@@@@@AA@@@@@A@@@@@@@@A@@AA@@@@A@A@@@@@@AA@@@@A@@A@A@AAA@@@A@@@AA@@@A@A@A@@AA@B@@@@@B@@A@@B@@@BAB@@@BA@@B@@@BA@@@@@@@AA@AA@@AA@@@A@@@@B@BB@AB@@@@@@A@@@@@AA@@@AC@C@@@AAA@@AAAA@A@A@@@C@AA@@A@@@@A@BAAA@@@B@@@@@@A@@@@@@@A@@@A@@@@@@A@@AB@@A@@@A@@A@@@@@A@@@AA@@@@A@@BA@@@AB@@@@AA@AA@@@@A@@@@CA@@@@@@A@@@A@@B@@@@@@AB@@@AA@A@@@@@A@@BA@@@@@@AA@@@@@AA@@@A@A@@@@@A@@@@A@@@@@AA@@AB@@AB@@AA@B@@@@@@@BAA@@A@A@@A@@@@@@A@@@A@AA@@CBA@@@A@A@A@A@CAAAA@AAA@@AAAA@A@AA@AACA@@EAAAC@E@AA@@A@A@AA@ACACA@ACAA@@@A@EAGAE@CAA@A@G@A@ABEB@@A@@@CCECAAAA@@@@A@@BA@@@A@@AA@EEACA@CAAAA@A@AAAA@AA@AAA@@@C@C@E@CAAAA@@@@AA@@@AC@A@G@C@E@GBA@AB@@A@@@@@@AAAAABA@OGAAC@@@AECWAA@@@@BA@@B@BAB@BCFC@ADCBABABG@@BC@A@ADI@@@CBKB@@A@ADEB@B@@@BI@@D@BA@ABC@@@@@C@@@CJAAG@@L@@@@ABA@CB@A@@AACSHODEBIBG@IBKBMBG@IBMBE@O@E@A@C@@@C@MAK@GC@@A@A@AAIAGCEAIAGAC@ABGCGCABCDABEBCBCBIBMDA@@@@@A@CGCEA@@@@@A@ABA@A@@AA@@AAA@A@@A@CBA@@@A@@AEAKGCCACAAAC@@AA@ACAA@A@A@A@@@ABA@@B@@A@@BCFCFADA@CD@@"],encodeOffsets:[[117066,31423]]}},{type:"Feature",id:"420115",properties:{name:"江夏区",cp:[114.319097,30.376308],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@KBKBKBE@CDAD@DAF@F@HAHCDADA@IDEBBF@BBDBDBD@B@DAJ@B@DAHCFABCDAFCDADEBABABADCDEHCFCBAF@@A@CFEJCFCF@F@BELCDCBKJA@EBQ@@@EBA@CBEBGB@@CBA@C@GAE@@@EACAA@C@@@A@A@E@CCCA@@ACACCACAGAC@CD@DAD@BAD@D@DBB@BBBB@D@FA@@BBDF@DBBB@HHXPFLFJR^LXBLDVBRBP@HBF@FBB@B@BBFDL@DDFBDFJBDDHFHCBB@JND@DDADBB@@DCDABBHDJFDBFHDDCDA@B@B@FFBDFFJLBBJJFHJNFF@BB@DHFLBB@D@@@@AB@@@@DBFABFHLDHBDBFDFFH@B@@\\K@A@@@@AC@@@ABAB@@A@A@AAC@@A@AAA@AA@@AA@@@CAE@E@ACEEKACACEG@@@@DA@@DA@ANG@@B@BDBA@@@@DA@@@B@@BB@@AB@@BBA@DD@AB@@B@@ADA@A@@BBF@B@@BB@@@B@@@@BBBB@AB@DA@@@ABB@@BC@@B@@@@A@A@@@@@ADABA@@B@@CBCFKBCB@BABA@@B@DA@@BA@@@@DB@@@A@@B@@@@@@@@@BBB@@B@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@B@B@@@@@@B@@@@@@B@@@@B@B@@@@@B@@B@@B@@BBB@@@F@BADBD@F@B@FAJ@D@D@D@DBB@BBBBDBBB@B@B@D@B@@@DA@@BABABABC@@BA@@DA@@@@@AB@@@@A@@B@A@@AB@@B@AB@BA@AB@B@@AB@B@@BB@B@D@@B@B@@BB@@@BB@@BB@B@@@B@@B@BBBFHDDBFBB@DBD@BBBDLBBDFDBFDD@D@DAHA@@BAB@B@BCB@@@@@FAB@@@@@@AB@@A@@B@@@B@@CA@@@@@@@BABA@@@@@ADCHC@@BA@@DABABAB@@@D@D@D@B@D@D@FAB@@BBBFFDF@@BBB@B@BBB@BBBBBBJHDBDBDDDB@BB@@DBBBA@@@C@CBC@EE@EA@CBIBAF@HBD@D@BABCBAB@AEB@@A@@@@@A@@A@@AA@AA@AAA@@@A@AB@@A@@@A@@B@@ADE@@BAB@@@BA@A@A@AAA@A@@@AA@@A@ABA@A@A@@@AAA@C@@BA@AB@B@@@@@@@BA@@@A@A@@AA@@@@A@@A@@@A@AAAB@@@BA@@BA@@@A@CB@A@@AB@@@BC@@@A@C@@B@@@@AB@B@B@@@@JF@@B@FA@@@@@BD@D@D@@@B@BB@@@@BAB@B@@@@AD@@@BA@@B@@@B@B@@@BB@@B@@@@@D@@@BBD@@@B@@@@B@@A@@BA@@@@BA@@@@@ADA@@@A@A@A@@BA@@@A@A@@BA@A@A@@@@B@BA@@@AFBDBAB@@A@@B@B@B@BAF@B@@AB@D@B@D@B@@A@AB@B@@@D@D@B@@@@B@@@CH@B@@CA@@@@@@@@A@@@A@@@@A@@@@@BB@@BB@B@BBBBBBBB@@BH@B@B@B@@@@@B@@BAB@B@@@B@@BBB@@@BB@B@B@B@BB@BB@B@@@@B@@@BJ@AP@J@VTBB@@H@BLD@H@@@@@FBJ@DBDBDHAD@@@BB@DB@@B@@B@@B@@F@B@@@HCFE@@B@BC@ABE@BB@BAHCFAFABABA@E@C@@AA@@@@@C@@A@@A@AB@@A@A@@AA@@@AB@@@B@BA@@@@@A@@@@BABABAB@@@D@B@B@D@D@BB@@@ABB@@@B@@BA@@A@@A@@B@@@B@@B@@@@@@@@B@@@@@B@@A@AB@@AB@@AA@BCB@A@@B@@B@@B@@B@@@@A@@@@@@B@@@@@B@@@@B@@@@B@@B@@@@@@@B@@B@B@@@ABBB@@@B@@@B@@@@@@@BA@@@@@BBB@@@@BBB@@B@@A@@@@@@@AB@@@B@@@@@@@@@AB@B@@B@@@@@@A@@@@@@B@@@AB@@B@@@@A@@@B@BABBB@@@BB@@@@BA@B@@@B@@@@@B@@ADA@@B@@@@@BA@@D@B@@@B@@@@@AB@BB@A@BBA@@@@@@@@@@B@@@@A@BB@@B@@BAAA@@A@@AB@@@@B@@@@@@@@@@@AD@@A@@@@B@@@@@@A@@@@BA@@@A@B@@@@@@B@@BB@@B@@@B@B@@@B@@@@BB@@@A@@@B@@@BBB@@@@@@@@B@@@@@B@@B@@@@@@BA@BB@B@@@@@@@@A@@B@@@B@@@BB@@@B@@@@@BB@@@A@@BB@@@@@@B@@B@@@B@D@@B@@@@@@@@B@@@@A@@B@@@@B@@@B@@@@B@@@@A@@@@@@@@@@B@@@@@@A@@@@@@B@@@@@B@B@@@@@BA@@@@B@@@@@@@@@@@B@@@B@@A@@@A@CAA@@AI@A@G@A@@D@@A@@@A@A@@B@@@@B@BB@@@@BBB@@@B@@@@B@@@@BBB@B@B@@B@@B@@@@BF@D@D@F@BB@@D@BB@@@B@@@@@@@B@@B@DBB@@B@@B@B@@B@@@B@@@@@B@BA@@@@B@B@@@@B@@BB@@@@BA@@@@@DB@B@BB@B@B@BBBB@@BBBF@@@BDBBBD@B@F@B@D@HCB@BADA@@B@B@@@@@B@@@B@DAD@@@D@B@@@B@B@DBB@B@BA@@DADAB@B@B@@@@AB@B@@@@A@@@@@A@@BAAA@@@@@A@A@@@A@@@@@A@@@AA@@A@@@@B@EE@@@@@A@@A@@A@@@@@@@@@A@@@@@@@@@@A@@@@@AA@@@A@@A@@@@@@@@@A@@A@A@A@@@@AA@@@@AAA@@AA@A@@@@AA@@A@@B@@@@@A@@@@@@@A@@@@A@@@@@@AA@@@A@@@@@A@@@@A@@AB@@@A@@@@@@@@A@@@@@@AA@@@AAA@AA@@ABA@@@@DAHAB@@@DBDAB@B@@@@@@ABBAB@@B@@@B@@@@@@A@@@@BA@@B@@@BAB@B@@A@@@A@@B@@AA@@@B@@@@A@A@@A@@A@C@@@AAAA@@A@@@AA@@A@@A@AAA@A@@@B@B@@A@@@@@A@@@@AA@@AA@@AAAA@@A@@A@@@@@@@ABC@A@@B@D@@A@@@@@@@A@@@@B@@AB@B@@@AA@@B@B@@@@A@@B@A@AAAC@C@CB@@AB@@@BA@A@A@A@A@@A@@AC@@@@A@@A@AAAAAAAA@A@A@@@CDCDEDCBEBG@E@AAIFC@IAA@GACAK@E@MAI@GEK@KFOHIHEBGBG@@AGAGAGAI@@AEAE@C@A@E@EBG@GBGAIAEEGECGCECEEG@GEAC@EAE@E@G@GCGECGEECGEIEEEAG@G@G@CBEAGAGAGAGCGGGGEE@GBA@C@@AAAAC@C@C@C@CBC@ECCEACA@C@CDC@@BGACACAA@AECACCCAA@A@ADC@@@A@C@A@@@@@@@A@@@@@A@A@@@AA@AA@@AAAA@AAABA@CBC@A@@@A@@@EDCDAFGBADCDCBC@A@@B@@@@@BC@@AA@A@CA@BABADCD@@@@@B@B@BADAHCBA@@@ABC@A@A@A@@@A@ABCBEDADADCB@BCBA@A@@@A@AAAAA@A@AA@BA@AB@@@BABCAC@E@C@C@ABCBEDEDGDEBAB@F@BABA@ABCBADABAB@@A@@AAAAA@ABA@ABAB@@@@A@CAABA@ABA@ABA@@@AA@@@AA@@A@@@ABABA@@@A@A@@AA@A@AA@A@A@A@A@A@@BABCDCBAB@@AB@AA@@AAA@A@@@A@A@AB@D@BABA@AAAAA@CBADCBABABA@@BCBAAA@A@@A@A@A@@@@@A@A@@A@@@@@A@@@@A@@@@@@A@@@@@A@@@A@@AA@@@A@@@@@@A@@@@A@@@@@A@@A@@AA@@A@@@@@@@@@A@@B@@@B@@@@@@@B@BABA@@@@@@@A@@A@@A@A@@@@A@@@@@@@@@AA@@@@@@AA@@@@A@@@@@A@@@@A@@@@@@@@@@B@@AB@B@@@B@@ABA@@@A@@B@BA@@@@BA@@@A@@@A@AA@EGECA@A@A@@@@BA@@@A@@@A@@@@@AB@@CHABA@@@C@A@A@A@A@@@@@A@AA@@@@A@A@@@ADABA@ED@@A@@@@@AB@@@@A@@@@A@B@@A@@@@B@@@@@@@B@@@@@B@@A@@@A@A@A@A@A@A@@@AB@@@D@@@B@@@@B@@B@@BB@@@@@B@@@@@@@B@@A@@@@@A@A@C@ABA@A@ABA@@@A@@@@@AAA@A@A@CAA@A@@@@A@@@@BA@@@@BAA@@A@@A@A@@B@@A@B@AB@@@@CBCB@BCBA@@BA@@@@@A@A@A@@@@@A@A@@B@@@@A@@@@@@@A@@@@@A@@@A@@@ABA@@B@@CBBDAB@@ABGBCBCBA@C@C@G@E@KDABEB@@A@EDABAB@@ABCB@@EBABGBGFGBGBCBA@EBE@E@G@GBABE@EBGDEB@@EDA@A@C@ACAAE@A@CCGCA@C@EBCBC@E@CCCCEE@@GGEA@@GCC@OCA@AAGCEEE@GACAA@E@A@CAA@EA@@A@@@CAG@EAGAGAA@E@E@CAA@@@CAC@E@IAA@A@A@ABA@ABkL@J@DBD@F@DBB@FADAHADEFCDEDEFADA@AFADAD@D@F@DBD@F@D@@BF@D@F@DAF@FAD@FADADABEBC@"],encodeOffsets:[[116929,30810]]}},{type:"Feature",id:"420117",properties:{name:"新洲区",cp:[114.801096,30.841425],childNum:4},geometry:{type:"MultiPolygon",
+coordinates:[["@@@@BA@@BADA@@A@@A@@@ABA@A@AA@@AA@@@@@@A@AB@BA@A@@BAAAAA@@CAAAA@@@A@C@AAAB@BA@@@@@AA@B@@B@@B@@@@@@@@@@@B@@@@BA@B@@@@@@AB@@@@A@@@@B@@A@@@@@@@@@@@A@@@@BB@@A@@@B@BB@@@AB@@@@@@B@@@@@BB@@@@@@@@@@@B@@@@@@@@D@B@BBBB@B@@@BB@@B@B@@@@@@@B@@BB"],["@@@@@BAFCB@@ADCB@B@@A@@B@@BB@B@B@BA@@@@B@@ABA@ABABC@PLL@NM@@AA@C@G@AFBDBAHJWCE@AEGECC@@@@B@@@@@BAA@@@@AB@A@B@@@@@@@@A@@B@@@@AB@@B@@@@@A@@@@B@@@@@@A@@B@@AB@@"],["@@@@@@A@@AAB@@A@@@@@@@@@A@@AA@@@A@@@A@@AAA@@A@@@AA@@@@AB@@@B@@@@A@@@@B@@B@@@@B@@A@@@@@A@@@AA@B@@A@@BA@@A@@@@A@@B@@@@AB@@@@@@@A@@@@@@A@@@@@@BA@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@AB@@@@@@A@@B@B@@@@@B@@@B@@B@@B@A@@@AB@B@@B@@B@@@B@B@@@@B@@@BB@@@@@@B@B@@@@@BBAB@@@@@@AB@@@B@@@@A@@@@B@@@@@B@@B@@B@B@@@B@@BB@@@@@@@@@B@@@@@B@@@@@@A@BB@@@@@@@@@AA@@@@@@B@@ABA@@@A@@@@@A@@@A@@@@@@AA@@A@@@@@@A@@@A@@@A@@@A@@@@@AB@@@BABA"],["@@MNK@OKA@E@@@CBC@A@ADABABBB@BBB@BB@FABBDAB@@@B@BB@@B@B@@BBB@BB@@@@B@@BB@BBBHBFDBBDBBBDDD@FBHBB@DAFAD@D@HABBDB@@BBB@FDDBDBDDDB@A@@@@BA@@BA@ABA@C@ABABCBEBCBA@@@A@@@@@A@@B@@@@@B@@A@@@AB@@@@B@@@B@@@@B@@@@@@B@@@DDBBBBBBABA@ABC@@DA@@@A@@@@@ABA@@@@B@@@@@@B@@@B@@B@@@@@@A@@@@B@BB@B@@@B@@@@D@H@B@@@@@B@@@@B@@@@B@@@@@@@@@@@@@BBB@@B@@@AAA@@@A@@B@@@AAAC@@@A@@@C@@AA@@@ABA@@@@@ADAB@B@BAD@D@DB@@@@BB@BBBBB@@B@@@@@@B@@AB@@@@@B@@@B@@@@@@BBB@B@@BB@B@@B@@A@@@@@@@@DDDDFBDADAF@DAFCDADABBDDDBBFBFADEBCDE@GCE@I@@AGBIB@@@B@B@@@@@@@@@@B@@@@B@@@@@@A@@AA@@@A@@@@@@@A@AA@@B@@ABA@@@ABAB@@@@@B@@@@AB@@A@@A@@@A@@@B@@A@@@@@@@@@A@@A@@@@@A@@@@@A@@@A@AA@@A@ABABAB@@@B@@@@@@@@A@@@@@@@@@@AA@@@@A@@@@@@@BA@@A@@A@A@@@BA@@A@@B@@@@@@@@@@@B@@@@A@@B@@@@@BA@@@@@@@AAB@@@@@A@@@@@@@@@@@AA@@@@ABB@@@@B@@ABA@@AC@@@@@A@@@A@CBA@@@@DA@@BAB@BABA@AB@B@@@@BB@@@@BB@@@B@@@B@@@B@@@BABC@A@@@@@@A@@@A@@@C@@@@AA@@AA@A@@AA@@A@@@@@A@A@@@@@@AA@@A@@@@AA@@A@@@ABA@@BCBE@@@A@@A@AA@@@A@A@@B@@@@@B@BA@@DCBCBADE@A@@FA@ADADEDAB@B@BABA@A@@@@B@B@B@B@B@BB@@B@@@@@B@B@B@@@D@@@B@BA@@B@@@@@B@@B@B@@BB@@DBB@DAHCB@@@@A@@@AAAAA@@AA@@@A@@CAAAAA@@@@A@@A@@@ADCDCFC@@DAHC@@BBB@@BB@BB@B@@B@@@BAB@BC@@@@B@@@B@B@B@@@B@@@B@BAB@BA@@BA@@B@@AB@@A@A@@AA@AA@@@A@C@@@A@@@@@@@@A@@@@BABA@@@AB@@AB@BA@@B@@@@@FAH@DADBB@DAD@B@BAB@BABCACBC@A@ADA@A@CAAEEEAIAAACAA@AAA@@AC@AAAAEA@@CEA@C@A@@@@AAABCBAD@BA@@@ABAA@@@@AA@AAA@@AA@@@A@@@ABA@C@EC@@A@@@AAAAAAAC@@E@C@C@@@CBCD@@BBBDBBBBA@@BABABA@@@A@AAA@A@@@GDGBKA@@@A@ABC@@@@@A@@@@@@@AA@@@AA@@ABCBABCFC@@@E@EEGG@@B@@ABAACAA@A@CBABADA@AB@BABCBCBCBCACCCAAA@@@C@A@AA@@CCAA@EAC@E@CBCAACAA@AAA@@@AB@D@F@D@@@@@B@@@@A@A@A@A@@A@AAE@A@AACA@CAC@@@A@A@@@@B@B@BADA@A@AA@@@@CCCACACACAE@EAE@ACA@@E@@@ABABAB@B@B@B@@AB@BCBABADC@C@C@C@CCE@AAACAAA@@CDCD@BCD@BCBABCBA@ADA@@BCFEDEB@@GACA@@IKAAAAA@@@C@CBC@CBA@CBC@CB@@A@AB@DAB@FABA@A@AAC@CDE@ABC@E@AAC@EA@A@@@C@C@A@ABE@E@E@EAG@E@ACICCCC@@CCEAEAGECCCCCCCEA@ACCAECEAECE@GAEA@@EACAEAGACAEECAIHEFC@EAACCG@A@AAAAAACAA@@@A@A@ABA@ABABC@AB@@CBAFGDC@C@@BABCBA@@BA@A@AB@BA@ABAFA@ABA@AB@@A@A@@@A@AA@AAAAA@AA@@AA@@@C@A@@AA@A@A@A@AB@@AB@@A@@BA@@@A@A@@BA@ABA@@BA@ADA@@B@B@@BB@@BB@BBB@BBB@B@BB@BB@@B@B@B@@@B@BB@@@B@BAB@BAB@BAB@@ABA@@BABA@@BAFEBA@ABEB@@ABCBABCDABC@C@A@C@AB@B@B@BB@@BB@BB@B@BAB@@ABA@A@@BA@ABA@AAA@AC@A@A@@@AAAA@ACCKAEAG@[ACAUAQCQEECM@CAmMCAKGCAAAIIGECAAAOEIEICA@KCMCIAQC@A@@C@YCIAEAANEA@@A@A@@@AAC@MDABA@EBABE@CBE@C@ABA@C@CBE@AB@@GBE@GBA@AB@@G@ABA@CB@@CBEBC@CBABABABEBABABEFCDA@CFABADABADABCFAB@B@B@@@@DDABABGE@@@@@B@@AD@BCFAFA@@@@BAB@BAB@BA@@B@@@B@B@@ABAD@BA@@B@@@@@BA@@@@BA@@B@@@@AD@@AB@@@BA@@B@@@@@@AB@@@B@@@@AB@@@BAB@@AB@DA@@@@@@@@B@@@BAB@B@B@@CF@B@BA@@BABA@@BAB@@CB@@DB@@CD@B@FBL@FA@ABBDEB@A@@@@@@@AAACCAA@@@@@@B@@@@@CC@ACBA@ODBD@BB@A@@DAB@B@@K@@@BHIB@D@@@D@@@@AD@BABC@@@AJ@@A@A@CF@B@BA@AL@D@@CJ@B@BAD@@AHABABCD@BEDADA@ABA@@@AB@@@@BBDXBF@@D@BBPHB@BABBBB@@@@@@@BA@@BAB@H@F@D@H@BBD@@B@@B@@B@BBDBF@D@D@@@B@BBB@@BBBBBB@B@BBDBB@BDFFB@@BB@@@B@@AB@@@@@BBBBFDDD@@B@@@FABAB@H@B@B@DBF@HBFBB@@@B@DB@BDBDB@BBBB@B@@@BBF@D@BBFB@@DBBBB@@B@BBBBB@@BB@BBBBB@D@B@B@B@B@@AB@DB@@B@B@@@B@@@@B@@@@B@BB@AB@@@@@@A@B@@BA@@BA@@BB@@B@@@@@B@@B@@@@@B@B@@@B@@B@@@@BB@@@@@@AB@@@B@@@@@BBB@@A@@B@@@@A@@@@B@@@B@@@@B@@D@@B@@@@@BBB@@B@@A@@B@@AB@@@B@@B@@B@@@B@@@@@BB@@@B@@@BA@@@B@@@@B@@@B@@@@@@@B@@@@@@@@A@@BBABB@@@@@@BB@@B@D@@@B@BBBBB@@BB@B@@@DBD@@B@@B@@@@@B@@@@A@@BAAA@@@@@@B@@BB@@BBB@@B@@@@@@AB@@A@@@AB@@A@AB@@A@@@@BA@@@@@A@B@@BB@B@B@@@B@@B@@@B@@BB@B@B@BB@@@@@BB@@@@@@@B@B@@B@@BB@@@@@@@@@@B@@@@BB@@@@@@@@@BA@@@@@@BA@@@BB@B@FAB@@@@@B@@C@@AA@@@BB@@A@@@@BA@@@BB@@@AB@@@@@B@@@@B@@@B@@B@@A@@B@@B@@@F@BA@@B@@@@@@@D@@@B@@@B@BBFB@D@D@F@B@B@DBFBDBDBHBFBB@BDDDDD@B@B@@@B@@BBB@@@BA@@@A@@@A@@BA@C@@DCBA@A@@BA@@B@@@@@B@DBBBDDDBBBB@@@D@B@@@B@@@D@B@B@@B@@@D@B@B@@@@@BB@BBDBBBB@@@BBDB@@B@B@B@DAD@@@D@D@@@B@@BB@@B@B@@@@BBB@B@@A@@@A@@@@AEAA@ABA@A@@@@FCFAD@F@FB@B@@@DCB@@A@@@ABA@@@@@A@@@@B@@AB@@@B@@@@@@AB@@@BC@@BAB@B@@B@B@@BB@@B@@BB@@@B@@AB@@@@A@@B@@@BA@BFDD@DADAB@@A@@B@@@@@@@B@@@@BDBBF@@@B@@@B@@@B@BB@@DD@B@B@@@BB@@A@@@@BA@@BAB@@@@@BBB@DBB@D@B@B@B@B@B@@@F@DBDDD@DBB@@@@FFFDBBBB@DDD@FBD@B@D@D@BCBCAAAA@A@AD@BADBD@B@B@B@@A@A@AA@@AA@@@A@A@@@@@A@@A@@A@@A@A@ABA@ABA@C@@BA@@@A@@@AAA@AA@AAAA@@@ABCDC@A@AB@@@B@BBBBBB@@BB@DDH@BBDBDBBBD@DBD@F@D@@BB@@B@BBDBBDDBBB@BB@BBBBBBB@@@@@BB@@B@@@B@@@@B@@B@@@B@B@@@@B@@@B@A@@@@@@@@@@@AB@@@@@@A@@@A@@B@@@AA@@AAA@A@@A@@@AAA@@@AA@@@@@@@A@@@A@@@@@@A@@@@BABAB@@@@@@@B@@B@@B@@@BBBB@@BBDBBB@@B@@@B@@@B@@AB@@@@@@A@C@A@A@A@A@ABA@@BABA@A@EAC@A@CA@@AA@@@AA@A@@A@@A@A@@BAB@B@DABAB@B@@A@A@AB@B@@ABGBABADAB@B@@@B@BBB@B@@AAA@A@ABA@@@E@@B@F@D@@@BB@B@@B@BAB@B@B@B@D@B@@@@ABA@@@CBA@@@@B@B@D@B@B@@@D@B@D@B@B@@@B@D@B@@@DBB@@@BBDDBF@@BDBBDJFBFDBF@@BA@@@AB@@@@@@@@A@@B@@@@@A@@@BA@@@@@AB@@@@@@@@@@A@BBA@@@@BB@A@@@@@A@@D@FDFH@BDFIXED"]],encodeOffsets:[[[117748,31742]],[[117635,31709]],[[117707,31768]],[[117643,31726]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("仙桃",{type:"FeatureCollection",features:[{type:"Feature",id:"429004",properties:{name:"仙桃市",cp:[113.423583,30.361438],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@@@B@@@A@@A@@AB@@@@@@B@@@@@"],["@@@@@@@A@@A@@@@@@DB@@A"],["@@EECCAAA@A@@B@B@@@B@@F@BBBDBD@@D@@@@A@A"],["@@@@@CAB@@@BB@"],["@@DAH@B@FBFBBAD@BCDGDEBK@IAEBC@@DCBEBE@A@@BQ@AAAAAQOOMSOKICAC@EA@@ABA@@BA@AAAA@@C@A@@@AABA@A@A@AA@@AGBAA@@A@@C@A@AA@CAA@EBCBC@ABCBABCH@BA@C@A@ABABABA@CBIDA@A@A@A@CEAA@A@AB@@AAA@@BABABC@A@CA@@AA@EAA@@BA@E@GAC@AACAAAAA@CAC@C@C@CCI@ACA@ACCAAC@KAC@EAA@ABABA@A@A@C@AAAAACA@CG@AA@IEC@AACAC@EBEBGDCBEDABABCBA@C@MESAGAKCC@A@CB@@A@GCC@K@E@A@CAC@EAECCACAE@CAE@G@AAIAECCAC@@@AAABA@A@@@CA@BC@GDCBC@AAC@AAAAAAA@@AC@C@EBC@CBCBABC@EFCDAD@B@D@DBF@@BBB@@BABAFABABABCBEBA@ABABABABCBABABEB@@ABEFCBABMFAB@BA@@B@F@B@B@BADABABA@@BADABCD@BABABAD@@@DB@@BB@ABCDABIHEDIBGDKDADCDGHCDEBQ@K@@@GDIHEDGDKFEDCBCDCDCFA@ABQHC@E@EAE@G@@@KBIBE@EACCCC@C@CACCGCECECCCEAAGEGCGEICEAKAQCOAOAQ@O@WBE@IEE@G@CBIDEDE@E@CBCBC@C@GAICEAC@GAC@@AA@CAEAE@G@QEKAKAGACAAAE@OBE@C@C@CD@@A@EFCDEBGBGBEAGAEAEACAEAE@C@EBEBONGFEDGDAB@BCDO^CFEDEDA@GFEBG@GAEAAB@BAFABABA@A@CACACBABAD@H@@AD@FADADAFDD@D@BCDC@E@ACAA@CEA@J@DCJAD@DAB@BBF@B@DBD@BBF@D@D@DBD@D@FBHDBF@BD@DAHCDCFAH@FBDBFBDBFCFADADAD@BGDCDABEBG@IDABEB@@@@CFCF@@CLAHAH@BBJDF@BHFDD@DEFEFKHEHGH@@ADEHCFAHCJBFDFDBDFDFFHDHBDBDBFDBHDBBBB@@DFDBBB@D@D@@CD@B@BD@F@FB@D@D@BADADCDA@GFCBCD@BBDBDFBDDBBBB@H@DB@BBLBB@B@BB@F@B@DAD@BEHA@@BA@@ACAAAC@A@@B@@@BA@A@CAC@A@@@ACA@@@A@@@@@A@CHCDCBABA@A@CCAAACAAC@A@CBABA@C@GD@@ABAB@BDDD@BF@BABCDEDAD@B@@CJAH@F@F@@@HDFDHHHFDDCJCJBHBHB@@FBBB^HJ@D@NBF@@@@@PADAH@TIHELIFAHELCHAHBL@FBFADCBA@C@CAEAA@@CG@ACIAACG@I@@@A@ABEFEHAN@LDB@NHFDDDDB@@B@BBFBB@BBP@LCDCBE@EAACGAGBC@ABEBAHGFI@AB@BGHCNEHIHKHAB@FBHHBB@@DFBBBFFFBBFDHAJAFCDAFEBCBCBC@A@CBCBGHEJAB@PDNBLBP@JBJ@D@F@H@B@DBV@B@H@F@L@B@N@FALAB@BAB@JAHCB@DA@@FARABBDBB@B@JDDBPBHAB@NAB@DAB@B@BAFABAFCBABA@@DA@@NIF@JAH@D@HCBABABCDAPKPCDBDBFBPAJCDCDEJMB@@A@@@@DAFADAH@FBDBBH@NBBBBDBJ@LEBAFEJIFAFAJBBBDD@HAJBJFNLLDDFDNFLBF@D@HDHFBHBBBZ@FDLJJJJ@BBDFJHPJFFDD@RBFBRPDBDCBCBIBCDAF@DABABCD@FFDDBBFHDBFADCBCBCBC@CBC@EFCFGBAAECICAACAEAA@@@A@EAGAE@AAE@G@A@AAAEECEHCXAB@N@B@N@BAJAJCHIFIBCDCDCDCBAFEB@BADAFAJ@F@H@F@F@F@FAAC@@ECAC@C@ABAFCH@JCD@DADBD@FAD@DAHADBBB@BJBNADA@C@@CGEEEGAC@EBA@A@@@AEEAAAADGDG@@@A@@BC@ADCBAFADADC@@BADADADCBA@CCAACA@@C@CBC@@@@@A@ABEBCBABC@@@A@@@AA@AA@@@A@CA@@@@@A@@@@A@@@A@A@@B@@@@B@@@B@@BA@C@ABCBEDABCB@@@@@BAH@DAD@DBD@BBD@DBDBD@DA@@BE@E@@ACCCAAACACACACAGAE@C@E@EACAAAAC@CAC@CAMCAC@@@@AAACCAAAAAACAC@A@A@ABCDADABABABCBC@ABC@@@AHCDAHADAFADAFABAB@PEFC"]],encodeOffsets:[[[115691,31203]],[[115693,31148]],[[115882,31168]],[[116493,31026]],[[116530,30929]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("咸宁",{type:"FeatureCollection",features:[{type:"Feature",id:"421202",properties:{name:"咸安区",cp:[114.298711,29.852891],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@CBALABC@GBK@CD@F@BABCHAF@JFL@D@DBDBFBHBD@BABCD@B@BABA@AACAA@ABAD@BCDC@CBEAE@GCGACAGAMAG@GAE@A@AB@D@BA@OFC@EBC@ABADC@@B@DAD@D@BBBDBDD@@@D@BAF@H@B@BTFB@BB@B@F@F@@@@AB@BAB@@AB@@@D@B@BBB@@FB@@B@@@@B@B@@@BBB@@BBAB@B@BAB@@AB@D@B@B@BBH@B@B@B@BADAD@@@B@B@B@@ABABADABABCDADA@@BA@A@@BA@@@@@@B@@@@FFDFB@@@BD@@@@B@BBBB@B@@@B@@@B@B@BAB@BA@ABA@AB@@@B@@@BB@@@B@@B@@@B@@B@@@@@BBB@B@@AB@B@@@B@@@@@@A@@@@@@B@@BBB@@@@@B@B@@@@@BBB@@@@@B@@CB@@@@BB@@@BA@C@A@@@AB@@BBBBBB@B@@A@@@@B@@@@BBBB@@@@@B@@C@@B@@BBBB@@@@BAB@B@@B@@@@@BABEB@@@@@@@B@@BB@@BBABBD@@@BBBA@@BA@CB@@@B@@@BB@AB@BABA@A@A@AA@@AA@@ABBN@BABABA@CBCBGFABCBIFADAD@F@H@NAF@R@BCBGBKJAHIPADEJCRCDEBCHAFAF@FVBZ@RAFAD@DBDDFDF@FAJEHAFBJD\\ZJNLV@@HDFBlKBAB@BAB@B@B@JBF@D@DB@@B@DBF@F@B@HBHBFBH@DB@@B@@@FBB@DBB@F@B@DBHBF@FFHDBBB@PDD@HD@@FBHH@@FFDDDDF@D@DAFAD@B@HDDDB@F@BBBDD@B@B@FC@@FAHCFAF@BAHAH@F@F@FAB@DAHAHAHEHABAFA@@DABA@@BABAFCB@@@FABALCF@H@D@D@B@DADAHABA@@BAACDA@@@AB@BA@@B@@@B@@@@@B@@@@@@@B@@@@@@AB@B@@@@@B@B@B@@@@@B@@AB@DA@ADADA@@@@BAA@B@@@@AB@B@@@@BB@AB@@@@AB@@@@@B@@B@B@DBB@B@B@BB@@@@B@@@B@BAB@B@BAD@B@B@@@@@B@@@@A@@@@@@@A@@@@AA@@@AA@@@@@@A@@@C@@BA@@B@B@B@B@B@B@@@B@@@@A@@@@@A@@@@@@@A@@B@@@@A@B@@B@@@@@BA@@@@B@@@FCB@BABC@@B@B@@@@@BBB@@@@@B@B@B@B@D@@@B@BADG@@BA@@@@B@@@B@@@B@@A@@B@@@AA@@@A@@@@DEFAHAL@B@DEHK@A@ACAGAG@EACEAEACDAB@ZJDBBBFAFCAC@C@CBADAFAF@NDNBNB@BFBD@BAB@HAFAFE@E@EACAABA@@FATGD@ZGPCPEJ@D@D@NAD@BABGBCB@FAB@B@H@DADCB@F@JEZGD@FBFDB@DADAF@H@BB@OAA@ACCAC@C@EAIAIACBGAAAGCGAC@GAKABKBKDKACB@BC@CAKCEAGBMDEBK@E@AAAAE_@@E@AAAAAKAC@E@IAECCGAEAW@E@CACBC@EBM@K@OAC@AC@C@A@CBIFEFAF@BAFGJGFG@C@CGEGEEEI@IAGACCICOCECICIAMBKBE@EA_WA@CCKEUQGGCCACAE@GACA@E@KBC@A@GCOISECAQEG@E@AA[@CACCACBG@A@CA@EAACGMCEAEGKGCECE@C@CDABGBC@GAQIC@EBA@AB@\\BFBBFBDDBD@BAFCBC@EAcMSKEEG@GAGBC@ADABE@CACCKQC@EAI@G@KCK@G@ABCDCBIAK@_B"],encodeOffsets:[[116993,30373]]}},{type:"Feature",id:"421222",properties:{name:"通城县",cp:[113.816966,29.245269],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@E@CAC@ECCCCACAEACAA@@@GCAE@ACCCAGBE@C@EAE@A@CBCBCBIBC@GD@BADBB@BBDAFCFAD@FDHBDDDBDDB@DC@EACBI@C@CAC@ECCCCAEAC@C@C@A@ABADCDCBCBCBC@CBED@DBFDBBH@FBD@BBB@B@DADEFCDADCFABCBEBC@CBE@C@EAGCIAGAGCCAA@A@AB@@ABGCCACCC@EBEAECCCCA@C@C@A@@AIAAAACEECCECCACCCCECCEAE@CBABABA@@BABCDAFADBDADAB@@CDABCL@@@HBFDFBDDJBD@BADCBE@CAC@ECCEECA@GGACAE@EAEAAEACCCAEAE@E@CBED@DAD@DBFBB@DBDBL@B@HBD@F@DBFCF@@EHA@AFBF@D@D@@CBEBGBGDCFADAF@B@BBFABBDBD@B@BABCBEBAB@@AJAB@DBDDDDFBBFDDDDDFBD@B@BDDDDDBB@BBBBDDDBBBBDJ@F@FAH@HAB@FBF@D@DEJCBGBCBC@CAC@AA@@EAEBC@C@CAAA@C@CAACAA@CBA@A@@@C@ABABCDABCB@B@BCFADABCD@@ADAD@@@FADCBCBA@@@CAC@C@KBE@CAA@IBCBAB@B@@@B@D@D@B@D@D@D@DDBBBBBFB@@@DDHDJDJDFJTBLB@BF@BDFBFBFDDJFFFHDFBB@HBFBDBBBFL@DBB@DBD@B@B@@ADCD@B@D@D@F@D@DAB@D@@ADBDDHBDDFBDFFDDDDDBF@DBFBDBD@D@DBD@BAF@D@B@B@JBHBFAD@FC@@BAD@DADBBBBBDBDBBBJIBE@CFENIHCPMBEBADAHCFAJCLGDGBGAMAGBEBINEDCB@FEFEDGDO@IBGDEF@H@HDD@H@LAJA@@DAXEB@B@LEXCH@FD@@HFDFBBHJBJ@D@PBD@DFDH@JDJBDDBFEL@FDFFBDB@B@BABEDIBGDEDCD@JDF@@BDJFLBRBHA@@FCBAACDADADAB@FBHDHHF@BBB@BB@B@F@HDDLFJ@DAJAJ@H@DBNFDBD@DAFCF@F@FDDBHBHBHAFAFADABEBCBAF@DBDABADCB@B@DBHDBDDDDBD@DCNSBEBE@EACAAGG@C@A@ALGDEBAAAA@ECE@C@GBC@IAIGCAQGEAECAAAA@C@GAACGAA@ABABABC@CCGAA@K@GBADADADCFIDEBA@@D@NDH@F@D@BABC@C@IAI@C@A@C@CCCAC@EFYBEDODGFEFCFCFAFABCDOIAAAAA@GBI@@AEAIGK@@ACECAAICA@CACCAEAI@ICEEEA@GCGEIEGA@@GAIEIGCC@@GEEAKCCACMEIEIEIEE@@EBE@IAECGCCEAEAC@EAI@I@E@GBE@GAAEK@CCAAACCAE@A"],encodeOffsets:[[116677,29745]]}},{type:"Feature",id:"421221",properties:{name:"嘉鱼县",cp:[113.939271,29.970676],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@ZVHGAACCCCECQ@"],["@@ZH\\JzRrPDBRJnVNLHLDNDJAD@HCHEFCDeRUJufk`@@UP@@CDKHWROJODG@CBI@CBA@GACAKACCSG_MC@YEOAMBK@GDED@@EH@F@B@DHTLRHJHJHL@BDD@@BDBBPZDFHNHJJPDBLLHFLJrNLBRAJAF@BATKXQB@BALIFCJINULUBCDKBA@ABC@@FK@@BEFALGHA@CAA@CCEAA@@EBC@A@AA@AAA@C@CBC@ABC@CDCD@HBDBDBBDBD@@DBDDF@B@B@@@D@B@DBFB@@F@HBD@B@DA@@HAFADAB@FA@@R@FAB@LIDADCFK@A@EDEDEFIDEB@@@BEDADEFGDCBCBABAFABCDCBEDCBADEBG@C@ABI@C@AACACAC@AAEFAJCB@BCDCBG@G@EBE@CBCDCF@LALALA@@D@FABABCBC@EBC@EBE@C@E@CAE@@@C@EAC@C@E@CBCBCBEB@BCFEFCDCFEBCBGBC@EAA@C@EAC@C@IEAGC@@KUIM[YICEAGBIFEBE@ECCCCAC@EBQBY@UAI\\CBCDIBKBWBKAICGCCIAA@CGgE[GI@AEKCGA@CGAGHW@EACAAEACACBEBABMJCDC@AAKECAAABC@A@ACAC@EECAC@EBA@@AAAACAACACAC@GBUHGFCBC@@@CACC@@ACAI@EBCBEBCBCAC@ACAECGAS@E@CBCDCDCBCBE@EAECEEECGCCEAC@G@CDADCFADCFEDG@C@CA@EAE@CA@ABCJI@AAAAACAC@EBGDCBA@AACCAAACA@CAECECA@C@ECEEEEAACCECICCAC@E@EBC@EAICGCGACA@@@C@C@AA@GAIAC@E@@@A@AB@DAH@DAB@@C@E@AACCCAAGAAAAC@I@C@CDAFCDCBGFCDADADAN@BABA@ABEBE@EAM@A@ABAD@BA@A@AA@@CACCC@CAC@E@CCEAGMAACAABEBEDEDEHGH@@QPCH@B@H@BADABEBGBKDEBIFEDIDC@KFEDCDAFAHAJAHCHAF@BBBDHBDAD@DABGHAB@BAB@DBB@BDBJDDDBBBDCRjJJBJANALAVAJ@XANBDfDTFLDXLJJDDBBDFDDLNLP@@NRFHDF\\fDBPVHHR@ACGI@AEEAC@ADAD@BBDBXPBBDBDDDD@@JHFDBBPJHDBBDBB@@BA@@BEAICA@KAAAIAECC@EAAAEC@@GH^PXFPDbJVHBB"]],encodeOffsets:[[[116624,30733]],[[116701,30767]]]}},{type:"Feature",id:"421223",properties:{name:"崇阳县",cp:[114.039523,29.556688],childNum:1},geometry:{type:"Polygon",coordinates:["@@DCFCHCHAHAJAF@BAFEBALCVKLEHEFGHUDCXYBAJKFIBIBI@A@IGIKMGEIEKCG@ICKGCFCBC@C@WCAOEEEAAECAE@CDE@ABS@CBC@C@A@@AASO@EAGBCPIBCBE@CECIK@G@O@AAAC@EAAA@ABCDMHKBQ@@AGAECAGAIAEBIDEDE@GAIGMGGCCAGAI@E@C@ECGEIGAAC@C@CBEBCBG@MAGAKCK@@@cCA@E@C@A@EBE@I@A@EAGAE@GBE@CCKAA@MCMACPADEBEBEDEDEFCHCPAFEZ@FBDDD@D@D@B@DBJ@J@DADABC@E@G@MCC@@@ABCFEJCDCBCBAB@H@LBBDH@DADABAB@BBBDHBB@H@DBBBBFDFBRHDBJHJBD@HAD@F@FDB@BBABCFKH@B@B@DHHBBBD@FAFAFMTCDC@CACCACGCCAA@A@CDABCBCAE@ABADAFCBEBEBGBGAGACAECE@E@EDCBC@CAMECAG@I@IBCBI@KECC@G@E@AAAA@AAE@GGGCEAA@CBCBCBBDABED@@GBQAKAIEAC@@CE@IDCFCHCJAFCBA@A@ACAEACE@EFKAECCIAICG@EC@CAC@O@CAIGIAACEGE@@ECG@WDKFA@A@WFCB@@IBKBG@C@GCG@E@CFAH@JCPCHEFEFA@CDMFAJAFBHBNAHCHKHIDEBGDCBABAFONGDMJEF@DAFIJ@@@BBDBD@DBDBDDFHH@D@B@DIP@@@B@@DD@@DDBBBDDDJDDDDBBDBB@H@BABA@@BA@ADA@@B@B@BABADA@CB@BABADABABAB@@@BB@BB@B@@BBDBFFBBBDFF@D@B@DCHCFAD@D@BDBNLFBBBFBHDDBBF@J@J@HAD@F@D@DBBDBLDNDNBL@NBVD`F^@FBBDBDCFGPABGFCD@DABBH@DCF@B@@AD@BAF@F@DDDFBB@LDH@D@FDNLJFJDFDBBB@B@H@FAFBDBF@HAF@D@HBFBHAHAHCD@D@FBDBFDHFVDBDFHDTFNDFDBDBJ@JBHBHFJHDDD@DAB@D@D@B@@E@EBAFGJKBAJAB@BCDAB@B@FHB@@BB@B@FAFCDAD@JDLBRBF@B@BAFGBADAFBRBFBDBDBH@DBB@FFDBFDPFB@FBLBHBHBN@R@D@JAVAVCD@FBDDBB@BAD@D@FJPDBD@FAFARBRBHBFBD@H@F@LBNBDCL@HAD@BABKDAD@`AL@JBDADCBAH@L@LDH@J@FBD@LRDDDBF@BABCD@HAHBH@FFTLEq@KEICICEACEEAA@AEGGGIGIEA@GEECCEGEEECK@GG]CGAEIKIIIIEGAI@MBKBK@IAK@CCCAAECiKECGG@EBCD@VCBAJMDMBE"],encodeOffsets:[[117010,30179]]}},{type:"Feature",id:"421224",properties:{name:"通山县",cp:[114.482622,29.606372],childNum:1},geometry:{type:"Polygon",coordinates:["@@UI@@cCCDABCBG@I@I@UAMEEBG@A@ABCD@DABBB@FBB@@A@@@C@C@AA@@ABMH@@@BDBDBFBB@@BA@@BCBABADA@A@AAA@AA@AAC@EA@A@A@CACAGC@@EBE@K@KAYAC@ME]UA@CAE@IFGAO@E@A@GDGDMTGLEJGTGFEBC@LHJDH@LDJFHFLNHJ@J@BAJAJEJILABWZCDGVEHGFKFULKDABEFABE@IBGBGBGDEDCDAFCNINABUDC@AD@FHHFDjLFDBBDD@DBL@JALAL@NBJFHJJJJJLBFDHH^@HDLFFHFDFFDHFB@JFJHHHFH@BBBFFBDDFDJFJ@LFrdNFBD@DABE@AACCCEAAAAE@[BAB@FAD@RJHBD@HABADCD@F@FDHDHLBFDFHNBDFBB@@D@BAHBDDDDB\\@BBF@H@RFDBTFPJHDB@D@LAF@B@BD@HBFBDDDHHVRLFDDB@`XFBF@LANAJBJDFDPDJDDDHBJBJ@FFHFHF@D@DEHIHEHABE@EBEFAJ@D@B@DBDD@PBL@N@FAD@DADBF@X@FBHBDDBF@J@FBDBLBBBBF@@@F`BBBBF@L@FANCHAFBLDDBD@@ADALBLCLABABADAHAJ@P@D@BA@GBOAMAKACBEDGBE@EEIEG@IAKAIIKAE@EBCBCFEHGRKFEDA@BHBDBBBH@DAF@DADA@CB@B@B@D@B@B@D@B@FAB@D@BCBA@@B@B@B@F@B@@@@@@B@DB@B@B@@@B@BAL@F@FBD@J@B@DAFABAH@H@D@DADAB@BAFGFI@G@A@C@A@ADC@ADG@CAI@ABA@AD@PAXCFA@@BEBCBEB@DE@ABABAFG@@DEBC@W@CDIFGDCHGBABCAGAKCI@C@C@EBIBCFCHILKBECG@GBEFAD@D@D@L@HAJAH@FA@EAE@ECSDCFADAFGHAJ@HCEMGECAAG@O@CBCBCD@HAXCDABCAEEGCAAKCEECC@ECGACA@@EAA@@@CAAAABABC@A@AB@B@@@BAB@@A@C@C@A@@@AA@@@@A@ADAB@@@B@B@DBFBDBF@DBD@BAD@BAB@@A@C@@AA@@ACE@CAABC@AAAAAA@EA@@CAC@C@C@@@A@@BCAAAA@A@@@@@@@BB@BBBBB@B@@AB@BBBBB@@@B@BB@@BBB@@D@@BB@AB@BABABAFAD@@EBEAAC@CAEAEAECCAGAKGMAEOECAAECEACGEECEAAAAABADAB@@C@AB@DBDBF@DAFENs@CBEBCF@DBPNLHJ@LFDDDDAHEDIBEBCFAFADDFNHDDJHJDJB@@HBH@DE@AB@@@@A@ACE@@@@CE@@@A@@@A@AB@@@@AB@BA@@@A@@@AA@@A@@A@@@@@@@A@@A@@@@B@@@D@@@B@@@B@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@BB@@@@@@@@@@BA@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@A@@@@@@@@A@@A@@@A@@@@A@@@A@@@@@@@@@@@A@@@@@@B@@@@A@@@@@@@@A@@@@@@@@@@AIACCCCAEGEE@@CBA@@@A@@A@@BA@@@C@A@@@AB@@AA@@ABAB@BCBA@@@A@AAC@A@A@C@C@A@A@@@AAACCAA@A@A@@A@A@C@EDABCBE@CBA@AA@@@@@A@@@@@A@AA@AA@@@@@@A@A@@AA@@@@@@AA@@A@@@@@A@@@A@@@@A@@@AA@A@@@@@@@AB@@@@@@A@@AA@@@@@@@@@A@@A@@@A@@A@A@@A@@@@A@@@@AA@@@@@AA@@@@@@AA@@@A@@@@@AA@@@A@@@A@@@@A@A@@@AAA@@@@@A@@@AA@@A@@@A@A@@@@@A@@@@A@@A@@A@@@@A@KCAFBF@DCFCDGBE@IAKCMAIAMCKACCCAEACCAAECGEECGAEAEBUFMBSEKIIMEMACWBGBCBCB@HBXAHILEDGBSDQ@GCGAKCG@G@KDIDI@ICOAIAOESGaIECECCCCACCCCACCCGEEE@@EEECCCA@GCIAIAIAKCOCIEGGKCWI"],encodeOffsets:[[117280,30035]]}},{type:"Feature",id:"421281",properties:{name:"赤壁市",cp:[113.90038,29.725184],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CBCDEDG@C@A@CEEACAAEECAAAC@@@CDA@EBA@A@@@EBA@@BA@@@@@A@A@A@@@A@@@AB@@@@@BA@@@A@@@@B@@AB@@@@@B@@@@A@@@AB@@@@BB@@A@@@A@A@@@A@@BA@@@@B@@@BAA@@@BA@B@@@AB@@A@@@A@@@@@ABB@AB@@A@A@@@A@@@@B@B@@AB@@A@@@A@@@@BA@@@A@@B@@@BA@@BA@@B@BA@AB@BABAB@@@@@BB@@@A@@@@B@@@@@B@@@B@@@BA@@BEBCB@@@B@@A@@B@B@@@@@@@@A@AB@@A@@@AB@@A@@BA@@@AA@@@BA@A@@BA@AB@AA@@@AA@@CAAA@@A@@@@B@@@B@@@@A@@@A@AAA@@@AAA@A@A@A@AB@@A@@@@A@@@@@AA@@@@@A@@@@@A@AB@@A@@BA@@@@@AA@@@@@A@@@@@@@@@@@@A@@@EAA@@@A@A@C@@@E@@@A@@@CBA@A@@@A@@BA@@@A@@@A@@B@@@BA@BB@@@@@B@@@B@@@@@BA@@B@@A@A@@@@B@@@@@B@B@B@D@@@@@@@BB@@@BA@@@BB@@@@@@@@@B@B@@BB@@@BBB@B@@@BBB@BB@@BB@@@@B@B@@@@@BBB@@@BBH@@@B@@@B@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@B@@@@@@@BB@@@@@B@@@@@B@@@@@B@@@@@B@BBBAB@@@B@B@B@@@B@DA@@B@@@@@B@@@@BB@BBB@B@@@B@B@B@BBFB@@@B@@@@@@A@@B@@@@@@@@@BA@@@@@@B@@@@@B@@@@@B@@@B@@@@BB@@BB@@@@@@B@@@B@@@@@B@@@B@@AB@@@@@BB@@B@@@BA@@@@BB@@@@@@B@@AB@@B@@@@@B@@DBB@B@@@@@@BB@@@B@@@@BB@@B@@@BB@@BB@@@B@@@@@@@@A@AB@@@BAB@@@B@@@DB@@BBB@@B@@B@@B@BA@@@@B@@B@@BB@@@@@ABAB@@@B@@BBBB@BBB@@@@@B@@ABA@@@@B@@B@@B@@DBB@B@@@D@@BB@D@@@B@@@B@@BB@@@@B@@@@@BD@BBB@@@B@@@BA@@@@@@B@BB@@B@B@@@B@@@DBB@@@@B@@@BB@@@@@@BB@@@@B@D@B@BAB@@@@@@@B@@B@@BBA@@BB@@@@@B@B@BBBA@@@ADA@AB@@@@A@A@A@@AA@@@A@@B@@A@@BABABAB@@AB@B@BA@@BAB@B@@AB@@AF@@AB@B@BAB@@A@A@@@A@@@A@AAA@A@A@@@A@A@A@@@AAAA@@@@A@@@@@@BAB@@AB@BA@@B@@@B@@BB@@@@@BA@@BAB@@A@@@@B@@@BB@@D@@@B@B@BA@@@@BA@@@@@BB@@@@BB@@B@@@AB@@@@A@@B@@@@@B@@@@@BB@@@@B@BA@@@@@A@@@CA@@A@@@A@@@@@A@A@AB@@A@@@@@@@AAA@@@@@@B@@@@BB@@@BB@@B@@BB@@@@A@A@A@@@A@A@@@@@AB@@AB@@AB@@A@AA@@@@A@@A@@AACA@ACAA@@@A@A@@@ABA@ABA@AB@@A@@B@B@@@BB@@@D@@@B@@@D@B@@@@@B@@@B@@@@BB@AB@BABABABABABA@@BAB@@@B@BAD@FABADAB@BCB@@@@@@@BBFBD@BABABAB@BABABAB@@AD@BBBB@@B@B@BA@A@A@@@A@CAAAA@@@CBA@A@A@A@@AAA@ACAACAAA@@A@@@AB@B@@A@@@AA@A@E@A@AAAAAAA@A@A@ABA@C@A@@AAB@@A@@@A@@@C@A@C@CAA@C@ABA@ABAAA@A@AAACAAE@@BC@ABAB@@ABABE@A@WFQBOCG@KBUBQR@BD^HLHJTbDL@DBH@LAHCHCFADAB@D@BBBBB@@ABCDCB@@@BBBDFBDBDDDBF@DB@BBBBBBB@D@BB@@B@@@@B@@CBCBABA@@B@@@BDFBFBB@BD@@BBB@BBB@BDBBBD@B@@B@B@@CBA@A@A@CAA@A@ABAD@BB@BBB@B@B@DB@@B@@BDB@@BBB@BB@B@B@BAD@@AB@BBBBBBB@BBBBBBB@B@@@B@@ABCFEFADABABAB@FHDVLLFBBFBDDJFFF@@\\\\@BFFRRHJJHJDJD\\NNFDQACAACCICCA@AAA@CBA@ABAHGBA@CBCACCGAA@ABEDGBGBIBGBEDCFCLED@JCFCJEFALCHAFABABC@A@G@ADGRO@@HGFGFCFCFABADBBBHNFBDDF@D@DBD@DDDB@@BBB@B@@ABCBAB@N@FBF@FABAB@BA@ABMBCBCDCHEDADCBEDCD@J@D@BBBBBHDBDDBBF@D@@@BA@CBG@CBAB@@@F@D@JBHBB@@B@D@D@@DBHBHDJDFBD@FAF@D@DBJDFDDDBBFFFFFDD@B@FDFDDBB@BDBBDDBBB@DAHCFAD@DBBBBB@BIJAD@BDBF@FBB@@D@DCHEFCDEBCDCB@D@HBDDFHDFDFFFDFBF@DADADCDCDAF@T@HBFDDB@BBDADADAFAD@FBJBD@@DDDB@@D@DAHEVGHAD@DBDBBBBDBB@BB@FAD@DBFFD@DB@B@BADBBDBLFBBD@DCNIBAFADADBFBBBBD@FGXBHDHB@DHFL@BHJF\\Hh@DBBDJHDJDLBXALAJADCDAJ[@EBEBEDGFADCDQFIBCJOBGLIHADA@A@QBE@M@G@EBCBCJEDABAHEDADAB@BABA@AAMBA@@BB@@BBB@B@B@BA@ABAA@@A@@@A@@DAB@@AB@AA@A@@ACBAAA@@AA@@@A@@@@@@FABA@A@@@@@AA@A@AB@@@@AAAA@@@AD@@@@A@@@@AAAA@@@@@A@@B@@@@AAAAAAA@@BA@@B@D@B@@A@@AA@@@@DA@@@A@@@@AA@A@@@@@A@A@@@@AA@AA@@@@@@@@B@@@@A@@@A@A@@BA@A@AA@@@@A@@@@A@@@AA@@@A@@A@@@A@@BAB@BAB@@ABA@A@A@A@@@A@@@AAAAAA@@@@@AC@@A@CEEE@@@@@A@@@@B@@AB@B@@AB@BCDCBABABCBABA@@@A@A@A@@BCBC@A@A@A@AAG@A@A@A@CBA@@BA@A@ABAAA@@AA@A@@@A@A@@A@@@EA@@AA@A@A@C@@BA@@BA@ABA@@@@@E@E@AAAA@SE@A@A@GBE@A@C@@CCCAAA@A@CBC@C@AD@BCBAD@FAD@PEB@@A@CBAB@F@HBH@NBHBDBHBHDF@FBDAD@DC@ABCBAB@DBBBB@BA@A@ADCBA@AACAGAEAC@C@CEK@IBEDGBA@A@EMAKAE@G@C@EAGAQAQAEBEBC@CAIO@E@CBC@AAACCEAC@UDUBIBC@Q@M@GAGAKAEAA@OEECCAEEA@CAG@CACAEAQAEACBABEHABA@E@QAKAICC@CBEDEBA@A@@AA@EGA@A@CBADA@IBABILEHAB@F@FA@C@C@A@CBC@CCIGGEGAIAI@CACACEEMCSEGACUCGEECCAEAC@C@GDGBGBEAGAC@E@GBE@CAEAEBG@A@A@AAECICIEMKECC@G@KCA@EACC@C@EBE@ABC@@@ADE@CAGBA@CDCHEBAHODEACACEA]@_EUCMAK@MAMCKCCAAA@C@C@EBC@G@I@IAECAGCEAAAEAMKCA@A"],encodeOffsets:[[116479,30203]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("孝感",{type:"FeatureCollection",features:[{type:"Feature",id:"420902",properties:{name:"孝南区",cp:[113.910705,30.916812],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@BBA@@@A@A@AA@@AABA@@@@@@@@BBB@@BB"],["@@@AACAAAACBA@CBAF@B@@ABCD@@AD@HAB@DA@GFCBC@ADCHIJCDC@@@C@C@C@CBA@IBA@IDIDCB@D@LJ@D@HDFBF@F@DD@B@B@BEBC@ICGCC@CBEB@D@BBDJD@BBBCBE@IAGBCBAH@FBF@FFFBBB@FBDBFAD@BAB@DB@B@B@D@H@DBB@B@BABADABOBE@A@A@AB@@AB@DADEDAB@F@DBB@@B@FAB@B@DBB@B@B@@B@B@@ABBB@@DBBB@BBB@F@P@@@BABAB@@CBABCDEFE@G@CACACCCCA@A@@AC@EBE@ABAB@@BBFBB@BB@B@DAF@D@D@BB@BBFBB@H@LFDDBJ@B@B@D@DAF@FBDB@FDD@@BDD@B@@@BC@@@@@@BBBB@@B@@@@CB@@AA@@AA@@@@A@@@@B@@A@@B@@ABB@@@BBB@@B@@@@AB@BA@@B@@A@@@@@AA@@B@@AB@@A@@@@@AEBC@@@A@ABA@A@AB@BABA@E@@@@B@@@BB@@@D@@@B@@B@@@BB@@@D@D@@@@@@B@BAB@@A@@@A@@@@AAB@@A@@BBB@B@B@BAB@B@@@@@B@@ABA@ABAB@@BB@@D@@B@@ABA@ABEB@B@@@D@H@L@D@F@D@@@BCBCBCDCJCNBFDJ@@DJFHDHALCL@@A@ADAD@BAHABCBFBHBF@LDBBBBDD@FADCDAF@@ADBDB@B@BBD@DB@ADABCD@H@@@D@DBD@BD@BDDDBD@D@D@F@BBFBAF@D@F@F@D@FAF@FBFBBBBHAFADBBBBDFBDBDDFDHPDDBB@B@D@FB@DBD@FBDBBDBBDF@BDLBBB@BB@@DABCBCBABABAA@DCBCBAA@@@AAA@BA@@B@AA@AB@B@BA@B@BB@B@BBB@D@D@D@F@DBFBB@D@FBFBF@F@@@@AAAAEB@BABAD@B@BA@@FBDA@C@E@C@E@E@E@C@E@E@CBE@C@EAECCCAEC@A@ABCBC@C@EAC@C@G@CAECCECACCCAA@C@C@EBC@A@CBE@CBEBC@CDEBCDADA@@@@B@F@DDFDFBD@DAFBHHHJFLFFFJDFDFFBDADCBCHCDAB@JDJH@@HDD@D@BIDGBE@CBE@G@CACBCBAFBDB@AB@B@@BB@@@@B@@B@@B@BFDD@@@HBHBFBD@DBHAD@FCDADBFB@@@BBDDFD@FF@FBD@BBB@B@BBB@B@B@B@D@BABC@AB@B@B@BBFDDDDB@BDDHFFDDF@FAJEBAD@@AJAHAHBB@BBFBH@HEB@FGDE@@@AB@BAHGB@B@@@@AJCHAHA@@@A@@@A@A@@@A@AB@B@@A@CA@@A@@EAAAAA@A@A@CBA@AA@AAA@C@ABA@A@@AAA@A@A@AAA@AA@A@A@A@CBE@A@A@@A@A@A@A@AB@@AB@@A@@@CAA@AECA@AAA@AAACCE@@@A@ABA@@AA@@@ABC@AB@@@F@DAD@@AB@@@@ABC@ABABA@@@A@@@AC@@A@@AA@@AA@A@@@ABE@@@@BCBA@@@@@@@@BAB@@ABA@ADC@ABC@AAA@@DA@@@@B@@@@@B@BA@@@A@@AAEA@A@A@@B@@@B@BA@A@@AAAAC@A@@A@@@@BA@@BA@@BA@@BB@@BA@@@A@AAA@A@@CA@@A@@ABA@@@A@@@@@@A@A@AAA@@AAC@A@A@AA@@AA@C@AA@@AA@@@A@A@@A@A@A@A@@@@@A@@@AAA@@A@E@A@A@@AA@AB@@AD@@A@AB@BA@@@A@C@A@AAA@@AAA@@AA@@A@A@@@AB@@BB@B@@@@A@@@AAA@@@AB@B@@@@@AC@A@@B@@CAAAA@@@AA@@A@@BABA@A@ABA@@@A@@@@@@@@BA@AAA@@@ABA@@@A@AECA@B@@@B@@A@@@A@A@AB@@A@@BA@A@@AAC@@A@@@A@A@AB@BAF@@A@@@@@@AAAAAA@A@A@@A@@@E@@@@A@@@ADEBC@A@@B@FEB@@@BA@@@@@@AC@@@@@A@ABC@A@@@A@@@A@A@ABC@A@ABE@A@E@@BE@C@GBE@G@E@G@AB@PSBA@@@AAAAAAAAACWAIBMDOFMBCBG@EAG@GA@@A@A@@A@IDA@C@@@C@AA@A@A@AA@@@AAABABCDIFADEBEHA@C@C@G@K@A@C@@@CBIDC@A@A@A@AA@AACA@AA@@A@CBEFAB@@@B@B@BGDGDA@ODC@A@@@A@ABA@@@QB_BA@C@Q@OBK@MBEAGAAA@A@AA@@AC@CBC@@@GDEDCBA@E@CBC@C@E@CACACA@@CACAA@AAA@EAAAAAA@@@GF@@@@AA@AA@A@CB@ACAAACBKDE@G@A@CCAAG@C@@@AAAAAA@A@@ACA@AAGAAAA@AC"]],encodeOffsets:[[[116596,31623]],[[116662,31505]]]}},{type:"Feature",id:"420922",properties:{name:"大悟县",cp:[114.127022,31.561164],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@@@@@@A@@@@@@@@@A@@AA@@@@@AA@@A@@@@AA@@@@A@@A@@@@@B@@@@@@@B@@@@@BA@@@@@@@@@@B@@A@@@A@@B@@A@@@EBC@E@A@I@C@E@A@@BA@@@A@@@@@A@@BA@@@A@A@@@A@AB@@@@A@@@AAA@A@A@@@A@@AA@@@A@@ACA@@I@IBQ@EBEAGAE@A@ABAFBBABA@CAED@D@FCBE@C@GAIC@@GAECEAA@CFEHC@C@A@GD@BABEJABADGDEAG@CDAF@B@HAFEFGHEDABABEBCACAEAECCCGGECG@GCCDEFGDABAH@@@FABABCHEHEDCBG@K@GCEA@@@@C@AAKCCAKAC@CAG@KCA@EAC@IC@@EAA@CAIEMAKBMAKFKDIDGAIAKAMBGHGDEDCBGHEFA@A@CBGBE@GFAHAB@FBD@D@BAH@H@BAFCFGFEBEDGHCJCLAJJ@FFB@BBBBDFDFDJDHBHBF@J@DCDCDCDED@BCJBHBDDFFDFBFDBBHDFF@@BDAFADBHFDFBFBHDBB@@BB@@BBBFBDBDDBDBHBDJBF@@AFCBC@GBGAABC@EBCFCJ@FBF@BDLDHHPBH@@DHBDDFHHBFDHBDBHAF@DAH@J@HBH@HDFBBBFBHDFBBBFHJDH@BDHBHBB@JANCDABEBGBGDIJGJAB@@@@ABADCDEJ@H@@@HDHBFBBDD@BDFHHJF@DEHEDABEDCFABAF@FDBDFDBDDDFHFDDHDBB@B@@CDADADABABADE@CBA@AB@DBB@BBFBDBBBB@@BBHPBHD@BBBDBJ@F@DDFDDBF@D@NBDLLB@F@@@H@HCHCF@H@JBBBB@@D@D@BHJFDHBL@JA@@JAD@B@DCFEFCHAHCFCJIB@FC@AB@D@F@DBDDFJBBBBD@@C@CAE@CBKHI@@HIFEBC@EDCTSDCBGBGBC@@DCFABAB@BE@CB@@CHIJIJGFGBCBE@ADAD@D@DBDBFBFAF@FABADBHDBBD@F@HAJ@H@B@H@FBB@D@@@FADCFC`KXEDAF@FBFBDFDBDBDADAFALCDA@@BEBEBEBADAD@D@@@B@DHB@B@DAJGHADAF@D@BBBB@N@BB@BBBAFADEB@DADBDBBDFFB@DBBBD@B@HAJEBA@@FAD@F@PD@@NFDD@D@B@BKH@BAB@BBFFJ@BBDBFFBH@D@D@BADGBIDEDGFQ@ABAB@B@@@HBLFDD@@CH@DB@D@F@BB@@BD@FAFAH@B@BDBB@F@HAHCBAB@B@BBBBBBB@B@@@BCBEB@B@DBDD@@D@D@DADC@C@A@@DE@A@AA@CCAAACAG@AAC@E@ADIBCAAAAACAAAC@G@A@AFI@CAAA@AA@A@ABCBAF@JABABC@ACC@AAACGCC@@C@GBC@A@AAACBCAKAIACA[@AA@@@EAOECAACACACACECAA@CDCDI@CAGACCAAA@C@GBI@AACBC@@BGFO@EBQ@CFSBEBEEEAKCGCEECGCC@M@GBG@@@CDAD@HCBC@ACAE@CDIBCAGAACAAAECACAA@A@@AG@A@G@@GE@@CCCE@C@AAEBE@C@C@A@EDG@ADEDGBE@ABCBEBIBC@CBECGACGAEAGBEDCDCBEBCC@A@AACAEAIAE@@@@CG@AACACAG@A@E@E@C@CBG@IBIAECECAGAIBEFCDCDAD@@@@ABE@ECA@@EBE@GBGAE@A@AACCGACEACAE@EAA@CECG@ECE@ECECCAE@E@CBGFEFABAFEDC@@AACAACBC@ABA@C@@BA@ABEBC@A@@@C@C@A@A@@@A@@@A@ABC@@"],encodeOffsets:[[117190,32066]]}},{type:"Feature",id:"420921",properties:{name:"孝昌县",cp:[113.998009,31.258159],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBCBADCF@DADAF@DAF@D@BAD@F@D@DBBDDBDFDDDBF@D@H@DBD@F@DADAD@B@BFDDBDDBF@F@DAF@D@F@F@D@F@F@F@D@F@DCBEA@@ABA@C@ABABA@BFBB@B@@E@E@EAEAC@A@EACAE@C@C@C@A@AAA@A@@A@AABA@A@@BBBA@@@ABB@BB@@B@ABADCDB@ABABABADADCB@@AAA@AACK@ACEAAACCAEAC@CAA@@E@C@AAACCGOECCCCAEAACAACAEBGBAAAAAE@EBE@E@C@E@E@CBEEAAAE@C@C@C@CACC@AACC@CAC@@@G@C@ADCB@BCAC@AAA@A@ACBC@@BEDCBC@ECCAAAAKCE@GAEAABADAB@B@B@B@B@@@@@D@@CB@BABC@IB@@AB@BAB@@CB@B@@@DB@@B@@@B@@@BB@@B@BBBB@@AB@B@@B@B@BB@BD@@@B@D@@ABDBB@D@B@BB@BB@B@@@BB@@@B@B@BBD@B@B@BB@@@@B@DABADCHAB@DCDAFCBA@A@AB@@AB@@@B@BB@@BB@BBBDBD@D@BDBFBD@DBBF@DAD@DAB@BCDCBEBA@A@AEACCAC@CHADCH@D@B@BDDDHADCDBBHFD@B@@@BB@BBB@B@BABA@CBCBAF@FBDBDBBBBDBD@BAD@DADBD@B@BBB@BB@BB@@B@F@BAB@@ADCDCD@B@@A@@B@@@BAB@@IBAJBFBDBFB@ANBH@H@DA@@@EBCFABELCLFDJDHJHFFFF@HBDDFJHHBDFDHNFJ@BDHBB@H@DAJ@F@FAJAH@BAF@B@FBBDHDF@H@BCF@DBBFDDFBDHHDDBDDBFBF@BBD@D@BAB@BA@@B@B@D@BBB@@@DBBDD@BBDAHC@CAC@A@A@ADCDAB@DAB@B@@B@B@B@D@D@D@DBFBDFDDFBB@BDG@@B@B@BA@A@ABA@@HBB@@@DBB@F@@@BDDB@@BA@A@A@AB@@@FB@B@BABBBBBB@NFDDFDDB@@DCD@BAB@@@B@BB@@B@D@BAB@B@BF@DADG@@B@@@B@BBB@B@@@JAD@BA@@B@@AD@B@@@BDD@F@B@DAB@@BBDDB@D@DAJ@BABCAGFC@@LCLBNFFDHHDDBBDLBFHDDBBADBD@FGFAFCBAJEBIDKDIHGFCFAHEDEBE@A@GBG@A@CAC@EBABGHEF@HADAB@B@FEHGDAFCHCHGNALBJBHBJCLCLENBLANBJFDBB@FB@@JDD@FBB@LDH@DBD@LBDBLDBBD@@@@@FBHDL@H@DAFCFGDGBABA@E@@BGBAHCFEDCCCCAGAGAICAE@AACBE@G@E@@ACIAGEEGEGCGCEA@IAGBG@CCAEAGCCCG@GBC@AEEDCBEDE@A@CCAG@IBC@C@EAAACG@C@GBMFQFKJUFGD@FAFADEDEDEFABC@CAGBAB@HFD@DCD@DBFBDDFABADEDCB@FEDE@E@GB@BCDABCAECECICCAAIEAACCAEAA@CAACAECAE@CAECAGGIIEEOKGCCAEEICGCCCAA@A@@@A@A@ABABABA@A@@AA@A@@@ABADA@A@@@A@C@AAE@C@@AAAA@@AC@@@AB@@A@@@@BABA@A@@BAB@BA@@BAB@@A@A@@BA@@@AB@@@@@AA@@AAGBGBID@B@@A@A@GHABA@@B@@CFEHA@GFG@EAAAA@GAGBIB@BC@ABIFEBE@CCEECGACA@CCCCAE@A@A@ABAD@BA@A@C@A@A@AAA@A@AAA@AAC@EEEC@CEAC@A@@EACACBEDC@GBCAC@EAGAGA@@C@EC@A@AA@@@@A@@A@@AA@A@@BCAEAABADBD@D@HAF@DAFCHAJC@C@GC@@IGICA@CBGDADCDCBEACECEEIEEEKGIGGEACBC@EAECCCE@A@@@@@"],encodeOffsets:[[116719,31780]]}},{type:"Feature",id:"420923",properties:{name:"云梦县",cp:[113.753554,31.020983],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@A@A@AB@BFC"],["@@@A@AA@@DB@"],["@@AA@AA@@BA@@@@@@BC@@A@C@A@@F@B@@CBGAC@C@@AAAACAC@ABEPABABABC@C@CAEGCAI@C@GAE@EBGBCDAD@H@J@J@B@B@D@BAB@@A@CBC@ABABEFCDABA@ADADABCBCB@@AB@D@BABADEFCBABA@A@AA@AAAA@AAC@CBCB@BEFCB@BC@EBC@AB@BABCF@BAD@@@B@B@BBF@B@B@B@BADCDCDCF@BAD@B@B@DAFAF@B@@@B@@BBHFFBBB@@BBBB@B@F@B@DAD@BBB@BBB@@DBDDBBBBBDBBB@B@B@BADAB@BB@@B@BB@@BDBDBNKBCBCBCBABA@GBA@@@ABABCDA@ABE@C@EBABABAD@L@DA@@BADABAH@BABABA@@@EBA@C@G@ABA@AB@BCHCLCFAF@BAF@DAD@BBDBF@B@D@@CL@@C@GBA@C@CBABE@AB@B@@@BDF@B@@ABE@CBA@CFAB@B@BB@D@DBD@BB@BBD@@@J@F@BAB@@C@CB@@AB@D@B@FA@@BA@ABEBA@AB@@C@AAEAA@ABA@ABADAFABABC@A@@BAF@@@@A@@BA@E@A@A@A@ABCFEDABCDEHABGJADABAB@B@B@B@BBD@@A@ABA@@@@BPFF@DBDBBB@B@B@D@F@DBBDB@@@BA@BB@BB@BB@B@@@BA@ABC@A@A@A@A@ABAB@@BBBBB@B@B@BBB@@B@B@BABBDFF@@FBF@DBBBD@BBD@B@DAB@BBBBBBFDDBDF@DABABB@CDCBCDCBCB@BAD@B@B@BBBBFFHBB@@DB@BBF@BBF@D@B@D@F@B@B@DBBFBD@@@DBBBLDD@DBB@@D@FBB@FDBDFBDDDDBFBFBDBB@BBFF@D@FAD@DB@@@BB@@AD@DBBBB@@DD@BAF@BF@D@F@D@BADAFADA@@B@DCF@FAF@D@D@BC@@BEFCD@B@D@D@@@BCB@@@@@D@B@DBDBFAJBFADAHEBCDCDCBA@AFAJEJCBCDADCBCCGCC@A@A@CDGBCDGD@DBBDBFB@B@FADADC@ABA@CBC@CAECAC@EACA@A@CACACAAA@@AA@@A@A@@BA@@BAB@B@DABEDC@CBADGBCBA@C@A@@A@@A@A@AAC@A@A@A@@AA@@A@A@@AAAA@C@A@CABA@@@C@A@@ACA@@A@A@AA@A@@BA@AA@A@AA@@A@@@A@@@AA@@C@@@ADA@@BA@ABA@@JAD@BA@ADA@@@C@@@@@A@A@A@ABABCBADABABG@ABCBCB@@@DKBKCGEGCI@@CIAEDMDIDCDADA@A@@@C@E@C@K@G@C@@@AFABAB@BA@@@AC@@@AA@@BABAB@BA@@@A@@@@@ABA@A@A@AAA@AB@@@BA@B@@B@@@B@@@BA@A@A@@@@C@C@@@A@@A@@@AA@@@C@@@A@@A@@@A@@F@B@BA@ABAB@B@BAB@@@D@FA@B@@@@@BA@@BA@@@BB@@@@B@@@@AB@@ABA@@@@@AA@AA@@A@BA@@@AB@@@@A@@B@@@@@BB@@BB@@DA@@@@@AA@AA@A@@@@D@@A@@@ACC@AC@ECA@AC@EBE@C@C@A@AAICCKEG@A@EAAAA@@A@C@CBE@C@AAAA@EAAA@@BABAF@FAD@@BB@B@DDDDDBDBH@F@FEDCBADA@@BABA@A@@@O@EAA@AAACA@@AABA@@@A@AA@ED@B@B@@AB@AAA@@AA@A@@@@@@B@BA@BB@BACAA@A@EBA@@@AA@C@EBAFCBC@CBA@@BAB@B@F@PABABCBA@A@AAA@C@G@C@A@ACAA@ABC@EBCAEAA@AAEE@EAE@EBGDAHAJBF@DAAA@AICAC@A@CFADAD@HDJDD@FA@A@A@ACCE@E@EAGCC@I@C@A@A@CCAGAEACBC@CBG@I@EAE@E@CACACCC"]],encodeOffsets:[[[116600,31619]],[[116587,31507]],[[116588,31509]]]}},{type:"Feature",id:"420981",properties:{name:"应城市",cp:[113.572707,30.92837],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@YH]ASKWQI@CN@DEVCDINILEFILOTEJA@INCFAD@@CDAD@@AD@BA@CFA@CD@BABGJEFADCFA@@@EBG@E@A@C@A@CBEFAD@DBD@BAHA@@@CA@@A@AB@B@DBDA@CAA@@B@BBB@@C@@BBBD@@B@@C@A@AB@BB@B@@@@D@DA@AC@@@@ABADBD@B@@A@CAA@ABAD@BBBAB@BCA@BA@AB@@ABA@ABADA@CBAB@B@BABA@A@ECA@CAKCAACAIEABA@CBA@AB@DAB@BBB@J@DAB@@ABC@E@A@@BABBD@@ADC@AAGAKBC@AFCDCBADAJBF@BBDA@@BKFC@AD@@BLABAHAD@DBDD@@DB@@@DA@B@@@B@@@B@@BB@B@BA@AAA@@BA@CF@@@DBBBB@@BB@@@DC@AD@BBBBBB@F@BBBDFDDBBAB@BABGBA@AB@JBB@B@DADAFAD@BBB@@DABABAB@B@F@@ADA@CF@BBBBDBBB@FA@@B@@BCF@DBBBBBBB@CFBBHH@BBBDAD@DBDBJ@DAFAD@B@@@@B@@ELA@ABAB@DABBD@B@B@@A@@BABAD@D@BCHBDB@B@DC@@DCBA@@DEB@@@D@B@BBDHBBJF@F@DBBDDDDF@DADBBFDBFDD@DBDBDBBD@DBF@BJLPLDBBBHF@@@@FD@BFFFDFJBFFLBLDLAL@L@F@J@FBB@D@FAD@DBBBBF@DBFDBJD@D@DAB@D@DBDBFF@ABABANMHCDADP@BBBB@HDLDHBBBBB@@@@BFFJHHHJHDNHB@DBD@DADAD@B@B@BCDCDAB@DADADCEAECAAA@BA@AB@BAB@F@D@B@B@D@B@@@B@@CDE@@B@@@DB@@B@@@@@BA@CAGBA@@DB@DDDBDBBDBDBD@DADADC@ADEDKDEBG@C@ABADABABA@@@ACAA@E@C@C@@@AAA@@ABC@AB@B@B@BA@A@A@@AAA@A@@AFEHEFEDEDADCBAB@@AA@NOB@@C@A@@B@BAB@@@AC@A@A@A@ABABABCHIBAFGDCBAFCDEBAB@B@B@F@B@@AB@@@@@BE@AB@D@BABABEBCBAB@BAB@FBBBD@@@BAB@FABAB@@AB@@E@A@CBA@@DAD@@@BA@A@E@I@@AC@AAAC@CAC@A@@A@ABADEB@DAF@BA@@@ACE@A@@@ABAF@BADAD@B@HAD@@@DK@@@C@AAEAC@ABC@CBE@ABEDEDKDG@ABAB@BAH@D@B@FA@@B@BABA@ABGBABC@AB@@C@KBCBABAFAD@F@BAB@DCBABA@@B@HAB@BADADADALAAMACAC@@AAA@@@AAA@CBABA@A@A@AAACAAAACCCA@@AA@AAA@ABC@C@A@E@AAAAA@@AAEAGEAA@@@A@@@ABEBE@C@A@ABCUGAAAA@EBM@E@M@AAAABCB@@IN@@EFCBCD@@@@CBABC@CAAA@@GGCCEAA@GOCIAAEIIOGEECOKECECCAUAQ@CCIE@@@@AA@@A@@AGCUMSEAI@KJAB@PCBCCUECEC@@KAWAIA]GIAOA"],encodeOffsets:[[116290,31462]]}},{type:"Feature",id:"420982",properties:{name:"安陆市",cp:[113.688941,31.25561],childNum:1},geometry:{type:"Polygon",coordinates:["@@@C@E@C@A@AAACACAE@OE@DA@MPB@@BA@ABCDCBCFEFGFEF@BB@B@BB@@@B@BABA@A@A@@BAD@BB@BB@@D@D@F@B@DB@B@@ABABCBAB@B@DAHCFCLCF@BCDCBCBC@CACAAAACCC@CCA@@ABBH@DAB@@@@A@@@CA@@A@@@CF@DA@@@A@C@A@A@C@E@A@ABA@@BABB@BBFDFBCDCBCBA@CBCDADA@A@C@CBCBC@CAA@MGGCGIGGEIAE@@@@AAAAGAKCGCA@AA@ACOCBGDMNABAB@B@BABEBABABCBC@ABIHADAB@DDDD@@BDDDDBDDDBDDH@B@@BF@L@H@H@H@H@H@B@@@@@NAL@BAHBN@RANAB@@ALKHE@MDA@GBA@I@C@CAKA@@GDEDCFCJCLAJ@@@BA@E@EDADADCH@FAF@D@FADCFODEBAF@BABABADA@ABC@AAA@CACBCA@ACA@AA@ABABCDAB@@A@EAECACACCKAA@CA@ABA@@B@DADA@ABCBAB@BAD@B@B@@AB@@AFBF@DABCB@@ABADAD@BABABK@AC@AA@AA@B@@ADADCD@DEDEDCBA@@@AAC@A@A@A@AB@B@DBB@B@DABADCDADCBCDABCBCDADBBDFBHBH@DBF@DDBF@D@DC@CDAFDHJDNCR@NCNCJCPCPBF@FFFHDNDJBB@JBD@HCFEJEBAJIFANAJ@JFFDHFLDB@HBB@@@B@@@REH@D@@BFFBDF@DADAFAF@BBDBHDB@B@B@BAB@BBBBBD@BAB@BB@@BD@H@B@@@HBB@BAHIBCJIBAFAFCB@@ABABABAHGBAD@DAB@BAB@@AB@@ABA@ABADCDAD@FA@@@A@A@CBAB@BA@@D@B@B@@@BB@@DBBBD@BAB@BBB@@@BA@C@AB@@ABAB@@@B@@B@BBB@@DB@B@F@@BB@B@@BB@@B@DA@@DBBB@@B@B@BCDA@@B@@@DB@B@@@@BD@@BB@@AB@@@B@D@@B@B@BBFB@BB@@AB@@ABABAB@@BB@B@B@@@@BBB@@ABAB@@@B@@BBBB@@D@BB@@@B@@@BB@@@@B@@@BB@B@@@@ADAB@BAB@@B@@@@@B@BADA@@@@BB@@@D@@@DBFADBB@BD@C@AB@@ADAPCB@BAHG@ABCBE@A@I@A@A@@LCBABAB@BABGB@DABAF@D@B@HBD@BB@F@B@DDBDBF@D@B@DFHFBBFDHD@BBBBBBBDFDH@@DDFBB@H@HDFDDF@BDDDJFJFHFDLJBDHFJFHDDBHFB@D@JAHAJAHELCB@D@JAF@JEJ@LEHE@@HGBCJCHCJ@@@HBLFHCLCL@JBDDJBFFHFBBHDJDFAFAHIDEDEBABADCAM@@@@@@BA@@@@@@@A@CDIBADE@@FAHCFBHABD@DFJBHDHBFFDHBFB@@LCDAFEBEDI@AFGDCFALAFANAHA@C@C@C@C@@@CCCGACBC@E@A@GAAAEAAC@GAA@AAACEECACAE@C@C@C@C@A@A@AA@A@CBA@CBCD@B@B@BBD@DGDCBAAC@ACCA@@A@AAC@A@A@@@ABA@ABC@C@AAE@EACAACCCGGACCEECAA@CDE@A@GCECGAA@E@ABE@ABGBI@E@EBI@C@GAACG@AEIGMECACGGEICCGAE@EEGEGIICECDKFKBADEFA@@B@@C@GAGBMA@AEACAEBIJA@@BA@A@@@AB@@@@ADCDCBC@@BA@A@E@AA@@AAAA@AAA@C@CACBC@ABC@CAAAAAACAC@EBEDADAB@BA@A@AAA@AAA@@A@C@GEAACBADIDIFEB@BABCDCDADGFCBEBIAEBCACAA@C@@@@@A@AD@@C@C@A@C@EDAF@@ADC@C@E@EBE@CDA@@@CBEBCBABC@E@C@E@@ABE@ACC@@AAAA@CBC@@AA@@A@@CBC@E@CEEAAA@CAEAEACACCACCECA@EAA@E@CA@CAC@KCAACA@@C@EAAA@C@A@A@E@C@A@CAE@AAE@ACA@@AAEGAEAA@A@A@ABC@ADADADCDADCA@BABA@CCECAECAAAAAAA@CBA@C@AAC@AACAE@EA@@EEACBA@A@A@AA@AAA@A@A@AAAA@@BABAB@B@B@B@D@BAB@@A@@@AAAA@@AAAB@@A@@CAAA"],encodeOffsets:[[116377,31820]]}},{type:"Feature",id:"420984",properties:{name:"汉川市",cp:[113.839149,30.661243],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@E@@@@B@D@BD@@A@@@@B@@A@C"],["@@JNDJNIAC@@ACAAAA@AA@@@AA@@@@BA@@@@@A@A@AAC@@AC@A@ABAB@BAL@B@FAFAD@HADAFADAFABA@@JAFADAJIBA@@BBBAD@@AB@B@@FB@@BB@BDBB@B@@@B@BB@BFA@ABDH@BB@@@BB@BB@BBD@@@@B@BA@A@@@@@@@A@@AAB@@@@B@@B@@B@BBBBBB@BBB@@B@@@@BF@B@@B@@@@@B@@@@@BA@AA@@A@@B@@@B@@@B@@B@@B@@@@@@BB@@@@@@@B@@BB@D@@B@@D@@@@@D@@ADB@B@@B@@B@@@BB@DB@BAB@D@B@B@B@@@D@B@B@@BD@@AD@@@BA@@@A@@@@D@BABA@AD@@BBAB@@@BBB@D@B@B@@@B@B@@@JALA@C@@@ABEE@@CAA@AEA@ECAAABC@A@CF@D@BBBA@EBG@ABBDCDC@CBEC@ECIEGOEIAC@AIIIICK@EAYAAAGGEGCC@E@KAMEECCCKKEMAIBI@GCCAAIAEBEBIJEFABKFI@CAAACF@D@D@H@DADAFAD@@@D@FBF@DBFDF@H@FDN@@@B@FBJ@F@HAF@B@@IBABOFIDGFCF@D@J@PCDCFADFNBB"],["@@FNAFAF@B@BA@@BABADCB@BA@EDCBABA@A@@P@BAB@D@NBB@@@F@@BJDJDJBBDDBBBFBDBBDJDFAD@DFB@DCDAB@D@DFFBBBDDBHB@BBBAF@D@DBD@DBH@FCBGBA@AFA@@B@DAFCBEAWRAD@BAL@D@@@DAF@B@D@HB@NBDD@F@D@D@F@H@@ABADEBEBC@EAC@E@CB@DDB@@@@@BB@@@@@@@@@B@@@FBB@DBBD@BBDDFBDBDBD@H@D@@ADADAD@@ABCBCDCBCBCBCB@@AFBDBBFBDBBHBD@DDBDDDD@FA@AB@@A@A@AB@@@B@@BD@B@D@B@D@BBD@B@F@B@@@@@@B@B@@@@@BBADAD@@EJCDCHIJABADABABCDADCJCDCDABC@CDADCFABCHEF@BABABIJCBADC@CBCBA@ABCBEBCBJFDBBBLDDBB@FDB@B@BA@A@ABADAB@BCBAB@BA@@BAB@@ADB@ABAAA@ABCBAB@DBB@@@@AACBCBA@@@@BDB@@C@C@@A@A@@ABAB@D@@@@AC@AA@AD@@@AA@A@AB@DBB@AC@C@ABAB@@@DB@@B@BG@AAC@CBCFEDAB@D@B@F@H@FA@@B@DEBCFEHIBA@ADCB@DEB@@ABC@@BCDC@@BCDEJMB@FIPSJKFEJKJMDCFU@CDMJ@XRTL^BZGB@PBJB^HJBXBLB@@FDFDDVADODA@IB@LBJTFVNHD@BB@@@BB@@@@JFDDR@VBDBFDFDPLFDHFJPFJBBDJHPB@FBDDHH@@BBDBD@BADA@@@@DCDAFE@@JM@@DABABB@B@N@FAN@FBBBBVH@ADEDCDCBC@A@A@A@AAE@A@A@A@@BC@ADEBA@ABAD@FAD@@ADAFE@ADADAD@BBB@BB@BBBB@B@BADAFEBCBA@A@CBA@@DADABABCBCB@BADCFEBABAD@DAB@@@BA@A@C@A@A@I@I@GBCDCHAFAF@HBD@J@DBFHDBD@D@BABABAFOBAD@DBBBBB@@@DBDAH@D@B@B@BBBDDBDBD@D@FBF@F@JAH@DADBDBFBHDDB@B@D@@K@CDAJCJCB@JAB@DAD@D@D@@@D@DCJIDGBCD@DAHEB@@CBA@GBC@@CCCA@AC@CACAAC@C@C@ABCBBH@H@D@@ABC@A@@@AAAAA@CCC@AACAC@CAC@CAABCBCBCBADA@A@@@AAAAAEAC@C@CB@B@BAB@DABC@CBC@CAA@@A@ABCDAB@BA@AAACAA@@A@ABA@CAC@@@A@A@ABAB@F@F@B@@@D@FBD@BB@BBBBB@BB@BBB@BA@@BA@@@@@A@AAAAA@AAA@@@A@ABABC@@@C@ABA@@@@FCBA@AB@@@@ABAB@B@B@@@BBBBBBB@BBD@FB@@B@FI@AFE@ABA@@B@BAB@D@DAF@B@B@DAAAIKCCCECCCGEGCE@@EEGEA@GEEE@AAC@EBCFAFALADADC@AACEEEAGECAA@@AAA@CDCDAFADADADCBA@ABA@@AE@CCE@@@@@C@ADCBA@@BABA@@@@@@@A@@ACACACCCCC@@CACCCCCCAAA@@AIEOCE@C@IBCBA@@@@E@AAA@@A@A@@@CD@BCDCACAAECEAGA@AECC@AA@@A@@@A@@AC@E@C@@@A@A@AAG@CBE@CBCBE@AAAAAECAAA@ECCAA@CBADAD@BCD@D@DABCDEB@AA@@@A@CAAACA@A@A@@ACACAAA@AAAACACAA@CCCC@CA@@CAC@AAC@A@AAI@CAA@@@@CA@@C@C@A@A@A@C@ACA@EECCCCCC@@AAAAACAACCAC@CACCAACCACEAAAACE@@AA@ACCKCACCCAIACAKCE@A@@E@C@C@EAECE@AAC@CCCCA@A@G@E@A@E@GAE@E@E@C@CACACA@E@A@@@CACACBCAA@@CCGECAAAACGCAA@CBAD@D@FC@C@AEBE@E@E@G@E@I@EBCBABA@EFABCDCDCDADEJGJIDIBABM@A@M@A@WBGDDFFFBB@B@B@HBF@BBFBH@F@B@@BBBFBDDBDJBFABEHED@FAD@DADADADCDEBCAEGAACCEEC@ADABCBE@CBADAJADCDCAQOEAQAAF@DCDCDAA@BAH@FABAAC@E@@D@BADBBDB@FFB@BBB@DF@AF@B@@@DKBIB@@A@A@@@A@A@C@A@AA@@A@AB@AC@@BABABC@@@@@@B@@AB@@C@@BC@@AA@A@C@@@A@A@A@C@A@ABA@@CAA@@A@@@@AA@A@BC@@@C@@@@@CA@@@@CAA@@@A@@@@@@AA@@@@@@@AA@@@@A@@@A@@@AB@@@BBB@@A@@@@@A@@@@@AA@E@@A@@A@@@AA@AAAAAAAA@@@@AA@@@@@BA@BB@@@@@@@B@B@@A@A@@C@AAA@@AAA@@A@@ACGBAB@AEA@@A@A@@@AAAACA@@AA@@EA@A@@BC@ABAA@@ABIJCBEBIB@@ABEBCBEBCBGBC@EBEBA@K@ABHNB@@BBF@BB@BB@BBD@D@BAB@B@@A@AAAAMJ"],["@@A@A@@D@R@B@B@@@BB@@@@A@@@K@IBC"],["@@@F@B@DAFF@BDJA@@H@@A@AEAA@EA@AC@BE@CG@"],["@@@C@@CB@@A@@@@DB@BDB@@@@E"]],encodeOffsets:[[[116586,31505]],[[116164,31235]],[[116171,31247]],[[116117,31453]],[[116109,31411]],[[116107,31416]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("宜昌",{type:"FeatureCollection",features:[{type:"Feature",id:"420502",properties:{name:"西陵区",cp:[111.285646,30.710781],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@AC@@@@GK@@@@@@ECAACBC@ADA@@BAF@D@A@@A@@A@@CB@@@AAB@@@@E@CB@@A@@B@@AB@@@DADABC@A@@@@@@@@@AAAA@@ADAA@BAA@@@@BA@@A@@A@@@@AB@@ABA@@AAAA@@@@AACAAAACBKHABABCD@@EF@@@BADCFAJADAH@DAB@HBD@BFJBDB@FJDDDF@B@F@F@DCHADADCDCDEBIDYDcF]DA@AB@BCJBBDDB@DFDD@DBDAJ@DB@BBDBDAFAD@FA@@D@F@B@DAB@B@@@B@B@BAB@BA@@FAFAFAD@F@B@DBD@B@@@DAHE@ABC@A@ABCAA@@ACBAD@F@BCAC@A@AB@FAB@@A@@BAB@@A@A@CBCBCB@DCB@FCFABAFAF@B@@@HBB@B@B@BAB@FBBBDBFBDBD@@@B@DAD@FDDA@@D@DEBC@AACACAAAA@ABA@@DBB@HC@@@@BAB@DBDDHBDDBDB@B@BABA@CA@@CBC@ABA@@DABC@AACAAAA@AAE@E@@BADC@@BCBC@A@@@AACACAA@ABAD@D@BA@AAAAEGG@C@CC@A@@CCAC@ABA@@@@@C@IAC@G@A@C@CBEDA@C@@A@ACE@AA@@@@@@A@@"],encodeOffsets:[[114004,31426]]}},{type:"Feature",id:"420503",properties:{name:"伍家岗区",cp:[111.361037,30.644334],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@CBEAACMICFCDEF[\\GJABCBABIJIJ@BCDEFABCDGHGJABSRIH@@IJEFGDEBAB@@ABA@CDA@CB@BA@@BA@ABA@ABBBBBBD@B@@B@BB@BB@BA@@BA@@@@@BB@@@AB@@@@BB@ABBBC@@BBBB@@@@@@@@B@D@BABC@C@@BA@@@AB@@@DAF@@@@@BA@B@@DA@@@BB@@@@B@CBE@AB@BCD@DABBFD@@@@@@HL@@@@BD@@@@@@@B@@@@B@@BDF@B@BD@B@FCDAD@B@H@D@JBD@@@@@B@BAD@DB@DB@D@@@BAFCB@BC@ACE@CBC@@@@BAD@H@B@F@JBFAD@@ABA@A@A@@BA@AB@D@H@B@@A@C@A@AB@B@B@FABABABAAA@A@C@AAA@@C@A@@@A@@A@@B@BCFIBCCE@@@@BAD@@@@AAGAA@AFC@@B@DFDDBBDDBDB@F@D@B@@@FB@BBBBBBB@BFBBBDDB@@B@BA@@@AB@B@@@BBB@DBDBBBF@BBBBBFDFDB@DCBABADAJCF@B@D@D@BA@A@AAACECCC@E@C@CCCCAAE@AA@A@AHGBC@AAC@C@C@C@A@CC@BADAAABCI@CBE@EAECAA@C@AAEA@G@@@@A@A@@D@F@@@@A@@AAA@CBC@GFA@E@CA@C@EBG@CCAOBC@E@CAAABKACAAE@GB"],encodeOffsets:[[114072,31338]]}},{type:"Feature",id:"420504",properties:{name:"点军区",cp:[111.268119,30.693247],childNum:1},geometry:{type:"Polygon",coordinates:["@@FGBCIAA@@@AA@@@@E@CBEBCBA@A@@C@ABCAAECC@E@GFEBA@@A@AAGC@G@EAA@@ABAFGHG@A@A@CCAC@EBEBMDI@EBGBCFABILKPEBG@E@ABCFAJABE@C@IJA@A@A@ACCACAEAE@CCAAA@AEGCEAW@CA@AAECKIGA@E@A@GC@ACAA@C@E@G@G@EAEBEDADBBDHBF@D@DEDGDCFAB@D@@BDFB@BABABCB@B@D@BC@A@ICAA@A@CBE@AA@@@ABA@AB@FABA@@B@B@F@B@BABCB@@ABGDEBMDCDC@AAECC@A@@D@@@BA@A@EA@ABEACCCCAC@E@CBEBCBGHGFEDCBIAE@A@GDCBGHCBABABC@E@C@CBC@A@EACACBC@C@G@S@E@A@E@GBC@E@C@AAGAECEIA@C@A@CBCDA@CDABCBCBCFGFABADEFAFCBEBAB@@ABAAA@A@ADA@@BB@@@DBDB@BB@AB@@AAAAE@A@A@AD@F@FABEHADGHADEFCBGFEFGDE@CAA@CDEDCLA@GFCD@BBBFBDDFF@B@BD@B@BBH@DB@@BB@FB@B@BBBBBBB@B@B@B@B@B@BBBB@BBD@@BD@BB@BBB@BBBA@@FCB@B@B@D@B@BBBB@BB@B@B@JAB@B@BB@B@@BBD@D@BBDBDDNFDBR@D@BBTRJHDBF@F@D@B@@B@BABBBBBDBB@F@B@DD@DDBDADCDAFAJADA@CCMACBAD@FDDF@BB@HJLJbNRHNLBDDDFBLCHAHBDDBBBB@@NAVEHAHAF@P@P@H@^CdEZCJCFADCDCBCBCDG@C@E@E@ACECCEIA@ACEI@AAC@GBA@CBGBCBIDEBC@A@@FE@@DCBABALGDABAB@BAB@@AB@@ADAB@DCB@BA@@BAFAHCFEJI@@JGTQBAHIHGDCBAFEDC@AJIJIBADABAHI\\[FEDCDEHIFG"],encodeOffsets:[[114065,31322]]}},{type:"Feature",id:"420505",properties:{name:"猇亭区",cp:[111.43462,30.530903],childNum:1},geometry:{type:"Polygon",coordinates:["@@BC@KAEAAAA@EACCCEEAE@GACAAAAEAGBC@@BEZADAHABCHADABABEHKLEFKNCHEJ@@ADAD@B@@ABCFENCJAB@BEXCLIf@B@HADADEHEHGJNJBDFBDA@@B@HAF@BBBDALBBDBF@D@PADB@DAH@F@DDBF@B@HED@DAB@BB@@@B@@E@C@@@@B@B@@H@B@BF@B@DBBFDFBF@DAJ@BABE@CAABABAB@@C@C@E@CBC@AHAFC@@BCACDMDE@CHGJO@O@ADCDADCBAACAECAACBGB@HABAXQDACG@EAE@@@GBQDCH@DADAFCBADAF@D@@@@CAAA@ECEEACAAGIGGA@AB@D@B@H@D@@AB@BEJCD@@A@AA@@ACDC@E@EAGA@@A@A@@DC@AA@A@EBCBA@A@A@@A@ABAFC@@@AA@ABMNIFGBAAAA@EBE@A"],encodeOffsets:[[114146,31225]]}},{type:"Feature",id:"420506",properties:{name:"夷陵区",cp:[111.32638,30.770006],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@E@GAGAIAC@C@C@ACCAC@E@CBAACAAAC@EBGBE@C@C@CAGCC@E@E@C@AC@CAG@C@A@CCIACGAE@CA@C@CBA@EAAAAGB@C@ACACCA@CAECCACAC@C@EBGFCBWRABGBA@AHBDDBBFBDABCDCBCD@B@PIPGH@DCFCNBDAD@@EDGB@BAD@D@F@D@DA@ABABBB@DAFABADBBCBABD@@D@B@D@D@DBD@BADGH@B@BBBF@BBDDDDD@F@D@DDDFBB@B@BABC@C@A@E@IDCBABABCDA@ECECAAAA@AAEAAAC@CAA@A@@@ABA@@B@@A@AA@CCAAEA@AAAAAAA@AEA@@A@C@E@A@ACCCAACCCEA@@@ED@BBBBH@B@@C@AB@@@@DFADEJADA@@@@BB@@@B@D@@@BB@B@D@BBBABABABEBA@A@A@@B@B@D@BA@G@C@A@@BAB@@@B@BAB@BC@EBIAE@A@G@C@AB@@@@AD@DDF@BADA@EDAB@@@D@DHHBFBB@BABC@C@AB@BBBBDBD@B@@@BADAD@@CDAB@@@FBF@BBBBBBD@BADCB@@AB@BAD@DB@@DABABA@A@ACCCGACCCAA@AB@@@@GDA@CA@@AB@BBBBBBDBD@BADCFC@@@CBECC@CBA@@@C@CAEACAAAEAA@ABA@A@A@GA@@A@E@EBABEBEDA@CDA@ADAD@D@B@BA@AB@@@BA@EBA@@B@BBDADE@C@ABBD@@BBAD@B@BAD@BGFCB@@A@C@CAA@E@C@EBEBEB@@ABA@ABA@A@@@A@A@CBA@E@C@@@EBC@EBCBCAAAA@@CBIAC@CCCCEA@CCAADI@ABAB@G@O@O@E@GBGBUFMB@@AAAACCGAGBKDEACCACMKQGaMKIGIA@@ACEECC@ABBDDN@DCBIBEBCBCDCBCA@CCCA@E@A@CAAAAABA@A@AA@C@E@E@CAIGSQAAC@Q@CAMECCCAAAC@C@AA@@@AAAA@A@IBA@A@A@@AAAAAA@C@A@A@A@ED@@ABAAA@AAA@@AAC@@AC@AAAAAA@A@A@A@A@A@AAAAAAA@A@@@A@ABA@ABABA@C@A@A@A@AAA@E@A@A@A@G@ABA@A@ABA@C@C@A@A@CBEDEACD@DFLBABADADAFAFAJAD@D@DDDFDFDH@@D@@@BBDF@D@B@DFDHDHBLBFBHDD@DH@@BB@D@DIDCD@DAFBFFFFFFFFFFDBDB@@@@B@B@@@F@D@B@B@@@BBD@@@BA@EBCDCBGBGDAD@D@D@F@DAFBBB@HCB@D@@B@B@BA@CBADAB@BBBB@BBBABADCB@D@BB@BB@@H@FABABCBABAFIJCHCDEJADABEFCDGHGFEBMDGBK@@@A@OBGBMFIDABMFOFMDA@KAG@C@ABAD@F@@@B@B@B@@@BBD@DADAFABCBABAAG@IDEFGDCBCDAHAFBH@DFDFBB@FBFBB@DBBBBD@DABBDAFCHKLCFEFAHAHCJ@DALCHAF@@@B@BBD@HADABABAF@B@D@DBD@BAB@BABADADBF@F@@BBFDHBD@B@F@JCB@B@FDFBDBDDBB@@@@ABCD@B@F@F@BBBBBB@BBD@LBXHHBJL@BABADABA@ABE@EAGCA@A@ABABAAA@A@ABA@ABAD@DAFADABB@BDFBHBPBFBBBB@BB@@ADBBDB@@@B@B@BA@@BBD@@BBB@@BBADA@@D@B@BBDB@@BDBD@F@DAJ@HBJBFBBB@BBF@BAB@BADAFAF@FBD@B@B@BADEDAB@BABADIBCDALCDAD@B@H@H@@@BA@ABAD@J@DADABA@AFAB@@@DA@BDBBDBFAH@BAB@B@BBBDDDFBDAJ@BAD@BAF@B@BFDHBDBDAFAB@FBJFJ@DB@DDFDDFCH@JFRHFD@FBBDBBDBB@DBBD@F@BBB@BFBDF@FAJBH@DD@BCF@DBHCJ@L@BBBFFJDJBLHNfADGDI@GBCBADAFAFADAHAD@D@FCDADABEBCD@DCBAFBD@HHJAP@FBBFBH@DBLL@BABCBGFGJGRCD@FDBDFFBD@NCL@DDBH@BLVDHADAH@D@H@DADCBCBIBGFCBEBCBAH@BADEDABEBCDADAF@BADCDCDCD@F@D@D@FBFBDBHAD@@IDCBABA@@BDBD@HDFAJBBBBFDAD@D@F@D@JGD@B@DBBBBF@FBBDHBDBDDBHFDDBBDBBBD@F@DABADCB@BAB@DBDAB@BBFDHDFBDBBBBDFHDDDDB@B@DADA@ADEBEBAD@DBBB@DDBFADCDADABCBADAD@D@FBDFFLBFBHDDBDBBBBFDD@FBJ@LAFANETENCJAFEFCDCBCDGBGAEAKEKCAICAAACBGDEDA@CAG@IJQFEDAJAFBF@J@NAD@BADCDADAD@H@B@BA@C@AACAC@C@ABA@ABCBAD@BAB@FEDEHAFAHADAAAIC@ABADCBEFEDCDE@CACKGEAA@A@A@ONGHEFGDK@EAG@ICOM[QAA@@ACACACBADGBADAJCBA@CACCCGEICIK@GFGDAJALCHIDEBABABCHCFA`BHADC@C@CSMGCEBEBIFMFK@GAEEI[AE@K@O@GCECCCCC@K@C@CA@C@O@EBEBAFCD@JADEJELEH@H@DADAPQFCDAF@HBJFD@F@BCBCBCB@N@B@D@FCDCDEDCJEFELCD@HFJDB@D@BABADAB@BA@A@@@AA@C@CAA@AA@AAA@E@AAACC@A@A@@DA@A@@A@@AA@@BA@@A@@BAFARBBAPEDAF@LCFADAHAJCHCDADCFADCFAFADADADADCBA@@BADABC@@@@HABABADABA@ABGDC@ADEBEDEBABABABAB@B@B@B@FDDBBBH@BAH@FAJEB@FABA@AB@@ABABA@AB@D@DBB@DBD@@@BEAMACAACAE@CA@C@ECEACCGBKBABAF@D@BABCB@BAB@DBJHD@FBFDJ@FADADADCFADC@ABCDADABEAAACIEEAGGAC@C@CBABCBABCAAB@BAB@@C@EBE@C@CAA@EAABAB@D@B@BAAA@ACAAAAACAAA@@@@AAAC@A@A@A@ABA@A@@@A@@FQBG@C@AAAAACAEEAAACAC@A@CBCBADAD@FCBABADGBABADCBAD@BABABALCJABABABA@AAEKEAACEAG@G@ADAB@DDBBBBF@B@@@DABCBC@EAI@AA@@AAA@A@@BAHAFADAFCFAHCHCBADADC@ABC@C@AACEGAC@C@ABAB@F@B@@ABA@A@AAA@A@C@GBE@A@EAGAA@EACAEACGKAA@C@A@CDG@CAAA@EBC@AB@AA@@AACBC@C@A@C@A@A@A@AGA@AAEAEAECAAC@C@ADCBCBAFEDCDEBCBE@CBC@AAEAA@A@CBOFOBIBE@CBC@CBEBIFG@EBE@CAC@CBABEBABADCDAB@D@DBBD@B@DBD@@@BBDBBBBB@BBBB@D@D@BBBB@B@BA@@@CBEDAFAB@DCBB@DBB@B@B@B@@DBF@DADAB@@ABA@ABADEB@AA@AAC@A@CBC@A@@AA@AC@A@AB@@A@A@AA@AAACC@@AC@AB@@ABA@A@CAAAACC@@BABAB@@ADC@ADCDA@ABADABA@@@A@A@AA@BC@AAC@A@@BA@AD@DBBBBB@BB@@@DCB@BHBBDBD@FB@BBC@C@ABCBADEBADC@AACAABADE@A@A@AA@@AB@@@AAA@A@@@AAA@@@@A@@AA@@@@@@@AA@@@A@@B@@A@@@A@@@@AA@AA@@A@@@@@@@A@@@A@@A@@@@A@A@@@@@@@A@@@@@@@@AA@@@AAC@A@@@@@A@@@AA@@AA@@C@A@A@@A@@@AAAC@@A@@@AAA@AA@CC@BA@@@A@@@@AB@@A@@A@A@@@@@@A@AA@A@C@A@EAA@"],encodeOffsets:[[114291,31325]]}},{type:"Feature",id:"420525",properties:{name:"远安县",cp:[111.640508,31.060869],childNum:1},geometry:{type:"Polygon",coordinates:["@@AABA@A@@@AI@EBCAACCE@A@A@@DBBA@@BA@C@A@@AAAAGFABCB@BAAA@@@A@CB@BA@A@A@EECECAA@EFKFCBOBCBAACAAAAAEE@A@ABA@ACE@A@AB@FAB@@A@@ACA@ABA@A@CAACCACAC@CAAAACCAACCEECE@EBCFCD@BDF@F@DCDCDEBOHKB@BABABABIBKDABABABC@ABCDABABCHABABEDC@CBABAD@D@BBDBDBBFFDBBBBB@B@DAHER@@@B@@@BAB@B@B@B@BBDBB@@@@BBDBBBBBDB@BBBABA@C@A@ABBB@FBB@D@DAF@F@DA@ABA@BBADABADAB@D@DBDHHFBJFBDBBAFCBCBAD@BCDEBCDCBCBEBI@ECEAC@IGCAA@ABA@ADABC@E@ABABALDHBDDF@F@DDBF@DBBBBDBNAF@@C@CAA@CAC@A@@BABAB@BA@@BABEBA@IFEBG@ABG@AACAECA@A@A@A@ABABABABCFAFCF@BCDAH@BABCBABABGB@@@@ADCBAB@@ABCDCBCBCBEBEBCDEBCDCBGDIDGBCBEBKDE@CBOFABQAEBAB@@@BB@@AB@@BB@@@@BCB@@@B@BDDBB@B@FBB@BBBB@DBD@B@@B@@@BABA@CBABABC@A@ICGEC@KDEFIFCDCFCDEDC@A@M@A@ADADADE@C@IEGAE@CBEDORCBCBG@G@KFIFCFIBC@EDABAF@F@P@DDBD@L@D@DDDDDF@H@P@LBFJ\\FFHBL@NEJEFAFAHDTN@D@DCDGB_AEBGDADABABCFGJKDIBCBEH@HJLJDHFDDBD@DABIDCBABCHABBDBDBD@@BB\\RPNJDH@FBL@HCFEHGPMB@B@B@FBLHBD@DCFCDEFAFCDAB@BJDBBDAJADADAFAD@JBH@D@DDLJJHHBLBB@DAD@DBJDD@B@DBFDBDDDD@@@DABABAFAD@FBFA@@D@HA@ADCAEAG@IBIDMBA@C@@@CAIAODEFIPENAPCN@FAT@F@RATCF@FA@@@@J@N@THPHFBDDPFBBJFRDLFHHPHJFJDDBHDHHJLLLLJDJFHDFD@B@@@@@B@@@@@B@@@TKRIJCHEJCJ@LANBJBNDLDLBJ@HBR@PFNFLFNC@CCGACAGBCBEDAB@DCDICEEGECCE@ADEB@H@JAFA@EC@CAAAACAECEGCGEAC@C@EFEFEDEFGDGFCDAB@B@F@HDDADEDMFGDADBFFDHF@DEFCBCBC@ABCBADCBC@A@AHADCLCF@F@FCFCFCDCHIHIFADADAH@D@@@B@B@BAFEB@BBBBAD@FB@B@D@B@BABA@C@EAA@CBABABAJ@D@BABA@C@AAAAAAA@C@ABAD@HBB@F@DABA@ABA@AAAEGCIAA@A@A@A@@BAFCDCBABC@CACACBE@CBAA@ACA@AAE@GBG@AAAAAG@C@ABC@AAA@IEEGCGCEBC@A@AACEAAACACAAAEM@CAAAAA@C@CBCBAB@BABABCACCAE@EAEAACCIAGEMAGAECECCKCECIGACCACAA@CBEFCFC@A@CAGGKMEG@EAIAECAACBCHGBABEBC@EACICCACABEBIBGDEFE@CECGCGACBC@@@@BBBDB@B@@@@@@A@E@A@A@ABCHABCFCBABIFE@C@A@@@@CBK@CAC@ACAAAABMHCDC@C@E@CCACCCBCAECCEAC@CAAEAEBCB@DABAB@BA@CAA@AAACCA@@BA@A@A@@A@A@ABA@@AC@A@CBCBI@@@AFC@AEE@@@C@AAAAAE@G@G@C@AACAAAAAC@C@IBAB@@A@CD@@@BADABEBA@@@AAA@AAA@AAA@@BA@A@AAA@ABAB@@AAACAC@@@A@A@@BC@@BABAHABAB@D@@@@B@@BBBADA@BD@B@FADAFGBAFA@ABAACEEAI@A@@@EA@AAA@AA@@BA@@@AEA@@"],encodeOffsets:[[114347,31668]]}},{type:"Feature",id:"420526",properties:{name:"兴山县",cp:[110.746804,31.348196],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@F@CGAB@F"],["@@BG@ABAB@@ABA@@BAB@B@DCDEBABC@CBABA@A@C@C@AAACEACAIA@@AAAEAGCSOCAC@A@A@CDCBIA@@A@CBEJADEDCDMHABC@A@IE@@C@AA@BA@CDCBQFIDEDA@CDCDBD@BCJGHABC@ABE@ABAB@B@FAN@B@DBDBDBDADCBEBG@IBE@C@E@E@BF@BADCDAB@@FF@DADADBBF@HAFBB@BBBDBF@B@B@BCFADAHAH@J@BBBDFFDJFDDDFDBFDDB@BCDEBCBKACAA@ABGBABA@ICCAA@A@@BCBADABCDEDABA@A@A@KAAAA@ABA@@BAD@FAFCDEBEDC@ABADCFCD@B@BBBFB@DB@@BEF@D@J@B@@@D@@CDGFCDAB@B@B@@@BJFD@HBD@DBDD@BDDBHBFHARBD@FAD@DBFBFDJHDBDD@DBHBHBD@DBBDDB@@B@F@B@BAFBJBBFDFB@ABADADABABCBC@@B@BAB@B@@AB@BA@@@ADE@@@EAA@AA@@C@@@@AAAA@A@@DEDCBCB@@@HA@@DABCBA@ABC@A@@AE@ABC@ADABAB@HAB@BABCBABABAD@B@D@@@DFDBDBD@F@FBD@HBDBFBJFDDBD@DADBDDH@DADCHCD@@GJCH@F@BBBBFJDFHFHDF@J@HCH@H@DBDHFDDDDBB@B@D@F@B@B@BLNDHBDBJ@DDDBDDBDDBDBFAH@D@FDDHHDF@JAHAD@H@BBBBBBBD@D@DALADAHED@F@D@F@D@DBHBDBD@F@F@LAJ@H@H@FBFBDDB@DADADEFEFAD@J@D@BB@@BB@HFHBDR\\DBHHDDDHBF@D@BAHAD@DBDBD@@FDHDDFDBLAH@FDHFD@HAD@B@DBJHJFHDJDD@J@DC@@HGFGB@DABADABE@AB@DBB@D@BBBBB@FD@@BBB@BADABC@E@O@IBCJELIBCDEDKDEDAH@DBDDHFJHDDPFBBHDBBDDDH@FFDFBH@D@J@@@FBBBDDB@@DBH@DFL@B@B@BBDBBFBBBHBFDFDDBDBF@H@DBDBDBDD@@@BDAD@P@LBJDLBJARED@DBFBB@FBB@DADCBABAJ@N@JBF@HAFBLBD@FABABIBI@C@EACGEIECA@C@AFCJGDCDALC@@DC@A@OBEBEDADA@@BC@CEEMGGGKOCG@I@OCK@CBEBEJOFEB@BCDA@@BA@EC@ABAAAA@@@CAAACECAACEAC@@@A@AB@BAB@BAD@BBB@FFFDBBB@DBDBBBB@AB@@@BEDEFDHHCDAF@HBFFD@D@B@DCHIB@FCBADADEBEAA@ACCAC@A@@AEAAIAEBGCC@CA@AB@BADAJC@@BCAGACAE@E@C@C@EDCDCDCBC@ABEBCDCFABAFCBC@ABGDAFADAHEJADADABC@C@G@CBGBCCGKU@AAGCCK@MDC@EACECA@EDCHQHIHEDABA@AKKCAG@EAAA@EBOGI@GACBEDA@CDCFABABCDC@E@CBCBGBCBEBEBCDAHAJ@HCBCMeKGIAICEEAA@A@KDIAG@CDE@ACCG@IAEBE@ACAEA@AAE@C@AA@CAAACCAAA@EECQGIEG@EDCCCE@CCAI@IEEAA@EBCBCAGAEC@A@ABE@ABC@ABIACCECCAA@A@ABA@ABGAEACCA@ACB@@A@EB@BABCBCBI@C@AB@BAB@@G@G@A@C@CBKDCBADCJABABA@CBCFABA@A@C@EAE@EBCBABA@ABE@AAA@AAAEAI@GBI@C@EACAC@@CAAAA@C@@@CBAB@AA@AA@@A@EBE@A@A@CAA@CBAB@@ABA@A@@A@G@@AAA@K@E@CB@@GFABCBCBKBGBCBCFCHCDCBE@C@GC@AC@A@CBADAD@@@DCFA@A@ICCCEAEACBEBCBCAGGKECAICICGAQEI@KFEFAD@DBBBB@DCDEBIJCFCDC@CCIEIEC@@@AD@DBF@DABEBABAB@J@BBBFCB@FBBH@DA@@DHTBB@BAF@@ADC@A@CEAAA@@@AB@B@D@B@BEBGDCBAD@DDHDDBD@@@@ABA@I@ABCBED@BCFCDC@KCIAIEEAAAACAA@ECACAECC@GDEHABCBEBAB@@ADBFCBEBGDEBCBCDC@EACACAGDEAIEEAI@GBIBGAEAIEEECECEEG"],["@@@@@@A@@@@@@@BB@@@B@@@@B@@@@AA@@@@A"]],encodeOffsets:[[[113719,32196]],[[113248,31916]],[[113384,31903]]]}},{type:"Feature",id:"420527",properties:{name:"秭归县",cp:[110.977711,30.825897],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@AEBC@@BAFADABAFGHCD@FDDBDB@FBBBDBBFBJFJBLDD@DCDE@AFCDABAJ@B@BA@@@@ACCCCG@CBCDA@CCC@@@A@@AA@@@@@@B@@@@@@B@@B@@B@@JDFA@A@A@C@ABA@@B@BBDFB@D@BC@@BE@AAAGS@CB@@CAGEAA@EDAA@A@IBABAFABA@CAE@CBC@@D@JFJFDDD@DCDEJIFADC@CAAAA@CBCFELEJ@RFHBJDJDDBLFHHDBDAFADAFBFBDDJDB@B@DE@C@@BCBCDAB@D@@BHDD@F@DADCDGDEDAHALADADABAHE@@DAF@L@B@BB@@@H@BB@B@BA@@BADAB@DBB@B@F@FAB@AC@AB@@A@A@A@@CAAABC@@AAA@AAEAOAGAEAACA@BABCBE@CBCBAB@BAB@B@BBBABAB@B@HDFBF@BAB@BABCBA@AIKGAWGKAC@AAA@AAAA@A@E@E@ADCBA@@@@AACCCAEAECA@A@IDE@A@C@GAECAA@@@EAEBCBCBA@ABA@AAC@C@C@ABEBABABC@GAC@A@A@@BEDGBK@CDIBGBGFEDELKDGBEACBA@CACAACAA@EAEAA@EAEC@CAGBEBGDCDAHCFEJCH@BBBADABABEBC@CAC@A@@@A@A@A@@@EBCBAD@H@LBB@NCPENEBAJCNEHAPAB@@@L@HANCFAHEHGDCFEBABCFIDCDGJIBEBADABABA@E@GA@@AAAC@A@CDABABAAA@AA@ABABCDAB@@A@A@AC@A@GDA@AABE@C@E@C@CBCHCHADADCFAB@@A@@AC@A@@@A@A@C@E@@@A@A@@A@ACECEEEEEEEEAEBE@CDCJC@C@CAA@@CGC@GCEAKAGAGCEC@C@A@CCEAA@@C@@@CGCECECCC@C@IBEBEBCBCBABAB@@CBA@GCKICGCEACACAAC@@BAD@D@B@@@BBBCBCDADCB@BCNAHCJGFE@I@IAECCECGAK@I@M@ICIECGCKCECEG@A@A@E@CBC@CACACCCC@ABC@CBAB@B@BADABABADABCBC@C@CDBD@BABAFAFGHGDEFCBC@C@ACACCAA@GCIEEGCGAIBIAGCKCICEECECGBIBK@Q@EAGAEAEE@@@@@AEKEKAEIEEA[BSBK@GB]DYFQDKDEHAHBHAHCHGDGBQHQBmNKFAJ@J@NDLAH@JAHCHEHIJAFCDADDFDJDHDF@HAJ@NEDEBG@MAEAICECICOGEEKEIEIICGEEEEEAGAA@C@CAC@IDCAK@EAUBE@A@E@ECOAICE@G@CDCDCHEDEFCBAFCHAJAHAH@D@DAHKNKFUBW@G@I@KAAACCAE@@CE@@CEAAAA@@AACAEAAAA@AACCEEAA@@ICEAGASAI@EBKB@@EDCDCDADH^FNHJ@@DDDDDDBDFLBHGFCFABBD@DAD@DBBB@BBDB@@ADABPDL@FBDBDBBDDHFHBBFDD@F@DBD@DDBDBDDBDDDDD@@@BBBDDD@BFFJHHFFBJDFBB@HCHED@@BD@DBB@B@BBB@BBB@B@JFBBBBB@BB@BB@@B@DCD@BA@@B@D@DABGJCDAD@D@B@B@BADADA@A@E@A@AB@BB@BBBDBB@B@@@B@F@@BB@B@@BB@BBD@@BLDJDJHJBD@DAHHXBD@D@BBL@JALCV@BBJ@N@XBDBHBBH@RBNBHBPF@@ALCNCHITCLCL@HDFFBJBJBB@@@H@F@B@FBP@FFHDDBJLBB@FDFBBBFB@BBBBFBB@BBBBDDDBBDJFDDBDBNBBD@@@JFB@D@BANGDCFCBCFIDAB@@@JBDADCB@B@D@DBTPHDFBBB@BB@BJBDDFBB@B@D@D@BABAB@DADABCFCDA@A@AB@@AB@BA@AB@BAHFHDFDFFFJFFBHBJAHAJ@FBJFFBHCDBDBFBD@DCDAFAHCFADA"],["@@HCICA@@@DD@D"]],encodeOffsets:[[[113324,31930]],[[113386,31909]]]}},{type:"Feature",id:"420528",properties:{name:"长阳土家族自治县",cp:[111.207242,30.472763],childNum:1},geometry:{type:"Polygon",coordinates:["@@KEICGAEGEEEGCIEAIBEBKBKBI@IDGDKBICIAM@GDCFCDGHCHEFI@AAICGCECEAQCIAOAK@E@C@KA@AIAIAG@CB@@@@ABCHELCHCJGDKAKAOAOBG@I@QDKHIDOJIHEJEHAJAJCLIFKDMBI@KBGDGHSPMJEFIFEBM@W@UCO@U@I@ADBDHHHHDDBL@JAJ@DABAHCLCHG@EDOBKBA@EBCDA@CBEBADCDK@C@K@EAE@@BADADCBKHCBA@CAA@ACAACAKCC@C@C@IAICGECGGEAIAM@GEEEE@CEGW]CEGAIEGA@@@@@@AAA@ACGAGEICACKCKEMMMISKCFIH@@GFIJEJDDF@J@LFABEFGDKJ@P@JDNDDBDBDDFFLBDDBFFDBDBDDBBDF@D@H@@MDABABCB@DAFBLBDBB@@@BB@HDBB@F@FALAF@FBDFF@BADCFMZ@D@D@DDDFDB@HCJED@F@FDFDTHHBDBB@@BABABCBIH@B@DB@HCFC@ADADABBBBD@B@F@D@D@F@BBBBDBDBD@D@FBBB@B@BAB@BBBBBBDBD@HBD@B@B@D@BBBDDDBBBBB@D@DFBD@DAB@F@DBDBHTHR@F@BCBAD@DBBDBDBB@@BB@FBB@LABB@DENABA@CCEAI@MBA@@B@@@FBB@BB@DDB@@B@B@DBFABAB@B@HAB@DABAFAF@@IHEDIFGBA@A@CACBCDCHA@ADCDCBAB@D@BBDHDLFDFBDBBAB@DBDBD@JBFAHF@F@F@D@FBB@FADAB@@FCFCHAFAH@BM@ABEDAF@BBD@DFD@F@D@HBH@H@JBDBBB@DBBFDDBHAFEDAF@FBNJDPBFDF@B@F@VAFBL@DBJCD@DBD@B@HBFBFFFFDHJJJFLFFFPHJDFDJDFBNBH@FAFC@MBI@GCECGCICEBCDCBEJIFGDGBG@IBGCK@M@IBILEnMRARGHAHCDGBGAGBGFGLCRCZE^CHAL@TA\\AFBJFBFFLFL@B@@@@FFFBHBFBR@L@JAHAFDFDDFDJDLBHAJBJDHFHJFHDB@DBBDBDD@D@DAFEHCHGBEBEBA@AACDCD@D@DABABCBABABC@A@ABADAD@BAD@DDBDBD@DAD@D@F@B@BFHFDLDHDFDDJ@J@N@JBLDHDFFDJBJ@F@HEDIBGDM@ADABCDCDAAA@A@@@A@CBC@AD@BBBDBDDFDHLJHDB@DA@@EK@CDCFBFCDAB@B@D@D@B@BAB@B@BAH@B@B@B@F@B@BBB@B@B@D@B@BABAB@BAB@@@@EAA@@CAG@AAA@C@@A@AEECCEAAA@ADCHEB@DKFCDCB@DBF@HCFEHEDAFEBCHGBCFGBA@E@EBCB@B@F@BBBB@@BAA@@ACACA@@A@@AB@BCB@B@BBBA@@BAFADABEFEBCBAHEDEDADABADCB@DCDAB@D@B@FJFDHBBBD@F@D@HAF@B@F@T@H@D@D@DADBFBB@D@DAD@F@D@BABADAHGDAHCB@F@JBDAFCHEHGDAFADAF@D@DBDDBDAF@BFBB@B@@A@@@CB@D@FDBBD@DCNCFAHCBA@@DABA@A@A@E@A@AB@BA@EBAB@BA@@B@@BAF@D@BBBJDB@D@@A@C@ADABABA@AEAAC@@@CBADEHCFC@C@CAECGAABCFCFAFBH@H@F@D@B@DB@BHDB@F@BABADABA@ABCBE@@B@FABABABAAGBCBAFG@A@AACAA@ABCDC@@@ABC@@JAJEDCDE@CAI@EBABAHCFCD@@@DAD@D@F@D@DABABABE@CAAAAC@G@CACA@ABMBA@@DABA@@ACA@A@GBC@K@EA@AAAB@@ABAL@DADAB@B@NFB@FBJFFDFJFDDJBARADAFAHCDCDGDCBEBIBE@KACEICCACGIECAAAAECAAAAAAAAAA@A@C@E@GAABABE@C@C@EAC@AAAAACAA@C@A@IDCBC@C@A@A@AAAAAA@CAA@C@CBC@@BABABADABCDCFCFAB@@C@A@AAAAAA@ICC@FAHCHE@A@A@A@EAECC@AC@AAAAC@A@G@CAACCA@@CGEEEAG@G@GEAAAAA@EAA@A@AD@@A@@@A@@@EBABAA@@EAGCA@@A@KAA@@EA@@G@C@@ABI@@AA@@C@A@A@CDC@@BAD@B@@A@A@@@AAA@ABAB@@A@ABCAAA@A@G@G@A@C@@D@DBB@JEBA@AEG@ABABC@C@C@@AAEBC@CBAB@B@BC@GBC@C@OBGBUJIBIBA@CIGGCCAC@E@CAEACEECC@A@CAC@CAACCACAA@A@ADEBABAB@HAB@ACCGECKEAAAA@ABABEDABAD@DBBBB@DA@ABKAACA@@CBA@A@@AMUA@C@CA@CEAC@CBADC@AAAAAA@A@C@AAG@A@@@@CCAC@CACAE@C@C@GAA@@AAAAA@ECA@AAA@ACA@CAACA@C@A@@B@BBD@@ABA@AB@B@B@B@@@D@@CBCAC@AD@@ADABCBED@BABGBEDCBC@C@CAEAA@A@@B@P@D@@K@A@ABBF@H@B@@CAC@ABBBFF@@ABABEF@D@@@B@BDB@@AB@@C@CDEDEDABAJAJAHAFGDABGBIDKFIDGFKFI@E@KAC@I@ICECGIMIECCBC@ABC@C@C@GBGEGCIEGC"],encodeOffsets:[[113606,30996]]}},{type:"Feature",id:"420529",properties:{name:"五峰土家族自治县",cp:[111.07374,30.156741],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CECEAKCC@ABABCDABG@AA@@@AFE@CGOBGDEBCBA@@@CACCCEAACCCGEA@EAEAACAAAACAAAMCA@AAAAA@KIKIIIKMGKEMEGAE@@@@ACEEGCIAA@UBQCM@Q@KDKDSCKCMMEECAAACACBG@GB_LOHEFABAH@@FHFHFHDH@@@HENGLAH@FDH@BDFHFHJ@P@H@JBHFFFHBFAB@BADAB@JALHLJFVNFFBHAHCHKBUJKDBDFFLJNJFDHDFHFHAFEFCDEBEDQFIFKBOFCBCFAD@H@JANWJMDIDCDCDAFBJBB@BBBFD@@JDNBD@FBFBBBBDADCHCHCBA@@@GB@BKHABG@AB@@EAC@EBE@CBC@ABCBADCHCFADBBFBDD@@FBNFJBR@J@R@JBDBLDHBRDFAPED@PCLABDBN@LDJ@BDHDLHNDJFHFJFPBDAHCFABTLNJNNLFLDBDJDHFHBBDB@BB@@@@@@HBJFHBDFX^FH@DFFFF@HBNBJHFDHHFJDJBD@D@D@LDDBBBBDB@DBB@DALGDABCBC@AF@FBL@D@L@DCBCFADAB@DCFAB@LAPAFCH@DGDKBGBA@CBI@IAKCCGGGGACBCJ@V@P@VDX@N@FAJEFENITOHGHCLAJ@NALCJEDKBIBIFGFIJGPIJCLGRCJ@H@PAPBLBLBHCDIDGFKDGBA@@@@DAH@JBJB@BLBD@F@L@PBJBRDFBFDHDJDBBJ@FEDGHGDCDEHCN@JBJDLAHCJCJ@LALAFAJAFBDJFHFFFHHBJDLFHDJFHDHFHAD@D@D@BAD@DAFDNJHJFDJDJ@D@LBF@J@LEHEJCLEJCHABAHCBEBGBIBIBAFCFCDCD@@@BA@@CA@A@A@@@CFEBABA@@EEAABAD@DB@@@A@GAEBAB@L@@@@C@O@AB@B@FBDBD@D@DAFCHABA@AFCDABABC@@BCD@DBDA@@@C@@@A@A@ABAB@BA@@AC@A@AA@@@AA@AA@@C@ABEDE@@BABA@ABA@C@A@@@A@A@@BAD@D@@BD@BAB@BA@ABA@CA@AA@AAA@@KMCEIIEGEGBEBC@AFCLAB@@AXBJ@JBJ@FFB@PFPJLHJBBBLCF@TCZCP@D@R@NBPCT@PBH@NCNEFIFGACF@H@HBFFNLHDPDJ@LAHEDCNGHK@@@@FGBCFE@AB@@@FIDCJKLQ@AECGEEEMCMCMCGECECGAGAKAKCIAAAEGCMCE@E@G@I@M@K@SAGAG@GAA@ABCAC@C@A@CAEEAB@@E@E@IAI@E@GBGBE@C@C@CAC@G@C@GBC@EBE@CBEBC@A@AC@A@AA@E@CBEBC@EBC@C@E@CAC@CBC@A@EBE@E@G@GBG@G@CA@@C@EBGBG@EBA@GBA@C@C@C@E@EBC@C@E@C@E@CAAAC@C@C@CBC@C@E@C@G@G@@@E@G@C@EBI@C@A@C@EBGBGBEBE@I@ABC@ADADCBE@C@GAG@G@C@EBC@G@E@EBABGBG@GAGAE@GBG@EDCBE@E@@AABCBABAD@B@B@B@DABADADB@@F@BBJBJ@F@FADADBF@BAFBD@BADC@E@I@E@GAE@EAEAG@ABEBCBGBK@I@E@EBCBEDG@ABABAFCDABCBE@ECEACAE@A@IDCBE@GBE@E@E@AA@CACCCECCAAAAAC@A@CB@@EDGBEBGBG@GAG@EBGAEAGAEAGCEAEA@@AEDCJEBGEICG@GFEFCDA@@@AAC@GAO@GBE@CBC@A@CAAEGGGGCECEAEAEBCBE@EAA@CAECECGEE@CBCBGDEDEDCB@@@@EH@@A@AB@@AB@BAB@@ABAB@@A@AB@@@@@@@@AB@@@B@@@@BBBBB@BB@D@@ABCDABE@EBC@GDKFGDA@ADAFCBEBC@CAEAGCEAECCCEAAAAAECCAG@CAECCAIACAECEAG@EBCBC@EBC@C@EBA@A@CBE@A@A@AAAAACAAE@ABABABCBAAE@CACAA@EBEBCBEBEBG@CBC@@@EACAC@C@AC"],encodeOffsets:[[113173,30779]]}},{type:"Feature",id:"420581",properties:{name:"宜都市",cp:[111.450096,30.378299],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@B@BG@A@A@C@AACA@A@AB@BABABCD@BABA@CDA@@B@D@D@BB@F@D@BAB@DA@ABA@A"],["@@IGIGEAGCMECAGHGFGFGFGBG@IEKEGAIEABC@K@IBIHCBEDEDGBC@E@KAEAG@EBCDEDGDGAA@@@@@IBG@GJEDI@G@I@C@IIEGCGAEACECKCKG@BKRILCDEJ@@A@@BEFADEH@@@@GLMHCDGFKBI@OCGCMKEEGAG@E@BDEHEJMFMDG@OAS@ODMAQ@C@O@YDSDE@KDAAIAKGOIOEA@EEI@IAI@WA@BA@KBED@BADAFFHFHJJDFLN@@BB@BBBB@@DAB@BABA@ABC@@AC@C@AB@@@B@B@@@B@DAB@BABAB@@CFAF@B@DB@@BBB@@B@B@D@B@BDDBB@BDB@BBB@FDB@BBBB@@BB@H@D@DBFBD@DBDDD@@@@@BBH@B@D@BBBBBBBD@BCDAD@FB@DDBD@B@NV@BB@B@DA@@DBBBAL@BCBA@AACAC@ABCBAFAB@BBBBBLFFDDHBDA@GBA@ABABCF@B@BBBBDDDBB@DBD@D@BDDFFBDBF@D@FBDDDHHDJB@JAJAVIHAPAD@D@HAD@@A@ABADAD@FABB@@@D@DADAB@BFH@BABIFA@CAC@@@@D@B@H@H@BBBDBBAB@@@BABAB@BB@@B@B@@@@ABC@AD@DCB@B@D@@@BB@@AJ@BD@H@@@FB@@BB@L@BB@HDFB@@BBBAFA@@B@@@B@@@BCB@B@FBB@BBBBHFH@H@FBFFDH@@DBBDDBH@B@D@BBBBD@@BDDBF@F@B@B@BGFGDEBD@JDB@BBBB@B@B@DA@EBEDCDADCBABABAB@@AD@D@DBB@DBBBBBBB@B@D@D@DAJCB@D@B@DBBBBB@BBD@F@D@DAFABBB@H@F@D@BBBBBBBBBBBFDBBBBFDHJBDDDFJBD@LAFAJAFCDCHCDGDEBCBQBABCIECEIECIEEAA@MEA@A@CBCBK@AB@BA@BB@BFBL@D@HAB@B@BD@@ABCB@@ABAN@BDBDBH@D@BBBB@DAFABABCBC@E@C@C@CB@@C@EDGDABAB@FBJ@DCFCDIFIB@@AD@B@@CDAD@BBBBD@B@BEHABADBHABABABEBA@@@AFAD@BABCBABABB@JHDLBF@BDBX@FBHDBFB@BBDDF@FBDBDBBDB@B@B@JID@F@BABIDEBAF@H@FALOJKBADEHAFAJ@NCFAFAD@DB@D@B@BGHEHAB@BB@FBH@D@BH@B@BB@FAHEF@D@FDBBAD@B@DB@B@DAFADAF@@@@@BB@@B@JBBC@G@AJeDKFW@ABADIFMDEBA@@@ABCBC@@FIDGLMFELKFGBABABCDGBABGBCFY@A@I@CACCQC]@A@A@A@@@@BIFGFGFEF@DA@@F@J@HBFBLBBBFBD@BBD@LDD@B@DBLBFAHAFAFAFCB@@@FEFCFGBGDGBK@CAGAMAIACAA@AAC@@ACAGCGAAA@AACECCAAAACEACEG@AA@CIAC@@AI@GDIBEFKBCBCDGBAHG@@BA@@FCDC@ANIBALIHE@@@@BA@@DADEB@HGNIDAHCJEFC@C@CDO@CBA@@@ABABAB@D@DBD@FABABA@ABAB@D@BB@B@DAF@@BBBAD@BAD@BAJE@ABA@A@@AAAACA@A@ECGAA@AACA@AAAACAEA@KBC@C@A@AAAACAA@A@C@AAAAAAAA@C@C@AAC@BI@A@CACCM@A@CBAB@BADABA@@@CBA@A@A@AAAAAGACCAA@AACAA@A@C@@@CBABK@EAC@AAA@A@G@A@A@C@CCACA@@A@IA@ACAGCKG"]],encodeOffsets:[[[114278,30889]],[[114230,30834]]]}},{type:"Feature",id:"420582",properties:{name:"当阳市",cp:[111.788312,30.821266],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BCGC@DFD"],["@@CGCUEOCCCBOAI@A@GBE@C@WBCB@@E@G@@@@B@@@B@@A@@B@@ABAB@BBBBBB@B@DB@BAB@BABABGJIHABCD@BABONAFADAB@B@B@B@BBB@B@BCDABA@CBCAC@C@A@A@ABE@CBA@A@CBA@ABAB@D@DADBB@BBBBBB@DBB@DBL@DB@@@B@J@B@DADCBC@ABABAB@@@B@@@B@B@@E@G@CA@@@CCCECAAECE@A@AB@@ADABC@CAA@AAC@C@A@AA@@CCEAC@C@GDCBADABCBCDA@C@AAAAGEA@K@A@A@CA@@A@ABCB@B@D@BBB@DGHEDADAB@@A@C@AB@@@@A@@C@AAA@@E@A@A@A@CEA@C@A@@B@D@B@@ABA@@DA@@D@@BD@BAB@@AB@@@BBB@BA@E@C@A@A@@ECEAACACAACCAA@G@AAA@ACAAA@@@A@@B@B@D@BA@@@A@ACAE@CBAB@@A@AC@@@ABADAB@@A@CAC@E@CBCBCDCBC@@@@GDC@A@AA@AAE@A@EEIICCACA@A@A@IHCDAFDD@BAD@BHNDH@DDJ@BCDCBCD@BDDCF@F@DBFBD@DBBF@D@B@BBBB@DDJBHBD@F@D@FBDA@A@CBG@AAACCECKAACCECAACAAAAA@AB@BA@AAAAAA@A@ABAB@@CB@@AB@@EFCDGDCDAB@BDFBJ@BADA@@@AA@A@A@A@C@@@@A@@@@D@@@DABABA@CACB@@ABA@C@A@A@AAAA@CAACAA@AAC@@AAAAC@@ACAG@CAE@AEAC@CAAAAGA@CD@@A@@AAAAACAC@@BAB@@@BBD@BADB@@B@B@B@@ABCBAB@BCBCD@BCD@BA@ABAB@@DDBBBB@D@BAB@BA@@BBD@@DDBB@BBBB@B@@@BAB@D@@BBB@@@BAD@D@BBD@BBBA@CFAB@BAB@BA@CBCBE@CA@@@A@A@AAA@CAACDA@EBCBAF@D@@ABA@A@AA@A@C@CAAAAA@AAAAAC@A@@AC@C@AACCAC@A@CBCDABABAFAB@DBD@DAF@FEHAJAF@DAD@DAFAJEPAP@D@BBBBF@BAD@DAFADCFCDEFABADCD@B@DBDDBBFBFBF@BHB@B@B@B@B@D@B@DADBD@BB@@BBAD@FAB@BB@DCH@D@B@DBBHLBDBFBD@FBBBH@F@BAF@H@D@BBB@B@BAB@BA@E@A@AB@B@DBDFHBD@B@DAD@BCDCBABGDGDEBEDCBEBGBAB@@@BBB@BB@@BBJ@FADADCB@@A@E@AAAACCA@CB@B@HBHDFBBLFBFLAPGFADCDC@C@ECE@ADCDEFAF@FDDFBDDBBDBBDBD@DBDBBDDBB@B@BAB@BD@@@BA@EBA@@B@BDF@BAB@B@BFFBBBBDBBBDAPADALEFEB@DBDFFFB@B@B@@ADAB@@@B@BB@ADABAHEBBBB@@@B@DAB@@ABCA@@@B@BDFBDDBFAJ@@B@@@BABBB@@FB@B@@AB@@BBB@BBB@@F@@@BBJFFBDAB@BEBABEHCBEBA@C@@ACBABAA@@@A@@C@A@ABGBABAB@@AD@@@B@B@@BDBDBB@@BABAB@BBB@B@@AB@BBB@BBB@BB@@B@FABABC@A@@DCB@@@BAJAD@D@BBBBDBBBD@H@H@F@BBBB@B@D@@FF@BED@B@@AJAD@D@BBD@@AB@B@B@BB@B@B@@AB@DDBB@BBB@DABA@ABCBA@ADBFBFDBD@FBDDBFADDDBDDDF@D@D@DCNGBABBDB@BBD@DAL@D@@B@D@F@JEBADADEBADGBAB@B@F@B@@@@@@@@ACAAA@A@@D@DAHBHDFD@DEFCFAHAJAFDBDBJDBD@FADAFABGHADBDDBBFBJ@FFHLNHHDBB@D@DEFEDAB@DBDBBDJHFDLDDDDFBFBHFNBHDJBDFBFBF@DBBDADABABA@ABAD@D@DBBBBDBN@BFBBBDBDBBDFBBB@D@FAHDHDFF@JBB@BAD@B@DBHBBBBH@HAF@BBB@BDB@@@DBFBFD@BFDDBBBBDBLBDFBDCBCBEAC@CACAACCAEDEDAFABBBBDDDFFFDBDADIFCD@FBFABA@C@CCG@C@C@AAI@AAGAEAAACAC@CDCD@DBD@DABABC@ABCBCBE@CDCD@H@D@FCBABAFABABABC@C@@CCEACAACAC@C@@CAAAA@AC@@K@AAAAAACGACEEAEAE@ACEAACCAACAABC@EBCBCDCDCDC@CAACAA@ADEBADCDKBCDGFIBGDA@@FAF@L@B@B@LLJDL@T@F@DABCBABCBEAC@CAEEGAC@A@@DCDCHABC@C@GBEDAAABAF@NAD@B@@@CG@A@ABAB@@ABABAB@B@F@@@@@B@@@@A@@@@B@BBB@@@@C@@@@@@BB@@@A@A@@B@BBB@@@B@@A@@AA@@A@C@@A@@@ABA@A@A@AB@@AB@@@B@@@B@D@B@@@@A@E@AB@@AB@BABA@AB@B@BB@@BAD@B@B@@@B@@A@AACAACAA@AEAACGAEAE@C@ACE@@@C@A@ADK@E@CHIBE@@@@@A@CAEAC@CBCDCDAB@D@@B@DADAB@B@DDBBBD@D@B@@ADADA@A@CACACACDK@@BC@E@CACAG@A@CBA@CAC@CAK@C@GAICE@@A@C@A@@A@CAA@A@AA@A@A@AAAABADEPQDEBADCFKDELKBADA@AB@@@B@@@@ABC@A@AB@AA@A@ABABA@A@@AA@@AA@@@@A@A@@@A@B@BC@@B@@AB@@@@@@A@@@A@@@A@@B@@@@@@A@@@A@AAAAA@A@@B@@A@@@A@@@A@@@@@@B@@@B@@CB@@@@@BA@@@@@A@@@@@A@@B@@@@@@A@@A@@@@@A@@@A@A@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@@@@A@@@@A@@@@A@@@@@@@@@@@AB@@@@@@A@@@@A@@@@@@@@A@@@A@@@@@AB@@@@@@@@@@@B@@@@AB@@@@@@@@AA@@@@AB@@@@@BA@@@@@@@@@A@@AA@@@@@A@@@A@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@A@@AA@@@@@@BA@@@@@A@@@@AA@@@@@A@@@@@A@@@A@@@@AA@@@A@@@@B@@A@@@@@@@@B@@@@BB@@@@@@@@@@@B@@A@@B@@A@@@@BA@@@@@@@A@@@A@@B@@@@AB@BB@@B@@@@@BA@@B@@@B@B@BA@@D@@@BA@@@@@@BA@@@@@@@@@@@A@@@@@@@A@@A@@@@A@@@A@@@@@AB@@A@@B@@@@@@@@A@@@@@A@@B@@@@A@@A@@A@@@@@A@@B@@A@@B@@A@@@@AA@A@@@@@@@AB@@ABA@@@A@C@AAA@@@A@A@@AA@A@A@@AAAAA@CAAAAC@AACA@A@A@A@BCBEBAFC@@DEDCBEDCHIBABA@A@AECADA@A@CAACACACCCA@BCBAB@F@F@BD@@BH@DHDBABABABCBE@E@@@A@A@C@ABAB@D@BCBE@ABC@CA@C@@AAC@GACAACA@@G@G@@AAC@@CCAA@@C@C@ABA@@BB@BB@B@BA@@@EAA@A@IEAB@BBDADC@A@AAACA@@@@CAAA@A@E@A@CAAAAAAAA@CAA@A@A@GCA@AAA@@ADC@A@AEEAQACCCCCAABEACA@AAAAA@A@C@ACCAAACCAA@AA@ECMECAAABABCBGBCACEMIGGCCA@@AAB@@ADAD@B@F@FEBAB@FBF@DABABABA@ADCDI@IACCCSYIM@AAA@@"]],encodeOffsets:[[[114764,31434]],[[114672,31261]]]}},{type:"Feature",id:"420583",properties:{name:"枝江市",cp:[111.76053,30.42594],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@@@OAJeI@EDIFGDCBMJGHA@CFCB@@AB@@@@GFKJABMJ@BCDED@@AB@@GHABCHADADELAFCJ@HBJ@@BDDJB@@BFHBDDFBBBBDDDFBBB@BBDHBHBD@@BD@BBBBDBJBNBH@DALCHAHEHEDEF@@A@EDEBEBGBEBKACAA@C@KCC@AAC@EAAAKAEAGAI@E@@@CBE@EFEHEHAJ@@@@@B@B@BD^DRBD@D@JD@HAFBBBBBBD@HBFFFDDBD@FBBBBBF@LAD@BAF@FBBBBHAJENMBAB@@B@@EDAB@B@BB@B@B@DAFAB@B@@BCD@@@B@BB@BH@F@FCDBD@@BBB@@@DCFI@ABA@@@C@G@A@CBAB@HHHJBBBDFFFDB@BB@D@@C@E@CBABEDCBCBG@CDAR@H@@BF@FDHHEFAD@D@DBDBFDDBB@DDDB@B@DHABBBB@FAB@D@DDBF@HBBDDJ@D@B@DBH@DBDD@F@F@D@HDDBD@D@F@HAFAD@BBDBBBDAF@D@DBBDD@D@D@JBHBHBF@@@B@FBB@D@B@B@@B@B@@@@B@B@@@@BA@@B@@B@@@B@@ADDB@@BBB@B@@@BD@BB@B@@@BB@B@D@@@BB@@BB@@B@@@@@B@D@BB@@B@@B@@@@@@B@@@@@@@B@B@@@@@@BB@@@B@@@@@@@B@@@BBB@@B@@B@@@B@@@@AB@@@B@@B@@@@@@BB@@@B@@B@BB@@B@B@BB@@A@@BB@@B@B@BCFABBBBD@BCDABCFABAD@B@DADBF@DBHBD@@BDBB@BD@BBB@DBBB@DBBBBB@B@D@B@BA@@DADBB@BABA@C@@@C@@B@@@@@@D@B@B@BBB@@B@BC@AAICE@ABADCHCDCFE@@BA@@DA@@BABAB@B@BBBB@BABA@@BBBBBDBBBFDDDBBDLDFBDBBH@DAB@B@AC@E@C@EACAGCI@CAAAAA@C@E@AA@CACAE@C@EDECC@ADCDADC@ACI@CCGGM@ABC@ACCBEDCJGB@B@B@BDDDJJFFB@F@BBB@@B@BCD@H@@D@DADCDADAF@D@DBB@@@BABCBA@@D@@B@BA@AB@DBFBDB@@@B@@A@C@A@AB@@@B@BBBDB@BBH@B@DBBDDBDBBBDF@FB@B@D@F@B@@AAA@A@@BA@@BA@AAC@@@CB@@CB@BA@@@A@C@AB@D@B@DFB@B@B@F@@@BB@B@DB@@@@@BAD@B@@@BABCFCHG@CAA@A@C@ADABAB@@@DBB@B@L@B@HFBBBBD@B@DCDABABCDAHCD@D@FBDD@@BBB@D@D@BBB@DBD@BABC@@BAB@F@FDBBFDDD@D@@DBH@F@@@@A@A@@@A@@BABABAD@DABC@C@A@I@A@@CAK@CAA@CAA@AAAA@AAABC@C@CBABAB@DAB@B@DAF@BAB@B@D@D@DBDAB@BADC@A@AAA@A@A@A@ABABCBEPMBA@ADCBAJGHIBABA@ABA@ACAA@A@AAAA@ABABA@@@AB@@@@A@@@A@@G@C@CAAA@CAC@C@AAE@CAAAC@CAE@ACACA@A@AB@HCDAHAFAB@BA@@@AAAC@ACCCCC@A@ABCDEBAAAA@A@C@CBAB@BABABABAACAAAAA@CBA@ADADCBADA@@DEDCBAB@BAD@D@DBB@B@DAJEDAFAH@F@D@BB@@@B@@EBCBCB@BAB@@BBB@B@DADCFAB@B@BB@@ABABABA@@BB@D@F@@AD@DCBAHEHIFMBABGBABEDYAMCO@K@O@A@ABKAGAE@CDEHGDGFINSDCNMBCNKDCBARKJGJCJEPAFE@@B@@ABAAC@C@CAEAAAAA@EAAA@A@A@ABA@A@CBAUCE@O@O@G@CBODCBMHKLCDCBGHEFORCDILCDIPCJEJAFGJ@BGJEBIH@@CBGCE@KAAACACCAG@EAEBE@E@CBCGKEKCGAIBE@A@E@GAGEGEGCICEIBKHMBIBMFG@C@IBOBMAGAGCGBEBCH@HBD@DABCDEAE@CAAAE@EFAF@B@BGHCFEFCFEHEFAFCDAHAB@DAFAFAFAF@F@FCJCF@BCDCBCBEBEBA@GBEBGDG@E@A@A@CBGAA@EAICA@ECIEGCEEGEAACCGECAIIAAKEGEGCGCGCGCIEMEICGAKEGCGCCCCCGGACACACCGEICEEECGECAG@AACCEAAA@AEORWE"],encodeOffsets:[[114261,31001]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
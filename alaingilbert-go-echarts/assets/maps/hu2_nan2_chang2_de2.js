@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("常德",{type:"FeatureCollection",features:[{type:"Feature",id:"430702",properties:{name:"武陵区",cp:[111.683153,29.055163],childNum:1},geometry:{type:"Polygon",coordinates:["@@LC@@@@FAJCDA@G@EAGCG@@@@@AEIACACAAAAAAA@@@A@A@AAA@A@A@AAA@A@@AA@ACBCJODC@AFEFAF@F@J@BODG@ADIDCDCBA@EAEAAAA@AIAIAE@K@IFMF@BYJI@ABGCGCIEHQCE@AC@@CBAA@@IM@BCA@@@C@@BA@AE@CDC@AE@CI@EBABABBBDD@@BFCHCFBHCKGO@E@A@@AA@BA@ABCEACFGEECCABCB@@CB@@ACAEAC@@AEFAB@AC@EB@FGDIE@ECC@DCACCGEBECCCKDGA@EFC@GDG@DF@DG@CFEBABCBAFADIHCB@BDB@DABABDD@@BBABABA@CD@DAD@B@B@B@B@@BB@B@B@@ABEDB@HB@@B@FDHFB@FDF@FBF@DDDFHN@BBB@B@JBF@@@DDDB@FJBF@FAL@JDJBH@B@D@D@F@B@DCD@@@BCDADCBGDABA@@@A@A@@BA@GBE@@@C@I@IAMCAAKCGGEG@ACI@I@GDQBG@K@CCGKKEACCGCEACAKCA@A@A@C@E@G@QFIBIFID@F@DAB@D@F@DBBBDDFFFDDFF@@PHBD@BB@@BDFBDBB@B@@BDB@@BBD@D@BABAFCFKFABABML@BIHAB@DAB@B@B@@@B@B@B@B@BBB@B@D@D@F@B@DAB@B@B@BBB@B@@HJDDHJBBDBFDF@F@FCB@LILEHBBBD@D@D@D@B@B@B@DAD@DABABAB@BABABA@@@ABA@@FAD@B@BBB@@J@HBL@JB`HJFJDdEAAAEBEBC@ABC@DLFDADJJAJXJHBF@HGAHFE\\LDHEFGDDHFDEH@HBH@FRKHFFCBCHALBF@H@HADAHGFAB@D@D@FBDBB@HBF@JBHAFCDEFAFCCS@IEE@@CECCAC@A@C@C@ED@JAD@@@J@FCFGDIDGHI@@LGHAHAPCR@DBFEBA@CBGAEAA@ACAACEGAACA@CAC@AAAE@C@CDCBC@C@A@AAAAACCAGMAAEIOIGACE@A@A@CBCBA@CBA@@@A"],encodeOffsets:[[114477,29742]]}},{type:"Feature",id:"430721",properties:{name:"安乡县",cp:[112.171131,29.411309],childNum:1},geometry:{type:"Polygon",coordinates:["@@AACGCG@@@C@E@GFSDGA@C@A@ABA@A@CAA@AAAA@@@A@A@A@@@CAE@A@AC@C@AB@@@@GBGDEDC@EDEBGDEBCBIDCBKFEDGFCBABABEFAF@FBF@F@HALCJEH@BCHAH@FDJ@FADAFGPGJGNCDA@A@ABC@EBIFKHGFGHEDEFEFENAFADADAFAF@FCNAF@J@F@JBJ@F@F@D@FABADCJCF@D@DB@BBBB@BB@@B@BA@@D@B@@@BBBB@BBBBBDBFBD@B@BABAFCDAB@BAF@FADADAFIJEFCDCD@@GFGFKDMDCBKBC@EBIACBA@CFA@AL@BABMJIHCF@BA@AHAFCN@D@BBFBDDBDDDDDBDBDBF@B@B@B@B@B@@@BBB@B@B@BBF@BBD@DAD@F@NCB@JADAD@D@FBJBFBB@B@HDF@BBB@@BB@@@@B@D@B@@@B@@BD@DBD@@BD@BBBBBBBBBFBDDDBBD@B@B@FBB@B@BAB@BCBA@EBKHQJABABEFKNCJAD@HFLBDFJBFFJBH@B@D@J@BADAFCJAD@@@H@B@BBJ@@@DBDDF@D@F@B@HBDDDBDDD@F@BAHAHCFEFCD@BAB@H@HAH@JAJCHABEBAD@B@D@DAD@D@BAB@B@BAD@B@@ABAB@@@@A@ABAB@@BB@BB@B@BB@B@B@@@@A@A@AAA@ABA@@B@D@BBB@BDBB@@@@@@B@@@B@@@F@BBD@@BFBBHLFHBF@FBFAD@BAD@D@BA@BD@BDDBBBBBBBDDF@B@D@BAB@D@B@B@@B@@@B@@@B@B@BA@@B@@@BA@@DCBABABAB@@@BBB@@B@@@@@B@@ABAB@BAB@@@BABABEDADA@@@@BABBB@@BFB@@BBBBBD@BBBBBD@BBA@A@A@ABC@CBA@A@CAC@A@A@AB@BCBCBC@@BADADAB@BA@A@A@@AA@CA@AC@A@AAC@C@C@C@G@A@ABA@A@A@A@AAACCAAAAC@AA@@A@@@AA@@@C@@ACBA@@@ABA@CAA@@@AA@A@AAAA@A@ABCBABCBC@ABC@CBA@A@@DADAD@B@BBDBB@B@D@BAB@D@@BD@@@DBB@@B@BDB@BB@B@@ABABCBAB@DEHCDABCDABA@CDE@EBCBCB@FB@@BBB@BBB@BABCBC@@DAB@@@DBD@B@B@F@B@F@B@B@B@B@D@FABAFAD@@@BBHBBBDBBB@@DDB@@BB@D@@@DAB@@AD@@ADAD@DCBABCDEDEBI@EBK@ADC@@BABA@A@EBABABADAHG@@@B@@@B@@@B@B@@@D@D@B@DBB@BDDBDB@DBB@D@DAB@BAB@B@D@BBDB@B@@BB@D@B@B@@@BABAB@B@@@B@@BBBBBB@@JFDDDBHDBBBBD@BBD@@@B@B@@@D@AA@A@CACACAA@CAA@@AAAAA@EEACAA@@ACAAAC@C@@@AAA@C@@@A@ABA@ABA@ABABABABE@ABA@@@@@AB@FC@@BABABA@@B@@@B@BBB@BBB@H@@@DAB@BA@@B@@A@@BAB@BADA@@BABAB@BAB@DA@@DADAB@@@DAB@BAB@BAB@B@BAD@@@B@@AD@B@B@BBB@B@B@B@@@B@BA@ADABC@AB@BCDCB@@A@@@A@AAA@@@ABC@A@ABA@A@A@A@CAE@@@A@AAA@A@@ACAAAC@AAA@A@AAA@@@AAEACAAAC@@@AAA@@@AAAAA@@CCAAA@A@A@AAA@AA@@AA@AAC@C@C@@AM@C@I@AAE@A@CBA@ABE@CBABE@@@A@@BA@A@@@@B@@B@ABEBA@@@AB@@ABCBCBA@CBABE@CBA@A@A@E@A@CBC@A@C@E@@@A@A@ABA@ABA@AB@BA@@BA@@BA@@BA@A@A@AACAA@A@CAA@AAC@CAA@EAC@A@C@A@@@@AC@C@C@C@A@AB@@A@C@ABA@A@CAAA@E@A@A@CAA@@AA@@AAAAE@A@AAAAAAA@@A@@A@@ECCA@A@@CAGGACCCACAA@AAA@AAA@CAC@CAA@CAAACEMACAA@A@CAC@C@C@AAE@A@CAC@C@C@C@C@CBC@CBCBC@CBCBABADEBCBA@@BC@@DEBABAA@@AAAACAACAA@EAC@CBA@A@@@A@CBG@CBCDCDC@A@AA@AEAC@CAA@@@EBEBCBCAA@@CBCB@BCFCFA@ABA@C@E@G@G@E@C@ABIDGDGBE@E@EAG@C@IAEAGBCBI@E@A@AFAD@DADACCIMIIAACAKEOOAAKMAC"],encodeOffsets:[[114894,29878]]}},{type:"Feature",id:"430722",properties:{name:"汉寿县",cp:[111.970514,28.906106],childNum:1},geometry:{type:"Polygon",coordinates:["@@AGAA@@AE@ACACAC@A@C@ABA@C@C@A@ABADABCBABEBCDCDAFAFAF@F@B@@ABABC@C@A@C@CBCBC@EAC@A@ABADADABABA@CAAAACAC@C@AAEACAAC@A@CBCBGFCDADEFAFADAFCBCBCBABA@@BADBF@BBDDFBBADAB@D@BBJ@@@FAD@F@F@@ADEDCBCBAB@D@BABABABA@A@AACACCCAC@C@CBC@A@CAC@E@CDABE@@BA@@@@BA@@@@B@@@@@B@B@@@B@@@B@@BB@D@@@B@@@@@@@@@@AA@@@@@@A@@@@@@@A@@@@@@@@BAB@@A@@B@B@@@B@@@@B@@BB@@BB@@@@@@B@@@@BB@@@@@B@@@@@@BBB@@@@@D@@@@@@@@@BB@@@@BB@@@B@@@@@@@DA@@BAB@@@B@@@@BBBBB@@@@@B@@@@@@A@@@ABA@@@AB@B@@@@@@@B@@@BBB@@B@@@@@@@@@@@BB@@@@@@@B@@@@@@@B@@@@@B@@@B@B@@@B@B@B@B@@@BA@@@@B@@@@@@@@@B@@@BA@BBA@@B@B@B@B@@@@@BA@ABA@@B@@@@@B@DH@@B@@@@B@@@@@B@@B@BB@@@@BB@@@@B@@@@@B@@@@@@@B@@AA@@@@A@@@A@@@@@AB@@@B@D@B@B@B@B@B@B@@BB@BD@BB@@B@B@@@D@B@D@@BB@BBB@@BBB@B@@@DBB@BBB@@@BBB@B@B@B@B@@AB@@BB@B@@@@@DAD@D@B@B@@@BD@@@B@BAB@B@BB@@B@BBBDB@@@@B@@B@@B@@BB@@BA@@B@B@BB@@B@@BBBB@@@B@@@B@@@B@@@B@@@@@@BB@@B@B@@@B@@@B@@@@B@B@BA@A@@BB@@BBBB@BB@B@BA@@@@B@@@D@BB@@@@BB@B@D@DB@@@B@@@B@@@@AB@B@@@@@B@@BB@@B@@@BBB@B@@@BB@@@@B@@BB@B@@@B@@BB@@BB@B@B@BB@@B@@@B@@B@B@B@BB@@@@BB@B@FCB@B@B@@@@B@@@B@@AB@@@@@D@B@B@@B@D@@@BA@AB@@@B@@B@@@D@B@@AB@B@B@@@BBBB@@AB@BCBAB@B@BBBB@D@BAD@B@@@B@BBB@@@BEBCD@@@@CBKHCDGFGDKJABABA@@@@@ABC@A@GBEDGDCBCBCDED@BKFED@@@@ABA@@@@@E@@@A@@@@@A@@B@@A@@@@@@@AB@@GDABCD@@MF@B@@@B@B@BBD@DBDBFBDBBJFDDDNBV@B@BBDBBBB@B@BA@ABBBBD@B@DC@@B@@@BBAB@D@DADAHEDCB@DAD@H@D@H@FDDBDBDD@BDFDP@L@BAF@FAD@BCBAB@BAB@B@BDB@BBBBBBF@FABCDCDCJ@BCHAP@H@FDDFDFBHDHBFBH@N@JAPCD@HABA@@AECEAC@CBEBEDCBADAD@D@NALAHAJ@BADABABEHEPCJAL@LDNDPNJJBFABABEDABAB@B@B@BBD@B@DA@@BABCBCBCBAD@B@D@BB@BBTJB@BBBBDBBDBDBBBDBDF@B@D@B@FBBB@B@B@@ABA@@B@@@BA@ABA@@B@@@BDDDBB@BB@@BD@@D@DD@BBBBBBDBBBDBB@D@BBBBBBBBB@@@BB@B@B@DBBB@B@F@B@@ABAB@DABAB@B@BBBBD@@@B@B@@AD@D@D@BADAB@DAD@B@BBB@B@BBB@@@@BDDB@@DBD@DBFBLDF@DCBABABEBCBABAF@FAB@HCJCHAJ@DAD@DAB@BA@A@@BBBBFFDB@@DBB@BBFBB@BBBDBB@D@@@B@@BB@BBBBBHL@FBBBDB@B@FBF@F@FAHCFCHGHEDAB@B@@DAB@BAB@B@@@@B@BBBAB@DABABAB@@AB@BAB@D@F@D@H@B@FBP@NBFBHBHDHBLDFBBBDBBDBBBDBBBB@@BBBBB@BBD@FAD@D@B@BF@@BBBB@B@@@@@B@@BAD@D@DABAB@B@B@@@BA@@B@B@@@BA@@B@@@B@@@BAB@B@BAB@@@BA@@B@B@@@BA@EAABA@CFCBADAB@BA@@BBB@@@B@@B@@B@@@BB@@@@@@B@@@BB@@@@B@@@BA@@@@B@BA@@@@B@@@B@@A@@BA@@B@B@B@B@@@B@@@B@BCBE@@DEBADA@@DA@AB@@CAA@AAAAC@ABC@EAE@GAE@CAG@EBGBE@ABC@@DEFCDEBCDCBA@A@@AC@C@@@AA@@A@@@C@A@AAA@A@A@@@A@A@C@@@A@A@AAA@A@A@A@@@C@A@@@A@E@@@A@@A@BAAABC@ABAA@@A@A@A@C@A@A@A@A@A@A@@ACCC@G@AAUBCBCBADAHCDG@CBiBqBCBEBSEIAC@O@CAa@YDGNIDCJKHITKJEFCTMBABC@C@CAE@E@C@@@CBE@E@EACCEAEACCEACCACACAA@CAC@CACACE@EBC@C@EBG@EAE@E@C@E@ABGAE@C@E@EBA@CA@A@C@AACCECA@CAAAA@CACAA@CAE@EAA@CAC@AAC@CAA@@@GBC@CACAC@AAA@AA@ADCAAACCCCAAAAACCEAA@AA@CCAAACAC@C@CA@ACGACCAAC@CAA@EAAACC@A@C@@@AACAAAC@C@CAA@C@CDEDA@ADCBCBADCBA@A@AAAAAAAA@AAC@CAAACAAA@@DA@AB@@AAAA@AACAA@@A@CAC@C@A@AA@A@ADADADAD@@AAAACCAAACAEAE@KBO@CBIBI@E@C@A@A@AAACAAACCECAA@C@A@@BABABADADADABABA@ABABC@C@A@ABADABAD@D@B@D@DADABABABC@C@C@CAAAAAA@A@C@ABA@@BCBC@CAC@CBA@A@CAC@CBC@CBC@C@AAAACA@A@ABCFEDCDADAD@B@DA@A@CAC@AACCGAC@C@@@CAA@@CAA@E@C@E@C@A@CBE@CBCBEDEBCBC@CBC@@@ABCPABABC@CCCAECAAC@A@@BAD@B@D@BBDBBDBBBA@ABCBEBGBEBC@E@GDA@A@EBCDA@@@ACADABA@C@E@A@C@ABGAA@E@C@EB@@EBC@ABCACAGACACAAAACAEBA@ADAF@BABCBA@EBAACAECCAAAC@AAAAAC@ABC@CAAA@CAEAA@AC@A@CBCBC@C@C@EAECCAGACAA@CACBCBCBABEDC@CBC@A@CAEEAAAGAE"],encodeOffsets:[[114532,29313]]}},{type:"Feature",id:"430703",properties:{name:"鼎城区",cp:[111.680783,29.018593],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@CEIEEEA@CACE@@GCGESSA@ECEAEAE@A@A@@D@D@D@@BLDJFNBRDFBHBFBHBF@FABIDE@EBAD@@@BBJBJAB@B@@CBABE@CBCDCFCFADBD@DABABEBCBCDADAB@D@FBDBDBB@DBBBDBBB@BB@BAD@BAB@@BB@B@B@BEDAB@B@D@DBDDDBB@@@@JFDBDFBDB@DBBDBB@FBF@B@DDBB@B@B@HBBBDDBDBF@@A@ABC@C@@BA@@D@B@@ADBD@D@@A@CACAC@E@CBCDA@A@A@EC@@CAC@ABABAD@D@DBD@DABAD@@ABCF@B@BB@@BABADAFEHCF@BAD@BBBBBBBD@D@DB@@D@BA@@BABA@A@CBABA@BB@DBDD@BBBB@@@DAB@DAD@D@BADABABADA@@B@@D@F@DBBD@@@@@@@B@B@@@BBBB@DBDBBB@B@DABAFCDADADBB@BBAD@F@DBDDFBF@@@F@H@H@DAF@B@FBDABADEHEDAD@BAD@B@B@F@FADAD@@ADAF@FBBBB@BBDDFDFDFBB@D@BAD@B@B@F@DBF@D@BDDBBBD@@BFBH@B@D@HCLBDBFLXBDBBFBFBL@LBLFJFDB@@@@ZNJDFD@BJCJEJAREH@F@D@B@B@B@LDDBFBHDDDFBLLDH@D@LAHCR@H@JDJ@BFHHHLDBBNDJBJ@D@@@F@HAB@@AB@B@@@B@BAHCDABCDC@A@@DC@C@A@E@C@C@AAGCI@IBK@EAEEIA@CC@C@@AE@I@AAA@AGMCECCE@EAE@ECA@GEECA@@@GAA@FCBA@@@A@AAA@@@A@A@A@ABC@CDCB@BABAAA@@CCBABA@CCA@ADAJGBCBEDABAFADEH@@CCEH@HCD@FEB@CHDLDDAFHFDDDB@CDD@FJFHC@EFAD@@BBAFE@BD@FBDB@BA@@DA@ADDBFDHFDEFBAD@BABB@@BB@F@P@LHGDEAGDED@AC@ACAAABAB@FDJF@@BCD@DBFB@@AD@@@B@ADN@@JB@AB@DD@@BDFGRJFHDHDBAJ@ZI@ANEJEL@F@JBJBCA@A@ABA@ABADA@ABC@EBE@A@KCOCE@ACCCACAECG@C@G@C@CBA@CDGFCBCBC@A@AB@A@@@AD@@C@AACAABAB@@A@AAAAAAC@A@AAUCMCCIEAAACAEAC@CAC@A@A@A@@@ANE@@DCBAHC@@BA@@@@@@B@@@@AB@@@@@B@@@F@@@@@B@BA@@@@FCLE@AFCDCDADAHCFCHAB@D@BA@@@@B@BABALIHCHEDCLGDA@@@@DCFA@A@@AA@A@A@@@ABC@A@CAAAAA@A@ABADA@@BA@AA@A@@@A@ABA@@@A@C@@@AA@@@A@@BAB@@C@A@@@@A@A@C@@@@BA@@@A@@@A@@A@A@A@EDA@A@@A@@A@@A@A@A@AA@@@A@@@AAA@A@A@@AA@@AA@@@A@A@@AA@@@@@AA@@A@A@AA@@A@@@AA@@@A@@@@@ABA@@@@@A@@@A@@CAC@A@A@@A@@A@@A@C@@@A@@B@@A@AAAA@AA@AA@@AB@B@@A@A@A@@A@@@A@@@A@A@@@AA@@@@@@@A@@@A@@@A@@@A@@AAAA@@@AA@@A@A@AB@@AA@@AA@@@@AA@@@@@CAAA@A@AA@@A@ABA@A@A@@AC@@A@A@C@C@CB@@@@A@A@@AA@@BA@A@A@A@A@AA@@A@AAA@CA@@A@A@AA@@AA@AAA@@@C@A@C@@@A@AA@@AACA@@AA@A@A@A@A@A@C@A@@@A@@B@@@@@B@@@B@@B@@BA@@@@@@@A@@@@@A@@@@@AA@@@@AAA@@@@A@@@@@A@@A@@@CGA@@@@@A@@@AB@BAB@@@@A@A@A@A@@@AB@AAB@@A@@@@@@@@@A@@@@@AB@@A@A@A@A@@@A@A@@@A@@@@@A@@@@@@@A@@@@@@@A@@A@@@@@@@@@@@AA@AA@@A@@@@@@@A@A@@B@@AB@B@@@B@@@@A@@@@@A@AAAA@@@@@A@@BA@AB@@C@@@@@@@A@@AA@@@@AA@@@@@@@@C@@@@@A@AA@@@@@@@A@@@@AA@@@@@A@@@@A@@AA@@AA@@@@@@A@@@A@AB@@@BA@A@@@@@@B@@@@@@@B@@@@@@@BB@@@@@@@@@@@A@@@CAA@@@A@@@A@@@A@A@@@@@A@@B@@A@@B@@AF@BADCF@D@DBB@D@DAD@D@DBDDDBBBB@B@BABABA@A@CBADADAFCBC@@@E@EBC@E@@AI@A@CBABCAACEAC@AAEBC@A@@CACAACAACAA@A@C@GBE@CAGACCAACC@ABAD@D@B@@A@AAACAEACCAAACBC@CB@@E@E@CBADCBCBAAC@A@CACCCCAAACCCCAA@EACAAAAC@ABCBADABA@AAACCCCCCACACAE@C@EBA@CBE@C@A@A@CBCDABAFADAFADAF@DDF@DAF@HABAD@@CBEBEACAEAE@C@CDADABC@C@A@CACAAACICAEAIBA@@@EDUFIFCBKHKFOFGBC@E@A@C@ACIKA@ECCCCEAAEEEEII"],["@@@@ABA@AAC@@BABA@A@A@A@A@A@ABABCDCB@B@BAB@B@B@@@D@@@BB@BBBB@B@@BBBBBB@@@BBBAD@B@BAB@@AD@@@B@B@@B@B@BBB@@@B@@@B@@@B@B@BBB@@@@@B@@B@B@B@@BB@D@DBD@FBFBJ@D@DBD@B@D@@@B@DAB@BA@ABABA@ABCBA@ABABAB@BAB@DBB@BB@@BB@B@@BB@B@B@B@BB@BB@@B@@B@@B@@B@@AB@@@B@@@@@B@@BB@BB@@@BB@@BBB@@@@@BBD@B@@@B@D@@@@ABA@AB@@@BA@@D@@@@@BA@AB@@C@@@@@A@ABA@@BA@@@@@AA@@@@A@AB@@AB@@A@AB@@A@A@A@A@AA@@AA@AA@@AA@A@A@A@@BABA@ADAB@BAD@BAB@B@D@BBB@B@@B@BB@BBD@B@@ABA@A@ABA@ABABADABABAB@B@B@B@BBD@@BD@BBBB@B@BBB@BBDBBBDB@@D@DBB@@@D@B@B@@@DA@ABABAB@@@B@BB@@BBB@BAD@B@BA@@D@BBD@@@B@BB@@BBBBB@@BBB@@BBBBBB@@B@D@D@DAB@DBBB@@BB@BBD@@@D@@@BB@@B@@@@@@B@B@DAB@BABABA@@BCAABA@@@@BAB@@@@@B@@@@@B@@@B@BABADCBA@@BAB@B@BAB@B@B@BBB@D@@@DB@AD@@@BA@@BAB@DAB@B@BA@@B@BBB@BBD@B@NFB@HBBBB@BB@@@BB@BB@@@BB@BB@@@BB@@@@@B@B@@@BA@@BBB@B@@@B@DAB@BAB@B@BAB@B@BBB@B@B@D@B@B@B@BBB@BB@@@B@B@@B@@@@@B@BABAD@@@D@BB@@BB@BBB@B@@@B@@BB@@B@B@B@@@@AD@@AB@B@@@@@B@AB@@@B@B@@@@B@BAB@D@BBB@@@D@B@DBDAB@D@@@B@@@B@@@B@@@BBB@B@B@B@B@BA@@BC@ABC@C@@BCBCBCBA@@@ABAD@B@B@B@@BB@BBB@BBBDBB@B@B@BBB@BBB@BBB@@BB@@BDB@BBBBB@@BB@BB@@@@B@@@B@@@@@D@DBDBBBB@@@BB@B@BADCF@B@@@BB@DFHBFBD@F@@@BABAB@@@BAB@DABBB@B@B@B@DAHG@@BAB@@AB@FDDBB@@BB@DHDFHHFBF@D@FADBBFHDJBD@FAD@@A@C@ABC@@B@DBB@DBB@D@B@BAD@B@@@B@B@B@@@BA@@B@B@@ABABA@A@@B@@A@@B@DA@@BB@@B@@@D@@@BAB@BAB@B@B@@@B@@@B@D@B@DAB@BABC@@B@B@B@B@LBD@D@D@B@F@@@@@B@B@B@B@B@B@BA@A@ABE@A@@BCBADCBCBADC@@@ABC@E@E@C@CBA@CBA@AAG@CBC@AD@@BDLDFDJBBDFFHFFBD@FAJEH@B@DDHDDBBHBTFDBHDPJDBF@BAB@B@DAB@DBDBDBBDBBBFBBBBB@BBBBBDBD@@BD@B@DBB@BBD@@@BBBBDBBBBBBBFDDBBBB@@@BBBBB@B@B@@@B@D@F@B@@@B@B@BBB@@B@B@@BBDBB@@BBB@@@@BBBDCDCFEJIBEBCBC@EBE@ABADCBEBA@A@AACAEACAAAAA@AA@A@@@A@CB@@A@AA@@AAAAAA@@C@CDEDIBCBA@E@C@E@EAI@I@E@IBEDM@EBEBEBCBCBEFMFEFEFCHGHELGJEFAD@BAB@B@DCHMHIHOBEBC@ECI@EBGDG@AFGDIBK@G@EAE@EBEFEBABADAHEFCLEDAJCDAFAHCFAFCD@FCHCHA@@@@@@@A@@@@@AAAAA@@AEA@C@C@EBC@AAA@AAAA@@AAAAACAAACCAAAEAKCGAGCGAEAMAO@EAA@G@C@E@C@A@ABA@@BA@ABABCBA@ABAAA@@@@@@ABA@ABA@CA@A@CBGFGHEDGDEBE@E@EAA@A@ACAA@EGKAAAA@AAA@@@A@@@CAAACAAA@EAAAA@CA@@CAEEAAAA@@@BABA@CBC@CBI@GBIDGDA@EBE@ABABADAFABABCDE@KCEACAC@CA@@CAAC@@@@AA@A@AAA@A@ABC@CBABC@A@C@CBC@@@A@A@@ACAA@A@ABABA@CBABA@@@A@E@AAACAA@A@A@@A@@AAAAAAAA@A@CAAACAAACAAAA@ACCC@@@AC@@AAA@CACC@A@@@AB@BAB@@A@@@AB@BA@@@A@AAAEAA@C@A@E@ACACAAACACCAAAAAA@SIAAA@@A@C@ABCDADADABA@AB@@C@AAC@A@A@ABABAFCBABAAEIIOMMCKCK@IBODGFAFABCBABI@GBKBMBC@C@CBABCDAFAF@DBDDFBF@@ABGBC@ODIBM@G@EAGAGCEAECCC@E@GI@E@E@EBEF@BCDIPADBDB@@BB@B@BBB@B@B@BBB@B@@@B@BBBBBBBDBDFJ@B@@@@DHBH@F@HCBIDEB@@@@KD@B@@AB@DABAD@D@B@BDFHBPJFJBBHNDBBDBBBBB@D@D@DADCD@F@BB@BBD@DDBBBFHBDDB@BBBBFAH@DABEFCAQ@ODGBGBKH@@GJCHCJEHEDI@@@C@IBC@@F@D@D@BBDDDDF@@FF@JDTEDEBCFEDGBIAE@GAA@CAEAC@C@A@EBGHCBGBG@E@KAGBADEDGEQL@EAG@GFGECCGHCFECG[KEFBGGHE@GAWIBIIIBCECCKD@BAD@FAFABBFBCcEIGIA_@IAK@G@IA@AAA@C@EB@@AB@B@@ABABABA@ABABCBC@CBA@A@A@C@C@C@C@AAGAKFKJA@EDE@E@ECCAAAGICCGI@B@@AB@@A@AAAA@@A@A@A@A@ABABA@ABA@ABAAA@A@A@@@A@@BA@@B@BAB@B@D@D@D@D@B@DADADAB@BA@BB@D@@@@@@BBB@BBB@BBB@@BB@@BBB@@@BBBABAB@D@@@B@BA@@@@BADBDBD@BB@@BDBBB@B@@B@BBBB@@B@@@@BA@AB@BABAB@BB@@BBB@BB@@D"]],encodeOffsets:[[[114219,29300]],[[114261,29831]]]}},{type:"Feature",id:"430724",properties:{name:"临澧县",cp:[111.647517,29.440793],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@@@@A@A@@AAA@AAA@A@A@C@A@A@A@AAA@A@ABA@A@ABA@CBA@@@A@A@AA@@AB@@A@A@@@@@A@@A@@AAA@@A@@AAA@@A@@AAA@AAGAA@MEA@C@AAA@AAA@@@ABA@A@CBA@AB@@AB@@C@@BCA@@C@A@AAA@A@A@ABA@A@AB@@ABCDABABA@@@A@@@@@A@@@@@A@AB@@@@ABBBAD@@ABABABA@CBA@A@@@@@@@@AA@@A@@@C@@AC@AAA@@AACAA@CBC@C@A@@@AAAAAA@@AA@AA@AAAA@@AAA@@@C@AAC@@@ABA@C@ABA@AA@@AAA@@@A@ABAB@BCB@@A@A@C@@@A@CAC@@@CAAACAAAA@AAA@A@ABA@A@@@A@C@@A@@AAAAAAA@@@C@A@A@A@AAA@A@@A@AAA@A@AAA@@AAAAA@@@A@AA@A@@AAAA@@@@A@@@A@@DAB@BABA@A@@@A@@@A@C@C@A@A@@@A@ABABABABABA@AB@@A@A@C@@@C@@B@@C@AD@BABCD@BABAB@@ABAB@B@@@@A@@B@DABABADKBA@@D@@B@@@B@@@B@B@BB@@B@@BBB@BB@@BB@@@B@BBB@B@@@B@BBDAB@B@@@BB@@@@BBB@@BB@@BB@BBBB@@BABCD@B@@@@@FFBBD@BBHBB@AFAB@B@DBBBBDDBDBFBDBBB@B@B@DBBB@F@D@@ADA@A@AAA@CACAA@AB@BDD@@BD@BCBA@C@GAE@@@ABAD@B@@BB@BBBABA@G@A@@A@BC@C@@@CBEBC@AB@BKFMJG@ICCCCACEOSCE@IAEI@A@GBOASCAB@FDBFB@BADAFAF@@BH@@BHBBFDBD@DAFIHCH@F@@BDFBH@FAFELGJC@@B@H@B@HAH@BAHADBHJDFBBDFB@HJ@@@@DF@DEHAD@D@@B@BB@@B@@BB@@@B@@BBB@@@B@BB@@@B@BBD@BD@@@@ABBBCD@@A@AD@BA@@BB@BDDBB@BBB@BBBBBB@@BB@@BB@@@B@@AB@B@@@B@@B@@@BBB@B@B@@@B@@@BB@@@B@@@BA@@B@@@BBB@@@BB@@@@BBBB@CDA@A@GBCBEC@AAAEA@@A@BDADAHBH@@BD@DBBB@D@D@DD@D@D@D@BABDFHJ@DBNCLGLCFEH@@@DAD@B@BAD@D@B@F@D@@@DBD@D@BCBAB@@E@C@C@EBC@E@A@@@AAE@C@EBCB@@@@A@CBABAB@DBBBB@BB@B@B@B@DAD@B@@@D@B@@DAD@@@B@HAFAFEFEBABCBCB@@@@@B@@B@@BB@BBBD@DDBFDD@DABCBGDAFABAB@BCB@BCBKBA@A@ABA@ABA@@BA@@B@BAB@BAB@BADADAB@@AB@B@@@BBBBB@BBBDBDDBDBDBDDBBBH@F@DCBA@ABEAA@C@GBA@GBABABCB@B@BB@B@@B@@BB@BA@CAEAGAA@@BAB@@@B@@DBB@BBDDB@FDBBDBDDBBFDBBDDDBD@@@B@BAB@BA@@B@@@BABBB@D@BB@@BBB@BBB@B@@@B@@@BADA@@@ABC@A@A@@B@@@@@B@@@BB@B@@BF@B@D@H@FBB@BBBBB@@BFABABABA@AB@@C@C@C@AAAAAACACACAC@CBABABADAFCBA@ABCB@D@DAD@D@@AD@DA@AHEHAD@B@D@@@B@B@F@D@BBHBBBBBD@@@@ADCB@@@BA@@B@@A@ABEB@@CB@@CDABABCDABCDABCBCDABCDAB@BCBABABAB@@@BA@ABCBABABC@ABCBEBABA@ABC@CBC@CBCBABABADABABCBABADABADCB@B@D@BBBB@BBD@FDBF@HCFCJ@B@DBF@@@BBDDDFBDBDBBFBD@BBD@D@B@F@@AD@D@B@B@DBD@BBBBD@B@D@D@BBB@@D@D@DAD@BBDDD@@BHDDBF@D@DA@@DCB@@ABAB@DABAFADABADA@A@@@@DADADCDC@A@E@ABCD@D@BA@A@AAA@@BAD@BABAB@B@BBD@@A@@DABAD@DAD@F@D@BA@@D@BBDBDB@@B@@@D@BBB@@@B@BBB@DABAFADAD@DBD@D@BBBDDBBD@@@B@@AB@BABAB@D@DBBDBBBBBDBDBBBFDDB@@DFDBD@DADBF@F@DBHAB@DA@ADABAFA@@B@BBD@BA@@FAD@FAD@J@AG@ACAB@@AA@@AB@@GA@@A@A@AA@A@EACB@@@@A@CCCEAG@CACACBC@CAC@ABCBAFADAFADAFAB@DAAA@ACCMCAIVD@A@I@C@C@CBE@E@C@A@OAACCCE@ACAAA@AAC@C@ABC@AACAAAA@CDADBD@@@B@B@FABCBCBCBADCD@DADAFABAB@DC@C@CAACCCC@@@AACACAAC@A@A@ABCBEDEDABCBCDE@CBCBCAAA@A@CACAAC@A@AA@A@A@A@@A@A@ABCAAA@CDADCBAHKHKFC@AFC@@BADAB@BADCJCF@D@@@JBD@B@DBB@DB@@BBDBNJFDBBPHDBBC@C@CAAACACAE@EAACEAAAE@CDCDADAH@BDBBB@@@DAFEBC@A@ABA@CBCBCBCB@@@@@BAD@DBB@BBHBD@DA@CB@D@FAD@FCB@DC@@BC@C@CACACA@AACBA@AAAC@AAA@E@CBCDC@A@A@CBA@A@ABABABC@ABABCBCBC@A@AAA@A@ABA@AAAAA@@@CBABA@@DCDAD@FADBDDBBDDBBD@B@B@D@B@FCF@D@BBH@FDD@DBDBDAD@D@HADABC@@DCDC@CB@@AB@BAFEDEB@B@BADBBDFBF@D@DC@@DAB@DED@FA@@BCB@BA@BB@@B@@@B@@ABABCBA@@@@B@@LHDBB@B@BA@@AAAA@@@AB@B@B@BACE@@BABA@@B@@BBB@B@BBBB@@@FCBA@@BA@@AEBAB@B@DBB@B@BABCBAACAGAC@CAA@E@EA@@@@@ABA@A@@@A@@BB@@B@@@B@@@@A@A@@@A@@BBDAF@BA@@BA@@@AE@C@CA@CA@A@@@ABA@@@A@@@A@@@A@@B@@@B@B@@@B@D@B@@@B@@AA@ACAAC@GAA@AAA@@AB@B@D@B@B@BABA@@BBDFDBBBB@@AA@AAAA@ABAAC@@AACCA@EAABA@@@CCAA@A@ABAB@B@@AAE@CA@@@BC@ECAECA@A@@A@@BAD@B@@ABAAA@E@E@AAEEC@CCCAAA@@CACACDA@AAABCAA@@@A@EB@@CAAA@AG@A@AAAACAACCCAAACAAGAE@C@C@C@A@ABEBA@AACCC@@@A@@D@B@@@@A@A@A@AAAA@ABC@A@@@AA@CB@BA@CA@@A@@CC@C@ACE@C@ABEAC@CCCECCACE@C@@@A@@@C@A@@@AD@FE@@A@AACACAC@@@@@A@@@A@@@@@AAA@@AA@AAAAA@AC@@AA@@AA@AAA@AAA@A@A@AAAACAAA@AAA@@AA@A@A@C@AB@B@@ABADADAD@@@DAD@BAD@@ABA@A@A@A@A@AA@@A@@@A@@@A@@@C@A@CBCAA@C@@@A@AAC@A@ABA@@@@@@A@A@@BAA@@@@@A@A@@BC@@B@@A@A@A@@@AA@@@A@@@AAA@AAA@@AAC@@@C@ABABA@"],encodeOffsets:[[114389,29993]]}},{type:"Feature",id:"430723",properties:{name:"澧县",cp:[111.758702,29.633236],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@C@A@@A@@AA@AAE@GCA@A@EAIAEAC@C@CBIBA@MDE@C@CBC@AAE@AAA@A@A@AA@@A@A@A@A@A@E@CACACACCCCCAACAECHEJCHEHGHON@BEDCHAB@B@BBFDDDD@@BBFBFBDBDDDB@DAB@BAF@@AB@@A@C@A@A@@@GBGFEDAFAD@H@BAFADABABCBABEBCBEF@@ADADCJCLADCB@@A@CA@@AAAAC@A@AA@@@A@A@AB@@BD@@@B@@@@A@A@A@@@@CC@E@A@@@@BIDCBEDABADAFABAB@@AB@BA@@@@@D@F@@@B@@BA@@@ABE@@BAB@BA@@@@@A@@@A@A@@@@AA@@AA@A@A@A@A@@@@A@@@A@@@A@@A@C@AA@@AA@@BA@@B@DBB@BA@AD@@A@A@@@A@@@@BB@@BB@@@CFA@@@A@@@A@A@@@@BB@BB@@@@@BCDABA@CBE@ABABGFAFAFADAHMPCFEFCBA@@BIJEHCDABGBCBA@@DABAD@BBB@FDJBB@B@@@BBB@BBBB@BBB@F@B@B@B@F@FAD@FBFBBDBB@@@B@B@HBDBDDFBBDD@B@DBD@BADCD@@@B@B@D@@@BBB@@@B@@@B@@BB@BBF@B@B@BAB@@CBABAB@@AB@BBB@BA@@BCBA@ABABAB@B@@@BB@@@B@@@B@BABAB@B@@@@@BB@@@B@BA@ABAB@B@@@BBD@@@B@@@BA@@FABAD@@@B@BB@DDFDFBB@FBB@D@B@D@BAB@BA@AB@BBB@DDB@BB@@B@BBBBFDBBBD@B@BABADADAD@@@DAB@BAB@B@B@@BB@@D@B@@BBBBD@B@B@BA@A@AB@B@BAH@HBH@BABABABCBABABADADABA@EBA@A@AACA@@AAABA@ABA@C@A@AA@C@A@@AAA@C@C@@@A@AAA@@AAC@AAA@EAA@AAA@A@AAA@CAA@A@AAA@A@AAA@CAA@A@AAA@AAA@A@AAAAC@AAAA@AAC@A@A@CAA@A@AAC@AA@AFCDCBA@AAEAECGAC@CAE@A@@@A@ABAA@@AAECE@A@C@ABA@AB@AA@AAAAA@AAC@A@A@@@AAA@A@C@@@ABA@A@@@AAAAA@AA@M@K@G@GAE@CCECEGA@EIAAAA@@@CAA@@@A@@AAAAA@AAEACAA@@AAA@A@A@C@C@AAC@CAC@CAC@CACACACACEGCC@AAA@@IMEGCC@@MQAAMWACGMEKAKC@C@CBCACAC@ECG@AAC@E@EDA@C@A@A@C@AACCAACCCAEBC@CBCD@@ABAB@D@@BBBB@BAB@B@BBB@B@BADADADAB@BADABAB@B@BAB@D@B@BCDAD@D@FBB@BBDBBB@DABBB@BDBD@D@DAD@@CDA@EDC@EBC@A@@DCBC@GAAAA@CAC@AB@@@@A@ADADAD@DAB@B@BADEFCB@@A@AAACG@CBCBCD@DBFBBDFBB@FBFBDBDBB@D@DADCAOGAAECMICAAA@@CAA@CAA@C@IA@@C@E@IDCDABA@CBAB@@ED@BEDGLGLABCDCB@DBBDBBAB@B@@@@B@B@BBBB@D@BBBD@D@BBBDBDADAF@DCDABAFCFCDABAB@B@D@BBBDBD@B@@DDDDBB@D@DCDA@ABEBCBCBC@CDABADADADEBA@A@@@C@CACB@DBBBBBD@BAD@B@DBD@BBBDB@BDFDDBB@P@B@D@FAF@D@D@D@J@BUCBJNDDD@BBBCBA@EBCBEBCBEBABAD@BBD@DADBDBD@DBHDFDDB@@@@@DAFBB@B@@B@B@BB@@HA@@BB@@BA@DB@BBHI@C@EBC@EB@@ABC@AAA@@@EBABCB@BCBA@GBCAE@E@CACBC@CACE@@CAECAACACAAAAACAAA@C@CBABA@ABA@@@A@@ACCAACAAC@C@CAC@CBEBABCBA@AAA@@@A@AAC@@@A@@@CACAAAC@@@ABC@E@C@CBC@ABCB@@@BC@AAA@A@ABABC@AB@@BB@B@BABC@C@AD@B@F@BCDCDCBCB@@@@@BCBABCBEBABCBA@AB@BA@CD@@CBC@E@CAGC@AC@CCAAC@CBC@C@@@AA@A@C@C@AACAA@AAC@C@A@A@CBC@@@E@A@CAC@AACAECACAEACC@@A@@@C@A@A@A@AAEABEDA@CACA@A@@B@DB@CH@@A@AAA@@@@BA@ADABA@@@B@@D@BA@AA@BABA@ABCAADCH@DAB@BAHADADC@C@C@CAC@CACCCACAECCAA@CAA@@AC@AACACAA@CAEA@@CCA@C@@BABBB@B@F@F@HAFEDCBC@CBABADADADABABABCDCDAD@B@@B@@@@F@FCBADCBE@C@E@EAEAG@I@C@G@IBK@C@AD@@@BDFDFHFLHBD@B@DAD@@@D@BBBBBBB@BDF@DAHCFCF@BEDCDABCHAHCBCBEDCDCD@@ABEBCCEBA@@HAD@BCBA@CA@AECAEKIGCK@GBCDGJCFEDABKFA@ABADCB@@AB@@@B@D@@@B@D@D@FBD@FABEFCFABAD@B@FBDBBBHCHCH@DAF@DAF@FAHABCBABABAB@BBB@@BBB@B@DBB@D@D@B@F@F@F@H@DAB@D@D@DAFBFF@@HDF@D@BAB@DAB@FBD@DBJBJFBD@@FBB@B@B@@@@ABABAB@BABAB@B@B@DAB@DBBA@@F@FBBB@BBBDDFDFBFBFBFBF@DBDBBB@BFDB@D@@@@AB@DCDAB@@@@@B@@BB@@B@BBBADBD@B@@@BB@@@D@@@B@B@B@B@B@@A@@B@@@B@@@B@BBB@DBBBB@@@BBB@B@F@B@@@B@@@@A@@@@BA@@@A@AB@@@B@B@@@B@BA@@BB@AB@@@@A@A@@@A@@@AACAC@@@@AA@@@AAAAA@A@@@A@@@A@@BA@@D@B@@BB@DBDBDB@@B@@A@@AE@A@AA@AAAAAAAAAC@C@A@@BABCBADCBABABADAB@DAB@BAB@B@B@B@B@BAB@@@BBD@FB@BB@@@B@@@B@DBB@B@BBB@BB@BB@@BBBDDBDDBB@BBDBDBFDBB@@B@@@BAFEB@FCD@DAD@B@BB@BBB@@BDF@DAD@B@DABCDCHCFA@AB@F@D@B@D@DBD@FBFD@@BBDBD@FBDAD@B@DBB@BBDBD@DBB@D@F@D@DADC@CBE@@DEBABA@@BADE@C@A@ABCDAFA@BD@B@DA@@BABABA@CBCBCD@@ADBDBB@@BB@@BB@@@@@@@@@B@@@B@@@@@@@@@B@@B@@@@@AB@@@@@@@@@@@B@@@@@@@B@@B@@@@@@@A@@@@B@@@@@@@@B@@B@@A@@@@@@@@@@@@B@BAB@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@A@@@@@@B@@@@@@@@@@A@@B@@B@@@@@@BA@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@BB@@@@@@@@@@@B@@@@@@B@@@@@@@B@@@@@@@@B@@@@BA@@@@@@@@B@BB@@@@@B@@@@B@@@@@@@@@@A@@B@@@@@@@@@B@@A@@@@@@@@@@B@@@@@BA@@@@@A@@@@A@@A@@@@B@@@@@@@@@@@@@B@@BB@@@@@@@B@@@@@B@@@@@@@@@B@@A@@@@BC@@@@@@B@@@B@@@@A@@B@@@@@@@BA@@@@@@@@B@@BB@@@B@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@BB@@AB@@@B@B@@@@@B@@@B@@B@@B@@B@@@@B@@@B@B@@@BA@@@@BB@@B@@@@AB@@@@@@@B@@@@@@@BB@@@@@@@@@B@@@@@@@@@@@@@B@@@@B@@B@@@@@@@@B@B@@@@@@@@@B@@@@@@@B@@@@@@@@A@@@@@@@@B@@B@@@A@@@@@@BA@@@BB@@@B@@@@@@B@@@@@BB@@@@@@@BA@@B@@AB@@@B@@@B@@@BA@@B@BB@B@@@@BAB@@@BABB@@B@B@B@@@@B@@@@B@@A@@B@@@BA@@D@B@@@B@@A@A@A@@@@AA@@@@@@B@BA@@B@D@@ABB@A@BB@@@@AB@@@B@@@@@BA@@B@B@@@B@@B@@@@B@@A@BB@B@B@@@@BB@@@@BB@@@@A@@B@AA@@B@@AB@@BBA@@B@@@@@@BB@@@B@B@B@@A@@@@B@@@@@@@@AB@B@B@@AB@BB@@BA@@@B@@B@@@@@B@@@@BDA@@@@@B@@BB@@B@@B@@@@B@@BB@B@@@BA@@@@A@@A@@@@B@B@@@B@B@@B@@B@@@B@@@BA@B@@@@@@@@@@@@B@@@@BB@@@BB@@B@@@B@@@@@B@@B@@@@@B@B@@BA@@@@@B@@@BB@@@@BA@@@@B@@@@A@@@@@@BB@@@@BB@B@B@@@@@B@@@@AB@@B@@BB@@@@@@@@@BBA@@@@@B@@@@BBB@@@@@B@@@@B@@@AB@B@@A@@B@@B@@@BBBB@@@@B@@@@B@@@@B@@@@B@@@@@BB@@@@A@@B@@@@BB@@@@@@AB@@@B@@@@@@B@@@@@@@@B@@@@@@@BAB@@@@@@B@@@BB@@@B@@@@@@@@@B@@@@@@@@B@@@@@@@@BB@@A@@@@@@@@@@@A@@B@@B@@@@@B@@@@B@@@@@@B@@@@@@@@@@B@@@@@@@@BB@@@@@@AB@@@@@@@@B@@B@@@@B@@B@@@@@B@@A@@@@BB@@A@@@@@@@@@@BB@@@@@@@B@@A@@@@@@B@@@@@BA@@@@B@@@@@@@B@@@@BB@@@@@@BB@@@@@@@@A@A@@@@@@@@BB@@@@@@@@@@@B@@@@@@B@@A@@@@B@@@B@@@@B@@@@@@@B@@B@@@@@@@@AB@@@@@@A@@B@@@B@B@@@@@BB@@B@B@@@BA@@B@@BB@@@@@@@@AB@@@B@@@@@@AB@@@@@@@B@@@@@@@@@@A@@@@@@BB@@B@@@@@@@BA@@@@@@B@@@@@@@@@@@@@@AB@@@@@@AB@@@@@@@B@@@@@@@B@@@@@@@@BB@@A@@@@@@B@@A@@@@B@@@@@@@@@B@@@@@@@B@@@@@@A@@B@@@@@@@@@@@@AB@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@B@@A@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@@@BB@@@@B@@@@@@@@@@A@@@@@@B@@@B@@@BABAFABADABADAD@D@@@F@@@B@D@BA@@DABA@@BA@@@A@C@A@AAA@A@@BAB@JCB@DADABAB@B@@@B@@@B@@BD@B@BA@G@E@CAAAA@C@CD@B@@@DDB@BBB@D@B@D@BA@@FAJGBADA@@BCDCBCDE@@@AFCB@B@B@B@HBDBB@@@B@HBB@@D@DBD@@B@B@@CAC@CB@@@B@BA@@B@BAB@@@B@B@@@@@@@BB@@BA@@B@@@B@@AB@B@@@B@@@B@@@@@@@B@@@@@B@@AB@@@@@B@B@@@B@@@B@B@B@BA@@BAB@B@D@BAB@@@@@B@@@@@B@@@@@@ABABADGBGBA@AB@D@BABA@@@@BCDAFAFABAB@B@D@DBBBB@DBFBB@DBB@H@F@B@D@B@BABABA@A@A@AAAAAAACC@@AACCCCACAAAA@@AC@C@ABA@C@@BCBC@EBA@@@C@CAA@ABADBB@@@BAFABADAD@FAB@D@BAD@B@@@@@BDBBDBF@D@B@BEHQ@@@@BABAB@F@D@@ABABAFADBDBBA@@DADCFCHCLEBADADGB@D@BAD@D@DAD@@@DABABCB@@ABC@ABA@@@@@AB@@A@@@A@A@CBA@A@C@ACEACAAAAAACC@AACB@@A@CBC@ABCAE@EAEEGGKAAAE@@AC@A@E@@@A@@@A@@@@A@CA@AAA@A@C@AB@BAB@BBB@B@@@@@@A@AAAA@A@@AAA@@BABAB@@@@@BABA@@@ABC@A@ABA@A@CBC@C@C@ABCFABADGBI@IBG@G@GBA@ADCFEDEBGBG@A@ECCACCCAC@G@A@E@CCEAC@C@@AI@A@A@G@@BCDIBEBC@A@I@C@AAGEIAEEIACEK@GBCDILMFEBABARILGFAB@DA@ABA@A@AAA@E@A@AACCACCEAAAAAAAAA@AAC@@AC@CAC@@@A"],encodeOffsets:[[114751,30173]]}},{type:"Feature",id:"430725",properties:{name:"桃源县",cp:[111.488925,28.902503],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@FBBAFCHCH@D@D@DDBDBF@BCDCFEHEFAD@B@@GFEHADCF@@C@CBAD@DBDDBFDDBBDDF@FCDCBEDCDAB@BA@@B@B@B@@@@BBB@B@@@@B@BAB@BAB@DBFBB@B@BA@@BE@A@A@A@AA@@@@@A@A@A@ABAB@@A@A@@AA@@A@A@CDABA@@@A@@AAAAAAA@A@ABA@A@@@@@AA@@@@@CD@@AB@@A@@AA@@A@C@A@@AA@@@@A@@BAB@BA@A@A@A@@AACA@AAAAA@C@A@A@ABABCFADBFABC@GAAA@A@CBCBE@EAAAAAACAABCBCBGBG@G@A@C@C@EAEACCAACEGACCGCCCEECACAE@A@@BAD@D@F@D@BADCDAFADAD@D@FBFBD@DAFADCFA@CBCBAB@DAD@DBBBBFB@@FBB@D@D@DADAD@B@B@B@DBDDB@B@D@BAFAF@DAFBD@D@DAFAD@D@DBB@BD@DBDBD@F@F@D@DBBB@FDFBFB@@B@BBDBBDBBHFLBJBB@L@LAP@JBNHJFDBBB@BA@@B@@EB@B@F@BABCFC@CDGHABABADAB@BAB@@@BDF@BFDJDFBFBDBD@BAFAD@HEH@D@BBFFJDHBBBBB@B@@@J@F@@DDFDBDAD@BBF@DFFB@@BABCF@BABBBBBBBFDDD@@FDB@BD@@@BABCB@BCDAB@@@BAJAD@DA@ABCDCBADAB@BAB@DBDBBDF@@@BABCBMDGDCDEFCDEF@DADDFBD@DADEDEBABCNEFGHIBMBG@ICAAC@CBABCDCDAFAF@D@DABCDABABCDCDA@ABA@CBA@A@ABABA@@BAFABEFADAFAB@@ADAB@@C@A@@@@BBBBBBDAD@BCDEFCF@B@@CDADCH@FADABCBCBEBEBAB@@IBG@I@EBEDAD@DDDFH@BCH@B@@ABCBCD@B@D@BCDABADCD@DBFDDDFDD@D@D@DADAF@BDDBBB@DBJBDFHJFHD@F@F@B@BD@BDDBBAB@B@B@@@BB@@BB@@@@@@BA@@AA@A@@@A@A@AB@@@BAB@@A@AB@@AA@@@@@C@A@@@@@@A@@@@BAB@@A@A@A@A@A@AAC@AAA@@@A@@@@BA@@@B@@BD@B@BB@@@@BB@@@B@@@@@B@@A@A@A@A@A@@@@@@B@BA@@B@@AB@@ABA@@@A@@@@AA@@@A@A@A@@@A@@@ABA@A@@@A@@@AA@@@A@@@@AA@@@B@@A@@@@BBB@DBB@@@@BBB@B@BA@BB@@@AB@@ABAB@B@@A@@AA@@BA@CB@BABA@@B@@ABABC@@@A@A@A@CBA@ABABCBCBABABA@A@A@CBABAB@@A@@@ABA@AB@B@@@B@BCBADA@@B@@BD@D@@@B@BAB@@CB@BA@@B@@@B@@@BBB@B@@@B@@@B@@AB@BA@@BA@C@@@ABA@A@@@A@CBA@A@A@AAA@@AA@@@ABC@@@AB@@@B@@@BA@@@A@AB@@@BA@@@ABA@A@@@@AA@A@@@A@ABABABAB@BA@AB@@AB@@@@@B@@@BAB@B@BA@@@A@A@@@A@@@@@@@@AB@B@@A@@@A@A@@AA@@@AA@@@@BA@@@@DB@AB@@@@CBAB@@A@@A@@@A@A@@@@@@A@A@@@A@@B@@@BAB@B@B@B@B@B@B@BAB@@AB@@@BA@@B@B@@ABA@@@AB@@@B@DA@@BAB@@@@AB@B@B@@@B@@BB@@@@@B@@@@AB@@@@@@@B@@@@BBB@DBDDHAH@F@HBHBF@F@HFHBFDBDB@DDDBB@BBBBB@B@B@B@H@H@HFDDLFBDD@LDLFHDBBBBDBFDDDFB@@@@BAD@BAFCDEDAFCDABCBC@A@CBCBAHCBAD@FAFBDBDBDBD@@@FDDBB@DBD@B@B@F@D@DBD@B@B@D@BBB@B@BB@B@BBB@D@BAD@BBD@B@B@DBB@BJDJ@H@HAFBDDB@FCB@BADAHBDBLLAFHJ@DDJBHEHBBBD@BBBBB@B@B@B@BB@@BB@@@DLDFHNDDNPHD^RTFPJJFLF@BJFJFHDZC@ABAB@DADABAB@BCB@BC@CBA@AAGNYRAVHFF@BFD\\HHBBDDFDN@D@DBD@D@DABABC@A@A@CHELCH@FDLNHDDB@FDDBBDDB@BBBDBBDBBBDBDDDBDBD@BBF@DBHABADABAD@BAD@BAF@DAD@BCFABAB@BCBABCBA@AB@B@B@@@BBDBB@@DDDD@BBB@BBF@B@DBB@@BD@@DFBFDDBD@BBFBBBFDFBD@BBDDFFFNFBBJBD@J@JBHDBDD@HDFB@@D@F@NDJB@@BAF@BA@AB@@C@AB@@AB@DA@@@@BABEBCDEBEBCBADE@ABABADC@@BA@@B@B@BA@@BA@@BA@@@@@A@A@@@@AA@@@A@@@AB@@AB@BAB@BAB@@AD@@ABAB@@@BA@@@ABA@@AA@@@@@A@@AA@@@@@@@CBCBCBCBABCBAB@BABAB@B@FC@@B@@@BABAB@BABAD@@@D@B@B@B@DABADABABA@ABA@ABC@C@C@A@@AAMG@@@A@A@@AA@AA@@A@@A@@@@AA@@A@@AA@A@A@ABA@ABADABCDABA@AB@@AB@@CBA@@@C@ABC@A@A@C@A@AAA@@@CD@@@B@DAB@B@B@B@BBBBBB@@@BB@BB@@BB@@DBBDBBB@DDBDDAB@D@HADAB@@@B@@ADAD@BADAB@BAB@B@B@B@B@BBFBD@DB@@B@B@@@B@DBB@B@BDDDBBB@B@B@B@@@B@@ADADCDABAB@B@@@D@DBB@B@BB@@DBDFDDB@@BB@@@B@BCBABA@C@AB@@@@@@ABABA@@BABA@ADCBA@ABCD@@@@AD@@@D@B@B@@@BAB@BABABABABAB@@@B@B@D@D@B@@@B@@@B@B@BA@ABA@CB@@@B@@@@@BBBB@@@BBBB@@@B@BBBB@@BB@B@BBB@B@BB@B@BBB@B@B@D@@@B@BBBBBB@@@BD@B@@@B@B@BAAA@AAC@@AC@A@A@A@ABABABABCBABAB@BAB@B@BA@@@AAC@AAAA@@@@AAA@A@C@ABA@ABC@ABABCB@BA@AB@B@B@B@@BB@@BBB@@BBB@B@B@B@@@BAB@@@BA@@BAB@@@@@BB@@@@B@@AB@BAB@@@@@D@@@BAB@@A@@@@@CB@@A@@BAB@BA@@@@@C@A@@@AAC@A@@@@AA@AA@@A@@AAA@@AA@@@@@A@@@A@@BA@@@@AA@@@@AA@@AAAA@A@A@A@@AA@A@@AA@@AAA@CBA@ABABABAB@DABAB@BABAB@@ABA@C@A@@@C@AAC@C@CAIAE@EAC@C@CAA@@@A@A@AA@@@@@A@AAA@A@@@A@@@A@@@A@AAA@A@@@@A@A@@BC@@BA@A@ABCAA@A@@AAAAAA@@@AAAAAA@@A@@@C@@@A@ABA@A@ADADCBABAB@B@B@B@B@B@BA@AD@BBB@BA@@@CA@@AAA@AA@@ABABA@ABAB@@A@@A@@@AAAAA@@@@AAACA@AA@@AACACBC@A@@B@@A@A@@@CBABAAA@A@@AA@AA@@AA@AAA@AAA@AA@@@@@@@CAAB@@ABABCBC@C@A@C@C@C@C@ABA@A@AB@@AB@@@B@B@B@BBBAB@BAB@BABAB@B@B@B@@@BBBBB@@@BA@@@A@@@AAA@A@A@ABA@C@A@E@C@C@AAA@A@A@A@A@A@@@A@ABA@CBAJG@ANKBABALEDEBEBA@A@CAC@AA@AC@@@AAAACCE@AA@@AACOG@@EECCEECEACAA@C@E@CBA@C@E@AECICYM@@@@CAIEKEKAK@EAEAAAACKWAEACDK@G@C@AAGAE@@ACAACC@A@CAE@C@E@A@ABC@A@CAACECECEAC@AAAAA@EBEBC@@BCBC@E@E@A@ABC@ABCFCFGBCBAAC@E@ABE@C@G@G@E@@AECEAC@C@EBCAAA@CACBCBEDABCBA@A@AAAC@CAAAA@@A@A@@@@@@@C@AA@C@E@CA@@@CBABABCBABC@C@CBA@CB@@A@AA@ACCCAA@@AABAB@D@BABAB@@ABC@@@CAC@C@AAAAAA@ABC@ADEFGBEBCBA@AA@@A@ADEBA@@BCBA@CAC@C@CBCBABAD@DB@@FDB@B@B@DCDAF@D@DBDBB@@@@CACBC@@@A@CB@@AD@D@BAB@@@AEACCCAAGAA@A@A@CA@C@AAE@EAAACCAA@ACCECAIE@@@@AACCAC@C@C@ABAFC@A@A@AAA@@BA@ABC@AAAA@AAACAA@CAAACAC@E@CBABCDCDAFABABA@CACBCDEDEDCDAF@BADA@@@ABAAIAI@A@@BCFAF@JCBA@EAEAGAEAGCEAQEMCIAK@@@C@C@CAE@GAA@AAACACAA@GC@ACACEACEAEACACAI@G@E@G@C@I@A@A@GACC@A@AAA@AAACAEA@@@@I@KBG@G@GCAAA@EEEACA@@C@@@IDAD@@GFEDABC@GBEBA@@ACCA@CEEGCC@ACCEAEACAAACEAACAC@A@A@EAC@EGAEAAA@A@A@A@C@C@AAC@A@CDABAB@@CB@@ABAAA@AA@ACAC@E@A@@@E@G@E@CAECEAE@A@EBMJABA@AAC@A@A@@BABADA@ABEAC@@@ADCD@BC@A@AACACACAEAMCA@AAAACAC@CBCDABABCB@B@@@B@D@B@BA@ABABABA@A@CCCCCAA@AACAGCCAAAAA@A@AA@AACCCAC@C@A@C@CAAAAAAAAAC@A@A@C@CD@@EBABA@AAECC@C@I@A@AA@ABEAC@CBCBCBA@G@ACA@A@@CEAAA@AC@A@ABAACAEAA@A@A@A@EA@C@E@ABCAEACACAC@CACAECACCAC@CBAB@@EBCBC@ICC@AACCGMA@AAEAIAA@C@CBC@ICEAAAC@CBA@A@A@EDIHCBA@CBC@CACAECC@A@CAAACAA@ECEE@@AACAC@C@E@C@A@A@A@A@@@C@AAAA@@AAAAAAAAA@CBC@ABE@CAE@E@E@CAA@CCCEECECCAC@CAE@EAE@C@C@E@AACAEC@CCAA@C@C@E@A@CAECC@EAA@CAEAECEAECA@C@CAE@EACACAECA@ABC@ABCBCBAACAC@C@E@EBE@CAA@AA@B@B@D@D@D@DBD@@@BBDDBDBD@DBBB@DBBDDFDHDDDFBBDBBBB@@@@AD@D@BBB@DFBBBFBFBDBFBDDBBDFHHDFDDBBDDB@DBBB@B@FDF@B@DDBBBD@DABADADCDAF@DADBBDBDDDBBDDDBFDDDBBBDDF@DBD@DBFBDBDDBB@D@H@H@D@@@@B@B@B@@DBDBDBFDDBBB@BBB@BBDBBBBBDBDBFDDBBBBB@@@BBDDBBFDB@B@DADADADADBFBB@D@DABAFADAD@BABC@CFBFBD@B@B@DCDAB@"],encodeOffsets:[[113722,29219]]}},{type:"Feature",id:"430781",properties:{name:"津市市",cp:[111.877499,29.60548],childNum:1},geometry:{type:"Polygon",coordinates:["@@EGCEAACICECK@AC@@BAD@DBH@BAB@DAB@D@D@F@FAD@B@@CDABADCDABAD@@@BAF@B@BABA@A@A@A@A@A@@@@@E@A@C@C@C@KAA@A@A@A@@@ADABA@CBA@C@A@@@A@@@A@A@A@ABA@AB@@C@@@A@@@AA@@CBA@@@@BA@@@@BABAB@BA@A@@@AB@@A@A@A@@@A@C@ABA@C@A@CAA@CAA@@@AD@B@D@BC@EBC@IAGCAECAEBC@E@EAGGCECGA@@AA@CAECA@@BA@AB@@GHCBA@A@A@A@AACBA@AB@@A@ABAB@@E@C@EAGACEA@@A@@@ADEBC@A@AAAEFC@@B@@@B@D@@@B@@@DDFDBFDDD@DBDAF@B@DDF@B@DDD@@@BB@@DABA@@DBB@@B@D@BAB@BB@B@B@B@B@@A@C@@@@B@@DDBD@BABAF@B@B@D@D@DBFBHDBBBDBDDBBBDBB@B@BBHB@BB@DA@@F@B@@BBADBB@BCBBDBD@DB@BBDD@DFDBF@B@F@FBBAB@BA@C@AB@@@BB@B@FDDB@FAD@@B@@DBF@BA@A@AB@B@BBBDD@@B@BAFBB@DDBB@@BDAB@BBBBBB@@BA@AACACEAA@@ABABA@A@C@A@A@@BB@BBB@HBD@BBBDB@@BA@@@A@C@A@@@A@A@@@A@@@@B@@@B@@@B@@AB@B@@@BDBB@@D@DBF@@B@@AB@@ABEAC@AB@@@B@B@@@@@@A@@@AA@@AB@@@B@B@BA@@@@B@@F@FBB@DBDBHBDABADABA@A@CAA@A@ABBF@@AB@@ABED@@A@AA@A@AAA@AA@@@ABAB@@DFABA@A@A@@B@@BBBB@@ABA@A@CAKG@@@A@@B@DABABA@@@A@@@AA@@AABA@AD@@EBC@CFA@CB@@CDC@E@EAACCAABA@A@CFEFABA@@BA@@DCDCD@@ADCBGBBLFLHNBDNXBBNR@@DDFHJN@@BB@BDDFHBDBDBDBD@DBD@DBD@DBD@B@D@D@B@BBB@BB@DBFBBBB@BBBB@@@B@@BB@D@@BBBBFJB@FHFDDDF@HBH@L@N@B@@BBBBB@B@@@BAB@B@@@D@BBB@B@@@B@BBD@BBBBB@BBBA@@BAB@B@D@BDFBF@BB@AB@B@B@@@BBF@DBDDHBFBF@BABCDED@BBBD@BBB@B@DBB@B@D@BBB@BB@BBDBB@B@BBB@BBB@B@BBB@DBB@B@BBB@B@BBB@DBB@B@BBB@BBB@FBB@BBD@BB@BBB@@@D@D@B@BB@@@B@DBBB@D@B@BAB@BABB@@DBBBB@B@FAB@BABCBCBABADABABABA@AAG@GBG@A@ABAB@B@@A@A@AACAA@AA@C@@@AA@@@A@ABA@ABA@C@@BCBCBCBA@A@AACAAECAAAAA@@@AAA@CCA@AAA@@BABA@ABC@A@C@A@EAA@EAECCCA@@A@A@@BCBA@EB@@A@@@A@@AC@A@@@ABABAB@@A@A@@AA@@@@A@A@ABABA@@@A@@@A@@A@@@ABABABAB@DA@AB@@AAA@ABA@@BABADA@@BA@A@A@AAE@AAA@@@A@@@A@@AA@A@@@C@A@A@@DCBC@AAC@C@ACCAACEACAC@G@A@A@@AAACEAEAC@EBE@A@A@A@E@A@AAA@AA@AAA@A@@@AAACI@EAA@ABCBA@CB@DAHABADCFGJI@AB@DAFEDENOBGBCBEBEHEBABAF@DAB@BADC@A@@@@AAA@@A@@B@B@@@B@@@B@DE@@A@@AA@@A@@B@@@B@B@@@BCB@@AAA@C@AB@@AB@@BB@@B@D@BB@@@B@@@B@@@@@@B@B@B@BBB@@BB@@@@@B@B@@@B@@@@ABA@AB@@AF@B@@AB@@@A@@@E@C@@@@ABA@@BA@ABEBCBABCBAFCDAJ@@@@@B@FDD@@@@@B@B@B@@A@@@C@@AA@@B@B@B@@BBB@D@BBBB@@DBB@@@DABCDKDIBCBC@@FEDAFABADABABABCBE@A@GBCBEFCHEHA@@B@B@D@B@@@BA@@BE@ABA@CCACCCAEAEAAA@@CCCCAE@A@ABADGFC@APMHGFGDGFIDG@A@CDMBEBGB@@ADEJGNIBA@ABKB@DEB@DAJBFAD@LADANCLCHEHE@@AA@A@@A@AA@@AAAC@AA@A@@@AA@A@A@A@@@A@E@C@A@@@A@AAAAA@A@@AAAACCAEAAAAAAACAA@A@@AC@AAA@C@AAC@@ACACAAAAA@AAAAAEAAACCACACAA@CBA@A@ABE@CAOIGCCASEGAAACCCG@C@AFGBI@EACEE"],encodeOffsets:[[114652,29996]]}},{type:"Feature",id:"430726",properties:{name:"石门县",cp:[111.380014,29.584292],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@BABAHAB@HAD@B@FBBAB@DA@C@EAGAACCCACACAACACAAA@AAAA@@A@A@@BA@CBCBABA@ABA@ABA@@@AB@@AB@BAB@B@BABALADA@ADA@ABEBCBAHADCBC@ECCA@CACAAA@@AA@@@@A@@@@DADABAFAFEBEBE@G@A@@BC@CA@C@@@A@C@CBA@A@A@A@@AAAAA@CBABADAB@@@@@DAFAD@F@BB@@B@F@D@FAD@D@F@@@BADA@A@CAC@C@@@C@E@A@CBC@A@ABC@C@@FGDEHKDKAM@CGICEBA@A@C@C@CCCC@C@A@AA@CAC@@AGBGBCACB@@@FBBB@BFDDAHAB@B@DCA@AA@A@@A@@A@@AA@A@@@AB@@A@@@A@@AA@@A@@@A@A@A@AA@@A@@@@A@@@ABA@@@A@@AA@@AA@@AAAAAAA@AAA@CAACA@@AB@@ABCB@@@DCAABA@@@@ACC@AAA@@@A@@A@A@@AA@AA@@@A@@AA@@@AAA@@@@CBCFG@CCE@@@@GIA@CEAACEGICAGBABG@GBA@G@A@@@IDKHEFEBG@EAAC@@@EDGJGBE@CACECAAAG@@AG@@BEBEBC@AEACA@EBATDPBHAB@J@BF@JDFPTDFDBDDJDH@NILE@ABAD@FADA@@D@D@@A@BB@H@B@BAAA@AAA@@@ABCBA@@F@HBD@B@DA@AAC@@CC@ABAB@DBDBB@BBB@B@BC@@@C@EAACAA@A@A@AAACAEACCCAAAA@C@ABABEA@GAAAC@AAEE@@@@A@C@ADAB@@AAAAA@@AA@@AA@AA@@@@AA@@A@A@CBAAA@@@A@A@AAA@@@A@@AA@@AAA@AA@@@AAA@A@@@A@@@A@@@@CB@LAA@@@A@@AA@CCCECA@@AAA@A@CAC@@@A@A@ABCBCDCB@BA@@@A@A@A@A@AACCACA@A@CAA@@@A@A@@@CAC@EAAAA@A@A@A@A@ABA@CBABC@CB@BA@@@A@CBGBC@A@CBACCCA@AAACCA@@AA@@AAA@@A@@AAAAAAA@A@A@A@CBA@@@C@@D@@BB@B@B@D@B@BAD@B@D@@AB@DA@@BA@@BABCBADCBAB@BAB@B@B@BBB@@@BB@@B@@B@@@@BB@@BBB@@@B@B@@NHBB@@@B@D@DAD@BAB@BABABCBABCBA@A@A@C@@@C@ABABA@ABAB@@A@@@EDA@A@ABABA@ABADABADADAD@D@@@@@@BB@@@B@@@@BB@@AB@B@@AB@@A@AB@BC@@BA@ABA@ABA@@BA@@B@@@B@@BB@@@@@B@B@@@@AB@@AB@@ABA@A@@@AB@@CDABAB@BCFABADAFCFADAFAB@@@@CBA@@BA@@B@DA@@BABE@AB@@EDADEHCHBHBDBF@B@B@BBB@@BB@@BB@@BBB@BBBB@@BB@BBD@BB@BB@@D@@BB@@@B@BB@BB@@FBBBB@D@@@@@B@@A@@@ABA@@@@B@@@DAB@DAB@@ABABADCB@BABAD@D@B@D@BBBBD@@@BBDBFB@BDBDDD@@@@@BBBBDBBBBBBDBDBDB@D@B@@@@ABABC@ABAB@BAB@B@BAB@B@B@D@B@DABABCDCBAD@BCB@D@B@B@B@B@B@D@@AD@B@BADABAB@@A@@@A@@@C@CAC@E@@@EDA@AB@BABBB@B@@BB@BB@BB@BBB@BADA@ABABCDCBADCB@@A@@@C@AAA@CAA@@@@BA@@@@BA@@B@BAD@DAB@@@BAD@BADADADABABAB@BAD@BA@@@C@A@A@C@AAA@@BA@AB@BAB@@ADCBC@@@A@A@CB@@@B@@BB@@@B@BB@@D@BBB@@@B@B@@@BA@@BA@@BA@A@A@C@A@A@C@C@@@A@CBC@CBE@CBA@C@A@A@A@A@@@A@A@AA@@A@AAA@AAA@EAC@A@@BA@ABC@@@A@@@C@CA@@CAA@@@C@A@A@C@C@C@A@A@EBC@C@ABC@A@C@C@@@AAC@A@@AC@AACAC@AAGCC@A@ABC@ABAB@@@D@@@@@B@B@BAB@@ABAB@@ABA@ABC@ABA@@@A@ABC@A@A@A@C@C@A@@@CAA@@@C@C@A@A@@BA@@BABA@AB@@A@ABC@ABA@@@A@CBC@A@A@C@C@CBC@E@C@CBC@CBC@CBC@A@CBA@A@C@C@AAC@C@A@C@GAE@A@ABA@@BAB@D@BAD@B@B@BBD@BAB@B@BADABABABABA@ABCBA@ABA@A@A@C@A@C@ABA@@B@@@B@@BBBB@@BB@@B@B@BBB@@BBBBB@D@@@DAB@B@@@BAB@DCD@BAB@BABA@@@ABA@@@A@A@A@C@A@C@CBA@AAC@A@@@A@AAA@A@C@A@C@A@A@A@GAA@A@A@A@ABGFCFCBCBC@E@C@C@CBC@E@E@C@@@A@CAAAAAA@C@CA@@E@CACAAAACC@C@EBC@A@EBEBC@A@AB@B@D@B@@ABAB@@GBaJ@@GBGBI@CBA@E@EAEAC@E@C@C@EDCBADADABABC@ABC@ABE@A@CAC@@ACACACAA@@@A@ODMEKMKEWEO@OH@@A@@BGBA@E@EAG@EAEA@D@D@D@F@D@B@D@D@FAF@B@B@B@F@DAF@BAB@BABEACACCCACAAAECACAAAAACCCACA@@@AAA@AACCAA@ACCC@CAAAAAA@C@C@CDGBEFGDEB@@EDEDEBGBE@EB@@@@ABGBGBEBE@CBEDCDADCDADAD@FAFBF@DBD@DAD@DCDCDCDCBE@EBCBE@C@CBC@@BCB@DADCHDDDBDFBDDFDD@DBD@B@BADADAFCFADABCDCHADCD@D@JADADCFAFAFCHCDAFCDCDCBCBCBA@AB@B@B@BA@A@A@A@@@AAA@AB@B@@@BABA@EACAC@CAA@ECA@CACBA@ADAFADAB@DC@GBI@G@E@C@C@C@ABABAD@FAHAF@B@FABADADCDAD@@A@CDADCD@DBBBDBDBD@D@F@DAD@@ABCDEDCBCDCBE@CAAACA@@A@A@CBABADCBCBABE@@@C@C@C@AB@@@B@DFDDDDDBBDD@H@F@HAH@F@FBD@BDDDBBBBD@F@D@DBDDDDBHFHDFDBBFFDDDBFBFBJBFBBBFFBDBB@BADCDCBCDAD@B@@CDEBEBIBG@G@IAG@CBEDIDKFEDGDCDCDCFDDBDFBDDBD@D@@ABADCFAHHP@DEF@B@@BBH@BADCBABAD@LDFBFD@DBDD@D@DBFB@@D@DAH@FAFADAFAFAB@DBDBF@BBDABABABAF@BBBDBBBBB@B@F@DAB@B@FAD@D@FAD@DAFAH@FBFDDBJBDBFDDBH@DBFDBBBBFBDDFDFBHDFBDBD@FADABEBCB@HCLEHCD@FAF@BADCBA@@@CAAA@AAAA@@@@@A@@BA@@@@@@@@BAB@@@BABA@@BA@ABA@@BAB@@@FG@@@@DAFCFCHCDADAF@HFFDFDDBB@FBF@DAFAFBFBFDHDHHFHBB@D@BAD@DAF@HBP@HBD@B@@CBEDEF@HDHFJAHIFCDBF@@FBFBHDFBHBFBHBFAH@HBH@HAFAHAFC@@DAB@D@BBBBDBFDDDBD@DBBF@F@F@HAF@DAJCB@F@DBFBFDF@DABADCBEBABAH@FCDAFAF@J@L@HADAFABAH@FBFBF@HBF@J@F@D@BC@AACBE@AAEBCBC@E@EAIAI@A@EA@BCBCBA@C@A@A@ABCBADABA@BF@F@DAFCH@HAF@HBHBH@HABAFAF@H@D@FAD@H@H@HBD@F@DABCBCD@BAJ@F@FAHAHAFAD@B@D@J@FAD@H@F@@@H@H@D@F@D@D@DAD@D@D@BBDBF@D@F@D@D@FAF@D@D@D@B@HAB@FAH@HAFAD@@@DBH@H@HAH@F@F@FAB@D@DAD@DBF@D@D@FAD@FADAF@B@@B@BBDB@D@FADAF@FAD@HAD@H@D@DBD@D@F@HAHAF@J@JBF@F@@@BABABCBAB@D@B@D@D@BABABABABEBEBA@ADEBEBC@A@A@ABABAB@@@B@F@B@BB@@BAB@@@BA@@@@@@D@F@B@BBD@@@@@B@DA@@@A@@B@DCB@B@@@BABADABA@@@C@A@A@AACAA@@@A@A@CBA@A@@B@BCBABABA@A@@BA@@B@D@BA@@B@@@B@BA@@B@@@@AB@@@BCBABC@AB@@@@ABAB@@@@@B@@@BBDD@B@@@@@B@@@@@B@@B@B@@@B@@ABA@@BAB@@A@@BA@@@ADA@@BA@@@A@@@@AAAA@@@A@A@@@@BA@@B@B@B@BAB@B@@A@A@@@C@@@A@@@A@AB@@AB@@ABEDIBA@@C@A@EC@AAACACAE@EAEAEAEAECCCAA@AAAEAE@@@ABCAA@CBA@A@A@ABABA@ABAB@B@@A@A@A@EA@@ACIEIACAC@EAA@CBA@ABC@E@GC@@EEEACBC@C@A@CBG@E@E@E@A@C@C@A@CAA@A@AA@@AA@ABABABADABABG@EBE@CBE@CDGDGAGAAAC@E@ABCBADEFEBA@EAC@E@C@C@A@@@C@A@@BA@@DABCBAB@LEBAFCDEHIDCHAL@HDLJBFFD@BDBB@DA@ABC@GB@FADDFABA@@DCDCFCDADABGDGBADCFC@ADEDEBG@CCE@AAAAAAA@A@C@@BC@C@AACKGGECECE@A@@BCD@L@JAH@D@J@H@FBFBF@D@F@DABCDA@E@E@@A@@@@ABCDCDCBABABABCBCBCBADAD@DAFCBE@G@E@E@AAABA@AD@B@DD@@FBDBB@DBDBBBD@@BB@DBB@DBFDDBDBDDDBD@DBD@D@D@BCBCBG@ABA@CDGBCDBBAB@BA@ABBB@@A@CA@@@B@BABCB@@A@@B@BBB@@@DGA@@C@AB@B@BD@DCBAFFBBBB@B@B@D@@@B@@@AC@A@@AE@C@ADIDE@GAEECC@AAA@AA@A@C@ADABCBABCBADABABABCBABADADAD@DAD@BAB@BAFADABAD@BABADABAB@@A@@BABABADA@ABADCBADCDABADCBADCBABADC@@DA@@FABAB@@@@AB@@A@@DABC@@@@ACAAAAAG@A@C@E@A@A@@@C@ABCFGBGB@@CBC@@@CBC@C@CDABAB@DABEBCBABADAD@DBDBDBBBBBBBD@D@D@@@BAB@BABABAAE@@AAAA@AAA@E@G@C@AAE@@@AAA@@A@@@@@A@@@@B@BAD@B@@CBAB@@A@@@A@A@AAA@AA@@AAC@A@AAAB@@A@@@ABA@ABA@@@C@CACCAAECAACCCAAAECA@CCAAA@CA@@@A@@BA@AB@HBFBDBB@@AAA@@@AA@A@@A@ADA"],encodeOffsets:[[114177,30258]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("长沙",{type:"FeatureCollection",features:[{type:"Feature",id:"430102",properties:{name:"芙蓉区",cp:[113.032539,28.185389],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@@@@@A@ABABA@A@@AA@A@@A@@AA@A@A@CB@B@@A@@BAB@@@BA@A@@DI@@@BDA@C@A@A@@@A@GB@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@A@@@@@A@A@A@C@@@A@@@C@C@@@C@A@C@@@A@A@@@@@A@AB@@@@A@@@@@@@@@@@C@A@C@@@A@C@A@A@@@A@@@@@@@@@@@@@@@@@A@@@A@@@ABA@@@@@@@@A@B@AAB@A@@@@@@A@@@A@@@@@@@@@@@A@@@A@ABAA@B@@@@@@@B@B@B@@@@@@@B@B@@@DBA@BB@@B@@C@@B@D@@@@@B@B@@@BB@@@@@@@B@@AB@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@B@@@@@D@@@@@@@@AD@@@B@B@B@B@@B@@@B@@@@@B@B@@B@@B@@@@@B@@B@@@@BB@@@@B@BB@D@BA@@B@BAB@B@B@BBD@@@B@AB@@@@@B@@@@@@A@@@@@A@@B@@@@@@@B@@@@@@@BA@@@@BA@AB@@@@@B@@@@@@@BB@BB@@BBBD@@D@@@BBBA@ABAD@@@BBLBB@HBF@@AB@@@HA@@B@@@JCB@@@B@@BAB@@DAB@B@FBDB@@B@BBB@B@D@@BBBB@@@@@@BDBBC@C@@BCB@B@@@D@@@BB@@@@B@@AHBHA@CD@N@@CBAB@BB@@@EB@D@@B@@@@BBA@@BADD@@A@@@@BBDA@AB@@CD@B@@A@@D@BEA@@@D@B@B@@@@@BCB@B@ABB@@BB@@@@CD@@AA@@A@A@C@A@@D@@D@BB@B@@AB@@A@@@A@@@@ACCA@@A@@C@A@@@A@A@@@@@ABABA@A@A@A@C@@@BAAB@A@@@@A@@AAA@A@GAKGCA@@CA@@CACAG@E@G@EBGDABGFGFA@@@EFCBCB@@ED@@@@A@@A@@@@@@A@@@@@@AA@@@@@@@@@@A@@A@AA@@@@AA@@@A"],encodeOffsets:[[115758,28861]]}},{type:"Feature",id:"430103",properties:{name:"天心区",cp:[112.989897,28.114526],childNum:1},geometry:{type:"Polygon",coordinates:["@@BBB@B@@@BB@@BBB@B@@@B@@A@@@@BA@A@@B@@@A@@A@@BA@@BABABA@@@@BA@@@@@@BA@@BA@@@A@@@ABC@@@@BA@@BC@@@@BA@@@@@A@A@@@@@A@@BA@A@@@@@A@@@@A@A@A@CB@AA@@@@AD@DAB@@@@@AAAC@@AEA@@@C@@@E@@@@CAAA@BCB@@EB@@@BABAB@@CAG@A@A@C@@BAFKCAA@A@AAAABA@@@@@CDABBBAB@B@BBBAAA@A@C@@@A@A@CAA@@A@@A@@@ACAB@CABA@AB@HDBBDDDBB@B@B@@@B@BABA@@B@BADC@@DCDCB@DEF@BBBA@AAABABAAC@ABABCAADACCA@FCAECAAC@AC@AAA@@AC@DAACCA@@@AAACAAAE@GB@AB@BA@C@ABA@E@AD@DADE@CDGFEFADFBE@C@AHBHDCACG@@CCCEEC@@AA@CDIDEDE@A@@@E@ACAC@A@A@@BABAB@DBBBBBBABABEBC@@@AAA@@A@A@ABADC@AAACEAAC@AAEBA@CAA@EGCCA@CAKLABCDEDQLGDKHEDIHCDABCZS`CFABEJCDINGJCFAFADANAXBVFLFD@BHFHJLLJNBDBHBJ@B@J@F@BAD@B@D@BBD@HBDBDDL@DBHBLBFBT@@BB@B@@@FBD@DBF@F@BB@D@@@@@@@D@@@@@B@B@B@@@@@BAD@@@@AA@@AAB@C@@@A@A@@@@@@@A@A@A@@@@@@@ABBBAB@@@B@@@@@@@@@@@B@@@B@@@@@@@@BBA@B@A@B@@@@@@B@BA@@B@@@B@@@@@@@@@@@@@@A@A@@@A@A@@@A@@@A@AAA@@@A@@@@@C@@@A@@@@@AA@@A@@@C@A@@@@@A@A@@@AB@@A@@@@@A@@@A@@@C@A@A@@AA@A@@A@@A@@@@@CBA@@@@@@@C@A@@@C@@@@@AB@@A@C@A@@@@@@"],encodeOffsets:[[115698,28820]]}},{type:"Feature",id:"430104",properties:{name:"岳麓区",cp:[112.93132,28.234538],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BCD@@BBA@A@@BA@@@CB@@@D@BB@GJCB@ACAGAEA@A@AA@@BC@E@@A@ABA@CBABEAAAA@@@@@@AABAAAAABBBAB@@@BABBBDDCDA@@BBDC@E@@A@CBAAA@EAB@BED@F@@@B@DABACABBACB@BA@ACGBACCDA@ABCB@@BD@@@B@BB@B@BB@@@@@B@@A@B@@@@@@A@@B@B@BCB@@@@DB@@@@@@B@@@@@@@@@@@@BB@@@@AA@B@B@@AF@BD@B@@@DCBAB@@AB@@BDBBFBA@@@AFDFAB@BAB@BBBA@A"],["@@HIJMDCFIBADET_DYBA@@A@@@A@@@ABCBCBADEFCHAD@DABAFC@A@@BA@@A@@@@@BC@@@@@@@@B@@ABAA@@@@@@@BAB@A@@A@A@CC@BA@@A@A@B@B@@A@AA@@@@@AB@@@AAA@@@@@BA@@BAB@@A@AB@@AB@@ABA@@@A@@@CA@@A@@BAACA@@@@BAA@@BABB@A@@@@@@A@@@@@B@@A@@@@A@ABA@AA@@@A@@A@AA@@AA@@A@@@AA@@@AB@BA@@B@B@@@B@@AB@BB@@B@B@B@BA@@BC@@@A@@@AB@@ADA@@@AAA@@@@AA@@B@@AA@C@@@A@BF@@@BA@@BA@@@@A@@@AA@AACBA@@AA@A@@@AAA@A@@A@ADADB@A@A@@BA@@AAC@@AA@@AA@A@A@@AA@A@AD@@AB@@AB@@A@@AA@A@@BA@@@A@@AA@@BA@@A@@@AAAA@@@@A@@@AA@@AC@@@@@ABCAA@@DBBADC@@@CDABC@A@AAC@CCA@@@A@@A@@@A@AB@@AC@@@A@@@A@@BA@@@@BA@@BBBB@AB@@@BA@@@BB@@A@@B@B@@ABB@B@B@@BB@BBH@@@A@@BA@@B@@@DB@BB@@@B@B@DA@@B@@ABA@@@@@ABA@@@@@A@@EA@A@@AA@A@ABB@AD@@D@@DC@@BAB@@@B@BA@AB@B@@@BAA@@AAA@@F@@AA@B@A@@A@@@@@BAAAB@C@A@AAAB@A@A@A@@B@@@D@B@BAC@BA@@@AACBA@AB@@AA@@AA@@AAAA@AB@ACAACABAAA@CB@B@@A@AAA@@@A@@BBBB@@F@@@BBBA@@B@BB@@@@BA@@BA@A@@@@@@@@BDBDB@BA@ABB@ADA@@BA@A@B@@@@BB@A@A@@@@@@B@BA@@@CB@@@B@@@@@@ABDDABABAB@@BB@@AB@@@B@@B@@@@@@B@@@B@@A@@B@@@B@@@AA@A@A@CAC@CAC@C@AD@BC@ABAB@B@@A@A@A@AB@BB@DD@BA@@DBBBBCBA@@DDBABBBA@@BABA@CAA@@@@@@BA@AB@@A@@@A@@@@B@B@@A@@@BBBB@D@BAB@@AB@BA@BB@@A@ABC@A@C@@@BBA@@B@@@@@@@@@BB@@@@B@@ABBDA@BB@@ADB@AB@BBBBB@D@@@@@@AAC@AB@AA@@CC@A@AB@@ABABA@A@@CCAA@@B@BABA@ABABCA@C@AA@A@EAA@ABABAD@@@BAAEDA@@@AAC@ABABAD@@@B@DC@A@@@CBABABA@@AABA@AAAD@BA@@BCBABA@@B@BAB@@@@A@AA@@C@A@AAAACACAAAACC@AAA@@@EAA@A@CCA@CBE@CBA@A@@@CAAAABC@A@CBCCCBA@A@C@CBCBA@ABC@KBCBEDC@@BBBAFABCBC@A@GBADADIAGHABC@CBABA@ABA@ABA@AAABABA@A@@BAB@BBBBDEBABC@A@A@AAE@@AKBKAMCEB@BAAEBA@AB@B@B@F@DB@B@@BA@CHA@AB@B@@@@BBD@B@BBB@DFH@FB@BDFBBBF@D@BB@@B@B@@@BBBCF@BDBBBBBBB@FCF@B@B@BAD@BABAB@BA@@@@@BB@@@B@BBBB@BBB@@@@@B@BBBB@BB@@A@@B@@BA@@B@BBBBF@BAF@D@B@BD@@@@@@@BAFBB@@BB@A@B@@@@@@@A@@@DBDBDBDB@@BA@@BB@@@@@@@@@BB@@@B@@BB@@@BBBBDDB@@BDBB@BAB@BB@AA@@AAAAA@@@@B@@CB@BA@BBAB@A@@ABA@A@AB@@AAA@CB@B@BAB@@@BA@@@AD@@@BC@@D@@CB@@@@@@@@A@@BBBBB@BBDA@AB@BBBADBD@D@FABAB@DABADCAEBABA@@@AB@B@BCBABCB@B@DBBDB@BBB@BB@@D@DDBBB@BBB@DDBAD@B@BA@AD@B@B@DEBBBABA@@AAAB@@ABAADAACDABADA@@FAFAF@DBBDBBB@B@BA@@@A@ABA@@@AB@@A@@@@BBDCBBB@BBDAD@@BA@ABBBB@B@BAB@@CD@B@BABA@ADAFCDE@EBAAEBAB@@AB@DCBA@AFA@AB@BB@@DBDBFBB@B@@D@B@@BD@@@@BAB@DFBBBB@DABBBBBB@BA@@B@@@BA@@D@B@@@@BBBB@@BB@@B@BB@@@BB@A@@@ABA@ADABBBDB@BE@AB@@@B@B@BAB@@A@A@@BABDB@BCBBB@@FBDNHDDDB@BA@@BD@@BA@@B@BABC@E@@@@BABCA@@ABAA@B@@@@@B@@@B@@BBCBA@@@@BBB@@FBHBBBDDJHDBB@@@@BAB@@@B@DAB@@A@@@A@@BA@A@@@AB@@@BB@@@BB@@@BB@@@B@@@@BAB@@A@@B@BA@@@A@CBA@BBBA@DADABCFA@AB@A@@@BA@@BB@@@B@AB@@CA@B@@A@@@@A@@@@A@A@@@A@@@@@@@AB@@@BAB@DA@AD@@@@@@AB@@@@A@AB@@@BA@A@@@@B@@@@@@A@@@ABABBDAB@@@@A@@AA@@@@A@@A@@@@@@B@@@@@@@AA@@D@@@BABA@@B@@AA@@AB@@A@@@@BAAB@@@A@@A@@ACAA@@AA@@@@A@@@@@@BAA@@@@@@A@AD@@AD@D@@AB@@@@A@B@AA@@@@@@@A@B@@ABCA@A@B@@ABAB@@@B@@AB@BABB@ADAA@BAA@B@@B@@BA@AB@@A@A@@BAB@BA@@@@B@@BB@@B@@@DDBA@@B@BAADB@B@@@@B@@@B@B@@B@BB@@BA@BBAB@@@B@@@@@DBBB@@D@B@@B@@BAB@@@@@@ABAD@@A@A@@B@D@@AB@@AA@@A@@B@B@D@FDD@FAB@B@BBBD@@FA@BB@BCFB@BBB@HB@@AB@B@B@@@B@B@B@@C@@BBBAA@B@@@@@B@@@B@BBABD@FDDBBABB@@@@BAB@B@B@@@@@@@B@BA@BB@@@BB@ABBB@B@BBB@@A@@B@B@B@@@@@B@@@B@@@@@B@@BB@B@@@B@@@@BD@@@B@@@@@@@B@@@B@@BBB@@BAB@F@DABBABBB@BLHB@@@@@F@@@@@B@@BDB@DB@@B@@BB@@@@BBB@BA@@B@@@@@@BB@B@BB@ABA@D@@@CB@BAB@@A@@@A@@@@@@B@B@@@BAB@@A@A@A@@@ABBB@@@@BB@@@BBB@@CD@BAD@DBB@DAD@@@@@@@BB@@@@@BA@@A@@@BA@@BB@A@BB@A@BB@@@@BA@@@@@@@@@BB@B@@BA@@@@@@@@@@@AB@@B@@@B@@BB@@@A@@@@@@@@B@DBDBD@BDBCBAFO@@@ABEBCBEBG@A@E@A@I@@BKAQAC@A@A@GAI@I@@AC@C@C@@@@@C@AAI@A@EAE@CAC@E@@@AAA@@ASAEAKAG@CCKACAC@GAC@A@C@ABC@A@E@I@AAIAGACIMKKGIGE@AECEKAUBWBMBCBEDE"]],encodeOffsets:[[[115503,28722]],[[115645,28721]]]}},{type:"Feature",id:"430105",properties:{name:"开福区",cp:[112.985884,28.256298],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@@@@@A@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@A@@BA@@@@@@@A@@A@@@A@A@@@@@C@AAB@@@@A@A@A@@@@@C@@@@@@@C@A@BJ@B@D@@@@@D@DBD@@@JBJ@H@B@BBDBRAL@@@J@B@F@BAHAFADAF@B@@EPABADABAHCD@BS\\UXYXIJIJCBEFCFFBDBDBJHDBHDFD@BB@J@FCDAFA@@BBDADBDBADABBBBFDDDFCBFFBHBB@HBD@DADBDHBB@B@ABF@A@@DB@@AFBD@B@B@DDBABDBBB@BDB@DABD@AAAD@@@DC@@BCBADBB@AC@ABBB@@ACABACAB@BADBBA@@DFD@DCBB@CPADDB@B@BADBB@BBABBBDGBD@ADCDA@CFDFHB@JADGAAFEJEBC@EH@FAHA@GF@D@D@CKDADDD@BAB@DA@DDBBJF@B@DABCBADEG@@A@@@@B@BA@@BA@A@A@@AA@AA@AA@@CB@@@@A@@A@@@A@@@@@A@@BA@@@@@CAA@@AA@A@@@@@A@@@@@@AA@@A@@@A@@@A@@A@@@A@@A@@A@@@A@@@A@@@A@@@AA@@AA@@AA@@@AACAA@AA@@A@@@A@@@A@@@AA@@@A@@@@@@@AB@@@@A@@@A@@@@@A@@@@B@@A@@B@@@BA@@BA@A@@@@@@@@A@@@@@@@AA@@A@@@A@@A@@@@@A@@BA@@@A@A@@@@@A@@@A@@@@@AA@@@@@@@@@AB@@@AA@@@@@@@@@@@@AB@BA@AB@@@@@BA@@@@B@@A@@@@@A@@@@@A@@A@@@@AA@@A@@A@@A@@A@@@AB@@A@AAA@@@AA@A@@AA@@EAC@C@AACACACCAEACCEACAAAECCAE@E@@HCDC@CB@BB@A@CBA@AA@@ABAB@B@@BDBBB@@D@@AB@B@@DBBBAB@D@AABA@B@@B@AADAAC@A@A@@@AB@DAB@@@@@B@@A@@@@@@@ABA@A@A@A@A@A@A@AAC@A@A@@AA@AA@@@@@A@@A@AD@@AB@B@@@BCAA@@AAA@AB@A@@@AB@CA@A@@@@A@AA@AC@A@A@AAA@@@CAEAA@A@CB@@BA@AA@@@A@ID@@A@@@GB@@A@@BE@GAA@KAAA@@C@AB@BABAA@@C@@@ACAA@@AAA@@A@@@@@@@A@@@@BAB@@A@@B@@A@@@@@@@A@@@@@@@AB@@@@@B@@@@@@@@A@@@@BAA@@@C@AAA@A@A@ABA@@@ABC@A@@A@A@@A@@A@@A@@@@A@@@@@AA@@@@A@A@@@@@A@@@AA@A@A@A@@@C@@B@@@@@@"],encodeOffsets:[[115698,28876]]}},{type:"Feature",id:"430111",properties:{name:"雨花区",cp:[113.03826,28.135722],childNum:1},geometry:{type:"Polygon",coordinates:["@@ADAB@BBDABABBB@BABAAE@CFA@CDCD@@CDABA@@@ABABA@@@A@A@A@CACCAAGCA@@BABDBA@DB@B@@@BB@@@BB@D@B@B@@@D@BBBABAAA@A@ABAACB@D@@@@ABBBBBB@B@DBELAB@@@D@B@BBH@DA@ABAB@@A@@FA@ADB@BB@D@@F@@@D@@@B@BF@@BDBB@@@@A@CBC@@B@@B@@BDAB@B@B@@@@@@B@@@@@BAB@@@B@@@@@B@B@@@@AB@@@@AD@@AB@@@@AD@B@@@B@@AB@@AB@@@@@@AB@@@@ABABAB@@AB@@@BB@@@A@@@@BAB@@@@@BA@@@A@A@AA@@AA@@A@A@AA@@@@@@@B@D@BA@@B@@@@@D@@@B@D@@@@@@AB@D@@@@@BB@@@@BBB@@@B@B@D@@@B@@@B@@@@@BA@@B@@@B@B@@@@@B@D@@@BB@@B@@@@@B@@@D@@@@@B@@BB@B@B@@@B@@@B@B@@@B@B@@@@@@@@@@@@B@@@B@B@D@B@@@D@B@D@@@@@@@@@@@B@@@@@BAB@@@@@B@B@@@D@B@D@@@D@D@@@B@@@D@B@B@B@@@@@B@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@HAB@@@B@B@D@B@AC@@J@@CB@B@@A@@BA@AB@@@@ADAB@B@B@@BB@@@@BBB@@@BABAB@B@B@@@@BB@@@B@@BB@@@@BBB@@@@B@@@@@@@@B@@B@@@@B@@@@@@@@BB@@@@@FC@@DADAFE@@B@HEHEBAHCFAH@F@H@DBDB@@DB@@DBLHHBB@B@B@@@B@BABC@@FIBC@A@@@@@A@A@AA@CAA@C@EBGDEBA@E@CAGACCGECC@@AA@@@@EGAE@ACAAE@EBC@GBGDE@@DEJIB@BGFQDCXYBAZQB@RAVHBANANAH@LMBCFCFGJKDM@AAAACBCRBDEBABCCG@GAE@EBE@E@E@A@ECECECCCCA@C@CAC@CAAAAAAAAC@A@A@ABAHEBA@A@@A@A@@A@A@@@A@@AAA@A@A@ABA@@BA@@@@@B@@BB@@@@BA@@BA@@@@@@@@@A@@@@@@@@A@AAA@@A@AAAAEAEAA@AA@@@CBABA@A@CACAA@CB@BABA@CBA@C@@BABAD@BCBA@CAAAACAA@AA@@ACACAECCAAG@EAC@AAA@@ABABCBCAAAAAAC@C@AAAA@A@A@ADABA@CACBCB@BAD@DA@A@@@AAA@AAA@C@C@@@A@CAC@AA@EAA@A@A@A@A@CBA@A@ABCBADAB@B@BABABCDCBAD@BABADADA@A@C@C@ABCBABCBCDCDCDCFCB@DABBDBD@DBDBFBD@D@@@BA@A@CACACBA@ABAB@B@B@@CBCAABA@AB@@@B@BBBBB@BBBD@BDFBDDDDFFBDBB@BABABADAB@B@BEDABEFGHADAB@BEBGAG@]EC@KA@@GCGA@B@DAFCEEBEFCH@DCFCBC@@B@FAB@B@DABA@@BHAF@BBDBBB@B@@DBBDCBD@@BB@BBD@@BBDDBBFEDB@DDCBBB"],encodeOffsets:[[115748,28725]]}},{type:"Feature",id:"430112",properties:{name:"望城区",cp:[112.831176,28.353434],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@DAAA@@@A@@@A@@@@@ABBBA@@DBBA@A@@F@D@BA@A@AB@@AC@@AB@@ACACCMGAC@EA@AAADA@ACAB@@@B@BA@ABA@A@@@A@@BAFA@ACAACB@BAB@B@@@BAA@@A@@A@AA@@AA@AA@A@@A@C@@@AB@@A@@@ABA@AAAABA@CAAAACEA@AB@@@@AC@@@A@CA@A@EACACA@@AAA@@BEB@BABCDA@@BA@ABBFAB@FCFEDCB@BABABA@C@@DA@ABA@A@AABAB@@AC@CBAAA@AACDAA@@@@@BA@@B@@AB@B@B@@ABA@A@AAACCAE@EBEB@@CBABCBBDCBBBBA@@BABB@@ABABAACFA@A@C@@BABA@C@ABCCA@AAA@AACCC@@@AAA@AAA@ACCAA@A@ADABADA@A@@B@@ABABBFCDABCBA@ABEBC@C@CAABAAA@@BCBAAA@AAAA@@@B@@@@@@A@@DC@@@AD@@C@@B@@AB@@A@ABA@A@@DBB@BA@@B@BAB@BB@A@AB@AABA@@DA@@@@@BBBB@BB@@BBB@@@@B@B@D@B@B@BADAB@DABBB@BBDBFFBFBD@@BBDBDB@@BBD@BBBDDBBBBBBB@B@B@BABABCDCDCD@BAB@B@B@BDB@BBB@BDBDLADADCD@F@HAD@B@D@D@BBB@BBBB@BBB@D@FBHBDBBDB@@BA@A@ECA@ABAB@@DBF@HDB@@B@B@D@@@@@D@BAB@@ABCBCBABAB@BA@@DABAFABAB@B@BBBB@BBB@B@@BBB@@AB@BA@AB@BAB@@@DB@BBDBDBDBBD@B@@AD@B@BB@B@DBB@B@@BA@ABADABCFAB@BCDCFEDADEDABAB@BAHADBFBDDBF@FBFDDBDBDBFB@BDD@BAB@D@DAB@BABA@C@ICE@@@A@ABC@ABA@C@CAC@A@AB@BA@@BBB@@DDBBDDBB@D@B@BABA@CBE@E@E@CBA@CBABA@ABCDAD@BAD@D@D@@@DBBBBBD@BBB@@AB@@AD@B@@ABABC@CBCBODCDCBAB@B@B@B@D@DBD@FBDBDBDBBBDDHFHFDB@DBLDDD@@@BADEDABCDMJOBGBEDBBBB@BBFBFBDFJ@BFJHLDHBDB@DBDBDFBB@@@@B@@@B@D@@BBAB@BAB@B@LCJCNKHCFCB@F@B@B@D@DCD@@A@AD@HAFBD@@@JB@@B@@@BB@@@B@@DBB@AH@DBB@@D@@@@@B@B@@@FBBL@@FLB@DD@B@@FDHHHLDDHFB@BBRHJDHDTDD@@AB@A@@CECCGGECGIGAAA@ACAC@ABCAA@CBA@A@ABABC@AB@BA@ABAD@BAD@D@B@B@D@D@B@DBB@BBBFBDBBBB@@D@BB@@B@B@D@@AB@@A@A@ABA@AAEAC@E@A@A@ABA@@D@B@B@DBB@D@BAF@BAB@B@L@DBD@DBD@B@B@BADADA@@BAB@DAB@@A@C@AB@@AD@BAB@FA@@BB@B@D@BAF@DAFAD@D@BBBBBB@D@B@F@F@FB@@B@DBBDB@BB@@B@BA@ABABA@ABADABAB@D@D@J@B@F@FBDDD@D@B@B@BAB@BAD@BAFEHGBABAD@BBDBDDFDBBD@D@DBB@DBDBDBDBDBD@F@FABBFBDD@E@@@A@C@A@C@AAA@ACCAC@A@C@A@ABCBA@ABA@A@@AA@AA@AA@AC@AACA@AAA@AAC@A@AAAAA@AA@CACA@AA@@A@ABABADCFEBABABADADAB@DAFBB@B@BABA@ABABAD@@@B@FBHBFBJDJDDBLFFB@BBBBFBBDFDBDBDDDBD@D@B@D@BAD@BAFCC@@CDADA@A@@@@@AA@AAAAA@AAAACAAACBEBA@CA@AA@AC@AAADAB@@CAA@@E@AAAAAGBEDGGIAEAC@ABA@EBCTPAEAEAEMECGGGGIA@EEEAAAA@A@C@A@C@@@C@A@@@AAA@@@AB@@A@A@CCACEA@A@AB@@@@AB@B@BAB@@ABA@CBCAA@ACAECAAA@EBAAA@BCA@ACA@AAACABCCA@A@C@EA@BA@@CB@E@BAA@A@GAACBC@CAC@GAAAGEEDACECCAEAABABCCACACBAA@@EBCBEDI@A@@AECGCCAIGCACAEADEFEDAJIJIZWVWT[@ADCBGBAACC@CACAA@@@@@@@@@@B@@AA@@@A@@@AA@@B@@@@@@@@@@AB@@@AAA@@@@@@@@B@@A@@A@@A@BAAB@A@@AB@@A@@@BB@@A@@@@AA@@@@@@C@CBA@CAC@ABC@@DA@AA@@A@@A@@A@AA@B@@@B@B@BA@AB@@A@A@@@@@@@@B@@@BA@ABA@@D@@@CAB@BAAA@A@@A@@@@A@@@ABA@AA@@@@AA@@@AA@@CCA@AA@@@@@E@@@@@A@KG@AAABAAACBE@A@AB@@AA@AA@@@A@@@@@@@A@@@C@@A@@A@@@A@A@@AA@@@@@A@@@A@@@@@A@A@A@@@@BA@AAA@A@AA@BAA@@A@@AABA@@@@@@@A@A@A@AB@@@@AAABCAECC@BAAAA@@@A@@@@@A@B@ABAA@@@DA@A@A@@@A@A@A@@BA@@GAA@AEAADA@@AEB@@ACAAA@A@EBC@ECC@A@A@@@@BB@@BA@@BC@A@@@@B@BC@AB@B@@@@A@AB@@@AA@C@@@AACA@@@@A@@@A@AB@AAB@@AAA@@@@A@A@@@A@@A@A@BCABA@@@ABCC@@A@@@AA@@@A@@B@@ABA@AB@B@@@BAB@@AA@@@@ABB@ABBBCA@BA@ABA@@@A@@BABA@@@A@BDBBA@@@A@B@@@@@@BBA@B@@@@@BA@@@CBC@@BCB@@@@@@@BB@A@@@@B@@@@@BB@@BBBD@@@BB@@@A@BB@A@@B@@@BA@@BB@@@AB@BA@A@@@CB@@B@@@@@@@A@@@@B@@@@B@@B@@BB@@@@@BAACBABA@@B@@@@@@@@A@@B@B@@A@@BAB@@@@@BA@@@@@@BCB@@CBA@A@@BA@@@@@@B@@@B@B@@@@@@B@@B@@@@ADB@@BAA@@@A@@AB@@A@@@BBAB@DEBABC@CABAAB@DAB@@@B@@A@AB@@@BA@A@@A@@@A@@A@@AA@@A@@A@@BA@@B@B@@AB@@@B@@@BA@C@A@@BA@A@@A@CAIGCCAAGAEA@@AA@A@@"],encodeOffsets:[[115553,28851]]}},{type:"Feature",id:"430121",properties:{name:"长沙县",cp:[113.081097,28.246918],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@IJCF@@CFAH@HAD@FBFDB@BBFFH@@@@BB@@DDHFDDHBDBF@B@FAHCFAD@B@DBB@@B@B@B@@@@@BADEJ@@ADABA@@@A@BB@@@B@@B@A@BB@A@@@D@B@B@BABAB@B@@@@@B@B@@@B@DB@@@DBBD@@@@@B@@@BA@@BA@A@@A@CC@@@@B@D@B@BB@@BC@@D@@A@@AA@BAA@A@AD@@@@A@A@C@@@B@AFC@@@@BA@C@@DA@@BCBAA@@@@@BC@BC@AB@AA@@@@@AC@A@@F@@AAA@AB@DM@C@@DGBGA@BA@@@@@AA@@C@@@A@A@AD@@@DADA@@B@@@BBAB@BB@@BBAD@@A@A@@BC@@B@BB@@@@@B@@BBB@@@B@BBD@B@B@B@B@B@B@BAB@B@@@@@@@BA@@@@@A@CBA@@B@@@B@BBDCBBBA@@@@AABBBC@A@ABAA@CA@A@@BC@@@AACA@AA@A@AB@BB@@BAB@D@BAAA@@DCDGD@@@FBFDDBFBBBDDFBDBFDDDBDBBBD@D@FB@@BB@@@BBB@@B@BBB@@@BA@@B@@@@BB@@@@BB@@B@@B@@@@B@@@@@B@@@@@BA@@@@@AB@@@@A@@BABA@@B@@@@@@@@@@B@@B@@BA@@@@@@@@B@@B@@@@@B@@@B@@@@@B@B@@AB@@@B@@@@@BB@@@B@@@BB@@@@@@B@@@@@@@@@@BAB@@AB@@A@@@@BA@@@@@@B@@@@@B@@@B@@A@@B@@@@@@@B@@BB@@B@@@B@@@B@@@BBB@DBBB@@B@@BB@@BB@@B@@@B@@@B@@@B@@@BB@@@@B@@@BB@@@B@@@B@@@BB@@@@@@@B@@@@@BBB@@BB@D@@@@AB@@@B@@@@@B@@@BB@@@@@DA@@BBB@@BBB@@@B@BAB@@ABA@@@@@@BH@CFABADCBA@E@AICA@CCBA@ABC@CCCBDLC@C@E@@HGBEBG@@FADIFEFBBCHIBA@EGEC@DCBCD@BACCHAABAAAA@CAABA@A@CCOB@DAACDC@CE@@ABCAABA@DBABDB@BA@AA@BBDA@CAABAD@@CD@@C@BB@BACCBADB@BBFAB@BBFDDB@BBBAD@DAB@BA@ABA@A@@B@@A@@B@BFBBDDDB@B@@@BA@@B@BB@@B@D@@@D@B@D@B@B@BBFBFFB@HJHHDHNFBFBFBFSOAD@FAB@BBDBFHJCHAFBHBBBBF@@@BB@DA@CBBB@BBDB@@BDBB@FADABBDBBBBBB@BBBBB@@B@@@@@BCBCB@DD@EDABFDFFFFDBDBBDBDD@@FD@BBBBDDBFA@BDABFH@DBDDB@CB@DDDDDBB@BE@ABCJC@CBA@C@CD@B@BEFBDBBBB@@@D@BABAD@@ABA@ADCJA@AD@FABBDBD@D@D@FAD@DA@@BCBADEAGBACA@ABCACDAAEBACC@@@C@AAA@@BCBADBDDD@BBD@D@B@FAD@DADBFBDBBDBBDBD@BB@@DBDB@@BB@BBB@@@B@DAD@BBD@B@@@BB@B@B@BBBB@BBB@D@BB@BBD@BBDBB@@BBB@B@@ADABAB@B@DBF@HBL@@@DBDBD@BBBBBFJDDBBAF@DADB@DBBBBBBDBBBBBDCF@HCDAECBABAB@BABEACBA@AB@BAB@BADBD@DAD@DDFDF@JCFADAB@BBBBBBBD@FBD@DBBBBDBB@BB@F@BBB@BDDDDBFDDDDB@B@D@DAFAH@BADBB@BBBB@BBDBD@BBFBDBDBDBD@DBBB@D@B@DAF@D@B@B@HB@DAB@B@DCBADC@@@ABA@A@A@@@@@A@ADCBCBA@@AAAA@AAA@AB@BA@ABABADC@C@ED@DAB@BAD@B@BBBBBBBBB@B@B@D@D@D@B@BB@@B@BB@DBDB@BBB@B@DBDAD@DADAD@FABAN@LAFCDABABAAA@E@C@C@ABABCBAD@@@B@D@B@@@PFDBLAF@BAFAD@D@@B@@D@BBDBFHBD@LDDBBBB@@B@BDBBFDDBHDDBDBD@F@LED@JEHEFCFAFAB@@@B@D@HBHBDBBBB@BA@A@A@A@CAC@EAE@@AC@@@AA@A@C@A@@AAC@ABC@ADC@A@A@AA@AAAAAA@CAMAI@C@ABABBB@BBBA@@B@BA@CBCBE@ABAB@@@BB@B@B@DBDBDBB@B@@BBH@H@F@B@B@@BBBBB@BB@B@BA@ABAB@B@DA@@B@BBBBBDDDBBB@B@B@@AB@DCDCFCDEDC@CBAAA@A@A@@BABABAD@DA@AJCFAFADCDABABA@ABCBABC@ABC@C@EBGBE@CBA@CBABCBAAGBGAC@CACACCCCA@CECCAE@CBAAC@GCE@EAC@CABA@E@CACCAC@C@E@A@CAACCAE@GAIAG@EAEBAB@BAB@DCAACAGAGACAACACAGAAEACA@GACAA@AA@A@A@ABABE@G@C@C@AACG@CEE@G@A@@ACA@AC@CABAACAB@@AB@DA@ICA@@AAABC@ACAAAEBA@CBABAAEAAAACBGAA@CAAACE@CAG@AE@C@AAAAAC@A@A@E@CACAACGCEAAA@ACCCAAAAC@E@CBCBIDGFMDMBICGDEBA@C@@AECCCAAC@AACBE@EBEBEBA@A@A@CAECA@AAC@AAC@EAC@E@E@CAAAACAAAACDCBC@A@CECEEGEC@CDABCAAA@A@C@AACCACCCAAE@EACAAAC@E@@@C@C@GAEACACACCAA@AAE@ACDE@CCAEE@ACCBEAGGAE@A@EACCCACBAAAA@AF@@CDEBAFIHGDGBABE@CACAAACBCDGBCBAD@B@BA@CBABCBEDC@CB@@AD@BBB@BDB@BBFBFDFBDBD@DBDBD@FBDBD@HFHDDBDBBBFA@C@AAEBABAD@BBBBBDDDF@F@D@DDBDBBBBD@DCDAB@DABC@AAE@CCACAAAC@C@CACGAC@EAEACCE@CDAFBDCDEFEBC@ACEEC@AAA@CFABDFDDBFDFDHDJBJDHBJBDBF@F@DAFAAG@EBADADABAFCF@D@H@H@D@DC@@ACAAEEGECAEEGGCCICGCCA@CCCCA@@IACACACACCCAEAACAAACCCCACAEACAACAA@E@CACCAAACACAAECECCECCCCAGCGEEAEACBCBA@@AAEAECAA@C@IBG@C@AAAAAAAAAC@CBA@EFCHCHABA@ADABACEE@CCE@EAADE@C@CBAFDDBD@D@BABABE@C@IBK@EBGDCBCBIFYLCBABIJGJCFABC@A@AAACCCAEAC@C@AFEICGCAFABABC@C@E@AAICEEEGAAAAA@A@ABABCJABABA@G@C@A@IAQAADBDBB@BCNILEHEDADKNG@MBMBABUGQBA@YRABWZCDERAH"],encodeOffsets:[[115800,28802]]}},{type:"Feature",id:"430124",properties:{name:"宁乡县",cp:[112.551885,28.277483],childNum:1},geometry:{type:"Polygon",coordinates:["@@BBD@D@DAF@FBF@FCDAFCBABABADABAB@F@D@DBB@DB@BB@BF@B@B@@@BEAEAE@A@@@AB@@@BBB@@ABADA@AB@BBBBBD@B@F@HCHADAD@BADABAD@FBDBBDDB@F@DB@BBB@BA@CBCDAD@D@F@DBBBBB@BBBD@B@D@@BBDBBB@B@B@@EBAB@B@BDDDDDDBFBDBBB@B@DABAD@B@D@@BBDA@ABABAD@DBBDDFBDBB@@BABCAC@CBAD@B@DBBB@BBB@BBBB@B@DBD@D@BABAB@BAB@BAD@@BD@DAFABBB@@@@@DD@BB@@BB@B@@@BA@@BABCBAHABADABAD@DBDDDBD@F@D@DA@@DD@D@DADAFCF@B@BB@@@DADEBC@A@@B@D@DBFBFDF@F@@@FB@@B@DB@BB@DDDBDBBBB@B@BAB@@@@A@A@AAAAACA@A@@BAB@B@B@DAB@D@BAB@BAFABAB@D@BBBBBBBB@@DFDBB@B@@@B@B@F@DCB@BB@BABABCDCBCB@@AB@@@B@D@B@BAD@DAD@BDBD@FDDBBBDB@BBB@B@BBB@F@D@F@D@D@BBBBBBBBBB@@B@B@D@BAD@D@B@DBF@BBFBD@LBDBDD@HBFDBD@FBH@BBB@@BBDBDBFBFBHD@BB@@@@BB@@BFJDHLTBB@@BRBJ@F@BB@@B@F@BF@B@J@D@DBHHFHHHHHBBFCHAPANIDCBAFCBC@A@@CCKCCAA@ECEGCGACAAACACAC@EAC@C@C@A@A@ABADADCPCDADAD@BABA@@@ABC@@BA@@AA@AACAAAA@C@@@C@CBC@ABCDCBAB@BADAB@DAF@F@F@DAB@BA@A@A@CAACCAACC@@AA@AB@@ABAB@D@DBD@B@BAD@BAB@@@F@JDD@B@BA@ABA@C@CBA@ACC@AEACACACAECEAE@CAACAEBCBG@ABABAFCBCFCDEDC@ABADEBABCBAB@@AA@A@CAA@A@@A@ABC@@@AACCACACAAAA@@C@@BA@ABAB@@ABA@@AA@AA@A@AAA@AA@A@ABABABEBA@CB@@ABABADADABA@@BA@A@C@@@@@C@A@AA@GCE@CA@@BABAB@FDB@B@@AA@ACCAGAEAC@A@AAA@AA@AAA@A@C@C@ABC@G@EDCBCBCCKCA@AAA@ACA@A@A@ABA@ADCDCDCBABA@A@A@AAAAAAACAACAAC@AA@@CACAAA@@ACAEEECAAAA@AACBA@CBABA@A@C@A@A@@@@@AAAAA@ABA@CA@AA@CCAAAA@@A@@AA@@@A@@A@@@@@@@@AA@@AB@@CACACACA@@B@@@@@@@A@B@A@@AA@EAAB@@@@@@C@@A@A@CBE@AAEAA@A@AB@@AA@@@@BA@@AAAAAA@@@@@A@AAA@AA@A@A@@AA@@@@B@@ABABA@ABC@A@A@ADE@EAAAAAACA@ADEAA@A@@@A@AA@@A@CAEAACE@AEAG@CEA@AAA@C@AA@@@@@ABAB@DGB@@AA@A@@C@E@A@ABAB@FABB@AFANDLBLA@BF@BBB@B@D@BAFAACAA@ABA@AB@B@BABABBB@BAB@BAB@BADAD@BAHGJBBCBCHAB@D@DABABEAA@AD@FCDALAD@BAB@DADAD@B@B@DADDDAB@D@BABBDB@@B@B@DAF@DAB@DDB@B@FB@@B@BBD@BDBBDBDBBBBBB@D@@@BBB@@@@@BA@A@AB@BADA@AB@@ABCBBB@BA@BB@BABADA@@B@D@@C@A@@BCBABAAA@A@A@A@AAEAC@CFGFGBCAECA@AA@@BA@ABCD@@A@C@@ABE@@@A@ABB@@@@AA@@@@@@@@@@@@@A@@@@A@@C@@A@ADA@A@@@@B@@@@A@B@@@@A@@@@AAA@A@@A@A@@AC@@DABAB@DC@@B@@A@A@CLGDCBCAAGEE@EC@@AE@C@E@EAAAAAAC@@A@CBCBC@C@C@AAC@CAAAAACAAAC@C@CACAC@ABA@A@A@CACACCCCCACCEEECECC@C@CBA@EAA@C@CAC@A@@AAAACAI@A@AB@@AA@AAAAC@A@A@@BADABA@@@AAAAAI@EAC@C@G@C@ABIJABAB@@A@AAC@@@E@CAE@E@EAE@C@A@@BBBB@BBBBBBB@@B@@ABC@CBCBCBCBABCB@@ABCBC@EBCB@BCDABABA@C@E@CAAC@AACACCCAAAACC@A@@EI@A@ABAFCBAB@B@DFB@@ABC@A@C@A@CBCBE@CBABABC@A@A@AAAC@AC@A@C@C@@@C@CACACAA@@C@C@CAE@E@E@ABABAB@B@BAD@B@DAB@@@BABADABCBABEDCBC@A@A@C@C@ABA@@B@B@DBBBBBB@BA@CBCB@@@BAD@F@D@@ABA@@@AAA@@AABA@AB@BAB@@@B@@ABBB@B@BABABADAB@@AD@B@B@D@B@BDDBD@BBDBB@B@B@B@BAB@BABCBCBEBCDE@A@A@C@A@C@ABA@AB@@AB@BBB@B@B@@@BAB@BC@A@CBABA@ABAD@B@BAD@DABABA@AA@AACACAAA@AACAC@CBA@A@ABCBC@C@C@@@ABAB@BABAB@@@DAB@B@F@DAD@D@@ABCBA@EACBE@C@C@A@AAA@C@A@@BAB@@BBABADAD@DAF@F@DBDBDBDD@FB@@@BABCBCDGDABA@CBCD@BEBCFIFEDED@BCHAHADCJADCDC@C@C@EAEECCCACACACBCDCDCBC@E@A@AAEAAAC@E@C@AAEAEACA@AAC@E@AABCBA@CACCACAA@A@CACAC@AA@DCBA@C@CAC@ABABCBCBCAAAAAAACACAC@A@A@AACC@A@GBC@A@AA@A@A@C@AB@@AB@D@D@B@DBB@BAB@@ABCBA@@B@D@B@@@@AAGCMAA@E@C@ABA@@@AAA@C@C@EAA@@A@@@@H@D@BA@ABA@AA@ADI@@AAABCD@BA@CAAAAAC@EAC@@@CA@BAB@BA@@@C@C@@BADAB@@C@EAE@ABABA@@BC@IACAA@CA@@CAA@C@AAAA@C@A@@GCAACAA@A@C@C@A@AB@@A@EHAB@@A@CBC@EACAKEAAA@C@ABCDAJABAFAB@@CBGAE@C@CACAEAWEEAICGAAACAA@C@A@C@@@ABABA@A@A@A@C@ABA@ABA@C@G@EAE@A@CAA@AB@B@BAB@B@BAB@BABABA@A@A@A@@AAA@@@ABCBA@AAA@@AAA@@AAAACAAAA@@AA@AA@@AA@A@A@ABCD@BABABC@A@A@A@@B@BA@@@AB@B@BBF@B@BABA@@@AAAAAAAA@@AA@A@C@A@ACGCGA@IKACBE@CACA@C@ABGDA@@AA@@CBGAA@@A@ABABA@CAECECCCACAC@CDCDC@A@AA@CAEA@@AA@CACCAAAE@@@A@A@CBAAA@CACAA@@DABBD@BABA@GBAACAGKCEAAACAA@ACAC@A@EAEAEAC@@@C@C@C@C@@@ABABABCJAF@DCDEBEBSJG@@BA@A@CBABABABA@AACAA@A@ABABA@AACG@AA@A@AAA@AA@@AAABEBE@A@@@AA@CAA@@AA@@A@A@BH@BABA@AAA@AAAAA@A@C@CAAAACAAAAA@AB@BAB@@A@A@AAA@C@@@ABC@C@EA@BAD@@@D@D@DADADCF@BADAFAFAH@@@D@FADABAFA@@BADADADCBA@CBC@ABA@ADABADCBABC@CBABABAB@B@BADABAFADABADAFCB@BABABCBCBEBEBCBA@A@CAA@AAACCCCCCEECECEA@@GACAC@A@A@CBCBABABABABAB@D@B@BBBBDBBBD@BBFDDBB@@@DBBABGDGDA@@@ADCB@BBDBDBD@B@D@BBB@BB@DB@@@@DDDBB@B@DBDBBB@B@BAD@BA@ABADABAB@D@B@B@D@BDFBD@D@BDDDDDBBBBDBDBBDBDBDBB@@BABA@C@CBABADABAB@B@B@B@BCBABABB@BB@BEFEFABEDGD@BADCD@B@BB@FD@B@@@BDHDJDF@@DDFDFDFDFDDBDDDBDDDD@BBB@D@D@B@D@BCH@D@D@BDB@BBF@D@D@FAF@DBBBBD@D@BBDBBDBDBF@BABABA@ABABA@@B@B@@@B@@B@F@D@B@DDBBBDBBB@B@D@BABBBBBDDB@BB@@@@BAJBDBFDDDBF@DBBB@@@BDBBB@@@D@BB@DBHBDB@BBBD@H@L@H@F@DBDBBB@BAB@BCBAD@D@B@BBFDDBDB@DBFBDBD@DBD@B@BBBBDBDBD@D@D@HBFAD@BBDBDADAB@@@D@BDBBD@B@D@DAB@BBBBD@D@D@DBBBB@DBDBDDB@@B@D@D@B@@BBDADADAD@D@B@DBBBBDBBB@D@BAB@B@B@HBHDD@D@BAFEBADAD@D@H@HBD@DADCDA@C@@BA@A@CBABCHAFCFCHADADAD@HADABCBCFGDAFAD@HAD@DBBADAD@DBBBDB@@HFB@BBHA@@F@BAB@@ADAB@@@FADABABA@CBCBA@G@CDC@C@@DAB@B@BAB@BA@A@@BA@ABADBDB@BBB@DBBDFHDDDBF@B@BDFBDB@B@D@BB@@BBB@B@B@B@BBBB@BDB@B@BDBB@BBBBBDBBB@D@B@BB@@A@@B@DBBBBB@DB@BDAD@DC@ADEBAB@B@HJB@BB@D@BBD@@DDBBHBB@J@D@B@D@D@BB@D@FAFAB@BCDAJ@BAD@B@@"],encodeOffsets:[[115013,28963]]}},{type:"Feature",id:"430181",properties:{name:"浏阳市",cp:[113.643076,28.162833],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@C@GB@@C@@@GBEAC@A@EAE@G@CAE@AAEACCA@A@ABA@ABEDEDEDCDCBC@@@A@AAC@CAC@A@A@C@CBEBCDCDEBCBCBC@A@C@EBEBCBA@@B@B@D@D@D@BABC@A@AAA@C@E@A@AA@@BA@C@CBC@CBCAA@@A@A@E@A@EAEAA@C@A@@B@B@D@DBB@DBFBDBBBB@@BBBDBB@D@DADBF@D@BAD@B@DADADABABA@C@ABAB@@@B@BB@B@BBD@D@B@DBB@BAD@D@D@B@@B@@@BAB@BAB@BABABCBCBC@A@C@A@C@AAA@AB@B@BA@@D@B@B@B@BAB@BCFADCDEFCDAB@B@BBDBDBBBBB@B@BADABAB@BB@@@BBB@BAD@D@D@D@BBDBB@BDBBDDB@B@B@BABABCBABCDABAB@BBB@FBDBD@DAD@BCBA@A@@B@BB@BB@@BBCDAD@B@@D@@B@B@BGLADACAAAA@@AG@CAC@C@C@@BA@@@@@A@@@@@@@@@@@@A@@@@@@@A@AA@@@@@@A@@BB@@@@@@@@@BB@B@@@D@F@@@B@DBDBH@F@@A@@BAAAA@@AB@@@B@B@BAFA@@@EBA@@AA@ACAA@@AACGAAAAA@ABAB@BCBC@G@ABE@@BAF@D@@A@EAC@CAABABCDAD@BAAAA@GEMAAA@C@GBE@AAA@EAE@ABA@CBE@G@A@AB@BABDDDDDFDF@F@B@F@FAF@FBF@HDHADABCFJBB@D@H@B@BABADIBABAB@B@BBBBFHFFJDBBF@D@D@BABABEHDJDEF@B@DBDBFDDBDBBB@D@BADEHIJIBADAZKJEDADAHCFAL@JAD@F@BABA@A@CACCCBEDAD@F@BCFBF@DDF@DFABCB@BABGBGDED@FAB@DBDBBBBBBBBD@H@JAD@B@DBBFBF@BB@DADAFBFBHFHDDBDDFDDDDFBFDBDBBBDBBD@D@FBBBDDBFBDBDBDDBDBBBDFBDBDDDBDBDBJB@@DBDD@DDBHDJDDDHHFFDBHFFFBBBD@@CDC@G@G@C@E@EDABCBCBAB@FBHEBCBE@E@CAIAGAICIAGCECECCAECACEB@DBB@BFDDF@BADEFCFCDEACB@DDFBDBF@FBDDHDBD@D@BBDBDB@DBF@BADCBA@CBCDC@AAAAACCCC@E@E@CCACAAAAC@ABABBF@B@DEBAACACAGCGEC@CAEAC@CACAC@CAEAECEAAAA@ACA@AAC@@BA@@DCDAFADAB@DABA@C@ABADCHADBDBBBD@DAFABCHGHEJABCF@DE@@BBBBBDADBDDFBB@F@HBBHAFDD@BFFDB@DCFBDF@BBB@DBBDBDBDBF@H@D@D@@@FBDBBBD@FBFDBDDDBBD@B@D@BBBDBBADCD@HFFFFD@D@BADCDBDBBDBBBBB@D@F@FBD@FBD@BBD@BDBBF@D@B@BABAFAF@FAFBD@BBDDBDDBF@@@DABCFDHAJCNENCHAJAD@D@FBDBBDBDD@BBBFBHDBDDBDBF@B@B@D@BBBB@B@DBFH@DBF@BDBB@DBBAHBDBBFBBBBADAB@FABBDB@BADBB@BB@JDB@@CBA@@BABDABDBD@@BDB@BB@H@F@DFH@BD@B@D@D@HAFAB@B@B@BBBB@DBHBB@BDBFHBDBDBBBBDBHBHBDDB@CBA@ABAFAFBH@JBHBF@DBBDDBB@F@D@D@DBBD@D@FABDBD@FBF@HDD@BBDAF@DBFD@DDBDDBDBD@DBDAHBH@@B@B@@@D@@@D@B@B@B@B@B@@ADADABCDADCB@@AFBJAD@DA@@D@DAD@DAB@B@@@@@BBB@BB@BBDBBDBBBB@@@B@F@D@D@B@@@FDHB@@DB@@B@BAB@BABABA@ADABCBEBADADEBAFGHGDCBADCDCDC@@JIB@BADCDCDAB@FABA@@BADCBCBA@A@ABAD@DBB@B@@@@AB@BABCBADCB@B@BCB@BA@@BABABABC@ABCBABA@ABADAB@BABABCB@@A@AAACAACA@@A@@@A@AB@@@BAB@F@B@DAFAD@FADADABAB@FCFABCDAFCDCDC@@BAHEHEB@RBHBD@@BBBBLHHBBBDDDB@DBHB@BJBLDHDLBJDF@HAD@H@FBFDJHFBDAB@D@D@FAD@B@B@DBFBRNFD@BDJFPDFDBBBB@DDB@BBBD@DAF@B@@@B@BBDDFFNDJDFBHBDDDFDFDFBBBDBB@BABABADCFCDCJEFCFABADAFAB@B@B@BBDDHHFFDDDBBB@@B@DAB@DADAF@FABBVFHDD@BBBABABABCB@BA@@DBDDDDBBFJFDLFFBBBFNBFFLDDBDBBBBB@B@BAB@D@FAB@B@D@F@DBBB@@B@B@BAD@HCHE@@DAFABABCBA@ABC@IBADADAB@D@F@BBHFDBBBHDFBFADABADCDABAD@D@DBF@DBHBDDBDBBDBDDD@D@D@D@F@DBBCBABCBCDABABABADE@@DADADADAD@F@D@FBD@DAFABABCD@BBD@BBD@@@D@D@DABCBGBE@GDABCBAB@BAB@DEBCDCDCBCF@DADABCDCDADAHADAF@FADBF@FBD@FBB@DADADCBADADABADCDABAFABAF@BA@ABA@C@C@E@E@EACACCCGCECCAC@C@CC@CACACCACAAACAAA@CAG@@AAAAECC@C@C@G@CACABCF@F@FAD@DAB@D@BBFAD@BABAFCHEDEDEDEDG@ADCBC@ABA@CFAD@D@B@DABCBA@ADCBDDBDBDABAD@F@DAF@D@D@F@@@BADABC@E@K@ADCBC@C@@@AAEEACCECACBCBAJEDCDCBC@CCCA@EBCA@CAACCACAAAA@C@ABCDEBEDABABC@C@A@AAA@ACACCCAEAC@AAACCAA@AAE@ACAC@@C@CC@ECACACAAAAACCGAC@E@C@CACCE@C@CA@AAEACBEDC@AACBC@C@A@CECA@C@CAC@EBA@AEAG@CBECC@C@A@AAAA@ABCBGBABC@E@C@MEECA@ABC@A@C@CACA@@AA@A@CBC@CACAAA@CCAACCCACAGC@AAGAAACCCCA@AAECACCCAA@ACCCCACCAAACBC@@@ABC@CAC@C@CBA@CAAAAAAC@C@@@K@C@AAE@EAE@CC@AAAEAC@ECGAQ@A@K@A@ADADCBC@CAAAEAA@E@EBC@ECC@C@E@EBC@ABGBAAAEEIAAIAA@C@E@CAAA@A@EBA@@BEBCDEDEJADA@IB@@A@@@@@AB@@A@@@@@AAA@A@@@A@A@@@@@I@CBABA@@D@B@@BB@@A@@B@@@B@@@@A@@BA@@B@@A@@@A@@A@@A@A@@@@@C@C@E@CBA@E@A@ABED@@@D@@@@AB@@A@@@A@A@@@ABA@@@AB@@@B@@AB@@@@@@@@@@@@A@@@A@@@@BA@@@A@AB@@A@A@A@@@@A@@@A@@@@@A@A@@BA@@@@@@BA@@A@@@@AA@@@@@@AB@@@@A@@@A@@@@@@@@@BA@@BA@@@@BAB@@ABAB@@@@@B@@@BBB@@@@@B@@A@@@AB@B@B@@@B@@@BA@@B@@@@@B@@@B@@ABA@@@A@@@@@@@@@@@@@@@A@@@@@@@AB@@@A@@@@@@A@@@@@AB@@AB@@AB@@AB@@@@AB@@@@@BA@@@A@AB@@A@@@A@@BA@ABA@@@A@@@AB@@A@@@@@@A@@@A@@@@A@@@A@@@@@A@A@@@@@@@@@@@@A@@AA@A@@@A@@@AAA@@@A@@@A@@@A@A@@AA@@@@AA@@A@@@A@AA@ACCC@@A@@AA@@@@@@@@@@A@@@A@@@A@@AAB@@A@@@CAEA@@@ABE@A@@@AB@@ABA@EDEBC@CBCACBA@ABCBA@C@EAGAA@E@EBEBE@C@C@CBC@A@CAA@C@AACAAACCAA@AAC@A@A@ABA@@DCDADCDC@AB@@A@A@@AAAACAC@EAC@C@E@C@@@A@C@C@ABCBCBCB@BC@AACCA@AA@GEICCCACAA@@@AA@@@@AAACACAA@C@@@A@@AA@@AAIAC@CAC@EAA@A@A@E@CBEBC@ABABA@C@C@C@ADAFA@@B@@AB@@CD@BAB@@CB@BA@ABC@A@AAA@EEAA@C@@@AAACCACCC@AAA@A@E@CB@AC@C@C@AAA@AA@@ACAC@A@A@C@C@A@C@A@EAA@E@A@ABABAB@B@B@D@DAB@BA@C@C@A@CAAACCCACAA@AAC@C@AACAAACAAAACAAACAA@A@A@A@CB@BC@A@AC@A@AAA@E@GAEBE@A@EAC@AACCACCCACACAACCCAAACACAAAACAA@AAA@@AEAAAAAEAA@C@A@AACAA@AAA@AC@@AA@AAACACAAAC@C@C@AAAACAE@CACAA@C@AABG@CBCAA@@AAAAACAAACACCA@@@AAC@CAAAAACCCAAAC@CBE@CBCBCBCBE@EBEBE@EDCDEDCDCBA@AAA@AAAAAA@C@CA@A@A@@@C@CBCBA@AB@B@D@BABABABCBABABADABAD@BCBCBCBE@CBEDCBCDCBCBCBCDCDA@ABC@AB@@A@GAA@AA@A@ADE@A@EAAAEAEAE@AAAA@ABA@ABCBCDEBCBA@ABAB@@ABAFADADAF@B@B@@@BBDBB@B@B@BABABAD@BAD@D@B@DBBBB@B@D@BB@@BD@BBB@B@BB@BAD@B@D@D@D@D@B@DADAD@BADABEB@@CBA@C@CAEAA@A@EAC@C@C@EBE@C@CACAEACAC@C@CBC@A@@@AA@A@C@ABE@E@CCCAECAC@C@CAC@EACAE@E@A@A@GBE@E@A@CACAAAAC@C@CAC@AAACAAAC@@AE@CACAGCCAACA@ACACAE@C@A@C@ABC@C@@CAAACACAC@C@E@@@C@C@@@EBG@EBE@E@C@CGAAAACACA@AA@@@AC@A@CBA@C@A@EBE@CAE@@@CAA@CBC@CAAAA@ACAEACAA@EAA@@@@@A@AA"],encodeOffsets:[[116122,28522]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("郴州",{type:"FeatureCollection",features:[{type:"Feature",id:"431002",properties:{name:"北湖区",cp:[113.011035,25.784054],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@MAAFCDB@FH@R@FBJDN@B@BDH@HEDIFABFJBD@D@D@BCBCBAAA@IAKAG@A@]FWBQ@GAA@AB@DCLBLDH@@@B@@DN@JAHEFGF]FEBA@@@CHAFBDBFNVBB@@HH@BHFHH@BVRFF@@BDBBBBRB`F@@@@D@FCBCDE@C@@BA@QAMCG@@@AEE@C@@@A@IAEBC@ABCFCD@DBFDFDD@@@B@BC@G@ABADADBDDBHBBFBDAL@B@@A@CEEEG@ABAFAB@BBBDD@FBFB@B@DCJADABABCDEBE@E@C@@BABBBDBHBD@HLDH@JBBBBDABCDE@CCCAAAC@CDAB@F@B@DDDJ@HBLBDDH@BDBFDBB@FAFKLCB@@IF@@AD@@APEFMJGBABIAEAIAE@GBC@CEC@A@EBCBAB@@A@GD@BA@AB@F@BAP@@@D@H@PBHDHDFB@HFHFFDD@FABA@C@@@@BG@@@@DMPWXU@@B@DBFFDJ@FAJBH@DBD@@@BAD@B@BDBF@D@D@@A@A@@@A@@BAA@@AA@@C@A@@BA@@@AAC@@@@BA@@@A@@A@@A@@@@AAB@@A@AB@BABBBBBL@DBDDBB@DABAAEBCDABAD@B@HBFFDBJFFFBD@BBD@D@@@FAB@@@BA@ABEAC@AC@CACCAAAA@A@CB@@DDDB@B@DA@CBI@@BA@@BBDBBHFF@D@F@DBDBDFB@B@DCBAD@B@@B@BABABAB@@A@A@GFCDADGDMDEDCFCBEBEDEB@B@J@BCBC@A@AACAAAMICEC@CDCHELBHBJDJFFDBFDJLDDBDBD@F@@@@@@DH@FADCD@BB@B@H@B@HDDBF@L@F@DBFB@BDDBF@LABADABIFEDEH@@AD@BDFHDDFBF@B@H@HAB@HDFHJ@FJNDF@DHHFHV^DFBBZRDB@@HBBBHCNEF@B@@B@@@BCB@B@B@@BBHA@@B@@A@@@A@ADGBAJCB@@@@A@AAE@AAACEAC@A@AAAKC@@@A@@@@D@BC@@@AAAGKAC@ABA@CDCBC@C@ABABAB@@A@EACAI@A@A@GBAB@B@B@B@DBDJBBDB@ABADEFCD@FCH@B@BBB@DDDFFHBD@FAB@BFBHDRBBBD@DDBBAB@@@B@@@@BBD@@AB@BAB@DBHDFDBFBD@FABAD@BBJ@B@@EFA@@B@B@BBDB@BB@@BBA@@BA@A@@B@@BBB@BADAB@F@@A@@BC@A@@BABABADADAB@B@B@DAB@BB@BDFFDBBD@D@@B@B@B@FBDDB@@@D@@B@D@FAD@B@B@@BB@BB@B@BB@B@@@BEB@BCB@@AACAA@ABC@A@ACA@@@ABC@A@CAA@@A@A@@A@C@@@@DBBA@A@AC@@@A@@A@ABC@AAAAAGC@A@@A@AA@CACAA@A@AB@@@BABA@@@@@@@ABEBA@A@CBCBCBABABA@@B@BA@E@@BC@A@@@ABE@C@@B@BA@@@A@@@@@@BA@@@A@@B@@ABCBA@@@@EACBA@@A@@A@@A@@@A@AB@@AB@@@@@@B@@@AB@@@@@@A@@@@@@@A@@@@@A@@A@A@@@A@A@@AB@@@@@BA@@BA@@@@@ABA@A@C@@@A@A@EAG@A@@@@AADC@@F@D@BBBBHPDBFBBADA@A@@@A@AD@@@AMJDHCBAFAP@@AAAACAACAAC@ABCLWDED@B@BA@C@CAACACA@@A@C@CBABECE@GBCBCBEDEBE@A@C@CBCBCDC@AAACCAACGACAC@CAECA@A@A@A@AACCCACCAA@ECCMQAEAE@@ACAAEAACBABA@C@C@EBCDEFIFGDAFAFADEBABCDAB@D@@@@A@C@C@@@CBMAAAAA@A@A@@@A@EDCBC@G@IACAE@AAAAAA@ABG@C@ACAEAGCAAAC@A@AAACIC@CAAACCEECCE@ABC@GCEBA@@AEEAAA@@@A@KCE@EC@@@A@AAC@AAABE@@BKBA@ABAFA@AAECCGC@@A@@A@CBE@IBCDC@CACEC@A@CCOAC@EBI@AC@IAA@@ABCFIDADCB@@CAAAAKCAAEO@ADCFAFEBEBAB@RFCKAEBA@ABABC@C@AACC@GCCAAB@BAFADCBA@EECCC@C@A@A@EAKKA@OCIGECCICI@ICA]OCCCCEEMEKAO@MBCAA@ICKCICG@EAE@M@MB"],encodeOffsets:[[115501,26044]]}},{type:"Feature",id:"431003",properties:{name:"苏仙区",cp:[113.112105,25.797013],childNum:1},geometry:{type:"Polygon",coordinates:["@@@GEACAC@C@CACAEAC@CACAGCEAGAGAIAICAAQEA@ABAFEFEBCD@BFPBBLDBBBB@DA@CDCBEJAD@BB@JBD@@BAJ@FBDDP@D@BFDBD@DCDAD@JAF@D@BB@@@HDDDBF@BEBAB@BABAL@@AFBB@BBD@B@B@@FDF@LDB@@@B@BBFF@BB@FAHDD@BAF@DDFFDDBBDBD@DJBB@B@BBDBBHDFBDB@B@DAH@BBBBBBBF@DBJBH@D@DAFCB@@@B@B@B@BBBBAN@D@@@D@D@B@@C@A@CBADABCFEBEBCBEHEJCFAD@F@D@DABABBDFBBBBD@@BFBFNRDD@FBBDDDBDDBBB@B@B@B@FDDBD@DBHBBDDBBDBBD@DCDADAD@B@F@FAFCDADAHAF@FDBADAD@B@@@DBDBBB@D@DABA@C@CFKXAD@BBDDBBBBDBB@BO@EBABGDICBN@@C@@B@B@@@BCBABEACAGOAAAAC@E@@@CDBB@@@@@BBH@F@B@B@@@D@BAB@B@@@@AB@@AB@@@@A@@BB@B@@@B@B@@@@B@@@@@B@@@@@@@B@@@@A@@B@@@A@@@@A@@BA@@B@B@@@BB@@@@BB@DAFB@@@@ABAD@BA@@@@B@@AB@@@@@@@B@@ABA@@@@DAF@B@@@BAD@@@FABA@@@ABABABADAD@D@BABAF@B@@@@@@ABAB@@A@@B@BBBBD@DBBB@@@@BHDBBBB@BAD@B@BB@@@D@@B@BABCA@@@@@D@BB@B@@@BB@D@BAD@B@@DB@B@BAD@BBBBD@BA@ADA@AF@@A@A@@A@AAAA@@AA@A@C@EBC@A@@@@C@@CAAC@E@A@A@AC@C@AAECCE@AAAA@CBA@A@A@CBCBABABAB@@@BAD@@@BE@A@CBABA@AA@@@AB@B@@AB@AA@@AAA@AC@A@A@AB@FE@@@AAI@ABCBA@EACAEECGCCAA@ABA@@BC@AA@@@@@A@@BAAACCC@AAQAGCEA@ABA@EACEGCECCA@AAA@G@EDC@EDCFAB@BCAAACICAA@A@A@A@AB@H@B@BBJBD@F@BA@ABAB@B@DADCD@DAB@BBDHLBB@B@@ADC@@@@@@B@@LDBB@B@BBDDFBB@BBF@B@B@@A@IDABCH@B@B@@@BA@@@@BAB@BAD@BBBB@@B@BAB@@BBBB@B@@@B@B@B@BDB@@BD@BAB@@A@ABCBCB@BAB@BBB@DBBDBB@BAB@BB@B@B@@ABBD@B@@A@@B@B@@DD@@@@ABA@@@@BBD@BBB@@AB@BD@B@BBBD@@B@@@DB@@@B@@A@A@BB@B@@BD@@@@B@BA@@DHB@BBB@BABAB@B@B@B@@@@@@B@@C@CD@@@B@B@@@B@BA@A@@@A@AB@@AB@BB@BB@B@B@@FBBBFD@@@BCB@B@@DB@@@BABA@A@A@EA@@AB@BCB@BA@@@BB@BB@D@@@@@@A@@@@BA@@B@@BB@B@DD@@@BABA@@@@BBB@BA@@@A@@@@A@@A@@BAB@FA@@BEDABA@AAA@@@@@CAG@@JELAJBBFNBDDFHFFJ@BB@FDNFH@N@H@FBHBDBDDLHDDHBbMBBB@DFFHBB\\@BAHCFCDCFIBCD@H@BBFFFB@@HDB@H@FBBBFH@H@DADBJ@DDHHDNDB@L@LAJGDAB@@BB@B@@@@A@A@@AC@C@@B@B@@@@B@@@D@@@BB@DC@ABADCFGDE@@B@@@@B@B@BBBD@B@B@BA@C@@BA@@D@@@D@BA@@AA@@@A@@@@@CDADG@A@C@@AC@@@CDA@ABCAA@C@@HAD@FAL@CACA@CBCBA@CAAE@MDC@AAAABCDABA@C@@AAC@EBAAAAACAAA@@@@A@@@A@AB@BA@BB@B@@@B@B@BBB@@B@BB@B@BA@@AA@@BABBBA@@BAB@B@BB@@DB@@@B@B@@@@DA@@BBB@@@B@@@@ACA@@@AACAC@@@@D@B@B@@@@AAC@A@A@@@@B@FB@@B@@A@@BCB@@ADBD@@@@@BA@C@A@AB@B@BABBBB@BBBBBBBBABAB@B@B@@@B@B@B@B@B@AA@@C@@A@@BAB@@A@@CAA@A@CA@C@@DABBHBBBDABAB@B@DBBBJDF@DAH@HARBDABABA@EAC@AE@CAEECE@CAABC@@BA@@@@B@D@HFD@B@B@@@BADADETYDEV[NUFGB@@A@@@ADEFCDAB@BABAAAEC@A@AFCDCDCRg@CAE@CAC@A@@BCBCLGBADAAE@IAIBCBADCBC@AAEAE@CBCBEFEBCBGBCCCEIAC@EBG@EACKKAAOQ@@EECGAEBEDEDCFAFABA@A@A@CAC@EBI@A@ACGECG@E@ABABADABABCCAC@A@CDENCDAFC@AGYGCGAMCAACACEACGKEIGIKQEKAGAKCK@AGICGEKCKA@EOCOCM@IEWAAAAG@GBE@E@SLKFA@ABGDGABJFDF@AFEDGCIABHEFIAMD@@EECACCAA@ACAAACC@IACEEBEHEDCIAAACC@C@CCCG@IBEGAIAIAGCECACAIAG@M@EBGBKDMBG@GCKAGACCE@KAE@CDA@@@E@AAK@@AA@@C@ABCBAMKAAE@GAMA"],encodeOffsets:[[115700,26139]]}},{type:"Feature",id:"431021",properties:{name:"桂阳县",cp:[112.734173,25.754172],childNum:1},geometry:{type:"Polygon",coordinates:["@@F@FADCBABABCDI@C@AEAEAC@ACAAA@EBAB@BFHFF@D@BA@K@CBEAAAAGCCCACBAB@B@HADA@@@C@ECECCAC@EDAD@BADBF@J@B@@@DFF@B@@DHBN@RAB@@@DCFADEDC@@@@@_EQAAAAAAC@@EEUQ@AGGGE@AGG@@AAMUAEACBEDG@@B@FA^EHEFEBG@ICM@@@A@@CGAKDK@CBAB@HBR@XA[aECICCCAACA@@J@FA@@@A@AMGCCAC@GAACAE@CB@@E@ABAD@F@@EDIDCBI@CAAA@@AE@IAEAACAEBIDEBABAAAACAEAEBCLCL@B@DCJBHDF@@DFBF@F@BADCBMH@@A@@BA@@@A@AB@@A@AB@@A@ABA@AB@@ABA@A@@BA@@D@FFJBLFL@F@FAB@BC@CBIBCBYJGAGEDG@GCEEAEBEF@D@D@H@BADEJEFGFGHCH@FDBFB@FCFGHCLEH@@@@ABEFG@OGA@SKKNINCHAB@DAPAHADADCBCFCFEHEB@@EDADADEDABAFBDBB@BHDD@F@DADABAB@B@FDZJ@@DFHJFBDBF@BEBEFAHDBBFDFFDBHFBDB@DDBB@DDJDHAHI@CD@FIHKFA@A@AB@@C@CD@DKFEHE@EBGH@B@BDF@DABABMLE@EABI@EM[AAGCG@CDB@@BBB@B@FAFDDDDDDHDDB@B@BCB@@IBA@EDGDA@@HAB@@EFQ@IAGCCG@IACAA@AE@KJOH@JDNDD@@DBJFDDCHMBEBCD@NCFAFADADGAAEAAKECCAGAODQ@AA@GFADCFILEDGFEBA@G@ABGFIDOFABABCBKHGHCBIFGPCDCHDJBHAB@DC@OCGHMDCAA@ABA@@B@@@BHD@@@BABA@A@AA@@ABABEDAD@B@@BBBBDB@DB@AB@BABAB@B@BAB@@A@A@A@A@AB@@ABBD@@D@JCF@B@BBB@B@@@@@BA@@BB@@@BBBJ@@@B@@@D@DADB@@BBBDFHDD@B@DCD@BFJ@B@@@BABEBA@C@ECABAB@@@B@@@@EFEH@HCLADGJCHCJBP@B@FGFE@GCEG@CAGEEMQC@E@E@ABGDC@EBMBG@GAOAOCO@Q@GDCDAH@FFFHHHHRVB@NPHJHF@@LDH@J@DDBFAFCDEHAFBFAFABCBGAEAGN@HBFBFBHBHBF@DBJDN@D@@@@BLBDHHFFDHBBDFLRDHB@BDFRBH@FBJ@NDFBHAHKPIL@@EHGDEDABC@C@GCCECCECC@CBAB@@AFCJABEBG@A@AEACEEGGEAC@CBABEDA@GCMCABABEBKBCDGFCFEL@J@F@@BBBDB@@BFBDBFBDBDBBDBB@@B@@BB@BA@A@EB@B@J@BB@B@H@DB@B@D@F@FBFBDDDDFDDDFDFDB@B@B@F@BA@CAIBC@@B@BBBDBBB@@A@EDADAB@FBBBDDBFBHBFDFADCJB@@FDBHDH@HAF@BDDDFDFBD@F@FCFADAFCFAFBDDBFBD@HBF@DBDDDDDDDDDFDDD@D@FBD@DBDBDBBDDD@D@BADCBCBE@EBC@A@@@EBABABABAD@D@D@DAB@D@D@DDBBF@BABAF@FBFDDBB@D@@CBA@C@AD@DAF@DABADCBADC@@B@DDBBDBDBD@DADAD@@ADAD@F@DBF@D@D@DADABCDEB@BC@A@ECEAC@CBC@CBADCBAD@DBD@DBBD@BBBDBD@B@D@BBBB@@B@B@BABGBICI@ADGBEDCFBF@FBD@B@DDBD@D@FBDH@FAF@F@DBFBD@F@BCF@DFDDFD@BDBFFDBF@B@@@@CBCDCF@DBDD@FCDCFCH@D@F@D@DBF@DCDCF@D@DAF@DB@DHDHFL@H@HBDFBHBTAD@FEDG@IBABIDGJGFCH@B@DADCBC@EBGBC@CDEBA@EBCBE@CAEAEAE@E@CDCDC@@BEBCBCFABE@C@@@@BBB@BAB@D@B@@ABAB@BADBBAD@FAB@F@@@@BBBB@@@BCBAB@B@DB@CCESSGAIAG@CCAAAC@CB@HCPELDDBFLFBHABBDFBH@H@JBD@@B@DAB@B@@AB@BA@@BABA@@@C@C@@@A@A@CBAB@BADDFBF@DBJDHFDF@@BD@D@DBDDBD@BADA@EBCBCD@BCBCBE@CDCBCFKDI@AFOBGJSJODIBERY@ADCFIJINAHCDCBEDGJAHAFDB@DHFHLFNFJBNHJLHHD@DDBCFC@IDECOEIEIGGCQCKBGFCHAJBLAZAF@F@D@DA@MCE@C@CBEDCFAD@TALCHBFBfGH@DB@@@@B@BBB@BAFC@AB@@EBA@AB@@@@B@@B@@@B@@AAA@A@@B@BA@A@@CCA@A@@AA@@@AB@@@@@B@@@@C@A@@AAA@@B@@ADA@ABA@@FBB@B@B@BA@A@@CA@@@ADA@A@@ECAAEA@@@A@AAAA@@ABA@@BAB@@@B@B@@A@A@@@A@A@@DCD@@@@A@@@@A@A@A@A@ABABA@AAA@CG@@ABA@@@@@AC@@@AAAB@B@@@@A@@CA@@A@@@ACAAA@C@@ABA@@AA@AAC@A@@B@BA@@@@CC@@@A@AB@@@@AACBA@@@A@AAAA@ABA@CAAA@CAA@ABA@ADADABAB@@@BA@AAC@@CA@A@A@A@A@@@AAAAA@@BA@A@AA@AA@ABC@ABA@A@@@@GBAA@@@A@ADA@A@@@AA@E@MFGDAAGA@@CAYQAACEU]EGGG@CCEIM@EGICE@GBA@G@G@AAECEGCCE@ABC@@FGFCJEBABCBA@KAECC@AEACAE@K@E@CAGCA@G@A@A@@ADCBC@ECG@@@@@@@EACACCCIKECCAEECIAIAGFKDGDCD@DFNJBBDBBBB@D@DA@A@I@AFAFCFADADEFCNCHCBCDCHEB@B@@@BABABA@A@AA@C@ABCDA@A@CECACAE@C@E@GEAAAC@AB@@AJ@DAB@@C@ACACC@@DAB@B@BBDBBD@DBDD@FBBAB@@A@@BA@E@@@CAC@AACEEIECAEEGAA@C@ABCBADBFABCBA@CAAC@CAKAAAAABA@@B@BA@BB@@@@@BB@@@@B@@AB@@@@BD@B@@AB@@@B@DB@@BB@AB@@@B@@@B@BC@C@E@CA@A@ABC@A@@AC@CAGBI@ECIEECAA@@@WVOXCN@@@@AH@@@@@DABEBC@ECGEGEA@CECGAG@O@G@C@@BO@A@EBAB@@AHCB@@@BADAFAB@D@DFD@HAF@JBFBJBBAHANIFEBO@@BC@@JE@@DALKBE@EAAECCA@ACGACAK@GCICCA@E@A@CB@DBDBBDD@DCFADCBAAAA@ICGGKC@GACAAABA@AD@F@"],encodeOffsets:[[115504,26168]]}},{type:"Feature",id:"431022",properties:{name:"宜章县",cp:[112.948712,25.399938],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABABG@E@EBCACGAEFI@C@C@AAA@AAAA@A@C@@A@AA@A@A@A@ABABABA@@@@@@BBBBB@@AB@@A@A@CBCBGDC@AAAA@CCCAAABCBEDCFABADELA@EDA@A@EAECGAIAECCAECKICEECAG@A@EEGKKUEMGEAE@EDCFCRAFEFGBOBE@@AA@AC@CDKAEAEEBABC@CCAAAC@ABAD@D@HDD@F@@A@CAAEAE@C@C@EAE@E@A@EAEECECCAEACBA@ADABBBB@D@BB@BBBA@AAC@C@AEAAAEBCAA@@A@@@ABCBADCDADCBCFM@C@A@EBI@G@AA@C@C@CDEBCDA@A@CDA@C@CAA@CBCBE@CAQE@@AA@A@ABAFAB@BA@ACACAECAEBEAA@CAAEGEACAACBE@A@CEAC@E@I@EAEAEAAAACACCAE@CEIEAACAEDE@AA@A@EAACAC@AC@C@A@@@A@@A@CAAC@G@AAAC@C@A@A@CBCHABEBA@ECCAA@A@ABC@@@@@CACAC@EBADCDA@C@GCCACAEACBE@A@ACEKACEAGAA@E@CAAEAEAEECEAGBCAA@CAAC@CBGCEEG@C@OCECAEBC@KFIHEDEFCDCPAPCNAD@DADEBAAAAGKA@CBE@C@@AEGCAC@@@C@GH@@CBEDEDCBA@@@CA@A@C@@@CBCBA@CBAB@@ADCBE@CACCCAA@A@CBCDE@G@GAE@CAABABABADAB@DCDMBCDA@AB@@@@BB@D@@@BA@A@AA@AC@AAA@C@ACACIA@BAB@@AACAEBCBCBAD@DA@@HBBBH@B@BABAFAD@F@BCBCB@BABCBADAD@B@BB@@@@B@@@@FHDDD@@B@JB@@JBDBB@@@BCBC@ADAB@BADBBB@@AH@DB@BBB@F@BAB@H@FCFCD@BDBHBBBDF@B@DCDCFAD@BBDBB@B@@@@ABABCDCFATDFBJCBCBAAA@E@CBADADABADABEBCBCDCDIBCBEFCFCF@FBD@B@DIFG@C@CGEECCAIAGAI@G@A@AACCACIGAAKEOGGCKEEI@EACBACMAG@@@A@G@EDEFGDCHEDABAFGDCBC@CFE@@@EDCFA@@DCDC@@FMDMDCDAB@HAHABAAA@AA@@AA@@@A@AA@@@@A@@A@@A@ACEC@AC@AA@AA@A@@AA@@AAA@@@AAA@A@A@@@A@@@A@A@@B@@ABAB@@A@@@A@@A@@A@@AA@AA@@AA@@@@A@@@A@@@AA@@A@@@A@@AA@@A@A@@@AAA@A@@@@@AA@@@@@A@@@@A@@@@@@@@A@@@@@A@@@@C@@@@@AA@@@A@@A@@AA@@A@@@A@@@A@@A@@A@@A@@@A@@@@A@@@AA@@@A@@AA@@@@@@A@A@@@A@A@A@@@A@AA@@AB@@@@@B@@@B@@@BAB@BA@@@A@@FEJCBG@EEIACBGB@B@@A@@AA@AA@@A@@@@@AB@@@D@B@@@@@BB@@@A@@BA@@@A@A@@@A@@B@@@BA@@B@BB@@@AB@@@B@@@B@@@@@@A@@@AA@@A@@@A@@B@@@B@@@B@@A@@@@@A@@@AAA@@@@@@BAB@D@DCBEAGAA@E@E@C@CACCA@@CA@AA@@@BC@A@@B@DEB@BADABABA@A@ACACA@@GCQAEAAC@@BCBA@ABCAE@@@ABA@@A@@@AA@@AB@@A@@AA@@A@AAAA@CA@AA@@@A@@@EA@BA@@@A@ECCAA@ABC@AAABA@@@A@CBABABCB@@A@@@A@A@AAC@AAAAA@A@AAA@A@AA@@AAA@AAA@AAA@ABA@@@AB@@@B@B@@@@AB@@A@@BA@A@A@A@@@CAA@CAA@CAA@CBC@E@EBEBE@A@ABAA@@A@AA@@AA@@AA@AA@A@AA@@A@CAC@AACACACACACAAAA@AAAAAA@CAA@AAA@AAA@@@AA@@A@@AA@@@@A@A@A@A@A@A@C@A@CBC@A@@BA@A@E@C@E@C@CAA@A@A@A@A@AAA@@AA@@@AA@@A@@@AA@@A@@@A@A@A@A@@@CBA@@@ABA@A@AAA@A@AACAA@A@A@A@A@A@A@ABA@A@AA@@A@@AA@A@@AA@A@ABA@A@A@A@A@C@A@A@ABC@A@CBA@ABABA@ABAB@@@@@B@BBB@BBBBDBBBDBD@B@@@B@B@@@BAB@BAB@BAB@BAB@D@@ABBD@B@@BB@BBB@B@B@@@B@D@D@D@DAB@DAB@BABABA@ABABABA@ABA@C@ABA@A@A@A@@@A@A@@@ABA@ABA@ABABA@C@ABA@C@A@A@A@A@ABA@ABA@@@@@@BA@@BAB@B@@@B@B@B@@@B@@@B@B@B@B@BAB@DBB@B@B@B@@@B@BBB@@BB@@B@@B@@@B@@AB@B@@@BAB@B@B@BAFAD@D@DC@C@CBAB@D@FADC@ABEBCBABILIRHn@H@BATE`@BCFABC@MFIDADADAFA@CFOLABGJEDEB@@C@E@AB@D@D@BAHCFCF@BCDCBCB@FBDB@BBB@DHLFPBJ@FBD@HBFDHTA@CDKD@F@@ADADCFABCJILMF@BSDOD@BOHA@KJCFCNAF@@@@AHBDBPDLBHDFFDF@HAFCH@J@HBNFHBTHPBF@N@HDDFBB@B@FAFEFMDK@GDBFFJFFNLBDFDHBFCFCD@JAJDDDJF@@HLJJHJBBDH@LCDEDGDCBGN@JFRDFBHBD@@FJHTDPFPBFJVDDD@\\OHBDBBFBF@FCb@JBJNBD@NAN@F@FBH@JDLDJDB@DBNAP@LBNFFFDDDD^PDB@JDJDJFDJHPDB@LLFBB@B@D@D@DDFFB@DABCBE@ABADBHDD@BD@B@DADAB@BABBFDLBBJDJBHBHBFBHDDBDBD@FBDBDBD@D@DBFB@HNBHBF@BBNLABAD@B@DB@@BL@BBF@@@B@DCF@LBF@DDHBLBHDH@NALCHAFAN@H@JBDBDBDFBHBJBJFHJAH@DD@D@DDDBBJBCDGFAFFFBD@JDDBBDB@BBBDDDBFF@@NCJBFEAGJBHDFCBEE@ECAIHBHCBAB@LETKF@F@HAH@BBBBFX@JDNDPFPB@DLFLDHHJ@BDLBLBHFLLRHJFJHLBDDFDBBBNDHBHDLEFGLEBA@CAA@CFAB@D@BBBFDBDD@D@D@BBDBBDAF@DA@A@@CEACB@DABABA@@CG@CFGDEDABAJAD@FAVCLABALAPEJAD@FABC@EACOOCC@@GKCC@A@@IIGKEEEEECGE@@ECCACAECGGEE@AA@GGCE@@CE@CAA@@ACGYCEDI@A@AFEHCDCBIBI@I@EBEFEFCBCFAB@FABABC@A@@BA@CAAAA"],encodeOffsets:[[116025,26127]]}},{type:"Feature",id:"431023",properties:{name:"永兴县",cp:[113.116527,26.12715],childNum:1},geometry:{type:"Polygon",coordinates:["@@AABIFK@IeHEAGAKDSBC@EBCDAF@D@DDF@NCBC@E@E@YBKBIAGBEDAHDLDRHHFJFJDPCF@JEDADCCC@GGIKMGIAMEKEEGCGA@ECGBIBCHAFCDGDMBIJEJCD@BQZAFCJIPITAHEP@BCJELADCD@DAFADADDBFHDDFBFBFJBFBDDDFADABG@O@@BCD@JAFDDD@BBBAB@BAHCH@FBDHDB@D@BC@EDAJAF@H@B@@@BB@J@FBBAF@DBFBB@BB@B@FBFB@DA@@BB@DDEDCDM@ABGBCFBHHJBBDJDBBBF@D@BG@@@CAC@EAEBCAAHADBDBFHDJHDF@B@B@HCHIBKBE@GAM@@@CACBCFAFBFBFADCDCF@FB@@B@HDFCFAFAFADBD@HBH@FADA@EBAFBHFFBFDBBFABADCBAD@DCHC@@FAHFTNBJ@X@@BFF@F@D@JCBABAFAFCHGBGBCB@FAF@B@D@NGFCF@H@HBLDBBHDDBBDD@F@HADADEB@@@BABAD@D@DBBFBBFDHB@@FDDHBFBBBBBABABABBB@BF@DBDAH@B@HAFBDBD@@@B@HAFADADBHDBDDAFCDCFAFAD@FAD@DCDCBCFAHBFBBFFDFFBFBDBF@FADCDC@@FCDBFBDBFBH@DBDCDCDAD@FBF@F@DAD@D@BB@B@DADAFAD@D@FCD@F@DBFBBDDDDDFBDBDDFDBHBFAD@BAHAF@BDBFBBDAFAF@BDABAF@FBDDFBB@FCDAFBDJHDDDDBBHBDADAHAD@FBDBDDDADABBDBB@D@F@B@@BBD@BA@CFEFADAFAFBDFB@DCFCBCBEFCDAH@HBDDDB@BBDBHD@D@DCFCFCDADADBFBF@F@HBDBB@B@FFFD@FAPMLALDLJDDPPLJBJCFGFCBEBEDCDEDED@BFJLLHJFDFB@@JCDAFBDDF@DDBD@FBD@HBHCJHCD@D@B@B@HAB@D@FBBAHELMBEAIMQ@AAGDKJEFCZ@PAF@D@H@NCPKJMLONCVAJ@HBNBHBH@B@DAD@NAFA@ABC@GDABAFBLDHBDDBF@HDDD@D@D@BABK@CBABC@CAE@CDAFBDCDARAFADA@CCEEIEGC@AACEAEBEBGFILEHAHCFEFGDIHAF@D@LDJFDBDDHBFCFCHCFCD@BBBBJFDBH@H@HA^CFADEDAJAFCFGLM@@L@VCHA@CCGGIEGCGBGBADGFCFAF@FBHBNBJ@FAHAHCBEHGHEHCFECECCEGCGCACBABCDABABC@AACECKCCCCCCCCAGEO@A@@CI@E@GBEBE@CACAAACAEGKCKAIAAEOIMIGCA@@AAECECMKKEMGEAOCAFAAGDECCCAAGDABACDACEAAA@G@IFEFKFMBSAYEQEGACCEGBA@GAE@C@MDMBG@CBEBC@A@@CEACAEA@A@C@CFEDCBC@E@GBCDCBE@E@E@@BC@E@EDGFEFCDA@EBI@GAA@GAKBIBGBEBIA@@A@ECEGAACECEEEC@A@E@IDCF@HAB@DADEHAHAJGLIFEFABGD@@G@G@EBCFCN@JGJEJK@MGKGJABCB@@AECCBGBABA@A@@A@C@A@@@G@CACCCECE@CA@@AC@EACA@GDO@@AAA@A@C@A@@@@AIACIIC@CBCBA@AABAAGB@JGB@F@NBBA@C@C@KEO@CGOGGGKKGEGCCIKAAOOMMEKAACG@A@A@CCCACCCACACACCIAC@@ACAAEAGBA@AACAA@AC@A@AAEAACAK@EBC@GB@@@DBBAD@BCB@D@@BD@@@D@BCHCB@D@@@@@B@@BB@@ABC@@@C@@@AB@@@DABA@A@C@AA@A@A@A@@A@@@CFEHCDAB@BCDA@@A@@@C@@@A@@A@A@@@@DBD@@@B@B@@A@A@@AA@CBIHKBK@A@MCGCCG@CAIBC@C@GEGAAEAG@A@GC@@EAEEAAG@C@ADEJCDEDGDAB[@AAEGCEA@AAaNGACCKGCCCAGAEAG@M@G@MEECA@@AEIGECEACEM"],encodeOffsets:[[115624,26617]]}},{type:"Feature",id:"431024",properties:{name:"嘉禾县",cp:[112.36902,25.587519],childNum:1},geometry:{type:"Polygon",coordinates:["@@@MDIHGHGBEAGBKQLABCFCDEFADCJAB@B@D@B@DAJ@HAJ@@@BCFABADCL@BILCDKLEHABEFEJCFHJBJ@D@BA@CNKDA@I@GAIACAGAICECAAKCE@GBCBA@KDGFEDABIHEJEDIFGDE@M@IAA@K@GBQFCDEH@FFDPFDDDDALA@SDEDADA@AB@BEFCN@HNV@@XhBH@BBHAH@HKRCHIR@@AH@H@@AD@DABJBD@DB@@@B@@A@@B@@B@@@@@@BA@E@@B@BA@ABAAA@@@@D@DAD@@DHDDB@HBdJRDBDBDBBB@BDDBBBBDD@BB@D@BB@DDDBFBR@JAFEFEBG@A@G@G@A@EBEBEDCHCJGLCD@DBFDDJDLHNBBFFNJDBHBPJPHFFJHBF@DCBOHIF@B@DAJBDDPJZBFDLDCHOJEDAHGLGDABABAPEJCHEBAH@B@FAHEFCJKDEBCHEB@@BCRBPBHDDLFBBBFHBBCBCBEDE@MDCFANADGCCIECA@@CCCM@IPGLIF@@BBBBD@JDHHDJBR@FE@@BA@GB@HCFCB@JA@@DA@A@ACAGCCCCCCCBE@E@AAA@AA@DCH@HDBBN\\@FAJFBF@NKBABA@CCE@A@AHGFAF@FGLE@CDCD@@@BAB@B@LEJG@EDCJ@BGCGCI@CAACCA@ACGECAEEECAAGCEBAFAFE@CAEAGICE@@YIECA@A@ABCBCBE@C@GC@AAAACBEBAFCBCBCFC@@FAFGDEDEDABCBCBGBO@CBADGJMLM@G@KDK@GAE@CEEMAK@IB@@@@A@CDEFEJEFCBGBG@_@C@EAKGKKGM@K@CFIBG@CACAEEAEBCAAA@CBEDCAIGAIBG@KAIBC@@@E@GDCBCH@B@FABCDG@ICCEAACG@EFK@AJOHEBGACCAICK@KAC@CCEK@K"],encodeOffsets:[[115108,26079]]}},{type:"Feature",id:"431025",properties:{name:"临武县",cp:[112.563456,25.27556],childNum:1},geometry:{type:"Polygon",coordinates:["@@CKAOACBG@@@@BEDMDELIB@PG@APCTC@ANEJKDIBADEBCBC@@@ELCDCB@GSECGAC@EAI@OAKECGA@AAA@AC@EA@EBEAE@ABA@ABCBADCBA@GBEBC@CACECEE@I@CAC@KEE@ECAAGAC@A@CBC@C@CAAACAE@E@EBCA@@I@@@G@MDEBIFIDMDG@M@ABEDE@C@IAIB@@A@AB@FAD@@ABABA@ABC@A@A@A@@@A@AAA@@@A@@@AA@BA@ABABABABEBABE@CBCAA@C@C@C@E@C@EB@@C@@@ABAD@DCBABAB@B@DBD@DBB@D@B@D@BADADADADAB@BCB@BA@A@ABA@@@A@IGIICA@AAA@AAC@CBC@CACAEACACCCACCCACAAAA@AA@ACCAACCA@@C@C@C@A@C@C@ABC@CDCBCBAB@BABABAB@B@BA@@@@BBB@BBBBBBBBB@BB@@B@B@BA@AB@@C@@BA@ABA@@BABAB@BABABC@ABABABA@AB@@ABAB@B@DADAF@BABAB@BABCBA@ABA@BP@BADAB@DAB@B@B@D@B@BDDFHBJBH@FAFCFCDCFEDADALBDDHBDCFGF@HBD@@@B@@@BBBBD@DCDEDIFEB@@ADBD@BHBHDLHFDF@HBJBBBLJLNBBB@LBB@BBB@BBFDDDNJV\\@BFLFPFH@BDFHBFAD@JAHBHHHJDJ@FCDABIAM@CBAAA@C@A@A@C@CDAB@@EFMN@B@BBBBBBBDBF@B@B@H@J@B@BB@@@@@B@B@B@DCHCDMPGJABEJABCJALBHAFGHGHCJ@N@LFLDDD@LBL@JDDBBDAHGFIP@BEL@FDHBBDFJDH@DCBA@E@ADGDAHCF@@@D@JALBH@JAHBBJCDAF@DBBDBFAFBBFBD@DAHEJ@D@LHNLLLHFBD@`@H@HADAFEFIFEDCB@@@@@JAL@NBFF@DBF@HCL@L@HTLB@PHH@FEBA@@@@FGDKHGDE@EEACA@EDGHGHEFEFIBC@A@G@C@CFEFAFBDF@HCHHFHBZIDAJADAD@@ABA@E@EEKAKEI@E@CB@@AB@B@BA@@BAB@BAB@@@BAB@@@BAB@@@B@@AB@@@NGDABC@A@EAECE@@CEAGDI@C@ADKDKFAFBDBBBBBBAFAJCFADBBBBF@JBF@@BBDBJ@DAJCFC@@@EBCBAF@@@DAF@DBBB@HBDDDNH@B@B@@EBI@@@DBBBDDJDFD\\b^EB@H@LBJBB@BBDADA@A@C@CACEIBAJEFC@GCG@A@ACMAI@E@QEGA@DCBEAI@IDa@EAEAECAGA[PC@CCIUAEEOCOGSEI@@ACAGCEEQ@IHMDAHCFCDC@KCGAAGIIIGK@@IECCICIBC@EDEDGAECACMKEEEIAEHCL@NCFEBE@E@AAACEGCM@E@OASGGAMEGAI@G@EDGBE@ECCEAG"],encodeOffsets:[[115340,25842]]}},{type:"Feature",id:"431026",properties:{name:"汝城县",cp:[113.684727,25.532816],childNum:1},geometry:{type:"Polygon",coordinates:["@@FEHCHAHAFAD@BAF@FCDC@E@CCE@E@@@AAEAS@C@CDC@AFAHC@@DADA@@BABCAA@CGE@KIYDIBCBAAAAAC@I@I@C@A@CBCBCAECCGAI@CACCECGAEGEGEAC@@FADDHDFAAEACACAAAA@E@AD@D@DADAAA@CACGKAAACGGICCCAC@CBEBC@EAC@CDCBCBCAECAGAEBEBCB@@A@ADC@C@E@KBA@A@@A@ABAAAA@@@GBIBCAC@CCE@GAG@CCAC@CBCBC@A@@CCA@@A@ABCHCDA@@DE@C@ACAC@C@EAAAACBEDG@CAEEGEE@EBC@CCAGCICEGACA@CAMAMAGCGCAEACCCCE@IAICEEECGEECAEAA@CAE@EBC@E@C@C@CBCBC@C@E@ABGBIBQGOAC@E@ABAB@BDBD@D@D@@B@D@DCBCFCD@H@DCJCLADABAB@@A@ABC@GCCCACCGAA@CBC@CCAABA@CBA@ADABA@AB@@ADABADA@@@ABE@A@@CCAA@CFC@CBC@C@@A@ABADAB@BA@A@ACAC@A@CBA@A@CCKBE@E@CACEAE@@@ABC@C@AAAACAACCA@@@A@@B@@A@@@@@@@A@@@@@@B@@@@@@@@A@@@A@@@A@@@@@AB@@@@@@A@@@@@A@@@@@@AA@@@@@@@A@@@@B@@A@@@@A@@AA@B@@A@@@@@A@@@@B@@@@@@A@@@@@@@A@@@@@@@A@@A@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@BAAA@@@A@@@@@@A@@A@@@@@B@@A@@@@@@@@@@A@@@@@@@@A@@A@@@@@@@@@@A@@@@@@@AA@@@AA@@@@@A@A@@@@@@A@@A@A@A@@@A@@@@@@@@@A@@@A@A@@@@@@@@@@B@@@@A@@@@B@@@@@@@@@@@B@@@@@@@@A@@@A@@@@@@A@A@@@@@@@BA@@@AA@@@B@@A@@@@@@B@@@B@@@@@@@@@@@@@@A@@@@@@@A@@AA@@@@@@@@A@@@@@@@@A@@@@AAB@@@@@@@@@@@@@@A@@@@@A@@@@A@@AB@@@@@@@@@@@B@@A@@@@B@@@@@@@@@@A@@@@@@@@ACB@C@@A@A@@@A@@@AA@@@@@@BAA@@@@A@@@@A@@@@@@@@@@@@A@B@@AB@@@@@@@@@@AA@@@@A@@@@@A@@@@@@B@@A@@@@@@@@@A@@@@@@@@@AB@@@@A@@@@@@@@@AA@@@@@@A@@@AA@@@@@@@BA@@@@@@@@@@@@@@@@B@@A@@A@@@@@A@@@A@@@@@@@@AAA@@A@A@@AAE@G@KGGECCCEACCAA@A@ABADBJ@D@@@@A@A@@BBJDJBJ@FAF@FADCDEBGDGBKBKBEDADCH@@@@@BC@C@ABCH@FABA@ABE@A@C@AECEECCECCCCCAABEFCDAD@DBBDDBF@B@@@B@@A@A@A@C@GBCBADC@A@EAEAG@GBCBCBEBCAC@EGCGCCE@A@ABADCBABCAECCCGGGCG@GBCDAFCDABCBEBEFADCH@J@JAHCDABEFEBGBCBE@GCICCACBCB@DABCDA@A@EAG@CCC@C@ADCHALAHANAJAF@BCDEFCBC@CAC@A@ABGHIJCDABG@GAABCBGAEAACACECE@A@@B@@@B@D@D@D@D@JBJ@DBFAD@BEFAHCH@JBFBD@@A@ABA@@DAD@FA@@BABEBCB@BADA@CFBBDBFBF@BB@@@DA@BBBB@DAB@@@BADABEBA@EBADEDEFAF@F@JAJAJCDGDEF@B@BCJDFHZBD@@BB@DDF@@DFHHB@@BFFHHFDDBDBFD@@HFFDDCFCD@D@FBDFFLBFDBDBD@BCBCAGBCBCHCB@@@F@LDJ@H@HAHADCPEFCD@DBFBFDBDBDBJBH@@DNDHDHJFJDJBBAB@FCDEBCDGDCDABA@@BACACA@AAABA@@BADC@CBA@C@ABA@E@C@ABA@C@CECAA@AAC@A@@CCA@@A@@@AB@FEBA@@BA@A@CBC@@BADA@@@A@AEK@C@ABA@CB@@@BABBDBDDDBFBDBDBBBFJDBD@F@BA@AAAGI@A@A@ABADAFADCD@F@J@DBB@DAD@BAB@BBFFBBFBD@D@HCB@JB@BDFFBDDBFBJBFBDHHHHDDDBDBF@F@DBDDDDBDDF@D@B@BAFEBGDCD@J@BBDFHFDJJB@HBDDBLCHAFDD@JBFDBVHF@FADCBCDAFAB@DB@D@HAJDHFDJBB@PLJBNABBHDHDDBDFDBDD@H@B@BCFKLCD@HCNBBFBFBHBTJDLBDDHFLAB@BKNKLAHBBBDHBB@BCDAFDB@B@B@B@DFLFDDBBFF@BCHEHEBE@CBABAB@NAFAF@F@DCDCFABABE@CBEBCD@BA@CAKME@CDANCFEDC@OEC@EBEDKDCD@BHHHDNDD@FDJNF@JBF@BBBD@H@H@DFFPCBAFAF@FBLFPTHFFDD@FADAB@FCDEBEBCBGBGBGFGFEJEHALAHIHKHCJEHCHBNDHDLDHBRCDCBC@CGGMGMGEIBIFILIHKJIJIJEHCJCLEJEHG@GBIAGEECG@GFCD@D@LBLDNDDDHFJJHLFFFHD@D@JATGNAHAD@D@HBFBFBPDDAFAJM@EBINQDA@A@CBEBABCFAFAB@D@AC@GDG@ABABCAGAGAAACAE@C@EBABCB@D@DAFIFIDGBEBIBG@GBABADAF@BABC@EBCBCBE@G@EDCDAFADC@C@CACAC@GBGFEBE@A"],encodeOffsets:[[116690,26230]]}},{type:"Feature",id:"431027",properties:{name:"桂东县",cp:[113.944614,26.077616],childNum:1},geometry:{type:"Polygon",coordinates:["@@EGEEGKIIGECCMCKCKAC@C@ED@HDHFFBHAJ@HGHIFKFIDGDIFIJIJGLKJEJAJFJNHNHHH@DADCDQDGAKCGCMCGAGDIFGDGLGJKBGBIFEFEHAHAHAHADAFCFEDA@CBEBC@ECGEOSKEEAE@EBABOD@B@HEN@RE`@FBDFLNHHDJBPBJDH@HAJEF@D@FFFFBF@FAPBPJ`DJDJ@TAJBJ@FDhHRPLHFDDFLHJFFFFFDFIFGHEHCFAHANDVNNLHHHFFFDDBFBD@FANCNBLJLJHBBHBHAHAFEDKDIBEFEH@H@FBLFJFTLDDBHDLDDHHFJBBTFNFNFJDJFFDFH@@DLDFLLFFFDDN@LDBL@L@JHB@@@@BHBFBDDBFHEDE@G@A@AAEAACAEA@EFCFCDAFE@CDCFAB@B@DDDBFBD@B@DBJHHJDBBBLBDB@@DDFFFBBBBBBB@DBDDABAHDBFD@DCHAHAF@DDDDBDDDDBDCDEDAACAGF@NFJBBABEDI@G@CBAFDLFDBH@FCFAHAHBBDDFFFDBHB@D@D@FBBDDDBHBHBHBHDDBBBJBJBFBDDDDDBF@H@HBHDB@HDFBB@JBHDHDF@@@BC@EDE@E@EBCDCDBFAJDJBFBHBFBB@DBD@FEBE@C@GBCBADAHCFABE@A@@@ACEECGEECCC@CBEDADCDAJBF@FA@ABCBEAC@@A@GAECGAEECCEECEGAEAGCGEAACAECAAAACCA@AAAAEBE@C@E@CBA@EBOEA@AAECAAAAE@CAEAGC@@ICGCE@GCIAGAIAECGACCAADCDA@@FAFCFADC@A@C@@@AGECIAACACAACCCA@A@EBCBEACAEACCCAE@CBCBABA@CDCFCBADA@CDE@C@GCEACBCDC@C@G@ECBCBGB@A@ACCCCCEAACAE@CBC@A@AAA@A@A@ABA@CACAAACCAACAC@CCGEACCEAACCACEECCCCA@CACBC@C@ABEDED@DGB@BA@@@AAA@@BABAB@D@F@DAB@@A@AA@@ABABA@C@C@C@@A@ACAACAAAAA@C@A@@CIBCDEBA@C@ACE@A@ABCBCB@B@F@BA@AAAAA@A@AB@D@BABA@ECEAECEAECCCC@A@EBGBABC@@@A@CAAACAA@ADC@@@EBCDCBC@AAA@@MEKGA@@EAEAE@EAEC@E@CACCCCACACEAE@CCAECCACCCCACECC@EACECEAAEACBEBC@CBAACACBE@C@@AEACACCCAAAA@A@CAAAACE@C@EAC@CAC@C@CBCBABCGGEEEEIKCACCEAEAGGEGGEGECCAAAEACCE@AC@A@EBEBADABAF@D@BCBMRAJ@FINEBCBOCEAEAGAC@C@GBMBSHIBC@C@"],encodeOffsets:[[116566,26389]]}},{type:"Feature",id:"431028",properties:{name:"安仁县",cp:[113.26932,26.709061],childNum:1},geometry:{type:"Polygon",coordinates:["@@BGBEDCJMNEFGBEAG@E@EBA@AAIAEAAICC@G@EFGDGFGHAFGDGBEBI@MAGAEAE@EBEDCHABAHDHFHHJDH@DGBUDK@@@KNEHEDIBCBCFEB]DGBG@G@CAIEAAAAC@EDGDEDEDGACCCAIEKCC@E@GBCJEHEFGDGBKFEJAHAFBFDFBBD@FHFJDF@DCBEBQBCBCDEACB@DBF@DADAB@DALABC@C@C@CC@GAECCGAKCEAABCB@HAD@BEBMBC@CBA@G@GAMAGAI@UBMDKPINOLMDG@C@E@OBY@EDIFCLBH@BNRBJAFKNGFABEAC@A@GBA@A@C@C@GDCBCHCDCDCFCBCFCBEDEAEAE@E@E@CBC@AD@FADCBEBCDAFCBCBEBE@ADAFADCBCDCBABCD@D@F@DBFDDDFFDFDDDBBBB@@DDDFBD@H@F@F@B@HB@DLBF@FBD@B@H@H@DFB@HCDCDAFBDBFADAFAFCF@F@F@DBBDHBDBFBDBDBD@HBF@DAD@@AHAD@F@HCDAD@FBHCBEBG@CAC@EACAE@CBAFCBCFCD@BA@AFCBCDEBIDCDCFABAFF@DFADIDG@GBE@EDBFBFBDDDJDDBBD@FABC@I@IAC@CBCH@FAFABEBGAEAGBADCHBF@FBB@D@D@DAB@D@BBDABBDH@DDFBF@D@F@D@FBHBHDFAH@DBHDD@JBFBDD@@@FADEBCDAF@@BD@D@DCB@B@H@J@H@BBH@HBB@BB@DBF@D@HABCBEBEBABAH@D@NAF@DAJBHBFDH@J@F@@@FDDAAA@AAA@AB@DAD@B@DAHCHAF@BC@CAABCBALCDCBEFADB@DFBDBFBDBF@DBHFBD@FBDBDAFFAB@HAF@NBD@BA@@ACIGAC@IBCBCFEBA@@JIBA@G@KEGGCAE@ELMJALCD@@@F@HDFHFPDL@BHPLNLH@BLDL@DAFABANERCHCRCXADBPNNKHCHKBEFKBCFEHEBAHCFCAKCKCGGS@@AEAEGQGMAEEGAGCCCGMEQCEEAC@EDGHMJOBADCBEHEAIDE@ANEHIFEBADIBM@E@A@ACCAAAECE@K@A@AAEECEAIAAACG@@BGHGHAHEHC@@DC@A@@HOBCFOBEDGBAB@HANBF@FBFBF@FBH@FADAHANDFDBBNJFDFJB@HRJHRHNDPBJ@@@DAB@TC@@FANEHEBALSBC@AAICGCKBOFKBC@@BC@CFIDGBIBCDEFAH@FDJB@@H@@C@AEG@A@CB@FB@@F@D@DCJEIaACCGAIDIGECACC@C@EHIDCFGHGBABCFMFQ@IAIACKMMMACCAECGGCCAEBGBC@ECK@E@CBCDCHE@AFIBE@GBGFE@CBM@M"],encodeOffsets:[[116325,26963]]}},{type:"Feature",id:"431081",properties:{name:"资兴市",cp:[113.236146,25.976243],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAEEAAA@ABC@CBA@CAI@E@C@CDEBCBAB@B@B@BHJBB@BABE@C@CAEIAACACAEACACCCAAAAB@@A@@DAB@B@DFL@B@B@@CBAB@@AD@D@BAB@@ABEFA@@B@@@BB@DD@@@BBD@BBBFD@D@DAB@B@D@FAB@B@DAB@DCDAB@@ABBB@BDBDBAB@@ABCBCDCHADCFEDA@ABIAICIECGCGCM@@AGAIACACECEACAC@EDOFCDGBGBG@I@KCE@@@A@GDADADBHADADC@CACAAEEKCEEAC@C@EDCDFFFFHLJJ@@@BDDHL@@DDPPBD@FADEBC@IBOFKBABKBUDEBC@IBABCBCFEH@DDH@@ABABCBA@BDDF@@@BCBE@CBAAAC@A@C@CCCCAAEAAC@A@EB@DBB@DABKFEHKFHZ@BEDCBMDCF@D@BBDDDBABABCBABAF@H@FDDH@B@BAJ@FBD@D@B@BABEBEBCDCFAFBFDHFF@@PRBBLLBD@FAH@FBDFJDDADAHADEFAFAD@DBFBF@BADCDABADBJ@JBFCBABKHADAD@@@BBD@DBF@DQhCDCDED@B@BFDBBABABA@CBEDCF@B@@@BA@EHMVU\\CFSZCFCBAB@@A@A@C@GEC@A@@@@@AB@@ADBB@DDFFFDBF@@BBD@FABABCBQAGBG@CBE@ICAACAA@A@ABCBAAGAAACB@@@DDBB@B@DB@@@BA@AB@@@BD@@@BBA@A@A@A@A@@@A@A@A@ABABAAAAAA@AAAAAABA@A@@B@B@DAB@@@@C@CA@BA@AD@@@BA@@@EAA@@@@@@B@BBD@B@@A@A@C@@@@@BDBD@B@@DB@B@@A@@@A@AA@@CB@@@@@A@A@@CA@@AAA@A@AB@@ABAAAB@@BB@@ABA@A@@A@AA@AAA@A@@@A@A@@AABA@@B@B@@@B@@B@BBBDBBBBFAD@BB@@@DABCBADBBBBD@NCF@BB@DABAD@DDBDBDBBBBF@B@BBDB@DBBBB@HAFBBBBD@@BDDJBDBDBDDDBDDD@D@B@BDHBBFLNNPPBBJLDDFHLHHLHHHP@DFP@L@D@DABMAE@A@IHA@BHABBBB@DADAD@JJBDBJ@@@@@B@D@BBB@BP@HCB@BD@FBD@@DBF@FDDDBD@D@H@@@B@D@BB@B@BAHADAFD@BA@ADIBLHNHL@FIHI@IDMDEFAH@H@@@HCBAFEJEHKBIBGFGBC@CBA@GDEJCF@B@D@FFDFDFBBFHFDB@@@JBFAHAJALAHBB@HBJ@FAB@DCFEHEFCF@D@@AF@F@F@DADCHAF@D@DAFCDED@B@B@BFBDDF@@@BADAF@DAHCN@N@DBF@HABFHDDHBRFZFTBNALEFEJEH@B@BBDFCBBDBAHCBBDDFDHCBBBEPDFBNHLFNLFDFDBB@@DBJHJNFPBBBJDLHLBFBDBBBD@DAFAF@H@FDJ@@@BFPBHDDDDDDDDDLDFBBD@BABADCBADADBDHFHDDDFH@D@JD@KHCHA\\IFABAHAFAFBHDDBDAB@DGHIHCFEFEDE@@DEJGRCRGNGB@LGLEJE@@FGBEBG@MBEFIFMDQ@S@@AOAKCMA@@ACIEOCCIGQEIECAAAEAEE@EAI@AAIEMCIBIDELOJM@ABABGBCBCFCLEHGECEEEEGIEKCCGEOKGQCg@EAIBI@SCICII_AOBO@EAEEEEEC@E@IFGBG@ICOAIAGCMGEKAC@EF_@QFM@G@AEE@C@G@GACAAE@IAE@IMECC@MCGCGG@ADCLCFCFAD@PFD@FCDEBMDCF@LNDBB@@ADCFADAF@BABADEDC@C@EBEBE@MBABADAF@FAFGDG@AEEAACCKECEA@A@A@A@ECCBADA@GAACAABGLKLM@ABAEKCGACCKSIGAEAEAAADM@GDCLKDE@A@A@GCCCACECAGCGCAAMBIAOKA@IAECCGBI@G@CCAA@EBCBADCDEBE@UGCAAE@ICCBEDGAKCCGAA@IIECEGAC@A@IDCHCFABE@A@A@CCEACCCCCCAE@E@CACACCGGGGACAEAIAECCEACE@AIAA@GDC@C@EA"],encodeOffsets:[[116216,26180]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("邵阳",{type:"FeatureCollection",features:[{type:"Feature",id:"430502",properties:{name:"双清区",cp:[111.496341,27.232708],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@@CACCCEEEAAAC@C@A@AF@FABADCDEFGFAB@@@B@@DD@B@@@@ABA@A@A@@B@@BD@@HH@@DB@@DB@BAB@@A@CBCAA@@B@@AD@@@B@@AD@@AD@BA@@@ADABABDB@@@@BB@@DBDB@@@B@@@B@@@BAB@@@@AAA@A@A@@@AB@@ABADAD@@ABAD@@@B@F@DB@@B@BBBB@@BD@B@@@B@@@B@DC@@BEBADI@@BCB@BAB@D@BAD@@BB@B@BDBB@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@DD@BBB@BB@@D@D@@@B@@@BA@@BABA@AB@B@@ABBFDHAD@DAD@@CDCBCB@B@@BB@BDDBBB@BB@BBBA@ABA@@B@BBBB@BBB@BBDBB@BBB@@A@E@@@AB@@@DABBBABA@@D@BBB@B@@@HCF@@@F@HB@@BBB@N@HCBCGISQ@IBAD@H@J@JD@@BA@A@@@@@@@@@@@@@A@@@@B@@@@@@@BB@@B@BB@B@@@@ABBD@BB@@@@@B@@@@@@@@@@B@@A@@@A@@@@B@@@@@D@BBBB@BB@@AB@@B@@@@@@B@@@@C@@@@BBB@@AB@@@@A@@@DBDABK@ABGHEDAJGPBB@@@J@HAB@B@@BFBHLFFJ@J@BA@@@@FCBKBGCGAA@@@A@@@AB@@@FCB@@C@ABABAFA@@@A@@AA@A@@@@@@@A@@@A@@@A@@AAAABA@A@AB@@@@@@@@@@@B@@@@@B@HFBBD@FD@@B@B@@@@@@@BA@@@@@@DC@AAAACAIAAAAAAA@@A@A@C@@@@@A@@BC@@@A@@@C@ACC@AAA@A@@@A@@@@AG@Q@CAA@@@C@E@CA@@@AA@@A@CCA@CAAA@A@A@@AAA@AAC@A@@@ABA@@DAB@B@BABABA@A@@@A@G@EBA@CAC@AAEAAAA@A@GBA@C@E@EAA@CCICCEE@CBADAD@B@@@B@@@@AFABABA@CAA@AAAAABA@ABABABA@@@@@A@EEACAAAA@@GCAC@@@@A@AA@B@@BF@@BDAB@@A@@@A@ABCB@@OF@BA@AB@BABAB@A"],encodeOffsets:[[114181,27860]]}},{type:"Feature",id:"430503",properties:{name:"大祥区",cp:[111.439091,27.221452],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@@D@DBB@@@DBD@DBD@BADA@AB@@@B@F@B@@@@@D@B@D@@B@@D@@@F@BB@@B@BABABC@@B@@ABC@@BC@@@A@@BC@@@AB@DBDAB@@@BA@ACA@@CA@@GG@@AC@@@AB@B@B@BA@@@@@ACC@@@A@@BAHEFEDCBCBA@EBEB@D@D@BBFBFFDDBD@DB@B@@BBABA@ABAB@@APE@@DABAB@@@B@@@BAAC@@AE@@@ABBB@@@@@BDHD@@BBBBBDFFB@@@@@B@BABABAB@BABBBBB@DBB@BABABE@@@@@A@@@ABCBCDAF@DFJDDDB@FBF@D@B@HAB@B@BBFBBBD@DBB@FAH@B@@@B@CGCAAC@@BC@@CA@A@@@@ACIQAG@C@@ACAIIQ@@ACEK@CBE@ABCLCBA@@B@@ABAB@@A@A@A@AE@@@@@BABAJE@@@A@@AA@@AA@ACA@AA@BC@@B@D@B@@DBBB@D@@@BA@C@CAAAGBA@CBA@@A@A@C@AAGCAA@@BAD@B@@@AA@@@A@@@@EDA@@AA@ACAA@@C@@@@BA@@@A@@A@@A@AB@@A@@AA@CB@@C@@@@@A@@A@ABA@A@@@AA@A@A@CBA@EC@@AAAC@ABA@@AAAAA@A@AACAEAA@ABCBC@IF@BAD@DBFDFBB@@B@B@@@@BADA@@@BDAHA@AAA@A@A@@@@@@D@@@BBDDF@B@@@@ABA@A@A@A@ABA@@B@B@BDD@@@@C@@@@BC@ABA@A@CAAB@@ABA@A@@@ABB@@B@B@@AAA@@BA@@BCFAB@BAF@D@B@B@BA@@BC@@BC@A@E@@@@@@A@@A@A@AAAAC@A@C@@BA@AACAAA@A@A@@A@@@CBGB@@@@A@AA@@@A@@DBB@@A@@CCAAC@AA@@B@B@B@@@B@@AAA@A@ABABABA@AAA@AAA@E@C@A@@DADAB@@C@AB@@G@CAG@@AA@@AA@AAA@ACAA@A@@A@A@@AA@@@@CAA@A@AB@@CBA@@B@@@BA@A@AB@B@B@BBB@@@B@@A@ABCAC@A@@A@EB@BA@@@@@CAAAB@B@@@@@@A@@A@AA@@AA@@A@@@A@@AAC@A@A@@BA@@@@@A@@C@@AAA@AAA@AAECA@A@CBA@CAC@@AA@@A@A@@A@AA@@@C@@A@EBABA@AA@@@C@@A@@B@@@@A@@@@CDC@@AAA@A@CBA@ABAB@BA@@@ABABAB@@ABCAA@ABAB@@A@A@A@@B@@@B@@BB@@@BCD@BC@@B@@BBJBB@B@FAB@BB@@BB@D@@@B@@BB@B@@AD@BA@C@@@@B@BBBBB@B@@A@AB@B@BDFBB@BBB@BFBB@FH@B@@@B@@ADIFA@CAC@C@@BB@@@FB@@CBABAD@@ABADCBA@CAAB@@@@FL@@@F@BADABCBA@CBEDCDGHABC@AAAAA@A@A@AB@@@B@BBF@B@@ABED@BAD@LADADAB@DBBBB@BBB@DBB@B@@BAB@BBBDBDBDBD@@DDFFBBDBBBD@B@D@B@B@BA@@@ABA@G@ABCBA@@BADABADADA@@F@@B@@D@FDBBDDBDB@@BBD@B@B@DAB@B@BABAD@@GLEH@DABBFBBBB@BDDBBBBBBB@DBB@DB@@"],encodeOffsets:[[114109,27894]]}},{type:"Feature",id:"430511",properties:{name:"北塔区",cp:[111.452196,27.246489],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@AE@@@CBCBABCBAB@@ABAD@B@HAB@B@@ABA@A@C@A@C@AACAAAEECC@@ACACACACAAA@AB@@A@@@@@@@@@@@A@@@@@@@@@A@@@A@A@ABAB@B@@BD@BA@@@A@@@A@@@@AA@@A@BA@@BA@C@GAA@AAA@A@C@@@ABA@CAC@@BAB@@BDBB@F@@B@BA@@@@@B@B@B@@B@B@@B@@AB@@@B@@D@@B@BA@@D@B@BA@@@AA@@A@@BAB@BBBBB@@BBDFBB@BD@B@DB@B@B@B@@@BBB@B@BADAB@B@BDDBF@BABA@A@A@CBAD@BADBBB@FDB@@BA@@@@@ABBB@@@@B@@@B@@@BAB@@@@BB@@@B@B@@@@B@@@B@@@@B@BB@@@@@B@@@B@@@@BBBB@BBBBBB@B@@@BB@@BBBB@@D@B@@@B@BC@@B@@@@@B@@@B@BBB@@B@B@@@@BB@@B@@@BB@@B@@@BAB@D@@@BB@@@@B@@BBBB@@@@@@A@A@@@ABBD@D@@@@@B@@@BB@@@BA@@B@@BBB@@@B@@@@@B@@@B@@@B@@AB@B@@@@BBB@B@BBB@@@@B@D@B@@@B@@@@B@@@BB@@@B@@@B@B@@BBDBB@@@B@@A@AB@B@@AB@B@@A@A@@@@B@@B@BB@B@@@BB@BB@@B@B@B@B@B@BBB@B@@@D@@AB@D@B@@A@A@A@@BA@@BA@@BA@@D@B@@BB@@@BBB@B@BAB@@A@A@ABA@@BBB@D@@@BB@@@B@@ABB@@@B@BB@@B@@B@B@B@DBB@@BBB@@@BA@@D@B@BBD@B@B@@@@@@@DEBA@A@@BABADADAB@BADADADC@@BC@CBCCGAEBA@@@ABAB@BA@AB@@A@@@A@@@C@CA@@AAA@ACC@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@AAACA@A@@AC@ABC@A@ABA@AD@@CJABAF@@CDA@@@A@@@A@C@@AA@AA@A@AA@@C@E@A@@BCBA@@BCBCBA@@BA@@B@B@B@BB@@@@BA@A@@@A@@@A@@CACA@@AA@@@@CAA@@@AAE@@@C@@@@AC@A@C@@@@@A@E@A@@@A@@BCBABC@CAC@CA@@A@CAC@@@A@@@CAA@CAA@AAAAAACC@AAAAAAEBA@CFGHK@@BCBA@A@ABA@C@A@AAC@AA@ACCCAAECC@"],encodeOffsets:[[114095,27848]]}},{type:"Feature",id:"430521",properties:{name:"邵东县",cp:[111.74427,27.258987],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CAACECCEACAACA@@C@ABA@EDAFA@BB@@@BBD@B@F@B@D@BAF@BBD@B@DAH@FBH@HAJAH@@ABAFABBFDDBB@B@D@BA@CDCFA@CDMD@@AB@@@@@B@@@@@BA@@@CCA@AAAB@B@DBF@DADCBC@ABAB@DABA@CAGCAA@@C@CBEBG@CAEACAA@A@IHIFABCBAAC@A@CFABC@C@A@@B@BBFBDBFBDDF@BABILADAB@@CBE@C@AA@A@A@ADE@C@CAAACC@ABABABC@I@ADABA@EBC@C@ABABAD@@A@ABC@A@ABA@@BCNAD@DA@@BCBA@@@A@@@A@A@A@AAA@AAABA@A@C@A@@@EAA@@B@@@BBBFBBB@BA@A@A@AAAAE@C@A@AAA@AB@BA@ABAA@@A@A@A@A@@@AB@BEBA@@BABABA@A@@BA@A@AB@BA@@BA@@@@@BE@@AA@AA@AAAA@A@A@A@@AAAAA@AA@AAAAAAAA@@A@AAA@AA@CAA@A@A@AAAAAA@@A@A@A@A@@AAA@CAA@A@A@AA@AAA@A@AA@@A@AAA@@AAAAE@A@@A@@@AB@@CBA@C@C@A@A@A@AAA@A@AB@AABC@BFCFBD@B@@DB@@@BA@A@A@@@A@BF@BDFDJ@D@@B@@@B@@@@BBB@B@@BB@@@BA@AAA@@A@AA@A@@@A@BBADB@B@@@@@@B@@A@@@@B@@B@@@@BAB@AA@ACA@@@CDCD@BAB@DABAD@B@FA@@BC@@@A@@@CBCBA@A@@@@@AB@@AB@@@B@@@@A@@@A@@@@BA@@@@@@A@@A@@@AB@@@@@@@ABA@@@@@A@@CA@A@A@A@@@@A@A@@@@@@A@@@@AB@BA@@A@@@@@AB@@@@@@@@@A@A@A@AB@B@@@B@@@D@@A@@B@@BB@@A@ABA@A@@@ABEB@@@@@BA@@@A@@@ACA@A@A@@ACA@@@A@@@AD@@@@AB@AABA@A@@A@A@@@@A@@BA@@A@@@@@@A@@B@@@@A@@A@@BA@@@AAA@@@@B@@AB@@@AA@@AAA@@@@@A@A@A@A@@@@@AA@@@A@@@@B@@A@@@@A@@AAC@@@A@@AA@ACA@A@@BA@@B@B@@@B@B@@@@@BA@@A@@AB@BA@@B@@@BAB@@@B@@B@@B@@@D@@@BBB@@@B@@AB@BBB@@@@B@@@@B@BB@@B@BBB@@BAB@B@BB@@ABA@@@ADAB@@ABAD@BA@@@@@A@@A@A@@@@A@@B@@@@@@BB@@AB@@A@@BEBA@@@AB@@@@AA@AA@@B@BABA@BBA@@@AA@@CB@B@@BB@@A@C@AB@@BBHDBBD@B@B@@@AB@DABBHBB@D@DAB@@C@A@AA@CA@C@A@@@ADB@@BDB@BBB@@BB@@@B@@IFABAB@@@@F@@B@B@B@BA@AB@BA@@@ABKDAD@BAF@DFLBD@@JRBJBD@@@DBHJRBD@@@@@BDB@@AD@@BDDBDHB@BABA@A@ABA@CB@BA@@B@D@BBB@BB@@@B@BBBDBB@DDB@@@BB@@B@@D@F@D@@BB@D@RBH@@@@@B@@@BBB@BDD@B@D@@@B@@AD@@@B@@@@@D@B@BB@BBBBBBBJBDBB@BCD@@@@@@AB@@@@@@A@A@@@ECC@AAGEA@@@@@A@@@@@@@@@@@A@@B@BABBBBB@@@B@@@B@@@B@@@@@@@BBB@@@B@@EBABAB@B@DA@ED@@A@@B@@@B@@BBDHAHALED@@@@ABAF@F@BD@B@B@B@@@D@@BA@@@B@@B@@A@@BD@@@@B@@BB@@@@@BA@@@@@@@BBA@@@@@@@A@@@A@@@@@ADA@@@@@BBA@@@A@@@A@@@@B@@@@@@A@@@@@@B@@@@@@@B@@@@B@AB@@A@@@@@@AABA@@B@@BBA@B@A@@@AB@@B@@@C@@B@@@@A@@B@@BDBDLDNFHBBBFAFEBE@GBIBG@@FCFAJBFF@@@@B@@@@AB@B@B@@@BAD@@B@B@B@B@@@B@BB@@AB@@AB@@A@@@@@B@@@B@B@@@@A@D@@@@@B@@@@A@@BA@B@@@@@@AB@@B@@AB@@B@@AB@@@@@@BB@@ABA@AA@A@@A@@B@@@D@@@@AAAA@@@A@@@@@@@@A@@@@@@@@@@BB@@@A@@@@B@@A@@@@BLFD@B@DABABC@CAK@CD@FAB@FBHBDAFAFEBABAHEB@@@@@J@FFDH@D@B@F@NCNAF@D@@@@CNAH@DBHDDJB@BF@N@DBF@D@JBB@LDBBFFFLAN@D@HBHBDBBDFNDPDHBFBLF@@BBBBB@BABAB@DA@A@ABAB@BABBB@F@B@DCDADBBBBBBBLFBDBD@LBFDBB@D@HDD@B@@ABA@GBEBCB@F@BBD@FEB@@@D@DB@BBFDBB@DCBCDABBDBDDBBA@@BAD@DBDB@@B@BABCDIDABE@A@EDCBED@BEJABBH@@DLFLHPBAHAD@BAHBBDDHDFDHDBD@@@BBFBD@B@D@FC@AFGFELCBA@@JGB@DC@AHGJKFCFE@ALGDAHGJIFC@@@@BG@GEGCCAC@EDEJGBAFAJENGJEHEDANEHAD@FCDCB@LCB@HCLMRKB@JGEICG@CDGBA@@@A@EDIHCLHF@ZAD@BE@@BCBE@@DEBABEFEHIB@JGDADAHBLDDAB@JCDEBABM@@@G@E@ABEBO@C@G@@@GBIBEBCBI@IAE@C@@BOBA@ABCFE@@FC@@AAB@@AB@B@@@B@@@@A@@BAB@B@@@BADA@@@@@B@@B@@B@@@@@@@@@@@@@@B@@@@AB@@@@B@@B@@A@@@@@@BB@@@@@@BB@@@A@@@@@@B@@@@@@@@@@@@@@A@@@A@@@A@@D@@@@@@@A@@@A@@@@A@@AA@@A@@B@@@A@@@@@A@@AA@@@@@A@@@@AA@@@A@@@A@@@@BB@@@@@AB@@B@@B@@@BA@@@@@@AA@@@A@@@@A@@@AA@@A@A@A@@@@A@@@A@AB@BBBB@@@@BA@@B@@@B@@@@A@A@@@@@AB@@@A@@@@@@AA@@@AA@@ABA@A@@@A@A@@BA@@@A@EAC@@AA@A@@@AB@@A@@@A@@@@@@@BBB@@B@@@@A@AB@B@@A@@@A@@@@B@@A@@@@@CAAA@@@A@A@AB@@AECAAAAAACAAAAA@A@AA@@@@@@AEGAEACAG@AAA@A@@@CACAC@A@@@A@A@C@ADCBA@A@A@@@@@AAAACCECE@A@EBC@ADCDCFCDE@CBGAA@@CEACA@AC@E@E@@BGDEBCBA@A@@@A@C@CCCMCAC@ADCDCVELCD@FADCBCBG@G@ABCBAF@D@BA@A@AGCAAACBA@ABAFCDADA@A@CACBEDABA@C@E@CCEACACA@AAAACAEBGDA@E@EAEBCBCBCAEAGGAAAA@@AA@A@A@C@@AAAAA@A@A@@@AAA@A@A@A@@@@@A@@@@@@@@@@ALA@A@CDABCBC@C@C@E@G@CAACAAAAAC@CBC@C@CCCCCCCAEBC@CAAG@CACCCAACAI@AACE@AAAAAACAAA@C@A@A@AB@@C@CAEAIAC@E@BD@BDBBBDB@BDB@DAB@B@D@BABABC@IB@@C@AAC@AGAAAAGAAAA@C@CDCBCBABA@EACAA@CBA@IAEAA@AACG@A@@CG@@AE@AAACCAAA@@@EBC@CA"],encodeOffsets:[[114605,27582]]}},{type:"Feature",id:"430522",properties:{name:"新邵县",cp:[111.458656,27.320917],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@ABEA@C@CAE@@@A@A@A@@@A@@@BAB@@@@@@@AA@@@A@@@@A@@B@@@@@@@C@A@@@@B@@AA@A@A@A@@@A@@B@@@B@B@@@BA@@@A@BB@B@@B@B@B@BB@@@B@@A@CBABA@@BA@@@AA@AAA@@ABAB@B@@@B@@A@@@ACA@@A@@@@A@@@A@@AA@@A@@A@@B@@AB@@AA@@A@@AB@@@@A@@@@A@A@@AA@@@AAA@@@@@@@@B@BA@@@AA@A@@A@@B@@@BBB@@@D@@@BA@A@@@@@@@BBB@@BB@BA@B@@@@ABA@@BBB@F@B@@A@@@A@A@AB@@AB@@@B@@AB@@@D@@A@@BA@@@BB@@@@@@ABC@@@A@@AB@BC@A@A@@@@AA@@@A@@@@A@A@A@@BB@@@BB@@A@@@A@AAA@@AA@@@AB@@A@A@A@@@@@@@@B@@@@A@@@@@A@@@@@A@@@@BA@@@@@CCC@ABAB@@C@CFA@AB@@AAA@@A@@AGAAAAA@@@C@AB@@@BCB@BABAB@B@BCDAB@BBD@@BBFHBDABABA@GBCBAB@D@DADA@C@C@AB@@AD@BABAB@BBBB@BB@D@BA@CBC@G@A@C@A@@@ABBD@FBB@DABABC@@@G@EBEB@@AB@BBD@BEJEJCF@BGBE@A@E@A@KAKAIAC@IFCH@HAJBH@@DFF@JALALA@@B@HD@@B@DAB@DBFBDD@B@@@BCDADAB@DABC@A@CBAB@D@B@B@BABCD@DC@A@GA@@AB@B@B@B@B@@A@A@@BBB@D@@@BC@C@GAGBA@AB@@AD@BBD@B@F@BAD@BBFBDBBB@BBB@D@B@DAB@B@FD@BBB@DBDB@JH@B@B@@@DA@ABE@A@GBCBEDABAD@BBDTJBB@@@B@DABABA@ECA@A@@B@DDBDBLB@BB@@DBD@@D@D@D@DABAB@BBDDBDJDB@@BB@ABABAD@BBBB@B@B@FBBB@@@BA@@BIDEDCD@B@BBDBBBFBBBBH@@@@BA@CBABA@ADBDDHBBBBDBF@DBB@DBBD@D@F@@@DABCHADAB@BBB@BBDFHBB@F@FABABAHAHAD@D@F@@DBDBB@DDB@D@B@FAB@F@D@D@DAB@DC@ABC@CAG@CAABABABAHCRGHEBAB@DB@@B@@BBD@B@BB@B@@@B@BB@B@L@@@BB@BABABB@B@B@D@BB@D@DAB@BBB@D@FCB@B@@BBDBDFHBBBD@BBDBBHDD@BDD@HFDBBBDB@@D@@@D@FERMBAFEB@BCFIJCFALEHCB@L@DADABAJM@A@A@@A@EHA@A@@A@A@@FGB@B@BABA@@B@@@@B@B@BB@@BB@@@FC@ADB@@B@D@DBBD@BDB@@FBBD@@BDBHAB@DEB@BBBBBDAD@B@BBD@FAB@BBBDBBBB@BFDDDLD@@BBHLBFBDB@HP@@BBDB@@DD@@NLHJHFB@@@BBLFJBFBDA@@B@BAHCHEBIBEDC@@FEH@@@PDDH@@@DFHBBHDHDFBFBJD@@JBRDDBBBBBB@BA@@D@B@BB@BCB@@BBB@B@@CBAB@DBB@BBB@@@HBFBDBBDBBBBB@BBBFBB@@@BDLBBDBBB@@B@FBB@D@DEB@B@BI@AAG@AFIDEBAJ@@@D@RABE@@@CBIAE@@DGBA@BB@BBBA@A@@@AACAC@A@ABA@AB@D@@ABA@CBAB@BABBBB@@B@D@BA@@BA@A@CBADA@@@AACBC@AB@DBDFBDBBB@BB@BA@EDKH@B@BD@B@DAPGD@B@B@@B@D@B@@B@BBD@B@@A@AAA@@D@DBBB@BAH@@@@BBBA@@BADK@A@AA@G@AA@ABAB@D@BBDB@@BA@@@ACGACGI@@C@@@E@AA@AAACCAABC@@BAD@B@DBFBB@@BAF@B@@BBB@DABA@C@C@@@@FA@@BA@A@A@AAAAA@A@@@AD@B@BABADC@@FA@@@@BA@@@CAC@A@@AA@@@@AA@@@@ABCDA@@@C@A@@@CDA@A@AABC@@BA@@@CDCBC@CAEB@@A@@B@@A@A@ABAB@DAB@BA@@BCBAB@BBJLFJBBDFDDDLB@DBB@B@DADCDEFEDABAB@@ABABE@ADABB@DAPBBBBB@B@@@@A@@B@BAJEFCFCDAHEFEDCF@F@FBDBF@FCDIBEHKFIDEVANDN@RANBB@FACK@GBKBCBI@C@@BA@@@@BAH@FDDFDDJAFE@I@A@A@@BIDIFEFCHCGOEKCK@@AGBAFI@AFCDAFCB@F@BAJCDCBA@A@AA@AC@CBC@AB@AACCCAAACBADCDA@CAAE@ACAC@@@A@EFC@AAE@A@ADAF@HAB@BA@C@GCC@A@CAAE@KACACKEAAAAAACACBCDA@E@A@AAABA@AB@B@BCBA@ABABA@AAAA@@KEEAGAOCMCCEAAACAG@G@CBMEKEEAAKCA@IAC@E@CAM@E@@AIACCAG@CBGDM@@@@@CBEDM@M@E@A@CCGEEI@@@@@A@GFABABEFEBCBGAEAA@EBC@@DBL@DADABCBA@C@KE@A@@B@@@@A@@B@@@A@@A@@@@@@@@B@@@@@@@@@@B@@BBBB@@@@@C@@@AB@@@@BBBB@BA@@AA@@@@@@BA@@@AA@@BA@@@BA@@@@@@@AAB@@@B@@A@@@@@C@B@@@@@@A@A@@@A@@@@@BA@@BA@@BA@@A@A@@@A@A@A@AC@AB@@A@A@A@@B@@A@@@@@EEIAEBED@@AHAJ@HAFEFEBAAGAMEKCACAC@@@AB@@@@@@AD@@@A@@@BA@@B@A@B@AA@@@AB@BA@B@@@@B@@@BAA@@@@@@A@@@@@@@A@@@@B@@@@@@@@A@@B@@@B@@@B@AA@@@@B@BC@@@@B@@@B@@@@@@@B@AA@@@@@@B@@A@@@@AA@@@A@@C@@AB@@@@AA@@@B@@AC@@@A@A@A@C@@A@EBEI@I@EEGKEA@AA@A@GBI@@@A@OAIHCBGFAH@BALCBCA@@B@@@@@BA@@AA@A@@D@@@@@@A@@@@A@@@BA@@AAA@AA@A@C@@@@@A@@B@@@B@@@@A@@@@@@@@A@@@@@A@@AACBA@@@@@AAAA@@@AA@@@@@@A@@@@@@B@@@@@@@@@@@@@BAB@@ICI@G@C@AB@JTRHJADGDM@A@AA@@GAE@@@E@GD@@A@A@AAC@@@ABABAACB@@A@@B@@@F@BA@AAA@CAAAA@AAA@AA@A@AB@BAB@AA@AAAA@AACC@AAA@@@AABA@CBCBABAB@@@BABCF@@@@@@A@A@C@AAA@C@@@AB@@A@AA@@AA@C@A@A@AA@@@AAA@@@A@BA@@@A@@AA@@C@A@AA@@AB@B@B@BA@ABA@A@AA@@A@@AA@C@@@AB@@AB@@AB@@@B@B@BA@C@A@@BC@@@A@A@AAA@A@A@A@A@@@AAA@@A@@@AAAA@@@@A@@B@B@@@@ABA@@@ABAB@@@@A@@AAAC@AA@A@@@A@@@A@@A@@@A@@A@@@A@C@A@@@@@AA@A@AAA@A@@A@A@@BA@@@A@@@A@@@@@A@@@A@AA@@@AB@@A@@AA@@A@@@@@C@C@AA@B@@@B@B@@@@A@AA@AA@@@@@AA@@C@A@AB@@A@@@AA@@A@@@AA@@@@@A@AA@AAA@@@A@@@@@A@@@ADA@@@A@C@@@AAAA@@AA@@A@A@AAAA@AAAAA@@@@@A@@@A@@@@AAA@@@@@@A@@@A@@A@A@@@A@@A@@A@AB@@A@@@A@@@@@AAAAA@@@A@@@@B@BAB@@ABC@A@C@A@AAAAAC@@@@@AA@A@A@C@A@A@A@@A@AA@@@A@C@ABA@C@@@A@EBA@@@AA@@@AAAA@AB@@@B@@A@A@@@A@A@@@@A@@@@@AA@@B@@@@@BA@@@A@@@A@A@A@@@AAA@@A"],encodeOffsets:[[113986,27906]]}},{type:"Feature",id:"430523",properties:{name:"邵阳县",cp:[111.273805,26.990637],childNum:1},geometry:{type:"Polygon",coordinates:["@@BA@@@A@@@@AABAB@A@A@@@@B@@A@@@@A@C@@@@@AA@@@C@AAB@@ABABC@ABAAC@BABC@AAA@@@A@AA@AB@AAA@@AC@@BBB@BAB@@AACBBBA@@@A@A@@AA@AA@@AA@@@AA@AA@BA@@B@@@@ABBBAB@BBBA@AFBBA@BB@@@D@B@@@@ABA@C@C@@@KFGDE@C@C@ECC@MCEAA@C@EHABCDCBCAGAKACAAC@A@G@C@CGCIGIGIECCAEEMCGCC@A@A@CBC@CCCIKAA@CBADCBCDEBCBABC@A@CCC@C@CBEBCDAB@BABABAACAEAC@ABABE@@@ABCACAECQ@@AA@E@AAACAC@CAAE@M@ADCDCBA@A@C@ECCEAEAE@ABA@ACCCAEAEACQGUGOEEFEHAF@FBHDF@D@DABABC@KDGBI@AAMAEAKC@@I@G@GA@@CBGBEDABAB@B@B@B@DBF@D@BBBADAB@B@DADADAD@B@F@D@FADAD@D@@EDCDAB@BAHAH@BBDBBBDDB@B@D@FABABC@CB@@A@E@O@GDODABCBGBCBI@C@GBAD@BAHADCBEBEAA@A@C@EACC@AACAA@AAEACAA@@CBCDAB@B@DCBGBE@GBAB@BAB@D@F@B@@A@C@CBCBCDCBCDABADC@CAAA@ACACBE@I@A@MC@@E@GFCF@@@DDDHDJFDAB@B@@BDDH@BB@B@@B@@@@FBB@F@@@BB@@B@@ABA@C@@BA@@BE@@@A@A@C@CBGBAD@BAHAHADB@B@@BB@B@HBAB@@AB@BB@AB@BB@@D@@A@ABB@@BA@A@A@@BB@@BA@@BFBFBBFDBAFCFIB@C@@AA@@@AA@@@A@A@@@A@@@A@@@A@@@A@AB@B@B@BA@@@AA@@AAA@@B@BA@@@@B@@@@AB@@A@ABIDI@@@KBOFABEB@@BBB@BB@D@@AB@D@BAB@B@DA@AAC@ABCDABAAAAABC@A@AB@BB@@@@DE@@@@@ABA@@B@BA@@B@@A@@AAA@AAAADMHBBBDDHDFBB@@DBDB@@FCD@@AB@J@DAD@FBFBDD@BBB@@AD@B@DADAD@DBDBBDDDD@DAD@@@DA@AD@BAF@D@BFBBB@DA@CBAD@FDDDBBB@BC@MB@BA@ELAF@BBBAHBB@DBBDBB@B@F@FADBHBFDHDLJDF@@DF@BDDDDJDJFB@BD@D@B@BBB@@BF@LBDBFDBB@B@B@DCBCHMBEBE@C@ABABABAD@B@FBDBFDBBB@JG@@DC@@JGDCJGDADEDEBEDCDCB@B@BBB@BB@B@BADA@@B@B@DBBBJ@B@B@B@BA@@BKHKJ@BAB@DBBB@@@B@B@H@B@@B@@@@AB@@B@B@BB@B@@@F@@B@@B@@A@@BC@@BAF@B@@@BBD@@@BAB@@BBB@@@@C@@B@@@DD@FB@ABBB@A@CBA@@@@B@@@@BAD@@@BD@BAB@BABA@A@@BAD@@BBB@@@DB@B@D@@@BBBB@BDBB@@@@A@A@ABAD@B@BBBDBBBBD@B@B@@BBBB@DBB@FADB@@@A@AB@B@@@BB@@@BB@B@BAD@BBBA@A@A@AB@B@BBDDBB@BB@DADA@B@@@BBBB@@A@A@AB@B@BBDBFDDDBDBB@D@D@D@D@BAD@B@BBBAB@BAB@B@@BB@BDDDD@B@B@D@D@D@@@B@@@BDHA@@@@@AAACA@@@A@ABCB@@@B@B@B@@AH@B@DBD@BBBFDB@B@FEB@@B@@AB@B@DBB@@DBDBDB@BBB@BBBB@DBB@BDBD@DAB@BAB@BB@B@BB@B@@@DABCFAB@B@D@BA@@@A@@@@AAB@BABA@@@A@AA@@A@AD@@@@A@AA@@A@@B@B@@@BBB@@A@@@@DB@H@@@@B@@@B@@CB@B@@B@BAB@B@@@BBAB@@DF@B@@@BA@A@AAA@@@@@AB@@BD@@A@@@A@A@@BA@@BB@B@@BBB@DA@AAC@A@@@@@DB@@B@@B@@A@A@@@@@@B@D@@@@DD@B@BB@@B@@@@A@A@@@@@@B@@@B@BB@@@@@A@@B@@@@D@B@BAB@@@BA@@AA@@@A@@@@B@B@@@@AB@@B@@@BB@@@@A@@@A@BB@@@@@@@AA@AB@@@@@@BB@DBB@@@AB@@A@@@@@A@@@@@@BEF@@@@@B@BA@ABAB@B@@BB@BB@B@@@@B@B@B@@BB@@DDA@@@AB@@BB@B@B@BB@BB@@@@A@A@@@@B@BAB@BA@E@ABA@@@@D@@@B@@@BB@@@@A@@B@@@BB@B@B@@ABABBB@@A@A@@B@B@@@B@B@B@@@@@@AA@A@@A@@B@@@@@B@@A@@B@@@BA@@BAB@@AA@@@@A@@B@B@B@B@@BBB@ABDB@@B@@@B@D@@BB@@B@@BBB@@@@BA@@@@BDB@BBB@B@B@@B@BB@@@DDF@@@@BAD@@@B@BBBBBH@@@BB@BB@@BAB@DED@@@BABAD@DD@@@@B@@A@@B@@@@@B@@@@@B@@@@@@A@@@@@@B@B@B@@@BA@@B@@BB@BBB@@@B@@@AA@@A@@AB@B@B@@@@@@B@@BB@@@@@B@BADA@@BB@@@D@BA@@@@@@AA@@B@@AB@@@@C@@BA@@@A@@BA@@BAB@B@@@B@@@@A@EAA@AB@BA@@@@@AABA@@AA@AA@@@@@@B@B@@A@@@C@@AA@A@@@AB@@@@BBB@@B@@A@A@@@@@@B@BB@@B@@BB@B@@@@@@B@@A@@BB@@@BB@@BA@@@AB@@@@BB@@BB@@@B@@@@@@BB@BD@@B@@@@A@@@ABABA@@BB@BBB@@B@@AB@BADAB@@@@A@@AAA@A@A@@@@AAAB@@@B@@A@@@A@A@@@AB@@@B@B@B@B@@BA@@@@@@B@D@@@@@@@AB@@@@@@B@@BB@@@@@@A@AB@@B@@@B@B@B@@@F@DBD@B@AF@B@@@BB@BB@@B@B@B@@@B@@@B@@A@@@@@AB@@B@@@@@B@@B@B@@@B@B@@@@A@@BAB@BB@B@@BB@@B@FAB@@@D@B@BAD@B@@@B@@B@BB@B@B@D@B@B@B@@B@@@@BDBBBBB@D@B@D@BA@@BA@A@A@@B@@@B@BBBA@@@@B@@AA@ECA@AABC@ABCDAB@B@B@BA@AAECC@A@ABABC@A@AAA@A@@@A@A@ACAA@C@@AAACEAA@@AAAA@ABA@AB@@@BB@@B@@A@A@CB@@A@AC@@@@A@@BA@@@AA@A@@@@A@A@A@@@@ABA@@@@EAAAC@@BA@AD@DBB@BA@@D@B@B@BBB@HBD@B@@AB@@A@BB@@B@@B@@@B@@@B@@AAC@@@ABABAB@B@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@@@AAA@CAA@AAAAA@CBABCBC@KBC@AFCBA@@@AAE@A@A@@BAB@B@B@BBBBD@BAHGDCFCDAB@DABABC@A@E@@EK@@@@BADBB@DABCBA@@BCBADA@@EA@@A@@AD@D@DBB@JEBC@@@A@@@AEGA@EA@AAA@AAACE@A@ABAB@@@@AAAAA@A@A@@D@B@@ABC@@@AAA@@@A@@@CAA@@AAA@EBA@A@IAAA@@@AD@@ADC@A@@AA@@@A@@@AB@B@B@@@BABAB@DBBA@@BABABA@@B@@ABABAB@DAB@B@BB@@CD@D@@B@@@@@@AB@@@@D@@BBB@BAFAB@@@@D@@BBB@@@@B@BB@@BD@DBB@DAB@B@FDBBB@BBB@BB@@@DB@@@@@B@@AB@B@D@BB@@@B@@@BB@@BB@@B@BB@@@@@@@@ABABB@D@@@@ABA@@F@BB@D@DBBAB@@@@A@@AA@A@A@ABAB@B@@A@@@AB@DA@@BAB@B@DB@@@@BB@@@B@BB@B@DB@BBB@BBB@@BB@@BH@D@HA@@B@DA@CBCB@@@B@D@FBB@BBB@BABABAB@B@BBB@BA@@@A@A@A@@@BBD@BBDD@@@BA@CA@@@B@@BBB@@@@@HADA@@B@@@@B@BBBDBBBB@@AD@B@D@BBBBB@B@@@@B@@@@F@B@D@@AD@@AB@@A@A@A@CBE@ABADE@AB@@AB@BB@@@A@AA@BA@@B@B@BA@@BADBB@B@BAD@@A@@D@@@@@CC@A@A@AB@BAB@B@B@B@BA@@@@@ACEAC@A@@@C@@@@B@B@B@BBB@BGAC@@B@BC@A@@A@A@@@AACEAE@CBC@AJED@DABAB@FBDBBBB@B@BBBB@@AB@BBDBB@@FDB@DAB@B@B@@B@@@BAB@B@BB@@@@@D@@@DAB@@BB@@@BAB@@@@BB@@@B@@A@@D@@@BBBDB@@BB@FC@@@@DA@@BB@@B@AAB@BA@A@AB@@BBB@@@@BA@@B@FA@AB@@@BA@@AA@@@@@@@AB@@@@@@B@BB@@@@@B@@ABCBA@@BABC@@B@BA@@AAA@A@AB@@AA@A@AA@@A@A@@A@@@@@AA@ABA@@@A@@AA@A@@@C@@@AA@@@@A@@BA@A@@@AB@@ABA@@@BB@@A@@@@@A@A@@@A@AB@@AB@B@BDB@@BB@@@D@BB@@@B@@B@@@@A@@B@@@B@@B@@@@@B@B@B@B@@@@BB@BB@@B@@BA@@@A@@B@BB@@B@@AB@@@@B@@A@@@@B@@@@B@@@AB@@@B@@B@B@@@@BABBBA@@B@@C@@B@@@B@@DB@BB@B@B@BD@@B@@@B@@A@@@@FABA@@B@B@BAB@@@AA@@@AB@@@@C@@@A@@@ABAB@B@B@@@@@@@@@A@@B@@@@@BB@@ABA@@@@@B@@@@B@B@@@@@@B@B@BDB@@@B@@@@AB@B@@@@@@BA@@B@@@@B@@@@B@@A@@B@@@B@@@@@@A@@BA@@BA@@@@B@B@DADA@@B@@@D@@AB@@E@ABCBA@CBA@ADCDC@@B@BDB@@BBA@A@@A@@@@A@@B@@@@A@@@@A@A@BCAAB@@@B@B@@B@BB@BBB@@A@@AA@@@AAA@A@@A@@@A@@@@CCICE@AAEB@@@B@B@B@@A@@CA@@@AACDEAEAA@@@@A@A@A@@AA@@@@A@ABA@A@@AACAAAA@@ABABC@ACC@@@AAGACC@CAC@AAAA@ACC@A@ABABCB@@ABABAACAADEHE@A@AEKAGACCEACAC@ABC@A@@CBG@A@AAAAA@ABCBCACCACCCCACAEAC@C@ABADEBABCBC@CCA@E@CBCBA@CBC@CBABAD@DABAAC@ACCAAAA@AAAE@EAEAE@AACAEIIGEAA@ADA@@DCDC@ADABAB@NC@CBA@AD@CA@@@A@C@@@AAA@@A@@@BC@@@@BAAA@A@@@C@@BCDEDCBE@A@ACCCA@AAC@AAAA@CA@@AAAE@AAAA@ABC@ABC@C@AAAAAE@GAE@ECCCCC@CAA@C@AACC@CAC@C@C@ADAAEACACAAAACAAAA@@@@@ADIDEBAB@D@B@HAFADAHEDABA@E@E@EAGCGCAAK@A@EBE@IAEACACAA@@@C@EBA@CBCDCB@@C@GAC@A@@BAB@FAB@@IBS@E@AACAAGAAA@@@A@"],encodeOffsets:[[114111,27506]]}},{type:"Feature",id:"430525",properties:{name:"洞口县",cp:[110.575846,27.06032],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@CCC@BI@A@@BEDEDGAI@A@K@ABEBEFG@CLIDCJGBA@@FG@@BCBADADAF@F@D@F@B@DABA@@AA@CACAC@CA@AAA@C@@AAAAABC@C@C@@CAAAC@CACCC@CAA@A@A@CBAAC@AACAC@ABA@@@A@ABOKEIGIKGKCAAC@OEEBEBIFABABCFAHHHFHBJ@B@BIDGBC@CBIHELCHCHGBG@O@@@UAAAMAG@A@GBAFABA@A@@@@@A@A@@@@BA@A@@B@BB@@@BBABA@EBIASECAEEACQWCACAA@CEECAEA@IMA@AAAK@CAE@@EECACAECCA@@EAK@I@IAM@MAGCAAGGAE@CDI@EDI@GICCAE@G@C@KHIJGF@@CDEDCDEFEBEBK@C@KBEDEFABGDGBG@KAE@GA@@@@G@IGAAAAEEMICAACIAIAEACACAGCA@CBABC@C@AA@ACCIGMEACCI@AACBCBC@ABA@C@E@@@A@@ACGDEDCHGDM@@@EAE@@@@B@DBDDFBHDFDDBBDDDB@B@BAH@DCFCDCDCDCBABIRAF@FEHGHCFC@EC@E@ICGE@GBMRCFCF@DJDDPCFABEFEDCDGDAB]NABABAD@B@BDDBDBD@D@BABG@C@ADADCDA@CBC@@@GAA@AD@J@FFF@B@DADCHGFABABCBA@@DCBC@C@A@AAA@@B@BBBDBBBB@BC@@B@DBBB@B@HBBB@DADAB@DDBB@@CBABABD@B@BB@@APAF@BEDE@A@C@CBCBCBC@E@A@GGC@AAA@G@CCAAA@MHCBA@KAA@A@AFCB@B@@BBFFDD@BAFABAB@DBBDH@@AB@@CBE@C@CB@@ABADED@DAD@BDDBBD@@@@B@@@BF@@@BD@B@BAB@@CBGAEAAAAA@A@CBA@AAAABA@@BADCBCD@B@B@BDDB@HDB@B@BADAFAD@J@D@FDJFLHFFDFHHBD@D@D@@@DBD@BCFEF@@IJKHEBGDCBCBCHABABA@A@CA@@AA@C@A@AA@GAC@A@AAGG@@DCBEECAAA@CAE@C@CCGEGCECCACA@@@BGDODE@AAEEGKGQBSBGBEFM@MAM@ECKCM@ECOGOIGIMCIGM@M@GCKCECEIMGOGOKGEEIAGAE@KBE@M@GT@TKHE@I@G@MAMAK@KDKBCBKDEDC@MBEDCBABAFAB@DBBBDBDB@BD@D@L@FAFCHAF@HB@@HBHDHBF@@FFBBBBFDBDXLPFNDPDHFDLDNDJ@BBBCLCJEDCDGDELFLDFFLLHXHPHHFBBLH@@BBDBHJDFHFB@HBDBB@F@NBNDHDLDHBFDDBB@BABAB@B@B@HFDDBB@@BA@ABADCBABCB@DCH@DBL@D@DABABA@A@A@A@A@@PCD@BBDBBDBB@D@D@DHLDDBD@BFF@B@BBD@FAFEPAH@FBHDFBDDBFBD@BB@@BBBFBBBDBBDBBBFDBBB@D@D@BADAHCDE@@DABAJCFAJEHCPGPEV@@@F@@@JDPJDFHH@@DPBP@H@NFPJJRLPHTHVHNHLHHJFHFFEHHFDDLHJFFBD@JDH@DE@A@CFEFEJIFIBCFGJCN@HBH@LDNJ@B@@DBB@@@BD@D@B@F@@CF@@AB@@@BABDFDF@BADCFAFAD@BDBNF@@B@BBBBHH@@BBBB@DBBB@HH@BDD@@BBBBBD@DBDDJJHHHFF@FRDH@FBB@DAFEHIB@@MAEBGHGDAHAFBD@HBBAF@BAD@DBD@BABA@A@C@CBC@ABAB@B@DBDD@BBBD@D@DAD@FAD@F@D@F@B@BB@BBB@B@B@B@BBDFDHJBBVJNFN@B@B@BAHCHEBC@ABGAOAKAO@E@C@CAOCOBQAGAC@I@EB@@ADAFCHCDCFCBABCBE@@@E@CBCDA@C@A@@@A@A@CCE@A@ADABA@A@A@AACAC@@@A@@BAF@@AB@@A@A@A@AAABEDCBABABABCBEB@B@FADAB@@ABC@ABA@@BAB@@ABA@E@C@C@AB@@@DBB@@A@@BA@AFCB@@BABA@@BAB@@@BB@BAD@@A@@@ABAB@BB@@BA@@@A@@A@A@ABA@@@@@@A@@@A@@AA@@A@@@@CAA@@BAD@@ABADCB@AAA@CAAAA@@AAA@@C@@BA@A@A@AAA@AAA@CAA@A@AB@AC@A@C@C@ABAB@@AAA@C@C@ADADAB@@@BAAC@AAAA@A@AAA@ACAAAAA@AA@@BAD@BA@@@AACA@AA@ABA@A@AA@A@AFA@AAAAAAA@CBABBB@BA@AAA@AAAAAACAABA@@DABA@CAACACAAA@@B@B@@@D@D@BB@@DB@BB@FBB@FAFAB@J@HAB@HC@CBABADAB@@ACA@A@ABC@@@@@ADCBC@C@A@A@C@C@C@AB@@@H@D@D@H@F@F@D@DBF@DBBBBBB@B@BABEBCH@@ABEDIDM@@DGDIFGFEDA@@BADALE@@FCFG@ABC@C@E@AAKBE@A@A@C@ADGDADCFBJDJDD@D@BCBGBCBAFAFADADE@ABAAGAI"],encodeOffsets:[[113582,27676]]}},{type:"Feature",id:"430524",properties:{name:"隆回县",cp:[111.032437,27.113978],childNum:1},geometry:{type:"Polygon",coordinates:["@@ECGACAEBE@A@A@CAAA@CAABGEBAB@B@@@BEBEHGNA@@AAA@@EAC@AB@@AB@D@BAD@@@BA@@B@D@D@@AF@B@DADCD@@EDGDIBA@KAM@CAG@E@MAG@C@A@A@A@AA@AA@@@A@@@@@@@@AA@AA@@@@A@@@@@@AB@@C@@A@A@@B@@AA@BA@@@@@@@A@A@@@@@@@A@@A@@@B@@@@A@@@A@@@ABA@AA@BAB@@@@A@@@A@C@IBBJBHAB@BCFCBEBEBABADAHADC@C@ICICEACDCBCH@B@D@B@BAFBL@B@F@DAD@BEHED@@KFCBAB@@CBEFEHCJCH@@CNCJAF@BG@ADAFABA@A@AAAACAE@CAC@E@E@G@C@C@G@@@A@@B@D@D@D@B@B@DADCD@B@@@@AD@B@BDB@BA@CBABAB@DGDA@GBI@A@EBEBA@EAA@@ACA@@AAC@C@@@A@A@@@BBDBDBBB@DABCB@@ABBBBDBBBB@BBB@BABA@AAAB@DBBBBBB@BEB@B@BBBB@B@BAB@@BDBBB@@B@@ABC@AB@@BBBBBDB@BBB@B@BBBBBD@BB@A@@BABCBCD@D@B@BB@@BABAD@D@B@D@@BBAB@B@DBB@BBB@BBB@B@B@@AD@@@BB@BB@BBDBB@BBA@CDAB@BC@AB@@BB@D@@B@@@BB@@@B@@@B@@@@B@BAB@B@@@@B@@AB@@AAA@AB@B@@@BC@ABA@@A@@BA@AB@BA@AA@ED@BAB@@@BA@CA@@A@@B@D@D@FAB@BA@AB@@AB@BAD@BA@CBEBA@A@AFADABABABCDAFBB@B@B@B@BA@@BE@AB@@@B@@BDBD@B@B@BABCB@B@BDF@D@B@B@@@B@DCBAD@D@F@@AFADABEDCDGDEDCB@BA@@F@JBDBHARDPBP@D@D@FBPBLBPAH@BADGFGDABA@A@M@MEUIAAGIECAC@A@A@A@AAA@AAAA@E@C@E@C@EBC@CBC@C@AA@ACCCAA@A@AB@BAD@D@D@BABABC@CAC@ABE@ABGAC@EAGBCBGHAHBF@NA@GJEFCBA@EAG@QCBB@F@DDBDFDFBFDL@DDL@NBRFPHLNP@BHD@@HFLHHHBF@D@FCHEHEJ@L@BAD@H@J@DAJA@AFABGLGHKJML@BCDCFCB@BCBGHOFCBEBABC@AJ@BBB@@@B@@@@@B@@@@@BB@@B@@@B@@@@@@@@BB@@@@@@@@@BB@@@@@@@@@@B@@B@@@@@@B@@@@@BBB@BBB@BB@@BB@BB@@BB@@BBD@DDLH@B@BADBHBDB@@@BABAFAD@@B@@ABCBADAB@DBFFBBBB@@DBBBDDD@BD@B@JNLFL@P@J@DBB@@@D@DBFBDBFHBH@BBBD@LAHBJ@D@B@ABAB@B@D@DBBBBDDDAD@@ABC@A@CDC@ACGAAEEAC@C@G@AB@B@DDDBH@BAB@NKLOHKDCTOLOBBBBAB@@B@B@ABFB@ABA@@@AB@@CB@B@@B@AB@AA@ABA@CBADB@AB@@@@@B@@BDCJDDBFBHLLJBBJHFDLFBB@@D@FBDDBBHBHBFDBD@BBB@F@FCDADEDABAD@@AB@B@D@FAB@DA@BBD@@@@BCB@B@@J@BADAD@BAB@D@BBB@BB@B@@CBEBEBC@@BB@BD@FBJAD@D@BB@@DBDDDHDNDBFDCLCLAL@TDRDTDB@VABAD@NAN@XDTBBBNDF@D@H@HC@@BAB@JGDMDMCE@AEIFGD@H@LABBFDLJJFFAF@LAFC@@F@D@D@LBTCDCBADCBAJIDOBE@ICK@IDEDAHABAJBP@B@JBP@DCFGBE@EHAH@FBNFJ@JEB@B@BAD@BB@@BDBBB@BBFABABAD@BA@@@AA@CA@A@A@A@ABADAB@BBB@BBBB@B@BBB@@B@B@B@@AB@@BBB@@BB@@BA@@@AAACAAAAC@ABABA@ABCBA@CBABADAB@FBB@FF@@B@@A@C@CBCBABADADAF@B@BAB@BABCB@@E@CBCBGBGBABA@E@EAAEGAC@AAA@ABABCDGBA@C@@@E@CACCAA@CAE@CAAAAACGACBCB@BADAB@@A@@G@AAAAAEAAAC@A@ADCFCJC@AB@@A@@AAEAA@A@A@AA@ABCBABAA@@AA@ICACCCAAA@ABCBC@C@C@@@AC@CA@@AKACACA@C@AB@B@FDB@BABA@C@A@@AASIAC@ABCBAFCDAHAB@F@BAB@@C@@@A@AIGA@AC@CAA@AECA@A@CBA@C@A@AAA@AAACAE@ABC@A@E@AAC@ABC@@BAB@HAHBD@D@@A@@@CAA@AB@B@@@@A@A@A@ABA@@HBB@D@@CDCBA@A@A@A@CBADAB@D@BA@CBABCDC@A@@@ACCEACAA@CBA@@@GCA@@@KBKBIBE@CE@@AGBI@GDGJED@JBLBLBB@F@B@F@HA@ADEFIFI@AAC@ABA@@FAFAH@@@D@BABA@CAA@EACBA@@B@D@B@H@D@DAB@@A@CAAA@AA@ABABA@ABC@@BAD@D@B@BC@C@CBADAHAB@BABAACEGAA@@AC@ABADC@A@ABABA@ADA@A@@CE@C@@AAA@@@@A@AAA@ACA@A@@B@@A@@A@AA@@@AA@@AC@A@@@A@@@CABAA@AA@@@A@A@A@AB@@@@@BB@@BA@AB@@A@@@AB@@@@A@@@@@AB@@@@BBB@@@@@@@A@A@A@@@A@AB@B@@@AABABA@@@A@AAA@@A@@@@B@@A@@A@@@A@@@C@@B@BAF@B@@ABA@A@A@@B@B@@@@@AAA@@A@A@AAA@@BA@@B@CC@@AA@@@A@A@A@@A@A@@AAA@@@ABABAB@@A@A@@@@FE@A@@@@B@@@@@B@@@BA@@A@CAA@@A@@@@A@@BBB@@@@@@A@@A@B@@@B@@A@@A@@@AA@@B@@A@A@@@@@@B@@BB@@AB@@A@ABA@C@@@@@@AB@@@@@A@@A@A@@@A@@@@B@B@@@@@@AA@@A@ACC@@@@@C@A@@@@B@B@@@@AA@@@CA@@@@B@D@BBB@@CAA@AA@A@@AB@@AB@B@@@B@@@AC@@BA@@@@B@BBB@B@@A@@@ACE@@BAAA@@A@A@ABA@@@@ADA@@@A@@@A@@G@A@@C@@B@@@AA@A@@@A@AB@@@BBB@@@@@BCB@@@BBB@@@B@BA@ABA@B@@B@@@B@@A@C@ABADEBA@C@@@AAAA@A@@ABA@ABA@CACACA@CAA@AA@AAA@ACACACA@@AA@C@ABA@@@AA@EFA@A@ECAA@AAC@C@ABG@@@A@A@A@@DABAB@@@B@BDBB@@@@B@CG@A@@@A@@@C@C@C@A@ACCCC@AAA@@@ABA@ABAAA@A@ABC@A@C@C@C@CAAACCCECCAAAA@A@@B@B@BA@AA@A@@@ACBCBA@@AAACCAAA@A@@B@B@BABAAC@ABA@A@@A@@AA@@A@A@@B@B@@CAEBA@CAA@AA@AA@A@C@AAAAACAAA@C@AB@B@B@B@@A@CA@AAAAA@@C@A@A@@C@@AA@AC@AB@@@BABABA@ABC@@A@@BC@A@@A@@@@@AB@D@BAABAA@@ECC@@A@@@@D@@A@AA@@BA@A@@AC@A@@@ABE@AD@@AB@@@@AA@@@@E@@@AAAA@A@@@BA@@@@@AA@G@A@A@@@A@AA@CBA@ALILG@AB@@A@A@A@AAIAA@C@A@AB@BC@A@AAAA@AAA@A@CDCDAFCFCFCBIHCDIH@@CD@@IHA@AAECCAEAA@C@ABABAB@B@DAFAFGNADCDA@A@A@CAAEAC@KAE@@AA@A@A@CACA@IEICCCCC@ACE@@CEKIGC"],encodeOffsets:[[113709,27653]]}},{type:"Feature",id:"430527",properties:{name:"绥宁县",cp:[110.155655,26.581954],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BBF@FBHDJFLBDFDJDHBBFB@@BB@BA@D@DAD@B@BBBBD@BDBBB@B@B@BABA@ABAB@B@@@@HDBD@D@BFBJ@@B@BAHABCAE@CBCBCDA@A@CBCDABA@A@AAA@@@C@A@ABC@@B@D@D@DAF@FBBBD@D@DBF@DFDDBDD@BBBAB@BABCDCDCDCD@DAB@@A@A@AACA@AOLKJEFOJIL@@AB@@@B@@@B@@@HBJFJHBL@HEF@HDBB@DDH@HBFFPFDBBPDNFRFP@FBB@DBBD@D@JBHHFJFHDL@P@BA@@LCFDB@DBDDBDBFDFHFJBHBJBLABAF@D@DA@ED@H@D@B@DABAFCHCBABC@A@A@ABADAD@DBD@B@@A@ACCEA@CDA@AB@H@D@B@BADAB@D@B@B@@@@DBB@BBDBBDDHHJHDDBBBBBD@@BDBH@@BD@FBBBBB@B@B@B@FAB@B@DBB@B@B@BADADAD@BBB@@BDDB@BBD@@BBF@D@DAD@@CBCB@B@DAH@BCB@@A@@AAA@A@@C@A@@BIDA@CDABCBCBBF@@@D@BBDBFBBBBD@DB@@@B@DCBGHAB@BBBHCF@D@DB@@DBBB@F@D@BCH@BB@B@B@DABA@@BBBBADAD@BEDAB@B@FBFBHDHBBADABCBADCFAB@H@FAD@@CDCH@D@B@DBDBD@D@B@BABA@GBC@CBCHCDADAF@@@BBBBDDF@HBLBL@FBDHPFLDDBDFBDBJAH@D@HBFDHDHFHDDFDDBF@JAFCFILKJMFGJCHDLDHPHLFN@HAFBDFBD@DFNFRJNBBDDDBDBBBBBB@DBHBB@DAB@DDB@D@D@FAB@BDBBBABEBADAFCNAD@FCLCDALALCL@NBNBH@J@F@LG@SHSN@F@LAF@HBJBFFLHHPHPJNDFDFDL@H@NHNDJJNJHHPDP@FDNDL@FBN@NENAFAHATHRHLFFBBF@PCHC@A@@DBDBFDHDHFDDD@F@DBB@BBFDAFCD@@HHBBB@D@HBB@@B@B@DBB@@DBB@B@BABADGDADAHCFALGJI@@FEDE@AAC@C@@@C@CACGGCEEEKGIEECC@I@C@EBCBABA@A@GCA@CC@A@A@ADCDABC@AB@BABB@BAB@D@BBBBBFBHBDA@@BA@A@AAC@@E@@A@@@A@@C@AACC@ABC@CFCBCBA@@DAD@F@DA@@BA@@CGAA@CBABABE@ACCEEAA@@@ADABEB@B@LBB@DANGB@BBDDH@B@BBD@HHB@F@D@DADADAD@B@F@FC@ABEBO@@AAA@C@BABADA@@AACCA@CBCBA@AA@G@AAACAA@@@ADA@AACAAA@A@AB@BBB@D@D@DA@CB@DABABAHEDGBC@C@AEE@E@IBCB@HB@@D@DAB@DCBCBCD@H@BA@A@CACACCC@A@ABCBABA^MBAHCDCFCFEBADECOIC@CDEDENQHAF@DH@J@FFDD@DEHGFG@EBEJQBADADCDCDCDE@CBG@A@ACACCAACCCEAGCEAC@C@A@@F@FB@@N@HCDGFCHC@CAG@EAIAGCIAC@AACA@AA@EAEACCECCEEEIACEECAACCCCECGCIEGEIEKGKCIAAEIEGCECCEACCECECGGEEGEIGIESKIIHGOIGMGYEKACKOAI@AAAEUAC@CACCEGIOMMIGGMSKMAAKKA@IIEEIKOQCEEIAC@@A@@AKQEQMWGWAAEUAOESAO@I@AAK@@AGEEEGEEAAGEOAI@GDEHCJCD@BADAJBJ@H@LAJBJBJ@D@HAFAFE@GBGECAIGGEEEEAAAI@G@GHAJALGJKJA@IFGAIAMGEKEOCMAI@@@@@A@CBKAMESGSCEACEIAICGAOAMBKBI@EFOFGBG@ECOAOCO@Q@C@O@CBEBCCEECGAEAEB@@@@IBQDGDODG@GBGBCDGFMBMCSMICOGOGOAIEEBA@CBAACEEECCCECE@CACCCOMAAEG@C@MBE@G@CFCDA@AD@@E@CCGACCECEACC@A@CBABEDGBKDC@EAC@EAC@@DAD@BCBA@ABCB@DABBDBBDDBBDFHJBFBBDBDBDBHDBBDD@D@@@@BF@PBLBNBLHJ@FBHCJEJCPBPMJWFABOFEBEFAL@LBPHRHRDJADA@QEKAI@MFGDGBCFBHFH@DADEFKBIBIFAD@B@FBJAJCHGBIAGAGEAAAAEGA@MIKCK@EDMHGD@@CBE@C@ECGCGAGD"],encodeOffsets:[[112493,27101]]}},{type:"Feature",id:"430529",properties:{name:"城步苗族自治县",cp:[110.322239,26.390598],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAOCKAMAA@A@C@C@CBIFEF@NBFBJBPFL@J@DCPCJCLINA@KHGDIDKFA@KHEFCLALCHGHGDK@IGGGIMGO@AAGACAGCKEIKECCAACBA@@@A@@@@@@@AA@@@@@@@@@@A@@@@@A@A@@@A@@@@@A@@@@B@@@@@AA@@@CBGBIHABIHSJA@EBE@GGGMGGEA@@EFAFCJGLIFGBMBIFADADAF@BDLJNDNBR@HBB@DBDDDBD@B@@@B@@DBB@@B@BA@A@@B@BBBDFDB@BBBBDBB@B@B@BBBDD@@@BBBBB@B@B@B@BDD@@BB@B@B@@@B@@DD@@B@B@@ABA@@@@@BBD@B@B@@@B@AA@@@AB@BABAD@DCFAFAD@F@H@HBDBHBDDF@BC@CBG@IBE@C@CCC@C@AAK@K@IBM@GCGECCC@EBEBEBABABAFILADCDCDADABC@EBK@AAICCCA@CAI@A@G@GDGFGJEJGBDHDHDJBJBJJLFHFFDBFHFFEH@@AFBD@DBDFHBDBB@D@@ADABCBABBD@BD@FBD@FBD@LCHAFCBADAB@D@BDDFDFBDDH@D@FC@@BCBED@D@HAF@N@DFHBBPNDDBD@DDFDFDDFFDFBBDAB@FAJFPBPHPHJDTNNDNAHEDCHAHAH@PCHCRCJA@@@@FAFBHBFDDFADAF@D@P@D@RDPBPDP@FAHEHEP@FAJALBNBPDHBJFJBDDFHTFTBNAL@D@B@@@@BJDNFPFLNHJBHBJEB@LIHIBKBIHGH@J@BBFBFFHFJHDBHFHAF@BEBE@G@CAIAIBI@K@GAIBIBC@ADCDIFGHCJ@PBHFBBFFFHFFBH@@BL@B@JBPFTBPFVBBHXNXFRLR@BB@@@BDFJDFPRJLFFJJB@LLBBLNNTHHNJPNHJDFBD@DBDFVBB@BBJLPBDFLHZHNPJGHNEFIHKBEFEFADBF@LBH@BALCLA@@BABANIHEFEFIBAFK@CBIDI@CBMJE@ADALCFBF@HDFB@DB@@BCFEH@@A@GD@BAFDDFDJ@NCB@DAJAJGFIBEHOLE@AL@HFBFBHBFHPDFB@BFLJBABCBC@ABCAAEECCACBEAC@AA@ACBIBA@AAA@CBABD@B@B@D@BAB@B@B@BDDBFFHFFBF@FBB@BDBBB@@BDFCBAJGLOHM@QBUCM@E@CAECSAEG]@CAMCMBEBC@AHIAEAIBI@CCECAGCGBGJIFKHIBIAEIAICQAICKGKKQIO@AGQGSMWEMACCGAECGGKCGCCAAC@@@@@AAK@KBK@K@ECCCAIBKAK@CAGAGAG@G@A@MFK@@BCDGHMJMFKBIBOCGAIAIDGDCDELCLCJEJGJCJ@PBFBH@HBHBFAHCHCJCJCBBAGKEEAECECIAIAIACEAIAICI@IACCI@QBQAKAIEOCCGEGICCGOEI@AGGEI@AIIIIGGEEKEGAGDEFCLGHG@ECEEGGCEKGMGGEGAGEGIEIGKGG@AQOMGAAMGMMIIKIIKGI@M@GCECCAEEEEGECGEEIDIFE@GAEEECG@CEIEMCKGIAICGCMCKCECEGIIEOEE@AAACA@A@A@AB@@@B@@@BBB@@AB@@AB@A@@@A@@@A@@ACA@@@@@A@@BAB@@A@@@@A@@AABA@A@@AAA@A@A@C@GBABEA"],encodeOffsets:[[112932,26590]]}},{type:"Feature",id:"430528",properties:{name:"新宁县",cp:[110.856988,26.433367],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BBBDFBDABIJCFAH@BC@G@UAKAICYCWAMAC@KIGEIEIAC@K@I@IBGBOBCAO@Q@KAMGOE@@MAKBEDIHIHABIHIH@HLLJJJLFHAPGRGHIA@@GAEIIMGKMEE@GFEJCJIJCBKDQFODKBOIQEMCQDIFIFANCNCFEBI@MIIIKIGOGICIAAIDIDGDGDEBGAGAG@EAOAI@IDIHIFKDKDCFCDCHBJBJDHAPAJELINGNCHAD@@EL@N@B@HBHBHBH@DBLALBJDDFDL@L@LAL@BB@@@@D@BBDDDHHLDHBFDHBDFNNXHTHR@BJPLRHLDLBJDRBJFJJBJALGJEHIHAHDDBDF@DAJBJBFGJB@JBFBFABAF@H@B@LBFBHD@@@@B@LFFAHCFCJAH@L@@@HDLD@@H@FCFSFGFAD@B@DDDNFLJHHBL@LE@@NCTBFBHBJBLFDHDFFPFHJFHDHAFEFIBEDEDIJEH@JHFFBHCH@B@BANAD@FCT@JFNBDDJ@FBJBJAJAL@PBPBHBBHDD@HBL@HCFKDEJ@FBDDFPBBFLHRBJDJ@BBLCPAFADCDIBC@E@CBCDABAD@B@F@B@F@B@DB@FB@@B@JBDBD@D@B@B@D@JBHBF@DBDBDBBBDABABCBC@ADCDA@@@@BABAF@@@D@D@HBLDLDFBFBFBBBDDDDBHDHFJ@DDFBDFPBH@HGN@FCJ@LENCLADET@BCRANBHDHHDFCHGLGB@LEL@DBPDJDHHHH@L@HCJ@BAL@L@F@FBHBD@BFT@DDLBBBBFDHDFDNGBCBB@BBB@BB@@@@AB@@A@AB@BA@@@@F@@C@@A@@ABAB@D@BABBBBBADCBAD@BBB@@C@ABA@A@CBA@@@CAAA@AA@@FABAPELA@@J@JCBAB@@@BA@@@@@A@@B@@A@AB@BB@@BB@@B@@A@A@ABAB@@@B@@@B@@@B@@@B@B@@@B@@B@@BB@@@DJADEBECAAEEAEA@AB@@AA@@AB@B@B@@AA@BAB@@@@CA@@ABAA@@ABA@@BAGAA@A@@AA@A@BCBGBG@ABCHADAD@B@B@@@F@@AB@@AD@B@BA@@@AA@@A@@@EAA@E@@A@@@@AAAG@CC@AA@A@CBIEGCCC@C@@DEHEF@@@NDB@J@F@DADB@BBBDBD@BCBADCDADCDADAD@B@@@@A@E@CBA@ABAHAF@HADA@C@ABADCDA@@BBBDBF@BBBBD@BDDFBD@B@B@FBFADABCBG@ABCHAD@J@DAHADABAPCHCP@F@B@@@DAD@BABA@E@C@ACAACAAAC@ABGBG@ABADCFC@@@CBCBC@E@C@E@ABCBCBC@C@ABABCAA@A@CAE@C@A@A@ABABAFCHADA@@HBH@J@@@LDFBNBBBJ@HALCD@BABA@C@CCEAG@EBEFGFE@CDADEBE@CDCFAD@FADABADCDCDO@C@C@CBA@C@CBG@CBAFCJAD@BA@AAC@AAA@ABADA@ABCACCCGCAAACAE@CAI@K@EBCBA@ABAAAAAAC@ABA@C@CAE@@ACIGAABG@EACEAECCEAC@EBIDKD[FM@C@CBSAGACCACAACAC@KACCCEAE@E@ABCF@BEJC@C@A@GKCI@E@G@A@AC@CBKFE@AAEAG@E@GBA@CAAACCAAIGCA@@MGCAACAAAAA@A@CAA@@AAGAA@@C@A@CBEBC@CBI@EBAB@B@BFFBB@B@BABE@CBABCBABA@CACAA@ABEHCBIBCB@@ADADBFBFDD@B@BCDCH@BBBHBFBDBBDBDAFABEBCDCBAFCJADADGJMPCB]X@B@H@TBH@FBDFNDJBB@@BJ@LBDBFBF@DEN@BADCBGB@BCAI@CCCACAC@A@C@AA@A@CBAFEBC@GAEAEAEEMAECAC@GBMDGBC@AAA@AAC@E@E@C@EAACEMCS@AAQ@GDG@ABO@OACCEAC@A@ADADC@E@M@C@ABCACAAEA@AAA@QAAGCAC@ADCDG@ABEAECKKUEEGCA@AAAE@CFE@C@AA@CCECOICA@CAC@EACCEEWAEAAACAA@C@C@CACBCHIDC@AACGEAA@GAICECCKIWFIDEFEFCD@D@F@DABEBABCFABC@A@A@EAAAG@KAGCKCCECC@CDCDGBKBEAAAAAC@E@K@EACEYACGCUCC@AA@ADADEDEBGDADC@EBC@ECCBA@AFEBA@EACCGCIEMCIAIBC@CAAC@C@GB"],encodeOffsets:[[113584,26886]]}},{type:"Feature",id:"430581",properties:{name:"武冈市",cp:[110.631884,26.726599],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@BD@@@B@@@F@DAB@BADADBD@BDJBDNFJHDD@BBBD@D@BADAB@HDDBDBFBJBJBBDDBNJFFBBBBJHH@@@@@HBF@LBH@HAHCBAFEFCLAD@L@FAFAFEDCFCDC@@HEJILGD@H@F@DBJD@HCJ@FCJ@DBFHHBBHDNBN@JBJ@L@FB@@DBFDDBDBFF@@BF@DBLBBB@JNB@BFFDDFB@DBDBRXBDFFDBTFJBFAB@BAAA@@A@@A@AB@B@@A@@B@B@@@@@B@B@BABEHAB@H@NBBBVB@@P@H@HADGDGFKJGDAD@HAJC@A@AAIEGGGBGDEBABAJEFAFAPFD@BBLDLHHJFJPLBAB@@@B@BAD@DBBBD@BBDAB@B@B@DBD@DDDBD@BBDB@@@D@DADBBBB@BD@B@BBB@@DBDBD@DBB@@ABCBA@E@C@E@E@CBCBABAD@@EH@@ABIHCDKJ@DEHAFAF@B@L@BBJCHCFAF@@@BAJD@DDF@JAD@B@@@B@@@@@BA@ABBB@BA@@B@@@B@@@@@@A@@@BB@@@@@@@B@B@@@@@@@B@@ABB@@@AB@B@@@@DA@@B@@@@B@@@@@BBB@@B@@@@@@B@@@B@@BBBB@B@B@D@H@NBF@H@DBN@LBB@JAHCFC@@DCBC@C@ABE@@@C@C@AB@@A@@BC@A@CBA@@BAD@FB@@BB@BB@HMFGFA@A@@@ABAFAAA@ABEFKB@@ANAD@@AAACACC@EBCDAB@@CAAEA@A@CBE@ABCB@@C@@BC@CCCCCAAAC@CBCBC@C@ABC@@AA@ACCEAEAC@CBI@A@@BC@ED@@CACA@@AACECGACAAECGCECAAAACK@CES@AACAG@E@E@KBK@ADI@G@KGGGGICOCCAK@KFA@KHGHEDGCCGAGBMDQ@AFSBCDKFM@KDI@EHM@GAGEOACCE@CEICGAGCCCCAAEAEAEAKCKCGAC@C@@@E@ABAB@@@@CBCD@BADADABCBAACACACAE@GAIAC@A@A@C@C@CAIAA@@@EAA@@C@A@E@A@E@ABCBADCDAF@D@JADCBCBEDOAK@ACIAIGQEKAAEOCCEAI@CFELGDK@GAC@GCAAAGAO@OBKBIAIAI@ECIACEM@IDS@EBCBM@A@ADGAGEEIGG@IFCJCFAFEJEFGBGCIEEGEOCECGKEIAGAEASAMD@@KFK@GAIGEKCMCCA@C@EBEHETEDG@@@KCGC@@K@G@IBEDGDEBKEA@@@@@GCEAKAA@G@E@ABEBEAIAA@@BADAFDNBN@DH^BFDTBF@D@FDNAV@RGNKPIHABEDAC@@AACA@AAA@EAEEEEGAECC@A@A@ABA@A@C@A@AACAB@DBB@BABAJBDB@@BBDAFBDDDFFBBAD@BADADABKIAEA@CEGOAEAGAEGEK@@BKFGPAFEJIHIBCBA@MDI@ECCCBE@AHCB@@@FGDE@AA@@CEAGCE@EAKDCB@BIFAN@DCJAJ@DELABEJEFGFMJABAB@@KBKDABG@KAE@CAEBEFAFGLEJMFJJTLJFJHHFFFHHFDFDDDFBDDDFFHFJBBDJHLFLFJFHDJDHDFDDBDDBFFBDFJFFDDDFBDBF@FBBB@BD@BBDDJBHBJ@FBH@D"],encodeOffsets:[[113193,27499]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("湘潭",{type:"FeatureCollection",features:[{type:"Feature",id:"430302",properties:{name:"雨湖区",cp:[112.907162,27.856325],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@AAA@CA@@AAA@CAAAECA@ACACCGAIAE@C@E@EBIDKDGDEDCEBG@C@C@@@A@E@EDAB@@A@@FAB@DAHCFEDEDEDABEAG@K@M@@@A@@@@@IFEDMHCBGDODIBMDCBIDGDGDK@A@EAAAA@ECCIKIGEGCAAGGAHEDEHAF@F@B@DHDBBDD@BEBCBWJAH@FCBCFCDEFADEL@LBF@@FLJDANAD@FCF@@CLEFD@FBJ@BBBB@B@@ABCBAB@@@B@BBDBB@BBB@@BAB@J@DBBBBB@@@BBD@D@DBB@FAB@D@DDDDFFFDFBDDDDDBDBD@D@B@BAB@BBDBD@D@DBDBBBDBBBB@DBD@B@D@DADAD@D@BD@BBBBBB@F@F@DBF@@FDF@HFBBADCDKH@D@B@BA@@@BDHABDB@@ADAABBABDBA@C@A@@@EFC@ABA@FBBAB@D@BF@D@AC@AB@DCCCAABA@A@@BAAABABBBBBA@B@@@@B@BBFBBADAB@BAB@@@@FAD@@BBB@B@BFBHBDA@ID@HAAC@@@A@@D@@AB@@@BAB@AC@AD@BABAAA@ABA@EBEC@B@@ABADEHEH@DBDBF@B@B@B@BBBD@BB@@B@FCBB@A@@BCBABAB@FBB@B@@B@DDBBABAB@BA@A@AB@DB@DB@B@BABA@@BAB@D@@DB@@BBAD@BB@@@@@@@CAAAA@ABAA@BC@@AAB@ACBA@@@A@@A@@A@@@@@@@@@AB@AA@@D@B@D@BAB@@@AAB@@ABA@@BA@A@CAAAA@@B@@@@A@A@@B@@@B@@@BAB@@A@@@@B@DBB@BA@AB@AABACA@CB@DAAAAA@CB@@ACCA@@ABAB@B@B@@@@ABABAD@@ABCD@D@DBD@DBB@B@B@@B@@@A@@@AB@@@@A@@@A@@@@A@@@@A@@BA@@AA@@BABABACCBA@@@@@@@A@@DA@@B@@A@A@@@@B@B@A@@A@@A@B@B@@AB@BCA@BAB@@ACACA@A@@@@@@B@B@@AB@@A@@A@@A@AB@AA@A@@@EA@AA@AB@@@B@BBB@@@@ADAB@BBBABDDB@BBAB@BB@BB@@BB@@BA@@BABBD@B@@ABD@ABA@C@@@A@@@@B@B@BBABBB@D@A@BBAB@@@@B@@@@B@ABB@@@EB@BB@@BB@A@@@ABAB@@A@A@@BA@AD@@CC@@@BCA@BAB@B@@BB@B@@FB@@@@@B@BA@@@@B@BA@@@AB@@C@A@A@@AAA@@C@@@AB@@AB@@@G@AAA@@AA@A@A@BA@@@A@AB@@@AA@@B@@A@@BAA@AA@AB@@A@@B@@AB@@@B@@@D@@BA@@B@B@@@BB@@@B@DDD@BBB@D@BADC@@D@BCAA@CB@DBBA@@@@D@@BB@@B@@@B@@B@BB@B@@@BB@@AB@@BB@@@B@@AB@B@@BB@@@BA@@BA@@BCB@B@@BB@B@B@@BB@@BD@BB@@AB@@@B@BCACB@B@BB@B@BB@@B@B@@BB@DABBB@@B@@@B@@B@@AB@@A@@AEB@@@D@B@@BA@@@BB@@@@BB@B@@CB@BA@@B@@@B@@AD@@ABA@A@A@@@AAA@@BA@@@A@A@@@ABA@@B@@BB@@B@@@BB@@BBB@@@@B@@BBB@BAB@@@@@@BA@@@@@B@@@@@@@@BAAAB@@BB@A@@B@BDAB@@@BB@@D@@@B@@AB@BA@@BA@@B@BA@AB@@AB@@@@B@BB@@A@@B@@@@BBB@@@@A@A@B@BB@@ADDB@B@@@@BBA@A@@@@@@BBBA@@@A@@@@@@D@@A@@@@@BB@@AB@D@BEBA@CBCDGFEBCDADABA@@B@@@B@@@DCJGFCLGHCRKFCDCBALKBC@@DCDA@ABEBA@C@C@A@ECG@AIGIG@AA@ICGAGAKGCEAEAEAC@@EG@@CCAAAECMAEAEAGAC@A@AA@EK@ACCEG@@CCA@@AAAA@@@MGAAECA@MG@@MEKEE@@A@@@@CA@@EAEA@@"],["@@CAA@C@CAAAA@@AB@@@J@BAB@@AAC@@C@@@A@@B@@@BA@@@A@C@A@A@AA@C@A@@AAA@AB@@@@@B@@@BBBAB@BBBDB@BBD@@BDBBB@FBFAD@BCAA@@"],["@@B@D@F@B@BA@@AAA@GACCC@@@A@@B@@@D@DB@B@B@"],["@@D@BC@@BAAC@AC@A@C@@B@@@@A@@B@B@B@B@BB@B@@@@@B@"],["@@@@ABCDCD@B@BB@BDFBF@B@@ABC@@BCBABA@AAAAAGACB"],["@@A@@@C@A@ABAB@HB@AB@D@BCB@B@B@@@BA@@DB@BDDBL@FBD@B@BABA@A@@BA@I@A@C@GAAAAA@AAI@AA"]],encodeOffsets:[[[115616,28517]],[[115423,28526]],[[115363,28488]],[[115376,28494]],[[115383,28498]],[[115365,28503]]]}},{type:"Feature",id:"430304",properties:{name:"岳塘区",cp:[112.969479,27.872028],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@GAAAKAKCGAEAA@E@EAI@CAK@K@EBC@GDGFA@GDCDCFCHCLAJ@F@F@DBFBJDHBDBDB@FDBBDBB@BB@@DBB@BB@@FBFB@@DB@@@@@BF@LFNF@@NHB@FDBBNH@@B@BB@BB@DD@@FHDD@BFLB@@B@BBDBHBFBFDNBFBBDD@@FH@@BDBFBFDFLHHBHBJDB@@BJHJH@BDH@F@B@D@DABAF@BCBCD@@ADDBB@DDFHB@DBB@FABBD@BBDFBB@BCDAB@B@B@BB@BB@@D@FABABAAAAAAA@CBABA@AB@B@D@DB@B@F@@@BCFCFCJ@DBB@@FDDFDD@@DHDB@@LBD@^FH@HBFA@ABABCHGFEBAFC@A@ABABCBABA@AAAACEECCCCEAACC@AA@AAAAA@A@A@@BAB@BADBDA@@@A@ABABAB@DADBDBB@B@@A@@@CACAEAC@CACAC@@AAAAAAAA@@A@CBEEOIGQ@@CM@EAIAIACA@CGGI@AIO@KACAM@@@AGECCACAA@A@A@CBA@@@@DAB@@@@A@C@E@AFI@@@A@@@@BEC@GACCIGECEE@@@@A@@@MIAAIGCC@@AAEICCCCIC]IKC@@EAAAGAQC"],encodeOffsets:[[115674,28467]]}},{type:"Feature",id:"430321",properties:{name:"湘潭县",cp:[112.950831,27.778958],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@C@EBEAA@AB@D@BB@@DDB@B@BABBD@BBB@BBBB@BBB@EFBBB@@BDBBAB@B@BBABBBD@@@AE@KFKBCFECC@EB@ACAB@@CDBBA@ABAABCA@BCAAABAAC@BBAD"],["@@@A@ADCDCBA@@@ECAAAB@CAADC@A@@@@@A@A@@A@A@A@A@AB@@@@@@AD@B@CAG@@@ABA@E@C@A@A@A@A@@C@CGAEACCABABC@E@A@C@EB@@CBC@ABA@GHA@A@GGACCECCCCACAAEECCAAECAAC@@DAHAD@H@BBBBBBBBDBB@B@BABABEBA@ABCDABEHADADAB@BALAD@D@D@DD@BADADABAB@@@@BBB@D@H@B@B@@@B@@B@J@FADAB@@@BAB@@@BABA@@B@B@BABA@ADABBDBBFB@DBBBDDBBBF@D@BBBDBF@B@D@B@DBBBBA@A@AB@DB@@@CBAD@B@BCBBBCDC@A@@@ADAACA@@CB@@A@@@A@ADA@A@CBAA@@GBABAB@D@@@B@BBJ@BBB@BBBAF@"],["@@AD@@AD@BA@E@EAACA@E@ABBB@H@D@B@JAB@@@BABABA@C@EAK@CACB@B@@@BCDADAAADA@C@AB@D@@CAA@@B@BAB@@B@@J@BAD@B@BBDBD@B@@F@DBD@D@@@BBBDBD@D@D@@@D@D@BBDD@BB@B@B@BADABAB@DAFAD@D@B@D@BAD@BA@CEA@A@ABEDAB@B@BFJ@@@BDDBBBBDDBDBD@BBDDBF@D@B@BABADC@ADAFAD@DABA@@DABADADADADAD@BA@@@AA@AAAAAAA@AA@AB@D@F@FBF@F@DBF@@@D@BBB@@@BABAJIBAD@H@D@FEDK@@DE@EBCBMICEKC@AABAAAA@A@ABCA@AA@AAFEA@AAA@AA@AAA@AACBA@A@ACA@CA@@A@CBAAAAC@@AC@ACAAA@ABAAA@A@@@A@@@@BAB@BB@@@B@DBBB@B@D@B@@@B@@A@@@AB@@@D@@@BD@BA@ABI@@@A@@BB@BBDBD@B@DB@@D@BBBABBADB@ADBBBAB@AADC@@BABDA@@FDDDCDEDA@EBGXIDAFA@ACCAAGC@C@A@EBEFGFCBGHHBBHDHFLJDJFDB@BBFBB@L@HCHCJCDANCJAPCHCDANGFCJE@@@@B@@@N@L@H@FBBAFCFCFCDEBG@CBA@EB@@@BAFCF@B@@@D@D@H@FAHCB@HEHCD@FAL@L@DBJ@FBF@B@FBHBLDLBBBHB@@RDHBBBFB@@LD^JJDAA@@ACAC@I@CBCBAD@BADADCB@B@@@BBBBB@D@B@JIBAFMFIBCFKBOAEAGEGAEAABGFCJA@@BANCRKFE@AB@BAB@@ACK@@AEEECGA@A@A@ABE@EAC@C@EAGBA@A@@@A@C@@@EICIGG@AAAACAA@@ACCCACCCAAC@CAA@AAAAAACCA@AAAA@A@AAC@G@ABCBA@@BABCBADC@ABAAC@AB@@AB@D@@@B@@@BBBBBBDBB@JH@@D@D@H@DABADABABCBA@A@AC@AAA@CA@ACG@ABCB@BAB@BADCDAFCDC@ABCBABA@E@A@@@CCAGA@@C@AAC@C@@@AAA@@ACGAAA@A@C@CBIFCDEF@@ABA@A@CACACAAA@@@A@A@A@ABA@C@A@C@C@@BADAFADABABA@A@A@AAC@@A@AAC@AAAC@AAA@CCC@@AAGAA@ACCEAAAAAAC@A@A@CB@AAAKICC@A@CAGDMDKBM@M@A@@@A@AAMEOEK@AFK@@HGL@LE@AAE@CAC@AAAAACAAAGCEEAA@EAAAA@AB@BADAAA@@@ABABAB@DAFBBBFB@@BA@ABAAC@E@@@A@I@IBA@CBCDAB@@@B@BABABC@A@C@AABMBK@GAMEICUGGAWEQECCGEEE@A@CAEBC@KBO@EBE@I@K@IBKCGEKA@C@CBEBG@GBEBC@CAE@EAC@AAEAGAC@C@C@E@C@C@AACAACAAA@A@ABCBC@CBEBE@C@C@CAEBE@C@CAA@ACAEAA@CAABABCBC@A@AA@C@E@E@C@C@ADADABA@@BCAAACAAAAAAAC@A@ABABCDAB@BBBDBD@BB@D@D@DAB@D@BAF@D@@EBGDA@A@CCCAA@@@ABABCBADABCFADCBCBCBCB@B@BBB@D@B@BAF@DAB@DADAB@@A@ABEDCB@@CB@@@BAB@DAB@@ABAACCCCA@CAE@A@CBCBE@EBC@AAA@@ACAAECAC@ABABCDADC@EDCBCBEDGBC@E@EACB@@C@A@C@CAEAA@A@@B@D@BAD@BCDCBCB@BADBB@B@DBB@D@DABBBB@@BBBDBBBB@BBBB@BBFBB@F@BBDBDBD@B@BAB@BA@@@E@C@A@C@EAE@A@@BAB@BA@A@C@C@AACCAACAA@A@ABABCBCBADCBAB@BABB@@B@@ABCBA@A@@@A@AA@@A@AC@AA@AAA@AB@BADA@ABAAA@A@@@AB@BABA@@@A@A@A@C@A@C@@@EDA@AAA@@@@A@@A@@CAECECACC@@C@CAE@A@C@CBCBAA@@AABA@EBCA@CAAACAAC@C@C@A@CAAAACAAAC@EBA@ADADABABABA@CBC@AACCA@AAECECECAA@ABA@@BC@C@@@@C@A@C@A@CCCCACAACACAABA@@BAD@DCBA@AB@B@B@D@BABA@A@C@EAC@ABEDCBCBABABCBADAB@BGD@@GFIFKDCBIBC@EDABGFEHAFALALGL@BENGD@@AD@@A@GACACAC@ABADABA@C@AAABAB@BA@G@AB@BA@BBB@@@BBBBB@@B@B@@ABAD@B@DBF@@BBB@@ABCB@B@@BCDADCJ@BABCDA@CBKDBD@@D@DBBBDJ@D@BAD@B@BB@BHAH@JANDFBBB@HBJCFAB@B@HAHB@BHFFFJDJBJ@D@LBH@BBDFDDBB@BDFJF@B@@JFF@B@F@DEFIHCJAJ@N@JFFB@@FABCBC@A@A@A@E@MBABA@ABA@E@ABA@CB@BABADAB@BDDN@JBBB@@BDABABADAD@BBBBDBBBBB@@DA@@BA@@BAB@B@B@DBDBBB@DDJ@BB@D@BAD@DAFAB@JAD@FBBBHBBB@B@BAB@BAB@B@D@@A@@B@D@F@BALEFCFOL@BCD@@CDCDMJELAJAPBN@BDFDDNBVHHF@D@HAJABAHKNILIL@@EJ@H@BBDHFHBLAHCFAFARCJBDBBBFFFHBJAHCHGDEDEF@@CHADAH@@@@AFAJBJDL@DDLDD@@FB@@FDHBHBH@B@F@F@FAB@D@@@@BAD@BAB@B@D@D@BCF@@AHABABABCBA@ABCJBB@@BBFDBB@B@B@BA@@@DDFBHB@@@AB@@@D@DDHBB@BBH@DBD@@BBDAB@@DBA@BBDB@FDAHBBBBB@BABAB"]],encodeOffsets:[[[115423,28529]],[[115378,28505]],[[115389,28503]]]}},{type:"Feature",id:"430381",properties:{name:"湘乡市",cp:[112.550205,27.718549],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BABCAA@AAA@CAAE@@A@A@@@AAAA@@@@ABABABA@A@AAAAAA@E@C@A@AAAA@C@AB@BA@A@C@AAACACAE@C@A@@@AAACEAC@C@@@@@A@AAAAAAAA@AA@@AC@A@A@@BC@A@A@@@CDA@AB@@@BBB@B@@AD@@@@A@@@AC@@@@A@@@ABA@EAA@@@@BAHAH@@ABA@@@A@AACEA@@@A@A@CDA@A@@@AAGGAAAAA@A@@BAB@@@BBBB@BBB@@BA@EBABA@A@AAGECAA@A@A@A@CBC@@B@B@B@D@DADADA@@@A@A@@@@B@DBBBB@B@D@D@F@BBB@B@B@D@@DD@B@BAD@B@@BD@BDFBBHBBB@BDF@D@B@@BBLPFFBFFHBBDLBLBLAJAFADBNCJCBABEDIDKFKHGD@@KFONABEFEDAJA@@H@BDDJDPBH@B@HBJ@BBFBBF@D@H@JFD@BDFBJCJELGFEDCFEHIHEDABC@EBKCGAAAG@AAA@K@EF@@CB@@GAEBGBCDADABA@A@C@A@@A@A@AAK@CCG@E@C@@ACAA@@A@CBA@A@CAAA@A@C@GAAEGIGAAA@A@A@CL@@@@AAAA@@ECGCAA@@@ABA@A@ACM@C@CBA@A@A@@C@GDA@C@E@CA@@CAC@CAO@CAA@AB@@BD@BABA@CBEBEFABAACAAAAA@C@CAC@AAAC@CBCBABAB@BAD@B@DAD@@CFADA@ABC@C@@@C@AAAC@ACA@ABCBA@AAAAA@@CEA@CCAAAACCA@AAC@C@C@CBADAL@@A@A@AACAABA@ABC@CCEC@@@@CCCCEE@@AA@@A@A@GDA@MD@D@BAB@BABABABABABABC@A@A@@B@BBFDFBD@D@B@BABA@A@EAA@AB@@@B@D@H@@A@A@A@AB@BA@A@@A@AAA@AA@C@@@A@@@@BADA@@@A@@A@G@@A@@@A@@B@@@BBB@@@B@BAD@@@F@DBDDB@BFB@B@@@BA@ABCB@B@B@@BF@B@@ABG@AB@B@BBDLLBBBBHBDBD@LDBDH@H@FAFABC@AB@D@F@HB@DABEBEBC@CB@@@DBDBBB@BD@B@D@BDBDBF@D@F@D@DCBA@E@C@A@AAA@C@CBABA@ADABBB@BB@DBFBTBLBBDDB@HDL@HDDB@B@@ILODCDABCHA@EBEBMBA@@BADDT@BHJDHDFABCRAFCHCFED@@GDG@A@GEECGECAC@EAE@GDEFA@EFEB@@A@ABIFAJAFAF@BEN@H@DAHAJEDECAGAK@AAEEAC@CDCDEFEJ@@CN@J@B@LADBBBB@@@B@B@DADAFADADCFCH@@CJAD@DADAB@BAB@F@BABFBD@D@BA@@D@B@BBB@B@@@BA@ABAB@BBBBBDBBDBD@B@B@BBBBB@BBB@BA@AAGB@B@@@BB@@BB@DBB@@B@F@FABABB@@BBB@BBB@B@@BDHBBB@BABAB@B@DBBBB@BABABADAB@B@@AH@TIFAFADC@CBEDIBABABA@@D@D@D@D@@@D@FBFBFBB@D@DB@BBBBDBBDFHLDBBBHAB@BA@AACBA@CB@DBDBB@BBDAB@B@@@F@BBDBBD@DBB@@FBDBB@@B@BCDCD@DBDBDDDFDFDDBB@BABAB@@@BBAH@DB@@BB@HCBAD@B@BD@DAFBDJLB@DHDH@B@B@D@BBB@@BBBBBBBB@@B@BA@A@AAE@A@ABA@@B@@A@AB@B@B@D@BABA@ADCBAB@B@B@@BB@@BBB@@BBBBBDBB@BB@BB@@BB@BABAD@B@@BB@BB@B@B@B@BABA@ABA@A@ABA@A@ABAB@DBB@F@FBH@D@B@BAB@BAD@B@B@B@B@BABA@@D@B@D@B@DBBBHBJDFBXFFBDBDBD@F@HBDA@@BABEBABIDCBAD@B@BBLFDBFBD@DAB@@@BAFGB@@@BAB@D@D@B@B@DBBBHD@@@B@DBBBBD@B@DB@@DBB@DBJBD@@AB@BABAF@FBD@@@BABC@AD@D@@@B@@ABA@ADB@@D@FBD@BBBBDBB@@ADCBABB@@CJ@BBBB@BAB@@A@C@G@@B@@@BB@F@D@DBB@B@@AB@B@D@FBBDNBH@B@@A@C@A@@@ABAD@BA@ABA@CAA@C@C@A@@BA@@B@D@B@BBBB@D@HAB@D@BD@B@B@BBDBDBDBBBBBBADADADAB@BBD@D@DABCDB@@BBDBD@D@BBBBDDDDBB@DABA@B@FBD@BDBFBFBBBD@F@D@BBFBBBB@F@D@DADCDCDADBDBDBDDFFFBD@D@D@DCBCDIBCBGDG@AFCFCJEDEFA@AAACAAA@C@CAC@AEAAA@A@AACBC@CAE@@YSKEACGGCGAK@A@GBI@ABAAA@@@@EBACCB@@EAI@OCJKIG@EB@@AA@A@@@AB@DA@@@@@CCCACC@@@AA@@AA@@@AB@BAB@BBB@B@@AAA@@@A@A@@@@ABC@A@A@AA@@M@@@AB@DB@@@@@ABEAE@AA@@A@AB@B@B@B@B@B@@A@A@AAA@@A@ABAACEA@@ACECE@AA@AAC@CAA@EAE@@@EACAAA@@@ADCBA@E@ACEEEAA@A@ADGBC@A@ABA@AB@BBF@DD@@@@B@B@@@@@DBDDDBF@@@B@@@@A@A@@@A@MBCBCFEBA@@@@BABA@A@@B@BAD@BC@A@EB@@AB@PQLIFCDCJGDBB@PDDT\\JSF@F@DATRDBBB@RLBCDCBAJG@@\\ONC@AHALFLBB@@@F@FDH@HCFGBEBA@@@@BCHCFAHAFABABADA@C@C@CBCBK@ABABCBCFGBADCBAB@FABABA@A@AAAACAAAAAA@A@GBCBG@CD@BBFDBBDDFFBBBDDDDDDFBDHHB@B@HGB@BAD@DA@@FAD@B@F@D@BABA@@B@@A@A@AAAECAA@@AADIBAB@DABABABABG@@DE@A@C@C@ABA@ABC@A@@C@A@EBE@E@A@G@GAGAEC@@EA@@CCCK@CCKAIBIBE@@@@BGBCDG@@FEFCHCDGBGAIEGEEAACAIAQDEBEBGDKBGAGEAC@A@GFI@@JKJKLMBGBABI@G@CGEUGMACCCE@AAMBOBIFKNIDCDC@@DC@APKDEFEBK@A@E@C@AB@@@@C@ABA@ABA@A@AAAGAAAEAC@IBA@EBCBC@ABC@A@@ACI@CAACACAA@A@A@AB@@AB@@CB@@AAAACAAAAAC@CBABABCB@AA@AA@ICMACA@CBABABA@@DAB@B@FAB@BABAB@N@F@B@B@BADADEB@@EAIEM@I@IBGDEJCFE@A@E@IE@@@AIECE@AAACCCEAAG@KAC@I@IAICEEGE@AGAGBA@A@EBIDGAA@AACEBM@IBGAGA@@A@ABC@A@CCIAACAC@@@ACA@E@@@CAEACAC@C@A@@A"],encodeOffsets:[[115104,28185]]}},{type:"Feature",id:"430382",properties:{name:"韶山市",cp:[112.52667,27.915008],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@A@G@CAA@A@@A@ABCBCBABC@CBABABEBGBEBGDAD@@@@ABAFEHGDG@ECE@@@A@KAKEGB@BMD[P@@IHABCDADQKA@AAQCBS@C@ETE[ICSOCA@CAIHCDEDKJORA@@BA@@F@BADC@ABA@@@@BABAB@@@@ABEFADAD@N@B@@@B@B@@A@@@E@CACCCA@@@@A@A@@@@@CCE@AAA@@BAB@B@BADCH@B@BBBFFDF@B@FABCD@B@@BBDBFB@@F@FBB@DBD@BBB@@BDFDF@BB@DFBBBAB@@@BB@B@B@BA@A@A@A@A@A@@B@BB@@BBFAF@B@@@@CAA@@B@@@NB@@B@B@BAD@B@@B@B@@@B@BB@@@AAA@ABA@ABA@@B@@BB@@B@@DDDBDD@@@@B@@CBA@@B@B@@BA@@FJHILPDJ@FB@@DABDFA@@@@BBAB@BAJ@H@BBLDHHHBDLFZT@@BF@DADBD@B@BBBFB@BBD@D@DBBDBBBDCDAB@BAHCDCDABA@A@@EAC@ACACAC@C@EBE@CBCBCBAAA@@BA@AB@D@B@BBB@D@D@F@DAFBB@DABA@@@CBC@C@E@ABA@C@@BABA@ABABA@@D@D@D@DABAB@B@DAD@DBBBB@BBBDBD@BBBB@BABA@CBC@A@ABCBAB@BADAB@D@@ABA@A@@@A@AAA@ABA@@BAB@BAD@B@D@B@B@F@DCFADADABA@ABA@A@A@A@AAAAC@AACCC@A@A@C@A@ABC@@BABCBABA@A@AAABA@@@A@@BA@ABAB@BA@BB@BB@@B@BA@@@C@EBC@A@@DADAB@@AAAAAAA@C@A@AB@BAD@D@B@B@D@DAFCBADABABCBA@A@@BA@C@ABC@A@ABABABAF@F@@@@AACAC@A@ABC@A@IA@@@AACAA@C@A@E@CAAA@A@CAEAACCAACAA@AECAAACB@BABABA@A@@@ABAB@@A@AB@@A@CBEBI@A@@@@A"],encodeOffsets:[[115296,28522]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("湘西土家族苗族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"433101",properties:{name:"吉首市",cp:[109.698015,28.262376],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@AACEACCACCCAC@A@@BAFBJAD@@@@IJGL@BBD@D@DCD@B@@@DDD@J@FEDABAF@D@D@BA@@@@@AA@@A@@@@B@B@F@BC@C@CAE@EISJGAICC@@@CB@LEDKBMDC@I@C@CBEBEAABCAC@E@A@CACCAAA@M@E@EAAAAA@@BC@A@AAACCIECCACAAA@E@CAA@AAAE@AAAA@CBABCHADCBC@G@C@MEEACEE@C@E@ABC@C@CB@@@B@D@B@FCRA@@D@DBDBBDBD@P@FBBB@B@BA@GBE@A@ABAFCDKA@@ABAF@FAFAD@BBDLBNFFBFF@FCHCB@BABA@ABE@A@ABBBBDB@@BABABGBEDCDADAD@F@FAF@H@B@D@DAH@B@BDBBBBBF@BBDDDDDDBDBJDFBD@BA@ABA@EAIAE@G@AD@@AP@PDH@@BB@B@BMBCDCB@BBDDDBDAFEFAFAB@B@BFBN@H@HDHHB@@@H@B@F@B@D@DADAFCJE@A@@BA@AB@DA@@FCDAB@B@DBDBB@DAB@FCBABAJADABABC@ABA@AB@B@B@D@@B@B@BB@BDBBBD@DBB@BBBFDBBDADCBAEOB@@CDAFBB@@@BAB@B@D@@BB@BBBD@B@HBBBBNJBDB@D@@C@C@ABAB@FBDD@B@@CBEBAB@@BBB@DBBBBBBDBBDD@B@@CAA@A@@BAF@DA@EAC@A@CDABABBDBD@@DDB@@BJ@HBFDBD@JDD@@DBBAD@BBDDBDFFDBFBD@JCJADBFH@F@@F@DBJDNFDBFDDFFDDBF@D@FAFAD@D@BBDBDBDDFBH@F@DBFBB@DBF@F@DALCB@D@B@DBB@BBB@DABAB@BBDBBBD@B@HAFCB@B@DBJBFDFFBBHHFJBDBJ@FCD@B@B@B@BBDDBDBFBFBB@F@B@BBBBFCJ@DCDGDCBCB@D@B@LJFBFBL@DABADEHMFEDID@VJB@H@HABADAD@B@D@B@@@BAAAAC@ABCAAA@KECCEC@CAECEGY@IHEHGDAD@@ANCLCJCJA@@BANBPBLHPJB@DAFABAD@B@BDJH@@DBH@DBFDLLHN@DBBF@D@DB@@B@@HDDDBD@HAJAHDN@D@B@DBLADBJHBFD@H@F@D@D@FADADCDCBC@ABC@CCC@@CA@@@ABABAB@BA@@@C@A@A@@AAEEAA@@C@E@C@A@A@C@A@A@A@CDA@AAIEAAC@E@K@AACACEECCCCAAC@@AG@@@E@CAI@@@C@K@K@EDGDELIBADC@A@C@AAGGKACAA@@@CBCBCBAD@BA@A@ABA@AAA@@@@AAAAI@@AA@@@@A@@BABADAFC@@BA@A@@@AA@AAC@GAC@@AA@@C@A@@GA@A@AAEAAA@CBAAA@ACAE@GACCECCAAA@ABCBGDGBMLEBC@A@AAAAACAAC@CCCCAAC@C@CBEBC@A@CCACEEEC@@ECCAAAE@C@A@C@CBCD@BAB@BA@A@A@CEEECACCCCA@A@A@GBABA@C@CAA@A@EBCBGBA@@AAAA@AAE@EEGOCAA@A@CAAAGKEGIIAE@E@A@EBEFGFGBA@C@ABA@ABCAAAEGGAA@CBCBC@A@CEGEI@@AA@C@ABADA@ABAAAAE@ABC@ABC@AACAGCECAC@C@GBI@E@AACC@A@AAG@@ACECEAE@C@EBEB@ACAAAC@A@E@GDIDC@ICC@G@E@AACACCEICACAEA@@@@GCG@C@@@ERADCBC@A@IACAA@CBAFEHABABC@"],encodeOffsets:[[112353,28831]]}},{type:"Feature",id:"433122",properties:{name:"泸溪县",cp:[110.21961,28.216641],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACGCWKKEG@EBEDABIHIHC@AD@DDJ@BDDAFADEDGBEHEFCBEAIGIGC@AB@B@B@FBPAFADEBCBABADCBEAACACDC@CACCACCC@C@@B@D@FADA@A@@@E@CBIDE@ECECC@C@GBECCCAA@CB@BC@AAAC@CC@EAEAAE@CBEBIDEFCDK@GBKTCDIFKFEFGHGDK@K@C@CB@BHJDFADGBI@MAG@GEEAGAI@KAE@CACECAA@ABAHGLELCDABC@E@BLDBHFFF@FAF@JBHBFB@DDFDBDBBDDBDBFBBFLFJ@H@FCDCDIHCF@D@V@B@B@BCBCAE@K@EBCBGAABADEJAD@BCBCBA@E@A@GCC@C@GHABC@EBEBCBEBA@CBA@@ACAACCCCCECA@A@A@A@IFEDEBA@EACCGAGACCCACAI@GBEDEFCL@J@DHDFD@D@B@BIDQJAB@BBDBB@@FDFFFRFNDJDBBBDDJDFBD@D@FAH@JI@@@@BCAIBE@AB@D@DBDDDBBDDFBBD@D@BABAFGBEDAB@DBJBB@D@DABCFQ@@D@H@HD@@@@FBDBDBFJDDDBBBF@H@D@JDD@JCHCF@B@D@BBDB@BFAFAD@F@FBFDBD@@BH@B@BDDBBF@J@HAD@D@DBDFBHBD@BAD@BAD@BBFBBAB@BCBAB@B@DBB@@FJFH@D@BADAD@DBBHHBFBBAD@BAB@B@DABEHEHAF@F@B@FBFJJFHHLBBDBB@B@DBHPFFF@BBB@BB@BB@HADAFAB@B@DBD@B@BAHAB@B@B@DDDDDBFFDFB@B@B@@ABA@ADCDAD@B@D@F@BBDBFD@@FDFFBDDDB@D@FADAD@D@BBDDDDD@BBBDBBBBB@D@FANKHAHCDABAB@BBDDDFBD@HBFBDB@BBDAB@BBBF@B@BHB@@@B@DB@@BD@HBD@BBB@@B@@@BAB@@EDCBABAB@@@B@@B@@BJ@BBBB@@@@BB@BAB@B@BABC@ABADAD@D@@BBBDHLBH@B@D@BCDABKJCFCH@F@L@L@D@@BJ@D@F@@BH@@BDDBDDFDDFDBBBL@F@D@BBJFBBB@DCB@B@B@D@B@B@D@F@D@@@BBFFBB@@@B@B@D@@ABA@ABABD@H@DBB@BD@DA@@FBHBHBDDDBDdHL@RBHCFEDCDEBE@CAEAECI@C@KBGHKHGFCFABCB@NQBCHGDEFAB@BBBHBDDDDBDAFAJGDCDCFEBAB@FAHAF@BADGHGFIJAF@F@FBD@DCBC@EBCFC@C@AACKIEECAA@A@A@@@C@GAGAEEAKAKAC@@AAAAC@@C@M@MFIFGDG@CAC@AA@C@A@E@A@CA@A@CBEBCFEFGBA@@@AAAA@@A@ABADCBCFCNEFAFAD@B@B@@@BA@CDI@ADAD@B@DBBAB@DABCD@BBD@B@LEFAHEBCBAAACA@@@ADGHKBICKACGKCCCAE@G@GBEFEF@BA@A@KCY@C@C@AAAC@EBALCFEBEAKAEAEIEQGUGKI@A@CBCFCLIHEDE@GACCCEA]CQCCAEC@C@ABCBCPKNGBCBCAC@AAAGGAACAAAMECAE@A@I@WBA@A@G@K@@@E@CAAA@C@ABE@ADMDCFGFGDCTKFCBC@CCA@C@ABC@A@AAA@@CBA@AAAA@A@C@AAAG@E@A@A@@@ACBADCDGDEBEBCAA@AACCAAACAE@A@A@A@@AAA@C@AAACECC@EAK@G@A@A@CACCACAACCCACCCAAICE@E@C@A@A@AC@CAAA@A@CBCAC@GACAEGEGCCECG@C@AACAAC@A@ADCDC@E@CDE@ABA@C@ACAEEAC@C@CCCAA"],encodeOffsets:[[112687,28591]]}},{type:"Feature",id:"433123",properties:{name:"凤凰县",cp:[109.581083,27.958081],childNum:1},geometry:{type:"Polygon",coordinates:["@@GHC@E@KCAA@AAA@@C@A@GDAB@BADA@@@A@AAAAA@C@@@CBA@MCE@C@EAC@A@@D@D@BD@JFFHDFBBADABEDKBA@EBA@@@FHBBAB@BABAFBJABA@EBCBEFGHABAB@B@B@BCBA@A@E@E@A@A@@B@@@BBB@@@@ABG@AB@BBBDFHHB@@@BB@BA@CBC@EAA@@@A@A@BBBDHHHDD@@@BBA@A@ABABABBD@BDD@@DD@FEH@HBHJR@BBB@DCDADGHIHCDIHCF@DBDDBHBHD@@BB@BABC@I@E@EAAAC@@@@DBB@D@BCBCDAH@D@F@BDH@DBHABCHGHCF@DFR@DADADAF@B@BBDBB@BADCD@BCD@FAH@DCDEHAD@B@BBF@DDFDDF@H@B@BBBB@BB@D@B@@B@B@B@BB@BB@BAHAH@BAFBDBBJFDBJHHFJFDBD@D@L@JBF@DBB@@B@@@@B@@B@B@@@B@@@B@@BB@@@BB@@BB@@@@B@@BB@@@@@D@@BBB@@B@BB@@@@B@@@@@B@@@B@@B@BB@@@@BD@@@@B@@B@B@BB@@BB@B@@@@DADCBCDCB@@@B@BADADCBEBGFABADABC@G@EB@BABCDEDKBE@AAEG@@@AAIA@CICICAGAIAGCCECE@AAAE@C@C@AF@@@HBJDT@J@D@@DN@NBHBBHHFFBB@D@DAD@F@BBH@B@FBDFDJJHHDL@LFJFFHHBHAFCT@B@B@DDBDBFDBN@B@FALLJBBF@DBB@BBBDBBBBB@BAB@BC@C@EBEBAB@DBBBDBDBDBDBB@@@B@HAF@DBF@JBFBJ@FBD@BADABAB@H@H@BB@@@JAN@BB@BBDBBB@BBD@D@D@B@FBB@B@FDJBHBBBBB@FBD@DBD@D@DBFDDD@BDD@B@@AB@B@BB@B@B@BA@AB@B@B@@@BBBB@D@@FJ@BAB@BB@@BBBB@@BBB@D@B@B@BA@@@@BB@BAD@B@B@@BB@BD@BBDBBDBDBFBH@F@B@B@BBB@@BA@ADAD@@@@@B@@B@BBD@BBBDDFDDBBD@@BB@@BA@@BA@ABB@@BB@@BBA@@B@@B@@@B@@@B@@@@BB@@B@F@B@BBD@BBFD@BBD@B@J@NH@F@JBFBB@BAB@@AACCEAIACCCCCCCAAE@AAAACA@A@ABG@C@C@A@GBE@E@EBCBCDCFCHABABA@AA@ACAABAB@F@BAB@BA@ADADG@EEEEAMEKAAC@ABCBE@EBEBA@@LBDCBEBAB@F@HAB@@A@AAAEAO@C@CAAAAC@C@CB@DQ@E@A@C@A@@DAD@D@BAF@D@F@DFFBNFD@H@D@DABCDGBADAB@BB@BBFBBB@DBF@B@BBBDDDJFDDBB@B@BAD@@BBBBFBF@N@B@BBDDDBB@F@D@DBBAFBFADAD@J@D@NCLAFC@KDA@@D@JDHBTIFJF@DBD@D@@A@E@A@A@@B@@@BB@@@@B@@A@C@CBEBAFC@E@ICC@C@@@ADC@C@CAC@AHKG@EBC@C@EAICCCAACACIEMEQEEEC@@AAAC@ABARIJC@A@A@CECGC@C@IDKFEFCHAJ@DBDBDDHBHBDDFBB@FAFCJEB@B@B@B@FDDDDDBDDB@BB@DAB@FADAFAFAD@BAHGD@D@HDB@F@B@DADA@ABCFIBCBAHBDAFAL@F@DBDA@A@A@A@U@CDEJGDCDC@E@GEIEKAAAEACCCAAC@EAECEAE@G@I@@@WEKA@AABEBC@C@A@AAA@CBEBCB@@AAC@ACAC@C@@@ABAFABABC@AAACECCACBA@A@GDK@C@C@@@@ACECGCA@A@A@C@@@AAAECACE@EAC@CDCFIBCBC@CACCAGACAAACECAECC@CAAECCA@A@CBC@C@C@EAA@AAACAEACEAEEACCCA@GAGACACEEG@CBE@C@@AAC@E@CBEDC@C@A@CBEBABEBAACG@E@CA@@@A@AAA@@AAE@CAA@A@OAGBCDAL@HAB@D@@C@E@ABABAFEBC@CAAAEACBEBEHEDG@IAESOWAC@AACC@CBAFADA@A@AAAGECACBCFA@A@@@ACACAEEK@ACCECA@C@CDAFABABC@C@ACCAA@ABAB@BABCBAAA@A@CDEBA@IEAAAA@EAAC@C@A@AACGGKIEGCC@EDAFCHCBEB@FBJ@B@JAFC@A@CCA@C@@BCBCBAAECCEAIAAAAC@A@ADABMEECIEEGCCCCCAE@EBABADBHDJ@FADEBCAI@I@CAAAAACCACCEAE@ADADCDEBIBC@C@ECAIGGECECECIAEACIEAAGA@ACCEACCAA@CAIAECEEG"],encodeOffsets:[[112045,28387]]}},{type:"Feature",id:"433124",properties:{name:"花垣县",cp:[109.482078,28.572029],childNum:1},geometry:{type:"Polygon",coordinates:["@@CV@D@@@DDLFLBBHJNLFFBF@FADCD@BAB@D@@B@BBD@FBBBFDFLDHBBAB@DA@EDA@@B@@BBB@D@DBFDNLFDDBJBL@@@B@@F@D@@@@C@IAE@EBUFE@CAAAE@CA@AAACCEGAAA@C@A@A@A@AD@BDHBBFFDHFFBDAB@@CA@BCBCBEBC@GBIFCBE@E@A@A@AAEK@@GEIGA@CCEAKCEACBA@EBCBCBABAB@@@DDFDH@FABABABABABEBCBABAB@@@DADCBABC@C@AB@@@@@@A@@B@@A@@@A@ABA@@@@B@@@@@BB@@BB@@@@B@@@B@B@@A@@@@B@@@B@BA@@B@@@B@@BBBBB@BB@@AB@@A@A@@@A@A@@@@@@B@BAB@DCF@B@@@D@@@B@@A@@B@B@@BB@@BBBDB@BB@B@@@B@B@@BB@@DDDD@BB@@DBB@@BBB@BBDD@@BB@B@@@B@@@BB@@BBB@B@@@B@B@B@B@BBB@@@B@B@B@BABABA@A@A@A@A@A@A@@@A@@@A@A@AB@@@BA@B@@@@BB@@@B@@@@B@@@B@@ABAB@@A@@@A@A@A@A@@@A@AB@@@@AB@BA@@B@B@@AB@B@@AB@B@@AB@@@@@B@@@BB@@BBB@@@@AB@@@B@@A@@@AB@@@B@@B@@@@B@@@B@@@B@B@@ABABAB@@@@@B@B@B@B@B@D@B@@AB@@A@@B@@@BBB@B@@@BAD@@@B@BAB@D@B@@@BB@@B@@@@BB@@@BA@AB@@@@AD@B@@@B@@@@B@@B@@@B@BBB@B@BAB@@@B@@BD@BBB@B@B@@BB@BBB@@B@BBB@@@@@@@@@@B@@AB@@@B@@@@A@@@A@@@@@AA@@@@A@@B@@@@BB@@@B@B@@@BA@@B@BB@@BBDBDBD@B@B@B@@@BB@@DBB@B@D@BBBBBBBB@B@B@B@BAB@@@B@@BB@AB@@AB@BA@@B@B@D@B@B@BBB@@AD@B@B@B@@AD@@B@@B@@@@@B@DADAB@D@@@B@B@@@BDBB@B@@@DBBBBB@@@BBB@@ABA@@BA@@@A@@B@BA@@@@@AB@@@@@BA@BBA@BDAB@B@B@B@@@B@B@B@B@B@B@@BB@@FBBBD@BB@@BBB@BB@@FBB@@@@BBB@BBF@BBD@BBB@@@@B@@@@@BB@@@@@D@B@D@B@@@B@B@@@BBB@BBB@BB@DD@@B@@@@B@B@B@B@BB@@@@BB@@@B@DBBB@@B@B@D@F@B@B@@@BB@@BB@@@@B@B@@ABA@@B@@A@A@@BAAABABADCDEDABC@A@CEG@A@A@@@@BBJDDBBBBAB@DGBA@@B@B@DDDDBB@@B@BABADC@AB@@@B@BFBBBBF@BBB@@@B@@BBDBB@@BBBBBBBB@B@F@HADCF@B@BB@B@H@F@FBHDB@BBBD@FBAFC@BDBBBBBBFB@JBFBJBJBB@F@D@FABABABA@@@@BCBA@AB@B@B@LDD@D@D@FAJAFALEBAB@B@BBDBDBBBFFDDBBB@B@DAB@BABE@AB@@@@@DBBBB@@ADC@@BA@@B@@@BBD@FFFFDBBBDBNDDBBBHFHFDBHDFBF@HBD@B@DDFFFBHDD@BA@@FCDABAF@DBD@DAFCB@@@JADAFEFA@@HADBDBDHBDB@BBH@BA@CCECE@CBCDCFA@@HADCJMHCFCBE@QBC@CAAAAAA@ABADAB@BAAAA@IBKAGBGBCHABC@C@ABC@AACCEAEC@@AE@IACAAE@IBQAEACAAC@EDIFEBABCBCBAAE@A@A@O@E@CEG@ACKAGEIIGA@CCEC@CAA@GAC@CBAFEBAFE@A@A@E@A@ABCDAFADA@A@AAC@C@ABE@@B@BCBABAD@D@DBBB@DBD@@DBDBF@LBD@JADAFCHCDA@@@@@A@ABAAEAE@ABCBAB@B@BBBBB@@@F@B@D@@BBBBBDAD@D@J@JAD@DADCHG@AJGDE@A@ECCCAAC@@MIIEKAA@@AC@BABCF@J@HBF@B@DABABACA@@CC@IACECGAI@@AA@CC@@ACACBABADCB@D@FBB@@CBE@AB@B@DB@@@ACCAAACAAAACAA@AA@@BAFADA@@@ACCEAA@AB@B@D@DC@A@ACMIAAAA@G@AACAAA@@AC@A@A@AB@@A@EACB@DA@FPABCDCBAAECAA@AAA@CACAAACA@@A@A@AC@A@A@A@@BAB@BADABCBIBABABEDA@CBA@CACAA@A@CBED@@CBA@@BAB@@@BIFEDCBCBC@A@E@A@G@@@A@GGGCG@M@EA@A@ABABEFEBEACCCAC@ADADCNA@A@AAA@@CG@OBO@@BC@M@I@AAC@AECAAC@AAA@E@A@@@AA@@@@@A@@@A@@@AA@@@AB@AA@@AA@BAB@@AB@@AA@@AC@AACCCEACAAC@AAA@@@@A@@@@BCBCB@@AA@AAA@A@E@G@EACACAAAAC@AACA@@AA@A@C@ABA@@A@@B@@A@A@A@CAA@AA@AA@AA@@ABA@AEI@@@CAAAA@@A@A@A@@BABA@A@A@@A@ABA@@@ACC@ACCECCAC@C@CAC@EAA@AAAAAGCI@E@AAA@E@A@C@CAC@AAACAAAA@@ABM@I@@AAG@G@A@ABCBABC@EAI@EAIAE@CAE@GBA@@@A@CACACACAAACAA@ABAF@F@DADA@ABA@AAAAACAAA@CAE@AAKI"],encodeOffsets:[[111967,28856]]}},{type:"Feature",id:"433125",properties:{name:"保靖县",cp:[109.660559,28.699878],childNum:1},geometry:{type:"Polygon",coordinates:["@@ECCAMEICCAE@@@@EEGCAIBIDC@EACAEEACCCAAC@ABABABCBA@E@GAI@E@ADABD@@BB@LBJFNJ@@BDDBDD@F@BCFIH@BGHCDCBC@IBI@C@C@CBAAAA@AC@A@E@@@A@AAAAA@A@ABAD@BBFBFAB@B@B@@@@CBGDEDCBIBC@KAE@CACA@@AC@CAACAC@C@ABABADA@@@AF@B@DBD@B@BCBEBCBAD@B@B@F@B@BEFABEFAB@DBD@HBB@DFDDDB@JHFJBHDL@BFH@D@F@P@B@BBFABADADABEFCJ@FBDDBFBRBJAF@BBBD@JBF@@FDFBDDBBD@BAD@D@BADGHAHALBJAB@BBABA@CBAB@BBBBBBB@DAD@RAFEDGDINCDGB@@EBCDAD@DDFDF@DABG@AAA@ACCGCACAGB@@EBEFCBIB@@A@EDCBC@CAE@ABCBED@@ABC@GCEAEECCA@C@GAE@EAGCCAGEGEAACAMCCAAACAEEEEC@AA@@A@@@AB@@CD@BA@AACA@@@@A@@BAFABA@CBA@A@AACCEEAACACAAAA@A@ABKFEBIBEBC@C@C@KCA@A@A@@BABAD@@@@ABABABEBC@E@A@IAIAEAIAA@AEAAAACA@AEDAB@EACAAA@GCEAE@G@A@A@@A@ADEBC@G@E@AAAAAAAAA@@AAAC@AA@@@A@AAE@AAAAAEA@@@A@@BCDABABA@@@AACCCCA@A@@@ABCHA@ABAACAICAA@@@@@B@BFH@D@BADCBCFCDABABBBAB@@@B@B@@@@@@@B@@@@@B@@A@@B@@@@@B@@@@@B@@@@B@@@@@@@@@@B@@@@@B@@@@A@A@@@@B@@@@@@A@@@@@@@A@@@AA@@@BA@@@@B@@@@@@AB@@@@@@A@@@@B@@@@@@@@AB@@@@@@@BA@@@@BB@@@@@@BA@@@@B@@@@@BA@@@@B@@@@@B@@A@@B@@A@@@@@@BA@@@@@A@@@@@AB@@AB@@@@@B@@@@B@@@@B@@@B@@B@@B@@@@@B@@@@B@@@DB@@@@@@@@@B@B@B@@AD@@BB@@@@@BAB@BABA@@B@@A@@@@@@B@@B@@@@B@@B@A@@@@B@@A@@@@@@@@@@@@B@@@@@@A@@@@@A@@@@@@@@BB@@@@@@@@@@@A@@@@@AB@@AB@@@@@@A@@@@@@B@@@@@@A@@@AA@@@@A@@@@@@B@@@@A@@AA@A@@@A@AB@@ABA@@@@@A@A@A@AA@@AAEGAAA@@ACEAA@ABA@A@@A@AAEBA@CAA@A@@@AAAAA@AAA@AAAAA@AA@@A@A@A@A@A@A@A@A@@BA@ABA@@BAB@BA@@BA@@BA@@BA@AB@@ABABCBA@@BA@A@@BA@@@A@A@@@A@A@@@AAA@@AA@@A@@AA@AA@AA@@AAA@@@AA@@A@AAAAA@@AA@@AA@@@C@C@AA@@ECECACCCAA@@A@@@@@@B@DAB@@KHEBABCBABCB@@@B@@@@DDBB@@@@@BA@G@A@ABAB@BAB@B@@@@BBBBLFDD@BBHBDBDBBDBD@FAB@BB@@JF@@DB@BB@@BB@B@@BB@@@B@@BB@@@BBB@B@BB@@DBB@@BB@@@B@BBB@BBB@@@B@B@BBB@B@BB@@B@DBB@BBB@B@BB@@BBB@B@BBB@BBB@BBB@B@BBB@@@BB@@BBD@@BB@DBB@@BB@B@BBB@@@B@BB@@BB@@BB@@B@BB@@@B@@@B@B@@ABA@@BAB@@AB@B@@ABAB@@A@@BA@A@@@A@ABA@@@A@@@ABA@@BA@@BA@@@@B@@AB@@@@AB@@@B@BBB@@BB@BBBBBB@B@BBB@BBB@BBDBB@BA@@B@B@B@B@B@@BBBAB@B@@@BBB@B@@B@BBB@BA@@B@@@@B@@@BB@@@@BB@@BHD@BB@@B@@BBF@@BB@B@BBBD@BB@B@@@@B@B@BGDABADBFTNBF@D@FADABABA@ABC@@@ABK@K@CB@@@@BB@B@@@@@@@B@@@B@@@B@@@@@@@@A@@@@@A@A@@@@@AB@@@@BB@@@@ABA@AB@@AB@@@@A@@A@@A@@@@@A@@BA@@BA@@@AA@BA@A@EB@@CBA@@@CBA@AB@B@@@@@BB@@@@@B@BAB@@@@@B@@@@B@@@BA@@@@BBB@@@@AB@@@@@B@@B@B@@B@@@BB@BA@@B@BA@ABADABA@@B@@@@AB@DBBBFDHHDBDBDAHAD@FBB@B@BB@BDDBBJR@@DFDBDBHAB@D@BABABCBEDEBA@@DAD@NBL@PAXAB@D@F@DBDBFF@@BBJFBBBDBD@@@D@B@JAF@B@@BFDBFFFDDFBHDJFHFJDFHHDDHFJFHBFBBBHBFFFFJLBBB@B@B@BAFEDKBEDABAB@DBLBD@D@FCHEDAF@H@B@@@@FADEDEFAD@D@B@@B@BALEFABCB@B@BBDDBBB@D@B@BAFGBANGLEBABCBEBCB@DAFAHCLGNIB@D@B@@BFLDFFVLVBDHXFNJJBDJLBDHNLPFFVEFAFAHEDAD@DBFBB@B@B@DCDAB@D@@@BABAB@@@B@@@DCB@@AB@B@B@BBD@@@B@@@@@@@B@DA@ABABA@A@@BAB@B@D@B@BADABAB@B@B@BBBBB@@@B@B@@@BBB@B@D@B@DBFBB@D@@@B@BA@AAA@@@AB@BAD@@@BA@AA@@AAAAA@A@ABC@E@ACEAC@@@CBA@@AA@ACAECACBCBA@@FCH@JCLEJGFG@@BCBGBE@EAI@EFKDCFC@@FEFC@C@CZCF@HUAY@@BA@@@AAA@AA@AAAEACCCAAAAEACAC@A@A@@@CCAAA@A@AB@@A@@A@A@@AAA@ECA@@A@A@@BAB@@BB@B@B@BA@CBADABAFCDG@A@A@CAIAE@CBAB@@@DAR@HAHCFAFAFAFALB\\DPBFEDEBC@A@@@@@AACECKIAACCECEC@@AC@A@C@E@@@A@AKKEGACAC@C@ABA@@BADAB@BA@@@@@AB@@CAEAKACAA@ACA@A@@@ABAFE@AB@BCDEDAB@@@B@@ADBB@FBDBZLB@B@HDD@LBLAJALEHIHOFIDMHG@ICECIACACAECI@M@@CGKICCCEEGCI@OAGCEGGECCCIGCCCIAI@KBK@C@CDCBABCFCBA@@B@BB@B@B@@B@DAB@@CBCBCDABAB@D@DBDBB@B@D@DAB@B@FBDBDA@ABA@A@E@@@@@GAEAC@A@@A@CIAEAEAAAAA@E@A@EAEACACAAC@A@A@A@ADC@EAIACEIGGAAEEECIACAA@A@EDGBA@C@AACAAAA@ABCBA@AAA@CAA@C@A@KDCBE@E@CAA@EACAE@G@EACCCACAAAC@C@EBEBC@E@CAECCE"],encodeOffsets:[[112331,29106]]}},{type:"Feature",id:"433126",properties:{name:"古丈县",cp:[109.950728,28.616935],childNum:1},geometry:{type:"Polygon",coordinates:["@@IGACA@C@ABEBCBA@OIKGOAMAAB@@IBIDKDMD@BC@CBGHGF@JHZDFBF@DFDDDLFB@BBAD@BBDBBAB@@A@C@A@C@CBABGBG@A@UIC@CJEFGNCFABCBK@EAEAKIA@C@A@ADCDCHCDI@EDBFBFDJB@@@@BBDBF@H@@@@@F@BAB@BCBCAEAA@A@CBC@A@A@CACAC@A@ABCBADAD@DA@CBA@@@@A@AAAA@@@ABEDADABCD@D@DAL@LBJDJDDJHDDFDHHDFBH@PDJFHDFDDLJDH@@@NDJBFBDBDDJDF@JGHCNEJGPGJKFIBKBKAC@GCA@A@YKCAEAA@CA@BA@@@A@CBCFADA@@BEFAB@B@@@BDB@BBBBDBLBF@DA@@B@@@@ABA@CBAB@@AB@B@DBDBDFHLL@B@B@@@F@D@BBD@@FDFDDDBBLJFDBD@B@@@@@BADCFEFFFHHDHDBHD@BTHXJFDTLHDFBFBHBJAD@D@JBHBH@NALAFAFABABCBCBCHGB@HIFEBCDAD@DA@@D@JBVH@BHBFBDBB@B@@@@@B@@ADABABAFEHEHCFAHAHCDABADADCDEBCBADCFCHELCBAH@F@F@HBRHD@H@FBD@BBDF@B@PBJ@DBDDDB@FBF@JAF@B@DBDDDFHJBBNFRFDBFBF@FALCDAB@RGLEB@JELGB@LGDC@@BARODA@@@@DAHCNCPCN@N@D@@BH@B@BBD@NDD@DB@@D@B@D@HAFAVKPILGFAFAF@FAD@DBDBDBFBFDFBFDBBJBH@D@B@D@HBAMCOBK@E@@@@BEAGCO@GACC@C@GBCBEAKYCAEAABCA@CAEBE@G@ACAC@GDCDEHCBE@EAEIAAACAKEMAEAC@ABCDANCHCHCBA@AAAEAEA@CDKBK@@@@@@FGBA@CAC@CFGHO@CEKKCGEWDADCJCFCBCAE@IBKAA@EPIDGBA@A@EAACCGCGAKAI@MD]@OEAQAECCEBGAKAeAK@S@ADGBCFGFCHABABCAAEECCIAICGACACCAE@CACEAODA@ABBBDJ@DAB@BABABC@CBEAGBEBE@EAAMCE@@@@QAK@cGACCCACAGAG@EB@@CACA@CAG@C@@B@@DB@@DD@DAD@BADCDCDCBEBC@C@E@G@C@AEIGCAKBCAA@C@M@GCIBGBC@CACC@GA@@@CAC@E@AA@CGMKKECCAG@CA@@"],encodeOffsets:[[112603,29090]]}},{type:"Feature",id:"433127",properties:{name:"永顺县",cp:[109.856933,28.979955],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AGCCACGGGEEOA[CKAEBEBEBEBGDGBQ@CB@@A@AB@DBFBJ@D@B@BCHEDABCBAB@DABA@A@A@@AA@AB@@@B@BB@FDB@BB@@@B@BB@@@BAB@B@BBDD@@B@B@D@DBFBBBBBDDBDBFBBB@@BBB@B@@AB@@BZGVE@YD@D@DEDEF@@EDCDEL@FBJ@FAFAHAD@@EHIHKFIDG@ED@@ABADBDFDDB@BBB@@AB@D@@BDDF@B@FAD@B@BBBBB@BB@@BAB@@C@ABA@@B@@BB@BABA@@@C@A@EACAA@C@A@A@AA@@A@A@@@A@AAAAA@A@A@ABCBABA@C@A@A@AB@@@BABAB@BCBA@@@@@@@A@@@C@AAA@A@A@@BA@CD@@A@@@A@ABAB@@C@A@CBCDA@A@A@EACAC@CBGFEBEBUFJNBBFJHJBFBDBJDJFJBNBL@@DNHV@BJdFP@@@BLf@HBH@HDL@N@H@BADAP@TA`@DBPBFBPDJ@@RLDFBR@DDVBLBHDH@BB^DV@DHdDJ@DANBH@BBDDFDFFFHHJJFDJHDDFBD@B@DCBAD@D@DBBBDF@DBFBDB@F@FAD@FAFBB@F@D@H@HDDDTLRTJLNLHJDDBBBD@DDBD@FDBBAHELCHQRBDDDBDf\\LHf^PJDDPNPLPLJJDDRPNLFD~vBBFDHBB@F@D@H@JELODKDKDIFgHIBAFI@GAEIOCE@A@EDGFADAFCFEBCDK@KKICECCOECCGAAA@A@AJCVEPKLKDCBADAFCDALALAJ@F@BAF@FARMD@DADBRNDBRPDDXRHDDDFB@@JFHBD@D@BA@@B@@@BA@AHAFARIJE@@FCHIDCTYBK@G@ACMKY@AACAEEACAEAK@EBA@E@A@AAAAAE@A@CBC@CBC@C@A@AAAOM@ACKAODKJIBGBIBEFCDEFMBGDK@I@C@CAG@GDGBCBADEFADADBB@BBBBB@B@B@F@BBD@FAFAFCFCFALDFFFHAJDDFBFBDBFHBDFFDDN@HCFEJGFEJ@BBDFFJBBB@F@B@DBB@BADA@@DCBA@CBC@A@@B@@AAA@A@@FGDEBADABABA@A@A@C@@BA@@B@DBBBBBB@D@@@BAACA@AACACEAAAA@ABADADABBD@FBDAD@DAD@DABABAB@D@D@@@B@J@H@F@BAD@B@BA@ABCBEBC@C@AAAACCE@AAA@AAAC@ECAAAAAEAE@GAE@A@E@ABCDAJCDABCB@@AD@D@BBF@BBFBDDBB@FDDFFHBBBHBD@D@B@DBDDDBJB@ABE@OCEAE@ICQ@AAC@MBEBABABAAA@AFGJEH@BBFDFDDBH@DAHCDBFDF@DC@ADCEK@GHGDG@GAGCEGG@@CCCE@EACACCGEEAAAA@@B@F@B@@A@AAAKIAAGAAAA@@A@G@A@ACAOICAAA@EBOBGDCDCDABAB@D@FBDBFBJ@NAPGHCNEJGFEDG@CAUCEGM@G@EB@JATETCLG@EBAAC@AHKDG@AAEIAMEKMGCAAA@G@CAACIMUYGGAK@AAICGAGBK@GBI@IAIAMMOECMCKAM@G@GDIFKHCBC@ECCCAG@IBGDMAMCKCCCC@E@IACAACAGAC@A@C@G@IAAAECEAECEACACACAC@EBE@EBEBKHOJULEBGBC@A@C@@@CAC@MCC@AAA@G@@AC@M@M@ODMDGDCB@@@@CBQPAB@@CDKHA@KHIFA@KFQHA@CBKDEBE@EACAQEMEAAGICECCCAA@E@IBE@EAA@CCAC@CAI@O@ACEAAC@EAG@C@QGGAE@E@G@ABKDGFEDCDABADCFCDCBABCBGDGBEBGDGFEFABABCB@BA@@@@@A@A@CAEAGA@AUGIAC@@@CBC@CBADEFGJA@GHADADADABEBEBKBMBG@GAIAC@C@IBGAEAEAGCSKECWISG"],encodeOffsets:[[112430,29431]]}},{type:"Feature",id:"433130",properties:{name:"龙山县",cp:[109.443938,29.457663],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@HCJC@@FADBFDFFFFD@@@JCVBFD@F@D@JCDMJC@@BCDAB@B@@CHAD@JBHJNFBFDDB@B@BABCF@F@FBFDJBLBD@DADCFGHEFEF@BCB@@CBAB@F@FFFNHPHPJDBLDjPBBBABBBBBBB@BBBBB@DBBBDBBBDDBDBBB@BBBBDDDB@BBBB@BBB@BB@BBBBB@BB@@@B@BBBBBBBB@@BBBB@B@B@B@@@B@BA@@BB@@BBB@@@B@@@BB@@@@@@B@@@B@BADAD@DBDABA@GBE@AB@DDF@FAH@HG\\@BBLBF@BBL@L@LBB@@@HBDDDFDDBJBB@FDB@HDDBBBD@BADADGBAPCHCD@B@@@B@@B@@@BADABABCDADA@A@A@ABA@@BBBBFDD@D@DABADABABADABAB@BABAB@@A@@BA@@BABA@ABABA@A@@B@B@B@BBB@B@@BB@@@B@@@B@@@@@B@@BBB@@B@B@@@B@BB@BBDBBBDBFBDB@@DB@BB@@DBDBBDBDBD@DAJCBABABA@@B@B@@B@B@@AB@@@B@DBBABABCBABBB@@JDBBBBDBB@D@@ABABABBB@@D@DAB@BCBCDCDCBADABADADAB@BBBBDDD@@DDBFBB@F@D@BA@AD@BADDDBB@BBBDBBB@@B@B@B@DAB@DAJEDCDAB@BAB@@@DBB@BDBBAD@D@@CBCBCFCB@BAD@DBHA@@B@B@D@DBD@BABA@@B@BAD@B@BBBBBBBDBB@B@B@@AB@DABBF@B@DA@ABA@C@C@A@ABABABAB@D@BADABC@@BADAD@BBB@@@BD@@@D@@ABAD@BADAD@BABADAB@B@F@DBF@F@B@BBD@B@D@FBB@B@BDD@BBBDBBB@@B@@@BAB@@ABA@@@AD@B@BA@@DABA@@B@B@D@F@B@B@B@B@B@@B@DBB@B@BBD@BBB@@FFDDBFBFBH@@FTBBAD@D@B@D@B@B@BA@A@E@C@AB@B@DBBBBDBD@D@FADADADADAB@B@BBBBBB@B@B@B@BBB@B@@B@BAB@B@B@@B@B@@@BA@A@CBA@A@ABABAB@BABAB@B@D@B@D@BBBBBDAD@B@B@B@BAB@@@B@BB@@BBB@@AB@@A@CBAB@@@B@@@@A@@@A@@@@@@AA@@@@@A@@@@@@@AA@@A@@B@@A@@B@@@@@B@@@D@@@B@DAB@B@D@B@J@BAB@DADCFCBAB@BBB@BBHDDDB@BBFFDDBB@B@DAD@DBBBBDBPDJBHBJ@D@PBB@B@B@HEFA@@HADAREJADAFBFBFBB@B@@A@CAU@A@A@AHIFEDCD@D@B@DBFDB@FFDBHBJ@LBPAH@F@HBFDHD@@JEFCH@HBLFJFHBJ@VA@@HAB@BADEBEAECECA@A@EBE@@DGBCFCHCHADEBC@AECUMQKEEKIAAAC@CA@CACBE@ECEGAA@CBAFCDEHMJS@@FOBAJIPGFK@EDABAH@DABC@A@A@EBGBC@A@@PUNQBAFEDCBABC@CAC@C@ADCDABCBG@E@@@ACAEACCIIAAAG@A@AFCDCB@FEXYHCDCHIHSCCOIe]KGe[ACCCACRQDGFKBGAAECC@CA@CACAACCGIMKIKQSSKCCGCG@C@E@A@EAEBC@EBE@A@ACAE@CCEAACAC@C@ABCDA@C@EACCIGECIIGGEECECEAC@AAGBM@CCIGc@CCUA]@ACGAGAKCU@CAQCEQK@@CIAOAEAO@CB_@SBOBC@A@G@MCK@GAG@GKe@A@@EOIc@AGUCM@@AKAMEICIAIACAEGIEIAAIMEEKOGMACIKACIIEMGWACKUEUCEEK@AA@C@A@MJKHGDEBCBA@ADAFADABKFMHABEHABA@C@A@AACCAAA@A@ADEBKFABA@@@@A@CBCFEFCBC@E@@A@G@E@CBGFEDC@C@KACAA@ABCBAFCLEFABA@A@A@AAIKEEEEGAAAEAGAIEGECCGGCEEIEGCIAGCEECEECAAE@@@ABE@I@A@C@@ACACAAIEAA@@EECACAE@C@A@WBOBK@MAC@CB@@ABCFAFADABABC@A@GBCACACE@@IQAACC@AAAA@A@EAC@GBCBCACAGGECAACAA@@B@@A@@@ABCBAB@BABA@@@ABA@@@A@A@CBA@C@AAA@A@@@A@@A@@AAA@ABA@C@ABABA@@BA@@BAB@B@@@B@@@B@BBB@B@B@B@B@B@BBB@@@B@B@@@B@BBB@@BBBB@BBBBD@@AD@B@B@B@B@B@@@B@B@B@B@B@@EH@D@@@HABADAD@B@DBB@BBBB@@B@@@@@@@BAB@@AB@B@B@B@B@B@BBB@B@B@@@B@DBB@B@B@B@DA@@B@BAB@@AB@BA@@BAB@@A@@BA@A@@@@BA@@@@BBB@@B@@@B@@ABB@@@@@BA@@@@@ABA@@B@FBN@D@B@@@@@BDJBB@@B@@@@@BB@@@@@B@BA@@BBB@@B@BB@@B@B@@BB@@BB@@BB@@B@@@B@@@@BB@B@B@@@D@BB@@BB@@@@B@@BBB@B@@@B@@@@@B@@@B@B@@B@B@@@B@B@B@B@B@@@B@B@@@B@@@B@@@B@B@BAB@B@B@@AB@B@@@B@B@B@@BB@B@BB@@B@B@@@B@B@BBD@B@@@BBB@@@BBB@@@B@@@BBB@B@@@B@B@B@@@B@@@BB@@B@BB@@B@B@@BB@@BD@@@BBB@BBB@@BD@BBBBB@BBBB@@BB@B@D@B@BB@AB@B@@@B@B@@@BB@@B@B@BB@BB@@B@BBBB@@@@B@@@B@BB@@B@BBB@B@@@BBB@BB@@B@B@@@B@@@@@B@B@@@B@@@@@B@@@B@B@@@B@@@D@F@@@D@BBFDF@BA@@D@B@BBBBBDBBBB@DBFB@@BB@DDFDJDFFFFDDF@D@DABAFAFA@ADA@@B@D@@@DCF@B@B@B@BABA@ABC@A@@@A@EBABA@AAA@GAA@CBGBIFOHABEB@@AAA@C@@@AB@BABGFA@ADC@ABC@C@KAC@G@A@E@AB@BA@@H@B@D@DCD@BCDAB@BAFAH@BCB@@@B@@BDB@D@B@B@@BBD@B"],encodeOffsets:[[111855,29819]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("永州",{type:"FeatureCollection",features:[{type:"Feature",id:"431102",properties:{name:"零陵区",cp:[111.631109,26.221936],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAACAC@EA@EAICAACCSI@AIGCCAEAMAIAEEKA@A@CBGDGFA@Q@A@GBA@G@OHA@CDADAFAH@L@BCFCFABIFABABKHCDEFAFADB@BDDBH@D@BBCJEBKJA@ABEFAFBBFFBD@BCBCBC@G@IDABAHBJAFADA@ICKACB@BB@DFFH@D@JBH@@BDFDDBDD@BDF@D@B@F@BBBBFBFDHBB@B@D@BABAB@BBBDDDD@D@B@@ABAD@BBB@BDBFBDBDBBF@@BBFHFDBDBF@HBB@DDBBDD@@@DBD@F@HDBBBBBFB@H@BAD@H@D@B@@BBBABABABABA@EBCBKHAB@DABGFABAD@@HJFHNJFDFBDD@DBBA@@@C@CAECA@@B@B@@BDDDJFFD@D@B@B@B@B@D@BBBBFFBF@DAHEFADBDBJJBF@D@FAJABAD@D@DADEFCD@BBDBDFDDDDD@BDBB@D@DA@@FGBAFCDAD@D@DDDFBD@@@DCLCF@D@D@@ABADABAB@BDDNHHBD@D@BCBAFADAB@DDBDBBBFBBB@B@BCDAFAD@D@JD@BHBHDDDBBBH@@@BDDFDNJD@B@B@@ADGBAB@DCF@HAD@D@B@BB@@BBBDFHFD@B@@BB@@BD@D@F@DBBBBJDFDBDDH@BBBDJDDBDJFHDRLHFFDBB@@FBJDNHDBRLHBBDDD@D@BCDAD@BBF@DFFBDBDDBD@D@D@BAB@BAB@DGBABAFEDCHEFCFAF@BAB@B@BBB@BD@BAHAF@@@D@DBBDBF@FADADADIBA@@DIBGBEDCHELG@@D@D@HBHBF@DABABAAACECE@C@CBADCB@@@F@BBBBBBFFD@DADABABCBC@CAC@ABABAB@B@BDDH@BBDBD@DBF@@BBDFDHJHLJBFBHBFAF@J@F@D@DDDLFDB@@DBB@DA@@BADB@BBBBDD@F@DAF@H@D@DDB@JLBB@DADCHADCF@DBBDBHBHDHBBBBAFADABADBB@BDBFBBHBDBB@JFBBB@D@D@F@FBB@DCB@DBNFDBB@DADAB@F@DBBBD@BABABCBK@C@A@A@CDIBAB@B@@@D@NJB@B@DABAD@B@BB@@DFDDDB@@BADCB@@@@@B@BABBB@BDBBBFBBBBBB@B@BAD@B@BB@B@F@DBFBB@B@DEDCBAB@FDHBDBBD@BB@HCDAD@B@F@B@DBDBFDB@D@D@FCHCD@DADA@@B@B@HDBBD@@@@A@AAC@A@A@ABC@AB@HDB@D@@ABCAC@AB@BAD@DAB@BC@A@AAAAAAAAG@EBCDCFAHEBEBEBA@CDAD@B@HDD@@@DADEFOFQHKDE@C@E@@AGCE@CBEDEFKDEDM@C@CACBGBCAC@ACAGAAACCCGAC@C@GDG@@BAD@B@FFDBB@B@DCD@DAD@DEFG@@BC@I@EBCFGBC@CAAAAIAKCAA@ABG@C@EACBCBIFOHSDEHGFEBAD@FBFBD@D@D@BBBADA@@DE@A@ECEIECA@@@@CAGICACAE@G@A@C@AAACC@A@CDC@ABC@C@ABABCBA@AACECAA@C@CBADCDABC@IDEBc\\IFCB@@EBEBEDCFCFELEJCF@F@BCFABA@C@C@CAC@A@C@ABC@A@E@E@C@EAIEAAAAACA@A@C@CBC@A@CAECA@A@A@C@ABCAA@C@C@CBA@AA@@@A@A@ADCDADABC@E@CDCBA@AAACAGAAAAABABALCBADABA@CAACAI@A@GGAC@AEGECCACAE@IBGBC@ABC@AAEAC@@CAC@CAAIGAC@A@ABAFADA@A@A@ACCAC@CAA@@CGCAC@E@E@CBABEJAFADADCBABCAIAQIA@OGKG@ACCCACAA@@@EACC@@A@ECEEGIAAA@AAEAG@E@C@CBGHAD@@AF@FBFBDBDBBNBBBDB@B@D@B@@CDELABA@AAAAAECAECE@EBGBC@C@@@A@EAGAI@GAAACCGMEIEE@AAK@@@CAGAU@K@GACAAC@A@ADAFADA@ABA@AAACCACCCACAEACAAA@CACAAAAA@CAABA@GECAAACCAGAC@E@A@ABCDCDIBABALEDAD@B@B@HFFBJ@D@@@DADCDE@CEI@E@CDEFCLCHCBCBA@ABAFCFABABABE@CCIAC@@CEGMCCECGCE@GBADA@ABCHELEHCDEBEBEAEAGEKEEEECGEA@C@A@A@CLAH@JBFBB@@ABA@A@I@GB@@ABA@A@ECC@CA@@EACBGFGJEPGHCFCBC@EBCBGDCBC@A@EAECCAICA@AACBA@CFEJCDCFABCB@@E@A@CCCCEGACCGAC@AA@@@A@C@C@A@GAAAAC@C@E@CDCDE@A@AACAAEEAACGCECEA@CAA@CA@AACBC@C@GBA@CDC@A@CACKMAC@A@ADEDG@A@CCGAE@E@EWCKAC@@@A@@@@BBBBDBBAB@BCDGF@B@D@FBFAB@BAB@@A@A@GAGCCCACACAG@AAAAAC@CBCBCFADKTA@A@E@ICIC"],encodeOffsets:[[114004,26525]]}},{type:"Feature",id:"431103",properties:{name:"冷水滩区",cp:[111.592343,26.46128],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAGAGCGACAAA@CDEBCDGBC@CAAIKA@CCC@G@E@CBE@C@ACAA@ACAAB@@CBA@CA@@CAKECC@C@C@E@IBEAEAGAEKIIGCGCEAA@@AE@CACAC@ACGACA@A@ABAB@BBD@DADADABCBCBC@EEAAAAAAE@@@A@CDAB@D@DDFDFBBABABCBE@GAGAC@C@@@KHGFCDAFAHCJ@@ABCJCBCBEBHDDD@DAFAFA@@BEBABAD@HBFDFDD@B@BABC@ABA@EHEJIRADBB@B@D@@@BABBF@H@BAD@DBD@D@D@D@@ADCDABAB@B@B@B@BADCBC@E@CBA@GDCBC@A@AB@B@D@B@@ABCFAD@FBH@@@B@D@L@@@@@FBHADAD@BAB@BBBD@D@BA@@D@DAH@BBBBB@D@BB@@BBBD@@@@BBBBF@DBDBBBDD@BBB@DADA@@@C@EGCA@@C@CDCB@B@BBBHHFHDF@F@B@BBB@BDBFBB@D@B@B@@D@BAB@BBB@BHBHBBBFBD@DBBADADAB@D@BBBBABAD@BDFBBBFBF@B@B@F@D@BABCBA@@BBBDFFB@BB@BBBFBBBBF@D@D@@@BB@BCNAJ@B@BBF@B@D@BAB@BBDBDBB@FAD@@CJ@@AFEFCHCBA@A@AAA@CCCCC@C@C@C@EACAA@C@ABAB@D@@@DABABADADC@ABABAD@FCLAB@@ABEHCDCDAD@DAFAF@DADABCB@BCFAF@FBBFBFBDB@@DDFFHDFBF@DADAHAF@D@BB@BCDCF@BABABI@GBGFCFADABADGH@BCF@B@@EDGDC@C@KEGACCAAC@@@GAABC@C@EACACBA@@D@F@@@D@BBBFHDD@FAHJBJ@DBDB@@BBAF@DBHADADGFCBA@CA@@A@CBABAFCFAB@BBBBBBBDBDB@B@D@DABABA@GBCBCBABADA@AF@D@BBJBFDDDBB@DDBBDBBB@BABCF@@@@BBHFAJ@JKD@B@@@BBB@@@B@B@B@@@B@@@@@BBAD@BB@@B@DBDBBBFDF@H@H@JEDC@@DCFCBAFAFBBBBB@F@BBD@BBBBBBBFBDFDDBB@H@FELEL@D@DDB@BLBDBBDBBDFDBDB@AB@B@B@@@@ABA@A@EBCDAJ@T@DBD@B@FA@CBC@EBCBCD@FAJ@BABC@CD@AAAC@A@C@A@CFCDEBCDE@@FCPMHEDAFBB@B@DAFEBAJIBCDCDADANADADA@ADE@@DABALAFAFABAB@FFB@B@B@FADAD@B@FBB@B@HADABCDI@AAAAC@ABAFGBAD@D@DBFDFBB@B@BABC@CAAACIGACAC@C@ABCDCB@BAD@F@BA@@DCFEBCB@D@F@F@H@D@D@DAB@BCAGAAAACA@A@CBABA@C@AACAAECCA@A@C@AB@BA@@AAAA@AB@BAF@BABC@A@AA@GAEAACAA@CDABC@C@ACACACA@A@@BAD@DABABAJ@DCLGHCHCBC@E@K@EAEACE@@A@A@A@EBCDABC@A@CAGDUAEBALQ@C@G@I@M@CKCCAAA@CPY@@@E@GAG@A@ABADAD@DBFBD@D@BADABADCFGHSBC@A@C@@AC@A@@@ABI@GAE@AA@@ADMBC@C@CBA@A@@DMBE@EAAACCAAAAC@ABA@ABBDBBBB@BAB@@C@AAABABAF@BAD@BABC@C@C@AAAEE@AAIBC@ABAB@DBB@BA@A@AAAAC@AAE@C@C@CBCBCFCHEBADCDE@@@C@@@EAC@ABA@ABA@A@A@EACBCBCA@@AACCAGAECA@ABCDCFA@A@EACAE@A@A@@A@ABC@A@AAAAAAAAEAAACA@AAABA@@@@@A@CDAB@@CACCCE@@AAA@C@ABCBA@A@MIC@@@A@A@ABCJ@D@B@B@DALADABABC@AACAE@A@CBCBA@CAMECAA@CDA@EAE@C@C@A@AAIEA@CAGAAAAEACA@CAABCBEBAB"],encodeOffsets:[[114358,26973]]}},{type:"Feature",id:"431122",properties:{name:"东安县",cp:[111.316464,26.392183],childNum:1},geometry:{type:"Polygon",coordinates:["@@@DBFBDBBHDDDBDAD@BCBAB@BBB@BBD@BABC@IBEDAB@DAH@D@DAB@D@D@DCPCDCDABCBEBC@EBCD@DAFCFCB@DPFVHRHBDBFBFDDBDB@BAF@FBFBDD@F@D@BABCDCD@B@NBFDBD@DBBB@B@FBB@@DRBFBDAD@B@@AFAB@BBDBFBDABABABA@CBADAF@D@DDD@D@BADABADCFADCDAB@DBBJLDD@DAD@D@B@BDDDHFNBFDDJFJHJHHD@D@D@H@BBDDBLBHBDBDADCBAFGD@B@FBNDD@FDD@D@F@HCLE@@D@D@B@BA@@@@@A@C@@AAB@AABEB@AA@ABAAABA@@@@@AB@@ABBB@@B@@BB@@BBB@@BB@B@@@B@AADABB@@BA@AAA@AD@@BB@BBA@@BBBB@@@B@BBD@BA@ABDAB@BADAB@BA@BBD@@@B@@B@@@@@D@B@@B@@@@A@@B@B@A@ABBB@@@@@B@@ABB@@@B@BBBHDBBBF@T@JA@@BA@EBA@AB@D@HBD@@@DADCDAB@FAD@@@B@DBDBFBJBF@FAB@L@BBHDHDFBF@F@B@BAFCBGBCBE@G@A@CBAFAJCBC@@@@BCBA@CDABABC@@CGCE@EBABCFAAA@@A@A@A@@BCACACEAAACCAKA@ACA@C@CFKFK@E@GAACCCEEAAAAAAA@AAC@A@EAAAAEAEBABEDCD@@CDIFG@G@E@ECAACACAA@@@AAC@AB@A@@@@@A@@@A@A@A@@AA@A@@@ALC@IBIGEAA@@@@DEBA@AAACAAACCA@CACCAEAI@A@CBEB@BCBADADAHAB@BABA@C@C@ACACAAAAAAA@ABADEBEBADAB@@@DBB@DAHEBCBCAG@CBEAA@@CACAI@IABG@ECCEGAA@A@C@@@E@CB@DADBFBD@D@BAHB@@D@BBDDHBLFD@D@HCFC@@@ADE@AHGBCBABCDEHEHAJ@BABA@ADEDC@AAAC@E@GBCBCBE@EAGCEECC@@CAEAEAAA@EBEDE@ADABABC@CBEBE@CBCDCDCFGBA@@BADK@EBCBABAD@BCBCBABA@C@@@CBABAD@B@DBFBD@D@D@D@DDDDB@BBB@B@DADGFEBE@@DI@@BC@EAAACAC@ABA@A@C@AAE@A@ABIDM@AAA@@C@C@E@AAAAAEAAA@@AEACEAA@AB@DABA@A@C@E@A@AAEAEAACE@ABCBAAAAAC@A@CBCBABCAC@EAAAGAGA@AAA@ABA@A@CA@A@C@A@EACA@AAA@A@A@ECEEGGGAA@A@ADADCD@@@DBFHD@@@B@BC@CAA@ACCAACACAE@AAAA@@@@ACAA@@AAC@A@AAAAG@CBC@@@ABC@C@AA@ABA@ABCBCAG@E@@@@@K@C@A@@AG@EBCDEBA@@@A@C@ABAB@D@DAHCB@DAF@D@DABC@A@A@A@ABABADCBC@@@C@C@CAC@CBC@A@GAEBA@A@@@C@AAABCJQFIFGB@BAD@BA@A@ACCCEAE@GBCBAFA@AB@BEBE@CCCGCE@CAAA@C@C@@BEBG@AACA@AAA@A@ABE@EBEDGFCDEFABABCHA@ABA@ABC@C@C@CAACACEE@CAE@ABCDC@A@CCCACGAQKCAMGICEA@@AAECGEQKGCIEACCCCIAA@ACGACECICAAAA@C@E@CAC@@AA@@@AECEGACAA@@AAA@C@C@GBE@CDA@ABCH@BA@A@C@MIECCC@A@@AGAACCGCGA@AICC@C@EBCBADA@A@AAAEAAACCCA@CBEBABADC@C@GAMGCC@ABABABCBA@@@C@CDEDK@C@@ACCECCC@C@CBEDABEH@@CBC@A@CA@ACCCCECACAC@ADCFEBC@C@CBCBABI@E@CAEIICACAEBGFCBE@EACD@J@FADEDAB@@CD@BAFCLAF@FBDBDB@@B@B@DFHFFDDBD@DAFBFBDDDB@BDA@@BABA@A@ABADED@DABBFBDFB@BB@@D@DCF@D@BADBDBDDBF@F@FB@@PDFBDB@DBDAD@D@@BDBF@F@FABCBADBL@D@BEFADABADCDCFAF@DCBC@G@@@GBC@C@ECE@@@OCAA@AAEAAC@EDGDC@A@CAGGA@CAA@CB@DAD@FADA@A@AACEC@A@EDA@GHGHCDBDBFDFDHBFAD@B@DBH@DADABA@CBC@EAM@EAEBI@C@C@EBABA@CBC@A@C@MCCAQ@I@EAA@C@C@CB@@CBGBC@@@A@C@CACBC@A@C@AAC@A@A@AAAA@CA@A@C@A@A@CBABC@C@EBCBCDCDEFEDABCBA@AAAAAAA@A@ABAD@BA@EAA@ABAB@D@D@@ABA@CACAAAACAAA@A@A@ABCBCBA@A@CAA@A@@B@@@B@BDHBHABC@EAGACBABCDABCBEBI@GAG@C@IDABAD@B@DBHJPBDLJDDDFBJ@HBBHFBD@BCDGJADBD@D@D@DBBBDBBBFFXDFBD@FBD@DDBPJFDDDB@@B@DEF@DBFBBB@HDFFLVDLBFAF@BCHCD@BBDHDBB@RBB@BFBBBBDAD@B@D@N@FCDCB@B@BBDDFBD@PAP@BCH@HBR@BDTFNBDFBD@F@F@D@BBB@BBD@HANCHAD@DBBFFNBFBFBF@HADEFAB@D@BBBD@B@D@DBDBDDJ@DB@AHADABC@AFM@CAEAEAC@KAI@@AACIEMAC@EAG@S@G@A^WDANOHIBCBCDIBEDADCFABABEACACCAEAGAAA@ADGDC@A@ACCAEAEBCBC@@DAJADAFGBAB@DBDBB@BADABADAF@BA@A@AAAEE@A@ABAFAJ@DAD@FADAB@D@@@BBBH@BB@DBB@B@BBBBBDDBNH@@DBJHBBDDBBDBB@HAF@H@FBBBF@LEDAD@@B@B@H@FDJHLB@D@D@FI@ADEBAF@F@FBDDBD@LBDBDDBDBBDBHAT@D@DENC\\CLAJ@FBDDFFDFBBD@FAHBBJHBD@@BF@D@DAB@BBDBBBBAB@BABAD@F@LBJ"],encodeOffsets:[[113977,27254]]}},{type:"Feature",id:"431121",properties:{name:"祁阳县",cp:[111.840657,26.58012],childNum:1},geometry:{type:"Polygon",coordinates:["@@icGCAAIAGBC@acOOICKICAC@C@EBEFAF@@FF@D@DEFEFABEBCFEHAH@H@DCBGBE@QDGFABAB@D@F@BABEBA@EDADBF@@FDHF@BBBAHCHFFHFBBDBF@B@B@BCDAD@FBDFBBABCBCDCDIDA@ADGDIHE@A@A@ECCGAIAGBI@G@EAAEAICEACA@ABAFCDCACCACAG@E@GDE@CAGECAO@GHGFOD@HCHCBGFSNOHDF@F@BCF@@CBABAAC@C@C@EAEAC@ABEFGHCFGTEPAJADBD@F@DAH@BBBLDJBBBBB@DADEHAD@F@JAD@@EHCFC@CBC@CDA@A@CAEEA@C@AB@@CH@H@DBDDHDDBBHBDB@BBDADAHBD@D@DCNCFELCFAF@DDFBH@@@F@DCFGLEREPCFCB@@C@GCA@C@CB@DABAFAFGFEBCDAD@FBHBBBBBB@B@BADA@CBC@ABA@@BBDAD@BC@A@GCA@@BAD@B@B@BBD@B@B@@C@AAGCA@A@@@CBCBC@GDEDC@C@A@ECCACAA@E@A@C@CBGDADADBD@F@B@BAB@BAB@BBD@F@@@D@@CFCDABGFEDADAD@D@D@DBF@BBDBB@B@BABA@CAA@AB@BADBJ@BFFBB@B@D@DADABC@ABE@ABABBB@B@DA@ABA@AACAAA@BAB@BBDBBDBBDBB@FAFCN@@@BAB@D@DADCN@BB@@BBF@HAJ@B@@@BBD@@@D@BADGTEHCDABCBABC@C@EACAC@CBAB@B@BBH@H@F@@OZ@DBBDBLD@D@N@J@H@DKRABBFCVBH@D@BADCBAD@F@B@B@BF@BDBF@F@L@FADGDGDKHCDI@ABABCBC@AB@@@BDBDBDB@B@DADCB@DBBBDFBHBB@@B@BADABE@ABA@@BBBBB@@ABA@@B@D@BDBFDBBBD@B@DABAB@D@BDBBBBBBHADA@CBC@C@G@E@E@C@A@ADEFCD@@ABE@C@ABA@CDAD@B@DBDBDJHBDBB@DADABA@A@EAECCAC@C@ABEHAB@BBDBB@BCJADCBGBA@A@EAA@C@CBEBA@A@A@EEA@ABEBEBKBABCB@@CF@BCBCBMBCBCBCDADIJABEFCBA@A@EACBGFONED@@CFADCFED@D@B@D@BBDBBBAFADA@ADABCB@B@D@B@DBJHB@DBF@FADAD@DADBBB@DBDDDDBD@D@BB@BCBABBBD@DBD@@BAD@B@F@FAD@B@BBDBD@D@F@@BBDFBB@D@D@DBDDDFBF@D@F@B@DBBBDAB@D@FCFEBAD@DCHADADBDBDDBBDADAD@DBBBCD@D@DBBD@F@DBBBDDBBD@FABAB@DABA@ABADCBG@ADCFCHAD@DBDHFFFDB@D@BA@@BCAAEC@CB@DAB@BA@AAC@@CA@@ECA@A@AC@C@A@AAC@ABC@CBADADABABADAB@F@D@DDBBDBBABAF@D@D@BC@AAK@ADCHCBCB@DGBAFBF@HBDBFBBBBB@BBBBF@BBBFBHBJBF@B@F@B@B@BA@E@C@@@EAC@CEAA@AA@A@AAC@CD@DAB@B@DCB@D@BDDDBDF@D@D@F@FBB@B@DBDBDDBDDFDDBBFBF@@@F@B@DCDC@ABABEBC@C@CBADCDCB@BA@ADCB@@ADABE@CBA@@@ADCDCDABADC@CBCBCDCFADAH@F@FAFAAC@A@C@C@CAC@C@C@CDC@C@@BABC@@BCDE@@BEBCBCBCBCDCBA@@@@B@@ADAHCBC@@BADE@CBEBCBCDCBCBEBC@ABCBE@E@E@C@ABCDCDCBADABCBC@AAC@C@ABC@@BCDADCDCBAB@BCDGFCDAFAH@HCB@@ABC@ABG@CBAB@BABABE@ABE@ABAFCFEDCDADDDDFJDLBHBDBB@DADABE@CBABBBBDC@CAAACCCB@D@DADABCBCDABABADAB@D@B@B@@BBDBFBDBFDFABBJ@H@DBBBHABBDBFADABCRGDAD@FDLFLCD@F@HALAFCDCFCBAHADEB@BBBB@DALBDDBB@BAD@B@@B@FBFFDBBDAD@BCBABA@BDBFABC@@@AC@@EACDAFEJCBA@AD@FBB@B@@A@AFEBADBB@DAFCBABAD@DABA@C@AAEACAACAC@@A@CBIACAAAAC@@A@ABEACFEFAF@L@F@BCBAB@B@DAFCD@@AB@BEBEACCCC@@ADAHABC@@@A@@AAAC@ADA@A@AAAEACA@A@AB@@AACEAA@@@CBA@AC@@CDABAAACCEAACAA@A@@ADC@AHAB@FCD@@@DCBCAAACA@EBC@A@AA@CECACEA@A@@@C@ADC@CAC@E@@@A@A@CACAAAE@ABAD@D@DA@@B@BDFDDDB@DFFFBDFDBDJHBBNJ@@JHBBFDNJFBLFBBDBHBFBLALC@@BAFCHCDEJGBEB@@E@KAK@C@AGKEEICIACBC@IBE@AAEAIEIGCEEEAEAE@GCDADBD@DABCBA@ABA@CDABA@ABABADABCBCBAACAACAAC@E@@@C@C@CCAA@CBE@CB@BADCBAB@BA@E@ACAC@C@CAAAACCACAAA@A@@DAD@BABA@EAEBAB@DADABCA@@CACCCCAACCAA@C@C@C@CACAAA@C@A@ABAFAAE@AFAD@DABCAC@C@ABCBA@CA@A@A@AAA@@ABA@A@@DEAA@AAA@ABADC@A@@AAAAC@CBCDABADCBA@@@AA@A@ABA@M@C@A@C@@AC@CBABAB@FAB@FCBAB@@A@CAECC@C@ABC@A@I@CCG@@@CBABABA@ABCBA@AFBBBDDD@@@D@BAFAB@@A@C@ABABB@BFBFAD@DCDC@ABC@C@EACAEACACAC@A@E@C@C@A@CBE@C@E@A@CBADB@@DD@FDBFBDBF@BABCBABAD@BBBDBD@BBBDBD@DADCBCBC@C@AAAAC@A@C@EACAEACACCCAECACCAAACAABC@EBC@@@A@CBCBCACAC@E@EAG@EBGAC@A@CAC@A@CACAAACCAAAAABC@C@C@EBC@CDCBE@A@A@C@E@CACACCCAAGC@A@CBCDCBCDCBEBCDADCDCFADADAB@D@DBDBD@DCACCEACAC@C@C@A@AD@HAB@@@BB@@BBB@@ABC@GBI@AFEBCB@@@D@B@@A@A@ABA@G@C@C@AAA@A@ABAA@ACAA@@@EKAIGGI@@A@IGCCA@EDCBE@OC@AGAUKAAAA@@"],encodeOffsets:[[114867,26714]]}},{type:"Feature",id:"431123",properties:{name:"双牌县",cp:[111.659967,25.961909],childNum:1},geometry:{type:"Polygon",coordinates:["@@LLFDFBH@HAFBDBDBF@JNBFABODWHGAGCGCE@CD@JBDHHBDADEBC@ECGAM@G@IDEAGCKKCCC@EFGLCBGA@F@FBFDH@D@BCHCF@B@BBDLNBD@D@BCD@DAB@H@DADBD@BDBB@DBB@DFDFDHBBFFBBBD@B@BCFCD@D@F@DBDBBHBB@D@D@B@@@B@@BBDDHBDFHDDDDB@F@@@DABADEDCFIDEB@DABBB@JDDBFDFBB@D@DAHCDAFAD@DADEHGFOHIHEDAFB@@DBD@FDB@B@BA@@HAJ@B@B@BA@@AAAE@IBGDKB@B@D@B@HFFDFFLFHFFBFBFAFADCFGFKDGBAB@BCHAF@HDFDDDHNDF@@BDDJ@DAFABABEBEDAB@BABADGDKDEDCF@D@FFJ@DCFCDCB@@C@I@EAGEA@A@C@CBKFABABCJCDAD@B@B@FBDBHDDBBDBHFB@BADBB@BBBBBD@DBBDBFBDBDBDDDBBDBBB@BAB@BCBEBCB@D@BBBD@H@LBVBH@D@@BL@BFFFJHNDDBBHBJ@HBFBB@@@D@D@HAFAF@FDDBBFBBBBB@BAFKDC@@@A@C@ACAAAMAAAACACAE@EBE@@BCHGDAD@F@H@FBBBB@BBHJFFFDB@@@DDFB@@B@DBDBDD@BLHPHB@RJJBDBBADABCBCBEFIBADAF@F@D@DBDH@@BB@DBDDD@B@B@BCBEBAB@B@BBDJHBB@DBD@DD@FBBBD@BAD@HAJAF@DBDBFDFH@BBDHHB@J@DBBB@DABCBABKDABABBBBBHBDBBB@BABCD@D@FADCBCBCD@B@B@B@@BBB@DAD@D@B@DBBAD@B@B@B@FDDBB@D@DAD@B@B@BDBBBBJFFBD@F@F@B@D@BAD@B@D@DBD@D@B@BADE@A@EDEFIFKDEDEFCFAFA@@DAJEd[FAJCD@BADCBCDAD@B@DBDFBBB@DABABAD@D@BAD@DCB@D@BDBBD@B@H@F@DBDBHJDB@@@@DBJFPGTMHEDADG@GKSAYBSCQ@SAGDE@@AC@CDC@ACIAAAAC@AA@ACEACC@ECA@E@A@EDA@ADAFABC@@@AAECEAA@CEECAAABGDCBCACCCAACDMCEAECQAC@CCIEIGK@AEWAKAI@A@G@IAGAC@@CCEAMAKBG@A@ICACAE@A@EBYAIAQBE@G@A@@@EBCBUBOHSBEACYKIEEGO[GGQI@@KIAGEOCQ@@CQAGACAG@@AABA@AAGAECCAAACCAAAEAGA@@A@AAA@@C@IA@@AA@EAGCC@CD@DCDCBA@AAAABEBCDG@AA@@C@C@@@AAA@@A@@@G@AA@@@CBABEA@ACCAIECAACACAA@@A@AAAB@AA@A@AA@@EO@@AC@A@ABA@A@@@C@A@@AKAECG@AACCAGCGGIGCE@@@AFG@AEGCECEAAAAAACCEEECA@A@G@MBMDGCGEGKGEA@AAA@AAAA@C@ADCDCBEHGBA@EAA@ACACCC@A@A@EBABGDCB@BAD@HCFAFCDID@BABAFBDFF@D@BABG@KDADBH@B@DABC@A@GBC@CBCDA@CBGJENCFA@CFCBE@A@GDADCBA@E@CBEFCB@BALFJ@BADA@C@@@AGCKA@@AA@C@CA@BGBAB@BAB@B@FA@@B@@AB@BAB@BBBBF@@@BAF@BCF@D@@BFDFAD@BG@C@@@ABC@A@AB@BAB@BADABEF@FARALBB@BBBBDBBBBBD@BBD@DBBB@@BBDAD@@ABCFABA@AB@BCBAB@BDDHH@B@BADA@E@GAKAC@EBC@EBCBABCD@D@DBFCF@@GDEDABADAB@B@BDDDF@D@BABA@CBC@@@AAAAACAAEEKAKA[LINABaXODABUJCH"],encodeOffsets:[[114132,26469]]}},{type:"Feature",id:"431124",properties:{name:"道县",cp:[111.600795,25.526437],childNum:1},geometry:{type:"Polygon",coordinates:["@@FOJUFCDAHBHBFFJDDC@E@GLMFC@EDGAGGICIAKDGDEFEDEJCFG@C@AAAEACC@CBEDEFE@ABA@A@C@C@ACCCAAACCEEECKIEGEEICMAWGIKEGEGI@G@EB@@K@I@IEIGIEaUEGGAIBCBABEJS\\IJIJIFKDkPEHANAJAHEDGFE@K@GBIHKHGLKlAFCD@BIHMDENGFOJEFEFCJCFGDOBM@E@E@QHADADFHDH@FABABIHMFI@KCGAGGCIAEBGBGFGB@CCCAABABE@EAIBI@EBCD@HABA@EACA@@A@@D@FADKJGHABC@ICAACAIGEGAEAAEA@@A@CAAAEEC@E@A@CF@@ABADCBC@CAEC@@KCCCGKCKEOAOEICCICEAC@ED@BABAF@F@HJDBD@BCJ@BBDBDDDBB@FAFCFEDABCBAD@B@BAR@F@FFFLNDDARADCDABGBABEBCD@DAD@DABCDCBABBB@B@DEBGDCDIPEFCHAH@DA@CAEAEAG@G@GDKLGFEBMAKACAACACACA@SCA@E@C@AB@DDLAFABIDEF@@CTETELQNIPaZUVMFDFJJDH@@B@@A@@B@@@@@@B@@@@@B@@B@@@@B@@@@B@@B@@@@AB@@@B@@@@@BB@@@@@@B@@@@B@@@@@B@@@B@@@@@BB@@@@A@@B@@@@@BB@@@BD@@@B@@A@@B@@@@@B@@B@@@@B@@B@@B@@@B@B@@BBFZB@AD@@BJ@HALAFCJAH@D@JBPBFHRHXBBDPHFFH@DAFEJILKHKHABGHKRCJ@BAJ@DBJF^BL@J@@ELCF@JAR@DARBD@BBBDBHDHNHDJBF@FCDAFAH@JB@@LDTFB@D@DAF@DBDBDD@@NBPD@@NFDBJPLTLLPBHCJ@HBFDDD@LALAJAJAJ@@ARANADCFKFCD@FDRDLDD@BDD`BPBDGVIBAPCbWBAJM\\KLBLBFFBBBDBBBB@@D@DAB@BA@A@CCECC@A@ABABCBAFCHC@@DEAE@C@CDCBADAFAD@FAD@LBHBF@B@BC@A@AGGCC@ABADA@ABAB@BADEBA@@BCAC@AA@AA@CAC@AACAAAAACAA@AAABKBQ@EFEBABC@ABA@ABAB@D@BA@@D@H@@ABCCEAE@@@CDE@ABE@A@@AEAA@ABA@ABA@@@AB@@E@ABA@ABAHA@ADBD@B@@BB@DLBH@@D@B@BC@AEIBK@ADAFEDAF@B@DABCHCB@F@DADEB@DEFMHIDAB@DCDAD@HAB@D@BA@C@AAGBCLCH@BA@A@CEEACBEBA@AJCDCBEDE@GBC@ADAHCBAFAB@B@D@DDDB@BBB@FABGHAFCDCD@B@DBBBBB@BBB@HFHLHFHDNCNAH@B@B@FDFFDDBBBBBBAC@C@@BA@CACCE@C@AAC@C@CDAF@N@NGDABEEIAGBCBA@CCQAC@ADGDAB@FB@@BBH@DBBBBHCFBBDDHFBFBFDDJHDHFBFDBB@@BBJN@D@HAF@B@B@BDBFAFABADAF@FBD@D@BC@@@A@K@CBA@CBADCLEHADABAAACIAEAEDEHCHADA@C@I@EBEBEROBMAKEOIQKIGIAE@G@CAEACCAC@C@EACACCACCAAAC@CBA@GAC@GBIDA@AAA@AC@CAGBEBED@B@FBDB@@B@BA@AA@AEAC@E@@KMA@SKGKKMGSEMCEAK@A@IBEJED@L@XBLDDBNHNLFFFHHDH@JAJEDKBIHQLk@EBEBEBMDMBKBABKBIDK@CBEDE@AFIHIDGFQLY"],encodeOffsets:[[114560,25929]]}},{type:"Feature",id:"431125",properties:{name:"江永县",cp:[111.343911,25.273539],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAICGCK@SEMEIEI@MBIDCBA@OAIAACEIAAGAC@G@GJAB@@A@@@EC@@AAAACAAEIECCA@C@A@@B@@@BDHFH@@B@B@L@@@FLBBABA@G@@@A@@B@BA@AJ@HDPFL@D@B@BEFEFC@GBMBCBCF@BABBBHFDFBJAD@BAB[XADALADCL@B@DBFBDCN@BGR@DBHBLFLHLBBFHDF@DA@EBAB@BLLLNNLBBFJ@@@BADGDAD@FBBHJBF@BAB@D@DBBBDBD@BNVDFBDF@DDBD@DCFAH@BDBDFXH\\LHDFDJ@JAB@DBBBBHDFHBF@PABBB@BDBFFDHHBDDBHBFDHDDDFBPDLDFFBBBFAF@FDHFF@F@HADMV@DBH@HAHM^@HRLDBB@FAB@B@BDDDXLHFTXJLFHN^@BBDJFBB@B@D@DDF@@DFJHBDFHBBB@B@VCTAHAB@DBLJTLNDDBDHBDBDBDLHHDLD@B@J@LBFJP@JBBDBF@F@JEB@BBBJBDDBH@DBHHHPHJBDBJ@@@DCH@JBHDFDHNEVUbYJORMFKFSDS@@FEJCBABECK@CBAD@F@B@TDB@BDBDBDDBLBNBFAHELKHCH@H@FBFBDBB@@CBGDGFEJODCHCFA@C@AAABADADCBA@CBC@CDCFABAHABADCBCBQCCKMEE@E@EBQ@A@ABCDABAFCDEBE@EAACCACAC@ADI@AACIC@G@EBEBAICEE@CAEBADCB@BC@YBK@EEECEaU@E@ABABIDGHODCFCD@DBBDDDFBHAhCF@FEB@ACBCDIBCBGDC@ADEDC@E@EAA@GCGKICGAGAEBCBCFCFE@C@CAGACCC@@BA@@PDDDHDFFBBJH@B@@FBD@F@NKHEBI@GEICEEGEEQIA@Y@A@KEAEAQDECiACOCA@OCIAECACISGQIBO@KBODKDEDEDGDCDEHCLBN@JBNFFFLTbBDDJEHIBGAOAI@IDIJCJ@PAHGFABODA@UCCACEAEAEAIAIMKKCO@C@MBODQBEJEDIHOFMFADCFEL@D@FAJABADEBCDEDEDCAQKEAACFEAC@GCA@AGEQ@CAEEA@GEQKEGCCGGAG@IA@@AAEGEIEGCKQ@CECE@cOA@QG]UACEEGAICc@A@MCOCC@AACCBKBG@CH]@AACIqA@CEACACBIBG@KBQ@KEIA@G@GB"],encodeOffsets:[[113755,25541]]}},{type:"Feature",id:"431127",properties:{name:"蓝山县",cp:[112.196567,25.369725],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCBMAOEOEIGEEAG@OBODGBEJAH@HCFABEFGDKLEHAHCR@RBDBNB`@LBNFJDHDNDJFDD@FCFAF@HDFDBDDNDPDJFJFDNDD@BBJHBDFJ@J@F@JCPAFADCF@B@DABB@@B@BBD@BBBFHFHHAFAFCDE@C@A@C@CDAD@FBBBDFDDHDLB@FDPAHBHBDHBJBPCN@HBNDLHHFBDBbDHFFJ@LBD@HBDDFL@B@F@BALCLENCLBFDHDH@D@N@@@@BF@H@RAJALGT@DEN@J@FAJ@D@FFHB@HDLBDD@FCFABCFAFGDQRKL@FHHFBFDBDABABAFGB@BKAA@A@@@KCG@EBAH@BAF@HDFDDFBJAL@H@J@DBBB@D@B@@ABCDYDOBGBE@C@EBA@GC@@BCACECC@@@ABC@@BA@@BABDFBDAB@DABGFBHDJDDBBFBFBHADCFCDEBABA@D@DBHBHDFJJDDNBLBBBDBDDBDBLAFBFFJFDNHDDHDJH@BDPBBBBDBNADDHFDBDBHFr^BBPPJLHFHHBBDFHHFDB@FBH@DAHAJ@D@HDHHFDDDF@DAHAFEDCJG@GDMFE@ABAB@BCFCTCB@BKCCCCOEEC@EFGDCREHAL@B@JBN@F@HCJEFCFIJGBAFCHELCB@DAHAF@LDBBFDJDHBDBJBHBJ@B@LCDMB@@A@CAIGIDEFIFEBAFGLKDCJK@ADKBCBADE@A@@BI@GBI@C@A@C@ABADIBCFEDCDEBARKDIBAFIBAHINODCDG@C@A@A@A@@@@AAA@I@G@A@A@E@CAAAAAAA@A@ANMFE@@BADCD@B@B@D@B@BBDAN@JBBADC@ECIGIGGGAIBC@EBGACE@AEGEOEK@AU[MICCECAAA@AAA@KAA@AAKMKIAAIAGAE@ECKGGCGA@AACBC@@FAJEFCDC@CACAA@A@@@A@@AC@GHEDEACCGACBKBCFCDEDCDEBE@EAGAIEGCC@A@A@C@A@ABA@CBABC@AAO@@C@CAA@CACACACACAAAEEAAA@CAA@ACCAAACACAEAC@E@A@A@CBA@A@ABA@A@A@@AA@@@ACAAAA@AAAAAACAA@@AA@@BA@@@ABA@A@@@AA@@@AA@@A@@@EAUDC@A@C@ABA@@BAB@B@@ABAB@@E@A@AAA@AAA@A@@@AB@@A@@@A@@BOEKCA@@B@@@B@D@D@D@D@B@B@B@BABA@AB@@A@@DGLADEBGBM@SAQ@C@I@K@CBA@A@@@C@CAA@CAA@AAA@AA@A@@@@@AB@@ABA@@BABABCBC@A@A@@@AAAACAC@AAAAA@@AA@C@A@A@A@CAAAA@A@@AA@AAA@A@ABA@ABAAA@A@@@AAAAA@AAAAAAAAA@ACAAA@AA@@A@@@AB@@ABABA@A@A@@@E@CAE@C@C@CBA@C@@AA@@@ACCACAAACACAGACCGAA@@@C@A@@@A@A@E@CAA@C@AAA@@@AAA@AAAA@AAA@@@A@@AA@@AA@GO@AB@@A@AEBE@CA@@CAIEGCOAADEFEBC@ACKKIWAEGAG@C@A@ADCBABCAIEGICCGMGCG@GB@@C@EBA@ABA@ABA@CBCDEBCBI@IAA@AACAA@@B@D@F@JAJ@DCFAB@@I@OAAAW@ABCFCDABGBGDEAAAEGAC"],encodeOffsets:[[114666,25642]]}},{type:"Feature",id:"431126",properties:{name:"宁远县",cp:[111.945844,25.570888],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@GU@GAS@BABBBA@ABA@A@A@A@@@A@AAAB@@@B@@@@@BAA@BA@A@AB@@@BA@A@A@DDBF@BB@@B@B@B@BBA@@B@HDBB@BAD@BABA@AB@B@DBD@D@FBDAD@DJB@BHDF@@@DABG@OAIAE"],["@@@ABADCF@HBFDHBDABE@I@O@AASAIACCIEKIGGECCGKEMCCCEA@A@A@@ACBCB@A@AAAA@E@E@AAACABA@ABADA@@@A@@@A@ABA@@@@B@B@BB@BBA@A@@@B@DB@@A@A@A@@BAB@B@@AB@@A@@AC@@@@B@BABB@ABAB@BB@ABAABB@B@BA@A@AB@@BAAA@@A@@@ABA@@@A@@@A@@@@@A@@BB@@@@B@@B@A@@B@@AA@A@BA@A@@BA@BB@B@BB@BBB@B@@@B@@AB@@BBBB@@@BA@@HBDAB@B@@BBB@@B@@@BCAEBA@@@AB@BA@@FD@@@@@BAB@@BB@@B@D@@@@@BB@B@@@@B@B@BAB@F@BB@@@B@BBB@BBD@@B@B@BB@@@@@BCBC@A@AAA@@AGGA@AAA@@@A@@BBB@BA@@BA@@@A@A@A@AD@B@BBB@B@B@BA@CBA@CDA@A@@AAAA@AAA@A@@A@A@@AA@@C@A@AAABAA@@AAC@@@@BB@@B@D@@@B@B@@@B@B@BA@A@A@A@@@C@@@B@@B@@@B@@@B@@A@@B@BA@@@@BA@BA@AA@A@@B@AAB@@A@@@AB@@AB@@A@@BABB@AB@@@@@BBBBBB@B@BBBBBB@@@B@@@B@@@@@B@@A@@BA@ABA@@@AB@B@B@@BB@BBBB@@BA@@@@@BBB@@@@B@B@B@BB@@B@@@@BB@B@@B@@BBB@@B@@@@@BB@@@B@@@BB@@D@@BDAA@BA@@@AA@B@A@@A@@BB@@BB@@@@B@BB@BB@B@@AB@BAB@@@B@B@BA@@B@B@@AB@B@BA@A@@@AB@@A@AB@@@@A@BBAB@A@AAACB@@@@AB@@AB@B@B@@@B@BA@@BC@@B@B@@@@@@@B@B@B@@@BB@BB@@B@@@BB@@@BB@ABA@@@ABA@@@@B@AA@@@AB@@AB@@A@@@A@@@AB@BA@A@A@@@@BA@@@@@@BCA@BA@BBAB@@@B@B@B@@@B@B@@BB@B@B@D@BBB@DAB@@A@@BABA@A@A@A@@B@@AB@B@@@B@BB@ABB@@BA@@B@@@BA@AB@@A@@@A@A@@@A@A@A@@@ABA@A@@@C@C@CBABCBA@AA@@AAA@AAA@@AA@@@AA@ABA@@AA@@@@A@AA@AAAA@@@A@@AB@A@@AA@A@AC@A@@AAA@A@@AA@@@@A@@A@@@@AB@@AAA@A@@A@@@A@ABA@A@@BA@A@@@@@A@@@@@A@@B@@@@A@@@@@@@A@@@@@@@@@A@BB@B@@@B@@@B@@@@@B@@@BA@@@@BB@B@@@@B@@B@@@A@@BB@@@@BA@@D@@C@@B@@@@@@A@@AAB@@AB@@@BABA@@BB@@BA@A@@@@BB@@BA@@@@B@@A@A@BB@@BB@B@B@@ABA@A@@BADCB@@ABAB@@@B@BA@@B@@@B@@AB@BAB@D@@@@ADA@@DA@@B@BA@@B@B@B@BA@BBAB@B@@@B@@@B@@@BA@B@@B@BAB@@BB@@ABAB@@@@@B@@@BA@@B@@A@@BA@A@@BA@@@A@A@AA@@ABA@@B@B@@A@@DBDDRBFDFCNBDDBDDDBDAHCBABBFDDFB@FBFDBB@@D@BABEBCB@FCB@F@B@FDD@BDDF@BBBD@BBBBDJ@BCD@DBD@@CFBH@TDRATBZLTPCHEHGP@DBHFDBF@HCF@H@DBDBBDCDEDAB@BDBFBJDFBBB@F@HAJBHBJDHFDB@B@F@JGHCBCB@JCDCDCDABAAACEEAC@CBADA@A@E@CAAAGEEEDGBGAA@AGEEC@@AEBCFCB@FABA@A@E@CBABAHERCF@HADA@C@GBGFGDEFABAFEFE@C@CEE@@BEFEDUDUBELKDYNOBC@A@GIKEI@CDGBEAG@@IIKOEIEG@E@CBCDABBBBDDLN@CBCAC@E@CAC@CAD@BA@@@AAAA@@ACA@A@@B@@A@@@A@@AA@@@A@AB@@E@A@C@@@A@@C@EBABAF@B@DCB@B@B@HDB@@A@@@A@A@A@A@AA@@AAECCB@B@B@@A@@BAB@B@@ABB@A@@@@@A@@BABBB@@@B@B@B@B@BAB@AABA@ACK@E@E@E@CA@GIIGEGEMAE@C"],["@@CS@OBI@I@EBIBEDE@AFCFAHEB@JANBJBPDNLJFDDD@D@BA@CBAB@FDD@DBDABKGMAAAEMI@@KAS@@A@@FE@@VSLEBA@AAM@GAC@@A@MB@@C@CACE@E@C@AB@DABA@@B@LJD@D@D@LANCFA@@HEDC@A@C@G@A@AFAJABABC@O@@BAD@B@B@ATBF@@@DDDFDFD@@DFBHDRBHBBFAFAHCNID@DADBFBDB@@@@B@@@B@FD@@B@B@BDBB@@AB@@@@@BBAF@FC@@BC@C@C@@AG@C@ABA@CBC@@@GBG@@JQDGLQ@GBGAG@AAGWg@@MUIHCDEFGBCBE@CCECGGGCC@I@GBCBG@EAA@ECGGCEAAGGGEIKOOAAq]GECACAGECCMBCAAAAACO@AIGGCCCMGECEIAEBEAKACCCCAAAKAMACCIICEAGAG@C@CABABCFEDCDGBEAEAAACCCIAGHEBA@CBAACCEBA@AB@@AD@BA@@D@FDBDAD@@HDB@FAD@F@HAPAZCDCBA@@@A@CAACAI@G@K@IBEACCCE@GBE@ABGFAH@LD@@B@B@LB@AHABEBABAACECEAGG@ELKRQHCBEDEBADE@ECCKAGCA@EG@E@CBI@E@IFM@CHSBKBI@Q@GAE@@@@@M@CCGCGAEDKFMDKBK@A@E@AEKCCGAC@KAI@EECGAaACGEKGMCGAM@ODIAGAACAGBGCO@EKAGCCCCEAAEAC@CB@D@D@B@DCFEDEBGBBB@BAB@@SLCBC@C@A@AA@CCCCCEAI@@@ABA@ABC@ABA@E@ABAF@B@BA@C@CDABA@A@A@AB@BAB@@C@AAAA@@AAEFCFAF@DDDFBBB@B@DEHIDCFEFCFCHBLDJHJBHCH@FEDKN@H@FCDICEEGAGACBEDIVEPKZERCHGJEJ@BCFAF@DCLAJALABALCNANAFAF@FKlGRAJCLIFIBG@GCEGEEMKMGCAKCWAK@C@IFAF@J@BBLDFFNHTLNHLTLB@LN@@@FBDBFB@@BABA@@@CAEAA@C@AFAFBH@DBDB@BBB@JCHAD@HBB@DAD@BBDBBDDDDBFBD@D@DBBDBF@D@HBFHJLJJRFPBLANQPAFAF@F@J@DCBGBGDCFBFBFDJBBABCBGBKFCDAB@DAB@D@L@B@@ADC@C@EAE@CBABEBEBCA@A@A@ABE@G@CIMAA@@AAECEACGIGCCAEAEGECCAADEAGAACAG@AA@@EAA@CBCH@BBDDR@DABADBHFJAFCBMHM@E@CB@D@DBD@B@DDFBD@DAB@@@DBDDFDFFH@BEH@B@@DFJHHHHDDBBD@BDHBFBL@@@B@D@@@BAB@B@BBD@@FP@@BBB@B@@BBABBB@@@BBBDBDDBJFDBBDB@AFAB@D@@BBH@@@B@@@BB@B@@@D@DB@@BCHADAFBBBBB@DADC@CDCD@HDFBB@@BB@@J@DB@BBB@@@HBFBBBDBBDBBDDBFBH@BABBB@@BHBDBHDR@@DRFPBHLJ@@RJHHP\\FHJFZLBDAFGTAPAVAD@F@@@B@HAFBRBJAZ@F@BBFBDJDB@H@LANBFBDD@@BDBH@J@H@BBJBLFX@BHLFJDJB@@@@A@AB@BA@@BBB@B@@@B@@AB@B@@AB@@@@AB@@A@@@A@@@@BABA@@AA@@BA@A@AA@B@@A@@@A@@@A@@@ABAAAB@@A@A@A@AB@@A@AB@@CB@BC@@@@@CBA@ABA@@@A@@@AB@@A@A@@BABA@@DABC@AB@B@BA@@@A@AAA@@AAB@B@@@@A@@B@@AA@@A@@B@B@@AA@@AB@BA@A@@BA@@BA@BB@@@@@@@@AD@@@@CB@@A@@A@@AB@@@A@@@@A@@A@A@@A@@B@@A@@@A@@@@@A@@@A@@@AAAB@@@@@@@@@B@@@@@@@B@@@@@@AB@@@@@B@@@@@B@B@@AB@B@BAB@@@B@@@@BBB@BA@@B@@B@@@@B@@B@@BB@B@BB@@@BBDB@B@@BB@A@@BB@@@B@BB@BBBB@@@@@BB@@AB@BBB@@B@@BB@BBB@BB@@BBB@DABADAD@D@@@B@B@BA@@B@B@B@@@B@B@@@B@@@BAB@@A@@@AB@@AA@BAA@@A@A@@@ABA@@@AB@B@B@B@BA@AB@@@BA@CAA@A@C@A@AAA@@@A@A@@@A@A@A@@BAAAB@@ADB@A@@@@B@@A@@B@B@B@@ABA@@B@@@B@@@BA@@BA@@B@@B@A@@B@BA@@B@BAA@@A@@AA@@A@@@AAA@@A@@@A@A@A@@@@@@@A@AD@@AB@@A@A@@@A@ABA@@BA@@@@DABB@B@BBAAAB@@@@@BAB@@@BA@@B@B@@A@ABA@@@A@AB@@A@A@A@@BA@ABA@@@AAAA@@A@A@@A@@AA@@AB@@@@B@ABB@@B@@ABBAC@@@CA@@A@@@A@@AA@@@@A@@@AA@AA@@@@AAA@@@@@AA@@A@A@A@A@@A@AA@@@@B@@AA@AA@AAA@@@A@ABA@@B@BAB@@AB@@@@A@@@@@A@@@A@@AAAAAAA@A@AAAA@A@@@@BAA@BA@AB@@@BA@@BA@@B@@@BA@B@AB@B@@BABB@@A@@B@@A@AB@@@@A@@@A@@@AA@@@D@@@B@B@B@B@@A@A@A@@@A@A@@@C@AA@@A@@D@BB@@BBBA@@@ADABCBAAE@CA@AA@AA@@BA@@@A@A@AAA@@A@AAAB@@AB@B@@A@BBB@@@AB@A@@@@A@@A@@AB@@@@@B@@@B@@@B@BA@@B@@@BBAB@@BAB@B@@A@AAABBBAA@@ABABAA@BA@A@A@@D@@BB@@@BA@@@ABA@AB@B@B@@@CAA@@@B@B@AAA@@A@A@A@@B@BAB@@@B@@@B@BCBAB@BABDBBF@F@B@BB@B@BDADA@BB@B@B@DFDD"]],encodeOffsets:[[[114809,26541]],[[114785,26474]],[[114779,26406]]]}},{type:"Feature",id:"431128",properties:{name:"新田县",cp:[112.203287,25.904305],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@@B@DBHB@BBBAB@@A@AF@B@@A@@@@A@@@@AB@@@@A@@CAC@IA"],["@@AEBSA@A@C@AB@@@PADABIBEB@B@B@H@D@BCDGF@@EBMDKBC@C@C@KIA@@@ABCBA@@B@D@FDFDBD@@@NAB@@@BD@HBN@BABKFUT@@EF@@@BT@LB@@NJBFBBHNALCBCAC@ECA@AB@DABC@C@CCIEMKOCIAMAIBA@GFEBED@BCFAFAJ@F@JAJ@PDTFNHLDDHFJHFLDJBDBJBT@B@P@JAFCBGAECGAE@CDAB@B@DBFFNFHJHHJB@@D@F@F@FDLBT@HHVBFBJ@PAHCB@@E@GC@AIAKMCCAAAACBAD@D@FFHFJLPJJ@@BHAFCH@DFJJL@H@BADMPCZKLAFCVCVFAD@D@DBLJJDPPbdD@HAJBBBHDjd@@BBBBVLHB@BPDF@DAFCB@DDJHB@@@HJJHLB@IFKDEHEDCLAFABABANDHDB@FCBADAD@FBHHFFBDBFB@H@FABADIBE@@BADAD@FDDDDFHDD@D@BAFCHCFG@@JKLOBGAGCE@MAI@EAGEQACA@CGKQCEAACGEEGGACAK@@@@@CCMAI@CAEAGAGAEAE@GHMFBHBDABABEAEBEFGDCBEAECCI@G@KC@@GEGIMOA@QUGGGGEE@EBGDCHCR@P@PDPBHBH@NAFAD@HCBAF@F@D@NRFFBH@DFHHDF@HE@E@AAODIDGHIBCDK@GFGFE@@@@@A@@BABAFDD@B@FABA@A@@@AEI@ADC@C@ACCEGACAA@@CACBC@@@A@@@I@AA@A@@AA@@AB@@@@A@A@AAA@E@IDC@@@ACBA@@BAB@B@B@B@@@BA@A@ABABA@ABAA@@CCAAAAA@@@ABCFCBABA@@BBB@B@BA@A@@GC@A@@@AB@BAB@DBNCHGPDD@@CBAAGCIDGCKAEIYCOACBI@C@AJEPGDA@CAEIGEEOGOIGACAMIEEAAGMCKCIECCAC@KDIHGDCDAFAF@F@B@H@H@BAHEFEFIBQ@EACACCA@@A@CAAC@ACAACAACA@AAACACQCcIGAA@CCCGEDE@AB@A@@@@BA@@AAACA@A@@@ECA@@@A@@@@@CAEACACBC@MJGDEBEBAAAGCQAGCE@@ECECCC@C@@"],["@@@AB@B@BD@@BBBB@@B@@ABC@ABAB@BA@ABC@AAAGCA@@@AB@B@@@BA@GCA@A@A@CDA@E@ABAB@F@DB@@@D@B@F@@@BAB@@@B@@BB@@@B@@@"],["@@B@B@@@B@@AB@@AAA@AB@@@B@BBB@HH@BB@BBB@D@DA@A@@@@AAA@A@@@AC@AAA@A@A@@AAE@A@ABA@A@@@@@@AAA@@@@C@A@@@AA@@BA@A@@@@EC@@ABA@@B@@ABBFAD@@A@@@AA@AA@A@CBGA@@AB@@@DBFABADCB@B@@BBB@D@@@BB@@@B@BB@B@BBB@BB@BB@B@DCB@DAB@@A@A@AAA@A@ABCB@"]],encodeOffsets:[[[114937,26302]],[[114879,26289]],[[114788,26543]],[[114755,26432]]]}},{type:"Feature",id:"431129",properties:{name:"江华瑶族自治县",cp:[111.579535,25.185809],childNum:1},geometry:{type:"Polygon",coordinates:["@@FCBCBCBCBCB@D@DADABABC@@DADBF@B@DADCFAB@B@FAF@B@BA@A@ABCAC@A@CAC@EAAAA@A@AAEACAE@EAEAC@CBAAE@CAAAA@@@A@ABEBE@A@AAA@AACACBADABADA@ABAAACC@@A@AA@ABCACAECAA@A@AADA@CA@@AAACACACEACEAABEDEFEDABCCEAEAE@E@A@A@AAACCCCACCCA@@CACAGAGCE@EAAAAC@CBCBAACEEEECCCCECEAG@KAGAEAICACAEBCBCAAC@CAAAAACAAAECEECCACCACACAAC@C@A@IBGAE@EAECG@CAAECECEDEBEDCFEDCD@BUNKBKDG@I@MDGDEHCBM@GACGGKCCEAC@GAI@IBSHKDI@I@ICG@AAC@WGEBEBAD@FBJAHG@E@E@E@EDIDGBK@E@EAMCIEMAKBODKBGHIL@@CDGBI@GAEECEGC]GKAG@A@GDKBKBI@GBCBADGDAEACCACAC@@@CBCDG@ECEAEAGIKIIG]iSUEIAK@GBE@CDEDKBIAK@EAEEEEAQBODGBGDSJGDIAGCGGCIEOMeCQACUKCDCFEDEFC@C@CAA@AA@AIC@C@AAC@CCAA@G@EDABCBCDC@@@A@@@ADBDEFAHADABCBABABGFAFABCBCBEBGDA@ABIDQ@KB@@ACAGACCAE@QAC@AB@D@FANAFADC@C@CACAE@EBEFAD@DBDB@FLBDLLFHBFDFHF@BB@@DBFBDDF@@DB@@DBBDDF@FAB@DAB@DAD@B@BABBFFHBDDDBFBH@HBJHL@@DHBFBFBJ@FANAH@@ENELEFQXGJEHEN@LFJHHHNBJCJ@@GFIBEHAFBFFFBDDHDDHB@@JBBBDHDJ@F@HBHCBC@GBEBCFGFC@KBKLGLABGP@P@D@FDPJHRHRFFBDBNTBDBRAB@JAF@@CDHRJTBDFDJBPDB@PDBDDjCFBRBFLFB@Z@B@RJFFFHDFFJ@HAJGFMLE@C@EA@@@AIGAAEEGCCCOC@@AB@@DDBDBH@D@DEFEDADADBFBHDHLJDH@HBB@F@FCDCF@BCDAHADCJADBDA@EFE@gDGBEACCACCAC@EDCDGPCHAJAB@B@FbVDFFF@FAL@ZADA@CDABBF@DFFJD@AFCD@FBJDDDFJBPFPDLHLDDLD@@FDDBD@DABCBA@@DEB@F@D@FFBBDBB@@@FBBBBFFHJHDBBBJDD@BAHGLIBC@E@CB@@@DBFBB@BA@GDCFAJ@JAFBF@BABADBDDA@EHAHAHBFDJHHHBLDJ@NEJGBABA@ECGEGBCBCRGF@F@N@PAHCDEDIFEFEPIHEFMNCJG@ADCBELkHKLGJGHAL@F@HEFCBGBIBMFGlOLCJEJIJIT[FIBADAJAHBFHbVJFJHJFJ@L@@@FAH@J@FHFHJLXHNBJDFFFHLJFDFFDDBBDBDD@B@D@D@BAB@BBB@@BBBBD@@@BA@ABAB@B@B@BADCD@B@@A@ABEBAF@B@BAD@BAB@BA@@J@FBDDDD@DBBB@D@D@DATK@@BA@AAAEGEGAA@AAC@A@AA@BA@C@ADEBCBEDO@I@E@IEIACIGAAC@MCECEICICOCMACECGCE@EBEDC@ECCICMCGEIAM@KA_AMAC@QDQBGFGLKHCFEBADE@GBGFIHAPCPAH@FBHFFJFPBPANDDBDFHBBFBHCHABADCDEBAX@BBPBJ@@@BADE@CBI@I@E@C@AB@DBBBB@JBJ@DAFADCDAB@BAB@BAB@FAD@@@HAH@HDHNDDHJJFDBBADABCB@D@H@HBBFJXLLBDD@FAFEBCPBHDJFDB@@DBF@FA@@@A@A@CBA@A@A@@@A@AA@@@@AAAAAAAAA@A@A@A@A@@@AB@@AHIBAB@@A@@@AA@@AEGACAAACAACECECCCCACCCAC@AA@@A@@ACCAEAECGAAA@A@G@G@C@EBE@CAEACAA@CFADABC@CBEDC@CB@DAHC@@"],encodeOffsets:[[114827,25547]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("岳阳",{type:"FeatureCollection",features:[{type:"Feature",id:"430602",properties:{name:"岳阳楼区",cp:[113.129684,29.371814],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAAA@CBC@CAAE@ECC@A@CDGFGBAACA@AAA@@@CB@DADA@A@ACAEEAABGBCDCFCDC@E@E@CBEBE@EAAAAC@@@CDA@C@A@CFA@CBC@C@EAGCAAEBADGR@@ADGNABGBAB@BBBBB@DADADC@CBCBEFAD@BB@@BHAB@DD@@@FADIN@BCDABC@CACC@AACAA@@C@A@@@A@E@IBABABAPCJAHEFCD@@IFCFBHHTCH@@BHBD@@DFHHHJBF@@BDBD@DBFBF@B@@BD@B@@B@@D@H@H@DBD@B@@BB@BDD@@B@JFFB@@@@FBHBRJJHJLFBBDHFHFFDBDDD@@@F@D@FBJ@D@BAN@BJH@@DAJEFCBAJCHC@@hQTM@AHMAWFOJEFCN@LDDBB@FDF@J@N@BBFA@@B@DC@CDEBCBCBBD@BDBDBD@@FDD@DBFAD@FABADB@@BBAD@@AD@@@DBBFDFBBBDAD@D@B@BBBBFBJBF@FDB@BBB@@@D@D@@@D@JCDAB@@AAACGAEAC@ABC@@BCBA@ABC@@BCBCBEBCBG@CBEBCCGC@EACA@AAAAECACAA@CACAEACAAAACAE@C@C@E@CBCDADCDA@@D@FAD@F@F@D@DBD@B@DCBAFE@CBAB@FADABCAA@CAABEBGBA@ABGBC@C@C@AAC@AAE@I@GAKBA@@@A@CACAACCA@@@A@AA@@@AAAAA@A@AAA@CAA@@AA@@A@@@AAA@A@AA@AA@@A@A@@B@@AB@BE@A@A@CAA@A@@A@ACA@@AA@AA@@A@A@A@@@A@@@A@@@A@AAAAECACAAAB@@ABA@A@@@@DADAB@BCAC@@@AACGCA@@A@A@ABABCBABA@A@@AAA@@CAA@CBA@CDADA@AAAAA@@@ADA@ABAAC@CAABC@AB@BA@A@@@ABD@DB@B@B@BA@A@C@ABCBADAD@B@BABCBCBCBCFABC@AAA@A@@BADABCBAAEAAA@C@A@AA@CBCDCBIHCDCBE@KAGCC@EAE@EB@BCB@DABCBAD@DB@DBBB@@ABAD@B@D@DABA@A@E@EAAACA"],encodeOffsets:[[115898,29991]]}},{type:"Feature",id:"430603",properties:{name:"云溪区",cp:[113.272312,29.472745],childNum:1},geometry:{type:"Polygon",coordinates:["@@CF@DCDA@@@EBAAM@I@E@ECA@CAKCM@EDIFEPBXGN@BSNgR@@GDIDABEDIFCBDDjnDBHJFJBBJNJNLP`nDFFJPLNHJH^TDBRNHHBD@B@FFLBDBFDBRPBBNNFDTXDANEPGJ@HDJNFJ@BBD@@@@BBPXBAJAB@@ABEBEBC@AGGMeDBDHBDBD@@D@FAFAHELKFKNINADDFDBNAJALBFFBLALCRIDEFAB@BAD@@ADAB@@@BAB@B@@ABABA@AACAACAECA@CAKGCCGEO]GGCAE@G@MCCACC@ABA@AFANBH@DEFGHCNAF@@ADADAFAFCDAB@BAJI@AAA@C@CAIAAA@OBGDGDIBEBC@GEEIEECAAACECAE@E@@B@AABIBEAA@@AAC@E@CCK@A@A@CAE@C@AGCMBMBOCOAC@E@CA@EDCJ@D@@AJ@NBFBD@DABC@AAABCDCB@B@@BBBBBBABA@GAGACECCCAC@ABCHCH@FBDBFDD@DADADC@I@CDA@AHC@@@AB@@A@@B@@A@@@AAEOOCCCBCDCFCDCBCBA@ACACCEAA@@AAEBGD@BCBCBA@ADC@AACA@EBE@CCC@ACCCEAEACA@C@@B@@ABABC@AAA@CA@@@@AB@B@BBB@DA@ABAAAEAACCAA@@ACA@AAA@ECE@IAEAAAAAA@C@C@CBAAEAECAA@C@@BC@@BCAA@@CAABEBC@EBCAC@EC@@ACACACC@AAADAD"],encodeOffsets:[[115977,30101]]}},{type:"Feature",id:"430611",properties:{name:"君山区",cp:[113.006435,29.461106],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@MCKAG@@@CBCLDNBBHP@B@LCHCBCDEHCDA@AN@@@B@FFJBF@B@B@DADCFEDCDCBCBA@C@AB@B@DBDAD@BGFABC@A@C@CAE@GBCDEFABAFCFABMBC@GBKDIJADCBADCB@BAB@@GJCDCDEB@B@@A@@DAD@DADAB@B@B@B@@BBB@@@@B@B@B@DBBAF@B@@@@ABAB@BA@B@@BB@@@BAB@@@B@@@B@@@BAB@B@B@@@B@@@@BB@@A@@@@@@B@@@D@B@BA@@B@@A@A@@BA@A@A@@B@BAB@BAB@B@B@B@@AB@@@@A@@BABA@@@CB@@@@@B@B@DAD@B@@@B@@B@@@BBB@@BB@@@B@@@FCFAF@HBDBBHFFBB@D@@@DBDDBDBDBFBDFHBFFN@@@BBF@@@BBBVDnL@@LDJBBDBN@FFJ@BDF@@ABAB@DAB@@@FBFBBBD@BBB@DBD@BB@BDDBDBF@D@FBDDBHBDBDBDBB@DBDBF@DBD@@B@BBBADABCD@D@B@B@BBD@DBB@FDDDDDBFB@@BBBBDBDBBD@B@B@BB@@BBBBBD@BB@B@@@BCBABC@AB@BAB@D@DBDBDBBBB@B@DBFBF@BBFBDDBBB@BDBB@DDD@FBDBJBBBBD@DBBBBBB@@@B@BBBDDBDBBBD@B@D@D@F@D@BBDBF@BAFBB@DBBABADEHE@@BAB@B@DCBABADA@AB@DCFAFEAAEG@G@IFKJ_BCHWBI@GDMDMDI@ALaDSDOBQBMBABEDSDEBGLGD@NA@@JBFDBBR`RTVNLHXPTJLFTBPA@@LCHEFMDKFWFWBUBA@KB]FYDGLEH@FBHDHHDJAPALAF@@D@DBB@D@B@DCDADAD@F@F@DBD@D@DBFADCFABADC@@BQ@A@@AE@@AA@AACACCC@@@@A@@@A@@@@@@@BA@@@@B@A@@@@@CCECECAACAAAAAAACCCAAA@@@@A@@@@AA@OGAAAE@AA@CAAAA@EBA@AAA@@@@AAB@@@@@@A@@@@@AA@@A@AAA@@@@@@A@@@@@@@@@A@@@@AB@@@@@@@AA@A@AAOICAKEGEGAC@ODIB_IA@ACCCAG@GD[AGACEAECOAK@CBABAB@PAZ@BADCF@DAPAHEHIHMLMJQRABMPIJABYNjQNMDMDGBMDKDGDIFGFGHEFOJKNMNA@E@EBEAKAOGSKECGGCCAC@CCMEU@I@C@A@CAAAACE@@@@@E@A@@@ABADCHCD@BBBBJFHDD@BAD@@C@CBA@E@ADC@@@@DAB@FGBE@CDA@I@QAE@CIWESCIAI@@AAAACCA@C@E@@@K@ICAEBC@ADGDIBCAOAIQQGGCEKO@@EGA@IAKA"],encodeOffsets:[[115451,30020]]}},{type:"Feature",id:"430621",properties:{name:"岳阳县",cp:[113.116418,29.144066],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAEAK@AB@DABA@CBC@CD@HADABABC@A@GAKAC@@@E@C@CB@B@BCHC@AAC@EACCGAC@C@G@CAAAACACCAECCAAAA@CBA@@@A@C@ACCCCA@AA@E@C@CBAFABADEAE@EBC@EDCDCDCB@BABEBE@MAKAK@E@A@EBABCD@DBFADADCDEDEDCBEBCBABCAECEGCCE@E@G@CAC@CBIDGFCBE@ABCAECECCACAC@EDEBCBGAGAAAAC@A@CBCBG@CACCAGCGECCCAACC@AAC@G@AAEACCACAACCEBGDCBCBA@E@CCAACCCACD@BBJBDADADABA@A@C@ECECACCACACAC@CBEDE@EAC@CDCBAD@D@BCDE@GAA@AAC@CBEDEFGFIHCDCBC@C@CBC@CBABABCBABCBCBC@CBCBCBAB@@A@EFA@ABABABCBAB@B@BADCBABA@E@C@C@CBC@ABC@AACAAACAA@@@E@C@EDCBEFCDADABCBAA@@ACA@CBABC@C@C@CAC@CDCBABC@A@C@A@GACAC@A@EDA@ABCBADADCD@DAD@HAD@D@B@F@DAD@D@B@DAFADEDGBSDAIAEEICGCGAACGGBABCDGDIFJNLTGBC@EBABAD@NAJCLK\\CPAH@J@NAR@H@HAJ@@@F@F@B@@ABA@UDWFCBGDMDEDGBOA»QIBGBAFC@EDFJJNNN@D@FEDULG@C@ECEEEEA@ABDF@@BHBHDFBH@FBFBHBFBFBHBF@FBF@FBF@FBBPX@F@HCH@BBB@FBD@F@D@FBDBHBBBF@F@D@HBFBFBHDFBD@D@B@FDHBDDHBHDHBDBFBFDFBFFJN^BDBJDRBL@JAF@@AJERENEX@HBDBFBDDD@D@BC@CBGBCBA@@A@EACAA@AAACA@A@@A@@@CACCACA@AAC@A@A@C@CB@DAFEHA@CB@@@@CD@B@FAB@D@DC@ABC@GCIEAAAAC@GDCDAB@B@@@B@F@@@@DFBBBB@D@B@D@JFVDN@DBDDDHHFDTLPHLBFBFAF@B@NMLMPIFEHGHEJEHCLCNCHANCNCRMiZMBAJINOBARQNINKJGFGBGBO@CDEBC@ABY@OBABADAL@PBFDFBBDBHC\\@HBHDDBDB@`JJAPCD@HBHFLFDBPJBBB@B@@B@@@@@@BA@@@@@B@@@@@@@@@B@@@@B@BBB@@@BB@@@@B@@@@@@@BA@B@@B@BBB@FAB@BBDBB@@BBFBBPHB@@B@@B@@@@@BBDBDDBBBBBBDBBBFDFDDD@@@@B@A@@@@@AB@@@@@@B@@@B@@@@@DDBDBD@BBB@@BF@@@BARBAHAHD@ABM@A@CAI@E@C@E@@CCACECGEGEACEAIKIGQIGAEA@@@@EAIEA@@@CC@AAA@@@AAC@C@G@G@CA@@@@AAC@@@AAEAE@CACAC@@AEGIGGCE@@ACAG@@DGGSAGDEJE@@DCFEBGDIBOBABAJAF@B@@@B@D@@@BBBD@BDDDBD@BADC@AJMBC@E@@CCA@GB@AA@@ABCFEDADAD@BCBC@CAAAA@ABAHABAHMBC@@HQBCFABBHDFBD@D@DAB@DEB@D@B@DC@@D@BBBB@FAFAF@D@F@FCDEDCDADAHBBFFDB@B@BCBCBA@@D@@BB@BDBBBHAHEDCB@D@FDF@BB@DAD@DBBFBDBBBFBF@B@B@BA@C@C@ABCBA@@AACAA@@CBCDABA@CDA@AFAF@FBD@HDLBF@DADCJGDADCDAB@@B@B@DBBFBBBDABABC@AB@B@BBD@BADEDADADABA@A@ABCBCDABAD@B@B@@A@A@ACAC@BA@@B@B@@ABAD@BADBD@BBBAB@BC@@B@BBBBB@BCDCB@DAB@DB@@BB@BB@B@BADABABAB@B@@@DBDHBB@@D@DB@ABABC@C@@B@B@BA@@BACCAA@ABC@@DCLAF@D@BB@BB@ABA@A@AB@BBBBB@@FAB@BA@@NAF@DA@AACE@AA@C@C@EDC@E@CAAAC@A@AFIBCBABABB@@DBBFBBB@B@JCFABADCBAB@DBBDBDDFDDB@D@HCFEB@B@@@BBHDD@DA@@FCD@FCDABA@@@@DC@@B@BAF@B@DDFDDDD@DFDDDBBBB@DDDD@B@@BBDDDDBDBDBFFDBBFBD@DBDBJDHBDDDBDD@B@@BBDBBDDDDBFDBFADCBADCD@B@@@BBDBDDFDBBBDBBBFBFDDBD@B@HAHIDEBCDED@F@B@B@B@F@F@DBDBFBDDFBBDBDDDBBFDFBFBBBD@DBHCDCBEB@BCB@BABAB@FAB@DBB@FAF@NB@@HDF@DA@@FIBA@@DIDEBABAB@DBBB@@@@FC@@@ABADAJAB@DBF@LAD@D@DB@@B@DADABC@E@@BCBC@@DCBABCDE@A@ADABADCBABAD@@@B@B@DAB@DBBB@D@DBBDBD@D@FAFB@@BBD@DBD@DAHADAFI@C@CAE@EBA@GBG@E@ECIAAAACCACAA@AAACCCCACA@C@EACCCCECAACECCAC@CBABI@@BAFADABA@A@AACACBAAE@A@ABEBCDEHCHAFADA@@@C@CAEBEB@FG@@DEAE@C@EAC@G@AAKAC@CAAAE@CBC@CFCDAF@F@FBDBDDFBBBBF@FBFBDHHB@FDDFFDD@DBF@DABC@AACCIACCEAE@G@@DKBADC@@BABCACBCBEDCBA@AA@C@EACBA@CCEE@@@A@@O[ACEGGCC@C@I@GDOFG@CBGAECGCKCCAC@GEAEAGCAACA@A@A@CFCFC@OBIEECCACCACAA@CBA@A@AACACA@EEAACGAAAA@@@A@ABAAC@E@G@K@E@E@CCCA@CBC@EBEBEACACAC@CB@HAD@B@BA@@@@DABE@AAACBCBCBC@CCACAE@CAE@CBABBDHFFFBB@D@BCDCBC@EBA@A@EAAAAC@C@CACAC@AACACA@@@AAECGACACAEBC@CBG@A@E@C@CACCMIEACCCBABADCDCB@@E@EBC@ABIAIA"],encodeOffsets:[[116211,29656]]}},{type:"Feature",id:"430623",properties:{name:"华容县",cp:[112.540463,29.531057],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@A@A@CBA@AAA@A@ECAAA@CBC@IF@@EB@BIDKDE@GBI@C@@@C@CAA@C@C@CBED@@ABCDAHAFADADAB@H@FAB@BCFABCDEBABGDABOF@@ABGBCBABGBIDKDEB@@E@A@A@@BCBEDKF@@@B@@@@IFCDABA@ABEFA@@BA@@@CBA@@@CDCFA@KJABKJA@GHAF@BAD@DBJBJBH@@@@ABAF@@ADEDABUNEBAB@BAB@D@B@F@F@J@BAF@@A@A@A@AAC@CACACAAACAC@AAAAECAA@CAACACBC@ABEDCBCB@@AD@BAF@F@D@DAH@DADAH@F@DBB@DBF@DBB@D@DBHBFDNBF@D@@ABA@EDGDEBABADCD@D@B@@@B@B@FBHBFBFBDDBD@@BDBB@B@DBF@B@FBFAF@H@FAD@DA@@D@D@B@B@B@D@@AB@B@D@B@@@B@B@B@BBBBFJBBHJBFBBB@HHDDFHB@@ABG@@BA@@B@BB@@BBBHB@@B@BBD@D@@BB@@@B@@BBB@F@DBBBBBBBB@BDBBBBBDB@@BBB@@BBBBBDBB@@BBBDBB@@BD@B@D@D@D@D@D@BBD@B@B@BBB@@BBB@@BBBBBBBBBBB@@BD@BBB@BBBBB@BDFDAHADBDBBBD@DB@@B@B@DAB@BAB@BAB@BABAB@B@@@B@D@BBFBB@D@D@F@BAB@B@BAD@DAF@D@DAF@@AB@@@BA@@@A@@BA@A@@@@@A@@@C@C@E@@@A@ABA@ABABAB@@@BA@@@@@@B@BAB@D@B@@@B@@ABAB@@@B@JCD@DAFA@AFAD@B@BA@@@@B@BB@@F@B@BBBB@BBB@@DBDBBBDB@@@DDBFDDB@BB@DB@BDBDDFBD@BBB@@B@@F@D@FADAFAF@D@DABADADAHADADAB@BABA@A@ABCB@HBBBHDFFFBBBD@DAB@DAAAFCDCB@DABAB@DC@ADE@@BABCBC@ABCBCD@BBDB@BBBBBD@BDD@F@DBB@F@D@D@DCBCBC@CBABCDADBBBBBDBBDBDBD@B@@@@AD@BB@BAD@BB@B@BAB@D@B@BB@D@DBBBBDAD@BAD@BBBDFBBBDBBDD@DBBB@DBBBBBDBBD@F@D@B@D@D@BBDDDDBD@DBB@BBFBBABABAACAC@CBADAD@DBDDDDB@BBBBDBBBBBD@DDBDB@BAB@B@@@D@B@BDDBB@BDBD@BAB@D@D@DBDDBDBBBD@D@DBDBB@BBJADAB@DD@BABADAFAB@D@D@DBBDBB@D@BBD@DBBF@DAHADABADADBDDBBBDBBBDBDBDBBDBDABABCAABABCBCDABABADPDBBZPRNVTRPbVDBPFVBRAJCLCVMLGLGBADADCBGDG@EBC@GBM@GASGc@E@M@MBEFGDCDADAD@@@FBFBDDDDDBDDFDDDDF@BBFBFDFBDBFBFFHBFBDBB@FDFBFHJ\\RVRJPHJNHB@DBH@DABCDE@@@IK[AGCYAEEQESCGEIIOAAQO@@gYIKIKACCAEEEACAECAAA@ECC@OMEECAWUKKEFEBCDA@@BCBABABCDA@A@AB@@GFCFABABCAA@EAABE@CAAAC@E@C@C@A@C@AACACAACAAA@@@A@AAAACAC@AAAAAIAC@ECC@CAAACA@AACCEAAAE@EACAA@A@AACACACAC@A@ABA@@BADABAD@@A@A@@AACAAAA@@AAA@A@C@AAACACAA@AA@AECCCCECA@CAC@AAA@A@C@C@ADCBABAAA@@@AC@CAEAC@CAAACACACAGCCEAC@E@CACAACA@@AAC@CAA@AACAAAE@E@@BA@CBABA@@CE@AEI@EAMACIAKC@@mKUCAA@A@@AE@A@@EMAEEGACAEACACCCCA@@C@A@EAGEAAAC@GBEDE@E@@@A@@AA@@AA@A@@@AA@@@A@C@CBA@A@@@@@A@@D@@ABAB@@@B@@A@@BA@A@A@A@ABA@ABA@@@@B@BAB@@@B@BA@@@ABA@C@@@A@@@@@@@@BA@@A@@A@@@A@A@A@AB@@A@@@A@@@A@AB@@A@@AA@B@@ABABA@@@@@ABEAA@C@A@A@A@@A@AA@@@A@A@ABABC@CBC@CB@@@@AFADCDCHI@@BA@ADABCDABCJILCHAD@NABADEBEBAFEDCHAF@DBD@B@D@BAHE@ABCAC@C@ABAD@B@DADADCFCDEBC@C@A@AAEEI@E@A@@BMB@DCFGDCDADG@K@AGOAACMDKDA@@H@LBNDF@LBJBB@FH@@LPDFHHRRBJBPADCJCH@BADBFJDL@@@F@D@B@DDBBBB@@BJDJFTJX@DBF@R@JD@B@B@D@BBB@BDDDDB@@B@@@@BDBBB@BBBBD@F@BB@DAHADAD@@A@CCCACAEAC@GFWFMFQBI@@BE@IAKCQAIACM]EIAECEAEAEACCGAGCGACCG@E@A@CACCEAGAEAE@G@C@EAEAAAGAC@E@C@EAC@EAA@ADG@G@EOWAA@EAE@EAE@EAEAGAEAEAGAE@EAGCEAGAG@@CEGGEGICIAICA@AAA@A@A@A@AAC@@@EAA@A@A@A@A@@@A@@@A@@@A@@@@@A@@B@@AB@@@@@@A@C@GB@D@BA@@BCBA@ABA@A@A@A@@B@DABADCBABEDC@AACAC@ABA@A@@@ABA@ABCBA@CBA@ABA@@BEBE@C@A@G@AAABABABAB@D@DAB@D@B@DBDBDBBBD@DADABADABAB@D@DBBBDDBDB@DBD@D@@@BCBA@C@@B@FBB@B@B@@DBFDFBDDHDBDBF@BABABCBABCDC@ABABAD@DADBFBBDBB@HDFBBBBDDFB@BB@BA@ABC@ADGFADA@A@@@A@@@A@@ACACBABCBADAB@DADABAB@BA@@@ACA@AAAACCA@A@A@ABADCDAFEJCHCJCDABCFEDGFGDEDC@G@K@KAICECAAKGOKCCCAIKCGCECCEAC@C@C@@@@@IBGB"],["@@OLgXEDIBODBBDDD@JFJBD@N@BAREJEFCNIBCFGDG@IAG@EACKLED"]],encodeOffsets:[[[115264,30008]],[[115640,30489]]]}},{type:"Feature",id:"430624",properties:{name:"湘阴县",cp:[112.909426,28.689104],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CBABABADCDABABC@CAAA@AAAACCACAEACBC@E@C@C@@AC@CAEAA@GCEAA@AACCBCFAFABA@CAC@CBCDADABEBCBCBADCF@B@@@FANGDAHCF@F@FBF@D@D@B@DC@ABCDAD@FADAD@B@HAF@DAFADADABC@A@CCACAC@C@C@CAACACCACA@@CC@ECK@GAE@@@EAAAAAC@@AC@AAC@CCACACACCCCACACACAC@CBA@CBCBA@ABADE@CBA@C@CAA@AACAAACCEAAAC@CBA@ABC@CACAC@C@CAGAACEACCCCACCCACAC@CCEAAAEBE@C@CACACACACAA@CAC@C@AAECCCCAAAC@ABABGHEFABC@ABA@ABA@A@C@C@CCEAE@A@I@C@C@A@ABCBAB@BABAB@BABA@@@AAA@ACCAA@@@EAE@E@A@C@A@AAAA@A@CBCBE@CBE@A@C@AAA@@EBA@ABC@@BA@@B@D@BA@CBA@AB@@CBCBABA@A@C@CAC@CAK@A@A@ABE@ABC@A@CAA@A@C@@@AB@B@B@B@FBDBF@BAB@B@B@BA@@BC@A@A@@@AAC@@@AAAAACAEAAA@CAA@C@C@A@A@C@C@ABC@AB@BABA@@BADAB@B@BAB@DBBAD@BBDBDB@BBJHDHHFDHFD@DB@A@@BC@SCGCICQGAAA@GECCGKGGEC@@@ACCA@EK@@AKEA@@A@A@@@@@C@@@AA@CBGA@CA@@@A@@AA@@A@@@IA@@C@EAGBC@@B@BC@CDC@A@A@E@A@EDGDMLIDKDA@A@ABA@AB@BBFDF@BA@@D@@AB@D@B@DB@BFBBHDFBDBB@@BDDB@D@D@D@B@B@B@@BDDDBB@B@BAB@D@FBD@BBDDBBDDBB@BBBB@@BD@@B@B@@@BCDAB@DADCDEFABA@@@ICCAA@ABABCJ@D@B@@B@B@D@BBB@@@@B@D@D@@@B@BA@A@CAC@C@CBADABA@A@ABC@A@A@CAA@CCC@CAE@C@C@A@A@AA@A@CGKAGAAA@A@A@ABEBABA@CAEACAA@AACEACAAGOCEA@A@C@A@AAACA@EAC@AA@A@A@AAC@CACCCCAGCGAEACAGAEAE@E@CAEBC@C@CBAB@@AFAB@DAD@BABABCDABCBA@CBIBGBEB@@GDCB@@@B@DBDBDDFBDFHFFBDBBB@BDDBFBB@@BBB@B@B@B@@BBB@B@@@BD@B@@DB@BBB@@@BABCBA@AB@@@BBBDD@B@@@@@@@BABCD@B@B@@@HBD@DBDBDBB@@ABA@E@CBCAAAA@AEAAAA@@C@AB@@CBCBCB@@@@ABA@@@ABAD@DADABA@@@GHAD@DA@A@A@AACCCAEAG@CBA@E@A@ADA@A@C@AB@@@D@BABC@ABEBEF@@EBA@GDHHHFDFBBDH@@DRBF@DAL@F@DDRDF@BLFJBJBFB@@B@HFB@BDBB@@@BB@ADAFCD@DA@BB@BD@B@DBB@BBDAB@BAB@B@FBBBFDB@BDDBDBDBBBDBB@B@@@B@B@@@@@B@BBB@DB@@F@DBBBB@B@DABCB@B@BBAH@H@HDHDDHH@@DDHHBF@F@FD@BBB@HBDBBDBBHBFBBBB@BBDBD@HAD@BDFBD@DADCD@PD@@DBBBB@BBBD@D@@@@@BC@AB@@AB@BBB@BBB@B@B@D@FAD@DCDEDCBAB@DAD@F@DCFCD@D@D@FFFFFFF@B@DBH@BBB@BBB@BBBBBDF@@BD@BBDDDBBHHBBFBJ@D@H@JBJBJBB@D@D@F@D@HBD@BBD@DBB@FBBBDBDBBBDBBBDBB@DBDBB@FBD@FBDBD@BBFDFB@BBDBBBBDDFBFBJBHBB@B@B@B@B@BBBBHDHDJDNFB@LBZHTLJPXTPVDVDTTMDELQ@I@EIQGOCGAAEKEQCEAKAGBOLSFKNSFGDQ@OAMCQAQF@D@F@FFB@DDH@JADCDCDCFAJ@B@@@B@B@NFBBF@HAHCHAFCDCBAB@DALCF@B@HB@@B@F@FAD@FAFAFCBA@@@@DEBAB@@ADAFCDAB@@A@@BCBE@ADCBAFCHCDAFCDABABCBAAECAACCAA@@CBCAACGCICEAEAC@C@EBA@@BEDCBCAC@A@A@A@CDCDEBEFGBCBC@AACCECGCCIGCEAACAAAC@"],encodeOffsets:[[115718,29389]]}},{type:"Feature",id:"430681",properties:{name:"汨罗市",cp:[113.067251,28.806881],childNum:1},geometry:{type:"Polygon",coordinates:["@@AD@@@BA@CBEDCB@BA@ABCF@@@@ABEDEBEBC@EBE@A@@@GAA@E@KDCBA@ABCDEDGBGDGBE@AAMEA@A@@@A@I@EBCDCDCDIBG@CCA@EEE@C@E@BRDRBN@PCREHMTELKTAPBHBLDFFRFLBBDHHPJR@F@JKRCFSNHJVV\\T`PFDJHL^JNJEHCDCBAHADHBBDHDHFJBFBJTCHAFCBCBE@C@A@CBC@C@E@A@CBC@GBC@CDCBCBCDABAB@FCB@D@DBHBB@D@B@D@BADADCD@DBD@D@D@BADAB@BD@@BBDABABCDCFEDAFCD@F@@@B@DBBBDBBBD@BAD@DAD@D@F@B@BADABC@A@ABADABABABAB@FEB@@@BADADADAD@DADABADABABADAD@DAD@D@DADCJGHEFEFCDAD@BBB@HBF@DC@A@CBCDADCD@FBF@FCDAD@DBDBDBBDFDFDD@B@B@BABCBCACAI@ADCDBDDBBDDF@B@DADAHCFADDBBBDDDFBBBH@D@BBD@BDDBDDHFHDDBBD@DAHAD@D@BBDBBHBHBDAFAFCD@DBDBFDFDDBBAF@DAHEJCDAD@DBH@F@F@DDFHFDDBBADAFADAFCFCDCBCBCAE@CDCBAFAB@F@L@LBNBF@FABA@ADADCDCFCD@FAF@FBBCBABEBCDCBCDCBCBCBE@C@A@A@@A@GGEE@A@A@@BA@ABCFC@@@A@@@@HEFCFABE@C@A@@BCAEEG@AAA@A@CBABE@@BCFCD@DAHAFAHAHCDAJCDCB@BAB@DCDCDCBABEBE@ABEBC@CACAACAAAAAEBAB@@ADCFCFC@CACCEECEEGCAC@CDCBC@MGGCCACEECGIIGCAAAAAAAGACBABGJKT@@EHCHABCBCBEAAACCACEECCGCIEC@CA@@ABA@@@A@AB@@A@EBE@G@A@IAA@A@@A@@@@@@@@A@A@ABCBA@ABE@CBAAC@CAAAACAC@CBC@CDCBABA@@@@BABCBA@A@AACCE@@@AAA@CAA@@A@AAA@AAAAAAACEICA@CAAAGCEAEAEAAAAA@A@CBEB@@CBEDABA@AAC@CAA@AAC@CAMAC@E@A@C@C@EBABABCDABABAB@@A@@@ABABA@ABA@ADCFADAB@DAB@BABA@A@ABCBABC@A@A@ABA@CBA@C@@@A@@@AAAA@CCC@CAA@A@A@A@@@A@@@A@A@A@ABC@ABC@@AA@A@AAA@@AAA@AAA@@AAA@@@A@@@A@@BA@A@A@@@A@A@@@A@A@@@A@@D@@AB@@AB@@A@@B@@@BBB@@@B@@ABA@A@A@@@AAA@A@@@AA@@@@ABA@@BA@@@A@A@@B@@@B@BA@@@@B@AA@@@AA@@A@@@A@A@A@@BA@A@A@A@AB@B@@@BA@@@AA@@A@A@A@@B@@@B@@@B@BBB@@A@@@@AAAAA@AAA@@@AACC@@@A@@BE@@BAB@@@BA@@@AA@@AA@@AA@@A@@@@BE@A@@@A@AA@@A@@@ABA@@@A@@@AA@@AAA@A@AAA@@AA@@AA@@@@@A@@B@BA@@@@BA@@@@AA@@@ABAB@@@@A@@@@AA@@A@@A@@@@@A@@@A@A@C@@AA@@AA@@@AA@@@@AA@@AB@@@@A@A@@@A@A@@BAB@BAB@D@BAB@B@B@B@B@BABADAB@DAB@D@D@HBD@B@@@@AAABAB@B@@ABABAB@@AB@@@@ABABABABABAB@D@B@@@@A@ABA@@@AB@D@@G@A@A@CBE@C@A@CAACAC@CACACAEAAAC@CAAAA@AA@AAABC@ABGBE@C@C@ACACCECCACCACA@AAE@A@@AAAACAACAC@EAC@AAAAAA@ABABCDE@ICECE@CBC@CACBC@ABA@ABAB@DAFBBA@ABABADABFDC@GDEACAAAAACAAAACAA@BC@CBEAACCEIAAAA@AACAC@C@@AK@GAE@C@ABABABC@@@AAA@AA@CAAAC@AAA@@A@CAA@AAAAAA@A@A@@A@@@AAC@ABC@C@A@@AA@AAA@@CACA@@AAC@CAAAACCAEACACBC@EBA@C@C@AAC@CCCAABAD@@BB@B@D@@DDABBFCBBDAD@BDBABBHCFABAD@@CBC@EBC@C@C@CAAAEBC@@BIBCD@BAB@BC@ABABC@@@A@AACAEAAFA@C@@D@DAB@DIDAD@BAFA@CACCCCA@@DCAAC@CEGBAACB@AECCAAAAC@@EC@ACACCACAEEEEECC@ABC@A@C@C@CACCCACACEAAAEAA@AEAKECAICICEAGAEAA@@@C@ABAB@BABABA@A@EACBA@CBCBABABABEFCDABAB@B@BB@@BDBDBB@@BBBBB@B@BBD@BBB@BDBBBD@@BBBB@@BBB@@@BAB@BABAD@B@B@D@BBDDD@BBB@B@D@B@D@B@@@FD@DBDBDDDBDDBDDFBBBH@D@DBDBD@DAD@BAB@DBDBBDFBDBBBD@BBB@D@DAB@DCFAB@BABAD@DAB@DBDBDBDBDDDDDDBDBDB@DBD@BBD@@BDBBBB@F@@BF@HDL@FDD@@DBDBBDBDDBD@D@D@DBDB@D@BADCBCBEBCBE@GBA@C@CBEBC@CBAD@BCDA@C@C@E@EAE@E@GDCBMHEB@@A@E@CDABADADAFCBCBAD@DBD@DABEBEBADDDBBB@FBHDB@FBDBD@@BD@D@F@D@DAFBDBDBBDBB@BBBDBD@BABADCBCBABADAB@D@BBDBBBDFJHDDDHDFBD@BADADEHAFCFCD@D@B@B@BBDADCDAF@@AB@F@DBDBFDFDJDHBBAD@DB@DBBDDBBFABADABCBEDCBGDEDABCD@BAF"],encodeOffsets:[[115718,29495]]}},{type:"Feature",id:"430626",properties:{name:"平江县",cp:[113.581234,28.701868],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@BABADAF@FBDDDFDDBDDDDFFDDFBBBBBJ@@@B@D@DDBDDFDFBFAD@DDDBHDBA@@BAB@B@DBHDHBJBHDFBD@F@DAD@FADABADEBCDCFEBC@C@AAA@AAC@EAGCAAE@CFCDAD@DADADADCBCBAB@D@D@D@FBDBDDFDD@DBD@J@DAFBD@@CCAACCCACCG@EBCDEBEAC@AAABC@AHCD@JADADADAB@F@FBD@F@HADBDD@BBFHD@@B@DBFBDBDBDDFDD@DBF@D@B@FEBA@ABCACBE@C@@@A@A@CAE@EAG@CBCBCHEDEBCDEDCDCB@@A@A@ACI@CEGAC@C@C@A@ABA@CBC@CBAB@@GBABIBABE@CDKBG@AB@@@BA@@DAFEBA@@@@B@@@BBFHDDDFDBDB@@HDF@D@D@DBDBJF@A@@@@B@@@@@@@@@B@@@B@@@@A@@@@@@@A@A@A@A@A@A@@@A@@@A@@@C@A@@AA@A@A@CACAI@@AGAA@A@C@G@ABA@ABB@A@@F@B@@@DBDAD@BCDADCDEBCBGBEBI@CDE@BB@BDDFDFFHDDBBDBD@D@D@D@DADABADAFAFEDGBK@@BE@EAA@@CACACC@@AECCAC@C@CBC@CBABCDC@ABC@CCA@C@C@CBA@AD@D@DBD@BA@CBC@CDABEFCDAD@F@D@D@B@D@D@DAFBDBDBDBFBHBB@FBDBFDLB@@D@FCAC@EBABEDEBABCD@D@D@FBBBDDFFBDBB@@DC@CBE@EAEACBABC@ABA@CAGAC@E@CAEAAACACCCEAAACCAA@C@CAAACAEDCDADAFCFAD@FC@@BCBEBC@EAGCGAE@CAC@C@CACCCAAAC@A@C@@DCBE@CACAC@CCCCAACAC@IAEAE@@E@E@AACAE@@@CAACCGAE@A@ABA@C@C@C@CDCBAHEFE@AACCEAEBCBABA@E@C@ACCAC@C@@@ADEDAAEAAAACCAE@CAAAACAAC@C@A@CBCBAB@DADA@@FQCCACEEAAAC@CACAEECAA@AA@EAC@C@CAC@CACCAEAEECCC@@AC@C@C@CACCACAC@CC@C@@CAE@C@C@C@C@CCCAAAACCCGACAE@CAC@C@ABCBCDABCBEBEAGCAACAGEAAE@C@A@CBCBAB@JAD@BABADABEBCB@@GFGDC@ABA@A@@@AACAE@C@A@A@EBC@A@ABA@A@AAAAACCCEKAEEMAAEAKEECEIAACCCCCA@@ABA@ADABABABAAC@GCUEAAEBE@CBCBA@CBA@@@AACACCEEGGCCAAA@A@A@EBCBABEBEDIFCDEDCDABABABA@CAAAEAECECCCACAGCECIEMCEAC@A@A@@@ABE@CACAAA@CCA@AACACEEOCI@AECQMEACAA@A@C@EBC@C@A@CBEAIGECEAG@C@GBE@ICKAGCKCIA@AGACAA@CCACAAGGAKAA@AC@GAQAA@GFGFAB@@CDCDEDCBADEBEDA@ABCBCBEBC@EBCBA@E@A@AB@@A@@B@B@@@BB@BDDBBB@B@BA@ADABABA@CBAB@BABABAD@BADABABAB@@ABA@ADA@A@CDABADABA@@B@@A@A@CAC@AB@B@BABADCDAB@@ABEBA@CBCDCDABA@IJ@@CDCDCDABCDGHEHABCFCBABAFADCB@BABABABA@ABA@@@CA@@GAEC@@A@C@C@E@A@@@A@AACAAAAC@AAAA@AA@@@@A@A@CBC@CBC@@@CBC@IBEA@BA@CDCBADCBCB@BA@A@A@A@A@C@@@C@@@A@A@@@ABADAB@DAB@DAFAH@F@DAD@BADABAD@BABABCBCDEBEBID@BCBC@ABABAB@@@B@BBBAB@DCDCFEDCDCDA@@BA@A@A@AACCACAAAAA@@@CBA@A@AB@BABA@A@@AAAAA@AA@A@E@G@G@AA@@@AAAACAC@C@A@AAA@@A@AB@BAFAD@DABA@@@ABAAA@AAAB@B@DBJBN@DBBBBBBB@@B@B@BCD@BAD@BBD@BB@D@B@B@@B@@BD@@BF@FBD@D@B@B@BABA@AACAGAGAC@A@@@A@EBEBEDGFIFC@KFE@C@CACAGCCAECAAACA@@@AAAACC@KACEGCAAAC@@@@AC@C@EBABE@KBCAOE@@A@C@A@@@C@ABADAB@B@D@D@FBBABABCBEDKBM@ABEBC@CBCBC@CBCAA@A@AAA@AC@CAAA@@@AAA@C@C@C@A@A@A@AAAAAAAAA@C@ABA@CBC@@F@DCDABAB@BABA@@BBB@BBBBB@@ABADCD@B@B@@@@@B@BAB@B@@CDABCDA@A@CBA@C@A@@B@@AB@B@B@@A@C@A@ABABABABAB@B@@A@@BA@ABAB@BA@A@ABBB@B@@A@C@GAC@C@A@CBA@CBABABA@A@A@A@A@ABC@A@ABA@AB@@@B@B@@@B@B@@A@@BB@@B@@B@@B@@BB@@BB@@@D@B@B@@@B@@@@@BB@@@BB@@@@@B@@A@AB@B@@BB@@@@AB@@@@ABA@@@@B@@@@BB@@BB@@BB@B@BBB@BB@@B@@@B@@AB@B@@@BB@@B@B@@@BAF@@@@@BB@@BB@@BB@@B@@AB@@A@AB@@AF@@@B@@DDBB@@B@BBB@BBBB@@@@@BA@AAA@@@A@@@A@@@@B@B@BB@@B@@AB@@A@A@@B@B@B@BAB@@@B@B@B@@@BB@@B@@BBA@@@@@ABA@@@A@@@@B@B@@AB@@AB@B@@B@@B@@@BBB@B@@@B@BAB@BA@@@A@AA@@A@@@@BA@@BA@@BC@@@@B@@@B@B@@@B@B@@@B@BAB@@@B@@@B@@BB@BB@BBB@BB@@BB@B@BBB@@AD@BAD@B@B@B@B@@@B@@@B@B@BBB@DDD@DBBBB@@B@@@D@B@DAB@BAB@B@D@BADABAB@B@BA@ABA@CBABCDEBCB@BAB@BABA@@B@@@BABABADCBABAFAD@D@B@F@D@NBDBD@BBB@DBD@BBB@BAFCDA@@FADAB@B@BBBBBFBFDFBHBB@DDBFJBDBBBBBBB@BBB@@@BB@DBB@B@@DFBD@B@BABADAB@@@@ABABCD@DAD@DBDBDBBDBD@BBDAF@BAB@DABAB@B@@@@@@@@@@BB@B@JBB@H@F@FAB@@@BAB@@@B@BA@@DBD@JFHDDDFFBDDDBBFBDADABADGFG@@LSHIBADAHBBBBBBBDBJHHJFDDFDBHDNHD@DADCD@DBFHDFFFDDDBD@DEDEBC@@BAFABBBBDBBBBD@DADAF@BAFAFABCDCDCDA@ABA@CDIDCBGDGBEBGBCBC@EDAD@@AFAB@D@BBB@BFHBFAD@@@B@DAFEBEDGF@@@@@B@@EDAD@BAB@@@B@BFFHHB@@@@B@B@DAFADADCDADCDADDAD@F@B@@BDBDDBDD@B@@@B@DAB@BBDBFDDBBDBDBBDBH@D@D@HBDDFBD@BBD@DG@A@ADAD@F@@@D@LBHBB@D@BABABC@GDCD@DAB@BA@CBAL@FBDBJBJBBAD@FAF@@@DADCBCBADADDFBNJDDDBD@F@B@H@DAD@FADBDBHBFDBB@@B@BDBD@BBDBD@D@DBDBBFBB@B@FAD@DADC@A@CAAEEGEACBADAF@DBF@DBDB@DADADADBDBBF@BA@C@@B@@A@ABC@GDAD@DBDBFBFAFAD@DAB@DD@D@F@F@L@H@FBDAB@B@B@@BBBBDHBBFFB@BDBD@B@BAB@DBBBDDDDBFDJFPAD@DEDEB@B@B@BDDBBHBFHFD@DBLDHDFDHBDAH@PEHCJ@D@D@HDFHBDP\\@@@B@@FFDDB@DAFBD@B@"],encodeOffsets:[[116467,29759]]}},{type:"Feature",id:"430682",properties:{name:"临湘市",cp:[113.450423,29.476849],childNum:1},geometry:{type:"Polygon",coordinates:["@@PTHJNPBBPTVXNNNPDFXXBBFFNJDBNNFDFFFFHFFFXRLHFFTLdPJHXRFDFD\\VJHPRZZ^VXRDBJHFBLHLJB@FDDB@EBABABABCFEDEBA@@@A@@@AAAAAAA@AAAAAAA@ABA@@BC@A@A@AAAA@AA@@CA@AA@@@CAA@A@A@AAA@@ABCBAB@B@DBB@B@B@DA@@@A@AA@C@AACA@AAA@AAA@AC@@AAAAECE@A@@@AB@BADADA@@@A@@A@@@AAC@A@AAAAAAA@@CAECCACACCEAA@A@@DADCBA@@AAAA@A@CBABCDEDGBG@KAG@CCKSaGIGKC]@ARQVALAH@PDRAXEB@F@BABA@@BABAD@@AF@BBBDBBB@B@BBBAB@BAD@B@DBD@B@D@@@B@@@B@@@BA@BB@D@B@BAB@B@B@BBBBBBB@F@B@B@@B@@@BA@A@@B@@@BB@BBBDDB@BBB@BB@B@B@B@DA@@B@BBDBB@@@B@B@B@@A@A@AA@AA@ABC@@BABABA@ABABABA@AACAE@A@@@@@@DA@ABABCBA@EBC@A@A@@BA@AB@BABABABABA@ABAA@@A@@A@@@A@@@@@A@C@@@A@@@C@@@A@@A@@@A@AB@@@BAB@BAB@BA@@B@B@@@B@DB@BDBBB@@@BB@@@@@BBB@@@BA@@BA@@BA@@@@B@B@@@B@B@B@@@@@AA@@@AA@@A@@AA@@@@@A@@@@B@BB@@@@@@B@@@BAB@B@@@@@B@@@B@@@DB@@B@@@@@B@@A@A@@A@@A@@@@@A@@@@@AB@@@@@BA@@A@@@AA@@@@AA@@@@B@@A@@B@@A@A@A@@@CA@@A@@@A@@B@@@BA@AB@@A@@@@AA@@@A@@@AB@@ABA@@BA@A@@@@B@@@@@BBBB@@B@B@B@@@B@B@B@BBB@@@B@@@B@B@@@BA@A@ABA@@BE@@BA@@@ABA@AB@@A@ABA@@BABABA@AB@@@@AB@@@B@@BB@B@B@@@@@BAB@BC@@B@AA@A@A@A@@@@AA@@AB@AA@@@@A@@@@@@BA@A@A@C@A@@A@@A@@@@A@@A@@@A@@A@CA@@A@@@A@A@@@AAA@@@@@@@AB@@A@@@A@AAC@@A@@@@@A@@A@@AA@@@A@@@C@A@@AC@@@A@A@CA@@@AA@@@@A@@B@BA@@@A@@@@AA@AAAAA@@@A@@BABA@@@@A@@AA@@@@A@@B@@A@AA@@@@AA@AA@@CA@@A@@@A@AB@@A@@B@B@@@@@@A@@@A@@AA@@A@@@AA@@A@@A@@@A@@A@@@@A@A@CA@@@A@@@@@AA@@BA@@@@@@@AA@@@@AB@@A@@@AA@@@@A@@BA@@@A@@@@@A@@@A@@@@@@@AA@@AA@@@@@A@@@A@@@@@A@@@@@A@@@@B@@A@@@@@@@@@AB@@@@@@@@A@@EAAAA@A@A@@@A@A@AAA@@A@@A@@@@@A@@@CBA@@@A@A@A@@@A@ABAAA@@@@@A@@@@@A@@@@@A@@@@@A@@A@@@@@@@A@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@A@@@A@@@G@AA@@A@AA@@@@A@A@@@@@AA@@AAA@AA@@A@A@AA@@A@@AA@A@@@@@@@@@A@@A@@AB@@A@@A@@@@@@@C@A@A@A@@@@@A@@B@B@@@@AB@@A@@@@@A@@@A@@@@AAB@@A@@@AB@@@B@@@B@@AB@@@B@B@DA@@B@@@F@@@D@B@B@@@B@FB@@B@@@@@@@@@@@@@@B@@@@BB@@@@B@@AB@@@BAB@@@@@B@@@@@B@@B@@@@@B@@B@@@BAB@B@B@B@BB@@B@BBB@@@B@@@@@@A@@@A@@B@@@BBDB@@BB@@B@@BBAB@@AB@B@@A@@BB@@B@@AB@@@BA@@B@@@BAB@@@@@@@@@@A@AB@@@@A@@DAFA@AB@@A@@@A@@@A@@@@@A@@B@@@A@@A@@@@BABA@ABAB@@A@AB@@AB@@A@@@AB@@@B@@A@@B@@@B@@@BA@@@A@A@@B@@@B@B@@@BAA@BA@@@@B@@@B@@@BA@@A@B@@A@@BB@A@@@A@@B@@AB@@@B@B@@@B@@@AA@@@@BA@@B@@@@@@A@@@@BA@@@A@@B@@@B@@A@@@@BA@@B@@@B@B@B@@@@@B@@AB@FA@@B@B@FAB@DC@@D@@@@AAAA@@A@@BABABABCBA@ADAB@BCBA@A@A@AB@BCB@@AB@BA@A@GAAACCACCICCCACAACC@@CC@@@A@@JO@C@A@CGGCEACAC@CACAC@A@@AACACAAAAACACBC@AB@@EDC@EBGAIAA@A@C@E@ABC@CAC@C@CAEACAE@CACCCCEEACCEACCGACBC@@@CBA@C@C@E@C@C@ADCBC@@@A@AAC@CAA@CEKAACAEAGAA@EAGCEEIECCAEAECE@AAEA@AKISCECICICG@C@@EAAAAACA@C@C@C@C@A@C@C@AED@@@@AACAA@ABABCFCJ@@ABEJ@@CBE@GC@@MAE@EBA@CAA@EBA@ABABA@ADA@AFCDGDCAC@AAEAEAECAACCACACEACCEACACAE@E@A@A@A@E@C@CFADCFGJGBA@C@CAECEAAACAAACACEACAC@A@@@ADCBCDABCAEECCACCACCAAA@@@ACCCACCGAICCACAC@EAAAECAEACACCCCCAA@@@ACCCCA@AACACCCEC@CCECCCA@E@ABA@@@CD@@@@ABCBEDC@ED@@CBC@GCAA@@A@A@EFGDC@A@CCCEACACCAA@ABCDABEBIDA@A@AAAECA@@AAABABADEJ@B@BBDBB@D@FCD@F@D@DBBF@BD@BCBE@MB@@ABA@EB@@AAAA@ABAB@B@BAA@@AAAC@E@KBCD@@AD@BBBDDBBBDFDBBBBB@@@B@@@B@@@B@B@B@@@BBB@@BB@BDB@@@@BBB@D@B@BAFA@@BA@@@@B@BB@@BBBB@B@BB@@B@@@BB@@BB@DBB@B@BBBBB@B@@BBB@@@B@DDBBBD@D@B@@ABBL@H@JBF@BBD@B@D@DADAH@BABAHAFBB@DBBADCBEBA@AB@DEFABCDA@C@CAC@E@E@C@EBC@@@CBCDCBAD@D@F@D@DBFBDBBDBFBDBDBB@DBDBBFBB@BDBFBD@DHADAF@DAHADAFADAD@@AD@BABAD@@AD@BBDBFDHBB@BA@CBIDC@@@C@C@@@BD@@BBDDBBBFBBBAB@@CAA@A@ABA@@@@DBB@BBD@BABA@@@AD@B@BDBFDFDD@BDD@DAF@FDBBBD@BCB@DADA@AHCFABB@@BBDFBDBDB@DADADCDEDCDADDPPBF@B@@@BA@@@@BA@@B@@GD@BCB@D@JCDCBCBC@ECCAEAG@GDAD@BBDDDFDBDBH@HABABAAAA@AA@A@CDADBB@BADCBC@EAMAI@@BC@I@CD@FDBF@D@PBPDNANAHD@B@DBF@D@B@BDL@D@FBD@BB@FBJABA@B@AF@F@DBDFBBDBFFFJHFD@FAJAHCHCPAB@BBBJ@D@DBB@BIJABA@CBEDEBCBCB@BE@MBGDEHCFG@MAEB@BAB@BDDDBNDH@F@DBHHP^HFDDLHDBB@FDDBBBBD@BABAB@BA@A@AB@@A@CB@BC@ABA@EBCFQJKDKBEAAEBKBIAMECCCMBMJELKLGFEBEBC@@@ACACCGCANfHH@BADAFAF@BA@IBABOWAA@@@@AC@AEIIMGCI@OHMFCB"],encodeOffsets:[[116028,30337]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("张家界",{type:"FeatureCollection",features:[{type:"Feature",id:"430811",properties:{name:"武陵源区",cp:[110.550433,29.34573],childNum:1},geometry:{type:"Polygon",coordinates:["@@HIHQ@I@@A@G@A@A@IBGFYJQHCBIFCFCHCBQZAF[R]TSHGA@@@@C@IGGEKAE@GHCLAJCFABE@MAI@GB@BCD@ZAHC^CDA@EFSROLCFQJQBG@C@EDMFOF@@EBC@AE@IEG@@GEKAICE@CBEBAD@FNFHFBF@F@DAFGLEHBDDFBDB@@B@BDDBDDBDBF@DBDBDBDBFBF@DBDBH@F@D@JBJ@F@FBL@JBD@HBJDJBLBHBJBB@D@DBFBBBB@B@@@H@TDRD@BJLHPLHJADEDCHKJCH@XJFLALAR@@DHFDH@F@BC@A@Q@CHAHCFGHIBA@@BCBG@ABMBC@CDIDIFGDCFCFAJ@HJDFDBHHLHLHHDB@F@B@D@BADCBM@IFIRIBAHCNCPCFAV@D@TAH@H@LBHALELIFCDAH@LBN@HCDEBEAGEIIMCC@ACCIGSKEAEAaKIGACCEQGKCMBA@MBK@KAGECCMECI@EBEOLA@ABC@CCCCCAA@E@@AE@E@C@CACAE@EAEAC@E@EBC@EC@@FUDILUBADGVe"],encodeOffsets:[[113207,29938]]}},{type:"Feature",id:"430822",properties:{name:"桑植县",cp:[110.204652,29.414111],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@A@AEEEAEACASZCDGJED@@IFQJEBGB@BAB@@A@@@ABC@C@GAIE@@EACCGCWQCCQOCAQMCACBC@QNEBE@ABE@I@KBKBCBEDCBABCDKLOLUFID@B@BBBHBDDPFDDDFLJ@LCLADEFEDCBEBCH@F@BDFJPBF@HEJABGJEhCJCLCLKPIFG@C@E@A@GAECAA}uECMKQOCCIIOKOKOMGTGJCDGDWZEFA@CDED@B@BBHBBJJDDFBDB@B@@@FAHADCBCD@B@DBD@DADABCDEFABMROV@@@BADAH@F@B@BADCBG@ABCB@FELOHIJABEP@@ITGNCFEDAB@DBBFHFDF@DADBB@@DBDBBLJFFRLVNFD@BADCFGBGDEDADCH@@AF@F@BDBDFBFAFCFABA@GB@@UBI@GAIEKEGAG@EDIFDBBBAFAFCFCFCFQJ@DBBHFJHBBDDBFDFBF@FGPAHBFB@JBD@FBXFHDFDHDHBHBJFDDDDBDBD@D@D@DADCDA@ED@B@DBDBBFDLF@@DDBB@B@BCDCDUH@@A@@B@@@@B@@@BB@@@@@@@@A@@B@@A@@@@A@@AA@@@@A@@@@BA@@@@@A@@@@B@B@@@B@@@@@BAB@@@B@@@@@B@@B@BB@@@B@@B@@@@@@@@BB@@@PAFBDB@FFHHHHDLFLFNDJBJBJBPDL@L@H@LBJ@NDL@F@NDFAFCFADCDABAD@FAF@FDFDFBDAFCDEBAJ@NFNFDBD@BB@@@@BB@BB@@@BBB@@@@BB@@@@@BA@@@@B@@@B@@@@@@@B@@A@@B@@B@@B@@@BB@@BB@@BBBB@@B@@@@@@@B@@A@@@AB@@A@@@@@@@@@@A@@A@@@@@@B@BCB@J@J@RAHCFCF@HBHBHDF@HAH@HCJBNANBLDJDRDJDNDNDDBRBNDH@JBNBLBNBL@JCLEDABAHCFCHEFC@@HEFEBCHEFEDEFGF@DAB@H@H@JBJ@HBF@HAH@DAHATGLAFCDAFEFCHANGHCB@JGJGHIHCJGLEFAFEHGFEDE@@DO@O@C@GBG@CDEFCFC@@DAB@D@JALBJFPDLBJBDAD@@@@A@@@A@A@@@ABA@ABADADCBABABABABA@A@AAA@AAA@C@@@A@@@ADEBA@AB@DCDAB@BAB@DAD@B@B@BA@@B@@@B@B@BBB@BBBBB@DBBBDBBBB@B@@BFBDBB@BBDBDBB@BBB@DBDBFBB@@@BB@@@BB@B@@@BB@@FB@@@B@B@@@D@BBB@B@@BBB@B@B@B@B@B@D@B@D@D@B@@@B@B@B@B@B@B@B@B@@@BBD@B@DBB@B@BBB@BB@BBB@D@FABBF@D@HBDAHBF@BBFDB@BDBDFDDBBBD@DBB@DDBDDB@B@DABABA@@DCPEHAFCHADALGJCBADCF@BAH@HBD@B@FDNDHFDBHFHFFBFBNFJDFDHHJJFFHFFDLFBBJHLFJFPF@@DDFFDDDDDBD@D@D@@@DGBC@CDA@AD@DAD@F@DAFAF@DADCDCDC@CBC@CAC@CAEBE@EBCBCDCBCDCFCDAF@FAHAHABA@@@@FAF@HAFAFCFC@@FAHCFEHADCD@D@B@BBBBDBD@DD@BBBDDBBB@BB@@B@BDDDBDBBBBBDFDBBDBDBDDDBFBBA@ABA@ABE@C@E@A@A@ABE@E@C@C@A@C@E@C@C@CB@@EA@@CDSCE@CCEAGECCE@@CI@GBGBEBI@C@CAEACAAAA@AECIAG@MAKBSCI@G@OCMEOEICGBA@E@G@CCEEEGCGEEKEMEGCQIQMCECMBMBI@A@C@CEO@M@EAK@CAGAGACAI@CEI@CCIAIEI@A@@EIIIACGOGMCOAGCIA@GOM_E@A@GCKGKGGGCACEGII@EBEDCDEHCJCJ@DADAN@BAHAD@@ABGJEHGDGB@D@R@BADE@G@ECCG@@BQBKEKWIG@IDGLCDCFIBKGGOIK@AQCSCG@@@A@A@AAEACAC@A@IAGAKAIAICGAC@IAK@EAE@I@IAC@E@G@CACAE@EACACACACAE@CACAACCC@A@AA@ACCEACACEEECCAECCAEAC@EBEBA@MCGEEEIEKBGDICCCEEGG@AECACEACEOOMMEAE@GDELABCLIHABEDGDC@KDS@UGCAICCA@@GEKIIGMGQKGGAKBOAABAB@@A@@B@"],encodeOffsets:[[112767,30003]]}},{type:"Feature",id:"430821",properties:{name:"慈利县",cp:[111.139775,29.429999],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@C@A@AAC@ABC@A@CAA@A@AAAA@A@AAC@A@A@C@CAC@E@A@A@C@CAA@CAEC@@C@CACACAEAEBC@ABGDABAD@D@BADADCBEDCBCFEDABC@AB@@@@EACCECCAAAAAGCKEKCC@ACKECCGEG@G@A@A@A@A@AAAAA@CACCA@ACECGAGEE@E@GAGAE@G@GBCCCAA@@D@FAFADCBADEFEFGBK@G@A@ACCGEICACAEACAGACAC@CBA@EDABCDABABC@C@C@A@C@ABCBCBABCB@@A@CACACCAACAACAAAAC@A@CAE@ECGAGAGCE@AACAEAA@ACCAAAAA@A@ABCBA@AB@@ACAAAAAC@@AA@CAA@C@CBA@C@@@C@CACAAAC@@AC@C@AAA@AA@CAAQCICC@@@KBGBKBCFGJEHGDIFIDG@CBKLABMPADCJ@FBJ@BFFHHFBHFBB@@BBFBDDBB@@@BBB@DCFMBENAP@HS@RNJHDDTRLNBFFFFDFFJD@BABCBKJCBIFCBGDMDCBCBCJELCDADEFCBGFAB@@GHOLMV@@@BCB@BABAH@BCBCFEBAFGJCHBHAF@FDJNFDDHFLBL@NAB@NALDRHDFBDJHbLFBFBTLJHDD@BDDJNFJBHAFCFGDM@KAG@CBEDKJKFGBKAG@G@SBC@U@EBODMDGDABQJEJ@JANCDABC@A@N`HPB@DJBHDPHNHPBDJJFJ@@@BFJBJDJ@DFJ@DBJBDBHBH@DBL@F@NFP@D@D@BAJANDNDFRNRJHDNFLFFFDHFHFFDDH@F@B@HAJDPFNFPDH@J@TDLANBH@JBFD@BBBBBBDBF@D@DAJAFAH@HDJ@@DFFDBHDF@DDFCT@DB@@FA@FBFBH@FBF@B@HA@AB@@@PGP@XFLFLNNFPCB@@@B@DBDBDB@BD@DBB@F@BAD@BAD@BABABCBCDAFCD@D@F@D@FBFBF@B@DAJ@HAHA@@bIHA@@BABA@@@A@C@ABAB@D@FAFAB@D@FAD@D@BDBBDBDBF@@@DBD@B@BBBBDBB@@@D@F@F@D@DAD@D@F@D@DADADEHEBAB@B@B@B@HBB@B@B@D@B@D@B@B@BBB@@@B@D@BBB@DAD@B@D@B@B@B@@@B@BA@@B@BA@ABA@ADC@CBA@A@@@ABA@C@@@CAAAA@AA@AAA@A@@@AA@@AAAA@@@A@@@AB@BAD@B@D@B@B@B@BAB@DABAB@BABABABABC@A@ABA@AAC@A@A@ABC@A@CBA@AB@BAB@F@HBD@B@D@D@BBD@D@B@B@DAB@D@DAD@DAD@DAD@F@D@DAD@D@B@B@D@DAB@@@B@BAD@BAB@@@BAB@BA@AB@@AB@B@D@D@@@B@DB@@B@D@D@B@B@B@D@BAB@@@B@BAD@BAB@BA@@BABA@@BA@A@A@A@@@@@C@@BABAD@BAB@D@HDBBD@DBBBD@@BB@D@BB@@D@D@B@D@BAD@D@FAB@B@D@D@D@B@B@D@@@B@DB@@DBD@@@B@@@D@BAB@@AB@D@FBB@BBB@BBB@@@BBB@B@@@B@B@B@B@D@B@DAF@DAD@DAB@@@D@D@B@B@D@B@B@B@@AB@@AB@@A@@@A@A@@AA@A@CA@@A@A@@AA@@@A@@DAB@B@@@D@DABC@@BA@ABAB@@AB@BBD@B@B@D@@@B@@ABC@ABABABABCBCBC@ABC@A@@BA@CBC@A@AB@@A@@B@@A@@B@DBB@BBD@@@B@@@DABCDADCBABAB@BC@AAA@AAAA@@AAA@@@AAABA@ABAB@FC@@F@D@DBD@@@B@@@B@@@BABABC@A@ABC@@@C@A@A@A@A@A@CDA@ABCDADCBABA@C@A@C@A@ABA@A@ABA@ABABAD@BABA@@@@@A@CCACACAAAAAAAACAA@A@@@@CCACACA@AEAC@A@@ACAA@A@C@A@CBCBA@ADABCBABA@@BA@CBA@C@@@A@@B@BA@@B@@@@A@@@@@CAAAA@EA@@AAAA@@@A@@AC@@@AAA@@AAC@AAA@@AAAAA@AA@@AA@@AA@@AA@A@A@AAEACAGDGFGBCFCIAMCE@C@@@EAGCC@ACGCIAI@C@IAAAMEEECEAC@AACCEAEAAAE@AACCCAECE@@AC@@AA@C@AAE@AAA@ACCCC@@AAAC@A@@@A@ABAB@DABADA@ABABADE@ABC@CBE@ABC@ABCBABCBAAG@CAE@AACACCCACACAAACCAAA@ACAACCAECA@CCMGCK@EDGFKDGB@B@D@BABA@C@CAC@C@CCMCEACGA[GEC@AEEUGQBMZBH@BAB@DADA@ADA@ABCBCBA@AB@BYDGCIEIE@AKEIEOISE]QGCMOCCGMCECK@@A@@AA@@A@A@A@AAAAA@AACAAFGAGCI@CGIBEKKCAGACBABA@EDA@CCEAGBG@I@IC@A"],encodeOffsets:[[113691,29837]]}},{type:"Feature",id:"430802",properties:{name:"永定区",cp:[110.537138,29.119855],childNum:1},geometry:{type:"Polygon",coordinates:["@@@KAIEAGAK@IFED@LGNGJKNMJKJOJKLMNEFKBOAQDIDEL@@EHCBMZKPG@KEICKEEEEEIIDEDIHIHKBGAGCCKEMCIEKEKGAG@CHMHKLMHIFGDMBQEMIGKGELGLKLIFKHKJEFKFEDA@C@A@CAACCAAGCCAAAABABAFADC@CBAAAACC@I@CAAACGACAAC@C@OBGBCB@B@D@D@BCBC@I@M@CBCBIJEHEBC@ECGCGAE@IACAGECCAEAKKHSDSFIBA@@F@HHNDFBV@DCHEFIHMFGDOHMBI@EACAEAC@A@ABCBCDCDAHAP@FBBDBPJDB@B@B@H@BB@BBHBBBLJBB@B@BA@E@A@@@BBBBFFDHBDBD@FDFDD@@HHDFBH@HCHGH@HFLCD@BCDE@ECCAGDCBG@CAECECAAG@IFEH@BBBABABABAF@NBD@BDR@JBFDF@PAF@BIACACCCAA@C@C@GAAAGAEECC@EAACCEAAAE@AAC@C@@BA@ADCBIDCBAD@B@F@BBF@HBFBFBBBBFDD@BB@BBB@BDFBDBB@B@DADAFAD@BABA@C@ABE@G@I@A@@@C@C@A@ABABCBC@CBC@CBEAC@AACBCBAB@BBBBBDFDBBBB@BDAB@@C@A@AAAACAA@@@AB@@@D@B@BABABCBABCFEH@@@BBB@BA@@@@BAD@DABCD@@CBABA@CAA@E@A@AAEICEAAI@EFIHEFGDM@CCEEACEGCAEAEACCBIEGEEKCEBEDEDEBEBC@AAE@A@A@A@AAAAA@CACBEBCFABADCH@HBH@D@D@JCLAHENCFEDAFAJAHIJCLBPDL@BPNBB@B@B@DAD@DAD@D@BBFBBBBB@F@B@FAL@FBDBFBBFBD@BLZDN@B@HALDBFBFBFF@B@B@B@BA@@@@BA@ABBBAPBLHHRLNHJHLJHF@@DBJDDBVHT@LCD@HCFCBAJGDKBAFKHCF@FBNNPPDFFBBDFD@BHHFFDDJDHCLAJFFFHFNDB@FAFAD@FBDBFDDBFDFFBDFGHKBE@C@EAEGEME@EBCFADAF@JDLBHF@@FH@JBFD@FA@@PENEFCD@H@RARIDEPKTQFEB@DCD]BG@YDC@AHAJ@NBF@BADEBIDKHGF@LBHFJHD@@@@@HBTG^S\\QBERYDADGDEJEDARGZIHEJAB@B@H@B@@@@JGRGJUfCHABKVCJEV@@FDD@FAF@D@FBFBF@DBDBD@F@F@@BF@B@DBDDDDD@BAB@PKAGDGHIBEFADEDA@ABGBA@ADA@A@@NUPKHG@@BAHEDAFEBCDCFKDIDADANCHCDAJEDALIDABA@AICEEECEEAEKMSQCCIGQMT@@GBOFMNADE@CAA@A@@AACCEAAA@@AAGEEAGGEE@AAI@EDIBCNOBALKDAH@JCJEHCFGHIDELAHALA@@D@JDRDBB@DBBB@BBD@D@@BD@BBDBDBD@@@D@B@DAD@B@DBB@@BD@BBBBDB@BA@@BABAD@B@BBBBBDBBDB@FBDBBBF@HDHBHBFDF@DBB@D@BBBBBDDBBBDDDBDBB@@@DABADADABAD@B@D@D@D@BABADCBAFCB@DAD@DBHBDBFBDBDBFJDHBDB@H@L@HAFEFEBCDABCBE@E@CAA@@@@@A@@@@@@BA@@@@@A@@@@AA@@@A@@@A@ABA@@@@BA@AB@@C@A@@BA@@B@BA@@@A@AB@@A@@BA@@BA@A@A@A@A@A@A@ABA@A@@@AB@@@B@B@@@@@@@@B@B@@@BB@@@BADA@@@@BAA@@C@@B@@A@@B@@B@@BB@@@B@B@@@BA@A@@B@@@@@@B@@@B@B@@@B@@A@ABA@A@@@A@@@@BA@@BAB@@ABABABABAB@@@B@B@@B@@B@B@BA@@B@@A@@BAB@@@B@@A@@@A@@BA@@D@BA@@B@@BB@BBB@B@B@DAB@@@B@B@BA@@D@B@@AB@@ABA@@@A@@@A@@@AAA@A@@@A@@@AB@@ADA@@BA@AK@E@CA@CAM@IDGNIHG@AEECAC@AEAC@CA@AA@@C@@@A@A@@@A@@BAF@B@BA@A@@@AACC@A@A@A@AAAEAA@UDSHO@I@QEMEAIFMDGDEHKBKBK@IEIKEMCKFOHKFKHIDIBEE@M@I@OAGAAAOGIEIKGMGKAMBIFEFQLMNIDI@EIGGECECOBKFGJGBEDCABK"],encodeOffsets:[[113349,29613]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("株洲",{type:"FeatureCollection",features:[{type:"Feature",id:"430202",properties:{name:"荷塘区",cp:[113.173487,27.855928],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@C@A@@@E@C@@@AAA@@@@@@@@A@@@@@AD@BA@@BB@BD@B@D@D@B@B@@@B@B@@AB@B@@BDBA@@BBBB@AB@@@@EAGAC"],["@@@@A@GCABA@@@A@E@A@EAA@AD@B@@AB@@@@@BAB@@AB@BABAF@@@@AFCFCFA@CF@@A@AB@@EDED@@ABABBBB@BBB@B@FB@BB@@@D@BBFD@BBBBBB@B@D@D@BBB@@B@@@B@@AB@@BD@@@BABBBBD@BBB@B@DB@B@@F@@DBD@@B@BB@@BBBAB@@@@@B@B@@@DB@@@@CB@@@B@@BBAB@BB@@BAB@@ABBBABAD@@@B@BD@A@ABAB@BBB@BBABB@B@DBADB@@BBDBBB@ADBBD@A@ABBBABAB@D@@BBA@A@@B@@@BBB@@ABBB@BA@DD@BBBB@CDHB@B@@@BD@@BFDDBBFFDBDB@FCBBEDCBHBABEAC@@DA@@CCD@BC@@B@BAB@@A@A@@@A@@AC@@BA@@@@A@AA@@B@D@@AB@@@B@@@BA@@B@B@@@@@@@@BB@@@@@@@BA@@@@BB@@@BB@@A@@B@@@@B@@BVRDBHHBDDDB@BBJDD@@BFC@C@A@@BEBAF@NA@BDBJLFH@BHHFBBBBB@BA@A@A@@@A@@BBD@BBBB@@@@@@@BBB@@@@@@@B@@@@@@@@@@@@@@B@@@@AB@@@D@DBD@DBH@@BBBBBDBCHK@A@A@AC@@@@ABCDCAA@@AAA@@A@AB@B@DA@ABC@CACAC@EAA@ABABADCBADABABA@A@A@ACAACCA@AAAAC@A@C@C@CBC@AAA@A@@AAA@ABCBABA@A@AAAAACAC@A@ABADCFEDCBCDE@ABA@A@A@A@A@CB@@A@ABAB@BBD@B@D@B@D@DADABABA@ABA@ABA@A@@@AA@C@C@C@ABA@CAA@C@C@AAA@A@@A@A@@BABAD@B@BABABCBC@C@ABC@A@CAEBC@C@CAAACAA@@AAAAACAE@CAA@C@C@AAAAA@AA@AA@@GBEBC@AAMIOGMGCAGEGG@@@@E@IAAAQOOHK@A@M@ICEACAA@EA"]],encodeOffsets:[[[115977,28677]],[[115893,28498]]]}},{type:"Feature",id:"430203",properties:{name:"芦淞区",cp:[113.152724,27.78507],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAI@A@ABAB@BADAHABABA@C@ABCBCBGHEBEBC@@AAAAA@ABC@CA@AAAACACACAC@CAC@A@ADCBA@ABAD@FAB@BA@AB@@AB@@CBCAA@CAAAECAAAACAC@CBC@AB@@ABCDABCDABAB@@ADCBADCDCDEBA@GB@@UH@@BCBAFE@KAEE@@AIAA@I@MFEBGDGBA@AAEACACAC@@AACBABABA@ABAACAAAACACCA@@@CBABEBABA@@@@@@@CBEDEDKLA@SFA@I@CUG@KA@@GA@FAHCN@@CP@BAB@F@DBDDHFBBBB@HBRBDBFBHD@@FJ@BFT@B@D@B@F@D@F@B@@AB@B@BCH@B@@CBABADEB@BEDEDABABGDFD@B@B@D@@@B@@@@B@@@D@BBB@@BDBBABA@@FCFC@@BAB@@@DEB@DEDEBE@@@@BEBA@ABA@@BA@A@@@@BA@@@ABCB@FBB@F@B@@@B@BAHDB@@@FBB@DBFBJDN@B@L@PGRPBBJBF@@@@@HHHFDBNHPHNJBBD@FAHA@@BBB@@BBBBB@AB@D@B@FBFBB@F@B@B@@@BBAD@DAD@D@DAB@@BBB@F@D@B@BBB@D@BA@A@C@C@C@A@AB@DAFAFAD@B@D@DADAFADCDCFADAD@B@B@D@DBD@BBB@@@D@DADCFCFCFCBAB@BAB@A@@C@C@A@@BC@QDS@MAK@A@A@A@CAC@ABA@CB@BCAC@E@AACA@A@A@C@A@C@AAC@@@AA@AAA@CBABC@AAC@C@A@CB@@ABCBA@A@A@@@A@A@CACCIAAACAA@@AAC@A@A@A@A@A@@@A@CECCAACA"],encodeOffsets:[[116051,28411]]}},{type:"Feature",id:"430204",properties:{name:"石峰区",cp:[113.117731,27.875445],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@C@A@AECC@@@EBM@CA@@IAG@A@@@IBODA@WDEBSBOAIEMGAF@@@@@B@@EJ@B@F@D@B@@A@CB@@@@AB@D@B@BBBBDDDHF@B@@BNBD@LJP@BHJDHB@BDBJBJ@FDN@@HRPJFFDAB@@@BBBBBBBB@@BA@CDADEDCDCDCDABADABAD@D@B@B@BCBCBA@ABCDADCBABA@A@ABABCDABAB@B@DAB@B@B@B@B@FBB@@BBD@D@B@@@D@DBB@BBB@B@@@BCBC@ABA@ADBD@DABCB@B@B@BBBBBD@D@BBBBBBADADAB@BB@BBD@FBH@BBDDBFBDBD@@BBB@DBBBBB@DABADC@ABAB@@@DAB@DABABA@@DBBBD@D@BABAB@D@@BBB@FBFBBBBBB@@@BB@B@B@@@@@@B@@@@@@@@@B@@AB@@A@@A@@AA@@@@@B@@AB@BAB@B@B@BB@@@B@@@B@BB@B@@@@BABGFAB@B@B@BBDBBBBBBDBD@DBD@B@BA@ABAB@H@F@DAB@BAF@FBB@BBF@HAD@B@BBFN@HBBBB@ABCDCBABADBD@FBB@@@@CBE@AF@BAH@D@DA@ABABAB@BBBBDHBB@@BBBDB@@BB@FA@@B@BE@A@A@A@@AC@@@ABA@@@A@A@@@A@A@C@C@AACA@@AB@@ABC@@@@B@@@@@@@@@@@@@A@@AAA@AAC@AB@@@B@B@B@@AAAAAEAGG@AEGIKCA@AMBE@ABAF@@@B@DED@AC@ICAAA@CCACGGCAUQ@AA@@@@@@AB@@@AA@@A@@A@@B@@A@@@@@@AA@@@@@@@@@A@AB@@A@@@A@@BA@@@C@AB@@B@B@@B@@AD@@BB@@@B@B@@@BA@A@AD@@ADC@DB@@CD@FBBAGADAFCAAEDA@ACECAECAEC@AC@@A@@@AGADCA@AA@ACCB@@AAABA@@AA@A@@@AB@B@AA@@@CBABAAABAB@C@AABCA@AAAC@AA@BCCAA@A@BAAAA@AAA@AB@B@BACA@@@C@ABABAA@BA@AB@@AAA@AB@AA@@@A@@D@@A@@C@@@A@A@@@@BAAA@AA@@A@AC@CA@@@EA@A@@C@AAA@AACAABA@A@@AC@@BA@@@A@@@AA@AAC@C@A@A@AAAA@AECAAC@@@A@@AEAA@A@AAA@AACA@AA@AAC@@@A@@@@@"],encodeOffsets:[[115846,28524]]}},{type:"Feature",id:"430211",properties:{name:"天元区",cp:[113.082216,27.826866],childNum:1},geometry:{type:"Polygon",coordinates:["@@GCBEC@E@AADCEA@EDBAECA@AEGC@CEHAAGE@@GEACDEAEFEACECCCBCEB@EAACGBCGE@@ACBI@CFCCEACB@JCDJLEFIJCBGDANAFF@BD@DDFH@FABCJCFADHHHFBCFFHBHDJEHCDE@AD@N@B@BEFEAEDABCBAD@BE@CDED@FE@@D@B@@@@B@@@BBDH@BB@BB@@D@D@BBD@@@HBDB@D@@@B@FABABAD@BCDEDCBCDABA@ABA@AD@BDH@BDBB@BBD@@B@BABADABCBABCBG@C@C@@@IGA@CAAAAAAA@@A@@@C@A@@BA@@BBDAB@BCDABADAB@@ABAD@B@HBD@B@BBBBBB@DDBBBBBBB@DBD@BBDDBDDDBD@@BBBDBB@BHHDJFJ@@D@B@@@B@B@HAFBD@D@FBF@BAB@B@B@DHFFBF@@DL@BA@ABA@@BEFQLMDAB@@IBEDAHBBBFFHBHBFAPELADEJENABIJA@C@A@AAAA@@A@A@CDCBABC@ABAD@D@JBDBD@@BBDDDDFJBB@@DDJHBBNJ@@B@@@@@FFFDJHDDHBD@NHJFPBTAFAXCB@PCJA@@B@H@JB@@DBN@FA@@D@HCBABAFCFC@AFABCBADA@@@ADG@A@ABA@@@A@E@C@E@A@C@AES@AEI@@GCEACAQAGAA@AAEACGAC@C@EBA@ADO@@DMBG@EDE@@JMFK@AHIBCFQ@IAK@CCGEGCGAGAEA@IQIQA@AQAI@AAG@AAAA@AAAC@CAEAA@CAC@A@A@@@@@ADGBCBCBABABC@I@G@C@@BE@A@GBI@@A@@ABC@A@@BE@CDEDC@CBEBABC@CBABE@@@@GAACABAGCCBCEBG@@BG@@ABEC@ACIE"],encodeOffsets:[[115814,28222]]}},{type:"Feature",id:"430221",properties:{name:"株洲县",cp:[113.144109,27.699232],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACAE@EAEAEAC@EACAAAAA@CAE@C@C@@BAB@D@B@BAB@BADCDABCD@B@@@@D@@BBBA@@BABABCBCDC@ABABADC@@@C@A@A@CBCBABABBBB@D@BB@@@B@BABADEFADCDADAB@B@BDBB@@B@@ABC@@@@B@BB@BDBB@BBB@BAB@BCBC@A@A@@BABABCB@BCBADABADABABCBC@A@C@CBC@ABABABABC@ABABAB@DADAD@D@D@D@D@BADABABADB@@@B@BBBBBB@B@DBD@D@BABABADCBCBCBCBC@E@A@@B@@@D@BBB@DAD@B@DAB@DBBBB@@B@BBFBDBBDBB@BABABCBABA@ABAB@BADABABA@CDCBCDADADAD@DAB@BBB@BBBBBBD@BADMFM@OHMN@@ANDNBD@JDT@F@FCBCB@B@D@BADABABA@@@A@CBAD@DAB@J@J@B@@@FBDAB@BAB@@EAAAEACBA@ABAB@B@@BBCBABA@@BBBBB@FBBFFHDBBDBBBBB@BBD@DBF@BKFK@GH@@EL@BFLFPBN@B@B@@@B@NANCLCNBH@D@BDDLJBB@BDAB@B@D@BBBBBBDFBDB@HBBB@@DD@DBB@BBDBBD@BBB@@@BD@B@B@BABABCBEBCBAB@@@D@D@B@DAB@B@B@B@B@@BBDBDBDBB@B@BA@@FEDCJEDAD@B@B@@@A@@@@@@A@CF@@EFCDCF@@ABCDABAFCFBFE@A@A@MBCF@DCFGCIAGEGDEEAGGCGEBIDADEBG@CE@CACE@BEBMHCDAJIFEIKDC@IDAFBDDDEJ@DA@BF@DHHABDFBA@DFDADDDFFBFEFBDCFB@HF@BHGBDFD@FH@BDBBFCA@FFBCDBBF@D@AFHDJFBDD@AF@BH@@AH@FAADDDBHBABDHB@@@@AFAB@DADABAF@DCDCF@DAF@@@BAD@BB@@@AJ@H@BAF@@@D@H@JADABABADADCH@B@@@@@B@BBD@DBBBF@DBDBBB@BB@BBH@BBJBRB@JRJRB@BFBHDHFHDH@DBL@JERADGJ@BELIN@@CFHB@@LBH@DVJ@B@TEB@LKFCFCDA@@@@@@B@BAFABADA@@B@DDDBBBBBBDAB@BABABABBD@BD@DBDBFBBBB@HAHCFANEJ@B@JB@BF@BF@LEFABAD@@VG@@HAB@FADCDCBCDABC@@BABADCBADCBA@@BAD@DAD@DBBBBBFDBBDBB@DBDA@@BA@@BAB@@ABA@EBCBAB@DABCB@D@DBD@DBDBDBBBBBB@@EBA@ACC@@ACBA@CDEBCBA@C@A@A@AACBA@ABAFC@A@@@AAA@@AAA@ABABE@A@A@AAA@AA@AAA@A@AACAAACECACCAAACC@A@ABABC@A@ACGC@@ACA@A@@@@AA@@A@AAA@C@@BA@@@A@CBABC@C@A@EA@AAA@AAE@ACAC@A@C@AB@@@D@B@@CBQA@@@@A@A@A@A@@@KA@@@@@@CCMK@@AAEMACAC@@AEAC@SCQAAACGIOM@AOKQKGMAEAE@IBKLGHEFCBI@E@ABK@AFMFEFGBABGDGFEHCFEBEAEACECGACEEG@ECICEACKICGAK@CHGFGJELAHDD@NFNFJDH@D@BAFCHKDMFO@CJKHGDALGPALAPBP@JCJGDCHK@ABADIFMBA@@DUBAFO@ICAEIGAIAM@CACCAACCAAACAAEICAAAC@CAA@AAGICCCCEMCAEKA@ACC@AAC@E@A@A@AAA@AAECEAC@CBA@A@E@C@IAC@CAC@CACACAAAEAA@C@AAA@AA@AAACCCC@A@@@A@AB@FAD@DABCBABEBA@A@C@C@C@A@C@CAAAAAA@AAC@CDEDGDEBAB@B@B@B@BADABADABBLBB@DADABADG@E@GACCICQAK@A@AAAAAACA@AAA@C@CAC@E@E@E@E@C@C@E@E@C@E@CBC@CBC@C@A@ACCAACCAEAAA@@@@C@CBA@CBABABCBADCDADABCDC@CB@BABABABA@A@AAAAACAECCAECEACAEAAAA@C"],encodeOffsets:[[115849,27882]]}},{type:"Feature",id:"430223",properties:{name:"攸县",cp:[113.396385,27.014583],childNum:1},geometry:{type:"Polygon",coordinates:["@@CKEOEGGCE@@@C@KDIBKN@FBFHDFH@L@HABIJ@@ABEFADAD@JBDJHBD@@ABC@MAE@GBA@EBEBEDAF@FAFCFEFGLEDKJCFAD@B@B@HA@ADCFAFBDDBH@B@B@FCDDBFBH@@@FAFCB@BDDH@DBB@BBDBFBBFBB@D@FAFADBDDDJBH@BBFDHJDBFB@F@@DFBBBD@BCDIBEAC@E@G@G@G@CAEAE@G@CFADCDCD@BAB@@AFIFIDA@@F@F@FBFADEDADDH@FDFABBD@JBBBDAD@B@BABABABC@ABAB@@@BB@BDBBB@@D@BBDB@BBD@FBD@FBB@@B@@@@@B@B@DABA@ABAAC@ABABABABC@A@A@C@A@C@A@@BA@@@@BB@@BBB@@BB@DABADAB@B@B@F@@A@A@AAC@CAI@GBA@A@ABBD@DBF@B@D@B@BABA@AB@BB@BB@BB@AB@BABABBD@BABAB@DCB@BAD@BBD@B@BABABA@CBABABABCBAD@@ABABC@A@CBA@ADAFADAD@BAB@@A@A@A@ABA@AB@D@B@DBD@B@D@B@B@BBDBB@BBBAD@BAB@BBD@BD@@@BBDBD@BBB@@BBBB@BBB@D@D@BBB@BBBBB@D@D@B@@B@@@B@B@B@B@BB@BBBAD@DADADADABCDAFCB@FA@@B@B@B@DDBBBB@BDBBBFBDBBBB@D@B@@B@@@B@B@B@@B@B@B@BABA@ABCB@B@B@@BBB@B@D@B@D@D@B@BB@BBB@BBBB@@@@@BBB@B@DB@BBD@B@D@@@@B@DAB@D@DADBDBBB@B@BADADAFABABAD@F@D@B@BAD@@BB@@DBB@B@D@@BB@D@D@D@DABAD@DAB@B@DA@ABABAD@@AFABADAD@DABAB@@ABABABADABABAB@BADABCDCBCBAD@@@B@D@B@@@B@BCDABADCFADABC@A@C@CAC@CAEAC@C@ABABC@BBB@BDBBBB@B@BBLDRDJBD@H@FCHABCBCBA@KAAACBABCBABA@A@A@A@ABCFCHCF@DBD@BBBBBBB@D@D@B@D@D@D@BABAFABADCBC@EBA@@B@B@@@BDDDDBB@BBBB@BBD@B@FBBBDBDBDBD@DBD@JBD@F@B@B@DAD@FBFDBBB@BBB@B@F@D@BBD@BDB@FLDBFNDDDDHJBBB@DBD@BBDBFJBBBDBBDDBBDDDBN@JBHBFJDBDG@E@K@ADGDGH@L@LDLFLDLAH@B@LDFDBDFLBLBDBN@@@BHLDFFFJFD@HBHCHGJAJAJ@HCD@PGFEJELCL@JAJEFCFCFC@@HAHBFBDBFAFCHGBAFCLARBVD@@LFDFDFB@DJ@@@BB@DNBL@B@JBBFNNFNDLGLEDCFANEFCBAHE@ADAFCH@JB@B@AF@F@D@D@B@DAB@J@H@AAB@DAJABA@AACAAAAC@C@GA@@CA@@AAOIIGCCAAAG@IBKBKHK@AHI@ADGBCDCHAJAHDHDHDDBL@ZE@@JGHILINCH@H@N@LBJDJDFDLHDHDDBBJBH@FCLAFDBBBBHHHDDBPFD@HBJATBDDFBNBHBJBNBJCFAHAH@DBBBFDJFLDBBNDPDLFHD@@HDDADCAOAM@GAI@MAIAECMEKGOACCIEIAEAC@C@EFGJ@LC@@FEBGAICGKIGMES@@AIAIAOAMEMAECEOOKMGICCCE@AEGGQGSKOOOEEKIGIEC@AKKEIAG@CACKCMAE@I@IAMCCGA@AICMA@CAMCM@I@QASGIIGGMGKGCA@@MCIAC@M@ICICIGCEIK@AAA@@AASSKKMGKEMCKEMIA@QEGAIA@@OCEACAGGIIIIOIMIOKEG@AAK@KCK@GECK@EFEDEAA@AGAKEECEMCK@IB@@EBQJKHIJE@GDC@E@CCEAA@IAGCACBADA@@DB@@B@BABA@AAECCGIECC@E@[AE@ABCAI@K@GEEI@@EG@A@GAIDKBEBCDCDA@CDCACCAC@GCCI@I@ABKBI@CCCAAGAE@EAACBEDKBCDGDOFMFIDEJCD@L@F@HEFIDGBECEOMCAWBQDGDQDMFABEBCBK@KC@AKGKMGO@A"],encodeOffsets:[[116031,27431]]}},{type:"Feature",id:"430224",properties:{name:"茶陵县",cp:[113.539094,26.777521],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAEAE@MAGBA@ABCHAFEPADGP@@@BCD@@GDGFGBGHAH@@DHBBJBFBFDBF@B@B@LDFBFBBDD@B@B@FANCJABEFGJMF@BCFBJGFAFCDABIPGNCH@FBDFFRDNFDHDDBHFHBFHNHRBFBF@@HTDHDLBLEDGDABGFEFADELAFGLGDMLDFAFCHEJGFE@K@C@IDCFEJENCPCHADCLAFBDFBF@HBBBDD@DAJAL@B@JDJHDD@DBBDCD@DCBCDADAFCLBJ@H@BFH@@FJHFL@J@DBBAF@\\BF@D@FDHJDDBF@BABABA@@@CA@@CBABBDHDJBB@FBDDF@D@HCF@JILGRIFA@@JAL@NDDFFFBLBHB@FBFCFEL@FD@HDL@LBL@BFHPLNJPJJJJJHHDBFBPD@@JBHBRFB@NJLFNDLFNHLLTTBB@@BB@BJLDFJHJDJDN@D@JBND@@DBLHNHHHJJTHRBJ@N@NDDBB@DNBJB@DHNDJBJ@F@NBLDAG@G@K@ECK@KBADGJGFAB@J@JFFHFFFFB@JADODQBUBSDQBKBCBEDGFCJ@JAJ@JCDGBI@IDCD@D@B@D@JBH@FC@@FOBI@KBOFEBADADCJIHIDIDGLAD@RCD@JCF@FB@C@C@CDCDCDCACACCACAACCCAAECGCECGGCCBI@CCC@CDE@CACCACEAGCAA@ECAC@G@CBE@EACCCDGBA@A@CBCAE@ADG@A@A@@A@@@K@C@CAE@C@GG@CAAACCACCACACEGACACCE@GAEEC[[CA@@CCCCACBC@A@CBEFEBCDC@@HABKBEBCBEDCBCBCAEAEBCBCBC@CAEAC@EBE@EBABABGAEAC@GBCBE@E@E@CBC@C@@BCFEBK@C@E@E@CAEACBE@G@E@IAG@CDGDGDCBCCEAAGCCCECACCCCIBC@CDEBCDC@@BA@ABMEKEICACCGCQAQ@I@OEQEIGEGCM@ACOCGACAEACCEEGAACCCAACCACAA@ICMAGBGAICICKGGEKCCAGAI@E@C@A@GCOGKEEAEAICICO@GDIDIJIFIBGCIIAGAECECCEAEAIJGFCACCICE@EBIFCDC@E@@@EAA@@D@BFH@B@DG@@@IAECG@EBCFADAJCHEJ@DAD@@ADELAPDLDHBJ@BADKTABGFMFEB@@SDA@CB@@I@OAMCQGIGGQA@EIECMIAAECMCGBCBEBG@EAE@"],encodeOffsets:[[116172,27199]]}},{type:"Feature",id:"430225",properties:{name:"炎陵县",cp:[113.772655,26.489902],childNum:1},geometry:{type:"Polygon",coordinates:["@@GBGBGAAAIGIKAKDMBM@EACAECCEEGEGGMKUMMCGBEBGDGFEHEJGHKFEDADADAHAB@BINKPCFAJDJFNBJ@BBJ@FFFFBBBDBJFRFJHDDFPDJ@BB@DNBLBP@@@TCRENEJAF@NAHAFEH@@IFKFKHA@MHQHQDIHCF@@CFEFEFGDGJCHA@CBCAGCEAEBGBABEB[JGBGD@LBBBFBJ@BAB@F@FBHAFEHMFINCDAFAH@NAN@DEFAH@HAFEJ@BGFCDAD@D@FDL@FADAHBFDDHHFDDBBDNNLNBDBJ@JERENADABGHEHCDGJ@F@DDDDBHFCJBJDHBDJbFAF@JDDDDBHEJIFBFBDDDFBFBHJJHDJAJEJIJCHCP@JDJDFBFBLFPHHDB@D@F@J@HBDBLDHFLHJDJDHBHANBJDB@DBDBBDDBDDBBFHDFBDBFBDDHDP@BDNFHJHRFPFJ@R@RBHDDDDBFJFLAN@BAB@@CDADCF@DADDJDDBDFDDDHDBBDFHBDBDBDBDA@@DABGBGBI@@@EBEDCD@FADAHADCBCBCBGBEFELCF@DBB@FBF@@@FAB@BAD@HAH@F@HBF@D@F@DBDB@@FDDBDCF@F@DCBCFBBDD@DAH@D@D@B@DBD@F@DC@@DCJEFCDE@@FCF@DCACACAC@EDEFAHAJ@HAHCFCDABALBL@B@L@HCJEF@BBF@@@DCDDHBH@FAHBHAFAHBDA@@@A@A@@@@@@@A@@@@@@@@@@B@@@@A@@@@@CACAC@C@CACCECCCCACAACC@A@EBC@AACAAACAAACAAACACCCAABC@ECGAG@G@GCKCE@@AECCCCCCC@@ABC@C@C@ABAB@BBD@D@B@DAFAB@DBBBBBB@BA@@HFDBH@LAD@@EOMGIAA@C@CACCCCCCC@CACBE@EACCEAABCBCBAACAA@C@A@C@@@AACAA@ABADABCAC@E@C@AA@ACAC@@ECCBE@ACCBAFCDG@GCACCCACAAACGECECCC@E@CAAC@CCECECEC@GA@C@CBEDCBCDADABC@C@@AEAEBABG@G@@DCHEDC@ACEGCAACCCEAEBEBE@E@@BEDAB@@CAAEAAAECEEGEACAC@CCCACAE@EBEBGBGDCBADEB@EAECEAECA@@AC@C@CAECEAEGGC@@CAC@EAEAECACCAEEAABEBE@EAECCECCACCBC@CBC@I@EEGECCAGCE@CAE@CCCACEAEAEAECCEAGA@A@@A@IGK@K@CA@KCMECEEKKCECK@@EGECIEICMEMESEAAEIGGCCCKAGCCSKIEKEEAG@G@EFAFCJCLEF"],encodeOffsets:[[116535,26730]]}},{type:"Feature",id:"430281",properties:{name:"醴陵市",cp:[113.496999,27.646096],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@KBKCKEKCK@G@CHCH@B@L@FCH@JEPABCV@@ABENCJAB@BGLCDIHIDO@OAKBOBKHCBGHIL@DEPCNGLEDABC@G@ICMEMEC@GCKBIFEHGH@DBLDHLJBDDFDJ@FFHDFHBFDBDBFAFEFGDEFCHAHABEHEFEN@BAL@B@FAJEDGFKHAL@JBFBFHNRLPL@BPNHJBDBBDR@TBDBF@@BDBDFNBB@@NLDD@@@@@@LB@@B@B@B@B@@@@@RBDA@@@A@C@@BAD@B@D@DB@BBF@BBB@BFBB@D@D@BADAB@@@B@@AD@B@BBB@@@BB@@@@@BDB@BD@DH@B@BADAB@B@BDDBBDBBDFDBDBBBD@B@BBB@BBBB@BBB@B@F@BABAB@BB@@BB@B@@@BEDAB@BABBD@B@B@B@DABADCF@DABBD@@DD@BAB@F@DAD@BBBBB@BD@FAFAHGDADABAD@B@BABABGBC@ABABAB@J@BBDBBBDDDFB@@@B@B@B@B@B@D@BB@@BBBDBBDJBD@D@B@B@@@B@BABAD@BA@@D@B@DBD@BADAB@DBB@BBB@@D@BBD@B@D@B@B@B@BD@B@FBDADA@@DAB@BBD@D@B@B@BBL@NCT@RAD@@@B@D@DB@B@DDFBBBF@DBH@F@FBB@D@FBHA@@D@@@HAD@B@BBB@@@@@B@FBB@DBFBDB@BBBBB@DAD@DBB@D@@BF@DAF@F@B@DAB@D@BBD@@B@@BDBDBBBBBDHD@F@F@FAH@FA@@D@D@@@F@D@D@DBDBBBDB@@@DAD@B@D@B@DBFBDBDB@BDDBHDDBDBF@@BD@BBDBBB@BBD@D@DBDBBDBDBB@F@F@HAB@B@F@F@DBFBD@DBD@D@DBBFDD@D@FAF@B@D@BBB@@B@D@DAD@D@DBFBDBDBD@F@FAD@D@D@FBB@B@FBDBD@B@DA@@FABABC@ABCBC@C@A@C@C@C@C@ABC@AAAA@A@AAC@@AA@@A@C@AAAAA@C@A@CBC@ABCBABA@A@A@AAAAC@A@@@A@ABEBCBCBEBA@@BABAB@DAFADCDABAB@BAB@BB@BBFBFBFBB@F@BCF@B@BBBB@HBB@@@BAD@BAB@DCDCDADADADCDAFCDAF@DADADA@ABCBABCBABADABABABA@A@C@ABAB@DADAD@@@B@B@B@@D@DBBBBBBB@BBB@DADCFCDCFCF@FAFAF@DADADADAF@DAD@BBDBDDBBBBDBD@BB@@B@DDDBBBDBBBBB@BB@DBDAH@BAB@@CAC@AACAA@ABCBA@ABABCBAD@D@DBBAFCBAFA@@F@B@BAB@HEDEBCDI@EDE@CFS@ABMBOAQEMACECQKAASQA@IIGCMOEEEEIIGIIEEE@AEGBE@ABKCKCG@C@@IIECEEMKGECA@@GIGQEO@@EKIKAACCMKMIAAMGCAQIGGAAEGIO@GCO@@BSAECM@EAIACEMGOAEAAMQKUAA@O@ABIHMBAFCLCJABA@@DCD@FAD@DAB@FBFHDFBDBDB@@BB@B@@AAIBA@@BAB@B@B@@ABG@A@AECACAC@AAC@@BAFEB@@CA@@@CCA@CAAACG@@ACGG@A@A@A@CBCEACAK@AAAA@@AAAC@@CAE@CAA@AAB@@CBCFIFOB@AC@IAO@C@G@A@A@AB@DE@C@ABABADADAB@F@FBF@H@DAB@DABA@C@CACA@G@I@A@CBA@C@C@E@E@@B@AIAG@EDCB@BGFABEDMFEBCDKFKHMCMEEMAA@I@AAKCMA@@A@@CIA@CECEKE@@UCQAKBEDABGHEDEBCAEAGAGB@@EDEDEDIFIBK@KDIFEFOHC@GDI@IBIBGHGDGAC@IEEECEGK@A@@AMACAKEKACECKCA@"],encodeOffsets:[[116082,28038]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("白城",{type:"FeatureCollection",features:[{type:"Feature",id:"220802",properties:{name:"洮北区",cp:[122.851029,45.621716],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AAAC@AA@@AA@AAA@@AAA@@A@@B@@@D@D@D@@@@A@@@AA@A@A@A@A@@@AA@AAA@AA@@A@@@A@@@A@@AA@@A@A@C@AA@@AAAAAACA@A@AA@@@@@AAA@C@A@A@C@A@@@AA@A@@@CAAAAAAAAA@@A@A@@BABAB@@@BA@A@AAA@ACA@AA@@A@A@AA@AAAA@@@A@AB@@@DA@@BA@AB@@@@AA@@AA@AA@AC@@AA@@@A@@@@A@@@@A@@@@@@@@A@@@AA@@@@AA@A@@@AA@@AA@@@A@@@@AAA@@@AA@@@@@AA@@@AA@AA@@@@A@@A@@A@@@A@@BAB@B@BAB@@A@A@A@A@@A@CAA@A@@@AA@@@AB@DADCBADA@A@@@A@@A@A@A@AB@@A@A@A@AA@C@AA@AAAAA@AA@ABA@AAA@AA@@@CAA@AA@@A@@A@@A@@A@@@@A@@A@@@@@@@A@@@@@AB@@@@@@@@A@@@@@A@@@@@A@@A@@@AA@@@@@A@@@AA@A@AAA@@@A@@@A@@@@@@A@@@@@@@@B@@@B@@@BA@@A@@@@A@@A@@@A@A@@@@@@A@@@@@AA@@@A@@@AA@@@A@@@A@@@A@@@@@@A@A@@@A@A@@@A@@@@@@@@B@@@B@@@@A@@@@@A@@A@@@A@@@AA@@AA@@@A@@@@AA@@AA@AA@@@AA@A@@AA@@@@A@@@AB@@@@A@@@@@A@@AA@@A@@@A@@@A@@BA@@@A@A@@@@@AA@@AA@A@@@@@A@@@@A@A@@@AA@@@@A@AAA@@@A@A@AAA@@AA@@AA@@@@@A@@AA@@@@AA@A@@@A@@@@@A@@@A@@@AAA@A@@AA@CA@@A@@A@@@@AAA@A@A@AA@AAA@@@A@A@A@A@@@AB@@@@AA@@@@A@AAA@A@@AAAAA@AAA@A@A@AAA@@@AAA@@@A@AB@@ABA@@AA@@A@CA@@AA@A@A@A@A@A@@@A@A@@@C@@@A@@AA@A@@@A@@@A@@@@A@@@@@@BAA@@@@@A@A@@@AB@@CB@@ABA@ABA@C@@@AAA@ABA@A@AAC@@@EACCAAAA@A@@@A@@@@AA@@@A@@@ABAAA@AAACACAA@@AAAAA@@@AA@@AA@@A@A@@A@C@A@C@AAA@@ACACACAAC@@AA@@@A@@@@@AA@A@CBA@CBCBA@ABA@AAAAB@@ABA@@@AB@A@@A@@A@AAA@A@AB@@A@A@A@@@A@ABEBC@CBCBA@A@@@A@A@@@@@A@AACACCAAC@A@CBA@CBA@A@A@ABC@E@A@A@CBA@ABC@A@A@AAAAA@CBA@A@@AA@@@AA@@A@CAA@A@ABABBB@B@D@BAB@@ABC@A@C@AB@BADABABA@A@A@@AAAAAA@C@C@ABCDA@A@C@AAEACACAC@G@C@ABEBCBC@KBABC@C@@@@A@ABABC@@@AC@A@A@EBE@A@C@C@@AA@ACAAA@A@@@CBCDCBG@CBABEBCBC@A@A@A@@C@AAAA@AAC@C@ABA@CDA@A@A@CA@AACA@AAA@CBCBABAB@@@@@BBBB@B@@@D@@@B@@@@@@B@@@BA@ABA@C@AB@BAB@@ADABABABABABA@@@A@A@A@A@@@@BAB@@A@A@CACA@@C@ABCBCBABADA@ABA@@@@D@F@B@BBF@@@D@@@BABC@@@C@E@C@@@AA@@A@A@A@@@A@A@ABAB@@A@@@A@@A@@@C@@@@A@@@CB@@@B@@A@@B@@A@A@C@AAA@A@@@A@@@@B@BA@@B@@ABA@@B@@BB@BB@@BA@@@A@@@@AAA@@AAC@@@A@AB@B@B@BAB@BABAB@B@B@@@B@@@@@BA@@@C@ABC@A@ABA@ABA@A@A@@@A@A@@@AB@@@@@B@@@B@@@@A@A@ABC@AB@BA@ADAB@B@B@B@B@@A@@@A@@@ABC@@BA@@B@@@BB@BBB@BBBB@@@B@@@@ABCB@@@@@B@@@BAD@@AB@@AAA@@AA@A@@@CD@BA@A@@@AD@B@@BB@B@@@@AB@@AAA@@AA@A@@BCB@@@B@B@B@B@@ABA@A@@@A@AAABA@@@A@ABA@A@A@ABA@@B@DAB@BA@A@AA@@@A@CA@AAA@EAA@A@A@A@A@@B@@@B@@BBB@@B@@@BA@@@CB@@C@CB@@ABABABA@C@@@A@@@AAAA@@A@@@A@ED@@@B@B@BB@@B@BAB@BA@@BABA@A@C@A@CAA@A@A@@@AB@B@B@@B@BBB@BB@@@B@D@DAD@@AB@@C@C@ABA@AB@B@B@BBB@BB@BBB@@B@@@BAB@@A@A@A@AAA@A@A@@@ABA@@BAB@@BB@BB@@@BB@@B@@BBB@B@BA@@BA@ABA@A@@@A@@AA@@@A@@@@@AB@@ABA@@B@@@@@@BB@@@@BD@@@BA@@@@BA@AAA@@@A@A@A@@@A@@@@@@D@@@@BB@@@@@@@BA@AB@@@B@@@B@BA@A@@@A@A@AAA@@@A@C@@@ABABA@@BA@@B@@@B@@@@@BA@@@A@AAA@ABA@@BA@CBA@A@A@A@@@ABA@@BA@A@A@CBA@CB@@AB@@@@@BB@@B@@@B@@@BABABA@ABA@A@A@@@@@A@A@C@AA@@C@AB@@@@@B@BB@@B@B@B@@AB@@A@A@A@AAA@A@AB@@@B@@@B@B@BA@A@A@AAA@A@@BA@A@@B@BB@@B@B@@@@@BA@@@AB@@A@AAA@A@AAC@A@A@A@A@@@A@A@AB@@@B@B@@BB@BBBBBBBBF@@@B@@AB@@C@C@@@A@@B@@@B@@BB@B@B@B@B@BAB@B@B@B@B@@A@ABC@A@AAA@@AA@A@A@CBAB@DABA@@B@@@B@@@B@DB@@BBBB@BB@B@DABC@A@@B@B@BA@@D@@A@@BC@@B@@C@CBCBABA@@B@B@@BB@B@B@BABABA@AB@@ABA@ABA@ABA@@B@@@BBB@@B@@@@BAB@@A@@@AB@@@B@B@B@B@@@B@@@B@B@@BB@B@B@B@@ABA@ABAAC@A@AB@@@B@BA@@BE@ABAB@B@B@B@B@@ABA@@BAB@@ABA@ABAB@@ABA@@B@@A@@D@BABAB@B@B@B@@AB@@A@A@AB@@@BB@BB@B@@@BABA@AB@@@B@@@BB@@B@BB@@@BBBB@@@BB@AB@@A@A@A@@B@@@@@B@@@BBB@@@B@@@BABA@@@AB@@@@@B@@@@BBBB@@@B@@@@ABA@@BA@@B@B@@BB@@BDB@@B@@@BABABAB@B@@@BB@B@BB@@@@@B@BBD@BA@@@@@A@A@AB@@ABA@@B@@@F@@A@@BA@@A@@A@@@A@@B@@ABA@@@CB@@ABA@@@A@@@A@A@A@@@@@AB@B@DAB@@A@CBA@A@@@CB@@A@AB@@A@@@A@@@@BA@@BAB@B@@@B@BA@@@@B@@@BAB@@A@@B@@BB@@B@@BB@@B@B@@@@AB@@A@@@BB@@B@B@@B@@@@@B@@@B@B@@AB@D@BB@@@@BB@@@@B@@AB@BABAB@BA@ABEDCBCBABADAB@B@DAB@B@D@@@B@BB@@B@@BBA@@B@@ABA@C@C@CBC@CBADCB@@@BC@A@@BE@E@C@@@CBAB@@A@@@ABA@ABABAB@@A@A@A@A@A@A@@@@B@@@@AB@@@@@@BB@@@B@@@B@@A@@@A@@B@@A@@BBB@BBBBB@@B@@BB@B@D@@@BB@BAB@@@B@B@BA@@BA@@@ABA@A@@BA@@B@@@B@BBB@@BBB@D@@@B@BB@@BB@@AD@D@@@@BB@@B@@@B@@B@@@@@@A@@@@B@@@B@@@B@@@@@@BB@@@@BB@@@BBBBBB@BBBBAB@BCB@@AD@BBDBB@DAB@BA@ABGBI@CBR@ABAB@BA@@B@@@@AB@@ABE\\CLALCR@N@B@@BB@F@L@HRDDBD@J@D@EDAHB^Ap{DIHG@@@AHCBABADEFCBADCDADCDCBEDI@E@@@A@C@@BC@C@ABE@G@A@@BEBG@ABA@A@ABE@CAC@@BCBC@G@@@AA@AAAA@@BEBG@G@G@ABE@AB@BA@@B@@B@@B@@@DAD@DAD@B@B@FBBBBBBDFHHJBDBHBBBBFHFFHFHFJDDBB@DAB@DABCDAFE@@@A@E@C@AHGNGFCFERMJGLKDADAJAzGDIBCBGBGDGBEBG@G@E@GBE@@BAF@D@D@F@FAD@AG@CAEHADG@A@EBCBM@C@AF@bBB@B@B@NBJ@F@jDH@RB@HL@AFD@CP^DAHGD@@EFCJNBBJFBJDHDA@DF@BB@DB@@@@@B@@@BFDBB\\T@DAB@BCB@HGFCFALIJCLIHBJIRKLQ\\BFHHRHHJFNx@`lDF@DFJ@FHJB@@@BB@B@HAFBBAFBB@BBB@@BBEVQàB@uRS^S`GlQ\\YNeP_uD^g~YqF«OAWKSOMUgB@J@D@@BB@BAD@DOJSDGLKJGHI`CRABABAB@BAB@BAD@BADAB@BAB@BA@@@@B@B@BBD@BBD@BBD@DB@@B@@@@@BA@@@@@@D@@@B@@@@@B@BABA@AB@BA@@B@B@LE@@@@AAC@E@A@@CBA@A@A@ABA@ABAB@BA@@@@AA@A@@@@@A@@BA@@@A@@@A@@@@@AA@A@@AC@@@A@@@@AA@A@@@@@@@@A@@@@@@@@A@@@@@@@@@@B@@@BA@@@@@AAA@@@A@A@A@@@@@A@@@@@@A@@BA@ABA@@A@@@A@@@@AA@@B@BA@@B@@A@A@AAA@@AA@@@AAA@@@@A@@@@@@@@B@@B@@@@B@@@@@@AB@@A@A@@@@@AB@B@@A@@@AA@@A@@A@A@@AA@@@BA@@@@B@B@@@B@BB@@@@@@BA@@A@@A@A@AAAAC@AAA@A@@@@AA@@A@A@AAAA@A@AAAB@@AB@B@B@@AB@@CAA@AA@AA@@@AA@A@CBC@A@A@@@CA@AAAA@A@@@A@A@"],encodeOffsets:[[126181,46656]]}},{type:"Feature",id:"220822",properties:{name:"通榆县",cp:[123.088238,44.81291],childNum:1},geometry:{type:"Polygon",coordinates:["@@K@KAE@EAG@A@OD]LABSLEBQLGBIXDFLHdBt@J@F@H@B@B@BBp@\\BpA`CPKYI[FS@I@aHGHSTDDLDHjCP_^K\\@@MLPqPODHJFLIXAHABcVE`GlE\\@FAJB@HJPTBBBJBJAFHDRLB@bJBBNJDDA@CJN@GNGNADQ@AAK@]CKAYEAACJCF@BDHThFCHCHCLIPADALCBDTRXFDBDDOJGFI@g@MAGAOBMDOHABGNABAJAFJNFFAL@D@@ADCFCHCDELCBCDCDAB@D@B@B@BBD@BB@@F@B@B@B@@CD@DA@@BABC@C@CD@@@DAJAD@FBJEJA@A@GDC@CCAAA@EBAH@DCB@@BB@BBBAD@FODCBJRBBAFDBBAB@FA@@FDB@HCVD@@DAFDNCVQAHr`DJHXFXXf@@AE`KvbbPVLPFLZABBHACDDlX\\ZXE`@V@DXCLCB@LADAB@@@BOTCXC@@B@R@B@BB@ABM@A@A@O@@BANIFCJGFCFELIB@@@@A@C@CBE@I@C@A@@@AACACAC@@@CAE@A@ADE@ABA@@BAFAJEFCDAB@@AB@FCLEDABADABAD@HEHC@@BANGTMNED@HCHETIRGNGHCJEFAJERGHCJEHCB@@A@KD]@A@@BA@@BA@@DAHCJCHCFCJCLEDAHCB@BAJCBAFABAB@HCLCFAD@DAFCHCDCFABAB@HAJCRCLALCB@@@@BB@@BB@@@D@BAB@@@@@@@@A@@@@@AH@P@NAB@BB@@TFNFHBBBB@D@ZFLBLDB@D@F@\\@R@\\FB@@@NDZHHBHBHBNDFBJDFBFBHDFBB@PF@@@BBBJLHJFHDDDFBBDFHNFHBFBB@@BBF@FBR@LBHBNBJBHBB@DBD@PDLBFBF@JBHBNBH@JBHBL@J@P@JBH@FC@A@@FCDCDAB@NDRFJDPDVFVFJBNLNJDB@BB@VED@BABA@GBS@@B@D@T@^BfB\\B`B\\BJ@P@LBT@D@FBD@RFVFLDHBRDNDLDHBRFNDHB@@@BJDTHNFJDLDBBB@PHJDB@LFPFRHRHLDFDB@BDFB@BBAD@B@DAD@FADAB@D@FAB@B@@@BADABAD@BABBND@@B@FABAB@B@@@@@B@@B@@L@B@BAB@B@@@@@BA@ABABA@@BA@@@A@ABA@@BA@@@@@@BAB@B@D@@@B@B@D@D@B@BBB@JD@@BB@BB@BB@BBD@DBB@@@BBBB@D@DAD@F@D@@@HBDBB@@@B@BABEBEDGCK@@BAHGACAA@C@ADKB@@@@BDB@@B@@BB@B@B@BBB@B@B@B@BA@@BAB@BA@@BA@@BA@A@@BA@ABA@A@@@@@A@@BA@A@@@A@@@@@@@@A@@AA@@@B@DKJUBA@AHO@ADeBW@ABALUP[BA@@l]CIBAJcDKJi@AB@HG@@HID@BC@A@@BA@A@@BA@@HKB@A@ICAAEAOIBCHQ@EBA@AD@F@BBB@BG@@@@CAAAE@GA@@AAAA@@A@@@A@C@A@AAAAAC@CAA@A@@AA@@A@B@@@@@BA@@B@@A@@@A@@@@@@@@@A@@@@@@B@@A@@@@B@@A@@AA@@@@BA@AB@@AB@@A@@BA@@@@@A@@D@BA@@B@@AAIAAEEAAGEGECCGEIICAECAA@@EGAAAAAACAEEECEC@AA@GGEGEGEEAAGICCCCCCEE@@@@AA@AAACCAA@AA@DCHCDADABA@A@@FGBCBCBA@ABC@AB@@AFIHMFMDGB@DCHIHGDEFCFEDEBCHKBADEB@HKFGFGLKJKBCDEDAFGBABCLMLGJEAI@GAO@GCBE@A@A@CBC@I@C@@@GAC@KAEAAAA@C@EAEAMAGAA@AA@@A@A@A@AACAAAC@A@GAAAEACCECECECECCAECCAECCCAAEAGEOCC@CAC@KAGAGAMCG@G@GAC@A@CAA@CAAACAAAEAICICKAOAEA@@ECGEAACACAACAAA@@@A@A@@@ABC@C@CAM@KAGCC@@AAAACAC@A@C@AACECCAIAE@@AA@@AA@ACCACCAAEACCEC@@CAAAA@CBA@A@@@CAA@@ABA@@B@@A@C@A@A@@A@ECCAKAYGGAAACEACEO@CE@MDC@MCKCGAKC@EGAGAICC@ECEAGEKE@@CCACCECC@C@C@GCE@KBEAC@@AAGGGGEEEEA@C@@@G@G@E@E@KAC@QAQAA@E@S@E@@@AAE@KCEAC@@AWCSGKEIEKE@AKEA@AAEA@AA@@@@AAA@@AA@@AA@@C@AAA@A@@@AA@@AAA@A@@@A@@ACAAAC@@AKEWOWMIEGAKCSMKIIE_OKGICEAaQICIECAMGMCIAEAiKMCGAYGiKICWGECAAMIMKEEIGAACAEECCMM@@CCAAAAEEA@AACCAAAC@@CC@@EC@@AA@AAAAAAA@AACA@@ACCAAACAA@A@AAA@CAA@C@@ECA@CCAACC@@@@CA@@@@AACAAAC@E@C@EAC@C@O@A@EAC@E@C@A@IAICE@CAC@GACAE@CACAA@E@CAA@CAE@CAC@AAGCA@CAEAGAAAC@CAC@CAEA@@C@EA@@EAEAA@GAOCE@EAAAC@GAEAC@EAE@C@EAC@AAC@A@AAECA@A@@AA@@@@@ICECMEOEA@@@M@A@I@C@G@A@O@A@C@s@W@G@Y@C@]AS@K@A@G@C@I@GAQ@"],encodeOffsets:[[125279,45297]]}},{type:"Feature",id:"220881",properties:{name:"洮南市",cp:[122.798579,45.356807],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@BA@@BAB@BA@A@@DA@@@A@@@A@A@@@@DAB@B@@@@C@@DC@@@A@C@@BA@AB@@A@A@A@@ACAA@@@@AA@@@A@@BABA@@@@@AC@AA@@@A@A@AB@@CD@D@@AB@@A@@@A@A@@@ADAB@@A@A@A@@@A@@BA@C@ATAB@@AB@@ED@@D@@DAB@JEB@DA@@@AD@HAD@D@FA@@BBBBLAB@BBDCB@@@@A@@AA@@DAHEBAD@@@JDD@JCB@DBB@@ADAB@BAD@BAB@HAD@D@@@DAHA@@BABAB@@@B@@A@@@ABA@@D@BB@@HBDBB@@@DEBA@ABCDAD@BADCDAF@@@@A@@@AB@@ABAB@@@@AAIBG@A@AB@B@D@F@HB@@BALD@ABC@CHBAC@AFBDG@EB@BEC@@KDABEJGBG@ABAB@DCHB@BD@@DJB@DDDHDFAFCJ@CDADAFNAAFDDJCNDBF@@ED@@BBDDLBJFABK@E@AB@BBDT@D@BB@@J@@DCB@D@@@DDD@BBB@D@@ABLFRF@@FDCBDBEFDF@@B@F@HAF@BAHADC@@H@LBDAJ@HABAB@@ABA@CAAAC@ABC@@DA@ABAAAAAA@AAAA@A@@AA@@@@AA@@@@@@@A@@@A@@@A@@B@@@@@@@@AA@@@A@@@AA@@@@@CBC@@AA@@AAA@@@C@A@AA@@AA@A@A@@@AB@@AB@B@BA@@B@@AB@@A@A@A@@BA@AAA@@C@A@A@@AA@@@AAAA@AAA@AB@@@@AB@@@B@@@@A@@@A@@AA@@@@@@BA@@@@@A@@B@B@B@B@B@B@@@BABABAB@BA@@B@@@BADA@@D@F@F@@AB@D@@A@@DABCDAD@DAD@D@B@BA@@@AB@AA@@@AA@@A@A@@@C@ABA@C@ABABCBADADAFCBAB@@ABABA@ABA@@@A@@A@@A@@A@@A@CBA@@@A@A@@@A@@@@@AA@A@@@AA@@B@@@BA@@@@@A@AA@@AA@@@AA@@@AB@@@BA@A@@@A@@B@@A@A@@@ABA@AB@@A@@B@@@B@@@BAB@@@DA@@B@B@DAB@@@BA@C@ABA@@@@B@B@B@@@B@@@B@BA@@DA@@B@BA@@@AB@@@B@@@@BB@@AB@@@@E@@@AB@BA@@BAB@B@@@@@B@@AAC@A@A@@@@AAA@A@@A@@@ABABABA@A@@@AA@AC@@AA@@@A@AB@@AB@BA@@@@@A@@AAAA@@@@@A@@@@BA@@B@BA@A@@@A@@AA@A@@@A@@@@@AB@B@B@@@BAA@@A@@AAAA@@A@@A@AA@@A@@@A@@BAB@BA@A@@@AAAA@@A@@BAB@B@@@BA@@@A@A@ABABA@A@CB@@@@AB@BA@@BABAB@BA@@BA@AB@BA@@@A@A@A@ABABAF@@AB@@A@A@@BAB@D@BBBAB@BA@@@A@A@AAA@@@A@A@@@A@@@A@A@A@A@@BA@@B@@@BA@A@@A@@@AA@A@@@AB@BAB@BAB@BA@@BAB@BABA@A@A@AAA@@@A@AB@BADADAD@@@@AD@@AB@@@@CB@@A@A@AB@D@BA@C@AAAA@AA@AA@@C@A@@@A@@@AB@BA@CBADAB@B@B@@BB@BBB@D@BAB@@@@A@A@A@ABA@A@A@A@A@AAA@@@A@@@AB@@@D@D@@@BA@@@A@@AEAAAAAA@AAA@@@A@A@@BAB@B@@@B@B@B@B@D@BBB@B@BBB@@@BA@@B@@A@@@@@A@AA@@A@AB@B@@AB@B@BBB@B@B@@A@A@A@@@A@@BAB@B@BBB@B@B@@@BA@@@A@A@AA@@A@A@@@@BAD@@@BBD@B@B@@@@@B@B@B@BAB@BABA@A@@@A@@@AA@@A@@@@BA@@DAB@DAB@B@B@@AB@BA@@B@B@B@B@DAB@@AB@BAB@BBB@@@B@@A@@@@@A@@@AB@@AB@BABA@@D@B@@@B@BBB@B@@@B@B@@A@A@@@A@@BAB@@A@@@@@@AA@@@@@C@@@@B@@@B@B@B@@@B@BBB@@A@@B@@A@@AC@@@@AA@@@@@@@AB@BA@@BA@@@@B@@@B@@BB@@@B@B@BAB@@AB@@A@AAA@AA@@@AA@@A@@AAA@@BA@AB@BA@@B@B@B@BBB@B@B@@@BA@A@@@AA@AAA@@AAA@A@A@ABAB@BAD@D@@@BA@@BC@C@C@A@@AAA@AAA@@@@A@ABA@@B@B@B@DBB@D@B@B@BA@AB@@ABA@A@AA@@A@A@A@@FCB@@@B@@@BBBB@@B@@@D@B@BABABA@@DAD@@@DA@@B@@A@@@AA@AA@@@A@@@AB@B@B@B@B@FBB@BBB@@D@B@@BBB@B@@ABA@C@AB@BAB@B@B@BAB@@@B@BABBB@@@B@B@BA@@@A@A@A@A@@DA@AB@B@@BB@BB@@BA@@@@@AAA@@@ABC@@B@B@@ADC@@B@B@@BB@BB@@BA@@BC@A@@@A@@@@DABA@@@@@A@@AAAAA@AAA@@A@@@AB@@AD@BA@@B@@@B@@@@A@A@A@ABABCB@@ABAD@BAB@B@@@@@@A@@@A@@@@BA@@B@B@@@B@B@B@BAB@BAB@D@BAD@@@B@@A@@@@@A@@@A@ABABA@ABA@A@A@ABAB@@@D@BB@@BB@B@@B@@@B@@AA@@AAA@@@AB@BA@@@AB@@A@A@@B@@@B@B@BBD@B@B@@@@AB@@@@A@@DA@@B@@@@@@D@@@BB@@@B@@@BABAB@B@@@B@B@B@@@BB@@D@F@D@@@D@BA@A@@@C@@AE@A@A@E@C@@B@BAB@BCBADADABAD@@@DBDBB@B@@@BA@A@@B@B@B@B@@@B@BABABABABABC@@BA@ABAD@B@BAB@@A@@@A@@@@A@@@C@@@A@A@AA@A@@@@BABADADAB@BBB@BD@BDBB@B@B@DCB@BAD@D@BBB@BB@B@DB@B@B@D@DAFABADAH@DADCDA@@B@B@BBBDB@@BD@D@B@F@FAB@B@D@@B@@ADAB@B@B@@D@D@BALAD@DAFABAD@H@D@DBDBFBBBD@B@B@DCBAD@D@B@BBBB@BB@B@B@BABABC@ABAD@B@D@BA@@BA@A@C@AAABABAB@B@DBB@@@BB@@B@@BB@B@DAB@BBBBB@B@D@BAB@DAB@B@F@D@BAB@B@B@DAB@DAB@D@BBDDDBBBB@@@@@B@B@@@B@B@DADAD@FABAB@@@B@B@B@@@BAB@B@BBB@@@@BB@A@@B@@AB@BA@BBBBB@BAB@DADAB@DAB@B@@B@@@@@B@@BB@@BDDBDBDB@BB@BBD@B@D@B@@@@B@BB@@BB@@B@@BBBB@BB@DBDBBB@BBBAB@B@@@B@@BB@@@@@B@@@BBBBBDDFB@@D@BBB@B@BAB@BB@@D@B@BAB@BA@@DA@@BA@@B@B@@@@@B@AB@@@@@@@B@@B@@@B@@@B@B@@BB@@@D@@@B@B@@@B@B@B@B@B@B@@BB@@D@BB@@BB@BA@@BAB@@@B@BB@@B@BBB@B@B@BBB@BBBB@@@BBB@B@B@@B@@B@@A@@B@@@B@B@B@B@@BB@BBBB@B@B@BB@@@@@BB@@@DBB@@BB@B@BB@@B@@@B@@@@@B@@@B@B@@B@@B@@BB@@@@@B@@BB@@BB@BBB@B@@@B@BBB@@@@@BB@@B@B@@@@@@B@@@@@BBB@@BB@@@@B@B@@@B@@AB@@@B@@@B@@@BB@@@B@@@@@B@@A@@B@@@B@@B@@BB@B@@B@@BBB@@BB@@B@@B@@@B@@BB@@B@@@B@@@BB@@@@@B@@@@@@A@@@A@@@@@@B@@@B@B@@@B@B@@@@@@@@B@@@B@@@B@@BB@@B@@@B@@B@@@@@B@@@@B@B@@@B@@@@B@@B@@@AB@@A@@@A@@@@@@@@@@B@@@@B@@@B@@@B@BBB@B@@B@@@BBAD@DAB@B@@C@AB@DABA@@BA@@@C@A@@BCBABA@CBA@E@CBA@AB@B@B@@@@AB@BCDCBADA@@B@@AB@B@B@@@DB@@B@@@@AB@@A@@@A@ABAB@DCFC@ABCBA@C@@DABAB@DCFCFEHC@A@C@@DEBABAFEHGBCBA@ABABC@ABA@ABAFCFGBADC@ADAFABAHE@@D@@AB@@A@ABA@A@A@CAA@AAA@AAAA@BCDABA@@B@BCDC@@B@@@@A@@BA@@@@@ABABA@AB@BADCBABADABAB@B@BB@@B@BAB@BAB@D@B@@ADCBEBCBABEBCB@DBDEAGAC@AB@@AB@BAB@BABA@@B@@@@@B@@@@@DCDA@@B@@@B@@@DB@@LIHEDAQBIBAA@A@A@AB@@ABA@@B@B@@A@@@@@A@@B@@@B@@@@@@A@@A@@A@@@AB@BABABAB@B@@@@EBG@K@ADAfGBABAFYDOBMBIBE@@@CAE@G@E@AABEBA@@@MCAAABC@ABCBAB@@A@A@EBC@A@CBEBC@CBA@C@AB@AEAACA@ECKCQGQGOEKEA@ICOGA@AAKCICMESGIC@A@@GAMCQEGAKCMCQCGAKCUEQEC@EAC@S@KAO@I@[A_A[AeA]AS@C@A@@@AT@HABABC@UFA@@ACAMIMKIAUEUEOCICQEMCA@CBCDED@@@BEDG@IAO@I@K@GAIAG@MAGAIAE@EAKAOCC@CAA@GAIAMAGAKAQ@EAE@AA@@AAAEEGGMCEAACECCEGGIIKAA@A@@OEA@EAGCEAEAICEAMCGAGAGAYGMC@@A@[EQ@[@E@C@A@KCKAYEC@A@AAGAMESE@@AAA@MBO@G@@B@@@@@B@@@@@@A@ABC@@@A@@AA@@A@@A@KDKBQDIDGBA@ABEBCDGDEDCBC@EBKDGDA@ABEBABIDABA@GDCBKFIDEDGDIDGDCB@@AB@@AB@@@BC^@L@BA@GDIFGDQHIFEBIFGDMHQHSJGFGDC@MFSNMHAB@@GDGFC@ABCBABCBKFEDA@@BA@CBEDIFEBAB@@AB@BCF@B@BBF@D@@BDBDBD@B@@@B@D@JAF@D@D@B@@A@KJEFEDIHEDMJAB@@@P@B@BAN@BAAA@Q@A@@@WDSDAP@@A@CBKBA@KDWD@@[FGBsLOBRA@Et@D@\\@d@HBHBJ@FBJ\\^JNFDHJBBJHBD@@@@B@@@ADADSb@D@DBFAD@BCDCBABGBCBKDC@ABGBA@G@eHZaJKBABmVMHSD]ZABEDEF@BB^GNGN@BA@AB@B@BBD@BAF@LURI@@DAFC@@DE@@DAHAF@B@@@B@FG\\@@FBD@L@FCBB\\FD@AD@HN`BFADCP@@MHC@@FBBCJAFIVADADCHADADAFEHADGZGHc^C@ABADGBA@A@@A@AA@A@CCAA@@A@ABCBA@@D@@A@A@@@E@A@CACDEDCACAA@GBEBKBAAGGCBMBSFA@A@CA@@ABGD[CAA@@AA@A@@C@AAC@C@A@OESEA@C@@@@A@AA@@AA@I@GA@@@EBGCAEEFMC@@@KDC@QDUFI@O@CASCA@KFC@A@A@CDA@C@CBCBIDQBSBsVE@@@CDEFCFC@MF@JE@AACFBBBDHDB@@BAB@@AB@B@@CBABC@A@A@@B@@ABA@A@A@@@AB@BABE@EBCBABABEF@B@BB@HH@BBD@BB@BBD@B@BB@B@B@BBB@D@@BBB@B@B@DCB@F@@@B@@AD@B@BBD@B@B@@@B@B@@ABABAB@DAB@JCD@F@D@B@B@@@B@A@ADAB@BB@BBDBD@BBB@@BDBBBBB@@DD@B@BB@BBFDDBB@B@BB@@HD@BF@FBB@ADC@ELH@FGA@BCB@@CF@DA@@DBF@FDCF@@@@FBBBB@B@FBB@@BFA@H@@ABAD@@AH@@BAB@BC@A@ABB@D@DADAB@DADBDBDBBDDB@BAB@B@B@DBD@B@B@BDBDBB@@@BBBBB@@A@AB@B@BABC@@@@@A@@@@AA@@B@@@@ABA@@@@@@BBD@B@@@B@@B@DBBD@BB@@B@DF@@B@@@BBBBBBBD@B@@B@@@BDBDBBDBB@B@B@B@B@@@BBB@@F@BB@@BBBBBB@@BDBBB@FB@@B@BB@@@BBB@@BB@@B@BBB@DA@BB@B@@BH@B@@BB@D@@@ADGH@B@BB@B@ABGHDB@@@@@DA@AB@BABABF@@@DFA@IF@@@@BB@@@BB@BBD@@BBDFDBB@@BB@@@B@@ABB@@B@B@@@BB@BBBBBBB@AB@@@B@@@@CBABA@@BAB@B@@BBBB@@@@@BA@@BA@@B@@A@I@@@A@@AAAAA@@A@@@@BB@BBBD@@AB@@A@BBBBBB@BBB@BCB@BA@@B@@GBA@BBB@@B@@AB@@@BF@FBB@@@@B@@AB@@AB@B@B@DA@@BADA@@B@BB@BBBB@B@@@@@BCBABAF@B@@A@ABC@ABA@ABABADABA@ABABA@@DAB@@ABK@@@BD@BAB@B@@@B@@AAE@E@C@AAEAA@CB@@A@@BAFAB@@ABA@ADA@A@@@A@@B@@@@@@BB@BBB@BBB@BBBB@B@DA@@B@B@BBBBB@B@B@B@DBJ@FBB@BF@B@B@@@B@B@BL@HADDB@B@B@B@BB@@B@BBBDB@BBB@B@B@BBB@BBBD@@ABAF@B@@@@B@B@B@@ADABA@@DBB@DA@@FBDBB@BAB@H@L@@@DB@AB@@@BABEA@@@BCDE@@TIB@@CBC@@@@BAFC@A@@FADAB@BAD@@@B@B@@BBB@@BAB@D@DBB@B@L@F@BBB@@@@B@B@B@@@BA@@BB@@BB@RAPABBBD@@B@@A@AB@HADAB@B@@ABC@A@AAE@ADED@@EBCB@B@DBN@L@@A@CB@F@B@BAB@B@B@HBB@B@B@B@B@@A@@AA@ABAAA@@@ABAB@BADB@@@@B@BAD@DADA@AB@B@B@DBB@B@BADAB@B@@ABA@@BA@ABBBBBBBBBB@B@D@@BB@BBB@@B@BBB@BBB@JB@EBABA@@@@AAAA@A@@BA@AB@B@@A@@BE@@@A@A@@@AAAA@@A@@@@B@BABAB@BADA@@BABABBB@J@B@D@DAJ@B@B@B@BA@AFBB@@@BA@@@A@AB@@AB@@AAAA@AC@@CAA@@@JA@C@ABCBA@A@@D@DA@@B@@A@@@E@@@@F@BA@@@@@A@A@@BAB@@AB@B@FB@@DABAB@@A@AB@BBB@DAB@B@B@B@"],encodeOffsets:[[125002,47033]]}},{type:"Feature",id:"220821",properties:{name:"镇赉县",cp:[123.199607,45.84835],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@ND@@@@B@BB@@B@BB@@B@@@@@BBB@@@BBB@@@B@@BB@@@BB@@B@@@B@BB@@B@BB@@B@BBB@@@B@@BB@@@B@@BB@@@B@@BB@@@@@BB@@@@B@@B@@B@@B@@B@@BB@@@@BB@@@@B@@B@@@@BB@@@@@BB@@B@@@B@B@@BB@@@B@B@@@B@@@B@B@@@@@B@@@B@@@B@@@B@@@@@B@B@@BB@B@B@B@B@B@@@B@BBB@@@B@@@B@@@B@B@@@@@@@B@@@@A@@BA@@@ABABA@@B@@@B@@A@@@@@@@@@A@@@@@A@@@@@@B@@@B@@@@@BB@@B@@@@@@@B@@@@@B@@@B@@BB@@@B@B@@@@@@A@@@@@A@@@A@@@@@A@@@@B@@@@@B@@@@@B@@@BB@@@@B@@@B@BA@@B@B@@@BA@@B@B@BA@@@@@@B@@B@@BB@@@@B@@@@@@@B@@AA@@@@A@@@@@A@@@@@@@A@@A@@A@@@@@A@@@@@@@@B@@A@@B@@@B@@@@AB@@@A@@@@@A@@@@A@@@@@@B@@A@@B@@@@@BA@@@@B@@@@@BA@@@@@@@@@@BA@@@@B@@@@@@@@@B@@@B@@@@@@@B@@@BB@@B@@@@@BB@@B@@@@@B@@AB@@@@@@@B@@A@@B@@@@@B@B@@AB@@@@@B@@@@A@@B@@@@@@@B@@@B@B@@@B@@@B@@@B@@@@BB@@@@@B@@@B@@B@@B@@@B@@@@@B@@@B@@@B@@@B@@@B@@@BB@@@@B@@@@@B@@@@B@@@@@@A@@B@@@@@@A@@@@@@BB@@@B@@@@@@@@@BA@@@@@@B@@A@@B@@@@AB@@@@@B@@@@@B@@@@@@@B@@A@@@@B@@A@@@@B@@A@@D@@@B@B@@@BA@A@@@@@AB@@@@A@@@@@@B@@@@@B@@@@@B@@B@@B@@@@@B@@A@@@@B@@A@@B@@@B@BBB@BB@@B@@@B@@@@@B@@A@@B@@@AAB@@@@A@@@@@@@@@@@A@@@@B@@A@@@@BA@@@@@A@@B@@AB@@@@AB@@@@AB@@AB@@@@A@@@@@@@@@AA@@@A@@@@A@@B@@@@@B@@@@@@@@@B@@@@@B@@@@@A@@@BA@@@@@@@@B@@@@AA@A@@@@AA@@@@@A@@AD@@@B@@AF@@AB@@@B@B@@@BAB@B@@@B@B@BA@@B@@@B@@@@AB@@@@@@@B@B@@@B@@BB@B@@@B@@@B@@BB@@@@@BB@@@@@B@@@B@@@@@@BB@@@@@@@@@@B@@@@@BA@@@@B@@@@@@@B@@@@@@@@B@@@@B@@@BB@@@@@B@@@@@B@@@@@BB@A@@@A@@@A@@@@BA@@@@@@@@@@B@@@@@B@@@@B@@@@@B@@@B@@BB@@@B@@@@@@BA@@@@@AB@@@@A@@B@@@@@BB@@B@@@@B@@@B@@@B@@@B@@@@B@@@@@@@B@@@@@@@BB@@@B@@@@@B@@@@A@@B@@A@@B@@A@@B@@@@@@@@BB@@@@@@@B@@@BA@@B@@@@AB@@@@AB@@@@@B@@@B@B@@@@@@B@@BB@@@@@B@@@B@@@@@BB@@@B@BA@@@@@@B@@@@AB@@@B@@A@@B@@A@@B@@@B@@@@@@@@@BB@@@@@@@@B@BA@@B@@@B@@@B@@@B@B@@@@@B@@@B@@@@BB@@BB@@@@B@@B@@@@@@@B@@@@@B@@@B@@@B@@AB@@@B@@@@@B@@AB@@@@AB@@@@@BA@@B@@A@@B@@@@@@BB@@@@@B@@B@@B@@@@A@@@@@@B@@@@@@@B@@AB@@AB@@AB@@@B@@@@@BA@@@A@@B@@A@@@A@@B@@@@@@@B@@@BB@@@@B@@@@@@@B@@@@@B@@BB@BB@@@@B@@B@@@@A@@@BB@@@@BBB@@BB@@@B@@@@@@@BA@B@@B@@@BBB@@BB@@BB@BC@@@@B@@@B@@@BB@@B@@@B@@B@@@@@B@@B@@@@@BB@@@@@@@@@@@B@@@@A@@B@@@@@@@@B@@@B@B@@@@@@@AB@@AB@@AB@@@@@@@@B@@B@@@AB@@@B@@@B@@@B@@@B@@@B@@B@@BB@@@@@B@@B@@@@B@@@@B@@@@BB@@@@@B@BBB@DB@@B@@BB@@@@@BBB@BB@@@@B@@@@@@@B@@@B@@AB@@@B@@@@@BA@@B@B@BA@@@@B@@@@@BA@@@@@@B@@@B@@@@@B@@@BA@@B@@@B@@@@A@@B@@@@@@A@@@@DA@@@AB@@@@@B@@@@@B@@@BA@@@@B@@@B@@A@BB@@@B@@@@@B@@A@@@A@@@@BA@@@@@A@@B@@A@@B@@A@@B@@A@@@@B@@A@@@@B@@@@AB@@@B@@A@@@@B@@@@@@@@@BA@@@@@@B@@@@A@@B@@@@@@@@@B@@@@@B@@@@@@@@@B@@AB@@@@@BA@@@@@@@@BA@@@@B@@@B@@B@@@AB@@@@@B@@@B@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@@B@@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@@BB@@@@AB@@@@A@@@@@AA@@A@@@@@@BA@@@@@A@@@@@A@@@@B@@A@@@@BA@@@@@AB@@@@A@@B@@@@@@@@A@@@@B@@@@@@A@@B@@@@@BA@@@@@@@B@@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@B@@@@@@B@@B@@@@B@@B@@@@@@@B@@@@B@@@@@@B@@@@@B@@BB@@@B@@@@@B@@@@@BB@@@@B@@@B@B@@@B@@@B@@@B@@@@@@@B@B@@@B@@@BB@@@@@@B@@@@@@@B@@@B@BBB@@@B@@@B@@@B@@@AB@@@@@B@@@@@@B@@@D@@B@@@B@@@@@B@@@@AB@@@@@@A@@@@B@@BD@@BB@@@B@B@@B@@@B@@@B@@B@@@@@@BB@@@@B@@@@@@@@@@B@@@@@@B@@@@B@@@@B@@@@@A@@@@B@@@@@B@@@@@@@@@@@@B@BB@@B@B@@@B@@@@@B@B@@@B@@@@@B@@@B@@@B@B@@@B@B@@@@@B@@@@A@BB@B@@@B@@@B@@@B@B@@@B@B@B@B@BB@@B@B@@@B@B@B@@@B@@@BBB@@@B@B@B@@@B@@@B@@BB@B@@@B@B@@@@@B@B@@@B@@@B@@BB@@@B@@@B@B@B@@@B@B@@BB@@@BA@@B@BB@@B@@@B@BBB@@@B@B@@@@@B@B@B@B@B@@@B@@@B@B@@@BA@@@@B@@A@@@AB@@A@@@@B@@A@@B@@@B@B@@@B@@@@@B@@@B@B@@@B@@@BB@@B@@@@@B@@@B@@@B@@@B@B@B@@@B@B@@@B@@@@B@@@@BB@@B@@@B@@@B@@BB@@@B@@BB@B@@@D@@AB@@@@@B@@@B@B@B@B@@@B@B@BA@@B@B@@@@@B@B@@@B@@@B@B@BAB@@@@@B@@BB@B@@@B@@BB@@@B@BB@@B@B@@@BBB@@@BBB@@@B@@BB@@@BB@@B@BB@@B@@BB@@@B@@@B@B@B@B@@AB@@@@@@@@A@@@@@@B@@@B@@@BA@@B@B@@A@@B@@@@@@@@@B@@@B@@@@@@@BBB@B@@@B@@@B@B@B@@BB@B@@@B@B@@@B@@@@@B@@@@BB@@@B@B@@@B@@@B@@@B@@BB@@@B@@@B@@@B@@BB@@@@@B@@BB@@@B@@BB@@@B@@@@@B@@B@@@@@@@BB@@B@@@@@B@@@@@@BBB@BB@@B@BB@@B@BAB@@@BBD@@@BB@@@B@@B@B@B@B@@@BB@@@B@B@B@F@B@@B@B@@@@@B@B@@@B@@@B@@@@@B@@@@@B@@@BAB@@@B@B@@@B@@@B@@@@@@B@@B@@@@@B@@@BB@@B@B@BB@@B@@@B@@BB@@B@@B@@@@B@@@@@B@@A@@B@@@B@@@@@@@@@BB@@@@@B@@ABAB@@@@@@BB@FDDBBBDBBBDBB@BB@@BBB@BBB@BBFAFAF@D@B@@AD@B@D@D@B@B@BBB@B@B@B@BBB@B@B@PBH@JBL@HEDCBC@AAACAA@AAAA@ABC@ABABA@C@ABCBA@A@CACCCEAECCC@CBCBAFEDAFAB@BA@@B@B@B@B@B@@@B@B@DABBBBBBHFFDBHBP@HFFFFBBD@J@F@FBFDBBD@D@DABAHKDCBG@CACAC@@AAAAGECA@ACAAA@@@AA@@A@@@A@A@@@A@@@@@A@A@@@A@@BA@@@A@@@A@@BA@@@AB@@A@@@A@A@@@A@@@ABABABAB@D@BABAFCBADCBALEDCB@B@@AB@BA@@@AB@@A@@@A@@@A@A@@AA@@AA@@@AA@@AA@@@A@AAA@@@A@@AA@A@@@OEECIG@AA@@A@@@A@A@@@A@@@AB@@AHKJGJCHIBG@EEGAE@EDCPCH@HBHBRBFAHCDC@CACAECAMGICCACC@CBEFCHEHEJEHCHEBE@EEECAWIGECE@EBCHEHAJCHEFCFGBGCGIEIECCAC@EBCDEFCJALCJCLEDIFMFMBK@E@CCCEAE@IAQBGBKDGBG@GAEAECCCAEBEFAJC^MFAFEDG@EAEECGCQAYCOAKCECGEEICK@KBOBKDGDIJINKLKHIHGJCNENANCRCPEH@NAJAHEFEBIBE@AHIDG@G@G@@DK@G@KAEECEAG@KBM@G@IACACCCEBCBIFKJKJEHERGHEDC@CAACAI@G@ICGCIEGECCGASAQ@EAGCCCACAEBCDGJMHMDGDAFAFBJBJBLBP@P@FAFEHCLENEHCDA@CAC@AAA@@A@CAA@CAC@CACAC@AAEAEAE@CAA@A@@AA@A@A@C@ABA@A@@@A@A@@A@@@A@ABAB@@@@A@@CCEEA@@@@@ABA@CB@BCBCBEBCBC@CBE@GBEBA@A@A@A@A@@@A@ABG@E@EBC@C@C@EBC@E@C@K@C@G@IBA@I@K@G@C@E@C@E@C@C@C@C@A@AAA@A@C@@@C@AAC@C@AAC@AAC@AACAC@CACACACACAGAECEACAEAEACAC@GACAEAC@EAC@A@A@CAC@C@A@CBA@A@A@E@A@CB@@A@C@ABA@CBA@ABC@ABA@ABABA@ABA@ABAB@BABCBAB@@AB@B@B@BB@@B@@B@BB@@B@@@BB@@@@@B@@BBBBB@@B@@@@AB@@A@@@AB@@A@@@@@@@@@A@@@@@A@@@@B@@@@@B@@AB@@@B@@@BA@@@@@@BA@@@AA@@AAA@AAA@@@C@A@ABA@A@@B@@@@@@@@@@@BA@A@AB@@@@A@@@A@@AA@@@@@@A@@B@@A@@@@@@@AA@AA@@CA@@A@@@@@@@@B@@@@@B@@A@@BA@A@@@@B@@@@@B@@@@AB@@A@@BA@A@A@A@A@A@@A@@A@B@@@@A@@B@@@B@B@B@B@B@@A@@@@@@@@AAC@A@A@A@@@A@@@@@@@@B@@@@@BA@@@@@AAA@@@AB@@@@@@AA@@@@@@@A@@@@@A@@@@B@@@@@B@@AA@@@@A@@@@@A@@A@@@@@@@AB@@AB@BABA@@@@BB@@@@B@@@@@@@@@@ABA@@@A@@AA@@@@A@@@@@@@A@@@@A@@@CAA@@@@@@A@@@@@@@AB@@@B@BAB@@@@@@AA@@@@@@A@@@@@@@@@@@A@@@@@@@@A@@@@@A@@@@@A@@B@@@@@BA@@@@B@@@B@@@@A@@@@@A@@@A@@@AB@@@@@@@@@@@B@@@@B@B@@@B@B@B@@BB@@BA@@@A@A@A@@@A@AB@@A@@@A@@@AAA@AA@@@@@@@@@BA@@@BB@@@@BBBB@B@@@B@@@@@@@@@B@@A@@@@@AA@@@@A@@@AA@@@@A@AA@ABA@AA@@@@@A@ABEDABA@@@A@B@@@BA@AA@@A@AA@@@C@A@A@C@@BD@@@@B@B@@@B@B@@AB@@@@A@A@@A@A@AAC@AAA@B@@AB@@ABA@@A@@DA@@@AA@EAA@@@@B@@@B@@@DA@@@AAA@@A@@@ABA@@BB@AB@@A@@A@A@A@@B@@AD@@A@@@AACAA@@A@@C@C@@@@B@@@@@B@BAB@@A@A@@AC@@@@A@@A@A@@@@@@ABA@CBA@@@@A@@@AB@@@A@@@CA@@AAA@@AAAA@@CB@@AB@BEAA@@A@@@@DCDAFA@@@@@@C@AAA@CA@@@AD@@@AAA@AAA@A@@B@@@@@@BBBD@@ABDB@@@B@@A@C@A@@A@@AAA@@@@@@BB@@B@B@@@@@@@BBB@@AB@@@@@BA@@A@@@C@@AAC@@AB@B@@@@AA@C@@@@@@AC@@@A@A@@@B@BB@@A@@@CBA@@@@@@BB@@@@@@@AB@@A@AA@@@@@@@A@@@A@@A@@@ABAB@@@BB@@@BBAB@@@@A@A@ACA@@@C@A@CBAAA@A@@@A@@@A@AB@@@@A@A@A@@@@A@@@A@@@@@AD@@@@@AA@@A@CBAB@AAAAA@@A@@@BBBB@@@@A@A@E@CA@@AB@@@BBB@@@B@@@@A@@@@@@@@A@@@@A@@@@@@@A@A@@@@@@@@@@@@@@@A@A@C@@@BB@B@@@@@BA@@B@@A@A@@@@A@@@@@A@@@@ABA@@AAA@@A@CA@@A@@@@BB@BB@B@BBB@@@@@B@@C@@@@@A@A@@@@@@@AA@B@B@@EAA@A@@@@@@@AB@@A@@@@@@@@A@@A@@@@@@@@B@@@@@@@B@@A@@@A@@@@@@@@@AB@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@B@@@B@@@@BB@@@@B@@@B@@@@A@@@@AA@@@@@@@@@@@AB@@@@@@@@A@@@@@@@@@@A@@A@@@@BA@@@@@AAA@@@A@@@A@@@@@@@@B@@@@B@@@B@@@@@@B@BA@@@@@BB@@@@@B@@@@A@@B@@@@A@@A@@@@@@@@@@@@@@@@A@@B@@@@A@@@@@@@@@AB@@@@@B@@@@@@@@@@A@@@A@A@@@@@A@@A@@@@@A@@@@B@@@@@@@@@B@@@@@@@@A@@@A@@@@@@@@@@@@@@@B@@@@@@A@@@A@A@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@BA@@@@B@@A@@@@@A@@B@@@@A@@@@@@A@@@@@@@AAB@@@@@@@@@B@@@@A@@@@@@@@A@@@@@@@@@@A@@@@@@BA@@@@@@@AB@@@@@@A@@@@A@@@@@@A@@@@@@@BA@@B@@@B@@@@@@@@@@A@@@@@@@BA@@@@@A@@A@@@@@@@@@@@@@@A@@@@@@@@B@@@@A@@@@@@@A@@@@@A@@@@@A@@A@@@@@@@A@@A@@@@@@@@@@B@@@@B@@@A@@@A@@@A@@@@AA@@@@@A@@@@@@B@@@@B@@@B@@@B@@@@@@B@@@@A@@B@@C@A@A@@@C@A@@@@@@@@@B@@A@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@A@@@@@@@@@A@@@@B@@@@@@@B@@B@@@@@@@@B@@@@A@A@AB@@A@@@@@A@@@@@@A@@@@@@@@@@A@A@@@@@@@AB@BA@@@@@@@A@@@@@@@@A@@@@A@@@@A@@@A@@@@A@@AA@@@A@A@@@@@@@@@BB@@@@@@@@@@B@@@A@@@@BA@@@@@@B@@@@@@@AA@@@A@@@@@A@@@A@@@@B@@@BB@@@@@@@BA@@B@@@@@@@@@@@@B@@@@@@AB@@A@@B@@@@@@@BBA@@@@B@@@@@B@@@@@@@@@A@@B@@@B@@@BA@@@@@@@@@A@B@@A@@@@@@@@@@@@@@A@@@@@@@A@@@AA@@@@@@@B@@@@@@@@@@@B@@AB@@AB@@@@BB@@@@@B@@@@@@@@A@@@@@AA@@@@@@A@@@@@@@BB@@B@@@@@AB@@@@@@@@A@@A@@A@@@@@@@@@@B@@@@@@@@@@@BA@A@@@AB@@@@@@@B@@@@@@@@A@@@AA@@A@@@AB@@@@@@A@@@@B@@@B@@@@@@@@A@@@A@@@A@@@A@@A@@@@@@@@B@@@@@B@@@@@@@BA@@@@A@@@@@A@@@@@@@A@@@@@@@@@@@@@@A@@@A@@B@@@A@@@@@@@AA@@@@A@@@@B@@@@BB@@@@@B@@@@@@@@@@AA@@@@@@@@@@A@@@@@@@A@@@A@@A@@@@@@@@A@@@@@@@@@A@@@@A@@@@AA@@@@A@@@A@@@@@@@@@@@@B@@@@@B@@@@@@@B@@@B@@B@A@@@@B@@@@@@AA@@@@@@@@@@A@@@@@A@@BA@@@A@@A@@A@@A@@A@A@@@@@@A@@@@@@A@@@@@@@A@@@@@@@@@@@AB@@@@@@A@@@@@@@@@@@@@@A@@@@A@@@AA@@A@@@@A@@B@@@@@@@@@@@@@@@@@@A@A@@@@@@A@@@A@@@@@@B@@@B@@@@@@@@@@@@@@@@A@@@A@AAA@A@@@A@@@AB@@@@@@AAA@@@B@@AB@@@@@@AA@A@AB@@@@@BA@A@@@@A@@@@A@@@A@A@A@AA@@@@@AB@@@@AB@@@B@@@BAB@@@@@@@@@@AA@@@A@@@@@@@@BA@@@A@@@A@@@@@@@A@@@ABAB@@AB@@A@@@@@@A@@@@@@A@@A@@@@@@BA@@@@A@@@@@AB@@@@@@A@@@@@A@@BA@A@@@A@@@A@@@@@AA@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@A@@@@@A@@@@@@@@B@@A@@@@A@@@@A@@@BA@@@@@A@@@@@@A@@@@B@@@@@@A@@@@@@@@@@@@@@@A@@@AB@@@@@A@@@@AB@@@@A@@@@@@A@@A@@@@@@@@@@@@@@@@@@A@@@@@@@BA@@@@@@A@@@@@@@@@@A@@@@@@@AAA@@@A@@@A@A@@@A@@@@@A@@@A@@AA@@@@@A@@A@@@@BA@@@@@A@@A@A@A@@@@@@@@@B@@A@@@@A@@@A@@@A@@@@@@@@@@A@A@@B@@A@@@@@@@@@@BA@@@@AA@@@@@@@A@@@@AA@@A@@@A@A@@@@@A@@A@@@@@@@A@@@A@@@@AA@@@A@@B@@@@@@A@@@@A@@AA@@@A@@A@@@@A@@@@@A@@@@@@A@@@@@@@@@@@@@A@@@@@@@@AA@@@@@@A@@@@@A@@@A@@@@@@@A@@@@@AA@@@@@@@@B@@@@@@A@@@@B@@@A@@A@@@@A@@@@@A@@@@B@@A@@@@@@@@@@A@@A@@@@@A@@@@@AA@@@@@@A@@B@@A@@@@@@@@@A@@@@@@A@@@@@@@@@A@@B@@@@@@@@@AA@@A@@@@@@@@@@@@@BA@@B@@@@A@@@@@@A@@@A@@@@@A@@@@@@@@@@A@@@@@@@A@@@@B@@@@@@@@@@@B@@@@@@AA@@@@@@@@@@@AB@@A@@@@@@@AB@@@@A@@A@@@@@@AA@@@@@@A@@@@@A@@@A@@@A@@@A@@@@AA@@@@@@@@@A@@@@@@@@@A@@@@@@A@@B@@@@@B@@@@@@@BB@@@A@@@@@@@@AA@@@@A@@@@@@@@A@@@@@A@@@@@@@@@A@@A@@@@B@@@@@@@@@B@@@@A@@@@BA@@@@B@@A@@@@@@B@@@@@@A@A@A@AA@@A@A@@@@@@@@AB@@@@@AA@@A@@@A@AB@@@@A@@A@@@@BAA@@@@A@@@@@@B@@@B@@@@A@@@@@@AA@@A@@@@@@@@A@@@@@@@@A@@@@BA@@B@@A@@@@B@@@A@@A@@A@@@@AA@@@@A@@@@BA@@@@@A@@@@@@@BA@@@@@@AA@@@BA@@@AB@@A@@@A@@@@@@A@@@@@@@A@@@@A@@@A@@@AB@@@@A@AA@@@A@@@@@@BB@@@@@@B@@@@AA@@A@@@A@A@@@@@A@@B@@@@@@@@@@A@@@@@@@@A@A@@@A@@@@@A@@@A@@@@@A@@@AB@@@@B@@B@@@@@@@@@@A@@@A@@B@@@@@@A@@AA@@@@@B@@@@@B@@@B@@@@AA@@@@@A@@@A@A@@@@@@A@@@@B@@@@@A@@@@AA@@@A@@@@@@@@@@B@@@@A@@B@@@@@@@@@@@@AB@@@@CA@@A@A@@@@@@@AB@@A@@@A@A@@@A@@@@A@@@@@@A@@@@@@B@@A@@@A@@@@@AA@@@A@@@@A@@@AB@@AB@@A@@BA@@@@@@@A@@@@A@@@A@@@@@@@@ABA@@@@@A@@@@@@@A@@@@@@@A@@@@@A@A@@@AB@@A@@@@B@@A@@@@@@@AA@@@@A@@@A@@A@@@@A@@A@@@@@@@A@@B@@@@@@A@@A@A@@@@@@@@ABA@@@@@AB@@@B@@@@@B@@@BB@@@@@@@A@@@@@@@A@@@@A@@@@B@@AA@@@@@@@AA@@@@@A@@@A@@@A@@@@@A@@@@@A@@@@AB@@@@@@@@@@@@A@@@@@@A@@@@@A@@@@@@@@AB@@@@@@A@@@@AA@@@@@@@@AB@@@@A@@@@A@@A@@@@AA@A@@BA@A@A@@@A@@AA@@@AA@@@@AB@@@@A@@B@@@@@@AB@@AB@@@B@@A@@@@@A@@@@@@@@A@@AA@@@@@@AB@@A@@A@@@@@@@A@@@AA@@@@@B@@A@@@@@@@A@@@@@A@@@@A@@@@@A@@@@@A@@A@@@@@@@A@@@@@@@@@@AA@@@@A@@@@@@@@@A@@B@@@@@@A@@A@@@@@A@@@@@@@A@@@@B@@BB@@@@@@A@@@@@@@A@@@@A@@@@A@@A@@@@@@A@@BABA@@B@@@@@@@CA@@AA@@A@A@@@AAA@@@@@@A@@@A@@@@A@@AA@@@A@@AB@@@@@B@@AA@@@@@@A@@@@@@@@@A@@@@@A@@@@B@@AB@@@B@BA@@@@B@@@@@BB@@@@B@@@@@@@@A@@B@@@@AB@@@@@@@@@AA@@@@@B@@A@@@@@@@A@@@A@A@@A@@@@@@@@@@@@B@@A@@@@@@@AA@@A@@A@@@A@@B@@A@@@@@@@A@@@@@@A@@@@@@AA@@A@@A@@A@@@A@@@A@@@A@@@@@A@@@@@@A@@@@AAA@@@@AA@@@@A@@@@@A@@@@AA@@A@@@@A@@B@@@B@@@@@B@BA@@@@B@@@A@@@AA@@A@@AA@@@@A@A@@BA@AAA@@@A@@@@A@@@A@@@A@@@AA@@AAA@@AA@@@@A@@B@@@@@B@B@@@@@B@@@@@@A@@@@@A@@@A@@A@@A@@@A@@@A@@A@@A@@AAA@@@AA@@@@@A@@A@@@@A@@@@AAA@@@A@A@A@@@AAAAA@A@@AA@@@AA@A@@@AA@@@AB@@AB@@@@A@@AA@AA@@AACAA@AAAA@@@@@@AAA@AB@@AAA@@@@A@@@@@A@@@@A@A@A@AAA@A@A@ABA@@@A@@@AA@A@@@AAA@A@@ABA@AB@BAB@B@B@BAB@DB@F@D@BB@@@@KFA@A@@@ABA@@BABABA@@@@@A@@@C@@@@@@@AB@@@@A@@@CAC@AAC@AAC@AAA@A@@@@@ABA@ABA@CBABC@ABA@ABA@ABABQB_DGJIHKLCHITCPC@ABA@@AC@I@A@VhPNLTBXPE¬r}Z]hCvO`Mf[ZkR_H]TQT@vßAGhGfWªGf@@bDHĜX¤LHJJhfZDhFVDQAHrDBD@^dDBlZFDLFFD"],encodeOffsets:[[126135,47359]]}},{type:"Feature",id:"220882",properties:{name:"大安市",cp:[124.292626,45.506996],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@GGKKCCEECCEAECC@AACACA@ACAEAA@A@KAIAA@CACAA@CACACAGCAAC@G@GA@@ADCDAB@@AB@@ABABAB@@ABAB@@@B@B@@@B@BA@@@A@C@KA@@A@@@AHA@AAE@C@@BAB@FGRADPJFBBBJDB@A@GL@@AB@@@BAB@@@BADC@GJ@@GHA@@BIjCLIdABDJk^@@ABO\\KVAB@BAXCf@BGP@BABIVCLA@@@B@@BB@@@@@@@@@@B@@@BAB@@@B@@@@@BAB@BAB@@@BAB@@AB@@ABA@AB@@ABA@A@A@A@AAA@A@A@@AA@@@CA@A@@A@CL@B@DBBBDGHAB@@DLCHAFAFABA@@@A@CAGA@@C@E@C@CBC@A@AA@A@@AA@CAC@AAAA@@AAA@@ICA@AAA@C@C@A@A@@@C@A@A@AB@@@@@@AB@@AB@B@B@@AB@@ABAB@BAB@@@@A@A@ABA@K@@@@AA@@@@@A@A@@B@F@HBF@D@@AFAJANCPEZABABeHCB@B@LAH@F@@A@A@ABABABA@@B@@@BB@@@@B@@@@A@@@A@@@@B@@@@@BA@A@@@AB@BA@@B@B@BBBJARACBGFKJ@@CA@@A@@@A@@@CBCD@@@@A@@@@@A@@@ABABA@ABA@@BA@@BBDBHCFCAA@ADAFABADAFCD@BA@C@A@ABA@ABA@@@AAA@A@ABCBABABCDABA@@BABAB@B@@@@AB@@@B@@A@@@CDADA@@@ABCBADB@BB@BBB@BBB@D@B@BAB@B@BA@@BC@@@GFABEBCB@BCDABEHEDAB@BAB@BADAB@BABADGHEFABABCF@@@D@BGDEFEDCDA@ABCB@@@DABAD@BEDCDA@AB@B@B@@@BA@@B@@A@@@CA@@A@A@A@@BA@@@CBABCDADA@@B@@A@A@A@@BAB@D@FAB@DABABAD@@@B@D@@AB@@ABCBA@@B@DA@A@CBC@AB@@@@BB@@B@@@@B@@@@@B@@@@@B@@@@@@A@@B@@@@@B@@@@@@@BB@@@@@@BB@@@@BB@@@BBB@DB@@B@@BBB@BAB@BBBB@BBBBB@@B@DBBB@B@B@@@BAB@B@B@@@@B@@@BCBABCDCBA@@B@@BB@@B@B@DBB@@@@B@B@B@BA@ABA@A@AB@@@B@@@BB@@@@B@@B@@BBB@@B@@B@@@@BB@@B@BB@@@@@B@@BB@@BB@@B@B@@B@@B@@B@@@B@@@@@@B@@@@@@B@@B@@@B@@BD@@BBBB@@BB@@B@@A@@BAB@@CB@@A@@B@B@@BBBBB@@B@B@BB@@BDB@BBB@B@BAB@@A@ABAB@@@B@BB@BBBBBBBB@D@@@BBB@@B@D@B@B@D@B@BB@@@@B@@B@BDBBBBBBB@@BBD@B@B@@@BB@@@B@@@B@@@BB@@BBB@BBB@@B@B@B@B@B@@B@@@B@@C@C@C@@@A@@@@BB@BB@@BB@BBB@@BBD@BB@BB@B@B@@@B@B@BB@BDB@@B@B@D@DAB@B@@B@@BBB@@BBB@DA@@BA@A@A@@BA@BB@B@BBBBBB@B@@@BB@@@@@BBB@BBDBB@B@B@BB@@@AB@@@@@@AAA@@@A@A@@@@@AB@@B@@BB@B@@@@BB@@B@@@BA@A@@B@@@@@B@BA@@B@@@@A@@@@@@AA@@@@@@@@@@B@@B@BB@@B@@BB@BBB@B@@@@AB@@A@AB@@B@@B@@@B@@@AB@BAB@@@B@@@@B@@@@@B@B@B@@@B@BB@@@@B@@A@@@A@@@@@@@@B@@@@@@@@@@B@@@@@@B@B@@B@@B@@@D@@BB@B@@B@@@@@B@@@B@@AB@@@B@@@@@BBB@@@BBB@B@@@BBB@@B@@@B@BAB@B@B@BBB@B@B@@@@@@B@@@@@B@@B@BB@@BAB@BB@@@@@@BBBBB@DBBB@@BBB@@BB@@@@@BA@@BA@@B@@B@@@BBB@@B@@BB@B@BBBB@@B@B@B@@@B@BB@@@@@B@@B@@@@B@@@@BB@@B@BB@@@BB@@@@B@@@B@@@BB@@@@B@@@B@@@@@B@@@@A@@@@@A@A@@@@@A@@@@B@@B@@BB@BB@@BB@@B@@@B@@@B@@@@@@B@@BB@BAB@@@B@B@@B@@BB@@@BB@@B@@@A@@@@@ABA@@@@@A@@@A@@@@B@@B@@@BB@@@@@B@@@@@B@@B@@B@@B@BB@@@@@B@@@@B@@@@@B@@@B@@@B@@@B@@@@BB@@@BB@@@@@@@B@@@@B@@@@@@@B@@@@AB@@@B@@@@BB@@B@@@@@@@BA@@@@@@@@@@@@@@BB@B@@@B@@@@@@@B@@@@A@@@@BB@@@@@@@@A@@B@@A@@@@B@@@@@@A@@@@@AA@@@@A@@@@@ABA@@@A@@BA@@@@@@B@@@@@B@@@@@@@@@B@@@@B@@BA@@@@@A@@BB@@@B@@BB@@@@@@B@@@B@@@@B@BB@@B@B@@@BB@@DB@@@@@@A@@@ABAB@@@B@@@@B@@@@B@@B@@@@@@B@@@@@@@B@@@@A@@AA@@@@@@B@@@@@@@B@@@@@BB@@@@@@@@AB@@@@@@@@@B@@@@@BB@@@@@@@@@@@B@@@@@@@BB@@@@@B@@@@@B@@@@@@B@@@@@B@@@@@@@BA@@@@@B@@B@@@B@@@@@@@BB@@@BA@@@@@@BB@@@B@@@@@@B@@@@@B@@@@A@@BA@@BA@@@@@@@AB@@@@@BA@@@@BB@@B@@BB@@@B@B@B@@AB@B@@B@@B@@@@B@@B@@@@@BA@@@@@@@@BB@@@@@B@@@@A@@B@@@@@@B@@@@@B@@@@@@@@B@@@@@@@@@@A@@B@@B@@@@@B@@@@@B@@@B@@@B@@@@@B@@B@@@@@@BB@@@A@@B@@@@@@B@@@@@@@B@@@@@@AA@@A@@@@@A@@@A@@B@@@@AB@B@@@@@@B@B@@@@B@@@@A@@@@B@@@@@@@BB@@@@@@BB@@@B@@@@@BB@@@@@@B@@@@A@@B@@@BA@@B@B@@@@@B@@@@@@@B@@@@@@@B@@@@@B@BA@@@@@@@@@B@@@B@@B@@@@@@@B@@AB@@@BA@@BA@@B@@@@@@B@@BB@@@@B@@@B@@@@A@@@@B@@@@@@@@B@@B@@@B@B@@@B@@@BA@@@@@@B@B@@@DB@@@@BA@@@@@@@@@@@@@AB@@@@@@A@@@@@@@@B@@@B@@B@@B@@@@@A@@@@@@B@@@@B@B@@@B@@@@@B@@B@@A@@@A@@@@@A@@@@@B@@BB@@@@@@@@AB@@@B@@@@@@@@@@@@AA@@@@@BA@@B@@@@@B@@@B@@@@@B@@@B@B@@@@@@@@@@B@@@@@@@@A@@@@B@@@@@B@B@@@BB@@B@@A@@@@@@@AA@@@@@@@B@@BBB@@@@@BA@@B@@@B@@@@@@B@@@@@@@B@@@@B@@@B@@@BA@@B@@A@@BB@@@@@@AB@@@@@@B@@@@@B@@A@@B@@@@@BB@@@@@BB@@@@B@@@A@@B@@@@AB@@A@@B@@@@@@@@@@B@@@@@@B@@@BB@@@@@@@B@@A@@@A@@@@@@@@B@@B@AB@@@@@BB@@@@@BAB@@@B@@@BB@@@@A@@B@@@@@@B@B@@@BBB@B@B@@@@@@@@A@@@@B@@@@A@@B@@A@@B@@@@@@A@@@@@@@@@A@@B@@@@B@@@@@@@@@B@@@@@B@@@@@@B@@@@@BB@@@@@@@@@B@@AA@@@@@@A@@@@@A@@@@B@@@@B@@@@@@@@@B@@@@@@@@@B@@B@@B@@@B@@@B@@@B@@@@@B@@@@@@@BB@@@@@@@BB@@@@@BA@@@@@@B@@@BA@@@@@@@@@@B@@B@@@@A@@@@@@@@@@@A@@@@@@B@@@@@@@B@@@@@@@@B@@@@@B@@@B@@@@@@@@B@@A@@@AB@@@@@@@@@@@@B@@@BB@@@@@@@@A@@@@B@@@@@@@@@B@@@@B@@@@@@@@@B@@@@AB@@@@@@@BB@@@@B@@@@@B@@@@B@@@@@@@@B@@@@A@@B@@@@@B@@@@@@BB@@@A@@@@@@B@@@@A@@@@@@@@@BB@@@@B@@@@@@@B@@@B@@@@@B@@@@@@@BB@@@@@@@@@B@@@@@@@@@@@@@B@@@@B@@@@@B@@@@@@BB@@@B@@BB@@@@@@B@@@@A@@@@B@@BB@@@@@B@@@B@@@@@@@BB@@@@@B@B@@@B@@@BB@@@@@B@@@@@@BB@@@@AB@@@@@@@@@@@BA@@@@B@B@@@@@@@@B@@@B@@@B@@@@@@BA@@@@@@@@@B@B@B@@@@B@@@@AB@@@@@BB@@@@@B@@BB@@@B@@@@@B@@@B@B@@@B@@@B@BB@@@@@@B@@@@@@@@@@@@B@@@@B@@A@@@@@@@B@@@@@@@@@@@@@@@@B@@@@B@@@@B@@@@@BA@@@@@B@@@@BA@@B@@@@@@@@@@@@@@@B@@@@@@@@A@@B@@@@@@@@B@@@@AB@@B@@@@@@B@@B@@@@A@@@@@@B@@@@@B@@@B@@@@@@@@@@@@@@@@@@@@@@B@@@@@@BB@@@@B@@@B@@@B@B@@AB@@@@@B@@@@@@@BA@@@@B@@@@@AB@@@@@@@BB@@@@@@@@B@@@@B@@@BA@@BABA@@B@@@@@@@B@@@B@@@B@@A@@@@@@B@@@B@@B@@@@@@@@A@AB@@A@@@A@@B@@A@@B@@@@BBB@B@B@@@B@@@@@@B@@B@B@@A@@@@BAB@B@@B@@@@A@@BA@@@B@BB@@@@@@BA@@B@@@B@B@BBB@@@B@@@@@@@@@@@@@@@@@@A@@@A@@@@B@@@B@@@@@@@@B@B@@@@@@@@@@@@@@@@A@@@@B@@B@@@BB@@B@@@@@@B@@@@@@@@@@@@B@@@@@@@BA@@@@@@@@@@B@@@@@@@B@@@@@@@@B@@@@B@B@@@@BB@@@@BB@@@B@@AB@@@@@B@@@@@@@@@@@BB@@@@@@@A@@B@A@@@@A@@@A@@@@@@@A@@@@@A@@@@@@@@@@B@@@B@@@@@BB@@@@@B@@B@@@@@@@@@B@@@@@@@@@@BB@@@B@@@@@@@B@@@@@@@@@@@BB@@@@@@@@@@@A@@@@AA@@@@@A@@B@@@@@BB@@@@@@B@@@A@@@@B@@@B@@@@@@@@@@@@B@@@@@@@B@@@@@B@@@@@AB@@@@@@A@@@@@A@@@@@@@@@@BB@@@B@@@B@@@B@@@@@@@@@@A@@@A@@B@@@@@@@BA@@B@@@BB@@B@@@@@@@@@@A@@@@@@BA@@B@B@@A@@@@@@@@@@@A@@@@@@@@B@@@@BB@@@@@@@@@BA@@@@A@@@AA@@@@@@B@@@@@@@BB@@@@B@@@@@@@@@@A@@@@AA@@@@BA@@BA@@@A@@@@@@@@@@@A@@@@@@BB@@B@@@@@@@B@@@@@@@@@@@@@@@@BA@B@@@@@@@@@B@@A@@@A@@@AB@@@@@@@@@A@@@@@A@@@@@AB@A@@@@@@@AB@@@BA@@@@@@@A@@@@@@@@@@A@@@AB@@@@@@A@@A@@@A@@B@@@B@@@@@B@@@B@@B@@@@@@@A@@@@B@@A@@B@@@A@@@@@@@@@@@AA@@@@@@@@B@B@@@B@@BB@@@@@@B@@@B@@B@@@@@@B@@@@@@B@@@@@@@B@@ABA@@@@@@B@B@@@@@@@@@@@@B@@@@B@@@@@B@@@BAB@B@@@@@@A@@@@@@A@@@@A@@@@@@@A@@B@@@@@@@@@B@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@BA@@@@@@@@@B@D@@@B@B@D@@@@AB@@@@@@A@@@@A@@@A@@@A@@@@@@A@@@@B@@@@@B@@B@@B@@@B@@@B@@@A@@@@@@A@@@@@@@@B@@@@B@@@@@@@BB@@@@@B@@@@@B@@@@@@@B@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@BB@@@@@B@@A@@@@@@@B@@@@@@@@A@@@A@@@AB@@@@@@B@@@@@@@@B@@B@@@@@@@BA@@@@@@B@@A@@@@B@@@@@@@@@@@@B@@@@@@B@@@@@@A@@@@@@@@BA@B@@@@@@@B@@@@B@@@@@@AB@@@@@B@@@@A@@B@@A@@@@@@@@A@@@@@@@@@@A@@@@@@@@B@B@@@B@@@@@@@@A@@@@@@@@@@@@@@@B@@@B@@@@@@A@@@@@@@@@A@@@@@@B@@@@@BB@@@@@B@B@@@B@@@@@@@@@@@@A@@@@BA@@@@@@@@B@@@@@@AB@@@@@@@@@@@@@@@@@@BB@@@@@@AB@@@@@@A@@@@AA@@@@B@@A@A@@@@A@@@A@@@@@@A@@@@@@B@@@B@@@B@BB@@@@B@@A@@B@@@@B@@@@@@@@B@@@@@@@@@BA@@@@@@@@@@B@@B@@B@@@@@@A@@@A@@A@@A@@A@@@A@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@BA@@@@@@@@B@@@B@@@@A@@@@@@@A@@@@@@B@@@@B@@@@@@B@@@BA@@@@@@B@B@FB@@@A@ABB@@@@@@B@B@@@@@D@@@@A@@@@AA@A@AAAA@@A@@B@@@DBB@@@BB@BB@BA@@@@@B@@@@@B@@B@B@@@@AB@@A@@@@@AAA@@D@B@B@@@@@@@@@@@@@@@B@B@@@@@@@B@@@@@@B@@@@@@B@@@@@@A@@AA@A@@BA@@DBF@B@B@@@@@AAAA@@B@@@BBBB@BBADAB@@@BB@@@@C@@B@@@@@B@@@B@@B@B@B@@@@@BAB@@@B@@@B@B@BBDAB@D@@@B@BDB@B@@@@@BAAA@@A@@A@@BABA@@B@@@@B@@@B@@@@@@BBB@@@BA@@@@@@A@@A@@@@B@DA@@B@@@AAA@@@B@B@@@D@@B@@@@D@B@@B@@A@A@@BD@BB@@@D@@@BB@@A@@@@BA@@AA@A@@@@@@@A@AA@@A@@@@B@BB@@@BB@D@B@@@@A@@CABA@@ACAA@@@@@@@AB@B@BBB@BB@@C@@B@@DBB@BBD@@@@@@@EBCBCD@@@@@BB@FB@ABA@@DA@@BBBB@@BB@BB@@D@@@B@@BA@@B@@@@@AB@DAB@B@@@@B@B@@@@B@@D@@BB@B@@@BA@A@A@@@@@A@@D@D@@@@BB@DBBB@@B@@@BC@@@AB@B@B@@@@BA@@BAA@@AB@B@@@BB@BB@@B@@C@@@A@@@A@@B@FBB@@B@@CB@@@BB@BA@@BA@@@ABB@BBD@B@B@BB@B@@@@@BA@@@A@A@@@A@A@@C@@AD@B@B@D@@@B@@B@BB@@BAB@@A@B@@@B@BAFCBAB@@@@@B@@BAB@BBBB@@@@@BB@@B@@@@@BB@@@@B@@@@A@@@@@@@@@A@@@AAAAA@@@@AA@@B@@A@@@@@@@@BBB@BB@@B@@@B@@@BAB@@@B@B@B@@@B@@AA@@AA@A@A@@@A@A@@@@@@A@@@@@@@@@@BA@@B@@@B@@@@@B@@@@@@A@@@A@@B@@A@@@@@AB@@@@@B@@@@@B@@@@@@@@@@B@@@@@@@@@@@B@@@@B@@B@@@@A@ABA@@@A@@B@@@@@@@B@@@@B@DB@@B@@@@@@B@@@@@@@B@@B@@BB@@@B@BA@@@@@@@@@@@A@@A@@A@@B@BA@ABA@@BA@@@@@@B@@@@B@@@@@B@@B@@BA@@@@@A@@@@@@B@@@@@B@@@@@@BB@@@@@@BA@@B@BB@@@@B@@A@@@@@A@@@@@@B@@@B@B@B@D@BB@@@@@@@@@BA@A@A@A@A@@@A@@@@B@@A@B@@@@BB@B@B@B@B@B@@AB@@@BA@@@@@A@@@@@A@@B@B@@AB@@@@A@@@@@A@@@@@@B@@@DB@@BBB@@B@@@@@@@BA@@@@B@@@@B@@BB@@@B@@@@@BAB@B@@A@@@@@@@@@@@AB@B@BAB@D@@@B@BBB@BB@@BB@@B@@A@@@@B@@A@@@A@@BA@@@A@@@@@A@@B@@@@@B@@@@@@@@@B@@@BA@@B@@@BA@@@@@AA@AAAA@@@A@@@@AA@@A@@@AAA@@@@AA@@A@A@ABA@@BADABA@ABABAB@BAB@BABAB@BAD@BAB@DAB@BAD@B@@@DAB@F@B@B@B@DAB@D@D@DBB@B@D@FBD@FBDBHBD@DBFBFBDBFBFDHBDBDBDBDBDBD@DBBBD@BBD@BBD@D@BBD@@@D@B@B@BBB@D@D@D@D@F@D@F@D@H@L@J@B@JAH@D@L@D@F@D@FAD@D@D@FAF@H@BAB@@@B@B@B@B@B@FAHAF@DAD@DAFADADA@ADAB@BA@@@@B@FFDD@@@B@@A@AB@B@B@@@BB@B@@@B@B@BAD@B@B@B@@BB@B@DBF@FBFBBBD@DBDBD@DBB@DBB@@@BB@BBD@DDGDEFEJCFABC@E@E@E@M@CBCFCHCLCN@NCLCHCFGBCAEAECCKGICCAAA@CBAFAF@LALBJ@D@DC@CCCCCAABCBE@CECMCKCCC@EBCJCHIDCNIPGNELANAD@L@JBJDNHNJNHRFL@JCHEBE@ECEKIACEG@E@EDGHEHEHCN@JBHDJ@JAHAFEDC@EEIKKEIAI@CBEHGJGFCDAFE@@LGHCHAHBNBNBXBRBTDTDJAJCHGFIFSBOAIAEEGEEIEECCC@C@CBEFEJEHCFGDKFSBE@AAAMEECCASICACA@BA@@@@@AACACCAACCECAAA@AACAA@A@AAA@AAA@A@A@@AA@A@AAA@A@AAA@A@A@AA@@A@AA@@C@A@@@A@@AA@A@CAC@CAC@CAA@CAA@CAC@AAA@@@AAA@@AAA@@A@AAAAECAACAAA@@CA@@AA@AA@@@A@AAAAA@AACA@AA@AAA@AA@@CAA@A@@@@@ECGAECCA@ACAAACAAAA@GAIAACAEC@GCGAKEIACAEAKESIIEOGGCCACAKEOGECICGCIECACACAA@A@A@@@A@C@A@@@@AA@@@AAA@A@AA@AE@E@@@C@C@AAA@@BA@C@@@A@CAA@A@@AA@CAA@A@@@AA@@@@AAA@A@@@A@AA@@@AAA@@CAA@AA@@@A@@@@@A@@@@A@AAA@A@@@@@@A@@@ABA@@@A@AA@AA@@@@A@@@A@@@ACEUCMACAI@AAAB@@ADADCBCDC@AB@@AB@@A@@BABA@@BABABADCDADADA@AAACGCECE@@@C@AAA@A@@AACCEGGI@AA@CAGAGAIAMAK@W@I@C@MCI@AAA@AB@@A@@@A@AAC@AAC@A@A@A@A@A@GAC@C@E@A@C@C@C@C@A@@@AAG@@@AAE@A@AAC@AA@@C@A@CA@@C@A@@@A@CAG@IAECCAEEGAIAKCCAECGGKIIGEECCGEGGECCCAACG@A@@A@AAA@AAAAA@@A@@AAAACAACCAAACCA@CCCCCACA@AA@AAAAA@CACAA@@AA@@@@@CAA@@A@@@@@BAA@@AAA@AAAAAA@@AA@AEC@A@@A@@CCCAA@@@AAAEACC@@@@@AA@@@@ACAAAEEA@AAOMMICCKIKIAAAAIGIGWSGEAA@@CAAAIIA@@AA@AAIECAKEAACAGEGCECC@CCEACAIEGAIEA@AAA@IEICMEEAECGAEAIEICKCOECAGCEAAAA@GECCECA@@@AA@@GCEEAAAAECII"],encodeOffsets:[[126571,46068]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
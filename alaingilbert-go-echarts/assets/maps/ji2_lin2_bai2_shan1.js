@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("白山",{type:"FeatureCollection",features:[{type:"Feature",id:"220602",properties:{name:"浑江区",cp:[126.416093,41.945409],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@E@C@@BA@AHEDABABA@@@ADEBEDE@ABGBG@C@C@CAAAAAACAICEACAEACACCAA@A@C@E@CCCECEA@A@@@@@@@A@@CB@AA@A@AA@@A@@@@@A@CB@@A@CBCB@@A@A@AA@@@@A@@@@@@BA@A@C@@@C@A@A@@@A@A@@@@@@@AA@AA@@AA@@@@A@A@@AAAAAA@@@@BA@A@@AAAA@A@@AA@@@A@A@@A@@A@@AC@@@AB@@@@@@@@@AA@@AA@@@@@@@@A@@@@@@AA@@A@@@AB@@@@@@A@A@@ACA@A@@AA@@A@@@@@AA@AA@@@@A@AA@@@@@@AA@@A@A@ABA@AB@@A@@BA@A@A@C@A@CAA@A@ABA@A@A@AAA@@@A@ABA@AA@@A@A@A@A@AA@@AA@@A@@@A@A@@@@@AAA@@BAB@@@B@B@BBB@B@B@@@B@@BB@@@B@@@@AB@B@B@B@B@B@BCBABA@A@A@C@A@AA@@CBA@A@C@A@AAA@A@A@A@CB@@C@A@A@@@CAAA@@@A@@@@@AA@@@@A@A@A@AA@A@@@C@AAA@@AC@A@A@@@ABABA@C@@@A@A@A@A@@@ABA@AB@@ABA@A@A@A@A@A@A@A@@@A@A@A@C@@@ABA@ABB@@@@B@@@B@@@@@B@@@@@@@B@@@@B@@@@@@B@@@@@@BB@@@@@@@@B@@B@@@@@@@@@B@@@@@@@@BB@@@@@@BB@@@@@BB@@@@@@@@@BB@@@@B@@@B@BB@@B@@@B@@@@B@@@@@@@@@B@@B@@B@@@@@B@@@@@@@@@@A@@B@@A@@@A@AB@@@@A@@B@@@@@@A@@@@@A@@@@@AB@@@@@@A@AB@@AB@@@@@@A@@@@@@BA@@@@@@@@BA@@@@B@@@@@@@@A@@@@@@B@@@@@@@@@BA@@@@@@@@BA@@@@@@BA@@@@@@B@@@@@B@@AB@@@@@@@@@B@@A@@@@@@@@@@@@BA@@@@@@@@BA@@B@@@@@BA@@@@@@B@@A@@@@@AB@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@A@@B@@@@AB@@@@ABAB@@@BA@@B@@A@@B@@A@@@AB@@@@@@B@@B@@@@BB@BB@@B@@@@@B@B@@@@B@@B@@@@B@@@B@@BB@@@B@@BB@@@B@BB@@B@@@BB@@@@BB@@@@@BB@@@@@@@@B@@B@@@B@@@B@@BB@B@B@@@B@@@B@@@@@@BB@@@BB@@@@@@@B@@@@@@@BAB@@@B@@@@@B@@@B@@@@@B@BB@@B@@@B@@BB@@@@@B@@B@@@@B@@@@@@@B@BB@@@@B@@@@@@@@@B@@@@A@@@@@A@@BA@@@@@@@@B@@A@@B@@@@@@@B@@@@@@A@@@@@@@@B@@@BA@@B@@@B@@@BB@@B@@@BBB@@@B@@@B@@@BBB@@@@@B@@@BB@@B@@@@BB@@@BB@@@@B@@@BB@@B@@@B@@@@@BA@B@@@@@@@@BB@@@BB@@@BB@@BB@@@@@@B@@@@@B@@@@@B@@B@@B@@@@@@@@@B@@@@@@@B@@@B@@@B@@@@@@@B@@@@@@@B@@@@@@@BB@@@@B@@B@@@BB@@@@@@B@@@@B@@@@@@@B@@@@@B@B@@@@@@BB@@@@@@@B@@@@@@@B@@@BA@@B@@@B@@@B@@@@@@@@@BB@@@@@BB@@B@@@@BB@B@@@@@@@BB@@B@@@@@B@@B@@@@@@@@@@A@@@AB@@@@AB@@A@@B@@A@@BA@@BA@@@@B@@A@@B@@A@ABABC@A@@B@@C@C@@@@AA@@@@@A@@B@@A@@@@@A@@B@@@@@@@B@@@B@@@@@@AA@@A@@AA@@@AA@@A@@@AA@@A@A@@AA@@@A@@@A@@@@@AA@@@@@@A@A@A@A@A@A@A@A@@@A@@@A@@@A@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@A@@A@@A@@A@@A@@A@@A@@@@@@@A@A@@@ABA@A@@@A@@@A@@A@@A@@@@@AA@@A@A@A@@@A@@@@@A@@@@@@@@@AB@@@@@@@@A@@@@@@@@@A@@@@@@@A@@BA@@@A@@B@@A@@@A@@@@@@@C@AAA@@@A@@@AA@@@BC@@@A@@@A@@AA@@@A@@@A@@@@@A@@@@@@@AB@@@@A@@@@@A@@@A@@@A@@@AA@@A@AA@@A@@@@AA@@@@@@@@A@@@AA@@@@A@@@@@@A@@A@@A@@@A@@@@@A@@A@@A@@@AB@@A@@@AAAAA@@@@BBD@B@B@@AB@@@@@B@@@B@B@@@B@B@@A@@@@@@B@@A@ABA@ABA@A@A@A@@BA@@@@@AA@@A@@BA@@@ABA@A@@@A@@BBBBBBBBB@@@B@@ABAB@@@B@@AB@@A@AB@@@BA@@BA@CB@@AB@@@B@B@BB@BB@B@@@@@BAB@@@B@B@@AB@BABC@@BA@@@A@@A@@@@@A@@@AA@C@C@A@@@A@ABA@A@@@@BB@@B@@B@B@@BBD@@AB@B@BBB@B@BB@@@@BBB@@BB@BB@@DB@@BAB@BABABA@@@@BB@@B@@@BBB@@BBB@BBBB@@@BBB@BBB@B@@BBA@@B@@@@AB@@ABABA@ABA@ADAB@@@B@@@BD@@@BB@@@@@B@BB@D@F@@@@@BA@@BAB@B@B@@@BB@BBBBBB@BBB@@B@@@@AB@B@@@B@B@B@B@@@B@@A@@B@@@B@@@@@BA@@@BBB@BBB@@@BB@BB@@@B@F@BB@@@@A@@BCB@@@B@@@BBBBBBBB@BD@@@@@B@@@B@@A@@@@B@@@@AB@@AB@@A@@@AB@@@@@@A@@@@BBBB@@@B@B@@@B@BB@@@BBBBBBBBB@B@@BB@B@@@@@@@B@@@DA@@BA@@B@B@B@B@BBBBB@B@BBB@@@B@@A@@BA@@@AB@@@@AB@@A@ABCBA@CB@@A@A@AB@@@BB@BD@B@@@B@@A@@@@BBB@BAB@@ABC@ABC@@@A@ABAB@D@B@D@B@BAB@@@BA@ABA@A@@@@B@@@@A@@B@@@@A@@@@@@BA@@@@B@@A@@@@B@@@@@@@@@@AA@@@@@@@@@@@A@@A@@@@A@@@@@@A@@@@@@A@@A@@A@@@@AA@@A@ABA@A@ABA@CB@@A@@B@BA@@@@@@B@@@@@B@@AB@B@B@B@BA@@B@@@@ABABC@ABA@A@ABA@@@A@@@A@@@A@A@AA@@AA@@@@@@ABA@@@@@A@@@ABA@ABA@ABA@A@@@@@AB@@A@@@A@@@A@@A@@A@@@A@@@@@AA@@@@A@@@@@@AA@@@A@@@@@@@@@AA@@@@@@@A@@@@A@@@A@@AA@@@@@A@@@@@A@@@@@A@@A@@A@@@@@A@@@A@@@@BA@@@@@@B@B@@A@@@A@AB@@@@@@@B@@@@A@@B@@@@@@@@@B@@@@A@@@@BA@@@A@@BA@@@@@@@@@@B@@@@@B@@A@@@@@A@@@@@AB@@@@A@@@@@@@@@BB@@@@B@@@@@@@@B@@B@@B@@@@BB@@@@@@@B@@@@@B@@@@@@@B@@@@B@@@@@@BB@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@B@@@@@@BB@@@@@@@@@B@@B@@@@B@@@@BB@@@BB@@@@@@B@@@@@@@B@@@@@@@@@@@BB@@@@@@@BB@@@@@@@B@@@@@@@B@@@@@@BB@@@@@@@@BB@@@@@@@B@@@@@B@@@@@@B@@@BBB@B@@B@@B@@@@@@@B@@@@@B@@@@B@@B@@B@@@@@@B@@@@@B@@@B@@@B@@A@@@ABA@AD@B@@A@@BC@@@A@@@@@A@@@AB@@@@@@A@@@ADA@@@A@ABA@AB@@A@@@AA@@AA@@@@AA@@@@A@@@A@@@A@@@@@A@@@A@@@A@@BA@@@A@@@@@@@@@@@@B@@@@B@@B@@@@B@@@@B@@B@@@@@@B@@@@A@@@@@@@A@@B@@@@@@@B@@A@@@@B@@@@@BA@@@@@@@AB@@AB@@@@@@@B@@@@@@AB@@@@@B@@@@A@@@@B@@A@@@@@A@@@@AA@@@A@@@AA@@@@A@@@@@A@@@A@@@A@@AA@A@@@@@A@@@@@A@AA@@A@A@@@A@@@@@A@@AA@@@A@@@@@A@@@@@A@@@@@@@A@@@@@@BAB@D@BA@@@ABAB@@ABADABAB@@AB@@@B@B@@A@@@@BA@@@@@@@A@@B@@@@@@AB@@@@A@@@AB@@A@@BA@@@@@AB@@@@@@@@A@@@@@@@@B@@@@@B@@A@@@@@@@AB@@A@@@AB@@@@A@@BA@@@@@A@@@@B@@@@A@@@@B@@@@@B@@@@@@A@@@@@@@@@A@@@@@@@A@@@AB@@A@@@@B@@A@@@A@@BA@@@@B@@A@@@@@@@@@@@AB@@@@@@@B@@@@@@@@@@A@@@A@@@A@@@@@@@A@@@@@AB@@@@A@@@@@@@A@@@@@A@@@A@@@@@AB@@@@A@@@@B@@@@@@@@@B@@@@@@@B@@@@@@AB@@@@@@@BA@@@@B@@@@A@@@@B@@@@A@@@A@@@A@@@A@@@A@@@@@AB@@@@A@@@@@@@A@@@@BA@@@@@A@@B@@@@@@A@@B@@@@@@A@@@@@A@@BA@@@@@@@A@@@@@@B@@@@@@@B@@@@@@@@AB@@@@@@A@@B@@@@@@@@@@@@@B@@@@B@@B@@B@@@@@BB@@@@BB@@@@B@@B@@@@@@@B@@B@@@@@B@@@BB@@@@B@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@B@@B@@B@@@@@@B@@@@B@@B@@@@@@@BB@@@@@@B@@@@B@@@@@B@@@@@B@@@B@@@@@B@@A@@BB@@@@@@B@@@@@@@B@@@B@@A@@B@@A@@@@@@B@@@B@@@@@@@B@@@@@B@@@@BB@@@BB@@B@@@@@BB@@B@@@@@@@B@@B@@@@@@@@@B@@@@@@B@@@@@BB@@B@@@BB@@@@@@@@B@@B@@@@@@@B@@B@@B@@@@B@@@@@@@B@@@B@@B@@B@@@@@B@@@@@B@@@@B@@B@@@@@B@@@BB@@@@B@@@@@B@B@@@B@@@@@@@B@@@@@BB@@B@@@@@@@@@B@@AB@@@@@@@B@@AB@@@B@@@B@@AB@B@@AB@B@@@@@@A@@@@B@@@@@B@@@B@@@B@@AB@@@@@B@@@@@B@@@B@@@B@@@B@@@B@B@DA@@DB@@B@@@BBB@@@@@B@@@BB@@@@@@@@@B@@@@BB@@@@@B@@@@@BB@@@@@@B@@B@@@@@B@@@@@B@@@@BB@@@@@@@B@@BB@@@B@B@@B@@B@B@@@B@@@B@@@B@@@@@B@@@BB@@@@B@@@BB@@BBB@@@@B@@@@BB@@@@@BBD@BDBD@BBB@@@BB@@@@@B@@@@@@BB@@@@@B@@BB@@@@@@@BB@@@@@B@@B@@B@@@@@B@@BB@@@B@@@B@@@B@@B@@@AB@@@@@@@BA@@@@BA@@@@BA@@@@B@@@@@B@@@@@@@B@@@@@BB@@@@B@@@@@B@@@B@@B@@@@B@@@@B@@B@@@@@@B@@B@@@@@@@@@@B@F@D@@AB@@@@@@@@BB@@@@@@@@BB@B@@@B@@@B@@BB@@@BB@@@B@BB@@@@BBBB@BB@B@B@@B@@B@@B@@BBB@@@@@B@@@B@B@BBDBB@@B@@B@@BBB@@DBBBDB@@B@BB@@B@B@BBB@B@@@@@@B@@@BBB@@BB@@@B@@D@BAB@DA@@B@@@B@@@B@BB@@@@B@@BBBBB@@B@@@@@B@BAB@B@@@BA@@B@@BB@BBB@@@B@@@B@@@@AB@B@BBB@B@@@B@B@B@B@@@BB@@@BB@@B@@BB@B@@BD@@B@@@BBB@B@B@B@@BBAB@@BD@B@BBB@BBBBFD@@@@@@DDB@BBB@B@DBB@B@BBB@@@B@@@DBBB@BB@DB@@B@@B@@@@@BB@@@BB@@D@B@@@B@B@@@B@@@B@@@B@@@B@@@B@@AB@BAB@BBB@B@B@@@B@B@@BB@B@B@@@@@BB@@BA@@B@B@B@D@@@B@@@BA@@@@B@@@B@@@B@@@@AB@@@BAB@B@@A@@B@@@@BDB@@@@BB@@B@BBB@@@B@B@D@D@B@@@B@BA@@B@@@B@@@B@BB@@@@B@@@B@B@@@B@@@BBB@@@BBB@@B@@@@B@B@B@@@B@@@B@B@@@@@B@@AB@@@BB@@B@B@B@B@B@@@@@@@@@@B@@@BDB@B@@BB@@BBB@B@@BB@@@B@@@@B@@B@@@@@@@B@B@@AB@B@BAB@@@@@B@@@@@@@@@@A@@@@@@B@@@@B@@B@@@@@@@@@@@@@B@B@BA@@@@@@B@@BB@@BB@@@B@@@BB@@B@@BB@B@BAB@@@@@@A@@@AB@@@BA@@BA@@@AB@B@DAB@B@BA@@@@@@B@@@@@@@@A@@@@BA@@@@@A@@@A@@@@DA@@B@B@@@B@B@DA@@B@B@@@BABAB@@AB@@@@@B@@@BA@@B@B@BA@@@ABAB@B@B@@@BA@C@C@@@@BABABA@@@@B@@AD@B@BA@AB@BABAB@BA@@BA@@@A@@@@@AB@BCBCD@BA@@BC@@B@@A@@@CA@AA@AB@B@BAB@BA@C@@AAA@@@AA@AAAAAA@A@AA@@AA@ABA@@@@AA@A@@@A@@@@@AA@@A@@AA@@@@AAAC@@@@@@@A@@@@@@@@AA@@@@A@@@@A@@@A@ABC@AB@BAB@@A@AAA@A@@@@@@@A@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@BCBA@ABAD@@@@@BA@@B@@@AE@@AAAA@AA@A@A@A@@@AA@@@@@AB@AA@@CCACAC@@A@@@A@@@@@@B@@@@@B@@AB@@A@@@AB@@AB@@A@@BC@@B@@A@AB@@@AAE@@@@AA@@B@@AB@@A@E@CAC@A@@AAAC@AAA@ACAAA@@A@C@CBA@@@@@@@AA@@@A@@@@@@@@@A@@@@@AA@BC@A@AEAA@AAA@A@AACCB@D@D@@AB@BE@@@@B@B@@@BA@A@A@@@AC@@A@@@@@@BC@@@@AA@A@@@AA@@A@@@A@A@A@C@CAA@AA@A@@@@AAA@A@@@@DBB@B@@ABAB@B@DAF@D@B@D@B@B@B@DAHAD@FAH@@A@ABADAB@DAB@BBB@BBD@B@F@B@B@BBDBDB@D@@B@B@D@B@B@B@@@B@DBB@DAD@D@BA@@@A@CAA@C@ABABAB@B@@AAC@C@@FCDADAD@BABEAA@ABA@ABABCBABCDE@E@ABADABCBC@AAABA@AACCE@A@@@CAAAC@C@A@@@CBA@A@A@@@@@ABAA@@AA@AA@AAC@@@@AAECCAA@@@@CAEAEAC@@AACAAA@A@@AAAAAA@@AA@@@@@A@@AA@A@@@AAAAA@A@A@@BAFADCBABA@AB@DA@A@@@ADC@@@ABC@@BAB@@ABA@@BA@A@C@@@ABAD@BABA@A@@B@@C@@BC@@BA@A@AAA@A@@@ABC@@@A@@AIAA@AA@@CBC@ACC@ABA@@@A@C@@AA@@@AB@BCB@@@BA@@BAD@BA@A@@BA@AB@BAB@B@@@BABBB@@@B@DAB@B@F@B@DAB@BABAB@@AB@@@B@B@@@DA@@BAB@@ABA@A@C@A@EA@CCAABA@ABAB@DADAFAFCBAB@BA@A@AB@@ABADA@A@@BABA@AB@@@B@B@FAB@BADAB@B@B@B@BA@@BA@AB@B@BAB@B@B@BAD@BA@@BBB@B@B@B@B@BAB@@@DADABABA@@BA@@D@FCJCBAD@@A@@@@@@AAC@A@AA@@CAA@AAE@CAE@A@A@A@@AA@CACECCAAC@EAC@C@E@C@CBABCBEFCBCFADABABEBABCBA@C@C@C@CAC@AACCCAAAAAAA@A@AACAE@A@C@CBA@@@AD@@@@A@@BA@AAAAKAA@CAEAAGCC@CAACAAAA@AAE@AAE@@@AA@@@@@A@@@GD@@AB@@AAEAA@EAOCCCCCACAE@GBEBI@@BA@@@C"],encodeOffsets:[[129605,42597]]}},{type:"Feature",id:"220605",properties:{name:"江源区",cp:[126.591178,42.056747],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AAAA@@A@AAA@@@AA@@AA@A@@A@A@AAA@AAAAAAAA@A@C@A@@@A@A@@@A@AA@@AA@@AAA@@AAAACA@AA@AAAA@@AC@A@@@A@@@A@@A@@@@AA@@A@AAA@A@@@A@A@A@A@A@AAA@A@@@A@ABA@C@A@A@@AA@AA@AA@CAAAAAC@AAC@AAA@AAA@A@@AAA@@@A@@@AA@@@@AAAA@AAA@@AA@@A@@@A@A@A@AAA@@A@CAC@AAC@A@C@@@A@@@@AABA@@AA@@@A@A@A@A@A@AB@BA@ABA@A@A@ABA@C@A@A@CBA@A@C@@@A@AB@@@@BD@BBBB@@BB@AB@B@@@@@B@BAB@D@@@B@DBDBB@D@@@BDFBD@BABBB@BADADCBAB@B@FCFADABADAB@BAB@BBBAFABC@CBCBED@@@DBD@BA@A@ABAB@B@DBB@D@B@@ABC@C@CBA@CAA@@@A@A@A@C@A@A@@@@CCACAAAA@A@E@A@C@AAA@AAA@CBA@CBAB@B@BG@EBC@GBCBA@A@A@C@A@C@E@CBA@A@AB@BA@A@CA@@@@@BBB@B@@B@B@@BBB@D@D@B@B@B@@@BB@@B@@@BBB@@@@AD@@@@@@@BD@@B@@@B@BAB@@A@A@@@@@AFA@@BC@C@A@DDBBB@B@BBB@FB@B@BADB@@B@@@@@B@@@@@@@@@B@@BB@@@@@@B@DAD@B@@@BBDB@BBB@BBDBB@@@BBD@D@F@BA@@BA@@@BB@@@@BF@B@@BAB@@@@AD@@AB@@@BA@@BA@@B@@@BA@@@A@@@@@A@@@@B@@@B@@@BDBDDD@@BBA@@B@@@@BB@@B@B@B@B@@BBBBB@@BF@@A@@@AB@@@@C@AB@BABADB@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@B@@@@@@@BBB@B@BA@ABA@@BAD@B@B@@@B@@B@@@@@BB@@@@@@@@@B@@@@@@BDBB@@@@BB@@@BB@@B@@@@@B@@@BBB@@@@AB@BBB@@BBB@B@BBBB@BBB@@B@BB@@@DABA@ABA@A@@BBBB@@D@@@BA@@@AD@@ABC@ADADA@@B@@@@@B@@AB@@ABA@ABABA@@BABA@C@@BA@@@@@ABABAB@@@@@D@DAB@@A@A@A@AB@B@@ABA@A@@@AB@@A@@@@@A@@BA@ABAB@@A@A@@@CBA@A@@@A@A@@@CB@@@@@B@@@B@@@@AB@@@@@B@@@@@@A@@@@@@@ABA@A@CBA@A@@B@@AB@@AB@@A@@B@@@B@@@@A@ABA@A@@AA@@@AA@@A@@@A@@AA@@AA@@@@@@@ABA@A@@@@@@@@@@@@@A@@@@A@@A@@@@@@@@B@@@@@@@@A@@@@@A@ABA@A@@BA@A@@@@@@@A@@@@A@@A@@@A@@AA@A@AA@@AA@@@ACA@A@@@A@@@@@@@@A@A@A@A@A@@@AA@@A@@BA@@@@@A@A@@@A@@@A@A@A@@@@@@AA@AA@@A@AA@@A@@@A@A@@@A@@@@@AAA@@@A@@@A@@@ABA@@@A@C@C@A@A@@@A@AAA@@@AA@@@@CA@A@@A@@@@BA@A@AB@@A@@B@@A@@@A@@@A@@@@@AB@@A@@@@B@@@B@@BB@B@B@B@B@B@B@BBB@@@B@@BB@@B@@BBB@@@@@B@@A@@BA@@@AB@@@@@@@BB@@@@BB@@B@@@@A@@@AB@@@@@@A@A@A@A@@@A@A@@B@B@B@B@B@B@B@BAB@B@BA@@B@@DBB@@BB@B@BAB@@@@BB@DBBB@BBB@B@B@BB@@@@@D@B@@@@B@@B@@@@@B@@@B@@@B@@@BBB@BBB@B@@BB@B@@@B@@@BBB@@@B@@B@@@B@@BB@BB@@BB@BBBB@BBBBB@@B@D@B@@@B@@@B@@@B@B@@@BB@@DB@B@@@@@@B@B@@@BB@@B@@@BB@@B@@@@@B@B@B@@@B@@@@@@@B@@@B@@@@@@@B@@@@B@@@B@B@@AB@B@B@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@B@BB@BBBBBB@BB@B@B@D@@@@@BBA@@BAB@B@@AB@B@@B@@BB@B@B@BBB@D@@@@BB@@BB@@BBBBBBB@B@@B@A@@B@B@@@@@B@@@B@@BB@@BB@BB@@B@@@B@B@@@BA@BB@B@B@B@B@D@@@@@BD@D@B@B@D@@BD@FBB@B@BB@@BB@B@@@B@@@B@@AB@BBBBBBBDDB@BBF@FB@@B@BB@@BBBB@@D@B@BB@@B@BADADABBB@B@DCB@D@BA@@B@BA@BBAB@@A@@BAB@FCDCBAB@B@FADBF@BBB@B@BABB@@BBDBBB@BADCB@B@B@@BBB@BDDD@@D@D@B@B@BBBBFBDBB@DBB@B@DABBBDB@@@BD@@@DBF@@BB@BB@@B@D@DAF@DABBBBD@@D@@BB@B@B@@@B@B@D@B@B@B@B@B@B@B@B@B@B@BAB@@@@AB@@@BA@@B@@@B@@@@@@@BA@ABA@@BA@A@A@@BAAA@@@AAA@A@A@A@A@A@AAC@AAAB@@@B@BAD@@AD@BAB@B@BAB@DAB@DAB@DA@@D@DADAB@B@B@@@B@BABB@@B@BA@@@@@@@A@A@@@ABA@@BA@ABABA@@BABA@@B@B@B@B@B@BA@@BAB@@@B@BB@@B@B@@AB@@@@AB@@@B@B@@@B@@@B@@@@@BA@A@@B@@AB@@AB@@AB@@ABA@@BA@@BAB@B@BAB@@AB@@CB@BCB@@@@A@@@B@@@@B@@@BBB@D@B@B@@@B@B@BBB@@@BB@@B@@BBB@@BBBB@@B@@A@@B@@AB@B@@BB@@@B@BA@@@@@A@A@@BABA@@@A@@@@@A@@@@@A@@@@@@@A@@@A@@BA@@@@BA@@AA@A@@@A@@@AA@@@@@@A@@@@BA@@BA@@B@@@BA@@@A@@BAB@B@B@@AB@@A@C@A@CACAC@A@@AA@A@A@A@A@@BABA@AB@@@@A@@AA@@@@@ABA@@@AB@B@@@D@@@B@BBB@B@B@B@@@B@DAB@B@B@B@B@B@B@B@D@B@B@BAD@DABAB@B@B@BBD@B@BBB@@@BB@BB@BBB@B@B@B@B@@@B@@@@@B@@@@@B@@@B@BB@@@@B@B@@@B@@@B@B@B@@@B@@@B@B@BA@@B@B@@@B@B@B@@@B@B@@@B@@BBB@@@BB@B@B@B@@AB@@AB@B@B@@@B@B@BAD@BADA@@BA@@B@BBB@FBBBD@DBDBDB@@B@@@B@@A@@@ABA@A@@B@@@B@B@@@B@BAB@BAB@BA@@BAB@BAB@B@D@B@@@B@BAB@@ABABA@A@@B@B@B@B@B@D@@@@@@@AB@@@B@BBB@BBDBB@BBBBBBBB@@B@@B@B@DAFAB@B@B@B@@@B@BBBB@@B@@@B@B@B@B@B@B@@BBBB@@B@@@B@@@B@B@@@BBBBB@@BB@@@B@@AB@@AB@@B@@BBBB@@@D@B@D@@@B@@BB@@@B@B@B@B@@@B@@@B@@@B@B@BAB@B@@@B@@@BBB@B@@@B@B@@@B@B@@@B@BAB@BA@@BABABAB@BA@@BA@@BAB@@ABAD@@AB@@A@@B@@A@A@@BA@@BABABA@ABA@ABABA@AB@@AB@@AB@@A@@@AB@@@B@BAD@B@B@@@B@FA@@BAB@B@@AB@@AB@BAB@B@DADAB@@@@A@@@A@A@@@AB@BAB@B@B@BBB@DBB@B@B@DADAD@@AB@@A@@BA@@BAB@@@B@BBBBB@B@BB@@BAB@D@BAB@B@@@B@@@BBDBB@@@B@@B@BBB@@BBBBB@@@@B@B@@BB@@BBB@@@@B@BABB@@BBBBBB@@BB@@D@@AD@@@BBB@@BBB@BBBBBBB@@BAB@@@BB@@BB@@@@BB@@B@@@@@B@@AB@@@@@@A@@BA@AB@@AB@B@B@@@BA@@B@@BBB@BBB@B@BBBBDB@BB@DBBBB@@B@@@B@@ABA@@BABABA@@BC@@BAB@@@BAB@B@B@@@@@@@@@B@@B@B@@@B@@@@@B@BBB@@BB@B@@@BAB@BA@@B@@@BABAD@BABAD@BAB@B@B@B@D@B@B@B@@AB@BAB@@AB@B@BABCBABCBC@A@AB@@@DAB@@ABABAB@@AB@@@@@@@BB@B@@B@B@@BB@BBB@B@@@B@@@B@BABA@ABAB@@AB@@@D@B@B@@@DAB@B@B@D@B@B@@@B@BBB@B@B@@@B@BA@@B@BAB@BA@@BAB@@AB@B@B@D@B@BAH@B@D@D@B@B@B@BB@@@@B@@AAA@@BCBA@CBAB@@@B@@@BBB@@@BA@ABA@@@AB@@@BAB@@A@@@@B@@A@@D@D@B@B@B@B@H@B@D@B@FBB@B@B@FBDB@@B@BB@@@@B@B@@@BAD@DABAB@B@D@BBD@B@B@BB@A@B@AB@@@@@@@B@@@B@@@@@B@@@@@BB@@@@@B@@@@@@@B@@@@@@B@@@B@@@@@@@@A@@@@@A@@@A@@@A@A@@@A@@@@A@@A@@@@AAAA@@@@AA@@AA@@@A@@@A@@@AB@@AB@@A@A@A@A@A@@AA@@AA@C@AA@BA@@@AB@@A@@@A@@@AAA@A@@@A@@BA@@@AA@@A@A@@@ABA@ABC@@@AB@AC@C@@@ADABA@@@@@A@A@A@AAA@@@A@@BC@G@A@A@@BABA@@CACACACA@@ACAA@A@C@A@@BABA@@B@B@BBB@BBDBD@BBDBBB@@BB@@BBBDBB@BB@@BDFBB@BBB@@BB@@B@@@B@@@B@D@B@B@B@B@@@B@BAB@DAB@D@BBB@BA@@@@@@@B@B@BBBBB@BB@@@@@B@@@DABABAB@BADCHEBA@@@@@A@@@C@@@CA@@@AAAAAAACAAACAAAAA@AA@@C@@@C@@@A@AAA@@AA@@AA@@A@AAA@@A@C@A@A@@AC@AAAAA@AAAA@@@AA@@@BA@@BA@A@@B@@@@@@AB@@@@A@@B@@A@@@@BA@@@@@@B@@AB@B@BAAAAA@@@AA@@A@A@@@AA@@@@A@A@@@AABA@@@AAA@AAE@CAA@@@A@C@A@A@CAA@C@A@ABC@@@CBAAA@A@AB@@A@A@@@A@A@AAC@A@@@@A@@B@BA@AB@@A@@AA@@A@BA@@@A@ABAAA@AAAA@AA@AAA@@A@ABAAA@@AA@AA@@AAA@ABA@A@C@@AA@A@@@AA@@@@@@AA@@@@@AAA@AA@AA@@AA@A@@@ACCA@@@A@C@C@A@@@ABA@@@CB@@AB@@A@@AA@A@A@C@C@A@C@AA@@AA@@@@@BA@AB@@ABA@@@A@@@@AA@A@@AA@@A@@A@@@@AAA@A@@@AA@AAAAA@@AAAA@A@ABC@ABABABA@@BA@ABAAA@A@A@ABC@A@AB@@@AA@@@A@AB@@A@@AA@@@@@A@@@A@ABA@A@AB@@A@ABA@@@A@@@A@AB@@A@A@@BA@A@@@A@A@A@A@@BA@A@@BA@@@A@A@@A@BA@@@@B@B@@BB@@DB@@@BC@ABA@A@@@A@ABA@@@@AA@A@@@A@@@A@A@@@A@@BA@A@A@ABA@A@@AA@AAA@A@AAA@A@AA@@A@AAA@A@A@AB@@A@@@@BA@@B@@@B@@@@@B@B@@@B@BA@AB@@ABA@@@A@ABA@@@A@@BA@C@@@A@@@A@@@@@@@AA@@@@A@@@AB@BA@@AA@@@@@AA@@A@@@A@@A@@A@@AA@A@A@A@@@@A@A@AAA@A@@@A@@@A@A@A@AA@@A@AA@AA@@@AAA@AA@A@@@@@A@AAA@AA@@@@@A@A@@AAAA@@A@A@AAA@@AA@A@@AA@AA@@A@CAA@AA@@A@@A@A@A@A@@@A@@@A@AB@@AA@@AAA@A@@B@@A@@@@@@@@@A@@BA@@@@@@@@BA@@@@@@B@@A@@@@B@BA@BB@@@B@B@B@BABA@@@@@@@@BA@@@@@A@@@@@@@@@@BA@@@A@A@@@A@@AA@@A@A@AAC@AA@@AA@ACAA@@AAAAAAAAAAA@@C@@@AA@@@AA@@A@@AAA@A@@@A@C@@@A@A@AAAA@@A@AAA@A@A@A@@AA@@@AB@BADCFAB@DAB@@ABA@A@A@A@A@A@A@A@AAAA@BCBADADAB@BC@A@AAC@C@C@C@ABC@ABA@ABA@ABA@A@A@ABA@ABC@A@AB@@A@@BAB@@@B@@@B@BA@@@A@@@A@@@A@A@A@A@ABA@AB@@A@AAA@C@A@A@A@A@AA@@AAABA@AB@@AB@@@BAB@B@@AB@BA@A@@@A@@@A@AAA@CA@@C@@@A@AA@@A@@@@@BBB@@B@@AB@@ABAB@BAB@@@BA@@@@@@B@B@@ABA@A@C@A@A@C@A@@B@@@@@B@@@BB@@BB@@B@@A@@BABA@@B@@@BA@@BA@@@ABB@B@BBD@BB@@BB@@AB@@@BAB@B@@ABA@@@A@@@A@A@AAA@@@A@ABC@A@A@A@@@A@@A@@@A@A@@@@@@A@AA@@AA@@AA@@A@ABA@@@@BA@@B@@AB@@ABA@@B@@A@@B@@ABA@A@@BA@A@A@AAA@@@A@@@A@A@@@A@A@@@A@@@A@A@AA@@@@A@AAAACAA@AA@@A@A@A@A@@@AAA@AAA@@AA@A@@@A@A@@@A@@@AAA@AA@@@AA@B@AA@@@A@@@A@@@AA@C@A@A@@AA@@AAA@@A@@AA@ABA@A@A@A@ABAAA@@@A@@@@@@@A@@@@AA@@@@A@A@AB@@CAAA@@AA@@@@A@@@AA@@A@@@A@@@AA@@AA@@C@ABAA@A@A@A@@@A@@@@B@A@@AA@@AA@A@A@@@@@AA@A@@AA@@@AA@A@@@A@C@A@@@@AAA@@@@@@@A@AA@@AA@@A@A@@@@AA@@@A@A@@@A@B@@@BABA@@BA@ABA@@BA@AB@@AB@B@BA@@@A@@@A@A@A@A@AAAAA@@AA@@@AB@@ABA@@BA@@@A@@@@AA@AA@@@AAA@AAAAA@ACA@AA@@AA@@@@@@@AAAA@AA@@@AAA@@@A@A@@AA@AAA@AAA@@@A@@A@@@AA@@AA@@@@A@@@ABC@A@A@@@@AA@AAAAA@AAA@A@@@@@@AB@BA@ABA@@@AAA@@@@@A@@@ABA@A@A@A@@@AAAA@@AAA@@@AA@CAA@AAA@AA@@@AAA@@@AA@@AA@AA@A@@A@@A@A"],encodeOffsets:[[129736,42882]]}},{type:"Feature",id:"220621",properties:{name:"抚松县",cp:[127.449763,42.221207],childNum:1},geometry:{type:"Polygon",coordinates:["@@FCFABABABABA\\SkMSGDIXSp[HEAAACNCB@@A@@@AACAGBAACB@@ABAB@B@@AAAAA@A@A@CAA@AAA@ABCB@BA@@BAHAAC@A@A@A@@@A@ABAB@DC@ABA@C@C@CAEA@@AA@@BA@AB@BA@CBA@A@C@C@A@AAAAA@G@A@AB@@AA@A@AA@AAA@@A@@@A@A@@BADA@@BE@A@ABA@CB@BAB@BABA@ABABABA@A@@@A@AB@@A@@@AA@@AACCC@@@@BA@ABAFEB@BCDEDE@@@CDCFC@@AA@@@@CA@@AA@@AAAAC@CACAC@AAAA@@AC@A@A@@@EBC@CAA@AACAAAAA@CAAACAAA@@CCCCAA@AAA@@@AAAAC@AAAA@@AA@A@A@A@EBA@@@ABA@A@A@A@AACBC@A@ABA@AAAAAAAAEAAAA@CAC@A@@ACAC@C@AAA@EA@@C@EAC@CACAA@AAACA@@AAC@BC@A@A@CAA@CAEAA@@AA@@A@A@@AAAA@ACC@AAACECCEECAEACAE@CA@@@@@@@@BABABABAFC@@BA@@AA@CACA@AAA@@AA@AA@AAC@AAA@AAAAAAAAA@A@AAA@@AAAA@@A@A@A@AAA@ACAA@A@AA@A@A@A@A@A@C@@@E@GBABA@A@A@@AAAAAEC@AA@@AA@AEAECCAACC@@AA@@A@ABA@ABAB@B@@A@@@C@ABEBCD@@A@AAA@C@A@CB@BAD@@ABA@C@E@C@I@A@E@A@A@@@@AACACACA@AA@C@A@AA@@@@@A@AACAAACACCEEAA@AA@AAA@CAECECABA@ABEDABABEFCBCDAB@BAB@@@DBB@BBDB@@BADAD@B@@A@A@E@C@C@AA@@C@A@CBE@ABA@A@AAA@A@A@A@C@A@CBCBCBGBGDEBCBCBA@ABADCDCD@BA@@@AACAC@A@@AE@C@ABC@CBCBA@A@C@A@A@CBGBEDEBIDGDEBABA@ABC@I@E@CBE@CBGBEBCBABABCDCFEFCHCBCDEDA@EBCBC@C@CAC@C@A@CBCBADA@A@A@A@AACAAAECEAAAA@A@A@A@ABA@A@A@ABA@@BAB@@CBA@ABABABAD@B@B@BA@A@C@CBA@A@CBGBCBEDCBA@@B@@BBB@BB@@BBBB@DBB@BAB@BA@@B@@A@@B@@@@@B@@@@@@@@@BA@@@@@@B@@@@B@A@@@@@AB@@@@@@BB@B@@@@@B@@@@@@@@@B@@@@@DBB@B@@@BA@@@AB@@A@A@A@A@@@AA@@A@@@@@@@@B@@@@@@BB@@@@B@@@@BB@@@BBB@@BB@B@@@@@@@B@D@B@@@B@@BA@@@AB@BAB@@AB@@A@ABA@AB@@@@A@@@@@AA@@@@@@@@@@@@@@AB@@@@A@@@@@@@BB@@BB@@@@@@@B@@@@@@ABA@@BA@@@A@@@A@@@@@@AA@@@@@@@AA@@@@@A@@A@@A@@A@A@@BA@@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@BA@@@A@A@@@A@A@A@@@A@AB@@A@@@BB@@@@B@@@B@B@BBB@@BB@@BA@@B@@E@A@@B@@@@@BA@@BC@C@E@C@C@E@AACBA@C@A@A@EAA@A@AA@@A@A@C@ABA@C@C@EBA@ABC@A@A@@@@@@@A@@@@@A@@@A@@@@@A@@@A@@@@@@@A@@@@@@@@@A@@@@B@@@@A@@@A@@@@BA@@@@@A@@B@@A@@@@@@@A@@@@@@@@@@@A@@@@@@A@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@BA@@@@@@@A@@B@@@@A@@@@@@@@@@@A@@@@@A@@@A@@@@A@@@@A@@@A@@@A@@@@@A@@@AA@@@@@@A@@@@BA@@@A@@@@@@@@@@@A@@@@@A@@@@@@B@BCDABCDABABCBEDEBEBEBCBCBGBEBCBAB@BBB@BBDBD@B@@@BC@ABCBAB@B@B@B@B@@@@BBBBBBB@@BB@@BA@@BBB@D@@BBB@B@B@BB@@@@BB@B@BBBB@BB@BBB@B@B@BBB@B@B@BABAB@BC@AB@@ABAB@@CDADAB@BAB@D@B@BAF@B@B@@@BB@DBDBBB@@ABB@@BBD@BAB@D@DAD@D@B@B@@BB@@B@@BBBBD@@@BAB@@AD@B@@@DBB@BB@BBBB@B@@@B@BAFABAB@BABA@A@AB@B@@@DAB@@@BAB@@AB@@@@@B@B@@@B@@AD@DAD@BAB@BA@@DABAB@BABABABABAB@BAB@BABCBAB@BEBABADA@A@C@CBC@C@A@C@C@C@EBC@GAE@EB@@E@C@E@C@A@A@C@A@CBC@EBE@C@IBCBE@EBIBC@C@CBCBC@C@C@EAC@EBC@C@E@CBC@A@C@CBEBC@E@GBCBGBCBCBCBCBA@ABA@@ACAE@A@E@A@CBAB@@@BAB@B@D@B@BA@@@C@E@E@GBG@C@C@ABC@A@C@C@A@A@@@A@@BA@A@C@A@ABC@A@A@@@@@A@A@AAA@C@G@I@@A@D@@@D@@@B@@@@ABGFCDABA@ABABCB@@A@@@@@A@@AAAAA@A@A@A@@@@@@ABA@AAC@A@CBA@ABA@@@A@A@A@A@C@A@@@A@@@A@@@AA@@AA@AAACE@AA@@AAAACAA@@AA@@AACAAAC@CAAAA@AAA@A@@@ABAB@@@B@D@BBBBD@@DBDBDBDB@@ABAB@@@B@B@HAD@@@B@@BB@B@B@B@B@@@@ABCB@B@@@DBDA@@B@@AD@BAB@B@@@B@BB@@B@@AB@@@B@@@BBB@B@@@B@@@BA@@B@@ABB@@B@DBB@@BB@@@B@B@B@B@BA@@BA@@B@@@B@@@B@@BB@@BB@@@@BBBB@@@@@BB@@@@@@B@@@B@B@@@B@@@B@@@@@B@@@@@@A@@@A@@@@@@@@A@@@@@@@A@@@@AA@@@@A@@@@@A@@@A@@@@@@@A@@B@A@BAAA@A@C@AAC@A@A@ABCBC@AB@@A@A@@@@@AAA@@@CAEAA@A@A@EAA@C@A@G@A@A@A@A@C@C@@@@BA@@@@@@BA@AB@@A@@B@@AB@BAB@@A@AA@@A@@@A@AB@DABAD@@BB@BA@@@@@AAA@A@A@C@C@A@G@ABA@C@A@A@A@@BA@AB@@ABA@ABA@@@ABA@@@BB@BBB@@@@AB@BBB@@BBBB@BBBBB@@BBB@BB@BBBB@DBB@D@BAB@B@B@@@@@@BA@AB@B@B@B@@@D@BB@@B@@@B@@BB@B@@B@B@B@B@@BB@BB@BB@BBB@B@BB@@@B@@BB@@@@B@B@B@BAB@B@@@B@B@D@BBD@B@DB@@DB@@B@BAB@B@BBF@DBB@DBBBFBF@F@BB@AB@DABAB@F@D@BBB@B@@@BBD@DBD@BBB@@BB@@@B@@@B@D@B@@@B@@A@ABABA@@D@B@B@B@BA@@B@@@B@B@D@B@D@B@BBB@BBBBB@B@B@B@B@B@D@B@@@@@BB@BBB@@@@@B@@BB@B@B@BB@BBB@B@DBB@@@@@BB@B@B@DBB@BBBBB@@B@@B@B@@@DBB@BBD@B@BBB@B@@@BAB@@AB@BCBA@@BABA@CDABA@AB@B@B@@@B@@@BB@BB@@D@B@BBB@B@B@BBB@B@B@B@BB@@B@@BB@B@@@D@BBB@@@B@DBFBDBB@DBBBB@BBB@@BFDDD@@DBB@BB@BB@@@@B@@@@AB@@A@@B@@A@@@A@A@A@@BA@@@@B@@@B@BB@@B@@@B@@B@@@@BB@@BB@BB@BBB@@BB@BBB@@B@BB@B@@BB@@@B@@@@@B@@A@@BA@@@AAA@@@AAA@A@A@A@A@@@A@@@A@@BAB@B@@@BA@@BB@@B@@B@@B@@B@B@B@B@DAB@D@B@BAB@B@B@@@BBB@@@B@@B@@B@@B@@AD@B@B@@AB@@@BA@ABA@ABCBA@CBA@@@ABA@@BA@@BA@@BABABABAD@@@@@@@B@@BB@BBB@BBBBB@BBB@BB@BDB@DBBBBBDBBBB@@B@BB@@B@B@B@BA@@B@BAB@@CD@BABABABABABAB@B@@@BCAABCDAB@BB@@@@@B@BBA@@@@BA@@B@@@@@B@@A@@B@@@B@@@BA@@B@@A@CB@@CBCB@BC@@BA@CB@BCBABCB@@@@@BABABABABA@A@ABA@@@A@A@@@AAAAA@AAAA@@@A@CA@@A@@BA@A@ABA@A@@BA@@AA@AA@AAAAA@A@AAA@A@A@A@A@A@A@@@A@AAA@AAEAAAA@@@AAA@@AAAA@@AA@@AA@AAAACAA@AAAAAAA@A@A@A@A@ABCB@@AB@@ABADA@ABA@ABA@A@A@CAA@CAGCEAE@AA@@A@@BA@AB@BABCBABAB@@AD@@@BA@A@CBC@A@A@AAC@AAA@@AA@@A@@@A@@@A@ADCBCBADCBADA@A@ABAAA@AAACACAC@CAE@CAEBA@A@@BCBABEBABABA@C@AAAACAACEGAAGCCAA@C@I@G@C@CBA@@B@DBB@BBBDD@DBB@BA@@BC@A@C@CBIBE@CBGDABABA@CBG@GBGBE@CBAD@B@FBBBDDBBBBB@D@B@@@BAB@BA@ABA@@BA@A@AAA@A@A@CBA@ABA@@B@@@B@B@@BBBBBBB@BBDBB@@BB@BBBB@@BB@B@@AB@@@BABABC@ABA@C@A@C@CAA@A@A@A@C@A@@@AB@B@@BBBBBB@@BDDDFD@BBDBDDDBD@D@B@DBB@B@DBB@BBB@BB@BB@@BBB@B@B@DB@@BBB@@BBBBBBBBBBBB@BB@BB@@B@BBB@B@B@BA@@@AB@@C@ABABA@@BAB@BAD@@@B@BBB@BBBBBBBBB@@BB@@BBBBBBDBBBBB@@@BA@ABADABABA@@B@B@B@@@B@BAB@@A@@BC@ABCBABC@ABAB@@A@@B@B@BAB@B@B@@@B@@A@CBEBA@CBA@AB@B@@A@@B@@BB@@BB@@BBB@DB@@B@B@BBBBBBBBBBBB@@B@@BD@B@D@@@DB@@@@BB@@@B@B@B@B@@BBBD@@BB@DB@@BBBBBBBBB@@@BB@B@B@B@@@B@@@B@@B@@@@@@@B@@@@@BA@ABABAD@BAD@@AB@B@B@@BB@@BBBBD@BBDBBBBBBBBBBB@@@B@B@B@@@BAB@@@B@@@B@BBBB@D@B@B@D@B@B@B@B@@BBB@@@B@@ABA@@B@@@B@B@D@D@DBF@D@D@B@D@B@D@D@B@D@B@BBD@DBB@DBF@B@DBB@B@@BBBBBBDBFBDBB@DBD@B@DBD@BBD@BBBBBBBB@BBB@@@BABC@A@A@C@CBA@C@@@CB@@@BAB@@BB@@BBB@B@B@BB@@B@@B@B@@AB@@BB@@BBB@B@D@B@B@D@D@D@B@B@BB@@BBBBBBBB@BBB@DBB@BBBDDBBBBBB@BDD@BDBBBBBBBDBD@B@BBD@D@F@D@D@B@BADAFADABABABA@ABABABAB@B@B@B@B@BBBBBBF@DBF@B@FBF@D@F@D@D@B@@BB@DBBBDDDBBB@@@AB@BA@A@A@@@A@A@AAAAAAAC@CCCAACAAACACAAAEAC@C@A@A@ABA@@BAB@BAB@B@B@BBD@DBD@BBB@DBB@D@BAB@D@BABADADADAB@BAB@B@BBB@B@BBB@BBBBBBBBB@DBB@D@BBB@@@B@BBDBB@BBB@@BBBDBBBD@D@D@B@D@BBB@BB@@BD@B@D@B@B@BAB@@@B@B@BBBBBBBB@DBB@BBB@B@D@F@D@DAD@BBD@D@DBD@BBBBB@BBDDDBB@BBB@B@B@B@@ABA@@@C@@@E@A@ABC@ABABABCBA@ABCDEBCDABCDA@CB@@A@A@ABA@ABAB@FAH@B@DAB@@@BABA@@@ABA@AAA@AAA@AAC@A@A@A@ABAB@BAB@D@D@D@F@FAF@BAFADADABADADAD@B@D@D@BBBAB@B@BABABAB@F@F@B@BA@@@AAA@@AC@@BAB@B@B@D@FBDBD@DBD@FBD@B@DBD@D@FAB@DABABABABAD@BAF@BABADABADAD@BAB@BBBBDBBBBBB@B@BBB@@@@BBD@BAF@DABBD@B@BB@@BB@@@@BBBAB@@@BA@ABADABA@@BABAB@@@B@B@B@@BBBBB@B@@BB@B@B@BAD@F@F@FBB@F@DBFBB@FBB@BBB@@B@B@@@BAB@BB@BBBB@@@B@BA@@BA@ABA@A@A@CAA@AAA@A@@BA@A@@B@B@B@B@D@HAF@B@DBDBDBBD@BBD@D@FAB@B@B@B@B@BB@B@@BBBDB@@B@@B@@@BA@@BC@AB@BA@@B@DBHDDBFBPDB@BBDBD@B@HAB@BBJFHFFJDFBB@@B@B@D@BAD@DBBBFJBFBD@BBBDBD@DAF@DAB@BBB@B@DDFFBBDDDH@H@H@@@DBDDFFDFFHFDBJHDDBBHDHBF@F@B@D@JABBB@B@DDFDDH@DBFABABABE@GBCBAD@BBBBDFBHBBBBD@B@B@@AFA@CD@DDBBBDBDFBDBBBBB@B@B@BAB@DA@AB@@CDAB@B@D@BBBDBDFHBBDBBBD@BBF@D@DBBBDBBD@DB@BBB@DAH@F@D@B@FBBBBDBB@DABABADABAB@D@B@B@BBBBBB@DADABBHDHFBBBBBB@DA@ABC@C@CAA@AACAA@C@A@ABABBB@BBDDDDJBD@BBDBBDBBBF@H@F@F@H@FADCHADADAD@B@@@DAF@H@L@F@F@F@J@HAD@B@HAD@F@D@FAHGDA@C@E@C@ADADABCHCD@FAH@D@FCLCBA@@BA@A@AACCCAAAA@AAA@A@A@ABA@GBCAC@A@A@ABADA@AB@D@BBB@B@D@B@FADAB@BABCBABABAD@B@D@D@DBB@B@BABA@A@AACCAAA@E@A@ABCB@BCDAB@B@BA@@@A@AAA@@AAA@A@AAA@CBA@CBABCDA@ABA@C@ECA@AAA@A@A@@@@A@@@A@CBABABA@ABAB@B@@AB@D@BAB@BABAB@BABABC@ABA@A@AFGDCDG@AB@AA@@@ABABADCB@BAB@@@BAB@B@B@B@DBB@B@DBB@@@D@BADA@@BAB@BABA@ABCBA@C@@AAA@C@A@CBEDEDA@A@C@AA@AAA@A@C@C@EBABADADAB@DAFAFADAD@D@B@B@B@DBB@DBBBD@BBD@B@D@DAD@DABADABA@ABAHCFCDAD@F@F@B@BAD@BABA@@BA@@D@FAD@DAB@DABC@AA@@A@AA@@C@@@A@A@A@@@@@AB@@@AA@@@@A@@@A@C@A@A@A@AAA@@@A@@A@@@@A@@@@A@@@A@C@@BA@AB@@@@@@A@@AA@@@@BA@AB@@@B@@@BAB@@A@@@@@A@@AA@@@A@A@A@@AA@A@@@A@AB@@@BAB@BBB@D@D@D@B@B@BB@@@@B@B@@@@A@@@A@@AAAA@@AA@@A@@@@AA@@C@AA@@A@@@@@A@@@AB@@@B@@@B@@@B@@@B@BB@@B@D@@@B@@@B@@@@@B@@@D@B@@A@@@A@@@AA@@@AA@A@A@A@@@@@AB@BA@@@@B@BB@@@@@@@@BB@@B@@BB@@@B@@@BA@@@AB@@A@@B@DABAB@@A@@A@A@A@A@A@EA@A@A@@BADCBAB@BAB@@ABCBAA@@ACAA@@A@@@@@AB@B@@@B@@ABA@AB@DAD@HAD@DA@@BABABAD@@ABA@@BABA@A@AAAAAACAA@@@ABA@AB@D@@BDBBBD@@BB@DADABAB@@A@@@A@@A@CA@AA@@AA@AA@@@@BA@A@A@A@AAA@C@AAAAE@A@A@AB@DAB@BBB@B@B@@@BA@AAA@AAACAAA@@AGB@@AD@BAB@D@B@@@D@B@B@@A@A@AAA@A@C@A@O@@@CA@@CB@@A@C@A@A@ABA@@@@@A@ACCAC@@@AFCDE@A@C@ADADEDC@@BA@@@@@A@@AAAAAACGAEACCCEE@@CCCCGCEGCCAA@AACAEAE@CAC@A@ABABCBCAC@AA@@AA@AAACACAC@CAA@AA@AA@@@@@@@@A@@@C@E@C@AAEACA@@@@AA@@@ACAEECAEAICEAKEEAGAACCA@A@AAAAECG@AACCKAACA@AAAECAAA@A@C@E@O@A@GBA@A@A@A@CAEAA@C@C@E@A@G@A@@@@AAGAABEBC@ABC@E@ACACAA@AA@@A@@@@@AA@A@@AAEECC@E@@ACAC@@@A@@@@IAC@E@IAA@A@E@C@A@MAE@A@A@E@A@CDCBA@A@A@@@A@AA@A@AAAA@@@A@AB@@C@CAAACAC@AAC@AAABA@C@A@A@A@A@@A@@BA@@AA@AAACCCCACACACAC@A@CBCDI@AAA@AB@B@DALIDCFEHEDAB@B@B@LBFB@BD@F@DBH@D@@ABADEHIB@@@BBJD@ADA@CBC@AB@B@BADABA@@@AB@@AAA@@@@BABCBA@ABC@AAA@A@C@CBABABEDC@A@@D@@AB@@@BA@@B@@@@@@@B@@@@@B@@@@@B@B@@@B@B@B@B@BA@@B@@@B@@@@@BB@@B@@BB@B@@@BBB@@BB@@@@@@@B@@@@@B@@AA@@A@@B@@@@A@@@A@@@@@A@@B@@A@@@A@@@AB@@@@A@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@BA@@@@@@@A@@@A@@@A@@@A@@@@@@@AAC@CAA@A@@DCBA@@@A@A@A@@AC@@@ADC@CBC@A@AB@FE@@BC@A@ABCDABCBCDAB@BADAB@DADADADAFABABABADCBCBABABAB@D@H@D@@@@@B@@A@A@@BA@A@@BAB@DABAB@@A@@@A@@@AAACCAA@@@C@C@@@C@A@AAACEAA@A@A@@BA@ADCD@FEDAFCHE@ADABAD@HCBAB@@CBCBCBCBC@AB@BAB@@ABA@AB@BABCBCDGBAFEDA@@FGFEDEFGDGDEBCBE@@@C@A@@@A@@BC@AB@BC@ABCBABABABC@CBA@E@ABCDCBCAA@A@A@A@CBCDEBCDC"],encodeOffsets:[[131115,43037]]}},{type:"Feature",id:"220622",properties:{name:"靖宇县",cp:[126.813583,42.388896],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AB@@AB@@ABA@ABABAB@@ABA@ABA@@@A@A@@@A@A@@@A@A@AA@@A@@@A@A@ABA@A@@@A@@@A@@@A@A@A@A@@@A@@AA@@@C@A@C@@@A@AA@AA@@@BA@@BA@@@A@@AA@@AAAA@A@@@A@A@@@A@@@AA@AA@AA@A@A@A@A@A@@@A@@@AAAAA@@@A@A@A@A@EBCBA@A@@@@AA@AAAAAA@AAAAC@AAA@A@A@@BA@@@@@@C@A@A@A@A@A@@@@BABAB@BA@ABA@@@A@C@A@A@ABA@AB@@ABA@ABA@ABA@@@A@A@@@A@@@@BAB@B@@@BA@@@A@@@CACACAC@AAEAA@AAA@@@AB@@CBABC@ABA@A@@@A@A@A@@BA@@BA@A@A@A@@A@@AA@AA@@@A@A@@@A@A@A@@@A@A@@@ABA@A@@@A@@@A@A@A@@@A@@@A@A@@@@@AAA@@@A@@@@@A@@@@@A@@@A@A@A@A@A@AAA@@AAA@@A@AAA@C@AAA@A@A@ABCBC@ABA@A@C@A@A@A@A@A@A@A@A@CBA@@@A@A@A@A@AAA@@@C@@@A@A@@B@@AB@B@@@@BB@@@B@@A@@BABAB@@@B@B@B@BBB@@@BBDBD@D@B@D@BA@@BA@A@A@AB@@@B@@AB@@A@@@AB@@AB@@@@@B@@@@B@@B@@@B@@@BBB@@AB@@@@AB@@@B@@@B@@@@@@@B@@@@@B@@@@@B@@ABAB@@@B@B@@@@ABA@@@A@@AA@A@@BA@@@@BA@@@AAAA@@AA@AA@@@AA@@A@AAA@A@@@A@A@C@A@AA@@A@@@@@@A@@@B@@A@ADA@@DA@@BA@ABA@A@AB@@AB@@AB@BA@@BA@@BA@@BA@@@@BAB@@@@A@@@A@@@A@A@@@A@@B@@A@@BA@A@@@AAA@@@A@AB@@ABA@A@A@A@A@@@ABAB@@ABAB@BAB@@AB@B@@@B@B@@@@@@ABA@@@AAABA@@@A@A@A@CBCBC@@@CBA@CBA@CBA@ABA@A@ABC@@BC@ABA@@@A@BB@BBD@B@B@B@B@B@BBB@B@@BBAB@@@B@BAB@@AB@BAB@@@@@@A@@@A@@@AB@@A@@B@@A@ABA@A@A@A@A@A@A@A@A@A@C@A@A@@@A@A@A@@AC@@BA@ADA@ABADCB@@ABA@A@A@A@A@A@CBABEBEBCBC@A@CBA@CBA@CB@@@BABAB@BA@@BA@@@A@A@CBA@A@@@C@@@A@@BAD@BA@A@A@ABC@AB@B@BBB@BBB@@AB@@A@ABA@@@@@@B@B@@@BB@DBBD@B@@@@@BA@@B@BB@@B@@AB@BA@@B@BA@@DA@@B@B@B@B@B@B@@@B@B@@@B@B@BB@@B@B@B@B@@@B@B@BD@BFDB@BDBB@@B@B@BAHA@@BAB@B@BABAB@D@BBD@D@BB@@BBBDBB@BBBBBB@B@B@BBB@B@BBB@@@@@B@@@BB@@@@B@@@@@B@B@@@@BD@@@@@B@@@B@@B@@B@@@B@BCDA@@@A@A@@@@@@@B@@BB@@B@@@B@@@B@@@DA@AD@BABEBABABAB@BA@ABAB@B@BA@@BCBAB@@A@ABA@@B@B@B@B@B@B@BABAB@F@@@B@BBB@B@BBB@@@B@@@@@@@B@@@BB@@B@@BB@B@@@B@@@B@@@@AB@@AB@@CBCB@@AB@@@B@@@@BBAB@B@D@@@B@@@@@@@@AB@@@@ABA@BB@@BA@BB@@@@@@@@BB@@@@B@@@@B@@@BB@@B@@@@BBB@@BB@@@@@@B@BBBBDDBDBBB@D@B@B@BBBB@B@@@@@B@@@@@BDBBB@@@@@@BB@@B@@@@@B@@@@@B@BBB@@@BBB@B@FD@@@B@@@BB@@@@B@@@@B@@BB@BBBBBBBBDB@@D@@BB@@@@@@@@@BB@@B@B@@@@AB@@@B@@BBB@@BB@@@B@@@B@@DBBB@@@@B@BB@B@@@@B@@B@@@@B@@@@@BB@@@B@B@BBDBBDB@B@BB@B@@@B@BABAB@B@L@F@JBD@F@B@DAFCFAB@@@B@@BB@@BD@B@B@BBBBBBDB@@BB@BBBB@B@BB@@B@AB@BAB@B@@@@ABB@@BB@@BB@BB@@@BB@B@@BB@@B@B@@A@BB@@@B@@@@AB@BABA@AB@BBB@BBB@@@BBB@@@D@D@@@@@@@@@@@@B@@B@@BB@@@D@@@B@@@@@@@BB@B@@@B@@BB@@BB@@BABAB@B@B@@@@DB@@AB@@@BA@AB@@AB@@@B@B@@@BAB@BBB@B@@@@B@@BB@@@BBBBB@A@ABAB@@AB@@@B@@BB@BDBBB@@@@@BA@@@AB@@@@@B@BA@@BABA@@D@@C@@B@@@B@@@B@B@@AB@@@B@@@@@B@@@B@@BB@BB@@B@D@F@@CD@BAB@B@B@B@BBB@@@B@@ABAB@@@BAB@@@B@@ABAB@BA@@BC@ABAB@@@@BBBBBB@BDDBBBB@@B@D@B@B@D@B@BAB@B@@BD@BBBBB@@B@B@B@BBBBBBBB@BBB@@@@@B@@@B@B@B@B@BABAB@D@B@B@B@BB@BBB@@B@B@@@BA@@B@BA@@@@B@@@BBBBB@@@BBB@@BB@@@@B@B@@B@@@B@BAB@B@@AB@@AB@@B@BADDBB@BB@@AB@B@D@@@BB@@@D@B@B@B@B@@@B@D@BAB@F@BBB@BAB@B@@BB@@@BB@@B@@@DBB@@BB@BD@BB@B@@B@@AB@@BDBB@DB@@F@B@D@B@@AB@@A@BBDB@BB@B@BBBBBBBBBDB@@B@@@B@B@@B@BBBBFDBBBDDD@D@@@B@@@@B@@@@@B@B@B@@BBB@D@BBBAB@@A@@BA@@B@B@BA@@BBBBBBBBBDBB@BBD@BB@@@@@@BB@@@BB@@@@B@@@@@@B@B@@@B@@@@@BABABABA@@@@B@BA@@B@@@B@DB@@B@@@B@B@@@BBB@@@B@BBD@@B@@B@@@B@@@@@@@BB@@@@@@@B@@@@BB@BB@@B@@@B@@@@@@@B@@@@@@@@B@@BB@@@@@@B@@BB@@@B@@B@@@B@B@@B@@B@@@@@@B@@BB@B@B@B@B@B@B@@@@@BB@@BBDB@@BB@@BB@@@B@@B@@@@B@@@B@@@B@@BBB@@@BB@@@DBBB@@B@D@B@B@B@@@B@B@@@B@@@B@@@@@BB@@BB@@BB@@@B@@@@BB@@@@@@@B@B@@@@@@@B@@AB@@@B@@@B@@@@@B@@@B@@@@@BAB@B@DB@@@@B@@@B@@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@@AB@D@@A@@BA@@@AB@@@BAB@@@B@@@B@@@@@BA@@@AB@@@B@@@B@B@B@@@BB@@@@@@B@@@B@@@BB@@B@@BB@BB@BB@@B@@@B@@@BB@@@B@B@@@@@@BBB@@@@B@@@@@@@@@B@@@@AB@@@@B@@@BBB@@@B@@B@@@B@@@B@@B@@B@@@B@@@B@B@B@@@@@@@B@@@@BB@@@@@B@@AB@@AB@B@@@@@BB@@@BBB@B@B@B@@@@@@@@B@@@B@@@B@@B@@@@B@@B@@B@B@@BB@@@B@B@@@@@@@B@@@@B@B@B@DADBDA@@@@BBB@@@B@BBB@@BDBB@B@B@B@D@D@@@BBDB@@BB@@B@B@DB@@B@@@B@B@B@BA@@B@@@DAB@B@B@D@B@@@B@@@BB@@@@@@B@@@B@@@B@@@B@BAB@@A@AB@B@@@B@BA@@B@@@@BB@B@@BB@@@BBB@B@B@@@@@B@@@B@@BB@B@@@B@B@@@B@B@B@@@B@@B@@@BB@@@@@B@@@B@@B@@@@@@@@@B@BAD@B@BA@@B@B@B@B@@@B@@@D@B@D@@@@BB@@B@@BB@@@BB@B@BB@@@B@B@BBB@B@BB@@@@B@@@BA@@B@BA@@BA@AB@@@@@@@@@B@@@@@@@B@@@B@@AB@B@@@B@@@@@@@BB@@@@@@B@@@B@B@@@B@@@BB@@@@B@@@@@B@@@B@BAB@B@B@B@@@B@@@B@@@@@B@@@B@B@B@B@B@@@@@B@B@B@@@@@B@B@@@B@@@BB@@@@BB@B@@@B@@BB@B@@@@B@@B@@BA@@D@D@DAD@D@@@B@B@@A@@B@B@BA@@@@B@@@B@@@B@B@@@B@@@@@B@@@@@@@BA@@B@@AB@@@@@B@@@BA@@@@B@@@BAB@@AB@@@@@BB@@@@B@@@B@@A@@B@@@BB@BD@B@B@BA@A@AB@@@BABAB@BABA@@B@@ABA@ABA@A@@B@@@@@B@@@B@B@@@@@BA@@BABA@@@@@@B@@@B@B@B@B@B@B@@@DC@@B@@@B@BB@@BBBB@@BB@@BB@B@BD@B@@@BBB@BA@BB@@BBD@BB@BB@@B@@@BB@B@@@BB@BBD@BBD@B@@@B@@AB@DAB@@@B@@@B@B@BABBD@BB@@B@@@@BB@@@BB@@@@B@@@B@@@B@B@B@B@BBBB@@BB@BBBBBB@BBB@@@B@BA@ABAB@BADA@AB@B@B@B@@@BABCB@BAB@B@BADADABAB@BA@@@@BB@@BBBBB@D@BBD@D@D@B@B@@@B@BBD@@@B@B@B@D@D@DBB@@@B@B@@@@@DBDBB@B@@@B@@C@@BABCB@BA@@BBB@@@D@DBB@D@B@BBBBB@B@B@@@DA@@BA@AA@@A@@B@@@D@BBB@@BB@B@BBA@@@AB@@@@BB@@@@@B@B@BBBBB@@@@B@D@B@BBB@@@@@B@@@@BB@@@@@@@@B@@B@@B@@@@@B@@@B@BB@@BB@@@@BB@@@B@BB@@B@@@@@B@@B@@B@@BB@@@@B@@BB@@@@B@@B@@@BAB@@BB@@@BB@@@@B@@@@AB@@@@@BB@@BDD@@BB@B@@@B@@D@@BB@BB@@BBBB@BB@BBB@@@B@B@@AB@BABABA@ABABC@A@AACAAAA@A@AAAAACC@@AA@AAA@@@A@EAE@A@A@CBE@CAABCBABCBEBABABABABA@ABA@CBC@C@E@E@AAA@G@AAC@A@A@A@A@A@ABABADCBABADCBADA@A@A@A@C@ABABADCDABAD@FABADA@ABA@CBC@ABABCBABA@A@C@CBABABAB@B@DAF@DABAD@DCDABABCBA@A@E@C@IACAMAA@A@AACAE@CCEACECCCCE@@A@@@CEACCCAAAAAACACA@AAA@AAA@AACAC@AAA@AAAACAA@A@A@C@C@A@@AACACCAACAA@@AA@C@C@E@C@E@EAA@E@CAE@AAAAAAA@A@A@A@A@ABABAB@BABABABCBEBCBABA@C@C@E@C@C@AAA@C@CAAAAAAACA@ACC@AAAAAAACCAA@AAA@CAA@AAAAAAAAA@@AAA@A@C@C@C@A@A@C@A@A@AA@@AA@@BA@@@A@AA@@@AAA@A@A@AA@@AA@@BA@A@@DA@@D@B@DAD@B@B@D@BA@A@@AA@AAAAAAAAAC@AAC@CAA@C@CAA@CAEACAAAAAAA@@@AAA@C@AAE@CAA@CAC@A@A@C@A@C@C@A@C@A@C@CAE@C@C@C@A@A@@@AB@BA@@@A@@AA@AA@A@A@A@C@A@A@C@A@AA@A@A@@@A@@BA@A@@@A@A@A@@AAAAAAAAAACAAAC@AAAA@@AA@@@A@ABA@@BC@ABCBABAB@@A@@@@@A@@@@@@@AA@@@A@@@A@A@A@A@@A@@AAAAAAAA@AA@@CAA@@ACAA@@@A@A@A@A@@AA@@@@CA@@C@A@C@@AA@@@AAAAAAAAAAAAA@A@@@CAA@AA@@AA@@AA@@@AB@@@@ABAB@DAB@FADAB@@@@A@@@A@ABA@A@A@AB@@@BABAD@BADABAD@@AB@@@BA@A@A@@@A@A@AB@BABABCBAB@@A@@AAAACAAAAAAA@@AA@@AAAAAAAA@AAA@A@A@@BC@ABA@AB@BABAD@@@BA@@B@@A@A@AAA@A@AA@@AAAA@AAAAAAAAAA@AA@AA@@CAA@A@A@AA@@AAA@@AAA@AAA@C@AAA@C@A@CACCCACAC@AECCCAC@@AAAAAA@@@ABA@@B@D@B@B@B@B@DBD@B@D@B@BAD@BABA@A@@BA@@@AAA@@AAAAA@@AA@CAAAA@AAAAAA@@@A@A@@@AB@BAB@DAB@B@B@BBB@B@@AB@BAB@@ABA@A@@@A@CAAAACAACAA@E@ABCDAF@HAHAH@DAB@BABAHCDAF@JADAD@B@D@@AB@@AAA@CCCAA@AAA@C@AB@DAD@H@J@D@B@DBHDBBFHBDDBBBBBD@B@BABAFABADA@AB@B@FADBF@DBD@DBDBBB@BBBAB@B@BCBABCDABADCD@B@B@@@B@@@BB@@BB@BBD@BBB@B@D@DAB@B@@A@@BC@@BABADABA@ABAB@@AB@@@BBF@FBHDDBB@DBB@B@B@BAB@BAB@BCBA@@BA@@DABAB@B@B@B@B@BBBBBBB@DBBBBBB@@BB@@BB@BB@BB@BB@@B@BBFBBBB@BBB@@@B@B@B@B@B@B@B@BBB@B@BBBBB@@BBB@@AB@@@BAB@B@BAB@@@BB@@D@B@@BBBBB@BBBB@@B@B@@@B@BAB@B@BABABABA@A@@@@DABADA@ADAB@@AD@@ADADA@@DAB@@@@AB@@A@@@A@@@AB@@@@A@@@@@AB@@A@@B@AAA@@@@@A@@ABADCBADB@A@@@ABABABABABABA@ADC@@BA@A@AB@@A@A@A@AA@@A@AA@AACAAAAACAA@ACA@@AAA@AAAAA@AAA@AAA@@@A@@@@@@BCBABABA@AB@@AB@@AB@BA@@B@DAB@DABAB@BAB@@A@@BA@@@A@ABC@@@AA@@@@AA@@@A@AA@@A@A@A@ABA@C@A@CBA@A@A@A@@@@AA@@@@AA@@AB@@A@@@ABA@AB@@@B@@@B@B@B@B@B@BB@@B@BB@@B@@AB@@@@A@@@@@A@@AA@@@AAAA@@@AA@AAA@@AA@AAAA@@AA@@A@@A@@@@A@@@AA@@A@A@@@A@@B@@AB@B@B@@@B@@@@AB@@@BA@@@@@A@@A@@AAAA@CA@@CCEC@AA@AAA@AACAA@CAEACAA@@@A@AAC@@@A@A@@AA@@@AAA@A@A@A@AAA@A@A@AAA@C@@@AAA@@A@@@A@@@A@ABAB@BADCB@BA@AB@DA@ABA@@BA@A@@@AAA@A@AAC@AAA@C@@@A@AA@@@AAAA@AAA@C@A@AAA@@@@A@CAA@A@AAA@@A@A@AAA@@@A@@@@AA@AAA@@@@A@C@A@A@A@A@A@A@AAAAA@AAA@C@A@C@A@A@@@A@@@ABA@A@A@C@@@ABAB@B@BA@@@A@C@A@@@A@@@A@@AA@AAC@CAC@AA@@A@A@AAC@E@A@ABCBA@@BAAE@E@EAAACAA@CAE@AAA@A@ABA@@@CA@@CAA@C@AAC@A@A@@@A@A@ABA@A@A@@@@@AA@@@A@@AAA@A@AAA@@AAAA@@AA@A@A@A@@@@AAA@@@A@@@AA@@A@C@@@A@A@ABAB@@A@@@@A@A@A@ABC@A@CAA@AA@AAAA@AA@@AAAA@AAAAA@@AA@ABA@@@@AA@AAAA@A@A@AAA@@@A@A@C@A@A@A@CB@@A@A@C@@@A@@BA@AB@BABABA@@@A@@@A@A@AAA@@AA@A@@@@AAA@@@@@@A@@BA@ABAB@BA@CB@@A@@B@BADADABADABA@A@@BA@ABA@@BA@A@A@C@A@A@A@A@ABC@ABABC@ABAB@@A@@@ABA@AB@@A@A@@AA@AAA@@@@@A@@@A@A@@@@A@@@@@@@@@A@ABA@A@@BA@AD@@AB@BABA@AB@BA@@@A@@@AA@AACAA@@ACAAAAAA@A@AAA@AA@@@AB@@A@@@A@ABA@@BAB@@AB@@@@@@@BA@@@A@@@@@AA@@A@@A@@AA@@A@@BA@AA@AAAAAAA@AA@@AA@A@@BC@@@CA@@AA@AAAA@AA@BA@A@A@@A@AA@@AA@@@A@A@@A@AAAA@@AA@A@AA@@@A@CAAA@@A@@@A@A@ABC@A@AB@@AAA@A@AAAAA@@@A@AB@@AB@@@BA@@BC@CBCBA@A@A@CAA@AAA@A@A@ABA@@B@@@B@B@@@B@@A@CBCBA@A@ABA@@BA@@BA@A@AB@@EBA@@@A@A@C@ABA@@@A@@B@@@BA@@BA@@BA@@BABAB@BAB@BABABAB@@AB@@@B@BA@@@@BA@@BC@AB@BA@"],encodeOffsets:[[129957,43153]]}},{type:"Feature",id:"220623",properties:{name:"长白朝鲜族自治县",cp:[128.200789,41.420018],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AAA@@@@@AA@@@@A@@A@@@@@@@@@@@A@@@@@@@A@@@@@@AAA@@AA@ECA@A@A@AAC@ABA@EBCBA@ABGFCDAB@@@@A@@@AB@@G@A@@@@@A@@@@@@@@@@@@AA@@@@@AAA@@AA@CAA@ICA@A@A@A@A@A@@@AB@@A@@@@B@@@B@@BJ@B@@@B@@@B@@A@@BA@A@A@C@C@@@@@A@@AA@CA@@@@A@@@@AAA@@AA@@@A@AAA@A@C@@@A@@@A@@@AB@@ADC@@@@BA@@AA@@AA@@GCA@CAC@C@M@CBA@A@C@@BIBC@C@ABC@A@@@A@@@A@@@AAC@AA@@A@@AA@@AA@@AA@A@A@C@A@A@CBCBABEF@BA@@B@@@D@B@B@D@@@B@B@B@B@@@BA@@@@@ABAB@@A@@@A@CBA@A@EBG@@@@@A@@@C@@@A@@@CA@@AA@@@@E@AAA@A@@@A@A@E@CBG@C@A@@@A@@@A@@AA@@@@@AA@@@@@A@@@@@@@A@@BA@@@A@C@AB@@A@@AG@A@@AA@@A@A@C@A@C@A@A@CBMDA@A@@@ABC@CDA@A@@@EB@@A@@@@@ABA@A@ABCB@@CBA@CBCBA@CBCBABC@@@CB@@A@CBABEBCBE@@B@@A@@@A@AAA@C@@@@@A@@A@@A@@@@@@AA@A@A@CA@@A@@@CCAA@@A@C@E@K@A@E@ABCBC@CBABAB@BA@@B@B@@BB@@B@BBD@LDHBD@D@H@DBB@B@BBBB@@B@BDB@@BB@@@BB@@@@BB@@BB@B@@@@@B@@@@@B@@@@@B@BADAD@BA@@B@@ABA@@@ABA@@@@@A@EB@@A@E@@@A@A@A@@@EAC@@@A@@@@@A@@@@AAA@@@@@@A@@AGEGGAACAAAA@@@A@A@A@A@@BWN@@@@@B@@@@@B@@B@JH@@BB@@@BBD@B@@AB@@EDABA@C@@BBBBBB@DB@BC@C@@BAB@BA@@BABAB@B@B@@@@@B@@@B@B@@B@B@@@@@B@@AB@@@@@@@@@@@BB@D@@@B@@B@B@BBBA@@B@@@@@@A@@@AB@@A@@B@@BB@BD@@@BBB@@B@B@@@B@B@BA@@@@@@AA@@@@BA@@B@@@BAD@B@@@@@B@@@B@BBB@B@@B@@@@AB@@@BABAB@@@B@@A@@B@@@@ABB@@BB@BB@@B@B@B@B@BAB@@@B@B@B@B@@@@@DBBB@@B@@@B@@@@B@@@B@@A@@BA@A@A@A@@BA@@B@@A@@@@B@@BBBB@@@B@@B@B@BB@@B@B@@BBBB@@B@@@B@B@B@@BBB@@BB@DBB@@BB@BBDBB@BBDBD@@@BB@@@@BF@B@@B@@@@@@B@@B@BBD@@BB@@BB@BBBB@@@@@B@BBB@@@@@BB@@BB@DB@@BBB@@@B@B@B@BAB@@ABAB@BAB@B@B@@BB@@@B@B@@@B@BAB@B@@@B@B@BBB@BB@@BBBBBD@BB@BBD@B@DBB@F@B@B@@@B@@@B@@BB@B@BB@@@@B@@@@B@B@BB@@B@@@BB@B@B@@@B@@@BB@@B@@AB@@@B@@@B@@BB@@BB@@@@BB@@BB@@BB@@@@B@@@B@@AB@B@@BB@@@BB@BBB@B@D@@@B@@@@@BD@@B@@BB@B@B@BAB@D@B@B@B@B@@@@B@@B@@B@@BB@@BBB@B@@@B@@@DBB@@BB@@B@@@@@BB@B@B@@AB@@AB@@AB@B@B@@@B@BA@@B@@@BBB@@@B@@@B@@@B@B@B@B@B@BBBBD@@@B@@@BBB@B@@@@B@@@B@@AB@@@BB@@@@@B@@AB@@@@@@@BAB@BAB@@A@A@A@@@@@AB@@@BBB@BA@@B@B@B@@@@B@@@BB@B@D@B@B@BBB@BBB@@@B@@@B@BA@@BA@@@@B@B@BBB@@@B@@B@@A@@@ABA@A@AB@AA@A@ABA@AB@@@BA@B@@@@BD@DBB@@@B@B@@@B@B@@BB@@B@@BB@@B@@@F@@@B@@@B@D@D@B@BBB@@BB@@B@@@BABABCDA@A@@@@B@@@@@B@BB@BBB@B@@@B@BA@@@ABA@@BAB@B@B@B@D@BBB@@@B@@BB@BBBBB@@B@@B@B@B@@AB@@@BA@@B@@AB@@@B@BAB@B@B@B@@@BBBBBB@B@@@BB@@@@BB@@B@@B@@@B@@BDBB@@BBBB@B@B@@@B@B@@BB@BBBBB@@@BB@@B@BA@@B@BA@@@A@@@A@A@A@@@@@@B@@@@@@@B@B@@@B@B@BBB@B@@@B@@@F@@@@A@@B@@@@@B@@@@@B@@@@@@@B@@B@@B@@@B@@@@BB@@@@B@@@@@@BB@@@@B@@B@@@@@BB@@B@@@@B@BBB@B@@@@@B@@B@@@@@@@BB@@@@@B@@@@@@@B@@@@@B@@A@@BA@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@A@@B@@AB@@@@A@@B@@AB@@@@@B@@@@@@AB@@BB@@@@@@@B@@@@B@@B@@BB@@@@@BA@@@@B@B@D@B@@AB@B@@A@@@@B@@@BB@@B@@@B@@@@AB@@@@@@A@@@@B@B@BA@@BAB@@@@@B@@@@@@A@@@@@@B@@@@B@@@@@@BB@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@@BB@@@@B@@@@@B@@B@@A@@@@@B@@@@@@@B@@@B@B@B@@ADB@@BA@@@BB@@@@BDBDBD@@@B@@@B@@@BB@@DBB@@@B@B@@BB@@@B@BB@@BB@@DB@BBB@@BB@BBBBB@BB@BB@@BBD@D@DABAB@D@BBB@D@BBDBBBB@B@FBB@B@@@@AB@BA@@B@BAD@B@D@D@B@D@D@F@B@B@B@B@@@D@B@B@B@B@@AB@B@B@DAD@B@@@BBB@B@FBB@@@B@@@BBB@@@@@B@@@@@B@BA@BB@@@B@@AD@B@BAB@@@B@B@@@B@@@B@B@@@D@BAB@B@@@BBJ@B@B@@@BA@@B@B@@@B@B@@@B@@@B@BBB@@@B@@@B@B@D@DAF@DAD@@@B@B@@B@@B@@BB@@@@@B@@BD@B@DBBB@@B@@@BBB@B@@BB@DBDBB@BBBBDDBBB@BBB@B@B@@BF@B@BB@@B@@@B@@@@@@@B@@@@@B@@@@@@@@BB@@@@@@@B@@B@@@@@@@@@B@@@@@@B@@@@@@@B@@B@@@@@@@@B@@@@@@@@@BBDBBBD@BBD@D@FBD@F@D@H@B@B@DBB@B@BBF@@BB@BBBBDDBBBBBBBDBB@BDB@@BBFDFDDBB@BBB@@BBBFFDDDBBBDBBBB@@@@@B@@B@B@DBBB@BDBDBD@B@@B@B@F@B@J@D@F@D@B@BA@@BC@ADAB@D@B@BBB@@@DCFABAD@@@B@@@@ABABAB@BAB@@@BB@@DDBBDDBFBFB@@BB@@BFDBBBB@BB@B@B@BAHAF@@@D@B@B@B@B@B@B@@B@BBBBDB@BBB@B@B@@@BBBB@@BB@B@BBBBBBBBB@BBB@BBD@BBBB@@BB@BBB@BD@DBB@@AB@@EDABABABAB@@@@@@@@DBF@DBFBDBFFDDDFBB@BDD@BBBBB@@@B@BB@@BB@FBDBB@DBB@B@D@@ABD@BB@BDBBB@DBDBD@FBD@@@FBB@BBD@D@DB@BB@D@DBB@BBFBBBBBBBBBB@BAB@D@DABBB@B@B@B@BA@@B@FAB@B@B@B@@BB@BB@BBDBB@B@@BB@BBBDDDD@@BBDBBBDBB@BBBBBD@BBB@DAD@F@@@B@BBD@@BBBBD@DBDBD@BBBB@@BB@@DB@@@@BB@@EDCD@D@@CFCFADA@EFAB@BAB@@@@DDBD@BB@@B@@@BA@@B@B@@@BABABAB@BABABA@ABA@@DAB@B@BAF@@CBAB@@@B@B@@@BB@BBB@@B@BBB@@BAB@H@B@BBBBB@D@D@B@B@DAB@@ABAB@@AB@@BB@BF@D@D@DAB@BCDA@AB@B@B@@@B@B@BBDGBAB@@ABA@AD@BBB@BBB@D@B@BBBBB@BA@A@AB@BA@BDABBHBD@B@@@BA@MDBDBBVOXQFAB@@ABAJ@BA@@AE@CAS@KAA@A@CA@@A@A@ABCBS@ABC@ABA@ABABAJG@AB@@@@A@@@@CGAG@AA@@AA_@@@AAC@@@AAKCU@A@@@AAA@@@@AA@@@@AE@@@@@@@E@A@@@@BC@@B@@A@@@@B@@@@@@@@@@A@A@@@AA@@A@@@@@@@@@E@@B@BC@A@@@@AC@@@A@@@@BE@@@@@@BABAB@@A@@@@BCBC@@@A@@@@@C@@@@AACC@@A@@A@@@@@@@@BEB@@@@CBE@@BG@A@@@@@A@@@@@@DA@@@@B@B@F@@@@AB@B@@@@@@@DC@@@@B@@A@@@@B@BA@@@@@@@@B@BA@@@@@@B@@ADA@@@@@@@@BA@@@@@@@@B@@@@@B@@A@@BBB@@@B@@@@@BBB@@@@@@B@@@@@@B@B@@@@@@@@@@@@C@A@@@@B@@@@@@AD@@@@@@@BAD@@@@@@@B@@@@@@@@@@A@@@@CA@ACA@@@@@@@@@@@A@@@@@@@@@@@@BABA@@@@@@@@@@@@@@@A@@@@@@AAA@@@@@@A@@@@@@@@@@@A@E@@@@B@@@@A@@@@B@@AB@@@B@B@DA@@@@@@@@@@@A@@AA@C@@@@@@@@@@@A@@@@@C@@@@@@AA@@@@CC@@@@@@@@@C@@@@@@@@@@@@@A@@B@@@BAB@BA@@@@@@BA@@@@@A@@@@@A@A@@@A@@@@BE@C@@@@@@@AB@BABA@@@A@@DC@@@@@@@@@E@@@@@@@A@@BABADC@AB@@@BC@@@@@AB@@A@@B@@@BAB@@@@@B@@@@@@ABA@@@@@C@@@@@@@A@@@@B@@@BA@@B@BAB@@@B@B@D@B@@@B@B@@@BA@@@@BADA@@@@B@@@@@B@@@B@@BDA@@B@@@@@@@BA@A@A@@@@BA@@@@BA@@@@@@B@@AD@BADAB@BA@@@@@A@@@@AA@@@AA@@ABA@@@@@@BADA@ADA@@@ADCBA@@@@@@B@@@@@B@@@B@FBB@B@D@@@@@@@@@B@@@@@@@BCBG@@@@@@B@BA@@@@@@BA@@B@D@B@DA@@B@B@D@@@@@B@@B@BB@@@BBB@B@@@BA@@@@BA@@@@BA@@B@@@@@B@B@D@B@B@@@@@B@@@@A@@@@@AA@@A@@A@@A@@@@@@@@@A@@@@@@@@B@@@DAD@DA@@B@B@B@@@@@@@B@@@@@@@B@B@@@@@@@@A@@@@@@@@@A@A@@@@@@@A@@@@@@AA@@@@@@@@@@@A@@@@@@BA@@B@@@B@@@@@B@@@BB@@B@@@D@@@B@@@B@@@@@@@B@@@@A@@@@AA@A@@AC@@AC@@@@@@@@@A@@@@DC@@@@@A@@D@B@@@B@@@@@@@B@DB@@B@@@B@@@@@B@@@@@@@@@@@@@@A@A@@@AA@@@CCCC@@@@@A@A@A@@@@@A@@@@@@BA@@@@B@@@B@B@D@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@C@@@@@@@@@A@@B@BA@@@@@A@@@@AG@@@A@@@@BA@@@@B@@@@@@@D@B@@@@@@@B@@@@@@@BA@@@A@A@@@@@A@@AACA@@@@@@@@@@@AB@@@@@@AB@@@@@B@@@B@@@@@BBBBB@@BB@@@B@B@B@B@D@@@@@B@@@@B@@B@@@@@@@B@@@BB@@@@@@B@@A@@@@@@@@@@@@@@@@AA@@@A@@A@@@@@@@@A@@@@@@@A@@@@@@@@BC@@DCBC@@@@@@@A@A@@AA@@AAA@A@A@ADA@@@A@A@@@AAAAAAAA@@@@@@@CB@@A@@BA@@B@@@B@@@DBB@BB@@DB@@B@B@@@B@@@@A@@@A@@@A@@@@AAA@AAA@@@@@@A@@@@@C@@@@@@@@BA@AB@@@@@@@B@B@@@@@@@@@B@@@B@BBB@@@@@@@@@B@@@@@@@D@B@@@@@B@@@B@@@@A@@@@B@@A@@@AA@AA@@CAAA@@@@@@@A@A@AB@@@DC@@@@@@DA@@@@@@@AB@@@@@@@@A@A@@AA@A@@AA@@@AA@@@@A@@@@BA@@@A@@@A@@@A@A@@A@AAA@@@@@@A@@@A@@@@B@@@@AB@D@@@@@@AB@@@@@@@@@@A@@@@@AA@@@@@AAA@AAA@A@A@A@@@A@@AC@AA@@@@CC@AA@CAAAA@@@AACA@@@@@A@@@@@@@AFC@@@@@@B@@@@@@@@AA@AA@@@@AAA@@@A@@@A@@@AA@@@@AA@E@@@A@@@A@@@@BA@@@@B@@AB@@@B@@@B@@@@@FC@AB@@@@A@@@A@@@A@@@@@A@A@@A@@@AAA@@AC@AAA@@@A@ABC@A@@@A@@@@@AAA@@@AA@@A@@AA@@@@@@A@@AA@@@@@@@@@@BA@@BA@@@A@@@@@A@@@@@AAA@@@AA@@@AA@@ECC@@AA@MK@@@@AA@@@@AAA@@AA@@@AC@AEC@@AA@@A@@@A@A@E@@@AA@@A@@@@@AC@@@@AA@@A@A@@@GACA@@@@EC@@@@AC@@@@AA@@@A@@BA@A@A@@B@@@DA@@B@BA@A@@@@BC@@@EAI@C@@@AA@CCAA@AA@@AAC@AA@ACCE@AAA@@@A@C@A@@@A@@BA@@@@B@BAB@@@B@B@@@H@@@B@@@B@@AB@@A@@@A@@@AA@@AAAAACAA@CAA@A@@@K@A@@@A@@@AAGCCA@A@@AAAC@@AGAA@AA@@AA@CC@@AA@@@@@A@KA@@E@A@@@A@@@@@AAA@@@AAA@@AA@AA@@@@@EA@@A@@BE@ABCBA@@@A@ABC@C@@@AAA@EAAACAICCACACAAAA@@@AA@@AAACAA@ACGA@@A@CA@@AAA@AAAAACACAAAC@OCA@@@A@@@@@A@ABC@@@A@A@A@C@C@A@@@A@@@AAA@AA@@A@@AA@@AA@@A@@@AAC@@@A@@BC@@@@@AA@@@CCGI@@@@A@@@A@@@A@A@@@EB@BA@CDCDA@@BA@@@@@ABA@A@@@@@ABA@A@A@A@@@E@ABA@@@A@@B@@@BBB@BBBBBDDB@DBBBD@NB@@BB@@@@BD@BBB@B@B@@@B@@@@AB@@ABA@AB@@ABAB@B@@A@@@C@@@A@@@@@@@@A@@@@@@BE@@@A@@@@@A@@A@@@A@A@KDA@A@C@A@A@A@AAA@AAA@@A@@@A@@@A@@DEBE@A@@@@@A@@@@@A@@A@AA@@A@@@C@A@A@@@AB@@CB@BAB@@AB@BAB@@@@@B@J@@@B@@@@@BA@@@A@ABA@CBA@AB@@A@A@A@E@A@@AA@A@GE@@A@@AE@@@A@I@A@@@A@@@ABA@@@@BA@@@@@@@@B@@@@B@@BFB@BB@@@@B@@BB@@@BA@AJAB@@@@@B@@B@@@HJ@BBB@@@B@@@@@B@@A@AB@BA@@BA@AB@@A@CBA@A@@@@@A@@A@@A@@A@A@@AA@@@AA@EEAAA@A@@@E@A@A@ABA@AB@@AB@B@@@DAB@@@BB@@BBHB@@B@@@BA@@@@BA@@@A@A@G@@@IA@@A@AB@@A@@@@BA@@B@@BB@@BBBBDBJDB@@BB@@@@BBB@@BBBB@@@B@@@@@B@@@B@B@@@@@BA@@@ABA@@@A@A@A@C@ABAAA@@@A@A@AAA@@@AA@@AAAA@AAAACCMGQ@CA@AAACA@AA@@A@A@@@AAAB@@A@@@@B@@AB@B@B@@BJ@B@B@B@B@@@B@@@@@@A@ADABA@@@ABA@CBCB@@A@CBA@A@A@A@E@A@C@IAA@A@A@CBA@ABA@A@@B@@@B@@BBFH@BBB@B@@@B@@@@A@@BA@AAGAAAA@A@@B@@A@@B@B@F@B@@@BA@@@@BA@@@ABA@A@AAA@A@@AAA@@@A@A@A@G@A@A@@@A@@AA@@AAECA@AA@@GAA@A@AAAAC@@AAA@A@@CE@A@@AA@@A@@@A@A@@@ABGHABA@@BA@A@@@AAEAAAA@A@A@@@A@ABA@ABABAB@B@@@@@B@@BBDD@@BB@@@B@@@BA@@BA@ABC@C@A@A@A@A@@AA@A@@ACC@@AAAAA@@A@A@@@A@@@A@A@@@A@C@@@A@I@A@C@E@AAC@A@A@@@ABA@ADCBA@A@A@@@A@AAA@AA@CAAAA@@@C@C@ABA@ABABCDCDAD@BABAJ@@@BA@@B@@A@CB@@@@A@C@@BA@@A@@E@IAA@A@AAA@@@AA@AA@@@@@@A@@@@@@@A@@@A@A@@@A@AB@@@@A@@@A@A@A@@@@@AA@@@A@A@@@C@A@ABA@ABABAB@@@BAB@B@D@B@@AB@@ABAB@B@@A@@BA@ABA@@@CBA@A@A@ABA@@@A@A@A@GAA@AA@@CAA@@AAA@@AA@@@A@@@@@C@C@@@@@A@@@@@@@@@A@A@@@@@A@@@A@@@@@A@@@AA@@@A@@@A@A@AB@@ABAB@B@B@B@B@H@B@B@@@B@B@B@@@@AB@@EBAB@@A@@BA@@@A@@@@@A@@@@AA@AAAAGC@AA@@@@@A@@@ABKDABCBEBA@@@A@A@@BA@@@AAC@A@E@E@C@@@@@@@A@@@@AA@@@@@A@@@@@@@A@@@AA@@@@A@@@A@AA@@C@OGCAA@C@A@O@CBC@A@MDCBIBA@A@A@A@A@A@A@AAA@AAAACECC@@AA@@A@@@A@A@@@A@AB@@A@@BAB@DA@@BADA@@B@@CBCD@@A@CB@BA@A@A@A@A@@@AAA@@@AA@A@@@A@A@A@A@@@A@@BA@AB@@AB@@@BAB@BA@@DA@@DCB@BABA@@@A@@@A@@@@AA@@CAAAC@C@C@C@EBIBIFEBABABA@ABCBAB@BA@@BA@@BA@@BABAFABCF@BA@ABABA@ABA@A@AAE@GAEAA@CAKCA@A@CAA@ABC@eHE@ABA@CBCDEDIJGHEH@BAB@@@DAB@D@F@D@H@B@@@BB@@@@@DF@@@@@B@@BD@@@B@@@@@@AB@BAB@@@@@@@@@@A@A@A@@@A@C@A@A@@@A@"],encodeOffsets:[[130600,42473]]}},{type:"Feature",id:"220681",properties:{name:"临江市",cp:[126.918087,41.811979],childNum:1},geometry:{type:"Polygon",coordinates:["@@BAAA@@CA@AAAACAAAAAACCAAAAA@@AE@AAA@A@CAA@A@G@C@E@C@EAC@C@AAC@AACAAA@@@@@@@@A@@@@@@@@@@AA@@@@@@@A@@@@@@@@A@@@@@@@@@AA@@@@@@@A@@A@@@@@@A@@@@@A@@@@@@@A@@@A@@@AAA@E@@AA@A@A@AAA@AACCAAAAA@CACAA@@AA@A@AA@@A@@@AACAA@C@@AA@@@@@A@@AA@@@@AA@A@@@C@CBE@CBC@A@A@@@A@@@A@AAA@@@A@@@A@A@@@A@A@@@AB@@A@A@I@AA@@A@A@ABC@@@A@A@@@A@@@A@A@@@A@ABA@C@@BA@@@A@@AABA@@@@@A@@@@@A@AA@@A@@@A@EAA@A@AA@@A@C@CBA@A@A@@BA@A@A@A@C@@@A@A@A@A@E@C@C@A@C@C@A@C@ABA@@@ABA@@B@@A@A@EAA@A@AACAAAC@A@AAC@A@ABCBC@C@AA@@AAA@@AAAAA@AAA@@AA@ACA@@AA@@AAA@@@A@@AA@A@@@A@CA@@AA@@A@@@A@@@C@CACA@A@@A@@A@@AB@@CA@BA@A@A@@@A@@@@@@@A@@@@@B@@@@AA@@@@@A@@@@@AA@@@@@@@@@@A@@@@@@@@@@A@@A@@@@@@@AA@@@@@@@A@@A@@@@@@@@B@@@@A@@@@@A@AB@@ABA@A@@@@@@B@@@@A@@B@@A@@@A@@@AA@@A@@@@@@BA@A@@BA@C@A@A@@@@@AB@@@@A@@AA@@@@A@@A@@@@@@@A@@AA@@B@@@@A@@@@@A@@BA@@@@B@@A@@BA@@@@B@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@AB@@@BA@@@@@A@@@@@@@A@@@@@A@@A@@@@@@@AA@@@@@A@A@AAA@@@@@@AA@@A@@@@@AA@@@@@AA@@@@@@@A@@A@@A@@A@@@A@@@@AA@@@@@@@A@@@@@A@@@@@A@@@@B@@E@@@A@@@A@A@AAA@A@@@A@A@@@@@@@A@@@@@@@@B@B@B@@@B@@ABA@@@ABA@@@AA@@A@AAAAA@@AA@A@@@A@A@A@AA@AA@CA@AA@@@A@@@@AA@@A@@A@@A@@@AAAAAAA@@A@A@A@A@ABA@@@A@@BA@@@AB@@A@@BA@A@A@@@@AA@AAAAA@@AA@@@A@AAC@A@A@A@A@AB@@AB@B@@ABA@@@A@A@AAA@@A@A@@@@@A@@B@B@DCBABA@A@@@AA@@AA@AAA@C@C@A@@@A@@@E@@@A@@@AA@@@AA@@AA@A@@@A@A@@@A@CAC@@A@@A@B@@A@@BAB@BAB@B@@BBAB@B@BA@@B@@@@AA@@@A@AAA@A@@@@@AB@@ABA@@@A@@@A@AAA@AAA@A@C@A@A@@A@@@A@@A@A@A@@@ABA@AA@@A@@B@@@@@B@B@BA@ABA@AB@@@@@@A@@BA@@@@@A@@A@@BA@@@A@@@A@@A@A@AA@@A@@@C@AAAAA@A@A@A@A@@@A@@@A@@@A@AA@@A@@@ABA@@@A@A@A@@BA@@BA@@BA@A@A@@A@@@@@AA@@AA@CA@@A@@@A@A@AA@@AA@@@AA@@@@A@@A@A@A@A@C@A@ABA@A@A@@AA@@@AC@@@@A@@@C@A@A@AAA@@A@@AA@@@ABA@@@A@@@A@@A@@AA@@AA@@A@@A@@AA@@AA@@@A@@@A@@BA@@@AA@@A@@@A@A@A@@A@@@AA@@A@A@A@@A@@@@@AAA@A@@AA@@@A@@@A@A@E@A@CAA@C@AAA@@AACAAAA@@AAA@AAA@A@@@A@A@ABA@@@A@A@@@A@@AA@A@A@ABA@AB@BA@ABA@A@A@@@A@AA@@CAA@@AA@@A@@@@AA@A@A@@@@AAAAA@@AA@@AC@AAA@@@@A@@@@A@@@@AAE@@@@AA@@C@CAAAA@CAAAA@@AA@CAA@@AA@AA@@@A@A@A@@@AA@AA@AA@A@@@AAA@A@@@@A@@AAAA@@@A@@B@@@@AB@@AB@B@B@B@@AB@@@@A@@@A@@A@@@A@@@AACA@@@@A@A@A@A@@@A@ABA@A@A@A@@@AAA@@AA@BA@@@@@AB@@@@A@@BABA@A@@BA@@@@@AA@A@AAA@@@A@@@@@A@C@AB@@A@@@AB@@@@BB@@@@@@ABA@A@@@A@A@@@AA@A@@ACA@@AA@@@@BA@@B@@@B@@@@A@@@ABAAA@A@@@@A@@@CAA@@@@@@@@@@A@@BA@@@@@A@A@@@@A@A@@@A@@@@@A@ABABA@AB@@ABA@AD@D@@ACAA@AAAA@AC@A@C@GCOCA@A@ABA@AB@B@B@@@B@D@@BB@@@@BD@B@@@B@B@@A@@BA@CBA@C@G@A@@AA@ECAAAACACAC@A@C@C@GBC@A@ABAB@@AD@B@BAF@DAB@BA@ABC@E@C@A@A@AACAMKA@AAA@CAA@CACAA@@AA@@@A@@@@B@@@@AB@B@@@@BB@@BBDDBB@@@B@B@@@B@@ABA@CBA@ABAAA@EA@@A@A@E@CAE@C@C@C@C@A@C@CBCBABC@CDADAB@@@B@B@BBD@BB@BBB@B@B@B@DAB@HEBAB@B@D@B@@@BB@DBF@D@B@J@@BB@BBBBB@@BBB@HBD@D@DAB@B@@@BBFBB@@BB@@@@B@B@@CLAB@B@BB@@BDBHFBBB@B@B@B@F@B@FAB@FAB@R@D@B@D@BBBBB@@BBB@B@@@B@B@B@BA@@BCBADA@@@@@@BA@A@@@A@@@A@@@A@@@@@A@@@C@A@@@A@A@KBE@]AG@G@EBE@@@CBA@CBA@AB@BABAB@B@@@BB@@BB@DBBB@@BB@@@B@@@B@@AFA@@B@B@D@@@BA@@B@BA@ABA@A@A@A@A@A@KEA@AAA@E@A@A@A@GD@@A@EBA@A@@BA@@BAB@@@B@BB@@BRDB@B@BBDDDDBB@B@B@B@B@B@@@BA@CB@@ABAB@@ABA@A@IAA@A@A@EEA@@@A@@@KD@@A@C@A@A@A@@@AB@@@BA@@F@B@BAFADABABA@EDEBC@SFC@CBEBCDGDABAB@BAB@@@BBB@@BBBDJJBBBB@BDL@@@BB@BBB@B@B@B@BAB@@ADA@ABADAB@DAB@B@DBF@DBDBDBDBDDBB@BBB@DBBAB@B@@ABCBC@C@EAUCC@C@CBAB@BAH@D@BBB@B@B@@AH@B@B@@ABAB@@A@@B@@@B@B@F@B@BBHB@BF@@@B@D@@AB@BABABA@A@@@A@A@A@C@M@C@A@C@AAC@C@E@C@E@EBC@KDABA@ABA@@B@@@@@B@@@@@BBDDDBDB@@B@BAD@@@B@BA@A@AB@@@@AAA@A@A@WDC@C@CBABA@@@@B@BAD@@@BEH@@AB@@A@A@A@G@C@I@IAC@KAA@A@@@AAAAA@A@AAA@ABA@EBA@CDA@AB@BA@@B@BBH@BBB@BBBBBD@B@@@B@B@DAB@B@@@B@B@@BB@DHBB@BBD@D@D@DAB@B@@@B@@ADAB@@A@@BA@@@AB@@A@A@A@@BA@@@C@@@@@A@A@A@ABA@@@A@A@@@AB@@A@A@A@@@CCA@AEC@CACACACAAAEA@AA@A@@AA@@@@AA@A@@AGAEAGACAC@EAA@@@CAAA@@A@@AA@AAAAA@AAAA@AA@@@@@AA@@@@A@AA@A@@A@AA@@@@@@@@@@AA@@@@@@AA@@@@AA@@@@AA@AA@@@AAAA@@@ACC@@AA@@CCA@@A@@AAACAC@AA@@@AC@@A@@A@AAA@CAGACAA@CCC@AAAAE@A@@@A@A@@@A@@@@@A@@@@@A@@AAAAACA@@ACAA@AAC@E@C@ABC@ABAB@BA@@DAB@BENAHADABABCBABA@A@A@AAA@CAA@@AA@CGAAAA@@AA@AA@@AAAAAAAACAAAA@C@AAI@A@CAC@CBA@EBCBCBGDG@A@A@@@AAAACA@AAC@C@C@E@EBCBA@CDABAD@DAD@BBD@B@F@B@B@@@BABABKHIFCDKFCBCBCDCBABCD@@EFCBEDOJGFCBCBCBC@ABC@A@G@EAKAEAA@CACAAACCCEEGAC@C@C@ABCBABADCDAFAFCJAF@FAF@J@BAB@B@@@BA@@@@@@@AA@@@A@@@AA@@@@@@B@HC@AB@@A@ABA@A@@@@@AA@@@@@@@CB@@@@AA@@@@@@@C@@AA@@@AECKGACAAAAACAA@@@@@@A@@@AB@@@@A@@@@A@@@@BA@A@@@A@@@@A@@@EAC@AAA@C@AACAECAAAA@A@AAA@A@A@A@@BABA@@@@@A@BC@ABIDEDC@@@AB@@ABABCBCB@@A@ABA@A@A@A@A@AA@@ABC@ABA@A@A@ABA@A@@BAB@@ABA@A@A@A@CBABA@EBA@A@@@A@@BABAB@@@BCBAB@BA@@B@BABA@ABEDEBCBCBA@AB@BABBBDDB@@F@B@D@BAB@BA@AB@@CB@@A@A@@@A@@BA@ABABA@CBA@E@A@A@CBA@@@A@AAAB@@A@A@ABA@@BAB@@@BABC@AB@@AB@@A@ADA@@B@@BB@@@D@B@@AB@BDD@BAD@DB@@BBBBJ@@@B@@AD@B@@@BBB@B@BAB@@AD@@@DA@@@@BABABC@AB@B@@@D@BAB@@AB@BA@AB@@AD@B@@CD@B@@@BCBA@@BABABCDEBAB@@@B@BBBBB@B@@@BBB@@@B@@@@BB@@BBBBBB@@@BBBDBBB@@BDBFBF@D@@B@DBFDBBBAB@@@D@B@B@DAB@B@D@B@BAB@B@B@BAB@@ABAB@B@B@B@B@@@B@@BB@BA@B@@B@@@D@B@D@BBD@DBB@@@BB@B@B@B@B@@@BB@@BB@BBB@BB@B@@B@@B@@@B@@BB@BB@B@BBB@BBD@BBD@BBBBDBB@@BBBB@@BB@B@D@B@BAB@@@B@B@BBB@B@B@B@B@@@B@B@BBB@@@BB@@@@@BB@@@B@@@B@D@@BB@BB@BBBB@BDBB@BB@BB@@BB@@BBB@@@B@B@@@B@D@B@B@BBBBBB@BBB@B@B@BB@B@@BB@@B@@BB@B@BB@BB@BB@@B@BB@@@@BBBB@@BB@@B@@BB@B@@BBB@BBB@DBB@@B@@BB@BB@BB@B@@@B@B@BAB@B@@@B@@@@BB@B@@AB@BABA@@B@@@@B@B@BBB@BBBBB@@B@@B@B@D@BA@@B@@@@@BB@@BB@@B@@@@B@@BB@BBB@BBB@@@B@B@@BB@B@@BBB@BB@B@@@@@@BB@@BBB@BDB@BBBBB@BB@@B@@BBB@@@@@B@@AB@@AB@BA@@B@@BB@@BBBB@B@B@B@B@B@@@B@@ABA@A@@BA@@BAB@@AB@BAB@@ABAB@@A@B@@@B@B@@@B@@B@@B@B@@@BB@@BBB@@@@@@@B@BB@@@@@B@D@B@@@BBB@@B@@BB@B@@B@@@@@B@BBB@@BB@@@B@A@@B@@@B@B@B@B@AB@B@DB@@BB@@B@@@B@@@BB@@B@@@B@@B@@BB@BB@DA@@B@B@B@@B@@B@@B@@@@@@@B@@@B@BBBAB@B@B@B@BAB@@BB@@@BB@BB@@BB@B@D@B@@B@@@B@@@B@@BBA@B@@B@@BBB@BB@@B@@@B@B@@@B@B@@BB@BBB@BB@@B@B@B@B@@@BBB@DBBBBBB@@@@@BBB@B@@@B@@@B@B@@@B@B@@@B@@@B@BBB@B@B@@AB@B@BA@@@AB@@@@AB@BA@@BA@@@AB@@A@@B@BAB@@@BB@@BB@@BBB@@@@@@@@B@B@@@BB@@@B@B@B@D@BAB@@@B@BBB@B@@@B@@@B@BA@@@ABA@A@@BA@@AA@@AAC@AAA@A@BA@@B@@AB@@A@@@AB@BA@AB@@@@AA@@AA@@A@@@A@@@@@AB@D@B@B@D@B@B@BA@@@A@A@@@@B@@A@@BA@ABABA@@BA@@@AA@AA@@@@B@@@BBB@@@D@@@DBB@BBB@@@B@@@B@B@@ABA@@@ABA@A@@BA@@BAB@BABB@@BBB@B@B@B@D@B@BBB@@@BAB@BAB@B@B@B@@@B@@@B@@@B@@A@A@@@A@@BA@AB@@@BAB@D@BAB@BAB@B@B@BAB@BAB@BAD@BAD@D@D@D@BBB@D@@ABABCBCDA@ABBBBB@B@B@B@B@B@B@B@BA@@BA@CBADEBC@ABA@@B@@BB@B@B@B@BBB@@@BBBBB@B@@@D@B@@@B@B@BB@@@BB@@B@@BB@@D@@@BBBBBBBBBB@BB@DB@BBB@@BBD@BBB@B@@@BB@@@B@@@B@B@@AB@@@@@@@@@@@B@@@@AB@@@@@@@@ABABA@A@A@@@A@@AABA@@@@@@BA@@@@@@@AB@@@@@@@@AB@@@B@@@@@@@@@BA@@@@BBB@BB@@BA@@B@B@@@B@@@B@B@B@BB@@@BBB@DBB@@@BBB@@BB@B@@BB@BBB@B@@@BBBB@@@B@B@@@@BBB@BBB@@@@@B@B@@BBB@B@@BBB@@B@BB@@B@B@B@B@@@B@@@BBB@B@B@B@@B@B@B@B@@BB@@@@BB@@@B@@@BB@@@@B@@BB@@ABA@@B@@@@@BB@@@@@@B@@@B@@@D@B@@AB@@@B@BAB@@@B@BA@@BAB@@A@A@@@A@A@@@@@A@@@AB@@A@@B@@@BAB@B@B@BBB@@@BBB@B@BBB@B@BBB@@BB@B@BAB@B@B@@AB@@@B@B@@@B@@@B@B@@B@@B@BAB@@@B@B@BAD@@A@@CA@@AA@@@A@A@@B@@A@BB@B@@@B@@AB@B@@AB@B@B@B@@@B@B@@AB@B@@@BAB@@@B@@@B@BAB@@@BAB@B@BAB@@@B@@@@@B@@BB@@@BAB@@@B@@B@@BAB@D@BAB@B@B@BBBAB@@AB@BABABAD@BAB@B@BB@BB@BBBBB@@B@@@BBB@B@@B@@@@BB@@BB@B@@B@@B@@@B@BA@@BAB@@A@@@@BB@@BBD@B@D@D@B@B@B@@BB@@@BA@@DA@@B@BA@@B@D@D@B@@@B@DD@B@@@BBB@@BBB@@BBB@B@@@@BB@@@@@@BB@@B@B@@BD@B@B@BAB@BB@@BBB@@BB@BBBAB@@@BB@BBBB@BB@BBBAB@B@B@@ABB@@@BB@@@BA@@BABA@@@@B@@B@D@BBB@B@@@B@B@@@BAB@B@BBDA@@D@BAB@D@B@DBB@B@D@B@@@B@DBF@BBB@BB@@B@BA@B@@@B@B@@B@@B@@@B@BB@@B@@BBBBABA@A@@BA@@@@@@@AB@@@@@BA@@@@B@@A@@B@@@@A@@@@BAB@@AB@@B@@B@@BBBBB@BBBBD@@BB@B@D@B@@@BB@B@BB@@BB@@BB@BBB@@@D@@@D@@@BBB@BBBBBDBBBDBBBBBB@@B@@BJ@H@D@B@BBB@B@@@@@B@B@D@BAB@D@B@B@@AB@@@B@B@D@D@B@D@BAD@D@H@HAF@F@D@@@B@@A@A@C@ABA@A@@BADAB@F@B@F@DB@BB@BAB@DADADADAHADAHAF@D@FADAD@B@D@DAF@D@D@FAD@FBD@D@D@DADAD@D@JAFAF@DAJAD@F@FAD@DAB@D@B@B@D@F@D@F@@@FAF@HBD@FAD@D@D@B@D@D@DAD@B@B@BCBAFA@ABADABA@ABA@ABABABABABA@ABABA@CB@@ABA@ABC@CBC@@@A@@@A@A@@@@BA@@BA@A@@BA@C@@@ABAB@B@BA@ABABABE@A@A@@@AAAAAA@@AAA@C@@@ABC@@BA@A@@ACAA@AA@@@AA@@@A@A@CBC@C@CBA@AAC@AA@BA@@AACACAA@@A@@@A@ABE@A@A@CBA@ABABCDC@@BABA@@BAD@@ABABA@A@A@AAA@A@A@AAA@AAAA@AA@A@AAA@@@@AAA@A@A@AA@@@CAA@AB@@AA@@AA@AAAAAA@@@@@A@A@A@ABADABAD@@A@@@AACAC@AAA@ABADAFAHADADAFAFAFAFCDABABADCBADC@A@A@@@@B@@@@@B@@@@@@@@@@@B@@@B@@A@@B@@@@@@@BB@@B@@@@@B@@@B@@@B@@@@@@B@@B@@@B@@@@@B@@@@@@@@@@@B@@@@@@AB@@@@@@@B@@A@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@B@@@@B@@@@@@@@@@@B@@@@@@@B@@@@AB@@@@@B@@A@@B@@@B@@@@@@A@@B@@@@@@@@@B@@@@@@@B@@@B@@@@@B@@@B@@@@@B@@@@@@@B@B@D@BAB@FAD@D@B@BAD@B@B@@@BBB@B@FBB@B@D@B@DABBF@D@D@F@D@D@@AB@@A@@@@@AB@F@@@@AB@@AA@@AA@AAA@A@@@A@@@@@AA@@B@@@BAB@@@B@B@B@@@B@B@@@B@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@B@@AB@B@@@@BB@@@@B@@@@BB@@@@@@B@@B@@@@B@@@B@@@B@@AB@BA@@@@@@@A@@@@@@AA@@AA@@@@@@B@@@@@BA@@@@@@@@@@@@@@BB@@@@B@@@@@BAB@BAB@@@BA@@BA@ABA@@B@@AA@@@A@C@A@@@@@@@A@A@@AA@AA@@A@@A@@A@@@@@AA@@@@@@@A@@@@@@B@@@BB@@B@B@B@B@@@BA@@B@@A@@@AAA@C@@@@@A@@@@@@@@@A@@@@@AAA@@@@@@BA@@@@B@A@@@@@@A@@@@B@@A@@@@@@@@@A@@@@@AB@@@@AB@@ABA@AAA@CAAAA@@AAA@AA@@@AB@DAFCDAHADAB@B@DAD@B@B@@A@A@ABCBABABAB@DA@@BA@AB@BAB@B@B@BAB@B@B@B@BBFBFDBBDBBBB@B@B@B@BCDADAB@D@D@DBD@D@DAFAB@FCDCDADGFEDEDCBABADAFAHADAF@DAF@J@D@BAB@BAFAHCJCFAFCHADAB@B@D@B@B@DADAD@BAD@F@@BB@D@DBBB@@B@@ADCDCBCBAB@DADAFAHCHADADADAB@D@B@B@B@B@BBB@B@BAF@DAB@D@@@BBD@D@F@B@B@@@@ABCBC@AA@AC@AAA@C@@BA@ABADCDAFEBABAFCBAB@"],encodeOffsets:[[130903,42716]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,4 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("长春",{type:"FeatureCollection",features:[{type:"Feature",id:"220102",properties:{name:"南关区",cp:[125.350173,43.863989],childNum:1},geometry:{type:"Polygon",coordinates:["@@DA@@@A@@@@AG@@A@@A@A@@@@@CB@@CI@@@EAABC@A@A@@@@@A@@@A@CA@AC@A@@@@A@@D@BC@@@@B@DGEADGPF@A@@@@BA@@B@FGAA@ABA@AAA@@A@@@A@@@@A@CA@CAA@A@A@C@A@@@C@AAC@CAC@AA@@AAAA@@@AA@@A@A@@@@AAECCC@A@@@@@A@@A@@@@@@@A@@@@@@@@@@B@@A@@A@@@@A@@@@@@B@@@@@@@BAA@@A@@B@@@@B@@@@@@B@@@@@@@@A@@AA@@@@@ABABA@@DBBABABA@ABA@@@A@AD@@AD@BBB@@@BA@C@A@@BABA@ABA@C@C@@BA@GC@@AB@@AB@@@@A@@@@@AA@@AA@@FEDEBAGCIAKCA@AACAAA@@C@@@@@A@A@A@@@@@@B@D@B@D@B@B@@BB@B@B@@@@BBBBBBBDBB@B@B@B@@@@@@@@@@BB@D@@@B@@@@ABA@@B@D@@@DBF@BBBDBBBBB@BBB@B@D@B@B@B@B@B@BB@ADA@BFC@@@B@ADBB@@BD@@@BB@@@@DBB@DFBBB@@@DABCBDBB@@DF@@BBB@D@BA@D@@FBB@BB@@B@@ABABDD@B@DHD@D@B@B@BBD@@@B@BABBBBBABABABABC@A@A@A@A@@@@@CBABABAB@BABA@@B@B@@B@@@BA@@BB@A@@B@@@@@B@AB@B@@B@B@B@@@B@B@H@BB@@@@AB@@B@@@A@@B@@@B@@A@A@@@A@@BA@@A@A@@AA@@AAA@@@A@@@A@A@@@@@@BB@@@BB@@@@@A@@@@@@B@BBBBBB@@BB@@BB@B@@AB@@A@@@@@A@A@ABA@A@@@@B@@BBDBDBB@BB@BB@B@@BB@BB@@BBB@DBB@@BAB@@A@AAAAA@@@ABABA@DF@BBB@@CB@@@@BB@@DB@A@@@B@@AB@@AB@@AB@@@B@@BBBB@@B@@@AB@AA@@@@@A@@@@@@@A@@@@@@@A@CA@BA@@@@@A@@BA@B@ADHD@@B@@@BBB@@@@@B@@B@@B@@@@B@@@B@@B@BB@@@@@@@BA@@@A@@@AAA@A@A@A@@@@B@@BBB@@B@B@B@@A@@A@@A@@AA@A@@@AB@@@@@@@@AB@@@@@@BBBBA@@@BB@@@@B@@BDB@@DBBBB@BBD@BBBB@@B@@BA@A@A@AB@@@@BB@@@BBBBB@@BB@@BDDB@BBBD@B@B@@BB@AB@@@@BD@@B@B@@@@@@AB@@@B@@@@A@AFB@@@@@B@@A@@@@B@@@@@@@B@BB@B@BBB@@@@BABA@AB@@ABAB@@A@A@C@ABB@BDB@DBDBDB@@BB@BB@@D@B@B@D@@ABA@I@A@AB@@@@BB@BB@AD@BAB@BABABBBB@DB@@@B@BA@C@@BA@@BBBDBB@BBBB@B@@@B@BE@@@C@C@A@@B@@CB@@ABABAB@BAB@@A@AB@@C@AAABA@@B@@A@A@AB@@ABA@C@ABC@C@A@C@CC@BA@E@C@A@CACD@@A@@@AAA@@@AACAFCA@@@A@A@@@@@A@@@@B@@@@@@IE@@A@@@@@A@@@AB@@ABCIA@A@@@@BAC@CA@@@A@A@@@@@@@@@@A@@OA@@N@@@I@C@GA@BG@@@E@@BA@@FCC@@AB@DA@@B@BA@@@@D@@BBB@B@@@@@@DVFA@@BCHBDABABAD@B@DABA@@BAB@B@@@B@B@@DBLD@@@@AB@BABA@BB@BBBBD@@@@@BABCB@B@B@B@D@@@H@@@D@D@@B@@@B@FBDBB@FB@@JBFBHB@B@@D@FBDBF@@BHBDB@@@@@B@@A@@@A@@@@@@@@@@B@B@@AB@@@@@BB@@@DB@@@@@B@@D@@@@@B@@@@@@A@A@C@A@@D@BDB@@@@BBB@@B@@@BB@@@@A@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@B@@F@@@B@B@B@@F@@@@@B@H@@@@@@@@@@@@@D@B@B@@@@@F@@@D@D@@@@@@@@@B@F@@@@@B@H@@@DAB@BB@@@A@@D@B@D@B@B@B@B@B@B@@@B@@@F@@@@@@@F@D@B@@@D@B@@@B@B@@@B@@@@@B@@@B@@@@@B@@@@@F@@@@@@@@D@B@B@@@@@BB@@DBBB@@DB@@BBDD@@@@DB@@@@DC@AF@@@FD@BB@@@@@JA@@@@B@B@@@@@@@ADA@@B@B@B@@@@ADCB@@A@A@@@B@B@@BB@B@B@DB@BB@@BBB@B@@@@H@B@@@@@@@BA@A@@A@@@BA@AAA@@@A@@BA@@@A@@@@@@@A@A@C@@B@BA@@@A@@@C@@AAA@C@@@A@@@@A@@A@@@AA@@@ADC@@@AB@BA@@@A@A@@@AA@AAAAA@@AC@AAAACA@C@@@@@ADC@@BABABA@AAA@A@C@@@ABC@C@@BAB@@A@@L@D@F@F@H@D@F@B@B@@@D@D@D@B@D@D@BC@A@ABA@C@@BA@ABABC@ABABA@CBA@ABA@ABC@ABA@A@ABA@A@ABA@C@CBA@@@@BB@BB@@@@@BB@@B@@B@@B@@@@B@@@@@@BB@@B@@BB@@@@BBBB@BBBBBBBBBBBBBB@@BB@@BBB@@BBBBB@@BBBBB@@BBBZIAJJ@NAH@B@BA@A@@B@D@D@@@B@@A@@B@B@@@@B@@@BB@BBB@D@BBB@@BB@@BBB@@BBB@@@@@@@BB@@B@@@B@@@@@B@@@B@@A@@BB@@@A@@@@@@@BBA@@@B@@@@@@@@B@@@@@@@B@@@@B@A@@B@@@@@B@@@B@@@@@@@B@@@@@B@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@B@@B@@@@@@@@BA@@@@@@@@@@@@@LB@@AB@@ABAB@BAB@@B@@BBB@D@@@@@B@@@@@@AB@B@@@BA@@@@@@BA@@B@BA@@@@@@@A@@A@@@AAAAA@@@A@BA@@@@@@@A@@@AB@@@@@B@@@@@@@@@AA@@B@@@@@B@@B@@B@@A@@A@@@@@@@B@@@@@@A@@@@@A@A@@B@@@@@B@@B@@B@@A@A@@@AB@B@@@D@@BB@@B@A@@@@@@AA@@@@B@@@@@@@@@@A@@@@@@@@@A@@@A@@BABAA@BAB@BC@AA@@A@AA@A@A@A@A@A@AB@@A@@A@@AA@@A@@@@@AB@@A@AB@@A@AB@ACG@@A@@@@AA@@@AA@@@AA@@@AA@@@@@A@@@@AA@@A@@A@@@@@@B@@@@@@@A@@@@A@@@@B@@B@@@@B@@@@AA@@@@@@A@@@@@@B@@@@A@@@@@@@@AC@@@@@@A@@@@@AAB@@@@A@@@@@@D@BAB@B@D@@AD@B@B@AA@@@AA@B@@ABB@@B@D@DAB@BBBA@@@@BA@@@A@@@@@@B@@AB@BBD@BBB@@BBB@B@@@@@@@@BA@@@@@@@B@@B@@@@A@@@@@@@@@A@ABA@@D@@@BAB@@@B@B@B@B@B@@@B@B@@@D@D@D@D@DBB@@@@@@A@@B@@@@@B@BBB@B@@B@@B@@B@@@@BB@B@BB@@B@@@A@@B@@A@@B@@@BA@@B@@@@A@A@@@A@@A@B@@@@A@@B@@A@@@@BA@@@@B@@@BA@@B@@@@AB@@@B@@@B@B@@AB@@AB@@@B@B@@@AA@A@@A@@@@AA@@A@AA@@@A@A@@AA@@@@@AA@@@AAA@AAA@@@AA@@@AA@@AACC@@AAAA@@@@@@A@@@AAAA@@BA@A@@@@@AA@A@@AAAA@@@AA@@@A@@AA@@@@@@BAD@DAB@@A@@@AAAB@@@B@@@@A@@@@A@@@@@@@@@@@A@@@@@BA@@@@@A@@@@@@B@BB@@B@@@@@@@@@@@@@BB@@@@@@B@@@@@BB@@@@@A@A@@AA@@B@@A@@BA@A@A@A@@@@B@@@B@@@@@@A@A@@AAA@@@@@@A@A@@A@@@@@@@@@A@@@@@A@@@A@@AA@@@A@A@C@@AA@AA@@@ABABABA@@@AAA@@@A@A@@DADCDAB@@A@A@@AAAA@AA@CBC@A@@@A@AA@AA@AA@@A@@A@A@@B@@A@@@A@@AA@@@ABAB@@A@@@ABA@@@A@@@@@@A@@A@@AAA@@@BA@@@@@AA@A@AA@@@@ABA@@@A@C@CAA@@@A@A@A@@@A@@@A@BA@@@@@A@@CAA@@AA@@@@@@A@@@A@A@@@@@A@@@@A@@@@@A@@@A@A@@@A@@@A@CAAA@@@@AA@@@A@@@@A@AA@A@@@@@@B@@@B@@A@@@@B@@AC@C@AAA@A@A@C@@@AB@BA@@@A@@@A@A@@@@@B@@@BA@@@A@@@@@@ACAACA@@ABCD@@A@@@@@A@ABA@@@@@@@A@@@A@@@CAAAC@A@A@A@C@A@AAC@AAAA@@A@AAAB@@C@A@@@CAA@AA@@@AAAAA@A@C@A@A@ABB@@B@@@B@@@B@B@DABA@@@@@C@@@A@@@A@@BB@@@@@@B@@@B@BA@ABA@A@AAAAAAACC@@A@@A@@A@@@@A@@@@@@A@@AA@@@@@B@D@BAB@BADA@@@@@@@ABA@@B@@@BBB@BBB@@@BA@@A@@A@@BCBABABAB@DAB@B@@@B@B@@BB@@@B@@@BBB@@@B@B@B@B@@@BBD@@AD@BA@@BCB@@@@@@A@@@A@A@@AA@@@AA@@AAA@@B@BEIB@GD@B@@@@@@C@A@@A@C@A@AAA@@@AAA@CCAACAD@D@B@AAE@C@@A@@@@B@B@B@D@@@AA@A@C@@@A@@@@DA@@@A@@@@B@@AB@@@@@@A@@@A@@BA@@A@@A@@@@A@@AA@@@AAA@@AA@A@@AA@@AAA@@@AB@@@B@B@B@@@@@@C@A@A@@@@@A@@A@@AACC@ABEB@A@AC@@@@@@A@@@@@A@@@A@@@@@@AAA@@@A@C@A@A@AAA@A@@@A@A@@BAB@B@@@B@B@F@@A@@@A@AAA@@AA@AA@@A@@AC@C@A@A@CAAA@@@@CAAAA@@@A@ACIDA@DCIAA@@@@@@@A@@BABA@@BAAAA@A@@BCA@@@GADC@@@@@@CAKGCAA@BA@AA@EA@AEAABGCABHDCBA@CAAACCCBCBAAGDBABAB@KAAA@C@AA@@AA@A@EBA@A@A@A@A@A@A@@A@AE@A@AA@@B@@@B@BA@@@@@A@@@AB@@@@@B@B@@B@@@AA@B@B@@ABB@A@@@@@AA@A@AAA@@BA@C@AA@AFB@@@@BE@@@@B@@A@@B@B@@@AAD@@@@@AA@C"],encodeOffsets:[[128403,44612]]}},{type:"Feature",id:"220106",properties:{name:"绿园区",cp:[125.256135,43.880975],childNum:1},geometry:{type:"Polygon",coordinates:["@@ECCCCACCAA@@@AA@@@AAAA@AAAA@AA@@AACC@@CCA@CCAAAAECAACAAAAAEC@AA@@@@@@@@@@@ECIG@@CCA@@@AAAAAAC@CC@@AACACCCA@@@@AAEEECCAAACA@A@@A@CCEECAGGAAGEEECAICJICC@FA@@BEBBGEAABA@BECAAFAABEYCSQ_FIN@FBLGL@HEJPbAJAJRR@DA@@B@@A@@@A@@B@@@@A@@@@@@B@@@@@B@@@@ABA@@B@@@@@BA@@B@@@@BB@@@@@@@@@BA@AB@@@@B@@BDDB@@BB@@@@B@B@BAD@@B@@B@@BAB@@B@@@@@@@@@@@@@B@@A@@B@@@@@BBB@@@@@@BB@@@B@@@@@@@@@@@B@@A@@@@@@@@B@@@@@BB@@@BB@@@@@@@B@@B@BBBB@@B@@@@B@@@@@B@@@B@B@@BB@@@@@BAB@B@B@@@B@B@@@@@BAB@BADABA@CBCBCB@@@B@@@@AB@BAB@B@BAB@@@B@D@@BBD@B@B@B@DBF@FB@@B@EJABABAD@@QC@@@B@B@@@B@@@@@@B@@BB@BB@@B@B@DBB@@B@@ABCDCHCDAB@BA@ABA@C@A@AACAA@@@CBABABCDA@A@@@@B@@@B@@@BABDPBDAB@B@@@@A@@@A@@@A@@@@BBBB@@B@@C@@@@BAB@@BB@@@BA@@@@@A@@AA@@@AAAB@@CB@@@@@B@@@@@@AAA@@B@@@@@B@@CB@B@@@@DB@@BBA@@B@@@BABA@A@@@@B@@ADA@@B@@@@BBB@@@@@AB@@B@@B@B@@@BB@@@@@AB@@B@@B@@@BA@@@BB@@DB@@@@@@A@@@AB@@BB@@@@A@@@@@AB@@A@@@@@A@@B@@@B@B@B@B@B@@@@D@@BB@@@B@@@A@@B@@B@@@@@A@@B@@@B@@@B@@@@@@@DBBABB@AB@@@B@@@@BBB@B@BB@@A@@@AB@@@@@B@@B@B@@B@@@@AB@BA@ABBBBAD@@ABA@AFBLGDBBB@@@@@A@@BA@AB@DBBCB@DBBBAB@AAB@@BBBADD@@DB@@ABDB@B@BD@AB@BA@B@@BBB@BB@B@@@D@BBCBFFFDGF@@FCBBCD@@FCBBGFFD@@B@@@BB@A@@B@@@B@@@@@DDAB@BBB@@B@DA@C@A@A@@B@@@@@B@@@HF@@@@B@BBF@@@@@B@@@@@B@@@BA@BB@B@@AA@GEA@@@@@@@@@@@B@@@JF@@GE@@A@@@@@@@B@B@@@FD@@@AA@AA@@A@@@BAA@BABB@@B@@@F@@@D@@AC@BA@A@@@AC@@@IACABA@ABAB@H@D@@@A@AB@@FBB@JGFEBAB@@ABA@@B@BB@@DAA@AA@@A@A@AAC@@A@@@ABA@ADC@@@ABACCA@@ABAA@AA@A@@@@B@B@BCB@@AB@@@BA@@LB@@FBBEDBDBA@DD@@DBB@BBB@@BFDB@B@@@@A@@B@@A@@@AB@@A@@@A@@@@@ABA@A@@BA@@@@BADA@@@@@@@A@@@@BA@@@@@@@@@ABCB@@ABA@ABAB@B@BBBAD@@CB@B@B@@A@@BB@@B@DDABB@@BB@@@B@@B@@@@B@A@@BB@@@@@@@@B@@@@@@@BB@@BB@@@BBB@@BB@B@@@BBB@BB@@B@@@BBB@BBB@@BBB@@BB@@@@@@B@@@@@BB@@BB@@@@@@@B@@B@@@@@@B@@@@@@@@@B@@B@@B@@@@@@B@@@B@@@@@B@@@B@@B@@B@@@@B@@@@B@@@@@@@@@@B@@B@@@@@@@B@@@@@@@@@B@@@@B@@@@@@@@@@@@B@@@@@@@@B@@@@A@@B@@BB@@BBBBBB@@BB@@B@ABFBBBB@BB@@B@@@ABHDBA@B@@BAB@AF@@@BBAB@@AB@@AB@@@@@@ABAB@@@@@@A@@@@DA@@@@@@B@@A@@BA@@@@F@DBBDDE@@CA@@JGBCFEFCA@BCB@BABADABABAEA@@@@@@@@B@BAB@@ABA@@@@B@@A@@BA@@@AB@@@BA@AB@@A@@IEDAA@@@@@AC@@@@CAA@@AAABAAAB@B@@@B@BA@@@BBA@@D@B@@A@A@@A@A@AAA@@@A@A@AA@@AAAA@@@@@@A@@AAAA@@A@@@@@@@@@@A@@@@@@A@@@@@@A@@A@@@@AAAAAA@@@@AAAA@@AAAAD@H@F@F@@@D@@@HAJ@D@B@@@J@D@B@B@F@@@F@BADEBC@@AA@A@@AC@C@A@@@@@A@A@@BA@@@@@@@AB@BA@@D@A@A@@@CAC@CAAAA@ACAA@C@@AA@EAABACC@@AA@ACEA@AEAAAA@@CC@@AAACAC@@@@CCACAAACAAAA@AA@GE@AECAAIG@@CAAAAA@@AA@@CA@AEA@@A@AAA@AACA@@EAAACACA@@A@@@@@"],encodeOffsets:[[128250,44897]]}},{type:"Feature",id:"220103",properties:{name:"宽城区",cp:[125.326581,43.943612],childNum:1},geometry:{type:"Polygon",coordinates:["@@BC@@@@@A@A@AB@BC@@@@@@A@A@@@@@IB@@@@A@@AEC@@E@@BCD@@@@CA@@@@CCAA@@CA@@AACA@@AA@@@@A@A@C@@@@@@@@@A@G@C@CAC@@@@@@@A@@@CBEBA@GD@@@@@A@@@@@@@@@@@AA@ABBB@FBB@@@DBBBDB@BBDBD@DB@@B@B@C@@@ABA@@B@@@@@@AB@@@B@B@@@@@B@DBD@@@BBB@@ADCFABE@@@E@A@A@C@I@@@A@C@I@GB@@C@@@E@E@G@C@BBBB@@BBBB@@@@BBBBBB@@@@@BB@@@@@@@@B@@@@B@@@@@@@@@@@@BB@BB@BB@@@@@@@BBBB@@BBB@B@@@B@BBB@B@@@@B@BA@C@@@AB@A@@ABA@@@A@A@BBABBB@BB@DB@@@@BD@@@@B@CBJF@@@BA@@BAB@@A@@B@@AB@@@BA@@@@@AB@BA@ABA@@@@@@@@@FBABABCBABABA@ADB@EDEFADIH@@DB@@CFACCAE@@@@@AB@@@BA@@@@@@@CB@@@@@B@@@@A@AB@B@@@@A@@BA@@BA@AB@A@@BEA@AB@@@AABGCBA@@A@@@AAA@AAEABAA@@@AA@@AAAAAA@@AA@@@AB@@@@@@A@@@@@@A@@@@@@@@@@@@@@A@@A@@@@@@@@@A@@@@@@@A@@@@A@@@@@@@@A@@@@@@A@@A@@@@AA@@@A@@@@@A@@@A@@@@@@@@AA@@@@A@@@@@@@@@A@@@@A@@@@A@@@@@@AA@@AA@@@@A@@@@@@@AA@@AA@AA@AAA@AA@@A@@@AAA@AA@@A@A@@AA@AA@@A@@AA@@A@@@@@@@A@@@@@@A@@AB@A@@@@@@AA@@@A@@AA@BACCA@@@AA@@@BA@A@A@@DC@ABAAA@A@AB@BAB@BA@AD@B@@@@@@@@AB@@@@@B@@@@@@CBAB@@@@AB@@@BAB@B@@@@@B@@@BA@@B@@@BA@@@@B@@A@A@EC@AA@AAA@CA@@CCB@CACAAFEA@@KA@@AB@@A@@BA@ADA@A@@@@@@BBBB@AB@BB@DDAB@B@@CD@BAB@B@@@BD@BBB@B@@@BBB@CB@@AAA@@@AB@BA@ABEFIHA@EA@@BAB@@@C@G@A@AB@BABDBJB@@D@@B@@@BABD@@BC@@@E@@@A@@@AAABB@AB@@B@@@BBB@@B@@EC@@A@A@@@@@@@B@@@HF@@IE@@A@@@@@@@@@@@B@HFB@@BA@A@@AAB@@A@@@@@A@@@@@E@AAA@@@@@GE@@A@@@@@A@@@@B@B@DCBA@@@AA@ABACC@@@@A@@@A@@@@BAA@@A@@@ECHEAAED@@DCAAED@@HEECEEDAAAC@@@A@A@@AAA@AA@B@@ABAC@@A@ACABA@@CA@@CCABAA@@BA@BBAAACAA@ADCAA@@BAB@@@B@@@@AACAKHEA@BAB@BC@ABAA@@@B@@@@@BA@AB@@@@@@ABA@@@@B@@BB@@B@A@@B@@BB@@@BB@BB@@@BA@@B@@@@@B@@@BB@AB@@B@@BB@@BB@@@A@@@ABA@@@@@@BB@@@@BA@B@@B@@@@@BA@@@CN@B@B@@B@@B@@@@AB@@BB@@DB@B@BBB@@@BA@A@@BA@AB@BA@@@@B@@@B@@@B@@A@@BA@@@@@B@B@@@@B@@ABA@@BC@AB@@@@@B@@@@B@@@BB@@@B@@A@A@@@@@@B@@@@@B@@@B@B@BAJ@LAH@L@DAD@@@J@D@BCB@B@B@B@@@B@@@@@BA@CBCF@@@B@@B@@@@B@@AB@BA@@@A@@@A@@B@@@B@@@@@B@@@B@@A@@@@@A@@@AB@@@BAD@BAB@@AB@B@@A@@B@@@B@BABA@@B@@@B@BB@@@@@A@@@@B@@@@@B@@A@AB@@@B@@@@@B@@A@AB@@@B@@@BB@@@@@ABA@A@A@@@@@@B@@@B@@B@@@@@@@ABA@@BB@B@@B@@\\BTGB@LCJCNENVnAdML@DB@@HHFAFADBB@HALAFADDJCCCB@BABABA@@B@@@BBBBBBA@@B@@@@B@D@DBHDBABA@BDCB@HEFAAAHCFBB@AHJBBCB@@A@@A@@@@A@@DA@@@@@@AAB@@AB@@@@AB@B@LB@@B@@ABA@A@@@@GACAFARHHDDBFBB@HBLB@@@BHDJDFDD@D@B@B@B@BA@@@A@@BA@@B@BA@@BA@@BA@@@ABBBA@@@@@@AA@@BA@@@@@@DB@@@BDB@@A@@BAB@BB@@@@@BABA@A@@@@DBB@@@DB@@@@@@@@AB@@@@A@@@B@@BB@@@@@@B@@B@@BB@@BA@@@A@@@AA@@AA@@A@AB@@@@AAA@@@@B@@@B@@@@B@B@@@B@@AB@@@@@@B@@@@@BB@@@A@@@A@@@A@@@@@AB@@@@@@@B@@A@@B@@@@B@@@@@@@BAB@B@B@@@@@@@B@AB@@@BA@@@@@A@@AAB@@@@A@@@A@@@@B@@@@@B@@A@@B@@@@@@BBA@@@@@@@A@@@AA@@A@@B@@@B@@@BB@@B@@A@@@A@@@@B@@@B@@@BA@@@@@A@@@@AA@@@@B@@@B@@@BBB@@BBB@B@@@@@@B@@@@AB@@@@@@A@@@A@@B@@@@@BB@A@@@BB@@@@@@AB@@A@@@AB@@@@B@BB@@B@@B@@@@@@@@C@A@@B@@@@@@BBB@B@@@B@@AB@@@B@@@@@B@AB@@@@@@A@@@AB@@@@BB@@@B@@AB@@@@B@@@@B@@B@@@@B@@@B@@@@@B@@A@@@A@@@@@@@BB@@BB@@A@@@@@@B@@@@@@B@@@B@@@@@AB@@@@@@A@@@@@@@A@@@@@BB@@@@B@@@@@@@@B@@ABA@A@@@@@@@@B@@@@B@@BB@@@@@BA@@B@@@@@@@@B@@C@AB@@@BB@@@B@@@BABA@@B@@B@@@B@@ABA@@@@@BBB@@@@B@@A@@B@@@B@@@B@@@@@@@@A@A@A@@@@@@@@B@@B@@@AB@@@@A@AA@@A@@B@@@@@@@BB@@B@@@@@@@BA@@@AA@@A@@@@@@@@B@@@B@B@@@@B@B@@@B@@ABB@@@@@@@@@B@@AB@@@BA@@@AB@@AB@@@@@@B@@@@@@@BBB@B@@@B@@B@@@@@B@@@@@@B@@@@@@@@@BAB@@@B@@@@@@BBB@@@@B@B@@@@@@@@B@@A@@B@@@B@@A@@@A@@B@@@@@BBB@@B@@@@@@A@@@AB@B@@@@@B@@@B@@@@@@@@B@@AB@@@B@@@@AB@@A@@@@@AA@@A@@B@@AB@@ABA@A@A@@@A@A@@@@@CAA@@@@@A@@@@@@B@@@@@B@@B@BBB@@B@B@@@B@@@@@@ABA@AAA@A@@@@BA@A@@@A@@@@@A@DBBBF@@BAB@@@@B@BBB@@@B@BAD@DBD@@@@B@BABB@DBB@A@AB@BA@E@@FL@@BDBBA@A@A@@F@D@D@B@D@@FB@D@B@B@@@B@DAB@B@BB@@B@B@J@@@@CBCHBB@@CBBFBB@B@@BHBHBDBDBD@@@@B@BBB@@@@@@BAB@@ADBDBA@@B@@DBD@BBB@@BA@FBHD@@A@BBHDFBHDDBB@B@B@B@FB@C@@@DB@@@@CB@BCA@A@BE@C@AFAF@A@@A@A@@B@HBB@B@@BHCHCAADAAAD@@@AB@BDBDC@@DDBBBBD@DFDBBB@BBB@BBBBDBBBBBB@@AD@BC@@B@B@DABB@BGFBEHB@BAB@FE@@DBEF@@FEB@EFF@@@@A@@F@@ABBCHDB@@B@B@@GDB@BH@CBBDBBBBBBPF@BA@B@@BBAHD@@D@@@@@B@DB@AB@B@BBDBDCDABCBBD@AB@BDAB@@@B@@B@B@B@B@B@BB@B@BA@B@@BB@BD@@CAA@C@AFDBAB@B@B@DBB@B@@@D@D@B@B@@@BABABAB@B@B@BAB@@AB@@A@@@C@AD@BA@@@@@@@@@@B@@@@@B@B@D@DADADADADAB@D@BBBBFBFDF@FBB@B@DBBB@@BBBABABA@@@CAAAA@AAC@@@C@E@@@AB@@@B@@ABA@@B@@A@@B@B@B@@@@@BB@@D@CEB@D@@@BBDBBADBDABBD@B@B@B@B@B@@@B@BABABAB@@A@AAA@@@A@@@A@A@A@A@A@@B@D@F@B@F@B@D@D@DBB@F@D@B@DADADAB@@AB@D@D@B@B@@ABA@A@@@@@@@@A@@A@@@A@C@AAA@@@@A@@@@A@AAAAA@AAA@A@@@@@A@@@@@@@@@@@@@A@@@@@@A@@@@@@A@@@@@A@A@A@A@@@AB@@A@@BAB@@A@@@A@@@AAA@@AA@AAA@@@AAC@@AAA@@A@@@@AA@C@G@A@@A@BA@@@@@@B@@@AA@@@A@@@@@@@@@@@@@A@@@@@@@@@A@@B@@AB@@A@@@A@@@@@@AA@@CCBAAAAAA@@A@CBA@ABAAAAA@A@@BA@AB@BC@@@@@ABA@AB@@@B@DABA@A@A@AB@@A@ABAB@@@@@@@BAB@B@@@@ABAA@@@A@A@@A@A@@@@@A@A@@@AB@BA@@@@@@B@@A@@@A@@@AB@@@@A@@@@@@BA@C@A@@@A@@B@@A@@B@@@@A@@@@@C@@A@@AA@A@@A@@A@@A@@@A@@@@@@AAA@BA@@B@@@@A@@B@BAB@@@B@D@@@@@@A@@@A@@B@@@AA@@BA@@@@@A@AAA@@@A@@C@AA@@@@@A@@@@@A@AACAAA@ABAA@C@@@@A@@@@@EDABABCBAFAFCAE@@@@B@D@@@@@@@@A@@A@@@@@@@BAA@@@@@@@@@A@@@@A@@AA@A@@A@@A@A@@@EBCBEBCBA@ABA@@D@B@B@BE@E@AA@@AAA@AA@@@A@@EAHC@AEACDA@FCAA@AGCGHI@@@G@A@AAA@GAA@A@@@@@CBCEIBG@A@ABA@A@@@BB@@@@A@ABA@AAA@@@A@@A@@A@AA@@@B@BB@A@@@ABB@@BB@@@A@A@@@AAA@@AABAB@BAB@B@@@@B@@BA@@BCAAA@@@@@A@@@@@@@AC@AA@@@@@@AAC@AA@@@@BA@@BAB@@@AAA@AA@AB@@ACAA@@ABA@CABA@AAAA@AAC@A@@AC@@@A@@@AB@@@@@AAA@AAC@CCA@CA@@@@@AA@@@AAA@AA@@A@@BA@CB@@ABAACAAAABE@CBC@A@BBBB@@@B@@ABA@ABABA@@BA@A@A@@@A@@A@A@AA@CAA@AAA@AAA@GCA@@AAAA@A@DAEKAA@AA@@A@@A@CCABA@AAEAAA@A@AB@FB@@CADADBBEBBBAF@@@@@B@@A@A@CAA@@@EB@@EBE@A@A@C@A@ABCB@BAB@B@BCDABAAA@@BAB@@@B@BAAACCA@@AA@ICBA@ABBD@@BB@@A@@@A@@CA@@@ADEBAB@@E@@@ABG@@@A@@@@BCB@@@@A@A@@@@@B@D@B@@@@B@B@@@@@BA@CHDHB@@D@@@DBBD@@B@B@@@B@BBBBBB@@@@FA@BF@@@XCBBB@@CHBD@@HD@AEF@D@@A@@@@@@@A@@@A@A@AA@@@@@@A@@@A@@@AAA@A@@AC@CAA@CAA@A@@DCBCBA@@@@@@@A@ADAA@@AAAA@@AD@BAHB@@ADD@@@B@DAA@ACCCA@B@@@@AB@BA@@BC@AAAF@F@BAHB@AB@DBDB@@B@@AB@B@B@@@B@B@@@BAB@B@@ADA@@@@B@@@B@@@D@B@B@@@@A@@B@B@@@BA@@AA@@@ABA@@@AB@@AB@B@@@@A@A@@@A@A@A@AA@@A@AA@@AAA@@A@@AA@@@A@@A@@@AA@@A@@@@@@AA@A@@@@@A@@@@@@@AAA@@AA@@@@@A@AAA@@@@@A@@@@A@@@@@A@A@@AA@@AA@@AA@AA@@AA@@AACAAACCAAA@@@A@@B@@A@@B@@B@@BB@@@@@@B@@A@@B@@AB@FAB@@AB@BA@@@A@A@A@@@AB@B@B@@A@@@@BA@A@E@A@A@A@A@@@A@A@@@A@@@C@@@CAA@@BA@A@@@C@A@A@A@@@A@A@C@A@ABA@ABABABA@CBACAAB@@A@@@@@@B@@@@AB@@@@@@A@@AA@@@@BA@@B@@A@@@@@AB@@AAA@@A@AA@@AAC@A@@BA@@@ABA@ABA@CBA@ABCB@@A@@@GCICQGABIEAADCDGE@E@A@A@ADMKA@AFA@EA@B@H@FGHHBNB@POAYACBGHCDCHCDEDADABAB@@ADAB@@CAA@AA@@A@A@AAA@@@@AA@@@@@AA@@@@@@@@BC@@@A@A@@@A@@@ABABCB@@A@@BAAA@@@AAC@@AAAA@@@A@C@G@A@C@O@GAA@@@@A@G@@@@@@AAA@AA@@ACAA@A@A@@AA@A@@@B@B@@@DA"],encodeOffsets:[[128361,44964]]}},{type:"Feature",id:"220104",properties:{name:"朝阳区",cp:[125.288254,43.833762],childNum:1},geometry:{type:"Polygon",coordinates:["@@BABA@AA@@@@ACA@@@AA@A@@@@A@A@@IDAA@BA@@@@AAA@@@@ADA@FFABAE@@A@ABBDA@AAAA@@AAA@@BA@@K@B@@AJA@@EC@C@@AJ@@AI@@@@A@A@A@AA@A@EB@@@@@B@BAB@B@BAB@@@@C@C@BCBAGCABA@C@@B@B@B@@@BC@ABAA@DGA@@AAA@BA@A@AABA@@B@@@@AB@@A@@@@@@BA@@@@@@@@@AB@@@@@@AB@@@@@@A@@B@@@@A@@B@@@@BB@D@B@B@D@B@@@@A@A@@@AF@B@B@@ABA@CB@BAB@@BB@@B@A@@@A@@B@@@@BBB@B@@@@@@BA@A@@@A@@BA@@B@@@BB@D@@B@@@BAB@B@@@@BBBAB@B@@@AB@@A@@BBBD@@B@@BB@@@@B@@@B@BB@@A@@@A@@B@@BBBB@@D@B@BBDAB@B@B@BBAB@@@B@@B@@@BA@AB@@ABBB@B@@@AB@@A@@B@BB@D@@@@@BBB@B@D@@FFC@@@@@BB@AB@B@@AB@@@B@B@BA@@B@@@@A@@@A@A@@BBB@@@BA@@B@B@@@B@B@@A@@@BB@@JD@@AB@B@@@@@@@@A@A@@BA@@@M@A@A@@D@BDBFBABBB@@AB@BA@@BA@@@@@@B@@@BB@A@BB@@@@@BA@AB@BABA@AB@B@B@@@@B@@@B@B@B@@@D@D@BBB@B@FBDAB@@A@CB@B@@BB@F@JDB@@BD@@B@@@@@@ABAAC@ABAB@@@B@@BB@@@@ABB@B@A@@B@@E@@D@@@BA@BB@@@B@BA@AB@@AB@B@@ABB@ADA@ABA@DDB@@@@F@FB@B@DBB@BDBBCBBB@B@@@B@@@BA@@@@B@@@@@B@@@BAD@BA@@AA@@@AB@@A@@@AA@B@@@B@@@@DBD@DBDB@BBBDB@@B@BI@@D@@B@@@BA@@B@B@@@B@@ABB@@@B@D@B@ADCA@BFBBB@@@@BBB@@@@@ABA@A@@BABABAB@@@BA@@@@B@@B@@@B@@@@@@@@@A@A@@BA@@@@@@BB@@@B@B@@@B@@BD@@@B@@@@@B@@BD@B@@@@B@@DBB@@BBB@B@@BBB@@B@@B@@B@BD@@B@@@@@@@@@@@@AFDBADGA@@@AA@AA@@A@@@@F@@@BB@@DAA@B@BAD@BA@A@AD@@@FA@@BB@@B@@@@@@@BB@B@A@@BAA@DABABCBC@@@AB@@@BA@@@@@BD@D@@A@BDEBBB@@@B@@B@@B@BB@B@HDD@PD@@BB@@@@B@JBDBB@D@@@@B@@@BABCD@@C@AB@@@B@@B@@B@@@BA@CB@@C@@@AB@@AB@@A@@@A@AB@@@@@@AB@@AB@@ABA@A@A@A@A@@@@FAJAF@@@B@@F@@B@BAB@D@BB@@@FE@AB@@@HDBBD@@BB@@B@@@@AB@@ABC@CBABABA@@@@@AB@@A@AB@@@@A@@@ABA@ABA@CBCBCBA@@@@B@@A@@@@@ABA@@@CDA@AB@BA@CB@@@BA@@@ABE@A@CBEB@@A@ABA@ABCBABA@A@@BA@@@@@@BA@@@A@AB@@@@EC@@A@A@@@A@AB@@@@A@@@@@AA@@@AA@@AA@@BABCBA@A@A@@@ABAB@@EDABCBA@A@@@AAA@A@@BEDA@@@@@A@@@@@A@@@CDCHABCDCBIDBB@BB@@@@B@@BB@@@BMFGDC@@@AB@@A@@BA@@@A@@@AB@@A@aOAF_CaC_@MDTRZDAFBBBEDBAFB@BAFBAHFA@AB@@EDDIJJDDBFFHFBBHHDBFFDDB@@@@BDBBBDBFDFFBB@@@@DBDDDBBB@@DDD@BBBBBB@@B@DD@@JHFD@@@@@@@@@@B@@BFDBBBBDBBBFDBBBBDDB@DD@@DDBB@@BBB@BB@BBBBB@@B@@B@@BBDDDBDDFD@@@@B@@@DBDBBBFB@@DBBBB@BBB@@@FB@BDB@@BB@@BBBBDB@@JHBBFD@BHFB@@BBBBBBDBBBDDD@@@@BDBDBB@@DD@@BBBBBFB@DF@BBB@@DDB@@B@@@@@@@@@@@B@@@@HCB@FADA@@B@@@@@@@D@DBD@H@B@@@@@@E@@@@@A@@@@@A@@@A@@@@@A@@@A@A@@@A@C@@@A@C@E@@@@@@@E@@@A@@@A@A@A@A@A@A@C@A@CB@@@A@@ABA@C@@@G@A@@@@@E@A@@@@@@@@@C@C@@@E@@@@@A@A@C@@@@@@@@@@@@@G@A@@@@@EA@A@A@@@E@@@@A@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@B@@@@@AA@@A@@@AA@A@@A@ACC@@@@B@D@B@B@@@@A@@@@@C@@@@A@@@@CA@@A@@A@@@@BA@@@A@A@@@@@@@@B@@@B@@@@A@@@@CAGA@AE@CAEAC@@@@AGAEAIA@@EAA@CAEAA@@@A@@@@C@C@@@G@@@C@A@A@ADABA@A@@@@ACAA@AAAB@BA@ABA@@@@KCCA@@@A@A@@@ABA@AB@BA@C@ABCBABAACDG@AB@UE@C@@@@A@A@AA@@@C@@B@@A@AB@@CBA@@DD@EB@@AF@@@H@@AHBD@J@@@M@@@PB@@@B@@@@@@@@B@B@@@B@@DBD@A@@B@B@DJBA@@BA@@B@@@@@B@@@JF@@@@@@@A@@B@@@@@B@B@@@B@EDDBBB@@B@BB@@B@@@DCDBB@D@F@B@@ADDD@B@D@D@BAD@B@BA@@BAB@B@@@@AB@BABBD@@@BAB@@@BA@ABABABA@@DA@@@AB@D@D@@@F@@A@A@@@AAAAAA@CAAA@AB@@AD@B@@A@A@@CAA@AABABA@ABA@ABCA@@AAA@@@@BAB@J@B@BA@@@C@A@A@CA@@AAA@@CACACAA@ACA@BAD@B@B@@@BABA@@BAB@BA@A@@A@AAA@A@@A@A@@@@@@@A@@B@@@@A@@@@EA@B@B@@A@@@A@@B@@@@A@A@@@AC@@@@BAA@@AA@A@C@AA@ACAAC@@AA@@AAAA@A@@AA@@@@BAB@B@B@@AA@@@AAAAC@AAA@AACA@@CA@AA@@@@@AA@@B@AAAA@@@@@@BA@@@@@@@@BA@@B@B@@BB@@@@BB@@@@A@A@AA@AA@@@A@@B@B@B@B@BB@@B@@@B@@A@@@@@@AAA@@@@A@@@A@@A@@@@AA@@@@@A@AA@@A@@@GCBCA@B@@AB@@@@@B@@ADBB@@@@@@@B@@@@@@@B@@@@@B@@BBA@@A@@@AAAA@@@A@@BA@@BA@@BA@@@A@@@BCA@@AA@@@@DA@@AA@ACEB@BABA@@B@BBBBB@@@BA@AA@CAA@AA@@AAA@@AA@A@@AAAA@CACAAA@@@A@@B@B@BAB@B@@@@@B@@@BA@@@AAA@@AA@@AAAAAAA@@@@@@@@B@@@@AA@@A@@A@@@@B@B@@@B@@@B@BB@@BB@@@B@BB@@AB@@@B@B@@@@A@@@AB@@@A@@@BA@@@@AAG@A@A@@@A@A@A@@@@ABAA@@@@@A@@@@BAA@@AB@@A@@@@A@AB@BA@ABABABADA@@@@B@B@B@B@D@BABABABAAAAABA@A@A@@AC@A@A@A@CGC@C@ACCBABA@@@AA@@AAA@EC@B@@A@CAA@AE@@CA@CADABA@C@@AAEA@CAA@C@@A@@A@@AC@@AABCA@@@D@AEB@BCA@@A@A@A@A@A@A@C@AAA@AAAAACAAA@AAE@C@@@C@AB@BA@@@@@A@@@CAA@@@@@@@@@@@A@A@AAAACAAAAAA@@@@@A@AAA@@@A@A@C@A@C@A@@@@B@B@B@@@@@D@@@BBDBBBB@LDJBHDABCFEF@@BB@@BB@@@@B@@@@@BA@@BA@@HDB@@AD@D@B@BAB@BA@AB@D@B@@A@@AA@ABC@@BCB@@@B@BAB@BABAAA@CB@BABA@@@@B@@BB@@@@@@@@@@A@@@@A@@@@@@AB@@@BB@A@@@@@@@A@@@@B@@@@@@BB@@@@A@@@@@@@@B@@@@@@@B@@@@@@C@AB@D@D@B@@AB@@A@AB@@AC@@AB@@A@A@@B@BB@@@A@AAAB@@@BABABABA@B@BBAB@B@@A@@BA@@@@@A@ABA@A@AB@@A@A@@@@AAA@A@BAB@BAB@BA@@DA@@BAB@@@B@@@@@@A@@@@A@@@A@@@A@@@BG@@@@A@@@@@ABAABA@@A@A@@@@A@@@A@@@@@A@@@@@AA@@A@@@@@AC@@AA@@B@@AD@@C@C@GBAB@@@@B@@@ABA@@@A@@B@@@B@BI@CA@AAAEA@AEAA@BA@A@AAACB@B@@CC@@AD@AABGCBCEBE@CABA@CA@@CI@@A@@AAA@@@CB@@@A@@A@@@A@@A@A@@@A@@BA@@@@CAAAE@@CAA@@A@A@@@@@AB@@A@C@@@A@@@BB@@GACA"],encodeOffsets:[[128314,44497]]}},{type:"Feature",id:"220105",properties:{name:"二道区",cp:[125.374327,43.865577],childNum:1},geometry:{type:"Polygon",coordinates:["@@@D@B@BAF@FA@@F@@BB@D@B@BA@@@@@E@ABAAAFCACBDB@@EAA@@B@BBBFBBBB@BADDB@@@@BB@@BBBFLCBB@B@BB@BB@HDB@BBB@BBB@DBB@@B@B@BB@@@B@B@B@@AB@BABAB@BA@@@A@@AAAAB@D@DAF@BABBDBBBBA@@DAB@@AB@@@BBB@BB@@B@@B@@@@DBB@DDD@BBB@BB@@@@A@@B@@@B@@BD@@@BBD@BBBBBB@BA@DAB@BB@DB@BA@@BBBB@BB@@A@AB@@AB@@@@BBD@BB@@@@@@BBD@@B@@@@@@@B@@@@BBDB@AB@@AA@@@@@@ABA@ABABA@BB@BB@@B@B@@@A@@AA@BA@@B@A@@A@A@@BBB@@@@BB@@@B@BBB@BAB@@@@@AA@@B@B@BAB@H@JADFDA@@@@B@B@HBB@BBB@H@@@J@HGHD@BBBEDB@DCFB@BGDFB@@@B@@BBB@BB@@BBF@F@@A@A@A@CB@BAB@DAFADAFA@@B@B@@@@BB@B@@BB@@@@@@B@@@@@@@@BB@A@@@@@@@BB@@@@@@@@@@C@A@@F@DBBEBEDABABAFC@@@@B@@@@@@DBBBAB@BBBD@B@B@@@@@B@@@@BBD@D@D@B@NDB@B@BBBBDB@@B@B@B@@@B@BBB@B@D@@@BBB@@@B@A@@A@@@@BAB@@BD@D@D@@@B@B@@@B@B@B@D@B@B@@@@B@B@DHBFBD@B@BB@B@@BBBB@@@B@BBB@@BD@@BBBD@B@@BB@@@@DJB@HDHDFBND@B@BABABB@BB@@B@@@@@@B@@@@@@@@@@B@@@B@@B@@B@@@@BB@@@@@B@@BB@B@@BB@B@@@BBB@@@BBB@@BB@@@B@BB@@B@BBB@@BB@A@@@@@A@@B@@A@@@@B@@@@@B@@A@@@@BA@@@@@AB@@A@@@@B@@A@@@B@@BDBABA@@BA@@@@@A@AB@@@BB@@@BBB@@@B@@@@BAB@@@BABAB@B@@DB@@@@B@HB@BB@@@AD@BBD@@ABADAB@BAB@B@@A@ABA@AD@BA@B@BB@@B@@@@@@@@B@@@BA@@@B@@@@@B@BBB@B@@@@@@@@@B@B@@@@BB@@B@@B@@BBAB@@@@BCDAD@@B@B@DGB@@@@B@@AB@@@@B@@@B@B@@@BB@@@@@A@ABABE@@@AB@@@FBFB@@BA@A@@@@@@@@@@@@A@@@@@@@@AA@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@A@@@@@@B@B@BAB@B@DBAA@@AA@@@A@AA@@A@@AA@@@AA@@C@@B@B@DBBBBBBBBBBBD@B@@BB@@A@@@A@@@@B@BBB@BA@@@AF@@E@A@AB@@FB@@@@@DBBBB@D@@@@@DBDB@@@@BB@@FDDBDBDBBADB@@@EACB@B@@@B@B@B@@@BAAAAACCAA@CBAB@F@DABABAAAC@A@CBE@C@A@AA@ABAHAFAB@B@@CACAA@AA@C@A@C@C@ACAA@A@ABA@C@AAC@ABABA@@BBBBDBBBD@B@DC@@@CCAAA@AA@@@AAABC@A@@@AAAABA@CFAB@D@D@@A@A@AA@ABC@AACA@A@ABAHABAAAAAEAA@CBABA@CA@A@A@AB@BABA@AACCAAA@ABAHABADCB@@E@E@A@A@ACEEG@ACA@CA@@AAA@A@@@CB@D@DBB@F@BA@@AAAAA@AAA@@A@ABA@ADCBABADCDADADA@C@C@ACAC@C@A@C@@@ACBADAB@J@D@@A@AA@CACC@A@A@AHEBA@@@CAAI@ABC@AABADAB@@A@AA@@CB@FA@ABCACAACAABC@CDABE@E@CAA@@A@ABAF@D@@ABABC@A@A@A@@EAA@@A@CAC@@GAAA@AAG@AAAC@AACC@ABCDABBDBBBBABAAG@AEEAA@AHGDEHCDCBC@AAACAAA@A@EACACAAA@AA@ABA@ABA@M@AAAAA@CA[@A@G@aD@D@B@@@J@F@B@BBB@B@DBDB@@BAB@B@B@F@B@B@BAHAD@B@B@B@B@@@B@BADADCHCHGFEFEFGFGBCBC@E@GAE@EAC@C@A@EBEBCBCDABCBAB@@A@@@EAC@CAA@CAAAE@AAEACCEAACEACAA@AAA@@@AAAAC@@CACACAA@AA@@@@@A@@D@D@B@@AB@@@BA@@@A@AA@AA@@@CAA@@@A@@B@B@B@BA@ABA@@BAB@@A@@@@@@A@@@A@@@@@AB@@A@@@A@@A@@AAA@A@@@@@@A@@@AB@@@@@@AA@@@A@@AA@@@@@@A@@@@BAA@@A@@@@AA@@@A@@@A@ABAB@@@B@BA@@@@BAA@AA@A@A@A@A@@@A@@@@AA@@@@AACAA@A@CAA@@@BCDCB@DABAB@@AA@AAAAAA@@BCA@A@AA@@@AAA@AA@ABCB@@AAAAA@A@E@C@E@C@@AA@A@AAG@C@@AAAAACAAB@@@@@@@@@@BB@@@@A@@B@BB@@@A@@@A@@@@BA@@@@@@@BBB@DB@@ABDBA@@BB@DBB@@@AB@B@@ABA@ABABIA@@@@@AGA@@BC@@@@@A@@A@@B@@AB@@AD@BA@@@@@A@@@BC@A@@@@EACABAB@A@@@@@GBABA@A@ABEBA@@@@@@@@@B@@@@@@B@@@@@@@B@@@@B@B@BAB@@BD@DA@B@B@@@BA@@@@@@@A@@B@@A@@@@BB@B@BB@@@@@B@@@B@@@@A@ECA@AAAAA@@AA@@@@@@@@B@BB@ABAB@BC@CB@B@@@BABABC@A@A@IAABA@A@A@A@ABA@A@A@A@@BC@@@A@A@ABA@A@CBCDABA@AA@A@@A@@@@@@A@@@@@A@A@@@@@A@A@@@@AA@@@@A@A@@@A@A@A@@@C@ABA@A@ABA@C@C@C@A@CAA@A@A@AA@@@@AA@AA@AAA@CAA@AA@@AAA@AA@AAAA@C@CAEAA@@@A@A@C@@@@B@BA@@BC@ABEBC@@@A@@@@AAA@A@AAA@@AAA@AAA@A@AA@@A@@AC@@@AAA@A@@AA@@@@@@@ABA@A@A@@@AA@@AAAAA@@@A@A@A@CBA@@@@@A@A@@@@@@@A@@ACAACA@@A@@@@@@B@@@B@@@BA@@B@@A@@CCACAA@@@A@@@A@AA@@A@@@@AA@@A@@A@@@@@@ABA@A@@@A@A@A@@@@@@@AB@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@A@@@A@@A@@A@@B@@A@@@@@@@@@A@A@@@A@@@A@@@A@@@@@A@@@A@@@AAA@A@@@A@A@EBA@A@A@@BA@@@A@@A@@@A@@@@@@@@AB@BA@BB@@@B@B@@@@A@@@A@@@@@@B@B@BAB@@@BA@@@BB@@@B@B@@@@AA@@@@A@@@@@@@AA@@@@@@@@@@@@A@@@AAA@@@@@@@@B@@@@AB@@@@B@@@@@@@@@@@B@@@@@@B@@A@@@A@BB@B@@@BA@CBC@AB@@@@@@BB@@@B@@BB@@B@BB@BB@B@@B@@@@@BAB@@BBBB@@B@@@@@@@BBBB@@DDBB@@BB@@B@@B@@BB@BBB@B@@BB@@@@B@@BB@B@@@B@@B@BB@@B@@B@@@@BBB@@A@A@@@A@@BA@@BA@A@@@A@@@A@@B@@A@@@AB@@A@@@@@AB@@@@@BA@@@@B@@A@B@@@@B@@@B@B@@A@@@AB@@A@@@@BA@@@@B@@@AA@@A@AAA@@@@@AA@@@@AA@A@AAA@@@@@A@@@@B@@@@A@CAC@C@C@C@@@A@A@@@A@A@A@A@A@@@A@AB@@C@@@AB@B@B@@@@@@@@@B@@A@@@@A@@@@@@AB@@@@@@@@@AAA@AA@AAC@AAA@@BA@@@@@@@@B@@AB@@@@ABAAA@CBC@A@@@AA@BA@B@@B@@BBA@A@C@@BC@A@A@ABC@@@@@@@@B@@A@BB@@@@B@@@@@@@BD@@@@@@@@@B@@A@@@@@@@@B@@@@B@@B@@A@@@@@@AA@@@@@@B@@B@@@@@@@A@@@@@@@@BB@@@BB@@@@@B@@@@BB@@B@@B@@BB@@B@@B@@B@@@DH@BBAB@@@BAB@@@BA@@@@B@@@BB@@@BB@@@BAB@B@B@B@B@B@@B@BB@@BADA@ABB@ABAB@@@B@@@B@@@@@@@@@B@@@@@@@@A@@@@@BB@@@@@@@B@AA@@AC@@@A@A@@B@@@B@BA@@@@AA@@@@@A@@@@B@B@@@@@B@@@@A@@@@@@@B@@@@BA@@@@AA@@@@@A@@@BB@@@@@@@@A@@@@@A@@B@@@B@@@@@@ABB@@@B@BBBB@@B@@@@B@@@@@@ABA@@@AB@@@@@@AB@@A@A@@B@@@@A@@@@@C@A@AA@@@AA@ABA@AB@BA@@BA@@K@@@@@@@@@@@@AB@@@@@@@@@AA@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@B@@@@A@@@@@A@@@@@@@A@@@A@@@@@A@@@@B@A@@A@@@@@@@A@@@@@@@@@@A@@AB@A@@@@@@@B@@AA@@@BA@@@A@@@@@A@@@A@@@AA@@@@@@A@AA@@AA@AA@@AA@AAC@A@AAA@@A@@@A@@A@A@@@@BA@@@C@C@A@@@@BABA@G@MBI@BIYJAA@AA@AAAA@@AAAA@AA@AA@@AA@@AAAAAAAAAAAAAAA@AA@A@@A@@AA@@@AA@@@@@@@A@@A@@@@AA@@@AA@@@@A@@AAA@@@@AB@D@DAB@B@BAB@B@BAB@BAD@BAB@BAB@DABAB@BADAB@BAB@@@DAB@B@BADC@C@A@C@C@C@@@A@A@E@C@G@E@E@C@K@@@@BA@AB@@@DAD@B@@@D@BBB@BABABAB@@CD@B@@@@@DDBBBBBD@@BB@BBBBB@@B@@@B@B@@ABA@@B@@CD@B@@BB@@B@@@@B@@B@@@D@B@BB@@@D@@@B@@ABA@@@@D@B@B@@@@@@@B@@AB@@@B@@BB@BAB@@B@@@@BAB@@BB@H@P@D@B@H@D@B@@BBBB@@BD@B@@BBAB@@@BA@ADAB@B@@@B@@@B@B@@AD@@@@@@@@BB@@@@B@@B@@B@BBB@B@@@BBB@DB@@BABC@@BABABCFCDCDGDCHGDAZBPB@OMAGAHG@E@G@AFBB@BEB@NLBCB@B@F@F@CHCDBBJFBARHJDHD@@B@@@DABAB@DAB@BAB@BA@@B@@AB@D@BB@@BBB@@@BB@BA@@B@@@@@BA@@@AB@@@@BB@@@B@@@@A@@B@@A@@@@@@@@BA@BBBDDAB@BABABAB@BAB@D@B@B@@@B@B@B@D@@@B@B@@AB@DB@@D@@@B@@@B@B@@@B@B@B@B@F@B@B@@A@@B@@@@A@ABA@@B@B@B@@@B@@ABA@@BA@EBA@@@AB@@@@A@@@@A@@AA@@@@AB@@@@AB@@@B@BBDDBBDBBB@@BB@@BBB@@BB@@BB@@BB@B@@@@@B@@@@@@B@@@@BB@B@B@@@@BB@@BB@B@@@@@@@B@@@@@BBB@@@@@@@BB@@B@@@BB@@@B@@BB@@@BB@BB@@B@BB@@B@B@B@B@@@B@B@@A@A@@BA@@B@@AB@B@@BB@@AB@@A@A@@@@B@@A@A@C@@@A@@@A@@@@@CB@BA@A@AB@@A@A@@@A@A@A@@BA@@@CACAA@@BGAABE@E@BB@BAD@@ABA@@B@@A@B@DDBDB@CBA@@@C@BC@@GAABC@@BB@BB@BB@CB@B@B@@@@@@ABADCD@@@BBB@DBB@DBD@@@BBB@B@@@B@@@B@@@@B@@B@B@B@@@B@@@@@@@BC@E@BFC@@GC@GA@DA@AAWD@@E@@AEB@@@@AAAAAAA@@@A@A@@@ACCA@@C@@@GAGC@DAB@@@@A@A@@@@@@A@C@A@@@@@B@B@@A@AD@@@@@B@@AH@B@@@FA@ABCF@B@@DB@@@B@@@BA@@AC@AA@BABJDB@@BB@DDBBABA@@@A@AB@@BBABCBADA@A@ABA@AD@B@B"],encodeOffsets:[[128436,45094]]}},{type:"Feature",id:"220112",properties:{name:"双阳区",cp:[125.664662,43.525311],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABCBC@CBAAABEBCBAB@BABC@C@CBABC@A@ABEBA@CB@@AB@@@BEF@BABABAB@@ABEDCBABA@@@CBA@CBA@AB@@A@A@I@ABA@CBA@ABAB@@CDKHABIFABCDA@@BABCFAB@@AB@@A@AB@B@@CDEBC@CBCBGBAB@BAB@B@B@BA@ADABABAB@BCBABABA@@@@@@B@@A@@@AB@B@@@@BB@@B@B@BB@@B@@B@@BB@@@B@@@BB@@@B@@@B@@B@@B@D@B@DBFB@B@B@@@@B@@@@B@@B@@@D@B@B@B@@@BBB@BBB@DBB@DBD@@BB@B@@BB@@BB@@@BB@@BBB@@@@BBB@@AB@@A@@@A@ABA@@@@B@@B@@B@@@B@B@B@D@@@B@B@B@@@B@@A@AB@@@B@@@@B@@BB@@@B@B@@BB@@@@@@@@B@B@@AB@@@B@B@@@@ABA@@@@@ABA@@BA@@B@@A@A@C@C@C@AAC@AAC@A@E@A@EACAAAA@@AA@@@ABA@@@AB@@A@@B@@AB@@B@@B@@@BB@@B@@B@A@@BA@A@C@C@A@A@A@A@AAC@@@A@@@A@AB@@A@A@ABA@@@@AA@AAA@A@A@C@C@A@AAA@A@A@A@@BA@@B@@ABA@@B@@@BAB@BA@@@ABA@@BC@AAC@A@A@@BCDA@@BCB@DBBDD@@BBBBFBHD@@DBDBFB@@BB@@@@@@@B@@A@ABCB@@@@@B@@@@B@@BB@@BB@AB@@@@@BA@A@CBA@A@@@A@A@A@ABAB@B@@@B@@B@@@@@B@@B@@@@@BAB@BA@ABEBC@ABA@A@@@CAA@A@@BA@@B@B@B@B@@BB@B@B@B@BA@@BB@@BB@BDBBBDDB@B@@@B@@A@A@A@A@ABABAB@BABABA@ABA@CBA@@@@@AB@@@@ABAD@B@@@@@@@B@@@B@@@B@@@@@@@@@BA@@BA@ABE@A@ABC@@@A@AB@@A@@BA@@@ABA@@BA@A@@B@@A@@B@B@@@B@@@@BBB@@BB@@@B@@@@B@@AB@B@@@B@B@B@@@BBBA@AB@B@@@BA@AB@B@BAB@B@B@B@B@BA@AB@@ABEBAB@@ABABABAB@@@B@B@BBBBBB@B@DBB@@@@B@BB@@B@@@B@BBBBD@BA@@BA@@B@BBBAB@@BB@@B@@B@@BB@@AB@B@@AB@B@@@BB@@B@BB@B@@@BB@@A@@B@@@B@@@@@BA@A@@BABBB@@@BAB@DB@ABA@@@ADA@@B@@ABBBBBAD@@@B@B@B@@@@@BA@@BAB@B@@@D@B@BBD@B@DBFA@@D@@A@A@@@@@BB@@@@A@@@AB@@@B@@@@@@B@JBCDB@JCBDB@@@B@BBDB@@@@BBDBB@B@D@D@@BB@@@BBB@@BB@BBB@@@B@@@@E@A@A@@@ABA@AB@B@@@B@B@BBB@B@D@B@@@B@BB@@@@@@@B@@@B@@@@@B@@@@D@@B@BFABAD@BD@BB@@@@B@@@@@B@B@D@@@@A@A@A@@@A@@B@@BB@BB@@BB@B@@BB@BB@@B@@BB@@@@@@BB@@@AB@@@B@@@B@@@@A@@BA@@@@@@B@@CB@@@@@B@@@D@BBB@@C@A@A@A@@@@@@BD@F@BBA@C@C@DBBBDDB@BB@@B@BBB@D@B@@@@B@D@@@@A@C@@HJAAFA@@@BB@BB@@B@@BB@@@B@B@@@B@@@@A@AD@@ABC@@BC@AA@@A@A@A@A@@@A@AA@@A@@@A@@AA@A@@@A@A@CBA@ABABABAD@@@BB@@@AB@@A@AAA@AA@@A@@@AB@B@@@@@@CBABA@ABC@A@@@@@B@@BB@@@@@@@@B@@B@@@@BB@@@DDBBBBBB@B@BAB@BABA@@@A@@@@@@@AA@@@B@@@B@@@D@@@@ABCBA@A@@@A@@@A@@@AA@B@B@B@D@BBBBB@B@@BBB@DB@@B@D@@@BABBB@@@BBBBD@BBB@D@B@B@B@D@BBDB@@B@@@B@@@@@@@B@BAB@@@@@B@@@DCBA@@DBBBBD@@@@@@@B@@AB@@A@@@@@B@B@@@B@@@B@@ABA@@D@B@B@B@BBD@D@@BA@@@@@@BA@@@A@@@@@@@@BBBB@@@@@@B@@BB@@@@BBDBB@@@B@@@B@B@@@B@@@@@B@@@@@@B@@@@@B@B@@@B@@@@B@@BB@DB@@@B@@@@ABB@@@B@@@B@B@B@@@B@DBD@B@@@B@BA@@@@BBB@B@@B@@@@AB@@B@BB@@@BB@@@@@@@@B@@AB@B@@@BA@AB@B@@BB@@@B@@@BA@@@@B@BB@@@BBB@@BBBB@@@B@D@DAB@@BBBBB@@@B@BA@CBCDCB@@@B@B@@BB@B@@ABABAB@B@@BBB@@BD@B@B@@@B@@BB@@@B@@@@@B@@@@@@@@@B@@@@B@B@@@@B@B@@ABA@@@@@@@@@B@@@BB@@@B@@AB@B@B@FAB@B@@@B@B@BB@@B@@@B@@@@@B@@@B@@@B@@@B@B@@@@@@@@@B@@@@AB@@@@BB@@@B@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@BA@@@@@@B@B@B@@@B@B@BA@@@@@@@BB@@@BB@@@@@BB@@B@B@@@B@@BBBDDD@@@BA@@@AB@@A@@@A@@@@@@@@BB@BDDB@BB@@@@@@@B@B@@@@@B@DAB@B@B@@@B@BBBB@@BB@@B@B@B@BA@@@@@@B@@BB@B@BB@@D@@BB@@@BBB@B@BBB@BB@@BB@B@BBB@B@@B@@@D@FABAD@@AB@@A@A@@D@B@B@@@B@FBDBD@B@BB@BBBB@BB@@BBB@DBB@BBB@@BBB@@@@BBB@B@B@DBB@D@D@D@B@BAB@B@BAD@@@B@B@B@@@B@B@@@@@BB@@@@@B@B@@@@@B@B@@@@@B@@@@B@@@@BBBB@BADCDAB@B@BAB@B@@@D@@AB@B@B@B@BAB@B@B@B@BAJBB@B@D@BABA@A@@@ADAD@@ABABAA@@A@A@@@@@@B@@BB@BBBBB@FDB@@@@@@A@@@A@@@@AAA@A@@A@@B@@@@AB@@@@@@@B@@A@@@A@ACBC@@AA@ABA@A@@@@@@A@@@@@@@A@@@@A@@@@@@@@@B@FABAB@B@BAHA@@@@B@A@ABDBFB@@@@@BAD@@B@@@@@B@@ABC@@BA@@@AB@@@@B@@@@AD@@HB@B@@@@JBBABAB@BA@@@ABA@@A@CAA@@AB@CABA@@CAA@AA@@@@@@B@@A@@B@@@B@@@A@@A@AB@@@@@AA@@@@@@@@@@BADBBBBB@BD@H@BBB@B@@BD@F@D@F@B@B@BBBB@@DABAB@@BBB@B@@BBB@B@AD@@BBBBBBB@@BA@ABCBA@CDAD@@B@DBB@B@DBDA@@@AFABAB@@@@AB@BA@@B@@@BBB@@@BA@A@@@@AAABA@AA@@@@BA@A@@BAB@BBB@@A@AB@B@B@B@@AA@@A@@BABAA@A@@AAAAA@BABC@A@@A@A@@AA@AA@AA@A@@@@@AB@@@BB@@BA@@@@@@B@@@@@@@BBB@@B@@B@@A@@@@AA@@@@@AB@@B@@BA@@@@@@AA@A@@@@@A@@@@A@@@A@@A@@BA@@@@AA@A@@@@@AB@@@@BB@@@@@@@AB@@@@BB@@B@@A@@BA@@@@@@@@B@@@B@@BB@B@B@@@B@@ABA@@@AA@@AA@AA@@@A@@BA@@BA@@B@@@@@BB@@AB@@B@@B@@F@B@@@@ABA@@@A@A@@@@A@@@A@A@@@A@@@@AB@@@BAB@B@@@@@BAA@@A@@@@AAA@@@@A@@@ABABC@A@@A@AA@@@A@A@@FC@@@A@@@@@A@@@A@@@AB@@@@A@@AA@A@@@AB@@@@@@@@BBB@@B@B@@@B@@AAA@@@ABA@@B@BAB@@ABA@A@A@A@@B@BA@AB@@@AACA@@@A@@BABABA@@BAA@@A@A@ABA@@D@B@@@@A@@@@BA@@@ABA@@@A@@@AA@@@ABA@AA@@@@@@@@BA@@@A@ABA@@BADA@ADAB@@@@AB@@@BBBD@@@@B@@@B@@A@A@@@@@@DA@AB@@ABAB@BA@@B@@BBBB@B@B@@@B@@A@@@A@@@@AAA@AA@@@@@A@@B@BA@@@@@AA@@@A@A@@@AA@@BA@@BAA@BA@A@@BAB@@@BA@@@A@AB@@@BAB@@@B@@@BABABA@@@@@A@@AAA@@@A@A@@@AA@@@@@A@@@@B@@@@@B@B@@@BA@@@A@A@@@@AA@@@C@@@AB@@AB@@@BAB@B@@A@@@AAA@@@@AAA@AA@@A@@AB@@ABA@@B@@@@@BBB@BBB@@@BADAD@@ABA@@AAA@@A@@@@B@BA@A@@AAACBC@AAE@@@@AABAB@DADCBE@CBCA@C@ABC@@CBAAAAAAA@CBABA@C@CBABC@A@ABCD@BABAAAA@ACCCAAAAAA@AAA@A@@@C@ABE@A@CA@@@@C@@CAC@A@AA@AB@BA@A@E@@@@AAAA@@C@A@A@A@@@A@@A@@BA@AB@B@B@B@B@B@@@@@@ACAAA@@@AB@@A@@@AAAC@@@A@A@A@A@C@AAAAAA@A@@BA@A@A@AAACAAA@@B@BAFABA@@AAAC@AAAA@GCCAA@AAA@AACA@@@AB@@AB@@C@AB@B@D@D@@A@@@A@@@ABAD@DAD@DAB@@A@A@@@@A@A@C@A@@AAC@ABC@EAC@EA@@AA@CAAACE@ACA@AA@ABA@@AC@@A@@@A@A@A@@@ABABA@@B@B@D@BAB@@AB@@AB@BA@@B@B@D@B@@AB@@@AAA@AA@@@A@A@@@A@A@A@@B@@@BBB@BB@@B@BA@@BA@ABABA@A@A@AAA@@@ABA@@BCB@B@BA@@BA@@@A@@@AACBA@@@AB@B@@E@A@@AAAC@@@AB@BAB@DDB@B@BAB@DCBCB@BAD@DAH@H@B@B@BABAB@@@BB@B@@B@B@B@@@@ACCAABABA@@BA@@DA@AD@DAB@BA@A@C@@@@BA@@B@DDB@B@DABA@@BADAB@B@B@BDB@B@@@B@@ACA@A@C@C@@@AB@D@DA@ACCAA@ADA@@DA@@@ABA@A@@@A@@@@@A@A@@BA@@@@B@@@@@@AB@@@BAB@@@BA@@@ADADAB@B@@BD@HBFEB@B@HH@BB@@DBH@@@BDB@@@A@@@A@@@A@@@A@A@A@A@@@@B@@@@@@@B@@@B@B@@@@@@A@@@A@@B@@ABC@@BA@@@@@@BAB@@A@A@@BAB@@@DABABADA@@@A@@AA@A@A@A@@BABAB@B@@@BA@A@A@A@A@A@@A@@@BA@AB@@@@A@ABA@AB@@AB@@@@A@@AA@@AA@A@@@AB@BAB@@@F@DBBAB@@AD@BADAB@BA@A@A@A@@@A@AB@DCDCB@@AA@@ACC@A@A@A@AB@@AA@A@@AA@@AA@@@A@@@AAAA@@@@A@@A@@@@A@@BC@@@A@@@@AAA@A@A@@@@A@@@A@@@A@ECC@CAA@A@C@AA@@AAC@CAC@A@AA@@A@AA@@A@@AAAAA@B@@@@@B@@ABABABEBEDCB@AC@CACAACC@@AA@BA@@@AB@@A@A@AAAAA@@@@A@@A@CA@CAA@@@A@AA@AAA@@AAA@C@A@E@CAICC@CAAA@@A@@@A@ABABA@@@A@AAA@AAA@C@E@AAA@@@@ABCBA@A@A@AA@A@AAC@G@AAG@AAA@CAECAAAACAA@E@AAA@GAC@C@GAA@G@C@C@A@A@A@AAAAA@CAAACBA@@@@@A@CAA@A@C@A@@@CBABAD@@ABE@C@CBA@ABABAB@DA@AB@@C@CBCDAB@BAB@@@@CBAB@@@B@BA@A@C@CAGCCAEACAA@@A@@AAAAECCAAACAAAACAAAC@@EC@AAAA@C@CAA@AAAAAAC@A@AAEAA@AA@@A@A@CAE@@AA@AACACAC@AAA@C@A@A@A@@@CA@AA@AAA@C@AA@@A@AAC@C@A@A@@@@@A@@@AB@@@@@@AAE@@@A@A@@@@@A@@@@A@@@@@A@@@A@@@A@@AA@@@@@A@@@A@@@A@@C@C@A@CAA@A@A@@@A@CAAAC@EAA@@@@@A@@@@@A@@@@@A@@@@BA@@@@@A@@BA@@@AB@@@@@@@@@@A@@@@@A@@@@@@A@@A@@@@@@B@@@@@@@@A@@B@@@@ABAB@@@B@BAB@B@@AB@@@DCBEBIFA@A@CBCBE@CB@@A@"],encodeOffsets:[[128678,44323]]}},{type:"Feature",id:"220113",properties:{name:"九台区",cp:[125.839573,44.151742],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AAC@A@A@CA@@AAAACCCAAACAC@A@A@@@@@@@AB@@AB@@ABAD@B@@@@@@@B@@A@A@@AAB@@A@@@@@@@@@A@@@@AA@@@A@@@A@@@A@@@A@@@A@@@AA@@A@@@@@AA@@@@@@@@@B@@AB@@C@@@@@@D@BA@A@@@@@A@@@A@@@A@A@A@C@@@@@A@A@ABA@A@E@A@A@@F@@@BA@ABADCBADADAF@F@B@DBD@FBF@H@FADADEHEHEFEFGHGDCDCBABA@@@A@A@A@A@C@GBABA@A@E@A@A@A@AB@@CACAA@A@AAA@E@I@@@A@C@C@@b@H@BB\\@DBBBB@B@NAB@BAB@BBBB@BBBDBD@F@BBBDBBB@BADCDGDCFGH@BBBFF@BBHABABAACAAACBAD@BDDBBD@BB@BBH@BBBHB@@BD@D@BB@FB@@@B@B@BADAB@BC@E@AB@B@BB@DBF@F@BADCD@BADBBBBDAD@BEBA@@DB@@B@BA@CBABBBD@BAJ@BB@D@@ABGF@B@B@BDDDBB@@B@BC@I@A@CBABBD@@D@B@D@D@DB@B@D@DCBCBCBCDABABCD@BAB@B@BB@BBB@BBBB@@ABE@A@CAC@A@@D@@@BBB@BB@@DDB@BFHDF@B@B@B@F@FA@CDABGBAB@BBBDBBD@BABABA@@B@B@BDBB@BADAB@FBBBBBABGBAB@B@BDBBBD@BAB@@B@B@BC@C@A@EB@DABBBBB@@B@D@BABB@@B@@BBBDB@D@@CDA@C@AACAAAAA@@ABAB@BBD@B@DAB@B@BBBBDD@D@B@D@B@@BBBBD@DA@A@EBGBAB@BBBB@D@F@DAB@D@BBABABCBE@A@AB@DBBDDBBBBAB@@A@A@A@@@A@A@BD@F@@CAABCACACAEC@@AA@@@@CACA@@@@C@A@AACA@@@@A@@EA@@B@B@FE@@B@@ABA@AAA@@@@@@B@@@BA@@AA@C@AAAAAAAAAACAA@A@@@@DB@@B@@BB@@@BB@@B@B@@BB@@BBCAA@A@ABA@A@@@@@@@@BB@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@B@@@@@@B@@@@@@@@@@@@@@BAB@@EAEA@@A@@B@@AFAB@B@B@@@@AA@@A@A@@@A@@@@@BA@@@A@@A@CHA@A@@@BCDC@A@@A@AB@AA@@@@AA@@A@@A@A@@@@@@@@@A@A@AAA@@@@@A@@@B@@A@@@A@@@@@@A@@@AAA@B@@ABCB@BAB@@@@ABA@ABABCBA@@AC@ABC@@A@@AGAA@@@@@CA@@@ABABA@A@@BA@A@@A@@@A@AA@@A@@A@@BAB@@@@@B@@AB@BACA@AA@@@B@@@@A@@B@@@BA@@@@B@@A@@B@@@@A@@@@@A@@B@@@@AB@@@@@B@A@@AA@AAA@@@AAA@@@A@@AA@AA@@A@AA@@A@A@@AA@A@@AA@@@@@A@@A@@A@@@@AA@@@A@@@@@@@@@@@@A@@@@A@@@AAA@BABA@A@AMCEAGCGCA@CI@@@@AA@@@AACAA@@AC@@AA@A@A@@AAAA@@@AAAA@C@EAGA@C@A@A@@A@A@C@A@A@A@@@A@A@@@C@C@C@@AA@AB@@@@@BB@A@@@A@AA@@C@A@A@AAA@@@A@A@A@@@CAAAAAA@A@MCA@C@C@@@@B@@BB@B@B@@@@AB@@BB@@A@@@@B@@@B@@@@C@A@@@A@ABA@@@@B@@A@@@ABB@BB@@@@@@@B@@@BB@@@@BB@B@@BB@@@@D@@@@@B@@A@@@@BA@@@@B@@@B@DAB@@@@@@@B@@A@@B@@@B@@@BA@@@@@@@ABA@@B@@@B@B@@@@@B@BB@B@@@B@AB@B@@A@A@AB@@@@@@A@AB@B@BA@@B@B@BABCBA@@@A@@BAB@B@@@@ADA@@BAB@@@BBBBBAB@BAB@D@BB@BBBBABDD@@BB@@@@@@@B@@@BA@@BA@@@@B@@@@@@@@@B@@@@@@@@@@@@@B@@BB@@A@@@@@@@ABB@@@@B@H@DBB@@@@@BB@BB@@BD@B@@BB@BBB@@BB@B@@@B@@@BA@AB@@@BA@@B@@@B@B@B@B@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@@@@B@@@@@BBB@BBBBB@B@B@@B@@@@@BB@B@D@B@@@BB@@@@@@@@@@BAB@BA@@@@@@@@@@B@@@@@B@@@@@@AA@@@@A@@@@B@@@@B@@@B@@B@@@@@B@@A@@@@@@@@@@B@@@@@@AB@@@@AB@@@B@@@BBBDB@@B@@BBB@@BFBBBB@B@BBF@BA@@@@@A@@B@FBD@D@F@D@BAB@B@@ABGDEFA@CB@@AB@@BBAFBBABA@@BB@@BB@B@B@@B@@@BADCDA@@B@@BBBDDFDBFBDBDBBBBBBBBH@DB@BBBBDDDFBBBD@B@BADAHABADABAFABAB@B@BBB@BHFHCB@BAD@B@DAB@@BBBF@B@BAB@F@FAB@F@D@D@B@@@@A@@@AB@BFB@BAB@AE@@CGBA@@@@@AAAF@TC@@ABA@BBBBB@BA@@BBEF@@B@BB@@B@@AB@BBB@ABDBBB@@B@B@F@B@BB@BF@BAB@AA@A@@HDF@BB@A@@@@@A@@@AB@@@@@HD@B@@@BDB@CB@@@@AHD@@FAHD@@@@@@@@@B@B@@BB@@@EJDAD@@B@NFHDD@LFDBB@B@BB@BBB@@@B@@BB@@@BDBFBFDDBB@FBDBD@BBB@@A@@BA@@@@H@D@B@@@@@@@@@@AF@@@DAB@D@B@B@B@D@@AB@B@B@@BB@@BBFB@F@D@B@B@BBB@@BBFDD@F@FAJ@L@D@@@DBFBFBD@BD@JDHBFBBBB@@@@BD@@BD@B@DADBHAD@@@@@B@@@AA@A@A@C@ACA@A@AB@DCBABA@@@CA@AA@A@ADA@AAACAEACA@ABAD@@@FDD@B@@A@AAA@@@ABABAD@DABABAJIBA@A@@CBA@C@CCCC@@@ADC@AAA@A@ABAFEB@B@@@B@@@B@@@BA@@@A@@@@B@BABB@@@@@@@@D@F@HAFAD@JAAAB@@A@@BA@CB@B@BC@@@@BA@@BA@A@@@A@@@@A@@@BA@@AA@@@@AAD@D@@@BA@@@@D@B@D@@@B@B@DBDBFBBAFBB@BBB@@AB@D@DB@@@ABAFB@@@B@B@@D@D@B@B@DBBBA@BBB@BBB@@@@@@AF@@DB@DB@@@BAD@BDBDBAB@B@BB@@@BBB@D@@@@@@@L@BAD@BB@@@A@@@@@A@@@A@ABA@A@@FBFBFBD@@@B@@BAB@BA@@B@@@@@BA@@B@@@B@@@@F@@@B@D@@@B@BB@B@BAD@@@@@BBB@B@D@DAD@B@DAB@D@BA@@B@B@B@BA@@DBBABHDBBA@A@H@@BI@@B@@D@B@B@ABA@A@AAC@A@AB@@C@AAC@CAE@ABBD@BA@AB@@A@A@@@A@@@A@A@@@CAA@A@@DAB@@@BB@@@ABBH@@ADABA@B@ADDB@@B@@BB@CBFB@DDB@A@AJ@DAHA@@K@@@JA@A@@JA@BD@FBD@@BBBBABCBB@DD@BM@BD@@@B@@@LAB@D@@GDBF@BA@AIAD@@CJBLBB@B@@@@@BA@@B@@@BAF@B@D@BB@B@@B@@@BABA@A@@@@B@@B@@B@B@@@@@@@@@AB@@@@@@@@@B@@@@@@@BB@@@B@@@@@@B@@@@B@@@@@B@BB@D@@@@ABA@@@AB@@B@@BB@@@@@@@@@AB@@@@B@@@@@@B@@A@@@A@@@A@@@@B@@@@A@@B@@A@@@A@@@A@@@@@@@@B@@@@@B@@A@@@@B@@@BA@@B@B@D@BAB@B@BBB@BDD@@@B@@@@B@@@B@@@@BA@@B@@B@B@B@B@@B@@A@@B@@@BB@@BBDAB@BAB@B@B@D@@A@@@BB@@BB@@@@AB@@@BB@@@@B@BB@@B@@BB@@B@@@@B@@@@@@ABA@@AA@AB@@A@@@AB@B@@A@@BB@@@@BA@@@AA@@A@AB@B@@@@@@BBB@@@@@@@ABAB@BB@@@@B@@A@@@A@@BB@@@B@@@DAB@D@DAB@B@@@@BB@@@B@@B@@B@@@@@B@B@@@@@B@D@B@@@@@B@B@B@B@@BB@@@B@@@B@BB@BBBD@B@D@D@B@JBD@D@F@B@H@BF@@D@BBD@B@BBBBDBDBBBB@BBBBB@BBDDBBBBBDBBBBBBBBB@DDDBFDDBHDFBHFFBDBBBD@BBB@BBB@DBB@B@B@@@@@@@H@D@B@D@DBB@FBB@@@D@B@@@B@BBB@D@@@B@B@BBB@DBFBD@BBF@DBD@B@DBD@B@D@DBB@F@FBFBDBJDB@B@DBDBFBFBF@FB@@F@FBF@DBB@B@@@FBBBF@DBFB@@FBF@D@D@FBH@FAL@VEF@DA@@B@BAB@BAD@DADADAB@BADABAB@BADAD@D@DAD@B@@@D@B@@@DBB@F@HBBBJBF@HBLBDBTBHBFBD@B@N@@@B@JAF@B@V@@@NAB@F@BAN@LAFBHDDBD@DBB@B@B@@D@BDDDB@CBEBGDEDCFCHADAJCLCDAFCBABA@A@GCCACAAC@CAC@C@C@E@AAC@CC@A@C@C@CBC@A@AACCC@A@A@A@A@C@EBCHCBAFGFCFCHIBE@CBAAEACECA@CCKECAACAA@@AA@ABCFCFAFAH@B@FBJBHBPBF@FBFBJ@PEFEDC@C@E@GACAC@GACCI@A@ABCD@DAF@DADCBCBC@AACAAAACCCAAACCE@CAECCAEAMAE@EACA@@CCACBE@ABABADCFCDADAFEFKBCAE@E@E@GAI@EBABCFEBAJAHAJCDADCBE@AACCCIAKEECCCACAC@I@CAGCIGGEEAE@E@EFIDABADCFAHAN@J@JADADADCFCBC@C@AAEACCE@@CAAAA@CAGA@@CAE@E@CACAAACAEEEEACACA@AC@C@C@CBADCBC@A@A@ECCCECACCICCAADA@CA@@C@C@A@AAA@A@A@A@A@@@A@A@A@@@A@@@@@A@AB@@CAA@@@AAB@@A@@@@A@A@@@A@@@A@AAA@A@@@@@A@@@ADA@@@A@AA@@@@A@@@@BBB@@@BA@@@AC@@@@A@@@AB@B@B@@AB@@AD@BA@@@AA@@@@@A@@@@@AA@@AA@@@@@ABA@@@C@A@CA@@@@A@@B@@BD@@AB@@@B@B@@A@@@AAA@C@@@@@A@@B@@A@A@@@@@A@@@A@A@A@@@@@AA@@@AAAA@AA@@@@@@A@A@@@A@@@A@@@A@@@A@@@@@A@@A@@AAAA@@CAA@A@C@ABCBC@AB@@A@EAC@@@A@@@@@A@@@@@A@@AA@@@@@A@@@A@A@@@A@A@A@@@A@@A@@ABEDA@@@C@ABA@ABA@AB@@ADABABABCDA@A@A@A@A@A@A@@BA@A@@@A@@@ABA@@@CB@@C@@@A@A@A@A@C@A@@@A@A@A@@BC@CBCBC@A@A@AA@@@A@CBC@A@A@@AA@@AA@@BC@@@AB@@AD@@@@@@A@@@@AA@@AA@@A@@@E@@BC@CBA@A@@AAAAAAC@AAAAAA@@E@@ACAA@CAC@@@C@@@B@@AB@BA@@B@AA@@B@@@B@@@B@B@@AB@@AB@@@B@B@@@@B@BB@@@B@B@@@B@@@B@B@B@@@BAFABAB@@A@@@AAA@AA@@A@A@A@CA@@A@@@A@@BA@@@A@A@A@@@A@@@C@C@C@A@A@A@A@AAAAAA@@A@@@@DA@A@E@C@A@AA@@@@BA@A@@@AA@A@@@E@A@CBA@A@@@A@@@@AA@AAAA@@A@AA@@AA@@@A@A@@@AA@AA@A@@@AAC@AA@AACAA@@AAA@@@AAA@@A@A@A@A@@BA@ABABAB@@A@C@A@AAA@A@A@@@C@A@ABE@ABA@ABAB@@A@A@@@AA@@A@@@@@@@@BA@A@@BAA@@@@@AA@@A@AA@AAA@A@C@A@EAC@AA@@@A@@@@@ABABA@@@A@@AAC@AAA@A@@@@@A@@A@@@A@@AAB@@A@@@AA@@AB@BAB@@AB@@@@@@ABA@@BA@@@AA@@@A@AAA@CAA@C@A@A@@@A@@@A@@@AAAAA@AAE@AAEA@@A@@@A@A@A@A@@A@@AA@AB@@ABC@AB@B@B@BA@@@A@@@@BA@ABA@AA@@A@@A@AB@@A@@AAAAAAA@A@AA@@@A@AAAB@@@@@@AB@@A@A@AB@@@B@@@BABABC@AB@B@BA@A@A@C@A@AB@@ABABABA@@@C@A@@@A@@AAA@@@C@AA@CAAACA@@AACAC@AAA@@AAAAC@A@@B@@A@AAAAA@AAA@@@A@A@@@@@AA@AA@@A@A@CACACCAAAC@A@A@A@A@@AA@AAAAAA@AAC@AACACAAA@AAA@A@A@@@A@@@AAC@A@A@A@@@CBC@@@@@@A@@@C@A@A@CAA@ABC@AB@@@@A@@A@@@AAA@@A@CA@AA@AAC@A@@A@A@A@C@A@@AAAA@AA@@A@@@AA@@@A@AAA@A@E@A@C@@AA@AC@A@A@AA@@AAA@@AAA@@@@@A@A@A@A@A@AAAA@ACAAC@C@A@A@@AACAECAAA@@@@AB@@@@A@@@@AA@@@@BA@@@@@AA@AA@@BCAA@@AAA@@ABABABA@@@A@CAA@@@@AAB@@@@A@@@AA@A@@@@A@@@C@A@@@@@@@AAAAA@@@C@A@AD@BABA@ABA@@B@BAB@@@@@DA@@B@@@BAB@@BFAAAAC@@BADCDCDA@AB@@@B@@@B@@@B@@@@@@A@@@@@A@@@A@AA@@@@A@@@@@@B@@A@@B@@A@@@A@@@A@@@@@AB@@@@@@A@@B@@A@@@@@AB@@A@@@@@@@@@AB@@@@A@A@@@@@A@@@@@@@A@@@A@@@A@@@A@@@@@@@@@@@@@A@C@ABA@@@@@@B@@@@@@AB@@A@@@@B@@@@AB@@A@@@@BA@A@@@@@A@@@@@A@@@@@@@@@A@@B@@@@@@A@@@@@@@@@A@@@A@@B@@A@@@@@A@@@A@@@@A@@@C@AAA@@@AAA@AA@BCBCA@AA@@AA@@@@@@A@@@@A@@@@@B@@@@A@@@B@@B@@@@@@AB@@A@@@@B@@@BA@@B@@@@A@@B@@A@@B@@@@A@@BA@@@@B@BA@@B@@A@@@@B@@@@@@@BAB@@@@@B@@@@@BA@@@@B@@@@@B@@A@@@@@@@@B@@@@@@@@@B@@@@@B@@@B@CCBA@AACDC@@AE@@@@A@AAACAAAAAA@@@CA@@C@AAA@ABA@@BA@@@@BB@@B@B@@@BBBBB@@@@@@@@AAGAA@@EA@@BCB@@C@ABAA@@ABE@@C@E@G@IEA@C@C@AA@@A@A@KAA@A@C@@@A@E@A@@@A@@@AAA@AA@@A@A@A@A@A@@@A@@B@@@@A@@@@B@@A@@@AAAAA@C@AA@AACAAB@ACACB@@@@@@A@A@C@CGA@@@@@@BA@@@A@@BA@A@@@@A@A@A@@@A@A@@BA@@@EAEADA@@@@@AA@AAA@@@C@C@@@@AA@A@EAAAA@A@A@@@@@E@C@C@C@AB@@@@ABA@ABA@BB@@@B@@A@ABC@@@@@A@AB@@A@@BA@@B@@A@@B@@C@@@A@K@A@CBC@A@@@@@A@@@A@@B@@A@@@@@EBAAC@CAEAAAA@AAA@KE@BDB@@EFECEA@@@B@B@BBBBB@B@BA@CBA@C@@@C@C@AA"],encodeOffsets:[[128906,44916]]}},{type:"Feature",id:"220182",properties:{name:"榆树市",cp:[126.533187,44.840318],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@FAEE@D@B@@@@@B"],["@@ACACAB@DEBA@@BB@B@BBB@BB@AAAAA@@BBB@@CFFB@@@@@AC@B@@A@@@@A"],["@@AB@BB@FE@@BAA@@@CBAB"],["@@E@A@AAC@@@@@@@CBA@AA@@AA@A@@A@@@CB@BA@@CCB@CAB@E@AA@A@A@A@@@A@G@@BC@ABA@C@ABA@@@@A@@AA@BA@A@@@@@CB@@CCCA@AA@@A@BA@@@@B@@A@A@@@@@@@@@A@@@@@@@A@A@@@@@@@@B@@AB@@@BBBDDDBABBBB@@@@BC@BB@@BD@@CDABMBC@@@A@A@AB@AA@A@ABA@@@A@A@@@@@@BA@A@A@@@@AA@BAA@A@AA@AB@BBBBB@BA@@BAB@@@@@@@AA@@A@@A@@AA@@ABABA@A@@@CDA@AB@@ABA@@A@@@@AB@@A@BB@BA@ADAAA@@A@A@A@@@@E@@@@B@D@@AA@C@@@A@B@D@BA@A@A@C@@@@@AB@@AA@@@@A@@@A@@B@DBD@@A@@@A@C@@A@@@AAA@@A@@BBB@@@@A@@@E@CAA@@@@@DFC@A@AA@CA@@@@BA@@@A@C@A@C@AB@@ABA@A@AA@@AA@@AB@@@BA@A@A@A@A@EAA@A@ABA@@DA@BDABAB@B@B@@@B@BHB@B@@ABC@G@A@@@@FA@A@AAABCACB@D@B@BB@@BAB@@B@BBB@@@@@@@BB@@B@@B@@C@AB@BA@@@@@AA@@@A@B@@A@@B@@@@@@AB@@@@@B@@@@@@@@AB@@@@@B@@A@@AA@A@A@@@A@ABA@E@SAB@@A@AB@@CBA@@BE@AB@@@B@FCB@BAB@DEB@AA@AA@@@@@A@A@CBCB@@@B@BA@C@A@@A@@C@@B@@EB@@BDABABA@A@@@A@A@@@@@@A@@A@@@A@A@@@@@@B@@A@@@AA@BAB@@@BA@@B@@A@A@C@@@BAA@A@A@AB@@CBC@AAA@AB@@@@@A@C@@@@A@A@CAA@@@C@@@C@AA@@A@A@A@A@@@A@A@A@@@BB@@A@A@CBC@@ACACCAAB@B@FABA@C@C@AA@C@A@@A@AA@A@C@C@@@A@@BBBAB@@@AA@A@AA@@@ABA@A@AAA@@@A@@@AB@@@AA@A@A@@@@C@@AA@@BABABABCAA@@@@@CBGB@A@A@AC@AAAAFAA@EAA@@A@A@@@@A@@@@BAB@@AB@@A@AAA@@@@AA@A@CACAAAA@A@EHAB@@@@@@A@A@EBA@E@EB@@EA@@A@@@CBEB@@C@KBA@A@A@@@C@A@A@@@A@A@EAA@A@@@A@@@AB@@A@@B@@A@AB@@A@@@@@A@@@A@@@@@A@@@A@@BA@@B@BBF@B@@@@A@A@A@EEEGCCAAC@AC@AAA@@AA@CBA@CBADAA@@@A@@@AA@@A@@@AAA@A@@@G@@@@@@B@@A@A@@@C@A@A@ABA@CAAA@@AAECCAC@AH@R@HAJCBABEDKDIJCBABIDGHEBABEDEFEDEBE@A@K@G@E@C@CDCBCDBBBDDDDDHDFBDDBD@DABA@ABEBIBGBEDCFADADCFCJCNADALALAH@DCD@BABABA@ADEFEBEBIBA@KDEF@HAD@BAFADGBO@IDCFAD@BEHMGUGKBUQECEC@C@CHEEKMEUCAHBBDB@F@DADEBIAA@CHGDQJ@JGHKHDLBJBHIFMFKFCFBJCFEFABABABABCFAD@BDD@@BB@B@HBFDFDDDB@BDF@D@BEF@@KFGDKD@@A@AAE@CAEAI@G@GAIBGBEDMBG@AAECA@A@AAC@EAK@CBABCB@BADCBEBGCM@KBEDIBCF@FFHM@I@IACAOAG@@BE@@D@DF@@FF@@FFBLAHAHAL@CHBBCDABABAB@@@BB@EDA@CB@@AB@BDBBD@BBB@@B@@@BAB@@@B@@@@@B@@@@BA@@@BB@BBDBBA@ABA@@@@BBD@B@@BDBBBB@B@D@B@@CBA@ABA@A@@@@@@BDDBD@BBADAB@B@@@@@@@@B@B@@@@@D@B@@B@@B@@HRG@@@A@@AAAAC@@A@@@@@@D@@BB@DC@@AA@@CA@@AA@ABCBA@ABA@@@@BBJBD@F@B@BBD@@BBBAD@JABBBDBDBDBB@B@BB@@B@B@B@BB@D@D@@DBD@@ABBD@BC@@@@A@AA@@@AA@@A@@B@@@B@D@B@B@DBDBH@DBHBF@@H@DAB@D@D@FAFAD@BA@@F@BAB@B@@@@@B@DCD@BA@@B@B@DABABA@@@@@B@@@BBF@@BBBHBF@BBB@DBJ@BAD@BFBB@@@@JBB@@@@B@@@B@F@F@F@F@B@BBB@B@B@D@D@D@B@D@@@D@FBD@F@B@BB@@@A@CAK@G@IAE@C@A@A@A@@B@@@B@BBB@@BB@@BB@@@B@BBB@@AB@B@@A@@BAB@B@FAD@B@@@@B@@BB@@@@@B@@A@@@@@@B@@@B@B@@B@@BB@@@@@@@@B@@@@@@@B@@B@@@@B@@@B@@@@B@DADAD@FADA@@B@B@@@D@B@@@B@@B@@ABB@BBBBB@BBB@@BDB@@BB@@@BAB@D@B@B@@@BB@@@@@BB@D@B@@A@@A@AAA@@A@AB@@@B@DC@@@@@BB@@AB@B@@ADA@@@@@AB@@AAAB@@@@AB@@@@@B@DA@A@@BA@EAE@A@A@@@AB@@AB@BBD@F@D@B@B@@AB@@A@A@A@@@@BA@@@@A@@A@@BAB@@A@@@A@A@@@A@@@@@A@@@CA@@@BA@@@@@@@@@@@BB@@@@@@ABAB@@A@ABAB@B@@ABABED@@@BAD@D@@A@@@BB@@@D@@A@@@AD@B@B@@@F@D@D@@BB@@BBDBB@B@B@BBB@@BB@B@B@B@B@BBB@@@BA@@B@BBBBB@BDD@BBBB@@BBD@@B@@B@@@B@B@DBB@@@@B@B@BF@FDFBB@@@BD@@@@AB@B@@@B@@BB@BA@@B@DBB@BD@BD@@@B@@@@B@AB@@A@ABB@A@@B@@@BA@@BAB@@BBAB@B@B@@BB@@@B@@ADB@@@@B@BB@@B@BA@@@EDGBCBCBAB@@BD@BADADABAB@BA@B@@B@@@B@@B@@@BBABB@@B@@@BB@@BB@@@BBC@ABB@@B@BA@@@B@BBA@A@@BB@BB@BB@@@A@BB@@@BA@@@BBA@B@@B@@@B@@@@B@@B@B@@B@B@@B@@A@ABB@@@@@C@@BA@AB@@BB@@AD@@@B@BCF@BB@BB@@A@@B@@@@B@B@@B@@@BA@@@AB@BA@A@@BDBFD@@FD@@@@B@DD@@DB@@D@BBB@BDBDBBB@D@@@@@@B@@ABA@AB@@AD@BA@ADA@@@C@@@@B@BA@AB@@AB@@@FDDADCD@@A@@@@@AA@@@@A@@@@@A@@A@@AB@@@@@@@@@@A@@@@B@@A@@@@@@@@A@@@@AB@@@@A@@@AB@B@B@BADAB@BA@@B@BABA@DDBDADCF@DDBD@JAFABBDFDHFFDBBABABAAA@A@ABAB@F@DFFBDBDADCBEDAHADBBBDFBDDBD@FADCDEBC@EAA@ABAD@B@FBDDBBD@DAD@D@BBBB@@@B@BCBAB@B@B@@BBBBHAFADBF@DD@@@BABCBCBAD@HFFHDJABAHCDAB@HAF@JBDBBDABIDCBEBAB@DBBFBD@H@FD@DCFED@FBDFDDBFAFCDC@CAE@CBCDAF@HFFBDADADAD@D@BD@DBBBBLDBBFFDDD@D@BABCBC@ADCD@H@FBHDDDBHBDBDBBNBHBBBBBBF@BFDNJFDHDDBBDHDHDTFDDDHDBFBFBDAD@BA@C@ABA@ABADEFAJ@D@F@B@D@DBBBBDBDFJDBD@D@DCB@D@F@DBJBFBDBJ@HBBDDBD@F@FADAF@F@BBBD@DDDDBFBFALEHEFAFCDAHBDBDDDHFDBD@@BB@BF@D@D@DBD@D@F@D@DADADAD@B@B@B@BAFADAHAB@FAB@DAFIDAB@D@B@DAB@D@F@BAD@B@B@BA@A@CBAB@DA@@BABC@AB@DADAF@JBD@DABCAAAAGCCA@C@ABA@@B@HABAB@D@BCB@DABAF@DADCBEBCBADADABAD@HBD@BAB@BABA@@BCBADCBAB@BADBB@BAB@B@B@FBBAB@FAF@HBFBDBBAF@B@D@B@LBDADABA@C@CAC@A@ABAFAHAHBJBJBF@DAF@HCJMDETGHABCBA@C@CBEAI@ABABCBC@CBABCD@D@FDFDF@FADADAB@BBFBBBFLBBDBDBHADABA@E@KDEDAF@BBDBHFFBBFDFBBB@DB@@DCBCFAHAB@B@HCB@DADBDBJFD@D@B@D@PCD@F@FBRDJ@F@BADA@ABABEBE@A@ADAD@DBBBFBBBBDBBFDHAB@@@P@D@DAFEBA@AAA@@CCAACA@AACAC@A@ADAFA@AB@D@D@D@BABA@ACEAEBCBCDCBGBCCGAABAAC@C@@EE@CBE@@BCBCCAFGHCD@DAFBB@DAFCBABCDABEDAFCBABC@EBCBCDADAD@DA@A@C@A@ABAHCBA@CAC@C@CHEFAXGFEBA@A@C@EBCD@B@DBJAB@@@BABA@AGCCAECCCCA@AACBCD@D@D@BFBBB@DCDADADBB@DABA@CAA@ADAHADCBC@ADABABCBABAA@@A@@AC@A@ABA@@DAF@DABABA@A@@AAEE@A@ABAD@D@BBDBD@@@@@B@BA@A@A@CBA@ABA@@HG@A@AA@AACBE@AAAABA@CFMBE@CAE@AAAAA@AAA@EACA@AAABC@ADABABC@A@@ABA@AAA@C@AAA@A@ABCAA@@@@I@C@@@@@A@@@@AC@@@@BM@E@A@A@CB@@A@CAA@@@A@A@AAA@EACA@@@AA@@AA@AAA@AA@@A@AAA@A@A@C@@@A@A@A@@@@@AA@@@@@C@A@@@@A@@@@@A@CA@@AA@@A@AACAA@@AAACCAA@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AAC@AAA@AAC@@A@@@@@@@@@CC@@@@AA@@@@@A@@@@@@@A@@B@@A@@BA@@@@@A@@@A@@@AB@@@@A@@@A@A@@@C@@@C@@@A@C@@@A@AB@@@@@AA@AA@A@AAA@@@AA@@AA@AA@AA@C@A@@AA@A@@@A@A@A@@@A@A@@@A@@@@@A@@@@@AA@@@AAAAA@A@AC@@AA@CAA@A@@AA@@AA@@BA@@@AB@@A@A@A@A@@BA@@@A@E@A@A@A@A@A@AB@@C@ABA@@@ABA@A@@@A@A@A@@@@@ABA@@@A@@@@@A@@@@BA@@@A@@B@AAB@@@@CBA@A@@@A@@BC@A@@@A@@@AAA@AB@@@@AB@@A@@@A@@BA@@@AB@@A@@AA@C@C@A@ABC@@@AB@BAB@@@B@@@BABA@@@@@@@AB@@@@A@ABA@@@A@@B@@AB@@@@@BA@@BABA@@@AB@@ABABCDCDABA@@BA@@@@BAA@@A@@@@@A@@@AB@@@B@@@@A@@B@@A@@DCDCDCBA@ABA@@@A@@@AD@@AB@BADABABA@@BC@@BA@@@ABA@A@@@A@@@@BC@@@@ACAACKA@@A@@@@@A@@@A@@@AAA@A@A@ABA@C@AA@BCAA@CAA@A@C@CACEE@C@@@@@A@ABAB@@AB@B@@A@@@@@@@@@@@A@@@@AA@A@A@@@ABA@@B@B@@@B@@@@AAA@A@@@A@@B@DA@AB@DC@AB@@@@ABA@@@A@A@@@@@A@@@A@@@@@A@AAA@A@A@@@AAA@@AA@@AA@@@A@A@@BA@@@@@AAABA@A@@@A@A@@@ABA@@@A@@@@BA@@@@@CA@@A@@A@@A@C@A@C@E@C@@B@@A@@@@AA@@A@@@@@AA@@A@@@A@GC@AAA@@@AB@@A@A@@@@@A@@@AA@C@C@A@@A@C@ABAB@@A@@B@DBB@@A@@@@@@@@@@@A@@@A@@@@JE@@B@@@B@B@@@@@@A@A@A@@@@BA@@BA@@@@@A@C@@@A@@@A@ABA@ABA@@@C@ABCAA@@B@BCBABA@A@@B@@E@@SEGAGAI@IEMCGACACGMYCGAACCAAG@IBOB[@K@G@CCECCAE@C@ADIDMDMDG@GACACGCCAAE@EBA@@ABC@CAGEAQAM@@ACAA@A@C@ABABADCBABCDEBC@CB@BABABAF@D@B@@@F@BA@A@ABA@@BC@C@A@A@A@AAA@E@@@C@EAABC@C@A@G@CACAA@EA@@CA@@A@C@@@C@@@CA@@C@@@A@@@@BAAECCAEAA@AA@@@@A@C@@@@AB@@A@@A@BAA@@@A@@@A@A@@FECA@AB@@AB@@@@A@@@@@AA@@@@A@@@@@@@A@@@@@C@A@A@A@AB"]],encodeOffsets:[[[129759,45604]],[[129761,45601]],[[129746,45601]],[[129822,45595]]]}},{type:"Feature",id:"220183",properties:{name:"德惠市",cp:[125.728755,44.522056],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@A@A@A@C@AACBCAABCAAA@@C@A@DFC@@@AA@@@@A@A@A@@@@BA@@@AB@BA@@@A@@B@@@F@D@@BD@BBBBB@D@@ABABABAA@@AACAA@A@EAE@ECEAAAAAC@A@CBCBCBCBCBC@A@A@@@@@A@@@@@@@@@@@ABC@@B@D@@@BA@@BA@ABA@A@A@ABABAB@@A@A@C@C@@@A@A@CAA@A@A@ABEC@B@DBB@DC@@AAA@@@AABA@A@@A@A@A@A@A@AA@@@A@CB@ABAC@AAADCBCDCAAAA@A@@BCAA@@@@@ABCDC@@@DBA@ADCDABEFAD@@GJ@@JGBDCDCBDDADA@AF@@CDA@EFAACDBB@@BBB@BB@@DBCHB@BA@@ADADAB@AGA@@A@ABCDCDCFA@@B@@@@@B@@@@@B@@BBTLBADBCFBB@@B@DDB@BBCDEDAAABKEMRCDABAB@@DDCDC@CDCACAA@@A@@@@EHAB@@AB@BA@@@@@BB@@A@CFB@@BABBB@@CFB@@B@@ABAAA@EACAEAE@CA@@EA@B@HC@CBBBE@CBA@A@@@@B@@@@@B@@BFB@BFCB@ACBE@CFAB@DAD@@A@CA@F@B@BE@BB@@@B@@@B@B@B@@@B@@A@A@ABBBA@AA@@BBABAC@@@BAHEDB@EBDBB@BABAHDB@B@D@DB@@@BABFB@@@@@@@B@@BDB@DADAB@F@D@@@@@B@@@B@F@B@@BBF@B@BBB@B@@ABAB@@A@A@BBBBDB@BDFBFD@HA@BBAD@@@@@BB@@@@@@BB@@@@@B@@@@B@@@@B@@@@@@BB@@A@@BA@@@A@@BA@@@@@@@BB@@@@@@@B@@B@@B@BAB@@@B@@AB@@AAAB@F@@A@ABA@@@@@BBDBBBDBA@@@FD@BDB@@CD@B@@A@@@AD@@@BA@@B@@@BA@@B@@@@@BA@@@ECAAAB@@@@A@EH@@GAA@A@@@@@@@ABABABBBB@@@AB@BJHEBCBDDBBNHVLB@BBFBFDFD@B@@@@ABEDCJELA@@@ABCBEBIBABABCFC@CBABGJ@B@@@BBF@B@@@@@B@@@@@B@@@@@B@@@B@@A@@@A@@@A@@@@B@@@B@B@@@@@@@@B@B@@BB@@@@BABB@@@@@B@B@@@@@B@@@@B@@A@@B@@@BB@@@@@B@@A@@@AB@@@@@B@@@@@BB@@@BB@@@B@@@B@BB@B@BB@@@@@@@@@B@D@@@B@@@@@B@@B@@@@@BD@@BB@@BDB@B@BBB@@DB@@B@B@@@B@BBB@@@B@B@@@B@@B@B@@B@@@BBB@@@DB@@@@BAB@@@@@@@@B@B@D@BB@@@B@B@@@BB@@@@B@B@@AB@@@BB@@@@@B@@@BBB@@@BB@@@@@@A@@@AB@@@@@B@@@BB@@@B@@@@@@@B@@@B@B@B@BB@@@B@B@@@BBB@@B@@@@@BA@@@@B@@@B@@B@@@BBB@@@@B@@@@AB@@@B@BBB@@@@BBA@@@@@AB@@C@A@@@@@@B@@BBB@@BB@@B@@@BB@@BB@@ABB@@B@@BB@BB@@B@@@BB@@BD@B@@@BBB@@B@B@BB@@BB@@BBB@@@B@B@@@@BBB@BB@@@@@B@B@B@@@@B@@@@ABB@@@@BB@BB@@BB@@@@B@@A@@B@@@B@@@@B@@BB@@@@B@@BB@B@B@@@@BB@DB@BB@@BBB@@B@@@B@B@@@@@B@@B@@A@@@@@@@@B@@@@@BB@BBB@BBD@@@B@B@@BB@@BB@DBB@@BDB@BBB@D@@@@@BAB@@@B@BAB@B@B@@@BBBBDBFBBB@@BB@@BD@BBFBB@@@B@B@@@B@B@@@B@B@@BB@B@@BB@@B@B@@B@@B@@B@@ABAB@@@@BB@@B@@BBB@B@B@@@@BB@BBB@B@@@BBB@@@B@@@B@@@B@B@B@B@@@B@@AB@@@@@B@@@B@@B@@DBD@@@B@B@@@B@B@@@@@@B@BA@@BB@@@B@B@@ABAB@@@BB@@B@@@@@B@@A@AB@@@BBB@BBB@@@@@@@@A@A@A@@B@@@B@B@BBBA@@B@@@BB@@B@DBD@@BBA@@@@BA@@B@@BB@BB@@B@@@@@B@@A@@B@@@@@@B@@BB@@B@@@@B@@@@@@B@BAB@@@DB@@@@B@@A@@B@@@B@B@B@@@@@@@@@@@@BA@@B@@B@@B@@@@@B@@A@A@@@@@AB@@BB@BB@BBBB@@@@B@B@@@B@@BB@B@D@B@B@B@@@@B@@@@@@B@B@@@@@@@@@@@@@AA@@@@@@B@B@@B@@@@@@AB@@@BB@BB@@@A@@B@@AB@B@@@@BB@@@B@@B@@@BB@BB@@B@@@@B@@@@@BB@@@DDD@DDB@BB@@B@B@@@@@@@@B@@B@@@BA@@B@@@@B@@AB@@B@@@B@B@@@B@@@B@@@@@DB@B@@@B@@@@@@@B@@@@@@@@B@B@B@@@@B@@@@AB@@@B@@B@@BB@@@@AB@@@@@@ABB@@B@@@@B@@AB@@@BA@@@AAAB@@B@@@B@B@@@B@@@B@@B@@A@@@ABA@@@@@@@BB@@BAB@@@@@@B@@@@A@A@A@@@A@@@@B@@B@@BB@B@@B@B@@B@@@@@BAB@B@@@@B@@@@A@@B@@@@@BB@F@DBB@@@B@@@@B@@@B@@B@@BB@@BA@@@@@A@@@AB@@@@@@@BB@B@BAD@B@B@@@B@@@@@@AA@@AB@@B@@BB@@BBB@B@@B@@@@@@AB@@@B@@BB@@B@@@B@@@B@@B@B@@@@@@A@@B@@A@@@BB@@B@@B@@@@@B@@@BB@@@@BA@A@A@@@A@@A@@A@@@@@@B@@AB@@@BA@A@@@A@@B@@A@@BA@A@AA@@@@@@@A@@@@@@@@@@@B@@ABB@@B@@@@AB@@B@@BB@BA@@B@@@B@@@@@@@@BA@A@AB@@BB@@BBB@@B@@@@A@AB@B@@B@@@B@@@B@@@B@BA@@@@BB@@BB@@BBBB@@@@B@@@@@@A@@@@B@B@@@BB@@@B@@@@A@@BA@@@@@@B@B@B@@BB@@B@@B@@@@@@@@A@@B@@@@@B@@B@@@@BA@@@A@AB@@@B@@@@@@DB@@@@BA@@@@@@@A@@@@@@B@@@BBB@@@@B@@@@B@@@@@B@@@@@@B@@AB@B@@@B@@@@@@@@B@@@B@@@@@AB@@A@AB@@@B@@@@@BA@@@@@@B@@A@@B@@@@A@AAAA@@@BA@@@BB@@@B@@@B@@@@B@B@@@@@@@@@@B@@B@BBB@BBB@@B@@@@A@@@A@A@@@A@@B@@@@BB@@B@@AB@@@B@@@B@@@@B@@A@A@A@@@A@@B@@@@B@H@@@@@BA@A@@@@B@@BB@@@B@@A@@B@BBB@B@D@D@BBD@B@B@B@B@NBD@B@@BB@DD@@B@@B@@@B@@A@@@@@B@@@BAB@@@@A@A@@@AB@@@@@@BBB@B@@@@@BA@@BA@A@@BA@@@@@@@@@DBB@@@BAB@@AB@@@BA@@@B@@@B@@CDAB@@AB@D@B@@@@ABABAB@B@@@BBB@BBBBDD@DBJDDB@BBB@B@B@@@BABA@ABABAB@@@@@@@BB@D@H@F@B@FBB@BB@B@D@BAB@B@@@B@@B@@@B@B@@ABA@@@A@AAA@AAABA@ABAB@@AB@B@B@BBBBBB@B@B@B@DBBBB@BBBD@DBBA@@BABABAB@@AD@@@B@B@BBBBDD@BBBAB@@A@GFADABAD@B@B@B@@AB@@A@A@AACAA@ACC@@AA@AB@@A@@B@B@B@@BBBBD@DBB@@BB@@B@B@DBD@BBB@BDBBDB@DBFBF@HAJAF@D@B@F@FBFB@DBDAB@BCDA@E@CBCBADAB@B@BBDHHFHFDDDBP@H@D@@A@@@@BCDAB@@@@H@F@H@DABABAB@DADADADADCBABC@CACGGAC@CBCDADAFCD@DADBF@FALEFCBA@A@A@@CAACCA@ACCAC@@A@A@@@BABCDEBCBCD@FADBF@DDBDDBDDBB@B@BDBD@@@FBF@HABC@AD@F@B@DAF@B@D@FBDBJ@JALC@@BA@CAGDKBGFGDADAFAJ@FADANCHAFE@C@C@A@@B@B@@AB@BCDABAAAAEAACAA@@ADIBE@CDEDCFEB@FANAFABAFA@@HCHCLAB@DBFBHBFDHBFD@@@@@B@@@BB@DBDB@@DBBADAH@LBNFBBLFFFBBD@DBFCFAF@F@@AH@PBDBJBJ@N@EG@EDEJAFCLAN@HDFADABC@ADABADAL@FBD@BBB@B@FDBBH@NAFCHAJAHBH@J@FBDBF@BBB@@@LCHCLE@@FE@A@CCE@ACACCCEAE@G@AAA@@CC@ABCDEBABABABAFEDEAIDELENEJEAGAICKLGHG@IRIHCDGB@JBFABC@C@ECAAABGVDNFFLGF@D@DFDFDVRLAVHNHFG@ABCDEJCP@HABCBE@ABC@GFELCB@JAFAFAFEBCB@BABA@ADC@CBGBKBKBCDMDIDEBCBCDEFCHAJAFABAB@BA@CACCCEAGCCCCCACAADCDADCD@F@H@L@B@F@FAFCFEFCBAFAHGJCBADAJILCFCBADABI@G@QBGCACC@A@CA@A@A@CAC@CAGCEAKBM@ABE@A@MB@@U@A@E@IBA@@@M@A@C@EAGASACAKAGAE@IAAAGAE@A@CA@@A@C@@@A@C@CBC@C@CBABA@ABCBABA@CBCBCBC@ABA@ABA@@@CBE@UFK@EBG@EAC@C@E@EA@@EACAE@AAEA@@A@A@CAE@EAE@@@EAE@EAEACACAA@A@ICCAEAEAE@A@CAC@A@C@CAA@C@CAE@AAC@EACAA@AAA@A@@@C@A@AAA@@@A@C@@@A@EAA@CAC@A@C@G@@@@@@@A@A@A@CAA@AAA@AAC@AACAEAGEEAGCCAECCACCA@AAAAAAAAACAAAACCAAA@AAAAA@AACACAAAAAA@C@AAC@@@AEG@A@E@C@C@IAA@C@C@A@C@AA@AAAA@@@A@@@A@@AA@A@A@A@@@@@A@C@A@@@@@A@A@@@@@A@@@@AA@@@A@@A@@A@A@CBC@A@CB@@A@@@A@@AB@@@B@@@@A@@A@@ABABA@@@@@@A@AA@@@@@@@ABAB@@@BB@@B@@A@@A@@AB@@@@ABA@@B@@@BAB@@BB@BA@@@@@@@A@@A@@@AA@@@AA@@A@A@@A@@A@@BA@@@@AA@@AA@@B@@@@C@A@ABA@ABAAC@AA@@A@@@AB@@@@AA@A@A@A@@@@AB@@A@@A@@@A@@@@@@A@@CC@AAA@A@ABA@A@C@A@AB@@A@@@A@@B@@@@A@@@@@A@@@@@@B@@@B@@@B@@@@AB@@@@@@A@@B@@@B@@@B@@@@A@@@@A@@@@@BA@@@@@@@@A@@AA@@@BA@@B@BA@@@@@CAAA@@@@@A@@@@@@A@@@@A@@@A@@A@@@@@@@A@@@@@@@@BA@@@@@@@@A@A@@@@AA@@@@@@BABAB@@A@@@@AAAC@A@E@AB@@A@@@AB@@@@A@A@KAIA@DC@JB@BABE@CA@HC@A@KB@@A@@@C@@AANC@@CAAADABAA@AC@EAC@@AIB@@@BIB@@L@@@GBCBI@@B@BCA@CEADAA@@AA@@@CABCA@B@BABC@@AGBA@@A@@A@@BA@CB@B@DB@@B@B@@@B@@@B@B@@@BAB@@AACBAF@DBD@BBD@@@BAB@D@BBB@B@BAA@A@C@@@@AJ@@AG@B@B@AAGCBAAA@CB@@A@A@A@AB@@A@CBA@C@ABC@C@C@AAA@A@@@@BC@A@AAAA@@@C@A@@@E@@@@@@A@@@AB@@A@@@@@AB@@ABA@AA@@@C@EAEAEA@@@BAB@B@B@@@B@@@@@B@@AAC@ABK@@@@@@@C@A@AA@@A@@A@ABACACA@ABC@A@@CAA@@CE@@B@@@@A@AAA@AAB@AACAA@A@C@C@@@@A@A@@EAAB@B@@CAC@A@@BA@AAA@EAABEACACAA@A@@@C@A@C@@@@@AB@@C@C@BB@@@@BB@@AB@@B@@@@@@B@@@BAB@@AB@@@@ADA@A@@DAB@@@BA@BBIBC@EBGBE@C@@@@@@@@@AAABA@@@@@@B@@AB@@A@@@A@@@A@A@EFAB@B@BBB@BCD@B@@DDDDD@B@DA@@@BABIJABABCBC@ABAB@B@@BB@B@BA@C@EC@@C@AB@BDBFBDBBB@BCB@B@BBBB@@D@@ABABCDA@@B@BDB@B@D@B@BBB@@A@@@@@C@GBCACBA@C@@AC@@A@@A@AAEAGAICC@@AACAEAE@C@@@C@KBI@E@ECCAE@AA@AAA@A@C@E@A@AE@AA@@AA@A@A@@BC@A@A@A@C@A@CB@@E@@B@@@@@@@@A@C@G@@@@@AB@@@BA@AAC@CAEAA@CAECEACA@A@@AA@@@A@@AA@AAAA@A@CAKEC@GCMEA@@@BCIC@F@@AA@@@A@A@@@@@@@@GCEB@@GC@B@@A@@DCA@A@@@AGC@@@@A@@B@@@B@@@@@BAAE@GC@@@BBBA@ABE@@AAAA@E@A@A@@@AACABAA@AAA@@BA@@@AAA@@@FEAA@@ABA@AAAAB@BA@@SDE@BB@B@@@@ABDH@@BFA@ABA@AEA@@B@@@B@@A@C@C@E@A@EBE@A@ABA@E@AA@AA@CBA@C@ABA@GDGE@AAA@A@ABABABEBABCBABGBC@A@AACAACECCAAAAA@@CAGAAAAAACACAEACACEACAA@@@AB@DCBC@A@@@AA@A@A@@AA@@AB@BAAABEAA@@BA@@DAB@FEHCBA@@@ABA@A@C@E@CAC@E@AB@@@@@B@@AAE@A@AAAAAAE@@AA@AA@@@CAAA@A@@@A@@BA@@@@BA@@@@@@@A@@@@@@@@B@@@@A@@@@@AA@@@A@@@@@@A@@B@@@@@BB@@@@@@@A@@@@@A@@@@@@@@A@C@C@A@@BA@CBCBCBA@C@E@A@CAC@C@A@E@A@E@C@A@@@@B@B@B@B@B@@@B@@BB@B@BA@ABABABA@@@"],encodeOffsets:[[128567,45297]]}},{type:"Feature",id:"220122",properties:{name:"农安县",cp:[125.184887,44.432763],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@ABA@@BABA@@@A@@A@@BABEFCA@@A@@@@@BCB@BA@AA@@B@@A@@@@A@AA@CBABA@@@ECAAEB@@CACAA@A@@@A@@@AAB@CA@AEA@BEAA@A@@@DGIA@@A@A@@@A@@@CHA@@B@@AF@B@B@@@@AB@@@@@@@@BB@AB@@B@@B@@@@@@@@@@BB@BB@@@@BB@@B@@B@@@B@@@B@@BB@@B@@BB@@B@@@@AB@@AB@B@@@@@@@@B@@B@@@@@@@@@BA@@@@@@@@B@@@@A@@@@B@@@@A@@B@@@@@@@@@@AB@@@@@@@B@@@@@B@@@@B@@@@@@@@@@@@B@B@B@B@B@@AB@@@B@@@@@@@BA@@@@@@B@@@@@BA@@@A@@BA@A@A@@@A@@A@@@@A@@B@@@B@@@@@@@@@B@@A@@@@@A@@@@@@@@@@AA@@@A@A@@@AAA@@@ABAB@@@@@@@@@BA@A@@@@@@@A@@AA@@B@@A@@@@@@@@B@@@@@@A@@@@@@B@@@@@@@@A@@@@BA@AB@@A@@@@@A@@@@@A@@@@@A@@BB@@@@@@@A@@@@@@@@B@@@@@@AB@@@@A@@@@@@@@@@@@B@@B@@@@@B@@@B@@@@B@@@@A@@B@@@@@@@@@B@@@@@@@@A@@@@@A@@@@@@@@B@@@@@@A@@@@@A@@@@@@B@@AB@@ABA@CB@@@@AB@@@B@@@D@BAB@BBB@B@@A@@B@@@@B@@B@@@@@B@B@@@@B@@@@@@B@@@@B@@@@@@@@BAB@@@@B@BB@@@@@A@@@@B@@B@B@@@@@@@@A@A@A@@@@@@@@B@@A@@@@B@@@@@@@B@@@@@BA@B@@@@@@B@@A@AA@@AB@@@@AB@@@@@@B@@B@@@@AB@@@@@@@@A@@A@@@@@@AB@@B@@B@@B@@@B@@@BB@@@@ABA@@@@@B@@B@BB@@B@@B@@@@@@@B@@@@@B@@@@BB@@BB@@@@@B@BBB@BB@@BBB@@BB@BB@@B@BBDB@B@@@@@B@@BD@@A@C@ABC@A@ABA@IDCBA@ABC@ABABC@A@ABC@C@@@A@ABA@C@C@ABC@EBE@@@A@@@A@@@A@ABA@@@@BA@@BB@@@@@B@@BA@@B@B@B@BAD@B@@@@E@AEG@GAA@A@AAC@CAA@C@@@@B@@BB@B@@@B@@A@A@@@@B@@A@@B@B@B@B@@@BA@@@BB@@B@@B@@AB@@ABAB@@C@CA@@@@@BABA@@@AB@BABA@BBB@B@@@@B@@@@ABABABABADA@BB@B@@ABA@A@A@C@C@G@@@A@@@AAA@A@A@@@A@A@A@AA@@@@@@A@A@A@@@@@@@A@A@A@AA@@A@AA@@@@A@@A@@A@@@AA@AA@AA@@AA@@@@A@@@@@AA@@@@@@A@A@@@A@@@A@A@A@A@A@@@@BAB@B@@@@@BA@A@ABABA@ABA@@@AB@@@B@BA@@B@@A@@@@@@@@@@@@A@@@@@@@@@@A@@B@@@@A@@@@@@@@@@A@@@@AB@@ABA@A@AB@@A@@BA@ABA@@BA@AB@B@@AB@@@@@@@@@A@@@@@@A@@@A@ABC@ABAB@@A@@BA@@@A@A@@@A@@@A@@@@B@@@@@BA@@B@@@B@@A@@@A@AAA@A@@@A@AA@@@AA@AA@@@@@B@@@B@@@B@B@@@B@@@@@B@@@B@@A@@@@@@@@@@B@@@@AB@@@B@@@@BB@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@B@B@@@BB@@B@@@BA@@B@@@@@@BBB@@@@B@@@@@BA@@@A@@BA@@@@B@@AB@@@@AB@@@B@@ABB@@@@@B@@@@@@@@ABA@@@@@@@@@@@@B@@@@A@@B@@@@A@@@@BA@@@@@AB@@@@@@@@@@@@@@@@@@B@@AB@@@@@BA@@@@BA@@@@B@@B@@@@@@@@A@@B@@A@@BA@@BA@@@@B@A@@@@BAB@BA@AB@B@@AB@@AB@@@B@@AB@@A@A@A@@@@@A@AB@BABAB@B@@@B@@@@BBB@B@@B@@B@BBB@@@@B@@@@B@@@@@@B@@B@@@@B@B@@B@@@@@BB@@B@@@@@BB@@B@@@@B@@@BB@@BB@BB@@B@@BB@@@@BB@BB@@@@@BA@@BB@@B@@@BB@@B@BB@BB@B@@A@@@@@AB@@@@A@@@@@@A@@@@@AA@A@A@@@@@@D@B@BA@@B@@@@@@@BA@@@@@@B@@@@@@@@@@AB@@@BB@@@@B@@@@A@@@@B@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@AAB@@@@A@@@@B@@@@@B@@@B@@@B@B@@@@A@A@A@A@@@A@@@@@@AA@AA@@@@A@A@@@@@@@A@@@@@@@@@BB@@@@@@A@@@@@A@AA@@@@@@@@B@@A@@@@@@CA@@@@A@@B@@AAAAA@@@AA@@A@@@@B@@A@@@@@@@@B@@@@@@BBB@DBBBDB@@@@@@@B@@@@@@@@@@@@@B@@A@@@@@@@@@B@@@@@AB@@@@A@@@@B@@@B@B@@@@B@@@B@@BB@@B@@B@@@B@@@BB@@B@BBB@@BB@@@BBB@@@@BBB@@@@@@A@@B@@AB@@ABA@@BA@@@A@AB@@ABA@A@@@@@@@@@A@@@ABABA@@BA@@@@@A@AAA@@@@@AB@@A@@A@@@BA@ABADAB@B@@@B@@B@BB@@@@ABA@AB@@@@AB@@B@@B@@@BAB@B@B@B@B@@A@A@A@ABA@@BBBB@AB@B@BB@FDFDBCBABBDDDFBDDDBBBBBBBBBBDD@@@@@@@A@@@@B@@A@@@@DBDDDBBB@@BBB@@DBBBBBB@B@D@BBD@B@FAF@B@B@DA@A@A@A@AA@@@@A@AA@@CAAAAA@A@A@C@A@A@ABA@A@AA@CACA@@@AA@AA@AA@CAA@@@@@CACAA@A@CA@@A@AB@@A@AAC@@AA@@BABA@BA@C@A@@@@AB@B@@@@@A@A@@@@@@@A@ACACACACA@@EHCH@@C@C@@BA@@BAB@BA@@@AABA@C@@@@@@@@ADAD@@A@AB@BAB@ABCA@@@@BABA@@ABC@AC@ABADI@@CCC@@@B@D@BC@@ABGA@@@AF@D@@@C@EA@@B@DC@@@@E@AA@@@BA@ABCAAAFAD@@@@BB@@@FA@AA@EA@@HA@AAAE@@C@A@CA@@@DAHB@ADAD@BA@CAGCGC@@@D@BADCA@AC@IAE@@BA@@BADA@BEBA@@@@BC@AG@ADABCBC@@@ADADCADCBC@ABCE@CF@BGBA@A@A@@BBB@BA@@B@@@BA@@@@BAB@B@BABA@@@@@@A@A@A@ABCGAABADAB@@@@A@@B@B@@@@AB@@@@@B@@@@C@A@@@AB@@BBBBBB@@@B@@@BAB@BABAB@@@B@B@@@@@B@B@B@F@BA@@@A@@@CA@@@@@BAFAD@DAD@BCH@B@B@@BBB@@B@BADAH@BABA@B@HFB@BBB@CF@B@@DBBBDBBBFBBBBBBB@@BA@@@@BBB@A@@B@@B@@@AB@@@B@@B@DBCBCBABB@BB@@A@AB@@C@@@@@A@B@BBBBD@BBB@BBBBB@@BDBBBB@@@BBB@AB@D@F@D@@@@BB@@CFABA@@B@@@B@B@@@BABCDCHABABB@DB@@@@ABABIHABABDB@@@@GF@@@@ABA@@B@@A@@B@@@@@B@@D@BBB@@@@@CDCDJBFBFBBBB@BBD@@B@BBD@BBB@D@B@B@BABABEDCBABCDCBABA@@@A@@@AA@@A@A@CAABCDAB@@@@@@@B@@@D@B@BB@B@@ABA@@B@@@B@@ADBBBB@@@ABADEHCFABADABC@A@A@@BAB@@A@A@A@@@@A@@BA@AEAAF@@@BABB@@@AB@BAB@AA@A@@BADC@AAA@GAEA@BAB@@@DAB@BABADAB@B@@DB@@@BB@AB@@A@A@A@AD@BA@@@@@ABAB@BA@AAA@CAA@A@A@EFCD@DAB@F@BBBB@DBB@DBDA@@BB@@DBBBBDBBDF@@@BB@@@@B@@B@HAFCDDBAFCDCDCDBB@DBNFHB@@FE@@B@BBBBDBDD@@@BDB@@@AB@@@@@@@FE@@@@B@@@DBB@BBFB@BB@@@FEBA@@BBBAB@B@@@B@B@@@DDBBBB@DBBFP@@@@A@@@@@A@A@IHCBCBA@EBCBCBA@@BA@@@CBEFCBCDA@@@ABAB@@AB@@@@CBCBCBA@A@A@AAA@AAAAAAA@ADA@ADABAB@@@@@@AAA@@@A@ABA@@BA@ABAD@@B@@@B@ADCDDB@@@@@@@BCBB@BBBABAD@B@B@@@AFABFB@@@@@@@B@@BBDB@@@B@@ABCFABA@@@A@EC@BCBCBCBCBABABA@ABMJ@@@@AAA@@@@@@@A@@@@@A@@AAA@@@@A@ABA@A@ABCB@@A@@@@BA@ABCB@BABA@@BAB@@B@BBB@@@@B@BABADDBB@BBBBBB@@@@B@@@BAB@B@DBD@BBB@@@@B@@@@AB@@@B@@@BBB@@@@@@B@@AB@B@BBB@@B@@B@@AB@@@@@B@@@@B@@@B@AD@DABB@D@@@D@@@@@BABCD@BAB@@DDBBBB@B@@EHCDABBB@@ABAB@@@@@B@@CBA@@BCDCBADA@CDCDABABABDBDBBBB@FDFD@@DBDB@BBBDBB@BBDBBBB@@@B@@@B@@@B@@@@@@@@DB@@D@BBB@B@BBB@@@BBBB@@BBB@@@BB@@BB@B@BBDBDBDBD@B@B@DABA@BB@DBBBBB@B@@@BD@DBB@DD@@DDDBFBBDHDFFCDA@A@@BA@ABABCDADA@B@BBDBDDB@BBBBDBFDBBBB@@BBBABADA@@@BDBBBBBD@B@BBD@BBD@FBDBD@DBF@@@BBB@FBB@B@B@D@FBB@DBB@@@B@BBB@B@B@F@DBD@@@DBDBB@DBDB@@B@DBF@D@B@FBBBD@D@B@B@D@B@F@D@BAF@DADAD@DCBADABADADAB@@@DBJDDBBBB@B@HFDBDB@@B@HFJHDDBB@@B@@B@B@@AD@B@B@BAB@B@@BB@@BBD@DCFEDCHEB@D@DA@AB@BCDCB@BABBJDDBBBB@@@BBHDBBBCBAB@@ABABB@@B@@@@@@@@@@@@@BA@ABA@B@@@BB@@B@@@BBD@@@@ADCFDB@@BB@@BB@H@@@BGFCBABABMNOPRFVLZLdPXLD@BAJIB@B@@@D@DBBBDBB@B@FAD@BABAB@B@BA@@@@B@@@DBJFfP^PjTFGDA@@D@HDTHBBBBD@BBB@FBF@FAB@@BBBBB@BB@DBBBBDBDBBBDB@DDBBBBDDBB@@CB@@BB@@BBFBDB@@B@@BB@B@DBDBFBBGDEBC@A@AB@@A@@B@B@@@DBDBB@@@@@@B@@@ABCBABC@ABAB@B@FB@@B@HBD@DBB@D@DBDBD@F@BBB@@@B@@A@A@A@@@@B@@@B@B@@@@@@AB@@@@@@@@A@@@@B@@@B@@@D@@@@@B@DBD@B@B@@@B@B@B@@AB@@A@@B@@ABABABABABAB@@ABA@@DCBA@AB@DCBCB@@@@@@@@@@BCFCDABCDABABADD@BABAB@B@B@@BABAB@BBB@@BCBA@A@@B@@@@@BA@@B@BA@@B@@@BB@@B@B@@@@@@@B@@@B@@@@@BBB@@@B@BB@@B@@@@@@B@@@B@@B@@BB@@@B@@@B@@@B@@@DABA@AB@@@@@@@BAB@@@@@B@A@@BABA@AB@@DBB@@@DB@@@BB@@@@BB@@B@@BBB@BDB@@@B@@@@@@@@B@@ADCDA@@B@@@BB@@@BB@@BBDBDBD@@BB@@@B@@BBBBBB@DBBBFDB@@@@@B@@@@@B@@A@@@@@AB@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@A@@A@@@@@B@@A@@@@@@@A@@B@@@@@B@@@BBB@@@BAB@@@@@@@BA@@B@@@B@B@D@B@@@B@B@@@@A@AB@@@@@@@BBBBB@B@BBB@B@@@B@B@@@B@@@B@@@@@@@B@@B@@A@@B@@BB@@@@BBBB@@B@@@B@@@B@@@@@B@@@B@@@B@@@@@B@@@B@@BB@@@B@@@@BB@@@@@B@B@B@@BB@BBB@B@B@@@B@@@B@@@@@@@@B@B@@B@@BB@BAB@@AD@DB@@B@BB@B@@@B@@@B@B@@B@@@BA@@@@DDFDBBDAA@AAAA@@AA@@B@@@@@@@B@@BB@@@@@B@@@B@@A@@@AB@B@@A@@B@BB@@B@B@@B@@B@BBBBBB@@BB@@@@B@@@@@B@@@@@BA@@@@BA@@BA@A@@@@@A@@@@@@BA@@BA@@B@B@@@B@@@B@B@@@BA@@B@@@B@@@B@@@@@@@@A@@@@@@B@@@BA@@@@B@@@@A@@@A@@@A@@@@A@@@@@@A@@@@B@@@@@@@@@B@@@B@@BB@@@B@D@@@B@@@B@BBB@@@@@B@B@BBB@B@BBB@B@BBHBDBD@BBF@DBD@BBDB@@BBDBB@B@DBF@BBB@@B@@B@@@DBDBBBB@B@@@@B@@@BA@A@A@@BCBADEDADEBABA@A@ABC@A@@@A@CBADA@@B@B@BAB@BABABABCB@@A@@AAAACCA@AA@@@C@A@@BABA@AB@@A@@@A@A@@@@@A@@@@@AA@A@AA@@AAAA@@@A@@@A@@@@BA@@DAB@@A@@@@@@@@@AA@@B@@A@@@A@@A@A@ABA@A@@@@@ABABA@AB@@@@A@CBEB@@AB@@A@@@ABAFABA@AB@AA@A@@DE@@RB@B@BBBBB@D@FBDBBBBBB@B@D@B@@ABA@AB@D@B@BBBAF@@BD@D@B@D@@@BAD@HEJCHAF@D@F@FBFBFDHHHFJDFBH@H@JDDBDDFNBBDBDBB@D@F@RBFBDBHBNBF@J@@@F@F@FAJA@A@A@A@AA@@@CAA@A@AAAA@A@ABABAB@D@D@DBD@D@H@D@D@D@DBD@DBBD@B@BBD@B@BDD@BB@HJHHHDHBH@FADC@A@ABCBEDAHCD@H@F@B@F@H@F@FADEDE@ABA@ABAFAF@F@FBFBBD@BBDBDLDD@DBFAF@H@L@J@LBBBBBJDHBB@BBN@N@BBV@HBDBDBHCFADADCBCBAB@FAF@BBF@@B@D@DBBB@DDB@DBBBBDBBBDBBHDLFVNNHDD@B@B@D@BCF@DBDFJFDHDF@B@LCJAH@J@PDL@JAH@H@LBJBH@J@HCFCHMDCBAD@DA@ADCBCBG@ABC@AFCBAFEBG@C@G@ADCFCJG@@@@BADC@A@@B@@@@C@GAOCCECEGGGAC@A@ABABCDADAF@B@DC@ABAAC@CEAEAE@A@C@E@IBGBE@EACAA@ACCA@AAA@AAC@C@A@AA@@AA@CAC@AAAA@@@A@A@AB@@@BAB@@BD@BDB@DBBBB@B@@@BA@@@A@A@ABCBABCHEB@@@BAAA@ACCAAAAA@A@@@C@@BA@ABABAB@@ABCAC@AA@AAAAA@C@A@A@AAAAAAAA@A@A@@BA@AB@BABBB@BBB@B@B@@AB@BA@A@@@A@@@@A@@@ABA@A@C@AAAA@EAA@E@G@C@A@@A@@@@@@BABABAB@BA@A@@@A@AAA@ACAICCAC@ACAA@AAA@A@@@ABABABA@@@@@A@CBA@@BADC@@@A@@@A@@AB@@A@@BA@AB@@A@CA@@@@@@@@B@@AB@B@@AB@@A@@@@@AAA@A@@@@A@@B@@@B@B@@A@AB@@A@@@@@B@@@@A@@@AA@@@CCA@@AA@C@MAA@A@A@A@C@AAC@C@A@A@AAA@@@@BA@@@A@@AA@@@@@@BAB@@@@G@A@@@@@@AB@@@B@B@B@@@@A@@A@@@A@@@A@@BA@@@AA@@@@@AB@@@B@B@@@B@@@@@@AA@AAA@AAA@@@@A@@@@@@@@A@A@@@@@@A@@@A@@AA@@B@@A@@BBBBB@@@@@@AB@@@@A@@@@B@@A@@@@@A@@BAB@@@BA@@@@A@@@A@@@@@@@@@@A@@@ABA@@@A@@@@A@@@@@A@@@@@@A@@A@AA@@A@@@@@@@@B@@@@@@AB@@@@CA@@@@@@@A@@BAB@@@B@@A@@A@@@@A@@@@@AB@@@@@@@@@@AA@@@AA@@@A@A@A@@@@B@@AB@@@@@@A@@AA@@A@A@@@@@@B@@@@A@@@@@AAAA@@AA@@AA@@@@ABA@@@A@@@A@@@A@@@@ABAB@@@@@@AA@AA@@AA@@BAB@B@@A@@@@@@A@@@A@@@ABA@@AA@@@BA@@@@@AA@BA@@@A@@@@@@@@@@@B@@@@@@BBB@B@@AB@@@@AB@@@B@B@@A@@BA@@@A@@@@B@@@@BB@@@B@B@B@@A@@A@@A@@@A@@@@@AA@@@AA@@B@@@@AB@@@@@@@@A@AA@@@A@@@A@@@AA@@@A@@BA@@@@@@@AA@A@AA@@AA@@@AA@@BB@@B@@@@A@@@A@A@C@ABA@A@@A@@@@@@BA@@B@@@@@B@@AA@@AA@@@@A@@@A@@A@@@A@CAE@A@@A@@@@@AB@@@@@@A@@A@A@AB@@@@A@@@@A@AA@A@@AA@@@@A@@B@@@B@B@B@@@@@@A@@@@A@AB@@AA@@@@@@B@BA@@B@@@@AA@@@A@@@A@A@@@A@@@BABB@@B@@A@@BA@@@A@@A@@@AA@B@@@@A@@B@@A@@AA@@@@A@@BA@@@@@A@@A@A@A@@@@@@@@@@A@@@@@@@A@@@ACA@@@@A@@@A@@@A@A@@@A@@@BA@@@A@@A@@@AB@@A@@@@A@@@@@@A@A@@@AAA@CCC@CC@@A@@A@@@@@A@@A@@@AAA@@A@@@AA@@@A@@A@@A@A@@BA@@@@B@@AAA@@A@@BA@@@@@@@AA@A@@@@@@@BB@@@@@@@@@@@@A@A@@@@@@@@A@@A@A@A@C@A@A@@AA@@@A@A@@@@@AAAAA@@AAA@@BA@@@@B@B@@@@A@@@@@AA@@@@AB@@A@@@@@@@@@@A@A@A@@@A@@@@BA@@@@@CA@@A@ABA@@@@@@@@A@@A@@@AA@@@A@@@@A@@@@BA@@@@@A@@@AAA@@AA@@@AB@@@@AB@AC@CAA@@@AA@@A@@@ABAAA@A@@@A@@@@B@B@B@@@@@@A@AAA@AA@@A@@B@BA@@@@@A@@@AA@@A@AB@BA@A@@@A@@AB@@A@A@@@@A@A@@@A@A@@@C@CA@@@AA@@@A@@@@@A@@BA@@@A@A@A@A@@@A@@@A@@@A@AA@@A@A@AAA@@A@@A@A@A@AA@@@AA@@A@@A@AB@BA@@@@AA@@@@A@AA@@AA@A@@AA@A@@@A@A@@@A@A@@@A@EAAAC@@AA@@AA@AAAEACAA@A@@@A@ABA@A@A@@BA@A@@@@@CAA@ACA@AA@CAA@@AA@@AA@A@@@C@AAA@AAA@@A@@@@@A@@@@@@B@@@@AA@@@@@A@A@@@A@@@AA@AA@@ACAA@@A@@A@A@A@@AA@@@@@AA@@@A@@A@@@A@@@@BA@@@@@AA@@AAA@@A@@A@BA@@@@@A@@A@A@A@@@@@A@@AAA@A@@A@A@@@A@AA@@AA@@AAA@A@@@AA@A@@@AAC@@AA@@A@@@AAA@@AA@@@AA@BA@@AA@@A@@@AA@@AA@AA@@@A@@@@B@D@@@BA@@@@B@AA@@@@AA@A@A@@BA@@@@@A@@A@AA@@A@@@@A@@@A@@B@@A@@@@@AA@AA@@A@A@@@A@@A@A@A@A@@@A@@@@@@@A@@AA@@A@@@@@A@@B@@@B@@@@A@@A@@AA@A@@@A@@@@AA@@A@@BA@A@@@@@AA@@A@A@@@A@@A@C@A@A@@@@@@A@AB@@@@CA@@A@AA@@A@@@@A@AA@@@A@A@@@A@AAA@@@A@A@@@CA@@AA@A@ACA@AA@@AC@@A@@@@@AA@@@@@A@@@C@A@@@@@@@@@A@@A@AAAA@@@A@@@A@@A@@AA@@@@A@@@@@A@@B@@@BA@@@@@A@@A@@@AB@@@@A@@A@@@@@A@A@@@@@A@BA@A@@A@@AA@A@@@@@@@@@@A@A@@@A@@B@@@B@@@B@@@@A@@@A@@@@@A@@@@@A@@@@@AAE@A@@@AHIBADAD@DEBABAJAFADABA@@B@FKDIFCBA@@@@@AECECEAAAA@UKMGAACCDAFAIG@ABA@@A@AABABABA@@@@@@B@B@HB@@FGB@@@@@BABBFD@@B@@A@@@@@AB@@A@@@AB@@A@@BC@@B@@@@ADC@@CA@AEC@@B@CAAACAAA@@@@B@BAB@@@@EBABB@@BA@@@A@@BA@A@AA@@@@A@@@@@@AA@@@@@@B@@AB@@@B@@AB@@@AA@@@@@@@A@@A@@@@@@A@@@@AA@@@@@@AA@@@@C@AB@AGBC@AECE@ACAAAAAB@B@@@BABA@@@AAA@A@AAE@AA@E@A@@@A@@@@@C@E@A@CBCBA@AC@@@A@@@@@@EABA@A@@CAC@A@A@GCABABA@CAFAA@FCBG@A@@BDBAAA@@BBB@AABAB@B@@@@A@@@A@A@A@@@A@@AAF@@A@A@EDBB@@@BC@CBADEF@DA@BDAAEA@AE@@@A@@@@@A@@B@B@DAF@AADAD@@G@AFB@@DBF@FBDBFBB@BBBA@@@AA@DE@@AABA@AA@DEB@@@AA@@@@B@@ABA@@BAFG@@@@@BB@DBDBDCD@DCCC@@BABADCNQLFBABBFCDCAAA@CCA@@@AADECAABSKAA@@@A@@@@@A@@@@@AB@DEDCDCBAB@@@HB@BBABCBC@@ABA@DGCA@@AAA@AA@@AADCBBFEB@DC@@BEB@BCCCDADCACIH@@HI@@BCFEBADCBCB@CA@@D@DCBAC@@@GCAB@AA@B@@AOEAAAAAAACDAG@@ACA@HA@A@@@CADGAA@BE@@@@B@@E@FEA@EF@@FECA@@EFA@ABA@FGEAAHA@BA@C@A@AD@@ABC@@AAAAAAACAA@AAA@AAACACEC@AAAACC@@CDCA@ABA@@C@BBCBBBGDGD@AA@A@GAA@@@@B@BB@E@EB@B@DAFB@B@ADA@@D@@A@@C@@@DEAA@A@A@A@CAGCEAGCAAB@@@GCEAB@@AA@AAC@CA@@@AB@CACA@BA@AB@@@@@@AA@A@A@@C@CACAGAGA@AA@A@EAAA@DA@GAAD@D@@I@A@A@@@AAA@A@CBA@@@A@A@C@A@@EC@A@C@C@E@@@@B@BABCA@AK@@EF@B@@ABAB@A@CAA@BA@A@A@@C@CAC@ABA@@@A@AAA@@@@@BA@AE@AACAB@@@@@B@@@B@B@@A@@B@B@BBB@BA@@@@@@@A@@@A@AA@AAA@@@@A@@@@@A@@@@B@@@@@B@DB@@@@B@B@@@B@B@B@BA@@BA@@@AB@@@BB@@@@B@@@BA@@@@@A@@BA@@@A@@@@@@A@@@A@@@@@A@A@@B@@@B@@@@A@@@AA@A@@@@@AB@@@B@@@@A@@@AB@@@@A@@@@@@A@A@@@@@AA@A@@@@A@@@A@AB@@@@@@@@A@@@@@@@@A@@@@@AA@@@A@A@AA@@@@@@A@@@@@@@BA@@BA@@B@@A@@BA@@@A@@@@@@@@AA@BA@@@A@A@@@@@@A@A@@@A@@@@@@B@@@BB@@B@@A@@@@@@@AA@@A@@@@@@@AB@@@BBB@@@@@BA@@A@@@@A@@@@@@B@B@B@@@@@@@@@@A@@@A@@@AB@@@@A@@A@AA@@@@B@BA@@@A@@@AA@@@ABAB@@A@@@A@@A@@BAD@@@@A@@@@@@A@@@AB@@@@A@@AA@@@@@@A@@@@@@B@B@BA@@@A@@@@@@A@@@@@AA@@@@B@@@@@@@B@@@@@@@BA@@@@A@@@A@@@@@@@@A@@@@B@@@AA@@AA@@@@@@B@@@B@@@@A@@@@@A@@@A@@A@@@@A@@A@@@@@BA@@@A@@AA@@@@BA@@B@@@@@@@BAA@@@@@A@@@A@@BA@@@A@A@AA@@@@@@@AB@D@@@@@@@@@@AA@@@AAA@@@@@BA@@B@@@BA@@@@@@AA@@B@A@@A@@@@@AB@@@B@@@@@@@BA@@@@@A@@@@A@A@AA@@AA@A@@@A@@@A@@B@@B@@B@@@@@B@@A@@@A@@@A@@B@@@B@@@@AA@@A@@@A@@@AB@@@BB@@B@@@@@@@B@AA@@@@@@@AB@@@@A@@@@@A@@B@@@B@@@@@BA@BB@@@@@B@@A@@BAA@@@@@@@A@A@A@AB@@@@@@A@@@@@@AB@@@@A@@@@@@BA@@@@B@@@B@@@B@@@A@@A@@@@@A@@@@A@@BA@@@A@A@@@@@@A@@@A@@B@BB@@@@BAB@@@BB@@BB@@B@@@B@@AA@@AA@@@@A@@@@A@@AA@@@B@@@@@BA@@@@@@@@CA@@A@CA@@@@@BABAB@@@@A@@ABA@AB@@@CA@A@@CA@@@@@@AB@@BB@@@@@@ABAA@B@@AB@@AB@@ABA@@@AB@@@B@@ABA@A@A@C@C@ECICGC@A@@KAGAA@EACAGCQGEBDBHB@@@@@BAB@BA@@@KAA@A@@B@@A@@BA@BB@@@@@@CB@@@B@@B@@@@BA@ADIABGA@EAGDBBEBGFA@CD@AABABGCCAC@A@@@@@@AB@AAAAAA@@A@@@ABABABA@DDIDCCEBKBGBA@CAEBEBGG@@CAK@cNmBMUMFIDKDA@SH[A@@@AA@A@@AB@BA@@@@@@A@@@@A@@@A@@@@B@B@B@BA@@@@A@@A@@@A@@BAB@@@@A@@@@@A@@BAB@@@@A@@@@@A@@B@@@@@A@@A@A@@@AB@BA@A@A@@@AB@@@@ABA@@BA@ABC@A@@BA@@B@@@@@B@@@@A@@@A@@@@@A@@@AB@@@B@@@B@@ABA@@@A@@A@@@@A@@DEDAB@@A@@@@@A@@@A@A@ADA@A@C@I@@BC@C@KBG@KBI@A@A@A@@@A@@@@@A@@@@B@B@@@@A@@AA@@A@@@@@@A@@@@BAD@@AB@BA@@@A@@A@A@@@@@B@@AB@@@@A@@@A@@@A@@B@@ABAB@@AB@B@@A@@AA@A@ACA@@AA@@BA@@@@@AA@@@@A@ADM@@B@@A@@@@@AA@B@@A@@A@@A@@@@B@BA@@B@@@A@@AA@@AA@@@BAA@@A@@@A@@@@@AB@@A@@AAA@@A@@AA@@@AB@A@@@AA@@@A@@B@BA@@@@@@BAB@@A@@@@@A@@BAB@@ABA@@@@@AA@A@@@@A@@@@BA@@B@@@AAA@A@AA@@@@@A@@BAA@BAAA@C@@@@@@@A@@@A@@@AB@@@@@A@@@@AB@@@A@@@A@@AC@@@@@@A@A@A@A@A@@@AB@@@@@B@@@BA@@@@B@@@@@AA@@BA@@B@@@@@@@CA@@AA@@B@@A@@@AA@@@BA@@@@A@@A@@@A@AA@@@BA@@@@A@AA@@@@@AB@BC@@@A@@B@B@BA@A@@@AB@AA@@CA@@@@@ADA@@@A@@@@@AB@BB@@@@@@@A@@@@DA@@BABB@@B@@BB@@@@@B@@A@@AA@@BA@A@@D@@@@AA@AA@A@@B@@@B@@@B@@@@@@ABAACCO@@A@@@A@K@BDI@A@A@@@@B@@F@D@@B@@@@C@G@@@A@@@@@@BB@B@B@D@D@@@@@@B@@@B@@E@C@AAQBGHOPADA@A@@A@@A@A@AD@B@@@@A@CC@@@ABAA@A@AAA@@BA@@@@AA@@AB@DE@A@A@@A@BCBCB@@A@@A@@@A@@BEF@BCF@@@@A@@@BCBA@A@@BCBA@AB@A@@AA@A@@BAD@BA@AA@AB@@AA@AA@@E@@@@A@@BAA@A@@@AB@B@@AB@@@@@@@@@@@ABA@@@A@@A@@@@@@@AB@B@@@@A@@@@@@A@@@@@@@@A@@@@B@@A@CA@@@@@ABA@@AAAAA@@@A@AB@BAB@@@@GBE@A@@@@@"],encodeOffsets:[[127999,44972]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
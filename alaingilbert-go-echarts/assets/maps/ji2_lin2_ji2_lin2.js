@@ -0,0 +1,5 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("吉林",{type:"FeatureCollection",features:[{type:"Feature",id:"220202",properties:{name:"昌邑区",cp:[126.574709,43.881818],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@ABE@@@CBA@A@@BCBAB@BC@ABA@C@AACAAACEECAACCACAGAE@G@GBA@CBA@EBABC@CBBB@B@B@D@D@@@@@@@B@D@B@@@@@@@BB@@D@B@@@B@@@@@B@@@@@@@B@B@B@B@BA@CA@@A@EAA@CA@@ADDBADA@@B@@@@@BAB@B@@ABIACC@@@AA@A@@@A@A@BAHCBABCAA@AA@EBADAB@@A@@AADA@CBABA@BBAB@@CD@@CDAB@@GF@@@@CDCDAAABCBA@ABABA@@@AA@AA@@A@@@@@B@@AB@BA@@D@BABAB@B@BAB@BA@@@@@@@@B@@@@A@@@@BA@A@A@A@A@@BA@C@@@A@AB@@@BA@@BB@BBBBD@BBBBB@BBB@B@@@@@@@B@AB@@@@@@@@@@A@@@@B@@@@A@@B@@@@@@@@A@@@@BA@@@@@@@A@@@@@BB@@@@@@@B@@@@@@B@@@@B@@@@@BA@@B@@@@@B@@@@@@@@@@@@@@@@@@CH@BBD@DBFDDFA@DH@BB@D@@CBG@IB@BBBBBBFBAB@HCHADAB@D@H@F@BAD@BA@@BCBADCDABE@ABE@A@C@C@@@A@CDCBC@@BADCBA@@FADCFAD@HAF@B@F@F@F@D@B@@@B@D@@@D@B@FAF@DA@@B@DAFCB@B@BABABABA@A@A@EAC@A@AAC@C@@@AAC@A@A@A@A"],["@@AAACAA@A@C@A@CBABADAHAH@B@BC@C@CCCCAGDAB@AB@@CCAC@@@BAAAAAKEA@A@@B@@@BABCBA@ABCDEBABA@A@@@AB@@@B@B@@@@@@@@@@@@@B@@@@@B@@@@@B@@@@A@@B@@@@@B@@B@@B@@@@@B@@AAA@AA@@A@A@@BAB@@A@@@A@A@@AA@AA@@A@C@A@ABA@ABA@@@@@@@@@A@@@@@@@@@@@@@@@B@CACBA@CBA@CB@@A@A@@AAAAAAAAAA@@@A@@@@@BB@B@@BBA@@BA@@@CACAA@AA@@@@ABAB@@A@@@A@@@@@@BABB@@B@@ADABA@@@@@AA@@@A@@@AA@A@A@A@CBA@A@ABA@A@ABA@@BAB@DABBBBB@@BBB@@BA@ABC@@D@BBBBB@B@@@B@BA@A@CBCBCB@@AB@B@@BB@BDB@DBD@BA@@BA@A@A@A@@@@@@AA@@A@@@@@A@@@@@@@@@A@@B@@@A@A@CAA@G@E@AAI@C@EAA@@@@@@@A@@@A@@A@AB@BA@@@AA@D@HCAC@BACIF@@A@@AB@A@@AA@@@@@@@@@@@A@@@@@@@A@@A@@A@@@BA@@@A@@@@@@@@B@@@@@@B@@B@@@@@@@B@@A@AAAAAAACAABABAB@@@@@@@@@@@BA@@BAB@@@@AB@A@@A@@@A@@@@A@@A@@@A@@A@@@@@@@@AA@@@@@@@@@@A@@A@@@@@@@@AA@@@@@A@@@@@@AA@@@A@A@@@A@@@A@A@@@@@@AA@@@@@@@@@A@@C@AA@@@@@AB@@@B@@A@@@@@@AB@@@@A@@@@B@@@B@@AB@@@B@@A@@@@B@@@@@@A@@B@@AD@BAB@@ABA@A@AAA@E@CA@@A@ABA@ABEAA@AAAECBCBCA@@CA@@A@A@A@@@A@A@A@@@AA@AACAA@@B@@@@A@@@A@@@AB@@@BAA@A@EBC@CBCBA@@@AAA@CCA@CAA@AAAAA@@@@A@A@C@@A@@@C@@@ABA@@@ABC@@@@@ABA@CAA@C@AAA@CC@ACC@@A@A@@@A@A@@@@@@B@@@@@B@@@@A@@B@@@@AAA@AAC@A@A@A@A@@@A@@ABAB@AAA@CA@@A@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@AA@@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@A@@@@B@@@@@@@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@AA@@@@@@@@@@@@B@@@@@@AB@@@@@@@B@@@@A@@@@A@@@@@@@@@@@AB@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@B@@@@@B@AA@@@@@@@@@@@@AB@@@@A@@@@@@@A@@@@A@@@@@@A@@@@@A@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@A@@@@A@@@@@AA@@@@@@BA@@@@@@@@@A@@@@@A@@@@@@@A@@A@@@@@@@@@@@@AA@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@AA@@@@@@@@@@@@@@AB@@@@@@@@A@@@@@@@@@@@AB@@@@@@@@@B@@@@@BA@@BA@@@@@@@A@@@@@@@@AA@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@A@@@@@@A@@@A@C@E@@B@@@B@@@B@@@BAAC@A@CA@@EA@AA@@A@C@C@@AAA@EAAADAA@@@ACD@@@B@BAB@@@@AA@AAA@@@@@@@AAA@A@A@@@@@@@A@C@C@A@ABCBAC@AEAA@A@@B@@@@AB@BA@A@AB@BA@@B@@BB@@@@BB@BB@@B@@@BABCD@B@B@B@BBB@B@@@@ABA@C@@@A@A@A@A@@@A@@@@@@B@BA@@@A@@@E@C@CB@@A@@A@@A@A@@@AA@@@@@@@BA@@@@@@@A@@@@@@@@@@B@@A@@@@@A@@@@@@@@B@@@@A@@@@@@B@@@@@B@@A@@@@@A@@@AB@@AB@@@@@@AB@@@B@@@@A@@@@@@@@B@@@@@@@@@@@B@@@@A@@@@@@@A@@@@@@@@@AB@@B@@@@@@@@@@@@@ABA@@@@B@@@@B@@@@@AB@@@@@@@BBD@B@@BBD@DBB@AB@B@@ABA@@@@B@@B@@@BB@@B@@@B@@ABB@@B@@@B@@@@@@@BA@@@@@A@@@@@@@@@@@@A@BA@@@@@@B@@@@@@@BADCB@BADCB@D@BA@B@@DBDBFDDBBC@AHBD@B@@@@A@A@ABA@@BBDB@@@BBB@@FBDB@@@@DBB@DB@@@@B@@@@@B@@BB@B@ABA@CBA@@@@B@B@@@BA@@@AACAAA@AA@G@C@A@@BA@BB@@@@@B@@@@BB@@B@@@@BB@@@@@@@@@BB@@@@@@@@ED@@A@@@A@AAA@@@AD@@@BB@BBB@CBABCBAB@D@B@@ABA@@B@@@@@@BB@@@@@@BB@@BB@@@B@@@@@@@B@B@@@B@@A@@B@@@B@B@DA@@BA@@@@@AAAA@@@AA@EDA@@@A@@@A@@AA@A@AAAAAA@ACAAAEAAAC@CA@@@@@B@@@@@@@B@@@@BB@@BB@B@@@BAB@BAB@B@BA@A@@@A@A@AAA@BBBB@@CDDB@@ADA@AB@AC@@@AA@AA@@@@@A@@@@@A@@@@@A@@@@@A@@@A@A@@@@@A@@B@@A@@@@BA@@@@@@BA@@B@@@B@@A@@B@@@@@@@@A@@@@@@@@BA@@B@@A@@@AB@@A@@@@@AB@@@@A@@B@@@@A@@@@@@@AB@@@@A@A@@@@@A@@@@B@@@@AB@@A@AB@@@B@@A@@@@@@@AB@@@@A@AB@@A@@@@@A@@@@B@@@@A@@B@@@B@@A@@@@@@@A@@@@BA@@@@@A@@BA@@@@@@@A@@@@A@@A@@@@@@B@@A@@@@@@@@@AA@@@@A@@A@@@@A@@@@@@AA@@@@@@@@@@A@@@@@@@@A@@@@A@@@@@@@@@@@A@AA@@@@@@@@A@@@@@ABABABC@A@@C@C@@@A@@BA@@@A@@@@@AB@@@@A@A@A@C@C@@@C@@BA@@@ABA@A@A@A@@@C@A@ABAAA@A@A@A@CA@B@@@@A@@@@BA@A@@BA@@@@@AB@@@@A@A@@@@@A@@@@@A@@@A@@@A@@@@BA@A@A@@BA@A@@@A@@@@BA@@@@@A@@@A@@@A@@AA@@@@@A@@@@@@@A@A@@@A@@@A@@@@@@@@@@@A@@A@@@@@@@AA@@@@A@@A@@@@@@@A@@@@A@@A@@@A@@@@@A@@@@@A@@@A@@@@@@@A@@@@@A@@@A@@@A@@@A@@@A@@@A@@BCBCDCDAB@@BDBBEB@AA@AB@@A@@@CB@@@@A@ABA@@@AB@BABABC@@B@B@D@@BBBB@B@@@@@@@B@D@@@B@@B@B@@B@@@B@@A@BB@@@@BB@D@B@@ABABAB@BB@BB@@BBAD@@BBB@@B@@@@AB@@@@BB@@@@@B@@A@@B@@B@BBFDDBBB@@@B@B@DBDDB@BBBBBB@B@B@B@B@@@@@B@BB@@BB@BB@@B@B@BBDB@@BD@B@F@B@B@BBB@@@B@@B@@@BB@@BBBBB@@@B@D@B@B@BB@D@BBB@@BDBB@@@BB@B@@@BB@@@@@BAD@BAB@DBB@B@D@@@B@@@@@@@AD@D@@@B@B@BBD@B@@@B@@@B@BBB@BBBDBDBBBD@BBB@BBBB@BBB@@@B@B@B@BBDBBDDDBDBB@B@@@BBB@@B@@@@@B@B@@BB@BBBBB@B@BA@@@@BBDBB@BB@BBD@DBBB@@DBBBDBB@@B@D@@BB@BB@@@B@D@@@B@BABABA@@BAB@D@B@B@B@@A@ABAD@BABA@A@@@A@@BAB@B@@@BA@@@@@@BABBB@@@B@@B@BBBBBBB@BB@@@BAB@@@@BB@@BAB@BAB@@@@@B@@ABA@A@A@@BAD@BA@@BBB@@@BB@B@B@B@@@B@@@FBBBF@BBB@BBBB@@B@@@B@@@B@B@D@B@DBB@BBB@@@B@@B@@AB@@AB@B@@@@A@@BA@ABA@@BB@@B@@@BA@BB@@@B@@@BB@@@@@B@B@BBD@BB@@@B@@ABAB@B@@@@@B@@BBD@FBB@D@B@B@BBB@@B@BB@@B@@@@BB@AB@B@@A@@@@@@B@@@BB@@B@B@@@BABAB@BAF@BAB@D@@@B@B@B@BBB@D@B@@@BABABAB@@AB@B@B@B@@@BB@B@@BB@BB@DBBBB@@BBD@B@@@BBBB@@B@@@B@B@@BB@@BBB@@@BBBBB@@B@@B@@@B@B@DAB@F@@@B@B@@B@@@BAB@@@@BBB@D@F@B@B@@C@@B@@@BBBBBBB@B@B@B@D@D@D@@@B@@@B@B@B@@@B@@AB@@@B@@@DBB@B@B@@@BBB@BB@@B@@@BABABE@A@@@A@A@A@@@A@@@A@A@@AAA@@@@@@A@A@@BA@@BA@@@A@A@@@A@@@AB@@B@AB@@ABA@@@A@@@D@@BD@DBBBD@@@FB@BBBB@BBDBBBB@BB@B@DAD@@AF@@@B@@@BB@@BB@@@@@B@@@@C@@BA@@B@@AD@@BB@@BB@@@B@BAD@D@B@@BBB@B@D@DADAD@@AB@B@B@@@B@D@B@B@B@B@@@D@@@DA@@B@BA@@B@@@B@B@@AB@B@B@B@B@B@B@DCBABABABC@@BAB@BAB@BAD@@@B@FCBA@@@BB@@@B@B@B@@@B@B@@@B@@@@@B@@BB@@@@@B@@@@@B@@@D@FBB@@@BAD@DABAD@B@B@DB@@BBBB@@@BB@@@@@B@@@B@@@B@@@B@@@B@B@@@@@@@BBB@BB@B@@BB@@@@B@B@B@@@B@@@@@B@B@@@@AB@@@@@D@B@BB@@B@@@@A@A@@BA@@AC@@@AB@@@@@DBB@D@@@B@BA@@@@B@@BB@@B@@@@@B@@@@BB@@B@@ABC@@BA@@@A@ABA@@B@@@@@BD@@B@@A@@AA@A@@B@@@@@BBB@@@B@BC@@B@@@@@B@B@BBB@@@B@@@B@B@@@@@@BA@BB@@B@DB@@BAB@@@@@B@@@B@B@B@@@B@B@B@B@B@BBB@D@D@@@DBB@BCGAC@CAAAAE@@EGCECEKOGKACEEGCCA@AACACACCAB@BAAA@@BA@BBA@@B@@@BAB@@AB@B@B@@@DA@@BADA@BB@@B@@B@@@B@@A@@@@B@@B@@@B@@B@@@B@B@B@@A@CAC@AACAA@@@ABADABA@@@AAACAACACAGAEAIAA@ACKAEDEBE@@@C@ABABADABADABAB@BAB@FBD@DBDADCBAFCJAJAH@HAFAJEDCFE@CBE@AAEACEEAE@GBEBC@I@CACAGBG@EDIBC@ADEBADCFE@@DCDADCBCBABABA@A@C@C@@@AAA@A@@@A@A@ABC@ABABA@ABC@A@A@C@@CCCAAAAACAI@AAE@AACAAACC"]],encodeOffsets:[[[129636,44917]],[[129517,45001]]]}},{type:"Feature",id:"220203",properties:{name:"龙潭区",cp:[126.562197,43.910802],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CBE@EBA@C@@@C@A@@@A@C@E@E@E@A@E@GBC@EBCDEB@@ABCDAB@@ADCD@D@B@@@D@D@BAF@BAFCBCDABAD@@ABC@ABE@G@C@A@CBGBGDA@ABI@G@E@E@A@G@GBCBABAB@D@B@D@BBBBDBBDDBBDBBBF@BBJ@DBBBBBDBDD@@@D@B@BAD@BABAB@BAD@B@B@B@@@BBB@B@@@D@D@BABABABADCDCBCD@@EFCDABCF@BADCJ@FAHBHBD@D@JADAF@HBFFFBDBF@BAF@DEFCDIFEBGBG@IBIBEDABCDCBCAC@EAA@ABA@ABCBABCBABAB@B@D@@AFCFBFDL@BBBBJBFBHBDBDDBBB@B@@ABCBAB@B@@BBBD@BBD@D@BA@A@A@@@A@@@@A@@@AA@@@@@@BA@@@A@@@@AA@@ACBAB@@CB@@A@A@A@@BA@AB@@A@@@AB@AAB@@BBABA@DBBDBDBD@BDBHDFFBDHLLPDFDFFH@@BFBBDBD@HBDBJDDDDBDFDD@F@B@BADCDAB@D@D@DBDB@BDBDFFFFDBBBDBDBF@F@DB@@HBDBB@BBDB@@DFBDBF@B@DADHB@@BB@@@BCBAB@BDB@@BB@@@@@BB@DBB@B@@A@@@@B@@@D@B@@@D@BAB@B@@AB@@@D@BBB@@@B@@AB@B@B@FADCBA@@@A@@A@@AD@B@@@BA@@B@@@@@BB@A@@@@@A@AD@@AACAC@@@@B@@@@@B@@@@@@@BB@@B@@@BBB@B@@@BB@@B@@@B@@B@@B@@BB@BB@@B@@@B@@@FD@B@BBB@B@@@BAB@@AB@@AB@B@@BAB@B@@@BA@@B@D@B@@@D@D@@@BB@@B@D@@B@@BA@@B@@ABA@@@@@@@ABA@@B@@@@A@@@@BAB@@AB@@@BA@@B@@@@AB@@AB@@@@@B@@@B@@@B@@@@AB@B@@AB@@@B@BBB@B@@@BA@@@@@@BA@@@@B@B@@@BAB@@@B@@AB@@@B@B@D@BAB@@@@@BB@@@@B@@@@@@@@B@@@B@B@@@B@@B@@BB@@@BBDCBA@AB@@A@@BAB@DBD@BBBBBB@@B@BBF@FBB@BDB@BB@@@@@DB@@B@B@@B@B@D@D@@@H@J@DAB@B@BAFABA@@D@B@@@BB@BBB@@B@B@BA@@BB@@@@AB@@@@A@@B@@A@@BA@@@A@AB@@A@A@@@@@A@@BB@@BB@@BBD@@BBDBBBBDBDBB@BBDBBB@DDBB@B@BB@BBB@BBBB@BDB@@@BBBB@@B@@@@@BA@@@@BB@@@@BA@@D@BADA@ABABABA@ABBD@BB@BBD@@@@@@@B@@@B@@@@@BDB@@@@@B@@@B@F@@@B@@@@@BBB@@BB@BBB@BB@@B@D@@@@@B@@@@@@@@@@B@@@@@B@@@@AB@D@@@@@@@B@@@BBB@B@B@@@@@@@@BDBB@@B@BADCBADC@@FA@@B@@@B@@B@@B@DA@@B@@@@@BB@@BAD@DDB@D@@@DBDABB@@BA@AAA@AB@@AB@AA@A@AB@BEDGBABAA@A@A@CEA@@AGE@AA@@A@@@@GC@A@@A@@CAE@@@C@A@@@AACAA@@B@@ABC@@BEBBB@B@B@F@B@B@DB@@B@@@BBF@DBBBB@@BB@@@@BBFBFBB@B@@B@@@BBB@@@F@@@@@@AB@@A@@@A@@@@@AB@BA@@B@BAB@B@@@BA@@@AB@@@@B@@B@@@AA@A@E@A@@DAB@@A@A@@@A@@@@BABCBA@ABA@AB@BA@@B@@@@A@AB@@A@@AA@AA@@A@@@@@@BA@@@@@@BA@A@@@@BA@@B@B@BABAB@F@DAB@BAB@BB@@B@B@@@B@B@@ABA@@B@B@B@B@DAD@@A@@@A@@@@A@AAA@@AAACACAAAAAAAA@@AAAB@@C@A@@A@@@A@@@@AA@@@@ADBB@@A@C@@@@@@@@B@@@@@@@@@@@B@@@@@@@@A@@B@@@@A@@B@B@@@B@BA@@B@@@D@@@B@BBB@BAD@@AB@@@@A@AAA@@CCBAA@AA@@@@A@@B@@@@@@ABA@@@@@A@A@@@A@A@@@AAA@AAA@A@AAAAAAA@AAAA@A@AA@AA@AAA@AAAA@AAA@A@@@@A@@@@@ABA@A@@BA@AB@BCB@@A@A@ABA@A@@@@@A@@@@@A@@BAB@BA@@DA@@@A@@@@BA@@BA@@@@AABA@@B@@AA@BA@@@ABA@A@C@A@ABABA@ABA@@@@@AA@@AAC@@@AAAAA@@@A@@BA@@B@B@B@BAB@@BB@BAB@@@B@B@B@BC@@@A@A@A@@@A@@@A@@@@@@@A@A@@AC@@BC@@BC@ABA@AA@B@@A@@@@BA@@@@@@@A@@@@BAB@HC@@@A@@@@B@B@@A@@A@@A@A@@BCBA@AB@B@@A@@BC@@B@BCBABA@@B@B@@@@A@A@AAA@A@@@@@AB@@@@A@@@@@@@A@@@@@A@@@AB@@@@AA@@BCB@B@@@B@B@BA@A@@@A@@BAB@@@@@@@@AA@@B@@C@A@@@@@AA@@@@@@A@@@@A@ABA@C@A@A@@@A@@@@@@@A@E@@@@@ABAAABA@AB@@AB@@@@@BA@AB@@A@@@BB@@@@@@@BA@A@@@@@@@AB@@@@AB@@@AA@@@A@@AA@@@@@@@AA@BA@@@A@@@@AA@E@EBC@C@A@@@@@BA@@@@A@AAAA@A@@@AAA@@@@AA@@@A@ACE@ACGAAA@AAA@@A@A@@A@CAAA@@@A@A@AA@@ABAB@B@BABAB@B@B@@AB@@@BB@@@@B@@@B@@A@@@@@@@A@@@A@A@A@@@@BA@@@ABA@A@@@@B@@AB@@@@@@@@A@@@@@A@@@A@@@@@A@@@AAA@A@A@A@A@@@A@@@AA@CAC@AAC@C@@@AAA@C@A@@AA@A@@@@@@@AB@@A@@@AAA@@AA@@A@@@AB@B@@A@A@@@A@A@@@@B@B@@@B@@@@@B@B@B@B@BAB@@@BA@AB@B@B@BAB@BA@@BAB@B@B@B@BAB@@@@BB@@BB@B@@@@@@@@A@A@A@A@@@AA@@@BA@A@@@@@AAA@@@@BABA@@BA@AB@DA@@BA@AB@@@BA@B@@B@@@BA@@@@@@B@BA@@@A@AB@AA@A@@AA@@@A@@B@@@B@BAA@BAB@@AB@@AB@DAB@B@B@B@BB@@@@@@B@B@BBB@@@B@B@B@@AB@@@@@B@@@@@B@@@@@B@@@@@D@@@BB@@BB@BB@@B@@@@@AB@@@B@@A@@@@B@@@B@B@@A@@DAB@A@@A@@@A@@@A@@@A@@@@BA@@@A@@@@@@@A@@B@@@@@B@@@@AB@@@@@@AB@@@@@B@@@@@@AB@@A@@@@AAB@@@@@B@@@B@@@@@@@@AA@@@@@@@A@BA@@B@@@@A@@B@@@BA@@B@@@@A@@@A@A@@@@AA@@@@@A@@@@@AB@@A@@@@@AA@@@AA@@@@@A@AB@@@@@BA@@@B@@@@D@B@B@@@@@B@@A@A@@@@B@@@B@B@@@@@B@@A@@@A@@B@@A@A@@@@@A@@@A@@@@BA@@@@@A@AB@@A@@@@@@@A@@B@@@BAB@@@BAB@DA@@B@@@@AB@@@@A@@BA@@@A@@@AA@@@A@@AA@AA@@A@@@AA@@A@A@@@@A@@@@@@AA@@@@@AA@@@A@A@A@@@A@A@AA@@@@AAA@@AAA@@@A@@@@@A@@A@AAA@AA@@AA@@@@@A@@@A@A@@@AA@@@@A@A@@@AGC@@@G@@@AF@B@B@@@B@B@@@B@@@ACAC@AAACC@A@@@@@ABA@@BA@@BA@@@A@@@AB@@A@@@@BA@@B@@A@@@@@@@AB@B@B@@AB@@@B@@AB@BA@@@@B@@@@@DAD@BAB@D@D@@@BA@@B@BA@@@A@@@A@@@@@@@@@@@@@@AA@@A@@@A@@AA@@AA@AAA@@@AAA@A@@@AAAAA@@AA@@@@ACA@@A@@AA@@@A@@@A@A@A@AAA@A@@@@BA@A@@@A@@BA@@@AB@@@@ABA@@@ABA@A@ABA@ABC@@BA@A@@@AA@@@@@@@A@@AA@A@@@@@A@@@AB@@@@@@A@@@@@@@@@EAA@A@@B@@@B@B@@@B@@@B@@@@@B@@@@ABA@@BA@AB@@A@A@@@A@@@@BA@@BA@@B@@@@@@A@@@A@@B@@@@@@@BA@@@@@@@A@@@A@@@A@A@@@@@@@@@@@@B@@@@@@@BAB@BA@@@@@@@@@@B@@@@@@@BA@@D@B@@@@@B@@@@@@@@@@@@A@@@CB@@@@A@@@@B@@@@@B@@@@A@AB@@A@@@@@@@A@@@A@A@@@AA@@A@@@@@A@@B@@@BA@@B@BAB@@@@@@@BA@@@@@@@@@A@@@@@@@@BA@AB@@A@@@AB@AA@@A@@AA@@A@@@A@@@AA@@A@@@AA@@@@@@A@@AA@@@A@@@AA@@@AA@@C@A@@@A@@@C@A@@@CA@@AA@@A@A@@@A@@AA@@@BA@@@A@@@@@@@A@@@A@@@AA@@A@@@AAAA@@A@@@@@@@A@@@@@@A@@AA@A@@A@@@@AAA@@@@C@AAA@@@A@A@AA@@@A@@AA@A@@@@AC@@@CBCB@@AB@@A@A@A@AAA@@@A@AAA@@@@@A@@@A@@@A@@@@@A@@@AA@@A@@@@@@@AA@@A@CA@@A@@@@@@@ABA@@AA@@@@@AA@@@@@AA@@@C@CA@@@@A@@@AAA@A@A@A@@AA@AAA@A@EAA@A@C@@@C@CBA@ABA@@@ABA@@@A@A@@@A@@@A@A@@@@@A@@BAB@@@@@B@@@@@DB@AB@B@@A@@@A@A@@@AB@@A@A@AB@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@B@A@@A@@@@@@@A@@B@@@@A@@@AB@B@BBB@@@B@@A@A@@@@BCDABAB@@A@AAAAAAA@CAC@CAABABABABADC@@BABA@@@@B@@@@@@AB@@@BAB@BA@@B@@BB@BAB@@ABCB@@A@ABA@A@EBA@E@A@ABA@@@A@@@@B@@@BA@ABA@@@@@@@@@AA@@@@@@A@@@A@@@@@AA@@@@@@C@A@A@@@A@AB@@@@AB@B@@A@@@@BA@@@A@@@@@AB@@ABA@@@A@@@A@@@@@@AA@@@@A@@@@@@@@@AA@@@A@@@A@@@@@@@A@@@A@@@A@A@@@@@A@@@@@A@@@A@@@@@A@@@@B@@A@@@@A@@A@@A@@A@@@A@A@@@A@A@AB@@@@A@@@@BAB@@AB@@A@@@A@A@@@@@A@@B@@@@@@@B@@@@@B@@@@@@@@@@A@@B@@@@A@@@@@@@@@@BA@@@@@@B@@@@@@ABA@CBCBC@A@C@E@G@ABA@@@@@@@@A@@DA@AB@@@AA@AA@AA@@C@A@E@@@A@ADAAAAAAA@A@@@@B@@@@@@C@A@A@AB@@@@@D@BAD@@@B@@C@A@@AA@AAC@@B@BBD@B@@@DBD@B@BBD@F@B@BABABABABA@A@EDCBA@"],encodeOffsets:[[129627,44944]]}},{type:"Feature",id:"220204",properties:{name:"船营区",cp:[126.540966,43.833445],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AAA@A@C@EA@CC@CABA@@BA@@@A@A@A@@AA@@@@A@@AA@A@AC@AAC@@@A@C@A@@AA@@@@@@@A@@@@@@@AA@@@@@@A@@B@@@@A@@@A@@@@@@@A@@@A@@AC@A@@@A@A@A@C@CAA@C@AAA@AAC@@@A@C@GAA@A@A@@BAB@@A@A@A@A@A@@@A@C@A@@@@@A@C@C@C@A@@@A@@@A@@@CAA@@@@@A@@A@@@@@@A@@@@@A@AB@@A@A@CA@@A@A@@@A@@@A@A@A@AA@@A@@@A@@AA@@@C@@@A@@@@@A@AA@@A@@@A@A@@@@AA@AAA@A@A@A@@BA@A@@@A@A@A@@AA@A@AA@@A@AB@@AAA@@AC@AA@@A@AA@@@@@@@A@@AA@@@@A@@@AA@@@A@@ACAA@A@@@@A@A@A@A@A@@@A@@AA@@A@@A@@A@@A@@@@AA@@@@@@@@@@@BA@@@A@@@A@@@@@AB@BA@@@A@@@C@@@A@@AAAC@@@AA@@@@AA@@AAA@@@A@@@AA@@@@@AA@@@@@@@@@@@A@@@AA@@@A@@AA@A@A@@A@@@@@@AA@@A@AAA@@@A@A@@@AA@@A@@@A@@@A@@@@@@@A@@BA@@@A@@@A@A@@@EB@@C@C@@@A@A@A@@@A@AB@@@@AB@@A@@@AB@@AB@BA@AB@@@@A@A@A@@BA@@@@@AB@@A@@@A@@@@@@@@@@@@A@@B@@A@AB@@A@@@@@A@@@@AA@@A@@AA@@@@@AA@@@@AA@@AA@@AAA@A@@@AA@@@@@@@A@A@A@@AA@AA@@@AAAA@@AA@@A@AA@@A@@@A@@@@@A@@@A@A@BA@@@@@@@@@@AA@@@@A@@@A@@@@@A@@@CB@@A@@@@@A@@B@@A@@B@@A@@B@@@@@CAA@@@@ACCCAAAEA@@@A@CAA@A@A@C@AAA@A@A@A@@AA@@BA@@@A@@B@@@D@B@@A@@@@@A@A@@@AA@@@A@@@A@AA@@AAA@@A@@AA@@@AA@@@AA@@A@@AA@@@A@@@@AAA@@@@@A@@@@A@@A@A@A@C@@@A@@@A@ABAB@@@@AB@B@@A@ABA@@@@BA@@B@@AB@@AB@@A@CBA@GDCBABA@@B@@@@@B@@@B@@@@@@@B@@@BB@AB@B@@@BBB@B@@BB@@@@@@@BB@@BBB@B@BB@@B@@@@@@AB@@@B@@@BB@@@@B@@@@@@AB@@@@@@ABB@@@AB@@@@A@A@A@A@ABA@@B@@AB@BA@@@AB@@@@AA@@@@@@@@A@AAA@@@A@A@@BA@@B@@@BA@@@@@@@AA@AAAAA@@ACA@@A@@A@A@A@A@A@@@AB@@@@A@@BA@@B@@A@A@@@A@A@@@AB@@@@@@@@@@AB@@@@@B@@AB@@AB@@@B@@@B@B@B@@AB@@AB@@A@A@A@@@@B@@@@@@AB@@A@@BA@A@@@A@@@A@@@A@@B@@@@@@@B@B@@@@AB@@@@@B@@@@@B@@@B@@@B@@A@@BA@@@@B@@@@@B@@A@@@@@AB@@@@@B@@@@@@@BB@@@D@D@D@B@@@B@B@B@B@@@BB@@B@@@D@@@@@@@B@@@@@B@@@B@@@@A@@B@@@B@@@B@@@B@@@B@@@@@B@BA@@B@@@@@B@@B@@B@@B@@B@@BB@@@@@B@@@B@@@B@@B@@@@@B@@@B@@@B@@@B@@@@@BA@@@@@@@A@A@ABA@@@@B@@AB@@@BA@@@ABA@@@A@@@A@C@A@@@AA@@@@C@@@@@A@@@@@AA@@@@A@@@@@@@@BAB@BAB@@@@A@@@A@@@ABA@@B@@@@@B@@@@ABA@@@@@A@@@A@A@@AA@@@A@@D@B@@@BA@@B@@@B@@@B@@A@@@AAC@@@A@@@@BA@AB@BA@@@@@@@BB@BBB@@@B@@@@A@AB@@AB@@@BA@@@@@@A@@@@A@@AAA@@A@AB@@AB@@@B@@@B@@@BAB@@@@A@A@@@@@@@@BBB@@BB@@@@@@BBB@@B@@@BABABB@@BB@BB@@BBAB@@A@A@A@A@@BA@@B@B@@@@B@B@@@B@B@@@B@@B@@A@@@@@A@@@ABA@@BA@A@A@A@@B@@A@@ACAA@AA@@A@CB@@@@@@AB@@@@@@A@CACAAAC@AACAA@A@@@AAA@A@@@A@@@@B@@B@@@@BA@@B@@@B@@AB@BAB@B@@A@@@@B@@@@@@@B@@@@@@@@@B@@@B@@A@E@@@@@@@@@@B@B@@@B@BB@@@@B@@@B@B@B@B@@@BA@AB@D@@ABA@@@@@DDBD@B@BB@BBDB@@B@DBB@B@@@B@@@@BAB@@@BA@@@@B@@@@@@@BB@@@@@B@B@B@@@BA@@B@@@@@@@B@@B@B@B@D@BAD@B@@@@@@A@@@@@@@A@A@@@A@@@A@@@@@@@@BA@@@@BA@AB@@A@A@A@A@AAEC@@@@@B@B@BC@A@A@ABA@A@CB@@A@@@DDDBBBB@DB@@FA@B@B@@@B@@@@AB@@EB@@@BBBB@@BA@A@EBCB@BA@CB@B@@A@A@A@AA@@@D@@A@A@A@@@@A@@@@@@A@@BA@@@@AAAA@@@C@@A@@@AA@@@B@@AB@AAA@@@@AA@@AA@@@A@A@@@@AB@@@A@A@@@B@BA@@@A@@A@@@@A@@@@A@@@@@A@@AB@@@@@AAB@@@@@@@@A@@@@A@@A@@@BC@@@@A@@A@@@@@@@B@B@@ADA@AA@@A@@@@@@A@@@A@@C@@@@AB@@A@A@@@@A@@@@B@AAB@A@C@@@@@BC@AC@ABAA@B@@AA@@ABA@CDCBABABA@C@A@A@A@AB@@A@AA@@G@@@A@@BB@B@B@@B@@@BABAB@B@@@@ABGDA@C@@B@@ABG@A@AB@@@@C@C@C@@B@@AF@BB@AB@B@DA@AD@@FB@@BBBH@B@@@@@@A@AAAA@@A@A@@@AA@@@@AB@@AB@BBB@B@DB@@D@@BBBBBBBDBBB@@@@@BF@@CDBD@BABDDA@@@A@@@@@A@@@@@@@@@A@@@@@@@@@@BA@@@@@A@@@@@AB@@@@A@@@@@A@AB@@@@@@A@@@@@@BA@@@ABA@@@@@AB@@@B@@A@@@@BA@@@@B@@A@@@AB@@A@@@@@@BA@@B@@@@A@@@@A@@@B@@A@@@@@B@@@@@@B@@@@B@@BB@@BDBDA@ABBB@BB@@B@BBD@@@B@@@@@@B@@@B@@@@@BA@@@@B@@@B@@@@@@@@@B@@@@A@@@@B@@@@@@@@@B@@@@@B@@@@@BAB@@@@@BA@@B@@A@@@@@@BA@@B@@@@A@@@@@@@AB@B@D@B@@@@@@@@@@@@@B@@@B@@@B@@@B@@@@@@@B@@@@@B@B@@A@@B@@@@@@@@@BA@@B@@@@@BA@@@@B@@@@A@@B@@@@@B@@@B@@@BA@@@@BA@@@@@@@@B@@B@@B@B@@@B@@@@@B@@B@@@B@@@@@B@@@@@@@B@@@B@@@@@B@@@@@B@@@B@@@@B@@B@@@@@@@B@@@@B@@B@@B@@@@@@@BB@@@@@@@@@@@B@@@B@@@B@B@@@@@@@B@@@@@B@@BB@@@B@@@B@@@@@B@@A@@B@@@B@B@@AB@B@B@@A@@B@@@B@@@B@@@@@B@@@@@B@B@@@@@BA@@@@B@@AB@B@@A@@B@@@@@@ADBB@B@B@B@BBBAB@D@@@B@B@B@B@BA@@B@@AD@@@D@D@B@B@B@@@@@BA@@@@B@@@B@@AB@@@D@D@@@@BADABAB@B@@@@@B@@@@@@B@@B@B@@@@@@@@@@@B@@B@@@@@@@@@@B@@@@@@@@B@@B@@@@B@@@@@@BB@@@@@BB@@@@@@@@B@@@@A@@@@B@@@@B@@B@@@@@@@B@@AB@@@@@B@@A@@B@@@@@@@B@@@@A@@@AB@@@@@@A@@B@@@@@B@@@BAB@@@@@BA@@@@@@B@@@@A@@BAB@@@BA@@@@@A@@B@@@@@B@B@@@@@BA@@@@@@B@@@@@@AB@@@@@BA@@@@B@@@BA@@B@@@@AB@@A@@@@@@B@@@@@@@@@@AB@@@@A@@@AB@@A@@@@B@@A@@B@@@@AB@@@@@B@B@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@BBB@@D@@BBAB@BC@@CADC@@AAAAB@BBB@B@@@B@B@@A@ABA@ABA@A@@@AAA@@AA@@@@@A@@@@@@@A@@@@DBD@BBFBBBDB@BBBBBBBB@B@@BB@@@B@@@B@FCB@@B@@BBBB@@@@B@@AB@@C@A@A@@@AB@@@@A@@@A@A@@@@@@@A@@AA@@AA@@@@@@AA@@@@@@@AB@BA@@@A@CBADABADAA@AAA@@A@@BC@@B@BBB@@@B@@@FC@@@@@@@@AA@@@@@@@@A@@A@@A@@@AA@@@@@A@@@@AAB@@AB@D@H@B@@BBBDBBB@@B@@A@@@A@A@@B@DAB@BAA@A@@AA@@@@@A@@@@@CAA@CA@@@@CAEA@@AA@A@@CAAA@@AB@B@B@B@@A@C@GA@BADCAECCACA@@@AABC@A@CDABA@CDAB@@@@@@A@@@@@@@ABB@@@@@@@@@@@@@@B@@@@AB@@@@@@A@@@A@@@AA@BA@@@A@@@AA@@A@@@@A@@B@BA@@@ABAA@CAC@AA@@@AAC@A@@@@@@BA@@@@A@@@@@@A@@B@BA@@@@@@@@@@@@A@@@BA@@@@@@@@B@@@@@@@B@@@@@@A@@@@@@@@@@@A@@@@@@B@@@@@@A@@BA@@@@@@BA@@BA@@B@@@@@B@@@@A@@@@@A@@@@B@@@@@@A@@@@@@B@@@@@B@@@@A@@@@@@@@B@@@@@@@B@@A@@@@@@BB@@B@B@@@@BB@@@DAD@F@@@B@@@B@@A@A@@@@B@@@B@B@B@B@@@D@B@BA@@@@@AAA@A@A@A@ADCBA@A@@@AA@@AAA@@@@AA@@@AB@@ABAB@B@@ABA@@@@@AB@B@FB@BBDDABAB@D@D@B@@@@@@@B@B@B@BB@@@@@@B@BBB@@B@@A@ABA@@@C@BD@@B@CBBBFBB@BB@@@D@D@BB@@BFB@@DBB@D@BB@A@@@A@@@A@@@AF@D@B@@@B@@@@@@@@BB@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@BB@@@@@@@@@B@@@@@@AB@@AB@@@@A@@@@@@@@@A@@B@@@@@@@@@@@B@@@@@@A@@B@@@@@@@@@@@@B@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@@@BB@@@@@@@B@@@@@B@@@@@@@@@B@@A@@@@B@@B@@@@@B@@B@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@B@@@B@@@@@B@@@@@@@@B@@B@@A@@@@B@AA@@B@@@B@@@@A@@@A@@@@@@@AA@@@@AB@@@@BB@@@@@B@@@@@@A@@B@@@D@@@@@@AA@B@@@BAA@@@@A@@@@DAB@DAD@DAD@DAD@BAF@B@@B@BBDB@D@D@BA@A@A@A@AAAD@D@B@D@DDDDFBB@BCF@@C@AFACABADAD@D@DBDDNAF@DAH@DA@CBEBGAC@CBCDBBABBD@@BBBDABBDBD@BAD@BADABABCDAB@@C@AD@FBBAF@DBF@B@B@D@DDD@DBBBBBDBBABBDB@BDBD@@BDBFBBADBBBDBDBBBFBBBBBD@F@H@JAH@JAJ@J@F@HAD@DABB@@B@B@@ABBBA@@D@DAD@D@@@DCNALCD@@@BBBBDDBF@BBDDBBD@BDBB@@DDBD@BAB@B@B@B@@BB@BBD@B@B@B@@@B@B@B@B@BBDBB@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@AB@@A@@@@@A@@A@@@@@@@@A@@@@@@AA@@@@B@@@@@@@B@@A@@B@@@@@@@@@@AB@@@@@@A@@B@@@@@@@@@@@BAA@@@@@@@A@A@AAA@AAAAC@AAAAA@@AB@@A@@BAB@@@D@B@@AB@B@B@B@B@@A@@B@@@@@@A@@@@@@B@@ABA@A@ABABA@A@CB@@ABA@@@A@@@@@BB@@BBB@@B@BABAB@DABABBDCDC@@@@HE@@BADC@@DC@@BAAAB@BADAB@BC@BB@@@BABCFAB@@BBBADABGDABB@B@@@B@B@@B@@DDJBBA@@@ABA@A@@@@@AB@BCCABC@@DBB@FBB@@@DBB@@A@A@A@A@A@@@@@@@A@@@@@A@@@A@CA@@A@@@@@@@A@C@A@@@@@@@C@C@A@AAAK@IAA@EAA@AAAAA@ECAAAACA@@AAAA@CAA@A@@AC@C@ABA@CBA@@AA@AA@@@@AAAEAEACAEACAEAI@E@"],encodeOffsets:[[129548,44856]]}},{type:"Feature",id:"220211",properties:{name:"丰满区",cp:[126.562274,43.821601],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@G@E@C@AAAAEAAACACAAACAABEACA@AC@CA@ACAAAABCAAAAACAC@CCC@A@A@E@CAE@ABEAC@@B@DA@CBADABCBABC@ABC@CAAACBAA@AC@AAABCAAD@DBDAHAF@DCBG@CBE@MBCCCAC@C@CBABDBEB@B@DE@ADA@EACCCCC@A@C@C@BB@B@B@B@BABC@C@A@AC@A@AA@E@ABC@CBC@CBC@CBA@CB@@@@@B@@B@AB@@A@B@@B@@@@C@@@A@@@@B@@@@A@@@@@A@@A@@A@@B@@B@@B@@@@@@@B@@@B@@A@@@A@@@BBA@@@@@@B@@@@@@B@@@@@BA@@@@@@@@@@@@BBA@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@BA@@@@@@@@@@B@@@@@@B@@A@@@@@@@A@@B@@@@A@@@@@@@@@@@@@BB@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@@@@@@A@@@@@@BA@@@@@@@@@@@@@A@@@@@@@A@@@@@@@B@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@BB@@@DBB@BBA@AB@BB@@@B@B@B@B@D@BBB@BB@@@@@AB@@@@@@A@@@@@A@@@@B@B@@@B@B@@@DD@BDDB@BBD@B@DBB@BA@@@@D@BA@@B@BA@@D@@@B@@@@D@B@B@@B@BBBBB@DBB@DDB@BB@@B@DADAD@FAB@B@AB@@A@@B@@@B@@@B@@A@@@BBBD@BBB@@B@B@B@@@B@B@B@@@DB@@DBDADABFBBB@FBBAB@BAB@@@DBF@B@BBB@B@BA@@BA@ABC@@@AB@@@@@@@@A@@B@@@@A@@BA@@@A@@@A@@B@@@@@BA@@@@@@@BA@@@A@@B@@@@BBD@@@@B@@@@@@@@BB@@@@@@@B@B@@@B@@@B@B@@BB@@@@@@@B@@@@BB@@@@@@@@@BB@@@@@@@@@@@BB@@@@@@@@@BB@@@B@@@@B@@B@@@B@@@@BBA@@@@BA@AB@@A@@@@@@@@@@BABABADBBBBBBB@B@BA@@@@@@@A@@@@A@@@@A@@@@@@@@@@B@@AB@@B@@@@BB@@@@@@@B@@@@@@@@@@@B@@BB@A@@BB@@@JEBD@ABDGDC@B@@B@@ABA@@B@BB@@@B@@@@@@@B@FBD@J@BBF@H@B@DBB@B@@@A@@@@B@@@@@@@@@B@@@@@BB@@B@@@@B@B@B@B@@AB@@AAC@CCA@AAA@@@ABA@@DADADAB@B@@A@A@@@AAAAA@A@CD@BAB@@AA@AA@@AAAABA@CBA@AB@BAB@B@BAB@B@DAB@B@B@B@@B@@@B@@BB@@@@B@BABC@@@AA@BA@A@@@@B@@@B@@@BABA@@@@BBB@DBDB@@B@@AB@AA@@@AAA@@@@B@@@B@BBBBBBBB@BB@B@@@DAB@DAB@DADBA@@@@@@@@@@@@@@@B@@@@@@@@@B@BAB@BAB@D@B@@@BBB@@BB@B@@@B@@@BA@AB@B@@@BBB@BB@@@A@@@@@AA@@@@A@@@@@AB@@@@@@A@@@@@A@@@@@A@@@@@@@@@@@@@A@A@@BA@@B@B@BAFADCBAB@DABA@A@@@AB@B@LFBBBBAB@@D@DB@DA@@BBAHCDBDD@D@DADF@F@H@J@AEAAAA@AJAH@DA@@@CAAG@@CEBCCAE@CAC@ADGA@@@A@CAAAA@A@A@A@@@A@A@A@C@AAA@@AA@A@A@A@ABC@CA@CA@CA@AACCAAC@AAECCAAAA@@C@KDMBCD@@C@C@CBC@@@ABAA@BA@A@@@AACBC@GBE@I@I@IBG@IB"],["@@@@@A@@@@@ABABA@@@@@A@@A@@A@@@A@A@@@@AA@@AAAC@@@A@C@@@@AAA@@AAAEC@@CAAAE@CC@@A@@BA@@B@@@@AB@@@@A@ECA@A@A@A@C@ABA@A@@@C@A@A@ABCBABCBA@@@A@@B@BABAB@@AB@B@@@@@@A@EB@@AAA@@@A@ABA@@BA@@@A@AAA@@@C@A@C@@@C@ABC@@@AB@@A@@@@@@@A@CB@@C@A@AB@@A@AB@DABBBAB@@@@@@@@@BB@@@@B@@@@ABABCF@B@@A@@B@B@BB@@@@B@B@@@B@@@BB@@@@@@@A@@@ABC@@@@B@@A@@B@@@@A@@@@@@@@@@B@@@@ABA@AB@@@@@@@@A@@@@@@@A@@BA@AB@@@@@@@@@@AB@@@@A@ABA@@@A@@B@@BB@@ABABA@A@ABA@ABA@CBA@@@CBABABA@@BB@BDBBBBBBB@@DBB@@@B@@AB@@ABAB@D@@@@@D@@@BBB@@BBB@B@D@B@@@@B@BBB@BBBBBB@DBDBB@BBBAD@DAFB@@B@@@B@B@@@@@BB@@DB@@@@BB@@A@@@@BA@@@AB@@A@AD@@A@@@@@@B@@@BABAF@@A@@B@B@BB@@B@B@DBB@BBBBBBB@B@B@B@B@DAB@B@B@BA@A@AB@@CDCDA@A@EBA@AB@@ABA@CBA@ABA@@B@F@B@B@@@B@@@BBB@BD@@B@@@B@@@@@B@@@@@B@BDB@B@@@@@@@BBB@B@BAB@B@B@@A@A@C@A@A@A@A@A@@BA@AB@@BB@@@B@@@BA@@B@B@@AB@B@B@@ABAB@@CDABB@@BAB@@ABC@AB@@@@BB@@AB@B@BB@@B@B@D@BAB@BABB@BBFBDBFBFBB@BBDBDDFBDDDBB@BD@@@DAB@D@@ABAD@@@BBF@B@@@BBB@@DB@B@BA@ABABADABAB@@@BEFAB@BA@ABA@A@CBA@@@A@@@CB@@@@@B@B@BADAHAB@B@BCFAB@@@BA@@@A@A@ABA@@@@@@B@B@@A@ABE@@@ABABAB@@@BB@@B@DBB@B@@@BAB@BBD@@A@ADA@@@@@@B@@@@@@@@AB@@@@@BA@AB@@@@@@@@AACC@@A@C@A@@@@@A@@BA@@@AB@@@@A@@@@@A@@@@@@@@B@@A@@@@@@@C@A@A@A@AB@@EBCDAB@BA@@@@@A@A@@@A@@@A@@@AB@@@@@@A@@A@@A@A@AAAAA@@@ABA@A@A@A@A@AAA@A@EAC@@@CB@@A@@BA@C@@AA@C@C@@@C@AAA@AAAAEA@@A@@@C@A@A@A@@@AAA@AA@AC@CAA@ABABA@@@AAAAAAAA@AA@@@A@CBAB@@C@@@@@AA@@A@A@EAC@C@A@@@CC@A@@@A@A@A@@AA@@A@@A@@CCAA@A@@BAFCBAB@AA@AA@AC@C@CAC@@@AGCA@E@A@A@AAA@A@C@G@AAAACCAAA@@@E@E@GAC@A@@BA@@BBBABAB@B@@A@CAA@@@@@@@@@@@A@EBA@@@@@@@AB@B@@A@@@@B@B@D@@A@A@A@A@@@@D@F@B@@@DA@@@A@CAEA@@A@A@CA@@@@@BAB@B@@@B@@@B@@@BA@@@@@@BA@@@@B@@A@@BA@E@A@A@A@A@A@@@@B@DA@@BC@ABA@CAA@A@@@@@@@@@@@@B@@@@@BA@@@@@@@AB@@@@A@@@@@@BA@BB@B@B@@AB@BAB@B@@ADC@@D@D@@@@ABA@@@@BB@@BD@BB@@@D@BB@@B@@B@@@BB@@@@@@A@@BA@A@@BA@@@@@A@A@@@@B@@A@@B@@@@A@@B@@@@A@@@A@@@AB@@@@@@@B@@A@@B@@@B@@@@@@@B@@A@@@@@@@A@ABAB@BA@@@@B@@@@@@@@@@BB@@@@B@@B@@@B@@BB@BB@@B@@B@@B@@BDBB@@@B@@@D@@@B@@ABA@@B@@@@@B@@@B@@AB@@@@@@@@@@B@@B@@B@@@@BB@@@@BB@@BB@@@B@B@B@B@B@@@@@@BBBBD@@@B@@BB@@B@@@@@BB@@@B@@@@@@BBB@@@BBD@@BB@BB@@BAB@@@BBB@B@@BB@B@B@@@B@B@@AB@B@B@B@BBB@@B@@B@B@@@B@@@BBB@@@@@B@@@D@@@B@@BB@@@B@@@BBB@B@B@@@B@@@B@B@@@DBB@B@@@BAB@@@@@B@@@@@@@@BB@@@@@B@DB@@B@@@B@@@B@D@D@D@B@@@@@B@D@B@@@B@B@B@B@B@@@BA@AB@B@B@HBD@B@@@D@BBB@BBD@B@DBD@B@B@B@@@B@D@@BB@@@B@@@@@@@B@@@B@@@@@@AB@@@@@@@BB@@@@@@B@@@@@@@B@@BB@D@B@@@D@BBD@@B@BBB@@@B@@B@@BB@B@B@@@B@@AB@BA@DDDB@@F@D@BBB@BB@F@J@FBDBFBDBFBFBBB@B@@B@@BBB@@AB@DAB@B@DBD@@@BBB@DBBBB@@DBBBBBFDB@BBBBB@FBB@JBL@DAD@BAFAB@DAB@HAH@F@HBDBDBBDDBFFBDBBBD@B@DAB@BADA@ABAD@@@BAB@D@@AF@B@B@BD@BBB@@BB@D@@@@A@@BC@A@C@@@@BAB@B@D@@@@@@@@A@@B@B@BBBBBBBCB@@@F@B@D@@@BBB@@BBB@@A@@BCB@@@B@@@@@@B@BAH@F@D@B@D@DADAB@BA@@@@@@@A@@@@B@@A@@@@@@@@B@@@@@@AB@@@@@@@@@@@@A@@@@@A@@@@@@@AB@@@@@B@B@@@B@@@BA@@BA@A@@B@@@@@BAB@B@@@B@B@@@B@@@@BB@@@@B@@B@@@@A@@B@@@@@B@@@B@@@@@B@@@@@B@B@@@B@@@B@@@@@@@B@@@B@@@B@@B@@@@@@@@@B@@B@@B@@@@B@@@B@@@B@BA@@BA@@@@B@@@B@@A@@B@@@@ABA@@@@BAB@@@B@B@D@@@@@@@BB@@@@B@@@B@@@@@@@BB@@@@@@@@B@BAB@@A@@@A@@B@@@B@BAB@F@B@FAB@B@BAB@@@DABA@@BA@AAA@@@AB@@ABA@A@@BA@@@@@@@A@@B@BA@AD@BCBABABABADBD@DBB@BBBBBBB@@@BABADC@A@@B@B@@@@A@@AA@A@ABA@@B@@@@@@AB@@@@@@@B@@@@B@AB@@@@@@@B@@@@@@@@@B@@@@@@@B@@@BAB@B@@@BA@@B@B@@@B@@@@ABAA@@C@@@@@A@@@@BA@AB@@@@@B@B@@@B@@@B@B@@@B@BA@@B@BAB@DAD@@@D@B@B@FBB@B@BBB@@BB@B@B@B@BB@@B@@@@@DBD@@@B@@B@@@@BB@@@@B@@BB@BA@@@@@@B@@@DBB@@@BB@@@@@@B@@@BB@@B@@@@@B@@@B@@@B@@@@@B@BBB@@@B@BBB@B@B@@@BA@@DADA@@D@A@@@@A@A@@@AA@@@A@@AA@@AC@A@@AA@@@@A@A@AAA@@@@@@BABABA@A@A@@B@BAB@BABABADAB@@A@@BAAA@@@@@A@@B@@AB@@A@@B@BAB@B@@@BAB@BA@@BA@A@@BABABA@ABG@E@EIQEGCCAEBEDCFCFADE@CGEQKWSECOYEGSIEQB]CODMJGRMNEVARBVFZDXBhGfOpGVCHK@WEIQQOOOGACCEDCDAHAJELMDK@KAO@IDMDKBGE[AEAI@IFQ@GF]DKHIKCC@A@A@C@A@A@@AAA@@C@C@C@@@AA@@@C@ABA@ABABA@AB@@@@AA@@@@@@AA@AAC@A@A@A@A@A@CAA@C@E@@@C@@@A@ABAB@@A@@AAAACA@A@@@AAA@CAC@A@A@A@CA@AA@AAAA@@A@AA@@A@@@@A@@A@@@AAA@@AA@@@@@AB@@@@C@@BC@@@A@A@@@AB@@A@@B@@@@AF@B@D@BA@AB@BA@@BBB@@BB@@@B@@@B@B@@@@@B@@C@@@@@AB@@A@@@@@CB@@ABCBC@AB@@A@A@@BA@@BAD@B@B@@@BA@@@AB@@@@AB@@AAA@@@CBEDAD@@A@@AA@A@@@@@@@A@A@@B@@A@ABA@ABC@@B@@AAAAC@ECA@@A@@A@@AC@AAAA@@@AA@@@AAABAAA@@@@AAC@@CAAAAA@A@@A@@@@@@BA@@@AB@@A@@@@@A@@B@@A@A@@@A@@@@@A@@@A@ABA@@@C@A@C@@@A@AAE@G@EBA@CAA@ABCBA@A@C@C@@BA@A@A@@@A@A@AACAA@A@CBA@@@A@@AA@@AA@@@A@A@A@A@A@A@A@@@A@A@@@CAC@A@A@A@ABCBCBA@A@A@A@A@AA@@A@"]],encodeOffsets:[[[129438,44950]],[[129760,44516]]]}},{type:"Feature",id:"220281",properties:{name:"蛟河市",cp:[127.344229,43.724007],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@A@@A@@AA@A@@A@ECA@@@A@C@@@A@A@@@AA@@A@A@@@@@A@@@@@@@A@@@AA@@A@@@A@@@A@@@@@@@@AA@@@@@@@@A@@A@@@AAA@@@@@A@@@A@@@@@AA@@@@@@A@AAA@A@A@@@A@AAC@A@@@A@@A@@@@@@BA@A@@@@@A@@AA@@@A@@@A@@B@@AB@@@@A@@@@B@BA@@@@@@A@@@ACA@@@AAAA@AA@@AA@@A@@@@@@@@@@A@@@@@@A@@@A@@A@@@@@@@@@@AA@@@A@@@A@@A@@A@A@AA@@@@A@A@@AA@ACAAA@AAA@A@A@A@@@AAA@@A@@A@@@@@A@@@ABA@A@@@A@C@@AA@@@A@A@@@A@@@AB@@@@A@@@CBA@@@A@@@ABA@@@@@@AA@@@AA@@@@@@ABA@A@@@A@A@@@A@@AA@@@@@A@@A@@@A@A@@@@@A@@B@@@@A@A@@@AA@@@@A@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@AA@@@A@@@A@A@A@@@@@@@AA@@AAA@@@A@A@A@A@A@@@@@@@A@@@@@A@@@A@@@@@A@A@@@@@@@@@AAA@@@@@A@@@@@@BA@@@A@@@A@A@A@A@@@@@A@@@A@@@A@A@AB@@C@A@@@A@A@@B@@@@@@C@A@@@AB@@@@@@AB@@AB@@@@A@@@A@@@@@@@A@@@@@A@@@@@AB@@A@@@@@A@@A@@A@@@@AA@@@@@A@@@@@@@A@@@A@A@@@@@A@@@@@C@@@AA@@@@A@@A@@A@@A@@A@@@A@@@@@A@@@A@@@@@A@@@@@A@@B@@A@A@@@A@@@A@@@@@@@A@A@A@@BA@A@@B@@@@A@@B@@@@@@A@@@A@@@AB@@A@@B@@@@@@A@@@A@@B@@A@@A@@A@@A@@@AA@@@A@AA@@@@AA@@@@@@A@@@@@A@@@A@@@@@A@@@A@@@@@@@@@A@@A@@A@A@A@@@@AA@A@@AA@@@AA@@@@CA@@A@@@@@A@@A@@@@@@AA@@@@@@@@@A@@A@A@@@A@@@@@@AA@@@@@@@A@@@@@A@@@@@A@@@@@@@AA@@@@@@@AC@A@@@A@@@@A@@A@@AA@@@A@@@C@@@C@A@@@AB@@@@A@@@@@A@AAA@@@AAA@@@AAA@@A@@A@@A@@@A@@@@AA@@A@A@ABA@A@A@A@A@@@A@@B@@A@@@@@ABA@@@A@A@@@A@@@A@A@A@A@C@@@A@@AAB@B@@@BA@ABA@ABABC@BB@B@@@@@@@@@B@@@B@B@BABA@@BA@A@@@@B@@AB@@@BAB@@ABB@BB@B@@@B@@@BA@A@A@@BABABEDAD@B@BABABADABAF@BCBA@A@@@A@A@G@C@A@@@AA@@@@A@@@@@A@@@A@@@@@A@@@@AA@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@A@@@A@@@@@A@@@@@@@A@@@@AA@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@AA@@@@@@@@@@@@@@@@A@@@AA@@A@@@AA@@@@@@A@@@@@@@A@@@A@@@@@@@@@A@@@A@@A@@A@@@@@A@@@@@C@E@CACBE@EB@@@@BFJPRPBBHJHNBFAHGFG@KCM@GDCDAFCJADELMJQPCFCFAH@JFFDJ@J@F@LFJFDHDJBFDHHDHHJTTDFBFADGBEAGAIEKAE@K@A@GBCBMFQLOFQAaCI@_@UDMHGJGHCHAJOVMRMNMJIBMDOBQFQLOJ@@GJCLE^@HER@JBJBFF\\AHCLCN@JBP@LCLKNIFGBCBCDDFBDPHPPRRFJ@XGLUDoHePgHWAYCUEQAUBMFQNIHCNDPA^FRTJFHPZFDXTRLHF@DCFEBEDCDAFBFDDFHJR@F@FAH@BABABAB@@@BAB@@ABA@AB@@A@A@ABA@@@@BA@@BA@@@@B@@@@BBAB@@@BA@CBABABABA@ABA@@@@B@BABABAB@@@@@@BB@B@B@B@@B@@BB@D@@BB@@BB@@@B@@B@@@B@B@@B@@B@@B@B@@BB@@@B@@B@B@B@@BB@B@D@@B@BB@@@@@BB@B@@BB@@@@@@@@B@@@@@@@BB@BB@B@@@BB@@B@@@B@@@B@@@@@@@B@@AB@@B@@BB@@@B@B@@@BB@@DB@@B@D@@@B@@@B@D@@@BB@@B@@B@@@B@@BB@@@B@@@@B@@B@@@BB@@B@@@B@@@BB@@BB@@@BBA@@B@@@BA@@BAB@@@@@@@@@B@@@@@@@@AB@@@@@@A@ABA@@@AB@@A@@@@B@@@@@BB@@B@@@B@B@@@B@@@@@@@BA@@B@B@@A@@@@@A@@@@@@B@@A@@D@@@B@@@@@@@@@@A@@@@@A@C@@@AB@@@@@@A@@@@@@@@@@@ABA@AB@@@@@@A@@@@@@@@@@@@@@B@B@@@B@@@B@@@@@@AB@@@@@@A@@@@B@@@B@@@@A@@@AB@@AB@@@@@B@@@B@BA@@BAB@@AB@B@@A@@@@@A@@@A@@@A@A@@@A@@@@BBB@F@@@@@@@@@B@@@@A@@B@@@B@@@@@BBB@@@B@@@@@@BB@@B@B@@AD@BAB@BAB@B@BA@@B@BA@@@@BA@@B@@AB@@@B@B@@A@@B@B@BBB@B@B@@@B@@@B@@BB@@@DB@B@@B@@BB@BBBB@@B@B@BB@@B@BBB@@BB@@BB@@@B@@@BB@@@@@@@@@@@@@@@B@@@B@@ABA@@@AB@@C@C@A@ABC@CB@@@@A@@@@@ABA@@BA@@@A@@BA@A@A@@B@@@@@@@BA@@@AB@@@@@BA@@B@@@B@@AB@@AB@@AB@B@@@@@BDDBB@BBDBD@@A@@@A@A@@@A@A@E@@B@@@H@@HD@B@@@B@B@@B@@B@@@B@B@@@B@@@@BB@@BBB@BBB@@@@B@@@@@B@@BB@BB@BB@@@@BBB@B@@@B@B@B@@@B@@B@@@@BB@@@@@@@B@@B@B@@@BB@@B@@@BBB@@BB@@@B@@B@@@B@@AB@@@B@@A@@B@@A@@@CBA@AB@@A@AB@@A@@@@B@@@@@@@BA@@B@B@@@@AB@@@@@B@@@B@@@@@B@BA@@@@B@@@BA@@@@@A@A@@@A@@@@@@B@BA@@@@@A@A@C@@@@@@A@@AB@@@@A@@B@B@@@@BB@@B@@B@@@@@BA@@B@@@@@B@@@@BB@@@@@B@B@@@B@@A@@@AB@@A@@@@B@@A@@@ABB@@@@@@@B@@B@@@@@@A@@@A@@@@@A@BB@@@@@BA@@B@@@@A@@@@@A@@B@@@@A@@B@@A@@@@@A@@@@B@@@@@@@B@@AB@@@@@B@@@B@@@B@@@BB@A@CB@@@BA@A@@@A@@@@@@BA@@@A@@B@@@@@AA@@AAA@@AA@@C@@@@@A@@@@@A@@@@@A@@@@@A@@BA@A@A@@@A@AAA@A@@@@@@@AAA@A@A@A@CBA@@BA@@BA@ABB@ABA@@@A@@@@B@@BB@@@BBBA@@B@B@@ABA@@@@@@@AB@@A@@@@AAB@@A@@BAB@@CBA@@BAB@@ABAB@@@@BB@B@@@@@BAB@@B@@B@@@B@B@B@B@@@@@@A@A@@AA@@A@@A@ABA@A@A@A@AB@@ABA@ABA@A@A@@BAB@@A@ABA@A@A@A@@@@@A@@@A@A@@@@@@B@B@@@B@BA@A@@B@@@BB@@BB@BB@@B@@@BA@@@@@@B@B@@BB@D@B@BB@@D@D@BBD@DBB@@B@@@B@@@B@B@B@BBB@B@@@B@@@@@B@@@B@@@@@B@@@@@@A@@BA@@@@@@BAB@B@@AB@@@@@B@B@B@@@B@@@@@@@BA@@@A@@@@@AA@@A@@BA@A@A@ABABA@A@AB@BB@@B@B@B@@BBDBB@@@@B@BB@BBB@BBDH@BDF@B@B@@BB@@@@BB@B@@@BBBBBB@@@@@AB@@@@B@D@D@FAF@B@@B@@B@@@B@@ABB@@@@@@B@@BB@@@B@@B@@BA@@@@BA@@@@@@B@B@@A@@@@@@AA@@B@@@BAB@@A@@@@BA@@BAB@BABBBA@@@@F@B@@@@@@@B@@@B@B@D@B@BAB@@@@@@B@@@@B@@B@@@@@B@DA@@@BB@@@@@@@@BA@AB@@@B@B@@A@A@A@@@ADA@AB@B@BA@@B@JBB@D@B@JDD@D@D@D@BBBBBBFBFBDBDDFJFD@BBB@FBBBDDBFBBBBBDDDBBBBBB@@B@DBDBD@DDHBDD@DBJ@B@D@DABAFAFADAD@FAD@D@D@BBD@B@B@B@@ABA@@BAD@@@@@@AAA@@AAB@D@BA@@B@BC@A@CB@@A@AB@@BBBD@B@BB@@@B@B@B@B@@BBD@BBFBB@B@BAB@B@B@B@@@BAB@BABB@@B@B@B@B@B@B@B@B@@@B@@ABABAD@D@B@@@BBBB@B@@BB@@@@BB@@BAB@B@B@BAD@B@B@B@@@BA@@DC@@B@@@B@@@@A@A@@@AAACC@@A@@A@@@A@@@@B@B@B@D@F@B@D@B@DBBBB@B@@BDBDB@BB@@@B@@AB@@AB@BADCHAB@BA@@D@B@@@B@@AD@@@@A@A@A@@@@B@B@@BB@@@B@@@@@B@FDB@B@@@B@FAH@BAB@DBB@@@D@@@B@B@@@B@B@B@BBB@@@B@@ADA@A@A@A@@BAB@D@@ABCB@@ABA@A@@B@BB@@B@B@@A@@B@B@@@B@BAB@B@BBB@@@@@B@@BB@B@DBB@B@B@B@DB@@BB@@BAB@DABCBAF@BA@AB@@ABA@ABE@AACAA@A@@DBB@BAD@BBD@FB@@D@@@BAB@D@D@DBD@J@B@B@BAD@B@BAB@@@@A@@@ABCAA@AAA@@@AB@@@FAB@DABA@BB@B@F@DBDBB@@B@@D@DBF@DDB@@B@@B@@AB@BAB@B@H@B@BA@@@@@A@@@A@@@@A@A@AA@@AA@ACACAAA@AAACC@@@A@@@@BAB@@A@@@@@AAABCBC@EAC@AA@@ABA@CBC@A@A@ABA@CB@@@F@HABBDBB@B@D@DAHAB@@@@AAA@A@CAEAAACA@@A@AAACEGGAA@AA@@A@A@A@@@A@@C@GAE@E@AA@A@AAC@A@A@C@C@C@A@A@A@A@A@A@@BCBC@@B@BA@ABA@@@A@@@C@A@C@A@ABABA@AB@@A@@@A@@@A@@AA@@@A@@@A@ABA@ABA@@@@DA@A@AB@@A@@@ABBF@B@DBB@@@B@@@@@@@@ABA@@@A@@BABA@@@ADA@AB@@@@@B@B@B@B@@@@@B@@@B@B@@@B@@@B@B@B@@@@A@@B@D@@@BAD@B@B@@@DABBB@@AB@@@@@BA@@BA@@@@@A@@B@@@@@@@B@B@B@B@B@B@B@@@B@B@B@BBB@B@B@@@B@@@@@B@@AB@@@BA@A@@BA@@@@@@@@@@@@B@B@@@B@B@@@D@@@D@@@BB@@B@@@B@B@@@@AB@B@@@B@B@@@BBD@@BB@B@@B@@B@@BBBBBBB@@B@@@@@B@@@@@@B@@@@B@F@F@FAF@DAB@B@@@B@D@@@@@@B@@@@@B@@@@@@@B@@BB@@@B@@@@@@@@@@@@@BAB@@BB@B@B@BCD@@@@@B@@@@A@A@@@@@A@AB@@@B@@@BA@@B@@AB@B@B@@@BBBBDB@@BBBB@BB@@@@DBB@B@DBB@BB@BB@DBBBBBDBB@BBB@D@D@BB@@B@@@@@DDBBB@DBFAF@B@B@B@@@@B@B@@BDDDFDBDBB@BB@AB@@@B@@BB@@D@BB@BBBB@@BD@B@B@BAB@@@B@@BBBBDBB@B@@@BAB@@@F@B@DAD@B@@ABAB@BAB@B@B@@@B@@@B@B@@@@B@DDB@BBD@@@BBBB@BBB@@B@@@B@@BB@@BBB@B@BBB@@@@CB@BAB@B@@@B@B@BB@@@B@B@@@BB@@BD@@AB@@AB@B@B@BB@@B@BBBDB@BBBBB@BDBBFBFBBBBBBB@B@@DBBBB@@@@BB@B@B@@@BBB@B@BB@B@B@B@@@@B@BAB@B@@B@BB@@@@BB@BB@BB@@BBBAB@BA@@B@DABBB@B@DB@@@@BB@@BB@AB@@@DABAD@BAB@@@@@DBB@D@B@BBB@B@DB@@B@@BB@B@B@D@D@D@H@B@B@B@FB@@B@B@@@B@FB@@@@B@@@B@@@BBD@BBB@@B@@B@B@@@@@@AB@@@DCBC@ABC@A@@B@BA@@B@@A@ABABE@@@@@@@@@A@@BA@ABC@C@@B@B@B@B@B@DDB@B@@@B@@A@@D@B@B@D@BA@@BABA@@@@B@B@BBB@B@B@@@B@@@B@@A@@@A@AB@@AB@D@D@B@@@B@BAB@BA@BB@BB@@B@BAB@B@B@@AB@@@@AA@@@AA@A@A@AB@@AB@B@@A@@@A@ADABA@@BAB@@@B@B@B@@A@@BA@AB@@A@AB@BA@@D@B@DA@@B@@A@ABCB@@AA@@A@@@A@ABABABADAD@FAB@BABABCBA@A@@AA@@CAAAC@@@AA@A@C@E@GBGAA@ACC@A@A@BBBB@B@DBDAB@D@@@B@@@BA@@@@@@BBB@B@BBB@@@B@B@B@BBB@BBD@B@B@BBD@BBBBD@B@DBB@DBB@B@B@B@@ADABABABABA@@B@BBFBFBDBDEDAB@@A@@@@AA@A@@BABABA@@@C@@DABABAB@@@@C@A@AB@FCB@B@@@@A@@@A@ABA@AFC@@BABADABA@@@AA@AC@@@@AA@@@A@A@@@@@A@@BABAB@@AB@@@@@B@BBB@BB@AB@@@B@B@B@@@D@@@B@B@@@BBB@@@B@B@B@BB@A@@B@@@B@B@B@@BB@@@@@B@B@B@BAB@@@@AB@BA@@B@BA@@B@BAB@BA@@BA@ABBB@B@@BB@@BBBB@@@B@B@BA@@B@B@B@B@DB@@B@@B@@B@B@BB@@B@@@BB@@@B@B@@@BA@@B@B@@@@B@@@@@BBB@B@B@BBBBBBBBBBBBB@BB@@@B@@BBDBBBBBB@@@B@B@BAB@DBFDD@BB@@BBBBFBBBB@BBBBBBBBBBDDBBBB@DABAB@B@@BBB@@B@@@@B@@B@@@@BBB@BB@@B@B@@@B@@@DAB@D@BBB@BA@@B@BBB@@B@BBD@B@B@@BB@@B@@DBBBBDBDBD@B@BDBD@@@@AB@@ABBB@@@DBB@BA@@BAB@@AB@D@BAB@D@BB@DBBBBBDDBBDBDDFD@A@@B@@@@@B@B@@@B@@@B@@@B@B@@@@@B@@AB@@@D@@@@AB@@@@AB@@AB@B@@AB@BA@@B@BAB@@@BAB@@@B@@@@AB@@@BABAB@@AB@@@@@B@B@FBDBB@DBB@BBDB@@B@@BBB@@@@B@BBFB@BB@@B@@@BB@@@BB@@B@@@@B@@@B@@@@B@@@B@B@@@B@@@@A@@@@BA@@B@@@B@@A@@B@@@BA@@@@B@@@B@@@B@BA@@@@B@@@@A@@B@@B@@@@B@@@@@BB@@B@@@BAB@@@B@B@B@@@@@B@@@@@B@@@B@B@B@@@@@B@BBB@B@@BB@@@B@@@@@@@B@@@@AB@@@B@@@B@@@B@@A@@@A@A@A@@@@@ABA@ABA@@@@@AB@@@B@@@@@BAB@@@B@@@@@@A@@BA@A@@@@B@@A@@B@BA@@@@BA@@@@@AB@@@@@BABA@@B@@AB@B@BA@@@@B@@A@ABA@@BABABA@@BAAA@@A@A@AAA@AAA@AA@@AAAA@@A@CAA@ABA@A@A@@AA@@A@A@A@AAA@A@@AA@@A@A@CA@@A@CAA@A@@@AAC@@AA@A@A@@@@@@@BA@A@@AC@A@@@A@@AAA@AAA@A@@AA@@AA@@AAA@@@A@@CACAAAAAAAA@A@CAC@A@@@CAA@A@A@A@@@@@AA@AAAAAA@@AA@@A@@@AAA@@AA@@@AA@@@AAA@AACAA@AAA@A@@@AAA@AA@@@@@A@@@A@@A@@AA@@@AA@@@@AAAA@@@@@A@@@@@AB@@A@@BA@@@A@A@A@@@AAA@A@AAAAA@@@A@@@A@A@@@A@@@A@@@@BAF@BAD@BA@@BABA@@B@@AA@@@@@ABA@@@A@A@@@A@A@A@A@@AC@@@A@AA@@A@@@@A@@AA@@@AB@@A@@@AB@@A@ABA@@BC@@B@@AB@BA@@@@@AB@@A@A@@@A@@AA@@A@@@A@AAA@@@@AA@@A@@@AAA@@AA@@@A@@@@AA@A@@BC@@@A@@@CA@@@@A@AAA@@AA@A@@@@A@A@A@CBE@C@C@AAA@B@BAD@DABA@@B@@A@@@@B@B@@@BAB@B@B@@AB@@@B@B@@@B@BA@@@@BA@AB@@A@@@@BA@@@@B@@@B@@AB@@@BA@@@@BA@@@@BAB@BAB@@@B@BA@@@@B@@AA@@A@@@A@@BA@@B@@@B@@@@@@AB@@@@@BA@@B@B@BAB@B@@@BAB@BA@@@@B@@@B@B@B@@@@@B@@@@AB@@@BA@@BA@@@@B@@@B@@@BAB@@@@@@AB@BA@@B@@@B@@@B@BAB@B@@@BAB@B@BAB@B@B@@AB@B@BA@@BA@@@A@@BA@@@A@@B@@@B@B@D@B@B@@@BA@@B@@@D@B@@@B@@@@@BA@A@@@ABA@@AA@@@AA@@@@A@@@A@@@AB@BA@@B@B@B@B@B@@@BA@@@@BA@@B@@A@A@@@AA@@A@AAA@A@@@A@A@@@A@@AA@@@@@A@@@@B@B@DAB@@@@A@@@A@@CAAA@AC@@AA@@AAA@AAA@C@@@A@@@@AA@@@BABABABCBCBABC@CBC@A@AB@@A@@@@AA@@A@A@@@AAA@@A@A@C@CB@@AAA@A@@AA@CCCAAAA@AA@ACAAA@AAE@@AA@A@A@A@@@CA@@AAAA@@@@@BA@A@AB@BAB@BA@@B@@@@@@AA@@@AAAAAAA@AACAAA@@@@AAB@@A@@B@BAB@B@B@BAB@@A@@@A@@@A@@B@@AB@B@@A@A@@@A@AA@AA@@@A@A@@AAAAAAD@DAD@B@D@DAD@@@B@BA@@BABAB@@@@ABA@A@@B@B@BAB@@A@AB@DBB@D@B@@@B@@ABA@@@@@@CA@AA@@AAA@A@@@ABABABA@@@@BAA@@AAA@@A@AA@@A@@A@@@A@A@C@A@A@A@A@@BA@ABCBC@@BA@A@@@A@@B@BAD@B@BABAB@@A@AAA@@@A@@@A@@BABA@@BABABADC@ABA@ABA@AAA@AAA@@@@@AB@@AB@DAD@BABA@AD@BAFCDABABAB@BAB@B@F@FABBBAB@DADA@AB@BA@@@@@@B@B@BB@@BBB@@@BAB@B@@AB@BA@@B@@@BBBBBB@BB@@@B@B@BAB@BAB@B@B@@@BA@@@AB@@@BA@@@A@A@@A@@AA@@@@A@@BAB@B@B@@A@@@@@A@@@@A@@A@@AA@@A@@A@@@@@A@@@AB@@ADABABA@A@@@A@@@A@@@@@@B@@AB@@@@A@@@A@@A@@@AAA@C@ABCAA@A@@@A@AA@@AA@@A@@@A@AA@@D@@A@A@@@A@AB@@@@@BA@AB@@A@A@@AAAAAA@@A@A@C@A@A@@AA@@AA@@@@@@A@@B@@@B@BA@AB@@AB@@AB@BA@@@@@@A@AA@@AA@A@@@A@@@AB@@AA@@@AA@@@A@@@@BA@@BA@A@A@@@A@@A@A@@A@@@@@AB@D@@@B@@@@AB@@@@@@@@@@@@@@A@@@@@@@@BA@@@@B@@@@@B@@@@@@A@@@@@@@@@@@A@@@@@A@@A@@@B@@@BA@@@@@@@@@A@@@@B@@@@@@@AA@@@@@@@@@A@@B@@@@A@@@@BA@@@@@@@@@@@@@A@@@@A@@@@@@AA@@@@@AA@@@@AA@@@@@@@A@@BA@@A@@@@@AA@@A@@@@@@@AA@@@@A@@AA@@@AA@@@@@@@@@A@@@@A@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@A@@@@@@A@@@@@@@@@@A@@@@A@@@@A@@@@@@@AB@A@@@@AA@@@@@@A@@@@@A@@@@@AA@@@@@A@B@@AB@@A@@B@@@@@AAA@@@@AA@B@@@@A@@@@@@@A@A@@@@@A@@@@@@B@@@BB@@@A@@@A@@AA@A@@@AB@@@@@AAA@@A@@@@AA@@A@A@AAA@@@@@A@@@@BA@@AA@@A@@A@@AA@@@AA@@@@A@A@A@@@C@A@AB@@A@A@@B@@A@A@A@@@@BA@@@A@@@@@@@CB@@A@@@A@A@@@A@@@@@A@@@@@@@A@@@A@@@@A@@AA@@AA@@@@@@A@@@@@@@A@@@@@A@@A@@@@@@@@@AA@@@@@AA@@@@AA@@AA@@@@AA@@@@@A@@@A@A@@@AA@@AB@@@A@@A@@@@AA@@AA@@A@@@@@@AA@@@@A@@@A@@AC@@@@@A@@AA@@A@@@@@AAA@@@CA@@@@@@AA@@@A@@@A@A@@@@A@@@A@A@C@A@@BA@@@A@@BA@@@AB@@A@@@A@@@@@A@A@A@@AA@@@@@A@@@A@@@@AA@@A@@@A@@A@@@A@@A@@A@@@@AA@@@@@AA@@@@A@@@@@@A@@@ABABAB@@@@A@@@A@@@A@@@A@@AAAACAC@A@A@@@AB@A@@A@@A@@AA@@@AA@@@AA@@A@@@AAA@@@A@@@AB@@A@@@A@@A@@A@@A@@AA@@@A@@@A@@@@@@@AB@@A@A@@@@@@@A@@@A@@@@@@@A@@@AA@@@A@A@A@A@A@@@A@@AA@@@@E@A@A@C@A@A@AA@AA@@A@A@ABAB@@@@A@@@A@@@@AA@ABA@@@A@@@A@A@@@A@@@AAAAA@@AA@@AA@@@AA@@@@@ABA@@@AAA@C@@@AAC@@A@BABA@@BA@@AAAA@A@@@A@@@A@@@@@@@@@A@@@@@A@@@@B@@AB@@@@AB@@A@@BA@@@A@@@@BA@@@@A@@AA@A@@@AAA@A@@@A@A@C@A@@@A@@@A@@@@@AA@@@@@A@@@@AA@@@A@@A@@AA@@@@A@@@AA@@@@@@@A@@@A@@@@@A@@@@@@A@@@@@A@@@@@@@@@A@@@@A@@@AA@@@@@@@@@@@A@@@A@@@A@@@A@A@AA@@AA@AA@@A@@@A@@AA@A@AA@@A@A@A@A@@@A@A@ABA@A@A@A@A@A@ABA@EBABA@@@A@A@@@AB@@@@A@@B@@@BA@@@@@@@@@@@AA@A@C@@@AAA@A@AA@@@@@AAA@AAA@@@@@B@@A@@@A@@AAA@AA@@AAA@AAA@@AAA@@AA@A@@AA@@A@A@@A@@AA@@@@CAAA@@@@AA@@@A@@B@B@BADADA@AB@@ABA@A@A@A@A@@@A@A@A@C@AA@@A@@BA@@@A@A@AAAAAAAAA@@AA@@@A@A@@A@@AAAAA@AA@@AA@@A@A@C@A@CAE@C@A@ABA@ABABCBABABAD@D@B@F@B@@@BA@@@A@@@A@@AA@AAA@A@AA@@AAA@AA@@A@A@C@C@CAA@A@AAAAAAAAAAACA@A@@@@@AB@@@BABABA@@@A@AB@@@@A@AA@@AAC@A@AA@@A@AA@@AAAA@@A@@@A@AA@@A@AAA@@@AA@@@AAA@AACAA@A@@A@@@AAA@A@A@@AA@AB@@@@AA@CAAACAA@A@@A@@@@@@@AB@@A@@@A@AB@@@B@BA@@@@@A@A@A@AA@@AA@A@C@AAC@A@@AA@@@@A@AA@AAA@A@A@AAC@CAC@A@A@A@AAC@C@A@@@C@A@C@@@A@AAAAAACAAAAA@@A@C@C@A@E@A@A@C@CB@@A@@@AA@AAAA@AAA@@@A@A@@A@@@@@@@A@@@@@@@@@A@@@@@@B@B@@AB@@@BAB@@@@AB@@@@@B@@AB@@@BA@@@AB@@AB@BA@@B@@AB@@AB@@@@AB@B@@A@@@A@A@A@A@@@A@@AA@@@@AAA@A@@A@@@@B@AA@@@A@@@@@@AA@@@@A@AAA@@@@AA@A@@@A@A@@@A@@@AAA@AAAA@@AA@@A@@AC@CBC@GBEBEBC@A@A@CAE@EAA@AA@A@@@EBA@AACAACCAAAAAC@AACCAAAACA@CAC@AAA@ABA@AFEFCDAB@BAB@@CBA@CBABA@AB@@A@@AA@A@A@C@A@A@A@@@A@A@A@A@E@AAA@@AAA@AAA@AC@ACA@AA@@A@AA@@AA@@CBAAA@A@C@AAA@@AC@C@A@A@AAAAA@A@@@AA@@A@@@@@A@A@@@A@@@@@A@@@@@A@A@I@A@A@A@@@A@@@A@@@C@A@A@@@@@A@@@AAACAAA@AAAAA@AAA@A@A@A@A@A@A@A@"],encodeOffsets:[[130527,44314]]}},{type:"Feature",id:"220221",properties:{name:"永吉县",cp:[126.497741,43.672582],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BA@AB@@A@A@A@A@@@A@A@@@C@A@@BA@@AA@AA@@A@@@A@@@A@A@A@ABA@A@@BA@ABA@AB@@A@ABA@@BA@BBB@@BB@@B@@BB@B@@AB@B@BA@@B@@@B@@BB@@@@@B@@AB@BA@@BA@@B@@@BA@ABC@ABABA@@@@BA@@@@B@@@B@@A@@BA@@B@@@B@@BBB@BBBB@@DB@@B@BB@@@@@B@B@@BB@BB@@B@@@B@@@BAB@@AB@B@BA@ABABA@AB@@AB@@A@A@A@A@A@@@A@@@@B@B@@BB@@@B@@@B@B@@AB@BA@@B@BA@@@A@C@A@A@A@@@ABA@A@@BA@A@@@A@A@@@AA@A@@A@@A@@A@@A@A@AA@@A@@@@A@@@@@@A@@@@@@@A@@@@AA@@AA@A@AA@@AA@A@A@A@@@AAAAA@AACAA@C@CA@@A@@@A@A@A@ABA@@BA@A@@BA@@@A@AAC@AAA@@@A@A@@@A@@@A@@A@@A@A@@@AA@@@A@@AAAAAA@@AA@@AAA@C@A@AAA@A@@@@@@A@ABABA@@@A@@@A@@AA@@@A@@@A@ABA@A@A@A@A@@AA@@AA@A@A@A@@B@@A@@@@AA@@@@AAA@AA@@@A@AAAA@AAA@CAAAAA@@@@A@@@A@@@A@A@@@A@A@@@@@CBABABC@@BAB@@A@@@A@A@@BA@A@AB@@ABA@AB@@A@@@A@@@A@A@A@A@A@A@@B@BA@@BA@AB@BA@@BA@A@ABABABC@@BC@ABC@ABA@A@C@C@A@C@A@CBA@A@A@A@@A@BA@A@A@ABA@@BA@@@A@@@@@A@@BA@CBABA@A@A@@BA@@@@BABA@@@@@A@@@AB@@@B@@@B@B@@B@@B@B@@@@AB@@AB@@A@@B@@@@@@@B@@@B@@A@@@A@A@@@@@@@AB@@@@@@A@A@@@C@@@AB@@A@@BAAA@A@A@AAA@A@@B@@@BA@@BA@ABA@A@AB@@@BA@@B@@@B@@B@B@B@B@D@BB@@@BB@@B@B@@@B@@BB@@B@@@ABA@A@A@@@@BA@@@@@A@@@AB@@A@A@@@A@A@@B@@A@@@A@@AA@A@@@@@A@@@@@A@A@AAA@A@AA@@AB@@@@@@B@B@@@@B@@B@@@@B@@B@@BA@@@A@@@@@@@@@@@@@@@@@@@@B@@C@AAC@C@E@A@GAA@@@@BAAA@A@AAA@A@A@@@A@A@A@@BA@AB@@A@A@@@AB@@@BABA@@@AA@@@@AB@@A@@@A@A@AB@@ABA@BADCBCBC@@@@@@A@A@A@@@@A@A@@@A@@AA@@AAA@C@A@A@C@AAA@@AA@@AA@@A@A@A@AA@@A@@B@@A@@BAA@AA@@@@@A@@A@A@AA@@A@@@A@@BA@@B@BA@@@A@ABA@AB@@A@AAA@A@@@A@@@ABABA@@@A@A@@@@@B@@B@@@BAB@@@@@B@@AB@@A@AAA@ABA@C@A@ABA@@BC@@@A@@B@@@B@@A@ABABA@A@@BA@@BB@@BBB@BB@@D@B@B@B@@@BA@@B@B@DAB@@ABA@C@A@@BA@@B@B@@@B@B@B@@BB@@@BBBBB@@BB@@@@ABAB@@@B@@@@B@@BBBB@@BBB@B@@BB@B@BB@@B@@@@@@@BA@A@BB@@B@@@@B@@@BAB@B@B@@@@A@AA@@@@A@@@A@@BA@A@A@A@@@@BA@AB@@A@AAAAA@ABA@A@A@A@A@A@A@A@ABA@@@A@A@AAA@AAAA@A@@@@A@AAA@A@A@A@ABA@A@A@A@@@A@@@ABABA@@A@@AA@@@AAA@@@AA@@AA@AA@@@A@@@CA@@A@@@A@@@AA@@BC@CBC@@@A@@@@@@AAAAAAAA@A@A@AB@@A@@B@BABA@ADABA@@B@@A@AAA@AAA@A@AACAA@AA@@@@A@@AA@A@AAA@@B@@@BA@@@AB@@A@ABABA@@@@@@BB@@B@@@@@BB@@B@BA@@@@B@@@@@BA@@@A@@@A@@@A@A@E@C@@@A@@@@C@A@AA@A@A@A@A@ABA@@@C@@@@@A@@@@@@@AACAA@C@AA@@@A@A@@@A@AA@@AAAA@AA@A@@AAAA@@@A@@@A@@AA@@A@AAA@A@@@@A@@A@A@A@A@A@A@AA@@A@A@A@@@A@AAA@A@AAA@A@AA@@@A@@@A@@@A@@@@AAA@AAA@@@AA@@@AA@@@@@@@A@@@@@@@@@A@@A@@@@@@@@@@A@@B@@@@@@@@A@@@A@@@@@A@@@A@@@@@AACA@A@@AG@CA@@AGGA@A@EFGAC@@AA@A@CBCB@B@@AB@@A@AB@@A@@B@@@@A@@@@@AB@@@B@B@@@@@B@@@BAB@B@@CB@@CB@BBBDD@BCBC@A@@B@@@D@D@BDB@BA@@@A@A@ACA@A@A@CBAB@@ABCBA@A@CCA@@@AB@@@@@D@BABA@CBC@@BCB@@AB@@ABABBBDD@B@@A@A@A@@@@AAA@@A@ABABA@A@G@G@CBC@ABA@ADCDA@ABA@A@CCA@ABA@@B@@BDBB@@@B@FA@A@@B@@ABBD@B@@@B@@AB@@ABA@A@AD@@AB@B@@BB@B@B@BABAB@BAB@@ABA@@@AAA@AA@@A@@@@B@B@B@@@B@B@@BBB@BB@@A@@BA@C@A@A@@@ABA@@BA@@BA@ABC@A@A@@@ABAB@B@@@B@B@B@@@BD@@BB@BAB@@BDB@BDFBBDBB@@BB@@FBD@FAD@BBD@BB@D@B@B@@@@@@B@BA@CBC@CBC@AB@B@@@B@@@BC@C@A@A@@B@DA@@BA@@B@@DBBBB@BBB@DBHDB@BB@BBDBB@@ABEBABA@@@BBDBBB@B@B@BAB@@@BBBBBBBD@B@B@B@B@@@D@BB@B@@@BA@@B@@BBDB@B@@@@A@A@A@A@A@A@@BAB@@@BB@@@B@B@B@D@@@BBBB@@@@@F@BABA@@BBBB@D@DB@@@D@@B@@D@BAF@B@D@@@BBB@BBBBBBBDDBDB@BBABABC@AD@B@BADAB@D@DABAB@DBBBBBBAB@DD@BAD@B@AD@DAFCDCBA@ABBB@@@@BF@BADBDBB@@@BABA@@@@@@BB@BB@@AB@BC@CBAB@@A@AAA@AA@@@@A@@@AB@BA@@BB@@@BBB@BB@@@@BB@B@@@BA@A@AB@@A@@BA@@B@@@D@@BB@@@@@B@B@@AB@@A@A@@@@@A@@@@@@B@@@@BB@@B@B@@@B@BB@@@B@@@@ABABAB@@A@@@A@AB@@A@@B@B@@AB@@A@AB@@@BABB@AB@@AB@@BB@@B@B@@@B@@B@@@@ABA@@@@B@@@@BBB@BB@@@@@B@@@BA@@@A@A@A@AA@AA@@@ABA@AB@BA@@BCB@@@@@@@B@BA@@@A@@@@@ACAA@@A@@B@@A@CB@BCBAB@@AB@B@B@@AB@@@@@@@@BBB@BA@@B@@B@@@B@@AB@B@@AB@@@@@B@@A@C@@@AB@B@B@BB@AB@@ABABAB@@@B@@DBBB@@A@@BABA@@@@B@B@BAB@BA@ABA@@@AB@B@@BB@BA@@@A@A@@@AA@A@@@@@@A@@B@@@BBB@@@B@@A@@B@@@B@@@B@@@@@B@@ED@@@B@B@@BBB@@@@BADAB@B@@@B@@B@BB@@@@@BB@AB@@@@A@A@AB@@A@@B@@B@@@B@B@@@B@@@@@@B@B@@AB@B@@A@E@@@@AA@@@BA@@AA@@@@A@@@AB@@AB@@@B@@BBB@@BB@@B@@AB@BA@@@A@A@A@@AA@@@A@@@@@@@@@AB@@@BA@@@AA@@@@BA@@@@@@A@@A@@A@@B@@@@@BBB@@@@AB@@@BB@@@B@@@@@@B@@@@@BBB@@@@@@AB@@@AA@@B@@@@BB@@@@@BA@@@@AA@AA@@@@@@A@@@@@@@AB@@AA@@A@@B@@@@@BBBB@@BBB@@@B@BB@D@BA@ABBBB@BB@B@ABAB@@@BB@@BA@A@A@A@@B@BA@AAA@AB@@@BAB@@@@BBB@BABB@@@@@BAB@@A@AA@@A@@@ABA@@B@@A@ABEB@B@@CBBB@@@@BB@@@@@B@@@B@B@B@BBBB@AB@@@@ABA@@@A@AB@B@B@@@B@@BB@@@@@BB@AB@@@@@B@@@@B@@BB@@@B@@B@@@@A@@B@@@B@@@@B@B@BB@@@BB@@@B@@@BA@@@@B@@@B@@@@@@@@BA@AB@@AB@BABA@A@A@@@@B@@BB@D@@BBB@@B@B@@AB@@A@@BA@C@C@@@@B@@@@BBB@DBDBDB@@BDBB@B@@BB@BBBBDDFBBDFBDBF@BBFBB@DBB@DBDB@B@F@B@B@BAB@B@@@@@D@B@B@B@@@B@@@B@@@@@B@B@@A@C@@@@D@@@BA@@@A@@@@@@@@BB@@@@B@@@BB@@B@@@B@@@B@@@B@@@B@@@B@@B@@B@@@@@@@@@B@@@BA@BB@B@@@@A@@@@@@@ABCBA@@BA@@BA@@@@@@B@B@D@DBBBDBDDBBBB@@DBB@B@D@BBBBD@D@@@D@B@DAB@@A@AAAAA@A@A@A@@FBFDFE@@CA@ALFB@BBB@BBFBDBD@BBFA@@@@B@@@@AB@@@B@@@@@B@D@DAB@L@B@@@D@@@@AB@@@@AB@@AB@@@BAB@@@@@D@BAB@@@@A@@AAB@BAB@BA@@@@BAD@D@D@F@@@@@B@B@B@BBFBB@B@@B@@D@D@@@B@BBB@@B@@@@CBFBFB@@B@@AB@B@@@B@B@B@@@@@@BAB@@@B@@AB@@@@@@HB@D@D@B@B@@@@A@BDBDA@BBBD@BBBD@B@BBBB@@B@@@@A@@B@@@@@@AB@@@B@B@B@B@B@@@BBB@BB@@B@@@B@F@B@@@D@B@B@LBB@B@@@BBD@D@B@JFH@F@D@@AD@D@D@@@@@BAB@H@BA@@@AD@B@HCBA@@@@@ABABA@A@@@AA@A@A@@AB@@@H@@@BBB@@@BAB@B@B@D@B@BABADADCB@BA@@BB@@@ABBBAD@@BAD@@@@D@B@A@BBA@@@@@@B@@B@B@@@BA@@@@@DB@@@B@@@@@@@@BB@@BCB@BA@A@@@@@@@B@@@@B@@D@@A@@@BB@@@@@@B@@@@@@A@BB@@@@A@@BB@@@@@B@@@@@@B@@B@@@@B@@ABA@@@B@B@@@A@@B@@B@B@@@B@@BB@@B@@B@BBA@@BA@@@B@@B@@@BD@@@B@BB@B@@B@@AB@@@@@@@@B@@B@B@B@@@@C@@BBB@B@B@@@@ADAB@@ADAFAB@B@@AA@AA@A@@FA@@BA@@@@@A@@@A@AEB@@CAA@AACACC@@B@@@DAB@B@BAB@B@D@@A@A@A@@@@FDBBB@B@B@B@@@BAB@@A@@B@@A@@@@@@B@@@B@@@B@B@@@@@@@B@@@@@@@@ABC@A@C@A@A@AA@@@@@@@A@@@AB@@A@A@A@@@@@A@@A@@@@@@@A@@B@@A@@BA@A@@A@@@A@A@CAA@@@CAAAA@@A@AACCC@@@@B@BA@@@CBAB@@A@@@A@A@A@A@@@A@@A@@A@A@@@A@A@@@@@@@@F@B@@@@A@@@A@@@@@@@@@A@@@@@@@A@@B@@@@ABA@ABA@@@A@@@AB@@A@@A@@@@A@@B@@@B@B@BB@@B@B@DBBBD@BBDBDBB@@@@@@@BA@@@@@@DAB@@@BBB@DB@BB@@@@AB@B@B@B@@AB@BA@@B@@@@@B@@@@AA@@@A@A@@@A@A@@@@@@A@AB@@AB@B@B@B@@@BAAA@@AAA@@AA@BABA@A@@@AA@AA@@@@@@AA@@AA@A@@@@@@B@B@@@@@BA@A@@@A@@@A@@BA@@BAB@@@BB@BB@@@@@@B@@@@B@@A@@BA@@BAB@@@@@@A@@AA@AAA@@@@@@B@@ABAB@@A@@B@@@D@BB@@B@@@@A@@@A@@@AB@@A@@@A@CB@@@B@@BB@B@@@B@@@@@B@BA@@@@@A@@@@@AB@BA@@B@@@B@@@@@BA@ABA@A@@@@@@B@@@@@BB@@@@B@@@@@D@@@@@BB@@B@D@B@@@B@@@B@BA@@B@@A@@BA@@@A@@B@BAB@B@@@@@@@B@@A@@@@@A@@@A@@@A@@@A@@@@@AA@@@A@@@A@@@@@A@@AA@@@@AA@@@@AA@@@@@A@@@ABA@@@@@A@@@A@@@A@@@A@@@A@@@@B@@A@@@A@@@@@A@@@@@@@C@@@A@@@AA@@A@A@A@A@@@A@C@C@C@@@A@@A@@@@@@@A@@@@BA@@@@B@@@@A@@@@@A@@B@@AB@@@@A@@@A@@@A@@@@@A@@@@BA@@@@@A@A@@@@@@@AB@@@B@@@B@@@B@B@@AB@@@BA@@@@@@@A@@B@B@B@@@BA@@BA@@@A@A@A@@@A@@BA@@BA@@@A@@@@BA@@@@@@@@@@BA@@B@B@@@B@B@@@@AB@@AB@@@@@BA@@B@B@B@B@B@@@@BB@BD@@BBBB@BBB@@@@@@B@@A@@@AB@@AB@B@@@B@BBB@@@@@@@@@BB@@@@BA@@B@@ABA@@@AB@BAB@B@B@B@@@@@BA@@A@BA@@@@@@BA@@@@@@@A@@A@@A@@@A@@BA@@@@@@@AA@@A@AAA@AA@@A@@@@@@AA@@@AAA@A@@@ABAA@@A@@@A@@@@@@@A@@@A@@@@@AB@BADAHCB@DAB@@@BA@@BA@@@AB@@A@@B@BAB@@@@ABA@@@@BABAB@@@B@@@D@B@B@B@@@@B@@B@@@@@B@BB@@@@@B@@BB@@@BB@@B@@BB@@B@@BB@@@BB@BB@@B@B@@@B@@BB@@B@B@@@@@B@@@@A@C@@@AB@@@B@@AB@@BB@B@B@B@BBD@B@B@B@DBB@@@B@BFBBDDBD@@@@BB@D@@@@@AB@@@@AB@@@@AB@@@@@B@@@DA@@B@@@@@B@@@B@@@@@BB@@@@@@@@@@ABB@B@@@B@@@@@B@@@B@@@BBB@@@BB@@BBBB@@B@@BBB@@@B@B@B@@@@@@BB@@B@B@BB@@BB@@BB@@@@BB@@@@B@@BB@@@BB@@@@@B@@@@@BA@@B@BA@@@@B@@@@@@@@@@B@@@B@@@BA@@@@B@@AB@B@B@@@@@BAB@@ABA@@BA@@B@@@BA@@@@BAB@@@B@B@B@@@D@D@@@FA@@B@B@@@B@@@B@@AB@@@@@@@B@@@B@@@B@@@BB@@B@B@@@B@BBB@@@BB@@@@@@@BB@B@B@@BB@@@B@@BB@@ABABAB@@@@@@@B@@@@A@@@@@@@A@@@AB@@@@A@@@@@@BA@@B@@@B@@@@@@AB@@@@@@AB@@@@A@@B@B@@@@@B@@AB@B@@AB@@@@@@@AA@@A@@@@AA@@A@C@@AAC@@AA@@A@@B@BA@@@@@C@CD@BC@@@ABA@ABA@@@A@AAAB@@A@@@@B@@@@@BA@@@@@@B@@A@@@@@A@@@@@@@@@@B@B@DBB@BAD@@AB@@C@A@@B@B@B@B@B@F@B@@AB@@@@A@@B@@A@@@@B@@A@@@A@@@A@@@ABA@A@@@@DBB@B@@@FBDBB@@@B@@C@@@A@E@C@@B@B@B@B@@@@C@A@A@@B@@@@ABA@@@@@@B@FAB@@@@@@@@@@@B@DBB@@@@ABABAAA@AB@@AB@D@HBF@F@@@B@BBDDBBBBH@D@B@B@BBB@B@F@B@HD@B@@BD@D@DBDB@@BBBA@ABEDAB@@@BBBDD@@@BB@@@BB@@@B@B@B@@@BDD@@B@D@D@FBB@B@@@BB@@@@D@@@BADAB@@@B@@BBBBBBBBB@@B@BABAB@DBD@@BBBB@BB@@B@B@B@D@@@B@@@FBBBBBB@BBD@@@D@D@B@@BD@B@@AB@@@DA@@D@FBB@B@BBB@B@B@B@B@BA@@B@BBBBB@B@@@@BB@@@@@@@BA@@B@@@B@@@B@B@@@@@B@@ABADCFA@@BAB@B@B@D@@@@@@@B@@@@A@@@@@@B@@@@@B@@@@@BA@@B@@AB@@@@@B@D@B@@@DDBB@@@@@@@@BAB@@A@@@@BA@@@@@@@@@A@@@@B@BCB@@@AC@ABA@A@@@AAA@C@AA@@A@@BABABA@@F@BAB@@@@A@A@@@@B@BAB@B@@@B@@A@@BADE@A@ABABGBC@A@A@A@@@@DA@@B@@@B@DAB@B@BAB@@ABAFE@A@@BABABCBABAB@@A@ACA@@AA@A@@@AAE@A@@BCBA@@@CBA@C@@ACA@CACCEACCCAAAA@EAEACAEAAAA@BA@ABA@A@C@A@AA@@A@ABA@@AA@@@@BAD@BA@@BA@AA@BADC@@BABA@@@A@ABA@@@A@AB@@A@@@A@@AA@@BAB@@AB@B@B@B@B@D@B@B@@@@A@ABA@A@AAA@A@@@@@@@ACA@A@A@@@@@A@@@@@A@@@AC@@AAA@A@@@A@@@A@A@E@AB@BAB@DAB@BA@@BAB@FAB@B@DCDC@@BAB@B@@A@A@ABA@C@A@A@A@AAAAAAA@AAA@C@A@AA@@A@A@AB@@@BEBA@A@@@A@@@@B@@@BCB@@@BA@@B@@A@@B@@@AA@@@@CA@@AA@@@@A@A@@@A@@@EACBC@ABAAA@CACAA@AAAA@AAA@A@A@@A@C@A@A@AA@@AA@A@@@C@@@@@CBABA@@BA@@@A@@AA@CA@AAAAAAACA@@AB@BABADA@@B@DAB@BAB@BAB@B@BABA@@AA@@@AB@@@B@BAB@@@@@BA@@@@@@@@@@BAB@@AB@@@@@@@B@@@@@@@@@BAB@BA@@@@@A@@@@@@@@B@@@@@@AB@@@@A@@D@BA@@B@@@@@@@A@@A@@@A@@@A@A@@A@@A@A@AB@@@@ADEBABA@@@@@A@@A@@A@@@@@@@@BAAABA@CBAB@@@BAB@D@@@DAB@@@@@@@B@@@BA@@D@BAD@@@@@@A@@BAAA@@@AAAA@AA@AAAA@@A@A@@@A@@BA@@BA@@BA@@@A@A@@@AAA@@@@@AAA@@AA@A@@AC@A@@@A@@@@BAB@@AB@BA@@BAB@@A@@@@@A@A@@BAB@BABAB@BA@@@A@A@AB@@A@@@@AA@@@AA@@AA@@@@A@C@AB@@@BA@@A@A@A@@@CAA@AA@@A@A@A@A@A@A@@@AAAAA@AA@@A@A@CAC@AAC@AAA@C@A@AAA@A@C@A@CAA@CAA@AAC@AAA@@@A@A@@@B@@AB@@ABA@@BA@ABA@@BC@@BA@@@A@@@AAA@A@@@AA@BAAA@@@A@AA@BA@@@AAAA@@AA@A@A@AACAA@AAAAA@@A@@@A@A@A@@A@@AAAAAAAAA@@@A@@A@@@A@A@A@A@@@AAA@A@A@A@@@A@@@AAA@@@AAAAAA@@CAAACAAAA@AA@@ABA@ABA@ABA@AAC@AAA@A@@@A@@@C@A@A@A@@AA@@A@AA@@A@@AA@AAAA@AAAA@@@@@@A@A@@AA@B@@A@@@@@@B@BA@@@@@A@@@AB@@AB@B@B@@@BAD@BA@@BA@@BAB@BABA@@@@@A@@B@@AB@@A@@@@@@@@@@@AA@@@@A@@@AAAA@A@AAA@@A@A@@@A@@@AB@@ABA@@BAB@@@@AA@AAA@@@ABA@@@AAA@@@A@@@A@A@AB@@CAA@A@AAA@AAA@AA@@A@A@A@A@A@@AA@A@A@A@A@AA@@A@@AA@@@@@@@AA@@A@A@@@A@A@A@@@AB@B@@@B@@@D@@@B@@AB@@@B@B@B@B@BA@@@ABA@@@C@@BA@A@@@A@C@AB@@A@@@A@ABA@@@A@@B@@AB@@A@@@@@A@@BABA@ABABA@ABA@A@A@@@@B@@@BA@AB@B@@@BA@@B@@AB@@A@@BAB@@AD@@AB@@AB@@@BA@@@AAA@AAA@AAAA@@AA@@A@@AAA@@AA@@A@AA@@A@@A@BA@@BA@A@@BA@@@A@@B@@AB@BA@"],encodeOffsets:[[129504,44386]]}},{type:"Feature",id:"220282",properties:{name:"桦甸市",cp:[126.746309,42.972096],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@B@B@B@BBD@DBB@B@D@BADAB@@ABA@@@@B@B@D@BBB@@@DAB@@AB@BA@A@C@@@ABADAB@BAB@B@B@B@B@B@BABAB@DA@@BABAB@@AD@BAB@BABABA@C@@AA@@AA@@@A@@BAB@@ABAB@@AB@@A@@@A@AAA@@@A@@B@B@D@B@B@D@@@B@@ABAB@BAB@B@@AB@BABA@ABAB@BABADC@@DABCB@DADAB@BABAB@B@BAB@B@BBB@B@B@D@B@DAB@B@B@B@DADAB@B@BABAD@BAB@BABA@@@A@@DABAB@DA@@B@@@D@B@B@B@B@@@B@@@B@@@BBB@@@B@B@BBB@B@B@DAD@D@B@B@B@B@B@@@@A@@BA@AB@B@@BB@B@B@@BB@@B@@@@@BBBBBB@BB@@@BBB@@@BB@@BB@BB@@@BB@@@D@BB@@@@B@B@BA@@BA@@@A@@B@@@@@B@@@BAB@B@BA@@@@@@@A@@B@@@@@@@B@@@B@@@BAB@B@BAB@@A@@@A@@B@BA@@@A@C@@@A@@@@BCBABADAB@@ABA@@B@BAB@BA@@BAB@BABA@A@@BAB@@@B@D@B@D@B@B@BB@B@@B@@@@@BB@@@@BB@@@@@@B@B@@@B@B@BAB@DAB@@@BAB@B@B@@@B@B@@@@ABC@@@AB@BABAB@@A@@@A@@AAAACA@@AA@@AA@@@A@@AA@A@@BA@@@@@A@@@@@AA@@@@A@@@ABA@A@@@AA@AA@AA@@AA@@ABA@ABA@@@@@@@A@@AA@@@A@@@A@@@A@AB@@AB@B@@@B@@@BA@@@@BAB@@@@@@AB@@@@@B@@@B@B@@@@AB@@@B@@@B@@A@@@ABA@@@@@A@A@A@@@A@@@@@@@@@A@@@@B@@A@@@A@@B@@@@@@A@@@AB@@AB@@@@AB@@A@@@AB@B@B@@@B@@@B@@@B@@@B@BA@ABA@A@@B@@@B@@@@@B@@@BAB@BABAB@@A@@@@@@@@@A@@A@@@A@@@@@@@@AA@@A@@@A@@A@A@@AA@A@A@@@A@@AB@BA@@BA@AB@@A@@AA@AA@AA@AAA@@@A@A@@@@@@@@@A@@@@AAA@@AA@AA@AA@@AA@KDEDC@G@EBC@GDADCBCB@B@D@F@DCBGHEBC@E@C@GBA@C@GBI@E@E@E@K@G@E@CB@@A@C@CBCBGBCDEBG@E@E@G@E@AACAAAAC@AACCICCAC@AAABABAB@D@B@DBBBB@DBD@D@BAB@@CAAAAAAGEGCAACBCBA@AAAA@A@A@A@CBABABCBABA@CAAACAAEAA@C@E@G@CBA@AAA@@CACCAAACAC@E@AAC@AACAAAEGACACAAC@A@A@CB@DA@@BCBA@ABA@A@A@AAAAACCECAAACA@CDCB@BE@@@A@AACAAGAEAACAA@ABCDAHAF@BABABAAE@CCGECCCA@A@AAIBC@A@E@E@GAGCAACCIGCAGEEEECCEAC@C@@@G@GCGCCAAEECCA@A@AAA@CBE@CBC@CAAA@AACAEEIAACAC@ABC@A@A@@@AACEEIGEIEAAA@GBA@C@CAAAA@OCEACAGCCAA@@@ABA@@BAD@@AB@@A@@@@AA@ACAA@@@AAAA@A@A@A@A@EBC@C@AAC@AAACAC@C@ABE@G@C@A@A@A@AB@B@@AB@B@BBB@DBB@B@B@BAB@@AB@@A@A@@AAAAA@@ABA@A@@@A@AA@AAA@EAA@EACAE@A@EAE@E@C@ABA@A@A@@AA@A@AAAA@@@A@A@A@@BABA@AB@BABCBAB@@A@@BAAA@A@@A@@AA@@A@AACBA@CBE@AAC@A@@A@AAA@A@AAAACAAAAAA@ABC@CBABCBABABE@ABC@ABABABABCBA@EBC@C@CAA@C@EAC@CAC@CAEAC@A@A@A@AB@@BD@@BB@B@@ABA@E@E@A@ABABABA@A@ABAAC@C@A@C@CBCBABCBCBEBABE@EBE@C@C@C@A@ABA@AB@B@B@B@BBD@BBB@BBB@BAB@B@@ABAB@@A@CBA@G@EBA@AB@BAB@B@B@BA@@DCBADCBADCFAD@BABADABAB@BAD@B@B@F@@@D@@AB@BA@A@A@A@AAA@CACCAAA@AAAAC@CAC@C@AAC@CBC@E@C@A@A@AAA@CAA@AAAAAA@A@A@A@@BA@A@A@A@C@AAC@@AAA@AAC@A@C@C@C@AACAAA@AA@AAA@CAAAA@@@A@AAC@A@CAA@AAAAAAAAA@AAA@A@AAA@A@ABA@CBCBCBABABC@A@ABC@A@CAA@AAC@CAC@AAA@A@A@ABA@AB@@AB@B@B@B@DBDBFBBBDBDBBBDDBDDD@BBBBBB@B@B@B@@@B@BABA@@B@B@D@D@B@BBBBDBB@BBB@BBDBD@BBB@BBB@BDBDBBBBBBBDDBDDF@@B@@@DFDDFDBDDF@DBFBD@B@BBBBNBD@J@D@F@BABADABCBCDC@ABCBE@CBA@A@ABABAB@D@D@BABABADAB@BAD@DAB@BCBABEBC@ABCBCDABAB@B@D@B@B@BCBABCDABABCDABAB@B@B@B@B@B@BBD@B@HBB@B@F@F@DAD@DAB@BABABABABABAFADABADBB@DAF@D@B@BBF@F@B@@BB@BBB@@DDBBBB@B@BBBBBBD@B@BADAB@BABABABA@@BA@A@@@A@AAA@@AAAAA@@AAA@@AC@@@@A@@@AAA@@CC@AA@@A@@@@BA@@@@@A@@A@@A@@AA@@BA@A@@@AA@@@@@AA@@@A@@A@@AA@@@@AA@@@@@A@@@AAA@@@A@@A@@A@@AA@@A@A@@@A@@@@@AA@@@@A@@@@@@A@@A@@A@@@@@A@AAA@C@A@@@@@AAAA@A@A@A@@@@AA@@@@BA@@B@AAA@A@@AA@AAC@@@A@@@@BB@@BAB@@CB@@A@A@A@AAAAA@C@A@CAC@@@A@AA@@ABA@ADAB@@@DA@@@A@A@CACA@@@@A@A@@@A@CAC@C@A@A@A@@@C@AAA@@@A@A@C@C@C@AAC@A@AAAA@@AA@@@@ABA@ABCBCBABA@A@ABA@ADAB@@A@A@A@A@@BCBABA@AB@BABA@@@A@AAA@AAAA@AAA@@AAAAA@A@A@A@@@A@@@A@@@@@AA@@A@@A@@A@@@AAC@AAABA@A@@@A@@@A@CBA@@BA@@@A@C@AAC@AAA@@A@@@AAA@@A@@@AAA@@AAC@AA@@AABA@AA@@A@C@@A@AAA@@AA@@AAAA@@AAA@@@A@@@CD@@A@A@A@A@A@A@@@A@@@@@@@ABAB@@AB@@@@A@A@@@A@@@@@A@@@@BAB@BAB@BA@@@ABABA@ABAB@@A@@B@BABA@A@C@@AAA@@A@@@@BA@@@A@@@@@AA@@@@A@@BA@AB@@A@@@@@AB@@A@@@@@A@@BA@@@AB@@@@@@A@@@@@A@@@A@A@@@A@@@A@@@@@ABA@A@@@@BA@A@@@C@C@CBC@C@@@AB@@@AA@@@@@@AAA@@@A@@@AAA@@@@AA@@A@@@A@A@@@@@A@A@A@@@@@A@A@A@A@A@@@A@@@@@A@@@A@@@A@A@A@A@ABA@@@A@@@@@A@@@@@AA@@A@@@A@A@@@A@@@@@@@AA@@@@@@A@@@A@A@@BA@@@A@@@@@@@A@@@@@@@@@A@@BAB@@ABA@@@AB@@A@@@@@AAA@A@AAA@A@@@A@@A@AAA@@A@@AA@@@AA@@@@@C@A@C@@@A@@@A@A@A@AB@@A@ABC@A@A@@@@@@@@@AA@@@A@@@@@A@@A@@@AA@@@A@A@A@@@A@A@@@A@A@@AA@@@A@@@@@A@A@A@AA@@A@@AA@A@@A@@A@@@ABA@@@A@A@@B@BA@ABA@@@A@@@A@@@A@@@@@@@AA@@A@@@A@C@A@A@A@CB@@A@@@ABA@A@A@@@A@@@CAA@A@@@AA@@CAAA@@C@C@A@A@A@A@CA@AA@AAA@@@A@AA@@@@CBCACBA@A@A@@@@@@A@@@@@@@A@A@@AA@@@A@AA@@@@A@@A@@@@A@@@A@@@A@@@@@@A@A@A@A@AA@@A@@A@@@@@ABA@@BA@@@A@@@@AA@@@@@A@@@@@@@A@A@A@@@A@@@AA@@@@A@@@A@@@AA@@@A@AA@@A@@@@@BA@@@@@A@@@@@@@@@A@@A@AA@@@@@@@A@A@@AA@@A@@@A@@@AAA@@AAA@@@AA@@A@@@A@@@A@@@@A@@A@@@A@A@A@@@A@@BA@@B@@A@@@@@A@@@A@@BA@A@@BA@@B@@AB@@@@CBA@@@@B@@@@@B@@@@@B@@@@@B@@@@@@@@@@A@@@A@@A@@C@ABA@A@@@@@A@@@A@@@@@A@@@A@@BA@@@A@@@@@@@A@A@@@@@@AA@@@@@A@@AA@@AA@@AA@@@@A@@@A@@@A@A@@@A@A@A@C@A@@@AACA@@A@@A@@AA@AA@@@A@@@A@@@@@@AA@@@A@@AA@@AA@AC@AA@@A@@@@@A@A@A@A@A@AAA@@@A@@@@A@@@@AA@A@@@A@@@@A@@AA@@@A@@@@A@@AA@@@@@@@@@@A@@@@@@@A@@@AA@@AAA@@@@@A@@@@@@AA@@@@@@A@@@A@@@@AC@AAA@@@A@AA@@A@A@@@A@@@CAA@@@A@@@ABA@@@@@ABABABAB@@@@A@@@A@A@@@@@A@@B@@@@AB@@AB@B@@ABABAB@B@@AB@@@@AD@@A@@D@@AB@BA@@B@@A@ABA@@@ABA@@B@@@@@D@B@DA@A@A@A@A@A@ABC@@@A@@@A@@@A@A@A@A@@@A@ABA@A@ABA@ABA@CBC@ABA@@@A@@@AB@@AB@@A@@BA@AB@@@@@@@@@B@@@@A@A@@@A@@@A@@@A@@@A@ABA@A@A@A@@@A@A@@AA@A@@@@@@BA@@@AB@@AB@@@@@B@@@@@@@BB@@B@@@@@@@B@@@B@@A@@B@@@B@@@B@@A@@@A@@B@@A@@@AB@@@BA@@@@@A@@@A@@@AA@@A@@@AA@@@@@@@@A@@@@@@@AB@@@BA@@@A@A@@BA@@@A@@B@@A@@B@@@B@@A@@@@@AB@@@BA@AB@@A@@BA@@@@@A@@@AAA@@@@@A@@@@@A@A@A@AB@@A@@BA@@@@B@@@@@B@@@B@@BB@B@@@BBB@@@B@@@B@@B@@B@@B@B@@BB@@@B@@BB@@B@@@@@BB@@B@@@B@@@@BB@@@@AB@B@@@BAB@B@BAB@@@@@@A@ABA@@@@BA@@@@@@B@@@@@B@@@@@B@@@@@@A@@@AB@BBB@@@B@@@B@@@@BB@@@@@@BB@@B@@@@@@@@BA@@@A@@@@BA@@@AB@@AB@@A@@B@@@@@B@@@@@@@BB@@@@B@@@B@@A@@B@@AB@@@@@@@BB@B@BB@@@@BB@@@B@@@@@@B@@@@BB@B@@@@@@@B@@@@@B@@@@@BB@@B@@@@@B@@@B@@@B@BA@@B@@@B@@@B@@@@@BB@@B@@BB@@@@@BB@@@@@BB@@@BB@@B@@BB@@BB@@B@@@B@@@B@@@@@BB@@@@BB@@@@@B@@@B@B@@AB@@@BBB@B@BBB@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@BB@@B@@@@@B@@@@BB@@@BB@@B@@@@B@@@@AB@@@BA@@BAB@BA@A@@B@@A@@@AB@@A@@@@B@@AB@@@BA@@B@@@@AB@@@@AB@@A@@B@@@@@@@@@@@@B@@@BB@@@@B@@@@@@BB@@@@@@@A@@BA@@@AB@@A@@@@@@@A@@@@B@@AB@@@@@B@@BBB@@B@@@@@BB@@@AB@@@@@BA@ABA@@B@@@@A@@B@@@@@B@@@@@B@@@@@@@B@@B@@@@@@B@@@AA@@@A@@@A@@@A@A@@BA@@@@@AB@@@@@@A@@B@@@@@B@@@@@B@@A@@@@BA@@@ABA@A@@@A@A@@AA@@@A@A@@@A@@@@@@A@@AAA@@AA@AAA@@@AAA@@@A@@AA@A@@@A@A@AAA@A@A@AAA@A@A@AA@@A@A@@AA@A@@@@@A@@@@@A@@@A@@B@@A@@@@@@@@B@@@@@@@@AB@@@@@@A@A@A@@@@@@@A@@@@@@@AB@@@B@B@@@B@B@@@B@@@@@B@@@BAB@@A@@@A@A@A@AB@@AB@@@B@B@BA@@BA@@BABBB@B@@@@AB@@@B@@BB@B@BAB@B@B@BB@@BBB@@@BB@BB@@BBB@@BBB@@BBBB@BB@B@@BB@@@@@@@@B@B@@@@@@@@@@@B@@@@A@@B@@@BA@@BABAB@BA@ABAB@@@B@B@B@@@@@B@@BBC@@AA@CAA@A@CA@@A@A@AAA@A@@@@A@@AAA@A@@@@@@@@B@@@@A@@@@@@BA@@@@@@@@@@@@B@@B@@B@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@A@@@@@@@A@@@A@@@@@C@AB@D@F@@@B@@@B@D@B@B@@@BB@@B@B@BB@BBB@DBBBFDFDFBBB@@@BB@@@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@B@@@B@@B@@@@B@@@@@@@@@@@@BB@@@@@@B@@@@B@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@B@@@@@@@B@B@B@BADAB@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@BA@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@BB@@@@@B@@@@B@@ABA@CBC@A@C@CBEBC@CBA@ABABA@ABA@A@AB@@@@@@@@@B@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@B@@B@B@B@@@@@B@@AA@@@@@@A@@@@@@A@@@@@@@@@@A@@@@A@@@@@@@@@@A@@@A@@@A@@B@@A@@@A@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@B@@AB@BBBBB@BB@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@BA@@@@@@@B@@@@@@B@@@@@@@@@@B@BB@BB@B@@B@B@B@BBB@@BBBBB@@BB@B@@BB@@B@@AB@B@@@B@B@BBB@@BBBB@@BB@@@@A@ABABAB@D@D@B@@A@@@A@A@@@@@@@@B@BBB@B@B@B@@C@A@C@A@@@@@@A@@A@@AA@C@C@A@A@A@A@A@A@A@AA@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@B@@@@B@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@AB@@@B@@@@@B@@A@A@@BA@@@AB@@ABABABAB@@@@@B@BAB@B@@@B@@@@AB@@@BA@@BB@@B@@@@BBB@@BA@@D@B@B@BAB@BA@@BA@@@@BA@@@@@A@A@@@@BA@A@@BA@A@@@@B@@@B@@AB@BAB@B@@@B@@@B@B@B@@B@@BB@@@@B@B@@@B@@B@@B@@@B@@BB@B@@BB@@@BB@@@@@@@B@@@@B@@@@@@AB@BA@ABABA@AB@@AB@@@@A@A@@@@@A@@@A@ABA@AB@@@B@@@@@BA@@@ABA@A@@@A@@AA@@@A@@@A@@@A@A@A@A@@@A@@AA@@@@AA@A@@@AA@@A@@BA@@@A@@BA@@B@@@@@B@@@@A@@@@B@@@@@@@BA@@B@@@@@BA@@BA@A@@@AAA@A@@BA@@BAB@@@BA@@B@@A@@B@@@@AB@@A@@@@@A@A@@@A@@BA@AB@@@BA@AB@BA@ABABAB@B@B@@@B@B@@@B@@@B@@A@@BA@@@@BAB@@ADAB@BA@CBA@@@A@@@@BABCBAB@@@BAB@BA@AB@@@@AD@@@BA@ABC@CBA@A@ABA@@B@@ABA@ABA@A@@@A@ABA@@BABABABADAB@BA@@@@B@@@@@B@@@B@@@@@@A@@@AB@BABA@A@A@A@A@CAA@A@A@A@@BAB@@AB@@A@A@@B@B@BA@A@C@A@A@C@CA@@AA@@AAA@@@@A@@A@A@@@A@@AA@@@A@A@@@AB@B@@B@@BB@@BA@@BA@A@@BA@@@A@@B@B@@@@BBB@@@@@@B@@@BA@@B@@ABA@@BA@@BABA@@BA@@B@B@@@B@@@BA@@B@@@BA@@@A@AB@@ABA@@B@@@BA@B@AB@@@@@@@B@@A@A@@B@@@BA@A@A@AAC@A@A@A@A@@@AA@@AAA@AACA@@@AA@A@@AC@@@AAA@@@@A@@A@A@A@AAA@@@A@A@@@A@AAA@@@A@@@AAAB@@C@ABABABABA@A@A@@BAB@B@@A@A@A@@BA@A@@@ABA@CBC@CBA@ABA@A@AB@@A@BB@B@D@B@@AB@B@@@B@B@B@@@BAB@@@@@@@@@DAB@@@B@@@B@@@B@B@@@B@B@@AB@@AB@B@B@B@BBB@@ABAB@@A@@BA@A@AAA@@BABAB@@AD@B@B@BBB@@@D@@@B@@@@A@@BA@@B@BA@@B@BAB@BA@@B@@@@@@A@@@@@@@@@A@@@@@@@A@@@A@AAA@A@@AC@A@A@A@AA@@A@A@@@A@A@@@@AA@@A@AA@@BA@@BA@CBA@CBC@ABA@A@AB@@@@@B@@@B@@B@@B@@BB@@@B@@@BA@ABAB@B@@AB@@ABA@A@ABA@@BA@@BA@@B@B@@@BA@@@AB@BAB@BAB@@@BAB@B@@A@@@AB@@@BBB@@@B@BB@@BB@BB@@@B@B@B@@@B@@A@B@@B@@@B@@B@@BB@BBB@@AD@B@B@@@B@BB@@B@@B@@@@BBBA@@B@BAB@@@BB@@BB@BB@@@B@@@BAB@B@BBB@B@B@@AB@BB@@@@B@B@@B@@B@@@@@B@@@BA@@B@@@B@B@@@B@B@BA@@BABAB@B@B@B@B@D@BBB@B@B@B@@@@B@@@@@BB@@@@B@@AB@@@B@@B@@BB@@B@@B@@@BB@@@BB@BBB@@@BBB@BB@@D@BBB@@@B@@B@B@@@B@@BB@@B@@@@B@@@BAB@@@B@B@B@B@@BD@@@D@@@@AB@B@B@@BB@@BB@@BB@@BBBB@@BBD@BBB@@B@B@B@@@BA@@BAB@@@BABA@@BA@A@@AABA@A@A@A@A@A@AB@@A@@B@@@B@@@B@@@@A@ABA@AAA@A@@@C@C@A@A@AA@@A@@BA@@@@@A@A@@@A@@BA@A@@@A@A@@@A@@@ABA@@@AB@@@B@B@@@BAB@@@B@@@B@@AB@B@@@BAB@BAB@@BB@@@B@@@B@@@B@@BB@@@B@@@@@B@@@@ABA@A@@BA@A@A@A@A@@@A@@B@@@@A@@@A@@B@@@@A@@@@@AB@@@@@@@B@@@B@@@@@@@BA@@B@@@@@@A@@@@@A@@@@AA@@@@@@@@@@BA@A@A@@@ABA@A@A@A@@BAB@@@@BBB@@@@BFF@@@B@@@@AB@@@B@B@@@B@@@B@@BBB@B@B@B@B@B@@@@@B@BBB@B@BB@B@BB@BBBB@@@@B@@B@@BB@BBBB@BB@B@@@B@BBB@@@B@B@@@B@B@@@BB@@@B@B@B@B@B@B@@@B@@@B@@@BAB@BA@@BAB@B@@AB@B@@@B@@@BA@AD@BABADA@@@@B@B@@@B@B@@@B@@@B@@@@@BBBBDBB@BBB@BB@B@B@@BBB@BB@@@@BB@@@@@BA@@@@B@B@BBB@@BB@@@B@B@B@BAB@B@B@@@B@@BB@@@B@@@B@@ABAB@B@B@@@@B@B@BBB@D@B@BB@@BB@@BBBBBB@@@B@@BB@@B@B@@@@BB@@@B@@@B@B@@@B@BBD@BBB@@@B@@AB@B@@AB@BAB@B@B@@@B@@@DBD@B@DBBBB@BBBB@@B@B@B@B@@BB@@B@BBB@@BB@@@@@B@@@@@@@B@@@@B@@@@@@BB@@B@B@BB@@@@BB@@@@BBB@@B@B@@@B@B@@AB@B@BA@@B@B@B@D@B@@@B@@A@AB@@ABA@@@A@A@@@A@@AA@@@A@A@@B@@@B@B@B@B@B@@@BA@@BAB@BABAB@@A@ABA@@BA@A@@@A@@@AA@@AAA@@@A@A@@@@AAA@@@CA@@AAAAA@AA@@@A@@@AB@@AB@@@@A@@@A@@B@@A@@B@BABAD@BAB@@A@@@AB@@AB@@ABA@@@A@@@@AA@@@A@@@AB@@A@ABA@@@AAA@@@AA@@AA@AAB@@AB@BAB@@@BAB@BAB@@AB@B@BAB@B@B@@@B@@@B@@@BBB@@BB@@AB@D@@@B@B@@@B@B@B@B@@@BAB@@AB@@ABA@@@AB@@@B@@AB@B@@AB@@A@BB@@@BBB@@@BB@@BB@BB@@@BB@@BBBBB@BBB@BB@@B@@A@@BA@@BA@@BC@@BA@AB@@@BA@@@AB@@A@@@ABAB@@A@@@A@@B@B@B@BAB@BABAB@BA@AB@@@@@B@@@BA@@@AB@@@B@BAB@@@B@@@BAD@B@@@B@B@@AD@@@B@BA@@B@@A@A@A@A@A@@BA@@@A@@@C@@@A@@@ABA@@B@B@B@@@B@B@@@BB@@@@@@B@@BB@@@BBB@B@B@B@B@@BB@B@B@B@B@@@BBB@BBB@BBB@B@DB@@BAB@B@@@B@@@B@BB@@B@BA@@B@BBB@@B@@A@AB@@AB@BA@@B@@@B@@@B@BB@BBB@B@BB@B@@@B@@B@@B@@@@@@@@@@@BA@@BA@@@@B@@@@ABABA@AB@@AB@@ABC@AB@@A@A@A@@BA@@B@@@B@@@@ABA@@@@@@@@BA@B@@BB@B@@@@@@@BBBBB@BB@BBB@@@BB@@B@BB@@BB@B@B@D@@@B@@@B@B@BBD@BBB@BAB@BAB@BA@@BBB@BBDBBBDB@@BBBBBB@@B@BB@@B@@@B@B@B@B@BB@@B@B@B@B@@@B@@@@B@@BBBBBBBB@BB@@@@B@B@B@@@BB@BBBBB@DBBBB@B@B@@BB@BB@B@@ABB@@B@B@@BBABB@@B@@@BBB@B@@@B@@AB@@AD@@AB@BAB@@AB@BA@@BA@@@B@B@@@B@BBD@BBB@DBB@DBB@D@B@B@BBB@D@B@BBD@BBD@DBB@B@@@BBB@BBBB@@B@B@B@B@B@B@@@BBB@DB@@B@B@B@@@AB@@A@@B@D@B@@B@@BB@@B@@BB@@@@@BA@@B@B@B@@ABA@ABABA@AB@@@B@B@@@@@BA@AB@@ABA@@BA@AB@@@@@B@@@BBD@@@BBB@@BB@B@@@@BB@B@@@B@B@@AB@@AB@@AB@@@B@@@B@BB@BB@BBBB@BB@B@@BBAB@@@B@@D@B@D@@@B@BBB@@@B@@AB@BAB@@@B@BB@@FAB@@@@@@@@ABA@@BABA@A@AB@@@B@DABADABAB@B@D@@@B@B@BAD@B@B@B@B@FDB@@@@@BA@@@@@AB@@AB@@@DDF@BBDB@@FDBB@BB@BB@@@@@D@B@@BDBB@@BB@@@@@B@B@@@BB@@@@B@@@@ABAB@B@@@@@B@@B@@@BBB@B@B@B@B@DADABAB@B@B@D@DB@@B@B@@@B@B@B@B@B@B@B@@@B@@BB@@BB@@@B@DAB@B@DBBBB@B@@@B@B@B@@AD@D@B@B@DABAB@DBB@FAH@F@BBB@@@D@B@D@@@B@BAB@@@B@@@@@B@@@B@B@@@@AB@@@@@B@@@BA@@B@@A@@@@B@@@@BBBBBDB@@BD@B@@B@BBBABB@@B@@B@@BBBBD@@BB@@@@BB@FDD@BBBB@@@AD@BAB@BAB@@@@AB@B@@@@@@@B@B@@BB@@@BCFCDA@@B@BB@@BA@@@@BA@@B@@A@@@A@ABC@AB@@AB@B@@@BAD@DABA@@DA@@@@B@@@BA@@@@D@@@@A@@@@@A@A@@@A@@AA@@AA@AB@@ABAB@@A@C@ABE@@@@@AB@@@BA@@B@B@@@D@@AD@@@@@BA@@@@B@@BB@BB@@B@@@@B@@B@@@BBB@@@BBBBB@@BDBB@B@B@D@DBB@BB@@B@B@BDBB@BB@@@BABAB@@@D@@@F@D@B@DBB@B@B@B@B@D@BBB@@B@@@@B@@B@@A@@BABAB@BAB@B@D@@BB@@D@D@D@@@BB@BB@B@D@B@B@D@LD@@PIRKREPANCJANINMNQPUBIDGHGHINGVC`@J@bDRBPERKNEDAHAB@L@F@LBJFHBFBHABCAECESSGICGGGECIAGCECEI@K@E@ICIEE@IBGDEDERONIFKBCDIBEDCHCN@LDH@HEBGAEGMGIAAQOIOAE@@@@FAF@DADBF@D@@@@@B@@@@@B@@@@BB@@@B@@@@@@@@@B@@@B@@@@@@@B@@@@@@@BB@@B@@@BB@@B@@@@@@@@@@@@@@@@@BB@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@B@@B@@B@@@@@@@B@@@@@B@@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@B@@B@@B@@@@@B@@@B@@@@@B@@@@@BB@@B@D@H@B@B@@@B@B@DA@ABEBABCBABA@A@ABCFCBABA@AB@B@B@@A@@@A@@@AAAA@BA@@BA@A@@BA@@@A@@B@B@@AB@BA@A@A@A@@@A@@@@@@@@@AAAD@BABAB@BAB@@A@@@ABA@BB@@@D@B@B@B@B@@@B@@@B@B@@@B@BA@@@@B@@@@AB@@@B@B@B@B@B@BAB@B@@@BB@@@@@B@@@BB@@@@BB@BB@@B@BB@@B@BBB@@@@@B@@@@@BA@@B@D@@@D@@@B@@@B@@BB@@@@B@@B@@@B@D@@B@@@@@@BB@@@@@@B@@@@@B@@@@@B@@@@@@@B@@B@@@@B@@@B@B@@@@B@@@@@@@@BB@@@@@@@BB@@@@@B@@@DB@@@@BB@@B@@BB@B@@B@@B@B@B@@@@BB@@@@@@@@@B@@@B@@@@@B@@@B@@@@@B@@@@@@@BB@@@@BBB@@@B@@B@@@BB@@@@BB@@@@AB@@@B@@@@@@@@AB@@@BA@@B@@@B@@@@@@@@AB@@@@@@AB@B@@AB@B@B@@@@@@@B@@@B@@@B@B@@@@AB@@@@@B@@@@@B@@@B@@@@@B@@@B@@@@BB@@@@BB@@@@@BB@@D@@@@@B@@@@@B@B@@@B@@@@@@@B@@@@@B@@B@@B@@@@BB@@@@@B@@@BA@@@@B@@@@@B@@@@@@@B@@@B@@@@@BA@@BA@@@@@@BA@@B@D@@@@@@@@AB@B@@@B@D@@@BAB@B@@@B@@@B@@@@@B@B@B@B@@@B@@@B@@A@@@@B@@@@@B@BB@@@@@@@@B@B@@@@@B@@@B@@@@@B@@@@@@@B@B@B@B@B@@@B@BB@@BB@@@@@@B@B@B@@@B@@@B@@B@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@B@@B@@B@@@B@B@@A@@@@B@@@@@B@B@@@BB@@@@@B@@BB@@@B@B@@@B@B@BA@@@@@@BB@@B@@B@@@@B@BA@@B@@@B@DA@@B@@@@@BA@@B@@@B@B@@@B@@BD@B@@@B@B@BA@@B@@@@@B@@@@BB@BB@@B@B@B@B@BBB@BBBDB@@BB@B@@@@@BBB@B@@@@BB@@@B@@@B@@B@@@@@@@@B@@@@B@@@B@@@@B@@@@@@@@@@@@BB@@BB@@BBBBB@@B@BD@@B@@@@@@@ABA@@@@@@B@@A@@BA@@@@B@@@B@@BB@@@B@@@@@BAB@@@@@@@BB@@@B@D@BBB@@@B@B@B@BBB@@@@@@@BB@@@@B@@@B@@@@@B@BB@@B@@@@B@@@@@@B@@B@@@@@@B@@@B@@@B@@@BB@@B@@@@@@@B@@@@@B@B@@@BB@@B@B@@@D@B@@@B@FDB@@@@BBB@@@BB@B@B@B@B@B@B@B@B@B@BBB@BBBBB@BBBDBB@@B@@@@@B@B@D@@@B@@@B@@@B@B@B@J@B@B@@@@@B@@@@@B@@@B@B@@@@@B@@@BB@@B@B@BBBBB@B@D@D@@BB@BBD@B@B@BBDA@@BB@@BBB@@@BBB@BDD@@BBB@BBB@BB@BBF@B@B@B@B@@@B@B@B@D@B@B@B@@BB@@@BAB@BADAB@DA@@BA@ABADCFEBEB@BAB@BBD@DBB@BDBBDBBD@BBDBBBBDDBBBD@BAB@F@@@BBBB@FBF@DBB@B@D@FAFAHAD@DAD@@@@@BA@@@ABA@A@A@@B@B@B@B@DAB@@@D@@@DAD@DADAB@BAB@@AB@B@BAB@DA@@B@BA@@BABABAD@@AB@@A@AB@@AB@BA@@@@@A@A@@@ABA@@@A@@BA@AB@@AB@BA@@BA@@@A@@AA@@@AA@@A@A@@@A@A@@BA@@@A@A@@AA@@A@@@AA@@@A@@B@@A@@@@@@@AA@AAA@@@@AA@@@AA@AA@@A@@A@@@AA@@A@AAAA@AAA@@AA@A@@@@AA@@AA@AA@@A@@@A@@@AA@@A@@AAA@@AA@@AAA@@@A@@@A@@@A@A@A@A@@@A@@@C@AA@@A@AAA@@@AA@AAAA@@AAA@@A@@AAA@@@AAA@@@@AA@AA@@AAA@AAAA@@A@A@@@AAA@A@@@AA@@AA@@BA@@AA@@@AA@@AA@C@@@AAA@A@@AAAAA@@A@AAA@@AA@A@AAA@AA@@AAA@A@A@@@A@A@A@@@A@AA@@A@@AA@@A@@AA@@@A@@AA@@@A@@@AAAA@@@AA@@A@ABA@A@A@A@C@A@AAC@@@A@@A@@@@AA@A@@@@AA@@A@@AA@@AAA@@@AA@@A@@@A@A@A@A@A@A@@@A@A@A@@AAA@A@A@@@AA@@A@AA@AA@@AA@@@A@AAA@@@A@@AA@@AA@@@@A@@@@B@@A@@@A@@@@@A@@@@@@@AA@@AA@AAC@AAA@@@@@AA@@@@A@@@AA@@@@A@@@@@@@A@@@A@@@@@@@@@@@AA@@@A@@@AA@@@AA@@@@@@@A@A@@A@@@@@A@A@A@A@@AA@AA@@A@A@A@A@A@@AA@@@A@@@@@C@A@AAA@A@AAC@@@@@A@A@A@@@A@AA@@C@C@@@A@A@A@A@C@A@A@A@A@A@A@A@CAA@A@E@CB@@@@@A@@@A@A@@BA@@B@DABAB@DABA@@@@@A@A@A@@@@BAB@B@BADAD@BABAD@@AB@BA@ABA@A@@@@AAAA@@@A@A@@AABABA@A@@@@A@A@CA@@A@@ABA@@@ABA@A@AAAAAAA@@@CA@@A@AAA@@@A@AA@@A@A@AAAA@@AA@@@B@BAB@B@BAB@@A@@AA@C@A@A@A@@@@BA@@@@B@@ABA@A@ABA@A@A@AB@BA@A@@@A@A@@@A@A@A@@AAA@A@A@A@A@CACA@@C@AAC@AAA@A@@A@@@AA@@C@@@A@A@A@A@A@@AAAA@@AA@@@@@A@@@AAAAAAAAAAAAAAAA@@A@@A@@AA@@A@@@AA@@@@A@A@@BA@@B@@@A@@AA@@@@A@@@A@A@@@AB@@AB@@AB@@ABA@@@@B@B@@@BAB@BABAB@@@@@B@@@B@@@B@@@B@B@@BB@B@B@D@@@D@B@@@BBDAB@B@B@B@@@BA@A@@@@AA@A@@@@@A@@BA@@@AB@BA@@BA@@@A@@@AB@@@@A@@@AA@@@B@@A@@@@B@@@@@B@@@B@@@@@@@@@@@B@@@B@@@@@@@@@BA@@BAB@@@BAB@@@@@@AB@@AB@@@@AB@@A@@@A@A@AB@@AB@DABABABA@@@A@ABA@CBABADABA@AB@@@B@B@B@@@B@B@B@FABADABABAB@@@B@@A@@B@@AB@B@D@D@B@BB@@@@B@@@@@B@B@@@BADABAB@BAB@B@@@@A@@@A@@B@B@@@B@B@BBB@@@B@@@@@@A@@@A@@BA@@@ABA@@BA@A@A@@BABAB@BAB@@@@A@ABA@@@AB@DABA@A@AB@"],encodeOffsets:[[130649,43987]]}},{type:"Feature",id:"220283",properties:{name:"舒兰市",cp:[126.965607,44.406105],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@AA@@BBBB@@@@@AA@"],["@@B@@BBAB@B@ACEB@B"],["@@BCB@@AAAB@@@BA@@@@@BB@FEA@@ABAIBCJ@F"],["@@@@F@@@B@@BDBDBDB@@BBBBB@@BBB@@BDBB@@BBB@BB@@@@BB@@@@BBDB@@BB@@DDBBBB@@@@BBDBB@BBBB@@BBD@B@BB@@B@@B@@@B@B@@@B@@@@@B@B@B@@@B@@BB@@@@AB@@AD@B@@BB@BB@@BBB@@BB@BBB@B@BB@@B@@@@B@@@B@B@B@D@@@B@B@B@@@B@@@B@@@B@B@@@B@@@BBB@@@B@@@BA@@@@B@B@B@B@@@B@B@@@BA@@@AB@BAB@BAB@BAB@BA@@B@B@BAB@B@B@@@BA@@B@@B@@B@@@@@B@@AB@@@B@B@B@B@BDA@A@A@@B@@DBD@B@@BB@ABA@@BB@@@DBD@B@BBBAB@BBC@@BB@FBBF@ABBDDB@@@B@@B@@@B@D@B@BBBB@B@BAB@B@B@HHBD@B@B@BC@ABC@AB@B@B@@DBB@@@B@@@D@HEB@HF@@@@@@@@@@BB@@@@@@HB@@@@@@BA@@@@@@D@B@DB@@B@B@B@D@D@B@B@B@@@@BB@BB@@DB@@B@B@B@@@B@B@B@B@@@BA@@BABAB@@AB@BAB@@A@@B@BB@@B@D@BBB@@AD@B@@AB@@@BA@@@A@@AAA@AAAA@@@AA@AAA@@@A@A@A@@@A@@@A@@@@@@@@A@@@A@@@A@A@@A@A@A@@AA@@@@A@A@A@@@@AA@@@A@@@@@AB@BAB@BAB@D@BAB@@@B@BBB@B@B@@@B@@BB@B@@@@ABADABADA@@@@B@B@DBB@@@B@B@B@B@@A@@BA@@@@B@B@@@B@@@@AB@@@@A@BB@@B@@B@@@@A@@@@@@@@B@@@BA@@@@@@B@BAB@@@DAB@DA@@B@@@@@@B@@BBB@@@@B@@B@@@@B@@@@@BB@BD@BBB@@BD@BBB@B@@BB@@@@@@AB@@@B@B@@BB@B@B@@@@@B@@@@@@@B@@@@BB@@BB@@B@@B@@@@@B@@@BB@@@DBD@D@FAB@F@D@D@B@B@B@D@D@DAB@B@F@B@B@B@B@B@B@@@B@BAB@@@D@@@B@B@B@@@BA@ABAB@D@B@@@@@B@B@@@B@B@B@B@B@B@D@H@B@D@DAJ@D@F@D@F@D@FAF@D@D@H@B@H@DAF@F@D@B@D@B@@@B@@BB@@@B@@@@BB@@@B@B@D@@@B@BAB@BCBA@AA@AABAB@BABA@AB@BAB@BAB@B@B@D@BABAB@D@D@D@BAD@BA@@B@DAB@BA@AA@BABAD@B@D@D@B@B@BB@@BBB@D@BA@@B@B@B@B@FCBAB@@A@AB@B@B@BABAF@@@B@D@B@B@DBB@B@BC@AB@BAB@BA@C@AB@D@D@B@BBBBBA@@B@B@DABAB@B@B@B@AA@A@@BAF@DADAB@BADC@@@AA@@A@A@ABCDCDABAB@DAB@B@DADBB@BABAD@B@@BB@@BB@BBB@B@BA@A@A@@@@DAB@@AB@BAB@F@BBB@B@B@@AB@@B@@@B@@@@D@@@@@@A@@@A@@BAB@DAB@@@BB@@B@B@@ABC@@DGBABC@ABADAD@@A@A@A@@B@@@@@@A@AA@@A@A@@B@@@@AB@@@@@@ABA@A@C@@@@@@@@A@A@@AA@A@AA@@A@@AA@@AA@@A@@A@@@A@@@A@A@@AA@@A@@@@AA@@A@A@@AA@@@AAA@@@AA@@A@@AA@@@A@A@@@@AB@@A@A@A@@@A@@@@@A@@A@A@@@@@@@@AA@@@@A@@AA@A@@@AA@@@BA@@@AA@@@@@A@@@@@A@@@@@A@A@@@@AA@@A@AA@@AA@A@A@A@A@A@A@CBA@A@A@A@A@@@A@@@A@C@C@A@A@@@A@@@AB@BA@AB@@@@ABAB@B@@@B@@@@@BA@@@@A@@@@@@@@@@A@@@AAA@@@AA@@A@A@@@A@AB@@@@A@@AA@@@A@@@A@@@AAA@@@@@A@A@@@ABA@@BA@@@@@A@A@@@A@A@@@A@AA@@A@@A@@@@AA@@A@@@@@A@@@A@@A@@A@@A@@@@AA@@@@@@@@@AB@@A@@@@@AA@@@@A@@@@@@A@@AA@@@@@AA@@A@@@A@@@A@A@@@A@@@A@@@A@@@A@@AA@@@@@BA@@@@@A@@@A@@@A@@B@@A@A@A@@B@@A@A@@@A@@BA@@B@@@B@@A@@B@@@@A@@BA@@BA@ABA@A@@@AAAAAAA@@AAAAAA@@AA@ACA@AA@AA@A@AA@@A@ABA@@@A@AB@@AB@@AB@@@B@B@@@B@@@@@@AA@@A@@@AB@AA@@@A@@@AB@BCB@@A@A@@BABABABA@@@AB@B@B@@@B@@A@@AA@A@A@@@A@@@AB@BAB@B@D@B@@AB@@@BAD@B@BAB@B@BAB@@@BAD@BAB@@@@AB@@ABCBA@ABA@@BABABA@A@AB@@@@A@@@A@@AA@@@@@@@@A@@@@A@@A@@@@AAA@@AA@AA@@A@@@@@A@A@CBA@A@@AA@@AA@@AA@@AAA@@AA@AA@@AA@@A@@@@A@@@AA@@@@AA@A@A@@AA@AA@AA@@AAAA@@A@@@@@@@AAA@C@A@@@@@@@A@@@@AA@@@@@@@@@A@@BA@AB@BA@@B@@@@@@@@@B@@@D@D@@AB@@@B@@@@@B@@@B@B@@@BA@@@@@@@@@@@@@@B@B@@@BAB@BA@@@@BAB@B@B@@@@A@@@AB@@A@AB@B@B@@ABA@@BA@@DA@@BABADCB@@@@AB@@A@A@A@@BA@AB@@A@@@A@AB@AA@AA@@A@ABA@A@AAA@@@C@A@@@A@@@@@@@@@@@@B@@@@@DBB@B@DB@@@@BAB@BAB@BAB@@A@@@ABA@A@@@ABA@@@@BCB@BABA@@BA@@B@@@B@B@@@@@@@B@AA@@@@@@BA@@@AB@@@B@@@BA@@BAB@BA@@@@BA@@B@B@BA@@BAB@@A@@@ABA@ABA@@BABAB@BA@AB@@ABAB@B@B@B@B@BA@@B@@A@ABA@A@@@A@@AA@@@@@ABAB@BA@@B@@A@A@@BA@A@A@@BAB@@@B@@A@@@@@A@A@@@AB@@@BAB@@@@AB@@AB@BA@@@@B@@BB@BAB@@@B@B@@@@@BA@@BA@ABA@@A@@A@@@@@@BABAB@BAB@BA@@B@@@B@@@B@BA@@B@BAB@B@B@BAB@@A@AB@@@B@@AB@@A@A@A@@BA@@BA@@BAB@B@BA@@@@BA@@BAB@@@@@BAA@@A@@BA@@@ABA@@@@@@@@AA@@AA@@@@@AA@@@AA@@A@@AA@AAA@@@@AA@@@@A@@B@@ABABA@AB@@A@@@C@@B@@@B@@A@AB@@A@@@@BA@@BA@A@A@@@A@@@@AAA@@@A@A@@@ABA@A@@@A@A@AA@@@@@A@@@AA@@@@ACAA@@AA@@AA@CC@@AA@@A@@A@@BA@@@@AA@@@@@A@@@AAA@AA@@A@@@@A@A@@@@@AA@@AA@@@A@@A@@@@AAA@@AC@@@@AAA@@A@@A@A@@@@AA@@@@@@@B@BAB@BA@@BAB@B@BA@@@AB@@AB@@A@@@A@@@ABA@ABA@AB@@ADABA@@@A@@A@@AB@@ABABA@AB@@@B@@A@@BA@ABABA@AD@BABAB@B@@@@A@@B@@AB@@ABA@ABA@ADABC@@BA@@B@D@@A@@B@@@B@B@B@B@@A@@B@BBB@B@@BB@@B@@BD@@@BBB@B@@@@B@@A@@BA@@B@BA@@BA@@@@@AB@DAB@@@@@BA@@B@@@BAB@@AB@@AB@BABABAB@@ABAB@@@B@BAB@B@B@@@BA@@@AB@@A@@@A@A@@@A@@@@@@BAB@BAB@BA@@B@@@@A@@B@B@BAB@B@DA@@@@@@BB@@B@@BB@@B@@B@@@BBBBD@BBB@BBB@@@B@B@BB@@BB@@B@@@@@B@@BB@@B@@@@@BB@@BB@@@@BB@@@@B@@BB@@@@@B@@@@@BABB@@B@@@B@@@@@B@@@BB@@@@@B@@B@@@@@B@@@@@BB@@@@B@@B@@BB@@@@@BB@@@@@B@@@@BBBB@@BB@@BB@@@@B@@BB@@@B@@@B@@@BBB@@B@@@@@@B@@A@@@ABA@@@AB@@@BABA@@@@@A@@@A@@BA@@@A@@@@@A@@AA@@@@@A@@@@@A@@@@@A@A@A@@@@@A@@@A@@A@@A@@@A@@@A@@@A@@@@AA@@@A@@@A@A@@@@@A@@@A@@@A@@@A@A@@@@@A@@@@@A@@@@AA@@@@@CBA@@@@@AA@@AAA@@BC@A@@@A@A@A@ABA@A@@@ABA@@B@@A@@B@@A@AB@BAB@@@BA@@@@@@@A@@AA@AAA@@@ABA@@@ADAB@@ABA@A@@@A@AAAB@@AB@B@@ABA@@@AA@@A@AAA@A@@@A@@BA@CBA@A@@BAAA@@@A@@BA@A@@@A@@A@AA@@@A@ABA@@AA@@@A@A@@AA@@@A@AAA@@AA@AA@@AA@@A@@@@B@BAB@@@@AB@@@B@B@BA@@B@@A@AA@@@BA@@@@B@BADABAB@B@B@B@BA@@BADA@@D@B@BAB@BABA@@@@@@@@ECCCCAAACCAAAACAA@@A@CBA@A@CBA@@BA@AB@@AAA@C@@AABA@@BA@@@@ACACA@C@CACAAAAA@CA@@@AA@@@A@AAC@A@AA@AAA@@@ABA@AAC@A@CB@@A@@@A@A@@@AAA@AA@@@@@AA@@@@@@AA@AA@@@ABABA@CAAAACCAAAAAAAAAAA@AAEAAAAA@@AAC@ECCAA@ABA@A@@@A@AAAACAAA@@@A@@AAA@AAAAAAAAAAAAA@A@A@AA@@@@A@@@@@@A@AB@@A@@@A@A@@AA@@A@@@AAA@A@@@@AA@@@CAA@A@A@A@@@ABA@A@@@A@AA@AA@@AA@A@AA@BAB@@ABA@ABA@@@ABA@@@ABA@@B@@A@ABA@A@A@@@@@A@@AA@A@A@@@A@@@@BAAA@A@A@@@A@AA@@A@A@@@C@@@A@A@A@@@A@@BAAA@AAA@@@@@A@@BA@ABAB@@@B@@@@@B@B@@BB@@@@BDB@@B@@ABCBABAB@@ED@BAB@B@B@@@B@@A@A@EDA@@B@B@D@@A@ABABCB@@@D@@ABABAB@@@BBB@@@@@BA@CBCFCAEAEAAAA@@@ABABABABCB@BA@A@A@A@CAA@CAA@C@AAAAC@AAA@A@C@AAA@AAA@A@A@@@A@AAA@A@AA@@@@@@AB@@A@@@C@A@CBCAA@A@AA@A@B@BDD@BBBAH@H@F@D@BBB@@D@BBDB@@BB@@@BABADABABA@EBC@CBABABAB@B@B@@@BB@@BA@AD@B@BA@@@CBA@C@@@ABA@@B@BA@@BAB@@@BA@A@A@@@A@AB@@ABCB@B@B@@@BA@A@@BA@@B@B@BBB@@B@@B@@A@@BA@A@A@ABA@@@AAA@@AABA@ABA@@@A@C@C@A@@BA@@B@B@@@BA@@@A@@@A@A@A@AAA@A@@@@@ABAB@@ABC@A@A@C@@@@BA@@@A@A@CCA@A@A@A@A@@@@DAD@BAB@@@B@@@@@@@@AFAB@B@BA@@@ABA@@@@BAD@BADCD@@A@@B@@@@A@A@@@@AA@AAC@AA@@A@@@A@@@@@EAA@@@A@A@@@EAA@A@A@G@C@C@C@A@A@A@@AA@@@CAA@A@AAA@C@A@CA@@@@A@ABC@ABCB@@A@@BAA@@AA@@@@CAA@A@AACBA@@@ABA@ABAA@@AAA@@AAA@@@@AAA@@@@ABA@A@A@@A@A@A@A@@A@AAA@A@@@A@AAA@@@@AAAA@CA@A@AAAAAAAEAECA@AAAAA@ACAAA@A@AA@@A@A@ABA@@BA@@AC@@AA@@A@A@@@A@@A@A@A@@@ABA@ADA@@@@AA@A@AAA@AA@@AA@@@A@@@AA@AAAAA@@C@AAA@CCA@@@@@@A@A@@@A@@@A@ABA@ABABA@@@ABC@C@A@E@@BA@A@@@AAAACAA@AA@@@A@ABA@A@C@@AA@AA@AAAC@@@AA@@@A@@BAA@@AAAACECCCAC@@@A@A@@A@A@A@E@EBCAA@AACC@@@@A@@@AAC@C@A@AAA@CAAAAACAA@@AAAA@CAA@A@CA@@@@AAA@AA@AA@ACAA@A@@@A@ABA@@@AB@@A@@@A@@BAB@@@@@B@B@@@@@@A@@@@DC@A@A@AAA@@BA@A@@@@@@@@@@@@@A@@AA@@@A@@@@@@@A@@@@@A@@@@C@A@@@A@A@CBE@EBE@E@A@@@@@@A@@@@A@@@@@A@@@AAAAAA@AA@@@@AA@A@@AC@AA@@A@A@@@A@A@@B@@A@A@@@A@@@AA@@C@@@C@@@A@A@@@A@A@@@@@@@@@@@@@AB@@@BAB@@A@@BA@@@@@A@@@A@A@A@AAA@A@A@@@A@A@A@A@A@A@A@@@@@@@A@@@@B@@@@AB@@AB@@@@A@@BA@AACB@@A@A@C@AB@@C@A@@@@B@@A@A@A@@@A@@@A@A@@@A@@@@@A@A@A@A@@@@@A@@BCB@B@@ABAB@@@B@@AB@B@@@@@@A@@@A@CAA@E@AA@B@@@BA@@B@BCB@@@@AB@BAB@B@B@@@B@@BB@@@B@@@B@@@BA@@BABAB@B@B@D@B@D@@@B@@AB@BABA@@@ADAD@@@B@B@B@B@B@B@D@D@D@B@BBD@B@BBBF@F@HBD@@@@B@@@B@B@BB@@BBBHHDFBB@B@BB@BDBBBF@D@BBB@B@@A@GBCBC@A@A@CAAAGBE@@@A@@DAB@B@B@BAD@DAB@BB@@BBD@FADADBB@B@@@@@BA@AB@@@@@B@@DDBB@BBBDBDB@BBB@@BBB@B@@@@@@B@@@B@@@@ABA@G@A@A@ABA@@BA@@@@AA@CCE@CAC@@@@AA@CACAE@A@A@@AABCBA@EB@@A@@B@@BB@BBBAD@B@@@B@@A@ABA@C@ABA@A@I@C@CAC@C@A@AB@@C@@@EAC@AAC@ABA@CA@@@BBBBD@BAF@BAB@BA@@BABE@ABADCBA@AB@@AA@@CAA@A@A@A@CAA@A@@AA@@@@@A@AAA@A@ABA@@@A@A@@@@BA@A@@@AAA@@@@BAB@BA@AD@BC@A@AB@@@B@B@BCB@BA@@@A@AAA@A@A@@@A@A@@@C@@@A@CAA@ABG@EBA@@@A@A@ECA@@@@@A@@@A@@AA@A@@@@@@B@B@B@@C@@BA@@@A@C@@@ABA@GBCDABA@@BA@@BA@@@A@@ACACA@AA@A@AACAA@C@A@E@C@A@A@A@@@@@@B@@@BB@@@DDBB@B@@@B@B@@A@@@A@@@CD@@AB@@A@A@A@C@ABA@A@A@AB@@AA@@@@AA@@@AAAAA@@A@C@C@ABAB@BA@@@A@A@A@A@A@A@A@A@@@AAABA@AB@@A@A@A@A@ABA@A@EAAAC@AA@@@A@A@A@A@@AAA@C@AA@AA@@B@BA@@D@BADA@@@ABC@A@BB@@BB@B@@@@C@AB@@AB@BA@A@A@C@AAC@C@C@EBC@CBEBEBABCBC@A@I@CAC@ACCG@CACAC@C@AA@AAAACACCAAAAEACAACAA@EAA@AECEICCCAEAEAAAAAAAC@C@C@C@ICA@C@A@IAA@@@ABA@@B@@A@@B@@@B@@@@@B@@@@@@@B@@A@@B@@@@@BBB@B@B@B@@A@A@@@ABABADA@@@AD@@@BA@A@@BABAD@@@B@BB@@@@BA@A@@@@@@B@@GDA@AB@@@@@B@@@@@@AB@@@@@BA@B@@BAB@BAD@@AD@@BD@@@B@B@@@@@@@B@@@B@@@B@B@B@@ADA@A@A@@@A@ABA@@AA@ABA@A@A@@@AB@@@B@@BBBB@B@@BD@BB@@B@@@@AB@BABAB@B@B@D@BAB@B@@ABB@@BA@@@ABBB@@@@AB@@AB@@@@@B@@CB@@ABA@AB@@@B@@@@@B@@@@@BAB@B@B@BA@ADA@@BAB@@@BAB@B@@@@@B@@B@B@BBB@BB@BBB@BBBB@@B@BBBBBB@BBBBBBB@B@BBB@BB@@B@B@@@B@B@@@@@B@BA@@@@@@@AB@@@@@BBB@ABDD@@BB@B@B@@A@@BC@ABA@AAA@@@C@@@A@@@ABA@@@A@A@@@@B@@A@@@@B@@@@@@A@@@@@@@@@@@A@@@@@@@@@@D@BA@CA@B@@B@@B@@B@@@B@@@@B@DA@BB@BB@BBBBBBDBDBBB@BB@BBB@@@@@@B@@@BC@CBA@A@A@A@@@AB@BA@A@@@A@A@@@AAA@ABA@CBE@A@ABABA@A@@@AB@@@@@BAB@@@@@@AB@@@@@@@BB@@BBB@@@BA@@B@B@@A@@@ABA@@BAB@BABADAB@@@@@B@@@B@BA@CB@@@B@F@BBB@@A@@@@A@@A@@B@@AB@@A@A@ABA@@@ABA@@B@@@@@B@@@BA@@B@@@@E@@@A@AA@@A@@@@AAAAEAE@A@@A@@AA@AACAE@AA@@A@@@CAA@A@E@A@A@A@AAAF@@AD@BA@@@BBBD@B@@@B@D@@BF@DB@@@@BHD@@@@@BB@@BHF@BB@DFB@B@B@ABABCHAFA@@B@BBBA@@BA@@BBB@BAB@@AACBCA@@C@A@CCC@AB@@AA@@@@A@@@CBA@@@@AA@@@A@@@EB@@CDABCDABA@@@AAAC@@@@@@@@@A@AAA@A@@@A@@@@@@@CBA@@@@@A@@@@@A@@@@@@@@A@@@@@C@A@@@AAA@AAA@@AA@AA@@@@A@@@E@A@@@A@@@@@A@AC@@@@A@@@A@@@@@@@C@AAA@@AACBAB@BABABAB@BC@A@CB@@A@@A@@A@@B@@A@@@@@AA@AA@A@@CA@AAAAAA@AAA@@A@AAACCA@AAAC@AAAACACAACAAA@@AC@AA@@AA@@AB@@@@@B@B@@@BAB@@@B@@AB@@@@AB@@@@@BA@@@@AA@@ABA@A@@@AA@AAA@@A@C@@@ABEBABA@A@CBI@G@@@C@C@A@A@@@@A@AA@@C@@@@AAA@ACA@EAE@AAA@@@AAAAAAC@CAA@AB@@@BA@@BABCDAA@@A@@AA@@@@A@@@A@A@@@A@@@@@@A@@@@@AA@@@@A@ABC@A@A@@@A@@BA@@@A@AB@@A@A@@@@@AB@@@@@@AB@@A@A@AAA@@@A@@BA@A@@B@@A@@@A@@@A@@@@@A@@BA@@B@@A@@@AB@@A@@BA@AB@@@@@B@@A@@@AB@B@@@@@@AB@BA@@@AB@@@AC@A@@@AA@@C@C@@@A@C@A@@@AB@@A@A@AB@@@ABA@@BA@@BA@A@@@AAA@A@AEC@@A@@@A@@@AAA@@AA@@@@AA@@@A@@@AA@@A@A@AA@@A@@@AA@@@@@@A@@@@@A@@@@@BDBD@BC@@B@B@@@@@BAA@@@@A@@@AB@@A@C@@BB@@@@B@@ABCDEBA@A@A@@BA@@@A@AAC@@@A@@BA@A@ABC@@@A@C@@@A@@@@@@BA@A@CAA@@A@@@@AA@@CA@ABADA@A@@AA@@GAEDCDCBCBIBI@M@GBEBCDABCBEJ@F@FBFFFHHDJBH@D@JBDBDDDFDLFJBDDBD@BAFCDCBIDGBIBABEFADAB@FBJ@H@F@FBFADELEFCBCBEDCDABAB@BAFBDDD@@DBFBF@NBFBDBFDDBF@DDBBDBDDBBBBBD@BADADCDCBE@CBC@AD@B@BDJBD@HBDBD@H@F@DCDEFOFI@EAEAE@OAGAIAEAA@G@EBEBEDAD@BBB@@BBBDDBLFDDB@FDBDBFAB@DAFGJEDEDEHABGDAD@F@D@B@B@B@BDDBD@B@BAD@D@D@D@BDDD@BBF@D@D@D@DBD@BBBDDD@H@BABABEDCBKDIDCBGBEDCDCFAHAF@DD@DBFDBB@@BBDBB@BAB@B@D@@@B@B@@@@A@@@@H@@@B@B@BB@@B@@@BB@@B@@@B@CBAB@DAB@DBB@@BB@BBDD@BBDDFHFFB@B@B@@@@@@AAE@A@AB@@AB@@@B@@@@@B@@@B@@@@@B@@@BAB@@@@AB@@@BA@@B@@@B@B@FBB@B@@@B@B@D@@@B@B@B@LAD@@@FADA@@B@@@FB@@FAF@B@FAB@B@@@@@@@BAFGB@B@BBDBDBB@B@@B@@B@BBB@@@BA@@BA@A@@B@@@@@@B@BB@FBB@EBBBBBD@@B@B@BHADA@@@@B@DBBABABA@AB@@BD@@@@@@B@BBB@@A@@B@@@B@@BB@B@BAB@B@@BBB@B@@B@@BAAA@AB@@@D@D@B@B@@B@BB@D@B@@B@D@DABEBA@A@BBDDDB@BD@DAB@B@@@AA@@B@B@B@@@B@B@B@B@@@BBD@@@D@@@B@DBB@B@@@@@@D@B@@@@BAB@BBD@DA@@BAB@B@B@AB@@D@B@B@@@@AB@@A@@BA@ABB@@B@@@@A@@@@B@B@@@B@@@@B@@@@B@B@@@B@B@BABAAC@@FA@@@AD@@@@BB@D@B@@A@A@@DADAB@B@@@@@B@@BBBA@CFA@ABA@EDA@@@A@@BAF@@AB@DA@@B@BA@TBF@B@BAB@@@B@B@B@@BB@@@@A@@@@BA@@@@@@@@@A@@@@BA@@@@@@@AB@@@@A@B@@BB@@@@B@@ABAD@@@@AA@@@AA@@@@@@A@AAA@@@BA@AA@@A@A@CDADBBABBB@B@@E@@B@H@D@BA@@@AGA@A@A@@@A@ABABAACB@@CB@BAB@B@FBB@B@B@B@B@@A@@BA@@BB@@BBB@B@BA@@BAD@B@D@B@@@B@@A@@B@@DBBB@D@CE@@@@B@DBF@@@B@@@@@AA@AB@@@BB@B@@@BD@B@@@B@@@AC@C@AB@@@B@@@@@BB@@BA@@@@D@B@B@B@@A@C@A@B@@@DBB@@@C@A@@F@@@@@@B@B@BB@BB@EDIJABADA@@B@AB@@EFEFBA@@BAB@DC@@B@B@BABA@@BB@@@BB@@@BB@@@@@@A@AB@@ABA@AAAAA@@BBBB@B@ABB@@B@@B@B@B@@A@@@@B@B@@@B@BAB@@AAAA@AAA@A@@AB@FA@CBABDBD@B@@B@@@@ABD@@@@A@BD@@D@NABADC@@AC@@AAD@@A@@A@AABACACCAA@A@@BA@@@A@@@@@@B@B@@@@@@@B@@@@@@@@@B@B@@@@A@@B@@A@BB@@BDBDD@@DA@@@@B@B@@ABB@@@B@@B@BAD@B@BAD@@AH@B@@@B@B@B@B@@B@FBA@DDA@DB@@ADA@@B@@@@BBB@@BBB@DA@@@@@@D@BBB@F@BAB@B@B@D@@@@@B@@@@@@@B@@@@@BB@@@@B@@@@@BA@@BAB@FD@EB@B@@@B@@@B@ABB@@@@BA@@B@@D@B@@@@@BBB@FBDBFDBB@A@@B@@@D@@@DB@@D@@@D@B@@@DB@@FBB@DBDBH@B@D@D@BAFBD@@@F@B@BBB@B@B@D@D@@AB@BAB@B@@A@E@@@A@CBEBABA@ADAD@FADCBADABCBABAD@B@B@DB@BN@RBFBBH@DAD@BB@FAF@BBDDDHDBHBH@NCNCJCBCD@F@DBFDDDH@L@\\@PAJAH@BBDDBBDHNZDHDBHBNDJFJ@HBHBTF@@@F"]],encodeOffsets:[[[129758,45602]],[[129759,45605]],[[129739,45610]],[[130100,45637]]]}},{type:"Feature",id:"220284",properties:{name:"磐石市",cp:[126.060427,42.946285],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@A@@@A@@@@BA@@@@@A@@AA@@@A@AAA@C@AB@@@AA@@A@@AAC@@@AAAA@@A@C@A@A@@@ABA@AD@@A@@B@BEA@@A@@@A@@@A@@@C@@@EAAB@@@@AA@@AAA@ABA@AAC@AA@AC@A@AA@AA@C@E@C@@@A@A@A@@A@@@@CAA@EB@@AA@@A@C@@@C@@@AA@@AA@@ABABCDAB@@A@A@E@A@C@A@C@@BA@@@A@@BCBCB@@A@@@@BA@@@C@A@AB@@@BA@@B@@ABA@@BA@@@@@@B@BABBBA@@BEB@@A@@@AB@@@@A@ABA@A@AB@@A@AA@@C@@@A@@@@BA@B@@B@@@@AB@@@@@B@@@@@BA@AB@B@B@@@BC@@B@B@@@@CB@@B@@B@@B@@B@@@B@@B@@B@B@@BB@@@@@B@@@BAB@@B@DBBB@B@B@@@@@BA@@@B@@B@@@@AB@@BB@@@B@@@B@BB@@B@B@BB@A@@B@@B@B@BBBBB@B@@@BB@@B@B@@@BB@@@@@@@@BB@B@@CB@@@BAB@@@@@@AB@BB@@@AD@@@@@@@BAB@@AB@@@@@B@@@@A@CD@B@@BB@@@@@@@BAB@B@@BBBBB@DDBD@@@BABA@ABC@@@AB@@@B@@@@AB@B@@ABAB@@BB@B@@A@@B@BAB@@@B@B@@@B@BA@@B@@DB@D@@@A@@@@B@@@@@@B@@B@@@@B@@@@AB@@@B@B@BBBBBBB@BBBB@@BB@@B@D@D@BAD@B@B@DABADCFABEDEBEBCBE@EAG@CACACC@@ACEGCAA@CAC@A@C@C@CBC@ABQJCBCBA@AB@@A@@BC@K@A@ABC@CAC@CACACAACACCE@@@A@A@@AAAAAACACAGAGAC@C@CBA@C@C@EAEAAAAAAA@CAAAAACAACAAAA@A@C@A@A@A@AAA@C@ABA@CAA@A@@AAA@A@AB@@A@@@AA@AAA@CAAAC@A@@@A@A@C@ABA@@AA@@@A@A@@@@BA@@B@@@@A@@@A@@@@@A@@@A@@@@BA@@@@@@B@@A@@@@BA@@@@@@@@B@@@@B@@@@@BB@@@@@@@BA@@@@@A@@@@B@@@@@BA@@@@@@@@@@B@@@B@@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@@@@@A@@@@@@B@@AB@@@@A@A@@@A@@@A@@BC@@@@@A@@@@@A@A@@@A@@@@@A@@@@@@@AB@@@B@@A@@@C@@@@@@@@@@@@AA@@@@@@@@AA@@@@@@A@@A@@@@@@@A@@@A@A@A@@@A@A@A@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@AA@@@@@@@@@@@@@A@@A@@@@@@@@@@@@A@@@@@A@@@@A@@@@@@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@@@@@@BB@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@B@@@@@@@@A@@A@@@@@@A@@@@@@B@@@@@@A@@@@B@@@@@@A@@@@@@@B@@B@@@@@@@@@@@@@@A@@@@@@B@@@@A@@@@@@@A@@@@@@@A@@@@@@A@@A@@@@@@@@@A@@@@B@@@@@@@@A@@@@@A@@@@B@@@@@@A@@@@@@@@@@@@@AA@@@@@@@A@@A@@@@@A@@@@@AB@@@@A@@@@B@@@@@@@@@B@@@@A@@@@@@@@A@@@@@@@@AB@@@@@@@@@B@@@@A@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@A@@@@A@@@@@@@@A@@@A@@@A@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@BA@@A@@@@@@@@@@@@B@@@@@@@@A@@@@@@A@@@@@A@@@@A@@@@A@@@@@B@@@@A@@@B@@B@@@@A@@@@@@@@@@@AA@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@A@@@@@C@ABC@A@C@A@@@C@@AA@A@@@ABA@@A@@@@@@BAA@@A@@@@ABA@@@A@A@@@@B@@@@A@A@A@A@A@AAAAAA@@ABA@A@@@A@@@A@AA@B@@@@@@@@@BAA@@@@@B@B@@A@@@@A@@@@ABA@A@@B@B@B@@A@@@A@@@A@@B@@A@@@@@AA@A@@@@AB@@AA@@A@@@@@@@AAA@@@@@@@@@@B@@@@@B@@A@AA@ACB@A@@@A@@@@C@@@A@@BA@A@@BA@@@B@@@AB@@@AAB@@@AA@@@@@A@@@A@@@@B@@AA@@@B@@@@A@@A@@AB@A@@@@@A@@@@@A@@A@@@@@@BA@A@AA@@A@@A@@AB@@AC@@@A@@ABAB@@A@A@A@@@@@@BB@@@@B@@A@AB@@E@@@CA@@@BA@@@A@AB@@@@@@A@@@@@A@@@A@@@@@@@A@AA@@A@@@CBC@A@@BE@@@BB@@@@@BAAA@AA@@A@A@@@@AA@@@ABAB@@@@A@@@A@@B@@GB@@AB@@A@@@@@BB@@@@A@@B@@@@@BA@@B@@A@BA@@A@@@A@@A@@A@@@@BA@@BA@@@@A@@A@@@@@E@A@@@A@@@@BB@@@@@AB@B@BA@@@@@@@@@@B@@@@A@@@A@@@@@BB@@AB@@A@@@@@@@@@@BA@@BA@@@B@@B@@@@AB@@A@@@ABA@AB@@@BB@@@@@@B@@A@@@@B@@A@AB@@@@@BA@@@@B@@@@@B@@A@@@@@@@A@A@@@@AA@@@A@@@@@A@@@@@A@@@B@@@AA@@A@AB@AA@@@ABA@A@@A@@A@@A@@C@@@A@@B@@@B@@@@AB@@@@@AA@@@@@@A@@C@@@@B@@@@A@A@@@@@AB@B@@A@@@@A@@@@AB@@@@@A@@@@A@@@@@BBA@@@@@A@A@A@@B@@@BA@@@@A@BA@@@A@AA@@@B@@@@@@AB@@@@@A@@A@A@@@@@@B@@A@@@@@@BA@A@A@@@@@BB@@A@A@@@@@@@@BA@A@@@@@@B@@@@@BB@@@@BA@@@AAA@@@@BA@@@@B@@@@AA@BAB@A@@A@@@@BA@@@ABA@@@@@B@@AA@@AA@@@@B@@@@@@A@@B@@B@@B@@@B@A@@@@A@@B@@A@@@@A@@@@@@A@A@@@B@@@@B@@A@@@B@A@A@@@AA@@@@@B@@@@@BB@@B@@@@A@A@@B@@@B@@@@AB@@@@@B@@A@B@AB@B@@@@A@@B@@A@@B@@A@@@A@@@A@@@@@BB@@@BB@@@@@AB@@@@A@@@AB@@@B@@A@@B@@@@A@A@@@@B@@@B@@A@@BA@@@@@@@@@A@@@@@B@@B@@@@A@@@@@A@@@@@@B@@A@@@@@@@@@BB@@@@ABABAB@@@B@@@@@@@B@@A@@B@@@@@@AD@@A@@BA@A@@@A@BBA@BB@@BB@@@B@@A@@@@@@B@@@B@@@B@@B@@@@@@@@B@@AB@@@B@@@@@@@@B@@BA@@@@B@B@@@@@B@@@@@BAB@@@@OA@B@@@B@DABCB@@@@D@@@@BBF@BA@@@@BB@@@@B@BBDA@ADA@A@BBB@BB@D@FABBB@@@BCB@B@@B@HA@@B@@@A@CD@@@@B@BA@@GFABCBABCBCBCBA@CBEAA@A@CBABC@EBA@GDA@KDCBABEBABA@I@I@E@E@EBB@B@@@DDB@BB@@DB@B@@@BA@@B@@@BB@BDDD@BD@@BFDBBB@BB@B@@FBBBBBFB@@A@@BA@C@@@@@C@A@A@EDID@@@B@@DD@@@@@AB@H@B@B@F@B@D@@BB@AB@B@@B@@@@BA@@@@B@@@@A@@BA@A@@@A@A@AAE@C@A@CA@@CBCBBBAB@BA@A@A@@@A@@@AB@BE@A@@BE@AB@@AB@BB@@DABAD@@AD@@@BAB@F@@B@@@@B@@@BAB@BA@@@ABA@AD@@@B@@AB@@@B@@BB@BA@@@@BABC@@BAB@@@B@@@@CBA@CBA@AAA@AB@B@@AB@B@@@@A@C@@@@@A@@@CBABB@BBB@B@B@@B@@@@A@@@A@@@@B@@A@@@@@@@@@A@@A@@A@@@@@@@@B@@ABA@@BA@A@ABC@ABA@CBA@ABA@ABA@@@ABC@@@A@@@C@A@@@ABA@A@ABA@@BA@@@AB@@A@@BA@@@A@A@@@A@@@A@@@AAA@@@A@AAA@A@AAA@CAA@AA@@A@@@@@@@@@A@@@A@AA@@A@@@@@A@A@@@@@A@@@A@@AA@A@@AA@@@A@A@A@A@@@A@@@@AA@A@@A@@@A@AAACAA@ABCDCBA@CBA@@FBBAD@B@F@BAB@@ABABC@ABEBA@ABC@A@CB@@AB@BA@CFA@A@A@ABA@@@A@A@ABA@A@A@A@ABA@CBA@ABA@ABABA@CB@@A@A@ABA@C@A@ABA@AB@BA@ABAB@BAB@BABABAB@BCBCB@@ABABC@@@@BB@@@@B@@@@BD@@@B@@@B@B@B@B@BBB@B@B@@@B@BAB@@@B@@@@@@@@ABABABA@B@@@B@D@BAB@@@BAB@@ABAB@@A@@@A@@B@@AB@@A@@@A@@B@@@BA@@BA@@B@@A@@BAAAB@@A@@B@@@DA@@BABAB@B@D@B@@@B@BAD@@@BBB@BBB@B@BAB@B@B@B@BA@AB@BABA@@B@B@@@B@B@BBB@B@BBB@BBD@B@@@BBB@@@@@B@@@@BB@B@@@BBB@@@B@BB@@@@B@@@B@BB@@B@@@B@@@B@@@B@B@@BB@@@B@@@@@B@@@BB@@@B@@@D@@BB@@BBB@BB@B@@@B@@@BBBA@@@@@A@@@@@A@@@@@ABA@ABAB@B@B@@A@@BAB@@ABA@@BA@@@@B@@@B@@@B@@B@@BB@@@@B@@BB@@BBB@@@B@@@@@DAB@@@BBB@@@@@BA@@@@@AA@B@@@@@B@BAB@@@@@@A@@@AB@@@@A@@B@@A@@@@@@@AB@B@@@B@B@@@B@@@B@@@B@@@B@@BB@@@B@@B@@B@@@B@B@@@BB@@B@B@@@B@BB@@B@@@@BB@@@B@@BB@B@@@B@@@B@B@BBB@B@B@BB@@B@@BB@BBB@B@BBB@BBB@B@@B@@B@@@B@@BB@@@B@@BB@@BB@@@AB@@ABA@@BA@@@A@A@A@AB@@A@A@A@A@A@A@A@@@@@@B@B@B@B@B@B@@@B@@@@@@A@A@@BA@AD@@A@@@A@@@A@@B@@@BA@@B@@AB@@@B@@@@@B@@A@@BABA@@BA@@BA@@B@@@B@@@@@B@B@@A@@B@@@@@B@@AB@@A@@@A@AB@@C@C@A@A@A@@AAA@@A@ABA@A@@@A@@@A@@@@@@A@@A@@AA@@AA@A@C@@@@@A@AB@@@@A@CAA@A@AA@@AA@@A@AAA@A@A@@@A@ABA@@@A@@@AAA@A@A@A@ABA@A@@@@B@B@@AB@BABA@ABAB@@A@@B@@@B@@@B@@@B@B@@@B@B@@AB@@A@@B@@AB@@@B@@@B@@A@A@@@@BA@@B@@@@@B@@BB@@A@@B@@@B@@AB@@AB@@AA@@@@@@A@@@@AA@@@A@@@@@@AA@A@@A@@A@@B@@@B@@@BA@@B@@@@AB@@@@@BB@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@AB@@@B@@@@@BA@@@@BAB@@@@AB@@A@@B@@@BA@@@@DABCD@@A@@B@@@B@@@B@B@@@@@@@BB@@B@@@B@@BB@@@@@BB@@@@@@B@@@BA@ABA@@@@@AB@B@@@@@@AB@@@@@B@@@B@@@@@BA@@@A@@AA@@@@@@@A@@@@BA@@BA@@B@@AB@@@@@B@@@B@@@BA@@BA@@@A@@@@B@@@@@@@@AB@@@@@B@B@@@B@@@@@@AB@@@@A@A@@@A@@@A@@@A@A@@@@@A@@@@@A@@@@@@@A@@@A@A@@@AB@@@@@B@@AB@@@B@@@B@@AB@@A@@B@@@@AB@@@B@B@@@BA@@@@@AB@@A@@@@@@BA@@B@BAB@@@B@@@B@@@@@B@@@BBB@@@B@@@B@@B@@BB@B@DBBBB@@@@@D@@@DBB@B@@B@@@@@@@B@B@BA@@B@@@BB@@@@BB@BBBB@BB@BB@@B@@BB@@@B@@@B@@B@@B@@BA@@BB@@@@BB@BBBBBBB@BBB@B@BBB@BB@@BB@@BDB@BBBB@@DBBBBBDB@BDBBBBB@@@@B@@@@B@@B@@BB@@BB@FBD@BBDBB@@@B@B@B@DBB@D@D@@@@B@@@B@@@B@@@@BB@@@B@@@B@@@B@@@@@B@@B@@@@@B@B@@@F@BB@@@@@@BA@@B@@@@@B@B@D@D@BBB@@@BBD@B@BBB@@BDB@@B@B@B@D@B@BBD@DBDBBBB@@BF@DBB@B@@@BBB@FBBBB@D@BBBBBBB@DBD@B@BB@BFD@@BDBBBDBBDBBBDBFDBBBB@@@BB@DBFBDBHDDBD@B@B@@A@A@@BADA@@@@BA@ABADCDAD@@@BAB@@CBABABAB@DAD@F@BA@@BCBADA@@B@D@B@B@DBB@@@@@B@DABBDBB@BBBBB@B@B@D@D@H@B@HBD@D@HBB@BBF@B@DBBBBBFDDBB@BBH@BBH@D@BBB@B@@B@B@BABAD@B@@B@BBF@D@B@BBB@BBB@@@B@BABAB@@@B@@@BBDBD@JDDBF@B@D@B@BB@@BB@BBBB@@@B@DBB@@D@BB@@@@@BBBB@B@B@BA@@B@@ABB@@BD@BDDBDBD@@BDAFCFABABABA@@@A@@@@@ABBBB@BB@@@BBB@@@BBB@D@DBD@BB@@BBD@B@B@DBD@FDB@@@B@@@B@@@@@@B@BBB@B@@B@@@D@@AB@@@@@@BB@@@@@BBBB@@B@@@B@@BB@@BB@B@@BA@@B@B@B@BDD@BB@@BA@CDCDA@@B@B@@@B@B@BABA@CBABC@@BA@ABCAE@@@A@ABA@@B@@@BBB@@BB@@@B@@A@@BA@@BAB@B@B@@A@@BAB@@B@@@@B@B@B@B@BAB@@A@A@ABAB@@@B@B@BBB@@@B@@CBABABCB@@A@AB@@@B@BA@AB@@@@@@AB@@AD@BA@@@@B@@@B@@@@A@A@@@A@@@@@@@A@@@@@@B@B@B@B@@@B@@@B@@@B@@BB@@@@B@@@B@@@@@B@@@B@@@@@@@@@@AB@@@@@@@@@@@@BB@@@@@@@@@B@@@@@@@B@@B@@BB@@B@BBB@BB@@@@@B@@@B@@@B@@BBB@B@BBB@B@BBB@@@B@B@B@@@BBB@B@B@B@B@B@@@@B@@B@B@BBB@@@BB@@@B@@@B@@BBBB@@@BBBB@BB@BB@@B@B@@@B@B@@BBD@B@DBBB@@@@@@B@@@@@D@@@B@BAB@B@B@B@B@@B@B@D@@B@@@D@F@B@B@@@B@@@B@@@B@@A@@@@@A@@B@@A@AA@@A@@@@@AA@@A@@@@B@BABAB@@@BA@@B@@A@@@AB@BBB@B@@BB@@@@@BBB@DBBBB@B@BBB@BBB@@@@AB@BABCB@BA@A@AB@@@BAB@B@B@BBBBBB@B@@@@B@@@D@DAD@@AB@@B@@@B@@@BB@@D@@@B@@BBB@@BB@@B@@BB@B@@BB@@@BB@BABA@@B@@@B@B@B@B@BAB@B@B@B@BBB@@@@@@BBBBBB@BBB@B@@@B@BAB@B@B@B@B@B@B@B@BAB@BBBBB@@@BAB@@A@@B@B@B@B@@AB@@@B@@@@@BBB@@@@@@A@ABA@A@@@A@@A@@@AAB@B@@A@@@@@@@AA@@A@AAA@@@AAA@AA@AA@AA@@@@@@A@@BABA@@@@AA@@AAAA@A@@AA@@@A@A@A@@@A@AB@@AB@D@B@BA@@BA@C@A@AB@@A@@@A@A@A@CA@@AAA@AA@@AB@@AB@B@BABAB@@@@A@@@AB@@@D@@AB@BAB@D@B@BAB@BBB@@@BA@@@A@@@@BA@A@@@AA@@@@@B@B@@@B@BABA@@B@@@B@B@BBB@@@BAB@BAB@@@B@@A@AB@@AB@@@B@@@BBB@B@@@@B@@@@BBB@AB@@@BA@@@@BB@@B@B@B@BB@@BB@@BB@BBD@B@B@D@B@BB@@BB@@@B@@@B@B@@B@B@B@@@@@@@ADADCDABB@BA@@BAB@B@@@B@@@BA@@@@BB@@B@BA@A@@BA@@B@B@@@BAB@@AB@B@B@@@B@B@B@BBB@B@BB@A@@B@HBB@F@D@D@BBD@@@@A@@@@@@@@@@@@@@@@@@B@@@B@@AA@@@@A@@A@@@@A@@A@A@@@@@@@BA@@BBB@B@BBB@B@@@@@B@@@@@B@B@@BB@@@B@@@@AB@B@@@B@B@@@BA@@B@@@@@B@@A@@B@B@B@BA@@A@@@AA@@@A@@@A@AA@@A@@AAC@A@A@A@A@@@@A@@@AB@@A@@BAB@B@BAB@@AB@@A@@@AB@B@BBB@B@B@BB@AB@@@BA@@D@@@B@B@@@@@@@BA@@@@@@B@B@@@B@@@@A@@@A@@@@@@@AB@@@BA@@BA@@@@@A@AA@@@@A@A@@@A@@BA@@B@@@@@B@BA@A@@B@@AB@B@B@BADAB@@AB@@@@@B@@@B@@AB@BAB@B@B@@A@BB@B@B@B@DAB@D@B@D@D@B@B@BAD@BAD@@AD@BABABAB@B@@AB@@ABAB@@AB@@A@A@@A@@A@@@A@A@@@A@A@@AA@A@A@@@AAAA@AA@AAA@@@AA@@@@@AAAAA@@A@AAAA@A@AAA@@@@@A@A@A@A@A@A@AA@@@A@@@A@@@A@A@@BA@@@@@A@@EE@A@@A@AA@@@@BA@AB@B@B@B@BA@@B@B@B@@A@@@@@@@@B@@B@@B@@@@@B@@@@@@@@AB@@A@@@@@@@A@@@A@@@@@@BA@@@@B@@@@@@AB@@@B@@@@@@AB@@@B@B@B@B@B@@AB@B@BA@@@@@A@@@@@A@@AA@@@A@@@A@@@A@@AA@@BA@ABA@A@@@ABA@@@A@@@A@@BA@A@@@A@A@@BA@@B@BA@@B@@@B@B@@@B@B@@AB@@@B@B@@@@@B@@AB@@@BBB@B@D@D@@@B@B@BBB@BAB@@@@@@A@@@A@@@AB@@@BAB@B@B@B@B@B@BA@BB@B@@AB@BA@A@@BA@AB@@A@@@A@A@AA@AAC@AA@@AAAA@@AA@@AA@@AA@@@A@ABA@@@@@C@@AC@@@A@A@A@A@@BA@A@@@A@@A@@@AA@@@A@@@A@AA@@@A@AAC@@@AAA@AA@@A@AAA@@A@@AA@@A@@@@AA@@AA@@@@A@@BA@@@A@@A@@A@@@@@A@@A@A@A@A@AAC@A@A@A@A@A@ABAB@@ABA@A@@@A@A@@@A@@@AB@@A@@@@@A@@@@AA@A@@@@@AAA@@BA@A@A@AAA@A@AB@@A@@@A@@AAA@@AA@@A@ABA@@@ABAA@@@@@AA@@@AAA@@@A@A@C@@BA@AAA@@AA@@@@A@@@AA@B@@@@A@@@A@A@A@@AAA@@AA@@A@A@@AA@A@@BA@@B@@@@ABA@A@@BA@ABA@ABA@@B@@A@@@A@AB@@AB@@AB@BAB@B@BA@@BA@@@ABABAB@@A@@@A@@AA@@@AA@@@@A@@@A@@@@BAB@B@BAD@DAB@DAB@@AB@@AB@@B@BB@@B@@B@B@@@B@B@@@BBB@B@B@D@@BB@B@BBB@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@AB@@ABA@A@AB@@A@AB@@AB@@@@@@A@@@C@@AA@A@A@ABC@@BABA@AB@BBB@B@@AB@@@BABA@@AA@A@A@A@ABA@@BA@@@A@A@@@A@A@@@A@@@A@@BA@C@@@@@@@@BA@A@@@A@A@A@@@ABA@@@A@C@AAAB@@@BAB@B@BAB@DAD@DAB@BA@@B@B@@AB@B@B@@@@ABA@AB@B@B@BABABABAD@@@BABB@@B@@@B@BBB@@@B@B@@@B@BBB@B@B@@@@B@@B@BB@@D@@BB@B@@B@@DBBBB@BB@@BB@@B@B@B@B@D@BBB@B@B@@A@@@AB@B@@@@A@@@@@@BAA@B@@A@@@AB@BA@@BAB@@@B@@A@@@AB@@A@@@A@@@A@AB@@AB@BA@AB@@AB@BA@@@AB@@A@@@A@@@@A@AA@@@@@A@AB@@@B@@AB@B@@AB@@AA@@AA@@@@ABA@@B@B@@@B@@BB@@@B@B@@@@B@@B@BB@@BB@@DBD@B@B@D@B@B@@A@A@AB@B@@@BA@@BA@AB@B@B@B@DBB@B@B@B@B@BA@ABA@@B@@@@@@@@A@@@A@@@@@A@@B@@ABABCBABABA@AB@BAB@@@B@B@BAB@BA@@@AB@BAB@B@DAD@BAB@@A@@BC@@@@BAB@@ABA@A@@BADABA@A@@B@@@B@DAB@@ABABC@@BA@A@@B@@AB@@@@A@@@A@@@A@A@@@A@ABABABAB@@ABAB@@A@@BAB@@AB@@@B@B@@@@@B@@@BA@@@@@AB@@@@AB@@A@@BA@AB@@AB@B@BB@@B@B@@AB@@A@@@@@AB@@A@@@@@@@A@@B@@@@@@A@@@@@AB@@AB@@@B@@AB@@@BB@@B@B@@B@@B@@BB@@@B@B@B@B@@@B@@@B@@@B@@BB@@@B@B@BA@@B@@A@@@@@A@@BAB@BAB@@@B@@@@@B@B@@@@@BA@@BAB@BABAB@@ABA@@@@@@@A@@A@@@@@@@A@@A@@AA@@@AAA@@@A@@@AA@@@@A@@@A@A@@A@@AA@@@@A@A@A@@@A@@@ABA@ABA@@@A@@@A@@B@B@@AB@B@@A@@B@B@@@@@B@@A@@B@@AB@@ABA@A@A@A@CB@@AA@AA@@@@@AA@@AB@@A@@BA@@@@@A@@@ABA@A@A@@@@BABABABA@@BA@@B@@AB@B@@@@A@@@@@A@@BA@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@A@@@@@@A@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@BBB@B@B@B@B@B@B@D@D@B@@BB@@@@B@@@@B@D@B@D@@@@A@A@AAA@A@A@@@@@@B@B@@@B@@@@A@C@CBABABAB@@@@@AA@@AAAA@@AA@A@A@A@@@ABA@@@AA@@AA@A@@AA@AAAA@@AA@A@A@A@AA@A@@AAAA@@@@@@@@@@@@A@@@@A@@@@@@@B@@A@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@@@@@A@@@@A@@AAAAA@ABA@@@A@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@B@@@B@@@@AB@@@B@@@B@@@@@@@@@@@@B@@B@@@@@@@@@@@@B@@@@B@@@@@@@BB@@@A@@@@@A@A@AA@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@A@@@@@@@@BAB@B@BAB@BABAB@DAD@FADAD@B@D@DAB@BA@@@A@@A@@@@@A@@A@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@B@@A@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@BABC@A@A@A@A@@@@@@@A@@@@@@B@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@A@@A@@@@@@@AA@@@@@@@@@@@@@A@@A@@@@A@@@A@@A@@A@@@@@@@@@@@@@A@@@@@@@@@@@@A@@A@@AAEAECECAACAA@AAA@@A@A@AA@@A@@@A@A@C@A@@@A@@@E@CBAD@@@@@B@@@B@@@@@@@B@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@AA@@@@A@@@@@@@@@@B@@A@@@@B@@@@@@A@@@@@@B@B@BB@@@B@@B@B@BBB@B@@@DBB@B@DBB@@BD@AA@@@A@@@@@A@A@A@@BABAB@@ABABA@AB@@A@@@AB@@@@@@A@@@@@@@@@@@A@A@@@@@@A@@AA@A@@AAAAA@@AA@AA@AA@@AAA@@A@@AA@AA@@A@A@ABA@A@AAA@@@A@@BA@@@@@AAABA@AB@@AB@@A@A@A@@BA@@BAB@B@B@@@B@@@BA@A@@@A@@@@@A@@@A@A@@@A@A@@BA@@@@@@B@@@@@@@B@B@B@@@@@@@BA@@@@@@@@@A@@@@@@B@@@@AB@@@B@@@@@B@@@@@B@B@@BB@B@@@BBB@B@B@BBB@B@B@BBB@B@@@B@B@@BB@@@B@BB@@B@BBB@@BB@BB@@@B@@@@B@@@B@B@@@B@@BB@B@@@B@B@BA@@B@@A@@B@@@@A@@@@@A@@@@@AB@@@@@@@BA@@@@B@@AB@B@@@B@@@B@@@B@@B@@@A@@@@A@@@@A@@@@@@@A@@@@@A@@@@@AB@@@@@@AB@BAB@@A@@@@BA@@A@@A@@@@@AA@AA@@@A@@@@BA@@@A@@B@@@@@@@B@@@BA@@B@@AB@@@@@@@A@@A@@@@A@@@@@AA@@A@@@@@@@@@@@@@@AB@@@BA@@@@BA@@@@@AB@@A@@BA@@@A@@B@@@BA@@B@@@@AB@B@@ABA@AB@@A@@BA@@@@@A@@A@@@AA@@A@@A@@A@@@@@A@@@AA@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@A@AAA@A@AA@@A@@BA@A@@@A@@@@@A@@A@@A@@A@@@@@A@@@A@@@AA@@AA@@AA@@@AA@@A@@A@@@@AA@@@@A@@AA@@@AA@@@@A@@@A@@@A@@@ABA@@@A@@@A@@@@@A@@@AA@@@@A@@@@@A@@@@@@@A@A@@A@@A@@@@@@@@A@@AA@@@@AAA@A@@A@@@@@@BA@@@AB@@@@A@@@A@@A@@A@@@@@@@A@@@@@AB@@@BA@@BA@@B@@A@@B@@@B@@A@@@@@@A@@@AA@@@@@@AA@@@@@A@@@A@@AA@ABA@@B@@@@@@@@A@@@@@A@@@@@A@@@@B@@A@@B@BAB@@@@@@@BA@A@ABA@A@@@ABA@@@@AA@@@@@A@@@AA@@A@@@@@AA@@AA@@@A@@AA@A@@@@AA@@@@A@@@A@@AA@A@@@AAA@@@A@@AA@@@A@@A@AAAA@@AAA@@AA@@AA@@@@AA@@@@@A@A@@@A@@AA@@@@@@AA@@A@AA@@A@@@@AA@@A@@@@AA@A@A@@A@@A@@@@A@@@@@AA@@@@@A@@@@@A@@@A@@@@@@@@@AB@@@@A@@@@@A@@@CAA@@@AA@@@@@AA@@@@@@AC@AAA@@@@@AA@@AA@AA@@@AA@@A@@@@@A@@@A@@@A@@@@@A@AA@@A@@@@A@@@AA@@@@A@@@@@A@@@@@A@@@AA@@@@@@@A@@AA@@@AAA@@@A@@AA@@B@@A@A@@@A@A@@@A@@@A@@@@@A@@@A@@@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@A@@A@@@@@@@@AA@A@A@AAC@AAAAA@@A@@A@@@@A@@@A@@@A@@@A@@@@@@AA@@@@@@A@@@@@A@A@@@@@A@@@@A@@@@@A@A@@@A@@A@@@@@@A@@@@BA@@@@@A@@@A@@@AACA@@AAA@ABA@@A@@@@@AA@@@@@@A@A@@@@@@@A@@@A@@@A@@@A@@@A@@@A@@AA@@@@@@@@@A@@@@@@@A@@@A@@@A@@@A@@@A@A@A@@@C@@A@@A@A@A@@@A@@@@@@@AB@@A@@@A@@BA@C@@BA@@@@@A@@BA@@@ADADAB@BA@@@@B@@@@AA@@A@@@A@@@@@@@@@@@CB@@@@AB@@A@@@@@A@A@@BA@A@A@@@A@A@@@@@A@@@@B@@A@@@@B@@@B@@@@A@AB"],encodeOffsets:[[129547,43710]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
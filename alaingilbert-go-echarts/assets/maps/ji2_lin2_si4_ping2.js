@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("四平",{type:"FeatureCollection",features:[{type:"Feature",id:"220302",properties:{name:"铁西区",cp:[124.345722,43.146155],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@C@A@A@AB@@A@@@GDCB@BABAB@@AB@@AB@@ABABAD@@ADABCD@BA@ABABAB@@@@A@@@AB@@@@A@@@A@@B@@@@A@@@@@@@@@A@@@@B@@A@@@AB@@@@@@ABA@A@@BA@@@A@@B@@A@@BA@A@@BA@@@@@@@@@A@AA@@AA@@AC@@AAA@@A@@A@CBCDEDAACDB@@@B@@B@@B@BB@@@@B@BBB@B@@BABIDGBEDA@CBABB@@@@@BBCBC@@B@@@@B@@@BDEBAB@@A@BB@@B@BDBBC@@@@@A@A@A@@@CBCB@@BBBB@BB@@@@@@BB@@@@B@@B@@@@@BB@@B@@@@@@BB@@B@@@@@@@B@B@@@@AB@@@@BBA@A@@B@@BB@@A@ADAB@@@B@@BB@@BBB@BD@@AB@@BDBBBB@@BBBA@@@@@@@@B@@@@@@@@B@@B@@@@@@B@@A@@BA@@@@@AB@BAB@@AB@@@B@@@@@B@@@@@@@BA@@@AB@@@@@@@B@@BB@@@B@@BB@@@@@B@@@@CD@@BB@@@BAD@B@@B@B@B@@@@BA@@@@@BB@@A@@@A@@@A@@@A@@A@@A@@@C@@@@B@@AD@@B@@BAB@@@AA@AB@@A@AAAAA@AA@@A@A@ABA@@D@@@@A@AA@@@A@@A@@BA@@@A@@@BB@B@@B@@BAB@@BB@@@@@BA@@@CBDNLCHPNJBBFRB@@DBBAHL@FJBAJ@@BH@BDD@NEPEJBVAD@@G@AAGD@AGH@@AB@ZFLBF@@@@@@BBBAB@BEBED@HBDEFKNBFDBDDHDJBF@P@bEJCLCBCJAD@@GB@JABCRGDDKDBBPE@CNBBCDAFCFDFBDCBADAPILEGGBAD@DBJAH@BBF@@ABAFEBA@CFADC@AD@FC@AACBABADBBCD@B@BA@ACA@CACAAA@ECEA@CEEEACAEAEBCCEC@@@@@B@@@@B@A@ABA@@@@@@BA@@B@@@@A@@@A@@@@@A@@@A@@@@BA@@@@@A@@@@@A@@@@@@@@@AA@@@@@@@@AA@@@@@@@@AB@@@@@@@@A@@@A@@@A@@@@@@@@@@@@A@@A@@@@B@@A@@A@@@@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@@@@AB@@@@@@@@@AA@@@@A@@@@A@@@@A@@@@@@@@@A@A@A@@@@@@B@@@@A@@@@@@@@@A@@@A@@AA@@@@@@BAA@@@@A@@@@A@@@@@@AACC@@@@@@CCAA@@C@A@A@ABA@A@@@AA@@AAAAA@A@@@AA@@BA@@CECCAEA@@@@@C@AC@@A@CBA@@@@@A@AAABABAB@@@@AA@@A@C@C@@@@@@B@@@@@@@B@@@@@B@@A@CA@AA@@@AAA@@@@AA@@AAC@@@@@AAA@@AC@AAAAAACAA@@AE@@@@A@ACCE@AAA@@AAAC@A@@AA@@AA@@@AA@@ACE@@AAAC@A@@CECCAC@@ACCE@@CE@@AC@@AA@ACC@A@@@AAC@CAA@C@@AC@A@A@A@E"],encodeOffsets:[[127339,44160]]}},{type:"Feature",id:"220303",properties:{name:"铁东区",cp:[124.409591,43.162105],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@@B@@B@@@@B@B@@@@@B@@@B@@@B@@BB@B@@A@@BA@@@AB@@@@BB@@B@BBB@B@BB@@B@@@@@BB@@@B@@@BB@@@B@@BB@@@BB@@BB@@B@@@@@BB@B@@B@@@@@B@B@@A@@B@@@B@B@BA@@@@B@@A@@@@BB@@@BB@@@B@@BB@@@@BB@B@B@B@@BB@@@BB@@B@@B@@@BA@@B@@@B@@@@@@@@B@BB@@@@B@@@@@@B@@@@@B@@@@@@BA@@B@BA@@B@@@B@@@BB@@B@@@BB@@@@B@@@@@B@@@B@@@B@@@B@@@@@@@@@@@BB@@@B@@@@AB@@BB@@@@@@B@BBB@@@B@B@BB@@BB@@B@@@B@D@D@BB@@B@B@B@@@BB@@@@@B@@BB@@@B@B@B@@@BB@@@@BB@DB@@BBB@B@BB@@B@@@@@@B@@@B@@@@@B@@@B@BAB@@BB@B@@@@@@@B@@@@@@AB@@A@@@@@@B@@BB@@@@@@@B@B@@@BB@@B@@@@@@@@B@@@@@@B@@@@@BA@@B@@AB@@AB@@ABA@@BA@ABA@@@AB@@A@@B@@@@A@@@@@@@A@@@A@@@A@@BAB@@AB@B@BA@ABA@A@@@@@@@ABB@@B@B@@AB@@A@@@@@A@A@A@A@@@A@@@A@@B@@@@AAA@AAA@A@AACAA@AAA@A@AAA@@@@@@@A@A@A@A@A@@@A@AAA@C@@@A@@@ABA@@@AAA@@@@@A@@@@@A@@@@@A@@@@@A@A@@@A@@@A@@@@@@@@@A@@@@@A@@@@B@@@@@@@@@@A@@@@@@@AB@@@@A@@@@@A@@@@@@@A@@@A@@@A@@BA@@A@@@BA@@@@@@@@@@B@@@@@@A@@BA@@@@@A@@@A@@@@@@@A@@B@@A@@@@@A@A@A@@@@@@@@@@B@@@@@@A@@BA@@@@@@B@@@@A@@@C@@@AB@@@@@@@@@B@@@@@BAB@@@@AB@@@@A@@@@@A@@B@@A@@@@@@B@@@@@@A@AB@@@@@B@BA@@@A@AB@@A@@B@@@@A@A@@@AB@@@@AB@@@@A@@B@@AB@@A@@BA@@@A@@@@@@@AB@@A@@@@B@@DD@@BB@BB@@BB@BB@@BB@@BBB@BBBBBBB@@@BBB@BB@@BBB@@BB@BB@@BB@@BBB@@BB@BB@@BB@@BBB@BBBBDBBBB@BB@@DDDB@@BB@@BBBBB@@BB@@BDB@BB@BB@@DD@@@@BBDDB@@BB@BBBBBBBBDDBBBBBB@@BBB@BB@@BBBBBBBBBB@@DB@BBB@@BBB@@BB@@BDB@@BB@@BBDB@@BB@BB@BBBDB@BB@BBB@@BBBBB@B@@B@@A@@@@@@BA@@B@@@@ABA@AB@@@@@BA@@@@@@@BB@@C@ABA@A@EBABA@AB@@ABA@@@@@A@@BA@@@@@A@@@ABA@@@AB@@@@A@@@A@@@CB@@@@ABA@@@@BA@@@@@AB@@A@@B@@A@@@@@@@AB@@@@@@@@AB@@AB@@AB@@@@@BA@@B@@@@@BA@@@@@CFA@@@@BA@@@@@AB@@@@@@AB@@@@ABA@@@@@AB@@@@A@@B@@AB@@A@@BA@@@@@@@AB@@AB@@@@@@@BA@@@A@@B@@@@@@A@@BA@@B@@AB@@@@@@@@A@@@@B@@@@A@@@@B@@@@A@CBEBE@A@@BA@A@@@@F@B@B@BBD@@@DBB@DBD@B@@@BDD@BBB@@BD@@DF@@DFBD@@BDDDDF@@@BBDBB@@DF@BB@@B@@BB@@BB@@@BBDBB@@BB@BDFBDB@@@@@BF@@BBBDBBBB@BBD@@BB@B@@@@BD@BB@@B@@B@BB@@B@@BDBB@@@@A@@@@@A@@@@@@@A@@@@D@D@B@@@BB@@@@BABABABBB@@@@@B@DAB@@@BDD@@@@@B@BFDDDF@@AB@@BB@@B@B@BBBB@@BB@@B@B@BAB@B@D@@@BBDD@@@@@@DDBB@@@@@@@B@@B@@@@@BB@A@@@@B@@BB@@@B@@@@@@@@@B@@@@@@A@@@@B@B@B@@@@@@@@@B@@@@@@B@@B@@@@@BB@@@@@@@@A@@B@@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@@@@@@@@BB@@@@A@@B@@@@B@@@@@@@@@@B@@@B@@@B@@@@@@@@@BA@@@@@@@@BB@@@@@@@@BB@@@@@@@@B@@@@@B@@@@@B@@A@@B@@@B@@@@@B@@@B@@@@@@AB@@A@@@@B@BAB@A@@@@@@A@@@@@@B@@A@@@@@@B@@@B@@@@A@@@@@@@A@@@@B@@@@AA@@@BA@@BA@@@@BAB@@@B@BAB@@A@@@@@C@A@@@A@A@A@A@@BAB@@@BA@@B@@@BAB@B@BAB@@@D@@@@@D@B@@@B@@AB@@@BAB@BA@@BA@@BA@@B@@@@@BA@@B@@AB@@A@@B@@@@@BA@@@@B@@@@@B@@@B@B@@A@@B@@@@@B@@@@@@@@A@@@@@A@@@@@@BA@@@@@@B@@@@@@A@@@@@@BA@@@@@@B@@@@@@A@@B@@@BA@@B@@@@@B@@@@@@@B@@@@@@A@@B@@@BA@@B@@@@AB@B@B@@@B@BAB@@@@@@@@BB@@@@@@@@@@B@@@@@@@@@@B@@BB@B@@BB@@@BB@@@@B@@@BA@@B@B@B@@@@@BA@@BA@@@@@@@@B@B@@@B@@@B@@@BA@@B@@@@BB@@@@@B@@@BB@@@@@@B@@@@@@@B@@@BA@@@@@@B@@@@@B@@@B@@@B@@@@@@BB@@@BB@@B@@@@@B@@@@@@@@@@AB@@@@AB@A@@A@@A@@A@@@@@A@@@@@AA@@A@@@@@@@@AA@@A@@@@@@@AAB@@@@@@@B@@@@@BAB@@@@@@@B@@@@A@@B@@@B@@@@@@A@@B@@A@@@A@@@@BA@A@@B@B@BBB@BB@@B@BAD@@AB@B@@@B@BBB@@@@@B@@AB@@A@@B@B@BA@@@@@A@@BA@@B@D@@@B@@@@@B@@BB@BB@@D@B@@@B@@@B@@AB@@@@AB@@@B@@@@@@A@@@@@A@A@@@ABA@ABA@A@@AA@AA@@A@@B@BAFGDCBADABAD@@BB@@@DBHBDB@@BBBDBB@B@BB@B@@@@BD@@BB@@@B@BBB@B@@@BB@@B@B@B@@@B@@A@@B@@B@@@@@BA@@B@B@B@@@BBBBBB@@BBBBBBB@@B@@BB@DBB@BBBBB@@B@@@B@B@@@BB@@@B@B@B@@@B@B@@@BA@@B@B@@@B@B@@@BB@@B@@@B@B@B@B@BA@@BB@@B@@@B@@@B@B@B@B@B@@@BBB@B@@@B@@@B@B@@@B@@@B@B@@@BAB@@AB@@@B@@@B@@@BB@@B@@@B@B@@BB@B@B@@@BB@@@@@B@@@BA@@B@@ABB@BB@@BB@@BBBBB@@B@@BB@@BBBB@@BB@BB@@@@BBBB@@@B@@@@BB@B@@@B@@@@B@B@@BB@@BBBBDBB@@@BB@BBB@@B@BBBBB@BBB@@BB@@AB@@@B@@BB@@B@@@@B@@B@BB@@@@B@@@@@@B@@@B@@AB@DAB@B@@AB@@@@@B@B@@@B@@@B@B@@@B@B@@@BA@@B@@A@@B@@@B@BB@@B@B@@@B@@BB@B@BB@@B@@@BB@BBB@B@BB@@@@@BBB@@@@@@B@@BB@@B@B@B@BBDAB@B@@@B@BBB@B@@@@CAA@A@A@C@ADC@A@@BA@A@ABAB@BADABABABAB@@@BAD@@@F@B@B@BABAJGHEVQLIB@HG@@@ADABADABA@@BABAB@@AB@@@BAJCFCDCFCBADADADAFABADAD@DAB@B@DAB@BA@@B@D@BCDADABCDCFC@@A@ABA@A@@@A@A@A@A@@@A@AAAAAAA@@AA@@A@@CCAAAAA@CAAAA@AA@@A@AAA@@AA@A@A@A@AAC@@@A@@@A@ABA@@BABA@ABA@@@A@ABA@@@ABC@@@ABA@@BAAA@A@@@A@A@AAA@AAA@AAC@A@@@A@ABA@A@@@C@@@A@AA@@@A@@@AAA@@A@@AA@A@AAA@A@AAA@A@@@A@A@@@A@A@@@A@@@ABA@ABAB@BAB@@A@A@@@A@@@@@A@@AA@AA@AA@@@@@A@@@A@@@A@@@A@AAA@@@A@AAA@@@AA@@@A@@@AAA@A@A@A@ABA@A@A@@@A@@AA@AA@@AAAA@AA@AA@@AA@AA@@AAA@AAA@CAA@AAAAA@AAAAA@AAAAA@@AA@AA@@AAA@@@@A@@@AA@@A@@@@@A@@A@@A@@@A@@@C@@@AAA@A@A@@@A@@A@@@@@@@CAAAAAA@AAA@KCC@A@ABA@A@A@CBA@A@@@A@C@A@A@A@A@A@A@A@A@A@A@@@@@A@AACA@@A@AACA@@A@AAA@AAA@CCA@@A@@@AA@AAA@AAA@A@@@CAA@AAAAA@AA@AAAAAA@AA@AAA@ACC@@@AA@@AAAAA@A@@AA@A@@AA@ABA@A@@@A@@@@A@@@AA@@A@@@@AAA@@@A@AAA@@@A@@@A@@BA@@@ABA@A@A@C@A@A@ABA@@BA@A@@@A@CAA@A@@@A@A@A@C@A@@@AA@@A@@@@@@AA@@@@AA@AA@@@AA@@@@AAAC@A@A@@BA@@@A@@A@A@@@A@A@@@BA@@@@@@@@@A@@@@AAA@A@@AA@C@AAAA@@@A@@@@BAB@@A@@AA@@@A@@@A@@@A@A@@AA@A@@AA@@A@@@A@A@AB@@@BA@ABA@EBA@@@A@A@A@CAA@A@AAA@CAA@A@@AA@@@@AAA@@@@@A@@@@AA@@@A@ABA@@@A@A@A@A@AA@@A@A@@@A@@B@@ADA@@B@B@B@@@@@@A@@AA@@@@AAA@A@@AA@@AA@@@@AA@@A@@A@A@@AA@A@@BA@@@A@AAA@A@A@@@@@@A@@@AAA@@@A@@@A@@@ABA@@@@@AA@@@A@CAA@@@A@A@AAC@A@A@AAA@A@@A@@AA@@@AA@A@@A@@AA@@@AA@@AA@@AB@@@@AB@BA@@@A@A@@@@AA@A@AAA@@AA@@@AA@AAAA@@AAA@AA@@@@AA@AAA@AA@@A@@AB@@@@A@@AA@@A@AA@@@A@AA@@@@AA@@@A@A@@@AA@@@A@AAA@@@A@A@@@AA@@@@AA@C@@@@A@@@A@ABA@@@A@A@@@AA@@@@@A@@@A@@@A@@@@AA@@AAA@@@A@@@A@@A@@A@@AA@@@A@@A@@@A@A@@AA@@@@A@A@A@A@A@AB@@A@A@@@A@A@AAA@A@@AA@AA@@A@A@A@@@AA@@A@AA@AA@@A@@@AB@@A@A@@@A@@@A@@@@AA@@AA@AA@@A@@AA@@B@@A@@BA@@@@@A@@A@@A@@@A@@@A@@@@A@@AABA@A@@@AB@BABAB@@@@A@A@@@A@A@A@A@@AA@@@A@A@A@A@A@@BA@@@@BA@@@AA@@ABA@AB@@@@@@A@@@@@@@AA@A@@@@A@A@@@A@@@AA@@@AA@A@@AA@AA@AA@AAA@AA@@@@AA@@@@BA@@BA@@@@@A@@@@A@@A@@AAA@@@A@A@A@@@@@@@@@@@BB@@@B@BA@@@@B@@@@A@@@@@AB@@@@@@A@@@@@@@AA@@@@@@A@@AA@@@A@AAA@A@@AA@A@A@@@A@@@A@@@@@AA@@A@@@@A@A@@@@@AA@@@A@@AA@A@@@A@@@@@@@AA@@@@A@@@@A@@@@A@@AA@@@@A@@@@@A@@A@@@@AA@@@@AA@@@@A@@A@A@@@@A@@@@@@@AB@@AB@@A@@@@@@AA@@@A@@@@@@@A@@B@@@@A@@@A@@@@BA@@BA@@@@@A@@@@@A@AB@@AB@BA@@@@@A@@@@AA@@@AB@@AA@@AA@AAA@AAA@@@AA@A@@@@A@@@@@@@A@@@C@@@ABA@@@A@A@A@@@A@A@A@@@@BAB@@A@@@AB@@@@@BBB@@@@@B@@@B@@ABB@@B@@B@@B@@BB@@@BA@@@@B@@@@@@@BB@@@AB@@AB@@@B@@@B@@@@BF@@@B@@BB@@@@@B@@@@@B@B@B@@@B@@@@@B@@@B@@A@@@A@@@@B@@@@AB@@@BAB@@@BA@@B@@@@BB@@B@@B@@@@AB@@ABA@@BA@@BA@@@AB@@A@A@@@ABA@@@A@@@@@A@A@@@A@@@@B@@@@@@@@@B@@@BB@@B@@@@BB@@@@@@@B@@BB@@@@@B@B@@@B@@@@AB@@@BA@AB@@A@@@@@AB@@@@@@AB@@A@@AA@AA@@A@@@A@@AAA@@AA@@@AA@@A@@A@A@@@@@A@@@AB@@@B@@AB@@A@@@@@A@@@A@@@A@@@@@A@@@ABA@@BA@@@A@A@@@A@A@A@@@A@@@A@A@@@A@AB@@@@AA@@A@@A@@A@@@AB@@A@@BA@A@@@A@A@@BA@A@A@A@A@AA@@A@A@@BA@A@A@@@A@A@AAA@A@A@A@A@A@C@@@A@@@ABA@@@A@AB@@A@@B@@@B@@BB@@@B@BBB@@@@@B@@@@@@A@ABA@@@@@AB@@@B@@"],encodeOffsets:[[127352,43922]]}},{type:"Feature",id:"220322",properties:{name:"梨树县",cp:[124.33539,43.30706],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@A@@@@@@A@@A@@A@A@A@A@@@@AA@@@@A@@@@@@BA@CC@@@@A@@BA@@BBB@@@DB@@BA@A@@@@BA@@AA@A@@AA@A@A@AAA@@@@A@@B@@@@A@@@@A@A@A@A@@@@BA@@AA@AB@@@@AB@@@BA@@@AAA@@A@@A@A@@@A@A@A@@@AB@@BB@B@@@@ADA@@@AB@@BB@@@@ABA@A@@@A@@A@@@@@@@ACAA@@B@@AB@@B@@B@@@BB@BB@@B@BB@@@@A@@B@@A@A@A@AAC@@@@B@@@@@B@B@@B@@BB@@@@B@@AB@BB@@@@@@@@@@@@B@@A@@BA@@@@B@@@@@BA@@@@@@@A@@A@@@@A@@@@@@A@@@@@@@@A@@A@@@@@@@@A@@@@@@@@@@A@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@@@A@@@@@C@@AA@@A@@B@@AC@AAC@@@@@@@@A@@@CA@@@ABA@@BAAAB@AAB@A@AA@@@@A@@@@@A@@A@@@@A@@AB@@AB@@@@@B@@@@AB@@@@A@A@@AA@CA@@@AA@@@A@@@@@@B@@A@@@AAA@@@@A@@A@A@@@A@@BA@@B@@@@@B@B@@B@@@@BB@AB@@A@@@A@A@@@A@@BA@@@AC@@@@@A@A@A@A@@@@A@A@A@@@AB@BC@AB@@@@@@A@AB@@A@A@@@@AA@C@@@@@@@@@AB@@@B@@D@DBB@@@@@AB@@ABA@@@@@A@@@A@@@@@@AA@@@@@@A@AA@A@@@A@C@@B@@B@@@@B@@@@@@@B@@@@B@BB@@@BB@@@@@@B@@@@A@@@A@@@A@@A@@A@@@AA@@ACA@A@A@@@@@@B@@EBA@@@A@@@@BA@@@@@@@@@@@@A@@A@@@A@@A@@@A@@@@A@AB@BA@@@@@@B@@@B@@A@@@@BA@@@@@@@A@@@@A@@@@@@@@@A@@@@@@@AA@@@@@@@BA@AB@@AA@@@A@@@A@@@@@@@@@@@@B@@@@A@@@A@@@@@@@@@@@@AB@@@@@@@@@@@@@@A@@@@@A@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@B@@A@@@A@@@@@A@@@@@@A@@A@@@@A@@@@A@@BA@A@@@A@@@@@@@@B@B@@@B@@A@@@@@A@@@BB@BB@@@@B@B@@@@@B@@@@@B@@@B@@@@@@@B@B@@@B@@B@B@@@@BA@@@@BDB@@AB@@ABAB@@CB@B@@AB@@@@DF@@BFDB@B@@@BA@@@A@@@@B@@A@@@A@@BAB@@@@@@A@C@A@@@BC@@@@@AA@@@@BCD@BAB@@AAC@GA@@@@@BDDBBBFB@@B@@@B@@@B@@@@@@@BAD@@AB@@@@@@A@@AA@AAA@EBA@C@C@C@A@AAA@A@A@K@I@C@A@@@C@A@A@CAC@CAA@AAA@@@C@C@CAE@@@C@CA@@EC@@A@AAEECAA@@@@BA@A@@BA@@@@@ABABA@@@CD@@A@AB@@ABABA@@@AB@@ABABED@@CDAB@@@AA@@@A@@BCB@@@@@@@BA@@@AB@@@B@@A@AB@BA@@@AB@@A@@@A@@@ABA@A@@BA@@BA@@@AB@@A@@BAB@@A@@B@@ABA@@B@@@@@BA@@B@@AB@@@@A@ABABAB@B@@ABA@@@AB@BA@@BABCBCFEDCFA@AB@BA@@@ABA@CDA@@@@@BB@@@@@@B@@B@@@@@@B@@@@BGBABC@ABABA@CB@BA@CBCDAB@@@B@@@@@@A@@AA@@@A@@@A@@B@AA@@@A@ABABC@ABA@A@CBABCD@@CBCBC@@BAAA@@@@@A@@@@@AA@@A@CBA@A@@@AACAA@@@A@AB@@A@@@C@A@@@A@A@ABC@A@@@@@ABA@AB@@@@@@BB@@B@@B@D@B@BB@@DBB@B@@@@@B@@@@@B@@@B@B@@@@@B@@BB@@@@@@@B@@@BB@A@@@ABA@@@@B@@@@@@AB@@@@ABAB@@A@@@@B@@@BA@@BAB@B@@AB@@@@@@@@A@@@@@@@A@@@@@A@@@A@@@@@A@@@@@@@A@@@AB@@@@@@A@@@@@@@AB@@@@@@@@A@@@@@@A@B@@@@A@@@@@@@@@@@A@@@@@A@A@@@@BA@@@@@A@A@A@@BA@@@A@A@@@@@@@A@@@@@@BA@@@@@@@A@A@@@@@ABA@@@@B@@@@@B@@A@@@@B@BA@@BABA@AB@@@@AB@@C@@@AB@@A@A@@@ABA@@@@@A@@D@@@D@@@@B@@B@@AB@B@@@B@@A@@@@B@@A@@@@@@B@@@@DB@@@B@B@@B@@@@B@@@@AB@@@B@@B@@B@@@@@@B@BB@@@@@@@B@@A@@B@@@B@@A@@@@B@@@B@@@B@D@@BB@BB@BB@B@B@@@B@@BBBBBBD@BBBBB@@BB@@BDDDFB@@BBBB@BBC@ABA@A@@BA@@@A@@BA@A@@BA@@@A@@BA@AB@@@@@@A@@BA@@B@@@@@@@@@@B@@BB@@@@BB@@@@BCBCB@B@@AB@@A@ABCBA@ABAB@@@@AAAA@AA@@@ABC@CDA@@@CBED@@A@A@A@@@A@@@AAA@C@@AA@A@AB@@ABA@EDA@AB@@ABCBA@A@@BC@CBAB@@@@A@@@A@@BA@A@@BB@@@@@@B@@@@@@ABCDA@ED@@@BC@EBA@A@@@@@@ABA@A@@@@@AAA@AAAACAACA@@@@ABA@A@@@A@@AA@@@@@A@@@AB@@A@@@A@A@@@A@@@@@@@A@@@@@A@@A@@@BCB@@@@@B@@@B@@@@@@A@@@@BB@ABA@GBAB@B@BB@FDBBAFAF@BHBBB@DAF@BJBBBBD@B@BBB@B@@@B@@F@L@D@@DBDBDDFAFCL@BDDDDDDBDJHB@DBBBB@@@B@BBF@FADBHDDBDAB@@@LEHAD@DDFBF@B@BBF@B@DAD@@@BAB@D@FAD@B@DDBBBBB@D@HDF@DDDBB@@BB@@B@B@@@B@DB@@HADAF@BB@@B@@@@@@@B@@@@@@@@B@@@@@@@@@@BB@@@@@@@@@@B@@@@A@@@@BB@@@@B@@BB@@@B@@@BB@@@@B@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@B@@AB@@@B@@@@B@@B@@@@@@@@BA@@B@@@@@@@B@@@@@B@B@@BB@@@@@@@B@@B@@@@@@@B@@@@@B@@@@@@@@A@@A@@A@@@@@A@@@@@@B@@@BAB@@BB@@@@@@@@@@B@@@@AB@@@@@@@B@@B@@@@BB@@@B@@@@@B@@@B@@@BA@@B@@@@B@@@@B@@BA@@@@B@B@@@@B@@@BBB@B@BB@@@@BB@@@@D@@@B@B@B@B@@@@@B@@@@@@@@AB@B@@A@@B@@B@@@B@BB@@@@BB@@@B@@@@@B@B@@A@A@A@@BA@@N@B@BB@BB@@BB@@@@@BB@@@BB@@B@@DB@B@D@BCD@BBDBBFBB@@@F@FBH@@@B@D@BBDBDHBDD@FBHBDBLBHD@@@@FBBB@DADBBDDFD@@@D@D@BBBB@F@D@HAD@DB@@BF@@@B@B@HDDBBHDJ@BBB@B@@@HAHAHAJDFB@@BB@@@@BBBBD@@BDBDJBD@DBDBB@@DD@DBB@B@@@B@B@@@BB@BBDBDB@BFBBB@D@@BBFBDAB@D@BB@DBB@BB@B@B@D@B@J@HBFFDBJDBBFBD@FC@@F@D@D@BDFDBBB@DGDADAJ@F@D@FADBBB@B@@BB@BBB@@B@B@DAB@DABB@@FB@@@@B@B@@@BBD@JCD@B@DBDFDBF@HAD@DB@@DBBBBB@B@@@BDFFP@@@B@@BBBBB@B@DDDB@@@BEL@BBBNBB@@DADAD@HABB@BBBADBBB@@DBDBF@D@FBF@H@H@@@J@F@L@NBD@D@D@DBF@B@D@F@F@D@DBB@B@F@D@D@B@F@DBD@D@D@D@D@B@BBB@D@B@B@BBD@B@B@B@B@@@BBB@B@B@B@@@B@B@BBB@B@B@B@DBB@B@B@B@@@DBB@D@B@D@B@D@@@BBB@B@B@B@D@HBBADB@@B@BCD@F@B@FAB@B@BAB@@@B@B@@@B@BAB@B@B@@@BAB@B@B@@@B@B@BAB@B@B@@@B@B@BADAF@B@D@FAHAD@@@B@F@DADAD@@@B@DAD@DA@@B@H@B@B@D@@@BBD@B@B@BBB@@@D@@@BBD@B@B@B@D@@BB@@@F@B@B@@ABBB@FBHAB@@A@A@A@@HCJALENA@AB@B@B@DAB@@@D@@@B@D@B@DD@@D@@@D@BBB@D@B@D@B@B@B@@B@BB@BBD@BB@@DAB@DABABBD@BA@A@AFADABABAB@B@BABADA@@B@BABA@@BA@A@A@@AA@CBA@@BABAFADCBABA@AB@BAB@B@DAD@@AD@F@DABABABADA@AFG@@BA@ADCBADC@A@AB@BCBA@@BABEHGBC@@@ADABAFC@@B@B@B@BAB@B@B@BAB@B@DAB@DA@@FAFAFAB@BADABAB@BAB@B@BABAHA@@BABAB@BABAB@BA@AJAFAHADADCFEBCFG@CDEJUBEDEBG@CAGACAA@@@A@AA@@A@E@GBK@EBGBCBE@AFABEB@@ABEDADCDEBA@K@I@EBI@G@CCC@A@@@@AA@@AA@AAA@AA@@A@@@A@AAC@CAQEG@@@AAA@AAAA@AA@ACAAC@@@EBEBCBIBEBC@ADEDGBABC@ABC@ABCBCB@@A@@@ABA@@@AB@@ABA@@@ADCACB@BA@A@AB@@ABCBA@@@A@@BA@A@@BA@@@ABA@ABCBABA@ABABCBABCBA@A@@BA@@BADA@A@ABABC@C@@DCFA@A@@BAB@JGBABA@A@ABKAABA@@@CBA@A@A@AB@@A@AB@@A@A@ABABA@A@@BA@ABA@ABA@@BADC@AB@@@DABAB@BAFA@@DA@@B@BABAB@@@BA@A@ABA@A@CBICC@@@AA@@C@@BC@ABA@@BA@ABA@ABABC@@@A@@@@@A@AAA@@@AACBA@AB@B@B@BAB@BCBA@E@@BA@A@AB@@@@A@A@@BCBCB@@A@AB@@A@E@A@AA@AA@EAAECC@@A@@@AAA@@BA@ABA@AB@AC@AA@A@A@AAA@AAAAA@AA@ACE@@@AA@@A@A@E@ABA@A@ABA@A@A@AB@@A@ABA@C@@BCDCB@@AD@B@D@BAB@B@@AB@DA@ABABABA@ABAB@@A@A@CDADA@A@EB@@ABAB@DCB@BC@@BABADABAB@BADABABC@CAA@@AAC@A@AAC@@@CAEC@@A@AACBA@A@A@C@AAA@ACAA@CBABA@AB@@ABA@@BABABAB@@C@A@A@@AAA@A@@@A@A@AAA@@@A@A@CBAAA@A@A@@@AA@@@A@@@@A@AA@@@@A@@A@AAA@AAA@@A@@@AAA@A@@AA@@@A@A@@@AAA@@@A@@@@BA@@@AB@@A@A@@@A@A@@@A@@@A@A@@@@@A@@BA@A@CBA@@BA@@@A@@@@@@@@A@@A@@A@AA@@@@@@AA@@AA@@@A@@BA@@AA@AAA@AAAAA@@@AA@AAA@@A@CAAAAA@@AA@@@A@A@@A@@@A@A@@A@@A@@@A@AA@A@@A@@AAA@@AAAA@@AA@@@AA@AAAA@@AA@@AAA@BA@@@AB@@A@@@A@@@@AA@@A@A@A@@AA@A@@@A@@@AA@@A@@@A@@@A@@BA@AB@@A@A@@@A@@@A@A@@@A@@@A@A@AA@@A@A@A@A@A@@@A@@@A@@@AA@@ABA@A@A@A@@@A@@@AA@@A@A@@@A@A@@@AB@@A@A@@@A@A@A@@@A@@A@@@A@A@@@AA@AAAAA@CAA@@AA@@@AAAAAA@AA@AAAA@A@@@A@A@AB@@A@@@@@AA@@@@BA@@@A@A@A@@@AA@@A@A@AAA@@@A@@AC@@A@@A@A@@A@AAAACAA@@CAGACA@@A@@AC@ABCBABCDEHABA@@@@BB@@BBB@@@BAB@BAB@B@@@B@B@@@@@B@@@@A@@@A@@B@@A@@BA@@@A@@@A@C@@@AAA@@AA@@@@@A@@@C@A@@@AB@@@B@@@@ABA@A@@@@BA@@BA@@@@@A@AAA@@@A@A@@BC@ABA@@@AAA@AAA@A@@@@BAB@@@@@B@@@BA@@@@B@@@@A@@@A@@@@B@@A@@@@@@@A@AB@@@@A@@@@@@@A@BB@@@@@@B@@@BB@@@@@@@@@BB@@B@@@@@B@@@@@BB@@@@BB@A@@B@@A@@B@@@@@@@@A@@@@@A@@@AA@@A@@A@@@@A@@@A@@@A@@@@@A@@@@@@@AB@@A@@@@@@@A@@@@@@@AA@@A@@@@@A@@A@@A@@@AB@@A@@@A@@@A@A@@@@@@@@@AB@@AB@@@@A@A@A@@@AB@@A@@@@@AA@@A@@AA@A@@AA@@@@@@@@@@@@A@@@@@@@@A@@A@@@@@@A@ABA@@@A@A@A@@B@@A@@@AB@@A@@@@B@@@@A@@@@@@@A@@@@@A@@@AB@@A@@@@B@@@@A@@@@@@@AB@@@@@@@B@@@@A@@@@@@@AB@@@@@@@B@@@@@B@@@@@@A@@@@@A@@@@BA@A@@@A@@@@@A@@@@@AB@@@@A@@@@BA@@BA@@@AB@@@@A@@@AB@@AB@@ABA@AB@@A@@BA@@@A@C@@@@@C@@@A@ABA@A@AB@@A@@@AB@@A@AB@@@B@B@B@B@@@B@D@@@@@BA@ABA@@@A@AB@@@@AB@@AB@@B@@B@@A@@@@@@B@@@@@@@B@@A@@@A@@@@@@@@BA@@@FDDDFAFBDBFBFF@DFBFDB@BBBD@DDB@BABA@C@ADCAABABBD@BEDC@@BCDEB@DABEFAB@BE@AAG@IBCAC@ABHHKFOJCBABCDEAECEDCBADMA@DOFAALCCCQHADIBA@@HC@IBADKDIDaFO@E@IAGCCCCAAELMFEAC@GFCFA@ABAAA@A@@@@E@KAYEA@@BG@BHC@BH@B@HC@UBIAOFMFC@ACG@@AI@ABEIK@BGAA@CA@EQAAMIGOKDCMDA"],encodeOffsets:[[127244,44277]]}},{type:"Feature",id:"220323",properties:{name:"伊通满族自治县",cp:[125.305393,43.345754],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@@A@@A@@AA@@@@A@@@A@@@A@@B@@AB@BA@@BA@AB@@@@A@ABABAB@BA@@@@B@@@@@B@@@@@B@AA@A@@@A@@@AAAA@AA@@AA@@@C@@@A@@AA@@A@@@@@A@@@A@@AA@A@@@A@@@A@@@A@@@AAA@@@A@@@@@AAA@@@A@AA@@A@A@@A@@A@@@@@A@AA@@A@C@AAA@AAA@A@AAA@A@@@A@A@@@ABABA@@BABA@A@A@A@ABA@A@AAA@AA@@C@ABA@@@A@C@A@A@ABAB@@CB@@A@@@@BA@BBAB@@@BA@@@AB@@AB@@A@@@@B@@@BA@@BA@@@@B@@@BA@AB@BA@AB@@A@ABC@A@@@A@B@BABABA@@@@@@@@@A@@BA@A@A@@@A@AAA@A@A@A@A@A@@@A@@AC@@@@@A@@A@@A@@ABCBABCB@@C@ABCBA@@@ABCBA@CDCB@@ABC@A@ABC@GAA@E@A@C@AA@@A@A@A@AAA@A@A@A@AAC@CBA@A@A@A@ABA@A@O@@@CBKBA@CBABAB@@ADCD@BABC@@BA@A@CBCDA@EFCBCDCDA@ABABC@CBA@A@ABA@C@@BA@A@C@ABA@C@A@A@A@ABA@G@AAA@C@A@@AA@A@A@C@ABA@C@A@ABA@@@C@A@CBA@A@C@C@CBC@A@A@C@@BAB@BAB@@AF@B@BAB@@@BAF@D@@ABBH@@@FAB@BABC@@BABABA@@B@HBD@@@B@H@@ABAD@@ABAB@DA@@B@B@@@D@@BBBBAD@B@BA@@B@B@D@B@BBBBBBB@BB@@BBBBD@@BB@BDDBD@DBBBB@BAD@B@BAB@@CD@BAB@B@BAB@@@BABAB@BABABABABA@A@C@ABABA@A@C@C@A@C@@BCBAB@@@B@BAB@B@BA@@BCD@BCDABA@A@E@ABAB@BBBAB@BA@A@C@A@C@AAA@A@A@AB@BAB@B@@AB@B@@@BABG@A@A@CBA@ADA@AAA@@AC@AACACCCAAA@@AC@A@@@A@A@@@AA@@@A@A@A@A@A@A@A@A@A@A@AAA@@@@@A@A@A@A@A@A@AA@B@@A@@BA@A@ABA@@@A@A@C@A@@BA@@@A@AB@@ABA@A@@@A@@B@@@@AA@@AA@@@AAAAAA@@AC@@@A@BCAABCBA@@@A@@AA@@@A@@BABA@@BA@ABA@@BAB@@@B@B@@AAABA@A@@@AA@C@@AA@BA@A@@@A@@AA@@@AAA@ABA@A@@@A@A@@@A@A@@@A@A@@AA@@AAA@@AAAA@AAAAA@AA@AAA@@@A@A@ABA@C@@@@AAAAAAACA@@AAA@A@A@@DABABA@@@@B@B@@@@ABABADABABABA@ADBB@B@@@D@@@B@B@B@B@B@FAB@B@B@@@B@B@FAF@D@B@B@BAB@@@@@BA@@BA@@BC@ABA@A@@@A@CA@BC@@AA@@CA@AAAAAACCC@ACAAAA@AAAC@A@A@ABA@AAA@@CA@@AAB@@A@@BA@@@A@@@A@A@AA@AAA@AACA@@A@A@AB@@@@A@A@A@CBA@ABA@@@ABA@@BA@A@CBA@CDABA@ABCBABEB@BA@CBCBABCDIFA@AB@@ABGBA@CBA@ABC@ABC@ABC@@@ABE@C@C@@BEBA@EDCBABA@ABA@C@EBABABA@CB@@IDABABA@EBA@A@A@ABEBABA@CB@@AB@BAB@B@DAB@@ABABC@@@@@CDABADABA@ADABCBEBABA@CB@BAB@B@BABA@ABABABA@AB@BABAB@@@BAB@B@BABABA@ABC@ABABABABEFA@@BA@CFABA@ABABA@@BABA@AB@@ABCBBBBDABABA@AB@@@BAB@F@DABABAB@@CB@@A@C@EAE@E@C@C@AAC@ABC@@A@@A@A@@@@@@@A@C@A@C@C@@@A@EBC@E@C@A@C@AA@@A@@A@@A@@AA@@@A@@@@A@@@@@AA@@@A@@BA@AAABA@A@@@AAA@A@A@@@A@ABA@A@@@C@AAC@CAC@AAA@A@A@A@A@C@CAC@E@@@C@A@E@@@A@A@A@A@AA@@A@@BA@A@@@AACC@AA@A@A@@@CAA@C@ABC@ABA@A@A@AAC@A@A@C@CAC@C@AA@@AAC@A@A@A@A@A@KLA@ABA@EAA@CBABABA@A@AACACAECAAC@CACAE@@@@A@A@@@@A@@@AAA@@AA@A@@AA@@AA@@@@@@A@@@@@@@AB@@@@A@A@@@@AA@@@@@@@@AA@@@@@@@A@@A@@@AA@@AAA@@@AA@@A@@@A@@AA@A@@@AA@@@@@@@@A@@@A@A@@A@@A@ABEBA@AB@@A@@@@@@@@@A@@AA@@@A@@@@@@@A@@@@A@@A@@@A@@@A@@@@@@@A@@@@A@@@@@@A@@@AAA@@@@@A@A@A@@@A@A@@@A@@@@@A@@B@@@@A@@@A@@@AAA@CAECAAA@@AA@AAA@@A@@@A@@@ABA@ABA@ABA@C@@@A@A@A@@CAA@A@AAA@AAAAAAA@AAA@A@AAA@@A@BA@@@@@A@@@AA@@A@@@@@AAA@@AA@@@A@AAAA@@AAA@A@@AA@AAAACACCAAAACA@@@@A@@@@@AA@@A@@@@@AA@@A@@AA@A@@@AEABG@@CAAA@CACACAE@A@A@A@A@AAAEAA@AAAEAECCA@@EDCDADCBCBADC@A@@@ABA@CBA@A@CBC@CBABEBCBCBCBABEDCDEDIDAB@@BBHDDDFBDBBB@@B@BB@BDBBB@D@@@D@@A@ABCBCDEDCDCBCDABABCFABAB@BAB@B@@ADABABAFADAB@BADAB@B@B@B@BB@@BBBBBBBB@@BBB@@@B@BA@@BBB@B@B@@BB@@BBB@@B@@AB@@@B@@@@BB@@B@B@B@@BB@@@@BDB@@BBB@BB@@AB@B@@@B@B@@BBBD@BB@DDB@BBBB@BBBBB@B@D@BBBBBDBBBBDDD@BBBBB@B@BBB@@@D@BBBAB@BABADAB@BAB@B@B@B@BDBBBDBDBD@D@B@BADBB@B@B@D@DBD@DBB@B@@BDBDBDB@BD@BBDBDDDBDBDDFDDBBBB@BBB@BDJFBBDBBBBBDD@@@B@BBDB@@@BBBBB@@@A@ABA@A@@@A@@@AB@@AB@@@B@@@@AB@B@@AB@@@@A@@@ABA@@@A@AAA@@@A@@@@@@@@@A@@@A@@@@@@B@@@@@B@@@B@@@@@@@@@@BBC@@BA@@@B@BBBB@@@@BB@@@@@B@@@@@B@@@B@@A@@B@@@@@B@@@@@BB@@@@B@@@@@B@@A@@@@@A@@@@@A@@@@B@@@@@@@@@B@@@@@@@@@B@@@@A@@@@@A@@B@@A@@@AB@@@@@A@@A@@@AAA@A@A@A@@@@@@@A@@@@@A@@B@@A@@@A@A@ABA@@@@@AB@@A@@@@BA@@@A@A@@@A@@BA@@BA@@@@@A@@@A@@@AB@@A@A@CBA@@@@@@@@B@@@@@B@@@@@@A@BD@@@B@@@@@B@@BB@@@@@B@@@@@@@@@@@@@BBB@B@@A@@@@B@@B@@@@@@@A@@@@BA@@B@@@@@B@@@@@@@@A@@@A@A@AB@@@@@B@@BB@@@B@B@@@D@BBB@B@@ABAD@@@@AB@@ABABCDABA@ABA@A@@B@D@D@B@DA@@B@@@BA@@@@B@@@@@BA@@@@@A@A@@BA@C@A@@@A@@AAB@@BB@@@BBBB@BBB@@BB@@BBBBBB@@B@@@B@@BB@BB@@B@B@@BB@@BBB@BA@@B@BB@@B@B@BBB@D@BBB@@@BBB@BA@@@@B@@@@@B@@AB@@@BA@@D@B@B@@@B@@@BBB@BB@@B@@@@B@@B@@@B@@B@@@@@BB@@B@@B@@B@@@@B@@@B@@@B@BB@@B@@@BBBBB@@B@B@@DD@@@@BB@@@@@B@@@BB@@B@@BB@@@@@@A@@B@@@@@BA@@@@@A@@B@@@@@BB@@@AB@@@@@BABAD@@@@@@@@@B@@@@BD@B@@B@@@@@@@BB@BBB@@@@@B@B@@@@@@@@@@@@@B@B@@@BA@@@@B@@@@A@@@@@A@AAA@@@AB@B@@@B@@AAA@A@@B@@@B@@@@@BA@AB@@@B@@@B@@A@@@A@@@@@@BA@AB@@@@A@@@A@@@@@A@@@@B@@@@AB@B@@@B@D@BB@@B@BA@@@@B@@@@ABA@A@@@@@@@BBB@DBBB@@BBB@BBBBBB@@BBBB@@@BBB@@BBBBFBFDB@BBFBD@DBB@BBB@DBB@DBB@@@B@B@B@DBB@BBH@BBB@D@BB@@BAB@B@@@DBB@@@DBB@@@BBDBD@BBD@B@@@B@DBB@BBHB@@@@A@B@@@BBFBFBFB@@B@BBB@BBDB@D@B@BBB@BDBB@BBBDDD@@B@@@@@@BBB@BB@BB@BBB@@DB@BDD@@@BA@@@A@@@CBABA@ABA@@B@@BB@@@@@BA@@B@@@@AB@@AB@@ABB@@@BB@@B@@AB@BABB@@@BA@A@@BB@@@D@B@@@@@@@AA@@BA@BB@@@B@B@@@B@@@@B@AB@@@BA@@@ABA@A@@B@@BB@B@B@BBBB@@BB@@@BAB@@AB@@AB@@BB@@B@B@@@BA@@BB@@@B@@AB@@A@A@@BB@@B@@@B@@@@@@B@@@B@@@@@@@@B@@@B@@B@BAB@@@B@@@@B@@@B@@BB@@BB@BBBB@@B@BB@@BB@@B@@@@BB@@@BB@@B@@B@@BBB@@BB@@BBBB@@@@DBBBDBFDDBBBB@B@DB@BDBB@HDHDHBDBDBB@BB@@B@B@DBB@BB@@D@BB@@DBB@B@@BD@BBB@B@B@BB@@B@B@BB@@B@@@B@@@@@B@@@BB@@B@B@@@B@@@@BB@@@FBFBB@@@D@BB@@B@@@B@@@@@B@B@B@BBB@D@B@B@B@B@@@@@B@B@B@B@BBB@B@B@B@D@DBD@BBB@@@B@BBB@@@B@BBBBB@@@B@@@BBB@@@BBB@B@@BB@D@HB@@DBB@D@BBB@B@D@@BB@D@B@BBB@@@@@B@B@FB@@@@BBB@@B@@@B@@@@B@BBF@DBB@B@BBD@B@BBB@@@@@B@@@@B@@@@A@@B@@B@@B@@@AB@@AB@@A@@@@B@@AA@@@B@B@@@B@@@B@@@B@@@B@@BB@@@B@B@B@@@B@FBB@D@DBD@D@BBB@DBBBB@BBD@BBFBDBF@B@FBD@FBD@B@DBB@F@@@B@D@HBD@D@D@DBB@B@F@B@D@D@B@B@B@DBB@B@BBB@@A@AA@@@@A@@AAFAACB@@@@CAC@@@@B@@A@@BA@@D@DABABA@CBB@AB@A@A@@A@@@@@@@AA@@AB@@E@@BCB@B@@ABC@A@ABB@CA@@A@@@E@@B@@@BBB@@B@@HBBCCABE@@@@@@@@@@@@@AC@@A@AA@@@@AA@AA@@@AAA@AA@CA@@@A@@A@C@@AA@@@@@A@@@C@@AA@@@A@A@@@A@@A@@@@B@@AB@B@@@@@@@@@A@@@A@@@@A@@B@@A@@BABABA@AB@B@BA@@@@A@AA@@@@AAEA@ADBBCA@C@A@@@A@BA@@@A@@@A@AB@@A@@@AC@@@AJA@@@CAAA@ACACAC@CA@@@@@A@@@ABB@@B@@@BA@@B@@BB@@ABC@A@@@A@@@@@A@@B@@A@@@A@@@AAADAAAACA@CAA@A@@E@E@@A@CCB@BAB@BCA@BA@@@ABA@@BAB@@A@A@@AAB@@A@@@CF@@@@AB@A@A@BA@@@@AA@@@A@@BABAD@BBBA@@@@@@@AC@@AA@ICE@A@@AA@A@@D@BA@CBEAA@A@AAC@C@@@A@A@A@@@A@@@@@@A@ABAB@BA@ABAB@@A@@@@AAB@A@@A@@@A@@@@B@@AB@@ABA@@AABAEACA@A@CB@B@N@@@B@@AB@B@@@@@@@@@@ABA@@IC@@AA@@B@@@@A@A@@@A@AB@@A@@AA@AB@B@@@B@@@@@@AB@@A@A@A@@BA@@@ABAA@@A@@@@ED@EC@A@A@AA@@@@C@A@@A@AB@@@BA@@A@A@AA@BA@@BAB@@A@@@@A@@BAAAA@A@A@CBAAA@C@@@AAAA@@@AB@@@B@@@AAA@@@A@@@@@AA@@@AC@AA@AB@@@BA@@A@A@ABAA@@@@@ABA@A@@@AC@A@@A@@@AB@@AB@@@B@B@@A@@@@A@A@AA@@@@@AB@@@B@A@@@AA@@BA@ADAB@BA@@@A@ABE@@B@B@@@@@@A@C@A@A@CAA@@@@@AB@@@@@@AB@@@@@@@BA@@@@@@BA@@@@@@@@B@@A@@@@B@@@BA@@@@@AB@BA@B@BABB@BB@@HB@CBBBAD@@A@@@A@A@AD@B@BAHDABADD@D@@@@@BA@A@ABA@A@A@@@@FAB@B@@B@B@B@B@@J@@BI@@BD@D@@FB@BI@@@A@LB@@AB@BB@@BBBBB@ACBAB@@@BFBAEEB@BC@@@@BB@B@@B@@ABBJC@@@B@B@@B@B@@B@@DB@B@@B@@BABABDBHB@@AA@@B@@@D@B@@@BA@@@@B@B@@@BB@DF@BBDB@@@@AB@@@B@@@B@BB@@@B@@@@B@@DA@@B@BB@@@BJ@@DB@@DABDBF@FAADHDBA@BBC@@DD@@@ADABB@B@BABB@FB@BFBBB@BDBJ@@A@A@@@AB@@@B@BA@@A@@@@@BAHAD@D@@@BC@@@AB@@BD@@B@@@@@BB@@B@@@@@B@@@@@B@@@B@@B@B@@@ABBBBA@@@@B@@@@@AH@@B@@@B@@@B@@@@@@B@@@@A@@@A@AB@@CB@@ABA@ABA@ABB@B@BB@@@@@B@BA@@B@BAB@B@B@@@@AB@@@BA@A@AB@A@AABABABAB@@A@BB@B@B@@AAA@@@@B@BA@@BD@@BA@@B@BA@@BA@C@C@A@@B@D@@@B@@@@@BDDFDBB@@@@@B@BB@@B@@BBBB@@BBD@DBD@BBD@@@B@D@B@B@B@DBB@@D@B@@B@@@B@@@BB@BAB@BBBEHA@@@AB@@@@@BOECHFBCHA@@@@@ADC@@@@B@@B@D@@BDBB@@@B@@@@@B@B@D@BAFB@@J@@DA@@D@@@@@B@BB@@@BH@@@@@B@@CB@DBB@@@@C@BB@@A@A@@@@BA@@@@@AF@@@@EA@BBBD@B@@AB@BBB@B@@B@@@@@BAA@BA@A@B@@B@@@AA@A@@@@@A@@B@@@B@@@@ABA@@@A@@@BBB@F@@B@BB@B@B@B@B@B@B@FAB@B@@BB@@B@DBBLBA@ABABHCBBDADADDBBDBB@DAGCBAHDBAFB@BFBB@@BABB@DBLHDB@@@@@@CDHB@@B@AD@@@BBBBB@AB@BA@AB@@A@@BA@@B@@@@@AA@@@@B@B@@@@CB@AE@C@AAC@A@A@C@@@ABA@AB@@A@@@@@A@A@A@@BCAAAABA@@@AB@BC@@B@BAA@@CBA@A@@AABA@AB@B@@A@@@@@A@@@AB@@@AA@@A@A@@A@AA@@A@@@ABA@@@ABA@@AA@@@AA@@@AA@@BAAA@A@AB@@AB@@AACAA@A@A@@@AA@@A@A@@A@CAA@A@AAAA@A@A@A@@BABABABA@@BAFABA@@BAB@@A@A@A@A@ABA@A@ABAB@@A@@@ABAB@AA@A@@@A@A@A@@@ABA@@@A@@A@@@A@@AA@AA@@@@@A@@@A@AB@@@@AB@B@@AB@BA@@B@@AB@@@BAB@@@D@BAB@F@BAB@@AB@@A@@@@@@@@@A@@@A@@@A@@@@@@@ABCBA@@@@BA@@@@B@DAB@BAB@BABA@ABABABAB@B@B@B@@@@A@@@ACAACAAACA@@AA@@AB@@A@A@A@AAA@@@A@A@A@AB@@AB@B@DB@@B@B@BAD@FABAB@@ABA@A@@@@@AA@@@@@A@@@@A@@@ABABAB@B@B@@@B@B@DAB@B@@A@@@@BAA@@AA@@AA@@@@@@A@@@@DABAB@@@@A@@@@@@AA@@EACACA@@GCEAAAAA@@CCAA@CDA@AB@DC@AB@B@D@BBD@@AB@BA@@B@@ABA@A@@@AB@BA@@@AB@@AB@B@B@B@BBB@D@D@B@B@B@BBB@@B@@B@BAB@B@@@BAB@@@B@@@D@BBB@B@B@B@D@D@B@B@@AB@A@@@@AA@@A@@@AA@@@BA@@@AB@@@BA@@B@BA@@B@@BB@BBDBFBB@F@B@D@BBD@BBD@D@D@B@B@@@@AB@@AB@BA@@@@B@BA@@@@@A@A@@BA@@@A@A@@@@@@A@@AA@A@@@A@@AA@@@@@@A@@BAB@@@@A@@@A@A@A@@@C@A@A@A@@@AA@@@@A@@B@BAB@@@B@@@BA@@AA@A@@A@AA@@AA@@A@@AA@@AA@A@@AC@CAA@CAA@AAA@AA@@A@A@A@C@@@A@@@@A@@A@@@@@@A@AEACAA@C@A@@@@AA@@@A@@@A@@A@@@A@@AA@@@AA@@@AAA@A@@@AA@@@@@ABA@@B@@@@A@@@@B@BABADA@ABABABABCB@@A@A@ABA@ABAHADADAD@FADC@@@ABAB@@@BA@@BADEBA@AB@DCBAJEBALGDC@@BABAB@DAB@BAJ@B@B@@@BAB@DAB@DA@@B@BADAFCBA@@BABABA@AFE@A@@BA@@DAB@FABAB@D@BADAD@D@BA@ABADAFABABBDAD@DABAB@@@DAF@DADAB@B@JEFADA@C@@BA@@@ABA@A@A@@BABA@@@@@AB@@@@@@@@@@A@@@@B@@@@B@@@@B@@@@@B@@@@@@@@@@@BA@@B@@AB@@@@@B@@A@@B@@@@@B@@@@@B@@@@@@AA@@AA@@@@A@@A@@@@@AAAACA@ACAAAAACA@@AAAAA@AC@@AA@@AAA@AAA@A@AAA@AAAAAAA@@A@@A@@AB@@AA@@@@AA@@@A@@@A@@AA@@@AAA@@AAAAAA@@A@@A@@A@@@AB@@A@A@A@@@@@@@AA@A@CA@@C@@@@@A@AACAA@A@@AA@@@@A@@@A@@AA@A@@@A@@@@@A@@@A@@BA@A@AB@@A@@@@B@@@BA@@@@B@@A@@@A@A@@BA@@@@@AB@@@BA@@@A@@@A@@BA@@@A@@@@BA@@B@B@@@B@@@@@@@B@@@@@B@@@@@B@B@@@B@@@B@@@B@B@@@@@BA@@@@@@@A@@@A@A@@@@BA@@@@@@@@@A@@B@@@B@@AB@@A@@@A@@@A@@@@BA@@@AB@@AB@@A@@B@@@@@@@B@@BB@@@B@@A@@@@@A@@@A@@@@BA@@@@@@@ABA@@@@B@BAB@@A@@@A@@@@A@@A@@@@AA@@@A@@@AA@@A@@@@@@@A@A@@@A@@@AB@@@DCBA@C@@B@@A@@@AB@@@BA@@@@BA@A@@B@@A@@@@@A@@BA@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@A@@A@@A@@@@BA@@@@@AB@@A@@@A@@@AB@@@@BB@B@@B@@@@B@@@B@@B@@B@@@@@@@BB@@BA@@BA@@@A@@@AB@@@AA@@@A@@@@@AB@@A@@B@B@@@@A@@@A@@BA@@@AB@@@BA@@@A@A@@@A@A@@@A@@@A@@@AB@@@BABAB@BA@ABA@@@A@A@@B@B@BAB@B@B@B@BB@@B@@@B@BAB@@@B@B@B@BBB@@@BB@@BBB@B@DBB@@@@@BAB@@@@@D@B@B@@BB@@BB@@@@B@@@@B@@@B@@@B@B@BAB@@@BB@BB@B@B@D@D@@@BAB@@@B@@@BA@@@A@@@@@AB@@@@A@A@@@@@A@@@AB@@AB@@AB@BA@AB@@@@A@@@@@A@@BA@@@AB@@A@@@AB@@@B@@@B@@@BCB@@AB@B@@@@@@@@A@@@A@A@A@A@A@A@@@@B@B@B@B@B@B@B@@@BAB@B@B@@@B@@AB@BA@@BA@@A@@AA@@AA@@@A@@AA@@@A@@@@AA@A@AAA@AAA@A@AAA@@AA@@@AAA@A@A@AAA@A@@@A@@@A@A@@AA@@@A@@A@@A@@@AAA@@@A@A@@@AA@@A@A@@@A@@@@AA@@@A@@AA@@@A@@@A@@@A@@@A@A@@@A@A@@B@@@@@@@BA@@@@B@@A@@B@@@B@@@@A@ABA@@@@@A@B@@B@@@@AB@@@@A@AA@@A@CB@@@@A@@@A@AA@@"],encodeOffsets:[[128749,44125]]}},{type:"Feature",id:"220381",properties:{name:"公主岭市",cp:[124.822929,43.504676],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@CCA@@AACAA@@@A@A@@@ABA@@AAA@AA@@CA@A@@A@@AA@A@A@@@AA@@@@@A@@BA@@@AA@AA@@AA@@@A@AAA@AB@@A@A@@AA@AA@AAAAAA@AA@@A@A@A@ABABC@ABABCBEBABABC@@@ABA@ABABADEBABADCDADCFCDCDABAB@@@@C@@@CAACA@AAAA@@@AACAEACCGCAAA@@BA@ABAB@@ABCBABCB@B@@GHA@KJURGFIHABABA@A@E@@@C@AB@@A@ABABABCBABA@AB@B@BAB@@@BCD@B@D@B@BBB@DB@B@BB@@@B@B@DA@ABABAB@@AB@BA@@BABAB@DBBBDB@BBD@B@B@B@DABBB@@@FDDB@@D@BBB@D@BB@@BB@DADABCBABA@ABCBABAB@@ADA@CDA@AB@BA@@F@BCBCB@D@B@BA@AB@BABABAB@BCBA@@BA@A@ABC@A@C@@BA@CDAD@@@DAB@B@BA@@B@B@BAB@B@BAB@B@F@B@BB@@B@@DF@BBBB@BBBBB@BBB@B@B@@BBDA@@BAB@BAB@@BB@B@@@BD@FDBB@FBBB@@B@B@F@BA@@B@BA@ADAD@@@B@B@@A@@B@BAB@@@FABADA@ABA@A@A@@BABBD@B@@BB@B@B@@@@@B@@ADAB@BAB@BAB@@AB@BAD@@@DB@@B@@DDAJ@D@BAB@B@BAB@@A@ABABA@@@CB@@EBABA@ABCB@@A@@BCDAB@@AB@BAB@BAB@@@BABAB@B@B@BA@@B@BA@@B@B@BAB@D@@ABBBAL@B@BABABIHA@AB@@@BEBCD@@@DADAB@B@BCBAB@@AB@@@BABADABADAB@BABABAD@BAB@B@@AB@@@BAB@@@B@@ABAD@BA@@B@BABA@BDCD@B@@AB@BA@@B@@AB@B@@@BA@ADAD@BAD@BADABCHCF@BADAFAJADAF@F@@BDDB@BBBB@BB@BBB@B@@FHBR@DBD@B@B@@@BB@@BBB@BBB@@BB@@@@@BDD@D@HAJ@F@J@LABCFCDCBAF@BA@AFEB@BAFADAH@FAL@H@F@BB@@B@B@@BBBDBH@DAHCFAFIVCF@DEHADEFCDCBGBEBIB@BABA@ABABA@ABAB@@GBABABA@A@ABA@ABCBABA@EBEBEB@@CBA@CBA@A@ABA@A@A@ABA@A@A@@@EDABCB@B@@ADGHAFAB@@ABADA@@B@BCDABCD@BAB@@EH@BCBABABABCBE@C@@BC@CBA@A@ABA@@BABABCDEBABAB@@AB@DBB@@@B@BAB@@ABABA@@@CBABABA@A@ABABCBEB@B@BABC@AAABCBA@CB@@AAC@AAA@@A@AA@A@A@C@A@C@A@AAC@@@C@@@CCA@C@A@@@C@@@A@CBA@A@A@@BMBKFIBGD@@@B@B@BA@GBEAA@AA@BA@A@E@@@A@@AC@A@A@A@C@AA@@C@@@A@AAA@A@C@AA@@C@A@A@G@A@@@CBC@CBA@@@C@CBCBE@A@@@C@GBEBC@A@E@CBABA@A@@@A@A@A@ABA@A@@@A@A@A@AB@@A@A@A@ABA@@@A@A@@@A@ABA@A@EBA@E@C@ADA@@@CAABGAC@A@A@A@A@AA@@C@A@C@A@C@A@CA@@A@A@A@A@CAA@A@A@A@AAA@A@@@A@A@A@A@AA@@A@A@A@A@C@AAA@A@C@A@AAA@C@C@C@C@C@CAE@A@C@C@E@A@A@CAC@E@E@C@A@E@CAC@C@C@MAK@E@I@@@G@G@E@EAC@E@CACA@@AACAABAAA@BA@GBCBC@CA@MAAA@AFK@A@@CACCA@A@AAAA@@@A@@EOCE@A@@@AAAAACA@@CAC@GBE@CACECAA@C@IDC@AA@@A@A@@@@@EA@@AACBA@CBA@A@@@AA@AAA@@@AAACAEBC@E@I@CBCBCHA@AAECACC@C@E@@@EDC@EAAAEDEBCBEDCBCBEDABCB@A@@A@@AA@@AA@@@GD@@AD@B@@@@BB@@@BBB@BB@BB@DBB@@@BB@@B@@@B@BA@@@AB@@AB@@@B@@B@@@@BBBB@@@BB@@@@@B@@@B@@@B@@@B@B@@AD@DA@@B@@A@@BABAB@@A@@BA@ABA@AB@@AB@@@@A@@@AA@B@@ADAB@BAD@B@BAB@DAB@@AD@@@BAB@DAB@@@@@@A@EAC@IAAA@B@D@B@@C@GAEAC@@@@@AD@@@@BB@@@@B@D@B@@BBB@BBDBB@B@BB@B@@@ABCBED@@BB@BBBBBBB@B@@@@@BCBA@AB@@ABA@C@A@A@A@@B@@@@@@B@B@@@ABAD@@@@B@B@@@DBB@@@@@@@@B@B@B@B@@@@B@B@@@D@@@@@@A@A@@B@B@B@BAD@@@@@AB@D@@@BB@B@@@@D@B@@B@B@F@@@@B@@@@@D@@BBB@D@B@B@@@D@DBD@B@D@B@@B@BB@@B@B@@@B@B@@@B@@BB@@AB@B@@@@A@ABA@@@@@A@@@@@@B@B@B@BB@B@A@AB@@ABAB@@@B@@@B@@@D@@@@@@A@A@@@@B@@@B@@@B@@@B@@B@@DAD@@B@@@@@@@@@@@AD@@ABCDEHHDDAFCFA@@@A@@@@B@DC@@DAB@@A@@BB@B@@@BBB@BBB@@BB@BBD@BBD@BB@@B@B@@BB@B@B@B@@@@AB@DAB@BABAD@BA@@@ABAAA@A@@@@BB@@@@B@@@@BB@B@@@@@@@@@@A@@B@@A@@@@B@@B@@B@@@@@@@B@@B@@B@@@@@@A@@@AB@BA@A@AB@BEBABAB@@@@@B@@ABCBAB@@@BAB@@@D@@@B@B@@@@@B@@@@A@@BA@@BCD@B@BAB@@A@@B@@A@@@@@@BA@@@@BCB@BADCD@@AB@BA@AB@BCDADABABABABADABABAD@B@BAB@D@BADAB@BAB@@AB@BABAD@BABAB@DAB@BAB@B@DAB@BAB@BAB@B@BAD@B@B@B@BAB@D@BAB@B@BAB@BAB@B@@@B@@@B@@@B@@@B@@@@@B@B@@@B@@@@DB@B@@@@BB@@@@@B@@@D@@@@@@B@@B@@@@@@A@A@@B@@@@@@@@AA@@@@A@@@A@@@@@AA@@@@A@B@@BA@@@BB@B@B@B@B@B@B@@AB@D@@@@B@BBD@D@DBH@H@FBD@D@H@NBH@B@D@FBF@DBDDDBDD@@@@@@@B@BB@BB@BB@BBB@DBB@@@BAB@DAB@B@H@@@BAB@B@B@@@B@FADAFAD@FAHAD@H@FAD@B@B@B@B@B@B@@C@AB@@@B@D@B@BAB@@ABA@@B@BB@BB@DDB@@@B@B@DBD@BBPBHBBADCDCBCBABA@C@A@@@A@C@AA@@AB@FCB@D@D@F@FAD@BADAHA@@A@@A@@AAAA@@@A@@@A@@@AB@@@DFDF@@@@BEHDBBBBDB@@B@B@B@DBD@D@@B@@@@B@@@B@@B@@@@@@B@@@@@B@@@@B@@@@B@@B@@@@DCDCBABC@@@@@@@B@@AD@DBB@D@@DC@A@@BIB@B@B@B@B@@DFBBCBEBB@@B@@@B@@B@B@@@@B@B@B@B@@@@G@A@A@A@@@@@@@A@@D@@DD@B@@FA@@FD@@@F@B@@@B@@@B@B@B@AIF@@@@D@D@B@BBB@@@B@@@@@B@BADH@B@F@D@B@B@BBB@BA@@@@@AA@@AB@@AB@BAB@D@D@D@D@B@BAD@BADADA@@BBB@D@F@FBF@B@BAB@B@B@B@@AB@BAHDDBB@ABABA@ABB@@@B@@@B@@@@B@@@@AB@B@@B@BBBBBB@@@@@@@@BBABCDA@B@@B@@A@@B@@DAD@B@BAD@B@@@D@CBADCB@BB@DCB@@B@AB@@@@@@@B@@AB@@@B@@B@AB@BB@@D@DBDBD@BB@@B@BABBB@@@B@B@@BAF@BB@BABA@@@AB@@@@@B@@@B@@A@B@@AD@@@B@@@@B@@@@CBAB@B@@@@@@B@B@DB@@@B@@@@@@@@D@@@@BABA@@DBD@D@@@B@@@@@@AA@@A@@@@B@@@B@@@@A@@@A@@@B@@@@B@@@@@AB@@@B@@B@@@@@BA@@@DA@@@@@@B@@@@@@@BB@@@@BB@@@B@@@B@@@@@B@B@@@BBD@@@@@B@D@B@BB@L@BBD@@@@B@@A@C@CB@@A@C@AB@B@B@@B@@@D@@@BB@@@B@@EB@B@BB@@@B@@@DBDB@B@@EBA@@B@@@@@@B@BADB@@@BC@ABA@@BBB@@@EN@B@@@@B@@@@B@@BB@@B@@BA@BBB@@@@AB@@@DC@A@A@@@@@A@@@A@ABA@A@@DBBBDB@BB@@@DBB@BBB@B@@@B@B@BB@@BBBB@@BADAB@B@@@BB@@DD@B@@BB@@DD@B@@BB@BGDBBB@DABA@@@@BB@@@@BBA@CB@B@@@@B@@@DC@@@@B@@@@@ABCD@@@@@@@B@@BADA@@@A@@@@B@@B@@GF@@@@@@BB@ADABA@@BD@D@B@B@@AB@@@DB@@@DBDD@@DBB@@@@@BABEDBDBDBDB@@@B@FFBJF@@B@@A@@B@@@DBB@BB@AB@@ABAB@@@@@@@@BAB@B@@@@B@@@B@@CBA@@@@@@@FAD@@A@ABB@@B@@BBDBBBB@BDBBB@@BB@BBBBBBBB@@DDBBD@BBDDB@DDD@DBFDB@@BB@B@D@@B@B@BAF@@A@@@@BA@@@@@@@AB@@@@@B@@@@BB@@BBBB@@BBB@B@@@BBFBDBB@DBB@D@B@BBB@BBD@D@D@FBF@D@B@D@B@BBB@BB@@DAB@DA@@B@BBB@@@B@BAD@@AB@@ABAB@B@BA@@B@B@@B@@BB@@@BB@B@@AB@B@B@BBB@B@B@B@B@BB@@B@@@BA@@B@@@B@B@DBBA@@D@B@@@@@@B@@@@@BA@AB@@@@@B@@B@@@@@@AD@B@D@D@B@B@BBB@@@DBB@F@B@F@F@DBBA@@@@@A@@B@@@@@B@@@@@B@@@@AB@@@@@BBB@@B@@@@A@BBBAD@DBB@B@DBD@L@B@@@B@@A@AAAACAC@@B@@@DFBD@@@ABABA@@@@@BBB@@@BBBB@@@D@F@BDB@@A@ADADAD@DA@BABAF@BB@BBB@D@BBB@B@@@BB@@BBB@BB@@B@@@BB@@ADBBBB@A@ABC@B@HBBBI@@DBJFJDDDD@B@BBHBD@B@D@FB@@DBHBDBBBB@B@BBB@DB@@@C@@D@BB@BH@@CF@@C@AB@BBJDBNFB@D@@@BB@@@BA@@@@B@BBB@@@@@B@@AB@BABA@@B@@@@@@@@@B@B@BA@@BAB@@@B@@AB@BA@@BA@@@AB@@@@@@@AA@A@@A@AA@@A@@AA@AAA@@@AA@@A@@@A@@@@AA@@AA@@@A@@@@@@A@A@@@A@@B@@@@@BA@@@@A@@@@@@@@@B@@@@A@@@@@@@@@@@@@A@@@@@@CAAACAA@AA@@@@@@@A@@@@@@B@@@@A@@B@@@BB@@B@BBBB@@@AB@@@@@DB@@@@@@@BA@@@@@@@@@BBB@@@@@B@@@@@@@AA@@@@@@@@B@@@@@@@B@B@@@@@BBB@@B@@@@B@@@B@B@B@B@@@@@@A@A@@@A@@@A@@@@@A@@B@@@@@BA@B@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@A@@B@@@@@@A@@A@@A@@BA@@@@@@@@@@@A@@@@B@@A@@@@@@@AB@@A@A@C@@@@B@B@B@@B@@@@@B@@@@B@@@@@BA@@@@B@@@@AAAA@@A@AA@@A@@@AA@@AB@@A@@@@AAA@@A@@A@@AA@@@AAA@@AA@@A@@@A@@A@@@AA@@@@A@@@AA@@@@A@@@@A@A@@A@@@@A@@@@A@@@@@@A@@A@AAA@@@@AA@A@AA@@@@@A@@@ABABA@ABAB@@@@@B@B@B@@@BA@@@A@@BA@@BA@@@ABAB@@ABA@A@@B@A@@@@@AB@@AB@@@BA@@@@B@@@@@@A@@@@A@@B@@A@@B@@A@@@@BA@@@A@@@@@@@@@@@@@@@@A@@B@@@@AB@@@@@B@@A@@@@B@@A@@@@@@@@@@@@@AB@B@@@@@@A@@@@@A@BA@@@A@@BA@@@@BA@@@A@@B@@AB@@@B@@A@@@@@A@@A@AA@@@@@@@AB@@A@@@AA@@A@@@A@A@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@AA@@@@@A@@BA@@@@@A@@@@@@@@B@@@@A@@@A@@@@@A@@@A@A@@@A@@@A@@@@BBB@@B@@BBB@@@B@B@BBB@@@B@@@@A@@@AB@@A@@@@@A@@B@@@B@@@B@B@@@B@@AB@@@BABAD@BAB@@@B@@@@@@@@B@@@@@@@@BA@@@ABAB@@AB@BAB@@AB@@@BAB@B@BA@@BA@@@@@B@@@@@@@@B@@@@@@AB@@@@@@@@@@@@B@@@@@@@@@@B@@@@AB@@A@A@@BA@@B@BAB@BABAB@B@@A@@@@@ABA@A@@B@B@B@B@B@@@B@@@B@B@@@@@@@BB@@@@B@@@@@BB@@BBB@@BBB@@B@@@@BB@@@@@BBB@@@BBB@B@B@@@@@@@B@B@B@@@@@@@BBB@B@B@@@B@B@B@BB@@B@@@H@D@D@B@B@B@BA@@@AAAB@BCBABABABA@@@@@A@@A@A@AAB@BA@ABA@@B@BA@A@@@@DBD@@@BABA@@BA@@@AA@@@AA@@B@@A@@@A@A@A@AB@@@@A@@B@B@@@@A@@@AAA@@@A@@D@B@DBD@BBB@B@HBH@BFF@@@@@@ABC@A@A@A@AB@@AA@@@@@A@@AB@@A@@B@BAB@@@B@@@B@@@F@FAD@BAD@D@B@BAB@@@D@D@BAB@D@BABAD@BAB@DAJCB@BAB@D@BAD@B@@@AC@@@A@@@@@ACAAAA@@@AAA@@AA@AA@@AAA@AAA@@@@@A@@AA@@A@@A@@@@@A@@@@@@@A@@@@AA@@A@AA@@@@@B@BA@@@@AA@@A@@@A@@@@AA@@@BA@@@@@@@BB@@@@@@@@@BA@@@@@AA@@@@@@@BA@@@@BA@@BBB@@@@A@@@@A@B@@A@@@@@A@@@@@@@A@@B@@@@A@@@@@@B@B@B@@@@@@@@@@A@AA@@@@@@B@@@@AAA@@@@@BA@A@@@@@@A@@@@@@A@@@@A@@@@@@A@A@@@@@AA@@@@@@AB@@@@AAA@ABA@A@C@@@A@@BA@@@@DAB@BA@@BA@@@A@@@@B@@@@@B@@@@@@@@A@@@@@@B@@@@@B@@@@@@@@@@A@@@@@@@@@AB@@@@@@A@@A@@@A@@@@@A@@@@A@@@@@@@@@@B@@@@@BA@@@@@@@A@@@@@@B@@@@@@@A@@AB@@@@@B@@@@@B@@@@@B@@@BAB@@A@@B@@@@@@@@@@A@@@@B@@@@@@@@A@@@@@@B@@@@AB@@BB@@@@@@@B@B@@A@@@@@@@@BABA@@B@BB@@B@B@@@B@@B@@@@@@@@B@@@@@B@@@@A@@@@@@@@@A@@@AB@@@@@@BB@@@B@B@B@@AB@@@B@@A@@@@@A@@@@B@@A@@@@@@@A@@BA@@@A@A@A@A@A@@@@@@@@@@A@@@@@@A@@@@@A@@@@@@BA@@@@@@@@@@@AB@@@@@@A@@B@@@@@@A@@@@@@B@@A@@@@@@@@@AA@@@@@@@@@@ABA@@BA@@@@@AA@@AA@@@AA@@@A@@@A@@@AA@@@AA@@@@AAA@@A@@@@@@@@A@@@@AA@@BAA@@@@@@@@BA@@@@@A@ABE@@@AB@DG@@B@@@B@B@@@JBCH@@B@B@FB@AFB@BDBA@BB@@B@@@B@B@DBDB@@FABBFD@@B@BADAB@@B@B@@B@@@@AB@@BABA@AD@@@@B@@@DBFEBA@AB@@@@B@@ABAB@@AB@B@@@@B@F@HA@@@@BA@ABAB@@@B@BBBB@@AB@B@@@@DBB@@@@A@@B@@@@@@@@@@B@@@@B@@@@@@ABA@@@@@@B@@@@B@@AB@B@@@@@@@@@@BA@@@ABA@@B@B@AB@@@B@@F@@@BBB@@BA@@BBBB@@ABC@AB@B@@BB@A@@BABAD@@@BABAD@@B@@@@@DE@AFE@AB@@@B@@@@BA@ADADB@@@@B@BCFA@@BB@@B@@B@@AB@BBB@B@AB@B@@DDB@@@@@@ABCB@B@@@@BB@B@BCPOHGRABBD@F@@@@A@@@A@@@@C@C@A@A@A@@A@@@@B@@@H@D@@@@@@AC@E@@@@A@@B@B@J@ACL@B@@@B@@@BA@A@@@A@@@A@@B@B@DCBABADA@@B@DBBBB@D@B@BAB@@ABADCDGDCBA@@@AA@CAA@A@@@AAA@@AA@@@@@@@@A@@@A@A@@RD@@BCBABAFIA@@@EAE@CAA@A@A@C@AA@@@C@A@@BA@A@ABA@ABA@@@@@A@@DADADAB@BABC@ABA@A@@@@@A@A@@@A@ABA@A@@@@AA@@@A@A@@@A@@@@@A@@A@@@AAAAA@@@@A@@@@@@AA@@A@@A@@@@@A@@@@@@B@@@@A@@@@@@@@@@@A@@AA@@@@@@AA@A@@@@@AB@@@@A@@@@@@@@@@@@@AA@AB@@@AA@@@BC@A@A@A@@A@@AA@CC@AA@@@@@BAB@@A@@@@@@@@AA@@@@@AB@@A@@@@@AB@BA@@@@@A@@@@@A@@@@B@@@@@@AB@@@B@@@@AB@@CQQBIBIOaFI@GHKAK@EJM`ENC`@bD`DBEbPB@@@BA@@B@@@B@@AB@@@BA@@D@HCNE@A@@AA@@@A@@A@@AAAJCDADCBADGDC@@B@@@@@B@@@@@B@FC@AB@B@BB@@B@B@DABAFC@@BABA@@B@B@B@DABA@AB@@BB@@B@@BB@@@@B@@@@@BAB@@@B@B@@@FD@@@@BAB@@@B@@A@@@@B@@AB@B@BADABAB@BAB@@@FADAB@F@BA@@B@@A@@DAB@@ABAB@DC@@B@BA@@@@B@@@@A@@B@DADADAB@BAB@BA@@B@@@@@BAB@@@BA@@@@B@BABADAD@BA@@BA@@@@@AA@@AC@AAGC@@A@@BEF@@A@@A@CBA@A@AE@@@@A@@BEBI@E@@B@B@B@B@B@BA@@BA@@BA@@@@@@BAB@@@B@@@BA@@BA@@D@@@DAB@@A@@@AA@@@@A@@BAD@@@DCBA@A@@@A@@C@A@CAIAA@@@@@AA@@OCC@GCA@A@A@AAA@A@CAA@A@A@C@C@A@E@A@A@CAC@C@C@GAC@A@@@E@A@CAA@C@EAC@EAA@E@CAEAAAC@AAA@AACAA@AAC@C@CAC@A@EAA@@@A@A@A@@@A@@AA@@@A@@@A@@@A@@@A@A@@@B@@BA@@@@@@BA@@BA@@B@@@AA@@@@AB@@@@@@A@@A@@@@@A@AAA@C@AAA@A@CAE@AAA@@@@@@A@@@AA@AA@@@@EAA@A@@@@@A@AAA@C@A@@AC@A@A@AAC@A@CA@@GAC@A@@AA@A@AA@@A@AA@@A@@@A@AAAAA@@@A@AAA@@@A@AAC@CAC@A@A@A@A@AAA@A@A@A@@@@@A@A@A@A@C@A@AAA@A@A@@@@@A@@@A@@@AAC@@@A@EAEA@@A@@A@@A@@@A@A@@@AA@@A@@@@@A@@@A@@@AAA@A@@@AAA@A@A@AAC@@AA@A@CA@@AAC@@@AAA@CAA@A@@@AAA@CACAGAGCGCA@CA@ACAA@A@AACAECCAAACA@@@@AAAA@@AA@@AA@AA@@@@AA@@A@@AA@@@@@AA@@AA@@A@AA@AA@AAA@@AA@@@A@@@A@@A@@@A@ABA@@@@A@@@A@@@@@@A@@@A@@@@@@@@A@@@AA@@AB@B@@@BA@@@A@@AA@@AB@@A@A@@@AA@@BA@@BA@@BA@A@@AA@@AAAAA@A@A@@AA@@@@BAB@B@@AB@@A@@B@A@@A@@@A@A@@@A@@AAB@@BB@@@@@@A@C@@@A@@AB@B@@A@@AAABA@@BA@@@AA@@A@BA@@BA@@BA@@@@@AB@@A@@@@AA@@@AB@BAB@BADA@@B@@@B@@A@@CC@ACA@@AA@AAAA@@AAA@A@@@@A@@@CCACAAA@CA@AAA@A@A@CCAAAA@AAA@@@EAEAEAAA@@A@B@@@@@GAAAA@CAA@@@A@C@AAC@CAAA@@A@CA@@A@CA@@A@A@AB@@AAC@A@AAG@AAA@CAA@A@A@@@A@CAA@CAA@AAA@CAC@EAAAA@ECEAAAAA@@AA@A@@AAAA@@AAAAAAA@AA@@AACAA@AA@@@@@@B@B@BA@@@@@A@@B@@A@AA@@A@C@A@@@ABA@@@@@A@@B@@@@@B@@@B@@@@@BAB@@A@@@@B@@@B@@@@A@@@A@@BAB@@A@@@@@A@@@AB@B@BB@@@A@@@ABA@@B@BBB@@@@@B@@@@@@A@@B@@A@@@A@A@@@@@@@@@@@@@A@A@@@@AA@AAA@@@@@@A@@@@AAC@@@@@A@@@@@@@@BCBA@A@@@@BA@@A@@A@@@@@AB@@@@@B@@A@@@@@AB@@@@@@@AA@@@AA@@A@@@A@@@@AA@@@@CC@@@A@AA@AAAA@@A@@@AAA@@@A@@@A@@@@@@AA@@@@AA@@A@@@@@AA@@@A@@@@A@@A@@@AAA@AA@@A@@@A@A@C@@@AB@@A@@BA@@@@@A@@@@@ABA@AA@@A@AAC@A@AAA@A@@@AAA@@@ABA@AA@@AA@@@A@AA@@AAA@@@A@@@AA@AAAA@AA@@AA@AAA@AA@A@@AA@@BA@BB@@@B@D@B@@AB@B@@@@@B@@A@@@@@A@@B@@A@@@AB@@C@A@C@C@AB@B@BAB@BADCBABA@@BA@@@@BCBA@@@AAA@A@C@@@A@A@@AA@@@A@@@@BAB@B@@@B@@@@@@@@@@A@@@@@AB@@A@@B@@@@@@@A@@@@A@@B@@@@AAA@A@@@@@@@@@@@@@A@@@@AA@@@A@@@@@A@@ACB@@@@@@@@A@@@@@A@@@@@@B@DAB@B@@@BA@@B@@@B@@@@@B@@AB@@AB@@@B@B@@@B@@A@@B@@@BA@@@@B@BAB@B@@@B@@@@AB@@@@@B@@@@@@@B@B@B@B@BB@@B@@@@B@@@@BA@@B@@@@AB@@@@@B@@@@@@A@@@@@@@@@A@@@@@@@@@A@@B@@@@@B@@@@@B@@@@A@@@@@A@@A@@A@@@@@A@@@@@AB@@@@A@@@A@@@@@A@@@@AA@@@@AAAAA@@@B@@AD@AA@@@@@@@@@@@A@@@A@@@@@A@@@@B@@@B@@@@@@@@@B@@@B@BBB@@@B@BA@@B@@@@@BA@@@ABA@@@@@A@@BA@@BA@@B@@@B@B@BAB@@@A@AAAA@@A@AC@A@A@@CCAAAACAAAIEACA@AAA@AACAECCCCACACCCAAAC@@ACACACA@AA@A@CAC@CAC@A@A@A@CAABA@C@C@CACAAACA@A@A@A@ABA@ABABCBA@ABAAA@A@C@@AA@A@AAAAA@ACCACAACAAAAA@A@C@AAAAA@AAAAA"],encodeOffsets:[[127849,44286]]}},{type:"Feature",id:"220382",properties:{name:"双辽市",cp:[123.502723,43.518302],childNum:1},geometry:{type:"Polygon",coordinates:["@@BBD@HDD@DBB@B@B@B@@@BB@@B@@@B@BB@@D@DBFBFBB@B@BBB@@@B@@@B@BBB@B@D@B@B@@@@@B@@BB@@@@@B@@B@@@@@@@B@@BBBB@@BBB@@BB@@@B@B@B@B@FAdEPCD@LANC@@@@F@HALCHAHAHAPCFADAH@BAD@@@HADAFA@@@@@BB@B@BB@BB@@D@@@DBB@@@@ABB@@B@BB@@B@@@DB@@B@@@BB@BB@B@@BBA@@B@@@B@@@B@@@BAB@BA@@@ABA@@B@@ABEDCB@@@BA@@BCBCD@@A@A@@@A@A@EA@@A@@A@A@@@@@@@@@@@AC@AAA@A@@@@@ABAF@@ABCHADCFAB@DA@CF@BAB@B@@F@AFDLAFA@@@@@@@@B@@@B@@@@@@@BB@@A@@B@@AB@@@BA@ABA@C@A@@BABADA@AB@@@B@BAB@BBB@@@B@@@BBB@@@BA@@@@@A@@A@@A@@@A@@B@BA@@B@B@B@BA@@B@BAD@@@FAD@B@B@B@FABA@@B@@AB@@A@@@AA@@A@@BC@@@AB@B@B@B@BBDB@@BB@BB@BB@@D@BBB@B@BA@@B@@AB@@@B@B@BBB@B@B@B@B@BAB@D@D@BBB@B@@@DBDBB@B@B@@@BBB@DAB@@@@@B@@BB@@B@B@B@B@B@B@DBDBB@BAB@@ABABA@CBAB@@@B@B@@DBB@DBBB@@@B@BA@@BABA@AB@@@B@@B@DBB@B@@@@B@@ABA@ABA@@@BB@@@@@BB@@@B@B@BBB@B@B@@@B@B@@@@@B@@@@B@B@@@@B@@@B@@@B@B@B@B@@@@A@@BA@ABA@@B@@@B@@@B@@BA@@BAB@@@@@BB@BBD@BBB@@@BBBD@@@@AB@@@@ABA@AB@@AB@@@B@B@@@D@@BB@@@@B@B@@@B@@@BB@@A@@BA@A@@@A@@B@@@@BBB@BB@BB@@BA@@@@@@BC@@@A@@@AB@@BBBB@B@@@B@@ADA@A@@BA@A@@@@BA@@BBB@@@B@@AB@@AB@@@B@B@B@B@B@@ED@@@BA@@B@B@@AB@@B@@B@BA@A@@BA@@D@@@@AB@@A@@@@B@@@BB@@B@BB@FFB@@B@@@D@@@B@@@@@@@@@@@B@B@B@@@B@BA@C@@@AB@@@@@B@@@B@@@@@BA@AB@@@@@BB@@BBB@B@B@@@@@BA@A@@@@@@A@@AA@@@@A@@@A@@B@@@BB@@BB@@@B@B@@@@@@@@@@B@@A@@@A@ABA@@B@@@@BB@B@@@@@BAA@@A@@@AB@@@B@@@BA@@@A@C@A@@@@BA@@D@@A@@@AB@@@@@@@B@@AB@@A@@@@@@D@@@@AB@BA@@BAB@BA@@B@@A@AB@@B@@B@@B@@B@@@B@@A@@@AA@AA@@AA@A@@AA@AAA@A@A@@BA@ABAB@BA@@BCBADAB@@@@@B@@@@@B@BAB@B@@B@@@@B@@@@@@B@@@BB@@@@@@@@@BB@@@@@@B@@@@@@ABB@A@@B@D@B@F@BBD@B@D@D@BHDB@@B@B@BAD@@B@@@D@B@HBB@AH@@@B@B@B@B@DAJA@@BA@ABAD@@@B@DIB@AA@AA@@CAAAE@DB@@BB@@@@BB@@@@BBA@CBAB@BAB@@ABADABAB@@@B@@ABAB@B@B@@B@@DAD@D@B@DC@C@E@AB@B@BA@A@AB@@AD@B@@@D@@@B@D@BBBAB@B@D@D@B@@B@@B@@B@DD@@DBAB@B@H@B@D@BBB@DABABEBAD@BBF@@@F@BCB@@@BABAD@BC@@B@@@D@@B@@@CDA@CDEAA@C@A@E@AF@@AFAHA@@BAB@@BD@@ADAD@@@@@@BBBB@B@@@@@BAB@@@B@@ADADAFEHGL@@@@AB@D@@@F@B@@@FGB@AEAADC@@@@BE@ABADADABBD@BC@A@ABC@C@@@@@ABAF@B@@AB@BAB@@CHCHCACA@@GAADE@@@AA@@@A@@A@A@@BCHADAB@@@B@@@@A@@B@@@BAB@BAB@@@@AB@@CDCDH@D@A@FDFFB@R@@@BB@@FBB@@@@@FBDB]XQPCB@@CD@@ABABCB@@@BA@@B@BABAB@B@B@BAB@BC@A@AB@BABA@CBABCBCBEBCBGBCBCBGBCBCB@@@@@@A@@BA@@BABABAB@BB@@@@B@B@@@DA@@@@B@B@@@B@@AHG@CL@B@@B@LDFBBB@@@@@@AD@@AFABF@@@BB@@CDDB@@B@@@@BA@ABEFhFB@@@@AB@BBB@@@B@@@B@@@B@@@BB@@B@@@@@B@@@@@@@@@B@B@BAB@B@@@D@B@@@B@F@BA@@@@B@FAF@FAD@FAF@B@D@DADAD@DA@@B@@B@D@B@BDBD@BBBBB@@@B@@DD@DBF@H@B@D@B@BA@AB@B@BA@@BBB@@@B@@AB@B@BBB@@BBAB@B@@@@B@BB@@@D@B@D@@@BA@@B@D@F@B@@BB@@B@@BBB@B@B@BAB@B@B@@@B@@@@B@B@@@@@@D@B@B@@@F@HBF@DBB@B@BB@@B@B@JAB@BAD@F@D@D@D@HAD@HA@@DBLAT@TAP@JAB@BDBBDBDBDBDBD@FBFBDBD@DBD@F@D@BBD@DAD@B@D@D@B@DAJCPCJCFAH@FAF@D@DAL@D@F@D@DBBBDBBDBDB@BBBBBBDBDBD@DBBBB@@B@DBD@D@DBD@DBBABB@@BB@BBD@BBF@D@B@D@B@B@D@D@B@@@BA@ABA@CDE@CBCBAB@BCBAJCBADAAA@@CAAA@A@C@A@@@ABABCFCBADADC@@DCDADCDADCBADA@A@CBC@ABABA@@B@B@D@ACAAAAAA@@@AB@@ADABA@@HCAA@@@AB@@AHEBBBBD@B@BAB@D@BBDBB@B@BABADABAB@BB@@BBD@@@@@B@@@FE@@@@@ABAHGBAHGBCFCAA@ACAAAAAEC@@@A@A@A@ABABACAGCDABADADAB@BA@@D@@@RGREzS\\BBFAVAB@dBH@FBF@H@FAZ@d@\\A@@D@D@F@LBRBlD@@B@J@FBD@D@@@H@FBD@@@@@@CBA@@@A@A@A@A@A@AAA@@B@@AA@B@@@@@BB@@@@B@@@B@@@@@BB@@@@@@@@@AB@B@@@@@@@@AA@@@@@@@@C@@@A@@@@AA@@@@@ACA@@@@@A@@@A@A@@@@@A@@@A@@@A@@@A@@@ABA@ABA@A@ABA@A@CBA@A@A@A@ABC@A@ABA@ABA@ABA@C@ABA@ABA@CBABA@ABCBA@ABA@@BA@ABABC@A@CBA@A@ABCBABABCBABABABABCDC@ABAB@@ABA@@DCBC@ADA@A@@B@@A@@@@B@@@@AB@@@BA@A@ADC@AB@@AB@@@@@@A@@@@@A@A@@@C@@BA@A@@BADABA@@@A@@@@BABAFA@ABAB@B@@ABA@@B@@@@@@@@AA@@@@A@@@@@@@AA@@@@A@@B@@@@AB@@@@@@@@@@@@AAA@@@@@A@@A@@A@@B@B@BBBA@@B@@ABCBA@ABA@CBA@@@@@A@A@AAA@@@A@AA@@AAC@AAC@AAA@@AA@AAA@A@@@AAA@@@BA@CB@@CDA@@@@@@B@@EBEDCBGCFGDCBA@@BC@@@@@@@@@@A@@@BC@CA@@@@A@@@A@@@A@@@A@@B@B@@@@@@@@C@@@A@@@A@@BABA@@BAB@A@A@@A@A@A@A@@@@B@@@@@B@BAB@@@@@@ABA@@AA@@@A@@@A@A@@@A@AA@@A@AA@C@A@C@CAC@@@A@A@C@A@AA@@@C@@@@@A@@E@A@A@@@@A@C@@A@A@@A@@@CBA@@@@C@ABA@A@A@@@@B@B@@@@C@@@A@A@@@@@@A@A@A@A@@@@@@A@CA@@A@A@@@@@BCBA@@A@A@@@@@@@@AB@B@B@D@B@BA@@BAB@DA@A@@@@@AAAAAAA@AAA@@FCDABA@@A@A@@A@AAAAC@AAA@AA@C@A@@@@@AA@@@@BC@@@@D@FBHBD@@@@A@C@ABBJBD@FBB@@@@@@@BA@CBA@A@@BC@@BA@CBA@A@ABC@ABABC@@@ABB@@B@@@@@BA@@BAB@BAB@@AB@@@BABA@AB@@@@AB@@CBC@@@A@A@@@A@@@A@@@A@@@@AA@@A@AA@A@@A@@@@A@@BA@@BA@@B@@A@A@@@AA@@A@@AA@CAAA@@AAA@A@@AA@@@@@ABC@@HC@@B@@BB@@BB@@@@BDABAFCDADAFCDAFAFCICCAEEGAI@A@C@A@A@A@@AAA@CAAC@A@CBEAAA@@@CAAEA@ACACAAAA@@A@@@A@A@@@AAA@CCC@@AAAC@CACCICA@AC@AAAA@@@@AA@@EAICGBGBGB@@A@A@AAI@GCAACC@G@A@A@@AE@@CAC@GBC@E@A@AA@A@C@C@@ECCCAABC@CAAEA@@@@GCKACAGAEAC@ACCGCAAAC@A@@@G@EAE@@@A@EAAAAC@ADC@A@C@ACA@@@AA@@A@@AA@@@@A@@AA@@AAAA@M@@@AB@@@B@B@BA@A@@@@@A@@@A@@A@@A@@A@A@@@AA@@@@BA@A@@B@@@@@@A@@@@@A@A@A@A@@@C@@@@@AA@@@@AAA@A@AA@@A@@@@@@A@A@@B@@AA@@@@@@A@@A@@@AB@@A@@@A@@@@@A@@@A@@A@@A@@@@A@@@@@@BA@@@@@A@@@@@@@@A@@AA@AB@@A@@@@@@@@B@@@@@BB@@@@B@@@@@@A@@@@@A@@@@@@@A@@@@A@@@@@@AA@@@A@A@@@@@A@@@@@@@AB@@A@@@@@@A@@@@A@@A@@@A@@BA@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@A@@A@@A@@@A@@AA@@@A@@A@@A@@B@@@@@@A@@@@@@@@A@@A@@@@@@@@A@@@@@@@@@@A@@@@@@@AA@@ABEBC@GA@@C@A@@@A@AA@@AA@CACCE@GCC@A@AAAACCA@C@EBC@A@AB@@C@CBA@E@AAA@E@EACCC@GBKF@@A@CBCAGCCAEBE@AAA@@@A@AACAA@IGACCCCCCC@ADKBECEACAC@CC@K@E@@@@A@@@AAA@A@AACAAIA@ABE@CAAGA@ABEBEAAECA@@A@ABAHAB@BAA@@A@@EC@@AA@A@@AA@@A@A@A@ABA@@ACAAAAA@AA@AACAA@A@CAC@A@@AAA@ABAB@BADADA@@BA@AAC@CAAA@@A@AB@@A@A@@@AA@EAA@@AACAA@AB@FCBAB@@AA@@@A@@@A@A@ABAAA@@AA@@AB@BA@AD@D@@@B@@AAAAAC@AAC@AAAA@ACACCCCAAA@A@A@CBA@A@@AA@@ABA@@BABAD@B@B@B@@A@@@AA@A@A@A@CBABA@A@@@C@CAA@ECAC@@AAA@@@ABA@@@AAA@@@AA@@@AB@BA@A@A@@@AA@@@CAAA@AA@BA@@BAB@BA@@@A@@A@CAA@A@A@A@@@A@@A@@BCDC@C@@@A@@A@AAA@AAAAAA@A@A@@BA@@DA@@B@D@B@BADC@A@@AA@@C@E@C@C@@@AAAA@@@A@CB@@AB@BAB@BBB@B@@@BA@@@@@A@@@AAAAA@A@A@@C@I@GAM@M@I@EAK@M@OAM@I@K@CAA@@@A@@@G@A@@@I@K@I@GBEDIDC@EDGBCBCD@@CBCFCDA@ADABCDEFABCDEFABAB@@@@ABABGHADA@ADGFGFABACEEEEEEEEKEA@A@@@A@CD@@A@AB@BCBADA@ABAD@BA@ABAD@@AB@@ADCB@B@@@BA@@B@@@BA@@@AB@@@@ABAB@@AB@@AB@B@@CLA@@BAB@@AD@@ADABAB@@AB@BA@@@ABABABA@@B@@O@@@A@AACAC@A@A@A@@@A@@@@@@BA@@@AB@BA@@@AB@@A@@AEoUAFI\\@@e`ADIL@PAHIPONODEJ{CcTCBNV@@BB@@@BBB@@BB@BBD@BBFBBBF@D@@EDABEDCBCDEBABEDCBCDA@@BA@@@@BC^"],encodeOffsets:[[126264,44596]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("松原",{type:"FeatureCollection",features:[{type:"Feature",id:"220702",properties:{name:"宁江区",cp:[124.86562,45.209915],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@AB@BABADA@AB@@ABC@A@@BAB@BA@A@@@@@A@@@@AA@@A@@@@ACA@@@@AAA@A@@A@@B@@@B@@AB@BC@@@@@A@@@@@@BAB@@@@@@AAA@@@A@@@@DA@A@@CC@@AA@@B@BA@@@@@AA@@AA@AA@@@@BAFA@@B@AA@@A@E@A@@A@@B@DADA@@BA@@BABAB@BAB@@ABA@@A@@@@A@@@A@@@A@@@AC@@@@@A@@B@@AB@D@B@BA@A@A@@@A@@@A@@@C@CACAA@A@@@@@@C@@@@@@A@@A@@CA@A@@@@BA@@@AA@A@@@@A@@A@@@ABCBCBA@@@@@@ABC@@@A@@A@@@@@ABABA@AB@@A@@@AAA@A@@A@@@A@@ACCAEC@@A@A@GBA@ACAAAACAACCACC@AA@IDGD@@A@AACAAA@@@A@@@@BA@CBCBA@A@@@@@A@@@@ABC@@BA@@AA@AAC@A@@AA@@@@AB@@ABA@@@@@A@@BA@@@@@@@@C@AAC@A@AA@@@@@@@ABA@A@@A@@AC@CA@@@@@ADADAB@BA@AB@@@B@@A@ABA@A@@AA@@@@@@@A@@BAA@@A@@AAB@@AD@BA@@B@B@B@@@B@@A@@@ABA@@@AAAAA@@@A@@@@AA@@@A@A@@AA@@A@ABA@@@@@A@@@@ABA@ABEBAA@@@@@A@AFAB@B@B@@A@@@@@A@EAAAA@@@A@@A@@@BA@@@@BA@@A@@AAA@@ACAAAAC@ABA@ABA@A@@@@@AA@C@ABCBA@@@A@AACCCC@@@A@CBC@C@@B@@@@@B@BB@@B@B@B@@@@@B@BA@@BAB@F@D@@@B@@@@AA@@AA@@@AAAAAAACAAACAAA@@@AACAA@B@@A@@CAA@CA@@A@A@@@@@@@DAB@@A@@A@CAA@A@BAD@JEFCB@@@@ABB@DBB@@@@B@@@B@BAFA@ABABCBABC@ABA@A@ABC@CBA@ABAFEFEDCBCBA@C@E@AA@@AA@AACACEAA@@@@BADADADAB@@@BA@@B@@C@@AC@AEC@A@@@AFCB@BAD@BADADAB@BA@@CAACCAIGAA@@BA@@B@@@BA@@@ABG@A@@BAB@HCDA@@@A@@CA@A@@BABAJGfANIBHPDJ@@E^FJG@ERENCAFLAFEGCIQZG\\DNHJHBHCBTJP@D@FHDBJ@L@FBV@ZAFCLON]FCGI@K@GNMLKCCHEB@VGPE[eaYaESCOGOGHeCGAQG[HIIEDGCGDOBC}AFAHAFCDCDEDCBEH@@EHCDCBCBCBKDE@C@E@CBCDCDCDADAB@DCDEDA@C@C@A@CBIDCDC@C@C@K@E@ABAB@B@BwMMCA@@@E@K^QGOCUFAN@DFRQXHJHNpD\\FGDCD@BDDBD@BCBIFGHEBADE@M@EBCBEDCD@D@D@B@BADCBA@GBCBC@@@C@A@A@E@E@C@CBABC@AB@B@D@D@FA@CFEBCBCBCBA@ABABAFCBA@@BA@C@C@A@CAC@A@KBEBEHCBABC@C@@AA@A@A@A@ADADEJEHAD@BDF@DCBABCBGBC@ABAB@D@JA@@BA@@@A@ABA@A@@B@@A@AB@@A@A@@@CBA@@@A@A@A@@@AB@@A@@BAB@@@@AD@BA@AF@@AB@@AB@@CDA@ABA@ABCBA@@@A@A@A@A@@@C@@@ABA@@@AB@B@B@D@DA@ABA@@@CEGGEG@@AACEAACC@AEEACCEAAGBEBAB@@A@EDGD@@ABC@@BC@CB@@@@@@@@@@@A@A@A@A@A@@@@AA@AAA@@@@@@@AA@AA@@@@@@@AAA@@@@A@BAB@DA@@DAD@BADAB@@@@@DABAB@DAB@BA@@DAB@B@BABAB@DAB@BAD@@AB@B@BBDDDBBDBB@@BB@BBB@@B@JCDAD@BCDABA@@BABADAA@CAQEA@@AFADADAB@@ABABA@A@@@AAA@G@EAC@CCEAA@AA@A@A@C@A@EBCBC@EAGAE@@@CAE@A@C@@AC@@@A@@@ABA@@@E@CBEAG@EBE@@BA@ADAB@@@@CA@@C@C@CBA@EBC@CBCBA@ABABABA@MFCBA@A@GF@@GF@@AB@BEFCDABAB@@@@A@A@AAC@AAC@@@A@A@A@A@E@G@IBKBGBMBC@E@A@A@IACAKAGAAA@@@A@@@AEK@@E@@@@@GLAHEH@B@D@DAD@B@BABA@ABAB@B@B@BB@@F@D@DAF@@@B@@@BB@@BAAA@C@A@C@CBCB@B@@@BBDBDBBDDDDDDDFBD@BABA@@@I@EAC@GAE@A@CAA@A@@@A@@BABAB@@@BAB@BAB@DABAD@B@BAB@B@BAB@B@B@@@@A@@B@@A@@@EAE@G@CBC@A@@BCBA@ABA@@@BBDDBBFB@BBB@@@B@@ABA@ABC@@@A@E@CBC@A@ABCDCBADAFCFAB@BAB@@AD@@@D@@@B@B@BBBBDDF@BA@A@E@GBE@A@C@EAEAIAE@AAC@E@C@CBC@AB@@@BB@@BB@DD@BB@@@@@@B@H@DBDDFFFBDDF@B@D@DB@@DBB@@FAB@D@FDDDDBBBDDDBHDJDHDFFBBBBBDBB@@BBBBBBBDBB@BBD@BBDBDB@@BFBDDB@BBB@F@H@LBB@D@B@@@B@@C@AD@HAHCD@@@AB@@@BB@@B@@BBB@B@BBB@BB@@B@@@B@@@B@@@B@B@@B@@@@@@J@BBD@LBNDFBD@BBB@LDPDND@@AB@BAB@B@@BD@BABCBGDEBA@A@CBEBADAH@DBDDDLHBD@BCFAHBF@BBDHFFDBFAFCDEBOAGBIDEDAD@HAHADGBMDIBKDIHIJGHAH@NDDFHFFFBBBBD@FCFQPGJCTLJLHRFPBLCPCVGNCPBJFLJXXPJPBPAFEBGCMAI@IDGFCJCH@D@PDJDTNNHNHJBNARIXMLETELDHFHL@PGXETAVDNNJLHDBBDFLFFFF@F@FAHBHDFHHFBF@FBFAJCFCFEDGB@BEBI@YBEBCDCFCLENCZCRAJ@D@P@F@T@RBN@FADEBG@E@EDE@E@KDQP[FSHOLGPCPBN@L@HEFE@EEGIGOGGAGACC@C@KDGDED@B@F@BBJAHALA@@@A@@AA@@@@BA@@@A@@"],encodeOffsets:[[128094,46468]]}},{type:"Feature",id:"220723",properties:{name:"乾安县",cp:[124.041139,45.003773],childNum:1},geometry:{type:"Polygon",coordinates:["@@I@MAE@CAiAcAQAAAA@G@GAC@A@ABGJYXCFA@KJCDCBABA@MLEDMLSRQPKHCDABA@C@A@EBE@G@I@@@@HBP@HBJIFKHKNADABEHCBCFADILKLEHEHGLA@CFABGLADCFEFEDCFGHGJCDA@CHENGNEJ@BA@@BAD@BABADADEH@@@BABCBCBGDCDB@@BBBDDBB@BBB@@@@FFDDDDDDHJBBFFFHFHHHB@@BFDFDFFDBBBBBBBFH@@BBFDDBJJHFDDHFHFBBFFBBBJ@BA@@@ABC@@@@B@@@@AB@@@BA@@BA@@BAB@@@@BB@@@BA@@@@@@BA@@@@@@@@B@@@@@@@@@B@@@BA@@@AB@@@@A@B@@@BB@@@BBB@DBDBBBBB@D@B@@@B@@@BBBB@@HBF@BBDB@@B@@@LBD@B@@@B@@A@A@@@A@A@@BABA@@BABABA@@BA@@BADCBC@@HBH@D@BBHDDBDBDBB@DBDBB@JBLBB@B@FBDB@BDBDBBBD@FDFBDDFFDDLLHHJJFDBBBBFFHD@@BB@@B@FDDDHFB@BBFBHDDBPFLDJDJFFBHBFDFBNFJDJFB@BBB@JFHBJFDBFBDDD@FDHDHFDBBBLFDBJFBBB@@BB@JJBBDB@@BBHFXTJHJHBBBBLJLJDDNJPNBBB@FFBBDB@B@@B@@B@@@@DDFBBB@B@@BBDD@DB@@@@BFD@BBB@@BBBBBBB@BB@@BB@A@@@@@BB@DB@@@@B@@BB@DBDBB@BBBBB@@BDBDBDDDDB@DDBBDBBDDBBBBB@@@BB@BBBBB@BBB@@@@BDHBBDDFDHHHFDDFFJHLJHHFDDBLDJBHBFFDBFDJBH@DBB@@@B@D@@@DBB@D@@@BBD@BBB@F@BB@@H@BB@@B@D@D@D@D@B@F@D@D@HBB@B@B@B@B@D@BBD@BBB@@@B@@@BAB@BBJ@NDD@J@X@L@NBJBHBHBDBB@@BHJFHDDBB@@@BBB@B@D@@DFDFDHBB@BCBCBCBCDABABAB@@ABAB@@@BA@@BA@@BCDADCDCB@BA@BB@BBJTNXPRHXDd@PAHGDEBIDOFe@CF@rDdBF@@AHkDUD@HBB@@@BAHADAFCDAHCJEHCFCHCFCDCDABAFEBADADEDIDEBA@A@ABI@MBK@A@ADY@AHm@AD@HB@@ARDYDS@AD@jDvDBB@AF[D[@@F]BGHi@@AAEA@@AF[@@`ñ@A@@@@eAyE@@@@XÓBC@A@@DaDYDY@ABCBMBG@IBI@EDO@A@CBG@GBCBK@EBKBIBM@EBGC@ACAAC@IAG@MCG@A@A@AAC@A@G@EAGAE@G@CBQCSA[AGA@@E@KAK@]CG@MAG@UAI@CAE@E@KAE@UACAC@QAA@]AO@[CI@IA@@@@I@QASAAAG@EAIAIAI@IAG@A@C@C@EAI@GAE@G@E@MAKAI@G@AAG@I@IAO@EAC@@@E@A@GAI@C@IAE@E@IAG@C@SAQA@@A@E@MAK@OAKAM@E@C@C@AAG@CAG@C@C@CAE@MAM@EAE@C@A@E@C@CAE@C@C@EAA@G@A@E@IA@@A@I@GAG@KAGAE@KAG@C@GAG@C@GAA@E@C@G@CAA@KAMAKAMA[@MAI@"],encodeOffsets:[[126664,45718]]}},{type:"Feature",id:"220721",properties:{name:"前郭尔罗斯蒙古族自治县",cp:[124.823417,45.118061],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CAAAAAA@AAAAA@@@ACA@AAECCCEA@AAAAAAAGE@@@@@@EDGDEDGD@@A@@BA@AB@@@@A@@@AB@@A@EDABGDA@@BA@KHA@aRABQJA@ABA@ABEBA@@@A@A@@BC@EBC@ABCBA@CBABCB@@DBHFHDLFJFDBBBA@GFKFEBEBEDCBCBE@@BA@CBC@ABE@CBA@@@GDCDABA@CBA@EB@@E@EBGBEDEBCBA@EBEBABA@EBABA@CBA@@BA@@BA@ABC@AB@@AB@B@@ABA@ABA@ABABA@ABC@CBA@ABA@@BA@ABA@A@CBA@ABA@@BABA@ABABABCBA@A@ABABCBABABABCDABABCBCBCDC@EBCBAB@@CDA@AD@BCDABABCBCDCBCBGFCBEDCBA@EDABC@ABABA@@BAB@@ABA@EBEDE@ADCB@@CBABEBEDCBCBCB@@CBIDA@EDGBCBCB@@CDEHEF@BCBGDABEBCBCBEDA@ABC@C@A@A@AAA@AA@@ABC@@@CBA@A@A@@B@@E@AB@@@@CBAB@@CBAB@BAB@BABA@ABC@E@ABA@ABABADABABABADCJADAB@BADEJ@DCF@JAFAFAF@BABEDA@ABABCDABABGFURURGFCBZJB@CDKLUEGFEAABABGFED@@GFIHCBABEFCDEFCBCFEDABABEFEFCBB@D@HBH@B@BBRBdBjBDBF@NBJ@J@NB\\@NBLBNBLBB@DBH@D@F@B@HBD@H@HBD@H@LBF@HBLBH@HBJ@B@@@JBF@B@H@B@FBD@D@F@DBD@F@B@D@F@FBN@NBF@DBD@D@H@DBH@BBD@D@F@N@LBPBL@NBF@B@@@RBTBD@H@JBF@F@JBD@J@HBB@F@@@D@FBP@JBJ@H@BBH@J@LBNBF@H@F@HBJ@FBD@D@B@H@JBJ@JBJBFBH@BBTBRBJ@@@@@JBJ@\\DP@^BB@RBD@DBVBF@LBF@F@DBJ@VBH@NBH@^DL@LBF@@@HB\\BTBRDDAH@F@HBFBH@B@D@BBB@B@H@NDH@JBD@BBBDD@AH@FANAJAL@FALAD@HAH@D@BCP@FAJ@JAHANAD@BCZCZCb@@@BADWÔ@@@@zFfB@@@@@B_ò@@E\\@BB@FBB@@GjAHE^@@C\\E\\@BAAuCiCC@@BCTCZQ@BA@GC@@BGn@BCZ@B@BAL@NAJ@B@BABCFCJCFCBABEFABCBCDEDGDEDGDIFGDCBEDCBGBAB@@A@GAC@CVGl@BE@cAqCE@@DEfCPAJCFGHOBc@WCQGWOSMBDDNFVBD@@B@@@B@@@@@BBB@@B@B@@AB@B@@@B@@@@B@B@BBB@@@@@@B@@@@@B@@BBB@DB@@BB@B@@BBB@@@B@B@BB@@@@BB@@B@B@DBB@@BB@B@DBB@@@D@B@@AB@BBD@D@@@F@F@@BBBB@B@BB@@B@@B@@B@D@B@@@B@B@B@DBDBDBJFHDJDFDPHLFDBDBHDPHJFTJLFFBDBJBLFHBHDD@BFBDJBHBB@BBDBBBDB@BDBFDHBFD@@@@B@B@DB@@BBB@BBB@@BDBBBB@BBBBB@@@B@@BBB@@DB@@BBDBBBFDBBBBB@@@BB@BB@BB@@B@BBD@DBB@DBB@DBD@DBD@DBB@B@@BB@@@B@D@@@BBB@@@BBB@B@B@BBB@B@BBB@B@@BB@B@B@BBB@BBB@B@DBBBB@BBFDDDBBDDDBBB@@@@B@@ADBDBTJDBFDNFBB@ADCJGHEPINELCH@DAFBFDFJFDJFNDZFVDVBL@FAHAJKBCLQHIHGNMNEDAbIXGJ@L@DBFDLHHDB@RHDFDF@DCHIJCF@F@DBDDDHBH@H@HAHCLCPG^ONCN@N@VFLDDSHIRODE@EACAAEAEEEGCC@MBGHGJIJGLCJANCHABCBG@GBCFCJCHAPBFADCBEAEECGEAC@AAEBGDE@AACKGCCAC@CBGBCFADAB@B@FAHCDABA@AAC@@@ABA@ABA@@MCOCKCA@AAC@EAMCKAC@AAI@@@@@@@@AA@A@@@A@@@A@@@A@@@AAA@AAA@A@AA@@@AA@@A@@BA@@C@GDGBC@@B@DA@@@A@C@A@KAG@E@A@AAA@CCEA@AA@ACAC@AAC@AAAACAAAAAA@@AAACAAAAEEGCICGCCACCAACACCECC@A@EB@@AA@CA@@C@C@ACEACEECEAC@C@G@A@@@@A@@ACCA@@AA@@A@@BAD@DAD@F@D@BBF@JBFBFBD@B@F@HAF@B@B@@ACEACAA@A@A@A@@@C@@BC@@BA@ABADEBEBCDADCBAB@D@DAF@B@@@D@BAB@BA@@@A@@AA@AEAAACCAA@@B@BAB@DA@AB@D@DAH@F@FB@@B@@@@AB@@@@@@A@ABA@A@ABA@A@ABCBA@CBA@ABA@A@@BABA@AB@@@B@B@DBB@F@HBD@FBJ@@@B@BA@AACCECCCCCCAAACAC@A@@@ADADAD@B@D@B@BB@AA@@A@@@A@@BE@C@C@EA@@A@A@ABABAB@BA@A@ABC@C@C@AFGBGHK@@@@F@@@FL@B@@@B@@BBHBLBDBJBB@B@F@D@NAHALAJAH@F@B@B@B@B@@@D@BBD@BBB@B@@@@@BABADCFE@ABA@@HE@@HEB@B@DANEB@BABABAB@DADAD@FAB@DAD@D@@@DB@@@@BABCB@@AF@FAH@FBDAF@@@B@BA@@B@@@D@@BD@B@F@DB@@F@HBFBD@DAFAB@D@B@B@B@@BBBDF@DBD@F@HBB@B@@@BABAB@BA@CBCBEB@BB@RFDBB@CBABAB@@ABCBADC@CBIDA@@@AA@AAA@@AAACCACCAAA@A@@BC@ABA@CBA@ABABA@A@CB@@ABA@CBA@ABCB@@@@A@CBABC@CB@@CBA@ABB@@@@@BB@B@@@@@@BBB@@B@@@@@@BB@BBB@@@@@B@B@B@B@B@@@@@@@@@@DAD@@AD@BA@@HCFCB@@@BAFAHABBDFBDFF@BDDBBDFBB@@FHHHDF@@B@BAB@@C@C@A@ABA@@B@BA@@D@@@B@B@B@B@@@B@DABAB@BAB@DC@@BA@@BA@@BEB@@ABC@@@@BA@AB@@@BA@@B@B@B@@@B@DA@@B@B@@@BAB@@@@AB@B@BAB@@@B@@AB@@I@CBABAD@HADABADA@CCE@ABCFGFIBCBCB@B@B@B@@BD@D@BADAFGFALAB@D@DBB@D@D@B@@AB@DABEBABAB@DADADAFADEB@@E@C@C@ABAD@BADAD@F@F@B@B@D@@@D@DAHAB@DABC@A@A@C@CDCFCDAFAN@F@BCFAHGJEDA@AACCC@ADCHC[EoCGMGIRWEQ@CBMVEPDRHL]F@@@B@NDxN@A@ABABAF@L@D@D@D@DCJCDAB@D@D@B@FCDC@CBABCDCDCDCDAF@D@F@LCDADADADCFG@@FGDAFCDCDCBEBGBE@C@CAAAA@ABABCHKBAB@FADA@A@A@G@AAA@AAC@A@A@ADCD@DAF@D@F@D@DCHCBADAD@FBFBFBJ@FBF@JBHADADABCHAHAJABB@E@@@@BA@A@A@A@AA@C@A@A@AAAACAEAC@A@AAAAA@A@@QF@@CB@B@@BBAB@BABEBA@@B@@@BA@@FADAB@@@@@BAB@BABA@@@@B@B@BAB@B@@@@B@@@BA@@@BB@@@@@@@@B@@@BA@CB@@A@@B@@@B@@@B@BB@BB@@B@BBB@@@@B@@@@@B@B@@@B@@@BAB@BA@AB@D@@@B@@BDBBDBB@BB@@@DABABABA@ABA@A@A@AB@BCDAB@@@B@D@BAB@B@BAFABCFCBCDA@AB@B@B@@A@@@A@@A@A@AACACA@@A@@@@AA@AAE@CAA@A@CAAA@@CAAAC@CAE@AAC@CAGAAAA@A@AAA@A@AAA@A@@@@@A@AAA@@@A@@@C@A@@@A@@AA@@@A@@@@@@@@@A@@@@@@B@@@@B@@@@@@B@@@B@@@B@@A@@@@@AB@@A@@@@@@@@B@@@@@@A@@@A@@@A@@@AB@@A@A@@@A@@@A@A@@@AB@@AB@@@@@@@B@@@@@BAB@@AB@@@@AB@@@@A@@@@@A@@@@@AA@@AAAAAAA@@@@AA@A@@@AAA@@@@BA@A@@B@@@BA@@@A@@@@@A@@AA@@@@@@@A@@@BB@@BBBBB@CBAAECCC@@@@AB@@A@@@@A@A@@@A@@@AAAA@@@CAC@@BA@ABA@@AA@@@@A@A@@@@@@A@@@A@@@A@A@A@AAA@@AA@A@A@@@@@A@@A@@A@@@A@@AA@@@A@@@@@A@@@A@@@A@@@@@A@@@A@@@A@@@AAAA@@@@AA@@@AB@@@@AA@@@@@@@A@@@A@@@A@A@@@A@A@AAA@A@AAAA@@@@@@A@@B@B@@A@A@@@A@C@A@A@@@A@@@AB@@@@@@A@AB@@A@AA@@A@@@@@A@@@@B@@@@@@@BA@@@@@B@@@@B@@@@@@@@@@@@@B@@@@@@@B@@@@@@A@@B@@@@@BA@@@@@A@@@@@A@ECAACAA@AAAA@AA@@@A@@AC@CACAAA@@AA@@A@@A@@@AB@DCBC@@@A@@@@@@A@@@A@ACA@AA@@@AA@@A@@A@@A@@CA@@A@CA@@BAB@BA@AB@A@@@@@A@AB@@@@@@A@@BABCB@@A@@@A@@@A@@@A@@AA@@@@A@@@A@@@@A@@@AAA@@@A@AA@@@@A@@@A@@@@@@@A@A@@@AA@@A@@@ABA@@@AB@@@@A@@@@BABAD@@AA@ABABA@AA@A@A@ABABC@BCBABADCBADCDE@A@@@@@@@@A@ADCDA@@BABCD@@AB@BA@ABABABABAB@BA@@@@BA@@BA@A@A@@@A@A@C@CAA@@@@@C@@@A@@@A@@@@@@B@@@@@@A@@B@@@@A@A@@@A@@@@@@B@B@BA@@@A@AAE@C@CACAC@A@CAC@GAA@@@EAA@A@AB@BADABAD@B@@@A@@@@A@CACA@@A@A@@@@BA@@B@BADCFAHEACACAA@A@@AA@@@CAEAAA@@AA@@DA@@AACCAAAACCA@ACAAACACAACAA@@AAAAA@AA@EBE@EAA@AAC@AAAASGGCC@@@CBEHiS]OeOIECA@@A@@@@@ABA@A@ABABC@EBA@A@CAAACAC@@@A@A@IJABC@WKcOYKUKQEPONMBABADAHE@A@@@GAA@@AA@@CADEBC@@@@AC@A@@@AA@@A@@@AAB@BAB@@@@@@@@@@@@A@@@AAAB@BA@ABADAAGCAA@@A@AACAICAAABA@CDADA@@BCBC@A@GFCDEFCDC@AA@@AA@@@ABA@A@A@ABC@@@A@AA@@@AACCIGGEA@@@CACAGEA@A@AACAICCA@@A@CBCBABCBABCDC@CBCBE@ABC@E@A@C@A@A@C@C@AAEAA@C@E@CAA@@@CACAA@CACA@@C@CAE@A@A@A@AAA@@@A@CAA@EAC@A@A@A@EAA@AA@@E@CAC@CAEAC@AAC@AAA@C@AAAACA@A@@CBABABAA@@AAAAECCAAAAAA@CCCAAAA@B@BCDCBABAB@@AB@B@DCEEGCACEACACC@@CCA@CAC@@A@@@AAAAACAA@@AABCBA@A@C@CACACAAAA@A@@AA@@A@@AA@AA@AA@A@@AA@A@AAA@A@CA@@C@@@@@@A@@@A@@@A@@@A@AACAAAA@CAAA@ACACA@@ECECA@AACACABABABADCDCB@BCDADC@AB@DA@@@A@@@@BABA@@AABADCFG@@@AAAAACC@@BA@ADCBA@A@@@@@C@@@CAACBC@@B@A@@@A@@A@@@@@A@@BA@@@@AA@AAA@A@@BA@@@@@@@AA@A@@@A@@BA@@@@@A@@A@AAC@CAA@A@AB@@A@@@@@AAAAAAA@CABCBA@A@A@@A@AAA@@@BA@AB@@@A@@@AAA@E@MCA@GCA@AACAC@CAA@CAC@EAA@E@A@AAA@@@A@A@AAA@A@AAC@A@A@@@@@CA@@A@A@C@IAAAC@C@IAEAKAGAICEAA@CAA@KEEACAIECAGCGCECA@GAC@IAEAKAGAKCCAE@CACAGACA@@@@CACACAEAA@EACACACACAAACAA@AAA@@@CAAAAAA@AACAA@@AAAA@E@CAA@AAA@AA@@AA@AAAA@AACACACAEAECEAECECCAGCCAGCGEGCGECAECIGCCAAA@@@ECAACACACCAAA@AACAECEACACAAAA@ECCAEC@@CAA@AAECC@AAAAEA@@CAAAGAAA@@@@EA@AA@A@CAC@EAAAKCAAA@CA@@AAA@AAIGEE@@AACAAAAAAAA@AAAAECAAECCAC@A@A@@ACACCCAA@AAA@@@CACA@@AAA@@A@@A@@@CACCCACAGC@@EAAAECEAAA"],encodeOffsets:[[127103,45383]]}},{type:"Feature",id:"220722",properties:{name:"长岭县",cp:[123.967483,44.275895],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@ADABCDAC@@@A@C@ABA@C@CB@@@AB@@@@AA@B@DCBAAA@@@@@@@@AAAAAAA@@@@ABA@@@@@A@@A@@@A@@@A@BAB@BABAA@CAGCABA@@BA@A@A@A@ABA@E@EAE@C@A@AA@@CBCBABC@ABA@C@C@C@C@A@ABA@@BA@@BB@@B@@@@ABA@AAA@A@C@E@A@G@BC@A@A@@@@@A@@AA@A@A@C@C@@E@BJA@A@A@@@A@@@A@E@@@C@@EB@@EA@C@@CC@@@@B@@@@@@@B@B@B@H@@A@A@A@A@@@@@@A@AA@@@A@@@AAAFADEA@CA@A@A@A@A@AJ@@@BCD@@@CAA@CBC@@@A@@@@@@ADABCDCD@@@@@AA@@@@@@A@@A@@@@@A@@@@@@@@AA@@@A@@@@@@AC@C@CAA@A@A@@@CAAAAAGCAF@@@@CECE@@A@@B@@@B@@@B@@BBBB@@@BB@@@GBCBABC@EBE@C@C@A@EDA@@BB@@B@D@B@@@B@DABABADCDCDABGAOAAAC@CAA@A@@@A@CCA@@AAAA@@@AB@BA@ABA@C@A@@@A@@B@DA@A@A@A@A@A@C@EBG@C@GBEBC@EBCBEBA@@@A@A@A@AB@@G@A@A@CBA@AB@@A@CAA@AAA@@AAAA@@A@A@@@@@@CCCACCCAE@EAC@A@G@MAG@C@C@EAG@G@CAC@C@AAA@C@EAG@@@C@C@EAI@A@@@kCQAKAE@C@C@@@[Bc@Y@EBG@E@EAG@cAA@UBEBAA[yTQFQH@@C@@@ABA@CBCBABCBHDDBABAB@B@B@B@B@@FDBBBBDB@BBBEDADGHABGHAB@B@@@@EF@@A@@@@@C@AA@@AAA@ABCBABABA@A@CAAAC@A@ABA@C@AAAAGF@BA@@B@@BBGD@@ABCB@BA@@B@@BBBBBBBDC@A@A@@@ABAB@BAD@D@BCBABCDCBCDCBCD@@CDCBABEDADAB@B@@@B@D@BBBDB@@BBCBABIDABADA@ABAD@DCF@DAB@BAB@@A@C@C@A@A@C@A@C@E@AAC@AAA@@AA@BAAA@CAC@C@CAC@C@AA@AACAC@CACAAAAAAAA@ACACCAAACAC@E@C@K@CBC@E@EBG@EBIDODIDCBA@C@C@A@C@CBC@AAC@E@C@CAC@CAEAEAC@CACACACAAAACA@IBO@SBS@KBCA@@GBC@GBC@C@C@E@C@ABA@IBA@A@@@AAA@A@CAE@GAE@@@A@A@C@@@@@@@@A@A@@A@@@A@A@A@ABA@A@A@AA@@@AA@@AA@E@C@A@@@AB@@C@A@C@@@A@@A@A@@A@A@AB@AA@AAA@A@@BA@@@A@AA@@ABA@A@@BABA@C@A@G@E@CAC@@CA@@@A@AAAAC@CA@A@A@C@AA@@@CBC@CBCBC@A@E@EBC@EBE@EBA@@@@@ABE@A@@@A@C@@@A@A@ABA@A@@@@@@@@@A@@@@@A@@@AA@@A@@@A@@@A@@@A@AAA@@B@@A@gE@@@B@@B@BBB@@DCF@DAB@F@@LJHADD@@BBFGFBHJADCF@@DHDFDF@@@FBB@F@@DHDF@@@B@FBF@D@BBBBD@@@@B@@@@@lR@BCF@D@B@DBF@BBFFAAD@@DDDD@@@@GAEBMDABKBIDE@@BA@GB[HQDGBGDEBIFB@CHAB@DA@CBKDODA@GTADAPCAICBDADAHAJAJAFADAJAJCJAHABGLCDCFIJABEHCB@BW\\IL@@AB@@@BA@@BA@ADA@CD@BEF@@EFCFEFEFCFADCBABAB@B@@AB@@@@CDEFCFA@@B@@ABCB@BCBAD@@AB@BA@@BIAE@A@E@G@CBI@A@@DCFA@A@EDIDCDIFGBCDC@O@A@KBI@GD@@@FJAADADAFCHAJCJCF@BAFADABAF@B@D@@D@RFV@@@@HAH@DD@DJFP@@KR@BINCH@BGLAD@@@@D@B@B@BBFBDBDBB@CB@D@D@BEBADEFCFABJBFHHH@B@FEH@F@BBD@BBD@@@@GDBBOHFFFFHHHHBB@@BDAF@LDF@H@D@DDDDFBDDD@@LFHFFBFDD@JDHBHB@FLDHBLDNDD@NCF@@DFPBDDFBBHBZHLBDBFDB@@@@B@B@D@BA@@@AB@BB@DB@@B@B@DAB@BBDB@@FDDDFBBBDDDBBDB@@BB@@BF@JBDBFDBD@B@D@BBDBDBB@BD@HDLBN@DBD@D@BA@@B@B@@@B@BBBDDBDBBBHFFD@@FBPBLBJDJDFBBBDBBBDBB@DBB@D@HBH@H@NDHBHBLBD@DBD@PDHFFBBBDDFDDBFDDBFDFDFDFDDDFBBBHBB@D@BBDBBBB@B@B@@@BBB@HBNBFBFBD@B@BBFBLBD@HB@@D@J@D@DAB@B@F@DA@@J@H@F@FAB@D@B@BADCLGROTQNKFCNKB@BADADCLIB@DEZWHIBADAFEFEBABAFCDEDAFEDCFEBADAJGHE@@FCHEBABAFBHEVFLKDCA@YIDAHEVQVQHEBABADCBABAB@FCBA@ABEBEBE@IDE@CFIBC@ABABCDIBCBABABABCBABAB@BAF@D@BAB@BA@ABA@ABADA@@BADA@@@@BAF@@@@AB@B@B@DA@@D@BA@@BBB@BBB@B@D@D@BAB@FCDADAFABAHCDA@AFEFGDC@@DADAHAFCB@JCDA@@DADADAFCFABADA@@DABCF@FCFAB@BA@@BA@AB@BABAD@BAFCB@DAFCDAHEDADADCDABABADC@ABCB@DC@@BADAFAD@DCDADABABADCBABABADABABAB@B@DABABABAB@BA@AB@BAB@DAB@B@BAB@@AB@BAB@DAD@BAB@BABAB@BAB@BA@@@ABA@@BAD@BAB@@AB@@AB@DAB@BAFAB@BAFAFAB@DAFAFCHAFAF@@@FAB@DAB@BADCHC@@B@DAF@BAD@DAB@@AF@DADAFCFAFALEHEB@AACAIEKEGCGECA@@DABADAB@DABAD@FAD@@AB@B@@@B@FABAB@BAB@RIBAbQB@LGB@@AB@HCBAFCB@@@BA@@B@@@@@BAB@@AB@@@HCFCHCFC@@@@@@HFBBBBBB@BFBDDFDBBB@BD@@B@BBBBB@BBBBDB@@BBFBFDBBFB@@HDDBDBDDDB@@B@@@@BB@BB@@DBDB@@B@BBB@DBDDDB@BB@B@D@DBFDBBFDBBBBB@BBBBBBDBBB@@FFJHBBB@BB@@DBB@BBLDBBFBD@DBB@B@@BFB@@@@BBHBBBDB@@FBBBBBD@FDBBB@DB@@FDDBFDB@BBDBDBFBFDDBBBB@BBDDDBDBBBFD@@B@BBDDJHFDDBHFHDHFHDDBHDDBFDFDFBFDFBDBDBDBBBB@BB@BBB@@BBB@BBB@DBF@B@BB@BB@DBBBB@BBBBDB@@B@BBB@DBBBDBDBDBDBFBB@FBDBDBDB@@@@DBHBDBDBF@DBLDHBLBFBJBD@HBB@FDHDHDDBJFDBFBLFB@DBB@FBJDHBLBFBJBD@D@BBJBD@B@B@@@DB@@@@B@B@D@BBB@B@BBB@B@@@B@BBB@F@B@FBD@DBB@DBD@DBBBB@HDB@NDF@B@BB@@B@@@BA@ADABAB@@A@@B@@@DABAB@B@BAB@@@@@BB@BB@@@@@B@@@@@@@B@BB@@@@NIBAB@BABADADADADA@AFDB@@@B@BADEBA@@@A@@CAAA@@@A@@@@@@EABABE@@A@A@C@ABABAAA@DA@A@@@@@@CADCBCA@@@A@@@BCBAB@@AB@BAB@@@B@BB@@@@@@BABABCB@BCB@BBBBBBB@BBB@B@B@DADADA@@@@BA@@BABA@@B@DCDAFEDA@@B@@AB@DADAFAB@DADAJGB@B@@@@@B@@@@@EOAA@CAAAACC@@A@A@@@A@A@ABAA@@ABEF@@A@@AEAAAA@CA@@A@@@@@EF@@@@@@A@@B@@CA@A@@CCCAAAAAA@@@EF@@GAMECAA@CACDCDEDABCCEDGBA@@@@A@@A@@A@@CEAAACAACA@@AA@@CBCAA@CAA@AA@A@EBA@CDCFEB@B@B@DBB@BBB@@ABABA@@@@B@@ABCB@B@B@@@BAA@@A@@CA@@@ABABCBA@ABA@C@@BA@AFBHBB@BBD@BC@AB@B@@BBA@ABA@@A@BA@A@@BEFB@BAB@@@B@@B@B@B@@@BA@AB@B@D@BABCBADEFGBCBA@@A@AACA@BA@@@A@@@AB@BA@A@@A@A@C@@@A@@@@@@BADCBADBB@B@@@BB@@B@@@B@BADADCBADAFCBABA@A@A@A@CAA@AAC@A@AC@AAA@AAEAEAIADCDC@@@@A@AAC@@@@A@@@@@AB@@@@AB@BA@@@@HE@@@@CABABAJGBABA@@@@CAA@BABADGDCBA@A@@@A@A@@@AB@BADE@@AA@@@@@C@E@CBAA@AA@@A@AACA@AA@AAAAA@AAC@AAAAA@B@@@@@D@@@BAB@@@AAA@BADADACAA@@@@A@@BA@@A@@@@AB@A@AA@@@@AB@@AAAAAAEAAACAAACA@@@ADEA@AAA@GEA@B@BA@ABGBC@A@AA@AA@@@A@ADG@ABC@CBCBE@A@@@@DB@@B@@@B@@A@E@A@A@A@@@@@A@A@@BABA@ABA@A@@@A@@AAAAAA@@BA@@B@D@@@@@@A@@@@BA@@@@@A@AB@@@@@BABCBAHBAD@B@B@B@B@@@@B@BA@A@ABA@A@@B@@A@@@AB@@AAA@AB@B@B@HA@ADEF@AD@BADCDDBBCBC@@D@DABABCH@@BAD@@@@ABAFB@BC@AB@@AF@JBD@@BDBBC@A@C@@HDHDDBB@@ABCBCA@BG@C@@DBB@D@@@BFBBB@@GB@@FBBB@@E@@AA@@@@BCBEBBAD@BAB@@B@@B@F@@D@@C@AB@@F@D@@@CBE@@B@AH@BD@@A@C@A@@DD@DJ@BCBAD@@BAD@BB@BA@A@@B@AD@BBA@ABAB@@@BCBC@@@@@@@@@DABBB@@B@@ABA@AB@@AD@D@@@DGFG@@DBDBDBDB@B@B@@@@@@@B@B@@@@@ABA@@@@@B@DABB@BA@AB@@BD@BBB@@@BAB@@@DBB@B@DBDB@@@@B@DBB@@BBBB@@B@@DBDBB@@B@BAB@B@B@B@D@B@BBBBBDB@@BBB@@@@@BBB@B@B@B@@C@A@ABE@E@AAC@A@C@AAAAAAA@CA@AA@@AACACCCA@@@@@BA@@@@@@B@@@@@@CCAAAAAAAAAACCACCECCAAABADECECA@@A@ABAA@AA@AB@BAB@B@B@@@@A@A@A@ABA@A@@@AA@@@BA@@@@BAB@BA@@@@AAA@@@@A@@@ABABCBAB@@A@@@@@CEAAMICAAA@@B@DE@@DG@@AAAC@@@@D@@CAA@AAA@A@AACAGACA@@EAC@A@C@GAAAA@C@CCICIECA@@AJAA@G@AAD@B@BAAAABC@@AA@@A@@@AAA@AA@@AA@@A@A@AAC@A@AAA@@ABEBA@ACBC@CBCB@B@BA@ACE@C@@@A@AA@A@@AA@A@@@@ABAB@B@@ACCE@@A@@@BDBDBB@B@BA@@@A@K@C@CAA@A@CAC@ABAAB@@@@@@AA@AA@@@@A@@B@@A@@@@@A@@@@@A@@@@B@@@@ABCAE@E@A@E@A@CA@@A@AAA@A@C@C@A@C@@B@@@@A@@@@A@@@@BAB@@A@@@@@A@@@@A@C@@@ABCAA@A@@@A@@@AB@@A@@@AAA@A@A@A@A@AAA@A@A@@BA@A@@A@@AA@@@AA@A@@@ABA@A@AB@BA@@BC@ABA@@@A@AAA@@@CBA@CB@@AAA@AAA@C@A@C@E@EAC@C@C@AAA@AAA@C@A@CAA@CAEAAA@@A@A@AA@@AAAA@@AA@@@@@A@@@@BA@@@@@@B@@A@@B@@@BE@A@A@AC@A@A@@AA@ECCAC@CCA@CCAAC@AACC@@AAAAAAAAA@@AA@AAACA@AACAAA@@@AA@BAB@@@BC@E@@@@@@AB@DA@@@A@@@@@@ABA@A@@@@@@A@AB@BA@@BAAA@CA@@A@@@@BA@@@IEEA@E@A@@CACACACAAFAB@@@@A@CA@@CCCA@@A@@C@@BA@@@A@A@CAC@@ABCB@BAA@@@@@@HE@@@AA@@@@@@B@@CBAB@@@A@@@@@@DCBA@@@@A@@@@@CD@@A@@@@@@ADAB@AA@@@@AA@@@@ABCBA@AAHC@AAA@@@ACC@@AA@@@ACC@@AA@@A@A@CBAB@@AAAA@@AAA@A@@@A@A@AAA@CA@@A@@ACAAACA@@@BAB@B@B@@@B@@@@@B@BCD@@A@@B@@A@AAB@@AA@@@AA@@@A@@A@@@@@@AFM@@A@AA@@AB@BAD@@A@BC@A@A@@@@A@@@AB@FA@A@AC@C@@@A@@AAA@A@@FA@@@A@@A@@@C@@@AA@A@A@@B@D@BA@@D@D@BA@@@@@AC@A@KAAA@C@A@@@@@C@AA@@A@A@@@@@A@@@A@@@A@@A@@A@@A@@@@@@@A@@@@B@@C@@AB@@@@A@@@@A@@BA@@@@A@@@@@@A@@@B@@@B@@A@@@A@@@@@@BB@@B@@@@A@@@C@C@CA@@ABAB@@@@@C@@@@@@A@@@A@@C@A@A@@@@A@A@AB@D@@A@@@@@@A@@BC@@@A@BA@@@A@@@@@A@@B@@ABABA@@ABE@AA@A@@@A@AAABA@@@AAC@CACAC@@@AAA@@B@AA@@@A@@BA@@@@@@@A@@B@AA@CD"],encodeOffsets:[[127305,45068]]}},{type:"Feature",id:"220781",properties:{name:"扶余市",cp:[126.049803,44.9892],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@A@CBA@A@@AA@@CA@AA@AAA@AAAAA@BA@@@AA@@@A@C@@@A@A@@@CBEBC@CBCBA@@@@@@A@@@A@@A@@@@A@@@@@@@A@@@@@@A@@AA@@@@A@A@@@A@@@@B@@@@A@@@@AA@@@A@@A@C@EBA@A@AB@@@BA@A@@BA@AAA@@@A@@AA@@AA@AAA@@@A@@@@B@B@B@DBF@J@HBL@D@B@@AAA@E@C@EAC@@@C@A@C@C@C@A@A@A@AAA@E@E@E@E@A@@@A@@@@@AA@I@@A@EA@ABC@AAI@CAA@AAEAGAA@@AE@A@@@A@@@@ABABCBA@A@@@ABC@CDA@@@@@A@A@ABE@@@ABC@EBEBC@C@A@CBG@@@AEAG@CAGAC@C@A@A@C@A@@@AB@@@BB@@B@@B@B@@D@@AACBA@@AC@CC@C@A@@A@A@A@AA@@A@AAAACACACAAIBC@ABAA@@AC@A@A@EACAI@A@@B@BAB@DABAB@@BB@@DB@@BD@@CAA@@@C@@@@B@@@BDBB@BB@@@H@GQ@@@AA@@@@A@C@@@@@A@A@@@@@@A@A@CBAB@AACCC@A@@@@B@B@BAB@DA@@@A@C@AAAAAAC@@@AAC@A@@B@BAB@AAAC@AAA@@B@@A@@A@@@@@A@@@A@AB@@A@@@AA@AACCA@ABA@@DAB@FCA@@A@@BABABADCAADGK@GBGBKBEA@EE@@EE@@C@CE@EBEDCAC@AAEEKEAAMEKAG@CBABCA@@CACAA@@A@@@A@@@@ECGAECGAEACAA@KBGDGD@@EBABEBMBEBA@EFCDCF@DAFCJ@BB@DBBBBFBBABCBADA@@BA@A@@@@B@D@DEFGBMDCBEBI@EBCBCBEHAHCLBH@DAB@@KDIBI@CAEAC@A@E@CBA@E@C@@BADGBE@EA@@C@CA@A@AAACCCAACCCE@CAEBC@ADADCFADAB@@B@B@@@BDDD@BDBBDDB@@@B@BABEDKFEBE@CACBC@EDCBCBAD@DBDHHBD@DADABCDCBCBCBCBA@ABABCBG@E@G@IHEDCD@B@H@DAHEFABED@BAD@BAHADCD@BCBC@ABCDGNEDGDI@G@IAKAG@G@IBK@OCI@G@IBKDA@E@GCECEIAC@CDE@A@C@A@ACCMGUMKEGCAAACAAACAACAA@CCA@AA@C@C@AE@AAE@EBA@ABADCDCBEBGDCACAGAU@AAM@M@AAA@GAICAAAAKAI@K@G@E@EBCAC@KCACAC@AACEAEAE@E@EBAB@BAB@BCFCFEBE@G@E@A@E@G@C@GDCBAFAD@B@BCDEBG@GAGCGGGIA@@ACC@A@AAC@A@AACCAC@CAC@C@C@G@C@C@CAC@C@A@ABAB@B@BBBBBB@B@DB@@B@@B@B@B@BIBEBE@E@@@I@E@MAGACAEAQAE@C@A@CACAAAEMCCCAICG@G@EAICGEGGECEAEAE@C@E@GBIDGFC@AB@@C@A@C@C@@AE@ABAAA@C@A@@BAB@B@B@B@B@BAB@@@@@FAAIBGBGBADCBCBGBIAE@EAI@EAEAEAC@CBABGDCDC@E@C@E@CBC@CD@B@B@BBD@BBB@B@H@B@BCBEBA@ABGLADAB@BBBBB@D@D~ADCPDHCHJFGJH\\BRDHGfPHPHTDbFbZ\\fOFUHA@GFDDKLMN@H@LHJEDM^KPEDYBU@EAK@I@CAEGC@O@SIDAAGIGMG[CYHJRHDEFKBBEMDQF@FIH]E@FI@OCAGMJeBIHABAB@@@BDB@@@B@@CBGDA@AB@@@BAH@B@@AB@@A@@@AB@@BBJHDBBDDB@@ABA@CBCBABC@ABA@ED@B@@@BFD@BBD@@@DA@@@AB@@A@CBCBCBAB@@@@BBDFDBBBB@@BB@@B@F@DABADCDEFEFAB@BAB@DAD@B@BAB@BADABADAB@BEBABA@@@A@@@@@AA@CAA@B@@A@EDIFC@ABB@B@DBB@@@@BA@CB@@@@@@B@B@@@DBB@DB@@@BA@B@DBBB@@B@BBBDBBBDBBBBBB@@B@@BB@@B@@A@@@C@E@A@AB@@ABA@@@@@A@A@A@@@AAA@@@@@A@@@@DAD@D@B@@DDDDBBB@@@B@DABAD@B@@B@@@@@BAB@BAB@BBDBBDB@BB@BB@@@BB@@A@@B@@A@@@BB@@@B@BBFBB@@@@@B@@@@A@ABABEB@@@@@B@ABAF@BAB@B@@B@@@@@B@BAB@@@BB@@@B@B@@BB@@@@@B@@BBBB@B@@AB@B@@@BA@@@A@A@A@@@ABC@@BA@BB@@@BB@AB@@@B@@@@@@BB@@@BAB@B@BA@@@A@@BABA@CBCB@B@@@@DBD@@BB@@@@BAB@B@@@@@@BBB@D@BBD@@@@@@@@@AB@@@B@@@@AB@BA@@B@@B@@BB@D@BBB@@BB@@AD@BA@@@@@B@@@@@BABAD@DAB@@@@@B@@BBDBBBB@@@HCJCB@@BDDDBBDDBBBBBBDB@HAB@B@@@FDDBBD@@@B@@@BB@B@BB@@B@@@BAB@BABA@@@@B@@@@B@@AD@B@@@@B@DADABA@@B@@@@B@@B@B@@B@@AB@@@@@BDB@@@BB@@@@@@@@D@@@@B@B@DBDBD@@@B@@@B@@@B@B@B@@A@A@CBA@@@AB@@@@@D@@B@@@B@@@B@@@B@@B@@@AB@BA@ABA@ABAB@@AB@@CBCBA@@@@BB@F@B@@@BBA@@@EBAB@@@@BBB@@BB@@B@@@@ABA@@@BB@@DD@@@BCB@@@@@B@@BB@B@@@@A@AB@@@@@@@B@@@@ADA@@BA@@@A@@@@BB@B@BB@@@@DB@B@@B@@@BB@@@@@B@@@@@BABA@AB@@@BAD@BA@@BCBABABA@@BA@@@@B@@AB@@@@BB@@@B@@H@DBPDNHBBXTBBHFPLJDNBPCRGB@TKHAHAJ@`HHBbP^HRDN@T@VAPILILELCHBFF@FAJAD@DFDHDHDFADALGPSLONILCP@PDPJd\\HFfpTXHHFF@JAHEHKHEH@HDDDBL@NAJ@H@TFTBTAHAFGBIDGFEJCH@LD\\HRH\\JVFF@`DB@ZFJDLF^\\RPLFLBRCLKHENELAPBRDXHDAPMHIBAACCACAEA@CECAA@CBADAHAFBF@F@FEBC@GB@@ACCGAGACA@C@CAC@CDCF@D@BDD@D@DA@C@CCECAE@G@IC@A@EDCFAH@BABA@ECCCC@IHQDAHAFA@A@A@I@M@I@CAGEOEGGGCCCG@ECCEI@@@@@A@AFCDADA@C@A@AK@AA@@@@@@A@@E@ABCLGHGJCJ@LAFABAAACGCCGCAABCDAFAHBF@DAHE@EJKFCDAF@B@@AB@@CAA@C@@BAD@BABA@A@@CCAE@C@CBCHEFCB@B@@@BBBB@DABAB@BA@BB@BB@@@B@BABAD@D@DBBBDBBBDADCJADAD@B@BABCAAACAA@CBAPGTIJAF@DABC@AACCAAC@C@E@E@EDEBEFEFCFAH@L@LBL@H@FADA@EBEDCFBH@HABCBC@CDCJAJ@HBF@DA@C@GDCFAHAF@BADABCBEBCBAFEHAHALBPBLBJ@H@@@B@B@DDDBB@D@B@DEDCD@DAB@@A@A@ADAF@F@H@FBFDDBFADADABC@AAACAA@CBAA@A@A@@@AA@C@@DC@@AAABADABA@@@AC@C@E@A@@@CACAAAAA@ABAD@B@DBBBBBDBB@DADCB@D@DBD@BDB@D@B@D@BA@@@A@@CCA@A@C@AA@@@A@A@ABCDCBA@A@@AA@@@A@@@AD@BADBB@D@DBDBBBD@B@B@B@DCBAF@B@DABBD@DBBBB@@B@@AB@@@B@@@BBB@@B@B@H@HABBDBB@BBFAF@FAFEBAD@F@FBF@H@FAFAFAF@DABABC@EBC@AF@DADABA@AAAE@B@BABAF@D@DAD@D@BADA@AB@BAB@DBBBBBADBDBDB@D@BADEDCD@DADBBDBBD@D@BAF@BBD@DAFCBAF@FABCDCDCFEHADBB@B@BA@A@AB@@ABABC@A@A@ABA@@B@@@@@BA@@@@@B@@@@@@B@@@@A@@B@@@@@@@@@@@BA@@@BB@@@@@B@@@@@BB@@@@B@@@DCBCCC@E@@BA@@BAB@@A@A@@D@@@B@BCB@@ABC@@BAB@BA@@@A@@@@C@A@AAACACA@AAC@@@CA@@CCA@@@@@EC@@ECCA@AB@B@@ABA@@B@@A@@@AA@A@@@@@@AB@@@AAA@@ADE@A@A@@BC@@AA@@BAB@@AD@@@@@A@BAB@@@@AA@A@@@@A@AA@@@@@@A@@@AA@B@AA@@B@@A@@AAB@@@A@@AAAA@@AB@B@AAA@@@B@@A@AA@BAD@AA@@A@@AA@@A@@@AA@BAAA@@A@@@@A@@@AA@B@@ABABABCBC@AAC@@BADADAHAFC@@B@@A@AA@@A@A@@A@BC@@@A@@AA@@@A@ABAAA@@BA@AB@@A@@@AB@A@BAB@@@BAA@@@@@@A@@ACC@@AAA@C@AB@@AAA@@@A@@@ABA@@@@AC@@A@EAECE@@A@A@A@@A@CAA@A@@@A@@@@AC@AA@@AAAAC@ACA@AAAAA@@@AB@@A@AAA@A@A@A@A@@AA@AAA@A@A@CAAA@@AA@@@C@C@E@@@A@ABC@@B@@@@C@@AA@@B@@@@CBC@A@@FCBABA@@@ABABAB@@@BABA@@@@@@AA@@@@@@@@@@B@@A@@DB@@B@@@@@B@@@B@B@@@B@@@BA@AB@@@@B@@B@@A@@B@B@B@@@BA@@@A@A@C@EAC@ABA@@BA@@B@B@F@FBB@@AB@B@@C@A@@@@BA@@@@BABB@@BA@@@@B@BC@@@ABA@@AA@@@@D@@C@A@@BAB@@@BB@B@BB@@@@A@CAA@@@@A@@A"],encodeOffsets:[[129221,46001]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,5 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("通化",{type:"FeatureCollection",features:[{type:"Feature",id:"220502",properties:{name:"东昌区",cp:[125.927101,41.702859],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@@@@@AA@@@@@AA@@@@@A@@AA@@AAA@@A@@@A@@A@AAA@@@A@A@@@AAAB@@A@@AC@@AA@@@@@A@@BA@@@@BA@A@AA@@A@@@A@@@A@@@@@CBABCBABA@ABA@@@AA@@C@A@A@A@A@A@A@@@A@@@A@@A@B@@A@CB@@@@AB@@@BA@A@A@A@@@ABA@ABABA@@@AB@@@@@@AB@@ABA@A@A@A@A@C@A@@@A@@@A@@@AA@@A@@B@BABA@@@AB@@A@@@ABA@A@A@AAA@A@A@A@A@AAA@AAA@@AA@A@@AAA@A@A@@@@@@@@@AB@@@@A@@AA@AA@@@@A@@AA@@@A@@@@B@B@@A@@@AB@@@@A@@@AA@@A@@AA@@@@A@@@@@@A@A@@@AA@@A@@BA@@A@AA@AA@AAA@@@C@ABA@ABA@@BA@AA@@A@A@AAA@@@@AA@AA@@AB@@AB@@AB@@DBFDBB@BADABADCFADADAB@DCDCDAFCD@B@DABAHCFBF@B@D@DADCDAB@B@@@B@B@B@@@B@@@BB@@@BBB@D@BBD@DBB@D@B@B@BB@@B@BBB@@@BBB@B@BBB@B@B@B@@@D@@@B@B@B@B@@@B@@@B@@@B@B@B@@@@BB@@@@@BB@@@@B@B@B@@BB@@@@@BB@@BB@@UFQHQHYXEJERAPHNTA\\CDINM@MAQPCNJGHAA@A@AC@@@@@AB@@@B@@@B@@@BBBBB@BBDBB@B@BABABC@@B@@@B@BAB@B@B@B@B@@BBBB@@B@@@AB@@CDCBA@EBABA@AB@B@B@@@B@B@@@BBBBBB@BBBBBBBBB@@BB@@B@BCB@BA@ABC@@BA@@B@@@B@@@BA@AB@BABAB@B@B@@@BA@@@BBBB@@BBB@B@@B@@ABAB@@AB@@C@A@@BAB@@AB@@ABA@AAA@A@@AA@@@A@AB@@A@@B@B@BAB@@A@@@A@A@ABA@@@@BA@ABA@A@AB@@@BAB@@@B@B@@@B@@@B@@@@@BAB@B@@@BBB@@BB@@BBBBB@@BB@@@AB@@@@AB@B@@AB@B@B@B@@AB@BA@BB@B@@BB@B@@@@BBB@BBB@BBB@@BBBBD@@BBB@B@B@D@D@D@B@@BBAB@D@B@D@D@B@B@B@B@BBB@BBB@@BB@@B@@BBBB@BBBB@@BD@B@B@B@B@BBB@@@@@@BB@@@B@B@B@@BBBB@BB@BB@BBB@B@B@@@D@B@@@@B@B@@BD@B@B@B@B@B@BABABA@@BA@A@BB@@BBB@BB@B@B@B@B@@@B@B@@@BB@@B@@@B@@AB@B@B@@@BBB@@BBB@BB@@B@B@B@BAB@D@B@BADABAD@@AB@BABAB@@A@@A@@AA@@C@AAAB@AA@AAA@ACAA@@A@@@AFCBAB@@AA@@A@ABA@ABABABA@ABABABC@@BABADABAB@B@@@D@B@@@B@@@BB@@BBB@B@@@B@DB@@B@B@@BB@B@BAB@@@BBB@B@@@BA@@B@B@D@@B@@BB@B@@B@DBB@B@BBD@DBB@D@B@B@@@BB@AAA@A@A@A@ABABA@@BA@AAAA@@AC@@@@A@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@A@@A@@@@@@@@@@AA@@@@@@@@BADBF@D@H@F@D@BABA@AB@D@FADBD@FBBBBBDADADCDADABAFA@AA@@ABIDCDACCCC@@@@B@@@B@@@@@@@B@@@@A@A@A@@B@@@B@@@@@@@BA@@@@@A@@@A@@BAAA@A@C@@@A@@AA@@AA@A@@@A@@@A@A@@@A@@A@AA@A@@@@@A@@@@A@@A@@@@B@@A@@@@@@@A@@AA@@@@AAAA@@@A@@@A@A@@@A@@@AB@@A@@@AB@@AB@@ABAB@@A@@@@@A@@@A@@B@BAB@B@B@BA@@@@B@@@BB@@B@@@B@@@B@@BB@@A@@BA@A@@BA@@@A@@@@BAB@B@@@B@B@@@@@@@@BB@@B@@@BB@B@@@B@B@BA@@@@@@BA@@@@BA@@@@@A@ABA@A@@@A@A@A@A@@B@@@B@B@B@@@B@@@B@@B@@@@B@BAB@BAB@B@@@@B@@@@B@B@AA@AB@B@B@BA@@B@B@BB@@@@B@@@B@@@BA@@@@@@B@@@B@@AB@@@@@@@B@@AB@B@DA@@@@BAB@BAB@@AB@@@@@DAB@BA@@BAB@BABADABAB@DAD@B@FABADAFA@@BA@@@AB@@@@A@@@@@@@@@AAAA@AAAA@@A@C@A@CAA@AAC@AAA@@@A@@A@@A@@@@@@@@AA@@@AA@@A@@@@AAAA@AA@@@A@AA@@@@AA@ABA@A@@@A@@@@@AAAAAA@@AACAA@@@@@ABA@A@@@@@A@CAA@AACAA@AA@@@@@@A@@A@@@AA@@A@@A@@@@A@@@A@@@AA@AAA@A@ABA@AAA@A@@AAA@@ACAA@AAAAA@AA@A@@AA@@@AAA@@@AA@@@@AA@@@@A@A@@@AA@@A@A@@@@@A@@@@A@@@@@AB@@@@A@@@A@@@A@@@A@@@@@A@@B@@AB@@@BA@@@@B@BA@@B@@@@A@@AAB@@A@@@AA@AA@@@@BA@@BA@@BABAB@@AB@@@@@@@@ABA@A@A@@@@@A@A@@AA@@@@@A@@BA@@@@BAB@B@B@B@B@@BB@@@B@B@BABA@@BAB@B@DA@@BA@@@A@A@A@@@A@@BA@@B@@AB@@@@AB@@AB@@CB@@ABAB@BAB@BA@@BAB@@AB@BA@@@A@A@ABA@@BAB@@@B@@AB@B@BAB@D@B@B@B@B@@@@@@@B@@A@A@@BA@@B@@@BA@AA@@A@A@@@AB@@@BA@@BAB@@@BAB@B@@@BA@ABA@ABAB@BABABAB@@A@@A@A@A@AAA@A@AA@@AAA@AC@@AA@@@AA@@@@@B@@@BABA@@BAB@@A@@@AAA@@AA@@A@C@CBAAC@A@AAA@@@AA@@A@A@A@A@A@ABA@A@A@A@@AA@@BA@@@ABA@A@A@@@AA@@@AA@@AA@@AA@@A@@A@@@AB@@AB@B@B@@A@@B@@AB@@ABA@@@@BAB@@@@AB@@A@A@@BA@@@A@@BA@@B@@AB@BA@@@ABA@A@@BA@@@@A@@AA@@@@@@A@@AA@ABA@A@AB@@A@@@A@@@A@ABA@@@@BA@A@ABA@A@ABC@@@@@@BBB@@AB@@ABA@ABA@@@C@@@A@AB@@A@A@A@@@A@A@A@A@@@A@@AA@A@C@@@A@A@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@A@@@@@@@A@A@@@A@AA@BA@@@AA@@@@@@@@@@@@A@@@@@A@@@@@A@@AA@A@@AA@A@A@@@A@A@A@A@@@ABA@ABAB@BAB@@@D@B@B@BB@BB@@BBB@@@@@AB@@@@AB@@A@@BA@AB@@@BAB@@@@A@@@A@@BA@ABA@@BA@A@AB@@@B@@@B@B@@A@@BABA@"],encodeOffsets:[[129001,42588]]}},{type:"Feature",id:"220503",properties:{name:"二道江区",cp:[126.042678,41.774044],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@B@@@B@B@B@@@@@B@@A@@@AB@@@BABAB@BABAB@@CB@@AB@@AB@B@B@@@@@BA@C@AB@@AB@@ABA@AB@@@@@@AA@AA@AA@@A@@@@@@@@BA@@@@@AB@@A@A@@@@@AA@@@@@@@CFC@@@@@AC@A@@@A@A@A@@AA@@B@@A@A@@BA@A@A@A@A@@@AAA@@A@@@@AA@AA@@@AA@@@A@A@@@@@A@@@@@@AA@A@@A@@@@A@@@@@A@@A@@A@@@AA@@@AAA@@@A@@@AA@@@@AAB@@@@A@@@@@@@A@@@@@@AA@@A@@AA@@@AAAAA@AA@A@@@A@@@@A@A@@@AA@@AA@@A@@@ABA@A@A@@@A@@BA@@@A@@AC@AACAAAAA@@@A@@@AAB@@EBCBABEBA@C@CBA@ABCBABABA@AB@@ABA@CB@@@@A@@BA@ABA@AB@@@@CBA@A@@BA@@@@@@@A@@BA@@@A@@@@@@@AB@@A@@@A@@@@@AAA@A@@@ABA@A@A@@BBBA@A@@@@@@A@@A@A@ABA@ABA@@@@@@AA@@@A@@@A@A@A@@@A@@@@B@B@B@B@@@BAB@B@B@@@@AB@@@@AB@@@@@@ABA@A@@@A@A@@A@@@AA@@A@@@@@@A@A@@@A@A@AB@@@@@B@@AB@@@BAB@@@BA@@AA@@@A@@@A@@@AA@@A@@@@@ABA@A@A@ABA@@@@B@@@B@@@@@BA@AB@BA@@BA@@B@@@BA@@B@@@B@@@B@B@@@B@@BBBB@@@@BB@@@B@@@@@@@BA@@@@@@BB@@@@@@B@@@@@BBBB@@@@B@@@B@B@@@B@@@BBB@@BB@@@B@@@D@BBBAB@@@B@@@B@@@@AB@@@@@@A@@@A@@@@B@B@B@@A@@@@@@@A@@@A@@@@@DDDDCBCDAJ@BB@@BEBABCBCBCDCBCBAAAAEAC@CAEBC@A@@BABABC@E@G@C@E@CAAB@@@@@@@@BB@@@@@@@@@@@BB@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@B@@D@@BB@BB@BAB@@ABAB@B@B@B@BBB@B@@A@CBABABAB@@ABAAA@CAA@C@C@CBA@ABA@@B@B@@BB@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@AB@@@@@@@@@B@@@@@BB@@@@@@@@@@@BB@@@@@@@@B@@@@@B@@@@@@@BB@@@@@@@@B@@@B@@B@@@D@B@B@BB@@B@D@BB@@B@B@@@@@B@B@@@BB@BD@@@BB@BBBABBB@B@@B@@@B@@@B@B@@@@BBBBBB@BB@B@@@@@BB@@@B@@BBBB@D@@BBBBB@BBBAB@B@BBB@BBB@@@D@D@@@B@@@B@D@B@D@B@B@DBB@@BBD@@@@@@B@@@@@@@@@@@@@B@@@@AB@@@@@@@B@@@@@@@B@@@@@B@@B@@B@@@@@B@@@@BB@@@B@@@B@@@@@B@@B@@B@@@@@B@@@@@BB@@@@B@@BB@@@@@B@@@@BB@@@@@BB@@@@@@B@@@@@@@B@@BB@@@@@B@@@B@@@B@@@@BB@@@@@B@@BB@@@@@BBB@@@B@@@@@B@@@@@@@B@@@@@@@@@@@BB@@@@@@BB@@@@@@B@@B@@@@@@B@@B@@B@@@BB@@B@@BB@@@@@BB@@B@@@@@B@@@@@@@@@B@@@@A@@@@@@@A@@B@@A@@@@@@@A@@@@@A@@B@@@@A@@@@@A@@@@@A@@@@@AB@@@@A@@@@@A@@@@BA@@@@@@B@@A@@B@@@@AB@@@@@B@@@@A@@@@@@BA@@@@@@@A@@@@@A@@@@@A@@B@@A@@@@@@@AB@@@@@@@@A@@@@A@@A@@@@@@@A@@@@@@AA@@B@@@@@@@@A@@@@@@B@@@@@@@@@B@@@@AB@@@@@@@B@@@B@@AB@@@@@B@@@@A@@B@@@@@B@@@@A@@@@B@B@B@BADABADABAB@B@DAFAB@@@B@B@@@D@@@BABA@AD@@A@@@AAA@AA@@A@@@@A@A@AA@@@@@AD@@ABA@ABABA@A@@AAA@AA@A@@@C@@@A@@@A@A@@A@A@@A@@@A@A@@BA@@@A@@AAA@@A@@@A@@DA@@B@B@B@@@BA@@B@@A@AAABC@@AA@A@@@A@A@A@@B@@@B@B@B@BBB@B@B@@@BB@@B@B@@@BBB@@@B@@@@@B@@@B@@A@@BAD@BA@@B@@AB@@A@AB@@ABA@@BA@@@@@@BBB@B@@@B@D@D@B@@@B@@B@@B@D@BBB@@@B@@@BB@@@@@@@@BB@@@@B@@@@@B@@@BB@@B@@@@B@@B@@@BB@@B@@@DABAB@BA@@@@B@DBBBB@BBB@B@B@B@@@B@BBB@@BB@@@BB@@@@@@@@@B@@@B@@@B@B@B@B@B@@@B@@@@AB@@@@@@DBBBD@@BBB@@@@@@BB@@@@B@@B@@B@@@B@@@B@@@@@@@B@B@@BB@@@@@@@BB@@B@@@B@@@@@@@@@@@B@@B@@@@@B@@B@B@@@@@B@@AB@@@B@BBD@B@B@D@B@@@@@B@@@@@B@@@@B@@@@@B@@@@@@@@@@@@@B@@@DDB@B@@@@BB@@@@@@@@@B@@@B@@@B@BA@@BA@@@@B@@@BB@@B@@BBBBB@@BB@@@@B@@@@B@@@@@B@@B@@BBB@@BB@B@@@BBB@@@@@B@B@B@BBB@BBB@BB@@@B@@B@@@@B@@@@@@@B@@B@@B@@@@@B@@@@@B@@@@BB@@@@@@B@@@@@B@@@@BB@@@@@B@@@@@B@@@@@B@@BB@@@B@@@@@@B@@@@@@BB@@@@@@@@B@@@B@@B@@@@B@@@@@BB@@@@B@@@B@@@@BB@@@B@@@B@@@BA@@@@B@B@BAB@BAB@BA@@B@@@@@B@BA@@@@@@BB@@BBB@B@@@B@@@B@@@B@BAB@B@BAD@BABA@@@@@AB@@A@A@A@ABA@@@A@@@@@A@@@@B@@A@AB@@@DAB@BAB@B@BAB@@@BB@@@@@BB@@@@B@@@@B@@@@@@@@B@@B@@@@B@@@@@@@@@@BB@@@@@@@@@@@A@@B@@@@A@@B@@A@@@@B@@@@@@AB@@@@@@A@@B@B@BAB@@A@@BA@A@A@C@A@CBABAB@@@D@BAD@BA@@BA@AAA@A@@B@@@@A@@@AACA@@A@@BAB@B@@@DAB@DABAB@@@BA@@@@BA@@B@@AB@@@@A@@AA@A@AAAAA@A@A@A@A@AB@@AB@@C@@@A@@@@@@@AAA@@@AAAAAAAAA@A@@AAA@@@A@A@@@A@AA@A@@B@@@@@@@BA@@B@@@BA@@BA@@@@@A@@B@@@@A@@@A@@@@ACA@AAAAAA@A@@@A@@DA@AB@@@@@AAE@A@@@A@@AAA@@A@AAA@AA@@B@@A@@@@@A@@@AB@@@@A@@@A@A@A@A@@@ABA@@@@@AA@AAA@AAAA@AAA@@A@A@A@AB@@AB@@@@E@C@A@@A@A@@@@AA@@C@@A@@@A@@BABCB@BAB@BABA@@BA@@@@@AB@AA@@@AAA@AAA@A@@AAAAA@AA@@AA@A@@@AA@@A@@B@BABA@ABA@AA@@CA@@AAA@@AA@A@@A@@A@AAA@A@ABA@@AC@AA@A@@@@AA@@A@@B@B@BAB@@@B@D@D@B@@B@@@B@@@@@BB@@@B@@AD@BA@ABA@@@A@A@@BA@A@@@@@AAAA@@A@A@A@@BA@@DAB@@AB@@A@@BAB@@@BA@@@A@@BABA@@@A@@AAAAAAAA@AA@A@A@A@ABA@@@@@@AA@@A@@@@@@@A@A@AA@A@@@A@@@AA@@@@CAA@@@A@ABA@A@A@AB@@A@@B@@AB@@@@@@AB@@ABCB@@AB@@A@A@@@@@A@@@A@@@@@A@@@A@A@C@A@@@ABA@A@@@A@@@A@@@@@A@@@A@@@@@A@@@@@@A@@A@@A@@AA@@A@A@AAAB@@A@@@@@AB@@@@A@A@@@AB@@A@@@A@AAA@@@A@ABABA@@B@@@@@@@@A@@@A@C@A@A@A@A@A@A@@@A@A@AAAAE@C@A@CAC@C@A@A@@@C@C@A@C@ABAAC@A@@@A@A@E@C@C@C@@@A@ABAAC@A@AB@BAD@BBB@BBB@@FBHBF@B@DBB@D@B@@@B@@@@@B@@@@@@B@@@BB@A@@@BB@@@@@B@@@@A@@@@@@B@@AB@@@B@B@@@BB@@B@@@@@@@B@@A@@B@@@B@@@@@BA@@@A@@@AB"],encodeOffsets:[[129227,42698]]}},{type:"Feature",id:"220523",properties:{name:"辉南县",cp:[126.046783,42.684921],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@@@A@@@@@@@AA@@@@A@A@@@@@@BAB@@@@@@@@@@@@B@@B@@@@@@@@@@@B@@A@@@@@@B@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@B@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@AA@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@B@@@@@BA@@@@@A@@@A@@@@B@@A@@B@@@@@@@@AA@@A@@A@@@@A@@A@@@@@@@A@@@@@@A@@A@@A@@@@@@@@@@AB@@@@@A@@A@@@A@@@@@AA@@@A@@@@@A@@@@@@B@@A@@@@@A@@@A@@AA@A@@@A@@@@@A@A@@@@@A@@@@@A@@@@A@@@@@@@@A@@@@@A@@@@@@@@B@@@@@@@@@@@@@@A@@@@AA@@@A@C@A@@@A@AD@@C@@@A@AA@@E@A@A@@@@AAAB@@A@@@@A@@@@@@BA@@@AAA@@@@B@@@@@B@@@@@B@BA@@AA@@@@@@A@@A@A@AA@BC@@@A@@@@@@A@AAA@@A@A@A@@@@@A@@BAB@@@@@B@@@@A@@@@A@@A@@B@@C@@AAA@@A@A@@@A@A@@@@B@@@B@@ABA@A@ABB@@BB@DDBDDF@B@D@B@BA@@@BB@@@@AB@@CBAB@@@B@@B@BBB@@@@B@@@@BB@B@@@BC@@@@B@@@@A@ABA@@@A@A@A@@@@@@@A@B@A@@BA@A@@@@@BB@@@@@@@@AB@@@B@@@B@@BB@@@@@@@@@@@@A@@@@BB@@@CD@@@B@@@@ABA@A@C@@@@BA@@B@V@@A@@@@AA@@@ABA@AB@@BD@@B@ABBB@@@B@@@BB@HBFDB@BB@@AB@@@B@@B@@B@@@@@B@B@@@@@B@@@B@@@@@@B@@@@BB@@BB@@@@BA@@@@@A@@@A@@@@B@@A@@@@@A@@@@@A@@@@@@@A@@@A@@A@@A@@@@@A@A@@@A@@@@A@@A@@@@@AA@@A@@@@A@@@@A@@@A@@@A@@@@A@@A@@@@@@AA@@@@@A@@@@@A@@@A@@@@@A@@A@@@@A@@A@@@@A@AA@@A@@@AAAA@@C@A@CBA@A@CAA@G@A@C@CBA@CBA@G@A@A@A@@@ABEBEB@@ABA@@AA@A@@@@@@@A@@@@B@@@@A@A@E@@@A@@@A@A@A@ODIDAD@BA@C@C@GBCBA@A@AB@@@@@@@@@@B@@B@@@@@@@B@@@@@B@B@D@@@@BB@@@@@@@@@@B@@@@@@@@@@B@@@@B@@@B@@BB@BBBBB@BB@@@@B@@B@@B@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@BB@@BB@@BBB@@@B@@BB@B@B@B@B@B@@@@@D@@@B@@@B@B@@@B@@@@@@@B@BB@@B@@@B@@B@@@B@@@BB@@B@@@@@B@@@@@@@BA@@@@B@@@@@D@BA@@@@B@@@@@B@@@B@@AB@@@B@@@@B@@@@@@BA@@B@@@B@B@@BB@@@BB@@BB@@@BB@@@BBB@B@@@B@@@B@@@B@@@B@@@@@BBB@@@B@B@BBB@@BBBBBBB@BB@B@@BBBBDD@@B@@BD@@BB@@@@@@@@@@BABAB@@AB@@@@@@AB@@@@@@@B@@@@@@@@@BBB@BBB@@@B@@@@AB@@@@@@@@@BA@@@@@@@@@@B@@@B@@AB@@@BBD@@@BB@@B@@A@@@@@@@@BA@@@@BABA@@BA@@B@@@@@BA@@B@B@@AF@BADG@A@C@A@E@C@E@C@A@EA@BA@@@@BA@@@@@@@A@@B@@@@A@@B@@@@@@A@@@@B@@@@@@@@@@AB@@@@@@@@@@@B@@@@@@@@@B@@A@@@@@@B@@A@@@@B@@A@@@@@AB@@@@@@@@@@@@@B@@@@@@AB@@AB@@@@@@A@@B@@A@@@@@AB@@@@@@A@@@A@@@AB@@@@A@@@@@@@AB@@@@AB@@@@A@@@@BA@@@@@@@A@@@@@@@@@A@@@@BA@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@B@@@B@@@@@B@@@B@@@@@B@@A@@@@@@B@@@@@@@B@@AB@@A@@@@@@@ABA@ADCBAB@@EA@@AB@@AB@BC@@BCDA@C@@@@@AB@BA@A@@BBB@@@BBDDD@@@BABA@@BABBD@B@@A@@B@@BD@@@BED@B@BB@@B@@@@AB@@CBAD@BAB@@A@@BA@AB@B@B@B@B@B@@AB@@@BAB@B@@@BCB@@A@A@AB@@@@AB@B@B@@AB@@BF@D@BB@@B@@CH@DEF@@@BAD@@@BA@@BAB@@@@AB@ACA@@A@@@A@@@@@@@@B@@A@@@@@@B@@A@@BA@@@@@@@@BA@@@@@@BA@@@@@@@@@@@@BA@@@@B@@@@@@@@@B@@@@@@@@B@@B@@@@@@@@@BAB@B@@@@@B@@A@@@@BA@@@@@@@@BB@@@@@@@@@@@B@@@@@@@@@AB@@@@@@@B@@@@@@@@@@ABA@@@A@@@A@@B@@@@AB@@@@@@A@@@@@A@@@@@A@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@BA@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@B@@@@B@@@@@@AB@@@@A@@@@@A@@@@A@@@@@@@@@A@@@@@@@@@@@@@@A@@@AB@@A@@B@@A@@@A@@@A@@B@@@B@@@B@@@@@@@@@@@B@@@@@@B@@@@@@A@@@@@@@@@@BB@@@@@@@@BB@@B@@@A@@@@@@@B@@B@@@@A@@@@@@@A@@@@@A@@@@@@B@@@@B@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@BB@@@@B@@@@B@@@@@@@@A@@@@@A@@@@@A@@B@@@@@@@@B@@B@@@@B@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@B@@@BB@BB@@@@B@@AB@@A@@B@@@@@B@@@@B@@@B@@@@@B@@BB@@AB@@AB@@@B@@@@@@@@@B@@@B@@@B@@A@@BA@@@@B@@@BB@B@@@B@@@@@@@BBB@@@@@@B@@@@@B@@@@A@@B@@@@BB@@@@@@@@@BB@@BB@@@B@@@B@@@BB@@@@@@@B@@@@AB@@@@@B@B@@@B@@@@BB@@@@@@@@B@@@@B@@@@@D@@B@@B@@@@B@@@BB@@@@BB@@@@B@@@B@@@B@@@BB@@@B@@AB@@A@@@A@@B@@@@@B@@@BB@@@B@@@B@@@@B@@@@AB@@@@@B@@@@ABA@@@@B@@@B@@@B@@@@AB@@@B@@@@@B@@B@@B@@@@@@@B@@@@BB@@B@@@@@@B@@@@@@@@@@@BA@@B@@A@@B@@A@@B@B@@@B@@B@@BB@@@@@B@@@@B@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@DB@@B@@@@@@BA@@B@@@@@B@@@@@@@B@@A@@A@@@@@@A@@@@@@@@@AA@@@@@@@@@@@@@@@@@@A@@@A@@@A@A@@BA@ADA@@@@@A@@@A@@@A@@AA@@@A@@@A@A@ABA@@@@@@@@@@@A@@@ABA@@@@@@@@@A@@A@@@A@A@AA@@AA@@A@@@@AA@@AAA@A@A@@@@@@@A@@@@B@@@@@BB@BBB@@@@B@@@@AB@@A@A@@@A@A@A@@@@A@@@@A@A@@@A@@@@BAB@@A@@@A@@@A@@@@@@@AB@@@B@@B@@@B@@@@@@@@BA@@@AB@@A@@@@B@@@@AB@@A@@@@@A@@C@@A@@@@@A@@@@B@@@BAB@@@@A@C@A@@@A@@B@@@@@@@BAB@@@B@@@@@@B@@@B@@@@@@@@@@@@B@@@@@B@@C@A@@@A@C@A@A@A@A@A@A@A@A@@@@@@BB@@@@B@B@@@@@B@@@@D@@@@@B@@@B@@@@A@@@@@B@@B@@@@@@@@@@@@@@B@@@B@@@@@@@B@@@@@@@B@@@@@B@@@B@@@@@@@@@B@@B@@@BB@@B@@B@@@@B@@B@@@@BBBD@@@@@B@@@B@@@B@@@@@@@@B@@B@@@@@@B@H@B@B@B@@@B@@@@B@@@@@@@@B@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@@A@@@@B@@@@@@@@@@A@@@@B@@@@@@@@@BB@@@@@@@@D@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@D@@@@@@@B@@@@@B@@@@@@@@@@@@B@@@@BA@@@@@@@@@@B@@B@@@@@B@@@@@B@@@@@@@@@@@@@B@@@@@@B@@B@@@@@@@@@@B@@@@@@B@@B@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@B@A@B@@B@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@BDDB@B@@B@@@@B@B@@BBBB@@@BB@@BB@BBB@B@@B@@A@@BAB@@@@A@@@@B@@@BA@@BAB@B@B@@@B@@@@@BB@@@D@BB@@@B@@B@@@@@@@@BB@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@BB@@@@@@@@@@@@@@@B@@@BB@@B@HFB@@@@@BG@@@@B@BA@@@@B@@A@@B@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@B@@@@@@@@B@@B@@DABBD@B@@@@B@@@B@B@D@@D@@@@B@@@AB@@@@ABB@AB@FCBD@@@@@BA@@BBB@BBB@@BB@B@BDD@@BDB@BD@BBBHDBB@BDBA@@DADAB@B@B@B@@@B@@@@@B@@@@@BBA@@@BB@@@@@@A@@BB@@@A@@B@@@B@@@@@B@@B@@BA@B@@BA@@A@@@B@@AB@B@@AB@@@D@@@@@@B@@@BDA@BBBB@@@@A@@@@@A@@@@@@@@B@BB@@@@@@B@@@BB@@BA@@@@@BBB@@@@B@@@@AB@@@B@@@B@@@@A@A@AB@B@BA@@@@@B@@B@@@@A@A@@@@BB@A@@@@@@@@@ABBB@@@B@@@B@B@BA@@BBBBBBB@B@B@B@B@@@@@@A@@B@B@@@B@BA@@@@@BB@AB@@@@@@@BB@BA@@B@B@@BD@@@B@D@B@D@BAD@@@@@B@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@BB@@@@@@@@@@B@@@@@@AA@@@B@@@@@@A@@@@@B@@B@@@@@@B@@@@@B@@@@B@@@@@@@@@@A@@@@@@@@@@B@@@AB@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@B@@@B@@@B@@@@@@@@@@B@@B@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@B@@@@@@A@@@@@@@@BA@@@@@@@@@B@@@@@@B@@@@@@A@@@@@@@@@A@@B@@@@@BA@@@@B@@@@@B@@@@B@@@@@@BB@@@@@@@@@@@@B@@@@@@@@A@@B@@@@@B@@@@@@@@@@A@@B@@@@@@@@@B@@@@B@@@@B@@@@@@@B@@@@@@@B@@@@@@A@@@@B@@@@@@@@@@@@@@@@AA@@@@@@@B@@@@@@@@A@@B@@@@@@@@A@@@@B@@@@@@@@BB@@@@@@@@@@A@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@A@@A@@@@@@@@@@B@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@B@@B@@@@@B@@@@@@@@@@@@@@BB@@@@@@@@@@@@@B@@B@@B@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@B@B@B@@@B@B@B@@@B@@@@@@@B@@@@B@@@@B@@B@@@@@@B@@B@@@@@@@@@@D@@@B@@@@A@@BA@@@@@@B@@@@@B@@@B@B@@@@@B@@@@@D@@AB@@@B@@@B@B@@@@@BA@@@A@@@@B@@@@@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@@@@A@@@A@@@@@@@@B@@A@@@@@A@@B@@@@@B@@A@@@@@@AA@@@@A@@@@@@A@@@@@@B@@A@@B@@@@A@@@@B@@A@@B@@@B@@@@@B@@@B@@@@@@AB@@A@@B@B@@@B@@BB@BAD@B@B@@@B@D@BBDBB@BBB@@B@@@BA@@B@BBB@BB@B@DBB@BAD@B@BBB@B@B@D@B@B@BBDBBBBDBBBB@DBBBBBBFBFBD@D@B@DAD@D@HBHBDBDBBBBBBB@@@B@B@@DFBDBDDBDBDBD@DBD@BAB@L@D@@AB@@@BAB@DADARIBAD@DAD@D@B@D@DBB@DBFHBD@@DDDBDBH@FBF@DAFAFAFCBADEBCBA@C@A@ABC@A@C@C@AA@@AA@AA@AAAAAAA@A@A@A@@BA@@@@@A@@A@@@@A@@@@A@@@@@@B@@@CCA@@@AB@@A@A@@@A@A@@BA@A@AB@@@@AAA@@BABA@@@ABA@@@@@A@@BA@@D@BAB@BA@A@@ACCCA@AAAA@@@ABA@A@@@@@@AA@@@ADCB@@@@@@A@@@@BA@@BA@A@@@@@@BC@@A@@ABA@@@@@@BA@A@@DA@@@AAA@@@@@@@@AA@@A@A@@@AA@@A@A@AAAAA@A@@@@AB@A@@A@A@AA@@A@A@@@A@@AA@@BA@@@@@AA@@@B@@A@@@@@A@AAACAA@@@BA@A@@@A@@@@AA@@@A@AA@@@@A@@@AA@@@@AA@@@DA@@@@@A@AD@@A@@@A@ABAB@@A@@@@@A@@@@BA@@@@@AA@B@@A@@B@@@D@@@BBB@@@BAB@B@BAB@@@@@BA@@B@@@FA@AB@AABA@A@A@@@@B@@AB@BA@@@AB@@A@@BAB@D@@@B@@A@@B@@@DADA@AB@@@B@@AD@B@D@B@F@B@B@@@BADCBABA@@BB@@BB@@D@@@D@B@@@BB@@FAB@DB@@@@@BB@B@B@@@D@F@D@B@@BBBB@D@@BBBD@BBB@BAB@BB@@BB@@@@BAFB@@D@@@B@@@B@@@B@@@FB@A@AB@@@BCB@BA@@B@B@D@B@@@BBBB@@D@BB@@@BB@@B@@BAD@B@BBB@@@B@@BB@@@@@B@@A@@B@@@B@@@BAB@@@@@@A@@@A@@B@@@@A@@B@@@@@B@B@@@B@B@B@@AB@B@@@@@B@@@BA@@@@DA@@@@@@@@@@B@@@B@@@BB@@@@@A@@B@@ABABCBC@@B@@AB@@@@@B@@AD@B@@AB@@@B@@@BA@@@@@@B@@@B@B@B@@@@BD@@@B@B@B@@@B@@@B@@@B@@@B@@@@@@@B@@@@@@@@@B@@BB@@@B@@@B@@@B@@@B@@@B@@@@@@@B@B@@@@@@@BB@@@@B@@@AB@BBB@BB@BD@B@@@B@@@B@@@@AB@@@@@B@@@@B@@@@B@@@B@B@@@@@B@@B@@@@@B@B@@@@@B@@@@@@@BB@@@@@@@B@@@B@@@B@@@B@@B@@@@BB@BBBBD@BBB@B@B@@B@@@@@@B@@@@BB@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@B@@@@@@@@@B@@@B@@@@@B@@@B@@@B@B@@@B@B@@@@AB@@BB@@@B@BB@@B@@BB@@@@@@@B@@B@@@B@@@@@B@@@@@B@@B@@B@@@B@@B@@@BBB@@@@@B@@@B@@@B@@@@@B@@@BB@@B@@BBB@@BB@@@@B@BBD@@B@@@@B@@B@@@@BB@@B@DB@@B@@@@@B@@@@@BA@@@@@@@@B@@@B@@@@@B@@@@@B@@B@@@@@B@@B@@@@BB@B@B@@B@@B@@@BB@@@@@BB@@B@BB@@B@@@@B@@BB@@@B@B@@@@@B@@B@@B@@BB@@BB@BB@@BBBBB@@@@B@@BB@A@@@@@A@@B@@AB@@@BAB@B@B@@@@@B@@@@@B@BB@@B@@@@@B@@AB@@@BAB@@A@@BA@@@@B@@@@A@@@AB@@@@AB@@@B@@AB@B@@@B@@A@@BA@@@@@@B@@@@@@@@@BB@@B@@@BB@@B@@@B@@@@@B@@A@@BA@@B@@@@AB@@@B@@@@A@@@A@@@AB@@@@A@@@A@@@@@@@AA@@A@@@@@@@A@@@@BA@@BA@@B@@A@@@@B@B@@BB@B@@@B@B@B@B@BAB@@@B@@@B@@@B@@@B@B@@@@@@A@@@@@@@@BAB@@AB@@@BA@@BA@@B@@@B@BAD@DAB@BAB@BAB@B@BAB@@@B@B@B@B@@@B@@@B@@@D@BAB@B@B@B@B@B@@C@A@C@@@@@AB@BA@@B@BAB@@@@AB@@ABA@@@CB@@@BC@@@@BA@@@ABABABA@@@ABA@@BA@@@@@AB@@@@A@@A@@A@@AA@@@@@@AAC@AAA@CAAAAAAAAA@AB@@A@A@AB@@AB@@@BAAA@A@CAA@AA@A@A@@@@@A@@@@@@A@@@CCCACAAECAAAAA@@@@A@A@@@AA@ACAAAAAAAAA@A@@ACAAAB@@@BA@@@A@C@A@EA@@CAAAC@@BA@@@AA@A@@AACA@@AA@CA@@A@@@AA@@A@@AA@A@ABA@AAE@A@ABC@A@@@A@A@A@A@C@@@A@@A@@@C@ABA@@AAA@CABC@A@AA@@BA@@BA@A@ABA@@@A@@@@A@A@@A@@AA@AA@@A@AAAA@@A@@@@@ABA@@@AB@@A@A@@@AA@AAAA@A@A@C@A@ABABA@A@A@A@@@A@@@@@A@AAA@AAAAAA@A@A@A@AA@AAAAC@@AA@A@ABA@C@A@A@C@A@@@AAAACC@AAAAAAA@@@@BABAD@@AB@@ABABA@@@A@@BA@A@@BABA@@@A@@AA@A@A@A@ABA@ADC@@@E@C@AA@@AAA@@@A@@@A@@@@@A@@BA@@@A@A@@@A@@@AD@@@@CB@BA@AB@@A@A@@@@BA@@B@@A@@@@AACA@AAA@@@A@@BA@@BABAB@A@AAAA@@A@@AA@@@@@@AAA@ABA@A@@@A@A@@BA@@BAB@@A@@BA@@CA@@@@@A@ABABA@AA@@AA@@AA@@@A@A@@A@@@@@@@A@@@C@@AA@@@AA@@@@@@@@@@@@@@C@C@@AA@A@@AA@AAA@ABAB@BA@ABA@@@@@A@@AAB@@@@A@AA@@AA@A@@A@@AAA@@AA@@AA@BA@@@@@ABA@ABAA@@@AAA@A@AA@AAA@@CAAAAAAAA@A@C@@AA@@AA@@@A@EBEDCBA@E@C@IAE@K@A@A@ABABA@@@A@A@@A@ACAAAAC@A@A@A@@AA@@@@A@@@@@@AA@@@@@@AAAA@@@@@AACA@@@A@@@A@@AA@@AA@AA@@@A@@B@@A@A@@@AA@@@@@@@@A@@AC@@@CAAAAAAAAAA@@AA@@@@@@A@@A@@A@@@A@@ECA@A@AA@@A@AAA@@@@@A@@@@@A@@@AA@@@@@@AACA@A@@@@@A@@@@@AAAAAA@A@C@A@AAACCCAAAAA@@@@@@@AA@@AA@A@@A@@@AA@@A@@@@@@A@@A@@A@@@@@@A@@AAB@@AAB@BA@@@@BA@@@@@@@@@A@@@C@ABAAA@@@@@A@@BA@@DADA@@BA@@BA@@@@@A@@@A@@@AAA@@@AA@@A@@@A@@@@@@@A@@AA@A@AAA@A@A@@@EBABA@A@A@A@A@A@A@AB@BAB@@@BADA@AB@@A@ABABAB@@ABABABAFABA@ABCB@@C@@@A@@@A@@@AA@@AA@@@@@@@B@B@@@B@DC@A@A@@@AA@@@@A@@@A@@@@AC@@@@@A@A@@@@@A@@A@@A@@@A@@@@AA@A@AAA@A@A@AAAAAAAA@CAAA@AA@@A@CAC@A@CBABA@A@ABA@AB@BG@A@A@AA@CA@ACAAEC@@A@A@A@@@A@A@A@AC@C@C@CAC@AAA@@@AAAA@@AAA@A@A@C@A@A@@@A@G@ABC@ABAAAACAECCA@@C@CAABC@EBKBEBG@@@@@A@@@@@@@@@@@@@@@@A@@A@@@@@@@@@A@@@@@@@@@A@@@@@AB@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@A@@@A@@@@@@@A@@@A@@@@@@@A@@B@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@AA@@@@@@@AA@@@@@@@A@@@@@@AA@@@@@@@@@AB@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@B@@A@@@A@@@AB@@@@@@A@AB@@@BA@@B@B@@AB@@@@@B@@@@AB@@ABA@AB@@C@A@A@A@AAA@@ACAC@AAC@A@A@C@A@A@EBCB@@A@@@A@A@ABA@ABA@A@A@CAC@A@AAA@E@C@A@C@@@AAA@@AC@C@@@A@C@@@A@ABA@A@A@AA@@@@@@AE@@A@A@A@@@C@CBA@C@@@C@@ACACAAAAAA@AACAA@@ABA@AB@AAAAC@CAC@AAA@A@@@A@A@@@C@@@A@@BA@@@@BA@@@AAA@A@C@IBC@A@CA@@A@C@AAA@AAA@@@@AA@@@A@A@A@@BA@A@@@@@BBC@@@@@@B@@A@@@"],encodeOffsets:[[129327,43290]]}},{type:"Feature",id:"220524",properties:{name:"柳河县",cp:[125.744735,42.284605],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@AAA@AAA@@@@A@AAAA@@A@@@A@@@A@AA@@A@A@E@@@@@A@@A@@@@A@@@AB@@A@@@A@AAA@A@AAA@@@AB@@A@@@@@AA@@A@@@AA@@@AA@A@@@ABA@A@@BA@A@@@AAA@@@@@A@@@@@A@A@A@AB@@A@A@@@A@@@A@A@A@@B@@@B@@@DB@@BB@@B@@@BB@@@@@@B@@@@@BK@AB@@A@@@@@@@@@A@@@@BAD@@CDCB@@@@@@ABAD@DA@@@CAC@A@CBABC@C@GAE@C@A@A@@@ADAB@B@B@@@BBBA@@BABADA@CB@@C@C@A@I@EBA@CBAB@@@@A@A@CBA@EBA@A@C@ABABABABAD@@CF@BA@B@ABA@C@A@CAA@@@CDABGBA@E@@@BB@HBDB@DBBB@B@B@DBB@@@BA@@BB@@@@@JBD@B@DD@@D@D@DADBD@BB@@@@@B@@BDBBDBBBB@D@B@DBDBFBD@BB@BBDBB@B@@B@@D@D@B@@@DB@@BB@B@F@@@BADABAB@@@DBDBJBB@FBB@DBD@B@@@@@@@@B@@A@@@AB@@@@@@@B@@@B@@@B@B@@@@@BAB@@@B@B@B@DA@@BA@A@@B@@@@@@@BDBBBBBB@BBB@BB@B@@BB@@@@@@BB@@@@BB@@B@@BB@@@@BB@@@@B@@B@@B@@@@BB@@@BB@@@BBB@BBB@B@@@@@BB@@BD@@@BB@BBB@@B@@BBB@DD@@@@@@BB@@@@@BB@@@BB@@B@@BB@@BB@@@@BB@@@@@BB@@BB@@BB@@@@@BBB@BA@@B@@BB@@@@BBB@B@@B@@BB@B@B@@@@BBB@B@BBB@@@B@B@@@@@BB@@B@@B@@@@@B@@@B@@B@@B@@BB@@@@@BAB@@@@@B@@BB@B@@@B@@B@@BB@@@@@BBB@@@@B@@@@@B@@AB@@@BB@@B@B@@@B@@AD@@@@@BB@@@AB@@@BCBA@@@EB@@@@A@@B@@@BA@@B@@@@@B@B@B@@AB@@A@@BA@@B@@@@@BB@BBB@@@B@B@@@B@B@B@@@B@@@B@BA@@@@@@BABB@@B@@@B@B@B@B@@@B@@@B@B@@BB@@@B@@@BB@ABA@@B@B@@AB@@A@@B@@@BB@@@@BAB@B@B@@AB@@@BB@@B@B@@BB@B@@B@@@BB@B@@@B@@@BB@@B@@B@@@B@@@B@B@@@@@BB@@@@BB@@B@@@B@@@BAB@@@@@B@@@@B@@@BAB@BA@@@@B@@@B@@@B@@@B@@@B@@@@B@@@B@B@@BB@B@@@B@@@@B@@BB@B@B@@BB@@@@@@@BBB@@@BB@@B@@AB@@@@@B@@@B@@B@@@B@@AB@@@@@BA@BB@@@BB@@@B@BB@@B@@BB@BBB@@@B@B@@B@@B@@@BBBB@B@B@BB@@@@@@B@@@@ABA@A@ABA@@@AB@B@@@B@@@B@@@@ABA@@BA@@@AB@BA@A@A@A@@@A@@@@@A@@@@@@BBB@@@B@@AB@@AB@@A@A@@@AB@@@@@@@B@@ABCB@@@@AB@BA@@@ABA@@@A@@B@BA@@@@@@@A@@@A@A@@@@B@@@@@@A@@BA@@BA@@B@@@@@@@@@@B@@@@BB@@B@@B@@B@@@@@B@@@B@@@B@@@@AB@B@@B@@BA@@@@B@@B@@B@@@@@BB@@@B@@B@@B@@B@@@@@BB@B@@@B@B@@B@@@@@@BB@@B@B@@B@@@@B@D@B@B@B@@AB@@BB@B@B@D@@@@@@@B@@AB@B@B@@@B@@@B@B@B@B@@@@@B@@@BB@@D@B@B@@B@@B@D@B@@@B@@@BBB@@@@@B@@AB@@@@@B@B@@@B@@@B@@@B@B@@AB@@@DA@@B@BABAB@@@B@@@@@BA@@B@B@@@B@@@B@BB@@B@@BB@@@B@@BBB@@@@B@@BBB@@B@@@BAB@B@B@@@AB@@@@@@@BA@@@@BA@@B@@BD@@@B@@AB@@A@A@AB@@@BA@@D@@AB@BAB@B@@@@@@@B@@BB@@@@@B@@@@@BB@@@@@@BDB@@@B@@BBB@BBB@B@@BD@BBBBF@BBBBBDB@@@@B@@@B@B@@A@@@@B@@AB@@@@B@B@@@BB@@@BB@B@@@BBB@DBB@B@B@@@@BD@@BB@B@B@BBBBBAB@@BB@B@H@BBBB@@AB@@AB@DAB@BA@A@@@ABAB@@AB@B@@@D@B@@ABA@@@AB@@A@@B@@@@@B@BA@@B@B@B@@B@@BB@BBB@@@D@BBDBBB@B@BB@@@B@BB@@@@BBBA@@BA@@@@@@@ADA@@B@BB@@BB@@@B@@BB@@@@B@@@BB@BBBBDB@@@@@B@@@@@@@@AB@@@@@@@B@@@@@BA@@@@B@@@@@@@@@B@@A@@@@@@B@B@B@@AB@@@@BB@@@@@@@@@@A@@@@B@@@@@@@@@B@B@D@@@B@B@B@B@D@B@B@@AB@B@B@BA@@B@@@@@B@BB@B@@@BB@@B@@AB@@@BB@@B@@BBB@BB@@@BBB@B@B@@BB@@@BBB@@BBB@B@@@@B@@@BB@@BB@AB@BAFAB@B@@ADAD@D@B@@@B@BA@@@C@@@@A@@A@@A@@A@@@@AA@@A@@@@@@A@A@A@A@A@@@A@CAA@@B@@ABA@ABA@@@@B@@@@BBBBB@@@BB@@BA@B@@@BB@B@@B@B@@A@@@BB@@CBAB@BABA@ABAD@@AB@@@BAB@BABA@@B@@@@@@A@@B@@B@@@@B@@B@@B@@@@@@@@BB@@@@BA@@B@@@B@B@@@B@B@@@B@@BB@B@B@@@@@@@BBB@@@B@@BBBBB@@BB@@B@BB@AB@B@@@BA@C@@@@@AB@@@@@FBB@@@@A@A@@@@B@FAB@@@D@@@B@BA@A@CB@@@BADABAF@B@B@D@@@BB@@@BB@B@@BB@BB@@@B@BDBBBBBBBB@D@DBBBB@@@@B@B@@BD@D@@B@@BBB@@@@BB@@BB@BB@@BB@BB@B@@@B@@AB@D@@@@@B@@@B@BA@BB@B@@BB@@@@@B@@B@@BB@@@@B@@@BB@@@@@@@B@@@BB@@@BB@@@B@B@DAD@B@BA@A@@B@@A@@B@B@@A@@@@B@@@BB@@@@B@@@B@B@@@B@@@BAB@@@B@@@@@@AB@@@@@@A@@@A@A@@@@BABA@@B@@@@@B@@A@@B@@@B@BAB@B@B@B@B@D@D@B@B@@ABA@A@@@AB@BBDADA@@B@@BB@@@@@@@@@@@@@@@@@B@@@@A@A@A@@@@@@B@@@B@BA@@B@B@DBD@B@@@F@@D@B@@B@DBBBB@@B@@B@BB@@B@@B@@B@@@@@@@DCB@DCB@BA@A@AB@@@B@@@@@@@@@BB@@@@@@@@@B@@@@@@@@@@BB@@@@@@@B@@@BB@@@B@@BBBB@@@@B@@BB@@@BBBB@B@@B@B@@@B@@@@B@@@B@@@@@@@@B@@D@B@DBB@@@B@B@BBB@BBDBB@B@@@@B@BFABDBBFD@BBBB@@@BABB@@B@ADB@B@@A@@@@@@@@@@@@@BB@@@@@@@@@@@@@BBBB@BB@DD@@D@BBBBB@@DB@@B@@@BB@@@@BBBBD@@@B@B@@@BAB@@ADAB@@@DHA@@B@PAFAB@FAD@DADABAB@B@@@B@BAB@D@B@@@@B@@AB@B@@BBBDBB@@@B@BAD@@BB@BB@@@B@B@@DB@@@@BB@B@B@B@@BB@@@@@BBB@@BBBBB@B@@BBB@B@BB@B@@@BB@@D@BB@@@@B@@BB@B@B@B@@@@@B@B@@@@@@@B@@@@@@@@@@@@@@@@@BB@@@@@@B@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@BB@@@@B@@@@@BB@@@@@@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@BA@@@@@@B@@@@@@@@@@BB@@@@@@@@@@@@B@@@A@@@@@@@@@@@@BA@@@@@@@@B@@@@@@@@@@@BA@@@@@@@@B@@A@@@@@@B@@@@@@@B@@@@A@@@@@@@CBB@B@B@@@@@@B@@B@@@@@@@@@@@B@@@@B@@@@@@B@@@@@@B@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@BB@@@@@@@@@@@B@@@@@@@@@@B@@B@@@@@@@@@@BB@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@B@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@B@@@@B@@@@@B@@@@@@@@@@@@@@@B@@A@@@@@@B@@B@@@@@@@@@AB@@@@@@B@@@@@@@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@B@@B@@@@@@B@@@@@@@@@@@BB@@@@@@@@@@@@@@@BB@@@@@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@B@@@@@@A@@@@@@@@@@@@@B@@B@@@@B@@@@@@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@BB@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@B@@@@@@@@B@@B@@@@@@@@@B@@@B@@@@@@@BB@@@@@@@@B@@@@@B@@A@@@@B@@@@@@B@@B@@@BB@@@@@BBB@@@@@@@B@BB@@@@@B@@BB@@@@@@BB@@@B@@@@@B@@@@@@B@@@B@@@@@B@@B@@@@@@B@BB@@B@@@@@BB@@@@@@B@@B@@B@@@@@B@@@BA@@B@@@@@@@@@@@@@B@@@@@@BB@@@@@B@@@BB@@@@@@B@BB@@@@@@B@@@B@@@BB@@BB@@@@B@@BB@@@B@@@@@@@B@@@B@@@@@@@B@@BB@@@@@@@@B@@@@@@BB@@@@B@@B@@@@@B@@@@@@BB@@BB@@@B@@B@@B@@A@@B@@B@@@@@@@@@@@@@@B@@@@B@@@B@@@@@B@@@B@@@@@@@B@@@@B@@B@@B@@B@@@@BB@@@@@@@B@@B@@@@BB@@@@@@B@@@@@BB@@B@@@B@@@B@@@@B@@@@BB@@@@@@BB@@@B@@B@B@B@@BB@@@@@@@@@@@@BB@@B@@@@@@BB@@@@@@@B@@@@@@@@BB@@@BB@@@@@B@@@@@B@@@BB@@@@B@@@@B@@@@@@@@BB@@@B@@@@@@@@@@@@@B@@@@@@@BB@@@B@@@B@@@B@@@@@@@@@@@BB@@B@BAD@B@B@B@D@@@B@DBDBB@BBFD@B@@@@AB@@@@@B@@@@@B@@@@@@@@@@@B@B@@@@@@BB@@@BB@@@@@@@@@@BB@@B@@@@BB@@@@@@@@B@@B@@@@@@B@@@@@B@@BB@@@B@@@@B@@@@@@@@@@@B@@B@@@@B@@@@@@@@@@@@BB@@@@@@@@@B@@@@@@@B@@@@B@@B@@@@@B@@B@@@@@@@@@@@@@@@@@@B@@A@@@@@@B@@@@@@B@@B@@@@@@@@@@@@@@@B@@BB@@@B@@@@@B@@@@@@@B@@@@BB@@@B@@@@B@@@@@@B@@@@@@@BBB@@@@@BB@@@B@@@@BB@@@@@@B@@@B@@@B@@@@B@@B@@@B@@@@@@@@@@@@@B@@B@@B@@BB@@@BB@@B@D@@A@@@B@@BBB@@BB@@@@B@@@@@BBB@@@@@@@BB@@@@B@@@BBB@@B@@DB@@@@@@@@BB@@@B@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@B@@@@@B@@@@@B@@@@@@@BA@@@@@AB@@@B@@@B@BA@@@@@@@@@@@A@@@@@@BA@@@@@@@@A@@@@@@@@@@@A@@A@@@@@@B@@A@@B@@@@A@@@@@ABA@A@@@@@@@@@AA@@@@@@@@@@A@@@@@@@@@A@@B@@A@@@@@@@@@@B@@A@@@@B@@A@@@@@@B@@AB@@@@A@@@@B@@@@A@@@@@@B@@@B@@@DB@BBA@@@@BA@AB@@A@@BC@A@@FE@CDG@@@AA@@A@CAE@@BA@@@A@ABA@@@@BAB@B@@@DA@A@@@ABA@A@@BA@@@A@A@A@A@ABAB@@AB@@@BA@ABCDA@@BA@@@@@AA@@A@AFC@A@@AC@@@AB@@@@AACBA@AB@BA@A@@CCAC@A@@AA@AB@B@@ABA@@@@D@B@DC@AD@@ABA@@BA@@FB@@BADABCB@BA@@@@@@B@@@BA@@@A@@@@@@@A@@@@B@@@@A@@@@@A@@@A@@@@@A@@@A@@@@@@@@@@@@@AB@@@@@@@@@@@@@B@@A@@B@@@@@@@@@B@@@@@@@B@@A@@B@@@@@BA@@@@BA@@@@@@B@@@@@BA@@B@@@B@@@@@@@BA@@@@B@@@@AB@@@@@@@BA@@BA@@@@@@@A@@@@@@@@@@@@BA@@@@B@@@@A@@B@@@@A@@@@B@@@@A@@@@@@@@@A@@@@@@@@@@BA@@@@@@@@@@@A@@B@@@@@@@@AB@@@@@@AB@@@@@@@B@@A@@B@@AFBB@D@F@D@F@B@D@B@H@BC@ABE@@@A@AB@@A@@@@@AB@@AB@BA@A@@B@@A@@@@@@B@@@@AA@@A@@AC@A@@BA@@@A@@@A@@@@@@@@B@@A@@@@@@@@BA@@@@@A@@AA@AAA@A@@@@@@@@@A@@@@@@BA@@@@@@BA@@BABA@A@@@@@@@@A@@AC@@AA@@@CCAAAA@@@AAAA@AAAAAA@@AA@A@A@A@@AA@A@@@@@A@@@A@@@A@@@A@@@AAA@A@@AA@@A@@AA@@A@@AA@@@A@A@@@AB@@A@@@@A@@@@@@A@@BA@@@A@@@A@@@@@A@@B@@A@C@@@@@A@@B@@A@@@@@@@A@@@@@AA@@A@@@A@@@AA@@@A@@@AAA@@@@@@@A@@@A@A@@@A@@@C@@@@@A@A@A@A@A@A@@AA@@@A@AA@@AA@@AA@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@A@@@@AA@@@@@AAA@AAAAA@@AA@@@A@@@@@@A@@@@@@@@A@@@@@@@@@@@AA@@@@@C@A@A@@@@@A@@@@@@@AA@@@@@@@@@@@BAB@B@DAHAD@D@B@@ABCJCPCB@B@B@@@B@@@F@B@B@@@@@@A@@B@@@@@@@B@B@@BB@BA@@FAFABA@@B@B@B@H@B@DAB@DAD@B@H@B@DBB@B@DAB@D@@@BBBB@@B@@@BBB@@@@@@BB@@@@@@BB@@@@@B@@@B@@@@@B@@@@@B@@B@@@@B@@@@B@@B@@@B@@@B@@@@@@B@@B@@@BB@@@@B@@@@B@@B@@@B@B@@@@@B@@@@BB@@@B@@@@@@@B@@@@@B@@@@@B@@@@A@@B@@@B@@@@@B@@A@@A@@AA@@A@@A@@@@@@@@A@@@A@@@@@A@A@@@@@AA@@@@A@@BA@@AAA@ECGAA@@A@@@A@@AABAA@@@AC@@BAB@BA@@B@@B@@B@@@@U@AB@@A@@D@B@B@BA@@@@@A@@DC@@A@@A@@B@@@@@@@@@@@@@AA@@@A@@@A@@BA@@@@@@@@AA@@@@B@B@@AB@A@B@@@@@@@B@B@B@@@B@BAB@@@@@@A@@D@@A@@@AAA@@@@@A@@A@AAA@@@@A@@BADA@@BA@@@@AA@@B@@A@A@C@ACEACCCA@@AA@BAB@B@BA@@@A@@@A@@B@B@@@B@B@@@BB@BD@@@@AB@@@@B@@B@@@@@@A@@@@BA@AB@@@@@B@B@B@@@BB@B@B@@@@B@@@D@@ABBB@B@@@@B@@@@B@@BB@@A@A@@@@@A@@@@@A@@B@BB@@B@@A@@@@B@@@@@@BA@BB@B@@B@B@F@@@BBB@@@D@@@BCB@@@B@D@B@@@B@@B@@B@@@@@@@@@@@@@@@@A@@@@@@B@@@@@B@@@@@@@@@@B@@B@@@@@B@@@@@B@B@@@@@B@@@B@B@@BB@@@B@@@@@B@@@@A@@@@B@@@@@B@@@B@@B@@@@@B@@@BB@@@@@A@@B@@@@@@@@B@@@@BB@@@@@@@@B@@@@@@@BB@@@@@@BB@@@BB@@@@@@@@@AB@@@@A@@B@@@B@@@@@B@@A@@@@@A@@@@B@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@BB@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@A@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@A@@@@B@@@@A@@@@@@@@@@@AA@@@@@@@@@@@@@BA@A@@@@B@B@@@@@BB@@@@@@B@@@@@@A@@B@@@@A@@@@D@AA@@@@B@B@@AB@B@B@@@B@@B@@B@BBB@BBD@B@@@DBB@D@JAD@B@B@BB@@B@@A@@B@@AB@@@D@@@B@B@@@B@B@BBD@DBD@BBBBA@@BAB@BB@DBBBB@BBBBDBDB@BD@@@D@B@DAD@@@B@B@B@@@BF@@@@@@BBB@B@B@BAB@@@D@B@@@D@D@@BB@BB@@D@B@D@F@B@BBB@D@DBB@B@B@BAB@BAB@B@@@B@@@DAFAB@B@D@B@B@D@BBD@DB@BB@BBB@B@B@D@@@BAB@BA@@BA@@@@@A@@@@BA@@@A@AB@@A@@BAB@@@@@@@BA@@B@@@B@@@@A@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@BA@@@@@@@@B@@B@@@@B@@@@@@@B@@B@@@@@@BB@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@AB@@@@@@@B@@@B@@@@@@@B@@@B@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@BA@@@@B@@@@@@@@@B@@@@@@@@@B@@@@B@@@@@@@@@@@@@@B@@@@@H@FALAFAD@BADBD@@@DBFDDBBBBBBAD@BAH@B@@@B@B@D@B@B@B@BB@@BBBB@@B@BBD@DBD@D@D@A@@A@A@A@@@A@A@@@A@A@A@A@A@AB@@CB@@A@AB@@ABA@@@AA@@A@AB@@A@@@@@AACCAA@@A@@@A@A@@@@B@BAB@@@BA@@AA@AAA@A@ABAD@BAB@B@B@@ABC@AB@@@D@@@B@B@DAB@B@@@B@@AB@@ABABA@A@@DAB@DAB@DAB@D@DAFAFABADAB@B@B@B@B@B@BA@@DABCBAB@BCB@@A@@ACAABA@CBE@C@C@AA@@AAA@@AE@C@@AC@@A@ACAACBA@A@CAA@CAEAAAAAA@A@C@C@@@CCACA@AA@@@A@ADABC@AAACAAA@@AAABA@A@AAE@CAEBA@A@ABCDEDA@AB@@@BA@AB@AABA@@@ABC@A@CDA@A@AACBCBABA@@@AAA@C@@@AAAA@@AAA@@@EAE@AAA@CCAAAAAA@ABA@@@A@@@A@@@AAA@@AAA@A@EAC@@AC@A@A@C@C@@A@@@@@C@A@A@A@AAAB@@A@@@A@A@@@AA@@AAA@@AA@@@A@@@A@@@@@A@AB@A@@@@AAAAAAA@AA@@AA@@A@@C@A@AAA@A@A@@AA@@@@ABA@@@ABA@AB@AA@@@@C@A@A@A@@AAAAAAAA@@A@A@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@A@ABA@@@A@A@@@A@@A@@@@@@@A@@@A@@@@@@@A@@@A@A@A@@@@@A@@@AA@@A@@@AA@@A@A@@@@@@@@ACA@@AA@@A@A@@@A@@@A@@@A@C@A@@@AAAA@AAAAAA@@AA@@AAA@@@A@@@AA@@@A@AA@@A@@@A@A@@AA@A@AAA@AA@@A@@@A@@@A@@@@@A@@@@A@@A@C@@@@@A@@A@A@AAA@AAACAA@@A@@A@ABA@A@@AA@CA@@@AB@@A@ABA@A@A@A@A@A@A@A@AB@B@@@B@B@B@B@@@@@@@BA@@B@@@@@@AA@@A@@A@@A@@@@@@BA@@B@@AB@@@@A@@@@AA@AA@@@AA@@@A@@AA@A@A@A@A@A@A@AAA@@@A@@@A@@@@C@A@A@A@@@AB@@AA@@@@A@A@A@@AA@A@@@A@A@A@AAA@ABA@@BA@@@A@@@A@@@A@@@A@@@A@A@@@A@C@@@AA@@A@@A@@@@@AA@@@CAA@@AAACA@@A@@@A@AAA@A@CAA@A@AAA@CC@@@@@@ECAAAAA@AAA@C@@AA@AB@AA@A@A@A@AA@@A@@@AC@@@AAA@@@AA@@A@@AA@@A@A@A@A@@@A@A@AAA@A@@B@@A@@@A@@@A@AAA@@AA@@@AB@@A@A@ABA@@@@@A@@@AAAA@AA@@@@@AAA@@@A@@@A@@@CBA@ABC@@@AB@@ABAB@@DB@BB@@BB@@BB@@@@B@B@B@B@@@B@@B@@BBB@@@@@@AB@@@@@BA@@@AB@@A@A@@@@@@@@B@@B@@BB@B@B@B@BA@@@B@@@@@@@BB@@@@BB@BB@@AD@@BB@B@@@B@@BBB@@B@@B@@@@B@@@B@@@@AB@@AB@B@@@BA@@@@@AB@@A@A@@B@B@@B@@B@@@@@BA@@B@@@B@@BB@@@BB@@@BDBB@@@@ABA@@@ABA@@@@BA@@@A@@@A@@@A@@@@@@AA@@A@@A@@@AAA@ABA@A@A@A@ADAB@@AB@@AB@@A@A@@@@@A@A@AA@@A@@@A@@BA@@@AB@@@@A@@@A@@@A@@@A@@@@B@@@B@@@@@B@@B@@@@@@@@B@@@@@B@@@B@@@@B@@BB@@@@B@@A@@B@@@@@@@B@@@B@@@B@@@@@@@BB@@@@@@B@@@BA@BB@@@@@@@B@@A@@@@@@B@@@@@@@BA@@B@@@B@@@@@B@@@@@@@@AB@@@@@@@@@@@BA@@B@@@@@@@@@BA@@@A@A@AB@@@@A@@B@@A@@BA@@BA@@@A@@BA@@@A@@@@@@@A@@B@@@B@@@BA@@@@BA@@@@@@@A@@@A@A@AAA@A@A@A@A@AAA@@@A@@@AAA@AA@@A@AA@@@A@@@@@A@@AA@@AAA@A@C@A@@@A@A@A@A@AACBC@A@A@@@ABA@AAA@AA@@A@A@@AA@@@AA@@A@@@@@@A@@A@@AA@@ACA@@AAA@@@A@A@@@A@@@A@AA@BA@@@A@@@@BA@AB@@A@@@A@@A@@A@@@@@AA@@A@@@AAA@@@A@@AA@@AAAA@@A@AA@@A@@AAA@A@@@C@@AA@A@@@A@@A@@A@@@A@A@@@@@A@@@A@@@A@AA@@A@@@AA@@@@@A@@@@@@AAA@@@A@A@@@@AA@@@@AA@@AA@A@@@A@A@CAA@@@A@@AA@@@AA@@A@@@@@AB@@@AA@@@A@@AA@@A@@@@A@@A@@@AA@@A@A@A@A@@@ABA@@@@A@A@A@@@@@A@AA@@@@A@@@@@@@A@A@C@@@A@@@C@A@@@@@A@A@AAA@@@@@@@@BA@@@@@A@@@A@A@@BA@AAA@@@A@@@@@A@C@AAA@@@@@A@A@@@A@@@A@@@A@A@@AA@A@@@A@@@A@AA@@C@A@@@@@AB@@AA@@CC@AAAA@C@@@EBCBABABCBA@AB@@AB@@ABA@A@@@A@A@A@@@A@ABA@@@C@@@C@@@A@@@A@@AA@A@A@AACAA@@@A@@@A@@@A@A@@@CA@AA@A@@@A@A@A@A@A@@@A@@@A@A@A@@AA@@A@@@@@@@@BA@@@A@@BA@@AA@@A@@AAA@@@A@@AA@@AA@@A@A@AAA@@@A@@AC@@AA@@A@A@@@AAA@@@AB@@AB@@A@@@@@AA@A@C@A@@@A@@@@@A@@@C@@@@@A@@@@AA@A@AAA@AA@@A@C@AACA@@AA@@A@@@A@@@A@A@@@A@C@A@AB@@@@A@@@@@AA@AA@A@@@A@@AA@@@@AA@AAA@A@A@@AA@A@@@A@@@A@@@A@@@AAA@@AA@@@@@A@AAA@A@A@@@@@ABA@@@A@@BA@@@@B@@A@@@A@@@AA@@@@A@AA@@AAA@@@AAA@@A@@AA@@@AAA@@@@@A@@@A@AAA@@@A@A@@@@@A@@A@A@@@AC@@@AA@@@AA@@AAA@@AA@@A@@@A@A@@AA@@@@@@A@@@@@A@@B@@A@@B@@A@@B@@A@@@ABABA@@BA@@BA@@B@@A@A@@@A@AB@@@@A@@@@@AA@@A@@@@@AAA@A@A@AA@@A@A@@@@@A@@@@@@@A@@@A@@@@@A@A@@@A@@@@AA@@@@AA@@AAA@@@A@A@@BA@A@@A@@@AA@@@@A@@@@@AAA@@@A@@@@@A@@A@@A@@@AAA@AA@@@@A@@@AAA@A@@@A@@AA@A@@AC@@@@AB@@@@AA@@A@@AA@@@A@@@@@E@AA@@AAAAAA@AAAAA@@BC@CBA@C@CBA@EAE@G@A@A@@@@AAAACCAAAA@AA@A@@AA@@A@@AB@@A@@B@B@@@B@@AB@BABA@@BA@@@ABABABABAD@BA@@@A@@@A@A@@@@AAA@@A@@AC@AA@CAA@CA@AA@AA@@A@@AAA@@@A@A@A@A@@A@@A@@@A@@@A@@@A@AA@@@AAA@A@AAA@C@@@A@@A@@AA@@C@@@A@AAAAAA@@A@A@@@ABA@@@A@A@C@AAABA@@@A@@@C@AA@@AA@@AAA@@@@@CDA@A@E@AB@@ABAB@@A@@@A@@AA@@BA@@BA@C@AAABA@CBA@CB@@ABAB@@@BA@AA@@@A@@AA@A@@@A@@BA@@BABABA@@@@@@@@@A@@@@@@@AB@@@@@@@@@@@@@@@@@B@@A@@B@@@@@@@@@@A@@AAAAC@EC@AAA@@@ABAB@BA@ABA@A@@@A@C@A@@AA@@@@@A@A@@@@A@AAA@@@@@@A@@BC@@BC@A@@AAA@AAA@A@AAA@AAC@@@AAA@A@ABA@ABA@@@AAA@@ACAA@@AA@A@@AA@@AA@A@A@@@A@@@@@B@@A@A@@@A@A@@@@@A@A@@@AA@AAAA@@A@A@AA@@A@E@A@C@CAEDCBA@@@AB@@@@@@AB@@A@A@@@ABA@@BA@@B@@@@AB@@@BA@@@@@A@A@@@AB@@A@@@A@A@A@A@@@@@@AAAA@@AA@@@@@A@@AC@A@@@@@A@@A@@@@A@@@A@A@A@A@A@@BA@@@@BA@@@@@A@@@A@A@@AA@@@@AA@AA@@A@@@A@@AA@@@A@@A@@A@@@ABAAA@@@AAAAA@@@EB@@A@@@@B@@A@A@@@A@@@@@A@@@@@A@@BA@@BA@@@@@AB@@@@@@A@@@A@@@@@A@AAA@C@AAA@@@A@@@@@A@@@AB@@@@A@@@@@@B@@@@AB@@@@AAA@@@A@A@@@A@@@A@A@@@A@@AA@@@A@@AA@A@@@A@@@@@A@@@A@A@A@@BA@@@@B@@A@@@@@@@@@AA@@@A@@AA@@@AA@AA@@A@@@A@@@@@E@A@A@A@@@A@@A@@A@A@@@ABA@@@A@@@A@@@A@@@@@@@@@A@@@@A@@@@A@@AA@@@AA@@@@@@BA@@@@@@B@@@@@B@@AB@@@@@B@@@@@@AA@@@@A@@@@@@AA@@@@@AA@@@@@A@@@A@@A@@C@@AAA@@@@A@@@A@A@@@@@@@@@@@@@@A@@@@@@@A@@AAA@@@AA@@A@@@A@@@A@@@A@A@A@@@@@@B@@A@@@@@@@A@@@@@@@AAA@@@A@@@A@@@A@AB@@@BA@@BCBCB@@@@AB@@@@@@@B@@@@@@@B@@@B@@@@@BB@@@@@@B@@A@@@@@A@@@@@A@A@A@@BA@@@@@@BA@@@@BB@@B@@@@@B@@@@BBA@@@A@@@@@A@@@@@A@@@@@@@@@A@@@@@A@@@A@@@@@A@A@AAA@@@A@A@@@AAA@A@@@AA@@@@A@@@@@C@A@ABC@ABA@AB@@@@@@@@@B@@@@@B@@@@@@BB@@@BB@@B@@@@A@@B@@A@@@A@@@@@@@A@@@@@A@@@A@@@@@@@@@A@@@@BA@@@@@@@@B@@@@@B@@@B@@@B@@@@@B@@@BAB@@@B@B@@AB@@@B@@@B@@@@@@A@@BA@@@@@ABAB@@AB@@@@A@A@@@@@AB@@A@@BA@@BA@@@@@AB@@A@@@@B@@A@@B@@@B@B@@A@@@@BA@A@@B@@A@A@@@@B@@@@@@@B@@@BBB@@@@@@A@@@A@@@@@@@@@@BA@@@@@@BA@@@A@@B@@@@A@@@@@@BA@@@@@A@@B@@A@@@@@@B@@@@@@BB@@@@BBB@@@@@@B@@@@@@@BB@@@@B@@B@@B@@@@@@@B@@@@@@@@@B@@@@@B@@@@@B@@@@@@A@@@@@@@@@AB@@@@@@A@@@AB@@AB@@A@@B@@A@@@@@@@BB@@@@@@@B@@@BA@@@@@AB@@@@@B@@@@@@A@@@@BA@A@ABABC@A@@BA@A@AB@@A@@@@@AB@@@@A@@@C@@@@@@@@B@@@@BB@@@@@B@@@@@@@B@@@@@@@B@B@@@B@@AB@B@@@@A@@@@B@@@@@@@B@@@BB@@@@B@@A@@B@@AB@BA@@B@@A@@@A@@@@@@B@@@@@@A@@@@@A@@@A@A@@@A@A@@@@@@@@@A@@A@@A@@@@A@@A@@A@@A@@A@@@@@@@@@@@AA@@AA@@@A@@@A@@@@@@@@@A@@AAA@@@@@@@@A@@@@@@@A@@@@@@@@@@@CAA@A@A@@BA@@@A@@@A@@@@@@@A@@B@@A@@@@BA@@@@AA@@@@@AB@@A@@B@@A@@BA@@@@@@@A@A@@@@@A@@A@@@@AA@@@AA@@@AA@@@A@@@@@A@@A@AAC@CAA@C@CB@@A@@@AA@@@@@AA@AAA@@A@AA@AA@@AAA@@@@@@A@@AA@@@A@@@@@A@@@@@AA@A@A@A@A@@A@@@AB@@A@@@A@@@AA@A@C@A@A@@@@A@@@@AAAAAC@@@@@C@A@A@A@A@A@@A@A@A@A@A@@@A@@@AAA@@@AAA@@@A@@@A@@@A@@@@BA@@@@@A@A@@@ABA@@BA@CB@@A@A@@AA@AA@@A@@A@@@AD@BA@@BA@A@@@A@@AA@@AA@@A@@@@A@AA@@@@AA@BAB@@A@@@AB@@ADABAB@@A@@D@B@BAB@@@B@@AA@@@@A@A@@BABAB@@A@@AA@@@AA@AAC@"],encodeOffsets:[[128447,42923]]}},{type:"Feature",id:"220521",properties:{name:"通化县",cp:[125.759259,41.679808],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@@@@@@@A@@@@@AAA@@@@@AA@@@@@@A@A@@AA@@@A@@@@@@@A@@A@@A@@AA@@@@@@@@A@A@@@A@@@A@@B@@@@A@@@A@@BA@@@@@@@@@@@A@@@@@AB@@A@@@@A@@A@@@AB@@A@@@@AAA@@@@A@@@@@A@@@@@@@A@@@A@@A@@AB@@@@A@@@@@@@AA@@@@@AA@A@@@@A@@@@@@A@@@@A@@@@@@@@@@@@@@AA@A@@@A@@@@@@AA@@@@@@AA@@@A@@@A@A@@@A@@@@AA@@@@@@@@@A@A@A@@@@AA@@A@@@@@A@@@@@@@A@@A@@@@A@@@@@@@@A@A@@AA@@@@@C@@@A@A@@AA@AA@@@A@@AA@@@A@@@@BA@AB@@@@A@@@@@A@@@@@A@@@@@AB@@@@@@A@@@@@AAA@A@A@@@@@@@A@@@@@A@@A@@@@AA@@A@@AA@@A@@AA@AAA@@AA@@@@@@@@A@A@AA@@@@A@@A@@A@@@@@AAA@@A@@A@@@@@@@AB@@AB@@@@@@A@AA@@A@@@@B@@@@@@@BA@@@@@@@A@@@@B@@@BA@@@@B@@@@@BAB@@@@@@A@@@A@A@@@@@@BA@@@@@A@@@@@@@@@@@A@@@@@A@@A@@@@@A@A@@@A@@@@@A@A@@@A@@@@@@@@@A@@@A@@@@@@@@@AB@@A@@@A@@@@@@@@@@@A@@@@@@@A@@@@@A@AAA@AA@@AACAAAC@@AA@@@@A@@A@@A@@A@@@@A@@A@@@@@A@@@@A@@A@@AA@@@AA@@AA@@@A@A@@AA@@@@AAA@A@AAC@AAA@AA@@A@@@@@A@@@AAA@@@A@A@A@@@AAAAA@@@A@@@A@A@A@A@A@@BA@C@E@A@A@A@@BAAA@A@A@A@@AA@@@A@@@A@@@AAA@AAAAA@@@A@AA@@A@@@A@A@@B@@A@@@A@@BA@@@@@@BA@@@@@AB@@A@A@A@A@CA@@@AA@AA@ACAA@@@@A@A@@@A@@@A@AA@A@A@A@A@A@A@AA@@@@A@@AA@@@@@A@@AA@@@AA@@AA@@A@A@A@ABA@@@A@A@ABA@CB@@@@@@AB@@@@@@@BA@A@CBCBA@AB@BAB@@BB@@@B@@A@@@@@@@A@@@@@A@@@A@@AA@@@A@@A@@A@AA@@@@AAC@A@AAC@A@A@C@A@A@A@AB@@A@@@A@@@A@@B@@A@@B@@@@@B@@AB@@@B@B@B@B@B@B@B@@@@AB@@A@@@A@@B@@@@AB@@@@A@@BB@@@@B@@@B@B@B@@AB@@@@@B@@@@@@@@@@@B@@@BA@@B@@@B@@@BA@@@@BB@@B@@@B@B@B@BA@BB@B@BBB@@@B@@A@@B@@@@AB@@A@A@@B@@A@@BA@@@AB@@@@ABA@@@A@@@@B@@@BB@@@@B@@@B@@@@@B@@B@@B@B@@@BB@@B@B@@@@@B@@@@@B@@@@@B@@@B@@@B@B@@AB@@A@AB@@@B@B@@@B@@@B@@AB@@@B@B@@@@@@A@@BA@@@A@@@A@@@@@A@@@@@ABA@A@C@@@A@@@A@@@A@@BC@A@A@ABA@A@AB@@CB@@A@A@A@@BA@@BB@@@@B@B@@@@ABB@@B@@@B@@@@@B@B@BBB@BB@ABA@AB@@AB@@@@BB@B@BB@@BB@B@BBBB@@BB@B@B@@@B@@@B@@BB@@@BB@@B@@@@@@@B@BB@@B@@BB@B@@@BAB@B@B@B@@@BA@@@@B@@A@@B@@A@@B@BBB@@@B@BAB@B@@@BA@@@@B@@@@@B@@@@@B@@@B@@B@@B@@BB@@@@BB@B@@@BB@@B@B@@@B@BAB@B@B@BBDA@@B@B@B@@DB@BB@BB@BA@@BA@@@ABA@A@@@AB@B@B@@A@AB@BAB@@A@@BA@@@@@AB@@@@A@@@@@ABA@@@A@@@@B@@BB@@@BB@@B@@@@@BA@@BA@@@@@AB@@@@ABA@A@@B@@@@@BB@@B@@@BB@@B@@@BA@@BA@@BB@@@BB@@B@AB@B@B@@@B@@@B@@@@@@@B@@@B@@@@@@@@ABA@@B@BABCBABAB@BABABA@AB@BA@A@A@A@AAA@A@AA@@A@@A@@ABA@@BABAB@@ABA@@BAA@@A@A@A@@@A@AB@@@@A@@@@B@@AB@B@BA@@BABABB@@@@BB@@BB@@BB@@B@@BB@@B@B@B@BA@@B@@AB@@BB@B@B@B@BAB@B@B@B@B@@@BB@@B@BBB@D@BBDAD@B@@@BB@@BB@B@@@BA@AB@@ABAB@@A@@@@@B@@B@@BB@@BDB@BB@@BBB@B@BBB@B@B@@@@BA@ABABABA@AB@BAB@BAB@@A@A@AB@@A@AB@@AB@@A@@B@@@B@BB@@BAB@@A@@@AB@@@B@BA@@@@@@@A@A@A@A@C@A@ABA@A@@BA@@@A@AB@@AB@B@B@B@@ABA@@BA@AB@@ABA@ABA@AB@BA@@DA@@BA@@B@@A@@BA@@@AB@@@B@@@B@B@B@@AB@@CBA@A@AB@@ABABA@A@@@A@@AA@A@A@A@A@AB@@@@AB@@@B@@@@BB@@@B@B@@@@@B@BAB@B@@@@@@A@@BA@ABAB@@AB@@AB@@@@BBB@@B@@@BA@BB@@@B@@A@@@ABA@@@@@AB@@A@@BA@@@@B@@@@@B@@@B@@@B@@@B@@A@@B@@@@@B@@B@@@@@B@B@@@BB@@B@B@@@@@BB@@@@BB@@B@BB@@B@@BB@B@@BBBBB@BBBBD@@BB@BB@B@BBB@BAB@B@BBB@@B@@@B@@@B@@B@@@@BB@@B@@@BB@@@@@@@BBB@DBBBB@DBB@@@@@B@B@BA@@@@B@DBBB@@BBBBBB@@@@B@@@B@B@BAB@@B@@B@@B@B@@BBB@BB@B@@B@@@BB@@B@@B@@@@@@B@@@@BB@@@B@BBD@BBB@DBB@D@B@@@BBBBB@BB@B@@@@@@@@@B@@A@@B@@@B@@@B@@BBBBDBBBD@@BB@@@B@@AB@@@B@B@B@BA@@B@@@BB@@BB@@B@B@@@@@@B@@@BBBB@BBBB@@B@@BB@@@BB@@@@@@@B@@@@@@@B@@A@BB@@@@BB@@B@@@B@BB@@B@@B@@@BB@@@@B@@@@@B@@B@@@@BBB@@@@@@@B@@@@@B@B@@BB@@B@@BBB@@@@@BB@BB@@B@B@B@B@B@@AB@B@@@@AB@@BB@B@B@@@B@D@@B@@@@ED@D@@@@@@BB@@@@B@B@@@BA@@@@B@@A@@@@@@B@@@BBB@@BBB@@@@@@BAB@BA@@BA@@BAD@B@@A@@@@@A@ABA@@BA@@DA@@BABA@ABABA@A@@BA@@B@@@@A@@@@@A@A@A@@@ABABA@@B@@@B@@A@@@@@A@@@AB@@@@A@@@@@@@AA@@A@@@A@A@@BA@@@A@@@@B@@@@@@A@@@@AA@@B@A@@A@@@A@@@@A@@@@@A@@@A@C@A@CAA@E@GAEA@@AA@AAA@ABC@ABAB@D@BBBAB@@@D@D@D@F@B@B@@@B@D@BBBAD@B@D@D@@@B@B@D@D@DBB@D@F@BBBBB@B@@@B@B@B@B@B@B@D@B@@@B@@@@@@@@@@AB@BABAB@@@B@BBB@@@B@@@BA@@B@B@@@@@BA@@@@B@@@BABBB@B@@@BB@@@BB@@@@B@@@@B@@@@@B@@@B@@@@@B@@@B@@@B@B@BA@@B@D@B@B@@@B@@@@@B@@@B@@@@@B@B@@@BA@@DABA@@BA@@@@@@BA@@@AB@@@BAB@B@B@BAB@@@B@DB@@@@BB@@B@@@B@B@@B@B@B@@@@@@@BB@@B@@@@B@BAB@B@B@B@B@@@B@B@BA@@B@@AB@@@BB@@@@B@@AB@B@B@B@BAB@BAB@@@@A@@@@B@@@@A@A@@@A@A@@@A@@@@BA@@@A@AAC@A@@B@BBBB@@B@@@BA@@B@@@@BB@@@@@B@@@B@@@@BB@@@@@@@@B@@B@@B@@@B@@@@@@B@@B@@B@@@BBB@@@BB@@B@@@B@@@B@@@@@B@@@@@BA@@@@@@B@@@@@B@@@B@@@B@@BB@@@B@@@D@@A@@BB@@B@@@B@BBD@@@@@@@B@@@B@@@@AB@@@B@@AB@@@@@@@B@@@@@@@@@B@@@@@@@@@BA@@@@@@@@B@@@@@B@@@B@B@B@@@BB@@@@B@@@@BB@@@B@@@B@B@BA@@B@B@@@@@@@B@@@@BB@@@@BB@@@@BB@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@B@@@B@@@B@@@B@B@B@B@B@B@B@B@@@@@@@BB@@@@B@@@B@@@B@@BB@B@@@BB@@B@@@BB@@B@@BB@@@BB@@@@@@@A@@@A@@@@@@@AB@@@@@B@@@@AB@@@@@B@@B@@D@D@@@@AB@D@BABAB@@@@AB@@@@A@@B@@AB@@AB@@@@AB@@@BA@@@@BA@@B@@@@@@@@@@@@AA@@@@@A@@@AA@@@@@@A@A@@A@@A@@@AA@@@@A@@A@@@@@@@@@A@@@A@@@AB@@A@@@A@@@@@@@A@@@@@@AA@@@@@@@A@A@@@@@A@@@@@@@A@@A@@@@@@@AA@@A@@@@A@@A@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@A@@@A@@@@@@@A@@@@@@@@@AA@@@@A@@@@@A@@@@@A@@@@A@@AA@@A@@AA@@A@@A@@@@@@A@B@@A@@@@@A@@@AA@@A@@@A@@A@@A@@AA@@@@@AA@@A@@@A@@@@AA@A@@@A@@@A@@AA@A@@@AA@@A@@@A@@@AB@@A@@@A@@@@@@B@@@@@@@@A@@@@@@@AB@@@@A@@@@@@B@@AB@@@@@B@@@@@@A@@@@@@@@@A@@A@@A@A@@@@@@@A@@A@@@@A@@@@AA@@@A@@@AA@@A@A@@@@@A@@@A@@@@@A@@BA@A@@@@@@@A@@@@@@AA@@A@@A@@@@A@@@A@@@A@A@A@@AA@@@A@@@A@@@@A@@@@@@A@@A@@@@AA@@@@AA@@A@@@AAA@@@A@@AA@@@A@@AA@@@A@@@@@@AA@@@@@@A@A@@@@@AA@@AAA@@@@@AA@@@@@@@BA@@B@@@@AB@@@@AB@@A@@BABA@@@@BA@@@@@AB@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@BA@@@@B@@@@A@@@@B@@A@@@@@AB@@A@@@@@@B@@A@@@@@@@@@@B@@@@A@@@@@@@@BA@@@A@@@@@A@@@@B@@A@@@@B@@A@@@@@@B@@A@@@@@@@@@A@@@@B@@@@@@@@@@A@@B@@A@@@@@@B@@A@@@@B@@@@@@@BA@@BAB@@@@@@@BA@@@@B@@@@@B@@@@@@@@AB@@@@@BAB@@@B@@@@AB@@@@@@@@@@@@A@@@@@AA@@@@A@@@@@@@@@A@@A@@@A@@@AAA@@@A@@@@@AA@@@@@@@@A@@A@@@@AA@@@@@@AA@@@@@@@@@A@@@@@@@@@AA@@@@@@@@@AA@@@@@@@A@@@@A@@@@@@A@@@@@@@A@@@@@A@@@A@@A@@A@@@@@@@A@@@@@@AA@@@@@A@@@@A@@@@A@@A@@A@@A@@@@A@@@@@@@AB@@@@A@@@@@A@@@@@A@@@A@A@@@@@A@@@@AA@@@@@@A@@A@@@AAA@@A@@A@@@@AA@@@A@@@A@@AA@@@AA@@@A@@A@@@@@@@AB@@@DA@@B@@A@@@A@A@AB@@AB@@A@@B@@@B@B@@@@A@@@A@@@A@@@AB@@@B@@A@@@AB@@A@@B@@@@A@@@@@@@@@A@@@A@@@AA@@@@AA@@AA@@@@A@@A@A@@@A@@@@AA@@AA@@@AA@@@@A@AA@@@@A@@A@@@@A@@@@@A@@@AA@@A@@A@@@@A@@@A@A@A@A@@@A@AA@@A@@@@@AA@@A@@@A@@@A@@@@@A@@@A@@@@@@AAA@@@@@AA@AAA@@@@AA@@@A@@AA@@@@@A@@AB@@@@A@@A@@@@@AAAA"],["@@A@@A@C@AA@@A@A@A@C@@@AA@@@A@@@@@@@@@AA@@@@@@A@@@@@A@@@@@@@@@AA@@@@@@@@@@A@@A@@@@@A@@@@@@@@BA@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@AA@@@A@AB@BAB@DAD@D@B@DBB@BBBA@@BABABADAB@@@AA@@A@A@C@A@CAC@AAA@A@CAA@@@@AAA@@@AC@A@A@@@AB@@A@A@AA@@A@ABA@A@@AA@A@@@CAA@@@A@A@AA@@AA@@A@@@A@C@@@A@A@ABCBABAB@@ADABAB@BABABAB@BAB@B@BB@@BA@ABED@B@@@BB@DB@BBB@BBBA@BB@B@DB@@BB@@@@BA@ABABA@@BC@ABCBABA@C@A@ABA@A@A@@@AAA@AA@@AA@A@@@A@ABA@@@A@@@AA@@A@@@A@A@@@A@A@A@AAAA@AA@@AAB@B@@AB@BABA@A@A@A@A@A@AAC@@@A@A@@A@C@@@A@A@A@AAA@@AAAA@AA@AA@A@A@@@A@@A@@@@A@AAA@A@A@A@C@@AA@AA@AAAAA@@@AA@@AA@AAA@AAA@A@A@A@C@C@A@C@A@AB@AA@C@C@C@A@A@A@AA@@ACAA@AA@AAA@AAA@AA@@@@@AAA@@@AAAB@@ABA@@@A@A@ABA@@@ABA@@@@BA@@A@@AA@AAAA@@AA@@AA@A@@@ABA@A@@@@@A@@@A@@@A@A@@BA@A@@BAB@B@BAB@@A@@B@BAB@B@@@B@@@BA@A@A@AB@@@BAB@@@B@@BB@B@BBB@BA@@BA@@BA@AB@D@@@BA@@BABA@@@AA@A@AA@@AAAA@@B@@A@@@A@ABABA@ABAB@@A@@@A@@@AB@@AD@BAB@@ADA@A@AA@@AA@AAAAAAAAA@AAAA@A@@@A@A@@@A@ABAB@BAFAB@DADC@@BA@@A@@@AAAA@@@A@A@A@ABA@A@A@@@AD@BABA@A@AAAAC@AAAAA@A@@@A@@@A@@BA@@@@D@@B@BBBHGMIODBR@NMNCJ[DSBGMBOFQFIZWRGRGVE@@AA@@AA@@@@A@@AA@A@A@@@@@AA@@@@A@@A@@A@A@A@@@A@@@A@@@A@A@A@A@@@C@@@A@A@A@A@AAA@A@AA@@A@AAA@@@AAA@A@C@A@CAC@AAC@A@AA@@A@@A@@@A@@@A@A@A@@@ABADCBC@C@C@AAEDEBGBA@C@ADCBEDCDC@CBABCBCDEBCBABC@AAAECCAGACAA@C@AAAAAA@@A@E@GAE@G@C@C@A@@AAAAC@A@A@C@CBCBEBA@CB@@A@E@C@AAACCCCEAEAGAC@AAAAA@C@E@CBC@ABC@ABABADEBABCDA@@@A@A@AAAAAAACAC@A@@@A@@@A@CBCBABCBA@@@AD@DADAF@BAB@BA@A@A@C@C@A@AACAECCAEAA@C@C@@BEBC@@@A@@@AA@AAE@EAA@E@EAA@CBCBEBEDCDEBGDC@@@CAA@CCAA@AAC@@@GBEDCBABAFCDABA@ABA@A@A@@@@AAA@AA@CAACACA@ACAAACAC@A@C@AAC@CAAA@AAAAACAAAE@C@E@EAC@A@C@ABC@EBEBA@@@A@C@C@C@A@E@CACACAECACACAC@A@EACAACCA@@AA@AAAA@@@A@@BA@CBA@CAEACAEACAA@CAAAAA@C@A@A@ABA@CBC@EBG@E@C@EAAA@AA@BA@A@A@A@CBA@ABABCDCDCDAHEHCHCHCDA@@BABC@CAAACEAGCGAE@E@E@EDEFCBEDEBC@GAICCCCAECGGKOIDUCIGA@C@EBCBGDCBABAFADBD@D@BBBDDDBDDBB@B@BA@ABADAD@DADAD@B@BA@@BC@ABA@A@@AA@A@C@C@C@E@A@A@@@A@GBA@@BA@ABCB@@A@A@A@@@@@A@@@@@@@@@A@@@@@@B@@@BBB@B@@@@@B@@@@@@@B@@BBBB@@@@B@@@@BB@@@@BA@@BA@@B@@@B@@@@@B@@@@A@@@A@A@@@@@A@@B@@@B@@@D@@@@AB@@@@A@AB@@A@@@A@@@@@A@@@AA@@@@AB@@AB@B@B@B@@A@@BABAB@@AB@@@B@@@@@BBB@@B@BB@@@@@@@B@@@B@@@@@B@@@@BB@@B@@@B@@B@@ABAD@@@B@B@@A@@@@B@@A@@BA@@B@@@B@@@@@B@@@@@B@@@@@BBB@@BB@@@B@@@B@@@@@B@@@@@B@@@@@@@B@@BB@@@B@@B@@@@B@@B@@@@@@@@@@B@@@@@B@BAB@@@B@@@B@@@@BB@@@B@@BBB@@@@B@@A@@BA@A@@@@BA@@@@B@@AB@B@@A@@B@@A@@B@@@@AB@B@@AB@@@@@BA@@@@@A@@@A@@@A@@@AA@@A@@BA@@@A@A@A@@@@@A@A@A@A@@@A@@@AAA@@@AB@@@@@@@B@@@@@B@@@BB@@@@@AB@@@@@@@@B@@@@B@@B@@B@B@@@B@@A@@BB@@@@@@B@@@@@BCBABCB@BAB@@@@@B@@@@ABA@@@A@@BA@AB@@@@A@@@@B@@@@@@@BA@@@@B@@@@@@@BB@@@A@BBA@@@@@ABC@A@@@CB@@A@@@ABAB@@A@@@@@ABAB@@@@@B@@B@@B@@@@AB@BA@@B@@@@@@@B@B@B@B@@BB@@@BB@@@@B@@@B@@@@B@B@D@B@B@@@B@BB@@B@@BB@@BBB@@@D@B@@@D@@@BB@@@@BB@@@@@@B@@@@@@@BA@@@C@ABA@A@A@A@ABA@A@A@A@@@A@A@@@A@@BCB@@AB@@A@@BA@@BA@AB@@A@@B@@@@@B@@@@@@A@@BA@AAA@@B@@@@@@@B@@@@AB@@A@@BA@@@@@B@BB@@B@@B@@@@AB@@@BAB@@@BA@@B@B@@AB@@@B@@BB@B@@@@AB@@A@@@@BA@@@A@A@@@@@AB@@AB@@AB@@@@AB@@ABA@A@A@@BA@@@AB@@@BA@@B@BA@@B@B@@BB@@BD@BBB@BB@@BA@@B@@AB@@CBAB@@AB@BA@AB@B@@A@A@@B@@@@@@@BA@@BA@@@@B@@@B@B@BA@@B@@@B@@A@@BA@@B@@@B@B@@@B@@@B@B@BB@@@@BB@@BB@@@@B@@@BA@@BAB@@@B@@@@B@@BB@@@BB@@@B@BBB@@@B@BA@@B@B@BB@@BB@@BD@BB@@BBB@@BB@@BB@@@BB@@@BB@@BB@@BB@B@@BB@@B@@@B@@@@@@BB@@@BBB@BBBA@@B@@@@ABA@A@CBA@A@C@A@@BA@@@@@@@@BAB@@@B@BA@@@@BA@@BA@@BA@@@@B@@@B@@@B@B@@AB@@@BA@@BA@@@@B@@@@BB@@BBB@@@BB@@BB@@@B@@AB@@AB@@A@A@A@@@AB@@@B@@@B@@@B@@@@AB@@@@@B@@@B@B@@B@@B@@@BA@@@ABA@@@AB@@@AA@A@A@AAA@@BA@A@A@A@@@A@@@A@@@A@A@AA@@A@@@@B@@AB@@A@A@AB@@A@@B@@A@@B@@A@@@AB@@A@@BA@@B@@AB@@@@ABA@@BA@@BA@ABAB@@A@@BA@@BA@@B@@AB@@A@@@AAA@A@AAA@AA@@ABA@A@A@@B@@A@CAA@A@A@@A@@@AA@@@@@A@@AA@A@A@C@A@@@C@ABA@A@@@A@@@A@AA@@A@@@A@A@@@@AA@@AA@@AAA@@A@A@@AA@@@@A@@A@@A@@A@AAA@CAA@@AA@@@@@A@@B@@AB@@A@@@A@@@A@A@@@A@A@@@A@AAA@AB@@AAA@@@A@AA@@AA@A@@AA@AAA@@@@A@@@A@@@AB@@A@@@@@A@@@@@A@@@A@@@A@A@@@@@A@A@@BC@@@A@@@@@A@@@@AAAA@@AA@AA@@A@@@@@@@AB@@@@@@A@@@A@@@@@A@@@@BA@@BAB@B@@A@@B@@@@AB@@@@@B@@B@@B@@B@@B@@@B@@@B@@@B@B@@@BBB@@BDBBBBBB@B@BB@@B@B@@BB@@@B@@@D@BA@@B@BBBBDDDBFFD@B@B@BB@@B@@BB@@@B@B@@@B@B@B@@@BAB@@@B@BCH@B@B@DDFB@@@@BBBBDBB@@@@B@@B@@@@@@@B@@@@@@@@@@AB@@A@@@@BA@@@A@@B@@@@@@@BA@@@@B@@@@@@B@@@@@@BB@@@@@@@@@@@@@A@@B@@@@@BA@@@@BA@@B@@@B@@B@@BB@@BB@@BB@@@B@@B@@@@B@@B@@B@@@@@@@BB@@@@B@@@@BB@@A@B@@B@@@@@@@B@@@B@BB@@@B@@@@@@@BA@@@@B@@A@@@@@@@A@@@@@@B@@A@@@A@A@@@AB@@@B@@@@@@@@@@AA@@@@@@AB@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@B@@@@@B@@@@@@A@@BA@@@@@@@@@@@AA@@@@@@@@@@A@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@B@@A@@@@A@@A@@@@@@@A@@@@B@@A@@@@@@@@@@@@@@@@@A@@@@BA@@@@@A@@@@@A@@@@@B@@@@B@@@@@@@B@@@@@@AB@@@@@@@@@@@@@@@@B@@@@@@B@@@@A@@@@@@@@@AB@@@@@@@@@@@B@@@@BA@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@A@@B@@@@@@@@@@BB@@@@@@@@A@@@@B@@@@A@@@@@@B@@@@@@@@@@AB@@@@A@@@@@@@@@@B@@@@@@@@@B@@B@@@@@@@@@@@@B@@@@@@@B@@@@A@@@@B@@B@@@@@@B@@@@@@@@@B@@@@@B@@@@@BA@@@@@@B@@A@@@@@@B@@@B@@@@@@A@@B@@A@@@@@@@@@AB@@@@@B@@A@@@ABA@A@@@A@A@@BA@@@@@A@@B@@@@@@@B@@AB@@@@@@@BA@@@@B@@@@@@@@AB@@@B@@@@@B@@@@@@@B@@A@@@@B@B@@@@@@@@AB@@@@@@@@@@@@@@@BA@@@@B@@@@@@@@@B@@@@@B@@A@@@@@@B@@@@@B@@@@AB@@@@@@@@@BA@@@@@@@@@@@@@@BA@@@@@@@@B@@@@A@@B@@@@@@@BB@@@@@@B@@@@AB@@@B@@@B@@@B@@@B@@@@@B@@B@A@@@@BAB@@@@@@@@@@@B@@B@@@BB@@@@@@@@@@@@@BB@@@@@A@@@@@@@A@@@@@@@CD@@@@@@@@BB@@@@@@@@A@@@@@@@@@@@@@@B@@@@@BB@@B@@@@@@A@@@@@@B@@A@@@@@@B@@B@@@@@@@AB@@@@@B@@@@@B@@@@@B@@@@@@@@B@@@@B@@@@@B@@@@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@@B@@B@@@@@@B@@@@@@B@@B@@@B@@@@@B@@@@B@@B@@@@@@@@@@BB@@B@@@ABB@@@@@@@@@@@@A@@@@@@@A@@B@@@@@B@@@@@BB@@@@@@@@@@@@B@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@BB@@@@@@@@@@@AB@@@@B@@B@@@@@@B@@@@@@B@@B@@B@@@@A@@@@@@BB@@@@@@@AB@@A@@@@@AB@@@@@@@@@B@@B@@B@@@@@@@@@@@@@@@B@@A@@@@@AB@@AB@@@@@B@@@@@B@@@@@@BB@@@@@@@@@@@@@BAA@@@B@@@@@@@@@@@@@@@@A@@@@B@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@B@@@@@@@@A@@B@@@@@B@@@@@B@@@@@@B@@@@@@B@@B@@@@B@@@@@@@@@B@B@@@@@@BBB@@@BB@@@@B@@@@@@BB@@@@@@B@@@@@@@@BB@@@@@@@@@B@@@@@B@@@@@@@@@B@B@@@BA@@@@@@@@@@B@@@@@@BB@@@@@B@@B@@@@@@B@@@@@B@@B@@B@@@@@@@BA@@@@@@@@B@@@@@@@@@@@B@@@@@@A@@@@B@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@A@@@BB@@@@@@@B@B@@@B@BBB@@FBB@B@B@B@@BB@B@@@B@@@@@BBB@@BB@@@D@BBB@B@@@B@@@B@@@B@DAB@@@DBB@B@BBBA@@@@F@F@@AJ@F@B@B@@@@@@@B@B@D@F@HBD@D@BADAB@D@DB@@B@@CBCBA@@@@@@DADC@@BC@A@@B@@@@@@@@@B@@@BAL@@A@@@@@A@@@@A@@A@@@AA@@AA@@C@@@A@@@AB@B@B@@@B@@@B@B@@@BAB@B@B@@@@@B@@@@@B@BB@@B@B@@AB@B@BA@@B@B@@B@@BB@@B@@@BB@@@@B@@@BA@@B@BBB@B@BBB@@@B@@@BA@@B@@@@@@BB@@@@@F@B@B@@@BBB@@@B@@@B@@@BBBBB@@@@@BB@BBB@BD@BBB@@B@@BB@@@BA@ABAB@@@B@B@@B@@BA@@@A@ABA@C@@@@BA@ABCB@BA@@B@@@BA@ABB@@B@@B@@B@B@@B@@@BB@@BB@@@B@@@BAB@@ABC@@B@@@BB@@@BBB@@BB@B@@@DAB@@AB@BA@@B@B@@@@@B@@A@@B@@@B@@@B@@@B@BB@@B@BB@@B@@@B@B@B@B@B@@@@B@B@B@B@B@D@@@@BDBBBB@@@@@B@@B@B@D@B@B@@B@@@B@@@BA@@B@@@BB@B@B@B@B@@B@@@@@B@@@@@B@@BB@@@B@@@@B@BB@@BBB@@B@BB@BBB@@B@@@@BB@@B@@@DAD@B@DBD@BBB@@@@B@@@@@B@@BB@@B@@B@@BB@@@@@BB@@@@@B@B@@@@@@@B@@AB@@@@AB@@@BA@@@@B@@B@@B@@A@@B@@@@AB@@@@@@@B@@@B@@@B@@AB@B@B@DB@@@@@@@@@@B@@@@@@@B@@@@@@@@@BB@BB@@@@@@@@@B@@@B@@@B@@BB@@B@@@@@@@@@@@BB@@@@BB@@@@B@@B@@@@BB@@@@@@@@@B@B@@@B@B@@@B@@@@@B@@@@@@@@A@@@@B@@@B@@@@AB@@ABA@@@AB@@@@A@@A@@A@@@A@@@@@@@A@@B@@@@@@ABA@@@A@@@A@A@@@@@@@A@@@@@@@A@@@@AA@@@@@A@@@@@@D@@@B@@@@@BA@@@@B@@@BAB@B@@AB@D@BABAB@B@@A@@B@@@@@@@@A@@@@BA@@@@B@@A@@@A@@@@@@AA@@@@@@B@@@@AB@@@BA@@BA@@B@@@@@@@BA@@@@@@@@B@@@@@@@@A@@@@@A@@@@@A@@@@@@@@@A@@@@@@@AA@@@@A@@A@@A@@@@@@@A@@@@A@AA@@@@AA@@@@@@@A@@@@B@@@@AB@@@@@B@@A@@@@B@@@@@@AB@@@B@@A@@@@B@@A@@@@@@@@B@@@B@@@@@@@AA@A@@@A@@@@@@@A@@B@B@@@@AB@B@@A@@B@@@@A@A@@@AB@@@@A@@B@@@BA@@@@B@@AB@@AB@@@BA@@@@B@B@@@@@BA@@BABA@@@@B@@AB@@@@@@@@A@@@A@@BA@@@A@A@@BA@A@@@A@@@@@A@@@A@@@A@@@@@A@@@@@@B@@A@@B@@@@@@@@@B@@@B@@@@@B@@@@@@@B@@@B@@@@AB@@@@@@AA@@A@@AA@@@@@@@A@@@@@A@@@@@@@@BAB@BAD@BAB@D@@@@@B@@@@@BB@@B@B@BB@@B@B@@@B@BBB@B@@@@@B@@@B@@@@@B@@@@@@@@@B@@@@@B@@@@@B@@@B@AA@@@@@A@@@@@AA@@A@@B@@A@@@@B@@AB@B@B@@@@@B@@@@@B@@@@A@@@@A@@A@@@@@A@@@A@@@@@@@A@@@@@@BA@@@@DADA@AB@@A@@BAB@@@B@@@B@@@B@BB@@@@@@B@@@@@@@B@@@@A@@@@B@B@B@@@B@@@B@@@B@@@BB@@B@BB@@@B@@@@@@@B@@@@@@@@@@@@B@B@@@B@@@@@BB@BD@@@@BB@@@B@@@@@B@@B@@@@BB@@@@@@@B@@B@@B@@@@A@@@@@A@@BA@@@@@A@@@@@@@AB@@@@@@BB@@B@@BB@@@@@@B@@B@@@@@@@@@B@@@B@@@B@@@B@BA@@B@B@@@@BB@@@B@B@B@F@@@@@B@@@B@@@BBB@@B@@BB@@@B@@BB@@@@@@@@B@@@@A@@B@@AB@B@B@@@B@@@@@B@@@B@B@@BB@@@B@@BB@@@B@B@@@B@@@B@B@@@B@BB@@@@BA@@@@@A@@@@B@@@@@BA@@B@@@@@B@@@B@BBD@B@BBB@@@@@B@@@B@@@@@@@BA@@@@B@@AB@@AB@@@@@B@@@@@B@@@B@B@@@@A@@B@@@FA@@B@BBBB@@B@BBBA@@B@@@@BB@@@B@@BB@@@B@@@BBB@@B@@B@@BB@B@@@B@@@@@B@@A@@B@@AB@B@B@B@B@@@B@@@@@@BB@@@@@B@D@@BB@@@@@B@@BB@BB@B@@@@B@B@B@B@@@B@@@BA@@B@B@@@@@B@@A@@BA@@@@@AB@@AB@BA@@B@B@@@BA@@@@@@BA@@B@DAFCDBD@B@F@B@@@BBB@B@@@BBBBB@@B@@@B@B@@@@@B@B@@@B@BA@@@@@B@@@B@B@B@@BB@@BB@B@@BB@DB@BB@BB@@B@BAB@BAB@B@BB@@D@BBB@BBB@B@BBB@BB@@@BAD@@AD@@@B@@@@B@BBB@@@@@@B@B@@@@BB@@@B@D@B@@@BAB@BABA@AB@B@@BB@BFDD@BBBB@@@B@@@@@@@@A@@@@BA@@@@@@@@@@@@@@@@@A@@B@@@@@@@B@@@@@@@@ABABAB@@AB@@@B@@@BBB@@@B@@BBB@@A@@BABA@@DAB@DAB@BABBD@B@@AB@@AB@@BB@@@B@@@BABA@@BAF@B@B@DC@@@@B@BB@@BB@@BBD@@@B@@@B@BABBD@B@B@@@B@BA@@B@B@@@BBBBBBB@@@D@@@BB@@@BB@@@D@B@BBB@B@BB@@B@@B@B@@@B@@@B@@@BB@@@@B@B@B@B@@BB@BB@@@BBB@@BDBB@DBB@@BBD@@@BB@BB@@@@@B@B@@@B@@ABC@ABABABAB@B@@AB@@ABABA@@BA@@@A@A@@@@BA@@BB@@@BB@@@BBBB@BBDBBDBB@B@@B@B@H@F@FBB@DAD@B@DAD@@AB@BBBBB@BBBB@BB@@B@F@@@@@B@@BB@@@BB@@B@@A@@B@@D@@BB@B@@BB@@@B@B@BB@@B@@@@@BBB@BB@@B@@@@BB@@@@@B@@@B@BB@@@@B@@@@@BB@@B@@@@BAB@@@B@B@@BB@BB@@B@@B@@B@@B@@@B@B@@@@@B@@@B@@@@@@@B@@@@@B@B@@@BBB@B@B@BB@@@@B@@@BB@@@@B@@@@@BAB@@@B@B@@@@AB@@AB@@AB@BABA@@B@@@@AB@@@@AB@@@@AB@@@@@B@@@@@@@BB@@@B@B@@@BB@@BB@BB@@BB@@B@@B@@BD@@B@B@@@@B@@@@@B@B@@BB@B@B@@@B@@@@BB@B@@BB@@@BB@BB@@B@BB@@BBB@@@@@BB@@B@@@B@@@@A@@B@@AB@@@B@BA@@@@B@B@B@BBB@@@@@B@@BB@BB@@B@@@B@@@B@@@B@B@@BB@B@B@BBB@@B@@B@@BB@@@B@B@@BBB@@@@B@@@@@BAB@D@B@@@B@B@@@B@@@B@@@BB@@DBBBD@B@@@BBB@BBB@B@@B@@B@@@@@D@@@B@@@@@B@@@B@D@B@B@@B@@@@@BA@@BA@@B@@BB@B@@@B@BB@@BD@@BB@@@B@BBB@B@@@BB@@BB@@@B@@BB@BB@@@BB@@AB@@@B@@AB@@@@@@@@@BB@@BB@B@B@@@B@@@B@B@B@B@B@@@B@B@@BDB@@B@B@@@B@@@B@@@B@DBBBB@B@B@@BB@@@B@@@D@@@D@@@B@BAB@@@B@B@B@@@B@B@BA@@BA@@BAB@DABABADAFA@@D@B@BB@BDD@@BB@@BA@@@@B@D@@@BBB@@@B@@@B@B@@BB@B@@@B@@@B@@@B@B@@@@@B@BBD@B@@@@@B@@@B@BBB@@AB@B@@@B@@@@@B@@A@@@@@@B@BBB@B@@@@@B@D@@@B@@@D@B@B@@@@@@@B@@@@@BBB@@@@@B@B@B@@@@@AB@B@@@B@B@B@BB@@B@@@BB@@@@@@BB@@BB@@@B@@B@@BA@@@@B@@@BB@@B@@BB@@@B@DBB@B@@@B@B@@BB@@B@@B@@B@@B@B@@@B@BB@@@@@@@B@@@@BB@@B@@@BBB@@@B@@@B@@@@@B@B@@@B@@@@BB@@@B@B@@BD@@@B@B@BB@@@BB@@B@BB@BB@BB@@BB@@@B@BB@@B@@@BB@@@@B@@@@BB@@@B@@@BAB@@A@@B@@@B@@ABBB@@@B@@@B@B@@@B@BB@@DB@BB@@BB@@@@B@@@@B@@@BB@@B@@BB@B@@@BBB@BBB@BA@@B@B@D@DABBB@B@B@B@@@B@D@B@B@BB@@BB@@@B@@@@@B@@BBB@@@BBB@BB@@B@@@B@BBB@B@B@B@B@BBB@B@@@B@@@@@@@B@@A@@B@@A@@@A@@@AB@@@@@@@B@@@B@@AB@@@B@@AB@@AB@@@@AB@@@@@BAB@B@@@B@@A@@@@@@@@@AB@@A@@@@@@@@@@BA@@@@@@@@@A@@@@@A@@@AB@@A@@@@@@@A@@@@B@@@@A@@@@@@AAB@@A@@@A@@@@A@@A@@@@@@@A@@@A@@@A@@@@@@@AB@@@@A@@A@@AA@@@@@@A@@@A@@@@@A@@@@@@A@@@@A@@@@@A@@@A@@B@@@B@@@B@@@B@@@@@BA@@B@@AB@@@B@@@BBB@B@@@@@B@B@@@BA@@BA@@BABCB@B@B@B@BAB@BB@@B@@@@BB@@B@@@@B@@@B@@@B@@@B@@A@@B@BA@@B@BA@@@@AAAC@@A@@A@@AA@@@A@@@AB@@A@@@@@AA@@@@A@AB@B@@@BA@@@@B@@A@@@ABA@@BA@@@@@A@@@A@@A@@@@AA@AA@@@A@@@AAA@@BC@@AAA@@A@@A@@A@@@@@@@A@@ABA@A@A@A@@AA@@@@A@@@@@@B@B@@@BA@@B@@A@@@@BA@@@@@@AA@AA@@@@A@@@A@A@A@A@@A@@AA@@AA@@ACA@@BABA@@BA@A@@AA@@AA@A@@@A@@@@A@A@AAA@A@@@AAA@@@CAAACA@@AA@AA@@@@AA@CAAAA@A@@@A@@@@@A@AA@@@AA@@@@AA@A@A@@AAAAA@@@@AAA@@@A@@A@@AA@@@A@@@A@@@AAA@@@@@@@@AA@@@@@@@@BA@@@C@E@A@@@@@@@@A@@@@A@@@@A@@@@A@@A@@@@@@AA@@@A@@@@@A@@@@@AA@@@@A@@@@@@@A@@@@@A@@@@@AB@@@@AB@@@@AB@@@@@@A@@B@@@AA@@@A@@@A@@@A@@AA@@@@@A@@@@AA@@@@@A@@A@@@@@@AA@@@A@@@@AA@@@@@@@A@@@@AA@@A@AAC@CA@AAC@A@@@@AA@@@@@A@@A@AA@@AA@@A@@@@@AA@@A@@@@@A@@@A@@@A@@@A@A@@@@AA@A@@@A@@AA@@@@@@@A@@A@@A@@@@@A@@@@@A@@@@A@@@@A@@A@@@@@A@@@@AA@@@@@A@@@@@@@@AA@@A@@@@@A@AA@@A@@@CA@@CBA@A@@@A@@@A@@@A@@@A@@@@@A@@@@@A@@BA@@@A@@@A@@@@@A@@@@@@B@@@@A@A@@BA@A@@BA@@@A@@@A@@BA@@@@@@@A@@BA@@@@@@@@@A@@@AA@@@@A@@@@@@@A@@@A@A@@@@@A@@@@@AA@@A@@@@@A@@@@A@@A@@@@@A@@@@@A@@@@AA@@@A@@@@@@@A@@@@@@AA@@@@A@@@@@@@AA@@@@@@@@@AA@@A@@@AA@@@@A@@@@@@@@A@@@@@@@@@AA@@@@@@@A@@@AA@@@@A@@@AA@@A@@A@@A@@@@@A@@@@@@@A@@@A@@@@@@@@BA@@@@BA@@@A@@@@@@@A@@@@@@@AA@@@BA@@@@@A@@@A@@@@@A@@@@@A@@@@@@A@@@@A@@A@@@@@@@AA@@@@@@A@@@@A@@@@AA@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@A@@@@@AA@@A@@@@@A@@@@A@@@@@@@AA@@@@@AA@@@@AA@@@@A@@@@AA@@@@@@A@@@@@@@@@@@@@AB@@@@@@@BA@@@@@@@@@A@@@@@@@A@@@@B@@@@@@@B@@AB@@@@@B@@@@@@@@AB@@@@@@@@AB@@@@@B@@A@@B@@@@@@@B@@@@@BA@@@@B@@@B@@@B@@@B@@@B@@@@@@A@@B@@@@@@A@@B@@A@@@@@@BA@@@@@@@A@@@@@@@A@@@@@@@@@A@@B@@@@@BA@@@@B@@@B@@@@@B@@@@@@@B@@@@@BA@@@@B@@@@@@@B@@@B@@@B@@@@@@@@@@@@A@@@@@@BA@@@@@@@@@@B@@@@A@@B@@AB@@@B@@@@A@@B@@@BA@@B@@@@@@@B@@@@@@@@@B@@@@@@@@A@@@@@A@@B@@@@@@A@@B@@@@@B@@AB@@@@@BA@@B@@@BA@@@@@@B@@@@A@@@@@A@@@@@@B@@@@@@@@@BA@@@@B@@AB@@@BA@@B@@@@@BA@@@@@@@AB@@@@@@@B@@A@@B@@@@A@A@@BA@@BABABCBA@@BABA@@B@@A@CBA@A@@@@B@@@@@@@B@@@@@B@@@@@B@@@B@@BB@@@@@B@@@B@B@@@BBB@@@@@B@@@@@B@B@@BB@@@B@@@B@@@@@B@@@@@BB@@B@@@B@@B@@B@@@@@B@@@@A@@B@@@@@@A@@@@BA@@@@@@@A@@@@@@BA@@BA@@@@@@B@@A@@@@@A@@B@@@@A@@@@@@@AB@@@@@@@B@@@@@@A@@@@A@@@@A@@A@@@@@@AA@@@@@@A@@@@@@@@@@B@@@B@@AB@@@B@@@B@@@@@B@@@B@@@B@@@@@BB@@@@BB@@BB@@B@@@BAB@BAB@@@B@BC@@B@@@@@@@BA@@B@@@@@B@@@D@@AB@@@@ABCB@BA@@B@@@@A@@@A@@@A@@@@@A@@@@A@@@@AA@@@@@@A@@@@@A@@@@@@@AA@@@@AAA@A@@@A@@@@A@@@@@A@@@@@@@A@@A@@@@@@A@@A@@@@A@@@@@@@A@@@@@@@A@@A@@@@@@AA@@@@@@@@@@A@@@@@@@A@@@@@@@AA@@A@@A@@A@@@@@@AA@@@@@@@@@A@@A@@@@A@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@A@@A@@@@A@@@@@@A@@@@@@@A@@@@@A@@@@@@AA@@@@@AA@@@@A@@@@@@A@@@@@AA@@@@@@@@B@@@@@BA@@@@B@@@@@B@@@@A@@@@@A@@@@@@@@B@@AB@@@B@@A@@B@@@@@@A@@@@@@@@@AB@@@@@@A@@@@@@BAB@@@B@@@@A@A@@@@B@@A@@B@@@@@AA@@@@@A@@@@@A@@@@@AA@@@@A@@@@@@@A@@A@@@@A@@AAA@AAA@AAA@A@A@@@@@A@AA@@A@A@@AA@AA@@@AA@@@@@A@@@@@@A@@A@@AA@AAAA@@@AA@@A@@@A@@B@@AB@@A@A@@@A@@@A@@@@@@@@AA@@@@@ACA@C@@@A@@@@@@@@@@@@@A@@@@@A@@A@@@@@A@@@@@A@C@A@A@C@AAA@@@A@@BA@@@@@A@A@@@@A@@@@@AA@@@@@@@@@@@A@@@A@@@AA@@@@@@A@@AA@A@@@@@@@A@@@A@@@A@@@@AA@@@@@AA@@@@@@AA@AC@AACA@@@@@@BA@@@@@A@@@A@A@A@A@A@@@A@@@A@@@@@@@@AA@@A@@AA@AAA@@@A@A@A@A@AAA@AACAA@@@@@ABA@ABCB@@A@@@AA@@A@@@@A@@A@@@AA@@A@@@@@A@@@@@AA@@@@@@@@AA@@A@@@A@AAC@A@@@@AA@@@A@C@C@A@@@A@A@AA@@@@@@AB@@AB@BA@@B@BA@@BA@@@ABC@AB@@@BA@@@A@@@@@A@@@A@AA@@A@A@@@AA@@A@A@A@AAA@A@A@@@A@@@@B@B@B@@@BBB@@ADBB@B@BA@@@AB@@A@A@A@@@CB@@@B@@@BB@BB@@@B@@AB@@@B@B@@@BB@B@@@@B@B@@@B@@@D@@@BBBB@BB@@@BABAB@BAB@BC@@B@@@@BBB@B@@@@@@BB@@BBB@B@@@BC@@BABAB@@C@@@A@A@@@A@EBCBA@A@ABCBABCBABA@A@A@@@@@@B@@A@@@@@A@@@@B@@A@@@@@A@@BA@@@A@@@@@@@A@@B@@A@@@@@@@@@A@@@@@@@@B@@@@@@A@@@BB@@@@@@@B@@@@@@@BB@@@@@@B@@@@@@A@@B@@@@@@@BA@@@@B@@@@@B@@@@@B@@@@@@AB@@@@@@@B@@A@@@@@A@@B@@A@@@@BA@@@@@@@AB@@@@@B@@@@@B@@A@@B@@@@@B@@@@@B@@@@@B@@A@@@@B@@@@@B@@@@@@@BA@@@@B@@@@@@@B@@A@@@@@@@@@A@@@@@A@@@AA@@@@A@@AA@@@AA@@A@@@@AA@@@@@@@@AA@@@@@@@AA@@@@@@AA@@@@@@@@@@A@@@@@@@A@@@@@A@@@A@AA@@@@A@@AA@@@@@A@@A@@A@@@A@@@A@@@@@A@@AA@@@@@@@A@@@@@@@AA@@@@A@@A@@A@@@@@A@@AA@@@@@AA@@@@A@@@@@A@@@@AA@@@@@A@@@A@@@A@@A@@A@@@@@A@@@@AA@@@@@A@@@@@@@A@@@@@@@A@@B@@A@@@@@@@@@@@@@A@@@@@@@AC@AA@CAA@A@C@A@C@A@@@A@@@C@C@@@A@AAA@AAA@A@ABAAA@AAAA@@@CAAAA@@@A@@AA@@@@A@A@@AAAAAAA@@@@@A@A@@@A@@@AA@A@AAABAAA@@A@@ACA@@A@@@A@A@@@@@A@A"]],encodeOffsets:[[[129395,42448]],[[128999,42822]]]}},{type:"Feature",id:"220581",properties:{name:"梅河口市",cp:[125.710859,42.539253],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@@@AA@AAAA@@AA@AA@@A@@A@CAA@A@AA@@A@@AA@A@ABA@A@A@@@A@A@A@A@A@@@A@AA@@@@A@A@ABA@@BA@A@A@A@A@@@A@ABA@@@A@@@A@A@@@ABA@@@A@@@A@@@@@AB@@A@@BA@@@CBA@@@A@A@@@A@@B@@@B@@@@A@@@AB@@A@@@A@AB@@A@@BAB@@@BA@@B@@@@A@A@A@A@@@A@@@ABA@@@AB@@A@@@@@@BA@@@@@B@@B@@AB@B@B@@AB@@@@A@@BAB@@AB@@@@@@A@@@A@ABA@A@A@A@@@A@@BA@@@A@AB@@A@ABA@ABABAA@@@@@@@@A@@@@@@@A@@@AA@@A@@@A@@@@@A@@A@@@@A@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@A@@@@A@@@@B@@A@@@@@@AA@B@@A@@@AB@@@@AB@@@@A@@@@@A@@@@@A@@BA@@@@@A@@@A@@@@@A@@@A@@@A@@@@@@@AA@@@@A@@@A@@@A@@AA@@@A@@@A@@@@AA@@@@@A@@AA@@@@AA@@@A@@@A@@@AB@B@BA@@B@B@B@@@B@B@@@@@AA@A@@@C@@AA@@@@@AA@@@@@AA@@A@A@@@C@@AA@@@A@A@A@@@A@@AA@A@A@A@@@@@AB@@A@@@ABA@@@A@@@A@@@@@AB@@@@A@@A@@@@@AA@@@@AA@@@@@A@@@AA@@@@A@A@A@A@A@A@@@A@C@@@@@A@A@A@@@A@A@A@@@AB@@AB@@A@@B@@AA@@@@@@@AA@@@@@@BA@@@@B@@AB@@@@A@@AA@@@@@AB@@A@@@@B@@@@@B@@@@@@@B@@A@@@@@@BB@@@@@@B@@@@@BB@@@@B@@@@@@@B@@@@BB@@@@@B@@@@@BA@@BBB@@@B@@B@@BB@@@B@@B@@BB@@@@BB@@B@@BB@@B@@@BAB@BA@@B@@@@A@@@@BA@A@@B@@@BB@BB@BB@@B@@@B@@@BAB@B@@AD@@@B@@@BBD@@@BB@@@@BB@B@@@BB@@@@BB@@@B@B@@@B@@@@BB@B@@@B@@@@AB@@A@@@AB@@@B@@@@BB@B@@BB@BB@@@BB@@@@B@@@@B@@@@@BAB@BA@AB@@@@AA@@@@@@@A@@B@@@B@@A@@B@@@@A@@A@@@AA@@@AA@@A@AA@@AA@@AA@@@@@@A@@@@AA@A@@@AA@@@A@@A@@@@@AAA@@@@AA@A@@A@@@@@@AA@@CAC@@@ACAACCA@CAAAA@@AAA@@@A@A@AB@@AAA@@@A@@@@@A@@@A@@AAA@@AAA@@@A@@@A@A@@@@@@B@@@B@@A@@@AB@@@@@@@BB@@@B@B@@@B@@B@@@B@@@BB@@BBBBB@@@@B@BB@@B@@@B@@@@B@@@@@@ABA@@B@@@@@BB@B@BB@@@@BB@@AB@@@B@@ABB@@B@@@B@@@@@BB@@@BB@@B@@@B@B@@@B@@@@@B@@B@@@@@@@@@@B@@B@@@BA@@B@@AB@@BB@@B@@@B@@@@@@@B@@@B@@@@@@@BB@@@@B@@BB@@@@@B@@@B@@B@@@B@@AB@@@B@@@@@B@B@B@@AB@@A@A@A@A@@@AAA@@@CA@@A@A@@@A@@@@@@B@@@@BBB@B@BB@@B@B@@@B@@B@@@@B@@@B@@@B@@BB@@@@BB@@@B@@B@@@BB@@BB@@BB@B@@@@@@B@@B@@@@@@B@@A@A@@@A@@@@@A@@@@B@B@@@@A@@@CA@@@@AB@@A@@@@@A@A@A@@@A@@@ABCBA@AB@@A@@@AB@@A@@BA@@@@@A@@BA@A@@@A@@BA@A@@@ABA@A@@@@@AB@@A@A@A@@@A@A@@@A@@@@@@@BBB@@@@BBB@@BB@@B@D@B@BB@B@@@B@@BDBB@DB@@@B@@B@D@D@@@BA@@B@B@B@@@B@@@B@@@BA@@B@@@@A@A@ABAB@@A@@@@B@@@BA@@@@B@@@B@@@@@BA@@@@@A@@@@@A@@B@@@BA@@@@@@B@@@@@B@@A@@B@@@B@B@@BB@@@@@BB@@BB@B@@@B@B@B@B@@BB@BDBBBBB@DB@@@BB@@@@B@@B@@B@@@B@@BB@@@B@@BB@@@@@@@@@@@B@@BABBB@@@@@@@B@@@BB@D@@@B@@@@A@C@A@A@A@@@@B@@@@@@@BB@@B@@@@A@@B@@@@A@@@AB@@@@A@@A@@A@@@@@A@A@A@ABC@ABA@CBA@ADAB@@AB@DAB@@@B@@@A@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@ABABA@@@A@@B@@A@@BAB@F@B@BA@@@@@@@B@B@F@BBB@B@DBB@@@B@BAB@B@@@BABBD@DBD@BBBB@BBBBBDBDDB@BBBBD@BBB@DAB@B@@@B@B@B@DBDBBBB@B@D@B@BBBBBBDD@@B@@@@@@BB@BB@BB@@@@@BBD@@BB@@@@@@@@@@@@@B@@B@@@@B@@@@@@@@@@@B@@@@@@B@@@@@@B@@@BB@@@@B@@@@@@@@@@@@B@@AB@@@BBB@@@B@@@@BB@@@@@@@@BB@@@@@B@@B@@@@@B@BB@@@@@@@@@B@B@D@B@@BB@B@@@@BB@B@BB@@@B@B@@BB@@BB@@BD@B@B@DBD@@@B@B@BBB@D@BBB@D@BBB@@@@@@@B@@@@BB@@@@@@@B@@@@@@@@@@BB@@@@@@@B@@B@@@@B@@@BB@@@@@@@@@@@@@@@@@@@@B@@@BB@@B@@@@@@@BB@@@@@@B@@@B@@@@B@@B@@@@@B@@@@BB@@@@@@@@@B@@@@@@@B@@B@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@B@@@@@@@@B@@@@@B@@@B@@B@@@@D@BBBBFDBBDBBBB@F@BBB@B@@@B@@@B@@ABAB@@AD@D@D@D@D@F@D@DBD@D@B@B@DAD@DBB@B@BB@@BBFBFBB@@BDB@BB@B@@@@B@BAB@@JBB@@@@@@@DBD@DB@@@@@@B@@@BB@@B@@@B@@@B@@B@@BA@@BABA@B@B@@B@@@@@@@A@@@B@@@@BB@B@DBF@@@@@ABA@@@@@@BB@@@@@B@B@@B@@B@BB@@B@@BB@@@@@@@@@BB@@@@@@@@B@@@@@@@B@@@@BB@@@@@B@@B@@@@@@B@@@@@@@@@@@BB@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@BB@@@@@@@B@@@@@@@@@@B@@B@@@@@@@B@@@@@@@@@@B@@B@@@@@@@@@BB@@B@BB@@@@B@@@@@@BB@@@@@DBB@@@@@B@@@@@@B@@@@@@@@@@@@B@@@@@@@B@@@@@BB@@@@@@BB@@@@@@BB@@B@@@B@@@@@@@@@@B@@B@@@@@@@B@@@@B@@B@@@B@@@@@@@B@@BB@B@B@@@@@BB@@@@@@B@@@@@@BB@@@@@@@@@B@@@B@@@B@@@@BB@@@@@B@@B@@@@@@B@@B@@@@B@@@@@@@B@@@@@@@@@@@B@@BB@@@@@@@@@B@@@@@@@B@@@@@B@@@@B@@B@@@@BB@@@@@@@@@@@@@@@@BB@@@@@@@@@@@B@@B@@@@B@@@@@B@@B@@@@B@@@@@@@@@@@@@B@@@B@@@B@@@@@B@@@@@B@@@@@@AB@@@@@B@@@@@B@@@@@B@@@@@@@@@@@@@@@B@@@BBD@@BB@@@@@@@@@B@@@@@@@@@DB@@BB@@@@@@@@@@@@B@@@@@@@@B@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@BB@@@@BB@BB@@BBB@B@@@BBDBB@@@B@@@@B@@B@@@@BB@@BB@BBB@@@D@B@B@@@B@BBB@@@B@B@@@B@@@BB@@B@B@@@B@@@B@@@B@@@B@@@B@@@@@@B@@B@@BB@@@@@@@@@B@@BB@@@@BB@@BB@@@@@@@B@@BB@@BB@@@B@BBB@B@@@B@B@B@@@B@@@B@@@BBB@B@B@B@@@B@@@B@B@@@@@B@B@B@B@@@@@B@@@@@@A@@B@@@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@BB@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@B@@@@B@@@@@@@B@@@@BB@@@@@B@@@@@@@@@B@@@@@@B@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@B@@B@@@@@@@BB@@@@@@B@@@@@@@BB@@@@B@@@@B@@@@B@@@B@@@@@B@@@B@@@BA@@@@B@@@@@@@@@@@BBD@@BD@DAB@D@@@B@@B@@@BABADADBB@BBB@@B@B@@AB@B@@AB@@BB@@@@@B@@@@AB@@@@B@@@@@@@@@@@@@B@@B@@@@@@@@B@@@@@@@B@@@@@@B@@@@B@@@@@@@@@@BB@@@@@@@@A@@B@@@@@@B@@@@@@@B@@@@B@@@@@@B@@@B@@B@@@@@@BB@@@@@@@BB@@@@BB@@@@@B@@B@@B@@@@@@@@@@@@@@B@@@@AB@@@@A@@@@B@@@@@@@@@B@@@@@@@@@BB@@@@@@B@@@@BB@@@@@@@@@B@@@B@@@@@@@@@@B@B@B@@@BB@@B@@@@@@@B@@@B@@@B@@@B@@BB@@@@B@@@@B@@@@B@@B@@@@@@@B@@@@@@@@@B@@@BA@@B@@@B@@@@@@@BA@@BA@@BA@@@@@AB@@@@@@@B@@AB@@@@@@@@@B@@A@@@@B@@@@@@@@@@@BA@ABAB@B@DA@@B@@@@@@BBB@BBBB@@BDBBB@@@@ABDBBBBB@@B@BB@@@@@@@@@@@@@B@@AB@@@B@@@@@B@B@@BB@@BBBDBBBBDDDBBBBB@BB@BBBBBBB@B@BBB@D@@@B@@@@AB@@@@@FDB@@@@@@@@B@@@@BDBB@D@@@@B@@B@B@@@@@@@@B@@B@@@B@@@@BB@B@@@@@@@@@B@@A@@D@B@BBBBB@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@B@@@@@@B@@B@@B@BB@BB@@@@@@@B@@BB@@@@@@B@@@@@@@AB@@BB@BB@@BBB@BBB@@@B@B@@@@BB@@@@@BB@@@@B@@BB@B@@@@BA@ABCBABA@C@EAA@@A@@@CBA@A@C@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@A@@@@@@AB@B@HA@@B@@@BA@A@AB@@@@@BK@A@@@@A@@@@@@@@A@@@B@@@@@A@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@A@@@@BA@@@@@A@@@@@A@AB@@A@@@A@@@A@@@A@@BA@ADEA@@@@@@A@@@@@A@@@@@AA@@@@@@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@ABA@@B@@BBB@@BB@@@D@B@BBB@@B@BAB@@BB@B@@ABCBC@CDA@@@@@@B@@@@@@@@@B@@@@AB@B@DABAB@@@@@B@@@@@@@B@@@@@@@@@@@B@@A@@@@@@@@@AB@@@@@@AB@@@@@@AB@@@@AB@@@@@@@@@@@@AB@@@@@@@@@@A@@@AB@@@@@@A@@@@@A@@@A@@@@@@B@@@@@@@@A@@@@@@@@@AB@@@@@B@@@@@B@@@@@@@@AB@@@@@@BB@@@@@B@@B@@@@B@@@@@@@@@@@B@@@@@B@@@@A@@@@B@@@@@BA@@@@BA@@@@@@@A@@@@@@@A@@@@@A@@A@@@@A@@@@@@@A@@B@@@@@@@@BB@@@@@@@@@@@@@BB@@@@@B@@B@@BB@@@@B@@@@BA@@@@B@@@@@B@@@B@@@@@@A@@@AB@@@@@@@@@@@B@@A@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@B@@@@@@@@B@@@@@@@BB@@@@@@@B@@@@@@B@@BB@@@@@@@@@B@BABCB@B@B@@@@@B@B@B@@@@BBB@@@@B@B@@B@@BB@@DB@A@@BA@ABAB@B@B@BA@ABB@@BBB@BBBBBBDBBBBB@@@@BBBB@@B@@@@@B@@D@D@BB@@B@BBA@@B@BB@@F@BA@@@ADBB@BA@@AA@AB@@GDC@AAA@A@ABA@@B@BCD@@A@AB@@@BABA@A@@B@@CBCBABA@AB@BED@@@@DD@@BD@DB@BBB@DA@AB@BBB@B@B@@@B@B@@AB@B@BA@AB@B@@@BABAB@@@DA@@B@@@@@B@H@@@BBB@F@@@BB@@F@FAFBF@@@BB@@B@B@HD@@B@@@B@BB@B@@@@@@@BB@@BB@B@BBB@B@BA@@B@@@@AF@@@B@DC@@B@B@B@DBBB@@@@BAD@DB@@@@@BB@FDB@@@B@DBD@B@B@DA@A@@D@B@B@B@D@@@B@@@B@B@@@@@@@D@D@B@B@B@@@B@@@@@@@@@@@B@@@B@@BB@B@@@@@@@B@@@B@@@@@@@@B@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@B@@B@@@@B@@@B@@BB@@@@@@@@@B@@@@@B@@@B@@@B@@@@@B@@@@@@@@@@@B@@@@@@@B@@A@@@@@@@@B@@B@@@@B@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@@@@AB@@@@@@@@@@@B@@@@@@@B@@B@@@@@@@@@@@@@@B@@AB@@@@@@@@@@@@B@@B@@@B@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@B@@@@B@@@@@@@@@BB@@B@@@B@@BD@B@BA@@@@B@@AB@@@@@BA@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@BA@@@@@@@@@@B@@@@AB@@@@@@@@@BB@BBBB@@B@@@@A@@@A@A@@@@@@@A@@@BB@@DFDF@BB@BBB@BBFABA@@@@D@BAB@BCB@B@B@BBBB@@BBFBBBDBFD@@BB@@@@AB@@@@A@@@A@A@@@@@@B@@ABA@@A@@@AA@@BA@@@@B@@@B@@@@@@A@@@AB@@@BA@@@A@B@@BBA@@@@@@@BA@AB@@@@@@@@A@AB@@@B@@@@@@@AA@@@A@A@@BA@@@@B@@AB@@@@A@@A@@AB@@ABADA@A@ABA@@@@@@@AB@B@B@@@@@@ABA@@@A@@@A@@@@B@@A@@@@B@@@@@@@B@@A@@B@@@B@@A@A@@@AB@@A@@@A@CB@@BB@@A@AB@@AA@@@B@B@@A@@@A@@@A@@@A@@BC@@BA@A@@B@@@@@B@@A@@@@@AAAB@@@@B@@@BB@@A@A@ABA@@@@BABB@@B@@A@@@A@@B@B@@A@A@A@@@A@B@AB@B@@@@@@A@C@@BA@@@@@@@BB@@@@@@A@@@@@A@@@@@A@@@AB@@B@@B@@C@@@@A@@@@@A@@AB@@@B@BA@@@ABC@@@A@AB@@@B@@@@A@A@@@@@A@@A@@A@@@@B@@@BA@@@@@A@AB@@@@B@@@@BA@@@@@@B@@B@@@ABA@@@@@@@@@@BA@@@A@BA@@@@@@@@A@@AA@AB@@AB@@@D@@B@B@@@ABA@@@@@@@A@@B@@@@@@@@@@A@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@A@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@B@B@@@@@@@@@@B@@@@A@@@@@@@@@@@@B@@@@@@B@@@@@@@@@B@@@B@@@A@@BA@@@@@@B@@B@@@B@@@@@@@@@@BA@@@@BA@@@BB@@@@@B@BAB@@A@AAABA@B@@@AB@@@B@@@@ABA@@A@@A@@B@@B@@@@BA@@@@B@@A@@A@@@@A@A@@@@@AB@@A@AB@@@AA@@B@@AB@@@@B@@B@@@@BBA@@B@@BBA@A@@B@AABBBB@@B@B@@@@AB@B@BA@AB@@B@@@@B@@A@A@@B@@@B@@@@BB@@ABAB@@BB@@A@@B@@@@@BB@@@@@@BA@@@@A@@A@@B@@@BBB@B@@B@ABA@@@B@@@AB@@AB@@@@B@@@B@AB@@A@B@@BB@@@@B@@@B@@@BA@BB@@A@@@A@B@@@@B@@@B@@@@@A@@AB@@@@@@@B@@A@@BB@B@@@AB@@AB@@B@@@BB@B@@A@@BB@@@@@BA@@@BB@@@A@@BA@@BB@@@BA@@B@@@AB@@@@B@@@@B@B@@@B@@@@@@B@@@@@@B@@@BA@@@@@B@@@@@B@@BA@@@A@A@A@@B@@@@@B@@@@@@B@@@B@@BABB@@B@@@@@B@@@B@@@@B@@@@@@@@@@B@@B@@@@B@@@@@@@BB@@@@@A@ABB@@@@@B@BB@B@@@BA@C@@B@@B@@@@BA@@@@B@@@@B@@@AB@@BBA@@B@@A@@B@@B@@@BB@@BB@@@BBBBB@@@@@@AB@B@@@B@@B@@@@@@@CBA@@@B@@@@B@@@B@@@B@@@B@@B@@@B@B@@@@@@B@@A@BB@B@@@BBBB@@@@@@@B@@@@A@@@@@@B@B@@@@@B@@BA@@@BBBBB@@@@A@@A@@@A@B@B@B@B@@@@B@@@@@B@@@BB@@@@@@@@B@@BB@@B@@@ABB@@@@@B@@@@B@@A@A@@@BB@@B@@@@@B@@B@@@@A@@@@@@B@@@@@@A@@A@@@B@B@@B@@BA@@@@@AB@@@@@@@B@@A@@BAB@@@@AB@@@BA@@@@@B@@@B@@@@@@@A@BB@@@@@@@@@B@@B@B@ABB@B@@B@@AB@@A@@@@@A@@@A@@BAB@@@@@@@@A@@BA@BB@BA@@@@BC@@@@B@B@@@@@@@BA@BB@BB@@B@@A@A@B@BB@@@BB@@@@@@BA@AB@@@@@B@@@@@@A@@@AB@@@@AB@@@@@@@B@@@@@@@@@BA@@@@BA@@@@@A@@@@@@B@@@D@B@@A@AB@@B@@@@B@@@BA@@@@B@@EB@@@BABA@@@@B@B@@@BA@@@@@BB@@BB@B@@@@@@@B@AAB@B@@@@@B@BA@AB@B@BA@@@B@@B@@@@@@BB@@@BB@@@DBBB@B@B@@@BB@B@@B@@B@@@B@B@B@B@@@B@@BB@B@@BB@@@B@@@@@B@B@@@@@B@@@BBB@@@B@@@@@@@@@B@@@BBB@DBB@BBB@B@BBB@@@B@BB@@B@@@B@@@B@B@@@B@@AB@@@BA@@B@@AB@BAB@B@BA@@B@D@@@B@@@D@BA@@B@BAB@BAB@DAB@BAD@BAB@B@@AB@BA@@@A@@@@@@B@@@@BB@@@@@@@@@B@@@@A@@B@@@B@@@@@@AA@A@A@AAA@BADA@@B@@@@@D@B@@@@@@ABA@@@ABAB@BBB@DAB@DA@@@@@A@@BA@AD@BA@A@@B@@AAA@@@A@@BA@@@A@@BCB@BA@@B@@ABA@A@@@A@@A@@@@EBA@A@@BC@@BCBA@CA@@ABA@@BAF@@AB@F@@ABA@@B@@@B@B@B@@ABAAADADA@@DBB@D@F@BBB@B@@@B@B@@AB@@@@@@A@@B@@A@@A@@@@ABAA@@AC@A@E@A@A@G@A@@B@@@@CC@@@A@@JCFCB@B@D@@@@@D@B@@AB@@@EAAAAAEA@@@AAAA@AAEC@AC@@ACCACA@@A@@@AB@@A@@@ACA@@AAA@CC@@A@A@FAF@F@J@J@B@BAFABADALCB@HCB@FAD@BADAB@B@FBDAB@DADADABADABAHE@@ABA@@@@@DCB@@@A@@@GBA@@@@ADA@A@@AABA@E@CAAA@AAB@B@BCB@AC@A@A@@A@@A@@B@@AAE@A@@C@@@@@DABA@C@A@@@APB@@@@BA@A@@@@@A@@@@@A@A@@B@@AA@@@@@@@@@@A@@BA@@@A@@@@@@A@@@@A@@@A@@@A@@@@B@@@@A@@AA@@AAB@AAB@@@B@B@@AB@@@BC@@@@@@@AB@@@@A@@@@@@@A@@BABABA@@@@AA@@@@@@@@B@@@@A@@@@B@@@@@B@@@@@@AA@@@@@B@@@@@@@@@B@@AB@@@@A@@@A@@B@B@@@@@@AB@@@@A@@BA@@B@@@@@BA@@@@A@@A@@AA@@@@B@@@B@@@B@@@@AB@@@@AB@@@@@@ABAA@B@@@@A@@@@BA@@@@@A@@@AB@B@@@@@@AA@@A@@@@@A@@@@BB@@B@B@A@@@B@@@@A@@A@@@B@B@@@@@@@@B@@B@@@@AB@@@@@@B@A@@@AA@@@@AB@@@@@@@@A@@B@@BB@@BA@@@@@B@BA@@B@@A@@B@@@@BBA@ABB@@@@@A@@B@@A@@B@BB@@B@@A@@A@@A@@@@@A@@@@B@B@@A@@@@@@B@B@@@AA@@@@B@B@B@@A@@@@B@@@@A@@@@B@B@@@@B@@@@BA@@@@@@@A@@BBB@@@B@@A@B@@B@@A@@@AB@B@B@@@@@B@AA@@@@B@@@@@@B@@@@BA@@@@@B@@B@@@@ABA@@@@B@B@@@@@@A@@D@@@@B@@@@B@@B@@@@BA@@@@@A@@@AB@@@D@@@@BB@@@@BB@B@BA@@B@@BBAB@@@BB@@A@@@B@@@@@B@@@@@B@@@B@@B@@B@B@@@@@@@B@@@@A@@@@@A@@B@@A@@@@BAB@@@@A@@B@@@@A@@@@A@@A@@BAB@BA@@B@@@BA@@@@@AA@@@B@@AB@@A@@@@@@@@B@@@BA@@AA@@@@B@@@B@@@@@@A@@@@@@@@B@@A@ABA@@@@A@@A@@B@@@B@F@@@@@B@@@@B@@B@@AB@@A@@B@@@@BB@@@B@@@ABB@@@@AB@@A@@@@@AB@@@@@AA@@@@B@@@BA@@HA@@@AB@@@B@@@@@BABA@@B@@B@@B@B@@@BBB@BB@A@@@@AA@@F@@AB@D@DA@@B@@@BBB@@@@@@@B@@@B@@@@@B@@@@@@@BAB@@@B@@A@@DB@@F@@@BAB@@@@A@@A@@A@@@@B@B@B@@@BABA@@@B@@BD@@BA@@@BB@@@BBB@B@@A@@@@B@@A@A@ABABC@CB@CA@AAAGCAA@AACA@AC@@CC@A@AAA@@AA@AAA@AB@@A@@@@ACEDA@@BAA@B@@A@@B@@@A@@C@@@@C@A@A@@@A@@A@C@AACB@@@AA@@@@@@@@@@A@@@@A@@@@@@@@@@@@A@@@@@@@@@@A@@@@BA@@@@@ABA@@@@@AH@@@@A@GEA@@@AA@@A@@@@@@@@@@@@@@@A@@A@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@A@@A@@@@@@A@@@@A@@AAC@@@A@@A@@@@@A@@@A@ABA@AB@@A@@@A@@B@@@@@BA@AB@@@@AA@A@AAA@@AA@@A@@AAAA@@@A@A@@A@@@@ACAAC@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@AA@B@A@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@AA@@@@@@@@A@@@@@@@@A@@@@A@@@@A@@@@@@@@@@@@@A@@@@@A@@@@@A@@@@A@@@@@@@@B@@A@@A@@@@@@@@@@@@@@A@@@@@A@@@@@@@C@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@C@@@@@@A@@A@@@@@@@@@A@@B@@@@@@@@@@@@A@@B@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@A@@@@@@@@@@A@@A@@@A@A@A@G@A@@@@@@@@AA@@@@@@@@@@A@@@A@@@A@@@@ACAA@@@@@AA@@@@@@AA@@@AA@@A@@@@A@@@@@@@@@A@@@A@@@@@A@@@@@@@A@@@@@@@A@@@A@@@@@@@@@@@@A@@@@A@@@@@B@@A@@@A@@@@@C@@@@@@A@@@@@A@A@@A@@A@@@@B@B@B@B@B@B@B@B@D@B@@@B@D@@@@A@@@@@A@@@@@@@@@@A@@@A@@@@@@@@A@@BA@A@@@@@@@AB@@@B@D@B@@@@@BA@A@@@A@@B@@@@@B@@@@DB@@@@@B@@@BA@@@@@A@@B@@@BA@@B@@A@@@@@@A@@@A@@@@A@@BA@@@@@@B@@@B@@@B@@@BA@A@@B@@@B@B@@@@@@B@@B@B@B@@@B@B@@@BA@@@@@A@@A@AAA@@A@@@@@A@@B@@@@@@@B@B@B@BB@@BB@@@@@BB@@BB@@B@B@B@@@BB@@@@@@@@@B@BA@@B@@@@@@@@@@@B@BAB@B@@@B@@@B@@BB@@@B@@@B@@@@@B@BCB@@AB@B@@@B@@@B@@@@@@@@@@@@@@@@@@@BB@@@@@@@@B@@@@@@@@BB@@@@A@@@@@@@A@@@@@AB@@A@@@@A@@@CA@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@A@@A@@@@@A@@AA@@@@A@@@A@AB@@@@AB@@@@AB@@A@@@@@@@@@@@A@@@@A@@@AA@@@@@A@@@@@@@AA@@@@A@@@@@A@@BA@@@@@A@@@A@@@A@@B@BA@@@@@A@@@@BA@@@@@A@@A@@@A@@@A@@A@@@A@@@@@AB@@@B@@@BA@@@A@@AA@@A@@@A@@@A@@@@@AA@@@@AA@@A@@@@@@AA@@@@C@@@@@A@@A@@@@@@@@@AA@@@@@A@@@A@A@@@@BA@@@@@A@@@@@@AA@@A@@@A@@@A@@AA@@A@@@@@@@@AA@@@@@AB@@@@@@A@@@@@A@@@@A@AA@@@@@@A@@@A@A@@A@@@A@@B@@AB@@@@A@@@A@@@A@@@@@@@@@A@@BA@@BA@@AA@@@A@@@@@A@@@A@@A@@@@@A@@@@BA@@BA@@@@@AAA@@A@@@A@@@@@@@@@@@@A@@@@@@@@@@@@A@@A@@@@@@AA@@@@@@@@@@AB@@@@@B@@@@@B@@@@@@@@@@A@@A@@@@@AA@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@A@@@@@@A@@@@B@@@@@B@@@@@@@B@@@@@@AA@@@@@@@B@@@A@@@AA@@@@@@@@AA@@@@@@@@@@@B@@@@A@@@@@@@@A@@@@@@@@@@@A@@@A@@@AB@@@B@@@B@@@@AB@@@BA@@B@@@@@@@@@@@@@@@@B@@@@@@@@@B@@B@@@@@B@@@@@BA@@@@@@@A@@A@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@B@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@AB@A@@@@A@@AA@@@@@@@@CA@@@AA@AA@@A@@@@@AA@@@@@@A@AA@@@@A@@@@@AA@@AA@AA@@@B@@@@C@AA@@A@@AA@@@AA@@@@A@@@@@@@@@@@@@A@@@AA@@@@@@A@@@A@@@A@@@@A@@A@@A@@@A@@A@@@@AA@A@@@@@@@A@@@@A@@@@@@A@@AA@@@@@A@@@@@@@A@@@@@A@@AA@@@A@@@@@@@@@@@@@@@AA@@@@@@@@A@@@@B@@@@A@@@@@@@@@@@@@@@@A@@@@A@@@@@AA@@@@@@A@@@@@@@A@@@@@@@@AA@@@@@@@@@@@@@A@@A@@@@A@@@@@@@@@@@A@@A@@@A@@AA@@@@@A@@@@@@@@AA@@@@@@@@@AA@@@@@AA@@A@@@@@@@@A@@A@@AA@@@@@@@A@A@@@@@@@@@@@A@@@@@A@@@@BA@@@@@AECAAA@CACAA@@@C@A@A@A@C@ABA@@@AA@@@@@@@@@@A@@@A@@@A@@@A@@A@@@@@@@A@@@@@@@@@@@@@A@@AA@@@@@@@@@A@@A@@@@@AA@@A@@@@@A@@@@@A@@A@@AA@@@@@@@@@A@@@@@@AA@@@@@@@AA@@A@@@@@@@@@@A@@AA@A@A@@@@A@@AA@@@@@@AA@@@@@A@@A@@@A@@@A@@@AA@@@@A@@@@@@@AA@@@@@AA@@@@@@@A@@A@@A@@@@AA@@@@A@@A@@@@@@@A@@@A@@@@@A@@@A@@@@@@A@@@@@@@@@@@@A@@@@AB@@@@AA@@@@A@@AA@@AA@@@@@@@A@@@@@AA@@@@@AA@@@@@@@A@@@@@@A@@AA@@@@@@@A@@@A@@@@@@@A@@@A@@AA@@@@@AA@@AA@@A@@@A@@@@@@@AAA@@@@@@@AA@@A@@@@@A@@A@@@@A@@@@@@@@@@@@@A@@@AB@@A@@@@@A@@@@AA@@@@@@@AA@@@@A@@@AAA@@@@@@@@AA@@@@@A@@@A@@@@@@@@A@@@@@A@@AA@@@@@@AA@@@A@@@@AAA@@@@@@@A@AA@@@@A@@A@@@AA@@@@@@@@A@@B@@@@A@@@@@A@@@@@@A@@A@@@@@@@A@@@A@@@@@@@@@AA@@@@@@@@@@A@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@A@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@A@@@@@@@@@@@A@@@@@@AA@@@@@@@@@@@@@B@@@@@@@@A@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@A@@@@@@@@A@@@@@@@@A@@A@@@@@@@@@@@@@@AA@@@@@@@@@@A@@@@@@@@AA@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@A@@@@@@@@@@@@@@@A@@@@@@@BA@@@@@@@@A@@@@A@@@@B@@@@A@@@@@@@@@@@@@@@A@@@@@A@@A@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@A@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@A@@A@@@@@@@@A@@@@A@@@@@@@@A@@@@@@@@@@@A@@A@@@@@@A@@@@A@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@@@A@@@@@@@@A@@A@@@@@@@@@@@A@@@@A@@@@A@A@A@DA@@@@@@B@@@@@@A@@@@@@@A@@@@B@@@@A@@@@@@B@@A@@@@@@@@@@@A@@@@@@B@@A@@@@@@@@@@B@@@A@@@@@@@@@@@@@AA@@@@@@@@@@@A@@@@B@@A@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@A@@@@@A@@@@@@@@@@@@@@@AA@@@@A@@@@@AA@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@@A@@@@A@@A@@@@@@@@@@@@@@@@@A@@@@@@@A@A@@@@@A@A@AAA@@@A@@A@@A@CA@@A@@@AAAA@A@AA@@@AAAAA@AA@AA@@@@A@@AA@A@A@A@@A@@A@@CA@A@@@A@@AAA@@BC@A@A@@AAACAA@@@ABA@@@A@@A@C@A@ABA@@@A@A@ABCBCBC@EBA@EBOBA@@@GB@C@@BABC@@BA@A@@@A@A@@ACAA@A@@A@@A@@@AA@@CA@AAAAC@@@CCA@@AAAAA@@@@@@@@@@@@A@@A@@@@@@@@@@@@@BA@A@BCA@@@AAAB@@A@AA@AECAAACEB@A@A@@A@A@CAAAA@AAA@A@@@A@CAA@C@@@@A@@@@@@A@@@A@@@@@@A@@@A@AA@A@AA@A@@AA@@@A@@A@AA@AA@@@A@@A@@@A@@@@@@AA@@@@@@@@@@@A@@@@@@@@AA@@@@@@@@A@@@A@@B@BABA@CDA@CD@@@@@@A@@@@AA@@@AAA@@@@AA@AACAA@@@@A@CE@@@A@C@CAA@A@@@ABA@@@A@@@@@@@@B@B@B@@A@@@@@@@@@@@@@@@@@A@@AA@@@CBCBAAA@@B@@@BAB@BA@A@C@C@A@A@A@A@A@ABA@@@A@@@@BA@@@@@A@@@ABAB@@@@@B@B@@@B@@@@A@@B@@@@A@@@A@AB@@A@@@A@A@@@A@@@@@AA@@A@@@@@@BA@A@@@@BA@@@@BABA@C@CBA@A@@@A@@A@@AA@@A@@@@@@@A@@A@@@A@@A@@AA@@@@A@@@@AA@@@AAAB@@A@A@@@A@@@@@CBA@@@A@@@AAAA@@AA@@AAA@@AA@@@@AA@AA@@@@CAC@@@A@A@@A@AACAC@A@AAAAAACA@A@A@@AAA@@AA@A@@A@@AA@@C@A@A@E@ABCBAB@@A@@D@BABA@@@C@@@A@EBA@@@@@@B@B@@A@EA@@@@A@@B@@@@@DAB@@A@A@@BAAA@@@AA@@AAAA@AA@@@A@AA@@@@@@A@A@A@@AA@@@A@A@@@A@A@@@A@@@AB@@@@AA@@@@@@@@@AA@@@@A@@A@@@@AB@@@@@@@@AB@BA@ABA@A@@BA@@BCBAB@BA@ABADA@@AA@@B@@@@A@AA@A@@A@@@AAB@@AA@@A@AAAA@@@@@A@@B@BAB@BA@@@AB@DBB@@@B@B@B@B@B@@@@@@@@BB@@B@@B@@@@BB@@@@B@@D@@@B@@A@A@@@A@CBCBC@@@ABABE@ABAA@@AA@@A@@@A@@A@A@AA@@AA@A@@AA@@@A@AAA@A@@AAA@AA@@@AA@@A@@BA@@@AA@@A@@@AAAA@@@@@A@@@ABA@A@A@@BA@A@C@A@A@A@A@@@C@A@A@@@@@@@@@A@@@@@@B@@@@@@@@A@@A@@A@@BA@A@A@@@@@@@@BA@@@@@@@@@A@@@@@AB@@@@A@@@@@@@A@@B@@@@@@A@@@@@A@ACAA@AAA@@A@@@@@AA@@@A@@AA@@AAA@@@CB@B@@@@@@AB@@ABAA@@@@AAA@@@A@@A@AAACAAAC@@@A@AAA@@AA@@@@A@A@AB@@A@A@@@@@AB@@@BA@@B@BA@@@A@C@@@ABA@@BABA@@B@B@@ABA@CBA@@BA@@AAAAG@A@A@@AA@ABAAAAA@A@A@@AC@@A@@A@A@A@CAA@AA@@A@A@@A@@AA@@A@A@@@@@BA@@@A@@B@@@@A@A@@@A@@A@ACAAAAE@AAAAC@@AA@A@AAA@AA@@@A@@CA@A@@@@A@@A@@@@@A@@@@AA@@@A@@@@@@@ABA@ABA@@@CB@@A@@BAB@B@@@BA@@@A@@AC@@@AB@@A@@B@@A@@@@@@BA@@A@A@A@AB@@A@@@AA@AA@@@@@AA@AA@@@A@@AA@@@AAA@@@A@@@A@A@@@AB@@@@A@@@A@ABABA@@@CB@@A@@BA@A@@@A@@@A@@@A@A@@@@@A@@BA@@@@@A@AA@@A@@@A@C@A@@@@AA@A@C@@@AA@@A@@@@@A@A@A@A@@@A@@@A@A@A@@BA@@@@@@@C@A@A@A@@AA@@BA@A@A@C@A@@@@@@AA@A@@@AA@@@@@@@AA@A@@@A@A@@A@@@@@AA@@@@AA@@@A@@A@@@@@AA@@@@A@@B@@AA@@@@ABA@@@@@A@@@A@@@A@@@@@AA@@@@AA@@A@@A@@@@@@@@@@@@AB@@AB@@AB@"],encodeOffsets:[[128470,43173]]}},{type:"Feature",id:"220582",properties:{name:"集安市",cp:[126.19403,41.125307],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCCAA@CAEAECCACCEEEEACAAAC@A@A@ABAB@DADAF@BBF@ZND@DBB@D@B@JCHADCHCDCBABC@@BA@AA@@ACAEAE@CAE@EBC@AAC@GCEAC@C@A@CBA@CBIFEDCBABC@C@C@AAA@AAAAAA@ACEAAACA@@A@AA@@@@@BA@@@@@@D@@@B@@@@@@AB@@@@@@AAA@A@@AAAA@@CACACACAA@A@OCA@G@EAGBC@GBG@EBCDEBEFCD@BAB@B@B@B@DBB@BDDFFBDBB@B@B@B@BA@@BABA@A@A@ABAAA@A@@AAAAACEACCCAAC@AAE@A@E@C@ABCB@BCDCDEDE@A@C@C@AAAAAACCAA@@ACAECGACACCCCAECCACAC@KGcQA@CACACAEAC@A@A@A@A@@@A@ABA@ABABA@@BA@@B@@@B@@@BB@@@@@@B@@B@BAB@@@B@@@@@B@@@@B@@@@@@@@ABA@@@@@@BA@@@@BAFABADABABA@ABABCBCBABABGFA@@@GDEBGBCAC@AAA@AACACCAAEEEEECCAGCEEMEGAGB@@C@E@C@A@CAG@ECGCCAA@@@A@CC@B@DCJCFINKPILA@GFKFMDGDGFEHGBEBEACA@C@EAAACEACBKBI@IAMCC@KCSIQGICE@IBCFAFBFDJHHDDBFAFBB@@@@B@@AB@BA@@@@BB@@@BB@@B@B@B@B@@@BB@AB@@@BAB@@@BA@@B@B@B@@B@@@B@@BB@B@B@@@B@B@@BB@BBB@BBB@@@@BB@B@@@BBB@B@BB@@B@@BB@@BB@B@B@@BB@BB@@B@B@B@B@B@B@BBB@B@B@B@@@B@BAB@@@B@BB@@B@@@B@@AB@B@B@@@B@@@BB@@BB@@@B@BB@@B@@BBBBB@@@DBB@@@@@@B@@@@AB@@@B@@@@@B@@@@@B@B@@BB@@@@BB@@B@@B@@@BBB@@@BB@BB@@@B@@@@@BAB@B@@@B@@@BBB@@BB@@BBB@BBB@BBB@B@B@BB@@B@@B@BB@BB@@B@@@B@B@BB@@B@@@B@@@BAB@BA@@@@BA@@B@@@B@BA@@BB@@B@@@BBBBB@@@@B@B@@@BB@@@@@B@@@B@@B@@@B@B@@@@@B@@BB@BB@@@@@@BA@@B@@AB@@AD@BAB@@BB@@@B@@@B@BABA@@@@B@B@B@@B@@BB@@@@@BB@@@@A@@B@@@@@@@B@@@@@B@@@@@BA@@@@@@@@B@@@@@@@@@@BB@@B@@@@BB@@@@@@@BB@@@@B@@@@@@B@@@@@B@@A@@B@@@B@@@@@B@@@@@@AB@@@@@@@B@@@@@@@B@@@@@BB@@@@@@B@@@@@@@B@@@@@B@@@@B@@BB@@@BB@@@@@BB@@@@BB@@@B@@BB@@@B@@@B@@@@@B@@@B@@@B@@B@@B@@@@B@@@@@@BB@@@B@@B@@@@BB@@@B@@BB@@@@@B@@@B@@@B@@@B@@@@BB@@@@@@B@@@BB@@@@B@@@@@@B@@@@@BB@@@@@@@BB@@@@@@@@BB@@B@@@@BB@@@@@@@B@@@@@@@BB@@B@@@@BB@@BB@@BB@@B@@BB@@@B@@BB@@@@BB@@BB@@@BB@@@@BB@@@@BB@@@@BB@@@@BB@@A@@B@@@@@B@@@@B@@B@@@@@B@@@@@B@@A@@B@@@@@@@BB@@@@@BBB@@B@@B@@BB@@B@@@B@@B@@B@@@BB@@B@@@@@B@@@@@B@B@@@@@@@BA@@B@@B@@B@@@@BB@@B@@@@BB@@BB@@@@@@BA@@B@@@@@BB@@@@B@@@@BB@@@B@@B@@BB@@B@@@@@B@@@B@@A@@B@@A@@@A@@B@@@@AB@@@@@@A@@@A@@@A@@B@@A@@@@@@B@@A@@@@@A@@@@@A@@@@@A@@@@BA@@@@@A@@@A@@@A@@@@@@@AB@@@@A@@@@@A@@@@@A@A@@@@@A@@@@@A@@@A@@@@@A@A@@@A@@@AA@@@BA@@@@@@B@@@B@@@@@B@@@B@@B@@@@B@@@@@B@@@B@@B@@B@@@B@@@@BB@@B@@@@BB@@@@@@@B@@BB@@@@@B@@B@@@@@BB@@B@@@@@@@B@@@@@B@@B@@B@@B@@BB@@B@@@@@B@@@@@BB@@@@BB@@@B@@@B@B@@@@BB@@@@@@B@@@@@B@@@BB@@@@BB@@@B@@B@@B@@@@@@BB@@@B@@@BBB@B@@@B@@AB@@@B@@AB@@@B@@BB@@@B@B@@@@AB@@@B@@@B@@BB@@@@B@@B@@B@@B@@B@@@@B@B@@BB@@@B@@@B@@@@@B@@@@@@BB@@@@BB@@B@@@@BB@@@B@@AB@@@B@@@B@@BB@@@B@@@BB@@@@BB@@B@@BB@@@BB@@@@@@BB@@B@@@@@B@@@B@@BB@@@@B@@B@@@@BB@@@@BB@@B@BB@@B@@@BB@@@BB@@@BBB@@@B@@@B@B@@@@@B@@@@@@@@B@@BB@@@BB@@B@@B@@B@@B@@BB@B@@B@@B@@BBBB@@@@@BBB@@@BB@@@@@B@@A@@BA@@@@@A@AB@@@@A@@B@@A@@BA@@@@@@@A@@@AB@@@@@@AAA@A@A@@AA@@@A@@@@@@@A@A@@@A@@@ABA@C@@BA@@@A@@@A@@@A@@@A@A@@@@@A@@@A@@@@@A@@@A@@@CB@@@@AB@@@@@BA@@@@@A@@@ABA@@@A@ABA@@@A@@@CBA@@@ABC@@@A@@@A@@@AA@@A@@@A@A@@B@@@@A@@B@B@B@@BB@@@B@@@B@@@B@@B@@BA@@@@B@@@BA@@B@@@@@B@@@@@BA@@@@@@B@@A@@B@@@@B@@B@@B@@B@@B@@@@B@@@B@@@@@@AB@@@@@B@@@@B@B@@B@@B@@B@@@@@B@@AB@@@@B@@@@B@@BB@B@@BB@@@B@@B@@B@@@@@B@@@@BB@@@@@@@B@@BB@@@@@BB@@BB@@@@BB@@@BB@@@@@B@@@@@B@@@@@B@@B@@@BB@@B@BB@@BB@@@@@@B@@B@B@@B@@B@@A@@B@@@B@@@B@@@@@@BB@@@@BB@@@@BB@@B@@@@@@B@@@B@@AB@@@@@BA@@@@@@@@@@@A@@@@@A@@B@@@@A@@@@@@B@@A@@B@@@@@B@@@@@BA@@B@@@@@B@@A@@@@B@@@@@@@BA@@B@@@@A@@@A@A@@@@@A@@@@@A@@@A@@@A@A@@@A@@@AA@@A@@BA@@@A@@@@@A@@@@@A@A@@@A@AA@@@@A@@@AA@@@@A@@@A@A@A@@@A@@@A@@@A@A@@@A@A@@@@@A@@@@@@@AB@@A@@BA@@BA@@@ABA@@@@B@@A@@@@@@@A@@@A@@@@AA@@@A@A@@@A@@@A@A@A@@@A@@@@@A@@@A@@AA@@@A@A@@@@AA@@@@@A@AA@@@@@@@@A@@@AB@@A@AB@@@@@@@@@@B@@@@B@@B@@B@@@@@B@@@@@@@@@BA@@B@@@@@@@@@BB@@B@@@@A@@B@@@@@@@@@B@@@@@B@@@B@@@B@@A@@B@@@@@B@@@@@@A@@B@@@@@@@B@@@B@@@@A@@@@B@@@@@@@B@@A@@B@@@@@@AB@@@@@B@@@@@@@B@@@@@@AB@@@@@B@@@@@@@B@@@B@@@@@@@@AB@@@@A@@BA@@@@@A@@@@@A@@BA@@@@@A@A@@B@@@@@@@@A@@@A@@B@@A@@@@@@@AAA@@@AA@@@@A@A@@@@@@@@@@A@@@@@A@@@@@A@@A@@@CA@@AA@@A@@@@@@A@@@@@@@A@@@@AA@@@@A@@@A@@@A@@@A@@@A@@A@@A@@@A@@@AA@@@@A@A@@AA@@@A@@@A@A@AA@@A@@@A@@@@@@@A@@@@@@B@@@@@@@@@BA@@BB@AB@@@@@@@BA@@B@@@@@@AB@@@@@@@B@@@@@B@@@@AB@B@@@@@@AB@@@@A@@B@@A@@@@B@@A@@B@@@@@@@@@B@@@B@@@B@@@@@B@@ABBB@@@@@B@@@B@@@B@@B@@@@@@@@B@@B@@B@@@B@@@@@@BB@@@@@B@@@@@B@@@B@@@B@@@@@B@@@@@@@@A@@B@@@@A@@B@@@@A@@@@B@@@@AB@@@@@BA@@@@BA@@@@@@@A@@@@@@@@@AB@@@@@B@@@@BB@@A@@@@@A@@@A@@BA@@@@@A@@@@B@@AB@BA@@@@BA@@@ABA@AB@@@@AB@@AB@@A@@@@@@@@B@@CFLDHDD@JHFLBNELSTMNIDLPHHFDDBDDJDHBD@FAFCDAFEFCF@F@F@HBHDFBBDBB@DADAB@@CBGDGDGDGFCBCDCDADAB@BAB@D@B@B@BABB@@BBBFBD@F@H@FAD@DAB@BAB@B@D@B@BBBB@DBBBDBFBDBF@DAB@DAB@@@B@@BBBBB@@BB@DDBBBD@F@BBDBDBDFDDBDBDBF@B@D@D@D@B@@@B@FAFAD@BAD@B@D@FBF@D@F@BBDBBBBB@BBBDBD@BBD@B@D@DBBBDB@BDBDBBB@DBBB@BB@@@@@B@BAB@BABCBEDABABCDAF@H@@BD@BBBDDB@DB@@D@HCFADCFCFADADAB@FBF@B@FBF@BBB@@B@@@B@@ADAF@@@D@DBBBFDDBFBD@B@B@D@D@B@BABA@ABE@CBCBC@@B@@ABADABAD@D@B@@@B@@@BBDBDBBBBBBB@B@@@B@DCBAFABCBABAD@BAD@DAF@D@B@BBBBD@HBFBFBDDDDBB@B@D@F@BA@@DABAFAD@D@D@B@BBDBB@BB@D@D@H@F@HBF@B@@@BBBBBBD@B@DBHB@@BA@@BA@@BA@@BBB@@B@@B@BBB@B@@@BBB@@AB@BAB@BAD@@@B@BBB@@BBBB@@@AB@@@BB@@B@@@B@B@@@@B@@@@@BB@@@BB@@B@@@B@@A@@B@@@BA@A@@@@@@B@@BB@@@B@@B@@BBB@@@B@@A@@B@@@@@@@@@B@BBB@BB@B@@BB@BBB@BBB@B@B@B@B@BBB@B@B@BA@@B@@@BA@@B@BA@A@AB@@@BB@@B@@@B@@@B@D@B@B@B@B@B@BA@@BA@@@@@@BA@@B@BABAB@BA@@B@B@B@B@@A@@BA@@@@DAB@@@@A@BB@@@B@@@B@B@B@B@B@B@D@@@BB@@B@BAB@BADABADA@@@@B@@@B@@@B@@@BBB@B@@A@@B@@AB@@@@@B@@BD@@BB@@@BABB@@B@B@@@B@BBB@@@@B@@@BB@BB@@BB@@@B@@@@BB@@@@B@@B@@@@BAB@BA@AB@@@@A@A@@@A@@BAB@B@@AB@BAB@@AB@@@B@@@@@BA@A@@BAB@@AB@@@BA@@@@BA@@@@A@AA@@AAA@@A@A@A@C@@BA@ABABAB@BA@@B@B@B@B@@@B@B@B@@BB@B@@BB@@@@@B@@@@@B@@@@@@@@@@@@@BB@@B@@ABBB@@@B@B@@@@@@@B@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@B@B@@@D@B@B@@BB@@@B@B@B@B@@@B@B@B@@@BAB@@@D@@@B@BAB@BA@@BA@@AA@A@@@@D@BAB@B@BAB@B@@A@@B@BAB@@@B@@@B@@@BAB@B@BAB@@BB@@@@@@@BB@@@B@@B@@AB@B@BA@@B@@ABA@@@AB@@AB@@@B@@AB@B@@@BA@@@@BA@A@@B@BA@@BA@@@AB@@@@A@ABA@@BA@@BABA@AB@@A@ABA@@@A@@B@@@@@BAB@@@B@B@B@@@BB@AB@BA@@BABA@AB@BA@@@BB@@@BBB@B@BBB@B@B@B@@ABAB@BABA@ABABADABA@A@AB@BA@@@@@@@@@A@@@A@@@@@@@A@@@A@@@A@ABAA@@@AA@@A@@AB@@AB@@A@@@AA@@A@@@AA@@A@@@@@AB@B@BA@@@@BA@@@@B@@AB@@A@@@@@AA@@A@@AA@@@A@@B@@@B@BA@@@@B@@@@@BA@@@@B@@AB@@@BA@ABAB@@@@A@ABA@@B@B@BA@@B@@AB@@AAAA@@ACA@@@A@A@AB@AC@A@A@ABA@A@A@@@A@AA@@A@@@AAA@@@@AA@@@AA@@@@A@@@A@@@@@A@@@@@A@@B@@A@@@ABA@A@A@@AA@A@AB@@@@AB@@@@A@@B@@A@@@A@A@ABA@A@@@AAA@@@AA@@A@A@@@@@@@AA@@A@@AA@@@A@@@A@@@A@AAA@@AAAAA@A@@AA@@A@AAA@@@@BA@@BAB@BAA@@AAA@A@A@A@@@@@A@@@AA@BA@@@@@A@A@@A@@AB@@AB@B@B@@@DA@@BAB@B@BAB@B@D@@AB@@@B@@@B@@@D@B@B@BA@@@@B@@@@@B@@@B@@@B@@AB@@@@@@@@A@A@@BA@@@A@@@A@@@A@A@@BAB@@@BA@@@A@A@@@A@@@A@@@@@A@@@@@A@@@@@A@AA@@A@@@A@AA@@@@A@@@@@A@@@A@@A@@A@@@A@@B@@@B@BA@@@@BA@@B@@AB@@@@AB@B@@@BA@@@@@AB@@@@A@@AA@A@AAAB@@A@A@A@A@@@AA@@A@@B@@A@@@A@@@AB@@A@@@A@@@@@@@@@@@A@@@@BA@@@A@A@A@@@A@@A@@AB@@@@@BA@@@@@AB@@@B@@@BA@@@@@A@A@A@A@A@A@A@@BA@@@A@@@@@AB@@@@AB@@@B@@@B@@@BAB@B@B@D@B@B@D@BBB@D@BB@@@@BBB@@@@BB@@@B@@BB@@@B@@@@@B@@@@@@@B@@@@A@@AA@@BA@ABAB@DADAB@B@@A@@@@@@BA@@@@@@DAB@BAB@B@@@B@BAB@B@B@@@BB@@BB@@B@@BB@@@@@B@@BB@@@@@BBB@B@B@B@B@B@B@@B@B@@@B@@@B@B@@B@DB@BBBB@@B@@DBB@B@B@B@@@BA@@@@B@@A@@@@B@@AB@@@B@@@@AB@B@@@B@@@BBB@@@B@BBBBB@BB@@B@@@B@@@B@@BB@B@B@B@BB@AB@B@B@F@D@B@@AB@B@B@B@B@@@B@@@B@BBBB@@B@B@B@@@B@BB@@B@@@@@B@@@BBB@BBD@BBB@B@BB@@@@BB@@@B@B@@BB@@BB@@B@@BB@@@@B@@B@@@@@B@@@@B@@B@@@@BB@@@@B@@B@@BD@BBDBBB@@BBB@BBB@@@@@B@@@@@@@B@@@@@@@@@@@B@@@B@@@BA@@@@@@@@B@@@B@@@@@@@@@B@@@B@B@@@@@B@@@B@B@@@@@B@@@@B@@@@@B@@@@@@@@@@@B@@@@AB@@@@@@@B@B@@@B@@@@A@AB@@@@A@@@@@AB@@A@@@@@@B@@@@@@AB@@@@@@A@@@@@@BB@@B@B@@@@A@@BA@@B@@@@@@@BB@@@BB@B@@@@@BB@@@@B@@B@@B@B@B@@@@@@B@@BB@BBB@@BB@@@BB@@@BB@@B@@B@@@@B@@@@@B@@@@@@@B@BBB@B@@@@@B@@@@A@@B@@@@@B@@@@@B@@@@@B@@A@@BAB@@@@@B@@BB@@@B@@BBB@@BB@B@@@D@@@@@B@@BB@B@@@@@@@@@@B@@B@@@@B@@@@@@@B@@@@@BB@@B@@B@B@B@@@@@@@@@B@@B@@B@@@B@B@@@B@@@B@@B@@@@B@@B@@@@B@@@B@B@@B@@@@@@@@@@@@B@@@@@@B@@@@B@@@@@@BBB@@@@B@@B@@@@@@@B@@A@@BB@@@@B@@@B@@@@@@@B@@@@@B@@B@BB@@@@@BA@@B@@@BB@@@@@@BA@@B@@@@@B@@@@@@@@@@AB@@@B@@@B@@A@@@@B@@@B@@@B@B@@@@@@B@@BB@@@@BB@@@@@@@B@@@B@@BB@B@@@@@@@BB@@@@B@BB@@@@B@@@@@@@BBBB@@@@B@@@@B@@A@@BB@@@@@B@@BB@@@B@@B@@B@BBB@@B@@@@BB@B@@@@B@@@B@@@@@B@@@B@@@B@@@BB@@@@B@@@BBB@@@B@B@B@B@@@B@@@@@@BB@@@BB@@B@@@@@B@@@@@@BB@@@@@BBB@@@@@BB@@B@@BB@@B@@B@@@B@B@@@@B@@B@@BB@@B@@B@@B@@@B@@@B@@@@@@@@@B@@A@@@@BA@@B@@@BA@@@A@@B@@@B@@@B@@@B@@A@A@@@A@@@@BA@@BA@@B@B@B@@@BA@@@CB@@A@@B@@@@@@@BB@@@B@@B@@BB@@@B@@@B@@BB@@@@@BB@@@BB@B@@@BB@@@@@@@BB@@@@@B@@@@@B@B@@@B@@@@@B@@@@@B@@A@@B@@@@@@@B@@B@@@@BB@@@@B@@B@@@@@@B@@@@BB@@@@@@@B@@@@@@@BBAB@BA@@D@B@B@B@@@B@B@B@B@B@B@B@B@BA@@BAB@BA@@B@B@B@B@@@D@B@BABA@@B@B@D@@BB@BBD@@@B@B@@B@B@B@B@@B@@B@@@@@B@@BBDB@@B@B@D@@@DAB@B@B@B@BBB@D@B@B@DA@@BBB@D@B@B@B@BADA@A@A@A@A@A@ABA@@@@@A@@AA@@@A@@@A@AAA@A@A@A@@BA@AB@BB@@@@B@B@@@B@@@BB@@BBB@B@B@B@@@BBB@BAB@@@B@BBB@B@B@BAB@B@DBB@D@B@B@B@@AB@@@BAB@BAB@B@@@BB@@@@@@BBB@@@@@BBB@@B@@@@@BB@@BB@B@BD@@@B@B@@@@A@@B@@@BB@@B@@@@B@@@@@@@@@BB@@BB@@@@@@@@A@@B@@BD@@@BB@@@@B@B@@BB@@@BBBBB@@@BAB@@@@BBBBBB@@@B@B@@B@@BB@@BBB@@@@@@B@B@@@B@B@D@@@D@B@B@@A@@@@B@@@@@BBB@B@@@DADAB@@@DAB@@@@@B@@@BBB@B@@BDAGEAA@A@CAC@CA@@@A@A@CB@@A@@@@@@@@@BAFABABA@ADE@A@@@@@A@@A@@AA@A@CBA@@@@A@@@@@C@A@A@@AA@@@@A@@@E@@BA@@@@A@@@@@@FABAB@@@@A@@@ACCAC@A@@@A@C@A@@BA@AFCBAB@@@B@DAB@B@@@@@@@@@@A@@GCAAUKCCA@@@AA@AACACAEAEAE@CAA@@@AA@@@@@A@AB@@@@A@@@@A@C@@@A@@@@@AA@@@A@A@A@@@A@@A@@@@B@BA@@@@@@@A@@@A@@IQCC@AA@@@A@CGCM@A@@@@@A@@@A@AA@AA@@A@@A@ABC@CAEAEAC@ACC@A@C@C@ADGFGBEFG@A@@DABAB@PGLEJEHCHEBABA@ABA@@@A@AAA@AACCCACCACCCCEACAA@C@CAC@C@E@C@GBE@E@E@SAG@A@A@A@AB@@@B@B@B@BB@BBB@DBF@B@B@D@@@D@B@B@B@F@FBB@BB@BB@@@D@D@DBDBDD@DBDAB@D@BABABABA@CBIBG@E@G@G@AAC@GACACCSKGACACAYEIAEAAACAAA@@AA@ACI@A@C@A@A@ABEBA@A@@@A@@AA@@AA@@A@A@IBGBE@C@AAC@AAA@CCAAACAAACAC@ACICICCACCCCCCCUMKECCAAAAGECCAA@A@@@A@E@A@AA@@A@A@@AA@AEI@AACAA@A@A@A@A@ABE@A@CAA@CAAAAAAAACAAAGAOCICGCGCEAAAAAAAAAAA@AEIACACAA@A@AAG@ACSAG@CAA@AA@@AA@A@E@GAK@E@AAA@@@AA@@@A@@@@@@@A@@BA@@@A@A@CBA@A@A@@BABEBC@A@A@A@@AA@AAAACCCCAECCAECCAC@CAWCGAGAEAGACAICA@AAAACAA@@AAA@CACAI@AAA@AA@A@AAC@IC@@IAEAC@GCCAEA@@CAEAEACACA@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@A@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@A@A@A@AA@@AAA@AAAAAKGKGEACAECEAGAG@GAA@@@@@E@C@EBGBKBA@A@A@C@AAA@AAAAEEACAACEACAA@A@A@A@@@ABA@ADABAFCDAFAFAHABAB@@A@@BA@CAA@AACKK@AAG@ACAKEC@CAABE@EBA@CAA@ACCAAAAA@AAC@CBA@CBC@CNSBCBA@C@A@A@AA@AAC@EAC@ABC@ODCBC@A@C@CAEAGEA@CACAACAAAA@C@A@A@CBABAZUBABA@@@AAAECEEEAA@CAI@GAC@EAAACAAA@C@A@C@CBE@@@CBAAA@A@AAACAMEEAAAAA@A@A@A@CBABGBA@AA@@AA@AAC@C@C@ABCBCDGDCDCBA@EBC@G@C@C@A@CACA"],encodeOffsets:[[129026,41917]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
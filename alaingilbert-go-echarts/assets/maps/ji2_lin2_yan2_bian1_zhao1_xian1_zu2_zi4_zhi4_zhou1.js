@@ -0,0 +1,5 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("延边朝鲜族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"222401",properties:{name:"延吉市",cp:[129.508804,42.89125],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@A@@@@AAA@@AA@@@A@@@@@A@@@A@A@@BA@@AA@@@A@@@@@A@@A@@A@@@A@@@@AA@@@A@@@AA@BA@A@@@A@A@A@AB@@C@@@A@@@@@A@A@AAA@A@AAA@A@@@A@@@@BAAA@@@@@AA@@A@C@@@A@AB@@A@@@A@A@@@AAAAA@A@A@@AABA@A@@@A@@@AAC@AAAAAAA@CA@@A@ADA@ELBDEHC@GAALE@@NC\\_BCEAAIEAGEMMI@KFMFAGCCGBEHA@GEEaFCAGQG@G@KCAEBEBCCAEEGCI@EBGDEB@CK@QEC@C@KJKF@DC@BPKBEFA@@DFN@LGDIEGCGHGNINABEJ@FBD@H@H@JCNCHCHGPEHGRMHMDCH@HAJCLGJKPS\\YRKPO\\CPITELIJIDMBKDGFITITIVBA@@B@BB@@B@@B@@BB@@@@B@B@B@@@D@B@B@B@B@BB@@B@@@BB@@BB@@@@BBB@@BBBBB@@@BB@BB@@D@DBB@B@BB@@@B@@ABC@A@A@C@AB@@AB@@AD@@@B@B@BBBBB@@BD@@@B@B@@BBAB@B@BAB@@AB@@A@A@A@A@A@@@A@@B@BA@@B@@AB@B@@BB@@@B@B@B@@@B@@A@ABA@A@@@ABAB@@@@@BA@@B@@@B@@AB@@@B@@A@@@A@@BA@@BA@A@AB@@A@A@A@A@ABA@A@A@@@A@AB@AA@@A@@@@A@@B@@AB@@A@@@@B@@@BA@@@A@C@@@A@A@A@@@AB@@A@ABA@@@A@@@A@A@A@@@A@@@ABABA@A@A@@@A@A@AB@@AAA@A@@@A@@@A@@@@@A@@@A@@BA@@AA@A@@@A@A@A@ABA@ABA@@@A@A@A@A@A@A@A@@@A@@@A@A@@@A@@@A@AB@@@@AAA@@@A@@A@@A@A@@@A@AAC@A@AA@@@@A@@@@BA@@@A@@@A@AB@@A@A@@@@@@BA@@B@@ABA@@B@@@B@@A@@@A@A@@@A@A@A@@@ABA@@@ABA@@BA@A@@BA@A@@B@@@@@BB@@B@@@@@@@BA@@@@@@B@@@B@@@@@@@@BB@@BB@@@@@@@B@@A@@B@@@B@@@@AB@@@@A@@@A@A@@@A@A@@@A@A@@@AB@@A@@@ABA@A@@@@@@@AB@@@B@@@@@@@B@@@B@B@@@B@B@B@@@BA@@@ABA@@@@@@B@@AB@@@@AB@@@B@@@BA@@B@BA@@BA@@B@@AB@@@@AB@@A@@@AB@B@@A@ABA@BB@@B@B@D@B@@@B@B@@BB@@@@@A@@@ABAB@@@@@B@B@@@BBB@@@@BB@@BB@@B@@B@@@BBB@BAB@BA@@@@@BB@@@B@@@@BBB@B@@@B@@@B@@@A@@@@@@B@@@D@B@@@DA@@B@@A@AB@BAB@B@@@BBB@BBB@B@@@@BB@@@B@@BB@@B@@@B@B@D@B@@AB@@BB@BBB@@BB@@B@@B@B@DB@@B@@B@@B@B@B@B@B@B@B@B@@@@@@@@BB@@@@BB@@@B@BB@@B@@B@@@B@@@B@@@B@@@B@@@B@@@@BB@BB@BBB@DB@@@@@@@BB@@@B@BBB@BBB@@@B@@@B@B@BBB@B@B@BD@@BBBB@@@@ABAB@BABABABAB@@CFAFAD@B@@@D@D@BABCDABAB@DAD@B@B@BA@AB@@@B@@B@B@BBBBDDB@@@B@B@B@@@B@D@BB@@@B@@@D@B@@@B@B@@@BABAB@@@B@B@@@B@@@BBBBB@@DD@@BB@@B@@@BB@@BB@@@@@BB@@@B@@@@@@B@@BBB@@B@@AB@B@@@B@@A@@AA@C@C@ABA@@@A@@@@@A@@BA@A@@BA@A@@@@@@@ABA@C@@@AB@@@@@BA@@@@BA@@@A@BB@B@@@@@B@@@B@@@B@@B@A@@@@@@@A@@B@@@@@@AB@@@B@@BB@@@@A@@@@@A@@BA@@@A@@@A@@@A@@@@B@B@@AB@BA@@@@BA@A@@@A@ABA@@AA@AB@@ABABA@@@@@A@A@A@@@A@@@CB@@AB@BABA@@@@@A@@AAB@@AB@@@@A@@BA@@B@@A@@@A@AB@D@@AD@@@BA@@@AB@@@@A@ABA@@B@@@B@B@@@@AB@@@B@BA@ABA@@@@@ABAA@@A@@@AB@@A@@@@B@@@@BB@B@B@@@BB@@@DB@@@B@BCD@DABA@@B@@@B@B@B@B@B@B@B@@@B@@@@@@ADABB@@@B@B@BBDBB@@B@@BB@BBBB@BBF@BBB@F@B@@@@AB@B@@@@BBBBDBHADD@@@B@B@@@BAB@B@@@D@@@BB@@@BB@@@BBF@F@D@D@D@F@BBD@BBJBFBFBBBB@BADA@B@DB@BBBBF@B@@@FAB@DABABAFCDADC@@BABABA@AB@@AB@B@@@@A@@BAA@BA@@@A@@@@BAB@B@B@@AB@BADCBABCB@@ABA@@B@B@B@B@BAB@B@@AB@B@@AB@@@D@D@B@@@B@@@FADAD@D@DAB@BABBB@@@B@DDDB@@BBB@@BB@@B@BBBBB@@BB@@D@B@B@D@D@@@BB@@DB@@@@@B@@A@@BCBAB@@CBA@AB@@@@A@@B@@@BDF@B@@@@@@@B@BAB@@@@ADCB@B@@BBFBBB@BBD@@@@BB@@BA@@DAB@FBB@B@D@@@B@BBB@BBB@B@FBD@B@D@D@@@@B@@@@@BAD@@@DB@@BBB@@FBDB@B@@B@AB@BAD@@@@BAB@B@B@@@B@@@B@BA@@B@B@DBB@BA@@D@BAB@B@B@B@BBB@@@B@B@B@BAB@B@D@F@@@B@@@BAB@B@DAB@BBB@B@B@D@B@D@HBD@D@DBB@@@@@BA@@@C@@AC@A@A@@@A@ABA@@@A@AB@@@@AA@@@@C@@@A@@@A@@AA@@@A@A@A@AB@@A@A@@CAAC@@AA@@@AB@@A@@DABABA@@BA@A@A@@@@BA@@BAD@@@D@DAB@DAB@B@B@B@B@B@@@B@B@@@B@D@B@B@@@@@ACA@@A@@A@CA@@A@@CAAAAA@ACAA@@@ABA@@A@@AAA@@@A@@BAB@@AB@B@B@B@B@@A@@@A@@@A@@@@DA@@@@@@AA@A@AAAB@@@BAB@BA@ABA@@BCBA@AB@@@@A@@B@@@BA@@@@@@@A@@@@BA@ABA@AD@@ABA@AA@CAAA@@AC@@@AAA@ACCAA@A@A@A@@BC@A@A@@@A@@@A@AAA@AECAAAAA@@A@A@@CA@A@AB@DAFCDADC@@@AA@@A@@B@@A@@@ABAAA@@@AA@@AA@@@@AA@@AA@@@CAA@@@@@@AB@BA@@B@DAB@@@@@@A@@@A@AB@@A@@B@B@@@@ABA@@@AB@@A@@@AB@BA@@B@B@B@B@B@@@@@B@@ADAD@@@BA@@@AB@@A@@BA@A@A@@BA@@@@B@D@B@B@DAD@BAB@B@@AB@BA@@@A@@@C@A@@@AD@BA@@B@@A@@@A@@@AAA@@A@@A@A@@BA@@BA@@B@@@@ABAB@@@B@B@B@@@B@DAD@@@B@BA@@BA@@BAB@@C@A@@@AB@@@AA@A@@@A@@@@DAB@@@BA@@BA@@BA@A@@@@@A@AB@@@@AB@@@BAB@@@@@@@@@@AB@@A@@@@BA@@@@@ALCD@B@B@AAB@@A@@BABABA@@@@@AB@@AB@B@@AB@@AB@@ABA@@@@@A@@BABABA@A@@B@@AB@@ABA@@@@B@@@B@@@B@B@@@B@@@@@@ABAB@@@@@B@@@@@@BB@D@@@@@B@@@@@B@@AB@B@@@@@@B@@BB@@B@@BB@@@B@DA@@B@BABA@@B@B@BA@@B@@BB@B@B@BBB@B@BBBBD@BB@@B@B@B@B@@@@@B@B@@@@@BBBB@@B@@@B@BAB@B@@@@A@@@@BA@@BAB@@A@@BA@@B@@A@@BA@@B@BA@@B@@@BA@BB@@@B@@@@@B@@@B@@@@@@@@@B@@@B@D@B@B@@@B@BBB@B@B@@@BAF@B@@@B@@AB@@A@AB@@@FA@@BAB@B@B@B@B@@A@@B@@@B@@AB@@A@AB@@@@@B@@@@@BA@@B@@@@@BAD@B@B@@BB@BAD@D@B@B@B@B@B@BAB@B@@B@@B@@BBB@@@@BBB@BBB@BB@@BBB@DB@@@D@@B@@@B@BBB@B@@@B@D@@@B@B@DB@@@@BA@@B@D@@@B@@B@@@@B@@@@@BAB@@@B@B@D@@@@@B@B@@AB@@@B@@@B@@@B@@@B@@A@A@@B@@@BAB@@@@@@A@@BAB@@ABB@@@@B@@@@@B@@AB@BA@@@@BA@A@@@@B@@AB@B@B@@@@@B@@@BA@@@A@A@@@@@A@@@@@A@A@AB@@A@@@A@@@A@@@@@A@@B@@A@@B@@A@@BA@@@@@A@@@A@@A@@@BA@@@A@@@@B@@@@AB@@@BA@@B@@@@@@@@@B@@BB@B@@@B@@@B@B@@@@@B@@@@B@AB@@@@AB@@AB@@A@@@A@@@AB@@@@@@A@A@@@@@A@@A@@A@@@@@@@@@@@@@A@@@@B@@@@@BB@A@@B@@A@@BA@@BA@@B@@@BA@@@@@@@A@@@A@@@@@A@@@@@AB@@A@@@@@A@@@@@@@A@@B@@@@@BA@@@@@@@@BA@@@@BAB@@@@@B@B@@@B@@A@A@@@@@A@@@@@A@@BA@@@@@@BA@@BA@@@@@@@A@@@@@AB@@@@@@@@A@@@@@@@@@@@A@A@A@@@A@@@A@@A@@@@@A@@@A@@@@@@@@AB@@AB@@@@ABA@@@AB@@@BA@@B@@A@@@@@A@@A@@@@A@@@@@AB@B@BB@@B@@@@@@@BA@@@@@@BA@@B@@AA@@@@@@AA@@@@@@A@@@@@@@A@@@@A@@@@@AA@@@@@@AA@@@AA@@@@@@A@@@@@A@@@@@A@@B@@A@@A@@@@A@@@@@@@A@@@@@@A@@@A@@@@@A@@@@A@@A@@A@@@@A@A@A@A@@@A@A@@B@@A@@@A@AAA@@@@@A@@@@@@@A@@@@AA@@@@@@@@AA@@@@@AA@@@@@AB@@@@AB@@AB@@A@@BA@@@A@@A@@AA@@@A@@@AA@@@@A@A@@@@A@@@@@A@@@@@A@@@@@A@@AA@@A@@@@@AA@@@A@@@A@@A@@@@@@@@A@@@A@@@A@@BA@@@A@@BA@@@A@@@@@A@@B@@@@@B@B@@@BA@@@A@@@A@@@AA@@@@A@@@@@@@A@@D@@A@@@@@@@A@@@@@@@AB@@@@@@A@@B@@@@@AA@@AA@@@A@@@@@A@AB@@@@A@@BA@A@@@A@@@AB@@@@A@@@@B@B@@@B@B@BA@@B@@@@A@@@@@A@@@@@AA@B@@A@@@AB@@@@A@@@@BA@@@A@AA@@@@A@@@@@A@@@A@@@@@A@@@@B@@@@A@@@A@@@@@A@@@@@A@@@AB@@@@A@@@@@A@A@AA@@AB@@@@@A@@@A@A@A@A@A@A@A@@@A@A@@@A@@@A@@@A@@@AAA@A@@@@@@AA@@@A@@@A@AA@@@@AA@CAAC@AAAAC@C@CBC@A@A@@@@@AA@@@@A@@A@@@AA@@@A@AA@A@@AA@@A@@@AA@@A@@A@@@A@@A@@@@AA@@@A@@@A@@A@@@A@AB@@A@@AAEE@@A@A@A@@@@@@@@@@AAC@A@C@C@AAA@C@CAGAC@CAA@A@@@@@AB@@@@@@@B@@ADA@ADA@@B@@A@@@@AA@@@A@@@A@A@A@A@@B@@A@@@@@@@AB@A@@A@@@@@A@@A@@A@@@A@A@AA@@A@@@A@@A@@A@@@@AAACACAA@C@ABABA@AAAACACAAAA@@@@@A@AB@B@@A@@F@DADA@ABA@CF@FABADAD@B@@@BBB@BAD@BABADAB@D@B@B@BAB@B@B@DAD@B@B@B@B@DADAD@B@@@DABBB@@@B@B@DBB@BB@@B@BAB@BAB@@A@@@A@ABCBAB@B@BADA@@BAB@@@B@B@@@DBDDDDBDBDBBBBBBBBF@B@FAB@D@B@BBBBB@BBB@D@@BD@B@B@@@@@@D@B@BBABAB@B@B@DAB@B@@@@@@AB@B@B@BAB@@@@@B@BB@@B@B@@@B@@@B@B@@@B@@@B@BABBB@B@@@@@@@@A@AB@@A@A@@@ABA@@B@BA@@BA@A@@BA@A@@B@B@B@D@BBB@D@@@B@BB@@B@@A@@BA@@@AB@@A@@@@@A@@@A@@@A@@@AB@@A@@@@@ABA@@@A@A@A@@@@@A@@A@@@@@@@AAECAAC@CA@A@AAAA@CBC@C@A@C@@@A@AAC@AAA@A@@@A@AB@@A@@@CBA@@@A@@@@@A@AAA@AA@@A@@@A@ABA@A@@BA@@@AA@@@AAA@@@A@@A@@A@A@AA@CAA@A@@@A@@@@@A@@BA@@@@@A@@AA@@A@@A@@@AA@@@@@@@@AA@@A@@@A@@@AA@@A@ABA@A@A@@@A@A@A@@@@@A@@@@BAB@BAD@@A@@@@@AA@AA@@@@@A@@@@@A@@@A@A@A@ABAAA@A@@@AB@@@BA@@@@BA@@A@@AA@@A@AAA@@@A@@@A@@A@@@@AAA@@@@A@@@A@@@@@@@A@@@@AA@@A@@A@@AA@@@@@AAA@@@A@@AA@@@@@CAACAAACAAAA@BC@AAAAAAAAA@A@A@@AACAAAA@AA@@@@@A@@@A@@@@@@@AA@A@A@A@@@A@@@A@@@A@@@A@@@AB@@A@@BA@@@A@@@A@A@A@A@AA@@AA@@@@@AAA@AA@A@@@A@A@A@A@A@A@A@@@A@@AA@@@A@@BCA@@A@C@A@@@A@@@A@A@@BC@@AA@@@A@A@A@A@A@AAA@A@A@@BA@@@@@A@AB"],encodeOffsets:[[132640,43880]]}},{type:"Feature",id:"222402",properties:{name:"图们市",cp:[129.84371,42.968044],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBA@CBAAC@@@AACAACCaCQESAGCICO@GCIKGM@ECCAEQBI@IAKCICGCG@IFMBK@AISMKAF@BA@ABA@A@A@C@A@@@ABA@CBC@A@ABC@ABC@A@ABA@C@@BAAA@@@A@A@ABC@CBA@@B@@@@@BA@@BABCBA@@@@B@@A@@@A@C@A@A@@@A@A@EBA@A@CBAAA@C@@AAACAAAAAAA@@AAA@A@A@AB@@A@@@A@@@ADA@@@A@AAABC@AB@@A@A@@@A@@@@@A@A@A@A@@@@BA@@BC@A@A@A@A@@@A@@A@@A@@@A@@@@@A@A@A@@@@AA@@@A@@BA@@@A@@@A@@@@AA@A@@@A@A@C@A@EAA@@@A@A@AB@@ABA@@BBBB@@@B@@B@BBB@B@@@@ABA@@B@@@@@@@BB@B@@B@@@B@B@B@@BB@@@B@BA@@B@@@BB@B@B@@@B@B@@@BB@@D@BB@@BB@@@@@B@@@@BBB@B@B@BBB@B@@@@@B@BBB@BB@B@@@BBBBB@BB@@B@BBB@BBB@@@@@B@@@@@BB@@@@BBBB@BBB@@@BBB@BBDBD@BB@@@@@@ABA@@BA@AB@B@@@@@@@B@B@@@BB@@B@B@@@B@@A@@@C@C@EAA@C@@@AB@B@@@B@@@DBB@BBD@@DBBB@B@@BB@BBB@B@@B@@@@@BAB@B@B@@@@@@BB@@B@@BBB@@@@B@@ADAD@BA@@@@B@B@@@@AB@@A@@B@@@@@B@@@@@BA@@@@B@@@B@@@@A@AB@@A@@B@@@B@@@B@@@BA@@BA@@B@@A@AB@@@@A@@B@@@BA@@@A@A@@BA@@@@@@B@@AB@@@@@B@@@B@@@@@BBB@@@BB@@B@@A@@BB@@@AB@@@BA@@@@@@@@@A@AB@@@@@@B@@@@B@@@@@B@B@B@@AB@B@@@@@BA@@B@@@B@@@B@@@B@@@@@BA@@B@@AB@@@BA@@@AAA@@@C@A@AAC@A@A@A@@@@BAB@@@BAB@@ABA@@@AB@B@@@B@BA@@B@B@@@@@@A@A@AAABA@@@A@@@A@A@@@A@@@A@A@@@AAA@@@@@A@ABA@A@A@@B@@@@A@A@CBA@A@A@ABAB@A@A@C@@@@A@A@C@@AC@A@AAA@AAAAA@C@A@EBA@E@AAAAAAAAACACCCCCCA@@A@A@@@A@AB@@CBABA@A@ABAD@B@B@@@BA@ABA@ABA@@@AAA@CAA@A@@@A@AACB@@A@C@CBCBA@A@A@A@C@CBA@A@A@ABA@A@C@A@CBABABC@ABA@AA@@A@C@CBABEBE@@DAB@BCBCBE@@@@BA@A@@B@B@@@@BBBBBDBDBB@BABAB@B@DBBBDBDBB@@@@@BB@@@@B@@@BB@@B@B@B@@@BB@@@@B@@@@@BB@A@@B@@@@@@@BA@@@@B@B@B@B@@@B@@BB@@@@@BA@@@CB@BCB@BA@@@@@@@A@@B@@@@@BBB@DBDBH@D@DBB@B@D@D@BBD@B@@@@@@@@B@B@B@@@FFBB@@@BA@@B@B@@@BB@@@B@@@B@@B@@B@@@@B@@@BB@@@BB@@B@@@BB@@@BBBB@@@B@@B@@@BB@@@@@BB@@@@B@B@D@DAD@D@BBBBD@BB@DBB@@@@BBB@@@B@@@B@@B@@@@B@B@BB@@B@@@B@@@B@@@B@B@@@B@B@B@B@B@B@B@@@B@@@@@A@@BB@@B@B@B@@@@@B@@A@@B@@@B@@@@@B@@@@@B@@@B@@A@@@@@@B@@@@@B@@@B@@@@@B@@B@@B@B@@AB@@@@@B@@A@@B@@@BA@B@@B@@@@@B@@@@@B@@A@@@ABA@A@@@A@A@@@@@@B@@A@@B@@@B@@@BAB@@@B@@A@@B@B@@@@@B@@BB@@BB@@@@A@@@@B@@@@A@@B@@@@@@@B@@@@@@@BC@@@@B@@@@@@@B@@B@@B@@@B@@@B@@AB@@A@A@@@@@A@@@@B@@@@@B@@AB@@@B@@AB@@@B@@@B@@@B@@@@@@B@@@@B@@@B@@BB@@@@B@@@BB@@@B@@@@@B@@@@@B@@@@@B@@B@B@@@@@BB@@B@@@B@@BB@@@@B@@AB@@@BA@@BA@@B@@A@@B@@@@BB@@@@B@@B@@@@@@B@@B@@B@@@@@@@B@@@@@B@BBB@@@B@@@@AB@B@@@B@B@B@B@@@@@@BB@@@@B@@B@@@@@B@@@B@@@@@@@@B@@@@@@@B@@B@@@@BA@@@@B@@@@@B@@@@@B@@@@B@@B@@BB@@@@@@BB@@@@B@@@@@@B@@@@@@@B@@@@B@@B@@@@B@B@@BB@B@B@@@@BB@@@D@@@B@B@@@B@B@@@B@@@B@BA@@@@D@@@B@B@@@B@@AD@@@B@@BB@@@B@@AB@B@@@B@BA@@B@@BB@B@BB@@BB@@B@@BB@@@@@@B@@@@@BBB@@B@B@@@B@B@@AB@BBB@@@@B@@@@BB@@B@BB@@@@@BA@@BA@@@@BB@@BAB@@@@@@B@B@@@BB@@B@@B@B@@B@BB@@@@@B@B@@@BBB@@@BAB@@@@B@@BB@@@B@@@BB@@@B@B@@B@@@BB@@@B@@B@@@AB@@@@@B@@@B@@A@@BA@@@A@@@@@A@@@@BA@@@@B@@AB@@@B@@@B@@@@BB@@B@B@@@@@@B@B@@@BA@@B@@@B@@AB@@AB@@@@AB@@@@@B@@@BBBA@@B@@B@@@B@B@D@@@BA@@D@BBB@@@B@@@B@@@B@@@@@@@@BB@@@@BB@BB@@B@@@BBB@@@BB@@@@@BB@@BB@B@@@BBDBB@DBB@BBB@B@BA@AB@@@B@BAB@@@BAB@@@@AB@B@B@@@B@B@@@@BB@@@@@BBB@B@@B@@B@D@BAB@B@@@@A@@@@BA@@BA@@BABA@@BA@@BA@@@A@@BA@@@@@@@@@@@A@@@@@@@A@@@A@@@A@@BA@@@@BA@@@@@A@@@@@@B@@AB@@@@@B@@A@@B@BAB@B@BAB@@@B@B@B@@AA@@A@@@@@@@A@@@@AAA@@AA@@@@AB@@@@A@@@AA@@@@@@A@@BA@@A@@A@@@@@A@@@@@@@A@@B@@A@@@A@@@A@@@A@@@@@@B@B@@@@@B@@@@AB@@@B@@A@@B@@@@@@@B@@@@@BAB@B@@@B@B@@ABA@@BA@@BA@@@ABA@@BA@@B@@@B@B@@AB@@@B@@@D@B@D@@@B@BBB@BAB@B@B@D@B@B@B@@@B@@@@@B@BA@@BA@@@ABB@@B@@BBB@@@BB@@BB@@BB@BBB@@@B@@B@@@B@@B@@@BB@@@@@B@@@@@B@@@@@@@@BB@@B@@@@@@@BB@@@B@@@@@@BB@@@@@@@@@BB@@@@@BB@@@@B@@@@@@@@@@B@@B@@@@B@@@@@B@@@B@@@B@@B@@@BB@BB@@@B@@@D@BA@@BBB@BB@@B@@BB@@@@@@BB@@@B@B@B@@@B@B@@@B@B@B@@@B@B@@@BAB@@@B@@@B@B@B@@@BA@@B@@@@@B@@@@A@@B@@@@@B@@@B@BB@@@@@BB@@@B@@@@B@@@@@B@@@@BB@@@B@@@@B@@@B@@BB@@@BB@@@@B@@B@@@B@@@@@@BBB@B@@@B@@@B@@@@@@B@@@@@@BB@@@@@@@B@@B@@B@@@@@B@@@@@@@@@@B@B@B@@@BA@@@@BB@@B@@@B@@@B@@@@A@@B@@@B@@A@@B@A@@@@A@@B@@@@@@@B@@AB@@@BA@@B@@A@@@@@A@@@@@A@@@@@@@@B@@A@@BB@@@@B@@A@BB@B@@@@@@@B@@@@@B@@AB@@B@@B@@A@@B@@@B@@A@@@@B@@@B@@@@@@@BA@@@@B@@AB@@@@@@@@@AA@@B@@@@A@@B@@@@A@@@@@@@A@@B@@@@@@@@@@@B@@@@@@AA@@A@@B@B@@@B@@AB@@@@BB@@A@@@@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@BA@@B@@@@A@@@@B@@@B@B@@@B@@@@@B@@B@@BA@@@@@@B@@AB@@@@@B@@@@@@@@@@AB@@@@@@@B@@AB@@@B@@@@@B@BB@@@@B@@@B@@@B@B@@@B@@@B@BB@@B@@B@@B@@@@@B@@BB@@@B@@@B@@@@@B@@@@@@B@@@@@B@@B@BBBBBB@BB@D@BBB@BBB@@@B@BB@@@BB@@@BB@B@@B@@B@@@@BB@@@@B@@@B@@BB@@@B@B@B@@@B@B@@@B@@@B@@@@@B@@@@@BAB@B@B@@@B@B@@@@@@A@@B@@A@@@@B@@@@AB@B@@@@@@@B@@@B@@@B@B@@@@@@@B@@B@@BB@@@@B@@B@B@@@@@B@@@@@@BB@@@@B@BA@A@C@ABADBD@HC@C@GBKXAbFxMAlHzLTHRCBIAIGKCGBI@IAOIKKMIC[MUIOQIGGO@I@IDICE@C@GFGHCHCFCFC@C@G@EEGACEGCE@ECEEE@G@CEC@CBCDEBCDC@AACCCB@KCMASA_AO@IBKBEH@BIJA@EDC@G@GAEAIACAE@GAUDEBEBADCHAHCRAH@L@FCBEBE@E@GAQCAEAECECEEACAE@C@C@ADCDAF@NATCF@DABABA@CAACAECCACAE@EBG@QFGBGBG@E@E@@@A@AAA@A@AACCECAAAA@AAAAG@C@A@@A@@A@@@EBC@A@ABABGLQNQDCBA@C@ACCC@@@M@A@ABA@CB"],encodeOffsets:[[132976,43952]]}},{type:"Feature",id:"222405",properties:{name:"龙井市",cp:[129.427066,42.76631],childNum:1},geometry:{type:"Polygon",coordinates:["@@QSEIAEAE@EBC@ABGBCDCLIDCFE^WDCBA@CEAQCGCEECCDGBE@IBA@CDABAFAD@DADA@A@A@A@AAAAAC@A@CBE@EBABADADAFCDADE@A@GAICEA@ACAAC@C@CBCDCDAB@BADBD@B@B@BAB@DEDC@@@AA@AAGAECGGAA@C@ABCBA@A@ABAD@B@D@FBB@J@D@B@D@BAB@DAB@B@@@BAAA@A@AAE@A@@BAA@CAE@G@C@E@CAG@CAACCEA@@A@ABABA@AAA@AAA@CACA@C@AAGAIAECCCCMBUHGJY@IKMAG@ED[AECECEAC@A@A@ABABCFGBIJQAK@C@AAAKK@@IQACGGUYUOKCWDgDUAKCICOJYjMPK@EAOI[EMCBGJAdEDGOQGKBMCGECY@KGSMIOBEZI@EUEWCAEHSGEODONO\\CFMNMFKDKRILMHYDSJILMNILKBMEMGMBQJQLODA@IACGDIBEAICEEAEAICO@KBCH@HPRBLGJOFM@G@QGGMFIZYFOCGMBACLICAIAMDILCVEFCBA@@BABAB@B@@DD@B@D@BAB@B@@ADAFA@BBAB@@AB@BA@@BABA@ABA@@B@B@@A@BB@@A@@@@BA@@B@@@BA@ABAB@B@@@B@@@@@@A@A@C@A@@@@@A@@@@@@DBB@DBBBD@B@BA@AB@@@BBB@DBB@@BBBB@@AB@@@BA@@@@B@B@@@B@B@@@B@@ABA@@DA@@@@BBBA@@B@BB@@B@@BBBB@B@@@@@@@B@@@BA@@@@B@@@B@B@@@B@B@B@@AB@@AB@@@B@@B@@BA@@@@B@@BB@@@B@@@B@@@@@BA@@B@@@@@B@@@@@@A@@B@@@@A@@@@BA@@@A@A@A@A@AB@@A@@B@@@@A@@@A@@@@@@B@@AB@B@@AB@@A@@BA@@@A@@@@@A@@B@@@@@BA@@@@@@@A@@A@@A@@@@@ABA@@@A@@@A@A@@@@@A@@B@@A@@@ABA@@@AB@B@BAB@BCD@BAB@@@@AB@@A@A@@@A@@@A@A@@@A@@@@@A@AB@@A@A@A@@@@@@@@@@B@@BBBBB@B@BB@@BBB@@BB@@@B@@@B@@@B@@B@@A@@@@@@B@@B@@@B@@@BB@@B@BB@BB@B@BBB@B@BB@@B@@@B@@@@@B@@B@@@@BDBBDBDDB@@BB@DBD@@@BB@@@B@@AB@@AB@@@@BB@@@B@@@BA@A@@B@@@BAB@B@B@B@@BD@BBB@B@@@@A@EBE@A@A@AB@@@B@@@BB@@@BBD@@BB@BBA@@@@B@@B@@@B@D@@BB@@@BB@@BBBBB@@@@BBB@B@@B@B@@@@BB@DB@@AB@@@B@@@B@DA@@BAB@@AB@DA@@BA@ABA@C@C@@@A@@@@BAB@B@@CBABC@ABA@A@A@@@A@A@@@@B@@ABABAB@@AB@@@B@@@BA@AB@@@@BB@@@@BBBB@@B@@@B@B@@@BBB@B@B@@@@@B@@AB@@@BAD@B@@@B@BBB@DB@@@@B@B@B@B@B@B@BBB@@@@@@B@@@B@@B@@BB@B@B@@@BBB@B@@@BB@@B@@BB@@BB@@@B@B@@@B@@@BBB@B@B@@@B@B@BAB@B@D@B@B@B@@@@BB@@@@B@@@B@@B@@BB@@BB@@@B@B@@@B@B@B@@AB@B@B@B@@@B@@@B@BB@@@@BA@@@@BAB@@AB@B@@@B@@@B@@@@@B@@B@@B@@BB@@AB@@@B@B@@@BBB@B@B@@@BB@@B@B@B@@@D@B@B@B@@@B@@@B@@@B@B@@@BBB@@@B@B@BB@@BB@@B@B@@BB@B@B@B@@@B@B@DB@@B@@BBB@@BBB@BB@BBB@@@B@@@B@B@@A@A@A@@@CB@@@BA@A@@B@BBB@@D@BBBBB@@@@@@BA@@B@B@@B@@BBB@@@B@@@@@B@@@@@B@@A@@@A@@BA@@@@@ABA@@@@B@@@DBB@B@@B@@BB@D@BBBB@@@D@@@BDBDBBBB@@@@B@@AB@@@B@@@@@B@B@@@@DBB@B@B@B@@B@@BBA@@B@@@B@@BB@@@@@B@B@@ABAB@B@@@BB@@@BB@@@B@@AB@@@@ABBB@@@@@B@B@BABB@@B@@AB@BA@@BA@@B@@@@BB@B@B@@@BCB@@AB@@ABC@@@@BA@@B@B@@@B@B@BAD@@AD@B@DABCD@B@@AB@@A@@B@BABABA@ABCBAB@BA@ABA@@B@B@D@BBB@@A@@B@BCD@BAB@@@B@@ABAB@@ABAB@D@@@BABADA@ABG@AB@@@@CD@BA@EB@B@@AF@DAB@B@B@@@D@B@B@@@BADAD@B@@@BAB@BAB@B@B@@@@CBC@G@@@CAC@@AA@@AAA@@A@@@G@EBA@C@@@AAA@A@C@E@A@A@C@A@AAC@CAC@A@@BC@@BAB@BA@CBCA@@CDC@ABA@AACAA@A@@@C@A@@@C@CBABABABC@@@A@@@A@CAE@E@@@CDA@GAA@CAABC@CBA@A@A@A@AA@@@@AAA@ABA@@@@AA@ECAAA@AA@@AA@@CAAA@@A@@@AAAA@@@A@@AA@AAAC@ECAAA@CAA@A@A@A@@@C@AA@@@@A@A@CBABEBA@E@A@@AA@A@A@@AAAC@CA@AA@A@@@AAA@AAAAA@A@CAC@C@@@CACAAACAGAA@@@CA@AE@CA@@@@@@E@AAC@KCCAA@@@ABCBCBA@ABA@C@ABE@C@@@E@CA@@CACAA@AA@AA@@@AAAAA@@@A@EBC@A@A@AB@@ADAB@@A@ABA@@@ABAB@@A@A@A@IBC@C@A@AAA@A@AAA@C@@@CCCAEBA@@@A@CBCD@@A@@@A@@@@BA@@@@@A@@@@@@@@BAB@@@@BBBD@@@BA@@@@@@@@@ABA@A@@@A@A@A@A@@@@@A@@B@@@@@@@@@@@@@@@B@@@@@B@B@@A@@B@@A@@@A@@@A@@@@@@@A@AB@@A@@@@@A@@@C@C@C@A@ABA@@@@B@@@@@B@@@B@@@@AB@@A@ABA@@@ABA@A@@@@B@@@B@@@B@@@B@@@@A@@@@@A@@@A@@@A@@B@@@B@B@B@BA@@BA@@@A@@BA@@@A@@@@B@@@B@@@@@B@@@@AB@@A@@B@B@@@@@BA@@B@@A@ABA@A@@@@B@@@@@B@@@B@@ABA@A@A@@BA@@@@@AB@@@BA@@@ABAB@@@@@B@@A@@B@@@@@B@@@@@@AB@@A@@@A@ABA@@@A@@@@@@@AB@@@B@BA@@B@@@B@@@@@@@@A@ABA@@@@@@@A@@BA@@@@B@@@@@@@@BBB@@B@@@B@@@@AB@BA@@B@@@BBBDB@BB@BB@@@B@@BB@BBB@@@B@@@@@@@B@@A@AB@BA@@BA@@B@B@BAB@@@B@B@BA@@B@@@B@@ABA@@BA@AB@@@B@BB@@B@@@B@@A@@BA@@@@B@@@BA@A@@@A@@BA@@B@@AB@B@@AB@B@B@B@B@B@@B@B@@BB@BBB@BB@@@@ABA@@BA@@BBB@@@B@B@@@B@@A@A@@@A@@@@BA@@@@@A@@AA@AAA@ABA@C@A@@@ABA@@B@B@@AB@B@BA@ABA@AB@BA@AB@@@@AB@B@@@BA@@BA@@BABA@@BA@@BA@@@C@ABA@@@A@AB@@@BAB@BA@ADA@@B@@@B@@BB@@@@@B@@AB@@AB@@AB@@BB@B@@BB@BB@B@AB@@AB@@A@A@AB@@A@ABAB@@@B@@@BA@@BA@AB@BA@@@ABA@@@AB@@@@@B@@@BBB@@@@A@@BA@@@@B@B@B@@@BAB@B@@@BB@@BB@BB@B@@@B@@A@@B@@@@@@@B@@@B@@@B@@@B@@@B@@A@@@A@@BA@@@AB@@AB@@@B@@@B@@@BA@@B@B@@@B@@B@BBB@@BB@@BB@BBB@BB@BBBB@B@@AB@B@@@F@BBBA@BB@@@BBB@BB@@D@B@B@B@@@B@@AB@B@@@B@@B@@BBB@@@DBB@B@D@@@B@@B@@BB@@B@@B@@@B@@@@@B@@@BBB@@AB@@AB@@AB@@@@B@@@BB@@B@@@B@@@BAB@BB@@B@@BB@@B@@BB@@@BB@@B@@B@@BB@B@B@BBD@DBB@@@B@@@@A@@@ABA@AB@@AB@BA@@@@@AA@AAAAAAAAAA@@@A@@@A@@@A@@BABAB@@ABA@@@AB@@A@@@AB@B@D@B@B@@@B@@@B@@@D@@@B@@@@@B@@@@@B@@AB@@@B@@@B@@@B@B@@@@@BB@@B@@@@@B@@@B@@@B@@BB@B@@BB@@@BB@@B@B@@@@@B@@B@@B@@A@@B@B@@@B@@@@@B@@@@@B@@@@@B@@@@@@@@@@@B@@@B@@@@@B@@@B@@B@@B@@@B@@@B@B@@@B@@@B@@@@AB@@@@BB@@@BB@@BBB@@@B@@@B@@@@@B@@@BA@@B@@@B@B@@@@@@@BAB@@@@A@@B@@@B@@@BBB@@@B@@BB@@@@@BAB@BAD@@@BAB@BAB@B@@@B@@@BB@@@@B@@@@@@@B@@@DA@@BB@@@@B@B@B@B@B@@@BBB@B@@@B@BAFAD@F@F@D@BBDBBBBB@B@BDBB@BBD@BBB@B@@@BB@@B@@@B@@@B@@@B@B@@@B@@@B@B@@@B@@@@@B@@A@@@ABBD@B@BB@@B@B@B@@@@@B@@@BA@@B@DA@@B@B@@BBBB@@@BB@@@B@@@B@@@BA@A@@BA@@BAB@B@@@@@@A@@B@@@@@B@@@BA@A@@@@B@@BB@@@@@@@B@B@@@BA@@@@BA@@@@BA@@B@@@B@@@@B@B@B@@@@B@@@@@@@BA@AB@BA@@B@@@@B@@BB@@@B@B@BBB@@@@B@@AB@@@BAB@@ABA@ABC@ABGBEBA@@B@@@B@@AB@@@@AB@B@@@B@BA@@BA@ABAB@@BB@BB@@BB@@BB@@BB@@@@B@@AB@@ABA@@BA@@BB@@@B@@BB@@@B@@@BBB@@@B@@@BBB@@@B@B@@@@@BB@@@@AB@@@@@B@@@@AB@@@BB@@@@B@@@B@@@B@@@B@BBB@@@B@B@@BB@BB@@@BB@@BB@@@@B@B@BB@@B@@@BBB@@@B@@@BAB@BA@@@@B@@@B@@B@@B@@@BB@@B@@A@@@@B@@A@A@@BA@@B@B@@@B@JUJSJSHELCNAJCJIFKJSDOP[LOZQT[LOHIDKBI@GDGNCNGHQFGHODGDGDM@I@G@GAC@EFIBAJMHMHGHDJFHC@KEM@CB@FELAAOD@@CLELID@D@RFL@@DFAHCFAJ@HDFFDBADAFBFLDH@H@HRDBbEFF@HGBAFDHHDEBEN@LNJFNBHJFBBDF`AD[@MF@BKHBD@FGACFKB@BCB@@@DBB@BBBBBBD@BB@@B@@@B@B@BA@BB@B@B@BBBB@@B@B@@@B@@@BAB@@@D@B@@@BB@@@@B@BB@A@@B@@@B@B@BBB@B@BBB@B@@@@@B@@@D@@@BAB@B@B@@@B@B@@ABB@@B@@@B@@B@@B@@@B@@@@BB@@@@@B@@@B@@BB@@AB@B@@@B@@@@@B@@@B@@BB@BB@@@@@BBB@@BAB@@@@@B@@AB@B@B@BBB@B@B@B@B@@@B@@BD@@AB@B@@@B@@@B@D@B@@@DB@AB@@@B@@BB@@@B@B@B@B@B@B@B@@@B@B@@BBB@B@@@@BB@@BBB@B@B@B@@@B@@@B@@AB@@@BA@@B@@@B@@@B@@@B@@@B@B@B@B@@B@@@@@@@B@@@B@@@@BBB@BBDBBB@@@B@BBBBBBBBB@BADB@BBDBBBDBBB@D@@@@BB@@@B@@BB@B@@@@BB@@@BB@@@BB@@@@@B@@@@@@@B@@@B@@B@BB@@@@@BB@@@B@@@B@BBB@@@BB@@@BB@@A@@B@@A@@BA@@B@B@BBBAB@B@B@@@B@@@@@B@@@@@B@@BBB@@@@B@@@BC@ABA@A@@B@@@@@B@B@B@@@B@B@B@BAB@@@BB@@B@@@B@@@BB@@@@@@@@BB@@B@@@@BB@@BB@@@@@B@@AB@@@@@B@@@B@B@DBB@@B@B@BB@@@@B@@BB@B@@BB@@B@@AB@B@BAB@@@B@@@BBB@BBB@@@@@B@@@B@DA@@B@@@BAB@@@B@B@BBD@BBB@@@D@B@D@D@DAB@BB@B@BDBD@BBFDBBBAB@@@@@@@@@B@@A@@BA@@A@@AB@@@@AA@@A@@AA@A@@@@@A@@@A@@@@BA@@@A@@@@B@@AB@B@@@B@@A@@@AB@@@@@BAB@@@@AB@@AB@@A@@@A@@@A@@@AB@@@BAB@@@@@@A@@@A@@B@@A@@@@@A@@@@@AB@@@BA@@@@@A@A@@B@@ABCBC@@@A@@A@AA@@@AA@@A@@@@A@A@A@AB@@@@A@@@@AAA@AAA@@@AAACA@@AC@AAA@C@@@A@@@ABA@@D@B@FBD@D@@@B@@@@A@@@A@AA@@A@@@A@A@@@@@@@ABAB@@AB@BA@@@@@@AAC@CAAAA@AA@@A@AAA@AA@A@@A@@A@@@@@A@@@@AA@AAA@A@AA@@AAAAA@A@@@AAAA@AAA@@@@@A@A@AAA@A@A@AA@@@@@A@@@@AA@@AAC@@@AA@@A@A@@@A@A@A@@A@@@AB@@A@A@@AA@@@A@A@A@@@AA@A@@A@@@@@@@AB@BA@@@@@AAA@A@AA@@@A@AA@AB@BA@@BAB@B@@@B@FBB@D@B@B@@@B@B@@B@@B@@@B@@@B@@AB@@@B@@B@@B@B@B@@@@@B@@@B@@@@BB@@@B@B@B@B@D@@AB@@A@@B@B@B@B@@@@@B@@@B@B@@@BAD@BABBB@@@B@BC@@B@@@B@@@BAB@B@B@BB@@BBBBBBDBBB@BD@B@BBDAB@B@FAB@B@@@B@B@D@B@@@B@@@@A@@B@DABA@AB@@A@@@@@AB@DAD@BAB@B@@@B@BB@AD@B@BAB@D@BAD@BAB@D@DAB@BA@@B@D@B@B@B@BAB@@ABEMKOSAAAU@AAIGM"],encodeOffsets:[[132893,43766]]}},{type:"Feature",id:"222406",properties:{name:"和龙市",cp:[129.010106,42.546675],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@A@@BA@@@@B@@AB@B@B@BA@@@A@@@A@@@@@A@@B@B@BAB@@@@AB@@A@@@@@A@AB@@@BA@@@@@A@@@@@A@@@A@@B@@@B@@AB@@@B@@AB@@A@A@A@A@@@AB@@@B@@@B@@@@@B@@@@@@A@@@A@@@A@@@A@@B@B@BA@@B@BAB@@@BA@@@@@A@@@A@@@@@A@@B@BAB@D@D@D@@@B@@@@@B@@@BAB@@@@@@@B@@@B@@@B@@@@AB@@@@A@A@@@@@A@@@@@@@@@@@@@@@AB@@@@@B@B@B@B@@@B@B@BA@@@@@@@@B@@A@@ACAA@@@@BA@A@@@@@@B@@@@@B@@A@@B@@@B@@@DCDAB@@@B@FADBDD@@D@B@BBB@B@BBB@D@D@JAB@B@B@@@BABA@@B@BAB@@@BABC@@BAB@B@D@FAB@@@B@BBBB@@B@@BBBB@DBDB@@DBF@@@D@F@BAD@B@BAB@DADABA@@B@DBLDD@BBF@@@@@@@DBF@@BDB@@B@HBDBBBDBDB@@D@D@DBB@B@BBBBB@BB@@B@B@@BDBD@BB@BB@B@B@@BB@F@B@FABADAB@B@@@@@BBD@@@B@B@B@B@DBB@BBFDD@BB@BBB@@@B@@BBBB@@B@@@BBDB@@BB@@BBB@BBFDB@@B@@B@BAB@BB@@@@BBB@B@B@B@DAD@BADBB@HBB@DC@@F@F@DBB@@@B@@@D@BABABADAD@@@B@D@@@B@B@DBBBB@BAD@DC@@DBDAB@@ABA@AD@@AB@D@DBD@BBB@D@B@B@F@D@B@B@BB@@D@B@FAH@@@B@@@BB@BB@@BD@DB@@H@D@DA@@@@@A@ABA@ABA@A@@@ABCBC@A@@@A@A@C@@@A@ABA@CBE@@@AFAB@@ADC@@@@BAH@BAB@BCBA@A@@@CBABA@@BABA@@@A@@BA@ADC@A@AB@@@AA@A@C@A@AB@BAB@@ABADABAB@BABA@A@AB@@@BA@@@ADCBA@C@ABC@@BC@A@A@A@@@A@AB@@A@@D@BA@@BA@@DA@A@@@A@AAA@@@@@AB@@AB@@ABA@@@AA@BA@A@A@A@@@@AABA@@@@BA@@@A@@AA@@A@@A@@@ABABA@@@A@A@@@@AA@@@A@@@AB@AA@@@AA@A@A@A@CA@@@@@A@A@@@@@A@@BA@@@A@@A@AACACA@A@@@C@@AAAAC@A@@AA@@@@AAA@C@@@A@@B@BA@@@@B@@AB@@@B@@@@A@@@@@A@@@@@A@@AA@AA@@@@A@AB@@A@@@@A@AAAAC@@@AA@A@AB@B@@A@@DA@@B@B@B@@@@A@A@@@A@@AA@AAAA@AA@@AA@AA@@@CAA@A@@@A@A@A@A@@AA@A@@@AA@@AAA@A@@@A@AA@@A@A@@@A@@@A@@@A@A@A@C@@@A@A@A@@@AA@@A@A@A@AA@@A@A@@@A@@BA@@AA@@@@AA@@@@@A@@@A@@@A@A@@BA@AB@@@@AB@@@@AAA@@@A@@@A@A@A@A@@BA@A@A@@@A@A@@@A@@AA@@AA@@@@A@@@A@@A@@A@@A@A@A@C@A@A@ABA@A@@@A@A@A@AA@@A@@@A@A@@@A@@AA@@AA@@@AA@@A@A@AA@@A@A@A@@AA@@@@A@@@A@@@@A@AAA@A@A@A@A@A@@@@@CAA@AAA@@@A@C@AB@@A@@BA@@@@@A@A@A@AA@@A@A@@@A@@@AAAA@@@@AA@@@@BAB@@A@@@A@@BA@@BABABA@@@A@@B@B@@@B@B@B@BAD@BADA@@@ABA@A@@B@@@D@D@B@BAB@@AB@@CBA@@BA@AB@@C@A@@@A@@BA@@CAA@@A@@A@A@@@@AAA@A@@A@AAAA@@AA@@A@@AC@A@@@A@@@@A@@B@AAA@@AC@AA@@A@@A@@@A@@BAB@B@F@FAB@@@@@@AAA@AAC@@@A@A@ABA@A@@@AB@B@@A@@@A@@AA@@@@BA@@BA@@@A@@AA@@C@CAA@@AA@CCCAAAAC@@@@@AA@@@@@A@@@A@@@AAA@A@AAA@A@@AAAA@@@AA@@A@@@A@@@@A@@@@B@@@@AA@@@A@@@A@@@A@@AA@AA@@AAA@A@AAAA@@@A@@@@@@@@B@B@B@@@BAB@@@@@B@@@B@B@@@B@@@B@B@@@BA@@@@BA@ADC@ABA@A@ABA@@B@BA@@B@@@@AB@@@@@B@B@@@B@@@B@BA@@@@B@@@@BB@@@@@@@B@@A@@@@@AB@@@@@B@@@B@@AB@@@BA@@@ABA@@@A@@@@B@@@B@@@@@@AB@@@BAB@B@B@B@@@B@@A@@B@@@@@@AB@@@@@@@@A@@@@@AB@@A@@@@@A@@@A@@AA@@@A@@B@@AA@@@@A@@BA@@BA@@@A@A@A@@@A@A@@@A@@B@@A@@@A@@@@@@@AAAAA@@@AA@@A@AB@AA@A@@B@@CB@BA@@@A@@@A@A@@@A@A@@B@@A@@BA@@AAAA@@AA@CAA@A@@BAB@@A@AACAA@CAA@C@@@@B@@@@@B@D@B@B@@@@@@@@A@@@ABABAB@@A@@@AB@@A@@B@@@AAB@@@@A@AB@BAB@BA@AB@@ABA@@BAAAB@BEBC@@@ABA@A@C@ACC@@@ABABA@AB@DAA@AAAGACAAA@EBM@GABOLG@ACACAMBAA@ADCRMHEFCBC@CCCEAeFOOIBOHMDA@AC@EACACGEICKFMDI@GCAOKMCCAGFGLITIVMBGCGEIOCQASBYFOAIGDK\\C^DNAAKKEYMQAICAEAEDCVCH@LBDA@EGGAEAGGEKCM@aBKAEEAK@O@G@EBCFAH@LFHDJ@HCBGKKEE@EFKRCT@@KKG_CE@C@CACI@CAACCKEIEKAEAE@CCAE@EBC@CFG@A@AAAAAIAWBUBIEAG@GBCIIGECCCE@A@ACAC@E@CBCDABC@GACAGACACBCBCD@BADAHAD@BCDCDEBC@E@AAAAEC@CBCDCBCDEBC@AAAC@EBGAC@GBC@AAAAAGAAEBCBC@A@ACECCC@ABE@CAAGAGAAAAABAB@FAJBF@D@BA@@ACIAEAAA@EBABA@AGCC@AAEECAGBKBEACCCCAC@CAC@@A@A@A@ABIBC@G@GBA@@AGACAI@A@CDIFA@CAAACCECKGCG@G@KAM@APM@EAICQEWEIIAGCOIKG_CIBQDEDADEJEDQCO@QDUJ]CSFMJOFSBUDSCCKIAKBMDSVKFY@MMMOm_WGUCQBOBGFANEJSHMBOCQGOAMBECEGBOGQcOWEQDULOCECGBWDGAGAOAA@M@EBQN_NKLCA@@AB@BC@@@A@@@@@A@@A@@@@@@@A@@@@@A@@A@@@A@@@@@@B@B@B@BBBBB@BBB@@BD@BBD@B@L@H@@@BADAF@BAD@B@BAD@@ADABABABABABAB@@@@@B@BAB@B@B@DAD@@@@@B@@BBBB@@@B@@@@ABADCD@DAD@@@@@B@DBD@@@@B@D@BB@@B@@BBB@@@B@@@BAB@B@B@B@BAB@B@BAB@@@B@B@B@B@B@DBB@DBB@@@BBB@@A@@BEBC@@BA@ABABAD@BABAB@BA@AB@@ABEBCBCBA@CB@@A@A@A@AAA@A@A@A@@@@@@BAB@B@B@DBB@F@B@D@D@B@@ABABAD@@@BAD@B@@BBBD@@ABCBA@@@A@A@CBC@A@ABA@A@CBA@A@CAC@A@@BC@@BA@@BA@@BA@@@ABA@A@@@@BA@@@@B@@@@@B@@A@@FAFAD@BBDDDDDDDHBDBBDDBBB@B@@AB@@ABA@ABA@ABA@@B@@@B@B@B@B@@@B@B@B@@AD@@@D@B@@@BBBBBB@@BD@@@BAB@BAD@@@D@@@D@B@B@B@F@D@D@B@DBD@B@BBB@BBB@BBB@DBB@B@BBD@BBB@BB@@BB@B@BA@@BBB@B@B@@BB@@B@B@B@B@BB@@B@@ABABA@@BAD@B@D@B@H@D@B@B@D@BAB@B@D@B@B@DAB@B@BBB@B@B@B@@AB@B@@AB@B@B@B@B@B@BBD@D@B@B@@@B@B@B@B@DBB@B@DBB@B@@BF@F@B@B@B@@@D@DAB@B@B@D@D@D@B@B@BBB@DBB@BBBBB@@B@@@B@@@B@B@B@@BBB@BDB@DBBBDBD@BBD@@B@@@@@BBB@@@B@@BB@@BBDB@@B@BB@@B@Ĺ]fXŖEARTd^PdQNaVYN[RAX¹[ZGfdFGlENHRLJjRZFZ@FBDBDBD@DDD@B@DADAD@@@BBFBB@BAD@DBBBDDDBD@BADADCDADABCF@B@D@B@BBD@@BB@@@@BD@D@BABADADAB@B@@A@@@EBC@E@EBA@ABC@EBCBCBABABAD@DAF@BBBBBBBDBDBDDDDBB@B@DCBCBABAD@B@BDBDDBD@BABADEDEFAB@B@D@B@BA@CBCBCBCDCDCBA@@@AB@@@BBB@@@D@D@FAH@B@D@DBDBBA@@@@B@@@@ABA@CB@@AB@B@@@BA@@@A@A@C@E@C@@@@B@@@B@@BB@@@B@@@BAB@@BB@B@@AB@@@BADA@@@ABA@@@GDEBEB@@A@ABABCBABCDA@ABADAB@B@@A@@@CBA@@@@@A@@BEDC@@B@BBDDH@B@BBB@B@@BBBB@@A@ABAD@BAD@BA@ABABABCB@BA@@B@BAD@B@DAD@D@B@B@@@B@@@B@B@BAB@DB@@@@BB@BBB@@@B@@B@@@@A@@B@@@BA@ABCDEDA@@B@B@BAB@D@@BD@@@BBB@BBB@@BB@BBBBBDBDBB@@BB@BD@@BB@B@@@B@B@B@@@BBB@B@BB@BB@BB@@BF@DBB@@BB@@BB@@@B@B@D@D@B@@@B@@@BBB@BBB@@B@@@@@@@B@@@B@@AD@@BB@B@B@@B@DBBBDBBBBBB@@@@BB@@@@B@@@@@B@B@@@@@B@@BD@@BDBB@@BDB@@@@BD@@@@@@@@@B@@@@@BA@@@@B@@@DA@@B@@@BA@@@@B@@@B@@B@@@@@@@BB@@B@@@@@@@@@@B@HDBBB@B@@B@@B@@BB@@@@@D@FBDB@BB@@BBDBD@DBHBJ@B@@@B@B@@B@@@@@B@B@B@B@@@B@@BB@@@@@FC@@BC@@BA@@@@@@B@B@@@@@B@@@@AB@@@@@@A@@@@@AB@@A@C@@@A@@@@@@B@@@@@@@DAB@BAB@@@@AB@@@B@B@B@@@BAB@@@@@B@@@B@@B@@D@B@B@B@@@B@@@@@B@@AD@@AFALC@@B@DCB@@@BABA@@B@@AB@DA@@B@@@@@@@B@@@@@@@B@@@@@@@B@BBDBBB@@DBB@@@@@@BB@@@@@B@@@@AB@DABABAB@DAD@@AB@@@BADAB@@@@AB@@AB@@@@@B@@@@A@@B@@@B@D@@@@@B@@@BA@@B@@@BAB@@AB@BAB@B@@@@@B@@@B@BBB@B@@@@@@@BBB@@BB@@@BBB@@@@@@@B@@@@@@@@@B@@@@A@@BAB@@@@@BABA@@@@@@B@@@@@BB@@@@B@@@@@B@@A@@@@B@@@@@@@B@@@@@BA@@@@@@@@@@B@@@@@@@@BB@@@B@@@B@BAB@B@B@@@@@B@@@@A@@B@@@B@@AB@@@@@B@@AB@@@B@@@@@BA@@@@B@@A@@@@BA@@@@@@@A@@@@@@@@B@@@@A@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@AB@@@@@BA@@BABA@@B@BABAB@@AB@@AB@@@@@@A@@@@@@@A@@@AB@@A@A@@@A@@@A@A@@@@@@BAB@DAB@BA@@@@@AB@@@@@BA@@@@FAB@@@BAB@B@B@@@@@@@@B@B@@BB@@BB@@@@B@@@@@B@@@B@@@@@@@@BBB@B@B@@@@@@B@@@@@B@@@@@@@@@B@DA@@DAB@B@D@@@@@B@B@@B@@@@@@@@@B@B@@@@B@@BDBBBDDB@B@BB@@B@@@@@BB@@@BBFBB@@BBBB@@B@@@B@B@B@B@B@@@B@@@@@@B@@@@@B@@@B@BAB@@@B@D@@@B@@A@@@AB@@A@@BA@@B@@@@@BBB@@@B@B@F@D@B@@@BA@@BABAB@@ABAB@@ABA@A@A@A@@@A@@@@B@@@BAB@@AB@B@B@@@B@B@BBB@B@@BB@@@@AB@BABAB@@@B@@@@@B@@@B@@AB@BAB@BABA@@BABA@@BAB@B@@@B@B@@A@@BAB@@ABABA@@BAB@@ABABA@@BAB@@@B@B@B@B@@@@B@D@D@D@B@B@@B@@B@BBB@D@FAD@D@D@B@D@B@BBBB@B@BBDDBBBADCBADAB@B@B@B@B@B@@BB@BA@@B@BA@@@@BBDBDDB@BB@@B@@ABABABAB@BDBF@B@BBB@BBB@DBB@B@BB@@BBB@BABBBA@@B@@@@A@@@A@A@@@A@@B@BCBABAB@DABAD@B@BBD@BABAB@DAB@B@B@@A@@BA@@BAB@B@@@BAB@@BB@@AB@BABAB@BAB@BCBAB@B@@AB@BA@AB@@A@ABA@@@@@AB@D@B@BAB@B@@@BB@@BB@@BB@D@B@BB@@BBBBBBB@BBB@@@B@BA@@D@@@B@@ABED@B@D@B@@DBB@B@BBBBBDBB@B@B@@B@@B@@D@B@@@B@@B@@@BB@@B@@BB@@@@@B@@B@B@BB@BB@BBBBBB@@B@DBD@B@B@@@B@@B@@@@BA@@BA@@BAF@DC@@B@BA@BD@D@@@B@B@DAB@B@DBB@B@BB@@BB@@@B@A@@@@@@@AB@@@@A@@@AAAA@@AA@@A@@@ABA@A@@@A@A@A@@B@@AB@@@@@AA@A@@@A@@@@BA@@B@BA@@B@@ABAB@@AB@@A@@@A@@BABAB@@@BAB@B@@@BA@@BAA@A@@AAA@@@AAA@@BA@@BA@@BA@@@A@@@@AA@@@A@@@AB@BCB@@ABA@A@@BAB@@@B@BAD@@@B@@AB@@AB@BA@AB@@AB@@A@@@ABA@@@@BAB@@ABAB@BAB@@A@ABA@@@A@AB@BA@@B@D@B@BAB@BBB@@BB@@@@@B@@A@@B@@@B@B@@@@A@@@A@A@@AA@AB@@AB@BA@@@@AAA@AAA@@AA@A@@@@A@A@A@A@ABA@@@ABA@@@AB@@AB@@@B@B@@A@@@A@@B@@AB@@@@A@@@AA@@A@A@@BAB@@AB@BA@@@A@@@AB@@A@A@A@@BA@A@A@AB@@AB@@ABAB@@@@A@@@@@@@A@@AA@AAA@@@A@@AAA@@ACAAA@A@@@AB@@ABA@@@@@A@@@AA@AA@@@@@@@@@A@@B@@AB@@@@@@@B@BAB@@@@@@@@@@A@@@AB@@A@A@@BA@@@@@@B@@@B@BAB@@@B@@@BA@@@@@@@A@@@@@AB@@@@A@@@@BABA@@"],encodeOffsets:[[132113,43847]]}},{type:"Feature",id:"222403",properties:{name:"敦化市",cp:[128.232131,43.372642],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@BA@@@@B@B@B@DB@@B@@A@@@@BA@@BABABABADABAB@@@BA@@B@BA@@B@@AB@B@@@F@B@@AB@@@B@@AB@@@@@@A@@@@AAAAAA@@A@@@AA@@@@@@@A@A@@@AACAA@A@AAA@A@@@A@A@AAA@AA@@A@AAA@@@A@@AA@@@@@@BA@@@A@@@@@@@@B@@@B@@@B@B@@@B@@@@@B@@@B@@@@@B@@@B@@AB@@@BA@@@@@A@@@@@@B@@@@@@@B@@@@@B@@@BAB@@@B@DA@@B@@@@@BB@@B@@@@@B@@@B@@@B@D@B@@@BA@@@@@@BA@@@AB@@@D@BAB@B@D@B@B@@@@@B@@@B@B@BA@@B@B@@@BABA@@@@BAB@B@B@@A@@@@@A@@BA@@@A@A@@@A@@@@A@@A@@AA@@@A@A@@@A@@@AA@@@@@AAA@@@A@@A@@A@A@AA@@A@A@@A@@@@A@@A@@A@@@AA@@@@@A@@@@@A@A@@@@@A@@AA@A@@A@@A@@A@@@@AA@@@@A@@AA@@AAAC@@@A@@BA@A@@BA@AA@@@@AAA@@A@@AA@AAAAA@@A@@A@@@AAAAA@@A@@@A@@@@@A@A@A@C@@AA@@@AC@A@@@@ABA@A@A@A@@@AA@CCCA@A@@@@@@D@B@B@BAB@@@BA@@BA@@@A@@BAB@@@@A@@A@AA@@@@BA@@BA@@@AB@@AB@B@BAB@@A@@@A@A@@AACCAACCAAAA@AA@AAAA@@AAAAAA@@@@AE@AAAICGACA@@A@@AA@AA@@A@A@@BA@C@A@A@ABA@@BA@CBC@CBA@A@@@A@A@C@AB@@A@A@@@A@AAA@@@A@A@AAC@A@@@ABA@CB@@CBA@A@A@@BA@@AA@AAACAAA@@AA@@@A@@@A@@AA@@@@@A@A@@@@@AA@@@AA@@@CAA@C@A@A@@AA@@AA@@@A@A@A@@A@@AA@@@AA@AA@@A@@@A@@BABAB@@AB@@A@@@AAA@A@@AA@AB@@A@@@A@AAA@@@@@A@@BA@@BA@ABC@A@A@A@ABADA@EBGBA@C@EAC@A@AB@@ABABAB@@CD@@@BA@@@A@@@AB@@AB@@AB@B@@@D@@@B@@AB@BAB@@A@@@C@ABA@@@A@AAA@AA@@A@C@A@ABC@A@A@@BAB@BA@A@A@@@A@@B@@A@ABA@A@@@AB@@ABA@C@@BA@@@@B@@@@A@@@A@@@A@ABA@AB@@A@@@A@@@A@A@AAA@A@@@@@@A@AACAAAAAAAA@@@A@ABABABA@ADC@A@A@C@C@A@@@C@A@@BA@@@A@@B@@A@@BCBABA@@@C@@BC@E@E@@AACCCAGCIACAAAMIEECA@AAEAA@EAACCCC@@@GACACCCA@A@C@CDABCBC@EDEDEDABEBEBA@GBEDC@ABCDGFGDABBB@B@B@@ABA@A@CBADA@AD@B@B@FBBDBBD@B@BABABA@A@CAC@C@A@C@ABABABADABA@CBEBA@C@A@A@AAC@A@@BCBAB@B@B@BBDAD@HAB@B@B@BBB@BBBBBDDBD@B@BAB@@ABB@@BB@@BBBB@@BB@BB@@@@@B@@@@@@@@@B@B@@BB@BBBB@@BBB@@@BA@@BAB@@ABA@@BB@@@B@B@B@@BB@B@@@@B@@@BB@@B@@@@@@B@@@B@@@@B@@@@@@@@@BA@ABABA@AB@@A@@@@@A@@@A@@@@BAB@BABA@@@A@@@A@@@A@@@A@A@A@@B@@@BA@@B@@A@@BA@@B@@@B@@@@A@@@@B@@@BA@@@@@@B@@@@@@@@@B@@@B@B@B@@@@AB@B@@@BA@@@A@@@A@@B@@A@A@@@A@@@@@A@@B@@@@A@AB@@@@AB@@A@@@A@A@@BA@@B@B@@@B@@@B@@BB@@@B@@@@@@AB@BAB@BB@@BB@@BBBB@@B@@@BAB@B@@@B@@B@@B@@@@@B@@@@AB@@@BBB@@@B@@BB@@BB@@DBBBBB@@@B@@@BA@ABABA@@B@@AD@B@@A@A@@@A@A@A@AB@@A@CBA@ABA@A@@@A@A@@@@@@@AA@@@@AA@@@@A@@@@AAAA@A@C@A@C@A@@@A@AB@@@BABABA@AB@@ABA@ABA@@@AB@BA@CBABABAD@@@@@B@@@D@B@@ABA@@@@B@@@BA@ABA@A@AB@@A@@@A@@@@@@@A@@@@B@@@@@@ABA@A@AB@@A@@@@@A@@@@B@@AB@@ABA@A@@@@@AAC@@@A@@A@@AAA@@AA@@A@@AA@A@@AAA@AAAA@A@@@@@AA@@AA@A@A@@AA@A@@BAB@@@B@@A@A@A@A@A@C@C@CBA@A@A@AAA@A@@@A@AA@@A@@@A@@@A@A@A@A@C@@@A@@@CBA@ABCB@@@B@@ABABA@ABC@ABABA@A@CBCBA@A@A@A@CBA@C@A@A@A@AAA@A@ABA@A@ABABA@CBCBA@ADCB@@CDABABA@AB@BABABA@@BA@A@ABA@AB@BA@@@C@A@A@C@A@A@@@@B@@BB@B@B@@@BA@@BA@AB@BA@AB@@@B@@BB@@BB@@@DABABABA@ABC@@BA@ABAB@@CBA@ABABA@A@A@A@A@A@ABA@CBAB@B@@@D@BABA@@BA@CB@@A@AAC@A@A@@@@@AB@BA@CBABC@A@A@CAC@AAA@A@A@A@@B@BABCBA@@B@@AB@B@B@@A@ABA@ABAB@@@B@BAB@@AB@B@@AB@@@B@@@B@@@@A@@@A@AAA@A@@@A@A@@@@B@@@B@@A@A@ABA@ABCBAB@@A@A@@@@@A@@@@@AAA@C@C@A@A@@BA@@@@BA@@@A@ABABCBABEBA@A@A@@@A@A@A@@@A@@BABCBABAB@DAB@B@B@@ABABABCBA@@BA@@B@B@B@@@BA@@B@@A@@BA@@B@@@@A@AB@@A@AB@@AB@@@@@@@@A@@@A@@@@@@@@@@@A@@@A@@@@@A@@@@@@BA@@@B@@B@@@B@@A@@B@@@B@@AB@@ABA@@B@@AB@@@B@@@@@BBB@@@@@BAB@@A@A@A@A@CBAA@@A@C@@@C@A@A@A@@AA@A@@@A@@@A@@@A@@@@@A@ABABA@AB@@A@A@@@@@AB@BA@@BA@@BA@@B@@@B@B@@@B@@B@@BB@@@A@@@AB@@@B@B@@B@@B@@@BB@@BB@@@@BB@BBBBBBBBBBBBBB@B@@@B@@@@BB@@BBBB@@@B@B@B@B@B@@@DB@@B@@@BB@B@BBD@BBD@@@DBDBB@B@B@B@B@BB@@@B@B@B@@@B@B@@@BABA@@B@B@BAB@B@BAB@BA@@@@@AB@@@@@B@B@B@DBB@@@BA@ABA@A@ABA@@@B@@BB@BB@B@B@BB@@B@B@@BB@B@BB@@D@@BBBBBB@B@BAB@B@@AB@BB@@@DBB@B@@@@@@BABABBB@@@B@B@@BBBB@@@@@BAB@BABA@@BC@ABABC@CBABA@A@AB@@@@@B@B@B@@@@ABCBA@ABCBA@@@AB@@@B@B@@@B@@@@DAF@B@B@DBB@B@B@B@B@B@B@D@B@B@B@B@@@D@D@@@BBB@@@B@B@B@@@@@D@BBB@B@BBB@D@@@@@B@@@B@@BB@B@B@B@B@@@BBB@@BB@B@B@B@@@@@B@@@@B@B@@@@@@BB@@B@@B@@@B@@BB@@@@@@@@@@B@@@B@@@@@@@B@@@@@BB@@B@@@@@BB@@@@B@BBD@BBB@@BB@@B@@@@@@@B@@@@@B@@@BA@@@@@@B@@B@@BB@@BB@@@B@BBB@@@B@@BB@@BBBB@@@BB@@B@B@B@BB@@@B@B@B@@@B@B@B@B@B@B@@@BB@@B@@BB@BB@@BB@@@BB@@@@@BBB@@@@@BB@@@D@BBB@D@B@B@B@B@BAB@@@BB@@B@BB@B@@@B@@BB@@@B@@BB@@@BB@@BB@@@BBB@@@B@B@B@@@B@B@B@BB@@BBB@BBB@B@@BB@BBB@@@BBBB@BB@B@BB@@D@B@@BB@@B@@BB@@AB@@BB@@BB@@B@B@BB@@B@B@@@BBBBB@BB@@BBB@@B@@B@BB@@B@BB@@@BB@BB@@BBBBB@@B@@BB@B@BB@@B@D@@@B@@@B@B@B@B@@@B@@@B@@BB@BB@@BB@BB@@@BB@@B@@@B@@@BB@@BBB@@BB@@@@@BBB@@BB@BBBBBB@@@BB@@B@@@@BB@@BBB@@B@@B@@B@BBB@@B@@@@@@@BA@@@@B@@BB@@B@@@BB@@@B@B@@AB@@@B@B@@@B@BB@@B@@BB@@@B@@AB@@ABA@@BA@@BAB@@@B@@AB@B@@@B@B@@@@ABA@@BA@@B@BA@@BC@ABABAB@@ABA@@@CBA@ABA@A@@BA@ABA@CBCBC@CB@@C@@@A@CBA@A@A@A@@@@B@BAB@B@@AB@@@@@BB@@@BB@@BBBBB@BB@@B@@@B@B@@@B@B@@B@@B@BBB@@@@@BB@@@@@@@B@@BBA@@@@@@BB@B@BB@@@@BB@@@B@@@B@B@B@B@@@BA@A@@B@@A@@BA@@BA@@@ABA@@BA@@B@@AB@@A@@BA@@@@@A@@B@@A@AB@@A@@BA@A@@@@@@@@B@@@@@@@@@B@@@@@@@BB@B@@@B@BBB@BB@BBB@@B@@@DAD@B@B@F@B@D@D@B@@@BBBBDBBBBBBBB@@@D@B@D@@@B@D@D@BBB@B@B@D@DBD@BBB@B@B@BBB@@B@B@@B@@BB@D@BBD@B@B@@BB@@B@B@B@B@@@@ABA@@@A@@B@B@@@BA@@B@@@@@@@BB@B@DBBBDBB@@B@@A@@BBB@@@B@BBB@B@@@BB@B@DBBBB@BB@@B@@B@@BB@B@BB@@B@B@@@BB@BB@BB@@B@BB@@B@BBD@BB@@B@B@@A@@B@B@@ABABAB@@A@@B@@@@@BDBBBBBBBBBBB@B@BBB@D@D@D@B@BB@@BBB@BB@@B@BBB@BBB@@@B@@@B@@AB@@A@E@A@C@C@ABABABADAB@BAB@B@B@DBF@D@B@D@B@BB@@BB@@BBBBB@BB@@@@B@B@@BB@@BBBBBBBB@B@B@B@@AB@@@BB@@B@D@B@B@B@@@B@B@B@BAB@BA@@BCBCBABA@A@@@@B@@BB@@@@BBDB@@@@BB@@@BB@B@@@BB@@@BBB@@BB@BB@BBB@BB@@BBB@BB@@@B@@@BA@@@@@B@BBB@BB@@@@B@@B@BBB@B@@@D@BBB@@@@@@@@@@B@@A@@@AB@@@@@BA@@B@B@@@B@BAFAB@BAB@B@B@B@B@B@BAB@B@@@B@B@B@B@@@BBB@B@@BB@@@B@@@BBB@@BB@@B@B@B@@@B@@@B@@@B@@@@@@@@@@BB@@B@@@@@@B@@@@@@@@@B@@@@@B@@@@B@@@@@B@@@B@@@@@@@B@@B@@@B@@B@@BB@@@@B@@BB@@@@@B@@@@BB@@@@B@@@B@@@B@D@B@B@@@B@B@BB@@B@B@@BB@@@@@AB@@@@@B@@AB@@@BA@@B@@A@@BA@@@@@@B@@@@@B@@@@@@@@@B@@@B@@@BBBBB@@AB@@ABABB@@@BD@B@@@DBB@B@@AB@B@@@@BB@@B@@BB@@BB@BBBB@@B@@@B@B@@@B@@@B@BAB@@B@@B@@@B@@@@@BABAB@B@@@BBB@@B@B@B@D@B@B@F@@B@@BB@@@B@B@B@B@B@@@B@@B@@@B@@@@@@@B@@@B@@@@@@@B@BA@@B@@@@@@@B@@@B@@BB@@@BB@@@@BB@@@B@@@BA@@B@@@B@BB@@B@@@BB@@B@@B@@BB@@@BB@@@@BBA@@B@B@D@DBBBBB@@@B@@@B@@@B@@@B@@A@ABAB@B@@@B@@@@B@@@@@BB@@@@B@@B@@B@@@@BB@@@B@@@@B@@@BB@@B@@B@@@B@@@@@B@@BB@B@B@@@@@B@@@B@@@BA@@B@@AB@@@B@@AB@D@B@B@@@B@@@@@@B@B@@@B@@BB@@@@@@DB@@B@BB@@@@B@@@BB@@@B@@@@BD@@@B@@@B@@B@@B@@@@B@@@BB@@BB@@@@@BB@@@A@@BB@@B@@@B@B@@@B@@@@BB@@@@BB@@BB@@@@BB@@@@B@@B@@@@@@@@@BB@@@@@B@@@@@@@B@@@@@@@BB@@BB@@@B@@B@@@B@@@@@@@B@@@@@B@@@B@B@@@B@@@DA@@@@@@B@@@B@@A@@B@B@B@@@@AB@B@@@BAB@D@@@B@B@B@@@@@BB@@B@@BB@@@@BB@@BB@@A@@B@@@@@B@BBB@B@@@BB@@@@@BB@BB@@@@A@@B@@@BBB@@@B@@@B@@AA@@A@@@@@@@@B@@@@@B@B@@@@@@@B@@A@B@@B@@B@BB@@@@A@@@@BA@@BA@B@@@@@B@@B@@@@@B@@@@@B@@@@B@@B@@B@A@@B@@@@@@@B@@B@@@@@@B@@@@@@@@B@@@@@@@@BB@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@B@@@@@@B@@@@@@@@BB@@B@@BB@@@@@BB@@@@@@B@@@BB@@@@B@@@AB@@@B@@@@@@BB@@@@BB@@@@B@@B@@@@B@@@@@@B@@@@@@@@@@@@AB@@@@@B@@A@@@@B@@@@@@@@BB@@@@@@A@@@@@@B@@@@@@@@AB@@A@@@B@@@@B@@@@@B@@@@@@@@@@@B@@@@A@@@@@A@@@@@AB@@@@@@@@@B@@@@@@@@@@@@A@@B@@A@@@C@A@@B@@@@@BB@B@@@@B@@@B@BAB@@AB@@@@@B@@BB@@B@@BA@@B@@@B@@@BBB@@BBB@@@@@@@ABA@@BA@@BA@@BABA@@@A@@@@B@@@@B@@BB@@BB@B@D@B@B@@@BBBBBB@@@B@BA@@BAB@@@@A@@B@B@@@B@BC@@@BBB@@@B@@@BB@@BBB@@@B@B@DBBAD@B@BB@@B@@@@B@@@B@@A@@BA@@@@@@@@B@@@B@@@BABABCB@BA@@B@@@B@@@@@BB@@@BB@@@BB@@@@@@B@@@@@BA@A@A@AB@@@B@@B@@BB@@@@B@B@@AB@@A@@B@@AB@@A@A@A@ABA@ABA@A@@@A@@AAAAAAA@@A@@@ABA@@BA@A@AB@@A@AA@@AAA@A@@@@@@@ABA@@BCBCBA@ABAAEBE@A@A@ABA@ABABCBEDABC@@BABABC@CBA@@BA@@B@@@@BB@BBB@BAB@BAB@BCDABABAB@@ABAB@@@B@@@B@@BB@B@BA@ABABA@C@ABA@@@@B@@@BAB@@ADAD@BAB@@@B@B@B@B@D@B@B@@@BB@@@BBB@@@BB@BB@AB@@@@ABABAB@B@@@BBB@BB@@BDB@@@@@@AB@BA@@@A@C@A@CAA@@B@BA@ABA@A@@@@BAB@B@@A@ABAB@@ABA@@@C@CBC@A@C@CBC@BBBBBB@@@B@B@@BBB@@B@B@@@B@BA@A@@BA@@@@B@@@B@@@BA@ABA@A@A@ABA@@@@BA@BB@@@@BBDBBBB@BBBBBB@@B@@B@@@@A@@@ABA@ABA@@B@BAB@@@@B@BB@BB@@D@@@B@B@BBB@@BF@BBBDB@BBBB@BBDBDDB@@BB@B@BB@@DAD@B@B@@@BB@B@@@B@BB@@B@@B@@@BAB@D@DAD@BADADABABABA@A@@BB@@@@@B@@@DBB@BBB@BB@@BD@@BBBDB@@@B@@@B@@A@CBA@A@@@@@@B@@@@BB@@@B@@@B@B@@@BBB@B@BB@@B@@@B@BA@@@AB@@@@AB@@A@A@A@A@A@@@ABA@@B@@@B@@@B@@B@@B@@BB@@AB@B@@@BAB@@@@A@@@A@C@@@A@@@AB@@A@A@C@A@A@@@A@@@@B@@AB@@@B@@AB@@ABA@A@@BA@A@A@ABA@A@AB@@A@A@ABA@@@A@@@A@@@ABA@@B@@@@A@AB@@A@@@A@@@@@AB@@AB@@A@@B@@A@@@@@A@A@A@@@A@@@@@ABA@AB@@A@A@ABA@A@@@AB@@@@A@@B@@@@A@@@A@@@AB@@@B@@@BB@@BA@@@@@ABA@@@A@ABA@AB@@@@AB@@@@AB@@A@@BA@@@A@@@@@AB@@@@@BA@@BAB@@@@ABA@@@A@A@@@A@@BA@A@A@AB@@A@A@@@@@@BA@@@ABCBC@ABA@B@BBD@D@F@DAB@B@B@@@@@@BBB@@BB@B@B@@B@@D@@@B@@AD@@@BBB@@@@@B@@BB@@BB@B@@@BB@@B@@B@BBB@@@B@@@BB@@@B@@@B@BA@@B@@@@ABA@@BA@@@AD@@AB@B@BA@@B@@@BA@@B@@BB@@@B@@B@@@BBB@@@D@@BB@B@B@B@@@B@B@@@B@BA@@@@B@@BA@@@ABAB@@ABC@ABE@AB@@@@@B@@@B@@@B@B@@@B@@BBBB@B@BBB@B@@@B@B@B@@AB@@@BA@@B@@@@@B@@@@BBBB@@@@BB@@B@@BB@@@@B@@@B@@@@BBB@BB@@B@B@BBB@DBBBB@BB@@B@@B@@BB@@BB@B@@@BB@@BB@BBBB@BBB@@@@B@B@B@B@DB@@B@D@DBB@B@BBBBBBDBDB@@@B@@BB@BB@@BB@@BB@B@BBB@BB@@@B@@@BBD@@@BAB@@@@@@B@B@B@@BD@BB@@B@B@DBB@@@DBB@B@@@BB@@@BBB@B@B@B@BB@@BB@B@B@BAB@DBB@@@BBBB@@BBB@BBB@BBB@B@@@BBAB@@ABABAB@@AB@B@BA@@@B@FBB@DB@@DB@@B@@@B@B@B@B@@@B@@BD@D@BB@@BBB@BBB@@BB@@@@@B@B@@@BA@@B@B@@AB@@@B@@@B@@AB@@@B@B@B@DAB@BAB@@@B@B@@@B@B@@@@@B@@@B@B@@@B@BBB@@BB@@@@BBB@@@B@@B@@BB@BBB@B@B@BB@@B@@@BBB@@BB@B@BB@@B@@@B@@@BA@@@@@B@@@@@B@@BB@@BB@@B@@@BB@@@@BB@@B@@BB@BB@@BB@@@@BBB@@@B@@BB@B@@BDBBB@@@@B@DAD@B@B@B@@@B@@@B@B@@@B@B@@@BBB@@BB@@B@@B@@B@@B@@@DAB@@@B@B@B@B@B@@@B@B@B@B@D@B@@@@@B@B@BB@@B@@@B@B@@@B@B@@@BB@@B@@B@@B@@@@@@@B@@@@@B@B@@@B@B@B@B@@@BB@@@@B@@@B@@A@@B@@@BB@@@@B@B@B@@@@@DBB@BB@@FD@@@BB@@BBBBBBBBBBB@@BBB@BBB@BBB@@@B@B@@@BBBAD@B@@@B@DADAB@BA@@DAB@@@B@@@@@B@@@B@BB@@B@@@BB@B@@@@B@B@B@B@@@B@BBBBB@@B@@B@@@B@D@B@@@@B@@BB@BBB@@@@BB@@BBBBDBBBB@@@BB@@B@B@@BB@BBBB@@BB@@@BB@@@BBFBBBB@BBB@BBDDDBBBD@DBF@FB@BB@@@@@@@@@A@A@CBC@EBABA@@BA@ABAB@@AB@@ADAD@@@B@@@D@B@@@BB@@@@@@B@B@BBD@D@@@@@B@@AB@@A@@@A@C@A@A@A@A@AAA@@AA@@@A@@@@@ABA@AB@BA@AB@D@@A@@@AB@@CBC@ABA@ABA@@@@B@@@@@@@BBB@BBB@B@@B@B@@BB@B@BB@@B@BBB@@BB@@B@@@@@B@B@@@@@B@@BAB@@@B@@@B@B@@@B@@@B@B@B@B@B@BAB@D@B@B@B@BBB@@@B@B@@@@B@@@B@B@B@B@D@BAF@B@B@BB@BB@@BB@@@@@@B@A@@@ABABA@@B@@@B@@@B@B@B@@@B@@@B@B@B@B@@BB@BAB@@@BAB@B@@@@@BB@@@B@@@B@B@B@BAB@BA@@@@B@B@B@B@B@@@@@@@B@@@@@@@BB@B@B@B@@@B@B@B@BA@@B@B@B@B@@@F@B@B@@@@@@A@A@@@AB@@A@@@AB@B@@A@@BA@@@A@@@A@@@A@@BA@@@AB@@A@ADCB@@AB@@@@@B@@@@B@B@BBB@B@@@B@@AB@@@@@@@@B@@@@AB@BAB@B@@@B@@@@@B@@@@@BA@A@A@A@CAC@CAC@AAA@@BA@C@C@ABC@@@A@ABC@ABAB@@@BA@@D@B@B@@@D@B@@@B@B@B@@@BB@@BB@BBBBBBBBDBBBBBB@B@B@@BB@D@BBD@BBB@@@BBB@B@BBB@@BBB@@B@@B@@@BBD@@DD@@B@@BB@@@BB@@@@B@@BBBBB@BB@@B@BBB@@@B@BAB@@@@ABA@A@@@@@A@A@A@AAA@@AA@@A@@A@@@A@ABA@A@@BABA@AB@B@BABABADA@@B@B@@@@@B@B@@@B@@@@@@A@A@C@E@A@C@C@C@A@A@@@@@@B@@@@BB@@BB@@@B@@@B@@AB@@@BA@@B@@AD@B@@@B@@@B@@@B@@@@@BA@@B@B@@@@AB@@ABA@@@ABAB@@AB@@A@@B@@@B@@BB@BB@@BBB@@BDB@@B@@BB@@@BB@@BBB@@@@@@@@A@ABA@@@A@A@@@A@@@A@AB@@A@ABABCD@@AB@@@B@B@@@@@B@@B@@@BBB@@@FD@@@BB@@@@@@@B@@@B@B@B@@BB@@@@@@@B@@@@B@@@BBD@@@B@@@BB@BBBDBB@@@@@@@B@@@@@B@@@@A@@@@@@B@@@@@B@B@@@@@BB@@@BBBBBB@@B@@@@@@@@@B@B@@@@@@@@@B@@A@@@@@A@C@@@@@A@@@@BA@@@@B@@@@@B@BAB@B@@@B@@@@@@@BA@@@@@ABA@ABA@@DA@AB@BABAB@BA@AB@@@BA@@BA@@@@B@B@BA@@B@@@BBB@@@@@B@@BB@@@B@@@B@B@@@B@DBD@B@B@B@B@@@B@@A@@B@BAB@@AB@@AB@B@BA@@B@@A@A@A@@@@B@@@B@@@B@B@@BB@@BB@@@BBB@BB@@B@@BB@BBB@@BBBB@@BB@B@B@D@B@F@BAB@B@BAB@@@@@B@B@B@@@B@@AB@@@@AB@@@@A@@BABABA@A@@BA@ABABA@@BAB@@AB@@@B@@BB@@@B@@@BBBBB@@@BBBB@@BB@@B@@B@@BBB@@B@@BB@@@@@BB@@@@B@@@@B@@B@@BB@@BBBB@@B@@BB@BBBDB@@B@@BB@@@BB@@@@B@@@B@@B@@B@@@BA@@B@@@B@@@@@B@@@BA@BB@@@BB@@@@@BB@@@B@@BB@@@B@B@@@BB@@@@B@@BD@B@BB@@B@@@@@B@@B@@B@@@@@BB@@B@@@@@@BB@@@@@B@@@B@@@@AB@@@@@@@B@@@B@@@BBB@DB@@D@@BB@@@BB@@B@@@B@@@@@B@@@@B@@B@@@B@B@@@BB@@DBB@BB@@B@B@@B@@@@BB@@B@@B@@B@@@@@@@@BB@@@B@BB@@B@@@B@B@@@B@B@@@BB@@@@@@@@@@D@@@@@B@@B@@@@B@@B@@@B@@BFBBB@@@BB@@B@B@@@BB@@BBBB@@BB@@@@BB@B@BBB@@@BB@@B@B@@@D@DAB@B@B@@@B@B@@@B@@@B@@BB@D@B@@BB@B@@@B@D@D@B@@@B@@@@@B@@@@@@@BB@@@@@B@B@BB@@B@B@@@B@@@@@B@@@@BB@@@@@BDBBB@@@B@@B@B@D@D@B@B@B@BBB@B@@@B@@BB@B@B@DBB@BBB@B@@BB@B@BB@@B@B@DBB@BBD@@@@BB@@@AB@@@B@@A@@BBB@BBB@@@B@B@B@@BB@BBB@@@B@@BB@@BB@@D@BBB@BBB@@@BBB@BBB@@@BBB@B@B@B@BBB@BB@@BB@@B@BBB@B@B@BBB@B@B@BBBBD@BBBBB@@BBBB@@BBB@@BD@@@@@@@BB@@@@@@@@BB@@BB@@@B@@BB@B@@@BBB@@@BBB@BB@@@@B@@@@@B@B@B@B@@@@@B@@BB@@@B@@@BB@@B@@@D@@@@@@@B@@@B@@@B@B@@@@@@@B@@@DB@@BBB@@@@@B@B@@@B@@@B@BB@@B@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@BA@A@@@@@AB@@@BA@AB@@A@@@@B@@AB@@@BAB@@@@@BA@@@@@AB@@A@@@A@@@A@@@A@AB@@@@AB@BABA@@@ABA@A@A@A@A@@B@@ABAB@BA@@BA@@B@BA@@B@BAB@B@BA@@D@B@D@B@BAB@@@B@BAB@B@@AB@BAB@BAB@BA@@BABA@@B@BA@@B@B@D@B@B@BA@@BA@A@@BA@@@A@@@AB@@A@@@AB@@A@AB@@A@@@@BA@@@AB@@@@AB@@@B@@AB@@@@@B@@@@AA@@A@@@@@A@@AA@@@A@@@A@@@A@@@@@AB@BA@@B@@A@@BA@AB@@@@AB@@@B@B@B@B@@@@AB@@AB@@A@@@AB@@@BAB@@A@@B@@@@@@AB@@A@A@@@@@@B@BAB@B@B@@AB@BA@@B@BA@@@@@ABA@@@AAA@@@A@A@@@@AA@@AA@@A@@A@@@@AA@@@A@@@AA@@A@@AA@@@A@A@@@A@AB@@A@@BA@@@@@A@@AA@A@@@@AAB@@@@A@@@A@@@@BA@@BA@A@@@AB@@A@@@A@@B@@A@A@@@A@@@@@ABA@@B@@A@@@@@AB@@@@@BA@@@AB@@@@AB@B@@@B@@@B@@AB@BA@@BA@@@A@@@A@@@@@@@AB@@@BABA@@@@BA@@@A@@BA@@@@@A@@B@@A@@@AB@@A@@@@@A@@B@@A@@@@B@@A@@@@A@@A@AA@@@@AA@@@@A@@@@@A@@@AA@@@@A@@@@@AA@@@@A@@@@AA@A@@@@@A@@@@@A@@@AA@@A@@@A@@@A@A@AB@AA@@@AB@@A@@@A@@@A@A@@@A@@@A@A@@@AAA@@@@AA@@A@AAA@@@A@@AA@@@A@A@A@A@@@A@A@@AA@@@@@A@A@AB@@A@A@A@@@A@@@A@A@A@AAA@@@AAA@A@@AA@@@@A@@@@D@BAB@@@BA@@B@@@BA@@B@@A@A@A@ABA@A@@@@BA@@B@BAB@B@@@BA@@@@B@@A@@B@@AB@BA@@BABAB@BA@@B@BABA@@@@BA@@@ABA@A@ABA@@@A@@@A@@@A@@@A@@AA@CA@@AAA@@AA@@@AA@@A@@@A@@@A@@A@@AA@@A@@@A@@@A@C@@@@@A@@B@@AB@@@@@@A@@@A@@@@@A@@@@@@@AAAA@@A@@A@AA@@@AAA@@@A@@AA@@@@@AA@@@@A@@@A@@AA@A@@@@@@@AA@@A@AA@@AA@@A@A@@AA@@@AA@AA@@@A@@@AAA@CAA@@@A@AAA@A@@@@BA@@@@B@@A@@@@BA@@B@BAB@D@B@B@D@@@B@@ABA@@BAB@@@@@@@@AB@@@BA@A@@BA@@@@@@AA@@@A@A@@AA@A@@@@@AB@@@AA@A@@A@@A@@@@BAB@@A@@@@@A@@@@A@@@A@@AA@@A@@AA@@@@@AAA@@@AAAA@@AA@A@@AE@A@AA@@@@@@@A@@BABA@ABA@A@@@AA@@C@@@A@@@A@A@A@@AA@@AAA@@AA@AAAAA@@A@@AA@AA@@A@@AA@@B@@@@@B@B@@@B@B@BAD@B@@@D@BA@@@@BA@@BABA@A@@B@@A@@@@@@@A@@@@@@AA@@AA@@@A@@@A@AAA@C@A@AB@@C@ABA@A@@B@@ABA@A@@@@@A@@BABA@@@AB@@@@AAA@A@C@@@@BA@AB@@A@@@A@@BA@@@A@A@@B@@A@@@A@@@@@A@A@@@@@A@@@@@AB@@@B@@@BAB@@@B@B@@@@AB@BA@@BAB@BA@@@AB@@A@@B@@A@AB@@@@@@A@@BA@@BA@A@@@AB@@A@@BA@@BA@CB@BA@@@A@@B@@@B@@@B@@@BA@@B@BABA@@BA@@@@@A@@B@@A@@@AB@@A@@@A@@B@@AA@@@@AA@@A@@@@@A@@B@@@@@B@@@B@B@BB@@B@@@BA@@B@@@@@@@BA@@B@@@@@B@@A@@@@@@B@@@@@@@@@@@@@B@@@D@@@@@@@BA@@@@@@B@@@@@@@@@BA@@@@@@@@B@@@@A@@@@BC@@@@@@@ABAB@@A@@@@@@B@@@@@@@B@B@@@@@B@@A@@B@@@B@@@@@@A@@@@@@BA@@@A@@@@@@@AB@@@@A@C@A@A@@@@BA@@@@@A@@@@@@@@@@B@@@@@BA@@B@B@BAB@@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@AA@@A@@@@AA@@@@@A@@@@@@A@@A@@@@@@@A@@@@@@@@B@@@@@@@@@@A@@@@@@B@@@@@B@@@B@@@@@@@@@@@@@BA@@B@@AB@@A@@B@@@@A@@@@@@BA@@@@@@@AB@@@@A@@@@@@B@@@BA@@@@@@B@@@@@@A@@@@@@@@@@@A@@BA@@BA@@@A@@B@@A@AB@@@@@@@@@BA@@BA@@@@@AB@BA@@@A@@@@B@BA@@@@@@BA@AB@@@@@@A@@B@@A@@@@@A@@@@@A@@@@AC@@@@@@@@@A@@@@@@B@@A@@@@@A@@@@@@@@@A@@@@B@@@@@@A@@@@@@@@B@@@@@BA@@B@@@@AB@B@@A@@@@B@@@@@@@@A@@@@@@@@@@@AAC@@@@@@@@@@@A@@@@B@@@@@@@@@@AB@@@@@B@@AB@@@@@@@B@@@B@@@B@@@B@@@BA@@@@@@@@@@@@@@@@@A@@@@@@BC@A@A@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@A@@@@@AB@@@@@@@@A@@@@@@@@@AB@@@@@@@@@@@@@@@@@@A@@A@@@AA@@@@@@@@@A@@A@@@@@@@@@@A@@@@@AB@@@@A@@@A@A@@BA@@@@@@@@@@BA@@@@@@@@@@@@@@@A@@@@A@@@@A@@@@@@@@@@@A@@@A@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@A@@@@@@@A@@@A@@@@@@@@@@@A@@@A@@@A@@BA@@@@@@@@@@BA@@B@@@@@@@@@@@@@BA@@@@@@@@@A@@@@@ABA@@@A@@@@@@@@@@BC@@@@BA@A@@@AB@@@@AAA@C@C@A@@@ABA@@@A@@@AA@@A@@@@BA@@@@@@@A@@BADC@@DABABA@@B@BA@@@@B@@A@@@A@AA@@A@@@@@@DAD@BA@ABABC@@@ABABCBAFEBA@A@@B@DCBADAFE@@BABA@@B@BAB@B@@ABAB@@@@@B@@@BAB@D@BA@@B@B@F@@@@@B@D@@@B@BA@@@@@ABA@A@A@@@A@@BA@@@@@@AA@@@@AA@@@@BA@@BAB@@AB@BA@@@@@@@A@@A@@A@@A@A@@@A@AAA@@AA@AC@@AAAAAAA@@@AAAAA@@A@@@A@AAA@@@@@@B@@@@@@A@@@@BA@@@@@@@AB@@@@@BA@@@@@@BA@@@A@@@@A@@A@@@A@@@@@A@A@@BA@@@@@@BA@@@@B@BA@@B@B@BA@@@@@@B@@@@AA@@@A@@@AA@@AAAAA@@@@@@AA@@A@@@A@@@@@AB@@A@@@@@@@@BA@@@@@@@A@@@AA@@@@@@A@CB@@A@A@@@@@@B@@@B@@@@@BBB@B@@@B@B@@@B@@B@@B@@BB@@@B@@B@@B@@@DAB@DA@@@@@@@@@A@@@@@A@@@@@@B@@@@@D@@@BAB@B@B@@@@@B@@@B@@@BBBB@@B@@B@@DBB@@B@@BB@@BB@@@@@@@@@@@@B@@AB@@A@@B@@A@@@@@@B@@@B@B@@@BA@@B@B@BA@@@@B@@@B@@@BB@@@@@@B@@BB@@@@@B@@@@@B@@A@@@@@@@@@@@BB@@@B@B@BBB@B@B@B@@B@@B@@B@@@@@B@B@@@B@@@BBB@B@@B@@@@@B@DABAB@B@@@BA@@B@@AB@BAB@@@BAB@B@BA@@B@B@B@B@@@B@B@B@B@@@B@B@BAB@@@BABAB@@AB@BAB@@AB@@ABA@@BA@@BA@@DCBCB@@@@ABA@@@AB@@ABA@@@@BABA@@B@BA@A@@@@BA@@BA@@BA@@@AB@@@B@@@@AB@@@BAB@@AB@B@B@@@BA@@BA@@@@@@B@@@@@@A@@@@@AB@@@BA@@@@@@@ABA@@@@@ABA@@@@@@A@@@@@AA@@@@@@@@@A@BA@@@@@@A@@A@@@@@@AA@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@A@@@A@@A@@A@@A@@A@A@@@@A@@@@@@A@A@@AA@A@@@@AAA@@A@@AA@AA@@AA@@@@@@A@@@A@@@@A@A@@A@@@@@@A@@A@@@@@A@@@@A@A@@@@@@A@@@@A@@@@@@@@@@@A@@A@@@AAAAA@@AA@@AA@@AA@A@AAAA@@@A@@@AA@@@@AA@@AAA@@A@@A@@@@AA@@@@AA@@@@AA@AB@@A@@@@AA@@@AAAB@BA@AB@BA@AB@@@BA@@BA@@B@@@B@B@@@B@@@B@@A@@B@BB@@@@@A@@B@@@@A@@@@BA@@BA@@@@@@@@B@@@@@@@B@@@A@@AB@@@@@B@B@@@BA@@@@@A@@B@@@@A@@@A@@@@B@@A@@@@@@@@@@@@@@@A@@@@@BB@@A@@@@@@AA@@B@@AAAB@@C@@@C@A@@BAB@BA@AB@@A@A@@@AB@@A@@BA@@@@@AB@@@@@@@B@@A@@@@@A@@B@@A@ABC@ABA@ABEBC@@BEBC@ABA@ABC@@@@@@@@BA@@@AB@J@BACCEAC@IBIBGA@@@@AA@@@AA@A@A@@@CAA@A@A@AAA@@AA@AAAA@@AA@AA@A@@AA@@A@@@A@@@A@AA@@A@@@@@A@@@@@A@@@AB@@A@@@AB@@@B@@@B@@BB@@BB@D@B@B@@@B@B@B@BBB@B@BA@@@@@A@@@AA@@@AA@@AA@@@A@@BAB@@@BA@@B@@@BAD@@AB@BAB@BA@@B@@@@@B@@@B@@A@@@BB@B@@@BB@@BB@@@@BB@@@@B@@@@@B@@@@@B@@@B@@@@@B@@@@@@@B@@B@@B@@@B@@@@B@@@A@@B@@@DA@AB@@A@@@@@@B@@AA@@@@@@A@@@@@@@@@A@@@@BA@@@@@@@@@@@AB@@@@@AA@@@@@AAA@@@@@A@@@@@@@@@@@AB@@@B@@@@@B@@@@AB@@@B@@@B@@@@@BA@@@@B@@@BA@@B@B@@@B@@@@@BA@@BA@A@@@@@@@AA@@A@@A@@@@A@@@@@A@@@A@@@A@@@@BA@@@@@@AA@@@@@@@A@@@A@@@A@@B@@@@AB@@@@@@@B@@A@@@@@A@@B@@AB@@A@@@@@AB@@A@@@A@@@@A@@A@@@AA@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@@@@@@@@@@AB@@@BA@@@@B@@@@@@A@@@@B@@@@@BA@@@@@@B@@@@A@@@@@A@@B@@@@@BA@@@@@@B@@@@A@@B@@A@@@@@@@AB@@@@A@@B@@A@@B@@@@A@@@@B@@A@@@@@@@AB@@@@@@A@@@@B@@A@@@@B@@A@@@@@@@@BABA@AB@@@@@A@@A@A@@@A@@@@@@@AB@@@@@@@B@@@@A@@@@B@@@@A@@@@@@@A@@B@@@@@@A@AA@@AAA@A@@@A@@BA@@B@@AB@@@@@@@BA@@@@@@@@@A@@@@B@@A@@@@@@@@@@BA@ABA@A@@@@@A@AA@@A@@A@@A@@@A@A@@@@@@@@B@@@BA@@@@B@@@BAB@@@@@@@B@B@B@@@@@@@@@@@@AB@@ABA@@@A@@@@@@@A@@@A@@@@@@@A@@@@BCBA@A@@@C@A@C@A@@@@@AB@@A@@BA@@@@BA@@@A@@@AA@B@@A@ABA@A@@@A@@@A@@@@@A@@@@@@@@BA@A@@@@@A@A@A@@@@B@@ABA@@@@@A@A@@@@BA@@@A@@BA@@@CB@@A@@@A@C@A@@@A@AB@BA@ADEBAB@BABADAB@FADA@@B@@A@@@@@A@A@A@A@@@C@@@A@AA@@@@AB@@A@@@A@A@ABA@AB@@A@AB@@A@@@AB@@@B@B@D@B@B@B@B@BBB@B@B@@@@@BA@@B@@CBA@@@ABABA@ABA@@BA@@@ADC@ABA@@BC@@@A@@B@@@@A@@BBB@B@BB@@B@@@B@D@@@D@B@B@@@@@HAB@@@B@BBB@DBB@@@D@@@BB@@F@D@B@D@B@B@BBB@BBBBB@B@BB@@@@BB@BBBBBBB@@BB@@B@@BF@DBFBB@@@B@B@F@B@B@B@B@@@B@@@DADAB@@@@@BADE@AB@DC@ABA@@@A@A@@B@@@BAB@BAB@@AB@@AB@@A@@B@B@D@D@@AB@@@B@B@@@B@B@B@B@FAD@B@B@@@B@B@@ABAB@BAB@@@B@DAD@DAB@B@B@BABADABAB@BA@@BA@ABAB@B@@AB@D@B@@@B@@@BB@@@@B@@B@@B@@B@@B@@@@BB@@@BB@@B@@BB@@@B@@BB@@@@@BB@@B@B@@B@@@@BB@@@B@@B@@@@BB@@@@@@@@@BBB@@@@@B@@BB@@@B@@@@BB@@B@@@@@@B@@B@@B@@BB@@@@@B@@@@@BB@@B@@@B@BB@@@@B@B@B@@@B@B@@@@@BB@@B@@@BBB@B@B@@@B@@@BB@@B@@@B@@@B@B@@@@@B@B@@@B@@@BAB@@@B@B@B@BB@@B@@@B@@@B@@B@@@@BB@@@@@@@@@@B@@@B@B@B@@@B@B@B@@@B@@@B@BB@@B@B@B@B@@@B@@@B@B@B@@@B@@@BA@@B@@@@@B@@@@@AAAA@A@@@A@@@A@ABABAB@BA@A@@@A@@@A@AAABA@A@A@@BA@@BAB@BC@@BABC@ABABA@ABAA@@@@A@@@@BA@@@@BAHAD@DA@@B@DAD@BAB@B@B@@A@@@@DAB@@AB@@@@A@@@A@@B@@AA@@A@A@A@@@A@@BAB@B@B@@@B@B@B@@AB@@@@@@AB@@A@@B@@AB@B@BA@@BA@ABA@@BA@@B@BADB@@DAB@H@D@B@@@@@@C@CBABCBAB@BABA@AB@@A@A@@@AAAACAAA@@@A@@AA@@A@A@ABABABABA@@@@AAA@@CA@@ABE@CBE@@BA@@@ABC@@B@@A@@@@@@@@@ABC@@@@@E@C@CAA@A@A@@@AB@B@BCFCBA@A@@@A@A@A@A@@@A@AB@@@BA@AB@BA@AB@@@B@B@BAB@FB@@HAF@B@B@B@BAHAB@B@F@B@FAD@B@DAB@B@B@B@@AB@@@BAB@@AB@@@BC@ABAA@@@@ACAEA@@AA@AA@@C@@BC@A@@@@@A@@C@C@A@C@EAA@A@AAA@AAA@@@C@A@A@EAA@CB@@AB@@AA@@@@AC@AAAEAAA@@@ADABC@@@@BA@A@A@@@@@@@ACE@A@@@AB@@@@@BAB@DA@@BADA@AB@@@@A@@@@CA@@AA@@C@C@A@A@C@@@AA@@AAAA@A@AA@@AA@AA@@CACCA@@@A@AAABA@CBC@C@CBEB@@A@@@A@C@C@@@A@@BA@A@@BA@A@ABA@A@A@A@@@AB@BA@ADABCDABA@@BA@A@A@AB@@@@@B@@ABB@AB@@@B@@A@A@@BA@@BABABAB@@CDCBEDABABCBA@EB@@A@E@AAAAA@@C@ACBABA@AAEAEAIAAAC@AAE@C@C@C@E@E@AA@@A@@A@@AA@@C@@@A@A@AB@@A@A@@@C@@@@BABA@@BABA@@@A@C@I@C@A@@@A@ADCBCBAB@@A@@BA@ABA@@@A@A@CAAA@@A@@@A@@@ABA@A@A@C@A@A@A@@@A@@@AA@@A@@@A@ABA@@@AB@@@@@B@@@@@B@@A@@@ABA@A@@@ABA@A@@@@@@B@@@BA@@@@B@@@BA@@B@@ABAB@@A@A@@@A@@@A@@AAAAA@@@@C@@AA@@@@@ABA@@@@@@@@@AA@@@A@@A@@@@@A@@@@@@@A@@@@@@@@@AB@@@@@@@@A@@@A@@@@@@@A@@@@AA@@@A@@A@@@@A@A@@@A@@@@@A@@@@@@@A@@B@@A@@@A@@@@@A@A@@@@BA@A@@@A@@@@@@A@@@@A@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@A@@@AA@@A@@@@@@@A@@@@@@AA@@@@@AA@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@@BA@@@@@@@@@@@A@@@@@@@@@A@@@A@@@A@@B@@@@@@@@AB@@@@@@A@@@@@A@@@@@A@@@A@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@@@@BA@@@AB@@@@A@@@@BA@@A@@A@@@@@AAA@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@AB@@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@AB@@@@@@AB@@@@@@@@@@A@@@A@@A@@A@@@@@A@@@@@A@A@@@@@@@A@@@@AA@AAA@@AA@@@@@@A@@@@A@@@@@@@ABA@A@@@@@@@A@@@A@@@A@@@A@@@@AA@@@@A@@A@@@@@A@@@@@@@A@AA@@A@@@AA@@@@@@AA@@@AA@@@@AA@@@@A@@@@AA@@@@@A@@@@@@@@@A@@@A@@@@@@@A@@@@@A@@A@@@A@@@A@A@AA@@@@@@A@@@@@AAA@@@@@A@@@@@@AA@@@@@@@@@@A@@@@@@@A@@A@@@@@@@@@@@A@A@A@@@A@@@ABA@A@A@@@A@A@@@@@A@@@@@A@@A@@A@@A@@A@@A@@@@@@A@@A@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@@BA@@@@@@@@@@@@@AA@@@@@@@@@@A@@@@@@@@@@@@@@@AA@@@@@@A@@@A@@@@BA@@AA@@@A@@@A@A@@AA@@@A@@@A@@A@@@@@@A@@A@A@@@A@@@@@@A@@A@@@@@A@@BA@@AA@@@A@@@@@@@@@@@@@A@@@AB@@@@@@A@A@@@A@@@@@A@@@@@A@@@@@A@@@@@@@@@A@@@@@A@A@@@@@A@@@A@@@@@A@@@@AA@A@@@@A@@@@@@A@@@@A@@@@A@A@@@@@A@@@@@@@@A@@@@@@@@@@@AB@@@@AAA@AAA@A@A@ABA@@A@@A@AA@A@A@A@@@A@A@@@AA@@@A@A@@A@@@AAA@C@C@@@AB@B@@A@@BA@@@AB@@A@@@A@A@@BABC@ABA@A@@@ABA@@@@@AA@@AACA@@AAA@A@@@A@@AA@A@@@A@A@A@@@@AA@@@AAA@A@A@A@@@@@AA@A@@CAC@A@@@AAAA@@@@A@@@A@A@@@AA@@@@@AA@A@A@@A@@@@@A@@@@@@AAA@A@A@A@C@E@@@AA@@@@A@@@A@AAA@@A@AB@@@@A@AB@@A@@@@@A@@@@@A@@B@@@AA@@AC@A@A@@AA@@AAEACA@AAA@@A@AA@@AAAA@@BA@@ACAA@@AA@A@@AA@@@A@AB@@AA@A@AAA@@@A@@@A@A@@@@@@AA@@@AA@@A@@AA@A@@@@B@@A@@@C@@@A@ABA@AB@BA@@@AB@@A@@@@B@BA@@@@B@@@@BB@@@BAB@B@DABA@@@C@ABA@C@@@AAAA@@A@AB@@AD@BA@A@A@ABA@A@C@@BA@@@AB@@A@A@A@@BA@@BAB@@A@A@@B@A@@@AAAAAAA@@@AB@@C@@@@AA@@ABA@@@A@A@A@A@@@A@AA@@@A@@@@@A@@C@@ACA@@A@@A@A@@A@@@A@CAA@@@@@A@A@A@@AA@@@@@A@AB@@A@A@A@AA@@AA@@@A@@@A@@AAAAAA@@@A@AAAB@@A@@@@BA@@AA@@A@@AA@@@@AAA@@A@@AAA@@@A@A@@@A@@A@@AA@A@@@A@@@A@@@@AA@AACAAA@@A@@@A@A@@BA@@@A@@@ECAAAACEAC@@@@@@@@CA@A@@@ABAB@@A@@@@@@@AAA@C@AAA@AAAAA@@A@A@A@@@@A@A@@@@@ABA@A@@@A@A@@@A@@@A@@@@A@@A@@@A@@A@AAAA@@AC@A@@BA@ABC@@@@@A@@B@BA@@@@@@@AB@@@B@@@@A@A@AB@@ABA@A@A@@@@@@@@@ABA@@@@@A@@BAB@@AB@@@D@B@@@B@BAB@@@BA@@@@@AA@AA@@@A@@@A@@BABADA@ABABABAB@BAB@@@B@B@D@@@DAD@@@@@BA@@AA@@AAC@A@@@AAA@ACAA@A@AAAA@CA@@@@@A@A@@@A@A@@BA@@B@@A@A@A@A@@@ABC@@@AB@@@@@@@@AB@@A@A@@BA@@BABA@ABCBCB@@A@ABABC@AB@@@@AA@BA@A@A@@@A@@@AA@@@@@A@@A@A@@@AB@DA@@@@BA@@@A@A@@@A@@@@@AB@@@B@B@@A@@@@B@@@@A@@BAB@@AB@@@B@@A@@@@@@@@A@@@@@AAC@A@A@A@@@CAC@AAA@A@A@AA@@@@A@@A@@@@@CA@@@A@CAA@ABC@@@C@E@A@@@@@A@@@A@@@@@A@@@AA@AAAA@@@A@A@@@@@@@A@@@@@GCAA@A@EBA@@@A@@@@@@@@@AA@@A@@@AB@@A@@A@@A@@BA@A@@@A@@@AAA@@@A@@@@@@AA@A@@@A@@AA@@@@A@@@A@AA@@A@A@@@@@AA@@@@@@A@@@@@A@@@A@@@AA@@@AAA@AA@@@AAA@A@@@@AA@CAG@@@A@@@A@@@A@@@@@AA@@AA@A@@A@@@A@A@@@@@CDAB@@AB@@@B@B@@@B@@@B@@@@A@@@@@A@@BCDA@@BA@A@@@CAA@A@@@A@@@CBABA@@@A@ABCB@BA@@@@B@@@@B@@B@@@@@B@@@B@@@B@@@@@B@@@@@B@@@@@@C@A@@@@BAB@@AB@@A@C@E@A@A@A@A@@@A@C@A@ABC@@@C@AAC@CAA@A@A@EBA@A@A@@BAB@@A@@@A@@@AAC@@@A@@@A@@@CBAB@@@@AAA@@@ABA@AA@@A@@@@A@@AAA@C@A@@@A@@BA@A@@@AB@@CBA@@@A@A@@@@CA@@@AAA@AA@A@@@A@A@@@A@@@A@@@@A@@AA@A@A@A@@@AB@B@@@@@@A@@B@@AB@B@@@@@@A@@@@@A@@@A@@@@@@@A@@B@@@@BB@@@@@BA@@@A@@@@@@@AA@@@@A@@B@@A@@@@B@@@@@B@@@BA@@@@@@@A@@@A@@@A@@@@@A@@@AD@@@@@B@B@@@@A@@@@@@@A@@@@@@@A@@@A@@BA@@AA@A@A@@@A@A@A@@@@@A@CA@@@@A@@A@B@@A@@@@B@@@@A@@@@@@A@@@@AA@@@@@@@@@AA@A@@A@@@@A@@@A@@@A@@@A@A@A@@@A@@@A@@A@@AA@@A@@@@@A@@@C@@@@@AB@@IgGWO]MgkGmAs@·@@GUcC³BQUy@m@@C@@A@EACAE@C@A@AA@@AA@AA@@A@@@@BA@@@@@@@ABA@AA@@A@@@@BA@@BAB@@@@AB@@A@@@@@A@@BA@@@@@AB@@@@@BA@@@AB@@@@@@@@@@@@A@@@@BC@A@@@@@@@@AAAA@@AA@@@@@@@@AB@@A@@@A@@@@AA@CAAA@@A@@@CAA@CAA@@@@@@@@A@A@@AA@@AAAAAAAA@@AACAA@@@AAA@AA@AA@@@@AAA@@@@@@@A@@@A@@@@@@@A@@@AA@@AA@@@@A@@@@@A@@@A@@@AAA@@@A@@A@@AA@AA@AA@@@A@@@@@A@@@A@@@@AA@@@@A@ABA@@@A@A@@@A@@@AB@@@@A@ABA@ABA@@@@AC@@ACA@@@@A@@B@@A@@@@AA@AA@@AB@BAB@BABA@@B@@A@@BA@@@@BA@@@A@@BA@A@A@AB@@@@AB@@@B@@A@@BA@@@@BA@@B@@@BA@@"],encodeOffsets:[[131181,43944]]}},{type:"Feature",id:"222404",properties:{name:"珲春市",cp:[130.366036,42.862821],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@AA@@A@@@@C@A@C@A@A@A@AAAAAAA@AAA@A@C@AAA@AAA@@AA@@@@AAA@A@A@A@@AA@AAAA@AAA@@@@AAC@@AC@A@A@@@A@@BA@@B@@@@@B@BA@@B@@A@@@AAA@A@@BA@A@@@A@A@AB@@@@A@@@@@@@@@@@@@AA@@AB@@@@A@@BA@@@A@AA@BA@@@A@@AA@@@A@@@A@@@A@@@@@A@@@@@@A@ABC@@A@B@@@BABABA@ABA@ABA@C@A@A@@@AA@@A@@@CBA@AAA@A@A@@AABAA@@A@@@A@@@@@AA@@@@A@AA@@A@@AA@AB@@A@@AA@@@A@ABA@AB@A@@A@@@A@@@A@A@CA@@A@@@A@@@A@AA@@AAA@@@@A@A@A@@@A@A@A@@@A@A@@B@@A@@B@@@@@@ABA@A@@B@@A@@@@@@@A@@@@@A@ABA@@@@@@C@@@A@@A@@A@A@@@AA@@@A@@AA@@@@AA@@@A@@@AA@A@@@A@@@AA@@@@@@AA@@@A@@@AA@@@@@A@@@@AA@@A@@@A@@AA@@@A@@@A@A@A@A@A@@AA@@@@AA@AAAA@AA@A@@AA@CAA@AB@@@B@B@B@@@@@B@@@BA@@@@B@@@@AA@@AA@@A@@@@@A@@B@@@@A@@B@@@B@B@B@@BB@@@@@BA@@@@BA@@@A@A@A@@@A@@@AB@BA@@B@@@@@BA@@@@@@A@@A@@@@@@BAB@@A@@@@@@AAA@@@@@@A@@@ABA@@@A@@A@@A@@@@@@@A@@@A@@A@@@@AA@A@@@@AA@@A@@@@A@@A@@BA@C@@@A@@A@@AB@@@@@B@@@B@@@B@@@@@BA@@@@@A@@@@AA@@@@BA@@B@@A@@@@@AB@@A@@@A@@@@@AA@@@@AA@@AA@@@A@@@@AA@@AB@@@BAB@BA@@@@@@AA@@@@B@@AB@B@BAB@B@D@BA@@@@B@@A@@@@BA@@BA@@B@@@BBB@@@B@B@B@@@@@B@@A@@B@@@B@@@@BB@@@BBB@B@@@B@@@D@@@B@@@BB@@B@BA@@@@B@@@B@@@@@BA@@@AA@CA@AA@@A@AAA@@@A@@AA@@AAAA@@A@@A@@B@@A@@@@@@@@@AAA@@@A@@@AB@@@@AB@@@A@@A@@A@@A@@@AA@@A@@@@@@B@B@@@B@B@B@@@@A@@@@@AAA@@@@AA@@@A@@AA@@@A@@BA@ABA@@AA@@ABC@C@A@@AA@AAAA@@A@A@A@@@@@A@@A@@A@@AA@@@A@AA@@@A@@@A@@@@@AA@@A@@@@A@@@AA@@@A@@@@@@AA@@@AA@@AA@A@A@AAA@@@@AA@@@@A@@@@@A@@@A@@AA@@@AA@A@@@@@A@AB@@CB@@A@A@E@@@ABA@@@A@C@C@A@A@A@AAA@@AA@A@@@A@@@A@ABA@@BA@@@@@@@@@AB@@A@@@AB@BA@AB@B@@C@A@@@AB@@@@A@A@A@@@ABCBA@@@@@AA@@A@CAA@@@@A@AAC@@@AA@AA@@A@A@A@ABA@@@@B@@@@C@AB@@C@A@@@ABAB@BAB@BA@@@@@AA@@AAA@AAAA@@@@A@@B@@AB@@AB@@A@ABAB@@AB@B@@@@A@A@A@@@AAA@@A@AA@AA@AA@@AA@AAAA@AA@A@@AA@A@@AA@AAA@AA@@@@@AA@@@AA@@@@@ABA@@BA@@@AB@@@BAB@@ABA@@@AB@@@BA@@@@@AA@@A@@@A@@BAA@BA@@@@@ABA@@AA@@@A@C@@@@@@D@@AB@B@@@@A@@@A@@@AB@@@BA@A@@B@@@BAB@DA@@@@BABA@@@@@A@A@@@AAAB@@@@A@@@@@A@CB@@A@@BA@@B@@AB@B@@@@@@ABA@@@AB@@A@A@A@AB@@A@@@@@A@@@@@A@@A@@@@@AA@A@@A@@@@@A@A@A@A@AAC@A@A@A@A@AB@A@@A@A@AAA@A@ABA@@@CB@@AB@@A@@@A@A@@BA@AA@@C@@AA@@@A@EA@@A@@@A@@@@@@AA@@AA@@AA@@@A@@@@@@@A@@@ABA@@@A@@@ABA@@@A@@@AAA@A@@@@@@AA@@@@@AA@@A@@@@@A@@@CA@@A@@@@@A@@@A@@@@@A@@@A@@@A@AB@@A@@BAB@BABAB@@ABA@ABA@@@A@@BA@@@@BA@@@AAA@@@@@C@A@@@A@A@ABA@@@ABA@@BABA@@B@@A@@@A@AB@@AB@@@B@@@B@@@@A@AB@@A@@BA@@B@@AAA@AAA@A@AAA@@@A@@@A@@BA@@@C@A@@@A@A@A@@@A@@@A@A@A@@@AA@@@A@@@A@@A@A@@@AA@@CA@AA@@@A@AAA@@@AB@@A@@@@@A@A@AB@@ABA@AA@@@@@AAA@@@@AAAA@@@A@@@A@A@CAA@AA@@AA@A@CAA@@@A@@A@@@@AA@AA@@AA@@@AA@@A@A@A@A@C@A@A@A@ABABA@@@A@ABA@ABA@@BA@@@A@@BA@@BAB@@@B@@@BAB@@@@@BA@A@A@AAA@A@AA@@A@A@A@A@@@CAA@A@@@A@A@@@@BAB@BAB@@@B@@@B@@@@@B@@@BA@@@AB@@A@@@A@@@@B@@@@@BA@@BA@AB@@A@AB@@A@@B@@@BA@@BA@@B@BA@@BA@A@@@A@AAA@@@@@A@A@AB@@@@AB@@A@@@A@A@A@C@@@A@A@C@@@A@AAA@@A@BC@A@@@CA@@A@@BA@@@A@@@ABAA@@A@@@@@A@@AA@A@A@AA@@A@@@A@C@A@A@@@A@@A@@A@@AA@@@@AA@@@@A@@AAA@@@@@AA@@AA@@A@A@A@@@A@@AA@@@@AA@AACA@AAA@@@@@ADABAB@@@B@@A@@@@@@@@@A@@@A@@A@AA@@@@A@@AA@@@@A@@@A@A@@AA@AC@@A@AA@@A@C@A@AB@@@BA@AAA@A@@B@@A@@@A@@A@A@AA@AA@AA@@A@CBA@@@A@@@C@CC@@A@@@AB@@C@ABA@@@AB@@A@A@@@ABA@A@@@AB@BA@AB@BA@@BA@AAC@AAA@@@A@@@A@C@A@A@AAA@A@A@@AAA@@AA@@AA@A@@ACAA@@@AA@A@@A@@A@@@A@AA@@A@AA@@A@@@A@C@@@C@A@A@AB@@A@@B@@@D@@AB@B@B@@B@@B@BA@@BABABA@ABABA@AB@@A@@@A@C@A@A@A@@@A@A@A@@@A@@@A@A@@@ABA@@@@BA@A@A@@BA@ABC@A@@@@@A@A@CAA@@@A@C@C@@@A@CAA@A@AAC@@@ABA@@@A@A@@AAAAA@@@@A@A@@@A@@A@@A@@A@@AB@@A@@AC@AAAAA@@@A@@@@@AAAA@@AA@@A@@A@A@@@@@@AA@@@@AA@@@@A@AAABA@@@A@A@@@@@A@C@A@CB@@@@A@@@@@CAA@AAAA@@@@AAA@A@@@A@@@A@@A@@@AAA@@A@@@C@@@@@A@A@@A@@AA@@@@C@@@A@AAA@EEEAACC@@@CAA@C@E@A@AAA@A@A@@AAAA@@ABA@@A@@A@@ABA@@@A@@@ABA@@@A@AB@BA@ABA@@@A@A@A@@AA@CB@@A@@AA@A@@AABA@@AA@A@A@A@@@A@@A@@AA@@@AA@@@@A@A@@A@@@AAA@A@ACA@ABAA@@A@@@@A@A@@AAAA@AAA@@AA@@@@AA@A@@@@@A@@@@A@AAA@AAA@AA@@A@@AA@@@@@@@@A@A@@@@@@AA@@A@@@@@@A@@@@A@@AA@AA@@A@AA@@A@A@A@@AC@A@A@@@A@A@A@@@A@A@AA@@A@ABA@@@@@A@AAA@@@A@@@A@@AA@AA@@A@@@A@@@@@@@@@A@AA@@A@AA@@A@AA@@A@@@A@@A@@@@A@@@A@@@@A@@@A@@@A@@A@@@A@@A@@@@@@A@@@A@@AA@@@@@ABA@E@AA@@@@CBA@A@AAA@A@A@A@@AA@@@A@A@@AAAA@C@A@@@ABA@A@@@@A@@A@@BA@@A@@AA@A@A@@@@@@@A@@@A@@@AA@@A@A@A@AA@AA@@@@A@@@@@A@A@@@@@A@@@@AA@@@A@A@@AA@@@@AA@@@AAA@@@A@@@A@A@@@A@AAEA@@A@@AA@A@AAAAC@@@A@AAA@@AAAAA@AA@AAA@A@A@@A@AAA@A@A@A@A@A@A@@@@@AB@@AB@@A@@@A@@@AB@@@@A@@AA@@@A@@A@AB@@A@@A@@A@@@A@ABA@@@A@A@@@A@@@A@CBA@A@A@A@A@@@A@A@A@AAA@AAA@CA@@@AA@@AB@@@@@B@B@B@B@B@B@@@@@BA@@B@@@@AB@@@@A@@B@@A@@@@@@@A@@B@@ABAB@@A@@@A@A@@@@@A@@@AB@@A@@B@B@@AB@BA@AB@@@@@@A@@B@@AB@@AB@B@BA@A@@BB@ABBB@@@B@@BB@B@@@@@B@@@B@BBB@BBB@@@B@@@@AB@B@@AB@@ABA@AB@DAB@B@B@BAB@B@B@B@@@B@BB@@BA@@@@@A@@@@@AB@@A@@BA@@BA@A@@@A@@@A@@@A@@@@B@B@@AB@@@BA@AB@@A@AB@@A@@@@BAB@B@DBB@B@BBB@BBB@@BB@@B@@B@BA@@B@B@B@B@@@B@@@B@B@B@@@B@B@@@@@B@@@@@BAB@@@@@B@B@B@@@@@@@BB@@BBB@@@@@B@BB@@B@BB@@@@B@@@B@@@B@@@@@@@B@B@@@@@BB@@@@@@BD@B@@B@@@@AB@@@B@@@B@B@B@B@B@@@B@@@@@BB@@@@BB@@B@BB@@BBB@@@B@B@DBBBB@B@@@@@@@B@@A@@B@BA@@B@@@B@@@B@@@B@BA@@B@B@BA@@B@B@@@B@BA@@@@@@@AB@@A@@BAB@BABA@@@@B@B@@@D@@@@@@AB@BA@@B@@@B@@@B@@@D@B@B@@AB@BA@@B@B@@@B@@AB@BA@@B@BA@@@@@AB@@@BA@@@@@ABA@@BA@@@AB@@A@@B@BAB@B@BAB@B@BAD@B@BAB@@AB@@@BA@@@A@@B@@@@@BA@@B@@A@@@@@A@@@A@@B@BA@@@@@@@@BA@@@A@@@A@@BA@@@A@@BA@A@@@@@A@@B@@@BA@@@A@AB@@A@@@@B@@A@@@A@@@@@A@@@@@@@A@@@A@@BA@@@@@A@@@A@ABA@@BA@@@@@A@AA@@A@A@@BA@@B@@@BA@@BAB@@A@@B@@@B@B@@@@@@@@@@A@@B@@AB@@@@A@A@@@@@A@@@@@@B@@AB@@@B@@BB@D@BA@@B@B@@@B@@A@@@@B@@@@A@@@@@AA@@A@@@@@A@@@@B@@A@@@@@@@@@AA@@@@A@@@@@AB@@@@@@@BA@@B@@@@A@@@@@A@@@A@@AA@A@A@@AA@@@A@AB@BCBA@A@A@@@@BA@@B@@AB@@AB@BAB@BA@AB@@A@A@@@@@AB@@@AA@@AA@@@@@A@@B@@A@@A@@@@AAA@@@A@A@@B@@A@@@A@@@@AAA@@@A@@@A@AAA@@A@@@@@AB@@A@@@A@@@A@@@@CC@@@AA@@A@@@A@@AA@A@@@@@@@AAA@@@A@@AAA@A@A@A@@A@@A@AA@@@@AA@AAACA@AA@@AA@@@BA@@@A@@@AAA@@@A@A@A@A@A@A@AABA@AB@@A@@@ABAB@@A@ABC@@B@@A@@B@@@@AAA@@@A@A@A@ABA@A@A@A@CAA@A@@@A@@@CBA@@@A@@@AAA@@@A@@ACAA@@@@@@@A@@@AA@@A@@@A@A@@@@@@@@A@AA@@@BA@@@AB@@A@@BA@A@A@@@@@A@@@@@@@AB@@A@@B@@A@A@@@@BA@@@AB@@@@@@A@@@@@AA@AAA@@AA@@A@@AA@@@@@C@A@@AA@@A@@@A@@A@@@A@@AA@@AA@@@C@A@C@@@AB@BABAB@@AA@@@@CAA@A@AAA@AAA@A@A@@AA@A@@AA@@BA@@@@@@BAB@@@BA@@BABA@@@@B@@AB@@@BA@@@@BAB@@@B@@AB@@AB@BAB@BAB@BAB@BADA@@BAB@@@@@B@DABADADABAB@BA@@@A@ABC@C@A@CBE@@@A@@@A@@@AB@@@@A@@A@AA@@@@@AA@@@AA@@A@@@@A@@@A@@@@@@@AB@@@AA@@BA@@@@@AA@@@BA@@BA@@@@AA@@@A@@@@B@@A@A@AB@@@AA@@@A@A@AB@@A@A@@B@@@B@BABABAB@@@BA@@@@BA@@@@B@@@B@B@@@B@@@@@B@@@BA@@B@@@@@@@B@@A@@@@@@@A@@B@@@@@B@@A@@@A@@@@@AB@@@@@B@@@@@B@@@B@B@@@B@@@@@@@B@@@BAB@BA@@B@@@B@@AB@B@@@B@@AB@@@@A@@B@@A@@@@BAB@BAB@@@@AB@B@@@@@@@B@@A@@@@HCKG@BWLcJ{DIFCN@JBHJVFPDDFJ@FCDUHYHGFAJ@P@RCNML[^GN@FAXCLGLGLAJ@JJJHRAPIPSJQBOBKAOCMMKOKMGOAE@KJMPMHIDIEEGEGCMGUEO@MFITABKV@NFHVRPNDL]NWFCASEUMMEE@KNKXGTCDIFODYJWNSPIJGLCHAHKRMTQPEBMBWDMDIDEH@HAD@LQPEJBLFHJNBNAJGNCHAFPBHHBFBFIHMHAFDNBP@JNNBLBFBJBN@XHHHHDB@@DBBBB@BB@@@B@B@HBD@DFNBD@B@BABABEDEFEFCBCBGBA@C@E@C@EAgEQ@U@MFYJWDOBQE_MSBWLOHQJKJDJVFZBBHABORAJDHPJTNLJ@JmTKFMBcQOIUGA@DDBD@BCDADCFAD@DFD@D@HFFDF@FDFFHBDFH@F@H@DEDEDGDGDEH@H@DDFCJ@J@JHPJHPRVJ\\NJDLNJLBP@JAJDHHLBJAJQDSGyKkGBwNaEWBAL@H@DGDC@CAAB@B@D@BAB@@BB@@B@BB@@B@@@B@B@@@@B@@B@@B@@B@@B@@B@@BB@@BB@B@B@@@B@@@B@@@@@BA@@B@BA@@B@@@@@B@BA@@BAB@@@B@@@BA@BB@@AB@@@B@@@B@B@@AB@@@B@B@@@@BB@@@@B@@@B@@@@@@B@B@B@@@@@BB@@B@@@@BB@@BBB@@@@@@@@BB@@B@@@BB@@@@B@@BBBB@B@@@@B@@@@@@BA@@DCBAFA@AB@ACB@@@D@F@FAB@F@B@BBF@DB@@B@@@@B@@@@AD@B@B@B@@@BBBBD@BB@@BB@B@@@B@B@@@B@@CB@BABA@@BA@@D@H@B@@@BA@@@@@@FEBA@A@BJDHDB@BDBBBBBDDDDBD@DBDBBBB@D@@@B@B@@@@@B@@@BAB@BADCB@@@@@B@@@B@@@B@@@B@@@B@@@B@BADAB@@@@@B@@@B@D@JB@@F@D@@@B@B@B@@@B@@BDDB@@@@@@@@B@@@@@@AB@B@D@B@B@B@@@BBB@B@B@BABAB@BABA@@@@B@@@BB@@@@B@@@@@@@@@@@BA@@B@@@@A@@@@BB@@BB@B@B@@@BBBB@@@B@BD@BBB@BAB@B@B@@@D@B@B@@AB@D@B@@@@@@@@B@@AB@BA@@@@B@@A@@B@@AB@B@@BBB@BB@@BB@@B@B@B@BAB@B@B@B@B@D@B@B@@@B@BA@AB@B@B@@AD@B@BA@@B@BA@A@ABA@@BA@@B@@BBB@BBB@@BB@@B@BB@@B@B@B@B@D@B@BBB@B@@@@@B@@AB@@@@@B@@A@@B@@AD@@@@@B@@@@@BBB@@BB@@@BDB@@@BBD@B@FAD@B@F@D@BBB@BB@@@@D@BBB@@@B@@@@@B@B@DA@@B@B@BB@@B@BB@BBBBB@BB@BBBBBBBBB@BBDBB@@@DBB@B@@@@@B@@@@@@@@@B@@@@BBBB@@B@@B@DBB@D@B@@B@@B@@@@B@@@BB@@@BBB@@@@@B@@@@B@@BB@@ABB@@@@@@AB@BAB@BAB@B@BAB@@@B@B@B@B@B@B@B@BBB@B@BAD@@@B@BA@@B@@@BAB@B@@@B@B@@@BB@@B@@@BBB@B@B@D@DA@@B@@@B@@BAB@D@B@@@@@B@B@BB@@BB@DBB@FDB@@BBB@@BBFFBB@@@BBBDB@@B@BBDBDBD@BBB@B@@AJABABAB@B@BAFCB@DAD@D@DBB@B@BBBB@B@B@B@B@@BB@@BD@B@B@B@BBBBB@BBB@BB@@BB@B@@@B@D@BAB@B@B@BAB@B@DAFADAHCDA@@BABA@@B@DBFDDBBB@@D@DBB@DBB@@BB@FAD@B@B@@@B@D@D@DBB@DBB@B@B@DABBB@BBB@D@B@@@B@@@A@@AAAA@@C@A@A@A@C@A@ABCAA@C@ABABCBCBA@A@@@@@A@@BA@@@@@A@@B@D@B@D@BAD@D@B@@@DABA@AAA@AA@@@@A@@@A@A@@@AB@@AB@BABADA@@DAB@B@F@B@D@B@B@BAB@BA@@DBD@D@B@BABAF@D@FABBB@BB@@B@@@@@@AAA@A@A@ABA@CDKBC@AD@DAJBHAH@B@D@@@BAB@BABAB@@AB@D@D@@@B@BABA@@DA@@B@@@DBB@B@B@B@@@BAD@@@BAB@BAD@BA@@B@D@BBB@B@B@B@D@B@D@D@@@D@B@@@D@@@DAB@@@B@D@B@@@BABA@@BAB@@@BAB@@@BAB@B@@@BABAB@B@BAB@@@D@BA@@B@@A@@@@@AB@@@@@B@@AB@@A@@B@@@DAB@B@D@B@@@B@B@@A@@B@BAB@B@@AB@B@@AB@D@B@B@B@B@BAB@B@@@@AB@@AB@B@B@D@BA@@B@DA@@B@B@BB@BBBB@@BB@@BBBB@@@B@@@@@BAB@BAB@@AB@B@@@BBD@BBBBBBB@BB@@@@BAB@DCB@@@D@@@@@BA@@B@B@BA@@B@BBB@B@BBB@@@BBD@BB@@@@@BBB@B@@BB@B@@@BAB@@@B@B@BBDBBBDB@B@B@B@D@@@DB@@B@D@D@B@B@B@B@@@@B@@@B@@@B@@@@@BD@BBBBBBB@D@BB@@BB@BA@ABABAB@@@D@B@B@B@B@BAB@BAB@@@BA@@@@@@@@BB@@@@@@B@B@B@B@@@B@BBB@B@@@B@@@B@@ABA@ABAB@@@@@@@@DD@@@BBB@BBB@@BB@BBB@@@@@B@@@B@@AB@@B@BD@BDD@BDBBBBB@B@@@BB@@BBB@@@@@B@@@B@@@B@@@B@@B@@BDB@@BB@@@BB@@B@B@@@B@@@@@BA@CB@@@@@@@B@B@B@@BB@BBBBBB@BB@BB@@BBB@@@@BB@BB@B@B@BBBB@@B@@@B@BB@@BB@BBBDBBBDBDDDB@@@@@B@@@@@BA@@B@@@BA@@@@BA@@@AB@@A@@@@B@@@B@@@D@B@BABAFABGFCBAB@BBBHFBB@@@BBB@B@@@B@@ABAB@@@B@B@@B@@B@@@@@B@BA@@BA@ABA@AB@@@@BB@@@@@@@B@B@B@B@B@B@@AB@@AB@B@B@BAB@B@BA@@@@@A@@B@@@@AB@@@@A@@@@@@@A@E@A@A@@@@@@@@@@@@B@@A@@@@B@@@@@@AB@@A@@@AB@@AB@@AB@@ABA@@@CB@BAB@@AB@@ABC@ABA@A@A@A@A@@@CBADABA@@BB@B@@B@ABB@AB@@@@@@@B@@@B@@BB@@@BB@BAD@@@BAB@@BBBBBBBB@@B@BBDB@@BB@B@B@@A@@@ABABA@@@@B@@@B@B@BBB@@BBBD@@@@BB@@B@BBB@@BD@DBF@F@B@@@B@B@BBBBFDBDBBBB@BBD@@BBBBBBB@@B@D@@@B@B@BB@@BBBBBBBDB@@B@@BB@@@BB@@B@BAB@B@@@@B@@B@@B@@B@@@B@B@B@B@B@B@B@@@@@@@@@@@@@@A@@@@@AB@@AB@BA@@BADABAB@@AB@BA@@B@B@@@B@@@B@@A@@B@@@B@@AB@@@@@D@DBB@B@BB@@BBB@@@@@BA@@BA@@BABAB@DADAD@B@B@F@DB@@B@B@@@DB@@B@B@BBD@B@BBD@BBD@@BD@@@B@@A@@@A@@@AB@@A@@BA@@BAB@@@B@B@B@B@B@B@B@DADAB@FABABAB@BABA@A@C@A@AAA@A@@@A@@BAB@@@@@B@@@F@DBBBDBDBB@@@BB@@B@BB@@BD@@BB@@@AD@FADAB@B@@@B@@BBD@B@@@B@D@@BD@B@BBB@@B@@B@@@@D@B@@@B@B@@@B@@@B@@BB@B@@B@@B@@BB@@@@@B@@@B@B@@AD@D@@@D@@@@@BABBB@@@DB@@@@@@@@BB@@@@@@@B@@@@@B@B@@@@BB@@@@BBD@B@D@FBFBB@B@BBB@@@DBDB@@B@@@@BB@@@@@@B@@@@@@@@BB@@@B@@BB@@@@@@@B@@@@@@@BB@@@@B@@@B@@@@@@BB@@@@@@@B@@@@B@@@@BB@@@@B@@B@@@@@@B@@@@@@@@B@@B@@@@@@@@@@B@@B@@@@@@@@BB@@@@@@@@BB@@@@@@@@@@BB@@@B@@@@@@@@BB@@@@@@@@@B@@@@@@@@@@B@@B@@@@B@@@B@@@@@B@@@@@@@BB@@@@@@B@@B@@@@B@@@@@@B@@@@@@@@@@@B@@@@@B@@@@@@@BB@@B@@@@B@@@@B@@B@@@@@BB@@@@B@@@@BB@@@@@BB@@B@@@@BB@@@@@@@@BB@@@@@@@@B@@@@@@@@B@@B@@@@@@@BB@@@@@@B@@@@B@@@@@BB@@@@@@B@@@@@@@@@B@@@@B@@@B@@B@@@@@@B@@B@@@@@D@BB@@B@@@B@B@B@B@@AB@@AB@@@@@@B@@@B@@@B@@BB@@@@@@@B@@@@@B@@@@BB@@BB@BBB@@B@@@BBB@B@B@B@B@B@BB@@B@B@BB@@B@@BB@@@BBB@B@B@BBD@DBB@DBB@BB@@BBBBB@BBBBB@BBB@BBDBBB@@@@@@B@@@@D@@@@@@@@AB@@B@@BBBB@@@DBD@DAB@@@D@DBB@D@BAB@@@DB@@@@B@D@D@B@@@B@@@B@PKB@BAD@@ADCFCFEBCHCNE@@BAD@DAD@BADAB@BEDEDC@ABCFCDCLKFCFCBAD@@ADA@@LEFADAB@@@B@D@B@B@B@FAHC@@B@@A@ABA@ADA@@BA@CAC@A@ABCBADAJEBA@@@@@@B@@@@@B@BA@@B@@@@@@A@@B@B@B@B@@@@@@@@@B@@@@B@@@B@@@@@@BBB@BB@@@@@@B@@@@@@@B@DAB@BA@@@@@@B@@@@@@@@@B@@@@B@@@@BB@@@@@@@@@@B@@@@@B@B@@@@@@@B@@@@B@@@@B@@@BB@@BBB@BBBBDB@@@BBBB@BBBBB@@@BB@@@@@BB@@BBB@@@@@@BB@@@@B@BBB@D@B@@@@@DADAB@BAB@BABA@@BA@@@@@AB@@@@@@@@BB@@@@@@@@@BB@@@@@@B@@@@@@@@@@BB@@@@@@@B@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@BB@B@B@@@@BB@@BB@DBB@B@@AB@B@@@@@B@@@@@@@BB@@@@@@@@@B@B@B@BBB@B@@@F@B@B@@@BBB@@@DB@@B@@@B@@@D@@@B@@@D@B@B@B@BBB@@BBBB@@BBBB@@BBBBB@@@@@@@B@@@@AB@BA@AD@@@@@B@@@@@@@@@@BB@@@B@@@B@@@B@@@@@@@BB@@@B@B@D@DBB@B@BB@@@@B@B@@AB@B@B@BA@@B@B@B@B@@@@@B@@@@A@@BABA@@@AB@@@@@@@BA@@B@@@B@@@DABAB@B@@@B@BAD@B@D@BBD@D@B@@@BAD@B@B@B@@@B@B@BBD@D@B@B@@BB@@B@BB@@@@BA@@BA@@@A@CBA@A@@B@@@@@@@@@@@@BBB@@BB@@@@@@@B@H@B@NBD@@@@@@B@@@B@@@F@F@@@@@@EFA@EF@@CB@B@@@@@@@@@@@BBB@@@BB@@BBB@@B@BB@@DB@B@@BAB@D@B@B@BAB@@AB@B@BBB@@@B@@AB@@@B@@@BABABC@@@A@C@AAA@A@@AAAAAA@AAA@@AA@@AA@@BA@@BCA@@AAAAA@A@C@@@A@@BA@A@A@@AA@AAA@@AAAA@@BA@A@@@@BAB@@A@@BAA@@A@@AAA@@AA@@AA@A@A@A@@@AAA@@@@@A@A@@AAA@@@A@@AA@A@@AA@@@@@A@@@AAA@@@@@AA@@@A@@@AA@A@@BA@@@AA@@@@ABA@@BABA@@BA@@@@@A@@AA@@@A@C@A@A@@@A@@@AB@@@@ABA@@@@@@BAA@@A@@@A@@BAB@@AB@@@BAB@@AB@@@B@@@B@BAB@B@@@BA@@B@BA@@@A@A@A@A@A@@@@AA@@A@@@@C@@@@@A@A@A@A@@@@DA@AB@@A@@B@@A@@@A@AB@@A@@@AC@A@@A@@@AA@@A@A@@@A@@A@A@@AA@A@AAA@@@C@@@A@A@@@@@A@@A@@@A@@AA@@@A@@@A@@@ABAA@@@AA@@AAA@@@A@A@A@AB@@A@@@AB@@@@A@AA@@A@AB@@@@@@A@CA@@AAA@AAA@AAA@@A@@@A@A@A@@AA@@AA@@AA@A@@@AA@@@AA@A@A@@@ABA@@@A@@@A@@@@@@AAA@@@@A@@@AA@@AA@@A@@@A@A@@@CB@@@AA@A@A@@@A@A@@@A@@@AA@@A@@@A@@AA@@AA@@A@@A@@@C@@@A@@AAA@@A@@AA@@BA@A@@@@@A@@AA@@@@AA@@@A@@@A@ABA@@BA@A@A@A@AA@@A@@@AA@@@B@@@B@@@B@B@BA@@BA@@@@@A@A@@@AAA@@AAC@AAAA@@@A@@BABA@@@@@A@A@@@AB@@A@@@@@A@A@@@A@A@A@@BA@@@@@A@@@A@@@A@@@AA@AA@A@@@AA@@A@@A@AAA@A@AAA@AAA@@@AA@@AA@@@ABC@A@@AA@@A@AA@@@A@@@@@A@AB@@A@A@A@A@A@A@A@ABA@AA@@C@@@C@@A@@@AAA@A@A@A@@AA@AA@@A@A@@@A@@AA@AA@A@@A@AA@@CAA@A@A@@AAAAAA@@@@A@@@A@A@A@@@A@@@A@@@AABA@A@ABA@AA@@A@AAACA@@@AAA@@@C@C@A@@AA@@@BAAA@@A@A@C@AA@@@@AA@@AAAAAAA@@A@@@@BA@@AA@@@AA@@A@@@A@AA@@AAA@@@AB@@A@@B@@A@@@A@AB@@A@@@@@A@@AAAA@AA@@@@AAC@AAA@CA@@@BA@A@@@A@C@@@@@ABA@@@@@A@@@A@@B@@A@@BA@@B@@A@@@@B@D@B@B@B@@@B@@ABA@AB@@@BA@@B@@@@@@A@@BA@@@AB@BABA@@@@@AA@@A@ABA@@@A@@B@@@@A@@@AB@@A@@@AA@@A@@CAAAAAAA@A@@@A@@@A@@@A@@AA@@AAAAA@@AA@AA@@@A@@BABA@@@@@A@@@@@AB@@A@@@A@ABA@@BA@ABA@@@AB@@A@A@@@A@@@AB@@@BA@@@@@A@AA@BA@@@@@A@@@@A@@A@@A@@@A@@@A@@A@@A@@A@@@@AAA@@A@@@AAC@@@A@@CA@AAC@@@A@@B@@AAA@@@@AACAAA@@AA@AA@@AAAAAA@@AA@AA@@AA@@@A"],encodeOffsets:[[134345,44155]]}},{type:"Feature",id:"222426",properties:{name:"安图县",cp:[128.899772,43.11195],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@C@@@C@ABA@AB@@C@@AA@AAAA@A@@@A@C@@BC@@@A@A@A@@@A@A@A@A@@@AB@BAB@BAB@BA@@BA@@@AAACAACCAGACCCCCCAC@ABCBE@EB@@@@A@@@@@A@@B@@A@@B@B@BA@@B@@AB@@AB@@AD@@AB@D@DBB@B@DAB@B@BAB@D@DAB@B@@@B@DABA@@ACAA@@@ABC@A@@BCBABA@@@A@C@C@A@EAA@C@A@ABA@A@@@@B@B@B@B@BBB@B@B@@@DAB@DADAFABA@@BAB@@ABABA@ABCBABAB@@AD@FA@AB@@@AA@A@@AA@CAA@C@A@A@A@A@A@@BA@A@ABA@A@A@A@ABA@A@@@A@@AA@AA@@@AAC@A@@@@@AC@C@A@@@@BC@CDCBCBA@@@@@A@@AAAA@@@A@@@@BC@C@A@ABA@A@A@@@@BABABABABABABC@@BC@A@ABC@ABEBC@A@@@G@K@AAC@AAC@@AA@AAAAA@A@A@A@A@@A@@AA@@@A@@@A@@B@@A@@@@@@@A@@@AA@@AA@@A@@@@AA@@@@@@B@@A@@@@B@@A@@@@@C@@@@B@@A@@@@@@@@@@B@@@@AB@@@@@@@@@@@@@B@@@@A@@@@@@@A@@@@@@B@@@@@@A@A@IAK@GAE@GAC@G@IAA@E@GBG@E@G@G@ABE@G@E@G@GBE@I@C@EBI@I@E@GBG@E@C@C@EBK@I@KBG@E@G@GBE@E@G@EAG@G@G@E@G@A@A@@@K@I@U@S@M@G@U@S@O@M@KAG@K@I@Q@A@A@A@EAA@GAMAA@AAA@A@A@G@OBE@K@M@C@GBE@C@CAICeQ[TABABABABEBEDCDADCFAD@D@B@B@BBBADCDAD@B@FAB@DADABABABAD@BADA@@BAD@@@B@@@B@D@@AFADCFCHEHCFEFEH@@CBEFABCHADADABA@@BAB@BA@ABA@@BADADADAD@DA@ABGDC@ABCB@BGFEDCBEFC@CD@BAB@@@B@BBBDFBB@B@B@D@@@D@D@@BBDDBB@B@@@B@@@BA@ABCBA@AB@@@BAB@@@B@BA@@@@@C@G@C@A@ABABABADCDABABABEBCBCBCBCBA@CBABA@CBADADCBAD@B@BAD@@EFA@@B@BAD@DCD@B@@BD@@@B@B@B@@ABCD@@@BBB@DBD@B@@@@@@@B@@@B@@@B@@@B@@@@@@AB@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@B@@A@@B@@@B@@@BA@@@@B@@@@@B@@@B@@A@@@@BB@@BA@@@@@A@@@@@@@A@@AA@AA@@A@A@@AA@@@AA@@@@A@@@A@@@ABA@A@A@A@@@A@A@@@@@A@@@@@A@@@@@@@A@@@AB@@A@@BC@@@@BCDAFABAB@D@D@BBB@BAD@BABADAB@@@@BB@BA@@B@@ABCBABA@A@@BAD@DCB@BICAA@@A@GJCFAB@BC@G@CAE@C@@AEAKAA@A@A@CBGFEFCDKJCBA@A@@BBB@BCJAD@D@BBDBDBDBDDDDDBB@BBB@@AB@@@BB@B@B@B@D@B@BABBD@BBD@DBBBDBD@@@BAB@@@B@BB@B@BBBB@@@B@B@B@DADCB@F@B@B@F@NBB@D@F@B@B@JBF@D@JB@@@@@B@@BDBD@@@FDDFFBB@@@BBB@@@@B@@@BBB@DBDB@B@FAD@BADAFBBBH@B@@B@H@B@F@D@D@B@FBDBB@B@B@B@HAB@P@F@D@B@B@BBFDBB@BDBBBDLBD@BDHBFBB@B@BDBBDHBFBLFFBJDFBDBFFDB@B@@BB@@@@DBFBBBD@F@D@@@B@@@@@@@@@BBB@@BBB@DBDBDBDBBB@@BB@@BBDADADAB@B@BBD@DBFBFBD@BBBDDFHHDDDDD@@FFDDBDBFDHBBBBBB@@@B@@@@AB@@CDCFCB@B@D@BCFED@B@@BDDD@B@B@@@@AB@B@B@B@D@BA@@DB@@D@@@P@B@D@BBB@B@B@BA@A@C@@@A@C@A@ABC@@BA@BH@@BBDBBB@BBB@BAB@@A@A@A@AAA@CBA@@B@B@BBFBB@B@DBB@B@B@B@BAB@@@@BBB@@BB@@BDBB@@@@B@@@BA@ABCBCBA@@AC@AACA@AC@A@@BAB@B@@BBBDBBBB@B@BABAB@@AB@BC@ABABAB@@CBC@GBC@CBA@@BAB@BA@@@A@A@@B@@@@@BB@DB@BB@ABAD@BA@ABA@ABCDAB@@@B@BFBB@B@B@B@B@@@@BA@ABCBA@@@@BA@@B@@AB@@A@@@A@@AA@@@AA@@@@@@@@AAA@@@@@ABA@@B@@@@@B@B@BBB@@B@@B@@@B@@@BA@C@@@A@@@@@A@@@A@@@C@A@@@AAA@@@A@@@A@@@A@@@A@@B@@@B@@@@@BB@@B@DB@@B@@B@@@BB@@BBBB@@@B@@@B@@A@A@@@@@AAA@A@C@C@C@A@AAA@AB@@A@@B@B@@@BBB@@@B@B@B@@BB@@@B@@@@@BA@AB@@A@@@A@@BAB@@@@BB@@@B@@@@A@@BAB@@@D@B@@@B@@B@@@@@@BB@@@B@BBB@B@B@D@B@@@B@@@@@BB@@A@@B@@@@@B@B@B@@@DB@@B@BB@@BADCBA@CBC@EBC@@@AB@@ABABC@ABA@E@E@C@CBEDGDAB@BABCBABCBC@CBC@A@C@AAC@AACAA@CAA@A@A@C@C@CBEBEBCBA@CBCBABAB@F@D@D@BBB@BBBD@B@B@FCFCDAB@D@B@BB@@@DABAD@BABABA@AB@@CBABC@@@A@CAA@A@CAA@A@A@A@AB@@A@ABA@CDABAB@B@@BBA@@BCHCDEH@B@BAB@BADABABA@ABABA@ABC@A@@BA@A@AB@BABABAB@D@B@@@B@@B@B@B@BBB@FDD@B@BAB@DCBADAB@DAB@BBB@B@BB@@BAHCHEDCBAD@FCHAB@FAFABAFCFCFCD@DABADCD@B@B@DDBDBD@H@@DDDDBB@FBBBF@BDBFFNJBBDBJBHDDBDDBB@@@F@FAD@@@D@@ABABAD@@@BA@@@@B@@AB@@@B@D@@@B@D@D@B@BCD@BABABAB@B@B@@BBBBBBBBBD@B@B@@@@B@B@BBB@B@@@B@@@B@@@BAB@BAB@@@B@@@B@@@@@@A@@B@@AD@B@BA@@BA@@B@B@BAB@@@@AB@@@B@B@B@@ABA@AB@B@D@BAB@D@B@@@BBB@BBB@@@B@BAD@@@B@@@BA@ABA@@@A@@@C@@@ABA@@BA@@BA@@B@@@B@@A@@DC@@BABABA@@BAB@D@FBD@B@HAFAB@BCBAB@B@B@D@BAB@@AB@@AB@@@@@B@BBB@@@B@@@BAB@@BB@B@BB@@B@@@BA@@BABA@AB@@@B@@@BBB@@B@@BB@@@BB@B@B@@@B@@BB@@BB@B@D@B@DB@@B@@B@@BB@@@@B@B@@@@@B@@BB@@@B@@@B@@BB@BBBDBBB@@BB@@AB@B@B@DA@@DAB@BA@@B@D@BBB@B@@@B@BBB@@@B@B@@@BAD@B@B@@@B@B@DAD@DAB@@AB@BAB@B@D@B@@AB@B@@@BBB@@BB@@@DBHBJDBB@BBF@@@@BBBBBB@@BBBBB@@BBBBBDDBBDDBB@@@B@B@@@BA@ABA@A@@BA@@B@@AB@@AB@@@@BBB@@@@B@@A@AB@@@B@@AB@@AB@@A@ABA@A@C@@@@@@@@BDBDDB@@B@@@B@B@BAB@B@@B@D@@B@@BB@@@D@B@B@B@@@@@B@@@BB@BBBB@@B@@@@BB@BBBBB@@BB@@@BB@B@@B@@BAB@@@BAB@@@B@@BDBB@@BB@@@B@@B@@B@@B@@@@BB@@@@BBB@@@B@@@@@B@B@@@@@B@@@@BB@@B@@@@BB@@@@@@BB@B@@@BBB@B@@@@BB@@@B@BB@@@@B@@B@@@B@@@B@B@@BB@@@BB@@@@@@B@@@B@B@@AB@@@B@@@@@BA@A@A@AB@@@@ABAB@@A@A@@@ABA@A@@@A@@@@@A@A@C@A@A@ABC@@@A@@B@@AB@@@@@@AB@@A@C@A@@@A@@@A@@@@@A@@@AA@@@@A@@@CBA@@@A@AB@@A@@@@@A@@@@@@@A@@@@@@@@B@@@@AB@@A@@BA@@@A@@@@@A@@@A@@@@@A@@@A@A@@@A@@@A@@@@@@@@@@B@@AB@@@@@@BB@@@B@@BB@B@BB@@BBB@B@B@B@@@BBB@B@BBBBD@B@@@B@B@@@@@@BB@@B@@@BBBBBB@@@@@B@@@@A@@BA@@@A@@BA@E@@@A@A@@BA@@@ABA@@@AB@@A@ABCBABABABAB@@AB@@@@@BA@@@CAA@A@A@@@@@AB@@AB@@A@@@AB@@@@AB@@@BA@@@A@@B@@A@@B@B@BAB@@@B@@AB@@@@AB@@@BA@@@ABABA@ABA@@BB@@BBB@@@@@BA@@@@B@@B@BD@@BD@@@@AB@BAB@B@B@@A@@B@@@B@@@B@B@@AB@B@B@@B@@B@@B@@@B@@@@@B@@@B@@BBBB@@BB@@@@B@@BB@B@@@B@@@B@@@@@B@@B@@BB@@B@@@B@@@@@@@B@@@B@@@@@@BB@B@@B@@BBBB@BB@@B@DBBB@@BBBBBBBB@@BB@@@B@B@@@@@@B@DBB@DB@@B@@@BBDBB@@B@@B@@@B@@@BA@@@@@@@@BB@@BBBB@@@@@@@@@BAD@@@@@B@@@@@@@@@@A@@B@@AB@@@@A@@B@@@@AB@@@B@@@@@BA@@B@@A@AB@@AB@@@@@BB@@BAB@B@@@@@@AB@@@@@BB@@BBB@@BBB@D@F@DBFBB@@@@Dn@z@RV´ADVd@H¸@t@nBlHNhP^HXJh@@BA@@@@D@@@B@@@@@B@@@BB@@@BB@@@B@@@B@B@B@@@B@@@B@@@B@@@@@@BB@B@@B@@@@@@@@BB@@@@@B@@@@B@@@@@@A@@B@@@@A@BB@@@@@DBB@@@@@B@B@B@@@B@B@B@@BB@@AB@@@B@@@@@@@B@@@@@@@B@@@@@@A@A@@@@BC@@B@@@@@B@@@B@@@B@@@@@@@B@@A@@@A@@@@@A@@B@@@@AB@@@@@BB@@@@@@B@@@B@@A@@@@AA@@@@@AB@@@@@@@B@@@B@@@@@B@@@@@@@@ABA@@@AB@@@@@@@@ABA@@B@B@B@B@@BB@@@@@@B@@@B@@@B@B@@@BBBB@BB@@B@@D@@B@B@@@B@DA@@BA@@B@B@@AB@@@B@D@B@BB@@@B@@B@@@BBB@BA@@B@BB@@@@BADA@@B@@@B@@@D@BB@@B@@@B@@@BA@AB@B@B@FAB@B@B@DBD@BBD@@@D@BAB@D@B@@@B@B@B@B@F@D@B@@@BA@@BA@A@@B@D@@@@@@@@A@@@@@A@@@@@A@@@A@@@A@@@@@AA@@@@@@A@@B@@ADABAB@@@B@BADA@@B@@@B@B@DB@@B@B@@AB@DC@AB@@@@@B@@@@@@A@@@A@@@A@A@@BA@@BADC@@@@B@B@@@B@@@@BBB@@BB@@@@B@@@B@@@B@@@H@DBB@@B@@B@B@BB@@B@@BBB@B@@BB@@B@@@B@@@@@B@@@@@@@BB@@@@B@B@@@BBB@@@B@@@@@BB@@@B@@@BBB@@@@@@@B@@BB@B@@@B@@@BAB@@@BB@@@@BA@@B@@@BB@@B@@@@@@@@@B@@AB@F@BBBHD@@@@B@@@@@@@B@B@@@B@BB@BBB@@B@@@@@B@@@B@@@@@B@F@D@@@D@BAB@DBB@@@B@@D@@@@@BB@@@@@BBB@B@B@BBD@DB@@B@B@B@D@BB@@@@B@@@@@@@@@@BA@@@A@@BA@AB@@@B@@A@@@@@@BA@A@@@A@@B@@@@@B@@@B@B@@AB@@@@CBA@@B@@@B@BB@@@@@B@@B@@@B@@@B@BABB@@B@@A@@BADAB@B@BA@ADAD@BABAB@@AB@@@B@BA@@B@@@@@@A@@B@@AD@B@@@B@B@B@BA@@@AB@@@B@B@@@B@B@@@@DBB@BB@B@BBBBDB@BB@@B@D@BB@@BB@@AB@@@@C@CB@@C@A@A@@@A@ABA@ABABAB@BCBABAB@@@B@@@B@@BBB@@B@@@@AB@@A@ABA@@@A@C@@@A@@BA@AB@@@B@@@@AB@B@@@@@@@@@B@BAB@BA@@B@B@B@@A@@@A@@B@@@@@@ABA@@@@B@@@@AD@BAB@@@BBD@@BBBBB@@@@B@@@BB@@@@@@B@@@B@@@B@B@@@BAB@B@@@@@B@B@@B@B@B@@@BBBB@BBB@B@DBB@B@@@@@@@BA@AB@B@@@BDB@@@@@@@@BDDFBBBBFD@@B@@@B@@AB@B@@@B@@@BBDBBBB@@B@@B@@@B@@@B@B@@BB@@@@B@@@B@B@@BB@BB@@@BB@B@@B@@BB@@@BB@@AB@@@@@BA@BB@B@B@@BBBBBB@@@B@@@B@@BB@@BBB@B@B@@@BAB@@@@@B@@BB@B@B@@@@@B@DBB@@@B@@@@B@BB@@@DB@BD@@@@B@@@@@B@@BBB@@@B@B@B@B@@@B@BA@@BB@@@@@DA@@B@@BBBBBB@B@@@B@AB@B@@@BA@AB@@AB@B@B@@@BA@@B@@AD@B@B@BAB@B@B@@ABC@@BAB@@@BBBB@@D@B@BAD@@@B@BA@C@ABA@A@@AA@@@@@A@@B@@A@A@@B@@@BA@@B@@ABAB@BAB@@@D@@@B@@@@A@@B@B@@BB@@@BB@@B@@B@@@@B@B@@@B@@@B@BBB@B@@BA@@B@B@@BB@@@BBB@@BBBD@@AB@@BBBB@@BBB@@@BB@BDBFBBB@@BB@@@B@BBD@@BB@@A@@@@B@@@@@B@@@@@BA@@B@B@@A@@B@BB@BBB@@@B@@@@@BB@@F@D@B@B@B@B@BB@@@@@@@B@@@@@BB@B@B@@B@@@@BB@@B@B@@@B@@@@@BBBB@@B@D@DB@@@BBB@@@@B@B@B@B@BB@@B@@B@@B@B@B@@@B@B@@BB@@@B@B@BB@@DBBB@@BB@@@@B@BA@@B@B@BAD@BA@AB@B@@@B@@@BA@@B@@AB@@@@ABA@@D@D@B@BB@@B@@@@B@B@@BB@@B@B@@@B@B@B@B@@B@BB@@@AB@B@B@BBB@BBB@B@@A@@B@@@@@@@@@@@B@@@@@@B@@@@@B@B@@@@@@B@@B@@@@@@@@B@@B@B@@B@@B@@@@@B@@@B@@@@@B@B@@@@@B@@@@@@@@@B@@@@@B@@@@@B@@@@@B@@@B@B@@@@@@@BA@@B@@@@@@@@@@@@@B@@@B@@BB@@AB@@@@@B@@@@B@@@@B@@@B@B@@@@B@@@@B@@@@B@@@B@@BB@@@B@B@@@B@@BB@@AB@@@@@B@@@B@@@@B@@B@@@@@@@@@@@@@@@B@@@@@@@@B@@B@@@@@@@@@@@@AB@@@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@BB@@@@@@@@BB@@@@BB@@@@BB@@@@@B@@@@@B@B@@@B@B@B@BA@@B@@@B@B@B@@@@@@@@@@@B@@@@B@@@@@@@B@@@@@@@@B@@B@@@@B@@@@@B@BB@@@@B@@@@@@@BBB@B@@@B@@@B@@@@B@@@@@B@@@@@@@B@@@B@@@@@@@@@B@@@@BB@@@@@B@@B@@B@@B@@B@@BB@@@@@@BB@@B@@@BBB@@@@@@@B@@@@@B@@@@B@@B@@B@@B@@@B@@@B@@@B@@@@@@@B@B@BA@@@@@@B@@@@@@B@@@@B@@BB@BBB@@B@@B@@@@@@@B@B@@@@@B@@@@@B@@@@BB@@@B@@@@@@@@@@@BA@@@@@@BA@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@@@BA@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@BB@B@@@@@BB@@@AB@@@@@B@@A@@B@@AB@@@@@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@B@@@@@B@@@@@B@@@@@@@BA@@@@@@@@@AB@@@B@@@B@@@@@@@@@B@@@@@@@@@@@B@@A@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@B@@B@@@@BB@@@@@@@B@@@@@@@BB@@B@@@B@@@@@@@@@@@@B@@@@@@@@@@@@B@@B@@@@@@B@@@@B@@@B@B@@A@@B@B@@@@@B@@@B@@@@AB@@@@@@@B@@@@@B@@@B@B@@@@@@BB@@@B@@B@@B@@@@@@@B@@@B@@@@@@@@@BA@@@@@@@@B@@@@@@@B@@@@@B@@@@B@@BB@@@@@@@@B@BA@@@@B@@BD@@@@@BBBB@BB@@@B@@@B@B@@@BABA@@@AB@@A@@@A@@B@@A@@@A@@@@B@B@BA@@B@B@BA@@B@@@@A@@@@@A@@@@BA@@B@BAB@@@B@@@BB@@B@@@B@B@B@D@B@B@B@BA@@B@@@B@@@BBDBB@B@@@B@BAB@@AB@@@BADADABCB@@@B@D@J@D@B@@@B@BA@AB@BA@A@@BCAGACAA@A@@A@A@@B@@A@E@A@AAE@AAA@AA@AAA@@@AA@CAAAA@A@@@A@BABC@@@@@@@A@@@A@A@A@A@A@A@A@@@AB@BA@CDC@A@A@@CA@@A@@A@@@A@AAA@@@@@A@@B@@@BA@@B@@@BBBA@@@@B@BAB@@A@A@@BA@@@@@A@A@@@AB@BAB@@@@@BA@@B@@A@@BC@@@CBAB@@@B@@@@AB@@AB@@@@@BA@@BA@BB@@@@@B@BA@ABA@@DA@@B@@@B@B@B@@@@@B@BABA@@BAB@@BB@BAB@@@B@B@@A@@B@@ABA@@@A@A@@B@@@B@@@B@@@B@@AB@@@@@B@@@@@AA@@@A@@BA@@@@@@@AB@@@@@@@B@A@@@@A@@@A@@@A@@@@@AAAB@@@B@@A@@B@@A@@@@BA@@D@B@BA@@@@@@B@B@@AB@B@@AB@@@@@B@@@B@BAD@D@B@@BB@@@@A@@@ABA@@@AA@AA@@@A@@@@A@@@A@@A@@@@AA@@AA@@A@@@AA@@CC@@AAAA@A@@@A@@@A@A@@BABA@A@@@A@A@@@A@C@@@A@@AAC@A@@@A@A@A@@@A@CCAAAAA@A@@@@A@@BAB@@A@A@ABC@CBABADCBA@A@C@C@@@ABCBEDE@@BABABABA@ABABA@@@@AAAA@@ACA@A@A@AAA@A@@@A@@@A@AAA@AAA@@@A@@A@@@@@@CAA@AAA@@AAA@@@@@A@@@A@@@A@@@A@@@A@@@AA@@@AAA@@@A@@A@@A@@A@@@@@@A@A@A@A@A@A@A@A@@@@AA@@@CAA@A@@@@AA@@AA@AAA@@AA@@BA@C@A@A@@@A@@@AA@@@A@@AA@@@@@AAA@AAA@A@@@ABA@ABAB@@@@AB@@C@@@A@C@@@A@@@@B@@@A@@@A@@@A@A@AA@@@@@A@@AA@@@@B@@ABA@AAA@A@@@AA@@@AA@@AA@@@@AA@A@@@A@A@@@@BABA@@B@@@@@A@@AA@A@@@A@C@A@A@@@AAB@BAB@@@@ABA@@B@@@BA@@@@BA@@@AB@@AB@@A@AB@@A@@@A@@BA@@@@BA@@@A@@@@B@BA@@B@@A@@@A@A@A@@@A@A@@@A@@@@@@@A@@BA@@@@@@B@B@BA@@B@@@BA@@B@B@@@B@B@@@B@B@@@B@@@@@BA@@@@@A@@@AB@@@@A@@@@@@AA@@AA@@@@@@@@@A@@@A@@@@B@@A@@@@@@@AA@@A@@@@@AB@B@@AB@B@@AB@BA@@B@BA@@B@B@B@@@B@B@@@B@@@@A@@@AB@BA@@@AB@@A@@@@B@B@@@BAB@@@B@@@B@@A@@B@@@@@BBB@D@BBB@@@B@B@@@@BB@@@B@BB@@@@BAB@@@B@@@B@B@@@B@@@B@B@B@B@B@B@B@@@B@BAB@BAB@B@B@@@B@B@@BB@@AB@@@B@@@@@B@@@B@@@B@B@BB@@BAB@B@@@B@B@B@BABA@@B@@@B@B@B@@@B@@@B@BAB@@@BA@@B@B@B@@@D@B@@@B@@A@@@A@@B@@@BA@@@AB@@@@@@BB@@BBAB@@@B@B@B@BAB@B@B@B@@@BAB@B@@AB@@AB@@@B@@@@A@@BA@@@A@@@AB@@A@@@@BABA@@B@B@BAB@@@@A@@@A@A@A@@AA@@@ABA@@@AB@@A@AB@@@B@B@B@B@B@@@BA@@BA@A@ABAAA@@@A@A@@AC@@AAAA@A@A@A@@BC@@BA@@BAD@B@B@D@BA@@@A@@AAA@A@CAC@@@AAA@@A@@AAAA@AA@AA@@@@AA@@AA@@A@@@AAA@A@A@A@C@@@A@A@A@@@@@AA@@@AA@@@AAA@@@ABA@@@A@A@@@AB@@@B@BA@@@@@@BA@@@AA@@A@@@@AA@@@A@@@@@ABA@AB@@A@@@A@AA@@A@@@AAA@A@@@@@AA@@AA@@A@@AAA@@@A@A@@AA@A@A@@@A@@@A@@@A@@A@@A@@BA@@@@@A@@@@BA@@@@AA@@@@A@A@@@A@AA@@A@@@A@AA@@A@@@A@@AA@@@A@@AB@@AB@BA@@BA@@@A@@A@@AA@@AA@@AA@@AAA@@BABAB@@AB@@A@A@@@ABA@@@@BA@@@A@@@AB@FAHABAD@BAB@BA@@BA@A@@BA@@@A@@A@AAA@A@@@A@@AA@@@@@@AB@@ABAB@@A@@@@@@@A@@A@A@A@@@@@@A@@@AB@@A@@B@@A@@B@@A@@@A@A@@@@@@AA@@@A@@B@B@@A@@@A@@@@@AB@@@@@@@@ABA@AB@@AB@B@@A@@@A@@@A@@AA@@A@AA@AA@A@@@CBA@@@AB@@A@@@@@A@A@A@@@AAA@C@AA@B@@@BA@@@@@A@@@A@A@@@A@@@A@A@@@A@@@A@@@A@@@AA@@A@A@AAC@AAA@CA@A@AAAAACAAAC@E@E@C@EBABA@@@A@A@AA@@A@A@A@A@A@@@@@AA@@CB@@A@@@@@@@A@@@@@AA@@A@@@A@A@ABA@AB@@C@ABA@AB@@@@A@@AA@@@A@AA@@A@@@A@@@@B@@A@AB@@@@@@A@A@@@A@@@AB@@A@@@@@A@@@A@@@A@AA@@AA@@A@@A@@A@@B@@A@@@A@@@A@A@@@A@@@A@@@@AA@@@A@@@@@A@@@A@@@@@@@@@@@A@@@@@A@@@@@A@@@@@A@@@A@A@@@@BA@@@@AA@@@@@A@A@@@AA@@A@@AA@A@@AA@@@A@@@A@@@@@A@@@AA@@@@A@A@@@A@@@A@@@A@@BA@@@@@A@@@@@A@@@C@@@A@@@A@@@A@A@C@A@A@@B@@@BA@@B@@AB@BA@ABAB@@@B@@@B@@@B@@BBBBBBBBBBB@@B@@@@ABA@@BA@@BAB@B@@@B@@A@@@A@CAC@AAA@A@A@@AA@@@@AA@@A@@AA@@@AA@@AA@@@AAA@AB@@A@@@A@@@AA@@A@@@@@BA@@BA@@BA@@AA@A@@@A@@@@@A@@@AA@@@AA@@@AA@@@C@A@A@CA@@A@AA@@@AA@@@A@A@@BA@@@A@A@A@C@@@AAA@AA@@A@@AABAAE@@@A@A@@BA@A@AA@AAAA@AAA@@AA@@AA@AAA@@@@A@@@A@AB@@A@@@A@@@A@@BA@@BA@@B@@AB@@@B@@@@A@@@A@@@A@@@A@@@A@@AA@@AAA@A@CAA@A@CBA@A@@@C@C@@AABA@@@CDE@AB@@AB@@AB@@@@@AA@@@A@A@C@CAA@@@AAAAAAA@@AAAA@A@@@@A@@@@AA@@@AA@@A@@@AA@@@A@C@@@@AA@@@@A@AAAACAAAAA@A@CA@@@A@C@AFCBA@@@A@@@CB@@A@A@@AA@AAAAAAA@AA@@A@A@CAA@@AA@@AA@@A@A@ABA@C@A@@B@@@@AB@B@BA@@BABA@@BA@A@ABADA@ABA@ABABA@@BA@@AA@AB@@A@A@AB@@AB@@@BA@A@A@CBA@ABABC@AAA@C@ABCBA@ABABADA@@@@B@@@B@B@@@B@@A@@@ABAAABA@AA@@AAA@A@CAA@AAA@AAA@E@CA@ABABABABA@@@AA@@ACAACAC@A@@B@@A@AB@@AAA@@@A@A@A@A@ABABCDABCAACAAC@A@AAAAAA@C@A@C@C@C@EBC@A@AAA@@@@AA@A@C@C@C@A@@@@@@A@A@A@A@@BA@AB@BABA@@BA@AB@BABA@@BA@AB@@@@A@A@@@ABA@AB@BA@AB@BA@ABA@ABA@@@A@@@A@@@@@A@@BABA@ABA@@@@AA@@@AAA@A@A@A@@@A@ABA@@BA@A@@@A@@B@@@B@B@B@B@BA@@BABA@@BABA@AB@@A@@@A@C@E@A@A@@AA@A@@@@@AB@@AB@@@BA@@B@@@@A@@@C@A@@BA@A@A@@@A@@@@@A@@@@A@@@A@A@A@A@A@@@A@@@AAAA@@AAAE@A@@AA@@@@A@@@AAA@A@CCAACA@AA@@@@@@A@A@@@@@@@@@AA@A@@@@@C@A@A@CB@@CBA@@@@@@@@@A@@@@@A@@@@@@@@A@AAA@A@@@@@@A@@@A@@@@@A@@@@@AA@@AA@@@A@A@@@@@@A@A@A@AB@@A@EB@@@@AB@@@@A@@B@@@@ABA@CBA@AB@@@@@@@B@B@@@B@@@B@BA@@B@@@B@@@@@@@B@@@@A@@BA@@BA@ABABA@@@ABAB@@AB@@@@A@@B@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@B@@A@@@@@@@@@@B@@@@@@AB@@@@@BA@@@@@AB@@@@A@@@A@@BA@@@@@A@@BA@@@A@@@@B@@A@@@@@A@A@A@ABA@@@A@@@A@@A@@@@@@A@@@@@@@@@@@AB@@@@A@@@@@@@A@@@@@@BA@@@@@A@@@@@AA@@@@A@@@@@@@ABAB@@@@A@AB@@@B@@A@@@@@@@@@A@@@@@@@A@AA@@A@@AA@AA@@@@@@A@A@AAA@@@A@@@@@A@A@ABA@@BA@AB@@A@@@AB@@A@@@@@C@A@@@A@@@@B@@A@@@@@A@@BA@@B@@A@CBAB@@A@@BC@CBA@ABABCBA@@B@@A@@@@@A@@A@@@@A@CA@@AACAAAA@@@@@@@A@@@@@@@A@@@@@@@A@@@CBA@@BA@@@ABAB@@A@CDA@@@KDEB@BC@@BA@@@@@A@@@A@A@A@C@@@@AA@@@A@@@@@A@AB@@A@A@A@@@A@@B@@A@ABA@CB@@@@@@A@@@@@@@@B@@@D@BA@@B@@@@@B@@@@A@@B@@A@@@@@A@A@@@@@@@AB@@AD@@ED@@@@A@@AA@@@A@A@A@A@@@@@A@@@@A@A@@@AAIAG@CACAC@AA@@ACAEAC@@@@@A@@AA@@@@AA@A@AAGCA@@@@@@@@@@@@AA@@A@@@@@@@AA@@@A@@@@@AB@@A@@@CB@@A@@@@@AB@@@@A@@@@@@@@@C@@A@@A@AC@@AAAC@@AC@@@A@@@@@A@A@@@@@A@@A@@A@@A@AAAACAAACAA@@@@A@AAA@@BC@@@A@@@A@@@@@@@AA@AAA@AA@@A@@@A@C@C@A@A@@@A@@AA@@AA@CAE@@AA@@AAAA@@A@AAA@A@@@A@A@A@@@AAA@@ACA@@AA@CACAAAAA@AAA@@AA@AAA@A@@AC@@@CBA@A@A@AB@FCDCBAB@@A@@@AB@@@@@@AA@@@A@AAA@@A@@A@@CBA@A@A@A@@@A@@@A@A@CBC@C@ABC@A@AB@@ADABABABAB@@ABC@ABCBAB@@@AAAA@@@AAA@A@ACGAC@A@AD@FC@AB@@@@@B@DA@@B@@@@ABABCBAB@DCBADABABAB@@@FAFAHC@@B@BA@@B@BC@A@@BA@@@AAA@@BA@A@@@A@@AA@@@A@@@A@@D@F@D@B@B@@@B@@A@@@ABA@@DAB@BA@@@@@A@@B@AAAC@C@C@ABG@E@C@C@@AA@A@@BA@@B@DADCDCDADADAB@@A@A@C@ABAFEFCBCBA@AACCCCA@A@ABCBADADA@C@AAACCCCCACAAAAAAA@ABE@CBCBABADADAFAD@BAB@FAF@D@FA@@B@@@@ABABCBCBA@A@CAC@@@@AA@@AC@A@A@C@ADEBABCDCBCBC@AACCCACAA@CBC@AAAAE@A@@BCBC@C@ACC@CACACACEY@YEiQKIGQFMHkcEHe\\YºBW\\QZMbURMc]OScBQŕFeW^ĺA@@@AAA@@@CAAA@@AA@@@A@@AA@A@@@@@AC@AAC@CAAACAA@ACA@AA@@@A@A@A@@@A@@@AA@AAAAA@CAA@AAA@A@C@C@C@A@A@A@CBC@@@A@A@A@E@E@@AA@A@CAA@A@CAA@A@A@A@@@A@A@C@C@AAA@A@A@A@A@A@@BA@A@@BA@A@A@A@AAA@A@CBA@A@C@A@A@ABC@A@A@C@G@A@C@A@C@AB@@ABAB@BA@@@AAA@A@A@A@@@AA@@@A@AAA@AB@@A@AAA@@AAA@AAC@AAA@A@CAA@AAA@AAA@AAA@C@CAA@C@C@E@A@A@A@C@"],encodeOffsets:[[131488,43225]]}},{type:"Feature",id:"222424",properties:{name:"汪清县",cp:[129.771607,43.312522],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@A@@A@@@@A@@AA@@@AAA@AA@@ABC@@@A@@@A@@AAA@@A@@@@A@@@A@@@A@@@@@A@@A@@@@A@@@@@@@@A@@@@@AA@@@@A@@A@@@@@@@@AA@@@@@@@A@@AA@@@@@@A@@@AA@@@@@@@@@A@@@@@A@@@@AA@@A@@@@A@@@AA@@@A@AAA@@AA@@AA@@A@@AA@AA@@@AA@B@@AB@@ABA@@@@@A@@@A@A@A@C@A@A@A@ABA@AAA@@@C@A@C@@@A@@@A@@BA@A@@@A@@@AB@@AB@B@@AB@@AB@@AB@BA@A@@@A@A@AB@@@@A@@@@@@@A@@@@BA@@@A@@B@@A@@@@@A@A@@@@@@@@B@@@B@@@B@@@BA@@@@B@@@@@@@B@@@@@BB@@@AB@@@B@@@@B@@B@@@B@@A@@B@@B@@BB@BB@@@@@B@@@@@@@BB@@BA@A@A@@@A@ABA@A@ABA@@@@BA@@@@@A@@BA@@@@@@@@B@@@@AB@@@@AB@@@B@@@B@@@B@@@@@@@B@@@@@@@@@@AB@@@B@@AB@@AB@@ABAB@@AB@@AB@@@@@B@@A@A@ABC@A@@@@AA@A@AA@@@@A@@A@@A@A@@@A@A@A@@B@@A@AB@@A@ABA@@@A@@BABA@A@AAA@CAA@CAAA@@A@A@@AA@@A@@@@AA@@A@AA@@A@@@AAA@@A@@A@@A@@@@@@A@@@A@@@A@@@A@AAC@@@AB@@C@A@A@@@A@@@@AB@AA@A@@@A@@@@BA@@@@BA@@BA@@@A@@@AB@@A@@@A@A@@@@A@A@@@AA@@@@@A@@@A@@BA@@@A@@B@@A@@B@@@@@B@@@B@@AB@@@@A@@@A@@@@BA@@A@@@@A@@AA@@A@@@@A@A@@AA@@A@@@A@@AA@@@@@BA@A@@AA@A@@@A@A@@@@AAA@@@@A@AA@@@AA@@A@A@@@@@@@BA@AA@@A@@B@@AB@@A@@@@AAA@@@AA@@@@@A@@A@AAA@@BA@A@@@A@A@@@AA@A@@@@@A@@@@A@@AA@@@AA@@AAA@A@@AA@@@ABA@@@A@A@@BA@@@A@@AA@@@C@@BA@@@A@A@@@C@@@@@ABA@@@A@@@A@A@@@A@A@@@C@@@A@@A@@A@A@A@@AA@@BA@@@AB@@@@@@AB@@@@@@A@@@AAA@A@@B@@@@@B@@B@@@@B@@@@@BA@@@AB@@A@@B@@AB@B@@A@@BA@@B@@@@@@B@@@B@@@@@B@@@@B@@@B@@@B@B@B@@@@@@@@@@@B@@@@@@A@@B@@@@@B@@@@@@AB@@AB@@@@@@AB@@@B@@@@@B@@@@@B@BA@@@A@A@@@@@A@AB@@@@AB@@@@@@@@AB@@@@A@@@@B@@@@@@@B@@@@@BA@@B@@@@@B@@@@@B@@@B@@@@@@AB@@A@@@AB@@AB@@@BA@@@@BAA@@@@A@@@@@@B@@@@@@@@@@@@@BB@@@@B@@@@@B@B@@@@A@@B@@@B@@@BA@@BA@@B@@A@@B@A@@A@@@@@A@A@@@A@@@A@A@@AA@@@@@@@@@A@@@AB@@A@@B@@A@@@@@@B@@AB@@B@@@@B@@@B@@@@AB@@@BA@@@@BA@@@@B@@@@@B@@@B@@@BA@@B@B@B@@@@@B@@@@@B@B@@AB@@A@@@@@A@A@A@@BA@@@@@@BAB@@@@ABA@@BA@@@@@A@@@@@AA@BA@AB@@@B@@@@A@AB@@A@@@@B@BA@@@A@@@A@@@A@@@A@@BA@A@@@@@C@A@A@@@A@AB@@@@A@@@@@@AA@@@C@A@@@AB@@@@CAA@A@@@C@A@@@A@A@AAA@@@A@@@@C@@CAA@AA@@AAA@AAA@AA@@@@AA@AA@@@@AA@A@ABA@A@A@A@A@C@C@ABA@@AA@A@C@AB@@@@A@@@AB@@@@A@@@@@A@@B@BA@@BA@@@A@@@@BA@A@A@A@A@AB@@EB@@A@@B@BA@@BA@@@A@E@AB@@A@A@A@AAA@@@A@A@C@A@@@A@@@@@@@@@A@@@A@@@@@A@@@A@@AAB@@A@@@ABA@@@AB@@@BA@@@AB@@@BA@AB@@AB@@@@@B@@A@A@ABA@@@A@@@AAAA@@@@A@A@@@@@A@A@A@A@@@AAC@AAAAA@A@AAA@A@A@@AA@@@ABA@A@@@ABABA@@@CBA@@@A@@AA@@@AA@@@A@@@@A@A@@BA@@@@@A@@@@@C@A@@A@@@@A@@@@@A@AB@B@@@@A@@@A@A@@@A@@@A@@@@@AB@BA@@BA@@@@BABABAB@@@B@@@@AB@BA@@BA@@BA@A@@BA@@B@@@@ABABAB@@@BA@BBA@A@C@KD@B@@@@AB@@@@@BA@@B@@@@@@@@A@AB@@A@@B@@A@@B@B@@@@@BAB@@AB@@AB@@A@CB@@@@@B@@@BBB@@A@@B@@@B@DA@AB@@AB@@ABA@@@C@CBA@@@A@A@A@@@A@AB@B@@A@@@AB@@AB@@@B@BB@@@BB@B@@@B@@@BA@@@ABC@@B@@@B@D@@@B@@ABA@@BA@A@ABC@CBA@A@C@A@@@@@AB@@@B@BAB@@@BA@@B@@AB@@C@CB@BA@@@@@A@A@A@A@A@@@ABA@@B@@@BA@@B@@AB@B@@A@A@@@@BA@@B@B@@@B@@@@A@CBA@@@ABA@@B@@@@B@DB@@B@@BB@@B@@B@@BB@@B@@BBAB@B@@@BA@@@@BB@@B@@CDCBEDCBA@@B@BDB@@@B@BB@BBBBFD@BBB@B@B@@@B@@@B@BAD@@@B@B@BBBDD@BBB@B@@BD@@BBDBB@@BAB@BC@@BAB@BAB@@@@@B@@@@@@AB@@A@@@@B@@A@@BABAD@@AB@BABA@AB@@A@BB@B@BBB@@@@@@CB@@@@@B@@@B@@@BA@A@A@A@A@@BA@AB@@@B@@BB@BB@@@AB@B@@BBBDB@BBBB@DB@@@DBB@@@@BB@BD@@@@A@A@C@A@@@A@A@@@A@A@A@A@A@A@CBA@CBC@@@C@AB@@AB@@@@@B@BAB@@ABABCB@@@BA@@B@@BB@@BDDB@@@B@BA@@B@B@B@B@@BB@@@B@@@B@@@D@@B@@B@@A@@B@B@@AB@B@B@@@B@BBD@@@D@@AB@@@@A@CAC@C@GAC@A@C@A@A@A@AAA@CBA@A@AB@@A@@@E@C@A@A@ABA@A@A@@@A@AAA@A@A@A@ABC@@@ABA@CAA@A@@@ABA@@@A@@@A@A@A@AB@@A@@BA@AB@@A@@BA@A@A@A@CBA@C@EBA@E@A@A@GBABA@A@A@E@GB@@EAA@ABA@A@@@A@@BABA@@BAB@@A@@B@B@@@B@B@B@B@@@BABEDADA@A@@B@@@B@BBB@D@D@F@@@@AD@B@@@@@@@@@BA@@@AD@B@@AB@@AF@DAF@BB@@DB@BB@@@@ABABABAB@B@B@BB@@BB@@@B@BBBDBB@B@@@B@BA@@BABABA@ABADAB@D@D@@@@A@C@G@A@CB@@CAABA@@@AB@@AB@BAB@@ABA@A@@BA@@@@BA@@B@@@@A@@BA@A@A@@@A@A@A@AB@@@B@@@B@B@BB@@BA@@@@B@@@B@@A@@BA@CB@@@@@BA@A@A@ABC@CBA@@@CBC@GBAB@@@@AB@@@@@B@@B@AB@BABAB@BADAB@@ADA@AB@@AB@@@B@BABBB@B@B@@@B@@@BABA@ABAB@B@B@@@B@@@BBBBB@@@@@@@BB@@@@@BB@@@B@@@@B@@BA@@B@@@B@@BB@@@@@BB@@B@@@@@@B@@@B@B@@@@@B@@@@@BB@@B@BB@@BB@@@@@@B@@@@@B@BB@@BA@@B@@@BA@@B@@A@@B@@@@@B@@@BA@@B@DA@@B@@@B@@@B@BB@@B@@@BB@@@@B@@B@@B@@@B@BB@@@@@@B@@@@@@@B@B@@@BB@@B@@BB@@B@@@@B@@BB@@@B@BB@@B@@@B@@@B@@B@@@@B@@B@@@@BB@@@@@BBB@@@@@B@@B@@@@@@@B@@@B@@B@@B@B@@@B@B@@@B@@@B@@@@@B@@@B@@@B@@@B@@@B@B@B@@@B@B@B@@@B@B@B@B@@@B@@@@@@@@A@@@@@ABA@A@A@ABA@@@A@A@@@A@@@@@@@@@@@B@@B@@@@BB@@@B@@@@@B@@@@@BBB@@@B@@BB@B@@BB@@@@BB@@@BBB@@@B@@@B@@BB@@@@@@@B@@B@@@B@@B@@BB@@@BB@@@@@@@B@@B@@@@BB@BB@@B@@@@@@@@@@@B@@@BA@@B@BA@@@@B@@@@@B@B@B@B@BB@@D@@@B@@@@@@@@B@@@B@@@B@@@B@@@@BB@@@@@B@@@BB@@BBB@@B@@BB@B@@BB@B@@@B@B@@@B@@@B@D@B@B@@BB@B@@@BB@@B@@BB@@@BB@B@@BB@@@@B@@B@@@@BB@@@@@B@@@B@B@B@@@B@B@@@B@@@@@B@@@@B@@B@@@B@@@B@@@B@@@@@@@B@@@@@B@@B@@@@BB@@@@@@B@B@@@@@B@@@@@@@@AB@@@@A@@B@@@@@@@@@B@@@@BB@@@@@B@@@@@@@B@@A@@@@@@B@@@@B@@@@@BB@@@@@@@B@@@@A@@B@@@@@B@B@@@@@B@@BB@@@@@BA@@B@@@@@B@@@B@@@B@@@@@B@B@@@B@@@B@@@@@B@B@@@B@@@@A@@BA@@@AB@@@@@B@@@B@@A@@@@@@BB@@@@@@B@@@@@B@@@BA@@B@@@BA@@BA@@BA@@@@BA@@@@@A@A@@@A@A@@@A@AA@B@@A@@@@@@@@@@B@@@@@B@@B@@B@@@B@@AB@@@B@@@B@@@B@@@B@@AB@@@B@@@BAB@@@@@BA@@B@@@B@@@B@@AB@@@B@BBB@@@@@@@@@@DD@B@@@BB@@DBB@@@@@@@B@@@@AB@@@@@@AB@@@@@@@BB@@B@@@@@@@@@BB@@@B@@@@@B@@@@@@B@@@@A@@B@@AB@@@@@B@@@@@B@B@@@@@BA@@D@@@@AB@@@BABAB@@@B@@@@@B@@B@@@@B@@B@@B@@B@@@B@@@DB@@B@@@B@@BB@@@BBB@@@B@@B@@@@B@@B@@@B@B@B@@@@@@BB@@@@@@@@B@BBB@B@@@@@@@@B@@@@@@@B@@AB@B@@A@@@@@BD@@@@@B@@A@@B@@A@AB@@@@AB@@@@@@E@A@@@@@AB@@@@@@@@@@@@AB@B@@@@@BA@@@@B@@@@A@@@@@A@A@A@@@@B@@@@@B@BBB@@A@@D@@@@@BB@@@@BBB@@@@@B@@@B@@@@@BB@@@@B@@@@@BBB@B@B@@@BBB@@@@@B@@@@A@@@ABABA@ABA@A@A@@BA@@@AB@@@@@@@@@@@@@B@@@@B@@@@@@BB@@@B@BBDBB@@@@@@@@@@@@@BB@@@@@@AB@@@B@@@@@@@B@B@@@@@B@@@@@@AB@@@B@@@BADAB@@@D@@@@@BABAB@@@B@@@BA@@@@BB@@B@B@B@B@@@@@@AD@@@@AB@@@B@@@B@@@B@B@@@B@@@B@@@BB@@@@@@@@BB@B@@B@@B@@@@@@@@BBB@B@@B@@@@BDBBBDB@@@@@@@B@@B@@B@@@BB@@@BB@BB@@@B@BBB@@BB@@BB@@BB@BB@@B@@@BBB@BBB@@@B@BB@@B@@B@@B@@B@@BD@@@B@@BB@@@BB@@@@@BB@@B@@@BB@@B@BBB@@BBB@@@@@@B@BB@@B@BB@@@@B@@@@@B@B@@AB@@@@@B@@AD@BAB@@@BABAB@@@@@B@@@@@@@@@B@@@DA@@@@@@B@D@B@@@@@B@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@A@@@@@@B@@A@@@@B@@AB@@A@@B@@A@@@@@@@@@@@A@@BA@A@A@@@@@@BA@@@@@A@@@@B@@A@@@@@@@@@@@@@@@A@@@@B@@A@@@@@@@@@A@@@@B@@@B@@@@@@A@@@@B@@@@A@@B@@@@@@@@@@@@@@@B@@@@@@@B@B@@@B@@@@@@@B@B@@@@@@@B@@@BA@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@@B@@@BB@@@@@@B@B@@@@@@@B@@@@@@@@@@BB@@@BBBB@@BB@@@@@@@@@@@@@B@@@@@@@@@BA@@B@@@@@@@@@@@B@@@@@B@@@B@@@B@@@@@B@@@@@@@B@@@@AB@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@@@@@@B@B@B@@AB@B@@@B@@@@@@@B@@@B@B@@@@@B@@@@@B@@@@@@@@@@@@@B@@@@@@A@@@@B@@A@@@@B@@A@@B@@@@@@AB@@@@@@@B@@@@@@@@@B@@@D@B@B@@@B@@@@@@@@A@@@@@A@@@@@@@@@@@@B@@A@@@@@@@@@@B@@@@@B@@@@A@@B@@B@@@@B@@@@@@@@@B@@@@A@@B@@@@@BAB@@@@@@AB@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@BAB@@@B@BA@@@@@@@@B@@@@@B@@@B@@@@@@@BB@@@@B@B@@@B@B@@@@@B@@@@AB@@@@@@@@@B@@@@B@@B@@@B@@@@@@@@BB@D@@@@@D@@@@@B@B@B@BB@@@@B@B@@@@@@@B@@B@@@@@@B@@@@B@@@@B@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@A@@@@B@@@@@@B@@B@@@@@@B@@@@@@@@@@BB@@@@@@@@@B@@@@@@@@@B@B@B@B@B@@@@@B@B@@@B@@@@@BB@@B@@@@@@@B@@B@@@@@@@@B@@B@@@@@@@@@@@BBB@@@@@@@@@B@@@@@@AB@B@B@@@@@@@@@@@@@B@@B@@@@@@@B@B@@B@@B@@@B@@@@@@@@@B@@@B@B@@@D@B@B@@@B@@@B@@@B@@@F@B@@B@@@@@@@@@@@B@@@@@B@@@@@B@@@@@BBF@@@@@@@@@B@@@@@@@B@@@FBBBDB@@BDB@@FFBB@@@DB@@B@@B@B@H@D@B@D@FBBBFDJBBBB@F@B@BBD@B@BBBBHLDBBBBBBBFDB@FD@@B@@B@@@L@DB@@@B@B@B@B@B@B@DAF@@@F@D@B@HBD@BB@@B@@BBB@@BBBBBBB@@@@@D@DADAB@@AB@BA@AB@@@@A@@B@@@BA@@@@B@@@B@@@B@B@B@BAB@B@@@B@B@@@B@B@@@@AB@@@B@@@BA@@@@B@@@D@B@@@B@B@@@BBB@@@@@B@BB@@B@@@B@@@B@@BB@@@B@@@B@@@@@@@B@@@B@B@@@B@@@@@B@@@BB@@B@@@B@@@B@B@B@@@B@@A@@B@@@B@@@@AB@@@@@B@@@@@B@@@B@@A@@B@@@B@@@B@B@@@B@@@B@B@B@BA@@B@@@@BB@@@B@B@B@BB@@B@@@B@@@B@@@@@B@@@@@B@@@@@B@@@B@@@B@B@FBD@B@D@@@B@@@@@B@@@BBB@@@B@@@@@B@@@@@@@@BB@@@@B@@@@@B@@@@@@BB@@B@@BB@B@@@@@BBB@@@B@@@B@B@@@@@B@@@B@@@@BB@@@@BB@@@@@B@@@B@@@@AB@@@@@B@@@@@@@B@BA@@@@@@B@@@B@@AB@B@@@B@B@@@B@@@B@@@B@@@@@B@@@B@@@B@@@B@@@B@@@@AB@@@B@@@B@@@B@B@@@@@B@B@B@B@@@D@@@B@B@@@B@B@@@B@B@@@@BB@@@B@@@@@BB@@@@B@@@B@@@@@@@@@@@@@@@@A@@@@AA@@@@@A@@@@@@@A@@@A@@B@@A@@@@@A@@B@@A@@B@B@@@BA@@B@@@B@B@B@@@B@BB@@B@B@@BD@@BB@B@@@@B@@B@@@BB@@@@@@@@B@@@@@B@@@@@B@@@B@@@B@@@B@@@BBB@@@B@@@B@@@@@B@@@@@B@B@@@B@B@@@B@@@B@@@@@B@@@BBB@@@@@B@@@BB@@B@@@B@@@@@B@@@B@@@@BB@@@B@@@BB@@@@B@@BB@@@@B@@@@B@@@@@@@B@@@B@B@@@BAB@B@B@BA@@B@B@@@B@@@B@@@@@@@B@@@B@@@B@@AB@B@BA@@@@B@@@B@B@B@BA@@B@@@B@@@B@@@@@B@@@B@@@B@B@@@B@BB@@B@@@B@@@B@B@@@B@@@B@B@BA@@B@BA@@B@@AB@@@@@B@@@@@@@@@@@ABAB@B@BA@@B@@@B@BAB@B@@@B@B@@@B@B@@@B@B@B@@@B@B@BB@@@@B@@@@B@@@@@B@@@@@B@B@B@@@B@B@B@B@BB@@D@B@@@B@BA@@B@@@@@B@@@B@@A@@B@@@@@@@@@BAB@B@@@B@@@B@@@@@BA@@B@@@BA@@@@@@BB@@DB@@B@@@B@B@B@B@B@B@B@B@BAB@@@@@@@@@@@B@@@@B@B@@@B@@@B@@@BBB@@@B@@@@BBB@@@B@B@@B@@B@@@@@@@AB@B@@@@@@@@@BB@@@@@@@@@@B@@@B@@@@@@@B@@@B@@@B@@@@@B@@A@@BA@A@@B@@A@@@@B@@@@@@@@@BB@@BB@B@@BB@@@@B@@@@@@@@@B@@AB@@@@A@@@AB@@A@@@A@@BA@@@@@@@@B@@@@A@@B@@AB@@AB@B@B@@@B@B@@BB@@@BBBBBB@BB@@B@B@BB@@@@BAB@@@B@B@@AB@@@@@@@@@@@@@@@BB@@@B@@@@@B@@@@@@@BBBBB@@BB@@@@@B@@A@@B@@A@@BA@@@AB@@@@@@BB@@@@@@@BA@@@@B@@@@@@@@@B@@A@A@A@A@@BA@@@@@@@@@@B@@@@@@@@@@ABA@@@@@@B@@@@@B@@A@@@@@@BA@@@@B@@@B@@@@@@@B@@BB@@@@AB@@@B@@A@@@@BB@@B@@@BBBB@@BB@@@B@@@B@@@B@@@B@@A@@B@@@@@@@@@B@@@@@@A@@B@@@B@@@B@@@@@B@@@@@@B@@@B@@@@BB@B@@B@@@@@@@B@@@DAD@@@B@B@@@@@@@@@@@B@@@@B@@@@B@@@@@B@@@@@B@@@B@@BB@@@B@B@@@@@@@@B@@@@AB@@@@@@@@@@@BB@B@BBBBB@BBB@BB@@@@@@@BB@@@BB@@@@@@@@@@BB@@@B@@BB@@B@@B@@@@@B@@@B@BB@@@@@DBB@B@@@DBB@B@@@@BB@@@@@@@@@AB@@@@@@@@@B@B@@@@B@@@@@B@@@BB@@B@BB@@BBB@@BB@@@@B@@@@@B@@B@@@@B@@@@@B@@@@@@@@B@@@@@B@BA@@@@@BB@@@@@@@@@@@@@B@B@B@@@B@@@@@B@@@@@B@@@B@@@BB@@B@@@@@@@@@@B@B@@B@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@@@@B@B@B@@@B@@@B@@@B@@@@@@BB@@@@@@@@B@@@@@@@@@B@@BB@@@@@BB@@@BB@@BB@@BB@@B@@B@@B@@@B@@@@@@@B@@@@@@@@@@@@AB@@@@@@@@AA@@A@@@A@@@@@@@A@@@@@A@@@@@@@@AA@@B@@@@@@@@A@@B@@@@@@@AA@@@@@A@@@@@@BAB@D@B@@@BA@@B@B@B@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@BB@@B@@@B@@@@BB@@B@BB@@@@@@@B@@B@@@@BB@@@B@@@@@@BBB@@@B@@DBBB@@@@B@@@B@B@@BB@B@@@@@B@B@@@BAB@@A@@BB@@@@@BB@@BA@@@@B@@@BB@@@@B@@B@@@@BB@@@@B@@@@@B@@B@B@BB@@@@@@@B@@@B@B@@@@@@B@@@B@@@B@BBB@B@@@BAB@@@B@B@@AB@@@@@@A@@AA@@@A@@@@@@BABADABAB@@AB@@@@@@@@BB@@@BB@@B@@BB@@@@@@B@@@B@@@@@@BB@@@@@BB@@@@B@@BB@@B@@BB@@@BB@@BB@@BB@@B@B@B@B@@@B@@@B@@@BA@@B@@@B@B@B@B@B@B@@@B@B@@@B@@@B@@@BAB@@A@@BAB@@@BA@@@AB@@A@@BA@@@@B@B@@@B@@@B@B@@@B@@@FAB@BAB@B@BAB@BA@@@@B@@@B@BAB@@@BA@@@@@A@@@A@@@A@@@@AA@@@@@A@@BA@@B@@@B@@@@@@@BA@@@@@@@AB@@A@@BA@@@@@AA@@@@@@A@@@A@@@@@@@@@@BA@@@@@@@@@@@A@@@@@@@@@@A@@A@@@@B@@@@@@@@@B@B@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@BB@@@@@@B@@@@@@@@@@@@@B@@@@@@@B@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@BAB@@@@@B@@A@@@@@@BA@@@@@@@@@@@AA@@@@A@@@@@@@@@A@@@@@@A@@@@A@@@@@@A@AA@@@@A@@@@@@@B@@@@@@@@@@@BA@@@@@@@A@@B@@@@@@AB@@@@@@@B@@AB@@@B@@@@@BA@@@@@@@@@@@@A@@AA@@@@@@@@@@@@@@A@@A@@@A@@@A@A@AA@@@@A@@@@@@@@@@@@AA@@@@A@@@@@@@@@@A@@@@@@@A@@@@BA@@@@@@@@@A@@A@@@@A@@@@@@@@@A@@A@@@@@@@@@@@A@@@@@@B@@@@A@@B@@@@@@@@A@@@A@@@@@@@@B@@@@A@@@@@A@@B@@@@@@@@@@B@@@@B@@@@@@@B@@@B@@@@@@@@@B@@@@@@@@A@@@@@@B@@@@@@@@AB@@@@@B@@@@@@@@A@@@@@@@@AA@@@@@@@@@@@@@@@AB@B@@@@@BA@@B@@@B@@@@AB@@@BA@@B@@@@@@A@@@@A@@@@A@@@@@AA@@@@A@@@@@@@AA@@@@@@A@@@@@@@@@@@@@@B@@@BA@@@@@@B@@@@@@@@@BB@@@@B@@@@@@@B@B@@@@@B@@@@@@A@@@@@@@A@@@@@@B@@AB@@@@@@@@@@@B@@@@BB@@@@@B@@@@@@@B@@AB@@@@@@@@@@@@@@@@A@@@@@A@@AA@@@@@AA@@@@@@@A@@@@@AA@@@@@@@@A@@@@@@@@@B@@@@AB@@@BA@@B@@AB@@@@@@A@@@@@@@@@@@@@AB@B@@A@@@@@@B@@A@@@@@@@@BA@@@@@@B@@@@A@@@@@@@@A@@A@@@@@@@@BA@@@@B@@@@AB@@@@@@@@@@A@@B@@@@@@AA@@@@@@@@AA@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@A@@@@A@@AA@@@@@@@@@A@@A@@@@@@@@@A@@@@@@@@@@@@AA@@@@@@@@AA@@B@@@@@@A@@B@@@BAB@B@@A@@@@@@@A@@@A@@@@@@@@BA@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@BA@@@@@@@@A@@A@@@@A@@A@@@@@A@@@@@A@@@@BA@@@@@@@A@@@A@@@@@@@A@@@A@@B@@@@@@AA@AA@@EA@@A@@A@@AACE@@BA@A@@A@AA@ADA@ADAF@@A@@A@A@AAA@A@C@@@A@@A@A@@@@CCA@@A@AAC@@BA@@@AF@B@DB@@@AA@GCA@A@@@@@@@@ADC@@@A@C@AB@BC@@@@@AAABA@C@ABABADAB@@@@A@A@@AC@@@ABADCBCBABADA@@BA@@@CBA@@@A@A@AB@@@DBB@D@BBDB@@DADAD@B@F@BADA@@@A@CBABEB@@@@@@A@@@AB@DABA@AB@BAB@D@B@BBB@BAB@BA@@@A@@@AB@@@@@AA@@@@B@@@@@@@@B@@B@@@@@B@@@@B@@@@BBD@D@@@D@@A@@@@B@@AB@B@@ABB@@@@@@@@@@@B@@@@@B@@BBB@@BBB@@B@B@@B@BB@BAB@B@B@BBB@B@B@@BB@BA@@BBDBD@B@@DBB@@BBHBDBBBBB@BABCB@@@BAB@@B@BBB@BB@BAB@B@@@B@@A@BB@BDD@@ABAD@@@@BB@@HBDD@B@BB@AB@B@B@@B@F@D@@@BAB@DA@BF@DBB@B@@@BB@@B@B@@@@@B@BA@@B@@@@@B@@@B@@@B@@@B@@@B@@B@@BB@@@BB@@@@@@@@@@B@@@B@@@B@@@@@BA@@B@@@@@@@B@@B@@@BB@@B@@@B@@@@B@@B@@@@@BB@@@@@@BB@@B@@@@@B@@@BB@@@@@@@B@@@@@B@@A@@@@@@B@@@@@B@@@@@B@@@@@@AB@@@@B@@B@BA@@B@@@@@B@@@@@@@B@@@@@@@B@@@@A@@@@@@B@@@@@BBB@@@@@B@@@@B@@BA@@B@@@@@@@B@@@@@B@@@@@B@@@B@@@@@BB@@B@@@@@@B@@BB@@BB@@@@@B@@@B@@@BB@@B@@@B@@@B@@B@@@@B@@@@@BA@@@@@@@@@@@@@BB@@@@B@@@B@@@@@BB@B@@BB@@B@@B@@@@@@B@@BB@@@@@B@@@@@@@@@@@BB@@@B@@@B@@@B@@@@BB@@BB@@@@@@B@@@@@BB@@@@@B@@@B@B@BA@@B@@@B@@@@@B@@@@@@@B@@@@@B@@BB@@@@B@@@@B@@BB@B@@B@@@@AB@B@@@@@@@B@@@@@B@@@@@@@B@@@@@@BB@@@@@@B@BBB@B@B@B@@BB@@@B@B@B@@@@@B@B@@@B@@@@BB@@@@@@@@@B@@@@@@A@@@@BB@@A@@@@@@B@@@@@@@B@@@B@@@BB@@@@@BB@@B@@@B@@AB@@@B@BABAB@@@@@DA@@B@B@@@BA@@@@BB@@B@@@B@@B@@@@@@BB@@@@@@@@BB@@B@B@@BB@@@B@@@B@@@@@B@B@@@B@@@@AB@B@@@@@BA@@@@BA@@BA@@@@@A@@@@@@@A@@@A@@B@@@@@@AB@@@B@@@@@@@@@B@@@B@@@B@@@BB@@@@B@@@@@B@@@@B@@@@@@@B@@@@@@BB@@@@B@B@@@@@B@B@@@B@@BB@@@@BB@@@B@BBB@@@@@@@B@@@B@@@@@@@B@@@@@B@BBB@BB@@@@@@@@@B@@@@@B@@@@AB@@AB@B@B@B@@@BB@@@BBB@@@B@@B@@@@@@@@@B@@@@B@@@@@@@@@B@@@BBBB@@B@@@@@@@B@@B@@@@@B@@@@BB@@@B@@@@@@BB@@@@@@B@B@BB@@@@@@@@B@A@@B@@@@@@@@@B@@@B@@@@@@@BA@@@@@@@@B@@@@@@@@@@@BB@@@@@@B@@@@@B@@@@A@@BA@@@@@@B@@AB@@@D@@@@BB@@@@@@DBB@@@BBB@B@@@B@@@@@@@@@@@@@B@@B@B@@@@BBDBB@BDD@@BB@BB@@@@BBB@@@BDB@BDBBBBBBB@BB@@DBFBH@AB@@@@@@@@@B@B@B@@@@@B@B@B@B@@@B@BB@@B@@BB@@BB@@@@@B@@@@@@@@@@AB@B@@AB@B@@@@@B@@@B@@@@@B@@BB@@@@@BB@@@@B@@@@B@@@BB@@BB@@@BBB@@@@@@@@@BB@BB@B@@@BB@@@@B@@@@@@@B@@B@@B@B@@BB@@@B@@@@B@@@@@@BB@@@B@@@B@B@DA@@B@@@B@@@@@BB@@BB@@@@@B@@@@B@@@@@@@B@@@@@B@@B@@B@@@@@@@BAB@@@@@@@B@@@@B@@@@@@@@@BB@@@@@BB@@@BB@@@@@@@@@@@B@@@D@@@B@@@@@@@B@@B@@@@@@@B@@B@@B@@@@@@@B@@@@@@@@@B@@@@@@@@B@B@@@B@B@@@B@@@B@BAB@@@B@@ADA@@D@@@@ABA@@@@B@@@@AB@D@BAD@@A@@@@@@BA@@@@AA@@AAAA@@@@@A@A@@@ABA@A@AB@@@@@@AA@@@A@@@AAAA@@@@A@@A@@@@@AAA@A@@@@AA@@@A@@AA@@@@B@BAB@@@@@@@@A@@@@@A@A@@@@@AB@@A@@BA@@BAB@@A@@B@@@@@B@@@@@BA@@@@@@@@AAAA@A@@@@@@@A@@@A@AB@@@A@@@@AA@A@@AA@@@@@@@@@@A@@@A@@@@@AA@@@@A@A@@@A@@@A@@@@A@@@@@A@@@@@@@@@@A@@@AB@@@@AB@BA@ABA@@@A@@@@@A@AA@@@@@@@A@@AA@@@@@@@@A@@@@AA@@@@@@A@@AA@C@A@A@@@@@@@@@A@B@@@@@B@D@@AB@@@@@@A@@@@@@AA@AA@@A@@@@@@@A@@@A@@@@@A@@@@A@@@@@A@@@@@AAA@@@AA@@@@AA@@@@@@@AA@@@@A@@BA@@@@@@@@A@@A@@@@@A@@A@@A@@@@A@@@@A@A@@@@@@@@@@@A@@@@@A@@@@@@@@A@@@AA@@@@@@@@@@@A@@@A@@@@@@A@A@ABA@A@A@A@@@@@@@@@@A@@@@@A@A@@@@@A@@@@AA@@A@@@@@@@@@@A@@B@B@@A@@@@@@@A@@@@@@@@BA@@@@@@@@@A@@@@@@@@@@B@@A@@@@@@@@@AAAAAAA@@@@@@B@@A@@@@AA@@@@@@@A@@@@B@@@@@BAB@D@B@@@B@BABADA@A@@@@@@B@@@@B@@B@@@@@B@@@B@B@BA@@B@@@@AB@@@@@@@@A@A@@@A@@@A@@@@@@@@@AB@@@@@B@@@@@@@@AB@@A@@B@@ADA@@BA@@@A@@@@@A@@@@@@@@@A@@B@BA@@BABCB@BCBA@@@@@A@A@@@@AA@A@@@A@A@@@@@A@@B@@@B@DAFAD@B@@@@AB@B@@AB@@@@A@@BA@@@A@@@@@@BA@@@@@A@@@@@@@@A@@AA@CAC@CAAA@@@@@@@@@A@@@A@A@A@@@A@@A@@AA@A@AA@@A@AA@@@@AA@@@@AA@AA@@A@A@@@AB@@@@A@@@A@@@@@A@AB@@@@@@ADABA@A@@B@@A@@@A@A@@B@@A@A@A@@@A@@@A@@AA@@@@@@@A@@@@@@@AB@BABAB@BAFA@AB@@@@@BA@A@@@@B@@@BA@@B@@ABA@A@@@AB@@A@A@A@A@@@@@@A@@@@@A@C@@@A@@@@@A@@@@@A@@A@@AA@@@AA@@@A@@@@@A@ABA@@@@@@@@@@@AA@@AA@@AA@@A@@@A@@@A@@@AA@@AAA@@@@AA@@@@A@@A@@A@A@@@@@@@A@@BABA@AB@@@@A@@@A@AB@@A@@B@@A@@@A@@@@@AB@@@@@B@B@@@B@@@BA@A@A@@B@@A@@@@@@@@@@B@@@B@BAB@@AB@BA@@@@@@@@@@AA@@@@@A@A@A@@@A@A@@@@@@BAB@BA@@BA@@@A@@@@@@@@AA@@@@@A@@@A@@@A@@AA@@BA@@@A@@@AA@@@BA@@@A@A@A@C@A@@B@@@@A@@@A@@@A@@@@AA@@@@@@BA@@@A@@@@@A@@@A@@AA@@@@BA@@BA@A@@B@@A@@@@A@AC@@AA@AA@@A@@@AAA@@BA@@BA@AB@@@@A@@@AB@@@@AB@BAB@@@BA@@@A@@BA@@BA@@BA@@B@@A@@@AB@@A@@@@BA@@B@BA@@B@@@B@@A@@B@@AB@@@BAB@@AB@@@B@@@@@BA@@@@B@@AB@BAB@@AB@B@B@B@BAB@@ABABA@@B@@@@ABABABC@@@AB@BABABA@@@@@A@@@A@@@@A@@@@A@@@@B@@A@@AA@@@A@AA@@@@@@@A@@@@@AA@@@@A@A@A@@@@@@@A@@@@A@@@A@@@A@@A@@A@@A@@@@A@@@A@@@@@@@@@@@A@@@@@@BA@@AA@@@@@A@@@@@@@AA@@@@@A@@AA@@@AA@A@@@@AA@@@@@@BA@@@A@@@@@A@@@@@@@A@@A@@A@@A@@@@AA@@@@@AA@@@@A@@AA@@@@@@@@A@@@@@@AA@@A@@@@AA@@A@@A@@@@@AA@@@@AAAAAA@AAAAA@A@@@A@@@ABA@@@@@A@@BA@@@AB@@@AA@A@A@A@@@A@A@@BA@@B@@A@@@@@A@@@@@A@A@@@@@A@@@@@@BA@@@A@@@A@AA@@A@@@@@A@@B@@@@A@@@A@ABC@@@@@@BA@@B@@@@@B@@@BA@@BAB@B@@@BAB@@@B@@A@@B@@@@@@@@A@@@@AA@A@A@@@@AA@@@@@@@@@@@@@A@A@A@A@@@A@@B@@@B@@A@@BA@A@ABA@A@A@@BA@@@@B@@@B@B@@@@AB@@@B@BAB@B@@@@BB@@@@@B@@@@@B@@@@@@@B@@@B@@@@@B@@ABA@@@@BAB@B@@@@@B@@@BBB@@@B@@@@@@@@@B@@A@AB@@A@@B@@@@@BBB@BBB@@@@@@B@BBD@@@@@@BB@@@A@@B@@AB@B@@@@A@@@ABA@AB@@@@@B@@@@@B@@@B@B@@@B@B@@@BBB@@@BD@@@@BB@@B@@@@@B@BBBBB@BBB@@@B@@@@@@@@@@BB@@BB@@BB@@@B@B@B@@@B@@BB@@@B@@AB@@@B@@@@@@@B@@@@A@@B@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@AB@@@@@BBB@@@B@B@BA@@@@@@@C@@@@@ABA@@B@BAB@@@@@@@@C@@@@BA@A@@B@@AB@@@@A@@@@B@BA@AB@B@@@BA@@B@@A@@@AA@AC@@@A@@B@DB@@BA@@B@@BD@@@B@@@@@@@@@@A@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@B@B@B@@@@A@@@@@@B@@@DA@@@A@@@AB@@BB@@@B@@A@@@@@@@@D@@A@@@B@BB@@@BABA@@BBB@DAB@B@BAD@@@@A@@@@B@DAB@B@@@@A@@@A@@@@B@@@@@@A@@@@@@BA@@@@B@@@@AB@B@B@B@BB@BB@@@@AB@@@@@A@B@@@@@@@@@BA@@@@@B@B@B@@@@FD@@@@B@@@@@@@B@@@@B@@@@B@@@@@@B@@@@@@B@@@B@@A@@B@@@@@B@BA@@@@@@B@@@@@B@@A@@BA@A@@B@BBB@B@B@@@@@B@BA@@@@@A@@@@@@BABA@@@@@@@@AA@@@@@@@@B@@A@@B@@@@@B@B@B@@@B@@@B@@@@AB@@@@@B@@@@A@@@A@A@@@@B@BB@A@@DA@@@@@AA@@@@A@@@@BBB@@ABB@@BA@@@A@@@@BBB@@@@DB@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@BB@@@BD@B@BB@@@B@DBD@@@B@@@@@@A@@@@B@@@B@@AB@@@@@@@@B@@@@BA@@@@@@@@@@@@@@B@B@@@B@@B@@@@D@B@@@@@DBB@B@@@@@@@B@@@@@@B@@@@@B@@B@D@BA@@B@@@@@@@@@@A@@@@@@BB@BB@@@BAB@@@@A@@@@@@@@@@AA@A@@@@BBBA@@BBB@@BB@@@B@AA@@@A@@@@@A@@@A@@@@BA@@B@@A@@@@@@@A@@DA@@B@@@@A@@@A@@A@@@A@@@@@A@@@@AA@@A@@@@AC@A@@B@@A@@@@@AA@AA@@@@@A@A@@@@@A@@@AAA@A@@AAA@@A@@@A@AAC@@@A@@AA@@@@AA@@@@@A@@BA@@BA@@@A@@@A@@@@@@B@B@@@B@@AB@BA@@@@B@@@B@B@B@BBB@@@B@B@BBB@B@@@B@@@BAB@@@@@@@B@@@BB@@BBB@@BB@@@@@@@@@@A@@BABA@@@AB@@@B@@AB@B@@A@@B@@@@@BA@@B@B@B@B@B@@@BB@@B@@BDB@@B@@@B@@@@@@A@A@@BA@@@AB@@AB@@@@A@A@A@CBC@ABC@A@A@@AA@@@A@@@@@@@@@@B@DBB@B@@@B@@@B@@@B@@@B@@B@BB@@B@@@@D@B@B@B@B@B@BB@@B@@@BAB@B@BAD@D@B@B@@@B@@A@@B@@@@@@@B@@A@@ACAC@@@@@A@@BAB@@@@@B@@@B@B@B@DBB@@@D@B@D@F@@@D@B@D@D@FAB@@@@@BA@@BABCBABA@@@A@A@A@@@ABA@ABA@@BA@@@A@@@A@@B@@BB@B@D@@@B@BADAB@B@BA@@B@B@D@D@B@D@D@@@@@B@@@@@@@@@@A@C@@@A@@@@@AB@@A@@@ABA@@B@@@@@B@BAB@@@B@@@B@@@@@BA@@@@@@AA@@@@@AB@@@@@DA@@B@@AB@BA@@@@BA@A@ABA@@@@@A@A@@@@@A@@@@@@@@BBBDB@@@BBB@DBDBD@D@BB@@B@B@@@B@B@@@@@@@@@@@@A@@@@@A@@@@A@@A@A@@@@@@@AB@@A@@@AB@@AB@@@BA@@@@@AB@@@@@AA@@@A@@@@@AB@@A@@@@@@BA@A@@B@@ABABAB@BA@@BAB@BA@@B@BAB@@@B@@@D@B@@@B@@@@@DA@@B@BA@@@@B@@@D@@@B@B@@@B@@@@@BA@@@@@ABC@A@A@A@A@A@@@A@@@@@AA@@@BA@@DCBA@AB@@A@A@@BA@A@ABA@A@A@@@ABA@@BABADC@@BA@CBA@A@A@AB@@@@A@@B@@ABA@A@@@ABA@A@A@A@A@A@@AA@@@@@AB@@@DAB@BAD@@@BADA@AB@@@BA@@BABA@AB@@ABA@AB@@ABABA@@BA@@@@B@@AB@@@D@D@BAZABAF@D@FAB@F@@@D@D@B@DBB@DBD@@@BAD@BA@@A@@@CACA@@A@AAA@A@EAEAC@A@C@AA@@@@AA@@@@@A@A@@@@@A@@@@@@AA@@@@@@@@CA@@A@AAAB@@@@C@@@C@C@@BA@A@@@A@@@@@A@@AA@@@@AA@A@@AA@@@A@@@A@A@@@A@C@@@@@@AA@@@AA@A@AAC@@@C@A@@@AAC@AA@@@A@A@CBEBC@@B@@AA@@AC@@AAA@@@AA@@A@CACAAACAE@@@A@@@@@A@AB@@@B@@@BBB@B@B@D@BABABA@ABABEBA@CBCBA@A@A@A@A@A@A@@@A@AB@@AB@@@BA@@B@@@B@@@BA@@@C@@AC@AAC@AAA@C@AAA@A@@@CA@@A@A@@@CAE@A@A@C@CBCBA@ABAB@@AB@@AB@@@@A@AA@@AAA@A@CAC@@@@@A@@BA@@@A@@@@BA@@@A@@@A@A@@@ABA@@BA@ABCBAB@@ABA@@BA@@B@@@@@B@@@@@@@@@@@@A@A@A@A@A@A@A@@@A@@@@AA@@@@A@@A@A@ABA@@@AA@@A@@AA@@@CAAAAAAA@AA@@A@A@A@@@C@AA@AAAAAA@@AC@AAAAAACECAAAAA@A@@@A@E@E@CAC@@AA@AAA@@@AA@@@@ACAA@@AA@A@A@A@@@A@@B@BABA@@B@@@@A@AAA@@CAAAA@@@AAAAAAAA@@BA@A@@BC@AAA@@A@@AA@@@A@@@@@@@A@@BAA@B@AA@A@@AB@BABCDA@@B@B@B@B@B@BAD@BA@@BA@@BA@ADA@@B@BA@@BA@@BA@@BA@@B@@@BA@@@@@@@A@@B@@@@A@@@@@@@@@@B@B@F@B@@@@@@@B@@@@@BA@@@@@AB@@@@@B@@A@ABA@A@A@ABA@@BA@@@A@A@A@A@A@A@@@@@@AA@@@@BAB@BAB@@AB@@A@A@@@@@AA@@@@A@A@@BABA@@@A@@@AAA@A@@AAGEAA@ABADAHEBABEBA@A@A@C@@@A@@@A@@B@@@BA@@B@@A@@B@@A@@@AB@@A@@@@@A@@@@CACCCAAACAAA@AAA@@AAA@@@A@@@AAAAA@A@A@@AAA@@@@AA@AA@@AAAA@AAAA@AAA@@@A@A@A@@@@@@DAB@@A@@@@@A@@@A@AA@@A@@AA@@CA@AA@@@@A@@@A@@@A@@@A@@@@AA@AA@@A@@@AAAAACA@ACC@AACA@@@BA@@@A@@@A@@@@AA@AAA@@AA@AAA@A@@CC@@@@@@@@BABAB@BA@@@A@@@A@@@AAA@A@A@@@A@A@A@A@@@@A@@A@@@@@@B@@A@@BA@ABA@A@A@A@A@A@C@@BABABAB@@AAA@@AAC@A@AAAAAAC@@A@@@@@A@@@A@@@A@@A@A@A@A@C@C@A@@@CA@@C@A@A@A@A@ACAAAC@A@A@A@@BA@A@@@AAA@@@AAA@A@@@@AAC@AA@@A@AAA@A@AAA@@@ABA@A@@@AB@@@@C@@@A@CDA@AB@@@@AAA@AAAAAAC@AA@@A@A@@BA@ABA@AB@@@@A@@@A@AA@AA@@AA@AA@AAAA@A@@@CBA@@@ABC@A@A@A@@BA@@B@@A@A@ABA@A@A@A@C@A@@BA@A@@BA@A@ABA@@@@BA@A@@@A@C@A@A@CB@@A@@@@BA@@BA@@@@@A@@B@@@@@BA@@@ABC@@@A@ABA@A@ABAB@@A@A@AB@@A@AB@@A@AB@@ABAB@@A@C@A@@@A@CB@@C@@@A@C@@@C@C@A@C@A@A@A@A@AAC@A@@@ABC@ABA@AB@@C@AB@@A@A@A@A@CA@@A@@@CB@@ABABA@@@C@C@A@@BA@ABABA@AB@@C@A@G@GBIACBC@@BADCL@DAB@B@B@BBB@B@@@@A@@@AAA@AAEBC@E@ABABA@C@C@CA@@ABA@ABA@A@C@A@E@A@A@CB@@CBABABA@@BA@@B@@@B@B@@@B@@B@@BBB@BABCB@@A@C@C@ABC@A@C@A@@@@B@@@@AB@@@B@@@@@BABADADAB@B@DBBAD@B@B@D@B@B@B@DB@BB@BB@@@A@@@A@C@A@AAA@AACBA@A@A@CAA@CAC@C@A@@@A@A@C@EBA@@AA@CAA@CAC@@@AACAECCAA@@@ABAB@@CBGDCBEBCBA@A@ABA@A@A@ABC@A@@@A@A@@AA@@AAA@AAAAA@A@A@A@AAC@@AA@@@A@A@A@AAAAAA@A@CAC@C@CBA@EDABA@A@ABABIB@BA@A@AAC@CACAAAA@@@CAAA@A@@AAEEAA@@AA@AA@ECA@CAA@@AA@@A@A@A@@@@@A@CBA@AA@@@A@@@CBC@A@A@A@AA@@A@@@AA@@A@A@@@A@A@AB@@A@@@ABA@@@C@ABA@A@AAA@A@A@A@A@A@A@@@A@ABA@A@ABA@ABA@@B@@@@A@BA@@AA@@@A@@A@@@@@A@AA@@A@@A@@@A@@A@@@@AA@C@A@CAA@@@@AA@AA@A@@A@@@@@@@@@A@@@@@A@A@CA@@A@CAAAA@AAAAAAAAA@@AAAAA@AAAA@@@AAA@A@@@CBA@A@@@@@A@@@A@AAC@@@@@AAA@AAC@E@A@C@EBA@C@AA@@A@AC@@A@@AA@AA@@@@A@@@@@C@@BA@@@@BA@@@@@A@@BA@@@@@A@A@AAA@C@A@A@A@A@@@AAA@@@AA@@AA@AAA@AA@@@AB@@AB@B@BABA@@@ABA@C@@BA@A@A@@BABA@@@A@A@C@A@A@A@A@A@ABA@A@A@@@AA@@AAA@AA@@@ABA@@@AB@@@@A@@B@@ABA@@@A@@@@@@A@C@A@@BA@A@C@@@A@A@A@ABA@AAC@@A@A@@AAAA@@A@A@A@@AA@@A@@B@@@@@@AB@@A@@@@@@@@@@@A@@A@@A@@@A@@B@BA@ABABA@A@A@AAA@A@@@A@A@A@C@ABA@@@@@@@A@@@@@@A@CC@AA@@@A@A@A@@@C@E@@@IAC@A@@@A@@@@@A@CBABA@@@A@@@A@@@A@@@A@@@A@@@@@A@CDABA@AB@@A@@@@@A@A@@@C@A@AACACAC@CACCACAAAAACA@GCIC@A@BABEF@@@@@@AB@@A@G@C@@@AB@@ABABA@@DA@@@A@A@@@A@A@@AA@@AACAA@A@@@A@A@ABC@@@@@A@@A@@@CAE@AAA@E@A@EBE@C@@@A@BDA@@BEBABCD@@AB@@@@@@@A@@A@A@AA@AA@@@@@AA@@A@@@AA@@@@@@@@AA@AA@@A@@A@@@AA@@@@A@A@A@@@@@@@@A@@@A@@A@@A@@A@A@@@A@@BA@A@@@A@@@A@@BA@@AAB@@A@@@A@AB@@ABA@@@@@A@@@ABA@@@AB@@@@A@@@A@@@A@A@A@@AA@@AA@@@@AA@@@@AA@@@@A@@A@A@@@A@@@AAA@@@AA@@A@@@@@AA@@@@@@@A@@@@@A@AA@@@@@AA@@@AA@@@@@@@A@A@@@A@@@A@@@@@@@A@A@@B@@A@@@@@@BA@@@@B@@@@A@A@@@A@A@A@AB@@@@A@@@@@A@@@A@@@A@A@@@A@A@A@@@A@@AA@@@A@@@@@AA@@@@@AA@@@@AAA@@A@@A@@AAA@@@A@AAA@AAC@A@@AAAAAAAA@@@@A@@@@@A@@@@A@@@@@A@@@A@@@A@@AA@@@@@A@@@@AA@@@AAA@@@A@@@A@A@@@A@@@A@@@@@AAA@@@@@A@@@A@@BA@@@@@@@A@@B@@@@@@@@A@@@@@A@@BA@@@@@@@AB@@@AA@@@@@A@@@A@A@@@A@@@@@@B@@A@@@AB@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@@@@@@BA@@A@@A@@BA@@@A@A@@@@BB@@B@@@@A@@@@@@@@@@@A@@@@B@@@@@@@B@@A@@@@B@@A@@@BB@@@@@@@@A@@BA@@@@@AB@@@@@@A@@@A@@@@@@BA@@@A@@@@BA@@@@AA@@BA@@@@@A@@@@@@@A@A@@A@BA@@@@@AA@@@BA@@@@@@@@@@B@@@@@B@@@@@BA@@@AB@@A@@BA@@@@@@@A@@@@B@@B@A@@@@BA@@@A@@@@B@@A@@@A@@@A@@@AA@@@@AB@@A@A@A@@@@@@@@@@@@A@@@@@AA@@@@A@@@@@@AA@@@@@@@A@@@@A@@@A@@@A@A@AA@@@@@@@A@@@AA@@@@@AA@@A@@AA@@@A@@@@@@A@@AA@@@@@A@@@@@A@@A@@@A@@A@@@@AAA@@@A@@@@@A@@@@B@@A@@@@@A@@@AB@@A@A@A@@@A@@@A@AB@@A@A@@@A@A@A@@@A@A@@@A@A@A@"],encodeOffsets:[[132923,44184]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
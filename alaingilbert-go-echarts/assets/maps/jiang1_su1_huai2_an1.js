@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("淮安",{type:"FeatureCollection",features:[{type:"Feature",id:"320802",properties:{name:"清江浦区",cp:[119.026718,33.552627],childNum:1},geometry:{type:"Polygon",coordinates:["@@WKMGEAOIKGWOYO@@@@KLB@HDDBBB@BBDBF@LAH@H@D@F@FBF@DBHBD@BBFBDBDBDFFHNDFJNDFLPFJJPLTFHFHHNRRHLHJDF@BDHBDBDDFBB@B@D@F@BADADCFEJCDKRILAB@BEJGHEJCFCFEJGJELFBNCD@BBDBHBBBJHB@JFDDDDDDFDHHDBBBHFBBFFFDFDDFDDBDBBDBJBD@B@HAHAB@BAHCB@D@DAB@HCB@DCB@BAFCBABAB@DAD@D@D@D@B@D@BBDBJDJFBBDBBBD@D@L@FBBBBBBDBBBB@@BBDBDBD@DBDBD@F@B@B@@A@@@BD@ACD@BBF@LAF@JAHAF@D@B@BAB@@@HDFDFDDBDBHFBBBBDBFFDD@@DD@@@BAB@@@B@B@@BBB@B@HBBBBBBB@BFFB@@@HFBDDDBBBBBBBFBBBBBBABBBBABBDBBBDDA@@@@@@B@@A@@@ABBDB@@@B@FCBBBBFLDDBD@BNBHAACHB@GCA@CA@@EKABMD@@CDB@A@AB@@AD@@A@@@CG@@ACA@AGA@A@@@CA@BCA@BAC@DWH@@@@BD@@AD@H@@AD@A@BAOWBAAAA@IOACQ]EICCACEEA@CAC@@AC@@@CCAACA@@BAGA@@ECCG@@C@@E@@@IC@BA@@@@@@@@B@CCA@@BA@BAAAADA@DCAAGCCACACACC@@@@AA@@AAEEAAAAAA@@CA@ACAA@A@@AA@@AA@@@A@@@C@CBA@@@@@AA@@@@AB@@@A@@@@A@@@@@@@AB@@CCGA@CEAAACAQO@@CFCHCDGFAB@BAB@BBD@BA@@B@BKJCBABABGFGDAB@@@A@@A@@A@@ACDABADCDC@ABAFIFEFIBA@AHIDCDEDEBCFEFKBADEBCBABE@AB@@CBCBEBCBGBEDG@CFEDABCFCBAFC@AAAQI[O]OQISKMEGEIEUIUIUIOGEAQISGCACAcUABAB_OA@KEGC"],encodeOffsets:[[121814,34181]]}},{type:"Feature",id:"320803",properties:{name:"淮安区",cp:[119.141099,33.502868],childNum:1},geometry:{type:"Polygon",coordinates:["@@KCU@QBA@MHGBEBC@OBeFA@EBABIDK@I@EBCBKDMFCBCBCBCBAB@@IHABEDA@ABEDOJ@BA@ABGFED@@@L@@IB@A@@@A@AIFAB@@ACOBBB@B@@BB@@BFKHCBA@A@C@I@@FGAAH@DA@AB@@@D@BCB@@j@@@AX@NG@aA@@@B@BAD@BAB@BA@@B@BdVDBDBTHRJFBPHVJVJVJJFHFNFTLRJ^P\\PRJBB@BEDABEDADCBEF@DCHAFAHADAFAD@DA@@BAFABADCFABELEFADCFCFCDGJ@BABEJEFEJAB@BCDCDABCBBD@@@BB@@@@B@@BAHCHEBABADALI@A@AB@@AAC@ABA@ABAHEDCDGDE@@RPDBBBFB@DHBDD@@BA@@@@@@B@@@@@@B@@BA@@@@BB@@@@B@DAD@@@B@@@B@@BB@@BB@B@DB@BDB@@BBBBBBFFBB@@BB@@@@DDDBDBDBHDBBCDB@BCBBABB@@AB@DDA@@@@@@@@@ABD@@J@@@FD@@@DHFD@@HBAB@@DBBBDD@@D@@BD@DBB@FFBDDDFJR^BDJPB@BBABPXABB@C@@BG@C@@BC@@A@@G@CXD@ABB@ADB@@D@@@BHB@BDB@BH@@D@@@BC@@BA@@B@BCA@DC@ANLB@FB@@DDB@HGABDGBMAFJ@B@DDRBL@B@DAB@BABADADAB@B@B@@BDBBBD@@@BAF@B@BBDBBBB@BB@FDFBVHLDJBDBH@B@DBD@FBD@BBHBFBDBFBB@B@DBB@B@B@HAD@BADAFAHEFCBAFE@AHKBCBE@CBK@ABC@@BCHEDABABAB@@AGCBCBC@@FBBB@GBCBAJEPGDAFCCCBA@@@A@@FCFABAB@@@B@@@@@B@@@@@B@@@@@B@B@BARAB@FAZAD@D@J@D@FAD@N@B@FBH@PB@@@@@@B@D@B@@AB@B@@BB@@B@BJBB@D@DAHAB@B@@@D@FDFBBBF@FBB@BBB@@BBCLDFBDBFB@DF@@ACGBAB@B@DB@ABBB@@@AABAA@@@ACA@AAB@@AA@B@BAB@B@@@AADA@@AADA@@@BFAAAFAAGAB@A@@@AB@@B@@DA@CB@@@@AAAA@@A@@@AC@@@AA@@AE@@@@A@@@@A@@E@@C@@@CAAF@@CF@@BD@B@@A@@@AFDDCECIEECkUADLF@@A@@BAAABA@@@E@@AGB@CA@@C@@B@B@@@@A@@DBDCECBAF@B@@AD@@C@@@AF@@A@AEDA@BEA@BE@@A@@@@@@ABA@@@@@C@A@@@EBABAB@@CBA@C@C@CB@@C@C@A@C@A@C@@BABADCDEBC@A@@@A@ABC@@DC@ABA@C@ABEDG@@DGBA@C@A@C@CBABA@ABCBEBCA@A@CA@BGAAFA@@@A@@CB@DGCABCEB@CCAC@@C@@BA@AD@BE@@B@B@@D@@@BD@@D@@@B@@@B@BB@@@@CA@BC@@@DB@@D@AD@BADABA@@@A@@BA@@@@DE@EDE@CBE@A@AB@BA@A@@B@BB@@BA@@FABABA@A@CDA@ABAB@B@B@BA@ABAB@BB@@@@@@@A@@AAA@@@@@@AB@@@D@@@@@B@@@@A@@@A@@B@@@BB@@@@B@BAJG@@BAA@AADACAB@DD@ACCB@DD@@@AB@ACBA@@@BB@@BBAAABA@@BABBBF@@@BBB@@@AB@@@@@D@@@@A@@BA@ABA@@@AB@@@BABCBABADCHEFE@ADEB@@AB@B@FBF@BB@B@@ABD@@A@AB@@FD@BA@AB@D@DG@@F@@@BACA@@ABA@@ABA@@BGD@D@ACD@BDHB@AD@FCLJCMBAD@CGF@@EHBHD@CDBFBADDBDIEACAA@@ABE@C@@DC@AFCAEIFACJEEALEAAKD@@BA@@BAB@@ABECCIDAE@BEB@AEBMC@@@A@@@@A@E@@@@@@@E@GADAD@@AJABE@AA@CEGFCCA@@B@@AAAADAAAA@@@CAAACG@ACBG@@AA@A@CABAB@B@BA@@ACA@@A@A@A@CBE@@C@AAABCB@@A@@B@@BD@BB@@@AB@BCD@@AB@A@A@BA@@@GJ@@B@@BA@@BAB@@AB@@A@@@AB@@A@@@A@@@A@A@@@@@@DC@CB@@CBAB@@A@EBA@C@C@C@CAKEAB@@CAAB@@GABACAEAKAA@ABBDG@I@@B@@O@@AC@BEA@@AC@C@@@@@@D@BK@BEWD@C@@@@@@@CA@@@@@A@EB@@AE@@AFA@BE@@@@@CAB@CA@@A@@@@F@B@@@@@@@@A@AB@@ACCA@A@AB@@@@ABAB@@C@@@BC@AAAAABE@@@@@@@@GEAAAACA@@GACCAAA@@@A@@AA@C@@BC@A@A@@AAAQA@B@@GAI@MA@@AA@@AAA@AD@@A@@BA@A@AF@@MAI@@AA@A@A@A@@A@AA@CAG@@ECAE@@@A@@DA@@@A@@@A@B@C@@AAB@B@@@@A@A@@A@@@@@@@A@@A@ABEB@@A@AE@@@@A@AAAB@@@@A@@@@@C@@BE@A@@@@@A@@@@AAA@@AB@@BDA@@@AA@@@@@@@@@@@AA@@@A@C@C@AB@ACBAC@@@A@@@@QA@B@@@@@@A@EBC@@@C@@BG@AC@A@@A@@AI@C@@BEAA@CAADAA@A@@ABGABC@@@@@A@@A@@AC@@@@@GA@@A@@@KA@A@@@@A@AA@@A@A@@AA@A@CAC@CAA@C@BAA@@BAAABOEGAC@AAE@EACAA@A@CADEHKBAFIBC@CDKDE@ABCBCB@DAFCBA@@BAFIDECA@BA@@AA@@A@@CBC@C@CA@@B@A@@@@@@AA@@BB@@@@@@@@@@B@@A@@AA@@BG@GA@A@@@@A@@@@@@@@@AA@DC@@@@C@@A@C@A@AAG@EAIA@@@E@@E@@CB@@CC@@@A@@@AB@AA@@@@@A@A@@@@@A@@B@@A@C@A@@B@BEAO@GAMAAA@BMA"],encodeOffsets:[[122039,34087]]}},{type:"Feature",id:"320804",properties:{name:"淮阴区",cp:[119.034725,33.631892],childNum:1},geometry:{type:"Polygon",coordinates:["@@IOEIKOCEIMCEGMEEACACACAEMDACAD@AABA@DC@@BAA@@AAC@@A@A@BBEF@@DD@@@BCD@@ADECA@BA@@@@BA@@@ADABACA@@AACC@BCA@AAA@@CDAAADA@CD@AFE@@A@BA@@ABA@CD@A@ABAA@KIOK@@QMIGSMCCEAKEQIUGcC[AkD@PANU\\cj@@bF\\NjTBB@@BB@HDn@FBB@B@F@D@@A@@@@@AT@FAD@@AFB@@D@@@B@H@H@BAD@DAB@@@J@@B@B@@B@@@B@@@@@@B@@BB@@AB@BBBD@@BD@BDLBD@DBH@D@D@F@H@@@J@R@B@@@D@B@@@BDF@@@@@@F@@D@H@@BBBBBAD@@D@B@HBBC@@DB@B@@B@BBB@BB@B@@ABB@DBB@BBJ@B@@ABBBGDGDABEFGFCBABCBABABCDCHCHEF@BINEDADADAD@BCJAFAHEL@B@@DB@A@AB@@B@@@BD@@CB@ADHB@ABB@@B@@@B@BABBF@HBVF@AF@@@BA@@@@B@HDD@@D@@@BB@CXADEJA@@@@@ABB@BPB@@B@@@HDH@FFVBA@@@@@@B@@@B@@DB@@A@@BBD@AEHA@B@@@F@B@@BBBB@@DF@@BB@@A@BF@@@BCBACA@@BA@BDC@@B@@@DBB@@BHH@@B@@@@B@@B@DA@@BA@@@@@A@@AA@@DA@@AE@@BBD@@@AF@@@@BBFBFA@@@CB@@A@@@A@BDA@@BC@@BA@@A@@@DC@@BA@@D@@AH@DA@@BA@@FD@BF@B@@@DD@@FD@@BL@@DCAEB@JK@@@E@@BE@@@@J@@@BA@@FDA@@@@@AD@@FB@@B@@@BB@@D@@@D@@@@C@@D@@B@@@DBBD@@@@BBJHCHA@C@@@@@@@@FA@@F@@@DA@@B@@@B@@A@@FB@@J@BAB@DB@@D@B@BB@@@B@@@B@@BB@DB@@FB@D@BBB@@BBBBBDDB@BDH@HBDB@BBDDBBDBBDBFBF@@BBBB@@JFBBDDFDFDJHFDDBBB@BBBB@BBBBB@@@BBD@@@BDBD@@@BADAB@B@@B@LDB@B@B@D@BAB@BCDADAFCB@@@D@BAD@BBB@HBB@DBBBB@F@D@BBB@B@HDFFBBFFBBBDBB@@BBB@B@B@DCDA@@F@@@B@B@DBB@@BBB@BBDB@@B@@B@B@B@FAB@B@@@BB@@BB@@AFBB@B@@@BDBB@@BD@B@BAD@B@B@B@HFBBB@D@B@D@BABAHEBABCBAB@@AB@B@@@B@@AA@@ABAACF@@BB@BBJABAFAAC@@AC@E@@BA@CDABDB@@A@E@CBCAA@@@ACAAAA@@@DABAB@B@BBBAAGB@B@ACDCB@B@B@HAFAB@dKB@AAB@@@FA@@E@@C@@@@@@AD@@@EA@A@@EE@BCC@@E@@@CLB@@@ABB@A@@@B@@@@@@BADB@CB@@C@@AC@@BD@@@CA@B@BDBAAA@@@ABB@@@@BBAA@BD@B@ACD@@AFA@BBA@A@@BB@@@A@@B@FAAABABBDCB@ACB@DABADALEPEDAFAJC@DDA@AHC@@D@@CDK@A@@CUAA@@@@@@@@ACB@@A@@CGA@@@@AJA@@FA@@@@CAAACAAAAA@A@AAACAAC@@@A@CC@@@@@ACTAFA@@@@@A@C@@AE@AB@@AB@@A@@@AB@@ADA@@D@AAD@AKC@AGB@AA@@@ACB@CAED@AC@@@CB@@CAAB@NA@B@@@@DABFDAACL@@BB@@@B@@AB@@AB@@CB@@DFB@B@@@DD@@BDB@CD@BB@BR@@CDB@G@@@ABCF@ACB@@AB@@B@@B@@GAAH@@APA@@@ED@@@FAADJ@@@@@B@@B@@@@BBB@DBD@B@@@@@@@B@@@B@D@@@@AAIB@@BJ@@CAGACACA@@A@A@C@AA@@AA@A@A@A@A@A@@A@A@CAE@@AAAAGCA@AA@A@A@CBA@CBM@E@C@A@@@A@CAC@CA@AEACAEACAA@AEE@@@A@A@@AAA@AAA@CA@@CCEIAAA@@AEADEFD@A@@@CF@@AB@@@@C@@BA@BBIECECGC@@A@ABA@C@E@GBIBE@KBE@AAC@BDC@@A@@@BA@A@E@C@CACAC@CACAAA@@AAAAACAAAAEAK@C@C@AACAAAIEICCAAAC@A@C@C@C@C@CBA@ABABEDABA@CDA@GDA@CBC@A@GDABA@GBGBA@C@IACAAAACCCCEECECEEAAGEAACAGGECCCCCCCIEA@IGAAGACAAAC@MDEAFKHIFIDEDEFIHGFI@ABAJKLQDCFIDEBCBC@A@E@C@AAACEACACCG@ACEGIGKQQGMEGEGKS"],encodeOffsets:[[121793,34248]]}},{type:"Feature",id:"320813",properties:{name:"洪泽区",cp:[118.873241,33.294214],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAEACCCAC@C@@@G@C@EDABABCDCJ@DAJCTCPAF@B@@C@CBKHCBGDA@C@E@E@A@GAGAMASCEAG@G@KACA@@CAAAA@AB@@A@G@CBA@A@@@EB@@GBWAC@C@EAOBE@IAG@A@C@@@E@GBK@S@QD@BEH@FAN@@ARAV@F@BAX@FCANARADS@G@U@]@OCIACAA@A@A@C@EECAAAC@IAAAI@C@C@EBEBGB@@@CBA@@BACCCEAAC@A@EDE@@BAB@@@@EBA@C@@AAACA@@A@ABA@A@A@@@@@@@@A@@A@BAA@@@@A@@@@A@@C@AA@@@@AA@@AA@@A@@AA@@AAA@A@@@AAB@@@AAA@A@C@CA@@@@@A@@@AA@@@@@@@@A@@@@@@A@@@@@@@@AA@@B@@@@@@@@@A@@B@@@@@@@@@@@@@A@@@AA@@@@A@@@@@@AA@@@@A@@@@@@@@@AA@@@@@BAA@B@@@@@@A@@@A@@@AAA@@@@@A@@AA@A@@@@A@@A@@A@@@@@@@@@@@@@@A@@@@@@@AA@@@@AA@@@@@@A@@@@AA@@A@@AA@@@AA@@A@@BAB@@@@ABABAA@@@@@@@AA@A@@@@@A@@@B@AA@B@@AA@@AA@@B@@@@@A@@@AA@@B@B@@@@@AAB@@@@@@@@@A@C@@@B@@@@@A@@@@A@@@@@@@@@AA@B@@A@@A@@A@@A@@@DA@@@@@AB@A@@@ABAB@@AA@@@A@@@@@@@@@@AA@@BA@@@A@@BA@@@@@@@AB@B@B@@@@@BA@@@A@C@AA@AA@CE@BEACB@BA@@@@AA@@A@A@@@@BCA@@@DAD@D@@@B@@SAAO@YCOCOECAACKACCAACAB@HAF@@IB@BKB@@A@EBKBA@@@EB@@@@DHDJ@B@DBD@@@@BBBDABA@AB@@A@CB@D@HBB@DBDBFDFDFDFFFFFBDCBCD@ZC@G@@ABA@AC@BB@BI@@AA@A@@@@D@@@@B@@@B@@B@BA@@@AB@@@@@@ABA@@BA@AB@@BBB@FT@B@B@D@F@VG^@@CDUHUHKBA@^T^TÚTJxVd¤h|X\\NPlC\\BdDVHRJLFFBDDTNJHRN@@PLLJB@AB@B@BDCB@BA@@ABB@@@EF@BDCB@BCBBDC@@BB@BDB@ADDBB@@DBABCB@B@@AB@@@@ABB@FDBC@@DC@A@@CC@@FEAAB@B@@@BD@BB@AB@@CDB@BA@BBCBDNC@AACAG@CAE@E@E@C@GBG@KAEAC@AAACAGCA@LK@@@@ZPXPLHPJFBNHXLHDLFB@`PBABA@A@AB@@ABA@ABC@A@A@@bBH@@MBW@@i@@@DA@A@C@@BAB@@CBGHB@EJ@D@B@B@DALGAE@@AA@@@AAAPABD@@BAJE@B@B@@@BJA@@@K@@FCHEBAB@@APIFCBAB@FCBAJG@@BADADADADANELCDAFAJ@L@JCBAFAB@fEPAD@FAHANGFCDAD@FA@M@KCCUQCC@YBk@SYAI@K@A@E@A@A@AGE@CB@@IB@@EBE@GD@@M@aA@@@CACAAECA@AC@@C@EEAAA@EAEAE@EAA@AAE@@@QEE@@@CAA@AA@@E@A@@@CBCAKB@A@@@AA@@AA@A@EB@@A@AB@BA@A@C@@@A@CCA@@@A@AA@@AC@@@@@A@C@AA@A@AAC@EBCAIBC@A@AB@AAA@@@AD@@AB@@@A@@@B@@AJ@@@A@@ABAI@@@@@@@AFA@@@A@@@C@@BC@@@A@@@@BFA@@@@@B@@BBA@@BB@@@@@@A@@@@@@B@@BEDE@E@@I@A@IAG@GB@Cc@CkB@EA@@EB@@AF@@A@AA@@@AEA@@C@@@@AC@CDA@BHAACD@@BB@DAD@@@BDF@CIB@@@D@@@AGBA@@AAD@ACD@ACAE@@@@ACA@ACIPCBCD@@A@@@A@@@A@A@@BA@CEABA@@@AEA@@CB@AC@@BAR[MWEKDCDE@A`CF@ND@@DEDBBUBGGAA@UEOAEAA@EBUDA@E@KAKAAA"],encodeOffsets:[[121770,33845]]}},{type:"Feature",id:"320826",properties:{name:"涟水县",cp:[119.260227,33.781331],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@EE@AAAAAAAGAA@A@AA@@@A@A@@BA@A@@CC@@CCEECAAAAAGECACAAJ@AAB@@@D@@A@@BE@@D@@@BECCFFB@BB@BBFJDD@@DBB@BBB@BB@@@B@B@@FF@BBBBDBFBDBFB@@DBD@D@B@@@B@D@FAN@DAB@D@B@BBBB@HDBBBB@@BF@D@B@BB@B@B@B@B@B@@BB@@B@D@B@BB@BDBDBH@DI@@AA@BJ@B@@C@A@@@A@@@@@@@A@C@CAA@AA@@@@@AA@@@@@I@BCEB@@C@@F@@OB@BG@BB@HA@@@@AA@@BA@BDE@AD@B@@@HCA@DQ@@AAAC@@DCA@AC@@C@@@AEA@CA@@DA@@BA@@BA@@@A@@AK@BDCBAECB@@@@@AMBA@BB@DA@@D@@BDC@BF@DDA@B@@BBA@BHD@BLC@BBC@@@CB@BA@@B@@@BA@@BA@@BBF@@@D@B@@@@EBSBBD@@@@D@@D@B@@BDDBBB@B@BBBBBDBBBDB@@@@EB@@IB@B@@B@DH@@@BA@BD@@@@@@@@BBDV@@@BCL@DC@@@GD@BCB@CIDEBCBOFKFCBABCBA@BDA@CDAAABBBEBA@@@@B@@AA@@@BAB@AEB@BC@BDA@C@@ABBAA@@@@AA@B@@BBABACA@B@@D@@AC@@BD@@@DA@@DCAAB@@@@@@@A@@@BAA@B@@KA@D@@@FD@ADF@@FB@B@@F@@BC@@@@@@@DF@@@EB@@A@BBA@cLA@EBGBA@A@A@CDBDA@A@BHABAAA@A@ABCB@@B@BBDB@B@@BBAD@D@F@B@@BBB@@BB@DAB@@@@@BB@BBB@BFDBBFF@BBBB@@BD@B@DBBB@@BB@B@BDB@B@D@D@BB@D@@@B@A@@B@@@@B@BC@@@@B@@@B@@B@D@B@@B@@@D@BB@@B@@B@@@@@BA@CBA@@@@@@B@@BBB@D@DB@@F@B@@@BBBBB@BBBBDD@BDBB@BDA@@@A@BH@B@DC@@@EBDJB@@@@@@BAH@@IB@BCB@@@@@@EBA@DD@@@B@BABBB@BBBDDDDD@B@B@F@B@B@@BB@BHB@FDHBBB@@B@D@B@@AD@@@BBBBBBB@B@DBB@BDBB@BAD@BBDBB@@BDAD@B@B@@BB@BB@FDFFDBDDDBBB@@BBBDB@BBD@BBB@BDBBHFBBFBHDDB@@D@BBH@B@@@B@@BBBFFFF@BFBFDHHDB@B@@BD@BDB@BBBBB@BBD@B@@@B@BAB@D@@BJ@B@B@BBBBFBBBD@BD@BBB@@B@@BB@B@@BDBF@BB@@BB@DBBBHFFDD@B@LE@@@@@ABCD@BECA@@@CCCBCB@@A@@@A@@BBBB@CBA@AJIDD@@DCCAFEBCDCAAB@AADCDDDGB@B@@@@@DBBA@@N@DCBCFBLO@AB@BBBB@@DCB@@C@EBGPB@CD@BE@@JB@EJF@@@@@CJ@@@B@B@@BBBA@@B@DB@B@DH@BTABFD@@D@@JB@@DBLBB@@C@@DB@ABG@@D@@@@A@C@AB@AA@@@A@EB@FA@@@ADA@AB@BB@B@@@BFA@AH@@DHA@GB@@CH@@DFA@@B@@@F@D@@DB@B@@@@@B@B@@@DBF@NBH@NBF@NB@@@BLCB@D@FB@D@@@FD@@@@BB@@B@@@@BA@@@@@AB@@B@@BB@BD@@BD@FM@ABC@ADEBA@@@A@A@AB@DAB@DA@@BABA@AAC@ABA@@BA@A@@@ACCA@@CAA@C@AF@ABB@B@BEB@BA@@@BD@@B@@@@B@@AB@ABB@@B@@@@FC@@B@@@LD@AB@@@B@BA@@@@B@@ABBB@BEBA@A@@@@@@@AA@@@A@@@CABAAAB@AABA@@@AA@BCB@DCBB@A@BB@@AFBFC@A@@B@@A@A@@@A@AB@@@B@FB@BRJFBFBBBBBDBFDB@BDDBLFB@FDFDBBDBB@DDB@@B@@B@DBB@BB@B@B@@@@B@@@BBBBBCFBBA@@BAB@BBBA@@BBABABFBB@BB@@FJ@BB@HB@DD@ABCAABB@DB@@DBCF@@@BD@F@@@B@BCDB@AB@@@@ADB@@BA@A@@DBDEB@FB@EA@CA@A@@BAD@@@B@BCBG@BB@FB@AHDB@@ADB@A@@FB@CFB@@BEB@JBD@CA@@CABA@@CC@AF@B@@@D@BKNBLD@EDB@GBGDB@CLB@AD@D@@ADBBCB@BC@CICFKBAB@BEMCDCA@BAAA@@@@@@@AC@A@DED@@AB@BEEAEA@@AAICDCB@BAHDDBDAB@B@@HB@H@DC@B@BD@@AA@@C@ADEB@@@@@@A@@DA@BD@ACB@@E@A@A@@@@@ABABAB@D@B@@AB@B@DA@B@B@BBB@@BB@@BB@@DBFBBAB@@A@@@@FAAAHCB@A@@ABAACEBCIDA@E@@@@DCEEBAJC@B@@@@@BD@@C@@@A@C@AGO@@A@CA@@C@GAC@@ABA@C@AFB@AB@@@D@@@D@AGAA@A@A@AAA@@@@BCB@BA@A@@@@AAGEEAIGECGECCA@E@C@CAE@@@BC@@IECACACCIG@@@@ABGGEGECCCECAAKECAFCACAAAAABAAB@@@BAAAB@@A@BAABA@AGF@@@AA@A@@@@@AB@@@BAB@@AA@AAAB@@@B@BA@@A@@AAADA@@CB@@@ABA@@CB@@A@@@DC@@@@BAACA@@@@@@@@AAACB@AAAAAGGA@CCCEACAC@A@A@@BABCBE@A@AA@@AICEC@AA@@A@@@C@C@CAC@EAEAC@AAC@CAA@@MIAA@@AEAAACAEAC@A@AACCCAAAA@@AC@@@AAA@ACGAA@C@A@A@AH@@A@CAACB@AAAD@@CB@@AA@@@@@C@@C@A@E@A@@@AAA@@GCCA@@A@AAACAAACEEEECAEEACEAAAA@A@C@E@@CEACAEAKCAD@AA@AAA@EAE@AAEAECC@@@A@A@GBCBC@A@IA@A@AA@@AA@A@@BA@C@A@@@@@@@OAG@EAA@M@C@EBC@I@C@C@YBEBA@QBABA@A@@@@@A@@@@@A@@@@@A@@@A@ABEBED@@@B@@ABDDEDCBOHIFABAD@HAAEA@@ADADHD@BA@ABABCBGFAD@@AD@BAL@DAFADGL@BEFABEDGFEBCBABC@GBA@A@A@CAA@A@EACAEAGAAAC@EAC@CAA@G@CAIAKCUGEAECA@@AAAAAAC@A@ABE@A@@ACAAAC@@@A@ABABCBCBA@ABA@C@AAKCQ@C@AEI@AACCCEKAAAAEDA@@@A@ACBA@@B@@@@A@@@@B@CCAACAAAABAABAAAAAAAAEAAAAAACCACGE"],encodeOffsets:[[122043,34463]]}},{type:"Feature",id:"320831",properties:{name:"金湖县",cp:[119.020584,33.025433],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@@@@A@@@AA@@@@A@@@A@@@@AA@@@A@@@@@A@@A@@A@@@@@A@@@@@@@A@@@A@@@A@@@@@AA@@A@@@A@@@@@A@@@@@A@@@A@@@A@@@@@A@@AA@@@@@A@@@A@@@A@@@AA@@@B@@A@@@AB@@A@@BA@@@@@@B@@@B@@@@@@@B@@@@@B@@@@@BB@@@@B@@B@@@@BB@@@B@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@BA@@@@B@@@@@@@B@@@@@B@@@@@BB@@@@B@@@@@@@B@@B@@@@@@@B@@@@@BAB@@@@B@@B@@@@B@@B@@@@@@@B@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@B@@@B@@@@A@@@@BA@@@A@@B@@@@@@AB@@@@A@@@@@@B@@A@@B@@A@@B@@@@@B@@@@B@@@@B@@B@@@@@@@BB@@@@B@@@@B@@@BA@@B@@@@@B@@@@BB@@@@@@@B@@@B@@@@@B@@@B@@@@@B@@A@@B@@A@@B@@A@@B@@A@@B@@A@@B@@@@@B@@@BA@@B@@@B@@@@@@@@A@@@A@@@AA@@A@@@A@@@@@A@@@A@A@@@@BA@@@A@@@A@@BA@@@A@@@@B@@@BB@@@@BA@@@A@A@@@A@@@@@A@@@A@@@A@@@A@@@@@@@AA@@A@@@A@@@@@A@@@A@@@A@@@A@@@@@A@@@AA@@@@A@@@AA@@A@@@A@@A@@@@A@@@A@@@AA@@A@@@@@A@@AA@@@A@@@AA@@@@A@@@A@@AA@@@A@@@A@@AA@@@A@@@@@AA@@A@@@A@@@AA@@A@@@A@@AA@@@@@A@@@A@@AA@@@@@A@@@@@AA@@A@@@A@@@@@A@@@A@@@A@@AA@@@@@A@@@@@AB@@@@A@@@@@@@A@@@A@@@A@@@A@@@A@@@A@@BA@@@A@@@A@@@A@@AA@@@A@@@A@@@@AA@@@@AA@@@@@AA@@@@@AA@@@@@@@@AA@@@@@AA@@@@AA@@@@@@A@@@AA@BA@@@A@@@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@AB@@A@@@A@@@A@@@A@@BA@@@A@@@A@@BA@@@A@@@@@A@@B@@A@@@@@A@@@@@AB@@@@A@@@@@A@@@@B@@A@@@A@@@A@@@@@A@@@@@@A@@@A@@@A@@@A@@A@@@A@@@A@@@A@A@@@@@A@@@A@@@A@@@A@@@A@@@A@@@@B@@A@@B@@@@@B@@A@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@BB@@@@BB@@@@@@BB@@@@@@BB@@@@@@@BB@@@@B@@@@@@@BA@@@@@@@@BHR@@D@DJDJ@D@@@@AB@@@BBB@B@B@@@@@B@B@BABB@@B@B@@@B@@@D@@@B@@@@@@A@@@@BAB@@AB@@A@BB@@A@ABA@@@@@@@@DA@@B@BA@@@AB@B@BAD@@@@@BGAAAA@@BI@@A@@B@@@@@@A@@C@A@@@@DA@@@C@@A@@A@@AA@@BG@@EGB@@@AC@ABAB@FCAI@C@@ACAK@@AA@@AA@CDCG@@@AA@AC@@AC@@@@@AA@@AB@@@A@@E@@BB@@@C@@@@@A@@@AA@@@@@@B@@A@@BCAC@@@@@@@BD@@@@BBIB@@@@ED@@@@KHADEF@@AB@@BB@A@@@@@B@@@@B@@@B@B@@@B@@B@@@@@@B@BB@@@@B@@@@@B@BB@@@B@@@@@BBB@@B@@BB@@@@B@@@@B@@@@B@@@@@B@@B@B@@@B@@@@@@A@@B@@@B@@@@@B@@@@@@BBB@BBBBB@@@@B@@@@@@@@@B@@B@B@@@@B@@@B@@@@@@B@@DBD@@DFDB@B@B@B@@@B@@@BABAB@@@@@BA@A@@@@B@D@B@@@@BB@BBB@B@B@BBB@@@B@B@B@BA@@D@@@@@BB@@@@B@BAD@DAD@LA@@BB@BBBDD@@B@BBBBB@@BABDB@@@@BD@@B@BD@BB@T@DBDB@@@B[HD@D@DBDDFBBBBBLBLBF@B@VCFAB@FBPBVFB@HBAHAVCACF@@MCE@_D@BCFCDFLNXQ\\AB@@BDA@@DB@BF@@B@BADFB@@AB@B@@@B@@@B@@@DCDAJOBDB@BD@@@@BFBDC@BDC@BB@@ABBH@@C@@@A@DJE@AC@@C@CBA@@AC@BDGB@ACB@DBD@@@@@DB@BF@@B@@B@BE@@BA@@FB@@FlA@DDdA@@HBH@J@B@JF@F@FC@AA@@@@@@@@B@@@@A@@AB@AA@@@A@@@@EB@A@@B@@@D@@AD@@@B@@@B@BE@@@@@@J@AB@BB@@@I@@BA@@@B@@@A@@BC@@B@@BB@BBAB@D@JADBFAD@BBB@B@@B@D@B@@@@BD@@BBB@@@B@DDB@@@D@B@B@@ABAB@@@FAB@B@@BB@@B@@@BLADBDA@@B@F@@@BBB@DB@@F@RF@@F@BBB@FBF@FBFBB@BBFFD@@@BDB@FDBBBD@D@@bBN@@@HCF@FA@@JA@@DAF@BHB@B@F@B@L@J@ZBF@FA@@DA@BFADIBEB@BIDBD@@@@B@BB@@@BDDFBBB@L@BAB@B@JAN@D@H@@BB@@@B@@AB@@CR@LBAAB@FQ@AC@@A@A@A@@@A@@@@@A@C@@@A@EJ@BG@ADA@A@AFUGACADS@AXJTNRAAT@N@@BA@@DCDEBEBA@@BCBA@@BEHG@@DEBA@@BCB@@@BCBA@@@@DG@@NE@@@@DCDCDCB@BAFAJAB@BAB@FAFEHEB@BAHEBK@E@C@@@C@@B@@I@@B@@@@ABEBE@ADCPSBADC@ADIBGBCDE@ABCBAJCDCB@NGDCB@@A@C@A@@AA@@@A@@BA@@@AF@BA@@@A@A@AAC@CCC@AKSMOOQQMKGCAAGCCAAAAACAA@A@G@uBA}A[@g@gCCuIgAYBg@sDcDQA@CE@E@uAWA]AMAMBQHGJORYbMLADIDIB@@@BA@@@@@@BA@@@@@@BA@@@@BA@@@@BA@@@@@@B@@A@@@@BA@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@AB@@@@@@@@@B@@A@@@@@@B@@@@@BA@@@@B@@@B@@A@@B@@@@@B@@AB@@@@@@A@@B@@@@@@AB@@@@@@AB@@@@@@@B@@A@@@@@@B@@A@@@@B@@@@@B@@@@@B@@@B@@@@@B@@@@BB@@@@AB@@@B@@@B@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@BA@"],encodeOffsets:[[121902,33702]]}},{type:"Feature",id:"320830",properties:{name:"盱眙县",cp:[118.54436,33.011971],childNum:1},geometry:{type:"Polygon",coordinates:["@@IAEAC@E@C@EDCBCDE@G@C@EACAEAE@C@E@CBABA@CDCBADCB@DAF@DBDDFDDDD@DAB@F@D@@@H@F@BCFCDAF@FABABABE@EBKBE@C@GACCAAACAACCACAAE@GBABAHBF@DAFABCDC@EBG@C@C@E@CBEBCFCD@FEL@DAD@DBDFBD@DBDBDD@HAF@FADADABAFBD@@BDCB@BA@CD@B@D@F@BFN@@CBC@EAEAC@EDAD@B@FBFCBA@C@G@K@E@C@GAGCIEECAACV@FEJ@B@B@B@B@BAB@@@B@BAB@BA@@BAB@@AB@B@B@@AB@B@B@BAB@B@B@B@@@BAB@B@B@B@BAB@B@B@B@@AB@B@B@@@B@B@BAB@B@B@B@@AB@B@B@B@BAB@B@B@B@B@B@@@B@B@B@@BB@BBB@@BB@BBBB@BBBB@@BB@@B@BBBBB@B@@BB@BBB@B@BBB@B@BBB@@@B@BBB@B@BBB@B@B@BBB@B@BBB@B@B@BBB@B@B@@@BBB@B@B@B@B@B@B@BBB@B@B@B@BBB@B@BBB@BBB@B@BBBBB@BDFDDDDBDBB@FF@@BB@@@B@@@@@B@@@@@BA@@B@@AB@B@@A@EDA@EBG@I@A@K@I@IA@@MBSLEFABEFENAHA@EL@DAP@H@P@PADAJCXELAFABCH@@@@A@A@@@A@@@A@A@@@AB@@CA@BA@@@A@A@@@A@@@A@@@A@@@A@@@A@AB@@A@@@A@@@@@A@A@@@@@@B@@@B@@@BB@@@@B@@@B@@@B@@B@@B@@@B@@@BB@@@B@@@B@@@B@@@B@B@@@BA@@B@@@B@@@B@B@@@B@@@@@B@B@B@CHCJAJA@@@A@A@@BA@@@A@A@@@A@@@ABA@@@A@@@A@A@@BA@@@A@A@@@A@@BA@A@@@A@@@A@@@ABA@@@@@HF@@B@BA@@B@B@@@BA@@B@@@B@@@BAB@@@B@B@@@BAB@@@B@B@@@@@BB@@@BBB@F@LCL@BA@UC@FOA@@PBBJOCBDJBTBB@@B@DBXBXBDBPBLCFAD@@@B@@@BB@@@@B@@@B@B@B@F@B@F@D@BB@FBDBDB@@B@@@AAB@@@@ABB@@@@@D@@@@BD@@@@@@@@@@@@B@@@@@@BB@@B@ABBA@B@@@B@@@B@@@BBB@B@BA@B@@A@BBB@@@@@@@BA@@@@@B@@@BB@@@@@@B@@B@@@FBB@@@BDB@@BDBB@@@BB@@@BDDBB@BNDB@XLb@rANAVAI@@ZCVCLCXCTHDVFJBB@LAVGVGDC@@H]@U@E@C@A@AESA@AA@@BAB@@AB@BA@@@@@@BA@@B@@A@AA@@@A@@@@@@C@@B@B@@BJ@@AAAD@@BAB@BH@D@@YDCDAACEEEECECECEAEAC@CAA@G@CDAB@@@BAB@BAACAA@@@@AC@C@ACICG@@@@FA@@B@LAFAB@@@LA@AJA@@BE@GBABDDBBDDLBBFDDPDP@ZBPTB@@@A@@@CBC@C@@DB@A@@B@B@@@BB@@@@ABA@BDAFF@@DBBB@@B@D@B@@AB@@@@A@A@A@@B@@@@@@AB@@@B@@AB@@BB@@@@@@@@@@@B@@BB@@BABA@@B@A@@B@@@@CB@@B@@@@BB@@@@BA@B@@B@@@@@@@@@B@@B@@@@@A@@@D@B@@@@@@@@@A@BB@@@@A@A@@@BB@@B@@@@@A@@@BB@@BB@@@ABBA@@@B@@@@@B@B@@B@@@@@@BBBABA@@@@BA@AB@@@BB@@B@@BB@@@BB@@@@@B@@@@B@@B@@B@@B@@@@@@@B@@@@@@@@@@@@B@@@@BB@@@@@@BBB@@@B@@@@BB@B@@@B@@@B@@@@A@B@AB@@@@B@@B@@@@@@@@@B@@B@@B@@@@B@@@@@BB@@B@@@@@@@@@@@@@A@@@@B@@@@@@@@@AB@@B@@@@@@B@@@@@@@@B@@@@@@B@@B@@@B@@@@DBD@B@B@BB@@A@BB@@B@B@BB@@BB@@@BB@@BB@@B@@B@@B@DB@@@@@@B@@B@ABB@@@@B@@@@@@B@B@B@BAB@@@DBBB@BD@B@FA@@@@BA@AF@FCB@D@BBDFDDAB@@AB@D@@HAFAFAD@D@J@BBJBD@BBDBFFD@B@B@B@DBJBPD^@V@H@T@BCBQBMD@EBW@A@EBUBQ@@BM@EFG@ARCT@L@HAF@@@D@B@H@JBF@PAFBD@D@XBHA@@FA@@B@B@DAH@B@@@BAB@BBDB@@DBLBH@H@FBTDNBHBHBB@F@F@D@B@HCDALGDAD@@@@ABEDODSBI@CDIDCBABAFCD@H@@@\\G@A@@CACAS@A@@AACA@@@AC@@@@CABA@AA@AAAAA@@@CCAA@AAA@@KBC@CBC@ABA@@@@@AA@@@@C@@@ABA@A@A@@@A@AAA@A@A@AAA@@A@@A@C@A@@@@@@BAB@@@@A@ABAB@@A@@@A@A@A@A@EC@CC@CA@@@A@@@@A@@@A@@@@@@A@AA@@@@@@@@@A@@@@@AAAA@AAA@A@@@@A@@@@@A@@@A@@@@B@@@@A@@@A@A@@@@A@@@@@A@@A@@@@@@A@@A@@AA@@@AA@A@@@@@A@@AAA@@@@@A@@@@@AAA@@@@@@@@AA@@@A@A@@@A@@@@@@A@@@@@BAA@@BA@@FEBCLG@@@@FC@@@@JAAA@@@@AC@@@@@@D@DB@AB@@@@A@@@@B@@B@@@B@@@@@D@@AA@@@FB@@@A@@BB@@B@@@@BD@@BDB@@B@@DHDCB@@BB@@BL@DB@BD@J@DB@EBABAD@@B@@HA@FH@@AB@@BB@@@@BD@@@B@@C@@B@D@@@@B@@@@A@@@@BJ@@AB@BBHB@A@@@@BC@A@ABA@@B@@A@AB@@C@@@@@@B@BAB@@@AAB@@@BA@@BA@A@@B@@@@@@@@A@@@C@@@A@@@A@AA@BA@A@A@A@@@@@A@AAA@A@@BA@@@@@CCICIC@@@GQ@AA@@BA@@@A@@@@@A@@@AB@@@@A@@@@@A@@@@@A@@@A@@@A@@@@@A@@@@@AA@@@@@A@@@@@A@@@@@AA@@@A@@BA@@@A@@@A@@@A@@@AB@@@@A@@@A@@@A@@@A@@@@@@@A@@@@@A@@@@AA@@@@@A@@@@@AA@@A@@A@@A@@@@AA@AB@@A@@@A@@@@@AB@@@@AB@@@@AB@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@A@@A@@A@@@@@A@@@@@A@@A@@@@A@@@@@@AA@@@@@@A@@B@@A@@@@@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@@@A@@@A@@@@@A@@@@@A@@@AA@@A@@@@@@AA@@@@@BA@@@A@@@A@@@@@A@@@A@@BA@@@@@@@AB@@@@A@@B@@A@@BA@@@@@AB@@@@A@@@@@A@@@@@@@@@A@@@@@A@@@@@@@@BA@@@@@A@@@@@@@AA@@A@@@@@AA@@@@A@@@@@AA@@@@@@A@BA@@@A@@@A@@@AB@@@@@@A@@@A@@A@@A@@@@AA@@@@A@@@A@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@@@@A@@@@@@AA@@@@A@@@A@@BA@@@@@A@@BA@@@A@@BA@@@@@A@@B@@A@@@@@@@AB@@@@@@@B@@A@@@@@A@@B@@A@@@@@A@@BA@@@@@AB@@A@@@A@@@A@@@@@A@@@@@@@A@@A@@@@A@@@AA@@@@A@@@@AA@@@@@A@@A@@@@AA@@@@AA@@@@@@@AA@@@@A@@@@@@@A@@@@@A@@@@@A@@@A@@@@@AB@@@BA@@@@B@@A@@B@@A@@B@@A@@@AB@@@@AA@@@@@@A@@@A@@@@@A@@@@@@@A@@AA@@@@@@@A@@BA@@@A@@@A@@@@@A@@@@@@@AB@@@@@@A@@A@@A@@@@@AA@@AA@@@@A@@@A@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@AA@@@@@@A@@@@@A@@@@@A@@@@@@A@@AA@@@A@@@A@@A@@@@AA@@@@@@@A@@@@@A@@@@@@@A@@B@@@@@BA@@@@@@B@@@@A@@B@@@@@@AB@@@@@BA@@@@BA@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@AA@@@A@@@@@A@@@@@A@@@A@@@A@@A@@AA@@@@@AA@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@A@@@@@@@A@@@@@A@C@GCEEE@CBE@EAABABCBABCAA@ABABA@AAAAA@@BAB@BAAA@CDA@ABA@@AA@@@@B@@A@@@@@A@BB@@AB@A@@A@@@@B@@@@A@@@@A@@@@@BA@@@A@@@@@@A@@A@@@@B@@@@A@@@@@A@@@A@@B@@A@@A@@@@@AA@@@@@AA@A@@@@@@A@@AA@@@@@@@@A@@A@@BAA@@B@@@@@@@@A@@@@A@@@@A@@ACB@A@@A@@@@@A@@@@@@@A@@@@@@@AB@@@@A@@@A@@@A@@@@@A@@@A@@B@@A@@@A@@@@@A@@@A@@@@@A@@@A@@@@BA@@@A@@@A@@@AB@@@@A@@@A@@@@@@@A@@@A@@@@@A@@@A@@@@@A@@BA@@@A@@@@@A@@@A@@@A@@@A@@@@@A@@@@AA@@@AB@@@@A@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@@AB@@A@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@A@@@A@@@@@A@A@@@@A@@A@@@@@@A@@A@@A@@@@@@A@@A@@@@A@@A@@@@A@@A@@A@@A@@@@@@@AB@@@@A@@@@BA@@@A@@@@@@@A@@@@@A@@A@@A@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@BA@@@@@A@@@A@@@A@@@A@@@@@AA@@A@@@A@@@@@@@A@@@@@@@@@A@@@@B@@A@@B@@A@@B@@A@@B@@A@@@@@@@AB@@A@@@@@A@@@@B@@A@@@A@@@@BA@@@@@@@A@@BA@@@@@A@@@@BA@@@@@@@@@@@@B@@AB@@@@@@@@A@@@@@@@@@@BA@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@B@@@@A@@@@@@@@@@@@B@@ABAA@@AB@A@@A@@B@@@@@@@@@AA@@@AB@BC@A@A@AAA@@@A@@@A@@@@@A@@A@@@A@@@AA@@A@@@A@@@AA@@A@@@A@@AA@@@A@@@@@AA@@A@A@A@@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@A@@AA@@@@@AA@@@@A@@@@@@AA@@@@@AA@@@@@@A@@@AA@@@@A@@@@AA@@@@A@@A@@A@@@@@AA@@A@@@@CASEMBGDSJG@CBIBOBEFK@IDGBAD@@@@@@AB@@@@@BA@@@@@@BA@@@@@A@@B@@A@@@A@@@AB@@A@A@@@@@A@@@AB@@@@A@@@A@@@@@AB@@A@@@A@@@A@@@@@A@@@@@A@@@@@AA@@@@@@A@@A@@@@@@A@@A@@@@@@AA@@@@@@A@@AA@@@@BA@@@A@@B@@A@A@@@A@@@A@@@A@@@A@@@AA@@A@@@A@@@A@@@A@@@AA@@@@A@@AA@@@@@@A@@A@@A@@@@AA@@@@@A@@A@@A@@@@AA@@@@@AA@@A@@@@A@@@@A@@@@A@@A@@@@AA@@@@@@@@@AA@@@@@A@@@@AA@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@@A@@@@@A@@@@@AA@@@@A@@@A@A@@@A@@@@@A@@@A@A@@@A@@@@AAA@@A@@A@@A@@@A@@@A@@@A@A@@@@@A@@AA@@@@@A@@AA@@@A@@@A@@AA@@@A@@@@@A@@@@@A@@@@@AB@@A@@@@BA@@@AB@@@@@BA@@@A@@B@@@@@@AB@@@@A@@B@@@@A@@@@@@BA@@@@@A@@B@@@@A@@@@@@BA@@@@@@@AB@@@@A@@@A@A@@@@@A@@@A@@@A@@@@@A@@@A@@@A@@@A@A@@@@@A@@@A@A@@@A@@A@@A@@@@@A@@@@@A@@@A@@@@AA@@@@@A@@@A@@@@@AA@@A@@@A@@@A@@@AA@@@@A@A@@@@@A@@@A@@@A@@@A@@@@@A@@@A@@@A@@@@AA@@@@@A@@A@@A@@@@@A@@A@@A@@@@BA@@@A@@@@BA@@@@@A@@BA@@@A@@@@@A@@@@@A@@@AA@@@@A@@@@@A@@@@AA@@@@@A@@@@@AA@@A@E@E@C@C@CBA@A@C@EAAACAGBA@ABCAC@C@E@CBCBA@E@CDEJCF@DABAHABBDC@CCCACACC@AACAACCAAACCACCACCCCACACCA@"],encodeOffsets:[[121266,33504]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("连云港",{type:"FeatureCollection",features:[{type:"Feature",id:"320703",properties:{name:"连云区",cp:[119.338788,34.760249],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@B@@@@AA@A@@@@B@@@@B@@@"],["@@@B@B@@@@B@@@@@B@@@@A@@@@@AA@@A@@A@@B"],["@@F@FALCDAHABCB@@A@A@@AA@@AAA@ABCBA@A@A@@@BAAACAAAA@@@@AAA@@@AA@A@A@CAEBE@ABC@A@A@AAA@A@@@A@ABA@MLUHE@E@kAmCQHQBGBC@AA@@@A@ABABADADEDAB@F@PAF@P@P@L@B@N@B@B@zCDAPGFA\\KfMLALDRFFAAOCEIEIAAECECA_@AAAC@C@E@CBC@A@C@AAAAAE@A@CBADABA@A@C@A@AACA@CACAKACKK@C@C@AFCt[HC^QNERMRGTB^EJKFS\\atYZMjUJC[CAEACAA@GEA@EACAACA@@ACCAAAEA@AAA@@@A@A@ABCFABA@A@A@@@A@CAEEECCAEAAAA@A@GDC@ABEACBIBE@C@A@CBC@@AA@CAAAG@C@@@A@CBEFEDABA@ABCBABABC@C@E@A@A@CACCIEE@CAQ@IAIAKAMAG@A@EA@@IAEAAAE@EAA@GAEAEAKCCAEAGAYIE@QCAAM@@DCDA@@AEFCD[HQDUD@@@BBFBH@@IB@BE@@BC@AJGDEFLTCB@@ECEG@GCBMBMDA@ABGBABCBMJMLIFEFED@@@DLt@F^BD@BBB@BBB@DD^ZDDFJ^AFG@@FDDB@@EH@B@FB@@@@@@@KP@@A@B@ZPLF@F@@n@B@@ABAAAAA@EBEBCD@@A@GAE@@@C@@@CAGHABKEGHGJKD@BBD@B@@@B@@@BBBEB@BA@AA@@@@@AAAA@@A@@A@@AABAAA@@@AAA@CA@@@B@@AAAA@@CBABCB@@@BA@@@AAAAC@A@A@@@ABA@@BA@CDABABCBADCB@BCB@@A@@@@@AA@@@A@@@@@A@@@@@A@@AA@@@A@A@@@A@AACA@@@@@A@A@@@@@@@@C@@@AA@@@A@AA@@@@AB@@A@A@A@C@A@@@CA@@@@@@ABA@@@A@A@A@AAA@A@@AA@A@@@@@AA@@ABA@AB@@AB@@AB@@@@A@@B@@AAC@C@A@A@ABAB@@@@ABABA@@@ABA@@BA@AB@@@BAB@BAB@B@AA@@@A@@@CBABE@E@A@AB@BABE@@@C@@@@@AD@@@@A@A@ED@@@@A@@A@@@@@@A@ABA@A@A@@@@@A@@@@@@@CBAAEEKKCBED@BAAC@AAA@@AIC@@@@EA@@AA@@@ABA@@@@@CDM@AAACAAE@@ACAIAE@EAG@@A@@EGBG@IB@@AH@DCZUBCBA@@FBF@@@BC@A@@@AAEA@@A@@@BF@@@DB@@@B@DB@D@BB@@@BD@@A@@BBBBBBB@@B@B@@B@@@@BBBDBB@B@B@BABA@@BCD@@AB@B@@@B@BB@@DBDBBBBB@D@B@DBB@B@DDFHBDBB@D@DBDBBBBBDDD@BBD@DBD@BAB@B@BA@CDC@@BABAD@D@BC@@ACBA@@@C@BD@FE@G@@BC@@A@@@@@AG@@D@@@AGA@BA@AAE@@@DDB@eREB@@AB@BDLHnBB@B@HBHJA@@@@RAXONMDBKLCDCBCBADEDEDEBMBDNBFGB@BBDC@GA@@F\\@H@BDHFAFBF@PFBBFDND@BD@dFHKlyNGJAZHRA\\KPKhUXIN@D@D@F@HAD@BBBDBDD@B@FBDBD@F@HANCFADA@A@A@C@ABABAB@^DzDL@\\BE\\@B@BB@B@B@D@DABAFCB@B@@@B@B@B@B@DA@ABEBEBCBAB@DAF@D@L@D@B@B@BA@@@ABC@A@@D@BA"]],encodeOffsets:[[[122250,35591]],[[122360,35525]],[[122351,35598]]]}},{type:"Feature",id:"320706",properties:{name:"海州区",cp:[119.163509,34.572274],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@AMG@@DCBEBAEA@BA@CAEHCCGEGICECAACIBO@GBK@IBA@G@@@@AD@@G@C@EG@@FA@@DC@@F@@A@@AB@@GK@@AE@@BC@@AC@@BAA@@G@CAACA@@@@@BJEB@@AA@@@DA@@EA@WD@H@@ABA@C@BJE@GDGBABE@@KKBBBG@BH@B@@@@GACAMGAA@@@@@BBR@DBLZA@ANC@DD@@DCB@D@@C@@@@D@@F@@BA@@BB@@@@BBFKBA@@D@@CB@F@BF@@D@B@BJ@@B@DFA@BFb@H@JBP@@@@EB@@@@DF@@@BAB@@C@K@@H@@@B@@FB@BFFB@AHHDDBFBB@@@DB@BB@@DA@AH@B@B@BAD@DAB@B@@@B@B@BDFBFDD@B@B@B@@@B@D@@A@[B@@@BBD@BB@F@NABFB@B@@@B@ABA@KHKDKBI@[EQA@K@m@CIAEACAUGAACCK]G@GU@@@O@CA@@AYAEEK@IAQEADMFIHMB@@@DAN@B@BB@@V@dGBALIHBHI@@BlHFJBDAJCB@NP@BPQD@BFLFRNAF^BBDCHALALA@@B^I@B`@@MBEDAHBB@DDLB@@H@XK@M@A@@FBHB@ADHBNFFBF@@CB@@DD@LAD@B@JAHEHAHCDAFABDD@BCHGDEDA@AB@DCJCDA@A@@D@H@F@H@J@FAF@@DH@B@BGB@PD@@@@@BA@CAAHXFBBRDFBF@NAAGD@@HL@B@F@@BD@@@D@@BLB@GHBB@LBD@D@D@D@@@B@BA@AB@B@@A@A@@@ABA@@DC@AB@BA@A@A@AAAACAA@@@@@AA@A@@@AAAAAA@AB@@@AC@@A@@A@CCAA@@@A@@C@@AE@@B@@@FBBB@@B@D@@A@@AE@EB@DAVADY@CBG@@JAH@HA@FB@@@BH@FBFBJBD@@BFDBBB@BCN@D@@@@AB@B@@BB@@FB@@@@JD@BB@BBD@BB@AFCDALLFFBBDA@@@@@@B@@@@@B@B@B@BAB@@@@@@@@BB@@@@@FCB@B@@@@@BC@@@@D@@@F@BA@ABAB@F@F@BADA@@B@@@B@@B@ABA@ABA@A@@BAB@@AB@BA@@B@BABA@@@@BABAB@B@D@D@BB@@@AB@@@@@BA@@BA@@BAB@BA@@BB@@@@B@B@@BB@B@BBB@B@B@@@B@BA@@@@@@DB@@B@D@B@B@B@@@BA@@@@BBB@@@B@@B@@@D@@@@@@B@B@@@@@B@BD@B@B@@@B@B@@BB@@@B@@@@@B@@@@@B@@BB@@@@B@@@DA@ADABCDABABADCB@@AB@BA@@B@B@D@BBBB@@B@@A@@DABADA@@BBBB@@@A@@DBB@BB@@B@BBBA@BB@@@@BB@BB@B@@@@BBB@@AFAAA@A@@@A@@@AAC@ALCHIHGLFBAHGDB@@D@@@F@HBB@@@DCFAFAB@BBBBBA@@@Am@@@EKEYOA@B@@@LO@@@@@@A@@E@AFG@@CAEC@@EH]BEICC]YCCA@AAA@AAC@]A@EKs@C@@FCFEJENKNIDABAHABAB@NCNADA@HFHFD@@DAKSFEHCBID@@AF@@AJA@@AGAE@A@@VCRC\\GDCFE@@AE]@C@_BYAY@_AUAaAOAK@M@MA]@MEA@@@AAG@AAO@ADUAG@AA"],encodeOffsets:[[122081,35286]]}},{type:"Feature",id:"320707",properties:{name:"赣榆区",cp:[119.17333,34.841348],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@CBA@AA@AAACAA@@@@BB@BDBBDB@@BB@@B@B@B@@@BA@@AA@AA@@@"],["@@@HBD@B@@@@@@@@DB@@DBH@VFHBHBLFFBJDDBDBDDBBBBDBB@D@LDB@F@DBBAFADEFEFCFAD@PDD@BBHBDBD@DBD@BBH@D@J@FBFBF@B@DAB@HDB@FFFBF@F@BAF@F@D@F@F@BAL@J@FAD@F@FBDAF@BBF@J@DAHBFBFDBBDBD@F@D@F@FA@@D@B@F@F@D@H@H@LAH@@@D@FD@@BB@XBB@BB@JFDDBDBDHVDFBDDBBBF@F@D@DBHBHBJDFDDBFBHBH@J@D@HDJBR@FADADA@@D@BAH@H@FDDD@@BF@@BBD@FBDBDDDFDBF@FBD@BB@@BADA@@B@@@@@@@@@@@B@@@B@B@@AB@BAB@@@@@B@@@B@@AB@@@B@@@B@@@@A@@@@@@B@@@@AB@@@B@@AB@B@B@B@@@B@@@BA@@@@B@@@@@B@D@B@DAHB@@B@@@BA@@B@@@BABABAB@@@BA@@@A@@BA@@@AB@@A@C@A@ABCBA@@BABAB@BAB@@A@ABCB@HaSCECAC@CRkFODGACWGABEN@BJF@BQvEDE@MACAGKMG@@SGA@MIGAAAACECAEKYCKACIaCGEOCMAE@A@S@E@WBKD[@YCOCKCICIGS@CDINGDICQAIFKJGFMAIKIAEcEC@@AMCECAAOEE@EAEBCG@A@GE[@@HBD@AC@AHAAECMNAFAFCFCBCDADADCLKCAMNWPQB@@@@IBAG@G@AAAGmCK@ABA@@FAfQA@CC@@F@BBB@@AHB@B@@@CH@@B@@@@@BD@@AH@F@@EACD@@@B@DA@BD@@A@CBCBA@AD@DCB@@A@ABA@AAC@CAC@ACCACAAAAAC@C@CAAACEGCCA@A@CAA@C@A@AAAAAC@CA@A@A@@BABA@@@C@C@C@C@KAA@GA@HKA@AC@@@C@@AE@A@K@@GC@BHMBE@EAQCAAWEBGDBB@@A@@@@OCA@AHA@G@@CE@EBI@G@E@G@C@@@@BCBIDCDA@@BCBCFGHADC@ACEBCBGDGBGFIBA@C@KBC@@CA@@DE@EAMEGABCA@GCADEAEACAE@E@A@EBABMBIBCBEDQLGFABCBIHOLABCBCC@@A@@BA@@@A@@@GDCBDFGDGDMHA@ABCFECA@@@ABAB@BBDC@@DC@BBC@@B@@CA@BDB@B@@ABFBAD@FE@AD@DA@@D@@B@@HABA@ABABABC@ABA@ADADC@EBCBA@@@UB]DO@GAAAECIKEGAACA@@A@A@EDKDCBC@@@A@E@G@I@QCK@@F@J@H@BCBBDABBB@BBBABDF@@@@@@@B@@@@B@A@@BBB@BBBA@@BBAB@@@AB@@@@@BA@@@@@AB@@@B@@@@CBBBABA@@ABFBDBDBBBF@H@FCF@B@DBDDBBDDD@@ADCDABOLABCBCHB@@DDDH@FADA@@B@@@@@D@@@FBF@@@@@D@B@D@BADAF@F@BBBB@@BDBH@B@@AL@D@@BR@@@DBDBFBDBDBBBBFFDDBD@DB@@DBDXbBDTVRTDB@BB@BDFB@BB@DDDF@BAL@FARDR@H@FBD@B@BDJBN@D@LAL@F@FAH@J"]],encodeOffsets:[[[122149,35705]],[[121716,35863]]]}},{type:"Feature",id:"320722",properties:{name:"东海县",cp:[118.752842,34.542308],childNum:1},geometry:{type:"Polygon",coordinates:["@@CB@BKHA@CB@@@@BA@@@@@ACB@@@@A@@CAB@CA@@BE@@A@@@C@AA@@BA@@@A@@@AB@AC@@ACBA@A@@BA@BF@BACC@BDGBA@@@CB@D@@@D@@AA@A@AEF@@@CCB@BA@AAA@ABC@G@@@@DA@@B@@@DA@@@A@@BE@@@A@BBA@@DC@@BE@BHBABFA@AA@@B@AA@@A@AAA@@@@AC@A@@AE@A@A@AEA@AB@@@B@@AC@CA@A@@CM@@BEBA@B@C@@BC@@DA@AAA@@BA@@@B@@BC@@@@BC@@D@@A@BBEBBBA@A@@BA@EB@AIDDBEB@@@@C@BD@@BD@B@@C@DF@BCBBBA@@B@@@@@B@DA@BFABA@@BBD@B@@B@@@@@@B@@@BBF@B@@AB@@@BB@@@@@@@BB@@B@B@@D@@@@B@ACB@B@@@@@B@@@@ABA@@DD@@@@ACFA@AFABB@@BA@@@B@@HDB@BF@@BDBA@BBB@@@A@@B@@BBBBD@BBB@BBB@@@B@@BD@BBD@@@DIBC@CB@BDRC@@@@BA@@B@@@@A@@BAA@@@@@@A@@@@A@@AB@@@@EB@AEB@B@@@@A@ABAA@@@D@B@B@B@@@@@B@B@@@@@@@@A@@@@@@@@@@A@@@@A@A@@B@B@@@@A@@@@@@@@@@@@A@A@@@@@B@@A@@D@@@@@CCB@D@@@@@B@@@@@@A@@@@@@@@BC@@BB@@@B@@B@@@@A@A@A@BB@B@@@@@@@B@@@@BD@@A@I@M@C@@B@B@B@F@@C@@@AAA@A@AAC@A@A@C@A@@@@DBB@@B@DB@@@BBDB@@@@B@B@B@B@@@B@B@@@B@@@@@@@@AA@@A@@B@@@B@D@@AA@B@@CB@@AB@AA@@AA@@@A@A@@@@C@@@@A@@@@A@@G@AC@E@@BC@@@A@@MFDG@@@A@@@A@AA@A@@@@AC@@@E@@@BBEA@A@@@A@A@@BA@@@@AC@AA@@@@AA@A@A@@ACE@C@@CIAA@@@C@@@@@@BA@CACC@@@A@C@@A@@E@@@@ACACBA@BD@@@DA@@DAB@@A@G@@B@BE@@@@D@BC@@@@@G@@@CBA@CBCB@B@BBBABAC@@AAEB@@C@EBBDAB@@@@CB@A@@AC@@BDA@A@@@ACABA@@@B@@ACB@AA@@@@@A@@B@B@@C@CAAA@DA@@@@BA@A@@@@BA@ICKCaMAA@@B@AC@A@@B@@AA@MGDCGC@ACB@AB@@ACBC@@BCA@C@@@A@@E@AA@@@@A@@@C@@@C@@@AA@@A@@@@AA@@AAAAC@@@@AB@@B@BH@@GAAAABA@@B@@EB@@A@@@CB@A@@@@@@E@ABE@@B@@A@A@@@A@@@A@C@@A@@A@C@ABC@@@C@C@AL@BA@@B@D@B@BBBBFA@@@@BA@@@@B@@@@AB@@C@@@B@CBABA@A@CBI@MBA@EDDFDFBDBD@H@DDFAFBD@HBD@DBB@B@BBFBDBDBDDFFDDBFDDBDFBDJX@BBD@D@D@D@D@@BF@D@D@DAF@DAFAD@BADCFAFAD@BAFAF@@AFAFAF@B@B@FAF@FAFAJ@HAH@H@DBDDH@BBFFLBH@H@FFHDBDDDCFCDAFABABABAB@D@D@BBFBBFBB@@BDD@D@FCH@B@@B@@BNAJ@BABCD@@EDEBAB@D@DABAHADAB@@@BABBB@FBB@B@B@D@@@BB@BBBB@@@B@@@@BBBB@B@@BB@@FAFAD@H@HCH@B@FBH@BBHF@@DF@BBDJLDDFBFBFBD@D@@DBJBPCJDBF@JAN@D@BABABCDADAH@HBF@HDJFB@DBLBF@HBF@DAD@D@HBDBD@BA@@DIDY@AB@BABAJ@TEJCFAFAF@FBHDDBHFDBHBD@F@DAFANKLK@A@ADAB@BAD@F@F@B@FDFDPNLDJDDBH@L@F@N@H@JBJFBDB@HDFDB@NDB@@D@FJHBBVN@@@BILCBEFABIHGFC@A@A@A@MEC@AAG@@@G@C@EDCBCFEFAB@@A@EA@@G@C@ABABA@@B@D@BBDBB@@@BABCB@@@BDF@B@@ADDBJDHDD@F@D@DABAB@B@@@BDBFBJ@DAB@BA@CDEDKBAB@BBBB@DBB@DBBBDBD@B@D@BAJAB@@@HAF@B@FBB@FFDBB@J@@@B@BB@BB@BAAADA@@@@@A@@BA@@@@B@@A@@@@BA@@A@AB@AB@AA@AAA@AB@A@@@@@@A@@@@@@CEBAAA@AAABAACDA@A@G@I@EL@RDJ@H@F@B@@@D@DALCFCB@B@@@DBBBFHJLFDBBHBP@^CVA@@B@DAFAD@BCBCB@BAD@BABABAB@BA@GA@@@@CB@@CBCF@@EBCEABA@@@ACA@ADB@@@AD@AAD@@CD@AC@ABABA@@B@FDDEBAB@NGHCHCCEDAHC@@B@@@B@@AB@@@DDDABAPKJGDABAHERKFCDAJANABAFAB@F@F@DBFBFBBCHDAG@EB@N@L@@W@GA@CK@CAABGFCNA@@A_J@A]@@KBKBGBCDAAE]MBEQEK@ARCAOO@@MDABIACEIkG@AJ@AGJGBKHA@c@UA@@A@ABM@C@@NAJGNEBCRFJBL@FFZB@BB@@D@P@@HVH@L^DDBBVHDBFBJB@D@n@LRB\\FJ@LALCLGB@BAA@@@A@A@AEMBE@A@@AAC@A@@\\AB@@@@C@A@@@A@A@ACCAECE@A@A@A@@@ABA@CBC@A@A@ABGB@@CA@@ACA@@A@EACAGCBGA@EE@AEA@@@A@@@GL@D@@@BA@A@@CE@@@@FA@@@@AO@I@GEa@AEB@C@AI@@A@A@CE@@A@EDA@@@CB@LAAE@A@@A@@AB@@AE@@@@C@@D@@@@CDA@CC@@CMD@BYBAK@CAQ@A@@CABAC@@@@B@@@@C@A@@ACB@BA@A@EACA@@CGCE@A@A@@@A@IBA@C@A@IWD@DG@A@ABA@EQE@BK@EC@@EC@A@@AMBEB@BABBBO@@FIAS@BCMA@@@@@EAC@C@C@@C@CE@@JAECECGE@@EEA@AA@CEE@AA@GBAECG@AAAAAA@AEEAA@@EACB@CKF@@CWB@@@DF@@T@@@@AB@@BHABCHIJCBAB@BC@AAAACAAAAAAB@@@@A@A@@@AA@@EAADA@@DCAA@GAADE@ABE@A@@C@C@@B@@A@E@AQAE@@@E@S@A@C@C@E@I@A@EAIAA@A@EBIBIBG@KBCAC@A@AAGEA@EEHACGC@GA@@DFAB@AA@@BA@A@A@C@@@@AKB@C@@AEA@BFG@@C@@B@AEF@@ECG@AFACE@@BAAA@@BAACCBA@@@A@AA@@@@A@A@AGA@AA@A@@CC@@@@BA@A@AA@A@@AAACCA@A@@@AAAA@ABAA@@A@AA@EA@A@@A@@A@A@@@A@@A@OBA@C@A@ABAE@@A@AAAAC@@@@@BFIB@@BDA@@@@E@@AB@A@ECABH@@@B@@B@AB@@@D@@AC@@@F@@E@@DDAD@BA@@@D@BBBA@@BA@@BB@B@@@ED@@"],encodeOffsets:[[121610,35148]]}},{type:"Feature",id:"320723",properties:{name:"灌云县",cp:[119.239381,34.284381],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@CAE@K@EAA@AAC@A@A@A@@@I@C@EBA@AE@@EBE@A@SA@@@EE@@FM@@EA@A@@DI@C@E@A@AAAFGAA@@H@@CB@@@D@@@@C@EBEASB@IGBEBG@IB@@BJA@@F@@BHA@@HA@A@@D@@@FB@@F@@@@@B@@@BA@@BA@@B@B@@BDC@BDEB@DA@@VD@@BB@@B@@@DAB@BAB@@BDA@@@C@@D@BA@E@ABA@A@@@@@GBGB@@GD@@@B@B@@B@BF@@@@@BBHDP@D@@BB@@@@AAE@@DC@@J@B@@@HJ@@FF@B@BB@BBJ@F@B@@@D@BAD@@AB@@CBABAB@BABAB@BAF@DADBJDL@@A@IGAAA@@@@L@B@BCCAHEAC@@BC@@FEA@@A@@FF@AJ@D@@@F@FAJAFBHBF@DBF@@BH@BBBBDBDDF@@B@B@@B@@@B@@BFCB@@@@@D@@BHlB@@FV@@[@]@@B@DAB@J@B@@@B@BDFDH@@DBFBB@B@@ADA@BB@D@@@@@@A@@D@ABDB@@BBNHDBHB@@@@@AAGH@AALA@LF@BAHAHCF@AID@B@BA@@@GXCB@@FB@@C@@BB@@FAAI@@@@B@BDDBH@@@BB@AD@@BD@@AF@@BL@@HA@@BB@@@@ED@@CB@@EH@@F@D@HC@@B@@H@B@JAL@HAP@JABDDBDFHJHFDDFGDBB@@AFBABAFCD@@NH@B@@BBH@VBBCP@BBH@BB@@B@NF^@NBN@L@PBbBVB`BZ@ZB`AD@^@BF@@@BB@DC@CN@BBRDF@ZJHBFBDBLDFBFBHBB@FBF@BBFBJB@@FBB@H@NBLBJBJBR@DBF@JFDDDBB@B@F@D@D@BABADABAB@BAFCFEDAB@@@D@H@BBDBB@@BD@DAB@D@F@JADAFBBAD@HCB@B@BBFBDBFDFFDBB@@@B@B@B@BADEBAB@B@@@B@BBB@BFBBDD@BB@BDDBFBB@HFB@DBFBDBNEVG[dMPG`KHGjiDCHGRGD@E_AGABA@A@C@CA@@AIAA@@AK@@@AMDCB@ACAE@DGGCCAAACCCAGCCAGEKIUOCCDABAHGBADDBAB@BA@@B@B@B@@AB@J@DC@@@@B@@ABA@IA@OKAAKL@B@AA@@A@@@@A@@AQDGGMIQMUOEE@@CFABIGMIEEEC@@CDIJEE@@AB_AO@A@@AA@@@AAAABCA@CA@ABACABAAAAAA@AA@@CA@AA@@@@AA@A@AA@BECC@@@@@A@ECADA@GCCAAA@@BEBGWG@BKE@A@BMC@@CCECAACACAA@KEGA@@AABA@@A@AAECAACAC@AACAGA@AC@CECAAAAAAAEE@@DA@@@AA@CACA@A@@I@ADGABCEC@@OIYMBG@A@@CAA@@AGA@A@@IAQCA@IABE@@BAOCAA@BIC@@C@DE@@A@@C@@@@ICGAA@@@AAGAC@CAB@@C@A@E@A@@@AA@@A@A@@@C@@C@GAA@SB@B@BA@AB@@@@A@@@A@AA@@A@BAC@E@KAKAE@A@C@A@@@C@ECECAAA@A@A@K@AAEAE@@HKAAA@EC@BAE@A@IBCBC@ID@@A@KCC@KA@@EAC@]CKCMAC@E@G@CAA@A@A@EDA@@@A@QACAG@CA@ABB@@BGC@BCCA@FAHGAEC@@EAC@A@EAKAEAA@A@CA@@A@CAC@CCGCEAA@GA@EE@@DA@EAGAA@GA@EA@@DE@@A@@A@AAA@@FA@@E@@@FGAC@E@@EC@@FC@"],["@@BBDA@@B@@A@A@A@@AACAA@CB@B@D@@BBB@"]],encodeOffsets:[[[122073,35025]],[[122751,35359]]]}},{type:"Feature",id:"320724",properties:{name:"灌南县",cp:[119.315651,34.087134],childNum:1},geometry:{type:"Polygon",coordinates:["@@IE@FIA@@AFC@@DOAAH@F@DA@CD@@AAAAA@@BKPEAADCDM@@@ABCA@@@@A@A@CHCCCDBBA@BBCDADEFDBCD@@CCIJ@BAB@DAAAA@@@B@@@BA@ADDD@D@@DBAFC@AD@B@@@@KFA@C@EC@DC@@@D@AB@B@@CD@BCD@BEB@BA@AB@@@BDB@BBB@@@@GBBBBBFA@@A@@@B@@@A@@AA@@@B@B@B@FD@@B@BF@@@@DD@@@B@@@@EDA@@BAB@DAB@B@@AD@@AB@@A@ABCBA@ABE@ACAB@ACB@BBBCBA@AB@@AB@@@@ACECA@ABBBED@@AACCA@CFABAC@FC@@DGBA@E@@DAB@@FBPJBBDBDFFDDFGD@@@B@BBABDA@@@EB@BA@BD@@B@B@@@B@BFD@@BBD@@CB@BA@@BKB@BE@@B@@E@@DBBB@AF@BB@LB@D@F@@@JB@F@D@J@@CB@B@@FN@@EF@@F@@TBB@F@FA@@BFB@FAD@J@@@B@B@B@D@BBB@FBL@F@DBD@@ED@@FF@D@HB@E@@@FB@@EB@BBB@@@@BF@@CB@@FHBB@HBFBB@@CF@@FHBB@FBHDDDD@DBB@@@DBB@B@FBLBFBB@D@FB@@FDHBBG@EDBADD@AH@@AA@BDBH@DBRBB@@@B@FCB@B@B@DBH@F@D@NBLD^DD@FB@@LBD@LDB@@@JCD@DAJAB@F@ABD@@FBBLB@GF@FBBBL@B@B@B@BBFDFDD@@@B@D@B@F@LBLBF@D@ABB@@@BBB@@@B@@@@@BAB@@A@ATAB@HBD@@@@D@@@B@BB@@B@@@B@F@B@DA@DBD@HBBB@@B@HBJD@@@@@DB@@@CFD@@@JD@ABBPDAB@@AFJBB@RDJB@@@BHB@BB@DB@@@BAHZNPJ@@FDADHBBCJ@@@@BDBDBB@@B@@CB@@FFBBBBBBDBDFD@@BHBDBBBD@DBBBFDBBB@@@ABBB@@HBLFB@DBDBBBFDDD@@ND@A@BLF@AXHAHAF@@BBDBHDB@BCFDB@@@@@D@FD@ABBB@B@@B@@B@@BDB@@BBB@BBBBABDBAB@BDBB@ADBBBB@@B@@BB@P@`BBA@@FFJIDC@@FDFFNJJHBADE@@FFVPRNNJHHRC@BB@@@@@@BB@@B@ALKBBPLB@@JAB@BA@@@@@CDI@A@@BA@A@A@@@ABA@ABCCABGHABCBDDVPLJHFDBHDDBDDBBDBHDBKBE@EAE@CAEACEIGIAC@AAC@C@C@A@CBADGDABCFCDEBAFEBEBA@A@A@A@A@@@AAAAAA@EASEAAE@CAAAAACC@AAGAAACACCCAACAEACAC@EAA@CACAAAA@@A@AAA@CDK@EBCBC@@@A@@AACAAAECEAAACA@AAA@CAC@C@CAAACAAA@CAEAGCCAA@CAAACCCE@@CCCE@@IEACAACAECGCCACCCAA@AAEAAAGG@@CAICCCCCAAAACAEAGEMEA@ECG@EAGACAEACACCAAGCECIEEC@ACAAAGCAACAGAA@ECMGA@CAIIAAAACACACAGCC@AAGAE@E@@@A@CBC@ABA@@@CA@AEECACEA@IGCCA@ACAAAAAAECCAEAAAA@ACA@AACAEAA@AAAAAAEACACA@@AA@@@@DGB@BA@@@A@@BA@@DA@@BA@A@CB@D@A@BB@@PF@AJD@@B@@@@CBB@AA@@AHBBEB@BCC@@ADBBE@@A@BCA@AAA@@C@@@AA@@A@@@@CABC@@@@C@@A@@@A@A@@A@DGOCDICABAA@@A@@BA@@DDBABC@@BABCFEDIB@@AB@BCBADC@@D@BB@@B@@BB@@@@BHBAB@BHBBCB@BEBADEBEFG@@BC@@DBA@@@ABBBABB@@AB@@BBBB@@@DE@@BGDG@@BB@B@@ABBBB@@BA@AB@@@B@@@@B@B@@@@@B@B@@@BBA@@@BB@ADB@@B@B@AAABAABABABA@@BAFE@ABBB@BA@@DA@AB@@@@@B@DB@AEAEAAA@@@A@C@@@@BA@@@@@A@A@AA@@@@@CAB@D@@C@AA@AAA@@AAA@AAA@AA@AAAAA@C@C@EBA@A@A@AAA@AAAAAAAA@@CAA@EA@AB@A@@A@@BA@@@AA@@@HOC@BABB@ABAAA@@D@BA@AB@N[AAA@FOBCC@BGCC@AA@@@C@IAA@AF@@EA@DEA@@@BCA@BA@GC@BEAA@@AAHADA@@@C@AB@@@BDBB@@FEAA@CFCA@@@BAB@@CA@B@@A@@BCAADA@@@E@C@@A@@DECA@@CAA@BADBBAC@@CGAA@@AEI@@AAA@EABABAAA@@ABAAA@AB@@ABEAADAAAA@@A@@@@@@A@AAAA@CAA@@@@AA@CCA@CAAAECECA@KECAACA@ECCAAAAAEAEAQI@AEAA@@@A@@B@B@@@B@BA@@@@BEDEA@BA@@A@BAACDA@ADB@@B@@ABBBA@BBABDB@@B@@@B@@B@@@@@@@BABAFA@AA@BA@@@@@ABA@@@A@@BKC@@A@@@ED@@@@@AA@BAA@@BA@@@@@@AC@@A@@ABA@AFA@A@BAE@@B@DBB@DB@DD@B@@@BAB@@AB@BBD@BABAB@@CBA@CBA@@B@B@B@@ABCF@BAD@BENC@@AC@@AAA@@@AA@@B@@@@AB@@@@@AA@@A@@C@@E@@@CEAC@A@KD@A@@MAE@MAG@MAE@CA@@A@A@@@@@A@A@@CC@E@@@A@@@EB@CG@@DA@@HGB@CG@@BEB@A@@@AAAA@@BCB@B@@EBA@@F@B@@BBA@@B@D@B@@C@@@AH@BCA@@@DA@KACA@@IA@@@CC@AESB@ACGA@A@@C@AB@AA@AA@A@@@I@@D@@@@"],encodeOffsets:[[122122,34851]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
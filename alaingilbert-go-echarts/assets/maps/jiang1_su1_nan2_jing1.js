@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("南京",{type:"FeatureCollection",features:[{type:"Feature",id:"320102",properties:{name:"玄武区",cp:[118.797757,32.048498],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AB@@@@@@@A@@@@@@A@@BB@@@AB@@@@@@@@@@@B@@@@@@@@@@AB@@@DAFD@ABBBCBA@@@@@@@@@AB@B@@@@@BA@B@@BAC@@@BE@@A@@@AA@@@@@@@@@@@AA@@@@@B@@A@B@@B@@@@@@@@@@BB@@@@AB@@@@C@@A@A@BAA@AAEACAC@@@CAAA@@AAAAAAA@A@ABA@AB@@AB@B@BBB@@AB@@EDCACAADE@A@GA@@@A@AA@A@@@@B@@C@@@@BC@@BA@@GC@@CA@@BA@A@BBA@@@C@@A@@@FA@A@@B@@@B@@ADBB@@@B@B@@G@A@C@@@@@BDBHCB@D@BB@BB@@CBKAA@A@A@@AA@C@E@ABI@M@KB@@C@A@C@@@I@@@ABS@@H@@@J@@@H@@@H@B@@@L@F@B@B@D@L@@@H@L@B@B@@@D@BLCJC@@B@@@D@B@@BAB@@@BA@@BB@B@BBADB@B@@@B@@@BD@@D@DB@@BCB@@BBAB@@@B@BC@@@@B@@@@@@AB@B@@B@BD@@@B@@B@@BB@D@F@DB@JAD@@D@@F@D@@@@@@@BAB@@@B@@@@@@B@@@B@@B@@A@@B@B@HBBACAB@CABA@@A@BAAA@@A@@AAA@@A@@@@A@@@@A@@@@A@@AAA@@@@@@A@@B@NLDD@A@@@@DAACA@@@AB@@A@B@@A@@@A@@F@B@B@B@@@B@B@D@@@B@@@B@@@H@BABBBBB@@BDBB@BBBAB@@@@@@@BA@@B@@BB@B@@B@@BABA@@B@BB@BBAB@@@@BBD@@@@B@B@@@@@@A@@B@@B@@@@B@@@@@BA@@B@BB@@BAFEB@DABAHE@BB@B@@@@@D@@@BABA@@B@AABA@@@AB@@@@@B@@@@ADA@ABAA@MGA@@@AAA@@B@@@@A@@A@@@ACAA@@@AA@@BA@AA@@@@@@A@@@AAA@@CB@@C@@B@B@@CB@@AB@@AA@@CC@@@@@AA@@@B@@A@ADC@AA@@A@@A@@@@BA@@DCDABA@@@@@A@@@@A@@@A@@@@@@@A@@@@B@@@B@@@@AHC@A@@@@BA@B@@BA@@B@@@@@BA@@A@@@@@@A@@B@@@@A@@BA@@@@A@@@@@@@@@@A@@@@@@B@@@BAA@A@@@@@@@@AB@@@@@@A@@@@@@@@B@@@@A@@@@AC@A@@@B@@A@@@A@@A@B@AB@@@@A@@@@@@BA@AAA@AA@AAAAA@AAC@AAA@@ABCB@@@B@B@@@B@@@B@@@@@@@@A@@@@B@BC@@A@@@@@A@@B@@AAA@@@@AB@@@@CA@"],encodeOffsets:[[121735,32807]]}},{type:"Feature",id:"320105",properties:{name:"建邺区",cp:[118.731793,32.003731],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@BC@A@@BA@A@@@@A@@@@@@AA@B@@AB@@@A@@BA@AEBI@IBMBIBUDABA@ABABCHCDADADADDFFJBDFJFNDNDFFJDHDFHLBBBDBBHRJPVbRXPPTVBBBE@ABC@AAACK@C@A@E@S@G@AAEACAE@ACECE@ACEHAFABACAA@@AA@EIJCDAF@HA@P@FJ@@@RA@@B@JAH@@@B@FDHD@ABA@@@@BC@@@ABK@@@@@A@E@A@@AM@@AEAA@@@A@@@@A@@@A@@AABC@@@AAB@@@AGAA@@ACAAAA@AA@@C@A@@ACIMCC@ACCACCCGGCC@@CAEECCEAAAAACCAA@AAA@ACAACCAA@@@CAA@@A@@G@C@A@@B@@"],encodeOffsets:[[121554,32725]]}},{type:"Feature",id:"320104",properties:{name:"秦淮区",cp:[118.79476,32.039113],childNum:1},geometry:{type:"Polygon",coordinates:["@@D@B@H@@@@A@A@@AABC@@@A@@@AB@B@@E@@@BD@@@B@AAB@B@@AB@@DD@@HB@@AD@@A@@D@@@@A@@B@B@@B@B@@HBB@F@BCDBDBFC@@BA@@AA@A@ABA@@BAB@BAB@ACGA@A@A@@@A@A@@A@@@AA@A@@A@CA@@@AA@CA@A@@AA@@@A@@A@@@AB@@C@AB@A@@@@CCCAA@AAB@ECAA@@AAAAAAAAAA@@@@@@@@@B@A@@@@@@AACA@@GEA@@@B@AAAB@A@A@@@@AA@@AA@@A@E@@@@@A@@@A@@@@@A@A@GA@@@AA@@@@@A@@@@@C@CAEAAAA@C@@@@AABEAED@@CF@@ABEJGLABABCB@@@BB@@@@@A@@@@@A@@BABA@@BA@@@@@@@A@@@ABA@CF@B@@A@BB@BA@@AA@@BEBA@@@A@CBA@GBA@A@BD@@C@AB@@@B@@C@BD@@@@@@@B@@BBBF@@BN@@@B@F@B@@@@AL@B@@AD@@@@AB@B@@@@BBB@@@@@J@H@@@PAT@BA@@J@@@D@B@D@@@LAN@J@BAF@D@B@@BB@B@B@LBDA@@AAA@@A@CDAAGAC@@@@"],encodeOffsets:[[121690,32798]]}},{type:"Feature",id:"320113",properties:{name:"栖霞区",cp:[118.909153,32.096388],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@@B@@@@@@@@ABADAA@BECCB@@A@@B@@@@@@@@A@@@@@@@@@@@A@@B@@AA@@@B@@@@B@@@@@@@A@@BB@@D@@A@@B@@B@BB@@@AB@@@@@B@@@ADA@@@@@@B@@@@@@A@@@A@@@A@A@@@A@AD@BB@BBD@BBB@BBBBB@@BBB@BAB@@@@@@@B@@A@@B@A@BB@@@B@@@@A@@@BBD@@@@@B@@A@@@@@@@@@@B@@@@A@@B@@@@@@B@B@AB@@A@@@@@@@@B@@@@@@@@B@@@@@AB@@@B@@A@@@@B@@@@B@@@AB@@@@A@@@AB@@@AAB@@@@@BGD@B@@A@@@A@@@@@@B@@@@@@@B@@@B@@B@@@@@B@BADC@CB@@A@@B@@@@BB@@BCD@B@BA@@@B@@B@@@@DD@@BB@@BA@@DA@@@A@AD@@@DA@@BB@B@@@B@@@@B@@BAB@@BB@@B@DB@B@@@BB@@@@@@AB@BB@@B@NHB@AB@BCB@B@@A@@@@@A@@B@@ABBBA@@@ABAB@@C@@@@@A@A@@AGFABCBA@EFAB@@AAA@@@AB@@@@A@@@@@@AA@@@@B@@@@A@A@@@@@AC@A@@A@AB@AAAA@@@ABAB@@@AA@A@@AA@@@AB@@@@@@A@ABAAA@CA@AA@AAAAABG@@@A@@@A@@@C@A@A@@@A@A@A@E@@@@B@@@BA@B@@@BA@@B@BDCB@@@@@BCCMKA@@@@B@@@@B@BB@@@B@@B@@@@@@B@@B@@@BB@BB@@@BBABB@@@ABDBA@DBABGAA@A@@@@BA@@@@A@@@A@@@@A@@@A@AB@@@@@@C@E@@@@CC@IBA@@C@E@CAA@@@AA@@@C@@A@AA@A@@B@@@@A@@@@@ADA@@@A@AB@AA@AD@@CAC@@@AC@@A@@@A@@BB@HF@BC@A@@BAH@@A@A@@BBB@BB@BBBF@D@B@@@@BD@@B@@B@@@@A@BBBD@@DB@BC@@@A@@@AB@B@@@B@B@@@@@B@@A@AA@@AAA@@A@@@AAA@@AAA@A@@@A@@@A@AB@@@@@@AHCAACABAC@@AAAAA@CD@@JFDBCDCAIDSFDHFFDFDH@H@J@BER@DCLOrAD@D@DBD@DDDBBFDHFDBJFFDJDVJDBRDB@HBD@F@B@L@LCHAPEDCFCBABADADEDGDEBEDGPaHM\\BB@F@D@F@F@dEPANAJBvDD@P@~BN@FBD@DBNFHDPHDDFDFFFFBBNNZf@BBBHFFFHFDBHDB@FBBBNBHBJBNBB@H@F@F@FBLAL@F@HAR@NALANALCHALCNCPENC^IJC^KGE_SAAAACAAA@@@AAA@EACAA@AAAA@KA@@C@A@KEA@GAA@E@A@ABCBCDA@AB@@CAGAC@A@EAC@CAC@A@C@C@A@@CAC@AC@C@@BA@@BCB@@@BA@@DGDA@ABCBEBCBC@ABA@A@CCE@@@A@A@AAA@@A@@C@@A@@A@AAAA@@AAA@A@A@A@EAIAQCCACAA@MCE@A@EBC@@AA@@@@A@@AAB@@BBA@@@@@A@AD@@CA@@@@@@@C@@@@CAALEB@A@@@AAA@@@AA@@@@@@BA@@@A@@@A@A@@AA@@A@A@A@A@A@@BA@@@@@ABC@A@@@AAA@@@@@ABAB@@@@EAA@@B@@E@A@A@AAE@A@@@A@AB@B@AABA@@@@@@A@@@A@@@A@B@AB@@@BA@@B@D@@@BB@@B@@A@@D@@@DAACA@@AA@A@@@BDA@@@@@@B@@C@@@@@@A@@C@CBC@@CGAAB@@A@AAABA@@C@@@@@CA@@@BA@A@@@@BA@@@@@@C@@@AB@@@A@A@@@A@@@A@@AA@AA@@@@@A@AB@@@AA@AA@@@@@A@@@@A@@@A@@@@A@AA@@@A@@@@AA@@@@AA@AA@A@@@@BA@A@A@@@@@@E@@CB@@A@AA@@@@@@@AA@@@@AA@@@@A@A@@@@D@@A@@AA@@@@@AA@@@@A@@AA@B@AA@BA@BAA@@@A@@A@@AA@@@@A@@@@A@@@A@@@A@A@ID@@@D@@AB@@@@@B@ABDA@@B@@@@@@@@AB@@@@BB@@@@@@@B@@@B@@B@A@@B@@@@@B@@@B@@@@B@@B@@@@@B@@@@@B@@@@@@@B@@@B@@@@@B@@@@A@@B@@@@@@@@@B@@@@@@AB@@@@@B@@@B@@@FBBA@@BA@AB@@@@@B@F@B@@@AA@@A@AAB@@@AA@@@A@@BB@@BBB@@@AAB@@@@A@@@@@B@@BA@@@@@@@B@@@@BA@@B@@@A@@BABDBBA@@@@BABEAA@AAAAAAAA@@ABAB@DA@@@CAA@AC@A@CB@@@@ABA@AAA@AAADA@AAI@A@@@A@@@ABA@@@AAA@CBABABA@C@@@CCA@C@A@@@AAABA@ABAA@@CAAA@AEAAA@@BA@ABC@C@E@@@AB@F@@@B@@ECAEAAAGE@@@AAACA@@A@AA@A@AAAABCAABEAA@@B@BBH@@K@@@@@ABC@C@ABA@AA@B@@BB@BA@@BA@@@@@@B@BBD@BBD@@B@@@@@@AB@@@B@BDDD@@@BA@C@A@@BB@@@@BB@@@@BB@@@D@BB@@AF@@ABBBBDBHBB@BA@@BBB@@BBAB@@A@A@AAAA@@EBEBC@@@CCC@ACAACCAA@@C@A@A@ACA@A@@B@@@D@BA@A@@@@ACCCCA@AA@@@A@A@A@CA@@@CAA@AG@AA@C@A@@A@@@C@C@CCAC@@AACCAAB@@@A@@CBAB@@A@@@AA@@@BA@@BABC@A@@@@@@BAAA@@@DCAAA@@AAA@C@@@C@ABAB@@@B@@@@A@A@@@C@@@AA@@@AA@@@@BA@@AAA@@A@AA@AC@A@@@@@@DADABACACCACCEBA@B@@@A@@@@@@B@@BB@AA@AB@B@@@@A@AA@ABEGCCCCCCAAA@MCAAAA@@@@A@@B@@A@@A@@@@@@@@A@@@@A@BA@@@@@B@@B@@@@@@@@@@BB@@B@@@AF@@D@AB@@@AAB@@@@"],encodeOffsets:[[121749,32801]]}},{type:"Feature",id:"320111",properties:{name:"浦口区",cp:[118.628003,32.058903],childNum:1},geometry:{type:"Polygon",coordinates:["@@KSAE@BCBA@EAEBIBKFKFGBABADABABABEBABBD@@@D@B@BAB@B@DBBBDBBB@BB@BBBBB@B@B@BABCBA@EBC@CBE@KDA@ABABCBA@CBC@ABABCFC@OFSHA@ABEBABABCFABA@CD@BCBABCBCBA@C@C@@AIBE@C@IHADA@AFCBABEDCBC@CBA@ABAB@B@B@DBDBBBBBDBBBB@B@DAD@D@B@B@D@B@DBBDD@BBBDD@BDBBBB@BBBB@D@DADABABADAB@B@@@BBBD@@@D@FBB@B@D@BBBBBDBF@F@@@D@B@DA@@B@@AB@B@B@@@@@BBB@B@B@B@B@B@B@B@B@@ABB@@B@BBB@@BB@@@BD@B@H@D@BDAFCDGBGDCB@@ABA@@BA@@B@@@B@B@B@B@BBB@B@B@B@@@BBB@B@@@BB@BBB@@@BB@@BBB@@B@B@B@@@BAB@BABA@A@A@ABA@AB@B@@@@AB@B@@@B@@@B@B@@@@DDB@@@B@@@BA@@@@B@B@BBBBBB@BB@@D@B@@@BBB@B@@@@@B@@ABAB@@@B@B@@BBB@D@B@B@BABA@AB@@@B@@B@@@B@B@@@D@@@B@@@@@@B@@@B@B@B@@@D@B@@@BB@@FL@@@BB@@ABABAB@B@@@D@FBB@D@H@B@@@BB@@@B@B@B@@@@B@@@B@@ABAB@@@B@@B@BB@@BBBB@BBB@BBD@B@@@@@BB@@@B@B@@B@@BB@B@@AB@B@@@B@@BBB@@BBDB@@D@B@@@B@@@B@B@@@BB@BBDDBBB@@BBB@BB@@B@@B@@BBAB@B@B@B@BBBDBB@B@FAB@BBB@BBBBD@DBFBD@B@B@BAD@@@@@B@@B@B@@CD@@ABAB@DCF@@@B@BB@D@B@@B@@BBAB@BA@@BBB@BBB@BB@BADABADAB@@@@@@@@B@@@@@B@@@@A@@@@B@@AB@B@@Md@BA@@BABADBNAD@D@B@@BBBBDBB@D@DBBBB@@@@@BB@@@@BB@@@B@@@B@@A@@B@@@B@@@B@@@@@B@@@@BB@@@B@@@@@B@@@@@@BB@@@@B@@@HCFCBAD@BAB@B@BBB@BBBBB@BDBBBB@BB@B@@@DAFCB@@@B@B@PD@BB@@B@B@@@BB@BB@@D@DADADAB@B@B@@BB@DBDBFDFBD@B@@@BA@ABADAB@D@D@@@D@BBD@BBB@@AB@@AACB@@ABA@@@@B@@B@@BBBBBBBBD@DBB@LBBBB@BBDBDBD@B@B@DAB@FCB@B@B@D@BB@@FDFDHB@BB@B@BBB@BDBBHJNNHHDDBDB@@BDB@BP^@B@@hCF@NA^B@@@A@@@A@A@@@A@C@@B@BADA@A@@@@@@BAA@@@@@A@@@@A@@@@@A@@@@@@@AA@B@AC@@@@@C@@@@@@@@@@@A@@@@@@BA@@@@@@@@AAB@A@@@BA@B@A@@@@@@@A@@@BA@@@@A@@@@@@BAB@@A@@A@@@A@B@@@A@@@@A@@B@@@@ADA@@@@AAB@@@B@@@@AA@@@@@@@@AAB@A@@@@@@@A@@B@@A@@@@@BB@@A@@@@@@@@B@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@B@@@@@@A@@@@B@@@@@B@@@@@@A@@F@AA@A@@@A@@AA@@@@@CC@AE@@@CD@DB@B@BD@@@@@BA@@@@CE@@B@D@@@@@@CD@BA@C@@@@@AB@B@@@@@CA@@D@@@@@@AC@@@@@@AM@@C@ABACC@MAABCA@@@@A@@AA@@@ABG@CB@AABADFBADBDFDHFFDDFFBB@ADABAB@FCFEBA@CBA@@@IBA@A@A^B@CFQ@A@I@GCGCEEECGCCCEGIK@EEAAA@CCAAAAECGGACECCCGICEAASUOOQWUaIOGQAAACAAGKCECGEICECMEMEIACEICEEGCCEEACMKa_IGIGKIEEEEOAICMCGCKGGESYAAEEGGGECAOKKKEEGGCIKQEG"],encodeOffsets:[[121356,32618]]}},{type:"Feature",id:"320106",properties:{name:"鼓楼区",cp:[118.770182,32.066601],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@I@@E@OGBE@CBIDFJB@@BB@DBABEBGBDF@BDFDF@BBFBDBF@B@H@T@F@B@DDLBB@BAD@BAFDFHJDDFDBDHHFDBBBBDDB@BBFFL@HJDFDDTEJCDBDCCAIE@@DCB@BBBB@@BDBABDDBBG@@@@@@BAB@@@B@@@B@B@BB@@BB@B@@@BB@BB@@BBB@@@@A@@@@@A@A@@@ABA@@B@@@D@@ACA@@ACAAB@@@@@@AA@@@AC@@@@@A@CAEAAA@@AAA@AB@B@@@BG@AB@D@@AGEA@@AA@BCAAA@A@@AB@@A@@BA@AA@C@@@A@@@IDKD@A@C@@@A@A@K@G@@@K@C@A@A@E@K@@@A@G@@@G@@@I@@@GOB@@G@I@@@@@A@AA@@@@GCECA@@@G@IBA@@@QB"],encodeOffsets:[[121589,32809]]}},{type:"Feature",id:"320114",properties:{name:"雨花台区",cp:[118.779051,31.99126],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@GFFB@@B@@@@@B@AG"],["@@A@A@EB@@@@A@A@@@ABA@AB@@A@@BA@@B@@AD@@@BB@@@@BA@@@B@@B@@@@C@@@ABABABB@AB@@@B@@@@@@@B@@@@@B@@B@@B@@@@@@@B@@@@A@@AAB@@@@@B@@@B@@A@@@@A@@@A@@@@@AEBBA@A@AAC@AA@C@C@@B@AACB@@@@@@AA@@@A@AA@BA@A@ABABA@AB@@@@A@@@@@@A@@A@@@EA@@@@A@@@A@@AABAAAB@A@BAA@BA@@@@@@@@A@@A@AA@@@@A@@AAA@@AB@BA@A@CAB@@@@@@@A@@A@@@A@B@A@@@@@@A@@@@A@@A@@@@B@@@AA@@B@@@@@@@AA@@@@BC@@@@A@@@@@@D@@@@@@AA@@AB@AA@@A@@A@@@@AA@@BAAABA@ABB@A@@B@@@@@B@@@@@@A@@@@B@@@@AB@@A@@A@@@@B@@@@@@AA@@B@BA@@@@A@B@@A@@@@@@@@@@@@@AB@@@@@D@@@D@B@B@DC@AB@@@A@@A@@@@B@@@CA@@@A@@@A@@AA@@@@A@@@@@@CAB@@@@BB@@@@AAA@@@A@@@@@GA@BA@AB@@@@@BB@@@A@AD@@A@@@@@A@A@@@@@@@A@@@@@@A@@A@AA@@@B@@@@A@A@A@@@@@AA@@A@@@@@@@@AC@@B@@@BAA@B@AABA@@A@@@@ABA@A@@@A@@AB@A@B@@A@@AA@@@@@@A@@@@@@AA@A@@EA@@BA@@@@@@A@A@@@@@@@AB@@@@@@ABAA@@@@A@@AAB@@@@@A@@A@@@AB@@@@@@@@@@AB@@@@A@@AA@@@@@A@@@@@@@AA@@@@@@A@@@@AB@@@@A@@@@@BA@@@A@@A@@@@@@AAB@A@@@@@@@@A@@@@@A@@@A@@@@@@A@@@@@A@@@@A@@@@A@@A@@@@@A@@@@@A@A@@AB@@A@@A@@@AA@@@@@@@@A@@@@A@@A@@@@@@@AA@@AB@@AA@A@A@@A@CB@A@@@@@A@@A@@B@@AA@BA@@BABA@@@AB@A@@A@@@@@@A@BA@@@@@@@AA@@CB@@A@@@@@A@@@@@@@AAA@ABA@BBBH@@@@B@@@@A@@@@@BB@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@BB@@@@@@@@@@A@@@@@@@A@A@AA@@@@@A@@@@EAFF@BDDEBHDB@@@@@@@C@@@AACBDDCBAAA@A@@@A@@@AAC@@B@@A@A@AB@@ABC@@AAB@A@A@@A@A@@A@@@@A@@BB@@@A@@@@@@@A@@@CBCB@@@@A@@B@@@@@B@BC@AB@@@@@B@@DDBBCDDDDD@BB@BA@B@@A@@BA@AB@@EBC@@@ABGJ@@@@BBBDDFFJDD@BBBBBFF@@FFFFLJJHJHb`NLBDFFDDFHBCBCBCDCDGBABAB@BAVCJANAJAJ@FA@BAB@@@B@@BA@@@ABB@@@@@@@B@@B@B@@AB@D@@A@A@@@AB@D@H@@@@BB@DB@@B@DBBDDB@BBB@BBBDDBBBBFBDDFFDB@@DDHHDDBDDD@BDDJNBD@@@B@DB@@BBBBBBD@@BBBH@@A@BB@@D@BA@BB@@@B@@@ACD@@@@A@@BAD@@@ACB@B@HAB@DAB@@@B@FA@AB@@BB@@AAAB@@@@ADEB@BA@@B@@@@@@@B@@AB@BA@AB@@@@@B@@@@@A@@A@@DABABAHKFIBA@@DE@@FCFBBA@@@@A@AA@A@A@@CABA@@@A@@@@@A@@@@@AAA@@@A@@@A@@@@@@@@@@B@@@@@@AAB@@@A@@A@@@@@@@@@@A@@A@B@@@AA@B@@A@@@@BAA@BCFABA@@@GA@@CDEAEA@EGA@@A@A@@@@@@A@@@@@@@@@@A@@@@@@B@@@AA@BBA@@A@@@@@@@@@@@@@@@ABA@@@@@AAAMB@CA@@@@@A@@A@@@B@@A@@AABA@@@BA@@CB@@@A@A@CA@@C@G@AAA@A@A@@BADA@@B@BABA@@@@@A@A@@@@BAB@B@@A@E@A@@A@@@C@@ABA@@@@B@@@@@B@@@@AAABA@@@AA@@A@@@@B@@BBAB@@@B@@@@@BA@@@A@@@@@@A@@@@AA@@@@@@AA@C@@A@A@@A@A@@@A@A@AA@@CB@@@@A@@@A@BA@AAA@@AC@@A@@A@@@@B@@@@@@BBB@@B@AAB@A@@@@@@AA@@A@@@@@@A@@BA@@B@BA@A@C@@BA@@@A@@@BB@@A@BB@@@@A@@@AB@@@@A@BGBAA@@@A@@BA@@@@@@@@BA@@A@@@@@@@@@@CAA@@@@B@@@@@@B@B@@@ABA@A@@@@A@@A@@@ABAA@A@@@@@AA@@A@@A@@BC@EB@B@@B@C@"]],encodeOffsets:[[[121466,32635]],[[121589,32681]]]}},{type:"Feature",id:"320116",properties:{name:"六合区",cp:[118.822132,32.323584],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@BB@B@@@@@B@@BB@@@B@@@B@@@B@@@@BB@@@@@B@@@B@@@@@B@@@B@@@@@B@@@B@@@B@@@@@B@@@@@B@@@@@B@@@B@@@@@B@@@@@@@B@@B@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@B@@@@@@@@B@A@@B@@@@@B@@@@AB@@@@@B@@A@@@@@AB@@@@A@@@@@@B@@A@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@BA@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@@BA@@B@@@@AB@@@@AB@@@@AB@@@@@BA@@B@@A@@B@@@@A@@B@@@BA@@B@@@B@@@@@B@@@BB@@B@@@BB@@@@BB@@@@@@@BB@@@@B@@@@@@@B@@@@@@@B@@@B@@@B@@@B@@@@@@@BB@@@B@@@B@@@B@@@@@B@B@@@@@BB@@@@B@@@@BB@@@@@BB@@@@B@@@BB@@@@B@@BB@@@B@@@B@@@B@@@B@@@B@@@B@@@@AB@@@B@@@@@B@@@@AB@@@@@B@@@@AB@@@@@@@B@@@@@BA@@@@B@@@@@@@@@BA@@@@B@@@@@BA@@B@@@@A@@@A@@@@@A@@@@BAA@@@@A@@@@@@@@@A@@@@@@@AA@@@@A@@@@@@@@@A@@@@@@@A@@@@AAB@@@B@B@@@B@@@@@B@@AB@@@@@@AB@@A@@B@@@@A@@B@@@@@B@@A@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@B@@@BB@@B@@@@@B@@@@@B@@BB@B@@@B@@@B@@@B@@@B@@@B@B@@@@@BB@@@@@@BB@@@@B@@B@@@@@@@B@@B@@B@@@@@B@@@BA@@B@@@@@B@@BB@@@@B@@@@@B@BB@@@@@@@@BB@@@@BB@@@@@@@BB@@@@B@@@@BB@@@@BB@@B@@@B@@BB@@@@@@@B@@BB@@@@B@@B@@@B@@@B@@@B@@@@@B@@A@@@@B@@A@@@@@A@@@@B@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@@AA@@@A@@@A@@@@@@@A@@@@@A@@@@AA@@@@@@@@AA@@A@@@A@@@A@@A@@@@A@@A@@@A@@@@BA@@@@@@@AB@@@B@@@@@B@@@B@@@@@B@B@@@@@B@@@@A@@B@@@@A@@@A@@@@@@@A@@@@@AA@@@@A@@@A@@@A@@@@@A@@@@@A@@@@B@@@@@BA@@B@@@@@B@B@@@@@@@BA@@@@B@@@B@@@B@@@B@@@@@BB@@@@@B@@B@@@@BB@@@B@@@@@B@@@B@@A@@@@@@@@@AB@@@@A@@@@@@AA@@@@@@@A@@@@@@@A@@B@DA@BB@@@@@B@@@@@B@@@B@@B@@@@@@B@@@@B@@@@B@@@@@@BB@@@@@@B@@@@@@@@BB@@@@B@@B@@B@@@BB@@@@B@@@B@@@B@@@@@B@@@@@@@B@@BB@@@@@B@@@@@B@@@B@@@@@@@B@B@@@@@B@@@@@B@@@B@@@@@B@@@@@B@@@B@@@@BB@@@@@B@@@@B@@@@@BA@@@@@@B@@@@@B@@@@@@@@@B@@@@@B@@@@@@@@B@@@B@@@@AB@@@@@@AB@@@@A@@B@@@@AB@@@B@@@B@@@@@BB@@B@@@@BB@@@@BA@@B@@@B@@@@@B@@@@@BB@@@BB@@@@B@@@B@@@@BB@@@@@B@@@B@@@D@B@B@B@B@DBHBLFBCBA@EICIEAEAAC@BE@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@AB@@@@A@@@@@A@@@@@A@@@@@A@@BA@@@@@A@@@AA@@@@@A@@@@@A@@BA@@@@@AB@A@@A@@@@@AA@@@@A@@@@AA@@@@@AA@@@@@AA@@@@A@@@A@@@@@@@AB@@@B@@A@@B@@@@@B@@@B@@@B@B@@@B@@@B@@@@@B@B@@@@@B@@@@@B@@B@@B@@B@@@B@@@@BB@@B@@B@@B@@@B@@@B@@@@A@@@@@AA@@@@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@B@@@BA@@B@@@BA@@B@@@B@@AB@@@B@@@@@B@@@B@@@@@@@@B@@B@@@@B@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@B@@B@@B@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@A@@@@@@@@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@@@B@@B@@B@@@B@@@B@@@B@@@B@@A@BB@B@@@B@BA@@@@@@BB@@B@B@B@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@AB@@@@A@@@@BA@@@A@@@@BA@@@A@@BA@@@@@@BA@@@@B@@A@@BA@@@@B@@A@@@@BA@@B@@A@@B@@@@AB@@@BA@@@@BA@@@@@@B@@A@@@@B@@@@A@@B@@@@@@AB@@@@@@@@AB@@@@@@@BA@@@@@@B@@A@@@@@@B@@@@A@@@@B@@@@A@@B@@@@@@A@@B@@@@@@AB@@@@@@@BA@@@@@@@@B@@A@@@@B@@A@@@@@@@AB@@@@@@A@@@@B@@A@@@@@@@A@@@A@@@@@A@@@@@A@@@A@@@@@A@@@@@A@@@AB@@@@A@@@@@AB@@@@@@A@@B@@@@A@@B@@A@@@@BA@@@@@AB@@@@@@A@@B@@A@@@@B@@A@@B@@@B@@@BA@@@@B@@@B@@A@@BB@@B@@@B@@@B@B@@@@@B@@@B@B@@@B@@@@@BA@@B@@@@@@@B@B@@@B@@@@@B@@@B@@@B@@@B@@BB@@@@@B@@BB@@@@@B@@@B@@B@@B@@@@BB@@@@@@@BB@@@@@@@BB@@@@B@@@B@@@@@BB@@B@@@@@B@@B@@@B@@@B@@@B@B@@@B@@@B@@@B@@BB@@@@@@BB@@@BB@@@@BB@@DABB@@B@@A@@B@@@@@B@@@@@B@@AB@@@B@@@@@BA@@B@@@B@@@B@@@B@@BB@@@@@B@@@B@@@B@@@@@B@@@B@@@B@@@@@BA@@@@B@@@@A@@@A@@@@@A@@BA@@@@@@@A@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@A@@@A@@B@@A@@@A@@@@@@@@@AB@@A@@@@@A@@B@@A@@@@BA@@@@@AB@@@@@@AB@@@@@@AB@@@@@@AB@@@@AB@@@@@@AB@@@B@@A@@@@B@@AB@@@B@@A@@B@@@@AB@@@BA@@B@@@@AB@@@BA@@@@B@@A@@@@B@@A@@@@@@@AB@@@@@@A@@@@A@@A@@@@@@@A@@@@@A@@@@@@@@@A@@@@@A@@@@@@BA@@@AB@@@@A@@@@@A@@A@@@@A@@@@@A@@@@@A@@@@@A@@BA@@@@@A@@@A@@@@@@@A@@B@GC@@AA@@@@A@@A@@A@B@@B@@A@@@@B@@A@@@A@AA@@@@A@@B@@@@AA@B@A@B@@@@@@A@@BBB@@AB@@@@@@@@@C@@@@AB@@@A@@@@@AC@@@A@@@@@@@AB@@A@@BCB@@AA@@@@@@AA@@@@@AAB@@@@@A@@@@B@A@B@@A@@@@AA@@@@@B@@@@@@AA@@@@@@@AAA@@B@AA@@@@@@@AA@@@A@AA@@@@A@@BAA@@@@AA@@@A@@@@@@@A@@@@A@@@A@@@@@@B@@@B@@AA@B@@@B@@AB@@C@@@A@@@A@@@IB@A@@A@@A@@A@@@@@@@AA@@@@B@BA@A@@@@B@@@@@@@B@A@BA@@@AA@@@@A@B@A@@@@AA@@A@@@@@@@@ABAA@@@AA@@A@@@B@AA@@@@@@@@AAA@@@@@@@@AA@@@@A@@@@@@@@@@B@@A@@BB@@BA@@@@DD@@@A@@@@@A@@@@BB@@@@@@B@@@@A@@@@@A@@BB@A@@B@@AA@@@@AA@@@A@BA@AA@@@A@A@@@@ABAB@@B@@B@@B@@@@@@B@@@B@B@@A@@@@A@@@@@@@@A@@BB@@@@@AB@@@@@@@@@@A@@@@@@@A@@@@B@@@@@@@B@BA@B@A@@A@@A@@A@@@@A@@@@B@@@@@@AB@C@A@@@AA@@AA@@A@@A@B@@@@@@CB@@AB@A@@@@@@@ABAB@@A@@B@@@@@@@@A@@@@AA@@@@@A@@@@@@A@@@A@@@@@A@A@A@@@@B@AAAB@@AA@@@A@@BA@@@@BAA@@B@BCAC@A@@B@@AB@@@@AAA@A@@A@CA@BA@ACC@@@BA@A@@B@B@@@DB@ABABC@@@@BA@@@@AA@A@AB@@A@@@@@@C@BC@@@@@@@AB@@BBB@@BB@@@@@@BA@AB@@B@@@A@BB@@@@@B@@@@@@@B@AAB@@@@@@@B@@@@B@@@A@@@@@A@@@@@@@@AA@@@@B@@A@@BA@@AA@@AA@@A@@@@@@@A@@A@@@ABBB@@A@@@@@B@@@@B@B@DAHA@@@A@@BBB@@@@A@@@AB@@@@@@A@@@@B@@@@A@@@@@@@@@@B@@@@@@@@@@A@@@@@AB@@A@@@@A@@BAA@@@A@@AB@@A@B@AA@@@@C@@@A@A@A@@@CAA@A@@@A@@A@@@@A@@@A@@@@@@AAA@@@@@A@@@@@@A@@@@C@@B@@@@@@A@@@BB@@@B@@@A@@@BA@@AA@@FBB@@@BA@@@@@@@A@@@@AA@@@@@A@A@@@@B@@B@AB@@@@B@@@@BBA@@B@@@@@@@@B@@@BBB@@@@@B@@@@@B@@@@@@A@@A@@@A@@A@@@@BA@BB@BBB@@AB@B@@@BA@@AA@@@@BAB@@@@@DABAB@BB@@@A@@@@BAA@@@AAA@A@@@AA@@AA@A@A@@@A@@@A@@A@@@@A@@A@A@AA@A@@A@@@A@A@@A@@AAA@@@@@@@@BAAE@@A@A@A@@BAA@@@@A@@BA@@@@@@BA@@@@@AB@@A@@@@@A@@B@@A@A@@A@@AB@AA@@@@@@B@@AB@@B@@@B@@@@@@@BB@@ABA@@B@B@@A@@AABC@BB@@@B@@@B@@@@@B@@@BA@@@A@@A@@AAA@@B@BB@@B@A@@@@AB@B@@AAA@AA@AA@@AA@@@@@A@@CA@@B@@ABA@@@A@@@@@@@@BA@C@AA@@A@@AA@@B@@CC@@A@@A@@@@A@@@A@@@@BA@@B@A@@A@@@A@@@@@A@@@@@ABA@@@@@@B@@@@@B@@@B@@@@@B@@@@@@@@AB@B@AA@@@@@AAA@@@AA@@@@@@@@A@@@A@@@A@@A@CA@@AC@@@A@A@@@@@@@@@A@@B@@@@A@@BBBA@@@@B@AA@@@A@@@A@@B@BA@C@@@@@A@AAA@@@@@ABA@@@@@@@@@A@@B@@AB@AABA@@@@@AA@@@@B@@A@@@@@@B@@@@@@AA@@@@@@@@@AAA@A@A@A@@A@@C@@B@@@@B@BB@@@B@@@@AA@@A@@@@B@@@@@@@AEB@A@@A@@@AA@@@@@@@AG@B@AA@@@@EF@BAB@B@DABAHAD@FAFADAF@@@D@DATA@@B@@C@@@AAA@@BA@C@ADGBC@@GEEEGEAA@AYeMMAAEEEEECCCOGGCMECAC@EAM@}AO@C@uCIAMBOBcFE@E@C@E@A@[AGNObCHAFCFCHCFCBABABEDCDOFGBKDK@A@E@C@GAA@QCCAUIICECIECAGEECAACC@CAC@C@CBCPqDK]A@B@BAB@J@@AB@DABEFEDA@ABCB@BAAEECCEECGCECAABCEABBBA@@DAH@B@@BB@@@B@@B@ADBB@NDDAB@B@DN@@B@@@@D@@B@@@@C@@@DB@@@@A@A@@B@@@@@DABC@@D@@@@C@A@@@DF@@@@AB@@@@C@@A@ACAC@@D@@BFD@@D@@@@BB@@@B@@@BBBE@@@@B@@@@A@@@@@A@@@@@@B@@@@A@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@BA@@@@@@@@@@BA@@A@@@@@BA@@@@B@@@@@@@BBA@B@@@@@@B@@B@@A@@@A@BB@@@@CB@B@@A@@@@B@@B@@@A@B@@@B@@@@BA@AB@@@@@@@B@@B@@A@@@B@@@@@@@B@AAB@@B@A@BB@@@@@@@@AB@@@@@@@B@@@@@@@@@@@D@@@@BDA@B@@B@@@@@@@B@@@@@B@@B@@@@@B@AB@@@@@@@BCBABA@@@@D@B@@@B@B@@@B@@]AMBE@gD@@@@A@@BB@@BB@@BAB@@AB@@A@C@ABE@CBABCBADCB@D@B@BBDFJDHFHBDBB@B@@@B@B@B@D@BADAD@@ABA@@@A@AAC@ABC@ABAB@B@@@B@B@DBBDBDDHBDBDBDB@BBBBBDDDBFBJ@D@D@HAB@BBB@@@@B@DABA@ABE@C@C@CBA@ABBD@BDDD@DBB@D@DAB@FAF@D@BBBBBB@BBDBDADABCBIBA@ABAD@B@B@BDBBD@@A@A@C@A@ABAB@B@BBBBB@BB@D@B@B@DBBB@BCBABGLBBB@@@B@DAB@@@B@@@B@@B@@@F@B@@ABA@A@A@A@A@@@AB@@@@@BB@B@BBB@BB@B@@@BADCD@@A@ABA@AAAA@@@A@AAA@AAA@@A@@@ABCLCBIHA@ABAF@BBBDFFJBBBB@HDLBH@B@@@B@DCL@B@B@BBJBH@DBJ@B@FB@@DB@@B@DAD@B@B@DBDBB@BADAJ@B@@A@A@G@@@EAA@@@@@@@GACAC@@@@@C@C@@BIBABGBKD@@A@@B@@A@A@@@@@A@@AA@@@A@@@A@@@@@@@AA@@@@@@AA@@@@@@@AA@@@@A@@@@@A@@AA@@@@@@A@@@@@A@A@@@A@@@A@@@AB@@A@@@A@@@A@@@A@@AA@@@@A@@A@@@A@@AA@@@@@A@@AA@@BA@@@@@A@@@A@@@@BA@@@A@@B@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@A@@@A@@@@@@@A@@@A@@@A@@@A@@@A@@@A@@@@B@@@B@@A@@B@@@B@@@B@@@B@@@@A@@@@@A@@@A@@@A@@@A@@@@@A@@@A@@@@@A@@@A@@@A@@@A@@B@@A@@@@@A@@BA@@@@BA@@@@@@B@@@@AB@@@@@B@B@@@B@D@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@BB@@@@@@AB@@@@@B@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@B@@@@@B@@B@@B@@B@@@@BB@@@@@B@@@B@@@B@@@B@@@@@B@@@B@@@B@@@B@@@B@@@B@@@BA@@B@@@B@@@@BB@@@@@@@@BB@@@@@@@@B@@B@@@@@@B@@B@@@@@BB@@@@@@BB@@@@B@@B@@@@B@@@@B@@@@BB@@@B@@@@BB@@@B@@@BA@@@@B@@@B@@@@@B@@A@@@@B@@@@@BAB@@@@AB@@A@@@A@@@A@@@A@@@A@@@A@A@@@@@AB@@@@A@@B@@@@@B@B@@@@@B@@@@@BB@@@@B@@@BB@@@@B@@@BB@@B@@@@@B@@BB@@@@@B@@@@@B@@@@@@@@@B@@@@@BA@@@@@@B@@A@@@@@@B@@A@@@@B@@@@@@A@@@@BA@@@@@A@@B@@A@@@@BA@@@A@@@@BA@@@ABA@@@A@@@@BA@@@A@@@A@@BA@@@A@@@@@A@@BA@@@A@@@A@@@A@@@@@AB@@A@@@A@@@AB@@A@@@@BA@@@A@@BA@@@@@AB@@A@@@@BA@@@@@A@@BA@@@@@AB@@A@@@@@AB@@@@A@@B@@A@@@AB@@@@@@@@AB@@@@A@@B@@@@AB@@@@@@@BA@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@B@@B@@@@@B@@@B@@@B@@@@@B@@BB@@@B@@@B@@@@@B@@@B@@@B@@B@@B@@@B@@@@@B@@@@BB@@@@@@@B@@@@@BB@@@@B@@@@@@@BB@@@BB@@B@@@@@B@@B@@@@B@@@@BB@@@@@BB@@@@@@BB@@@@B@@B@@DBBB@@@@B@@BB@@@B@@@BB@@@@@@B@@@@@B@@@BA@@B@@@@@B@@@B@@B@@B@@@@@BB@@@@@@BB@@@@@@B@@@@B@@B@@@@@@@BB@@@@@@B@@B@@@@B@@@@@B@@@BA@@@@B@@@@A@@@@@A@@B@@A@@@@B@@@@@B@@@@AB@@@@@B@@@B@@@B@@@BA@@B@@@B@@@B@B@@@B@@A@@B@@@B@@@@@B@@@B@@@BA@@B@@@@@B@@@B@B@@@@AB@@@B@@@@@@AB@@@@@@@BA@@@@@@B@@A@@@@@A@@B@@A@@@@@AB@@@@A@@BA@@@@@AB@@A@@@@@A@@BA@@@@@AB@@A@@@@@@@@@@@@@@@@@BB@@@@@@@B@@@@@B@@@@@BB@@@@@@B@@B@@BA@@@@@@BBA@@BA@B@@@@@B@@@BBB@@@@B@@@@@@@@@B@@@@AB@BB@@@BB@@@@B@@@@@B@BB@@@BB@A@@BB@B@@@B@@@B@@@BBA@B@@@B@@@@B@@AB@@@BB@@B@@BDB@B@B@@@B@B@@A@ABA@AB@B@D@B@B@@@B@BAB@@BB@@BB@BA@@B@@@B@@@@@B@@@B@@AB@@@B@@@@@B@@AB@@@B@@@BA@@B@@@BA@@B@@@B@@A@@B@@@B@@@@AB@@@B@@@@@B@@@B@@B@@@@B@@BB@@B@@@@@B@@@@@B@@@B@@@@@BA@@B@@@@@B@@@B@@@B@@@@AB@@@@@BA@@@@@@BA@@@@@@B@@A@@B@@@@@B@@@@B@B@@@@AB@@@@AB@@@@A@@@A@@@@B@@AB@@@@@@AB@@@@A@@@@BA@@@@@A@@@A@@@A@@@A@@@@@A@@@A@@@AB@@@@A@@@@@AB@@@B@@@@@B@@@B@@@B@@@@@@B@@@B@@BB@@@@@BA@@B@@@@@B@@@B@@@@@B@@@@@B@@@@@BA@@@@@@B@@@B@@A@@@@B@@@@A@@B@@@@@@A@@B@@@@@@@B@@@@@B@@@@@@BB@@@@"],encodeOffsets:[[121547,33389]]}},{type:"Feature",id:"320118",properties:{name:"高淳区",cp:[118.89222,31.327586],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@B@@@B@@@@@@@@B@@A@@@A@@@D@BB@@HDB@BBBA@@B@B@B@BA@@B@@@@@@@@@@@@@@@BA@@B@@@@@BA@@@A@@@@@AB@@@@@@@B@@A@@@@@ABB@@A@@@B@A@B@@@@@@@@@@B@@@A@@@B@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@B@@@@@B@@A@@@@B@@@@@A@@B@@@@@@@@@@B@BB@A@@@@@B@@B@@@B@@@@@B@@@@@@@@@@AB@@B@@@@@@@@B@@@@@@B@@@@@@@@@B@@D@@A@AA@@@@@A@@@@@BA@@A@B@A@BA@A@@@A@@B@@A@@B@@@@A@@@@@@@A@@@@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@A@@@@@@A@@@@A@@@@@A@B@@@@@@A@@@@@A@@@B@AB@@@@BAA@@@@A@@@@@@A@@@@@@@@A@@@@@@@@@A@@A@@@@@@@@A@@@@@@@@AA@@A@@@@AA@@@@@@@@A@@@@@@@@B@@A@B@@@@@AB@@@@@@@@A@@@@@AB@@@@@@@D@@@@@A@@@BA@@@A@@@@@@@@BA@@@@A@AA@DA@A@C@@AAA@@C@@B@AA@A@A@@AA@AB@@A@@@A@@BB@@BA@@AA@A@A@@@@BA@@@@B@B@@@@@@@AAA@@@B@@AB@@AA@@@A@A@@@@A@@@@@@B@@@@@A@@@@A@@@@@@@A@@@@@BBB@@BAB@@@B@@A@@@@@AA@@@@@@@B@@@@@@@@@@@B@@D@@@@@@@@BA@@@A@@@@@@@@@@BABBB@@@@@@@@@@@D@@AB@B@B@@A@@BA@AB@@A@@@@@@@CB@@@@@@@@@@A@A@A@@@@@A@BB@F@BB@AA@@B@@@@@"],["@@@A@@B@@@@A@@BA@@@A@A@@@A@A@@B@@@B@@A@@@@A@@@AAA@@@B@@@AA@@@@@@@@A@BA@@A@BA@B@AB@@@@@@@B@AA@@@@@@@@@@@A@@A@B@A@@@@A@BAAB@@@@AA@B@@@@@BAA@B@@AA@B@@AB@A@BAA@@@B@@@A@@@@@@@@A@@@ABA@@AB@@@@@@C@@A@@@@@@@@@@A@@@@@A@@@BA@B@AB@@B@@BA@@@@B@@@@@A@BA@@@@@A@@B@@@@@@AA@@@B@@@@A@@@AB@@@@A@@@@@@B@@@@A@B@A@@A@@@@A@@@@B@@A@@A@@BAAB@AA@B@@@A@@@@B@@@@@@AD@@@@@@@@@@@@@@AA@@@B@@@@@B@@@@A@@@@@@A@@@AA@BAA@AB@@@B@B@@AB@B@@@@@BB@A@@B@@@B@@@BBA@B@AB@@B@@@@@BA@@@@@@DB@@B@@@@@A@@AB@@@@@@A@BB@@@@B@@@@@@BAA@@@B@@@@A@@@@@@B@@@B@@A@@@@B@@@@@@AB@@@@BB@@@BC@@@@@A@@BAB@B@@A@@@@@@@A@@B@@@BA@@@@@@@A@A@@@@@AB@@@@@B@@@@A@@A@@A@@@A@@BBD@BE@@@@@@@@@@@@@A@@@@@@BC@@@@B@@A@@@@BC@@@D@@@C@AB@@@DA@AD@@ADBDA@@@A@BB@@BB@B@@AA@BAA@A@@@@@@@@@A@@@@A@@B@@@@@AAD@@@DEECAACCAAC@CCACEEAAACAACC@@AC@A@GA@@A@AAAA@@AA@AA@@@ABA@ABABA@A@A@C@@@AA@A@@@C@A@@@A@AA@AA@@A@@@A@C@A@@A@AAAC@@@@@@A@@BA@@@A@@@A@@@A@@AA@@@@@AA@@@@AA@@@A@@AA@A@@@@AA@@A@@@A@AAA@C@@@C@@@A@A@C@ABC@ABC@@@@@@@@A@@A@@A@@@AA@@@@A@@@@AA@@@@@AA@@@@A@@@@AA@@@@@A@@A@@A@@@@@A@@@A@@A@@A@@@A@@@@@A@@@A@@@@AA@@@A@@@@A@@@A@@@A@@@@@A@@@@@A@@@A@@AA@@@@@A@@@A@@@@@A@@@@A@@@@@A@@AA@@@@@A@@@AA@@@@A@@@A@@@@AA@@@A@@@@@A@@A@@@@@AA@@@@A@@A@@A@@A@@@@A@@@@@AA@@@@A@@@A@@@A@A@@@A@@AA@@@A@@@A@@@@@A@@@A@@@A@@@A@@@@@@@@@A@@@@AA@@@@@@@@@A@@A@@A@@@@@@@@@AA@@@@A@@@@@@@@AA@@@@AA@@@@@@AA@@A@@A@@@@A@@A@@A@@A@@A@@@@@@AA@@@@@A@@@A@@@@@A@@@A@@@@AA@@@A@@@@@A@@@A@@@@@A@@@A@@@@@A@@@AA@@A@@@@@@@@@A@@@@@@@A@@@@@A@BA@@@@@A@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@A@@@A@@B@@A@@@A@@@A@@@@@A@@@@@A@@BA@@@@@@@A@@B@@A@@@A@@B@@A@@B@AA@@@A@@@@A@@@@A@@A@@@@AA@@@@@A@@A@@A@@@@AA@@@@A@@AA@@@@@A@@A@@A@@BA@@@A@@@@@@@AB@@@@@@AB@@@@@@AB@@@@@@A@@B@@@@@@A@@@@B@@A@@@A@@@A@@@@@A@@@A@@BA@@@A@@@@@AA@@A@@@A@@A@@@@A@@@@@AA@@@@@@AA@@@@A@@A@@A@@@@@A@@@A@@@@@A@@@A@@@@@A@@@@@A@@@A@@@@@AB@@A@@@A@@@@@AB@@A@@@@@A@@B@@@@@@A@@B@@@@@@A@@B@@@@A@@A@@@@@@A@@@@@AA@@@@@@A@@@A@@@@@A@@@A@@@@BA@@@A@@@A@@BA@@@A@@@A@@@A@@@@@A@@@@@AA@@@@A@@@@BA@@@@@@BA@@@@@AB@@@@@BA@@@@@A@@@A@@@A@@@A@@@A@@@@@@@@@@@@A@@A@@@@@@@@AA@@@A@@@@A@@A@@@@AA@@@@@A@@A@@A@@@@AA@@@@@AA@@@@A@@AA@@@A@@@@@@AA@@@@@@@A@@@@@A@@@@@@AA@@@@@A@@@@@@@A@@@@A@@A@@@@@A@@@@@A@@@@@A@@@AA@@@@A@@@@BA@@@A@@@A@@@A@@@@@@@AB@@@B@@@@@B@@AB@@@@@B@@@@@B@@AB@@@@A@@@@@AB@@@@A@@@AA@@@@A@@@AA@@A@@@@@@@A@@@A@@@@@@@A@@@@@A@@@@@A@@@A@@@AB@@A@@@@@A@@@A@@@A@@@@@A@A@@@@@A@@@A@@@A@@@A@@@A@@@A@@@@@A@A@@@@@A@@@A@@@A@@@@@A@@@@@@@AA@@@@A@@@@@AA@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@B@@A@@@A@@B@@A@@@@@A@@B@@A@@@A@@B@@A@@@@@@@A@@@A@@@@@AB@@A@@@AA@@@@A@@@A@@@@@A@@@A@@@@@A@@@@@A@@@@@@@A@@@A@@@@@@@A@@@@@AB@@@@A@@@@BA@@@@B@@@@@B@@A@@@@@AB@@A@@@@@A@@@A@@@A@@@AA@@A@@@@@A@@@@@AA@@A@@@AA@@@@A@@@@@AA@@A@@@A@@AA@@@@@@@AA@@A@@@@@A@@@A@@@A@@@A@@@@@A@@AA@@@A@@@A@@@A@@@A@@@A@A@@@@@A@@@A@@@A@@@@@AB@@A@@@@@A@@@A@@@A@@@@@A@@@A@@@A@@@A@@@AB@@A@@@A@A@A@A@A@AB@@AB@@ABAAA@KAE@CAE@A@A@A@@@A@@AA@@@AAC@AA@@A@AA@@A@@@A@@@@BA@@AA@AAA@AA@@@@@A@@@@@@@A@@A@@@AA@@@@@@AAA@@@AAABA@A@@@AA@@ABC@A@@BABABAB@@@BA@@B@@@@A@@@A@@@AA@@A@@@A@A@AAA@A@A@@@A@@@@@@@@A@@AA@@@AA@@A@@@@A@AAAAA@@@A@@@@B@@@@A@@@C@A@A@A@A@@@@BA@AB@BCBC@CB@@CDGHABAFAD@BBB@FBH@FAD@BABEFADCBCBCB@BEFCFEDCBEBA@I@GBK@GBE@C@A@AAABCBEBKLABEDCBCDEBABABA@CBA@CB@B@@@@B@@B@@@@B@@@@BB@@@B@@@@@B@@AB@@@@@@@B@@A@@B@@@@@@@BA@@@@B@@@@@BA@@B@@@@A@@B@@@B@@A@@B@@@B@@A@@B@@@B@@AB@@@@@B@@A@B@@@@@B@@@@BB@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@BB@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@B@@@@@@BB@@@@@@B@@@@@@BB@@@@DLJPHLJXL`BDPVBBF@@@B@@@@@BB@@@@B@@@B@@@@@B@@BB@@@B@@@BEBA@CDABCBC@A@CAGCEAC@ABAB@BAB@BBBB@@B@DB@@HBB@BBDBB@B@D@DBB@B@D@HAD@B@BBD@B@DBF@DBFBFDFBFHBBJBHDB@B@BBB@BBB@BD@@@@B@@B@@B@@B@@B@@B@@B@@@@BB@@@@@BB@@B@@B@@@@BB@@B@@@BCB@@@B@BBBD@D@@B@@B@@@B@@B@@B@@BB@@@@@BB@@@@B@@@@BB@@@B@@B@@B@@@@BB@@@B@@@@BB@@@@@BB@@B@@@BB@@@@B@@@@BB@@@@@B@@B@@B@@@@BB@@@@@BB@@@@B@@BB@@@@@BB@@@@BB@@@@BA@@@@@@BA@@@@B@@@B@@@B@@@B@@@@@B@@@B@@@B@@@@@B@@@B@@@B@@@@@BA@@B@@@@@B@@@B@@@@@@@B@@@B@@@B@@@@@B@@@B@@@B@@@@@BA@@B@@@@BB@@@B@@@B@@@@@B@@@BB@@@@B@@@B@@@@@B@@@BB@@B@@@@@B@@@B@@@B@@B@@B@@@@@B@@@B@@@BB@@@@B@@@B@@@@@BB@@B@@@@@B@@B@@B@@@B@@@@BB@@@B@@@@BB@@@@@B@@@BB@@@@B@@@@BB@@@B@@@@@BB@@@@B@@@B@@B@@B@@@B@@@B@@@@BB@@@B@@@@@B@@@@JAVU\\aBCFIT]FGHI@@@A@@@@AA@@@@@A@@BA@B@@@@@@B@@@AA@@@@@@B@@A@@@AB@@@BA@@@ABA@E@CBGBGBA@@@E@@@@@A@A@ABA@@BBB@@@@@@@JDB@DAB@@@BA@@B@@@@B@@BAB@@@@B@@DAA@B@AA@@DA@B@@B@@A@@@@@@BAB@@@@@@@B@@@AAB@B@@@@@@@@@@@@@B@@A@@@@@ABB@A@@@A@@@@@@@@@@@A@@@@@@B@@@@@A@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@A@@A@A@@ABB@@@@@A@@@@B@@@@@@@A@@A@@@@A@@@BA@B@@@@@@@@@@B@B@@A@@@@BBB@@@@@@@@@B@@@@@B@@BA@@@B@@@B@@A@B@ABB@@@A@@B@@@AB@@@@@@@@@@@@B@@@@@@@@@B@@@@A@@@@@@BAB@@@AA@@@A@@@@@@@A@BA@@A@@A@@@@@BA@@@@B@A@@A@@@@B@@@@@@AB@@B@@@B@A@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@AB@@@@B@ABB@B@@@@@@@@BA@@@B@@@@@@@@BA@@@@B@@@@BA@@B@@@@@@@A@B@@@BAB@B@A@BB@@@@B@@@@BAB@@B@@@@@@@@AB@AB@@B@@@@@B@@BA@@@B@@@@@@@B@@@@@B@@@ABB@@@@@@B@@@@B@AB@@B@@@@@@@@B@@B@@@@@@B@@@@@@@BB@@B@A@@@@@@BB@@BA@B@@@@AB@@@@@B@@@B@A@@@@@@B@@@@@@@@BB@B@@@B@@AB@@@@@BB@A@@@B@@@@B@B@@@@@A@@@B@@@B@@@@A@@AC@@@A@@@AB@@A@@@@FCDEBC@@@@B@@@@ADA@@BA@@@@BDB@B@B@B@@@@B@@@@@BB@@@@BB@@B@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@B@@BA@@@B@@@A@@BBB@@@@@@@@@@BBA@B@@B@@@@@@@@@@@@ABB@A@@@BB@A@BB@B@@BA@@@@@@@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@@@@B@@@@B@@@B@@@@@@ABB@@B@A@@BB@@@A@AB@@@@A@B@ABB@@A@@@@@B@@@@@@@@@@@@@B@@AA@@@B@@@@@@@B@@A@@@@@@@@@A@@B@@@@@B@A@BA@@@@B@@@@@B@@B@@@@BA@@BAB@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@@@@@@BB@@@@A@@@@@BB@@@@@@B@@@@@@@@@@@@@B@@@B@@@A@@@@@@@BBA@BB@@A@@@@@B@@BBBA@B@A@@BB@@@@@BA@@@B@@@@@@@@BB@@@@@@@@@@@@@BB@@A@@@BB@@@@@@@@@B@A@B@@@@BA@@@@B@@@AB@@B@@@@@@@@@@B@@B@@@@@B@@B@B@@B@@B@@@@@@@@@@@A@@@BB@@@@@@B@@@B@@@@@B@A@@@@@@B@A@B@@@@@B@@@@@@@B@@B@@@A@BB@@@@@@@@@AB@@@@@@@B@B@@@@@@@BAB@@@@B@A@@B@@@BB@@@@@@@B@@@@B@@@@C@@@@@@@@B@@@@@@@B@D@B@@@@AB@@A@@@@@@B@@@@@@@@BB@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@A@@A@@@A@@@@@@BAA@B@@@A@@@AB@@@BA@@@@@B@@B@@@@@@@@@@B@@@@@@B@@A@@@@@@@@A@@A@@@@@@@@@@BA@@@@@B@@@@@BB@@@@@@B@@@@A@BB@@@A@@B@@@@B@@@@@@@@A@@@BB@A@@@BB@@A@@BA@B@@@B@A@@@@A@@BB@@@@@@@B@B@@@@B@@@@@@@A@@B@@@@@@@@@@@@@@@B@@BB@@@@@BBABB@@B@@@B@@@@DAB@@A@@@@@A@@B@@A@@@A@@B@@@@@A@@A@@@@B@@@B@B@@@@@@@@@B@@@@@B@@@@@@@AAA@@@@@@A@@B@@@@@@@@@@@@@B@@@@BB@@@B@@@AA@@@@@@@@C@@AB@@@@@B@@@@@@@BBB@@@B@@A@@@B@@B@@BB@@@A@@@A@@@A@@@A@@@B@@BD@B@@@B@@@D@@@BB@@AB@@@@A@@@@BB@A@B@A@@B@@@@B@@@@@@@@@@@@B@@B@@@@@@@@@@@B@@@@B@@@AD@@@@B@@B@@B@@@@@@@@@@@B@@@@B@@A@@@@@@@@@A@BBCA@@@@@@A@@AA@@@@@AB@@ABAA@@@@@@BA@@@A@@AB@@@@@B@E@@ABA@@@@@A@@@@B@DA@@B@B@@B@@B@@@@B@A@@@A@@B@@@@AB@@@@@@DDA@@B@@@BBBCBDD@@@@B@@A@@@B@@B@@@@B@@@@@BBA@BB@@@@BA@@@@BB@@@@@A@@@@B@@@@BB@@A@@@@@@@A@@@@@A@@@@@@@@@@@@AA@@B@A@@AD@B@@@B@@A@@@@AAA@@@@ABBB@BB@AB@@@@BB@@@@@B@@BB@B@ABB@@@@@BA@@A@@@B@@@@@BA@@B@@@BAB@@@@@@@@@@AB@@@@@@@B@@B@@@@@@BABB@@@@@@@@ADBD@@@@@@@@@@A@B@@@A@@@B@A@@B@@@@B@@@@@B@@@@B@@@@@@@@@B@@BB@@@B@@@@@@@B@@@@@@@@@@@@@@BD@B@B@@@@B@@D@@@BB@A@B@@@@@AB@A@@@@@@@B@@@@@@@@B@@@@B@@ABD@@B@@@@@@@@DBD@B@B@@@@@@@@A@@@@@A@@@@@A@EAABA@@@AA@@@@A@@@@@@@@@@@@@@@AA@@@BA@A@@@@@A@@@@AC@AAA@C@C@@AA@A@@DABB@@B@@@@@B@@AFB@@@A@@@@@A@@A@@@@@@@@ABB@@@A@@@B@A@@@@B@@@@@@B@@@@@BB@@@@AA@@@@@@@B@@AA@B@@@@A@@@@@@@@@@B@@@@@B@@@@@@@@BB@@@BB@@@A@@@@@@BA@@@@@@@@@@@@@@@BB@AB@@@@B@@@A@@@@@@@@A@@AB@BB@@@A@@@B@@@@@@@@@@@@@@@@@B@A@B@@@B@@@@@@@@@@@@@A@BA@BBA@@@@@B@@@B@@@@@B@B@@BB@@BA@@@A@@BB@B@@@@@@@A@A@@B@@A@@B@B@B@@BB@@AA@@AB@@@B@@@BAB@@BB@B@B@@B@AD@@@BB@BD@B@B@@CBB"]],encodeOffsets:[[[121994,32195]],[[122029,32174]]]}},{type:"Feature",id:"320117",properties:{name:"溧水区",cp:[119.028288,31.651099],childNum:3},geometry:{type:"MultiPolygon",
+coordinates:[["@@AACACEEEEGAEACECG@CBABABBF@BBDDFFFDDDFDDDBHDD@F@DABA@CAACA"],["@@@@@A@@@A@@@@AB@AABB@@@@@@@@@@@@@A@@@A@B@A@@@@@@@@@@@@@@@@@A@@@B@@@A@@ABA@@@B@@@@@@B@@@A@@@@@BAA@@A@@@@@@@@@@@@@@AB@@@@@@@B@@AA@@A@@A@@@@@@A@@@@@A@@@@@@@@@@@@B@@A@B@@BA@@@@@@@B@@B@@A@@A@@@@@A@@@@A@@@@@@B@A@@@B@@AA@B@@@@@@B@@@@B@@@@@B@@EA@BA@@@@@A@@@AACB@@@BBB@@@D@DBB@BBD@@@@@B@@@@@BAB@@B@@B@@@@@@@@@@@@@@@B@@B@@B@@ABB@@@@@B@B@B@@@@@@@@@@@DA@@@@@@B@@@BAB@@AB@@@@A@ABA@@@C@@@@@@@@@@AABA@A@@@@@@@@B@@@B@@A@@@@@@C@@@@A@@@@@@@@@@@A@@@@@@BB@@@@B@@@@A@@BA@AA@AA@@@@B@@@@@@@B@@@@@@B@@@@@A@@@@B@@@"],["@@@@@B@A@@@A@@@@BA@@@@@AAB@@AA@@@@@@@B@AA@@A@@@@@@@A@@@@A@@@@A@@@@@@A@@@BAA@@@@@@A@@@@A@BA@@A@@@@@A@@@@@@@A@@@B@@AA@@@@@A@@@BAA@@B@@@@@@A@@@BA@A@@A@@@@@AAB@A@A@AB@@A@B@@@@@@@A@@@AB@@@@@A@@B@@A@@@@@@A@@@B@@A@@@@@@A@A@BAA@@@@@BA@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@B@A@@@AA@@B@@@@A@@@@@@BB@A@@@@@AB@@@@B@@@@BB@@A@B@@@@@@@B@@BB@@A@AB@@@@@@@B@@A@@@@@@@@@A@@@@@@@@@@@@@BA@@A@@@@B@@AA@B@A@BA@@@A@@@B@@AA@@@@@A@@@@@@@@@A@AA@@@@@BA@A@@@@@@@@@@@@AAB@@B@@@@@@BB@@@@@@@A@@@@@@B@@@@AA@BB@B@@@@B@@@@@@@B@@@@@@@@B@@@@@@@@@@@B@@@@@A@@@@@@@@B@@@@@@@@@@@B@@@BAA@B@@@@@BA@@@@@@@@@@@@@A@A@BB@@A@@@@@@@A@AB@@@@@@@BA@@@@ACB@@BBA@B@CB@@@A@@A@AB@@@A@@A@@@AB@@A@CBA@IC@@@@@@A@AA@@AB@B@B@B@@@@@F@@ABAHAH@D@FAB@B@@AB@@A@@B@@@BA@@@@@@@BB@@A@@@@@@@@AAB@@@B@@@@BB@@@@@B@@GJEHS^EJAD[bUVIB@BB@@@@B@@@B@@@B@@B@@B@@@B@@@@@B@@BB@@@@@B@@@B@@@@BB@@@B@@@@@B@@@B@@@@@B@@@B@@@@BB@@@B@@@B@@@@@B@@@B@@@B@@@B@@@BB@@B@@@@@B@@@B@@@B@@@B@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@B@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@BA@@@@B@@AB@@@@@BA@@@@@A@@@@@AB@@A@@@@@A@@@A@@@@@A@@@A@@@@@A@@AA@@@A@@@@@@B@B@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@B@@B@@@B@@@@BB@@@@@B@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@B@@@@@B@@@B@@@B@@@@@B@@@B@@@BB@@@@B@@@B@@@@BB@@@@@BB@@@@B@@@@B@@B@@@@@BB@@@@B@@B@@B@@@@@@BB@@@@@@AB@@@@@@AB@@A@@B@@@@A@@BA@@@@@@BA@@@@@AB@@@@@BA@@@@B@@AB@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@BB@@@@@@@B@@@BB@@@@B@@@B@@B@@B@@@@@B@@@@@B@@BB@@@@@B@@@B@@A@@@@BA@@@@@A@@@A@@@A@@@A@@@A@A@@@A@@@A@@@A@@@A@@BA@@@A@@@A@@BA@@@@@@B@@@B@@B@@@@@BB@@B@@@@@B@@@@BB@@@@@B@@@@BB@@@@@B@@@@BB@@@@@@B@@@@@B@@@@@@@B@@@B@@A@@B@@@@@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@A@@@@B@@@B@@@@@B@@@@A@@B@@A@@@@@AB@@@@AB@@A@@@@@AB@@@B@@@@AB@@@B@@@BA@@@@B@@@@AB@@@@@@@BA@@@@B@@@@AB@@A@@@@@A@@@A@@@A@@@A@@@@@A@@@A@@@A@@@A@CBCB@@@@A@@BA@@@A@@@@BA@@@A@@@AB@@A@@@A@@@A@@@AB@@A@@@A@@@@H@@BBBBDBDFBDDABAD@B@DAF@B@@@ABABAB@@B@B@DAB@BBBBBFB@F@D@FBB@BBB@@@BABAB@D@B@BAB@@@@@@BB@@B@@@A@AB@B@B@BBF@B@A@@B@@@@B@@BB@@@A@AB@@@@@@@@@@B@@@@BD@@@@@@B@@@B@@B@@@@B@@A@C@@@BB@B@B@@@B@B@BA@@@AB@@BBB@B@@BB@@BB@@@BB@B@@BB@@J@B@@@B@@@BA@AB@B@@@BB@BB@BB@@B@@B@@@D@B@@AB@@@B@@B@@@B@AB@@@@B@@@@@@@@B@@AB@@DBADA@@@A@A@@B@BB@@B@@A@@B@@@@B@@@@B@B@@@BB@@@@AB@D@DBDBLDDB@@FAB@@@@@DBBA@@@@@ABA@BB@B@@AB@@@FA@@@@C@@@A@@@@@@@FCBA@@@@AA@@B@AAB@@@@@@A@@@ABCBB@A@@@ABA@A@@B@B@B@B@@BB@@D@@B@@@@BBABBBD@@B@@@@@@@B@@@AB@BA@@B@BA@@@@@A@@@CB@BDA@@@BA@@@@@@B@@@B@@B@@@@B@@@BB@BB@BB@@@@@@B@@@D@@@D@BBD@BB@CDABCDAF@BCDABCDA@CDAD@BABUJoTBJBP@FITAB@FAD@DHHJNBFADAPLCHCFEFCDAFEB@FCD@@@B@@BBBBAB@FCB@DAFABAD@@A@@D@B@BAB@@AB@BAB@BAB@@B@@H@DBB@@@B@DBD@@@B@B@D@B@B@B@@AB@@A@@DAB@B@B@@@BB@A@@@@@@B@@AA@@@B@@@@A@@@@@@B@@A@@A@B@B@@@@@@@@@@@@@B@@@@@@B@@B@@@B@@A@@@@B@@AFBB@@@@@A@@B@@@@B@@@@@@BD@A@B@@@@@@@BBB@@@B@@A@@B@@A@@@@@@BBA@@@AB@@B@@@@B@@@@A@@@@@B@@@@B@@@B@@BA@@BADA@BB@BF@@@@@@@@@B@@BB@@B@DF@B@@@B@@@@B@@@@B@@@@B@A@B@@@@@@@@@B@@B@@@@@@@@@@@@@@@@B@A@B@@@A@B@@@@@@A@@@@B@@B@@@@@@@@@@@AB@@@A@@A@@@@@A@@@@@@@B@@@@B@@@@B@@@@@@@@@@BA@@@@@@@@B@@@@@@A@@@@@@@@@@@@BA@@@@@A@BBB@@@@@@@B@@A@@@@B@@@@B@@@@@@@@B@@@A@@@@@@@@@@B@@@@@@B@@@@@@@A@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@A@@B@@BBA@@A@B@A@@@@@@@@A@@@@B@@@@B@@@@@@@B@@@@@BB@@@@@@B@@@@@@BB@@@@@B@BFB@A@ACB@@@@@B@@@@BB@@@@@@@B@@@B@@@@@@@@@@@@@B@A@BB@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@B@@A@@@B@@D@@@@B@@@@@@B@@A@@@@@@B@@B@@@@@@@@@B@@@@@@B@@@B@@@@@@@@@B@@@@@@@@@@B@@@@B@B@@@BA@BB@@@@@@@@@@@B@@@@@@B@@@@@@BB@@@AB@@@B@B@A@B@AB@@B@@@@@@@B@B@@@B@@A@BBA@@@@@@@B@@@@BA@@@@B@AB@@@B@@@@@@@@@@@@B@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@A@B@@AAB@@@@@B@B@@@@@@@@@@@@@@@B@@A@@@AA@B@@A@@@@A@@@@@@@@@@@@@@@@AB@@@@@@@@@@@B@B@@@A@@@@@@@@A@@@@@@B@B@@@@@@@@@@@A@@A@BB@@@B@@@@@@@@@@@@@BA@@A@@@@@@@@@@@BA@@ABB@@A@@@B@@@A@@@@B@@@@@@B@@B@@@BA@@@@@@@@@A@@@@BB@A@@@@@@BB@@B@@@@@@@B@@@@@B@@@@@B@@@@B@@@@@@@@@@B@@@@@@@@@@B@A@@@@@@@@BBA@@@@@@@B@@B@@@@B@@@@@@A@@@@@@B@@@@@@AB@@@@@BA@@@@@B@A@@@@@@@A@@BB@A@BB@@@@A@@A@@@@A@@A@@@@@@@@@B@@@@AA@@@@@@B@@AA@@@@@A@@@@@@B@@A@@@@@A@@@@A@@@B@@@@@B@@@@@@A@@@@@A@@@@A@@@A@@@@@A@@@BA@@B@@@@@@@A@@@@@@@@@@@@A@@@@A@@@@@@A@@A@@@@@@A@@B@@@A@@@@A@@@@@@@@@@@@A@B@A@@A@@@@@@@A@@@@A@@@@@@@B@B@@@@@@@@B@@BB@@B@@A@@@@@@@@BA@B@@BB@@@@@@@A@@B@@B@@@@@@@@@@@@@@B@@@@B@@BB@AD@@@@BDAB@@B@@@@BB@@@@@A@@B@@A@@@@@@B@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@BA@@@AB@B@@A@@@@@@B@@BB@@C@@@@@@@@@A@@@@BAA@@@@@@@@@@AA@B@@@@@@A@@@@@@@A@@@@A@@@@A@@@@B@@@@@@@@@@@B@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@AB@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@B@B@@@@@B@BB@A@AB@A@@A@@@@B@@@@@@@@@@@B@@@@@@@@ABB@@A@B@@@@@@B@@@@@@@@B@@@@@B@@@B@@@@B@AB@A@BAA@@@AA@@@A@@B@@@@@@@@@@@@@@@BAA@@@@A@@@@@AAA@@@@@@@@@@BAB@@@@@@@@@B@@@@AA@BA@@@@@AB@@B@@@A@@@@B@BA@@@@@@@@BA@B@@BA@@A@@@@@@@B@@AB@@@@@B@@@@@B@@@@@@@@B@@@A@@@@@@B@@@@B@@@@@@@@B@@@@@B@@@@@BA@@@@@@BA@@@A@@A@@@@@@@@@@@@B@A@@@@@@@AA@@@@@B@@@@A@@@@@@@@B@@@@A@@@@@@AAB@B@@@@@@@@@B@@@@@@@B@@B@@@A@A@@@@@@@BA@@A@@@@B@A@B@@A@@@A@@@@@@@A@@@@@@@A@@@@@B@@@@A@@@BA@@@@@@@@@A@@@@@@@A@A@@@@@A@@@@@@@A@@@@@@@@@BBA@@@AB@@@@@B@B@@@BA@@@@B@@@@BB@@@@@BA@B@@B@@@@@@@@@B@@@B@@@@@@@@@@@B@@@@@@A@@@BB@@@@A@AB@@@@@@@@@@@@@@@B@@A@@@@@A@@B@@@@@@@BA@@@@@@B@@A@@@@B@@@A@BA@@@@@@@@@@AA@@B@@@AA@@B@@@AA@@@A@@@A@@@@B@@@BB@@BA@@A@@@@A@@@@BCBA@@B@@A@@@@@@@@B@B@@ABA@BB@@@@B@@B@@A@@@BB@AA@@B@A@@@BA@@@@@@B@@BBA@@@@@@@@A@@AA@@@@@A@@@@@@@@@@A@@@@B@@A@@@@@@@@B@@@@@@@@@@A@@B@@B@@@A@BBA@@@@@@@A@@A@B@@A@@B@AA@@B@@@@@B@@@@@BB@@@@B@@@@@@@@@@@B@@@@@@B@@@@B@@@@@@B@@B@@A@@@@B@BA@@@@@@B@B@@A@@@@@@@@@A@@@@B@@B@@@@@@@@@@B@@@B@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@B@@@@@@@B@@@@@AAB@@@AAB@B@@@@@BAB@@@@@B@@AB@@AB@BAB@D@DB@B@A@ABA@A@@BABA@@B@@A@@@@B@@@@A@@AA@@@AB@@@@A@@B@@@@AB@@@@@@@B@@A@A@@A@@@@@@@A@@@@@A@@@A@@@@@@@A@@A@@@@@@@@@A@@AA@@@AACB@AA@@@@@A@@@@@A@@@@@AB@@A@@A@AABA@@@@@@@A@@@@@A@@@@@@BA@@@B@@B@@@@@@@@AB@@@@@@A@@B@@@@@B@@@@@B@@@@A@@@@A@@AAB@A@@@AB@AAB@@B@BB@@@@A@@@@@@@@@@@@B@@@@@@@@@@AA@@@@@BAA@B@@@@A@@@@AA@@@@@A@@@@@@@A@@B@@@A@@@@AB@@@@@@@@@@A@@A@@@@@@@A@@B@@@@@@@@@@@BB@A@@@@BA@@@@@@AA@@@@A@@@@@@@A@@@@@@@@A@@@A@@@@A@@@@AB@@@@AB@A@CAA@A@@@A@@A@@AA@@@@@@B@@@A@@@@@@B@@A@@@@@@@@BB@AB@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@BB@@@@A@@@@BB@@B@@@@@@@B@@@@@B@@@@@@@@@@@B@@@B@@@@@@@@@@@@@B@@A@B@ABBB@BA@@@@B@@@@A@@@A@@@A@@@A@@@@B@@@B@@BB@@BBA@B@@@@@@BB@@B@@BBA@@@C@@@@@AB@@A@@@@@@@A@@@B@@A@B@A@@@@@@A@@@@@A@@@@@@@A@@B@@@@A@BBA@@@@@AA@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@A@@@@@A@@@@ACF@@@B@@@@@B@@@@@@@@@@@@A@@A@@A@@@@A@@@@A@@B@@@B@@@B@@@@@@@A@@@@A@@@@@@@@B@@@@@B@@@B@@@@@@AB@B@@@@@@@@@@@DBB@@@BB@@BB@@BC@@@@@@@@B@@@@@@@@A@@@@@@B@@@B@@@@@@@B@@BB@@@@@BB@@@@B@@@@BBB@@B@@@@@B@@@@@@A@@AA@@@@@A@@@AA@@@@@A@@@@@@@@AA@@@@A@@B@BA@B@@@@B@@B@@B@@@B@@A@@B@@@BA@A@@@@@@@@BA@@@A@@@A@@@AA@A@@@AA@@AAAA@@@@A@@@@@A@@@A@BA@@@@B@@@@@@A@@@@@A@@@AAC@@@A@AA@@@AA@@AA@@@A@@@AAA@@@A@A@AB@AA@A@@@A@@AAA@AAA@@A@@AA@@ACAA@@BA@@CA@CACA@@A@@@A@@BCBC@A@A@@@@A@AA@@@A@A@AB@@A@@AAAA@AAA@@@@AAA@CA@@@@AA@A@@AAC@AA@@@A@A@@@@@A@@@@@@@@A@@@A@@@@@A@@@B@@AA@B@BA@@B@@A@@@@@@A@@@@@@@@@@@@@AA@AA@@@@A@@AA@@A@@@@@A@A@@@AA@@@A@A@@@@@@@@A@A@A@@@A@A@A@A@C@C@A@AA@ABA@@AA@@AA@A@A@A@@@@AA@@A@B@@A@A@@@A@@AA@@A@@AA@@@@A@@@@@ABA@@@@@@AA@@B@A@B@@@B@@@@A@AA@@@@AA@@A@@@@A@@@@@@A@@@@@@@@A@BA@@B@BA@@@B@@@@B@@@@@@@@B@@@@@@@A@@@@B@@@@@@@DBB@@@@@B@@@@@@@DB@@@@F@@@B@@@D@B@@@F@@@A@@A@A@@@@BA@@@AB@@A@@AAAAB@@AA@@AA@@AC@@AA@@A@@@@@A@A@A@@A@AA@@AA@A@@@@@AA@@@@@AAAB@AA@@@@@@AAAB@@AB@@@@A@@@@CA@A@@@A@@@AA@@A@@AA@@@@A@@@AB@@@@AAA@CA@@A@AB@@B@AB@@AAA@AA@A@A@@AAA@@AA@@@@@@@@@A@@@AAA@@@A@A@@@@@AAA@@@ABA@A@@@A@A@A@@@A@@@A@@@AA@@AAA@@@@AA@@AA@@A@@A@@AA@@@@@AA@@AADAB@BABA@@@AB@@@B@DABA@@@@@C@@AA@@@@@A@@AA@A@A@@@C@AB@@A@A@@AAAA@@@AAC@A@@A@@@@@AC@A@@@@AAAAAA@A@@@A@@AA@@EACAA@@C@A@A@A@A@AB@@@@AB@@@AAA@GC@@AAC@@@B@@@B@@@@A@@@@@@A@@@A@@@@@@@A@@@BBA@@@@@@B@@@@@B@@@@@@A@A@C@CA@@@@@@@@@AC@BA@@@A@@A@@@@@@@@@@A@@@@@@@BBA@@@@A@B@A@@A@@@CA@@@@@@A@AAC@@@@@@@@@@@@@@@A@@@@@@@A@@AA@@@A@@@@@@@@@A@@A@@@@@A@@@@@@AB@A@@@B@@@A@B@@@@@@@@@@@ACBC@@@@@@@@AAAB@@@@@@@AA@@@@@@@A@@B@@@@@@@@A@AB@@A@@@AB@@@@A@@@B@@@AB@@@@A@BAA@A@@AA@@@@@A@@A@@A@@BAAA@AA@B@@B@BB@@@@@BA@@@A@C@@BB@A@@@BB@@@@@@@@@@@@@B@@@@@B@@@@@@@BA@@A@@A@@@@@@B@@@@AA@@@@AB@@@@AAB@AA@@@@A@@@@@@AA@@@B@@@@A@@C@ACADAA@@A@@@CB@C@@@@A@@B@@A@@@@B@@@B@A@@A@@@@AA@A@@@CBA@@@@@@B@@@@AB@BF@A@@@@@A@@BB@@@B@@A@@@@B@AB@BA@@B@@@@BB@@@B@@@@B@AD@A@B@@@@@@@@@BA@@@@@@A@@@@@@@@@@@AA@@@@A@@C@@B@@@A@@A@@@@@@@@@@@A@@@@A@@@@@@@@@@A@@@@@@AB@A@B@A@@A@@B@@@@@BA@@AA@@C@@@A@@@A@C@@AA@@@B@@@B@@@B@@@B@@@A@@AA@@@@A@@@BA@@@A@AA@@@@@@A@@@@@A@@BD@@@@@@@@@BB@@A@@@A@@A@@A@@@@@@@@@@@@@A@@@@B@@@@B@BB@@@@@@A@@@@@A@@@@@@@@@A@A@@@A@@@@@@BB@@@@@A@@@@B@@@BA@@@@B@@@@@BA@CB@@@@@A@@@AA@BAAA@@@@A@@AA@@@@@@@@@@@@@@@A@@@@B@@@@@@@A@@A@A@@@@@@@A@@AB@@@@@@B@A@@@AAB@@@BA@@A@@@BAA@@B@@@@@@@@@@A@@A@@@@B@@AAB@@@@@@A@@@@A@@A@@@@@A@@@@AB@@@@@@@@@@@BB@@@@@@@@@@BA@@@@@@@@A@@@@@@@@A@@@@A@@@@AB@@A@@B@@@B@@@ABB@A@@@@B@@@B@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@A@@@@@@A@@@@@@@@BA@@B@@A@C@A@@@@@@@A@@@@@@@@@@D@@A@@@@@@A@@@@@@AA@@A@@@@B@A@@A@AB@@@@@@A@A@@@@@@@A@@B@@@@@@@@AAB@@@A@@@@A@@@@@@@A@@@@@A@B@A@@@@B@A@@@@@A@@@A@@@@@@@AA@@B@@@@@@@@@@@A@@@@AA@A@@@@A@@@@@AA@@@@@@@@@@@@AA@@B@@@A@@B@@A@@A@B@A@@@@@@@@@A@@A@@@BA@@A@@@@@@@@@@@@AA@@@@@@@@@A@@AB@@@@A@@AB@A@B@AA@AA@@@@@B@@@AAB@AA@@@@@@B@@@A@@@A@@@@@@@@@@@@@A@@@@@@@AA@@@@B@@@@@AA@@@@@@@@@@@@@@@A@@@@A@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@BA@AB@@A@@A@@@@A@@@@@A@@B@@A@B@A@@@@@AB@@@@@@@@@B@@@@A@@@@@@@A@@BB@@@A@@@@@@@@@@@@@A@@@@@BA@BAA@B@@@@@BAB@@@A@@AB@A@@@AA@B@@@@A@@@A@@@@@@A@@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@@@@@@@@B@@AA@A@@A@BAA@@B@A@BA@@@@@@@@@@@@@AA@B@AA@@@@@@@@@@AA@AB@@@A@@@B@@AA@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@AA@@A@@A@@A@@@@@A@@A@A@A@A@AC@@@@AB@@CB@B@@A@@@@@ADCFED@@@@@BA@@B@@@B@@BD@@@B@@A@@@A@@@B@@@@@A@A@@@@@@A@@@BAA@@@@A@@BA@@@A@A@@A@@@@@@A@@@@@"]],encodeOffsets:[[[121722,32278]],[[122007,32174]],[[121843,32149]]]}},{type:"Feature",id:"320115",properties:{name:"江宁区",cp:[118.840015,31.952612],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@A@@@@@@@@B@@@@BBB@B@@@@@@@B@@@@@@@@@@@AA@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@A@@A@@@@@B@@A@@@@@A@@@"],["@@@@@@@@@AA@B@@A@@@A@@@@@A@@@@@@AA@@@@@@AA@@@@@@AA@@@@@@AA@@@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@A@@AA@@@CBE@@@@@@@AB@A@@@@A@@@@A@BA@@A@@AA@AAA@@@AA@A@@@@@@@A@@@@@@B@@@@@@@@@@@BA@@@@@@AA@@AA@@@@A@@@@@@@@A@@@@@A@@@@@@@@@A@@BA@@@@@A@@@@@@AA@@@@@@AA@@A@@@@@@A@@@A@@@A@@@@AA@@@@BA@@@@@@@A@@@@@@@@AA@@@@@@@@A@@@@@A@@@@@@A@@@@@@@@@@A@@@@@@B@@@@@BA@@@AA@@A@@@A@@@@B@@@@A@@@AA@@@AAA@@@@@@A@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@A@@@@@A@@@A@@@@@A@@@A@@@A@@@@@@@@B@@@@@B@@@@@@@B@@A@@@@B@@@@@B@@@@@@@B@@@@@@@BA@@@@@@B@@@B@@@@@@@@@B@@@@@B@@@B@@@@AB@@@@A@@@@@AB@@A@@@@@@BA@@@A@@@@@A@@@@@@@AA@@@@@@A@@A@@A@@@@@@@A@@A@@@@A@@@@@A@@A@@@@A@@@@@A@@@@@@@A@@@@@@BA@@@@@A@@@@@A@@@@@AB@@A@@@A@@@A@@AA@@@@@A@@@@AA@@@@@@@AA@@A@@@@@@AA@@@@@A@A@@@@@A@@@A@@@@@A@@@@@@@AB@@@@@@A@@@@@@@A@@B@@A@@@@@@@A@@@@@AB@@@@A@@@@@@@A@@@@B@@A@@@A@@@A@@@@@A@@@A@A@@@A@@@A@@B@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@A@@@@@A@@@@@A@@BA@@@A@@B@@A@@B@@A@@B@@A@@@@BA@@BA@A@@@A@@@A@A@A@@BA@@@@B@@@B@@@B@@@@@B@@B@@B@@@B@@B@@B@@@@@B@@BB@@@@@B@@BB@@@@@B@@@@@@@B@@@@@@@B@@@@@@B@@BA@@@@B@@@@@B@@@@AB@@@@@B@@A@@B@@A@@B@@AB@@@@@@@BA@@@@B@@@@BB@@@@@BB@@@@B@@@@@B@@@BB@@@@B@@@@@@@B@@BB@@@@@B@@B@@@@@B@@@@@@@B@@@@@@A@@B@@A@@@@BA@@@@B@@@@AB@@@@@B@@A@@BB@@@@@@B@@B@@B@@@@@B@@@@B@@B@@@@@@@BB@@@@@@B@@@@BB@@@@@@@B@@B@@B@@B@@B@@B@@B@@B@@BB@@@@@@BB@@@@BB@@B@@@@B@@B@@@@@@B@@@B@@A@@B@@@@@B@@@@@B@@@@@B@@B@@B@@@@B@@B@@B@@BB@@@@@@@B@@@@@B@@@B@@@@@B@@@B@@@@@B@@@B@@@@@@B@@B@@@@@BB@@@@@@BB@@@@@@BB@@@@@@@@BB@@@@B@@@@B@@B@@@@BB@@@@@@@BB@@@@B@@B@@@@BB@@@@BB@@B@@@@@B@@@@@B@@@B@@B@@@@BB@@@@AB@@@@@@AB@@A@@@@@AB@@@@@@AB@@@@A@@@@BA@@@@B@@A@@@A@@@@@AB@@A@@@@@@B@@@@@@@BB@@@AB@@@B@@@@@B@@@@@B@@@@AB@@@B@@A@@@@@@B@@A@@@A@@@@@AB@@@@A@@@@@A@@@@BA@@@@@AB@@@@A@@B@@A@@@A@@@@@A@@@@@A@@@A@@@A@@@A@@@A@@@A@@@ABA@@@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@@@AB@@@@A@@@@B@@@@AB@@@B@@@B@@@@@B@@@B@@@@@@BB@@@@@B@@@B@@@@@@@@A@@B@@A@@AA@@@A@@@A@@@@@@@AB@@A@@@@@A@@B@@A@@@@@@@AB@@@@@BA@B@@B@@B@@BB@@@B@@B@@B@@@@BB@@@BB@@@@@B@@@@@@@B@@@B@@A@@@A@@@ABA@@@@@@@@BA@@@@@@@@B@@@@@BA@@@@@@@@B@@@@@B@@@@@@@B@@A@@@A@@@AB@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@A@@@@@@@A@@@@AA@@@@@@@AA@@@@@AA@@@@A@@A@@@@@A@@AA@@@@A@@@@@@AA@@@@@A@@@@@A@@B@@A@@@@@@@AB@@@@AB@@@@@BA@@@@@@@AB@@A@@@A@@BA@ABE@@AA@@@AA@@@@A@@@AA@@A@@@@@AA@@@AA@@@@A@@A@@@@A@@AA@@@@AA@@@AA@@@@A@@A@@A@@AA@@@@@@A@@@AA@@A@@@A@@@A@@@A@@AA@@@@@A@@@A@@AA@@@A@@@@@A@@@AA@@@@A@@@A@@@A@@@A@@@A@@@@@AB@@@B@@@@AB@@@@@B@@AB@@@@A@@B@@@A@@A@@@@@AA@@@@@@A@@A@@@@A@@@@A@@A@@@@@AA@@@@@AA@@@@AA@@@@AA@@@@@AA@@@@@@@AA@@A@@@@AA@@@@@A@@AA@@@@@AA@@A@@@@AA@@@@@A@@A@@@@@A@@@@A@@@@A@@@@BA@@@@@@@@BA@@@@@@B@@@@@@@B@@A@@@@B@@@@@B@@@@@@AB@@@@@@@@@B@@A@@B@@@@A@@@@@A@@@@@A@@A@@@@@@@A@@@@@@@AA@@@@@@A@@@@@@@@@A@@A@@@@@A@@@@@@B@@AB@@@@AB@@@@A@@@AB@@@@A@@B@@A@@@@BA@@@@@@B@@A@@A@@A@@@A@@A@@A@@@@@A@@@@@AA@B@@@@@@@BA@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@B@@@@@@@B@@A@@@@@A@@@@@A@A@@@@@A@@@@@A@@@A@@@A@@@@BB@@@@B@@BB@@@@@@@@B@@B@@@@B@@@@@B@@B@@@@B@@@@@BB@@@@B@@@@@B@@@B@@@B@@@@@B@@@B@@B@@B@@@B@@@@@B@@@B@@@@@@B@@@@@BA@@B@@@@@B@@@BDHB@@@B@@@B@@@@@B@B@@@@@B@@@B@@@B@@B@@@@@@@@@B@@@@AB@@@@@@AB@@@@@@A@@@A@@@A@@@A@@@@@A@@A@@@@A@@B@@@@AB@@@@@@@@@B@@A@@B@@@@@@@BA@@@@@@B@@A@A@@@@@A@@@A@@@@A@@A@@@A@@@@AA@@@@@@@A@@AA@@@@@A@@A@@A@@@@@A@@A@@A@@@@@A@@@@@A@@@@@A@@B@@@@A@@@A@@@AB@@A@@@@BA@@@A@@@A@@@A@@@A@@@A@@BA@@@@@A@@BA@AB@@@BA@@@@@ADAD@BA@@D@B@BABA@AAA@A@CAC@CAE@ABA@C@GDBF@BFZP`V|BFLTFHLRDJHHFFLLPLDBHFHHFFBBTZHFLHHDNDJDPB@@EEAAAA@ACCEICEACAA@@@@HIBA@@D@FA@@BAB@@AB@@@@AABA@@ACCCCDCAACC@@@A@@@@BAD@@A@A@@@@@AB@@@@@DADA@@B@@@@@@@B@@@A@@AB@@@@@@BB@B@@@@B@BBA@BD@BA@@BAB@B@@@@AD@BB@@B@@@B@B@BBDACCDABB@@D@@@@@@@A@GCFACC@AEEHEAAB@BAB@BB@@@@@@B@@@@@B@@@DA@@BB@@@@@@B@@A@B@@@@B@@@@BBA@@B@BA@AB@@ABB@@@AB@@@@B@@@@@BDAB@@@@B@BBB@@BA@@BB@@@@@@B@@@@B@@B@@@@@@@@@BB@@B@@@@BA@@BB@B@@@@@B@@@@@B@@@@B@@B@@@@@@B@@@@@B@@@@B@@@B@@@@@B@@@@@@@@@@BBA@B@@@@B@@@@B@@AB@@@@B@@@@@BA@@@@@B@@@@B@@B@@@@@@@B@@@@BB@@@B@@A@@B@@@@@@@@A@@B@@@BB@@@@@A@BB@@@B@@B@AB@B@@@@A@@B@@@@@@@B@B@@@@B@@AB@@FB@B@@B@@@@B@@@@@@@BB@@@BA@B@A@@BB@@@B@B@BA@@@@@BB@BA@B@ABB@A@@@AD@@B@@@@@@B@@@BB@@@@B@B@B@@@@@@A@@BBB@@@@B@@@@B@@@@@@@B@B@@@@@B@@@BCB@@@A@@A@@@@BAB@@AHB@@@@B@@@B@BB@@@@AA@@@@BA@D@@@@B@@@@@BB@@@B@@@B@@DB@@A@@@@@@BB@@@A@@BCDA@A@C@@@C@@@@@A@@B@@@@@@@@@@@@@BA@B@@@@@ABA@@@BB@@@@@@@A@@B@@@@BA@@B@@A@@@@@@B@@@@A@@@@@A@@@@BAA@BABBBAB@@BB@@@@@BB@@@BBA@@BB@@B@@@@C@@@@@@@@B@@D@@A@@B@@B@@@@@@@AB@@B@@@A@@B@@@@B@@B@@@@@@@@B@A@B@@@BB@@@@@@@A@DBB@B@@ABA@@BB@BB@@@@@BBB@@@@B@@@@@@B@@ABB@A@BBABBBA@BB@@@B@@@@@FB@@B@@@@B@@@@B@@@@@BAB@BABAB@B@@ABBB@@@B@@B@@@@A@BD@B@AD@D@B@@BBD@B@BABFA@B@@@@@B@@@B@@B@@@@A@@@A@@@@BA@BB@@@@@@A@@@@@@@AA@@@@A@@@@@A@@@@@@@A@@BAA@BABABA@@D@@@@@@AA@@@B@@A@@A@@A@@BC@@@AB@@AB@@@BAB@BA@@B@B@@@@@FAB@B@D@A@@@@AFAD@@AB@@@@BB@@B@@@@@BBBBA@@B@@@@B@@B@B@BA@@A@A@@@@@@@@A@@B@DB@@@@@@@@@@@BB@@A@@@@@@B@@AB@@@B@ABAHB@@@@@BA@@B@@@@@AAB@@@AA@@B@@@B@@AD@B@B@@A@AB@@AB@@@@@@@@BB@@B@@@@B@A@BBA@@@AA@A@@@@A@@@@@@BB@@@BD@@BB@BABB@@@B@@@@@DA@@BBB@B@@@B@B@@@@B@BD@B@@B@@@@B@@B@@B@@@@@@@@B@@AB@@@@A@@@A@AB@AA@@@@@@BB@@B@@ABBB@B@@A@@@@@A@@@@@AB@BD@@@B@@@@B@F@BA@A@AB@@@@@B@B@@@@ABABA@@@CBAB@@@B@BBB@B@H@DB@@D@B@B@@DA@@AB@@B@BA@BB@@@@A@@@BB@@@@@B@@DNABB@B@@@@AB@B@@@@@@@@@@@@@@@BB@AAB@@B@@@A@@@@B@@@@@@@@@@@@B@@@@B@B@@@HB@FFBFBDC@@HB@@B@BADE@ABB@A@@B@@@@ABB@@A@B@@@@B@@@@@@@@B@@@@B@@BA@B@@@@A@@@@@@@@@@@@B@@@B@@BB@B@@@@@B@@@@@B@@ABDB@@@B@BBBB@@@@@@B@@D@B@BBFBDBD@@@@@B@@@@@B@@B@@HBB@B@@@@@B@@@B@@@@@F@B@@@BB@@BB@@@@@B@BBABBA@@@B@HF@@DBBB@@@@@@@B@A@@@@@@@@BBBBBBBBBB@@BBFDA@BBB@DBDD@@@@@BBAD@@@BA@@B@@@@B@@BB@@@BDBB@@B@@DBB@@@@BBB@@B@@@@B@B@@@B@BHBBDB@BBBBBB@@BBDB@@D@DBFBBBB@ABB@B@@@@D@@BBBBNDB@BBDDDDDDFHBAB@@B@B@@A@A@@BBBA@@AA@@@@@@@@B@@@AABDFBDDDDBABCBCB@@@@@@@BBDB@@B@BB@BB@@AB@@@@BB@@B@@B@@@D@@@B@B@@A@@@A@AB@B@D@@@DBB@BB@BBCD@@B@BB@A@@@@B@D@BA@AB@@A@@BB@@B@@@BADA@@@B@@BADBBD@BD@DB@D@D@D@@@BB@D@B@@BBHB@DB@@B@@D@B@B@B@@BBB@DDDD@B@@B@B@@A@C@@@AB@B@BDB@B@D@@@BBDDBBBDD@DD@@D@FAFA@@BBBBB@B@@@BAAA@@AA@AB@@AAAAGACAABA@@BE@@AAC@@@A@@A@@A@@A@@A@@AB@D@B@@A@@CCACA@@@A@@B@@@@A@@@AC@AAC@A@A@@@@B@@AB@@AAA@@@ABBB@BAD@D@BA@@@@L@@@AG@A@AB@FBBADBBABB@B@BBBB@@@DBBB@B@@HFBBFBDB@FA@@@E@A@@B@@@F@DAD@BAB@@BBFB@BBBDB@@BBBAB@BABB@@B@D@B@DD@@D@B@BABADAB@BB@@B@BA@@B@@@B@J@BBB@BCBBB@BBB@BA@@@@DAB@D@@BBB@D@@CBA@AB@BB@BBBBBB@BBBBC@@B@@@B@@AB@B@@@B@@@@@@@@@@@@@@B@@BA@@BABB@@BB@@B@BBDBB@@@BB@@B@@B@@B@@AB@@@BD@@B@B@@ABA@@@AB@B@B@@BD@D@@@@@@A@@@A@@@A@@@A@A@@AA@@@@@@B@@@BA@@AA@@BA@@@@@@@AB@B@BBB@@@@BDA@@D@@@@@@AA@@@@A@A@@@@AB@B@@A@BA@ABA@@B@B@@@B@B@@AB@@@B@@@@@AB@@B@@BB@@A@@BA@@@A@@@@BAB@D@B@@@@AA@@AAA@@@A@@B@@AB@@@@@@A@@AAAA@@BA@@AA@@AC@@BC@@@@BA@@@@AAB@@@@@B@@@@@@A@@A@@CA@@@@C@AACA@@C@@BA@@@@@@@@BB@B@@B@@@@@@@@A@A@A@@@@A@@@@@@@A@@@A@@@@@@@B@@@@C@A@@A@@@@@A@@A@@A@@@@@@@@@A@@@@A@@@@@@@@@A@@A@@A@@@B@@@@@BA@@@A@@AB@@@@@AAA@@@AA@@@@@@@AA@CBA@@@BA@@@@B@@@AC@@A@A@A@@@BADA@@AA@A@@@A@@@@B@@@@A@@@@BA@@@@@@@@@@BA@@@@B@@@@B@@@A@@@@@@B@@@@@AAB@@B@@@@@@@@@@@A@A@@@@@@@@@@B@@@@@@@B@@@A@BAAAB@@A@A@@@@@@B@@AB@@@@@@@@A@@BB@A@@@@@@@@B@@@@@@@@A@@@@@AABB@@@A@@@@@@@@@@@@@@A@@@@@@@A@@B@@@A@@A@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@AB@@@A@A@@@A@@@@@AAAB@@@AAB@AAB@@@@@FA@@@@AA@@@@BA@@@AA@@@@@A@@@AE@@@@B@@@B@@@@@@@B@@A@@@@@A@@@@@@B@@@@A@@@@@A@AA@@B@@ACB@A@@@@@@A@@@@@A@@A@@@AB@A@@B@@@@@A@BA@B@@@A@@A@BA@A@@@@@@@@A@@@@@A@@B@@@A@@@@@@@A@@@@@@B@@A@@@AA@@@@A@@@@@@@A@@@@A@@@@A@@@@@A@@@@A@@@@@@@@A@@@@@@@@@C@@B@@@@@@@@@@A@@B@@@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@BBA@@B@@@@@@@@@@@B@@@@@@@BB@@AB@@B@@@B@B@@@@@@BB@@@@@@@BB@@@B@B@B@@@AB@@@@@B@@@@@B@AA@AB@@AB@@@@@@A@@@@B@A@BB@A@@@@B@@@@A@A@@@@@A@@B@@A@@@AB@@@@@@@@@@@B@@@AAB@@@@@@@B@A@@@B@@@@@@@@@B@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@A@BBA@@@@AA@@@@@@@@B@@@@A@B@A@@@@@@@@@@@AA@@@@@@@B@@@@A@@@@@@@@@@@@@@A@@@@@@A@@B@@@@@@@@@@@@A@@D@BA@@@@@@@AB@A@BAB@@@B@@A@@@@@@@@@A@@A@@A@@@@B@@AA@@A@@@@@@B@@A@@@@A@@@A@@@AB@AB@@@@@@@A@ABA@C@@@@@@@A@@@@@@B@@@@@A@@C@A@@@@A@@@@@@@@@@BA@@@@A@@@@A@@@@@@@@@@BA@@@@@@@AA@@AB@@@@@@A@BB@@@B@@@AA@@@@B@@A@@@@@@A@@A@@@@AAC@@@@AA@@AA@@@@@@A@@@@A@@@@@@A@@@@@@@@A@@@B@@BA@@@@@A@@@@@@BA@@@@@A@@@@@@@@A@@@@@BA@@@@@A@@@@@AB@@@BA@B@@@AB@@@@A@AB@@@B@@@@@B@@@AAB@@@@@@@@@@@@@@A@@BA@@A@@@@ABB@A@@@A@@@@@@@@@@@@A@@A@A@@@@B@@@@@@@AAB@@@AB@@@@@A@@@@@@@A@@@@@@B@@@@A@@@@@@@A@@@@AA@@@AB@A@@@BC@@A@@@BAA@@@AA@@@@AA@@@@A@@A@@@@A@@@@AA@@@@@AB@@@A@@@A@@B@@@B@@@@@@@@@AA@@@@@@@@@@A@@@@@@A@A@@@A@@B@@@B@A@@A@B@A@@B@@A@@@@@BBA@@@@@@@AA@B@@@@B@ABB@@@@@BB@@@@A@@@@B@@AA@@@B@@B@@@B@@@@@@@@@@B@B@@@@@B@AA@@@@@AA@@@@A@A@@@A@B@@@@@@B@@@@A@@A@@A@AA@@B@@@@@A@AAA@@@@@@A@@@@A@@@@@@@@@@@@BA@@@@A@@A@@@@@A@B@A@@@B@@B@@A@@@A@@AA@A@@A@@A@@@@@@AA@@BAA@@@@@@A@@@ABAA@@@AABAA@@@@@@@A@@@@A@AB@@@@@B@@@@AA@@@AA@@@@@@@@@@@@A@@A@@@@A@@@@@A@@@AA@@@@@A@AB@@@@A@@@BA@@@@@ABB@A@@@@@@@@@@@A@@A@@A@@@@@@@B@@A@@@@@@B@@A@@A@@@A@@AB@@@@BB@@@@@@@@B@@@AB@AA@@@@BA@@AB@@@A@@@@@@@A@@@@AA@@@@B@@@A@@@B@@A@@@@@@@@A@@@@@@@@@A@@@@@@@@A@@@@A@@A@BCAAB@B@@A@@@@A@@@@@A@@@@@A@@@@B@@@@A@@@@@@@A@@@@@A@@@AA@@B@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@B@@@@@BA@A@@A@@AB@@@@@@A@@@@@A@@AC@@BA@B@@B@@@@@@@@@@@@@B@@@A@BA@@B@AA@@@@@@@@@@AB@@A@@@B@@@@A@@@@B@@@@@@@@@B@@@@A@BBA@@@@@@@@B@@@@@@@BA@@A@BA@@B@A@B@@A@@@@@@B@A@B@@@@@@@B@@@@@@@DA@@@@@@A@A@@@@@@@@A@@@@@@A@B@@@@@A@BA@@@@@@@@B@@@@@@@@@@@@A@B@AB@@@B@@@@@@@@@@@@@D@@@B@AABB@AD@@@B@@@@@@B@@@@B@@@@@@@@@@AB@@@@@@@@@@@B@@@@@@A@@BB@@@@B@@@@@@@@@B@@B@@@A@B@@@@@@@@@@@B@@@@B@@B@@FAJ@DBD@B@@@B@FALCB@@@B@HAD@FCBAB@B@@@@@@@B@@@A@@@BB@@@@@@@@@@B@@@@@@@@@@@B@@@@@BB@@@@@AB@B@@@@@@A@@@@@@@@B@A@B@@@@@B@BB@@@@B@@@@@@@@BB@@BBA@@@@@A@@@@@@@@@A@@DB@ABAA@@A@@B@B@@@@AA@@@@@@@@@@@@@@AB@@@@@@A@AD@@A@@AAB@A@BAA@@AB@A@@AB@@@@@@A@@@@@@BC@@@@@@@@@@@@@AB@A@BAA@@@B@@@AA@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@A@@@B@AA@@@@@@@@@@@@@A@@@@@@@A@@B@@@B@@@@@@@B@@@@@@@@@@@@AB@@@B@@@@@@A@@@@A@@A@@@@BA@@@@@@@@B@@@@@@@@@@C@@B@AAAA@AAA@@@@@@B@@A@@@@DAB@@@@@B@@AB@@A@@@AB@@@@A@@@@@@@AA@@@@@@@@@AA@@@@@@BA@@@@@@@A@@@@AA@A@@@A@@@A@@@@@@A@@A@@@@A@A@@@C@A@A@A@@@@@@@A@@@AA@@@AA@ACAC@@AAAA@@@C@A@C@ACE@A@A@AAA@@ECA@@AAAAAA@@AABA@QDKBEB@B@@D@@@CB@@@@@@@B@@@@B@@@@ABBBA@@@BAB@@BA@BBA@BBA@B@@@B@@@@@AAB@BB@@@A@A@@B@@@@@@@AA@@@@@@@@B@@@B@@@AA@@@@A@@BA@@AA@@A@@@@@@@AABAA@@@@@BAAA@@@@AB@@CHA@AB@ACA@@@AAA@@A@@AA@AA@@@@B@@A@@@@@@@B@@B@@AAA@@@@B@@@@@@@AAAAA@A@@@@@@@AB@@@@AB@@@@AA@@A@@A@@B@@AA@@@C@@@@@@@ABAB@@CA@@@A@@A@A@@AA@@B@@A@@@@@@CA@@CC@@@@A@@@@DA@@E@@@B@@BC@@@@@@AAA@@@@@@CAIBAC@@AB@@A@AA@@@@@@@@A@@A@@AAABAEE@@A@@@@AAC@AB@@ABEA@B@@IC@DA@BAAAABA@@BB@@BAA@A@A@@AA@@@@DB@AA@@@@@@AB@@CCA@@A@@@@@@BA@A@@@AB@@@@AA@@@@AA@@A@@BA@AA@@@@@@@@A@@@BB@@@@B@@@@@@@DB@@BB@@@@@@@@B@BB@@@@@BA@@@@B@@@@A@@@AA@@@@A@@@A@CA@A@@AD@@@@A@@@@@A@@@B@@AA@@@@@@@@ACA@B@@@@@@@@AB@@@@@@@@@@@@@@A@@@@@@AA@A@@B@@@@AA@@@BA@@@A@@A@@C@@@A@@@@@A@A@@@A@@@@C@@B@@AA@@@@A@@A@@A@@@@@@@A@@A@@CA@@B@@@A@BA@A@@@@@A@@@@@@@C@@@@@@B@@@@A@@@@A@@@@@@@@@@@@A@@A@AB@@@A@@@@@@@@B@@AB@@@@@@@@@@@@@A@@@@A@@@BA@@@@B@@@@@BA@@@@@@@@BAA@@A@@B@@B@B@@@B@@BA@@@@B@B@@AA@@@A@@@AAB@@@@@D@@A@@AAAA@AAAA@@@@AB@@A@@DAB@DAD@B@@ABAB@BAB@BAB@BAB@@ABABABCB@B@@@@@@ABC@A@ADEDABCBA@@@@B@B@B@B@BABAB@B@@A@@@A@A@@@C@A@@AGB@@AB@@@B@BA@B@A@@A@@@A@@@@@@B@A@@C@@A@@@@@B@@AA@@AA@@@@AA@@A@@@@@@@@@A@@@A@@@@A@B@AA@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@AA@@@@@@@@@B@@CAB@A@B@A@@@@@A@@@@@@@@AB@@@@@@@A@@@@@A@@@@A@@@@@@@@@@@@B@@@@@@AAAB@@@@@@@@@@A@B@AB@@@@@@@@@@@@@B@@B@@@@@B@@B@@A@AB@A@@@B@@@@@@A@A@B@A@@@@@@@@@@@@B@@@AA@@@@@@@@@@@@@A@@BA@@@@@@@@@@@A@@@@B@@@@@A@@@@A@@@@A@B@@@A@@@@@@@@@@@@A@@@BBAA@BA@@A@@@@B@@@B@@@A@@@@@@@C@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@A@@@A@@@@A@@@@A@@@@@@@AB@@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@@@BA@@@@@@@A@@@BA@@@@@@A@@@@@@@@@@@@@A@@@@@@@@BB@AB@@@@@@A@@AA@@BA@@@A@@@A@@CA@@AA@@AA@@A@A@A@CB@@@BA@@BA@A@A@C@A@A@@@C@CAA@@@A@CAG@@@@AA@ABA@ABA@@BA@ABA@C@@@@BC@ABEBCBA@EDA@ABAA@AA@@@C@EDA@EFCBEDEFGDKDBOBCAEIMGG@CBC@EBAJS@EAOAIpSVIBA@ABCDCB@DCBADC@ABEDCBADCA@@AAC@A@C@@@C@@@A@@@@A@@AAAA@@A@@@A@@A@@@@A@@@A@@@@B@@A@@CB@ADA@@B@@@@@B@@A@AB@@ABA@@A@@@@@@@A@@@ACAAAB@A@@A@@@@CA@@AA@A@A@A@@@@BAB@B@@@BAAAD@B@@@B@@@@A@BBA@@@BB@@@@ABED@@@@@@B@@@D@@@@@EB@@A@@BA@A@@AAB@B@@@@ABCA@@@@A@EB@@CAKCCACAC@A@@B@@A@@A@@@A@A@@A@@@@@@AB@@@@AA@@A@AB@B@@@B@BCCA@@BA@@@A@@@@@@A@@@@@BAA@@@A@@@@A@@BA@@@A@C@@@AA@@@AAA@@AAA@@A@A@@BAB@@A@@@A@I@@@AA@@@AAA@@A@@AA@@AA@A@AA@@BA@@B@@A@A@A@@@A@AAA@@D@B@@@@A@@A@@@@A@@@A@@@@C@@A@@A@@@@@@@@@@@BAB@@@A@@AA@@@@@@AB@A@E@AAA@A@A@@B@B@@@AA@@A@@@@A@ABA@C@A@ABAB@@A@AAA@EAC@E@A@AEAAAAA@CBA@A@@@BABABA@@A@E@CBA@C@ABCBACCECAAAAA@@@GA@@@@@A@@@A@@@AA@@A@@@A@@@@@A@@@A@@@A@@@A@@@A@@@@@A@@@AAA@@@@@@@@A@@@A@@@A@@@@BA@@@A@@@@AA@@@@@@@@AA@@@@@@A@@A@@@@A@@@@@AA@@A@@@@@A@AA@@@@A@@@@@A@@@@@A@@@A@@@@@A@@@A@@@@@A@@@@@A@@@A@@@A@@B@@A@@@@B@@A@@B@@@B@@A@@B@@@@AB@@@@@B@@A@@@@B@@A@@@@BA@@@@@@B@@A@@@AB@@A@@@A@@@A@@@A@@B@@@@@BB@@@@B@@@@@BB@@@@@@B@@@@@@@B@@@@@@@B@@@B@@@@@@AB@@@@A@@B@@A@@B@@A@@@@B@@@@@B@@A@BB@@@B@@BB@@@@@@@@@B@@@@@B@@@@AB@@@@@B@@A@@@@@@@AB@@@@@@@@A@@B@@@@A@@@@@AB@@@@@@@B@@@@@B@@@@@BA@@@@@@B@@@@@@@BB@@@@@B@@@B@@B@@B@@@@@B@@@B@@@@BB@@@B@@@@@B@@@B@@B@@@@B@@B@@B@@B@@@@BB@@@@@BB@@@@@@BB@@@@BB@@@BB@@@@AB@@@B@@@@A@@B@@A@@@A@@B@@A@@@A@@B@@A@@@A@A@@@A@@@A@@B@@A@@@@@AB@@@@AB@@@@A@@@@B@@A@@@@B@@@@A@@@@B@@@@A@@@@B@@@@A@@@@B@@@@A@@B@@@@A@@@@@AB@@@@A@@@AB@@@@A@@@A@@@@@@@A@@A@@A@@@@@@AA@@@@@@AA@@@@@@@AA@@@@@@AA@@@@AB@@@@A@@@A@@@@@@@AB@@@@A@@@@@@@A@@@@B@@A@@@@@A@@@A@@@@AA@@@A@@A@@A@@@@AA@A@@@@@A@@@A@@@A@@@A@@@@@A@@A@@A@"]],encodeOffsets:[[[121466,32639]],[[121599,32437]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
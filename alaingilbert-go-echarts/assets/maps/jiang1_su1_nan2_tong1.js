@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("南通",{type:"FeatureCollection",features:[{type:"Feature",id:"320602",properties:{name:"崇川区",cp:[120.857434,32.009875],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@GG@@BAB@RKDAFAPADA@AJBB@B@@@JBP@BBHAFA@B@@VC@Y@K@@@@@A@O@@B@@E@A@@@@CHGEMCCAABA@@A@@K@@LU@IBBI@IECCACC@AACIuCKc@A@G@E@KDSDQDRCBA@EBKDCBOFKHOHIHIHCDADCB@B@B@HAB@@CBCBA@CBADABCDAF@LAB@BCBCBGLCHCLAFKR]jIXABCTAFIhAHALERAHELKRABQLBN@D@B@DBFBBFFBDFDFFBBB@@@B@BBDBHHDB@@CBBBTRBAB@BB\\BB@XDB@@@AF@@@B@@@BF@DBBAAA@C@@B@@BB@@@BB@C@@@AD@B@P@@D@@ABD@D@B@D@D@B@D@@@@D@BBB@BB@BDBBBD@BDD@@@@@A@A@@@@@@@@@@@@@@@A@@@@@@B@@@DA@@AA@@@A@AA@BC@@@@@@@@B@@@@@@@A@@@@@@@BB@@@AB@@@@@@@@@B@@A@@@CB@F@@EDBB@R@N@PBB@L@`@FB@E@IHCAC@@@CAC@AAAKB@@@AAC@ABGBKBABCB@DEG@G@CASDC@AKAIAKF@F@@@BABE@ADIBC@BDA@AB@B@BBDFDDDAAIAE@ADM@ABB@AB@F@DATCAB@@@BLDLDHDFBTN@@BA@A@@@ADCBA@AB@B@BABCCBACA@BO@AC[@Ae@A@BE@ABC@CDIBK@@FSBCAA@@B@BCB@BCBC@@@@@@B@@@B@B@AA@A@@BA@@GMAE@@H@F@DA@@DDD@FARE@@@AAEBC@@@@@EAACM@@"],encodeOffsets:[[123930,32641]]}},{type:"Feature",id:"320611",properties:{name:"港闸区",cp:[120.818526,32.032441],childNum:1},geometry:{type:"Polygon",coordinates:["@@DA@@CAGGCAAAA@@@A@AAEEECACEEAAAE@C@A@CAMMNKDO@uBG@S@U@JDJBFFLDJBDDJBDBD@BFLBFFJBHBB@@BFDLBFBDBDBBBBBBPPJHDDFFJLFDFFNPJJDAB@DAB@DBBA@@@C@AAE@@@@@@D@B@JED@AC@A@@@@@ADAB@AAB@@CB@B@FA@@BA@@BBB@@ABBB@B@@@DB@@@AB@B@@A@@@@DBD@@@B@B@@@DBB@BBB@@@DEDCBCB@BA@@DABCJEBABAB@@@BE@@DEDCBA@@@@C@@AA@@@A@A@@@@ACA@@@@@A@@@AC@CC@EBA@C@A@EAA@ABAA@@A@@C@@@AAB@@@BAB@BA@@@@B@DD@@FBDDB@BBD@FDBB@@@@B@@@DBD@BADE@@HD@C@@@@D@@@BA@B@@@BB@BBDDB@@@B@@@DB@@@CBA@@ACB@HA@A@@TI@@@A@AAACC@@AA@@CEAA@A@@@@CAACAAA@A@E@A@@@@ABA@C@A@AA@@A@@@ACC@AACAAACA@@AAA@A@C@@C@A@C@C@A@C@C@BA@@@CO@A@C@@B@@@DAA@@A@@AA@@@@DBBABCAE@@A@@@A@@BE@@A@WCA@[AAAA@ABSQAA"],encodeOffsets:[[123731,32794]]}},{type:"Feature",id:"320612",properties:{name:"通州区",cp:[121.073828,32.06568],childNum:1},geometry:{type:"Polygon",coordinates:["@@O@IA@@A@A@IA@BCBOBEBCBQLA@AB@@HHB@@@@@DNBB@F@@@@ADBF@B@@QFEBC@CC@@CBE@G@@@BFHN@@AB@@@BBBA@A@@@A@@@@@@@ADADA@ADA@@@BBADET@@ALCJ@DAD@BAFB@f@@BD\\@BAPB@BDDAADABA@A@@BABCD@B@@@BAB@@SMEAGCKCKC@A@@BASDCBE@A@@BAA@BCN@BBFBJCBCCCEAAA@A@@BCB@AADCJ@BAFAB@@E@E@BLBJBLD@TCDBH@H@CFA@ADABALAH@BBD@B@@LABB@BBD@D@@BDGD@J@FEA_@K@A@OAM@Q@A@CA@FE@A@@D@@@BA@@@@@@@@@A@@B@@AA@@@@@@B@@@@@@@A@@@@@@@@@ADB@@B@B@@BB@@CB@@A@@@@@@@@B@@@@@@@@@@@@@@@B@B@@@@@B@@@BB@@B@B@DAB@B@@B@F@B@B@BBBDDB@@@@@BBBDF@@BB@@DDBB@B@B@@SJ@@@BGBA@BD@@AB@D@@CA@@A@@@A@CCAAA@@A@@@AAB@@C@@@@@@DGC@@CFABC@CA@@A@@@@@AAECC@AAA@CCEA@@CCA@@@@@ABA@AB@@A@BB@@D@@@@BB@AB@BBB@F@B@DAB@FDDD@@B@@@B@@@@DB@B@@B@B@@@B@@BD@@@@@ABCDCF@@AF@@A@ABABIFADCB@@ABA@ADCDCF@@A@AAA@CA@@A@A@@@C@CA@@@@@BA@A@@B@@CA@@A@A@AA@BA@AA@@AB@@EBA@A@@DA@BBA@CB@B@@@@@BBDC@IFA@C@@@@@@@BF@B@D@@ABCAA@CBA@CBIIMOEEECIKEECCIGOOAAAAAAACACAECKAE@@AAAGEIAEEK@AACACCIACCIEKAECIIc@MAAYBqADRDFLTFFBD@DA@@BC@KBWDGBABAD@BE^DBFDJF@@FFB@FDB@B@HB@B@DAT@BB@@B@B@@AHD@BB@@@@BB@@BB@@@@BB@DBBBA@B@@@@@@@@@@@B@@@@BBABBBB@@@B@@@@@B@@@@@B@@BD@@@@@B@D@@@DB@@RD@@H@@@@B@@FB@AB@@@A@BABB@B@@@FAFAFAC@@C@@@@B@@AB@@@BA@@@A@@@@BBBBD@BDH@B@D@BB@@@@@BA@@@AB@@@B@B@B@DA@@D@DB@@BADA@@@@B@@@@A@@B@BB@@@BBB@@B@@@B@@A@@B@@@B@BA@@B@B@@@BB@B@B@D@@@B@@@B@D@@@@@D@@@@@B@@B@@B@@BB@@@B@D@@@@A@@BA@A@ABBD@@@@@@BB@@@BC@@AC@@B@@@BC@A@E@F\\@@B@BFB@@B@BA@@@@@@F@@F@BBJ@B@BB@B@@@B@AB@@@H@@C@@@AAA@@@E@@@@D@@@@C@@@@B@B@@@BBBBB@B@H@JAB@H@@@B@D@@BB@B@@@B@DBABA@@@@BBBBD@@AB@@@@A@A@A@A@A@IAE@C@A@A@BB@@BB@@DD@@BBBD@B@@AB@@@B@BAD@B@BB@@@@@@@@@B@B@@@B@@@BB@B@@@BB@@@@@B@B@F@@ABBA@AB@B@BADC@@@@BA@@D@@@BLBRBNBB@@D@BD@B@BF@@B@B@@@@@B@@@DA@@D@BA@@B@@@B@BAB@@ABC@A@@B@DBF@@@@BBDA@@@@@@B@B@@@@@@B@@AB@@@@BB@@@@D@@@@AB@@AA@@AB@@@@@@AA@B@@@B@@@BABAB@B@B@B@@A@@@@@@BBD@BAB@@@@@B@BBB@@@@@@@B@@@@DFBF@@DFBDFHFJBHBHBBB@@@B@BAB@B@BBD@@@BAB@@AB@D@D@D@DA@ADC@@@A@@@AC@AA@A@@BADA@@B@BBDAB@B@BA@@@B@@B@B@@B@@@B@@B@@B@@@B@B@@BH@ABA@@B@@A@@@@B@BD@@B@@C@@@@@@@C@@B@@DDABB@@B@B@@B@@@@A@@B@@@B@@@@BDC@@@A@BDB@BBA@DD@@@BAB@@@@@BABA@@@BBAD@@B@@BH@ABB@D@FA@@@A@@BADAB@@@@@@B@B@@@@@@@@F@@A@@B@B@@@@ADADABA@BB@@@@A@@B@@@BAB@@@A@@@B@BA@@@@@AB@@A@@@A@@B@@A@@@@DA@@BB@BA@@AAB@@A@@BB@BAB@B@@@A@BB@@@@B@@AD@@@@@@AD@@AA@@@@@B@@ABC@@D@@@@@BB@@@B@@D@@@AD@@@BB@@@@@HB@F@@D@B@@@BC@@B@@@A@@AB@@@@AB@@AB@@BD@@@B@@@B@ABB@@CB@@BB@@@B@@AB@@B@@@@@A@@@@@BB@@@@@D@@A@@@ADB@@@@DBAEBE@A@@@@D@B@B@@@B@@B@@B@@B@B@BC@@@A@@@@BB@@FB@@@@@@@@@@BA@@BFBB@F@D@D@D@@@B@B@D@B@@@D@DAB@D@@@D@B@B@F@@AB@@CB@D@@@B@BBB@@@B@@A@@B@BAB@B@BABA@@DCDB@BB@@@BAB@@DB@@@BB@BB@BADB@@BDBB@@BDBBD@B@D@DBD@BB@@B@F@@@D@@@@B@@@@BB@@@@@AB@@@@@@B@@B@D@B@@@@@B@B@BBB@@@BAB@@BB@@@B@@@B@@B@@@@B@B@@@B@@B@@@@B@@AB@@@@BB@B@B@@BB@BA@@BBBBDW@@F@F@FA@AB@@@A@B@@@B@@AB@@A@@B@B@@B@B@@B@@@@@BB@@@A@@B@@BB@@@@@@@@@B@@@@@@@@BB@@A@AB@@@@@@@@@@B@@@@B@BCDAA@BAAAA@@@@@B@@A@@@@B@@@@@@@@A@@BA@@A@@@@@@AAAB@@@@BBA@@BA@@@BBA@@AA@@B@B@@@@ABADBB@@A@BBA@A@@D@@@@@@B@@@BA@@@@BB@@@BA@@@@B@@@B@@B@@BA@@@@B@@@@B@B@@B@@@@@BBB@@A@@@BBB@@BA@@@@@AA@AAA@@@@@@@@A@@@@BAB@@B@@@@B@@@@A@A@@@@@@@A@@B@@@@@@@@A@@@@B@@AB@@@@A@ABAB@@A@BD@D@@@B@@@@@B@@@@@B@B@@@@@@AB@@AB@@A@@B@BB@@B@@B@@@@BB@@@A@@B@B@@@B@@@@@@AB@B@@AB@@@BA@C@@@B@@@AB@@@@A@@@@@A@@@A@@FAD@@@H@@CHBJ@R@XCJAB@jGVCB@F@LAPA@@NARC@@@B@@B@@@@B@@@BB@D@B@@@@@@ABC@@B@@@B@@@@AD@F@DBDBD@D@B@B@DABAB@DA@@D@B@@ADA@A@A@ABABAB@B@BABA@@DB@@B@BBBB@@NARAB@LBLBHBD@XBH@tB\\@P@JBB@D@F@F@F@FBB@B@J@BBBBD@@AB@BBB@BBF@BB@@@AB@B@@@B@B@DB@@B@B@B@FADA@@B@BB@@@B@@@@ABCDADAB@@BB@@BBB@D@B@@@BABAB@@AB@D@B@B@B@B@DBFAF@B@B@BADAJGLILGTGHAbECQNkHOsO@AAAACA@AAGA@AA@@A@@B@@@B@DC@A@@@A@@@A@@@AACAAA@@A@AAA@AACACAE@@@AA@@@BO@@@A@@@A@@@C@E@A@I@@@@UA@@@B@D@@@@CB@@A@E@E@ABE@EB@@C@O@A@@@@GC@A@EAG@CAEBM@QAG@E@CBE@G@CA@FGAAPE@G@AZO@iCU@QA@AA@@@]AM@@HA@CCA@A@CC@A@@@@BA@@@@AACC@@A@BA@@AEBAAA@@BA@A@@AIAA@A@@BA@@@AA@@A@@@@@A@AA@@@@@@@@@A@@@@@@@@A@@B@@@@@@@AAB@@A@@BAA@BC@A@@AA@A@@@AA@@@A@@@A@AA@@@A@A@@@@@A@@AAA@@AABA@@@@@AA@A@@AA@@@@@@AA@@@@A@@@AA@@A@@@A@@AAA@@@@@@@A@C@@AAAC@@A@AC@@A@CCBA@@@AG@@A@A@A@@A@@@BA@A@@A@@AA@@A@@@@@@AA@@BAA@@@A@@@@@@A@@@@@AA@@@A@@@@@@@AA@A@@AAAAAB@@@@BA@@AAA@@BA@AA@@@A@@@@AB@@@AA@B@@A@@AA@@@@A@@@B@@@@@B@@@@A@@@@@@@A@@@AA@@@BAB@@@@@@@@@AA@@AA@@AC@@@@@@@@@@@A@@AA@@A@@@AC@@@@@@A@@@A@@@@@A@@@@@@@A@@@@BA@@@@A@@ABA@@@@AB@@AABAA@@A@@A@@A@@AA@@@@AA@@@ASAC@K@@@@SBW@S@@@A@Q@GAGC@A@GKBMBE@EBSBA@C@CBUB@@CAE@EAC@O@E@C@I@EABC@@@E@@A@G@Bc@@A@@C@@@@@ABGD@D@D@F@@@DOF@@ED@@@BKB@@E@@@@@S@A@@@Q@AC@E@A@@AAA@AAE@C@@@@@@BE@@@@BC@@@@BA@A@ABC@CBA@@@E@@@@@@@E@A@@@C@C@A@@@A@A@@@A@A@A@@@GA@@@@AA@@AAA@ABA@@@@AA@@@@B@@A@@@ABA@@@CB@@A@@BA@@@AA@@AA@@K@@@A@@@AB@@A@@@AUD@@@AEBGBAA"],encodeOffsets:[[123971,32627]]}},{type:"Feature",id:"320621",properties:{name:"海安县",cp:[120.467343,32.533572],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBC@@@ICIAA@A@AAAB@@CF@@@B@@B@@@@B@@BB@@A@@DB@@BA@A@A@@@@BAA@@@@A@AB@B@@@BA@@B@B@B@D@@@BBB@@A@ABAAC@@AAA@BC@@@ACA@EAA@C@AACA@@A@A@@@@ACAAAA@@@C@AAC@@@AB@@AAAAA@A@C@@ACA@@@A@@@A@@@@AAC@@@A@A@@@AB@@@BAB@BCBABAD@@ADABC@@A@@@AC@@@@@A@@A@@AB@AA@CBABEAAF@@ABB@AB@@@@@@C@@@ADB@AB@@@BAB@@@@DBBBBBBDBD@@@B@BCHAFAD@@AD@B@F@BAB@BADB@@AD@AFA@AB@@@DAD@DAF@D@JBF@B@@@@ADAF@@CDCB@@@H@BAJCP@D@B@BBB@@@BABAD@@@B@@BD@@ABBD@BA@AB@@@BAB@B@BADAB@B@BA@@DADABCHA@A@A@A@@BA@@B@BBF@F@B@BFA@@@@@B@@D@FBD@@@@@CFBBAB@@C@@F@@@D@B@@@D@B@@A@@B@@@B@DBF@BBF@LBDBD@B@FDJ@@@@@D@@BD@@CBADAD@BAF@@AB@@A@@DAB@@@@BBDB@@@B@@@@AB@BA@ABAB@@AB@@AB@@@BB@@B@@@BBB@@@@@BAD@BAD@B@BAB@BAB@@BB@@@@AB@BB@@B@@@BBBA@@BABA@@D@BA@AB@BAB@@AA@CBAACCB@@ABA@CAA@C@@GA@@BA@@BCAABAAA@@A@A@@AAA@@@E@C@A@AAAA@AAAAA@@@@AAA@@@@@A@AB@@CBC@@B@@@BABABA@ABA@C@ABCBCB@@BB@B@@@B@@C@@@AB@BAB@B@@@BABDB@B@B@@B@@@BBFCB@@@@AB@@BD@@D@B@BC@@B@BAAABA@BJ@BC@BDD@@BBB@BBB@D@B@BBFB@BB@BB@DABBCB@BDA@FBD@@D@@@@DBH@BAHAFF@J@@B@DBDA@C@@B@@@@@@BF@@C@@@AB@@@HATDBBBCD@@F@B@HBBEDC@@@BAB@@@@@BB@B@@@@A@AD@@@B@@BB@@A@@@@B@B@@AB@@@@@BA@B@B@BFAACB@@@B@@@@B@@AB@BD@@BBBA@@BD@@FD@D@D@H@B@D@F@BAD@B@F@F@DBB@DDDBDBBBDBD@@BB@BBFDBBBBDBHFBBBBFFBADCBB@@ABBB@@BBABBBB@BBDBB@DC@@@AA@B@BCC@BC@@D@B@BBB@FB@@@@BC@@F@ADD@BBAB@B@@@@@B@BBAB@FBD@F@@@B@@@D@@@D@BAB@@CF@BB@@BBBBB@DBD@BB@A@@B@B@RA@BDDB@DBB@@BBDFA@B@@F@@A@@@@B@@@B@BADAB@D@@A@@B@@@DA@@AC@@C@CU@EBAB@B@@AB@@C@@BBF@@AB@B@@@@AB@@BD@@A@B@BB@@AD@@@@@D@@DB@@AB@@@BA@A@@BA@A@@DBDCB@DEA@CABCF@FAB@@@DA@AB@B@J@BIBGBGBIBO@@BA@@@A@@HGAADCB@@@BBBA@@B@B@BA@A@@A@C@AAA@@A@G@EF@@@D@@@F@@B@@D@D@FA@DD@D@B@BA@@@A@@BA@@@@@AB@DF@@@@B@B@@ADA@@BAB@@AD@BJDA@DB@@AB@@@@@F@BAB@@@BA@@@A@@BAD@@A@@@A@@DAD@@A@@@@@AB@@@D@@@@@B@@@B@@F@BAV@BABB@@@BBH@JBB@@@@@@A@ABA@@D@@C@@@AH@@B@@@@@@BAB@B@DADA@BDA@BB@@ABA@DF@@@@@B@DB@AB@B@AA@@B@B@@ADDBA@BBAB@DABA@A@@B@DDB@BBBBBB@@B@B@@AB@@@D@FBDB@@B@@AB@@AFB@@HB@@@AB@FD@CD@@@@A@@P@@DJ@@B@@BBD@@D@@@@B@@@B@BB@B@BB@@B@@FFFBDBEDB@H@@CA@@@DCBABC@@@@DCBADCB@@@@@F@@@@@@@A@@AB@@@BCAA@B@@@@A@AA@AA@@@A@@@@AB@B@@ABA@@BABA@@B@@@@@@@BA@@B@B@@@BA@@B@@A@@@C@@@AA@@A@@@A@@@A@@B@AA@@@A@@AA@AAC@@A@AA@@BA@@AAAC@AAA@A@@@A@@AA@@BC@A@@B@@DB@@@@FHBBA@AE@@IFD@F@BDB@DD@@HF@@BB@@@BB@@@B@@@@B@B@@AB@AA@@@GB@@@BB@@B@F@@@B@@ABA@BB@@DB@D@@@CBABB@@@BADB@@@@@AB@@@@AB@@A@AB@@ADA@AB@F@@ABC@@@@@@@@@@B@@A@@BEC@BAA@BG@@B@B@@A@@BCICABA@@BA@@A@@@@@@AEC@@C@A@@@BB@@@B@@A@A@AHA@A@A@AA@@C@I@AN@B@F@@B@@@BFD@BBDBBBBBD@BBB@B@B@DB@@@B@@@@@B@B@ABB@@@BA@@@B@@@B@@D@@B@@@@B@@@DBBBBB@BB@@@@@@@BBB@@@@@B@@@@@@@BBB@@@B@B@BB@@D@@@@@BBB@@BBAB@@BB@@@B@@@@@@BRBB@@@D@@CA@@AH@@A@@H@@BF@@@@@@CB@@AAG@@A@@A@C@@B@D@@@BAB@@DL@@MD@@@DB@AD@ABB@@BB@BAB@@@@B@CB@@FB@@@AN@@@FB@B@@@BB@D@@BB@@L@J@@@B@D@DABB@@B@@B@@B@@@D@@BAA@@@@@BD@BB@@B@B@@BHCB@BBD@@A@AB@BA@@B@F@@@D@@CF@@B@@B@@BL@@IAK@S@@AA@A@A@@@@B@@@B@@@@@B@@@@B@BB@@@B@@@@@@BB@@A@ABB@BB@@A@@@@@AB@ABDB@@BB@AJD@B@@DBBBBDHD@@BBBBHBLDHD@@B@FBJBB@@BB@@@A@@BGBBD@FHAB@D@FAB@DAB@D@D@FBDBFBD@@@@BBADBFD@@A@AB@@@@E@EAGDA@A@@@IIA@@@@XZ@AXj@@BZ@@@HDD@BBH@HD@@B@HBtN|RLGHK@MCCUaEgQ@AMICBQM@@A@A@YLAAAEGMCCEGEI@@@G@A@AFC@@BO@CAA@A@CAAAAA@@@@DA@@@@BACAB@AC@@@A@@B@@@@@@@DC@A@@@A@AACBCAAA@@EA@@ECCH@B@B@@@B@@BA@@@B@B@BAB@@EAA@AAA@@@@@@ABA@@@ACAAAA@AAAA@@CC@@ACCE@@SJEI@@@ABAAC@CACAAWJA@@C@E@ABA@A@@@C@ID@@A@@@A@@AAA@DCHDAA@@@C@@@@B@@@B@@ACAAADC@@@GA@AA@@@C@A@@BCIAC@A@G@C@A@C@C@A@GAEAG@G@C@IBA@EAA@G@C@IAC@A@EA@@C@GBA@A@GAEA@@A@EBG@E@@@@A@A@AEIAACGEEA@AC@@A@@A@@@AA@@AAA@AAA@@A@@EA@@EB@DC@@B@@@@A@AH@@@@@@B@@D@@@A@@AB@@@D@@AA@@@D@BAAA@@AEB@BB@@@@B@AEAA@@@GE@@AB@@AA@@@@@@@A@@@@BAA@BB@@BA@@@B@@BI@AFC@@JI@@BE@@@A@AAA@@@C@@@C@CD@@B@CBB@@BCBDB@@@@@@A@AD@@ABFB@@@@AD@@CDAB@@@@@@@@AAA@GEAAABB@@@@@AB@@ABBBABAAA@@@@D@@GA@@AA@A@@@@D@@@@AMC@@EAAB@@@@@A@@BE@@BCBAA@@@A@@BG@@A@@@A@@BA@@@@A@@C@@@@C@@@A@@@EBC@@@A@@@EB@B@@KC@@A@@@EA@AB@@AA@@@AA@@A@A@BAC@@@C@@@A@@A@@A@@@ABIRB@@BA@@@@@ABA@AA@BA@C@A@@@A@@AA@@BA@@A@@@BAB@@AB@@AA@@C@@BA@@@CA@BE@@@A@@F@DAD@BB@@DA@ACCAC@ABA@CHC@@@@A@@@A@@@CA@@AA@BCE@@C@@A@AB@BA@A@E@@@C@ABA@BEC@A@@@EA@BA@BAA@@@A@@@B@@AE@AD@@@@@@@@@B@@B@@ABB@BA@AB@@@@A@@B@DE@OA[C@@A@@AI@BABIBKDKA@@@EC@@@@@A@@A@@@A@@BAA@DB@AB@@A@BB@BAA@BCACBA@BAA@E@@AA@@BC@@@A@AB@@ABA@@BA@@BAA@BA@GA@@@@AAA@C@@@@ABA@A@@A@A@C@CB@@ABB@@ABB@@@@@@BB@@@@@@@BB@ABAACAA@AA@B@@EAKC@@@BKCC@CEACCB@@@C@@A@@EA@@@A@@@@A@ABCA@@AA@@@BA@C@@B@DGBE@@[M@BA@ECDC@@B@@@@@B@@AKEDEC@BAA@@AAAAB@A@A@@@BAA@@A@@@A@@A@@B@@@@AAB@@@@@A@@A@@@BAEC@BABB@A@@BBBA@AACFOGIP@@@ACC@@A@@@@A@@B@AA@@C@BA@@DGDB@E@A@@B@@A@A@AA@@AC@@CAB@@@CAAB@@A@@B@@CGACAOGC@DIGA@@A@@ABA@@@@A@@@QA@@@@@@@ABA@@BA@@BC@@@@B@@A@@@@A@LQBA@A@A@@@AAAA@QGC@@A@@B@@A@BBABBA@B@@ABB@ABA@@A@@@@BAAB@A@B@@A@@@@ABAABAA@@@CD@@@AA@BAAA@@A@@BM@@AA@BAA@@@ICAB@@@B@@AB"],encodeOffsets:[[123265,33138]]}},{type:"Feature",id:"320623",properties:{name:"如东县",cp:[121.185201,32.331765],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@SHKHKJIHCBABA@A@E@EBCAA@A@A@A@C@A@@BA@ABAB@@A@C@A@AA@@AA@@BABCDCBA@@@@@A@@AAA@@@CBEBA@A@A@@@CAA@A@@@A@A@@B@@AAE@AAA@AAA@@BC@AAAAI@A@A@EAE@E@E@C@A@IAO@[@sAG@WAC@GAKAKAA@QBMB@@AAAAA@@@CA@@ABABA@A@ABAB@B@B@BCB@BA@C@@@CBA@ABCBA@A@C@C@CACAE@C@@B@@A@@@A@@@AD@B@@@@A@C@A@@A@@@A@@A@@@@A@@QDMB@@OBKBE@A@UDiHA@IBWDQ@I@GA@DG@@@C@EB@@@B@@@B@@@@@B@@A@@B@@@A@@@DAB@@A@@BA@A@@B@@@@A@@@A@A@@@@B@@AA@@@@@AA@@@AAA@@@@BA@@BA@@B@@@@A@A@@@@@A@@@@@A@@@C@C@@A@BA@AB@B@B@@A@@BA@@@@@@B@@@@@@A@@@@B@@@@@@@B@B@@A@@@@@@AA@AB@@@@@B@@@@@@B@BBB@@B@@@@AB@@AA@A@@@BA@AA@@@@A@@@@A@A@@A@@@@@AB@@@AA@@@A@@@@@AB@@A@@A@@B@@A@@@A@@@@C@@@@BAB@A@BA@CAAB@B@@A@A@@@BB@@AB@A@@AB@@AB@A@@A@BB@B@@@@B@@@AB@@@B@@@@@@A@@@@@@BA@@@@@B@BBABB@CBADA@@@@@@A@@@@@@@@A@@B@BA@@A@@@@@@A@@@@@@@@@A@@AA@@@@B@@AA@@@@A@@@@A@AA@A@@@@BA@@BA@@@A@B@@@A@@BEBE@E@@@CXAAAA@@ABA@@AA@A@A@@A@@A@@BA@@@@@@AA@@@A@A@@@@@@AA@@@A@@@A@@AA@AB@@A@AAA@A@@@@@A@C@A@@@@A@@@@A@@B@@@@AA@@@@@A@@C@@@E@A@@@AAC@CAC@A@C@AAAC@@AAAC@@CAABA@@AAA@@A@@CA@AB@@A@@ACACD@@ABABA@A@ABA@@@@BA@@@A@AAA@@@C@A@@DA@@BE@A@A@C@@@C@A@CBC@@@A@C@A@A@@@C@C@C@E@A@EA@AB@@A@@@@@@@@A@@EA@@A@@B@@@D@@A@A@AA@@@@AA@@@A@A@C@@@@@@BAFBFCA@@@@CA@B@@@BC@@@@@A@@A@@@@@B@@@@@AA@@BA@@@A@@AA@@DA@BAA@@@A@@@C@@AA@@BA@@B@@A@@BB@@@A@@@AD@@A@C@@@@EGA@@@@A@@A@@BC@@C@@@@A@@AA@@@@C@@@AD@BA@@@@@B@@BC@@B@@@@C@@BA@@@@@AAB@@@A@A@ABA@@AB@@@BA@BB@@AAA@@CB@@@@@BA@@@@B@@@BA@@B@@@@ABA@@@B@@@A@AB@@A@@@@B@@A@@AABCBCB@B@@A@A@@@@BE@@@@@@@@@@A@A@@@@A@CBAB@@@B@@EBC@A@BAG@@AA@@@BCAA@@B@BA@A@@@@BA@A@@CC@@ED@@@@CDIB@@@@@@@BB@@@@B@@ADABA@@HFB@@@B@BD@DBAB@BAF@@A@ABABABABBD@BADAD@@@DF@B@ADB@@B@@@AD@@@@@@B@@@@@BA@@B@@BF@@AFD@@@AB@@@@@B@@@@D@BBB@AD@@ABB@ABA@ABB@AB@@A@EDCAGRA@QA@ABB@A@@BACAAB@@AB@@@BQA@@@@CD@@@B@@@@CDADAB@@@@A@A@ABB@ABA@ABC@GC@BABAFC@@BB@@B@@@BA@@@BBAB@FAB@@BBAB@@@@@DA@A@@B@@@@@@@FB@@@@@AB@@@@@@@BC@@B@D@BGB@@@B@HC@eF@@@B@@AD@@@B@@@@BBABB@@@ADA@AHB@@B@@@B@@@BA@AFA@ABB@AB@AABB@ADB@AB@@A@AB@B@@@@CBBDABBDABAB@B@@ABB@@@@@@@B@B@@HB@B@@BB@AFBB@@@B@@A@@BA@@@@@AD@@FBELAD@B@@@@C@@@A@@@@@@@BBBB@BA@AB@@@BB@@DD@@@@BA@@@ABBB@@@B@@ABB@@B@@B@@@DD@BD@ADB@@@B@F@@@@B@@@@@@ABA@@@ADBBBBABB@@D@@@@C@EA@@MCCA@@@@@AA@BA@@@AA@E@IAA@A@CHAA@BAAABB@@BA@ABAB@@@@CF@AABBBAB@@@B@BADB@AD@@@B@@A@@B@@@@B@@@AD@@@B@@@@A@@@@BB@AD@@@B@@@BC@AHD@@B@@@@@B@D@H@@BBBFA@@@@@AAA@BF@@BBABC@@@B@@BC@@@A@@BB@@@C@@@@A@@@@G@@B@B@@A@@@CDA@@FB@@FB@@@BB@BBB@BB@@B@@@BB@@@BDB@FFDHBBFJ@B@B@B@@F@H@FAB@@@FBHBB@B@HAD@@@FBB@D@JBD@H@B@FBB@JAD@H@H@FBHBB@D@D@B@D@H@B@D@JBAD@@@B@D@@BBB@@H@@CDBBDB@BA@@@A@@@@@@D@@BBGCCDB@BB@@@B@@@BC@@J@D@@@BAB@B@F@DB@XIBBBD@DBDAB@B@@FJTI@@DFBD@@DD@@BBBBB@BBDB@B@@AB@B@@@@B@BBB@FB@@BA@A@A@A@@AB@@@A@@@A@ADGFD@@FB@@BBDBDABBB@@@B@D@@C@@@@@@@AB@@@D@@BBABD@A@@B@@C@@B@BBBB@D@BBB@DAP@@ED@B@B@H@@FJFHDDHNBFBBZKB@B@@@RNDANJ@BhRbFVamxI^Ab@e^KVIiwa][XENA\\AbC\\A`AJA\\GrS^OZSPW`ÅHDmHaNQDSDWPYD[CcO]AOaFGB"],["@@B@rBB@LEB@@M@ACAu@E@CBABAD@D@@@BB@B@@B@D@B"]],encodeOffsets:[[[124355,32963]],[[124318,33312]]]}},{type:"Feature",id:"320681",properties:{name:"启东市",cp:[121.655432,31.793278],childNum:1},geometry:{type:"Polygon",coordinates:["@@aHwLcHiJyLQD[FE@UFMDCBgLWHqPCBKDSCGDA@A@@@WJGDQDC@AGMFAB[H[L[VC@GFIFAFSDABCBCBEBMBABA@]TQ@ABAAEBODWBA@QDKDGFKDMFDF@BDFNZ@BDHJZFPFP@@B@@BB@@@@B@@B@@BA@@@B@HRBB@BB@BHCF@FAHAB@BBDAJF@@@BFDH@DBD@@BD@A@@BF@@@BDF@@BH@@BADDFFDL@@@@BDA@BD@@B@HDHBDBDB@@B@NFBB@@BD@@@BB@@B@@@@A@A@AA@@A@A@@@@@A@CB@@A@BD@@@@@D@BB@@B@BBD@DDJ@@@@BBDNFT@BA@AB@@AB@@B@@@@BB@BB@B@@A@@@@B@B@@@@@B@@@B@B@B@BBB@@@B@B@@AB@D@@@BA@@@AD@@@B@@@D@@@B@@@@B@B@B@@BB@B@@@@BB@@@BB@@@@B@B@@BB@@@B@@B@@AB@BB@@B@@BB@@BB@@B@BB@BB@@BB@BB@@@@@D@`@NBT@T@BB@@F@@@@@B@D@H@LAF@BBFDFJR@@@@@@@BBBBF@@@B@@@BAB@B@B@BAB@B@DA@@B@J@D@@@B@@@B@@@BB@@B@B@@B@@@@B@@@@BD@@@@@BBHBJFJ@BJRJT^G|KZGXG^IZGSZGbKrO|SFEBIHUJMTQ`_\\WBCP[LWHuXyFMFGJQÓFIhNMFUE_FJ¯wLRWFQDsL"],encodeOffsets:[[124787,32402]]}},{type:"Feature",id:"320684",properties:{name:"海门市",cp:[121.18181,31.869483],childNum:1},geometry:{type:"Polygon",coordinates:["@@BD@BDDDBFD@JAJJAV@@KL@@@@BB@BADBNDHFDG@@@@@B@FA@@@@P@B@@@@@L@Z@B@@@BA@@B@@@B@@@LB@@BB@@B@@AB@@@BA@@D@@AB@B@@@BA@@@@@BB@@@@AB@BBB@BB@@B@@B@@H@@@B@B@B@@@B@B@@@B@D@D@@@B@F@@@@@@@F@@AB@DAD@B@BAB@@@@AD@@@@AF@@@@@@@DBF@BBB@BB@F@D@@B@R@@@B@T@@@@@FA@AL@@C@@FE@CP@@E@C@C@C@AH@B@@@@@DB@@@AdH@B@@@@F@@ADFBJ@D@F@P@D@FBF@DB@@VADAD@B@TAFAF@NALA@H@BHDHBR@B@@@T@X@TA@@@@@LBDBT@@B@@B@@B@@BB@@@@BB@@@BBBA@BA@@B@@B@BA@@@B@@B@@A@@B@@@@@@@B@@@@@B@@@B@@@@@@@BD@@B@@@BB@@@B@@@@@@@@@@BD@@BB@@BB@@@@@@@@A@AB@@B@@B@@@B@@@@@@@B@@A@@@@@A@@@B@@@@@BB@@@BA@B@@B@@BA@@@@@B@@BBB@@AB@BB@@AB@@@@BABBBB@@@BBB@@@@@@B@@@B@@B@@@@@B@@@@B@@@B@AB@@BB@@@@@@@BB@@BB@@@@BAB@@B@@@@B@B@BH@@B@@ABDDB@@@BDB@@@BDBB@@@D@B@@@@@@BB@BB@@@B@@@BB@@B@@@@@BB@@@@@@BB@@@BBB@@@@B@BA@BB@BB@@@B@@@@@B@B@@BBB@@@B@@@B@@B@@@BBB@@@BADB@AB@@@BA@BB@@@@@@A@@@@B@@@@@@B@@@@@@@@@B@@B@B@@@@@BB@@B@@AB@@@BBBBJ@@@BAB@@BBABBF@@ABB@@@DDBB@@@@AB@@@@@BDDB@B@DDB@@GN@^B@@B@@BRBV@jDP@BYH@F@BOHB@EDBH@F@DAF@H@RBN@FADBH@FBB@D@@H@@B@P@D@@@FAF@BAF@F@B@@@DA@@@@@C@A@@VB@@@@@J@B@F@D@@@B@@@B@@AP@@B@@B@@BFBDBD@BBB@B@BB@BBBD@B@@@B@@@B@@@BCDA@@@A@@@@BB@@BHBBBB@BDBB@BtPHSZA@b`DAa_fY@AH@ISIQ@AEIAIAG@A@@@@AC@@@@@A@@A@@@@A@AA@@A@@@A@@@A@@@C@I@AB@@C@ABA@A@A@ABA@A@@@A@@AEAA@A@@@@@@IQCEAE@ABE@K@G@C@A@@@@@EA@@A@SAS@M@_@C@@@@AAA@@AA@@AAAA@@@AA@@AA@@@AA@@ABA@@@AA@@@A@@AA@A@@@@@AA@@A@@A@@A@A@@AA@A@A@@@@@@A@@@C@@@A@@BC@@B@@A@@@CBA@@@A@A@@AA@A@A@A@A@@@A@@@@@A@A@@B@@@@AAAA@@A@@A@@@BA@@BAB@@AESCMAA@@@@CI@CAC@A@AA@@A@C@@@@ACB@@@DAB@@@@@B@B@@@BBB@B@@@@@@AA@@A@@AC@@AAMEA@@@CACAGAGCA@@@ACB@AC@@@@CKEECCAB@@AG@@CE@A@@AE@@@BAC@@AC@CCGAE@@E@BIAC@ABABG@EDEAGA@@AAAGQA@@@B@@AA@@@@A@@A@@AA@@@EOEOIYCG@AMYCE@ACEC@IJGHADCFOXABDBDBD@CH@@ADCFABID@BILCB@BA@@BAB@@CDGJKNQJABA@IH]PMHOH]PEDMFKBO@UCC@OCaIYKQGCAA@_KqWc[CASSieWD@@_VQLUJEBSBiAK@DLJv"],encodeOffsets:[[123945,32580]]}},{type:"Feature",id:"320682",properties:{name:"如皋市",cp:[120.573803,32.371562],childNum:1},geometry:{type:"Polygon",coordinates:["@@GAA@A@ECA@EE@@IEECCAF]@ABCBAHAXCLAD@@AB@@CACEEKSCECQY@O@UAEBG@C@eFaJiNLZBDB@@BBB@@@BB@@B@@@B@@B@@@A@B@ABA@CDC@@BCBCBCB@BA@@@@B@@@@A@@B@@@@B@B@@B@@@BBH@H@B@BA@]J[HABB@FJ@@CHAHIR@B@@@DAJA@@@@@@@EBCBCBOD@BB@CB@@A@K@@BC@A@@@CBA@@B@@@BGB@@A@MF@@@@A@@@A@@BAB@D@@C@@A@A@@EB@A@@A@CBCBAB@@ABQHACCB@DKFED@@@@@DA@@A@@@BAB@@@CAB@CA@@@A@@@@@EDA@@@@C@AA@MBA@@AABGBC@AA@@@@EB@AC@@@A@@@A@@@A@@B@@@A@B@ACB@@@@@@A@@ACBBB@@AAA@@@CBBBGB@@A@BBG@CBIA@@@BC@@@@AA@AAABB@A@@AEBIBKBGBA@CAAJD@@BABD@@FC@AB@B@@A@A@ADB@@@@B@A@@@BB@AB@@BB@@@B@@@B@@A@@BC@@@@B@@@@@DA@@AADA@CF@BA@@BB@FB@BA@ABB@BBAB@@BB@@AB@@@BA@AB@@AB@@@B@@@@@B@@B@A@DBADB@@DB@BDBFBDD@@@DHA@A@BHB@@@BBDABBABDF@@B@BDC@@BB@@D@D@@B@@BD@AD@@F@ABBB@@BBF@@BC@A@BD@B@BD@B@@D@@BD@BC@@B@@B@@D@B@@DBBA@BAF@BCBA@ABB@@@@B@@A@@@@@B@@@B@AD@@A@BBABA@ADAB@@EF@@BABBAB@@AA@BA@@BAAABAAAB@@A@ABAAABB@CB@B@@AB@@A@@@A@CBBB@B@@@@CFAFB@@@@@@@BB@BD@@@AD@F@BAB@BADA@BD@@B@@BDB@@BB@@B@@B@@AD@AABB@@@@@@BB@@B@@@B@@@BA@@BA@@A@@@AA@@@@@BDBJ@F@@BF@F@BAD@B@BAB@BADB@@@BB@DB@@@A@@BB@B@B@@@D@@CBB@@BB@AF@AFAAABD@@BDBB@BB@@@BB@@A@@@BB@AB@@BBB@A@BBAB@@@@@@@@@@@B@@A@@@@BAAA@@B@@AB@B@@@@@@@B@@@@A@BAA@ABA@@B@@@@A@@@@@A@@BB@@BA@AB@@AB@@A@@@@@A@@B@@@B@D@B@@BB@@@@ABA@@BA@@B@@B@@@@@BB@B@B@@CB@@@@AB@@@AA@BAAA@BAAA@@@@B@D@B@@@BA@@B@@AB@@BB@B@@D@D@ADBA@@@BA@@BA@BAA@@A@@@@@@@@@@@@@@AB@@@A@@A@@B@@@@@@ABFB@@B@@B@B@D@@@BABA@BBA@@B@@@B@@F@@@B@@@BB@@@ABB@@@@BA@@@@B@@BAA@B@B@@BBA@DBCBDBDE@@BABBB@B@JBJD@@D@DABA@@@A@@BAJD@@B@ABB@@BN@@AB@@@BBABB@@B@@DC@@B@ABBBBA@@@@@BA@B@A@BB@A@@B@@@AB@BAA@BA@B@AAAB@A@BA@@@@BD@RHB@BB@B@@@B@BABKRB@@@@@@BA@@@@@AD@@AB@@AB@B@@@@@@RB@@B@@@@@AB@BB@@@HBCJD@PHDBHB@DA@@@@BA@BB@D@@BA@DD@@BB@@B@B@BA@@@@B@FCACH@@ABD@@@BBA@@@@B@@B@@@DD@B@@JOPHDEBBB@AA@AB@A@BA@AFDAB@@B@@@@B@@@@BA@B@@A@@@@BB@@@B@@@BB@A@@@B@BBABB@BB@ABD@CFLF@BA@@@@@A@@@CDFDB@@A\\N@@AFCHA@@@@DAB@@B@@BB@AD@B@B@@B@@@B@@FB@@@@D@@DABDDFD@LD@A@@LDFB@@@ABBB@DBBBBAA@@A@@@@@@AA@@@@@@AA@BA@BA@@DAD@B@B@@@@BAB@B@@D@B@BB@@@@HBB@@ABB@AB@@AB@BA@@BAB@@@D@@AB@@BF@B@ABB@DADB@ABB@AAAB@@@BAA@@CBB@AB@@@B@@@@B@@@@FD@@B@CLALAJABJ@@BB@@@\\DPBF@@C@AB@@@@@BAB@@AAA@BA@@@@A@@@@@@@@BCF@@BA@@@B@@@B@ABB@@AFB@@B@D@AFB@BAD@@@F@B@B@@ABAB@@@@DF@ADB@@BB@@D@@@B@@@B@@D@DGB@BAD@DBBDB@@CA@@ABC@C@EB@@@F@@ADB@@B@@AD@@@BB@@BA@@BA@A@@@BB@@AB@@BB@@@B@D@B@@ABBB@BA@@@@B@@AA@JQBA@@B@@@@BB@@@D@@@D@ABB@B@@@BB@@B@@BA@@BFB@@B@@@LD@@@AFA@@B@@@D@FA@@B@@@D@@@@@@DB@@@@@AB@@@B@@@BH@@AB@@@B@ABAD@@AF@@@B@@@@BAFB@@ND@B@@C@@@@@@BBB@@HB@@@C@@B@BBBAAABA@@BA@@@@A@BABBHFB@BB@@@@@@@@BADC@@BC@@@@EABA@@BCB@@@@@@@CADA@AA@DAA@@@DCD@@@D@@@B@BBB@@@F@@AJ@@ID@BEJ@@AA@@@B@@AA@@ABB@A@@B@@@@@@@B@@BA@@BF@@C@A@@@@@AC@BGD@@A@@@A@@BCA@@A@@B@@@@@@A@@BC@@A@@@@@@AB@@@@A@@BCA@BC@A@A@@BAAABA@BDE@@@@BABAB@@AA@BABB@ABBDGB@B@JBF@B@@B@@ABB@@B@@@@DBND@@FBD@@@@@@CA@BAAAAABC@@B@BA@@@@@@@A@@E@A@@@A@BCC@@ACC@@A@@@@AA@BA@@@A@@AABA@@B@@A@@C@@CA@@A@@BAB@@AAAAA@@@@@@B@@@D@@@@@@ABCFKEA@@BC@@@@B@@AB@@@@A@@AABEA@@AA@A@@GA@A@@@@@@@A@BA@@@ABABAACBAACDA@@@@@ABAB@@@BAA@BCA@BA@BBAA@BAB@BEB@@A@@@A@@@AA@BGB@BC@@A@BAAA@@@@@A@@BC@@@A@@fED@@G@A@@HA@A@C@AD@@A@@@@@@BA@@@@A@@E@@@@@@@AB@B@@C@@@@BAAA@@BA@EBAAA@@B@@A@@@AA@@AD@BEBA@AHDD@BAB@BAA@BAB@B@@@@@BABCDC@@@@@A@@DC@@@@RB@A@@BA@@BADBAB@@@BAA@BRBB@HQDBFCB@@@BAA@BAB@BAA@BA@@BCA@AAC@@@@@@A@@@@BA@@C@BE@@AE@@@AB@@A@@@@@A@@@@C@@B@@@AA@BCA@E@@C@@BCBC@AACBABABABAB@@@BE@ABACAC@@A@A@@EA@GB@BABC@@@A@@A@@A@@@@@@JADC@@@@FC@@B@AAA@ACB@@@D@AC@@@@@A@@@AB@@@@@@AA@A@@@AACB@CA@@@@D@@@@@@@DA@@@ACA@@@@@@BA@@@AB@BAG@@@A@A@@@AA@@@@A@@@AA@A@@@@A@@ABA@A@CBAAA@@@CBAB@@@BBBD@@B@@@B@@CD@BCBC@C@C@A@@BA@AB@@C@AAA@A@ABA@@@A@AAAGAGEIEGACCE@@AECE@@@@@A@@@@@@AA@A@A@@@@BA@AAC@A@@@@B@@@@A@A@ABABA@A@@@A@@@ABB@@@@@@BA@@BB@@BA@@@@@C@@A@@A@@A@@BA@@@@@@@@A@A@@@@B@AC@A@@E@CAA@@@@BAD@BA@ABA@@@A@@@ABC@@@CB@@A@@@@@A@A@@@AEA@C@@A@CA@MAQAKA@A@@@CB@@A@@D@BC@A@ABAB@AA@BE@A@A@@@@@A@@A@@@AAA@@A@@@A@A@@@@@@@@@A@@A@ABC@A@A@@BA@@@AACAA@@CC@@AA@@AAB@B@D@F@JBB@B@B@B@B@@@@@BA@@ACAA@A@@B@BACAA@@@A@A@@AC@A@@@G@A@IBG@A@A@AAAA@@A@A@@@@@@D@@C@@@@@@F@@BB@B@@@DG@@@A@@B@A@@@AAAA@I@AAE@@@@E@@@@B@@A@AA@AEA@@@E[F@B@D@@A@@@AD@@BD@@A@@AA@@@@@@ACBAB@B@@AB@@@@@@C@A@@AA@@@AA@@@@A@@@@@C@@@@@C@A@@@A@@@C@A@AAA@@A@A@@@ABA@@@A@@@@BA@@@A@@@AA@A@@AAA@@@@B@@A@@@@@CBAB@@CAC@@@CBA@A@A@@@A@@B@@AB@@@@A@@A@C@ACG@AACAA@A@@B@@@B@@A@@BA@@@A@@D@@@BDBEBE@E@@@AAAABB@@@A@@BEA@@@A@@G@@@QC@@CA@@C@A@@@@@C@@AA@@@@@A@@@@@A@@@A@AABAAA@@@@@A@@@@@@@@@@@AABAA@CAA@@@@AA@@AA@@@@AAC@BG@@@A@AA@@ABS@C@A"],encodeOffsets:[[123546,32832]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
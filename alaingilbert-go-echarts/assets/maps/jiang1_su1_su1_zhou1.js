@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("苏州",{type:"FeatureCollection",features:[{type:"Feature",id:"320505",properties:{name:"虎丘区",cp:[120.434238,31.329601],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AJGROLMB@@C@@@@BEBE@A@@@C@@@CDABA@ADGBCDIDEHSBCBABA@@@ADC@ABE@AB@@A@@@ABE@EBC@C@IBELA@EE@A@BK@I@GI@M@BEBEAA@CAEAAA@CB@HAH@DCDCBC@CB@B@B@DBH@DA@@B@BABABABA@CB@@@B@B@DAFF@@D@@FB@@@@@BE@@DCA@BAL@FCA@@K@@BE@@CA@@@C@AA@@@CC@@BG@@DC@@BA@ABC@@@A@@@A@@@C@BFADA@ARGBAH@@C@@D@@C@@@C@@@KA@@C@@AE@@AA@G@ABBDBBADCFC@BGCCDAEC@A@@C@@B@BA@@CA@@D@@ABABBB@@@D@@ABC@CAGBBBDABDEBEA@CC@HNADELA@E@ABCAA@ABDDC@@AC@@BQBBDMDAC@AABEEAECBCCCBAAFC@AFK@@IG@@CDCDA@A@@AKAGDDHKHC@@@BLA@@DCBA@BDABABACCDDB@BE@@BG@M@@CE@@@AFC@@AC@C@BBM@A@CACDGAECG@FGYAQAFKOGA@KEaQgSsUyKyDHgFMDaRLNĘÈ|f°z\\NPHLMVYTEBBR@HBDAACD@H@@CH@D@@CB@@GBB@@HBHFH@@AHC@@PC@DB@DBD@@FC@BDJCBB@ADA@BB@HDJGBHAJFBBADBBDH@DBEFEFNJFEFEDADC@@BDDDNAB@B@FCJIFEB@@BB@B@@AF@BDB@DDB@FBD@DBFFHFHFHAHBBJ@@@@JCDGTEAK@@ICCEA@MABIBCDGBCBEBCBEIAACA@AAA@C@@BA@@AA@BAECAC@C@C@A@C@AB@CAAAAA@@MECANKDCNM"],encodeOffsets:[[123437,32094]]}},{type:"Feature",id:"320508",properties:{name:"姑苏区",cp:[120.617369,31.33565],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@@CF@@A@A@@BCG@@CC@BCC@@EBABAA@BA@AC@B@@A@CE@A@CAAA@C@C@AE@EB@@A@KBAF@J@DAD@FAF@B@@@BA@@BAF@BCD@B@@ABABADGTCFCJADCH@BABCB@D@@@D@@@BAFAF@@@@@DA@KNQPIH@BMNCDMLDBNF@@BBBBDBA@@B@D@B@D@DBDFDABB@@BB@@AD@B@BBB@BDJBDGF@JEBBB@JAB@BA@AA@@@A@@A@@@@A@A@@@@ABA@@@AB@B@B@@ABABCDIHBHA@DJ@@A@AB@@@BAD@H@D@F@H@@ABB@AD@BI@A@ED@@BD@B@L@BIB@BBAFD@@AB@HBD@@ABCBABG@C@@@CRD@ABABBH@CN@BPNRNNJf`JJ@PL@BC@KLLXIHBLJDLNPXR\\JTTVDRDJNA@@ACG[COAM@EEU@AF]EC@GPD@AD@BCFBA@PBBCEADCFBBEDBH[DMDK@IDEDIFQHM@@EEICGOCKEKCGK@GJA@EDGDQDWBKSAAEEFCHM@EC@[@K@OCESCSAAEGGIECIBEDE@GBGEE@C@GBIBGHEFGHAHBBuvST@@EBC@@CAC@A@A@CAE@@CACE@AB@@A@CDA@A@@AA@C@A@EAE@@I@A@ADAHCJAHGAABABAB@FA@@CCB@@E@@@BIBEIA@DAAABC@@@@BA@@BC@G@@@BB@DEA@B@@@BC@C@A@AA@@C@C@A@IAG@"],encodeOffsets:[[123508,32027]]}},{type:"Feature",id:"320507",properties:{name:"相城区",cp:[120.642626,31.369089],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BAAIDACD@@EC@CAA@@COD@@GD@BG@GEGA@@AA@HA@@DC@G@@DG@C@BDCBGAQ@AASFUZKNlZNH@B@@@B@@A@ABAB@@@D@@BB@@A@@@BB@@AB@@@BA@@@@@@B@@ADAD@ILB@B@H@NATCL@\\BB@JDB@@B@@@@BB@A@@@@H@@AA@@@@A@@@AB@@B@@B@@BHAB@@BB@@@@AB@@@B@AB@@B@BA@@@@BBAB@@@B@@@BB@@@FHBBBBB@@ADB@@@BBA@AB@BBBA@@D@B@@@BB@AB@@@@@B@@B@@BABB@@B@@DAB@@B@@B@@ABBB@@@@AB@@@@@@BBD@FDBBB@D@B@DAB@BAD@BBD@B@@@@BB@FBD@BBBBDDFDBBB@@A@@D@B@@BABAF@@A@@B@B@@@@@@BDABA@AB@@C@@@AB@@@@@B@@A@A@@@A@@B@@@BB@B@@@@BB@@@BBBD@B@@C@@B@B@AA@@B@@@B@@@@A@@@@BD@B@@@@@BB@@@BB@@@BDBDB@@BBDBB@BDDB@TFBBB@B@DBJHFDFDBBBBB@DDJF@A@A@C@@@C@A@ABA@ABE@@@CB@B@D@@@@FB@DA@@BDBBB@@@BDAB@@BDCBBD@@@@AB@@AB@@AB@B@@DADH@@ED@@AB@@@DBD@BBB@BBB@@JAH@@HH@DBDA@EF@FA@@AGBCDAAIDAACABCEBAAEX@@@AICED@B@N@AGB@B@B@AAAABABBFA@AAAAAB@@ED@B@D@BBDEA@ACFC@@@CJABBBFJAJ@LADF@@FA@CB@BCDDAEDCBFFBBBDAFDCDDDB@DF@DB@BFD@BH@BDHD@D@J@F@B@LFCHJBLB@J@@CB@DD@F@BAD@BAFCDAH@FCD@FBD@B@BDABDPB@B@JEBALG@ABA@@DA@AJABABBDFB@@@AEBABGDBJA@DFA@GH@BJHNABBH@CJC@@JBDAF@@BBDBEFYKAE@A@@@A@@AA@@@@B@@C@A@@CAAB@@@AA@AA@E@AAA@@A@@@A@@B@@AD@BA@@@A@C@AB@DB@@@A@@@A@@CAB@B@B@B@@@@@@A@GAEAE@ABA@@B@@CF@@ABA@ABAHGBCBIBCL]@QCSCGMBIQCUCSS[IWQMOCKKIGAWJKK@LADK@@OIIe_MIQMOM@ADMG@AAAB@BQC@D@@@DAHABAD@BC@GAA@@BC@BEAAA@AJK@A@C@@AC@@F@BAJC@@BAA@BG@E@C@G@C@AB@@A@@B@BI@@CGBGACJADAB@BA@A@A@@B@@AB@B@@B@B@@@@@@BB@@@B@@BABA@IBA@AAIFE@CHAFADAFADCHADAJNBB@DFJD@@BLSFCHID@@@@AIGAGBGEGEEECAC@EAA@CCA@ACE@@BA@A@@AA@EFIJEDA@A@MBCCAC@@CDCBEFEFMIFEFECAG@ACCAABEABIAGIHGCA@@ACB"],encodeOffsets:[[123343,32149]]}},{type:"Feature",id:"320506",properties:{name:"吴中区",cp:[120.632308,31.263183],childNum:1},geometry:{type:"Polygon",coordinates:["@@gamWACMOGGSWW[CEIIUWMMCC[QYOgYwaBCA@A@EAA@A@AAA@A@@@CAAAGAC@A@CAAAA@EA@@A@C@ICI@QEMAAAMAG@GAGAA@C@E@E@AAA@C@A@C@A@CAE@@@G@I@OCC@C@A@A@A@A@A@A@@@@@A@G@C@@@I@AAABC@A@C@G@C@CBA@C@@@ABKBA@A@A@C@ABC@AAA@EAA@@@CB@BA@EBGBUDC@C@MBKBE@QBED@@@B@@AB@@EBA@@B@@A@C@A@EAA@CB@B@@CBABA@GFIFIFAB@@CBABAB@@CDABABEDQRMNKHQJMLABGD@B@@ABABCDABGDCBIDYHUHID@@@@A@@BIDKFGFABCDAJ@HAFABCFCFAFAH@DAB@BEFEFGFIFA@ABCFIL@BEHADEFCDEFADIPA@@@AB@@A@@AA@@@@@@@@B@@@@DB@@B@@B@@@B@B@B@BI\\CF@D@F@B@HBBAD@DAB@BABABQLABEFEJAB@B@B@DAD@BB@DBFFHHRRRRFFJJNJ@BL@NBDBhDnB^DdBZDJDJDPHTLFHHHJL@@bQNChEGzCzLtVhTbRLFB@PHELRBZBEHH@FDHBDCDBB@N@AAD@D@@BD@BE@@F@@DN@H@@AF@@ACADCBDBABAACB@DA@CB@AK@@D@LGCGHCLB@BB@B@DCDC@@JH@@EL@BEDBBDADDDABFFFBA@BBDNCACRA@AD@@BD@CCBAB@DBBAF@B@FKBCGMD@@DFBFAACCBAAHADBD@BA@@@C@@AABABA@@@CB@@DB@@A@AD@@@@BFDCBDDAHD@DEBCAAACBAH@B@@BF@@BD@@@LB@@D@@@D@@@@CD@@@BGHABQB@BCAED@@@B@@@B@@@D@BAB@@AD@@CH@@AD@@D@@BBD@@@B@@DF@@AL@@@DB@EBK@ADB@CF@@A@@@@EA@@@CE@BE@C@A@A@@DAB@BABABA@A@AB@@CAG@C@A@ADAD@DADC@CBG@GDAB@BBBF@DBBAFAFN@J@@H@JALB@F@@FB@@@FAF@@B@D@DBBDBB@F@@D@BA@D@@BABB@ABAB@FD@ADD@@DH@AD@@@B@BE@@DD@H@JBB@D@D@@@BBB@D@D@@A@@@AFB@CAA@@H@D@@AB@@A@@D@BABB@CJBAFAJ@@F@@@DA@DB@@EBABABAHBBGDIBGBCB@J@@@BF@F@B@DBB@@@BCB@D@BA@@BDFDB@@BF@D@B@BBD@DD@FA@@TSvuAABGHGFEHGJAHAD@F@HFHAF@FCJAFDHJFHBBDTFTPDL@\\@D@@FGNEDFFBBLTXARCHCFCB@HIL@DHFLDLHPJDFF@ABABCBA@@BCBCBC@MB@BAFAB@B@BA@CBA@@@A@CD@@AF@@A@A@AC@@@C@@CHB@A@@CC@@C@BE@@ACF@@@B@@A@@C@@@AA@A@@@@@C@A@ABABA@AAAB@@A@@BC@ABABIDB@@BCD@B@@@@@@A@AAAC@AAAABE@A@CB@BBD@@@BA@ABAD@@@BA@A@@@@DI@@@@@ABCD@@EEABA@CBEE@AEC@G@GBE@@CE@AGC@CBEBGDQAGAGCGEOEKGA@KG]EGMISGHCBIDEBSLCDKD@NDDDFBBBD@D@@@BOJCDKFC@EAMSGGCH@BAB@D@B@ACEEEAAAAEEEEMEE@C@EBEBCBO@OBE@CCEAECE@C@GAAAG@A@C@C@C@CB@B@@@HCN@BBFAJ@DEBGAO@ABKDEDEDG@@EBEDIEG@@EEEEBe@ECGCCIGGGGIEIQDAAEIG@Sc[sSUAA@ASOCA"],encodeOffsets:[[123438,31838]]}},{type:"Feature",id:"320509",properties:{name:"吴江区",cp:[120.645157,31.138677],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAAAACCAACA@A@@@A@@@ABCBA@C@ABA@CBA@ABA@AB@@@@@@@BAD@BADEFELCF@BADAB@BAJ@B@F@D@BB@@B@BAFAB@@@@ADE@C@EBCBCHADE@CBADAD@F@BBBBB@@HB@BBDAR@FABCB@@CD@DAHA@ABG@CBEBCDAB@D@@@BBDDB@BHFFB@@DBBDAD@@CDC@CBCFCFAFA@AB@BEBC@ADBB@F@FBDDBB@DBBB@B@DED@BADAHBB@B@BADADADCDQHABA@ABAACCAABG@ABCBEDODKIAAAA@EC@@A@ACC@KAAAQCC@E@C@A@E@G@A@ACAGI@@A@CMCA@CFCBEF@B@BB@AFB@@@@@BB@FAH@F@B@BADAD@@@D@DBLBBBBDBB@@D@BCHAD@D@@@BBBHHHHDDBB@B@B@B@BAAADxbhZZP\\RDDNNVXJJDFX\\TXHHNPBDnXhbDBTP@BBBTV\\tTdH@FJBBRCFJHJHHJHDDDH@FAfFFFF@@FHCJAF@FH@FCFCLCBAP@HBFA@CBIAE@ADM@G@@@ADAD@D@D@B@H@BBHBD@F@FDFBDDF@PAP@DAFAFAD@F@NFFFFFBBBBFFDF@B@A@CBA@ADGHHNTFBD@LEDCPI@A@@@CACAACECC@MLCDCTKFAJCDAHGDAFEHABABBFC@@@B@@B@@A@@B@@@@@B@@@@@BAB@@@@A@AAA@@AA@@@AB@@A@A@EBG@A@EA@A@I@AA@A@M@EBI@A@MLANETIHCDABCB@CA@@BAB@@@@@@A@@@@@@@@@@B@B@B@B@@@BBHEDAAC@A@ADCBADBBAB@BCBAH@@DD@B@BB@@F@@CF@AC@@@AH@JAF@B@@D@BB@BA@@ACBABCBABA@CBAB@BBDABA@@@A@ABADC@A@AAAC@ABA@AABA@CAGAC@CCGAG@I@GBABA@EBCDGDA@A@G@KAC@G@C@A@CA@@@I@@KEAAMCAQG@A@@C@C@AAA@@AA@@@@@C@A@C@@@A@@A@@@A@@@@@@@C@@@A@@A@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@A@@@@A@@@@@@@@@@@B@@A@@@@@@@A@@@AA@@@@@@@@@@@@@@A@@@@@@B@@@@@@AB@@@@@@@@@@@@@@AB@@@@@@@@A@@@@@@@@@@@@BA@@@@@@@@@@@@AA@@@@@@@@@AA@@@@@@AA@@@@A@@@@@@@@@AA@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@AB@@@@@@@B@@@@@@@@@@@@@@A@@@@@@A@@A@@@@@@@A@@B@@@@A@@BADADSJOFCBMAM@G@MACASCGACAACCCAAA@CB@BA@@@A@@A@CACAA@A@C@A@CBEBA@C@A@AEAG@EACACAAACECCAAAACCA@AAE@C@E@CBADCDABCBCDC@E@C@E@CAC@EBABA@AA@AAA@AAAAAA@CBABCD@@CB@ACCAAA@@CCCAAAACAEEAC@@HCJCHA@@B@BE@@DCDCBCDADGDGBC@CBE@CBE@A@A@C@C@AAC@CACAA@CBE@GBG@ABE@EBC@AIAC@G@AAAA@CBCBA@ABABEACCC@@CAA@ABABABAACB@B@DBBEDGBAB@B@B@BAB@BA@AAAAAC@AAAEAA@A@CA@AEIEGCC@@AAAC@@AB@@AD@@@B@@A@@@@@@@@@@@AB@@@@@@@@A@@@@@@A@@@A@@AA@@@@@A@@@@@A@@@@@A@C@A@@@@@AA@A@@@A@@@@A@@@C@A@A@@@A@ABA@@@A@@A@@@@@A@@@A@A@@B@@C@CDEB@BA@BF@@A@@@@@C@A@EAA@A@A@ABC@@@C@ABA@A@A@@@A@AACAAAAAA@AA@@AAA@AAC@E@CAA@@@ABA@A@A@@@A@A@CBA@C@CBABA@C@ABAB@@@@A@AAAAECAAACACA@GEAA@@E@CEEAECCAEE@C@AAAAAEEAAAC@@CECAACACCEACCEA@AECCACCCAC@@A@CGAAACEEAA@ACCCEACCE@@AACCGI@@"],encodeOffsets:[[123404,31504]]}},{type:"Feature",id:"320581",properties:{name:"常熟市",cp:[120.752481,31.654375],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@C@CG@AAGC@AEA@@CCEA@CCDCECCBAAEAAECDBFCCADA@@DEB@@CEKBI@IBAEAAIB@D@@EDBDB@CFAAC@A@C@@FA@BBBB@BEBAAABBBBBA@A@A@BHM@A@C@DFBJ@@W@BFABDFBABDCBBJCBADBH@@EBE@@FCBCAG@@GG@IBIB@JAH@F@@@F@BAFAFAJ@DCBCDGFABAFG@C@G@I@A@@@A@@AA@BEBA@@AA@@@ABACAOC@@@@A@@@@@ADIAAAC@A@GD@@BH@BB@@D@@ADA@@B@B@B@BA@BBAB@F@@A@@@@D@@A@@@BJ@D@BA@@BBB@@BA@@@@@BB@@A@BFA@@@@@@D@D@BA@@BBDEBAHB@@D@DB@@@@BABB@@B@ADA@@BA@A@@B@@@@DB@@@@@B@@@@BBD@BCBB@@B@@@A@B@@B@@B@@@@@@BBB@@A@@@@@@B@@@BBBA@@@BB@@B@DABA@@D@D@B@B@B@DAD@DA@@B@D@@@FDB@BB@@BB@@@B@@CB@@@B@@@@@@B@@BAB@@@@@B@@@@CBEFCB@@C@@B@@@@BB@@BDBAB@@@@@@@@@BA@B@@@@B@@@@@@B@@@@BDGDCF@@BB@@BABB@B@@A@@@@@BB@B@BBAB@@@BB@@@@AB@@D@@@@@@BB@@@@BGFAH@@@@A@AB@@AA@@E@ACEB@BA@@AIH@AA@AB@AA@@@CDAAAB@@@@@@@@AA@B@@@@A@A@A@@BBBA@AA@@@@A@@AA@@AB@@AC@@@A@@A@BAA@BA@ABA@ADB@@B@@ABB@@@@BBA@@@@@@@BBA@BBA@BBA@BBA@BBABD@@B@B@@@FA@@@@@B@D@B@BAB@BBB@@A@@B@@@AB@@BC@@B@@@BB@AB@B@@@@B@B@BB@@C@@@@@@BA@@BABBB@@@@A@@BB@@@CBAA@@@B@BA@@@@@@B@@@@B@@@@@BBBB@B@BB@B@@B@@@B@@B@B@BB@BA@@@B@DBH@@@@@@@@@@@A@@B@@@@@@@@@@@B@@@@BABA@@B@@@@D@@@BA@@B@@@@FAH@BB@@BD@@B@@@B@@@BD@@A@@@B@@BA@@BBADD@@BA@@BBBD@@BD@@BB@@@@@@@A@B@@D@@B@@B@D@@B@@@@@B@@@@@BA@@B@@@@ABEBA@AAA@A@@@@B@@@@@BB@AD@@A@A@@A@@@BA@A@A@ACC@A@@@@A@@@@@@@@A@@B@@@@@B@B@@@@@AB@@@@@@@@@@B@DBBA@A@@@@@@B@@A@@@@B@BA@@@@BB@@@A@@@@B@B@@B@@@@@@B@@A@@@@@A@BB@@A@@BA@@@@@B@FB@@B@@@@@A@@@BB@@@@@BB@@@@@@@AB@@@B@@B@@@A@@@@@@@BB@@A@@B@@@B@@@@@@D@@@BB@@@B@@@@@@@@B@DA@@@@B@B@@@BB@B@@B@@@B@@@@BB@AAB@@AB@AADAA@BA@A@@D@B@@@B@B@D@@@@@@BD@@B@@@AB@DA@AB@B@AABA@@BA@@@AB@@D@AB@@@D@@@A@@A@AB@@AD@@ADA@@DA@BB@@ABB@@@@@AB@@BB@@BBA@@@@@@@AB@AAB@@BDA@AB@@A@@B@@@B@B@B@@@@@D@BBB@@A@@@@BA@@@@B@@BD@@@B@BB@BBBBBB@BBB@@@B@J@B@BBB@BBBBBBB@BB@BDBBBB@B@B@D@DC@@JLBHB@ADB@@@B@FBBFB@@BBB@@B@BB@DJCDAB@@B@@@@B@@B@@@BD@@@B@@@@@@BB@@@B@@@B@DDBBHBB@BAH@B@BBDD@DDBD@@BABBBAFA@@B@@@@@B@@@@@@AF@@@BAB@@@BEBA@@@BB@@BBB@B@B@@BB@@BBB@@B@DB@@B@@@D@@B@@A@@BB@@@B@@BB@@@@@BB@@B@A@@B@@@@@@@@@BA@ABBB@BA@BBBB@B@@@@@B@BA@@@@B@BA@A@AB@@AB@@B@D@@B@@@@B@@B@B@@BBA@@@@B@@@@@@@@A@A@ADA@@@A@BDBD@B@BBBEBA@FH@@EB@D@@BBB@FFBA@B@@DFB@BADD@@CBFDFC@BFD@@B@BB@@DFTKXMjUXRHBHIB@BA@@ZI\\IPGVCDBFFz@@BA@G@A@ADABCDCJGJGPGLGPEDALCFAB@DAQRCTCLCF@H@B@d@L@jBTAFAVIRK`UkYQ[MUGA@AECEE@EEE@AAECGGSAAB@D@DCDGGI@@A@EDCBAAAEEEACMMEBA@A@@A@AA@@@A@CA@@@A@@AAA@AAAACAEEBABB@@@AB@@@B@B@@AB@@@BAB@@@B@BA@@BA@@B@@BBA@@DCBBBAB@@AB@BA@@BABBBA@A@@B@B@@A@@@@@@B@@@B@BA@AD@@A@@BAB@@@@A@@B@@ADCHCDAB@D@B@BAB@@@@@D@@@GMEBA@GMAAEACCKMEEEAIEGAKIGC[M@@NS[OLMIC[S@@AE@CDA@AMEHAJBBC@@@C@@XBBSDBFOBEDEIKKIGGGI@A@@IEAAECAD@A@BA@CAAB@BA@@@A@AAA@@@ABABAB@DCBC@@@C@AA@@A@@C@@@@A@@BA@@BEA@BDB@DC@@D@@@DA@CJG@CNCABAAA@@A@AFCAAHA@ABF@AF@FC@@D@BGCGEECICGCACIHBBCLUGCAEHBBBBFFFH@BCDCBA@C@@ABA@ADCCEGDA@@@AA@BAA@@AAGDAC@@BA@A@@AAA@AA@@C@ABA@@@@A@@A@@GC@@AC@@AB@@AE@@EBAA@@ABAA@BAAA@@AAD@AAD@AAEB@CFA@AMF@A@ED@AI@AA@AFE@ABC@E@@@@CE@@FA@EA@@A@ABA@CA@BBB@@BB@@A@ABC@@@@@@BD@@AD@@B@@@@B@B@AA@@B@B@@@ALC@@CA@@HDABD@@@DEBCAA@QAI@EZAFAC@AE@CBIA@@ID@DAGBAGMAIG@@HEB@CIBCAAHABBF@@A@CEAAABIB@BCB@@AB@BKHABIFA@A@COBAACA@C@EAC@EDG@CBEDABC@ABE@C@@CDA@@@IKAIADGKEA@E@I@"],encodeOffsets:[[123610,32276]]}},{type:"Feature",id:"320582",properties:{name:"张家港市",cp:[120.555982,31.875571],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@BCA@AGIK@@CDC@A@A@A@AACA@AAAA@AAAAAAA@AAA@I@A@@@A@AAA@AAAA@AAAA@@@C@@AA@@@@@AB@@@@@BA@AAC@@@@@A@A@A@@@A@@@@BA@@BCB@AA@BBA@@B@@@@@@AB@AA@@AA@@B@@@@AA@BA@@ACB@@CB@BC@@BA@@B@BB@@@C@@@A@@B@CA@@B@@AB@@ABBBA@A@@BCBA@@B@@@AC@@A@@@@C@A@A@@@A@C@@@@BABB@CBBBA@@BA@BB@@@BA@@@@@A@BB@@D@@@@BA@DB@@@AB@@@@B@@@A@@@@@B@@@@DD@@@BAB@B@BBB@@A@@@@@A@@B@@@B@DE@@B@@B@@B@@@B@@@@A@@B@@B@@DABB@@BA@@@A@@@ABA@@AA@A@@@A@ABAB@@@A@AABA@@@A@@B@@@@@@@B@@D@@@BB@BB@@@BD@@AF@B@B@@@BA@@B@@AB@B@BAB@BAF@@A@@DE@ABCFABEDAB@@A@@@ABEAEEA@AAEC@@AB@@@BAB@A@BAA@@A@@@AABH@@@@A@BD@@@@ABCACAC@A@C@@@ACA@A@A@@AAC@@E@@@@@C@EB@@@B@BAB@BA@@@A@@B@@AB@BA@C@ABAB@BC@@@@BBB@@CD@B@B@B@@AD@@@@@@@BA@@BB@@@@BD@@B@@@@D@@B@@B@@@@B@@@@B@@@@@@A@@B@@BB@@@@@@@@@@@@B@@@@@@A@@@D@@B@B@@@@B@@@@@@BBD@@@@@BA@@@@@@@@@AB@@@@@@@BA@@B@@B@@@@@AB@@AA@@@@A@@BB@@B@@@@@B@@@@B@@@@BB@@@B@@@@@BB@BA@@@@@@@@@@@A@@@@BB@@@A@A@@B@@@@@@AB@@@BB@@BA@@@@@AA@@AB@@A@A@@@@@@AA@AB@@CAAA@B@@@@ABB@ABAA@@@B@@@A@@@AB@@@@@A@@B@@A@@@@@B@@@@BA@@@A@@@@BB@@A@@@@@@@@@B@@@@@@@@@BB@A@@B@@@@@A@@A@@@@@BB@B@@@@BB@@@@@BABA@@@AB@@@@@@A@AB@@A@@B@@A@@@@@@BA@@@@@A@@@A@@A@@@@@@@@@@A@@A@@@@BA@@ABAA@@@B@@@AA@@BAA@@@A@@@A@@@@C@@@@B@@@@@@@BA@@@@@B@@BBBAB@B@DBB@BAB@B@@ADA@@BAA@B@@AA@@@@@@@B@@@@BBABA@@@@BAB@B@@@@AD@@@BAD@@@B@@A@@B@@AB@@@B@@@@A@@B@@@@@B@@A@@B@@B@@@@@B@@@@@A@@@@BB@@@@@@@A@@@@@@@@@@@@@@B@@@@@B@@@@@AA@@@A@@@A@@DC@@@@@@A@@@BC@@BAA@@B@@AAB@@@@@B@@@@@@@@@@A@A@@BAAB@AAA@@@AB@@@@ADA@DCA@ABA@@@@AA@@A@@@@@@C@@A@@@@@BA@@@@@@BAA@@@DA@@A@@@@@B@@C@A@@@@@@@@B@@A@@BB@@@@@@@BBA@@@@@@BB@@@@@@B@@@@@@@@@B@A@B@@@@@@@@A@BB@@@@B@A@@B@@@@BB@@C@@@B@ABAA@@@@@BBB@@A@@@@BA@@B@@@B@@A@A@@AABA@@@@@A@A@@@@@G@EB@@@AAC@@@A@@@@A@@@@@@@A@@B@@@@@@@@@@AA@@AD@@AB@@AC@@C@@@@F@B@BC@IDIBCB@AA@@@A@@A@@@@@@@@@@A@A@@@B@@@@@@A@@A@@B@@@A@@@BA@BBA@@@@@@@@B@@@@A@@@@@@@AB@@A@@B@@SHEBABA@EBAB@@AA@AA@@@@@E@@@@B@@@@@BA@A@@B@@@@@@@A@@@@A@AA@@@A@@A@A@@@@@AB@B@@AB@@A@@@@@@@AB@A@B@A@@A@CB@@@D@@AB@@A@@A@@@@AAAB@@A@@BA@@@B@@BA@@B@@@DB@@@@@@@BBAB@BB@@@B@@@A@@BB@@@@@@@@@@@@@@@B@@BB@@@@BB@@@@B@@B@@@@@@@@@@BB@@@@@@@B@@@BB@@BB@@@BBB@@@B@B@BAB@@@BA@@@AB@B@BA@CBC@@F@B@@@@@A@E@@@@A@@BA@@@AB@AABB@@BAA@DAA@@A@@@AA@@C@A@A@@@@B@DABB@@@@BB@@D@@@B@@@@AB@@@@@B@@@B@@ABB@@B@@@@@B@B@B@BA@@@@B@@@@AFAF@@BDANBPAXJHHD`Vj\\THPD\\JNBlBlCN@hQhOLCjMbIfED@H@FAVBP@Z@rBZABNBd@V@T@H@vAP@LCNMRKBALQFKBGFQBKBGJgBEDSBAJW^iLQBEDKDGHKDADA@ABA@KBEDCBABCDAB@DADAyEECAUDOH[JYJ@@ABA@GJGAWQiVWNSLCE@@AAA@@@EC@AEDECDA@@CCABA@CE@@@AABEEA@AA@@@CFA@@EGB@FAAA@A@AACACB@@@B@BCB@B@@@@@@@@@@A@@B@AA@@@A@AA@@@@@@AC@A@@@BA@@BAB@B@@A@A@@B@@A@A@@@@@AAAAAB@@AAABAB@@A@@@@@@@@@AB@A@@@AA@@@@A@@AA@@@A@@AB@@@@AC@@@A@@@CAA@@@AA@AA@@AA@A@A@AA@@AA@@B@FA@A@@BA@A@@BE@@@@@@@A@@@@@AB@BEAABA@AC@CA@CCCAAA@G@ABA@GAAACCA@@@A@@@A@@A@@@@A@@@C@@A@@@AA@@@@@@AA@CBID@CAAA@@@AA@AA@AEEAA@@@"],encodeOffsets:[[123576,32505]]}},{type:"Feature",id:"320583",properties:{name:"昆山市",cp:[120.980736,31.385597],childNum:1},geometry:{type:"Polygon",coordinates:["@@GACASDEBWFMBSBYDCAEAGAICGAE@AAC@A@MDA@MASAA@AAA@AAAACCAAAAACAC@A@A@CCCEE@@AAA@AAA@@CCCA@A@@A@ACBGFAA@@A@A@A@A@@@@@@@@@@@@B@@@@A@AB@@DBA@ADCBGDSJMFKB@N@BAJ@F@N@BBBJ@B@B@@F@BAH@F@B@BA@@B@@BB@@BB@B@B@@A@AB@@@@A@@@@@A@@@@BA@@@@A@@EDAAABGBEFCBJTHN^FLHB@LHPFHFHDHBRBHCFADAD@BHF@@DF@HAH@D@BFF@AF@DABFB@FC@AD@B@@@@CJ@@@@@BAB@@C@AB@BAB@@C@AAA@@D@BAFBBBBD@BB@B@B@@@@A@C@AD@@CAAJAB@BAD@@@BA@BB@BABAB@B@B@D@@@@@BBB@@D@@@@BA@@@E@BD@@AFD@@@DD@@@BGA@DD@@@D@@B@B@BE@@BC@@D@B@@AB@DABA@A@EBABA@@NADADAD@@ABADAB@B@@GNERCJCF@JCLCNG\\CAAFEACDFBADOAB@EAADC@@BOC@HFDE^@BFV@FBNDPH\\BD@@DHDT@RK^ADAJADGHAB@BAB@BE@@DA@@@AB@BBFBF@H@B@@@@A@A@A@A@DB@@@B@@@B@@CAA@@B@D@B@@ABC@@BA@@@@B@@@BB@BBF@B@@BBB@@A@BB@DB@D@@@@A@@B@@BB@@@B@F@LBJ@RBB@DBFA@C@@ACCB@GB@@DD@BK@@A@A@@@BBA@A@@@@@@AC@@BC@@A@@@@D@BAB@@@AA@@AA@ADBB@BAB@@@FBB@@EF@@D@@F@D@BAF@BEB@@BBJC@@F@BNE@BEB@DFABBC@BBC@BB@@BBABB@AB@BB@AB@FF@@BA@@BD@@BD@@HB@@@@B@@B@BAD@@@BBB@BB@@@BAB@@BDHCBB@@BB@ABB@@B@HCDFCD@BAB@BD@B@DADC@AEGEEAAAAFGDBVHDKAAJGBDHDJDFDHFHD@A@CD@@EBEE@BAB@BGDBBEB@@@BBABDBDMH@DIB@@C@@@CD@@CCA@AFB@AB@@AB@@@@@@DB@@@BBD@@@D@DA@CBABABA@@B@BBB@@@B@@ABADBB@@A@BBCFDBBJF@@B@BADELFDGFBBCDBD@DAB@CBBBDABDD@@CAA@EHEAAFEFB@CECC@AA@ABAAA@ABACC@AAA@C@AC@@CA@A@@GD@@C@A@A@A@@HCHABAH@AAACCCEABE@GDA@@@@@CB@AEH@BCJABBB@D@D@DBJEBCBABABBFC@@DB@BBBDBDBBBB@B@B@B@@ABGD@@CH@@CB@NHFHDFDDB@B@B@DCHEBAFIDCCABA@@@@MEACACL@AIACCEAACE@E@CAA@@@C@AD@DGBAB@BA@BDB@BBB@BB@@@@@B@@@@A@@FSB@DKA@FO@@@@@A@A@ABC@@@@@A@C@@@A@AA@BA@@AA@@B@BAAA@@@CB@D@@@B@@AB@BBD@DB@ABABA@ABAA@C@@GF@B@B@BAFBB@DBB@@@@A@A@@@A@@AABA@@@A@@@A@A@@@AA@BAB@AABA@@B@@AB@AAB@@A@AA@AAB@B@@A@AA@@@A@@A@A@@@A@@AA@@A@@A@@@AA@@@A@@A@@@A@A@@BA@A@@@A@@@A@A@@BAAAB@@A@A@A@A@ABAAABAB@B@B@NBAD@@@BFDDDDEBADI@@DCC@@AA@@AB@BA@BBAB@@@BCCA@CBBBA@@@CDB@@@C@@D@@AD@@@B@BEBAA@@AC@BAAAA@C@@A@AAAAA@@EAEAA@C@CB@DC@@AA@A@A@@@ABBBCBAAC@BDEB@CGBED@B@@BBGB@BA@@@@A@AC@ABA@C@AAACCAACCCAAEC@@D@AAC@CBCBAD@B@BABAFAFADA@@BA@ABABCBCABA@@EECMAGFACE@ACCA@E@EAA@CAC@C@G@@@AA@@B@AI@GBAA@@A@@AA@@B@@CAG@A@@@CA@ABAA@@@@B@BA@@B@@@@@@@@@@@B@@BB@B@@C@ABA@AAB@C@A@ACAA@BC@AB@B@@AB@@AD@@A@AA@A@@C@CC@@A@@@A@@@A@@@@@A@@@@@A@@@ABA@@@A@A@ABA@@@A@@@A@@@@@A@ABA@@@A@@@A@ABA@E@ABA@C@@BA@A@@@CA@A@@@@CF@@ADABAA@AA@A@@@ABA@@@A@AA@CABCJBBAICBCBADCBEEA@AA@@ABAFB@ADE@CIABCFB@A@AA@BCEC@@A@@A@CA@@DAA@ACAAABC@A@AEBA@BB@BC@@BA@@BE@E@AAA@BAC@C@C@@BAFGA@CCACA@@@EBAB@B@CIACACC@@BAAA@AFCB@@ABC@CA@B@@@DC@@A@@AA@AE@AABCGGA@A@SAA@]@C@"],encodeOffsets:[[123894,31881]]}},{type:"Feature",id:"320585",properties:{name:"太仓市",cp:[121.13055,31.457735],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AD@B@@@@E@A@@B@B@@@@@BA@@B@@@B@@A@@B@@@B@@A@@@@AA@@@AB@@@BB@@BAB@@A@@A@@AB@@AA@DCA@ADEA@AABAABA@@@AAAA@AA@A@@A@@@AA@@BAB@B@B@@A@@A@C@@@A@AA@A@@BABAC@AAC@AA@@@AB@@@@@@@B@@@BA@A@@@AB@BA@@AA@AMC@BC@BAC@@@AAB@@A@@A@GCCA@BEA@AC@@BA@@CAAAFEACCE@CABED@@AD@DA@AA@A@@AA@E@A@AA@@@A@AC@C@@@@DA@@@@@A@@@EPB@CLA@ET@@@B@@A@@@@@A@@AAA@ACA@AABA@ABCHC@@B@D@@BB@D@FDFBBDFBDBJK@BDBDNF@@@@ABDBCDEJABGFCDA@A@A@CCCEEGMGA@@DG@@DC@AH@BA@A@A@A@AACACAAA@ACA@@EDAAABABADIFCAC@C@A@AAIBADG@BFA@@D@@@@CB@HAFFBDDBDBBG@ABGBGD@@@B@B@B@DC@@HB@B@@DD@@B@DBB@BDDAB@BBBAB@BBBD@FD@DEAEFBBGF@FBB@DC@ACCBAADAA@CBC@CAADEACHKECFABA@@BHJHHLJJLCFAFEPCAATWA@@@D@@ADIAGBNF@BCB@DBF@@\\TJDKN\\PMT@@\\NHDLJHBJFFBFFLNDDFBBBHNB@FAHN@@C@@@@@A@ABA@C@A@CBGDCD@BA@@@@B@@A@AB@@@BC@@BABA@@@A@@@@@@@@BA@A@@@@BABAAAB@@ABA@@BA@ABAACD@@AB@AA@@@AB@@ABA@@@A@AB@@A@@BA@A@@@A@@B@@AAABFFDBBBBBB@BB@@@B@@DBB@@@B@@B@BB@B@FANNBDFFBFBBDAFCB@@@HJCHCDC@A@BBHTDHBF@BFF@FFFFD@BHBNVR\\lZ@@XC`EPAFA`_JIHEFEPMNMFGZUVWPMtqXULKRQJGXURObYLIVQHENIFEFCra{OGKGA@@@A@@AC@@BA@@CA@A@BC@@@AA@@@A@@@A@@@A@@B@B@B@BB@B@@BA@@@@BA@@BEA@@@A@AACA@CA@BB@@BA@@@A@@@@D@@@BA@ABCABAA@AB@@A@A@@@@A@@BAAAA@@@@@AA@B@@A@@@@AA@BC@@@@ABAABAB@@@AA@BA@BCACEA@@B@@CA@AAA@AAA@@BAA@BAB@@A@@A@@AA@@@@@@@AA@@@A@@BA@A@@B@@C@@AA@@@A@@@@BA@A@BCA@AAA@@A@@A@AACAA@AAAB@@A@@D@@A@@BAA@@@AA@@A@CC@A@AA@@@@A@@A@@@@AA@@@A@@@@@@@@AAA@C@@@@B@@@@@@AB@@@@@A@@@A@@@@A@@@@AAB@@BB@D@D@BA@@AA@@AA@@AB@@AC@@AA@AA@@AAA@A@@B@@@BA@@BB@@BD@@BC@@AA@A@@DA@@B@B@@@B@D@BA@@BA@@BA@BB@B@@@B@@A@A@AAAAA@@BA@A@@@@A@EBAA@@@A@A@AAAA@AAA@@AA@A@@B@BA@AAC@A@@ABA@A@A@@AAA@@C@C@@@CAABC@C@A@@@BB@@B@AD@@A@AAEAAAA@AAAACCA@@ABA@AA@A@A@AAC@C@A@@AA@AACCCA@A@@EC@A@A@@AA@AB@@A@A@@CAECA@AAC@CACAA@ABA@A@@@AA@@A@ABA@@@A@CA@@CBCAMEICB@@A@@@@AA@A@@BCA@@A@A@@BAB@BAFADAHAH@B@@AA@@CB@@@@@@D@@@@B@@A@EA@A@@BA@@AA@@ABAC@@BCA@BAAA@@@C@@A@@A@@AA@A@@A@AC@@@A@C@@A"],encodeOffsets:[[124054,32175]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
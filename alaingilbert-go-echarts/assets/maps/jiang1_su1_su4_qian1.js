@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("宿迁",{type:"FeatureCollection",features:[{type:"Feature",id:"321302",properties:{name:"宿城区",cp:[118.242533,33.963029],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@JCBF@@DD@AB@ADDAF@@@@@@BF@@AD@@FF@@BB@@BD@@@D@@@F@@@@CD@@AFA@C@A@K@A@@IIAAEB@@CD@@@@@AAA@@CA@@@A@@CAAA@@CC@BCAC@AAADA@@A@AA@@BAADC@A@@DGA@BC@ABAAC@CAC@ACEAA@AGECACCEECCA@MGUSGKu[]\\cnABABAB@BADAJABAFABD@CHGNC@CHEJELD@BF@@@BAD@DBHABEBCBE@A@@BAAABABABA@@BABA@@@ABABCF@@_F@AA@@@G@@@ABC@@HB@EHA@A@@AIBG@@DA@@AC@@CC@@@@@@@@A@AAG@@@GK@@AAA@AAAAC@AA@A@AEC@@C@E@@A@G@C@AEKB@@@CEBGB@@AOEB@D@DABAD@B@FABCHE@QB@@@DE@@CC@@BC@GBA@@@CBAEC@A@@EE@@DGA@DK@]@@@@BE@@BG@@@@@C@@AA@@@BAI@C@@DI@@DA@@EKBBDBF@B@DAD@DAB@BAB@BBBBBDDDBFBBBBBBB@B@DABCFADCFCF@B@F@F@DA@ADAFCDAB@@A@IGCA@ACAGEGEEC@@A@CBADC@EJADGBA@KAAA]FCBE@BBIDG@CB@CAGA@A@E@AB@@ABABE@CBA@AB@@@@@BB@DD@@BD@B@F@@BDBF@@@D@@@BADAB@@ABABA@CBE@AB@@@@@@@@A@GDE@GDC@GB@@@D@@CHFBAD@BBE@@BB@@@@@DB@HBA@@BBBB@BC@@BBAB@@FBA@@B@@A@ABCF@@@BA@@@A@AJDA@BB@AD@@@D@D@@@@@@E@@BA@@F@FABA@@@A@@BA@ABA@@BC@I@@@@BC@@@D@AFB@B@@BD@@DB@BD@@@D@@BJD@@DD@@@@FA@@BC@@DB@@A@@@@@@B@@BB@@D@@@DB@@DB@@BJAPE@@B@@B@@B@@B@@BBB@@B@BBF@DA@BBAB@D@@@FB@@BA@C@BF@@A@BJDA@JA@A@AD@@@B@@CA@D@@@CA@@AA@@@@AA@@AA@@BA@A@@BA@@@@BA@@DB@@B@BAD@@ABEV@B@@@BD@@@AD@@ADAJ@DAD@DA@@BABEBCBIFADABEJCFADCNAFAHAFF@AF@DAB@BC@@BAB@DAJ@BABGL@BA@AB@BCHABCFA@ABED@@E@@AG@@@@AA@@A@@@@@GAG@@@C@@@MB@@AA@I@@AA@@AC@@AC@@B@BC@@@AB@B@BAB@B@BI@@@C@A@@@C@AFEA@@@@A@@@M@BF@H@BB@F@@P@D@B@HC@@FC@M@@CAAEBBHA@G@A@A@@BA@@DO@@CI@BNC@@AA@A@@BA@@@BB@@@B@J@@@@C@KBI@CAA@@HABABCHAD@FD@BBBBBB@@ABB@@B@@@B@DAB@@CBBD@@B@@D@@B@BA@ADA@BBD@@A@@@@BBFB@@A@@@@@@B@B@@@@AB@@@BA@@@@@@J@@B@@BADB@AAA@@DC@ABA@@BB@@BBBA@@BBB@@BBB@@@@DB@@B@HDFB@@@B@BBBDDJBDBP@@@D@@@D@@DBDB@BABDNCFJB@JCJEDAB@HAD@DCDABCF@BAFCHAP@PB@@AAB@AAB@AC@@FC@@B@FCBBB@ACFCDBBABBFCBADHJG@D@FBB@B@@BA@@BFBABBBD@@LALEDABAHGDADEFCHEB@DAD@B@FBF@D@B@B@B@DAB@B@BABABB@@B@NJBBBAD@B@B@@@@@BAB@@@@AB@BAFGBA@@@CB@HBB@B@DANCLCDAF@HAHCB@DBB@LA@@PALC@@B@@ABA@A@C@C@E@C@ECGACCGAE@A@@CM@A@C@E@I@E@CBA@I@ABCBEBADEBABCBA@ABEBCDKDKDG@CDGBG@CDGBABAB@@AB@F@D@D@BAB@BCDEBGBA@ABA@@BAB@@AB@B@F@B@FAFAHCBAFAHAFADAFAF@HAD@DBH@HBD@LBDBB@BAB@BADCDE@@DAFEFCBALCFCFADCDATKD@BAB@FCB@NGLGRINEDADAD@B@B@BBBBB@@B@B@B@B@B@@BFB@BBBBJDVJBBD@DBDBD@B@D@B@B@D@B@DAB@DAF@F@BAD@BABADADEBCDAB@BAB@BAB@B@DCPMDC@ABABA@C@A@ABA@EBAEA@AAA@@@@@AC@@AAA@@BCCA@@A@A@EABACA@EC@@CA@@AD@@AA@BCG@@@@@MD@@@A@CABOLA@EDEBQ@C@E@@AA@AAAAAE@@@@@A@A@ADKDMBC@@@ACO@AA@AA@@E@E@@A@ACBA@@@@@@@AC@A@@AADCAAB@@AD@@@@@B@@@B@BABB@A@@BA@@B@B@@@DC@@BAFAAADC@@B@@ADA@B@@JEDC@@B@BA@@AAB@@AA@@A@@@A@A@@DC@A@@BCB@@@B@@CB@@AB@@CJB@D@@BAF@B@HCDC@CB@B@@@B@@A@EEB@AA@@C@@AC@@@A@@AAA@A@@CA@@CF@@ADABABA@@@@@AAAJAAAL@@DDA@BF@@CB@@GF@@@@@@AAKAE@@@A@AAAAA@AAE@@"],encodeOffsets:[[121422,34460]]}},{type:"Feature",id:"321311",properties:{name:"宿豫区",cp:[118.330781,33.946822],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@A@A@A@AA@AAAAA@A@C@CBCBMFQJKHMHA@EDA@ABC@SLCBCDEBEDKDABEDEFCB@@CFCDABA@ABA@CAKAC@GAG@CAC@GBE@EBCBEBGBEBABGDEBEBA@E@A@A@@BA@AB@@AB@BABAHCFADA@ABC@C@E@A@@BA@ABABCH@DAHCH@DCHCLCLADAF@BABADABCFABAFAD@B@JAB@D@F@J@F@D@BDN@@@BBFDHBDDH@F@D@F@D@D@BAB@BA@@@KDOB@@KBA@CAA@GDGBE@CBKDMDCBA@A@GAA@@D@@ABEHABA@@B@@A@AB@@@@A@A@C@ABAAMIA@@@AAABABA@A@CBA@A@A@C@E@EAA@C@CBA@GFEDCFCBGHABCBKFKB@@ACAAABAE@@AB@@@AAA@E@CIHCGABEDAAABCAEDBDA@AAEDA@@@ED@@BDA@BBA@BB@@OAO@GBEDABE@ADCBCDC@GBA@CBIFIDA@EIMDACABA@AC@CC@@@C@@@O@CAIACCAAAFA@ABAA@@@D@@AF@@AF@H@@B@HBAH@@@@A@@@A@@BAABFC@A@@B@@@BAB@@@BAB@@@BAD@@BBADDB@@FA@@B@@@@@@D@B@DADFBCHBB@AD@AFE@ADF@@DABEAGTA@AFAD@@A@@BABAB@@@BCHADA@GAEAA@CAIAOEGAE@EAIA@D@B@@A@ADAB@BALF@@@@@@B@@@@ABC@A@@FB@@@ABAB@@A@A@@DA@KA@F@@@PFA@DC@BD@BC@DP@@@DD@@B@BB@@BBJb@@@@F@B@BA@@@BBD@F@@@HA@B@@BBABBBB@FB@A@@@AD@@@@@@@@BD@B@@AA@@@B@@AB@@@@BB@AA@@DA@AB@F@@B@@@BFA@@@F@B@B@@@@FBB@F@@@BAB@@@B@B@F@@B@B@B@@HFCB@B@@@F@@@@DDBBB@BB@B@@B@@@HHBDBB@BBABA@@BAB@F@B@BAB@@ABADG@ADGDEDAD@BADABABA@A@@AC@A@A@ABADABAB@@@B@BBHBLBJBF@DAFADANCLCJCB@B@FDBBBFDP@BIH@@AJH@H@H@T@F@DADBB@FDBBDBBDFCB@@@@@D@@@BA@@@@B@@@B@B@B@@@FBB@@@B@DBBAB@@AB@BA@@BABA@@BAD@FGBABADADADCBAHGDCBEDG@ABCBAFEBCJAN@NA@@HANEHAZGRCD@VBD@ZBRBH@J@L@BBF@FBDBF@B@J@J@XAJ@B@DALAD@NCH@NCJAHAB@D@HAB@B@B@FCBABAB@B@D@B@F@FBD@FBB@B@BAFAJAB@DCDAFEBAB@D@B@B@DBD@HB@BB@DDBBBBDDBB@@D@FBDBF@DBBBDBBBB@@@FBJDBB@@@BDBF@HBFBRFD@H@DB@ABK@@C@BGBGKADIAAAAAFA@@CBEA@BCB@@B@B@@B@@A@ABAF@@AB@@@BGB@@C@@@E@C@AB@@@LG@B@@@AD@@@B@B@@ABA@@@@BA@@FB@AD@@@D@@AFAAABA@E@@BCBE@@@@BA@AB@@AC@@CB@@AB@@BB@@ABUB@@C@@@AF@@A@@B@@G@IC@@ICB@C@A@AA@@@CBDCACE@@@@AC@AGIB@@AA@AA@@MAB@AACB@@AAB@CLIFABAJFHD@@DQ@AFMLm@@FUBEFG@@D@AI@@A@@@AEFAA@AAAA@@AACA@@C@AG@@@@AKA@BDA@@CA@@GACC@@CB@@CC@@AB@@CB@BABABA@@@A@E@@I@@@@A@A@@@GA@@CA@AA@@AA@@@A@@@A@AB@@A@A@@BCHAA@DEB@@BB@@BB@@A@@DB@AD@B@BBBAH@@@@GD@@A@@@@BAAA@@C@@G@A@EK@@CA@@A@A@@@CA@B@@@@C@@@@A@ACB@@AB@AAGA@@H@@@DAACA@@A@@AAA@EB@EA@@@@D@@@@AC@@AAA@A@ABAAB@@A@@@CB@@GC@@B@@@AE@@C@@@CA@AC@BAA@AB@AABAAE@@@@AA@@B@ACDAA@BAACB@@BBA@BBBFGBCBA@@CE@@ABEEGI@@@@HCB@BA@ABAC@AAGBCBAA@@@@A@ACCBACA@ABA@ABA@CBADCFCBABABC@ABE@E@CBA@CBA@C@A@A@C@A@C@CACAC@AAUIICAAAAA@AE"],encodeOffsets:[[121344,34617]]}},{type:"Feature",id:"321323",properties:{name:"泗阳县",cp:[118.703424,33.722478],childNum:1},geometry:{type:"Polygon",coordinates:["@@YA{@uHIDABC@G@A@@@olAFAhE\\ELAPGdGZAHAHCHCHAFEH]b]bGH]\\v\\HLVTNHB@DDFFDDDBHF@BBBDF@BBD@DBDAB@BADB@CH@@@BCDBB@AB@@B@BB@BCBBD@DB@ADD@@BBDB@@@B@@DB@@BB@B@@@@DC@@FABBJJ@@@B@L@B@DEB@BC@@D@@E@@@C@@@C@@AA@@AE@@EC@@BE@@A@@@@E@CBBCA@@BCC@@AEIDA@@@BF@BBBBB@B@B@@BFBL@B@@@@E@@HA@@DE@@ACB@CK@BBIBBB@B@@@@ABABCB@BE@@DB@@DB@B@BB@@@B@@BD@@@DB@@BFA@F@BA@@@A@A@@DCDGDA@E@AB@@@CIA@DA@@BA@@DA@@@A@AD@@@BCD@@@B@B@@@BB@@BA@BB@@ABA@@@CDIF@@@ACB@BA@@@CDBBEBAB@@CD@@A@A@@@AB@@@BAAABA@@@A@@@@@C@@BA@BBCDBB@@@BBD@@@@@@B@DA@B@BF@F@@@BBB@@BDP@B@@ADCNCL@B@B@B@@@@BFBBBBB@@BF@D@R@FAFCB@PKBA@D@B@@NC@@@@H@ADB@@BC@@BB@@DD@@FDBABFBB@B@@@DBAD@@BB@BD@@B@@@@BB@BFBAB@FAB@B@B@DABAB@BCDONCDA@BDDABDB@@@@@BBDAHABBD@AB@BABA@GD@@@@HJFFBA@@DF@@ABADEHAA@AAB@AA@BDABB@CBBDA@@@BB@@@@BFABBBA@@BBB@ABDB@@D@@@DF@@B@@@AD@@HA@@D@@@BA@BBBAB@B@BB@@BD@@@@@C@@B@@FFAB@BB@@@BB@BDCB@@G@@@HBBBA@@BA@BDB@@@@@@D@@A@B@@D@@@B@BB@@DL@@F@B@HD@@@BBAB@@@@@BC@@H@@G@ABAAA@C@@BCA@@@BA@@AA@@AA@CFB@GBAD@@@B@BA@@B@B@@@B@@BB@@BBB@@DB@@H@@@B@B@@J@@@@F@B@@ABABABA@@DA@@BD@@DA@@DD@BD@HB@@DB@ACB@BL@@@@BHD@@@DBBB@@BBBBB@EBBF@@B@@@BJD@B@DBD@@GH@@JBB@DB@F@@C@@@@@DB@@CFBB@BBB@NA@DF@@CVA@@@B@B@@D@@FB@BA@DB@AGB@@B@@@B@BJ@@CB@@D@@@CFA@BBBB@DH@DB@@@DBDL@@B@@@@B@@@AB@BF@DBBBA@CJEBBDGDB@@B@B@B@@A@@@AB@@@BAA@DCACB@ACDAB@@AB@@@@@@@D@@@D@@@D@DAB@AEJA@@BBBDBHE@@DBA@@@B@HCBBFDB@@@CBCF@@DDABDB@@DB@BD@@@BD@@B@@BB@@BDBAA@B@@@@@@@B@@@B@@@@@B@@@J@@A@@B@AE@@@B@@@AB@AG@@B@@B@JD@@AF@@DD@@AB@@DBABFF@@BB@DB@AB@@GP@@FDA@D@@B@@@F@@CD@@ABA@@B@ADD@@@B@@C@@@AB@N@@@B@@@BCBAB@BBB@@A@@@AB@@@@BBCFBABFBNAF@D@ABBBB@@BL@@@@@@BB@@F@D@@@@LDB@jBJ@JBD@B@B@NC@@AC@@@C@@@EA@AAACDA@AA@@AC@ACB@@A@@@AA@@AF@@CH@@@@CH@AMA@BA@A@@F@@@D@@@@@D@@C@@@@@ED@@@@@BCB@@AB@@AF@BAD@@@B@@@@@@EA@BC@@@A@@B@@A@EDB@AFE@@BAB@B@B@DA@A@@B@@BD@@AA@@AD@@AB@@AB@@@C@@A@@@CB@@CA@@@AADEAAEC@AAAA@EAAAE@@@AA@CA@@G@B@CD@@E@CE@@AC@A@@@@A@@@@@AB@KCA@@@@ABABC@A@@ACAC@@C@AA@@A@AAAAA@AA@AAACAECIGECECCCAAIE@@AAAA@@AEAEACCAAACCAAA@AC@GCG@ACAACAAAA@@AA@A@CEA@@CAA@@AA@@@A@@@A@@A@A@CA@@CBA@A@IA@@EB@@@@A@@@AB@@C@@@EB@@E@@@@@@D@B@DGIGAA@@@@ACCA@@A@@@@CD@@@@@@C@@@CA@@A@@@AA@@EC@@B@@@@CB@EB@@A@@@I@@F@@AF@@@L@@IFADB@CK@@AC@@EC@@C@@@AAEC@@EB@@AB@@CBG@@@CB@@AD@@C@@@BB@@AD@@AB@ACB@@@B@@@DA@@B@AEAE@A@@E@@B@@AC@AF@@BB@@CB@@BB@@@@@B@@AB@@C@AA@@@@@@AG@AG@@AA@C@@@AD@ACB@@AB@BDDA@A@@AEB@@@AA@@CE@@AAAA@@@A@E@@@AGBBFC@AA@@@BA@@CA@@@A@@@@@@@ABEU@ECG@G@@@AA@AOA@BA@@@@B@FIBCDWA@@A@@@CC@GCA@@@@@AB@@E@@BUEGAE@AAABA@@@A@@@AA@BGABCA@@DC@@A@@@AA@@B@BCA@@@AFKBGBEDI@ABCBCBCFCJM@AFEDGDGDCBABADABADAHEFEBAHCHCAABA@@@AAI@AAA@CAA@BA@A@@AAA@A@AA@A@@CD@AA@G@A@CC@ABAAAA@@@G@CE@@@@@@@CE@A@@@A@C@@@A@Q@I@@@G@E@C@CAG@CACCK@AAC@@ACAAA@@BA@@AA@@@@@@@@A@@@AA@A@@@@I@@BA@CBC@A@G@G@A@@@CA@BE@@BC@EBS@@@@B@@@@C@E@AAA@ECm@GAA@@AAiS[MaE@@WE]C"],encodeOffsets:[[121549,34189]]}},{type:"Feature",id:"321324",properties:{name:"泗洪县",cp:[118.223591,33.476051],childNum:1},geometry:{type:"Polygon",coordinates:["@@pk@@B@H@D@BAJCvG|@ZB^DXF@@@@diV[BM@OMO[{W£gcwUSIÙ]S]SIAUEGCSWDKDUDYD@@JUBMBqBa@WKA@MC@AAACC@A@@AA@@A@CA@AA@AC@@A@EA@@A@@@@A@@@@A@@A@@@A@@@@AB@@@@@@A@AAB@@@@AABA@A@AA@@A@@@A@@@A@B@AA@B@AA@@A@@@@A@@@@@@@@@@@@@AC@@@@@C@@@@AA@B@@A@BB@@A@@@CACAEAA@AJ@BGTILABKJGFMJCBEB@@KD@@KFAAGACAECII@@GICGAG@E@GBEDI@C@ACE@AA@@AC@AA@@AACACA@@GAA@AAWGC@QEC@gMMEGACAQKCAE@@AA@@@AA@@A@@@A@@@A@A@@@A@A@@@A@@@A@@@A@@@A@A@@AA@@@@@A@@@A@@@A@@@A@@@A@@@AA@@A@@B@@@B@@@B@@@@AB@@@B@@@B@@@B@@@@A@@@A@A@@@A@@@A@@@@@@A@@@A@@@A@@@A@@@A@@@A@@BAA@A@@A@@A@AA@@A@@@@AA@A@@@@@A@A@@@@@AB@@@B@@@B@@@B@@@B@@@B@@@@@BA@@B@@@B@@@B@@@B@@@@@B@@@B@@BB@@@B@B@@@B@@A@@BA@@@A@A@@@AB@@A@A@@@A@@BA@@@A@@@AB@@A@@@AB@@@@A@@B@@A@@B@@A@@B@@@@AB@@@@@BA@@@@@@@@B@@@@A@@B@@@@A@@BA@@@@@A@@BA@A@@@A@@BA@A@@@A@@BA@@@A@@BA@@@@BA@@@@B@@A@@B@@@B@@@@@B@@@B@@AB@B@@@@AB@@@@@@AB@@@@A@A@@@A@@@AB@AA@A@@@A@@@A@A@@@A@@@A@A@@BA@@@A@@B@@@B@@@B@@@B@@AB@@@B@@@B@@@BA@@B@@@@@B@B@@@@@B@@@B@@@B@@AB@@AB@@A@@@A@@BA@@@@B@@AB@@@B@@@B@@@BB@@BB@@BB@@@BB@@@@BB@@B@B@@@B@@@B@BB@@B@@@B@@@B@@@BB@@@@@B@@@B@@@B@@A@@B@@@B@@AB@@AB@@@@AB@@AB@@@BA@GLCDCBGBI@M@WCO@GBGJ@DBDJPFHBH@FCHKLLF@BB@HDNFDDB@@@B@@BB@@@B@@@BB@@B@@@BB@@B@B@@@BB@@B@@@BB@@B@@@BB@@@@B@@B@@@@@B@B@@BB@@@B@@@B@@@B@@B@@B@@@@BB@@@B@@B@@B@@BB@@@B@@B@@BB@@BB@@B@@@@@B@@B@@@@B@@@@@BB@@B@@@@@B@@@@@B@@@B@@@B@@@B@@@@@B@@@B@@@B@@@B@@@@@BB@@B@B@@@@@B@@@B@B@@@@@B@@@B@@@B@@@BB@@B@@@B@@@B@@@@@BBB@@@BB@@B@@B@@@@BB@@B@@@B@@@BB@@B@@@BB@@@@BB@@@@BB@@@AB@@@BA@@@A@@@A@A@@@A@@@A@@@A@A@@@A@@@A@@@A@A@@@A@@BA@A@@@A@@@AB@@@@A@@B@@AB@@@B@@A@@B@@AB@@AB@@AB@@@@@B@@AB@@@B@@@B@@@@BB@@@B@@@@@BB@@@B@@@B@B@@@B@@@@@B@@@B@@@B@B@@@B@@@B@@@B@@@B@B@@AB@B@@@B@@@B@@@B@BA@@B@@@B@@@B@@@B@@@B@B@@@B@@@B@@B@@B@@@B@@BB@@@B@@BB@@@B@@B@@B@@@BBB@@@@BB@@@@BB@@@BB@@@@BB@@@@BB@@@@B@@B@@B@@BB@@@@BB@@@BB@@@@BB@@B@@B@@B@@B@@B@@B@@B@@@@B@@B@@B@@B@@B@@BB@@@@@@BB@@@@B@@B@@B@@@@@BB@@B@@B@@B@@B@@@B@@@@@@AB@@@@AB@@@BA@@@@BA@@B@@@@@B@@@B@@@B@@@BB@@B@@@B@@@B@@BB@@@B@@@B@@@B@@@@@B@@AB@@@BAB@@@@AB@@@BA@@BA@@B@@A@@BA@@@AB@@AB@@AB@@AB@@@B@@@B@@A@B@@B@B@@@@@B@@@BB@@B@@@B@@@B@@B@@B@@@B@@@BBB@@@B@@BB@@@B@@@B@@@@@BB@@B@@@B@@@B@@@@@@@B@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@BB@@@@B@@@@@B@@@@@B@@@B@@@B@@@B@@@B@B@@@B@@@B@@@B@@@BB@@@BB@@B@@B@@@@@BBB@@@B@@@@@B@@@B@@@B@@@B@@@B@@@@@B@@@B@@@B@@BB@@@B@@@B@BB@@B@B@@B@@B@@B@@B@@@@BBB@@BB@@@BB@@@BB@@@@BB@@B@@@@BB@@@B@@BB@@@@@BB@@B@@@@@BB@@@B@@@B@@@B@@B@@@@BB@B@@@B@@@@@BB@@B@@@@@B@@BB@@@B@@@BB@@B@@@@@BB@@B@@@B@@BB@@@B@@@B@@B@@B@BJ@F@B@FADAJAFADADAJCF@HCB@FAbK@@@F@J@R@H@BAJ@FBDBJ@HBB@DC@@B@@@BE@@DIB@HA@@FFANB@DB@@D@B@FBJBNBJ@F@B@DDX@@@HDV@D@BBD@D@B@@@DAHAJ@HABADAFAD@@@@BB@@@@B@@BB@B@JF@@@BBBB@@B@@B@DDDDB@BDB@BDDBBBDD@@B@BDBBB@BBBBB@@BHFJH@BPNBBHFBB@@@@DDB@@@@@@BA@@@@B@B@B@BADAD@B@B@D@B@D@B@D@B@DAB@D@B@BAB@DBB@F@D@B@D@DBF@DBB@BBDBB@@@B@@ABKDIBEB@B@@HRDHBD@B@@B@DAB@D@JA@@B@B@B@BBB@@@BB@@B@@BB@BB@B@@B@@B@B@@B@DDDB@@@BBAF@DAB@BABA@@BABC@A@@@C@@AEAC@@@E@AAC@@CCA@@A@@@@BAB@DAF@BABA@@BAF@B@B@BH@DDAH@JCAAF@DA^EBBLBB@HABCFID@BCDAB@@@FDHFHFDB@BDBJHB@@@BADCBEBCB@@C@E@E@ADEDEBCDEBA@C@AAAAAAAEACACCAAAA@ABA@ABA@CBC@C@AAEACLA@FB@@CJ@@CD@J@AB@@B@@BD@@@@@H@@AF@@A@@^@L@@CHB@CF@@FB@D@BFDA@@B@HAD@@AD@@DF@@C@@RAF@DGBA@E@ABCBA@C@CFABP@@HAFA@D@@LABFD@H@B@@@@F@DD@BFB@B@@BBDBB@BBB@BL@@H@@BH@B@B@@@@@@D@@DD@@BB@@CH@JA@BB@B@FGA@@GD@BA@@H@@@B@@B`E@@DEBABA@@B@BA@AB@BABABABB@AB@F@DAFABAAG@CBC@A@@AEC@FKFIDGD@HMDGC@BABEBABIBC@ABABABAdm^[^[HG^a^aFGBEDGDGBGBGHYHcBOFKF[BgBE"],encodeOffsets:[[121442,34217]]}},{type:"Feature",id:"321322",properties:{name:"沭阳县",cp:[118.804784,34.111022],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@CAA@IG@@HC@CAA@C@C@@@EHAFEV@@KnEN@BCR@@GCIEABEBKJ@DBA@BA@BD@BBA@NB@@BBB@@JABHD@@B@@F@BDCDDA@@B@@B@B@DDA@JD@@J@HA@@@@BE@@B@@@DA@AV@BA@@AA@@BA@@DD@@BA@@BAB@@@@AFAD@@@FABBBEB@BC@@@C@@BEA@@AB@@@@AB@BA@A@@@C@@B@@@AKH@@A@@B@D@F@@@DA@AH@@A@@BE@AB@B@BA@@@@A@AA@ADB@AF@DB@BEBBBBCJLBAHAHD@@@AL@BCAG@C@QEEAGAE@CAABG@EBA@@@@P@F@@AB@@A@BD@@@B@@@BBDDL@DB@@D@@@D@AK@B@A@@HB@@B@B@JD@@@B@@@@F@DBBD@@@@@@BD@B@B@B@@B@@@BD@B@B@@DD@@BL@@AD@RA@DDA@C@@@@DA@F@@BA@C@@D@@@@@D@@A@CDABHJ@@@@F@@F@@@@DBB@@DA@B@@@RBF@@ABA@@@@BDP@FFFDL@B@@@B@BFP@BBBB@@BBF@B@@AB@@FP@@B@@@@D@@GBI@@BEB@@@@@@@D@B@@@@@B@@BBAB@B@B@B@@@@@@@B@@@@ACA@@B@@@@@DA@A@@B@@@@B@@B@@BA@@BHA@BBABBFB@BB@BBFA@@D@@B@@DA@@D@FBBA@@DB@BBB@B@@DA@@BD@@BB@@BB@@DB@D@AFEAC@BF@@@@@B@BB@D@CF@B@BBD@BDJB@FA@CD@@FA@BF@B@@BAB@@@@BB@D@B@BAB@@BA@BB@@@BBA@@@@@@BHDAF@@@@@@@@A@@@@@@@@@@B@B@@B@@@@B@@@@AAAAA@@@@@A@@B@BA@@@@@BF@F@@BB@@BB@@BB@@@@BD@@@LB@AD@@DDB@EB@@A@@@@D@@AJA@D@@D@@AB@B@@DA@@BF@@@D@@BC@@@@BD@@@D@@B@BB@ADB@@DA@@BB@@B@@@FB@@E@@BF@@AE@@B@@@@DBB@@@E@@@F@@@E@@@F@@@B@D@B@DAB@B@@@BA@BBA@@@@@CB@@@B@BA@@B@@A@@BA@A@GB@@@@@@@@A@@B@@AB@@A@@BC@@FF@F@B@F@B@@@@B@@@@BDBAB@@@BF@@B@B@@B@B@@A@@B@B@B@@@DDA@DH@BAD@@@@@FACAJC@BFAB@@AB@B@AAFAAAB@@@@CD@@A@@D@@AA@@@B@@AB@BBB@@CD@@AD@A@B@FA@AN@@DB@B@@DBD@@@A@@BAB@BFB@B@F@@BB@D@@B@@B@BBB@@@BBA@@@BBB@AEABAGF@@AD@@CB@AAB@@@F@@AB@@@B@@C@@@AB@@C@@H@D@BAB@BBB@@ADA@D@@FE@B@BBB@@@C@@@CDA@@B@HAACD@BD@AAEB@@AB@B@DA@BD@@BBA@@B@@@B@@AB@@B@D@@@BF@@AB@@DBA@DB@@@@@DA@B@@@@AB@@@@DAB@LG@ADA@@FC@@A@A@@AB@@AB@AA@A@C@@ABC@CB@CF@@@@E@@BD@@@C@@BAA@@@@A@@AGDB@F@BBA@@@F@@B@AC@@JAAE@@@@D@BBBBB@@@BFBAB@D@B@PAB@@@@B@@@B@BB@@@@BFBB@@B@BB@AB@BBBBB@@B@B@DDBB@BB@B@@B@BAB@@@@DD@@@BBBB@BHB@B@@@@@BBB@@@B@DABDAB@@BBAB@@DFEB@BDH@FE@BFA@@@@DH@AEB@BF@@@DLA@B@@D@B@B@B@@AB@@BBACE@@HBD@DHGBFFB@HFBBB@D@DBLAH@JAJAFAB@B@JBFBB@J@F@D@D@B@T@F@@@F@RB@B@F@BA@@@@D@DB@F@BAF@BCHBB@DB@CB@BCFB@@BB@@B@B@@@@@BABBBBDBBBBBD@@ABADAJIDGBAAG@@BA@@@@@SE@@C@@XA@DE@DLA@BD@FB@FBBFB@BBBB@BDHBFHAB@@BFF@DBBB@FF@@HFFDFDIB@@DFD@@@@D@DBD@F@@@@NBADT@JB@EP@AABA@AFANA@BB@D@@FD@@FALF@FRB@BAB@H@@CXC@J^@\\@@@EU@@kAAG@@@C@@@@DAAE@@@A@@@AA@A@@@CEACACAA@AAG@@AE@CAEAGBEBI@E@E@@@CBIE@@EB@@@FB@ED@@AD@FBBGDD@A@A@K@@B@BBJHB@@@CKAIBC@CBE@ABABA@ABABADA@@BA@@BC@A@C@@@A@EAI@AAAA@E@@EI@@G@@@A@ID@@CF@BB@@@@AA@@@CCOAG@A@@@@AEA@@@@A@A@@HC@@HAHA@@@@B@B@BAF@B@@A@CD@@@B@AC@@BA@ABA@C@@@AA@@AC@@UB@@CFAACD@AC@@@A@AB@@AB@@A@@@A@@@@@EA@@E@@@CB@B@@GB@AG@@@EB@AI@@JAH@FAHA@JTAFBFAD@@@@@@C@@DA@@@GB@HBBEBB@I@@@E@CKAA@@ABEA@AA@CF@@@@AF@@ALA@AB@@ADA@@AC@AC@AEA@@@A@A@@@ACB@@AFA@@B@ACAB@A@A@@HCCEECCECAAAOIEA@@BA@CF@B@HA@CD@@EBDBADEB@DDBB@@FCAABAB@FDBD@@@@BA@@BAB@DAAA@ADA@BBABDF@BAB@DABAB@@@BA@@BC@@@ABA@CBA@AB@FC@@@@@A@@CC@@@@AEA@@@ECA@A@A@@@B@@BB@@@A@@@B@@@EBAAAAHA@@@@AA@ACA@A@@BAB@@AFA@ADC@ADC@@@ABAC@@@D@@CGEAACAA@@AA@@AAEAC@@@AAA@@@AA@AAC@@AACAAAEAA@A@A@AAI@@@CBA@A@A@@@AAC@AAAAA@ACA@AAC@@@ACAGGECEA@AEEEEAA@AA@@@A@G@AAC@@@CAGCEAAAGEAAACA@AAC@AAA@ACAA@@AACACCCAEEECA@@AAA@@@A@ABCAC@@AAAC@ABC@AAAACA@CAA@A@AAAAAA@@C@@BA@C@A@@@AAGAECA@AGA@@AA@A@E@A@A@C@CCCCAA@AAABA@A@A@@CCB@FA@@@@@@DA@AJA@@BG@A@@@@A@CIFA@@D@@C@AAGB@@@B@ACA@CA@ACCAAAAA@AAAA@@A@E@@@CAC@A@AA@@@A@@@@B@DAB@@A@@@@@AA@@@AAC@@@A@@@@A@C@AA@@@A@@@@@ADA@@@@@@AB@A@@@C@A@@A@C@C@ACA@A@AAA@@AACAA@C@@AA@AA@AEEAAEC@AAA@AAA@@@@A@CBA@@AA@AA@@A@ACCB@DAB@@@FBD@@BDEBABIBAAA@@AE@BDAB@BB@@BA@@@A@A@@BA@ABADABGFABABC@A@C@A@AAGEA@A@A@C@ABA@C@@AA@CA@A@@@AAABE@@AA@@AA@@A@A@EBA@A@A@@@@AA@AC@AAA@AA@CAA@A@@@E@@@CBCDA@A@A@AA@@AAACAAEEAAEEGCA@A@AAC@E@A@AACAA@GAA@AAC@ABC@@@A@EDCBCBADA@ABC@A@A@A@A@@B@@@@@B@@B@D@@BF@@D@FC@@D@A@HB@@DBB@@F@BBFBB@BB@BFDBBCFBB@@B@@DA@@D@@@BD@@@A@@BA@@BC@@BB@@BC@@AA@@@@BCBA@A@A@AB@@EF@BCA@F@BA@@@@B@@ADB@@F@@@@A@@@C@ABE@@BA@@BA@AD@@@@C@@F@@@@@DC@@@@@C@@@E@@@@BABB@BNG@@D@@G@@DE@@BB@@B@@@BA@BDD@@BB@@BCBBDBBB@@F@@@D@@BD@@MDA@A@C@IAI@iAA@KC@@@@@C@EA@@A@@@@K@@AA@AABAC@E@MBEABAEAAD@A@@A@@B@@@BA@AAA@ABAD@@A@@@M@A@@B@@@DA@@@C@BCA@@@AB@BC@@DE@@@A@@@@CCB@EO@@HA@@BCAA@@AE@AEAB@CA@@BC@@CE@@BC@@I@AA@@@BHA@@B@@@A@@BFA@@@@BI@@@A@@@@@A@@@A@@@@@@@A@B@ABAC@@AA@@@AC@@A@@ACA@@CA@ACCB@CE@AD@D@@CAAEDA@G@A@@AB@CF@AGACAA@@IBBFA@CBC@@@C@@@C@@@@@@@A@@BA@CBBDA@BDCDB@AB@@A@@B@@@BA@A@A@@@CACHAAIF@DABAA@CAEA@@B@@@A@@A@@@CKCA@@A@@CCGA@AA@AEB@D@@@CA@@DI@@A@A@@@AA@BHA@@CABA@@EC@@@@A@A@@UB@DE@@CMBA@AAA@EA@DA@@C@@@@@DE@"],encodeOffsets:[[121457,34771]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("泰州",{type:"FeatureCollection",features:[{type:"Feature",id:"321202",properties:{name:"海陵区",cp:[119.919424,32.491016],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@D@BABABABC@ABAEAAABA@@BACABAFB@G@@E@@@AA@AC@@@@A@ABAAAA@A@CABA@AA@@A@@@E@@@@@@@CEB@@@@@B@B@@ACABA@A@A@A@E@@@C@@@A@A@@A@@A@A@A@AB@@@@@@KB@@@@C@CA@@A@G@A@B@CAA@@BCAABM@BLADCD@@AB@@@B@@@@A@@@ADB@AD@DA@AAC@@@AD@@@@AHB@D@@D@BB@BB@@@@@@@B@@BBB@@@B@@@@AB@@BB@@@@@ADB@AD@B@@@@AD@B@DB@@BB@@FBBB@DBB@@@ABB@F@@AHAB@F@F@@@ABB@@B@@@DA@AF@BA@@DEA@@@BADE@@DD@D@@@@BBFDA@D@B@BA@@@A@@@A@@BB@AFC@@B@@E@A@@B@@A@@DB@@B@@@D@DBB@B@B@BAB@BCA@B@BA@C@@FAF@BAFIACAEAA@@B@DAA@@G@BCDB@AMACAC@CAA@@@ABABIHCD@@ABCDAB@@B@@@D@DBB@B@@B@@AB@B@B@F@B@D@F@DBH@BBD@F@BBX@@@B@F@B@JB@@F@@@D@H@@A@C@@D@B@@AB@@@B@@DB@B@@@B@@BB@@@@H@@@FBH@@@@B@@J@AH@@@B@FAJB@@@B@@@@B@@AD@BAF@@@@@@E@A@GBMB@@C@@@B@BFE@@@@@C@@AABBBA@@@@@@BB@BDCB@BDABB@@BHDH@DBFB@BAB@B@HABB@@@@B@D@B@BAB@BAB@FA@BB@BABBB@AE@@@AF@H@HEB@BA@@B@D@@BDBF@@@B@@@B@B@B@B@B@D@@@@@@@B@DAB@@C@A@AAC@AAC@E@@BI@@B@DBBDDBD@BBF@DBJ@B@PA@@F@@D@@H@D@FAL@@@@@H@@@@@DA@@@AAA@@B@D@@@@A@@@A@C@@B@B@B@BA@DD@BA@BB@@@DA@AJ@@B@F@@L@LB@ABE@ABC@CB@@A@A@CBAFCBDDBJLHHJFRRBDHADA@@BA@@@EBAF@BABA@@BCBAB@B@@D@@@B@@B@@B@B@@@ABBB@DHBBFAFADABHBBDJBANCBA@A@@BABA@CC@BCDEAGACBEDCB@AEL@AG@CEA@A@ADCAAA@@GFECACAAADCBABABEDG@A@AA@DG@@CADA@@AAA@@@AA@@GEEAAACACAFEF@@@BAFBDC@@AAIECCGC@@@AH@BADC@A@A@AAABCBE@@@@CA@@DGB@@AC@@CA@CACC@@CAEAICGACCC@KECAECCCFE@AFGBC@@AA@@@AEAC@@CA@Q@E@E@@A@@I@@CCBA@CCEDA@@@AB@B@@I@IA@GCAAA@@A@ECCAIC@A@@CABA@EBC@@@A@@CAE@GA@CC@KA@LA@AA@BC@AFGA@@@@@@@@A@@A@@BC@@@ABACACAIAKCC@E@@G"],encodeOffsets:[[122783,33180]]}},{type:"Feature",id:"321203",properties:{name:"高港区",cp:[119.881717,32.318821],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AEGAA@ACKMO@AAAFAA@CGCEEIQW@DAFCTADA\\AFAJ@@AJ@HET@DCHCNAH@BAD@BERCP@DAFADCFABIRADAB@BEFCFEDIH@@MPOPCBEDUTABHFBBB@BAB@BADDABED@@@B@@B@@BDBBBBBBB@BA@@BA@DB@BD@@@@A@@D@@@B@FH@@B@@F@F@B@B@@C@A@EB@@C@AA@@@@@BBB@B@@BB@BB@ABBB@B@BA@@@@BBB@BA@BDB@@B@B@@B@@B@@@@@B@@@AA@@BA@@@A@@DA@@@@@C@@@@@A@@BA@@@@B@@BBD@@B@BF@B@@B@BD@@@A@BB@@@B@@@DDALA@BBL@JN@BADB@AB@DBA@B@H@B@@@DBD@@@@@LA@@@@@@BAB@B@B@@@@BB@B@@@D@@@F@B@B@B@B@BABD@@@A@A@@@@FA@D@@@@@@@F@@@BB@@BABDBB@B@BBAB@B@B@@D@@BBB@@F@@@@HEAABDBAB@@ABBBFBAB@BADABABABC@@@@HF@D@LDJBDBDBAB@B@@AD@@@BB@@@@@@@@@HBBED@@ABBB@@KLBD@@DHBF@DB@@@B@@AD@FABDB@@@BJDDBFDB@@@BBDB@HJBJ@@@@ABA@@B@FCDDB@DA@DJ@@@@BF@F@R@B@R@BAB@@@@AB@D@BA@@BCBBDEA@DGA@@@@@BADBBEWCA@A@C@A@@AAC@A@@AAB@@AA@@@@A@@@@FCBD@@BADCFMDGDBB@BBD@DBBDBC@A@CBAD@@@HKDGDBB@BEDCCCGCACA@@@@AAAACKGCCCCDE@@@@B@@A@A@@XB@@BA@ADAD@@AB@BBBABCDC@@FABAB@DADBB@D@D@B@DB@BDED@F@BAB@@DB@D@@A@@@A@@B@@C@@@AAABA@@@@@@@A@@@AA@@AA@@AEA@@BAEAB@D@@@EABCA@@CA@BA@@@@B@B@A@@AA@BAA@@C@@B@@ABA@@@A@@@AB@@@@A@AB@@@A@@AB@AA@AB@@@B@BA@@@@@AB@BA@@B@@@@@B@@AB@@A@@B@@@@B@@@A@@@BB@@@@A@AB@@@ABBB@A@AB@B@@AA@BA@@BA@@A@@BAA@B@@A@@@@AB@@CB@@@@AD@@@@@B@@A@@B@@CA@@@@BA@@@A@@@@AC@@@@@@@@@E@@GC@@C@@@AC@@AC@@CB@@@@@@E@@@AA@C@@AEA@BMA@AWCB@CA@BIA@C@@BCA@A@@CC@@AI@@@E@@@@@@DC@@@@BD@AB@@@JC@A@A@GBCB@B@@@@C@@AC@@BC@@@@BE@@@@B@@@D@B@DC@@@D@@FF@ALKC@HAF@B@@@BA@@ACA@@C@@BIAAF@@GA@BOEBEEA@@@A@C@@BG@@ICABC@AFABADC@AJE@@BCB@@AC@@IDBDDDA@ABA@AA@@AAAA@AAAC@A@A@C@A@BDA@CBAD@@ABAA@AC@@CC@@C@@B@@EB@@AAA@AAC@@@C@EB@@@@AA@@C@@@AC@@A@@@@A@@@@AB@@AB@@A@A@@B@@AB@@@B@@@HADA@@@@AGA@@AAA@@AA@CCB@A@@@ADACELA@@@@B@@@@C@@@@@A@AABAAA@@@C@C@@A@CBA@@AA@@@AA@DG@@FI@O@A@@FEB@@AB@@A@@@AAA@AA@A@@@AA@AA@EG@@BBB@@AEAACB@@C@AEUAG@@B@@A@@C@CICGCCACAAA@AA"],encodeOffsets:[[122811,32968]]}},{type:"Feature",id:"321204",properties:{name:"姜堰区",cp:[120.127934,32.509155],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ABA@A@E@ABC@@BCBEDG@A@A@@ACACAAAACA@@@@BA@A@@BAA@BC@@D@@@@@@@BAA@BA@@BEFBBADAB@@BBA@@@BB@@@@@D@@B@@@BD@BABC@@BCBADA@ABA@A@@BA@@C@A@@@A@C@K@A@@@C@@A@@AA@@@@A@@BAB@AGB@A@@@@@@A@@@A@@BC@@CA@@@@A@@E@@@@EA@@C@@E@@@@@@@@AC@@B@@@@A@AB@@@@A@@@@A@@A@@C@AB@@@DABI@E@CB@AA@@C@E@@BA@@BBA@JC@@G@@A@@AE@A@AAC@@@A@@AA@@@A@A@I@A@A@@@@@CA@AA@A@@B@B@@A@BB@@@@A@@BE@A@@@A@@@ABE@@@@BAB@@@@AAAB@@@@AB@@A@@@ABA@@@AD@@@B@BE@@@C@A@@AABA@@B@@ABABAB@@A@AD@@A@A@@BAC@@A@@AA@@D@DA@@@@BB@AB@@@B@BAA@B@@@@@D@@AB@@A@@@A@@AA@@@CA@BA@A@BAC@ABA@@@E@AA@@AB@@@AE@CA@@@@@AA@@@@@A@@@@@A@A@CAABAA@@AA@A@@BAA@@A@@C@@BC@@CA@BCABMA@AAAA@C@C@C@BBCBBDB@CB@AA@@BA@@AA@A@@AAB@@A@@@@@@@A@@@C@@@A@@AC@@@A@@EG@@CA@A@@D@@@@@@EB@CA@@@@BA@@@@@A@@BA@@AA@@BA@C@AB@@A@@AA@@AA@@C@@GD@@@@@@A@@BCB@@@@@B@@A@@@BFB@@BA@BBA@BBA@BB@B@DCBA@@@@@C@AE@@@@@D@@CA@A@@@@IB@BA@@AEB@@E@@B@BC@A@@@@BC@@@@A@@A@@@AC@@EDABAAA@CC@@A@@@AB@@A@CAAA@AAAACAGCE@CA@ABE@C@CF@ACAA@C@C@A@CACBA@ABEB@@CDADABAAA@@BC@CB@BAB@@WA@@@B@BA@@@@@CFDDDDLHBDBB@B@@B@BDHDDDCDAFA@CACHGL@@C@AB@D@BADACCAC@AAA@CACHENCDAB@@ACED@@@@@B@@B@@BA@BB@@@BBD@BB@D@B@B@XDAFCAAB@@@@B@CHB@CFAAAD@@ABC@A@@B@@A@ABQ@@DD@FB@B@@BB@@ADEH@BEFDDFDDBLFD@DDHBJDFBDB@@DDDBB@@DD@@BA@CH@@DB@@@@AFADBB@B@B@BCDABG@@B@@HDDDJFBB@@CDEAAB@@E@EFDBDBBBFBHF@@BB@@B@BB@@CBDB@@CHB@@B@BCHAFABABCDBBDBDBEF@HB@BBCD@B@BFB@DBHK@BFA@CDAFBDBHCFADD@@DABAB@@@BABMDABCIAAAGCBEBEBAACGA@AA@B@@@A@AA@@@@A@@@CA@A@ABAD@@ABABE@AB@F@@AB@@CBGBACQQIEGGIKCAACEDAB@D@B@BA@@DAD@BAF@BKAK@@@@E@AI@@BCB@@A@@AABC@@CABA@A@A@@@@D@B@@@B@@C@A@@@BB@B@@CB@@@@G@@@@@K@EBC@G@@@@CE@@@OBA@I@CAE@AAC@CAACCAA@@@AJ@@@FBD@BBD@B@B@DA@CBA@@@@@@@C@A@A@A@A@A@@@A@@@E@CA@AC@A@@@ABA@GFG@E@@B@@BFA@AAABA@@AEBA@ABA@ABA@C@A@@@@@AAGBA@A@ABA@AE@CCGAGCB@@@@@DBDC@@FCBC@@FL@@@@@FF@B@@@@A@@DC@@@@D@@@@BD@B@@@DA@@D@@@BEBBBA@AB@B@D@@A@@BDB@BD@@A@@F@@D@@B@@@EL@B@A@BADCA@B@HAH@@@@A@@@@HD@F@LBB@@@@@FB@FD@@FB@@@BH@@@@@D@@@@LAB@F@D@@@@CFB@C@ADBBAF@D@@@@@AA@@@@@@BAB@AA@@@AJA@AB@@AD@@@@@@@@@@@B@@@BADA@@@B@B@DFAB@AAB@AE@@@AA@@A@@AAB@D@@@AGFAFB@ABE@@B@@BF@B@@@@@@DLB@@@@@@FBD@DA@A@@B@@AD@@@@@@AB@B@@@@FDH@@D@@@NBDB@@@@@BAB@FADCHCDADCDCD@@@BAF@DAJ@@AJ@@ADCDAD@BABAB@BAB@BB@B@@DZ@DB@@DA@@@DPA@@@BD@@AH@@@D@D@@@@@HCDH@@BB@@@@@@@BDA@BFABDHAAC@A@A@ABA@@F@@A@@D@@@B@@@B@@AA@@@@@@@@@B@@A@@@@@@D@@B@@D@BD@@@@BB@@BB@@@DDAB@D@D@B@H@B@D@BABAB@A@B@@@@CD@@BL@BB@B@@H@@@A@BA@@@@B@@AB@@AD@@C@@@AA@@@AAB@D@B@A@@AB@@AA@@@@ADBD@@C@@BBDD@@B@B@B@B@@DB@@@@@@@B@@@DB@BFBABHD@@DB@AF@D@AFH@FAD@@CFB@@@BBADC@ABB@AJ@BD@@@@C@A@GBAFD@@D@@C@@B@B@DA@CAA@@FA@@@@@BBAF@@@@@BB@@BADB@F@@@@CFB@BBB@@@@@B@@DBAF@DD@B@B@BBD@A@BBABBB@@DA@BB@ABB@@BB@FB@@@@@@B@@@@@@BB@@BADFBBAJB@@@@@@BEB@BBB@@ABBD@@AB@@@@DJ@@DB@D@H@AC@@AA@@B@@@FAAAAC@AAA@@B@B@@AD@AA@@DA@@HAAAD@BBF@B@B@@BD@@BD@D@@@@ABA@A@@ACAABA@A@@@ACA@@CBCEAADA@@@ABC@C@@@@DBHBD@@CB@@@B@F@@BFAACF@@@DAB@@AF@@AB@@@B@@EC@@@@@C@@EDADB@@@CB@B@D@AC@@@AA@@CAC@@CB@CA@@A@A@@@AEB@ABC@A@A@@@@BAAABACA@ABAD@@@@A@AB@@A@AB@@A@@@@@@@G@@AA@BA@@@@@@E@AACBA@@@A@@BAME@@CDCAB@@CGA@@@AD@@CBC@@BAB@@@@@@@BGC@@G@AB@BAHAB@BA@AH@@@@BH@@DB@@AD@@B@@@@D@@BH@JB@@@D@B@@D@@@JA@@@DB@@@@@@@@CB@BH@@B@@GB@NB@@@ADABBAB@B@B@@BB@BA@@BA@A@@BB@B@@@D@@DB@B@BE@A@AB@@A@@BC@@BB@BNB@N@DD@L@@@DI@@@@DA@@@B@@FC@@BAD@@@@CB@H@AC@A@@DA@@AGAA@C@E@A@E@@B@BAB@DABA@A@@@ABA@ABA@@D@@@@A@@@AAA@@DADABAD@B@BAB@BABA@A@@@AD@DA@@BAB@@@@@B@BB@@@@BBBB@BBBBBB@D@F@@@B@BB@@@B@BB@BBBADB@AB@@AB@@HD@B@DBB@BA@@DABDAB@DBB@@BA@ABAB@@A@CB@BA@AB@AA@A@@@AA@@ABA@@@@AA@@BA@ABA@A@ABC@ABC@A@@@@AA@A@@@AA@@A@@BA@@BA@@BABAB@@ABA@@@@@A@@CAAA@@@@BA@CB@@@BA@@BE@ABCBCDA@@AC@@@C@@@@CI@E@AACAC@KAE@AAE@C@A@@@AB@@@@A@C@@@A@C@@@ED@@@BAAADE@@@@C@EAC@@@@A@@@@EB@A@A@EAE@A@AB@@AB@B@B@B@DGBABC@CB@@A@ABABC@A@ABA@A@@BAB@@AACBA@@AC@@@A@@BCBA@A@@AA@A@A@CDOBI@A@G@@DADC@@BEBC@@@@@AAE@I@CBE@CBC@C@@BAB@BEC@@BA@BC"],encodeOffsets:[[123170,33185]]}},{type:"Feature",id:"321282",properties:{name:"靖江市",cp:[120.277138,31.982751],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BB@A@BABBB@@B@@D@@A@@JBDAH@AAB@@@HAAADA@@B@BB@@AADA@BB@@@@@@@DA@B@A@B@@@AB@@@B@@@B@@@D@@BFA@@@@BBD@HABA@BB@NAB@@B@D@@B@FC@@@@B@@@B@@DBA@D@@BA@A@@@BB@@C@@@@FCLE@CDABDRGBA@@BADADAB@@@@BFA@@@B@BD@@@@CBA@AB@@@B@@@@@NEB@@@HA@A@@@AB@DA@@B@D@@AL@B@@@DAA@@APCDADAFA@@@@@@B@BI@C@@@AJQBGDG@@EIA@BA\\G^IB@@A@A@GAG@A@@@AA@A@@@@@@AB@@@@@@A@@B@@ADADADA@AD@DCB@BAA@B@@@A@@@@A@@@AA@@A@@AA@AA@ACKYKDgPgRM@kDkAMA[IOCSGi[_UGCIGCCOIIGQIQIKCUEA@EACCOGGCMGKEGAYKA@GCUZE@CAA@@DEBIBBBOD@@BBSFGW@ADM@IXB@FA@CH@BAD@@@B@BBD@B@@BB@@NAAE@A@CB@FBB@B@DAAIDGKAK@oEA@E@CJ@DFRH@JZKDGBAKIBBD@DEBAIC@QBSBCSGB@BI@EBABGDC@CG]iQDKBA@@@GDSH@@aPA@IDGDG@GBIBQBYBM@@@C@MBA@GBIBGBEDGDDHDDDD@BDFLb@B@AB@B@FA@@@@@D@B@BBF@@@@B@@@DDBB@@FBB@@@BB@B@BB@B@@BBDHA@BEBFLDHBDA@@BA@@BDAFNSF@@BFFAAAD@@ABA@DB@AFB@@DA@@@A@@@BB@@A@@@BBBDBB@@@@@@@@FA@FC@BB@BBBBFBD@BF@JC@DBDFAFJBBBABBABBBABB@ABB@B@DBBB@@@@@@B@@@@BFBHDBBB@@A@@BA@@B@B@BAFF@BBAAB@BB@@@B@B@@A@@@@LF@@HBB@RFDBFBBBNBDBJBFBBC@A@A@@A@BC@B@B@BBCD@ABD@@@@@@ABAD@D@B@@@@@BCBBA@D@@DB@@AB@D@@A@@@@@AB@@CDBNBBBABA@ADJBF@BC@@B@@@@@FBAB@@@BB@B@@@B@F@H@BA@@FBA@B@BA@@@@@@B@B@@@BCBBAB@BB@@ABA@@B@B@@@AB@BH@B@B@@@@BD@BBBABBB@DBFBBBB@B@@@@@FBB@FBDEBBD@BBB@BA@@@BDB@AFBAB@B@@AFD@AD@@A@@@FDD@B@BBDBB@@@AB@B@@B@DBJDLF@BDBDB@@B@@A@@@B@@HB@@@AB@@@@@@@ABB@@@BAB@BBB@@B@@ABFDFBBC@@@@DBABDB@@BA@@BABBABB@ABB@DBFB@@BAFDB@CDAB@B@B@BBBBBHD@@@@BB@@@BABABABFB@BB@FDB@BAB@@AF@BAFBBEBBDE@@@@@AEAAA@A@@B@N@@@D@FAHCB@LAF@R@D@D@DBB@HALAJAFA"],encodeOffsets:[[123266,32899]]}},{type:"Feature",id:"321281",properties:{name:"兴化市",cp:[119.852541,32.910459],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCAA@@@DC@CA@B@@B@@BA@@BB@A@C@A@BB@@B@@B@@@DC@@BA@@BA@@@@@ABB@@@G@@@@AAAK@@AC@@D@@A@B@A@ABABC@A@G@A@C@C@A@CB@C@@AA@@AA@@@@ACC@@@@AC@@@@@@@@BA@@@@@@@@@B@@BA@@@A@@@C@@@@BE@@@AB@B@B@BBDGBACEB@ACB@A@@@@@@AA@@CGGD@@@@C@C@@@G@@BC@@A@@OB@C@@CB@@CAY@@CA@A@@BA@E@C@@@A@A@@@A@@AA@@@CB@A@@@AA@A@@BD@@BE@@DABAD@@C@@@CB@@@@@@@@@@@@@B@@B@@@@@@@@B@@@B@B@@@@@@@@@@@@@@@D@@@@@@@@B@B@@@@@@@@B@@@@@@B@@@@@@B@@@@FB@B@D@@@@@@@@@@@@@B@@@@@@@@B@@@@@B@@@@B@B@@@@@@@@@B@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@BC@@FC@G@BBBB@@@@M@@@C@@@@@A@@BA@A@@@@@A@@BD@AF@@@@DDD@@B@B@@GC@@@DA@@BA@BAA@AB@@@B@@CFAH@B@@@@@BE@BJ@@C@ABA@AB@@@@A@@FG@@@@B@@A@@DD@@B@@ADA@AHBBAJ@@HBD@@BD@@FBBB@B@BB@@@BB@D@@DDA@D@BBABDCBBBBBDB@BB@A@@B@D@D@D@HSB@D@@E@@@@@@@@@@@@A@@A@A@@@A@@@BBABA@A@EA@B@@AB@@BEAAA@CAA@C@@CC@@B@@AB@@@@AFA@@@@@@@@A@@A@@@B@ABA@@B@B@BF@ENDB@@@@AD@BDBB@ABC@@DD@@@@BA@@@A@@@@@@@BDB@AB@@@@@@@@B@@BABA@A@@BA@ABC@C@E@C@A@A@C@A@CBC@@@@@@A@AB@A@@@@BACC@@@@@A@@@@BA@BB@B@@I@C@GD@@@B@B@@@D@@B@B@@FA@ABFND@@F@@B@@EB@B@@@B@@BB@@FADC@@@@PBFEAA@CA@DEA@@@B@B@DB@@DD@@B@@@B@DB@@BDABB@B@@B@@@BB@@B@@BB@BBB@@BC@CA@@AFB@ADGA@DHBDBCFB@A@@@AH@@@@AD@@CA@@AD@@C@@@AB@@@DA@@B@@@@@@B@@@@DB@@D@@B@ABIBB@@B@@C@AD@BCFAD@B@@AD@BAHJB@@@B@@@@@B@BD@@BDB@@AB@DBB@BGA@AA@@FE@@F@@@D@@S@@A@C@@@@C@CBC@C@@BA@E@@A@C@@C@@G@@EA@AA@@A@@@@@@AB@@K@AA@@K@@@@@AAKA@DAB@BKA@@@AAABAA@A@@BA@@BC@@C@@@@C@@DA@AC@@@DE@@CA@@B@BB@@BB@@F@@@DAFC@AHL@@@@@BD@B@BA@AD@@@FA@C@C@@@ADA@@@@@@D@@A@BF@@B@@@DHB@@B@@@@@B@B@@AB@@GBA@@AC@A@@@A@@D@@C@A@GBBBDA@@D@@@@BEBA@@BBB@ABBKD@@@FE@@B@BA@C@@FD@@FH@B@@BAD@@G@@BAB@@@BC@@D@@@@B@@FF@@BLBAB@B@@@@@@@@B@D@@D@@A@BD@B@N@@@BA@@AC@@BB@@BC@@@@FH@B@@@BD@@B@@DFABJ@@B@@@BFFBAB@@@D@@@@@BADAD@@ABA@C@C@@@AAA@C@AAC@@@CBAF@B@@@D@@@@CA@H@@CA@B@@@@BFDB@B@@@DC@@@AB@B@DBBBA@@@@@D@@B@B@@@B@BADB@B@@AB@@@B@B@@AA@@A@@@@DAB@B@@B@@@B@@DF@AN@B@@ME@@@@@@@@KA@BA@@@@@@J@DBFBHBDCFBB@@@B@BA@CFAAA@@NL@@@B@BL@@FD@@KFA@@AA@@@@@@@C@@@E@@@C@A@@B@@I@@@A@@@A@@BEFAD@@@@C@@@EC@@A@@@AFELAA@@A@AA@@AB@B@B@@@@C@@@@BB@@BA@@BCA@@@F@@@@A@B@@BC@@D@@@@@@@@@D@@B@@F@BA@@@A@@@@@CA@BAAAJA@A@@@AB@D@BB@B@B@DBD@D@@@@B@@A@@B@@A@BFA@A@B@ABFDEDBJ@@C@CA@@@FFRB@@F@FEA@@@H@@B@H@@@D@@B@B@BNDDA@F@F@@BBDB@@BBBB@B@@HD@@A@@B@B@@FA@@@@@@@@@BADAB@@B@HBBDBD@@@@B@BAA@@AB@@C@@B@B@BB@B@BD@@@@DB@@@BB@BDB@@@@DBBBCB@BDD@@AB@BDHBDCBCBBD@@@FD@@@@B@BB@AB@BBDFEAAABAAD@BABBFCN@@BDB@B@@@@BB@@@B@@@DC@A@@@@@BBBDA@MAKC@@@@A@DF@BA@@DF@BAF@@ABBB@@@@@FAF@DBB@ACB@B@B@BABH@@HAH@@KJ@D@B@F@@KN@B@@A@A@@@@A@A@@A@@CACBCBA@@G@@@@AA@E@@@C@AAA@@AG@@@@@CAEB@@@@C@C@A@@AAB@@@@A@CB@JED@B@D@B@DD@@B@B@JCAEAECBAGAAD@B@@@@@F@@@BB@@@@FA@@@@@@@ACCA@@AA@CBAAA@@AA@@C@E@@A@A@@C@@DB@@BC@@@AC@E@C@C@@A@@B@BA@@@@BA@A@A@@@@B@B@AA@@@@@A@@BA@@@@BBBAB@B@@@AC@@@@@ABCD@@A@@A@@@BEJ@DBB@B@@ABB@CB@@A@@D@D@B@B@@@B@@@F@@AB@@B@B@@BBD@B@@@AAB@BBABB@@@B@BB@B@@B@@BDB@@@CB@@D@@BB@@B@@@@@D@B@DB@BB@@@BABBFBB@FDHBFDHB@@BC@@BB@@D@DBB@P@FA@@BF@D@BB@FCB@DF@@@@@@B@NBJBD@@@DBB@DAJBB@@@@BC@@BD@@@B@B@B@@@BA@@@@@CC@BALA@@@B@@B@B@@A@A@A@AB@@AAACILCFADJB@B@F@@D@@ABB@ADD@@C@ADBABD@@@B@B@BBDA@BHD@@BABAD@D@FADB@@F@B@D@B@HBD@B@JDHBB@FBFB@BB@B@BAD@JAF@FALAD@DAD@HAB@F@D@@@@@B@BB@@B@@@BCBBBA@@B@B@BABBBD@@A@@BB@F@D@B@FBBBB@DB@BHB@BB@BBB@@@@@@C@AB@@@FBB@D@BG@@BB@FB@F@B@FBF@HBB@DBD@BBHBZD@@FDBB@@B@DBBBHFB@JFHDFDFBDDFBB@JCPALED@PE@@@@FCFAFAD@BAB@DAHCLCXEDAFAHCJADAF@JCJAJCDAJCPEHA@@FCF@PE@@@@B@BA@@D@F@LAH@@@D@DAB@F@F@NAB@@@@B@@B@B@DAHCDAB@B@B@@@B@DB@@D@D@J@F@H@J@JAD@D@DAB@DA@@@@@@@AAA@A@AAA@C@CAC@AAA@A@A@@AC@A@@AC@A@A@@AA@A@A@@@ADA@@@@BA@CBC@@@A@ABIAA@CAC@@AC@A@@@ABCBAB@@ABA@CBA@@BCB@FABAHE@A@@@@@@@AA@@@@@AC@@B@FAB@@A@@B@@A@A@A@ABA@ABC@E@A@@@ABA@@@A@@AA@A@AAK@@@C@A@E@CAC@CAA@@C@@A@@D@@G@C@C@A@A@C@A@E@ABCBB@@FD@BFDBB@C@AAAKEAA@@@IBABA@ABBJB@@@@AEE@@AA@@A@AAA@CACAC@CAAAGDA@CDAA@GB@@@AABCG@@CEEE@A@A@ABABA@C@EAE@@@@@AC@CAA@ABCB@@ABA@C@C@EB@C@@@A@A@@B@D@@CGB@AHA@A@@@AB@BAB@JAB@AC@AAAAA@@@@ACBE@CA@@A@@CCA@CCEAAAC@@@CB@@@@AB@@@@@B@B@@A@AG@A@@C@@@AAC@SBKB@@@BE@@AE@E@GBG@@A@AAD@@@C@A@@BB@@BAC@@EE@@@C@@EAB@A@@@@A@@@A@A@@A@@@C@@AG@@EB@A@@A@GBG@@@@@A@BDA@BBABABBBCB@B@@@@@@A@@@A@A@@@@B@@@@A@@@A@@BA@AAE@@HA@@D@@@@@DA@GBA@G@KB@B@BA@@@@@@CE@I@@A@C@@@BA@@@A@@@A@BE@@@A@A@@@@@A@@B@@A@A@@@@B@@@AA@A@@@@@A@A@@C@@A@@BC@@BA@@BA@AB@AA@@DA@@@AAAD@AC@A@AB@@A@A@@@@A@@CB@@@@@@AB@@ABCB@@ADB@AC@E@@C@@@CA@A@A@AA@ABA@A@@@@BB@@@@@@BA@@A@@C@@@GA@@CA@@CAAB@B@DB@DD@@A@E@AHBB@BB@EB@B@@@@@B@BAB@A@@AB@AAFA@@@@B@@CAAB@BAB@BBB@BBDAB@@B@@B@B@@AA@@AD@@AB@@@@@@A@@B@@A@@@@@@BA@CC@@EB@@A@@@@B@@@D@@A@A@@@CC@CB@AC@@A@@ABA@@CA@@@@AA@@@@@C@@@A@@BA@@@@AA@@@@@EABA@A@@BA@ACB@AC@@AB@@@BA@@@@@@DA@@@A@@@@@@@E@AAC@@A@@AC@CBCBA@CB@A@@A@@@@A@AB@EE@@B@DA@A@C@@@AD@F@@CB@DB@AA@@EAA@A@@@A@AB@@@A@@A@@@CC@@AA@BA@A@@A@EABE@@A@AB@@@@@@A@BAC@@@A@@BA@@BC@AB@@@@A@@@@A@@@@A@@@A@@@@FEBEB@A@@@AC@A@@@@@@AC@@@A@A@@EE@@@@@@A@@HAAEH@BA@@BB@@@A@@@@@A@@@@BA@@FE@@@AA@@@AAA@DCAA@BCCA@AAA@AAABACA@@CABAGA@@A@@DAA@D@@@@@@AD@B@@BFAB@@@@@D@@AD@@@@@@A@AB@@BB@@@B@BB@C@@B@@@@@B@@AB@@BB@@AB@@AB@B@DABA@@@AD@@@DB@BB@BA@@B@ABB@@@AB@@B@@@@@ABBB@@@B@@B@BB@BAB@@AB@@@B@BF@@F@@DBB@BABABBF@@BDB@ADB@@@@@@B@@ADBBA@@@@@BBBBAAAD@D@@@BB@B@@BBB@@@@AB@BCBBBC@@BCBA@@@C@@@AB@B@B@BCBBB@@ACABADADAAAA@AA@BBBA@A@AAACEBA@ACA@BADABD@AB@@AA@@AA@@CA@@@@@E@CB@CB@@C@@A@G@ABAAB@AA@@@BA@AAAAAEGB@CA@@AE@ACD@B@@@BA@@@@@CB@ACAMACE@@CC@@DA@@@AE@@A@AA@@@B@BAAE@AD@@A@@@@BA@A@@@A@C@@@@AE@@A@A@@AA@@BA@@BC@@A@@A@@@A@A@A@C@G@AGA@@@@BCEAA@@@@A@@CB@C@@ABA@A@AAAB@@AAA@@B@@ECA@@@@A@AA@A@C@@CA@@AB@@EB@@@@CBA@AG@ACB@@@AA@@@@AAC@@A@AA@@@A@@@AD@@CAA@C@@C@AE@A@@@C@A@@@E@AEF@@A@@CAA@BC@AIA@A@@A@A@@@BC@A@ACBA@@E@AC@A@C@@@@AE@@BA@CB@@E@BDEB@AE@A@@@A@@DC@GACA@@@@@DAD@B@@CBBBDFDA@@DB@B@@@BABBBBD@@@BAB@B@@C@C@@AC@@AA@A@E@AAC@BBGB@@CB@@BBC@@BA@A@@@BB@BBDBBEB@@A@@@BB@@BDG@C@A@@CI@@C@@A@@BC@AA@BA@AAA@AF@@@@@@IAABEABC@AA@@A@@@@A@@@@@@@EAA@@AA@BAA@@ACB@@AABAAAB@C@AAA@A@C@@CBECA@@@A@@@@AA@AEA@D@@E@A@BC@AA@@A@@@@BEAA@@@@B@@EB@DBB@@C@A@AD@@@@CC@BEHAB@D@@@@@ACI@@BAA@BCDAB@A@@EA@DC@EBG@BEC@E@@BCA@@GCBAEA@ACA@@A@@@@@@@A@@CA@A@A@A@@@"],encodeOffsets:[[122880,33451]]}},{type:"Feature",id:"321283",properties:{name:"泰兴市",cp:[120.051743,32.171853],childNum:1},geometry:{type:"Polygon",coordinates:["@@KaCE@ACCCCCGA@YXADEFMNKLEHCDCDIJKRABEHCFEHKPABABGL@@OXEBCBABAB@BEHM^@@AHALADCNAF@DEN@DAF@DCHGPADEPABCNRXFJDFDHB@EBBB@BNPDL@BBBFH@BBBB@BBBDDDDHDJD@@@@BA@@@BHFV@B@DA@BDFB@BA@AA@@FHB@@BBB@@B@B@@BBB@B@@@BA@@BA@EF@@@B@PEJ@@CHB@@B@@BB@@AB@D@BD@D@@@B@BBBA@B@B@@@@@D@@A@@@@@KBDFCB@B@@@BDA@DBB@@BB@BB@BH@@@@CBGB@@A@@@A@@BA@@@@B@BA@@BA@@B@@B@@@@@@BD@@B@@@DB@@B@@A@@F@D@@BD@BBB@BA@@FA@@@@DD@@DD@@BBBBA@@BCDAB@ACB@D@B@B@D@BB@BBBBB@@BBB@BAB@CCACJC@@BD@@DA@AF@BID@BCBABED@BAJD@@AH@@@D@B@@FBAFPF@AHB@@BEJB@AD@@@DB@BB@@A@@@ABE@GLDBKE@@EC@@@D@@C@A@C@@@A@@F@@A@@D@@AD@@BD@@@@@@ADAHAB@B@D@@I@@BAC@@A@@D@@C@@@@F@@@J@@BD@@DB@B@AD@@@DJB@ADBA@XD@BNB@AFB@BD@B@@B@@@F@@@@A@@DD@@BD@@B@@@DD@@HF@@@@@@@@@D@@B@@B@@@B@@A@@B@@DA@@@@BA@@@@@C@@B@@A@@DA@@B@@B@@@@ABB@AB@@@AB@@ABB@@BA@A@@B@BAABA@@A@@B@B@@A@@A@@@B@@@A@@A@@@@BA@@BA@@@@@A@@@ABA@@B@@@@ABA@@@A@@BBBA@@BB@@@A@@B@B@@A@@B@@@B@@AB@BA@@@@DB@ABB@@BB@A@A@@@@@ABB@@DB@ADFB@@C@A@FBAB@@FB@BB@@BB@@B@@@B@@@@@@ABBB@B@@@DA@@@@B@@@BC@A@DFBHBDBB@BBBDBB@@@BA@@B@@@DDB@BBBAFC@@BD@@B@@@@B@@D@@A@@B@D@@A@AF@@@FA@BB@@AJA@@@@@BDB@@@C@@@@BFD@@@@@B@DA@C@AAAB@AAB@AAB@@AA@AE@@B@@@@A@@@@DA@AB@@@@@@@HC@@@DB@@BB@@BB@@@BAD@B@@AB@@BB@@AB@@@@@B@@A@@B@@DFA@@@@@@@CB@B@@DH@@FB@@@D@@BB@@@D@@@B@@@@@@@B@@@BA@BB@B@@BB@@AB@@BDAA@ACDAAAD@D@D@B@BB@BNBBAADB@@DD@@AD@@@@BB@AB@@@BBB@@BBBADBB@B@@@@@B@@@@@B@@B@@@@DBF@@B@@BA@@BBF@@@B@BAD@ABB@B@@ADB@@B@@BB@@@B@@@BA@@@C@@@@@ABB@A@A@@BAA@@A@@B@@C@CB@@BB@@@BD@AB@B@@@BCB@@@BABABA@@@AB@BA@BB@D@@@F@@A@A@@BC@@B@BA@@B@@@BA@@@@BABB@@@@BA@A@@F@BA@@B@@@B@F@@AB@@@@@AAB@@@@A@AB@B@@BDB@@@@B@B@J@B@B@@@B@@BB@@@D@BBB@F@@BB@@@@HD@@IAB@AB@@AF@D@@@BBA@@D@FAJCB@@A@@B@DB@@@@B@@B@@@@@BAB@@@@@@AD@@B@@@@@@F@@@@DB@@F@@F@@@@B@@B@@DD@@AB@@@B@@@@@@@@BHA@BBA@AB@@@@@BB@@@BD@@@B@L@D@B@@@B@D@B@B@@@D@BB@@@@@B@@@B@@DB@BD@B@B@BBBB@@BA@@D@BBD@@@B@BBDB@B@@B@B@@@DBBBD@B@FBB@BD@@D@@ABB@BD@BBBAB@@@AA@A@@@C@A@A@AB@@A@@@ABAB@@@@@BB@A@@B@B@B@@AA@@CB@@@AA@@@A@@A@@@@A@@CADACAB@AA@@@A@ABB@AA@@@@@AB@@@@AA@B@@AA@@A@@@E@@@@A@@@AB@AAB@BA@A@@@C@A@AA@@@EABA@@@@@@@AB@@@@B@@BA@@@@@@@@@@@@@@@BB@ABB@@AB@@A@@ABBCC@C@@@@AAA@@BA@@@AB@@A@@@A@C@A@@B@BB@ABBABB@@B@@BA@@@@DA@@@A@AAA@@@@A@@@@AB@@AB@BA@@@@AA@@@A@C@A@@@AB@@@@@B@@@BA@@BAB@@AA@@AB@@@@@B@@@@@@AB@BAB@ABB@@@@@@A@@@@@@@ABA@@@AB@BB@A@@B@@@@A@@@@@@@@@@BAAAB@A@AA@@BAA@@A@@@BA@@A@@AAA@CA@AC@BABBBEE@@BAA@@AA@DC@@@A@A@A@@AB@@@A@@CAA@@A@BC@ABA@A@ABC@A@EAE@@@EAIAC@@@@B@@B@@@BB@@AB@@A@@@A@@@AA@@A@@@@A@BA@BBC@@@AA@@@AA@@CA@AA@@@ACB@BC@ABA@A@EBC@@C@@AAA@@@@@@A@BEDE@@@@@AAADAB@@@B@@@BA@@@ADAA@BABBBAB@@@BABBBABB@AB@@ABB@@BAAAAB@@FE@@BABCB@BAAAB@@@BCA@@@A@@@@@B@@@@A@@A@BAB@DA@ABE@AABCA@@@A@CA@@@@AD@@AAC@@@CA@C@@A@AACB@D@@AE@AA@@AABAE@@@BCC@@AA@@@@C@CA@@AD@ACA@@@CEBAAACBAA@@A@AGB@B@CG@@C@ACAEACA@@CA@BCCAB@A@@@@A@@@@@A@@BA@@BAB@@A@@BA@@AA@@BAAAA@BAB@@AEAA@@AB@@ADEB@BC@BB@@C@@@@@A@@D@@AB@@@@A@@@A@@AA@@BAA@@A@@@B@A@@A@BCB@B@@@@ABAD@@EC@BA@AC@BIC@C@Q@E@KBA@GDEBC@@@M@A@@@@BBBFB@B@@@@CFAAAFEAABE@@BA@ABA@ECA@@AEABABABA@A@@AA@@@@GCAAAA@A@A@ABADCA@ECAB@@EACAA@BAA@BAAAAB@@AB@@CABACA@@@@ADEAECBA@@@AA@AAA@AB@@A@BA@@@@@@A@@B@@GA@@@A@@@BA@@@CACA@AKEICCAA@@@@ABA@@A@CAAAA@C@EC@@B@@@BCC@BE@@@ABAEA@BCA@A@@ABA@AAC@AACFEAA@EA@@@@A@A@AAEACAA@AAABAAC@@A@@A@A@G@@ABA@@A@A@@@AB@BA@@ABAAAAD@@A@A@@@@@@@ABA@B@EA@@ABG@E@A@@@A@A@@A@@BAEA@@@@A@@@ADE@IABCB@BAAAMACA@DA@@B@@@@@BC@A@@BA@@CC@B@AAAD@@@@A@C@C@AB@B@@@@C@BAC@AD@A@A@AADB@@@@B@BADEAIACAMAAAEACAQEA@GA@@KE@@@@@BA@A@@@A@@ABAAB@AEEABA@A@@@AB@@@BA@AAGCEA@A@@A@@@@@@@AACAA@A@BAA@BAAABAAAABAAEIEBAC@CIDE@@AACAEAA@AAAD@@EEB@@@@@@@@AAACAA@@B@@@AA@@B@@@B@@CA@BEA@@CAB@BC@BBEBAE@@TEEMCB@AB@@AB@ACCGEKFA@AGBAC@AA@A@@A@AAA@@A@EA@@AACC@@A@@@@@AE@A@A@C@@@@EBA@A@@B@A"],encodeOffsets:[[122915,32765]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
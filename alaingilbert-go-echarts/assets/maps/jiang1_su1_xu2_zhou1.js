@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("徐州",{type:"FeatureCollection",features:[{type:"Feature",id:"320302",properties:{name:"鼓楼区",cp:[117.185576,34.288646],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@A@ABCA@BCB@DD@AB@B@DA@@@ABABAAEA@BAFAAA@E@@B@BC@BLG@@BFDABB@FDBB@HF@BCBB@A@@DD@@@BB@ABCJC@@BJFBEF@RB@@DKTBF@@DF@T@@@B@L@@HJ@@GA@BI@A@@A@@A@@BA@@@@BA@@B@@ABB@@BC@@B@BC@BBEB@@@@@CAAAAAA@CAA@A@ECC@GCCAAAAHC@GAC@I@CAAA@AAA@@@@@ADBB@DBB@@@@CD@BBDBA@B@DBBAA@@@AA@CA@@@@CAA@@@A@@ABA@@AA@AAEA@AC@ICA@@A@C@BA@@AA@A@@@A@AAA@ABBDA@@@AB@@AB"],["@@FB@ABB@@FAH@FAN@DABD@D@@@@FBD@B@@@B@@@@AHAB@@@@@BAD@@AB@@A@@B@@@@A@@FAFAB@BB@@B@@@XDH@@A@@BA@@BAB@DC@A@ABCBC@ADA@@A@A@@AA@EBAAEA@BCBEBBDIDAC@@A@AAA@A@A@A@@@A@AAACBKG@BE@A@@@AB@B@B@@AB@B@B@AABCF@@@@A@@CA@CA@@ABGIAHC@@A@@ABADA@@EA@BC@@A@C@@@A@@A@A@A@AAABAB@@@@A@@@@@@@AACAAA@BA@@@@@@B@B@B@BA@@@@@AAC@@A@@A@A@AA@A@@@AEA@@A@A@A@@AA@@A@AB@B@@A@@@CAB@@E@@@@@AACAA@@BAA@BAB@@A@@@A@@BA@A@A@AAAB@@@@@CM@A@A@A@G@E@@A@ABI@AIA@@E@A@G@K@@DAH@@@BG@EBA@C@KBA@@@KBM@@BBDC@C@KAC@G@CA@H@DD@@@@@@B@@@@BBB@D@@BB@@B@@B@@@@@@D@BB@JDD@@BFBBBB@@BB@BA@@@B@@BB@D@@B@@BB@B@@AB@B@@B@@@B@@BBB@BBDBHDD@FDB@B@DBB@BBBBDB@@@@A@AF@AADA@@@AD@@AA@BA@@@AB@@@@AB@@@BB@@@@BAJB@@HAF@@B@FB@B@@F@BBAFH@B@@H@@DABBB@DBJFBB@BBBB@@@H@DBBG@CE@BAC@@@A@@AB@@E@@ABAAC@@@@AB@@AF@HBBCA@BC@@@@@C@@@@"],["@@AJJ@@I@@C@C@"]],encodeOffsets:[[[119950,35112]],[[120028,35155]],[[120093,35108]]]}},{type:"Feature",id:"320303",properties:{name:"云龙区",cp:[117.251076,34.253164],childNum:1},geometry:{type:"Polygon",coordinates:["@@CI@AEB@CBA@AAC@@EAACCA@@A@GB@@A@IBC@IB@B@DBB@BAAABC@EBC@@@A@@@@D@@@@E@ABC@E@AB@DADO@@@SB@BE@C@ABA@@CA@@AAB@A@AA@@BEAA@@@KA@@@@A@@F@@C@@BC@@@C@@FA@@DE@@BC@G@@@AD@BBJA@@BB@A@@BA@@B@BA@A@@@@AE@ADA@C@@@A@ABA@@BAB@B@@BBB@DBBBDDBDBBB@BB@@@@BB@BB@@B@@@A@@@AA@BA@BBB@@@@BBB@B@@@@@A@@B@@@@A@@AA@@@BB@B@@A@@BDDBA@B@@@BBB@B@@ABA@@@A@@@A@BBE@AB@B@@@@@BJBBB@@CF@BC@BBD@AB@@ABHAEDEDEDEN@DAB@D@DCDCBIFIF@@A@CBA@@BCBCBA@@BA@@BA@@@@AE@EA@BIAA@A@@@AB@@AACEADAF@B@@@@FFBDBB@B@H@@@J@BL@H@B@F@@@JB@BAJ@B@BF@H@B@B@B@N@@D@@@@BABBB@B@B@@AB@@@B@@@BA@ABB@AB@DBBB@@@@F@@@BA@D@@@BA@A@@B@BB@@BB@B@B@@@FB@B@@@BBBB@B@@@@BD@BB@@@@B@@A@A@A@A@@@@B@@ABBDBBB@@@@@@B@@@@@BABABBB@B@B@@AA@A@@CB@@@CA@@CA@AB@@@F@BBBEBK@@B@@CA@@@BEBGD@JB@CT@D@BFF@F@@BBC@G@EH@@AB@@AB@BCFB@A@CA@@CD@H@AA@CHA@@B@@B@@H@@E@@@GA@@C@A@CB@@@@AAABAAI@@@@@E@E@K@E@A@@@CL@AKHAF@CKFACED@@@B@B@B@BA@@D@BAD@B@B@B@BBB@B@F@@C@@BAB@@AFACE@AHC@@AE@@C@@ACE@@A@@@A@AAACAAAAAGB@@@@AAC@CCB@@@G"],encodeOffsets:[[120152,34974]]}},{type:"Feature",id:"320305",properties:{name:"贾汪区",cp:[117.464958,34.436936],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@A@CLA@I@ADEPFBBMB@@PAD@DDF@LL@@FEBFDFFJLDBABEB@B@@@@A@BB@@@@@@@@@BB@@@@@ABB@A@@BFH@@@B@@A@@BB@@@AB@BDFA@BBCB@@DABBB@@DB@FA@@@AH@@A@@@CDAF@@B@@DA@@@A@@B@@@B@B@@@@@B@@A@A@@@@B@@@DA@@@@BA@@B@B@@@@BBF@@@@@B@DB@@@B@@B@BA@@@BJAB@@B@@B@BD@D@@B@B@@@@@@B@@B@BD@@A@@@A@@B@@A@@PFDBB@B@@@@@@B@@@@B@@@@@BA@@@@@@B@@@BBJA@@FDBBBBB@@@@@E@@@@@@@@B@@A@A@@@B@B@@@@@C@@B@@D@@@@@@@C@ABADA@A@ABGFCB@@B@@@@B@@@@BB@@@@ABAA@BA@@BB@@@@@@B@@EA@BAB@@@@BB@BBBA@@@@@@@@B@@@@CB@BDB@@CD@BCA@@@@B@@BA@@@@@A@@@DB@@@@@@@@CB@@A@@@@A@BC@A@A@@BCH@@B@ABBB@@EA@BAA@@A@@@BBA@@DBB@B@BFDFD@@@@BA@@@B@@B@@AB@@@@B@@@@@D@@B@@DB@@@@@@BB@@@@@D@@B@@@@FBABB@B@@BA@ABB@@@@B@@@@C@@@A@@BA@@@@BA@@@A@@@@@A@@@AD@@@@C@@@B@@BA@@@AB@@AB@BADBB@@A@BB@DPD@FABADLBBBNFF@FDFFDBFCFD@@HED@B@JDDBDFDD@BCBFFHCDB@@FB@FBD@@B@BJFAFADABAJABABABA@A@GBG@ABAHEBAJAD@BACEDAJCDCDE@@@@@@@@B@DCFGJMLS@A@@B@F@HBB@F@J@DAFE@M@EBK@@F@B@FJABh@FAJAD@H@DCNAF@HFFHDBF@F@B@FAHCHEBCDC@@DCFAFAH@N@H@DBDHBBPT@@HC@ABA@AFA@C@@BCN@@@B@BA@AD@BED@@AB@B@BDBE@@BDB@@B@CDB@AF@@BJ@B@@BB@BA@CFEFI@CD@B@@HFB@HJ@D@LDJD@AACF@HG@CDKDADAL@JKFBH@LB@FFABEJ@DEAUGCGEDEECQBGBA@ACE@AG@AC@@C@CPIL@ACBA@@@CA@@A@EC@@C@EP@@CD@BA@AA@BIBCD@EI@AA@@CFAAEB@@ACQQD@EA@AA@CG@@IBB@OF@@AHAAG@AIB@@BDE@@BC@@AGBAECHC@CA@FG@@@A@@AC@@EC@CBA@@DI@@C@AE@@A@GM@@@@@@BE@BD@@@DE@ABABKJI@GDA@GAAFCA@JC@AB@BBBE@@DE@@CA@C@@AG@@CAAA@@DGBAC@EK@AEGF@ICABCB@@CC@@GA@BICB@E@CGBBEE@@AO@AEF@CMK@@ACB@CE@A@AECB@DE@AB@BG@@ACAY@@CE@EBAB@DG@@FA@ADCB@B@DC@@DC@AAA@ADYGOCUEICAHC@@CA@ADCAEADGICQEUCABWBMB@@O@G@A@COA@CMBA@B@BD@@C@AB@BC@@E@BCA@BEA@BCC@@AC@@AC@@CE@@@G@@@G@@BAAA@QA@BE@@@iF@BA@AD@EE@@DA@A@@BEBADAAA@BCC@@ADBBGC@@ACAABB@@B@@@@@@@@B@ABB@@@B@@@A@@B@@@@A@@BCA@BAA@DEAAF@BE@@AAAAAA@@BI@BIA@A@@BA@@@@BC@@@AB@BKA@@G@AFAA@@@@A@@@@@A@A@@@E@BAC@@AA@AHO@M@WAI@BI@EC@@@A@@FG@@EU@@A@@@B@@@B@@@D@BD@@AFB@@CBAB@BB@@@GDJBAH@BB@@DDB@@@B@@E@ADBBA@A@A@@BA@A@A@@B@@@BAFH@ALBDBBB@@@B@B@B@B@BBB@@@BDJCACFADA@AFBBBFAB@@BB@B@@@CB@BADAD@B@BCD@BBD@DCBBB@@@D@@ABD@D@@DB@BAJ@@@@FJ@@H@@@D@@B@B@@@@D@DBBB@@@B@@B@DA@@BC@@@@D"],["@@BGB@BCC@@ADB@EBA@AC@CABAF@B@D@ABH@@AC@@AA@@AA@@EA@A@@AC@@CE@BA@@@AB@@CB@@AA@@@A@@AC@@@A@@A@@@AA@@@C@A@B@FI@@A@E@E@@AK@EABCUA@@E@A@BMBB@A@@CAEABCECOEKCFIKC@A@@BCGAC@@@@C@@@AA@CD@ABA@@A@@@A@BA@@@CG@@AA@ABA@BEDGAA@ABC@A@AAAAC@@A@@AB@BCB@BADD@BDBDAB@@A@@@@@AA@@AECE@A@A@AAA@A@A@C@ABC@@@ABA@A@A@@@C@DFEBDLE@GBBLK@@D@@@B@F@L@F@F@@@@BJABBB@B@@A@@D@B@DB@@H@@@FG@@@@AA@@@GB@DBBG@C@@DB@@D@BEAADA@@BA@@BG@@F@HAD@AE@E@AEC@S@@DIAC@AHAF@@B@@DA@@@ALAFAAE@@@A@@BDB@@DB@@A@@DB@B@@@@BV@@FH@@EB@@@D@@FD@D@@@@JXBN@P@BGB@@BD@ABF@@@B@B@@@@@B@@@@@BBBEH@@@LB@ABA@@D@@A@@B@@AB@B@AJJ@@AB@BBBB@BF@@ABEFB@CBB@ADB@AB@@@@@@AB@@@A@@@A@BAA@@@@@@@@@@AA@BADB@BD@AHCA@BD@ADB@BBBCFA@AB@B@@CF@@FBCB@@AjE@@F@@ARBB@BB@AH@@@"]],encodeOffsets:[[[120114,35181]],[[120222,35105]]]}},{type:"Feature",id:"320311",properties:{name:"泉山区",cp:[117.194469,34.225522],childNum:8},geometry:{type:"MultiPolygon",coordinates:[["@@@CC@AAA@@AU@@@@BC@@@EA@BC@@@C@A@AC@@ABA@ACA@@B@BC@A@@B@FA@@@EB@BA@@@@D@D@@A@@CC@@DA@@@E@A@A@CA@@A@@A@AB@H@BAB@@AAE@A@@K@@BAA@AECABE@@@@B@@A@BDBDABBB@B@B@@C@@@@AA@@CCCC@AAAABAE@AB@@BFABAB@B@@@@@B@@BB@DBBA@CBA@AB@B@@BB@BCBAAABI@@AEB@A@B@@@@@BCBA@CAECACCAEDCBGHCDAD@BDBDBEBDFA@A@@BA@CBC@GB@DABAB@@@BB@@@@B@@@BB@DDB@@F@DB@D@@@@J@@C@@DA@C@AA@@E@G@CCCACCGEA@@AA@@B@B@@ABCBBFEDBDDB@@HFB@@LF@@BBDA@@FIF@@@CE@@B@@@@@@A@@AAB@@@@@B@@@B@@C@A@@FB@@DD@B@@@B@@A@@F@BBB@@BB@@@@@@@F@@@BHA@BD@BC@B@A@AB@@@D@@B@@BB@@@A@@BA@@BD@@@C@@@B@B@@@C@@BD@B@B@@@@@@@@@C@AD@@@CA@AB@@@BC@@B@@@@@D@@@@AA@B@@@BAB@CC@@BABAC@@C@@B@BC@@AE@@@D@@@BA@@@@E@A@@AA@@@@BB@@BC@@DD@CFE@A@@@@B@@@@A@@@@D@@C@@@EB@@@B@@C@@D@@BB@@@@@B@@C@@@@BD@@@@BDA@D@BB@BB@BB@@BA@@@@@@BB@@DD@@D@@A@@@@@A@@@@B@@D@@DAB@@AD@@B@@B@@@J@@@B@@@BBA@@B@B@@@B@AB@BABD@@DD@@@@DBD@B@B@B@@B@B@@ABAB@@DD@A@BBD@AHF@B@@@@@@AB@@@BA@@@@D@BC@@B@BBDEDBBA@@@BB@ABFBABABB@D@F@BAC@@ABA@BB@@A@@B@@AA@@A@@A@AAA@A@A@@@B@@@@@@@@@@@@CB@BCBAPBR@@CB@BA@@B@@BDA@AB@BCB@@IKIIE@AD@DIBAA@@A@@CC@@@BAAADE@@GAAECA@BAEC@AH@AKD@@A@AF@B@BBBE@AFBBBBABA@@B@@C@ABAC@@CDA@ADBBAB@BA@@BA@@B@ACBAB@BBB@@@B@B@@BB@@A@@@@A@@@@AA@@AC@A@AA@@@@@A@@@@C@@C@GDBH@D@LBD@D@AC@AN@LA@@B@LAD@B@FAH@@A@@BG@C@A@I@@@G@AAAACEE@@@@@ABEBCDFBB@@BA@@B@B@JB@AFBF@@B@@B@@AB@@AB@DADA@AB@DAB@@@JEJEDADC@C@CBA@CFMFCFCFCGBBA@@BAC@AAD@@ADE@@AAIAIA"],["@@BA@@@@@@@A@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@A@@@@@@@@@@@BA@@@A@@@@@@@@C@@@@@@@A@@@@A@@@@@@A@@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@@@BB@@@@@@@@@@@@@@@@@B@@@@@@@@B@@BA@@@@@@@A@@@@B@F@@@@B@@@D@@@@@@@@@B@@@@@@@@@@B@B@@@@@@@@B@@@@@@@"],["@@@@@@@AB@@@@@AA@@@@A@@@A@@A@@@@A@@@@@@B@@@@@@@B@@@@@@@@@@BB@@B@@@@@@@B@@@@@@@"],["@@@A@@@@@@@@A@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@"],["@@@@@@@@@@@BA@@@@@@@@@B@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@AA@@@@@"],["@@B@@@@@@@@A@@@@@@@@C@A@@@@@@@@B@@@@@@@@B@@@@@@@@@@@B@"],["@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@B@"],["@@@@A@@@@@@B@D@@@@D@@@@@@@@A@@@@@C@@@@@A@@A@@B@@@@@@@@"]],encodeOffsets:[[[120039,35025]],[[119944,35182]],[[119897,35121]],[[119882,35118]],[[119915,35178]],[[119932,35178]],[[119938,35189]],[[119946,35188]]]}},{type:"Feature",id:"320321",properties:{name:"丰县",cp:[116.59539,34.693906],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@AED@@@CAB@E@BIA@@ABAAA@C@@AA@@@@@@B@AA@@A@@A@@@@AA@@A@@@@@@CA@@@A@@C@@@@@CCCEF@@CDCDCDMHGFABCH@@CF@@CBABCBA@A@@@AB@@BF@D@D@BCFAD@DADADABED@BSNGDABEBCB@@EB@@EBGB@@KB]FABS@SAC@qB@@]@CBA@GDEBA@C@CBCBCDEBCBGFIBABKBC@@@CAOBA@A@AAA@C@EBI@C@I@C@C@C@C@G@C@E@CBCDCB@B@BBDHBF@LEJAJ@B@@BFBB@@@@B@@DH@@AB@B@DAB@BAB@D@BA@@BABC@A@A@C@A@ABCDCBABCBA@CBCDCBCBCDEDGFAB@BADEBEFEFGFCBEBA@E@E@E@C@CBEBGDEDEFA@ABCBEBCDA@ABABGFABCBEFCDA@AFAF@F@DBD@B@DBFCFEFCDEDCFCDBF@DAB@FADGFEAC@G@G@CBC@GDE@CBCBCDEBEDGDADADAFBD@D@@@H@FAFADADEBE@C@E@CAE@EACACAEACCCACAICCACAIACCEBE@CBGD@@EFADCD@F@@DFFFFDBD@@BDDBDH@BBF@D@FAD@D@D@@@DFHDDBBFDBDDDBDBDBD@FAHCDEDGDE@ABA@GDCBC@GBEBCDBDBDBF@DBDBFAF@DBFBDDD@@DBF@FDHBF@N@B@F@@@F@FDDBBF@D@D@D@B@H@J@F@B@@@B@D@FBD@D@D@F@D@D@B@FBH@F@D@F@B@D@DBD@F@@DF@F@F@F@H@DAD@D@F@B@B@@@B@B@@B@@BBB@@B@DB@DHJE@BF@@BB@DF@HBBDBBB@AAD@BDBA@BCB@@DDFA@@CBBBBB@BDDBDDDB@@@BB@@DDBADF@@BDB@ACF@@@C@BFA@@@@@@DA@@FB@@AL@@BB@H@@F@@@DB@LAA@DA@@JAB@@DFA@DB@@CA@@AH@@AH@B@@DB@@BD@BBR@J@D@@@@BB@BHH@BBTCBBGB@BLAF@@E@@JBTB@@HB@BBBD@DB@D@@D@@CD@@@HAD@D@@@DBNBXFAHB@D@@CD@BB@@HB@AFBB@HBNB@BBBB@D@BA@BB@BB@@BB@@BBFC@DBD@@B@@FHDBFE@BFBBBABB@@BDFAD@DABB@@BDHCDC@AB@@CD@@CF@B@@ANCH@B@D@@F@@@BB@@@H@@@B@@@P@H@BHB@@DB@@@H@B@@CLA@AB@@AB@@AJ@B@D@LFBBBE@@DADAB@BA@@BABA@@BA@@D@@AB@@@DA@@B@B@D@B@D@@@B@DAB@@@B@@@DAB@@@@EAC@ACEAG@A@@BADABABADA@AA@BA@A@@@@AAD@ACL@B@@AFAB@AC@A@IC@@@I@@AEB@AA@C@GBAEFA@AB@@@@AAA@@C@@@@@CB@A@AA@@C@@@@A@@BA@@AC@@CCB@AA@BGH@@AD@AAHA@AD@@A@@B@@@AACBA@@AB@@AFCBAAGB@@AE@@EA@@@A@@A@@@EB@@CB@@AD@@A@@A@@A@@I@@B@@C@@B@B@@A@@AA@@@A@AA@A@@B@@@AEAB@CACB@@@AA@AAE@C@AA@@C@AC@CGF@@A@AGB@AC@A@@AC@AAEB@CFAAAD@ACFCDAACD@@GCB@AGB@@C@@BI@@@AK@@@CE@@@@@A@@@@@A@@@DA@@E@AEA@@@AAGBAEBA@@@CA@@AA@@ABABBD@AEA@@A@ABC@AD@@@@@@@F@@AJ@@BF@AAD@@@FA@GB@@@D@@C@@D@@A@AB@BGA@@AA@IA@GF@@AB@@@@CD@BED@@@F@AAL@@CA@ACD@@EC@@AAED@@AC@@@DA@DB@@C@@@C@@@CC@@@B@@A@@@@CA@BA@BGA@@DAA@A@@@@@@@A@@CA@BA@@ACAI@@AC@@@GA@@EA@@A@@AAA@@@@@A@AC@@AD@B@@A@@@ABA@@@@B@@C@CF@@@D@@A@AB@@@@@@@BG@@@CC@@DA@@KD@@DB@@C@@@CD@@@F@@AB@@AA@AAA@EB@A@AA@@CB@@A@@E@@AA@@CC@AA@AA@ACBAA@@@A@@A@@@CB@@AF@@A@@A@@AN@@CH@ACBAAG@@FA@A@@@AB@@B@@BA@DBA@B@@BBD@@@B@@BD@AA@@F@@@BDF@@E@AB@B@@@BFA@BDB@@FDA@@@@B@AAB@@@B@@@AB@@D@@@D@@@F@@BB@@@B@@AH@@F@@B@@B@@@BD@@D@@H@BBB@FBB@D@@GJ@FA@A@EB@@@AABA@CH@@E@EM@@MK@@E@@@AA@@AD@@CD@@AB@@A@@A@ACCB@C@A@@@C@@@ED@@ED@A@DAFAD@@EA@@@@CAI@@@AC@@A@@@@C@@G@@@EFA@CP@DAAC@@@A@A@EA@@C@@AEA@@GAAFGB@@B@@@ABA@@@@@@BAAKBA@@AE@C@A@A@A@AAO@@BA@A@@@BB@BDB@@CB@@AD@@A@C@@@@@@@GB@@B@@@@B@H@@BB@@@B@@DD@@A@@JA@A@@@AB@@@B@@AB@AE@AAA@@@EB@@CH@@AE@@@B@ACB@D@@AB@BBBBD@@@D@@@@@DBDBB@B@@CA@@AHAD@@CD@@BJAACD@@DB@@C@@@@@AB@B@@@@@@@B@@F@@B@@@FD@@D@@BD@@B@@@@BAB@@B@@@@@@@DF@@@B@@CB@@AD@@AB@B@F@@CBA@BB@@BBB@@@@B@@BH@@AA@@CD@@@D@@@B@@@FA@@@A@CB@@MC@@EG@@CH@AAB@@C@AF@@EC@@GB@B@@DD@@CB@@AAAA@@CEB@AA@AEA@@ABAD@@CD@@A@@FA@@C@@A@CD@@CC@@A@AA@ACA@ABAE@@@CA@@AAGH@@CA@@CAG@@AGB@AAB@@@DCDAA@DC@C@@G@@IA@AC@@MBAG@@@G@@@@AAAB@ADA@@CA@@@@@AAGAC@AF@@ADA@@@@@ACB@E@@@@@@@AA@@CA@@C@@@C@@@C@@@@AAC@ACCB@CC@@@@@E@@BA@A@@@C@@@C@@AC@@@E@C@@@C@@E@@AD@@C@"],encodeOffsets:[[119631,35256]]}},{type:"Feature",id:"320322",properties:{name:"沛县",cp:[116.936353,34.760761],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@@@DAHADAD@@A@A@E@ANAB@@@FD@BH@@AH@@@H@@@F@N@F@BDBFBJBD@DBLBJFAF@JA@JWHG\\PCAPBP\\I@ABN@B@E@DFCDAB@BAHiD_@@Z@VAB@H@DBJAB@DABADEFGFCPIBAB@DAD@NETENEbOB@FMDCFEDADADAFAFADAB@J@D@L@F@D@HA@ABC@C@@B@@@B@D@B@B@@@@@D@@@@@@@@@@@B@@A@@@@@A@@@@@AA@@@A@E@A@AA@@@@AA@AA@@@@CAG@C@G@CAG@ABKBCPDFKBCFQBEHQJQDEBAJKBBB@DBFDB@B@F@BADCHGFCJENEDADAFABAB@BABCDC@ADADABC@A@CCGAG@CBCBABAB@FAH@B@BABA@@BCDGBA@A@@AW@EBCDC@@BA@@FAFAJAB@BAB@@A@@ACAA@AB@JMBAB@@GUIGCCAEGEGAIACCC@C@EDECO@GDGDKJK@@DEFCF@FBFBDFHDLHD@HBD@`@DGBAB@HDB@BAFMDCFEDMBM@IBG@ADKFGFMFKHUDK@ADG@EBC@EBEUB@@G@e@S@]@@@BC@ABE@AA@@AA@@CBACABCB@AA@@@AIC@A@A@@EAA@@@CACD@@C@@@A@@@C@@BGA@AA@@@@@AB@@A@BC@@@AB@@AC@@@@@@C@AE@BCC@A@A@C@@@C@@AE@@A@@BK@@@COA@AC@UDM@E@AA@EAA@EE@AAB@K@AAE@A@@@A@@IB@@@A@AA@@A@@@AC@AAB@EC@A@A@@CA@@CA@@AAG@CFA@A@@@AAAHAAE@@AC@@AC@@@CC@@AEB@A@@@A@@@CA@@CA@@AA@@CA@@A@@@A@@AA@@@@A@@@@@CBAA@@AC@@B@C@@@GB@BABAAAB@BA@C@@@A@BF@@BBGB@@@@@@A@@BG@@BA@@AA@BBC@@@C@@ACB@D@@@BA@@AC@@@@AA@@@C@@@C@@EBA@AB@AEBA@@@A@@@@AEA@@AABA@@BA@@FE@B@C@@AA@@BA@@BA@@AA@@@@@@AA@@@A@@@@@@B@@@AA@@A@@@B@@AA@BA@@CCBAEA@ACA@MB@BBHA@BD@@@@A@@@@BA@@DA@@@@D@@@D@@@@C@@BC@AAEB@BC@@AABBBA@ACEB@AA@@@@CEB@AAB@CA@@AG@AA@@@DG@@@CB@A@@A@@@@@BDA@@DCB@@A@C@CBBBC@@DAB@AC@A@C@IB@@A@@DA@ACG@@CA@@CA@@DA@@AC@@EB@@@C@@DCDB@CBCD@@A@BBA@BH@@BH@DB@@DG@BH@BB@@D@@BFBAB@BDB@@B@BD@@DC@@D@BD@@@EB@@@BC@@DC@AB@BB@BFB@@BFA@DB@BB@BA@@DC@@CA@A@@HD@@FE@@B@DA@BBG@@DH@@FD@@NA@@D@B@@EB@@A@@@C@@@C@@DB@@BG@@AA@@@@@AA@AA@@AAB@DE@A@A@@BC@@BA@@DA@@@E@@C@@@@@@@AA@AB@@@@@AC@@AC@@@EC@@A@@@@EA@@@@@@@A@A@@B@@@@@DA@@CC@BDIB@AC@@DC@GB@BB@@DA@A@CACA@@@@C@@@C@AAAAA@@BC@A@BDA@@@F@@BG@@DA@@F@@BB@BBFA@@BA@@@A@@B@@@BIB@@@BC@@CA@@@A@@AG@A@@@@@@AA@@H@@@@@@@D@BC@@BA@@DA@ACA@@A@@@BAB@@BP@B@B@B@B@DBF@@ABBLAB@@@@@@AB@B@@@AA@EHBB@HB@BF@@@DB@@F@B@B@@BDCBO@@DEB@F@@@HD@@@@@@BD@@B@@BJ@D@@B@@FC@EBCBB@C@@FC@@F@@@D@@@B@DDABDB@@@@BA@@BC@@DC@@BB@@B@@@FL@@NN@@F@FG@@DABBB@@A@@F@BEBI@@HC@A@EAA@AAG@@@@CC@@A@@@AA@@@@EG@@BA@@@A@@AE@@@C@@@C@@@BA@@A@@@A@BBA@@@@@CB@EA@ACB@AE@@A@A@@B@FE@AC@@E@@@BBC@@AA@@@C@AA@@@AAB@CAB@@@AA@@B@@@BEB@@BHABBDG@@DM@@BB@@@@BE@@BA@@D@@@BB@@@B@ABBDB@@BBBD@@DB@@BF@@@@BA@@DB@@B@BFAB@BBB@@BA@@BE@@@C@@D@@@DA@@CC@@LB@@CD@@D@@AH@@@@@@A@@B@BC@@@E@@D@DA@@@@@AB@B@@@BA@C@@BD@@B@B@@@@BB@BB@@@FB@@HB@@D@@BJ@DB@BB@@ADB@@@B@@@@@@@BBB@CB@AHB@@ADB@@@@@BA@@@D@@D@@@D@@@DA@@CCB@@D@@BC@BF@BD@@FC@BDB@@DK@BBE@@@C@AFC@@D@@A@@BE@@HJBB@@BB@AHA@@B@BC@@@@DC@@@A@@HEB@@C@BBE@@AI@@BE@@@@@@@C@@BAD@B@BB@BFC@AAAB@BB@@BB@@D@@ABBFHABB@@B@BFF@@@CB@@B@@@@@B@@@@@F@@D@@BL@@J@@AD@@@HA@BDA@HC@BDCBEDBDC@BBEB@DFABBD@@BB@D@@BHA@B@BE@DHD@@B@DB@@B@DBF@BBB@@A@BD@DBABF@@A@@@@BBBB@@@B@@BB@@@@A@AD@@@@AJ@@@@BB@@@@BC@@BA@@DA@@F@@@BB@@@B@@FF@@BA@BHABED@BA@@BB@DABB@@A@@@@BC@@BGBBBC@@BG@AHB@@BDA@DD@@BB@@AB@@@@@@DB@@B@BDA@@@@D@@@BB@B@@A@@BEBBFHAD@B@@BFA@BJ@@@D@@J@BBDA@EB@BA@K@BDC@BB@@@@@BABB@@BCBABABCBAB@@@BBHDF@BBD@F"],encodeOffsets:[[119537,35763]]}},{type:"Feature",id:"320312",properties:{name:"铜山区",cp:[117.169461,34.180779],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@DEC@@CD@@AA@@A@@B@@BB@F@@@@@AB@@C@@@F@@BD@@A@ACC@@@@@@A@@@@@@@A@@@AA@@@@@@@@@@@A@@@@@@@AEC@@E@KD@@@@@@@@@@@@A@TV"],["@@@A@@@@@@@@@ECACFHD"],["@@@@B@@M@AOAABADA@@D@@@@@@@@@@A@@@B@B@B@BBB@@@@BB@@BJB"],["@@AFABADCFADCBADCDEDEDG@G@GBCF@DBDFDBDBFADADCBCBEDEDABEFEBCD@@CBC@G@E@CAE@C@GACAC@CAGAEBEAAAA@ACCCAEAEAEAEC@I@GBGBEBEDCDAD@DIBE@EA@@EAACEACCCA@@O@A@@A@I@@KAC@C@I@K@OAG@IHEDIJI@@@@A@IA@[@@@UNKDIDIFCBEBSAHXBDBD@@A@KBAA@@EA@@IBOFG@@@@FBDAF@D@D@B@FADAD@DAB@BGFE@C@E@G@EBEBCDCDCDCFADA@@@EFEDI@I@G@GBEBCBEFEBMDEAIC@@A@ANABCBAFCFABGAA@C@GBC@A@@@DFBFBJBJBFFFFHDJDHFBDBBBBB@B@DAH@BBDHL@BCBABA@ABC@@L@D@@BFHD@B@HCFCD@@@@EB@@KCKGEE@@MF@BATADCBE@G@EBIDA@@BEDKFGDGFKHABEDCDABCBAF@H@F@FDHDFFJBLC@EDABADAD@F@DBFBFDDFFHJ@BDFBD@D@D@H@DAF@@AB@@A@KFAB@@E@QB@BA@@B@LBBP`@DKBCDCBGBG@EBEBGBABEBCBCDC@CBEDIBCBIBE@EBGAC@C@EAGCIAC@IACA@@EAGECCECECCAE@G@GAGAC@G@C@C@G@E@K@EHADEFGFEFDD@D@@@@@DB@@@B@@D@@@@B@@@BB@@@@@BB@@@BBA@@@@@@@BB@@@DBBAB@BB@AJF@A@DB@@C@BFD@D@@@BC@@@FD@@@D@F@@@D@@BD@@@D@@@B@B@@AF@@@@@D@@DDABDD@BB@@@@@D@@@D@@@DB@@DB@@B@@@@@@@FDA@B@@@@CB@BE@@BBDBH@B@@@@DB@@CB@BBABB@@@@@H@@BHNA@@BDB@@JH@@@D@@@A@@FD@@BB@@CB@@DB@@DH@BDB@@CB@@@JAD@B@D@@BBA@CD@AADAD@B@@@DA@CB@AC@@@@B@@@@BDA@@H@@C@@BBH@@BB@@DBA@BFA@D@@B@@BFABDB@AABA@BD@@AFABBD@@AD@@@@@@C@@@C@@B@@CB@@A@@B@@@@@ACB@AG@ANAB@BDB@BFDA@DB@@ABB@@@A@@@BB@@B@@@A@@@@B@@@B@@B@@@@B@@BB@@AB@@AB@@BD@A@F@@EB@@AB@BA@BB@BF@@@@@B@@ABBFA@@BAB@FD@@@D@@@B@@B@@D@@BB@@A@@@CDA@BD@@@D@AAB@@BB@@AH@@AB@@@@@@@HAAA@@AEB@@@D@B@@ABABBBA@AHA@@D@A@@@BD@@BBDA@@@@B@@@@@BB@@@B@@@BB@@DB@@BB@@DB@@D@@@B@@@BFA@BD@@D@@BD@@BD@@BFGBBB@B@@@BEB@DBH@BB@@DB@@DB@B@D@@FBA@BBD@@B@@@BBB@@@A@@JB@@@B@F@BBL@A@BBF@@FBB@FBBF@N@VCD@@BPB@D@@AL@@@BF@@BD@@@D@B@B@D@ADF@@B@D@@@@D@@BA@@B@@ADB@@@BA@@@@B@@BHB@AD@@@B@@@D@@@DCDB@@B@FB@@@B@BJD@B@@BBA@ADDBAB@DB@@BB@@BAF@BAD@@^@T@f@H@@@VA@C@KA@@@K@A@AAEK@ABK@EBI@C@C@G@GBABEBIBAB@HBNBJBD@BA@ABC@A@ABE@@BC@@@@@A@@DI@@BA@@@@@@SI@CB@@@@ABCBG@A@ABC@A@A@@H@B@@A@@B@BB@@BBB@@@BFBHB@B@HA@@AEEQ@@B@@@NAB@@B@@FALCFCB@DD@@@BGHB@@@@@HG@A@BPJBBFH@@@@BAA@CEAAMKGCAACEACGQCG@@@@BAB@FB^FDBBB@DAB@B@@D@@@BB@BBBDBDAB@DBD@BADABCBA@C@@BABAB@DB@@B@BBJDDBDDBB@@@BBB@@@BBB@@@@@@@@BB@D@BBB@F@@@@A@@@@@@@@@@B@@A@@@@@@@@B@@@B@@BD@@BBB@DBDBBB@BB@@B@@@D@@@B@B@@@BAD@@ABAB@@@@ADAB@@@B@@@B@@@@@BABAB@B@@@B@@@B@@@@@BA@@@@B@@@@BBBB@BB@BB@@B@B@B@@AB@@@BABAB@DBB@@@@@@@@@@B@B@@@BBBBBAB@D@B@@@B@@B@@B@@@@@B@@E@KB@@@B@@@@LA@@@@@@@@AB@B@B@@@@@B@@BD@@@@D@@BB@@@@@@@BB@@@@@@AB@@ABA@AB@@@@@@AB@B@@BD@@@@BB@@@@@@@@@BA@@AA@@@@@A@A@A@@@AB@@@@@@@B@@@BBD@@@@@@DB@@B@@@B@B@BAB@@A@@B@@A@BB@@@@@BBB@@@BBB@B@@@B@B@@@@@BA@@B@@@BBB@@@DBHDB@DB@@@@@BBB@@@@@@B@B@@@@B@@B@@@@BD@@@@@@@B@@B@@@B@@@@AB@BA@@B@@BB@@@@BB@@@@@B@@A@@@@@@@A@A@A@@@ABA@A@@@A@@BA@@B@@@B@@@@@@@B@@@@@@@@@AB@@@@@BB@@@@@@@@BB@@@@@@B@BB@@B@@B@@@@@@B@@@@@@@@B@@@@@@BB@@@A@@B@@@@@@@@@BA@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@B@@@B@@A@@@@@@@@@@@@B@@@@@@A@@@@@A@@A@@A@@@@@AB@@@@@A@@A@@@@B@@@@@@@@@@@B@@B@@@@@B@@@@@B@@@@@@@B@@@@B@@@@@B@@@@@@B@@@B@B@@@B@@@@@@B@@@@@BB@@@@@@@@@@@B@@B@BA@BB@BB@@@@DAFCBABABA@@B@@@@B@@B@@@B@B@@B@B@@BB@BB@B@BB@BAB@BCF@@@BA@@@A@@BA@A@AB@@@@@@@@@@@C@@@A@@A@@@@@@AA@A@@@@@@@@@@AAB@@@@A@@@B@@@@AA@@@@@@@@@@@@@A@@@@@@@A@A@@@@B@@@@@@@@A@@BB@@@@@@@@@@@A@@@A@@@@BB@A@@@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@@@B@@@BB@@@@@@@BB@@B@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@B@@@@B@@@@@@A@@@@@@@@@A@@B@@@B@@@@BB@@@B@@@@A@@B@@@A@@@BA@@@B@@@@@@@B@@@@@@@@@@@BB@@@BA@@@@@@@@@A@@@@@@@AB@@BB@B@BBDB@@DBB@@BB@@BBB@@BB@@@B@@@@@BB@@BB@@@B@@@B@B@@@B@B@@B@@@@@@@@@BB@@@B@B@BA@@B@@@DAB@@@@@BB@@B@DBBB@@@BB@@@@@@B@@A@@BA@@@@@@@@BB@@@HEFABAB@B@@@B@B@@@BA@@B@DCJCLEFCDAPGB@@K@[BEJGAIA@@@AC@EEA@@CAGDEEDA@ACCCECAICA@C@GF@@ECEDCAEEECE@MEAAKABCBA@EOC@CAAB@@@AABC@ABA@@BA@@B@@AA@@@D@@@@@BC@@B@@@@@B@@@B@@A@@B@@AB@@@D@@@@@@A@@A@BAB@@AA@A@BAEA@@@@@AC@@@@@A@@A@@@@A@@CA@@@@C@@@@@A@@A@@BA@@@@A@@AB@@@@ECEC@A@AAA@CB@AA@@B@@@BB@AFB@@AABAA@@@DG@AB@B@D@@A@B@@B@@@DA@@@@@@@@CA@@B@@@@@B@@AA@@@@@DB@ADC@@CA@ADA@@@@@A@@@@@@B@AA@AAA@@@@BA@AFB@@@A@@@@A@@AB@@ABBBA@@@@AA@@@@@A@@A@@@DAHEBAB@B@BCBAD@@@@@@@C@@@@AD@@@@@A@A@@@B@B@@@@A@@@@@@F@@@@@A@AAAAEC@@IBAA@@A@@@@@@@AB@@@@A@@@@@@A@@@@A@A@CAOE@@@BA@@@@B@@@BC@@A@AA@@@@@@@@A@AC@C@@A@AA@@@BAAI@@B@@A@AA@@@A@@C@A@@@@AE@A@@A@A@@@AB@@@@CB@@A@@@@@@B@BA@@@@@A@A@@@A@@@@B@@CB@@@AE@CB@D@@@BG@@B@@EBA@@CA@AACB@@DAAAB@CE@ABA@@A@@AB@@@@A@@EG@AB@A@BA@@@@A@@A@@@@@@@@AAB@@@@@@AFABACAIKEEECFA@EK@@KCE@CBC@OA@ANEAFOBCJ@B@DKB@@C@@D@@AB@@C@AA@@@A@AA@C@C@@A@A@@@@C@@@GI@@E@@I@ABA@@CC@C@BA@@@C@@AADA@CAC@AA@AB@@AB@@@BG@WC@@A@@@AAA@EBEB@@@B@@A@@@@BA@@BC@AB@@@@A@GB@B@@A@@@A@C@EA@@@@@CACCBM@EBG@EB@@AA@BEA@@@@@D@@@@ADB@ADGAE@@BA@@B@@D@BBBA@@@FA@@BB@@@D@ABF@@DAHCAG@BHA@@@@@@@@CA@AB@D@BA@@DEBA@A@AAA@@AAA@@B@@@CC@@AAAB@AAE@@@@@@@C@@@A@@@@GAA@@BBDE@BDEB@BBBCA@@C@A@A@ADA@@BA@A@C@AAAD@@IA@F@FABABCDABCBA@C@CACAC@CACB@@CBCBABA@@@I@C@@@A@KCA@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@B@@AHA@ADE@A@@@@@@AB@@@@@@A@@@@@@@@@@A@A@@@@@@@@A@@@@@@@@@C@@@A@@@@@@E@A@@B@@@@@@@B@@AA@@@@@@@@@@A@@@@@@@@@@@@@@@@AA@@@@@@@@B@@@@@B@@@@@@@@@B@@@@@@@@@@B@@@@B@@@@@@B@@@@@@@D@@@@@@B@@@B@@A@@@@@@@@@@@B@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@B@@A@KAE@GCB@AA@@@ABA@@DQ@@B@ND@@@@@@@@@@B@@@@@@A@A@@@@@@@@@@@A@@@@@@@@@@A@@@@IAA@@@@BA@@AAB@BD@ABE@C@A@BABAEABAA@@A@@AB@F@@@@B@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@A@@@@@@@@@B@GCAAA@@@ADC@@@@@AB@@A@@B@@@@A@E@BGC@AAB@C@@CA@AB@BA@A@@@@A@A@AAC@C@@C@@CC@BA@ABAA@@@A@A@@@AB@A@@@A@@@I@@@AA@@@BC@@BA@CC@@@@A@@B@@@@@B@@@@CC@@CA@@A@@@@B@@AA@@AAAA@@A@CCB@A@@C@@A@@D@@@@A@@@@AA@@@CD@@@@A@@FA@@D@@@@C@@B@@@@@@A@@B@F@SU@@@@@@@@@A@@@@@@@@B@@@@@@@@@@BLCF@@@FD@@@@B@@@@@@BB@@@B@@@@@BB@@@@A@@B@@@@DDD@@@BDBA@AD@@DBA@A@@@ABB@@@@@C@@@@@AD@@A@@BAB@@D@@BCD@@@@@@@@@A@A@C@@AD@@@A@A@@@D@@@C@@AB@@AB@@@A@@AA@@@@C@@BAB@A@D@@AACB@AG@@E@@@@@@@A@@AA@AAE@@@@BA@@@A@C@@CA@@EB@D@@@@A@@@A@@@@BA@BB@@@@@@@@AF@@D@@JE@EB@AC@AE@@KA@GE@@CAACFCAEDABA@@@A@AB@@BB@HFDDDBDDH@F@@@BBD@B@@CD@@@@I@@C@A@@C@EA@CCA@@A@@@A@@A@@A@@BABA@CHAD@DAB@@AB@B@CEFACACA@ABCDCHGDAFCDBBDFDDBB@DA@A@@@@@A@BFA@BJ@BABBDA@AAA@@@ABAB@DAB@AA@CAA@@@A@@@@@ABABAAE@@BAF@ABBBBBD@DD@DB@@B@@D@@@@A@AAABAACACB@@@@A@@F@BAFD@BBB@AL@@@@BBF@BA@ABG@A@@B@BB@@@DBB@B@F@@@B@@CD@@DB@@@@C@C@@B@@AFA@@B@@E@AB@D@@A@AB@BDB@BA@@BDB@D@@@D@@AFB@@D@@A@@V@@BB@BBD@@DJB@A@@@@@ABAF@AAB@@@B@@@B@BA@@@AAA@A@@@AABCC@AB@@@@AAA@@B@@BB@@@@@@AB@@@@@A@A@AA@@@@AA@AABB@@B@@@B@@@AA@@AAA@@@@AAA@AAACCCAACAA@AA@@@ABA@AB@BAB@@@D@B@BCF@@B@@B@B@@A@AB@@AB@A@@AB@AI@ABC@@H@D@@AF@@CB@@ED@@@D@@AD@@@@EB@@@@@LB@@B@FB@AB@@B@BBA@BB@@DB@BAD@F@@ATA@@P@BC@CBAF@D@BAF@@@@@@C@@B@@@D@FAD@BABB@AAA@C@AJAD@JAB@@@HAB@@@DBBDFB@@BD@BAB@DFA@BDJ@H@@DA@DBD@B@@A@BHBBBBBDBBB@@@B@@@DF@BD@@@BF@@GD@BDFEB@BA@AB@@@DFD@BB@@B@@@@@BA@CBCA@ACCABA@ADA@@BB@@@BDBB@B@BAD@BBBCHAFB@BAB@@BH@@D@@ABB@@@B@@@AB@BDCB@@B@@@D@@D@HBAD@@@BLDEJLDPFFDADFBDB@@@BAAANB@F@@@VBADFBL@@BF@F@B@@@EJA@B@D@@@B@@B@@@BB@@@D@@BB@@@B@@BA@@DA@@B@@ABF@@DD@@BB@B@@FB@@BB@@BD@@BG@BAC@A@E@ABDBD@@BAB@FCA@BD@ADA@AHH@@@F@@DD@@BD@@BD@ADB@AFB@ADF@@@ADA@@B@DC@@A@AABDNB@DPB@H@P@@@NAXABAVDRFJDCHFBDBBCB@@DD@BGJDVFPDZHBCB@BBD@@CD@@C@ADABCB@@EH@@CBAFAF@@DZ@DB@BH@@ABAF@@CDABFB@F@@DDA@BL@DNE@BFP@@BF@AFHA@D@FDAAJB@@HD@@DA@ADDB@JHEBFL@@FBDHA@CB@BB@DH@@BD@B@@DF@@CF@AA@ABAD@@IDBBEHBB@HCJ@LIBABAF@@C@@ACF@@A@@@@@EE@AACA@AAACA@@A@AA@A@@AC@AC@@IA@@IB@@AF@B@@A@A@EDA@BD@AC@AAB@@C@@EC@ACB@AI@A@@AE@AD@ACJ@@@FA@@@@@@BADA@CB@@@B@@@B@ACB@@@@@@E@EE@@@@@ABEDA@@@@@C@@AAB@AA@@IA@ACA@@FC@@AA@@AC@@A@@@BAB@@C@@@@@@@B@@C@@@C@@@AE@@@B@@EA@@@A@G@@AH@B@D@@@@CF@@@@C@@F@@@A@@AF@@@@ED@@C@CA@@EA@@C@@@C@@@@@A@@@@@C@ABE@@DCDA@CB@@@@ABB@@@A@GF@J@@I@WFB@@ACIIAAL@AE@@@CL@@A@@@IBKAA@AC@@AF@@CA@BE@@DB@A@@@A@@@C@@@AB@@A@@@BB@@CB@@BD@@EF@@ABCBCB@@@@AB@BBBAAA@@@AB@@E@@@@HBAD@DBAB@B@@@BEDBBI@@@C@@BA@A@@@A@@BABA@@@@B@@AAA@@@A@@@ABBBA@A@@D@B@@@A@@@B@@@@A@@BE@@@@BA@@@A@@@A@AA@@@@ADBBG@@@@@ABBBGA@@C@@A@@DEA@@C@BCA@@AA@@@@@@@AA@@@@@@@@@AA@@@@@@A@@@@BAA@@@CA@AB@@@AAA@BB@@A@@@AA@@@BAAAAABB@@@AAABCAA@@A@@A@@A@@@@BA@@@@A@B@@@@@@A@@@@@ACAB@CA@A@ABAB@@BB@@@@ABB@@@A@@CAB@@@@@@@@@@@@@B@@ADBBAEA@@D@B@CA@@D@BB@AB@@@@AA@ACMCAAA@@BA@AAC@CA@@@@AACACAC@A@A@A@@@CDEA@BG@@AA@A@@AA@A@C@@CA@A@@AA@@@@@@@EAA@@@EB@@@@@B@@A@@@@DB@@BABAD@BADA@C@A@E@@@E@@A@@@@G@@@G@A@@CI@@@C@@@C@@AEB@BE@@A@@A@CB@@A@@BA@@BA@ACG@AEC@@CEBC@@CG@AEMB@BA@@BA@@ACB@AI@@@@@AEH@@@AADA@AB@@AF@AEGBC@A@@@AA@A@@@CA@ACA@@AA@@ABAA@@@@@@@@AB@ACD@AEA@@E@@BCB@@AAC@@@AACGB@CAAE@@@OB@D@@@BGB@DE@GBACGBACAA@AABA@ACEFAEAB@@@@C@CB@AGBE@C@CCACAEAEEAEAGAI@EA@@G@CAEACCCACCCACAGCAAA@CAGACACAEAEAGCA@E@ICC@G@EAG@C@EBC@EDCBCDADAH"],["@@J@@@B@BADADA@@DADBD@DBDBD@B@DABADCBABA@E@EJB@@BCBBD@B@B@@AB@BCB@B@D@@@DBAA@AFAACF@AC@AB@HB@@@@@B@@@D@@@@@@BF@BBABB@@DD@@A@@@BB@BB@BBB@B@FA@CB@@A@CBAB@@D@@@@@@B@AG@@A@AA@AAAIECAA@AACB@@@GA@G@BEAAE@@@@AEAA@@@BEI@@GK@A@@@S@E@@CE@SACL@@QAE@AFLJ@JA@ADA@@B@HBF@L@BA@@B@BCF@BGB@BA@@@@@@B@@@@@@LDB@@@@@@@@C@A@@@@B@@@@@@@@@@@@AB@@@@B@@@@@D@@@@@B@@@@@@"],["@@D@BGA@AA@@@A@@@AA@A@@BA@A@@A@DBB@@B@ABCAA@B@CAAAC@@D@@A@CAA@CA@B@@@@BB@BBBDBJ@D@HB"]],encodeOffsets:[[[119891,35129]],[[119915,35179]],[[119930,35177]],[[120220,34855]],[[119947,35191]],[[119979,35127]]]}},{type:"Feature",id:"320324",properties:{name:"睢宁县",cp:[117.941563,33.912597],childNum:1},geometry:{type:"Polygon",coordinates:["@@DFHBFBD@DFDFDBBFBDBD@@@FBD@HBD@B@BBH@DBHDHBFBF@DBF@D@FBD@H@@@@EJEL@DB@@BFB@BBN@DBP@BBBBBBB@@@D@BAHAFAHF@@@BHBFDJAH@B@B@@@F@B@BADADEDCBA@EBC@EAC@GCCACACCGEC@CACCGAC@CBCBABABEDEFABCH@@ADADAFABAFCFAD@BABAFADCDADADCDEDADEDCDEH@D@D@H@D@@@FBFADEFCBADADCD@F@@@DABBHBFFH@BB@B@DN@B@D@FADEBAB@B@BCB@BEDCBEDCBADKFCDABA@CB@HYTC@KDIDGCECBCDCDCDEBE@E@A@G@AAEAEECEACAE@GAEAIC@AAACECCGCCAIACAGBEBADCDCFAF@BAD@DCFADABCDGBEDEDCBAJAHBF@DBH@DBFABADED@BEFCDEDCDCDEDCBEDEDEDA@@BDAD@@@@@BABFFEBDB@BA@BBBBDHABDHAF@@CHA@A@@@CPA@@F@BB@DHABD@B@@BD@BA@AD@@@FB@BFC@BDA@@B@@@@@@B@AB@BB@@BB@BDB@@D@@@BBB@@B@D@HABFE@@BA@@BCBBB@@G@BF@@@@J@@BDA@BB@@AB@@ANABFH@@DD@FA@DD@BFH@BDB@@AB@@AB@@@DAB@@@@BF@@AFA@BD@@@D@@@J@@DB@H@@@H@@@@@@BF@@@F@B@D@B@BC@ABCBA@AA@@C@@B@@@@A@@@@FA@@B@FB@@@@@@B@@BB@B@@DD@B@B@@BB@B@@BH@@AFBDC@@B@B@B@D@DBDBBB@@@@DBD@BBB@@AB@BBNDBDB@@B@@A@@BAAC@@@DBA@C@@@FBABCA@BA@@@@@@@@@@@@@A@DB@@@B@@AA@B@@A@@AA@AB@B@BDBA@DB@B@@@@@B@@@@A@B@@@@@AB@@@@@BB@@@@BB@DBBABB@@A@BABBBB@A@@BB@@B@@@AAB@BB@@A@@BDB@@B@AB@@@@@B@@@@B@@B@@@@@@@@BB@@@@@@B@@BB@ADD@@@FB@CB@@@@DB@NBHB@B@@@@ADDBADHDJDFDFBB@@@BB@DA@B@D@VC@A@C@IC@BC@@@AB@@E@@@@BAB@@AA@@@D@@@@A@CA@CABA@A@@@A@@@A@@B@@@DD@@@@B@BBB@B@BB@CB@@AD@@AB@@@B@@A@@@@@A@A@@@ABB@@@@B@@@BBB@@B@B@@@BB@@B@@AB@@B@@@@@BABBBA@A@A@@@@B@B@@@DB@@@@@@A@BBB@B@BAFBADB@@@B@@@F@@BJ@F@J@@@@BD@@@D@@@B@@@@@@CL@BB@@@BDB@@@@@BBD@B@@FAD@F@RCAEH@F@@@@DD@@DB@BAB@@@@NBB@JB@@@@D@@@BAB@@BDA@@@@@@@@BB@@F@@H@B@B@B@F@D@@FD@@@J@@@@@HBBADBAH@DB@@@BAB@@@B@@@ABB@@@B@@BB@@A@@@BB@BA@@B@D@D@@@BB@@@@BD@@B@B@B@@@B@B@BD@BB@B@B@B@B@B@B@BB@@BB@D@@BA@@B@@@DABAB@B@D@FAJ@@CC@@A@@ACA@A@ACA@@A@@@ADA@@B@B@@@@@DADD@@HCBBDAACA@@@AAAC@@ABAE@@AA@@HA@@FA@@B@BD@@BBD@AAH@@BDA@@F@@AA@@ADA@@DA@@DB@AAA@@BBB@@@AAB@AAB@@A@@@@B@@@D@@BC@BBB@@C@@@@@@@AC@AEA@@A@@@C@@BAB@DAB@@BB@DBDBB@F@B@DBD@BBD@B@B@HDF@@BC@@@B@@@B@@@B@@DFA@@@@CB@DB@HB@A@@@CB@@AF@B@BA@E@@F@@A@A@AF@B@@BB@@@BFBAB@@BHBB@DA@AAB@AH@@@BA@@@@@@FA@BB@@CAI@AA@@A@AC@@C@@COD@@AACD@@CEB@O@@@ELBB@@CB@B@@@BABA@@A@@EB@D@BA@@@@@A@@@@E@BK@ABABCB@@@@A@CJBFBF@HBPFJBDBB@FBHBB@BCDG@A@@BABA@AB@@@BCBEB@HSFBBA@CE@BCF@BEC@@BAADGEABC@C@A@C@@@@A@@@EB@@CABCAA@@BC@A@@BA@A@@BA@A@@@AB@D@AEBB@AB@@@B@@@@@BGGAA@@@@GBE@@BE@@@C@@BBBAB@BE@A@A@@EAGCA@@@CA@@@@AA@AA@AA@@ABAA@@AA@@AB@BCD@@BB@BCAAB@@@AI@@@@@@@AB@@A@@B@@A@A@@@@@@@@BA@AE@A@@B@@@AC@ACB@BABA@@@@CA@@@ACDA@@BA@C@A@@@AA@BA@@AAAAAAC@@EBCDGBABA@GB@DBJ@LAD@@@@@@I@A@@AA@@B@@AB@B@@BD@AMJ@@DP@@CB@@AB@B@H@B@AGFABB@DN@D@@ED@@G@A@C@OE@A@@A@GAEN@@@B@@@@@FBBED@@@B@D@@@J@@A@ABA@A@ABA@@D@@A@AD@@BD@@BB@@BJ@B@@BA@@N@@@D@@BH@H@@@@@BB@@B@@H@@BF@@@FCBAB@DEBADG@ABAB@@AHKBA@ABI@CBA@AD@@ABA@CBEE@BEBGBEDMBCDEFIBABCJEDAFABA@AB@@CBC@CBIBC@@BC@@C@@A@@@AFUBA@@BC@A@AA@@CB@@A@@B@@AB@B@@AB@@BB@@B@@B@@BB@@D@@@CDB@@@A@@BCB@B@@ICBAIB@@@AED@B@@AA@@E@@@CBAAAB@@CAE@A@AA@AA@@@AA@@@@AA@@@OFIB@AA@@CA@@C@@@CA@@AA@@@@@@@@BA@@CD@@AB@@E@@C@@CC@AI@@@C@@ACA@@CC@@AA@A@BEC@@@D@@A@@J@D@@AB@BAB@@AB@@@B@BA@E@EB@@AF@@@@@@@@C@C@@BCA@@ACBBIB@@@B@@A@@DEBAB@@@@AB@EA@@BAAA@@ADA@AA@AB@GAA@@C@@@@AA@@AF@ABCEADG@@@C@@@@A@EBA@@@@@A@A@AAE@EAEACAA@@@A@A@C@CBA@A@@@ABEBEDA@@@CD@@A@ABABADA@@@@@@@@B@@@@A@AD@@EFA@@BEDCBCBA@CAEAAAAA@BA@A@A@E@C@E@A@A@A@A@@@A@A@C@A@@@A@CBG@C@E@A@E@A@C@C@AE@CAA@AA@@@AA@@IC@BMGECBAA@C@BEB@@CE@@FCAADB@@BEAA@EAE@E@BAC@@ADK@@GA@AE@@BA@AAC@@DA@@FA@BDC@C@C@@FA@A@A@@@A@@ECB@AC@AB@CEBA@GB@BC@@F@@EAEACAE@A@A@A@A@C@@@A@ABA@CB@@@@A@@B@BAJ@@@@@B@@AB@BABAB@@ABCB@@@@AAEEEAAECCAC@@@E@CCG@E@@@CAACEECGAEAEAGBGBGBCBE@CB@@ADA@AF@@KABCIA@BI@@CEA@GDCDG@EMAIFC@ALA@A@G@A@A@GDA@@@A@C@@@ABC@CBC@CBA@A@@@ABA@ABC@C@GBC@C@C@A@AAA@E@AA@@A@A@@@ABA@A@A@@@A@@BCBA@@@CBCAC@A@@@CCC@C@A@A@@A@BA@@@ABA@G@A@C@EA@@C@A@AAA@C@CA@@A@@A@A@AA@@ACAA@AA@@AAA@CCGCA@C@E@E@A@A@AACEAAE@A@A@@@AEE@@EA@@B@@AAC@AAC@EBBLCBB@EBBBMD@CAB@DA@BBA@DH@@AFADABAB@@C@@BA@CBA@AB@@CB@B@B@@@B@BBB@B@BAB@@"],encodeOffsets:[[120549,34557]]}},{type:"Feature",id:"320381",properties:{name:"新沂市",cp:[118.354537,34.36958],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@FAH@BA@A@@AAICEA@@A@AACAAACAE@CAEAC@@@AACCAAAACCA@@AGAC@CAA@A@C@A@ABEFCBCDA@IBEBABA@A@EAC@EAE@A@C@A@A@ABABEDA@A@A@GBC@A@GBIBMDG@MDC@KBCBA@I@WBI@I@A@E@CAEAE@AAK@I@G@QAYAC@UAC@QDYHGBMFGB@@MBM@IBADEFABAD@BCHAFCDGHABCDCBCBABABEHC@AB@@ABAB@@ABA@@BA@ABCAA@@@A@EA@@A@A@A@@@A@@@@@AB@@C@@@@@A@EDACCAAAECA@CACBE@S@G@G@G@BI@@JG@ACOAEAAECA@A@IDKDMDCBEBCBE@IAKAGAAAA@@@A@ABCBAB@B@B@BBD@@@BABABCBABC@CBCFCH@BCHAB@BA@ABA@E@A@AB@@ABABABABAB@BEBA@CDABABAD@BAF@D@DADCJ@BAF@DADBB@F@BBD@BA@AFADEHCDEHABQRIFHFFA@ALF@@BF@DBD@DBB@@BB@@HH@D@DAB@BBDBH@@BBA@C@@@@@C@@@ABAB@B@@@LG@@HU@@@@BX@B@AD@@@@VAZ@N@ZA@BBB@B@B@B@@@BAH@D@BADBD@B@BDFBDBB@BAD@D@@@B@BBBBDBD@@@BABA@ABC@@@AB@BA@BBBDBF@@@B@B@BABAD@B@B@B@@@D@@BDBBDBB@B@B@BB@@BB@@BBAD@B@BB@@BDDB@BBBBBDBB@D@B@@AB@BADA@@B@BBB@BBFBB@B@B@@BB@@FB@BB@@B@@@B@@BDBB@@@BDAADABEHEFEFGFCBABC@ABCB@BADADAH@D@B@DAHABAFADEH@B@@AFADCDADA@@DABBB@DH@@@@@D@D@@BB@BAFADBDBB@B@H@@@JCF@AC@@DADACADAD@DAB@B@B@@@@@DABBBAB@FA@@DDBD@@BBB@@@@BBDB@BFC@ABCDEDABFJDAHABA@BAB@B@F@BBD@B@@B@BA@B@@FBBD@BBFDF@@@@@A@@D@FAB@BAD@@ACALGFCDAJCFAFEJCFADCBW@M@SGW@E@@DCAK@ACKEIEEBA@ABINCD@DD@HDJ@@@@B@H@@@@ABA@MBAZBBA@ABCF@B@D@BBD@B@FB@@@BAD@@BNBLDP@FBAHCFCFCJF@@B@H@BD@BBBnAB@J@@@N@@@@JBD@@BBPABBB@@D@@@NBF@@BB@@B@H@dC@@@DB@@B@BB@FBB@@G@C@@BAP@RBD@HAH@DBBBD@@ABC@A@C@@DA@B^CHAFBB@D@B@BFBDLHJDDBLAHB@BHNB@NAJ@DAB@B@BADAA@@@D@@@BA@@@@@A@@B@@A@@B@AEAA@A@A@C@AB@@ABKD@D@@@D@BAD@B@@@@BD@B@@@B@@@B@B@@@@AF@BAF@@@@@@@@BDA@@B@@@FA@@@AB@BABBHB@@AGA@@@BA@@@@BDBB@BB@@B@@B@@@BB@@D@@@D@@@B@@@@@BBF@@@@B@@@DDB@AD@DA@BA@@BDA@BHDCDNHB@@BA@@@@BBDA@@@BBbNLDJDB@@A@@B@B@@A@@B@@CBBDBD@@@@A@AB@@@@@B@@BDA@BA@@@B@BABD@@B@B@AC@@BD@@@BDA@@@@BAACFAD@@@FABB@@BDBAAA@A@ADADAB@DA@@H@@@@@D@@A@C@@F@@A@AH@B@@@BA@CB@@C@@ACB@DADB@B@@F@@@@BD@B@@@D@BD@DAB@@@@@@@D@@BBDJ@@@DDF@BB@B@B@@B@@B@@BBD@@@@AB@@@B@B@@@BFBAA@@F@@@D@@B@@B@B@@B@B@@@B@@CHNE@@@B@@AD@@@FBDH@@@@B@@B@@@@@@D@@B@B@@@B@@BB@@BBA@@DA@@@ABB@@@C@A@@@AB@@@BB@@@@@@@@@A@@@A@A@@@A@A@A@A@@A@AC@A@@CAA@@@AA@C@@B@D@B@B@D@BBB@B@BB@@D@@@@E@A@A@AD@N@J@B@@@AC@@@@@A@@@@@@@AAAB@B@B@@@@@@AA@@@A@@AD@@A@@@@@@B@@@@@@@@A@@@@@CDA@D@@@@@CB@@@@A@@@@@B@B@@@@@@@@@@B@@@@@@A@AB@B@@@@@@B@@@@@@@@B@@@@@@@@@@A@A@@@@@A@A@A@C@@BBBAB@@@@@@AFA@BFA@@@@BA@@@B@@B@@@@@@@BB@AB@@@@@@AB@@A@@D@CQ@ADAD@JA@C@@AC@AAC@@@A@@AA@AAA@AACAA@AA@@@@B@@AA@AABAC@@AEA@GC@@@A@@AB@@AAEB@BEBBD@@@@CC@@AB@B@@A@@@@@A@A@BDA@@@@@@CA@A@@@AA@@@@@@A@@A@@BA@@@AAE@A@@@A@@@@A@@@@AAC@AB@BAAEB@@C@A@@@@@AB@AADA@ACED@@@@AAC@@ACABG@@CCB@C@@@A@A@AB@@@@A@AA@A@@@AE@@A@ABAC@@@@@A@@A@E@A@E@E@@ED@@AB@@@BA@@@AB@@@@@@@@@HAB@B@@AB@@@@AB@@A@A@@DA@@@@B@AAB@@A@@@ABA@C@A@C@A@@@E@@@F@@@E@@@F@@AA@C@@A@@@BF@@AE@@@FA@@E@@@AA@@AB@@CA@BCA@@A@AC@@@C@@A@@D@@AC@@@E@@AB@@CA@A@@BC@@@@CIB@BC@@@@@@BA@@FCA@CC@@BKA@@C@@A@@A@@AA@@AA@@AE@E@@A@@@@ABA@@@@B@@@@BBBB@B@@A@@@@@@AA@A@@@@@@@@@@@@B@@@@@@E@CBAG@@@@@@AB@A@@AAB@@AA@ABA@C@A@@A@@A@AB@@@AAEB@@EC@@DEBA@CI@AAC@A@ADEC@A@@A@A@@@@AED@FBBEC@A@@CA@@AA@@AC@@AB@@CA@A@AAA@@CB@AA@E@CB@@CA@@@@CB@AE@AAAA@AEBAAAB@AG@@AB@@@AA@@@@@@AB@B@@C@@@@@AB@BD@@@@@A@@@@@@@A@A@ABAAA@@@A@@@@@A@C@@@@@@FA@AJ@HA@@@C@@A@@@EO@@BA@@@AAE@AA@AA@AEO@A@A@@@ACKEE@ECO@A@@B@BA@@AE@Q@@@ACB@@AA@C@@E@@@@E@@I@AGCB@D@BC@@@@@C@@@@DAB@@@ECB@@@@@DCB@CQBC@@BK@@AC@@CA@A@C@@A@@@AA@A@A@C@@A@@@@C@AA@C@E@@A@@@C@@I@A@AA@@GB@A@L@@B@C@@@CA@@CCKAC@A@@@A@@ACB@@@BA@@@E@O"],encodeOffsets:[[121351,34939]]}},{type:"Feature",id:"320382",properties:{name:"邳州市",cp:[118.012531,34.338888],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@C@@@C@@A@@I@E@I@@AE@@@A@@@A@BCEAABA@A@AAB@@@@@@@CA@@A@A@@@@@@B@BABAAAB@@@@A@@@BA@@@AA@@A@@@A@AA@AA@@A@@@@@AA@B@@@B@B@@@@@BA@@@A@@BC@@BA@@DAAA@A@AAA@@@@@CC@@A@@@@B@@@B@@@BABDBB@@D@B@@C@@@B@@BA@AB@@@@@FA@@B@@ADD@@J@D@BUDC@A@B@@CAA@@A@EAECICGCBCCABC@@@@@AGAMAAHAA@B@@@@AHCA@B@@B@@B@B@@@B@@AB@@@@AF@@@B@@A@@@B@@@A@C@@@@BABAA@B@@@B@@BB@BA@@@@@ABAB@@@B@@@BAB@@@D@@AJCAAF@@A@A@AB@CBCGA@@@@@FA@@B@@BBABAAA@@B@@A@ADAD@BE@@FC@@AA@@DA@@A@@@BA@@B@@@D@@@B@@@BCA@@AFB@@DE@@BD@@BBBAL@J@@@BK@@D@@BFK@BBJJBD@@EA@X@JI@E@@H@B@@AA@B@@A@@DCBCD@@AF@B@D@@@@@B@@@@@D@@@DB@@FB@@D@DC@@F@@E@@BB@@@E@@@@D@@E@@D@@C@A@G@@BH@B@@@B@@FA@@@F@@B@@@D@@@DA@@@@@@@D@@@BA@A@@@BD@@BB@@BD@@EB@BDB@@JB@@BBA@BD@@@@@B@FCBA@@@@F@@F@F@@@@A@BDA@@@A@@@A@@DCBAB@@@@@@EB@@I@BDC@@BBF@@@BBJA@BDD@@FD@@@BA@BBDC@@ACB@F@B@BA@E@@BA@@JB@@JD@@BBD@@@BBBB@@@DBBB@BDBBBF@@FN@@H@BF@@B@DJ@@CB@DAD@@FD@@BB@@@H@@EDBD@DGBFHA@BD@@AF@AC@@JA@BBHGB@BE@@PAA@JH@@DBBB@@FRCDR@BA@BFEB@DB@@BFJC@ADAJB@@BABC@@DO@@F@DD@@F@BB@@D@@ABBDK@OJ@D@DD@@BBHF@BDB@HARAFDCFHFHDBVCFI@AFEB@EKAG@EAILK@CBCBCL@DGHE@BD@BICKCC@I@@GEA@GA@C@@DEJEF@DABA@@AA@I@@AE@@BCA@D@AA@AC@@AFACA@A@@BC@AFC@@BABA@@@M@AD@@@DEB@BAB@BGDBDHR@BDDH@L@B@BADCF@FB@@FDDDFD@BBDB@@@B@B@BADEB@@AD@HFDBLJB@@DBDBDFDBBHDHHDF@@@BBBHLDFBLBR@FD@DAD@H@HAB@@@B@@@B@F@H@F@NAHAD@@@@@@@@@@@@@HARCTEB@DAbGHCJAFC@@DAHCDCLGB@@@@@B@@BB@@@B@B@B@@AB@F@B@@@B@@@@@@BA@@@@B@@@@@B@@@@@@A@@@@@AB@@@B@@@BA@@@@B@@@@BB@FA@@B@@@B@@@@@@@@@B@@B@@@@B@@@@@B@@@@@B@@A@@B@@ABAB@@@@AB@@@@A@@@@BB@@B@@@B@@@@@@@B@@B@@@@@@@@B@@ABAB@@@@@@@B@@@@AB@B@@@@@@@B@@@@@@BBBDBBBB@B@@AB@BABAB@B@D@B@B@DAD@D@D@D@B@DAFAF@DBDBF@B@B@L@BBFBH@DBFBHBNABGFBJBFBJANABAD@B@FNAAGNALA@@D@B@FBD@DBHFBB@D@BB@B@BBBAB@@IDABAD@DBDBFDDBBBB@@@BC@EBADAD@F@F@D@B@DAFH@@H@D@B@BD@@@@F@@AB@@AAC@AB@@AB@D@H@B@@@L@RAB@BBFVDPBHBF@@F@@@HAFADAHALA@@D@FAH@DDDDHLHHFBF@D@HADAFAFALCB@RAD@DABA@@@EAE@ED@B@HI@@H@LABOTA@@BB@FBPBDF@JA@@@A@AA@@A@@@A@@B@B@NBJAHAHCJCBFDBF@N@FAL@D@@A@A@@@A@@B@@@BBBBBBBBB@BBFAF@FAD@JCFGEGEIEE@@DADADABAB@ACAGB@FCHAJCDC@I@AEI@@AEAAIOAAAEAB@@A@@@A@@B@@AA@CCA@@AA@A@AAACA@AAAA@A@A@AAA@AAA@AA@@CCEI@@AIPCB@TEF@^ID@BALEDAB@B@BDDDDBBBDBBBB@B@DAB@BC@AAC@@@EDCFAHAHAD@DBBBB@H@FAH@FAAC@@PCHAB@@AGK@@IMAAIMCC@@AAAIDCB@@A@@ACGIIA@@KCGCKC@@@@CAAAEIIO@A@@HABAFAAG@@@@CEAE@AACEA@@@AABA@@@@AAC@A@E@ABA@AABGBCBEIBAFCDCBAD@AEA@AC@A@@A@AA@@ACCC@@EBA@ABAACB@@@@A@A@A@CBC@CBDBCBCB@@BDE@ID@@G@A@A@CACAEBABA@@AC@C@@@@@G@@CAABA@CB@BCDCBCBE@@@AFGBCBEBABG@C@A@CBGBCBC@ADABAD@BADAHEFEFEFGBABCCB@A@@AAAC@@@A@@@AA@@AEA@@AA@@@A@AAAAE@AAA@A@AB@BC@ABA@@@A@CAAACAAAAA@CC@AA@@A@ABCAA@@AA@@AAA@A@A@CAAAAC@@@C@@@A@A@ABCBA@A@A@A@@AEACAAB@@ABA@@D@BAB@BA@A@@ACACAA@A@A@@@CBC@AAAACCE@A@AACBC@A@CBG@A@@@A@A@AAA@AYBM@Y@UB@@@@BCA@W@@A@@V@@GH@@K@@@ABABA@@D@@@@@D@B@AA@@AGAC@ABA@C@CGG@@AA@@AA@CAC@CAE@@KE@BEBGEJERQBAFGDCFGBCBEB@@AAC@A@EAABC@CBE@ADIBC@C@CBE@ABCBABADCB@FA@ABABABA@AAAACGG@@A@@@@AAAA@AACC@@@@@E@@@ADAGE@@@A@A@AE@A@A@@@A@AB@@E@A@EA@@@@@A@A@E@@EB@A@@@AE@A@@BCB@@BBA@@A@@A@@BA@@@B@@BA@C@@A@@@@@@C@@B@@@BEAA@AABAAA@@@AGB@@E@C@AA@@B@@A@A@E@@a@@DA@@AEB@@@@@@AB@@G@@BBA@BCBA@GA@AA@ABAE@@A@@AA@E@@B@B@BE@@@@FABA@E@@BA@@D@@@BGAA@@CDA@@@@EB@CA@@@A@@@A@@@D@@AE@GCA@A@C@AAC@CAA@E@A@CACAA@@AA@CBA@AB@@@D@@@BB@BFD@@B@@@@@@@DA@AAD@@AC@@@A@@@@@@BA@BBA@BB@@A@AA@@BB@BCA@@CB@@CB@BB@@BE@@@CB@AG@BBC@AA@@ACA@@@EB@@GB@@BB@@BFBA@@BDBB@@B@BDCBAAGD@@CCCB@@@@A@A@@@CB@B@@@BB@BDB@B@BD@@@BD@@DI@EBC@A@A@ABCB@@A@@@AB@@@CAA@@AAA@A@A@A@A@A@A@@AACA@A@@@A@A@A@@@AC@@@@AA@@C@C@A@@@ABA@@A@@@BA@@AA@@@A@BA@@A@@@A@AB@@A@@CBGCAABGA@@@@I@@@C@@EC@E@A@A@A@G@@@@EA@@A@@@@@@B@AC@@BA@A@@@C@@A@@IAA@M@@A@ABA@@CC@@C@@E@G@BFQDE@C@EB@@@AAC@A@@@@CA@A@@AAK@@D@@@@A@"],encodeOffsets:[[120624,34949]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
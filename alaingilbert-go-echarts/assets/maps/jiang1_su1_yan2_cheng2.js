@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("盐城",{type:"FeatureCollection",features:[{type:"Feature",id:"320902",properties:{name:"亭湖区",cp:[120.197358,33.390536],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@GFEFIECCDCCC@A@@@A@A@BCBCDECABCDGEICMP@@CDADABABCFAB@@@D@B@@@@GAEAA@AACAABAD@@A@A@@@@B@@@@@@@@A@@@@@A@@@A@@@AB@@@@A@AB@@@AECCCAAA@A@C@EBB@LLAD@@AJ@D@D@BDBFBLB@@AFADHBCJA@C@E@EAC@A@CBC@CAE@EB@@A@E@G@G@A@ACACA@C@CBC@@@AAEBI@K@E@UBWBA@A@GBA@C@@@AB@BAB@BAB@BCD@BABADADCFABADABCHABADCHAB@BCFADCH@B@@@@@D@BA@AFCJADCFAFCFFBDBDBHD@@BA@@@@FDB@@@CDAD@BA@@BNFNFB@@@\\FFBXFBBNDD@DB@@BBB@B@D@@@BBD@B@BAB@@@B@D@@B@B@@ABAD@B@JAD@DADFBDBF@B@@@BBDBDDDBB@F@B@B@B@DABANAZED@JCJABAB@F@H@B@H@F@@@DBHBFBBB@@BDBBBBFBBB@@BBBBFF@@JDB@DBHAB@BBD@FDB@L@N@B@B@B@JDDBB@JH@ABCBG@C@C@@@A@IAE@EAA@C@A@C@I@ABABA@A@ABABAFG@@BCBA@EBABABC@ABCDE@A@@BE@CDE@A@ABAMGBE@ADBNDB@F@B@TAHALAPAF@B@@@DBFFDBPPHFFH@@@@A@ABABEFCDCF@BAB@D@D@BBB@BB@@@B@B@RCD@@@B@@@BB@@@B@@CDEDCFA@@B@@@B@@@DBBBBBDDDBBDB@@BAB@BABCLIFCFGDBJ@DBB@@BDL@B@BBB@@BB@@B@L@HBB@@@B@B@FCD@BABAB@BCBCBCDA@AB@D@B@B@HBHBJDHBBBB@BB@BBB@@@@AJEPGbABAFADAPCDGDIBBJ@DAFEH@B@FFBHBPDHDH@BBB@FADG@EDEHCHBPLBDBD@@@FCBMBEBCF@B@BDDFDTHD@R@PEJID@VGD@F@DDBBADDFPFHF@HABEHCJCFFFL@RCDAFBBBDDDB@@PDB@JDBB@B@@CDABCDA@FDB@B@@BDDBB@F@@B@JEJHfe~§bV|ZvDEx«C£qECCAAA@A]YQJEBWSAA@@@AA@@@AAXK{]SKIOK@@GESOKGCCMIIGGEEC[SCCA@AE@C@@AA@@AACCCAAAA@GCEAA@GC@@A@EAAACA@B@@IE@@@CCE@@@A@AA@@A@@@C@@BE@@AADABABABABA@AFEBAFC@@@@@A@A@C@A@A@AA@@AAC@AFBB@@@FDDBBBDD@A@AB@@ABABA@@@@@A@@@A@@BCGCDG@@BABA@BB@B@AMBC@A@@@ABA@A@AA@@@KCA@GCGABC@B@@@ADE@BBCCA@@GJAA@@@@FEBA@@@@@A@@@ADC@@BA@C@@@@@@BCBA@AB@DEFIDG@A@@@@HIDILFRaDB@AYKC@_KmMGAA@GEADA@@AEACAICAAA@AAGE@@ECCCICGCCAAACAA@@@@B@@@@A@A@@@@A@@@@AAA@@@A@@@@A@@B@@@@A@@@@@A@@AB@@@@A@DE@@@@C@@@@@@@@B@@A@@@A@A@@@@@@AB@BAEC@AQK@@@A@@HE@@BADA@@FG@@BADAAACAACCAOGMIKICA@@CACA@@CAA@@AA@@A@AAA@@BAIGA@A@A@@AA@BAA@@@@@AAA@@@@AA@AA@ABAECBA@@B@@@@@@@AAA@AA@@A@A@GECCA@ECSICAABAAA@BAAAAB@@@B@@CCADEHBB@@@BABEB@@@@@AAAIDA@A@@@C@A@@@A@@B@@AA@B@@@@@B@@BB@@BB@@@@ABBB@@@@@BC@@@UZ@@BBABA@AB@@@B@@@@@@@B@@@@@@A@@@@@AAAD@AA@@BA@@BA@@@AA@@@B@@CB@BDDABCLA@@@CACFA@ABAA@BABB@BBB@BB@@DB@@BB@@H@FBH@L@DB@@B@@@B@@B@@@@B@BB@BB@B@B@B@B@@@@BA@@B@BB@CDA@@AMNBB@@A@EFFDEFA@A@@BBBA@AACBDBABA@B@ABABCDB@D@EH@@A@@@ADB@ABA@ABAABAAAAB@@@@AA@@A@ABEACDAB@@AB@@AD@@AA@B@@ABB@ABFDEFC@ABABBBB@BBBBB@B@B@B@@@B@@@B@DB@@BB@@BA@@@@BAFA@@B@@B@@BB@@@@B@BCDBB@@@BB@BB@BBB@B@BDDB@BB@@@BBBA@@DEF@NBLAP@B@@BBBB@@BABADCBBB@@@@AB@DGCCCAAEHBB@@@B@@@B@@D@@@@@@BAAAD@@ABA@@@@B@@@@ABB@AB@@@@B@@B@@@@@B@@NHD@@BA@A@@@A@ABA@@B@@A@A@ABA@A@BBD@AD@@@@D@@A@@@BB@@@D@BB@CB@D@AB@@@@BB@@DBB@DB@@ABCFABAF@@ABABEFEDCBCBGFA@CDCBA@ABAB@@E@CBCBCBADCHCDADEDCF"],["@@DHHLBHFV@@DDBLAP@BCH@B@BJDFF@BB@XB\\B`GBA@AACIUEM@CGG_kEGACCACA@BQDOBOD"]],encodeOffsets:[[[123058,34156]],[[123300,34567]]]}},{type:"Feature",id:"320903",properties:{name:"盐都区",cp:[120.153712,33.338283],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AB@@@ACAAABA@A@@@ABAAAD@@A@@@AAA@@@@@C@E@A@GBC@CBC@KBEBE@IBC@ABA@A@@AEAEAA@GAICA@C@GAA@C@A@E@@@CAEBC@C@ABAB@@GC@ACBAAA@A@@@C@BACA@B@DC@BCA@BA@@@CE@A@A@CIEBKDDJBB@BA@@B@B@B@BA@A@@@@A@@KBABD@@D@@@@AB@@A@A@A@@@C@@AD@@A@@A@IACBA@CA@@C@IAMAA@@@@@@@CEA@EDA@@A@CAE@@EBO@A@CAC@@@AA@@AD@@GAECGAECA@EAAAAB@@A@@ACAA@C@@@@@A@@@AA@@@CA@@D@@CA@AA@@@@AAAA@@@A@BAAAA@BB@@A@C@AA@@@A@AA@@BE@@@A@@@A@A@C@C@@@@BA@@DAA@BA@A@CAI@AF@@B@@@@BC@AD@B@@@@BD@@A@A@ABAA@@@@AB@@@B@@@@BBA@A@@@@@@B@BAB@@@@ABA@@@@BD@D@F@D@@B@@AD@@CA@@@DB@B@@@@F@DB@@BB@BBDAB@@BB@DD@B@@@@@@EB@@@@AA@@E@@@@@A@C@BBBHDABFBFIDA@A@@@CCA@C@A@C@IFA@@D@B@@A@BB@@@B@D@D@@A@BF@D@@@@BH@@BB@B@D@@@FBB@@@@@HB@DADADB@@@BB@B@@@@@@B@BA@M@@LE@A@C@I@@LG@GBMDMDMDA@@@CJGHCH@B@@@@@@B@D@@BBBBB@@@B@BBB@BDDBB@@BB@DB@BFB@B@@@@@FD@BCB@@FFBB@@EBABBBFJB@B@D@@@D@D@@@@BB@@@@B@@B@@@B@@@@@@BBB@@AB@@A@A@@DBB@D@@A@ABBD@@@@C@@BAFJ@@@@F@@JDBA@E@@B@HDFBB@FDDB@@BBHBFBD@DA@B@@@@@BFBAB@DABF@@@@P@B@@@DJDB@ARHDHBRBJ@D@BBP@LBX@HBF@X@F@RB@@D@B@B@@@B@B@BBBBBBJFBBFBDBB@BBDBFDDBDBDBBBFBBBB@BDBBB@BBBBB@DBD@FDB@BBFFDBBBBBDDBBB@DDBBBDB@BBJFDDLHBB@@BB@@@D@D@B@D@B@@@BCD@@@B@@@@BB@B@@BB@D@B@BABB@B@@@BBB@BD@BDBBBFFB@BBD@HBJ@HBF@B@BBF@XDJ@BBD@DAFBB@BBD@J@PDF@B@B@DBDGBABCBADEBCBCBA@ADC@ABA@ABA@ABA@@D@B@HAB@B@XAVAF@L@J@FABB@@D@DAD@B@BDBDB@H@H@F@B@@@FAF@DBD@DAB@D@FBF@D@B@DIGABCBE@@KAEACA@A@C@CBI@@BCKKA@FAD@B@B@BBDDFD@B@@BAB@@@@@BA@@B@@@B@@@@@B@@@@@@@@@@A@@B@B@@@BCBADBBBB@FBHB@@@@@A@C@@BADEBABABCDC@@NOJDHFDCBAFDDCDA@A@B@B@@@BDDCDDDJFFEHEDEFCBCDCDGBCDADADAF@@@BABAB@DADCB@HEDADAFCFEBABA@@BEBADEBA@@CAA@CA@@AA@@@@BAC@A@@DAAC@@@A@@A@@@BC@@@@@BCC@AAB@B@BAB@B@@@@AB@BAB@@@B@B@@AC@MG@@@A@@@@@AA@@@@@BAA@BA@@@@@A@@B@BA@@BCBB@A@@@@C@@@@A@@@A@@AAFGBBDDHD@CBA@@@@AADABCBA@AA@AA@AA@O@KBMAE@CF@@ABAA@@A@@ACAACA@A@AAA@@AAA@@A@CAADA@@@@@AA@@@AA@@@EBAB@@@@AB@@AA@@CAA@@@A@@@A@A@A@A@AAAAA@AABABAD@FEECBAA@BA@@@ABB@@BC@@BA@@BADCFBBAB@@@BB@@@@BABBABBBBAB@BAA@BC@@B@@@FGC@A@DCBABAA@B@BACADABBB@AA@AB@B@FEECFEB@@@AANM@BB@DCA@@A@AB@@A@@A@A@A@A@A@@AAAA@@@@@@AA@@@A@@@CAK@G@EAG@@@AA@@CA@@AAA@AAA@BA@ABBBAB@DEDB@@B@DKBACC@ADA@@@A@@BB@@B@@AB@@AB@@BBCBB@@@@B@@@@@@@@A@@@@@@@A@@BAB@BAAA@@VY@@D@@A@@@@AABA@@@@AA@@AA@@@A@@@@@ABB@@@AB@@@B@D@@@B@B@JCBB@B@@@@FABA@A@@AAFGBCDD@@@A@@BACAA@BABCCADA@ABAB@@BF@@ABB@AAACAB@AC@C@A@@AA@A@@@ABCBA@AA@BA@@A@AAA@AA@A@AAA@AE@C@@@ABA@@@@@OFE@ED@@GBOFIDCBIDIBIDE@CBIBGDEBCBWFKDGDCBA@ABC@EBEBED@@@@OFC@KFOBIDA@EACCEAECGCIEA@GEAACAA@@@AAEC@@YCGAAAC@CAA@GAE@EAA@E@A@@EAA@@AHC@A@EA@@A@@B@D@@@@A@AAA@@AGA@ACAA@AAEAA@C@E@"],encodeOffsets:[[122863,33948]]}},{type:"Feature",id:"320904",properties:{name:"大丰区",cp:[120.50085,33.200333],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CCCAECACCCECA@AA@@CBI@A@GAG@E@E@GAE@CAE@A@@@AC@@A@@@CDAB@@C@EB@@AAAC@C@@A@@@A@@AAB@AA@@@A@@@C@@@A@@AA@@@@@@B@D@@@BD@@D@D@@A@G@@B@@@BBHB@@@@A@A@@@@BA@@@@DA@@D@BBFBDDB@DD@@@BB@@DAFBD@@@@BBBB@BBDA@IBA@ABA@@B@@@BGB@BHA@DC@A@@@@B@B@@@DFAD@D@B@BA@@DABAB@DBD@@B@@@@BF@F@DABAB@B@B@BFFDF@@DHBA@B@@HAB@CB@DCBBHBB@DBDBD@DBB@B@BB@@BF@BF@@@@IAAA@BABAB@J@@BBLFBB@B@DAAEC@AEC@@AAAD@B@F@B@D@B@B@D@D@HC@@@@BD@@@BB@DBD@D@F@B@D@@BL@B@BBB@@@B@@AB@B@@@B@FAD@BAB@B@B@B@BA@@@@BA@EBA@@@BD@@@@B@@B@@@@@@@BGFABEBA@AD@@AB@DAB@BA@ABAD@B@@@BBD@@BD@DBBAJ@B@B@@AD@DAB@@@@CB@B@@@B@BBB@@@B@BBD@@@BBD@@@B@BBB@BBD@D@DBB@B@BBB@B@@@@@@CBA@CBC@C@IBI@G@E@I@C@C@@@CAA@@@A@A@A@CBGDCBA@A@@@@A@@A@MBE@E@A@CBC@@@G@KB@BBB@B@BBBB@BBB@@@ABB@@BABAD@B@@@BBB@@@B@DBDA@DBBB@BAA@BE@@AA@AB@BCBDBADABB@DBBBABB@BBBADBTJFDB@DDHFB@B@@@BBB@BB@@@@@@A@@@ABFDAB@BBBB@@B@@B@BB@@@@B@ABB@@BB@B@B@JHAB@@BB@B@BB@@BB@DB@@DBDB@@DBLJNJPHDBBDDBBBCBAB@@EH@@CBAB@@GF@@@B@@RL@BFDABA@@B@@@@B@B@@@B@@@@A@@@@@@D@@@@@CFB@@@@@BA@@@B@@@@@B@@A@@@@B@@B@@@B@BB@@@@@B@@B@B@@@@@@A@@B@DBBBDBHDJDDDFD@@HFBBB@BBJDDBFB@BB@BCHFB@HBnN`LD@ZL@BCAQbKECJGJ@@@@@BCHEJCFA@@BABAD@@@@@@@DAB@@CD@B@@@B@@@@ABEF@@@@BBHI@@DBAD@ACF@B@@@AADHBHDB@LD@@B@@B@BAB@B@@@BADBNA@A@@AABAB@@CHHDAD@@@B@@@B@@@@ABAB@BA@@B@BCCAACAEC@@A@EA@BBD@BB@@B@B@B@D@B@B@@@@EDABEF@BABABABABCBBB@@AF@@@D@@@BB@@B@B@@DF@D@@JF@@@ADBBBFBB@@@HDB@FBHDB@BBDBDDBB@@BB@@@DBFB@DD\\TFDHFJHNJDDLHTPHF@@PLLJ^T|WLBB@@B@@B@@BBXTFARI^Z@BBBDBFD¤r¬DBC|±éFaPDUBQJ[J[TQVQPY\\E\\DRARCPINMHSBMCKEGIAGO@eLET@PIHSGUJaX¥^¥EnQRIFGHUsUI@qBA@@@KIeY\\IB@BA@@BEAS³@O@yB@SU@G@K@@SW@A@@@@@@CC@@D@@@@A@@eE@M@CACA@@@A@@A@AA@@AAECCA@AK@@IG@@@@C@@A@@@@@@@@BCAA@EC@AA@A@@AE@@@AAC@@A@@CA@@@A@@AA@@C@@@@@C@CB@@CAABA@@@A@@@@B@@@@A@A@EAA@ABA@@@A@ABABAB@@A@AB@@ABABA@@BA@AB@@AAA@C@A@ABA@C@@@C@A@@@A@@@@@AAA@AA@@AA@@@BA@@@A@AB@BABA@@B@@@@@@A@@BA@A@@@A@@@ABC@@@@BA@A@@@@BAA@EE@@B@@@@BB@@AB@BAB@BB@@B@BAB@@@@A@@@@@A@ABCBABAD@@A@C@@@@B@@BB@B@@@B@D@@@B@@B@@B@@@BAB@@AB@B@BA@CBA@A@@B@@@@@B@B@D@@A@@B@B@B@BA@@B@@GEKCDE@@A@ADA@BCAA@@ADCA@@AA@AA@@DCABA@@B@@A@@@GA@@BC@@HEC@@E@CA@AIA@@@@@C@@A@@AA@@B@@@@@@AB@AGCCA@@@@A@CA@AEAAAMGADEAA@AA@@@BAA@B@@A@@A@@EB@@CABCAABE@@@@@@A@@@B@DB@ACAGAEAOE@AA@AA@AC@@BMC@@A@DB@@@B@@@@@B@@BBBBB@DDB@DD@B@B@@ADAB@@@@A@A@AB@AC@AACAAAA@A@CBC@C@GAEAE@A@@@C@C@C@K@C@A@EAA@A@EBCBCB@@A@A@C@A@AAA@E@GBA@AA@@EBCB@@A@@AA@A@CB@@@@ABA@@A@@@DA@CBCBAAAB@A@@@AAB@@E@@@A@@D@@B@FA@B@BA@A@ABA@CD@@A@EBA@A@EAE@KBA@@@C@C@I@E@C@C@C@A@A@IBI@A@AAA@E@@@@@AACCA@AA"],encodeOffsets:[[123238,33727]]}},{type:"Feature",id:"320921",properties:{name:"响水县",cp:[119.578364,34.199479],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAEAGAA@CAEACA@@AA@@AA@@AA@A@A@ACBA@A@@BA@C@A@ABAB@B@@@@@B@B@FA@BDC@@ACB@@@B@@@@A@CF@B@DB@@BC@@A@ACDG@A@@GA@A@CBCAGCABA@CDJDBB@@FBFBAFA@@BC@CFB@D@@B@@@@@@BBABB@CDNDAFA@ABELJD@DADA@ADCA@BC@C@@BKA@DCAAH@HCA@FKCMAALC@@@A@E@@BDD@@ABDB@@DB@@B@@BDDAHD@ADEPB@BBM\\A@@BABC@@@BBAB@BAAABD@GP@@B@@B@@AB@@@BB@A@@BFBB@DB@@BBBBBBBBB@BBB@B@B@FAD@D@B@BBBBB@@BBB@BBB@BB@BBB@@B@DC@A@DB@@@@B@@B@B@B@@@@AB@@@@@D@B@@BBFBFB@BCAA@@@@@A@@BCB@@ABA@AA@BEFAB@@ABABABBBBABBA@A@@@CA@BAA@@B@AA@@A@A@@@@@A@A@@@@@@A@@BAB@@AA@AABA@@@AAA@@CHAH@@CF@@A@AA@AA@@BA@BAAABA@@B@CA@@AD@@EHAFCFABAFA@ADGA@ABAGA@A@@A@@AA@@@AAC@@@CDABADA@@BA@CJEFADAB@@ADABCC@@AB@@@BB@ABDBCJPDCHB@@@@B@B@@@BD@@@@@ADDB@@@@@BB@@B@@@DB@BBB@ADB@@@AFCA@BD@ADA@AFGA@BB@@BAA@D@@A@@@IC@BOE@@AAB@C@A@@D@BAB@@CB@@AB@@@B@@ABA@CH@@@@BB@@DBDBFBBBBBBBB@FBDBBBB@BDB@BBFBDBFDBBBBBBBDB@DDJHB@DFDBFF@BDB@@B@BAD@DAB@@@F@F@HBBBD@HDDBDBDBBBBBJJDBB@NHFDB@HBDBBBHDBBDB@BFDJFFDHDBBDDDBFBDBHBFBH@FDB@NFHFFBDBBBBBDDDDJDDB@@HHBBFBBBB@DBDDDBHDFDDBBBBDJF@@DFDD@@DFDDBBDBB@DBHDFBDBB@BBBDBB@D@DBD@DBB@BDBBBFBFDBBDBBB@@@B@@ADAD@FCL@DBB@B@BB@BBDBDBB@FBD@DBFBDBBBDDBDBDBBBH@BDDBBBBDBF@BBTFFBB@BBBB@B@@@B@B@B@BABAFEFABCFEDADCBCHAB@D@B@D@DBD@BBDHJFJBDBF@DBF@FAFALCHF@DB@BDANC@B@@BL@@BBBJ@@DBD@B@B@BABHF`P@fIvCnGlCfG^GTE\\IPIlQPGDAzY¼}NGggKECACCECAAECCAAA_V@@FJ@@a[NK@@BA@AUQFEZQBACIAAAACAA@AA@AAAEKAC@ACGCE@AAA@C@@BCAAAEAAACAA@@_YIGMICCSQMIWQSQECHGKGEFA@AAACIG]YACECEEOM@@KKa_CE@@CAGEECOIA@EEECCA@@@@IICACA@AACCCAEAA@AACAG@AACGKGICECCAAABA@@ABA@@CB@@BC@@CB@@@@BC@@A@A@@@B@AAAA@AAA@A@@GA@CCC@@@AAA@@@@CG@@AA@ACID@@AAB@@C@ACD@EGCECCEEGIEE@@@A@EA@AA@AAACA@ACACAA@@AA@AAAA@@IBC@QBE@A@AAE@CAC@C@EAKEGCA@AAAA@@@A@A@AACEEACCAA@CCCAC@C@C@A@CAME@@A@AAA@EAA@AA@@@@@@BABADA@@B@@CCCCAAAAAA@EAAAC@G@AAA@AAAAAAGGAAIKIIGEEICACECC@ACCAACAGABCA@AA@@EEGCAAECCBAOAB@@AB@AAB@@"],encodeOffsets:[[122517,34771]]}},{type:"Feature",id:"320922",properties:{name:"滨海县",cp:[119.82083,33.990334],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@EA@@G@@D@@@C@@AMA@@@@@ADE@@@BNBBEA@]IOCCL@@ABGRB@IVFB@@@B@@DBBA`HHB@@@@AA@A@@AA@AAABAA@@A@@BCFB@AAAF@BAD@BC"],["@@D@@BA@EAKAAF@@@DA@ADABADC@ABE@BB@BEAAD@@@BB@ABBB@BBB@@@BBB@@@@GAK\\@@A@A@ENC@WGHU]IHSIAHSYGA@EEECBEDI@ADIoMBCBC@@AAGEHACCB@GIPEB@HAFAD@JCA@@@ACCE@ABKTABKJB@CB@@AA@GEECAAIDGBEKLCAAAACEEECEHCDD@AEECAECEC@@A@DMBA@@AMAOA@BDO@@CD@@AFA@D@@@AB@@AB@@BB@AOAMBCBCDGNYDCDCDA@CBCBM@EAECCAAA@AAA@EAA@O@EBEDE@A@E@A@AACAAC@AACA@C@A@@DCF@BEHKNADCDEDABEBABA@A@E@CAC@GCGCA@A@A@AB@@A@@BBB@DBFDDBD@FBD@B@F@BABADCBCDAB@@EBCBC@A@C@A@CAC@@@AAACAA@CAOAC@CAA@AEECCCAAAEAC@A@A@ABA@ABADAB@BAH@HBD@BAB@BABABA@ABA@A@A@E@A@EAKCCAIAKAEAEACAAAAAAAAA@A@A@A@C@A@@BCBA@ADADAB@DAF@BAH@FAHAD@DABAB@BCBA@A@C@A@CAAAC@ACCAAAAC@CAA@G@A@C@A@CBEBABGDA@C@EBCAC@CAEAAA@@AA@@@A@A@C@@BABCJGLI@ABCBA@A@C@A@E@ACEAACC@@A@CAG@A@C@C@CBCBEDA@A@EAEAEAI@EAC@CAC@@ACGCCCEEECEAC@A@@AG@AAAACAACAAACAC@CAOEC@CACAECA@AAC@EAE@AAC@AACAAAAAACAC@AAAA@@AA@EAC@CAA@CBE@CBC@AAA@A@AA@A@@GAAB@@@B@BAA@@AB@AAD@@ABECABAD@@@DFB@DBD@@@@@D@@@@DCBBB@@@BB@@@@AFA@AB@@A@@BAA@@A@@@AB@@A@@A@@EDA@@@@@@@BB@@@@@B@@@@A@A@@B@@BBA@@@A@AAAA@@@@A@AB@B@@@@@B@BA@@B@@@@B@ABAFE@CAA@EBCEAA@A@CED@JABA@@DABEA@DG@AAA@@B@BC@@BD@BBAHA@@BCAAJ@@@@D@@B@@@D@B@@AB@BJBABB@B@B@ABBB@BB@@@@@@@B@D@@@AB@BAFDBF@@B@B@B@BEA@DAB@@A@@DA@@@ADA@@B@B@@AHF@D@B@@@B@@@D@BAB@B@@@@DAA@B@F@@@B@@@@@@BBD@B@B@BFDFBDC@BBBA@BA@@BA@@@D@@B@BA@@@@@A@@BC@@BAB@@BB@@@BA@@@ABCAA@@@@A@@@AA@A@ABAB@@BB@@@B@@@DA@@AAA@@C@@B@@E@@@@@@B@B@@AB@@A@A@AA@@@A@@A@@@@BA@@BA@A@A@C@@@A@BB@B@@A@C@@@A@@@ABAC@AE@@BA@@ACBSB@@ADABB@BBBBBB@@@B@@@@C@AB@@A@A@@@A@ADA@AD@@EAGCIEEAKEGEMEMIGCGEGEEEKGEC@BABy\\DD@@@@@BEB@@FHBBCBA@E@ODCEC@CB@@A@@AEDBBA@A@A@A@@@ABA@@AA@A@@DBA@B@@B@BBA@@DDABB@@@BC@@@F@@DC@@B@@@@AA@AA@@D@@@BD@@@C@B@CBB@GB@BA@@@@DABAC@@@AAAB@B@@AC@@@@@@@A@@@A@@AA@@A@AAA@@@AAB@AABA@ABC@ABA@@@BBAB@AC@@B@@@DC@@A@@@@@AIDABFFCD@@@@@FCBDJFABDAB@BB@A@GDBBEB@@@@@BA@ABDBB@HBFBBB@@BA@BBA@@BABPDAFDBBHDFF@@BBB@ADHBDBBBDD@BDDDFDBFJHFJJJLBBHHBBBBBBB@BBH@D@BBFBB@BBBBDBDD@DA@@@CBABAB@@@@@@BBB@FBB@BBB@@@NFDBB@D@D@D@DBDDB@DBBDFFBD@B@B@B@@BBBBB@HDLFFBD@D@DBF@BBB@F@RAD@JA@@BBBBB@@BB@DBDB@BDBBB@BBBB@@F@B@@FFHJFFDDDFFHC@BDD@@@BA@BC@DJ@BBB@@DH@@@@BB@B@@DD@DHB@@@BBB@BBBBBA@@@B@B@@@AD@@@@DA@@AD@@DA@@AB@BB@BABBDDDFHJHLBD@BBHBD@BBBBFDDBD@BDBDBJJ@@@@DBFDFFB@PJFDHFDB@@DFb`LL@@PNFFFDBD^ZJHBDBBB@FELHGHFDTRXRNJTRDDNJJH`Z@@BBBDBBBFBBAD@@@DBB@BDFDH@BBDFLBB@BBBB@DBBBBBDJABYREFVR@BAB@@MLb\\@@EI@@`UBBDBFDBBFDDDDBLFhhlQ]bMPGbMLCFA`MºgRKVI@EGMFkBID_RkRqXkHQR{BUDOBSH[AGDC@A@A@AACAC@ICEAEACL@LAAIZADHBBBDHBL@BAF@BqDC@A@C@MEA@AA@@EGGICCCC@@@A@CBAA@HQGA@@LYJYCAICGAGAKAQEAFBBFBFB@@F@BB"]],encodeOffsets:[[[123118,34915]],[[123130,34908]]]}},{type:"Feature",id:"320925",properties:{name:"建湖县",cp:[119.7886,33.439067],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@@@DEOMC@C@@@K@@EE@@GCBACC@@BA@@BCBCAIR@BIFCDDBDC@@@@@@BJB@BD@@AB@B@FB@@@AJIB@BC@@BAA@BA@AB@@@BBB@@FL@@BDABC@BF@@@BE@@B@BA@@@DH@@Q@M@APKABFHB@@GXSBAFLBJBETCLSA@@ABQDEA@DC@KG@@ADIE@@@@BBAB@@AD@@DHBB@BA@ED@B@@BBD@@@AF@D@B@B@BB@BD@@ABA@A@ABDBB@B@@BH@DA@BDHBBDB@@B@BBCBBBBB@@@AB@DDHEDFB@@BAFIB@BC@CBHBF@@@@@@@F@B@@@@@@B@@NDFA@BFA@ABFJCDDAF@BA@AB@@AB@@LCBBKFFBIFBDJEBFED@BCD@@@DAF@BB@DBFBBBH@B@F@@AF@B@@@B@H@HFGDBBEFEHCDFJEBBDBF@D@B@@BHDABF@@BEF@HJB@@BABDDBABH@@@@@BBD@@HABB@BI@BDGB@@FB@HA@@FB@BBD@FA@AD@@@J@B@@AD@CID@@A@ADAFJBA@@B@@@DA@AHAD@RB@@FFABBB@BB@@B@@BBBDBAB@@@BD@@@@D@@@BDBFBBB@D@BJ@DBBBD@@B@B@@BB@@B@DDP@BBDBD@DDNDN@B@B@FBBBDBD@DBH@BBBBFBDBB@B@BADB@@B@BB@@D@B@D@B@BBB@@BB@@@@@BB@B@BBBB@@@B@@@@@@@BA@CD@@BB@@B@@@@B@@@@@@E@@@A@@B@@@@@BB@@B@@@@@@@BA@A@CA@@@B@B@BBBBBFBLFBB@@@B@@@B@BBBBD@B@BABA@ADABIBABAB@B@BBDB@@BDBBBB@B@D@H@@@D@@BB@@D@DB@D@B@B@D@B@B@@@@@AA@A@@@E@A@C@@AAB@@A@@B@@@BBB@BBB@@@D@@@@BB@@@@@BA@BB@@@BA@@B@@@B@D@@AA@@AB@@@BB@@@@BABA@@B@@@@A@A@@BA@@B@@B@@B@@@@@BB@@B@B@@@@BB@B@D@FBB@D@HBB@DBD@B@D@B@J@H@PBD@B@B@B@B@@@@A@@B@@@HAF@HAD@B@DAB@B@@@D@F@D@B@F@@@D@BAB@FA@BH@BAB@@B@B@B@@D@H@F@D@J@AIAC@C@C@CAARA@@@@DA@DHAHAA@D@@@D@B@AEB@D@@@B@B@@DNBPDB@B@B@FBBAdD@@@@JFB@RJ@@JD@BB@DFBBBBB@@BBB@BBBBB@@@@DBD@@@@AFBD@@@BBB@@@@@@B@@@@D@DDB@A@@@D@BB@F@@BHLFDBBBBBDB@@F@B@@@@@@@@FB@BBLBD@JBBBDB@C@@@BDB@@@@@@B@D@B@@@@ABB@BPH@@@B@@@B@@D@FBF@@BBBBBBC@@@CAA@A@@@A@@BC@A@C@A@C@AAE@G@A@C@@@ABE@C@A@A@ABE@AAAEI@@@A@@AAA@A@AAAAAAEC@A@@@A@A@A@@ACAA@@@A@A@@BC@A@AB@BCDC@A@ABC@@@AD@@B@@THLFHFJDFBBACAABAA@BECDCLFJE@AGABABA@@HDDCA@DCBBHEAA@ADBBAAA@@BCA@BCDBBBFEFIBAMCEAC@CCCACAIECCAAMKCAAC@@@A@@@ABK@ABA@A@@@A@@@AB@@A@A@@@@AA@@@@AE@EDBB@AC@@@@@ACA@@AKBEBGBC@C@@@@@M@@@@@@F@BC@ABEHUCCCAAA@@A@E@CAEABC@CBC@I@ABCBA@@@A@AC@A@@@A@ABA@C@AA@@C@A@A@AA@@CAC@MCAAWEEA[E@@A@MEME@AB@@ABCDC@@A@EC@@@@AB@@GCCACAEADEBEDEBCDIBEB@@A@C@@@@@ADGBCDE@ABADGBCBACAA@A@E@OCI@C@AAA@EACBC@AAI@WCE@AAA@E@GAI@GAC@AAA@EEAACA@AACA@AA@@A@A@BA@A@A@CAA@@@AAA@@@@@A@@DC@A@@@A@C@A@C@C@@AA@@AAKGCCIEAAA@ACAACCA@AACCAAAACAEEAAA@ECC@CAA@AAAAA@AAACA@AAEAAACACACAECCAAAA@CAEAAAIEAAAAAAA@A@@@A@A@C@@@QAE@W@E@GAW@KAO@AAC@I@QAGAGCBQA@IC@C@@@A@O@@E@BA@CBAEA@A@@@@@ACBC@EAGAAA@@CAECA@EAGCA@@@@FABIC@@@E@@I@ID@@@B@B@@CFB@@BEABFI@ACMA@FMAAFGA"],encodeOffsets:[[122550,34096]]}},{type:"Feature",id:"320924",properties:{name:"射阳县",cp:[120.229986,33.758361],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@DOLSBMI_BM{YaU}¨efIGIFA@@@@EAACC@AA@A@ECB@DCBADC@@@AAAICA@OC@@CACCAAEACBQDK@EEDEDIFGBA@GGEOECEBCAACCE@C@UHC@IJOFQ@C@SGECCC@A@ADEFANADA@E@@ACACOKGAGDCF@FCHEBA@AAG@GCOCGAEA@E@AFGBE@CAIJAHCDCBOBCBEBAHaFOBI@@@@AA@AAAA@AAGAICGAGAA@A@C@A@@BCBADADADA@ABABC@EDA@A@@@A@GAK@A@@@AA@@AA@A@ACK@AA@CAI@CAEHEDKJADABA@AB@@CAAACCACAAAA@C@@@A@@@AB@DEFCDC@@@A@@AA@@A@@@C@QDA@A@@@A@@AAA@A@C@CBA@ADEDCFEBABAB@@@@@EGGEOOCAEECA@@A@E@OBKBGBSBA@E@A@MCCA@BAFNHAB@B@BCF@DAF@@@BCFAD@BADABAB@FABAD@@EHABAB@B@BABAB@B@J@D@B@DBB@FBF@J@B@@@D@DAHAD@BIGA@CAICA@A@A@M@K@A@ECC@AAA@GBCAA@IC@@EEAAAA@@AAEAAAAAAC@@AAEAGACA@@E@G@A@G@E@A@ABIBIDC@YFMBABCBA@A@A@E@A@CAGVAF@BADE@@@@@@@@N@@@@@DADAHAFBL@@DB@B@@@@BDA@CA@FBF@@@@BB@@@@@B@BA@@B@@@B@@@BAB@BAL@B@@@B@@BDDBNLBBDDJFDBDBDDD@FBNDABEJEFAACAADB@AD@@BBABCA@BBBGFAACDB@CDGC@@ABABHB@BIFKECDFD@ABBBADBABEAICGEKESG@@@AC@@B@@AD@B@BCDADA@@B@BAD@@@B@B@@BBBD@@@B@B@B@@@BFDBBBBBBB@B@BB@@@B@@FJBB@BAF@B@B@B@DAF@B@@@D@B@HBF@B@D@B@D@BAD@@@B@@@BBB@D@@ADAAAA@AE@EAC@@@@A@@@A@@OG@AAA@B@@A@C@A@@@@@@@CA@A@@@DCAAAIAC@KAAAA@@@CAQCALB@@BC@GAADSEIC@@A@C@GAC@@BCB@B@@A@CACA@@A@A@@@CHGHA@@@AB@@@@@@@@AAAAA@C@A@ABABA@CD@@CB@@A@A@A@BBA@A@DB@BB@DBPHAD@BFB@@@@EA@D@@@@AB@FAF@@@@PBD@F@B@@@A@@B@@@B@@@B@BB@@CD@@A@ABF@@D@F@@D@@@B@@BA@@@B@@BAAC@@DA@D@@@@BBABDB@GB@@@@BJB@DA@BBA@AA@BD@@B@@ABAA@D@@@@@@AB@@@@A@@@@@A@@DEB@@CDBBFDADIGCFCACDB@@@A@A@@@AF@@@@CDBBCD@B@@@@A@CF@B@@@@B@@@B@ABDB@@@B@B@@BB@BDB@@FB@@@@@A@@H@D@@BB@BAF@@BD@H@@BD@B@@BFF@@@F@@ADSA@B@@A@@DAA@FDBDA@@B@@B@@CJFBABGCAD@@@@GA@BC@C@AJNFCDCB@@B@DBLA@ATJ@@@@C@@DMA@BC@A@C@@FBFC@BEC@@BAA@FB@@BB@@@@HA@@DGAAB@@DB@@@@A@@@@BAA@BABA@@@@@@B@@D@NABA@@B@@@@@d@BFBLC@@@A@@BBB@DE@@DB@AB@@D@@BBD@@@ED@@CB@BHAB@AA@@B@@@B@@@@SDI@A@@@@@EA@F@@I@@B@D@@C@@@@@G@@@@DADB@@@@@AF@@@@A@@B@D@@A@@BGBIB@BBH@@@BBDDFFFDFDDDH@BD@DBD@FBJ@FBFBFBB@B@FCDADAD@D@B@H@DBB@@@DDBBDF@B@F@B@D@BABAD@BKJIHADAB@@@D@B@B@@BB@@BBFBDBD@DBFAD@B@HCBAFADAB@D@B@H@B@DBD@BBBBDD@BBDBB@D@B@D@BABADA@ABCBC@GBEBG@ABE@CBA@CBCB@BABAD@@@B@D@B@B@BBBBBBBBBDBFBFBLBJBDBLDFBB@F@B@B@B@BAB@BABA@ABA@AAC@GBG@ABABCBAB@BAB@B@D@FBBBDBDDFF@BBB@DBDBP@DBBBDBB@@D@DBB@D@B@D@DAFA@@BADCDABCBA@A@E@AAC@EACCCAE@CAA@AB@@@BAB@B@B@HDHDD@DBF@B@B@BAFABAFCDCBCLMFG@ADE@CB@D@B@BD@BBDDBBBB@F@B@F@FCFAP@B@FBB@BBB@BBDDBF@FANAD@DCBCDCDMZCHADADBNBPA@@AA@@BA@@B@@@CEB@BC@@DP@ACB@BPBN@@ABCNB@@@FDFDDBFF@BCCGDDFFFDFBBBBKDFLHAJCBBFDHFB@@BA@@DIAALSBAL@BDFBD@@B@IDC@EBGBA@OFHJA@DDGBHFBB@@ADADpNCJ@BCJAFFDFFB@ZHGTJBGT^JGVXHD@FMB@B@@@L[_GABCA@@@A@@EAJUA@HQBA@@DKPD^JB@RFLBHBHBJDDBIZKZ@@HBGRB@AB@D@B@@DDDDHJFH@@BBB@NFD@B@D@rC@ABE@AAKCGAAGABCJYBB@KDKFBFBJDD@DBBBB@B@D@HCBDQBGBGVkRe|¹PaLSi@s@@E@]@]B{@gBS@@@I@W@m@_@K@MD@@CTAJINHDBB@B@D@DAB@B@@A@ADG@ABOAKCC@@EUAGGKCGPCPARC@AAAEAGCICA@CAE@A@CB@G@GBA@@B@D@DCCACCKIB@PFPFNJZ\\JNDJWZFMDKJSBGD@rL@RNBHATADCJS@AMCC@QEAAP]@AHY@C@AHDVFBABCB_FB"],["@@MA@A@@CF@B@@@@NB@BD@@@C@@@@HB@@F@@BABCB@@C@@BELBFBB@@AC@AAE@@@EAEAAA"],["@@EAA`ADABUEGC@B@DGZ@BO^BBRFD@ND@BITCDSBGBMA@QqKC@AHITCLENYIXMC[IYMIOEOEA@LJDDDBCDC@A@@@AB@H@HDAB@F@DBB@JDHDFBBBDBDBBDFH`lHH@DFNJVBD@BAB_H[AWAA@@AEEICA@A@CBC@A@A@CAMGIJSB@DC@@N@L@`@n@X@J@@AT@hA|@^@^@Ft@j@²ęDINgLaVut­ZxTSuANJ`ANKTCP"]],encodeOffsets:[[[123478,34446]],[[123119,34904]],[[123478,34446]]]}},{type:"Feature",id:"320923",properties:{name:"阜宁县",cp:[119.802527,33.759325],childNum:1},geometry:{type:"Polygon",coordinates:["@@BE@ABA@@C@A@@@@@@@A@@AAABAA@A@A@BAIA@ABA@@@A@C@@@AC@@@@@BIDB@AB@BGAAC@@AD@@A@AB@BBH@@CFBBA@CB@BA@IFC@D@BBBDFFAB@DBF@BEBAA@@@@@@AB@@A@A@@@@@ABAB@@@@@BBBBB@@@B@AA@@@AB@B@@@@@@A@@@@AA@@@@@@B@FC@@@BB@@@BA@@B@@@BB@AB@@@BAB@BE@@@@AA@@A@AACD@@@@@C@@@@AC@CEA@C@@BCBAFDBA@@BC@BBA@@BB@A@A@@BAHB@@@BBBB@B@BBD@DAF@DAB@DBD@FBB@@BB@BB@BBDBDBBBBDBBBD@BBF@FBD@BBB@FDDBDBD@PFDBD@DBBBDBBBBDBBJAHA@AB@@@@C@AB@@@@@BE@@@@A@BC@C@@H@@@@@D@@@@C@AJ@@@@EFB@@@@B@J@TC@@@@@A@@@AB@@BBAAGA@@DC@@F@@AC@AC@@@BAA@@CF@@CAA@AB@@@D@AKAEc@@@@@A@@@ABMBC@@@@A@@@@B@BA@ABB@A@@B@@@@@CA@@BAHB@CB@@G@@A@@AA@@EBB@AD@AFD@AE@ED@B@D@@ANB@CD@@@@@SI@BKBCAA@@@DADCMEBID@D@@AHB@@@@BCHDBAEADI@@@AA@@@CBCA@EBB@CB@@@@ATBBC@@@E@@EE@AA@C@@AG@C@@AE@ABA@@AC@G@@@@B@@@@EA@@CA@AAA@@@A@A@@CABAA@@@A@@@@@@ADEB@@@@@@ADCAADC@@@@BE@@B@B@@@A@DCDBDEJHBCECAADC@@FA@CB@@@@@B@@@@@BA@@@@@@@CBBBA@@@AC@@ABBB@AAB@@CIA@A@@A@@HCABAAA@@@@@CCB@@BDAB@@@A@@AB@@@A@@@CE@C@@@AE@B@BC@@DA@@A@A@@@A@@@AB@@@A@E@C@OA@@@@BE@EBA@@@@@CFB@@@@EA@ABCOGCAA@@ACAB@B@AAB@B@B@@@DA@@DCB@BABAB@D@B@BBBB@@@@@@@@BA@@B@HGDG@@B@B@@@DBDBB@@@@ADA@AD@HBD@B@@@JDTFBCHBD@@AA@BKRDDB@@@E@@@@@@A@E@@@CAAAAACAKEAG@@@EAAC@@@B@A@CCC@@@@@@A@@@@A@AA@@C@EA@B@@C@CA@@@@AAAA@AAA@AA@AAAACEA@@AIC@@QIA@IE@@@@cCABEAA@A@A@OCMA@CA@A@@@C@A@BFA@C@@@C@B@GBGB@CCB@@@@QBBB@D@D@DBDBJI@C@E@G@C@@@@A@A@AA@ABG@@AEBA@ABC@@@E@A@C@E@C@@@A@A@CBA@C@GBE@GB@@A@@@@B@@A@A@A@A@C@OAG@I@A@C@A@C@CAA@GAC@A@EAC@A@A@@A@@A@A@@@AA@@@@A@@@@AA@@@AB@@@B@B@@A@@@ABAB@@@@AA@@A@@BB@@BC@A@@@A@@@AB@@A@@AAB@@@@A@@A@@C@@@A@AAA@AA@@A@@@@BA@BB@@@D@B@F@@@BBB@@@@A@A@C@A@A@C@A@@C@CA@@AC@@@G@C@A@A@AACA@AA@AC@A@ABABAJABABCB@BA@A@AACAA@A@A@@@A@@AAKEEAAAAA@A@A@A@@DBB@B@@A@@@@@@@AA@@A@@@@@AB@@@F@@@@@@@@A@@A@@@AA@@DCB@@A@@@@@@@A@@AAAAA@A@@A@@@@AA@@AA@A@A@C@A@CA@@A@AA@BC@A@AAAACAEAA@AAG@CACACAA@E@A@ACMCM@CACAC@ACO@C@AA@@AA@A@@@ACAA@CAIC@A@AAAEAC@@C@@@@@AC@@A@ABACAA@@@AA@@AAABAEE@@QAC@GB@BCB@@A@@@ABEICB@B@BC@DJC@@BA@I@@@C@@BEBC@AAA@@EB@@GEA@@HAACJ@@AAAGB@@AC@A@@@@AGABCCBA@AA@GIE@AF@@AECBAG@@@A@CAEACFAEIDCFGFEAAHCGEG@A@@@A@E@@BE@A@G@AACJCABCEACA@DGCGA@FE@DHC@ABDNKIEDC@@BGAACC@BDC@C@AH@@AB@BB@BA@@DBAB@@E@@@CHC@A@@BABC@@EA@@B@BC@BA@@@AAAE@EAA@A@@BA@CF@BEFGFCDABABADAB@@A@@B@@AB@BAB@@@B@@C@@@@@A@@B@@AA@A@@AEAAAB@@ABBBAB@AA@@A@@ABBDA@@B@@CCA@DD@BCCA@DBCBBBB@AB@@IHABA@@@@@AA@@A@@@@B@@@B@@A@@@@@C@@@A@@B@@@@B@BB@@@B@@@@@@AAA@AB@BABA@A@A@AB@BCB@D@BABABEB@@AB@@AAA@@@@BABA@@B@BAF@DCF@FCF@@@@AB@@@B@@ABCBABC@@B@CA@@C@@ADB@@D@@A@@A@A@@@A@@@CC@@A@@@CA@A@@@AFC@@BAB@@@DD@DB@DFAADDBCHA@@DB@@@B@BEHB@ADBB@B@ADAFAD@BABAB@D@D@B@DABCH@@CHAF@B@DAB@BCD@@AD@B@B@@@BADCFCDABAB@@@D@B@D@B@D@DA@@D@D@DAB@DA@ABAB@F@@@B@D@@@@AB@B@@@@B@@@AFB@AFB@FC@B@BE@@B@@@DC@@BA@E@ABFDCDCA@@@B@@A@A@@@@DB@@DHA@BF@@@B@BABB@AB@@@KEBClVFDJFFDCDEC@B@@@BA@C@@AE@@DE@BB@D@@@DF@@@@B@@B@@@@@BF@@BB@@D@@B@@@BB@BB@B@@A@@DCB@@@AA@@B@@@BBABHEBBBEB@A@@CBBB@@CBBB@@A@A@ABA@B@@BA@BBB@BD@@B@ABBB@@A@AA@BCAA@A@ABDH@BD@B@B@BBFBBDFFDBFFFFBDBBBDBBB@@@DBHD@@BB@B@@@B@F@B@DD@@@@@B@@BA@@DC@BB@BDABB@D@BG@@B@B@B@DBBDH@BBB@B@@BD@@BBBBDDBD@B@BBDBFBDBBBF@@BBNJ@@BB@DBD@BBDBF@FBD@D@D@D@@@BB@@BFDJD@BB@@B@BAFADAB@@@B@BBDBDDFDDB@HHBBBB@BDABB@B@@@@@@B@BDAB@@@@CD@@B@@@DA@@AB@B@@DA@@CBBB@BB@@@ABA@@@A@BB@BBB@@BA@A@@BA@@@@B@B@@B@@HE@BABBB@A@BA@BBAB@@A@BBBABBBBBDEDDBLFBBFDDDFDFHHHBA@@@@JHDDDBDBJF@@AD@@F@DBD@F@B@DDHFFDJHFBHFBB@@@@@BABA@AD@@@@BB@B@B@BBBBHC@@@C@@@A@@BEA@B@DAB@BD@HBD@@@DBB@@@HP@B@DD@@BBAAA@@B@BAD@BAB@BA@BBA@B@@BB@B@BB@@BB@@@B@@@@@@@D@@BA@A@BB@B@@BDBA@C@@B@@AHAA@DAA@D@@@C@@A@@@CB@@BBB@@@@@AD@@CE@@@D@@A@@AACB@CB@AAA@@@@AAB@CB@B@@BB@BA@@B@B@B@B@AAFC@BB@@@DAD@DFPCF@B@DAAAEG@@FA@A@@@@CCz[BA@AFDLHFFHFHFHDNJNFHFLFFBJFHDFB@@BCB@BCB@@@B@B@@@BAD@@@@@@A@@AAAAAAA@BABC@@TADA@BB@@AF@@BBDBA@@B@@@D@B@@@@AAAB@@@D@B@B@B@@AB@@A@@B@@@@B@@BBB@B@@@BA@@@A@A@@@@F@@@@AD@@@BB@BB@@C@@@A@@AA@@BABAB@B@@B@@@B@@B@DBBA@@B@@A@@AA@@BA@AD@@AB@@@@@B@@A@AC@@@B@@AB@@AABAAD@ACCEAEA@A@C@AA@@@@@@@A@@@E@ABB@C@@A@A@ABC@@@A@@@A@C@E@BG@@@A@AB@BC@@B@@CB@@@BA@CFB@A@A@A@AE@CA"],encodeOffsets:[[122702,34680]]}},{type:"Feature",id:"320981",properties:{name:"东台市",cp:[120.320328,32.868426],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@AAAAACA@@A@@@@@@AC@@@@A@@@A@@AB@@A@BAA@A@@@@@A@@@A@@C@A@AAA@AACAAAAAC@AEC@A@@@AE@A@M@@B@J@DB@@B@B@BGB@B@B@BA@@@A@@A@@@B@DD@BF@@@@@@@BB@@AB@BAJDAD@@@BA@A@@@AHB@ABD@AF@@@BA@@@@@@@@@@@AD@BE@A@@BCB@BA@@B@BA@@B@@A@@B@@@@CAAB@@A@BADA@@C@A@@CA@@AAB@BA@@@E@A@@@AA@@A@@H@@BBA@@BA@A@@@@@@A@@AA@@A@@AE@@GC@@CCA@A@EEC@JF@@BABGA@E@@A@@CA@@@@BAD@@BB@@@B@@@BBB@BBDBB@@AB@@BBB@@@BD@BBB@@@B@@BBA@@@@B@@@B@@@BB@@B@@@D@@@BA@@@AB@@A@A@@@AB@@@@@@A@@@ABAB@@AB@BA@A@@B@@B@@@B@@BBBB@@@@@@ABBAD@@A@@BB@@@@@@@E@@@@@A@CDABCD@@@@ADABCD@@B@@DG@A@FCCAEAEE@@@AA@@A@AAAA@@@A@@@@@@CC@AA@@@AI@@CO@@@@B@@C@@DECA@@B@@GA@@EA@BA@@BA@@@CAEAC@@@A@@BA@A@@@AAAAAAA@CCA@@@@BABCBA@AB@AABCC@BA@A@@@BBA@A@@BCAA@@@@@E@@CAB@BA@@ACB@ACBCBA@A@AB@@@@@@@AG@@B@@@DC@@@AB@B@B@@@@A@IAG@AA@@A@BA@ABU@A@EA@@@A@@@@@C@@@A@@B@@@@@BC@CB@@@B@@@BC@AB@@@B@@AB@@A@ABE@@@@@A@@BA@@CCBAIC@@BA@AB@@CB@BA@A@@@@@CEA@@B@@@@AB@@@B@@ABA@C@C@@CEBC@C@@@@AE@@@C@@@E@@F@H@BB@BBD@B@@@@BABA@A@@@ABAA@@A@CDBBGH@@@B@@AB@@APAJAHAHAJI@A@A@@BCB@@A@EBE@ADDBB@CFA@CDCA@@@BAB@@@BAB@@A@@BA@@CC@@@@@C@@BA@@A@A@BC@@AA@@B@@A@A@@BE@AA@@@DA@@BA@A@AB@FDVD@@@BD@@CB@@A@@@@BC@A@CBABA@@@A@@@@@@BE@@@@AEBAC@AA@CAA@CC@AQBA@A@@@@BAAC@CAA@AAAA@@AAE@@DA@ABC@@@C@@@A@@@E@C@EAA@AB@A@A@@@@@ABAAAC@BCE@@@AD@@@@EAA@AAA@C@@@ADD@ADA@B@@B@@CDA@CAAAA@AABAAA@@AABA@@AACDABEEAAAAGECAAAAAECAAA@@AC@CAAACACACCA@CAE@E@A@C@ABE@C@A@G@C@C@C@@EC@@AB@AA@AC@@ABA@@@A@@A@@@A@BDEB@A@A@AAB@@@@A@@BA@A@@@@@@BA@@AA@@@C@@B@B@@A@A@@A@@@@BA@A@@CDAFGAA@E@@@DCAACABS@G@@BA@@D@@@AE@@@@@@@AD@B@AC@C@AI@E@BEBG@AAG@C@@C@@@AC@ECB@ADAAACBA@@AAAA@AE@A@A@CAA@AAA@AC@ACD@@AAIB@BABB@A@AD@@A@A@CC@@AA@@B@@A@EDAA@@A@@@@A@ACACBA@ABA@@@@F@B@F@DBBBH@@CB@@@BBDG@A@@D@@C@AB@@ED@@@A@@CB@@@@CJ@@K@C@@C@MMA@AAA@@AD@@@BA@@B@BAFA@A@@CC@@@A@A@@AB@B@@AB@@AAA@@@A@ABAAACB@B@@MAA@@HA@@@AGA@@D@@@@@@A@@C@@IB@@C@@@@A@C@@IAG@@AC@@@@@@AC@@BA@@CG@@A@@G@@BABA@GBABA@@B@HD@AH@@@@@@A@AB@@AD@DC@@B@@HB@DA@DBDC@@NFAB@@@B@@ABBD@B@F@@@@B@@ABB@@@H@@@@@@@BA@@B@BA@@B@B@@C@AB@BDBABBBAB@@@@@B@BAD@BFA@B@@@B@BB@@DDA@@BD@DB@@B@@BDC@A@A@@D@@CACB@FD@@@@@D@@FA@@@A@@B@B@@D@B@D@@B@FB@DA@B@BAD@@B@B@@@@BJB@BADB@DB@@@BE@BFF@@@B@D@@@B@F@@B@DD@B@DB@@BC@@B@@@B@@B@BD@BB@@@@BB@@A@BDH@@BAB@D@@A@@FA@@BB@@DD@B@B@@B@B@@B@FD@@@AB@BB@@BABBB@B@BA@@@DDA@@@B@@B@FBAD@@@@HB@B@H@D@B@B@B@@@BB@@@AD@@AB@@BB@@@B@BF@@B@@D@B@@@B@B@@A@@B@@@BCF@BB@A@A@@BBB@@@BF@@B@@CD@@DF@BDBNBDA@@D@@@@AB@@A@C@BDF@@BB@@DHABFBBBBB@@A@@BBA@BBBAH@B@@@@DA@@DDAF@@@@@B@@DB@@BB@@BA@@BACCBABB@BDB@FABDBBB@B@AA@ABBB@BBCBCBABDB@BA@AAADA@A@A@@B@@@D@@ABAD@@ADAAADA@@B@@A@AA@@@AAA@@C@C@BBABAA@A@@@@ABCA@BA@@@@@@@CA@BCA@AE@AAABABA@CA@@@EE@@A@A@@BA@@BA@AAAA@@@@A@@AABA@@@@A@@@BA@@A@BAA@@@ABA@@ACA@@C@@B@@ABCBA@A@@BA@@BA@@AA@@BA@@@@@A@@@@DAAA@@@A@@AA@@B@B@@@@C@@BC@@@@@A@EB@AA@C@@B@@@@C@B@CB@@@BB@BHBA@DB@BDBABBB@BBB@DD@ABBCDB@BB@@B@@B@@EF@@AB@@@@@B@@@@@B@@AA@@ABG@BFGB@@@B@@@@F@@FB@B@@@D@@B@@@@B@D@@B@@@BFAFA@E@@B@@@B@@@@@@B@@B@@@@@BAD@@AB@@AB@@@D@ABB@@@@@@@BAB@@@AFFBB@@@@BABB@@BD@@D@@@BB@@@A@@B@B@@@BBB@FB@@BCAA@@DE@C@@B@@@D@BCBA@@@FFA@@B@B@@B@@@@BDAB@DADAD@@BB@@@BD@B@F@@@@@@@B@@CB@@@@@@AB@@A@@BD@@BDA@BAB@@@BABFB@@@@B@@B@@B@@AB@@@D@@@@@B@@B@@B@@DB@BA@@@BD@@BDAD@@D@@@B@BC@@@A@@@@@@BA@@FD@@DAB@@@@@@@BA@@@@B@@@@A@@BC@@BB@@BA@A@@@@AA@CBAAA@AAA@ABA@BB@DA@@@@@EBBBA@@BB@A@ABA@@@@@A@A@@FAAA@GA@B@F@BC@@CCAA@A@BB@DB@@DB@@H@@@DB@@@AB@@@@@@AA@@@@@BAB@BBBB@B@B@@D@@@DF@D@@BCA@BA@AD@BA@@B@@@@A@@DB@@@@@@B@BA@@B@BBDC@BB@B@@CB@@BBA@@BAB@@AB@@AD@@@BD@@@@B@B@@@@@BBB@@A@@@@@@B@BA@@@@B@@@@@B@B@@AFB@@@B@@@B@@A@@@D@BJ@F@@D@@@@B@@A@ALAH@B@HAB@@C@@@@@CB@@GF@BBB@@AB@@@B@@@@@@A@@B@B@@@B@@@@@@@@ADAAABABAAAB@ACB@@@@@H@HAB@@@@BFA@@BH@@@D@@@BB@B@@@B@@@@@@BBA@FD@@@F@@FD@AB@@AA@@@B@D@@BC@B@BH@HAF@F@@BF@@A@@LATAD@BB@@D@@AH@B@@@@C@CC@@A@@@C@A@@@@B@@BB@@@D@@@B@@@B@@BBA@BB@@@B@@@@DBDBB@@FAD@@@BADC@@B@@@BD@@B@F@DBF@HBF@F@H@HBB@J@DA@@BBB@FDDDBDFDDBDDB@BBB@DDBB@@@@F@B@BBB@J@JAB@B@D@D@D@F@J@D@D@@@B@LAF@FBB@B@FAB@@@DCB@BAB@B@@A@AEBA@@@@CB@@@F@@@BA@B@@@BBABBDADAB@@C@@@BB@BA@@@@DAB@B@@BB@@@DAFA@@BBB@HAF@B@BBB@D@B@B@@@DADAFAB@B@FBB@D@L@D@D@D@@@B@F@FBHBD@D@DAB@B@BBDBBBD@@BBAB@B@@@@@BABC@@@A@ACCA@CCA@AAAA@@@A@@@@@A@@CAB@@@ND@AD@@BBBB@@BPFFBHBDB@BCAA@@@B@@@@@@@AFBBADDB@@FA@@@BB@@@@ABB@A@@BBB@FBBCNHBBFB@BDBB@@@@@DBHD@BBA@@@@@@@AB@@BB@@@@D@@@@JB@BDBF@@@FD@GD@@AB@@H@@@BA@@@ABDB@CB@@BBB@@DBBC@@BBADB@BCB@@@CFLDHF@@@AB@@A@A@A@AB@@@@C@A@A@@@@@AB@B@DAB@@A@ABA@@BA@A@@@AA@@@@A@@@C@A@@@AAA@@@A@@D@B@@@BCBADABAB@@@@@B@@@@@BA@A@AA@@ABA@ABA@@AA@@@@@AF@@FBB@A@@B@B@@A@@D@BA@@B@@@B@B@@AB@@@@@@@@AB@BA@ABAB@@@B@@A@@BB@@BBB@BB@@@@B@@@B@D@@@D@B@BAB@D@B@BB@@BAB@@AB@BABA@@BAB@@@BABABAB@@@B@BAB@FBB@B@@@@@@A@@B@@@B@BADB@@DAD@@@@@D@@@BB@@@B@@DB@@@BD@BB@@F@@BB@B@@BFDB@DB@A@@@@@@B@@@@D@@H@@JL@@BDBFDBB@@BBB@@@@B@@DBDBN@F@@fB@@@@@@CD@@D@@@@B@X@@TL@H@V@@TzAP@´@BTAF@@ABA@[JfZLJ@@B@rAJ@tVT¹DSAIAEEEECOEDIHaNANGH]COAIDEDAF@PHzBFADK@QOăDYCGEEOCFKIMIIEEECEAI@IHIDG@A@IBIFGHMBEAI@AAE@OAWHgjJzLJ@{QsMGAA@@@GCG@AAC@GC@@Y@@Ai@BWY@@W@@B@JJ@@B@B@HCFBF@@@@@BAB@@@ECCAAB@A@@C@EACAEAC@C@A@CBA@EBC@A@GB@EACHA@AB@@@A@@AA@IAEAA@@@GCKCGAAAAA@@GCACAACA@@@AIC@BAA@@CABAA@@B@@@@@BA@@AAA@B@BA@@A@@@@A@@@A@@A@A@@A@@@@@A@@@A@@@@@@B@BBB@@@TBL@JK@@AA@@@@AE@@DC@@@E@A@@@ABA@@B@BC@AAA@GD@AA@A@@@AAC@@A@@@@BB@AC@@@A@@@@AA@@@AACBC@A@@@I@K@@@AA@@@CAA@@A@A@@E@@BM@@A@@EA@@D@A@@A@ABA@@AA@BAC@@BCA@@C@@NK@@CA@AB@@C@A@@@@D@BB@@@BH@BA@@D@@@@E@@AG@@@@BG@@BB@@DC@@@A@QA@A@@@@A@@@A@@AA@AB@AA@AA@@@@C@@@AAA@A@@@A@AA@@@@@@A@@@@@A@AA@@@@"],encodeOffsets:[[123580,33380]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
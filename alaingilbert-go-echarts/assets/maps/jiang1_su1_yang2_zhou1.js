@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("扬州",{type:"FeatureCollection",features:[{type:"Feature",id:"321002",properties:{name:"广陵区",cp:[119.431849,32.39472],childNum:1},geometry:{type:"Polygon",coordinates:["@@@b@DAL@HA@@R@BALRB@L@F@@M@E@A@GBC@EAC@@BCAGAKA@@@CBGCA@@GEAACCBA@AB@@AA@AA@AA@ECGEAA@@IEEHDFBF@FQPCDIGGAE@CBABABIHABEB@@KFKHCH@F@L@BABABA@A@@J@FBJBLBJ@FADBD@D@BBFBL@JC@IACAC@@@A@IAMCE@KAK@MA@@@B@DBD@B@BAB@@@BB@D@BB@@@@@D@B@BA@@BA@@A@@AA@@AA@@A@@@A@@BCD@@@B@BAFAD@D@@@BBB@@D@D@DBBDA@@@@@@@A@@B@@@@@@ABB@@B@@B@@DA@A@A@@@@B@@@B@H@DAL@D@@AD@B@B@FB@H@B@B@B@@@@@B@B@@@@@B@B@B@B@B@H@DBB@B@@@BBD@B@@D@B@B@@@DADAB@B@F@BH@H@DBD@B@LDDBDBB@FBLBFB@B@D@FBB@B@B@BAJ@D@D@DALAD@BCDBB@J@LBB@FAH@FBLA@@BB@@@BZ@@@P@B@@@D@@AHADA@@@AB@BJBJKFGBAHGDADAB@BABA@ABA@@@@BADCDCDEDCFGBABABCBA@@DABADC@ABCBA@CBCBEDC@CBCBG@@@ABADAHEDCBABABCBCDIBC@@@A@@CAABEAIBGA@@C@BCBI@A@C@@AM@AB@@ABA@@BAB@F@B@FA@DA@@BD@@A@@@A@AHAF@D@B@@A@EAA@AAA@A@C@@@@BAB@@AH@FAF@BABAFCDABABA@A@@@A@A@CC@MA@@CAAAAAAA@A@C@@@A@@BAD@D@B@@@B@BB@BDBBD@@BBD@@CBC@CBC@@BAB@B@@@B@BB@@FDBBD@B@@@D@B@BA@A@A@@@AAAAACA@@AAAA@A@A@@BABABAB@BAB@FBF@B@D@B@DADADCBABA@@@AD@JBNALARAB@PCHATGB@^OTM@@B@HIFEBALOBAFEHITUCCAAACCGGM]BA@EB@A@@C@AA@AACCCAACAEECACAAAEAGAGAGAE@A@A@E@GAEA@@C@C@AAEACAAACAAAACAC@AAA@CBCBKBE@C@C@C@AACCMAAA@A@AB@@AFAPANBDBB@B@DADABA@ADCB@BCBGBA@I@I@K@@@@@@AAABGD[@AAAAAC@i@"],encodeOffsets:[[122442,33005]]}},{type:"Feature",id:"321003",properties:{name:"邗江区",cp:[119.397994,32.377655],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@A@A@@@@@A@A@@@@@A@A@A@G@A@@E@A@ABC@@@CBK@C@G@A@@@A@@B@B@B@@CA@@@@AA@BA@@@@@@@AB@@@@@@@B@ACCAC@C@@@AA@A@@@CBCBE@A@A@@DC@AB@@@B@@@BB@@BB@@@BB@@AB@@A@A@C@@@@AAC@A@@A@@BA@A@AAC@C@A@@NBL@LBF@NDJBB@@@D@DBJBD@@IAKAE@A@CACBC@EAIAKAI@E@IB@B@BABA@A@K@EDGLGLE@@FABAJGBABADAF@HBJHDCRO@EAECEFGEAGCEBMH[FWDI@G@QAAA[CI@gMWGIKAAOOGEGGAAE@C@G@K@I@SBSBQDGDGBC@EJADITADAFALA@eC@A@@@B@@CA@@@@A@@@ABB@AB@@A@@@AB@@A@@BA@B@ABBBB@BBKD@DBB@@B@BDB@BD@@AB@@@BE@@DA@ACEAAB@B@BA@@DE@@BB@A@@FBBLB@@ABABGPABCDABABEDCDA@ABB@DBBD@@bFBA@E@@D@@@XB@@@@@@D@B@@BBABBDB@@APF@XDB@@@HFHDDB@@BB@DGdCPALAB@@AJCJ@@@@AA@@@B@@@@A@@@@AA@@@@@AB@@@A@@@@A@@@@@@@@AA@A@@A@@@BABGJGJAB@B@B@B@@B@@@@B@B@@@@BHDB@@@B@@@@A@AA@B@@@@A@BB@@@B@B@@@B@B@BBB@@@@@B@@@@BB@@@@DNBHBJ@D@@A@ABA@AA@BAA@BAAEF@B@@AB@@AB@DGF@B@@ABA@@B@@@@@B@@@AB@@B@@BB@AB@@@@B@@@@@@B@@@B@@B@@@@B@B@@@@BAA@B@@@@@BAB@@@@BBA@@@B@ABB@@B@BADD@@@@B@@@@@@@@@@@BABA@@@B@A@@BA@@BB@@@@@@B@B@@@@@@@@@BBA@@@@@@@B@@@@BBCD@@AB@@AB@@@@@@@A@@A@B@AB@@@@A@BB@@@@@B@@@@@B@@B@@@@B@@@@@@@BB@B@@@@@@BB@@@@A@@@B@@@B@@@B@@@@@BD@@@@B@BA@@@@@BB@@@@@B@B@@B@@@@@A@@B@@@B@@@B@@@B@@A@@B@@BB@@@@@B@@@@@@B@@@@@BB@@@BB@@@@@AB@@A@@BA@@@A@@@@@A@A@A@A@@@@@@B@@@@H@@@@@@@B@@B@@@@@@@B@A@@@BA@@B@@B@@@@@@B@@@@@@@BA@@@@BB@@@@@@@@B@@@BBBA@@@@@@@A@@@@@@@A@B@@@A@@@@@@@ABBD@@B@@@@A@@B@@@@@@A@@B@@B@@A@BB@B@@@@B@@B@@@@@B@@A@@BB@A@@B@@BB@@@BB@@@@@@@@B@@@BCBADCBA@CBC@A@ABGDABA@IFABA@CF@@B@@@AB@A@B@@@B@@A@@@@@@@EDA@ABAB@@@BBA@B@B@@@B@@A@@@@@@@@@@B@@@B@@@@B@@@@@B@@@@F@@@@@@@@@@@BB@@BF@@BABBBA@@@B@@B@BA@@@@@@B@@A@BBA@@@@@BB@@@@@@BB@@@@B@@@@@@B@@@BB@ABB@@@@@@@@BA@@@B@B@@@@@@@BB@@@@B@@@@@@B@@@BB@@B@@A@@@@@ABA@@@@@@@@@A@@@@@AD@@@@B@@@@@@@@BA@@@@@@B@@@@@@@B@@A@@B@@A@@@@BA@@@B@@@@D@@@@@@B@@@@@@@@B@@@@B@D@B@@@B@@@@@B@@@@B@@@BB@B@@@B@DA@@@@@@B@@@B@@@@@@AB@@@BA@@@@@@@AJ@@BDHB@@@BB@@@@BAA@B@@@@@@@B@@@@@B@@@@@@AB@@@BAAAB@AA@@@@@@B@A@BA@@@@@@@@B@@AB@@@@@B@@@@@AA@@@@@@@B@@@A@@@@@@@@@@B@@@@@B@@@@@BBB@BB@@@DDD@@@B@@@BA@@@@DA@@@@@@BA@@BAF@@A@@B@@@@@B@@@@@B@@@@A@@@@BABA@@@@@C@A@@@@B@@A@@@@B@@B@@@@@@B@@@BB@@DBLAB@@@@B@@B@BA@A@A@@@AAAB@@@@A@A@@@@@@B@@C@A@@AAB@A@@@@@@A@@@A@@@@@AB@@@@A@@@@A@@@@@B@@@@A@@@B@@@A@BB@@@@@@@@@BA@B@@@@@BBB@@@A@@A@@BB@@@A@@@@@@@@@@BA@@@@@B@@A@B@@B@@@@@@@@B@@B@@B@@@@B@@@@@@@BB@@@BB@@@@ABB@@@B@@BBB@B@@BBC@@B@@B@@BA@@@B@@@@B@@@BB@A@B@@@@@@@@@B@@@FH@@A@BB@@B@@@@BB@@@@BB@@@@@ABB@A@BB@@@@@@BD@B@B@D@@B@@@@B@@@@@@@BB@BAA@B@@@@A@@B@@@AAB@@@DA@@@@BBB@@@@@B@A@BBA@BBA@DDB@@ADC@@@@B@BA@@A@@@@A@BBA@@B@A@@@@@@@@AB@B@@BA@B@@@@AB@@BBA@BB@@@HA@@@@@A@@@@@@@@@@B@@A@AB@@@@@@@D@@@@@BB@@BD@@B@@BHHDD@@@FADBDDDBBBBBDBBDBFDBBDDDDBH@@JTBDF@DBHBBBB@@BB@BBB@B@BAD@@@BABABAF@HANEJCLCVCJAPCTCN@DQBK@EBEDGBOBIBIBM@ADIBBCDCDCBCJOBAFMJKH@RBH@FIBE@C@E@A@AAA@EBE@@@A@ABCBABABC@ABABCDEDA@ADIJKIA@ABA@@B@BCBG@@@C@@@A@O@@AY@@A@@AB@AK@EBG@EAA@K@IAADC@ABCBK@C@C@CBI@A@A@AAA@E@C@AEAKAEAA@CACAKCA@C@CAG@G@@A@E@ABABC@C@@@A@A@CA@C@AA@@A@A@CAG@A@"],encodeOffsets:[[122307,33179]]}},{type:"Feature",id:"321012",properties:{name:"江都区",cp:[119.569989,32.434672],childNum:1},geometry:{type:"Polygon",coordinates:["@@MGMIEEKKSVGJEFABKPABEFGJA@@@SN]PA@SHGBODA@QBKBMBIAC@@B@@ABABCDCBCBA@C@A@E@EAA@ABA@ABABAB@@@B@BBBBB@@DBBBBB@B@@@B@BABA@C@@@A@C@AAEC@@AAA@@@A@A@AB@@AD@DAD@DC@AA@@ACCA@AAAA@@@A@C@C@AB@@@B@@@D@BBBBBBBDB@@NBD@@D@B@B@@@BABABCBEDABABE@EBG@@BA@AB@@@@@D@BBB@BBB@F@BA@C@E@GB@B@B@@@BC@@AB@@CEBA@E@A@AB@@AB@BA@@BBN@@@D@BAJADD@@@HBJAFBBADB@@@B@@ADCJADADABABCDGFCBAB@B@@AHAD@DCDAFAD@DABAD@BCDABCB@@ABADABABEHCDCFCDCDAB@@@@AB@BABABA@CBCBGHABEHILILCJ@BCBCFADAB@BADABABAD@B@B@@AF@FBB@B@B@F@DAFEJG@QAG@ILENABIPADCDCDADACJ@BANAJAJAPCHAF@FALCRFAF@@@B@B@@@B@@BBB@@B@BBBA@@BAB@@@B@@@@@BBFA@@@@@ABA@@B@B@BAB@BB@B@BDA@@D@@@AAB@@FBB@DBDBH@FX@BAB@@@@@@@B@@H@@@@@@@B@AHDB@B@D@F@DB@@BBDB@@@@F@B@B@BY@@@@@C@@AGAG@AHAF@BDDAB@@@H@D@DB@@@@D@D@@@D@FBD@NBJ@FBB@J@J@B@D@F@BBF@RA@@H@\\AB@@DBNBVBP@BBR@BBHB\\@@A@A@@@@@@B@D@HF@@@B@B@@BB@@B@@B@Z@@@B@@@@A@@B@@@B@B@@@B@ADD@@CDB@BH@B@@AD@@@B@@@BAJ@@BB@@B@@@@D@H@@@@@@@B@D@BABEF@@@@@@AF@@@@B@@D@@@DABB@ADDBB@@@@D@FAD@@@@AB@BAB@@C@E@@FBB@@@@@D@@@F@@@F@B@B@@CDA@@B@@AB@B@@@B@F@@@@B@BB@BA@DDA@@@@@AB@@@DDFFLPFFHHAB@@@BBB@B@@@B@@@DB@D@B@@BDDBB@B@@@B@@BBB@DB@@B@B@BAB@BBDFBDHJDDFFA@CBBFD@@@@A@@D@BB@@A@@B@@C@@BA@C@BB@D@BD@@A@A@@HB@B@BDBB@ADHDBB@F@BA@@BB@DB@@H@@BB@D@@@D@@@B@ACAC@@@@DDBDBDDDB@FDFFBBDDDDFDDBBBBDDBDDB@HBBBB@@A@A@CH@@@@@D@BAA@CA@ABC@@@@CAFME@@A@A@AB@BAA@@@B@@@@B@@@@@@B@BE@@@@BA@@@AD@@DD@B@DBB@BBAF@@BA@@@AFBB@B@BAAA@@B@@@B@B@@@@B@@@@@@@@@@F@@@@CTA@G@C@C@C@AB@A@@ACAAAAADAACAB@A@CCB@CC@A@@A@@AAA@A@AA@EC@@AC@GA@@BIAABGB@BC@@@AC@@CB@@@@A@@H@@EB@@@@@BAB@BAD@@@AIF@@A@@@@@ABGDE@@@A@@BAB@ABB@@AB@@C@@HD@@@A@AC@CC@@@@BEC@@AB@@@@@B@B@@AB@@@@@D@@@N@@@@@AAAAH@D@@ED@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@A@@@@@@@@@A@A@@A@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@C@AEA@@@@@A@@@@A@@@@@@@@A@@@@@@A@A@@@@@@@@@@C@@@@@@@@@@@@@@@A@A@@@A@@@@@@A@@@@A@@@@@@@@@@@@DA@@D@@@BCBA@CF@@AC@@AB@B@@B@@@BDA@@B@@BB@@@B@B@@@D@F@B@@A@ABA@ABABA@ABCDCBC@@BI@@BI@CBE@A@@DCDCBCDCDGBC@EBA@A@@@@CAMA@@C@@@CG@E@@A@A@@B@@@@C@@BA@@@@BCBC@EA@@@@@@KA@C@@@@A@E@@AA@@@AF@BEAEBBH@@C@A@BB@@@BB@@B@@BFA@BBA@EB@C@A@A@@CBAB@@A@@@@@@@@@@@C@@BA@@BIB@B@@BBA@AB@@@@@@BB@@@@C@E@ABCA@B@DEA@D@@C@E@A@KB@@@@@C@@@@AG@@A@@EC@@EEA@@@@A@KAE@C@@G@@B@@@@@BG@G@ADBBC@A@B@AFK@@A@@@@CE@@@@BC@@ACA@AB@@@@C@ABAB@AAFA@A@@@CB@@C@@@AAC@@@@@C@@D@@CB@@@@@@AEE@@@@K@@ED@DA@ED@AC@C@@@@DA@@AACB@ADAACA@@A@@@@B@AABA@BD@@@@@F@AEA@@@D@@@NAHAB@F@@@@@@@BE@ABC@@@A@@A@@@A@BI@E@A@@BGI@@@@A@@G@EA@@G@@@@@AA@@@A@@@ACA@@@A@@BA@@@A@CD@B@@@@G@C@@@EA@@I@A@E@A@@AW@A@EAC@AAG@C@E@C@A@E@A@ABA@@@AA@A@CAC@@@A@@@BADCBA@@DCJGBABA@@B@DBD@DBNB@BCAADH@@@BB@C@AB@FBDBJBBE@ABE@ED@B@@A@ADB@ABA@A@A@AAA@C@C@@@AA@@CB@@@@AB@F@@@@AD@BEA@@AB@@@B@@@B@@A@A@CCBAE@A@@C@C@@CF@BC@A@@FB@CB@@ABEB@@C@@@AA@BA@@E@E@A@GB@BE@A@BA@@A@CAA@AA@EA@@AA@@C@ABC@@@@@ABCA@BC@@@@A@@AA@@B@@A@@@A@AA@@@A@@@@@@AAA@@A@CC@A@BG@@@@BC@@D@BBB@@CBCA@BC@@B@@@@@@A@@BA@@DCBCAK@IAK@AKBCB@C@@@A@@AAB@@@C@@A@AA@E@@A@AC@AA@@@A@@B@@AB@@@@@D@@@@@B@@CB@@@B@@AB@@B@@@A@@@@@AA@@@@A@AA@ACB@@AAA@A@@B@@A@AAABAA@@AAA@@@AAA@A@@@@BBD@@@FAB@D@@@@A@A@E@EA@@@EGA@@@C@@@@B@@C@@ACAB@@AB@@AAAAAAACA@AA@@@@A@@FCBACCABA@ABA@AAGEKHEFQFCBC@GDIBA@MDE@EBA@A@IBC@GBE@KACAA@EAAAA@OIGCCA"],encodeOffsets:[[122615,33089]]}},{type:"Feature",id:"321023",properties:{name:"宝应县",cp:[119.360729,33.240391],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CDA@A@@@AA@BA@@AAA@AA@@B@AA@@BA@AB@BCDB@@@A@A@AB@@ABA@A@@A@IAEAC@E@E@MCC@E@GA@@C@A@C@E@@@C@A@GAC@QCCAA@EAE@QCGACAA@GBQDE@E@CBA@EBI@IBC@KDI@ABC@C@@@C@E@AAKGECAAECCACCAAA@@@C@CBA@EA@@@@@@AB@BA@A@A@gEA@@@IT@@IBCBCBCBCDMHKFEDBD@B@B@B@@ABE@@B@@AB@@@B@@BB@@@B@D@BA@CDMHA@CDIDABAD@BCFADAHCJ@BCDABOTCD@BAFAF@B@@A@@@@JA@@@@D@@@D@FALGFABA@GFEFEBA@ABA@IBEBABA@CDCDCD@@@@MF@@CH@@@@ABAD@@A@AD@@ABCF@@GHAF@@ABAD@@ABAFCFCD@@AB@@@MBSQBSMWI@BCTDBHBEV@B@BCB@BAHI@@F@B@@@D@B@@@@@B@@@B@B@BD@@BERA@BBKAQ@@DA@@BA@@@A@@AG@C@M@IBA@A@ABK@A@AACEAC@@A@@A@A@@C@CAAJA@AFCJEB@ACB@@EBE@@TAl@ZDDVRDD@L@NEBC@CBEDB@RAV@LDNB@ABBNBHBP@FB@A@AB@D@B@@@@AB@@@@@B@B@@@@@B@@BBA@@B@@@D@@DA@@DF@@@@F@@JBFBH@BBB@D@B@@@@D@@D@@CBB@@@@@@@@B@@@@@@BHBH@@AB@@BB@@@@A@@@@@@@@A@@AB@@B@@@@B@A@@@DBD@D@DA@@@BB@@BB@@ADBCFEJAB@@ABEDCBA@ADAD@BCFCL@DADEJABGLCFDBB@B@DBFBF@BBD@HBPFBABB@AB@ABD@B@DBD@DBB@B@@BB@B@@@BBB@@@@@@BLB@@B@@@HB@@@@D@@BB@@@@B@@@@ADHBBA@@@BBBBCDBB@FB@AD@J@@BB@@@@BBDH@@AD@@@D@FAB@@@@@@@@ARB@@@@@B@@BDDA@BBAD@D@B@@@B@@B@@@@@@@@@@BB@@B@AC@@BA@@BB@B@@B@@@@@B@F@@AD@@@@@B@@@@@BABBB@@@@@BFB@@@FABAB@@@@B@@@@@@@BB@B@@@@@@ABA@BD@A@B@@@B@@@B@@CB@@@F@DB@FH@DBB@@B@BB@B@B@B@@BJ@NB@@BEB@B@@AB@@@BCB@BB@@BB@@NBJ@HB@@@ARBBB@BB@B@D@@AD@B@@BB@@@B@BBDDHB@@DBBBBBHF@@@@@@@@AFBBBB@BAD@@D@@@BABA@@@@BAB@B@DD@BA@@B@B@@@@@@A@E@@@@@@BB@@DBA@D@@@@AFB@BE@@BF@@FAB@@@@@B@@D@@@@@@@DXCAFL@@A@C@@@@D@D@@BB@AFD@@BP@@@@AJ@H@ACBAB@LBFBDBABHB@@BADB@@BALFDBD@D@D@B@FAB@@@BADA@@DAD@@C@@@@B@B@@@B@@@B@@@BA@@B@@@BA@@BA@AB@@AA@@@HI@@B@@A@B@BBA@@DC@ABA@@A@@AAC@@@AB@@@DABA@@@AFCB@@AAACG@@BC@@BAAA@@@@JFBC@@LHD@@CFBRCBA@@TBDKFSIAKABETAHW@@GAAELBBON@R@@@CG@@B@@A@AF@@A@@AED@BAAC@@EK@@AA@A@@BAB@@ABB@AD@@AJABI@@A@@E@ABA@@ACA@AI@@@@@@CDCADCJE@AJQDBDA@AB@@AD@BDDA@HF@@FL@@@D@D@PNCF@@D@HBBENB@ENBBDJ@AEFB@AA@DE@@@A@A@@JCBE@AD@@@@@ACBAB@@@@CAA@CB@B@@@BA@@AA@A@@@@A@@@A@@@@A@@A@@A@@C@C@@@C@A@A@EIAABAFA@@AAEE@@DA@AEC@@@@A@A@AEA@@CAA@@AACC@AAA@A@A@@AAAA@AC@A@@@@@@@@ADGHGDI@@B@NCNCNC@@AGABA@A@A@BDA@CAE@EB@@@@A@AA@BE@ABE@@CB@@ACEB@@@@@LDNBB@ACAA@@@@B@D@@C@@@A@@AA@@@@@ACA@AM@EDAAABC@BBBABBEFAC@ABAA@@A@A@@C@@E@@ACDADAACCG@ABA@@CC@ADAAACA@@@@CA@AAA@@A@@C@@C@@A@AAAA@A@@@@DA@@BB@ABA@@@@@ACACGAA@@@BABC@A@@@@@@@@EB@@@A@AB@@@GC@@@AAAAA@@CAAA@@@E@ECBMC@A@A@AC@@@G@A@@@@G@@FB@E@EA@EQ@E@@DBD@@@AIFCECBAA@B@B@AEB@@@@AB@@@@A@@C@C@CAA@A@A@@A@CBA@@B@B@BIBB@ADB@@@@B@@@B@@A@EA@@@@C@@C@A@@AC@@@@DA@A@@@@AA@@@A@@CC@@E@A@A@A@ABE@@@C@A@@@@BA@C@@C@A@@@@C@@@@A@A@A@@BAAAA@@M@BDA@A@A@@@A@AB@@@A@A@@GB@AC@@AEC@@GEAAAAA@@@@@QOA@CE@@AB@CAADACE@A@@@@@@BAAECA@@AA@@A@@@A@A@C@CA"],encodeOffsets:[[122452,33848]]}},{type:"Feature",id:"321081",properties:{name:"仪征市",cp:[119.184766,32.272258],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@@@@@@AB@LM@@JCHA@@F@DDBBDDDDHNDDHDBBLDDDDBFBDBFDFFDDDDHDFFD@HBL@D@ADABCDCDHJBDDDBDDFBH@BA@@DHPBBFDBBDB@@FDDDBD@F@DA@AFCDEBCBEDCFCDBD@FDDJF^H@@@B@@@@AB@@@@@@@B@@A@@B@@B@@@@BB@@@@@@@@@BBB@@@@@B@AB@@@B@@@@@@@B@@BB@B@@@@@@@@@B@@AB@@@BA@@BB@@BADBDA@@@@@@@@BB@@@@B@@@B@@@BDBFDJF@@B@@ADEBC@@EG@@@ABCDGFGBAB@B@H@BAD@LGFAFBF@DF@D@D@H@F@JBHBDBFLDAA@@@C@@@AACAA@A@@@@@@BA@@B@@A@@@A@@@@B@@@@@ACB@B@@@BA@@B@BA@BBDB@BA@@BABAB@AC@A@AB@@@@B@@BA@@B@@@B@@@BB@@@@@AB@@B@@@@@@B@@@@B@@@@BB@@@B@@B@@@@@@@@B@@B@B@@@B@@BBBBABC@@@@@@B@@AAA@@@@B@@@@@B@@@B@@BBAD@@@F@@B@@@@B@@@B@@@@@@A@@@@D@ABB@@@@@@@B@@@@@BB@@@@@B@@@@BBA@B@@@@B@@A@@@@@@AA@@B@@@B@@A@@B@@@@@B@@@@A@@@@@@BB@B@D@DAB@A@@AB@@@BABB@@@@B@@@@@@@@@B@A@@@B@@@@B@@@@A@@B@@@@B@@@@@B@@B@@B@@@B@@@@@@@@@B@@@BBD@BB@@@@@@@A@@B@@@@BB@@@@@@@@AB@@@@BB@B@@@@@D@@@@B@@@@@@@BBAB@B@B@D@@@BAB@B@@B@A@@BB@A@B@@B@@@@@@A@@@@@@@@@@B@AB@@@@B@@A@@@@@@@@B@@@@BB@@A@@@@@B@@B@@AB@@@@@@A@@B@AA@@A@@A@@@@@@@A@@A@@@@AA@@@@@@@@@@AA@B@@@@A@@@@AB@@@@@@@@@@@B@@AA@@@BB@@@A@AA@@@@A@B@@A@@@@@@@@AAB@@@A@@@B@@@@@@A@@@@@B@@B@@@@@BA@@@@B@@@B@@@@@@@@BBA@BB@D@@@@A@@@@B@B@@@@@BABB@@B@B@B@@A@AA@@@@@BAAK@CA@@A@@@A@@@@A@@@@A@@B@@@@A@@B@D@@@@@B@BA@A@@B@@@@@@A@@@@@A@@@@@AB@@@BE@AB@@A@@@@B@@C@@B@@A@@@A@@CC@C@@AAA@AA@@@@A@@@@@A@@@@@@@@@@@@B@@@A@@@@@@BB@@@@A@@@@@A@@BA@@@@@@@@@ABB@A@@@@@@@BBA@BBAB@@A@@B@@@@A@@@@@A@@@@@@@A@B@AB@@@@AA@@A@CG@AI@@B@@@@@@AB@@A@@B@@@@A@@@A@@@@@@@CBA@@@A@A@@A@@@A@@A@@@@@A@@@A@C@A@@@@@@A@@@@@@A@@@@@@@@C@@A@@@B@@A@@B@@@@AB@@@@A@@@@@@@A@@@@B@@A@@@@@@A@@@@@BC@@@@B@@@@@@@@@B@BA@@@@B@@@@AA@@A@@@A@@@@A@@@@@AA@@@@@@A@A@@@B@@A@@@@@@A@BAA@@A@@@A@@@@A@@@@@AA@@@@@@AA@@@@B@AAB@@@@A@@@@B@@A@AA@@@B@AABA@AE@@AA@@A@@@@@@@@@@@E@@A@@@@@A@@@@@@A@@@A@@@@@@@@B@@@@A@@@A@AAB@A@@BABAB@FC@@@@@@B@@@@A@@@A@BBA@@A@@@DEB@BAJEB@BAHCBAB@D@DAB@DABCDA@A@@@A@@@@@@A@@A@@AA@@@AB@A@@AB@@@@A@@@@@AA@@@@@@AAAB@@@@AA@@@@B@@@@A@@@@B@@A@@@ACBA@@@@@@B@@@A@B@@@@@@@B@@@@@@@B@AA@A@@@A@@@@@@A@@A@@B@@A@@@@@@@A@@@@A@@@@AB@@A@@@B@A@@@@@@@AA@@@@@@@G@@@@@@A@@@@B@B@B@B@@@@@B@@@B@@AB@@@BA@@@@A@@A@@AA@@@@A@@@@@@@@A@@@@AA@@@AB@@@@A@@@A@@@A@@@AB@@@@@A@@@@A@A@@@@AA@@@@B@@A@A@@C@@A@@@@@A@@@A@@@A@@@B@@A@@A@@@@A@A@@A@@@@@@@A@@A@@@@A@@@@@A@@@@AAB@@@@@BAA@B@@@@B@@@@@@BA@@BA@@DCAA@@@@@A@@@@@@AB@A@@@@@@@@@A@A@@@@A@@AB@@AB@A@@@B@BA@A@@@@@@@@@@@A@@C@BC@A@AA@BAA@@@B@AA@@@@BA@A@@@@@ABB@A@@A@A@@@@@@AA@@@A@@@@@@@@A@@A@@BAA@@@AA@@B@@@A@@@@@AB@BA@@@AHE@CBA@@BA@@@AFEBB@ABB@ABBB@BAB@@@@CAIAGCM@@@@AA@@@@@A@@@@AA@A@A@A@@@A@A@@AAB@@@@@@ABBB@@@@@@A@@CAAG@@@@@A@A@@A@@@@A@A@ABAHIHIBA@A@@@BB@B@@B@@@@@@B@@@@@@B@@BA@@@@B@@B@@B@@@@@@A@@BB@@@@DIBI@@BABKDOHc@CAA@@CAGCGE@@A@WCE@BO@@CAAAAB@AA@C@@@@@@@WA@@C@@@@FABaE@@ACCAA@BAB@DCFCBABADCBAHOBABA@@KAAA@EB@A@@AF@@CB@@A@ABAFBBDB@@CF@@A@@BA@@ACA@ACA@@@AA@CLCAAA@AABAA@B@@AB@@@BA@@B@@@BAA@BA@@B@@@@@DB@@@A@@@BfDB@BKBEBCJSBCFICBE@IBA@E@G@iAI@gAI@O@A@QCmM]JMDOFMDKDGBKDMBKBMBQ@GBE@K@KBEAE@E@G@A@MAIAGAMAAAEAA@GCCA@@ADCH@B@DAB@@BB@B@@@DA@@@SBCBC@@@E@CBEBEBC@GBABCBA@A@ABE@@F@@B@@B@ABH@@@@@@B@@B@@@BB@A@BF@@@@@@A@@@@@@BB@@B@@A@@@A@@A@A@@A@@@@DB@@@@B@B@BBB@B@@@@@@@@BB@@@@@@@A@@@@B@@@@A@@B@@B@@@@ABBBA@@BA@@@@B@@@@@@@@AB@B@@@@BB@B@B@@@@@DABA@@@@B@@@B@@BBA@@@@@ABAA@@@B@@A@@@@B@@@@@@@@@B@B@@BD@@DBB@@@@B@@@B@@@B@@@@@@B@@B@@BB@B@@@@BBA@A@@B@@@@@@A@@@@@A@@@A@@@@@A@@@@@@@AB@B@@@@@B@@@@@B@@@BB@A@@@AB@@@@@B@@@B@@B@@@@BD@@DA@@@BB@@@BB@@B@DAB@@@@@@@@@B@@AB@BA@@@DB@@@B@@@@BB@@BBB@@BBB@BA@A@@B@@B@A@@@AAA@@@BB@BB@@@@B@@AB@@A@@@@@A@@@A@@@A@@AADBB@@@BA@A@@@AB@BA@@A@@@@@@@A@@@AA@@BA@@@@@@@BBA@@BB@@@@B@BA@@@@B@@@@@BA@@B@@@@AB@@@@@@AB@@@B@@B@AB@@@B@B@BF@BB@A@@@@@@B@BB@@@BB@B@@@B@@@@BBBB@B@@@@B@@B@@@@B@@@B@@@B@BBB@@BB@@B@B@BB@@B@AB@@@@@B@@AAA@ABCB@@@@A@AB@@@@BB@@AB@@A@A@@BA@AAA@@AAB@@@@@BB@@@B@@@@B@@@@A@@@@@A@@@@@A@AA@@A@@@@@@@@@@AB@AA@@@@@A@@A@@B@AA@@@@@@B@B@@@@BB@@@@@B@@@@@@AB@@A@EA@@BB@@AB@@B@@@A@@@A@@A@@@B@@@@A@@@@D@@B@@@@@@@@B@@@@BB@B@@@@B@@@B@@@@@@BB@@@B@B@DB@@B@B@B@@@D@@@@@BBA@B@@@BA@@@B@@BB@AB@@@@@@BA@@B@@@@@B@@@@@@@@A@@@@@@@@@@@@B@@A@@@@@@B@@@@A@@B@@@B@@A@AA@@@B@@GBCBA@A@@@@@@A@@@@@BA@AA@B@@@BB@@@@@@@B@@@BB@@BB@@AB@@@BA@@@@@BB@@@@@@@@@B@@@@@B@@@A@@A@@@@@@@A@BBA@@@@@@@A@@@@@A@@A@B@@@AA@@BAB@@@@@@AA@@AA@AA@@B@@@@@@ADD@@@@@@@@BA@@B@BBB@@@@AB@@@@ADAB@BCA@@A@A@@@@BAB@@D@BDB@@ADBB@@@@BBB@B@@A@@BA@@@@BBDADA@@@BB@A@@B@@AB@@@B@@BA@BB@B@A@@B@B@B@@@@@B@@@B@@@@@@@@B@@@@BB@@@@@B@@@@@@A@@@@BA@AB@B@@@@@@@BBA@@DA@@@@@@@A@BB@@@BB@@BB@@B@D@A@@B@@@@A@@@@@@B@@B@@@@BB@@@@B@AABA@@@@@@@A@@@@@@B@@@@@@@B@@@@@@@@A@@B@@@@AA@@@B@@@@@@B@@@@@@BA@A@@@A@@@@@@@@AA@@@@AA@AB@B@@B@B@@@B@@BABB@@@B@@B@@B@@BA@@@@BAA@@@B@@@@@B@@A@@@@@@@AA@@@@@B@@@@@B@@CC@@@@AB@@AA@@@BA@@@@@@@@@@@@B@@B@@B@@@@@@B@BB@@@@@@@@BBA@@@B@@@BB@@B@AB@B@@@@@@B@@@BB@@@@@B@A@B@@B@@B@@ABB@A@@@@@@@A@@@@@@BABA@@@@@BB@@@@@@B@@@@BB@@@@BJA@@B@@@B@@@D@@@BA@@@A@@@ABB@@@A@@@A@@@@B@@@B@@@@@@B@@@@@@@B@@BB@@@@BB@AB@@@@@BBB@@@B@@B@@@@@@BBA@@@BB@B@@@@@@BB@@@@@@@A@@@@BB@@@@@BA@B@A@@@@@@B@@@@BA@B@@@@BB@@@@@@BB@@DA@AB@@@BA@@@@@@B@@@D@@B@@@@@B@@BA@@@@@D@@@@@@@@BA@@AA@AB@@@@@@@@A@B@ABB@@@@@AB@@@@@BBB@@@B@@@@A@@B@@@@AA@B@@@@BB@@@@@BB@@HD"],encodeOffsets:[[121925,33244]]}},{type:"Feature",id:"321084",properties:{name:"高邮市",cp:[119.459161,32.781659],childNum:1},geometry:{type:"Polygon",coordinates:["@@hBvJDD@h@hB\\B~A@v@H@BBBBDBBBBDDBHDBLHRNPRNPLT@BDD@DFCLENGDCDADADAJA@@JS@@B@hFB@B@B@@ABA@@@@@@FBB@DAD@@@B@BBDDDBFDBBFDLHBBF@D@@@D@D@BAJ@LCD@JAJ@FAB@DAF@F@RCHAB@DBHBRDF@FBB@DBRDD@HBB@D@@@F@D@B@D@@@HBF@D@NDF@F@D@FBJBB@@@@BAB@BA@@B@B@B@@CAADA@@BAB@@BBA@@@BBB@BB@@ABB@@B@@@BCB@DDBD@B@B@@@B@@@BB@@DBBFAB@@@@@@@BDFCBBB@DBA@@DFB@RP@@@@B@BBBBHF@@FD@BD@@BHA@@@B@B@@BAB@@@B@B@B@ACN@@@BBBB@AB@B@B@@@@@@D@@B@D@@@@DAB@@@@@B@D@@AF@B@B@B@B@FD@@DB@@@B@@B@@B@B@@C@@D@@BB@D@@@@@@@@CD@@AA@B@@@@@@E@@DB@AB@@AA@@A@@D@@@@@@A@ABA@@BBB@@@BBFKBE@@B@@@FD@@D@@@@@BCFE@AB@@@B@@@J@@@@AB@D@@@F@@@D@@@@@@@B@@BB@LE@@EC@@AKA@@@K@@MB@BBDEB@@A@A@@AADEACAGAE@C@I@@@@B@@ALB@@@@@@@@NF@@@ABME@@CA@@@A@@@@ABA@C@@B@@@BB@@@A@A@@BA@@@ACAABA@@@A@A@@@@C@@@@ABAA@C@ABA@@D@@C@@@ACAAE@@@@@ADB@@@GDB@@@@@C@@@ABEDA@@D@BBD@B@BB@@D@D@B@BA@@BCBC@A@@@@@C@@BAEAAE@@A@@@AIEB@CA@@@AC@@A@G@@E@@D@@AA@@AD@@BB@@A@@@M@AACB@@@@CC@A@@@@@@@@@@ABAKA@AE@@EA@@@@@@CD@@A@@BA@AH@@@BC@AA@G@@EC@@ED@B@@A@AF@@E@@LCAA@BAA@AB@FA@A@@C@@@CBAAHAB@D@@@@CB@@@B@D@@BB@HA@@BA@@@A@A@@@@@AA@CG@@A@@@AEB@@@@C@@@@B@BC@@D@D@B@@E@@BCB@@A@AAC@@@@K@BGD@BE@C@@@EA@@AA@@A@AB@@DF@@C@@BDB@@CD@@@@@@DD@@AB@@AB@B@ABBB@B@@LB@ABA@CLBBB@@@@L@@@BBL@@@BA@@@@@@@BB@@BFB@@@HD@@@@D@BF@B@@AD@D@DAD@@@@@@D@BT@@@@C@@@EF@@EB@@BHB@AAA@CBA@@CA@AC@@A@A@@@@@A@@IABG@ABC@@@ABCDE@ABCD@@@@AA@JABAA@@@@CA@@C@@A@@@@@@@@AB@@C@@BA@@D@@@BC@@DB@@BC@@@@BG@@B@A@DECAGA@CHBBCA@BE@@DBD@@AA@AAA@@AA@@@AA@@A@@@@AAACB@AA@@C@A@@@AC@@CA@@C@A@A@@FB@CDBB@FBAE@O@@D@BC@EA@@AA@@@A@A@@FA@@@@EC@EMBAB@@EA@A@@@@C@@@A@A@@HCD@J@@@@AAAB@@A@@B@@@@@D@BD@A@@B@A@@B@B@@@@D@DAB@D@B@B@D@F@D@D@BAB@@AB@B@BA@AA@@@@@@@@@BAA@AC@@@@@@B@@@B@@A@@C@@C@@@@G@@D@B@BA@AAGAA@CCCAACAACAECCCCCAAEEECA@CCACACCC@@@@BDBDA@@@C@@@C@A@@AG@@@CAA@@AB@@A@EAAGCBCA@CA@A@AGA@@@B@BC@@A@CAAD@B@@AD@@@@AB@@@AAC@@@@B@@C@AEDAB@EECCGIACCEAAA@ABA@A@@@CAA@AA@@@A@@@AAACC@AA@C@A@@C@@@A@@@AAA@A@@BAGGEEKOEECC@@A@@B@@@@CB@CABA@@A@A@@E@A@@@A@A@@BA@@@CB@DA@A@E@@@E@@@C@@@@@A@EA@@@F@DA@ABA@@B@@C@EBC@@@@@AACC@BAACB@@C@@@@A@@E@@B@@@@E@AFABC@A@@@@@@@G@C@@@@@@AA@@AI@AB@@A@@@C@@BA@G@@ACA@DC@BCA@@@A@A@@@A@@@@B@@A@@@Y@A@@@@AA@@AA@A@@@E@@G@C@A@@@@B@B@@@A[AG@AAQ@AAOAUAM@CA@[BG@@@QBE@AAE@C@A@I@I@A@EAI@MAC@EAC@@@C@C@@@@@CAC@G@@@A@CBACE@GB@BBHBH@@@D@@@@AZA@A@E@@@@@CAAA@@CAE@C@A@A@GC@B@A@@@@@@@GA@@@@@@@A@ABW@@EAGAC@CAA@EA@BB@@C@@@CB@A@AAAA@ABA@A@@@AB@B@@@@EBAA@@@@A@@@A@AB@@ABAAA@@@AA@AA@@@A@A@@@E@EBM@SDODIBUDKDIDMFGBE@ABABAB@@C@ABA@A@AAA@@AA@AAGACAE@ACIS@@AGCCCCAAECCAAAACAAAACCACBC@E@@CCGG@AA@@@AC@@AA@@@@C@@@@@@@A@@B@BA@@@@@@@@@@@@B@@@@GB@@A@@AAB@AA@@B@@A@B@@AA@A@@B@@@@@@B@A@@@AB@A@B@@B@@@ABA@@@@@CD@BA@CCB@AAB@AAB@A@@@@@A@AA@@@@CB@@A@BB@@A@@@@B@@A@B@ABA@@A@@@@@@@A@@A@@@@C@A@AAC@@@@@@AAB@A@BA@@@@A@@A@@A@@A@@A@@@AAB@@@EG@@A@@@@@@@@@A@B@A@@A@@@A@@A@@@B@@AA@@@@AD@AA@@@AAA@AA@@@A@BA@@@@A@@@@B@@@@A@@BA@@@@A@@@@@BA@@A@@A@@@@@@@@@@BA@@@@@BAA@@@@@@@@@@@@B@@@@A@@@@A@BAA@@@B@AA@A@AB@@C@A@A@A@AB@A@@@@@@@A@@C@@@@@A@A@@A@@A@@B@@@@@@A@@A@@A@@@@B@@@@@@AAC@AA@@A@@@@@@@@@A@@@A@@@@AA@@@@@A@@@@@@AB@@@@@@A@@A@@@B@A@@@@@@@@@A@@@@@AAAB@@A@@BB@A@CBC@A@A@@A@@@@B@@@@@@A@@@@@AB@@@@A@@@AB@@B@@@@B@@@@A@@A@B@AA@@@@@A@@@@AA@@@@A@@@@@@@A@BAC@@@@@@B@@@@A@@@A@@@@@@AE@@@C@AB@AA@@@A@@@@@A@@@@@BB@BA@@@@@@@ADABAA@AA@@@A@A@@@@A@@@@@@A@@@@A@@AA@@@@@A@@A@@@@@@@@AA@@B@@@@AA@@A@@@A@@@AB@@@A@@A@@B@BBDA@ABAB@@ABA@AC@AABA@@@AB@@A@A@BD@@@@A@@@@@@B@@@BA@@@AB@@@@@@@BBBBD@B@@@D@@BB@@@@@@DHEBC@EBEBCD@FAF@D@BBDBDBD@B@D@DADKFEDADAB@DBf@JBTBN@B@r@BELBJ@H@BAD@H@F@D@F@DCFADABCFCDAB@FAD@FAFAD@DAF@DAH@DADAFAF@DAB@JAL@D@DAJ@FG^@DRBdCtCh@ZA"],encodeOffsets:[[122132,33612]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("镇江",{type:"FeatureCollection",features:[{type:"Feature",id:"321102",properties:{name:"京口区",cp:[119.47016,32.19828],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@@A@@@@@@@@@AB@@@AA@@B@@@@@@@@@B@@A@@@A@@@@@AA@B@@AB@@B@@B@B@@A@@@@@@@@@@@AA@@@@A@@C@@A@@@@@A@G@AA@@@@@@B@@@@@A@AA@@BA@BB@@@@@@@@BB@@A@@@@@@@AA@@@BA@@A@@BA@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@@A@@@B@@B@@@@A@@@@@@@AA@@@@@AA@@ABA@@@AECB@@@@BB@BAB@@@@@@@@AAAB@@A@@@@@A@@@@@@@@@@A@@CA@@B@@@@AA@@@B@@@A@@@@@A@@@@A@@@@@@A@@@@B@@AA@@@@B@@@@AD@@@@A@@@@A@A@@B@@AA@@@@AB@@@A@@@@B@@@@A@@A@@@@@@@AB@@@A@@@@B@@@@@A@@AB@@@@@@@@AA@@@@@@AA@@@@@D@@@@@A@A@@ADB@@@@@AA@@@A@@AB@@@BB@@@@BA@@A@@AAC@BA@@@A@@@@BA@@@@BA@BD@@@@A@BB@@@@B@@@@@@@@B@@@@@@@BA@A@@A@@A@@AED@@@@@A@@@AA@@@@@@@AB@B@@BBBB@B@@@B@@@@@B@@@@@@@BCBA@ABCB@@A@@@@@A@@@CDBBB@@@@@B@@@@B@@@@B@@@@@@B@@A@@B@@@B@@@@CCB@@@A@@AA@@B@@B@ABB@@@@@@BA@@@@@AA@@@@@B@@@@B@@@B@@BA@B@AB@@@A@@@@@B@@B@ABB@@B@@AB@@@@@@@BC@@@B@@@BBA@@@@B@B@@A@@@@A@A@BAA@@@B@@C@@@@@A@@@B@@A@@A@@B@BAAA@@@A@BBABB@A@ABBBB@@BC@@@@@ABA@@B@@B@@@B@@B@@@@@@@B@@@@B@@@@@@@BB@@BA@B@@@@@B@@A@@B@@A@B@ABB@@@@B@BA@@@@B@@@@@@A@@A@@@@@A@BA@@@@@@@A@@A@BAAA@@B@B@@@@A@@@@@@@@@@@@@@B@@@@@@@@A@@@@@B@@@AB@@@@@@B@@B@@@@@@@@@BAAAB@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@A@C@@A@@@@A@@AAA@A@@B@@@@A@@A@@@@@@A@@@@@@@@@A@@A@@@@@A@@@@@@@A@@A@@E@CB@AAA@ABA@@@@@AA@A@@@ABA@@@A@ED@@BB@@@@ABAB@@A@AB@@@B@@@@@@@@AFAA@BCA@@@@A@BBA@@@B@AB@@@@@@A@@@@@@@@A@@@BA@@A@@@@@@A@@A@@A@@@A@@@A@@@@@@@A@@@@@@@@@@CAB@AA@@@@@@@AB@@@@@@AA@B@@@@@@A@@A@@A@@BA@@A@B@B@@@B@@@@A@BBAB@AA@@@@B@@@@A@@@@@@BA@@@@@BB@@A@A@@@@@@@A@BB@@@@@@@@A@B@AB@AAB@A@@@@@@@@A@@@@B@@A@@@@@@@@@AA@@A@A@@A@@ABBB@@@@A@@@@@@@@@@@@@@@A@BA@@@AA@@@AA@@@@@@@BEA@@@@@@A@@@@@AB@@AB@AABAA@@@BA@@AA@BAC@AAABAABA@@@@@@@A@@C@BAA@@CG@C@ABAB@D@DBDDHDJABEMA@AAAC@AGBI@BDABAD@@@DBH@DA@A@CA@@@A@@@A@@A@A@A@ABADAB@B@B@@A@@A@@@A@A@@@AA@@A@@@AA@ABA@@B@@A@@B@A@@@@@A@@@@A@@@@@@A@@@A@@A@@A@@@@@A@@A@@AAAA@@@A@A@C@AA@@@BA@@@@@A@@@@@A@@@@@@BAA@B@@A@@@@@A@@@@BB@@@A@@@@@@@A@@AAB@A@BA@@@@@AC@@A@@@A@@@@@@@@AA@@A@@@@A@@@@@AB@@@B@@@@@@A@ABAA@B@@@@A@@@@@EFABAD@@ABGJ@@EFCFABILBB@BB@@@AB@BAAAB@@AB@@ABAAADAB@@@@B@@DGBBBDFB@ABA@@BAD@B@@CDGF@@@@@@EAA@AB@@A@CA@@ABC@ABG@@@ABABAD@@@D@D@D@BAB@@@B@B@BA@@@C@A@AF@B@D@B@B@B@BBBBAHCB@@@B@B@BBFDHDDDBBJDFDB@ADADAB@B@DBFADARCRRBH@J@XC\\ENGFAHDFBJF@@BBHFFDB@@BBBB@@BA@@BABDDBBHF@@DBAH@D@@LBHBDB@AD@FBD@HAB@F@N@@@@E@KQABK@A@QB@@GBK@C@aj@D@BBBB@BC\\AHBB@B@@@@L@J@J@B@HADA@ADABCB@BABC@C@AAAACBMBOBE@@BAB@B@BBDNBD@B@D@D@DAFALAD@DBB@BBDBDBBDBBBDBFBBBD@D@@@FBHBF@B@B@F@HBHBHBFBBBDBDBFFDBBBDDBD@BBBD@@@@BFAB@^AYmfQFAD@B@DADABAHEJGBCLIBAJGDCD@DCDABCB@@@BAPGLENGDAFABAB@H@H@HBH@FBDBJ@H@J@LEDAFAFC@@DCBABA@@@@@@A@WAC@@E@A@A@I@@@@E@CAE@C@E@E@CAA@AAA@CCAAAA@A@A@A@ABEDIDE@@@C@A@CAAAGACCKCEAK@AAEAE@G@E@CBGDKBAGAI@A@C@ABC@@@@BB@@BA@@B@@@@@@A@AAA@@@@@@AABAAC@AAC@A@GDC@AB@@@B@B@@@@A@@@@AA@@AB@@@A@@@@@A@AB@@@@@@@BAA@@BAA@@@@D@@A@BCA@@@@B@B@@@@A@@@AA@@IBGBA@U@E@@@BA@@@@A@@A@@@@@@@A@@@@@A@@@AA@@AO@AB@B@@@@A@@BB@@@@@@B@BB@@B@D@@@B@@@BB@A@@B@@@@A@@B@@@@A@@@@B@@@@@@@B@@A@@@@@A@@@@@@BA@@@@B@@B@@@A@@B@@@@@B@@@B@AAB@BA@@@@A@@@@AB@AAF@@A@@@A@A@@@A@@B@@@@A@@A@A@@@A@@@@CD@@AAA@@@EBA@@@A@@AA@@@@@@AA@@AA@@A@@A@@AA@@@B@@@@@@@@A@@@@@BA@@A@@@B@@@A@@@@@@@@@AA@A@B@AA@@A@@A@A@@A@@@A@@@@AA@@@@@A@@@B@@AA@@@A@BA@@@@@@B@@AB@@@@@@A"],encodeOffsets:[[122565,32890]]}},{type:"Feature",id:"321111",properties:{name:"润州区",cp:[119.411959,32.195264],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@B@@@BA@@B@B@@@@AA@@@@@B@@@@@@@BAA@BAB@@A@@BA@@@A@AAA@@@@B@@@@A@@@A@@@@B@@@B@@@@A@AB@@@@@B@@BB@@B@@B@BAB@BBB@@@BB@@@A@A@@@@BA@@AA@A@@@@A@AA@@@A@@B@@@BB@@B@@@@AA@@@@AB@@@BA@@@A@@@AEA@@BA@@@@A@@@CB@A@@BA@@@@A@@@@A@@BA@@A@@@A@@@@B@@@@@BA@@@A@A@A@AAA@@@@@@A@@@AB@@AB@@C@A@@@@A@@B@@C@@@ABC@@@@BEB@AA@@@AA@AA@A@A@AA@@A@@@@BA@@B@BA@AB@@@B@@A@@@C@ABCBA@A@AAAAC@@@A@@@@BABAD@BGCE@@B@B@@@F@@AB@@A@@@@@BB@@B@@BA@BB@BB@@B@BD@@B@@@@B@B@@@BADAB@@BA@@@@@B@@@@B@@@BB@@@@A@AB@AFBBB@@BBB@@@@D@@BB@@@@@B@@A@@@@B@D@DB@BD@@@@@@@B@@@@A@@BB@@@@HB@@@B@@@B@@@@B@"],["@@BCCA@AA@@@@A@@BC@A@@@@DABCDA@A@@B@@ADAAA@@@A@A@@@@@A@AA@@@A@@A@@@@DA@@AA@A@AAB@A@@A@@AA@A@@@@@AA@@A@A@C@O@Q@E@[BI@A@ABA@@@@@@H@@@B@BAB@B@@@@ABBBCHBDD@BDBDDDB@FHAA@B@@@A@@A@ABA@@@AB@@A@@A@@@@A@A@@BAAA@@@@@A@@@C@@ACA@@A@A@@@A@@@A@@BA@EBAB@@@@B@ABDB@@B@@B@@@@@DB@BA@@@B@@B@@BA@@BA@@@D@@B@@C@@@BB@@B@@@@A@@B@@@@@BB@@@@A@ABB@@@@B@@@B@@@@@B@@@B@@@@@@@@A@@@A@@B@B@BA@MBABA@@D@@@@@@A@AACAAA@@@@C@A@@BI@CBC@K@G@E@ANZDPBDBH@DBDBBBDB^PDBNFBBHDFFFFHJFFHHHFPPBBJLXHhNJ@\\DBBDQBQBCAE@C@ABABCBCA@ECICAACCGCECAAA@A@@@A@GDABAA@A@A@A@A@C@ABEB@D@@@B@@A@A@A@@BA@A@C@C@C@@BCBABA@@H@BAD@BA@@DBB@@@BAB@FB@@@@@@HEDC@@@ABC@AB@BAA@CEAAHA@CA@@@@@BABCBBBA@@BA@@BABB@ABA@@A@@AAAJKBADEFE@@HIBA@@BCBAFE@@@@B@@@@@@ABBBAB@@@@@@@@A@@BA@@@@B@@@@AB@@AA@AA@@@@@A@@@@@A@@@@A@@B@@@@@@A@A@@@@AB@@A@@A@@B@@@A@@A@@A@@B@@AA@A@@@@@@@BC@@AA@@@B@@@@A@@AA@A@@@A@A@@BAAA@ABAAA@A@@@@@@A@@A@@@@@@B@@A@@@A@@@@A@@@@@@AA@@@@@@@@A@@@@@A@@@AB@@@@A@A@@B@@@@A@@@@@A@@@@B@AAB@@@@@@@@@@AB@@CA@@@@@@@BA@@@@@A@@@A@ABCFBB@B@@A@@@@BA@@B@@AAA@A@BB@@@B@D@@A@@B@@@@@@AA@@@@ABBB@@CA@@@@@@@@@@AA@@@@@@A@@@CC@@@@D@@@@@B@AA@@@@A@@B@@@@AA@@BA@@@@@@A@@@@A@@@@A@@@@@AA@B@@@B@@A@@@@@@@@@@A@@@AA@@@A@@@B@@@@AA@@@@@A@@@BA@@@@@@C@@@@@@@A@BB@@A@@@@BAA@A@@A@@BA@@@@@@@@@@@@@@B@@@@@@@@A@@@A@@BC@@@@@AB@@A@@@A@@@@BCCABA@EBEDIF@BBBBB@@BB@BLC@BBD@B@BABB@@@B@@B@@@BAB@BA@@@AB@@A@@A@@@A@@AB@@@@@BAB@@AB@@CAC@@@@ACBA@@@AA@B@BA@@@A@@@@@@B@@@@A@@A@@@AA@@@@@@@A@@@@A@@A@A@@@C@"]],encodeOffsets:[[[122194,32888]],[[122279,32935]]]}},{type:"Feature",id:"321112",properties:{name:"丹徒区",cp:[119.433853,32.131962],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@@D@@CBA@@B@B@D@BBD@@@DA@@BA@@D@B@B@@A@@@@@@@A@@@@A@@@@@@@@@A@B@@@@AA@@@@@@@@@@@@A@@@@@A@@@@@ABB@A@@B@AA@@@AB@@B@@@A@@@AB@@@@A@@@BB@@A@@@@@@@@B@@@BB@ABAA@@@A@@@@AEB@CD@@@@@@C@ADAD@@@@@@@@@B@@A@@B@@ABAAAB@@@@@BA@@A@@AA@BA@@@A@@@@A@AAI@@@@BA@@@A@@BD@@@A@@B@@@BA@A@A@BD@@C@@B@@@BA@A@@@@@ACA@@@@BA@@A@@@AA@@A@@@@A@@@@@@@@@@@@@@@@@A@@A@@@@@AAB@AA@@@@@@B@@@@@B@@BB@@@@@@@BA@@@@A@@@@A@@A@@@AA@B@@@AB@@@@@@@@@@@@@@@@@@@@@B@@@@A@@B@@@@A@@@@B@@A@@@@@@@A@@@@@@@@B@@@@A@A@EAC@@@A@ABA@CBAA@@C@ABAB@@A@@@A@C@@D@@B@@@@@@@@@A@@@@@B@@B@@@@B@@BA@@@@@@@B@AB@@@@@B@@@@@@@@A@@B@@@@@@@B@@@@@BA@@@A@@@@@@@@@@@@@@@A@@@@@A@@@@@ABB@@@@@AB@@@@@@@@A@@@@@BA@@C@@B@@AB@@@@@@A@@@E@A@@@A@@@A@@@@@@@A@@@AAAB@@@@A@AA@@@@A@A@AA@B@BA@@@@@@@@@@@@@A@B@@A@@BA@@A@@@AB@@@B@@@BB@@@BB@@B@@@BA@@@B@@A@@@AB@B@@A@@@A@@B@@@@@@@@A@BBA@@@@@@@@BA@@BD@@@@B@@A@@@AA@BA@@@@@@BA@@DCAA@@DA@@CA@A@C@AAC@@BA@AAA@@@A@A@@@@BBBA@@DA@@@@ACCCEEB@BC@@D@@@B@@C@@B@@@@AB@BA@@B@@@BBB@@A@@@@@@B@@@B@@@B@B@BB@ABB@BBB@@B@A@B@@@B@@@D@@B@@BB@@D@B@@@@@@@@A@@@A@A@A@A@@BA@@B@B@@@BB@BDDB@BB@BD@@@B@@@@@B@@ADBB@@B@BB@@A@@@A@@@@@@@@B@@@BB@@B@@B@@@BB@@@BA@@B@@@@@B@@@@@B@@A@@@@@@@ABB@@B@@AB@@AB@@B@@@@@@BC@A@@@@@@BDA@@BB@@@@@@@@@B@@JDBA@A@A@@@ABA@@@A@@@@@@@@A@@@@@B@@A@@@@@@@@@@B@@@B@@ABB@A@@@@BB@@@@@@AB@@@@@B@@@@@BA@@@@@@@@B@@@@@B@@@@@@BDA@@B@@@B@@@B@@@@@B@@@B@@@@B@@@@@@@@@@B@@@@@BA@A@@@@B@@B@B@@@@B@@@@AB@@@@A@@B@@@B@@B@@BA@@@@@AA@@@B@@@BA@@BA@@@@@BBB@@@AB@AA@@@@@A@@@BB@@@@@@A@@@@A@B@@@@B@@@AB@@@A@@@@@@A@@@@@@@@B@@@@@@@@@@@@C@@@@@@BA@@@@A@@@@@@@@A@@@BB@@@@A@@@@@@@@@A@@@A@@@AA@@@@@@AA@@@BBB@@@@@BB@@@@@@@AB@@B@@BA@AB@@@@BB@AB@@BB@@@@A@AB@AA@@B@@@B@@@BB@@@@@B@@@AA@@B@@@@B@@B@@@@@@@@BB@@@@B@@@@@A@@B@@@@@@@@@@@@@@@@B@@@BA@@@BB@@@@BBABB@A@@BD@@@BA@A@AB@@@@@B@@B@B@@B@@AB@@A@@@CA@@@@@@@@@B@@@@BB@@@@A@AB@@@@AB@@BB@@AD@@@B@@@BB@@@@@@@B@@@AA@@@@A@B@@@@@B@@@@B@@BB@B@@BA@B@@@A@@@BB@@@@@@B@@@@@B@@@@B@@@@@@B@@@@@B@@@BB@@@@@@@@@@@B@@B@@@@@@B@@@@@B@@@@BB@@@@B@@@@B@@B@@@@@@BB@@@@B@@@@@@@B@@@@@@@@@BB@@@@B@@@@@B@@@@@@@B@@@AB@@B@@@BA@@B@@@@ABB@ABB@A@@BDB@@@@C@@@@@AA@@@@@@@AAB@BB@@BABBA@@B@@@@B@@@@@@@@@@B@@D@@A@@A@@@@A@@@@@BB@@@BA@@@@@@@@@AB@@A@@@@@@BBBB@B@@@@@@@@A@@@AA@B@@@@B@@@AB@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@@B@@B@B@@@@@B@@@@@A@@B@@B@@@@A@@B@B@@@B@@B@@@@@@@BB@B@@@@BA@@@B@@B@@@@BB@@@@@BA@ABA@@B@@B@@@@B@@@B@@@@B@@A@@@@@@@D@@A@@@@@@@@B@BB@@B@@@BB@@B@@A@@DA@@B@@@@@@@@@B@@@@A@@@@@@@A@A@@@@@C@@@AB@@AA@@AB@@@@@@@@@BA@@@A@@B@B@@@@@@ABB@@BA@A@@@A@@@A@AB@@B@B@@@@@@@@@@B@@A@@@@BA@@B@@@@A@BB@@A@@@@B@@@BA@@@@@A@A@@@@@BB@@@@@B@@@@@@@@@B@@@@@@@@B@B@AB@@@BB@@@@@B@@@B@@B@@@@@BBA@B@@A@B@@@@@@BB@@BB@@@@@B@@@@@B@@B@B@@@@@B@@@B@@B@@@@@BBBA@@@BA@@B@@@@@@BB@@@@B@@B@BA@A@@@@@@@AB@@@@A@@B@@@@A@@@A@@@A@@@A@@@BB@@@@A@@@@@@@A@@@@@A@@@@B@@@BA@@A@@@@A@@@@@@BA@@D@@@A@@@@@@@@A@@@@BA@@@@@BB@@A@@B@@@@B@@BA@@@D@B@AB@@@@@@B@@BA@@B@@B@@@@@@@@@@@B@AB@@B@@@@@B@@B@@@A@@@BB@@@@@@B@@B@@@@@@B@@@@@@@@@B@B@@@@@@ABB@@D@@DF@@@@@B@BA@@@@@ABBB@@@D@B@@@@@DDBB@@@A@@B@BA@@@@BA@AB@@@@A@@BB@@@B@CB@@A@A@AB@A@@@@@AA@@@AB@@@@@B@@@@AB@B@@@@A@A@@@A@@A@@@@CA@@@@A@@@DD@@@@@@@@@@A@BB@@@@A@AB@@A@A@@@@A@@A@@@@@B@@A@@A@@@B@BA@@@A@@@@A@@BCB@@A@@@BBA@@@AB@@B@@@@B@@@@@@ABB@@@@BC@A@@BAB@@@B@@DDAB@@A@@@@@A@@@@@@CA@BA@@A@A@@@A@@@A@@BAB@@A@A@A@A@AA@@AA@@@@@B@@@B@@@@A@@@A@A@@BB@@@@@D@@BA@@@@B@@@@B@@A@@BB@B@BA@A@@@A@AAAA@@@AB@@@@C@@A@@B@@A@A@@@@B@@@@@@B@@B@@BDB@@B@A@@BB@@@BA@@@@BA@A@AB@@@@AAA@@@CA@A@@A@BB@B@@@B@@@@B@B@@BA@@BB@D@@@BB@@@@@B@@@BA@@B@@C@@@@BA@@@A@@@@DABB@B@@BBB@@B@@BFA@A@@D@BA@@D@@@@AB@@@@@@B@DA@@BA@@B@@@B@@@@B@BBB@B@B@@@B@@A@@@@@A@@B@@@B@@@AB@@@B@@B@@@@@AB@@@BDA@@B@@@@@AB@@FB@B@@@B@@AB@@A@@B@@B@@B@@A@@@AA@@A@@@@B@@BBB@@@@@@BBB@@AB@@@@@B@B@@AA@@A@AAA@ABA@@@@AA@@AA@@@@@A@@B@B@@A@@@A@@@@@@B@@@B@@A@@@@@BB@B@B@@AB@@@BA@ABB@AB@@@@@@A@@@@@B@@B@@A@A@@@AB@@A@@@@@A@@@@@@A@@@A@@GA@@@@AA@@@B@@A@@@@@@@C@@ACAC@A@@@@@@BA@@@@@A@@AC@@@@@AA@AA@AABEA@@B@B@@A@@A@@@A@@A@@@@@B@@AA@CBAB@@A@A@@@@@@AC@@A@AA@@AAAB@@AA@@@AA@@@@A@@@@@@B@B@@A@CACBC@A@A@AB@@ABC@A@@@@BA@@@@@A@A@BBBB@B@@@@AD@@A@@AA@AB@@A@A@@B@BAB@D@B@@@@BB@B@@@@ABA@@BCBA@CDAB@BADAB@@@BA@@@AB@B@B@@@BA@@BE@CA@@B@@A@@@@@ABA@A@@A@AA@@A@@@@BA@@@AB@@A@A@@@AA@@CBAB@@@D@@@@@B@@@B@@AB@BAB@@@BA@@BA@@B@@BB@@BA@@@@CD@BBBB@@@@BB@@@AB@@B@@@@@CB@@@@B@ABB@@B@B@BB@@AB@@B@@@@@@@@B@@@B@@@A@@B@@@B@@@A@@@B@@@@C@@@@A@@A@@@@@@@A@@@A@@@B@@@@@@@@B@@A@@@A@@@@BA@@B@@B@@@B@@@@@@@@@@AB@@B@@@@B@@B@@@@A@A@@@B@@@@B@@B@@@@B@@A@@BA@@@B@@@B@B@@D@@BBCB@BB@@A@BB@A@@BA@C@A@@B@@@B@@B@BADA@@@B@@A@@@@@BB@@@BA@@@A@AB@@B@BB@@@@AB@@@BBAB@B@@BB@B@B@@@@@@@B@@B@@@AB@@@@@@D@@@@ABAJB@D@DB@@@@@B@BB@@@B@H@B@@@@BBD@B@@BBDD@@B@BBBBB@DHDDCDEDCBABABABCDGFEBABWJ]LIDnNRDB@P@J@hBJ@jBH@F@B@JAF@DAD@HAHCRCTATAJ@L@H@D@F@BBEEGIEEEEGCAAMECA]OCAAACACAG@CAOAYCBMF@H@L@D@DAJ@@AB@D@@@@@BBDBBBB@@@@@@@@CB@BANAB@@A@A@AB@@@B@@@@@@@@@@A@@@A@@@@@A@@@A@@A@BAB@@@@@AA@@@@A@@@@B@@A@@@AA@@D@@@@AC@@@B@@AB@@AA@@@@A@@ABA@@C@@@@@AA@@@CABAA@@@@@BAFAB@@AB@@@B@@@B@B@@@DB@BD@@@B@@@@@B@BB@AB@B@@@@@@BB@@@BA@@B@BAB@@@@B@@@ABBEGA@CCACACC@ACDGAABA@@@@@ABA@A@A@@@G@@@@B@BAB@J@\\AF@R@P@D@B@B@@@BB@@@@B@B@@BB@@@@BBA@B@BBB@@CB@@@@@BB@@@B@@B@B@@@@@B@B@@BBCB@BA@@@@BCBADCB@@@@@BAD@@@B@@B@@BDBADD@@@B@B@@@@B@@B@@@@@@@B@@B@@@BB@@@@@@A@@@@B@@@B@@A@ABB@@B@DA@B@@D@DB@@BA@@BA@A@@@@BA@@@B@@@BB@@@BA@@B@@ABA@A@@@AA@@@A@BA@A@AAC@AKD@AAA@@AAAA@AJEFCFAB@BADD@A@@B@@@B@@@BA@@@@D@@AB@@@B@@@@@@@@@@A@@@@@@@@@@@@B@@AB@@@@BBB@A@@B@@@AAB@@@@@@@D@@@@@@@AB@@B@@@@@B@@B@@A@@@B@@@B@@B@@@B@@@@@@@@B@@@@A@@@ABB@@@@B@@@@@@B@@B@@@@@@@AB@@BB@@@@@AB@@@@@BBA@@@@@C@@@@@DD@@B@@@@@@@BB@@@@@@@@@@DB@@AABA@@@@BB@@@@@@@AB@@@@C@A@@AAB@B@BB@@@AB@@A@@B@@@@AAADEBAB@@@B@@@@@B@@A@@@@@@DB@@BA@@@@@@@@@@BA@B@A@@B@@@@@B@@@@@@AB@B@@@@@BA@@B@@@@@B@@@@@@@@@BB@@@@@@@B@@B@@@B@@@@A@@@@B@@@@B@@@@B@B@BBBAB@BB@AB@B@@@B@B@@BB@@@@@@A@@BB@@AD@@@@@@B@B@@BA@@@@BB@@@@B@@@AB@@@@BA@@B@@B@B@@@@@@@@AB@@@@@@B@@@@@B@@@@BBB@@BA@@B@@@BB@@B@@@@@@B@@@B@@@BD@@@@B@@A@BBA@BB@@@@@@@B@@@A@@A@@B@@@@@B@@@@ABB@A@@@@B@@@@@B@@@@@B@@A@@BBD@B@B@@@B@BB@BB@@@@B@@@@@BB@@@@B@@@B@@@@B@@@@@@B@@@@@B@AB@@@@AB@BAB@@B@@@BB@@B@@@B@B@@@BB@@@@A@ABABCBAB@B@B@@@@B@@@B@@DBB@B@@CAG@C@@BCBAACJ@HA@BBDBBB@FNBACICGAC@C@CBABAD@H@@DB@ABD@@@@B@@@@@@ABBBBABBD@ABB@@BB@@A@@BBBA@BBA@@BA@@@@B@@@@@@@FB@A@@@@@@BB@@B@@B@@ABB@@@@@@@@@@@@@@@B@@@@@AABA@@@BB@B@@@BB@@@@@@@@B@@@@A@@B@@@@@@@@@@BBA@BBAA@B@@@@@@@@@AAB@@@@@@@B@B@@@AA@@@@B@@A@@@@B@@@@@@A@@B@@BBAAAB@@@@@@A@@@A@A@BB@@AB@@@@BB@@@@@@@@ABB@@@@@@BA@@@@@@B@@BBA@D@@@@@@@@B@@@@@@@B@@@B@@@B@@@@BB@@@@@@@@BB@@A@@@B@@@@@@B@@@@@@@BAA@@@B@AAB@@@@@DB@ABBBE@@@@@@@@@A@@BAB@@@BABA@@@@AA@@FCB@@@B@BA@@B@B@@B@@@@AB@BBB@BDAF@@@@BB@@@@@@@B@@@@@B@@@@B@@@@@@@@@B@@@@B@@@@B@@A@@@@BBB@BB@@@@@@BD@B@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@BABB@A@@@@@@@@@AA@@@@@@@BA@@A@@@@@B@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@A@AB@BB@A@BB@@@@@@@B@@A@B@@@@@BB@@@@@@@@A@@B@@A@A@@A@BAA@B@@@@AB@@@@A@@@@@AAB@@AA@@@@@@A@@@@@@A@@@@@@@AA@@@A@@@@AB@BA@@@@D@@AA@AABAB@A@BAAAB@@@B@BB@A@AB@@@@BA@@@B@@@@@D@@@@A@@BB@A@B@B@@B@@@@A@A@@B@AA@@A@@@D@@A@@@@@@BA@@@AA@BAA@@@@A@@@@@B@@BAA@B@@AA@@@A@@@@@@A@@@@BB@@@@B@@A@@@@A@BAA@@@@AB@@BB@@@A@DD@@@@@A@@@AB@@@@A@@@@A@@@@@@A@@A@@@@@A@AA@@@A@@@@@@CB@@A@@AA@@B@@CC@@@@B@@@@@AA@BA@A@@@@ABA@@@A@A@@B@@@@A@@@@@@B@@@@@@@@@B@@A@@A@@@@A@@AB@@@A@@BAA@@@@@A@@@@AAAB@A@A@@B@@@@@B@@@@@D@@A@@C@C@@@A@AA@@A@A@@@AAAB@@@DAA@@@@@AA@@@@@@@A@@@@@@@@@@@@@A@@C@@@ABA@@A@@@A@@A@A@GAA@@AAA@AAA@@@@AD@@AA@@@AA@B@@A@@@A@@@@@@@A@A@@@AA@AC@@A@@@@A@@A@BA@@@@@@@A@BA@@@@@@AA@@@@A@@A@BA@@@@@@@@A@@C@A@@@@@@@@A@@@@@@@@AB@@@A@@BA@@@@AA@@@@BA@@@@@@@A@@B@@@@@@A@A@@BC@@@@BA@@@@@@@A@@@A@@@AA@@@A@@@@B@A@@A@@@@A@@@@@@A@@@@@@@AC@AA@BA@@@@B@@@@B@@@@@@@B@@@@BA@@@@B@@@@@@@B@@@@A@BBA@@@@B@@@@@B@@@B@@@@@@@@B@@B@@@@@@@@@@BBA@@@BB@@@@@B@@A@@@@@@@E@A@@@A@B@@@A@AB@@@@@AA@@BA@@@A@A@@@@@@@@@@BB@@@A@A@@@@@@B@@@@A@@@@@@AAA@@A@AA@@AB@@@@@B@@A@@@@@@@@@@@@@@@A@@@@@@@@@@BA@@@BDA@@@@@A@@B@@@AAB@@@@@@A@ABA@@@@B@@@@@@A@@@@@@@A@@@@CA@@@@@A@@B@@@@@AA@@@@@A@A@B@AA@AAC@ACCAAACACAA@AA@@B@@ABA@@@ECAAA@C@C@A@A@CBAAA@@@@BA@@@ABA@@@@@A@@@AA@@@AC@@@A@@@C@@BA@@@@B@@@BC@@B@@BB@@@@A@@B@@@@@@@BCAAB@@AAA@@@@AA@@@@@A@A@@@@@@@A@A@@A@@AAC@@@@BA@@@@@AB@B@@@@@B@@@@@B@@@@CDA@AAA@@@A@AA@@ABA@@@@@@BCB@B@@A@ABBB@@@@AAABA@@@@@@BA@@@@@A@A@@@AB@@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@@@BA@A@@AA@A@@A@@@BAA@@@@@A@BA@@@@A@@A@@@@@@A@@@@@@@@CAC@A@@AB@@@C@CA@@@@@@@@@@@@@@@A@A@@A@@@@@A@@@A@@@@@A@@@@@@B@@@@@@@@@@@@A@AA@@@@B@AA@@@@@@@A@@AB@@@@@@@@@@@@@A@@@@A@@@@@@@@A@BA@@@@@@A@@@@@@A@@@@@@@@@A@@A@@@@@@@@@@@A@@@@B@@@A@@@BAA@@A@@@@@A@@@@@A@@@A@A@@@@@@BB@@@@@@@@@@@@@@@A@@@@BAA@@@@@@@@@AA@@@@@@B@@@@@@@@@@C@@@@A@@@@@@@BA@@@@@A@@A@@@@A@B@@A@A@@A@@@@@A@@@A@@@@AB@A@B@@@A@@A@@@A@@@@@@@@@A@@A@@@@@@@@@@AB@@@A@@@@@A@@AAA@@A@@A@@A@@@@@@@@@A@@@B@@@@AA@@@A@@A@@@B@@@A@@@@A@BA@@A@@@@@@@@B@@AA@@@@@@@@@@A@@@@@AB@@ABAA@@@@@@@@@@@A@@@@A@@@A@@@@@@@@A@@@@@@@AA@@A@@C@@AA@@@@@@@@@@AB@B@@@@A@@B@@A@@@@B@@A@@B@@@@A@@@@@A@@@A@@@@@A@@@@@@B@@AB@@@AC@@A@@@@@@AB@AA@C@@@@@A@@@@@@A@@@@@@A@@@@AA@@@@@@@A@@@@@@@A@@@@@@A@@@@@A@@@BA@@AA@B@@A@@@@@@@@@@B@@A@@A@@@@@@@@@@@@@@@A@@@@@@@BA@@@@@@@@A@@@@@@A@@C@@@@A@@@@A@BA@@B@@@@@A@@@B@@AA@@A@@B@@AB@@@B@B@@@BA@@@@@@@@@AB@@@@@B@@@@@BA@@@@@A@@@@B@@A@@@@@A@@@@@@A@BA@@@@BB@@B@@@@@@A@@B@@@@AB@@A@@@@@@B@@@@@@@@A@@@@@@AA@@A@@@@@@@@A@@@@A@@@@AA@@@@@@@@@@A@@@@@@@@A@@@@@C@@@@AA@@@@@@A@@@AA@@@@B@@@@AAB@A@@A@@@A@@@B@@@@@BC@@@@@A@@@@@C@@@@@@A@@@@CB@@AAB@A@BA@@B@@@AA@@@@@@BA@A@A@@@A@A@@@@A@@@@@@@@@@@@@A@BA@@@A@@A@@@@@A@@@A@BA@@@@A@@@@AA@@@A@@@AA@@B@@@CA@@@@@A@@A@@@BCB@@@@A@@@@B@@@@@B@@@@@@@@@BABB@@@@@@@@@A@@@@A@@@@@@@@@@A@@B@@@@A@@A@@@B@@@@A@@@@@@@A@@B@@@@@@@B@@@@@@@@A@B@A@@@@B@@@A@BACABA@A@@@B@@A@@@@A@@@@@@A@@@@AA@@@@@@@B@A@@@@@@@@@@AA@B@A@@A@@@@@@BAA@@@@@@@A@BA@@@@@@@@B@@A@@A@@B@A@@@A@@@@@@@AA@@@@A@@@@@@A@B@A@@A@@BA@@BC@@@@@@@A@@@@A@@@@@B@@A@@@@AA@@@AA@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@A@@A@@@@@@AA@@@@@@@A@BAA@A@@BA@@A@@@@@ABAA@@@@@@A@@@A@@@@@@B@@@@A@@@@@@AAB@@@D@@@BA@B@@@A@A@@AA@@BA@A@@@A@BA@@@A@A@@@A@@A@@A@@@A@@@A@@A@A@@BABA@@A@@@A@@@@@AA@@ACB@@@@@@A@AA@@A@@@ABA@GBA@@D@DADB@AAA@ACACACAEC@A@@@A@ACC@@@A@@@A@@@@B@@@A@@A@AABAA@CA@@BA@@A@@@@@A@@A@@@@@@@AAA@@@@@@@@@@@@@A@A@@AB@@@@@@@A@@@A@@AA@AA@A@ABABAB@BAHGAA@BA@ABCF@@@ABAA@BA@AB@@@@@B@@A@@@@@@@A@@AA@@AA@@@@B@@@AADA@@@A@@@@@@@AB@B@@C@C@@@AB@@@DABA@@BC@@@C@A@@@C@A@C@ABC@@B@@AHBBA@@DA@@BABA@A@@D@@@@AB@@@AA@AAA@ACAAAAAC@@@AA@@@@@A@@@@A@@B@@@@@@@@@A@@@A@BA@@@@@@A@@@@@@@A@@AA@C"],encodeOffsets:[[122282,32620]]}},{type:"Feature",id:"321182",properties:{name:"扬中市",cp:[119.797634,32.23483],childNum:1},geometry:{type:"Polygon",coordinates:["@@FGBAECECAAIICCCGAAAAA@A@A@A@MDE@EBE@C@C@G@IAC@C@ABA@CBEFCBADCDADADCLAD@BCHELAF@B@@@F@BBDBB@BBD@B@D@H@B@J@@EFABQPIJEDGFCBMJIFA@ULCBIDCDMDIDCBGBA@E@QAC@C@C@A@CBEBADA@ABCLAH@D@F@HBFBF@BBLDFDLBDBHBB@D@B@D@@CFCJAF@B@B@B@BBBBBDDB@BBB@DBF@F@D@F@DBF@@@@@@J@B@B@FD@XBB@@@@@@@ABABCD@@EDEBCBKFI@G@I@CAEAG@GAG@G@A@ABEBCBMHKFOHAB@@A@ADCBCDC@CDIHABKJADIHGFABCBCBA@C@EBeRZnHNDHBDBBDDLLFFNJNHDBHDPJB@BBFBB@DBLBF@HAD@JAB@B@FAF@NCB@JAHCD@DAREFELGBAVSFCDAPONO@@JGFCDEFE@ABABCJQBADEBCBE@CDOFQ@ABC@ABGDMDG@CFS@GBI@@BIBEB[BCDSBE@CDMBAFOBCHODG@CBE@CFM@CBEDMBCBKBG@@N]FG@ABABADAFAPW@@HKBABALOFGDE"],encodeOffsets:[[122848,32793]]}},{type:"Feature",id:"321181",properties:{name:"丹阳市",cp:[119.606439,32.010214],childNum:1},geometry:{type:"Polygon",coordinates:["@@FE@@@I@A@G@C@AAC@ACBE@C@A@@@AAA@AA@AAA@A@C@AC@AAABCBCBCFA@@DABAB@B@@@@AD@DB@@B@@CH@@AB@@AAABECCC@@ECA@AABA@@@@SNCBGB@@@@@@@AA@A@@A@@A@@AA@AA@@@C@@@@A@@@A@@@@@AA@@AB@AA@@A@@AB@@A@@A@@A@A@A@A@@@A@@@A@@@A@@B@@A@ABABA@A@A@A@A@A@A@@@A@@@C@A@ABA@A@AAA@A@A@C@C@@@@@C@AAAA@@@A@@@@AA@@AA@@ACAAGM@AAA@C@A@A@@@@@AA@AC@@@@A@@@B@@A@@@CABACA@@AC@@A@CB@@@@AAAA@@@@AB@CE@A@@CB@@@CABACFA@AD@@@D@@BDA@CA@@@AEA@@@A@@@A@@@@@@@@@@AA@@A@AA@@CB@@@@@DA@@@@A@@A@@@A@A@@A@@@@C@@@@BA@C@@@A@@@A@AAAA@@@@@@A@@@B@@@@A@@E@@AC@AF@AEA@@@ACB@@@CA@C@@@@A@@A@@A@@CB@@AA@@@@A@@@@D@AAB@AIC@@A@A@@@@@@AA@@A@DAAGA@AA@@AED@AG@@B@@@B@@AB@@CA@@@@@AAB@@A@@@@BBDA@BB@@@@A@@B@AB@@B@@@B@BC@@@CA@B@@@ACB@AC@@@@BA@@@@B@@A@@@A@@B@@BB@DA@@@@@@@@@@B@AA@@B@@@B@AA@AB@@A@@@@@@@AB@A@@A@@B@@AD@BBBA@@A@@@B@@@D@@C@AB@AA@@C@@@AA@@@@@AC@@@@B@@A@@@@AA@@@@@@@@AA@@@@@@A@@AB@@@A@@AAB@@@@@@@@@@@@A@@@G@GBAAB@AAA@@@A@@@@@CB@@A@@BCCA@@@@@@@@@@@A@@G@@@A@@@@AAB@AA@@BC@@CA@@@A@@@AA@@@A@AA@@A@@A@@@@A@@A@CADA@@@@@AA@@@@@A@A@BA@@@@AAABCC@@AA@@AA@@EDCB@AAA@A@@@A@@@A@A@@@AB@@AB@@A@@@@B@@@@@BA@@@@@AB@@@@A@@@@BA@ABBBC@@@AB@BA@@@@B@@@@@@@@@@ADA@@@AA@B@@@BAA@@AA@@C@@@A@@@@@A@@B@AABAA@@@@A@@A@B@AAA@@A@@@@@ABABABCBCB@@B@@@ABB@AAA@AB@@ABCB@@@B@D@@B@@DA@B@@B@@@@AB@@@@@@@@@@@BB@@@@@@@@@BB@A@@@@@@@B@@BB@@@A@@@BB@@B@@B@@B@B@B@@@@@@B@@BA@@@@B@@@B@@@@@B@B@@@@A@@@@A@@A@@@@@@@A@BB@@@@@B@@BD@@BB@@@B@@@FBB@@ABA@@@@BB@@@@B@@@B@@@AA@@BBBA@B@@@@B@@@B@@@@AB@B@@@@@@@B@@@D@B@@@@B@A@@M[@@AB@@@@A@@@@@A@@A@@AAD@BA@@AAA@AAA@@@@@@A@@@@@@B@@CAB@A@@AAA@@@@@@@A@C@@A@@A@@@@@@@A@@A@@@@@A@@@@@A@@@A@@A@@@@@@A@@@@@@BA@@BA@A@@@AA@@@AA@AA@@@AB@@@@A@@@@@@@A@@@@A@@@@A@@A@@AB@A@@@@@AB@@A@@@A@@BABA@@@@@A@@B@B@@@@A@ABAA@@@A@@@@@@A@@A@@@@@AB@B@@A@@@AA@@AA@@@@@@A@@@@A@@A@@@A@@B@@B@@@@@@B@@@A@@@@A@@@@@A@@@BA@@A@@B@@AA@B@@@AA@A@@BA@ABA@C@C@A@A@AA@@A@@@AB@@@@AAA@@@@A@@B@@@@C@@AA@A@@@A@@@@@AAB@@@A@@@@@C@@@@@@@@@@@@B@@@@@@@@A@@@@@@AAA@@B@@@A@@@@AA@@AA@@@@@@AA@@A@BA@@AA@@DA@ACG@A@@@@A@@@@@@@A@@@@@@@@@A@@@@@A@@@@AA@@@@@@@A@@@@A@@@@@@@@@@A@@@@@BA@@@AB@@A@@@@@CA@A@@@@@@@@@A@A@@@@@@@A@@A@@@@@@@AA@A@@@@@@A@@@@AA@@@@A@@AA@@A@@A@AA@@A@@@@@AAAEAC@@@A@@@A@@BC@@A@@A@@@@@@@@@@B@@A@A@ABAC@ACB@@@@CA@@@@@@@@A@@AA@@@AA@@@@E@@@@@CA@B@@@@@@@@@B@@@@@AAB@@@@@@@A@@@@@@A@@@A@@ACB@@A@@B@DA@@@@@@BA@AB@@A@C@@@B@B@@@@JIA@@AO@@@@@C@@B@@@@@B@@@DCA@@A@CBA@EBA@@B@@A@A@CBAC@@@BA@B@AB@A@@@BA@@@A@@B@@C@@B@AA@@B@@EA@A@@@B@@A@BBA@A@@@B@@BC@@AA@@@BB@@B@@D@@AB@B@B@@@B@@AB@@BB@D@BA@A@A@AB@@A@A@@@AA@B@@@B@@@@A@A@@B@@A@ABBB@@AA@@A@ABAD@@B@@@A@@@@BA@@B@@A@@@@@@@B@@@AB@@@@B@@BB@@@@@BB@B@@A@@@@@@@@B@@A@@B@@@B@@@@@BA@@@@@A@@@AB@@A@@B@@@@A@@B@@A@@@@@CA@@@@C@@@@@CB@@@AA@A@@@A@@B@@B@@BA@@BAA@@@B@@@@B@@BA@@@A@@@@@@@@@A@@@@@@BAA@@A@@@@@@@A@@@@@@@@@@AA@@@@BCA@@DB@@@@@@A@@@A@@@@@@@@@@@@A@A@@CB@A@@A@@@@@@B@@@@A@@@BB@@@@@@@B@@A@@@@@B@@@A@@@A@@@@@AB@@@@BAA@@@AAAB@@@@@AA@B@A@@@A@@@@@A@@@@@@@@@@AA@@@@B@@@BA@@@A@@@@@@BA@@@A@@@@B@@A@@@@@@@@BA@B@A@@@@B@@@@@B@@@@ABB@@@@@@BAA@B@@@@@BAA@B@@A@@@@@@@@BA@AF@@AB@@B@@BA@@@@@@@@@@B@@B@@@CB@B@@@@@B@AB@@@@@@DD@@B@@@@B@EFCD@@@@AA@@@@@@@@A@@@@@A@B@@@@@@AABA@@A@@@@@@@@@@A@B@A@@@@@@A@@@@@@@@A@@@@@@@A@@@B@@@@B@@A@@A@@@B@@@@@@@B@@@@@@@@A@@@@@BB@@AAABA@BBA@@@@@@BA@@A@@@B@@B@@BB@AB@@@A@@@@@B@@A@EBC@@@@@@C@A@@A@A@@A@@AC@@AAGBA@ABIA@@A@AB@@A@@@A@@@A@BB@@@B@B@@@@@B@@AB@@@@@D@@@@@@A@E@@D@@A@@@@@DH@@AB@@@@@@@BAA@@@@@@A@@@@@AB@@@@B@@B@@B@@@@B@@@@@@A@@@@@@@@@A@@@BB@@@@@DB@BB@@BD@B@@A@@B@@@@@@@@@@@BE@C@@BA@@@@@@@@@@BA@@B@@B@BB@@@BAB@@@BA@ABA@@@C@@@@@A@@@@@@@@BA@@A@BAAAB@@@B@@BABBA@@@@BA@BB@@@@ABBB@@@B@@A@@@@@@B@@B@B@@@@@A@@@@BB@@@@@@B@@@@@@A@@B@@A@@BA@@B@@@B@BBA@DA@@BA@@@B@@@G@@A@@A@A@@@@ABB@AB@@@C@@@@@@@@@A@@@A@@A@@ABA@@@BAA@AB@@@@C@@B@@@@A@@@@B@B@A@B@@@@@@ABB@@@ABA@@@AB@@B@@@@@@B@@@B@@@AAB@@@BA@@@@@@@@AA@@@@@A@@@@@@@A@@A@@@@@@@@@@A@@@@@@@BB@@@@A@@BA@A@@@@@@@A@@@@BAA@B@@GA@B@@@@@@@@A@AB@BB@AB@@A@B@A@@@@B@@@@B@@@A@@BB@@@@@@BB@@A@B@@@@@F@@EC@BC@@AA@@AB@@AAAAA@@BEE@@A@@@A@@CA@D@@A@@@C@UA@@A@A@@@@@C@ABA@C@A@AAA@A@C@A@EAE@A@CA@@A@A@C@@@@@AFA@@@CBC@@@@DA@A@@B@@@AA@@@@@A@@@@@@@AAABCBCD@BA@@BEBBBA@@@EBCBBB@@B@A@@B@@AB@AAB@@A@@@@@@@@@@CA@@@A@EHABEAG@@@@B@@@@ABAD@B@@@BE@@B@@A@@BB@AB@@@B@@AB@@@B@@@@@@@@@BC@ABEB@@AB@@A@@@@@@@@@@@A@@B@A@@CA@A@@@@AAA@A@@A@BCCCB@@@A@@AA@@@@@@@A@@CA@@@@@@@A@@A@@CABA@@@E@@@AA@AA@CAGAGCAB@@CB@@ABGA@BA@@@AD@B@D@B@D@@@B@D@@AD@@ABCB@@A@@B@@@D@DA@A@@B@@@@@@@B@@CBBB@@A@@@@@BB@@BB@@@B@@@@@@@BA@@@@@A@@BABB@AB@B@@DEBAB@@ABBGHABA@ABAB@B@BBBB@@BB@@@B@@@@@@@A@@BB@B@@@@@@@@@@@@@B@BB@@@@@@B@@@@B@@@@@BB@@AB@@DBBBA@B@BB@@@A@@@@@@B@@@B@@DD@B@B@@@BFDDBDBDB@BBB@BCACBC@@@AB@HAB@B@@@BB@@B@B@@@@A@BD@@BB@@@@B@@@B@@@ABAB@@@B@BB@@@B@@@B@@@@BB@@@B@B@@@B@@A@B@@@BAB@@BB@@@B@B@@@AAB@@C@@@A@BB@@@@@@@B@@A@@@@@@@@B@@@B@@@@B@AB@B@@@@@BB@@AB@B@ABB@@@@@@@B@@B@@@@B@@@@B@@@@@@@@@@@@@B@@@@@@@@B@@@@@B@@B@@BB@@@@@BA@@@@@B@@@@@@B@@@@@@AD@@AB@@@BB@A@B@@@@@@@@B@@B@@B@@@@@@@B@@@B@AB@@@@BA@@@@@@@@@ABB@@@@@@@B@AB@@@@@@@BB@A@B@@B@@@@@@@@B@A@@@@@@@B@@B@@B@@@@@@@@B@@B@@@@A@@@BABDBABB@@@A@@@@@@B@A@B@@@@@@A@@@@@@@A@@@@B@@@@@@@B@@A@@@B@@@@B@@A@@@@B@@@@@@@@B@@@@@@B@@@@@@@@AAAB@@@@@@@@A@@@@@A@@@@@@B@@A@AD@@B@@@@B@@@@DB@@A@@@BB@@B@@@B@@B@@B@@@@@ABB@@@B@@@@@B@@@@B@@ABB@@@@@@@@@@@@@B@@@@@@B@B@@@B@BAB@@@@@@BB@@A@@@ABB@A@BB@@DA@@@@@B@@@@D@@@@@B@@@@@D@@A@@@@@A@@@B@@@BB@A@BB@@@@@A@@B@@B@@@B@@@@B@@B@@D@@@@@B@@@@@@@@@@B@@@@@@@@B@@B@@B@@@@@@B@@@@@@B@@@BB@@@@@@@B@@@@@@A@@@@@@@@BA@@B@@A@@@@B@@@@A@@@AA@@@@ABB@@@@@@@@B@@@@@BA@@@@@@B@@@@AB@@@@A@@@@@A@@B@@@@@@@@AB@@A@A@@@A@@BA@@@@BB@@BA@@@B@@@@@A@@@ABB@@@@@@B@@D@@@@B@@@@B@@@@@@@@@AB@@@@@@B@@@@@@@@@@@@@@@B@@@@BA@@@@@@@@@@@@BA@B@@BB@@A@@@B@@@@@B@@@@B@@@@@@@B@@@@@@@B@@B@@B@@@@@@@@B@@@@B@@@@@D@B@@BBA@@@@@@@BD@@B@@BA@@@A@@@@B@@@@@B@@@B@@@@@B@@@@@@AB@@@@A@@B@@@@AB@@@@@@ABA@@@@@@@@@@BB@@@DB@@@BB@@@@@@B@@@@@@@@@@B@@@B@@B@@@@@@@@@@@B@AB@BA@@B@@@@@B@@@@@@@@B@@BA@@@@@@@@@@BB@@A@B@@B@@@A@@@B@@@@B@@BB@@@@@A@@@B@@@@@@@@@BB@@@@BB@BB@@@B@@@@@B@@BA@@@@@@@@@@@BB@@@@@@@@@B@@@B@@@@B@@@A@BBA@@@@@B@@@B@@@@@BB@B@@@@A@B@@B@@@@B@@@@AB@@@@@@B@@@@@@D@@@@@@@@A@@@@@@@BB@@@@@@@@B@AB@@@@@B@@@@@@@@@@@@@@AA@@@@@@@B@B@@@B@@@@@B@@@@@BB@AB@@B@@@A@@@@@@B@@@@@@@@@@@BB@@@@@@@@@B@@@@@@@@B@@@@B@@A@B@@@@@@B@@@@@@B@@@@@@@@@@@@BA@@@B@@@@@@BBA@@@@@BBB@@@@@@@@@@@@@@A@@@@B@@@@@B@@@B@@@@@B@@@@B@B@@@@@@@@@@@@@@DBD@@@A@@BB@D@DB@@@@@@@@@B@@@@B@@@@B@@B@@A@B@@@@BB@A@@@BB@B@@BB@B@@A@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@@@BA@@B@B@@@@@B@@A@@@@B@BABB@@@@AABAB@@@@ADA@A@@@@B@BA@@BBB@@@B@BBB@DC@@@@@A@@@@@A@@@@@ABA@@@@B@@A@@D@BB@@@BB@B@@@@@@@B@B@@@@@B@@B@@B@BB@@BADB@A@@@@@@@AB@@@@@AA@@@AD@@A@@@A@@B@@AD@@@B@@@D@@B@@BB@@B@@@@@B@BA@@B@@A@@B@BBDAB@B@D@D@B@BBFD@@B@BA@@@AB@@BBBBDBDBBDD@BBD@BBBA@B@B@@@@@B@@B@@@@@AB@@@@@B@@D@@B@@@@@@@B@@@@@@@@A@@B@BAB@@@@@@@BA@B@@@AB@@@@@B@AC@@B@@A@@@@@@@@B@@@@@@@@@@@@@@@B@@@@A@@@@BA@@BBB@@@BB@B@@@@B@@@@@@A@@@@B@B@@@A@@A@@@@@@@@B@B@@@B@@AB@@B@@@@BAB@@@A@B@@@B@F@@@@@@@B@@@@A@@@@AA@@B@AA@@@@@@@@@@@AA@@@@@@@@@@A@@@A@@@@@A@@B@AAB@@@@@@A@@@@@@@A@@B@@A@@A@@@@@@@A@@@@@@A@@B@@ABBD@@B@@@@@@@B@@@@B@@@@@@BB@A@@@@@@B@@BB@@B@@@B@@@@@@@B@@A@@D@@AB@B@@@@@@@@AB@@@@@@@B@@A@@B@@B@@B@@AB@@@A@@B@@@@@@B@@@@@@@@@@B@DB@@@@@@@@@ABB@@@@B@@B@@B@@@@B@@A@B@@@@@@ABB@@@@B@@B@@@BDB@@B@@@B@B@@@@@@@B@@@BA@B@@B@@BB@@BC@@@@BB@BBB@BB@HBB@B@@@@B@@@BB@BA@@D@@@@B@@@@@@@@@@@@@B@@@@@@BB@@@@B@CB@@A@BB@B@@@B@BB@@B@B@@@D@DB@@@@C@@@@@A@@@@@AB@B@A@BB@B@@B@@@@@B@AB@@@B@@BA@@@B@@B@@@@BA@@@@@@@@@A@@@@@@@@B@@A@@@@B@B@@AB@B@@B@B@@ABB@@@@A@@@@@DD@@@AB@@BB@@@DA@@@@@@@B@@DC@@B@@@@@B@@@DABAB@DA@A@@@@@@@A@@@@@A@@@AAAAA@@@ABA@@@@@@B@@B@@@B@@@@FC@BB@@@@BB@B@@A@@@@@@@A@@@@@@A@@@@@AAB@@@@@ACB@@A@@B@@A@@B@@@B@@ABD@BB@@@AB@@@@AA@@A@@BB@@@B@@B@@@@CA@BB@B@@@@@C@@@@@B@@B@@@@B@@B@@@@@@A@@BB@@@@@A@@@@@@B@@BA@@@@@@B@@@@B@@A@@@@@@B@@BA@@@@BB@@@AB@B@@@@@@B@@C@@B@@A@@@@@BB@@@A@@B@@@@@@@@B@@B@@@@@B@@@A@@@B@@B@@A@@@DB@@@B@@@@@@@@B@@@@@B@@@BABB@@@@@@@@BA@AAA@@@@DABF@@B@BA@@BB@@@@B@@B@@@@@@@B@@A@@@@A@@@B@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@AB@@@BB@@A@@BB@@@@@@B@@@AA@@@@@@@@AAB@@AB@@B@B@@@@@A@@@@B@@B@H@B@@@@@BD@@@@B@@B@@B@@@@@@@@@@@BA@A@@@@AA@@BA@B@@B@@@@@B@@@BA@@@@@@@@@A@@@BB@@A@@B@@@@@@@@@B@@@@@@@B@@@@A@@BA@@@@@@@ABB@@@B@@BA@@@B@@@@@B@@B@@B@@@B@@@@B@BB@@@BBA@B@B@@B@@@@@@@@@B@@@A@@@BB@@A@@@@@B@@@@@@A@@@B@@BB@@@@BB@@BB@@B@@@@B@@BB@@@B@FA@@B@BB@@DC@@@@@B@@@B@BB@@@@@@AB@@@B@B@@@B@@@BE@BBA@@@@@B@@B@@ABA@B@A@@@A@@@@@AB@@@A@@@@A@@B@@A@@@@B@@@@@B@@@@A@@@@@@@A@@B@@@@@@AB@@@@@@AB@A@@A@@@A@@@C@AA@@A@A@@@@A@@AB@@@@@@ABAP@@BB@@B@@@B@@@@@B@@@@@@@BB@@@@@AB@@F@V@B@HAJA@@BB@@B@@@@@@A@A@@B@ADB@@@@C@@B@AB@@BB@A@@@@@@BAB@@@@@B@@@A@@BB@@B@@B@@@@@@A@A@@BAD@HCB@D@BBD@BBBA@B@@@@B@BBB@@@@@@@@AB@@AA@@A@@D@BAD@B@J@HBB@BCFADAB@D@D@D@RBF@B@HADAJCNCDCJCDAVKB@JENIDAHEFCJIROBA"],encodeOffsets:[[122774,32835]]}},{type:"Feature",id:"321183",properties:{name:"句容市",cp:[119.168695,31.944998],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@@CBA@@B@@@@@@A@@@CA@AB@B@CAB@ABA@@@@@@@@@@A@@@A@@CA@@@@B@@A@@@@@BD@@@@B@@B@B@@AB@@@@@@@@A@EBCAA@A@AA@@@BAB@@CC@@AAA@@A@@A@CAA@AAC@@@A@@AABA@@A@@@@A@@@A@@@A@@@@BA@@@A@@@@AA@@@@@@AABA@@@@B@@B@@B@@@@@@B@@@@@B@@B@@@B@@@@BB@@@B@@@@A@@@@@A@@@AA@A@@A@@@@@AA@@@@A@@AA@@@@@@@A@@@A@@@@@@@@B@@@@@@A@@@@@@@@@AD@@AA@@AA@@A@CA@@@@@@@@@@A@A@@B@@@@A@@@A@@@@@A@@@@@@@@@@B@@B@@@@@@@A@@@A@@@A@@@@B@@B@@@@@@BB@@@@B@@@@@@@@@@A@@@@@A@@@E@BD@@@@@B@@@@@B@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@B@@@@AB@A@B@@A@@@@B@@@@@@@B@@@@@B@@@@B@A@B@@@@A@@@B@@@@@@@BA@@B@@@@@D@@AB@AA@@@AA@@@@@@@AAB@AA@@AA@@@A@@@@@@B@@@B@@@B@@@B@@A@@@@@ABA@AA@B@A@BA@@@@@@@@@@@@@A@@@A@@@@@@@@@@@A@@@@@A@@@@@@@A@@@AA@@@@@B@@A@@AA@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@BAA@@@@@@@@@BA@@@@@@@@B@@@A@@@@B@@BB@@@@B@@@BBB@D@BBA@@@@BA@@@@@B@@B@@@B@@@@@@@@@@B@@@@@@@B@@B@@B@@@@B@@A@@B@A@@A@@@@@@@@@@@AB@@@@@@@B@@@@B@@@@@@@@A@@B@@B@@@A@@@@B@@@@@@@B@@@@BB@@@@@B@@A@B@AB@@@@B@@B@@@@@@@@A@@@@@@@@@@@@@@B@@A@@A@AA@BBA@@B@@@BBA@BB@@@@@@B@@A@@@@@A@@@@@A@@@@B@@@@A@@B@@@@@@A@@@@A@@AB@@@@@@@B@@@@@B@@@@@@ABBBB@@@@BA@@B@@@@@B@@@@@B@@@@BBA@BD@B@@BB@@@B@@@@@@@@@BB@@@@@@@B@@@B@@@@@B@@@@@@@B@@@@B@BA@@@@@@@A@@B@@A@@@@B@@A@@B@@BB@@@B@@A@@@@@@BA@@@ABAB@@@BAB@B@B@ACAC@A@ABA@@BA@@BA@@@@@A@AB@@@@A@A@BB@@A@BB@@@@A@@@@@@@A@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@A@@@A@@@@@@@@@@@@AA@@@@@@B@@@@@@@@@BA@A@@@@@@@ABA@@@@@@BA@@@@A@@@@A@@@@@@A@@@@A@@@@@@@@@@@A@@@@@AA@@@@A@@@@@A@@@BBA@@@@BA@B@@@@B@@@@@@AB@A@B@@@AA@@@@B@@@@@@@@A@@@@@@@@@@BA@@@@@@B@@@@@@@@B@@@@@B@@BB@@@@@@@@@AB@AA@@@@@@@AB@@B@A@@@BBA@@A@@@BA@@@@A@@A@@AAB@BA@A@@@@@@@@@@BA@@@ABAD@@@@@B@@B@@@AB@@AA@@A@@@@@@B@@@B@@BB@@A@@@BB@@A@@@BB@@@@@@@@@@ABB@@@A@@@@@@BA@@@@@@@AB@@@@@@A@@@@B@@@@@BA@@@@@@@@@@@@@@@A@@B@B@@A@@A@@@B@@@@A@@@@@@@@@@@A@@@A@@@@@@@@@A@@@@AAB@@@@A@@A@@A@@@@@AB@@A@A@@@@@A@@B@@AB@A@@@@@@@@@B@@@@@@@B@@@@@B@B@@@@@@@B@@@@@@@@AB@@B@@@@@@A@@@@@B@@@@@@@B@@@@@@@B@@@BA@B@A@@@@@@BB@@A@@@@@@@B@B@@@AA@@@@@@@A@@@@@@@@@A@A@BB@@@@@@@B@@A@@@@@@@@@@B@@A@@@@@B@@B@@@@@@@B@A@@@@@@@@@@B@@@@B@@AB@@@@@@AB@@@@A@@@@@A@@@@@@@@@@A@@A@@@@@@@@B@@@A@@@@@@A@@@@@A@@@@@A@@BA@@@@@@@B@@@AB@@@AAB@@@@@@@@ABA@@@@@@B@@@AA@@B@@@@ABB@@B@@A@@@@@@@@@A@AB@@@@@@@@@@BB@B@@@@@B@@B@AB@@@@@@@@@@@@@@A@@@@B@@BB@@B@ABB@A@@B@A@@A@@@A@@@@@A@@@@@@@@@@A@@@@A@B@@@AA@B@@@@@@A@@@@@@@@@@@A@@@@@@BB@A@@B@BAAA@@@@@A@A@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@B@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@A@@@@@@@@@@@A@@@@@@B@@B@@@@@@B@@@@@@@B@@@@A@B@@B@@@@@@@@B@AB@@@@@B@@@@@@@@@DA@@AA@@@@@@@@BA@A@@B@@AB@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@A@@@@@@@@BA@@@@B@@@@AA@@@@@AA@CB@A@@C@@BAA@@@A@@A@@@@@@@@@@@@@@@@AA@@@@B@@@@@@AA@@@AAB@@@@@@@@@BA@@@AA@@@A@@@@@@A@A@@@@@@@B@@@@@@B@@@@@@@BB@A@B@@@@@@@@@@@@@@B@@B@@@A@@@@B@@@@B@@@@B@@@@B@@@@@@B@@@@@@@@@@B@@@@@@@A@@@AB@@B@@@@@B@@@B@@@@@@B@@@@@B@@@@A@@@@@A@@@B@@@@@@B@@@@@BA@@@@@@@@B@@@@BB@@@A@@@@B@@B@@A@@@@@@@@@B@@@@B@@B@@@@B@@A@@A@BAA@@@B@@@@@@@B@A@@@@AB@@@@A@@B@@@@A@@@@@@@@B@@@@A@@@@@@AA@@@@@@@B@AA@@@@@@@@@B@A@@@@@@@@A@@@@@@@@@@@@A@@A@@@@@A@@@@@A@@@@@@@A@@@AA@@@@@@@BAA@@@@@B@@@@@@@@AB@@A@@@@A@@@@A@@@@@@B@@@A@@@BA@BA@@AB@@@@@@@@@@B@@@AB@@@@@@@@@@@@A@@@A@@A@BB@@@@@@@@@@@A@A@@@@@@@@B@@@@@@B@@@A@A@@@@@@@@@@@A@@B@@@@@@@@@@@@@@B@@@@@@BA@B@@B@@@BA@@@@@@@@@@@@@@@A@A@@@@@A@BB@@@A@BA@@@@@@@@@@@A@@@@@@@@@@@@@A@@@A@@@@@@@@@@@@@@A@@BAA@@@@@AB@@@@@A@@@@@@AB@A@BA@@@A@A@@@@@@@A@@@BAA@B@A@A@@@A@@B@@AA@@@@@@@A@@@@A@@@@@@@@@@@A@@AAB@@A@A@@@@@@A@@@@@@@@A@@@@@@@@@A@@@A@@@@@@@@A@@@@@@@@@AA@@@@@@@@BA@@@@@@@@A@@C@@@@A@@@BA@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@AAB@A@@@@@@@@@@@@@A@@@A@@@@@@@A@@A@@A@@@@@A@BDB@A@AEA@@@@@A@@A@@@@A@@@@@@@AA@@@@A@@@@@@@A@@@@@@A@@B@@@@@@@@@@B@A@BB@AA@@@AB@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@B@@@@@@@A@@@@A@@@@@@@@@@@@B@@@A@@@@@@A@@@@@@A@@B@@@@A@@@@@@AA@A@B@@@@AB@@@@@@@@@@@@@B@@@@A@@@@@@@@@AB@@@@@@@@@@@A@@A@@@@@@A@@@@@@@B@@@@@BB@@@A@@B@@@@@@@@@@@AA@@@@@@B@@@@A@B@@@A@B@A@@@@@@@@@@@@@@@@@@AA@@@@@@@@@A@B@A@@@@@@A@@A@@@@@@A@@@ACEA@@@AA@@@A@@@@@@@@AEA@@ACBAB@@AB@@@A@@@A@@A@@@@@B@@@@@@A@@A@@@BA@@B@AA@@@@@@@BA@@@@BA@@@A@AA@@@@@@A@B@C@@A@@@@A@@@@@@AB@@@@@A@EA@BA@@@@@@BA@@@A@@@@A@@@@A@@@@@@@@@@@@@A@A@B@@@@BA@@@@@@@@B@@A@@@B@@BA@@@@@@@@B@@BB@@DB@@@B@@@B@@AB@@BB@@@B@@@@A@@BAA@@@@@@@@@B@@@@@@@@@@@@@B@@@@B@@A@@@B@@@@@@AB@@@@@@@@@@@B@@@@@@@@@@A@@B@@@@@@@@A@@B@@@@@@@B@@B@@@@@@B@@@B@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@D@@@@@@@B@@@A@@@A@@@@@@BB@@ABBAA@@B@@@@@@@@@@@@@@B@@@A@B@@B@@@@@@B@@@@@A@@B@@@@@@@@@@@B@@AB@@@@@@@@@@@@@B@@B@@@A@@@@@@@@@@B@A@B@B@@@@@@@@A@@@BBAB@@@@AA@@@@@A@@@@A@@@@@@@@@@@@BAA@B@@@@@@@@@@@BABB@@@@@@@A@@@@@@@@@@B@@@@@@B@@@@@B@@@@@@BA@@@@@@@@@B@@@@@B@A@BBA@DA@@@@@@@@@B@@B@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@B@@B@A@B@@B@@@B@@@@@@@@@B@@@BB@@@@BB@@BB@@@A@@@@@BD@@@@B@A@@@@B@@@B@@@@B@AABA@@@A@@BA@BH@@@B@D@@@B@B@@@BA@A@ABABA@A@A@A@@@@@ABADCBCF@B@BAD@B@@@@A@A@ADABAB@BA@ABA@ABA@ABA@AB@BA@C@CBA@CB@@@BA@@B@@B@BB@BBBBB@@@BC@@@@@A@BB@@B@@@B@@BA@A@@@@@AB@@@A@@@A@AA@@@@BB@AB@@@@@@@@AB@@@@A@@@@@AB@@B@@@@@@B@@@@@@@@@@@@BA@@@A@@@@@@B@@@A@@B@BB@@@@@@@@@@@@@@B@@B@@@@@@A@@@@D@@@@@@@B@@@@@B@B@@A@B@@@AB@@DB@@@@B@@@@@@@BB@@@@B@@B@@BA@@@@D@@B@@@B@B@@@@@B@@@D@@@@BB@@@B@@A@@BB@@@@@AB@B@@B@@@@B@@@@@@@@@@@@@@@BA@@@@@@@@@ADB@B@@@@@@B@@BA@@@B@@@@@B@@@@@BC@@@BDBB@@@B@@@@@BB@@B@@@@@@A@@B@@A@@@@AAA@@@@@@@@@AA@@CA@@@@@@A@@@@@AA@@B@@@@@@@@@BBB@@AB@@@BB@@@@BB@@@@BA@@B@B@@A@@@@B@@@DB@DA@@B@@@@B@@BCA@@@@BB@@@B@BBB@AA@@AB@BABBABB@@CJD@@@AFBBA@@BAD@BB@@@@@BF@BFBABB@@@BB@@@@@@@@@BBB@@@BA@@BDJADB@@@@@@BB@B@@@@D@@AA@@@F@@@CB@@@@@B@@D@@DB@@D@@@@B@@@@AB@@BB@B@@@@B@@DB@@BABA@@@@@@D@@@B@@BA@@@@BB@@@BB@@@@BA@@@@BA@@@@@@B@B@BBBB@@@@@@A@@@@@BB@BA@@@@A@@@@@@@BA@@@@@BBB@@BB@@@BB@B@@DB@BBAB@DG@@BA@@@@BBAB@@@@B@ABBB@@@@@@B@@@BB@@AB@@@B@@B@@B@@@A@@@A@@@@@@B@@B@@@@@@@AB@B@@@A@@ABA@B@@@@@A@@@AAB@AAB@AAB@@BA@A@@ABAA@B@@A@@@@@@A@@@@@@DA@@C@@@@AFALARCB@BA@BB@BBBB@BB@FD@@BB@B@B@BDF@B@D@B@D@@BBBB@@BDBDB@@B@@BB@@B@@@@@@@B@B@B@D@@@B@B@@@@@@BB@@@@@@@@B@@@B@@@BBB@@@@@B@@@@@@AB@@@@@@BB@@@@@@@@B@@B@@@@@@@B@@A@@B@@@BA@@BA@@@@@A@CB@@@@@BA@@@@@@@BB@BBBBBA@@@@D@@@@@@@@A@@@@@@@@@AB@@@@@BB@@@@@@B@@@@A@@@A@@B@@@@@@@@@@A@@@@@@@A@@@A@@@@B@@@@@@@B@@@@@@@@@@@@BBA@@@B@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@BB@@A@@@B@ABB@A@@B@@@@@@@@@@@@AD@@@@@@@B@@@@A@@BB@A@@BB@ABB@A@BB@@@BC@@B@B@@@@A@@B@@@@@@@@@@@@B@@B@@A@A@@@@BB@AB@BCA@@@B@@@@@@@@@B@@@@AB@AA@@A@@@@@@A@@@@@AAA@@@@@A@B@A@@@@@@@@@@B@@@@A@A@@B@@@@AA@@@@A@@@@@@@@@@@A@@@@@@@@@@@AA@@B@@@A@@@@@@@A@A@ABEDC@GBA@@@A@KDEBA@@@A@C@CAI@EB@@@AA@@@@@@A@@@@@@@@@@@A@B@@@AA@@@@@@@@@A@@@@@AA@@@B@@@@A@@@@@@@@@@@A@@B@@@@@@@@A@@@@@@A@@@@A@@@C@@BAABBA@@@C@@@@@@@@@@@@@A@@@A@@B@A@B@@@@@@@@@@A@@@@@@@@@ABB@@@@@A@B@@@@@@@@B@@@@@@B@B@@@@@@@CB@@@@@@A@@@@@@@A@B@A@@@@@@@@BA@B@A@@@ABB@@@AB@@@@@@A@@@@@@@@@AB@A@B@@A@@@@@@@@@A@@@@@@B@@A@@@B@@@BA@@@@@@@@@@BBA@@@ABB@@@A@@@@@@@@@@@@@A@@@@AAB@@BD@@@B@@@@@B@@@@A@@BB@@@@BAB@@@@A@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@A@@@BB@@B@@@@@B@@@@@@@B@@@@@@A@@B@@@@@B@@@@@B@@@@@@BA@A@BBADB@@@@B@@B@@@@@@@@@@B@@@@@@@@@B@@@@@@B@@@@A@@@B@@@A@@B@@B@@B@@@@@@@B@@@A@@BB@@A@@B@@BBA@@A@@@@@@@@@AA@@@@BA@@@B@@@BB@@@@A@@@@B@@@@A@@@@@@@BB@@@@B@@@@@@@@@@@BAA@B@@@@AB@@B@@@@@BAB@@@@@B@@B@@@B@@@@@B@@B@@@@B@@@@@@@@@@B@@B@@BB@@@@@A@@@@BAB@@@@@@B@@@@@@BBBA@B@@BBBA@@B@@@@@@@BB@AB@@B@@@@B@@@@BB@B@@BB@@@B@@@@AA@@@B@A@B@@@@@B@@@@B@@B@@A@@@@@@@@@@B@@@@@@B@@@@B@BBB@@@@@A@@@BBB@@@@BB@@@@@@A@@@@A@B@@@B@B@@@@@BB@@@@B@@B@A@@@@@A@A@@@@@@@@A@@@A@@@@A@@BB@@@A@@B@@@@@AA@@@@A@BAA@@@@@@ABB@@@@@@B@AA@@@@B@@@@AB@A@B@@@@B@A@@@AB@@@B@B@@@@@@@@B@@@@@@@@B@@B@@@@@@@@@A@@@AB@@@B@@@A@@B@@@@BB@@@@@B@@B@@@@B@@B@@B@@B@@B@@BB@A@@@BD@@A@@@BBA@@B@@B@@B@@@@@@@B@@@@@@A@@@@B@@@@@@@B@@@@@A@@B@@BA@B@@@@@@@A@@B@B@@@@B@@@@@@@@@@B@@@B@A@BA@@@@@BB@@AB@@@@@@@@@@@@@@@BA@B@@@A@@@@@A@@BAB@@@@@BA@@A@B@@A@@BA@@@@B@@@@@B@@A@@@@@B@@@@@@B@@@@@B@@A@@@@B@@@@@B@@AA@@@B@@@@@@@@@@B@@@@B@@@@@@B@@@@B@@BB@@B@@D@BB@@@@@BB@@@@@@@@BA@@@@@BB@@A@@@A@@A@B@@@@A@@BB@@B@@@@@@AB@@@@@@@@@@@B@@B@@@@@AB@@@@@@@@@@@B@@B@D@@@@B@@@@@A@@@@B@@@@@@@D@B@BAB@@@@@@@A@@BBA@@B@@@B@@@@@@BA@@@@@@@@BB@@BA@@@@@@BB@@@@B@@@@@@@@@BA@@@A@ABB@A@@B@@@@@@ABC@@@@B@@@@@@@@@@A@@@@B@@@@B@@@@@@@@@@@@@@@@B@@A@@@@@@@B@@B@@@@@@@@@@@B@A@B@@A@@@@@@@@@BB@@@@AB@A@B@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@A@@@@@@@@@A@@@B@A@@@@@@@A@BB@@A@@@@@@@@@@@A@@B@@@BA@@@@B@@@@@B@B@@A@@@@@@BAAB@A@@@@@@B@@@@A@@BA@@BBBA@@@@@A@@@@@A@@B@@@A@A@A@@AA@@@@@@A@@A@@@@A@A@@@A@@@BA@@AA@@@@@@A@@@@@@@@@@@A@@@AB@A@@@@A@@@@@@@A@@@@@A@@@@@@@@@@@A@@@@B@@@@@@@@A@@@@D@@@@@@@@@B@@@@@@B@@@@@@B@@@@@B@@B@@@@@@B@@@@@@@B@@B@@B@@@BA@@@@@@@@B@@@@@@@B@@@AB@@@@@B@@@@@@@@@@BABB@@@@B@@@AABB@@@@@A@@@@BBA@@B@@@@B@@@@@B@@@@B@A@BD@@@AB@@B@B@@@@@B@@A@@@@@@@@B@@@@@BA@@@@@@@A@@@A@@@@@BF@@B@@@@@B@@B@@AB@@@@BB@@@@EB@@@@A@BBA@BB@@A@BB@B@@@@@B@@@B@B@@BA@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@BB@@@A@@@@B@@@@@@@B@@@@@@@@@@@@B@@@A@BA@B@@@@@B@@@@@@A@@@@@@@@@@BAA@@@B@@@@@@A@@BA@@@@@@@@B@BA@BBABB@@@A@@@@@@@A@@@@@@@@@@@@B@B@@@@@@@@@@@AA@BB@@@@A@@@@@@@@B@@@A@@A@@@@@AB@@@@@@@@@@AB@@@@@B@@A@@@@@@B@@@BBB@@CBAB@@B@B@B@@@BD@@A@@@@@AB@@B@DAB@@B@@@@@@BB@@B@BB@@@@A@@BB@@@B@@A@@@@@A@@@BB@@@@B@@@@@@@@@B@@B@@@@@@@@@B@@@@BB@@@@@B@@@@B@D@@A@@@@@@@B@@@B@@@@@@@B@@@@@@B@B@B@@@@@@A@@@@AAA@@@@@@@@AB@@@DB@BD@B@D@@B@@DB@@@@B@@@@A@@@@@A@BB@@@@AB@@@@AD@@BD@@BB@@AB@@BBBB@@@B@@@@A@@BA@@@@B@@BB@BB@@B@@A@C@A@AB@@@@@B@@AB@@@BA@@AA@@@BA@@@@A@@@A@@BA@A@@@A@A@@@AB@BABB@@@@ABA@@@@@B@B@@B@@B@@@@C@@@CB@A@@A@AAA@A@@B@@@@@@AB@@BB@@AB@@A@@@@@@@BB@@@B@B@@@B@@@B@@@B@@@@C@C@@AA@A@A@@B@@AB@BA@A@@@AC@@A@@BA@@@@AA@@@AA@@A@CAAAA@@@AA@@AAAB@@AB@@@A@@@@@@@@@@@@A@@@A@A@@BA@@@A@@@ADAFAB@@@@ABCABA@AB@@@A@@@AB@@@@@A@@@@@@AB@@@A@@@@@B@@A@BB@@A@AA@@AA@@@B@@BB@@A@BBB@@@BB@@A@E@A@@@@@A@@BAB@@ABEA@@A@@@A@@@@@A@@B@@@@A@@@@@@@@@A@@@@B@@A@@@@@A@@@A@@@@@@@A@@@@@A@@@@@A@@@@A@@A@@@A@@@@@A@@@@B@AA@@@A@@@@@@@A@@A@@A@@B@@@@@@A@@@CBBAA@@@@@A@@BC@@@C@@J@B@B@@@B@@@B@@B@@@@@BB@@@BB@@@B@ABB@@ABBA@B@@BB@@@@@BB@@@@B@@BB@@@@C@@B@B@@@@@BB@@@@BB@@@@@@B@@B@BA@@DF@@@@@@@@B@BAB@@@@@BBBB@@B@@B@@B@@B@@@B@@B@B@@B@@@B@@@@@@B@@@@BBB@@B@@BAB@@@@@B@@BBB@@@B@@@B@@@B@B@@BA@@D@@@@@@@AB@@@@@BAB@@B@@D@@@@@DB@BABBB@@@BAHB@DD@DAD@@@@B@@@@D@@@@A@@@@B@AC@@B@B@@BB@BDCB@@C@@@@BA@@@AA@@C@A@@@AB@@A@@B@A@B@@@B@@@B@@@@B@BA@B@ABAB@@@B@F@BBB@B@F@@@@AB@FB@@@@BABA@@@@B@BB@@B@D@BA@@@@B@@AB@B@B@B@B@@@BB@@@B@B@@@B@@AB@@@@@@BB@@B@BB@@B@A@KFBB@D@@D@@@@@@@B@@DC@@B@B@@@@AB@AA@BB@@@B@@B@@BD@FAB@F@NDB@DBDBRDJBFBB@B@B@B@BB@@BBBBB@@@@BD@@@@BB@BBB@B@@@F@DDB@B@BAD@DAFADABAB@HC@CB@@A@@DA@AB@@AD@D@@BBD@DB@D@D@B@D@DBD@FBB@D@HBDB@@BAB@DCDABAB@F@B@HBB@LFB@D@@@LBB@BB@BBBBD@FBB@B@@BBDBBBBB`THFXIBAFAHEDCBABABADAFCDCCCCGA@AAAAA@@@CCAA@@@AAC@A@@A@G@A@@@A@@A@A@@@@CAC@A@BIBA@@@@@C@@@@A@@B@@@AA@@@@@@@A@A@A@@AA@A@AB@A@@BA@@@@AAA@@@BAB@@@B@@A@@AA@@@@B@@@@A@@CBABA@@@@A@@@AB@D@B@@AB@A@@A@BA@@ADAAA@@@CA@A@@@A@@@B@@AB@@@@A@@A@@@@A@@A@@@B@B@@@@@@AA@@@@@@AA@@B@@@@@@@@A@@@A@@@@AB@@A@@B@@@B@@@@A@@@@@@A@@@B@@@B@@@@@@@B@@@@B@@D@@@@@@A@@@B@@@A@@@AB@@@A@@@A@@@@@@@@@@AA@@BA@@A@A@AA@BAA@@@@@DA@@@@A@@@BA@@A@@A@@A@AA@ADC@@@@AB@@AA@@@AB@@AB@@A@@BA@ABA@@@A@@@A@@@@@C@@BADA@@BB@@B@B@@@BA@@B@@A@@B@@@BBB@@@@BAB@B@@@@@BA@@@DBF@@AB@@A@@@A@ABA@@B@@A@@BABC@ABADCB@DA@AB@BA@@@@@AAA@@@@@A@CBA@A@AB@B@@@BAB@@BB@@@BC@@@@@AAAAAB@B@@@@@B@@A@@B@D@BA@@BAB@B@D@DADBB@@@@A@A@@@@B@B@@@BA@@@E@@@A@AF@HD@ABCBA@A@@B@@@D@BBBBB@B@DABAD@@@B@@@@A@@BAB@@A@AB@@A@@B@@@BBB@BA@C@@B@@@B@@A@@D@@@@AB@@A@@@A@@@@AA@@C@A@@AB@@AA@A@@@@@@A@@@AAAB@@@@BDB@@B@BB@@@@BAB@B@@A@@B@@A@@AA@@@B@AA@AC@@@AA@@@@@@@@A@@B@B@@@@AB@@@@D@@A@@B@@BBBBB@@@B@B@@A@AAA@@@BA@@@@@@A@@B@@AC@@@@@A@@AB@B@@@B@@@@@@A@@@A@@@@BB@@BBB@B@B@B@@@BA@AB@@@B@@@B@B@@@ABB@@D@@@@B@@@@@B@@@BACC@@@A@@BA@AB@D@@A@@A@BA@@@@@@@A@@A@@@BA@@B@AA@@B@@@DA@AB@@@@@@B@@ABA@@@B@@@@BA@@@@@B@@@@B@@B@B@@@BAB@@@@@AAB@@@@@@@@@@@CC@@B@@@@@DB@@@@@BB@@@B@B@@@@@@ABA@@@@@A@@@@BA@@B@@B@@@@@BBAB@B@@@DAA@@@A@@AB@@@@@BAB@@A@@B@@A@AB@@@A@CA@C@@@@@A@C@@AABA@@@@B@@A@A@@@@CE@@@CA@BA@@@@@@@A@A@@@@@@@@@A@@@@A@@@@A@@@@A@@A@@@B@@@AA@@@@@A@@@BAA@@@@@@@@@@@A@@@@AB@@AA@@@@@@@BAA@C@@@B@@AA@@@@@@AB@@@AA@@@@B@@A@@B@@@@@@@@@@B@@@CB@@A@@@@B@@@@@@BB@@A@@@A@@B@@@@@B@@@@@@@B@@@@@AA@@B@@@B@@@B@@@B@@@@@@AB@@@@@BA@@@@@@B@B@@A@AA@@@@@AA@@@@@@@AB@@A@@ABAA@@@@A@@@@A@@@A@@@@@A@AA@@@@@A@@@@@A@@AA@@A@@@@A@B@@@@AAB@A@@@@@AA@@@A@@@@@A@@A@@BAA@A@@@@@@@@@@A@@@@@@@@@A@@@@AA@@@@B@B@@@@@B@@A@@@A@@B@@@AAB@@@@@@AB@@A@@B@@@@A@@@@@@@@A@A@@@BAB@@@B@@@B@B@@AA@BA@@@@@@@A@AB@@@B@@A@@@@@@@@BA@@BB@@BA@@D@@@@@B@B@@@@@@@B@@@@@@A@@@@@@@@@AB@@CB@@@@AA@@A@@@AA@@A@A@@@@@@B@@@@C@@@@@@@BA@@@@@@A@@@A@@A@@@@AB@BAB@@A@@@@AA@@@@@AA@@@B@@A@@A@A@@A@@@@@@@AA@@@A@A@@@@B@@A@@@@AB@@@@@A@@@@@A@A@@@@A@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@BA@@A@@@@@@ABB@@B@@@@@@@@@@AAAAA@@@@@@@BA@@B@@@@@@@@AB@@A@@A@@@@@B@@B@@@@BC@@@@A@@@@@@@@A@@@@@@AB@AAAB@@AAA@BB@@@@@@B@@B@@@@@D@@A@AC@@@BAA@BAA@B@@A@@@AB@@A@@@BA@@A@@@@@@@A@@@@@A@@@@@AA@@@@@@@@A@@@@@@@A@@@@@AA@@@@@@@AA@@@@@@A@@A@@A@@A@@@@@A@@@@@@@@AA@@@@@@@@@@@A@@A@@@A@@@@@A@@@@A@@@@@@A@@@@@A@@@@A@@A@@@B@@@AAB@@@AAA@@@A@@A@@@@@A@B@@@@@BB@@A@@@@@@@A@@A@@@A@@BC@@AA@@BA@@@@BAB@@@@@AA@@@@@A@@@@@@@@DB@@B@@@BA@@@AA@A@@@@A@@@@BAB@B@@A@@AC@@@BAAAB@A@@A@@A@@AB@@A@@@@@@@@@@@@@@@@@@AB@@@@@A@@@@@AA@@@@@@@@@AA@@@@@@AB@@B@@@A@@@@AA@@A@@@A@@@BBA@@B@B@@A@@AA@@BAA@@@@BAB@@AA@@@BA@@@@@@A@@A@@@@AA@A@@BB@@@@@@BB@@B@@@B@@@@@@@@@B@@@@@AA@@B@@@@@@@@@@B@@B@@A@@@@D@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@B@@BA@@A@@@@@@A@B@@B@@@@@@@AA@@B@@@@@B@@BBA@@A@AA@@@@B@@AB@@A@@@A@@BB@@@@B@@AA@@@@A@@@AB@@@@@BA@@@@@A@@A@A@@@@A@@B@B@@A@@@@@A@@@@@@@@A@@@@@@A@@@A@@@@@A@@@A@@@AB@AC@@@@@@@A@@@@@A@@@@@@B@@A@@@@@A@@@@BA@@@@@@AA@@@@@BAA@BA@@@A@@@@@@@@@@@@BA@@@@@B@@@@@@@@@@B@@AB@B@@@B@BABIC@@@A@@@@@@@@AA@@CB@A@@@@B@D@@A@@@@A@@@BA@@BA@@@AA@BA@@@@@@B@@@@A@@@@@A@@@@@AB@@A@@AA@@A@@@@AA@@A@@@A@@@@@@B@@@B@@@AAA@@@AABC@@@A@@@@@A@@ACA@@ACAACA@@A@@@A@AB@@AB@B@B@B@@@B@@@@@@@@@@A@CA@@AA@@@@C@@@A@@@A@B@AA@AAA@BAA@@A@A@A@@@A@@@A@@@@B@@@AA@A@@@AB@@ABA@@@@@AD@@@@A@@@AA@A@@@A@@@A@@A@AAC@@@AAAA@BA@A@@A@@@@AA@A@@@AB@@@B@@@AAA@A@@@@AA@@@@B@ACBA@@BA@CAA@AA@BABABAACACGAA@@AAA@AAAA@@@@A@A@@AAA@@A@A@ABAA@@@@@A@@A@@AA@A@AA@AAAA@A@@BABA@A@@@AB@AAA@@A@AAAA@BA@A@A@@@@AA@A@A@A@CBA@@@@D@D@BB@C@A@C@C@AA@AA@@@@@ABC@@@@B@@@@@@@A@AAB@AA@@@@@@@@@A@@C@@AAA@@@A@A@A@@@ACBAAA@C@C@@@@A@@@A@A@A@@@A@@@A@A@@A@AA@@ACB@@A@@AAA@@@@A@@B@@@@A@@AA@E@@@ABC@@@@FB@AAA@@@A@ABA@@@AA@@ABCB@@@BA@@@@@A@@@ACA@@@A@@JABA@@@A@AAB@@@@@@A@@A@@@@B@@@@@B@AAC@@ACBC@@@@ABA@@@@AA@@FC@@A@@@@A@@B@@@BA@@@B@@B@@@BA@@BC@@@@B@BB@@DAB@B@BB@@B@BB@@@@@@@@@@@@@@A@@@@@@@B@@@@B@@@@@@B@@@@@@@A@@B@@@@B@B@@@B@@@B@B@@@@A@@A@@AB@@ABABABADAA@@A@@AA@@@@@A@@@AB@@@AA@@@AAAA@@B@@BBA@@@A@A@AAA@A@@@@@AA@AAC@@@@AA@@@AB@B@@A@CA@@AB@@@BA@@@AFE@@B@@A@@B@@@@B@@@@@AB@@@BB@@@@@A@@AA@C@@@@ABAE@@@A@@@CA@@A@@@EA@@@@A@@@@@AAA@@A@@A@@BABA@AA@@@@AA@AA@AAAAAAE@AAAAA@@BA@@BA@@AA@@AA@AAA@A@@A@C@A@C@AA@AAA@A@C@@@AECEAAAA@@@AA@@BAB@@ADA@A@@AB@@@@A@AB@@@@@@CA@@ECAAC@EA@@AA@AAAAA@@AA@@AAAAA@A@@@AA@@A@@@A@AA@@@@AAC@@@ACA@AAAA@AAAAA@@@@ABAB@@A@A@@A@@@@A@A@"],encodeOffsets:[[122126,32395]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
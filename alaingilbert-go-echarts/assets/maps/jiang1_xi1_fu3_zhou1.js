@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("抚州",{type:"FeatureCollection",features:[{type:"Feature",id:"361021",properties:{name:"南城县",cp:[116.63704,27.569678],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABA@A@@@A@@BADA@@B@BA@@BAB@@BB@@@BA@A@@@CB@@@BAB@@@BABA@@B@@AB@@AB@@EBEA@@AA@@A@@A@@AB@@@C@@AA@A@A@AAA@@ECGEA@@@CI@@BA@ADCBADABABA@AB@BABABCDA@ABA@C@@@C@@@AECCCAAAA@@@CBC@@ECCA@@@@@AA@C@@@@BABC@@@AB@AC@@@A@@B@BE@@@A@@@A@AA@@AA@@A@@@AA@@@@@@@A@@@@@@@A@@@AA@A@@@AA@@C@@@@BA@A@ABA@AA@AA@@A@@A@@BABABAB@@@@@BA@@@A@@AA@A@@AC@CBA@CB@@A@@@BA@@@A@@@A@@AA@@A@AB@@AAA@AA@AAA@@@@@AB@@ABA@@@@ACAAAA@@@@@A@@A@AB@@@@@@A@@AA@@@@B@@@BA@A@@@@@AA@@@AA@A@A@C@@@AB@B@@A@AAA@A@@@@BAB@BAD@B@@@B@B@B@B@D@@@@@B@BA@@@A@A@@BA@@@@A@@C@AA@@ABA@@BA@@@@@A@A@A@A@@@@BA@@@A@AAA@A@@@A@@@A@@B@@B@@BA@CD@@CB@@@@AB@@@@A@AB@@CAAA@B@@@@@B@@A@@@@BA@AB@@A@@@@B@@A@A@@@A@@@CB@@A@C@@@A@@B@B@BA@@B@@@@ABABA@A@@@@BA@@BA@ABA@ABA@@@A@AAAAC@A@@@A@A@@@AAA@@@A@@BABA@@@C@A@@@A@@@A@A@A@@AA@@@A@A@@@@@AB@BAB@B@@@B@B@@BBBBDB@BBDBB@B@BB@@BB@@B@@@@@B@@@@@@B@@@@@@B@@@@B@@@@@B@BBD@BBBB@@A@@BABB@AB@@A@AB@@@B@@@B@@@BBBABB@@B@@@@B@@@@@@@@D@@@B@BB@BBBB@B@@B@@@@BB@AB@BB@B@@@@@@@AB@BA@AB@@@@@@AB@BA@@BB@B@@B@@BBBBB@BBBB@@@BA@BB@@@BB@@B@@@@@D@@@BB@@B@B@BAD@B@@@@@@@B@B@B@@@B@B@@@@B@@B@@@@BB@B@@@D@@A@ABA@A@@@AAC@C@@@AB@B@B@B@@@B@B@BAB@@@@@@@BB@@@BBB@@@@@B@@B@@B@@BAB@@@@@B@@ABA@@BBB@B@@@@@B@@@B@@BB@@@B@@@B@@@@B@@@B@@B@@BBABBB@B@BDBB@@@@B@@@@AB@@BDB@@@@BA@@BA@@@BD@BBB@@BBB@BBB@BB@BB@@BB@@B@@@BAB@B@BA@@B@B@@@@AB@@AB@@AB@@@B@@@@BB@@BB@@B@B@@B@@@BBD@@@@ABB@@@@B@B@@B@B@@@B@B@@@@D@@BBBB@BB@BB@@B@@@@BB@BB@@B@@@@@@@@@BB@@@@B@@@@@@@B@@B@@B@B@@@B@B@B@B@@@BA@@B@DB@@B@D@BBB@@@B@@@@BB@@@@B@@@@B@@BBB@@@@BBBBBB@@@B@B@BB@@BBB@@@BA@@@@B@BB@@B@@BBB@@@BBBB@@BBB@BBB@@@@B@@@@BB@@@B@@BB@@@D@BB@@@@B@B@@@BA@@@@BB@@B@@@B@BB@B@@B@B@@BD@BB@@B@@@@@@D@@@B@@@B@B@@@@@@BB@@BB@B@@@DDD@B@@B@@B@@B@BB@@B@@@BB@@B@@@BBB@@@BBB@@@BBB@BBBB@@@@B@@AB@@AB@@AB@BAD@B@B@@@B@@B@@B@BBB@@AB@B@B@B@@ABBD@B@@@D@@BD@@@B@@@@BB@@@@BB@@@B@BBBABA@@BBB@BB@@@@BB@@@@BB@@@@B@@B@BB@B@@@B@B@@@B@@BBAB@@BBBBA@@@@@@B@@BBB@@BB@@@@BB@@BB@@@B@@BB@@@ABB@@BB@@B@@DBB@BB@@A@@B@@@@BB@B@@@B@@@B@@@@AB@@@B@BAB@@@B@B@@@@A@@BA@@@ABCB@@ABAB@@@B@@@@ABA@@@@B@@@BB@B@@BB@B@@BB@BB@@@@@B@@@B@@BB@@@B@@@B@@@@BBBB@@@@BDBB@@@B@@@@@@@@BBB@@B@@@B@@@@BBBBB@BB@BB@@@BB@BB@BBB@@@AB@B@BB@@B@@ABABAB@BADC@@BAA@B@@ABABABA@A@A@AB@@@@@@AB@@@BA@@@BB@B@@@BB@@@AB@@ABA@@BA@@@A@AB@@A@@B@@@@@B@@A@A@@@A@@B@@A@A@@@AB@@@B@@A@@@A@A@@B@@A@@@A@A@@@@@AB@@AB@@A@A@@B@@@B@@@B@B@D@BBB@@@B@@A@AB@@A@@@BA@@A@@A@@A@@@@DB@@@@B@@AB@@BD@@@DBB@@BB@BB@@B@@@B@@BBBD@@A@@B@@ABABA@@B@B@@AB@@@@@B@@BB@@BB@@BB@@BB@@B@@@@@B@@@@BBB@@@B@B@@BB@@BB@@B@@@B@D@@@B@B@BB@@DB@@@@@BB@@BB@@@B@@@@@B@@B@@@@BBBB@@@@B@@@BBBBB@B@DB@@@B@BBD@@@BB@@@@B@@@B@B@@AB@BB@@@@B@@A@B@@BBB@AB@@@B@@@B@@BBAB@@@B@@B@@B@@@@AB@@@@@BA@@BB@@@A@@B@@A@@@BBA@@@ADBB@@B@AB@B@BA@@B@@@@@B@@A@@B@@@@@BB@@@@B@@BB@@@@BBD@@@@@A@@@@@A@@@@B@@@@A@@@@BDB@@@B@BB@@B@B@B@@@B@@@@@B@@DBBB@@@BBB@@@B@@@@@B@B@@@B@@@B@@BB@B@B@@A@@@@BB@@@@B@@B@@BA@BB@@@@ABBB@@@@@@@B@B@BA@@@A@@@CBA@@@@BAB@BA@@B@@@@@D@@@B@@@@@B@@A@@@@@AB@@@BB@@B@@@B@B@@@B@@A@@@@@@B@BAD@@@@@BA@@B@B@D@D@@@BBB@@@FDBBD@@@@@@C@AB@AC@A@AB@@A@@A@@@AA@A@@@CBBB@@A@@@@@@ACAA@@AAB@@ABC@A@E@A@ADA@@B@@A@BB@@ABB@@D@@@@@B@@ABAAA@A@@BAB@@AD@B@@@@BB@@B@@@@@@BBB@@@B@@@BB@@BBB@D@D@@BB@D@@@B@@A@@@@B@BBB@@A@@B@BAB@@@@AB@@@@@B@@@B@@@@@BB@@@B@@@B@@@BB@D@B@BBBBBAB@@A@@@@@@B@@@B@@ABC@@@@@ABB@@@B@@B@BBBB@BB@@@BABC@@B@BB@@@@@@DBB@B@@@BA@A@AB@@A@@B@@AB@@A@@B@@E@@@@BA@@@@B@BA@@B@@BB@B@@@@BBBA@@@@@AB@B@@B@@B@@@@@B@@@B@@@@@BBB@BBBB@@B@@@@A@@B@@@B@@@B@@BD@BBB@@B@BABCB@B@@@@BBB@BBB@BB@@B@B@@@BB@@BB@@B@@@BAB@@@DA@@B@@@B@@@@lDd_BA@@@BB@B@BBB@@@BB@@B@@@@@B@@@@@@B@@@B@@BB@@BB@@@BB@DDDD@B@B@@B@DDB@@@AB@@@BB@BBB@B@@@@@B@@@B@@@@@BB@@@B@@@@@@@BAB@@@B@@@@@BDB@@B@B@@@@BA@@@@BB@ABB@@B@@B@@B@@BBBBBD@@@AB@B@B@@@AB@B@@AB@@A@@@A@@@@@@BB@A@BB@@@@@@@A@@B@@B@@@@ABA@@@@@@BA@@@@@CAA@AB@@A@@@@@AB@@B@@B@BB@BB@BB@@AB@BA@@BB@@@B@@@@CB@@@B@@B@AD@@BB@@@@@B@@B@BBB@@@@@@B@@@B@B@@@B@@@B@BBB@@ABBB@@B@@B@B@@B@BB@@@B@@B@B@BB@@@@@@@B@@@BBB@@B@@@AB@@DBB@@B@@B@B@@BB@@@@B@BB@@@B@@@@B@@A@@B@B@@@@B@BB@@BB@@@@B@@B@@B@B@@@@B@@@BB@@@@B@@@B@@@@@B@@@B@@D@BA@ABA@A@@@A@@B@@A@@B@BA@@@@AA@@@A@A@A@@@@A@AA@@@@@@BA@C@@D@D@@@BB@@B@@@FBDB@@@@@AB@@@@A@@BA@@@@B@@A@@B@@D@@B@B@@@BBB@B@@@B@@@@@@@B@@@B@@AB@@@@AB@@@B@@A@@B@@BB@@@B@@@B@@A@@@@@A@@A@@A@@B@@CBA@@@@A@@@@A@A@@@AB@@A@@@@@@@AA@@@A@A@@A@@@@AAAB@A@@@AAAB@A@@AA@@@@@A@AB@@A@@A@@@@@CA@@A@@@@@A@@BA@@@A@A@@@C@@@A@@@A@@@@D@B@BB@AB@@@B@D@BB@@@@B@@A@@@@@A@@DA@@@A@@B@@@@@@ABA@@B@@A@@@A@@@@@A@@@AD@@AB@B@B@B@@BBBBBAB@@@@B@@@@@B@@B@@@A@@@@BA@BB@@BA@@@@B@@@B@@@@D@BA@ABA@@B@@@BA@@BD@B@@DAB@@@BA@BB@@@B@BB@AB@@@@A@@BA@AA@@@BABA@@BA@@@@A@@A@@B@BAA@@@A@@AA@B@@A@@@A@@B@AA@A@A@A@A@C@@@@@A@A@@BA@@@AB@@B@@@@@B@B@@@BBAB@@@BA@@@@BA@@BBBAB@BA@@B@@A@A@A@@@@@BB@@@@@@BB@@@BB@@B@@@@BB@BBB@@@@@@@@@B@A@@ABA@AB@@A@B@@B@@@@BBA@@@@B@B@@@BA@@D@@@@A@@@AA@@@A@@@BC@@AA@A@@@@BA@AB@@@@A@@@@@AA@A@@@AB@@@AA@@@CA@@@@BA@@@A@@@A@@@@BA@@@A@@B@@AAA@ABABA@@@A@AB@@@AA@@AAA@@A@A@A@AA@AA@@@C@@@AAAAA@@A@@@AA@@ABA@@A@@A@@A@@@BAAA@AB@BA@@B@@A@@@A@ABA@A@A@@@@@@@AA@A@@@A@@@A@@A@@@@@@A@AA@@AA@@BAAA@@@@@A@@@@@@B@@@B@@@BB@@B@@@AA@@BA@@B@@@B@@A@@B@@@BA@@@@B@@@@A@@@@@AB@@AA@@@@A@@BA@A@@@@@@@AA@@@BA@A@@@@@AB@BA@@@@@A@A@@@AB@@A@@BA@@@@@@ABAB@@AB@@A@@@@A@@@@@A@@ACBCAA@@AA@@AB@B@@@B@@@@@BAB@@@B@@@B@@@B@@@AA@@AAAA@@@A@@@@AAA@@A@@@A@@@@@@BA@ABA@@@A@@BA@@A@@A@@@@AAA@@@@A@@@@BA@A@A@@@AB@@ABA@A@A@@@@@AB@@@@@B@BA@@@ABA@@@@@ABA@@BA@@@A@@BA@@@@@A@@@A@A@@A@@AA@@@AA@A@A@@AA@A@A@@@@AA@AAA@AA@A@@A@@@A@A@@@A@@@A@@A@A@@@AAAA@@@A@@@@@C@@@@@AA@@A@@@@BAD@BB@@B@B@B@@A@@AA@@@@B@@@AA@@@A@@@@@@BB@@B@B@@BB@@@@@D@@@BA@@AA@@@@CA@@B@@@BA@@B@BA@@@@@A@@@A@@A@@AA@A@A@AB@@@@@A@@BA@@@C@@A@AAA@@@AA@@@A@@@@@A@@@A@@@AAAA@@A@@@AAA@@@@BA@@B@BA@B@@@@B@@@B@@@@@BBB@@BBBBABB@@@@@@BDB@B@BB@@BB@@BBDB@@BBBA@B@@@@B@@BB@@B@@@B@BD@B@BBDB@BBB@B@@B@@@@BB@B@B@@@B@@BBA@@@AB@@@@@@B@@@@BA@@B@@@@@B@@@A@@@@@@A@@@@AA@@@@BA@@@@B@BD@@@@@@@AAA@@B@@@@@@A@@@A@@AABA@@@AAA@@B@@A@@@@@A@@@A@C@@BA@@@AB@@@@@B@@B@@DABA@@@ABA@@@@BA@A@@@@B@@@B@@@BAB@B@@@BAB@@AB@B@B@@@DA@@B@BABABAB@B@BAB@B@@AB@BAD@BAB@@@@@@A@@@A@@@AAA@A@EB@@@@A@AA@@A@@BA@A@@B@@AB@@A@@@@BAAAA@A@A@A@AAAA@@@A@@@A@@AAA@A@A@AAA@@@AA@@@@@A@A@@@@@C@A@AA@A@@@AA@@A@@@@@A@@BA@@@A@A@@@AAAB@BA@@@A@@@C@@@A@@@@BAAABA@@A@@@@AAA@@@AA@@@AA@AA@@AB@@@@A@@AA@@AA@@@AAAA@AA@@@@@BA@@B@@CB@@A@@B@@A@@B@BB@@AA@A@@A@AA@@@@AA@@@@AAAC@A@@@@@ABA@A@@BA@A@@B@@@BA@@A@@A@@AAB@B@B@@@B@@@B@@@@A@@@A@@@@B@@A@@@AB@B@@@AA@A@@BA@@@@D@@@B@B@@@B@B@@A@@BC@@B@@@@@BAB@@A@@B@BAB@BAB@@@B@BBD@@BB@@@F@D@@BB@B@B@D@@@@BA@BB@@B@B@D@BB@@@@BBB@B@B@@@B@BB@@B@@AB@@@B@B@@@B@@@B@@@B@B@BBB@BA@@B@BBB@@@BBB@@@B@@@BBBBB@@@DAB@D@@@@A@@@AA@@AAAA@@@@A@@AA@@AA@A@A@ABA@@BA@A@ABA@@@A@@@A@@@@@@AA@@AAAA@@AAA@@@@AAA@AA@@CB@@@BAB@BA@AB@@C@@@AAA@@@A@@ACAABAB@@@BA@@@AB@B@BA@@@A@@@@B@B@@A@@B@@BB@BB@@@@B@B@B@@@@@@AA@BAAA@AAA@@@AB@@@B@@@B@BBB@@@@@BA@@B@@BD@B@@@@@@@@A@A@A@@@@BAB@BAB@@@BAA@BA@@@@@A@@B@@@C@@@AAA@CACAA@CAA@C@G@A@AA@AA@AC@@A@CAC@CA@@EA@@@AA@BA@A@@@@AA@AAAA@@A@E@@@A@@@AA@BAAE@AB@@A@@A@@A@@@A@@@@B@@AB@@A@A@C@@AA@@@@BAD@@@@A@A@C@@@@B@B@B@@AB@@A@@A@@@CA@@@AAA@AA@A@@@BBAB@B@@@@A@@A@@AA@@@A@@BABABA@A@@@@AA@B@AA@BA@A@@@EAA@A@A@AAA@@@AB@AA@@@A@@@A@@AB@@A@A@A@A@@AA@@@@@@@@@@A@A@@@@@@A@@@AB@@@@A@@@BABA@@@A@@B@@@B@@@B@BAF@@@BBB@B@@@B@BA@@B@@@B@@A@ABA@@B@@A@@A@@@@AB@@A@@@@@A@@@@@@@@AA@@@@BA@A@@@AA@B@@@DAB@B@ACBA@A@AAA@AB@@@@@@A@A@@B@@ABAA@@CAA@A@A@@A@C@A@@@AC@@@A@AA@A@A@A@AAA@CCECAA@@ECCA@@@A@@AA@@@@B@@@@A@C@@@@BA@A@A@@A@A@AAA@GCA@A@A@A@A@A@C@@@A@AAAAA@A@A@@@@@AB@B@@@B@B@B@@@BA@@@A@A@@BA@@B@BAB@@A@A@@BAB@@@@ABA@@@@B@BBB@B@@@BBBAB@BA@@@A@AD@@@B@BAD@B@B@@ABA@@B@BBB@@@FBDA@@BAB@@@@AA@A@@AA@@@A@@A@@@A@@@@@@@BA@@BA@@A@@@@A@AAA@@@@@B@@@B@B@@@B@BA@@B@BA@@@@@A@@B@@@@A@@@@@AABA@@@@@@A@@@@@@B@@@BA@@A@@@A@@@@A@@@@@@BB@@@@@@BA@C@@@A@@B@@A@@BAB@@A@@@A@@@A@@@@BBB@BB@@BA@@@A@A@@B@BA@@B@B@@A@@@@@@BA@@@@CAA@A@@@@A@@B@@@@BB@@@B@@@@AA@@@@A@ABAA@@@BA@@@@@@@A@@BA@AB@@@@@AA@@@@@AB@@CD@@@@A@@A@@A@A@@BB@@B@@@@@@A@@B@@@@AB@@AB@@@BA@@@@@@@@@@BB@@B@@@@@@A@@B@B@@ABAB@@C@AA@@A@@BAAA@@@ADA@@B@@@B@@AA@@A@@@BBB@@@@B@@A@@B@@@AA@@B@@@@@BA@@@@A@@AA@@@@@@@B@B@@A@@AA@@A@ABC@A@@A@@AA@@B@@@B@B@@A@@B@BBB@D@@@@A@@@@@A@@B@@@@A@A@A@@@ABA@G@EAA@AA@@A@A@A@@@A@@AAC@A@AA@A@@@AB@@A@A@@@A@@BABABABCD@@@BABADAFA@AB@@@@AA@@@@BABA@@@@@A@@@@@ABA@A@A@@A@@A@@@C@@AA@@@A@@BA@A@C@A@@@@@A@A@AA@@BA@@AA@AA@@A@AB@@A@@@@@CCA@@AAA@@@AA@@@@@A@AA@@BC@A@A@@A@AAAAAA@BA@@@@@AAA@@@@@@C@@@A@@@@@@A@A@A@@@@B@@@@@@A@@@@BAB@@A@AB@@@@BB@BA@@@A@@A@AA@@@BB@@@B@@@@A@@@AB@BC@@@@@@B@@@@A@A@C@@@@@A@@@A@A@@@@BA@@AAA@AAA@@@@C@@@@AA@@@@A@@CA@AA@@ABA@@AA@@A@@@BAB@B@B@@A@AACB@@A@@A@A@C@@@@@@@@ABA@@B@@@@A@@@@AAA@@@A@@AA@@@A@AAA@@@AB@@@@A@@AA@@@A@A@@@@@A@@A@@A@AA@@A@BA@@AA@@@@A@@BA@@@A@@@AB@@AA@@@AAA@@@A@A@@BA@@@@@A@@B@@@@A@AB@@AB@@C@@@A@@@ABA@@@AB@@A@@B@@@DAB@DBB@@@BC@@B@@@@A@AA@A@A@@@AC@A@A@@BABA@@@@A@@@@A@@A@@@A@A@@@@A@@A@@@@@@AB@@A@@@@@@B@@@BA@@BA@@@@B@B@@@B@@@BAB@@@B@@@@@@@A@@@@A@@DABABAB@@@B@B@B@@BB@@@@@BB@@@AB@@AA@@@A@@@@A@@@A@A@@EC@A@@@@AA@@A@@AA@A@A@A@@@A@@A@AAAACCA@@@@A@@@EB@@ABA@@A@@ABA@AB@@@B@@@@@B@BAB@@A@@BABAB@BAB@@A@@@AAA@@@AA@@A@@@A@@AA@A@ABA@@@AB@@BB@@AB@D@@ABC@@AA@@@AB@@AB@@@B@@CBABA@A@CB@@CAAB@@@@A@@@A@A@@@A@E@A@@@AB@@C@A@@AA@@@@C@@A@AA@A@@@@A@@@A@AA@AA@A@@@@@A@@A@@@@@@@A@@@A@A@@@@@@@A@A@@A@C@@@AA@@AAC@AA@A@A@C@@CA@@@AA@@A@A@AA@C@AAA@AAAAAAA@@@A@AA@BA@ABA@@B@@@@AAAB@@@BA@@@A@CAA@@A@@@@A@@@A@A@@@A@@A@AA@@A@@B@@@@A@@AAAA@A@@@@AAA@@A@@B@@A@A@@A@@AA@AACC@@CAA@AAA@@AA@AAA@@@A@@@A@@@@@AAEAC@@@A@@@ABA@A@@@A@@@@@A@@@AB@@@@@BA@A@@B@@A@@@A@@@@@ABA@A@@@A@@BA@@DAB@D@@@@@@@BA@@BA@@@@@BB@@@B@@C@@@A@A@@@@BB@@BA@@@A@@B@@@B@@@@AB@@ABAB@@A@A@CD@@A@AAA@@@A@@B@B@@@B@@A@"],encodeOffsets:[[119380,27986]]}},{type:"Feature",id:"361002",properties:{name:"临川区",cp:[116.312166,27.934572],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@@A@@@@BA@@@A@AB@BABA@@@AB@@@ACAA@@@A@@@AA@@AAA@@AA@C@@AC@@BA@@@A@@A@@C@@B@@@@BB@@@AB@@B@@@BAB@@@@A@@AA@A@C@A@A@@@A@@@AB@B@@@BA@@BA@@@@B@B@@@B@@@@AB@B@@@B@@@@A@@BA@@B@B@@@@AB@@@B@B@@@@A@@@@@AB@@@BAB@@@B@@AB@BA@@BA@@A@@A@@@AAA@@B@@A@@BA@@@@@@BAB@B@B@BABABA@@@ABB@AD@BC@ABABABA@@@AA@@A@ABA@@A@AAA@@AAA@@A@@AAAA@AAAA@@@@@A@@@AA@AA@@@@@@@@@A@@AAAC@@@@AAAA@@@@@A@@@A@@AA@@@A@@@A@@@@AAA@@AA@A@@AA@A@@A@@@A@@B@BA@@@@@A@@BABA@@DABA@@B@@AB@@@@@@A@A@@BA@A@A@@BA@@@@@A@@@A@@@AAA@@@@@AB@@@AAA@CA@@@AA@@AA@BA@@A@@AA@@@A@@AA@@A@@A@@AA@AA@@@A@@@@B@AAAA@@BAAA@@@A@@@A@A@@@AAAA@@@@A@@A@@A@@A@@A@@A@@AAA@AB@BAAA@A@A@@AA@@@@AA@@@@@A@@AC@@@C@@@AACBA@@@A@A@ABA@@AA@A@AA@@@@A@@@A@ABC@ABA@@BA@@BA@@@A@@A@AA@AAA@A@@AA@A@@AA@A@@@AA@@A@@@AA@@A@AA@@@@AA@C@CC@@A@A@@AA@@A@@@@A@A@@@A@@@C@@@@@A@ABA@ABAB@@@@AAA@A@AAA@A@@@@B@@@BA@@BA@ABA@@@@B@@@@AB@@A@@@@@A@@B@@BB@@@B@@BB@BBB@@@BBB@@@B@@B@BBB@@@BB@@@@BB@@AB@B@B@BAB@@CBABA@A@@BB@@BB@@@B@@BB@@@@B@@A@AB@@@@@@@B@BABA@C@AB@@A@@@A@A@@AE@@B@@AB@B@B@@AA@@C@CA@@@@A@@BAA@@ABA@@B@@A@AB@@AB@@@AA@@BA@@@@@@@A@@@CD@@@B@@@@AD@@AB@BA@A@@B@@@FCB@@@@@BB@@B@@B@@BB@@@BB@@@B@@BDB@@B@@BB@BB@@B@@@D@@@BB@DBBB@@@BBBBB@BDB@@@@@@@A@@@A@@@A@@@@BA@@@@AA@@@A@@BA@@B@@@B@B@@@BB@@BBB@B@@AB@@BB@@@@@BA@@@@BBB@@@B@@@B@@AB@@BBB@@@@B@@@BB@@@@BB@B@B@@@BB@@@@@@BBB@B@@BB@@BB@B@@@B@@AB@@@D@B@B@B@B@@@@AB@@A@A@A@A@ABEDAB@@AB@BA@@@A@@@A@@@@@AAAA@@AA@@AACA@@@@ABAB@@AB@@@B@@@BBB@@@D@@ABA@ABA@@B@@A@@BAB@BA@@BA@@DA@AB@BAB@@@@@BA@BBAB@@@@@BA@A@@B@@B@@B@BA@@BAB@@AB@@ABABA@@B@@ABA@A@@B@@@@@BAB@@CBA@@@@@AB@@@@@DA@@B@B@@@@AB@D@BAB@@ABAB@@A@@@@BA@BB@@@@@BA@@BA@@BA@@B@@A@@@@B@BA@@@@@AB@@A@A@@BA@@BA@@@@@A@CB@@@BA@@@A@AB@AA@@@A@@@AB@@@@@B@@@@A@A@@@@@@@@A@@A@AB@@@@A@@@@BA@@@CBA@A@A@AB@BA@@@ABABA@@B@@A@@@ABCFA@A@@@@B@B@BA@@@@B@B@B@D@@AB@@@@@@CAAA@@ABA@AA@B@@@@@@@@@@A@A@A@@@A@A@@AA@AA@@A@@A@@@@A@AA@@CBA@@BCBEBA@@@A@AAAAAA@AAA@AA@AA@@@@@@AB@@AB@@ABCBA@AB@BAA@@ABAB@@AAA@A@@@@B@@@BAB@@BB@@@B@@@B@@AB@@@B@@@@A@@@@B@@@B@@A@@@A@@@@@@@@BCA@@@AA@@@A@A@@@@B@DAB@B@B@@@B@@@@B@B@B@@@B@AB@@BB@@B@@@@A@@B@@@@@@@@@B@@@BB@@@BA@@@@BB@AD@@@B@@@BA@@B@@A@@@@@A@@@A@@@@BA@@B@@@@@@A@@AA@@AAA@@@@A@@@AA@@@@A@A@@@AB@AA@CBCB@@ABA@@@A@@@@AA@@B@B@B@@@AA@@@A@@@@@A@A@@B@@A@@@@@@AA@AB@AA@A@AB@@A@A@A@A@A@AA@AA@@@CB@@AB@BAB@B@@BD@@CBCB@@AB@@@@A@@@A@FF@B@DAB@B@@EB@A@@@AA@@@@@A@@BC@A@@@A@@@@@AA@@@@AAA@AA@@@@B@@@AA@@B@@A@@@@@@A@@A@@@@@A@@A@@A@@@A@C@ACC@@@A@@A@AA@AA@@BA@@@@@@B@@AB@@@A@@A@A@AA@@@@@A@@@A@A@@AC@@@@A@A@@@@BB@A@@@AB@@@@A@@@C@EB@@C@@@ABCD@B@@A@@@@@@@AB@B@@@@@@@B@@@@@B@B@BAB@@AA@@A@@@@@AB@BA@@@A@@@@@ABCF@BA@@B@BB@@D@@@B@@@@@B@@@B@@@B@B@@@BA@@@CBA@A@@@A@AB@@@@A@@@@BBB@@@BB@@@A@@@@B@@DBBB@@@@A@@B@@AB@@@@@D@B@@@@@BB@@@@B@@BB@@AD@BBB@B@@@B@@AD@B@@@@BBBBDDD@@@B@BA@@@@@B@@@BA@@BBB@F@B@B@@@@@B@@@@@B@@@@DBB@@B@@BB@@BB@@@BBB@B@@@B@@@BBD@@@B@@@BAB@@@BA@@BAB@B@@@B@B@@@BA@@B@@AA@@A@@B@@ADABAB@B@B@@B@DBBBBB@@BBB@B@@@BBB@D@D@@@B@B@@@@@BA@A@A@ABA@@@ABA@@@A@@@@B@B@B@@@BA@@@@@@BB@@@B@B@@@@B@@@B@@@@@B@@@@BBA@@B@@@B@D@BBBBDDFF@BDFBB@BABABA@@BA@A@@BCBEB@B@@CBABEFA@@BA@@@AB@B@B@BB@@BBBBBD@@B@BBD@BAD@B@B@@@@AB@DBB@B@B@BBD@BBB@BBBBBBDB@@B@@A@AAC@A@A@A@EBA@@@CBEBC@A@A@@BCBABA@@@CD@@@@@D@B@B@B@BABBBAFBB@BBBAB@@B@BBB@BBD@@@BB@@@B@B@@@B@@B@@@@@AD@@BB@@AB@@A@@BB@@BBB@@@BB@@@AB@BAFA@ABCD@@CBABAB@@@B@@AB@BA@A@A@C@A@E@ABA@CBABA@CBABE@A@ABC@AF@DBBA@@D@@@@@B@@@B@B@B@@@B@@B@@B@@@B@BA@@B@@BB@BAN@BA@@DA@@BABABA@AB@@AD@B@@A@AAC@A@A@A@AA@AA@A@@AAA@A@@CBEACBC@A@@@A@@@@@A@@@@@AB@B@@A@@AB@A@@@A@@AA@@@@@AB@@@@@@ABA@ABA@@@@@@@AAAAA@@A@@@A@AAA@ABA@@B@@AA@@@@AB@@@@C@@AA@@@@@@@A@@AAAA@@AAA@@@@@@@A@@A@@A@CBC@CB@@AAA@@@@AAA@@@A@A@@@@@A@AA@@A@@B@B@@A@A@@B@@A@@@CA@@@A@A@@@A@@AA@AA@AA@AAA@A@@@C@@@@AA@@BA@E@CBA@ABA@@@@A@C@C@@AAA@CE@@@AAAA@A@@@@ABABA@C@@@@AA@@AA@A@AB@@A@@AA@@C@A@A@AB@@A@AA@@A@CA@A@A@E@@@@BAB@AAAA@AA@@A@A@A@AAG@AAC@A@AAAKOAA@@A@@AA@@BA@A@CA@@@@AB@@ABA@@BABD@BB@@@B@B@@AB@BCBABC@@@@B@B@@@BB@@B@@@@@D@@B@@@@B@@@B@@B@@@@BA@@@@BA@@B@@@@@BA@@@A@ABEBABABABCBABABAB@BAB@@CD@@A@CBA@@BA@@BA@@BB@BD@@@BB@@@@A@@DA@@BA@@@A@@@@BAD@BA@@B@B@B@@@@@B@B@@@B@BBB@@@@@@BB@DB@B@@@B@D@DA@@@CBCD@@A@BB@@BBB@@@B@@@@@@@AF@BBB@@AB@FAF@@BB@@@BAB@@A@@@@A@@A@@BB@@@@@AB@@@B@BABA@@@C@@@@@AA@B@@@B@DA@A@@B@@@BA@B@@@@@@B@@@@@@BB@@@BCBCB@@@BA@A@@@AB@@ABA@@BA@@@A@@@C@@BA@@@AB@@A@@@AB@@AAA@A@A@@@A@C@@ACBC@@B@@AB@@A@@@BD@@@@B@@@B@BBB@B@B@@@@DBB@B@@AD@@B@BB@@B@@@@@BBB@@BB@@@B@B@B@@@BB@@BB@@A@@B@BB@BB@@@B@@@B@BA@@D@B@@BB@@B@BA@@BBBB@@B@@@@@B@@B@B@B@BAD@@@D@BB@@B@B@@BB@@B@@@@B@B@@@B@@BB@@@BB@@@BBFD@@B@B@B@@@B@@@BBB@@BBBBB@@@@B@@@BBBB@B@@AB@@@@@@@@@BBB@@@@@B@@@@@@@B@@@@A@@@@@@@@@@BBDBB@BB@@B@B@B@BB@B@@@B@@@BB@BB@@B@@B@D@@@@@B@@@B@@BBF@@@BA@@@B@DBBB@@B@B@BA@@B@@@B@@@@@@B@@A@@@A@@@@BBABB@@BA@BA@@@ABABAAA@@@A@@@A@@B@@@B@@@B@B@@BB@BB@@B@@B@B@@@BBB@@@@B@@A@C@@@AAAD@@B@@@BB@@@@A@A@A@@@@@@BB@B@@@B@@BB@@@B@@@B@@@@BB@A@A@ABAA@@A@@@@@@@BB@@A@@@@B@@@@BA@@B@@@B@@BB@@@@@BB@@@@@@CBAB@@A@A@AA@@AAA@@B@@D@@@BB@@@@ABC@@@@@D@@@B@BABB@@@@AB@@@@BA@@B@@A@@B@B@@BDB@@AB@B@@AA@@A@A@AB@@@@C@AB@@A@BD@@@@@B@@@B@B@@@BB@@BB@@BB@D@@@@@B@@A@@@D@@@@BABA@@@@D@@ADBBB@@B@A@@@@@A@B@@@@@@BA@@@@@@@B@B@@@@AB@@@B@@B@@@B@@@AB@@@B@@@@BA@@B@@B@@@@@@B@B@B@@@@@B@@@B@@@B@@B@@@@@B@AB@@@@@@B@@@@BB@BB@@B@BBB@@B@@@@A@@B@@@B@D@@@@@B@@@@AB@@@@AB@@@B@@@@A@@@@B@@@B@@AA@@@B@B@@A@@@@@@@@@@B@@A@@@A@@@@@@B@B@@B@@@@B@@@@@AB@@@@@@A@@@@B@@BBBB@@A@@B@@@@@@B@B@@B@@@BAB@@@@B@@BB@@@@AAA@@@@@BBBBBA@@@@@@@BB@@B@A@@@BB@@@@@@@@A@@@@@@@@@B@@@@@@@B@@@B@@@@@@@@BB@@@@@@A@A@@@@@@@@B@B@B@@A@@B@B@@@B@@@B@@A@AB@@@@@@A@@B@B@@AB@B@@@@C@@@@@@@A@A@@@B@@BA@@@AA@@ABAB@@A@@BB@@BA@ADBB@B@@A@@A@@@@@B@@@@@@@B@@@B@@BB@@@@@@@B@@@B@@A@@@B@@B@@@B@@@@@@B@@BBB@@BB@B@@@@@B@@@AA@@AAA@@@@@BBB@@@B@@B@BB@@@B@@@@A@AB@@@@@BBB@B@@AB@@@@BDBB@B@@@@@@B@DBB@@@@B@@A@@@@@A@@@@@@@@B@@A@AA@@@@@@B@BBBB@@@@@A@@@@@@B@B@@@@@@B@@B@B@@@B@@@@@BB@@A@@@B@BB@@@ABA@@B@B@@B@@@B@@B@@B@@@@@@A@@@A@@A@@AB@D@B@@A@@@A@@@@B@@@@A@@@@@@BBBA@@@A@@@@B@@@B@@AA@A@@A@@@@@A@@A@@@BA@A@A@@BA@@@ABA@A@@@@BB@@BA@@@@@AAA@@@@AA@AAA@AA@@@B@@@B@@@@AAA@@@@@A@@A@BCA@@AA@@A@A@@@A@@@@B@@A@@@@@@@A@@@ABB@A@BBI@A@A@@@@@A@A@@@@@@B@@A@@@@@@@AAAAA@@@@@A@BD@BA@@@AA@@A@@BAB@@@@A@@@A@@B@@ABA@@@A@A@AB@@@@A@@B@BA@@BAB@@AB@@A@A@@BA@A@A@@@A@AA@@AB@@C@ABA@@@@B@@@B@B@@ADA@AB@BAD@BBBAB@@@B@@@BB@@B@B@@@B@@@@@@@BB@@BBB@@B@BB@@BB@BBBB@BB@@@@@BBB@@AB@@@@AB@@@BA@@@@BAB@@@B@B@@DD@DBB@@@BBB@@@B@BBB@@DB@@@BBBBB@B@B@B@@@BB@B@@@BB@@B@@@@B@B@FB@@BB@@@BB@BB@@@BB@@BB@BABAB@B@D@@B@BD@@B@B@@@BB@B@@@@AA@@@@@@BB@@@BA@BB@BB@B@@@BBB@@BA@AA@@@@BB@@@BBB@@BB@BB@@B@@C@@@@BB@@@@@B@BD@B@@@@@@@@@B@@B@DAB@@A@@CLAHDCDCHEBAB@FAFADAJCBADADAB@DAB@DCDADAFCDAB@BADCBCFIFKBA@C@AAC@A@@BEDEBAHGFEBABAB@FAB@B@D@D@D@J@HAD@D@@@HADABABABABADCBA@ABCBCBA@A@ABAB@BADADAD@B@B@D@B@@@@BAB@@BBABBB@@@@AB@@@B@BCB@@@@BBA@@@@@@A@@BA@ABA@AAABA@@A@@@@@@@@A@@@@A@@BA@@@AB@@B@@@@B@BB@@B@B@@@@A@@@A@@B@@B@@@@D@@BBD@@@ADB@@BBB@@DB@@BB@@@B@B@BA@AB@@@BA@@B@@A@@B@@@B@@@@@BB@B@ABBB@@@B@@@@ABA@@B@@@@D@BBB@@@B@@A@@B@BBD@@BBB@@BA@@@@@@BB@@BB@@@@BB@DB@A@@BAB@@B@@@BA@@@@@@@@B@@B@@@@@B@@@DB@@BBB@B@@B@BBB@@BB@BB@B@B@@@@B@@@@@B@B@B@DBB@BA@ABBD@@@@@@BB@B@B@@@@B@@DB@@@AB@@B@@@@@BBAB@B@@@@@A@@A@@B@@@B@B@@@@A@A@A@@B@@B@@@@@ABA@@B@@@@B@B@@@B@B@@B@@@@B@B@B@@A@@@@AAA@@@@@B@BBBD@B@@@B@@B@BA@@@@@BBAB@@A@@@@@B@@@@@A@@@@BFB@@@@@@B@@@B@B@BBDB@@DB@@@A@@@A@@A@@ABB@@B@@@@@B@B@@@@@BB@B@@BA@@@@@BA@@@@@B@B@@@B@@@B@B@B@@@BA@@@AB@@@AA@@B@@ABA@@B@@A@@BAB@@ABA@@@@B@@@B@B@@@@@@@@B@@@B@@B@@@BBA@@B@@@B@@@@@B@@@B@B@@@@BB@@A@@@@@@@DF@@@BBB@B@@@B@@@B@BC@@BA@@@@@@@@B@D@B@@A@@@@@AA@@@B@@@B@@AD@BBBBB@B@@BB@B@@@@B@@@@A@A@@B@@ABB@@AB@B@@@@B@B@@AD@@@B@A@@B@B@@@D@B@BA@@@@BA@@@@B@@@B@@@@D@@BB@@@@@B@B@@AAAAA@@A@@@@A@@@@B@@@D@B@BABABC@@DA@@@@AAA@@A@C@@@@@@@A@A@@B@@@@A@A@@@@B@BB@@@@BA@@@A@BB@@@A@BB@B@@@BB@@BD@B@@CDB@@@@B@@A@ABA@@@@@B@@AB@BB@AB@B@@B@@@@@B@@B@@@A@@B@@A@A@A@@B@@B@@@@BA@@AC@AB@@A@A@@@@@B@@@D@D@@B@@@@A@@@A@A@A@AB@@AB@BA@@@@ABD@@@@@@B@@B@@A@@@C@@BB@B@@B@@A@@@C@@@@B@@@BA@@@BB@@@@@B@@@@A@@BBB@@AA@@AB@@@@A@@@@BA@@@BB@@@B@@@@A@A@@B@BB@@@ABA@@@@@A@@@AA@A@BA@@@A@@@@@@@A@@@@@@B@@A@@@@A@@@A@@@@A@A@@@A@@@@AA@@@@BAA@@@@AA@BA@@@ACA@@@A@@@AA@@@@@@@@A@A@@AB@A@@AA@@CA@@AB@@AAA@@@AA@@@A@@@AA@@A@@@@@ABAB@@@@AB@@@BA@@BA@BB@@@@@@A@@@@C@@@@AB@@A@@@@B@@@@B@@BA@@@@@AE@@A@@@@@@B@B@@@@A@@B@B@B@DB@@B@@AA@AA@@@@@AB@@@B@@ABB@@@@B@@@@@BA@@@B@@BA@AB@B@@@@@@@@A@@@@BA@@@@@A@@@A@@@A@A@@BA@@@A@@BA@@B@A@AA@@@@@A@@AAC@@@@@@A@@BA@@BB@@BC@@@@B@B@@@BA@@@@B@@@B@B@@@@AAA@@AA@A@@@@A@@@AC@@@ADB@AB@BAB@@@AA@@B@@@@@@@BA@A@@B@@@B@@ADA@AB@@@@@B@@A@A@@@AB@@AB@@@@@B@@BBA@BB@@@BAAA@CB@@@@@BA@AB@@@AA@A@AA@@@@@@@A@@BA@AB@@@@@@@ABABAB@@AB@@A@@@@@@@A@@A@@@C@A@@@B@@BAB@@@B@@@@@B@B@B@B@@A@@BA@@@A@A@A@@@CA@@@@@A@@@A@@@@@AA@@@A@@B@@@@@@@@@A@@A@@@BCB@@@B@@@B@@AB@@B@@@@@@AB@@@@@B@@@@B@BA@@@A@@@A@A@@BA@@@@B@@@@@@@B@BA@@@@AA@@@A@@B@@@AA@@@AB@@@@A@@@@B@@BBA@@AA@@@@BA@BBB@@BA@AAA@@FA@A@@@@@@AA@@AAB@@@@A@@@A@BB@@B@@@@B@@@BB@@@A@@AA@AA@@@@A@@B@@A@A@@@@@A@@A@@A@@@@@AAB@A@@@@@A@@@AB@@@A@@@CA@B@@A@@@@@A@@BA@@@AB@@@@@@@@@@@@A@A@@B@@@@@@A@@@@@A@@@BA@@@A@@@A@@BB@@@A@@@@@@@A@@@@BA@A@A@@B@@@@@BB@@@@DA@@AA@A@@@@B@B@BB@@DA@@@@@CB@BA@@@@@AACA@@@@A@@@A@A@@AC@ABAAA@@@@@A@@@@@@@A@@C@AA@@@A@A@@@A@@B@@@B@DBBB@@BB@BBBB@@@@@@A@A@A@A@@B@@@B@@@B@BBBD@BBBAB@BB@@@@@DC@@BABAF@@@B@@@B@@B@@@B@B@@ABA@A@AB@@@B@@@@BB@@@@DAFADC@@BB@@@B@@BBB@BABA@@B@B@@@@A@@B@B@@@@@@A@@@A@@A@@A@@AB@@@AA@@@@A@@B@@A@@BA@@B@@@BA@@AA@@B@@@@A@@B@@@@B@@@A@@@@@AB@@B@@@AB@@A@@BA@@@@@A@@@A@@DABAB@@@B@@@@AB@B@BA@@B@BA@@B@@@@B@@@B@@@B@@A@@@@B@@@B@@B@@@@@AB@BB@@BB@@@B@@@@@@ABA@@@@BA@@@B@@B@BB@@B@@@B@B@BA@@B@@@@@@AB@@@B@@@BA@@@@BD@@B@@@B@@@@ADABB@BAB@@BD@B@@ABBB@DBB@B@@@@@@BB@B@B@BA@@BBB@BBB@BB@DB@@@AB@BB@@ABB@@B@@@@@B@@B@@@@@B@BAB@@@B@@@@@BBBA@@@@B@@@@@B@@@@@B@BB@A@@@@@@@AB@@@@@AA@@A@@AB@BBBA@@A@A@@A@@@@B@B@@@AA@A@@@E@@@@BAFC@@@@BA@@AA@@@@@A@@@@A@@@ABAA@@@@@@B@BA@AB@@@@@@@AA@@B@AAA@@@A@BAB@@@@@@@B@AAB@@@B@BA@@@BA@AB@@@@@@@@B@@@@B@@@@@@@@@A@@@@B@@@@@B@@A@@@@BB@B@@@@@A@A@@@@@@BA@@@@@@BA@A@@B@@A@@BABAB@@@@AA@@@@A@@A@@A@@@@@@B@@@@@@@BA@A@@@@@@@A@@BA@@B@@@D@B@@@B@@B@@@@@@B@@@@@@@@@B@B@DBD@@@@BB@@BAB@@@@BB@@@A@@@@@@@AB@B@@@B@@@BAB@@@@B@@D@@@@@@AA@@@@A@@@A@@BB@A@@@@@@CA@@@@@@A@@@@A@@@@@A@A@@@@@@BA@@@@@@@A@@A@ACA@@@@A@@@@BA@A@@BAB@@@@@B@@@@@@A@@B@B@B@AABA@@@B@@@AB@@B@@@@B@@@@@B@@@@A@@@AB@@@BA@BB@@@@AB@@@BAB@B@B@@ABBBAB@@AB@@@@A@A@@@A@@B@@@BDB@@@@@@BBA@@B@@@A@BA@AAC@@A@AA@@@@AC@@@@A@A@@@A@AB@@C@@AA@@@A@@@AB@@@@@AC@A@@A@@AB@@@@AA@@@@@@AA@@A@A@@@B@@AA@@AA@ACAA@A@@@A@@@AAA@@@AA@A@@@@@@CA@@A@@BA@@@@A@AA@A@@@A@@A@@@AA@@@@AA@@A@@@@@AA@@@A@A@@@A@@BABA@@@A@@@@BB@AB@@@@@BA@@B@@@@B@@@@@@AB@@@@@@@@B@@ABAB@BC@@B@B@B@@@@@B@@@@@@@@A@A@A@@DA@@@A@@@A@AAA@@AA@@BA@A@@@AA@@AA@@@AA@@A@@@A@@BA@@@@@@@@A@@C@@@CB@@AAA@A@@@AB@@@@A@@@@AB@@@AA@B@@@AAA@@@@B@@@@AAB@@AA@@@A@A@@A@@BBBA@@@AB@B@B@@@@A@A@@@A@@AB@@@@@@AA@@@@@@A@@B@B@@A@@BA@A@A@@B@BAB@@@D@@@@AB@@A@@B@@@@A@@@A@@AA@@BA@A@@B@@A@A@A@AA@@AAC@@@@@A@A@@BC@@AA@@@C@@@CBA@AA@@A@@BA@@@A@A@A@A@A@AD@@@@@@AB@@@@AAA@@A@@AA@@AB@@@BA@@@AAA@@@AAA@BA@@@@@@@@@@AAAA@@@@AAAAAAAA@@@@@A@@@@AAA@A@@@@A@CA@@ACA@@@@@A@AB@@A@@@A@@A@A@@@A@A@@BCA@@@@A@@C@@AA@@@AA@@A@@AA@@A@@A@@@@@AA@@A@@@@BCA@@@@A@@@AAAA@@@A@@@@AA@A@@AAAA@@A@@A@@BADADADAFC@@@ADC@ADEB@BALCB@B@H@B@DB@@D@D@BBB@DDD@BBBAB@B@DBD@B@B@B@BABABAB@B@B@F@F@DBD@FAH@D@BBB@BA@@DADCDA@AB@D@BABADABCDAFEDEDA@ABABCBCBE@C@A@@AC@A@A@ABCBADCBAB@BAB@@@@AD@BADAB@B@HCFADADAFCHCDAFADCHCDADCDADC@ADABC@ABCBC@C@@@AAE@A@AACCCAA@A@AAC@@AA@ABA@ABA@A@ABA@CBEBC@A@ABABE@A@A@CBC@@BAFEDABAB@B@DAD@FAFAFADAB@BABABCDABCDCBEBA@CBG@AAE@@@AAA@CA@AAAAAACEAAACA@AAA@E@AAGAAA@AA@BA@@BAB@B@BA@@@ABBDB@@B@@ABA@@@A@AB@@@@@@A@A@@@A@@AA@A@@@@CAA@@A@AB@@@@A@@@A@@A@@A@@B@@@@@B@@B@@@@B@AA@A@@@@@ABA@A@@@@DB@@@@@A@@A@A@CA@@A@@AAI@@@AAC@C@CA@@A@@AAAACCCCAAA@A@CAC@C@C@C@A@AB@@@@BBA@@@@@A@@ACB@@@BBB@@B@@@@BA@@B@BBDA@@B@D@@@@C@AAEC@@A@AA@@C@C@A@A@@@AB@@@@C@ABA@@@@@@@@BA@@@A@A@@@A@@@AA@@A@@B@@@@@BA@@@@@A@@@C@@@@@A@@@ABA@AB@@@@AB@D@@@B@@ABA@A@@@@@@@A@AA@B@@A@@AAB@@@AA@@@@@AA@@@@@BA@A@A@@AA@@@A@@@A@A@@@@@A@@@A@AA@@A@AA@CA@@@@@A@@@A@A@A@@@AAA@@@A@AC@@@@@B@@A@@@@@@B@@@@@B@@@@AC@A@@A@@AA@@@@@AA@@@@A@@@@BA@@@@@A@@@ABA@A@@B@AA@CA@B@@AB@A@@@BA@@@@B@@AA@@AB@@@@A@@B@@A@@@@AA@@@A@AB@AA@@@A@@@A@@BAA@AA@B@@@@A@@A@@ABA@@@A@A@@@A@@A@@A@@AC@A@A@@CAA@A@AAAA@@A@@@@@AAAA@@@@@AA@@@@@A@@@A@@AA@@A@@@@CA@@AAA@A@@@C@A@@@A@@@AA@@AA@@@A@A@@AA@A@@A@@@@@A@@@AA@@AA@@AA@@"],encodeOffsets:[[119293,28349]]}},{type:"Feature",id:"361022",properties:{name:"黎川县",cp:[116.907681,27.282333],childNum:1},geometry:{type:"Polygon",coordinates:["@@SGIKOIGCEEA@QCIDMBOHQBIBQESEOAA@OCQEQKIUEKOFGFWHE@MAOBKA@AA@@B@@B@@BB@@@@@@B@@BB@BB@@BBB@B@@BD@D@@@B@@@@@B@@BD@B@B@@@@BB@BBD@B@@BD@@@BBB@@AD@BA@AA@@@B@BABB@@B@@AB@@@B@@A@@B@B@B@B@@@@@@@BAB@@@B@@BBB@@@BB@@@B@@B@BA@@B@B@@@AB@@@@@B@@@B@@BB@BB@BD@BB@@B@@@DCB@DA@@B@@@@@BA@@B@@B@@B@@BBA@@BAB@@@BBB@B@BA@@@AD@BA@@BBB@@@@BB@B@@@B@@@D@@@B@@@BAB@@BB@@B@@B@@@@@BA@@B@@ABB@@@B@B@@@B@@@BA@@@A@@BA@@@ABBB@BA@@B@B@@@@B@@@@BB@BB@@@B@@@@B@@BB@@AB@BA@@BAB@B@B@@@BB@@@@B@@@@AB@BA@@BB@@BB@B@@B@BAB@@BBA@@B@@@BBB@@@B@@@BDD@BB@@B@@ABAB@B@BB@@B@@ABB@AB@@@@A@@@A@@@AB@@@@@D@B@@B@@B@B@BB@B@B@B@@@B@@@@@D@@@@BB@BB@@@B@@@@@BA@@@@@A@@@@@A@@@A@@@A@@B@BA@@B@BA@@@A@@@@@A@@@@@@B@@A@@@A@@@@@@B@@AB@@@B@@@BBB@@@B@@C@@@ABA@ABC@@BA@@B@@A@@@@@BB@@ABCB@@@B@@AB@@@@@B@@A@@BABA@AA@BABA@BB@B@@B@BBBB@@@B@B@@DB@B@@B@@@@B@@B@@@B@@@@@@B@@AB@B@B@@A@@B@@@B@@@@@BA@@@A@@@@@@BA@A@A@@B@@A@A@@@@@A@@@@@@B@@A@@@@B@@BB@BA@@@@B@@BB@B@@B@BBBB@@@@@@@@@BB@@@@@B@@BDB@@@BAB@@A@AB@B@@@@BB@@A@AB@B@@A@A@@@AB@@AB@@@@@B@B@B@B@B@@@@@@A@@BAB@@@B@@@B@@BB@@A@@BAB@B@@A@@@BB@@@B@@@@@@@B@@@BB@@B@@BBB@BB@@B@@@BBBB@@AB@B@@AB@@@B@@@@A@@@@B@B@BAB@@AB@BA@@B@@B@@B@B@@@B@@B@@@@BD@@BB@BB@B@@BA@@B@BB@@BA@@BA@@B@@@B@B@@@@@BAB@@@B@B@@BB@B@@@B@@BB@B@@@@BD@@BB@B@BB@@@BB@@@@BB@@@B@B@@@@@@AB@B@@@@@BA@@@@@@B@@@@BB@BA@@@@@B@@B@@@BB@@B@@A@AB@@@@@B@@@@@@@BB@B@@@@@B@B@@BB@@@B@BAB@@BB@@A@@B@@A@@@A@BB@@@B@@B@@@@B@@AB@@@B@BA@@BBB@D@@@BB@@BB@@@AB@@@B@@@@@B@@@@A@AB@BA@@BAB@@AD@BAB@@@B@B@@@BBB@B@@@@DB@@@@@BB@@@BB@@@@@@@B@B@@A@ABBB@B@B@@@@A@A@@@@@ABABAB@@A@ABCB@@@D@BA@A@@B@@@@@BA@@B@@@@AB@@@BAB@@AB@BAB@@E@@@@@BBBBB@@BA@@@@B@@BB@@@B@@BB@@@@@B@BBB@BB@B@BBB@@@@B@@@@@B@B@@@@BB@B@@B@@@@@@B@B@@B@@@@D@B@B@@@@AB@@A@@@A@A@@@A@A@A@A@C@A@@AA@@A@@A@@@@B@@A@@B@@@@ADA@@DBD@@@@@@ABA@@@ABABB@@B@@@@@B@@BBABB@@B@@B@BBB@@BF@@@BB@@@@@BBB@@@@@B@B@@@@BB@@@BDBBDBB@B@BB@@@B@B@B@B@@BB@@@BB@@@@@BFD@@@B@B@@@B@@B@@@B@@BA@@B@@AA@@@@A@@AA@A@A@@@A@ABABCB@@@B@@B@@@@@@@A@@@A@AB@@A@@@A@A@@@@@AB@@AB@@A@@@@@@@@BA@@B@@@@B@@@@B@@B@B@@@B@@@@B@@B@@@@@ABAB@@@B@BBD@@B@B@B@@B@B@@A@@@AD@@A@CAA@CB@@A@@@@BA@@B@@AB@B@@@B@@@DA@@BA@@B@B@@A@@@@B@@@@AB@@@B@B@@BB@B@@BB@@BA@@B@@@B@@AB@@@@@BB@@ABB@@@BBB@@@@BB@@@@@B@B@@@B@@BB@@@@@BA@@B@BBB@@@B@@BB@@@B@BB@@@@@B@@A@@@AB@B@@@@@@D@B@B@@@@BA@BD@B@BA@A@A@AB@@B@@@BB@@AB@BB@@BDB@@@B@@B@@B@@D@@@@@BB@BBB@BB@@A@@B@B@@@B@@@@@D@B@B@@@@@@A@@@@D@@ABA@@B@@@@@@A@@AA@@B@@B@BB@@@B@@AAA@@@@BAB@@@BA@A@@B@@@@@@@@A@@B@B@B@@@@@@@@B@@@D@@@@B@BB@@@@B@@ABBBBBBB@@@@B@BAD@@BBB@@@@@B@@B@@BB@BB@DD@@@@B@@@BAB@@@BBB@@BB@@AB@@B@B@B@@@@@B@D@BAB@@@B@@BB@@@D@@@BB@@@@B@BAB@B@@@@@B@@@@ABAB@@@@BB@@@@BAB@BEBCBA@A@@DCBABABA@AB@@@B@B@@@BA@@B@B@@B@BBD@BB@@@B@B@B@@@BBB@FBH@B@BA@@B@B@B@@@@@@AB@@@@@B@@@@@@CAA@A@AB@@@@A@A@@@AB@@BB@@@@BAD@B@BB@@BB@@@@A@A@@@@@@BB@@@B@@B@@A@@@@@AB@@B@@@AB@@@@A@@A@AA@@B@@@BB@@@A@@@AB@BC@@B@BB@AB@@@BBD@@@BABA@@@A@AB@@@@@@@@AA@@A@@@@@@@@B@@A@@BA@@BA@@@@@AB@@@@@@@@AA@@AB@B@@@@BB@@@@@DC@@BA@@@@B@@B@@@@BAB@@AB@@@@@@@B@@A@@BBBAB@@@@@BB@@@@@A@@AA@@@@@AB@@@@@@BBB@D@@B@@A@@@@B@@@@A@AB@@A@AB@B@@@B@@AA@@AAA@A@@B@@@B@@@B@@@BA@AB@@@@AB@@@D@B@@A@@@@A@@A@@@@B@@@@@@B@@@BB@@A@@@A@@@@B@@@@@@@ABBB@@@@B@@@@@@AB@@@@@B@@A@AB@@A@A@@@A@A@@@A@@@@BB@B@B@@B@@@AB@@AB@@@@@@B@@@B@@@@B@@BB@@@BBB@@@@BA@AB@AC@E@@AA@A@AB@BA@@@A@ABC@A@A@@BCB@@@B@@ABAAA@A@@@AAA@A@A@@B@BA@@@@BA@AB@B@@@BA@A@AB@@AB@B@@@B@@A@@@A@A@A@@@ABA@@@@B@B@B@BBBBB@@@D@B@B@B@B@B@B@HDB@BBB@B@@@@B@BAB@@@@@D@B@@A@@@@@BB@@@B@@DBFD@@BBFDDDB@BBB@B@B@B@@B@B@@BD@@B@D@B@@@@B@BBB@DB@AB@BA@@@@B@B@@@@A@@BBB@B@BABBDA@A@CB@@A@B@@B@@@BAB@@@@BB@@@@@@@@@B@@@@@BA@@B@@B@@@@BA@@@AB@B@BA@@@A@@@ABA@@@A@A@AA@@E@ABA@@@A@@@A@@@@B@@ABAB@@B@@@@@BA@@B@@@@@@@@B@B@@@@@@@@B@@BB@B@B@B@@@BA@@@B@@@B@@BBA@@B@@BB@B@B@BBB@F@@@BABB@@B@ABB@@@@@BABABAB@@@B@@BB@@@BB@@@@@@ABAAA@@B@B@@BBB@B@@DB@@B@@@@BA@@BA@A@A@@@@@@D@B@B@@C@AB@@@@BB@@@D@B@BA@@BA@@@@@@B@@@BB@@@@BA@@BBFABB@@B@@@B@@@F@BB@BB@BBB@@@@@BABB@@B@@FB@@DBD@DBB@@@BDB@@BBBB@H@D@B@DBB@DBDBB@BB@@D@@@B@FBB@@BBB@@@D@D@B@@BB@@D@BBB@BBB@@BB@@@@@AD@BB@BB@BB@DBD@@@B@B@@@@@B@FBBBB@BBB@DBDDB@@BB@@BBF@@@BBB@@B@@B@@@BBB@D@BBD@BBBBB@@FAB@B@B@FB@BB@@B@B@D@@@@BBB@BADAB@B@BBD@@@@@@BBB@F@BBBBB@@BBB@@B@BB@@@@@B@D@DAFA@@B@@B@@CF@BAB@B@BCBABABABA@A@@@@B@@B@DBBBB@DA@@B@DDD@@BB@@B@@B@DB@@DBB@B@D@BABEBCBADBDBDDDDBFBBBDFDDBDBBDBDBD@BBBD@@@DBDABABADCDEBC@A@A@CAAB@BA@A@A@AB@@ABAFADAD@DEBABE@I@GAG@E@I@EBC@A@@BADABCBC@EBCBA@@B@B@BCBA@AC@A@@@@ACCAACC@AAC@A@A@EDCDADCBA@CAAACAC@CDC@A@@@AAE@CDCDCDAFCB@D@DBB@BA@@@A@ABE@K@E@C@A@A@@@AB@BA@A@@@AB@BCBABA@@BAAEAEAGACAC@EACCCACCCEAECEAAAKCAC@A@E@A@C@C@ACAA@A@AACA@A@CBADEBCBC@CAC@AAC@@AA@ABCBE@E@EACAE@EACBE@@DE@@DEDAFE@@@@B@@@B@BBDBDADAJCDAFAB@D@D@B@B@D@B@@@B@B@@@@@BA@ABC@EACAECAAC@CAC@AB@@@LADAFB@@D@@@F@H@@@BABEDEDCHAH@DCAEAA@CAEACAA@A@ABA@@BAAA@@AAA@@C@A@EA@ACAAE@ABCAA@AAAC@AAC@G@CEECCAA@CBCBAAA@ACACCEAACACACCACCCCCAA@CAACACAAAAA@A@CA@@@@AEAEACAACAEACAEAC@CB@BADA@C@ACA@CCEKEGAEACAEAAACAAA@AACACACACAA@CACACCCEAAGGACACAE@@@CCACCEACAAAACAA@A@CAA@C@@AC@E@GB@BIBIFGBC@IBIBEBC@A@A@@@ACAEACAA@@@A@IEMIIAOCOAOGGASK@@GCKGA@KEMGQQQUCGAACMIQGEQZIBGBIBG@KASAGC"],encodeOffsets:[[119696,27680]]}},{type:"Feature",id:"361023",properties:{name:"南丰县",cp:[116.525725,27.218444],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@GIMEUUIIKKGACCCAA@ABA@AB@@@BA@@@A@@@AB@@C@ABA@@@A@A@A@@BA@@@EBA@A@@@A@@@A@@DA@@@BBA@BB@@@@BB@@@BA@@AA@@BA@A@@B@@C@@BA@BBAB@@AB@B@@C@@@@@@@@B@BA@@@@B@@A@@@A@@@@A@BA@@@ABA@@B@@@B@@@@@B@@A@@B@@A@A@AAA@@@@@A@@@A@A@A@ABAB@@ABA@A@AB@@@BB@@@@BB@@BBB@B@@@B@@B@BB@@@BB@@@@@BB@@@BB@@@@B@@@B@B@@@@A@AB@@A@A@@@ABA@@@A@@@CB@@ABAD@@@@@BB@DB@@A@@BBB@@A@@@A@@@@@@B@@@B@@@@@DBB@@BBBB@@BB@D@@B@@BB@@BB@@B@@A@@BABA@AB@@AB@@@@@D@BBBB@@D@BBBABBB@@@B@B@@AB@BA@A@@@A@@B@B@BAB@@@BA@@B@D@B@@@B@@@@@BB@BB@@@B@@@@BB@B@@BB@B@@@B@@@BBBBB@@BBB@B@@B@@ADBBA@@B@B@BABA@@B@@@@BB@B@@@B@@A@A@A@@@CBA@A@A@@@A@@@A@@@A@A@AA@@A@BA@@@@@A@@A@@A@@@AB@@@@@@@BAA@BC@@AA@@B@@A@@@@@@BA@@@A@@@@@A@@@@A@@A@@@@@AA@@@AB@AABA@@@@@AB@@ECCAA@A@A@@@@A@@A@@@@@@B@B@@@@A@@@AB@B@@BB@BBB@B@@@B@@@@@B@@@@@B@@@BA@A@AB@@@@AB@@A@@@@@AC@AA@@A@@@A@@A@A@@@AAA@@B@@A@AB@@A@@@@@AB@@@BA@@B@B@@AAAA@@A@A@AC@@@@CA@@@@@A@@A@@A@@A@@@@AAA@@@AAA@@AAABAA@@@@AAA@@AA@@BA@@BBBAB@@@B@BA@@BA@@AA@AA@@@A@A@@@@CAA@AA@@AA@AABA@A@@AAAA@@A@@A@@@C@@@A@@@@@@@AA@@@@A@A@AB@B@@@@B@ABB@@@A@@BA@@@C@@A@A@@A@A@AA@@@@A@AAA@A@A@ABA@A@A@A@@B@B@@A@@AA@A@A@@@A@@BA@AA@@A@A@@@ABA@@@A@@A@@@A@@A@A@@@A@A@A@@@A@A@@@@@AA@@AA@@@@AA@@A@C@CB@@A@@BA@@@@@A@@@@@@@AB@@AB@@A@@@A@@AA@@@@A@@@@A@A@@@@@@@@@AAA@@B@@A@@@@@AB@B@B@@BB@@@BA@ABAD@B@@AF@BA@@@@B@@@BA@@@@@@BA@@@C@A@@@@@A@@@A@AB@DAB@@@@BB@@AB@@AB@@BB@BA@@@A@CAA@A@@@A@@@C@ABA@A@AA@AAA@A@A@A@@AA@@@AA@@A@A@A@@@AAA@A@A@A@@A@@@A@@@A@@@@@@AA@@A@@ABA@A@@A@@A@@B@@A@A@A@A@@BA@A@A@A@@@@AA@A@@CAA@@@@A@@AA@A@@@@@A@A@A@@A@A@@@@A@@@A@A@@@@A@A@@@@@A@@@@@AA@A@@@@@@@A@@AA@@@AA@@AB@@A@A@@A@@@@@@@AD@@@@@@@@A@@A@@A@A@@@@A@@B@@ABAB@@A@@B@B@BA@@@@@@@AA@@@ACBAAA@@@@AA@B@@AC@@A@@@A@@@A@@@A@A@@B@@@@@@A@@@A@@@@B@@AA@AA@@ABAACBC@AA@@AA@@A@@@@B@@@BA@@@@@AAA@@@A@@@AA@@A@A@A@@A@AA@AAA@CAA@A@@@A@A@AA@@AA@@AA@@A@A@A@A@@@@A@AAA@@AA@A@@C@@@AA@@@@@AB@@A@AAA@@BA@@@A@@BA@@@@@A@A@@@AB@@@@@AA@@@@@A@@@@A@@@AC@@A@@@@@AB@@@@AA@@@A@@@BA@@@@AA@A@@@@@@@A@A@AA@B@@@BA@A@@BA@@BAAC@CBA@@@@B@B@BABAD@@@DBB@D@@@D@@@@@B@@@B@B@D@B@B@B@B@B@@A@@@A@@BCBAB@@A@@@A@@AA@@BA@CBAB@B@B@@BB@BB@@B@@B@@B@@@@AD@BBB@@@@@@@BB@B@B@@B@@B@@BD@BBB@BB@@B@@BB@@BBB@@@@BA@@B@BB@@@@@B@BADAD@@@BAA@@A@@@A@@@@@A@AAA@AAA@A@A@@@A@A@@@A@AB@@@B@@A@A@A@A@@@AAA@@@A@A@@@A@A@AA@@AA@@E@A@AAAACAC@@AA@AAA@@B@@@@A@@@A@AAA@EB@@AA@@AC@@AA@A@A@@C@E@A@@@A@@B@@@B@B@B@@@BB@@B@@@@B@BA@@B@D@@@B@@BB@@@@BB@B@BBBBDBBB@@AB@@@BA@A@A@AB@B@@AB@@A@A@@@AAE@@@A@C@A@EAAA@@@A@A@@CCAEAA@A@@BA@A@@AAA@@A@@@@@ABA@@@@@A@@@@@ABCBA@@BA@@B@@@@@BA@@A@AA@A@A@A@@AB@@@BA@@@A@@@@A@@@@A@AA@B@@@BAA@B@@@B@@@@A@@@@B@@@B@@AB@@@B@@@@@BBB@@@@ABA@@@A@@BA@C@E@@BA@@BA@AA@@@@A@A@A@ABA@@@A@AB@@ABADAB@B@AA@@@AA@AA@A@@@AA@A@@@@C@@BA@A@A@@B@BBB@@@B@@@@@B@B@BAB@B@@@BBBBBBB@@@@@B@@@@@D@B@@@@@B@@@@@B@@@BAB@@BB@@BB@@BAD@DBBB@@DBBBB@B@@@B@BBB@@@@@@BB@@B@@B@@BB@@B@@@DABAB@@@BB@BBBDBB@@@DB@@B@@BBBBB@@BB@@@B@B@@B@@@BBB@@BB@B@@@B@@B@BB@@@@@BBBBB@B@B@B@@@@AB@B@B@@@@@@@B@@@B@@BB@D@BBB@BBB@@@B@B@B@@@BAB@B@@@@@BAB@@@B@@AB@B@@@BAD@@AB@BB@B@@B@@@@@B@@BB@@@DBB@@AB@B@@@B@@A@@@@BAB@BA@A@ABA@A@@BAB@@BBB@@@B@@@@BB@@@@B@@@@B@@@BBBA@BBA@@@@D@B@@BB@B@B@B@@@@BB@@BBB@@D@BB@@DB@@B@@@@@BB@BB@B@@@@@@BB@B@@@@@@@BB@@AB@@@@B@@@AB@@@D@@@@B@DDB@B@BBBB@B@@B@@@@AB@@BB@@@@B@@BBBB@@@@@BB@@BB@@AB@BB@@BABBB@@@@@B@B@@AB@@B@@@@@B@@@@@@@D@@BF@@@@@D@B@@@BB@@@AB@BB@@BB@BB@@BB@B@@BB@@@B@@@B@@@@@B@@@B@@@@AB@B@B@@@B@@@B@B@@@BBB@BB@BBD@@@AB@@@BDB@@@@@BB@@BB@@AB@@B@@@@BB@BB@@BB@@B@@@@BB@@@@B@@@BBB@@A@@B@@@@@B@BBDB@@BABAB@@A@@B@@@@@B@BD@@@@@BBB@B@@DABB@@@@AB@@A@AB@@A@@@@@@BA@@@A@@BBD@B@D@BA@AD@@@@AB@@@@A@@@A@@DA@@@@B@B@B@@B@@B@@B@@@@@B@@BB@BDBBBDB@BB@@@@@B@BBB@B@@@B@@BB@B@@BDBB@@@B@B@B@@ABAB@@BB@@BBDB@@@BB@@@@@B@B@@BB@BDB@@@B@FH@@B@@BA@@@@B@@B@@BB@@B@@BBB@@@@B@@@@BB@@B@BA@@@@BBB@BA@@B@@BDDBBBB@@@BDD@BFDD@@BB@@@DBB@@BA@@B@BBB@B@@@BBBBBBBFF@@BBB@BBB@BBB@D@@B@@BB@@@B@@@@BBBBDBB@@BB@B@BBD@@BB@@@B@@@@@@@BB@AB@@BB@B@BBB@BBB@@@B@B@@@B@BA@@B@BA@BB@BAB@B@@A@A@@@@BA@@B@DA@AF@B@@BB@B@@BD@BBBBBBB@D@@@BBB@@@BA@@@BD@BBB@@@BBBBB@@BBBBB@@B@@@B@@BBBBB@@BBBBB@@B@@@@B@B@B@B@BB@@B@@AA@BA@@B@@@@@@BB@@@@BBBBBB@B@@BDBB@@@BAB@@@B@BBB@B@BBDABB@@BAD@F@D@@@D@@@B@B@@B@@@B@DB@@D@@@@@B@@@@@B@B@BA@@H@@@@@B@@@@B@@@B@@@B@BB@@@@@BBB@@@@@@@BBBDB@B@@@B@@BB@B@B@@@B@@@B@D@@@B@BA@AB@@@B@BB@@B@B@@@B@D@BBBBB@@@B@BAB@BAB@@AB@@A@@B@B@BABA@@@@@AB@@A@A@AB@@@D@B@@@DA@@B@@@B@B@@@@A@@B@@@BAB@@A@@B@@@@A@@@@@ABBDB@@BAB@@@@@BA@@@@DA@@DCB@@AA@@@@AB@@@B@@@B@B@BBB@@@B@@A@@B@B@B@B@@@@@B@@AB@BA@@BBD@@@@B@@B@@AB@B@@@B@@A@A@@@@@C@A@A@A@A@@@ABC@ABA@A@@B@B@BBB@@@@ABA@@D@B@B@B@@B@@BB@@@@B@B@@A@@@A@@B@@BB@@@@@@@BAB@@@@B@@@@BBBBBD@@@@AB@BA@@B@@@@BB@BBBB@BB@@BAB@@@BB@@@B@@@B@@AB@@B@@@DAB@DAD@@BB@B@@BB@@@B@@A@@@@BABABA@AB@@@@BB@@BBBB@BAB@B@@A@@D@@@BB@@B@B@@B@@@B@@@@@@@B@@@@@@BB@@B@@@BB@@BBB@@@B@@@F@@A@AB@@@D@@BBA@@D@BA@A@@D@B@@B@@@@DBFD@@AD@D@@BBBBDDFD@B@@@D@@@DAB@BCBADABABA@@BABABCBABCD@BAB@@DJ@@B@HFFD@@BB@B@B@BBB@@@D@@BA@@@BB@@@BB@@FBFA@@BA@@BA@@@AB@BA@A@@BA@A@@DA@@B@B@@A@@AA@@BA@AB@@A@AB@BC@AB@@@B@B@BAB@@@@A@@@A@AB@@@B@BBB@@@DCB@B@@@BABA@@BA@@@@@A@@@AB@@@B@@AA@@A@@B@B@@@D@@@@A@@AA@@@@B@@AB@@A@@@@@@@CBA@CB@@AB@@@B@B@BA@@@@B@@@B@@@@AB@B@@A@@@@BA@@B@@@@@B@@@B@B@BA@@B@@@D@FBBB@@@@B@@@B@@@B@BBB@@BB@BBB@DB@@DDBBB@@BB@@@@B@BA@@@@BB@BB@@@@@BBBBB@@@B@@A@@@@BB@@B@BB@@@B@B@@@B@@@@@@BB@DBB@@@B@@A@@BABB@@@@@AB@BAB@@ABBB@@@B@BBBBBBB@BBD@B@@B@B@BB@@B@@DB@@@D@B@BBBD@BB@@BB@@D@B@@@@B@B@@@@@@@B@B@@@B@@@@@@@BB@@@@@B@B@@BBBB@@@B@@@@@@BBBB@@@@D@@B@@BB@D@@@BA@@B@F@B@@@B@B@@@B@@@@@BADB@@DAB@B@BADA@@@A@@BA@@BA@@B@@BD@BA@@@CBA@@AA@@BA@@B@BAB@B@@BB@@@B@@@BB@@B@BB@@B@@@BA@ABABA@AB@@@BA@A@A@@@@@A@@BAB@BA@@@BB@BA@@FA@@B@@@@@@A@@AA@@@@@A@A@@@@AA@A@@@@AA@@E@@AA@AAA@@@@AA@BAAA@@@A@@@@@AA@BABA@@B@BA@@@@@@AC@CB@BC@@@@@AB@@@@A@@B@@@@BB@@BB@D@B@B@B@B@@@B@B@@@B@@@BA@@@@@A@A@C@@A@@@@A@A@@@@A@@@@AAA@@@@@A@A@@@@@A@@A@AAA@A@@AAA@A@A@@@@AA@@@A@@AA@@@A@@B@@AA@AAAA@@@@F@@@BA@ABA@@BA@A@@BA@@@@@AB@@A@@@@@AB@B@@A@C@@DABAB@@@BABABA@@@@B@B@@@@@@A@AAABAB@@@@A@A@@@@@@AA@@A@@A@@@@CA@@@@@AAA@A@@@A@A@@BA@ABC@@BA@AB@@ABAB@@@@@@A@@@@@A@@BA@@A@@AA@@A@@@CAA@AB@@A@A@@BA@@@A@@A@@@@A@@AAB@@@B@@@@AB@@@AA@@BA@A@A@@AA@@@A@A@@@@@AAA@@@@@@A@@@@@A@@B@BA@@@AA@@A@@@@A@@@@ABA@@A@@A@@@@@@@AB@@@@A@A@@B@@@@A@A@@@A@@A@@A@@A@@AAA@A@@AC@@A@@A@A@@AA@@@A@A@@AA@A@@@A@AB@@@@A@A@@@A@@@AB@@AB@@AAA@@@AB@@@AAAA@@AC@@A@@A@@@@A@@@A@AA@@@@AB@@ABA@@BA@A@A@A@@B@@@@@@A@@BA@@@ABA@@AAAA@@A@@@AAA@AA@@@AA@@A@@@A@@@@@@@A@@AA@@B@@@@ABA@AB@@@AA@@@A@@@A@@BA@AB@@@@@@@@A@A@A@A@A@@@@BA@@BA@@B@B@@@@ABAB@@@AA@@@@@ABAB@@@BA@A@@CA@AA@@@@@A@@A@@@@@@@@AAAAA@@@@AAA@@@A@@B@@AAA@@@A@@B@@@@A@@@@B@@@@@B@B@@@@AB@B@B@@A@@@@B@@@B@@A@@@@@A@@@AB@@@@A@ABA@@@A@@@@A@@@A@@@@A@@A@@@@ACA@@@A@A@@AAAAA@@@@AAAB@BA@ABBB@BA@AB@@@@A@@@@BA@@@A@@DABA@@AA@@@@B@@@@AB@@AD@BAB@BA@@D@@@@A@@AA@A@@@A@@BA@@@A@@@@B@@@B@@@@A@@@@B@@@@@B@@@B@@A@AB@@A@AB@@@B@@@B@@@@@B@@@@@B@@A@@@@@A@@AAA@@A@@C@@@@@A@@@A@A@A@A@@A@A@AA@@@@A@C@@@@BA@@B@@@B@@@@@BAA@BA@@@AA@@A@ABABA@@@AA@@ACC@A@@@A@@AA@A@@@AB@AA@@BA@A@AA@A@@AA@@AB@@ABA@@@@@A@@A@@A@@@A@ABA@AB@@ABA@@AA@@@A@@A@@@A@@AAA@@@@@A@@A@A@@@ABA@AA@B@@AB@@@B@@AB@@A@@@A@A@@@A@BA@@@AB@@A@@@@@AA@@@AA@@BA@A@@@A@@@C@@@A@@@AAA@@@@AA@AB@@ABC@@B@@A@AAA@A@@BA@AB@AA@@@AA@@@@AB@@A@@@@@AB@@CDA@C@@@AA@@AACA@@AAA@@@A@@@A@@@@BA@@A@A@@@ABA@@@@A@@AA@@A@AA@@@A@@BA@A@@@@@@@A@A@A@AB@@@@A@@BA@@@AA@BA@A@A@@BBB@@ABC@@AA@A@@AC@@@AAC@AAA@@@@@A@AAC@@@A@@@@@A@@@CAC@@@AAA@AA@@AAA@@@A@@@@A@@AA@@@@AB@E@EACAOEQKMEKGIIQMCEIIGIAAIIECMOMSIIEKIMWcAA"],encodeOffsets:[[119400,27534]]}},{type:"Feature",id:"361024",properties:{name:"崇仁县",cp:[116.07626,27.754466],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@AA@@@@A@A@A@@AAAA@@AA@A@@@CB@@A@@@@@@A@@@AA@@A@@AAA@A@@@@AAB@BAB@B@@@B@B@BBB@B@@@@@@AB@@B@@@@B@@A@AA@@A@A@@@A@A@ABA@A@CDCB@@A@CA@@@BA@@@A@@@AA@BA@A@A@A@A@@A@@AA@@@@CBA@@AA@@@A@@@@AA@@@@@@B@D@B@@A@@B@B@B@@@BA@A@@@A@A@A@A@@@@@CBAAA@@AC@A@AA@@@@AC@AA@@AA@AA@@A@@A@@A@A@A@@@@BABCD@@A@@@A@CA@@A@AACAA@@@@@@@A@@@A@@@EDC@AD@B@@@@@@A@@@@@A@CA@@CBA@AB@BA@@@AB@@@@A@@@AA@@@@CD@B@@A@@B@BAB@@BB@@@@@B@@@B@@A@A@A@AB@@@@@@A@AB@@A@@B@@@B@@ABA@A@@B@@AB@@AB@BA@@@@BA@@B@BAB@@@@C@A@AAAA@@BA@A@A@@@A@@@A@@@A@@@C@@@@@A@@@A@@AAA@@@@@EBC@ABA@A@A@CBABA@@BA@A@@@@@AB@B@@@@@BA@@BA@@@A@@@AB@@@@AB@@A@A@A@@@@B@@AB@@AB@B@BBB@B@@BB@@@B@@AFAB@BA@@BBB@BAD@BAB@@@B@@BB@@AB@@A@A@@@AA@@A@A@@B@B@@@@@B@@@@@B@@@B@@@@AB@@@DA@B@AB@B@B@BBB@B@@ABAB@@AB@D@@AB@BCF@@@B@B@B@@ABBB@@@B@B@@@@BD@@@@BB@@BD@@BB@@DBB@BB@@BBBBBBB@@B@@BB@BB@@B@@B@@B@B@@@@BB@@B@BBB@AB@@@BB@@B@@B@@B@@@BB@@@@@@BBB@@@@B@@@@@BABB@A@@@@B@B@@BDBD@@BB@@@@@B@@@B@@@BBB@@BB@@@@@@B@@@D@BBB@@@@AB@@@B@B@BB@@F@BBBB@@B@@B@@B@@@BCB@@A@@B@@AB@B@@@@@B@BA@@B@@@B@B@@@B@BA@ABCD@BA@B@BD@B@B@B@D@@@@BB@@BDB@@@@B@B@BABB@@B@@@@@@@B@@@B@@AB@@@@@B@@@D@@BB@@@B@@A@A@A@@B@BAB@D@B@@B@BB@@@B@@AB@@@B@@@@BBBB@@D@B@B@B@B@@BBBB@@BBB@B@@AD@@@@@@@@@B@@@@@BB@@DBB@@@BBB@@@B@BBBB@AB@@ADBBA@@@@@A@ABA@@@@DBB@@@@@@@@AB@@BB@B@B@B@B@B@B@@B@@B@@@@@B@D@@@B@@@BAB@B@B@@@B@@AB@B@@A@A@@@@@AB@@AB@@@AAA@@CAA@@@A@@@@@A@@AA@@B@@A@@@A@@B@@A@A@@@A@@@AB@B@B@@A@A@@BAD@@@BC@@@@B@D@@@@@BAAC@@@@@@@@@A@@@@@A@@@A@@@ABA@A@A@@@@@A@@B@@@@AAA@@@ABA@@BAB@@ABABAB@B@BABA@@@@@@BA@@@@B@BA@@B@B@@@B@@@@@B@BABA@@B@@@BA@@B@@ABDB@@@B@@@@@AB@@BB@@@@BA@@@ABAB@@@B@@BBB@@B@@BF@B@B@@AB@@ACCAAA@@@@@@C@@@@BA@@D@D@B@BAB@@@@@B@@@@AB@B@B@@A@@B@@AB@B@@@B@@A@A@CDA@@@A@@@@BA@@@@@AA@@AA@@@@AB@@A@@A@@@AAA@@CAAAA@AB@@A@ACA@A@AAAA@AAC@@B@@A@@@@@AAA@@@@@A@@AA@A@@@A@A@@CCA@A@AA@@A@@A@@@A@C@C@@@A@A@@@@AA@@CACA@@@@@C@@CC@@@AA@C@@ACAAAA@A@A@@@ABCD@@A@ABA@A@@@ABABA@@BAB@@@@@B@@@@@B@@A@A@AB@B@@@@A@@@C@@B@@@B@@@@@B@@@@@B@@AB@@A@@B@D@@@BA@@B@B@@ABAB@@@B@@@@@B@B@@@B@@@BAD@BABAB@BA@C@AB@@@BA@@@ABA@@@@BA@@@@@A@@@A@@BA@@B@@AB@@@B@@@D@@@B@@B@@@B@@@@@BBB@BB@@B@@@B@BB@@@BA@BB@@@B@B@B@@B@@B@@@B@BB@@@AB@BA@BB@@BBB@BBBDBBB@BB@@B@@B@B@@@BBB@@@D@BBBDB@@B@B@@@@@B@@@@@@B@@@@ABBDA@@BAB@@@B@B@@B@@@@BB@B@BB@@@B@B@@@BB@@@@@@B@@@B@@B@AB@@BB@@@@@@B@@B@@@@A@@B@@AB@@A@A@@@A@@B@@@@AB@BA@@B@@@B@@DB@BB@@@@BB@@BB@@B@BABBB@@@B@@BB@@B@@BB@B@@@@BBB@@@B@@BB@@@@@B@@@B@@B@@BB@@@@@AB@@@@@B@@@@@B@@AB@@BBB@@B@B@@@B@@@B@@B@@B@@@@@BBBBB@B@@@@@B@@@BB@@B@BA@@@BDBB@BAB@@@B@@@BBB@@@B@BB@A@AD@B@@@B@@@@AB@@B@@B@@@B@@@@A@@B@@B@@B@@AB@@@BA@@BB@ADAB@@@@@BA@@BB@@B@@B@@@B@@@@B@BA@C@@@A@BB@B@@@BAB@@B@@BA@@BBB@B@B@BB@@BBB@BBBBB@F@B@B@@A@@B@@@BB@AB@@@@@@@B@@@@@BBBBB@@B@@B@@B@D@D@B@@AB@D@BAB@B@B@B@@@BAB@@BBB@@B@B@BAB@@BBB@@B@B@@BB@BB@@@B@@BB@@@@B@@@BBBBB@@@B@@BB@@@@@B@BBB@@BBBAB@@B@@D@@B@@@BBB@@@@@A@@B@B@@@@@BA@BD@@B@@@@@@B@@@BB@@B@@@B@@ADB@@@@@@@BBBB@@@B@@@@@@BB@@@@BA@@BAB@@AB@@@@@@BBAB@@@BAB@B@@@B@B@@BBBBB@@@ABB@@BB@BB@@@@@B@@@B@D@B@B@BBB@BBB@@@B@@@@BBBB@BB@@@BB@BBB@@@@@@BD@DBB@FA@@@@BABAB@BBB@B@@@B@B@DAD@@@B@@BB@@B@@B@@@BB@@B@@B@@B@@@B@B@BAB@BBB@B@BADBB@@@B@B@B@B@@@B@@BB@BA@@B@@@@BBD@@B@B@@@@BB@@@B@BA@@@B@@@@@B@@B@B@@B@@B@BABAB@@@@@B@@@@AD@@@@@B@B@@@D@@B@@B@BB@@B@B@B@B@B@B@DBD@B@DBB@D@DB@@@@@@BB@@@B@@@@@BB@@B@@@@@BB@BBBBB@@B@B@@BB@@@@BB@@@B@@@B@@BBBBB@@B@@B@BBDD@@BBBBBB@@BBD@BBDA@@@@B@B@@@B@BB@A@@B@@@B@@@@B@BF@B@BB@D@@@@@D@D@@B@D@@BB@@@B@@@BA@@@AD@DA@BD@B@@@B@B@B@BB@@BA@@B@@@BA@@B@@AD@@@B@@@B@@AB@BA@@BA@@B@B@@A@@DADA@A@@AA@@@@@@@A@@@@A@B@@A@@@AB@B@@C@A@@@ABB@@@@D@@@B@BA@A@A@@BA@@@@A@@AB@@@@B@@B@@@BA@A@@AA@@BE@EBA@@AA@ABE@@@@@@A@@@A@AA@@AAB@@@DCDA@@B@@C@C@A@@@ACAA@@A@@@@A@AAA@@@A@A@@@@@A@A@A@@@ABC@AB@@@@@B@@AB@@CB@@@B@@A@@A@@ACA@@AB@@AB@@AB@DAB@@@DC@@BA@ABABABADABABABAFABAB@@@B@@A@@@@@AB@@A@@B@@A@@A@@@@A@@@A@@A@@@@C@@@@@AA@@A@@@A@A@@D@BADA@ABA@@@A@A@@AAC@BA@AB@BA@@BA@@@@DBB@B@@AB@@BB@@@BBLPBB@B@BBD@BBH@B@B@B@BB@@BBBBBA@AB@@@@@F@B@BDBB@@@BBB@@@BAB@B@D@@@BB@@@BA@@B@BBB@@BB@@@@@DABAB@B@@B@B@BB@B@@DFB@BB@@@D@D@B@@B@BAB@DAF@B@@AB@@B@@D@@@B@B@BBB@@BBBB@@BB@@@B@B@@@B@@D@@@BA@@@@B@BA@A@@@@BB@@B@B@@@@@B@B@@BB@B@@B@BB@@DAD@DAB@@@@BB@@@@@@@B@BB@@BBBB@@@B@@@@@@BB@@@D@@A@@B@@B@@BA@@@AB@BBB@B@B@@@BB@BBBB@@@@@@B@BAB@BA@@@@@@BA@@@@B@@BB@@@B@A@@BB@@@@ABA@@@@B@@@@@B@@@B@D@DAFBDA@@@BBB@BB@B@@BBBB@B@B@D@BBB@@@@ABC@@BAB@BABA@AB@@CB@@ABM@AAA@@@AB@@A@A@@@AA@@@@A@@@A@A@A@@@A@@@@@CB@AA@CBED@BAB@F@BADAB@BADAB@BAF@B@D@B@B@B@@ABA@@@A@@BABADA@@DCBAB@BE@ABA@@A@@A@@AA@AA@@AB@@@BA@@AA@@BC@@@@A@@@@A@@@A@A@@AA@@C@AAA@AAA@@@BAAA@AAABEAABA@A@A@A@A@C@@@@DC@@B@BADA@AB@B@D@FADA@@B@FAB@B@B@D@BBB@@@@AA@ACAAAA@AAA@AAC@A@A@AAA@CBA@@@@@A@ABC@AAC@A@AC@AAAA@AA@@A@A@ABA@@B@@AB@FEBADA@@@AFADA@AB@B@@AB@BABA@AAACE@AEECCAAAAC@A@@@A@@@AB@A@@A@@@@@A@@@A@@@@@@A@A@@AA@@@@@@AB@@A@A@A@@@@@@B@@AB@B@@AB@B@B@BAB@@@@A@A@@@C@C@A@AA@@A@A@AA@@AAAACAA@@@@A@ABABABC@@@AB@@@BB@@@AB@@A@@@A@A@@@ABA@AB@@A@@BA@A@@@A@@AC@A@@@A@@@AAA@A@@AA@@AA@@@AA@CA@@@@@A@@@@@A@@@@@A@A@EAA@AB@@A@@@A@@@@ABA@@@C@CCAAAA@@@@@ABC@@@A@@@AAA@ABC@@AA@@@A@@A@@A@@@@@A@C@@@@BA@@@AB@@@@@AACA@@@A@@B@@@A@@A@@AA@A@@B@@@@@BAB@@@B@B@DA@@B@@A@@@A@A@@@A@@@A@@@@@A@@@CA@@A@AB@@ADEBA@@@@B@@@B@@ABA@@@@B@@@BB@@BA@A@A@A@@@@@A@@@@@@@ABA@@@@@@B@@@@ADCBA@@D@@@FAD@@@B@@@@@BA@@B@A@@A@@B@B@@@B@@@@@@A@@AA@A@@@@A@A@A@@@@@ACA@@@@@@AAA@@A@@@@@A@@@@B@B@BAB@@@@@@AA@@CAA@@@CA@@AA@@E@@A@@@A@@A@@A@@A@@AB@@@@@@@A@@A@@@@@@@A@@AA@@@@A@B@@AB@@@@A@@AAA@@@@@AA@@B@@@@@@@@@@@B@@AA@@EBA@@DCB@@@@ABA@@@ABAB@B@B@@@B@@B@@B@@@BAB@@AB@BA@@@@CA@@@A@@@A@@@A@AB@@@B@@@AAAA@@@A@A@A@A@A@A@@@AA@AA@@AB@@ABA@@@@@@@@AB@@A@@@@A@@BA@@@@@@AA@AB@@@@A@@A@A@@@@@A@@@AAACA@@A@@@@@A@@@@@A@@@A@@@A@@@A@@@@B@@@@A@@@@@@@@@A@@AC@@AA@@A@@@@@A@@B@@ABA@@@A@@@@A@AAA@@@@A@@@@B@@A@@@A@@@B@@@A@@@@@@@A@@CA@@@@BA@@@A@AB@B@BABAB@B@@@@A@AB@@AB@@@@@BB@D@B@@B@B@@@BB@@BA@@B@B@BA@@@@@@B@@B@@BA@@@A@@@@B@B@@A@@@A@@@@@A@A@@BA@A@@BA@A@@DABABA@AA@@A@@@@AACA@AA@A@@@CAA@A@@@@AA@A@A@@@@@@A@E@@@ABAAAAA@@A@@AA@@@@A@@@A@@AA@@@A@@A@@@A@@AB@@AA@@@@AA@@A@@@@AA@ACB@@@A@@A@@AA@A@A@@@A@AB@@@@A@A@@@@@@BC@AA@@@@AB@@A@A@@BAB@@@@A@@@A@A@@@@B@@A@@AAA@@@@@@CAAC@@CBAB@@@@ABA@C@@@@@CBC@CBA@A@@B@@@@A@@@BAA@@@@@ABA@A@@BA@@@A@@@ABA@AB@@@BA@@@@@BB@@AB@@@D@@A@@@@DA@@@@@A@A@@@CB@@A@@@C@A@@@@@AAB@AA@@@@C@B@ABAB@BAB@B@@@B@@@@GBA@@@A@ABA@A@A@@@@AAA@A@ABA@A@A@@AA@@@@@A@@@@A@@@@A@@A@@@@AA@@C@@@A@@@@AA@@A@@A@A@@@A@@AA@@A@AAAC@@AAAA@@A@A@@@A@@@@@A@@@A@@@@@@A@@AA@A@@BAA@@A@@@AA@AAA@@@@AAAA@CAAA@@AACAC@@@AAA@@A@@A@A@@A@@A@A@@AAA@@CB@A@@AAA@AA@@A@A@AAA@A@A@A@A@@A@@A@@@@@@A@@@AC@A@@@AA@@@AA@AAA@@AAAAA@AA@CA@A@@CB@@AB@@AB@B@@C@@@AAAAA@@@@@CF@@@@@CAA@A@@@A@@@@@A@@@A@@@@@AA@BCA@AABABC@AAACC@@A@@AB@@@@@DADA@@B@B@BAB@BAB@@AB@@@B@B@@@@@BBBB@@D@DB@@@@BAB@@@@@BA@@D@@AB@@@@@B@@BB@B@@@B@BA@@B@BBB@B@B@B@DA@@B@BB@@BAB@BAB@BAB@BADABA@@BA@@@A@A@@@AB@@@DC@AB@@CDABADABA@A@@BABA@@DA@CBABABABC@@B@B@@@BBB@@@@@B@BA@A@@A@AA@AAABA@@@A@@EE@@A@A@AACB@ACBC@A@C@@@ABAB@@A@AB@@A@AD@@A@@@AA@AA@@@A@@@AA@@A@A@@@@@AB@@@@A@@@A@@A@@AA@@@A@A@AA@@A@A@@@@@AB@@@B@AC@AA@BAA@@A@@@@@A@C@@@@@@@A@@BAA@@@AA@@A@AAA@C@A@A@@@@AA@@A@A@C@@A@CAA@@AAAC@@A@@@C@@@AB@@ABC@@@A@A@@@@BA@@DAB@BABAA@@AAA@@AA@@AAC@@ACA@A@@AC@A@@@A@@@A@AB@@A@AAA@A@ABA@@AA@AAA@A@AB@@A@ABA@A@@ACAA@@@@A@ABA@@@A@CA@@@BA@ABAD@@ABAB@B@@A@@AA@@@@A@EA@@AAC@@@@@A@A@@AA@A@@@A@@CA@@A@@CA@@A@@@B@@"],encodeOffsets:[[118872,28085]]}},{type:"Feature",id:"361025",properties:{name:"乐安县",cp:[115.83048,27.428765],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAA@@@EAA@A@A@@@AAA@A@@AAAAA@@A@A@AA@@@@@AA@A@@@@BAB@@@@ADCAA@AB@@@@@B@B@@@@@B@BAB@B@@A@@@AB@@@@AB@@@B@B@@@BB@BB@@@B@@@BBB@BB@@@@B@@ABA@@BA@@ACA@@A@A@A@@@AAA@A@@@E@@A@@ABAB@@@B@@CB@BC@@@@@AD@@CA@@A@@@@@AD@@AB@@@@AB@@B@@@@B@B@B@@@B@BA@@B@@@@A@A@@@@BADBBB@DB@@@@B@@B@@@B@@@B@B@B@@@B@@@@ABA@@BA@ABA@A@@@AB@@@B@@@@AB@DBB@@AB@B@B@@A@AB@@AB@@@@BD@@@@@@@B@D@BAB@B@D@@A@@@AB@@@@A@@AA@@A@@@@@@@B@BBBDDBBDBB@@@@@AB@@@B@@@BBBBB@@@B@B@B@ACAA@A@AAEA@@@AA@A@@@@B@B@@@B@B@B@@BBB@@@BB@B@@AB@B@B@B@BAB@@CBAB@B@@@B@@@BA@@@@@A@A@A@ABAB@@@B@@A@A@@BA@A@CB@BA@A@@B@@C@ABA@@@AB@BAB@B@@@B@@@D@D@B@@ABABAB@BA@@@@B@@@B@BABBB@B@@@@BBAD@B@@@@ABA@@@@@@BAB@@AB@B@@@BA@@@A@@@A@A@@@A@CB@@@@@@@@A@@@A@@BA@@@A@@@@@A@@@A@@@A@@AA@ABC@@@@BA@@@A@A@@BABA@AB@AA@@@@@A@AA@@C@A@A@@@@@CAA@A@@@AA@AA@@@CA@@A@A@@@A@A@A@CAA@@BC@A@@@C@@@A@A@A@CAA@A@@AABA@A@A@@@A@A@@@A@AAAAAAA@AB@@AAA@@AA@A@CA@@A@@AAAA@C@A@A@@AA@AAA@AB@AA@A@AAC@@@A@@@AB@@AAA@@@ABA@@@C@AD@@@B@@@BBBAB@BAB@BAB@@@BA@A@@@@@@@@B@B@@@@AB@B@B@@@@A@@@@@@A@@@BA@@AA@@@@@A@@@@BA@B@@BA@@@@@@@@@A@@@@@A@@@@B@@A@B@@@AB@@@BA@A@@@@AC@CB@@@@@BA@@B@D@@@B@B@@@BA@A@ABC@ABA@ABA@@@A@@B@@@@AA@@ABAB@@AB@@AB@@AB@@@B@@@BA@@@@BA@@@@B@@AB@B@@AB@BA@@B@BAB@B@@BB@B@@@@@@@B@@BBB@B@@B@B@@A@@@AA@@@@A@@@ADABA@@B@@A@@@AB@BBB@B@BB@@B@@CBA@@B@@AB@@@B@@CDABAB@@@@AB@@@B@BBBABBBBB@B@BAB@BC@@@@B@@@@A@@@@@A@@B@@@@@BA@@B@@@B@@@@@B@@A@B@@B@B@@@@@BABA@A@@B@BB@@@BD@@@@CBA@@AA@@@A@@A@@AB@@C@@@A@AA@@BA@@AAA@AA@@A@@@C@@@@@AB@B@@@@A@@@@A@@@AAABA@@@A@@EBA@A@@@@B@@@BA@@BBB@@BB@@@@@B@@@@@B@@@@@BA@@@@B@@@@A@@D@@A@@BA@@B@@@B@@A@@BB@ABBB@@@B@@@D@@AB@@AB@@ABC@@BAB@@@@BB@@AB@@B@@BAB@B@B@D@@@B@BB@@B@B@@AB@@@BABAB@B@@B@BB@@BB@B@@@B@BAB@@AB@BA@AB@@@B@@@@AB@@@B@B@B@@@B@@@@AB@B@B@@@@@@@DBB@@@@BBD@B@B@@A@@@@BBB@DB@@@@AB@@B@@BB@@@B@@@@@B@@@@@@@B@B@B@B@@@@@@@@@@B@@@B@BBBA@@B@@@@B@@@@BB@@BBB@@B@@AB@@@B@B@B@@AB@@@@@BB@B@B@@BA@@@@BD@@A@@B@@@@B@B@@@@@@B@@BBB@@@BAB@@@@AB@@AB@@@@@BB@@@B@@BABABB@@@@BB@@B@B@B@@BB@B@@@B@@@B@@A@@B@B@@@B@@@@ABAB@@@@B@@B@@AB@B@B@@B@@AB@@@B@@@B@@B@@@@@BB@@BABB@@@@BB@ABA@@@@D@B@@@@@@BB@@BB@@@BB@B@@@@B@@@B@B@BB@@@B@B@@@@D@@@@@B@@@@BA@@BA@@@AB@@A@A@@@@B@@@BBB@@@BA@@@BB@B@@@@@@B@@B@@@@@@AB@@@B@@@@@BA@@@A@A@@@AB@@BB@@ABA@@BA@@@@BA@@@ABA@A@@BA@@B@@BB@@BB@@@BAD@DABBBAB@B@BA@@BAD@D@F@BBF@B@BABCF@B@@@BAB@@@B@B@@@@@BB@@@A@A@@@@@B@@@@B@B@BA@@@A@@B@@@B@@@B@@A@@B@@@@@@@@@B@B@@@@@DBB@@@@B@@BBD@B@B@@@B@@@@@@@@BBF@B@@B@@@BAB@B@DA@@B@@@@D@@@@B@@@@@B@@@B@@@B@@BB@@BBB@@@BB@@@@BB@@@@AB@@@B@@@@@B@@B@D@@@B@B@@@BBB@@AB@B@@@BA@@BB@@B@@@@@BB@B@BBB@@B@@@@@@BAB@B@@@B@B@B@@@B@@@BA@@B@@@@BB@B@B@B@@@BBB@@@@@B@@@@AB@B@@AA@@@B@@AB@B@BAB@F@B@B@@A@@BAB@@@B@@AB@B@B@@BB@@BBBDB@@B@BB@@@BA@@@@BB@@A@@@@@B@@@AF@@BB@@D@BB@@@@BA@@DD@@B@@@@BB@@B@@BAB@@@@B@B@@@@D@@@BB@@@@B@@@@@BB@B@B@BB@@@@B@@@@@@@B@@@B@@BB@B@@@B@@@@@@DB@@@B@B@B@@@B@@@@@D@B@@@@BBB@@@@B@B@BB@AB@@B@@@B@BB@@A@@BA@@BA@@@@@CB@AA@AB@@AB@@@B@BABA@@B@@@B@@A@ABC@@@@@BD@@A@@BA@@B@@A@ABA@A@A@C@AB@@AAA@@@@AAA@@A@AAA@@@@@A@@@A@@@@A@@A@@@C@@@@A@@CAA@@@A@@@A@ABAB@@AB@@@@@@AA@@@@BABA@A@@@@A@@A@@@A@@@@A@A@@B@@ABABA@@@@@@A@@@AC@A@@B@B@BABA@@@@@A@A@A@@AA@@AAC@@AA@@AA@@E@ABA@@@@B@BA@@@A@C@@BA@@A@@@AA@A@A@AB@@ABAB@@B@@BA@@@@BA@@@A@@@ABA@@@A@@BB@ABBB@@@BA@ABABABCBABA@AB@@@BA@@@@@A@ABA@A@@@AB@@@@@BA@@@A@@BA@@@@B@@@@B@@@@@AB@@@@A@@B@@@BA@@BA@@@@B@@@B@@@@B@@@@BB@@@@BA@@B@@@@@@@@AB@@@@ABA@@@@@AB@@@@A@@@BB@B@@@@AA@BA@@@@@@@@@A@@@A@@@A@@@@@@@@B@@@B@@B@@@@B@@@@@B@@@@@BAB@AA@A@A@@BA@@@@@ABABA@@B@BDDA@@B@@@@AB@@@@AB@@@@@@ABA@@B@@@@AB@@@@ABBBAB@@@B@@@@AB@D@@AB@@@@@@A@@B@B@@@@CD@@@@@@AB@AA@@BA@@@AB@@@B@@ABA@AB@@AB@@A@A@@DAB@@AB@@@B@B@@BB@@@BB@@@B@BBBB@@B@BBDB@@@BB@DB@BB@@@@DA@BB@@@@@BA@@@@BA@@B@@@@@BB@@BBB@@B@@B@B@@@B@@ABB@@D@@@@@B@@@B@@@B@@@@BB@@@D@@AB@B@BB@@B@BBB@BA@@B@@@@@B@@@@BD@@BD@BBB@B@@@B@@@@@B@@@@@@A@@B@@A@@B@@@@A@@@ABB@@B@@@DB@@@B@@@@BA@@BB@@B@@DD@@@BBB@@@@B@@@B@@@@B@B@@B@@@@B@@@@B@@@B@@@@BB@@@@@@@@B@@@BB@@@@BB@@B@B@@B@@@@@@@B@@@@@@@BB@@@@@BB@@BB@@@AB@@@@ABB@@B@@B@BB@@@B@@@@@B@@BBBBBBBBBD@@B@BB@@B@B@@@DB@@@B@@ADAB@@@B@@@BC@@@BB@@B@@@BA@@B@@D@B@@BB@@@B@B@@B@@@BB@@DD@@B@@BBB@@@B@@B@@@@BBBBB@@AB@@BBB@@B@@B@@@BBDBB@BD@@BBB@@B@@@@BBB@B@@B@@B@@B@BB@@@@@B@BBB@B@@B@@@B@@AB@@@@@DBB@B@BD@@@BB@@@@DAB@D@B@@@BAB@@@@B@@BBBD@BB@@@@@B@B@@@@@BB@@@B@@B@@@@@@@@@B@B@@A@@@A@@@@@@BB@@@B@@@@@@@@@B@B@@@@A@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@BB@@@BBB@@@B@@BB@@@@@BBB@B@@BB@@@@B@BB@@BBBBBB@B@B@@@D@BBBB@@@@BBB@BBB@@B@@@@@BBBB@B@@BBFDB@@BB@BBB@B@B@@BDBBBB@@@@BB@@B@B@@BB@@@B@BDF@BB@DB@@BDBBB@@DBBDD@@@B@BB@@B@@BB@@@@@B@@@B@@@B@BBB@@@@@B@@@BBDB@@@B@@B@B@@@BB@@B@@B@@@BB@@BB@@@@B@BBDBB@B@B@@@B@BB@@B@BBBB@@DBB@BBBB@@@BB@@B@BBB@@@B@@BB@@BB@@@@@B@@@@B@B@DB@@B@BBB@@@B@@ADA@@B@BABA@@@@B@B@B@B@DAB@B@BABA@@@@B@DBB@@@B@@@B@DB@@BC@A@AAA@@B@BA@@@BB@BA@@B@B@@@B@@@@A@@DA@@@@BABABA@@BCB@@@B@@@BA@@B@B@@@@AB@@AB@B@BB@@BB@@@@B@@BB@@@BA@BB@@@@BB@@AB@@B@@@B@BB@@@@@@@B@@@@AB@@@@A@@@@@B@@B@@A@@B@@@@A@@@@@@@@@A@@B@@@@@B@@@@B@@BB@B@@@AB@BB@@@BA@@BBB@@@@@@BB@@@AB@@@@@@@@@A@@@AA@@@@@A@@BA@@@@@B@@@B@@B@B@@@@@@@@@B@@@@@AAA@@@@@@ABB@@@@@A@@@@A@@A@@@@@@@@@@B@@@@@@@@@B@@A@@@A@A@@BAB@@@@AB@@BB@@ABAB@@ABA@@A@@A@A@@@AA@@@@A@A@A@@AAB@@A@@AAA@@@A@@@AAA@AA@@@@A@@@A@@@@A@@@C@@@A@@BA@@@AB@@AB@@@B@@@@@B@@A@@B@BA@@B@@A@@BAD@B@@ABABA@ABC@A@@@A@@@A@A@@@@@A@@BABA@@@A@AB@@A@@@C@AB@@@BA@@@A@@@@@A@@@@@A@@@AD@@@B@@@@@@ABAB@B@@@@A@@@@@A@@@@BA@AB@BABA@@B@B@BAB@@@DCBA@@B@B@B@BBDB@BD@B@@B@@DD@@@D@@@@DBDB@@BB@@@@@B@B@@@D@D@B@@@BB@@@BBB@B@DD@@@B@B@@@BBB@@@B@@@@BB@B@@@@@BA@@@BD@BBBBBB@B@BDB@@@BAB@BBDB@@BB@B@@@BB@@@BA@@@@BB@@BB@@@@B@@A@@B@@@B@DCB@B@@@@A@@@ABA@@@AB@@@@A@ABA@@@@@A@@@@BA@A@A@C@CB@@A@@D@@@@@@@BBDBBD@@BA@@@A@AAE@@@AA@AA@@@A@@BABA@@B@@A@@A@@AA@@B@@@@@A@@CABA@@@AB@@A@@@AB@BA@A@A@@@@@A@@@A@AB@@A@A@@B@@A@@@@B@BA@A@ABABABA@@BA@AB@BA@@B@BB@@@@@AB@@@@@B@B@B@BA@@B@@@B@@@@@B@@@@@@@@@D@BB@A@@@@@C@A@@D@@A@@BC@AB@B@@@@A@ABA@@B@@@B@B@@@@AB@@@B@@@@AB@@BB@@@@@B@@@B@DB@@BB@B@@BA@@BA@@@@B@B@@@@ABA@@@A@@@A@ABA@A@@@A@@@C@A@@@@@AA@@@@A@A@A@A@A@AAA@@BA@@@@@@@@AA@C@@B@BAB@@@@@B@AABC@@BAA@AA@A@A@@AA@A@@AA@CA@@A@@@@@A@@@@@@@@BC@@@AAA@AA@AA@AA@A@A@A@C@@@AAAA@@@@@A@@BA@@@A@@AAA@@@@A@CBA@A@AB@B@B@@@@A@@AA@@@C@@@A@@@@BA@@@A@@@A@@@@@@@AA@BA@A@A@A@@A@AC@@AA@@@@@C@A@A@AACA@B@@ADCBAB@@A@A@@@A@A@@@AB@@A@A@@@@@ABA@@@AB@@@DA@A@@@AA@@@@AA@AA@A@EA@@A@A@A@@BA@@@@AA@A@C@@@A@@@@A@@AA@AA@@A@@@A@@@@@A@@AC@CA@AA@A@@@@@@BAAAB@@@@A@@@@@AA@A@@@@A@@A@@@AA@@@@AA@@A@@BAA@AAA@@@AA@@@@@A@AA@@@@AA@@AAA@@@AA@AAAAAA@@AAA@CA@@AA@@AC@@AA@@@@AC@@@@@A@A@@AABA@@@A@A@A@@DE@ABA@@@CBA@@BABA@@@AAA@A@A@ABAA@B@@C@@BA@@@@@A@@@A@@@@@A@@@@@A@AB@B@@@BB@@B@B@@@BA@@AA@@@A@@BA@ABC@AAA@AB@@ABABE@@@A@@AA@@@AAA@A@ABA@@BA@@@A@@B@B@B@@@BA@@@@BA@@B@@@B@@AB@@A@@@@@ABA@@@@B@B@@AB@BADAB@B@B@BAD@FA@@@@B@BB@@@B@@@B@@@@@D@@@B@@@B@@@B@@@B@BAB@@BBBBB@D@@@@@BA@A@AB@@A@@B@@ABA@@BA@@@AB@B@BA@@@A@@@AB@@@BAB@@@@@@@BAB@B@B@@@@A@@@A@@@@AA@@BA@A@AB@@@@ADC@@@@BB@@B@@@@@BA@@B@@ABAB@DA@@DBB@@@@@B@@@@@@@@ABCD@FC@@B@@@B@@@@@@@B@DBBBB@@@DBB@@@B@@@DCBA@A@@B@B@B@@@@BB@@@BBB@@BB@@BBD@@@@BBB@D@@BB@BBDA@@@@B@B@B@B@@@B@B@@A@@@A@A@AB@@@@A@C@A@@@@B@@B@@B@@@B@@BB@DA@@@@BB@@@BB@B@B@B@B@@ABB@@B@@@B@@A@@DBB@@@DADCB@B@BAB@B@@@B@B@@@BBB@@@@A@@A@@@BA@@@@@@@AAA@A@A@A@@@ABA@ABA@@@@@@@@@ABAA@B@@A@A@A@A@A@@BA@A@@BABA@@@@@A@AB@BA@A@@@A@@@@@A@A@@A@@@BG@@BAA@BC@A@A@@B@BA@A@@AAA@@AA@@AA@@@A@CA@A@A@AACC@@A@A@A@@AA@@@@@A@@@@@AA@AA@AC@@A@A@@@AAB@AAA@ABA@@@AAAB@@A@@@A@@@A@E@@@A@@AC@A@A@A@@@AA@AAA@AA@AA@@A@@AA@@@A@@@A@@@ABA@A@@@AAA@@BA@@A@@A@A@AAABAA@BAB@@@@@AA@@@A@@@C@@A@@@AA@@@@A@@@@@AA@@@@AB@@@AA@A@AAA@@@A@@@@@@@A@@@@@A@A@@@@AA@@A@@@A@@AAA@@AA@@ACA@AA@@A@@AB@@@A@AA@A@@@A@A@AA@@A@@@A@A@A@@@@AAAA@@AAAA@@A@A@A@@@ABA@AB@BA@@@A@@@@BABC@ABC@@@A@@AA@@AA@A@@A@@@@@AA@@BA@A@@BABAB@BAB@BA@@B@@AB@B@@@@A@@@@@A@@@A@@@@@@B@@A@A@AA@@A@A@@@@@AB@@@@A@@@A@AAA@@@C@A@@BCA@@A@@@ABC@@@A@@B@@@@A@AB@@A@@@A@@@A@A@A@C@@@@BA@@AC@@A@@A@@AA@@@A@@A@@A@@BA@@@@@AAA@AAA@@AA@@@@@@AA@C@@@AB@@A@@@@AA@A@@@A@@@@A@AA@@@A@@@@@@A@@A@@@@@ABAAC@A@@@A@@@C@ABC@C@ABAB@@AB@@@@A@@@@DAB@@@@AB@@@@@@AAAA@AA@AAA@@A@@A@A@@@@A@@@@@@A@@@AA@@@A@@@@@@@A@AB@@@AA@@@@BA@@@AA@C@@@A@@@AA@@A@@A@@AAB@@@@@@@A@@@@B@@ABA@@@A@A@@@CAA@@@AB@AC@AB@@AB@@@@ABCBA@A@AB@@A@@AA@@@@BA@@BA@@@@@@@@DC@@@AB@@A@@@A@@BA@A@C@CB@@AAAAA@@@A@@@A@@@C@@CC@@@@@A@AA@@ABA@@@A@@@@@AA@@@@@@@@A@@@@@AA@@@@@@A@@@@BAB@B@@A@@BAD@@A@@@@@A@@B@@A@@@@@@@A@A@A@A@@@@AC@CAAAA@A@@@AA@@@@C@A@@@A@@@@@@@BA@@@AAA@AAA@@@A@AA@@AA@@AA@@@A@@@AB@BA@@@@AA@@@A@@@A@A@@A@@AC@AAA@@AA@A@A@@@A@A@@@A@A@@@@@A@@@AA@@AA@@@AB@@@BA@AA@@@@AB@@A@A@A@@B@@A@@@A@@A@@A@@@@AA@@A@@A@@AAAA@@BA@A@A@@@A@@A@@C@@@@@A@A@@@@@A@@AA@C@@B@@A@@A@@AA@@A@A@@@A@A@A@A@CAAAA@@A@@A@@@@D@D@B@@AB@BAFAB@B@@@@@@A@@AA@@@@B@@@BA@@@@BA@@A@@@AA@@@AA@@@@@@AAB@@@AA@@AAA@@@@AA@A@A@@@@@@@@A@A@@A@AA@@AB@AC@A@@@ACC@@@A@@A@@A@A@A@@@AAA@@A@AA@@@@AAA@@@AA@@A@@AA@@@@AAA@A@@@A@@A@@@@AA@@A@@@A@@@AA@@A@@A@@@A@A@@B@AA@@A@@AA@@A@@@A@@@@@AA@@@A@A@@@AAA@@@@@A@@AC@@@@AAAA@@AA@BA@@A@@@A@AA@AA@@AA@A@@AA@@AA@@AA@@@@@AA@@A@A@A@@@A@A@A@@AA@A@@ACAAA@CA@@@AA@@@@@A@@@@@A@@A@A@@AC@A@@@@BA@A@@CAA@AAA@A@@@AAA@@AA@A@@AA@AAA@@A@AAAAA@@AC@@BAAA@A@C@A@A@@@@AA@@AA@@AA@@AA@AA@A@@AA@@@AAA@@@A@B@A@ABA@@@@B@@AB@@AB@@@BDB@@@@C@A@AB@@@BAB@@AB@@AB@@A@@@@@A@@B@@A@@@AB@@@@A@A@@B@@A@@B@@A@@BA@@@A@@@@DAB@@EB@@A@@BA@@@"],encodeOffsets:[[118693,27634]]}},{type:"Feature",id:"361026",properties:{name:"宜黄县",cp:[116.236201,27.554886],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@AA@@AA@@@@@AB@@@B@@C@@@@A@@A@@A@@AA@@AAA@@@A@@@@AA@AAA@AAAA@@AC@CA@@@A@AAA@@AAA@@AAA@ABABAB@B@@AB@B@@@BA@@@A@@@A@@AABA@@B@@@B@BA@@B@B@@A@@B@@@B@B@B@@@@@B@@ABA@A@AB@@@B@@@@@B@@BB@B@@AB@B@@@B@@A@A@A@@BA@A@@@AB@B@B@@@BB@@B@@@@BBAB@@BD@B@@@B@@@@@B@@@B@B@@BB@BBB@@BB@@@B@BBB@@@B@@@@@@@B@@AB@@@@AB@@@B@@@@C@@BA@A@@@A@@@A@@@A@AAC@@BAB@@@@AB@B@B@B@B@@AB@@@@@@@@CA@@AB@@ABA@AD@@@@BBB@AD@@BB@@@B@@@B@@@BA@BB@@@@@B@@@@@@BBB@@BB@AB@B@@A@AA@@@B@@@BCBABABAB@@BDB@@B@B@@@B@D@@@@@BB@@D@B@B@@@BABAB@B@@AB@BAB@@A@@A@@@A@@A@@A@@A@A@@@@@AA@AB@@@AAAA@AA@@B@@@B@BA@@A@@AB@@@@A@@@A@A@@@A@@B@@A@CBA@@@@BB@BBB@@@AB@@CB@@AB@@A@@@A@@@@@C@@@@@A@@AAB@@@@@B@@ABA@@@A@@@AB@@A@A@@@AAA@A@AAA@@@A@A@A@ABA@@@C@A@@B@@A@C@@@@AABABA@@@A@C@ABA@@AA@@A@@AA@@AA@AA@@AAACA@AA@@AA@@@@A@@CA@@@A@@@AA@C@@B@@A@@BA@@BA@@B@@A@@BAAA@@@AAAA@@A@@@C@CCA@@@AA@@A@@A@@ABAA@BA@E@A@@@AB@B@@@B@@@BBBBB@DBD@@@@@B@B@B@B@@@@@@@BA@@@@B@@@@@BC@AB@@@BA@A@AB@@@@@B@@@@B@@B@@@@@B@@@@@@B@@B@@@@@B@@AB@BB@@B@B@@@@DD@@@D@@@B@@@B@@BBBB@BA@@D@D@BAB@@@B@@@BA@@B@@CD@@@@@@@@AB@@AB@@@@BB@@@BA@@B@BABAD@B@@A@@BA@@BBDA@@B@@BB@D@@@B@B@@AB@BA@@@@@@B@@@@@@BA@BB@@@@BB@@B@@@B@@@DBB@@B@@A@@B@@B@@BAB@@@@@@@B@@@B@@B@@@B@@@@B@@@@@@B@BB@@@BB@BBBB@BB@B@@@@A@@B@@A@CB@@@@@B@@A@@BA@AB@B@DAD@B@D@@@B@@@BBDAB@B@@@@@BB@@@@@@@@B@@BBB@@@@@@B@@@BBB@@@@@BA@@B@@@DBB@@@@@@BB@@BB@BBB@B@@@@AB@@@BB@@@@B@@BB@@@BB@@@BD@@AB@@@@@D@B@B@B@@@B@@@BA@@B@B@@A@@@@B@@AD@B@@@BB@AD@@@B@D@@BB@B@B@@@B@@A@@B@@@@@B@BB@@B@B@BA@@@@@@@@B@@@B@@@@@B@@A@A@@BA@@@ABA@ABA@ABAB@@@BAB@@BB@@@@B@@@@BBB@@BB@@@B@@AD@BADAB@@@@@B@@ABA@@BAB@B@@@B@B@BB@BB@BB@BB@B@@B@B@B@@@B@@@BBB@B@@@B@B@@B@B@@BA@@@BB@@BDB@BB@@BB@BB@@@B@@@BB@@B@@B@B@@@@@B@@@@@@@B@@@B@BBB@B@@B@@BA@@@@BB@@@@B@@@@@BB@@B@@@@D@@@B@@BB@@@@A@ABB@ABBB@B@B@BB@@@AB@@BB@B@@@BAB@B@@@B@@@B@@BB@@@BB@@BBBB@BBB@@B@@@B@B@BBD@@@B@@@F@B@@@B@@@BA@BB@B@@AB@BBB@BBA@B@@@B@BD@@BBBB@@B@@@@@B@@@@BB@@@B@B@BD@BD@B@B@BDBB@@@B@@BB@@BB@BB@@@BABA@@@@B@BADB@AB@@AH@@B@@@@B@B@@@@@B@@@BABA@@B@B@@@@ABAB@@@BAB@@@B@B@B@B@BA@B@AB@B@@@@@@@@@B@@B@B@BB@@@BB@@B@@@B@@@@B@@@DA@@B@B@@BB@BB@BB@B@B@@@@@BB@@BB@@@A@@@BB@@DB@@@DB@@@B@@@BBB@@@B@B@@@@BD@BB@@F@B@@B@@BB@@@@ABABA@@BCBAB@@A@@DBB@@@B@BAB@@@@@BBBD@@@BAB@B@BA@@B@BBB@BBB@@AB@B@BBB@B@BA@@B@B@@@B@@@BBD@@@BDB@BD@BB@@BB@@BB@BB@ABABA@CB@@AB@@@@@B@B@@AD@BA@@B@@@D@@@BD@BB@BB@DBB@@@@D@B@BB@@B@@B@B@D@B@BBB@@@BB@@B@AB@@@B@@@@@@@D@B@@@@@BB@ABB@@BBDA@@@A@@B@@@@@B@BB@@B@B@B@@BB@@@BB@@@B@@@@@BA@@@@B@B@@@BB@@B@@@B@@BBB@@B@@@BCB@@@BAB@@@BABA@@D@B@D@DA@BDABBB@B@@@FF@@@B@@ABBB@BBBB@@@@BABA@@@@@A@AA@@A@A@@@ADABABAB@DCB@@ABAB@@@BABCBABCB@DA@@BCD@@A@@B@@@B@B@@AB@@ABCBABA@ABA@ABA@AB@@AAA@@@CBA@A@A@A@AAA@@@ABA@@@A@A@@AA@@@@@A@@BC@@@AB@@@@A@AB@@@@CAC@@@AAAA@@@@A@A@@@A@@BA@ABA@ABA@A@@@CBCB@@@@A@@BB@@@DDBB@BADABBBB@ADB@@B@@@@@B@@@B@@@@@B@@@BBB@D@@@@DE@@@@B@BBBB@@D@@@@ABA@@BA@@DA@@@BDBB@@BBBBB@BB@BBB@@B@@BB@@B@D@@B@@@B@@@@B@@@@BB@B@B@B@B@BBB@B@@@BBB@BB@@@BDA@@BB@BB@B@@@@BB@B@@@@BB@BB@@D@DBBB@@BBDBB@BB@B@@B@BBB@@B@@@BB@AB@@@BBB@@@B@@@@B@@@B@@@@@B@@@B@B@@@BBBB@@BDBBB@@@BB@@@B@@@B@BB@@@BB@@@@@B@@@DB@@B@@B@@@@B@@B@@@@@@B@@@@BB@@@B@BAB@B@BBB@B@@B@B@B@BAB@@@B@HA@@@@@A@@@ABA@ABABAA@D@@@@@BBA@BB@@@@B@D@@@B@@@DA@@B@B@@@@@B@@C@@B@@@@C@@BA@@AA@@@@B@@A@@BAB@BA@@B@@@B@@AB@B@BA@@@@B@AB@@B@@@@@@AB@B@DAD@DA@@@@D@B@BA@@@@BADA@@BDDB@@@@@@BB@BB@@@@A@@B@B@@@B@@@@@BA@AB@B@@@BA@@@@BBD@@A@@@@B@B@@@@@BAB@@@B@B@B@@BB@@@@B@@DA@BBB@@@@@BB@@B@@B@@BA@@BB@@@B@@@@B@@BB@@@B@@@B@@B@@BB@@@BBBBAB@B@@@F@B@@@@B@B@B@@B@@B@B@DB@@B@B@@BDBBB@@@@@BB@@BABABCB@@@BAB@@@BAB@@@B@B@@@@@B@@@BA@A@@@@@@B@@AB@@@AA@@@@@@@ABA@A@@@AB@@AA@@A@A@@@@A@CAA@@@@A@@BA@@B@B@@A@A@ABABA@A@@B@B@@AB@@@@DB@@@B@@@@@@@B@@@A@@@B@@@BA@@@@@@B@@B@BBB@@@@@@B@@AB@BA@@@@B@@@@@BB@@BD@@BB@@@@@@@@@B@@@@@@A@@B@@@B@@@B@@@B@@@@@B@@@@@B@@@DBBB@B@@@B@@@@@B@BB@@@@@BAB@@B@@@@B@@AB@@@@@@BA@@B@@@@@@B@BA@@BA@@BBB@@B@@@B@B@B@B@B@B@@BBBB@@A@@@A@@B@B@@@B@@@B@@DB@@@@ABA@@BA@AB@@A@@@@AA@@@A@A@A@AB@B@@AB@B@@A@CD@@AB@FB@@BA@@@@@@@@@@@A@@@BB@@@@B@BB@@@B@@A@@BA@B@@@@@BB@@@B@@@@@@@BB@@@@@@@A@@BB@@@@BB@@@@B@@@BF@@@BB@@DB@@B@DB@@BB@@@@@@BA@A@A@A@@B@@@@@B@@@BB@B@@@@B@BD@@@@B@B@B@@@@@@BBB@@@B@@@@A@@@BD@@@B@B@@@B@@@@BBB@B@@@@B@@BA@@@A@@@@B@@AB@@BBBB@@@@B@@DD@B@D@B@@@BB@@@@B@@@@@BB@@@@@@@@BA@@@BB@@A@@@@@BBB@BB@@@@BB@@@@B@@@B@D@@AB@@@@@B@@B@@@BFA@@@ABA@C@AEEB@@@B@@@@@BA@@DADA@@AC@@@ABA@ABA@@DA@@B@@BBBB@B@B@B@B@@@BAB@B@@BBAB@@B@@@@B@@@@AB@B@@@@@B@@@B@@B@@@A@A@AB@@B@@B@@@B@BA@@DADAB@@BBA@@B@B@@@@@BB@@B@@@@@BB@BB@@BB@@@@@@@@AB@@A@@B@@@B@@@@@B@@@@AB@@A@@@A@@BCA@@A@@B@@A@@AA@@A@@@@@@@@@A@@@@B@@A@@@AA@@BAA@@@A@A@A@@@@@@A@@@A@ABA@C@A@@B@B@@@B@@B@@DB@A@@@@@@B@@@B@@@@A@@@A@@B@@@@@@A@@BA@@@A@@@A@@AA@@BA@A@@@A@@B@B@BB@@BABA@@BB@ABAB@DABA@@BA@@BA@@@@@@BBB@@BBB@BBBBBBBB@@@B@FADA@AB@DA@@BBB@@@@@@BB@@@BBB@@BB@B@@@B@B@B@@@@@@@@@@@@ABBB@BA@@BBDB@@@@@@BA@@@C@A@A@A@@B@@A@A@A@@B@B@DEBA@@B@@@@AB@BABA@@B@@ABAB@B@B@DA@@B@@A@@B@@@@@BAB@@@@B@@@@@@B@B@@@@@@A@@@@BA@@B@@@B@@BBAB@@@B@@A@@DAB@@@@@B@@AB@@AB@B@@@BA@@@@B@@A@A@@B@@@@AB@@AB@@AB@@A@@@@AAB@@A@@B@@@BABA@@BA@A@CBA@@@@@A@AB@@C@@@@BA@@@@B@DA@@BA@A@@@@@AB@B@BA@@@AB@BABA@@BA@@BA@AB@@A@AA@@@@AB@B@@A@@@@BAAAB@@A@@@@BA@ABAB@@CB@@AB@@ABA@AB@@@@AB@BAB@BA@@@C@@AA@A@@@A@@BA@@BABA@@@@DBBB@@BB@@BBBB@@@@B@@@B@@@B@@ABA@@BAFCBAB@B@B@B@@@BA@@@@@A@A@A@A@C@@BA@@@A@@@AAA@@AA@@@AAA@A@@@@A@@A@@@A@AAA@@@@AA@@A@@@@@AA@AA@@BA@@@A@@@A@AA@@@@AB@@@@A@@AA@@BA@A@AA@@AA@@A@A@@@A@@@AB@@@B@@BB@@@@AB@@@@@B@@@B@@@B@@@@@@CA@AAAAA@A@@AACAA@@A@@@C@@@AA@@AAA@@@AA@AC@@@A@@AA@@A@@AA@@@@AA@@A@@@@DA@E@@@AB@B@@ABA@@BC@@@@@A@@DC@@B@@@@@@@B@@AB@@B@@BA@@BAB@@@@AB@BA@@BB@AB@@@@@DBD@@@BB@@@A@ABA@@@AF@@BB@B@@@B@@@BAD@B@BA@A@A@@@@@@BAB@@@@A@@A@@AA@@@A@@AA@@AB@B@BADA@@BA@A@A@ABA@@AA@@@@AA@@A@AAA@@@@A@@AA@A@@AA@AAA@@@A@@AA@@@AB@@@@@B@@@BA@@@@@A@@B@BAB@@AB@@A@@@A@@B@B@BBB@B@BB@@@@BABAB@BAB@@@@AA@@AAC@@@A@AA@A@@A@A@@@AA@@A@@B@@A@@@A@A@@A@@A@C@@AA@@@A@@AA@@@@@A@@A@AAA@AA@@AAAA@@A@AA@@@AA@@A@A@@B@@A@@AA@AA@@A@A@A@@AAAAAA@@@@AA@AA@@@@@@A@@A@@A@@A@@@A@AAC@A@@@CAA@@@AB@@A@A@A@A@@@A@A@@@@AA@@@@@@@A@@@@@AA@@@@@@@@A@@@AAA@@A@@A@@@AAA@@AAAAA@@@C@@A@A@@@A@A@@@@A@A@@A@BA@@@@AC@A@@@AA@A@@@AA@@AA@@@@@A@@BA@@BA@@BA@@@@@A@AB@@A@ABA@A@@@AA@@AA@@AAAA@AAA@AA@@AA@AAC@@B@@AB@@A@@A@AC@@BA@@@@@A@@A@CA@A@AAABAAA@@@AA@@@A@@@@@@A@@@A@@AA@@@A@@@A@@@@@AAA@AB@BA@@@A@@@@BA@AA@@@@AA@@@@@A@AA@@A@@A@@@@@@BA@A@A@A@@@A@A@ABA@@D@D@BB@@B@B@BAB@@@@C@@@AAA@@@@@AA@@@@@@A@A@@@A@A@A@@@@@@@ABC@A@A@AA@@A@@@C@@@@@AA@@A@@AAB@@A@@AAAAA@AAAA@@@AA@A@@AB@@ABA@@@@@@BAB@@ABA@@@@@@A@A@@ABAA@@A@@A@@@@AAAAAA@@A@A@@@C@@@@@@A@@@@@@AA@BAAA@A@@@A@@@A@@BAB@@@BAA@BA@AB@@@AAAAC@AAA@@@@@A@@@@@@A@@@@A@@@@@@@@A@@@@@AA@@AA@@A@AAAAC@ACAAAAA@@@A@A@@@ABA@ABA@@@@ACAA@@@@@@A@AA@@@@A@@A@A@@@A@@@A@@A@@@@@G@@@ABA@A@@@@@A@@@@@C@@@CAA@@@A@@@@A@A@@@C@@@C@EBC@AA@BAAC@A@AAA@A@A@@BA@A@@AAAC@@@AAAAAAA@@@@AA@@@@@@@AB@@ABB@@@AA@@A@A@A@A@A@@A@@@AAAA@AA@AAAA@@@A@@@AA@AAAA@@AAAA@A@@AA@AAC@@B@@A@@AA@A@@@CAAAAAA@AAC@@@AAA@@@ABEB@@C@AB@@A@@B@B@@@@ABA@AAAB@@A@AB@@A@A@@@A@A@@AA@AAA@A@AAA@@BAA@@A@@@@@@@A@@AA@@AC@A@AA@AA@CAAAAA@@@@@A@@AA@@@AC@A@AAA@AAA@AA@@EEAAAAAA@A@@@AAA@A@AB@@AA@CA@@A@@AC@EC@ACC@A@@AAAACC@AA@@@ABA@AA@@@@ABA@@@AA@@@@@A@@A@AA@@@AA@@AA@@@@A@@B@@AA@@@EGA@@@A@ACA@@AA@A@@@@@A@@A@@CAAA@@AA@@BABA@@@A@A@A@@AAAC@@@AAA@@@A@@@AAA@A@A@@@@AAA@ACAAACA@@AA@@@@@A@@@@AA@@@@A@A@A@@B@@CB@@@B@@@@@BA@@@@BCB@@A@C@AAC@AB@@@B@@A@@@@B@@@BAB@@@BA@@@@AACB@@@AAA@A@@@@ACA@@@@@A@@@@BA@ABAB@@CAAAA@@@@@A@@@@BA@AA@@A@@@@@AA@@@@@AA@@AA@@AAA@@@@@AA@@BA@@AA@@A@@@@CA@A@@BA@@C@AAA@@AAA@A@@@A@A@@@A@@@A@ABA@@@@@A@@@A@@@@@A@@@A@@AA@@@AAA@@AAA@@AA@@ABA@@A@@A@@@A@C@@@@AE@@@C@@@@@@@A@@@@@AA@@BA@A@@@@@A@AAAB@@AAA@@BA@@AA@@A@@@@AAAA@@@A@@A@@AA@@B@@A@@@@AAAAAA@A@CCA@@@@@@C@@BA@@A@@@@@BA@@AA@@@@@@A@A@@A@@@@A@A@@AAA@@@@A@@@CA@@AAC@@@AA@AA@@A@@A@A@A@A@@AA@C@@@@@AB@AABAAAB@@@@@@AA@@@@@BA@"],encodeOffsets:[[118971,27704]]}},{type:"Feature",id:"361027",properties:{name:"金溪县",cp:[116.755058,27.918959],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AAAAA@C@A@@A@@@A@@@A@@AA@@@@A@@@A@@@@@A@@B@@A@ABA@@@@BA@AAA@@@@@@BA@@@C@A@@AC@C@A@AA@@AA@@A@@@A@AA@@@@@@@AA@@A@@A@C@@BA@AB@@@BBBAB@BA@@@@@C@@@AA@BA@@A@BA@@@CB@B@B@F@BAD@BA@BB@@BBBD@@@@@@@BA@AA@D@@@@DA@BB@@@@@B@AA@@@@BAB@D@D@D@D@DBB@B@BBDDDDBBBB@@@BB@@D@DBD@B@@BJ@BB@@@DBB@B@@@@B@@@@CA@@@@@BAB@B@@@@@BBBA@@@@@@AA@@@@@A@@@@BB@@@@B@@@B@@A@@B@BB@DB@@@@@BBB@@@B@@@B@B@@@@A@@B@B@@AB@BA@@@CAAA@B@@ABA@A@AB@@ABB@@BBBHBBBF@B@BBB@BDBBDFBBBBBBB@@DBB@B@@BF@BAH@DABAFCDADCBADABABA@CBEBEBEBC@CBA@A@ABCBEFAB@@AD@D@B@BAFAB@B@BADAF@DAB@B@BAB@BAB@BBB@@BD@B@BBBDDBD@B@BBF@B@@@DADAD@BADCB@BCDCBCDCBGDCDEBCBGDEDCBCBEBGDA@A@CBABC@@B@@A@ABA@ABCDABAD@B@B@BBD@@@B@DAFADADAB@BCBCFEFCBADCBABABC@A@@BCBCDCB@@ABA@AAC@G@EBC@CAE@E@A@A@A@ABABABA@A@A@C@CAA@A@ABAAC@CCA@AAC@C@@@CAA@G@A@A@KDABA@CF@BCD@B@@EDCBCBCBAB@@@BB@@@BBBB@@@BBB@@@@@B@@BBBB@@B@@@@@DB@A@@B@@@BB@@@@B@@@@BB@@BB@@@BB@@B@@BD@@@@B@@B@AD@@@B@B@@@B@BB@@@B@@@BAB@@@@@B@BD@@DBB@@@@@@BBB@B@@B@@@@@B@BBBBBB@B@@B@BB@B@@@@@@@@B@@ABB@B@@BB@B@@AB@@A@@BB@@BB@@@BB@B@@A@@B@@@@C@@B@B@B@B@B@B@@AB@@@BB@@BAB@D@@@D@@BB@@AD@@@B@B@@@@BD@BB@@B@B@B@BA@@@@BAB@@BB@@@B@@@B@@A@@@@BA@@B@@C@@@A@ABA@@@@B@BAB@@@BA@A@@@@B@@@@B@@B@@@@A@@BB@@@B@B@@@@@@A@ABA@@B@AA@AB@@@@B@B@@BB@@BA@B@@A@@@@@BB@B@@@ABB@@A@@B@@B@@@@@BA@@B@B@BB@@DA@@D@@@@B@@@@@@B@@AB@@@B@@@BB@@B@@BB@@B@@@BAB@@BB@@BB@B@B@@@B@@CB@@@B@B@B@@@@@@A@@@@@A@A@A@@@ADA@AB@BA@@@@@@@@@BA@@@@@@@A@@A@@@AB@@@@A@@BAA@@@@@B@@ABAB@@@B@@@B@B@@BB@@@@B@@@BB@@@@BB@@B@@@@B@@@BBBB@@@@@AB@@@BB@@D@@@@B@B@@B@@BB@B@@@B@@@BBBBDB@@BB@@BA@@@B@B@@@BB@@@@@@BB@@@@BA@@@BB@D@@B@@@@BA@@B@@@B@@BD@@@BAB@@@B@B@@@@@BD@@@@BBB@@@BD@BABB@@@@@B@@BB@@@BBB@B@DD@@B@@@@AB@@@AA@@AA@@BA@@B@@@BBBBBD@FB@@@BB@@@B@BB@BBA@@@@BAB@@BB@@AB@BAB@BBB@@F@@AB@@@@@B@@AA@@@B@@@D@B@BBA@@B@B@AB@@@@@@@@B@B@@BA@A@@BB@@BA@BBBDA@@@@BA@@B@@@@@B@@@B@@B@@B@@A@@BA@@A@@@@AB@B@@A@@@A@@BA@A@@@@@@B@@@@AB@@A@@B@BA@@@@B@@@@AB@@A@@BBB@@BB@@@B@@@@A@A@@@@@@@A@AB@B@@@BABABAD@@@B@@@@AB@@AB@BAB@@CB@@@B@B@@@BAB@@@BA@@@A@@@AAABA@@B@@@@@B@BB@@@@@AB@A@@A@@@ABC@@@BB@@B@@@AB@A@@@B@@@@@BDBB@B@@@B@@@@A@@@@@@@A@@BBB@D@B@@B@@@@B@@@B@@B@@@@@BAA@BA@BB@@@@@@A@@@@B@@@B@@@@@B@@@B@@@@@B@BB@@B@@A@A@AB@@A@@@ABABA@ABA@A@@@@B@B@@@DA@@BA@@@@BAB@B@@@@BBB@@B@B@B@B@@@AB@D@@@BA@B@@B@@B@B@@@@BD@@BB@FBBBBB@B@@D@@BA@@BB@B@B@@A@@B@@B@@B@HDB@@C@AB@@@D@B@B@@@@ABA@ABABA@@@A@A@@@ABA@@@A@A@@@@@C@A@@D@@AB@@AB@BAB@@A@@BA@AB@B@DAB@@AAA@@@@B@@@B@BB@A@@@@@A@@@ABB@@B@B@B@B@@B@@@BBB@B@@@B@@@B@@DB@@BBB@BB@@B@D@@B@B@BBB@B@@@B@@B@B@@B@@B@@@@B@@@B@@@@AB@@AB@@@@@B@@B@BDBB@@AB@B@B@B@@ABCB@@@@@AA@A@CB@@@@@@@B@@@@@@A@@@A@@BAB@@@B@@@@BB@@@@@B@@@@A@@B@@@@AB@@BB@@@B@@@@B@B@BB@@A@CB@@@B@BBB@@@@BB@@A@D@B@B@@@@@@@@@@@@@B@@@@@@@@@AA@A@@BBB@@@@@@A@@@A@@DA@AB@@@B@@AB@B@B@@AB@@@B@B@@B@@@B@@@B@@@B@@D@@@@B@B@@@B@B@@@@BEB@BABAB@@@B@B@@@B@B@@@B@@B@@@@BB@@@BABB@@D@@AB@@@B@@A@@@@@A@@B@B@@@@B@B@@@B@@@B@B@@@B@@@@@B@@BB@@BABB@@@@@@@B@@@@BBBDD@BB@B@@@@BB@@A@@B@@@@BAD@@BB@@@@@@@B@@@B@@@@B@@BB@@@@@B@@@B@@@@BB@@@B@B@@@BB@@BADAB@@@BCB@@@B@@A@@AA@@B@@@BA@A@@B@@AB@@@@@AA@A@@BA@AB@@A@@@@@A@@@AB@@AB@BB@@@A@@BA@@@@B@@@@A@@@@@@BC@@A@@@@A@@@@@@AA@@AA@@@@@A@@@@@A@AA@@ABA@AB@@A@AB@@ABA@A@@B@BABA@AB@D@@@DBFB@@BB@@@@DAB@@@@AB@BA@@@A@@@@@AB@@A@A@@@A@@AAA@@AB@A@A@@@@@@C@@@@@AAA@@@@B@BAB@@B@@A@@@@@@B@@@B@@@@@@BADA@@@A@C@C@@@@@@AA@@DA@AB@BAB@@A@@AA@@A@@@@A@@@@AA@A@@@@A@AAAA@@A@@@AA@@@A@@@C@@@@@A@@@AA@@A@A@A@@B@@@B@B@B@@@@@B@D@B@B@@@@B@@B@F@B@@B@@@@@@@BAB@@@@@@B@DA@@B@BB@BB@BD@B@@DH@B@@B@@@@@@AB@@@DA@@@BB@@B@B@@BB@@FD@@D@@@BA@@@@A@@@@AB@@A@@@@BBBBBB@@@@@A@A@@@AB@BABABA@@B@@@BA@A@A@@@AB@@BB@@A@@B@B@@@DAB@B@D@@@@AB@@A@AB@@@@@@AA@@AB@@@BB@@D@@@BA@@@@BB@@DB@@@@BAB@@@B@@@B@@@@@B@@@B@@B@@BB@@@B@BB@@@DA@@@B@@@@A@@@@@@B@@@@@BB@BBB@@ADBBBB@B@@AB@@@BA@@@A@AB@@A@@BA@D@DA@@B@@A@@BBBBF@B@B@B@D@@HDB@@@BBB@@@BAB@D@BBB@B@@B@@@@@BA@@D@@@B@@@B@BB@@@BBBD@B@B@@@B@D@BA@@@@B@BBB@@@B@@@B@@B@@@BB@@B@@AB@@BB@@@@BA@@@@BB@BF@@BB@@@@@@@AA@B@@@@@@@BB@AB@@B@@@@@@@BA@@B@B@B@@A@A@@@@B@BBB@@@@@BBBB@@@B@@@B@@@@@B@@B@AB@BB@AB@@A@@@@BA@@B@@@@A@@B@@@@@B@B@@@BA@@B@BB@@@@B@B@@@@ABB@ABB@ABB@@B@@@B@@A@@B@@@B@BA@@@ABA@@B@@A@@B@@@@@BA@@@@A@@@@@@A@@AA@@@AB@@A@@@@A@@@@@@@@AD@@@@A@@@A@@A@AB@@@A@@@@B@@@B@@@@A@@@@@@@@@@@@@@C@@@AA@@B@@@B@@@@@B@@ABBB@@@B@@@@@B@@@@@B@B@@@@A@A@@@@B@B@BBBB@@@@BA@@@@BBBBB@BBD@@@@@B@@@@@@@@B@@D@@B@A@@@@B@@ABA@@@@@@BAAA@@D@BB@@@B@@B@@@DA@@@B@@A@AB@@DBAB@@AB@@@BB@@@@BB@@@@BA@@@A@BB@@@B@BBB@B@@@B@@@@ABA@A@@@@BBB@BB@BBD@@@BB@B@@@B@@@BB@@@BB@@@B@@BBB@AB@@AB@@@@@@D@@A@@B@@@BB@@BB@@B@@@BAD@@AB@BAB@@@B@B@@A@@@A@@@A@@@A@@AA@@@A@@BC@A@@@CA@@A@AB@@A@A@A@@BCA@BA@@@A@@@A@@@A@A@@DA@C@@@@BAD@@B@@BBB@@@B@BAB@@@B@BB@@@A@@AAAACAAC@@@E@@B@@@@C@A@A@@BA@AAA@A@A@A@@@@AA@@AB@@AB@@@A@@BA@A@A@@AAC@AAAAA@@@BA@@@@@AB@@@@C@@D@B@B@@B@@@@FB@@B@@@BB@@@@@@BB@@@A@@B@D@@@B@BB@@@BB@@B@B@@AC@@A@@@@A@@A@@B@@@@A@@@@@BD@@@B@BB@B@@BBABA@A@ABA@@B@@@B@B@@BB@JD@@B@@A@@F@@@@@@A@@@@@@BA@A@@J@@C@A@@@@@AAA@C@@@@AA@AB@@AAABABA@A@@BEBA@@BAB@B@B@@@B@@@@@BAAA@AB@@A@@A@@ABA@@@A@A@@@A@A@@@@@@@A@@@A@AD@@@B@@@BABAB@@A@CBABA@@BA@@BAB@D@DABABA@@BA@@@@BA@@@@@AD@@@BA@@B@@@BA@@@@@@@A@@AA@@@A@A@@@@BA@@B@@@BADA@@@A@AB@@@B@DABAB@DAB@BAB@@AFAB@BA@@@A@@BAAA@@BA@AB@@@BBDAD@B@B@@@B@D@@@@@B@B@@ABAB@B@B@@A@@BA@@BA@@B@FB@@B@BC@ABA@@@CBA@A@@@AA@@AA@@AA@@AA@@AEC@A@@AA@AB@@A@A@@@A@@@A@A@@@C@A@@BA@@B@@A@C@@BA@A@A@A@@@C@AAA@A@@A@@@AA@@@AAAA@AA@@AA@BC@@@AB@@A@C@@AC@C@@@@A@@AAAC@@@C@A@AA@B@@AAAA@@@ACAA@@AA@@@C@A@@@AA@@A@@@@@AA@AA@@A@@A@@AA@@CA@@A@@AA@@@A@AA@@@A@A@C@A@A@A@@@ACA@@@AAAAAA@@A@A@@@@@C@@BA@@BA@@BA@A@A@@BA@@@AACA@@AAA@@CAEA@@@@A@A@A@@@@@A@@@A@CA@@AAA@@@@AAA@@@@@@A@@@A@A@@@A@@@@@@@AB@@A@A@C@@BC@A@@@@ABAAA@A@A@@@@BA@A@A@@@A@A@@@@AACCAAA@@@A@CAA@A@@@@@@@@A@@CC@A@@@A@@@A@@AA@@@@A@@AA@@A@AAA@@@@AAAA@@@AAAA@@@@@A@ABABA@@BABA@ABA@@@@@AB@B@@A@A@AB@@ABB@ABA@@BCDAB@@@BA@@@AD@BA@AB@B@BA@@B@BA@A@CAA@A@AAAACA@@@A@@@A@A@@@ABABA@@@@A@AA@@@@@@AA@ACA@A@@AC@@AA@@@AA@A@@A@@A@A@@@A@ABC@ABA@@BABAB@@A@A@A@@@@@AB@@@@@BA@A@@@A@A@@@@C@@AA@@BA@A@@@@AC@@@@@AA@ABA@@@@@@B@@AB@@A@AB@@B@@@@B@@A@A@@BAA@BA@AA@@A@A@A@@@A@@B@@AAAA@@@A@@A@A@@AA@@@A@@B@@@B@@@BA@@@BBABBB@@@B@@@D@@@@BD@B@@A@A@@@@@A@@@@B@BAB@@@B@BA@@B@@@@@@A@@@@@@BA@A@A@A@A@@AAB@AA@@B@@A@AA@@A@@A@@A@CA@@@AA@A@@@@B@BA@@@C@@@@@A@@@@AA@@@@@A@@@@@@B@B@@A@ABCBA@@@AB@@@@A@@@AA@@A@AAABCA@AAAAAA@@@@@AA@BAA@@A@A@@@AD@@A@@@A@@A@@A@A@A@@AAB@@@@AB@@AAA@@@AA@@AAA@CA@AA@AA@A@@AA@@@@AA@@AAA@@A@AAACA@@A@AAA@AAA@A@@AAAA@A@@AAAA@@A@@A@A@@@@@@@@@@AB@@@@A@@@@@@@AA@@A@@@@@AA@@A@@@@AA@AAA@@@@C@@@AA@@A@@AA@@@@@@@A@@A@@@@@@AA@@@A@@@AA@@A@A@@@@@@@AAA@AA@ABA@ABA@@@@ACE@@@AAEAA@C@AAA@@BC@@AA@@@A@AAA@@@A@@@C@A@A@AAABC@A@@@@@A@@@@@ABAAA@@@A@@AA@A@C@@@A@A@@AA@@@A@@AAAA@@B@@@B@B@B@@@B@BA@@B@@A@@B@B@@@B@@A@@B@@A@@@A@@@@@A@@@A@@A@@A@@@A@@A@A@@@@AA@@@@@AA@@AAA@@@@@@A@AB@@@@A@@A@@@A@@A@A@@A@@AA@A@@@@AA@CA@@BA@@A@@@AA@A@@@A@@@@@@AAA@A@@@@A@@AAA@@@@A@AA@@@AABA@@AA@A@A@@@A@@@A@A@@@A@@@@A@AAA@@@@A@@@@AA@@BCA@@@@A@@DA@@@@@A@@AA@@ABA@@BA@@AAAA@@A@AA@@@BA@@@@B@@@BAB@DB@@@@B@@A@@@@B@BA@@@@@AA@@@@B@@@@@@AAB@A@@A@@@@B@@@B@@@BA@@@ABA@@A@A@A@@B@@ACAAAA@@@AA@@@@AA@BAA@@A@@B@@A@@A@A@@@CA@A@@@@@A@@BA@A@@@@@@@A@@AA@@@@A@@@A@@@@@A@A@AAA@@A@@BA@@A@CCA@@@@A@ACCCCA@@A@@AA@@AA@@@A@@@A@@@@A@@@@@A@@@AA@@A@AAA@A@@A@@ABc`kC@@@@@A@@@AB@@C@@BA@A@@@AA@@AA@@A@@@A@AA@@AAA@AAA@A@@A@A@ADABA@@@AA@AAC@@@A@@@A@@@AB@@@@@@AA@AA@AAA@A@@@@@A@@@A@@@@@AA@@@@ABA@@@@B@AA@A@@A@A@@AA@@@ABA@@@@@AB@@@@@FA@@@@BA@@BA@@@@BA@@B@BAB@@A@A@CA@@@@@@AAA@@@ADAB@@A@@AAAAAA@@@@A@@AA@B@@@@AD@BA@@@A@@@@@@@@BA@AB"],encodeOffsets:[[119460,28357]]}},{type:"Feature",id:"361028",properties:{name:"资溪县",cp:[117.060263,27.706101],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@C@@AA@@@AB@@@@A@AAA@@@A@@@A@@B@@BB@BBBABB@@B@@@@A@A@A@@@@@AAA@@AA@@@@BA@A@@@@@@B@@ABA@A@@B@@AB@@A@ABBBBD@@@B@@BB@B@@@DA@@BABA@AB@@A@@DB@@BBB@B@@B@BB@@BBBB@@BB@@@@@@@B@@@B@@AB@B@BAB@@AB@B@B@BBB@@BB@@@B@@B@BB@BB@@B@@@B@@C@A@CB@@A@AAAA@@A@@@A@AA@@A@AAA@@@ABA@AAA@A@@@A@@@A@@@A@A@@@A@@BA@@@AAA@@@A@A@A@AA@@@@AAC@A@A@@@AAB@@A@@C@A@A@A@@AC@E@@@A@@AC@AAA@@@A@ABA@ABA@@@@BA@AB@@@@A@@@AD@@@BA@A@@@A@A@@@C@@@@@AB@@@BBB@@A@A@@B@@@BA@@@@@@B@@@B@@A@@@A@@@A@A@A@BB@@@BB@@@AB@@A@@@@BAB@@@BAB@B@@@@@BBDBB@@@@BB@@@@BBB@@@@BBB@@AAA@@@@BA@@@@BA@@DA@@@AB@@@@B@@BBBBB@@B@@BB@@BB@@@@@BA@@BBB@@B@@BB@@B@BB@@@@@BB@BABB@A@@B@@@D@@@B@@@B@@ABABB@@B@B@@@B@@AB@@@@@B@@@BB@@B@B@@B@B@D@@@@@B@B@@@@BB@@B@BBB@B@B@BB@@@B@@@B@@BBBBB@B@B@B@BBAB@@@@@BA@@BA@@@@BAB@@@BB@@B@B@@A@@F@BBB@B@@@B@@@B@@@@A@ABC@ABA@@BA@A@ABA@A@ABABABA@@@CB@@A@A@A@@BA@AB@@A@A@AB@@A@@@A@@@@@@BAB@@@@AB@B@@ABCB@@@AA@@@@@A@@B@@AB@@@D@B@@@B@@@@@BA@@@BB@B@@ABBB@@@B@@@B@@@@A@@@BB@B@@@@@@ACA@@@@@AB@@@@BB@@@@@B@@@@B@@@A@@@@@A@@@AB@@@@@A@@@@A@@B@@AB@AA@@@A@A@A@@A@@A@@@@AAA@ACAAAA@C@@A@A@@@AA@@AA@@@@@@AABAA@@CAAA@@AA@@AAA@A@AC@@@@@@AAABAA@AA@AA@@@@A@@@A@@@@@@AABA@@@AB@@@@BB@B@@@BB@BB@B@@@B@@@B@@@@@B@@BB@@B@BBB@@@@D@@AB@@@B@@@@BAB@B@B@@BB@@@@B@@@B@@@@ABA@@@AB@@A@@@DB@@@@BB@@AB@@C@@@@@A@@AA@A@@@AA@@@@@@@B@@BB@@A@@@@@BB@@@BA@A@A@@@AAC@AB@@@@@BB@@B@@@@@D@@@@@B@@BBBB@@B@B@@@@B@@@B@@@B@B@@@BB@B@@BBB@BBB@@@@@B@BBB@@@B@BBB@@B@@BB@@@@B@B@@@B@@@@AB@@@B@@AB@@AB@B@@@@AB@B@@ABA@@@@@A@@B@@@@@B@BAB@BA@@B@@@B@BAB@@@@@B@@B@BB@@@@@BB@@@AB@@@B@@AB@BAB@@@@@@@B@@@BB@BB@@@@@B@@BBBB@@BB@@A@@@A@@@A@@@A@AB@@@@A@@@A@A@@BB@@BB@DBDA@BB@@@@@B@@@@@@BA@@BA@AB@B@@@@B@@AB@@@BA@@B@B@@@@@B@@ABA@@@@B@B@@A@@BB@@@@@@B@B@@AB@@@@@BB@@BA@@@@B@@@@@@A@@B@@A@@@AB@@@@A@@@AB@@AB@@B@@@AA@@A@@@A@@@A@@@@B@@@@@B@BB@AB@@BB@@B@B@@@@B@@@@B@@@B@@@BBB@@@@@@B@B@B@BAB@@@B@@@@AB@@ABAB@BB@A@@@BB@@@@BB@BA@@BB@@B@@@BBBB@B@@@D@@BBB@@B@B@B@BB@BB@@BB@@A@@B@B@@ABAB@BBB@BA@@@@B@@AB@@@@@B@@@B@@AB@@@@DB@@B@@B@@BA@@B@B@@B@@@@@B@@A@@BAB@@@@@BBB@@AD@@B@@@B@@B@@@B@@C@@@AB@@A@A@@@@@AB@A@@A@@@@A@BA@@BAB@BB@A@@@@@@@@@A@AAA@@A@@A@@@AA@@A@@A@@@@A@@A@@@@@B@B@BA@@@ABA@ABAA@@AB@@@@AB@@A@AB@A@@@A@A@@@@@AA@@B@@AB@@@B@B@@@@@D@B@B@B@BBBA@@@@B@@@BA@B@@BB@@@B@ABA@@@@BB@@@@@AB@@ABAB@@B@@BAB@@@B@@A@@BAAA@@@A@@AAB@@A@CB@@@AAC@@AB@@A@@@AB@BABC@@@@@@A@@@A@@B@@AA@@AAB@@@@@B@@@AA@@@@@A@@@@@BAAAAA@AA@A@A@A@@BC@@B@@@B@@@@@B@@@BA@@@AB@B@@@@A@@@@B@@CB@@@B@@@@@BA@@@@@AAC@A@@@A@@BAAA@C@@@@@@B@@@B@@@D@@@B@B@@AB@@@B@@@@@BB@@D@@@@@BB@@@BAB@@@@@B@@BB@A@BB@B@@@BBABB@@@@@BB@B@@@B@@B@@@@@@@BA@@B@@@B@B@@B@@@@@AB@DA@@@@BB@@@@B@@@@@BA@@@A@@@A@@AA@@@@BA@@@A@@B@@A@@BA@@@A@@@@@@@A@@@A@A@AA@@A@A@@@@CA@@@@BA@@@@@AB@@@B@@A@@B@@@@CAEA@@A@@@AA@@C@C@@@@DAB@@@@@@BBB@@@@@@B@B@B@@BB@@@@ABA@@@@BA@@@@B@@@BAB@BABC@@@@A@BA@@@@BA@@@A@A@@@@BA@@@ABA@@@A@A@A@@@A@@@BBBB@@@B@@BB@@@B@B@@@D@BBB@@@B@@BBAB@B@@@@@B@@@@@BADBB@B@B@B@D@@@B@@BB@B@B@@BB@@AD@@BB@B@DBBBF@B@@DF@B@@B@BAB@BAB@@BBB@B@@@@@@@B@BB@@B@@@B@@BB@@@@@@@BB@@@@@@@B@@BB@@@BB@@D@@@@@BB@BBB@@@@@BB@@B@@@@@BB@@B@@@@@@@B@@A@@B@@@@@@@@B@B@@@@BB@BB@BB@B@BB@BB@B@BBB@BBB@@@DBBB@B@BB@BB@@BB@@@@BB@@@BBBB@@BDBB@BB@@BB@@B@BB@@BA@@@@BA@BB@B@B@@@@BB@@@B@@@BC@@B@B@@@BB@ABB@@@@B@BBBB@BDBBABBB@@@BB@@B@@@@@BA@@B@DABAB@@@@A@A@@@@B@@@@@B@@B@@B@@@@@D@@@B@@A@A@@B@B@@B@@DBB@@@@BB@@@BBB@@@@AB@@BBA@BB@B@B@B@B@@A@@@@B@@@@@@@@AB@@A@A@@BA@A@A@@B@@@@@B@B@@@@AAC@@@@@C@@@A@@AABAAA@@B@@A@@@A@@@AB@@@B@@BB@B@@@@B@@BBBB@@@AB@@@B@B@B@@@BBB@@ABB@AB@B@@@@A@@A@@@BAB@@@BA@@@A@@@@B@BAB@@B@@@@BD@@@@@BAB@@BB@@@D@@B@B@@@B@B@@A@@@@BA@@@@B@B@B@@@BABA@AB@BAD@BAB@@@B@B@@@@BB@B@@B@@BB@@BD@@@BDB@BBB@@@@@@BBB@@@@@ABAB@B@@@B@B@@@B@@DBBBBBB@B@DBB@B@@A@AB@@A@ABAB@@ABC@@B@@A@@BADC@AB@BAA@BA@@BAB@B@@@@ABA@@@@B@BAB@BA@AB@BABAB@@@@@B@BB@B@@BBBB@@@@BB@B@BB@@BB@@@@@BB@@@B@@@B@@@BDD@@@B@@@@@@B@B@DBB@@@B@BBDDBB@@@@@B@B@@@B@BAB@@@@@B@BBBAB@B@@B@D@@AD@B@B@@@BA@@@@@@B@@@B@B@@@B@@@@@@@BB@B@@B@BB@@DBB@@@B@@@@@B@B@B@@@@@FBDB@@BA@AB@@AB@@@BA@@@@D@@ABA@@@@BA@@B@@AB@B@BA@A@A@A@AA@@ABC@A@A@A@@CC@@@ABABBB@B@@@@A@@@@@@B@@@BA@@@AB@@@@@BA@@D@@@@@@@@@B@B@B@B@@@@@B@@@B@B@@A@A@@@A@@B@@@BABB@@@CACBA@@@A@@@ADBDBB@D@B@F@@D@@@@@BB@@B@B@B@@@@@@BD@@BB@BBB@B@@@@BBA@@BB@@BB@@B@@B@B@@@@@BB@BBB@B@@B@@BBA@@BB@@B@@BB@@@ABB@FF@@BD@BB@@@AB@@BBB@BBB@@B@@@BB@B@@@BB@AB@@@BBB@@@@@D@@BBB@@@BBB@B@@@@@@@B@BB@@B@@@B@@@BADB@@B@@B@D@@B@@@B@@BBB@B@@@B@@@@C@A@@@A@ABA@@@CB@BA@@BA@ADA@@B@@A@@@A@ABABA@@BA@@B@BA@@BB@@BBBA@@BBBB@@BB@@BB@B@@@BA@@BB@@B@@@@BBA@@B@BB@@@@BBB@@@BAB@B@DB@@@@BADA@@@B@@B@@BBB@@@BB@@B@@@DD@BB@@B@@B@@B@B@B@@B@B@@BB@B@@@BBB@@@BB@AB@BA@BB@BB@AB@@A@A@@@A@@@@@ABABC@A@@B@@ABAB@BCB@BA@@DA@@B@@ABA@A@A@@@@B@@A@@BAB@@@@@@@B@@@@AB@B@@@B@B@BAB@@@@@B@@BB@@BBBB@@@@@@BBBB@@@@@BBBB@B@@@BB@B@@@B@B@@B@@@@B@B@D@@AB@@BB@BAB@@@@@@C@@@@BAA@B@B@@@AAA@@@@@@@@ABA@@B@@A@@@A@@DA@@B@BAB@BC@C@@@@B@B@B@B@@@BBB@@@@@BB@@BB@B@@B@@B@BBA@@BAB@@@@AB@@ABABA@@@@BA@@@@BAB@@BB@@@@F@B@@@B@B@@BB@@BABABA@@BA@@@@B@@ABB@B@@@BBB@@B@BA@@BC@BBB@@@BB@@BBB@B@@BBBB@@B@BB@@@@@@@A@@@@B@B@@BD@@B@AB@BABA@@@AD@D@BAD@B@@ABABA@@B@BB@@@@DA@@B@@@@@BABABA@A@A@@BC@ABA@@BA@@BA@@B@@A@@B@@@B@@A@@@AB@A@@AB@@ABA@@@AB@B@@@BAB@BAB@BB@@BA@@B@B@@B@@@@BAB@@B@AB@@A@@B@@@@A@@BA@ABC@ABA@@BA@@B@B@B@B@BA@@B@@@@ABA@A@@@@@A@@@C@@@AB@@@@A@@BABAB@@AB@BCHCHAJ@F@@@FCDCFEBCBC@CAEAE@EAA@GAEAG@G@GAC@CDEBEDEBCDEBE@EAEACCAAA@ACCAAACAA@@@AC@C@C@CBC@AAGDABABC@CI@EBEBEBABC@CAA@ACACAC@ACCCCAAECC@IACAACACBA@ABC@A@A@CACAAACEACACAAC@AACBCBC@E@G@E@CAC@CACAEA@@EBC@A@CAAACE@EAC@@@A@@@EBABE@CAC@C@C@GBA@A@A@@@@@@CACDE@CAA@CAA@A@G@A@AA@ACACAA@A@CAA@CACBCDGBGDGBCB@@AB@@C@A@AAA@@@@@ABABC@CBCBA@C@C@A@@@CACAA@A@@@ABABADCBA@A@A@@BAB@@A@E@@@A@A@AAAAAACCAC@@C@CA@@A@@A@@BA@CA@@AAACAAAC@@AAAEM@C@@@@@@@AD@FCBCHMLS@AAA@@AAA@A@A@A@@A@A@G@CBAB@BA@AB@AACAAAC@CACAAAE@CBCDABKJGHCDABAD@DBD@B@DABABCBCDCDEFCFADADEDCBC@EBC@ABCAEAC@C@C@AAC@AAACCCEAAACACAAC@@ACAAA@CE@CBG@CBCEIAEACACAAA@EDABA@A@@A@CCAA@@AAA@@AA@@@@@AB@BABABCBCBADCDABADABA@AACCCAA@CBAD@DABADA@A@C@@@@BCBCDC@CCC@C@EAIAE@@AC@CAECE@C@CBA@ABA@ABABABADABADADAD@DAD@B@B@B@DADA@AB@@@@AA@@AC@CCA@@@CBA@AACAA@@@@A@@B@B@BABABADA@A@ABA@ADE@@@AA@@@EBCBC@A@@@@@A@@A@AA@AA@@AAAA@A@EAA@A@@@@C@AAA@A@CBABA@AA@@@@@C@A@AA@@AEAA@A@A@EB@@AAAA@AAC@A@CAA@A@@@AA@@@AA@A@@AE@AA@@AA@CCCAA@AAA@AAEAA@@@@@A@A@@@C@CAA@@AAAA@@ABC@@@@A@@AA@AAA@AAC@@@AA@@@A@C@C@@AA@AA@EAA@@@@@A@@@@B@@@@ABB@AB@@A@ABA@AB@@@@@B@B@B@@"],encodeOffsets:[[119765,28143]]}},{type:"Feature",id:"361030",properties:{name:"广昌县",cp:[116.335686,26.843684],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CC@@@@AB@@A@AA@AC@GACAA@A@CA@@A@CB@@A@@AA@@A@@A@AB@@A@A@@@AAAA@A@@A@@@@@@@A@@AA@AAAAAACA@@@@@@AB@@@BA@@@CDA@@B@@A@@@@@@B@B@@AB@B@@@BCB@@AB@@A@ABA@CB@@AB@@@@BB@@AB@BA@A@A@@B@@@BA@A@@BAB@@ABA@@@@@@@@BA@ABA@@BA@C@@@CBAB@@@@BB@@@D@@@@@BB@ABABA@@@E@@@A@@@AB@@ABABABABAB@@A@@@A@A@A@CBAB@B@AAB@@A@@B@@@@A@A@A@C@@@A@@B@BCDA@ABE@@BA@BB@@@B@B@@@B@@@BB@B@BD@BBB@BB@B@@@BBBB@BB@@@BBDDBBB@B@@@B@B@@@@BB@@B@@B@@AB@@@B@@@BBB@B@@B@@@BB@@DB@B@@@@BB@@BAB@B@@@BB@BB@BBB@DBB@@@BA@@B@@A@@B@@@BBD@BA@@B@@A@A@@B@@AD@@@BABAB@B@@@D@B@@CBAB@@AB@B@@AB@BAB@@A@@BABA@@@C@@@A@@@AB@BA@A@A@@@CD@BAB@@A@A@@@A@@@AB@@@BA@ABA@@@A@@B@@AB@@A@@B@BBB@B@@B@B@@@BB@@B@@B@DB@@@@@B@@@B@@@D@@@BAB@@@@@DBFD@@@@AB@@@@@BBBBB@@B@BB@@@@B@@@@BB@@@BBB@B@@BBB@@@@BA@@@@@B@B@@D@BB@@BBF@@@@AB@@@BBB@@@@BBBBD@BB@DB@B@@@B@@@BAB@@AB@BAB@D@@@B@D@B@B@@A@A@A@A@A@@@AAC@A@AAC@A@@BCB@B@BA@@@AAAAAAA@AAA@AAEC@@A@@@C@A@@@A@@@AB@@@@A@C@AA@@ABA@AACA@@@@A@@BCB@@@@A@A@AA@@@@AB@@AAA@A@@@@@AB@@@B@@BBB@@B@@@BA@@@A@@@AB@@@B@@AB@@@@BB@B@@@BA@@BAB@BA@AA@@CAAAEAAAA@A@@@A@@A@@A@@B@@ADAB@@@@A@@@A@AACAAA@@A@@BA@@@AB@B@B@B@BDD@@@@@B@B@B@B@@@B@B@@A@A@@B@B@@@@EDABC@@B@@@@@B@BB@@B@B@BA@ABAB@@@B@B@@@@AB@B@@BB@@@BA@AB@@A@ABA@@BA@@@CDAB@B@@AA@@@@@A@@@@A@A@CB@@@@AB@@@@AAC@@@C@A@A@AA@@@@BA@@A@@@A@@BA@@AAA@@AAC@@@A@A@A@A@A@@BA@@@@BCB@BAB@D@B@B@@@@AB@B@BA@ADA@@BABA@CBAB@@A@@C@@AA@@AA@@@@A@@@@A@@A@A@AA@@A@@BCBAB@@ADA@@B@BA@@B@@A@A@C@AB@@AB@@@D@@BB@@@@@B@@BA@BB@@BAB@@A@A@A@A@@@A@A@AB@B@BA@@B@B@@A@@B@@@BBB@B@@B@B@@@@@B@@B@@A@@B@@@@@BB@@@B@@AB@@@BA@@@B@@B@@B@@@B@@@@B@BB@@@BB@BBB@B@@@BB@BBB@B@@@@AB@@@BB@BB@B@BA@@@@B@D@@@@@B@@B@BBB@@@@@BB@@@@B@@@@B@@B@BB@@@@@B@BBB@B@@@@@B@B@@@BBB@@BBBD@B@B@@@B@@@BBB@B@B@@@BA@@@@B@@BB@D@BAB@B@@@@B@B@@@BB@B@@ABAB@B@@@B@@@D@D@B@@@BBBBBB@@@B@B@@B@@@@AB@BB@@@B@BBBB@BB@DA@@@@@B@@AB@BAB@BAB@@B@BA@@@@BB@@@BAB@@ABA@@B@@@BCF@@ABA@@@@D@@ADAB@@@BA@@@C@@BA@C@A@@@@B@@BB@@@D@D@@@@@BAB@B@@@B@B@@@BA@@B@@@@@@BBB@BBFD@@B@BB@@@B@B@BB@@B@@B@@B@DBB@B@@@B@@BB@D@BBB@BB@@B@@@BBB@B@BB@B@@@@B@@BB@@@@@B@BBB@@B@@@@B@B@@@@@B@@@@@BB@@AB@B@B@@@@@@BB@@@B@BBB@BBB@@@@@@@@B@@@BD@@@@BA@@@@B@@@B@@@B@@@BA@AB@@CD@@@B@B@@@BB@@B@@@@BBBB@BBBBB@BB@@D@BDD@@@BABABA@A@A@AAA@A@@BA@ABA@A@@@A@A@@B@D@D@BABA@BB@BBBBDB@@@A@@BA@C@A@A@@BA@@@@B@BABA@@@AB@@BB@B@@@BAB@@@B@D@B@@@B@B@@BFBD@@@BAD@D@@BD@B@@AB@@A@@BCB@B@B@@@B@@@D@D@@BBBB@@DB@AB@B@B@@AD@@@@@@BBB@@B@B@@BBB@@B@@B@ABABCBA@@BAB@@@B@BAB@B@B@@@@@BBB@@AB@@AF@D@B@@AB@@@B@BA@@@@AA@A@@@@@A@@BA@@@A@@@A@@B@@@@@@A@@@ABB@A@@@ABBB@@@@@@B@@B@@@B@@A@@BA@@@B@B@BBBB@@@AB@@@@A@@@AB@@ABAD@B@@@@@B@@@@AB@B@@@@@BB@BB@@@BAB@@@BBBBFDD@@@B@B@@BBFBB@D@B@@@F@BB@@B@B@@@BA@@@ABAB@B@B@@A@@BA@@AACAAAAAA@A@@A@@A@@AA@@@C@A@@@ABA@@@@@@AA@@A@@@A@A@A@@@AB@@@B@F@D@@@@B@BBB@@BD@@BB@@FAB@BBB@@@B@@@@@@AB@BBB@@BD@DBBBBBB@F@@@BB@@BBB@B@@@B@B@@@B@BB@@B@B@B@B@@@@A@@BAB@@@B@B@@@B@B@B@BBB@BBB@@@@@B@@@B@@@BB@A@@BCBC@A@A@@@@AAA@@@AB@@@@AA@AA@@AA@@@AAA@AAC@@AA@@@@AA@A@A@@A@@@@@@AA@ABC@@@@@AA@@@@AA@@AAA@@@A@ABADAB@@AB@@BB@@@B@@@BADA@AB@@@B@@@@A@A@A@A@A@C@A@A@@@A@@@@@C@@@CAA@C@@BCBA@A@A@A@@B@DAD@BB@AB@@AB@B@@A@@@ABBB@B@@@@@@@B@B@@B@@B@@A@@@B@@BB@@@@BA@@@@B@@@BD@@B@@@@@@B@@@@BB@@@@A@@B@@@B@B@@@@AB@@@B@@AB@@BB@B@BA@@B@@@@BB@@D@@@@BBB@@BB@B@B@@B@B@B@B@@@BB@@BB@@BBB@B@@@B@B@DBB@BBB@@B@BB@B@B@@@BB@@B@@@B@BB@@@@B@@A@@@A@@B@@@BB@@BBD@DABBBA@@BBB@@BA@@@@@@B@@@B@@@@A@@@@B@B@@@B@@@B@@@BD@@BA@B@@B@@B@BBDA@B@@BB@@@@@@B@@A@A@AB@@@BABA@@@AB@@@@@@B@BB@@@@B@@@@@@C@@B@@@@@@@BB@B@@@BA@@BB@@B@@BB@@@@@@@B@B@@B@@@@@B@@@@@B@B@@B@B@@@B@@@@@@B@BB@B@B@@@@@B@B@@BB@@@@@BB@DB@B@@B@@B@B@B@B@@AB@B@B@B@@@@AB@@@@BB@B@BA@@@BB@@B@@@@B@@@B@@@B@@@@B@B@BBB@B@@@B@B@BB@@B@@BB@@@B@B@BBB@BBBB@B@BAD@@@B@@@B@B@DBB@@@B@@AAA@@BA@@BA@@AA@@@@BA@CBAB@@@B@@@@@B@D@@@B@@A@@@@B@@A@@@A@@B@@ABE@@@ABCBAB@@A@@AA@@@A@ABA@@@@B@@@@AB@BB@@@@@@@@B@B@@@@@@B@@B@@BB@@@B@@@BA@@BA@@@@@@B@@@@@B@@AB@@@DAD@B@@@BB@@@@BB@@BB@@@@B@B@@@B@B@B@@@B@B@@@@B@@@BB@@@B@BA@@B@B@@@BBB@@AB@@@B@B@B@@BB@@@@A@AB@B@B@B@BAB@B@B@BBB@@@@@BBB@B@@@@B@BD@@@B@@AB@@@A@BAA@@@@@@ABAB@B@@@@@BB@@@@@@B@@@D@@@B@@@@BB@BB@BB@B@BA@BBB@@BBB@DB@@@@@B@B@@BBB@@BB@@AB@@A@A@@BAAA@AB@@AB@@BB@BB@@@@BBBABB@@BB@B@@BB@B@@B@@@@BB@@@@B@@@@DB@@@@BDB@B@@@BBBB@@@A@AB@@A@@BA@@@@B@@@BAB@@@@AB@BB@@B@B@@@@B@@@BB@@BBD@@@@B@@@BA@@@@BAB@B@@A@@@A@@@@@A@@@@@A@@@AAA@AAA@@@ABA@@B@@@@@@A@A@@@@B@@@@B@@B@B@B@DBFD@@BA@@@@B@BA@BBA@@B@@B@@@@@BB@@@@@@B@@@@@B@@AB@@@@@@@BA@@@BB@@ADB@AB@@@@@@A@@B@@@BB@@@@B@@@@ABB@@@BBB@B@@@B@@@B@@@B@B@B@DA@@B@B@B@@@@A@@@AAA@@@@@AB@BA@A@A@AB@AABC@@@AA@A@AA@@AAAA@A@@@A@@@AAA@@@AAA@@@@@A@@AAA@@A@@@@@A@@@A@C@AB@@A@@BA@A@A@AB@@@B@B@@ABA@@@A@A@@AABAAA@A@CA@AA@A@C@@@@BA@@BAB@BA@AB@@@@AA@@AA@@AA@@@@CAA@@AAAA@@AA@C@@@@@A@@@A@@@@B@@@B@@@AA@AB@@@CAA@@A@@@@BCBA@@DA@@B@@@B@BA@@B@B@@@BAB@@@@@@A@A@@@A@@A@@A@@AA@@@@A@@A@@AAA@@@@A@@@AAA@AA@@A@@A@@A@@BAB@B@BA@@BABAB@B@B@@@B@@@@@B@BBB@B@@@@AB@@@@A@@@@@A@@@AB@BA@@B@@A@B@@B@@@B@@@@A@@B@@A@A@@@@@@D@@@@ABA@@BAAAB@@AD@@@@AB@B@@AB@@BB@@A@@AA@@@@AAB@AA@@B@@CB@@@B@@@B@B@FA@@B@@AB@B@B@@@B@BAD@@@BA@@B@@@B@@A@@BAB@BAB@@@GEGKCEEEE[AE@E@SAK@ADIDODEDIDEJMBMEMACIMWUAGA@KIQGGIECCO@@AG@ADQDQHKDCPQLKBADEDCJEJQF@B@@@B@L@JAFOEKAA@K@CEMCWAW@ACIAKESMKA@E@CCAG@C@@A@@@AB@AA@EA@@@@@A@A@@C@@AAAA@@@E@A@@AA@E@CAA@@A@@@A@A@@AACA@@CAA@E@A@A@A@A@A@AC@@AB@@@@@B@B@BAD@DA@@BDB@@@BAB@BA@@@A@@@@B@D@B@@@B@B@BA@@DABABA@C@@@A@@BADAB@@CB@@A@A@A@@BABCB@@@BAB@@A@@BA@A@A@A@A@A@@@ABB@@B@@@BABABA@A@@B@@A@AA@@@@@BDD@@B@@@@B@@@BCDEB@BABCB@BA@A@A@CBA@ABA@@@A@@@CA@@ABAA@@@@BA@C@@AABAAA@@@AA@A@A@AA@@AB@@A@@@@A@A@A@@AAAA@@@ABA@@@A@@@CAC@@BA@@@AACACAAAA@CA@AAAEAAAA@@AAA@@@AAAABAAAAAA@A@@@A@@@A@ABA@A@A@AAA@CC@@@AA@AAC@C@@@@@AA@@@AAA@@AAA@@@C@@@@@ABA@C@@@A@G@@@AB@@@@AAA@GCCAA@AAA@AAAAAA@@A@A@@ACCAAAC@AC@@A@@@@@A@A@A@CBABAB@BAB@@CBABE@@@A@ABA@@@AA@@AACAAAAA@@@@@AA@@AAA@A@@A@@@A@@@@AA@ACA@AA@A@C@AAA@@@C@@B@@AB@BA@@@A@AB@@ABA@A@AAAAC@AAC@A@@@ABABA@@@A@A@A@A@@@CBAB"],encodeOffsets:[[119134,27149]]}},{type:"Feature",id:"361029",properties:{name:"东乡县",cp:[116.603559,28.247696],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ABA@ABA@@AA@@BA@A@@B@AAA@@A@A@@AA@@A@@EACAAAA@@A@@@AB@@BB@@BB@@A@@B@@A@@@CCA@A@AA@@A@@AA@@@A@@@AB@A@@@@A@AC@@A@@@@B@@@B@B@@A@@BA@ABAA@BA@A@A@AB@@A@@B@@A@@AAB@@A@@B@@@B@@A@@@@@A@@@@@@AA@@B@@@A@@ABBBA@A@A@@@@B@@@@A@@@@@A@AB@@@BAB@@@@@B@@B@BDB@@@@B@@@@@@AB@@@@@@@B@B@@@@@B@@B@@@@@@@DB@@@@@@@BAA@@@B@@@B@@B@@B@@@@C@@@@A@@A@AB@@A@@@A@A@@B@@@@@@@B@@AA@@@@BA@AA@@A@@C@CAA@A@@@@@@@@@A@@@@@@@@AA@@@A@C@@@A@@@AB@@@B@@@@@@@BAB@@@@@@A@@@@@@@@BB@@@@B@@B@@B@@A@ABAB@@@BA@@@@BAB@@@@@@AB@@@@@@@B@B@@@@@AAA@@@@@BA@@@@@A@@@@@@B@@@@@@@@@A@@A@@@@@@@@@BAB@@A@@ABA@@@A@BBA@@@@@@@A@ABB@@@B@BBA@@@BB@@@@@@A@@BABA@@@@@@@BBBA@@B@@@@@@B@@@@BB@@AB@@@@EDAB@@@@@F@@@BBB@@A@A@@@@@@BB@B@@@ABAAA@@BB@@@BB@@@@A@@B@@@@@@@BAAA@@@@@A@@@@@A@@@@@ABAA@@@@A@@@A@ABA@@@@@A@@@@A@@@@@AA@BA@@AAA@@B@@CAA@@AAA@AAA@AB@@A@A@AAA@@@@@@@AAA@CAABA@@@AAC@@BA@AAACB@B@@A@@@A@@@AC@@@@AB@@A@@@A@@B@@@@A@@@ABA@A@@@A@@@AAA@@@@A@@AB@@@@AB@B@@@@A@@@A@@AA@@ABA@@@@A@@@@A@@@A@@B@@@@A@@@A@@@A@@A@@@ABA@@@ABA@A@@B@@A@@@A@ABCB@@@B@@@B@@@@AB@@@BA@@B@@@AA@@B@@@@@B@@@A@@A@@@@B@@A@@@BB@@AB@@A@@@AB@@@BA@@@@B@@B@@B@@BA@@@BB@@@@B@@@B@@@@A@A@@@@B@@A@A@@@ABABA@AA@@@A@@AA@@CDEBCB@@@@AA@@@@@A@@BAB@B@BA@@@A@A@@@AA@@@A@@@E@ABAB@@CD@@@@A@@ABAAA@AACAAA@@@A@@@A@@@@B@B@B@B@@@@A@AA@AAA@@AACAA@@@A@@@@B@@@B@B@@BBD@@@@B@@@@@@@B@@@@BBAB@BBD@@@B@B@@@B@@B@BD@B@@@@ABA@@D@@@@CB@@AAA@A@@@@@@BBB@@CB@@@@AA@@@@A@@@@B@BAB@@@@@B@@@@@@@B@@AA@@@B@@@B@@AB@@B@@@@@B@@@@@@@@AB@B@@@@@@@@@@@@@BA@@B@@AB@@@@@B@@@@ADB@@B@@@A@@B@@@B@@@@@BBA@B@@@@@BB@@@@B@@@@@B@BA@@@@B@@B@@BBB@@@B@@AA@@A@@@@@@AA@@A@B@@@B@@A@BB@@BB@@@@@@@BEB@@BB@BAB@@AA@AAB@@@@BB@@AB@AA@@@@@@B@@A@@B@@BB@@A@@@@B@@BB@@@@ABA@@@@@@@A@@@@@AB@@@B@B@@@B@@ABA@@@@@@A@@@@BA@@@@@@@AA@@BA@@@A@@@A@AD@@B@@@@B@@@@@@@@@AB@@@B@@B@@@@@B@@@B@@@@DB@@B@B@B@@@B@@AB@@@@A@A@A@A@@@@@A@@BA@AA@@@B@D@@@B@@@@B@@@@@@@BA@@BA@ABAB@B@@@@@@BAB@@AB@@@@@@@B@@B@BBB@@A@@BAB@@@@A@@DBBAB@@A@@AAB@AA@@@@@A@@BA@@B@@@B@BA@@@@@A@@BCB@BA@@@A@@@@BAB@@@@@@A@@@BB@@A@ABA@@BCA@B@@BD@@B@@@@@@BBB@@BB@B@@A@A@@@A@@@@@AB@@A@A@@@@@AD@@AA@@AB@@@B@@@@D@BB@@@B@@@@BBB@A@@@AB@@@B@@AB@@@B@B@@@B@@@B@@@@AB@@@@@B@@@@@@A@A@@BAB@@@A@@AB@@@@A@@@@@AA@BA@@@A@@B@@@@BBB@@BA@@@CAA@A@A@@@@B@@A@A@@@@@@@@BF@@B@@@@AB@@@A@@A@@@@@@BA@@B@@D@@@@@@B@@@@A@@AAB@@AB@@A@@B@@A@AB@B@@@@@BB@@B@@@B@@BB@@B@BB@@BA@@DB@@BB@@@BBA@@@B@B@@@@@@B@@@B@@@BA@@BA@@@@@A@@@@B@D@@@D@@@B@D@@B@@@@@B@@@BB@B@@@BBBB@B@@@@@B@B@@ABABA@@BAB@B@@@@BBBBD@@@@AB@@BB@B@B@@@B@BB@@B@@BABBB@@@BBB@B@@AB@BAB@@BD@@@BBB@@BBBA@@B@@@@@@BAB@@B@@@@B@B@B@BBB@@B@@@@@@@@E@E@@ACAABA@@@@B@@@@BBB@BBFABB@@DAD@B@D@@B@@BBB@@@@@@@ABA@@@@@@@C@ABA@C@@BA@@@@BD@@@@@@@A@@B@@@@@B@B@B@@B@@D@DDB@@@BBBBB@@@B@B@@@B@BBB@D@BB@@BB@@B@B@BBBB@BB@B@@@D@@@B@@AB@BAD@BBD@B@B@BA@@B@B@BAD@B@@AB@B@@BB@@@DAB@B@B@BBB@DBBBD@@@BB@BB@@BB@@@B@@@@AB@BB@@B@BA@@BA@CB@@@BA@@BA@@B@@@BDB@@BB@B@@@BB@B@@BB@@B@@@@@@BA@@@B@BBB@@@@@@@AB@BB@@@AB@@@BBD@BBB@@BB@@@D@@@BB@@B@@BF@@@BDD@@BDB@@B@@B@@@@AB@@A@B@@@@@D@@AB@@BDAB@B@B@@@@B@@B@@BB@@@@@B@@@@@@AD@B@B@@@BABAD@@@B@@@@@@@BBAB@D@@@@B@@@B@@B@@BB@BBB@@@@@BBBBBBBBB@@@B@B@@BB@@@@@BBB@B@D@BBB@@@BBB@D@BB@@@@B@@@B@B@@@BB@@@B@@@B@B@B@B@B@BAD@B@BFBB@BBBBD@BB@@B@@@@@BBB@@BB@B@B@@BA@ABC@A@C@A@A@EDABC@C@@@BB@BAB@FAB@@BBBBBB@@@B@B@@@@CA@@@@@BAB@B@@@B@BB@@@B@@@@@@@@@B@@B@@AD@BAB@D@B@BABBB@D@B@B@BB@@@BB@@@AB@@@@@@@B@DBBB@@BB@@BBAD@@@BABA@@@BB@@@@@B@@@B@@@B@B@BA@@BABA@@B@@@B@@B@@@B@@@BA@C@@@@B@AFAB@@CB@B@@BBD@@AB@@AA@@@@@B@B@B@DB@@@B@@AAA@@@@@A@A@@B@@BB@@BBB@@@@B@@@B@@@BA@@B@@BBB@@AB@BCB@@@B@B@B@B@BBDBA@@BAD@DAB@@BBBB@@AB@@BBD@DB@@@@@A@@@@@@B@@@@B@@DABAB@@@BA@@@B@BB@BB@B@B@@@@@BB@@BB@@@@@BB@@B@@@@BB@@@@@BAB@B@@@B@B@@@BB@BA@BBB@@@@BB@@BB@B@D@BB@B@@BBB@@BBB@@AB@B@@A@@B@BDB@@BB@@@@@@ABAB@@BB@DB@@@BAB@@@BB@B@@@B@@BB@B@@@@@BA@@BBB@@B@@B@@A@@B@@C@A@@@AB@B@@B@B@@@BBDB@@@B@@@B@@A@A@@@BB@BB@BB@@A@@BA@@@@B@B@@@@@BA@@@A@@A@A@@A@A@@B@@@BA@@@A@@@A@@@@B@@BBBBAB@@@@C@@@@@@B@BBB@@@BABB@@B@BB@BB@@@B@BAD@D@BAB@@@B@B@B@B@@BB@@@B@@A@@@AA@AA@@B@B@@@@BBA@@BABA@@@@@@BB@B@@@@B@BAB@@@@@BB@@B@B@@@BA@@@@CA@@@@@AB@B@B@@@@D@@BB@@B@D@D@@@BB@BBB@@@B@B@@B@@B@B@BBB@@@BB@@@B@@B@@@@@BA@@PCDABABAB@@ABEBA@A@C@@BC@@BA@@@ABA@A@@BA@@B@@@@ABA@@@AB@@@A@@AA@B@@@@AB@@ABAB@AA@AAE@A@AAABA@A@@@A@ABC@@@C@C@@AA@@AAAA@@@A@E@AB@@AD@B@@A@A@@@@AAA@A@A@@@@@AA@AA@@CB@@AAA@A@A@@D@B@BAB@@A@@B@@@B@@@BA@@@CB@@@@@BABA@@@@@AB@@@B@@ADABA@A@@A@@@CBA@A@@@A@ABA@A@ABAA@@A@@ACA@@@AB@@@@CBAA@@@A@AA@@AAA@@A@A@@A@@@AB@BA@@AA@@@@@A@A@@@@AAB@@A@@A@@@@@A@@@A@@@@@A@@B@@A@@A@@AA@AA@@ABA@@@@@AA@A@E@@A@@@@B@@@BA@@A@@A@@@@A@@@AB@@A@A@@@C@@B@@A@@@A@A@@AA@@@AB@@A@ADA@A@@AA@@@@B@@BB@@@BABAA@@@BA@@@B@@@BB@@@B@@@@AB@@A@@@A@@@A@AAA@@@A@BB@@@BB@B@@B@BA@@BA@@B@@@@B@@BB@BB@@BB@@@B@B@@@@@BABBB@BBBB@@BB@@BBDBBBBBB@@BB@D@B@DBBB@@DC@@BA@@@@BAB@@@B@@AB@@@DAB@BBBA@@BA@@@@BB@@BBB@DBB@BADCB@@@B@B@@@BB@@B@@@B@@AB@@A@A@@BA@@BAB@B@BA@@@ABA@@DABA@@@ABEB@@BB@@@@@BA@@BB@@BA@@B@@@@@B@@@BB@@B@@@BB@@@D@@D@@@B@B@B@BC@@@@@@B@@@BBB@@@@@B@@@FDBD@@@B@@BAB@BB@@BBB@@@DBB@@@@@B@B@BBBA@@BABA@@B@@A@@@@B@BABB@@B@B@B@BA@@BB@@B@@@@AB@@@@@B@@@BABBBBBBBBBB@@B@BB@@@A@@@@D@B@B@@A@@B@B@B@@@@B@@B@@@B@@@@@B@@@@@B@@A@@B@BBB@@@@B@@@BBB@@@@B@B@@@@@@@@@@@BB@B@@BB@@@B@B@@@B@@@B@B@@BAB@@@@@@@@B@@BDAD@BB@@BBB@@B@@B@BBB@@D@@@BB@@@@@BB@@B@@B@BA@@@B@@BB@@B@@@@@@BB@@B@BB@@AB@@@@@D@B@@@@ABA@ABA@@B@B@@@@@@A@@BA@@@A@AB@@@B@@@BA@AB@@@@A@AAA@@DC@@BA@@@@@A@@@ABC@@BA@@B@@A@@B@@A@@@A@@A@@A@A@@@@@A@@BA@AB@@A@@@ABC@A@@DC@AB@@A@A@@BA@@@@B@BAB@B@B@@AD@B@@@@@B@@@B@@@B@@@@@@A@@@@B@@@@@B@@@@BB@@@@BB@@A@@DBB@@@@AB@BA@@@ABA@@@@@ABA@AA@@@BA@@@C@@@@@A@@@@@AB@@A@@@A@@BCBA@A@AB@@@BA@A@@@A@@@ABA@@BA@A@A@@@A@@@A@@BABA@@B@@@@@B@@A@@BAB@@@B@@@@AB@@@@A@@BA@CBCBABAB@@@@@@AAABCB@DB@@B@@@@@@A@@@A@A@@@@A@@@AA@A@@@BA@@AA@@@B@@@@AA@@A@@AB@@@@@A@@@@BAAAAA@@AA@AA@@@@@F@JB@@@@@@@AAA@@AAA@@@A@@@A@@B@@@@@AA@@AB@@@B@@A@@@@A@@@@@B@@@B@@@@B@@B@@@DBB@@@@@@@@@B@@@@@B@@ABBBA@B@@@A@@B@@A@@BA@@@ABA@@@AB@B@@@@@BA@AB@BA@@A@@@@@@@BA@@@@@@CAAA@@@@@ADC@@BABA@@@@BC@@@@@@@@@A@@@@@@@A@AB@B@AAAAAAACAA@@@@@A@A@A@AA@@@BA@A@AB@@A@A@CA@@@A@A@@@A@A@@@A@@@@@@@A@@@@@@@@B@@A@@A@B@@@@A@@@@A@A@AAA@@@@@@@A@AB@@AAA@@@AB@@@B@@A@@BAB@@A@A@AA@@AA@A@C@@A@@AE@EAC@@@@A@A@C@ABC@A@CBA@A@E@@B@@A@@BAAA@@BC@@BA@@B@@@B@B@B@@@B@B@BA@@A@BA@@BA@@AA@@AAACAAAAAA@GCA@@A@@A@@@C@A@AAC@ABA@C@A@@BABAB@@@B@B@@ABADAB@@@@A@@@AA@@@@A@@@AB@B@@A@A@@@A@AAAB@@AB@@A@@C@@A@@@A@A@A@@@A@AA@@CAA@@AA@A@A@@@@@@AAAA@@@@@@A@@BA@AAA@@@@AB@@AB@@@@AAA@@A@A@@@@AB@@@@AA@@@@@@@@@BA@@@@AA@@@BB@@AB@B@@A@@@@B@@@@@B@@@@@B@BA@@BA@@AA@AA@A@@@A@@@@BB@@@@@B@@@@@@@A@@@A@@@@@ABABG@@BA@@AAKAA@A@@AA@@@CA@@AA@@@A@@AA@@A@CA@@AA@@@C@@AA@EA@@@@@@A@@AACC@@AAA@@A@@A@@@A@@@A@@A@@A@AA@@@@@A@A@@@@@ABAB@@A@A@@@@@A@@@A@@@AB@BA@C@@@A@@@A@ABA@AB@@A@AB@@ABA@@BA@@@@BAB@@@B@@@B@B@@BB@BA@@@@BD@@B@@@DAB@B@@@D@BB@AD@@@@BB@@@@@B@@@BA@@BA@@@AB@@@BA@@B@@BB@@@@B@@@@DB@@B@@@@@BA@@@A@@BB@@@@BA@@D@DBB@@@@A@@@CA@@A@A@ABCAA@@AA@A@A@@@@@@A@@A@@AAA@@@A@@AA@@AAB@@@@B@@D@@BB@@A@@AAAABAA@@CD@@AAAA@@B@@@AA@@B@BA@@@@B@@@@@BA@@B@@A@@@AB@A@@ABA@A@@@@@ABA@@BA@@BA@@BABA@@FABDBB@@BA@BBAB@BB@@@@B@@@@A@A@AF@B@B@B@@A@CBA@@@@@A@A@@@A@@A@@@CAAA@@@AB@@ABA@@BA@@B@@@@@B@@@B@B@B@B@@@BB@@BD@@B@BBB@BB@@BBB@D@B@BBB@B@@ADB@B@@B@@BB@B@D@BB@@B@@A@@BA@@BB@@B@@B@@BB@@B@BBBB@@@BB@B@@BB@@B@B@@B@B@B@@@@@BA@A@@B@@@@A@@B@B@@@B@@@@A@@@@A@@@@A@@@@@@B@@@@@@@@@@@AA@@A@A@@@@A@@D@F@@@@AB@B@@@BAB@B@@@@@BA@@BA@@BA@@DAB@BAB@BBDB@@B@@B@@BB@A@@@A@A@@B@@@BA@@@C@@@A@@@@AA@CAA@A@AA@B@@AAA@@@AAC@A@A@@AA@@A@EC@AAAA@@AAA@AA@A@@@@@A@@@@A@@@A@@AAA@@A@C@AB@@@@AAA@AA@@A@@@A@A@A@AA@@AB@@AAA@C@@AABA@@@A@@@C@@@A@@@@BAB@@AB@@@@CB@DA@@B@@A@@B@BABA@@@@BA@A@@@@AA@@A@@A@@@A@@B@@A@@@@BB@@@A@@AA@@B@@A@@@@AA@@A@@@AA@A@@BA@ABAB@@@@A@@@@@AB@@A@@B@BAB@@@@@B@@@@A@@B@@@@@B@@@B@@B@@@B@@@B@@@@@BBB@B@BBB@@BB@@@A@@B@@@@@BB@B@@D@@@@@@B@@B@@B@BB@@B@@@@B@@@@@BB@@@@D@@@B@@@@@@@@@B@B@B@B@@AB@BA@A@@BA@@B@B@@@@A@@@A@@B@@ABA@@@@@A@@@AB@@@@A@@@A@@@@D@@@B@B@B@B@@@B@@BB@B@DAB@@@@A@A@AAE@A@A@@@AA@@ABA@@B@@@@A@@B@@BBBB@@BB@@@@B@@B@@@@@B@@A@AB@@@BA@A@@@@AA@A@A@@B@B@@@@A@A@AB@@A@@@@@@@AA@@BAA@@@@@@A@B@@B@@@@@@AA@@AEA@@A@@B@@A@@A@@AA@@BA@@@AA@@A@@@@A@@@A@@AA@A@A@@B@@A@C@A@@@A@AACAA@@A@@A@A@@@A@@@CB@@A@@@@@AA@A@AAC@A@AB@@A@AA@@A@GC@@@C@A@A@AAEAA@AB@@@@AB@@C@CAB@@@BA@@B@B@@AB@@A@@BA@A@AACA@BA@AAA@@A@@@@@A@@@@B@@@@@@A@@CB@@A@@A@A@@AA@@@AA@@@A@@@@@A@@@A@@@A@AB@@@@CA@@AA@@@@AB@@C@@@AA@@A@@BB@@B@@@@A@@B@BA@@B@@C@A@A@CB@@A@A@@@@BA@@AA@@B@@@B@BAB@@A@@@ABABABA@@B@@@B@B@@@@AAAAAA@@@@@BA@@B@@B@@@@@AB@@C@@@EC@@AA@@@A@AA@@A@@CB@@A@@B@@@@A@@@@ACG@@@AACA@@AAAA@@@CBA@@@@@@@BA@A@@@@@@@AA@E@A@@@@A@@A@A@C@A@@@@@A@A@A@@@A@@@@B@B@BB@@B@@@B@@@@@D@@@B@@BB@@B@@@BBBBB@@@@@@BBB@@@@@B@@B@@@BB@@@BA@ABA@@BCB@@BB@@@@@@@D@D@B@@CBAB@@@@@@@A@@@A@@@@B@@@@AA@ABA@@@@@BB@B@@@@@D@@@@B@B@A@@BB@BB@@@B@@@B@BA@@B@@@@@B@@ABA@@B@@A@CB@@@@AA@@EACA@@C@A@@BABABA@@@@BAB@BA@@B@BA@@BAB@BB@@B@B@@@@@B@@@@BB@@BB@@@@@@@B@@B@@@AD@@@@@@@B@@A@@@@@AB@@@B@@AAA@@BA@@B@@@B@@@@@BA@@BAB@@@BBB@@@@A@@BA@@@@BAB@@A@@@BB@@@BA@@@A@AD@@A@CBAB@@AA@@A@A@@@A@@A@@A@@@A@@@@@A@@AA@@@@@@A@@@A@@@@@@AA@@BC@A@@A@@@@BA@@A@@A@A@@ACCAA@A@@A@@@@@@@A@BA@AA@@AA@@@@@A@@@A@A@@@A@@@A@A@@@@@@A@AB@@@@@B@@@@A@@BA@@@CA@BA@A@@AA@@@@@AA@@@A@A@@@A@A@@@A@ABABA@AF@@@@@A@A@@@A@A@@C@@@@A@@@A@@@A@@@AA@A@@@A@@BA@A@A@@BA@@@A@@BCB@@@B@@@B@@@@A@AA@@@BBB@@@@@@@@A@@@@@@@@@@@@@A@A@C@B@@@AA@@@@AA@A@A@@DAB@@@AAA@A@@@@@@A@@AA@@BA@@@@@AB@@@@@@A@@@@AA@@@@@A@@BA@AB@@@B@@@@@@@@A@@@@@@DAB@B@@B@@@@DABA@@@A@A@ABA@@AAACA@@@@A@@@@BA@@BA@@@@@A@@@A@@A@@@@AA@A@@@@A@@@AAA@A@A@AC@A@@@AAA@AA@@CA@@@A@@@A@@@AAA@A@@@AA@A@A@A@@@AA@B@@@B@@@@@BAAA@@@A@@@@@BB@BA@CBA@A@@BAB@@@BA@ABA@@BA@@BC@@@@B@D@@@@@B@B@@AB@B@@@B@B@@ABAB@BAB@B@@A@A@C@@@A@@B@DA@GCA@@@@AA@@@@BA@A@A@@AB@@AC@@@@AAAAAEAA@@AC@@A@@A@A@@@@AA@B@@A@@@CBA@@A@A@A@A@@@AA@A@@A@A@AB@@@@AB@@CB@@A@A@@@@@@BAB@BABAB@B@@@BA@@B@B@BA@@@AAA@@@@@A@@@A@@@@@A@@@A@@@@@@B@@@@A@@AABB@AB@@@@A@@@@A@@@A@@A@@@@A@CAA@AB@@@@@@@B@@@@@@A@@@AAAAC@@@@A@@@B@A@@B@@@AA@@A@@AD@B@@@BB@A@@B@@@@AAA@@@@@A@@@ABBB@B@@@B@@AB@@A@AB@@A@A@@@A@@DA@ABA@@BA@@B@@A@@@C@ABABAB@@A@A@@B@B@@@@@@@B@B@@A@@@A@@AA@AA@@@BA@@B@@A@@@@@ABA@@@@BA@@B@@A@@@@@@@@BAB@@@B@@@BA@A@@B@@B@@@AB@@@BA@@@@AA@@@A@@@@@A@@@AB@@@@CBAA@AAB@@AA@@@BAB@@@A@A@@@@@@A@@B@A@AB@AAA@C@@@A@@@B@@BA@@@@@A@@BE@@@AA"],encodeOffsets:[[119339,28748]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,8 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("赣州",{type:"FeatureCollection",features:[{type:"Feature",id:"360702",properties:{name:"章贡区",cp:[114.921171,25.817816],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAA@@@AB@@A@@@@@C@@@@@@@@@AA@@A@@@@B@B@@@B@@@@A@@@A@@@@A@@@@@@@@B@@@@@@@@CACA@@@AB@BC@@@A@AA@@@@A@@A@@@@B@BA@@B@@@B@@A@CAA@@AA@A@C@A@@ASP@B@B@@ABAB@@AB@D@@@B@@@B@BA@@@@@A@@B@@@B@@A@@@AB@@AB@@@@A@@AA@@AA@@@@A@@@@AA@@BA@@B@B@@@@@@@@A@@AB@@@@@A@@@A@@@AB@@@@AA@@@A@A@A@A@@@@@@AAA@@CD@@@@A@@@A@@@@@A@@@@BAA@B@AA@@DABA@@@@AA@@@AB@@@@@B@@@B@@@BBB@@AB@@A@@D@@A@@@@@@@@B@@CA@@@@@B@@BB@@@@@@@@CA@@@@ADAB@@@@@@AB@@@B@B@B@@@BA@@@AB@@A@@AAA@@@@A@@@AAA@@A@@AA@@AA@A@A@@A@@@@@A@@@@@AAA@AABA@@AA@@@A@A@AAA@@@@@@A@AAA@AA@@@AA@AA@@@@A@AACA@@A@A@@AA@A@@@A@@@A@@@A@ABA@A@@@A@A@A@@B@A@@@@AA@A@A@A@@A@@A@@@A@@AA@AAA@@@AB@@@@ABA@@@@@A@@@@@C@@A@A@A@@AA@@AA@@B@@A@@AA@AA@@AA@@BAA@@A@@@AA@AA@@@A@@@@A@AB@@@@A@A@A@CA@A@@A@@BAB@@@@AA@@AB@@@@@BA@@@@@@@@BA@@@A@@@@@@B@@AB@@A@AB@@@AA@@@AB@@@BA@@@BB@B@@@@A@@BB@@@@@AB@B@@@B@B@@C@@@@B@@@B@@@BA@B@AB@@@B@@@@@BA@@@@B@@BBABB@AB@@@@A@@B@@A@@B@@A@@B@@@B@B@@@B@@AB@@ABAA@BA@A@@@@@A@@@A@@B@@A@@AAB@@@@@@A@@B@@@@@@@BABB@@@@BA@@@A@@@@BA@@B@@@@@@@@@@@B@@@B@@AA@@AB@@A@@@AB@@@@@BA@@@AB@@@@A@@@@@@BA@BB@@A@@@@@AA@@A@@@AB@A@@A@@@@@@@@B@DA@AB@@@AAA@@@A@@AB@@@@A@@@@@@@@BA@AA@@@BA@@@@B@@@@@BB@@@@@B@@A@@@@@B@@@@B@@@@@B@@@@@@@@@@@@@AB@@@@BBA@BB@@@@BB@@@@@@@AB@@@@@@@@B@@@B@@@B@@@@@@@@@B@@@@@B@@A@@A@@AAA@@@A@@@@B@@@@@@@B@@@@A@@@@@@@A@B@@B@@A@@@@@@@@@B@@@BB@@@B@@BB@@@@@BA@@BAA@@@@@@@B@@@@A@@@AB@@A@@B@@B@@@@@@B@B@@A@AA@@A@@@@@@AA@A@@@@B@@@B@@A@@@A@@C@@A@@@AA@@A@@@@@A@@@@BA@@@@@@@@@A@@@@@@B@@A@@@ADAB@BDBA@A@A@@BA@@@@BA@@B@@C@A@A@@@@B@@A@@@A@@@A@@@@BA@BB@D@@ADCB@BAB@B@@@BBBBDBBBBCBCB@@CB@AABBB@@@B@B@BB@@@@@@B@@B@@@@B@@@BADBB@B@@@@@@@B@@@B@@@BB@@BB@@@@@B@@@@@@@@BA@@@B@@B@@B@@@@BB@BB@BD@@A@@B@B@HBD@F@DBD@FDDB@BBDBB@H@FBDBBFDB@D@DB@@B@B@DD@@B@BBBB@@@B@@@BD@@A@@B@@@A@@@B@@@AA@@B@@@@@@A@@@@@A@@@@B@@B@@@BB@@@@@BA@@@@@@BB@@@@BB@B@@@@@@BA@@@BA@@@@@B@@BB@@@BABCBBB@@@BAB@BB@@@D@@AB@@BB@@@AB@@@@@@B@@@BA@A@ABA@@@A@B@AB@@@@@@@B@@@@CA@@A@ABB@@@@B@@B@@BB@@@@@@B@@@@B@@@@@@D@D@F@JA@@B@@EBA@C@C@E@@@CD@@AA@@A@@@@@A@AA@BAAC@@BA@BB@@@@@B@@BBA@@B@@AB@@C@@@@B@B@@A@@@C@@B@B@@@@A@A@A@@@@@A@@B@@B@@@@B@@@@A@A@@B@B@@A@@BA@@B@D@@@DAB@B@B@@AA@@A@@BA@@@@@A@@A@@A@@@@BA@@B@@B@@@@B@@@DA@@@@@@@BB@@@@@@BB@B@B@B@@ABA@CB@@AB@BA@C@A@A@A@@@@@AD@B@@@B@@A@@@@BA@@BA@@@@B@@@B@@@@@@@@@@B@@B@@@@@@B@@B@@@@@B@@BB@@@B@@A@@BBB@@A@@@@@@@@B@B@@@@B@@@B@@@B@@B@@@@BD@B@@@B@@@B@@@@@B@BB@@@BBB@@@@@@BA@C@@@@B@@A@A@@@@BBB@@@BBBABB@@B@@@BB@@@@@@A@@@@@@@@BA@BDA@@BB@@A@@@A@@BA@@@@@BBBBB@@@@@@@A@@@A@@@@@@@@@@B@@B@@@@BBB@@AB@@@@AB@BB@@BA@@B@@AB@@@D@@@@@BA@@B@B@@A@@@A@A@AA@@@C@@@A@@AA@@A@A@CA@@B@B@@A@@@@@@@@A@@C@@BA@@AA@A@@A@A@@@A@AA@@A@@A@@ABC@AB@B@B@@BBB@@@@@BB@@@B@B@@B@@@@@@@@@@B@@@@AAA@@@ABA@@@@BC@@@@@@BB@B@@@A@@BB@@@AD@@@@@BB@@@@@@B@BAB@@@@A@@@@@@@@@@BABA@@@@B@BABA@@@@BAB@@@B@@A@A@@@BB@B@@@@AAA@A@@AC@A@@@AB@@@BA@@B@BA@@BBB@@@B@@@AA@B@@B@@A@BD@@@BB@@@BB@@BBBD@DBB@@B@BD@@@@A@@B@@@@@@B@@AB@@B@@B@@B@@B@B@@@@@B@@B@@AB@B@@BB@@B@@@@@@B@@B@@BAB@@@BDB@BB@@BAB@@A@@@@@@@AB@B@@@@B@@@@@@@ABA@AB@@@@@BBB@@@@B@B@B@B@@@@@AB@@@@@B@@AB@@@@@@B@B@@@B@@@@@@BA@AB@@B@@@BB@@@B@@@@@A@@@AA@BA@@@@@@@B@@@@@BB@@@@BB@ABB@@@@@@A@@BB@AB@@A@@@A@@@@AB@@@@@@@@@@@BA@@@@@@A@A@@B@@@B@BA@@@@@A@@BAD@@@B@@BBBB@@@@@B@@@BBB@B@B@@B@@B@@BB@@@B@@AB@@@B@@@B@BA@@D@@B@@B@@@BB@@B@B@B@@@B@BB@@B@DAB@B@B@B@@@B@@@@B@B@B@@DBB@B@@@B@@@@@DB@BB@B@B@@@B@D@@@B@@@B@B@@@DBB@@@@@@BB@@@@@BBBAB@@@B@@@B@B@B@BA@@BADB@@BC@@@AB@@@BAB@@AB@AADA@@B@B@B@@@B@@B@@A@@@@@@@@B@BB@B@@BB@@@@@@@@BB@@@B@@AB@@@BAB@B@@A@@@B@BBB@@D@B@@@@@@A@@A@@AB@@@B@B@@@@A@@@@@@@A@@BA@@BB@@B@@B@@@@A@@B@@@@@@@B@@@@A@@@A@@@A@@B@@@B@@@@@B@@@B@@D@@@AB@B@@@@A@@@@B@@ABA@@B@@AB@@B@@B@@B@D@@@B@@@@@@BB@@@@@B@@B@@BA@@BB@@@@BBA@BB@@DA@@@@B@@@B@@@@A@@@A@@@A@@@@@A@@@@A@BA@A@@@@B@@@D@@@@A@@@C@@BA@A@AB@@@B@@@AAB@@A@A@@D@B@@A@@AG@@@ABC@ABC@ABEB@DC@@D@FAFAB@BAF@XADBF@DAB@D@DAB@B@DADADCBA@ABE@@@A@AAA@AA@@ACCAAAAA@CCAABABABAB@@@AA@@@@DA@@@@@@@A@@@@B@@@BB@@@@AA@@@A@@B@@@B@B@@@@@@A@@B@@@B@@@@@BA@A@@@@@@@@@@A@@@@@AA@@@@@A@@A@@@@@@A@@AA@A@A@AA@@@A@@@@@@@@A@@@ABA@@AA@@@A@@A@@B@@@@@@A@@@@A@@DA@AB@@@@@@@@@@AB@@AB@D@@@@@AAB@@A@@@@A@@@@A@@@@BB@@B@AA@@@@@@@A@@B@@@BABB@@@@B@@A@@A@@@BA@@A@@AA@@@@A@A@@BA@@@@@AAAAA@@BA@@@@@A@@B@@@B@@A@@@A@@AA@@A@@B@@A@@AB@@@@A@@B@@B@@B@@@@@@B@AB@@@AA@@BA@@@@B@@B@@BA@B@@@@@@AB@B@@BBBB@@B@@@@A@@A@@@@@A@@A@@B@B@@@BC@@@@B@@BBBB@@BBA@B@@BA@@BB@BB@DBB@@BB@@@B@@@@BB@@@B@B@@A@A@C@AAA@AB@@A@ABAB@@A@@@AB@BA@@@@AAB@@@@AAA@AA@B@B@@@@AA@@@@AB@@A@@B@B@@@@A@@A@@A@@@@@A@@@AB@@A@@@GBB@@@BDBB@@@@A@CB@@@@@D@@@@AA@BC@@A@@@@AB@@@@@A@@@AA@@BA@@@@CAA@@@@A@@B@@@B@@A@@@@B@@@B@@@@BB@B@@AAA@@@AB@@@F@DBFBF@BBJDHBBBBBHHBBDFDDDFB@B@D@BAB@@AB@B@BABA@@BBB@@@@B@@@@@@B@@B@@BB@A@A@A@ABA@@@@AA@A@@B@@A@@@@B@B@BAB@@@B@@@@@@@BA@@@@B@@@@@@B@B@@B@B@BA@@B@@@@B@@B@@@@A@@@CB@BA@@@@@@A@@@A@@@@@A@@A@@@@BA@@@CA@@@@@@A@@@B@AB@@@@@@@@@@B@@BB@@@@@@@AB@@@@@@C@@@AA@@@A@AA@@AABA@@@@A@@@@@@@AA@@@@@A@@A@AC@@B@B@@A@@AA@@@A@@AB@@@B@@A@@A@@@A@AA@@AB@@@@@@@AA@@@A@@@@@A@A@@AAB@@A@AAA@@@AAAA@@A@A@@@A@C@@@A@@@A@@@E@@@@B@@ABA@AB@@@@AA@@A@@@A@@D@B@BA@@BA@@@@B@@@BBB@@@B@BA@AB@@A@@@AAAA@@@AA@AB@@@@A@A@A@@AA@@@@A@@A@@BA@A@@B@@@@@@A@@@@@@A@@@@A@@@@A@@@@A@@@@@AB@A@B@B@@@AA@@@@B@@A@@@@@@@@ABA@@@A@@AA@@@A@@A@@C@A@@@@AA@@A@@A@@@@AA@AA@@A@@@A@@A@@AA@@@AA@@@A@@@@@AA@@@A@@@A@@@A@@@ABA@AA@A@@AAA@@A@AC@@A@A@@@A@@@@@@AAAAA@@@@@@A@AA@@@@@C@@@CA@@A@A@@AAA@@@AC@@@@AAA@A@@AAAA@AC@@A@@@@@@@@@@AA@@AA@AA@@@AAA@@AAA@A@A@A@@@A@@@@A@@@@A@@@BAA@A@@A@@A@AC@AAA@AAAAC@@AAAA@@BA@@B@@@DA@@B@BC@@B@@@@@B@@B@@B@@@DBAC@@@ABA@@B@AA@AB@@@@B@@@@B@@AB@BA@@@@@@@@@AA@A@AA@@@A@@B@DB@@DA@@@A@@@@B@@A@@@@@@B@@B@@A@@@@BB@@@B@@@B@@AB@@BB@@A@@B@@@DA@@@BB@@@B@B@@@@@B@@@@AB@A@@AA@@@@@@@@AD@@@@A@@@@BA@@@@@A@@@ABA@@@A@AA@@@@A@@A@@@A@@@@@AB@@@BA@@@A@AB@@E@@@A@A@@AA@@@@A@@@AA@@A@@@A@@@A@@@ABA@@B@@@B@@AD@BA@@@AA@AAA@AA@@@A@@@@EA@AA@@@@A@A@A@@A@A@A@CB@@AA@@AA@@A@AA@@A@@@A@@@@A@@@A@@@@A@@@AAAA@@@@@AB@AA@@CA@@@@@A@@AAA@A@@@@@@@AA@@A@AC@@A@AB@BAB@@@@@@"],encodeOffsets:[[117760,26302]]}},{type:"Feature",id:"360703",properties:{name:"南康区",cp:[114.765412,25.66145],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AB@@A@AB@@A@@BA@@B@D@@BB@B@BB@@@@@AAA@@B@B@@@@A@@BA@A@@B@BA@A@A@@B@BA@A@@@@@@@@B@@@@@BA@A@A@@@@A@@A@@@@BC@@A@@AB@@@A@A@@A@@@@D@@A@@B@BA@@B@BB@@@@@A@@@@@@@@A@@@@A@A@@AA@@@@A@AA@@@A@ABAD@BA@@@BB@@@BBB@@@B@B@@@@BBB@@AB@@@DB@@@@@@@@@@BA@A@@@DBB@B@@@BB@@@B@@@D@@BBB@B@@@B@@@@A@AB@@A@@@@@C@@BA@@@AB@@AA@@ABA@@@@BA@@AA@A@@A@@@@A@@@@@@@@B@@@B@@@@@@@A@AAAA@@@@B@@AB@@@B@@@AA@@CB@AAB@@@@@@@@@B@@@@A@@A@@@AA@BAAA@A@@AA@A@@B@B@@@@A@@D@B@@A@@@@A@AA@@A@@A@A@@@@@A@@@A@@@AAC@@@@@AA@@@A@@@A@@@@@@A@A@@@@@@B@@@AA@AB@@@@A@@AA@@@A@@@@@AA@@@@@@@@AA@@@@@@@@@@@@A@@@A@@B@@AB@@A@@B@@@@A@@@ABC@@@@B@B@B@D@B@@ABA@@DAB@BA@@@A@A@AAA@@@@@@AA@@@@@@B@@C@@@A@@A@@@@AB@@A@@B@@@@BB@@@@@B@@AB@@@BB@@@A@ABA@C@@@C@AB@@AB@@@@A@AB@B@@@@@@A@@A@@@@AB@@@@@B@B@B@@@@@@A@AD@@@B@@@@A@A@@D@@@BA@@@AB@AA@@@A@@@@AAB@@AD@BB@ABBB@@@@@B@@@BB@@DC@@F@D@D@B@FA@@@AB@@I@E@C@C@@@@A@@@@@@A@@@@A@@AA@@@@A@@A@BAB@@@DB@@@@@A@@@@@@BAA@B@@@B@BAB@B@@A@@@A@@@@A@@B@@AA@@BA@@@C@@AAA@AB@@A@AAADAB@@A@@AA@@@@@B@@A@@AB@@@@@@@AAA@@@@AA@@@@@@AB@@@@A@@A@@@AA@@@@@@B@@@@@B@@@@A@@@BB@@A@@@B@@@A@@@@BC@@A@@@A@@AAAAA@@@CCA@A@@@CAC@A@ECAAAC@E@GAAAC@ACAECC@CAE@C@GAA@A@@@@BC@@AAAA@@A@@A@@@@AA@@@B@@A@@@@@@A@@@@@A@@AA@@A@@@A@@@A@@@@@@@AAABC@A@@@A@@A@@@@A@@@@A@@A@A@A@@AABA@BDA@@DADAAAAAACAA@A@@@ABA@ADABC@@@CAAB@@A@@B@@@B@@@B@@@@A@@B@B@D@@@@AB@@A@@B@@AB@B@B@CA@ABABC@@B@@@@A@@@@B@@@@@@@@@B@@A@@B@@@@@B@@@BB@@B@@@@DB@@@B@@@@A@@@A@@B@B@@B@@@@B@@@BBB@@@@A@A@@@@A@@@@AB@@@BA@@B@@@@@@A@@@@@@BB@AB@@A@@@@AA@@@A@@AA@@A@@@@@@@@@B@@@@AA@B@@@@@@@B@@@@@@A@@@@@@@A@@B@@@B@BB@@@BB@@@@A@@@@@A@@@@@@@@@A@@@A@@@A@@@@@@A@@B@@@@@@AA@@@@AAB@AA@@@@BA@@@@@@@@@@@@A@@@@@A@@@@@@A@@@@@BA@@@@@A@@A@@@@@A@@B@@A@@BBB@@A@@@@@@B@@@@@BA@@@B@@BB@B@@BAB@@C@A@@@@@@B@@@@BBA@@B@@@BB@@@@B@@@AAB@@A@@@@B@@@@@BA@@B@@A@@@@BA@@B@@@BA@@BB@@@A@@@A@@@@@@@@@@@AB@@A@@B@@@B@@A@@A@BA@A@@@@@@@AB@@@@@@@BA@BB@@@@AB@@@B@@@@@B@B@@ABBBA@@BA@@@A@@@A@A@@@AB@@@@AB@@@@AB@@@@@BAA@BAAA@@@A@@B@@A@@@@@A@@BAA@B@@A@@@A@@@A@@D@@@@A@A@@@ABA@@@@A@@AB@@@@@@AAA@@B@@A@@BA@@B@@B@@BAB@@@BA@@@A@@@@B@@@B@@A@@@@@@B@@A@@@@BAC@@AAAA@A@@@AAAA@@@@AB@@@@CA@@@A@A@@@@@@A@A@CAAA@@AA@@BA@@AA@@@AA@@@A@@A@@AA@@A@A@@@@@@A@@C@@@AAA@@@AAA@@A@AA@A@@A@A@AA@@@AA@@A@AA@@@A@AAA@AAA@@BABA@@B@@AB@@@@@B@@@@@BADA@@BABC@@@@@A@AAA@AA@A@@A@A@A@A@@A@@A@@@A@@AA@A@@@@A@A@@@A@@@A@A@A@@@A@@@A@@A@A@@@AAA@@@AB@@AAAAA@@BA@C@A@@@@@ABA@@@@@A@@@A@A@A@A@@@A@@@A@@@A@@@@@A@@@A@@A@@A@@A@@@@@@E@A@AA@AA@@@@@AA@@@A@A@A@@@AA@@BA@@AA@@@@AA@@@@@@AA@@A@@@AAAA@@@A@A@@@@@C@@BA@@@ABA@@@@AA@A@ABA@ABAB@@AB@@@@A@@@@B@B@BB@@B@@@BAB@@@BAB@BAB@BA@@AA@A@@@@@A@@@A@@BAA@@A@@@@@@@@AA@A@@AA@@A@@@@@A@@A@@A@@A@@@@@@@AB@@@@@BA@@@@@@@AB@@@BA@AB@@@@AB@@@@ABA@@@@@AB@@A@@BB@@B@@@B@@AD@@A@@@AA@@@@@AA@A@A@@A@@@@B@@@@A@B@@A@A@@A@@A@@@A@A@@B@@@B@B@B@@AB@BAB@@A@ABA@@B@@AB@BA@@@@BA@AA@@AB@B@@@@AAAABC@@@@AB@@@@@@@@AA@@B@@A@@@A@@A@@A@@A@@A@@AA@@A@@@A@@@@A@@@@@AA@@@@@@BABA@@@AB@@@A@@@A@A@@@@@@@A@@A@@@A@A@A@ABA@@@AA@A@@@@@@@@@A@@@@A@@@@A@@@@@A@@B@AA@@@A@@@@@AB@@@A@@A@A@@A@A@AA@@@A@@@@@A@@AA@ABABAB@@@@@@A@@@A@A@@@A@@@A@E@AA@@A@AAA@AAC@A@A@AAA@A@@AA@@@AAA@A@AAA@A@A@A@ABAAC@@@A@@@AAAA@@A@@@ABA@A@CBA@ABA@A@@@A@AC@@@@A@A@@@A@@@A@@@A@@@@B@@A@@@A@@@A@A@@@A@A@@@A@@@A@AA@@AA@AA@@A@@@@@@A@AA@BA@@AA@A@A@@@AA@@C@@@AA@@A@A@@A@@AA@@@@@AB@@A@A@@A@AE@@@A@@@A@@@A@@@BA@@@@AAA@@@AA@@A@@@@A@@@A@@@AA@@@A@@@@A@AA@@@AA@A@A@@@A@@B@B@B@@CBA@@@@@AB@@AB@@@@AA@@@@A@@@A@@DABABA@@BAB@B@@@@A@A@CB@@@@@B@@@BA@@@@@BB@@@@AB@@AB@@@@A@@@@@ABA@@@@@@B@@ABA@AA@@A@@@A@@@A@@A@@@@@AA@A@@@A@AAB@@AAC@@@A@@@AAA@@AC@@A@A@A@@AA@@BA@@@A@@@@@A@A@C@@@A@@B@@@B@B@@@D@@@B@B@BA@@BABA@AAC@@AA@A@A@@@A@@@AD@@@@A@@@@AA@@@A@@@@@@BB@@D@@@BA@ABA@@@@AA@@BA@AB@B@@@@A@@@AD@@@@A@@@A@@@A@@B@@@DAB@@B@@BBB@@ABB@@BA@BBAB@@A@@B@@@B@@@B@@@B@@@@@B@@AB@@@@@B@@AB@@@B@@@@@D@@@@@B@B@@@BABABABB@@BAD@B@@@BAB@B@@@B@@CB@@@@A@@B@@A@@BAB@BA@@@AB@BAB@@AAA@A@CB@@@BA@@B@@BD@@@@@@@B@B@@@@@@@B@@@@@B@@@@@B@@@@@@B@@@@B@@@BB@@@@@B@@@@@@@B@@B@@BBB@@BBB@@A@@B@@@@@B@@@B@@@@A@AA@@@@@B@@@BB@@@BB@@@B@@AB@B@@B@@@@@B@BB@@@B@@@@@@@B@@@B@@@@A@@B@@A@@@AB@@@@A@A@@B@@A@@@@@@B@@B@@BB@@@ABA@@B@BA@@BA@AB@@BBAB@@BD@@BB@BB@@A@@B@BA@@B@D@@D@BBB@B@BAB@@BB@B@@@BAB@@@B@@@@@B@@A@@BAA@BC@@@@B@@AB@@@B@@@B@@@B@B@B@@AB@@A@@BA@@BA@A@@@@@AB@BA@@BA@@@@@A@A@@@@@@BA@A@@@AA@@A@@A@@A@@@@@A@@@@@@AA@@AA@A@@@@@A@@B@@A@@AA@AAA@AA@@A@@@@BAAA@A@A@A@ABAB@@ABA@@@A@AA@@C@@@A@C@A@AAA@@@A@@AA@ABA@A@@@A@@@A@@@@@C@@@@A@@A@@@A@@A@@ABA@@BA@@AC@@@CA@@A@A@A@@@A@@@@BA@@@A@@@A@ABA@@@AC@@A@@@A@@AA@A@@@A@A@@AA@AAAA@@@AA@@@AC@@@@ABA@@@@A@@A@A@A@AA@@@@ABAA@@C@@@@AA@A@A@@@A@@@AB@@CB@@AA@@A@A@A@@@A@ABEBABBBB@@B@@@B@B@B@@@D@@A@@BAB@@@@A@A@@@@@A@@AA@A@BA@A@@@A@ABC@AA@@@A@ABA@A@@B@@BDBD@@@BB@@BA@@B@@CB@@A@A@@B@B@@@@A@AB@AA@@AA@@@A@@@A@ABAB@@A@@@C@@@@AA@AAAA@@@@A@@B@@A@@B@@@BA@@@A@@@AB@@AB@@A@@BA@@@@B@@@B@B@@@BA@@BB@AB@B@@@@AB@@@@A@@A@@ABABAAA@A@A@A@@@@AA@@@@@AAB@A@@@AA@@AA@A@@@@A@@B@@@BA@@B@@@@ABA@A@@@@@@@A@@@AB@@@B@A@@AB@@@F@@DB@@B@@B@B@B@BAB@BAB@D@@B@@@@@B@@B@@@@B@BB@@B@@@@BBB@B@@BB@@@BA@BBBD@@B@@BB@@B@@B@B@@@@@@@B@BB@@@B@@@BABB@@@@BB@BBB@@@BB@B@@@@@BBB@B@@@B@@BB@@@@@B@BB@@@@B@@@BB@@@@@B@BDB@@@BB@@@@B@@BB@@@B@@B@@B@B@@@BBBB@@B@@@B@@AB@@@BB@@B@@@B@B@@@B@@@B@@B@@@B@@@@@B@@@@B@@@@BB@@@@B@B@@@@@BB@@BBBB@B@@B@B@@BB@@B@@@@B@B@@BB@@@BB@B@B@@@@@@D@B@@B@@BB@B@B@@@B@B@B@@@@B@@@@@B@@BB@@BDB@@@BBDBBB@B@BA@@@DD@BBB@@@BB@@B@B@B@@BBB@BB@B@@B@@@@@B@@@BADCB@@@@BB@@@B@B@DBB@@BB@B@@@BA@@D@B@BAB@@@B@@@BB@@B@@@B@B@@@@@B@@@B@@@BA@@B@B@@@@@BB@@@@B@B@B@@@B@B@DB@@B@@@B@D@@@@BDB@@BB@B@@@BA@@BBB@@@B@@@@BBD@B@@@@@BB@@@BBBBBCBAB@@AB@BAB@@@AA@@@A@@@@BA@ABA@@B@BA@@@@@A@@@AAAB@@A@@@@B@B@BA@@@A@@BA@@@CAAAAB@@A@@@@@@@@B@B@B@@A@AB@@@BAB@@@B@@@B@BA@@B@@BD@@AB@B@@AB@BABBBB@@@@@B@B@@@@@BBB@@@@A@@F@@@@@@CB@@A@@@@@A@A@@B@@BB@@@B@B@@@@@B@@@@@@@BB@@B@B@B@@@@AB@D@@@@BA@@B@@BBBB@@DA@BBDBD@@@B@@AB@B@@@B@B@@@B@@@@@@A@A@@@A@@AA@@B@@@B@B@@@@A@@@@@AA@AAA@@@@AD@@BB@@@@@B@@CB@@@B@@@@BBBBBB@@@@BBB@@@BB@@@B@B@@@@B@@BBBBB@@A@@@A@@@CDA@@BABAD@B@@A@A@ADAD@B@@@BBB@@B@@@@@@@@B@@@B@@BB@@@BA@@@AB@@@A@BA@@@A@@BA@@BA@A@@@A@@@@@BB@B@@BBB@@B@B@@FB@BB@@AB@@@B@B@B@BB@@@@@B@B@@@@BB@BB@@@@@@B@BB@BB@@BB@@BBBB@@@@AB@@@@@B@@@BA@CB@@@BBBA@AB@B@@@B@@ABABABAB@@@B@@BB@@@BB@@@@@B@BB@@BB@@@@@BB@@BA@@BA@@@AB@B@BABAB@B@@@B@@@B@B@@@@A@CB@@ABA@@@@B@@BB@B@@A@@B@B@@@@@BA@A@@@A@@B@@@B@B@B@@A@@@@@@B@D@@ABB@@@ABCB@B@B@@AD@@DB@BBA@@BD@BA@@@A@CAC@@@@@AB@@A@@B@B@@@@CA@AA@A@A@@@@@ABA@@@A@@A@@@@@A@@A@@@@AA@@@@@@BA@@@@A@@@@@B@B@@@@AAA@@@AA@@@A@A@@@@@AAA@A@A@@@AA@@@A@A@@@@@@BAB@@@@@BA@@BABA@@@@BBBB@@B@@@B@@@@@B@BAB@@A@A@@@A@@@@B@@@B@B@@AB@@C@@@A@@BB@@B@@@@BB@@@BBB@@AB@@@@A@@@@A@@A@@A@B@@@BB@BB@B@@@@A@@@@@B@B@@B@@A@@@AB@@@@@@AB@@@BB@@@B@AB@B@@@@@B@@BB@@@@D@@@@@BA@@B@@@@@@B@B@@@@BB@@ABA@@@A@A@A@A@@B@@A@@@AB@@B@@@B@BBB@@@@B@@@@@BA@@B@@BB@@@B@@@B@@@@B@@@FBB@@@@@@B@@@@BBB@DBB@@B@@@@BAB@@B@B@@B@@@D@@@B@@@BB@@B@@@@B@@@@@BBBB@@@@@BB@@BB@B@B@@@@@B@B@BA@@@A@A@@@AAA@A@@@@@@@@B@@@@BB@B@@A@@B@B@@@@@BAA@B@@A@A@@@@@A@BB@@@@@B@@@BB@@@@@B@@@BABBB@@@@@@@@B@@@@B@@@@@@BB@@@@@@@BB@@BA@@B@@@@AB@@BB@@AB@@B@@@@A@AB@BBA@@BB@@B@@@@@B@@@@@@BB@@A@@@@@@B@B@@A@@B@@@@@@BB@@B@@@B@@ABB@@@B@D@B@@@@BA@@@@@@B@@@BBB@@BB@B@@@B@@BB@BB@@@B@@@@BB@@@B@@@B@@B@@@BA@@@A@@@@BB@@@BDB@@BB@@@BA@@BAB@@@B@@@@@@B@@B@@@@B@@@BAB@@@@@BBD@@A@@@@B@@A@@B@@@@A@@@@BB@@@@B@@@B@@@@B@@BB@@A@B@@@@BB@@B@B@@@@@@B@@BB@@@@B@@@@@@@B@@@@@@@@BB@@@@@B@@@BB@@B@@@BB@@@@@B@@@BA@@BBB@@AD@@@@A@@B@B@@AA@@@@@AABAA@@@BA@@B@@AAA@@@A@@@@@@B@@@@@B@@@BB@@@BB@@@@C@@B@@@B@@@@@B@@@BC@@@A@A@B@@B@BAB@@B@B@@@AB@@B@@@@B@@@B@@@@B@@BB@@@@@@B@@BB@@B@@@@@@B@B@B@D@@BD@@BB@BBB@@@@@@B@@@@BBB@@BB@@B@@@@@@D@@BB@BDA@BB@@@@B@@BB@@@@@B@@@@@B@@@BABABBB@@B@@@@@@@B@@@@@@@@@DA@@@@BA@@B@@BB@B@B@@@B@F@@BB@@@B@@BBB@BB@BBAB@B@@BB@@B@@DBB@B@@A@@BA@@@A@@BA@A@@BA@@B@@C@@@A@@@@@ABA@@@@@A@@@C@A@@AA@@@AB@@@@@@@BB@A@@@@B@@@BA@@AA@@@@BA@A@@@BB@@@@@B@B@@@BA@A@@B@@A@A@@@A@@@@@A@@@@B@@A@@@@@AB@BA@@@A@AB@@A@AB@@@@CB@@A@@A@@@A@@EE@A@@A@A@@@@B@@A@A@@AA@@@AA@@@@A@BC@@AA@@A@@BA@@AA@@@AA@@@A@AA@@AAAAA@@AA@@AA@A@@@@A@AB@@@@@@AA@@@AA@C@@@A@@@@A@AA@@AC@@BA@AB@@@BC@@@A@@BC@ABED@BAA@@@@@@A@@BA@@B@D@@@B@@@@@BBB@B@@A@AB@@ABAB@@@@A@A@CA@B@@ABA@BB@@@B@@DB@BB@@@A@@@C@AB@@A@@@@BA@BBA@BBAB@@B@A@@@@@A@@@A@AAAB@@A@AAAA@@A@@@@@A@AB@B@@@@ABA@AB@@@@@BA@@DB@@BA@B@@B@@BB@B@@@B@D@B@@@B@@@BB@@BD@@@@@@B@B@B@@@@@B@B@@@BA@@BAB@B@@@@A@@B@@@@@BB@@D@@@B@@@BB@@@BBB@BB@@BB@@@BBB@BA@@@@B@@@B@@BBAB@@@@BBA@BB@@@BA@A@@@@B@@@@CAA@A@@A@@A@A@A@@BA@@@AB@@@BA@@@@@AB@@@B@@A@@@A@@BAA@@A@@B@B@AA@A@@@A@@@@@@@A@@@A@@@@@@@@@AB@@@@@@@@@@A@@@@@@@A@@@AB@A@@AB@@@@@@B@@@@@@@AB@@@@A@AB@@B@@B@@@@A@@@@@@A@@@@@@@@AAA@BB@@@B@@@@@BABB@A@@B@@@B@@ABA@@B@@CA@B@@@B@@@B@@B@AB@B@@@@@@@@A@@@@@@@@@A@@BB@@B@BAB@@@BBBA@@@@B@@@BA@C@@@@@@BA@@@@B@@A@@B@@@B@@@@AB@@@BAB@@@@@@@@A@@@A@@@ABA@@@@@@@@@AB@@A@@@@@@BA@A@@@@@A@@@CB@@AB@@@BA@@B@@@@AB@@A@A@A@@@@@A@AB@A@@ABA@@B@@@B@B@@@BA@@@AB@@A@@AAB@@AA@@@A@@@@AA@@@@ABA@A@@A@@C@A@A@@@@@@B@B@@A@@@@B@BB@@@@@BBB@@BABA@@BA@A@A@@@@B@@@@@F@@AB@@BB@@BB@@@@@BA@@D@B@B@@BB@B@@@BBBB@@BA@@@BB@B@@@B@B@B@@@B@B@B@@BB@BAB@D@B@@@B@B@BAB@B@@@@BB@@BB@@@@B@@B@@B@@B@A@@@@@B@B@@@B@@B@@@@@@@@@@AB@@@@A@@@@@@@@B@@@@@@@B@@@@@@@@B@@@@@B@@@@@BB@@@B@@@@@@@AB@@@@@@@BB@@@@@@@@@@BBC@BB@BBB@@@B@@@@B@@@@@B@@@B@@@@BD@@B@@@BBB@@B@@B@@@BB@@@B@@@B@@@BB@@@@BA@@BB@@B@@B@B@@BB@B@@@B@@B@@@D@@@BAB@@@B@@B@@B@@BB@BBB@@@BABBBBB@BCF@@CB@BAB@@@@@@@@B@@@@@@@@@B@B@F@@D@BDBBBB@@@@@@BB@D@@@B@BBB@B@@@@ABA@@@ABA@AB@D@@AB@@@BAB@@@BBB@@@@BB@@@D@@@@BDB@B@@@B@B@@AB@B@D@B@@B@B@@@D@@@@BBB@@@@@@B@@@@@BB@@B@B@@@@@B@B@@BB@@@@@B@D@@@B@BBB@@BB@@@@@B@@@@B@@B@B@@@@@B@@@DB@@BDBB@@@@B@B@B@B@@@BB@@@@@@B@B@@@@@B@@AB@B@@@@@BA@AB@@@B@@@B@@@@A@AB@@@B@B@@@B@@BBA@@@@B@@BBAB@@@@@@@BA@@@A@A@@@@BA@@@A@A@@@AB@@AB@@@B@@@@@B@@BBA@@@B@@D@@@B@@@B@@@BA@@B@@A@@AABA@@@A@ABA@@@@@AB@@@@AAA@A@A@@@@A@@A@@A@@A@C@AA@@AA@@A@@AA@@@@@A@@@A@A@@@A@@B@B@B@B@@@D@@@B@@A@@@@B@B@@@@@B@@@B@B@BBBBDBB@@@B@@@B@@A@@@@@@BA@@B@B@@@BAB@@@BBB@BB@@B@BB@@@@D@@B@@@@@@@A@@@@B@B@@@@@AAA@AAA@@@@@B@@@@A@@@@@@B@@@B@@@@ABAB@@ABA@@@A@@@A@@@A@@B@@@BABB@@@A@@AA@AA@@A@@B@@@B@@BB@BB@@@B@@B@@AB@BA@@@@@@@BA@@BA@@@@@@@@@@BB@@@@@BB@@@@@@@A@@B@@A@@@@@@@ABB@@@@BB@@@@@BAB@B@@@@B@@@@@@@@@@AB@@@BB@@@BB@@B@@@@B@@@D@@@@@@@BB@AB@@A@@D@@@@@@@B@@B@@@AB@AA@@@@@@@@B@@@B@@AB@@@@@B@@@B@@@@@B@BA@@@ABA@@@A@@@A@@@@AB@@@@@A@@B@@A@@@@A@@@@@@@@@@@B@@A@@@B@@@AAA@A@@@A@@@@@ABBBA@@B@@A@BB@@BA@@@B@@@@@@@@@B@@@@@@@BA@@@A@B@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@DA@@@ABA@@B@@BB@@@BC@A@@B@@@@@BBB@@@@@@@B@@@@@@@@@B@@AB@@@B@@@B@@@BC@A@@@@@@@@@@@@@@@@A@@@@A@@B@@@BA@@@@BBB@@@BABA@@B@BA@@@@B@@@@A@@@@@@@@@A@@@@@AB@B@@@@A@@D@@@B@@@@B@@@ABB@@@@B@B@@A@@@AA@@B@@@@A@@@@AB@@@B@@@@@B@@A@@@A@@@@@AB@@@@A@@@@@A@@@@@A@@@BB@@A@B@@A@@@@B@@@@@B@@B@@@@A@B@@@@A@@B@@@@@@@B@@@@B@@@@@@@@@@B@@@@@@@@B@@@BA@A@@@@@A@@@A@@@@@@BA@@BA@AB@@A@@BAB@@A@@@@@@BA@@B@@AB@BAB@@@@@BA@@@@B@@B@@BA@@BB@AB@@AB@@@@@BAB@B@B@B@B@@@@@@@D@@@B@@@@ABA@@@@BA@A@A@@@@B@BA@@@@B@@@@@@A@@BA@A@A@@A@BAA@@@@A@@@AA@@A@@A@@AB@@@BA@@B@@@@A@@@@@@A@ABA@A@@@@@A@@A@C@@@@BAA@@A@A@@@A@@@A@@@@@A@A@@@@@@AA@A@@@A@@@@@A@@@@@AA@@A@@@CBA@A@AB@@@BA@@BA@@B@B@@A@@@@DBB@@@AA@@A@@@@@BA@@@@B@AA@@B@B@@@@@@@@A@@B@@@@@A@@A@@DBB@@@@@@@AA@A@A@@B@@D@BB@@@@@@@@@@@@C@@BA@@@A@CAA@@@A@A@@B@@AB@@A@@BA@@A@@@@AA@@A@@BABA@A@@B@BA@@D@@@B@B@@A@AB@@A@@@A@@BA@@@@@A@A@@@A@@BAA@@AB@@@BA@@@A@@A@@A@A@@@A@@BA@@BAB@@AA@@@@@@@B@DA@@B@@@BB@@B@B@@@@@@@B@@@@AB@@@@A@@@@@@@@D@B@@@B@@@@@BA@@B@@@BBBB@@@@BB@BB@@@B@B@@AB@@@B@@AB@@@B@@@@C@@@ABA@@@@@FB@@@@@@CB@AC@A@A@@BA@@@@B@@C@@A@@@@A@AA@@A@@B@@A@@@A@@B@@@AA@@AA@A@@AAB@@AB@@A@@@A@@AA@@A@@@@A@@@ABA@A@@AA@A@@@@@A@@AAAA@A@A@@AAA@@@A@@A@@A@@AAA@@@AAA@A@@@@@@B@B@@A@@@@@A@@@AB@@@@A@@@AA@@AA@A@AAB@@A@AB@AA@A@A@C@@AAB@B@@@B@B@@AB@@ABA@@BA@@B@B@B@@@@AB@@@@BBB@@@@AB@@@@@@BB@A@@B@@@B@BA@@@@BA@A@@@@A@@A@AAAAA@AAA@@@AA@@A@A@@@A@@@A@@@A@@@@@@BA@@AA@@@@@A@@@@B@@BB@@@@A@@B@@A@@AA@C@@A@@@E@@A@@@@@@@@B@BA@@@@@AB@@AA@@@A@AB@BA@@@A@C@A@@@A@@A@@@AB@AA@@@A@@@@B@@@B@@@@@BA@A@@C@A@@@@@AA@@@A@@AA@A@A@@A@@A@@@@AAA@AA@@BA@@A@@AB@@@@ABAB@@@BA@@BA@@B@BA@@B@@@B@BAB@B@@AA@@A@@@A@ABA@@@C@@BA@A@@@@BA@@@@@@BA@@@AB@B@B@@@BA@@@A@@@@@A@A@@@@@ADCB@@AB@@@@A@@@@BAB@BA@@@@@@A@@@@@@ABA@@@@@@B@@@BA@AA@@ACA@A@@BA@AA@@@@A@@@@A@@@AA@@@ABA@@@AA@@@@@A@A@@@@AA@@@@AA@@BA@@@@@@@@AB@@@@@ACA@@@AA@CACAA@@AA@@A@@A@@ACB@@@@AA@B@@B@@@A@@AA"],encodeOffsets:[[117540,26543]]}},{type:"Feature",id:"360721",properties:{name:"赣县区",cp:[115.011561,25.86069],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@@@@@@@@A@A@AAA@A@@@ABA@C@A@@B@@@@@BA@@@@@A@ABA@@@@@@B@@@@@B@@@@ABA@A@E@@DA@@BCACAC@CA@@@A@@A@@@AAA@@BA@A@@B@@@BABAB@BA@EB@@@@@B@B@@@B@B@B@@@@A@CA@@A@@A@@@A@@AA@@A@AB@@A@A@A@@@A@@BA@ABA@A@@@A@A@@@@@@A@@A@@ACA@@@A@AA@@A@ABABA@A@@A@AA@@A@@AA@A@AB@@@@AB@@A@AAA@@@A@@@@@A@AAA@A@@@A@A@@@A@A@@@@@AB@B@@AB@B@@@B@@@@@B@@AB@@@@@BA@@@@@@@A@@@@@A@AD@@@@A@@@AAA@@@A@@@A@A@@B@@AB@@@@AB@@ABA@@@@B@@@BA@@B@@A@A@@@A@@B@@AB@@@B@@@@A@@@@@A@@@@B@B@@@@AB@@@@@B@@@@@@@B@BB@@@@B@@@B@@A@@B@@@@@@A@@@A@AB@B@@@BC@@@AB@@A@@@A@A@A@@@@@AA@@@B@@A@A@A@AAAA@@@@BA@@@@AA@@AA@@@@AAA@@@@A@@@A@@B@@A@@@A@@@@@@@A@@@A@@@@@A@@@@@@@A@A@@@A@@A@@@A@@@@BC@A@@AA@@@AB@AA@@@@B@@A@@@@@A@@B@@ABCA@@@@@BC@@BA@@@A@@@AA@@@BA@@B@@@@AB@B@@A@@B@@A@@BAB@@A@@B@@@@@@AB@@@@A@@B@@@@@@@BB@@@@BB@@@@@B@@@BB@@@BBB@@@@@@@@@BB@AB@@@B@@@B@@@@@BBB@@ABA@ABA@AB@@A@AB@@A@@@AAA@A@@@@@@B@@A@@BA@AB@BAB@B@BBB@@@@AB@B@@AB@@@D@@@@@B@B@@BBBB@@B@@@BB@@@@@@BB@@@@BB@@AB@@BB@@B@B@B@@@B@@B@@@@BBB@@B@B@F@@@@B@@@@BB@@@@B@@@B@@@@@B@@@B@@@B@@@B@B@B@B@@@B@@@@AB@B@@@@@B@DAB@@BBBB@BA@@B@@BB@B@@@B@BB@@@B@@@B@B@B@@@B@@@B@B@@@@@@BBB@@@B@@@BB@@@@B@B@B@BB@B@@BBB@B@B@@@@ADAB@@CBAB@@@@A@@@@@A@@BA@@@ABAB@@BB@BBB@B@B@@BBB@@@BB@@B@@B@B@BB@B@@B@BB@BB@@B@BB@@D@@@@B@@@@B@B@@@BB@@@BB@@@B@@B@@BB@@AB@@BB@@BBDBB@B@@@@@@@@B@B@@DB@@@@BA@@@@BBBB@@B@B@BB@BD@@@BB@@@@BA@AB@@@@BDBB@B@B@@@@@BAB@@@@@@B@@BBB@@B@@@BB@AB@@BB@@BBB@@BB@@@@AB@@BB@@BB@B@B@@@@D@@@@@B@@@@AB@B@@A@@B@@BB@BBB@@@B@@@BB@@@@B@B@BBB@@@@@B@AB@B@B@@@B@B@BAB@@@B@@@B@@@B@B@@BB@B@@@DBBBB@@@@@BBB@@B@@BBB@BBB@@@@@@@BB@B@B@B@@BB@@ABBBB@BB@@@@B@@@@@B@@@@B@BBB@@BB@@@BB@BB@@B@@@@@BB@BB@@@BA@@B@@A@@@A@A@A@@BA@@@@@@BABC@@@@DB@@@@@@@@AA@@@A@@@@DB@@@A@@@@@@B@@@@CB@@@BA@@AA@A@@@A@@@A@@@@BA@@B@@B@@B@BA@CB@@B@ABB@A@@B@@@@@B@@@B@@@@@DC@@BB@B@@@@B@B@B@B@@@B@@B@@A@@B@@@B@@@B@@@@BA@@@B@@@@@@A@A@@@AB@@BB@@@@@B@@B@@BB@@BB@@@@@BA@@BA@@B@@@@A@@@AB@@@@@B@@A@A@@@A@@@CBA@@BABA@@@A@ATO@BB@D@B@B@@BB@DBB@@@@A@@@AB@@A@A@@B@@@@B@@B@@B@B@@ADA@@B@@DBDB@@@@@@@@@A@@@@@@B@@@@@@B@@@B@@A@@@A@A@@@@@@BB@@B@@@@@@@@@D@@@@@BA@@B@@BB@B@@@@@@BA@ABAB@@@BDB@@@BB@@@@@@B@B@BB@@@B@@@@DB@@BBA@@B@@@@BBBB@@B@@@@@@B@@@B@@B@@@B@@@BBB@@@BB@@BB@@DAB@B@B@@@@B@B@B@@B@@BFB@@@@@B@@BBB@BBB@@B@@ABC@@BA@@@A@@@AB@B@@@B@@@B@@@BB@@B@@@B@@B@@BB@B@@@F@@@BAB@@@B@@A@@BA@@@@B@@@B@@@@B@@B@@B@B@@AB@B@@@B@@@@AB@@@@@B@@C@@B@@@@@@B@@BB@A@@B@@A@@@@@A@A@@@A@@A@@CB@@A@@@@BA@@AA@@BA@@@A@@@A@@A@@B@@@@AA@@@@@@@@BA@@@@@@B@@CB@@CAA@@@@B@@BBB@B@@B@@@@@@@@ABA@@BA@@@@@@A@@A@@BBBA@@@AB@B@@BDCA@@A@@@@AA@@@@@A@@@ADA@@@CB@@A@@@AB@@BBBB@@BDBB@BBB@BBDB@@@@BB@B@AB@@B@@@@@@B@@B@@@B@B@B@B@BB@@BB@B@@BBB@@BB@@B@@@@@@@@B@@@BDB@BB@BB@B@BB@@@@BD@@B@BB@@@B@BB@@D@@@D@@@@BBB@@@@@@@BBBB@B@@@@B@@@B@B@@@BDB@@@BB@BB@B@@BAB@B@@@B@@@B@@@B@@BB@@@@B@@@B@@B@@BB@@@BB@@@B@@@BBB@@B@@B@@@@BB@@B@@B@D@@@@BB@@@B@@BB@@@B@BA@@@@@@@@@BA@@@@@BB@@A@A@B@A@@B@@@@@B@@B@@@@@@B@@B@@@@@@@@B@@@@A@@@@BAB@@@BB@@@@@BB@@@B@B@B@@A@@BBB@@B@BB@B@@@BA@@BABA@@@A@AA@@A@@@@@AB@@ABA@C@@@@B@@@BB@@B@@A@@BAB@BA@@@@@@F@@@B@@@B@@@D@B@@@B@BB@BB@B@@BB@B@BA@BB@@@B@B@@@@@B@@BB@@@@@@A@@BB@@B@B@@@BB@@@@A@@BA@@@B@@BB@@@BA@A@@@BDB@@@@B@@@@BB@@@@@@B@@@@@ABBB@@BBB@@@B@@B@@@D@@@@A@@B@@@@@@AA@@@A@@@@@@@@A@@B@A@@@B@@@@B@@D@@AB@@@@@BB@@@@@B@@@B@@@@@@@ABA@@D@@@B@@A@@@@A@@A@@@ABA@A@@@@A@A@@@@A@@@@@AB@@@@@@A@@@A@ABA@A@@@@@@BA@@@@BBB@@@@AB@B@B@B@BAA@@@AA@@@@@@@@A@@A@AA@@ABABA@A@@BA@ABC@A@A@CECCCEAAGGAAAAGAICAAE@EACAE@@@A@@B@@BB@BA@A@@A@@A@@@A@@@@@@BA@@@A@@@@B@@B@DB@@@@AB@@BB@@B@@@@@A@@B@@B@@@ADB@@B@@C@@@@@A@@D@B@@A@CA@A@@AA@H@@@BA@@B@@@B@@@@@BB@@@@B@@A@A@@@@BA@@B@@B@@B@@A@A@B@@BBB@B@@A@BB@@@@ABA@@B@@@BA@AB@B@BA@@BBB@B@D@B@BA@A@@@A@@A@@A@@@A@@AA@CAA@@AAA@@AB@@@AAB@AA@AA@AA@@@@@AD@@A@A@@@@BB@@@@@B@@@@B@@A@@@AAAA@@@ABA@@@@@@@AAB@@@AA@@@@@AB@@BB@@A@@B@A@@@@A@@@@AA@@@@B@@A@@BB@@@@AB@@@BB@@@B@@@BA@@@A@@@@B@@@@AB@@BBBB@B@@@@AB@@@B@B@@B@@BB@@@AB@@B@@@@BA@@@@@AAAB@@A@@@@B@@@@@@BBA@@@AA@@@@@B@@B@@@@@@BA@BB@@@@C@A@@BA@@B@@@@@@@@A@@BCB@@@B@@B@@@@@@@@AB@@@@B@@BB@@AB@B@@@B@@@@@@B@@@B@@B@B@BBB@@@B@@@@B@@@@B@@@@BB@@@@B@@@@@@@@@@@@BAB@@@@A@@@A@@@@B@@@@A@A@@@A@@@@B@@BB@@@@AA@@A@@@@@@B@@@@@@CB@@@@BB@@A@ABABABBBDDB@BBBBDD@BB@@BBB@B@B@@AF@BABCDCBCBA@A@CBC@A@CBE@CAWBE@ABA@EBEBC@@@CDA@AF@BAD@BAD@B@@BH@@@BA@C@@@@B@BA@BB@@A@@@A@@B@BAB@@@D@@@B@@C@@@A@@@@@@BABB@@@@@@B@@@@@B@@@B@@@B@@A@@@A@@@@@CB@@AAB@AA@@@@AA@@AB@@@AA@@@@@A@@A@@@@A@@@C@A@@@@AA@@@BA@@@AB@BA@@@A@@B@@@@@@ABA@@C@@@@A@@@A@@@@@A@@@AB@@@B@@@B@@@@@@A@@@@@@@AB@@@@@@AA@@@AA@@AB@@@B@@@@@@@B@@A@A@@@A@@BB@@@@B@@@@A@C@@@AA@A@A@@@BA@A@AB@@A@@BA@@@A@@A@@@@@@A@@AA@A@@A@A@@@@@@B@@@@AA@@@A@A@A@@@CBBBA@@BA@AB@@A@@B@@AD@@CAAB@@ABA@A@A@@@A@@@A@ABAA@@@@A@@A@@@@A@CA@@A@A@@@A@@@C@A@@@A@A@A@@ACA@@@@A@@@A@A@CA@@@A@A@A@@A@@@A@A@A@A@CBA@@@AAA@@@A@A@A@@@AA@@A@@@@AC@@@ABA@@@A@@@A@@BA@@@A@@AA@@@@AA@A@A@AA@@A@@@@@A@AA@AA@@@C@AB@@@B@@@@ABA@@@A@@@@B@B@@@@B@@A@@@@@@@@@@BA@@@@@B@@@BA@@BAA@@@B@@@@A@BAA@@A@@A@@A@@@@@A@@@@@@ABB@@B@@@B@@@@@A@@AA@@A@@@BAB@@A@@@@A@@@A@A@@@@@@@BA@@@A@@@@BA@@@@A@A@A@A@@@@@AA@@A@@BA@CD@B@@@@@B@B@@@@@B@@AB@@A@A@A@@B@@AB@@@@@@AB@@@@@BAB@@@D@@AB@B@B@@@BB@@BA@A@ABA@@@A@@@ABA@@@AB@@AB@@A@AB@B@@A@@BB@@@AB@@BBB@BB@@@@@BB@@@@B@BBB@@@B@@BB@@@@B@D@@@@BAB@@@@A@@@A@@@@@@B@@BBA@@B@@@BB@@@B@D@B@@@B@@ABAB@@@B@@BA@@B@@@@ABA@@@@@@@@@@BF@@@B@@@@DBB@@@BA@@@@B@@A@@AA@@@@@@B@@@@BB@@AB@@@@@@@B@@@B@@@B@@@B@BB@@B@@BB@BBBBB@B@BB@@@@@@BAB@@@@ABA@@@A@@@@BAA@@@@@@BA@@@@AA@A@@A@@B@@A@A@A@@@AB@@AB@BA@@BA@A@@@A@A@BB@@@D@B@BBBA@@B@BA@BBB@B@@BB@@B@@@B@@A@@B@@@B@@@@@BA@A@@@@@@@@BBB@B@@BB@BB@@@@BB@@@B@BB@@@B@BBBBB@@@B@@@@@BBB@@@BAB@B@@@B@@B@@@BB@@@B@@@BA@@BA@BB@@@BBB@@BB@@A@@@@B@@@BA@@@@BB@@B@B@@B@@@@DA@@@@@AB@@@B@BBDA@@D@@@@A@@E@@@@AB@B@@@D@@A@@@A@@BA@@@A@@BA@A@@@A@@AAA@@@@AAAA@@A@@@AB@@@@A@@@A@C@@@@@@@@@@B@@A@@B@@A@@@@@@@A@A@@@AA@@A@@@CBA@@@@@@@B@@BA@AB@@AB@@@B@@@B@BB@@@@B@@AB@@A@@BB@AB@@@B@@@B@B@@@@AB@@@B@@@BA@@B@BA@A@@@C@@@ABA@@@@BABAB@@@BB@@B@@B@@@AB@@@BA@@BA@@@@B@B@@BB@D@B@@AB@@@D@@@@@@@@@@A@@A@CA@@@@B@BBB@@@@@@A@CA@@@BB@@@@@A@@@@B@@@@@@A@A@@@BBA@@@@@AB@@@@B@@@BB@@A@CA@@@@@BA@A@@@AB@@AB@@A@@B@BAB@D@@@BB@@B@@@@@B@@@@@B@@@BBB@@@@@@@B@B@@@@@B@@@B@@@B@BB@AB@@@@@D@BB@@@@@B@B@BAB@@@@@@@@B@@A@@@AB@@A@@BB@@@@BB@@B@@@B@@B@ABB@@@@B@BAB@@@B@@@@A@@@@@ABA@@@@@@B@BAB@@@@AB@B@@A@@@C@@@@@@@A@A@A@A@A@AB@@@@A@@BA@@BAA@@AB@@@AA@@@@@AB@@@@A@ABA@@BA@@@AB@@@@@@@BA@AB@@@BA@@BAB@@dnQ@@B@@F@B@@AB@BABBB@@@BB@B@BBBB@B@@@@@B@BBBBB@@B@@@B@B@@@B@B@B@@AB@@@@A@ABA@@B@B@@A@@BA@@B@@@@@BBBB@@B@B@@@B@@@BA@@D@@@BABAB@B@B@BA@@B@@ABBB@B@@A@@B@D@B@@@B@@BBBB@@@B@@@BAB@D@B@B@@@B@@@@@DA@@B@B@B@@@@BBBB@@@BB@@@B@@@BB@@B@@B@@BB@@@@@B@@@B@@BD@BABAB@@@@@BB@@@B@@BBB@B@BB@@@@B@@@B@@@B@@@B@@@BB@@BB@@@@B@B@@B@@@@@B@@BB@@@B@@B@@@B@@BB@BAB@B@B@@@@BB@@@B@B@@A@@@A@A@@@A@@@AB@B@BA@@B@BAB@@@@A@AA@@A@@@A@@@@AA@A@@AA@AB@BBB@B@B@@B@@B@D@@@BABA@@@AB@@@BBB@@@@BB@@BB@@BBB@BBB@@@@B@B@@@@BAB@@B@@BB@@@@@B@@@@BB@@@B@@@B@B@@@@@B@@B@BBB@BA@@B@@B@B@@@BB@B@B@@BBB@BB@@D@@@@@@AB@D@@@@@@@B@BABB@@BAB@@@B@@@B@@@BB@@B@@@@B@@@@@@@BABB@BB@@@B@B@@@BBB@BB@B@BABBB@@@BA@@@@@@BABAB@B@@@D@BAB@@@BBB@B@B@B@BAB@@@B@B@B@@@B@@AD@@@@@@A@ABA@C@A@AB@@@BB@@BBH@DBDAB@B@XED@HAB@D@B@D@DBBB@@BD@@@@DC@AB@BA@@BA@@B@@ABBBA@@B@BA@@B@B@@@@BB@B@@@B@@@B@B@@@@@@B@@@@BBB@@@BA@@@@D@B@B@@A@@B@@AB@@@B@@@@@B@BA@BB@@@BA@@@@B@@@B@@@@A@@A@@A@A@AB@@@BAB@@@@@@C@A@AA@BA@@AA@A@@B@@ABA@@BA@@@A@@@A@A@AB@@A@@@@AA@@@AA@AABA@@@@@AB@@ABA@@@@B@@@B@BB@BB@@AB@@BBBDD@BB@B@@@BBB@@@B@B@B@B@@AAA@@@A@@AA@A@@A@@@@@A@@@@A@@@A@@B@BA@@B@@A@@BA@AB@B@@@ACA@@AB@@AB@@@@A@@@@BAB@B@B@B@@BB@@@B@@@B@@@@@B@@@@AB@D@@BB@B@@A@@@@@@B@@@B@@A@AB@@@@A@@B@@A@@B@@A@@D@@A@ABA@@@@@@BABA@AB@@AB@@@@@B@BA@BB@B@@@B@@@BA@A@@BA@AB@@@B@B@@@F@@@B@DBB@@@BAB@@@@BB@B@@@B@@B@@@@BAB@@@@@BB@@BBBABB@@@@BB@@@B@@B@B@BB@@B@B@@@D@@@B@@@B@@B@@BB@BBB@@BB@@@@@B@B@@@B@@@B@B@@B@BB@@@@@BB@@@@BB@@@BD@@@B@@BBAD@B@@@BAAAB@@A@A@@@A@@@ABA@A@@@@AA@@@AB@@@@AB@@A@A@@BA@@B@@@@@B@BDBBB@BA@@@@BA@@@@@A@@BA@AA@@AB@@@B@B@@@BAD@@@@@B@@@BA@A@A@AB@@A@B@@@AB@BA@A@AB@@@@@C@@@A@@@A@@A@@@@AA@A@@BA@@BA@@BA@@@@B@B@@@BABA@ABA@A@@@AAA@A@A@A@AB@@A@@@@@A@A@@@@@A@@@@BA@A@A@CB@@@@@BA@A@A@@@@B@@A@@@A@@B@@B@B@DBDB@@BB@B@@B@@BAB@@@B@B@@@@@BAB@@A@@@@@A@@@AB@@@B@@BD@B@B@BB@@@BB@B@@@BBB@BB@B@@B@B@@BB@@BB@@B@@@B@B@B@@@@B@@BB@@B@@BBBB@BB@BB@@@B@B@@B@@BA@@@@B@@@BA@@@@BDBB@B@B@B@@@@BB@BB@@@BBB@BB@BAD@B@@@@B@@B@@@@B@@@@AB@@@B@@BAB@BA@@BB@@@B@@@B@B@AB@@@@A@@@@B@@@@@B@@@B@@@B@@@@@@@@@B@B@B@@@@A@@B@BB@@@BBB@B@@@BB@BB@B@D@@@@B@@@@BB@@@@BB@B@@B@@BBB@@@@BBB@@@B@@@@@B@B@@@@B@@B@BA@@B@@@B@@@B@@@BABA@@@@B@@@B@@@@AB@@@BBB@B@@@BB@@B@@@@BBB@@AB@@BBB@@BB@B@B@@AB@BA@BB@@@B@B@B@@@B@BBB@BB@@@@@@@BB@B@@@BA@@D@B@D@D@@@B@@@B@B@@BB@BABB@AB@@BBBB@BA@@@@@AB@@@@A@@@A@@BA@@@@BAB@@AD@B@@@BAB@@@B@@A@@B@B@@@@@D@@@@A@@B@@@@@@BB@@BB@B@B@@AB@D@@@B@B@@@BBB@@@B@@B@@B@@@B@B@B@@B@@DA@@@@B@@@BB@@B@@@B@B@B@@@B@@AB@@@@A@@@A@A@@@A@@@@A@@AA@@@@AA@@@@AA@@ABA@@@A@@B@BA@@@@@A@@@@B@@@@@@@BA@@@A@@@@AAB@@@@@@A@A@@B@@@AC@@@A@@@A@A@A@@@@@C@@B@@A@AA@@A@A@@B@@@@A@@@@@A@@@AB@@B@@@AB@@@@A@@A@@@@AA@@A@@AA@@@@AB@@@@AA@@@@@@BA@@@ABA@@AA@A@AAC@@@@B@@@@@@A@@@A@@B@@A@@B@@@@@B@@@@@BA@@@@B@DBB@@BA@@@@@B@@@B@B@BB@@@@BA@@B@@A@@B@@@@@@BB@@B@@@@@@@B@@B@B@B@@@AA@@B@B@B@@@@@B@@@BBBB@@B@@B@@@B@@BAB@BA@A@@@A@@B@@@@A@@B@@@@BAB@@B@B@@B@@@@BB@@B@@@B@@@@@@A@@@AB@@A@@@@A@@A@@B@@@B@@@B@@@@B@@A@BB@B@@B@@B@@@D@@@@@@BBB@B@@@@@B@@ABA@A@@@@@AB@@@@@B@@@B@@@B@@@@B@@BB@@B@BB@@@BB@@@BB@@B@@@B@B@@@B@@@@@BA@@@BB@@@B@@@BB@AB@@@BA@@@@@@BAB@@@@@B@BBB@@@B@@@@A@@@A@@@@B@@AB@B@@AB@BA@@BB@@@B@BB@@@@AB@@@B@@AB@@A@A@@@A@@@A@@@@A@AA@@B@@A@@A@@AA@@AB@@@B@B@B@B@@@@@@@@B@@B@@@@@@BB@@@BA@@@@@@B@@A@@B@@@@@@@@@AA@@@@AA@@@@@B@@@@@A@@@@A@@@A@@A@@@@A@@A@@@C@A@@B@@BBB@@B@@@BB@@@A@@@A@@@@A@@A@@@@@@@@@A@@@AB@@@B@AA@@@A@@A@@@@@@@B@@ABA@@@AAABA@A@@BABA@@B@@AB@@C@@AA@AAAAA@@@A@A@A@AAC@@@@@A@@@@DB@@@A@AB@@@B@@@B@@A@@@AB@@@BA@@B@@@B@DA@@B@@@BA@@BAB@@@B@@@@A@@@A@@@@BA@@@@B@@A@@@AB@@AAA@@A@@A@@AA@@@@BA@@B@@@@@ACB@B@@A@@@@B@B@@A@A@@@@AAB@@@@@@@BA@@B@B@@A@@BA@@@@BB@@@A@@BB@@@@@B@@BB@@B@@@B@@@@@B@@A@@@A@@BAAAB@@@@A@A@@@@B@@@@@B@@@AA@A@AAA@@B@BADAB@@BB@@@@A@@@AB@@@@A@@BA@@@@B@@@B@@@@BB@@@@ABB@C@@@BB@@@@@@@@BB@@A@B@@@@B@@@BBB@@@@A@@BBB@@@@@@@@A@A@@B@@@B@@A@@B@@A@@B@BA@@@A@AB@@@@AB@@@@@B@@@B@@@@@BA@@B@@@@A@@@A@@@AB@@AB@@@@A@@B@@AB@@A@@DA@@BA@@@@B@@A@A@@B@@AB@@@AA@@@A@@B@@@@@B@@A@@@@@@B@@@@@@@B@B@ABB@@@@@@AB@@@BA@@BB@@BB@@B@@@@@BABB@@@AB@BBB@DB@BB@AB@@B@B@@@BB@@@@@@BB@@B@B@@@BAB@@@@A@@@@B@@@B@@@@ABA@@@BB@@A@@@A@@@AA@A@@AA@@@@@@@B@@@@A@@BB@@B@@@@AB@@@B@@A@A@@@@@ABA@@@@@@@A@AA@AA@A@A@@CA@A@AA@BA@A@@A@@AB@AA@@@@AAA@@AA@@AA@@@@A@@BA@AAAA@AA@@@@A@@@@AA@@@AB@AA@A@@@A@@BC@@AAB@@AB@@A@@A@@A@@@AAA@@@ABA@@@AA@@@@@A@@A@@@@C@@AA@@AAA@@@A@A@@@@A@A@@@@@A@A@A@@@A@@@A@@BA@@B@B@@@B@BA@@@A@@B@@@B@@@@C@@@A@@BA@@@@@A@A@@@A@@AA@@A@@A@@@A@@BAB@@@B@@@BA@@@@AA@A@@@@@@@A@CBA@@@A@A@A@A@@A@@AA@@@A@@AAA@AB@@@@ADAB@@A@@@@@@@@@@@A@@A@@B@@@C@@@A@@@@CA@@@@AA@@@AA@@A@@@A@A@@@A@@@@@AB@@@@A@@@A@@A@@A@@@AA@@@@@A@@AAA@@@@A@@@@@A@A@A@@@AAA@@AA@@BA@A@@BAA@BA@A@@B@@@@A@@@@A@A@@@@A@@@A@AA@@@@A@@@@@C@@@@@AA@@A@@@A@@AA@@@@@AA@@A@@AA@A@BA@A@@@@@AA@@A@@AA@@@@AA@@A@A@@AA@@@AA@A@@B@BA@@@AB@B@B@B@BA@A@@B@BA@@BB@@B@@BB@BA@@@@B@AA@@@@BABB@A@@@@AA@A@@@@@A@@A@@A@@@@DA@@BB@@@@B@BABBBC@@BA@@BAB@@A@@BADBB@B@@@BA@@BA@A@A@ABA@@@@@@@A@@AA@A@C@@AA@A@@AAA@@@@@AC@AC@@AAA@A@AAA@@AAA@@@AAA@A@A@A@A@@@@B@@@@@@@@@@@@@BB@@@@@@BAB@@A@AA@AAC@@@A@@C@A@@AA@@@@@@@@A@@@@@AB@@A@@BA@@@@@A@@AAAA@@@ACA@@A@@BAA@@AA@@AA@@AA@@A@@@@@A@C@@@@BA@AB@@A@A@AAABA@@AABA@@@@@AAAA@@AB@@@@A@@@@@@@A@@@@@ABAAA@@A@A@@@@@@A@CAA@@ACA@@@@@AA@@@@A@@A@@AA@@A@@A@@ACD@@A@AB@B@@@@A@@@ABA@@@AD@@@BA@@@@@@@AA@@@A@A@@A@@AA@@@@AC@@@@@AA@@A@A@A@AA@@@AAA@@@A@@@@@C@A@@AA@@@BAB@@@BA@@@@BA@A@A@@@A@@@@@A@@BCB@@@B@@@@A@AB@D@B@@CB@BA@@@ABA@A@@@@B@@A@CBA@@BA@@@A@@B@@@@ABAB@@A@A@A@@@C@@@A@@BAAA@A@@B@@A@AB@@@@@BA@CBAB@@@@ABC@A@@A@A@@AC@@AA@A@A@@A@@AA@@@@@@AAA@@@@CA@@@@AA@@@@A@AA@@BA@@@A@AAA@@@AAA@@@@@@@ABA@@@@@A@@@A@A@A@ABA@AAA@@AA@@AB@@AAA@@AACA@CA@@@A@A@A@@AAA@@@AA@@BA@A@@@A@A@@@@B@@A@@BAA@@@@AA@@@@AA@AA@@@@@@AA@@@A@A@@BA@@@@AA@@@A@@BA@@AA@A@A@@@A@@@ABA@@BABA@@@A@A@A@A@A@A@@BA@@@@AA@@A@@AA@AA@@@@BAB@BABA@A@@@A@A@@@@@ABCA@@A@@A@@@A@@A@A@@@@@@@A@A@@CA@A@@@A@@@A@A@A@@@@@@A@@AA@@@A@@@A@AAA@@@D@@A@AA@BAA@@A@@BA@@@A@@@AB@@@BA@A@@@AB@@@@A@AA@@A@@@ABAB@@A@A@@@C@@BC@@@A@A@@@@BA@A@@@@B@B@B@B@F@BA@@B@BA@@B@B@@@BA@@@A@@D@B@@@B@@@BB@@@@BB@@@@DBB@@B@@BB@@@B@@BB@@@@@@B@B@BABA@@@@B@BA@@@AB@@A@@@A@@A@@@@@A@AACA@@B@@A@@A@@ABA@@@@AA@@A@A@@@A@@@@@A@BA@A@A@@@@BAA@@A@A@A@A@@@A@A@A@@BA@AACA@@A@@BA@@AC@@@AB@B@@@@@@A@CBABA@@@@BAB@D@B@B@DA@@@@B@B@B@B@@@B@B@@B@@B@@A@@BA@A@A@@@A@@BAAABA@A@A@@DABABA@@BA@@@ABA@C@A@@B@BADA@@@@@A@A@@@AA@@@@@BA@AB@@@@@D@BBB@BA@B@@B@B@B@@A@@@A@@@AB@@@@AA@@A@@B@AA@@@@B@@@@A@@@@@A@AB@@A@@B@@@BA@@@@@A@@@@@@@AA@@@@A@@@@@@A@@@@AA@A@@@A@@BA@A@A@A@@A@@AABAA@@A@ABA@@AA@@@C@@@A@@BA@A@A@A@@BA@A@@BC@ABA@@AC@@@@BA@AB@@@@AA@@@@@AA@A@@A@@A@@AA@@@@@A@@@@@ABA@A@@BC@AA@@A@@@ABA@A@@@A@A@@@AB@@ABA@AB@@@AA@A@@@A@ABA@@BA@@BAB@DBB@@AB@@@@@BA@@B@BA@@BB@@@@B@@@B@@@@A@@@A@@AA@@AC@@@@@@A@@A@@@@A@@B@@@A@@AA@@@@B@@@@@@@@@@@@@@@B@A@B@@@B@@@BA@A@@B@@BB@B@@A@A@@BC@@@A@A@@@A@@@@@A@@A@@AAA@@@A@@@A@@@A@@@ABA@@AA@@A@@BABA@A@@BAB@@CA@@@@A@A@@@@@ABCBA@A@A@@@@@A@@A@@@A@AB@@AC@@@@A@@@@@A@A@@@AAAB@AA@@BAB@@@@@@@@@B@B@@@B@@@B@B@@@B@@@BB@@B@B@@@B@@@@AB@@ABAA@@@AAB@@C@A@@@@@@BA@@BABAB@@@@ABABAB@BA@AB@AA@A@@@CA@@A@@A@A@A@A@@@A@@@AB@B@@@@ABA@@@ABA@@@@AAA@A@@A@AAA@AA@A@A@@A@@@A@A@@@@@@@A@A@@@@AA@A@A@AA@@A@@@C@@@AA@@C@AA@@@@AAA@@@AC@@A@@@@AAA@@@@A@@AA@BA@@@A@@B@@@@@@A@@@@BC@@@AAA@@@@AAA@@@A@BA@@@@@A@@BA@A@@A@@A@@A@CA@@A@AA@AAAA@@AA@@AAA@@@@A@@@@A@A@@AA@A@@@A@@@@@A@@@AB@AA@@A@@@A@@AAA@C@A@@@ABABABA@AB@B@B@B@DAB@B@BA@@D@@@B@B@@@@@B@@@@A@@A@@@CA@@A@@AAA@@A@@@A@@A@@@AA@@@@@AAC@A@@@AA@@@A@@@@@@DAB@BA@@BB@@@@@BB@@B@@D@B@B@@B@@@BBADAB@@AB@@@AA@@B@@A@@BA@@B@@@@A@@D@@@@BD@@@@@@AB@@@B@@@BABA@@@@@CAA@@@@B@@@D@@@@@@AAA@@@@A@@ACA@@@@@AA@@A@@B@@@@AB@@@BA@@B@@@@A@AB@@A@CBA@AAAA@@@AA@@A@@@@@@AAA@@@@A@@@@@AA@A@@BAB@BA@@A@@@@AB@@@@@AA@@@AB@@@AA@A@ADD@@@BB@@BB@@@@@@@B@B@@@B@@BB@@AB@@@@@@A@@@@EA@A@@AA@@@ABA@@@ABA@@@AA@@AAAA@B@@AB@@CB@@@@A@A@A@@A@BA@@B@B@@@@A@A@A@@@C@@BCB@@A@A@@B@@C@A@@BA@@@A@@B@B@@@BAAAAA@@AA@A@A@@@AB@@@B@@@@@B@BA@@AABCAABC@@@@FA@@@@BA@@@@@A@@@A@A@@@AA@@A@@@CBAA@@A@A@@@AA@@@A@AA@@@AA@@@A@AC@@AAAAAA@@BAAA@@@@A@A@@@@@A@ABA@@@@A@@A@AA@@@@@A@@@@@A@@@@B@B@@@@AA@@A@@@@@A@@@A@@A@@A@@@@@AABA@@@A@@BB@@@A@@@AABAA@@A@@@@@A@@@@@AA@@A@@@@@AA@@@A@@@@A@@@@A@A@AA@@@A@@B@@A@@AAAAAA@@@A@@@AA@@A@@AA@@A@EB@BA@@@AA@A@AA@@@BA@@BC@@B@A@@@A@AAABA@@B@@@B@@@B@@AB@@@@A@@@@B@@A@A@@@A@A@@@@A@@A@@A@@A@@C@@@AA@@@@@AAAAAA@@@@@A@@@@A@A@@@A@AA@A@A@@@A@@A@@@BA@@@A@A@@A@@@@@AA@A@@B@@@BA@@@A@@A@@AA@@@BA@@@AB@@A@@A@AA@A@@A@@@@@AA@B@@AB@@A@AAA@AAA@@A@@@@A@A@AB@@@@AC@@@@AAA@@AA@@@AA@@AAA@@A@@A@A@@BAB@@A@A@C@CACAC@A@C@A@@@A@@@A@@@CB@@AA@AA@@A@@@@@AAAAA@A@@AA@@A@@@@@@AA@A@@B@AA@@@@A@A@@@@A@@AAA@@AB@@@@A@@A@@@@AA@@@A@@@@@@@AA@A@@A@AAA@@@A@A@@A@AA@@@@@A@A@@@@@@AA@@@A@@@A@@@@@A@@@@A@@@A@@A@@A@@@@@A@@@@@@A@@@A@AB@@AA@@@AA@@@@@A@@@A@@AA@@@A@AA@@@@AA@@@A@A@A@A@A@@@A@A@@@@A@@@A@@@AA@@AA@A@@@@@@@@BA@@@@BA@AB@@AB@@@A@@A@AB@@AB@@A@AA@@A@@@AACA@A@@A@E@C@ABA@@@A@@A@@@AEA@AA@AA@@AC@@AB@@@@AB@@@@@B@@AB@BA@AB@@A@AACB@@ADA@@@AB@@@BC@AB@@@B@@@B@@@@@B@@@@@D@@@B@@@@@@@@@BA@@@@@@B@@@B@@@@@D@@@DB@@@@@@B@@@@@@AB@@@@@@@@@B@B@@@@A@@BAB@@AB@B@B@B@D@B@BAB@@AB@@AB@B@@@@@@@BAD@B@BAD@B@B@@A@@BB@@B@@@B@BAB@BAB@BB@@B@B@@BBAB@BA@@B@@@A@@C@AD@@A@@@@@@B@@AB@@@B@@@B@@@@A@A@AD@@A@@B@@A@@A@@AB@@ABA@AA@@@AA@@B@B@@A@@B@@@BA@@@@@@@A@@BA@A@A@B@@A@@@@@A@@@@@A@@@A@A@@@A@@AA@AA@@@A@@@@@@@@@A@@@@@@BA@@@@@ABABBDAB@@AA@BA@@A@@AAB@A@@A@@AC@@AA@@AA@@AB@@A@AA@@AA@@@C@@AA@@@C@A@@A@A@A@@@AAA@@AA@ACA@@A@@@A@A@@AA@@A@@@@@A@@@A@@@A@@@@@AA@@@@C@@A@@@@A@A@@@@@ABA@@B@@@@CAA@C@A@A@AAA@A@A@A@@@A@@@A@@@@B@@DBB@FFDDDFBB@@BD@BBD@@ABBBA@@B@@@BA@@@A@@@@@@A@@@AA@@@@A@@A@@@@@AAAB@@A@@@@B@@A@@@@@AB@@A@@@@B@@@B@@A@AB@BA@ABC@@@A@@@A@@BA@A@@BA@AAAB@@@@@@@BA@@BA@ABA@A@@@@B@@A@AB@@A@@B"],encodeOffsets:[[117748,26124]]}},{type:"Feature",id:"360722",properties:{name:"信丰县",cp:[114.922922,25.386379],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@@A@@AA@@@@AB@BA@@@AD@@@BA@A@@@@@@@@@@A@A@A@E@@@@@@BA@@B@B@@CA@@B@@@AA@@@@@A@@CAABCAA@AAA@@@@AAC@@A@A@A@A@@@@B@@@FB@@@B@@@@@B@@B@@@@@@B@@@BBA@@@A@@@@BA@AB@@@@A@@@@BBB@BBB@@@BA@A@AAA@A@C@@@A@ABAB@B@@AB@@ABAB@B@@AD@B@@C@@AA@@BA@@@A@@@AB@B@@A@@BA@A@AAAA@@CAC@@@@@A@@@@@A@@@@BA@@A@@@@@@A@@@@A@@@A@@@@BAA@@@@A@@A@A@@@@BA@@@@@@A@@A@@@@BA@@@@A@@A@@AAA@AA@ABA@A@A@@AAAA@AA@@@@A@A@@AAA@@A@A@@AAA@@A@A@@@@BA@@@@@@B@@@D@D@@A@A@A@@@@BA@@B@@AAAA@@A@A@@@A@A@A@AAA@@A@AA@AAA@A@CAA@@AC@A@@@AACAA@A@ADAAA@@AA@@@AAA@A@A@A@A@A@C@@@@B@@@BA@@B@@@BA@@B@B@BBDBB@@B@@@@B@@BBF@@B@@@@BB@B@@@B@BA@@B@@B@B@@B@@@@@BDD@@@B@BAB@@@B@@B@@@@@B@AB@D@@A@AB@@AA@@C@@@AB@BAB@BABA@@B@B@B@@BD@@@BEBABABA@@@@FB@@BB@@B@B@@@@ABA@AB@@@BAB@@A@@@@@@BAB@BA@CB@@ABAB@@@B@B@BA@@BC@ABA@yR@@A@@@@BA@A@@@A@@ACBA@@@A@@@@B@BA@@@@@AA@@A@A@@@A@@AAB@@@@AD@@@@@@A@CAA@@@@@@B@@@BBB@BBBAD@BAD@@AB@@A@A@@@CB@@E@@@@@@@A@A@AB@@ABA@@BB@@@ABCFCFADAB@BA@A@GDC@ABA@@@AB@@@B@@A@C@@A@@B@@@@@@A@@A@@@A@@B@@AB@@A@@@A@@DA@AD@@A@@BAB@@A@@B@@@BAD@B@@A@A@@@@B@B@BABABABAB@@AB@@@B@@A@A@AB@B@B@BA@AB@@AB@BA@@@@@@B@@ABA@A@A@@@AD@B@@ADAB@B@@@B@D@@ABCB@@@DBBDFBH@FABC@E@C@CBAF@D@BCBE@E@CAK@KBA@A@@B@BAB@BE@C@A@IFCDCB@@ABBDFDBDBBC@@D@DABC@CBABABADAD@DBBBBD@D@B@FAB@DAD@FFFF@D@@CBCAA@BF@FCFAB@BD@FBDB@B@BAB@BB@DDB@FBDAD@DBHFBBLLDBB@D@DCDCBCDAFA@BF@FDBBDBDDBBAB@BA@C@CCEAAAABAD@H@DB@FBF@F@DB@BEDABCF@F@HBH@FAH@H@DAF@BA@@BAB@@@@CB@BCBCFEBKAC@AB@D@F@BBBBB@BCH@B@D@DCDABA@E@EBAB@DBDBD@D@@ABAAC@A@A@CACAA@AB@D@DABABA@CAA@ABCDC@A@AAACAECCE@E@EDA@CBEHIHCD@@CBKBAAA@GACAEBCAE@C@AAC@ABCDABABC@CBAB@D@D@B@BAB@BABCBCBABABAB@D@BAB@BCD@BCD@DABCBA@C@C@E@A@CB@@AB@BDFDD@BBBABABE@@@@DBJDHAJMJMJI@AE@G@I@CA@A@A@AAA@ABCD@B@@@FBN@BBBDABBBBBDAB@BA@CAE@@@A@@@A@@B@@B@@@@@BBBA@@@@B@@@BB@@@@@B@@@B@@@B@@@D@@@B@@B@@B@@@@@B@@@@A@@@@@@B@@@@AB@@@@@@B@B@@@BB@@BA@@@B@@@@BB@@BAB@B@@@@@@B@@@D@@@B@@@@BB@B@@@@@@B@@AB@@@B@@@BB@@DAB@@B@@BB@@@@DBBB@@B@B@@@@@BABAB@@ABABB@@@@BAB@@A@@BAB@@A@A@ABA@@BBB@@@D@@@BB@@BA@@B@@@BBBB@@BB@@@@@BB@@BB@B@@@B@@BBB@@BB@@B@@B@@B@D@BBB@@@@@BB@@@BB@@@BBBB@B@@B@@@AB@@@@@B@BAB@@@B@BAB@@@@@BBB@@@@BB@@@B@@@@BAB@@@B@@@B@@@@@BB@@B@@@@@BA@@@@@@@@B@@@@@@@B@@B@@@@@@AB@@B@@@@A@@@A@@@A@AB@@A@AB@@@AA@@B@@@@@BAB@@AB@@@BA@@B@@@DBB@@B@@@@@BB@@B@@@BB@@B@@B@BBB@@@@@B@AB@@@BAAA@@BA@AB@@@@A@@@@@A@@B@@B@B@BB@@@BB@@BD@@@@B@@B@@BBBB@D@@@B@B@B@B@B@@BB@rB@@@D@B@B@@@@@B@@@B@@AB@@BB@B@B@@@BD@@BB@B@@@B@@BD@BA@BBB@@@B@B@@B@@@@@BB@@@B@@@B@@@BBB@BA@@@A@@@@B@BA@@@@B@@@@@BA@@BA@@@@AA@@@@B@@A@@@A@@@@DAB@B@@@@@@ABA@AB@BABA@CB@@@B@@@@@BB@@@@BA@@BA@@@@B@DA@@@A@A@AB@@@B@B@B@@B@@BBB@@@@@@B@@BB@@B@@@B@@@@@@BB@@B@@BB@B@@B@@A@@@B@@@B@@@B@@BFB@@@@B@BA@@B@@@@BB@@@BB@B@@@BB@@D@@@BB@@B@B@B@@BB@@ABBB@@@@@@@@BB@@BBB@@BBB@@@B@@@B@B@@@B@B@@@B@@@B@@@@A@@B@@@B@@@B@@@B@B@@@@@BDB@@@B@B@BAB@DAB@B@BA@@B@@@BBBB@@B@@@D@BBBAB@B@B@B@BBB@B@BB@@B@@BB@B@BBB@B@D@BBB@BBB@@@BBF@B@@@B@@@B@B@@@B@@@@@@@BABABAB@@BB@@@@@B@@@B@@B@B@BB@B@@@@B@@BA@@@@B@@@B@@B@AB@@@@@B@@@@@@B@@B@@@@@@@@@B@B@@B@@AB@B@B@B@B@@@BB@@@@@@@B@B@@@B@@@A@@B@@ABAB@@@@@@BB@@@@B@@@@@@B@@@BB@@BB@@@@BB@@@@BB@@@B@@@@AB@@B@@@@@@A@@B@@D@BABB@B@@A@A@@BB@@BAB@@@@ABA@@BA@@@AB@B@BA@ABA@@BA@A@A@@@A@@@@B@B@@@BB@@@@B@BA@B@@@@@@A@@B@@@@B@BBB@@@@B@@B@@DBBA@@@AB@@@@@B@@@@A@@B@@BBA@@B@@BB@D@@A@@B@@@B@@@@B@@@B@B@@@@@@@B@@@@@B@@@B@@@@@@@B@@@BA@@@@B@@@B@@B@BB@@@@BB@@BB@@@@AB@@@@BBBBB@B@B@@@@A@@BB@@@@B@B@B@@@B@@@BA@@D@@A@@@ABAB@@@B@@@@@@@@AB@@@@A@@@A@@A@@A@A@@@@@@@A@@@@BA@@@@@A@A@@B@@@@@B@@@@@@A@@BA@@@AB@@@B@B@@@@AB@@A@@@A@@B@BA@@BA@@@@BA@@@AB@B@@@B@@@B@BB@@B@@@@@BCB@@@@@B@@@@@@@B@@A@@@@BA@@@A@@@@@A@@@ABA@@@ABA@@@@B@B@@@B@B@@@B@B@BBB@@@@@B@@@B@BBB@@@BA@@@@BAB@B@@BB@@@BBB@@@@BABAB@B@BB@@B@B@@DB@BB@@@@B@@@@B@B@@@B@B@BAB@@AB@@@B@B@B@@@BAB@@@BB@@@B@@@BB@@@DBB@@@@@@A@A@A@@@A@A@@@@FAB@@ABABA@A@@@AB@B@@AB@BB@@B@@@@B@@DBD@DBDB@AB@@CF@B@B@BA@@@@@A@@@@@A@@@@B@BAB@@@@@B@@A@@@@@AB@D@B@BA@@B@B@BBB@B@@@@@@@@@B@@AB@@@BAB@@@@A@@B@B@BAB@@AB@@A@@@@@@BABBB@@AB@B@@AB@@@B@@@D@BAB@@ABAB@@@@A@@@A@@B@@@BA@@@@B@@@@A@@B@@@BABB@@@@B@@@@B@@B@@B@@@B@@@@B@@@B@@A@@@AB@AABA@@AC@AAC@@AACECCEEA@CA@@@A@@B@@@B@@@B@B@B@B@BBB@B@D@B@DB@@@@@AB@BA@@@@B@B@@@@@@BD@@@@@BB@@@@B@@@B@@@B@@@@@B@@@BB@@@B@B@@@BB@BDB@@BB@BB@@B@B@B@@@@B@D@@BB@@@D@@BB@@BBB@@@BA@@BB@@BB@@BD@@@BB@A@BB@@@BB@@ABB@@BAACBABA@@@@B@@A@@@@B@@@@@@@@@B@@@B@@BBB@@@B@@@B@B@@@B@@@@@B@@@@@BA@B@B@B@@AB@@@@@@@B@@A@@@AB@@@@A@AB@@B@@BBB@BA@@BA@@@BB@@@@AB@@@BCB@B@@@@@@A@@@A@@BA@@@A@@@@B@@@BCD@@@@B@@@AB@@ABAAA@@@A@AA@@ABA@ABA@A@A@@@AA@@AB@@@@A@ABC@A@ABC@A@@@@@@@ABA@@BA@@BA@A@A@C@A@A@ABA@@BA@AB@@@@@@A@A@@@@@@@@BA@@@@@@@A@@@@A@@C@@@C@@@@@A@@@A@@@@B@@A@@@@@@@@@A@@@C@@@@@A@@@@@A@@@A@@BAD@@A@@BA@@B@BC@@DABBB@@@BAB@@ABA@@@A@@@@BA@@@@BA@@BD@@BBB@@BFB@B@@@BB@@@B@BAD@F@B@@@@BDBBB@@B@@@BBB@@@BA@@BAB@@@@B@@BA@@BAB@@A@@B@@A@@@@@@B@B@@BB@@B@@@B@@@B@@B@B@@@B@B@B@B@B@@@B@@B@@B@@B@B@@BB@@@B@@@B@@@@BB@@B@@BA@@B@B@@@B@@@@B@@@@@B@@@@BB@@@B@@@@@@B@@@@@B@@@B@@BB@@@@@@@B@B@@@@BBB@@@@B@B@@BB@B@BB@B@@B@@@@@@@B@@BB@@@@@BB@@@@@BA@@BB@BB@@@@@@B@B@@B@@B@AB@B@@B@@@@B@@@BB@@@BBBBB@B@@@@@BB@@BBB@@DA@@B@@@B@@@B@D@B@D@DBDBD@B@B@@@BA@AB@B@@@@BB@BB@@BB@@B@@BB@BB@@@@BD@@@@BAB@B@@@@@@BB@BBB@BBB@@@BA@@@ABB@@@@B@@@@BBBB@@@@BA@@B@@AB@@B@@BB@@@@B@@AB@@A@@@@BBB@@@@B@@@@B@B@@AB@@B@@@@B@@@B@BBBB@@@B@B@@@@@@B@@@@BBBBBB@@@@B@@B@@@DB@@@@BB@@@@B@@B@B@@@B@B@@@@A@@B@@@@@BA@@@A@@@A@@@AB@BABBB@@@B@B@@BB@@@B@B@@@BABA@@@@FC@@@@@A@@BA@@@A@@@A@@BA@A@@@ABAB@BA@AB@@@BAB@@@@AB@B@B@B@@@@C@@@A@A@@@ABA@@@A@@B@@A@@@@@AB@@A@ABA@@@A@AB@@A@@B@@@B@@@B@@@B@@@B@D@@@B@@@@BB@@@B@BAB@@@AA@@A@@A@@B@@A@A@AAA@A@@@@@A@@@A@@@A@@@A@@@@@A@A@AA@@A@@@AA@@A@@BA@A@@@AB@@AB@B@@AB@B@@@DAB@@@@@B@B@@@@@B@B@B@B@@ABBB@BAB@B@B@@@BA@@@A@@BA@@B@@@BBBB@BB@@BBB@AD@@@B@@@BB@@B@@@BABA@@B@@AB@BA@@@@@C@@AA@@@A@@@AA@@ABA@@B@@AB@@AB@@A@@BBB@B@@@BA@A@AB@BA@A@@AA@AAA@@@@BA@@@AA@@A@@@@@@AC@A@@AA@@@@BA@@BCB@@A@@AA@AA@AA@@A@@B@@@@@BAA@@@@A@@@@@A@@AB@@@B@@A@@@AA@B@@A@@@@A@@@@@@A@@@@A@@@@@@A@@A@@@BA@@@@@C@@@@@@A@@@@B@@A@@A@@@A@@AB@@@A@@@A@@@@@@A@@A@@@@@AA@@BA@@A@@BAB@@@B@BA@@@BB@DB@@BBB@@@D@@@@A@@@A@@@@@AB@@B@@B@@@@@B@@@B@@@@AB@@@@@@A@@@@B@B@@@AA@A@@BA@A@@A@@@A@@A@@BA@@@@CA@@@A@AB@@A@A@@@@BA@@@@@AB@BA@@D@@@B@ACB@@AA@AA@@@A@@@A@@@A@@@@BA@@@@@AB@@@@A@@B@B@@@BA@@@@BAB@@CBA@A@@@A@@@@A@@@@AA@@@A@@@A@@@@@@@AA@@@@@@@A@@@@@@@@BA@A@@@@@A@@@A@@@@@A@@A@@@@@@AA@@@@BAA@@@A@@BABA@A@A@@AA@@@A@@AA@@@@@A@AB@@A@ABA@AB@BA@@@@@@@ABA@@@AB@A@BA@@@@@A@@@C@AA@@A@@B@@AA@@A@@@AA@@@A@AC@@@AAAA@@AAAA@@AA@@A@@@AAA@@CA@@@AA@A@@@AA@AAA@@@A@@@A@AAC@@BAAA@@@@@AA@A@A@AB@@@@@AAA@A@A@A@@@A@@B@@ABA@A@@CC@@A@AAAA@@A@@@A@@@@AAA@@B@@@@AB@@A@A@@@@@AA@@@@@@A@@A@@@A@@A@@@@@A@A@A@@@@@@@A@@A@@A@@B@BA@A@@AC@@B@@ABA@@@AAA@A@@@@A@@CA@AAAA@AA@A@@AA@A@@AA@@@@A@@@AA@@AA@@@@A@AA@@@@@@@@AB@@@BA@ABA@AB@AA@A@@A@@A@@@@AA@A@A@AB@@A@@@@BA@@@A@A@@@A@@@A@@@C@@@@@@ACA@@@@AA@@@@@@A@A@A@@@@@AA@@@A@@@A@@AAA@AA@AAA@@A@AA@@A@@@@A@AC@@A@@AA@@@@AA@@C@A@AAA@@@@BA@@@A@AA@AA@@A@@A@@@AA@@@@@A@@A@@AAA@@@@@BA@@AA@@@@A@@A@@A@@@@AA@@@@@@A@AA@@@AA@@@@A@@@A@A@@@@@@AAA@@A@@@@@A@@@B@@A@@AA@@B@@@AA@@A@@AA@@@@@@@@@A@@@@A@@@@@@A@@@A@A@A@A@@@A@@A@@AB@A@BA@@A@@BAA@@A@@@@@A@@A@@@@@@AB@@A@@@@AA@@AAA@AA@@@@@@@AA@@@@@@@B@@A@@@A@@@A@@A@@A@@AA@@@A@AA@@A@A@@@@C@@A@@@@@A@A@@@@@@@AB@@@@@@A@AAA@AA@@@@A@@A@@A@@A@A@@@@@@@A@@A@@@@@AA@A@@@@@BABA@@@A@@@A@@@@B@@AA@@AAAA@A@@@@@@AA@@@AA@@@@AA@@A@@@AA@@@@@@@A@@@@@AA@@@@@AAA@AA@@A@@A@@@@A@A@@@@@@@A@@@AA@@@AA@@@A@@@@@@@@B@@AB@@AA@@A@@@@@A@@@@B@@AB@@A@@@@A@@A@@@@@A@@@@@A@@AA@@@@@A@A@@BA@C@@@@AA@@@@A@@BAB@@AA@@A@@@A@@@@@@AA@@@@A@@@A@A@@@A@CA@@AA@@B@AAB@@AB@@@@A@@@@@@BA@@B@@A@@@A@@@@A@AA@@A@@A@@AAA@@AA@@@A@@AA@@@@A@@@A@@@@BAB@@ABABA@AAA@@@AA@AA@@AA@@@A@@@@AA@@@@@@@@AA@C@@@@@A@@@AB@BAB@@ABAB@@@B@B@@@B@@@@@BA@@@@@@@@BA@@BA@A@@@@CAA@@@@@@@A@@@A@BA@A@@@@@A@@@@A@BA@@@A@@@AAA@@@@@@@A@@@@A@ABAA@@@A@@@A@A@@A@@CA@@A@@AA@@A@@BA@@@AB@@@B@@@@AAA@@AB@@A@@@AA@@AA@@AA@@@@A@A@AB@@@B@B@BA@@BABA@@@BB@@AB@B@@@D@B@@@@B@@@B@B@@A@@@@B@@BB@@B@B@@@@ABAB@D@BBB@@@@@B@@@@@@A@@DA@@AA@@BA@@B@@A@@@@A@@A@@@@AA@@@AB@@@AA@BA@@@@AB@@A@@@@B@AA@@BAAA@AB@A@BA@A@@AA@A@AA@@@B@@@BAB@B@@BB@B@@AB@@BB@BB@@BA@@@@@@AC@@@@BA@@@A@@@A@A@@@A@@@@AA@@@@@A@@@@AA@@@A@A@@BA@@B@B@@@@@B@B@@AB@B@@@@AA@@A@A@@@A@@B@@@@CA@AAA@@@ABAB@BAB@@@@A@BAAA@@@AAAAB@@A@@@@AA@@@@@@A@A@@AAB@@A@@B@@@@AB@B@@@@@BA@@@@B@@@B@@BB@@@@@@@@AB@AA@@BA@@@AB@@@@@@AB@@@B@B@@@@@B@@@BAB@B@B@@@@AB@@@@A@@@A@@@@@A@@@@@A@@@A@@AA@A@@@A@AAAACBA@A@CBC@A@@@AAAA@@@@@AAA@@@@@A@@@@@A@AA@@AAA@@@BADC@AAA@@A@@AA@@AAA@@@A@@@@@@A@@A@A@ABAA@@AA@@@@A@A@@@ABA@AB@@A@@B@@@B@D@B@@@B@@@@A@@@AB@@A@@@@@@BA@@AA@@@A@@BA@@@@A@AA@@@@@AAA@@@@@A@@A@A@@@A@@AA@@@EB@AA@AB@@A@A@B@BA@A@@@ABABA@@BAB@@AB@@@A@@@@@AA@@@@A@@@@@AA@@A@AB@BA@@@AB@@A@@@@B@B@@@@@@AD@@A@AB@@AB@@A@@@A@C@A@A@A@@@A@A@A@@@A@@B@@A@@BA@A@@B@BAB@@@@AA@@A@@@@@EBA@@BA@C@A@A@A@@@A@@@@@@@@BB@ABBB@B@B@@AB@@@@@B@@@AA@A@@@@A@@AAA@AB@@@@DB@B@@@B@@@@A@A@AA@AA@@B@@A@A@@@@B@@@@@B@B@BAB@@@B@@@B@@AB@@AAA@@@AC@@@A@@AB@@AAA@@@AB@@@@@A@@AA@@@C@A@AA@@@@C@AA@@@A@@@@@AB@@AAA@@@@BA@@@@@@@@BA@@BA@AB@@@BCD@@ABAB@@@DAD@BA@AB@@@@A@@@A@@BA@@B@B@@@B@BA@@@@@AD@@@@A@@@@@A@@@@@A@@BA@A@@@@B@@AA@B@B@@@B@B@B@B@BAB@@AB@@@D@BA@@@AB@@A@@@CC@AA@ABA@A@AA@@A@AB@@A@@@ABA@@@A@@@AA@@A@A@A@@@AA@@A@@@ABA@@B@@@B@B@@@@@@A@@A@@AA@@A@A@@AA@A@AB@@@@ABA@ABAB@@@B@B@@@@@B@B@BA@@@A@@@A@@B@@A@@AA@@@AB@@A@AAA@@@C@A@@@CA@@@BAB@@A@@@AB@@A@@B@@@B@B@@@@BB@@@@@@@@@BA@A@@@AB@@A@@@@B@B@@@B@@A@A@A@@@A@@@A@A@A@@AA@@A@@A@A@A@@@@AA@@@@@A@@@C@A@A@A@@@@B@BA@@@AB@@@BA@@BBB@@A@@@A@A@A@@@@A@@CA@@AAA@A@A@@@A@CAAAAAA@@A@@@A@A@A@AB@@A@A@A@@@@@AA@A@AAA@A@ABCA@@@AAA@@A@@@A@AB@@ABA@AD@@@B@@@@A@AA@AA@@A@@@A@A@@A@@AA@B@@A@@AA@AAC@@@ACA@@AA@@A@A@@@A@@B@BA@@@A@A@@@@BAB@@C@@@@BBB@@@@A@@@CA@@@@@AA@@AEAC@@AA@A@@A@A@@A@A@@@@@@AA@AA@@@@@A@ABA@AA@@A@A@@@A@A@A@E@@A@A@AB@@A@@AAA@A@@@A@@@ABABABA@ABA@@@@B@@A@@BA@@@A@@@@B@@@@@@ABA@@AA@A@@AA@@B@AA@@@B@@@BAB@@@BA@@@AB@@@@@A@@@@@@AA@@DA@@@@A@@@AA@@@BA@@@AB@@"],encodeOffsets:[[117735,25604]]}},{type:"Feature",id:"360723",properties:{name:"大余县",cp:[114.362112,25.401313],childNum:1},geometry:{type:"Polygon",coordinates:["@@GEKEA@ECCAGGGGEEECAEA@CBEBCBGACAACAAA@AFADAD@@C@GCA@CACCAAA@AA@CACAAAAACAG@CCECEEGC@IBABA@A@ADBJ@DBBBBAFCBEBCBCBEDADAD@DEFF@FBDDBBBBBBBD@B@LBFBHFDFFDBD@DAH@J@LDDD@BCBGDGF@@CBG@IBILAF@FBFDBFDBF@DDDD@DADABAD@DB@@BBBF@FABGHCFADCD@BFBF@D@BBADADGBG@MFEBE@EBA@BBDBFFFBF@HBDBDDDB@BABCB@B@DCBE@C@E@IEECCCA@CBAD@@A@EAE@A@ADBD@B@B@@ADABAB@B@@AD@BBB@B@B@B@@B@B@@BB@@BBB@@@B@B@@BB@B@@@@@B@@@BBD@@@BB@B@@@B@@@B@DB@@@@BB@@@@D@@@@B@B@BBB@@@B@@AB@B@@@@@BBDBB@@BB@B@BAB@B@BBBB@@B@B@BBBB@B@BBBB@@B@@BDB@@@@@B@@BBD@@@BBB@B@@BB@BAB@@A@@@A@@@A@@B@BBBA@BD@@@B@@@B@BAB@@@B@@@B@@@BA@@@AB@BA@@BBB@BAB@BA@@@@@A@A@A@@BA@@BAB@@B@@B@@@BBB@BBB@B@B@BB@@BABBB@@@@@BA@@B@B@@AB@BB@@BBBB@@@@BA@@@@@AA@BA@AB@BB@@@@BB@@DBBBDB@@@@@A@A@A@ABA@A@A@ABA@@B@@@@@A@@@@B@@@@BB@@DAB@@@B@@@B@@@@@AA@AB@@@BAD@@@@@@@@A@@DBB@BDB@B@@@@@@@@@@AA@ACA@@A@@@A@@CC@@@A@C@@AA@@@A@A@@@A@A@AB@@ABAB@@@BA@@BB@@B@B@@@BBB@@@B@@@@BB@B@B@@@@@B@B@@@@BB@DB@@B@@BBB@B@BB@B@@BAB@BAB@D@BB@BB@@DDB@@B@@@BDDDB@@@@@A@@AAA@@A@@D@B@B@F@@@B@@B@@BB@BBBBBBB@@@@DB@@B@B@B@@BB@B@BBBB@@@@@B@DBB@B@@@BA@@BAB@@AB@@@B@B@@@BB@@B@@@@@DB@BABBD@BA@@B@BAB@D@B@@@B@BB@@@B@B@BAB@BBD@D@@@@B@D@BA@@B@BB@@BAB@B@@@B@@@B@@A@AA@@@@A@@@@@A@@@A@@AA@@@@BA@A@@AAC@@@@@B@@AB@@BD@BB@@BBB@BB@@B@BB@@B@@BB@BB@B@@@DBB@@B@BB@@B@@@B@BBBB@@B@@@B@@BB@@ABB@@B@B@B@@@BB@@BBB@B@@BB@@@@@B@@D@@@B@BB@@@@B@@B@@B@@B@@@BB@@BB@@@@AB@@A@@D@BA@@@@B@@@@A@@A@@A@@@A@A@@A@@A@C@@@@@@BEA@BBB@B@@@B@BBB@B@@@@@D@B@@@@@@BB@@BB@D@@B@@BBB@@@@@B@@@@@B@@@@AB@@@B@@@@@B@@@@@B@@@@A@@@AB@@@B@@A@@@@@A@@@A@@@@@A@@B@AA@@@@@@AA@A@AA@B@@@@@@A@A@@AA@A@@AA@AAABAB@BA@@@CD@@A@@BAB@D@B@@BB@@DDBDBB@B@@A@BB@@@@B@B@@@BB@@D@BBB@@@B@BA@@@@BB@A@BBBB@F@B@DAD@H@DAB@B@@B@@@@DAFA@@@@@@DBBBB@BBFABBB@@BB@@@B@BB@B@@BB@@BBB@D@B@D@FB@@B@B@B@@@B@AA@ABA@A@@@AB@@ABA@ABA@@@A@@@@@@BA@@@ABCB@B@B@@@BBB@@@B@B@B@@@DBB@B@B@@ABA@@@@B@B@@@BA@@@@B@B@@A@@D@@@B@@@@@@ABA@@@A@@B@@@@A@@@A@@B@@@@A@AA@BA@@AA@@@@BA@A@ABA@AA@@@@AD@@A@@@A@C@A@@AA@A@A@@@A@A@@@ABA@@B@@AB@B@AA@@@C@@AA@@BA@@B@BA@@@@BAB@@@@AB@B@B@@A@A@A@@BAA@@ABA@@@A@@@ABA@A@@@A@A@A@@B@@AB@@@@A@@B@B@@BB@@@D@@@BA@ABAB@BAB@@@BA@@@@B@@BB@B@@@@@BA@A@A@@B@@@@@B@@ABB@@BB@@J@@@B@@AB@B@BABBBAB@B@B@DAB@@@@B@@BB@B@@@BBBBB@@@BA@EB@BCB@@A@@@@@@A@@A@@@@B@@@@@B@@@BB@@@@@@B@@D@B@@@@A@A@@@A@@@@B@BA@AB@@B@@A@@B@@@@@B@@@@B@@@@A@@BA@@@B@@BB@A@@@@@A@AB@@@B@B@@@@@@B@@@@BAB@@@BA@@BA@@B@B@DBDBBBBB@B@@@@B@@BBB@@B@@@B@B@D@D@@@B@@@BB@D@@@BA@AB@B@BA@@@@D@@@B@@BB@@AB@@BD@B@@@BBB@@@B@D@@@B@B@@@BBBB@@@DB@@@D@@B@@@@B@@@@@B@D@DB@@BB@BB@@B@B@@B@@B@@B@@BD@@B@@BD@@BB@BB@@@@@@AB@BAB@@ABAB@@@B@B@B@@@@@B@@@@@B@B@@@BBB@BDB@@@@AB@B@BBB@B@@AB@@AB@@@BA@AB@B@@@@@B@@@@@@@B@BB@AB@B@BAB@B@@@@BBBBB@@@B@@B@@@B@B@@BF@B@@BB@@B@@@@@B@B@@@AB@BAB@@@BB@@@@@B@@BB@@@DADBB@B@BA@@BB@@@BB@B@@@@BB@@@@@B@@@@B@B@BBBB@@B@@@@@B@@BB@@@B@@AB@@@BA@@B@@@@ADB@@B@@AB@@@B@B@@@@@@@B@B@BAB@@@B@B@@@@@B@@BBB@@@@BAB@@@DB@@@B@B@@@B@B@B@@@B@@@DF@@BBBBA@BBAB@@@B@@@B@B@BBBB@@@B@@B@@@BB@@BBBB@@@@B@BB@@@B@@@B@@B@BB@B@B@@AB@@@B@@@@BB@@@@@B@BBBBB@@BB@D@D@D@B@@@@BAB@@B@@@BB@@BB@B@@BBB@B@BBB@@@@@DBB@BBB@@@D@BBB@B@@@BA@A@C@@@A@A@@@@@A@@@A@@B@@A@@@A@@B@@A@AB@@@B@BB@@B@D@@AB@@@BAB@@@BB@A@@@A@@@CAA@A@@@@B@@@BAB@B@B@BA@BB@@B@@B@@BB@@@@BB@ABB@@B@@B@@@BB@@@B@@@B@@@@@@@@@@@B@@@@@@@@@@@BA@@@@B@B@B@@@@@@AB@BB@B@@@@@B@@@BA@@@@@A@@B@@@@@B@@@BA@@@@@@@@B@@@@@B@B@B@B@BBB@@@@B@@@@@@B@@@B@@@B@@@B@B@@@B@@@@@@@B@@@B@@@B@B@@@@@B@BB@@B@@A@@B@@@@@@@BAB@BB@@B@@@DABA@@B@D@B@BA@@BB@@B@B@@@B@@@B@B@B@@@@AB@@@B@@@BA@AB@@@@@@@@BB@@@@BB@@@B@@@B@@@@@@@B@B@BA@@@@@AB@@A@@@AB@@@@@@BBB@@BB@@B@A@BB@@@@B@@B@@B@B@B@B@BBBABA@@@BB@@@@@BA@@@@@ABAA@@AB@@A@@@A@A@@@A@@B@@AB@@@BA@@BA@@B@@@B@@A@@@AB@@@@AB@@@@@BBBBB@@B@@D@@@B@@@BABAB@@@B@@@B@@BB@@BBAB@@@@@@A@AB@B@@@DA@@@AB@@AA@@A@@ACAC@@@AB@B@BAB@@@B@@BAD@B@B@@@BABB@B@@BB@@@@@B@B@@@@@BA@AB@@@@C@@@A@A@A@@@AA@AABAFABAB@@@B@B@B@@@BB@@DA@@BA@@B@@@B@B@B@@B@@D@@@BBBA@@@@BBB@B@B@@@@B@@B@BA@@@@BD@@B@@B@@BBBBB@@BB@B@@@B@B@@BB@@@B@@@BD@@B@BAB@@@B@@@B@@A@@B@@@B@B@B@@@DB@@D@@BB@@AB@BA@@@BB@@@B@@@@B@@D@@@@@B@@@B@@@B@B@BAB@@BB@@@B@BBB@D@B@@@D@@@BBB@@@B@BA@@BABAB@B@B@B@BB@A@@B@@@BBB@BBB@@BB@@@@AB@@@@@B@B@@BB@@B@@@@B@@@@@B@@@@BB@@@BB@@B@B@@A@@@@B@B@@@@@B@@AB@@ABA@@@@B@B@@AB@@AB@@@BA@@@A@A@A@@@A@@@A@@BA@@@A@@D@@ABB@AB@@@@A@@@@@A@@BA@A@@@AAA@@BA@A@AAA@A@CC@A@@@ABA@@@@BA@@AAA@@AC@@BAAA@@BAB@@AB@@A@AB@BA@@A@@AA@@@@A@@@@B@@@@AB@B@@@@@BA@@B@@@@AB@@@@@@A@@@A@@@@@@@A@@AAA@@@@@A@@@@ABA@@@A@@AA@@A@@A@@@A@@@@BBB@@@@@@A@@@A@@@@@AB@@@AA@AA@AA@@@AA@@@@@@@A@@@@@A@@A@@@A@@A@@@@@@@@A@@@@@A@@@@@A@@@@@@@A@A@@@@@@AC@@@AB@@A@@DAB@B@BB@@BA@ABA@@B@@ABA@AB@@@@AB@@@@@DA@@@A@@@ABA@A@@@ABC@AA@BABABA@A@@@A@A@@@@@C@@@@@A@@BA@@@A@@@@BA@@@A@@@@@A@@@A@@@A@@@AB@@@BAAAB@@AA@BA@@AA@AA@@@BA@C@@@AB@@@B@@@B@@@@@BC@@B@@@@@@ABAB@@AB@@B@@B@BAB@@A@@@CA@@A@@@@B@@@B@@B@@B@@@@@BC@@B@@@B@B@B@@BD@BBB@BA@AB@@A@A@A@@@C@@@A@A@@@AqA@@AA@A@A@A@A@@@C@A@AA@AA@@@@A@@C@@AA@@A@@AAA@A@@@@AB@@@@@B@@@@@BAB@@AB@BB@A@@BAA@@@@@A@AAA@@@@AA@@A@@@AA@@A@@@@@AA@CA@@A@@@AB@@A@@BA@AB@@@@A@@@BB@@A@@B@BA@@B@B@@@B@@@B@@A@@@BA@@@@@@@AA@@@@@@@A@@@@@@@@@AB@@@@A@@@AA@@@@A@@@A@@@A@AB@@@@@A@@AA@@@@AA@A@@@@BA@A@A@@BA@A@A@@@@BA@@A@@@@AAAAA@@A@@A@@AA@@@@A@AAC@A@@@@AA@@@AA@@AA@AA@@@A@A@@AA@@A@@@@AA@@AAAA@@A@@@AB@@AA@@C@@@A@AA@@AB@B@B@BA@AB@@@BA@AB@@@@AAAB@BA@ABAB@@@@A@A@@@AACA@@@@AA@@@AA@CB@@AA@@A@@@A@@BA@@@@@@@@AAA@@@@@A@@@C@@@A@@@@A@A@AB@@AA@@@@@A@@AB@@AA@@A@A@@@@@@@BA@@@@@A@@@@B@@@@@@A@@@@@AA@@@@A@@@C@@@A@@@A@@@A@@@@AA@@A@@@@@ABAA@@@@A@CBCDEBE@E@ABCBEFEDCBC@A@ABCB@BABA@C@CAGCEEIICECAC@A@C@A@AAEAE@AA@A@C@C@CBADCBC@CACEACAIEECA@CAG@E@EEAA@AAAC@ABA@@@CDA@EFGB@@ADA@C@A@AB@BBDBB@B@@CBGDE@MCA@AAAA@A@ACCCACCAAAE@A@EAEAECGEM@CAAC@CA@@@@DCBABA@A@@AAACCCAAA@AAAAA@@@CAC@AAC@A@CBA@A@C@A@AAA@C@@AAGACCAEAAAA@A@@AE@@@E@ACACAAACCAA@A@AB@BBBBB@@@@C@A@CAA@@@AB@HADABE@C@S@IBIDIFGDC@@@E@G@G@EDGDE@A@CAMEAC@@B@BAFA@A@@@@C@C@@@A@@AAA@A@C@A@C@E@E@@BADABAB@DA@@@@B@BC@E@AAAA@ABCB@@AACCAA@@A@ABA@AAAAAAAACA@@BCBA@A@AD@@AFCBA@@A@AAGA@@E@CBAB@BABABBD@DABCBEBUBEBA@@@AA@CEKCAC@C@E@AB_JIDE@EAAA@C@AA@A@ADCDC@GBC@E@A@GAC@A@A@@BBL@DADADCBCDGDC@C@C@EEMMGCE@CB@BADADADAB@@E@E@E@@@GCCCCECAAAA@@B@B@DABABC@E@A@AAA@EACBAA@ABABAAAC@CAACACA@IBCBA@C@AC"],encodeOffsets:[[116854,25908]]}},{type:"Feature",id:"360724",properties:{name:"上犹县",cp:[114.551138,25.785172],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@A@A@@B@BA@A@ABA@@A@@A@A@@@AD@@AB@BA@AB@BA@@AA@@@A@@B@@A@@@@B@@@@A@ABA@AB@B@@@@A@A@A@@@A@A@ABAAA@@A@AA@@@A@A@@AAAA@@@ABA@A@@B@@A@@@@BABA@AAA@@@AA@@A@A@@@A@A@C@AB@@A@@B@@@@@BA@@BA@@@A@A@A@AACB@AA@@B@@ABABAB@@@B@@@BA@@@ABCB@@@@@@AB@@@@@DA@A@C@@@C@@@C@@A@@A@@AA@@A@@C@@AA@@@C@A@@@@@@A@@BA@A@@@C@@A@@AC@@@@@A@A@@@@BA@AB@@ABA@@@AAAA@@@@@@AAAA@@CBA@C@@@A@@@ABA@@@A@A@AA@@@B@@C@A@AAAA@@AB@@A@@@@B@@@BA@@@@B@BA@@@@@A@@@@@@B@@A@A@@@AB@@@B@@AB@@@B@@A@A@@@A@@@@@A@@@@@@@A@A@@@@@A@@@AA@@@@@@C@@@A@@@AA@@@B@@C@@@A@@A@@A@@@@A@@A@@@A@@@CAA@@AB@@AA@@A@@AB@@@@CA@@A@AAA@@@A@A@AAA@A@@AA@@@ABA@A@@A@@@@@@@A@@@@@@@C@@@A@@BA@A@A@@@@@CBA@@AA@AB@@A@@AA@A@@@@@A@@AA@@@@AAC@@@@D@@@BA@@@@@A@@BA@@BA@@@@@ABAAA@A@@AAB@@AB@@@@A@@@AB@@@@A@@@ABAB@@@@A@AB@@AB@@CB@@ABAB@@@@@@AB@@@AAB@@@@A@C@AB@A@@A@@@A@@B@@AB@@AA@BA@A@@@@BA@@B@@BB@@@B@@@B@@B@@B@@@@@@@B@B@@A@A@@BA@A@@@@@@BA@A@@@CB@@A@@BCBCB@@A@@BA@ABA@@B@B@@@BC@AB@@BB@B@@@@@BA@A@@@AB@@C@@@E@A@C@CA@@A@C@ABA@ABA@AB@@A@ABA@@@@BA@BBAB@@AAA@@@@A@@AA@@A@AA@@AA@AA@@A@@@@@A@@AA@@@@AAA@@A@@AAA@@BA@A@C@@@A@A@A@@@B@@BA@ABABA@@@@A@@CAAAACA@AAA@AAAAAA@@AA@@A@@@@@@A@A@@CA@@AA@@@B@@@@@@@BED@@A@@B@@AB@@BB@@@@AB@@ABA@@@@BB@@@@B@B@@@B@@@B@BA@@@@B@B@@@BBBA@@BA@A@@@A@A@A@A@A@@@C@@@A@C@@AAB@AE@CB@@CA@@A@A@A@CBAB@B@@@@C@A@ABA@CBAB@@AB@@@ACBA@@@A@A@A@A@A@@AA@A@A@@AA@A@@AA@AA@AA@CAA@@AC@@@@@A@CB@@C@@AC@@@AAC@A@A@@@A@@@CAA@@@AA@@@@A@@@@@C@@@@@A@@@ADCDAD@F@@CD@BBBBDBB@D@B@@ADABAH@F@BDDDDBFDFBFDF@FABABC@A@@B@BBBBB@BABE@A@A@ADAD@B@BDF@B@DABCFADDJ@@@B@@B@@@@@B@B@@@B@DBBBBBB@@B@@BB@B@@BBB@BBBB@B@@@@BB@@B@@DB@@@BBB@B@B@@@@@BBB@D@B@B@@@@BB@B@BBB@B@BBB@B@B@B@@BB@@@B@@@B@FAB@BAB@@@@@BABBB@B@BADABA@A@@B@@@@B@@B@B@BB@@@B@@@B@@B@B@@@B@@B@@BB@@B@B@@@B@@@@@@@BBB@BAB@@AB@@@B@B@DDB@@@B@@@B@@A@@B@@@B@@@B@@BD@@@@@@AB@@AB@B@@A@@B@BBB@@B@@BB@BB@@BB@@BBB@@BBB@@@DBB@@BB@BBBBDBBB@@@@BB@@@@@B@@D@@ABA@@@@B@@@D@@AB@@@B@B@@@BBB@@BB@B@@@B@@@B@BB@@BBBB@@B@@@B@@BBBB@@@B@B@@@B@@BDBB@BD@BBBBBAB@B@B@B@@BBB@BB@B@@@@@@D@@BB@B@BB@B@@D@B@B@B@@@FB@@DB@@B@B@@BB@B@D@BBB@@@@@FA@@B@@A@@B@@A@@DBB@B@B@@@BBBBB@D@BA@@B@BAB@D@B@@@@AB@@@B@B@B@B@@A@AB@B@D@@AB@DA@@B@@@@C@@@@B@@@BAB@BB@A@@B@B@B@@@BAB@@BB@B@B@@@@@@BB@@@B@BB@@@@BB@BB@@BBBB@@BB@B@@@D@@@BBB@D@D@B@@AB@@AD@@BB@@@B@@@D@B@@@B@B@B@B@B@BA@@@@D@BBBB@@@@B@BA@@BBDB@@@BB@@@B@B@D@@@B@B@@@@BB@@B@@B@@@@BBBB@@BB@@@DDB@@@@@BA@@@AB@@A@A@@B@@@@@@@@@@@@AB@@@B@BB@@@@@@B@@B@@B@@BB@@B@@B@B@@@B@B@B@B@@A@@B@@B@@@B@BBB@@@@@B@BA@@B@@@@A@@@@B@BA@BB@@A@@BAB@@@BB@@B@@@BB@@@BB@@@B@@@BBB@BB@@@D@@@B@@@BB@@@@DB@@BB@@B@@BB@@@BDBBBB@@B@@@@B@@@@BB@@B@@BA@@@@@BB@@@@@@@@BB@@B@AB@@B@@@@B@@BB@@BA@A@@@@B@BB@@DB@@B@DBBBB@@@BB@@@BB@B@@@@@BB@@@BBBB@B@@@B@B@BAB@@@BB@@@@BB@@@D@B@@@B@BB@@@BB@@DB@BB@BB@@@@B@@BBB@BBBB@@A@@B@@ABBBAB@BBB@F@@@DB@@BBBB@@BDBBDFBBBBBB@B@BB@@@@B@BBB@BBB@@BD@BBB@@@@B@@BB@BBBB@BBB@@@@@BB@@@FA@@B@B@BADBB@@@B@@A@@DAB@@ADA@@B@B@B@B@BA@@B@B@@@@@@B@@B@@@B@B@B@@BBB@@BB@A@@BA@@AA@@BA@A@@@ABA@@@A@@@@@@BA@@@B@@B@@BB@B@B@@@BAB@BA@@@@@@B@BB@@B@B@FBB@B@D@D@BAB@@@AA@A@C@@@@@A@@@AACA@@A@@@A@@@@BABA@@@A@@@A@@@AB@BC@A@A@A@@@A@@@@@A@@AA@AB@@A@A@@@@@@BABB@@B@@@AB@B@@B@BB@BB@@@@B@@@@BB@@@B@@@BB@@@@B@@@@BDB@@B@@@B@@B@@B@@@B@@@@@BBB@@@B@B@B@BDB@@@BBB@BB@@BB@@BB@@@@@BB@@@B@@B@@@B@B@@BB@@@@@BB@@B@B@@@DBB@@@@BB@BB@BB@@@@B@B@@@B@@AB@@A@@B@@@B@D@@@BA@@B@@B@@@AB@@@@@B@@@B@@B@@BA@@BA@@BB@@BBB@@@@@@AB@@@B@@@@@B@@A@@B@@A@@@@BA@@B@@@DAB@BDBBBBB@@B@B@B@@@@@@@BABABA@@B@@@B@@@@@BB@AB@@BBB@@B@@@@@@BB@@@B@@@B@@@B@@@@B@@@@B@@BD@B@@@@@@BB@@@BBB@B@@@@B@@@@BBB@@@ADA@@@@@@B@@B@@B@@@B@B@@D@B@B@B@@@@@B@@@@B@@B@@B@@@@@@@BAB@B@@@@@B@BB@@@D@@BB@@@@@B@@BBB@@B@@@@@B@@@@ABA@A@A@A@@@A@CBA@AAA@@@A@A@A@@@A@A@A@@@AAA@@B@@AA@AA@A@@@AAA@@@A@A@CB@@A@@@@AA@@AA@@BA@@@E@@@@@A@@B@B@B@@AB@BA@AA@AA@@@@A@@A@A@@B@@@@A@A@@@@B@B@D@@@@BB@B@BA@@@@BB@@@@@B@@BB@@BA@BB@@@BA@@B@@A@@@A@A@@@AB@BA@@@BBAB@@@@@B@B@B@@@BA@@@@@AB@@A@@BA@@DA@@B@@@@@B@B@@A@@@@B@@@BA@@@@@@@@B@BA@@B@@@B@@@@@@@@@BA@A@@BA@@@@@A@@@AB@@@@A@@B@@A@@@@D@B@@A@@@A@@B@AA@A@@BA@A@AA@@AB@@@@@@@@@B@@@@@@@@@@ABAA@@@@A@@@A@@@ADB@@@AB@BA@@@A@@@AB@A@BA@A@@@@@A@@AAB@BB@@@@@@@@@B@@@@B@@@@@@AA@@@BAB@@@@@BA@@@@@@A@@@@@@@BA@@@BBA@@B@@@@@@@B@@@@@@@@@@@BA@@@@@@@@B@@@B@@@@@@@B@@@B@B@@B@A@AB@@@BB@AB@@@B@@@@A@@BA@@@@B@@A@@BA@@B@@AB@B@B@@@@BB@B@DB@@@@@A@@B@B@@A@@AAB@AA@@@@BAAA@@@A@@@A@@B@@AAA@A@@AA@@AAA@AA@@A@@A@@@A@@@CA@@A@@@@@AB@@@@@@ABA@AB@@A@@@A@A@@@@@A@A@A@@@@C@@AA@@A@@@A@@@A@C@A@@@AAA@@@AA@B@@AA@@CB@@A@@@@BAB@BA@@@@@ABAB@@@@@B@@@BBBBB@@@BABBB@@@B@@@@@B@A@@@BAAAB@AAB@@A@@B@@@BAD@@@B@@@A@@ACA@@@A@@AAB@BA@@@ADBB@B@@@@@BABA@@BAB@@@@AAA@A@@@@@A@@@C@AB@@AB@@@@@@@BB@AFCBAD@@AB@@@D@@A@@BAB@@AD@@BB@@B@B@@B@@@D@B@@B@@BB@@@@@@BAB@@@@@@BBB@@BB@@BBBB@BB@@B@B@@BB@@B@@BB@@AB@@@BB@@ADB@@@@@BB@@B@@BB@B@@@@A@@B@B@@@@BFF@@@B@@@BB@@@DA@@@@BAB@@@BAB@@@B@@ABA@@@@B@@@@A@@B@@@@@B@@@B@B@@@@AB@B@@A@@@A@A@@@@AA@@B@B@@A@@B@@BB@@A@@@A@@B@A@@A@@@@@@BA@@B@@BB@D@@@B@@@@@B@BA@@@@B@@@D@@@@AB@@AB@B@@AB@@@B@@AB@@@@AAA@CA@@@AA@@@ABAAAA@@AAA@AA@@@A@@AE@A@@@A@A@A@@AA@@@AB@@@@CB@@@@@@@@A@@@@@@@A@@@AABABA@A@@@A@@@@@A@@@@AA@@@A@@A@@ACB@AAA@@@C@@@@A@@@AA@@AA@A@@A@@@@@@@AA@AAA@@AC@@@C@A@A@A@@@@A@@@AA@@@A@@@@A@@AA@@@@@@A@@@A@@A@@@BA@@A@A@@@BA@A@AA@B@B@@@D@@A@@@A@@@@@A@@@AD@@@@@AA@@A@@A@@@A@@@@@A@@@@B@@@B@BB@@@AB@@A@@BBBA@B@@@@BB@@@A@AB@@@@@BC@@AA@AB@@A@@@A@@@@AA@@A@@@AA@@A@@@@@A@@A@@@@@@A@@@@@@@A@@@@@AA@@@A@@@@A@A@@@AA@@@@@A@BA@@AA@@@@@@A@@@A@@A@@A@@B@@@@@@AB@@@@A@@B@@@AC@A@@@@BA@A@@@A@@A@@@@A@@@@A@@@A@AB@@AB@@A@@AA@AC@@A@@A@@B@@@B@@A@@@AA@@@A@@@A@@A@@A@@@A@@AAA@@@A@@@AAA@@AA@A@@@A@@@@B@@A@@A@C@A@@@A@BA@@@A@@@AA@@A@@@@A@@@@BA@A@@@@@@@@BA@@A@@@@A@@@@@A@@@AA@@AB@ABAB@@@@@@AA@@BA@@AA@@B@@A@@@AB@@AA@@@@@@A@@A@@@@A@@@@@@A@@@@@@A@AAAB@@A@@@@@A@@A@@@A@@@@AAB@@@@@B@B@@@@ABB@A@@@@@A@AB@@@@AAA@@@@@A@@@@@@B@B@BB@@B@B@@@B@@A@A@A@@@@@A@AAA@@AA@@@@A@AA@A@@@@@A@@A@@@AA@@A@@@C@@@A@@@@A@AA@AB@@@@@AA@CAA@AA@@@@@A@@@@A@EA@@A@@@@@@A@@@A@@AA@@@AB@@A@@@@@A@@A@AAA@@@A@@@BA@@B@@@@AB@B@B@B@@@B@BA@@AA@@@@@A@A@@@@A@@@AB@@@@C@@@@@AA@@@A@@@@@ABAA@@@A@@A@@BA@@@@@@BA@@B@@@@AA@A@@@@@B@@@@@@AAAA@@A@@@A@BB@@@@B@@B@@@@@BA@@AA@A@@AA@@@@@AA@@AB@@@D@@@BA@@@A@A@@@A@@B@@@B@B@@@BA@A@A@@@@@A@@@AA@AA@A@@B@BA@AB@@A@@@@BA@A@@@@B@B@@@B@@B@@@B@BBB@B@@@@@B@B@@BB@@B@BB@@@@@A@A@@@@@B@@B@@A@@@@B@@B@@B@@@@B@@@@@BB@@@B@BA@@@@B@B@B@@BDB@@@@@A@AB@@@BA@@@@D@DBB@@@B@@AAC@@AB@ACA@@BC@@@A@ADABA@@A@BA@@@C@A@@@@B@@@@A@A@A@@@AB@@@B@B@@A@@@@@A@AB@@@@AAA@@@A@@B@BA@@DAB@@@@@@A@A@@@A@@@ABABA@A@ABA@@B@@AB@@AA@@A@@@@AA@@AAA@@@@@A@@A@@AA@@@A@@BABABABA@@@A@@@ABAB@AA@A@@DAB@@A@@@A@@@@BA@@@@AAAA@@AA@@AAA@@A@A@@@@A@@AAA@@@@@A@A@@@@AAA@A@A@@@A@@BA@@AEA@@@A@AA@AA@@@AAA@@@@B@@@B@B@@AB@@AB@@@B@@A@B@@BA@@B@@A@@AA@@@A@@@A@@@@@@A@@@AA@A@@@ABCBCB@B@@@@ABCBA@AB@DC@@B@@@B@@@AAAA@AA@@@@@@A@A@@AA@@A@AA@@@@AAAAAA@@@@@A@@DA@@@A@@@@AA@@BC@@@@BB@BBB@@@@B@@@@@@A@A@@@AB@@BB@@@B@B@@@@@@@@A@@@A@A@@@ABA@@@A@@ACAC@ACB@@AAAA@@@AB@@A@@C@A@@B@@A@A@A@@@AA@@@@@@A@@@@@A@A@@@A@@AA@@@@B@B@@@@@BA@@D@@@@E@@@@B@@A@AA@@@@A@A@@@@@A@AA@@C@AB@@A@@C@@A@A@A@A@@@C@AAA@A@CA@A@@@AA@@@@@ABA@@@@AA@@@ABABA@@BA@A@C@@@A@@AAB@@@@A@A@A@@BAAA@@@A@@A@@A@@@A@@B@AC@A@A@A@A@@@@AA@AB@@@@ABAA@@A@@@@A@@AAAA@AA@@AA@AA@@@A@@@A@@@@CB@@A@@@ABA@A@@B@@@@@@ABA@A@A@@@AB@@A@A@@AAAA@@@A@@@@@@@A@AA@@@@AB@@A@A@ABA@AAA@A@A@AC@@@A@@A@@A@@BA@@A@@@A@A@@@AB@@AB@@@@A@AB@@A@@@@BAA@BA@@BA@@@A@@@AA@BABA@@@AA@A@@@@AB@@@@C@@@@AA@@CA@@@AA@@A@@A@@AA@@A@@AA@@A@A@@@AA@@A@@A@@@@ABA@@@@AAAAAAA@AC@@@@@AA@AEAAAA@@@AAA@AAACCAA@@A@A@@@ABA@ABABA@@A@@AB@@@@A@AAA@@AA@AACBA@A@@AC@A@AB@BA@AB@@C@CBA@@@AA@@A@AA@@@@@@@A@A@@CA@@A@@@ABAAA@@BC@@@@@A@A@ABA@A@@@A@C@@@AB@@A@AAA@@@C@EA@A@@A@@@A@@B@BA@@B@@@BA@AB@@@BA@@@A@AB@@A@AB@@@@ABA@@@A@AAA@@@ABA@@BA@@@AB@@AB@@A@A@@@C@@@@B@@@B@BBB@BB@@@@B@BAB@@CB@@A@AB@@BB@D@B@@@BBB@@@B@@@BBBAB@BBB@B@BB@@BA@@@BBAB@@@BB@BB@@BBBB@@@@@@@B@@@D@@@BA@A@A@B@@@AB@@BB@@ABA@A@@@AB@@@@A@AB@@@@@B@BB@@@@B@@@B@@@BB@BB@B@BBB@@AB@@@@BB@@@@@B@D@@@BB@@@AB@BABAB@BDD@@@BB@@B@@BB@B@@BB@@BB@BBB@B@BB@@BA@@@BB@@@BB@@@@@@B@@@B@BB@@B@@@BBBB@AB@BA@@B@BABB@@B@@@B@B@@A@BB@B@@BB@@AB@@@B@B@@@BA@BB@@AB@@@@ABA@@@ABAB@@AA@@@@AB@@@B@BADABA@@A@@ACA@C@A@A@@@@@A@A@AA@@A@@@A@@@AB@BA@@@ADA@@BA@@@@BA@@@ABAB@@ABB@@B@@@@BB@@@@@B@@BBB@@B@@BB@B@B@B@@AB@@A@@B@@@B@BC@@BA@AD@B@@@F@DBBBDDD@@@F@D@D@D@DAD@BA@@@AA@@A@AAAA@@A@A@"],encodeOffsets:[[117123,26480]]}},{type:"Feature",id:"360725",properties:{name:"崇义县",cp:[114.308267,25.681784],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@@C@@B@@A@ABAB@@A@@@CAA@@A@@@C@C@A@A@@@A@@@AA@AA@@@@@@AAAAACACAA@A@@@AB@@AB@@A@AB@@@@@A@@@@A@A@@@A@@B@B@@@@@BAA@@@A@@AB@@@B@@A@@@@@@A@@@@@AB@@@@AA@@BABA@@@@@@B@@@B@B@@A@C@@@@A@@@@A@@A@@@A@@@@@A@@B@@@@B@@@@B@@@DA@AFAB@@A@@AAAA@A@@@AAA@@@A@@A@CBA@A@A@ABAAABA@A@@BA@@@I@@@AA@@AA@BA@@@@@A@@@@B@BAB@@@@A@A@@AA@@@@@AB@@A@ABA@AB@BAB@@C@@@A@@AA@A@@@@B@@A@@BA@@@@B@B@B@@@BAB@B@@@B@@AB@BB@AB@@@B@B@BA@A@A@@B@@A@AB@@@@ABA@@@AB@@BB@@@D@@BBA@A@@BA@@@AB@B@@@B@B@@@B@BBB@@@B@D@B@@@BC@@B@@B@@BAB@B@BAB@@@@BB@@ABB@@B@B@@A@@@@B@@@B@@A@@@@B@@AB@B@@@@A@@@C@@@@BA@A@@@@@AB@@A@A@@@@@AB@BA@A@A@CA@@A@A@A@@@A@AA@@A@A@A@AD@B@@AB@@@@@@@B@@AB@BAB@BA@@B@@@BAB@BBBA@@@A@A@A@@@EAC@A@C@A@AA@@AA@@@AAAA@@@A@@AA@AAEBAAA@AACA@@@@@@EBCB@@@@@AA@A@CBG@C@CBA@E@A@AA@A@BAA@@@@ABA@@@A@AAC@@@AA@@A@A@@@@@AAB@@@@AAAACCC@@AA@@@A@CBA@AB@@@DC@@B@@ABABABBB@@BB@B@@BB@B@@@@@@@@ABBB@B@@B@@@@B@@B@AB@@@@@B@@@B@@@@@B@@@@A@@BA@@B@@@@@@A@@@@@A@@@@@A@@BA@@@@@A@@@@@A@@@@AA@AA@@@@CAA@@AA@@@@@@@A@C@@@@@AAA@A@A@@@AAA@AFB@A@@@@D@B@@@@BB@B@@@B@@@@BB@@@@@@A@@B@@A@CB@@@BA@@@@AA@@AA@@A@@@@AA@@@@A@@A@@A@A@@@CA@@@@@A@@AA@A@AA@@AA@@A@A@A@@@AA@BA@@AA@@@A@@@AAAAA@@@A@@@AAA@@@AA@C@@@AAAA@@AA@@@AAA@@@AAA@AA@@AAC@@AA@@BA@@@@@D@BB@@@BAB@@@@BB@@@B@@@B@@@@@B@@B@@B@BA@@@A@@@A@A@AB@@AAA@@@ABC@A@@@@@@CAC@ABA@A@A@A@@AAA@@@A@C@A@ABA@@@ABC@AAABA@@C@@@@@AA@@A@@@A@A@@BA@@BA@AB@@A@@@AAA@C@A@@@@AAAAA@A@@AA@A@A@@@CA@@@@AAAAAA@AAA@@@AA@@@E@A@A@C@@@@BB@BB@@@B@@@@CACC@A@@@AA@CC@@AAA@@A@CBA@ABA@AA@A@@A@AAA@AA@@@CAA@@A@@A@A@@@@@A@A@A@@A@@A@@@A@AA@@A@A@@@AA@@AB@@A@AB@BA@@B@B@B@@@B@B@@BB@@@D@B@@DD@@@B@@@BB@BDB@@B@@@@@@@@A@A@ACA@CA@@@B@@@@@@C@AB@@A@@BBB@@@@A@@@A@@@A@CB@@AA@@@@@A@@B@@@@@A@@@AB@B@B@BAB@B@B@B@B@@@@CAAACA@@AA@@@@AAA@@BABB@@B@@@@AB@@@@AAAA@@AAA@@BA@A@@@AB@@@@A@AAAB@@AAA@A@A@AAA@AA@@A@@@@AA@AB@@AB@@@B@B@B@@@@ABA@ABA@AA@@ABA@@B@@AB@@A@@@A@@@A@ABA@@@A@@@C@@AABAAA@@@@B@@@B@@@BA@ABA@@AA@A@AA@@C@AA@@@A@@@@CA@AA@@@AAAAA@A@AA@A@A@AA@AA@A@ABA@A@AAA@@AAAC@A@@@@@ABA@@@A@@AA@A@A@A@@C@@@@@AA@@@@CAA@@@A@@@A@A@@A@@AC@A@@@A@@@@@AAA@@@A@A@@AA@AA@@AA@A@@@@A@A@AAA@ABCA@@@A@@@A@ABE@GDABG@EBCDCDCHAF@FDDHJLJHFJFBDBF@@AFADEBE@C@AAEACBGDEFC@C@G@E@EBCDEBEBACCCEEECGCG@ADADCD@DBD@FADAF@DBDDDJDHHBDBBHLBD@DBBCBCBC@C@@B@FBBBBBDBDBFEBGCCCEB@@BDHFHFBFDHDFBD@DBJDHFDDBDADAB@D@J@J@D@BBBBABADCJJZ@LHF@DBBADAB@@CBCB@@GDEB@BCD@D@DBTBF@B@@@FDF@D@FCDEDE@ABC@EBGBGBGDEF@BAFEFAH@HBDBD@D@DCDEBCBCD@F@HAFADAD@FADABE@CBABAB@HAHAJAFCHEJEJCBC@A@ADAB@F@DBFBDBBBHBHADAB@BCH@HBD@BDFBDBFBBDDHFHFFHHHFBFBDDDBJLFFFFHHADABAD@D@DBD@DBD@F@DDFBBBB@D@BBBBBDDBDBDBF@@@DAFBDBDAB@DADAFBDBFFBFDBD@FDDDFDBDDBDDDBFDDF@FBBDBDDDDDDBF@D@BF@FBFBF@FB@LHNF@@BB@B@@B@@@@@D@@@@@B@@@@@BB@@B@DB@@B@@@B@B@D@BB@@D@@BD@@@DAB@@@@@D@@BB@DBB@@BBBB@@BB@B@@BB@B@B@@BB@B@B@B@B@@@B@DA@B@@BA@@BADAB@BAB@D@@@@@@ABADAB@B@B@@@DB@@DAF@@BBA@BD@B@@@D@@@B@B@B@B@B@@@B@B@@AB@AA@A@@@A@A@@B@@A@A@@@A@@@A@A@@A@@A@@B@BA@@BA@@@@AA@@BA@@@AB@@@FC@A@@@@@@@A@@BB@@DB@@@B@B@@@@B@@@BB@@BBBBBBB@BBB@BDBBDB@@@B@@B@BABAB@@AA@@@B@B@B@@@D@B@B@@AB@BB@@@BB@BB@@@@BB@@@B@@@@@BB@@BBB@@BBB@@@BB@@@B@@B@BB@@BAAAB@@A@@B@BAB@@@BAB@BAB@BAD@B@@@DBD@B@F@@@D@@@BA@@B@B@@A@@@@@AAA@@BAD@@A@@@A@AB@BAB@@AB@@@DADA@AB@@@DA@@B@B@@A@@@@B@B@@AB@B@@@@A@A@@@@@@@AA@@@@A@@@A@@AA@@@AB@@A@@B@B@@ABB@@BA@@@AB@@@B@@@@BBAD@B@@@@@BA@B@@BA@@@@@@BABA@@DA@@BA@@BAB@@@@@BABA@@B@@@@@BA@@B@@@@@BA@@BA@BB@B@BBBA@@@@B@@AB@@AB@@@@@B@@A@@@C@@D@BB@@@@BB@@@B@@@@@BBB@@@BA@@BBB@@AB@D@@@@@B@BAB@@@B@@@D@@@@@@@B@@@@@@@BB@B@BA@@B@@BB@B@BBB@B@@@B@BBB@@@DB@@@@BA@@@BB@@BA@@BB@DB@@B@@@B@@@@B@@B@@@@BB@@@D@@@@A@@BB@@B@@@D@@@@@@@BB@@B@@@@@B@B@@@@@@@B@@@@@B@@@B@B@@@@A@@BA@@@A@@BA@@B@B@@@@A@@@@B@@@@@B@@A@A@@B@@A@@@A@@B@@@BA@@BBBBB@D@@@@A@@BBB@B@@@B@BA@@B@@@D@B@DA@@BBBB@@@@@@BBBB@@B@BA@@BAB@@A@@B@B@@@@@D@@BB@@@@D@@@BAB@@@B@@@@B@D@@@B@@BD@@@@BB@@BB@@@@BD@@@D@@@D@B@B@@C@@@@BA@@@@@@DABA@@B@@A@@@A@@BABABA@@@AB@@BDABBB@B@B@@@B@@AB@@A@@@@@AB@@@BAD@B@B@@@B@B@@@BB@@B@BBB@BA@A@@B@@@@AB@B@BA@@B@BB@BB@B@@@B@@B@BB@BBBAB@B@@@B@B@B@@@@@@ABAB@BAB@@@@@@A@@B@@@@AB@@@B@@BB@@ABAB@@ABA@@BC@@B@B@@@@BB@BAB@B@@A@AB@B@B@B@B@@@BBBBB@@@BB@@B@@ABC@C@C@C@C@E@@CCACAA@C@E@@@ABCB@@AD@@A@A@@@AB@@@BA@@@A@A@AAA@@@AA@AA@@@A@@@@AA@@@@@AA@BA@@BABA@@B@@A@@B@@AB@BC@@B@@ABA@@B@@@B@@@BBB@B@@@@@B@B@D@B@BD@@@BB@BABC@A@A@@BA@@@@BB@@BABA@@B@BA@@@@BA@@AAB@@A@@@A@A@@BA@@AA@@@AAAB@@@@A@A@@@AA@BA@A@AB@@ABAA@AA@A@@@AA@@A@A@@@A@@@@A@@A@@AA@@B@@AA@@A@AAA@AAA@@AA@@@AAA@@@AA@@A@@CC@ABABA@ABA@@A@@A@@@C@A@@@@AA@@@@BA@@AA@A@AAAA@@A@@@A@@@A@@A@@A@A@@@@BAB@@@@@BA@@B@B@BA@@AA@@BA@@A@B@B@B@@A@@@C@@@A@@@@@@AAAA@@AAA@@A@@BAAA@@B@@AA@@A@AAA@ABAAA@A@@@A@@AA@A@@@A@CAA@@BAB@@@DA@@BA@A@A@@A@@AAA@A@A@@@A@@D@@@B@B@@@BA@@BA@@B@@AB@BA@@B@BBB@@@B@BA@@@@BAB@@@BAB@@@B@@A@@BAB@@A@@@AB@@A@AB@@@B@@@@BFBD@@@B@BBB@@@BA@@D@B@@@B@B@BAB@B@@@@@D@@AB@BBBA@@B@@@DB@@@B@B@@@@@@BBB@@@BB@@B@DAD@@@BAB@@ABAB@D@@BB@B@DABBB@@BB@BBB@@@@@BA@@@BB@BABAB@BA@@B@B@@@BBDDBB@BBB@B@@BBBBBFB@@B@@@@BDB@BBBBBB@@@@AB@B@@B@@@@BB@@B@@@B@BB@@BB@@@BB@@@BB@@@BB@@B@@DB@@B@@D@@@@@BA@@@@@BBB@@B@BA@ABB@@B@@@B@@AB@@ABB@A@@B@@@BAB@@@@@BA@@BA@@B@B@@@B@@@AB@@@BB@@@@B@@BDB@B@B@BBB@BAB@B@@@BA@@@@BBB@@@@@@@B@@@B@BB@BB@B@@@BA@@B@B@B@BA@@@@@@@AB@B@BA@@B@@@DA@@@@@B@@@B@@BBB@@BB@@BBBBB@@@B@@B@@@BBBA@@@@BAB@@B@@B@B@B@B@D@@B@AB@@@B@@@@BB@@@B@BB@AB@B@B@@@@@BA@BB@@@D@B@B@@AB@BABA@@B@@B@@B@BA@@@@B@@B@@@BDBB@B@BBD@@@B@B@B@B@@@@DB@@@BAD@@@BA@ABA@@@ABA@@AC@@@AB@@A@A@@@A@@BA@A@@BAB@@@@A@A@A@@@@@@B@@@BABBDB@@B@@AB@@@B@@A@A@A@@B@@@BABB@@B@@@@@B@@A@AB@BAB@@A@@B@@@B@@B@@BA@ABA@@BADAAAAA@A@@AA@@@@A@C@AA@@@@@A@@AA@AB@@A@@@AAA@@CA@A@@C@A@@@A@@@CAA@A@@@A@A@A@@@@@AA@@@@A@A@@@AB@@A@@@A@@@@@A@A@@@A@@@AA@@A@@@A@ABA@C@@@AB@@A@A@@AA@CAA@A@@@A@@A@@A@CDAB@@A@@@@@A@@@@AAAA@AA@@@A@A@AA@@A@@AA@ACC@@B@@A@AAACAAA@@A@AC@@AA@@@A@@@@@A@@A@A@A@@@A@A@A@@AA@@@@A@C@@@@A@A@A@@A@@AA@@@A@A@@A@@@AA@@@A@AA@A@AA@AA@@A@@@@@A@A@@A@@A@@A@@@@@@A@@@@@A@@@AA@@@A@@@A@A@@@A@@@AA@@A@@BA@@@A@@@AAAA@@A@A@@@@AA@@@A@@AA@@@@@AA@@A@ACA@@@@@A@@A@@@A@@A@@A@A@@@@AA@@@A@@@AAA@A@@@@@AAA@@A@AAA@@A@@A@BA@A@@@A@@AAA@@@@@@@A@A@@@@AA@@AA@@@ACAAB@@A@@AA@@@AAA@A@@A@@@AAA@@@@@@AA@@@@@A@@@@CBA@ABA@A@A@A@AA@@@CA@@@E@@BA@@@B@A@@BAA@@@A@@A@@A@@A@@@@@@A@@BAB@@A@@@A@@B@@A@A@A@@@A@@@A@A@@@AA@@ABA@C@A@@@ABCB@@A@@@AAA@AB@@@@@@A@@@@BA@@@AAA@@@@@A@A@@@A@@@A@@@@@@@A@@@A@A@@@A@@@A@@@A@@@@@@@@A@@A@AAA@A@A@A@@@@@A@@@@@@@@@AB@@A@@@@@A@@@@B@@@@AB@@A@@@@@A@A@@ABA@@@@@@@A@A@A@@B@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@A@@AA@@A@@@@AA@BAA@@A@@A@@AA@@@@AA@@AABA@A@A@AB@@A@@@@@@BBB@D@@@B@@@BAA@@A@AB@@A@@BC@A@@@AAA@@@A@@B@BA@@@@B@@@BA@@@@B@@@B@@@@@B@B@@@D@BAB@@A@A@AAC@@@A@AAA@CA@@@@A@AAA@A@AA@@@AAA@@AA@@A@@@BA@A@@A@C@C@C@A@@AA@AAAAA@@@@@A@@A@@A@@@A@@BA@A@A@@A@AA@@@A@@@A@@A@A@@A@AA@AA@@A@@@AA@@@A@AA@A@A@A@@@A@@BAAAB@AAAA@@CE@@A@@@A@A@A@@@A@A@@@A@@C@@BA@A@@A@AA@@@A@@@@@A@A@@BA@A@A@A@@@@@@@A@A@@BA@@@AA@BC@@@@@AB@@A@@BA@@@A@@AA@@@A@@@@@AA@AA@A@A@A@@A@@@@@A@@A@@A@A@@A@@AA@@ABA@A@CACB@@A@@AA@@@@@A@@A@@BA@ABA@@A@A@@@@@A@@@AA@@@AAE@@@A@A@@@AA@@@A@AAAA@@@@@ABA@A@ABAA@@A@A@@@@@@@A@@@@@ABAB@@A@@BA@@BA@@@AAA@A@ABA@@@@CA@AAA@A@@@A@A@@@@@A@@@@@A@A@A@@BABA@@BA@ABA@@@@@@AAA@@AC@@AA@@@AC@@@AA@@@@AA@A@@@AAA@@AA@@C@C@A@@@@@A@@A@@@@C@@CA@@A@AA@A@@@A@A@@@C@A@@AA@A@@@AAC@@BA@@AA"],encodeOffsets:[[117090,26090]]}},{type:"Feature",id:"360726",properties:{name:"安远县",cp:[115.393922,25.136927],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@ABA@@BA@@@ABAB@@A@@@AA@@A@@@C@@BAA@AA@@AAAAA@@A@@@AB@@@B@@AB@@A@A@A@ABA@AA@BA@A@A@A@@@@@A@A@@@@@A@CB@@A@A@@BA@A@@BA@@B@@@BAB@@@BB@@B@@@BB@@B@B@B@@@@@B@@@B@@@B@@@B@@@@@BBB@B@B@BA@@@@BB@@@BB@@A@ABA@@@A@@A@@A@@@C@A@@@A@@@A@@@A@@@A@@@@BA@@B@B@@AB@B@BA@@B@@@@@BA@@@@B@@AB@B@@@B@B@@@D@@A@A@A@A@@B@@A@AB@@A@@BABA@AB@B@@@BAB@@@B@@@B@@AB@@@B@@@@ED@@@@ABAB@@A@A@@@A@@AA@A@@@AD@@AB@@B@@B@BBB@@B@@AFAB@@@BB@@@BB@@B@@@B@@BBBBBB@@@BA@@@@B@@BBB@B@@@@@@B@@B@@@B@@B@@@@@BB@@B@@@@@B@@@@@BB@ABBB@@B@@@A@@AB@@@B@BA@B@@@@@BB@@@@B@@@B@@@@@BB@@B@@A@A@@@@@@B@@@@@B@@@@BBB@@@@B@@B@BAB@@@@@B@B@@@@@BBAB@@BBBBBB@@BDB@@@B@@B@@BBB@@@B@@B@@@B@BB@AB@D@@@BB@@B@@@B@B@@@B@@@@AB@@@@EB@@@@ADBBADBB@@ABA@@@@@A@@@A@@B@@@B@BBB@@BBBBAB@@A@A@@@@B@@AB@@@B@DA@@@@B@BA@AD@@@D@@@B@B@B@@A@A@@@ABB@@@@B@B@B@@@@BA@@@AB@@@B@B@B@@@@@BB@@@@@B@B@B@@@@@B@@@AB@@@@A@@@@@@B@B@@@B@@@@A@@@@BA@@@@BA@@@AB@@@AA@A@@@AB@@@@C@@B@@@BABA@@B@@@B@D@@@@@@B@@@@@BB@@@BB@BBBB@@BA@@B@@@DB@@BB@@B@B@@@B@@@B@@A@B@ABBB@B@@B@ABB@@@B@@B@@B@BB@@BB@@B@BBB@@@@@@A@@@@B@B@B@@B@@B@@B@BD@DB@@DB@@@@@ABA@@@BB@DB@@B@@DB@@@B@BB@@B@B@@@B@@@B@@@B@B@@@BBB@@@@@AB@@@BBB@@@@@BB@B@D@B@@B@BB@@B@B@B@BB@@@@@ADA@@B@@AB@BB@@B@@BBB@@@B@BB@@@@BA@@B@B@BB@@@@B@B@B@@@@@@@@ABBB@@@B@@AB@B@DBB@B@B@@AB@@A@A@@@@BAB@B@@@@@@A@@@@B@DA@@@@BABB@@B@@CD@BA@@BBBB@@DAB@@@B@@A@@DD@@BB@B@@@@BBB@B@B@B@@BB@@@@A@ABA@@@ABAB@@@@AB@BA@@@BB@B@@BDB@@B@B@@@@B@@BB@B@@B@@@BBBBB@@A@@@A@@BAB@B@@ABA@AB@BAB@@@BA@@@@@@@A@@BA@@B@D@BA@@A@A@AAA@@@BAB@BAB@@A@@B@BA@@@A@@AAB@A@@@AA@A@AA@AAA@A@AA@@A@@@A@@@A@E@@@@@A@A@@@A@@BAB@@@A@@@A@@@@@A@@A@@@@AA@@A@AA@A@@A@@@@BA@@B@@@@A@A@@@ABA@@B@@@@ABAB@@A@@B@B@@@B@B@B@@AB@BB@@B@@@B@@@@@@@B@@@D@B@BA@@BB@@B@@BB@B@@@BBBBB@B@BB@@@@BA@CBABA@A@@B@BCBA@@BA@@BAA@@@@@GC@@@@A@@A@@@@@C@A@@BA@@BA@@BEDC@ABA@@BA@@@@@A@@A@BCBA@@B@B@@@B@@A@AB@@@@BB@@B@@@@B@@@@@B@@@@CBA@@B@B@BA@@BA@@B@@@@@B@@ABA@@B@@BB@@@@@@BB@@@@@@@@@BBB@B@@@BAB@@@D@@BB@B@B@B@@@@@B@AA@@BBB@@@@A@@@AB@@@@@B@B@BB@@B@@@@BABA@AB@@@BAB@@@@@@@B@@@@@B@@BBB@BBBB@@@@@B@B@@B@B@BB@@@BB@@@B@@@@@DA@@@AA@AA@@@@BA@B@@B@@AB@@@B@B@@@@A@@@A@@@@B@@@B@@@BB@@B@@A@AB@@@@A@@DA@@B@@@BA@@@@@@@AB@BA@@@@@A@A@@B@@@BBBB@@B@BBB@@@BABABB@@@BB@BA@@@BBB@@@@D@B@BCB@@AB@@BB@@@B@B@@A@A@@@A@@@@D@@@@@B@@@@@BABB@@DA@@@@@@BA@@BBB@D@@@@ADBB@@@BA@@BA@@BA@BB@@@@ABA@@@@BA@@@C@@AA@@CC@@BAA@BCB@@A@@@@@A@@BAA@@A@A@A@C@ABABABA@@B@@@@CB@@A@@B@@@B@@@BA@@B@B@@B@AB@B@B@@@@B@@B@B@@@@@@B@@BB@B@@@@BB@@BBB@@@@@BB@A@@DAB@BA@A@AB@B@@@@A@A@@@@@AD@@@@B@B@@BB@@B@B@B@D@@A@@B@B@@A@@B@@@@@@BB@@@@@DA@@@AB@@@@ABAB@@@B@DA@@@A@@ABA@@@EGA@@AA@@@@@CB@B@@A@@A@@AA@@@@A@AA@@@A@@@@@AB@D@@@BAB@B@B@@A@@AA@@@@@@AA@@A@@@A@BA@@@@BAD@@@@ABABC@A@@@@D@@B@@@@@@BB@@@@B@@ABCB@@A@A@A@@A@@@DCB@@@B@@@B@@BD@@@BB@BBB@@B@@A@@F@@@BAB@@@@A@AB@@A@@BAB@BAA@ACB@@A@@BC@A@@@A@@@AA@@AA@BA@@@@ECAA@@B@@@B@@@@AB@@@A@@@@AB@A@@@@@A@AB@@A@@@B@@B@@A@@@@@A@@@@@@A@@@@@C@A@@AA@@A@@AC@@AA@@@@@AA@@@AA@@A@AA@@A@@@@@A@@D@B@@BB@B@B@B@@@@@B@BA@@B@B@@@B@@@@A@A@A@@@AAA@@@A@@A@@@@A@@@A@@@A@A@@BA@A@@B@BBB@@B@@B@@@BA@@@@B@@@B@B@B@@@@A@@@@BA@@@AB@@@@@BCB@@@@AB@B@@AB@@ABA@@D@BA@@@CA@A@@@BA@@@@@AA@@CA@@AAAA@@@@A@@A@@@A@@@@A@@BA@@BA@@@@AA@@A@@@@A@AB@@A@A@@@@AA@@@A@@@A@@AAB@@ABA@@@A@@B@@@@@BB@@B@BA@AB@B@@@BB@@BA@@B@BA@@@@B@@BB@B@B@B@BB@@B@B@B@B@@@B@@B@@@@B@@BB@@@@@B@B@B@@@@@A@AB@@@@@BB@@@@@@B@@@BABAB@@A@@B@@@@B@BB@@BB@@@@BAB@@A@@@ABA@@B@@@B@@A@@BA@@BABB@@@@BA@@@@@A@@@AD@@@BA@@B@B@B@@@@@BBBB@BB@@@B@BB@BB@BBB@@B@@B@@BB@@@@@B@@A@AB@@@B@@BBA@@@B@@B@@@@@A@@BB@@@@BBB@B@B@@@@BA@A@A@A@C@@AA@A@@@@BA@@AA@@@@B@@AA@@A@@@AA@AAA@@AAA@@A@@@BA@@@@@A@@@A@@@@@A@@@@@@@@A@@AAB@@@@AA@@@A@@BAB@@@BB@ABB@@@@@A@@@AA@@ACA@@BAB@B@@@B@@@B@@B@@B@@B@@B@@@BA@A@@B@B@@@@@@A@@B@@@B@@@B@@@@AB@@@B@@@@@@@@A@@@A@A@A@A@A@@@AB@A@@A@@A@@@A@@A@AB@@@BA@@@@@A@AA@@A@C@@AA@@BA@@@A@@AA@@@A@@@A@@AAB@@@@@@A@A@@@@@@@C@AA@@@@AAA@@B@@AB@AA@A@A@A@@@A@@@A@A@A@@AAAA@AA@@@@@ACAC@@@A@@A@@AA@@@A@@@@@AAA@@@A@AA@@A@@@@@@@A@A@A@@@A@CAAB@@A@C@@@CBA@@@@@AA@@AA@A@@A@@AB@@C@@@A@@@A@@@@@AAACC@@@@AA@@@A@C@AB@@A@ABA@@@A@A@A@A@A@@AC@@@A@A@ACAAA@@AAABA@AACAAAA@@@ACA@A@@A@AAA@AA@@AAA@@A@A@A@A@@@C@@AA@@@A@@@AB@AA@@@AAA@CE@@@AA@@@@@A@@@@AAA@C@A@@@@@ABABA@AB@@@B@@AB@B@@A@A@C@@AA@@@@B@BABBBABBB@B@@AB@@@AA@A@@A@AA@@B@@@BABAD@@@BC@@@CA@DA@@@A@@BAB@@AA@AA@A@A@@@AA@@@@B@@@A@@@@A@@@@@@@A@@@ABA@@A@AAA@ABCAA@@B@@AB@BA@@BA@AAAAAAA@@CAA@AA@@ABA@A@A@@@@@@AA@AA@@@@@@@AA@@@@A@@@@@@@A@A@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@A@@@@A@@@@@@@AA@@A@@@@@@AA@@@@@@@@@@A@@@@A@@@@@@@@@@@A@@@@@@@C@@@@@@@A@@@@@@@A@@A@@A@@A@@@A@@@A@@@@@@@@@@@A@@@@B@@@@@AA@@@@@@@@A@@@A@@@@@@AA@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@A@@@A@@@@BA@@@@A@@@@A@@@@@@A@@@@@@A@@A@@@@A@@A@@@@@@@@@@@@@@C@@@A@A@@@A@@@A@@@@@AAA@@@AA@@@A@@@A@@A@AA@AA@@@@B@B@B@@ABB@AB@@@B@@@@AA@@AA@@A@A@@A@@A@@BC@@@@BA@@B@B@@@BABC@A@@A@@A@@@A@@@A@@AA@@AAAA@A@@@A@@DCDAF@BA@@AAA@A@AA@@ACC@@AAA@@@A@A@AAA@A@@B@@ABA@A@A@@B@BA@@@@CCA@@A@A@@AA@@BA@@@@@AB@@A@A@@@A@A@@BA@@BABC@@@A@ABABCBAB@@A@A@@A@AAAA@AA@@AA@CAA@@@@A@@@A@ABC@C@AB@@A@@@AB@@ABA@AAA@@@A@A@@AAA@CAAA@@@A@@@AB@BAA@A@@A@@A@CAAAAAAA@@AEA@AC@A@A@@@A@@@C@@@AAAAA@A@AA@@@A@@A@@@C@@AA@@A@AA@@@A@@A@@@AB@@@B@@A@A@@AABC@@@ABA@A@C@@AA@A@A@A@@@@@@A@A@@@AAA@@@AECAAAAACA@AAA@A@A@E@C@A@@@A@A@@@AAAA@@@AC@@A@@@A@@@AA@@A@@@A@AAA@@@A@@AA@@AAC@A@@A@@@@BABA@@@@@C@@@@B@B@@AD@@@@A@@@A@@B@@A@@@A@@@@BAB@BAB@BA@@BADABA@@@A@A@@@AB@@@@A@A@AA@@@@A@CB@@A@@AA@@A@BA@AAA@AA@AC@AA@@@C@@@A@ACA@@@C@AACAA@A@AA@A@ABA@AB@@ADA@@A@AAA@@@@A@@@AAA@@@AB@@A@@BABA@@BA@ABC@@@@@AA@A@ABA@@@A@A@C@CAA@A@AAA@CA@AA@@A@@@@@A@A@A@AAABADCBA@ABA@@@@@@BAA@AC@@@AB@@@BA@@@A@@BA@@@ABA@A@A@A@@@A@@@@@AB@BAB@B@B@D@BB@@B@J@D@@@D@BAB@B@B@@@@@AAAA@@@AAA@@CC@A@A@@@A@@@@AAACAAA@AAA@AACCAA@@@C@AAA@A@AAAB@DCB@@C@A@A@AB@@A@A@C@ABA@AA@@A@@A@CAEAC@C@@@ACA@@A@@@@@@AC@@@A@CAA@C@A@@A@@@A@@@AA@@A@@@A@ABA@A@AA@@E@@AA@@@@@DCAA@A@A@A@@BA@AA@@@EEA@AA@@AA@A@ABABA@AAABA@@@@B@@@B@@AB@@CBA@@@AA@@AAA@C@@@BA@@B@@ABA@CBA@@@A@A@@A@@@A@AA@BAAA@AC@@A@@@A@C@@@AB@@A@@FG@A@@@@@ABABA@@@A@A@@@A@@BA@@@@@@AAA@A@CAA@AA@@A@@@C@A@A@@@A@AAA@CAC@@@AA@@GAA@CBC@AAA@@@@ABA@@AA@@@A@@@A@@A@A@@AA@@A@@A@@@@BA@@@A@@@A@A@AA@@@@A@@AC@AAA@@@@A@@@A@@@@AC@@AC@@@@AACAAC@@AAA@@@ABEBABA@C@C@AB@@A@ABC@@@A@@@@@AA@@A@@AA@AA@@A@@@A@@@C@A@A@A@AB@A@@A@A@A@@BA@@@A@AA@@A@@B@@ABABCA@BA@C@A@A@@B@@A@@D@B@BABABA@@@A@AAA@@A@CA@@ACAA@AACBCAA@@@@@EA@@@@@A@@@AA@A@@@C@@@AAAA@AA@@@@A@@AAA@A@CAAAEAAA@A@@@@C@A@@@A@@BA@@@@@A@A@@@@BA@@@A@@@@A@@A@@B@@@B@@@BAB@@@BAB@@AB@@@B@@@@@@@@@B@@AA@@@@@@BAA@@@@AA@@@@A@@@@@@BB@@@AB@@@AA@@@@A@@@A@@@A@@@@B@@@@CAA@@@A@@B@B@B@@@@@B@@B@@B@@@@AB@@@@A@@@A@@@A@@@A@@@@B@AA@@@@@AB@B@@@@AAAB@@@@@@CBC@@@AB@@BBA@C@@@C@@@@BA@@BA@@@@AA@@AB@@@@AB@@A@A@@AA@AA@@@A@AAA@@AA@@@@@AA@@A@@@@A@@AAA@@@@B@@@B@@@@@@@@BB@@@BCAA@@@C@@@A@@@A@@CA@ABA@@B@@@@A@@@A@A@@B@B@BBB@B@@@B@@@B@@A@CBA@@@A@@BA@@@A@@BBB@@@B@@AB@@BB@@@@@B@B@@@@BB@@ABA@@@AB@@@@AA@@A@AB@@@@AB@@A@A@A@ABA@@@A@A@ABA@@@@@BB@@@@@BBB@@A@A@A@A@@@@@AB@@A@@@AB@AA@@@ABA@@@@A@A@@@A@@BA@@AAB@@@@@D@@@B@@AA@@ABA@@CBA@@@AB@@CB@@@@ABA@@@AAAA@@@@A@@@@BA@@@@AA@A@@@A@@@A@@@C@@@A@@BA@@@@@@@A@@@@BA@@A@A@@EC@@@AAA@@BABA@@@@A@AB@AA@@@@BAAA@@A@@@MVA@@B@@AB@@@B@BAB@@@@@BAB@@@B@@@B@B@@@@AB@B@B@@@@AB@@@@@DA@@B@@@B@@@@AB@@@@@@@@A@BB@@@BA@@@@@@@BB@@A@@B@@BB@@@@@@@@@B@BA@AB@@@@@B@@@@@B@@AAA@AA@@A@@@@BA@@AA@A@@@AA@@@@@BAB@@@@A@ABCB@@@@B@@B@@@@@BBBB@BBB@B@@@BA@@@AB@@@@BAB@@A@@BAB@@@B@@@B@@@B@@AB@B@B@@@B@@AB@BBA@B@@@B@B@@A@@@@B@@B@B@BB@@BB@@@BD@BBB@@B@@@@D@B@@D@@BB@BB@@@B@@@B@@CBA@@BA@@B@B@@@@B@@@@B@AB@B@B@@@@@B@B@@B@D@B@BA@@@B@B@B@@ABA@AB@BB@@B@@AB@@@B@BBB@@@@B@@@@@B@B@@@@@@@D@D@@@B@@@@BA@@B@@AB@@@B@@B@@B@@B@B@BB@@@@@@BB@B@B@@B@@B@@A@@@@@@@@B@@@BBB@@BB@@@@@@BBB@@@B@@B@@B@@@BB@@@@B@@@@@BB@@@@B@@@A@@BA@AB@@ABAB@B@@@BABA@B@B@@@BAB@@BFA@@B@@BB@@@B@B@@@@B@@@@BB@B@@@@BBB@@@@@AB@@@B@@BB@@AB@@@@@@@BA@@B@@@B@@A@@@A@C@A@@@A@@@@BA@@BAB@B@@@B@B@@B@@BB@AB@B@B@BB@@@@@@@@B@@BB@BB@@BB@@@BB@BCDAB@@B@BB@@BBB@@@@@B@@@@@B@BB@@@@B@BB@B@@@BAD@D@BABBDBB@B@B@@@BBB@@@B@@@B@@@@@B@@@@@B@@@B@@A@@B@@A@A@A@AB@@A@@@@@A@A@@@A@@B@@@@A@@B@@AB@@BBA@@B@@@@@@A@@AA@@@A@@@@@AB@@@@A@A@@B@@A@@@@BA@BB@@@B@B@@@@B@@B@@B@@@BABB@B@@BBABB@@@@@BA@ABABA@@B@BBB@@D@@A@@@@B@@@B@BB@@B@@A@A@@BA@A@@@@@A@A@@@AB@@@B@B@@BB@@@@@B@@@@BB@@@@@B@@@B@B@@@B@@AB@@@@BD@@@@@@AB@@AAA@@AA@@BA@A@@AA@A@AB@@A@@@B@@B@BBB@@@BABB@A@@BBBAB@@BBA@@@@@@BA@@B@@B@@ABB@@A@@B@@BB@@@@@BB@@@@@@BA@@@AB@@BB@@CB@@@B@@@@A@@@@@A@AAC@A@AB@B@@A@A@@@AA@@@A@@B@@@@A@A@@@A@@A@C@@@A@A@@BA@@A@@ABAB@@ABA@A@@@A@@B@B@B@@B@@BB@@BB@@B@@@BA@@BB@BB@@@@@A@@BA@@B@@AB@@@BB@@@BB@@DB@@@@B@B@@@B@@BBBAB@@@@@@B@@@@@@BB@B@@@B@@ABB@@@@@@B@@@@@BABB@@@B@@@@@@@B@DB@@@@@BAB@@AB@@@@@@@@AB@@@@A@@@A@A@@@A@AB@BA@ABA@@B@@@B@@@@@DBB@@@@@@@@BB@@@@@B@@BB@@BBB@@B@@BB@BABAB@BA@AB@@@@@B@@@B@@B@@BB@@@B@@BB@BB@@@BB@@@BBB@@@@@@B@@@BA@@@AB@@@@@@@B@@A@@BA@BBA@@@BB@@DBB@@@B@B@@@B@@@@@BB@@@@@@@B@@@BB@@BA@AB@@@B@@B@@B@@D@B@@AB@B@@@@@B@@BB@@@@@B@@@@@B@@@@B@@B@@@BA@@@A@@B@@@@@B@@@BB@@BA@@@A@@@@@@B@@@B@@B@@BB@@B@@@@@@@B@B@@@@@@BB@@@BBB@BB@@@@B@@B@@@@@B@@@@@@BB@@B@@@BB@@@@BB@@B@@B@@@@B@B@BB@BB@@BA@@@@@@B@@@B@@ABAB@@@@B@B@@B@@@@@BB@@@@@@@B@B@@@@BB@@@@B@@B@@BBB@B@B@@@@A@@B@@@@@@@B@B@@@@@BD@@@@@@B@BB@@B@B@@BB@@@BB@@@@B@@@B@@@BA@@@@@@@B@@B@@@@@@BBB@BB@@BB@@@@@BA@@B@@@@B@@@@B@@@@@BB@AB@@@BB@@A@BBA@@@BB@@@B@B@B@B@@@B@@@@@@@@B@@B@@@@@@@@@B@@BB@@@BB@@A@@@BB@@@BA@@@B@@@@@B@@@BB@B@@@@B@B@@@B@@@@@BB@@B@@B@B@@@@B@@B@@B@@@@BB@@@@@BB@@AB@@@@B@BB@@@BB@@@@@B@@B@@@BB@@@BBB@@B@B@@AB@@@@BB@B@B@DB@@B@@B@@BB@@@BDB@@@@@@BB@@B@BB@BBB@@BBB@BB@@@B@@@B@@B@@@@@B@B@B@@@@B@@B@@B@BD@@@@@@@D@@@B@@@B@@@B@B@@AB@@@@@BA@@B@B@BBB@@@@@BB@@@@BBBA@@BAB@BAB@@A@@B@@@@@@B@@B@B@@B@@BB@@B@@@B@@B@@BB@B@@BB@B@@BBBBBB@@DB@@@@@@BBB@B@@AB@BA@@@BD@@@BABA@@@@BB@@@@B@@@@@@@B@B@B@@@@@BB@@@B@@@@B@@@@B@@B@@@@@B@BA@@B@@A@@@BB@B@@B@@@B@@@BBBBB@@@DD@@@BAB@BA@@@@B@@@B@B@BBB@B@@@@BAB@B@B@@B@@@@BBAB@@BD@B@B@@@B@@BB@BBB@@B@B@@B@@DB@@BB@B@@@BB@@BB@BB@BB@BB@B@@BDB@@@B@@B@@@BB@@BA@@@@BB@@B@D@@@B@@@@ABB@A@@B@@AB@B@@@@@@ABA@@BAB@B@BA@@B@B@@@@BB@@@B@@BB@@@B@BABAB@@@B@@BB@A@@B@@B@@@@B@@@@B@@@@@B@@@B@@@@@BAB@@@@@@@@@B@@@@@@BB@@@@@@B@@@B@@@B@@B@@B@@@@@@B@@@BAB@DA@AB@@@@AB@@A@A@@@@B@@A@@B@@@@AB@@@@@B@@@B@@@B@@BBB@@BA@BDA@@@C@@@ABA@@B@@@@AB@@@@@B@BA@@B@B@@DB@@@@AB@@@BB@@@B@@@@BAB@@@BBB@@A@A@@@@@@B@@@@A@@B@@A@@@A@@@@@A@@@@AA@@B@@@@@B@@@B@@C@@@A@AA@@CAA@@A@@ABA@@@A@AB@@@BB@@AB@@B@@@@@BB@@@@@@@@B@@@B@@BA@@@B@@@BB@@@@A@@B@@@@@@@@D@@@@AB@@B@@@@B@@@@B@@@@@@B@@@@B@@@@@@BA@B@@B@@@BA@@@A@@BB@@@@@B@@@@@BB@A@@@@@AB@@@BBB@@BBB@@@BA@AD@@AB@@@@BB@@@BBD@@@@@@@BB@@B@@AB@@@@BB@BBB@@@BABA@@B@BAB@@A@A@AA@@@BA@@BA@@BA@@@AB@BB@@B@@@B@@BB@@@D"],encodeOffsets:[[118065,26062]]}},{type:"Feature",id:"360727",properties:{name:"龙南县",cp:[114.789873,24.911069],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@B@@BB@BA@@@A@@BA@A@@B@@AB@B@BAB@BA@@@BB@@AB@@@@@@@BB@@D@@A@@@@@A@@@@@@B@@@@AB@@A@@B@@@BB@@B@B@@@B@@@@ABAB@@@BBB@BABA@A@ABA@ABA@@B@@@@B@@AB@B@@@B@@B@@@B@@B@@CB@@@@BB@@A@A@@@AB@@@B@@BABBB@B@@@A@@BB@@B@@@@@B@AB@@@@B@@BB@B@@@@BA@A@@B@@@@@@B@@B@BA@@@A@AB@@A@@DA@@@@B@@BBB@B@@BD@@@B@B@@BBBB@@@B@@@B@@DBDBBB@@@@@BBB@@@B@@@B@@ABAB@@BF@B@BBB@D@B@@@B@@B@DBB@@@B@BDBBBBDBBD@B@BBB@BBB@@B@@@@BB@@B@@B@BB@BDB@BD@B@BAB@@@BAB@@@BA@AB@BA@CBA@@BA@@@@B@BA@@BAB@@@B@BBADAB@B@@BB@B@BB@@@B@@@B@@@@BB@@B@@B@@@@B@BB@@@BB@BB@@@B@BAD@D@@@B@@@DAB@B@@ABBB@B@@@@@B@@@@B@BBB@@B@@BBD@@@B@@A@@@A@@BA@@BA@@@@B@@BB@BDABADAB@@@BA@@@@@@@BB@@BB@@B@B@B@@DBB@B@@@BB@@B@@@BB@@@B@@D@@BB@@@@B@B@@@BB@B@@@BB@@@@B@B@@BBB@@DBBB@BD@@@@D@B@@BB@@@B@@@D@BB@@@@BA@@BB@A@@D@@@@@@@B@@@BA@@B@@B@AB@B@B@@BD@@@BB@@BBDB@@@@@B@BB@@B@@BBBA@@DA@@@@B@@@BBB@@@B@@@B@B@@@B@@@@BB@@BAB@B@B@BA@@@@@@B@BBB@B@@BBBCB@@BB@@AB@BA@@BA@@BA@@@@@@B@@@@A@@@@BD@BBB@B@@BBB@@@B@B@B@BB@D@@@BBB@@@B@@B@@@B@@@B@@B@B@@@B@@@@BDB@@B@@@B@B@B@B@BA@@BBB@BA@AB@B@B@F@B@B@B@@@B@@BB@@@B@@@B@@@@A@@@@@A@@AA@A@@B@@AB@@ABA@AB@@@@@BB@@@@BB@B@@B@@BB@@BBB@@@@CB@BA@AB@BA@@@AB@@A@AB@@AB@BA@AB@@AB@@@@A@@B@F@@@B@@AB@@A@@@A@@D@@@B@@@BBD@BBBAB@BA@B@AB@B@@@@@BBBBB@B@@B@@@BB@@@BA@@BBB@@B@D@BBD@@@B@@@@A@A@A@AB@@AB@@@B@@BB@@@@B@@FBBB@@@B@@BBB@@@@@@B@BABA@@BAB@BBB@@ABA@A@@BAB@BAD@@ABEB@@@@AB@@A@A@ABABCAA@@B@B@@@BA@BD@B@@DB@@ABA@@@AA@@@B@@@BA@BDA@@@@B@@@BA@@B@@D@@@DA@@@B@@@B@@C@A@A@A@@@@@@BA@@@A@@@@@@BB@@B@B@@@@ABB@A@@BA@@@AB@@@@AB@@BB@@D@@@@B@@ABA@@@@BA@@@@B@@B@B@B@B@B@@@@@CB@@@B@@@B@@B@@@@B@@@B@@BB@@B@@ADA@ADA@@B@BBB@@@BA@@@@BB@DBB@B@B@@ABCB@@AD@@ABA@@@A@A@AAC@A@A@@BAB@BA@CDABABABA@ABABA@@@ABC@@@A@@@@@@B@B@@@B@@B@@B@@@B@@@@@B@@@@@B@@B@BB@@BDBBB@DB@@BA@@BBB@@DBB@BBB@B@BBBBDBB@@@@ABAB@@A@C@A@A@AB@@AB@@@@AB@@@@AD@@AB@B@B@@@@@BAACA@@AB@BA@@@A@@@ABABA@@B@B@B@@AB@@C@@@A@A@A@@@@@AB@@AB@@CBA@@AABA@A@A@@@@@@BA@A@AB@@@@@B@@@@@B@B@DB@@B@@B@@@@@@B@@D@@@BB@@@B@@B@B@@@BB@@DB@@@@B@@B@@@@FB@@B@@@BBBB@B@@B@@BB@ABB@@@BB@@@B@@@@B@@@AB@@@B@@@@@@B@@@B@@@@@@B@@@B@@@@@B@B@@@B@@@@@BBF@@@D@@@B@@@@@@B@@@BB@@B@@@B@B@@@B@@@BAB@@@B@@@@B@@B@BBB@@@B@@@@@B@@@@@BB@@@@BA@@BBB@@@B@@@@@@@@@@@BB@@@B@@B@@@B@BBB@@@@@@@BA@A@@BA@@@BB@@ABBBA@@@@B@@@BAB@@@@@@@@BB@@BB@@@@@@B@@BB@@@@BBB@@BD@@BB@@@@BBB@BBD@BBB@BB@@@@BB@@@BBD@@@@@@B@@@BB@@@B@@@B@B@B@BAD@@A@@BB@@@@@B@@B@BBBB@@@B@B@@@@B@@B@@ABAB@@CBA@AB@B@@AB@@@B@@B@B@@@B@@AB@@@B@@@BAB@DC@@B@@@BBB@B@B@@@@@@@@B@@@@BB@@B@BBD@@B@@B@@@BB@BB@BB@@@@@BD@@@B@@@B@BBB@@@DBD@B@@@@@@BB@@B@@@@@@@@@B@@B@@@@@BB@@B@@@@BB@@B@@@BBBAB@@@@@@DB@@B@@@@BB@BA@@DD@BBB@@@@CF@@ADAB@@A@@B@@@B@BAB@@@@@@@@@B@@@@AB@B@@@@@@BB@@@@BB@@@@B@@@B@@@@@BAB@B@B@@@BA@AB@BAB@B@B@BAB@@@@@B@B@@@@AB@@@@@B@B@@B@@B@@@@@B@@@B@BBB@BBB@B@BB@@@BB@B@@AB@@A@@D@@BBBB@@@B@@A@@B@@@@B@BBB@@ABB@@B@@A@@@@@AD@@AB@@@@BB@@B@@@@@BB@@B@@@@B@@B@B@@AB@@@B@B@B@B@B@B@B@B@@@B@@@@@B@@AB@BA@@B@FABAB@@A@AB@BA@@@BB@@@@@BA@AB@@@@@AAAA@A@CA@@@B@D@B@B@B@BBB@B@DA@@@A@CB@DA@@B@@@B@@@B@@B@@@@@@B@FB@@B@@B@@DB@BB@B@B@@@@@BBA@@BB@@B@@@@B@@@BB@B@@@@@B@@B@B@B@@BB@B@B@@@BB@@@B@@B@DB@B@@@BB@@@B@B@BBD@DB@@@B@@DBFBB@@@@B@@B@@@B@@@D@@@B@@@@@BBB@B@B@B@B@B@@@B@@A@BB@B@B@@B@@B@BBB@@@@BB@B@BA@@@@BAAE@@@@B@F@B@B@B@BB@@@@BB@@BDB@@@@@B@B@B@B@@@@@B@B@@@B@B@D@@@BA@@B@BA@@B@@AB@B@B@@@@A@@B@@@BBB@@B@@B@B@@BBB@@BB@B@B@@B@BB@@@B@@BB@BC@@BAB@@@@DB@@@B@@A@@@@B@@@@BBB@@@@@@BAB@@B@@@BABAB@@@B@BA@@BA@@DAB@@@@@@AB@@A@@@AB@@A@@@A@@D@B@B@B@B@B@B@BB@@B@@BB@BBBCB@B@DBBB@@B@D@@BB@DBB@B@BBB@@B@BB@BBB@B@B@@@B@B@@@BBBB@@@AB@@A@@B@B@B@@@@C@C@@@A@@@@B@@A@@B@B@@@BB@BB@B@@@BB@BB@B@@@@@BBB@BB@BB@B@B@BAB@@BBB@BB@@@@B@@B@@A@@B@@@@B@@@@B@@A@@B@B@@@@B@@B@AB@@@@@B@@@B@@B@@@@@@@@BB@@A@@B@@@@@B@@@@@D@DB@@BBBBB@B@@AB@@@@ABA@@B@@@B@@AB@@BD@@@@ABC@@@ABABA@@BA@@@ABABAB@@@D@B@B@BBB@B@@A@@AA@AAA@A@@B@@@@@BAB@@A@@B@@@B@AA@@A@@@@@@@@AA@@@@@A@@@A@@E@@@AAA@@AA@@@AA@@@@A@@@AAA@AAAAA@@AA@@A@C@AA@@AAAA@A@AA@@@@AB@BA@@DCBA@@B@B@B@BA@@B@@A@AB@@@@A@@BA@@A@@A@A@@AA@@@@AA@AA@@A@@A@B@@A@@@@B@B@BA@@@@B@@ABAAA@AA@@@AACA@@@@@A@@B@B@B@@@@ABA@@B@@@B@B@@@@@@@B@@@@BB@@A@@@@B@AA@AA@@ABA@@@A@@@AA@@A@A@@@A@@@A@A@@B@@@@@@AAA@@@@AA@@@A@A@@@@@A@@@@@A@@BA@@AAB@@A@@BA@@@@B@@@@@B@@@B@@@B@@@@@B@D@B@@@B@B@BBB@@@B@@@AA@@A@@A@@@@@@BA@@@@@AA@@AAA@@BA@@B@@A@A@@AAAA@@@A@@@A@A@AA@@A@@BAB@B@B@@@@A@@@A@@@@@E@@@@A@@@BAAABC@A@AA@@@@@C@C@@@A@@AA@@A@A@A@@B@@AB@@B@@BBB@@@AB@@@@B@@@BA@@B@@@D@@@@A@@@AB@D@@@@A@@@@A@@AB@@@AAA@AAA@@ACAAA@@@AAA@A@A@A@@@@@@@A@A@@BA@@A@@BA@A@A@@@A@A@A@@@@A@@B@@AA@AA@@ABAA@@A@AA@@AA@@@A@@@@@@BAB@@@@@CA@@@AB@AAB@@@BA@@@AD@B@@@BAB@@@B@B@@@@@@BB@@A@@@@@AB@@@@@B@@@@@@AAC@A@@@@BBB@B@B@@@B@@@AA@A@C@@AA@@C@C@@@AA@A@@A@AA@@AA@@AA@A@@DB@AB@@A@@B@@@@A@@B@@AB@@@@@BBB@@@B@B@@@B@B@@@@B@@@@BB@@BAB@B@D@@ABAB@@B@@@BB@BB@@@@@@@BB@@@@B@@AB@@@@@B@@ABAB@@@B@B@@@BB@B@B@@@@C@@B@B@@@B@@@AA@A@@@@AA@AA@BA@@@@@A@CBA@A@A@@A@@AA@@@A@@@A@@@ABC@@@@@AA@@@A@E@@@CB@@A@@AABA@@AAB@@@@@BA@@AC@@B@@@@@B@@BBABBBB@BB@@@D@B@B@@A@@@A@A@@AAA@@@@@@AB@@A@AAAAA@@A@@@@@AAA@@A@AA@@@AA@@@A@@@A@@@A@A@@B@@@@AB@@@@@@A@@B@B@@@@@BA@@@@@@B@@@@@B@B@@A@@BBB@@@B@@A@@BABB@AA@@@@ABA@@AA@@@A@@@@@A@@@A@@@@@A@@@@AA@@@@A@@@@@A@@@@@A@@@@A@@@@@@@@@@AB@@A@@B@@@AA@@@@@AA@@@@@@@@@@@@AA@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@A@@@A@A@@@@@@@A@@@@@@@@A@@@@A@@@@@@AA@@@@@@A@@@@@@@A@@A@@A@@AA@@@@@A@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@AA@@C@@@@@@BA@A@ABA@@@@@AA@@A@@@@@@@A@@@@@A@@@@@AA@@@@@A@@@@@@AA@@@@@@AA@@@@B@@@B@BA@@@@@@BA@AA@@@@@@ACA@@@@AA@@@AABAA@@A@@AA@AA@@AB@@@@@A@@@@A@@@A@@AA@@@A@AB@@A@@BA@@@A@@A@@ABAB@@@@A@@@A@@@@@A@@@@@@@AB@@@@@@@@A@@@@@ABA@A@AB@@A@A@@@A@@@@A@@AA@@@A@@@A@@@@BA@@@@@A@@@@@A@AA@@A@@BCB@@AB@@A@@A@@AA@@@@A@@@@BC@A@@@@@@B@BBB@BBB@@@B@@A@@AA@@@A@@@ABA@@@A@@@@A@CA@@@@@CB@B@B@B@B@@@@A@@@@CAA@@AAA@A@@@@@A@AB@@A@@@@A@A@@A@@@@@AAAB@@@B@DA@@BA@@@@AC@@@A@A@@BA@@AA@@AA@@A@@@A@@@A@@A@@A@A@A@@@A@@@@@CBAA@@@@@@ABA@@@@BA@@@A@A@@A@@A@@@@A@@BA@@@@@A@@@@AC@@B@@A@AA@BA@@BA@A@@BA@@@@@AA@@@A@A@A@AA@@@@A@@@@@A@@@@@AA@@@B@@@@A@@A@AABAA@@ABA@@A@@@A@@AAB@@A@@A@@@AB@@@A@A@@@@@@@AAA@@BA@@@@AAA@A@A@@@A@@@@A@@@A@@A@@A@@AB@@A@@@@@A@@@ABEAA@@@C@@@CAAAA@@@@AA@AAA@A@A@@@A@BA@A@@@A@A@@@@@A@A@@@@@@GC@@@@@A@@A@A@A@AA@@A@ABAA@BA@@@A@@@A@@BA@ADA@@B@B@@AB@@ABBB@@@BA@A@@B@@@BA@AB@BAB@@@@ABAB@@@@AAA@@A@@A@@@@@A@AA@A@@A@C@A@@AE@@@AAC@C@@@@AA@@@@A@@@A@@BA@@@C@A@@@AA@AA@AA@A@@@B@@@B@@ABABCB@@@@@@AA@@AAAA@@AA@@@AA@B@AA@A@@@CAA@AAAA@@@@A@@@AA@@@A@@BA@A@AA@@AAA@A@AAC@AA@@A@A@ABC@@@A@AAA@@@A@AA@@AA@@AAA@@@@@AAAAAAAAA@@@B@@AA@@@@@@BABA@@@@B@@@B@@@@@B@@@BA@@BAB@@@BA@@@@@A@@BA@@@@B@@A@@CE@@A@A@AB@@A@A@@B@@A@A@@B@@A@@AAC@@A@A@@@A@@@AA@@B@B@BAB@BA@A@@@@A@A@E@@@A@@BA@@B@@@@@@AAAA@@@C@@@A@A@A@@@AB@@@BC@AA@C@AA@@@@@A@AA@@@A@@@CBAB@@A@@A@@@A@@@A@@@AAA@@@A@@BA@A@@BAB@@@@@BA@@BCB@@@A@@AAA@@@@@CB@@A@@BAB@@AA@@@@@A@@C@AA@@@@@AA@AA@AA@A@ABA@@BA@A@@@A@@A@A@@@AAAA@@@A@@AA@@@A@@A@@@@@@A@@@A@@@A@A@@@@@@@A@@@@@CA@A@A@AAAC@@A@C@ED@DAD@@EDGFE@C@@@@A@AAECAC@E@CAC@EBEDEFEFEBCBAAA@CA@ACAE@E@E@C@E@CCAC@ABABIBC@CCEAC@ADEBC@@BABABA@A@A@AACAA@AAE@ABADCDA@E@CAAAAC@A@CEAEEOADCBABABC@C@AAAC@CBCEECEAICGCGCEELELC@CBG@G@C@@BEJITEPEJCJBBBD@BABEHEFA@CACCEAECIAICECGCCAA@EDEDABADC@@@AAECAAECIGIECGCEEECECIACAEACCCC@E@GBGDGBEBA@CAA@EEEAIBGDGBGBEHCJGJA@EFC@CCCEACGEGEGCECG@GACCACAC@CA@C@E@CBECECE@EACAACEICGAGCGGAGACC@@CCECGCGAE@CAAAGIIGCCCAAB@BABADADABADABCBC@C@C@ADABADA@A@AACCCCC@AB@@@BBD@FBHBJBDBFAHAFAHABWHCB@B@D@DBFAHA@AD@B@BBFBDBHBHB@@BB@@@BBBBBB@@B@@BBB@@B@@@AB@@@B@@@@BB@@BB@@BDBD@BFD@@B@@@D@@BB@@@BB@@@@@@@@@BB@@@@@B@B@@@BB@@B@@@DB@@@B@@AB@B@@@@@BA@@B@B@@@BA@@BB@@BB@@@BB@BBBBBB@B@@@B@BB@@@@@BAB@@B@AB@@BD@B@B@@@B@@@@BB@@B@@@BAA@B@@@"],encodeOffsets:[[117172,25197]]}},{type:"Feature",id:"360729",properties:{name:"全南县",cp:[114.530125,24.742403],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@B@@@@@@A@@@@B@@AB@@AB@@ABA@@AA@@DAAA@AA@A@AAA@@@@@@@ABA@A@A@AB@@AA@@@@@A@@@A@A@@@A@@AA@A@@@A@@B@@CB@AA@AA@@@AAA@@@@@A@AC@AA@@A@@AC@@@A@ABAA@@@@AB@@A@@@A@@@@@@@CB@A@@AB@@A@@A@@A@C@@@A@@AA@@@A@C@@C@@AAACA@@AA@AA@A@@@@@AA@@A@A@@A@@@A@A@@A@@AC@@@@A@@AA@@A@@@AA@@A@A@CA@@@A@A@AA@@AA@@A@@@@@@AB@@A@CBABCB@AAA@@@A@@B@@AB@@AB@@@B@@@@A@@AC@AA@@@AA@A@A@@A@@@@@A@A@AA@BA@A@CB@@A@@@C@C@ABA@@@A@@AAA@@A@@A@A@@A@@@@AA@@A@@A@@@A@@@A@@A@AAA@@@ABABCAAA@@@A@AB@@ABA@@@@@AB@@AB@DABA@@BAB@@A@AB@@A@ABA@C@@ACA@AAAA@@@@AA@@A@@A@@@AA@AAA@A@AACCAAAAAACA@@@A@CAA@@@@A@@@A@CAA@A@AAE@@BABA@@@A@@@A@@AA@A@@@@AACACA@@@A@@@A@@AAAA@@@A@A@@AC@@@AAA@AA@@@@@CB@@@BA@@B@B@@ABA@@@@A@@@@A@@@@BAB@@@@@AAA@@@A@@A@@B@A@@@@@AA@@AB@@@A@A@AAAB@@@A@@BA@@B@B@@@AA@@@@DA@@@AA@@@A@@@@A@@@ABA@@@A@@A@@@AB@BAB@B@BABABA@AA@@A@AB@B@@A@@@A@A@@@AA@@A@@@@BA@@B@@A@@@@@@@@B@@@@@BC@@@AA@@@@@@A@@BA@@A@@ABA@ABA@A@@BA@@@@BAB@@@B@@ABA@@AA@@@@@A@B@AB@@A@@@AA@@@@@A@@@A@AAC@@BAA@@@BA@A@@@@AAA@@@A@A@AAAA@AAA@@A@@AA@@AB@@A@@@A@AB@@A@@@@@ABA@@@A@@CA@@A@@@AA@@A@A@@@@@A@@A@@@@@@@@AA@@A@@AC@@@A@@@EC@AACAC@@AA@@AA@@@@@A@@BA@@A@@@AA@AA@@@AAAAAA@@A@@AA@BBA@A@@@C@C@GAE@CDAF@D@DABABCDCBC@C@ABABAH@@ABA@E@@@EA@@A@A@@B@BBFBD@B@@E@GCEEBEDABABC@AACCAA@AD@FCFEBE@EEGGGAEFABCAKEGAGA@@EBEFAB@FAH@FCFADCDADCBAD@D@BADADDD@B@@@JCHCBGBC@@F@B@B@DA@@DABABC@A@IAIBC@@BA@CDAFAFABIDKDI@ICKEIBIDOFKFAF@DGAK@G@EAGACECAC@E@GAANBFDF@BF@DCBCAEF@HDFDAB@@EDCBAB@@@J@@@DCBEDABCDAFADCBABCBAHBHJDPBTBLCFADE@@DBL@RHXJZBB@DABBD@D@FAF@D@DBDBBBH@J@B@BBBBBBB@BDBF@H@JDNFFHFJDH@JAF@HDFBDD@@FFFHFBD@B@HDHBBBBDDFBHFLBBAJAHGJEFBJBDHDJBBD@N@BEPBHBB@@DFFDFBF@B@DB@BABAF@FBDBFFFFF@DBBAD@BBBBBDDFBBDDDDDBHBFBBHBJBFBFJHDHDDHBPBB@NAJEJGHIHAB@DB@DDHDDDBJBH@D@@EFA@@BCD@BBDBBBD@DCDADADBBBBBBDBFBHBH@TBHB@@BD@B@BGFADBHDDR@T@RFHDDDBBBD@D@DAF@BAD@D@D@B@BB@B@B@D@D@BBDDF@BBPHD@DBDA@CBC@@D@D@DBDAB@NCLB@@B@FDBH@@BTDLFFHAHEH@HDHHFJBFBH@@@LAD@BBDJHBFBDFFBBJTJLNBP@P@JAB@DADADADC@CB@B@B@@BADCD@DDFDBDDB@D@@CBA@CBEBBDBD@DAJCJAR@L@HBDBPJJHDD@@BDHFANBFDDLDNDJ@HCJANDJFBAD@@@DBDB@BBBBDDB@@D@DBDBB@DBBBDBF@B@B@B@BDB@BH@DBB@D@@B@B@B@BA@@@A@@@@B@@ABA@@BAB@@A@A@ABAB@B@@@@A@@BA@@BABABABA@A@A@A@@B@B@@@@ABC@A@@@AB@@@BA@AB@@@BCB@@CB@@@B@@@BA@@@AB@@@B@@@@B@@@@A@@@@BD@B@@@@A@@BA@@B@BAD@HCB@B@@ABABCDEDEBA@@A@@AB@BA@@BAB@B@@@@@@@F@@@DA@@B@B@@@BA@@BC@ABCAA@AAA@A@@@A@@@@B@DBB@@@@@@@BC@@@@BA@BB@@@B@B@@@BB@@@@B@@A@A@@B@@@B@DA@BB@@@B@B@@A@@B@@@zQB@BAD@@AB@@A@A@A@@BABA@@DAB@@ABA@A@@@@B@@@BA@A@@BAB@BA@@@@@A@AA@@AA@@E@@B@BABAFA@A@@AC@@@A@A@AB@BA@ABA@ABA@@D@@@BB@@BAB@@@@CBAA@@@@@A@@@@A@@BA@A@A@@CC@A@@@@@AA@A@@@@AB@@A@A@@@AAA@@@@@AE@AA@@@A@@A@@@AAAC@A@A@@@@A@CB@@AB@@ABA@@@A@ABAB@@A@@@BA@A@@@@A@AA@@@@@A@@B@@@@A@@CA@@@@BA@AD@@AAA@@@A@@AAA@@@@A@AAA@@AA@AA@A@@@@AA@AA@@A@@@@B@@A@A@A@@BA@@@ABA@@@AB@@C@A@A@@@A@A@@@@@A@A@A@A@@@@@A@AC@@AA@@@@AAA@A@A@E@A@@@@@BFAB@@@@ABA@A@@A@@A@AAA@@@@AA@A@A@@A@BA@@@A@A@A@A@A@A@AA@@@@A@@@C@@@A@@@A@@@@A@@A@EACA@@@A@@CAC@AAA@A@@@A@@A@@@ACAA@@@@A@@AA@@A@A@A@@AA@A@A@@@@A@@@@@AAA@@A@@@@@@AA@@AB@AA@@@@A@A@A@@ACA@@@AA@@@EAA@@@@@@@@AA@@@A@@@A@@@CBA@@D@B@@CBA@A@AAA@A@A@C@A@@@B@@D@BBBBB@@@@A@@BAB@@@@A@@A@@ABA@@B@BA@ABEBA@@@ABA@@BA@@@@@A@@@A@A@A@A@A@A@A@A@@@A@@BA@A@@@@A@@A@@@AA@@@@A@@@AA@@@@BA@@BC@@@@B@@@@AA@BA@@AA@A@A@@A@@@@BA@@@A@AA@AC@@@@BA@@BA@A@@A@@AAA@A@AAA@AAA@@@A@@@@@A@@@@AA@A@@@@@A@@B@@A@A@@@@@A@ABA@A@A@ABA@@BAB@@A@A@A@AB@@@@A@@@A@@@@@AA@@@@AA@@@@@@@ABA@@@@@A@@@@@@@@BA@A@A@@@AB@@@BABC@@DE@@@@AA@ACC@@ABA@@A@@A@@@CA@@@@@@BAAA@A@@@AA@@A@@A@@@AA@@@@A@@@@A@@@@@@@@@AA@@A@@@@A@C@CA@@A@AAA@@@A@@@C@@A@@@@AAA@@AAA@@A@@@@AC@AAA@@@AA@@@@@A@@@@@@A@A@A@AA@@A@@@CDA@AB@@A@@@A@@BA@@@A@A@@@@A@@BA@@@ABAB@DA@@BABA@@@AA@@@@@@A@A@@AAAAA@@@@A@@@@AA@@@BC@ABA@A@A@@@A@@@A@@A@@@A@@@@C@AA@@A@@A@@A@@AAA@AAC@AAA@A@@A@@AC@@AA@AA@@@@AA@@@A@@@@A@@AA@@A@@@@@@A@AB@@A@@@@@ABAA@BA@@A@@AB@@@BAB@@@@@@A@AAA@@@A@@@@A@@AA@@@@@@@@@@A@@@A@AA@@AB@@@@AA@@@@A@@@@@A@@@A@AAA@@@@A@@A@@@A@AB@@A@@@A@A@@@A@@@AA@@A@@@@@@@@A@@@C@@AE@A@@@@@A@@@A@A@@@@@A@@@A@@@@A@@@A@@@@@@@@A@@BA@@A@@@@@@A@@AA@@A@BAA@@AA@@@@AAAAA@@A@@@EA@@@@@AA@@@@@CA@@AA@@A@A@@@@A@@AA@@C@@@@A@@@@A@@@@AA@@C@A@A@@@@@A@@@@BAB@B@@A@@@@B@B@B@BA@BB@DA@@BA@@BA@@@@B@B@B@@@D@@@BA@@@A@A@AB@BABA@@B@@@B@@ABA@@DBBB@A@@@@@A@ABA@@BC@@@@BA@@@@BA@@BAB@B@D@B@@@BABA@@@@AAACAA@A@AAA@AAA@CA@AA@@AB@@CAA@AAAC@@AAA@@@@A@@@@@A@@@@@A@@@AA@@@@A@@@A@A@@@@B@@@D@BA@@B@BABAB@BABABADCB@@ABA@AB@B@D@BBB@B@@@B@BA@@BC@@DABA@@@A@AAA@CAA@@@@AB@@A@AAA@@@CB@BCB@BA@@@AA@@@A@@@A@@A@@@@A@@@A@@DA@@@@A@A@A@A@A@@@@A@@B@@A@@B@BA@@@A@@C@@@AA@@BA@@@@BA@@B@@AB@A@BA@@@@@A@AA@@A@@@@B@@@B@@A@@@@B@B@B@D@@@@A@@@A@@CB@@C@@@@AB@@A@@@A@@B@ACB@@A@@@A@@BB@@B@BA@@CA@@@AACB@@A@@@A@AB@DBBABAB@B@@@BA@@@@FABA@@BC@ABA@AB@B@BA@@AA@ABA@AB@BA@A@A@@@@A@AA@@@A@@AAEA@@@A@@A@@AA@@@A@@BA@@B@B@B@B@@A@@@C@AAC@A@@@AA@AB@@A@@AA@@A@@@@AAAAA@A@@@@@ABAA@B@@ABAAA@AAC@A@@@A@@@CB@@@B@@@BA@@@A@@@E@AB@@@@@BA@@BAB@@ABA@@BAB@@@BA@@B@@ABAB@@ADA@@@@AA@AA@@AA@@@@AAA@@@@AA@@@@A@@BAB@BA@@BA@@@@BBB@@@B@@@@@B@@A@@@A@@@A@@AA@@@A@A@A@E@A@A@A@@BABA@AA@@ABA@A@A@A@@@A@@@CA@A@@A@@@A@A@@@@A@@@A@@@AA@@@A@AA@@C@A@@A@A@A@A@@AA@AA@A@AAC@"],encodeOffsets:[[117325,25312]]}},{type:"Feature",id:"360728",properties:{name:"定南县",cp:[115.027845,24.78441],childNum:1},geometry:{type:"Polygon",coordinates:["@@BCBE@CCEACEMCE@C@G@EACAACA@@@@C@C@@@@CACAGAGEACAECEACACAA@ABCBCDABAHAFAFCFCBADBDDJDHBD@DA@CBIDEDE@GAGCKE@@EGAEAAA@CB@@CB@@EBEDADEDCDGFADCB@@@D@@@@@B@@@@@@@B@B@@@B@@@B@@@@B@@@@B@@BB@@@B@@BBBB@@B@B@@@@B@@@BAB@@AB@B@BBBB@@BBB@@@@B@@B@DB@@@@@B@@BA@AB@@@BA@@D@@@@BB@BB@@@A@AD@@AB@@@@A@AB@@@BAB@@@B@@BB@B@@@B@@@B@@@BB@@@BADA@@B@@@B@@B@B@@@@BBD@B@@BAD@@A@@B@@@B@B@B@@@D@@BBBB@@@@@@@AB@@AB@@@F@B@B@@@@@@BABA@ABA@A@@@BB@@B@@@B@B@@@BD@BB@@@@AB@B@@@@AB@B@@@BAB@B@@@DF@@@BA@@@@@AB@@@B@@@@AB@@A@AB@@AB@@A@@@@@A@@@A@@@@@ABAB@@@@@@BB@@@A@@BBBBBBBB@B@@@@BB@BB@@BB@@B@B@@BB@B@B@@AD@B@B@BB@@BBD@B@BBB@BB@@B@BAB@@@B@@BB@@B@@@@@BBBBB@DB@@B@B@@B@ABB@@B@@BB@BB@BB@@B@@@@A@ADAB@BA@@@A@@@B@B@@BBBB@@B@@@B@D@@AB@@@B@@@B@@B@@B@@D@D@BB@@F@@BB@D@B@@@@BBBB@@@@@B@@@@BB@BB@@@@BABA@@@@BA@ABAB@@A@@@AB@B@@A@@AABA@@BA@@@A@AB@BCB@@AB@@@B@@@B@@ABBBAB@@@BBB@B@B@@@@B@@@@HD@@@@@@@B@B@@@@@B@B@@@BABB@@@B@B@B@BBB@@B@@B@BBDB@@D@@@B@FBBA@@B@@@@@B@@@BA@@@BB@@@@B@@@B@@B@@@B@B@B@BB@@@@AB@@BB@B@@@@@@@B@B@@BA@@B@@@@BA@BB@@@B@@@BB@BA@@BBBA@B@BB@@@@@@A@@BB@@@@B@@@@@B@@@@@BBB@B@B@@@B@@B@@@@AB@@@BAB@@ABB@@B@BA@@@BD@@@@@B@@@@AB@@@B@@B@@@@BB@B@@@B@@A@@B@BA@@@@@@BBDA@@@@B@@@B@B@B@@@@BB@@@B@@@B@@@BB@@BB@@AB@@@B@B@@BD@@@@AB@@CBA@@@A@BB@B@@@@@BB@B@@@@@@BA@@B@B@@@@@BBB@BB@DB@@@@@B@@A@A@A@A@A@@D@@@@DBB@@@@@@B@@AB@B@@@B@@BB@@@BA@@@A@AAA@AAA@@@@@@@@BAD@@@@@B@@B@@BB@@@@BA@@BA@AD@@@BB@@B@B@@@@@B@@@@AB@@@@@B@@@B@@BB@@@B@@B@@@B@B@@@BAB@B@BA@@@@B@@@@@@@@@BA@@@@@@B@@@@@B@@@B@@@@@BABA@@@BB@@@B@@AB@@@BAB@@@B@@BB@@@B@@@@@@B@@@@BA@@BBB@@BB@@@BBBA@B@@BB@@@@DB@B@@@@B@@BAB@@@@@@ABA@@@A@@@@@BB@@@@@@BB@@@@@@@B@@@@BB@@@@B@@@@@B@@@@@@@B@@@BB@@@@B@BAB@B@@A@@@@D@@@BB@@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@B@@@@BB@@@BB@@@@B@@@@@@@B@@@@B@@B@@@@B@@@@@@B@@@@@@B@@@@@@@B@B@@@B@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@BB@@@@@@@@@@@@BB@@@@B@@B@@@AB@@@BA@@@@@@@@@@@B@@B@@@@@B@@@@@B@@@@@BB@@@@@B@@@@@B@@@B@@@@@B@@BB@@AB@B@@B@@BAAAB@@@BA@@@A@AA@@@BA@A@@@@@A@@@@@@@AB@@@@A@A@@@@B@@@@A@@B@@A@@@@B@B@@@B@@@B@@BB@@B@@B@BB@BB@@@@B@@@BBBB@B@BA@@B@@@@B@BB@@@B@B@@@BA@A@C@@@A@@AAAAAAB@AA@@@@@A@@@BD@@AB@@@@A@BB@@ABBB@@@BA@@D@@@F@B@@BB@@@@D@BA@@B@@@B@@@B@@BB@@@@B@BAB@D@B@@@@ABB@@BBB@@@@@BBB@@A@@@A@A@@@@D@@A@A@A@@A@@@A@A@@BABA@@@A@@@@BA@@@A@@A@@A@@@@@@AAA@@A@@@AA@AB@BC@A@A@AB@@AA@@@@@A@@A@A@@@A@A@@@A@AA@@@@A@@BA@@@@B@@A@@@@BA@@BCA@@@BBB@@BB@@BBB@@@@BBB@@D@D@@@BB@@@D@BBB@@A@@@A@A@A@AA@@@@@BBD@B@@@@A@@@@@A@@B@@@@@BA@@A@@@@A@A@@@A@AB@@A@C@@B@@AB@@A@BBA@@B@@DB@@@@A@AB@@@@@@@B@@BB@@BBB@@@BBBA@@BBB@@BA@@@@B@@B@B@B@@@B@B@B@@AB@@@AB@@@B@B@@@@@@@B@B@BBB@B@@BBDB@BB@BBB@BB@@A@@BB@@@@@@B@@C@A@@B@@@B@@C@@@A@@@AB@@A@@@@@BA@@A@AA@@@AA@@BA@@@@B@B@BB@@BB@@@D@D@@@@@B@@B@BADBBAB@@B@@@@@@F@@@@@B@@@B@@A@A@A@AB@@@BB@@B@B@B@@@B@@BBBB@@@B@BA@@@AB@@BB@BB@@B@@@@AB@@@@@@@BB@@@BB@@A@@@A@AAA@A@@@A@C@A@@@@@A@@@A@@@A@@@@@A@@@@@AB@@@BA@BB@@AB@@@B@@@@@B@@@@@B@B@@BB@@@@BB@B@@@@A@@@@B@B@@@B@@@B@BB@@B@@@B@@AB@BB@@BBBA@@@@@@BA@@A@@A@@@@@@@A@A@@@A@@@AB@B@@A@A@A@@@@B@@@@DBBB@@B@@BBBAB@BA@@@@@ABA@A@@@@@@BA@B@@@@BB@@BBB@@@@BB@@@B@BB@@@AB@@@B@@A@@B@BA@@@ABA@A@A@@@ABCD@@ABA@@B@@B@@B@BBBBB@@BBD@B@@@BB@@BBBB@BBB@B@@@B@@B@@B@@BB@@BB@@B@B@B@B@@@BD@B@@B@BBB@DBBADB@@@B@@@@BB@@A@@@DB@@@A@AB@@A@@@@F@B@B@B@@@@@@@@@@@@BAB@@C@@B@@ABA@@B@@B@@BB@@@@@@B@@BA@@B@@A@@BB@@B@@@@@CB@@BB@@@@@@@B@@@@BA@@B@@A@@BA@A@@@A@@BBA@@@BB@@@BBB@@AB@B@@@@A@@@@@@B@@AB@@@BA@@@@@AB@BABABAB@B@@@B@@@BBB@BB@@@BAB@B@@@@F@B@B@B@@@B@BB@@BAB@B@B@@@@BBB@@B@@@@B@B@@@@B@BB@B@@BD@FB@BB@@B@@@@DB@@B@@@@@AA@A@@D@@@BA@A@@B@B@@@B@@A@AB@@@B@B@@@BB@@DB@B@@BD@BBB@@@BA@B@@BB@@@@B@B@@@BB@@BBBB@@@@@@A@@BCB@BA@@BAB@@@B@@@BB@B@@DBBAB@B@BBB@B@@B@@@@@B@B@BA@@B@B@B@B@@@BB@BBBBDBB@@@B@B@B@BB@@DB@@@B@@B@B@B@@@B@@@AA@AB@@A@@BA@@B@@A@A@@B@B@B@D@@@B@@@@@B@@B@@B@B@B@@@@BB@@BB@B@B@@@B@@@B@B@B@@@@A@@@A@A@@B@@@BA@@B@B@@A@@@@@@@@AA@@@@@A@A@@@AB@@@BA@@B@@@BA@A@@DB@@B@D@@@B@BBB@@@BA@@B@@BB@@@@AB@@@B@@@B@@A@A@A@@@@@A@A@@BABAB@BA@@@@BAB@B@@BB@B@@@BB@@@BB@@@@@@@@A@A@@@AB@BA@@B@@@BB@@B@B@B@@@BB@@B@@@B@BA@@B@@@BAB@@@BBB@B@BAB@@BDD@@B@@@BA@@B@@A@C@@BA@@BA@A@A@A@A@A@@@A@ABB@@@A@@B@B@@AB@@@@@B@@@@@B@@@@@BC@@@@B@@A@A@@@A@AB@@AB@@@B@@@@@BAB@@ABC@C@@BABA@@DC@A@@BAB@@AB@@A@@@@@@B@@A@@B@BB@@BA@@@@B@@@B@@B@D@@B@@B@B@D@@BB@@@B@@@@BA@@B@BB@@BA@@@B@@BD@@B@BB@@BA@@@A@@@A@@BA@A@A@A@@@@@A@@B@B@@@@AB@@BBBB@B@@@@@@A@@@ACA@@@@BAB@BB@@@B@@B@B@@B@@@A@@@@BA@@@A@AAABAA@@A@@@@@@B@@@B@B@B@D@B@@AB@FA@@@@B@@@BB@@@@BA@A@AB@B@@AB@@@@AB@@@B@B@B@@@B@B@B@D@B@@@B@@@BA@@BAB@@@BC@@@@@@@A@A@@B@@@BA@@B@@ABA@@@AA@@@A@AA@@@@@@AA@@AA@A@@@A@@@@@@B@@@@AA@@@@A@AAA@@@@@@AAA@A@@@@AA@@@@A@@BA@@@AB@@A@@A@@@C@C@@@@@@@A@A@@@@@A@@@@@AA@A@A@@BA@@@AA@@ABAB@BA@@@A@A@A@@ABA@C@A@@@@A@A@@@@@A@ABAA@@@@@@A@@A@A@@@AB@@AB@DA@@@A@@@A@@AAA@@@CA@C@@@@@@AA@AAC@@A@@AA@@AAA@A@@@@A@@B@@@@A@A@@@AAB@ABA@@@A@@@A@ABA@@@A@@@A@@@A@@BA@AB@@@BA@A@@A@@B@@AB@@A@A@AAA@AA@A@@@@@AA@@@@@DABAB@@@@@BA@A@@@@BB@@B@B@@BB@@A@@B@@@BBB@BB@@@A@@@@@A@@@@BAB@@A@A@@@@@@@@AA@@@AB@@@AA@@@@@@B@@A@@AAB@@@@@@@@@BA@@@@@A@@@AB@@C@@@@BA@@@@@A@ABA@@@@@A@A@@@A@@BA@A@@@@BA@A@A@@BA@@@AB@NU@@@@BB@BB@A@@B@@BBAB@@@@@ABAB@@BB@B@@FD@@@B@BB@@A@@B@@@@@@@B@@AB@@@D@@@B@@@B@@@B@B@@B@@B@@A@@B@@@@@BBBB@@B@BA@@@@DA@@BA@@B@DA@@AB@BB@@BA@@@C@@@@@A@BB@@AB@@@B@@@B@B@@B@BA@@B@@BBA@@B@@@BA@@@@B@B@B@B@@@AA@A@@@@AA@@@@B@BAB@B@@@B@BAB@B@B@@@BA@@@@BAB@@@BB@@@@BA@@B@BA@@AA@@@@@A@A@@@@AA@@BA@@@A@@AA@AB@@@B@@AB@@@B@DAB@@@@A@@@A@@@AAA@A@A@AB@B@@@B@@@@@@AB@BAB@@DB@@@B@@@D@@@B@DB@A@@AA@@@@@@@@@A@@@A@@B@BB@@@B@@B@@@BB@@@@B@@BB@BBB@@@B@@BBB@@@B@BA@@B@@A@@BB@@B@@B@@AB@@A@@D@@@D@B@AA@@BA@@D@DA@@@@@@BABB@@@@@ABA@@@@B@@B@A@@B@@@B@@@B@@@B@@@@@BA@@@@@AA@@@@A@@@@@A@A@AB@@@B@DB@@@@@A@@B@@@B@@@B@@@@@BB@@A@@B@@AA@@@@@@@B@@B@@B@@B@AB@@@@@@BB@@@A@@@@@@@@@A@@BA@@BA@A@@BA@A@@@A@@@AB@@@@B@@B@@@B@@A@@B@B@@@@@B@@AB@@@B@D@AA@AB@BAAA@@@AA@@AA@@@BAB@@A@@@@@@@ABA@ABA@@@A@@BAB@@@@@B@BB@@@@@@B@@B@@@@@@BA@@@@B@B@@@BBB@@ABB@@@A@A@@@A@@@AB@@@@@@@@@AA@AA@AA@@@@@@@@@AA@@C@@@@BBB@@A@@BAAA@A@A@A@@@@A@@AA@@@@A@@@A@AA@BA@@AAAC@@AA@A@@@ABA@A@BB@B@DA@@BBB@@@@B@BBBBBBB@@@@@@B@@@BA@@@@BB@@@@BBD@@@BB@@BBB@BB@@@BB@@@BB@@@@@@@BBA@@@B@@@BB@@@@BB@BA@@B@BB@@@A@A@@B@BABA@@B@@A@@@A@@B@BCA@@AAA@@@ABA@ABA@@@@@@BBBBBB@@BBB@@@BAB@F@B@@@B@@A@@BA@ABBBBB@@BB@B@@@@@@@@@@A@A@@@@@@A@@AAA@@@AA@AA@@@A@@@A@@B@B@@@B@@@@@@@@@BA@@@@@AA@@A@@@@@@@@@A@@AA@@@@@A@AB@@@@EA@@@B@@@AA@@@@BA@@@@A@@A@@AAAAA@AAA@AB@@@@@AC@ABA@A@A@A@AAA@@@@AAA@AA@@@A@A@@@B@@@@C@@@@AAAA@AAA@@@A@A@@@AA@A@@@A@@AA@BAAA@A@@@@A@@@A@@@A@@@@@@@AB@AA@@@A@@@@@A@@@CA@@@@CC@@A@B@@A@@AAAA@@BA@A@@@AB@@A@@@A@@@A@@@@@@BA@@B@B@B@@A@@@A@@@@B@@B@@B@BA@@@@B@B@B@@B@BB@B@BAB@BB@@B@@@BC@AB@@A@@B@@@@BBAB@@@@@AA@@@@BA@ABA@@@@@@A@CGAEEIEGAC@AAIBG@GBGAECCC@EBEFEFKHEDCDG@EBEA@@GAG@EBEDCDCDCDCB@@A@G@CAA@E@E@AACAC@C@I@GAICCCCAAC@CAG@AAAACCGCCACCAC@CBEBA@GBGCIEEECAADGDIA@@GEGECAC@@@CAGCMEQCG@C@A@@A@GDG@ADID@FABA@CBAA@E@GDE@UBI@CCEA@@@@A@@@A@@@AAA@A@@@@A@@@@EAGBG@AACEA@AAACCAAAA@@@@@AB@@A@@@A@A@@@@@AAAA@@@@@@A@A@A@@@A@@@A@@BA@@B@@@@A@@A@@@@BC@@@A@@BA@@@A@@@@@@A@@@A@@@@@A@AA@@@A@@@A@@@A@@@A@@@@@A@@@@B@@@BB@@BB@@@@BBB@@@@@@A@A@@@A@@AAA@@A@@AACCEACBAFCB@BGBC@CEC@AA@B@BAB@D@@@B@@AB@@E@G@@@@@A@@D@@@BB@@BBD@@@B@@@@@@@@@@A@@A@@A@A@@AA@@A@@@@A@@@@@@@@B@B@@@@ABABAB@BAD@@AB@@@BA@@BB@@BBD@@@@@BA@ABCBAD@@@@@B@@@B@B@@@BA@@@@@AA@@@@C@A@A@@@@@AA@@AAA@@@@@@@AD@@@B@DB@AB@@@BC@E@E@AAA@A@C@@AA@A@ABA@A@AF@@@BA@@BBBA@@@A@AC@@@E@@@EGEEAACBCDEACEGMGKEEGAECCC@CB@B@@ABEBC@EAG@CBAD@DABABA@CAGEICG@CBAAE@@@E@GCECECEAE@AACGCEEEIEICKACDA@AF@H@H@BBDC@IBUBKEA@EECK@E@E@C@ABEEEEE@A@ADCBCAAACCACCAGAEBGBA"],encodeOffsets:[[117658,25206]]}},{type:"Feature",id:"360731",properties:{name:"于都县",cp:[115.415508,25.952068],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AAA@@@@AA@@@@A@@AA@AC@@C@AAAA@@AA@@A@A@AB@@A@@B@B@@@@ABCB@@C@@@@@@C@@AA@A@@A@ABA@ABABCBA@@BAB@@A@A@@@@CBABA@@@A@@A@@AEBC@A@@@A@@AC@@@A@A@@BA@@@A@A@A@@A@AB@@@A@@@A@@A@@AA@@ABC@@@AB@@@@@@@A@@@@@AA@@@@@@AB@@A@@A@@C@@@@@@AA@@@A@@@A@@@@@@@@@@AAB@@@@@@A@@@@@A@B@@A@@@A@@@@AAAB@@@@AAAB@@A@@ABBBAB@@@@@@A@@@@A@ABA@A@@@A@@AA@@BA@ADA@C@@@@@AA@A@@ABA@@A@@AAABAB@@A@AAA@@@AAAA@@A@@@@B@B@@@@ABA@@B@@@@@@AB@@A@@@CB@@@B@@A@@B@BA@@@AA@@A@@@A@@@@@@B@@@B@@A@A@@@A@@BA@@@@AAB@@@@BBB@@B@@CB@@@@A@@@A@@A@@AAA@A@@@@A@A@@@@AAAAA@AA@@@A@@@@@A@@@@@@BA@A@@BAB@BA@A@@A@@@AAA@A@@@@@A@@B@@@B@@A@AA@@BBA@@@@@A@A@A@A@@AC@@@A@AB@@A@A@AA@@@@@@@@A@@A@@@@A@@AA@@@AB@BA@@@@@A@@@AB@@ABA@A@@@AB@D@@A@@@@@A@@@@@@AA@@A@@A@@B@BA@@@A@A@@@ABADB@@@@B@@@@AB@@AB@BCDAF@@AB@@AB@@@B@D@@@@@BB@@@@@HD@@@@B@AB@@AB@@ABADA@@@@BABAB@DAB@@@@AAA@A@AAAA@@A@A@@AA@@@AA@@ABA@C@@@A@@@@@@@A@@@A@@@AAA@@BA@A@A@@@A@A@@@@BA@AB@B@@A@@@AB@B@@@B@B@@A@@@AB@@@@@BB@B@@B@BB@@B@@B@@@@B@@@@@B@@@B@@BA@AB@@@B@B@@@@@F@B@@@B@@@B@@@BBB@B@BBB@@B@BBB@@B@A@BB@@@B@@ABA@@@@BA@ABA@AB@@B@BBB@B@@@ABC@A@@@AB@@@B@@@@@@AB@@A@ABA@@BAB@BA@A@AB@@@B@@@BA@AAAA@@A@@@@AAA@@@A@@A@A@@@CA@AA@A@@A@@ABA@@B@@A@AB@B@@AB@B@B@@A@@AA@A@A@A@AA@@@@@AAA@@CC@@@BA@@@A@CB@@AAAA@@ABC@@DA@@@AAAB@@@@CBA@@@@@@B@@@@A@A@AB@@@@@B@BA@@BA@A@A@CAA@A@@BA@@@A@AA@B@@@@@@A@A@A@@@@@AAA@A@@@AB@@@@AAA@@@A@AA@@@AA@@ABA@@@AB@BC@@@@A@@A@A@A@AA@@A@AA@C@A@A@@A@@@@AA@A@@BA@@@@A@AA@@A@A@@@A@@@A@@@A@A@@@AAA@@@A@@CA@@@CAA@@A@@AB@B@@@@CA@@CAC@@A@AA@@@@AA@A@A@@@@@@B@@@@A@AAA@@@AA@@AAA@@@@AA@@@A@BAA@@@@AAABAA@B@@@@A@@@A@@@A@AA@@AA@@C@@@AB@@AA@AA@AAA@@A@@A@@@@@A@@@@C@A@@@A@@@ABAB@@A@@@@D@@A@@B@@@BBB@@A@@B@@AB@@@@AB@@@@@B@@A@@@A@A@@@@@@@@B@@A@@B@@@A@@@@@A@A@A@@@@AA@@@@A@A@A@@@A@@B@@AB@@A@@DA@@BA@B@BB@BB@@B@@AB@B@@AB@B@@BB@@@BFB@@@@@B@@@@A@@BA@@AA@@@A@A@@@@@@@A@@AA@@A@@CC@B@BBB@@A@@B@@BB@@@@A@@B@@B@@@ABA@AB@@@BBB@@@@B@@@@@BB@B@@@@B@@@BB@@B@BB@BAB@D@BA@@B@B@@A@@@AB@@A@@B@@A@@@@BB@@B@@@@DB@BB@@@@@BB@B@@@@C@@@A@@@@@BB@D@@@@ABAB@@A@@@A@@B@@@@C@@A@@C@@@@B@@A@@@AB@@@BA@@@BB@@A@@BA@CBAB@A@@@AA@A@C@@@@AA@@A@@@@AA@@ABA@CB@@@@@@@B@@BB@@B@D@BB@@@@B@@B@@@BB@@@B@@@BB@BB@@@DB@@B@@@@B@@A@@@@@A@A@@@C@@@ABA@A@CBA@A@A@A@@BABABAB@B@@@B@DBB@BB@@@B@@@BBA@@B@@@B@@@@@B@@@BBB@@@B@B@@B@@@@@BB@BB@@BB@BB@BBBB@@@DBB@@@@BB@@@@BAB@@@B@@@@ABB@@@B@BB@@@@BB@B@@AD@@@@@B@@@@A@@@@B@@ABB@@BB@@@@@BB@B@@B@@@BD@@B@BB@@@@BBD@@@BB@@D@@@B@@@BBB@B@B@@B@@B@B@@@@@@@B@B@@@B@@@@B@BBBB@BBB@@@@BBB@B@@B@BA@@B@BA@@@@@ABA@@B@@@B@B@B@B@@@@BB@@D@@@BBBA@@BABA@ABAB@B@@A@ABAB@@AB@@@@@@@B@DA@BB@@B@AB@BA@@B@@A@@@A@A@@@AA@@A@@@A@A@@@A@@@A@A@@@@@@@@@A@AB@@BBA@BB@B@@@B@B@@@@@B@@D@@BA@@B@B@@@BB@@@@@B@B@B@DABA@@@@B@B@@@@@DB@@BA@AB@B@BA@AB@@@BB@@AB@B@@@B@@@B@@@B@@BB@BB@@@@B@@@@@B@@@B@B@@AD@@@B@BA@A@@AA@@@@BAB@@A@@@A@B@A@@@@@@@@@@@@@@@A@@@@@BB@@@B@@@AB@@@@@@BB@@@@@@@BD@@BB@@@B@@@B@@A@@@A@@@@@AA@@ABA@@@AB@@@@A@@BA@CAA@AB@@AB@@AB@B@B@@@BBB@@A@@BAB@BA@@B@@@B@B@@@BAB@B@@@BB@@BAD@@@BAB@B@@@@@B@@@@BB@@@BB@@@@BBB@@@@B@@B@@A@@BAB@@@@BD@@AB@BAD@@@BAB@@@B@B@BAB@@@B@@@D@@BB@@AB@B@BB@ABBB@@@BB@B@B@B@@AB@@@B@B@@B@@B@@@@@@@@B@@B@@B@@@@@@@B@@@@AB@@A@@@@BA@@B@B@@@@@B@@A@@@@@BBA@@@@BB@@B@@A@@B@@@B@@@BA@A@A@@@@AABA@AAC@@@@@A@@BAB@@@@B@@B@@@B@B@@@@CBABA@@@@B@DAB@B@@AB@@ABABCB@@@B@BABBBAB@@@B@@@B@BAB@@@BA@@@@AA@A@@@A@A@A@A@@@@@CBA@A@C@A@AB@@@@ABAB@D@B@@@@A@A@@B@@BD@@AB@@@BB@BD@BAB@@@B@B@B@@@B@B@B@BB@AB@@@@@B@BABB@@@@@B@@@B@B@@@BB@@@@AB@BB@@@@BA@@@DBBBB@@@@@B@@@@B@@@BA@@B@@ABA@@@@@ABABA@A@@@@@@@AA@@@A@@AA@@@AA@CA@@@@AA@@@@AA@@A@@@A@C@@@@B@@AB@@A@A@@@ABA@@@ABE@A@A@A@A@@@@@@BAB@@@@@B@B@@AD@@@D@@@B@BA@AB@B@@@BB@@B@B@@A@@B@@@BAB@@A@@B@@@B@@AB@@@BB@ABB@@B@BC@@@B@BBB@@@B@@@B@@BB@@@@@@@@B@B@B@@@B@@@BDB@@@B@B@@@@@@@B@BB@@@B@@@@BB@AD@B@@@@@B@B@@@BABABA@AB@@@@BBB@@BB@@@BB@@@@AB@@@B@B@B@B@B@B@@ABAB@@AB@B@@@B@@@B@BBB@@AB@@@B@@BB@@@@AB@@@B@B@@BB@@@@@@BBB@@B@@B@@B@@B@AB@@@BA@@@@@@B@B@@@BAB@@BB@@B@BB@@@B@B@B@@DBB@BD@BB@BB@@BA@@BB@@BB@BAB@B@B@B@B@@@B@@@@AB@B@@@@@@BB@B@@BB@B@B@@AB@@BBB@@@@@BB@@@@DB@@@@BB@B@@@@B@@BB@@@@B@BBB@@BD@@@B@BB@D@BA@@@@BADAB@@A@@@@BAB@@@@AB@B@BB@AB@@@D@@@B@B@B@@@BABA@@@@@AB@@@B@@AB@DAB@@@@A@@B@B@BA@@B@@ADA@@@A@CBAB@@@@@@A@@DA@AB@@@@@B@@@B@B@B@@A@@B@@A@@BA@A@@BB@@@B@D@@@@@B@@BB@B@@BBB@B@B@@@BB@@@@D@@B@@B@@BB@@@@B@B@@BB@@@@@@B@@A@@BC@@B@BA@@B@@@@@@ABAB@@@DC@BB@@@@BB@@BB@@@@B@@B@@B@@@@DB@BB@DBB@@@@@@@@B@BB@BBBA@@@@B@@@@@@@B@@@@@BA@@BBBB@@@@B@BA@BB@BABBB@B@@@BAB@@A@@D@B@@@@@B@@@BB@@BB@@BB@@BB@AB@@@DB@B@@BBBB@@@B@@@@AB@@@BA@@B@@@@@B@@@@@@B@@BB@D@@@@B@@BD@BBBB@@@BA@A@@@@A@@A@@@@@@@@@@@@@A@@B@B@B@B@B@BB@@B@BB@@BB@B@BBB@BD@@BBD@@@@B@BB@@@BBB@@@D@BBB@@@B@@@@@@AB@B@B@BAB@@AB@@A@A@CAAB@@@BA@AB@@AB@@ADAAABA@@@@@AA@@CB@@@@@BB@@@@B@@@@@BBB@@@@@BAAAB@@@@BBA@@@@@ABA@@AA@@@AA@@ABA@@@@BABA@A@A@A@@B@@ABA@@@@BBB@@B@@BB@B@@@BB@@@@BB@@@BB@@B@@@@@BABB@B@@BB@@@BB@@@@B@@BB@@@B@@@BB@@@@D@@@@@B@@@@@BBB@@@B@@@@@@B@B@@B@@@@@@AB@B@@ABB@AB@B@@AB@@BB@BB@@B@B@B@@@@@B@@@@@BB@BB@@@@@B@@B@@@BB@@@@B@@@B@@A@@B@@@@@B@@@B@B@@@BB@@B@@BB@@@@DB@@@@@@@ABA@BB@@B@@A@B@@@@@BB@@@A@@BA@@@@@A@@@@@BB@B@@@BABA@ABA@@B@BA@@@BB@@@B@B@DA@@@@@@DD@@BBB@ABB@@BB@@BD@B@B@@@B@BAB@@@@@@@AB@@@@@B@@BB@@@@BBB@BBB@@@@@B@BA@@@AB@CA@@D@@@B@@@BAB@@@@@BB@@F@@@B@B@@@@BA@@B@B@@B@@@AB@@A@@@@@B@@@B@ABA@@B@@@BA@@B@@@B@@BB@@@B@@AA@@@@A@@@@D@D@@B@@B@@@@@@@B@@ABA@@B@B@B@@@B@B@@@B@@@BABAB@@@BBB@@@BA@@BA@@@ABA@@BB@BB@@@@@@@@@@B@BA@@@@@BA@AB@@A@BB@BBB@@@@@B@BB@@A@@@@@@@B@B@B@@BA@@@@@ABA@@@@B@B@@@B@@@B@@B@@B@B@@@B@@B@@B@BB@B@BB@@B@@B@BB@@@B@@@BB@@B@D@@@B@BCB@B@BB@@BB@@@B@@@@@@@BB@B@@D@@@B@@@B@@@BB@@@BB@@@@BB@@@@@@A@@B@@@@A@@A@@@@@A@@@B@B@BABB@B@@B@B@@A@@@@@@@@@@@@BB@@@@@C@@@BB@@A@@BB@@@@@A@@@@@@@@@@AA@@DA@@BA@@@B@@B@@A@@@@@@BBB@@@@A@@A@@@@@B@@ABABA@@@@@@@B@@@@@@@@@@@@BB@A@BB@@@@@@@@@@B@B@@@@B@B@@@@BB@@@B@@A@@B@ABB@@@@@BAB@B@B@@B@@@@@B@BA@B@AB@@B@@@@AB@@@@@@B@@@A@@@@@B@@B@@@@@@A@@@@@B@@@B@B@@@@@@B@AAB@@A@A@@BAB@@@@@@A@B@@B@@@A@@BB@@@BA@@A@@A@@@@@@@@@@@@B@@@@@@B@@@@@@@A@A@@B@@AB@B@@@@@B@@@@B@@@BB@@@B@@BB@@@@@@@@A@@A@@@@@@AA@@@A@BA@@@@@@@B@@@@B@@A@@@B@@@@@B@@B@@A@@@@@@@@@@@B@@@@B@AA@C@@@@@@@AB@@@@BBB@@@@@A@A@@@@B@@A@@@@B@B@@@@BB@@@@@@@@A@@@@@@@@B@@@@@@@AB@@@B@@@@B@@@@@@ABA@@@@@B@@@@B@@@A@@@@@@B@@@B@@@BB@@@@A@@B@@@@A@@@@@@B@@@@A@@@@A@@@@@B@B@@@AA@@A@@@@AB@@B@@@@@@@@@A@@@@@@@@D@@@@@@@@A@@@@@@@@@BBB@@@BB@BA@@@AB@@BB@@@BB@@BA@@@@ABBB@B@@@@B@@BBA@@B@DBBA@@B@@@B@@AB@@@@@BBB@@@BBDBB@B@@B@@@@BA@@BB@@DB@BBBB@BDB@@@@BB@B@@@BBB@B@BA@@BA@@@@BAB@B@B@@A@@@@B@@@D@D@B@BB@@DABAB@B@BBB@@@@B@BB@@B@@@@@@@@B@BD@A@B@B@@@@B@B@BB@@B@@@@@@B@B@B@@A@@B@@@B@B@B@@@@@BAB@@@B@B@@C@AC@@@@AB@@AB@@ABA@@BA@@B@@@B@B@@BBBD@@@DBBA@@@A@ABA@@B@A@@CBAB@BA@@@@@AB@@AB@@@B@B@@BB@@@@BBDB@BAB@@@@@@AA@B@@@BBBAB@@@B@BBB@@BB@@AB@@A@@BA@@AAB@@@A@@@A@A@@@@@@@A@@@@@@A@@B@@BA@BA@@@@B@@@@@@@@@B@@@@@@A@@@@B@@@B@@A@A@@@A@A@@@ABA@@@@AA@@@@B@@A@A@@@@@B@@@BB@@@@@@AB@@@@@BA@@@AB@@A@AB@B@B@@@BA@A@@@@B@@@BAB@B@B@B@@@B@BBBBB@@BB@@B@@@@B@@B@@@B@B@@@B@@@B@B@BBBB@@@@@B@@BB@@BD@@BB@@B@D@@@B@@BBBB@@@@BBB@@B@@@B@@@B@BA@@@@@@@@@B@B@@B@B@@BB@@BABB@AB@BA@@@AB@@A@@BD@B@B@@@@@@@C@A@@@@@BB@@@BA@A@A@@B@@@B@@@@B@B@D@@@@@@B@DA@@B@@@@BBB@@@@A@@@A@@@A@@B@@A@@@A@@B@@@@CB@@@@AA@@@B@@@@A@B@@B@AA@@B@@@@@@@@@@B@@B@@@@@B@@ABA@@@@@@@B@B@@@@B@@A@A@@@@@@B@@@@@B@@@BB@@B@@B@@B@BDB@@B@BB@@BB@@@B@@B@B@@@@@BB@@B@@A@@B@@B@@B@@A@@BB@B@@B@@@BB@BB@@@@BB@@B@@@@B@@@B@@@@@BB@@@ABB@@B@@@D@BBBAB@@@@@B@B@B@@BB@@@@@B@@BB@@@@A@@B@@@@ABAB@@AB@@@@BB@@@@BB@B@@AB@@A@@BA@@B@B@@@@@B@@@B@@A@@@A@@@@@AB@@B@@@@@@@A@@B@@@A@B@@BB@B@B@@@@A@@BB@@B@@A@@@@@@@DBBB@@B@@@BAB@@@@@@B@@@B@@@@BBB@@@B@@@@@B@B@@A@@B@@BB@@B@@@@BB@A@@@@BB@@BB@@@@@@B@@@@AB@@BAB@B@@@@B@@@B@@@@BB@@@B@@@B@@@@@B@@AB@B@@@BAB@B@@@B@@@@A@@D@@@BBBBDBBABA@@BAB@@@B@B@@@D@@@@BBB@@@B@@@@BAB@@A@@B@@@BB@@@BB@@BB@@@BB@@@@@B@@@B@@BB@@@BBB@B@@BB@B@@B@@BBB@@@@B@@BBB@@@BB@@B@@@@ABA@@B@@@B@BB@@@B@@@D@B@B@B@DA@@B@@@B@B@@@@BB@@@@@A@@@A@@@@BB@@@@@@@CB@@@@A@AA@@@BA@@@@@A@@AA@@@@A@@A@@B@@@@@@@AEB@@@@@B@@@B@@B@@B@@BB@BB@@B@@BB@BB@BBB@BD@B@@@BB@@@@@@@@@@B@@B@@BA@@@B@@@@@@@B@@@BB@@B@@@@@B@@@@BB@B@@@@BB@@@@BB@@@@@B@B@@@BAB@B@B@@@@BB@B@B@@BB@@@@@@B@@B@@@BB@@B@B@@B@AB@@@B@@@B@@AAA@A@@@@B@@@@B@@@BBB@BB@@ABBB@B@B@@@B@@@D@B@@@@@@@B@@B@B@@@@BB@BBAB@@ADA@@@@BD@@@@@@BA@AB@BC@BB@@B@@@@@B@@@@@@@@@@B@@@@@@@@@@@B@@AA@@@@@@@BABA@@BB@@@@B@@@@@B@@@@B@@BB@B@B@B@@B@B@@@B@@A@@@B@B@@@ABB@@@@A@BD@@@@@@B@@BB@@@BA@B@@B@B@B@@@B@@@@@@@B@B@@@@@AA@@@A@@@BB@BBB@@@@@AA@A@@@@B@@@BBB@@@@A@BB@@ABB@@@@B@@@B@@B@@D@@@B@BBB@B@B@BA@@B@D@B@BB@BB@@@B@@B@@BB@@@B@@@@B@@@B@@@@@@@B@@B@A@B@@B@@B@@@B@@@BB@@BB@@@@B@@B@BBBB@@@@@BB@@B@@@@B@@B@@@@D@@@BAB@@@@BBBBB@@@BBB@@B@@@@@@@D@@B@@B@@B@B@@B@@B@@B@@@BB@@B@@@B@FBBB@@@@BB@@B@@@B@BBB@@@B@@@@@B@@ABA@@@A@A@CBA@@@A@AB@DC@@@@DB@@@@BA@A@AB@@A@@@@@@@B@B@@@B@@@B@@@@@@BA@@@ABB@@@@@@DA@@@@@AB@BA@ABB@CBA@A@A@@B@@A@@A@@@AC@C@@@A@A@@@A@@@AA@BAB@@AAA@A@AAAB@@@BA@A@A@@B@@A@@CC@A@A@A@@DCBA@@@@@@BABABAB@@A@A@@BA@AB@@ABC@@B@@@B@BB@@@@BB@@B@BAB@B@@@@D@@@@@D@@BBB@@@@BBB@@B@BA@@@@BBBA@@BA@ABA@A@@@AB@@@@@B@@B@B@@@@B@@@@B@@@@BB@@@A@@BD@B@@B@B@B@@@@B@@@BB@@@B@@@D@DA@AB@@@@@D@@@BA@@B@@@BA@@@@AAA@@A@@BAB@B@B@@@@A@A@@@A@@B@@@@B@@B@@@BABAB@@@@@BB@@BB@@@@B@@@BD@@B@@@B@B@BAB@BABA@C@@@A@@B@BAA@@A@E@@@ADA@AB@FC@@@@BBBBB@@BBAB@@@B@@AB@@@B@@@B@@@BBD@@@B@@@@BB@@@@AD@AC@@@A@@@A@AB@@@@@B@@A@@@@BBB@@BD@@@BA@@@@A@@@@@A@@@@@@@@@@@@@A@@@@@B@@A@@@@@@@@@@AA@@BA@@@@B@B@BA@@@@A@@@B@@@@@@@B@@@@@B@@@B@@@BB@@@@@AA@@A@@B@@AB@B@@@@@@@A@AA@@@A@@BA@@@A@@@@@@AB@@@@@@@@@A@@@@@@@@@C@@AA@@@A@@A@@@@@A@BA@@@@BA@@@@@AA@@@@@@@BA@@@@@@@A@@AA@A@@A@@@A@@@A@@B@@A@@@A@@AAAAA@A@C@@@AB@@@@@@A@A@@AA@@BA@@@@@A@@AA@AA@@@@A@@AA@@AAA@@@A@@@AA@@AA@@@A@@BA@@@@BA@@BA@@BBA@@@@B@@@@BA@@@@@@@@B@AB@@@@BA@@@BBAA@@@@A@C@AA@@CA@@AAB@@AAA@@A@A@@@A@A@@@BAA@@AB@@A@CBA@@@A@@@@BA@@B@@A@@@@@@@C@@@@@@@D@@@@@@@@A@@A@A@@@@@@A@@BB@@B@@@@@@@@AA@AA@@AA@AA@@A@@A@@AC@@@@@@@B@@@@@AA@AB@@@A@@@@@@A@@@@@@AAEAA@@AAB@@@B@@@@A@@A@A@@A@@@@@AB@@CB@B@BA@CB@A@@AB@@@@C@A@@@@A@A@@AA@AA@@@A@@@AAACAA@AAA@AB@@A@@AA@A@@A@B@@A@AA@@A@@AAA@@ACA@AA@@BE@@@@@@A@@@A@@@@A@@@AA@@AB@@AA@A@AAA@@A@@@AAA@@A@@@@AAAA@@@@A@@A@@A@@A@@@A@@A@@@AA@@AC@A@@@A@@@@BA@@@@AA@@BA@@@@A@@AAA@A@@@A@ABC@A@@BAB@F@DAB@BADA@A@@@@CC@AACAAAA@@A@@@AA@@@@A@@@@AA@@A@@A@B@@A@@@@@@A@@@AA@@A@@B@@@@@B@@@@@B@@A@@@@@A@@@A@@@@@A@A@@@@@A@@@@@@@@A@@@@A@@@@A@@A@A@@AA@@@A@C@AA@@@@AA@@@A@@B@BA@A@@@BA@@A@A@@B@BC@@@@@@@A@@@@@@@@AB@@AABAAA@@@B@@@B@@AA@AA@@@@@@@@A@A@@@@@@A@@@@BA@@@CAA@@@BA@@@@B@@A@@A@@@AA@@@CA@@@A@@A@@A@@A@@BA@@AC@@@@BAB@@@@A@@BABAB@@AD@@@@@AA@@@@BA@@@@@@A@@@@@A@@@@A@@@@@A@@@@A@@@A@@@@@@A@@@AB@@@@AD@B@B@@A@@@@@@AA@@CBAAA@@@AB@@A@@@@@BA@@B@@A@@A@A@@@@@@@@@C@A@@@A@@A@A@AAA@@@AA@@@@@A@ABAA@@AA@@AC@@A@ABA@@@@@A@@@AA@@@AA@@@CA@@@A@A@@@@B@@@B@@@@@@@B@@@@@@A@A@@AAAAAA@A@@@A@A@@@@BA@@BB@@BA@@@BBAB@@@B@@@DABA@@B@@@@@BCB@B@@AB@@@@@A@@@@@B@@A@@@@@@@BB@@BB@@A@B@@B@@BB@@@BA@@B@BAB@@@@AB@@@BAAA@@@@@A@@@@@AAA@@AA@A@A@@@AB@@AB@AA@@B@BB@@@@@A@@@@@B@@B@@@AABA@@@@@@B@@@@@@A@@B@@@@A@@@A@@@@B@D@@@B@B@@@BA@@BABA@@B@@@B@B@@AD@D@D@@@BB@@@@BB@@B@@BBBB@@@@@BABA@A@@A@@@@A@@AAAC@@@@@@B@@@@A@@A@@A@@BAB@@@@@@A@A@@AC@A@A@@@@BA@@@@@ABACC@@@@A@@@A@A@@@A@@@@B@AAA@@@@A@@CA@@ACC@A@C@A@@B@@@@ABA@AB@@@B@B@D@B@@@@C@@@A@ABA@@@@@@B@B@@@FD@BB@@@BBBB@@@@@B@@BAB@DA@A@@@@@@@B@@B@@@@B@@B@AAB@B@B@BA@@@A@@@AB@@@@AB@@A@C@ABE@ABA@A@@B@@@DC@@BAB@@@B@@BBB@@@@BAB@BA@@B@@A@@DA@@B@@BDA@@B@@@B@@AB@B@@A@A@A@@B@@AB@BDB@@@B@B@BA@@@C@AB@@@BAB@@@B@@ABA@@BA@@@ADA@A@@@@@A@@@@@A@@@@AAB@B@@BB@@ABADC@@@A@@AA@@@A@A@@AA@@@A@@@@@@AB@@A@@ABA@@@@@A@@AB@@AAA@@@@AB@@A@@@@A@A@@@@@@@BA@A@@@AA@@ABA@CB@@A@ABA@@@C@@@ADA@@B@@@@AAA@A@A@@@A@AB@BADC@@AA@AB@@ABA@@@A@@@A@ABAAA@@@@A@ABA@@@A@@AA@@@CB@@@AA@@@A@@@@B@@A@@@@@@@@@@A@@AB@A@@@@@@@@@@@AB@A@AAA@@A@@B@@@BA@@AAA@@B@@@@AA@@AAAA@@A@A@A@@BA@@@A@A@A@A@@@A@@@@@@@C@@@A@AB@AAB@@A@A@@AA@@B@@AB@@@@C@@@ABAAA@@@@@@@@@@AB@@A@A@@@@A@@B@A@@@@@@A@@B@BAB@@@@@@@@@C@@A@@@AA@@AA@@@@A@@@@@@@C@@@@A@@@@@A@BB@@A@@AABB@AB@A@@A@@@BA@A@@@A@@AA@@A@@CAA@@@@@A@A@@B@@@@@B@@@@@AA@@@ABA@@BAB@@@B@@@@AB@B@BAB@@A@@B@@@@@@A@A@@B@@A@A@@@@@@A@@@A@CA@AB@B@@A@@@@B@@@@AB@BA@@@A@@B@BBB@@@B@B@B@B@@@B@@A@AAA@A@C@@@C@@@A@A@@@AA@@A@A@AB@BA@@BA@@D@@@B@@ABA@A@@@@B@@@@@BB@@@@BB@@@@@@B@B@@@BB@@@B@BB@BB@@@B@BB@@@B@B@@B@BB@@@B@@@B@@@BB@BBB@BB@@@@B@@@B@@@B@@B@B@BB@@B@@DD@@B@BA@@@@@@@B@@B@@@@B@@@@@B@@@@@B@@@@@@A@@B@@@B@@@B@B@@@@A@A@@@BB@@@@@BBB@@@@C@A@@@@BB@@@A@@@@@@@BB@@@@BA@@@@B@@BAB@@@@@B@@@@A@@A@@@B@@@@@@A@@B@@@@@@@@@@@@@@AB@@@BB@@@@B@B@@@B@@@BBB@@@@AB@@BB@BB@BDB@@@@D@B@B@B@BB@AB@@B@@@@@@B@@BD@BB@@BA@A@@B@@@B@@A@@A@@A@@B@@@@AB@@@@AA@A@@@B@@@BBB@@A@AB@A@@A@@@A@@@AC@@AA@A@AAA@@AA@@@AA@@@@@AB@@AA@A@AA@A@A@A@@@AA@@@A@@@@A@@@CB@@AB@@@@A@@AA@A@A@A@@@A@@@@@AA@@AAA@@@A@@@@A@A@@A@A@@@A@@@@@BA@@@@@A@@@@@BAA@@@@@AB@@B@A@@AA@@@@@@@A@@@@AB@@A@@@BA@AA@@@A@@@@@B@@@@A@@A@@BA@B@@BA@@@AB@@A@@BA@A@A@@AA@@@AA@@@@B@@@@AA@@@BA@@@@BB@@BA@@@@@@@A@@@@@A@@@AB@BA@@@@@A@@@A@AA@@@@@@BAB@@@@@B@@@B@@A@@@@AAB@@A@B@@@BA@@@A@@B@@A@@@@@@A@@@@@@@@@A@@@AA@@AA@@@@BB@@BB@@@A@@@@B@@@B@@B@AAA@@B@@@@@@A@@BA@@@@@A@@AA@AA@@@@ACB@@@@@C@A@A@@@A@A@@@@AD@BA@@@A@@@@@@AB@@@BA@@AA@@C@@@@@@@BA@@@@B@BB@@@@CCA@C@@@@@AAA@@@@AAA@@CA@@@A@ABA@@@ADA@@@@@@AA@@@@B@@@@@B@@@@@@@A@AA@@@@@B@@A@@@@A@@A@@@@@@@@A@@@BB@B@@@@AA@@A@@@@@@@@@@B@@@@@@@@AAA@@@A@@@@B@@@@@@B@B@@@@B@@@AB@@@B@@@@@@@BB@@@@A@@@@@@BA@@@B@@@@@@@B@@@@@@@@@B@@B@@@@A@A@@D@A@B@@@@@@@@@@@@A@@@@AA@@A@@@@A@A@@BA@@B@@A@@B@@@DA@ADABA@@AA@@@@BA@A@@@@B@@@BA@@@@A@@@@A@@@@@@@AA@@@BA@@A@@A@@@A@@@AA@@@A@@@@A@@AAAA@@@@@B@BA@@@AA@AAB@@@@@@@@@@@@AAAA@@@C@@@@@@BB@@@@@@B@@@BA@@A@@A@@@@A@@@BA@@@C@AA@@A@A@@CA@@@A@@@@A@@@@A@@@@@A@@@@@@@A@@@@@@A@A@@BA@@@@@BA@AA@AA@AA@@@ADA@A@@BA@A@A@A@@@A@@@@@@@@C@AB@@@@A@@@A@A@@BA@@BA@A@@@CB@@@B@@@DA@@BA@@BBB@@@@@@@@A@@@@B@@B@@A@@B@@B@BABC@@@A@@BA@B@@@@@B@@B@B@B@@CBA@@B@A@@AB@@@B@@@@@@B@BB@@@@A@@@@@@@@@ABA@@@@B@@@@A@@B@@@@AA@@AB@@@@@@A@@D@@@@@BB@@BB@@@@@@BA@@@@BA@@@B@@BBB@@@@@@@BBBA@@@@@AA@@B@@AA@@@A@@@AB@@AA@@@@@@B@@A@@@@A@C@@@@A@A@@@@@AA@@@@@@BA@@A@@@BA@@B@@@D@BCB@@@AA@@@@A@@@A@AA@B@@AB@A@@A@@A@@A@@AB@BA@@BABAB@@@@@@@AA@@@AAA@@@AB@@ABAB@DADABA@@B@@A@@B@@A@@@@A@@@A@@A@@@@A@A@A@@@@@@@A@@@@@@AABA@A@@@AB@@CAAA@A@@A@@@A@AB@@@@@@@@A@@AA@@AA@@@AACA@AA@@BA@@@@@AA@@B@@@AAABA@@@@AAA@@@AA@@@AA@A@AA@A@A@@@ADA@A@CB@@@A@@@@B@@AA@@@A@A@@@A@AA@@B@B@@@@A@@@A@@@A@@@A@@AB@@@AAB@@@@A@@@@B@@@@A@@BB@@@CB@@A@@@@ACAA@@@A@@BAAA@@@@@@A@@@AAA@A@@@@A@CA@AA@@@AAA@AAC@@@@@A@@A@@CA@A@@@A@A@@AA@A@@B@D@@@BBB@B@@@@BBA@@B@@@B@@ABA@@BBB@B@@BB@B@@@@@@@@@B@@BB@@@D@@@@B@@B@@@BB@@AB@B@@@BBD@@B@@@@ABC@CBA@@@AB@B@@@BCBA@@B@@A@A@@B@@AB@BA@A@@@@@A@C@A@@A@@@@@B@@@B@B@B@@@@A@@@@@A@@@@B@B@B@@@@@@@@@AA@@A@@@BA@@@A@A@@@@AA@@@@@@@AA@@@@@@ABA@@AAA@@@AA@A@@BA@@@@@@@A@@A@A@ABA@@@A@@A@A@@@A@@@@A@AA@@A@@@@@D@@A@@@A@@A@@A@@@@@A@A@A@@@@BA@A@@@A@A@@@@B@@@@@BB@@@AB@@@@@B@BA@@@@@A@@@@A@@@ABAB@@A@@A@AA@@A@@@ABA@@@A@A@@@A@A@@@@FB@@B@@@B@BA@A@@BA@@@@@A@@A@BA@@@BD@B@@@B@B@@A@@AA@A@@AA@@@@CA@@@@@AB@BABA@@@ABA@AAC@A@@@AA@@@@@@ABAD@@A@@@A@AB@@ABA@@@A@@@@@AB@A@@@@A@@AA@A@@@A@@BA@@BA@@A@@@@@@@@A@@AA@@@@A@AA@@@@A@@@A@AB@@A@@@@@@@A@A@A@@@AA@@@@@@AA@B@A@@A@C@CAA@AA@@AAA@@@@@@@AB@@CB@@@@AA@A@@A@@@@BA@AB@@@@AACA@@A@A@@@@@@@AB@@@AAAA@@@@@A@@@A@@@@@@@A@@@A@AB@@CBC@@@AA@@A@AB@@@@@B@@@B@@@@@BAAA@@B@@@@ADBB@@AB@@A@@@@@@@AB@@@BB@@@@@AB@@@@AB@@A@@BA@AB@@@@@@@@B@@BA@@@BB@B@B@@@@A@@@BB@@@@@@AA@@@B@@@@@@A@@@@@@@BAAA@@@AA@@@@@@@@B@B@@@B@B@B@@@BAB@BA@@@ABAB@@AA@BA@@@@B@@AB@@@@BB@@@@@B@B@@A@B@@BBB@@@D@@@D@@B@B@BB@@@BB@@@B@BB@B@@ABCB@@C@@@A@@@@@A@@@@A@@A@A@A@@A@@A@@AA@AB@@@B@@@B@BBD@BBB@BA@@B@@@B@BB@@@B@@@@@B@BBB@@B@@@B@@@D@B@@@B@@EB@@@BB@BB@@BBBB@@@BAB@@BB@@@B@@AB@B@@AB@@@B@@@B@B@B@@@@@B@@AB@@A@@BA@AA@@A@@BA@ABC@@@@@ABA@AD@@C@A@@BCA@@@@@@CAA@@@A@A@@@A@A@@@@@A@AAA@@@@@@A@A@AAAA@@A@@AB@@@A@@@@A@@@@BA@@@@A@AA@@EA@@@A@A@A@@@A@AAA@A@@@A@@@AB@@AD@@CBA@@@A@@A@A@@AA@@@@A@@@@@A@A@@@AA@@@@@@@AA@@@@@@CBADC@A@@@ABA@@AC@@AA@@@BA@@@@@A@@@AD@@@@@@D@@B@@@@C@ABA@@@A@@@@B@@A@@@A@ACA@AA@@A@AB@@@@A@@@CC@AA@@A@ACC@A@@@@@@A@@AA@A@A@AA@@A@A@@@AA@A@@AAA@A@C@@@ABA@A@@@A@A@A@@@AAA@@@DA@@@A@@AA@AB@D@@@@@AABAA@@@@@@@@@AA@@AA@@@@D@@@@@@@CA@@BA@@B@B@@@B@AAA@@@@A@@@@@A@@BB@@BAB@@B@@B@@@@@@AA@AA@@@@B@D@B@@@BB@@B@@A@A@@A@@@@@@A@@@@@A@@A@A@A@C@C@@@@A@@BBB@B@@@@A@@B@@@@@@@@@@AB@@B@A@@@@AA@@@@@@B@@@@@@AA@@AA@@AA@A@@@A@@@@@@@@ADA@AB@@@@@BC@AB@@ABA@ABA@ABAAA@AAA@A@AAA@@@A@@@A@@@AA@@A@@AA@@BA@@AC@@@AA@@A@@@A@@@@A@A@@AAAAC@@@A@@@@AA@@A@@@AA@C@@AA@A@@@A@@@A@@@ABABABABC@@B@@A@@@A@A@@BA@@BAB@@BB@D@B@D@B@@@@@BA@A@@BA@@BC@A@A@AB@@A@@BA@@BB@A@@@@@AB@DB@@B@D@@@BA@@BBB@@BB@@A@@@ABB@@B@B@B@B@@@@@@AAA@@@@B@@@@AB@AA@@@ABA@@@@@A@@@A@A@A@A@A@@AA@@BC@A@@DCB@@A@@AA@A@A@@@@AA@A@A@AA@@ABCBCAAA@@@@@A@@@@@@A@@@AAA@ABA@@@BB@@@@A@A@@B@@@B@@A@@@A@@A@@AE@@@AA@@@ADA@AB@B@@@BAB@@@@@BA@@@@CAA@A@CBAAA@@@@@BCB@@AAA@C@@BA@@B@@@@B@@AB@@@B@@@A@@BB@B@@B@BB@@@@@A@@@@BA@@@@AA@@B@@@@B@@BAA@@@@@@A@@@A@@@@@A@@@A@A@A@ABABA@ABA@@BAAA@AAA@@AA@@A@CB@@C@A@C@A@A@A@A@AA@@@@A@@ABC@ABA@A@@@AB@@@@A@@B@@@BA@@@A@@BA@AB@B@@B@@@@@BBAB@B@@@B@@@DA@ABA@ABABA@@@A@@@C@@A@@AAAA@@@A@@BA@@AAA@@@@A@@@@@AAA@@A@CA@@AAAAB@AAB@@@AA@AB@@A@@B@@A@@A@EBC@A@@@AA@@A@@@@BAB@@A@@BA@AB@@ABBB@@@BAF@@@@@BA@@@AB@@C@@BABABA@AAA@CA@@@AA@CA@AC@@@A@A@A@@@A@@@ABABA@AAC@A@C@A@A@A@ABA@@CA@@@A@@A@@@A@@@B@@A@@@A@AAA@A@@@@@A@@AA@@AA@@@@@B@@@@AB@@A@A@A@A@@@ACA@@@@@AAA@@@A@@@A@A@A@@@@B@BBB@@@B@@A@@BA@@A@@AACAAAA@CC@@@AA@@A@@@@A@A@@@ABAB@@@B@B@@A@ABAB@BA@@B@@@B@@@BCBAB@@@@@BB@@B@@@BABA@A@CAAAA@@@A@@@AB@@A@@@@BADAB@@@AAAA@@A@@@AA@@@AAAA@@@BA@@@@@@@@A@A@@@AB@@A@@@@@A@@@BA@@@@@@@@AA@@@@BA@AAA@@@CBA@@B@@@B@@@B@@C@A@A@C@EB@@EFABA@A@ABAA@@A@@@AA@@CAA@"],encodeOffsets:[[118278,26227]]}},{type:"Feature",id:"360730",properties:{name:"宁都县",cp:[116.009472,26.470116],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@@@BA@@@@DBB@@@B@@@@@B@B@@@@@DB@@@@B@@@B@BAB@@BBBB@@@BB@@@BB@@@@@BB@@@@B@@@BA@@@@B@B@BA@@B@@B@@BAB@@A@@B@@BB@@BB@@B@@@@@B@B@@@B@B@@@B@B@B@@BB@BBD@@BB@@@@B@B@@@B@@BB@@@@ABA@@B@@@B@@BB@@BB@@BBB@@@B@BBB@BB@@B@@A@@@@@@@B@@@B@D@@B@@@@ABA@@B@F@B@@ABBBA@@@BB@@@BB@@B@DDD@@@B@@@BBBB@@B@BB@AB@@@@AB@@AB@@AB@@@@AD@B@@B@@@B@@DB@@@B@@B@@BB@@BDBBB@BB@@BBB@@BB@@@BB@@BB@BAD@B@@@B@BABA@B@@D@B@@@@AB@D@@@B@BAB@B@B@@@B@BBB@B@BB@@B@B@@@BA@@B@@@B@BA@@@A@@@@BA@BB@@@@@D@@@@@B@@@B@@@BA@@DA@@BA@@A@AAA@@A@@B@DAB@@@@AB@@@B@B@@@B@@@@@BA@@@BB@@A@A@@@AB@@BBBBB@@A@@BBB@@@@B@B@@@@BB@@@@B@@@BB@@@BA@ABA@@@ABABA@A@@@A@A@CA@@A@@@@@C@A@@@A@AA@AC@@BABABADA@A@@@A@@BBB@@@@ABAA@@AA@AA@@@@@@@A@@@@AAB@@A@@@A@@@A@@AA@@BCA@AA@@@@BCB@BA@@BA@@DB@@@@@@@@BA@@@A@A@A@ABA@@@@BA@AD@BBB@@@B@@@B@@@B@B@@AD@@@@@@A@@BA@@@@BA@@@A@@@@@@@A@@AA@A@A@@AA@@AA@AAA@@@A@A@@@A@@@@@A@@@AAC@@BAAA@@@@@AA@@A@@@A@ABA@@B@B@@AB@B@B@@@@A@@@ABA@@@AAA@@@A@@@@@A@@BAB@B@BA@@@A@@@@@A@A@A@@@AB@@@@A@AB@@A@A@@@AB@BA@BB@@@B@@@B@@A@@@ABA@@@ABABABAB@BB@@BB@BB@BBB@@@B@@DBD@@BBBBB@BBB@@B@@B@@@B@BB@@BB@@@BB@@@@B@@D@@@@A@@BA@@@@B@@BB@@B@@B@@A@@@@BB@@@@@@BA@@A@@@@@@A@@A@@A@@A@@@A@AA@@BA@AB@B@BAB@B@@ABA@A@@B@@@@A@@@ABA@@AA@C@@AA@@@A@@@@@AA@A@@ABA@@BC@A@@@ABA@@@A@@BA@A@@@@@ABA@A@@@A@A@A@@AA@AAA@A@CAA@@@A@@@A@@@@@@@A@ABA@@@@@A@A@AAAAA@A@@@@AAA@@@@A@@@AAA@@AA@A@@@AA@A@@@A@@AA@AAAA@@@AA@@C@@AAACAAA@@A@@BABA@C@@@AA@@AA@@@@AA@@A@@@@A@AAA@@@A@A@AACA@@AACAC@AB@@AA@@AA@@BA@A@@@A@@@@@A@@@@@A@C@@@@@A@@@@AAAAAA@A@@@ABA@A@A@A@@@@@A@@AA@ACA@@AAAA@@@C@C@@@A@@@A@ADA@AB@@@BA@@@AAC@@@CBC@A@@ACAE@@@A@A@@@A@C@A@@BA@A@@@AAA@@BA@@B@BA@A@A@@B@@AB@B@D@B@@AB@@@A@ACAA@AAAB@BA@A@C@C@AB@B@@@B@B@BAB@@AB@B@BBB@B@B@BABA@A@@CC@A@CA@@AAAAA@AAAAA@@@@@AA@@A@@@A@A@@DC@@BAB@@A@@@A@@@A@@@A@@B@@A@@C@@A@@@A@@@@@@A@AAA@AAA@@@A@@A@@@@A@A@A@@BA@@A@@@@@A@@@@@A@A@@A@@@AA@A@A@@@@AA@@@A@@A@A@@A@AAA@A@@@AA@@AAA@A@CAA@@@A@@@AAA@C@AA@@@@AA@@A@A@A@@AAA@@@ECAAA@AA@@@@@@@AB@@A@@@A@A@@@ABA@A@@@@@C@C@@AA@@@A@@B@D@B@@AD@@@B@@A@@BABC@@@C@@B@BA@@DE@A@@@AB@BA@@BA@A@@AA@@@@ABA@@@BA@ABA@ABA@@@A@@@@CBA@@AAAAAA@@@A@@ABA@@@@@AA@A@@@A@AAAA@A@@@A@C@C@@@A@@@ABABA@@@AAA@@A@A@@@@@@ABA@A@CAA@@@A@@B@@A@@@A@AAA@A@@@A@@@A@AACAA@@AA@A@@@A@A@@@@@AAA@A@A@@@@AAA@@@@A@@A@@@@@AA@@@@A@AAA@@@@A@@@@@C@A@@B@@A@AAAA@@A@@BA@@@@@AAA@AAA@@A@A@AAA@@A@@AAA@@@@@@A@@@AA@@@@A@@AB@@A@@BA@@@A@@A@@@@@AB@@@@AA@@@@@A@A@@@@AAA@A@@@AB@@@@A@AB@@A@ABAB@B@@@B@B@B@B@@@BA@AA@@AAB@@@A@@@@AA@@@C@@BA@@BAD@B@B@@@@AB@@A@AB@BC@@BADA@AB@@@BBB@B@@@@B@@B@@@@@BB@@BB@@@DB@@@BADAB@BA@AB@BCB@@A@ABA@@@@@A@A@CBA@ADA@A@@B@@AB@B@B@B@B@@@D@BB@@BB@BB@@AB@@@B@@@AB@@@@BBB@B@D@@@D@BB@@@@BA@@@@DAB@B@@@@@@B@@@@BB@@@ABADC@@B@@AB@BAB@@@BAB@@A@@AA@@@ABA@@@@@A@A@@BABAB@@A@A@AA@@A@A@@@@@AD@BAFC@@@@@A@AB@B@@@@A@A@@@A@A@A@A@@@@CC@A@A@A@ABA@@B@@AB@@@BBDBBBB@@@B@@@@@BABC@@@AB@@@@BB@@@B@B@BBFBBBDB@@BBB@@ABA@AB@@A@@@AAA@@@@BA@@@A@@BA@@B@@@B@@A@@@AA@AA@@@A@@BA@@@@B@B@BB@@BA@@@@BBB@B@@@@@DA@AB@@@@@DBBBB@BA@@BBD@B@@@@@BA@@B@@@B@D@@@B@@@FDBBB@BBB@BBBBBB@@B@@A@ADA@AB@D@BBB@D@BB@@B@B@B@B@B@@@@A@A@C@A@@@CBA@ABA@@BA@A@@@A@@@ACAA@@AACAA@A@@A@AA@@A@@B@@E@AA@@AAC@@@@A@A@@@@AB@@@@AA@AA@A@AA@@A@@A@@A@@@@@AAA@@@AAAA@A@@@@BA@@@@ECCA@@@@A@AB@@C@@@A@@@A@@@@@A@@C@AA@@@AA@@A@A@@@@AAA@A@AB@@@BA@@@AB@@@B@BAB@@A@@BA@@B@@@B@B@@@BA@ADC@@B@B@B@@ABA@@B@@@D@@@B@BA@AB@@@BA@ABA@@@ABA@@BADA@@@A@C@@@ABABA@A@@BC@@@AB@B@@@@AB@@AAC@A@@@AB@@@@AB@@A@@AA@CAA@AAAA@@A@@@ABA@AA@@A@@A@A@@CA@@A@@@AA@A@AA@@A@@@A@@BA@@@@AA@@A@@A@A@@@A@A@AACCAA@@A@@AAAAA@@A@A@@AAA@AACA@A@@A@@@A@@@A@A@@AA@@AC@A@@AA@@C@CAC@@AA@@@CB@BA@A@CAA@@AA@AAA@@@AA@@A@@@A@@AB@@C@AAA@@@CA@@@A@@@AAD@@A@@@A@@@@@A@@DABA@A@@@ABAB@B@@@@@@A@@@A@@BA@@@@@A@@@@@A@A@@BAAA@AAA@@AA@@@A@AA@@A@@@A@@BA@@DBB@@@@A@A@A@C@A@A@@BAB@@@BA@@B@@ABA@@BA@A@@@A@@@A@@@B@@B@@@@@@B@@D@@@B@@A@@BA@@B@@@@AAA@@@@@AB@D@@@B@@A@@@A@@BA@@@@@A@@@@BA@@B@@ABB@@@AB@@@@A@@@A@@BC@@B@@@B@@@B@BABABA@@BA@@BA@@A@CC@@ABA@@@AAA@AA@AAAA@@A@@@A@@@C@EBA@@@@B@@A@@@@@@B@@@@AB@@AB@@@@A@@B@@A@@@@BA@A@@@A@@@A@@@A@C@A@@A@@ABA@@AA@@BAB@@@@@B@@B@@B@@@@AB@B@@@B@BA@@BA@AB@@@B@@B@@@B@BBBB@@B@@B@@BD@@@@@@@@@@A@A@@AAA@@@AA@A@@AA@@@@@AB@@@BC@@@@B@BBB@@@@A@AB@B@@@B@@@B@B@B@B@@@B@@AB@@@BAD@BA@@@A@@@@@@@@@A@@@@B@AABABA@@@A@@@A@@A@@@AA@@A@C@A@@@@AA@@@@@A@@@A@@@@A@A@A@@@A@AA@@@A@@@ACA@A@@@AA@@B@@A@@AA@@BABAA@AA@@@@@AD@@@B@@@D@BB@A@@BADABC@AB@B@@@@@@@B@@@B@@@BB@@@AB@@AB@@AA@@@@@A@@@AAAA@AA@A@@@@@@@AB@@@@@BEAA@@@AAA@@@A@@@A@@@@@ABCBCAA@A@A@@AA@@@AAA@@@@@@BA@@BA@@@ABAAA@@@BA@@BA@C@@BA@A@A@@@A@A@A@ABA@@AA@ABAB@@@@AB@AAAAAA@@@@@@@A@@@@@AA@@@CAA@A@A@A@@@A@A@@AA@@@AA@@@@AA@A@A@@BA@@B@@A@@B@@ABB@@@@@A@@@AA@@A@@B@@@@A@@@A@@BB@ABA@@BC@@A@@A@@A@@@A@@@@@A@@@@@@@A@@@@A@@@@@@@@@A@@@ABB@@B@@AAA@@@@A@A@@@AAA@AAA@AAA@@AA@ABAA@@A@@@A@@@@A@AAA@@@A@@AA@@A@@A@ABA@A@C@@@@@ABA@ADA@A@A@@AA@@@AAA@A@AACA@@BABA@@@ABC@@@@@ABA@@@CAA@ABAA@@AB@@AB@@@A@@@A@A@ACA@@BA@A@A@@B@@A@@BA@@BA@@BC@A@@B@AA@AB@@@B@@@@A@@@@B@DABAB@@AB@@@B@@BB@@@@@B@@BBA@@B@@A@@@AB@@A@@@A@@@@@@B@B@@@@AA@@@@A@@@@@ADB@@BCB@BA@@@@@BB@@@@@B@@@@@AA@@@@D@@@@@@ABC@@B@@AB@B@@@@@B@@A@A@CBA@@@A@A@@B@BA@@B@@A@A@@BA@@BB@@BAB@@@B@@@@@B@@@@@BAA@BA@@@@B@@@BB@@@@B@DA@@@@B@@A@@BA@A@CB@@A@@@@BAD@@ABA@@@BDA@@@@BAB@DA@@B@B@B@@@@@DA@@B@B@@@B@B@BAB@DAB@@@@@B@@@B@BA@@@A@A@@@@A@AB@AA@A@A@AAA@@@ACAA@@AA@AAA@@@@A@A@A@AA@@@@A@@A@@A@A@@@AAA@@@@@AAA@@@@@@ABA@@@A@@AB@@AD@@A@@A@A@@@@@@A@@@AA@@@@@A@@BAA@@A@@@AA@@A@A@@@A@AAAA@@@A@AB@B@@AB@@A@A@@@EAA@@@A@@@AA@AA@@@AA@@@AB@@C@AB@@@BA@@A@@@@@A@@AC@A@A@@@AAA@@A@A@A@@@AB@@@@A@@B@BA@A@@@@AA@@@@A@@A@AA@AA@@@@BA@AA@@@@AA@A@@AA@@A@@BC@@@A@@@@@AA@@AA@@A@@@@A@@@A@@@A@@@A@@AA@A@AA@BAA@@A@@@A@@@A@@@A@A@@A@@A@@A@@A@@A@BA@@AAA@@BA@A@@@ABA@AB@D@@@@AB@@@B@@A@ABAA@@A@@@@AA@AA@@AA@A@AB@@C@@@C@A@A@CBA@A@A@@@ABA@AAA@@@CC@@@@@C@@A@@@@A@A@ABADA@@BABCB@@A@@@@A@@@A@@@AA@@A@@@A@A@@@A@@@@@AA@@AAA@@@A@@AAA@@@@@AA@@@A@BA@@AAA@@AABA@@A@@ABA@ABC@AA@@@AA@@AA@@@AAC@AAAAA@@AA@@@CA@@CA@AAAA@@@@A@@@@@A@@A@@@@@@AAAA@@BA@A@A@@@A@@A@@@A@A@A@A@A@A@A@@B@@@@AAAA@@@@@A@A@A@A@@@@@@A@@AB@A@@@@A@@@@A@@@A@@@AAAA@@@A@@@@B@@AB@@@AA@@CA@AAAA@@A@@@BA@@C@@@A@C@A@@@A@A@A@@AA@@@AB@@C@A@A@A@@@A@@AA@@@A@@A@@A@@AA@@@@AA@A@AB@@@AA@@BA@@BA@A@@AA@A@A@@@A@AAAAA@A@@@AABA@AA@@@A@@AB@@@BA@@BA@@@ABAA@BA@@AA@@AA@AAA@@C@@@A@@BAB@@@A@@A@@A@@BA@A@AB@BA@@@@@A@A@AAA@AAA@A@A@@@A@AAA@A@A@AB@@A@A@ABA@@@A@@B@B@@@@@BA@@@@BABA@ABABADC@ADAB@@@AA@AA@A@@@ABA@@@@A@@@@@@@@AA@@@AAC@A@@EAA@@@A@AAA@@BA@@@ABA@AB@@AB@B@@AB@@@@BB@@@B@@AB@BABA@A@AB@@CA@@@AA@@ACBAA@@A@@BA@@@A@@A@@@@@@AB@DAB@B@@@B@B@@@BAB@@C@AB@@ABA@AA@@ABA@@@@AA@A@CCAA@@A@AA@EAAAA@@A@CAA@@@AC@@@AA@@A@@AAAA@A@@@AAA@@A@@@@B@B@B@BA@@@AB@@@@@@ADABA@@@@BB@@B@B@@AB@DA@ABAAA@@@A@@B@B@@AB@@@@AD@@A@@BABAB@@AB@@@BAB@BA@A@A@@BA@@@C@CB@B@@AB@B@@@DADCBA@@BAB@B@@@B@@BB@@@B@@ADABAB@@B@@BB@@B@@@D@BAB@@@D@BA@CDA@ABAB@B@@A@@@ABA@@@@AA@@@CAA@A@C@A@G@@@AA@AA@@ABA@A@C@@AABC@C@@BC@A@ABC@A@AA@AA@@@AACA@A@@@A@A@@@@A@@@@AAA@A@@@C@AAA@A@@@A@A@A@AAAA@@A@A@@@CBA@A@@@@A@C@A@A@@C@@@@@@AAA@@@@A@@@@ABA@ABC@@@@A@A@@@AAAAA@AA@AE@A@C@@@@AC@@A@@AC@@A@@A@@BABABC@@CE@@ACAA@@@@A@A@A@A@CAA@AAA@@@@AA@@@@@AA@A@@BA@AAAAC@@AA@@@A@A@@AA@@@@A@@@@A@@@AA@@@AA@@@@AAA@A@AA@@CA@@@@@BCA@@A@A@A@A@A@A@@@AB@@A@A@AAA@@@@BA@@@A@@@@B@BB@@B@B@F@@@@@BAB@BA@@BA@ABA@@B@B@D@BA@@BADA@AB@BABA@ABA@@B@D@@@@@BA@@@CD@@@BA@@@@AAAAAA@A@AB@@CDABA@A@A@A@@@A@A@@@@AA@@@@@A@@B@@@D@@BD@B@D@BBDB@@@@B@@@DB@@@@@@BB@@B@@@BABA@BBA@@@@@@BCD@@@B@@@BB@@@@BAB@B@B@@@BBD@@@B@B@B@B@@B@@B@@@B@B@@@B@@@BC@@@@B@@@B@@@FAB@@@@@BB@@BA@@BB@@@@@@BB@@BB@@@BB@@@@CBAB@@@B@@ADA@@B@@B@ADAB@@A@@@@@AB@B@@@B@B@BAB@@@@@B@B@@@@B@@@B@@@@B@@@BB@@@B@@B@B@@@@@BBAB@@B@@@BB@@@@@B@@B@B@@@@A@AB@@AB@@BBA@AB@@A@@@A@@BA@@@ABABA@@B@@AB@B@BBB@B@BA@AAA@@@@@A@@@AB@@@B@@@@@B@B@@@@@@@D@@A@AA@B@@@@@B@@@B@@@BB@BB@@BB@@@B@@B@@BB@@@B@@BBAB@FB@B@BBB@@@BA@@BA@@AA@@@AB@@A@@BB@AB@BBBABBB@@@@B@@@@B@@@@B@@B@@AD@@@B@@@B@@AB@B@@C@@BAB@@@@@B@DBD@@DD@@BBB@@BB@BD@B@BBB@@@@CB@@AB@BA@@B@@@@A@AB@@AA@@@@@@AAA@@@A@@@AA@@@@A@@BB@A@@@ABA@@BABA@@@@BAA@@@@@B@BAB@@@@B@BB@@AB@@@B@BB@@B@@@@@B@@@@B@@@@B@B@@@BA@A@@@@BB@@@@@@B@@A@@@A@@B@@@B@@@@@@@B@B@@BB@@@B@@@@A@@@@@@B@@@@@B@@B@@@@@AB@@@@@@@B@@@@BBA@@@ABA@@B@@BB@@@B@@@BA@@B@@@BABA@AB@@AA@@@@A@A@@@A@ABA@@BA@@A@A@A@@@@C@AA@@ABAA@@@BAB@@A@@BA@@B@@@BA@A@A@@@@@@@@@@A@@@@@A@@@@AA@@A@@BA@@@@@CA@A@@A@@@A@@@AAAA@@AA@@AA@AA@@@A@AB@@@@@@A@AAAA@@A@A@AB@@A@@@@BC@@B@@@ACA@@@AA@@@A@@AA@A@A@AA@@@AAAA@@BA@@@@@@@B@@B@@@@A@C@A@AA@@ABA@@@@A@@ABAAC@@B@@A@@A@@A@@@C@AA@@A@@@A@@@A@@BA@@@A@AB@AA@AAAA@@@@EDA@@BCB@B@@@F@BB@ABA@@@@B@@@DABABA@ABA@A@@@A@@@AC@@A@@@@@AA@@AA@@@@A@ABAB@@A@@@@A@@A@@@@B@@@B@B@@A@A@A@AB@@@BB@BB@@@@AB@@A@@@AB@@C@@@@@A@@BCBC@@@A@@@A@@A@@@A@@A@A@A@@@@AAC@@@B@@AA@@@@@A@@A@@@@@@A@AA@@@@@A@@B@@@BAB@BAB@@ABAA@@@@ABA@@@AA@A@@A@AA@@@C@@@@@C@@A@A@ABA@@@AA@@@@AAA@@@A@@@AD@BA@@BAB@@@B@BA@ABABAB@@@@@@ABCD@@@B@B@BDD@@@BA@@@@B@BAB@@A@BB@B@BBB@BA@ABB@@B@@@B@@@B@B@@@DBD@@B@@@@BA@@@@B@BAB@D@A@@BBB@@@AB@@BB@@@B@@@BBB@B@@BB@@B@@@AB@@A@A@@@BA@@@@AA@@AB@@A@@A@B@@@B@@AB@@@@@B@@@@@B@@@B@@BB@@B@@B@@@BBA@BB@@@B@BBBA@BBB@@@B@@B@B@B@@BB@@B@@BB@@@@@B@B@@@@BB@@@@B@B@@@@B@BBD@@@@BB@B@@@BB@BBBDB@@@@@@CB@@@@@H@D@D@BBBBB@BBB@@D@B@@@DA@@BCB@@@@DBBB@@B@@@BA@@BAB@B@@@@B@@A@@B@B@@B@@@@B@@BB@@BBB@B@B@BBDB@@B@BB@@F@DB@@@@D@BB@@@BB@BBD@@@BBB@@@@B@@@@BBD@@@BBB@BBDBBDB@BB@@@BD@@B@@B@B@B@@@BB@@B@@BB@@@BBB@@B@@AB@@AB@@@B@@@B@@BBBB@@@@@B@@CB@@@B@@@B@@@B@@@B@@@@@@@B@AA@CBA@A@@@AA@@CB@@A@A@@B@@@@AB@@@B@@AB@@@BA@A@@@@B@BA@AB@@@@@B@@B@BB@B@@@B@@@@@AA@@@A@@@@@CA@@@@@BB@@B@@B@@@BB@@C@CB@@A@BBB@@@@@@B@B@@@@C@@B@B@BADCD@@B@@@AB@@BB@@@B@BBB@@@BB@@@B@@BB@@B@@@@B@@B@@@@BBBB@@BB@@B@@BB@BB@@@@BB@@BBBB@@@@BBBB@@B@DDBBBBD@@BDDB@@B@@@BA@@@@@@BB@@BD@BBB@B@DDB@B@B@BBBBBBBB@@DB@@D@@@@@@B@@@@@BBB@@@@AB@@@D@@@@@@DBB@B@@BB@@B@B@B@DAD@B@B@D@B@D@B@@@BD@DB@@@@BB@B@DAD@B@B@B@@BBBB@B@@B@BBBDB@@@DB@@BBFBBB@@@B@@@D@@@@@B@@@B@@@B@@CD@@ABA@@@ABB@@@@@BBB@@B@@@@@@A@BB@B@@@B@BAB@@AB@@@@@B@@@B@B@@@@@B@B@BAB@BA@@B@@BBDD@B@@BB@B@@@@@B@@@BB@B@@@BB@@@@B@BB@@BBB@@@@DDDA@@BA@@@@@@B@@@BA@@B@@@B@@@@ABCBA@C@@@ABA@A@@@A@@@@@A@@@A@@AC@@@CA@@@@A@@D@@AB@@AB@@@B@@A@A@C@A@@@A@@@@@A@@B@@AB@@@@@@@BA@@@A@C@@@A@@B@@@@@@AA@@A@@@@@AAA@A@@@@@@@@A@@@@A@A@@B@@A@@@@@A@@@A@A@@A@@@AA@A@@@BA@A@@@AA@@A@@@AB@AA@@@@A@A@A@A@CA@@ACA@@B@@AA@B@@A@@B@D@@@@@BB@@B@@A@A@A@A@@B@@@BBB@@AB@@@@@B@B@BBBABBBA@@BBB@@@@@B@@A@A@A@@@A@AAA@@@@@AA@@A@@A@@@@@AA@A@A@ACCAA@@@@@AA@@@@A@@@@@AB@@CB@@AB@@ABA@A@@BC@@@@B@@B@A@ABAAC@@@A@@A@@@@@@B@@A@@A@A@E@A@AB@BCBA@@BA@@BA@@BBD@B@@AB@D@@A@ABABA@BB@@BD@B@B@B@B@B@@AD@B@@@B@B@BBBBB@@@@@@AB@@A@@BAB@B@@@BBB@@BB@B@B@D@BBB@@BB@B@B@B@BCB@@B@@@AB@@@A@@AB@@@@@AAA@@@@A@A@@A@A@AAAA@@@@A@@AA@@@AA@@@A@AA@@A@ACA@@@@A@@A@@AA@AA@A@@@AAA@@A@A@A@ABA@AAC@ABA@A@@@A@A@@BA@C@@@ABADGFCB@@@@A@@AC@@@@@A@AAA@@@@@AB@@BB@@@B@BA@@@@B@D@B@B@@AB@@@B@@D@@B@@@@BBA@BB@@BBB@@B@@B@@BA@@@@BB@@@D@@@B@@B@@AD@B@B@@B@@@BBB@BBBB@B@B@@@@@B@@BB@@@@AB@@@BA@BD@@@B@@ADBBB@@B@@@@B@@B@BAB@B@@@B@BA@@@AB@@A@@@AB@@@B@@A@A@A@@AAAA@@@AAA@A@@@@@C@AA@@A@@@CD@BA@AB@@CBA@@@CB@@AB@@A@@@A@@BA@@@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@@AB@@AB@@AB@BA@@BBB@B@BB@@BB@@B@@B@B@@B@@@B@B@B@F@@BB@BB@@B@@@@BB@@@B@@DDB@@@@@@BB@AB@@@B@@BB@@@@B@B@D@B@@A@@@@BA@@@@@@B@@@@@BB@BB@@@@BB@@B@@AB@@A@@@@@A@@@A@@BAB@@@B@@AB@@A@@@AB@@@ACBA@@@A@@@A@@BAB@@@B@@@B@@@@B@@B@DB@@@@@@@@BB@@BB@@B@@@@@@@BB@B@B@@@B@B@@AB@@@BA@@@@@@@@B@@@@@BBBBB@@@@BB@@@B@@C@@@AB@@@BA@@B@@@@@B@@@B@@@@@BB@@B@@@B@BB@@B@@@B@B@@@@BBB@@@@BB@@AB@D@B@@@@@B@BAB@@@D@B@BAB@B@D@BADBFABBBA@@B@B@@@@@BAB@D@@BDABB@@BBDAB@@@B@@AB@@A@@B@@@@A@@@@BA@@B@@BB@@@@ABB@@@AB@@@@@@B@@B@@A@@@@@@B@@@B@B@@@@@@@B@@AB@@@BAB@@@@A@@@@@@@A@@@@@A@AB@@@@AA@@@@@A@A@@@AA@@@@AA@@@AA@@A@AA@@A@A@@@@B@@A@AAA@AAA@@@A@@@@@@BA@ABAB@@@BA@@@AA@@@@@BA@A@CB@@C@@@@B@B@@A@@@@B@B@@A@A@@@@B@@AB@@A@@BA@A@@@AA@@A@C@@@A@@@A@@B@@ABA@@@@BA@AB@@AB@B@BAB@@@B@BB@@@BB@@@BB@@B@@B@DBB@B@B@B@B@B@@@@@B@BB@@BB@@@B@B@@@@@B@@A@@@AB@@@B@@@B@@@B@@@B@@@@@B@@@@@AA@@@@@BA@@AA@@@@A@@@@BBB@B@@@B@B@@@@BB@@@@@@@B@@@@@@B@@@B@@BB@B@B@@BB@BBBBB@@@@@AD@BAB@@A@@@@@ABAB@@A@A@A@A@@@@@A@@@A@@BBB@B@@@B@B@@@BB@@B@BB@B@@@B@@@@BB@@@@B@@@B@@@@AB@@@@@B@@AB@@@B@@@@@B@@AB@@A@AB@@@@@@@B@@BB@@@@BB@@@@@B@@BB@@BB@@DB@B@BBB@BB@@B@@B@@@@BB@@@@BB@@@B@B@BBB@BB@@@@@B@@@@@@@BA@@@@@BB@@DB@B@@B@@B@@AD@@@@BB@@A@@B@@@B@B@@B@@B@BBB@D@@@@DBD@BABABADBB@BAB@FA@@@ADC@A@A@@BABABA@@@@BA@@B@DA@@@@@@@ABBB@DA@@@@@A@A@@B@B@@A@@BAD@@@@@B@BA@@@@B@B@@@B@BA@@@@B@@@@@B@@@@@B@B@@ABAB@@A@@B@@@@A@@BC@@B@@A@@@A@A@@@A@A@AB@BA@A@A@@@A@A@AB@B@B@@@B@@@@AB@@AB@B@@@@A@@B@@@B@@AB@@AB@@@@A@@B@B@@@B@@@BAB@@@B@@@DA@@B@@@BAB@@@@AB@@@@@BA@@@AB@@@B@@A@BB@@@B@@@B@DB@@BB@BBB@@@@@BA@@@B@@B@@@B@B@B@BA@@B@@BB@@@B@BBBB@@D@@AB@BBB@@BD@@B@@@BB@BBBDD@@@B@BB@@@BD@B@@@@@B@@BB@@@B@@BBBD@@@B@@@@@BAD@@BB@@@B@@BB@@BB@BB@@BB@@D@B@@@B@BB@@B@@@B@@@BB@@@DB@@BA@@@@B@BA@@B@@AB@@@BBB@@@@@DB@@@@B@@@@@B@@@DABB@@B@@AB@@@@@@@BB@@@@@@@@@@BB@B@B@@@B@B@@@B@B@B@@@BBB@@@@@B@B@B@@@@@B@@@BBB@DB@@BBB@BBB@BBB@@B@@B@B@BBB@B@@@B@@@@@B@B@@@BB@@@@@@BB@@FAB@@@B@@B@@@BB@@B@@@@@@B@@@@B@B@B@@@B@@@BB@@B@@AB@BB@@@@B@@@@@BB@@B@BB@@@@B@@@BBB@B@B@@B@@B@BB@@@B@B@@B@@@B@@B@@BB@@@@B@@@D@@AB@@@@B@@BB@@B@@@B@@BB@@BBBBB@@DB@BB@@@D@@@BBB@B@BD@BBBB@@B@@BB@BB@@BB@B@BB@@@BBB@@@B@@@BBB@@B@@B@@BBBB@@BBB@BD@B@@BD@@@B@@B@BB@@FB@B@@@@@BB@@@BBB@BB@BBB@B@@@BB@BB@@B@@BBB@@B@@BB@@BB@B@B@D@@BB@B@B@B@BB@AB@@A@@BA@@B@BA@@BB@@@@B@B@B@B@@AD@@A@A@@B@@@@FA@@@A@@@@@@BB@@B@@@B@B@B@@B@@@BD@@B@@B@@@@B@@BB@@B@@BB@@A@@BB@@@B@BB@@B@@BB@B@@BBB@@BB@@BB@@BB@@@@@B@B@D@BBBAB@@BD@@BBBB@B@BB@BBB@@BB@B@@BB@BB@@B@B@BBB@DB@@@BAB@@@@@BBD@@@B@BB@@@@@B@@@@@B@@B@@DBB@BBBD@@@BBB@@@B@B@B@@@B@B@BB@@B@@@@BB@@BB@@BB@@@BBB@@BBB@@B@B@@@BB@@ABB@@BBBB@@@@BD@@@B@@@@BB@B@@@B@B@@BB@@@@B@@@B@@@BB@@@BB@@B@AB@B@@@B@@@@BB@@B@@@B@@@BB@@B@@B@@@@B@@@BBB@B@@B@@BB@@@BB@@B@BB@@@@BBB@@@BB@B@@@B@B@BB@@@@B@@DD@B@@@BBDA@@BB@@B@BB@B@@@@@@@@@@B@BBB@@@@BB@BB@@B@@BA@B@@@@B@@B@@BB@@B@@@AB@@@@AB@@A@@@@@BB@@@B@@@@A@A@EBABA@@BA@C@C@@@@@@BB@@@BBBB@D@B@B@B@B@@@B@BB@@B"],encodeOffsets:[[118789,27736]]}},{type:"Feature",id:"360732",properties:{name:"兴国县",cp:[115.363189,26.337937],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@AAA@@AB@BA@@B@@AB@@A@@AA@A@@BABA@A@@@A@@@A@A@@@A@A@AB@BA@@@A@@@@@@@AA@@@@C@C@@B@@@@@BB@@@A@@AA@@@A@@@AB@@A@@@AB@BAA@@@A@@@@@B@@@BA@@A@@@@A@AB@@A@@A@A@@@E@@@AA@@@@A@AB@@A@@@C@@@DBA@@B@@ABA@@@@@A@AAA@AA@@@@AA@@@A@@@@BA@@@@@@A@ABA@@@A@A@C@@AA@@AA@BAA@AA@@CC@@@@@@CBA@A@@@A@@A@@ABA@@@AB@BABAB@@A@A@@A@@@@@B@@@@AB@@@B@@AA@@@@A@B@@@@AA@@AAB@B@@@B@@@D@@@AB@@@@B@@@@@@@@@@@BA@CB@B@@A@@BBB@BB@@@B@@BB@@@@B@B@B@B@@AB@D@B@@@@@@@BBB@@@@AB@@A@@@A@AB@@@B@@@BB@@@BB@@@B@@@B@B@@@@AB@@@B@@@D@@A@@@A@@@@B@@ABA@@@A@A@@@AB@@@B@@@B@@@B@B@B@@@@@B@B@@B@B@@@B@BB@@BB@@@D@@B@@@@B@@@@BB@@B@BA@@B@BB@@B@@@@BB@@@BA@@BA@BD@@AB@@@B@B@@BBA@@B@@B@@B@@@@@BBB@BB@BAB@@@B@@B@@BB@@BB@BB@@@@BBA@@BB@@@@BABB@@B@BDB@@@B@BBBB@@B@B@@@@@@AB@B@@@@@B@BA@@@A@@B@@A@@@AA@@@B@@A@@@@@@@B@@BB@B@@B@@@B@@@BA@@A@@AB@B@@A@@@A@@@@@@B@@A@AB@@A@A@@@AA@@@@@@AA@@A@A@@@BAA@@ACAA@AAA@@B@@AAAB@@@@A@@@AA@@AA@@AB@@A@@B@@@@A@BAA@A@@@@@@@A@@@@@@@@BA@@@@@A@@@@B@@BB@@A@@BA@@@@B@BA@@@@@AB@@CB@@@BA@@BA@@@@B@@A@@BA@@B@@@B@@@B@@A@@@AB@@@@A@@@A@@@@@A@@B@@A@@B@B@@ABA@@@@BA@@@@BA@@@A@@@@B@B@@@@@@A@AA@@@B@@A@AA@@A@@@@@@A@BA@@A@@@@@@A@@A@@@DAA@B@@A@@A@@A@@@A@@@@@AB@@@B@@A@@B@@@B@@A@@AA@CBABA@@@BB@B@BBB@@A@@@@@A@@@@@@B@B@@A@BBAB@@@B@@@BA@@@@@A@@@A@@@AA@@@A@@@@AA@@@B@@AA@@@@AB@@@BA@A@@@AB@@@@@@A@BB@@@@@B@BA@A@@@@@@BA@A@BD@@@@A@@@AB@@@@BB@@@BB@@@BB@BA@@B@@@BA@@@@@AB@@@@@B@@@B@@A@@@A@AB@@AB@@A@@@CBA@@@A@@@AB@@A@@B@@@BA@@@A@@@A@@B@B@@CA@@@@@B@@@@BD@B@B@B@B@@BBBB@BBB@@@DA@@BA@@@ABAB@@@BABBB@B@@AB@BA@@@@@@@B@@@@B@@BBA@@@A@@B@@@B@@@@@@@@@BB@@@@@@B@@@B@@AA@@A@@@AA@AA@@@@B@D@@@BB@@@@@@BB@@@B@@@@@@B@@@@@A@@@@BB@@@@BB@@@@@@@@A@@@@BA@@@@@@@AB@@A@@A@@@@A@@@@A@@@@@@A@A@A@A@@@A@@BB@@BB@@@@BA@@@BBB@@@@@@B@@@B@@@B@BA@@BA@@@A@@B@@A@@A@A@@AA@@ABA@@BA@A@@BA@@@@@@B@@@B@@A@@@A@AAA@@@@@A@AB@@@@@@AB@@A@@BAA@@A@@@A@@A@@AB@@@@A@@@A@A@@@A@@@AA@@A@@A@@AAA@@@AA@@@A@@A@@@A@@@A@@@@@A@@B@@@@@BAB@BA@@@@@A@A@AA@@@@@@@C@@@AA@@@@AAAB@@@@A@@A@@@A@@@A@@@@BB@@@@@@BA@@B@@@B@@@@A@@@A@@@AA@@@@@AA@A@@@BA@A@@A@@@@B@@A@@@@B@@@BABA@AB@@@A@@@AA@@@AAAA@@A@@@@@A@A@A@@@BB@@A@A@A@@@@A@@@@@@@AA@@A@@@@A@@@@BA@@@AB@@@@AAA@A@@@A@@@@@B@@AA@CAA@@@@@AB@@@@A@@@@@A@@@@BA@@@@B@@@B@@@@A@@@@@BD@B@BBB@@AB@B@@AB@@@@@@BB@@@@BA@@@@BB@@@BB@@B@@B@@@@B@@A@@B@@@AA@@B@@@B@@@@@B@@A@@@@B@BB@@B@BA@@@@D@@@@@B@B@B@@@B@@BD@@A@@@@B@B@@@@A@BB@@@@@B@@AB@@@@@@A@@@@@@B@@@@ABA@@@@B@@AB@BB@@B@@B@@B@@B@@BB@@@@@@B@@@B@B@@@B@@A@@BA@@@A@A@A@@@A@@@AA@@A@@@@@CB@@@AA@A@A@@@A@@@@AA@@@A@AA@@A@A@@@C@A@@BA@A@A@@AA@@A@@@@A@@@@B@@C@@@@@A@A@@@@BA@@@A@AB@@A@C@@BA@AB@@@@AB@@@B@@@B@@A@@B@@@@ABA@AA@AA@@BAAABA@@AA@A@@@A@@@C@C@A@C@@@AB@@A@A@@A@@@@@@AAA@AAA@@@A@A@A@@@A@@AABA@@BA@A@A@@AA@AA@@BA@@AA@A@@A@@@AA@@A@A@AA@@A@@B@@A@@@A@@@@@ABAB@@A@@@A@@@A@@@ABA@@@@A@@A@A@@@@@A@@@A@A@@B@@@@@BA@@@AAAA@@@@AB@B@@@BBB@@@@A@@@@@AB@B@@@B@B@@@BB@@@BB@@ABA@@@A@C@A@@@ABAB@@@@AD@@A@@B@@A@@A@@A@@BBD@@@B@BB@@@@@A@@@A@A@AAA@@@AA@BA@A@@@BB@@@B@@@B@@AB@@@@@BAB@B@@@B@@@B@@@B@BB@D@DA@@B@@B@@A@@B@@A@A@@BC@@@A@@@@@@B@BBB@@DB@@@@A@@B@@B@DB@@@B@B@BA@@@@D@B@@B@BAB@@@@@@@@BA@AB@B@B@@AB@BA@BB@@@B@@A@A@@@A@@B@B@@@@ABA@A@@@C@@A@B@@BBBB@B@@@B@@@B@@A@@BD@@@@@A@@BAA@@A@@BA@AB@@A@@BAB@@@@AB@@A@AB@@@@@B@B@@@@A@A@@@AB@@@B@BA@@@A@@@CB@@@BA@@B@@@B@@@@ABAB@@C@@@A@AB@@@@AD@@A@AB@@ABA@@BAB@BA@@@@B@@AB@@@B@@@@ABA@AB@@AB@BABA@ABA@A@@B@@@@@B@@DD@BB@@B@@A@@B@B@@@B@BB@DB@@B@@@@A@@@A@C@@B@@@@BB@@B@@@B@@BB@B@D@BB@B@@B@@@BA@@@@@ABB@@B@@AB@BA@@@@@A@@BA@@@@@B@@@B@B@B@B@@@AB@@@@A@A@@@A@@@@@@@@@A@@@@B@@@B@@AB@@@BA@@@@AA@A@@@@@A@@B@@B@@B@BB@B@@BB@@@DB@@CBA@@@A@@@@A@@A@@@@BBB@@@@BB@@B@B@@@B@@@B@@B@@@@C@@BA@@@BBB@B@@B@B@@B@@@@ABB@@@A@@B@@@B@B@@BB@BB@@B@@BABBBAB@@BB@@A@@@@@B@@@B@B@@@B@@@@B@@@DA@@@@@A@@@A@A@A@@@@@@B@B@@A@CB@@@@@@A@A@@@@B@@@@DFBDBB@@B@@@B@@B@@@B@@B@@B@@B@A@@@A@@B@@@@@BB@@@@BB@@BA@@@B@@B@B@B@@@@B@@@B@@BA@A@@B@@ABA@@@@B@@B@B@B@BAB@@@B@@@B@BBB@@@B@@@BB@BB@@BBB@B@@B@BB@@BB@B@B@B@B@D@D@B@@DB@@@BB@ABB@@B@@@BB@B@@BB@@BDB@BBBD@@BB@@BB@@BB@@@B@BB@D@@@B@@BB@B@BB@BB@@BB@@BBB@@B@@@BB@@@B@@@@@BB@B@@@B@@@@@BB@@BBB@@BB@@@@BB@@BDB@@@@BDD@@BBD@@B@@B@@BB@@BB@@BA@@@BB@AB@@@@BB@BB@@@A@@B@@B@B@BB@@@@@@B@@BBA@@B@@@@@BB@@@@@BBB@@B@@BB@@B@@BB@@BB@@@DBBB@@B@@B@@B@@BB@BBB@@@@BB@@@BB@@B@@@D@@@BBB@@@B@B@B@@@BB@@B@@@@B@@B@@BB@@BB@@BB@B@@@BB@@B@DB@@B@BA@@B@BBB@@@B@BBB@@BD@BB@@BAB@@@@@B@BB@@B@@@BB@@@@@@@BBA@BB@@@BBB@DB@@@@@@D@B@@BB@B@@@BB@BB@B@@@@@B@@AB@B@B@@@B@@@@@B@@AB@@@BAB@BBB@@@@@B@@B@AB@@@B@B@@@@@B@@@B@B@@@B@B@B@@@B@B@@@@@@BB@B@@@D@@@BA@@@A@@@A@@@@@@B@@@B@@A@@DAB@@@BB@@@ABB@@B@@@@BB@@AB@@@@@B@@@BA@@@@B@BA@@@@B@B@B@@AB@@@B@@@BAB@@@BAB@@@@@B@@@B@@BDBB@@BB@B@B@BB@@B@B@BDB@@@B@@@BB@@@@AD@@@B@@B@@F@BA@@@AB@@A@@B@B@@AB@B@B@@A@@@@A@@@AAA@A@A@@@@@@@@A@@@@@@@A@@B@@@@@@A@A@@@@B@@@@@BA@@@AB@@AB@B@@B@@BB@@@BB@@B@@B@B@B@@@BB@@@@BB@BB@B@BB@@B@@BBB@@BB@B@@@BB@B@@B@B@@@A@@@@BB@@B@@AB@@@@BB@@@@@B@@BB@@@B@D@B@@BBB@B@@@@@BD@@B@@@B@@B@@@B@@BB@B@@B@@BB@@BB@@@@@BBB@@@B@@@@@@@B@@@@B@@@@B@@B@@@B@@BB@@BAB@@@@B@@@@@@@B@@@@BB@AB@@BD@@@BA@@@B@@@B@@@B@B@@@B@@@@A@@B@BBDA@@@@B@B@BBB@@BB@@B@BA@B@@@@@B@@@@B@@@BA@@@B@B@@AB@@@@@@B@@@BB@BBD@@@B@BBB@BBB@BB@@@@BB@@B@B@@@@A@@BB@@@BB@@@@B@@@@@B@BAB@BB@@@B@@@@DBB@DB@BB@@B@@@@BB@@@@@B@@B@B@@@@BB@@@@@BB@@@@B@@B@@@B@@@B@B@BBB@@@BB@@DA@BB@@@B@B@@@B@B@BBB@@@@BBB@@@@@@B@@BA@@@@@@B@@@@B@@B@@@@@B@@@BC@@B@BB@@@@B@DBBB@@B@@@BB@@B@@@@B@@A@@@@@@B@@@@@@@B@@@@@@@@@B@@ABA@ABABAB@@AB@@@@BD@@@B@@B@BBB@@@@@@@DF@@A@AB@@@@BD@@A@@BAB@@@BA@@@@B@@@B@@B@@B@@@@@@@BA@BBA@@@D@B@@@@A@@B@BA@@@@@A@@BBBA@B@@@@BA@@B@@@@BD@@@@@B@BBBBBB@@@B@@BBB@D@@@@@@BA@@@@BB@@@B@@@@A@@B@B@DAD@@@DAD@BA@@@B@B@@@B@@@@@B@@@@BB@@B@@B@@BF@@@@@B@@@BB@B@@B@@BB@@@@BB@BB@D@B@BAB@@@B@BB@@B@B@BA@@B@BA@@@ABA@C@@@@@A@A@AB@B@BA@ABA@@B@BB@@@B@@@@B@@AB@@@AA@@@A@@@A@ABA@@AA@@BAA@@A@@@A@A@@BB@@DBBAB@B@@AD@@@DA@@@@@AB@@AB@@A@@DB@B@@@@@B@@@B@@B@@BBB@BB@B@DBD@BBAAA@@@BA@@B@@A@@A@A@@@@@@A@@B@@@@@BA@@@BB@@BDABABBBBB@@BB@B@BABA@@BBB@@D@@BB@@@BB@BB@@BA@@@BB@@ABA@@@@@@B@B@B@D@B@@@D@DBB@@@@@@B@@@@@B@@@@B@B@@@F@B@BBB@B@@BD@@@BBB@@BBB@@BB@@@@AB@@AB@@@@BB@@@@BB@@BB@A@@B@@@A@@A@@@@B@B@BA@A@@@@@B@B@@@@@@@B@A@B@@@@AB@@ABBB@@@B@@@@BB@AB@B@@@@@B@@@@@@@BB@@@@@BBB@@B@B@@@@@B@@@@@B@@@BB@BBB@@B@@@B@@@B@@BB@BB@@BB@@AB@@@BB@@@@@@@B@B@@B@@B@B@@BB@@@B@@@BABADA@@@@BA@AB@B@@AB@B@BA@B@@BAB@@AB@@@@@A@@@@@@@BA@@@@@A@@B@@@@@BA@@B@@ABA@@@@A@@@@@@A@@A@B@AAB@D@BBD@BAD@B@@@@@@@@B@B@@@B@@B@@@@@@@@B@@@@@@@@@@@@BAB@@@@@BB@A@@BB@@@@@@@A@@B@BD@B@@B@@BB@@@B@@@B@@@B@@@B@BBBB@@DAB@B@@A@@B@@AB@@@BADA@@B@@AB@BA@@BB@@B@B@DBB@B@@@BB@B@@@B@@@@@BAB@@BB@B@@@BBBBB@@B@BBBABA@@BA@@@@B@@@@B@B@@@BBBBBBB@@@B@@@@@@DB@@@@@BBBBBB@@@BAB@@@B@@BB@B@B@@@BA@@BA@C@A@@BA@B@@F@@@@@B@@@@A@@B@@AAC@@BA@C@A@AAA@A@@B@@A@A@@@@@A@A@@@AAAA@@A@@@AB@@AB@@@B@@@A@AA@A@@@ABAAA@A@A@@@ABAA@BA@C@AB@@@@A@AA@@@@ABABAB@@@BABA@AB@BA@@B@@AD@B@BAB@BAB@@@DAB@@@B@@@B@B@@@B@@BDB@@BBB@@@BB@@@@@B@@@AB@@A@@B@@@B@@AB@B@B@@@DABA@@@@B@@@B@@@@@@BB@@@BB@@DBBB@BB@B@B@B@B@BAB@@@BB@@@@@@@@B@A@@@@AD@@@B@@@@AB@BB@@@B@@DB@@@@AB@@@@@BBB@@ABAB@B@B@B@@BBBB@@FAD@@@B@@@B@D@B@@@BA@ABAB@@@BA@B@@B@@B@BBB@@B@@@@B@@BB@@@BD@@@@@B@BBB@BAB@@@@@@@@@BAB@B@B@D@B@@@BA@AA@@@B@@@B@B@@@@B@@@@B@B@B@@@@AD@@ABBBA@@@BB@B@@@@AB@@@B@BBB@@@@@@BB@@BB@@@BAB@B@@AB@@@BA@@A@@ABA@AB@@@@@@A@@@A@@@@B@B@BA@@BAB@BABAB@@B@@@@@@@BB@B@B@A@@A@@AAB@@@@ABA@A@@@@D@B@@AB@@@@@@B@@@@AB@@@@B@@@B@@@BBB@@A@AB@@@@A@AB@@A@A@@BA@@@@@AB@D@BA@@BA@@@@@BB@BB@B@@@B@@ABAB@B@B@B@@@@@BDB@@@B@@A@@@BBB@@@@@BA@@@@B@@@@ABB@@B@@B@@BA@@B@@D@@@@A@@B@B@@@@B@B@B@BB@@@@@B@@@@@@@BBA@@@@BB@@@BB@@B@@@BBB@@@@@@ABAB@@A@@BBB@@@@B@@@BBB@@@@@BBBA@@BBBB@BB@BBB@BB@AA@@AA@@A@AAA@AB@@ABA@@BA@@BA@@@@B@@@@@B@@@@@B@@@@@B@@@@@@@@@B@@AB@@@B@@@BA@@DA@@B@DA@@BAB@@ADC@@B@@@BBD@@@@@B@B@BB@@B@BB@BB@B@B@@@@A@@BA@@B@@@BA@@B@@A@A@@@ABA@A@AA@@@@@@AA@AABC@@@A@@ACB@@A@@BA@@@@AA@@@A@@@@@A@AAAAAA@AA@@A@@@@A@ABC@@@AA@@@C@@@A@@A@@B@@AA@@@@AA@AA@@AAB@AA@@@@@AC@@@@A@@BA@@@A@A@C@A@@B@@A@A@@AA@@BA@@@@B@BBB@@@@@D@@BB@@@@@DAHEBCBA@@D@B@@AB@B@@@B@B@BAD@BBB@BAB@B@B@@@BB@B@@@BBBB@@BB@@@@B@@B@BDB@@@BBB@@@B@@B@@BB@@@B@@B@BB@B@B@BB@B@@@@@BB@B@@@@BA@@@B@@BA@@A@@@DA@A@A@A@AAA@@AA@A@C@A@AAA@@AA@A@@@ABA@AB@@@BA@@@@@@AAAA@A@A@A@@@ABC@@@A@A@A@A@AAC@@AAB@BABAB@@@@CBA@@@AAC@AB@@AB@@AB@DA@ABAB@F@B@B@@@@BA@@@@@@@@BB@@@D@BBBAB@A@@@@A@@D@@AB@B@BA@@BA@@DA@@BA@@@@B@@@@@BB@@@@B@DBBDB@B@B@@B@@@@@BB@@@BB@@@@B@BBB@@@B@B@B@@@@A@@@@AA@AB@AABAAA@A@A@A@@@@BA@@AA@A@@@AB@B@B@B@@@@AA@@A@@@@@C@AB@@@@ABB@@@AB@BD@@DBB@B@B@B@@@@@BBA@@B@@@BB@@B@@@BAB@@B@B@@B@@@BB@B@@@B@@@@@B@@@@AB@B@@@@@@B@@@@@@B@B@BB@@@@B@@@BB@@@@@@@AB@@@D@B@@@B@@A@@@@@@BA@@@AB@@@@@B@@@B@D@B@B@@@@A@@BA@@BA@@@CB@@@@@DB@@D@@BB@@@B@@@@@B@@@B@B@BA@@D@B@DABA@@@@@A@@@AB@@A@@@A@@@@B@@AB@CC@C@@A@AA@@AAA@@@@@AA@@A@A@@A@@@A@@@@@AAA@@@ACCAA@@@AB@@ABA@A@A@A@@@@@A@A@@@A@@@@BA@@BA@A@A@@@AAAB@@@@@@@@AA@AA@@@@A@BA@@B@BA@@DC@@@A@@@A@@@A@@@@@C@@@A@@AAEAAA@@CA@@A@ACAAA@@@@AAAAA@@@A@A@ABC@C@AAA@@@@CAC@@A@@@A@C@A@C@A@ABC@C@A@A@AA@@AA@A@CA@@@@@@@C@@BA@@@@AA@A@@@@@A@@@@C@@@CA@@AAAAAAAAA@A@A@CCA@A@AAC@@AA@@A@@@@B@@A@@@AA@CC@AC@AAAACCA@@@AAAA@@@@AAAA@@AA@@@@AAA@@AA@@@AA@@AAAA@@@@@AA@@@@@@AA@@AA@@@A@@A@@AA@A@A@@AA@@BA@@A@@@DCBC@A@A@AD@@@@@@A@A@@@@A@AAB@@@DAD@@@AA@@A@@@@AA@@A@@@@DB@@@@B@@@B@@B@@@@@A@@@AAAA@@@@A@@@@BAB@@A@A@@B@B@@A@@BA@@@A@@BA@@@@@AB@B@@@DA@@BB@@B@B@DAB@@B@A@@@@@@@A@@@A@@@A@@@A@@DA@@@A@@@@AAAA@@@A@@@A@@BA@@BA@@@AA@AA@@A@@AA@@@AA@@A@A@A@@@@AC@@A@@AAA@ACCAAAA@AA@@C@AA@@@@@A@@A@AA@@C@AAA@@A@@AAC@@@@@CAE@@@AAA@@@CAAAA@A@A@AA@@AA@@@A@@A@@@@A@AB@@@@A@@A@A@AB@@AB@@A@@@AACA@@@@DA@AB@@C@@@A@CA@AAA@AAAAC@C@G@@@@@A@@D@@@@A@ACAAA@@A@@@AAA@@@@AC@A@A@@A@A@@@@@AA@@@@@A@A@@@@AA@@@AA@@AA@A@A@@@@A@@AA@AABAAA@@@A@@AAB@A@@@AA@@@AA@@@A@@@A@@@@@A@@@@BA@@@A@@@A@BB@@@BA@@BB@@@@AB@@B@B@@@BA@@A@@@AA@@@AAA@A@@@A@@AA@@BA@@A@AA@@@BAA@BABA@@B@@@@CB@@@@@@AA@B@@AB@@@@@@@A@@@A@@@A@A@@@@@@@BA@@B@BAB@@@@CA@@@@CDA@@B@B@@AB@D@B@B@@AB@BA@@@@@A@@@A@AAA@@@A@@@AA@@@@AAEAA@@@A@@@AA@@A@@@@AA@@@@A@AA@@@@AC@@@@@@@A@@@AA@A@@AAAA@A@@A@AB@@C@@@@@@AA@@@@@@AA@@A@@@@AAAAA@@@@A@@A@@AA@@A@@@A@@@AA@@@@A@B@AA@@@@@@@A@@@A@@@@@@A@@AA@@@AA@@@A@@AAAA@C@A@@@ABA@A@A@AAA@@@C@@@@AA@@@A@@@@@AA@BA@@A@B@@A@AA@@A@@@@@@BBB@@@@A@AAA@@A@@@B@@BB@@@@A@A@@@@@@@A@@@A@A@A@@@@AAB@@A@@AA@@@@@@@ACB@@@@@AA@B@@@A@A@@@BA@@@A@A@@@@A@A@AAA@@@A@@A@@@@@A@@@@@AA@@ABAB@@@@@@B@@BA@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@AA@@AADA@@BAB@@@@@@AC@@@@CB@BA@AB@AAA@@@@@A@AA@@@@@@@A@C@@@A@@@A@A@A@AA@BA@@AAA@A@@@A@@A@@@@@@BBB@BA@@@A@@@A@@B@AA@A@@@AA@@A@@@@A@@@@A@@AA@A@A@@A@@A@A@A@AB@@A@A@@@@@A@@A@@A@@A@@A@A@@A@@A@@@@@A@@@AA@@A@@@@@@@A@@@B@@AA@@@@A@@@@@@@@A@@A@@@AACA@AAA@@AAA@@@AA@@AAA@@@AA@@@@A@@@A@@@@FA@B@@@@@@@AB@@@@B@@B@@BB@@@@@B@@A@@BBB@@@@@DA@@@@@@A@@A@@B@@@B@@@@@A@@A@@A@A@@@A@@@CBA@A@A@C@@@A@@@A@@A@A@@@AB@BA@@@@@AA@@A@@AA@AA@@@@@AA@AA@@@@AAA@@@AAA@A@@AA@@@A@@@A@@@@AA@@A@@AA@@A@@AA@@A@@@@BA@AB@@@@@A@@AA@A@@C@@@A@A@@@A@AB@@ABABCAAAAA@@C@@@@B@@A@@@A@A@AB@@A@A@@BA@@@@@A@@@A@@@A@@A@@A@@@A@@@@@@ABA@AA@@B@@A@@@@@@@AA@@AA@@@@@BAA@@@@@AA@@AA@@@@BA@A@@@@@A@@@A@AA@@@@@A@@@A@@@@A@AB@@A@@@AACA@@@@@@B@@@@AA@@AB@@@@@@A@AAA@@@A@B@@@AB@@@@@@@A@@@BA@@@@B@@@B@@@@A@@@A@@@@@A@AB@@AB@@@BA@@@AAA@@@@AA@@@@BA@@BABA@@@@@AB@@@@@AA@@@A@@@@AA@@@A@A@A@@@@BAAA@A@C@@@AA@BA@@A@@A@@@@@A@@@A@@A@@@AA@@@@AAA@@A@@@AA@A@@AB@@@@AA@@@@AB@@@@AA@@A@@@@@A@AA@@@A@@AA@@A@AA@ACA@@@@AA@@@AA@@A@@@@@A@@@@@@@@B@BA@@@@@@A@A@@@@@@AB@BA@@@@@A@@@@A@@@@@@@@A@@@BBA@@@@A@B@@A@@@B@@B@@A@@D@@A@@@@B@@@BA@@@@B@@@B@@@B@@A@AA@@@@@AB@@C@A@@@@C@A@A@@@@@@A@@@AB@B@B@@A@@AA@@@@B@D@@@@@@@A@A@C@@AB@@@BA@@B@@ABAA@BA@AA@@AA@A@@@@A@A@@@@@@@@ABA@@@A@@@A@@@AA@A@@A@AA@AA@@@C@A@@@AA@@AC@@AA@@@A@@@@AAAAA@A@@@A@@@A@A@@@A@@@@A@@A@@@AA@@AAAA@A@A@@@A@A@ABA@A@@@A@@B@B@@A@@@A@ABAB@@@BA@@B@@A@@@@BA@@@@@@AA@@A@@@@@B@B@@@@A@@B@@B@@B@BA@@B@B@@@B@B@@@@A@@@A@@B@@@@@@@@A@@@@@@@@@A@@B@@AAB@@@AB@@@@@@@@B@@@@@@@B@B@@@B@@BA@BB@@AB@@@BA@@AA@@AA@A@A@@BAAA@A@@@ABB@@@@@@BA@ACAAA@@@@AA@@@A@A@@BA@@BA@@@@B@@ABADA@@@B@AB@BAB@@@B@AA@C@@ACAA@@@A@A@@@AB@@AB@BA@@BA@@BA@@@@BDD@@@@A@A@@BA@A@@@@@A@A@A@@@AB@@@@A@A@A@@@@A@@@AAA@A@@@@@@A@A@BACA@@@@@@@@@@AA@@A@A@@A@AAA@A@ABCB@@AAA@C@C@@@A@@@@@@BA@A@A@AB@@@@AB@@ABA@A@AA@@A@A@@A@@A@ACA@AA@ACA@@AA@@AB@@@@@AA@A@CAAA@@A@AA@@@@A@@BA@@@A@@@ABCAA@@@AB@AA@@@@@@AAABA@@@@AB@@AA@@A@@AA@@B@@ABA@@A@@AA@A@@@@@@@@@B@@@@@@C@@@@@@@@@@B@@@@@@@@@AA@@B@@B@@@BB@@A@A@@@@@B@@@@@@B@@A@@@@@@@@B@@A@@@@B@@A@@A@@@A@@@A@@@@B@@@A@@@@@@A@@@@AB@B@@@@A@@@@@@A@@BA@@@@@@A@@@@@@@@@@B@@@@@@A@@A@@A@A@@@@@@BA@@@@@@B@B@@@@AA@A@@A@@B@@@@@@@DBBA@@@@@@A@@@@@@@@@@@BA@@@@A@@@@@A@@@BA@@@@@@A@@@@@@ABB@@@B@@B@@@@B@@@@B@@@@@@A@@AA@@@A@@A@@@A@@A@@@@@A@A@@BA@@@@B@B@@@@@@@A@@@@A@@@@@@@@@@@@@@BB@@@AB@@A@@AB@@@A@@@@A@B@@@@A@AB@@@B@BA@BBA@@@@@@@@A@A@@@A@@@@@B@@@@A@@@@A@@@@@B@@@A@@@@A@@B@@A@@@BAA@B@@A@A@@@@@AA@A@A@AB@@@@A@BAA@@@@BA@@@A@@A@@A@A@@@@@@A@A@@@@@@@@A@@A@BAA@@@@@@@@@@@@@A@@@@@@ABAB@BA@@@@@B@@@@B@@A@AA@@@@@@@BA@@@@A@@AB@@CB@@BB@@@@@@@@@@@B@@@@AA@@@BA@@A@@@D@@@@AA@@@@@@@@@@@@@BA@A@@@@AAAABA@A@@@B@@@@@B@@@@B@@A@@@@B@@@@A@@A@@A@@A@@AA@@A@@@A@@@C@@@@AAA@@@@@@A@@@A@@AA@@A@ADA@A@A@@@C@AA@@A@@@A@@AAA@@@@AA@@A@AAAA@@@@AA@@@A@A@@@@AA@@@A@@@A@A@@@@@AB@B@@@@AB@@@A@A@A@@@@@@@BA@@A@A@@@@AA@AAAB@@@BAB@@A@@@@ABA@@@@@@@"],encodeOffsets:[[118147,26713]]}},{type:"Feature",id:"360733",properties:{name:"会昌县",cp:[115.786056,25.600272],childNum:1},geometry:{type:"Polygon",coordinates:["@@@C@@AA@AAA@A@@BA@AA@@A@@@C@@@A@AAAAAAA@@BA@A@@BA@A@@B@@@BA@@@@@AB@B@@@B@@A@@@A@@@A@A@@@AA@A@A@@@CB@@AAA@@@AAA@A@@@AA@@BA@C@@@AA@AA@@@A@AAA@@@A@A@AA@@@@A@@@ACA@CA@@A@@@@A@@@A@@@@@@BABB@A@@@AB@@A@@B@@@A@@@@@@@C@A@@@A@@A@A@A@@@@AA@BA@A@@@A@A@AA@@@A@A@@AAA@@A@A@@AA@A@A@AA@@@@@BAB@B@@@@A@@AA@@@C@@@A@AAA@@AA@@@@@A@@@@@@@BA@@@@@A@@@A@AB@@@@A@@B@BADA@@B@BA@@AA@A@@@CAC@@@ACA@@AA@@@A@@@@A@A@A@@@A@@AB@@A@@@@@A@@A@@A@AA@@A@@@@@@@@@AA@@@@@@@A@@AB@BB@A@@@@@A@@@ABB@@@@BB@@B@BAB@@A@@@@@A@@@@@@@A@@@@@@A@@@A@@B@A@@A@@@BA@@@AB@BBBA@@@@@@@A@@@A@@@C@@@A@@@A@@@@@C@@AA@@C@ABA@AA@@@@@A@A@@AAA@AA@@@@@@@A@@A@AAA@@A@@@@@AA@A@@AA@@@@@A@@@AA@@@@@@AA@C@@ACA@BA@A@@BA@@@@@@@A@@@@BA@@@A@@@@@@B@@@@@@@B@@@@@@@@A@@@@@@@@@AA@@@@@@A@@@@B@@@@@@A@@@@@@@@@@@A@@BA@@@AB@@@@@@A@@@@@@@@A@@@@@C@A@A@@@A@@@@@@@A@@@@@@@AB@@@@@@@B@@@@AB@@@@@@@BA@@@@@@@A@@@@@@@A@@@@@A@@@AB@@@@@@@A@@A@@@@@@@ABA@@@A@@@@@C@@@@@A@@@@@@@@@A@@@@@@A@@A@@@@@@@@@A@@BA@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@B@@@@@@@@A@@@@@@@@@AB@@@@@@@@A@@@@@@@AAA@@@@@A@@A@@@@@@@@@@@@@@@A@@@@@@@@AA@@@@@A@@@@@@@@@@@A@@@A@@@@@AB@@@@@@@@@@@@@@@@A@@@@@@@@@@BA@@@@@@@@@@@AB@BA@@B@@A@@@A@@BAB@@@@@BA@@@@@@@@BB@@B@@@@A@@BA@B@@D@@@@@AA@@@@@A@@D@@@@@B@B@DAB@@C@AB@A@@A@@AAAAA@BC@@B@@A@@@A@@@@@AD@B@B@B@@A@@BBB@BB@AB@BB@@BA@@B@B@BABB@@D@B@@@@@@B@@@BB@DB@@B@D@BB@@D@@@BBB@B@DAB@B@@BB@B@D@B@@@BB@@D@B@BABAB@D@D@@@@@D@@BB@@BDB@B@D@B@@BB@B@@@B@D@BA@ABABABA@ABA@@@A@ED@@@B@BAB@BB@BBB@J@BAB@D@DA@AB@BA@AB@B@B@@@BBBD@@@@AD@@AD@BB@@@BBB@B@B@BA@@@A@@B@@A@@@@BA@@@@B@B@@@@BB@BAB@@@@@DB@AB@@A@@B@@A@@BAAA@@BA@@@A@@@@BA@@BABADAB@BB@@B@B@@@B@@ABA@@@@B@@ABBB@BA@@D@@@B@@@@@B@@@@ADA@@B@@@BA@@@AA@@@@@@A@A@@@A@A@A@@AA@@@AAA@C@CB@BA@ABAB@B@@@BA@@BCBABA@@@@B@@@BA@@B@B@BABAB@@@@@@A@A@A@@@AB@@AB@@A@A@A@@BA@@B@BCBA@AB@@@@C@A@A@@BAB@B@DCBB@AB@@A@@@A@@@@@@A@@AA@@A@A@@B@@@@C@@D@B@F@@@BAB@@AAA@@@@@@@A@@@A@@@@AA@A@AA@A@@@@@AA@@BA@C@@AA@@@@BABA@@B@B@@@B@@@BA@@BB@@DB@@BB@@B@@@@AB@@@B@@@@@B@@@@B@B@@@@B@DDB@@@@B@B@B@D@B@@@BB@@@BD@@@B@B@@@BADB@BB@@@@@BBB@@BB@@D@@@@BBB@@A@@B@@B@@B@@@B@@@@B@BA@@DB@A@@@B@@BB@@@AB@B@B@@@B@@@@AB@B@@@@@BA@@@BBA@@BB@@B@@A@@B@@@B@@@@@B@@BB@@A@@BB@@B@@BB@@@B@@BBB@@@D@BBB@@B@@@@BB@@@BB@B@BBBB@@@@BA@@@@BAB@@ABB@@BB@@B@@BAB@@@@@BB@@B@BB@@BA@@B@BAB@@BB@BB@@@B@BAB@@A@AB@@D@B@@@B@@@BBB@@@B@@BB@@B@BB@@BBB@@D@@B@@@B@@@@B@B@@AAC@A@@@@B@@A@@B@@@AC@C@@@@@@B@B@B@@@BB@@@@@@@A@@BA@@B@DB@@B@@@A@AA@A@@@@@@B@@@@A@@@A@A@@CACA@@A@@A@A@@AA@@@@B@@ABAA@@AA@@@AA@BAAA@@@@@@ADA@@A@AA@A@A@@B@@@B@B@B@@@@A@@AA@@BAB@@@@AA@@AAA@@@AAA@@@@@C@@B@@AB@@@@@@CB@@A@@@@@AA@@A@ABA@ABE@@BC@A@@@@@@B@@@B@B@B@@@B@B@@@@@@@AA@@@AA@AACAAAA@CAA@@AAA@@B@BA@@B@BA@A@@B@BBD@@@@BD@BBD@@@@@BB@@DB@B@ABB@@B@BA@@B@BBDAD@F@B@@@D@@@B@@@B@B@B@D@@@F@D@@@BA@@@@B@B@B@B@@@B@@A@@@A@@AAAABA@AB@@@AACAA@@@@@@A@@@@B@DAB@B@@@@A@@BA@@B@BA@BB@@@B@BA@@B@DAB@BA@@D@B@BAB@AA@AAEAC@CAA@C@C@A@C@C@CBC@C@CAC@@BCBC@@KGOAEAGACKAGEGCCCA@@AA@A@ABAB@BA@@AC@CAKBA@CDCDAB@BBDDFB@@BAAACGCEAEAG@GDGFI@G@@@AFCBABABCAC@CAC@C@@@CBC@GCCAACCA@AAEAC@CBE@CCAA@GBCBCBGBABEBC@ACEAACAACMMACCE@GBCBCFGFIAGAEEACAA@@AAA@A@A@@HAJADEFG@KACAAEA@CB@HAFC@CAECC@AACAE@AAEACA@@CCACCAA@AGC@@EEAAA@@@ABAH@@A@@BA@AB@@AB@@C@A@@@A@AAAA@@@A@A@C@EAACACA@@@@A@E@A@ABEFA@ABA@GDA@C@CACEAA@C@A@ABG@ADC@CBE@A@CAC@CCCCAAC@ECACCAAAC@AAC@CAA@@ACAAAAAA@@ACAC@CCACAC@CACAAAA@AAEDCBE@C@E@@@CACACCACCCACCAACAAAACACAABECIA@@CBCHGDEBAAAECEECECCC@EBG@EBI@AAC@EBC@CAC@ABC@CEAAAA@@@@@@@ABA@@BA@@@A@@@A@A@ABA@@@@@@B@@BB@@C@A@@@AB@@A@@@A@A@A@A@@BAA@@A@A@A@AA@@C@A@@@@@CB@@@A@@BA@@@@@A@A@A@AA@@A@@B@@@BA@@@@B@B@B@@@B@@@B@B@@BBAB@@@@BBAB@@@@AB@@AB@@@@@BA@A@@@@A@@@AA@@BA@A@@AAAA@@AAA@@@AA@@AAC@@@@B@@@BA@@@AB@@A@@@@@A@@B@@A@A@@@A@@@AA@@A@@@A@A@@@@A@@AA@@@@ABA@@A@@@@@AA@@@@A@@@@A@@@@@A@@@A@@@A@CA@@@@A@A@@AA@@@@@@@A@@BA@@@AB@@A@A@@@A@A@@@AB@@AA@@@AA@@B@@@@A@@@@A@@@@@@A@@@@@AB@@A@@@AB@AAB@@@@@@C@@@A@@@A@BAAA@@A@@@@B@@@@@BA@@@@@@@@@CA@AA@@A@CA@@@@@A@@@AAAAA@@@BA@@BAA@@A@A@@CB@B@@@@A@@@A@@@@BAAC@A@@@AB@@@B@@A@A@@@A@ABA@@@@BA@A@@BB@@BB@@@BB@@B@@B@@@@B@@@B@B@@@@BBB@@@@@@AB@@B@@@BBB@@B@@@BAB@@A@@@BB@@B@@BB@@@@@ABA@@B@@@@AB@@ABA@AA@@@@AB@@@@@B@BA@ABAB@@@@A@@@@@A@@@@@A@AA@@AA@A@@BA@@@A@@@AB@@@@@B@@@@A@@@A@@CAAAA@@AA@@@A@@A@A@@@@@AAA@@AAAA@@@@@@@A@@@@B@@@@AB@@A@@@@@@A@AAAB@@AA@@@@@AA@@@A@AAA@@@ACA@@@AC@@AA@@ABA@@AAC@@@A@@@A@@B@@A@A@@@A@@@@A@AA@BA@@@A@@AAA@@@AA@AA@C@@A@@B@@A@@A@@A@A@@BAAEA@@@A@@A@@CA@@ACAA@A@@@A@@@A@@@@@AB@@@A@BA@@@A@@@A@@@@@@B@@ABA@@@A@A@A@A@BB@@A@@B@@@@@BA@@B@@@@BB@@B@@BB@B@@BB@@B@@@@B@BBB@@@BB@@@BA@@@@@A@@@AB@@A@@B@@@@AB@@A@@B@@A@@BA@A@@@A@@@A@@B@@A@@@A@A@A@@BC@@@@AA@AA@@@@AA@@@@A@@@ABCB@@@B@@@@BB@@@BB@@B@BB@B@@@BBB@@@@B@@AB@@@B@B@B@B@@A@AB@@A@A@@@BAA@@@A@A@@@A@@@A@@BA@@B@@AAA@@BA@@B@@@@A@AB@A@@@A@A@@AA@@@AAA@AAA@@@B@@A@@A@@A@A@@@@@@A@@@A@@@@A@@A@@ABA@A@@B@@@@AA@@A@A@@@@B@@@@@@BB@@@B@@@@@B@D@@@@@B@BA@@B@@@@@@@BA@A@@@@@@BBB@B@@A@@@A@@B@@@BAB@@@B@@@@A@BB@@@B@@D@@@ABADAB@@AB@@A@@BB@@@BBBBB@BB@@@@@DBB@@@@@BA@A@@@@@A@@@A@@@AB@BA@@BAAAB@@AB@@B@@B@B@BB@@@@@@@BBBD@@@BB@@@@BB@@@@BB@@@@DBB@@BB@@AB@@A@AB@@@@BB@@@@@BAB@@@@@@A@@@@@@B@@BB@BBD@@BB@@BB@B@@@BAB@@@@BBB@B@@@@@@BB@@@B@@BB@A@@B@@@@@@@BA@@@@BA@@@@@@@@B@@@B@@@B@@BB@@@@@@BB@@@@@@@BB@@@@B@@AB@@@@AD@@@BAB@@AB@@@@A@AA@@A@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@A@@@@@@@@AA@@@@BA@@@@@@@@@@@AB@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@A@@@@@@A@@@@A@@@A@@@@AA@@@@@@@@@@@@@A@@@@A@@A@@@AA@@@@A@@@@A@@A@@@@@A@@A@@@@AA@A@@@@A@@@@@@@@A@@@@@@@@A@@A@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@AA@@@@@@@@@@@A@@@@@A@@@@@A@@@@@@@@AA@@@A@@AA@@@@A@@AA@@@A@@@@AA@@@@A@@A@@@@@@@A@@@@@@@@@A@@A@@A@A@@@@@@@@@A@@@@@@A@@@@A@@@AA@@@@@A@@@@@A@@A@@@@@@@@@@@ABA@@@@@@@@@@A@A@A@@BA@@B@@AB@AA@@@AA@@@@A@A@@@@@@@C@A@@@A@A@@@@@@@A@@@@@A@@@@AAA@@A@AA@@@@@@A@@@AAA@@@AA@@@@@@@AA@@@@@@@AA@@B@@@A@@@A@@@@C@@@A@@@A@@@@B@@@@@@@@@A@@@@AAA@@@@@A@@@@@@A@@AA@@A@@@AA@@@@A@@A@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@AA@@A@@@@@@@A@@@@@AA@@AAAA@@@AA@@@A@@BAB@B@@A@@@@@A@C@A@@@AAA@@@AA@@A@@A@@BAABAA@BA@@B@@@@AB@@ABA@A@@@A@CAA@C@AA@@@AB@@@AAB@@@@A@@A@@@@BA@@BA@@@@B@@A@@@@@@@@@@@A@CB@B@@@B@@@@@BB@@@@@B@@B@@B@A@B@@B@@@@BB@@@@@@B@@@BB@@B@@BB@@B@@AB@@@@AB@@A@@@A@A@@@@@A@@@@A@@@@A@@@@@@@@@@@A@@@@@@@@@@@@AA@@@@@@@A@@B@@@@@@AA@@@@@@A@@@@B@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@B@@@@@B@@A@@@@@@B@@@@AB@@@@A@ABA@AB@@@BA@@@@@@B@@A@@@@B@@ABA@@@AB@@@B@@A@@B@@BBB@B@BB@@ABE@CBCD@@@B@@@BBBBB@@BB@@@B@@@B@@@BB@@@@BADAB@@A@A@@@AB@@@@AD@@@BB@@@@B@BB@@BB@@B@@A@@@A@@BAA@BA@A@A@@@@@BBB@@B@BB@@@B@@@B@@B@@BB@B@@@@@B@@@B@@@B@B@@@D@@@@@@@@@@@@B@@@@B@@B@@@@B@@@@B@@@@@@@@B@@B@@@@@AB@@@@@B@@@B@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@B@@B@@@@B@@@B@@@@@@@@@BB@@@@A@@@@@@B@@@@@@@@@@@B@@@B@@@BB@@@@BB@@@@@@@B@@@@@@@D@@@@@@@B@@@@@@@@@@@B@@@@@@B@@@@@@@@B@@B@@@@B@@@BB@@@@@@B@@@@@@B@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@B@B@@@@@@@B@@B@@B@@@@@@BBB@@B@@@@B@B@B@BA@@BBB@DB@@BBBBBB@BAB@@ABA@@BA@@@BBAD@BBB@B@BB@BA@@B@@@@@@@B@@@@@@B@@@A@@B@@B@@@B@BBBB@@BA@AB@@@B@@CBB@@D@@AD@@C@ABAB@@A@@@BBB@@@@BBB@@A@@BA@A@AAABAAABA@@@@@BB@@@D@B@BA@A@@BA@@@A@@BABAB@B@@@@@B@DBB@B@@B@@@@@B@@B@@DFB@BB@@B@@BBA@@B@@@B@@BD@@@B@B@B@B@@@BB@BB@@BBB@B@BB@B@BD@@B@BBDBBBB@BABB@@BBDB@B@B@B@@BD@@@B@B@B@B@B@@AB@B@BA@@B@D@B@@BB@@@@DDBB@B@@@@@B@@@B@@@DA@@BB@@@@BBB@@BB@@@@B@DA@@D@B@@@BADBB@@@B@B@B@@@@@@@B@@@BBB@@@B@BB@@@@B@@@B@@BB@@@@B@@BDBD@@@@B@@BBBBB@@@B@B@B@@@B@@@B@B@BBBA@@BA@@@BB@B@@B@@B@D@@@@@@@B@B@@@@A@BB@@@B@@@B@@BB@@@B@@AB@@BB@@@D@BB@@B@B@@@@AB@@A@@B@BB@@@B@@@@BB@A@@B@@@B@B@B@B@B@@@B@@@@@@A@@@A@@B@@A@@@A@@@A@@@@B@@@@A@A@@@@BAB@@A@@@@AA@@@@AA@@@A@@@A@A@AB@@DB@BB@@B@@@B@@@@AA@BAA@@A@AB@@@B@@BB@@@@BA@BB@@@@@@@@@@B@@@@@B@@@B@@@@AB@@B@@@BB@BB@BBB@@B@@@BB@@BA@@@@@BB@@AB@@@@@BBB@@@D@B@B@BAB@@@@@A@AAA@A@@A@@AB@@@@@A@@@@A@@AB@AA@@@A@@B@BA@@@@@A@@AA@@@@AA@AAA@@AAAA@@@A@@AAAAA@@@@A@A@A@@@AB@@C@@B@@@B@@@@AB@@@@AAAB@@AB@@@BA@@@A@@@AB@B@@@BA@AB@@@@AA@@AAA@@@@@@AB@@@BABA@A@@@A@@@@A@@A@@@@BAB@@@@@A@A@A@@@@@A@@AA@@@@@@AA@@@A@A@A@A@@@AAA@A@A@A@@AA@@@@@ABA@@@AB@@AA@@A@A@@BABA@@@AA@@@@A@@@@B@@AB@BA@BB@@@B@@@B@@BB@@@@@B@BA@@B@B@@B@@@BB@@@@AB@@AB@@@B@@B@@@B@@@@B@@B@BB@BB@@DB@@B@@@@AB@@B@B@@D@@ABC@@@AB@BA@@BA@A@@B@@A@AD@@@@A@@B@@AB@@@@@B@@A@A@A@@@A@@@@@AB@@A@@@@AA@AAA@@@@BAB@@@B@B@@@B@@@B@@B@@@@B@@BB@B@@@B@B@B@@A@@@A@A@@@ABA@@@@@A@A@A@A@@AA@C@@@@B@@@@@BB@@B@BB@@B@@BB@@@@B@@BD@@BB@@@BB@@@B@D@@@@@B@@@@B@@@@@B@@@@AA@@@B@@@BAB@@@@@B@A@@B@@B@@@A@@B@@A@@@A@@@BBFD@@@@ABB@@BB@@B@@@B@@@BAD@@@BA@BDB@ABA@AB@@@BA@@B@B@@A@AB@@E@@@@BA@@@AA@AAA@@C@@AA@@@A@@@A@CD@@B@@@@B@B@BA@AD@BA@@@@@AA@@@@@@@AC@@@@@@BADAB@B@@C@AB@@@@ABB@@@B@@@BB@@@@@@BB@@@BA@A@A@AB@@C@A@@B@@@@@B@@BBB@@@@@BB@@@BB@@@@ADA@@@@B@@BB@FH@@B@BA@@@B@@CBA@@@A@AB@B@@A@@B@@CB@@@@A@@A@@@@A@@@@BA@A@@@@BC@A@A@A@@@AA@@@A@A@@C@@B@@@@@B@B@@A@A@@B@BABA@CB@@@BAA@@@@A@AA@@AA@@@@@AAA@@@A@@@@A@A@@@@A@@A@A@A@@B@AA@A@@@AB@@A@@@A@@@@BA@@D@@A@@@ABABAB@B@D@B@B@BB@AB@@@B@@@@@BA@ADB@AB@@DD@@BB@@@D@@AB@@@@AB@B@@A@@AAB@@AB@@AB@@A@CA@B@@C@A@AA@@AB@@@@@@CB@@AAAB@@@@A@@@@@C@@@@@@B@@@B@BBAB@@@@@@B@@@@A@ABAA@BB@@@BABB@@@@BABB@@@@@B@@@BA@B@@@@@B@@@@@@@BA@B@@@@@@@@B@@@B@@@B@@B@@@@D@@@@BB@@@BA@@@@@@BB@@@@B@@@@@@@A@@B@@AD@BB@@BB@@@@B@@@B@@BAB@@@@B@B@B@@AB@@@B@B@@BD@@@B@@@BADBF@@@BB@@@B@BADA@@@@@B@BA@AB@@ABADAB@BAB@B@BB@B@@BD@@@@@@@@DA@AD@B@@A@A@@@@BA@@B@B@BB@@BB@BB@B@DD@@BBB@@@B@@B@@B@@B@BBB@DB@@BB@@B@@@BBBAB@B@BAFE@@FAD@B@B@D@@@@A@@@A@@@AB@DA@@B@BBB@@A@@B@@B@@@@@@B@@A@@@B@@@@@BA@@B@@@B@B@@@@@@B@@A@@BBBB@@B@@B@@@BB@BB@B@@BABC@A@@B@@@BA@@B@@@B@BBDBB@B@BA@A@@@AA@@A@@@@BADA@A@@@A@@@AB@@ABABAB@@@@A@A@@BABA@@B@B@@@@@@BB@@B@@DDB@BBDBBB@@@BB@@AB@@@@A@@AA@A@A@@B@B@B@@@B@@@B@BB@@@@B@BD@@B@B@B@B@@@BA@@@@@A@@@@BB@@BB@@@B@@@@@BBB@B@B@@@BA@@@B@@@B@@@@B@@DB@@AB@B@B@B@B@D@BBD@BABAB@B@@@B@@@B@B@B@@BDB@@DBB@@B@@DBB@BABABAB@@@DA@@B@@AB@@@@E@AB@@A@AA@BA@@BAB@@@BA@AB@@@@@BB@@B@@@BAD@F@BB@@@@AB@@@BAB@@B@@BA@BBABB@BB@@D@BB@BB@@@@B@@@@@BB@BB@@AB@@@B@BB@BB@@@@D@@@B@@ABAB@BAB@BCB@@A@@@A@A@AB@A@@@@@AA@A@@BAB@@@B@@AB@@A@@@@B@@A@@B@@@BAB@BAD@BB@@@@@BBB@B@B@B@D@B@D@@@DAB@@@BB@@BB@BBBAB@@AB@BABAB@B@B@B@B@@@B@@@@@B@@@B@@@@B@AB@@@A@@A@@@BB@@@@AB@@@@@B@@@@AAA@@@@AAA@@@B@@@A@@BA@@@A@@A@@@AB@@@DBB@BA@AD@@@@B@BBDAB@B@DB@@@@AB@@@@A@AB@@A@A@@BCB@B@@BB@@F@@BB@@@@B@@@BA@@@A@@@@B@B@@A@@A@@AB@BBB@B@@@B@@@@B@@@@@B@BBADAD@BB@@B@B@BBB@@@@@B@BBB@@@BA@CD@@@BAD@@BB@@@B@B@B@B@B@@@B@@@@AB@B@@BBA@@B@@A@@@@@BB@B@@@@A@A@A@A@@@AA@B@@@BA@@AA@AA@@AB@@C@A@@@CAA@@B@@@@@BAA@@AB@@@BA@@B@B@BAD@@AB@@@BAB@@@@A@C@A@C@A@@AA@AB@@AB@@@B@B@@@BA@@@ADABABAB@B@@@B@@@B@@@BBB@@@DBB@@B@@@BB@@@@@B@@BDBB@BB@B@@@@@@B@@@BB@@B@@BD@@AB@@BB@@@BB@@B@@@B@@@B@@BB@B@BBB@BBBAB@BAB@BAB@BA@@BAD@@@@A@@BCB@B@@@@@@B@@@B@B@@BB@@BB@@B@@@@A@@@@@@@BB@@@@@B@AA@@B@@@@@@@@A@@@@B@@A@A@AA@@@B@@D@D@B@B@B@@@@B@@@@@B@@@@B@@@@B@BA@@@AA@@A@C@A@@@@@BBB@@B@@@@A@@@@AA@AB@@AA@@@B@@@@@B@@B@BBA@@@A@A@@@AB@@DB@@@@@@C@@@@@BB@@BB@@@@@@@@B@ABBB@@@@C@A@@BBB@@@B@@CB@@B@BB@@B@B@B@@@B@B@BA@@D@B@B@BB@@@BBB@@B@B@@@BBB@B@B@@BB@@@@@@@@BDD@B@BB@@BDD@@B@@@@@BAB@@@BBB@BDB@@@B@@@@A@@B@@@B@BAD@@@@@@AC@@@@@@@BC@@B@@@@@B@@A@@BB@@BD@@AB@B@@@BCDAB@D@@@@B@@B@@@@@@BB@@B@B@@@@@B@@@@@BB@@@B@BB@@@B@DA@@BC@@BA@@B@@@B@B@BBB@@@B@B@B@@@B@@FB@@B@B@@B@@A@@B@@@@@@B@@BA@@@BB@BB@B@B@B@@@@B@BBB@@@@@B@B@@@B@B@@@B@DB@@@@@@DB@AB@D@@@BCB@BA@@@@D@BAB@@AB@@@BBB@@AB@@@BA@@@A@@@@@A@A@A@@@A@@BA@@@ABA@@@A@@AA@@BA@A@@AAAA@@AAA@@A@@FA@@@A@@@A@C@@@A@@@AA@AAA@@@@@A@@@A@@A@A@@@AB@@AAA@AAC@A@A@@@A@@BAB@@BB@@@@BB@B@B@@@@B@@B@@@@@B@@@D@@@DABA@@@AAAA@@@A@@A@@AAA@A@@@@C@@@C@@AA@AA@B@@@@A@A@@@@AA@@@@BA@@@A@@B@@ABB@@BABA@@B@@ABA@A@@@A@A@A@@@A@A@@@@@@B@@B@@BBAB@@@@@@B@@@@@@@@A@@BB@@@@@@AA@@B@@@@@@A@AAA@@B@@AA@@@@@@@@@BAB@@AB@@@BA@@@@BA@@@@A@@A@@BA@@@@@@B@@@BA@@AABC@@@@@AB@BB@A@@@@@A@@@A@@@@BAB@@@BB@@D@DA@@BAB@@@B@@@@@@@B@@@B@@@@@B@BB@B@@BA@@@@@@B@B@@@DBBB@@@@BAB@@A@@B@@@@BBB@@@@DA@@BA@@@@@@B@BB@@BBB@DBD@B@@@@B@ABB@@@@@@BB@@B@B@B@@@@@@@B@@@BAB@@@B@@@@@BBB@@@@@BB@@@B@@@@@@B@@@AB@@AB@@@B@@@BBB@@@B@@B@A@@B@@@@@B@@AB@BA@@B@B@@@BC@AB@B@@@@B@@B@@@BBD@BAB@B@@ABABA@@B@@@@DB@@@@BB@@@BBB@@@BA@A@@@A@C@@A@@ABB@@@@B@@@@AB@@@BABA@@@A@@@EA@@@@@B@B@@@B@B@@AB@B@@@BB@@B@BB@@@BABA@@B@@@@@@B@@@@ABA@@@@@A@@B@@AA@@@@A@@@@@@B@B@@@BAB@@@@@B@B@B@@@@@BB@@@@B@@@BC@@@@@B@@@BBB@@@@@@B@@@B@BB@@@B@BAB@B@@@@B@@@@@@AB@@@BBB@@B@BB@@AB@B@@@@B@@B@@@@@@BB@@@@@B@B@@AB@@B@@@BB@@@@@@@@A@A@A@@@@@@B@@@@@B@@A@A@A@@@A@@@@@B@@@@B@D@B@@@@@BABA@@BA@@@@B@BA@@@ABAD@@A@A@@B@@AB@DAD@B@@A@@@AC@A@@@ABA@@AA@@A@@@@A@@C@@@A@@AA@@@@@@@@@A@A@@AA@A@AA@@AB@BA@@@A@@@AB@A@@A@A@AA@@C@A@@@@BBB@@@B@B@@@BDB@@@BB@@@@@D@BBB@BB@@B@@BDBB@@@@@@BBB@B@@@B@@@@B@BB@AB@@@B@DB@B@@B@@@DA@@A@@AB@@@@@@A@@B@@@@@BA@B@@BA@@@B@@@B@@@B@@@B@@A@A@@@BBB@@@B@B@@@B@@BA@@@@@@B@@DAB@B@BC@@B@B@B@@B@BBB@@B@@B@@BB@B@@B@BABB@@A@@@BB@@@@B@@AB@@BDBBB@@B@@BB@@BB@@@@@@@@@BAB@@@B@@@@BBBDB@@BA@@B@B@BA@B@@@@B@@@@@@@B@B@B@@@@@@BB@@@B@@@@@@B@@B@B@B@B@B@B@@@@B@@F@B@@@B@BBB@BA@@BB@@B@@BB@@B@@B@B@@A@@@AB@D@@BD@@B@@@BABB@AB@B@B@@B@@B@@@B@@@@@BB@@@BABA@B@@@@@AB@@@@@BB@@@@B@@@DBBA@@B@@@@@B@@@B@BB@@@@B@@AB@@@B@@BBABAB@@@@A@@@@B@@@B@@@BBB@@@B@@@@A@@B@B@B@@@B@@@BB@@@@B@B@@B@@@@B@@@BB@@@@B@DBBB@AB@B@@@B@@A@@@AB@@@@BBB@@BBB@@@@@@@@@B@@@B@@AB@@AAAAAB@@@@@B@@@@@@@@AB@@@@@B@B@B@BBB@BAB@@@B@@@@A@C@@@@@@B@@@B@B@@@B@BB@@@@B@@A@A@A@A@AB@B@@@B@@B@@B@@A@@CCAA@@@A@@BABA@@BA@@B@@ABA@@A@AA@A@@@@@A@@@@@ABA@@@@B@B@BAB@B@F@@AB@A@@A@@BA@@@A@@BBB@F@@@@@A@C@@@BB@@B@@@B@BB@@B@@@@A@@@A@A@AA@B@@A@@@A@@AA@@BA@CAA@@@AB@@@A@BA@@AA@@@@B@@@@@AA@@@@@@@@B@@@DBB@B@@@@AB@@A@@@A@A@@@@BA@@A@@A@@@A@AB@@@D@B@DAB@@AA@@A@@@@A@@@C@AA@@CB@@@A@@BA@@@@D@B@B@B@BBB@@@B@@@B@@@@@@B@B@@@@@@D@B@B@@B@@B@@@@@@A@@AA@@BABA@@B@@A@A@@BABA@@@@B@@AB@@A@@@@DAB@BA@@@AB@B@@@@@@AB@@@DA@B@@B@@@FCB@B@@@BAB@BA@@@A@@A@@A@@@A@CBA@@@ABAB@@@B@@@BA@@@AB@BBB@@@@@@@@ABA@@@@D@@@@@@@B@@@B@@@@@@@@B@@B@B@@@@@BAB@@@B@@@AA@@BA@C@@@@@@AAB@@A@@@@@@@AA@@ABA@@@@@@BABABABA@AA@@A@@A@@A@@@AA@@@@AA@@C@@@AA@CA@@B@@@@@BA@A@@DC@@@AA@A@@@AB@@@@@@C@AB@@@@A@@@@@@AA@@BA@@AA@C@@ABABC@@B@B@@@@@@C@@@ABABA@ABAB@@A@@CC@@@ABA@AAA@@AA@@@A@@@AB@@A@A@A@@AA@@@@A@@@CB@BABAD@BBB@@@BAB@@BB@@@D@BA@@B@B@D@@AB@@@@AAA@@@@@AA@@EBCB@BA@@@AAA@A@A@AAA@@@@BA@@@@@B@@@@@AB@@@B@@@B@B@B@@ABA@ABADADA@@B@@@@HF@@A@A@C@A@@@AB@@@@@@A@@BBB@B@@BAB@@B@B@@@@A@AAC@@@@@A@@DA@@@AB@@@@@@AB@@@@@A@@A@@@AB@@@@@@A@A@@@A@@@A@A@@@AB@@@@A@A@CB@BA@@@A@ABA@A@@BA@@B@@AB@@@@AA@AA@@@A@@A@@@AB@A@AAA@C@@@@A@AB@@@@@@A@@@@@AAC@@B@AA@@@A@@@@@@@@B@@@B@@A@A@@B@@@B@DA@@@B@B@@BB@@B@@A@A@@@@@@BA@@@A@@@@@A@@A@@@@A@@@@A@BAA@@@A@A@@@@A@@AABA@@A@@@@A@@B@@AAA@@@@@@@AB@@@B@@@@AB@@@@@@A@@@@A@AA@@A@@@@@@@@A@A@@BAA@@@@@@@AB@@@A@@@AB@@AB@BA@A@A@AA@D@@@@A@@@@@A@@AA@A@@BC@AB@@@@A@AACA@A@@@AAAA@@@C@@BAB@@@@@@ABAB@@A@@@@B@@@@@BA@@BBB@B@B@@@B@DBBB@B@@BD@@@B@B@@@@@@B@@@BA@@@ABCB@@ADAB@@@B@BA@@@@@AA@AAAAA@@A@@A@@@A@@@AA@@AA@AA@AAA@AAAAAA@AA@A@@@@AAA"],encodeOffsets:[[118618,26382]]}},{type:"Feature",id:"360734",properties:{name:"寻乌县",cp:[115.637933,24.969167],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@BABCFCFCF@BAD@DA@@DAFEBEACCCIGMGKAAAI@I@I@GEGCC@CB@BBFFJBF@@@@ABABAB@D@BBBBBBBBB@F@BCBE@A@C@ABA@@@A@CDABAD@DABABA@CBA@AB@BIDGBGBGBCBA@CACAAAAAAA@@ACAA@CAAA@AA@@A@ACACAA@C@A@C@A@CAAAA@AAA@AAA@A@C@C@A@CBA@A@EBC@C@CAAAAC@A@A@C@A@A@A@CDEFK@EAAEDCFCH@BACAAAA@@AA@AA@AAA@A@ABALOB@@A@AA@AAAAAAA@A@EBCBA@ACAE@CBE@GACAACCCAAA@A@@@A@A@CBC@C@C@AACACAAAAAACAAAAA@AA@@@@ABABAB@BABA@@@ABABABCBA@C@@HI@CACGEIGCA@@@AAA@A@A@A@A@A@A@@AA@@AAA@AA@@A@@AAAAAAA@@@A@C@C@A@C@C@ABCB@DABADADCDCFADC@ABA@ABAAA@A@AAA@AAC@A@@@AAA@AAA@A@@@@@AB@@@BAB@B@B@B@B@BABA@@BA@AB@@AB@@AB@B@BBD@B@@@B@@AB@B@@AB@B@@@B@B@@BB@B@HFDBDAHCBC@ECCCAA@BC@ABA@ABABA@A@@CAAAC@A@CCCA@ABCBC@AAACAA@CBE@C@@@A@@@@BA@A@@@ABI@CAECCCICK@AA@AB@DCFA@@B@BAB@@AB@B@L@D@BAB@@AB@@A@A@A@@@CBA@CBABA@@BABABA@CBA@A@A@CAC@CAC@AAA@A@A@A@@BA@@BAB@@@B@BB@@B@@@B@B@B@B@@ABABA@@BA@A@A@ABABAB@DABAB@@A@ABABABABABABABABABABA@A@ABA@AB@@AB@BBD@@CFGJGLG@@@ABABADABABA@C@AAC@A@ABA@ABABAB@B@BAB@BA@ABA@A@@@A@AAA@@AAA@@AAAA@A@@@A@@@ABA@A@@@A@A@@@KCMAG@CDA@CDG@EACAA@EBGDKDQASIQKCC@@IGMGQEK@C@A@AA@@AAA@A@A@A@A@A@A@A@AA@@AA@@A@A@C@@@A@ABA@@BA@@BA@@BC@ABA@C@C@A@@@C@C@A@ABA@ABA@AAC@A@A@A@A@A@A@@@A@@BABA@@B@B@B@@CBCHEJ@JDDNH@B@@@B@D@B@B@BAD@D@DADA@@BABA@@BA@@B@BAB@BAB@BABADADABABADABABA@ABA@A@C@EBA@A@CBA@AB@BABCBABCBABC@C@C@C@AACAA@AAC@ABA@ABAB@@@B@@AB@BAB@BABA@A@AB@@A@A@A@A@A@@@A@C@ABABABCBA@C@ABA@AAA@@@CEEGECGCC@E@E@O@IDEFAF@H@@@@@B@BABAB@DABADABABCB@BABABAD@@@B@BAB@B@B@@@B@B@@BB@B@D@D@@BF@DAB@BAB@BADAB@DAB@B@D@BBD@B@D@D@B@B@@AB@@@@A@CBCBEBCBABA@@BA@ABA@C@ABA@A@A@ABA@@B@B@BA@ABA@@@CBCBABABAB@B@@BBBDBFBDBD@DABCBABA@A@@@AAC@A@@@EAE@E@ABADAH@F@B@@A@CDGDEH@@BBBBDD@D@BEB@@C@GFABADGBEBAD@DCHAHCBCBABGDIBC@AAGAG@GBABCBCDCDC@ABCDIFIHEDEBUPKBKEOUGK@@A@ECGAGBABABABA@@@@@AB@BAB@@@@BB@@@@A@AB@A@@A@@@@BA@@BAD@@A@@@AAA@ABA@A@@A@AA@A@A@@@@@ABA@@@@AA@@@@@@B@@@BA@A@A@@@AB@@@@@@@B@@@B@@@BA@@B@@@BAB@@BBBB@@@BA@B@@@DD@@@@DB@@B@@@@@B@@@B@@BBA@@@@@@B@@@B@@@B@@@@@@BBBABB@@BB@@@B@B@@B@@@B@B@@BB@BBBBB@@@@@D@@A@@@B@B@@@B@@BBB@B@@B@BBB@B@B@B@BAD@@B@@@@BAB@BBB@BBBB@@@BB@@@@@AB@@@@BB@@A@@@B@@F@@A@@B@B@@@@BB@@@B@@@@@@@@@BB@@B@@@@AB@@@@@@@@A@@@A@A@@@@B@@@B@@BBB@@B@@BB@BB@@@@@@@@B@B@@@@@@@@A@A@@AA@AAAA@BAB@@@BA@@@A@E@A@AB@@A@AA@@AAAAAA@@@@@@AB@BAB@B@@BB@BB@ADA@@@@B@@@BA@@@ABABA@@@@B@B@@AAA@@@ABA@@A@@A@@A@@@A@@AB@A@@@@@@AA@@A@@A@@AAA@AA@@AA@@C@AA@@@@AA@@@@AB@@A@@@@@@@AAAAAA@A@A@@A@AA@@CBA@A@@A@BAB@B@@@BBB@@BDBB@@ABB@@B@B@@@B@@B@@BB@@@@@@B@B@BBBAB@@@BA@AA@@@@@DB@@B@@@@@@@@BBB@@BBB@@@@@@@@A@@B@@@B@@@B@B@@AA@BA@AA@@A@A@@@@@AB@@@@@@@AA@@@@@@@AAA@@@@@A@AB@@@B@@AB@BAB@B@@@@@@@BA@AB@@B@@BB@@B@@BBABA@@BBB@@@@@BBBFBBBDBB@B@BB@@@B@@B@@BBBBB@@D@@@B@B@@B@@@B@@@@FB@@@@B@DBDABBB@DB@BB@@D@BB@BBB@@@B@BABA@A@A@CB@@@@AB@B@D@B@@ADBBABA@@@AB@@@BBB@@@B@@AB@B@B@@@@BBAB@B@B@D@@@B@@@B@@@BBB@@BB@@@BB@@@@B@@@D@BAB@@@BAD@D@B@BAFABA@@B@BB@@BDDBBB@@@@BD@@BD@@@@@B@@@B@@B@BBD@@BB@@@@@BBB@B@@@B@@@B@@A@@B@@@@BB@@BB@B@@@@B@@@B@@BB@@AB@B@@B@BBD@DAB@HB@@BB@@D@DBB@BBB@@@B@B@D@@@B@@@BBB@DBB@B@BB@@@@@@AB@@@B@@@B@B@@ABAB@B@@@@@BEH@@@BA@@B@@@D@B@@@BD@@BBBABB@@B@B@@@BB@B@@@B@DAB@BA@@@AB@@A@@@DBB@BB@@B@@AB@DA@@BA@@@A@@@@@ABBB@BABAB@B@BBB@@BBB@FF@@B@@BAB@@@B@B@BBBCD@@@@B@@BF@@@BBB@B@BAB@@@B@@@BB@@B@@@B@@@@B@DBB@D@B@@BD@@@@@@@BB@BD@@D@D@FBDBB@@@@BB@@BAB@B@D@B@BA@@B@B@B@DA@CDA@BB@B@BBB@B@D@@BBDDBBB@BBB@BBBDBB@@@@@B@@@B@BDD@@BB@B@@BBBB@@@@A@A@A@ABC@@@C@I@A@@@AAC@A@A@A@ABA@@B@@@@@B@@@B@B@BAB@B@@AB@@@B@@AB@@A@@B@@BDB@AB@@@@@@AB@BABCDABBB@B@B@B@B@@@@@BB@@BDBB@BBB@B@DBD@B@B@@@B@BAB@B@@B@@@@AD@BAB@@ABAB@@@BA@@B@@BB@B@@@B@@B@BBB@@@CB@BA@@BAB@B@BBBB@B@DBBBD@@@B@BDB@@@D@@@B@@BBDB@@BBB@BABB@@@BB@@@BA@@D@B@@B@@B@B@B@@A@@B@@@B@B@@ABCBAB@@ABA@ABA@AB@@@@@B@@@BA@@@@B@@@B@@C@@BA@A@@@@@@D@@@@ABAB@@@@@BB@D@BB@@BB@@@B@@BB@B@B@@@BB@@B@@@B@@@BD@@B@@BBBB@@B@B@@@B@D@F@B@B@B@BBB@BDBBBBFD@B@@BB@B@@@B@B@@@@B@B@B@B@@BD@B@B@BA@@D@BA@BB@B@@@@A@@BA@@B@@@@B@@BBB@@@BB@@@D@@@BB@@@B@@B@BBBBB@B@@@D@@@B@@@B@BBDB@BF@@BBBBBBDBB@@@@BB@B@ABA@@B@@@B@@BBDBB@BB@@@B@B@@BB@BAB@BA@@B@@@BA@@B@DAD@B@B@@@B@@B@DBB@@BB@@BBBBBB@@@@B@BA@ABADAB@B@B@@ADAB@@AB@@@B@B@@@B@BA@@B@@@@AB@@BB@@@B@BB@DD@@@@ABA@@@@B@BAB@BA@@@@BBB@B@B@B@@BB@BD@BD@@@@@AB@@@@A@@BA@@B@BA@@@A@@B@@@@A@@@@B@@A@@BAB@BAB@@@@@BA@@@@@A@@@@B@@@@A@@@@@A@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@A@@B@@@@@@@BB@@@@@@@AB@@@@@@@B@@B@@@@@@@@@@B@@@@@@@@@@@B@@@@@@B@@B@@@@@B@B@@@B@@@BA@@@@BA@@@AA@@AA@@@AA@@A@@@@@@@AA@@@@@AA@B@A@@@@AA@@@@@A@@A@@@@@A@@@ADAB@@@@@@@@@@@B@@@@A@@B@@AB@@A@@B@@@@B@@A@BB@@A@@B@@BBD@B@DBB@@@B@B@BA@@BA@@@@@AB@@ABBBAAB@@@BB@@@BB@@B@BB@@B@D@B@@@@@B@@@@ABA@AB@@@B@@B@@BBBB@@BB@@@@B@@@@@@@B@@@BB@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@BB@@@@@BB@@B@@@BB@@@B@@@@B@@@@@B@BB@@@@@B@@@@@@@@@A@@B@@@B@@@D@@@@@@B@@@B@@@AB@@B@@@@@@BB@@@@@@B@@B@@BB@B@@@B@@@@B@@B@BB@BB@@@@@B@@@@@B@@@@@@@B@B@@@B@D@@@@@@@B@B@@B@@B@@BBA@@BA@@@AB@@@B@B@B@@@@@@@@B@BA@@@@@@@@@@B@@@@B@@@@@B@@@@BB@@B@@@@@@B@@@@B@@@@@@@@@B@B@@@@BB@@@@@@@@@B@@@@@@@B@@@@B@@B@@B@@B@@@B@@BB@@@@@BB@@@B@@BB@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@BB@@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@BB@@@@@@@@@@B@@@@@@B@@@@@@BBB@@@@@BB@@@@@B@@@@B@@B@@@@@BB@@B@@@@B@@B@@@@@@@@@@@@@B@@B@@B@@@B@@@@@@B@@@@B@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@BA@@@@@@@@@@B@@A@@B@@B@@@@@@B@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@B@@@BBB@@@@@BA@@BA@A@@BC@@@@BA@@@A@@A@@A@@@@@@AA@@@@@@AA@@@A@@@A@@@A@@@@@@B@@A@@B@@A@@@@@@@AB@A@@AA@@@A@@A@@@@A@A@AA@@@@BA@A@@@AAA@@AA@@AC@AAA@@@A@@@@B@@@@@@@BA@A@@@@AA@@@@BAB@@@BA@@AA@@AA@C@@A@@A@@A@@A@@A@@A@@ACAA@@@@@@A@@A@A@AA@@@BA@@BABB@AB@@ABA@@B@@@B@@@@@B@B@@A@@@@AA@C@@@@AAA@AAAA@@A@@AB@@@BA@@BABCBA@@C@@@@A@@AAB@@@@@@A@@BA@A@@@AB@@@B@@@@AAA@A@@@@B@B@@A@@@@@@@AB@@A@A@@@@@C@A@@@@@A@@AA@@@@@@@A@@B@B@@@BB@@@@@AB@B@BA@@@BB@@@@@@B@@@B@@@@B@B@@@@BB@@@@A@@BB@BBB@B@@BB@@@B@B@@@BBAB@@@@@@AB@@AB@BB@@@AB@@AB@@@B@@@B@B@@@B@AB@@B@B@@@BAB@@@@A@A@A@A@@BA@@@A@@A@AA@@A@A@@A@AA@@A@@AA@@@@@A@@DABA@@B@@@@@BB@@@@BBB@@B@@D@@AB@B@B@@@B@@@@AB@@@B@@@B@B@@AB@@@@AB@@@BA@@@@@AB@@@BA@@B@@@@@B@@A@@AA@@A@AAA@@@@@@AA@@AA@A@@AA@@@AA@@@@@AB@@A@@@@@AB@@@AAB@B@B@B@@@B@BA@@@A@@@@B@@@B@@@B@@A@B@@BA@@@@B@@@B@@@B@B@DB@BB@@DB@@@@B@@FBBB@AB@B@@@@BB@@@@AB@@@@DBBB@@B@@BB@BB@@@B@@ABBB@@@@@@B@@@B@BA@@@@B@@@B@@BD@BB@BA@@BB@@BD@@B@BD@@B@BBB@@@B@@B@@@@BB@@BABBB@@@@@@@@BA@@B@@A@@@@@@B@@@@@@BBBB@@BB@B@@@@@B@BB@@@B@@BB@BBDB@@@B@@@B@@A@@@@@A@@B@@@B@@AB@@@BBB@@BBB@@@@@B@@@@@B@@@@@BABAB@@A@A@@@@BA@@@@BBB@BA@@BA@@@@@AB@BA@@@@A@@AA@@@AA@@B@@@BA@A@@@AA@AA@@A@@@BA@@@@@@AA@A@@A@A@@@A@@@@@@AA@@@AA@@A@@AA@@AB@B@@A@@B@BAB@@@B@B@@@@A@@BA@@B@D@BB@A@@B@@@B@@@@@@ADA@@@B@BB@AB@@AB@@B@BBBB@@B@@@@@B@@D@BB@@BDB@@@@@@@@B@@A@@@@@A@@B@@@BBABB@@@B@@@D@@@@@@@BA@BBA@@B@@@BA@@@@B@@@@@@@@B@@B@@@@@@AB@@B@@BB@@BA@@B@B@@@B@B@@@BA@@B@@AB@@@@@@@B@@BB@B@@@@@DBB@@@B@@@B@@@@@B@@@@@@B@@B@@B@@@@@BB@BA@@@@BB@@@B@@B@B@@@B@@@BB@@B@@@B@B@@@@AB@@@@@B@@@BA@@B@@A@@@A@@D@BB@@BB@@B@BB@@BBBB@@@BAB@@BB@@B@@@@@@BAB@@@@A@@BA@@B@@A@AB@A@@A@AB@AA@A@@@A@@@A@A@A@@@@@AB@@A@@@@BB@@B@B@B@B@@@@AB@@@B@@DA@@@@B@D@@@BBB@B@B@@@BB@AB@B@B@B@@@B@@@BA@@B@D@@@AA@@@A@@@@B@BAB@B@@@B@@@B@@AB@BA@@@@BABEDADCBABA@CEAIAAABGBGBCD@FBB@BCDCHAHBHDD@@@DC@EBEDCFA@@BGBE@@BE@C@AAAACCA@@ECA@E@CB@AA@AA@A@A@C@@@A@CBCDEDABCDABADA@AEEAE@IBEBAD@F@D@N@LAHCFAJGDE@ABABADA@@@A@@AAC@AC@A@ABA@AAAA@@@AA@@@@@A@A@A@@B@@@B@@@B@B@B@@@@@@@B@@@@@B@@@@@B@@C@CBCBCDABAB@BA@@BC@@AA@@ACC@C@CEACCCCBABCDA@C@CAC@CAAAACAACBA@AAACAEEAC@@@AICEGBEBA@A@AACACAACBC@C@C@E@C@ABCBC@ADABCD@@C@@AA@AB@BCVAHADATSROHMFEBGAAEAC@A@A@GBKHEBCAECE@CBCF@HABA@ABABA@A@@@AB@B@@AB@B@@A@A@@BCAA@AAA@CACAA@AAACAC@A@C@C@C@C@CDCBCD@BA@@B@D@B@B@BAB@BA@@@ABA@@@A@ABAHKDE@@@AAAAAAAC@ADAB@BA@A@C@AA@AA@AA@A@A@@@AB@@A@@BA@ABC@ABC@CBE@E@C@G@E@EBEBCBA"],encodeOffsets:[[118668,25496]]}},{type:"Feature",id:"360735",properties:{name:"石城县",cp:[116.346995,26.314775],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAA@@@@BA@@@@@CB@B@@@BA@@@@@A@@@A@@AA@@@@@@BC@@@A@@@@@AB@@@BA@@@@@A@@AA@A@@AAB@@A@@BA@@@AB@@@@@@A@@A@@CBAB@BC@@@AB@B@@@B@B@@@B@@@BA@@B@@@@@@@BA@@B@@B@@@B@@BB@@@@B@@@B@@AB@@A@@@A@@@A@AB@@@@AA@@A@A@A@A@@@A@A@AB@@ABA@@BA@ABABA@A@A@A@@@@A@@AA@@AB@@A@AA@BAB@@A@@@AA@@A@@@A@A@@@@BA@@@A@@A@@@AA@A@@@A@@BA@A@@BAB@@A@@@A@@B@@A@A@@B@AAB@@@@A@@B@BB@@B@@@@A@A@@@@@@B@@@B@@@@A@@@@@A@@@A@@@A@@@C@@AA@A@@@ABA@ABA@A@A@@@A@A@@@A@A@@@C@@AA@@AA@@@A@@A@AA@ABA@A@@A@BCBC@@@CAA@@AA@@@AA@@A@@BA@A@AB@@A@@@@D@@A@@B@@AB@@A@@@A@A@@@AB@@@B@@@@AB@@@@AB@@@@@@A@@B@@@@AB@@@B@@CDAAABA@E@A@AAA@@@AA@@@@A@@@@AABA@A@A@AB@@@A@@ABBBCB@BAD@@A@AB@@AA@@@BA@@@C@@A@@@@AA@@@B@@A@ABA@@@A@ABCB@@@AA@ABA@@BAB@@@D@BB@@B@BABBB@B@@BB@@@BB@@@B@@@B@@B@B@@B@BB@B@BB@@@BA@@@ABA@@BAB@BA@@B@@AB@BBB@@@B@FB@@@BBD@B@@BB@@@@@@@@@B@@B@BA@@B@B@@BBB@@A@CB@BCDABAB@BABAB@@@@AB@@@@A@A@@@@B@@AB@B@B@BA@@B@B@BBB@B@B@@@B@BBB@BBB@B@B@B@@@@ABA@@B@BAB@@@BB@@@@B@@BA@AB@@@D@@@BB@BBB@@BB@@ABB@AB@B@@AB@@AB@@A@@BB@@@B@@BABBB@@B@B@BBBBB@@@B@B@B@@BB@B@@AB@@AB@@B@@BAB@B@@B@@B@@BB@@@@BB@@@B@@BB@@@B@B@B@D@@@BA@@B@@BB@B@B@@@B@D@B@@@D@@@AB@@B@@@BBBBB@@DB@@B@@BA@@@A@@B@@@B@BB@B@@@B@@@B@@B@@@@@@BBA@@@B@@@@B@B@B@B@@@@@B@BB@B@@A@@@@B@B@B@B@B@B@B@@@BB@@@B@B@B@@AB@BB@B@@@@B@@@@B@@@@@B@@B@BB@BDB@@DB@@B@@BB@BBBBD@BB@@B@@BB@@B@@BBD@BAB@BA@@@BB@BA@BB@BB@@ABB@@@B@@B@@@@BB@BB@@@B@BB@@BB@@@@B@@@B@B@@@B@@@BB@@B@@@B@@@@@@BA@ADAB@@CBAB@B@B@B@@B@@@@D@@@@DD@@B@BBB@BA@@B@B@B@DAB@B@D@@@D@@@BAB@B@@BB@@BBB@@@@@BB@AB@B@BA@@@A@@B@@C@A@@BAB@B@@@BAB@@BB@BB@@A@BB@@@@BB@@@@BB@B@@@B@@@B@@@B@@@BB@ABBB@B@@BB@@@B@@@B@@@B@@@@@@BB@@BB@@B@@@@@B@@AD@@@BB@@BB@B@@B@@B@@BAB@@@@BBB@@B@BB@@@@@BB@@@@@BABA@@@@B@@A@@B@@@B@B@BB@BB@@B@B@D@@BB@@@@@B@@@AB@@A@@B@DA@@B@@BB@@B@@BBB@@B@@@B@FB@@B@B@@@BA@@@ABAB@@@B@BB@B@B@@@B@BB@@B@@@BB@AB@@@B@@@@BB@@B@@@@@@@@B@BB@@@BC@@BA@@@B@@AB@B@@@@B@BB@@@@B@BB@@B@B@@@@BB@@@@@BBB@B@B@@@@@BB@BBB@@BBBD@@B@BBB@B@B@@BBAB@@@@@@B@B@@ABA@@@A@@@@@A@CBA@ABA@A@@@A@A@@@CB@@@@A@A@A@@@CBA@AB@@@@CB@A@@AB@BC@AB@@@@@BA@@D@BAB@@@BA@@@@@CBA@@@@@AA@@A@@@@@ABB@AB@@@@A@@@@@A@@@A@AB@@AA@@AB@@@B@BA@@@ABA@@@@B@B@@AB@D@B@BA@@@@@A@A@@BA@@@AD@B@@@@C@@@@@BB@@@@A@@@@@A@@A@@@@ABA@AD@@CA@B@@@@@B@@B@@B@@A@A@@@@@@@@B@@@BA@@B@@@BA@@@AB@AA@@@@@A@@AA@@@A@@BA@ABCBA@@@@@@B@@A@@@A@@BBBA@@@@BAD@@AB@@AB@@@BA@@@@B@BAB@@DB@B@B@B@@@B@@BA@@BA@@BBBAB@DB@@B@BA@@@@D@BA@@B@BA@AB@BD@B@BBB@B@@BB@@@B@BCB@BAB@B@@@@@D@BAB@B@BB@@@BB@@@B@@BB@B@B@@B@@@B@@@BBBAB@@BB@BBB@BBB@BB@@B@B@@@@@BB@BA@@@AA@B@@@B@@@@@@@@@B@@B@@@@@@@B@@@@@B@@@B@@@@BB@@@AD@@AB@BAA@@@B@@@B@@A@@@@BB@@B@@@B@@@@AA@BA@@@@BA@@@AB@@@B@BBB@@@@BB@@B@@BB@B@@@B@B@B@B@DB@@B@@B@@@@@B@@@@@@BBBBBBA@@B@@A@AB@BBB@@AB@B@B@B@B@@@B@BAB@@@DAB@@AB@@B@BBBA@@B@@AB@@A@@@@B@BB@@B@@BB@B@B@DBDABA@@@@B@@@B@@@B@BB@@B@FB@A@@@@BA@@@@@@B@B@@BBBBB@@B@@@@@B@@BA@@BA@@B@@AA@@A@@@A@@@@@@@A@A@@BADCBAB@@@BAAC@@@A@@@C@@B@@@@BBB@ABAB@@BB@@@BA@@@BB@@B@B@BD@@B@@@B@@B@B@@@B@B@B@@B@@@B@@@@@B@@B@@B@D@B@@@BB@@B@@@@B@@@B@@ABABBBA@@@@@@B@@@@@@@@@B@@ABC@AB@@A@@BA@@@A@A@A@A@@@A@@@A@A@@B@B@@A@AAA@@@@@AD@@A@@B@@@@BB@@@BBB@@B@BB@@@B@B@@@@@@@@C@@AA@@@@AA@AA@A@A@@@AA@@@A@@BAB@@ABA@@@A@A@@B@@A@@@@AA@@@@@A@AB@@BB@@AB@BB@@@@B@D@B@@@B@@@B@@@BAB@@@@@BA@@@@B@@A@@BA@@B@@A@@@@@@@@BA@@@@@AB@F@D@@@B@@@BB@BB@BBBB@BB@@B@BA@@DDB@@@AB@@AB@@ABABABA@@@A@@@A@@@AD@@@B@@@B@@A@@B@@AA@BA@@@AB@@@@@B@@@@AB@@@B@@@BA@@@C@A@@B@@@@BB@B@@A@@@AB@@@BA@@@C@@@@A@@@@A@@@@A@@@B@@@B@@@BAB@@AB@BA@@@AB@@A@AB@@@B@B@D@B@B@B@@A@CA@@AB@@@B@@@BB@@B@B@@BB@@BB@BBBAB@@@B@B@@@@@B@@@@AB@@@B@@@B@@@@A@A@AB@B@@@BABCB@@@B@@@@@B@@@BC@BB@@B@@@B@@D@@BB@B@DA@@BB@@@B@@@BB@@B@BBB@@BB@DBB@B@@ADA@@B@@BD@DBD@@@BB@@@BBD@@B@@AF@BAB@DC@A@AB@@@D@B@B@B@@@@@@AB@@@BA@B@ABADAB@B@B@@@B@@@BABABABABA@@BA@@B@@@F@@@B@BABAA@@A@@@@@C@@AA@@@@BADA@@D@B@@AB@BAB@@A@@@@@@B@BA@@BA@AB@B@@A@@@AB@B@B@@ABA@@AA@@@@BA@@DAB@BAB@@@BA@@DA@A@@@ABA@@@A@A@@@@B@@@@AB@DC@@B@@A@@BA@@@@@@DBBBBBBBB@@BB@@@@@@@B@@@@BBBBB@@B@B@@@BAB@@@@BB@@BB@@@DAB@@@DBB@B@DBHBD@@BBBB@@@BA@@@@DDB@BADA@@B@B@B@B@@@B@BABA@@B@D@BBD@BBBBB@B@BA@@BAB@@@B@@ABA@@@AD@@@B@BBD@B@B@@BDB@BBB@@@@@B@@@BB@B@BB@@BB@@@@B@BBBBBD@BB@@B@@AB@B@B@@AFAB@DA@ABA@ABAB@D@B@B@B@@@@@BD@@BBDBBDD@BB@B@@@BBBBBBB@BBB@DBHDB@BB@@@@BA@@H@B@@@D@B@BA@@@@D@@@B@BB@@BB@B@@BB@@@@D@D@BBB@@B@@DDB@BBB@B@B@BAB@@@B@@@B@B@BBBBABBBBB@@B@BB@@BBBBBFBBB@@DBBBBBDBD@B@@AB@@BD@D@@@B@@AB@B@@BBBB@@@B@B@B@@B@@@BA@@BBB@B@B@@B@@BBABBB@@@DAB@@@@BBBA@@DB@@B@@@B@BAB@DAB@B@B@@ADABA@AFADC@A@@@A@@A@@@CC@A@@@@BBB@@@@AB@B@BABA@A@@@AA@BA@@B@B@B@B@B@B@@AB@@@BA@A@@DABA@AB@B@B@@@DA@@BABC@AB@@@D@B@BABA@CB@@A@A@A@@@A@C@A@@B@@@B@@ABA@A@@CA@AB@@CBC@A@A@A@@@@BA@@BDB@B@B@B@B@F@B@DB@@DBBB@@@B@B@@@BB@DBF@B@@BB@F@@@B@BB@BD@@@@B@B@@@@FBB@@BBA@@B@AABCBEHCJEHCFADAHEB@JGFEHCFABABC@IAE@CBAH@HA@GFC@ABAB@D@DBBADC@EACCEKGAA@ABABALCB@B@BAB@@AACACCC@ADAF@B@BBDADGB@DCJBB@HBJ@F@FAFBH@@CAEAEBE@C@@ACECEEAG@IAE@CCE@@ECEAEAA@EEEEEEAE@@@CAC@EBCAAA@@@ACBC@GAGBABEFIDE@@AGAG@CCCCCEE@CAECAA@G@EACCEACCEBCB@DEB@@K@GAGCMECB@DBFHP@D@JGP@H@BCD@@ECIAUH@JAFQBKBGCEM@KAG@AGGKBEAAI@K@C@ACEACHCDE@AAAGCG@C@GEGCCEM@@@OEKGEQCCAE@ACAEACC@@A@AAA@CAEEAG@GAKAAAAEEGCAAMAOAHELG@C@E@@AGACCCE@EAEAA@CAIAECCEBC@EACA@CCACAE@@@ABABABABE@E@@@CCACAA@GECCDMDIDCDMACAE@A@AA@A@A@@@A@CA@@AAB@BABADA@@B@D@B@BB@@@BC@A@@BB@BBBBDAB@@@@CAAB@@AB@BABA@AA@CAC@A@@C@ABAB@B@B@@BB@@@BA@AA@AABC@A@ABBB@B@@A@AA@AAA@@GAAB@AA@@AC@A@A@ABAAAAAC@@AA@@A@@AC@A@AFCBABAD@DADAJ@BABC@ABAD@@@B@D@@BBBDHBDBBB@BABABABAAAAACAAC@ABAB@@BBBD@BAB@BBP@TJDBB@@@@AB@@@@@@@B@@BD@B@@BA@@@@B@@@@@B@@@@B@@@B@@B@@B@@@@@@@B@@AB@@BB@@BBB@@@@B@@@B@@B@@@@@B@@B@@@@B@@@@BB@@@@B@B@@@F@@@D@B@DABA@@B@@@B@@A@@@@@@@@@@A@@@@@@A@@B@@@AA@@@@@A@@@@@@B@@A@@@@BA@@@@@AB@@@@@@A@@@@@@@@@@B@@@BA@@@@B@@A@@@A@@B@@@AABA@A@@@AAAAE@C@ABCDA@ABAACAC@E@CDCFCBCDG@C@CBEAI@EEECCGGCACAECIEECCEC@CAC@ACGG@@CA@AGEGEOEIGCCEAEACCAI@E@ACEECEAGCGACA@@CABGBGAECC@AA@A@A@A@A@A@AAA@A@A@A@@@A@A@AAA@@@A@@AA@@AAA@A@A@@AA@AAA@AA@@A@AAA@@@A@@AAAC@@EC@@CC@@BC@A@CCAA@A@C@C@@@AA@AA@AA@AAA@@@A@A@A@A@@AC@EBEBCDCBCBEBCBEBE@A@A@@A@A@A@@AA@@AAAA@C@A@A@A@CBA@A@A@@BA@@BA@@@@AB@@A@A@ABAAA@AA@A@A@A@C@@@ABAAA@@BA@@@CB@@A@ABAB@@A@AB@BA@AB@B@@@BCB@@A@AAAAA@@@A@@@@B@@@@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@B@@B@ABB@@@@@@@@@@B@@@@BB@@B@@@B@B@B@BDB@B@BD@@@@@@@B@@BB@@AB@BA@@@EF@@A@C@CBA@@AA@AAA@@@@@CBABAB@@A@EAC@@@@B@@AB@BBB@@AF@@AB@@@@@B@@@B@@@@@@BB@@A@@B@B@B@@@B@@@BA@@@@B@B@@@@BDBB@@ABA@@@AB@BAB@@@@C@@@@D@@@@@@@B@B@@@@C@A@@AA@@@@@@@@@A@@@CB@@ABA@@@A@A@A@ABAB@B@@BB@@@@@BAB@@@@AA@@AB@@A@@@@B@@@@AB@BA@BB@@@B@@@@@B@@BBB@@@@B@B@@@B@@A@@B@D@@ABBB@@@@A@@@@@A@A@@@A@@@A@A@AAC@@@@@AAA@@AC@@@A@@@A@A@@BA@AB@@ABA@AB@@@@A@@@C@@AA@@A@@@@A@@@@@@BA@A@@B@@@BA@AB@@"],encodeOffsets:[[119053,26668]]}},{type:"Feature",id:"360781",properties:{name:"瑞金市",cp:[116.027134,25.885555],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CBEBCDCD@@AEGGK@EFCHAJEDI@O@MDEDC@A@CACBADABCDC@CACAEAACAAC@A@AACCACAC@C@C@C@CBCBCA@@A@ABA@C@@@ABA@CBA@@@ABA@@@A@@AABA@@@AB@@@B@@A@A@CBA@@@@@@B@@@@B@DBBB@@A@@BABBBBB@@@B@@@BA@@@A@A@A@A@@@@@AB@@C@E@@@C@A@A@A@@@A@@@C@@@A@E@C@CBAAA@@@ABA@@@AA@B@ACA@@AA@@@@C@AAC@@A@@C@AAA@@@@BABA@@@ABA@@@BB@BB@DBB@BBDBBBB@@B@@BB@@@@@@A@A@@@A@A@A@@@A@@@@@@@@BAD@@AF@BAB@B@BB@@B@@@@@BA@@D@@@@A@@BA@@@@D@@@@BB@B@@BB@BB@@B@@A@AB@@BB@@@B@@A@A@A@@@A@@@@B@BBBB@@@CB@B@@@@B@BB@ABB@@B@@BB@AB@BA@@@@@BB@@@B@BB@@@DBDB@@@B@B@@@B@@A@@@@@@@@BBBB@@@A@@@CAA@@@AB@@@B@@@@@@AA@@A@A@A@@@@@@@@DBD@@A@@@@BA@@@@@@BBD@BA@A@@@@@@A@@@AC@@@AA@AA@@A@AA@@AA@@@A@AA@@A@@@A@C@@@BAB@@@BA@A@A@@AAA@@AA@ABA@@@AB@@AAA@@@AA@@@@A@AB@@@AA@@AA@BA@@BA@A@@B@@A@@A@AA@A@AAA@@A@@A@@A@@@AA@A@C@@AA@AA@@@A@@AA@@@AA@@@BA@@AA@@@@@A@@@A@@@@BA@@@AA@@AB@A@@AB@@@@A@A@@B@@A@@@A@A@A@@B@@AA@@@A@@@BCA@@ABA@@@@@@A@@@AA@@@@AB@@@AA@A@@C@@@AA@@AA@A@@@@AAA@BC@A@@@A@A@@AC@@A@@A@@@A@C@A@A@A@@A@CCA@@@@@@A@A@@A@@@@@A@@@A@@B@@A@@@AA@@CA@@AA@@AB@@A@@@A@A@@@ABAB@@@@BB@@@DAB@@BB@@@@B@B@@B@BBB@@@@@B@@@B@@@@@@BB@BA@AB@@E@A@C@@@@D@@A@@@@B@BB@@BB@@@@@@@@B@@@BA@@BAACDA@A@AB@@@B@B@D@@A@@BABADA@@@AB@@@B@B@BA@@BA@@B@@@B@B@B@@@@A@ABABA@A@@@AB@@A@@@@@ABABAD@@AB@@A@A@AB@BABA@@D@DBB@B@@BB@@@B@B@B@@@B@B@@@@B@@B@@AB@@A@@@CB@B@@A@@@@@A@@@C@@@ABA@AA@BA@@@@@AB@BA@@@A@A@@@AAA@CBABAB@@AB@@@@@B@@AB@@BBAB@@@BA@@@@BA@@BCA@@@@A@ABA@@A@@A@A@@@@@AB@@@@@BA@@@@B@@ABA@A@A@AA@@A@@ABC@@BC@@@@ACAA@@A@A@A@@BABA@@BCBC@A@ABI@A@AAA@@ABA@A@A@@FCB@@@B@BAB@BABABAB@@A@C@A@@@AAA@@@A@C@ACA@AA@@AC@@@@@C@C@A@ABABA@C@@@AA@@A@C@A@A@@AA@A@CBA@A@AA@@C@@@AAC@A@@@CAA@@A@@@A@@@@A@C@@@AAABA@A@@@AB@@AAA@@BAAA@AA@@@BA@A@A@C@@B@@@@@B@@@BA@@@ADB@BBBB@@@BB@A@@B@DA@CBA@A@@@@@C@@@@B@@@@BB@@@@C@@@@AAB@@@B@@A@@@AA@@@@@@@@AB@@@@A@AB@@@B@@@BA@@@ABA@@B@@@@@@@@@@AB@@@@@@@@@@@B@@@@@@@@@@@@@@A@@B@@@@@B@@@B@@@@@@@@@@@B@@@@BB@@@@@@@@@B@@@@@@@@@@@@@@@BB@@@@@B@BB@@@@@@B@@@@@@@@@BA@@@@@@@@B@@@@@@@@@@A@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@B@@AB@@@@@@@@@B@@@@B@@@@B@@@@@@@@@B@@@@@D@@@@@B@@@B@BA@@@@@@B@@@@B@@@@@@BA@@B@@@@@B@@@@@@@B@@@@@@@B@@A@@@@@@BA@@@@@A@@@@@@BA@@@@@@B@@@@@@@B@@@B@B@D@@@@@B@@@@@@@@@@B@@@@A@@B@@AB@@@B@@@@@@@@@@@B@@@@A@@@@@@B@@@@B@@B@@@@@@@@@B@@@@@@A@@@@@@@A@@@@@@@@B@@AB@@@@@B@@@@@@AB@@@BABB@BD@@@DBB@@@@@@BB@@B@@@@@B@@BB@B@@B@@@@@BB@BBB@@@@B@@@@@@BBB@BB@@@B@B@@@@BBB@BAD@@@BB@@@D@@@@@B@@@B@@@D@@@B@@@B@@@@@@ABAAA@@B@@AB@@B@@@@B@AB@@@B@@@@@@@@B@@@@@@@B@@@@@BA@ABA@@@AA@@@@AA@B@@@B@@@@@BAAA@@BB@@@@@@@B@@B@@@@@@@@@BB@@B@BB@@@@B@@@@@BA@@BB@@@B@B@B@@@@@@B@@BB@@DB@B@@BD@D@@@BBB@@ABA@@@CBABA@@@@B@@A@@B@B@@@B@@@@AB@@@@@@B@@@@@B@@BB@BBB@@@D@@@B@@BB@@@@@@ABA@A@@@@BBB@B@B@@BB@B@@@BB@BB@B@@@B@@B@B@B@@@BABB@@B@@B@B@B@@@@B@@@B@D@@@@@@@B@@@AB@@@BA@@B@A@BA@A@@@@B@@@B@@@@@@BB@@DDB@B@@@B@@B@@B@B@B@@BB@B@B@@BBB@@B@@@DAB@@BB@@B@B@BB@@B@BB@@DA@@B@B@B@@B@@@B@B@@@B@@@BA@@@A@A@@B@@@@AB@@A@@@@BAB@@@BAB@@BBBBBB@B@B@@@D@@@BB@@BAB@@@BBB@BBB@@@DBB@B@@B@B@@BBBBBBBB@BBB@@BBB@@BB@@B@@@B@@@@BB@BBBBB@@B@@@@ABA@@@A@CB@BA@AD@B@@AB@@A@@@@@@@@A@A@@AC@@@AAACAA@@@A@A@A@AA@@AB@@@@A@@@@@@BA@AB@B@@@@A@AB@@@D@@BBBB@@B@B@BD@B@B@@A@@BAD@@@BBB@@@B@@@@@B@@C@B@@B@B@BABA@@BA@@B@@@B@@BA@@@@@@B@AB@@@B@B@@@@@@B@@@BBB@@@@@@B@@@@A@@B@@A@@@A@@B@@@@@@BB@BA@@@@B@@B@@@ABBB@@@B@@B@B@@@B@ABB@@@@@@B@@B@@@@B@@@@@B@@AB@@@@@@@B@BA@@@AA@@@A@A@@CBA@@@A@@@@B@BA@@@A@@@@@@@@@@B@@BBA@@@BD@B@@@@@B@@@@A@@B@B@@D@B@BBB@A@@B@@@BB@@@B@@BBB@@@@BA@@@AB@@AB@B@BAB@@@B@@ADAB@B@@@@@BA@@B@B@@@B@@@B@B@@@@@@@BA@@B@@@@B@@@@BA@@@@@@BA@@B@@CB@@@@@D@BBB@@@@@@A@AA@AB@@@AAA@AB@@@@@@@BA@@B@D@B@B@@@GE@@@@@AB@BCBCBAB@BA@@@A@A@A@@@A@@BA@@@@A@@@@@B@@A@@B@BBB@B@B@BB@@B@@ADAFA@@BB@@@@B@BB@@@@BA@@@C@A@AB@@A@C@@AA@@BA@A@@AA@ABCBA@ADA@@B@@@@@BB@@@B@B@BA@@B@@@B@@BB@@BB@BAB@B@@DD@@@BA@AB@BABAB@B@@@D@@@@A@A@@@ADAB@BD@B@@BB@@AB@@B@@@@B@@@@@BAD@@@@@@@BA@@B@B@@B@@CD@@@BAB@@@@A@@@DBB@@B@@@DB@@B@@B@@B@@@BB@@@@BB@@BABABABAB@@@@@@AB@BB@@B@@@@@@@BA@BB@@@@@@@DAB@@BB@@A@@@A@AB@@@@A@A@@@@A@@@@@@A@@@A@@@@@@@C@@@@@AB@B@@@@@@A@AAA@@B@@AB@@A@@@A@AB@B@@AB@D@B@@@BB@@@@B@@ABA@AB@@A@A@ED@@A@@@@ACB@@A@@B@@@@A@A@@B@@ABA@CB@@@@@BA@@BA@@@@@ABAB@@@B@BA@@@ABAB@@BB@@@B@@@@A@@@@AA@A@C@@@@@@@@A@A@@@@A@@@A@@@A@AAA@A@A@C@@@@@AB@@@B@@DA@@BBD@@@B@@@@@@BB@@BA@CBA@C@@@A@@B@B@@@BB@@@AB@@@@@B@B@@@BA@@B@@A@A@CA@@A@@@@@@@@@BB@@@@A@@@@@BB@@ABB@@@A@@B@@BB@DAB@@BB@@@B@@@BA@B@@B@B@B@@@B@@A@@@AAA@@@A@@@AA@@D@B@@@@@E@A@AA@@@B@@AB@@@BB@A@@BE@A@A@ABA@A@@@@@AB@B@@@@@B@@@@@BBBB@@@AB@BA@@@AB@@ABAB@@@B@@BBDD@@@BA@@@@AA@@@A@A@@B@B@B@B@BA@@@@@AAA@@@A@A@@@A@@@@@@@@D@B@@A@@@A@ABA@AAA@A@A@@@@@A@@B@@@@@@A@@@@@A@BBBB@BA@@BA@@@A@@@@@@@@@A@AA@@AA@A@@A@@B@@@BA@@@A@A@@BAACAA@@@@@AA@@A@@@@@@AA@A@@@@@AA@@A@@@A@A@A@@@@B@@A@@@A@AA@@A@@@A@@@@@@B@@A@ABAB@AA@@@A@@BA@@@@@AAA@@@A@@@@@A@@@ABCA@@A@@@@@AA@@@@A@@B@@@@@AABAB@@A@@A@@@@@A@@@AA@@@@A@ABAA@BA@A@@@AC@@AC@A@@B@@@BA@A@@@@AA@@AA@@@AA@@ABA@AAA@@@A@E@@@@A@@A@A@A@A@A@A@@@A@@@@BA@@@ABCBCBA@AB@BA@@B@@BB@B@@BB@@@@@@A@ABAB@@ABA@@BB@@@@BB@@@@BBA@@@ABBB@@@B@@@@@BB@@A@ADC@@@A@@@AB@@B@@@AB@@@@@@BB@@@@B@B@@@@@@D@B@@B@@@@A@@@@B@@BA@@B@@@B@@BB@@@AB@@B@@@@ABAA@@@@@@A@AA@@@A@@AB@@@@AB@@@@@@AA@@AA@@@@C@@@@B@@@@A@@BB@@B@@A@@@@B@@A@@@@@AB@B@@@@@@@@@B@@A@@A@A@@@@A@@@A@@BB@A@@@AB@DA@A@A@@@@A@@@@@AAB@@@B@@ADABA@@@@AB@@@@AA@@@@@@B@@@@@@A@AA@@AB@@CB@@A@@@A@@D@@@BAB@@AB@@@B@B@@@B@@A@@B@D@@@@@@B@@@B@B@B@B@@AB@B@BC@@B@@BBBB@@BAB@@@@B@@AB@B@@@@@@@@B@@@@@@@B@@@@@B@@B@@@@@@B@@DB@@@B@BB@@B@D@@AB@@B@@@@@@BB@@@AB@@A@@@@@@@AA@@@@@@@D@@BBBB@@@@@@@@@@@@BA@BBB@@B@@A@A@@@@BBBB@@@B@@B@@@B@@B@@@B@@@BB@@@AB@@B@@B@@@@@@@B@@B@@@@@AB@@A@@@@@@BAB@@@@BB@@ABCB@BCB@@A@@@@BA@@@AB@@@B@B@@B@@@BB@@@@@B@@@@@@@@@@A@B@C@@@@B@B@@A@@@@A@@@@@@@@@A@@@@@@@A@@AB@@@@@@@B@@A@@A@@@@@@@A@@BA@@A@@@@@@A@A@@@@A@@@@@@B@@BB@B@@@@@@A@@@@@@@@@@@@BB@@B@@A@A@@A@@@B@@@@@@B@@@@B@@B@@@@A@@@@BBB@@@@@@@A@@@@@A@@@@@BB@@@@@@CB@B@@AB@B@B@@DB@@BB@B@@B@BB@@@@D@B@DD@@@@AAA@@@@@AB@@@@@@D@@@BB@@AB@@A@@B@@@@B@@@B@@ABC@@@@@B@B@@@B@B@D@@@@DA@B@@B@@BBB@@@B@@@@AB@@@B@@@@A@@@BB@B@AA@@@A@@@@@@B@@AA@@AA@@@@BB@@BB@@B@@@@@@@@@B@@@@@@@@BA@@@@B@@AB@@A@B@@@BA@B@@B@@@@A@@@A@@@@BA@A@@@@B@@B@B@@@B@@@@ABA@@B@@@B@@@@@B@@@@@@AB@@AA@@@@AB@@B@@B@@A@@@@@BB@@B@@BB@B@B@@AB@@@BA@@B@@AA@B@@AB@@@@B@@A@@@@@B@@@B@@BAB@@B@@@BA@@@@@B@@@@@@BB@@@B@AA@@B@@@@BB@A@@@@@B@@@@AB@@@@B@@@B@B@@@@B@B@@B@@@B@BB@@BB@@@@B@@@B@B@B@B@@BB@@@@@BA@@DA@@B@@@@@@B@@BB@@B@B@B@B@@B@BBB@@BA@@@@B@@B@@BB@@BB@B@BBB@@BD@@B@@@B@@@@BBAB@@@AA@A@@@A@@@BBB@@@@BA@@@@@AB@@@@BB@@@@A@@@AB@B@@AA@@AAC@@@A@@@@A@@@BAA@@A@A@A@A@C@@A@ACA@@AAA@@BA@@@@AA@A@@@A@@@A@A@@A@@A@@BA@@@@@@@@@@@@@@@AB@@@@@@@@A@@@BB@@@@@@A@@@@BA@AA@@@@@AB@@@@AA@@@@@@B@@@A@@A@@B@D@@@@@AA@A@@@@AA@@B@B@@@@@@A@A@@@A@@@AB@@@@@@@@A@@@@@A@@@@@A@@A@@@@A@@@@@@ABA@@@CC@@@AA@@A@A@AA@@@A@@@A@@@@@AAA@AAA@@A@@@A@@@A@@AAA@@@@A@A@@AAA@@@A@@AAAA@@@A@@A@@@A@A@@@@A@@A@@A@@A@@@@@A@@B@B@BA@@@A@@@CB@@AB@@ABAB@B@@@BB@@B@B@@@D@@@D@B@B@BBB@@@@A@@@A@A@A@A@@AA@A@AB@@@B@@ABA@@@@@A@@B@@@@ABAB@@D@B@@@B@@@@B@B@@@@AB@B@@@@@@@@AB@@@BA@A@ABA@@@@@A@@BA@AB@BA@@B@@B@@@@@A@@@@@AB@B@@@@@B@DB@@@BB@@BB@@@B@B@@A@@@BB@A@@BAABB@@@BA@@A@B@@@@@B@@D@@@@@@@B@@@@@BB@@BB@@B@@@@D@@@@@@@@BA@A@AB@@@@@@@@B@AB@@@@@@B@BA@@B@@@@@@@@B@BBBA@@D@@@@@BA@@@AB@@BB@B@@@BA@BBAB@@@D@@@@@@@B@@@B@B@B@B@@@B@@AB@B@B@@@BBBB@@BB@@@@@AB@BB@@AB@@A@@@@BB@BBB@A@@B@@@@@@@@@@@BBA@@@B@@@@@@@@B@@@@A@@B@@@B@@B@@DA@@B@@BB@@@B@BAB@@@@@BBAB@B@B@@@B@@AB@BA@@BBB@@CDABA@@B@B@@@B@BBB@B@@A@@@CB@B@@@D@@AB@B@BA@@DAB@BB@@B@@@BAB@@@@@@B@B@@@@@@BA@@B@@B@BB@@BA@@@B@@@@AB@BB@@@BA@@@@@@@B@@BB@@@B@B@@BB@@@B@@CDAB@BA@@AA@A@BB@@@@@B@@@@@B@@@@@BCB@B@@AB@@AB@BA@@@A@AB@@A@@B@D@@ABA@A@@@A@ACA@@BA@@@@B@B@BA@A@@BA@@@A@@@CB@AA@@@CB@@@BA@@@ABA@AB@@@@AA@AA@@@A@AB@@CD@@A@@@@BAB@BAF@B@D@BA@@B@@A@@B@@@B@@ABA@A@A@BBA@@@@A@@A@@@@A@@@@@@@BCBA@AB@@@A@@@@AAAA@@A@@AEC@@A@A@@@@@@@AB@B@B@@@D@@A@C@A@A@@@A@@BAB@B@@A@@@@B@D@BDD@BB@@DB@@@@@BB@B@A@@B@@@B@B@@@B@@@@@DDAB@B@@@@AB@@@@@B@BBD@@@B@B@@@@A@AB@@@BB@@@@B@@A@@@@@@@BDBB@@@B@@B@@@@BABAB@@@@A@AA@AA@@@AA@@@@AA@@C@C@C@@BA@A@@@A@@@ABAB@@AB@@A@A@@@C@A@@@@@@B@@@B@@A@@@@B@@@@A@@@@@@@ABBB@@A@@@@A@@@@@B@@@@AAA@@@BBA@@BA@@B@@@B@BBB@@BB@B@@@@@B@@@@BBAB@@A@@B@@A@ABA@@@AB@@A@@AA@@@@A@BA@@AA@@A@@@@@@@BA@@@@@B@@@@@A@@BA@A@AD@@@@A@@@ABCB@@A@@@A@AB@A@@AB@@AA@@AB@@@@@B@B@@@BBBBBBB@@@B@B@@@@A@@@@@@@A@@@A@@@@@@B@B@@DB@@B@@B@@BB@@B@@@@@B@BAB@@@BD@@BB@@BBBAB@@@@@B@@B@@BB@B@B@BB@@@B@D@@@@@@@@@B@B@@@@BA@@@AB@@@@B@@@BA@@B@BBDA@@BB@@@@@@@BA@A@C@@B@@A@@B@@@B@@@@B@@@B@@@@@@B@@@@@B@@B@@@@@B@@@@@@@AB@@@@BB@@@@C@@BA@ABAB@@@B@@A@AB@@@@BD@@AB@@@BB@@@@BB@@@B@@D@@BB@@B@@@@BA@@@@@AB@@B@DB@@B@@A@@B@@@@@@@@B@B@@@@@@B@@BBB@@@A@@@A@@BBABBB@@BA@@@@@@@@@B@@@@@@ADA@@@@B@BB@@A@@@BABA@@@@B@@BB@@@@BBD@B@@@B@@BB@B@@@@B@@B@@@@@@B@@@@@@B@@@@@B@B@@@@@B@@@B@@@@@B@@@@A@@@@@A@@@@@AB@@@BB@@B@@@@@@@@BA@B@@@@BB@@B@@B@@@@@BB@@B@@@BBBBBD@BDD@@@@@BCBABA@CBE@A@AB@@@BAD@B@B@@@BBB@BB@@@@@AB@@BB@@@@AB@@@@@B@@@BBD@@BB@@B@@@@B@@@BB@@@@BB@@@@@BBBB@@@@@BB@BB@@B@@@BB@B@BBB@@BA@@BB@@B@@@@@@B@@@B@@@@F@@AB@@BDB@BB@BB@@@BB@@B@BA@B@@@@BBB@@@BA@@BBB@BBBBDBB@@B@@@B@@BBB@@@B@B@@B@D@@@@@BA@@@BDAB@@A@ADA@@BA@@@@B@@@@B@BB@@@@@@A@@BA@BB@FBBB@@@@@@@B@@@@B@@@A@@BBB@@@@A@@@@@@@D@@BB@@@@BB@@BBB@@BBB@@B@@@@@@A@@@AA@@@B@@@@B@B@@@@B@@@@@@C@@@@@@@D@@@@@@@B@@@@AB@@A@@B@@@B@DAB@@@BA@@BB@A@@@B@B@@@B@BB@BB@@BA@BB@@DB@@B@D@B@@B@AB@A@@AB@@@@BAA@@@@@@@@@AB@@@@@A@@B@AA@@AB@@AB@@@@AB@@@B@@BB@@BB@@B@@@B@BB@@BB@@@B@@B@@BBB@@@B@@@@AB@@BB@@@B@B@@@@A@@B@@@D@BBBBB@BB@@@B@@@@AB@@@B@@@B@@@@BBB@@@B@@@@@@AB@@@@@@B@@B@@@@AB@@@@ABB@@@@@B@@@@B@@BB@@@D@@@@@@@@@B@@@@@@@@BA@@@@@@@B@@AB@@@B@@BBB@@@@@@@A@A@@BA@@@@BB@@B@@@@AA@@A@@@A@@@@@A@@@@@@@A@@@B@@@@@ABA@A@@@@@AB@@BB@@@@@@@@@@@BA@@@@@B@@@@@@@@@@@@@B@@@@@B@@@@@AB@@C@@AA@AA@@@@@BA@@@@@A@@B@B@@@B@@BDBBBA@@@B@@B@BA@@BBB@D@B@@@@@@AA@@@@@@@B@@AB@BB@B@@BBB@B@B@@BB@@@B@@B@@DB@B@@@AD@@A@@B@@@BAB@B@@@BBBBB@@@@@@@BAB@@@B@@BBB@@BB@@BBBB@@B@@@B@@@@BDB@@@@B@@AB@@@BB@@@@@B@@@@@B@@@@@@@@B@B@B@@A@@@AB@@AB@@@BA@A@@BBBA@@BBD@@@@@@B@B@BB@@AB@BAB@@@B@B@@@@@BB@@BAB@BA@A@@@AB@@A@@@A@@AA@@@AB@BA@@B@AA@@@@@A@@@@@@BA@@@@A@@@@A@@@@@A@@@@B@@@@@@A@@AA@@@A@A@@@@@@@A@@@AB@@@B@@@@A@@@@A@@A@@B@B@@A@@@A@A@@A@@@@@@A@@@@@AA@@A@A@@BA@@AAA@@@@@BA@A@A@@@@BB@A@@B@BA@AB@BA@@B@A@@AB@@@@@BB@@B@@@B@BB@@@@@@BB@@BAB@@@@@@AB@@ABA@@DA@@@@AA@A@AACA@@AA@AA@@CC@@AC@C@A@@@@BA@AD@@@@ABA@@@A@@@A@@BC@@@AA@@@@@@A@@A@@@@@AABAAA@ABAA@@AB@@@BA@@B@@BB@@AB@@A@@AA@A@AEAA@AB@AA@@@A@@AA@@@@A@@AA@@AAA@@A@@@A@@@A@@@@@ABBB@@@@C@@@@@@@A@A@@@@@A@@BA@@B@@@@@B@BBB@@A@AAA@A@ABA@@@AB@BABA@@B@@AB@@@B@@@BAB@AA@@BA@@BAB@@@@@@A@AA@@@@@A@@A@@@AA@AB@A@@@@@A@AA@@@A@@A@@@A@@A@@@A@@@@@@A@A@@@@BA@A@A@A@@@ABA@@@@B@@@BABCA@@@@AB@BC@@@A@@BADA@@@@AA@@A@@AA@@A@@@@A@@AB@@AA@@A@@@@BA@E@@@A@@@A@@D@@A@@@A@@@A@A@@@AA@@@@A@A@A@A@@AC@A@@@A@ABA@A@@A@@A@@@A@@DC@A@@@@B@AAB@BA@A@@@AA@@A@@@@A@@C@@@A@@A@AC@A@C@AAC@@@C@@@AB@@@@@B@@B@@B@B@@@B@B@B@B@BADC@@BAB@B@BBBB@B@@B@@A@@DC@@B@@A@@@@@C@AB@BAB@BA@ABAB@BC@AB@@A@C@A@AB@BAB@@AB@@ABA@A@@@@@E@A@AA@@A@A@@B@@@B@@A@@B@BBB@B@@@BA@@B@B@B@B@B@B@@@DB@A@@@@DB@@BBB@B@BB@@@@BB@@B@@B@@@B@@B@@@@@BB@@@B@B@@BB@@BDBB@BAB@@@BBB@@@@B@@B@@B@BBB@DBB@B@B@B@@@@@BBBD@@DF@@ADABAB@@@BB@@@BD@@@BD@@B@@D@B@F@@BBBB@BBBB@@B@B@@@@@AD@BAB@B@@B@@@@@BB@B@@@@D@@@@B@B@D@B@@B@B@DA@@B@B@@@BBBBB@B@B@@@B@B@BBD@@@B@B@BB@@@@@B@@B@B@@@B@B@BD@B@@BBB@@B@BAD@B@BAD@@@DADBB@@@D@BAB@BB@@BBB@@H@B@D@B@B@DB@@B@@B@@B@BA@@B@@@@ABABAB@DCB@@A@C@@BA@A@C@@@AA@@AA@@@BABABC@@@A@@AA@@@A@@@ABA@AB@DABC@C@@@ABA@@@ADAD@@@B@@AB@B@B@@ABA@A@@BA@@BABA@AB@@@BC@@@@BA@@@A@AB@@@B@BBBAB@@CBA@@@A@AA@@A@@B@BABC@@@@@@BA@@B@@A@A@A@A@@B@@@BB@B@@@BBBBB@@@BB@@B@@BD@@B@DBB@@@BBBB@FBBB@@@BBDDB@B@@B@@B@BA@@BBB@BA@@BAD@@@BA@A@@@A@A@@@ABA@CBA@@@@@@@BB@@@B@@AB@@@BBDA@BB@@B@@DB@@BAB@B@BA@ABA@@@A@@AA@@A@@A@AAAA@@@@A@AA@@@A@@@A@@A@@AA@@@AAABA@A@AA@@A@C@@BA@AB@BAB@@B@@DABAB@@@B@BAB@@@@A@@BB@@@@@BD@@@B@@A@@BB@@BAB@@@BC@ADAAABA@@@B@@BAB@B@B@BA@B@@B@@@@@BB@@B@BBB@F@B@BABBDC@@@A@@BA@@@@@AB@@@@@@@BA@@@@BA@@@@@A@@BA@@B@B@@@B@@@BA@@@AB@@@@C@@B@@@BAB@B@@AB@@@BB@@B@@BB@DB@@D@DA@A@BB@B@BAB@@B@BB@@@B@@BB@@BD@@@B@B@@@B@B@@@B@B@B@BAB@BA@@B@B@@BD@@@B@@@B@@@B@@@@@B@@@@@@A@@@A@@@@B@B@@@@@@AA@@A@AB@@@@@BA@B@AB@B@@@@AB@@@B@@@BA@AB@B@@AB@@@B@B@@B@@@BB@@@B@@A@@B@B@@@B@@@BB@@B@@@BA@ABBB@@@BA@@BB@@@B@@B@B@B@B@BABAB@@AB@BA@@BAB@B@@@B@B@B@B@@@BB@@@@BAB@@@B@@@B@@@BA@@@A@@@A@@A@@AA@@@A@@@@AB@@A@@@@@@@AB@@A@@@A@@@A@A@@@ABA@@D@@ABADA@@@BB@@@@@@@BA@@B@@AB@@@BA@BB@B@@BB@@@@@B@@A@@BA@@@@B@@@D@@A@@@@B@@BB@@@B@@@@@B@@A@@@ADA@@@@B@@A@@B@BB@@BAB@@A@@@AB@B@@A@@@@B@@@@@@BB@@BD@@@B@@@@@BAB@BA@@BAB@@AB@B@@@B@@@D@@BB@BB@@@@D@BBB@B@@@B@@@B@B@@@@@B@@@@@AABA@@@C@AB@@@@A@@@A@A@@A@AA@@@A@@@@@A@@AAB@@ABA@@@@@A@@B@@@BA@@BB@@@@BA@A@@@@AA@@@ABABAB@B@B@@@B@BA@@DA@@B@@@@@@@@@B@@BB@D@@@@@@A@A@@@@@@@C@@D@@@@@BA@ABA@@B@BA@@AAAC@@@@@A@A@@B@@A@@@A@@@A@A@AB@@@AA@@@@@@@A@@@A@@@@BA@@BE@@AA@ABA@@@A@@D@FBB@@@BABADA@@@@B@BBB@@BB@DAD@B@@@FE@@B@@ABA@@AA@@@A@@@@@@ACA@A@ACA@A@A@@@A@@@AA@@@@@A@@@@@@@@A@BAA@@@@A@@@@@@@@@@@A@@@@@@@@A@@A@@@@@@@@@A@@B@@@B@BBBBB@@@DA@A@@@ABAB@@ABAB@@@BABAB@@@DA@@B@@AB@BBBA@@D@B@B@B@B@@BBBAB@B@B@BA@@B@@B@@AB@@AB@B@B@DAB@B@B@D@B@BB@BB@@BB@B@B@@@@@BC@CBCAACCA@CAK@GBA@K@KAAACAECK@G@CCAG@@AEAE@CACA@AACAAAC@CACACCAACAAE@AAC@CAA@ACACAEACAC@C@E@CCCCC@C@E@E@C@E@EACACC@@AGACAC@@CACAA@EBCBC@E@CAA@EAEAEBCDADA@AFCBC@EACAA@EAC@ECAAAAIAE@CACBC@C@@@C@EAAAIEGCAA@CAAACAA@A@CACAGCAC@IB@@EAC@E@AAEAEEEAAAAE@@ACCACACACACCA@@AA@BE@CAA@@AC@K@C@E@C@ABCBC@C@G@EDCDBDD@@B@BABC@EDCF@DAD@FCDABADABADABEBA@CAC@A@CAADAD@DADCBA@@BC@ABCDAD@DCBEBCFC@C@@@CCCACAEAC@E@E@C@AAC@A@C@A@C@@ECEA@@ABA@CB@B@D@F@@AH@B@BDFBB@BABA@A@C@@@A@IAIEC@C@ACAACBEBAAEAC@E@E@AAAEAC@AAAAAEEAAAAACACAE@EBA@ABACCA@CAAACCAAAEAA@GBG@EBA@E@C@@@CCAACEAC@CAE@C@EBC@AAAACCC@C@CFCBCDCAC@ADCDAAA@AE@EBG@EEA@@@DAFABC@AAACBGCE@ACC@ABABAFCHC@@@A@@@A@@A@A@A@A@@@@AAE@@@@@CDA@A@CCCAACA@CBADCBABABC@CBAFADC@@@C@CAE@C@AACA@@@A@CDADABA@EAAAAAAAAE@AACACC@C@C@C@CA"],encodeOffsets:[[118827,26242]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
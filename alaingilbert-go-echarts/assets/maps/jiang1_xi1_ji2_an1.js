@@ -0,0 +1,6 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("吉安",{type:"FeatureCollection",features:[{type:"Feature",id:"360802",properties:{name:"吉州区",cp:[114.994763,27.143801],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BC@C@A@@@A@@@AA@CCC@AACCCCAAAA@AAA@C@C@CAC@ECGAC@C@C@A@CA@AC@@A@C@@AA@C@A@AA@@AA@A@CBA@@@A@C@C@@AA@@A@AAAC@@@@@AAA@@@@BA@@@CC@@@@AB@@@@AAA@@BAB@@@@@@AB@@@@@BA@@B@@@@A@@@@@@A@@@@@@@@@@@A@@A@@@AA@@A@@@ABA@@BA@@@A@@@AB@BA@@@A@A@AB@@@@A@@AA@A@A@CB@@@@@@@B@@BB@@@@@B@@@@A@@@@@A@@AA@@@BAA@@A@@A@@@@@A@@@A@@@ABA@A@@@AB@@AB@@BB@B@@AB@@BD@BB@@@@B@@B@@BB@@B@@@@@B@BBBB@@D@BAB@BB@@@@B@@@BB@@B@@B@@BD@@BB@@BB@@@B@B@BBF@B@@@@@@B@@@B@DBF@D@D@B@BAB@BAF@B@BAD@B@B@@@BA@ADCDA@ABA@CBA@CBA@CDCBAB@BA@AD@@ABBB@@@BBB@D@BA@@BADA@@BCB@@ABAB@B@BAD@J@@AB@B@B@BABAB@@ABAF@BAD@@@BBFBB@@@D@BADABADAD@@AFBD@B@B@B@F@BABAHAB@@DBB@@AAA@@B@@A@@D@D@B@@@@@@BDBBAB@@A@@@@@@A@ECBADC@@@@DBBB@@@B@@@@B@@B@BB@@@@@BB@@B@@@@B@@DAB@@@BB@@BBB@@@@@B@@@B@@@@B@B@@@BB@@BB@BBB@@@B@@@BA@@BA@@@AB@@@@@@B@B@F@@@BA@@BA@@B@@@BAD@B@BDB@@BBBB@B@@B@DA@@DB@@B@BB@B@B@@BBB@@B@@@B@@BB@B@@@@B@BBB@@@BB@@BB@DB@@@B@@@B@@@@@B@@BB@@@BA@@B@@@@@BB@B@@B@BBB@@BB@@@@ABA@@@A@ABC@@@BB@BA@AB@@@B@BB@BB@@@B@BABA@@@B@@DB@@@@@@@@B@BA@@D@@@B@BB@@@@B@B@BAD@@@@@@D@BB@@@@BB@B@A@@BB@B@B@B@B@@@B@B@@@@@BA@@B@@AB@@A@@B@BA@@B@BBB@@@B@B@B@B@@BB@B@@@BB@@B@B@@A@@@@@@@@B@@@BB@@BB@@@@A@@@AB@@B@@@B@BB@@@@B@B@@A@@B@B@BB@@@@@B@@BA@@B@@@BA@@@AD@B@@B@@B@BB@@@@BB@@@BA@@@@@A@@@AB@@@@BBB@B@B@@@@@@BA@B@@@@@B@@@BAB@@A@@BB@@B@@@BB@BBB@@@B@@@@A@@B@@@BBB@B@BCLB@@@@@@@B@@@@@B@@@@@@ABB@@B@@@BBF@@B@@@B@@ABC@@B@@B@@@@@D@@@BB@BB@BB@@BBBA@@B@@@@@BBA@@BB@FFB@B@@@B@B@B@@@BB@@B@@A@@@C@@@A@@BA@@BA@@B@@@B@B@@@B@B@@BB@@@@B@@CD@@@B@BB@@@BBBB@@BB@B@@@@@BB@BB@@B@@A@@D@@@@@B@@@AC@@@@D@@AB@@ABAD@B@@B@@@@@B@@B@BB@BBB@B@@@BDB@@B@@BDBB@B@B@@B@@BB@@BD@@ABA@A@@@@AA@A@CC@AA@A@@A@@@@@@@@A@@@@@AB@@@B@B@@B@@@@@@B@@A@BB@@@@@@B@@@@BB@@@@B@@@@BDB@@B@@@BA@@B@@@@@B@@AD@B@B@BC@ABA@AB@@@@@B@@BBBB@BB@DB@AB@@@@BB@@B@@BA@@B@@@@B@B@@@@@@ADB@BBBB@@BD@@B@@@B@B@@@@BB@@@@BB@@@B@@@@BB@@BB@B@B@@@B@B@@@B@@@@@BBA@@@B@@BDA@@@@B@@BB@AB@@B@@BBA@@B@@@@ABBB@@@@BA@BB@@BA@@@@B@BA@@@@B@@@@A@@BA@@@AB@BA@@B@@AAA@@DABA@@@@@@A@@A@@@A@@D@@@B@AA@@AA@A@@@@BAA@A@@@AA@@@@@A@@@@@A@@BB@@BAB@B@@@AA@@BAAA@@BA@@@@B@@@@@B@@@@@@A@@@@@@AA@@B@@@@@@@@B@@B@@@@@@@B@@@B@@@@@B@@@@@@AA@AAAAAAAA@@A@A@@@@@@@@@@@@A@@@@@@B@B@AA@A@@A@@@@@@ABB@@B@@@@@@A@@@C@@@@@EB@DC@A@A@AB@B@@A@@@A@@B@@BB@@BB@BAB@@@@@@@@A@AAA@C@ADA@A@AB@BA@@@A@AAA@@@@B@BAACBAB@BA@@@A@@@A@@@@@@B@@@B@@@AA@@@@@A@@B@@@B@@@@@@@@CA@@@AAAA@@BA@@D@B@@A@C@@@@@AA@@@@AA@@@@@BA@@@AB@@@B@B@B@@@B@@@@A@A@@B@B@BBB@B@DBF@BCBCBCBABABC@AB@BAD@J@B@D@B@B@B@BB@@@@@@BBBBB@BBBBB@FAB@BA@@BA@AB@BAB@@@AAA@@A@@@AB@@@B@@BB@@@@@DA@@BB@@@B@BB@B@D@D@B@DA@@B@@@@BB@@B@@@@BA@C@@BC@AAE@ABC@@@AD@@AB@BABA@@@@ECCCECAAC@CCEAAAA@EAE@CAGCCAA@ECAAA@ACCECCAAA@AACA@@AAC@A@CAGCCACAECCAEACCEACACAA@A@CAG@AAA@AAAACCCCCCCEAACC@AAAACAA@@CGAAAA@@CCAA@AA@CAAAGECC@A@@AECCAAAEAEAE@EAA@@@IBC@A@C@CBA@CBCBADOBA@A@EC@C@A@ECC@CAIEAACCACAC@@AAAECACCAACAAAA@CA@@A@ABA@@BA@@BADAB@@AB@@AB@@AB@@@BAF@DBDBB@@@@B@B@@@@@@AB@@@@@BBBB@B@B@D@BAB@B@@ABABAB@@CBA@E@C@A@C@CAAAA@GC@@A@CAE@C@EBABE@CBCB"],encodeOffsets:[[117676,27714]]}},{type:"Feature",id:"360803",properties:{name:"青原区",cp:[115.014811,27.081977],childNum:1},geometry:{type:"Polygon",coordinates:["@@BB@BBB@BAJ@DA@@DABABCDCD@@ABKHCBCBED@@ABC@CBC@KDG@CBC@A@CBABEBCBGF@@CFABIJ@@ABADAFAF@BAB@F@BABCPABAD@DAB@D@D@BAD@J@@BB@FBFBFBFBBDDBF@@@BDDHFBBDBB@@BBBDD@@BBBBDH@@BBBDBB@BDDBBDFDDDDDDBBBBB@BBH@DBB@B@DBDBFBDDFBDBFDDBDBHDDBB@D@B@BC@AA@@@@A@@DCBAB@@A@@@@B@@@@@@A@A@@@@B@@@@ABABC@A@@@AA@BA@AB@@A@C@A@@@@CA@A@A@A@@@AA@AA@@@@BC@@@@E@@AA@@A@@@@@@@A@@B@@@@@BA@@@@@@@@@@@@@AA@@@AAAA@@BA@A@AB@@AAA@AAA@AB@BAB@@AA@A@A@@@A@@A@@@@@@@A@@B@@@BABA@@B@B@B@@@B@@@D@B@B@BABAB@@A@@@ADA@@@@@A@@AC@@BA@ABABA@AB@BAB@@AB@DB@@@ABA@@@A@@@@@A@@@@BA@@A@@A@AAA@@A@CAA@@@EAA@AAA@A@AA@@@@@A@@@@@@@@@@@AA@@@@@@AB@@BF@B@@BB@DBD@D@@BB@@@@@BAA@@AAA@A@AA@@@C@AA@@A@A@A@@AA@AB@@A@A@@AA@C@A@@@@A@@B@@@B@@@@@AA@@@@A@A@@@D@BA@@B@@@B@@@@@B@@AA@A@@@AAA@A@@@B@@A@@@A@@BA@@B@@@B@DAB@@AB@D@@B@@BB@@@@@B@B@@@@@@BB@@@BB@@@DB@@B@@@D@BBB@@AB@@@BADA@@BB@@BA@@@A@@B@@@BA@AA@@A@@@A@@B@B@@B@@B@@@@B@@B@@@B@@B@@@B@@B@@@D@@@@@@DA@@@AB@@@@@@@@B@@@@@@@@@@@B@@A@AB@@C@@@@B@BBB@@@AB@@@BB@@AB@@@@B@@@@@A@@B@@@@AA@@@DB@@@@@DAB@@@BA@@@A@ABAA@B@@@@@B@B@@@@BB@@@BBB@@B@@@@@@@@B@BA@A@@B@@@@@B@BA@ABA@AD@B@@ABA@A@@@BB@B@@BBB@DBBBB@B@@@BBBB@@@@@B@@B@B@BBBA@@@@B@@A@@@@@A@@BB@@AD@BB@@B@@BBB@@@B@@@@@B@@@B@B@@BB@@@BBB@@@B@@@B@@A@@@@@@FE@@BA@@@A@@@A@@A@@@BA@@@A@@@@@@BC@@@@B@@AB@@@DAB@@@@AB@@@BA@@@@B@@@B@B@@@@@@@@@@A@@B@@@BA@@@@AA@A@@@AB@@ABAB@B@@@D@BA@@B@B@@@@@@@@B@B@@@B@@@@B@B@@AB@@@B@@B@B@@@@@BBA@@@@B@@B@@@@B@AB@B@@B@BBBB@B@@B@@B@@FBB@@@B@B@BBD@@A@A@@B@B@@@B@B@B@BA@@BB@@BA@A@A@@@@BAB@@A@@@@@A@@AA@@B@@@B@@@B@BA@@@@@@@@B@@BB@@BA@@@@@A@@@@@@B@@@@@B@BB@@BB@BC@@BA@ABA@A@@B@@AB@@BB@@BB@B@B@@@B@@BBB@@BB@@BB@BB@B@@@F@@ABBBAFC@@@@@@@AAA@@@@B@@@BABAB@@A@@@A@A@A@@B@@@BABCCC@@@@@A@@AA@@B@@AB@@A@A@ABA@@AA@@A@@A@A@@@@B@@C@@B@DABC@AB@@@BAB@@AB@AAB@@@@@BA@@@@BABAB@@@@A@@B@@ABA@@@@BA@@BAB@@@@AB@BA@@B@@AB@BABA@@@A@CBA@@@A@@BABABA@@BA@@BA@@@@CA@AAAAA@@AAC@@@@A@A@@@A@@@AAAA@A@@@AA@@A@@B@@ABCAAB@AAAAA@AC@A@AA@@@A@A@AAA@@@AA@@AA@@AAA@@@@BAAAB@@@@@BAB@B@@@@A@@@@@A@@@AB@@A@@@@@A@@B@@@B@BBD@@@@AB@BA@@@AAA@@@A@AAA@@BCAABA@@AAA@@AA@@ABABC@A@@AA@AA@@AA@@A@@A@@AAAA@@@C@AACAA@@@@@@A@@@AB@@@BA@A@ABAB@@@BAB@B@@ABA@@BA@@B@B@@A@@BC@AA@@AB@BA@ABA@@AA@AA@@@AAA@@B@@C@A@AAA@@@@@A@AAA@A@@@A@@@AA@@AAAA@@@A@A@@@@AA@@BA@@BA@A@@@A@@AAAB@AA@@A@@AC@@A@A@@A@@AA@@A@@@@ABA@@@A@@@@BABABA@A@@B@B@@@B@BAB@@A@A@@@ABA@CB@@A@@B@@AA@@A@@@@D@@A@@@@D@B@@@B@D@@@@B@B@B@B@@@B@@@BD@@BA@B@@B@@@@@@B@@@@@@A@@@A@@@@@@BA@A@AA@@@@ABAB@@AB@@@AA@@AAB@@@@@B@B@@ABA@@B@B@@@BAB@@@BAB@@AB@B@@ABA@@BABA@@BA@A@@@A@ABC@AB@@C@@@@@A@@@A@@@@ACBAAA@A@@B@@B@@B@B@@@B@@A@@BAA@A@@@@@AA@@@A@@B@@@B@@@BA@@@@@AA@@A@@@A@@@@@A@@@A@@BA@ABABAB@@AB@@@B@@@@A@@B@@A@C@@@A@@@@@A@@D@ABB@B@B@@@B@@AB@@A@@@@A@@@A@BA@@@@@ABA@A@@@@@@@@@AB@AA@@@AAA@@@@@@A@@@@@@AA@A@@A@@B@BA@@D@@@BAB@B@D@@@BB@BBA@B@A@@@A@@@A@@BA@@@@@A@@B@@A@@D@BA@@B@@B@@B@@@@AB@@ABBBA@@B@@A@@BA@@@@AA@@@@BA@@BABA@A@@@@@A@@B@@@@A@@BB@A@@@@BAAA@@B@@@B@@@BBB@@@B@@@ABBBB@B@BA@@@B@@B@B@@A@@D@@@BDBB@@BA@@B@D@D@B@@@@B@@@B@@@@B@B@@B@BAB@@@@@@@@@@B@B@@@BB@@B@@@BBB@B@@B@@@BB@B@@@B@@AB@@@B@@@@B@@DB@@B@@@B@@@B@@@@BB@B@@@@BB@B@@@B@@@@B@@F@@@@@B@@A@A@ABC@@B@D@@@@A@ABAB@@AB@@AAC@@BA@@B@BA@@@A@@@ABA@@@A@AAA@@B@AAB@@AB@@A@@AC@@CC@@AA@A@@@@@@AA@AB@BABCDCB@@A@@@AB@BAB@@AB@B@BABA@@BAB@DB@@BB@BD@@BB@@BB@BBB@BB@@@@BD@@@B@@B@B@D@D@BAB@@A@@B@B@@ABB@@@@D@D@@@B@B@B@B@B@@@@A@@@AA@A@@@@CAA@A@@@AB@@@B@BA@A@@BA@@B@@@@ABA@A@@BAA@@A@@@AA@AAA@@@AAA@@@@A@@A@AAAA@ABA@@@A@@@C@@@@DCB@@AB@B@B@BA@@@AB@@AB@@A@A@@@A@@BA@A@@A@@A@@@AA@@A@A@@B@@AA@@A@@D@@@BAB@@BB@@@B@@AB@@@@@B@@B@@B@@@B@@@BBB@@B@@@@B@@BBAB@@BB@@@B@B@@@@@@@D@BAD@@@BB@@B@B@@@B@@B@@B@@@@BBA@BBB@@@B@@@@@B@@B@@BB@@BB@@@B@@ABA@@@@BABA@@@@BC@AB@@AB@@ABA@@B@@A@@BA@@B@@A@@@AAA@A@@@A@@B@@@B@@@B@@A@@@B@@B@@AA@@A@@@@A@A@@@@@@C@A@@@A@@@A@AB@@AB@B@B@@AB@B@B@BABA@@@@@A@@@A@@B@B@B@B@BA@@@@@ABA@A@A@@BA@@@B@@B@@@@AB@@@@A@@@@@ABA@@@A@@@EB@@A@@B@B@B@BBF@@@BA@@B@@@B@@@BAB@@@BB@@BD@@B@@@B@@@@@@@B@@@B@@A@@@@B@@@D@@A@@@@AA@@@@@A@@AC@@A@A@@@BA@@@AB@@@@@@@B@A@BB@@@@B@@BBA@@@@@@AA@A@@A@@A@@B@@@B@@@@A@@@@B@BBB@@@B@@A@@BB@A@@A@@@B@@A@@@@@A@@B@@ABB@@@BB@@@@@@B@BB@BAD@@AB@@@B@BAFA@A@@@AC@A@@@@@@AB@DA@@B@@A@@@A@@BA@@BAB@@AB@@ABA@@@A@@BA@@BA@@@@@A@@@A@A@@@A@A@@D@BA@@B@@@B@@@BAB@@A@C@@BA@@@A@@@@BAA@B@@@BA@ADA@ABAA@@@AAA@@@B@@@F@@AAA@@@AB@A@AAA@@@CA@@A@@@A@A@A@A@@@@@CA@@A@A@@@@A@AAA@@AA@@@@@@@@@A@@@A@A@@@A@A@A@AA@@@A@A@AA@@@ACA@@AAAC@@A@A@@@@A@@@@@A@@@A@C@A@ABC@ABAA@@@AAAAA@@@A@@@A@@@A@@@A@@AA@@@@AACA@@@@B@@@@@@AA@@@BAA@@@@A@AB@@@@@@@@@@@@@A@@@@@@A@@@@A@@@A@A@@@@@@A@C@ABC@AAC@A@BBA@B@@@@B@@@@B@@@@@AB@BA@@@AB@@@@A@@@@B@@@@AB@@@@@@B@@@@@A@@BA@AB@@@AABA@A@@BA@A@@BAB@@@@CBABAB@@A@@@A@@AA@A@@@@AA@A@@@@@@@A@@A@@BA@@AA@@AAA@@AA@@@A@@@A@@@AA@AAA@@A@@@@@A@@@@@A@A@@@AA@A@@@@AA@@@@@@A@@@@@A@A@@BAA@@@@@A@@AABA@@BA@@@@@A@B@A@@@@@@@A@A@@@@@BABA@A@@@@@@BB@@@A@@@@BAA@@AA@@@@AA@@@@BA@@BA@@@@AA@@AA@AA@AA@@C@@AA@A@AAA@E@@@A@A@@@@@@A@@@@@A@@@@A@CAC@@@A@C@A@A@A@@@@@@@AB@BA@@A@@AB@@AAA@@A@@AA@@@CA@AA@@ABABA@A@@AA@AAAAABCB@AA@@A@@AB@@@@A@@@@B@@@@B@B@@@@BA@@@AB@@B@BBAAC@CAA@A@@AAA@AA@@@@A@@@A@@@@@ACA@@@BA@@BA@@B@@@@CB@@C@@BA@A@ABCA@@AA@@@B@B@@@BB@AB@@BB@@AB@B@B@@@B@@BB@@A@@BA@@@@@@A@@AAA@@@AB@BABA@A@@B@B@B@@@@@DAB@B@@ABA@@@ABA@A@@@AAA@@@A@ABA@C@A@@AAA@BAB@@@@AB@@@B@BAB@B@BB@@BA@ADAB@@@B@B@B@@AB@@@@ABC@ABA@@B@B@@@BB@@BCBA@A@@@@B@@AB@@@@AD@@@B@B@@@B@@DB@@B@@B@@@BAB@@AB@D@B@B@@AB@@A@ABA@@@A@A@@@A@AB@@AAA@@B@@@@@B@@ABA@A@A@AB@BA@@BAB@D@@@BCB@@AB@@BB@B@@BB@@@@@BA@BB@@@@AB@@@@@B@B@@B@@BB@@B@@@BAD@B@@A@C@ABA@@@ABA@A@@@ABAB@@A@@BA@@B@BAB@B@@@B@@@B@@@B@B@@AB@@CB@B@BA@@@CBCBA@@@@@A@@@@@A@CB@BAA@@@BA@CBABAB@BA@@@A@C@@BA@CBABCBAB@@@@@@A@@@A@ABA@@@A@@@A@A@@B@BA@@@@@AB@@A@ABAB@@ABA@@@@@@B@B@@A@@@@B@@@BA@A@@@@BA@@B@@A@@@AAA@@@@@B@@A@@@@@@B@BA@@@A@AB@@A@@@@@@@@AB@@AB@@@BA@@BC@@@@@@B@@@@@BA@@AAB@A@@A@@@A@A@@AA@A@@BA@@@@@@@A@ABA@A@@@@BC@@@@@@@A@@@A@@@A@A@ABA@@BAA@B@@A@@B@@@B@B@@DB@B@@@@@B@@@DAB@@@@CAA@@A@@AB@@@@BD@BAB@BAAA@A@@@CB@BA@@B@@@B@B@B@@@@BB@@B@B@@BA@ABC@@B@@@BA@@A@B@@AB@@@BA@@BAB@@@F@B@@@BAB@@A@@@@@AB@@A@A@@@A@@@@B@B@@AB@@AB@B@@A@@@AA@@@BA@@@@@@A@@@@@A@@@AC@@AA@@@@AAB@@AB@@AB@@AB@@BB@@AB@@BB@@A@@B@@@@AB@@AA@@ABA@@@@@@B@@@BA@AA@@@@AB@@A@@@@A@@A@@BA@C@@@A@@@@@@B@@@@AB@@AB@@@BA@@@CA@@@@@@BB@B@@A@@@@@AA@AA@@@AB@@B@@BA@AA@@A@@@A@A@@@@B@@@B@@BB@@@B@@E@AA@@@@AA@A@A@@ABA@AB@@@BA@@@A@A@AB@B@@@@A@@@AA@@@@A@@A@@A@@@@@A@@B@@A@A@A@A@ABCBA@@@@B@B@@@@@B@B@@@B@@@BA@A@@@@BAB@@@B@AB@@BB@@@@BCBABAB@@@B@@@@@BB@ABBBA@@@ABAAAB@A@BA@@@A@@A@@A@@BA@@BA@AB@@@@AA@@AA@@@A@@@@AA@@A@@A@@A@@@A@@@A@@@@@A@@@AA@@@@A@@@@@@A@@@@A@@@@B@@A@@B@@@@C@A@A@C@@@A@C@@B@@@B@@A@A@@BA@@@@B@@@B@BAB@@@B@B@@B@@@A@@B@@@B@@B@@@@B@@@@ABCBA@@B@B@BAB@@AB@@@B@@@B@@@@@@@B@BAB@DA@@@ABAAA@@B@@@B@B@B@BA@@BA@AB@@AB@@A@@BA@@@@D@@@BBB@@B@@@@@@BAB@@@@BBB@@@@@@B@AB@@@B@BD@@@B@@B@@@@B@B@@@B@@@BBB@@@@@BBB@@@@@@B@@@@B@@@@A@@@@@@@ABB@ABB@@@@@@B@@BBAB@@@@@@@@AB@@@DB@@B@@@B@@@@@BABBF@@@BBB@B@B@@@B@@A@@@A@@AA@A@A@@@@@CA@AABA@@@A@@B@@BB@B@B@B@B@B@@@@A@@A@@AB@@@@BD@@@B@@A@@BAAAB@@@B@@@BA@@@A@A@@@@AA@@B@@@B@@@@A@EJAD@A@@@@AA@AA@@BA@@@A@@AA@@B@@AB@AA@A@@@A@@@@@AB@@@@A@@@A@@@AB@B@@ADC@@@@BA@@@@B@@@B@@@BAB@@AB@@BB@@@@@B@@DB@@A@@@A@A@@@@@@A@@A@A@@@@@@@AB@@@B@@AB@@A@@@BB@B@@@@C@@@@BBB@@ABA@@B@@@@@B@@AB@@CBA@@@C@@@@@@B@@@@@@A@A@@@A@@@ABCBBBFBBBBBBDBBDFBBDF@BBBBDBB@@FFBFDHBBBBBDBD"],encodeOffsets:[[117823,27649]]}},{type:"Feature",id:"360823",properties:{name:"峡江县",cp:[115.316566,27.582901],childNum:1},geometry:{type:"Polygon",coordinates:["@@CD@@A@@@EBA@@AA@@BA@@BAB@B@B@B@@AB@@@B@@@B@@ABA@@BA@AB@@A@@B@@@B@BA@@@A@A@AA@@A@@@AB@B@@A@@@@AA@@@@@@@@A@@@@@AB@@@@@@@@@@@A@@@A@A@@@A@@@@BA@@B@@CBA@A@@AA@ABA@A@@BA@@BA@A@@BA@@@@@AB@@@@@@AB@@@@@B@B@B@D@@@BA@@@@@BB@B@BABBA@B@@@B@B@@@BABAB@B@B@BA@@B@@@B@@@B@BB@AB@B@@AB@B@B@@@B@BAB@@C@@@A@@B@B@B@B@BAB@B@@@DBB@@B@@@@B@@@@@@B@@@B@@@@@@@@BB@@B@A@BB@@@@BAB@@ABABAB@BA@@@A@CAAA@@@AA@AA@@A@AAA@A@A@@@A@@AA@@@A@A@@@A@A@@@ABABAB@BAB@B@B@BAB@B@@@@AB@B@@AB@BA@@@@@A@@@@BA@@@@B@@A@@B@@@AA@@AACBCA@@AA@AA@@AA@@@AAA@ABA@AAAA@@@@BA@@@CAA@@@@@AB@AA@@@@AAA@@A@@A@@@AA@@A@@@@A@@AA@A@@@@AA@@@A@@@CBA@CA@@@A@@A@@@A@@A@A@@A@AAA@@@AAACA@@@AA@A@@A@A@@@@A@@@@AA@@A@@@AAA@A@A@@@@@AA@@@@A@@@@B@@@B@@@BA@A@AB@@A@@@A@@B@@A@@AC@@@@A@@@@A@A@A@@B@@@B@@@@@@ABA@@BC@AAA@A@AAA@A@@@A@@@@@@B@@ABAAA@A@@@AB@@A@@@@D@@@BAB@@@@@BA@A@@@@@ABA@ABAB@@B@@@@@AB@BA@ABAAABAB@DABB@@B@@AB@B@BA@A@@B@@CBABCAAA@@@AA@@@@@AAAAC@A@CA@@A@@@A@@@A@ABA@B@@A@@@@AA@@AA@@A@A@@@@@@AAB@A@@@AA@@@A@@AA@C@AACA@@@ABADCBADCBCDEBA@AAE@AAA@ACCACA@CC@ACAGCA@AAAA@A@@CEACAAAC@CAEACAC@@AAA@CAAAGCC@A@A@AAA@CAOAA@AB@DAB@B@@@@@@A@A@@@@B@B@@AB@@@@@BBB@BAFA@A@@@A@AA@@ABA@AA@@AA@@@@A@@@@B@@A@@BA@@@@BCBAAA@@@@@@@CAAAC@@@C@@@EAA@A@A@AB@@@@A@C@A@A@A@@@ABA@A@@@C@@D@@@B@BA@@@AB@@@BA@@@ABCBABA@AAC@A@@@A@@@A@A@@@CBA@@@@@AB@@ABA@A@AB@@A@@@DBD@B@B@B@@AB@B@D@B@B@D@@B@@@@AB@@@BA@@BA@@@@@A@AB@@A@@@A@@@A@@@A@@@A@@@C@@B@@B@C@CBC@@@CBA@A@A@@BA@@@AA@@A@@@ABAB@AA@ABA@@@@AA@A@A@@B@@A@@@A@@@AB@@A@A@@@@AA@@@AA@@A@A@@@@BA@CB@@ABA@C@@B@@A@@@CD@@@B@B@B@@@B@@ABAB@BBBAB@@A@@@@BA@@@@B@@@B@BB@@@@B@@AB@@@B@BABB@A@@B@@@@AB@@BB@B@B@BAB@@AB@@AB@BA@@A@@A@AAABA@C@A@@@@@@BCB@@@@@B@BBB@D@@@BBBBB@@BB@@@B@@@@@@AB@@@@@B@B@@@@@B@@@@B@@@@BB@@@B@@B@@B@@@@B@@B@@BB@B@@@@@B@@@@B@@@@BB@@@B@@@B@@A@@BAB@@@@A@@B@@A@A@@@A@A@@@C@@@@@A@A@@@@@@BA@@@A@@A@@ABA@@B@@BB@@@@AB@@A@A@@B@@A@@@A@@DAAA@A@@BA@A@@@AAA@@@AB@@A@A@@@AB@@@@C@@@AB@@@@ABA@@@A@A@@B@@@BAB@@@@@@ADA@A@@@@B@BA@ABA@A@A@@@ABA@CAAA@@AAA@@A@@@@AA@A@@@A@A@@A@AA@AA@@A@@@@A@@@@@AAA@@@A@@AC@C@@@C@@@A@A@@A@@AAA@@A@@A@@AA@@@A@@@A@A@A@C@@@@@@A@@@AA@@@AAA@@A@@@A@@A@@AA@@AA@@@AA@BA@@@@@A@A@@@A@@@AB@@@@ABA@E@@@A@@@@CA@@@A@A@A@@@AB@@@@@B@@BDAB@@@B@@BB@BBB@@@@@BBD@@B@@BBBB@@B@@@B@@@@@BCB@B@@@@BB@B@@BB@@@BBDA@@B@@@A@@A@@@C@@@AB@@@B@BA@@B@B@B@BBF@@@@@BA@@@A@AB@BA@B@@B@@@B@B@@A@A@@@A@@B@BB@A@@BA@@@@BABA@@B@@ABBBA@@B@@BB@BA@@B@@DB@B@BB@@B@@@@@BABCBA@@@CB@B@@@@@B@@DA@@@@@B@@@@B@BAB@@AB@@A@@FB@@AB@@AB@B@@BAB@@BB@@B@@@@@@A@AA@B@@BB@@@@A@@BA@@B@@@B@@B@B@BAB@@BBB@B@@@B@BB@@B@B@@@@BAB@@AB@@@DB@@B@B@BB@@B@D@@@@AB@B@D@D@@@FBBBB@BBBBBB@@@@BBB@B@B@B@@B@@B@AB@B@@B@@B@@AB@@AB@B@B@@@B@@B@@@B@@@BAB@@CBA@@@@B@B@@@BB@B@B@BB@@@@BAB@@@B@@BDBB@@B@B@ABABA@A@A@@B@@@@D@B@BBBBD@@@BAB@BA@@@AB@@@@@B@ABBB@B@B@B@B@@@@BA@@B@B@BBB@@@@@@ABA@@B@@@BDD@B@BB@@B@@B@@B@@BD@BA@@D@@@@@@@B@@@@@@BB@@@@AB@@BA@B@B@@@@@@B@@@@@@@BB@@BB@@@B@@@B@BBDB@@BB@@B@@@@@B@@@B@@@@@@BAA@@@@@B@@@@A@@@@@A@@@@B@BB@@@BA@@B@@@@@@BB@@B@@@@@@A@@@@@BB@@@BAB@@@@A@AA@@@@CD@@A@A@@@AB@@CB@B@@@BB@@B@@B@@BBB@@B@BB@BB@BD@@@@@@BB@BB@BB@@B@@@@BBBB@D@B@B@BB@@@@D@D@B@@A@@@AB@@@@@@@@@B@B@AA@@B@B@BA@@@A@AAC@@@A@@@A@@D@@@BA@@@@BAB@@A@@BA@A@@@@@@BA@AB@BA@@B@BABA@@D@@@@A@ABABABA@@BB@@@@@B@B@B@@AA@A@@@@AB@@@@@BBB@@@@A@@@@@@@BB@@BA@@B@BB@@@@@A@@@A@@@A@@@@A@@A@@@A@AB@@@BB@@B@@@@ABAB@@BBA@B@@BBB@@B@@BB@@B@@@BBB@@@B@B@@B@B@@@B@@BBAB@@BDAB@@ABBB@@B@@@ABBBA@@B@@A@A@AB@@A@@B@@BB@@@B@B@B@@AB@BB@AB@@BB@@@BBB@@@@BB@@@@AB@@@@AB@@@B@B@@@@@BA@@@@@@B@BAB@@B@@@BBBB@B@BAB@BB@@BBB@@@@BAD@B@B@BA@@B@@@@B@@@B@@@@@@B@@@@B@@@@BBA@@B@@@@@@B@@B@B@@@BA@@B@BA@A@@@BB@@A@@BA@@@BB@@@@@@AB@B@B@@@@@B@@@B@@@B@@B@BBB@@@@@BBB@B@@BF@B@B@@A@B@@B@@B@@BD@@B@B@@BB@@@@@B@@@B@@@BBBB@@@@B@B@BB@@B@@@F@D@BB@@BB@B@@BBABA@@@AABA@@@@AB@@A@@@@@@@A@@@@@B@B@@B@@@@@B@@@A@@B@@B@@@A@@B@@@@B@BBB@@AB@@BB@@@B@@B@@BBA@@B@@@B@B@@@B@@A@ABAB@BA@ABA@@A@@ABAD@DAD@BAB@@@B@BA@@B@BA@@DA@@@ABA@@@AA@@ABA@@@AA@@CAA@ADA@@@@B@@@@A@@B@AA@@BA@AB@@A@@B@@@BBB@@A@@BBB@@@B@@@@AB@@@@@BA@@B@@@@B@@BB@@@B@@DBDBBBB@@@@BBB@@A@@@BB@B@@B@BAB@B@B@@BBB@@@B@BB@B@BB@@BABA@@B@@@@BB@@B@BA@AD@B@B@@BB@B@@@BA@@B@@@@@@AD@@B@B@BA@BB@@@@@@@@B@@D@@@D@B@@@@@D@@B@@@BA@@@@B@@BB@@@@AB@@BBAF@B@BBB@BD@@@B@BB@@@@B@@BBB@B@@B@@@@A@@@@BA@@@@@@@@@AB@B@@@@@@@BA@ADA@C@A@BB@@@B@@A@@@@@A@@@@D@B@@@B@@A@@@A@AAA@@@CAA@@@@A@@A@@@@B@@@B@BB@@@@@AB@@A@@@@@A@A@@AAA@BA@@A@@@AA@@AABA@A@@BA@@@AA@@A@@@A@@A@B@@A@A@@CA@@@@@AB@@@B@DAB@@@@@BD@@BAB@B@B@BA@BB@@@@B@@B@@BDBBA@@BB@@@@B@B@@@B@@@B@@@B@@@B@@@B@@@@@@BB@@B@BB@BB@B@D@BA@C@A@@@AB@@@BDB@@B@@@BB@@@BA@@@@@BB@@B@@@@@B@@@B@@@@BB@@B@@@B@@B@BB@@@@AD@@B@@BD@@@B@@@B@B@@AB@D@B@@BB@@BDA@@BB@@@B@@@BBBBA@@B@B@@BB@@B@@B@BBB@B@@BBB@@BB@@D@@AB@@B@@@@B@@@@@BB@B@@B@@BA@@@@B@@BBDDBBB@@@@B@@B@BB@AB@@@B@BA@@B@@B@@B@B@D@DB@@BB@AB@B@B@BBB@B@@@B@B@D@DB@@DBB@B@@BB@BB@BB@B@B@@@D@B@B@BB@@BBD@B@D@BB@@B@@AD@@@BBB@B@@@@A@A@@BA@A@@@@@A@@@C@A@A@AB@@@BA@@@@A@@CB@@@B@@A@BB@B@@@@@@@@A@ABA@@@A@@BA@ACA@A@@A@@A@@@AA@@AAA@@BA@@@AB@@@@@BBBAB@B@@@@@@ABA@@BA@@D@@@BAD@B@@@B@@@B@BB@@DA@@B@BA@@B@@@B@@@B@D@@@BA@@B@@@@@B@@@B@BA@@B@B@@@@@B@@AB@@AB@@@@@BA@AB@@@D@DA@@BA@@DCB@B@@B@@B@B@@@@A@@@@@AB@@A@@@A@A@@@@B@B@@@B@@@BE@A@@@@B@@@BA@@@A@@BAB@BA@@BB@@@A@@BAB@@AA@@@@A@@@@B@@@@A@@DABA@@@AB@@@D@@@BA@@B@B@@@@@@AB@@@BA@@@@B@B@FADABA@@@@BA@@@@JADAB@@@DA@@CE@@AAACAA@AAACAA@ACCAGGCECECAACCAA@@@AAA@@@AA@@@@@@@A@@@@AA@@@@@@A@@A@@@@@@AA@@@A@@@CB@@A@A@@@A@@@C@@@@BA@AB@@@@@@A@@@@@@@@BA@A@@@A@@@A@@@@@A@A@A@ABA@A@A@@@@@ABABA@@@@@@BA@AB@@@@@@AB@@@@@@@@AB@@@@@@A@@@A@@@@@A@@BA@@@@B@@AB@@@B@@A@@B@B@@@BB@@@@B@@@B@@@@@B@@B@@B@@B@@@@@B@@B@@@@@@@@@D@@@BAD@B@DABA@@BAB@B@@@@@BB@@B@BAB@@@@@B@@B@@@B@@B@B@@@@B@@@BBB@@@BBB@@BAB@@@@@@A@@@@B@@@AA@@@@B@@@@ABADA@@@@@AB@@@BA@@@@B@BBBBB@B@BBB@@@AB@@ABABAB@@@@@BB@@BB@D@@BB@@A@@BABAB@@@D@B@B@@@BB@@BBDBB@@BB@B@@@B@@@B@@BB@B@B@@@B@B@@BB@@@BA@@BB@@B@B@bS@@@@@@A@AB@@@@A@A@@@@AA@@@BA@ABA@@@ABA@@@@BA@@@A@@@@@@@ABA@@B@@ABA@@BABABAB@D@@@@@B@B@B@@@D@@@BBB@B@@@BAB@BA@@B@@@B@BABAB@B@@ABA@@B@B@BB@@B@B@@B@@B@B@B@@@@@@A@AAA@A@AA@@@@AA@@AAA@A@@AA@A@A@A@A@@A@@@@ABAB@BA@AB@@@BADAB@@@BC@@B@@@B@@@BA@@@A@A@@BA@@@@@@BB@@@@@@@@B@@@@@@@@@B@@@BB@@@@B@@@@ABB@@BA@@B@BB@@@B@@B@@B@B@B@@B@@@B@@A@@BADCBCB@D@BABADC@AB@@@@B@@ADCF@@@B@@B@B@B@B@B@@AB@@ABA@A@@@CB@@@@@@@BA@B@@@B@@B@@@@@AA@@B@B@@B@@B@@@BA@@@@@A@@BA@@AA@@B@@A@@@A@@@AB@@A@@@ADA@A@A@@A@@AA@@B@@A@A@@@AB@@@@@BAD@@A@@@A@@@@A@@A@A@@@@ABA@@@AB@@AB@BA@@BA@@@@@A@@A@@A@@@@@A@@BA@@@A@@@@B@@@@A@@B@@A@@@A@@@A@@@@B@@@@@B@@@@@@A@@@@A@@@@AAA@@@@@@B@@AB@@A@@B@@@@B@B@@B@@@@@@BBA@A@@@C@@@A@@@AA@AA@A@A@A@@@@@AB@BA@@DA@A@@D@B@@@B@@@@AB@@AB@@AB@BA@@@A@@BA@@BBB@@@@@@A@ABCBC@A@A@A@AAAA@@@BAA@@A@A@ABA@@B@@@B@@ABA@@B@@@@@BAB@@AB@B@B@@@DDB@BA@@@@B@@AB@BBB@B@@@B@B@@AB@@@B@@@BB@@@@D@@@@@BBBBB@@BB@D@B@B@@@@@B@@@AA@A@@@@@A@A@ABA@@@AAA@@AAAA@@@A@@A@@A@CAA@@AA@@@A@A@@@A@ABABAD@@@@A@@B@B@@AB@@@B@B@B@@@B@@ABA@@@@@AA@A@@@AB@@@A@@C@@@A@@@@@AA@@AC@@@A@@BA@@AAAA@@AA@@A@A@AA@@AA@A@A@@@A@@@@@A@@@A@@@AA@C@CAAACA@@A@AB@AA@AA@@CAA@@A@@A@A@@@A@A@@@ABA@@A@@@BA@@@A@@@@@@@A@@A@A@A@@@@@@@BA@@BA@A@A@A@CA@@@A@AAAAA@AAAAAA@@@AA@@A@AA@@@@@BAB@@AB@BA@@B@@@B@@AA@@@BA@@B@@AD@@@@@@@AA@@A@@C@A@@@AA@A@@@@A@@@A@@AA@@@A@@AA@@A@@A@@@@@@@@AA@AB@@@@A@A@@AA@@AA@@@AA@AA@AA@AA@@AAA@@C@A@@AA@ABA@@@@@A@A@@@A@@B@@A@A@A@@@AACA@@@A@A@@AA@@A@AAA@A@A@@AAA@@@@AA@@AA@@@AAAA@@A@AAAA@@@CA@AA@@@@A@@A@@A@@A@@@AAA@AA@@@A@AA@A@@@AA@A@@A@@@@@AAAA@@A@@BA@@BA@A@C@A@A@A@@B@@AB@@@B@B@@@B@@ABA@@B@@@@C@CAA@@@A@@AAB@A@AAAAA@AA@CCAA@@A@@AABA@ABA@A@AB@@ABCB@@@A@A@A@@AA@A@@AA@BA@@@C@AB@@A@ABA@@A@@AC@C@@@A@@@AA@@A@@@A@A@A@ACA@@@AAAA@A@@@A@AA@@@@@@AA@@AAAAA@@@AA@@A@AA@@AAA@AAA@A@@@@@ABAB@BA@@@CB@@AB@BA@@@A@AB@B@@A@A@C@@@@@@BA@@@@B@B@@@BA@A@@@@BA@@@ABA@A@@BC@GBA@@B@@AB@@B@@B@@@@@B@BA@@B@@BB@@@BBB@BABABAB@BA@AB@B@D@B@@"],encodeOffsets:[[118098,28106]]}},{type:"Feature",id:"360824",properties:{name:"新干县",cp:[115.387052,27.740191],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@@@AB@@AAA@A@AA@@A@ABA@@@C@@AA@@@@@A@@@A@@@A@@@@@CA@BA@@@A@@@A@AA@@@AA@AA@@A@@@@@AB@@A@@B@@A@@@@AAB@@C@@A@@ACAA@@A@@CAAAA@@@AAAAA@@@A@@A@@AA@@@A@A@AAA@AAA@@AAC@AA@AA@@AA@@@@AA@@@@@A@@AA@@@@AABAAA@A@A@AAA@@@@A@@@AAAA@@@AA@@A@C@A@@@C@@@A@@@@@A@@@@B@@A@@@A@@@AB@@@@A@@@AA@@A@@@A@@@AAA@@@AA@@A@@@@AA@@@C@@@A@A@C@@@@CA@@@@A@@@@A@A@A@@@@AAA@@A@A@A@@@A@@BA@A@@@@B@@C@ABAB@B@B@@@B@B@@BB@@BB@D@BB@@@@B@@BBBB@@BB@B@@AB@B@B@B@@@@@BBB@@A@@@@@A@A@C@A@@AA@AAAA@@@@C@@@@@AA@@A@@@A@@BA@A@@@A@A@AAA@@BA@@@@@ABA@CC@@A@A@A@@BA@AB@@@@A@@@AB@BA@@@A@@@AB@B@B@BB@AB@@B@BB@B@B@B@BADAD@B@B@@@@A@AA@@AB@@@B@@ABA@@BA@@BA@@B@@A@@@A@C@@@@BCB@@ABA@@B@@@@@B@B@BBBB@@B@@@B@@@D@@@BAB@A@@@@A@@@@A@A@@A@@@@BA@@BA@@@@@@@BB@B@@B@@@@@@B@@@@A@@@@@A@@@@@@B@@@B@@@BA@@@@B@@A@@@@@@B@@AB@@@B@@@@@BB@@@@B@@@@AB@@ABA@@BA@@B@@AB@B@@B@B@@@@B@@B@@@B@@@BC@A@@@@BA@@B@B@@@@AB@@BB@@@@B@BCB@B@@@BA@@B@@@B@@@BA@@@BB@@AB@@@B@@@@AB@@A@@@@AA@A@@@BB@@@@A@@@@A@@@AAB@@@@@@A@@D@@@BAB@BA@@BA@A@A@A@A@@@@A@@DEBC@@@A@@A@@BCDABABC@A@ADCDAB@@@BA@@@A@@@@A@A@AA@@@@A@@AAA@@@AB@@AA@B@@A@@@@@AA@@A@@@@@@@@@A@@@@@@@@@AA@@@@@@AB@@@B@B@@AB@@A@@@A@@@AD@@A@CBAB@@A@@BABA@AB@B@@B@@@@B@B@B@BBB@@@BBB@BB@@B@@B@@B@BBB@B@B@@@@A@A@A@@@@AA@A@@@AAA@A@@@AB@BA@A@ABABA@@@A@@@ABA@AB@@A@A@AA@@C@@@A@A@A@@@@@C@A@ABABAB@@AB@BA@@@AB@B@@@@@@@B@@AB@@@@AB@B@@AB@BAB@@B@@B@@B@B@@@@@BAB@@@@@@@aTA@A@@@AA@@AB@@A@@AA@A@@@A@A@A@@AA@@@A@@@A@A@@AA@CAAA@@AA@@A@A@C@@@A@ABAB@@@BA@@AC@A@@AA@@A@@@@BABABA@@BA@@A@AAA@A@AAAAA@@@@@AB@@A@@B@@@@CBAB@B@@A@@@@@BB@@A@@@@@@B@@@@A@AB@@AA@A@@AA@A@@@A@@A@A@@@@A@@@AA@@@@@A@ABA@@@AA@@@@A@A@AB@@ABCBA@C@AB@@C@@@@@@@@@A@@@@A@@@@@AA@@@@AA@@@@@A@@@A@@@@@AA@@A@A@@@@BA@@@A@@BA@@@@@AB@@@B@@@@@B@@@B@@@@A@@B@@@@@@A@@B@@@@A@@BAB@@@@@@ABAB@B@@@@@B@BAB@B@B@B@B@@@@@B@@@B@@@BAB@@@@@@@@@B@@@@A@@BAB@@@@@D@@@B@@@B@BA@@D@@@B@@BB@@@@@@@BB@@@@@@@BB@@@@@B@@@@@@BB@@B@BB@@B@DBBDDBDFDFHHDBBDB@DBBB@BBBBDBB@@DF@@CB@@A@CBIB@@@@AB@@@@ABCBEBA@A@@@@@AB@@A@@B@@@@A@A@@@AB@@C@@@A@@B@@ABCB@@@B@@A@@@@@@B@@B@@BA@AB@@@B@@AA@@ABA@AB@@@B@@AB@@A@@@@@@BAF@@A@@@A@A@@@@@@B@B@@@BA@@B@@@@@B@@A@A@@@@AA@A@CD@@AB@@CBC@@@A@@BAB@@@@A@@BA@@BA@@@@@A@A@@@ABA@@@A@@@@@A@@@AB@@C@A@@@A@@@A@@@ABA@@@CB@@AAA@@@A@@@A@C@AB@@C@@@AB@@AB@B@@@@A@A@ABAA@@@@A@@B@@AB@@BB@BB@@B@@@BB@@@@BDB@BAB@@@B@@AB@B@B@@@@@@A@A@@A@BA@@@A@@DB@@@@@AB@@A@@B@B@B@D@@@B@@@@@BAB@@@B@B@@@@D@@@@BB@@ABBB@B@@@@@DBB@@BB@B@@@B@B@B@B@@@B@BB@B@@B@@@BABA@@DBDBB@@@B@@B@@BA@@BBD@@@@BD@@@DB@@B@@@B@@@BBBB@@@B@@B@@@B@B@DBB@DD@@@BB@B@B@BBB@@B@BB@BB@B@@@@@BBB@@B@@@@@DB@@@BB@@@B@B@@@@@B@BBB@@@@BAB@@@@B@BBB@@@@@B@@B@@BB@B@B@@BB@@@BBBBB@@B@@@@B@B@BB@@B@@B@@@B@@@@B@@@BAB@@@B@B@@@BBB@@@@@@B@@B@@A@@B@@BD@@BB@@BB@@BA@@@B@@BB@@B@@BD@B@B@@@@BB@@@B@@@@@B@@B@@@B@@B@@B@@@@@@B@B@@@BB@@@@@BB@B@BD@@@@@B@@@@B@@B@@@B@@@@@@@B@@@BB@@@B@@@@A@@@@@A@@B@BB@@@BB@@@@@@@@BB@@B@@B@@@@@@A@A@@@@@A@@@@@@@@@@@@@B@@B@@BBB@@@@@B@@@@@B@@BB@B@BBB@@@BBB@@@@@@B@@@@B@@B@B@BDBB@@BD@B@@D@@@@B@@@D@B@@BB@B@@@@BB@B@BAB@@@B@@@@C@@@@@A@@@@B@B@@@B@@@D@BBB@@BBD@B@B@DB@A@@@@@@B@@@@@@@B@@@@BB@B@@@@@B@B@B@FCD@B@@@@BBBBBB@@@@@@A@@@A@@@AB@BADABA@@@@B@@@@@@D@@@B@BBB@@B@@@@BA@@BD@BBB@B@@@@@BB@@@@@@B@B@@@BB@@@BD@@@@@HBBBB@@@A@@B@@B@B@@@BB@D@D@@G@@@AB@@ABABA@ABA@AB@@E@CB@BCBEFED@BABAB@B@DBB@@BD@@BBBBB@@@BBDDFDFBHD@@FBBB@@@BBB@H@BBJBDBBBD@BAB@@@BA@ABEBEBA@@@CBA@ABA@@BAB@@@F@B@D@D@BB@@BF@B@@@@@BA@@@@B@@B@B@@B@@@BB@B@@BB@A@@@A@@@@BA@B@B@BFBD@DBF@BAD@@@BA@@@A@@@@@@@@DA@AB@A@BA@@@@@AA@@@@@@@@@@@B@@AD@@@B@@@@ABB@A@@BAB@B@@BB@@@@B@@@@@@@@@@BB@B@@@@@@A@@@@D@@@B@@@@@BAB@BAB@@@@A@@B@@@@B@@@@BAB@@@@@B@@A@@@@@B@BB@@B@@@AB@@@@B@@@@B@@@B@@@@BB@@B@@@@@@@B@BAB@@@@A@@B@@A@@B@@@@@B@@@BB@@B@@@AA@AF@B@F@@A@A@A@@@@@C@@AE@@@CAAAC@A@AAE@C@A@EH@D@@@@@ACB@B@@@@@BD@@@@@@@@@A@@AAB@B@B@@@B@@AAC@@@@@A@@@A@@B@@A@@AAB@@@@@@A@@B@@A@@@AB@@B@@@@@A@A@@@@@@BB@@@A@A@@@@B@@@@B@@B@@@@@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@B@@@@A@@BB@@@@@@B@@BB@@@@A@@@A@@B@@@@@@B@@@@B@@@@@@@@@@@B@@@B@@@@AB@@@B@@@@B@@AB@@B@@B@@BB@@@B@@@@B@B@@@@B@@@@B@@@@B@@@B@B@@@B@BB@@@@@@@@BBB@@AB@@@@BB@@@B@@@@@@@BA@AB@@ABB@AB@B@@@AA@@A@A@@A@AB@A@@ABA@@@@B@@@@@@AA@@@BA@AB@@A@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@B@@@@@@B@@A@@@@@B@B@BBB@BA@@BB@@@@BAB@@@A@@@@ABB@@@@@@@@@@B@@@@@@@B@@@A@B@BBA@@@A@@@@BBB@B@BB@BB@@B@@@@@@@BBB@@@@AB@B@@@BBB@ABB@@B@B@@B@B@@@@B@@@@@B@@@@@BB@@@B@BAB@DAB@BAAA@@@@B@@A@@B@@@BA@@B@AAB@@@@@B@@@@@@@BB@@B@@@@B@@@A@@B@@B@@@@B@AB@@@@B@@@@@@@@@@@B@@@@A@A@@B@@@@@B@@@B@@@B@@@BA@A@@B@@@B@BA@@@A@@B@@AB@@@D@@B@@B@B@@@@AB@B@B@BBB@D@N@VW@A@@AC@@C@A@AA@@@@@A@AB@@@@C@@AAA@QIQG@@@A@@AAA@A@@A@A@@B@BA@@@@@@AA@@@A@@@@BABAB@BAB@@@BA@@B@@@A@@@A@@@@@@ABA@@A@@@C@@A@@@AA@AA@@@@B@@A@@@@@@B@@@@AB@@@B@@@@@D@@@A@A@@A@@@@@@@@A@@@@@A@@@ABA@@@A@@@@BA@AAA@@@@@@A@@A@@@A@A@B@@@B@@@@@B@@@@ABA@@@@C@@@@A@@@@AA@@@@@A@CA@@A@@BB@@B@@@@@@@@@@AFCPIB@@AB@BB@AB@B@BAB@BAB@B@B@@@B@@@B@B@@@BA@ABA@AB@BABA@AB@@@BC@CB@@AB@BABCD@B@BA@A@@B@@A@@@@BADA@@B@@A@@B@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@@@B@@@@@BA@@BA@@@@@@@@@@@AB@@@@@@@@@B@@@@@B@@@@@@@@@@AB@@@B@@@B@@@@@B@B@@@@A@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@B@@@@@B@@@@@BB@@@@@@@@@@@@@@BB@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@AB@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@A@@@@@@@A@@@@B@@@@@@@@A@@@@@@@A@@@@B@@@@@@A@@@@@@@@B@@@BA@@@@B@@@@@@@BB@@@@@@@@B@@BB@@A@@@A@@@@@@@@@A@@BC@@@@@@@A@@@@@@@A@@@@@A@@@A@@BC@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@BA@@@@B@@@@@@@@@@@@A@@@@@@@@BAB@@@@@BB@@@@@@@@@@@@BA@@@@B@@@BA@@@@B@@@@@@@@@@@B@@@@@B@@@@@@@@@@@@@@A@@@@@AB@@@@@B@@AB@@@@@@@B@@@@@@@BA@@@@@@@@@@B@@@@A@@@@@@B@@@@@@A@@@@B@@@@A@@@A@@@AB@BAB@@@B@@AD@@AD@BA@@B@@@@A@@B@@@B@@@B@BBB@B@B@@@@@BAB@@@B@@@@AB@@A@@B@@A@@B@@A@@B@BB@@B@@B@@@@B@B@B@@@D@B@@@B@B@B@@@B@@A@@B@B@B@DBB@BB@@@BB@@BDB@BB@@BB@B@D@@@BADA@ABA@@@AB@D@B@BAB@@@D@B@DAB@B@@@BC@AB@@C@A@@@A@@@CACBA@A@@@@B@F@B@BA@C@@@A@@D@@ABC@A@@BAB@@@@A@A@@@A@@DA@A@@@@@@@ABABABA@@A@A@@A@A@@@AAAB@@A@ABA@@@@AA@@B@@@AA@@@@AA@@@@@A@@@@BAB@@AB@@A@CBA@@B@@A@@BABAD@BA@@BAB@BA@AB@BAB@@AB@BB@@DAD@BB@@DA@@@@B@B@@A@@@@@AB@B@@AB@BA@@B@B@@@@BBBBA@@@@B@@BB@@@@AB@B@BA@A@A@@BAB@AA@@@ABA@@@@@A@@@CA@@A@A@@@C@@@A@@B@@A@ABA@A@@@AB@@A@@@@@A@@@A@A@@@@BA@A@AB@@AAA@A@@B@BA@@B@@A@A@@B@AA@@@@AA@@AA@@A@@@@AA@@A@@@A@AA@@@A@AC@A@@@A@@@@AA@A@A@@@A@@@A@@@@AA@@@AA@@A@A@@CCAA@CA@AAAC@@CAA@@ACE@A@A@@AA@@@A@AA@@A@@A@AACA@AA@A@A@AAA@@AA@ECAA@@@AAAAA@@@@A@@@AA@AAA@A@@A@AA@A@C@@@A@AAAAAAA@@AAA@@@@@AA@@@AAA@A@@@@AA@@@A@@AA@A@@AA@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@B@@@@@@A@A@@@@@@@@@A@@AA@@@@@@@B@@@BA@A@@@@@@@@@A@@@@A@@AA@@@@A@A@@@@@A@@AACAA@@@A@@A@AB@@A@C@A@CB@@@@AA@@C@@A@AAA@C@@@@BA@@@A@@@AA@A@AAA@@@@@A@@A@AA@@@@AA@A@AA@@@@@AA@AA@@ACA@CAAA@@A@@@@AA@AA@@BA@@AAAA@A@@A@@@@A@@AA@AA@@@CC@@AA@@A@@@@A@A@@AA@@@A@CA@@@AB@@A@@@AA@@D@@A@@@A@@BABC@@@A@@CA@@A@A@@@AAA@@@ACAAAAAAAA@@@A@@@@@A@@AAA@@@@AA@BA@@@@BA@@A@@AA@@A@@@@AA@@@@@@A@@@@@@@A@@@@A@AA@@A@@A@@A@@@A@@@@@@A@@A@@A@@@A@@@@@@A@@A@@@@A@A@@A@@@A@@@@@AA@A@@CC@@@AA@@AB@@A@@A@@@A@@C@@@AA@BA@@B@@@@@@AB@@@@AB@@@@@@@@A@@@@@A@@@AAA@AAC@@AC@@@@"],encodeOffsets:[[118382,28224]]}},{type:"Feature",id:"360821",properties:{name:"吉安县",cp:[114.907875,27.039787],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@@A@AB@@@A@@@@A@C@@@@BABADCB@@@@AB@@@@C@@AAA@CACA@@CA@@@AA@@@@A@A@@@@AA@AA@@@AAA@@@A@@@@ABA@A@@@@@A@@@@@@A@@@A@A@@@A@@@ABA@AB@@@@BB@@@@@@A@@BA@@@@B@@AAA@@@@@@@AAAA@@A@ABAB@@ABABA@@@@BA@A@@AA@@@A@@@A@AAA@@AAA@@A@@@@AA@@@@@@A@@A@@AA@@@@DA@@@AB@@A@A@@@A@A@@B@@@B@@A@A@A@@@C@@@A@@@ABA@ABA@@@ABAB@@@@AA@@@@@AAA@AAA@@BA@A@@A@A@@A@@A@@@A@@AA@@A@A@@@@@CAA@@A@@@AA@@AEA@@@A@@@@AA@@@@@ABAB@@@B@@@@AB@@CB@@@@DB@@AD@@@BA@AA@@A@@@@@B@BB@@A@@@A@B@@@B@@B@@@BBB@@@DB@@BBB@B@@@BBD@@@D@@@B@@@@@@@@BB@@@@@@@BFAB@@@@BA@@B@BBBB@@B@BA@@BB@@BA@ABC@@@@@ABBB@BA@@@A@A@A@AB@@C@@@@@A@@@@@@@@B@@A@@@A@@@@@BB@B@@@@@@@B@@A@@@@@A@@@@@@BB@BB@@@@A@@@@@@BAB@@@@@@A@AB@@@@@B@@@@A@@@@@@@@@@@AB@@A@@@@@@@B@@@AA@@AAA@C@@B@@@@B@@BBBA@ABA@@@@@@B@F@B@B@@@B@@@@AA@@AAA@AA@D@@EBADC@@B@@A@@@A@@@@BA@C@A@A@@@@BAB@@B@BBA@@@@BA@@@AA@@@@@@A@@B@@A@@@@AA@@@@A@@A@@B@@A@A@A@@A@@AA@@CBC@@@@@@A@@AAC@A@@@@A@@AA@@CAEAAACAIEA@CAA@@@AAB@@AAAAA@@AA@@@A@@@@B@@@B@BCB@BADA@@@@@@@A@@@@@A@@@@@@BA@@B@B@@@@AA@AAAAEAA@CACCAAEEAAA@@@@C@@@A@ABA@@@A@@AA@@@@AAAAA@A@@@AB@DABAB@BA@A@E@I@@@@B@@ABA@@BA@A@@@@@@@B@A@@@A@@BA@@A@@AA@A@@A@AAA@A@@@@C@@@AA@@@A@@@A@@@AB@@@B@@ABAA@BA@BB@@A@@@@@@B@@A@A@@BA@@A@@@@AAA@@@A@A@A@@A@@@C@A@@A@A@@AA@@A@@@@ABA@@@@@A@@@A@@@@@@A@AA@@A@CA@@@@@A@ABABA@A@C@C@@@AB@@A@@AA@AC@@@@AB@BA@@@ABA@@@A@@B@@A@@B@@A@@@A@@@@A@AA@@AA@@@A@@@@@A@@A@A@@AAAA@@@A@@@AA@CAAAAA@ACAA@@AA@A@A@@@@A@@@@@A@@B@@A@@BABA@@@@A@@@A@@@@@@A@@@@@@A@@@A@@@@A@@A@A@@AB@@B@A@@A@@AA@@@@@@A@AB@@@@@@AA@@A@A@AAA@A@@CA@B@@A@A@A@@BA@@@A@@AA@@AA@@A@@AA@@@@A@@@A@@@A@@@@@AB@BA@@DA@@@@@A@A@@B@@A@@@AA@@@@AB@BA@@@@@AA@@AB@AA@A@A@AA@@@@A@@@@B@@@BA@A@@B@@ACA@@@A@AA@@A@AB@A@@A@A@A@C@@@@@@BA@@@AAA@@@@@BA@@@A@@BC@@A@CA@@@@@@B@B@@@@@B@@AAA@@A@@@CA@A@@@@A@A@@@@A@@@A@@@@@@@@B@@@@CBA@AB@@@@@BA@@@A@@@AAA@A@@@@A@BAAA@@@@B@@AA@AAA@@A@@@@@@@@CBABAACAAA@A@@@@@A@@@@@@@@@A@@@@@A@@@ABA@ABABA@@@@@@AA@@@A@A@@@@CA@@@A@@@A@@AA@@@AAACA@@AA@AA@A@@@A@@@A@@@B@@A@@A@@@@A@@BA@A@AA@@@BA@A@AA@@A@@A@@@AA@@@@A@@AA@@@AAA@@B@@@@AA@@@A@@@@A@@@@A@@A@@@AA@@A@A@@@@@@AAA@@@C@A@@BA@A@@@@@AA@A@@@@@@@@A@@@@@@A@@@@@A@@@A@A@@@@@AA@@@@AB@@CB@@AB@@@@AA@@@@ABA@AB@BA@@@@@A@@A@A@@@@AB@@@BABABA@@@AA@@@BA@ABCB@@@B@@A@C@@@@@@@A@@B@@A@ABA@@@CBA@@B@@A@@@C@A@@@A@A@@@@@@@A@@@A@@B@@AA@@ABA@@@@@@@@D@B@@A@A@A@@@AAAA@@@AA@A@A@@A@A@@AB@@@@AB@@@@@BB@@@@B@@@@A@@@@B@@@BA@@@ABAB@B@@A@@@A@@AA@@@@@C@@@A@A@AB@@@B@@AB@@A@@B@@A@@@AAA@@@AA@@@AA@@BAA@BA@@@@@@B@BC@@@A@@@A@@A@@@@@@@@A@@BA@@@@BA@@@A@@BAB@@CB@BA@@B@@A@AB@AA@AAA@A@@@A@@@A@CBAB@@A@A@@@@@AAAA@@AD@@AAA@A@@@CA@@AA@AA@A@@BA@AB@@A@@BAA@BA@A@CDC@AB@@A@@AA@@AA@@@A@@@@AA@@AA@@AA@A@@A@@A@@AA@@A@@AAA@@A@@A@@@A@@@AAA@@@@@A@@AA@@@@@AB@@A@CB@@CBABCB@@A@A@A@AA@@@@AB@@CDA@@@AD@@ABA@@@A@A@ACABABABC@@@AB@B@B@@EBA@@@A@A@@B@@@@@B@B@B@B@B@BAB@FAB@B@@@BAB@BA@@D@B@@ABAB@@AB@@@BAB@@CB@@CD@B@@ABA@@B@@@@@B@D@B@BABA@@B@HAB@B@@BB@B@B@@@BABA@AD@@@@AB@B@D@B@@A@ABA@@BA@@@C@@@@@AD@@BD@BBD@D@BBB@D@D@@@BB@@@D@B@BBFDB@@BBB@@D@@@@B@D@D@@@B@@CB@@AD@BAF@@BB@B@DA@AD@BA@ABCBCBABA@A@@@@B@BA@CB@@@@@D@@@@BBB@@@B@D@FA@@B@DB@@B@@@B@DBDBB@B@B@D@FAB@BBB@@@BAB@BB@@BBB@@B@@BAB@BAB@BA@@B@@BBD@B@@@@D@B@@@BF@B@D@B@@ABAB@@CB@BAB@@BB@B@@BBDBBBB@DBB@B@B@B@@@@@BBBB@BB@@@DB@@@B@@A@@@@F@@@BB@@B@@@B@@@@A@A@@@@B@@BB@@@BAB@@BBB@@B@@BBBBBDBBB@D@B@@B@@BD@B@@AB@BA@@AAAA@A@@@ABA@@B@@@D@B@@@BABAB@@AB@B@@@B@BAB@@A@@@C@CB@@A@@@@@@D@@ABA@@@@BAB@@A@C@CB@BCBAB@@ADA@@BBD@BAB@BABADADCF@D@@@@BB@B@@AD@@BB@B@BB@@@B@BBB@BB@@@B@B@D@@AB@BBB@B@B@@@B@@BB@B@@BBB@@@B@@AB@@@@@@A@@B@B@@@B@DAB@@B@@@@D@B@B@BD@@BBB@@@B@@@DB@@@AB@@@B@@@B@B@B@B@BB@@DAB@@@B@@AB@@@B@@@BB@@@@B@@@@A@@B@@@@@B@B@@@BBBBBB@@B@@@B@@@@@B@B@@BB@BBBB@@B@@@@@BC@@@C@A@@BA@A@@B@B@B@@B@@B@@B@B@@BB@@B@D@@BB@AB@@@B@@@@AB@BB@B@@@B@B@@@D@@@B@B@BBB@BBB@@@@@@BB@@@D@@B@@BBB@@BB@B@@BB@@BB@@@BA@@BBB@B@@BD@@@B@@B@@@BB@BAB@@@@@@@BBBB@@B@BBB@BB@@B@@BB@BAB@DA@@B@@AB@@@@@BBB@BB@D@@@@@BBB@BB@@BB@@@@@@BB@BB@@@BBBBB@@@BBB@@B@@DB@BBBBB@@@@B@@BBBB@@@B@BB@@@@B@B@@@@B@@@@BB@A@@@DB@BBB@@BABA@@@AB@@@BCDC@@BAB@B@@A@@@AB@B@B@BA@@@@@@AA@@AABA@@B@B@BBB@BA@@BABA@AB@@@@@@@BAB@@BB@B@@@@@BAB@@@@B@BA@@B@B@@@BB@B@B@@BB@@@B@B@@@B@BBBB@BBBB@B@@@B@@@D@@@B@@@B@@A@@@B@B@@B@@@@BB@@B@@@@B@@@B@@@B@B@B@BB@@@@@B@BB@A@B@@B@@@@BB@@@BAB@B@B@@@BBBBBB@BBB@B@@@B@@BDA@B@@@BB@@@@BA@@B@@@B@@@B@@A@@B@@A@A@A@@@BBAB@@CB@B@B@@BDB@@B@@BBB@BH@@BB@@BB@@@B@@@BA@ADA@A@@AA@@@@B@@@BB@AB@BA@@B@@BBBB@B@@BB@@@@A@@BCB@@@@@B@@@B@B@@@@@@@B@B@@@BB@@@@B@B@@@@@B@@A@AB@@@BCB@@ABA@@@@B@@@BC@@@@B@B@B@BA@@@AB@B@@B@@B@BCBCFA@@@A@@@@@@B@B@BBBB@@@BDBD@@BB@@ABBD@B@B@B@B@B@@@B@B@B@BAB@@AD@@CB@@AB@@DBDBB@BDB@D@B@B@B@D@BDDFBBB@B@@@B@B@B@BABABCBABABABAB@@@@@@A@AB@@AB@@@B@B@B@@@@AA@@@@AB@BA@@BBDBB@D@B@@BB@B@B@@@B@@@@@@B@@@BBB@@D@F@@@B@@AB@B@@@B@@BB@@@B@B@@@BA@@BAB@@@BBBB@@BBA@@B@@@@AB@BBB@@@B@@A@@@E@@B@@@B@@BB@BB@@BB@@AB@@AB@B@BABADA@@B@@BDB@BBB@@BBB@BBB@BBB@B@B@B@@@@DB@BBBD@B@D@B@BBB@@B@B@D@FAB@@@BB@BBB@B@@AB@@@@BB@@@@@BA@@@@@B@@@@B@@@@@@@@@B@@@@@@@@@@B@BBB@@@@BBAB@@@B@BB@@B@@BB@@@@@B@@A@@B@@@@@@B@@BBB@D@BBB@BB@@@@@BB@@B@@@@B@B@@B@@@@A@@B@@@@@@AB@@@@@B@@B@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@B@@A@@@@@@@@B@@@@B@B@@@@BB@B@D@B@B@@@B@@@@@@B@@@B@@A@@BB@@BB@B@B@@@BBB@@@BBAB@@DB@@BB@@@B@@@B@B@B@@A@@BA@@BA@@B@@BB@@A@@@@B@@@@@BB@@@BB@@@B@B@@@B@B@@@@A@@@A@AB@@@@@@@@@B@@@@B@@@BB@@@BB@BB@@@B@@@B@@B@@@B@@@@BB@@@@A@@@@@AB@@@B@@@BB@@A@@B@@@B@@A@@@@@@B@@@B@@@@@B@@BBBA@@BA@@@@BB@@@@BA@@BB@@@B@B@BAB@@B@@AB@@@@AB@BB@B@@@B@@A@ABB@@B@@@@@@@@@@@@@@BB@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@B@@@@B@B@@@@@@@@@@@@B@@BB@@@@@@@A@@@@@@@@@@BB@BBB@@D@@@@@@D@@B@@B@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@B@BB@@@@B@B@@@@@@@@@@@@@@@@@B@@B@@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@A@@@@@@@@@@B@@@@B@@@@BB@ADF@@@@@@@@B@@@@@@B@@AB@B@@@B@@@@@@@@B@@@@B@@@@B@@@@@@@@@@@B@@@@@@@@@@@BB@@@@@@@@@@@B@@@@@@B@@@@@@D@@@B@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@BA@@@ABABA@@@@@@@@@A@@@@@@@AB@@@B@@B@@BA@@@@B@@@@@B@@AB@@B@@BA@@@@@B@@B@@B@@@B@@B@@@B@@@@@@@BB@@@@BB@@@BB@@@@@@@B@@@@@BA@@@@@@@@@BBB@AB@B@@@@@@BB@BB@D@BB@@B@@D@@@@@BBAD@@BB@@B@BBBAB@BA@@@A@AB@B@@A@@@AB@@AB@@A@@@A@@@A@@@A@A@@BA@@BAB@@BB@@@@@@@B@@A@@@@BA@@@A@@B@@BB@@@@@@@@@@B@@@@B@B@@@B@@A@AD@BA@@B@D@@@@@B@@@@@@B@B@@B@@B@B@@@@@@@B@@@B@BB@@B@@B@@A@@@@B@B@@@@@B@@A@@@@B@B@@@B@B@B@@@BA@@BA@BB@@ABA@@B@@@B@BA@@B@B@BA@@@AB@B@B@B@@B@@D@@@@@B@B@B@@A@@@@@@@@@@B@@B@@@@BB@@@B@@@@@@@@B@@@B@B@@@@AA@@@@@@ABAD@BAB@@B@@B@@@B@@@BA@A@@BBB@@@B@@@B@@A@@B@B@D@@A@@@A@@B@B@@B@BB@@@B@B@@@BB@AB@BA@@@AB@@AACA@@@@A@@@BB@@AB@BABB@@BB@@@A@@@@@A@@BA@@@@B@@AA@@AB@A@@AB@@@BAD@@@B@@@B@@@@@@@AA@@@ABA@@@@@@@@B@@A@@@@AA@@@@@A@AB@@@BC@@B@@@B@BABA@ABA@@BAB@@A@@@AB@BA@@BA@A@@BB@@B@@@B@B@@BB@@B@@BA@@B@@C@C@@@@B@B@@@@A@@@A@@@A@@B@@@@BD@@@@@@@B@@@BB@@@@B@B@@@B@@BB@@B@@@@B@@@@A@@@@@AB@BC@A@ABA@@B@@ABA@@BCA@@A@@AA@@A@@A@AB@BB@@BAB@B@@@@@B@@@B@B@@DB@B@@@BAB@@AB@B@B@@@BAB@B@@ABABC@ADAD@@A@A@@A@@CB@@@BA@@@@@AB@BA@@BADA@B@@B@@B@@B@AB@BAFBB@BB@BBBBB@@BB@BB@BB@B@@AB@@@B@@B@@@@B@@@@A@A@@B@@A@@B@AAB@@B@@B@@@B@@B@@B@@DDB@B@@@BB@@@BB@BBBABBB@B@BDD@BB@@B@@AB@@A@@@A@@AA@@B@@BBB@B@BB@@BBB@B@@@@@B@B@@ABA@AB@B@@ADA@@@AD@@@@A@A@@@ABA@@B@@@@@BBBBB@BB@@@@B@BBB@@@BA@A@@@@@AB@@B@BBBD@B@@AB@BAB@@@@@B@@@B@@A@@@@@AB@@@B@B@B@BAD@@@BB@@BBB@@@D@@@B@@ADAD@B@@EB@B@@@B@@@@@@@B@@@@@@BB@@B@@@@@@B@@DB@@@B@@@@BBB@@@@@@B@B@B@@A@@B@@BB@@BB@@B@@@@@@@@@@BAB@@@BB@DBBB@@B@B@ABBB@@@AD@@@B@B@@@DA@@@ABA@@BA@@@@@AB@@A@@BB@@AB@@AB@B@@B@@@B@@@@B@@B@@B@A@A@A@@BD@BB@@@@@B@@BB@BBABBB@B@@B@B@B@@A@@BBB@@@B@@DB@@BA@@@@BB@@A@@BB@@@B@@@B@@A@DD@A@AB@@B@@B@B@@@@B@@BB@B@@@BBB@B@@B@@@@@B@@@@B@@A@@AA@@B@@B@B@@@@@CB@@A@@@@B@@B@B@@@B@@@@@ABB@@@B@@@@@@@@@@A@@@BB@@@B@@@B@B@@@B@@@@@@@@A@@BA@@BB@AB@AA@@B@@@@@@@@B@@B@@A@@@A@@@@BB@@@@BA@@@@C@@A@@@@B@@@@A@@@@@@B@@@@C@@BAB@@@@A@@A@@@A@@B@@AA@@B@AAB@@AB@@@@@B@B@B@@@@AB@B@@ABB@@@@@B@@BBB@@@@@@C@BB@@@@@@@BA@@@@B@@@B@@@B@D@@A@@BAA@@A@A@@B@BA@A@@B@B@B@B@@A@@@@B@@@BAB@@AB@@@B@@@AAA@@A@@A@@@B@B@@@@@@AA@B@@@BA@@@@@AAA@@@@B@@@BA@@@C@@A@@@AA@@@A@@AA@C@@B@BAB@@@BBB@@D@@B@@@@A@@B@BA@A@AB@@@@A@BA@@@ABAA@@@@B@B@@@@AA@AAC@@@BAD@B@B@B@@BBB@@B@B@@@@@B@@@B@B@B@@@B@@BB@B@B@B@@@@@@BBB@@@B@@@@B@@@@@@A@@@AA@@A@@@@AA@@@@B@A@@AB@@@@@BA@@D@B@@A@@@BB@B@@@B@@A@@B@B@@@B@@@@A@@@@A@@@A@@@@@@A@@@@@@@A@@@@@@@@@@@AB@A@@@@B@@A@@@A@@@@A@@@@@@@@@@@AB@@@@@B@@@B@@@B@@BB@@@BBB@@@B@@@B@B@DBBB@@BBB@ABBB@@@@BB@@B@@ABB@@@@@@BB@BB@@@BA@@BAB@@@B@@@@@@@BA@@A@B@@A@@@A@@@BB@B@@@BB@@BBBB@@@@D@@@A@@AA@@A@@@@@A@@@@@@B@@@@A@@@@@@B@@@@@@@@@B@@@A@@B@@AB@@BB@@@B@@@A@@@@@@B@@BB@B@@B@AB@@AAA@@@AB@AABA@@@AB@BA@A@@B@@AB@A@@A@AB@@@B@B@@@@A@A@@@AA@B@@ABAB@@@B@@A@@@@AE@A@A@A@AB@@A@A@@BA@@D@@@B@@@@B@@@AB@AC@A@@AA@@@AAA@@@@@ADA@A@@@@@A@@@AA@AA@AA@@@AC@A@@@@AA@AAA@@@A@@BAAC@@@A@@A@@@A@@@A@A@@@A@@AA@@@A@@@A@AA@@@AA@AAA@@AA@@@@A@@@@BABA@@BC@ABA@@BA@C@C@@BAAA@@AA@@@@AA@A@@A@@@@AA@@AB@@ABA@@@A@@A@A@@@AA@A@A@@@A@@@@@A@AAA@@BCA@@@AA@@AABAAABA@@@A@@@A@@BAB@@A@@B@@@@AD@BAB@@@@C@@@@@@B@@A@@@AB@@C@@AA@@A@@AB@@@B@BB@@B@BA@@@A@ABA@@AA@@@@@AA@A@@@AAA@@@@AA@@AA@AB@@@@@BA@@AA@@B@@@@A@@@AD@@@@AB@@A@ABAAABA@@@@A@A@ABAAA@@@A@A@A@@@AB@B@@@B@B@@@B@@A@A@@B@BA@@@A@A@A@A@@@AA@@A@A@A@@@A@C@@AA@AA@@A@@@A@A@ABA@@AA@A@@@A@@ACAA@AAA@@@@@AB@@@B@B@@@@AB@@AB@@@@A@@@@@@B@B@B@@@B@BB@@@BB@B@B@BBBA@@B@B@@@BB@@B@@@BB@B@@@B@@B@BB@@@@B@B@@BB@@@BBD@@@BD@@B@@@B@@@B@B@B@@A@A@@BA@A@@@AA@@A@ABC@A@A@@BCBABA@@B@B@B@@@@@@@@@@@@@BA@@@@@@BBB@B@B@B@B@@@@A@@@@D@BB@@@@@AB@@@@ADC@@@C@A@AAAAAAA@@@A@@@@@AB@@@B@BA@@BA@@AAEAA@AACAA@@A@@@A@@BA@@DABAB@@@D@B@@@BA@@@A@@BA@@@A@@CA@A@A@@@AB@@BBAB@BA@@@@BA@@@@@@@@@@AAA@@@@@@@BAB@B@@@B@@A@A@G@C@@@@@@@A@@BA@A@@@A@A@@@@BA@@@@@@@@AA@BA@@@@AB@@A@@@A@A@@@ABA@@BADCJB@@BF@A@@@ABCBA@@@A@A@@@A@@@CA@@A@A@A@@@@AAAA@@@@BA@A@A@@BABAB@@@@A@A@@B@@A@@@@@@@A@@@ABA@@@@@A@@BA@A@A@@AAAAA@@@AAAA@@@@B@@@@A@A@A@@@A@A@@@@AAAC@AAAAAAAAA@A@C@@@A@@@AAACA@@@AAAAA@@A@@AB@@A@@AA@@@ACAAA@AA@@@@@A@@I@@@@B@@@@A@@@A@A@@A@@@@@@@@A@@@A@@@A@@B@B@@BBBB@@@B@@B@A@@BBB@BAB@B@B@@@B@B@B@@@@@@@@BABA@@BB@@BB@@@@@BBAB@@B@B@BBB@B@@A@@@@B@BA@@BABA@AB@@@D@@@B@BB@@BB@@BBB@@BB@@@@@B@@@@@@@@@B@@A@@@@@@B@B@@@B@BA@@@B@@@@B@BCAAA@@@AAACB@@@@@B@FBBBB@@@@@BB@@A@@BA@@B@B@@@@B@@@@A@@@@BA@@@@DA@@B@@@@BD@@@@@B@B@BAB@@@@@@@@@BAA@@ABA@@AAA@AA@@@@A@@@A@A@@AA@@@@@AB@@@A@@A@@@A@@@@@@@@AA@@ACA@@AAAA@B@@AB@@ABABA@@@@A@@A@@AA@@B@@@@A@@@@@@@CAAAA@@ACAA@AA@@@A@@FC@@@@@A@@AA@A@@B@@@F@BB@@@A@@@@BB@@@A@@@@@@@@B@@@BBD@@@B@@A@@BA@@@A@@A@@@@@@@@A@@@A@@@A@@@@AAAA@@@B@@B@AB@@@@@@@B@@AA@@@@@AAC@A@C@@BEBC@CBCIG@@A@@@@AB@@A@@BA@@@@@A@@BA@AB@@@@@@@AA@@B@@A@BB@@@B@B@BABA@A@AAA@@@ABA@@@C@@@@B@@A@@@@@@A@A@@@@A@@@@B@@@B@@@@@B@B@@@@A@@@@@@B@@AB@@A@@BA@@AA@@A@AA@@@@A@@@AA@@@@B@AA@@@@@@@@BBBA@@@@B@B@@@@A@ABA@A@@AAA@@A@@@@@AAA@@AAAA@@A@@A@A@@DC@@@A@@A@@AA@A@@@A@A@@@A@@@AB@@AB@@@B@@@D@@@BA@@@AA@@A@A@A@@@A@A@EEA@@AB@AA@@@@A@@@ABAA@@AAA@@AAA@@C@@@@@A@@@@AD@BA@@@A@@@AE@AA@@A@@@AA@B@@@@A@@@@@A@@@@@@@A@DK@A@AAA@A@@@AB@@@@@@A@@AA@AAA@@A@@@AA@@@BA@AB@@A@@@@@A@B@@A@@@@A@A@A@AA@@@@BA@@B@@@@@B@@A@@AA@@@@AAA@@@@AA@C@@B@@AB@@A@@@AB@@@A@@@@AAA@A@@@@BA@A@@@@@AAA@@@A@@@BA@@B@@@@@AA@@AA@@A@@@@@@@@@@BA@A@@@AA@@A@A@@AA@A@A@A@@@A@AAA@@@ABA@@@@BA@@BA@@@AB@@@@A@A@@@A@A@A@A@A@@AB@A@A@@A@@A@@A@C@@@@C@ABA@A@@@@@AAA@@@C@@@ABA@@@@@@@@@CA@@@A@@ABABA@@@A@@AAAA@@@A@@BABA@@A@@AD@B@B@@AB@B@@A@@AA@AAA@@@@AAA@@@@A@@@AB@@A@@AA@@@@@A@@@A@@@A@@CAA@@AA@@A@AAA@@@@@@AAA@@@A@@@AA@AA@@@A@AAAA@@@CA@@CBA@@@@AAAAA@@CA@A@ABC@A@@@AB@@AB@@A@@@E@A@A@@@@A@@B@@AB@@AB@@A@@@A@AAA@@AA@@A@@@A@A@@A@@@A@@@@@A@AA@@AA@@A@CB@@@A@@A@@@AA@@@@A@@A@AA@@@@@@A@@AACA@@@@CDABFDB@@@@@@@@BA@ABCA@A@@@@A@C@C@@@@BA@@@BB@BA@CA@@BABGBA@A@E@A@A@AACBE@@BCBCBABC@A@C@@AAAE@A@@BC@ABEBA@@BABA@A@A@ABA@@@IBC@A@ABABA@@DA@AB@BC@AB@@A@CAA@A@@AABA@@BCB@@ABADADCB@DAB@DAB@BAB@DCBCB@@A@@@A@ABC@A@ABE@ABA@A@A@C@CAE@C@A@@@A@@@@A@E@AAA@A@@@A@@AA@@AC@@AA@@@@AA@@A@@@A@@A@@ABA@A@CA@AA@A@A@@@@@AA@@AA@@@@A@@A@@AAC@@BA@@@AAA@@BA@@BA@@B@B@BA@@B@@@B@@@@@B@@@@BB@AB@@B@@BB@@@@@B@@@@@@A@@@@AA@@@A@@@@@@DAB@B@B@@BB@@@@@BAB@B@@@B@@ABA@@B@@@B@@AB@BA@@B@@@BB@@B@@@@B@@@@@@@@@@@B@@@@B@@@@@@AB@@A@@@@BA@@@@@@BA@AB@BB@@@@BA@@@@DD@@B@@A@@B@BB@@@@D@BB@B@BB@@BD@D@B@@@B@DAB@B@@BB@@B@B@DBB@@@D@BD@@BDBB@D@D@D@HBFDD@DBD@D@B@BBB@BBDBDDBD@BDD@DBB@@B@@@B@D@D@@ADADAF@BAFAD@F@DBB@@@HDB@BBDBD@B@D@F@B@DA@@BABABA@@@ABA@A@C@A@AAAAA@@@@A@@B@@@@A@A@@@@@AAAC@CBE@A@@BA@@BA@@BA@@BABC@AB@@AB@BAB@@@DBB@BBDBBBDDDBBFBB@@BDBDDDBBJFDBD@FDB@D@D@BA@ABEBEBCBA@@JIBADE@@HEDAFABADAB@D@DAH@LCD@DAD@BA@@FCDADALGBA@@DCDCBABA@CB@@CBI@AAA@AAAACACAAAACGAEEE@@AAACAA@ACEAACEAAACAAAAEAAAECCAEAA@AAAAA@AAA@CAA@ECEAA@AAKECACCECCCKKCAAAC@@@ABAB@@@@@A@@A@@@@@A@@B@B@B@@@B@@ABAA@BABA@AB@BA@A@A@@@@@@AC@A@@@@A@@@@@AA@@A@AA@@@A@A@@@@@C@@@A@A@ABABA@@BAB@BA@@@A@A@@@AC@AAA@@@@ABABA@@A@A@@@@@@A@@BA@@@AAA@AA@AEAAA@@CA@@A@@@A@A@AAA@"],encodeOffsets:[[117695,27583]]}},{type:"Feature",id:"360822",properties:{name:"吉水县",cp:[115.135507,27.229632],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@ACA@@@A@@@A@@@CA@@@@@AA@A@@A@A@@A@@@@@A@CA@A@@@@@@BA@@B@AA@@@A@@@A@@@@@@@AA@@@@@@@@AA@@@A@A@AA@@@@@@DA@@A@@@AA@@@@A@@@@@C@A@@@@AACA@A@AAA@CA@@A@@A@@A@A@A@@@A@@@A@@@A@@@@@AB@@@B@@CD@@AB@@@BA@@@A@@@@A@@B@@@@AAABA@@@@A@A@@@@@A@A@ABA@@B@@@BABA@A@@@C@@AB@@@@@@A@@B@@@@@@@@AA@@@A@@B@@@B@@@A@@@A@@@@A@@BA@@@A@@@@AABA@A@BB@@@@@B@@@BA@@B@@B@@B@@@B@BAB@@@@A@@BA@AD@@A@@@ABA@@@A@@@A@@@@@@@@B@@A@@@@B@@A@@@@B@@@B@@@B@@@@BB@@B@@@B@@@@BA@@B@AA@A@A@@@AB@@A@@@A@@@C@@@A@@@@@@B@BBB@@@BB@@@@@A@C@A@A@ABA@@@A@A@A@@@B@@@FB@@AB@@BB@@@AB@@@@BA@@@@@@@D@@@B@@B@BA@@BA@BBA@A@@BA@@@@B@BABA@A@@@A@@@A@A@@@@BA@@@@@@B@@@B@@A@@B@BAB@@@A@@A@@@AB@@AB@@@@@A@@A@@B@B@@@B@@@@@@@BA@A@@@@@@CAA@@CAA@@@AA@A@A@A@A@@@ABABEBADA@ABA@A@C@AAA@A@C@A@@AC@@A@@@A@A@A@@@@@@A@@@AA@@@@A@@A@@@@@@@@B@@@@@@BBA@@@@@@@A@@@@A@@@A@@@@@A@@A@A@@@ABAB@@@@@A@C@@@@@@ABABAB@@@B@BA@@@@AA@A@@@A@AA@A@A@AAA@@ABBD@@@@@@@@@B@@@@@AA@@A@@AA@@@@@B@@A@@@@@@BA@BB@@@@@@@@BB@@A@@BA@@@AA@@@AA@A@@A@@A@BB@@@D@@BBB@@@@BB@BB@@A@@@@@AA@@@BA@@@@A@@@@@@@@@A@@AA@@@AAA@A@@AB@BA@@B@@@@@B@AA@@@@B@B@@@A@@@@@A@@@BA@BA@@A@@@A@A@@@@@A@@@@@A@@@@@AAA@@A@@@AA@@@@@@B@B@@@B@B@@@@@BA@A@@@@@A@@B@@@@@@@@@@@@A@@AB@@@AA@@@@A@@@@@@@B@B@@B@@BA@@@@AA@@@@@@@A@@@@@@@A@@A@@B@A@@@@B@AA@@B@@B@@@@B@@@@@@@@A@A@@A@A@@@AB@B@@B@@BA@@@@@@A@@@@AB@@@@@BAAA@A@B@@C@A@@@@AB@@A@@@@@@B@@AB@@@@@A@@AB@@@BA@@@A@@@A@@BA@@@A@@@CBA@@A@@AA@@@@AB@@@@AB@@AB@@A@@@@@A@@@@@@@@A@@C@@@@@@AA@A@@@A@@@A@A@@@A@@@A@@@@@@@@BA@A@@@AA@@@@AA@@BA@@B@@B@@B@@@B@@@@@B@@@@@@@@@A@@@A@@@@@@AB@@@D@@A@A@@B@B@@AA@@AB@@@@AA@@@@BA@@@@@@@@AA@A@@@@AB@B@@@@@AA@AB@B@@@BA@AAA@@@@A@@@AAA@A@@AA@@@@@@A@@BA@@@A@@@A@A@A@@C@A@AA@@AA@@@A@@C@@@A@BA@@BA@@@ABA@@AA@@BAB@BA@@@AAA@@A@@A@@BAB@@A@AB@@@@AB@@@B@@@B@BB@ABA@AA@@@@A@@@AB@@@AC@@@@AAA@@AB@AA@@@CA@@@BAB@@@B@AA@A@@@A@A@@@@A@A@A@A@A@@@C@C@@@@@AA@BA@A@@@@BA@ABC@C@A@A@@@@A@@AC@@@@AAA@AAA@@AA@@AC@@AAA@@CAA@AB@@ABABA@A@@BA@ABA@@B@@@BA@CDADABA@@BBB@@@@@@@BBB@@DD@@BD@@@BA@@BA@BBA@@@BB@B@B@@AB@B@@@B@@ABA@@@AB@@BD@BA@@BA@AB@B@B@@C@A@@@AD@B@B@BA@@@@@E@@@@A@@A@@@A@A@@A@@A@A@@A@@A@@@A@@@A@@@CA@@@A@@A@@@A@@BA@@@A@A@@A@@@AA@A@AA@@A@@@AA@@A@A@@@@@@@@@@@BA@A@AA@A@@@@@@A@@@A@@A@C@C@A@@@AB@@AAAC@@C@@@@BA@A@@@@A@@ABA@A@AABA@@A@@@A@AA@@A@@@A@@@BBAB@@@@@BAA@@@B@@A@@@@B@@@@@BABAB@@AB@@@@BB@@@@AB@@@BA@@@ABAA@BA@@B@@A@@@@AA@@@ABC@@@@BA@@@@B@@@@AB@@@B@@@B@@@B@AAB@AAA@@C@A@A@AB@@C@@@ABA@@@@BB@B@@B@@@@B@@@@@@@BB@B@@BBA@@B@@@@@@@@@BAB@B@@@@ABB@@@B@@@@@@BA@@BA@@@A@A@A@BAC@@@@B@@@@@B@@@D@BA@@@@B@@A@@@A@@BA@ABAB@BAB@@@B@@@B@@@@@B@@@BB@@B@@@@AB@@A@@@A@@@@B@@BB@@@@B@B@AB@@@BA@@@A@A@@@@AA@@@@BBBABBD@@@@@B@@@B@@@@@DA@@BAD@B@B@@@BAB@@ABAB@@AB@BA@A@@BA@AB@@A@AB@@A@A@@@AB@BA@A@@@@@A@BB@@BB@@A@@BA@AB@B@@B@@B@BAB@@@@@@@B@@@B@@@@A@@@@@@@@AA@B@@AC@@A@@@A@@@A@A@A@A@@C@A@@@A@C@@@@@@BC@@@@@@BB@@BA@@@@BA@@DAB@B@@@B@BA@ABA@@@A@A@@@@BABABAB@@@@@B@@AB@B@@B@@@BB@@@BB@B@@@BD@@@BB@@BBABB@@@B@@@BAB@@AB@@BB@@@@@B@B@@BBBB@@BB@@B@@@B@B@BBB@@@@@B@BBB@D@@@@AB@BB@@B@@BBB@@AB@BABA@@BB@@BAD@@@BA@A@@@AB@@AB@BA@A@AB@@A@AB@B@BAB@@A@@B@@@B@@@@B@DBBBD@@@B@BB@BB@@@@BB@@BB@@BBB@@@BADAB@BB@@BB@BB@@ABBBAD@@BB@B@B@@BB@B@@ABA@@B@@C@AAA@@@A@@@@B@@@@@BA@@B@@@B@@@@@B@@A@A@AB@@@@A@BBAB@@@@BB@BB@@BB@@B@@BB@B@B@B@@BBB@D@@BBBBB@BBADBBA@@@AB@@@BB@@B@B@BB@B@@@B@@@B@B@@D@BB@@BBBB@BDB@@@@AB@@AB@@ABABAB@@@B@@AB@D@B@@ABABA@@BA@@@ABA@@B@@A@AB@@AB@@@@AB@BA@@@@B@@A@ABAB@@@@AB@@@@A@BBA@@BA@AB@@A@@BADCBA@@@@DA@@@@@@B@BB@@@BB@@AB@B@B@BA@@BA@@@BB@@@B@@@@DDADAB@@A@@@@B@B@B@@@BA@ABAB@@A@@@@@BB@B@@@@@@EDABAA@BE@@@A@A@@AAA@@AA@@AA@AA@@@A@A@A@@AA@@AA@@BA@@@@BAB@BAB@@ADA@@AA@@A@A@@@@@A@@@@B@@@@@B@@AA@@AA@@@@@@@@@ABA@@@A@@@A@@@BB@@@B@@@@@BA@AB@@@@@B@BAB@@AA@@ABA@A@A@@@A@A@@@@B@BC@AAA@A@@@A@EA@@@AA@@@@AAAAAA@@@@ABAA@@@@@@AA@@@@@AB@A@@@@@A@AA@@@A@@BA@A@@@@@@A@@@A@A@@@@@@A@A@@@ABC@@@A@A@AB@BA@@B@@@BBB@@@@AB@@A@@@@B@@@@@@@@A@A@@@A@@@@@AB@@A@@B@@A@CB@@A@@BA@@@@@AD@@@@@@@B@@AB@@B@@@@B@@@B@@AB@@EF@@@@@@@BA@@@A@@@A@AA@@A@@AA@A@@@A@@@@@A@@@A@AA@@@AA@@ABC@@AA@@@B@@@@@BA@@@@@ABAAA@A@@@@A@@@@AAAA@@A@A@AACAA@AA@@@AAA@@B@B@BA@@@ABCB@BAB@@A@A@@@@@AB@B@@A@A@@@@@@A@@@AA@A@@AA@@@@@A@A@@@@@ABBBAB@@@B@@A@@BA@C@@@@CA@@B@@B@@A@@@@B@@@@@A@@A@@BA@@A@@BA@@A@AAA@@@@@@DA@@B@BA@@@@@@@@@@@A@@@@@@@@@BA@@B@@C@@@@C@@@A@@@@A@@@AA@@@A@@@@A@@A@@@@AA@A@@@@B@@@BB@@BAB@@A@@@@B@@AB@@AA@@CBAB@@A@@BA@AAC@@@A@@@CA@@A@@A@@AA@@@@@@@A@A@@@@AA@@@AC@A@@BA@CBA@@@A@@@AB@@@B@@@BA@@@B@B@BB@@B@B@@BA@@@@@A@@@A@@@ABC@@@B@B@@@@@BB@@@@A@@@A@@@@B@@B@D@B@@BB@B@@@BAB@@BB@B@B@@@BBD@@@B@@B@BBB@BB@AB@@@@A@@AC@C@CAA@@AA@E@@AA@@B@@@@B@@B@@@@@@@@@@@B@@@@BBB@B@BBB@FB@@B@DBB@@@BB@B@BB@@@AB@@@@@B@@@@@B@@AB@B@@CAA@@BA@ABA@@BABAB@BAB@@BD@@@B@@@@CB@B@@@BA@ABABA@A@C@@@A@@@A@A@A@@@ABAB@@A@@@@B@@@@@@@BB@@@B@B@B@@BA@ABA@@BBB@BBB@BA@@B@BAB@@BBBB@@B@@B@@@@@@@@@@@@AB@@@@A@@@@B@@@@@@@BB@@BF@@@@@AD@@@@BBB@@B@@@B@B@BDB@@@@@B@D@BA@@BABB@@B@@@BADAB@B@@A@@@@@@B@B@@@@A@@@@@@BA@ABCD@@@B@@B@@BADA@BB@@DBBBB@BBDDDFBDB@BBFDB@DBHDDBF@FBB@BBFBDDD@BBFDDDFD@@@@ABABA@@BC@@B@@AD@BBF@BAD@@@DAB@@@@@AA@@A@@A@@@CBA@C@C@A@A@@A@A@@AA@@CB@@@@A@@AA@@@A@@B@@@BB@BB@@A@ABA@@BAB@@ABA@EBA@AAAAA@AAAA@@@@@@AAA@A@A@C@A@I@C@ABA@@BADABABADADADE@CAA@A@AAA@A@@@@B@B@@A@@@A@A@A@@@A@@B@@AB@@@@B@@B@@B@@B@@@@@D@BA@C@@@AB@@BBBB@@B@@D@@@@@@A@@@A@@@@B@@@@BB@@A@@@A@@@@@@@@B@@@B@@ABA@ABBDABA@@@@@BB@B@B@@ABA@@B@BCB@B@DBB@B@B@@@@@@A@ABA@@AA@@AA@@@@B@@@BA@A@@B@B@BCDA@@F@@@@@D@@@B@@@@A@@@AA@B@@@@B@@@@BBBA@A@@@@@@@@B@@@@@@@@@@B@B@@@BBBBBBBBB@@B@@@@A@@@@@A@@@A@@@@@@@A@@@@A@@@@@@A@@@BB@@@@@@@B@@@@A@@@@@A@@@@@AB@@BBAB@@BB@@A@A@AB@@AA@@@B@@@@@B@@@@BB@@B@B@AB@@@@@BBB@@BBA@@@C@@@@B@@@BB@@@@@@@ABCB@@BB@BA@@@ABA@@B@@AB@@@B@@A@@@@@ABA@@@@@AB@@AAB@@A@@A@AA@B@@A@@@AB@AA@@@BAA@@AA@@@@@CB@AA@@@B@AA@@@@A@@@A@A@@@A@A@A@@AA@@A@@A@@@A@@A@@A@@A@@A@A@@@A@@@AC@@AAAAA@BC@@@@@@@A@A@@A@@@AB@@@AA@@A@@A@@BCAA@@AAAAA@@@A@@@@BAB@BAD@@A@A@ABC@@@A@@@@@AB@@A@@@AA@AC@@@@@A@@A@@A@@A@@@@@@@AAB@@@@A@@@@A@@@@A@A@@BA@@@@B@@@@@@@@@@BB@B@@BDDB@B@@B@@B@B@BA@@AC@@AA@@@AA@A@A@CA@AA@@@CA@A@@@AAA@AAAA@@@@A@@@@@AA@C@AB@BA@@BC@@@@@BD@@A@@@@@C@@@@BA@@@@@@BAB@B@B@@A@A@@@@@ABAA@@@@@@@@BBA@@@@@BB@@B@@@@@BBB@@@BB@@AB@@@BA@@BA@@@@@@@@B@@A@A@@@@@A@@@A@@@@@@B@@B@B@@@@@@@@BA@@@@@@D@@AB@B@@BB@B@BABABA@A@@@A@@A@BA@@@BB@@@@@@A@@BAB@@@B@@@@AB@@@BA@@B@@B@@@JHAD@DADAF@@@D@BBD@B@@@@BB@@@A@@@@@@BAA@@@@A@@BBBB@@@@@B@@@B@@@B@@@@@@B@@@@B@@AB@@@BA@@@C@AA@@A@@@@@@@@@@B@@AA@@@@@B@@AAE@@@A@@@@BBB@@@B@@@@ED@@@B@@BBB@DB@BB@BBDB@@@@@@B@@@@@@AB@@BB@@@@B@@B@BABA@@BA@@@ABBBB@@DB@BB@@B@@@@@@B@@@B@@@@B@@BA@@@@B@@BB@B@@@B@@@@@BBB@BB@@AB@BB@AB@@@@@@@@A@ABA@A@@@@@C@@A@@A@@@CB@@@@AB@@@@@B@@A@@@@@@A@AB@@AB@@@AA@@@@A@AAEAA@@@@@A@BDBB@@B@BBADA@@@@@@A@@ABA@@@A@A@@@@@@@@BA@@@@@@@@@A@@@@@A@@AA@AA@@AA@@AAA@@@C@@@A@@BABAB@@ABA@@@@@@BA@A@AAA@A@@@BAAA@@@@A@@AA@@AB@BA@A@@@@@@A@A@A@@@A@A@A@ABA@AA@@@B@AA@@@A@AA@AA@A@@@@B@@@B@@@B@@@@@@B@@@@B@B@@@B@@A@@@@@@JB@@@@@B@@BBBDB@B@@BB@@@BA@@BB@@@BBBB@B@@DBBB@B@@@B@@@D@BBBBBBBBB@BBDBB@@@@@B@B@@@B@B@B@@A@@@@@BBBB@@B@BBBB@@@B@BAB@@@B@@@@AB@B@@@B@@@@@@@BA@@@@B@B@@A@ABAB@@@B@BAB@@@@BBBB@@@@@B@B@BB@@D@@@B@@@B@B@@ABAD@B@@@BAE@@IACDAB@@AB@B@@@B@B@@@BA@@B@@B@@ABB@@@@@@@@AB@@@@@B@B@@@BAB@@@B@@@@@@@D@H@B@BA@@@A@A@AB@@@@@@B@BB@@@@@@@@@@AB@@@@ABA@AB@AA@@B@@@B@BDB@@@B@@AB@@@B@@AB@@A@C@@@A@ABCB@@AB@@@B@@@BB@DBBBB@FBBB@@AB@@ABA@@@A@@B@@@@@B@@BBBBBB@B@B@D@@CD@B@@A@@B@@@@AAC@@@@@@B@@A@A@A@A@A@AA@@@@@@AB@@@@@@@@@@@@A@A@A@@@ABABAD@@@B@BAD@B@BB@@B@@@BAB@@@B@BA@A@A@@@A@@@A@@@AC@@C@AA@@A@@AA@A@@@@@AAA@@@@A@@@AAA@@A@@@AA@@A@A@@@ABAAA@A@A@@A@@AAA@@@A@A@A@@@@@@@@B@@A@@BA@@B@@A@A@@@A@@B@@@@BB@BBBBD@@@B@@@BBB@@AB@B@B@B@@@BB@@BBB@@@D@B@@@B@B@BB@@B@@@B@B@B@B@@ABA@@@@B@BA@@@A@A@@@A@A@@B@@@B@B@B@@BBAB@B@B@B@@B@BABBBAB@@@BA@@@@BC@@B@@@@@@AB@@BB@@A@@@@BAB@@BB@@B@@B@BB@@B@B@@B@@@@BB@@AB@B@B@@ABA@@@AAA@@@A@@BB@@@BB@@@DA@@B@@@BA@@@@@@@@D@@A@ABC@@B@@A@@@@BA@AB@@@B@@@B@@ABBBABBB@@BB@@B@AD@@BB@B@B@@@@@B@@@B@BBB@@B@B@@@@B@@AB@BA@@BB@@B@@B@@@@BBB@@@@BB@@BBAB@@@D@DAB@@AB@BAD@@ABAB@@@@@B@@B@@BB@BBB@@B@@BBB@@@B@@@B@@BB@@@B@B@@@B@@@B@@@BA@@B@B@B@@@B@@D@@B@@@F@B@BA@@@@BA@@B@@@B@B@@@@@B@@ABB@@B@@BB@@BB@@@@B@@@BB@BB@@B@@B@@@B@@@@D@B@B@B@@@B@@@B@@BB@@@@BB@BB@@@BB@B@@@D@@@D@D@@BB@@@B@BB@@@@B@@@@@@BB@@BBBB@@@@B@B@@@BBB@@@@@BB@BB@@BBDBB@BA@@B@B@B@BAB@@A@A@@B@B@BC@@@@@@BA@A@@@AB@B@@@B@BA@@@@BA@@D@@@@@BA@@B@B@@@BA@@B@BB@@B@B@@AB@B@BB@CB@@@B@@@@AB@B@@@BA@@@@AA@@@AB@BA@@@BB@@@B@@A@@@@B@B@@@@@D@@@B@B@@@B@B@@@@AB@@@@@BA@AB@@@@A@@@A@@AA@@@@@A@@A@@@@@A@A@@AA@@@@A@@A@@@@AA@@@A@@A@@A@@@@@@A@@@@@A@A@@@@BA@@@@@@@A@@AA@@AAAA@A@@@CAA@A@A@@@@DA@A@@@@B@D@B@BABBB@@@@BB@@ABA@@BA@@BA@A@A@AAA@@BA@@@@@AB@A@BA@A@A@@BA@@@A@@A@@A@A@@@A@@B@@A@@B@@@BAAA@ABABA@@@A@@@A@A@A@@DC@@B@@@@AD@B@BA@@DAB@@A@@B@B@@@BB@@B@@B@@B@B@@@BA@@B@@@B@@@@AB@B@B@@B@@B@BAB@@BBABA@@B@@@BB@@B@@AB@B@B@DA@@D@DAD@A@@@@AD@@@B@@@B@@@B@@@B@@@B@@@BAB@@@@@B@@AB@@A@@BA@@@@@AC@A@A@C@A@A@@BA@A@A@C@CA@@B@@@BAB@B@BA@@BA@@@@B@DA@@B@B@@@B@@@B@D@BBB@BADABA@@B@@A@@BA@@B@@A@A@@@CD@@@B@B@BA@@B@B@B@D@B@@@@@BAB@B@B@FB@@D@@@D@BBDB@@@@@@B@BBDA@A@@B@@AB@@@@A@@B@@@@@BB@@BBB@BA@@BBB@@@B@B@BE@AAA@A@@@@BA@@@A@A@@B@B@@@@@@@@ABA@CBAB@PBDBB@BBB@B@D@HDBBDBB@BB@@BDBDBF@DBDBBBDDF@@@BBBBBB@HDDB@BDDB@BDDD@BBB@BBF@BABCFADCDABCDAB@B@@DBBBD@B@@BB@@@B@@B@@@BBA@B@@@@B@B@@@BB@@BB@@@@@BA@B@BAB@@@B@@@B@@@DBB@D@BBBB@@@@B@@B@@BBDBBADA@@@AB@B@@A@ABA@@@AA@BA@CBABABBBAB@@ABA@@@@A@@@BABAB@BA@@@@B@B@@A@@@@BA@A@@@C@@B@@@BA@@B@B@BBBA@@@A@@@@B@@@B@B@BBB@B@BBD@@AB@BA@@@@@@@A@@@AB@B@B@@@@@@B@@D@@BB@@@@AB@@@B@@@BAB@B@@A@@@A@@@A@@B@@@@@BB@@@@B@B@B@BB@@B@@@BB@@@@@B@@B@B@@@@BBB@@B@BDBB@@B@BBB@@@@B@BB@@@B@@@@B@@DBB@DA@@B@@@B@@B@@B@B@@BB@@@@@@BB@@B@@@BB@@@BB@B@@B@@BBA@@@@B@DB@@B@@A@@B@BB@BAB@BBB@B@@BB@@BBB@@BB@ADBD@BB@@B@@@AB@@@@A@@B@@A@@B@@@@@B@@ABA@@@ABA@@@@@ABA@A@A@ABA@ABABABA@@B@B@@@B@B@@@B@@BB@@@B@B@B@BBB@@@BBB@@B@@BBDBB@@@B@@ABABABA@@BA@A@@A@@A@B@AA@@A@@@@@@A@@@A@@@@@@@@A@@A@@@AA@C@@@ABA@A@A@A@A@AB@@@D@@@BA@A@A@@@A@ABA@@@ABAA@@A@A@@@A@@@AB@@A@A@ABABA@A@@@A@A@@@AABBA@A@AAA@@@@B@@A@@@C@A@A@A@@@@BA@@@@@@BA@@@@B@@AB@B@@AB@@AB@B@BAB@@BB@B@DA@@@AB@@A@@B@@@B@B@@@B@@@@@@@@@@@A@@B@@@@@B@@@@@@B@@B@@B@@@@ABA@@B@@@BBB@B@@@B@@A@A@@@AB@@@BAB@@AB@BA@@@A@@@A@@BA@@@A@A@ABA@AB@@AB@@BB@FA@@B@@@DC@@@A@C@ABAB@@ABABABA@AAA@A@@AA@@@AB@@A@A@@@@@AA@@@BA@@@AB@HAD@@AB@B@BA@@B@@A@@B@B@@A@@@A@A@@B@@A@@@@D@B@B@@@@ABAB@@@B@@ABA@@DA@@B@@ABABA@@A@@AA@@AAAA@AA@A@@A@A@@AA@A@@AAA@A@@AAACAAAAA@@A@A@A@A@AA@BA@@@C@@B@BA@@AA@@AA@@@@CA@AA@A@A@@@A@AB@@@@AA@@BA@AA@B@AAA@@@@BA@AA@@@A@@AA@@A@A@@@@@BA@@@@AA@@AA@@@@A@AAA@@@A@@@A@@@A@@@AB@@@B@BA@A@@@@@A@@A@@A@A@A@ABA@A@C@CA@@AB@@A@AAAB@@AA@@@C@C@@A@A@A@@@A@@AAA@@AA@@AA@@ABA@A@AA@@AB@@CA@@@@A@@@A@A@AA@@@@A@AAA@AACA@@C@@@A@A@@@@A@@@@@AB@BA@A@AAA@@BA@@@ABAAA@@@A@@@A@@@@@A@ACA@@@@@A@ABA@A@ABA@A@A@@@A@AA@AA@AA@@A@@@AB@@@D@@@@@BAB@@@BAD@BC@@@A@A@A@A@A@A@A@A@@AA@A@A@@@AB@@A@@@AAA@A@A@CB@@AB@@A@A@@BAA@@AAA@A@AA@@AA@@@A@@ABA@A@AB@B@@@B@@A@@@AB@B@@B@ADA@@B@@@B@@@BA@@BABBBA@@DA@AB@@B@@@@D@@@B@@@@A@@@AA@@@BA@@AA@@@@AA@@@@BA@A@A@@A@@A@@@A@@A@@A@@@ABA@AB@BA@@B@@A@@B@@AD@BA@@B@@AA@@@@A@@@C@A@A@@@@@BB@@@@@BA@A@@@@@A@@AA@@B@@A@@@@@A@@@@@@@AB@@CB@@@BA@@B@B@@@@AB@BA@AB@@@@AA@AB@AABA@@@@A@@@AB@@A@@@A@@@@@@B@@A@@@@A@A@BC@@@A@A@@@AA@@@@@A@@A@@BA@@@@B@@AAAB@@@@AA@@A@@@@@AB@@@AABA@@@A@A@@@@@A@@@@B@@@B@@@@@@A@@B@B@@@BA@@@AB@@@BA@@@A@@@@B@@@@A@@@AB@@ABA@@BA@@@A@@@@AABA@A@@@@@A@@A@@@@@A@A@@A@AB@@@B@@@@@@A@@@@B@@A@@B@@A@@@@@A@@@A@@@A@@@A@@@AB@@@BA@AA@@@@@@A@@@A@@B@@A@@@A@@@@B@@@B@@A@@@AB@@@B@B@@@B@@A@@@@@@@AA@@A@@@@BA@@B@@@@D@@@@@A@BB@@@@@B@@@@@B@B@@@@@BCB@@@@AFABB@B@@FAFADA@@BA@@@A@@@A@AAAA@@AA@@@@A@A@AA@@A@AAABA@@@@@B@@@BB@@A@@@ABAAA@@@A@A@@B@ACABABBB@B@@A@@@@@@A@@@AA@@@@A@@B@B@@@@@@A@A@@@AB@@A@@BA@@@A@@B@AA@@@@A@@@@A@@@@@@BA@@@@@@@@@B@@@A@@BA@ADC@@B@@@@AA@@@@A@@@A@@A@@B@@A@A@@@@@BABA@@@CBBB@@@@A@@@@AAAA@AB@@@B@@@BA@@@@B@@@BB@@@A@@@@B@@@@BB@DAB@@@@@@@@@BA@@B@BA@@@@@@@AB@@A@AB@@ABA@ABA@A@A@@@A@@B@B@B@@@@@BB@@BBD@@@@BBBB@@BB@@@B@BBD@@@BBBB@BB@B@BBB@BB@@BA@@B@B@B@@AB@@BB@@@BBB@B@B@@@B@B@@BB@@AB@@B@@B@BBBBBB@@BA@@@A@@@A@A@@@@@@BA@A@@B@BAB@@BD@@@D@B@@@@@BA@@B@BABB@BB@@@@BDB@@DABA@A@@@@@AA@AAACAAAA@@EB@@@A@@@AB@@AB@B@@@A@@A@@@A@@@AAA@@@@B@@@B@B@@@@@@B@@@BBAB@@B@BB@@@B@B@BB@@@@@@@BA@AB@B@@@@BB@B@@@@@@B@@A@@BC@@B@@A@AB@@A@@B@B@B@BA@@@@B@B@BB@@B@@@@@@A@BB@@@B@@@B@BAB@@@@@@@@@@@@@@@BA@@@@@@B@@BA@B@AB@@B@@@AB@@@@B@@@B@@@@@@@AB@@@@B@@@@BA@@@B@@@@C@@BAB@AB@B@@@B@@@B@A@@@A@AB@@A@@B@@@@@@@B@@B@@ABB@@@@AB@B@@@@@@@@B@@@@@BBB@@@@@@@A@@@A@@@@@@@A@@@@BB@@B@@@@@@A@@B@@@@A@A@@@A@@@@@@BA@A@@B@@ABA@@@A@@@B@B@@B@@@B@@A@CB@@@@@BB@@@B@B@B@@@@B@@@@AB@BA@AB@@BBA@@@@BD@@@B@@@@B@@@@BB@@@@@@B@BB@@B@@@A@AAA@@BA@B@BBBBB@@B@@A@A@@@@B@@BB@BB@@B@@BB@@BB@FB@BD@B@B@@ABA@A@@@A@@BA@@AA@A@C@A@@@@A@C@A@@@AAA@@@@@@A@@B@BBB@B@@@D@BCBA@ABA@C@@@A@@BA@ABA@@@A@@@A@@A@@@@AB@B@B@@AA@@@AAA@A@@@@@@@B@@@@A@@CA@@AA@@B@@@BBBBB@@@DBB@@@@@@@@AB@BA@ABA@@@@@AB@@@BA@AA@@@A@AAA@@@A@A@@@@A@AAA@AB@BA@@@B@@@B@@@@B@B@@@@@B@BB@@B@@@@@@A@AA@@AAA@@@A@@B@@@BB@@@@B@@AA@@@@@BA@@B@BA@@B@@@@@BA@AB@@ABA@@B@B@B@@@B@@@@@@@B@@BB@@@B@B@B@BA@@B@BAB@@ABA@A@A@@@AA@@@@A@@@@B@@@B@B@@BB@@@BA@@@@B@@A@@@@@A@C@@AA@A@@ABB@BBAB@B@@A@@A@@A@@@A@B@BA@CB@@@@AA@@@@A@@@C@@@A@A@ABA@@BA@AB@@@BA@@@@@BB@@@B@@BB@D@@AB@@@AAB@@@B@B@BBB@B@B@@@BB@B@BB@@@BB@BBB@@B@@@BA@@@@B@@AB@@@@@@@BA@@@@BA@@B@@A@@@AB@BA@@@ABA@@B@@@AA@A@A@@B@@DB@@@@@BA@ABA@@BAB@@A@@A@@BA@@@@BA@@A@C@@B@@@@@B@@@BAB@@@@A@@B@B@B@B@BA@@B@@@B@@@BA@@B@B@B@F@@CBA@A@@@@@A@@@@BA@@@A@AB@B@BBBAB@@@B@B@@@@@@A@ABABA@@@@B@BA@@@@BAB@BAB@B@BABABAB@AA@@B@@A@@@@B@@@@@B@BBB@@A@@@@BAD@BAB@@@@AA@@@@@@AEA@@@AA@@A@@@@BA@@@@B@@@@@@BB@@@@@D@BO@@@@B@D@@A@@@@@A@@AAAAA@@AAA@AAC@@AA@@BA@ADA@@@@@A@AA@@@@A@@@A@@@@@@BAA@A@AA@A@A@@@A@@@@BA@AB@@A@AA@A@@A@@@AA@@AAA@@@A@AB@@@BA@A@@@A@@AA@@B@@AB@@@DA@AB@@AB@@@@@B@@AB@BAB@@A@@@@@@B@@AB@@@BABA@@B@@A@@BA@AB@@ADA@@B@@AB@@A@A@A@@@A@@@@AA@@@@@A@@@@BB@@B@@@@@AA@AB@BC@A@@@@BA@@@@A@A@@@@@@@@AB@@@@@B@A@@@AA@@@@@AA@@A@@B@@BBA@@@A@@A@@@@@B@@@@@@A@@@BBA@@@@AA@@A@A@@@A@AA@@@@BAB@@@@A@@@A@@@@@B@@@B@@@B@@B@@@@@AA@@BA@@AA@@@@@@B@@@BB@A@@AA@@B@@@@@@B@@@@B@@@B@@A@B@@@@BA@A@@@@@AA@@A@@BB@@@@@AAAA@@@@@@ABB@@@A@@A@A@A@@AB@@A@A@@A@@@AB@@@A@@@@@A@@AB@@@AA@BA@@DA@@A@@@ABA@@@@A@@@A@@B@@A@A@@@@@BA@@@AA@BA@@@@AA@@B@@A@A@A@@A@@@@@@@@BA@AA@@AA@@@@D@@@A@AA@@@BA@@@@AA@A@@@BA@@@@@@@@A@@@A@AA@@A@@@A@@@@@@@@@A@@@@A@BB@B@@@@AB@AA@@AA@@@A@@A@@A@@@@@AA@@@@CC@@@@@BAB@AA@@A@@A@@@A@@@@@@@@A@@A@A@A@@@@@A@@AA@BA@A@@@@@@B@@@BC@@A@A@@@@@@BC@A@@A@@@@A@A@@AA@@B@@A@CAAA@@A@@A@AAA@@@AA@@B@@A@A@C@@@ABA@@@@@A@A@@AA@C@@@E@@@A@A@@@AA@@@@@A@@@@@A@@@AB@@A@A@A@@@ABA@A@@@A@@@@AA@@A@@A@A@@@@A@@A@A@@AA@@@@@@A@@BA@A@@@@A@@@A@A@@@A@@B@@@B@DADC@@D@D@FB@@B@DB@AAC@@AA@@@ABA@@@@@@@@@@BAAAAB@@@@@AB@@A@@@BA@@@@@@@@CA@@C@@@@A@@B@@@@@@@@AA@BCB@@A@@@@@A@AB@@@@AA@@B@@@@A@@@@@A@@B@@A@@@@@@B@@@@@@@B@@@@A@@A@@BA@BA@@@@ABA@A@A@@@@A@@@@@@@@@CBA@A@@AB@DAB@@@BA@@@@@A@@@@@@A@@BA@@@@AAA@@@@A@@@A@@B@@@@@B@B@@A@@B@@@@@AA@@C@@A@D@BA@@@@BA@@@@AA@@@BAB@@@AA@@AA@@A@A@AB@@BDBB@@B@@@@@B@@@@@@B@@AB@@@@BB@@@BB@@@B@@@@@AB@@@@AA@@ADA@@AA@@@A@@@@A@@@@B@@ABCA@AA@A@A@@@@A@@@A@@BA@@B@@@@AD@A@BA@@@AA@@@@A@@@C@@@@@"],encodeOffsets:[[118323,27535]]}},{type:"Feature",id:"360825",properties:{name:"永丰县",cp:[115.421344,27.316939],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AA@@@A@A@ABABA@@AA@A@@@@BA@@@@CA@@@A@@AAA@@B@@A@@@C@@B@@B@A@@@@@@@@@AA@@A@ABA@A@A@A@A@@AAACA@@AA@@A@@A@@@@A@@@A@@@@@ABCB@@A@A@A@@BA@@@A@@@@BA@@B@@@A@@@@AA@@A@AA@@CA@AA@@@A@A@@@A@@@A@CB@@A@ABA@ABA@C@@BA@@@ABA@@BABAB@@A@ABAB@B@@B@@B@B@@A@@B@DABB@AB@B@@@B@BBBAB@B@@@BBBB@@@A@@@A@@BA@@B@@@BB@BB@B@@@B@B@@@@@B@BA@@@@BBB@B@B@DAB@@BD@BA@@@@B@@A@@@@@E@@@@AAB@@@B@DAB@@AB@@A@A@A@@AA@@@A@AB@@A@AAAA@A@@@@CA@@@@@@@A@@AAAAAA@A@@@A@A@@A@@@@@AB@@ABABAAA@@@AAAA@A@@@AAA@@BA@A@@@@@A@@@AAA@@A@A@CAA@A@@@AA@@ABA@@BA@@@CBAB@@A@@BA@@@@BA@A@CBB@AB@BAD@B@B@D@B@@@B@@@@@B@@B@B@@@BDBB@@DB@B@@BBB@B@@@B@@B@B@B@B@@@B@B@@@B@@@@@@@@BB@@BB@B@B@@B@B@@@DB@@@@B@B@B@B@@@B@@@DB@@B@BBB@A@@B@@BB@BE@@@A@@@B@BB@@B@AB@BCB@BAB@@A@B@AB@@@@@B@@AB@@@D@BA@AB@@A@@@A@@@ABC@@@@B@B@@@B@B@@@B@@@@AB@@AB@@@B@@AB@BA@@BA@AB@@AB@@@B@@@BA@@@CBA@@B@@@@B@D@@B@@@BEBABA@@@A@@BC@ABA@@A@A@@@@A@@A@@AA@BA@@@@B@@@@@BA@@@B@@@@BAA@@@BA@@@A@AAA@@@@@@B@@A@@@A@@@@BB@@@@BBB@@@@@@AB@AA@@@@@AAB@A@@@@@@@A@@B@@AB@@B@B@@@BD@@@B@@@@BB@@@@@BC@@@A@@@@@@BA@@@A@@@@@@@A@@@A@@@AC@@AA@@A@AB@@A@@@A@@@AB@@E@AAA@A@A@@@@BA@@F@@@B@@AB@B@@@@@B@@A@@B@@A@@@@A@@AB@@@B@BAB@B@@@@ABA@A@A@A@@@@B@@@B@@@@ABABA@A@A@@BA@A@A@@BA@@B@B@@@B@@@B@D@@@@B@B@@@@@@BB@@BA@@@@A@@@BA@@@A@@@A@@@@B@@@BBB@B@@@BA@@@AB@@@BA@@@AB@BA@@BA@@BAD@@@@ABAB@@@@AB@BA@@@A@@AA@@AA@@@@A@@@@@A@@AA@AAB@A@@A@@@@AA@@@A@A@@@AA@@C@ABC@@@@@@@A@A@@@A@@AA@AB@AA@@@@@@AA@AA@@A@@@A@@@@AA@@@@@A@@BA@@@A@@AA@AB@@C@@@@BB@@BA@@@@B@BB@@B@@@BB@@@@BAB@@@B@@@B@BA@@BA@@B@@ABA@A@A@@BA@CD@@@@@D@@@B@@AB@BBBBBB@@@@B@@B@BB@@B@BBB@@B@@@BB@AB@@@BAB@B@@A@@@AB@@@BABA@@@A@@B@@@BBB@D@@B@B@@B@@@B@@@B@B@@@BBBA@@@A@AB@@B@@D@@BBA@@BB@BB@@@@BD@@A@@B@@@B@@B@@BAB@BAAA@@@A@@@A@@B@@A@@B@BA@AB@@@BB@@@BB@B@@ABA@AB@@BB@@AB@B@@AB@@ABB@@@D@@@@B@@BB@@BBB@D@@@@B@B@B@@@B@@AB@@@B@@@@B@@BB@B@BB@@B@@@@@BB@BAB@@A@A@@BBB@@@@A@A@@B@@B@B@@B@@@@@@B@@A@@B@@B@@A@@BB@@BA@A@@@@B@BC@@@A@@B@@@@B@@@B@@@@@@@@@A@@@@@A@@@A@@@@AA@@@AB@@BB@@@@BB@@B@B@@A@@@@@@B@@@B@@@B@B@@@B@@@B@B@@B@@@@D@@@@B@@@@@@B@@@@@B@@@BA@@BA@@@@BA@@@@BB@@@BB@DA@@B@@@B@@AB@@@B@@@B@@A@@BA@@@B@@@@BA@@@A@@@@B@@@BA@@@@@B@DA@B@B@BB@A@@@@BA@@@@@B@@@@B@@AA@@@@ABA@@B@B@@@@B@B@@@@@@A@@@@@@AA@@@BBA@@@@@@B@AB@@@@B@@@@@@@B@@@@@@BB@@@@AB@@@AA@A@@@@@@@B@@@@@BB@@A@@BB@@@@@@@@@@@@@@AB@@@@@B@B@@A@@@@@A@A@@@A@A@@@@B@@B@@@BB@BB@@@@B@@@@@B@@@@@B@B@@@B@@@ABB@@A@@@B@@@@@B@@@A@A@@B@@B@A@@@@@AB@@ABA@@@BBB@B@@BB@@@B@@@@@@@@@B@@B@@A@@BB@@@@B@@@AAA@@A@@A@AA@@@C@@AAB@@@@BB@B@@B@@BB@@B@@AB@@@AA@@@@@@@@AAB@@A@@@@B@@@@A@@@@BB@@@BB@@B@@@@@A@@@@@@@@ACBA@@BB@B@B@BBBB@@@B@B@@B@@B@@A@A@@BABABA@@@@@@@D@B@@@@BABA@@B@B@@@@B@@@@@B@@@B@@B@@@@@@@B@AA@@@@@@@A@@@@@@B@@@@B@@B@@B@@@B@@@@B@B@B@@@B@@@BD@@@B@D@BBB@B@D@BAB@BCBABAFAB@B@@@B@B@B@BBB@@B@DB@@BB@D@@@@B@B@@A@@@@@@@A@@@A@AB@@@@B@@@@BA@@BA@@B@@@@B@@BA@A@AB@@@@A@@@A@@@@B@@A@@B@B@@@B@@@B@B@BA@A@A@@B@@AB@B@AAB@@AB@@A@AA@@@C@@@@@@@B@@A@@A@@B@@AA@@BA@@EA@@A@@@B@B@B@@@B@BAB@B@D@B@@@@@A@@A@@AA@A@A@@@@B@@@D@@@B@@@B@@@BA@@B@B@B@@B@AB@@A@@A@@@A@@@AA@@@@@A@@@A@@@A@@B@@@@A@@B@@@@A@@@@@@B@@@B@@@B@BA@@B@@@BCB@@AB@@@@@BA@A@A@@@AA@@@@AB@@A@@@A@@@@AAB@B@BA@B@@B@@@B@@AB@@@@@@B@@@B@@@A@@@AB@@@B@@B@@@@@@A@@@@B@@@@A@@BD@@@B@B@BA@A@@@AB@BAB@B@@@@@B@B@@@@@ABBB@B@@A@@@@B@@B@@@B@@A@@BA@@DC@@@A@@BA@@@@B@@@B@@@B@@@B@B@B@@@@BB@@@DBB@BBB@B@BD@B@@B@D@@@@@B@@@@@BB@@B@@@CB@@@@@@BBB@B@@@B@@B@@@@@@B@@B@@@@@@@B@@@B@@BBA@@@AB@@@@@@@BDBB@@@@@B@@@@B@BB@B@@B@@@@DB@@B@@@B@@@B@BD@@@@@@D@@@B@@@@@BB@@B@@A@BBC@@@@@AB@@AB@@@B@@@@@@B@BBBB@AD@BA@@@@@@B@@B@@@B@@BB@BC@@BB@@@@BA@@@@A@@@A@@A@@AA@@@@BA@@@A@@@@A@@@@@A@@@AAAC@@BAB@B@@@BB@@BB@@A@AB@@B@@B@@B@@A@@B@@A@C@BD@@@BB@@@@A@@@@BA@A@@@@@A@@@@B@@@B@@B@BB@@@@AB@@@B@@@@B@@@@@B@@A@@BA@CBA@@@BAB@D@@@@@@@@@B@@B@B@B@BA@@@@ABB@@AB@@@@B@@A@@@@@@@A@@@@@@@@BA@@@@B@@@@@B@@A@@@BB@@@@BAB@@@@@B@@@DA@ABB@@@@@@@@@AB@@@@@@DB@@D@@@@@@B@@A@@@BA@@B@@@@BABBAB@@@@@@@@@@AB@B@@BB@@BD@BCAA@@@EAC@C@@@CDCBA@@@A@@@@B@@@B@B@@@B@@B@B@@AB@@@@@@@BB@@@B@BB@@@@@@B@BB@@@BB@@@@@B@@@BAB@B@@@B@B@BA@@B@@@B@@@@@B@@@@BB@@B@B@@@F@@@D@B@@BB@B@@@@@B@BA@@D@B@B@@@@AB@@B@@BB@B@BB@@@BBDBB@@@@AB@@BB@B@@@@@@BB@D@@A@@@@B@B@@@AD@@A@@@@@@@@BABB@@BB@@@@@B@B@B@@@@B@@@@@@B@@@B@@@@BB@@BBA@A@@@@DD@@@@BB@@@@B@@@@BB@@@B@@BB@@BBA@@@@@AAAB@@@@@@B@@@@@@@@@B@@@BB@@B@B@@@B@@@@@@B@@A@@@BBB@@@@AB@@B@@B@B@@@C@@B@@BB@@BAB@@@@@@@@@BB@B@B@@@@AB@@B@@B@@ABB@@B@@A@@@@@B@BA@@@@B@@@B@@B@BA@@B@@@CB@@ABB@@B@@BA@@@B@@@@@B@@BA@@B@@@@B@BA@@BB@B@B@@@@B@@AA@B@@@@B@BB@B@@@@AA@@@BB@@B@@@@@BAB@@@@@A@BA@@@A@@@@@@A@@@@A@@@BB@@@BAA@@A@@@@@@@BB@@AB@@BB@@@@A@@@@A@@@A@@@A@@@@@B@@@B@@A@AB@@@@BBB@@@B@B@@@BB@@@@AB@A@@@B@@@@A@@@@@B@@@@B@@AB@AA@@@@BB@@B@@@@BB@@B@A@@@@@A@@B@@@@@@B@B@@@@@AB@@@@@BADA@@BBB@@@@A@@@AA@@@@@B@@@@BB@@@@@B@@@B@B@BA@@BA@@@CB@BA@@BAB@@@BA@@@ABAB@@A@@BA@@@@@@@@BA@ABA@@BA@@@@@A@@BA@@BCB@@A@@BA@@@BB@@@B@@@BAB@@A@@B@B@@BB@BB@@B@@@BB@B@@B@BA@@BAB@@@@@B@@@B@BBBB@B@AB@@@@@@@B@@@B@@B@@B@B@@@@CB@BAB@@BB@@BD@BBB@BB@BBBB@@@B@@@@@BC@A@@@@@APC@@@@@A@@A@@@@A@@@@@AB@@@@@BB@@B@@FB@B@@@@B@@B@@A@ABC@AB@@@@@BA@AAA@@@@@A@@@@@@BA@@@BBA@ABABABA@A@ABA@AB@@@@ABA@@@@@ABAB@B@B@@@@A@A@@@A@ABAAA@A@@B@B@@AB@@@@@B@@@@@BAB@DE@A@A@A@@@AB@@A@@@A@@@ABA@A@A@A@@@@B@@A@AB@@A@@@@@A@@@@D@BB@@A@@B@@AB@@@@BA@AB@@AB@BAB@@@@A@@CA@@@@B@BBB@@A@@@AB@B@@ABA@@B@@@BA@@@AB@@@@AB@@@@@@A@@BA@@@@@AB@@A@@@AA@AAA@@A@@A@AAA@@A@A@A@AAA@A@@@A@BB@@A@@BC@A@@AA@@@A@@A@@@@AB@@A@@BAB@@AB@B@B@B@@@D@@@B@@B@@B@@A@@DABA@B@@@B@@@@BB@@@@ABAAAA@BA@@@BBB@@@D@B@@@@@BA@@@@@AB@@A@@AA@A@@@A@@@@@@B@@B@@B@@@B@BAB@BA@ABA@@@ABA@A@A@@@A@@AA@@@@@@@A@@@A@A@A@@@AB@BA@@BAB@@@@A@@@ABA@@@AB@@@@B@@BA@@@@@AA@@A@@@@B@@BB@BB@@B@B@@@@A@@@AAA@@@@@A@A@@@@@@A@@@A@@ABA@@BBB@B@B@@B@B@@@B@BBB@@@B@@BAB@@A@@B@@@@AB@BABA@@B@@@@@@A@CA@@A@AAAA@@A@@@BB@@DB@@@B@@A@@@@@@@B@B@BB@@B@@BA@A@A@@B@@B@@@@B@@@B@@AB@BAB@@@B@@@DAB@BABADC@@@A@A@AAA@@@@B@@@@B@BB@@B@D@B@@@@@@B@D@BBB@@AB@@@B@@@BAB@BA@A@C@@AEAA@@AA@@AA@@@AAA@@AA@@@@@@B@BA@@@AAAA@A@AAB@@BB@B@B@@@AA@@A@A@@@@A@@A@@A@@@@@@A@@AC@@@@AB@AA@@BABA@@B@@A@@@@@@A@A@A@@AA@@@@A@@D@BA@@@A@@@@A@A@@@B@@AB@BA@@@@BAB@@@@@@@B@@@B@B@@A@@@@B@@@@A@@@AA@@@@@B@@@@@@@B@@@B@@@@@@AA@A@@@@@A@@@@@@A@A@@B@@A@BA@@@AA@@@@@@@A@@@@BA@@B@B@@@B@A@@@A@@@ABAA@AB@@@D@@A@@@B@@A@@A@@@@@BA@@@@@@A@@@A@@@@@BA@@A@@@BAA@B@@AA@@@@@@@AB@@@@@@@@@@@@@@A@ABA@@@A@@@A@@A@B@@@@A@@@AAA@A@@@@@ABA@A@A@@@@BA@@B@BA@@@AD@@@BA@@@@@@@@AAA@@@@@ABAB@@A@@@@@@AAA@A@@@A@@A@AA@AB@A@@@A@@@@A@A@@@A@@@@@BB@B@@@B@@@BB@@@A@A@@BA@@B@@@B@@FA@@BBBBBDBBB@@B@@@@@BABCB@@CA@A@@A@@AAAABA@@@AB@@@@A@C@@@C@@AA@ABA@@@@BAB@@@@@@@B@B@@@B@@AB@@AAAAAAA@@@@AA@@BA@@AA@A@@@A@A@A@AA@@A@@AA@@BA@A@A@@@AB@@AAA@AAA@A@@AAAAA@@C@AAA@@@A@@AA@AA@A@@C@AA@@AA@@@@A@A@A@@@@B@@@B@BAB@BAB@BA@@B@BA@@B@@@@@@ABA@@@AB@@@@@@@@A@CBA@@A@@A@@@@@@B@@AA@@A@@@@@AB@@A@@@A@@BBBBB@@@@@B@@A@AA@D@@ABAB@@@@B@B@@@@AB@@@@B@@@B@@B@@B@@A@@@CD@BAB@@@B@@@A@@@@@@@@AB@@@@@@@B@@B@@@@@BBA@@@@B@@AB@@@BA@@B@@@B@B@@@@A@A@@@@B@@B@@B@@@B@@@@B@@@@AAABABABDA@@@@B@B@@BBAB@B@@@BA@@A@@@A@@@@ABBB@B@BB@@B@B@B@@B@@BB@BB@B@B@@@B@@AB@@CBEBEB@@@AAAEB@B@@A@AD@@@@A@A@@@@@A@@@@@A@@A@B@@@@@C@@A@@@AB@@@@@BB@@B@@@@@@@BA@@@A@A@@@A@@B@@@BA@@@A@@@@@@B@@@BA@@@@B@@@B@@@@B@@BAB@@A@@B@@@B@@@B@@@B@@@B@@@@@BA@@@@BA@@@@@@B@@@@A@@@A@@B@BB@B@@@@@B@@@@B@@@@@BABBB@@@@@B@@AB@@AB@BA@@B@@@B@@A@@@@@@B@@AB@@A@@B@@AB@@A@A@@@@B@@@@A@@@A@@@@@@B@@@@@B@B@@ABBB@@A@@B@@@@@BB@@B@@A@BB@BA@@@@@AB@@@BB@@@@@B@@B@@@B@B@@ADB@B@@@@@@BA@@@@@@@@B@@@BA@@B@@@B@@B@BA@BBAB@@B@@A@@BABA@@B@@A@A@@@AB@@A@@DA@@B@@@@@@@B@@@@@BA@@@BB@@@B@@@@@BAB@@@@A@@A@@@@@B@B@D@@@B@@B@@BA@@@ABC@@BA@@@@BA@@@ABA@@BAB@B@@@BB@@@@B@@@BB@@@@B@BAB@@@@BB@@@@BB@@AB@@B@@B@@@B@@A@@@C@@@@@@AA@@BCB@@ABAAAB@@AB@@A@@@A@@@CB@B@AA@A@@B@@@BA@@@A@A@@B@BAB@BB@@@B@@BB@@B@BBB@BB@AB@@@B@BA@@BA@@D@B@BBB@B@@@BA@@B@@@B@BBB@@@B@B@B@B@B@B@B@B@@ADC@AB@@A@AB@@@@C@@@A@@B@@@BB@@BBBB@@B@B@@@B@BAB@B@BAB@B@B@@@@DB@B@B@@@@@B@@@B@@BBAB@B@@AB@@BB@B@BABA@@B@@@@@B@@B@B@@@D@@@DBBBB@BBB@@@@@BBB@B@@@B@@@@@DB@@BA@@BBB@B@BA@@BB@@BB@@BB@BB@@@B@B@B@@@@D@D@@BB@@BABBB@@@BA@@DBD@B@B@BAB@B@B@@@@BB@@@@@B@B@@A@A@@BA@@B@@@B@@@B@@@B@BBB@@@@@BB@@BB@@@@AB@@@@B@B@@@BB@@@B@@BBB@@A@@B@BBA@B@@BAB@@BB@@@@BAB@@@B@B@B@@BDB@@@@BB@@BB@@ABA@@@@D@@ABB@@B@B@B@B@BB@BBBBBDBB@@@BBB@BB@B@@BB@B@@@@BBBB@BB@BB@@BB@@@B@B@BBB@BB@@BBB@@@BB@@B@BBBB@@BB@@@@@@BBB@@@B@B@BB@B@@DB@B@B@B@B@@@BB@@B@@@B@@@DBD@@@BB@BAB@@@BAD@@@B@@ABB@@@BBB@@@B@B@B@@DABA@@BAB@B@BAB@BA@BB@@@BBDDB@@BBBBB@B@BBA@BB@@@B@DBD@@@@@@AB@BA@@@A@@@A@A@@BA@@@AB@B@B@D@B@B@@AB@@AB@@@BBBB@@@@B@@@@BBB@@B@B@@B@B@@BBB@BB@@B@@@@BB@@@@B@@B@@BDB@@B@BB@B@BB@BB@B@@BB@@BB@@@@BB@BB@B@B@BBB@@@BB@@@B@B@@DBBB@@B@B@B@@@@AB@@@B@B@@@@@B@BAB@@BB@D@@@BB@BB@@BBBB@@BBB@@B@@BB@@BB@B@@@@@BAB@@B@@@@@@B@@@@BB@@BB@@@B@@BB@@@B@@@@@@BBB@@B@D@@@@BB@@B@@@@@@BC@@@AB@@A@@BB@@@A@@@AB@@ABA@@BA@A@@@@BBB@@@BB@@B@BBBB@BBBBB@B@B@@DBB@B@B@B@@AB@@A@@@@@@@B@B@BB@@@@@@@B@@@B@@A@@@BB@BA@@B@B@@@B@B@@@@BB@DB@@BBB@@BBAB@@@DBBBDBD@B@@B@@@B@@@B@@@@@B@@@B@BBB@@BBB@B@@@BB@@BBBB@@AB@@@B@@BD@@BB@@@@B@@@D@@@@B@@BA@@B@B@@B@@@@AB@BA@BB@B@@B@B@B@@@@@@B@@B@@D@@D@B@B@@@D@@@B@@B@@B@@@BB@@B@BB@@B@@@B@@@BB@@B@@@@@BA@@B@@@B@@@@A@@B@@@@@B@@@D@@@B@D@B@@@BB@@B@BB@B@@@B@@B@BBB@B@B@BBBA@B@@B@@BB@@@@@B@@B@@B@@BB@@BBBD@BB@@BB@BBB@B@@BA@@BA@CB@B@@@BA@@BAB@BA@@@A@@BA@@B@@AB@@BBA@@@@@@DC@@@@@A@AB@@@@@@@BA@@@CBA@@@@@A@@BAAABA@@@@BA@@@@@AB@BA@@@@@@BA@@@@BA@@@@@AB@CC@A@AB@BABA@@@@B@@AB@B@B@@BBA@A@@@@@A@@@@@A@@A@@@@A@@@A@@@@@@B@@@B@@@B@@@@@@@@@B@@ABB@@@@@AAA@@B@@@@@BA@@@@B@BA@@@@BA@@@@@@@@@AB@@A@@A@@A@@A@@@@@@A@@@A@@B@@AB@@A@@@AB@@@@@BA@@@@A@@@@@@A@@B@@AB@@@B@@A@@@@BA@@B@B@BAB@@@@@B@@A@@BAB@BADABABABAB@@A@@AABAA@@AB@@@B@BA@@B@@@B@@A@@B@@AA@@@BABA@@BAB@B@B@@B@@@BB@@AD@B@@@B@@A@A@@B@BAF@@@BB@@BB@@BD@BB@@BB@B@B@@@@@B@BA@A@A@AB@D@@B@@@B@@@@B@BABA@@@AB@B@@@@@@B@@@BB@@@@@@BABAB@@@@BB@@@@@@BA@@BABAB@@@B@@@B@DB@@@B@@D@@@B@@@@B@@B@@@B@@@@@B@BBB@@@BB@B@@B@BB@@BAD@B@B@B@BAB@@@@AB@@AB@@@AC@@@@D@BAB@@@@A@@@AB@BA@A@A@@BA@@BAB@@BDA@@@@B@@AB@@AB@@@AAA@@@A@@@BAA@@A@A@A@@A@AA@@@@@A@C@@@@@A@@@A@A@A@@CA@@@@@@@A@@@AAA@@@A@@@A@@@@@@@A@@A@@A@A@AAA@@@@A@@@@@AA@@C@@@@@@A@A@@A@AB@@@AA@@A@@A@@@CC@@AB@@@@AAC@@@AA@@BE@@A@@@@@B@@@AA@@@@AB@@A@@A@AA@ACAA@@AA@@@A@ABA@@@A@@BA@AB@@@@A@A@EBA@A@ABA@@@A@@BB@@@ABA@@@@@A@@@@AA@A@@@A@A@AAA@@@@@AB@@A@@@A@@@A@A@A@@@ABA@A@@@@A@@@AA@A@AAA@@@@A@@@AA@@AB@@A@A@@BA@AA@@A@A@@@C@A@@@@A@@@@@A@@BA@@@@AA@@@@AA@@A@AA@@AA@@@A@@@A@@@A@@@@@A@@C@@@@@@AB@@C@ABA@A@@@AA@E@AA@@@@@@@@@A@@@A@AAC@AA@@@@@AA@C@@@@@A@A@@@@@@@@@AB@@@@A@@@A@@@AB@@@B@@A@A@A@@A@@@@@B@B@@@A@@A@@@@@A@A@@BA@A@@@ADEBA@A@AAE@A@E@CBC@AB@@A@ABAAABA@CBC@A@@AA@@AA@@@AB@@AB@B@BA@@B@@A@@B@@AB@BA@@AA@@BA@@B@B@@@B@@A@@@@@A@@BA@@@@@@@AA@@@@@@@@AAA@@B@@A@@AA@A@@@A@@B@B@@@BA@@B@@AB@@A@@A@@@@@C@@@@@@A@A@@AAA@A@@@A@@@@@@AAA@@A@@AA@@A@@@@A@C@@@@@AB@BAA@@@@AAAB@@AA@@@@A@@@@A@@@A@@BA@@@AA@A@A@@BA@@@@A@@A@AB@B@@A@@@A@A@@@@BA@@@A@@@A@A@@AA@A@A@@@AA@@@@AAABAB@@@A@@AA@@@@A@@BA@@B@@A@AB@@A@AA@@@A@@@@A@A@@@@@@AB@@@AC@@@@AB@@@A@AAA@@@@A@@BA@A@A@@@A@@BA@@@AA@AA@@A@@A@@@@@@AB@AA@A@A@@@A@@@@@@@@A@A@A@A@@@@@@@A@@@@@A@@@A@@AA@@@BA@@@@CAA@AA@@@@@BA@A@C@AA@@@@AA@C@@@@@@@A@ABA@@@@@A@@@A@A@A@@BA@@@@@A@@BAB@@ABA@@BA@A@A@@@AAA@@AAA@@@@ABABA@A@@BA@@@A@AA@@A@A@@@C@A@ABA@AA@@@BA@@AA@@@@BA@AD@BA@@BA@@BA@@@C@@@A@@AABAA@@AB@@@@A@@@AB@@AB@@@@CB@@@@@@A@@B@@A@@@@@A@@@@@A@@@@AA@@AA@AB@@A@@@A@@B@B@FA@@@B@@ABBB@B@@@B@@B@@@@@@ABA@@@@D@@@B@@@BBB@BB@@AB@@BBB@@@D@@@BA@@@BB@@@B@@BB@DA@@@@AC@@A@@A@AB@B@BA@A@@@@@A@AA@B@@@@A@@@@@A@@@AB@@A@@@@@AB@@@@@B@@@@@@A@@D@@ABA@A@AAAAABAAA@A@A@@BA@@@@BABADC@@@A@@BA@@@AB@DA@@@AA@@A@AAA@ABA@@B@@@@AB@BABC@@B@@@@@BB@@B@@@@A@AA@A@AA@@@A@@@@@@@AAA@@@ABA@A@AB@@ABA@@@ABA@@@A@@B@@A@@B@@A@@@A@@BA@@BA@@BA@@BABA@@BB@@@@@AB@@@B@BAB@BAD@BAB@B@@A@@@A@A@@@C@AB@@A@@@@DAD@@B@@B@B@@A@@BA@@A@B@@@@A@@B@@@@@B@@@@@@@@@B@@AA@B@@A@@B@@@@@B@@BB@@A@@@B@@@@B@@@@@@A@ABA@@@@@A@A@@@@@@B@B@@A@@BA@ABA@ABAAA@A@@@A@@BCD@@@B@BA@@B@BB@@BA@@B@@@D@BBB@B@@BBAB@BBB@@BB@B@D@B@BB@BB@@@DBB@B@@BB@BB@@BAB@BBBBBBB@@@B@B@@@B@B@B@BA@BB@B@DBB@B@B@@@D@@@B@D@@AB@DBB@B@B@@@B@B@@@DB@@B@@BBB@@B@B@DB@@@@B@B@D@@@BBB@@@@@B@@BBAB@BA@AB@B@@@B@@A@@D@BAB@@BB@@@B@@@B@@@@@B@@@B@@AB@@@B@@@@@@@@@DAB@@@B@B@@@B@@@B@@A@@@ABA@@BA@A@@@@B@BA@@@@@ABCAA@@@@@AAABA@A@A@@@A@@B@@ABABABA@@@A@C@C@@@A@@@ABA@ABA@@B@BAD@@@@AB@B@@ADAB@B@@AB@B@@@@A@@BABAB@B@B@@@@@B@@A@@@A@@@ABADA@@BA@A@A@A@ABA@@@AAA@@A@AA@@@A@A@A@@@A@A@@B@B@@B@@FBBBB@B@DB@B@A@A@A@@AAAA@A@@@A@@BA@@@@A@CAAACCAA@A@A@@@@@@@BB@@BB@@@@@BA@@B@@@@C@ABA@A@C@A@@@@@@AC@@@@BA@@BAB@@@@A@ABA@@AA@CBA@@@@@A@@BA@@B@B@BAB@@AB@BA@@@@@A@@@A@A@A@@@A@@@AA@@@@@CAA@AABC@A@@B@B@@@@@@AB@@A@A@@@A@A@A@@A@@@BA@@@@BA@@BC@@@@B@@@DB@@BC@@@@D@@ADA@@@A@@BABA@@@BF@@@B@B@BB@@B@B@B@@@DB@BB@@AB@BA@@@A@@A@@AAA@A@@@A@@AAA@@A@@@A@A@@BA@@@@BA@@B@@@@ABA@A@A@@@@@A@A@@@@BAB@DBBC@@@@BA@A@@B@B@@B@@@@BBB@B@@@BBBB@BB@B@BB@@B@B@B@FB@@B@BB@@B@@AB@@@FA@@BA@C@@B@@@B@@AB@@@@AB@@@@AB@B@@@@@BA@@B@@@@AB@@@@@B@@@BA@@BA@@BA@A@@BAB@D@@@@@CA@A@@BA@@BA@@@A@@B@BAB@A@@AB@@@AA@@@AA@@AA@@@@@@AA@@@AC@@A@@@@A@A@A@@@AA@@A@@@@B@@@B@@E@@A@@@@B@BC@@BA@A@A@A@@@@@AA@@ABA@@@AB@@@BA@@BAAA@A@A@A@@AC@A@A@A@@AA@@AA@@@AA@AA@@@AAA@@A@@@AAA@AAAA@AA@@A@@A@@@@@AEA@@AAA@@@@A@@AC@@@AACA@AA@@AAAA@@@AA@@@AA@A@@@A@@AA@A@@AAA@A@@AA@@AAA@@@AA@AA@AACA@A@AA@@C@@@A@@ACA@@AAAA@AA@@AA@@@A@@@AA@@@A@@A@@BC@@@A@@@@@AA@@@AA@@@A@@@@A@A@@AAA@@@@AA@A@A@AA@@A@@@@@AAA@@@AA@@@@A@@@@@AAA@@BA@@@AA@@A@@@A@A@A@@@@@@A@@@@A@@@AA@@A@@@@A@@BA@EA@@A@@@@A@@A@@@A@A@@@@@A@@@AAA@A@A@AA@@@AA@AAA@AAA@AA@@CAA@A@@@A@@@@@A@A@A@@@@AA@A@@@A@A@A@@@A@A@@@A@AAA@@@@@@@@@@AA@@@@@@A@@BA@@@AACB@@A@@@@@A@@@@@CA@@@@A@AA@@A@@BA@@@ABA@@@@@AB@@CA@@A@@A@@@A@@@AA@@A@A@@@A@CA@@AA@@AAA@@AA@@@A@@AA@@BC@A@@@@@A@@ACAA@@@A@@AA@@@A@@@@@AAC@@A@@A@A@@CCAA@AAA@@A@@@AC@@AA@ABA@@@CA@AA@A@A@@AA@@@AB@@A@A@A@A@@@AA@@@B@@A@@@@AA@AAA@@CAA@@@A@@@A@@A@BA@@@A@@B@@AB@@@@A@@B@@A@AB@@A@@@CB@@A@@@A@AB@@A@@@A@A@@@@B@@A@@BA@@BA@@@A@@@@B@@A@A@@BA@@B@@A@@@A@A@A@@B@B@B@@@B@BABA@@B@B@B@@@B@B@@@BA@@@AD@@@B@@A@@@@BA@AB@BA@A@@@@@A@@@@@A@@@@@ABA@@@A@A@@@@@ABA@@@@@C@AB@@@BA@A@@@@B@B@@@@CBA@AA@B@@@@@@CBA@@@AB@@@@ABABAB@@@B@BCD@B@@EBA@ABA@CAABABABC@CA@@@@@CAA@A@AA@@@@A@A@@@AB@@@AA@@@@BC@@@AA@@@@ACA@@AA@@@@B@@A@@@@@@@A@@@@AAA@AAA@A@@@A@@A@@A@@A@@A@@@@AA@@AAA@A@ACA@@AA@@AA@@@A@@@@AA@@@@AA@@@A@@@@@@BAB@@@BA@@@A@@@@@A@@BA@@@A@@@@BA@@@@@A@@@A@@A@@A@@A@@@A@A@@A@AA@@A@@@A@A@@@AAA@AB@@@B@@@@@B@B@B@B@@@BABA@@@@B@@@BA@ABC@@@@A@AAAAA@@AA@A@A@@AA@@@A@@@@@@@@A@@@@@@AA@@@@@A@A@@@AAA@A@@B@@@@@BB@@AB@@@@B@@B@@@@@A@@@@@A@@@A@@@A@@@A@@BA@@B@@@@A@@@@@A@A@@AA@@AAA@@@@@A@A@A@A@A@A@CAA@@@@AA@@A@@AA@@A@@A@A@@BA@A@ABA@@BAB@@A@@B@BA@@@AB@@@B@@@D@B@@@BB@@B@B@@AB@@@BA@@@A@@B@B@@@@A@A@@B@@@@A@A@@D@@@DAB@B@@A@@@@BB@@B@@A@@BABAB@@A@@@@B@@@B@BBB@BBB@@@@A@@B@B@@@BBB@@@BB@@B@@B@@B@@B@@@B@B@@@@BB@@@@BAB@@@@@B@@@@@@@B@@@@@BA@A@@BA@@@A@@@@@@@A@A@@@A@@@@B@@@@AA@@@@@@@BA@@A@BA@@@@AA@@@AB@@A@@B@@@@@@AB@@@BA@@@A@@BAAC@AA@BAAC@@@CBA@A@@@@@A@AB@AABAAEBC@A@C@ABA@A@A@C@@BA@A@A@@@@@A@CBA@@AA@@@@AA@A@@A@A@@@A@@@AAA@@@A@@@AA@@@@A@@@A@@@@@A@@@AB@@A@@B@@@DA@@@A@@A@@A@AAAA@@@@A@@@@@@@@@AB@@A@@BA@A@@@A@A@A@@A@@@@@@@AA@@AA@@A@@@@@@A@@C@AA@@@@@@A@@@A@@BA@AB@@@B@@@B@DA@B@@BA@@B@@@BA@@@A@@BA@AB@@@B@@@@@B@@@BA@@@AA@@A@@A@@AAA@@@@A@@@@@@@AB@@@@@BA@C@A@A@@@@@AA@@@A@@BAA@@A@@@@A@CC@@@A@@AA@@@@@AA@@AAA@@@E@A@A@A@@@AA@A@@@AAA@AAA@@AAAAA@@ABAA@@@@A@AA@@A@@@@@AA@AB@@@BABA@@@@@@AA@A@@@AA@@A@@AA@@@@AB@A@@@@@@@A@@@@AAA@A@A@@@@@@A@AB@@@@@@CA@@@AB@@@AA@@@AA@B@@A@@@@@AB@@@@A@AA@@B@@@@A@@CA@A@@@@@A@A@ABABA@@@A@@@AAAA@@A@@@@AB@@ABC@A@@B@@@@AB@@@B@BA@@B@B@@A@@B@BA@AA@@A@@@A@@@@@BA@@@@@A@@@@A@@BA@C@@@@@@BAB@B@@A@BB@@@BB@A@A@A@@A@@@@@@@AA@ABABA@AB@@ABA@A@@@@@@B@@@B@@@@A@@BAB@BB@@@AB@@A@@BA@A@AB@@A@@AA@@A@@@@A@AAA@@@A@@B@@A@A@@A@@ABAA@BC@@B@@A@A@A@@@@@@A@@A@A@@@A@@@B@@BAB@@A@C@A@A@A@AB@@@@@@@@A@ABA@AAA@@@@@C@@A@@AA@@@A@@A@@@AA@A@AA@@@@AAB@@A@AB@BAB@@A@C@A@@@A@@@C@EB@@AA"],encodeOffsets:[[118367,27301]]}},{type:"Feature",id:"360826",properties:{name:"泰和县",cp:[114.92299,26.801628],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@@AA@AA@@A@@@@@A@@@AAA@@@@A@@@A@@A@@@AA@AA@@AA@@AAA@@A@AAA@@@A@@@CAAA@@@A@@AA@@AA@@AC@AA@ACA@AA@@AA@A@@AAB@BAA@@CBABCB@@@@ABABA@AB@@A@@@A@A@A@@@@A@@BA@@@@AA@AAA@@@@A@@@@@@@A@A@A@@@@B@B@BA@ADAB@@@@ABC@C@AB@@ABAB@B@D@B@@CDAB@@A@ABEDCFA@@DA@@BA@@BBD@BA@AB@@@B@@@BA@ABADAB@@@@B@@@@D@@B@@@BBB@@@DAB@B@@B@@BB@@BBB@@DBBBBB@@B@@@@@@@B@BAD@DC@ABABA@A@@@@B@@@B@B@@@BAB@BA@@@@BAA@@@@A@@A@@A@@@@@AB@BA@C@@B@BAB@B@@@@@BA@@@@@@@@BA@AB@@@BA@@@@@@@AB@@A@@BA@@@@@AD@@A@@BCB@@@AA@@@A@@BC@@@A@@@A@CAA@A@CB@@@B@@AB@@@@@B@B@B@@BB@@AB@@BD@@@@A@@B@@A@@@@B@@@BA@@@@@@BA@@B@@@@@B@@AB@@A@@BA@@@@@AC@@AB@@AAAAABAA@@AA@@@AA@@@A@@@A@@@@A@@@@A@@@@@A@@@A@A@@@@@AA@@@AC@@@A@A@@@A@@BAB@@@@@@A@A@@BAB@B@B@@@@AD@@@@@@A@A@A@CDA@A@AAAB@@@BAB@@@@@@A@@@ABA@@BA@@@@BA@AA@BA@@BBB@D@B@@A@A@AAA@AA@@AAA@@BAAAB@@A@@B@@AA@@A@@@@@A@@BAB@BA@@@A@@@@BAB@@BBA@@BA@@@AD@@DB@@@B@@BB@@AB@@@@BBABBBAB@@@@AB@@B@@BAB@@@B@@@@@B@@AB@BA@BB@B@@@B@@A@@BA@@@@B@@@B@@BBA@@B@@@@@@@B@@AB@@@@@B@B@@@@A@A@A@@B@@A@@@A@A@A@@@@B@@@B@B@@@@@B@@@B@B@@@@@@@@@B@@@@BBDB@@@@@B@@B@B@@@@B@B@@AB@@@B@B@@@B@@CA@@BB@BA@A@AB@@BB@B@BAB@BA@AB@@AB@A@@AA@@A@@@AB@@A@@@ABA@ABA@@@@@@B@B@@CB@@@@AD@@A@A@@@@B@@@@@B@B@@@@@AA@A@@A@@AAAB@@B@@@@BABBBA@@@@@A@@@@@@@@@CAABAA@@@@C@@@@@@B@BA@@@@A@A@@@@A@@@AB@B@@@B@@@@@D@B@@@@A@@@@A@@@@@@ABA@@B@BBD@@@B@BAB@@@@@B@@@B@B@@@B@B@@AB@@A@A@A@@BA@@BA@A@@BA@@@BB@@@@@DAB@B@B@@@@A@AA@@A@C@ABC@@@@@B@@@@@@B@@@@A@BBB@@B@@@B@@@@@@B@@@DBBB@@CB@@B@B@@B@@@B@@@@B@B@@B@@B@@@@@@B@@B@B@B@@@@@@@@BC@@B@B@@B@@@@B@@B@BA@AB@@@@@@BB@@@B@@@B@@A@@B@@B@@@@@B@AABA@@@@@B@B@B@B@@@BB@@@@@A@@B@@B@B@BB@@@@B@BBB@B@@@@BBB@@BDB@B@@@B@BA@ABA@A@@BA@@@@DAB@@A@ABA@@@@@@@@AA@BA@@@@A@A@@@@B@BA@@B@@AB@BAB@@@@BD@@@BAB@@@BDB@B@@A@@@AA@@A@B@@BB@@@@@@B@BAB@@@BA@@@@AA@@@C@A@@@A@B@@@@@AA@@@@BC@@BA@A@A@@@A@@@A@AA@AA@@AAA@A@A@@@AAA@@@@@A@@B@@A@@AA@@@BA@@A@@@@@@@@B@B@@@@@D@@@@A@@@@@@B@@@@B@B@@@@B@@B@@@@B@B@B@@@@@BB@@@AB@@@BA@B@@BB@BD@B@@@@@@A@AAA@A@@@AB@@@@@BA@A@@@@@@@@BAAA@@@A@E@A@A@@@AB@@@B@@@B@@A@@AA@@B@@@B@@BA@@BB@@B@@D@@@@@@CA@@A@@B@B@@@@AAA@AB@@A@@A@@@C@@A@A@@@@@@@@AA@@AA@A@C@A@@@@@@@ACAA@@@B@B@@CB@@@BA@@@AA@@EA@A@@@@BI@@AM@A@GCEAAAA@@ACEGGGCCAAEEEE@AAA@AAAA@A@CAA@A@@@@@BB@B@@A@@@A@AA@@AB@@@@@B@@ABADAB@BA@ABABA@C@@@@@@B@@CB@DAB@@@@@B@@@@@B@BA@@@@@@A@@@A@@@@ABA@@@@@@DA@@BAD@@@@AB@@@@@BBB@@@B@@A@@@@@@@@B@@@AB@@B@@BB@@@@B@@BAD@@@@A@@@A@ABA@AB@@@A@@A@@@@BA@@@@BA@@BAA@@CAAA@@@@AB@B@@AB@@@B@B@B@@@B@@@@@@BA@@B@@@A@@B@@A@BBA@@@@@@BA@@@@@A@@B@B@@@B@@@BABBBA@@@@B@BA@@BB@@@@@@@@@@@@D@@B@B@@@@B@@@@A@@@@@BBB@@B@@AB@D@@@@A@AA@A@@AAAA@@A@ABAA@@@@@B@@@BABA@@@@@A@@A@AA@@A@@@AAA@@A@@@A@@@AA@@@@@@A@A@@AB@@AA@@A@@@A@CBACC@@@@@@AB@B@@@B@@BA@@@@AB@@A@@@@@@BA@@@@A@@BA@@@@@AA@AB@@AAA@@@@@A@B@@B@B@B@B@@AB@B@@@@BF@BB@@BA@@@@B@@@AA@@@@@@B@@@@@B@@@@@B@@@BAB@@@@B@@B@@@@@@A@@@@@@B@B@@@@AB@B@@@@@B@@@@@@AA@@@AABA@@A@@@@@A@@@@@@BB@@@@BA@@@@@@@A@@@@@@@@@@@@B@@A@@@@@@@@@@@@A@A@A@@@AB@@@@A@@@A@A@@A@@@A@@@@@@A@@@A@@@@@@A@@BA@@@@@@CCA@@@A@@B@@AAAA@@A@@@AAAAAAAC@@@@B@AA@@AA@@@A@@BA@@B@AAB@@C@@@@@A@@@@@@@A@@A@@@A@@@AA@AA@@@@@@A@ACCAA@A@@@AA@@@@A@@B@@@BA@@@@A@@AB@@@@@B@@@@@B@@@@@@A@A@@AA@@B@@AA@@@AC@@@BAAA@AB@@A@@B@@@@ABA@A@@B@BA@@AA@@@@@@A@@@@AA@@@A@@A@@@@@@A@@B@@@@@AAA@@@@@@A@AA@@A@@A@AA@@A@@AA@@A@@@@@ACB@@AACA@@@@AA@@B@@@B@BC@@AA@@A@@A@A@@@@A@@@@@@AA@@A@@@@B@@B@@BA@@@A@A@@A@@AB@A@@A@BC@@@@@A@@@@B@@@@@@@@@A@@@@@@@@AA@@@@AB@@BB@@A@@@@AA@@@@BB@@@AB@@AB@BA@@@@@@@BB@@@@@@@@A@A@@@@@AB@@ABA@C@@@CAC@AA@@@AA@AA@@@ABA@A@@@@@@@@AB@@@BCBEB@B@@@@A@@@@@@AA@BAAA@@@B@B@@@BA@@B@B@@A@@BA@@B@@@@AB@AAB@@A@ABA@@@ABA@@BA@A@A@@@A@@B@@@BBB@@BB@@@@A@A@B@@BA@@@@@@@B@@@@B@B@@B@@@@BA@A@@@@@@@@BAB@@AB@BABA@A@CAA@@@A@@@@B@@@BA@A@@@A@C@A@@B@@BBBD@@BB@@@B@B@@@@@B@B@@@@@@@B@D@B@BB@BBDBFBFB@@B@@B@@AB@B@@@@A@AA@BA@A@A@CBA@A@C@AA@@@@@A@@A@A@@BAB@@@@@B@@CB@@@BA@@@AA@@AAA@@@@@A@A@@@@AABA@@B@@@@AB@@AD@@AB@@@BAB@@@B@@ABGBABABAB@BAB@DAF@B@D@D@B@BA@@BCA@BA@@@A@@AA@A@@A@@@AA@A@@@A@@@A@@@@A@@ACC@@@@@A@CA@@@B@BA@@B@@AB@B@B@@AAA@A@@@@AA@AA@BAAAAA@CA@@@AAA@@@A@@@@AA@AA@@@AA@@A@BA@@@AA@A@@AC@AAA@@@A@E@@@@@A@@AA@@@@A@@A@@@BA@@@AAAA@@@CAECC@@A@@ABA@@@AAAA@@@A@@BA@@@AA@BC@@AA@@@A@A@@A@@@AA@A@CA@@A@AAA@@A@@A@ABA@@A@AA@A@A@@@AA@@AA@@AC@@@@@@@AB@@AB@@AB@@@B@@A@@B@B@@@B@@@BA@ABAB@@A@A@C@@B@@ABABA@@@A@@@@A@@@@@@CA@A@@AB@A@@AA@A@@@@C@AAA@@BCBABCB@@@B@B@@@B@B@@A@@B@@A@ABA@ABA@BB@B@D@D@B@BBB@BA@@@CBC@C@@BA@@@@@CACAEC@A@@@AAA@AA@EAA@A@A@A@@A@@@E@C@E@C@AB@DC@ABA@ABA@@@A@A@@BA@CBA@@@@@A@@@AA@CAAA@@ABED@BA@@A@@@A@A@A@AA@@@A@@@AB@BAB@@@BABADA@@@A@@@AB@@@@@B@@B@@B@@@@@@@@A@@@A@AAA@@@A@@@A@@B@B@@AB@@@@AB@B@@GB@BAB@B@BB@@@ABA@@BADA@@BA@@@@AA@@BCB@@A@A@ABA@ADA@ABC@C@C@A@C@A@A@@@AAA@CAA@@@AAA@A@AA@@@AA@ABA@A@@A@@A@@@CAAAAA@@@@@@AA@@A@A@@@@@AAAA@@@@@@@@AA@@@@@@@@A@@@@@AA@@A@@@A@@@@@A@@@A@@@@@A@@@A@AAA@@@A@@@@AA@@@@@A@@@@@A@@AA@@@A@A@@@A@A@A@AB@@@@C@CB@@@@A@@@@@@@@B@@A@CD@@@@A@@@@@A@@@A@@@@@@@A@@@A@A@C@A@@@CAC@A@@BA@ABC@GDA@C@A@A@A@@@@@@@@BA@@@A@A@@@CCAA@AACCC@@AA@@AA@A@@A@AA@@A@ACCA@@CA@@A@A@@AAAAA@@AACACACC@@A@CACBC@@AC@A@@@@@A@AB@@ABAD@@ABA@@B@BAB@DAB@B@BAB@@@@CC@@A@@AC@A@@A@@A@@B@@E@AB@B@@@@@@BB@@AB@@@B@B@@BBB@BD@B@D@BBBDBBBBB@@BB@@B@B@@B@BB@BD@B@@@@@B@D@@@B@@@@@B@@BB@B@@A@@BA@@BAB@BAB@B@@BB@@B@B@@@@BBBD@BBD@@BB@B@B@B@B@FBB@BBBBB@@AB@DB@@@B@@@B@@ABBB@@BB@@A@@@AB@@@@B@@B@@A@CB@@@B@@@@B@BB@@@@BBAB@@@B@@@B@@@BBA@B@@ABAB@@A@@B@@B@@@@BB@@BB@@@B@@@@A@BB@BDBB@B@B@F@B@B@D@@@BDBBD@B@@B@@B@@@BBD@@@B@B@@A@@@@@@FA@@@AB@B@@DB@@@B@D@B@BBD@@BB@BB@@B@BAB@BA@AB@@BBB@@B@@@DAD@B@BA@AB@@A@A@@BB@@@DBH@@BBA@@BBB@B@B@B@B@B@BBB@@BB@BBB@@B@BBB@@B@@FAB@@@@@BBB@@BBD@BB@@BBBB@BB@BBBBB@B@B@B@BA@BB@BB@@B@B@@@B@@@BBB@@@B@@BBD@@@BBB@BBBBBBBD@DBDBB@B@D@BBB@B@B@@BB@@@BBDBB@B@@@B@BA@@DABAB@D@@@B@BBB@@@BAB@BAD@BAB@@@BA@@B@@@BB@@BA@@BAB@BCBCBABA@@@@B@D@B@B@BB@@@@D@@AB@@ABABA@@B@B@BAB@D@@@B@BBFBBBDBBBB@B@BBDB@@B@B@D@B@B@BADBB@BB@@@BBD@@@B@@@BAB@BAD@BAB@B@@@@@D@B@B@@@@B@@BB@@@@@B@BB@@B@@@B@@@@BB@BB@@@BB@@BB@@@@BB@B@@BB@@BB@@B@@B@@@B@@BB@@BB@@@BAD@DCB@B@@ABB@AB@@@BAB@@AB@B@@BBB@@DB@@B@B@BB@@BC@@BBBB@@@@B@B@@@BADAB@@@B@@@B@B@BBB@@BBAB@@@@AB@@ADA@@BA@AB@@@B@@A@@B@@AB@@@@@@@@@@BB@@@B@@@D@@A@A@@@@B@@ABB@AB@@B@@BB@@B@BB@@B@@@@AB@@@BA@@@A@@BAB@B@@@D@@@@@B@@BB@@@B@@@@ABABA@@B@@A@@@A@@B@@@@@@A@@A@@A@@@@BA@@@@BA@@@B@BB@B@B@@B@@BBBB@@B@B@B@@@@A@C@@@@@@B@BA@@BB@@@AB@@@B@@@@@@@B@B@@@B@D@@@B@@@@AB@DA@@B@BAB@@@@AB@@@@@@@D@B@@@@A@@DABAB@@A@@BB@@B@BABA@A@@BA@@@@@B@BB@@@@@B@@ABAB@BA@@@@BB@@@@BA@@DA@@BA@@@@BB@@@@B@B@@@B@@@@@B@@@@@@@@B@@@@@@B@B@@B@@@@@BAB@@@B@D@@BB@B@@@@B@B@@@BB@@B@@@@B@@B@@@@@@B@@BB@@@@@AB@BB@@B@@BB@@@@@BB@@B@@@@BB@@B@BAB@@B@@B@BAB@@@B@@B@@@@BA@@@B@@@B@@@B@B@@BBB@@DBBB@B@@BB@@@B@@@B@@DB@@@@@B@B@@BB@@@@@@ABAB@BAB@B@@@B@@@@@B@@@@@@@@@B@@@@@BBBDBBBBADA@@@@@@@@@BB@BBB@@BA@@@@@BBABB@@@@@@BBB@B@@@B@@AB@@@@A@@BAB@D@@A@@@@@@@@@@B@@@B@@B@B@@@@@@BDB@@B@@@BB@BA@@@@@A@A@@@@@@@DBB@@@AD@@@B@@AB@@@@B@BB@@B@@A@@@@D@B@B@B@@@@BBAB@@@BBB@@@B@BD@@@AB@B@@A@@@A@@B@@@@@BBB@B@B@@BBA@@BB@@@@B@@ABA@@@@BB@@B@@@@AB@B@@@@@B@@CB@@ABA@@@@B@@@B@@@B@@@@@BB@@@BB@@BB@@BB@@@B@@AB@B@B@@@@ADB@@@BBB@B@B@BB@@B@@@@A@@B@B@@@@B@@BB@@@@B@AA@@BB@B@@@@B@@B@@@B@@@@@@@@B@@@@B@@@B@@@@@ABAB@@@BA@@@@B@@@@@B@@B@B@B@@BB@DB@BBBBBDBB@@B@@@B@@BBBB@@@B@BB@@@@@B@@@B@@BB@@B@B@@B@@@B@@@@AB@@@@AB@@@B@BA@@B@@ABA@@@@BDB@@BB@@@BA@@D@D@B@B@BABAB@@@@@B@@D@BB@@B@B@@@@B@@@B@@@@@B@BA@@@@@BB@@BB@B@@@@B@D@@@BB@B@B@@@B@BB@@@@@BB@@AB@B@@@@A@@@@B@@@AAB@@ABBBA@@@A@@BA@@B@@@B@@@B@@B@@@D@@B@B@BBB@@@@BBB@@@BB@@AB@@@B@A@@@@@@@B@B@@AB@BA@@@A@@J@F@B@B@@ABABA@CBA@@B@B@BBBB@@@@BB@@@B@@AB@B@B@@@D@@B@BBFFBBDDDBB@FBBBBBB@@B@@A@A@@@AB@@@@@@@B@@@@@B@@@@@@CBABA@ADA@@@A@@@@@@B@@BB@@BBBB@BA@BB@@B@DBB@JFDBBBFBDB@@BB@@@B@@B@D@BB@@@B@@@@D@DA@@BB@@@BB@B@B@@@@AB@@@@B@@B@@B@@B@@@@AB@@@@@@@BB@@B@@A@@B@AAA@@@BA@A@@B@B@D@B@@A@@B@@@B@@@@AD@BCFA@@@CBBB@BB@@BB@@@@@A@@@A@A@E@A@@@@B@BAB@AA@AA@@@@@@AD@B@BB@@BB@@A@@@@@@@B@@@BA@@@@@@@@@@B@@@@@@A@@@@BAB@@@@@@@BA@A@@@@B@@@@@AAA@@A@@@@B@@@@@B@@@@A@@@@@@@AAA@@@@B@@@B@@@@A@@@@@@B@@@@@D@@@BAB@B@B@@@B@@AAABA@@@@D@BAB@@AA@@AB@@A@AA@AA@A@AB@@A@@A@EB@A@@@@@@AA@@@@@@@@@A@@@C@@AC@A@@@AAA@AA@@C@@AA@A@@@AA@@@A@B@@@B@@@AAA@@@@@B@@@BBB@@A@@BC@@CA@@@@DA@@BA@@@@@A@@BABA@@@@B@@B@@B@@@B@BF@@BB@@B@@@BB@D@@@@@B@BB@@BB@@@B@@@@BB@B@@@@BAB@@BB@BBB@B@@@@BB@@@@BABA@@B@BAB@BA@@B@@@D@@@B@B@B@@@@A@@@AB@B@@@B@B@@@BA@@B@@C@@B@@BB@@@@B@@@@B@@B@@B@@@BB@BB@BBB@@@B@@@B@@BB@B@@A@@B@BABA@@BABAB@@@BBBB@@@@@@B@BB@@@A@@B@@AB@@@@@@@AA@@@@BAB@BA@@B@@@B@B@@@B@@@@@@@@B@@@@@BAB@B@@B@@@B@BB@@B@@BBB@@@@@B@B@@B@@B@@DB@@DBDBB@BB@@@D@@A@@B@@A@CDABAB@@@@@D@B@@B@@@A@@B@B@@@@B@BBB@B@@@B@@@DB@@BBFB@BBBB@BB@@B@@AB@@@@@@@@B@BB@BABA@@@@BB@BBD@@B@B@@@B@@ABA@AB@BABAB@B@@@D@@@@@B@B@@@B@@B@BB@@B@@@@@B@@B@D@@B@@@@B@B@B@@ABAB@BA@ABBBA@@@A@@@A@A@AB@@@@@B@@@@B@@@@BABA@@D@BBDBLLDDFDDDDBLFBBB@FBFDB@DBB@BBB@BBBBB@FBDBFDDABA@@B@@@B@B@@@@@@@@A@@@@D@@@B@DA@@BA@@@A@@@@@AB@BA@@AA@A@@D@@@@@@AAA@@B@@@BA@@@A@@BA@@@@@@B@B@@@@B@@@@B@B@@@B@@@CA@@@A@@@@AA@@BA@@BA@A@@@A@@@A@@B@@A@@D@BC@@@ABA@@B@@@B@@@@@BA@@@@B@@@B@B@@BBA@@@AB@@BB@@@B@@AB@@BBB@@@@@BBCFIB@@@@@@A@@@AB@@B@@B@B@@@B@@A@@@A@@BABB@AB@@@@A@@AC@@@@BA@@@BB@@@@@@A@A@A@A@AAA@@@AB@@@B@BA@BDB@@@@B@B@B@@BB@@@B@@@@A@@@A@AAA@A@@AEBA@A@@@@@A@@@AA@@C@@BA@@@@@@@@BAAA@@@A@@@@A@BAA@BA@@@@@@B@@@@@@A@@A@@@@@@@AA@A@@@@AA@A@@@A@@@A@A@@A@@@@A@@ACA@@@A@@B@A@@@@A@AA@@@@BA@A@@@@A@@@AA@A@@@C@@B@@AB@@@BA@@BAB@@AB@@A@A@A@A@@@AB@BBBA@@B@@CBA@A@A@@@@@@@A@@@A@@BA@@BA@A@A@AB@DABA@@@@@A@@A@@@@A@@@AB@@@A@@@@A@A@@BA@A@A@@@A@@B@@AB@B@@@@A@@@AD@B@@@D@B@B@D@@@@@@AB@@@@A@@B@@@@@@B@@@@B@@@@@BB@@B@@@@@B@@@B@@@B@@@@BB@@@BB@@@@@B@@BB@@BB@@@@BAB@@AB@@AB@@@@BB@@@B@@A@BBABBBA@@B@AABAA@@A@@@@@A@@BABADA@A@@A@@AA@@B@A@@BA@A@@B@B@@A@@@A@@@A@A@@@@@A@A@@B@DABAB@B@B@B@@@@AB@@@@@B@@@@BB@@@@@BB@@B@@@@@@ABAB@B@@@B@@A@@BAB@BA@@@B@BBB@@@@BBF@@@@A@@AA@@@A@@@A@@B@B@@@B@@@BBB@@AA@@@BA@@B@@BBB@@@@B@@@@AAA@@@@@@DB@@B@@A@@BA@@BA@@@@@A@@@@B@@@D@B@@AB@@@@B@@B@@@BA@@@@BBB@@A@@@A@@@@B@BA@@BB@@BA@@@@@AB@@@AA@@BA@@AA@@BA@@BA@@BA@@BA@B@@B@@BD@@B@@@B@@@@@B@@@@B@@A@@BB@@B@@@@ABA@@BA@@@A@A@@B@@@B@B@@@BA@@@@B@@@BA@A@@@A@E@@BA@AB@@A@@BA@@@A@BB@@A@@@AD@BAB@@AA@A@@@AA@@@@@A@A@A@@@AB@@ADA@@B@B@BB@ABA@AAC@@@@BA@@@BB@DB@@@@BA@C@@@A@@@@@ACA@@@A@A@@@AB@@@@ABB@AB@BAB@B@@@B@@@B@@@@@@@D@@A@@B@B@BAB@@@@@@@B@@AB@B@@BB@B@@@B@@@@BBA@BB@@A@@@@@A@@@@D@@AB@@A@@BA@@BA@@@@@@@@@BA@@B@B@@ABA@@@@@@@@BA@@@@@B@BB@@B@@@@AB@@A@@B@B@@A@@@A@@B@@@@A@A@@@@B@BA@@BABAB@@@BA@@@@B@@A@AB@B@@@B@@@B@BAB@@@B@@@@@@@BADABADAB@@AD@B@@@B@@ABABADAB@@A@@BB@ADAB@@@@@B@@@@@B@DADA@@B@@A@ADA@@BA@@@A@A@@@A@@@A@@@ABA@A@AB@@AB@@@BABA@@B@B@BA@@B@BAD@B@@@@ABC@A@@@AA@@AA@@@@A@A@@@@BA@@@@AAB@@A@@@@AA@@@AAA@@BA@@DA@A@@@CBA@AB@@ABAB@B@B@BA@@@A@@@@@AB@BB@@BAB@@@B@B@@@B@BAB@@@BA@@@A@A@CBA@@BA@A@@@AA@@@CA@@@A@@@A@A@@BC@@@@BA@@@A@@B@B@DA@AA@@A@@@A@AB@BAD@BA@@@@BA@@@A@A@A@@BABCB@@AA@@A@ABA@A@A@@BA@@@@BA@A@@@@AA@@@AA@A@@A@@@A@@@@AE@@@AA@@@AA@@@@@A@@@@@A@@@A@A@@ABC@CB@@C@CBA@A@@@@B@@A@@@A@@A@@B@@A@@@@C@A@AA@@@A@@AAAAAAA@@@@@C@@A@@A@@@AB@@@@@AABAA@@@B@@@@ABA@@@@B@@A@C@@@B@AAB@@A@@@@@@@AA@@@@A@@@A@@B@@A@@BA@AB@@@AC@@@@BAB@@@CE@@@@@@A@AAA@@@@A@@AC@@@@BA@@BABABAB@BA@@@A@@@@@@@@@A@@@@@@@A@@@@B@@@@A@@A@@@AA@@A@@@AACAA@@@@@AAA@@@AD@@A@@@@@A@@@@A@@A@@@@@@@AB@@@A@@@@A@AA@@@@AA@A@A@A@@@A@A@@AAB@@CA@@A@@AA@A@A@A@@@A@@@AA@@@@@AA@@@@A@@A@@A@A@@@@A@@@@AA@@@@@AA@@ACAA@CA@@@@@A@@AAA@ABA@@@@@A@@@@@AA@@A@@AB@@@@@@A@AA@@A@@A@@AAA@AAA@A@A@@AC@AAA@@A@@@@@@@BA@@@A@A@@AB@@A@@@@@@A@@@@@AABA@@@AA@@AA@A@A@A@@B@AC@A@AB@@@@@@A@@@A@A@@@A@@@A@@AB@@C@@AA@@BAA@@@@@A@@@@@@@A@@A@AB@@AA@@@A@@@AA@@@@@@A@@A@@@@@@@A@@@A@AA@@@@A@@AA@@AA@@@@AAA@@@A@@@AA@@@A@@@AC@@@@A@A@AA@@@A@C@A@@AA@@@A@@@@AA@@@@BA@@@AA@@A@@B@@@A@A@@@@AAA@@A@A@@AA@AA@@@AA@@A@AAAA@@A@@A@@A@@@A@A@AA@@@AA@@A@@AA@@@@ABA@@BA@@B@@A@@@@@A@@B@B@@@@@@@@AB@@@@@@@B@@@@@@@@@@B@BBB@B@@@B@@B@@@@A@ABA@@@A@AB@@@BA@@B@@A@EA@@@@A@@BC@@@@AA@@A@@@A@ACA@A@@@AAA@A@A@@AA@CA@AA@@@A@@@@@A@AB@@A@AB@@A@@@A@@BA@A@A@@@@@ABA@@@@@AB@@A@@@@@A@@BA@@A@@A@@@AA@B@@AA@@A@CB@@@BA@@@A@@@@@@@@B@@@B@@AB@@C@@@A@A@@A@@@@A@A@@@A@A@A@@@A@A@@@A@@@@@A@A@@@A@@B@AA@@@@@A@AAA@AB@@A@@BA@@@@@A@@@A@A@A@@BA@@@@@A@A@@AAA@@A@A@@AA@C@@@@@@@CAA@AA@@A@@AAB@A@@@@@@AA@@A@@@AAA@@@@@A@AB@@AAC@@AA@AAA@@@A@AAA@@@ABA@@@CAA@@@AA@@A@A@@AA@@AA@@AA@@@@A@@A@@@AA@@A@A@A@@@A@AA@@C@@@A@@@AA@@A@@A@@A@AAA@@AA@@@@AA@@@AACA@@A@@AA@@AA@@@AA@@@AA@AA@@@@A@@A@@@@@AB@AA@@@A@@@@A@@A@A@AA@@@@B@@AAA@@A@@A@@BAA@@B@@AA@@AA@@AA@@@@AC@AA@@CC@A@@A@AC@@AA@@"],encodeOffsets:[[117848,27111]]}},{type:"Feature",id:"360830",properties:{name:"永新县",cp:[114.243072,26.944962],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AA@CAA@AAAA@@@EAA@CAC@@AA@@ACA@A@AAA@C@@@AA@CC@@@A@A@@BABABA@@@CBA@@@A@AB@BC@@AA@A@C@CBA@@@A@@CAAACA@@A@@@A@AAA@A@@@@A@A@@AACCAAAACE@AA@@CAA@@AAC@A@AB@@A@A@C@@@CBABC@@BAB@@CBABCDABADA@@@@@A@@@@@A@@B@@A@@@@@A@@B@@@@@@A@@@A@@@A@@@@B@@B@@B@@A@@@@B@@@B@@A@@@@B@@@@@@BB@@@@B@B@B@@@@@@@BB@@@@B@B@@BA@ABAB@B@@ADCB@BA@@@@@C@@@A@@ACA@@@AA@@@@@AA@A@@A@@@@BCB@@@BA@A@@@@@A@@@@@A@@A@@A@@@A@@@A@@@CB@BABAB@@CB@B@@@@A@@BA@@@ED@@C@@@@@@@A@A@@@A@@BA@AB@@AB@B@@AB@@CBA@@AAB@@@@@@A@A@@@@@@@@@@BA@@@A@@@@BA@@@AB@@@@@@@@ABAB@@@@A@A@@@@B@@@BBD@B@@@BAB@@B@@@BBB@@@@B@@@B@@@@AB@@@@ABABAB@@@@@@AB@@A@@@A@@@@B@@A@AB@@AB@@A@@@ABA@@@A@A@@@@AA@@@A@@@@@AB@@@@@BA@@@A@@@@B@@@@ABA@@B@@ABC@@BAB@BAB@@@@ABA@A@CB@@A@AB@@A@A@ABC@A@@@CAAA@AAA@A@@A@@@EBA@A@A@AAC@A@ABAD@@@@@@A@@B@BAD@BA@@BA@A@C@CBC@AA@@AAA@@@A@@B@B@@@@AB@@@@C@@@AB@@@DA@@B@@A@@@@D@B@B@@@BB@@@@BA@@B@@@@@B@@A@A@A@AA@@@AACA@@@A@BA@A@@@AAA@A@ABABA@@@ABE@A@A@AAA@@AAA@@@AAA@@@AA@@AA@@@@AAA@A@C@@BCB@@A@@@CCA@@@A@E@ABA@@@@@ACAA@AAA@A@A@A@@AA@A@@AAA@@@A@@@AAA@GC@@A@AA@@AA@@@@C@AA@@C@A@A@AA@@A@@BA@A@A@@@A@@@@B@DBD@@@@@@AB@@CB@BAB@BAB@BA@@@ACAAAAAAA@A@A@ABC@ABGD@@ABA@@@@@A@@ACA@AAAAACC@@@@A@CAA@A@@@A@@AAA@@A@@@A@CBA@@BA@@B@@@B@@@@A@CB@@ADCBA@A@A@A@@A@@ADAD@@AB@@@BAD@BABABCBA@AB@B@BAB@BA@A@@B@@@B@B@BA@ABABA@ABA@A@A@A@@A@@@A@AA@@@CAA@A@EAAACAA@EAA@C@A@AAA@ACCA@@A@CB@@C@@@CBCB@@C@A@A@AA@AA@AB@BA@CACACAA@@@ABCBAB@B@B@BAB@BABABCD@BAB@@A@A@@@A@@@@@AAAA@@AAC@@@A@@A@@AA@AACGEAA@@AAAAAA@@AAAAAAACA@@@CDADEFAF@D@BADBDDDDD@@DB\\\\FDBF@HDFBDBDFHBDBDDDDBBDBB@DHHD@F@DBD@L@@@B@@@@B@BCH@BBFAD@D@BABCHDDBD@FAF@D@HBDFDB@DBBHDFDBBD@DCF@DDD@DAJDDHHFDHDFDBBDDBDDBDBBDBDCDCDCD@D@DB@@B@@@@AB@@B@@B@@@@BB@@DB@B@@ABBB@@@@@DBBDB@BBBBB@@@B@B@D@B@B@@B@@BBBBB@BB@@@DBD@@@@B@@@BBDB@@BAB@@@B@B@@DBB@BB@@@BDBDB@@@@BBB@B@@@@@D@B@B@D@D@B@B@BBB@@@DDBB@B@@CDAB@@ADAD@BA@@@@BAB@D@@@H@BBD@DA@@@BBBBDBDB@@A@@BBB@@BB@DDBBB@@D@D@D@BAB@@@B@@B@@@BB@@@@B@B@@@@@B@@BBBDBBBB@BBBBB@BB@DA@@BB@@@B@@BB@@@B@B@@@@B@BBD@D@B@@@@B@B@@BBBB@@@BB@@BBB@B@B@B@@@BAB@@@B@@B@@@BB@B@BBBBB@@@B@@ABCD@@A@A@ADAB@B@@@B@F@B@B@D@@@B@@BD@BB@BBB@DDB@BBB@@B@BBB@@BB@B@@@D@BB@@D@F@B@@BBBD@B@B@DBB@@BDBB@BBD@BBDBDB@@@DD@@@DBBB@BBBBBB@@BD@@@B@@BBDB@@BDBB@@@BB@@BFFBBBBFFFBBBB@@BB@@DFFBB@@DAL@B@DANADAD@D@B@BA@@BA@@B@@BB@BD@@BD@@@D@BB@@B@@FD@@B@B@@BBB@@DFBB@B@B@DBF@B@BBB@B@@BDBDBDBJ@BDDBBBBDBBB@@@B@@@@ABA@@BABADA@@B@BBDBD@B@@FFDBJHDDBB@@BBHBB@@@DA@@BAB@BABC@A@@B@@BBBBBBB@@B@BBB@BABA@@@@@ABABA@A@@@@BA@A@@B@@AB@@A@CB@@AB@FCB@@@@@BA@AA@@CB@@@DC@@@@@A@A@A@@@A@@@A@@@A@@BBB@@@B@@@B@BA@@@A@@@A@@BA@BB@@@B@@@B@B@@@@@B@B@B@@@BA@@B@@@B@@@B@BBB@@@@@@BB@BBB@B@@BB@BB@@BBDBB@@@@@D@BA@@BA@@BBFA@B@@B@BBB@@@@@BAB@@@BBB@@@BD@@@@B@@@BA@@@@B@@@B@@@@@B@B@@@@@@@B@@B@@@@@BB@AB@BABA@A@@B@@@@@B@@@@@BBBB@@@@A@AB@@@B@BBBBBB@BB@BB@@B@B@@@BBBB@BB@@@B@@@H@B@B@B@@@BA@@B@BCDA@@BA@@B@@ABAB@B@BAB@@@@@BBB@@B@@BB@@@@D@B@@@B@@@@@@@BA@@J@@@B@BBB@BAB@@@B@@@@@BA@@B@@@B@B@BAB@@@B@BBBBB@@@@@BAB@B@B@B@@@@AB@BA@@BA@@@@BA@@B@BA@@@@B@@@BBB@@@B@B@D@B@@@BAB@@@BBBB@@@@BA@@D@DB@@@@@@@@@@B@@@@B@@@@@BB@@@@@@@@@@B@@@@@@@@@@@B@@B@@@@B@@@@@@@@@@@@A@@BB@@@@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@A@@@@@@@@@@B@@@@@@B@@@@@@B@@@@@@B@@@@B@@@@@@@@@@BB@@@@A@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@BB@@@@B@@@B@@@@@@@B@@@@AB@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@AB@BA@@@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@DA@@@@BA@@BA@A@@@@BC@@@@DE@@@@@@@@B@B@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@A@@@@B@@@@@BA@@@@BA@@@@@@@@@@@@B@@@@A@@@@@@B@@@@@@@@@@@@@@@@@B@@@BAB@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@BB@@@@@@B@@@@@BB@@@@@@@B@@@@@BB@@@@B@@@@@@@@@@@@@@@@BB@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@J@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@BABA@@B@@@@@@AB@@@@@@@@@B@@@@@@@@@@A@@@A@A@@B@@@BA@@@@@ABAA@@ABA@@BA@AB@@AA@@ADA@@@A@@@AB@@A@A@C@@BA@C@A@@@A@A@@@ABA@@@A@A@@@@B@@@@ABA@A@@A@@@BA@@@AB@@@@AB@@A@@@A@@@@A@@A@@@@@A@@B@@A@@@AB@B@@A@@AA@@@AB@@A@@B@@@B@@AB@@@BA@@AA@@BA@@B@@@@@@ADABA@AB@@A@@B@BA@A@@@@@@B@@@B@DA@@B@@@B@@A@@@@@@BADC@@BC@@BA@@DCBAB@@A@@BABABABABA@@B@B@BAB@@AB@@AB@@A@AB@@@@@BABAB@BABA@@B@@BB@B@@@B@BA@@@AB@@@B@B@@@B@@A@A@@BADABA@A@CAC@A@A@ABA@A@@BAA@BA@A@A@@BA@C@@@A@@BA@@BAB@@A@A@@@@B@DC@@@@BA@AD@@@BA@@@AB@B@BAB@@@@@B@BA@ABA@@@E@A@@D@@@B@@A@A@@@A@A@A@@@ABA@A@@BA@@B@B@@@@AA@@A@@@@@@@A@@@ABA@C@@B@@@@A@@B@@@BA@@B@@@@@B@@B@@@BB@@@B@@@@@B@@BB@B@@@B@@@@@B@@@B@@CB@BABBB@@@BBB@D@BAB@B@@@BB@ABB@@B@@@@AB@@@B@@@BBB@BA@AAA@@@A@@@A@AAA@ABA@@@C@A@A@@AAA@AAA@@@A@@A@AAA@@BC@@@AAA@@@@@CDEBCBCBA@ABA@AAC@AB@BC@@BADA@ADAD@B@@@BA@A@@B@BA@@@C@@@@B@@@DAD@@@B@@@BA@A@A@@@ABA@@BABA@A@@@A@C@@@AB@BA@@B@B@BB@BB@@ABA@@@AAC@@@AA@C@A@AAACAAAA@@@AA@AA@@BA@A@@AA@@@A@@B@B@@@@@@A@@@AA@@A@@@E@@B@@@@A@@CA@@A@@AAAAA@@@@A@A@A@A@CAA@AACAAA@@@AAA@@BA@ADA@@BABA@@@A@C@AAE@@A@C@@@@@@AAC@AA@@@ABA@ABA@AB@@@AA@AA@@AAA@AB@@A@AAA@EBC@A@A@A@CACAA@@@A@@@CAA@@@EBC@A@@@A@AA@@@@@C@@@@DAB@@A@A@@B@B@BADADABAB@@ABCB@@C@AAA@@BE@ABC@@DA@@@A@@@C@C@A@@C@@@AA@AA@ECAAA@C@@@A@@A@@@C@CAA@A@CAC@AAC@@BC@@@@D@@@B@@AB@BAB@@@@A@C@ABA@@@@BCB@BA@A@@@A@AAA@@@ABA@G@AB@BA@A@A@C@A@@@@@AB@BA@@@ADC@@DA@@BA@A@@BA@@BABA@@@A@CB@@ABA@A@@@ABA@EBA@A@A@A@A@A@A@@@@@AB@B@@@B@FA@@@A@ABA@@D@BABABABDB@B@@@B@BA@@BC@@B@DC@@BA@@@@BBB@B@B@@@DABADA@@DAB@@@BA@A@A@C@@@@@ABA@ABC@ABA@A@@@A@@AC@A@@AAA@CAABA@A@C@A@A@@@CAAAA@A@AACAAAEAAAA@@@C@A@ABA@A@@@ABAB@BA@@BC@@@@@AAA@A@C@A@@@@@ABABADADA@AB@@AB@@AA@@A@@@AB@@A@ABC@ABA@AB@@A@AAA@@@C@A@ABCB@@ABA@@@A@A@CAAA@@A@@AA@A@A@AAC@A@A@CACAC@AAAAAA@AAA@A@@AC@AA@@@A@AA@@A@@@A@A@@@AAA@@AABA@A@A@A@AAAAA@@AAAAA@@AAC@AA@@AA@A@@@@BA@EA@@@AA@A@AA@AAA@@AA@AAA@A@A@A@A@A@AA@@AB@AG@CA@@A@@AA@AAC@C@C@AAA@ICA@@@A@@@ECA@A@CAC@C@@AA@A@CAA@EAA@AAG@CAA@AB@@CBA@E@A@AAA@@AA@@A@AA@AACA@AA@@CCC@@AAA@A@A@A@C@A@@@@A@@@A@@ACCAA@@@ABCBABCDADADAB@@@@A@AAC@CAA@A@AB@@@BA@@B@@@@A@CA@@A@CB@@A@A@A@CAA@A@IAA@C@@@"],encodeOffsets:[[116977,27421]]}},{type:"Feature",id:"360827",properties:{name:"遂川县",cp:[114.520537,26.313737],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@A@A@AA@@A@@CA@@@AA@@@@@AAAAAA@AA@@@AAA@@@AA@AAAACAA@@@A@A@@@@@A@@@@DBBBBDBBBBDB@@@@D@D@DABAB@BB@@B@BA@CBE@C@A@ABAB@@BB@B@@ABA@CHC@CFAF@B@DADBD@DDBDDFDDFDBBDFBDDFBDH@DBDBDDBBDBBBD@DAB@B@B@BBB@B@BAD@DBFBDFBDDDDBDB@A@AHADFDH@D@D@DCDAFBHDD@F@DCB@BCDADEDCB@BADADAF@DBDDFBDBFBDAFAB@B@DDBDDBDBBBDJHF@B@@@D@BCDEBEDEB@@CBCDBBDDHBFDJBHBJBHDF@HDJD@@HDFBDBF@BBBBFDBBB@PFFAB@DAF@D@F@FABBBBB@DDBBBBFDDBBBHFHDFBHBDFFFDDFFHBFDHBB@@@BDAFAD@BEBE@IACBCDCBAF@DDDFDHFFDDF@B@@@BAFEBGDCBABAD@H@DAFEFC@CAA@EAGAEAIAICEBCACDAD@F@FCF@FAD@@E@GCGCIAA@EAGCA@GCGAG@E@CACCCCEAIAIAAACAGCGAGAGACACCAA@E@C@CGACAEECEACGAGBEBEDG@CAKEECAB@D@HCJAFABIAMEE@BHBDCBCFCDCACCACCCCCE@GBGBCDC@AEGCABCBAC@CAAAAAAEAEECC@@CAKAAACAGIIGCAA@C@EACACCA@A@EBCD@DEFCBEDED@FFBDBBBBF@B@B@HCFGFBFBFDFDBDDF@DBF@HDDBFDFH@F@JAD@DADDDDBFDDDBF@FAFAFBBFFDBBDFDFBFBD@DB@@HDFHFBFDDBD@D@@BB@FDFBFDFBA@CFABCDAHAHAF@FBFBDDD@DBDBDHFFFFDBBFBBB@DA@CBAF@@@FAFAFBFDFDDBBHDDF@BCDGFCD@@@HAHABBFBF@@@DADCBCBADCDAF@D@DHBD@DFDFDF@DBDDBF@D@DDDFHFBDBBBDDDBDHDH@DCBEDABDF@DAFD@@BDBDB@@@D@@BBBB@B@@@BBB@BDDBFD@@B@@@FDB@D@BBB@B@DAB@D@BBB@BBD@BBD@BBB@D@BABBD@B@@BB@D@DBDAB@B@B@@@FB@@B@BABA@@@A@@BA@@B@@@@@@BB@BBD@@AB@F@BAB@@A@ABA@A@A@AB@@A@EBAB@BABA@@BA@C@A@A@@BAB@@@@A@A@A@@AA@A@@AA@@CAA@AB@@AAB@@ADABABA@A@A@@BCBA@ABA@@DA@@DC@@B@@@B@BA@@B@@@BB@@DAD@D@B@B@B@B@@@BADA@A@@BC@@@A@C@A@@BAB@BAB@BADA@AB@D@D@B@@@BD@@BBB@B@BBB@B@@@BAFAB@B@BAB@DAB@B@BAB@BA@A@AB@B@B@B@D@BBD@B@BB@@BBDBB@B@BBB@@@FAF@@BBBB@@BA@@B@@@B@D@B@@AB@BA@@BAB@@@B@@B@B@B@@@BDB@DBB@@@@@B@@C@@B@FA@@BABAB@BCB@B@@@B@DDDD@B@B@BD@DBB@@@@BBB@BAB@BAB@B@@@B@@BBB@BBB@@B@@B@B@@@@@B@BAB@@@@B@@@B@@@BBDDD@@BAB@@@@@BA@@B@@@DB@BBB@@@B@@@BB@@@@@BA@@BA@@@BBBB@@@BBBBBFBBDDB@BBB@@@DBB@@B@@@BBD@D@@AD@B@@ABAB@BAB@@C@ABA@AAA@@BA@A@CBA@@@@B@BABAB@@AB@BCBABCBA@@@A@A@AA@@A@@@A@ABAB@@A@@@A@CA@@CA@@A@@@@@A@@@ABEBEAA@A@@@@@A@A@@@AAA@@@@@@B@@@B@B@B@D@DAB@BABA@ABA@@@AB@@@D@B@@@B@@@@@@BA@@D@D@D@B@DAD@@@FABAB@B@@@@BAB@BAB@@DDBB@BAD@@B@@@@@DBD@BBB@BB@@B@H@@@B@BB@@@B@B@BA@@BA@@FAB@B@BA@CD@@@B@@@@DBBBB@B@BBD@@@@@BABBDBD@@@B@@ABBBBDBB@@BBBB@@D@B@@DB@@@@@B@@@@@@@@@BB@BD@@BA@@B@@@@B@F@B@@@BA@A@A@C@A@@@ABA@CA@@ABAB@@@BADAF@B@@B@@@B@@B@@@@C@ABA@@@@B@@AB@@AD@@EA@@@@A@@BCDABAB@B@B@BAB@@ABA@@@CBA@A@A@A@CAA@@@A@A@CF@@@B@B@@@B@B@@A@AB@@CBA@A@CBC@@BC@ABA@@BA@EDA@@@A@ABA@A@ABCBA@E@@@CBA@@@@BEAA@@@A@@AA@@ACBCBA@@BA@A@@@@@@D@F@FBB@B@@@BA@@@A@@@BFBD@@@B@@BDBBDBB@BBDA@@B@BB@@BB@@@@BBB@@@BAB@BBB@BBB@BBB@@B@@B@@@BBB@BB@BBB@B@@@@@AB@@@@@@@DD@@@DDDB@@@B@B@DBB@B@DBB@DBDBBBD@@@B@@@@@B@@@@@B@F@B@@BB@@@@DB@@BBB@@BBB@@@B@@@BBBD@@@@B@@@@@@BABB@@BB@BB@B@@@@ABAB@BAB@@@BB@DB@BBBBB@@@B@@@B@D@BA@@B@BAB@@@B@BAD@@@BCBAD@@A@@BA@@BAB@BA@@BAB@B@B@@A@ABA@@@@DCD@BCB@@@D@BCBABABCF@BAB@@@BDB@BBBBDBDBD@@AB@@@@DF@B@BBB@@@BB@B@@BBAB@B@@@@@DDAFBB@@@B@@@@A@@@ABA@ABA@@A@@@BAB@BB@BD@@BB@@B@B@BB@@B@BB@B@B@D@B@B@@@@@@BBDBBBDBBBB@B@BB@@B@@@D@B@BBBAB@B@@@BA@@@@@A@@@A@AB@@@B@@@B@@ADA@@@ABA@@BBB@BBBAF@@@@AB@@@@BB@D@@BB@@@DD@@@@BA@A@ABA@CBA@A@AB@BA@@BCBA@@BAB@@@@@B@D@@BD@DADBB@@@DDDBDBBB@@BBBB@BB@B@@@DB@@DBBDB@@@BBB@@@@BBB@@BB@@DDBD@BBBDD@@B@B@@@B@@A@@@@@@B@B@B@D@B@HCD@BAB@@AB@D@DB@@B@D@B@B@@@B@@@@@@@B@@@B@@@@@B@@@@@DCB@@@@A@@@@@@B@@@@@DAD@@@@@BAB@B@B@@@B@B@@@B@@BB@@@@@B@@@@@B@@B@@B@@@B@BBB@@@B@@@@@B@@@B@@@@@B@@@B@@@BB@@@@B@@@@@@@@@BB@@@@@@@@BBBB@@@@B@B@@@BB@@@@@@BBBBDB@@B@@@@BB@B@BAB@@B@@BBB@B@BB@@B@DBB@BB@@B@B@D@B@D@D@D@BAB@BCB@BAB@B@@@DA@AB@@B@@B@@AB@BC@AB@BA@@A@@A@ABA@AHA@@@ABA@@@@BA@@@A@AB@@@B@@@B@BBB@@@B@@@@@@@@@@AA@@@@A@@@@BA@@B@@@B@BCBA@A@@BA@ABA@@B@@@B@@B@B@B@B@@@BB@@AFCBA@@BBDBB@@B@@@B@@@@AB@DAB@@@B@B@@AB@BAB@BCDA@@B@D@F@D@F@@@BB@B@B@B@FBB@@BBB@B@@@BFDDBDB@@@@B@@AD@D@DA@@B@@AAA@A@A@C@C@AAA@CAA@A@AA@BC@@BA@AB@@A@AAA@A@ABA@AB@@A@@BAAAB@BAB@B@@AB@@A@@CABA@@AA@@B@@A@@@AB@@@B@@AB@B@@@B@B@@@BA@@@@@@@ABA@@@BB@@ABA@@@@B@@A@@B@@A@@B@B@@@BA@@@CA@@A@@A@A@@A@@@A@@@ABA@@@@B@@@B@B@@@@@BB@@B@D@@BDE@@DCBA@@@@B@@AB@@AB@@A@ABA@@BABA@@B@BB@@@@B@@AD@BA@@B@B@@@B@@@@@B@@AB@BAB@B@B@BAB@@@BBB@@@B@@@@@BA@@B@@A@ACAAA@@@@@A@@@A@A@A@@A@@@A@@@@C@@B@@@@A@A@A@@@A@A@AA@BAB@@AAABBB@@AB@@A@A@@@A@@@AA@@@@@AC@@A@@@BABA@@B@@@@A@A@@@AB@@A@A@@AA@AAAAAA@A@@A@A@A@A@@@A@@@AB@@A@A@@@@@@@A@@A@AA@@A@@@A@AAA@@A@A@AB@@A@@@@@@@A@@BA@A@@@@@AA@BA@@AE@AA@@A@@@@BCB@@A@A@@@@BA@@@A@@A@@@@@@@@BA@@AA@AAAA@@@A@@BA@@@@AA@AA@@@A@@@AA@@@@C@@@@AABAA@@A@@A@AA@AAA@@AA@A@@@AA@@A@@@AAA@AA@@AA@A@@@@@AB@@@@@@@AA@@A@@AA@@AA@@@@@@A@A@ABA@@@AAAA@C@@A@@@@AA@A@@A@AAAA@@A@A@A@A@@AA@@@@@@A@@A@A@AAA@@A@@AA@A@AC@A@@B@A@A@ABA@A@ABA@@B@@AABAA@A@A@AA@@A@@@@A@AAA@@A@@BA@@@@BA@@BABA@@B@@@@@B@@@@AB@B@@@B@BA@@B@@ABABA@AB@BAB@B@B@B@BB@@B@A@@A@A@A@@BA@@@AB@@A@A@@@A@@@A@A@@B@B@@@BA@@B@@A@@@A@A@AA@@@@A@@@A@A@@@@@C@A@@@A@@@@@A@AA@@@@A@@@AA@@@@A@@@C@BD@@@BABBB@@@B@B@@@@@BA@@@@B@@@@@B@@@B@@@B@@@B@@BB@B@B@DB@C@A@@BA@@@@@AB@@A@@B@@A@@B@B@B@@@@@@AB@@@@A@@@@@@BA@@@AAA@@BA@@@@B@@AB@B@B@B@@@@A@@BA@@@@D@@@@@BA@@DA@@@A@@@@B@@@BA@@@B@@@@B@@@B@@@@@@@B@@@@@B@@@@@@B@@BB@@@@BB@@@@@@@@B@@B@@@@@B@@@@@@@@B@@@B@@@@@@@@@B@@@B@@@@@@@@@@BtF@@@@@@BB@@BB@@@@D@B@BB@@@@@@B@@@@@@BB@@B@@B@@@@@@B@@@@@@@B@@@@@@@B@@@B@@@B@@B@@@@@@@B@@@@A@@@@B@BA@@@B@@@AB@@@@@@@@A@@@@@@@@B@@@@@@@B@@A@@@@@@@@@A@@@@@@B@@@@BB@@@@A@@@@@@BB@@@BB@@@@@B@@@BA@@@@B@@A@@@@AA@@A@@@@@@@@@@A@@@@@@@@@A@@@@A@@@@@@@@ABA@@@@@A@@B@@@@@B@@@@@B@@@@@@AB@@@@@@A@@B@@@@@@@@A@@@@@@@A@@@@@@@@@@BA@A@@@A@@@@@@@@BA@@@@BA@@@AB@@@@@@A@A@@@@@A@@@A@@B@DAB@@@@@BA@@@ABB@@@@B@@@@@B@@@B@B@@@B@@A@@BA@@BA@@@@@@BAB@@ABA@A@@@@@@ABA@AAA@@@@@A@@@@@AB@B@@A@@@@@@C@@@@A@@@A@B@@A@@@@@A@@BA@@B@@@BA@@@A@@BA@@BA@A@@B@@@@@@@@AB@@@@BB@@@BA@@BB@@@A@@BA@@@BB@@@B@B@@@@A@@B@B@BA@@@AAA@@DA@A@@@A@@A@@A@@@@BC@A@A@@@@@@A@@@@AA@@A@@B@@AB@@@B@@@@AAAAB@A@@@ABA@@A@@A@A@@A@A@@@CAAA@@A@AAA@@A@A@A@@AA@@A@@@@@ABA@@@@@A@@@@A@@BA@A@@@@@@AB@@AA@@@C@@B@@A@@@@@@@@A@@@@@@@@A@@BA@A@@@A@@@@@@A@A@@@A@@@@A@@A@@AB@@C@AB@@@B@@@B@ABB@@@B@@@B@BA@@@@@AAA@@BA@@@ABA@@@@@AB@@@@B@@@@@DBD@@@@B@BB@@B@@B@@B@BAB@@ADC@AB@B@B@B@@A@@B@@@@BBAB@@@B@@@@@B@@A@@@@B@@@@BB@B@@BB@BA@@B@@A@@@A@A@@B@@BB@@@B@@@B@B@@@@@AA@A@A@@@@BA@@@ABA@@A@@BA@@A@@@@@@@@BA@AA@@@BA@A@AB@@@B@B@B@B@@@@@@AB@@BA@@BB@@@@@@ABA@@BADBBBBABA@@BA@AB@BAB@@@BA@@B@@AB@B@B@@@@@B@B@B@@AD@B@B@@@B@@@BB@BB@B@BB@@BBA@@@BBB@@ABB@@BB@@B@B@@BB@B@@@@@B@@@D@@@@AB@BB@AB@B@@@BBD@BBB@@B@BB@@@B@@@B@B@@BB@D@B@@@B@BAB@BB@@B@BB@@@@BB@@BB@@@@BA@A@AB@@@DA@@@A@@@@@@B@@AB@@@BAB@B@B@B@B@@@B@BA@@@@@@BA@@@A@ABAAA@A@A@@AAB@@@@A@@@@BA@A@@@A@@A@@AA@CAAA@@A@@A@@@@@AA@@@A@@A@A@@AC@@BA@@@@@AB@B@@A@A@@@A@@@AA@@@AA@@BA@@A@@A@A@@AA@@AA@@@ABA@@@@AA@@@A@AA@@A@@B@@A@@AA@@AA@@@@@A@@AA@@@AAA@@A@@@BA@C@@DADC@@@A@@@AB@BA@A@A@@BE@@@AWQK[@@@A@@A@@BA@@@A@A@ABA@A@@AC@AA@A@AAA@A@A@@A@@@CA@A@CAAA@C@CAC@@@@AB@BA@@BA@@@@@ADA@@BA@@@ABAAA@AAAAAAA@AA@@@A@A@@@@@A@A@@AAA@@@@AAAAA@@A@A@@CA@@A@BCA@CA@@@AACA@A@AA@AAA@@B@@@ACB@AAB@@A@@@A@@AA@AA@@@@@@A@CB@@A@A@@BA@@@ABA@@@@@AA@@@A@A@CCA@A@A@@@A@A@@@BC@@A@@AC@A@CA@@AAA@A@AB@@@@@@AA@AA@@AA@A@AAA@CA@@@@BABABA@A@@@@@@@@CA@AA@@A@@@@BA@@AA@@AA@AAA@@B@BADA@A@CAA@A@@ACAA@@A@@@A@@B@@A@AB@@A@@ACCA@@C@AA@@ABA@@@AA@@A@@@@AA@@@@BA@@AA@@A@@@A@@AAA@@@@A@@@@@AA@@BA@@@@BAA@@@@@@A@BAAB@@@@A@A@@A@@@@A@@AC@A@A@@@AA@@@@BA@@@ABA@@@@@A@@@A@@@@@A@@BA@@@A@@@@@@@@@BBA@@B@@@AA@@@@@@@C@@@@BA@@BAA@@@@@@C@@@A@@@@@@AA@@A@@AA@@A@@B@@@@@@@@A@@@@@@ABA@A@@@@AA@@@@AA@B@@@@@@@BA@@@@B@@@@@@AA@@@@A@@A@@@@@@@@B@@AB@@A@@@@@@ABA@@BA@@@@A@@AAA@@AA@@@A@@@@BB@@BBB@@@B@@AAAB@@A@@@@B@@@B@@@B@@AB@BA@AB@B@@A@@@A@@@@@@@@B@@A@@@@@B@BBB@BB@@@@A@A@@@@@@B@@@@@@@AC@@@@@AAA@@@AAA@AA@@A@AB@@A@A@@@AB@@@@@@@BA@@@A@@@A@CAAAAAA@A@@@A@@@@@A@A@@@@@@BA@@@C@@@A@A@A@A@@@@B@@@B@B@@@B@@@@BB@@@BB@@BB@@B@D@BB@@@@BB@@@@@@B@BBB@B@@A@@B@@@@AB@B@B@@ABBB@@@BA@@@AB@@A@@@A@@@C@@@@A@@AB@AA@@@@@A@@@A@@BA@@B@@@B@B@@AB@@@@@B@B@@AB@@@@@@A@AB@AA@@@@@AB@AA@@@A@A@@@A@@B@B@@A@@@A@@@A@@BAB@@@@A@A@@BA@@@@@A@A@@@@@@@AA@@A@A@A@A@@@@@AAAC@@CA@@A@@@@@A@A@@@@A@@A@@@@@A@@AA@AAA@@@C@A@@@@@A@@AA@A@@@@@A@A@@@AA@@@@A@@@@@@@AA@A@@C@@@A@A@@@@A@C@ABA@@@A@A@@@ACA@A@@C@@@A@@A@@A@AA@@A@AB@@A@@BC@A@@BAB@B@@AB@B@@A@A@AAA@@@C@A@@A@@@@A@AACA@A@CE@A@A@@@@@@@@@A@@@@@@@@@BA@ADA@@DE@AAAAABA@A@@AA@AAA@@@AA@@@@A@@BA@A@@@C@@@AA@@@A@A@@AA@A@@@@AA@@AB@@A@@A@@A@@@A@@@A@@AA@@A@@@@AA@AA@@A@@@AC@@@@@A@@@A@@@@@A@@A@@@A@AAA@@AAD@A@@@@@@@@A@@A@@@@@@BA@@@@@@A@@@A@@A@@@@@A@@@@@A@@@@@@A@@@@@@@A@@@@@@@@@@B@@A@@B@@@@@@@@A@@@@A@@@A@A@@@@@B@AA@@@@AA@@@@@AA@@AA@@A@@@@@A@@@AA@AA@@@@@A@@AC@@@A@@A@A@@@@@ABA@A@@@@@@@AA@@@@A@@A@@@@@A@A@A@C@@@@A@A@@@AA@@@@A@@@@B@BC@@A@AA@@@@@A@@A@A@AA@@A@@A@@@@A@C@@AA@@@@@@A@@A@@@A@@@A@@@A@@A@@@@A@@@AA@AA@@BAA@@@@A@@@A@@@ABABAB@@@@@@A@A@A@@@AAAACA@ABA@C@@@AB@@A@@B@@@@AB@@@@A@@@@@A@@BA@@@@@@AA@AA@@AB@@AB@@AA@@@@A@@@A@@@@BA@@A@@@@AB@@A@@@C@A@@@AB@@@BA@@@A@@@A@A@@A@@AAAA@@A@@A@CA@@A@A@@@AA@@@@A@@AA@A@@@A@@@@A@@AA@@@@A@@AA@@AA@@AAA@A@@CA@A@A@A@A@@AA@A@@@@@A@@@AA@@@@A@@@AA@AC@@@@@A@@A@@A@@@A@@AA@@@@@A@@A@@AAAA@@@@ABA@@A@@@AAAB@@@@@@@B@BA@@BBB@@@B@@@@@B@@@B@B@BADA@@B@@@B@@@B@@ABAB@@@@@B@@@BB@BD@B@@@B@@@@@D@BBB@@A@ABC@C@A@A@EAA@A@@@AAA@@@@@@@ABA@AB@@A@A@A@@AA@@@@A@@AB@@@@@@@B@@AB@B@@@BAB@@BB@@AB@@@BAA@@AA@AA@A@A@@@A@@@@A@@@@A@A@@@ABA@A@A@A@@@CB@BA@CB@@@BA@@@A@CAABA@A@@@EB@@A@@A@@@@AA@AAAAAA@@AA@@@@@AA@AAC@@AA@AAA@A@A@@A@@A@AAAAAAACEAAAC@@AAAA@@CA@@E@A@AAA@ABAA@BA@@@@BA@AA@AAA@AA@@@@@AAA@@ACA@@AA@@A@@A@A@@@A@C@@AA@@@@AA@@A@ABA@A@@@A@A@AA@A@@AA@@@@A@A@@A@@AA@@A@AACAA@@@CA@@AAA@@@@@@BAB@@AA@@@A@@A@@@BAA@@@AA@@@@@@@@AA@@@@B@@AA@@@AA@@@@@A@@A@@@AAAAAC@@A@@AA@@@AA@@CA@@@@AA@@A@@@C@@@A@@AAA@A@@@A@@AA@@A@@A@@@AA@@A@@BA@AB@@@AAB@@A@A@@B@@@@@@AB@@A@A@@@@AA@A@A@@@AA@@@@BA@A@A@A@@@A@A@@@@AA@@AA@@@@AA@@@@@@@AAA@@@A@@B@@@@@@@@@@A@@@@B@BA@@B@@AB@@@@A@CC@@A@@AA@AA@A@@A@@@@AA@@A@@A@A@@@C@A@A@@@A@@A@@CAAA@@ABA@@@@@AAAAC@@@@@ABA@A@A@A@A@@@A@C@@@A@@@A@@AC@@BA@@BA@C@C@A@AA@@C@@@A@A@@AA@AA@AA@@AAA@@@@AAA@@@A@@A@@@@A@A@A@@AA@AB@@A@A@A@@@@BAAA@AB@@A@@@@@@D@@A@@@CBA@@BC@A@A@@B@BA@A@A@A@@@A@@B@@A@C@A@ABA@@@ABC@A@AAAA@@A@A@A@CA@@@BA@@@@BA@@@EB@@@@A@AAC@A@A@@AA@A@@@CA@@EA@@A@A@A@C@@@@AAAA@A@@AC@@@@@@@@AAAA@AA@@@A@A@ABAAAAA@AACA@CA@AA@@@A@A@@@A@AA@AA@@@A@@@AAAA@@AAA@@@A@@@A@A@@AA@AA@@A@A@@@A@@BC@@@A@@@@@AB@BC@@@@A@@@@AA@@@@AACAAAAAA@@AA@CA@@A@AA@@AA@AA@@AA@@AAA@@@AA@AAA@@@@BA@A@@BA@@B@@@@C@@AA@@@A@@@A@@@@BA@@@A@@@A@CCA@A@@@A@@BA@ABA@AA@@@@@@A@@@A@A@@@AA@@@AA@@@A@A@@@@A@@@A@@AAA@A@@@@A@@A@@@@BABCBABA@A@AAAB@@@@A@ABA@EBA@@@A@@@A@@AA@A@A@A@AAA@A@AAA@A@@A@@A@A@C@A@AA"],encodeOffsets:[[116779,26611]]}},{type:"Feature",id:"360829",properties:{name:"安福县",cp:[114.619893,27.392873],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAAAAA@@A@A@@@@@A@@@@B@@A@@@@@AA@@A@@@A@@BA@@@A@CB@@AAA@A@A@A@@@A@@@A@@B@@CA@@A@@@A@AA@@ACA@A@C@@@@@@AA@CBA@@@A@A@@@@B@@@@A@@BA@@@A@AA@@ABA@AA@@A@@@A@@B@@A@@@AA@BAA@@A@A@ABC@A@AA@@A@AAABA@@DA@@@A@@@@@A@@@A@A@@AA@@@@@A@@@A@@A@@@AA@@@@@A@@@AB@@A@@@@B@@A@@@@DAB@B@@@B@@@@@@@BB@@B@@A@A@@@AB@@@BAB@B@@@B@B@B@@@B@BA@@@C@@@@B@F@@AB@BABA@@@@@A@ABA@A@@B@@AB@@C@@BAB@@@@CDA@@@@@@B@BA@AB@@AB@@@B@@@DAB@@@B@BABB@AB@@@BAB@B@B@BBD@D@BABCBAB@@@B@BA@@@A@A@@@A@@B@@ABA@@@A@A@@AA@@@ABABA@ABAB@@@@A@@B@BA@@BA@@BA@ABA@A@@@ABABABABAB@@@BA@ABCD@@AB@@AD@@CDAB@@@@@@@BA@@@A@@@CBA@@@A@@@@@@@@BABA@@@@BA@@BABCB@B@@@@A@@@AB@@BB@@AB@@A@@BA@@@A@@@@BA@@B@@BB@@@BA@A@@B@@@BA@@@@B@@@@@BB@@@@@@B@@@BA@@B@@A@@B@@AB@@B@@@@BAB@B@@A@@@@@@B@B@@AB@B@@@B@B@@@B@DAB@@@D@B@BA@@B@@@B@@CB@BB@@BA@@BAB@@AB@BB@AB@B@@@@AB@@A@@@@B@B@@@B@@@@@@@@A@@@@@@@@@A@@B@@@@A@@@ABAB@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@I@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@AA@@@@@@@@@@@@@@@@@A@@A@@A@@@@@A@@@@@@AA@@@@A@@@@@@@AA@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@AB@@A@@@@@@@@@@@@@@@@@A@@@@@@@@B@@A@@@@@@@@@@@@@AB@@@@AB@@@@A@@@@@@B@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@A@A@@@@@@@@@CF@@@@AD@@@@@BAB@@AB@@@@CB@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@@ABA@@B@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@A@@B@@A@@@@@@@A@@@A@@@@@AA@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@B@@@@@AA@@@@@@@@@@@A@@A@@@@@@@@A@@@@A@@@@@@@@A@@@@@@@@B@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@@A@@AB@@@@@@@@@@@@@@A@@A@@@@A@@@@@@@@@@@A@@@@@@@@A@@A@@@@@A@@A@@@@@@@@@@@CAC@@@AB@@@@AAAA@@A@AB@@A@C@A@A@@@A@AA@@A@@@@@ABA@@@AB@@@@AB@@ABA@@B@@A@A@A@A@AB@@@@A@AAAAA@@@A@ABA@A@@@A@@@AB@@@@A@@@A@ABA@AAA@@@I@@@AB@@@@@@A@@@A@C@@@@@AA@@@AA@AA@@@@A@ABA@A@AB@BA@@@AB@@CBADA@@@AB@@A@A@A@G@@@A@@@A@@AAAAA@@A@A@@@AAA@@AAAAAAAA@@@A@@B@B@@A@AA@A@@@@@A@@@@@AB@B@BA@ABAA@@A@@@@@AA@@@@@@@A@A@@@@@A@@@A@@@@@AB@@A@@@@@AC@@A@AA@@A@AB@@@@A@AAA@@@@AEBAA@@AB@@ABC@@@@@A@CAAA@@AAA@@AA@A@AAA@@A@@@@A@AAA@@@A@@@A@@@AB@@A@A@A@@@@@A@A@@@A@@@A@@AAB@@@B@@@B@@ABA@@@A@@@A@AA@@@B@@@B@@@B@@@B@B@B@@@@CD@@A@@DB@@BAB@@@@A@EDA@@BA@@D@BA@@BA@@@@BAB@@@@@BABAB@B@@@@ABAB@@@@B@@BBBB@@AB@@BFBDDDBBBFDDB@@BB@BB@BB@@BBB@@@@BB@@@@@BBB@AB@@D@@BBB@@DB@@@BBB@@BB@@@BAB@DAB@@AAAAA@@@@BA@@@@B@@BBBB@B@BA@@@AD@B@B@BA@@BAD@@ABA@@@ADBB@B@H@DCD@B@@AB@@BB@@@BBD@B@@AB@@@B@@@B@@@B@@@B@D@@@@BBA@@BA@A@@B@B@@AB@@@B@@@BA@@BA@@@AB@BA@@@@@@@@@@@ABB@A@BB@@@BAB@@@@BB@@@B@@@BBBBB@BBB@B@B@@@BBB@@B@@B@@@@@B@B@@@B@BB@ABBB@@@BA@@@@@C@@@AA@@AA@@@A@@@A@CA@@@@@AB@@@@AA@@A@@A@@CB@@BB@@A@@@@@A@A@@@A@A@ABE@A@AC@CAA@@A@A@@A@@@@A@@@AB@@A@@AAB@@A@@@@A@@A@@@@A@@@A@@@BA@@@A@@@@B@B@@@B@@@B@BAB@@A@@@A@@@A@@@AB@@@@@BA@A@@@CB@@BB@@A@AB@@ABAB@BA@A@A@@BFD@@@B@@@B@B@@@@A@@@AA@@@@A@@@AA@@@@@B@@@@A@@@@B@B@@@@AB@@@@A@@@C@@@AB@@@@EBA@A@A@A@A@@@AAA@@@@@@AABA@A@@@AB@@B@@B@@@@A@@@@@@B@@A@B@@@A@BB@@@@A@A@@@ACA@A@@@@@@B@@@B@@@@AB@@EDBB@@@D@D@@@B@B@D@@@B@@AB@@ABA@@@@@@B@B@B@BAB@D@@A@@@@AC@@@A@@@@B@B@@C@@@A@@@ABA@@@@@AA@@@@@@ABAB@@AB@@@AA@@@@BAB@@@B@@A@@@@@AB@@A@@@@@@@BBB@@@BB@B@@C@@@@@A@@@@BA@A@@@@@BB@@A@A@@@@BB@@@@B@B@@@@@B@@@@B@@@B@@BB@B@@@@BA@@@A@@@A@@@A@@@@@@@A@@@A@@BA@@@@@A@@@@BAB@@A@@AA@@@A@BBA@@@@BB@@@@B@@AA@BA@CB@@@@A@@@A@AAED@@A@A@@@@B@BABAD@@@BC@A@@BAB@@AB@BA@@B@BB@@@BB@@@BB@@@BBB@@@@@AB@@AB@@AB@@@@@B@B@@@B@@@@AB@@@B@@@@@BB@@@@BB@BB@@B@@B@@BB@@@B@B@BB@@B@@@BB@@B@@BB@@@D@@@B@@B@@B@@@B@@@B@@@BBB@@@@A@@B@@@BA@@@@B@@B@@@B@@BB@BB@@@BBB@@@@BBBBB@@BD@@@@BB@B@@@@@B@@@B@B@BA@@B@@AB@@@BBBBD@B@BAB@@@B@BABA@@B@BA@@@@BBBB@@D@@@@@@B@@@@@A@@B@@B@D@@@AB@@@@@B@@@@@D@@@AB@@B@B@B@@B@@B@@AB@@A@@B@@@B@@A@@B@@A@ABAD@BAB@B@@AD@@@B@@@B@B@@@DDB@B@@@B@B@@BBBB@BBB@B@BB@@B@DB@@B@B@@@B@B@B@B@@BB@B@@@D@BBB@B@@@BAB@@A@@BAB@BA@@B@BAB@@AB@@@@ABA@@@@B@@@B@@@B@@@B@@@B@@@B@@@@@B@BB@@@@B@@B@@@@B@@@@BB@@@B@@A@@@@B@@@B@@@DAB@@@BB@@@AB@@@@BB@B@@@B@@@D@BBB@@@@@BB@@B@@BB@BBBB@@@@@@@@BBBB@@@@BB@@B@B@B@D@B@B@@@@@@@BB@BB@B@@@B@@BB@B@BB@@@@@@B@B@BBBB@@BBBB@BB@@BDB@D@@@B@@BD@@@B@BABBB@@@@@BBB@@BB@B@BBBAD@@BBB@BBB@@@@@@@@B@BB@@BB@@@B@@@FBB@B@FB@@AD@B@@@BBB@B@B@@BB@@@BB@@BBB@@BD@@BB@@DB@@@B@B@@@B@@@B@@@@@B@B@@@@@B@@D@@B@B@@AD@@@@@BA@AB@@AB@@AD@@AB@@ABB@@B@@@B@@BB@@@@BH@@B@ABABBB@@@@@B@BB@@BA@@BB@@@BB@@@@BBB@@BB@@@D@BABBB@@@B@@@@BB@@@B@B@BB@@B@@BD@BB@@BB@@BB@BB@@B@@BB@@@B@@@B@BBB@B@@@BBBB@@B@@@BABBB@B@@@B@B@@@B@BB@AB@BB@@B@@BD@@DB@@B@D@B@BBBB@@BB@@DBBBBB@@DA@@D@@@B@B@B@@@BB@@B@BB@B@@BBB@B@@@@B@@DBB@B@@@D@@@@@B@@@B@@@BB@@@BB@@@ABBB@@@@BB@@BB@@@BBB@@FB@@B@B@B@BBBB@BB@B@@@BBBBB@B@DB@@@@B@@@BB@@F@BBDAB@D@B@B@D@H@BAFAB@DCD@B@BAB@DAB@@A@@B@DA@A@A@@BA@A@AB@@@B@@@B@BAD@BADAB@FCDC@@@A@AB@@@B@FDB@BBD@BBBBBBB@BB@@DB@@@@BA@ABA@ABADA@@B@@A@@@C@@@@@ADA@@@A@AB@B@@@BA@@DAB@@AB@@AB@B@@A@@BAB@@@BA@@@A@@B@@@B@BBBBBB@B@@@B@@@BB@@B@B@DABBB@@B@@BBB@@BA@@@@@A@@@@B@BBDBD@@@@@@A@A@@B@DB@@@A@A@C@ABCBA@@@@B@B@@@BBB@@@@@BA@BB@@@B@B@F@DAB@B@@@BB@@@B@@B@DAB@B@BAFAB@B@B@B@B@B@@@B@@@@BDBBB@BABBB@B@BBBBD@@BBF@BBB@B@@B@@@B@BFF@@B@@@@A@A@A@@B@F@F@@@@@@@BB@BB@B@@@@BBBB@B@@@D@B@D@B@BA@@@@@A@C@AB@BABAB@B@@@BB@@B@BAB@@@B@AA@@@A@@@ABA@@@@@@BF@BBD@@@B@@B@B@@@B@B@B@@BB@B@BFDBBBBB@@D@BBB@@BBD@D@@AD@B@@@@@@@@DBB@B@B@B@DCB@B@BBBBB@B@@@B@@@@@DAB@BAHB@@B@@B@B@H@@AB@@AD@@AB@B@@@B@B@@ADAB@B@@B@B@DA@@@@BBBD@@D@@@@B@B@B@BB@DB@@B@@@B@@BBB@@BBB@BBBBB@@@@@BAD@DADADA@A@@@AB@@AD@@@@@@ABA@@@A@@AA@AA@BA@@BA@@AA@AAA@@@AB@BA@A@AB@@ABABA@A@@@A@@AA@@BA@@@A@@@AB@@AA@@A@A@@@@@C@@@A@@@A@@@@@@@A@@B@@@@A@A@@A@@@@AA@@@A@@A@@B@@A@@B@BCB@@@BABA@@BA@AB@@@BB@@@BB@@B@@@@@@@@BA@@@AA@@@D@@@B@@@BA@@CAA@@@B@B@B@B@B@BB@@@@@@A@@B@@@@@@@@B@@B@@@@@@@@BB@AB@@@B@@@@@@@@@@@@@@@@@@@B@B@@ABB@@@@@@B@@@B@@@@@@@BB@@B@@@B@@@@@B@@@B@B@@@@@B@@@@@@@@@B@@@BB@@B@@@B@@@BA@@BBB@@@@@BB@@BA@A@@BB@@B@@@B@@@@@B@@@B@@@BBB@@A@@B@B@@@@AB@@@@@DA@B@@BBB@@AB@B@@@@BB@@BD@@AB@@@@AB@@@B@BA@A@@AAAA@A@@@A@A@@B@@@@AB@@@@AB@@CB@@AB@BA@AB@@@B@@@@@B@B@B@@BB@@@B@@B@B@@B@@AB@@@B@@@BB@@@@@@B@@@BA@@BA@@B@@BB@D@@@@@BB@@@@BA@@B@@AB@@@AAB@@A@@@A@AAAA@@A@@@AA@BA@@@A@@@@B@@@B@@@BA@@@AB@@A@@@A@@B@@A@@@@B@BABA@@B@@@@@B@@A@@A@@@@@B@BCB@@A@@@@B@@@@BBAB@BBB@B@B@@@B@B@@@@BB@BB@BB@B@@@@BB@@@B@@B@@@BB@@@@BB@@@BBD@@B@@BB@@AB@@A@@@@@@@@BD@@@@B@@@B@@@@@BB@@BDH@B@BBB@B@@@@BB@B@B@@@@BBB@@B@@@BB@@BBBB@@@BD@@B@B@@@@@@B@B@BB@BA@@@@@@@@B@@@BB@@@@@@@@@B@@BB@@AB@@A@@B@@BB@@B@@@@@BB@@AD@B@@@BB@@B@B@@@B@@@@@B@@@B@@@B@BB@@BBB@@@BB@@@BB@@B@B@BB@@@@BB@@B@@BB@@@B@B@@@BAD@@@@@@B@BBB@@B@B@B@@@B@B@@@@@BFBBA@BB@@BA@A@C@@B@B@@@BAB@@@DBB@B@B@B@@@BBB@B@@BB@B@B@BBB@B@B@B@@@B@@@@@BABB@@@@B@@@@@@@@@@B@@B@@@@@B@@@B@B@B@@@@B@@D@B@@@@@@@B@@@@B@@@B@@@B@@@@BA@@B@@@B@B@B@@@B@@@@@B@@@@CAABA@AA@@@B@@A@@@AB@@A@@@A@@B@@@BB@@@C@@BA@@@@@@@A@@B@@B@@@@@A@@@@@@@A@@@AFA@@@ABCBC@@@A@@@C@@AA@AA@@A@@BC@A@A@A@A@@BA@@@@B@@@@A@@@A@@@@BA@ABA@@@AACAAA@@@BA@@@@B@B@@A@@AA@A@A@@A@@AAAAA@A@@@@@AB@BA@@B@B@@@@@BC@@B@BC@@@ABAB@BA@@@A@A@@@@@AAA@AA@@A@AAA@AA@@@BB@@@B@@@BA@@BA@@@AAC@ACA@A@AAABAAA@@A@@AA@@A@A@CC@@@AA@@@@A@@@AA@@@BA@B@AB@@@@AB@B@@@@@@A@@A@@@@A@@BA@@@AAAA@@AAA@@AAAA@AAAA@EAABA@@B@AA@@@@AA@B@BC@AB@@ABA@@@@B@@A@@DA@@@BB@B@@@BCBCD@BABA@@@ABA@A@@@A@ABA@@BA@A@@@ACA@@@A@A@@@A@@@@@ABA@AA@@ABAB@@@@BB@@BB@@@DB@AB@BA@@@AB@BAB@D@@ABA@@@@B@@@@@@A@@A@@@AA@@@A@@@A@A@@A@@A@@@A@@@@@@AC@@@@@AB@@@B@@@B@@@@@@A@A@@D@D@@@@AB@@AA@@@AA@@@A@A@@@AA@@AB@B@@AB@@ABA@@B@@@BA@AB@BAB@BA@A@A@@@AD@@A@@BAB@@@@@B@@B@@B@@@@A@@@@@@B@BA@@B@@B@@@@@@@A@@@A@@BC@A@@BA@@@BBA@@BB@@@A@@B@@AB@@@@@B@@@A@@AA@BA@ABA@@AA@@B@@@@@DBBB@@BA@@B@@ABAA@@A@@@A@A@@AAA@@@@A@AB@@@B@@@@C@A@AB@@@@A@@@A@@AA@AB@B@@A@@@A@@@AA@@@BA@ABCBA@@@@@@BB@@@@@A@A@@@A@@@@@@A@@@A@@A@@A@@@@A@@@@@@@@B@@@@A@A@A@@@@@CA@@@@A@A@ABA@@B@@A@A@AB@@A@A@@@AB@BA@@AAB@@AB@@A@@@A@A@A@@@A@A@@B@@@@A@@@@@A@A@@B@@@@AA@@@AAA@@@A@@@@@@@A@A@@@@AA@A@@@@@@@@A@@@@@C@AB@@ABCB@@@@A@@@A@A@@A@@@@@@@@@@@AA@@@AB@@@B@@A@@B@@@@A@@@@@@AA@@BA@AB@@AB@B@@@B@@@B@@@B@@@BA@@BA@@B@@@@ABAB@@@B@@ABAAA@A@AA@@AC@AB@A@@@@@CA@@@AAC@A@@AAA@@@@@@@ABAA@AA@@@@@@@@B@@A@@@@@A@@@@@@AA@@A@@A@@A@@A@@@@@@@A@@@AA@@@A@@@@AA@@@@@B@@AA@@@BA@@@A@@@@@A@@B@@AA@@@@A@@BA@@@@@@B@@@@@@@@@B@BABA@@B@@A@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@A@@@C@@@@A@@@@@@@@A@@@@@@@@@@AA@@@@@@@@@@A@@@@@@@@@@@A@@@@@@A@@A@@@@@@@@@@A@@@ABA@@@A@@@@A@@@@@@@@@CE@BAA@@@@@A@@A@@@@@@@@@@@@BA@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@AA@@@@@@@@@@@@@@@@@A@A@@@@@AAA@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@AA@@@@C@@@@C@@@AA@AAA@@@@@@@@@@@B@@@@@@AA@@@A@@@@@@@@@@A@A@@@@@@A@@@@@AA@@@@@@@@@@@@@@@@@@@@@A@@@@@@@AA@@@@@@@@@@@@@@@AA@BAB@@@@A@@@AAAA@@B@@A@@BA@@@BA@A@A@A@@AA@@AB@@@@AA@@@@AB@@ABAA@@@A@@@@@A@@@A@@@@B@@@@A@@@AB@@@AA@@A@@@A@@B@@@@@B@@A@@A@@A@@@A@@@@A@@@A@@AAA@@A@@AA@@A@@@@@@A@@@@@@@@BAB@@@B@@@@@@A@A@@@A@A@@AA@@A@@A@@@@@A@@B@@@AA@@@AB@@AB@@AB@@@@A@A@A@@@A@@AA@@CA@@BAAA@@A@AA@@A@A@A@@AA@@AB@@@@A@@@A@@@@A@@@A@A@C@A@A@@A@@A@A@@@@@@A@@@@@@B@@@@A@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@A@@@@A@@@@BA@@@@@@@AB@@@@@@AA@A@@@@@@AA@@A@@@@AAA@AAC@A@AA@@@A@@@@A@@@@BA@@@@@A@@AA@@@AAA@@@A@AB@A@@A@AAA@@@@@@@@@@@@A@@@@@@@@@A@@A@@@@@B@@A@@@@AA@@@@BA@@@AAA@AAA@@A@EBC@A@A@@@AA@A@A@C@AACAAA@@C@@A@A@A@A@AAA@AAA@AA@@AA@ACA@AA@@@CBABABA@A@@BA@@BA@@AA@@AAA@@@A@@@AF@@@B@@@@A@@AA@ABA@@@@@AB@AA@@AAAA@@A@AB@@AB@@A@A@@@A@@AA@@@A@A@@BA@@@E@C@@@AA@A@@@A@@@@A@@@A@A@A@@AA@C@A@CAAA@@ABA@@B@@B@@B@@A@A@A@@@A@@BA@@B@B@@@@A@ABABABABADABABA@A@A@@@A@A@AACEACC@A@A@A@C@A@ACA@CACA@@BA@@DA@@BC@@BA@A@A@A@A@@@A@A@A@A@AACBA@@AA@@ACAC@@A@AA@A@A@A@@@@B@@@B@DEDA@A@AA@@@@ABA@@B@@A@A@A@A@@D@@A@@@A@@B@BA@@DA@A@@BAB@@@@A@@@@@A@A@@A@@A@@@A@A@@@@@@@A@A@@@A@@@@DA@AB@@@@@AA@@@AAAAA@@@AB@@ABAA@@A@@@A@@B@@BB@B@BCB@@A@@@A@@AA@@AA@@AGA@AA@@@AA@AC@@@A@ADA@@BAAA@@B@B@B@@@@AB@@@@A@@@A@@@AB@@A@@A@@A@@@ACB@AA@@@A@A@AAA@AAAA@A@@@A@ABA@A@@AA@@@@@AA@B@A@@A@A@@@@AAA@A@A@@@A@@@A@@@@@@AA@@A@@A@@@@A@A@@@BA@@@A@@@C@@@A@@@A@A@AA@AAAAAA@@@A@A@@@A@@AA@A@A@@A@@@A@AB@@A@A@@A@AB@@@@A@A@@AA@AB@@@@@@A@@BABAB@@ABA@AAA@A@@@ABBB@@BB@@@@@@ABA@A@A@@B@@@BA@A@AB@@CDAD@@A@@B@@ABAB@@AA@ACA@@B@A@@A@@A@@@@@@A@A@@A@@A@A@@AAAA@@@A@@A@AAAAA@@CA@@@AA@A@@AAAA@A@@AAA@@A@@@@A@@AA@@AAA@A@@@@@CAAA@AA@@@@A@@BA@@@CBA@ABA@@AA@@@AAA@AAA@@@AAAA@@@@@@A@ABA@@A@@@AA@@@C@@AA@A@AA@@AB@@A@@AA@@AA@A@@AA@AA@@@AC@@@A@@A@@@@A@AAA@AAA@A@@@C@@@A@A@@@A@A@@ABA@@@@@A@@BAA@@AC@A@@@AA@@@A@AA@@@@AA@A@A@@@@BAB@@@B@D@@AD@@@@A@@@AAAAA@@AA@A@@@@@A@@@A@"],encodeOffsets:[[117243,27723]]}},{type:"Feature",id:"360828",properties:{name:"万安县",cp:[114.759364,26.456553],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@B@B@BB@@B@B@BAB@BA@A@@@A@A@@B@@AB@@AB@@AB@B@@B@@B@@@@B@@@B@@@D@@@@BAB@B@@ABA@@A@B@@@BAB@B@B@@A@@@A@@@@BC@A@@@A@A@@BA@@BBB@@AB@@@@@B@@A@@B@@AAACA@A@A@@@@@AB@@AB@B@@@BA@@@@BA@@B@@B@@B@B@BA@@B@@@B@B@@@BABB@A@@@ABCAA@@@A@@AC@@A@@A@@A@@A@@@@AAA@@@@A@A@@@A@@@A@A@@@@AA@@AA@AAA@@@AA@@@A@@@C@@@A@A@@@AAA@A@@@@A@@AA@@@@AAABAA@@AA@@@@A@AB@@@@@AA@@@A@A@@A@@A@AB@@A@CAA@@@E@@@A@A@@@A@@BAB@@@BAB@@A@@@A@A@@AABA@@@@@A@@BA@@BABAB@@@@@@AB@B@BC@@@@BA@@@@BA@@@@B@@A@@B@BA@@@A@@@@@@@@BA@A@@AC@A@@B@@A@@@@@A@@@A@@@A@@AA@A@A@A@AB@@@@@B@@A@@BA@@BB@BD@@A@A@@BAB@@@BA@@@ABA@@@@B@@@B@@B@@@@@B@@@@BBB@@@B@@BB@BA@A@A@A@@@A@AA@@A@A@@ACCAA@AA@@BA@@AAAA@@@A@@@@@AB@BA@@B@@@@ABBBB@@B@@BB@@@@@BA@@B@B@B@@@B@@AB@@AB@BA@@@@BBB@@ABB@@B@B@D@@@@A@AB@@A@@B@B@BB@@@@B@@A@@@A@@@@@AB@@A@@AABA@@@@@A@@@A@@BA@@@@BA@A@C@@@@@AB@@A@AA@@@@@A@@@@A@A@@@A@@@A@A@@A@@A@A@@@ABA@@@ABAA@BA@@@AB@@ABA@@BCD@@@@AC@@AACAC@A@C@A@GBC@WFA@A@CBCAG@AA@@AA@@A@@B@B@DAB@B@B@@@@C@@BA@@@A@A@A@@@A@ABA@A@A@A@AA@@A@ABC@@@A@A@ABAB@@@@@@AB@@A@AAABA@A@@AAA@A@@@A@A@@AAA@BA@A@@@@@@@A@@A@@@AA@@A@@@A@@@A@AB@@AAABA@@@@@@@C@A@@B@@@@C@@@AAA@AA@@@A@AAA@@A@A@@@@AB@@AAA@A@AA@@@@@A@A@@@A@@@A@@A@@A@@@@@A@@AA@@@BA@A@@A@A@@@@@AA@AAA@AA@@AA@@A@@A@AA@@A@@B@@ABAB@@C@A@@@@AA@A@A@AAA@@BBB@@@BBB@@@@@B@@@BB@@B@B@@A@ABA@@@ABA@A@@B@@@B@@@B@B@@@BA@A@@@A@@A@@A@A@A@ABA@@AA@@@A@@@@A@@AA@@@A@@@@@AA@A@@@@@AA@@AA@@A@@@A@@@A@@@A@@@@@AAA@A@AA@@@A@@AA@@@@A@ABABC@@AA@@@A@@@@@A@@AA@@@@AA@@A@@@A@@AA@@A@AA@A@@A@A@A@@@CB@@@@A@@@A@A@C@A@AB@@A@@@A@AA@AA@@@A@C@A@@@@BA@A@AA@BA@@@ABA@A@A@ABAB@@C@@@AB@@A@@@A@A@@@AAAA@@@@A@@@AB@@@BA@A@@@AB@B@B@@A@@BA@A@A@@@A@A@@@A@@@AAAA@A@A@@@@@AAAAAA@A@@A@@AABA@ABA@@@A@EA@@@RcmAB@@@@@@@B@@@B@@@@@BAB@@A@@@@@@@@@@A@@@@@@@@A@@@@@@A@@@@@@@AB@@@@@@A@B@@A@@@@A@@@@@A@@B@@@@A@BA@@A@@@B@@@@@B@@@@@B@@A@@B@@@@@B@@@BA@@@@@A@@@A@@B@@B@@@@@@AB@@B@@@BA@A@@@@@AA@B@@@A@@A@@@C@@@@B@@A@A@@B@@@@@B@@@@@@@@@B@@A@@@@@ABA@@@ABAB@@A@AA@@@@AB@@A@@@@B@@B@@@@@@@@@@@@@@@@@@BAD@@A@@@A@@@A@@BA@@@@@@@@@A@@@@@@@A@AA@@@@A@@@@BAD@@A@@AA@@@AB@B@@CB@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@A@B@@AB@@@@@@A@@@@@@@@@A@@@B@@AA@@A@BA@@@ABAA@B@@@@@B@@@BBB@B@@@A@@@BA@@@@@@@@@@@B@@@@@@BA@@@@B@@@@BA@@@@@B@@@B@@AB@BB@@A@@B@@B@@@B@BBD@@@B@@B@@@@B@B@@A@BB@A@B@@@@B@AB@@@@AB@@BB@@@@B@@@@@BB@BB@@@B@@@BB@@AB@@@@BB@@@@@BB@@B@@AB@BB@@B@DB@DD@BB@@@BAB@@@@AB@@@B@@@BBBD@@@BBB@D@BCBABA@@@BB@BBB@@BB@@AB@@@@@BB@@BDB@@@@@@@@@BABABAB@@@@DBB@BBB@B@@BB@@BBB@@@@@@BAB@B@BB@@DBB@D@@BB@@@AD@@B@B@@@B@B@B@DDB@B@@@B@@B@@@@AB@B@@AB@@@B@BA@@D@B@@@@B@@BBB@@@B@@@BA@BBA@BD@@A@@@BB@BBBB@B@BD@B@@DBB@ADB@@@DB@@@B@BB@BBBB@@@@BB@BB@B@@@@@B@B@@@B@@BBBBBBB@BBBAB@B@@AB@@CB@B@@@@AB@@ABA@@B@@D@DBD@B@BB@D@BDB@@B@@@@B@BBB@B@BBBD@@BB@B@BAB@B@@@B@@AB@@@@B@@L\\XR@B@@AF@@@B@BABA@@B@@@B@@CDCB@@@DAB@@B@@@BB@B@@BB@@@B@@@@BB@@BB@@@BA@@@@BB@@B@B@@BB@@@@AB@B@@BB@@BB@@@B@BB@@@AB@@BB@@B@@B@@@B@@@B@BA@A@@B@@@@AB@@BD@@@B@BB@@@B@@B@@@@@BB@@@BBDBB@@BB@@@@B@@@BAB@@@@@B@@A@BB@@@B@BBBAB@B@B@@AB@@@@@@ABA@@@A@A@A@A@A@AB@@A@@BA@@@@@@@@B@@CB@@A@@B@BAB@@@@AA@@AA@@@@AAA@@@AAA@ABA@@@A@C@A@@AA@A@@@A@@@A@@A@AA@AAC@AA@@A@A@@BAAA@@B@@C@@@A@@@@@A@A@@AA@A@@@AA@@AA@BA@AA@@B@AA@@AAA@A@@AAA@@A@@@A@A@C@@BA@A@A@@@@@A@A@A@@BA@@@AB@@A@ABA@@BAB@@ABABAACAAB@@AB@B@@@@A@@AB@@AA@@B@@@@A@A@A@A@@@A@@B@BAB@@B@@BAB@@@@@@@@@BB@@AB@@@AB@B@@AB@@@@@B@BBB@@@@A@A@@@A@@@A@@AA@@@@B@B@@@BA@@@ABA@@AA@A@@A@@A@@@@@@BA@@@@@A@@@A@AB@A@@A@@@@BA@A@A@A@@BCD@BA@ABA@@@@AA@@@AAA@@@@@AC@C@@@@@A@@A@@B@@@@AB@B@@AB@@BB@B@@@@ABA@@@A@@@A@BAA@@@A@@@A@@B@DA@@BB@@@@B@@B@@@B@B@@@@@@@@B@@@BAB@@@B@@@@@@B@@@@@@@@@@BA@@@@D@@BB@@BA@@@@@@@BAB@@@B@@B@@@@@B@BA@@@@B@@@BB@@@B@B@BB@BBB@@@BBDB@@B@B@@@@B@BB@@@AB@B@@@BBABB@B@@A@@@A@@BA@@@@BB@@B@@B@@@@@@@@B@BAD@@@@@BB@@@@B@@@BCB@@BB@B@@ABA@A@@@@B@@A@A@@@A@@A@@AB@@@B@@AA@@AB@@A@@A@@A@@B@@@@@@A@@@@BAB@@AB@@@B@@AB@@A@@@AB@@@B@@@@@BA@B@@@B@@@@@@D@@@@B@@@@ABA@@@@B@@@@@B@BBB@BA@@@@@@@BAB@BA@AB@@@@@@AB@@AB@@@BA@@@A@A@@@A@@@@@A@@@@@AA@B@@AB@@@@A@CBA@@@@B@@@B@@@@@B@B@@@@A@@B@@AB@@@@AB@@@@@@@@@B@@@BAB@@@@@@@@@@@B@@@@@@@B@@@@@@A@@@@B@@@@A@@B@@@@A@@@@@A@@@@@A@@@@B@@@@AB@B@@@@@@B@@@@@@B@@@@@@@@@B@@@@@@@@B@@@BB@@@@@BA@@@@@AB@@A@@@@@A@@A@@AA@@@@@@@B@@A@@A@@A@@@@@@@@B@@@@@@@@@BA@@@@@@@A@@@@@@@@@@B@@@@@@A@@B@@@A@@ABA@@@@@@B@@A@@@@@@@A@@@@A@@@A@@@A@@@@@@@A@@@@@@@A@@@@A@@@@AA@@A@@@@A@@@@@@@AAA@C@@@@@AA@@AA@@@@@@sE@A@@@@@@@@A@@@A@@@@@@@@@A@@@A@@@@@@@@@@A@@@@@AA@@@@@@@@@AA@@@@AA@@@A@@@@A@@@@@A@@@@@@@A@@@A@@@@@@A@@AB@@A@@@@@@B@@CB@@AB@@@@C@@@@@AB@@@B@@A@A@A@A@@BA@@@@@AB@@BB@B@@AB@@@@@@@B@@A@@B@@@@A@A@A@@@@BA@@@@BA@@B@@@@AB@@@B@DCAA@A@A@@AA@@@A@@@A@@@A@@@@@A@@@@@AB@@@@A@A@@@A@AAAB@@C@@A@D@@@B@@B@@B@@@B@@B@@B@B@@@@@B@@@B@D@@@@@B@B@@@B@@B@@B@B@B@@@BA@@@AB@@A@A@@@@B@B@@@B@@@B@BA@@B@@AB@@@B@B@BB@A@@@AAA@A@A@A@ABA@@BABAB@BA@@@ABA@@@A@A@@B@@A@@@@@A@@@ABAB@@AB@@@@AB@@@BB@BBB@@@@@@BB@@B@B@BBBBA@BA@@@AB@B@BAB@B@B@B@AB@D@@B@BBB@@@BB@BBB@B@@@@B@@@@B@@BB@B@B@B@@@BBBBB@@@@BBB@@@@@BD@B@BB@B@@AB@B@B@B@@@@B@@BB@@BB@@@BB@@@@@@A@@B@@@@@BBB@@BBB@BB@@B@@@BB@@B@B@@BB@BBB@@B@BB@@@BBBA@B@@D@@@@@BB@@B@@@B@@BBB@@@@AB@@@B@@BBBBB@@BB@@A@@@@@@B@@@@B@@AB@@@@@B@BA@AD@@@@@BB@@BBF@@ABB@@B@@@@@BAB@@@B@@@@@@@BA@@B@B@BB@BBB@@@B@@@BBB@@@@B@@@@@@@B@BA@@B@@@B@@@B@B@B@BB@B@BBBB@BBB@@@B@BA@@B@@@B@B@@A@@@ABAB@@B@@@BD@@@@B@@B@@@B@@@B@BA@@BA@AABB@BA@ABB@@B@B@B@@@B@B@B@@A@@@@D@@B@@@B@@@@B@B@B@@@B@@@@BBDB@B@BA@@@AB@@@@A@@@A@AA@@A@ABA@A@A@ABA@@BA@@@@@A@@@A@A@@@ABC@@BA@@@@@AAA@@@ABAB@@AB@B@BA@@BA@@BA@@@@@ABCD@@CF@AC@A@@@AA@@@@A@A@@@A@@@@@AB@B@@@B@@@BB@B@@@@BB@@D@@AB@@A@A@@@@BA@@@@BA@@@@@AB@BA@@A@@AB@B@@@@@@AB@@A@A@@@A@A@@BA@@@A@@B@@@BA@@@BB@@ABDB@@@BA@@BA@A@ABA@BBAB@@@BA@@BAB@B@BBB@B@BA@@BAB@@ADB@@B@BBB@DB@BAB@BAB@@@@AB@@@@A@A@@@A@A@@DABADA@AB@BBD@@@@@@BBB@@@BBA@@@BDB@@@@@@@B@@B@@@B@BABA@@@AD@B@B@@@BABAB@@A@@@A@@@A@AB@@@@A@@BA@@BA@@BA@@@@@@D@@BB@@BB@@B@@@B@BBBB@@@AB@B@BB@@@BB@B@BB@@D@BBB@@B@@@@B@B@@BB@@ADB@@B@@AB@@@B@@BBBB@@B@BA@@@BD@FDDB@@B@BB@B@@AB@@B@@@@B@@B@@BB@@@@@F@B@@@B@BBD@@BB@B@@B@@ABB@@@BB@@B@@BBB@@@@@B@@BB@B@@DBB@BBBB@ABBB@@B@@B@B@BB@@@A@ABA@@@AB@@A@A@@DBB@@@@@D@BD@@@B@@B@@@B@@@B@B@@B@@@BB@B@@BB@@@B@@ADB@AB@@A@A@C@C@ABE@CBA@ABABABAHABA@@@A@@BA@A@@BA@@BC@@BA@@@@@AB@BA@B@@B@B@@@@@B@BB@@BB@@B@@A@@DA@@@A@@@@BA@AB@B@@@@B@@@@BBD@B@B@DAB@B@B@@ABBB@@@@@@ABA@@@AA@@@EAEACAAAA@@A@A@C@A@@@@@@@A@A@@@@@A@A@@AA@@ACAA@@@AB@D@B@@@B@B@@A@@@A@@B@@@B@DBB@B@BA@ABA@@BA@A@@@@@@B@B@@A@@A@@@@A@A@@A@@@@@@@B@@AA@B@B@@@@@AA@@AA@A@@@AB@@@B@B@B@@AB@BA@@B@BAB@@@BA@BBA@@@@@AB@@AB@@@@A@AB@@A@@@A@A@@BBABB@@B@@@@B@@@@@@AFADA@A@@BA@@@@@@@@@BAB@B@@BBB@@B@@BBD@DB@@D@B@BA@@BA@@@@B@B@@@@@@@@@AA@@@@@@B@@ABA@@BA@@A@@A@@B@@B@@B@@@AA@@BA@@@@BB@@@@@@@@@B@@@@@@@@@A@@B@@@@@D@@A@BB@A@@BB@@@@B@B@@AB@@@AA@@@@@@BB@@B@@@@B@@@@@@B@BB@@@BB@@ADA@@@A@@@BB@@@@DBBB@@DA@B@@@@@BB@@BB@@@BBB@@@@BB@@B@B@@@@B@BB@@@@A@@@@B@@@@B@@@@B@@BB@@@@@B@@@@B@@BB@@A@AB@B@BA@@@@@AB@@@BAB@BB@A@@BD@@B@@BA@@@BB@@@B@B@@@@A@@@@@A@@@@@A@@BB@@@@@AB@@A@@@@B@@B@@B@@@BBBDD@B@B@@@@B@@BBB@@B@@@B@@@@B@@@@@@@B@@@@@DA@BBA@@@AB@@@B@@BB@@BBA@@@@@BDBBBBBB@@B@@@BBBB@@@AB@@@B@DD@@@@@@AB@@@B@@@@B@@@B@@@@@@@@B@@@BB@B@@@B@@@@@BA@@B@B@B@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@B@@@@@@AB@@@@AA@@@@@@@B@@@@@BB@BA@B@@BB@@@@@@@A@@@@@ABA@@@@@A@A@@@@B@@@@@@@@AA@@@@@BA@A@@@A@@@@@A@@@@@A@@@@B@@B@@@A@@B@@AA@@AAE@@@@@ABA@@@A@A@A@AA@B@@@@@B@BB@@BAB@@B@@@@AB@@@B@@B@@A@@@@B@@@BA@@@@AB@@@A@@@ABA@@@@@@DDAB@D@B@@@BB@@BA@@BB@B@@@@@@@BB@@B@@@B@@@BB@B@@@BB@@B@BB@@@@@B@BA@A@@@A@@@@BBBAB@@@BBBB@@@BBBB@@@@@@CBA@@@AA@AA@@@@B@@@@@@A@@A@A@@@@C@@@@@@@@@@A@@AB@@A@A@@B@AABA@A@@@A@@@A@AB@@@@@B@@A@@@@B@AAB@@@@AB@@@A@@@AB@@@@@@@A@@@A@A@A@@BA@@@ABA@@@@BBDB@@BB@AB@@A@@B@@A@@B@@@@B@@BAB@BAB@@@B@@@@@BC@AA@@@@@AA@@@AA@@B@@@A@@@@@@B@@@@A@@AA@A@@@@BA@@@@BC@AB@@C@@@@B@BA@@@@@B@@@B@@@@B@@A@A@@@@@A@@@@BA@CDA@@@A@@@@D@B@BABAB@@ABABCBA@@@A@@@@BA@@BBB@@@B@@@@AAA@@@@B@B@DBB@B@BB@BBB@BFFFFBBDDHHFHBD@@BBBBDF@H@BBN@@AJ@@@@@BFB@@BB@@B@@A@@DA@@@A@A@@BBBD@@@@@@B@D@B@B@@BB@@B@@@@@@B@B@@@@D@@@BB@@@BAB@BB@@@@@A@AB@@@DB@@@@@@@CA@@@AA@@AB@@@A@@@AB@@BB@@@@A@@@A@@BA@@B@B@F@B@@@B@BB@A@@@@@@B@B@@A@@@@BA@@B@B@BBB@@@@@@@@AACA@@AA@B@@A@@BA@@A@@A@@@@@A@A@A@@A@@@@A@@A@A@@@@@@A@@@@B@@@@@@C@@@@@A@A@@@@@@B@@@AB@@B@@BB@@@@AB@@@@@B@BB@@B@B@B@BB@@BBB@@B@B@@@B@@@B@BAB@@AD@@@@BB@@@@A@B@@@B@D@@@B@@B@@B@@A@@BA@A@A@@@@A@@AA@B@@@BB@@B@@@@ACA@A@@BA@A@@AC@@@@BA@ABA@@@AB@@A@A@@B@B@@@@@ABB@@B@@@@@@B@BAB@@@BA@C@@B@@AB@B@BAB@@A@A@@@ACA@AA@AA@@@@@AAA@A@A@@A@@A@A@AA@@@@B@@@@AA@@A@A@A@A@@@@@B@BA@B@A@@@@@AA@@@@BA@@@A@@@A@@A@@@@A@@BABA@@@@A@@A@@@@A@AD@@A@@@@@@A@A@A@@@@A@@@@A@@@@AA@A@@@@@@A@@@AA@A@@@DA@@AACA@@A@@@@@@@@A@@@AA@AAB@@@@@@A@@@@A@@@@@D@BAD@B@@@BBB@@@@@@A@ABA@C@@@@AA@@B@@AB@B@@AB@@AB@B@B@@@BA@@@A@A@@@A@A@@@A@@@@BA@A@A@@AC@A@AB@BA@@@@@@@B@@B@@@@@@A@C@@@@@A@@@ABA@@B@@@@@@B@B@@B@@A@A@@@@D@@@@@BBBADB@@@@@@@@B@@@@@B@AABA@A@@A@@@BABB@@@BB@B@@B@@@@@A@A@@@@@A@@B@B@@@BC@@@@DA@@@A@A@@@@B@BAB@BA@@B@@@BA@@B@@@BB@@@BBA@@BAB@@ABA@A@AAA@@BAB@B@@AAA@@DB@@@A@@@A@A@@BA@@@A@A@@A@A@@@@A@@@@CAAA@@@@@A@@@@@@@@@A@A@@@A@@@@@A@A@@@A@@B@B@B@@@B@@@@AB@B@B@@@@@@A@A@@@@BA@@@A@@@@@@@AB@AA@@@A@@@A@@B@@AB@@@@A@@@AAAB@@ABA@@@A@@@@@A@@BA@AA@@@BA@@@@BAAABAAA@@@@BA@@AA@@@A@@CA@@BC@@B@@AB@AA@@BA@A@@B@@@B@@ABA@AB@@@@@B@@@BB@@@AB@@@BABB@AB@BB@@BBB@BBB@B@@@@A@CAA@AB@@ABBB@@A@@B@@AB@BA@@B@@@@@@@BA@A@@BABBB@B@DCB@B@B@@@@@@@BC@@@@@A@ABA@AB@B@@@@@@@BA@AB@@@B@B@@@D@@B@@BB@@@@B@B@@@B@@@@@B@@@@@@B@@B@@@B@@@B@@B@@BB@@BBBABBBB@@BA@@BD@@@@B@@AB@@@BA@@@A@@@@@AB@@A@@@@B@@A@@@A@@B@@@@AB@@@@@AC@@BA@@AA@@@A@A@A@@@@BA@@@A@@DAB@B@DBB@@@B@@@D@@AB@@@B@@B@@DA@AB@@@BC@@@@B@@AB@@@BA@@@@@@B@@A@@BAB@@A@@@@@@B@@A@@@@@ABA@A@AD@B@@ABA@@@@B@@@@BB@@@@@BB@A@@B@@ABA@A@@@A@A@@@A@@B@B@BABAD@@CBC@A@A@@@@@@@AA@AAAA@CA@AA@@AA@@@AA@A@CB@@A@AA@@A@@@@C@@A@@@@@BABCBAB@@A@@@A@@BAB@@AAC@AB@@AB@@CB@DEFCBAB@@@BADC@@@A@C@ABABA@@BAD@D@BA@@@@BABCB@@A@A@A@@B@B@B@@@@@@@B@@@@@BB@BBB@@@@AB@@@B@@B@B@B@@@B@@@BAB@BABA@@@@DABADA@@BB@ABA@@@AA@BAA@@A@@CA@@@A@C@C@A@A@A@AAA@@AAA@@@@AAA@AA@@AAA@@A@@@A@AAA@@@A@@@A@ABA@A@A@@@@A@@B@BA@@@AB@B@@AA@@@A@@@@@@A@A@AA@@@B@@AA@@A@@A@@A@@@@@AB@@@B@A@@@@AA@@@@A@@@AA@@@A@@@AAACCE@@@@@A@@B@B@@@@@@@DAB@@@@A@A@@@@B@B@B@@@B@@@@@B@@C@@@A@@A@@@A@A@@@A@@@@@B@@@AA@@BAAABA@AA@@@AAA@@AA@A@@@A@@@@B@@AA@B@@A@@@@A@AA@A@AA@@B@@AD@@@@@@AA@@@A@@@A@A@@@AA@@@@AA@A@@B@@@@B@@B@@@B@DA@@CA@@A@@AA@A@@A@AA@@@@AB@@@@@B@B@@B@@B@@A@@BA@@@A@@@A@@B@@@@@@@@@B@@@B@B@@@@@BA@@A@A@A@A@@@A@@@@@B@@AB@@@@@B@@ABA@@@AA@BA@@@@B@@A@@@AA@A@@A@C@AAA@@@A@@@AA@@A@@A@@@@D@B@@@B@@A@@@CAA@@A@A@@@A@AB@@@@AA@@ACC@@@A@@@@@AB@B@BAB@BA@ABA@@BAB@BA@@@@@A@@BA@@@A@@B@@ABA@AB@BA@@BAB@@@BC@@@@BAB@@@D@@@BABA@@@@@A@@@AB@@A@@DA@@B@@@B@@A@A@@BA@@B@B@@@@@@A@A@@@@BAB@@@BA@@@@BA@AB@@@BAB@@AB@@@BB@AB@@@@@C@@AB@@@@A@@@A@@@AAAAA@@@A@BD@@@B@B@BA@@@@@A@AB@@@B@B@@@@A@@AAB@@ABA@@@A@ABAB@@A@@@@@@A@ABA@@@@A@C@@B@@A@A@A@@CAA@@@@AB@@@@@CA@@AA@A@A@@@@B@@@D@@AB@B@@@@AB@@@@AA@@@CBC@A@@A@A@@@A@@@A@@@ABA@A@@@@BA@@@A@@@A@@AA@@B@B@@ABB@@B@BBB@B@@@B@@@@@A@@A@A@@AC@AB@@@@BB@@@@AB@@@BC@@@@BABA@@B@D@B@@@B@BA@@AA@@A@@A@@@A@A@@@ABA@@@@B@@@@@AA@A@@@ABA@@@@BBBB@@B@@A@@@@@AB@AA@@@@AA@AA@@@@AAA@@@@AA@@@@@A@@C@A@A@@AAA@@A@A@AA@@A@@A@AB@@@@@@A@A@A@@@@@@@@@A@@@A@@@A@@@@@A@@B@@@@@BAA@A@@@A@@@A@@AB@@ABA@AA@@@A@@B@@A@@@@@@AA@@@@@@ABC@AAAA@AA@@A@@AAA@@@@@A@A@AAAAC@@@@AB@@A@@@@@AB@@@AA@A@@@A@@AAAA@AA@AA@@@AA@@@A@@A@A@A@@@A@@@AA@@AA@@@A@AA@A@@AAA@A@@@AAA@@A@@A@A@AAC@@@A@@BA@@B@@@@@B@@@BA@A@@@@@A@A@@BA@AA@@@@AAA@@CACAA@A@@@@AB@@@B@@@@A@@B@B@B@@A@@@@DAB@B@B@@A@@B@@@@@B@B@@@@@B@@@BAB@B@"],encodeOffsets:[[117822,26822]]}},{type:"Feature",id:"360881",properties:{name:"井冈山市",cp:[114.289228,26.748081],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@A@A@C@C@CB@@AA@@A@@@ABA@@@A@@@CD@@CB@@AB@BABAD@@@B@BABABCB@BA@BB@@BAB@DB@@BB@@@BBB@@@B@B@B@@A@AB@@@B@B@DAB@@ABABA@AB@F@BA@@B@B@BAB@B@BA@ABE@A@@BC@AAA@@A@@@@A@@@AB@@@B@@ABABA@@@EA@@A@A@A@CBCAC@A@@AA@C@AAABC@A@AAC@AAC@AAA@AAC@A@CBA@A@AAC@A@EC@@A@@@ECCAACA@AA@@A@A@AA@AC@@@@B@D@FBDADCBAB@BBBBD@B@@@D@B@DBBBDABADADBBDFBD@FAFBD@DDDDDDDBD@D@DBBHJPN@FC@KBG@CAGE@@ABA@AAAACAA@EBCBA@C@C@AAA@AB@B@D@DAD@BD@DDDDDDBF@@DFDL@H@HBHDH@FADBBDDBDBDBBBDBBBDBBBD@BAD@F@BDDBBBDDDDDDFBD@D@DBDBD@D@@@@@B@@A@@@@@@@@@@@@B@@@@@@@B@B@@CBGAEBGBGAEBG@GACCCD@@E@AAE@IFGDK@A@K@KAABCBEDGDGBI@GBEBCF@FBDBDBDCDE@ED@@CFEDIFCD@@CDE@C@CAA@C@C@G@CBC@ACEAADCDE@E@CDCAEC@@CACAE@C@E@GAE@G@GBC@ABA@EB@@E@EAA@CAE@KDEFAFAHADADCDGBCBEBC@CDAF@F@@AJAHAHCB@@CBCACACAGAADCDCHCH@DBH@J@F@HAFBDBF@D@F@F@DALEFAD@@@DAD@D@F@FAFAD@HBDBFAHABAB@FAF@FBDBF@DADADADBFBFADADCDAFADAFALGBB@BDBBBBBB@@BBBBBB@@BBHFBD@BBB@@@BB@@@D@BB@@BBBB@@@@B@@@B@B@@@BA@ADCBABA@ABA@A@A@ABADABA@@B@DBDBDBB@@ABAB@@BBBB@B@D@@@DADA@@D@@@DAB@@@DBBDB@BBB@D@B@FBB@DBBBFBB@B@DB@@B@@B@B@@@BB@B@B@B@BAB@BABAB@@A@A@A@@@AB@B@@ABA@A@ABAB@DABABA@ABC@A@@BA@@BCBC@@@BB@B@B@B@DABC@@DAB@@@@@@A@@@AB@@AB@DAB@@@B@@@BB@BB@@@B@B@DBB@@@@@DDBBBB@BDB@BB@@@@@B@BA@@HCBAD@BAB@B@B@BBBBBBBD@@B@@ABA@ABA@ADA@@BA@@@@@@AC@C@A@@B@@@B@B@B@@AB@@@BBB@B@D@@@BBD@@@@@BB@@BBB@@@HDB@BB@@B@@@B@BB@@@BBB@@@B@B@BBB@BBBBD@@@@B@BAF@B@@@B@DD@@B@@@DA@AD@B@B@BB@@@@BB@@BB@@B@BB@@B@BB@@BB@B@B@BAF@B@@ABAB@B@BBB@B@@@BABB@@@B@BD@B@@BBB@B@B@@@@A@@@@@AB@@A@@A@@A@@@A@A@C@@B@@@@AB@@C@@BA@@D@@@@@BA@@@@@A@AB@@@B@BB@@BBD@DAD@B@B@@AB@@ABC@A@AB@@@@@@@BCBAB@D@BBB@B@B@FA@@B@@@@BBB@BBBDB@@B@D@BAB@B@@@BAB@@@DAB@B@BA@@@@BA@ABA@AD@BA@@@AB@BA@@@@@A@@B@@@B@@A@@@@BA@@@@B@@@B@@B@@B@B@@@B@BA@@B@@@BA@@BAB@@@@A@@B@@@B@@@BA@@@@@@BABABA@@@@BA@@@@@A@@@A@@A@AA@@A@@@BA@A@@@AAC@A@@@A@@B@B@@@@@BABA@@@@@@@@BA@@B@@A@@B@@@B@@A@@@@@@@@B@B@@@@@@@BA@BB@DA@@BA@@@ABA@@BAB@@AB@@@B@B@@@@@@@D@@@FC@@B@@AB@@@@@@ADA@@BABA@ADA@@B@@@B@@@B@@@@BB@@@@@B@@@@@B@B@@A@@DA@A@@B@@@@BBB@@@@B@@B@@DB@BB@@@D@@@@@B@@ADABC@@@ABABAB@@AA@A@@@@@AA@@@@@@A@A@A@@@@@AA@@@@@@@A@@B@@@@A@@@A@@B@@@@AA@@@@A@@B@@@B@@@B@@@@@@@@AB@@@@@B@@@@AB@@@@@B@@@@@B@BCBADCBADA@@BA@AD@BADA@@D@B@B@@@BAB@D@BB@@BB@DB@@BDFBBBBDDBB@@@B@B@@B@B@BBB@@@B@@@DBBBDB@@@B@@AB@D@D@BBB@@ADA@@B@B@@AB@D@@ABABAB@@@B@B@@DDB@@B@@@DBB@B@BDB@BB@@BD@DBB@FB@@B@BB@BBB@DBB@@D@B@JBB@B@DBB@B@B@@@DAB@@@DBB@@@@@@AB@@A@@BAB@B@DBD@BBB@@@@@BABCBCDCBADABA@@B@DBBD@@@B@@@B@@B@D@B@B@B@B@BB@@DD@DB@@BDBBBB@@B@BB@@BB@BBB@F@B@DA@@BAB@DBH@BBB@FBB@DBB@B@@BD@D@DBB@B@FD@@B@@@B@JDB@BBD@D@D@BBB@@B@AB@B@@@BAB@@A@ABC@C@@@AA@AA@@BAB@@ABA@A@AA@@AAA@@AC@A@A@C@A@@CA@@@ABA@@B@@E@@@@B@@@@A@A@@AC@A@@@AA@@@@AACCA@A@@@C@A@A@E@A@AAAACA@@A@B@@A@@@A@@AA@@A@@A@@@@AB@@@BABA@@@AAB@A@@@A@@@A@@BAAA@@@@AAA@@@@@@A@@DAB@@@@AA@@@@@BA@@B@@@AA@@AABA@@@A@@@A@@CAA@@BA@AAAAA@EAA@A@A@A@A@@AC@AAC@AA@A@@A@A@@@AA@@@ABA@ABA@AB@@AB@@@@AAA@@@A@@@@@A@@@C@A@@@@@AACA@@A@AA@A@@@AA@@AAAACAAA@A@C@AACA@AA@@@A@A@@BA@@AA@@@@@@@AAAA@AA@@AB@B@@CB@BA@@@A@@@A@@B@B@@@B@@@@AB@@A@A@ABAAA@C@@@A@@@AAA@A@AACAAACAAA@@@@@@@A@A@C@A@AAAA@@@AAA@A@@@AA@@ACA@@ABA@A@@@BB@BAB@BA@@B@@@@@@A@@AABECC@@@@A@A@AB@AA@A@@A@@AA@A@ACE@@@@BA@@ACACACAA@ACA@A@@BA@ADEBABADA@A@C@@DA@ADC@C@@B@BAB@@@@A@ABA@AB@@ABA@AB@@AB@@@BCDA@A@@BC@A@A@@BA@A@AB@@A@C@A@@@A@@AAAA@ACAA@@A@@BA@ABABA@@@@@AAAA@@AA@BA@A@@@@A@@@@@ACAA@@A@@@A@AA@@AA@AA@@C@@A@@AA@E@A@@@@@A@@@@@A@@@C@AACACAA@CAA@A@CAA@A@@@A@CC@C@@CC@@@@@@A@@B@@@@@AAA@AAAA@AA@@A@@@@AA@AAA@AAA@AAA@AB@@A@AA@@@@AA@@AAA@@@CBAAA@CAAAAC@@@A@@ACAE@@B@@@B@@A@@@AAA@E@E@C@@@@B@B@@AB@DADA@BB@@BB@@@B@FB@A@@B@DA@@F@B@DABAB@B@BAB@@@B@FCB@@AB@BAD@@AD@DAB@B@DA@@BAB@@@@A@A@@@A@A@@DEB@B@@@B@DBB@B@B@B@DA@@B@BA@@BA@A@A@ABABADC@AB@@@@@FB@@BC@@BA@@@A@@B@BAD@@@@@@AA@@@A@@@@ABEBC@A@@BABA@@DBB@BA@@B@D@B@B@B@@A@@@A@E@A@@A@@@AB@@ACA@@A@@@@@@@@@A@@@@CA@@@A@CA@AA@AA@CAAAAA@BA@@@C@CAAAAB@@@@C@AAA@A@AACA@@@@@A@@DCB@@A@ABA@EB@@AB@@A@A@A@@AAA@@@G@A@@@AAA@AAC@CA@@@@A@@@BC@AAACC@@BA@ABA@A@@A@A@ABEB@@C@CBA@C@C@C@@@AB@@@@@@@A@@@A@C@@BA@@B@BAB@BA@ABA@C@C@A@A@A@@@A@@@@B@BB@@B@B@@@@@B@B@FBFABA@@B@@@@@B@@@DB@@DBB@@@B@@@BABAB@@@B@@@BBB@B@@@B@DABADA@ABA@@BABA@A@A@@B@DAB@B@@AB@BBB@BAD@@@BA@ABABA@@@ABC@@@CAC@A@@@AA@CA@@A@AAA@CCAAAEAAAA@@A@AA@A@@AB@@AB@@@@A@@A@@@A@@AA@ACA@@A@@@AB@@@@A@AB@@CCAC@A@@@A@@@A@@A@ABA@@@@@A@A@@@@AA@AAA@AA@@@A@@@ABA@ABA@AAA@A@@A@CAC@@A@A@ACCCCA@@@A@A@ADA@ABAB@@EBA@@@@DA@@@@@A@CAA@AC@@A@A@A@@@@A@@BA@AB@@ABA@@@A@C@A@@@AB@@AA@AA@AE@EB@@A@AAA@A@CAAA@@AAA@C@AAC@A@A@A@A@@B@BABA@ABA@A@CBA@ABA@A@EBAB@@A@A@AAA@A@AA@@AC@@A@C@C@A@@BCBABA@ABA@AB@@@B@D@B@@AD@@@BCBAB@@"],encodeOffsets:[[116929,27016]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
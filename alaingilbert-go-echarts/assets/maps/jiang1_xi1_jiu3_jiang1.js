@@ -0,0 +1,4 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("九江",{type:"FeatureCollection",features:[{type:"Feature",id:"360403",properties:{name:"浔阳区",cp:[115.990301,29.727593],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BBB@@DLA@CDA@@BEDABCAEAA@A@AF@B@FAJFBH@PDD@JBNFHBDBFBDBHD@@FB@@DBB@BBFBBB@@HBBBBBDBLDHDPFFBDBHD@B`NBEDBVBHBPDFALABG@@A@@A@CB@BB@@@A@AA@AA@@B@@A@@A@B@@AA@B@@@B@@@@AB@A@@@AAA@A@@@@AB@@ABA@@@@B@@@BA@A@@B@@@@@@@AA@@A@@@A@@AA@C@@@@B@@A@@@@@@A@@@@@A@@@@@A@@@A@@@@@@@@@@@@A@@@@AB@@@@A@@@@B@@A@@B@@@@@@A@@BA@ABAB@@@B@@B@@BA@@CACA@@@A@@A@@AA@AA@@A@@AA@@@@@@@@@A@AB@@A@A@@@@@AB@@@A@@AA@@A@@AAA@@@@A@@@@@@A@@A@@@@@@@BA@@A@@@@A@@@@AA@@@A@@A@@@@@@@C@@B@@@B@@@B@B@@@@AB@B@@@BA@@D@@@@@@A@@@@@@AA@@@@@@@A@@@AAA@@@AA@@@AA@@@@B@@@@@@BB@@@@@@@@@B@@BB@@@@@B@@@B@@BB@@A@BB@@@BAB@BA@CDA@@BC@A@A@A@@@BA@@@AA@@@AAACA@A@A@@@@AA@@A@@B@@A@@@A@@@A@@A@@@@@@A@@AA@A@@@@@@A@@@A@@@@B@B@@@@AB@@A@A@@@A@@@AACA@@A@@@@@B@A@@@@@@@@@A@@AA@@@@@@@@A@@@@@@A@@@@B@@ABABCB@@A@@A@A@@@@@A@@BA@@@@AA@@AA@@CA@@@@@@@A@AAB@@@A@@@@@AB@B@@@@@@A@@@@@@@AC@@@@A@@@@A@@@A@@AA@@@@@@@A@@AB@@A@@B@@@A@@A@@AA@@A@@D@B@@CCA@@@CCCAAACAAAA@AAEEA@@@A@@@@AA@AAAAGEGG@BABA@A@A@@ABC@@MCA@@@@A@@@@BA@@BA@@@A@A@AB@@@B@@@BA@@BA@ABB@A@A@@@@@A@@@@A@@A@@A@A@A@@G@KAADCFAB@B@D@DA@GG@CQ@CACEMACA@ACACEKCGAAAEAG@@MESGUE@E@A@KOB@J@D@B@BBB@DBDDFULFTIRCHJ@JDHFHFFH@BHFBFAFAHCD@FNDJFB@ABC@ABBFHCBDCHDFC@C@A@AB@B@B@B@B@BDBBBD@D@@B"],encodeOffsets:[[118742,30411]]}},{type:"Feature",id:"360402",properties:{name:"濂溪区",cp:[115.992842,29.668064],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAB@@@@@@AB@@@@A@A@@@AAA@AAA@A@C@ABABA@CAABC@AA@@CBA@AB@@@AA@@A@A@@@A@@A@A@@@A@@@A@A@AAA@@@@@@@@@BB@BB@@B@@B@B@@@B@@@@@A@A@@@@B@@@@@@A@@AA@@@@@@@A@@@A@AE@@AA@@A@@@BAA@@@A@AB@@A@A@@@BD@@@B@B@B@@@@@DB@@@B@BD@@AB@B@@DB@BB@D@B@B@@BB@DB@@FBG@C@@@@BABA@@@@@A@@@ABBBADB@@BA@@@@@AB@B@@A@@BA@@B@@@@@@@@@BB@@@@BA@@B@BB@@@@B@BB@BB@@BA@@@@@@BB@@@@@B@@D@@@@@@@@@B@@@@AB@@@@@@B@B@@AB@BA@BB@B@BB@@BAB@@BBAB@B@DB@@BBD@@@B@@@B@@@B@@C@@@@@@B@@BBA@@@@BB@A@@@BB@@A@@B@B@@@@@@@@B@@@@BB@@@@A@@AA@A@@BB@BB@@@@@AA@@@A@@B@@BBB@@A@@@@@@B@B@@B@@B@@@BBABBB@D@DB@@BA@@@@B@@AB@@@@@DBAB@@AB@@B@@@D@@@@B@@@B@@@BB@@@@@@B@@@@@@@@BBA@@BB@@@@@B@@BDAB@@@@@@@@@@@B@@@@@@@B@@ABB@A@@@@@BB@B@@C@A@@@@CCB@AAA@@AA@@AA@CAB@D@@@@@B@BBB@@A@@AAB@BBBB@BBAAACC@@BBB@@AB@BBB@B@BA@A@AJ@D@AB@DABBBBBD@@BBFA@AAG@@BA@C@@@C@@@A@@B@B@B@@@@A@@B@@@@@@B@@BB@@@BBB@@@@BA@@@@@@BB@@@@A@@@@BA@@@@B@@@@@A@@@@A@B@@A@AA@@@A@@B@@@@B@@B@B@@@B@@B@@@@B@@@@@@@@@@@@@B@@@@A@@@B@@@BA@A@@@@B@@BB@B@@@B@@B@@@@@@@@B@D@DAB@BA@@BAB@@@@AB@@A@A@ABA@AB@@ABA@AB@@ABA@B@@B@@BB@@B@@B@@BB@@B@@B@@BB@@BB@@@BB@@@@BA@@@A@@BA@@@ABA@BB@@B@@BB@@B@@B@@BB@@B@@@@B@@B@@B@@B@@B@@B@@@BB@@@@B@@B@@@@B@@@@A@@@AB@@@@A@@@@BA@@@@@@@AB@@A@@@@B@@@@A@@B@@@@@B@@@@@B@@@@@B@@@@@BA@@@@@@BA@@@@@@@AB@@@@A@@@@BA@@@@@A@@@@B@AA@@@@A@@A@@@@A@@@@@@A@@@@@A@@B@@@@A@@@@@@@A@@@@@@B@@A@@@@B@@B@@@@@@@B@@@B@@@@@@@@@@B@@@@@BA@@@@B@@@B@@@@@@@B@@@@AB@@@@A@@B@@A@@B@@@@A@@B@@A@@AA@@@A@@@A@@@A@@@@@@BB@@B@@@B@@A@@BA@@B@@A@@@@B@B@@@B@@@B@@@B@@@B@@@B@BBAB@@AB@@B@@AB@@@B@BBB@B@@BB@@B@B@@@B@B@B@D@@A@@B@BAB@BBA@@BABCB@BA@@B@B@BBB@@@B@@B@@B@@BB@@ABADAFADAD@B@DBDDBBBBBBBBB@DBB@BBB@BBB@BBDB@BB@DDB@BBBD@BBBBDBBBB@BB@@@@BDBBDHFJDF@B@@T@B@BI@E@ABEB@B@FBDBBAFC@AB@DCB@CK@@AA@A@AC@C@AACA@A@A@A@A@ABAB@D@D@CEDGACGDAEBAD@BAA@IEMC@EDCBGBEAEGE@AEGGEGEICI@DGJQESVKCEAC@CAA@A@A@C@IPA@L@B@FVFTHNF@@BHBFBBDHFLBDBDB@BDFNBD@DDRH@@HCBC@A@A@EBCDBB@L@HB@B@B@@@@BB@@@@@@B@@@@@B@BAA@BAB@@AB@@A@@@A@@B@B@B@@AB@@AB@@@@@B@@B@ND@@AD@BB@B@B@BA@AHHHFBBBBB@@B@@B@@@B@FFBBB@BBDBBBDBDD@@B@DD@@@A@CB@@@BB@@@BB@@@A@@@@BA@@BB@@@@@@@B@@BB@@@B@@@@@@B@@D@@B@@@@@@@B@@@@A@A@@B@@@@@B@@BA@B@B@@@@@@DB@@BB@@BB@@@@AB@@@B@@@@@B@BB@@@DABABA@@@A@@B@@@@@@@@B@@@@@@B@@BB@@@@@@@@@B@A@@@@@B@@@DBBB@@B@@@B@B@@@BA@@@@@A@A@@B@@@B@@@@@@@@BBB@@@B@@@@B@@@@B@@@B@@@BA@@@@BB@@B@@B@B@B@BDBB@@B@@B@@AB@@B@B@B@D@@AB@DCB@@ABA@A@@AAB@@@AA@@@A@@@A@@@@AA@@@A@@@@@@@@AA@@@@@@@A@@B@@B@@BB@@B@BB@@B@@@@@@@B@@B@@@@B@@@@@@@@CB@@A@@@ABA@@@@@A@A@@@A@@@AD@@@@@@@B@@@@B@@BB@@@@@B@@B@@@AB@@@@@@B@@@@B@@@@B@@@@@BB@BB@@@BB@@@B@@BA@@@@B@B@@@BAB@@@@@@@@@B@@BB@@@BBB@@BB@@@@B@@DBDB@@AB@@@AA@@@A@AB@BAB@@@B@@@@A@@@@BA@@@@@@B@@A@@B@@B@@@@@@@@@@@@@@B@@@B@@@@@B@@@@@B@@@@B@@@@A@@D@B@@BB@@@B@@@BB@@@@@@A@@@@BAB@@A@@@@@AB@BA@@B@@B@B@BB@@B@A@@B@@A@@@A@B@@BA@B@@@@BA@@@BBB@@B@B@@AAA@@D@BB@@@AHF@V@bBJ@^@B@H@LBLBD@@BB@@@B@jEXCTA`C\\AJ@B@@A@@CAACCAA@CAEEEAACEAA@AAAAA@AACE@ACGACAC@AAA@AAA@AAAAEAAAC@CEIACAG@@AA@ACEAECKAAACACAAAC@AAAACCGA@AEACEECCAACECCAC@ACEACACAA@CAC@AA@AE@CAC@AAAAGAA@AACACAA@AAAAAAC@AACAAACACCCAA@ACAACAA@AEEAA@AAC@ACG@CAGAG@E@CBGBGBA@CBE@ABCBABC@ABCBA@@BC@CBA@A@A@ABG@G@G@C@C@A@CS@_CAA@@@@CA@AAB@@A@A@A@@@A@AB@@@@A@ABC@CAA@A@@@@AA@A@A@@BA@C@A@@B@@A@CB@C@C@@@@@@A@@@@@A@A@@@@@@@AB@@@@@@@@@@AA@@AB@@@@A@A@CB@@A@A@A@A@A@C@A@A@A@A@@@A@A@A@@@A@@A@@A@@@@@@AABA@AB@@AB@BAB@@@@@B@B@@@BA@@@@@@A@@A@AAA@@B@B@B@@A@@@AAAA@@A@A@@@ABAAA@@BA@A@@@A@@BA@@@ABA@A@CBA@@@ABA@CBA@A@CDAB@@AB@B@@@@ABABB@@@@B@@@@CF@@DHBHDBCHJDHHCBCD@DHDDBDD@BBBDB@DDBBDFJ@FDBD@@B@BAB@BA@C@ABABAAAAACA@C@A@C@C@@B@FAB@DD@B@FAFADBABADABAB@BFBB@D@DBD@@BABAFBB@BDFC@C@CB@DBBI@EAED@BABD@@BA@@BBB@BB@D@HBD@B@D@BA@ABGBA@C@ADD@JADAD@B@@@B@@CBADAD@DBBGCEACAEAAEE@C@IHKFEAGCIFAAEAAAA@@AA@@ACCBGEGCA@CA@@@CE@BA@@@AAA@CCAAC@A@A@@AA@@C@AD@BABAAA@AB@BA@A@@AAACCAC@GCAAD@F@DAACDAECIBIBC@CFEBCB@ADA@CA@CBC@ABCEKACBA@BCB@BA@AA@@@A@@A@@DAF@BCBC@@BE@@@AAAABCADABCAAK@A@AB@@@B@BA@BBABAAEB@@@ABAAAABAB@@@ADCA@@@A@ADA@@@DDA@@BA@@@A@@@AAA@@BAA@@BA@AA@B@@AA@BA@@AA@ABA@A@A@AB@@@@@@@BD@@@A@AB@@@A@@@BAA@B@@@B@@@DA@@@AA@@AAAB@@AA@FCBE@AAEEECCEAEAEAAACCEGACEIBA@CAAA@C@AA@CC@CB@B@@AB@@C@CFABABCDCBC@@@AA@AB@B@BAB@@@@ABAAAACAC@AAC@A@AD@@ADAB@DCBAD@HEBABA@K"],encodeOffsets:[[118694,30216]]}},{type:"Feature",id:"360421",properties:{name:"九江县",cp:[115.911323,29.608431],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@ECGEEE@AAA@ACAAA@AECCAA@A@A@A@I@[B_DSBWDiFA@@@A@@AC@KAKAG@A@]@I@aAU@E@KBEBOCGAUACAAFBBHF^\\@@PNDBTPTNTNFBVJB@HDTHPLBJ@R@V@VAHCJCTMNONUFGBCDAHK@@DABCH@HADB@A@AA@GEGCEE"],["@@@@AA@@@@A@@@AB@@C@@@@@@AA@@B@@@AA@A@AA@A@@@@A@@A@@@@A@CC@AA@@@@@B@@AB@@@@@@AA@@@BA@@A@@@@A@@@AA@@@A@A@A@@@AA@@@A@@B@@@@@@@AA@@@AB@@A@@@AAA@AB@@@@@BB@@@@@BBA@@@B@@@@@@@@B@B@@@AA@A@@@@@A@@@@@@@AAA@A@CB@@A@@BA@A@@@A@@A@ABA@A@@A@@@@@@@A@@@@@@@@@@@@AAA@@@@AA@@A@@A@@@@@@@A@@@@@@A@@@BAB@BA@A@@@@@A@@AB@@A@@@@B@@@AAE@@@A@A@@@@A@@A@@A@@@@@A@@@@D@B@@A@@@A@@@@BA@@@@@A@A@@A@@@@BA@AA@@@@@@BAB@A@@@A@A@@@@A@@@AB@@@@AA@A@A@CB@@A@@AA@@@@@@@@@A@@@@A@@BAA@@@A@AA@@AA@@A@@A@@B@BA@@@@@@@@AA@@A@@@AA@@B@@A@@@@AA@@@@@AA@A@@@AA@@@@@@C@A@AA@@AA@ABABC@@A@A@@@@@@A@@A@@ABA@@@A@AB@@A@@B@B@@@@A@A@@@AAA@@A@@@BA@@@A@@@@@@A@@@A@@A@@@@@@@A@@@A@@B@@@@@@@BB@@B@@@B@BA@@A@@A@@@@BAD@@@@B@@@@AB@@A@@@@@B@@@@@B@@@@BA@@@@@B@@@@C@@@@@AB@@@@AB@@@@A@@@A@AB@@@@@@A@@BA@A@@@AA@@@@@@@B@@A@@B@@@B@@A@@@AB@@@A@@@@@BA@@@@@@A@@@AAB@@@A@A@AA@@@@@@@@@A@@@@B@BBD@B@BAB@@@@@AA@@AA@@@A@@@@B@@@A@AA@@@@@A@@@@@@@AA@@AB@@@AA@@@A@@B@BA@@A@A@@@@@@AA@@@@@@@@@BABA@@@@@A@@@A@A@@BA@@A@@@BA@AAA@A@@AA@@@AA@@AA@@@A@@@A@@B@@@B@@A@@@A@@AA@A@@A@@@@A@@AA@AA@@A@@@@@@@A@A@@@BB@@@@A@@@AB@@A@A@@@@A@B@@@@C@AB@@A@@@@@ADA@@@@@A@@@@B@@@@A@@@A@@BA@AB@BAB@BA@AB@BA@BB@B@@@B@@@@@BB@@@@@B@@B@@@B@B@@@@@A@@AC@@@@AB@B@@A@A@@B@B@@A@ABA@@BA@@BB@@B@B@@@@@B@@@@@B@@@B@B@BA@AB@@ADA@@@AB@@ADAB@@@@CA@@A@A@@BBB@@A@@@A@@@@@@@@@@@@AA@@@A@BA@A@@A@@AA@A@BDAF@BA@@@AB@BA@@B@B@@A@@@CBCB@@@BAD@B@@AB@@AB@AA@@@@@A@@@BB@@@BABA@@B@D@@@B@@@@A@@@@A@@@@@@ABBB@@BDBB@B@B@B@BB@@@@@@@@B@@@DA@@@@@AB@@@@@B@@@@@@AB@B@@ABBB@@B@BB@@@@BB@@ABA@AB@@@@@BA@@B@@B@@@D@@@@@@@@B@@A@@B@B@BB@@B@@AB@@@@A@@BB@B@@@BB@@@@BB@@@BA@@@AA@@@@@B@@@@@@A@A@@@@B@B@@@@@@@@@@AC@@@@A@@@@@@@@B@BCA@@@BABBBA@@B@@A@@@@B@BA@AB@BA@A@@@@BA@@@@@AB@@@@A@A@@@A@@@CB@@A@@@@@@@AB@@@@@@AB@@@BA@@@@B@B@@@@AA@@AD@A@BA@@@AA@@@D@B@@@@A@@@A@@@@B@@A@@@AA@@@@AB@@C@A@@B@BB@@B@@BB@@A@@@@@ABB@@@BB@@BBDB@@B@@@@B@@@B@BBB@@BBA@@@@@A@@@AB@@@@B@@B@@@@A@@@@@@B@B@@A@A@@@BB@B@@A@@@@@@AA@@@@@@F@B@@@@B@@B@@BA@B@@@@@BA@@@@BB@@@C@@@@@@@AB@@@@A@@@@@@B@@@@@@@@B@@@@@@@A@@@@@AA@@@@@AAB@@@B@@@B@@A@@B@B@B@@A@A@@@@@@BAA@@@@@@AB@@A@@@AA@@@BAB@@BB@@@@BA@@@B@B@@@@BBBAB@B@B@@@@@@@@B@@@B@@@@A@@A@@@@@@AA@@CBA@A@@AA@@@@@@B@@CBABADAB@@@B@BA@@A@@@A@@A@@@BB@B@@A@@@A@AA@B@@B@A@@@@@@B@@@D@@@@A@A@@@@@@A@A@@@@A@@@@@BA@@AA@@@@@A@@@@B@@@@@@@@C@A@@@@@@AB@@@B@@A@@@@@@DA@@@A@@@@B@@AB@@A@ABA@@BA@AD@@AD@@C@@@BB@@@B@B@@@@A@A@@@A@@@@B@B@@@@@A@@@AA@@@C@@@C@@@CC@@A@@BA@A@@B@@AB@@AB@B@@@B@B@@@B@@@@AB@@@@@BCB@@@@BB@@AB@B@@BB@@B@B@@@@B@@@@A@@@@@@B@@A@@@@@@@@@DB@@@A@@@@@B@@@@BB@@B@@@B@@A@@@@BA@@@BAB@@@B@@@@@@@@@B@@@@@@@B@@A@@B@@@B@@@D@@@BCBA@@BAB@DCD@@@B@BA@A@A@A@C@@@EAA@@@A@@@ABG@A@@@EBA@A@ABA@A@C@A@CAA@A@A@@@C@A@@@@@@@@BC@AAA@A@AA@@@A@D@@@BB@BB@B@B@B@@ABABA@A@@B@BA@ADA@@B@@A@@@A@@@AB@B@@@BB@@@FBB@@@BB@@@BA@@D@@A@@@@B@@@B@AA@@BA@AAABA@CDA@@BA@@@A@@@@B@@@BAD@@@BA@@BAB@@BD@B@@@BAB@@C@@B@@@B@B@@@B@B@B@BA@A@CB@@AB@B@BA@@BA@@B@BABA@@@ADA@@@A@@@@BA@@B@@A@AB@@A@@@A@@DA@AB@B@BA@B@AB@@@@AB@BA@@B@@@@AB@@AB@@AB@@@C@@A@@@CA@@CB@@A@AB@@A@@@AB@BA@BB@@@BAB@@@BB@BB@@AB@B@@@B@B@B@@@@ABAB@@BBBB@@B@@@B@BB@@BA@@BBB@@@@@@BBB@BBBB@@@B@D@@@@@@@@C@A@@@@B@BB@AHB@@@@BCBA@@BA@@B@B@B@B@@BB@@B@@CB@@@D@@@B@@@@@@B@B@@@@@B@B@@@@@@@AD@@A@AD@@DBBB@@BA@@B@@@@BBB@@@BBB@B@@B@@@@B@@@@BB@@@@BAB@BA@@@@@C@A@ABC@@BA@@BA@@B@B@@@@@@@B@BB@@B@CBAD@@@@@B@@AB@@A@@B@@B@@@B@@A@@BB@@B@@@@@BBB@B@@@B@@@BBB@BB@@A@@BB@@BBBB@@@BBCD@@B@BB@@@@DDBA@@J@BBB@BAD@B@B@@@B@B@@@@B@B@B@BA@@BA@@@ABAD@@@B@@@BCA@@A@@@ABBB@FB@@B@@DB@@B@@BBB@BBB@B@@BBB@FA@A@@@@@AAI@A@@@@B@B@B@BB@@BB@BAB@B@B@@BBBBBB@@FBDBB@D@B@@@BA@@@A@@BA@@B@@@@B@@@B@@BBDB@BB@@@B@BD@@BBBF@@F@B@@I@A@@B@BA@@@BB@BBF@D@@BB@B@@BB@B@@@B@@@B@BAB@BA@@B@D@BB@@B@@BB@DAB@BB@@BB@@B@B@BBB@@@BBBBB@B@B@B@@@BB@D@@@BA@@@EBK@A@@B@@@@BDB@BBBBDBB@B@B@JAB@D@D@@@@ABABA@@@@BB@@@B@DADAFAD@B@@@BFFBB@@@B@@EBA@@@KGAAA@EEAA@@A@AA@@AAC@A@C@CAE@A@@@C@A@@@C@@AABA@@B@@@@C@GAE@@@A@@@A@C@AA@@AB@@A@AAA@AAC@@@@@A@CB@@AB@DAB@B@@AA@BAAABA@@@A@A@@@@B@@@@A@ADA@@BA@@@@@AA@BA@@@@BA@@@AB@@@BBBB@AB@@BBBBBB@@@D@B@B@D@@BB@@BB@@@@A@@BAB@B@@BBB@@A@@BB@@DAB@@@BBDB@@@@ABA@ABA@@B@@@BB@B@@B@B@@@@C@@B@B@B@@CBCBCAC@C@@@AB@FB@BBDFBBDB@B@@@D@BB@@@BB@@BBBDBBHFDDBBDD@BBB@B@@@D@@ELADCD@@ABC@ABEBABCBEFMHA@ABABEFA@ABAB@BEF@BCBEDABAB@@@BBB@B@@@B@B@BABABCBCBABCFAB@@AD@@@BAB@BAB@@@@AB@BAB@@BD@@@@A@AB@@@@A@A@@B@B@B@D@@AB@@@B@@@BA@@@@B@B@BA@@B@B@DANF@J@JB`C`MPGf[BADCLG\\WZSDCNITIdWNGB@NIVKB@ZO@@DALCfKFApOlOF@jEB@@ACEEICGAAAC@@@@AAA@AACAAAAAC@AA@ACA@CAAA@AC@AAA@AAA@AAA@CAAAAAAAACAAC@C@ABCBCBEBCBA@@AA@@@AA@@@@A@@AA@A@A@AB@@ADABA@AB@AAA@ABA@@@@BC@A@A@A@@@A@A@@@AA@@@AAA@A@A@@BA@@@AA@@BA@AB@A@A@@@A@@@A@@@A@@@A@@@A@A@@B@@@@AB@@AB@@@@A@@@AA@@A@@@@B@@@B@@@B@@@B@@BB@@@@AB@@@@@@AB@@@@AB@@@@@BA@@@@@A@@@@@@@A@@@A@@B@@A@@@@@A@@@@@@@@A@@@A@@@@@@@A@@@@A@@B@@@@A@@@@B@@@@@@@B@@@@@@AB@@@@@B@@@@@@@@B@@B@@@@B@@B@@B@A@@B@@@@@B@@A@@B@@@@@BA@@@@@@B@@A@@@@B@@A@@@@@A@@@@@A@@@@@A@@@@@AB@@@@@@A@@B@@@BA@@@@@@B@@A@@B@@@@@BA@@B@@@@@@A@@A@@@@A@@A@@A@@@AA@@@@AA@@@@AA@@@@@@AA@@AA@@@@AA@@AA@@@AAB@BA@@B@@AB@@@B@@A@@A@@A@@AA@@AA@@@AA@@@AA@@@AA@@@AA@@@AA@B@BA@@BAB@BA@@BAB@BAB@B@@@BA@@@@BA@AB@@ABA@C@C@A@@@@@@A@@@@A@@@AAA@@@A@@B@B@@A@@@A@@@B@@A@@@@@@@@@@@@@A@@@@@@AA@@@A@A@@@@A@@A@@@@B@@BBB@@@@A@B@@B@@@@@A@@@@@AB@@@@@B@@A@@A@@@@B@@A@@A@AA@@A@@AA@@@@@@@@AB@@@@@@A@A@AB@@@@@@@A@@@A@@@AB@@@@AB@A@@@@@@@A@@A@@@@B@DA@A@@A@@@@@BAA@BA@@@@@@@A@@@@@@@@@@@A@CB@AA@@@@@A@@AB@AA@@@@@@@@@A@@@@A@@A@@@A@@@A@@C@@@A@@@BA@@BACA@@@@A@@BA@@@@@AB@@CAC@A@AAAB@A@@@AA@@@@A@A@@@@B@@@AA@AA@@@@B@@BB@@@@A@@AAA@@@BBB@@@B@@A@@A@@A@@@@@@@@@@A@AB@@@AA@@B@A@@A@@B@AA@@@A@@@@D@@@@A@@@A@@@A@@AC@AA@@C@ABAAA@@BA@AA@@A@AAAB@@ABA@@@A@A@@@@A@@B@@A@@@@@@@@@C@@@@A@@@@AA@@@@@@AB@@AAA@@A@A@@A@@A@AB@@A@@A@@A@@@@@@@@@AB@@AB@@@@ABA@@@@B@@AA@BCAABA@@B@@@@@B@BA@A@@D@H@EA@@CAA@@AA@A@C@A@@ACA@@@ABA@@ACA@@@A@@C@@@@@A@A@A@@AC@@B@B@@@BAB@@@B@AB@@B@@@BB@@BFB@@@B@@@@@@@B@@BB@@@@@@@@A@@B@B@@@@@A@@@A@A@@@@AA@@AAA@@@@@@@@B@BBB@B@@@B@@@B@B@@@@B@@@B@BB@@B@@BAB@DA@@BBD@BADBB@BABAD@B@B@BBB@BB@@B@B@@@@@BA@@@@@@BA@BBAB@@ABA@@DAB@AA@@BAB@@A@@@AAAAC@C@A@@@A@CA@A@A@@@AAA@@A@A@@@AA@AC@@BA@@AAACAA@@@A@AA@AA@@AAB@@@@A@A@AA@@A@A@@B@AA@@@@AAAA@A@@AA@@AA@@A@"]],encodeOffsets:[[[119042,30488]],[[118682,30173]]]}},{type:"Feature",id:"360423",properties:{name:"武宁县",cp:[115.092757,29.246591],childNum:1},geometry:{type:"Polygon",coordinates:["@@VAF@FBDDBF@DFFBBB@D@BABAF@D@B@DBD@BB@BB@B@BDADBD@B@@B@B@B@BA@ABCBAB@@AB@B@HAD@@ABCBCBCDCBA@@BA@@BBB@B@B@@@@@BADC@@D@BABA@ABA@@BCB@B@B@@@DD@BDBB@BAD@@AB@@@BB@BBB@BF@BA@@B@B@B@B@B@B@B@B@BBDDB@D@B@B@DBB@@@@B@BB@BBB@DABAD@F@B@B@BBBBBB@@BBB@B@DADCJEDCBA@A@CCGAA@@BABA@A@@@AA@BA@AA@@@@@A@A@C@@@AA@A@A@@AGAACACC@A@C@ABA@ADABAD@DBDBF@D@@@DANEHCDAHABAFCBAD@B@D@FB@@FAH@H@H@FBH@@@JG@@@@B@@AB@@@BABAB@B@@AB@BA@ABA@@BAD@D@B@BABA@@B@@@B@@@D@B@@B@@@@BBB@BAD@DA@C@@BAB@JE@AB@@@BA@AD@@A@@@@AA@A@@@ABAB@B@B@@@DCD@DAB@BADADA@@@@BBBB@BBD@@B@BB@B@@BD@B@@BB@B@@@B@@@@BD@@B@BB@@@@B@@BBB@@@@D@@AB@@ABB@@BAD@BABABA@@@A@@B@B@DA@@B@@@BB@B@@AB@@A@ABA@ABAB@@@BBB@BDBB@B@@@B@@@B@@@F@BABB@@B@D@BBBBF@D@@@B@B@B@@@B@@@BA@BBBB@B@B@B@@@B@@@BA@@BA@@D@@@BB@B@BBBB@B@@BB@D@B@B@B@@@DBBA@BB@@@BA@A@@@@B@B@@BB@@@B@D@B@B@B@@B@@@@@@B@@BDBB@B@@ABBB@B@@A@@@@B@D@@@BA@@@A@AB@@@DCBA@@B@D@@@@AB@@AA@@AA@@@@AAA@@AAAAA@@@@A@C@@@A@@@AAA@@A@@@AA@@AAA@@@@A@ABA@@A@@@AAAA@@@@@@A@@@@ABI@@@@@AB@@@@A@@@AAA@A@A@@B@@B@@@@B@BAB@DA@@B@@@B@@@BBBBD@D@@@B@@@DA@@@AB@BA@A@@@@@AB@@ABE@@@A@AAA@A@@AA@@AA@A@A@A@@@C@A@A@A@ABAB@@@@ABABABA@A@@@C@A@@AA@AA@AC@@@@A@A@@A@C@CA@@A@@@@@A@@@AA@@AA@@ABA@@@@B@@@@AA@@@AC@A@@@@A@A@@A@@@A@@@AB@@@AAB@@@@A@AA@@@@A@A@@B@@A@@@@@@@AB@@@D@@@@A@@@A@@@@BA@@B@@@@@D@@AB@@AB@@@@@@A@@@AB@@AB@BBB@DA@@@@B@@@@@@@DBB@@@B@B@B@@@@@BA@A@@B@@@B@@@B@@@BB@@BB@@@BB@B@B@@@@@@AB@B@@@@A@@@A@@@@@@BA@@@AAA@@A@@A@@BA@@@@@@@A@ABA@A@A@@B@@@B@@@DB@@@@BA@@B@B@@@@@@BB@@B@@BB@@BBBB@AB@@@@A@A@A@@@AB@@@@BB@@@B@@B@@BA@@@@BA@@@@B@@@@@@B@B@BB@@A@@BBDAB@@@@@@@B@@@B@BA@@B@@@BAB@@@@ABA@@B@@@BABAB@BB@@B@@@BB@AB@@@BA@@@@@AAA@@@AB@B@@AAA@A@@@A@@BABABABABA@@AABA@@@ABABABC@@@@A@@@AA@@B@@@@ABB@A@@@@B@@@@@@@@@@A@@@@@@B@@@@@@@@@BA@@@@B@@AB@@@B@@BB@@@@AAA@@@A@A@@@@C@@ACAC@A@A@@@CCA@AAAC@@A@@@@@@@@ABA@@@A@@@@@A@A@@@@@AAA@@AA@@@AB@@A@@@A@@@@@@@ABC@@BA@@@A@A@@@AB@B@@@BA@A@A@A@@@A@@B@@A@@@A@A@AB@@A@ABBBB@@BB@@BA@@B@@BB@@AB@BABA@@BB@@@BB@@ADB@AD@@@BA@ABAB@@AA@A@@BAA@@A@A@@@C@AA@@A@AAA@@@A@@A@A@A@C@ABCBA@E@@BCB@@CBA@A@AA@@C@AA@@CACBABA@A@@@@@A@@AAA@@@@ABA@@@@A@@A@@AB@@A@@@@A@A@A@A@C@@@AAAB@@@B@@A@@@AB@@AB@B@@@AA@@@@@AB@@@B@@A@A@@AA@A@@BA@A@@@@@@@A@@@A@A@A@@BAB@@@BA@A@A@ABABA@@@C@A@@AC@A@CBA@A@AB@@@B@B@BA@@@A@@@A@@@C@ABA@ADCBA@A@@@A@ABA@C@A@A@A@AAA@AAA@@@A@AA@@A@@AA@@AA@@@@A@@B@@@AAA@@@A@@@@@AA@@A@A@A@A@A@A@@B@@B@@B@@BB@@B@ABB@ABA@@BA@@@C@@@AAA@A@@BA@@@AB@@@@A@@BA@AAE@ABAA@@AAACC@@AE@@@AAA@AAA@A@@@@B@@@BA@ADAB@B@@AB@@@@@B@B@D@@@B@F@@@BA@@B@@ABBB@@@@@AA@@@@AA@ABCBA@@BCBA@A@@AA@AA@@A@AAA@@AA@A@C@@@ABA@@B@BAB@@ABA@A@A@AB@@@@A@ABA@@@AB@@@D@@@@@BAB@@A@A@C@A@A@A@A@@@E@ABC@@@ACEAA@@@ABA@@@A@@@A@AB@BAB@D@B@@@D@D@B@@@@AB@CA@AAA@@@A@@@@AAA@@AABA@@A@@B@B@DAD@@AA@@AAA@@BC@A@@@A@C@A@AAA@AAC@AAA@A@A@@@AA@@A@@CA@@@@AB@@@B@@A@ABA@AAEC@@C@A@@AA@@A@@@ABA@@AABAACBA@AAAB@@A@A@A@A@@@CA@@@CAA@AA@@@A@A@@@@DA@ADAB@@@@A@A@AAA@@@@@@A@A@AA@@A@C@A@A@A@@@AAAA@AA@@@E@C@C@@@A@@B@@@@AB@@AB@@@B@@A@ABC@C@A@E@A@@AA@@@@@AB@B@D@@AB@B@B@BBB@@@@ABEBC@C@AB@@ABABA@A@C@@@A@@@CBA@A@C@A@ABABA@A@@B@@A@@BA@A@E@I@ABA@C@A@ABCBCB@@AB@@@@A@ABABC@A@ADA@CBA@E@ABA@@@E@A@@BA@ABMBE@A@@@E@A@A@A@A@A@A@@BA@@AAAA@A@CAEAA@A@AAA@@@AB@@A@@BEF@@C@@@EACAA@AAABA@A@A@AAA@A@C@AB@@A@@@@@@AA@@AAAA@AAA@A@CAC@AAC@A@@@A@CAEA@@EBA@A@@AC@A@@@ABA@C@AA@@A@@BCBCBA@EBGAA@ABCBE@C@@AA@@AAACAAAA@A@AAAAAA@@A@CA@@CAC@A@CAC@A@A@A@@AA@C@A@A@AB@@A@ECCAAAC@@@C@A@A@CCA@CACA@@A@@BA@@@AAA@G@A@A@C@A@C@GCA@CCA@@ACAECAACAEAAACA@AC@@BA@AAA@@AA@AA@@AA@@CA@@AAAA@ACAAAA@A@CACAA@@@A@@B@A@@C@AA@@C@CBA@A@@AAACC@AA@ABA@@@A@@AAA@@C@A@AAA@AAACA@AA@@E@AAA@@AAEAAAAAAA@A@@BA@@@AA@@C@A@A@CBCB@@A@AAAAA@@@@@A@@@A@@@@A@@@@AA@@E@A@@@@AB@@AB@@A@AA@@AAA@@@C@A@A@@@A@A@@@@AAA@ACA@EEA@A@@@A@@@AAA@A@A@A@A@EACCA@A@AAA@A@ABGBA@ABA@@@@@A@@AA@AAAAA@A@CBA@C@A@@@A@CAAAC@C@CAA@@A@@AACC@CA@AA@C@@AA@@CAA@AAA@C@AAA@@@@A@A@@@A@A@AB@@AB@BABABE@@@@AAA@EAAAC@ECA@AA@@AA@AAACAAAA@@A@@@A@AA@@@AA@@@AA@@@@@BA@AB@@ABA@ABABAAAB@@@@AB@@@@@AAAA@A@EBC@@BC@A@@@C@ABABA@@AAAA@@AAA@@A@C@A@@AA@@A@AAA@E@A@@AA@AA@A@A@@AACCAAAAEE@@@@BA@@DC@@@A@A@ABA@@BC@AAACA@@AAAA@AA@@C@@@E@ABCBG@@BABCB@@ADA@@D@@AB@@@@E@A@AAAAE@@@C@@ACCCEAAACAC@A@@@AD@@@@@B@BA@ADA@@BBB@@A@A@AB@@AB@BAD@@@B@@@F@@CF@BABCD@B@B@BABCF@@@BABA@A@@@@@AF@B@B@@EDADABA@A@AB@@A@A@@AC@CB@@A@A@AA@@CAA@@@A@@@C@CBEBEBCBA@@@CAGBA@CBA@@@A@A@ABA@@B@@ABABAB@BB@AB@@A@@@CDADAB@@@B@D@@@@ABADADA@ABC@A@A@AB@@AAABA@@@@BAB@@CD@@@BABA@CB@BABAD@@A@@@ABCD@@@@@BBB@B@@AD@@A@@B@@@B@@@@A@@@A@AC@@@@@BAB@@B@@BB@@B@@@B@@ABA@A@@B@@BF@@A@ABA@@BA@@B@B@F@@@BABA@AB@@A@@@ABA@GDABABAB@BA@@D@B@@@DBBBDBD@BDDBD@B@B@@@BBF@@A@@BB@BB@BBD@D@@@B@@@DBB@B@@BD@BBBBD@DB@BBBBB@BB@@B@AD@BABABABABCBA@DD@BBBBD@B@B@B@B@B@B@DA@A@A@@BAB@@@BAD@@@B@BA@@DABAD@@@D@B@HBB@F@B@B@D@@CB@BAB@@ABABA@A@AB@B@B@D@B@B@B@DBB@D@D@B@@@BBBB@DB@B@@@B@@ABAB@@AB@BABADABADA@@BA@@B@@@B@D@B@@AB@@BB@BA@@@ABCDA@CB@BABAB@B@DA@@@A@@@C@@AABA@@@A@@@A@CA@AA@@AB@@A@@@AAA@A@A@A@G@@A@@@A@A@AAA@C@E@AAA@AA@@ABABAB@@@BB@BB@B@@@B@BB@@BAD@H@@BBAB@B@@@@BB@@@BAB@@@B@B@B@@B@BB@@@@@@BBAB@B@@ABAB@B@@B@@@B@@@@B@@@B@D@D@@@@AB@@AB@B@D@B@BAB@@@B@@DB@@@B@@ABA@@@A@@@@B@@A@@A@@A@@@@@A@@B@B@@ABABA@A@@@C@@@A@CC@@A@E@C@A@E@A@ABABABABA@AB@@A@A@GBABCD@@A@CBA@A@CB@@@@A@CBABAB@@@BAB@@ABA@ABA@@@A@@AABA@@@@BAB@@@@@@A@@@@@@@AB@B@BA@AB@@A@@AA@@A@@@BABA@@@@@A@@@@@A@@B@B@@BB@@@B@BAF@B@@@@CD@@@@AA@AA@@@@BAB@@C@@@@@BC@@@@CBABA@C@C@@AAC@@@@A@@@@B@@@BA@@@@@@@@@@A@A@@A@@@A@@B@@A@@@@@@@@@@ABA@@@A@@@@@@EAA@A@A@ABC@ED@@B@@BB@@@@@@AB@@@@@@@@B@@@DA@@@@@AAAA@A@@ABAB@@CC@@@@A@@DA@@@@BAA@@@@@AB@@AA@@@B@@@@A@@AA@@@AA@@@ADABA@AA@@ADA@@B@@BB@@@@@FB@@B@@A@A@A@A@AAA@@ACAAA@@@@@@A@@B@D@@A@@BA@@@@AIAA@A@AAA@A@A@A@@AACAA@@A@@@A@C@@@AB@@A@@@CA@AA@G@C@E@C@A@CA@@G@EAA@A@@A@A@@AAA@AAA@CBA@@@@AAA@A@@AAACAA@CA@CA@@AC@AAA@A@@AAA@@@A@EBA@ABABEFAB@@A@A@C@A@A@A@A@@@CA@@A@E@@@C@A@CBC@A@C@C@@B@@ABA@A@@@ABABC@ABA@@@A@A@A@A@A@@@@AA@@@A@A@@@A@@AA@AA@@A@C@A@A@@A@@ACAC@@AAAAA@A@A@A@@@AB@B@DAD@@@D@B@B@BA@@BA@C@ABA@CD@@A@A@A@A@A@A@A@C@A@@@ABCBA@AB@@@@@BA@ABA@ABCB@@A@A@@@A@@@@B@B@@A@ABC@AB@@@@@B@@AB@@E@AB@@AB@@@B@B@F@B@@A@@B@@EAA@A@A@@BCBA@A@@BA@@@@BB@@@D@BBDB@@B@BFDD@@AB@B@D@BBD@B@B@D@@@B@@B@BB@@B@BAB@@@BDB@BBBB@@B@@@DAB@DFD@@BB@B@@@BB@BBBD@HFD@BBF@BBD@@BB@@B@BBBBBBFBD@BBB@@BBB@@@B@@B@@DBLFDBFDB@BB@@@B@@@DAB@BABAB@BADAD@@AB@DADCD@BAB@BAB@@@BA@C@C@A@CBA@C@A@@B@@A@BB@BBB@@@F@BB@@@A@AB@BAB@@@DAD@H@@@B@@ADA@@@@F@B@B@BBBBB@@@B@@@BVJXJLDHHJFPDLDJBJBJBHDB@DDFDFF@@FFHFDDBDDDDDDBDDFDFDbJTHPFJBPBJDJ@JCLCH@H@LDHBHDR@TCHAFCJKBGAW@GDADAHAXABDFNJNLJTFNAVEFAFBHBFDHFFDBBDDFBDBDDLBNDJBNBLDJBF@HADCDE@CAEBELDB@@@@@@BB@@@@B@@B@@@@@B@B@@@B@@@BB@@B@@@@@B@BB@@B@B@@@@@@B@@@B@@BB@@@@B@@@B@@B@@@@B@@B@@@@BB@@@@@B@@B@@@@B@BB@@@B@@@@B@@@@@@@@BB@@@B@@@@A@@B@@@@@@@BBB@@B@@@@@@B@@@B@@@@@BB@@B@@@@B@@BB@B@@@@@@@BBB@@B@B@@@@@B@@@@BBB@DAF@DABAFCD@B@B@@@@B@BBBDDBB@B@@@B@B@D@D@B@BBD@B@B@@ABADA@AB@BB@@BA@@B@@@B@D@@AB@@@BB@@@B@DA@@FFFHDBDDBDBJ@@@@@@@@@@@B@@@@@@B@@@@@@A@@@@B@@@@@@@@@@@B@@@B@@@@@@B@@@BB@@@@@@@@@@B@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@AB@@@@@@@@@@AA@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@A@@@A@@@C@@@A@@@@@@BB@@@@@@@B@@@@BB@@B@@@B@@ABA@@B@@A@@B@B@@@B@@DF@@@@DF@B@B@@A@@BCFG@GA@@IAICIGCCMGCEBCBEDEFAJAFCBGCCCCKEI@KGOMCAE@ADAF@DMtEFCBE@CACAA@@B@DA@CBABBBBBFBFDHFBDDFBFDBPFBFHNBLBHDDBFBFBF@DBDFBFA@@BCBEBABA@ABAA@@AC@@@AA@AA@@A@A@@AAAA@ABA@@@AAAAA@AAA@@@@@@B@B@BBDB@AB@@@D@D@D@DB@@FBB@BBBB@BADBB@DDF@BB@@BD@B@@@BA@ABC@AAC@CAEACAE@C@A@A@@BABCB@@@@@BB@@B@D@D@B@@@BA@A@@@ABAB@D@BABABBDB@@B@FB@@DBHBFDD@FDDFBLDBFHBFADCBWDGBC@ADAD@D@PBHDBHFFN"],encodeOffsets:[[117716,30263]]}},{type:"Feature",id:"360424",properties:{name:"修水县",cp:[114.546836,29.025726],childNum:1},geometry:{type:"Polygon",coordinates:["@@BA@A@@@@@A@@AAA@CA@@A@CEAA@AAA@AEA@AAA@@AA@@BC@AAA@@@ACA@ACAAA@@@A@AB@@@@AAA@A@@BABA@AB@@A@E@@BABC@ABABA@@@@@A@@AAAA@AAA@A@AA@@BABA@A@CBC@ABGDAB@@CBGDA@A@A@C@@BA@ABCB@BABABCBAB@@A@E@AB@@A@C@@@A@@BA@A@@@@@CA@@@AAA@@AA@@AAAC@@@A@@A@AAA@A@A@AAE@EAAA@@ABA@@@AB@@AAA@C@@AA@G@A@@@A@AB@@AB@BA@ABCBEBEBCBCBABA@A@@@A@A@@AA@@A@E@A@AAA@A@@@CBA@A@AAA@@AB@@@@@BABC@CBC@CB@@A@AC@@A@@@@@A@ABABE@ABABABCBAB@BAB@B@D@@@@C@EBCB@@CBAB@BAB@DABA@@@A@C@CBABA@A@A@ABABA@CB@@ABA@AB@@@B@B@@@BBBB@@B@BAB@BA@@BABAB@DA@@D@BA@C@@@AA@@CDCBA@@@AB@@EHGF@BADA@@@A@A@A@A@AAA@AB@@ABA@A@@@ABABCDCB@BAAA@@BA@A@@@BD@@@BADAB@D@B@F@B@BA@@B@B@B@B@B@BAB@BAB@BBB@BA@@BA@@@ADABADA@AB@@@D@B@B@B@BAB@BABAB@@ABA@ABA@A@@@@@@@AA@AA@@@A@EBCB@@A@@AACCE@A@A@A@@@AA@C@A@A@A@AB@@@BA@@@@@AA@@A@@BA@@B@BA@@B@@@@ABBD@@@BA@A@@BAA@BA@@BAB@BA@@D@B@B@@BD@BBD@BA@@B@BABA@A@@@@@AB@B@@A@@DABAB@@ABA@CA@@AA@@@A@AAC@@@@@AEAECCAAAA@@A@A@A@@CCAC@@AC@A@AA@@@CAAAAACCAA@@AAAA@@G@AAA@EAAAGGAAA@CAAAAA@A@@@AA@C@AAA@AA@@@@@A@GBA@A@A@@@A@@AAA@AAA@@@A@A@A@C@@A@@AA@A@AA@@@EDC@EBEDCBCBCDBFBDBB@D@DBBDDBBFBDDBDBDBBBF@D@FBDBH@DAB@BADABBDBF@FAF@DCD@@AAACEECCAAEAC@C@C@ADABCFAFAB@FBDEDC@@@KAECCAEAA@GAEACACACAEACBC@C@A@C@C@E@C@CBEDAFCB@DAD@DABC@CAC@C@@BAB@D@D@DDB@DAD@BCDADAB@DAD@D@DBDDDBF@@DDDBDB@@BB@FAF@@ALCHEFEBCBABCBCBC@C@C@C@CAAACCEGCECEACA@@ACF@DAJAFAHADCFCDCBADC@CBCA@@A@E@@@@BAA@BAB@B@H@D@BBBBH@@BJBD@D@B@BBB@@@B@D@@@B@@@B@@@B@B@B@B@B@B@@@@@@@B@@A@@@A@@@@@@@@@A@@@@@@BIECACAC@C@E@GC@@CACACECCEGAA@@A@@@@@ABEFCB@@AB@@A@@BAHCL@DAFABAJAB@HA@AB@DAD@DAB@B@B@D@DBDFH@DDJ@B@B@BA@CDCDCFADCFGFADAD@DBH@FBF@D@B@B@@@DAFBDAD@BABEFA@@BBFDDBBDB@DFLBB@HAF@H@F@JBJ@FBDBFDFHDFDJBF@FA@@FFFJFJFJDNDBLDFBHF@@DDJHJFHB@@HBJFHFHDB@FFDF@JBJBFDDDBB@JDBBFDBD@@HLBJBF@@AJ@HBBBBJBNBNDB@LBDDF@HAF@HBFBB@J@F@FAB@D@F@B@dD@@L@LDHBNBH@DAFADAD@D@BBJHHFFDD@F@J@HBDBHDNHJHHBF@FCJCFAJBHBDBBFBH@@ARGLCNAD@BBBFBD@BB@B@P@HJLFD@DAFADOJADBH@FTPBB@@@B@DAD@DAT@BCF@DBFFDBBFFPFDBXD@D@DADED@FAHEHSFIHKNSHCHCB@F@P@HBJEF@DBB@^VNFD@ZBLBL@F@FA@@HDDBDBB@B@B@@FBD@BBBB@BBB@B@BCBADA@AB@@AA@EACACA@A@@NGBA@@BBD@D@@@B@@@AA@EAABA@CDCBAB@H@FANFVBJ@J@H@DABADCdD@@@A@@@A@@AAAA@A@A@A@E@@B@BC@@@A@@@GBC@C@@BA@ABAB@@@A@@A@E@@AA@AAAB@@@@AB@D@B@DAB@D@D@B@@A@@BA@ABA@ADCBC@CBA@@BCBC@ABABA@ABA@C@@@A@@AAA@ECCAKECA@@@AA@@@A@AA@@AA@AACAEAAAA@A@AA@@AC@AAE@AAC@GEC@AA@AAA@@A@A@@AC@CEA@CB@@A@@@AAAAA@AC@@A@ABA@@@AAA@@@@A@@@C@A@AAC@A@C@ABA@@CCAEA@@@CAAAC@@@A@@A@@B@@AB@B@DA@AB@B@B@FB@@@AB@@@@A@E@A@A@@BA@@BAF@@@BA@@@A@@@@BAD@BAB@@@@A@A@@B@@@B@B@@@DABAB@BAB@@A@@@@BAB@DABA@@B@D@B@B@B@B@B@B@B@@@DCB@BAD@B@@AB@@A@A@A@C@@BC@C@ABA@@B@B@B@B@BBBB@@BDBD@@@BB@B@D@B@@@BBB@@BB@@@B@B@@@B@@B@@B@B@B@B@B@@@B@BAD@BABA@@B@B@BA@@@AD@D@B@D@DAB@D@@@F@B@@@DB@@B@B@B@B@D@B@B@@@BAFEBABAB@FAB@@@B@BB@@@BBB@BBD@@DBB@@DBBBDBB@@@BBB@B@@B@DAB@BBB@BB@@@B@BB@B@FBH@@@DBB@D@F@D@H@B@@BDB@@B@@@BA@@D@B@@@B@@@BBBD@BB@B@B@B@BBB@B@JB@B@@B@@AB@@@@C@AB@@@@@@@BBDB@BB@BBB@B@B@B@@@@AA@@E@@@@AA@@@AB@BC@@BBB@BABC@@B@@B@@BB@@@B@@A@@@B@@BA@@B@@@@BB@A@@B@@CB@@@@@DD@@BABA@@@BBBBB@@@@B@@C@@@A@@@@@@A@@B@@@@A@@AA@@@FCD@BAB@B@B@FB@@@@@@@B@@AB@B@@@@@@@@B@@@@AB@@@B@@@@B@B@@@@@@@@B@@A@@@A@@B@@@@@BD@BD@D@B@BADA@@@@AD@@@@D@@@BA@A@@B@@BBB@@@@DC@@@@@ABE@A@A@@AA@@@A@AB@@@@@B@@@@@B@BA@A@@@BB@@BB@@@BAB@@A@ABA@@@@@@B@@@@@@@BA@A@@B@BA@BB@@@B@BAB@BA@@BA@A@@BABADAB@@@@@DAB@B@DAB@@@DCBAHAB@B@@@BAB@BABABABAB@F@B@D@F@B@@@DDB@@@D@@@B@B@BABA@@@A@AB@@@@@B@@@@BB@@@@A@@B@@@B@BA@@@A@@CA@@@A@@BA@A@A@C@ABA@@BA@@@@@C@C@A@@@A@@A@@@A@@@@ABABA@@@ABAAA@@@@@@AAA@@@@A@A@A@@BA@A@@AA@@@@@ABAAA@@@GBC@AA@@A@A@@@AAAA@@A@@BABABA@@BBB@BBF@D@B@BBB@B@@@B@@@@H@B@B@BBB@B@@@BA@@BB@@BDBB@@@B@@@B@BA@BD@@@B@@@B@@C@ABABA@ADAB@DCBA@@B@@AAA@@BA@@@A@C@A@@@AB@@AB@BCBABCBA@ABA@@BABA@@@A@@@ACAA@AA@A@@@A@C@CAA@C@A@A@A@C@A@ABAB@B@BABA@@BA@ADA@@@C@A@A@EAA@G@A@C@@BCBA@CB@@A@A@@BC@A@@BA@AB@B@B@@C@A@A@A@A@A@AACAA@ACCB@DABABABABA@ABCA@@@AAA@AAAAA@@CACAA@AAC@@@AAA@C@@@A@@@CAC@AAAA@@AB@@@AE@A@@@A@AACCC@AACACAA@C@@@A@CB@@ABABABAHCB@BA@@B@@@BAB@BA@A@@@E@A@AB@@AB@BAB@@@AE@@@AB@B@BA@@@A@@@AA@@AA@@@BA@A@@@@BDB@@@B@@@@@@A@@@AB@@@BC@@@AAA@A@@@@DCBA@@B@@@BCBA@ADAB@BA@A@@DC@@BA@A@@B@BABB@@BAB@B@D@BAB@BCBCBA@@@@@C@A@@BABCDC@@B@@@BAA@@ABABABA@@@AB@BAB@B@@@B@DAB@HADB@@B@DAFAFADAD@@@B@@@B@DB@@BBB@B@@@DAD@@BB@B@@@BAB@B@BABCFC@@@A@ABE@@@@B@B@BA@A@@DEBA@A@A@ADCBA@ADE@@@E@@@A@@BC@ABA@@BAB@B@@@AA@AB@BCB@@A@A@@@@BC@@AAAA@AA@A@A@A@CAAAA@@AA@ABC@@@AAA@A@ABA@A@A@@@A@@AA@AAAAA@@AAA@A@@@A@@A@AAAAECACA@@AA@@@AA@C@ABA@A@A@A@C@A@A@@@A@A@CA@@AAA@@BC@A@A@@@AAC@A@A@A@AAA@@AA@@@A@@BAB@@A@C@@@@BABCB@DABA@@BABA@@@@@A@A@@BABABC@@@A@AAC@A@AAAAEAAAAAAA@@@AAACACCAAA@@A@C@@AA@ACAAAA@ECAAC@A@C@@@A@@@A@@AACAA@A@A@C@@@A@A@A@A@@@ABABAB@@ABA@A@A@@@@E@A@@A@@@A@ABAAA@AAA@@@AA@@@A@A@A@@@A@@DABA@ABC@A@@@CAA@AACA@@BA@CB@@ABA@@@A@AAA@@@A@A@A@@@@@AA@@CCAA@@@A@A@A@@@@@A@@A@A@EAA@AA@@A@@@A@A@C@AA@@ACA@@AA@@@@BA@@DC@@@AD@@A@@BA@ADAB@BCDCB@B@BAB@@@B@F@@A@ABAB@@AB@B@BA@ABABCBA@ABA@EBA@@@@AA@ACCECC@AAAAAAAAAEAC@@AAA@@@A@CBAB@DC@A@@@CBA@@BE@ABA@A@A@CBG@A@@AAAACEAA@@@@E@A@EAEAA@AAAAAACAAAA@@AA@AACCCAAAA@AA@@A@CBIBA@A@A@AA@AACAACE@@AAA@@@@@AAA@A@@@@A@@EACCC@A@C@@@@@@A@AAA@A@@@A@@AA@AAA@@CA@@@@BA@@@A@@ACA@@A@@ABC@C@A@A@A@A@ABA@C@A@CDABC@CBA@A@C@@@A@@@A@@@AA@AAA@@AC@AA@@A@@@BABCB@@@B@BA@@@A@@@@AA@@A@@A@AAA@AB@@A@@BI@C@@AA@AA@@A@@@@B@@@BA@CBA@A@A@@@ABA@E@A@C@CAC@@AAAA@CAA@@@A@ABA@@@A@A@E@CAGAC@A@@@AB@B@BA@CFA@A@@@C@C@C@A@A@@@AB@B@@AB@@A@@@A@@AC@A@ABA@CBAB@BABCFAB@FAD@DA@@BA@ABA@EBA@ABA@@BCBEDA@ABCDABA@GB@BADAB@@ABAD@@AB@@@BA@@@A@A@@@@@@@@B@B@@@D@@@BABAD@@@BBD@D@F@BAF@@AB@B@B@B@DAB@F@@AD@DABA@@BA@CBABABAB@@C@A@ABA@@@AAA@A@C@AAA@@@@CA@@AA@A@ABA@A@A@CA@@ACAA@@@E@@@A@@AA@@@AC@@@A@A@AB@@A@A@@@C@A@C@A@@@@@CD@@A@AB@@AAA@@@A@@AAB@@A@@@AA@@A@A@@@@@@A@@@@@A@@C@@@@@@@@A@@A@@@A@AA@AA@@@AB@B@@@B@@AAA@@BA@@@AB@@A@A@A@CAC@A@@A@@A@@@A@@@AA@@@@A@@@A@@A@@@@A@@@A@A@@@A@@@AB@A@@@@@@A@ABABA@@B@@A@@@@A@@ACA@CACAE@AAA@@@@AAE@AA@@A@@BA"],encodeOffsets:[[117227,29435]]}},{type:"Feature",id:"360425",properties:{name:"永修县",cp:[115.831956,29.011871],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@A@@@@@A@A@AA@@A@@@@@AB@@A@@AA@AAAAA@@AA@@A@AAE@@@@C@@@AA@@@A@@A@AB@@AAA@AB@@@@@@@BA@@@@@A@@@@@AB@@@@@B@@@@CBBB@BAA@@@B@@@@@@A@@BB@@B@@@B@@@@@BAB@@@@@B@@A@@B@@A@A@@@AB@@B@@B@@@B@BA@@@A@CB@@@B@@@@@@BB@@@@A@@@AB@@@@A@@@@BABA@A@@@@@AB@B@BA@@B@B@BCD@@AB@B@BAB@@@@A@@@@A@@ABCD@@AB@BA@A@A@C@A@@AA@@A@AAA@ABA@A@@BC@@AA@@AA@A@ACAAAEA_JA@@@AA@@A@@A@A@@AA@@ABABA@@@AB@@@@@FQBC@C@CA@@AA@@AAABA@@BA@@BA@@BBBBB@@ABA@AB@ACA@@A@@BA@A@@@@@ABAAA@@B@@ABAD@@ABBD@D@@D@@@@B@@DDB@DBBB@BB@@@@AB@B@@@@@@@@@B@BAB@@@@@BB@@B@DB@B@@BB@D@@ABA@@@@@@@@BB@@B@@@B@B@@@B@B@@@@A@@@@B@BC@@A@AC@@@@@@A@@@@@AAA@@@@A@@@@ABA@@@@@@@A@AAAA@AAA@AAA@@@A@A@@@AB@@AD@@@BA@E@@@CB@@@A@@A@CC@@@@A@@@A@AB@@@AABABA@A@@@A@AA@@A@@A@@@@A@@@@@@@@AAA@@@A@@AA@@A@@BAB@@@@@@@BA@@@@BA@A@@@ABABA@ABA@@@A@A@AA@@@@AA@AAAAA@AA@@@@@@B@B@@B@@@@@A@@D@BABA@CB@@@B@@CB@@@HAFD@@@ABCDCBSDEEA@@AA@ABA@@@A@@B@@AA@@A@ADCA@@A@@@@@@BIBA@A@BB@BBBBB@@@@@@DAB@FA@@B@BAB@@@@@@@@BA@BBAB@@@@@B@@@@EBC@A@@@A@C@A@EA@@A@AB@BAAAA@@A@@@@BA@A@A@@AA@@@A@@@@@@ABBB@B@@@@A@@AA@@@@A@@A@@@A@@@ABD@@@BB@BBB@B@@@@@@@B@B@@@B@@@@A@C@@ACEBC@@@AAA@@A@AA@@@@@AB@@BB@@@@@@A@@AA@@@@B@@AD@@@@ABAB@@A@@B@@ABAB@BA@@B@@A@@B@@@BA@@@@@@@A@A@A@@@@@A@AB@@@@@B@BA@CBABA@A@CDABAB@BA@A@@BAB@@@@@D@@@@AB@@@B@BA@@@A@@B@@@BB@@B@@@B@@@@B@D@@@A@@@AB@@@@B@B@@@B@@@@@B@@@@B@@C@A@A@A@AAAB@@@@ABAB@B@BAB@BA@A@@B@@A@@@@DABA@@@A@@@A@@BABA@@@@@A@@@A@@@AB@@AB@@@@AB@BA@AB@@@@A@@@@@@BA@@@A@A@@A@@A@ABA@C@@@A@@@A@@@A@@BA@@@A@AB@@A@@@A@AA@@A@@@@@C@A@A@@@@A@@A@@@@@AA@A@@@AA@@@@@A@AAA@@@AA@@@@@@A@@AAA@AAB@@@@A@AB@@@@A@@@@@AB@@@@A@@@AB@@@@A@@@C@@@C@A@A@@@@@@A@@A@@@@@AAAAA@@AA@@AA@@@@B@B@BA@@B@BADC@AB@@@@AB@B@@B@BB@@@BBB@@@B@@@FA@@B@B@@AB@@@BA@@BA@@@A@AA@@A@A@A@ABA@@@A@@BA@@BA@@@A@@@@B@@A@A@A@G@AB@@@B@@A@AB@BAB@@@BA@A@A@@@@B@@@BA@@@@BA@ABABA@@@AB@@@@@B@@BB@@@@BB@@AD@@@@BB@@BBB@B@@BB@BB@B@BDB@BB@BB@@AB@B@@@B@@@BABA@A@ABA@CB@@BBA@@@E@A@A@@@@B@@A@AB@@C@A@A@@BA@@B@@BB@B@B@B@@A@CB@BCB@@@@@B@B@@BBB@DB@@B@@D@@@B@B@B@BA@BB@BABBDABBB@@AB@B@@@BB@@BB@D@@@FDBBB@BAB@@@@A@@BA@@@@DB@@@BB@@B@@@B@BBB@BBD@BBB@B@B@D@B@@@BAD@@BB@BB@@BC@CBA@A@@@@BB@BA@BB@BB@@@@@B@@BB@BDBA@@B@@A@C@C@@@A@C@A@ABA@@B@B@@@B@@AB@B@@BBDF@B@@AD@B@F@@@B@B@B@B@D@B@BA@AB@@@@C@@@A@@B@@AB@B@B@@A@@B@B@BAB@BA@ABA@@@AB@B@@@D@BBB@@BB@B@BB@@BBB@@@BABAD@@ABAD@BBB@@@@BB@@@@A@AA@BA@@@AB@@E@A@@@C@A@A@@@@@A@@BA@A@CB@BAB@@A@@@@@@BBB@BBB@B@@BF@@DDBB@BB@AB@BBF@BAB@@@B@@A@@B@@AB@@@BBB@B@@@D@@AB@@AB@BAA@B@AA@@AA@@@@AA@@@@B@B@B@B@B@BB@@B@@@@@B@@BB@B@@@AB@@@@@BB@@BB@@@BB@@B@B@@BB@BBB@B@B@B@B@DAB@B@B@@@BABCD@BAB@B@D@@@B@@@B@@ABA@A@@@A@@B@BAB@D@BBD@@@B@D@@ABAB@B@B@BAB@@A@AB@@@B@B@B@@@B@@@@@@@BAB@@@BBB@@@B@BA@@@A@@B@@@@BB@@A@A@@BA@@B@@@BA@@@A@BB@B@@@D@B@B@B@B@@B@@@BA@@@BB@@@@@AB@B@@B@BB@@@B@@@@@BABABBD@DB@@B@DB@@B@BAB@DA@AD@@@FABAD@B@D@B@B@BB@@@B@BBB@@@BBD@@@B@B@@@BB@AB@B@@BA@AB@BAB@@C@@BCA@BA@@A@@AA@@ABABA@@BA@@AA@@@AB@@AA@@AAAA@B@BA@@B@B@B@@@BA@@@@B@@@B@B@BAB@@A@A@@B@@@B@B@@AB@@AD@B@@@@@@@B@@@BA@@B@@BB@@BB@B@@@@@B@B@@@@@B@@AB@B@@@@@@B@@@B@@ADA@ADAB@@@B@B@@@B@@A@@B@@@BA@@B@BBBA@@B@B@B@B@D@@@B@@@@@@@@A@@BA@@BBB@B@@BB@@B@@B@BAB@B@@@BB@@@@B@@@B@B@@@@AB@AAAA@ABA@@BA@@B@B@@@@@@@BA@@D@@AB@B@B@@A@@B@@BBB@@B@B@@@@@B@AB@@@@@A@@A@@@A@A@AB@@@AA@@@@@@@BBA@B@B@@@@@BB@A@@@@@@B@@@B@@@@B@B@@@@B@@@B@@@B@@A@@@@B@B@@@B@B@B@B@B@@@DA@@@AB@D@@@@AB@@AB@@@@A@ABABAB@BAB@B@BA@@B@@A@A@A@@AABA@A@A@A@@A@@@@@@AAA@@AAB@@@@ABA@AB@BBBA@@B@B@B@BB@BBBBD@@BA@@BA@@@@@B@@@B@@B@@@@A@@DDB@@@B@@A@@@@AA@@@@D@@@@A@@B@@AB@@ABB@@@@A@@@@@@@B@@@BB@B@@B@@B@@@A@@B@@@@@@@@AB@@@@@@@A@@A@@@B@@A@@@A@@@AA@@BA@@@A@@D@@B@@@@B@@@@ABA@@B@@A@@B@BBBBD@@@@@B@@@BA@@@@D@@@B@BA@@B@BA@@B@@@B@BAB@B@@ABAB@@AB@B@BB@@BAB@@@D@@@BA@@@A@@B@B@B@@B@@BB@@@@BB@@@@B@@B@BB@@A@@@@BA@AB@@@B@B@@@@AB@@@B@B@@@@@BA@@@@B@BBB@B@@BB@@BBA@AB@@A@@BAD@@@@@BA@@@@@A@@B@@@@B@@D@B@@@BB@@BA@@BAB@@@B@@@B@@@@@B@B@B@@AB@@BB@BB@B@@BB@@B@@BB@BB@@B@BA@A@B@@B@@@FAB@@@BA@@B@@BBB@@@@@A@@@@@@@AB@B@B@DAB@DB@@B@BD@@@@B@@AB@B@B@@AB@B@B@@@B@@@BAB@D@B@@@D@@AD@@@@BBB@@B@DABB@@@@@@B@AA@@@A@@@@BAB@@@D@@@@A@A@@@@A@@@@@@@@@A@@AA@@@B@@@B@@@@@@@B@@@B@AA@@@@@@B@@@@@@@@B@@@@@@@B@@@@@@B@@@@B@D@@D@@DB@@@B@@@@@D@@@BA@BB@B@BB@@@@@@B@BA@@@AB@@ABBB@B@D@@@@@AB@@@B@D@@@BB@DAD@D@@@@BB@@@@B@@@BB@@@BAB@@@BB@@B@BABB@@@@B@B@BA@@BABABAB@B@@@B@B@@AB@@AAABA@A@@BA@@BA@A@@@ABA@@@ABA@@@AB@D@@@@B@@B@@@@@BABABA@AB@@@BA@@BAB@@@BA@@@A@@@AB@@@BAB@@AB@@@D@B@BBDBD@@@@@@@DBB@@AF@@@DA@A@@@@@@B@@@B@@AB@B@@@BA@@@A@AA@@@@@B@@@@B@@B@@@@@BA@@B@@AB@@@B@BBBB@@@@@@@A@ABA@A@@AA@@@AAAB@BC@@@AB@AA@@BA@ABA@@AA@@@@B@B@@A@@@@A@@@@@@@@A@@@@@A@@@@@AB@B@@@B@@@@@@@@@B@B@@@B@B@@@@@AA@A@C@@A@@@@A@@@AAAB@AA@@@@AA@AA@@A@@@@@A@ABA@@A@@@@AA@@@@@BA@@@@@@B@BA@@B@@@@AB@BAB@@@B@@AB@@@@@@AB@@@@A@@B@@@B@@B@@@@@@B@@@@@B@@B@@@@@B@@@@B@@@@@@@@BB@@@@@@@@A@@@@@@@A@@@A@@@@@@@@@A@@@@@@BA@@@@@@@@@@@A@@@A@@@@@@@@@@@@B@ACB@@BBABB@@@A@@@@@@@@@A@@@@BA@@@@@@@B@@B@@@@@B@@B@@@@B@B@@@@BBB@@B@@B@@@@B@@A@@@@A@@A@AA@@AB@@@B@@A@@@@B@A@@A@@@@BBA@@A@@@@@AB@B@@@@B@@B@AA@@@A@@@@B@A@@A@@@@@AB@@A@@AAB@@@@@@@@@@A@@@A@@@@B@@@@A@A@@B@@@@@@B@@@@@@B@@B@@@B@@@@BA@@@@@@B@@@@@@@@@B@@@@@@A@@A@@@BAA@B@@@@@B@@@@B@@@@B@@BB@@@@@@@@@@@B@@A@@BB@@B@BA@@@@@@AAB@AAB@@A@@AA@@B@@A@@@A@@@AB@@@@A@@@@B@@A@@@A@@@@@@@A@@@@@@@@@AD@B@@@A@BAB@@A@A@@@A@@D@@A@@AC@A@@BABABABA@@@A@AACAA@@@@@A@@BAB@@@LA@@B@@@BABA@@@@@A@AA@BA@@@@BB@@B@@BB@@@BA@@@A@A@AB@@@@AB@A@@AB@@A@@BBBBBB@@@@BB@@BA@@B@@@B@AAAG@@AABEB@AGA@CC@@@@@ADA@@D@@ABC@CB@B@BA@@BB@@@@@@B@@@BB@@BAB@@@@@@@A@@BB@@@@BD@@BBBB@BB@@@@A@@@A@@@@BB@B@B@BBBBB@@@D@@B@@@@@@@@@@@@@@B@A@@A@@@A@@@@@A@@@@A@@@B@@@@A@@BB@@@A@@@@B@@@@A@A@AAA@A@@@AE@BA@@B@@@@@BB@AB@@B@@B@BE@@@@B@@@BAB@@@@@@@@@@@A@@@@@A@@@A@A@@@@@@A@@@@@@@A@@@@@A@@B@B@BBB@BBD@@B@@@@DA@@B@BB@A@@@@B@@@BAB@@B@@@@@@B@@A@@A@B@BA@@BB@@B@@B@@BB@ABA@@@@@@@@CA@@@A@@A@DCB@@@@A@AAAAA@AA@@A@@AAB@B@B@@@@BB@@@B@@@@@BA@@@ABADB@@BA@@B@@@B@BA@@@@@ABA@@@@B@BB@@@A@@BCD@@@@DAB@BB@@@F@@@AAB@D@@@@@B@@@DBB@@B@@@@H@A@@@A@A@A@@@ABBBBB@@B@@@AB@@BBBB@@@@AB@@@@@B@BB@@DDBABBB@@@@@@@B@@@@B@@@ABB@@@BB@@DB@@@@@@@BA@@@AB@@AB@B@BA@A@@@AA@@@@@A@@CB@AA@A@@A@@@@A@@AA@@@A@@@@@@@@B@@A@@@@@@BAB@@@@@@AA@A@@@BBB@@BBB@@@BA@@B@@@B@BD@@BB@@A@@@@@A@@B@@@@A@@@@@A@@A@@@@@@@A@@A@A@A@@@ABB@@@BB@@BB@@@@@B@@@@BB@@@@@@@@@B@@@@@@@B@@@@BB@A@@B@BB@@@B@@ABA@@@@@A@@AA@A@@@@@@AA@@@BB@B@@@B@@A@@@A@A@@A@@A@@@@@@AA@ACA@@A@@@@@A@@A@@@@@@A@@A@@@@@@B@@A@@B@@@@@B@@@@@B@@@BAB@@@@BB@B@@@@@BDB@@B@@AB@@@@B@BB@@B@@B@@B@AB@BB@@@@DABA@@@@BBD@DDB@B@@@D@BBB@@@@AB@@@@AA@@@@A@AA@@@@@@@@@@A@@@@A@@@A@@@@A@@B@@@@A@@@@AAAC@AAB@A@@@@@A@ABA@@AA@@@A@@@@@@@@@@@@B@@B@@@AB@@A@@@@@@@@B@@B@@@@@@@@@AB@@@@A@B@@BAB@@A@@AA@@B@@A@A@@@B@@@B@@BA@@B@@@@@@@A@@B@@@B@@@@@@@@@AB@@@B@@@@B@@A@@@@@B@B@@@B@A@@@@B@A@B@@@B@@BB@@@@AA@@A@@B@@A@@@@A@@A@@@@@@A@BA@@B@@@@@BB@@B@@@@@@A@A@@@@@@B@@@@@B@@@@@BB@@@@@B@@A@@@@@A@@B@BB@D@@@@@@@@BB@@@A@@B@AAB@@A@@@@@AA@B@@@@@@@@B@@@B@B@A@A@A@@@A@@@A@@@@BA@A@@@A@A@@@@@AB@@@B@@@@@A@@@@B@@@@B@@@@@@@@@BB@@@B@@BA@@AA@@@@B@@@@AA@@@@@AB@@@@@A@@@@@@@@@A@@@@A@@@@@A@AB@@@@AAA@@A@@@@B@@B@@B@@@@@@@B@B@@@@A@@@A@@@@@@@@@A@@@@@@@@BB@@@@@@B@@B@@B@B@@A@@@B@@A@B@@@@@@@@@ABB@@@B@@@BA@B@@@@@@@@B@BB@@B@@@@@@@AD@@B@@@@@@@@BB@@BBB@@@A@@@@@@@@@@B@@@@@@A@@@@BB@@@@@@@A@@@@@AAA@@@AA@@@@A@@@@@@@@B@@B@@BB@B@@BB@@@@@@@B@@@@@@@@BAA@@@B@@A@@B@@AA@@@@@@@A@@@@@AA@AAA@@@@@@@A@A@A@@ABAB@B@@@@@@@ABC@A@@@@@@ABA@@@@@A@@@A@@@@BA@AB@@@@@@@A@@@@@@B@@A@@@@A@A@@@@@@A@@A@@BA@B@@B@@@@@@C@A@@AAB@AA@A@@@BAAC@@B@@@@@B@BC@A@@BB@@@@B@@B@@@@B@@@@@@@@AAB@@@A@@A@@A@@BB@@B@B@@@@@BA@@@@@AA@@AAA@@@A@@@@@@A@@A@@B@@@@@@BB@@@@@B@@ABBB@BB@BB@B@B@@@@@B@BA@@@BA@@@@@AB@B@@BBA@BB@@@@BB@A@A@A@A@A@@@@BB@B@@@B@@@@@B@@B@D@B@BA@A@@@@B@@C@@@@@@@@BA@A@@B@@@@A@@@A@@@@@ABA@@@BB@AA@@@@B@@B@@BA@@B@@@@@D@@@@AA@@@@CBA@ABAJ@@@@@B@B@B@BAB@B@BB@@@B@B@BBB@BDBBBB@B@@@B@@@BADG@@BAB@@@@A@@@@@@BBDD@@@@@@@@@@@@@A@@B@B@@@DB@@@@BA@@B@@@@BB@@A@@@@@@@A@@@@BB@@B@@@@@@ABBB@@@B@B@@BA@@@@@@B@A@@B@B@@@BB@@@AB@BBBB@DB@DDBB@@@@B@@@@B@B@@@@C@@@A@B@@B@@@BDB@B@BBB@DB@@B@@A@A@@AA@@B@@BB@BBB@BDBBD@@BB@B@B@BBBAB@B@D@BBB@@@B@B@D@B@@@@@@B@BA@A@AB@@A@ABA@A@@AC@AAECEAA@ABABG@@BA@@@B@@@B@B@BBFBBBBBBBBB@BBBAB@@@@A@@@@BAB@@@@A@@@@@ABB@@@A@@@@@@@A@@@@@@@@B@@@@BB@@@@A@@@@@@@B@@BA@@@@B@@@A@@@@@A@@@AAA@@@@AAA@@@ACCAAAAAAAAA@@ADAFCDCBABAHFFFDDFFLPFFBBBBBBDBDBD@H@D@H@BAHAJCB@D@B@B@D@BBHDJF@BDBFBBB@@B@@BBBBBBD@BBDBHBNBB@B@B@B@FAD@BAD@B@@CFCFCDABEDGFKHIDABABABAB@@@BAD@F@H@F@F@D@B@DBFBF@BBBBBPJHDDBFDFDB@JDBBHBJFDD@@DFFFFDBBDBDB@A@CBABEDE@C@A@ABC@IBCBA@CBABCDCBABAFCHKDCDCBEJGBAFCJGB@FCF@DAD@HAFAF@\\EB@NCJ@D@DAF@HCH@FAHAB@JAB@HCD@D@JBH@FBH@F@BBD@B@T@J@N@B@BAH@JCFCJCDCLEFEDABABA@CBCBIBKBEBIBE@A@ABEDU@E@C@A@AAAA@ACCCCAACKIIGAAEECAACKGCEECECCECAA@IAICQCEACAKCC@ICIBHA@CAGAGASC[BA@GB@@A@@@@AAC@A@ACACAA@@@@@A@A@CB@@A@A@A@ABE@@@A@@@A@A@CBA@C@@@CBA@AACAA@AAA@IGAAECAAGCAAC@@@@BBB@@@B@B@@@B@B@@A@GA@@@A@@@A@A@@@ABCA@@ACAAAAAAAAA@AI@@@A@@A@A@@ACA@@@CAC@AAAAELOJ@@@B@B@D@F@BB@@@B@@@@@@B@@E@A@AB@@AB@@BBAB@@@@AAAA@AAC@@AACAAAA@@AAI@@A@A@@ACCACCC@AAAA@C@A@@@ABABADABBD@B@FC@A@A@AAE@AAABEA@@A@A@@@A@CAE@EAA@AAEC@@A@A@C@A@C@AAA@AACA@@A@CA@@AB@B@BA@CBA@CBA@@A@AACA@AAA@@ACCAA@@A@AC@AAA@AB@BAA@@AA@AAA@C@@@@AA@@@@A@A@E@@BC@A@ABA@@@@B@@AB@@A@@BA@ABA@A@A@A@@@A@@AA@AAA@C@@AC@A@AAA@@@@A@AA@@AA@ABA@@@A@A@ACCA@@AEGAAAAA@A@C@@@@@@DA@@@A@A@A@AAAAAA@@@C@AA@AA@@A@@@@@@@@@@AA@@A@AAAA@@AA@@@@@A@@@AB@A@@A@@@@@A@@@@B@@@@A@@@@@AA@@@@A@@@@@AB@@@@@@@A@@@@AC@AB@B@BA@@BA@@B@@C@@B@@@@@B@@@BC@@@@@@@@@A@@BA@A@AA@@A@@@AB@@AA@@A@@AA@@A@@B@@AB@@@@@B@@@BA@CA@@@A@@BABC@@ACCAA@@@@A@@BCB@@A@@AA@@@@@A@@BAA@@AA@@@A@@A@AA@AAA@@ABA@@A@@@@AA@@@A@@@@A@@@@@AA@@BAA@@@@@A@A@@A@@@@@@B@@@@AA@A@@CA@B@@@@@D@B@@AB@@@@AA@@AAA@@A@@AAA@@A@@@A@A@@CA@AA@@@@A@A@@B@@A@AAA@@AA@@AA@@AC@@@@A@A@@BA@AB@@@AC@@@@A@@A@@@A@A@A@ABA@@@@@@@ABA@AA@@A@A@AB@@AA@@AA@@@@@@BA@@@A@@A@AAA@A@@@A@@B@@@D@@A@@BA@A@CB@A@@@@@@A@@@A@@@A@A@A@CD@@@@@@@B@@AB@@AB@@@BA@@@@ABG@ABCBA@CBC@@BI@ABC@@C@@@@A@A@@A@@@@A@@B@@@BA@C@@@@@A@C@@@A@@AA@@@A@@@A@@@A@A@@BA@@@AAA@@@@A@@@@AA@@@AA@@A@@@AA@@@@@A@A@AA@@A@AACB@B@@@@@@@B@@AB@B@BA@@@@@@AAA@@@@AA@@@CB@BA@@A@@@A@@@A@@@A@A@@@@@A@@A@AA@@@ABC@A@@BA@A@A@@@AAACABAAA@@@@@@CAABA@A@ABA@@@A@A@@B@AA@@A@ABA@A@@@AA@ACA@@AA@A@@AA@CA@@@@ABA@@BA@EB@@A@@@@B@B@@@@A@A@A@@@A@A@A@@@@@@A@AA@@A@@@BABAB"],encodeOffsets:[[118561,29598]]}},{type:"Feature",id:"360426",properties:{name:"德安县",cp:[115.767447,29.298696],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@@B@@AA@@@@A@@AA@@@BA@A@@@@AB@@@@@@A@@@@BA@@A@@@A@@@@@AA@@@BAA@@@@@@BA@@@@AAA@@@@A@@@A@AA@@A@@@@AABA@@BA@@@A@@AA@@@A@@@A@@@AB@@@B@@A@A@@@A@A@@@@@@@@@A@@@A@A@@B@@@@@B@@@@@B@@@@@@B@@@@@@BA@A@@@@@BB@@AB@BAB@@BBA@@B@@ADA@BB@B@@BB@@@BAB@B@B@@@@@@AAAAA@@@A@@BA@@@AB@@@@A@@@@A@@A@@@@@B@@B@B@@AB@@A@A@@BA@@@A@@@@@@@@BCB@@E@@BA@CA@@@@@@C@CAAAA@C@@@A@@BA@AB@@A@@B@@@B@@AB@@A@AB@@AB@@A@@BABABAB@@@@A@@@@A@@C@A@@B@@AB@B@@AB@B@@@BAB@@AB@@@BABBB@BA@@BA@A@@@A@A@ABABAB@@ABA@A@@@@@AAABA@@@AA@@A@AB@@A@@A@@@A@@A@@A@@C@C@CBA@@A@@@C@A@@BA@@@@C@A@A@AA@BA@@B@@ABA@@@@@@@AAA@A@@AAB@@C@@@@@A@@@A@@CC@@@@C@A@@A@@@@@@@@A@@@@@@@A@@@@@@A@@@@@@@BBA@@@A@@@@@@@A@@@A@@@B@@BB@@@@@@@@@B@@@@@@B@B@@C@@@A@AB@@@@@B@@BBA@@@@@@@AACBA@@@AA@A@@C@@BC@@@A@C@A@AB@@A@@@A@A@A@@BA@A@A@@BA@@@@@ACA@@@CAA@CBA@A@A@@B@@@@@@@B@@@@AA@AA@@@AB@@A@EB@@A@@@@A@BABA@@@AAA@@AA@@@AA@@@AAAA@@AA@@BA@A@A@@@@@A@@@A@@@A@AB@@AB@@AA@@A@C@@@@A@@A@@@@B@@@@AB@@@@C@AB@@@BA@@BAB@AA@@AA@A@AAA@@@@@AB@@@@A@A@@@A@@B@@A@A@@@A@@BAB@@@@@BA@@A@AA@@@@@AA@@@@AA@@@AA@A@A@@@@B@@AB@@C@@@A@AB@@AAA@A@@BA@AB@BA@A@ABA@@@A@@@ABA@@@ABA@@@C@@@@@AB@@A@@@@@C@AAAAA@@@@BA@@@AB@B@@A@@@@@@AC@@@@B@@AB@@BB@@B@@@B@@@@A@@@BB@@@@@@@A@@B@@@@@@A@@@@B@@@AA@@@@AAA@@A@@@@@@@B@@@@@AA@BA@@BA@@@@B@@C@@@@@BB@@@@@BA@@@A@CC@@@B@@A@@@@A@@@A@@@@AB@@AB@@ACAA@AAAA@A@A@@@ABAAA@@BAB@B@@A@BB@@BB@B@@@@B@@@@B@B@BABBB@@@B@B@BA@@@ABA@A@ABA@ABAB@B@B@@A@@BA@@B@@C@A@@B@@CB@@A@A@A@A@A@@@A@A@@@@@@BA@@@A@@@A@@@@@@A@A@@A@@@A@@@@@@@@BAA@@@@A@A@B@AA@@@@@@B@@B@@BAB@B@@@B@@@@B@@@@BAA@@@@@A@A@@@AA@AA@@@@BA@A@A@@BC@@@AB@@@@@@A@A@@@AB@@AB@BBBBBA@@B@@A@A@@@A@@@@@AA@@A@A@ABA@@@@AA@@AA@A@AA@@AB@@@B@@@@@@A@@@C@A@A@A@A@@@ABAAA@@@AB@@A@@@@BA@@@A@A@@@A@CB@BCB@BA@BDBBB@DD@@B@B@D@DB@BD@@@@@@B@B@@BB@B@@A@@AA@@@A@@BA@@@@@AB@@@@@@@@A@@@@@@@@B@@@@@@@@A@@@@@@BAA@B@@A@@@BB@@B@@@@@ADABAB@B@@ABBB@@ABABABABAB@@@B@@@BBB@BA@A@@B@@BB@B@@@@AB@@A@@B@@@@@D@@@B@@B@@B@@@B@@@B@BBD@@AB@@@B@@BB@@B@@B@@BBAB@@@@@B@B@@B@@B@@@B@@ABC@@@@@@D@@ABA@BBBB@@AB@@B@@@B@B@BB@@@B@B@B@BA@AB@B@@@BBB@@@BB@@@BB@B@@DDBB@@BBBB@@@@@D@@A@@@@B@@AB@@@@AB@@BB@@B@BB@@BB@@ABAB@@@@@@@@BBBDB@@@B@@@B@B@B@B@@B@@@@@B@@@B@@@@BB@@ABB@@@B@DB@@BABA@@B@B@@@B@@AB@BA@@@A@@@@@ABAB@D@@@@@@B@@B@BAB@B@@@@@@AB@B@@AB@BAB@B@@@BA@@@A@A@@@@B@@A@@@@@@@A@@BA@@BBB@@B@@B@@@BA@@B@@B@@BB@@@@B@B@@@@@@BBBBB@@@BBD@@@B@B@@@BAB@B@B@B@@BBB@@@@@AB@BBB@D@B@DB@@BB@BB@@BBBDB@@@AB@BDDBBBB@@@BB@@B@@F@BBD@D@B@BBDB@@@B@@@B@@ADDB@B@B@@@B@@@BA@@@DBB@@BF@@@BBB@@B@B@@@BA@@D@BA@@B@@@@BBBB@BD@@@B@BBBB@B@@@@@@@B@@B@@BB@@@DB@@DBB@@@@B@@BBBBB@@@BABAB@@ABBBA@BB@@@@@B@@@B@B@BBDB@@BAB@@@D@BB@@B@BABABA@@B@@@DB@@@@@@@@DBDD@@B@B@B@@@BBB@B@DBB@DBB@BBB@B@BB@@B@BBB@BBB@B@B@@@BBBBD@D@@@@@@A@@@A@A@@@A@A@A@A@CB@@@F@@@D@DA@AB@@@@A@AA@AC@@@@BA@@BAB@B@@@@@@AB@@@B@@@B@@AB@BAAA@A@A@@DBB@@@B@BA@@B@BBD@@BB@BB@@B@@@DD@@A@@@@B@@@@A@@@AB@@@B@@@@@@@@AB@BAB@@@B@BBJB@@B@DBD@B@@@B@@@@AB@@ADA@@@@D@@@@D@BBB@BBB@B@B@@@B@BBB@HAB@B@BBDBDBFBDB@@B@@@@A@AAACG@A@@@A@@@@@@D@@@DBBBB@B@BBB@B@B@BB@@B@@BDBB@BB@@@BB@@A@@B@D@B@BAB@@BB@B@D@B@@@B@B@BB@@B@B@@BB@B@@B@@BBB@BB@@B@@@@@BB@@@@@@B@B@B@BBB@B@@BD@B@B@B@DD@@B@@A@@B@@@BA@@D@@B@@DA@@@@B@D@B@B@B@@A@@BBBBB@B@B@@@DDBBBBD@BB@@DBB@B@@A@@B@BA@@B@B@@@BB@@B@@B@@BBB@B@BBD@@A@@@@@@B@D@@@B@B@B@DBB@D@B@B@BAB@B@FA@@B@H@BA@@B@@@B@FB@@D@B@B@B@B@@A@A@@DC@CBA@AB@DA@A@@@C@@@A@@@AB@@@@A@@@@@@@A@@@@@@@@@A@@BA@A@@AB@@@@@BA@@@A@@@AA@@@@@A@@@@@B@@CA@@@@@@@@B@@@@A@@@@B@@@@@@A@@A@A@@@AA@@@ABA@@AA@@@@DA@A@@@@BA@@@@@A@@@A@A@@@ABA@@BA@@@AB@B@@AB@@@DD@@D@@@D@@@B@@B@@@B@@@@@A@A@@B@@@B@B@@@@@@A@A@@AA@@D@@@BC@@BCB@@AB@BAB@@@BA@@@A@@B@@@B@@C@@@@B@@@@A@@BA@@@@@@@B@D@@@@@@A@@@@@@B@@@@BB@@AB@@@@B@@@@@@B@B@@@@B@B@@@@@@C@@@A@@@@B@A@@@@ABBB@@@B@@@@AAA@@B@@@@B@@@BB@@A@A@@BABCBADA@@@A@@@@B@@BB@B@DA@@BB@@@@@@@BB@@@@@@A@@@A@@@@@@A@A@A@ABAA@@@@@A@A@@AB@@@@AA@@BA@A@@BB@@B@@@BA@@@@@@BB@A@@@@B@B@@@@A@A@AB@@@@A@@@A@@BA@B@@@@BB@@@@B@@@@@@@A@@@@@@@@@@A@@@@B@@@@@BA@@@@@@D@@@A@@A@@B@@A@@@@@AAB@@@AA@@@@@@A@E@@@@B@@B@@@@B@@@@AAA@@B@B@@@@A@A@@@@B@@@@@@AA@@@@@BA@@B@@@@@B@AA@@AA@A@A@@@A@@A@@@CAAA@@AA@@A@BA@@@@B@@@AA@@@AA@@A@AB@D@@@BA@@@@BB@@B@@@@A@@B@@@B@@@@@@A@C@@BB@@B@@A@BBC@@BB@@@@@A@A@@B@@A@@BA@@@@@@BA@@@@@@B@@@DA@@B@@@B@B@@@@@BA@@@@B@@A@@B@B@@ABAB@@A@A@@B@@@@AB@AABA@A@@DB@A@A@@@@@@B@@@@@BD@@@@@@@@@@@A@A@@B@B@@@@@@@@A@@@@BB@@B@@A@@AA@@@@AA@@A@A@@AB@@@@@BA@@@AA@@A@A@AB@@@@A@@@@@@C@@@A@@@@AB@@A@@@@BAB@BA@@AA@@@@AAA@@@AABA@@@ABA@@@@@@@A@@@@BA@@@@B@@C@@@A@@@@@@A@@A@A@A@AAAAC@@AABA@@@@@@@B@@B@@@@@@A@@@C@AB@BA@A@@AA@@B@@@@@B@@BBA@@BA@@@ABC@A@@DADA@@B@@@@A@AB@@ABA@@B@@ABEACB@B@@BB@@@@BABB@@@B@@B@@@@@@@@@@B@@@B@@@AA@AB@B@@@@@BA@@@A@A@@@A@@@A@@BCBA@A@AA@@@AAA@@@A@AAA@@@@AA@@@@@@@@AB@BA@ABA@A@@AA@@@@@@A@@@@@@B@@@@@@@@@@@A@@CBAB@A@@B@@AB@BA@@@@B@@@B@@A@@@A@@@@@@@BA@@BAA@@A@@A@@BA@@@@@@@@@@A@@@CA@@B@@A@@@ABAAA@@@@AA@@@@@A@@BA@@AC@@@AAAA@@@@@@AB@@@@@@@A@@@@A@@@@B@@A@@@@B@@@@@@A@@B@@@@@@@@@@A@@@A@@@A@@@@@@BA@@B@@@@B@@@@@@@A@A@A@@@A@A@@@@@@@A@@AC@@BABA@@AA@B@@@B@@AB@@@C@AA@AA@A@@B@@@@@A@AA@@@@@AB@@@@AB@@B@A@@@A@A@@AAA@@A@@@ABA@@@@B@B@@@@ABABA@@@@A@@@@@AA@@B@@A@@A@@A@A@@@@@A@@@AAB@@@@@B@@@@A@@@@@@@@AA@A@@@@A@@@A@@@@A@@@@@AB@@@@@@@@ABA@@BA@@C@@@@@CB@@@@@@@B@@@@@B@@@@AA@@@A@@@A@@@A@@@AA@@B@A@BA@@@@@A@@@@@@A@ABA@@@@@@BAA@B@AAB@@@@@A@@@@@@A@@@@A@@@@@@C@CB@@A@@@@A@A@@@@@@AA@@AB@@@A@@@@AA@@@A@@@@A@@@BB@@A@@@@@@A@@A@@@@@A@@@@@@A@@@A@ABA@@@@BA@@@@B@@@A@@AAB@AA@BA@@@AA@@@BA@@@A@@A@A@A@@@@@@BA@@A@@A@@B@@@@A@@@@@@@A@@@@@@BA@@@@@A@@@@BA@@@@AA@@@@@@B@@BB@@A@B@AD@B@@AA@A@@@A@@@@@@@@@@@@A@C@@@@@@@AAB@A@@@@@@@AAA@@AAAAACAAA@@@@A@@B@@@@@@@B@B@@AB@@@@AB@BA@ABCB@@@@@@BB@@@B@@A@@@@@@@AC@@@@@@@B@@@@BBAB@@AA@AA@@A@@A@@A@@@@@A@@@A@@AB@A@@BAAAA@@@ABAA@B@@A@@@@@@AA@@@A@@@A@@B@@@B@@@@@BA@@AA@@@CB@@CB@@AAA@@@A@AAAA@@A@@@@@@AA@@@@B@A@@@@@@A@@B@A@@A@@@B@@@@A@@@@@@AA@@@@@B@@@@A@@@@@@B@@@@@@@B@@A@@@@A@A@@@@@@BA@@@A@@@A@@@@@@@A@@BE@A@@B@@@@@@AA@@@@AA@@@AA@@A@@@@@@@A@@A@A@@@@@@@A@@@@AAAB@@@AA@@@@@@@@AA@@@@A@@@@A@@@@@@@AA@@@@A@@@A@ABA@A@"],encodeOffsets:[[118497,29976]]}},{type:"Feature",id:"360427",properties:{name:"庐山市",cp:[116.04506,29.448128],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@C@I@@B@BABA@A@AAA@@BA@AA@@DDBBAB@AAAAAA@BB@@@BA@AAA@@@@@C@A@DBB@@BB@@BB@BBA@DD@@B@@@@B@@@@@@@BBA@@@@BA@@B@@@B@@@@@D@@@D@B@@AH@BBB@AE@AC@AAAABA@CBA"],["@@A@A@AACA@AAA@A@A@A@@AAA@A@ABA@A@A@@@@@I@AB@BAB@D@@B@@B@@@DBBHJBBAD@@BBBDBBB@BBDF@BCD@@AB@BB@BB@@BD@@BB@BAB@BABA@@@@B@B@@@@@@B@BD@@@B@BF@BA@AB@BAB@FBDB@@BDB@BB@@BB@@BB@@B@@BB@@@@@@A@@@BB@@AB@@AA@@@A@@@@@@@@@@@B@AA@@B@@@@@B@@AB@@@@AAA@@@@@@@@@@DB@B@B@@@B@@@B@@@@B@AB@@BB@@@@AB@@@BB@B@@@B@@@B@B@D@B@DB@BDDDF@B@B@B@@AF@B@B@@@@BJDD@HDFF\\ANKDQVLT@B@@BB@@B@@BA@@@AB@@@@@@@@@@@@@@@@ABB@A@@@@@@@@@@@AB@A@B@@A@@@BBA@@BB@@B@@@BB@@B@@@B@@BB@@@@@@@@A@@B@@@@@B@@@B@@@@@@BB@@@B@B@@@B@@@@BB@B@@@@@@@A@@@@B@@@@B@@@@@@@@B@@B@B@@@BAB@@@@@@@@A@@@@@@@@@AB@@@AA@B@@@A@@A@@A@@@@@AA@B@BB@@B@@@@A@@@@@A@A@@@A@CC@@@BA@@@@@@@@B@BBB@A@@@A@@@@@@@@B@@DBBD@@@CAAA@@@@@A@@B@BB@@@BB@A@B@@@@B@@@@@B@@@@@@B@@B@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@B@@@@@@B@@B@@@@@B@@@@@ABB@@@@@AA@@@@@@A@@@@B@@@@@@BB@@@@@@@@@@B@@B@@@ABB@@@@@@B@@@@A@@@@@@B@@A@B@@B@@@@@@AB@@@@AA@B@@@@A@@@@@@@@@@@@@@@@@@B@@@B@B@@@ABB@AB@@B@@@@@@A@@B@@@@@@A@@@@@@@B@@@B@@@@@@BC@@@@BAD@B@B@BAAAB@@@@@@BBA@@@@@@B@@@@B@@@A@@B@@@AA@@B@@@@@@A@@A@@@BAAA@@@@@A@@@@C@@@@@@@@A@@@@@@@@A@@A@@@@@A@@@@@@@@@@A@@A@@@AA@@A@C@B@@A@@@@@@@AA@@@A@@@@@@@A@@@@@A@B@A@@@A@@@@@@@@@A@@@@@@@@@A@@B@@@A@@A@@@@@AA@@@A@@@@A@@A@@A@@@A@@@A@@@@A@A@@@@AB@@@@@@@@@B@@@@A@A@@A@@@@@@@B@@@B@BB@@@A@@@@B@@AAA@@AA@BB@B@@@@@B@@B@@@B@@@@@@@@@@@@BA@@BB@@B@@@@@@A@@B@@@@B@@@@@@A@B@@B@AB@@@@A@@@A@@@@@@@@@B@@B@@A@B@@BBA@@@@@@@@@@@@B@@BA@@@@@ABB@@B@@AB@@@@A@@@A@@@@BA@@AA@@BA@@B@@@@@@@@@@B@@@B@AB@@@BC@@@@AA@A@@@A@@@@B@@@@@@A@@A@@@@@@@AA@@@A@@@AB@@AA@@@AABA@@BA@A@ABA@A@@BA@@B@@@@ABB@@B@@@B@@BBAB@@@BBB@@A@@B@B@@@@A@@@@B@@@@A@@@@A@@@@@@@DAB@@@BB@@@ABA@@@B@@BB@@B@@BB@@@BBB@BAB@@B@@@AB@@@B@B@@@B@@@@ABAB@@@@@@@@B@@B@@@@B@B@@@ABA@@@@B@@BB@@A@@BB@@B@@@@@@@B@@@@BBB@@@A@@@@@@B@@BB@@BB@@@@A@ABA@C@AAA@@@@@@B@@@@@@@@BBB@B@@@A@BB@@B@@@@@@@AB@@@@B@@@A@@B@@@BA@@@A@@@@BA@@@@@@@B@@B@@@BAB@@@B@@@@A@@B@B@@@B@@A@@B@@A@@B@@@@@D@@A@@@@@B@@A@@A@@@@@A@@@@@B@@@BA@@@@@A@@C@AB@@@@@BB@@@ABA@@@A@@@AA@@@@@@A@@@@B@@@B@@@@@@@BB@@@B@@@@@@@@B@@AB@@B@@@BB@B@@@B@B@@A@A@@@@BA@@B@B@@AB@BB@@@@B@@@@B@B@@@ADAB@BBB@@BBB@D@@@@@@@BB@@B@B@@B@@@@BB@@@@@BA@@@BB@@B@@@BB@@@@@@@@ABA@@@@BB@@@BB@@BBB@@@B@AB@@@B@@B@@@@@@@@@B@@BB@@@DAB@B@B@@B@@A@@B@@@B@@B@B@@@B@A@AB@@@@@@BBB@@A@@B@@@@B@B@@@@AB@@@@@B@@@BA@C@@@@@@B@@@@@BB@@@@B@@B@B@@@F@BB@@A@@@@@@BA@@BB@@@@@B@B@@ABA@A@@@B@@@@B@@@@@@@B@@@@BB@@B@@B@BB@@@@@@@@@@@@@B@@@@@@@BB@B@BAB@@@@B@@@BAB@@@BA@@D@BBB@B@@@@@@@B@@@@@BBB@@A@A@@@@@@@@@@A@@AB@A@@@@AA@@@@A@@BBB@B@@@BA@@B@@BB@@@@@@A@@@@B@@BB@@B@B@B@@@B@@B@@@B@@B@@@AB@@B@@B@@@@A@@BA@@@@@B@@BDDB@@@@@@BB@@@@@@BBBB@B@@B@@@AB@@B@@@@D@@@BA@@B@@@@@BB@@B@@@BB@@BB@@@BBBBB@@@@BBA@@@@B@BB@@B@B@B@@A@BB@@BBB@@B@B@@BBBDBB@@AB@@BDB@@B@@@B@BB@BB@@B@B@B@@D@B@@@B@DBDBB@B@@@BA@AB@@BBA@CB@@AB@BA@AB@LABABGFC@ABCDA@CB@BC@@B@BBD@BBDBDBBAB@B@@A@ABA@A@@BBB@@D@DADCBABADED@@@BA@@@ADAD@@DBBD@B@BB@DABFJBDFHDDBBFBFBFBDDFFBF@BAFEDB@@BA@BB@BB@@B@@CB@@A@@@A@B@AB@@B@@@A@@B@B@@AC@@@@@@A@@B@B@BAB@BBB@@ABB@@BA@B@@BAB@@BB@AB@BB@@B@@@B@@AB@CC@@B@BCB@@@B@CD@B@@BABABBAB@B@@FABBBAAAB@@A@A@@BAB@L@BBADCBDBBABB@B@@AF@@ADADE@CB@@@BB@@@B@@BABA@ADB@DALBDFBAD@DAB@@DCB@BDAFADED@JAJAFDCBBDCBE@C@BBHDD@DBBDBB@@@BABA@@BBBABABC@@B@DB@@BB@B@D@BBDDB@BB@@B@@ADF@@B@@DDBFHAHDD@BB@@BB@BBFBBBJEHDFBLEJGD@F@BFFBDBFBHDAA@CBCBCDA@@@A@@@ABCBC@ICC@B@DABAH@BABC@A@C@GAC@A@@AAA@AB@@AC@BA@AFCFBJ@AA@CDAD@D@CE@AAABEBA@AC@CAC@A@EA@ABABABCBACAEBEBA@C@@CBA@E@AD@D@B@D@B@BDBBBBBABAD@B@@ABA@A@AC@CA@EEIACCA@CCAAA@ACCCAGC@CDCDAGGICDGCAAGCG@@DE@@@@@A@@A@BABA@@@@@ABA@@BADCB@B@DAB@BA@@B@DAB@B@BA@@B@@AB@@@B@B@@AB@BBBA@@B@B@@@BBBB@@B@@@@A@A@AB@BBB@@@@B@@@@B@@A@@@A@A@@@@BA@ABA@@BAB@BA@B@@@@B@@@@BB@@@B@B@B@@@B@B@B@B@D@B@B@B@B@B@@@DAB@B@@@@@BA@@BB@@@@@@@@@@BA@@@@@@B@B@@@@@B@@@@@@@@D@DDAB@@@@AB@D@B@@AB@B@B@@B@@B@B@DBD@BAB@@@@@BAB@@@B@B@B@@@BA@BDB@@@@BB`DT@@@BA@ABA@ABABABA@A@@@A@A@@@A@E@@AA@AAE@ACEEMAGAAAEA@@AEEAAACAAAACACCIEEAECCA@@CAA@ECAAAACAAACEEGEGAAAAA@CACAKAAAOEA@A@CAMEMGCAECAA@@GKIKAAAEAA@AAG@C@EBIAGBE@A@ADEBEDC@ABABABADCFAPIDAJGHEFEBABABC@ABEBG@E@A@AAGAIACAEACCGCICGAAAAGIAAAACCA@ECAA@@CACAAAECEECE@@CCIEGAAAICA@ECECCAGCOIAAAA@AAEAE@C@A@C@E@E@G@EBC@A@@BABABABAJCLGHEFCBADCDEDE@@@ABC@ABC@E@A@A@AAAAMAGAC@AACAAAA@AA@@@AAEACA@AIEGCAAC@A@A@C@A@IDGBABG@C@G@C@CACAAAAAAAEEKOEECCEEGEABABCDEDCB@BB@BBBBBBBBDD@B@@BB@B@@B@BB@@B@@@@@B@@@A@@@@@AB@@@A@@@@@@@B@@A@@A@@A@@@@@@@@@@B@@@@@@@B@@AA@B@@@@@B@@A@AB@@@@@B@@A@ABAAA@AAAAAAAAAE@A@A@A@@@A@@AB@@AHAB@BBBDFBF@BBD@@@BAB@B@BA@@B@BABA@@@@@@@@A@C@A@A@@AA@A@C@ABAAA@A@A@AAA@@ACCA@AAA@AAA@@@AB@@BB@B@@@@AA@@CAA@A@ACA@A@@@AA@B@@@D@@@@@@A@A@@A@@@@@AACCA@@CAAAAA@@B@@AA@@A@A@@@@B@A@@@@B@@AA@A@@@A@AA@B@@@@A@@@AA@@@@@B@@@@@@@BA@@A@@A@@@AB@@@@CA@@A@A@@@@B@@@@@@@@@@@@CCAA@@@@@@@B@@A@AB@@CHAB@@A@@@"]],encodeOffsets:[[[118706,30271]],[[118675,29856]]]}},{type:"Feature",id:"360428",properties:{name:"都昌县",cp:[116.203979,29.273239],childNum:1},geometry:{type:"Polygon",coordinates:["@@EE@@@ALOAAKK@@ACAA@@AB@@ABA@@@C@A@GCAA@@@AA@BC@C@A@C@CACCAACACAAAAAACAEG@AACAAAA@@AAA@AAAACCACACCEACAAAAEGA@@AA@EA@@AA@@CAG@kEABYRe^EDIH]XEDIFYPMJA@A@E@A@C@CAICA@AACAAACCAAACEKAAAGAE@C@C@E@CBABGBGBCBEDE@ABEBEBCDE@A@CBK@E@ABABIBC@A@C@C@A@EAEAIAEACAA@AAAAAA@@@@@C@@BA@A@@@AAECA@A@@@A@A@EBABCAA@AAAACC@@@@AAACCAAACAA@AAAB@@@@AB@@AJAJ@D@D@B@BFJ@@@B@B@B@@CD@@@B@B@F@F@B@@ABCDCDADABABA@CBG@GBC@S@OBGBC@GBEBCBEBIFABCBABCDCFCDAFABCDEJCFGJADABGFEFA@AB@B@@@BB@@FB@@D@@BBDDB@BDB@@B@@@D@@@@BBBBFFFFJFPNDDB@JDD@LDDBFBRDJDJBB@DBDFFDFDDFLHBDDBFFBBJHLJBDDBDDBDB@BB@B@B@D@FCVAF@B@BAFAJAFALAJAD@DABABCBEFKFCDIDEDIDG@ABA@M@I@S@A@C@AAE@G@EAG@IAC@C@GDA@IBA@GBEBG@GDE@CBC@I@MDA@[FE@EBGBC@CBE@EDA@IHEDABIHAFCDCDGLEDABABCDADAB@DABAD@JAD@B@B@DCFAFAB@D@B@@BBFDB@DDBBBBHJBBBBDHDJDHBDBFBDBJBH@B@B@FAHAF@BADABABEFGFIHCBOJEBCDABABAB@BCDAFCF@B@BAFBHAJ@F@DBH@BBBBFBBJLHL@@BBFDDBNHNFDBB@B@PFBBLBDBDBB@BBBBFHFHDFBBDBBBBBFDB@DB@@DBFDFBJFDDDBBBBBBDBBFF@BB@BFBBBHFNDF@BBF@BBB@@@F@B@@@B@B@@@BABABAB@BAB@BAB@@BA@@BAB@B@D@@@@@BBB@@@@A@ABA@AB@BA@@B@B@@A@AD@DA@@@AB@@@B@@@B@@BDDBH@@@B@B@B@@@@@@B@@@@A@@@@BB@@@@@@BAB@@A@A@A@ABA@@A@@ABADCB@@@B@@@@@@@@BBB@@@@BA@@@B@@BAB@@ABAB@BCB@B@@@BB@@B@@@BB@@BABB@A@@@@@A@A@@@@A@@BA@@A@@@@@@@@B@@A@@A@@@@@AA@@@@AB@B@@@@A@@@@AA@@@AAB@@@AA@@@A@@@@BA@@BA@A@@@@B@D@BB@B@@B@@B@B@@BB@@@@B@@B@@BB@@@@B@@@@@B@BBBB@@DAB@@BBBB@B@@BBB@@B@@BD@@BB@BC@@@@B@@@@@B@BAB@@@B@B@@@@@B@@BB@@BB@@@B@@@@@@B@@@@B@@@@@@@@B@@@B@@@B@B@@@@@BD@@B@@@@@@@@@B@@B@@B@@@@@@BB@@@BA@@@@@@B@AB@B@@@@B@@@@BA@A@@@@B@@@B@@@B@@B@D@B@@@@@@@@BB@B@@BA@@@@@A@@@@@@B@@F@@@DB@@@@@@A@@@A@AAAB@@A@@@@BBB@@@@@@A@A@@B@@AB@@@@A@@B@@@@@@B@@@@B@BAB@@B@@@@@@B@@@B@@@@@B@@@@@B@B@B@@@B@FA@A@@@@@AA@@@@B@@@AAA@@@AB@B@B@@AB@BA@A@@@@BAB@B@@@@@B@@A@A@A@@@AA@@A@@@C@@@@@A@@AB@@AA@@BA@@@AAA@@AABA@@@@@AA@@@@AAA@@D@DAFAB@@A@@B@B@@A@@BB@@@@@B@@@B@BA@@@@@A@@@A@@@AB@@@@@B@@@@DB@@B@B@B@B@@@@AB@@@@@@@B@@@B@@AAC@@@@A@AA@A@@@@B@D@@BB@@@B@@BB@@A@@@@BB@@DBB@B@@AB@B@B@@@@A@@@@A@BA@A@@BAB@@@BB@@@B@@BA@@@@@@B@B@@B@@@@@@@AB@@@@@@B@B@B@@B@@@@@@BA@@B@@B@@@@B@@@@@B@@B@@@@@B@@@B@@@@@@B@@BB@@@@B@@B@@A@@B@@B@B@B@@@@B@@@B@@@B@@A@@@@BB@@@@B@@A@@@@AA@@@@@@@@B@@@@B@@B@@BB@@B@BA@B@@@A@@@@@@@A@@@@@@@A@@@@@@@@BA@@@@@@@B@@@@B@@B@@@@@B@@@@B@@@@@@A@@@@BA@A@AB@@@@A@A@@@AAA@@BAAAA@@@@@A@@@@@@AAA@@@A@@BA@@@@@A@@BC@@@AA@@A@@BC@@@@B@@@@@@AB@@ABC@@BB@@@ABA@@@@@@B@@@B@@@@@@AA@@A@@@AB@@@@@@@@@@BBB@B@@@B@@A@@@@B@@@BB@A@@@@@@@A@B@@B@@@B@BAB@BA@@BA@@@@@A@@@@@@@@BB@BA@B@@@B@@@DA@@BA@@BBB@B@@A@AB@@@@B@@BA@@B@B@B@@B@@@BB@BA@@@@B@B@@@@@@B@B@@@AD@@@@B@@BB@B@@@@@@A@@@A@@@A@@@@B@@@@@B@@BA@@B@@B@@@B@@A@@BB@@B@@D@@A@@@@@@@@B@@B@@@@@B@@@B@@B@@A@AB@A@@@@A@@@@B@@@BB@@B@@@BB@@B@@@@@BA@@AA@@@@@@@@@A@@@@@AA@B@@A@@B@@@@BB@@A@@@@@@@ABA@@@@@AA@@@@@A@@AAA@@@AA@A@@@@A@A@A@@@@AA@@AA@@AA@@@@@@@@@@@A@@@A@@@@@@@A@@@@@A@@@@@@B@@A@@B@@@B@B@@@@BB@@@@@B@@A@@B@@A@A@A@@@ADBBA@@@AB@B@@BB@@B@@@BBB@@B@BBB@B@@A@@B@@@BBB@B@@@@@@BB@B@@B@B@BB@@@BABABABAA@@A@A@@B@@@B@@@@AA@@@@@@@B@BAB@@AA@@@@@@@@@@AB@BA@BB@B@@@@@@@AB@@B@@@@@B@B@@@@B@@@@@@B@@@@@B@@B@@@B@@@@@@@B@B@@@@@B@@B@@@@@B@@A@@BB@@B@@@@@@A@AB@@@@BB@BAD@@@B@@BB@BB@@@@@@B@@@B@@A@@@@@@@BBBAF@DB@@B@B@@B@B@@BB@@B@@A@@B@@@B@@B@B@B@@B@@@D@BA@@B@@@B@@@@@B@@@@@B@@B@@BBB@@@B@@@B@@@@BB@@@B@@@B@@AB@@@A@@A@@@A@AB@@ABA@@AA@@BA@A@@@ABA@ABAAA@@AA@@@A@@A@@@@AA@AA@@@A@@@C@@@A@A@@@AA@@@@A@@@A@@@AAC@@@@C@@@@@@@@@@A@@BAAA@AB@BA@AB@B@D@B@@@B@@B@@@B@@B@@@@@BB@@@@BB@@@@@@@B@@@BB@@@@B@B@@@B@B@B@@@@B@@@@BB@@@@@@@BB@@@B@@@BB@@@B@@@B@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@BB@@@B@@@@B@@B@@@@@@B@BBB@@@@@B@@@B@@@@@@@@A@@@@@@@AA@@A@@@A@A@@@ABBBA@@@@@A@@@@@@@@@@B@@@@@B@B@@@@@@A@AAA@@@AB@@@@AA@@@@@B@BA@BB@@A@@@BB@@@BA@@@@B@@B@@BA@@@@@@@@BB@@B@BB@@B@@@@A@@B@@@@@@A@A@@A@@@BA@AB@@AB@@@@@@AA@@@@A@@B@@@B@@A@@@C@@@@@@B@AA@@AA@A@@@CDA@@B@B@@B@@@BB@@@B@@@B@@@@@BBBA@@B@B@B@@@@ABA@@@@B@@@@@B@BBBB@@@BBB@@B@BA@@@@FB@BB@@@@B@@@BB@@BB@BBB@@BB@@@B@@@@AB@@@B@B@@@@@@BB@BB@@B@@B@BBD@@@@B@@B@@@BDD@@B@BBB@BB@@@B@@BBBB@@@BBB@@DB@BBBB@BB@@B@B@D@@@BD@D@B@@@BB@@@@BB@BB@@@BBB@@@@@B@@BD@@@BBB@@@B@B@BBB@BB@BB@@B@BB@@AB@B@B@@BBBB@@@BDBBB@@@BBBBBB@@BB@BBB@BA@@@@BBB@B@@@@B@@B@B@BBB@B@D@@@@@BBB@@@B@B@@@@B@@B@B@@B@AB@B@@@@BB@@@D@DBD@@@@BB@D@D@B@B@B@BBDB@@@AB@@BD@@@DBBBB@B@@B@@B@@@DBB@F@BA@@BBDBB@@@@BBB@B@@@B@@BBBB@@BB@B@BB@@@B@B@@@BBB@B@@@BBDDB@@@DDB@@BB@DBB@BAB@BB@@B@B@BA@AA@@A@@B@@@AA@@@@@@@A@@@@@@BB@@@@DB@@B@BABBB@@@BAB@@@@@@A@@@@@A@@@A@@@@@A@A@A@@@@BA@@BA@@BA@AB@@AAC@@@ABA@A@A@@@@@A@C@@B@AEAAAA@@AA@AA@@C@@AAA@@@@AB@A@@A@A@@@A@@BA@@A@AC@@AA@@BA@@A@@AAA@A@A@AAA@AAA@@@AA@CCACA@CAA@AAAA@@A@AA@@AA@@@AAAAC@@B@@@@A@@BA@@BA@@D@BBBA@@@@@A@@D@BA@AB@BEBA@@@ABA@@BABA@ADA@AB@DD@B@@BB@@B@B@BB@@BB@@@B@@B@BBAA@@@@B@@A@A@@@A@@B@@@@AB@@@@@B@@@@AA@BC@AB@B@B@@@B@@@BBBBDBFAD@@@@A@@@AAAAA@A@@AC@A@@BA@@AAAC@@@ABA@@@@BAB@BC@@@@@A@@@@@A@@@@@A@@@@@A@@@A@AA@@@@A@@A@@@B@@A@A@@A@@@@@BA@@@@@@A@@@@A@@@AB@AA@AA@@AA@@@BABB@@B@@BBB@B@A@@BAB@A@@A@@@A@@CAAAAA@@AC@@@@@B@BA@@@@AA@@@@A@A@@@A@@@C@@@A@@@@@@FAB@@@BABCA@AB@@AA@@@@BA@@BA@A@@A@@@@@A@@@@ABA@A@@A@@@AB@@@A@@BA@@AA@@A@A@@@AB@@@@@@A@A@@@@@@@@@@@@@B@@A@@BB@@@AB@@A@@AA@@AB@A@@A@@@AB@@A@B@@AB@@@@@@@@@AA@BAAA@AA@A@AA@@@A@@@@@BC@A@@A@@@A@@A@@BB@@@CDA@A@@BAB@@@BA@A@@BAD@@@BA@@@@B@@@DA@@BB@AD@B@@@@ABAAAB@@@DCBAAA@A@A@@@A@@BA@A@@@A@A@CAA@@A@@AB@@@@@@A@AAA@A@@@A@@@@@@AA@@A@A@@A@@@@@@AAABA@A@A@@@AB@@AB@@A@A@@@A@@@@A@C@A@AAA@A@AB@@A@@AA@@AA@A@@AA@@@@A@@A@@@A@@@ABA@@@AA@@@@A@@@A@@B@@@BA@A@AA@@@AA@@A@@A@@@AA@@AA@AC@@BC@@BC@A@ABA@A@ABABA@@BB@AB@@BB@@@@@B@@@D@B@@@@@B@@@@@B@BAB@@@@A@A@@@@BA@A@@B@BA@@B@@A@B@@@@AB@@A@@@@BB@@@@BA@B@@@@@@@B@@@@A@@@A@@B@@@@@@AB@@@@@@@B@@@B@AAA@@@@@@@BA@@BA@@@@@A@AEA@@@@@BA@@B@A@@@ABABA@A@A@A@A@ABA@@@A@@@@DAB@B@BA@@AC@@@AA@@ABA@@B@@ABA@@B@@@BABA@@A@@@BA@@@@@A@A@@@@BAB@@@@A@@@@B@@@B@@@BA@@B@@@@@@@@A@@B@B@BC@A@@@@ABAA@@A@B@B@@@@ADA@@@A@@BA@@@ABA@@@@CC@AA@@AC@CCAA@@AB@@@@A@@@A@AA@@@A@@@AB@B@DAB@@@BA@@BA@A@A@@@A@C@CAE@@A@A@A@A@@B@@@B@@A@@@@B@@@@ABCB@@@@@@@A@A@@A@ABA@@A@A@@AB@@@@BB@@@@A@@@A@@B@BABAA@@BA@AA@BA@@@@@A@@@@BA@@BA@A@@A@@AB@BCA@@@@@@A@@@@@A@@@@@AII@@@@FADA@AAA@@@ADABA@@@A@A@AB@@ABABC@@@@@ABADC@ABABAB@BABAB@BADAB@@@@@@@AA@@@@@A@@@A@@@AB@@@B@@B@@@@BC@AA@B@@@@@B@@@BAB@@@@C@CAC@AAA@C@C@@@@B@@@@@@AB@BB@A@@AC@A@AB@@@B@@@BABAA@AB@@@@@@DC@@@@@@B@@AB@@AB@@@@AB@@A@@@@@@B@@A@@D@@B@@@A@@@A@@@@BAB@BADAB@BCB@@A@A@@@A@A@@@@BA@@@@CC@@@@B@@@@@B@@@@@BBB@@@BBBA@@BA@A@@@A@@@@@A@C@A@@A@@ABA@ABA@A@AAAB@@@@A@A@A@@@AB@@@@@B@B@B@B@@A@AAAB@@@@AA@@A@@@@AA@@@@B@@A@@@A@@B@B@@BB@BB@@B@@AB@@A@@B@@BB@@@@A@A@BB@BB@@NEhSbSYQwi[UA@KIGE[SIGKGIGIGA@×@OWGKMU"],encodeOffsets:[[119250,29827]]}},{type:"Feature",id:"360429",properties:{name:"湖口县",cp:[116.251947,29.731101],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@BC@AAA@@@@BAB@@@@@@@@AA@@AB@@@@A@@@@@AA@@@@@A@A@@@@@@@A@@@A@@@@A@@@@@A@@@@A@@@@@@A@A@@@@@AA@@B@@@@@@@AAAB@@ABA@@@@@@@@@@BB@@BA@A@A@@@@@@BB@@@@@A@@@AB@B@@@BBBABABA@A@@AAA@A@@@@AAA@@@@@@@AAA@A@@@AB@@@@AAA@A@AA@AA@@A@@@AA@@@ABA@@B@AABC@@B@B@B@@@@AB@@@@A@@@@AA@@@@@A@A@@@AB@@@@A@@@@B@@@@@B@@@@@@@B@@@B@@@@@@@@@@@B@@BB@@BB@@B@@B@B@B@@@@@@BBB@@B@BB@@@B@@@@BB@@@@B@BA@@@@@@B@@@AA@@@@@AB@@@@ABB@@@@B@@@@@@@@@B@@BB@@A@@@@@AA@@A@@@AA@@A@@@A@@B@@@@@@BBAB@@@@AA@@@A@@@@@A@@@@A@@@@@@B@@@@CA@@@AA@@@BA@@@A@@@@AB@@AA@@@@@A@@@@@@B@@@B@@@B@@@@A@A@@AA@@@@@BC@@A@A@@@@@@@@A@A@@B@@AAA@@A@@@@A@A@AB@@AA@@@@@BAB@@@@AAA@AB@@AB@@C@@@A@@@AABA@@A@@@@@@B@@@@@B@@AB@@ABA@A@A@@@AA@B@@@@@@@B@A@@A@@@A@@B@@@@A@@@AAA@A@@@@@@@@A@@B@@@BB@@B@@@@A@@@A@@@@@@@AB@B@@AA@@AD@BA@@B@@@@A@@@@@AD@@@BB@@B@@AD@@@B@@@@AB@@@B@@BB@B@@@@B@@@@@B@BBAB@@BB@B@@@B@B@@A@@B@BAB@@@@@B@@@@A@@@@@@A@@@@@AA@@@@@@A@@@@@@AB@@@@@@@@@B@@@@@@@B@@@@@@@B@@@AABA@@@AA@@@AA@@@@@@A@@@@@@B@@B@@B@@@@A@@A@@A@@B@@@@A@@@A@@@A@@A@A@A@@@@AB@@@@AA@@@@@AA@@@A@@@@A@@@A@@@@@A@@@@A@@@@@A@@A@@@@AB@@A@@@@A@@@@A@A@A@@@@A@@B@@@@@@@AA@A@@@@@@@AB@@@A@@AA@@A@AB@@@BABB@@@@@@B@@A@A@A@@BA@A@CA@@AA@@@@@BA@@AA@@@A@@AC@A@@@@@@BBBB@@@@@BD@BA@@@A@@@@@@@A@@B@@A@A@A@A@@@CA@@@@@A@@@@BA@@B@@@B@@@@@B@@A@A@@@A@@@@AA@@@BA@A@@@@BA@EBCBC@@@BB@B@@B@@B@@@@ABBB@@BB@B@@AB@@BB@@BA@@@B@@@@@D@@@BB@@B@@@B@B@BA@@@@@A@A@AB@@@@@BABA@@BA@A@A@@B@@BB@B@@@A@@B@@B@@@@@BEBA@@@A@A@A@@@@@A@@@@@A@@@A@@@@@@@@AA@ABA@@@@@@A@@@@A@@@@B@@A@@BA@@@@B@B@@@@A@AA@@@@@BA@BB@B@B@@@B@@@@A@@C@@@EA@@@@@@@@B@@@@AB@@@AAA@@@@@@@@@A@C@AA@@@A@@@A@@@@@@BAB@@@@@A@@A@A@@B@A@@@@B@@A@@AA@@@@@@@AA@@@@A@@@@@@@@@AC@@A@@@@@A@A@@@A@@@A@@@@@@A@@@@@@A@@@@A@@@A@@AA@@AA@@@@@A@A@@@A@ABA@@@@@A@@@@@ADA@@AC@@AA@@@AA@AA@A@AA@AA@CB@@AAAAA@@@@@A@@@@@AA@@@AA@@@@@AA@@@A@AA@@@@AAAC@A@@@@@@BAB@@AB@@@@@B@@BB@@A@BB@@B@@B@@B@@@@@@ABA@@@@BB@@@@B@@@@BA@@@@@@@@@@BB@@AB@@@@@@B@B@@@@@BAAAB@@AA@@A@@@AA@@A@A@ADA@AB@BA@AB@@@A@@AB@@@@AA@A@@@@@@A@@@A@CDAB@BB@@@AB@B@B@B@BA@AB@@@@@@AA@@@@@B@@A@@@@@@@@A@A@A@@AGCC@AA@@@A@@@A@@B@@CBC@@B@BA@A@@@ABA@@BAB@B@B@@A@AA@@@@C@A@A@AB@@AB@D@B@D@D@H@HAH@B@B@BAB@DAD@@ABAD@BADABAD@BAF@DABAHAH@D@FBHBH@DDH@BBD@BBBFF@BBBBDDB@BBBDDBDBDBBBD@BBDBBBB@BBBBDBD@BBBBHBB@BBD@DBFB@@BBD@DBBBDBDDF@BBDDDDFBBDDFFBDBFB@DHBDBB@BBDBBBDBDBBDLBFDF@BBB@@BHBDFJ@DBDBBBFBB@BBB@BBB@BBDBDDH@BDFBBB@BBBBB@FBBDFBFFDBB@DBBDDB@@@BB@B@B@DBFD@BBBDB@BBB@BFFHFFDFFHDHFB@@B@BPDTHPJLFPLJFJHHHNNTPVPNFTJdN^LF@@ADG@A@@BEBA@A@AA@@@CAAAA@@A@CACAA@@AAA@AA@AA@B@@@DA@@DA@A@@BA@A@@@@BA@BBB@@B@@@BA@@BBB@@@B@BA@@FBBABB@AB@BA@@@@@A@@@@@A@@BA@@@C@A@@@@CAAA@A@@@A@AA@AA@AA@AB@AA@@ACCAAB@AA@AB@@@B@B@@AB@B@D@@@@@BA@@@B@@@B@@@BB@@@@@@A@A@ABA@A@A@A@AAA@A@@AC@AA@AE@@@A@@B@@@B@@@@@B@@A@@@AFA@@B@@A@@@@@@@@A@@@@A@@@@@@@@@@@@@@AA@@@@@@@A@@@A@@@@@@@@BA@@@@@@@@@A@@@A@AA@@@A@@@@@@A@@@A@@AB@AA@@@@B@@@@@@@@A@@@A@@@@@@A@@@@@@@@@@B@@A@@@@@A@@A@AAB@@@A@@A@@AA@@A@AA@@A@@@@@@ABA@@@AB@AA@@@AB@@C@A@@@@AA@@@@@A@@BBB@BBB@B@B@@@B@B@@AA@@AD@@@B@B@@@@A@A@C@AB@@@@AB@@@@@@@@A@@A@@A@@@@@A@@@@@ABA@@@A@C@@@A@@BA@@B@BA@@@A@ABA@@AEBCA@@A@@@@@A@@@@AC@A@@@A@AB@@@@A@@B@@A@@@A@A@@AA@AAA@A@ABA@A@ABA@@BAB@B@B@B@@A@@@CBA@@@AB@@@DA@@@CAE@A@@BA@A@@AAA@@@@AA@@@BA@AA@@AAAAA@A@@@AA@AC@@AC@@@@@AB@@AB@DA@@@@BA@@@A@@AAA@@A@@@AA@BAAA@@@A@A@A@@@AA@CAA@A@@BA@AB@@AF@BAB@@@@A@C@A@AAC@A@@@A@@@ABA@@B@@ABAB@@AAA@AA@@@A@A@@@@@AC@@@@AAA@A@@@@AAAA@@AAAA@@AAAAA@AAA@@@A@ACA@@A@A@AAAAC@A@@A@@ACA@@@@BA@@@AAAC@@@@A@AA@@@AAAA@A@A@@B@B@@@BA@@@A@@@AB@@AB@@A@@@A@@@@BA@@B@B@BA@@B@@@@@@B@@@@B@B@@@@A@@@A@@BA@@@@@AB@@AB@B@@@@@B@@@@B@@B@B@@@@@BBB@@@B@@@B@@A@@AA@@C@@@@A@ACA@@@A@@@AB@B@BA@A@@B@DCB@DAB@D@@AB@BA@@AA@@@@B@@@B@@@@@B@B@B@@@@AA@@@@AEAA@@AA@@A@@A@AA@A@@@AA@CAAAAA@@A@BABA@@BA@A@@@@@A@A@@DB@A@@DA@ADCFG@A@A@A@AB@FA@@@@@EBCD@B@@A@ABA@@AAA@@@AAA@@AAA@A@A@A@@AA@A@@AC@@@A@@@@AA@A@@AAA@@A@@A@@A@@@A@CAC@@C@A@A@@@AAA@AA@ACA@@AA@A@@AAAA@@@A@@AAA@AAA@@@CC@A@@@AA@@@@@AC@A@AA@@@AAA@@A@@@@A@A@@@A@@B@@A@@@A@@AA@AAA@@AA@@A@@@A@@A@@AEA@@@@ABA@@@AA@A@@AAAAA@@@@@A@@@@@AB@B@@A@A@A@@@ABAA@@@@A@@@A@@@A@@A@@@AA@A@@@CB@D@@@BBB@@BBA@@@@@@@@D@@@BA@@@A@@@@B@@B@@B@@@@A@@BA@@BAB@@B@@@@B@B@@@@A@@@@B@@A@@@AAA@@@AA@@@@@@@@AB@@@AA@@@@@AB@@A@@A@@@BA@@AABA@@@@@B@@B@@A@@B@@BB@B@B@@@@A@A@@@@@A@@@@@@@@@@@@B@@@@ABAA@B@@@B@B@@@BB@@B@@@@@@@B@@@@@@A@@@A@@@@@A@AAA@@@@@@@@AA@@@@@@A@@AA@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@A@@@A@@@A@@A@@@A@@AA@@@@@@A@@A@@A@@@@@@A@A@A@@@A@A@@A@@A@@@A@@@@@@AA@@@@AA@@@@A@@@@A@@@AA@@@A@C@A@A@@BABA@@BBBAB@@@B@@@@@@@@D@@@@@BD@B@@@B@@@B@@B@@B@@@B@B@@@D@@@B@@BBB@@B@@B@@@@B@@BB@@BBAB@BAB@B@@@BAB@@BB@@AB@BA@@B@B@@@BB@@@A@@BA@@@A@@@A@@A@@A@@@A@@@A@AA@@@AA@@@@@A@@@@@A@@@A@@@ABC@@@A@@@@A@A@AA@@@A@@@@BA@@@AA@@@A@AA@A@@@CAE@ABAA@@@@@@B@@@@A@@@A@@@@A@@AAA@@"],encodeOffsets:[[119071,30283]]}},{type:"Feature",id:"360430",properties:{name:"彭泽县",cp:[116.56438,29.876991],childNum:3},geometry:{type:"MultiPolygon",
+coordinates:[["@@@@@A@@@A@A@AB@@A@A@@@@A@CB@@AA@@@@BAAABC@@@A@A@@@@@@@ABC@@@AB@@AA@@@A@@@A@@BA@A@@@@@@BA@@BABA@@@A@@BA@@@CB@@A@AACBAB@BA@@@AB@@AB@@AA@@@@AB@A@@A@A@@@@@A@@@A@@BAB@@CA@@AA@@A@@@@A@@ABA@@B@@@B@@A@@@ABCBA@@AAB@@@B@@@@A@@B@@@D@B@@B@@B@B@@@B@@ABA@@@A@@@@B@@@@@B@@@@@B@@@BA@AB@@AB@@A@ABCBC@AB@@@@A@@@A@AAA@@AA@@@@AC@AAE@@@A@@B@@AB@B@BA@AAA@@@@@A@@@@@ABA@@B@@AB@BA@AB@@A@@@A@@@@@BA@@AA@A@@@A@@A@@@@BA@@BA@@@AB@@@@C@@AAD@@A@@@@@@@AB@B@@@@@@@BA@A@AA@@@BA@@@AA@@@@@@AA@@A@A@@@A@@BA@@B@@AB@BCD@@A@@B@@@B@@AB@@A@@@AA@@A@@@A@@BA@@@@D@B@@@@@B@BAB@B@@BD@BA@@BAB@@AB@@AB@@@@@B@B@B@@@@@B@@@B@@@@@B@@@@A@AB@@A@AAABA@@@CA@@@@AA@@@@@@@B@@@@@@BB@@A@@@@BB@@BABA@A@@@AAA@ABA@CAA@@AA@CC@@A@CCAA@@A@A@AA@@A@A@@@A@@A@AAA@@AAAA@@@A@@@AAA@A@@A@CAAA@@ABE@A@CA@@A@@@@AA@A@AACA@@C@@AA@@B@@CAAAA@A@A@C@C@A@@A@@C@CAC@@@A@@A@@A@A@@B@AA@A@@@@A@@A@A@@@A@AA@@@@C@A@A@AAA@A@@@@A@@A@A@AA@@@@ABA@AAA@@AA@AAAA@A@@AACA@A@@AAAA@@A@C@AD@F@@@@EBA@@B@B@B@BEHCD@BCB@@@BCA@@@B@B@@@@@BAB@@ABABB@@@BBBBDBB@@B@@@BBBB@@@@BB@@BB@FB@B@@B@@B@@A@A@A@@@@@A@@@A@@@@@BB@@ABA@@BC@A@CBA@CDA@@@@BABA@A@@B@@@B@@DB@B@B@@D@@@BB@@@BA@@@A@@@A@AA@@@@A@A@@@@A@@A@@@@@A@A@@BA@@B@@@@AB@@@B@@@B@@A@A@@@@@@A@@@@A@@@ABA@A@@@AB@@@@@B@@@BA@@BA@@B@@@B@@AB@@A@A@@@@B@BBBBB@@B@@B@B@@D@BB@B@@AB@@@@DB@BB@@@@BBDBB@B@B@BB@BDB@@@B@BBB@BBBB@@BBBB@@BBBB@@@@@BBB@B@@D@@B@@@@@B@B@@BBB@BB@@BABA@@@AB@BA@@B@@@B@D@BBB@D@B@@@@@BA@ABE@@BAB@@AB@B@DBB@@B@@@B@B@B@@BBABB@@B@@@BB@BB@@@B@@AB@@@@CBA@@BA@@B@@@@BD@@BDB@@B@@@BBBBB@BB@@BAB@@B@@B@@B@BB@@@BAB@@@BBF@D@@CB@@A@@B@@AB@D@@@BA@A@A@A@AB@@AB@B@BAB@B@BBB@BBB@@@B@B@@@BA@@@@B@@A@@B@B@@@BBD@@@@@B@@@@@BB@ADBF@@AB@B@B@@ABA@@@AB@@@B@@@D@B@@AB@B@@@@@B@@@@@BB@@@@B@@@@@@A@@B@@A@@B@D@B@B@@A@A@@@C@@BB@@BA@A@@@A@A@A@AAA@AA@@@B@@@@BB@@@@@B@DA@@B@@BBA@@B@@AB@B@@@@B@@@BBB@@@BB@@@BB@@@A@BBB@@@@B@@@@@BA@@@@@@@@@@@@B@@@@B@@@B@@@@@@@@@@A@@B@@BBA@@@B@@@B@@@@B@@@B@@B@B@@@B@@@@@@@@AB@@@@@@@@@B@@@B@@@@@@BB@@@@@@@@@@@@@@@B@@B@@@@@@@@@@BA@@@EB@B@@@BA@@@@@A@@@A@@@@B@@BFB@@BBD@@@BBB@B@B@B@BAB@B@B@B@@@@A@@A@@@A@@@A@@AB@@@@C@A@A@@BA@A@@@A@@BBBA@BBDD@BB@@BBAB@@BBBB@@B@B@@@BBBDB@@@@@B@D@@AB@@@B@@@@@B@@@@ABA@@BAAABEA@@ABA@@@A@AA@@AB@@A@@@AA@AAB@@@@@BAB@@@BCB@@CB@@A@B@@BBBB@BB@@BBBD@D@BB@BBDB@@B@@B@BABAF@@@BCH@BE@@@rVDBNHXLJDLJLF\\RLBNBP@R@dAXBLB@@F@NFLH@@JHHTFJBL@BJfBLD\\Fd@DATD`L`HRHNBBT\\PNNHTDHBfFH@J@TBJAPCXGDAXMD@NGLELCLANBD@ZFLOHAJBD@DCDEBGBE@CDCDADCJ@BBDABGFMFIFIDGDCBABI@ABA@@B@@@D@DBFBHDJDHDHDF@D@BC@ECCAC@A@ABA@@@A@A@@BC@C@AAABC@AA@AA@C@@@A@A@@@AAA@@@@DA@@@AAA@C@A@ABA@A@A@A@A@A@A@AB@DGB@BAB@@@B@BB@B@BCB@@@B@B@D@DBF@@@@H@B@D@DAB@@@BBDBB@@@FDFBFFHDDBB@HBDCHCRIJE@ADCDCDCBCBABABEBEBE@GAICGCECEAG@E@A@GEQEI@CCGAIACGKEECCGGEEEIEEAACEIIGEA@GGCCEEGCIGAAACCEKOEMAECCCEAAEGKEGCEEECCCCA@@ECKAEBCBCDEFCDMBG@KIEEGG@ACIACAGCIKIKAECCAECAEIE@@ACEGIOECAACAA@KCEAAACAACCG@ACGAAEICGCE@CCCAGEEIACAGACACC@A@CBGDCT@NDHEFE@ABA@GAGEEECUQEEGECGEGAE@ABGFEB@FCFCJEHCFEHEFCBCDAFEBGOIA@@@A@A@@@@A@@@A@@CA@A@@AA@@@@ABA@@@@@@D@@A@CB@@@@A@@A@@A@@B@@@B@@@BA@@@@A@BA@@@@@@B@@@B@@B@@@@@@@AB@@@AAAA@@@B@@AA@@BA@@@@@A@@@@BABAB@@AB@@A@@@@@AAA@@@@@@@A@A@AB@@A@@@A@@A@@@AB@@AAAA@@@A@@@@A@@@@AABA@@@ABA@C@@@@@A@@@A@AA@@AAA@@@@A@@AA@@@@@BA@@@A@A@A@@@A@@@A@@BA@@B@@AAA@@@AA@A@@A@@@@@A@A@@@A@@A@A@@@@@AB@B@@@@@B@@@@@@CBA@@B@A@@A@@A@@C@A@C@@@@BA@@@@@A@A@ABA@A@@CB@AAABA@A@A@@@@@B@@AB@@@@AAB@@@@A@@@A@@@@A@@@@@B@@@@@@A@@@@@@@@@BB@@@@@@@@@B@@@@B@@@@BA@A@@@ABA@"],["@@AABA@@AA@@@@A@@@@AAA@@@@A@@B@J@@ABAB@@AB@B@@@B@@@@@@AD@B@@@B@@B@B@BBB@B@@C@A@@@A@@@@@@@AB@@@@@@A@A@@B@@A@A@ABA"],["@@@ACC@AA@GDAB@@@@@ABA@@@@A@C@A@AA@@@@AB@@@BB@@BB@@@AB@BBB@@@D@@@B@@BBD@@@BAB@D@DBB@@A@@@ABC@@@C"]],encodeOffsets:[[[119419,30295]],[[119508,30413]],[[119519,30405]]]}},{type:"Feature",id:"360481",properties:{name:"瑞昌市",cp:[115.681335,29.675834],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAAACC@ABA@@A@ACAA@@AAA@@AA@@C@A@CAA@ABA@@@@A@AA@@@A@A@ABA@A@@@A@A@@AC@A@@AAAA@A@@@@A@A@@@@@AA@@@AA@@@AB@@A@@@@AA@AA@@AB@@@B@@@@@@@BA@@@@@@B@B@@AB@@A@A@ABA@@BA@A@@B@@@@A@A@ABA@@@@A@@@@C@A@AB@B@@@@@B@@ABA@@BA@@@A@A@@@ABAB@@CAA@@@A@BA@@AA@@@@@A@@@A@@@@@AA@A@A@A@@@A@@@A@ACAA@@@@@@@@BABA@@AA@@AAA@@@AA@@BA@@@@BA@@@A@@B@@@@C@@@@AAAA@@AACC@@@AAA@@A@@A@@AA@A@@@ABAB@@A@A@A@A@@AAA@A@@@A@@@BA@@AAAAB@BA@@@C@@@@D@BA@@@A@@@AA@@@@A@A@@@@BAAA@@@AA@@@AA@@@A@@BA@@AC@A@A@@@A@@@AA@@@@A@@@C@@@@AA@@A@@@AAA@ABAB@@A@@@AB@B@@A@AB@@A@@@ABA@@@A@@@@@@@A@CBAA@@@BA@@A@A@A@@@@A@@@@@AB@@@@AB@@@AA@@@A@@A@@A@@B@@@B@B@B@@A@@BAAAA@@AA@@@AA@@A@@@@A@A@@@AB@@@@CA@@A@@@A@@@@B@BAB@B@B@@@@@@AB@@@BB@@@BB@B@@AB@@@@@@@B@@@B@@A@A@@B@@@@A@A@A@@A@@AA@@AA@@A@@@A@@@A@@@@BAB@@@@A@A@A@@@A@CA@@@@@@A@@@@@CBA@AAA@@BA@@B@@@B@@@@A@@BA@@BC@@@@@A@@@AB@@@@@B@@@B@@C@@@A@@B@@@@@@@BA@@@@B@B@@B@@B@B@@A@BB@@A@@B@@@B@@@BB@B@@@@@@BBD@@B@@B@@A@@@@@AB@BB@@BB@@B@@@B@@@@@BB@@D@D@BB@B@@@@@BDB@@BBB@@@B@D@@@BABABAB@B@@A@AB@B@B@B@B@D@@@B@B@BBB@@BB@@@BBB@B@B@@AF@BA@@B@@@@@BABA@@B@@CB@@A@@@C@C@AAAA@@A@@@A@@@CBA@ABA@@@@@@AA@@@@B@BBB@B@@@B@@A@@B@@@@AJ@B@@B@@@@@@@BBBB@@B@@@AB@B@B@@B@BB@@BB@@B@@@BB@B@@@B@@@D@B@@B@BBBB@@BB@B@@B@@BB@@BA@@B@@C@A@@@ABCD@@A@@B@B@@AB@@C@A@@@@@@BA@A@AA@BA@A@CA@AA@@@@@@@@AA@A@A@C@A@@@A@@AA@A@@@@@@BAB@@A@@AABCA@@A@A@A@C@A@@AA@A@AA@A@AAA@@C@@@AB@@AB@@A@@@A@A@A@A@AA@AAB@@A@@@A@A@A@@@C@E@AAAAC@A@@@AAABE@@@A@@@A@@@A@A@CA@AAA@A@@BABAB@BAB@@@BA@@@AAA@@A@@@CBA@A@@@@B@@ABABABC@AB@@AA@BA@@BC@@@@@AA@AA@@@@@AAA@@@AC@@@@@A@@@AAA@@@AAC@@@AAAA@@@AC@AAAAA@@@@CBCBABA@CBC@CD@@A@A@A@AB@B@@@BBB@@@@@BC@@BAB@@A@@BIFA@AB@@@DCBC@ABA@AA@@@@@AA@C@@@A@@@A@@@ABABA@C@C@AB@@AB@BABA@@BA@A@ABAB@@A@@BA@@@@@IHDDDD@JEJCJBHAH@J@H@JBF@BBJFJ@BA@@B@F@@@@@BA@ADAB@@@@@B@B@@@B@@@@@@@@DB@@B@B@@B@@@@@B@@@BA@@B@B@B@BBB@@BBB@HD@@@@B@@@@B@@@@B@@@@@B@@@@@BA@@@@B@@BB@@@BBB@B@@@@@@FAFA@ADGBEBEAEAEAGAG@E@A@@AEAAAAAC@@A@@A@@@@@@@A@@@@@A@A@ABCBCBCBA@A@CB@BABB@@BHBJBBBB@@B@@@@AB@@ABAB@B@BDF@BBBBBB@BBBBBB@B@BA@ABA@@@A@@B@@@D@B@@BD@B@B@BAD@B@B@BBBBBBB@BB@B@B@BBBB@@DDBH@D@F@BADGDC@A@ABE@EAIBE@EBGFCDEFABAHAD@FBFBFDFBBFDFBL@HELGDEDCDIJIFEJCHAJ@J@B@F@JCDABAH@JDHBJAP@NBL@HAJALCHDJBNBLDNBJ@HAFABG@G@IEGAGBKDEBCHGFAH@@@B@FC@AB@@AB@@@B@@BFH@BDBB@BB@@B@D@@@BFB@DD@@BD@@DBB@@@@B@D@@@@D@B@DABA@@B@@@@@@BB@BA@@B@@@BBB@BBD@B@FFDHHDPFD@D@FADBBBBBB@BABA@@B@@@DB@@B@DADCBA@@BBBBBB@BBB@BAB@BFBBBBBNNFFBBJD@@DBDBFFB@BB@@BAD@@@B@@@@@HH@B@B@@HFJDDDDDDFDFDDBFHFHHHHBBFF@BDBDDHFJFJDFFHDHD@BHFFFDFCV@@@FELAHBHBJDHDHDH@DAHBFBFBJ@HFH@@HFBDHDFBD@DBFBPDBAB@BA@@@@@@ABAD@BBB@B@F@@BB@@@@@B@@BB@B@@@BDJ@BB@F@JAF@B@JANAPBD@J@B@D@F@JBD@H@LB@@L@B@L@P@D@HBL@D@J@DBF@JB^DFBFBF@FBJ@DB@@BM@C@A@AB@@A@A@A@@B@@A@@@A@@BA@@@C@A@A@AB@B@@@@@BAB@@@@@AC@@BA@ABA@@@@BA@ABA@A@@BC@@BADEBADADABABA@A@A@A@@@AAA@A@@BABAFCDA@AFE@ABABAB@FEBABAB@NGFEDABAFABAD@BA@@DCBCFK@@@C@@@AAA@ACCAACCGEAAACAA@@AA@@A@@A@C@@@ACAAACEAAA@@EBA@@D@D@DBDADA@@@A@A@AD@@@@@@A@AA@A@@A@@@AB@BAB@BA@@@@CAAA@@A@CB@@AA@@@BA@AA@@@ABA@AB@@@@@AA@@AA@@@C@A@A@C@@AAAAAA@@BAA@AA@A@@BA@@B@@A@@B@@ABB@@@@B@@AB@BCB@@@@@@A@@B@B@@@B@BABB@ABB@@@ABA@CBA@@DAB@@@@@D@BBB@BBB@@@BA@@BBD@B@@@B@@@F@HBD@@@@@@AB@BA@BD@@@B@D@@@B@F@DBD@B@D@BB@@BBB@@@BBFFB@BBLH@@B@FA@@@A@@AAEE@A@@@ABCBEBC@C@A@@AA@@@@ABAB@B@@C@C@A@IBA@A@A@CAAAAAA@AC@@@@@AB@L@FA@@B@@A@@@CAA@@A@A@A@A@AAAA@@A@AAA@A@@@AA@@AAA@CBA@@AA@@@AAC@A@@@ABA@ABA@@@A@@@A@A@@AA@A@@AC@E@AAA@@A@@ABA@@@@B@JA@E@@@AEAA@@ACA@@@A@@ACAAA@@@A@@@A@@A@@@AB@@@B@@AB@@A@C@A@CAEA@@AAAAAA@@@A@ABA@AAA@@AAA@A@A@@@@@@BBJ@B@@@@@BEBA@AA@@@AAA@AAA@AA@@@CA@@@AA@@EAABA@@B@@@DB@A@@@A@@BCBA@@B@@AB@@A@A@A@A@@A@A@@@A@A@C@ABA@AAI@@@ABCC@@@@AAA@@@DCAA@@A@AA@AA@@AB@@@AAA@AA@@A@@@A@A@AA@@@@A@@@AA@@@BA@@@A@@@@AB@@@BA@@@A@@@@BCDAA@@@AAA@@@@@@@A@A@@@AB@@AB@@AD@B@B@D@@@@ABA@AB@@@@AA@@@@@A@@A@@@@AAA@A@@AA@A@@A@@@AB@@AACA@@BCB@@@BC@@@@@@A@A@@@@@A@A@@@@@@@@A@@@C@@DA@@@AA@@AA@A@A@A@@@AB@@ABAD@@@@GA@BAAA@@@@@@B@D@@@@@@C@A@@@A@AA@AAA@A@@@@A@AA@@AB@@AAA@@@A@@@AAAA@@BABA@@@@@A@A@A@@@ABA@@AAA@@A@@BA@A@@AAB@@ABA@@B@@@BAB@@@DA@@DB@@B@@@@D@@BA@@BA@@BA@@@@@AB@@ABA@@@@BAA@B@@A@ABAB@@CB@@@B@@@BAB@@@@AB@@A@@B@@@B@BC@@B@BA@A@AB@@AB@@A@ABA@@DAB@B@@A@A@A@A@@@A@A@@@AD@@@BA@A@@@AAC@@BA@AB@@A@@BC@A@@@A@@B@@@B@@AB@DCB@BABBB@@AB@@B@A@@@A@@B@@@@CB@@A@@AA@@A@EA@@A@@A@@@ABA@@B@@@B@@@@AB@BCB@@A@AB@B@BABA@@@A@A@AAAA@@A@@@CA@@@AA@@A@A@@@ABA@@@@BA@A@CA@@AAC@AAAACC@@A@A@A@AAAA@@@BA@A@A@C@A@@@@@CB@@@AC@@@AB@@A@@@@BA@@@CCA@A@A@C@@AA@A@AAA@A@A@@@@@@@AA@@@@A@@@AAA@AA@@@AA@A@@AA@A@@@AAA@A@@@A@C@A@A@@AA@ABA@C@A@@@@BA@@A@@AAA@CA@AA@@@AAA@A@A@AAA@A@AACA@@C@@@@@@@@B@@@BDHBB@B@B@@A@@@CAEACACAAAA@A@GBA@AAA@@@A@A@A@AAA@AAC@@@@@@C@@B@BC@@BA@@@@@A@@@AAC@C@AA@AI@A@A@@BA@ABA@@@@@@@@@A@@BA@@B@@@@@@A@@B@@@CC@@A@@@AAA@@AC@AAA@@@ABA@@@A@CA@@@B@BBBABA@@BA@@@A@@@A@@B@@@@A@A@AB@@AB@@@@BDB@@B@B@@A@@BCBC@@@E@@@A@@D@B@B@B@B@@@B@B@@@B@@@@C@C@AAAA@@A@A@A@AAA@AAA@@@AAA@A@AAA@CAA@CAA@A@AA@@A@A@A@@@CCCA@@@@@@@@CA@@A@@@ABABABA@@@AAC@@@A@AB@@CAAAA@A@@@A@@@@@A@@AABAA@BA@ABAB@@A@AAAA@@@A@@A@CA@@CA@@A@@AA@@@@A@@@@@@@AAAAAA@@@C@@AAAAA@@@@@AB@@A@CB@@A@@@A@AA@AA@@E@@AA@CA@@B@@A@@@A@@@A@ACABC@@@A@@@A@@CAAAA@C@C@AAE@@@@AA@@A"],encodeOffsets:[[118219,30176]]}},{type:"Feature",id:"360482",properties:{name:"共青城市",cp:[115.808844,29.248316],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@C@@B@@@@@@@@@B@@@@@@@B@@@BA@@@@@@B@@A@@B@@@B@@@BA@@@BB@@@BA@BBA@BB@@@@@@ABA@@@AA@@@BA@@@@@@@@@@@A@@AA@@@@@@A@@A@@@@@A@B@AB@@B@@@@B@@@@A@@@@@@@@@A@@@@@@@AB@@@@@A@@@AA@@@@@@@@A@@@@A@@@@B@B@@A@@@@@@B@@@B@@@@@@@@A@BB@@@BA@@B@@@@@BB@A@@@@@@B@@BBA@@@@A@@A@A@@B@@@@@BB@AA@@@@@BB@A@@@@@@BA@@@A@@BB@@B@BB@@@@@@BA@@@@@@AA@@@AA@A@@A@A@@@@@@AA@@@@@A@@@@A@@@@@@AB@@@@@B@@@@@@@@@B@@AAAB@AA@BDA@@@@@@@@@@@@@@B@@@B@@@@@@@@@@AB@@@@@@@B@@@@@@@@@B@@@B@@@@@@@B@@@@@@A@@A@@@@@@A@@@@@@A@@@@@AA@@@@@A@@@@@@@@AA@@@A@@@@B@@A@@B@@@@A@@BA@@@A@ABA@@B@@A@@@ABA@@@@@@@AB@@@@B@@B@@B@@@AB@B@B@@@@@BB@@BBB@@@@BBA@BB@B@@@B@@B@@@@D@BBB@@@A@@BA@@B@@@B@@@B@@BB@@@B@@AB@BA@B@@B@@@BBB@@@B@@@@@BB@B@@B@@A@@@@B@AB@@B@@B@@@@@B@@@BB@@A@@B@@@@@@@BA@@@@@BAB@@B@@BB@@@@@BB@@@AB@@@@@B@B@BAB@@@B@@@@@BB@@@@@@B@@@@@@B@@B@@B@@@@@@B@@B@@BABB@@@@@B@@@@@@@B@BB@@@@@@@B@@@BB@@B@@B@@B@@B@@@@A@@@@BAF@@@B@@@@@@@B@@@B@@AB@@@@@@@B@B@@B@@@@A@@@@@@@A@@@@B@@@@@@A@@@@BB@@@@@@@B@@A@@@B@@@@B@AB@@@@@@@@B@A@@B@@B@@@@B@@@BBBBB@@@B@BB@@DA@@DA@@B@@BB@@A@@@@@A@@@AB@@@B@@@B@@B@@@@B@@@@ABBBA@@B@BBAB@@@BBA@@@B@@@B@@@@@BB@@@@BB@@BBB@@BAAA@@@@@A@@@@@@BD@@@@@@B@@@@A@@AA@@@@@@DABAB@@ABA@@@@BA@@@A@A@@@@@@@AB@@@@@BBDBBBBB@BB@BB@@@@@@B@A@BB@@@@@@D@B@@@@@@@@@@@@@@B@@@BBB@@@ABCA@B@@@AA@@@A@@@@B@@B@@B@@A@@B@@@@@B@@A@@@@B@@@@@@@B@@@@@@AB@@@@BB@@A@@@@B@B@B@@@@B@@AB@@B@@B@@ABB@@BBA@BB@@@A@@@@@AB@@@@AB@B@B@@@B@@@@B@@@@@B@@@@B@@@@B@@@AA@@B@@@@@@B@@BB@@@@@B@@BA@@BB@@@@@@@B@B@@B@@@DAD@@@@@@@@B@@B@@@@@@@@B@@@@BA@B@ABB@A@@@@B@BAB@@@@@@@@B@@@@ABB@A@@@BB@@B@@@B@@@B@@@B@@B@@A@@@@@A@@@@@@@A@@D@@@@@DB@@AB@BA@@@@@@@@BA@@@@B@@@@@@B@@@B@@B@B@@B@@@@@@B@@@@@@A@@@@BA@B@@@B@@@@@B@BB@@@@BA@@@BB@@@@B@@@@@ABAB@B@@A@A@@@@@AB@B@@@BB@BB@@@B@B@@@B@AA@@B@@A@@B@@@@BBB@@@@@A@@@@BBBB@@B@D@@BA@@@A@@@ABB@@ABAB@@BD@@@B@@@@@@@B@B@@@B@B@B@@@@@@@A@@A@@@AB@@@@@@@B@@@B@@@B@@@@@@@@A@@@@B@@@@A@@@@@@BA@@@@@@B@@B@@@@@@@A@@B@@@@B@BB@B@@BD@@AB@@@B@@@@BB@@@@BBAB@B@@@BA@@@DB@@B@@@@@@@@@@@AB@@@BB@@@BB@AB@@A@@@@@@@B@@@BA@@@A@@@@@ABA@@BA@@@@BBADA@@@B@@@@@@@@@@@A@@@@B@@@@@@@BB@@@BAB@BABA@@B@@@@@@B@@B@@B@BBB@@@B@BB@@B@@B@BABAD@@@B@@@B@@@B@B@@AB@@DB@@@@BABC@@BA@@B@BC@@BAB@@A@A@A@@@A@@@@@A@@@@@A@AA@@AB@@AB@BAB@@@@A@AB@B@@@@ABA@@@@BD@@@B@@@@@A@A@@@AA@@@@@A@@A@@@@@A@@@AAAB@@ABAB@@ABA@ABAB@@AB@@@B@@@@@@A@@B@@@@@B@BC@@@@B@@@BAD@@@@@@A@B@@B@B@@@BA@@B@@@@@AA@@B@B@@@@@@@B@@@BBB@@BB@@@@@@BB@B@@BB@@@B@@@@A@@@AB@@@B@B@BA@@A@@A@@BA@C@@@@@@@B@@B@@@@@@A@@B@@@@@@A@AB@@@AA@A@@BAAA@@@A@@@AA@BA@@@@@AB@@AB@B@BAB@B@@AB@BA@B@@BB@@BA@@B@@@B@@B@@@@@@@BB@@@@@@@@A@@B@@@B@B@@B@@D@@A@@BAA@@@A@@@@@@@@@@@@AB@@AB@@BB@@A@@B@@@B@@@@@BA@@@AA@BA@@@@B@@AA@@@@@@@@@@@@@AB@AA@B@@@@AA@@@@@@@@@B@@@B@@@@@BAA@@@@A@B@@@@A@@@@@@AB@@@@@@@@AA@@AB@@A@@@@@@@@@@A@@@A@@@@A@@@@@AAAB@@BB@BB@@@A@@B@@@A@@A@A@@@A@@@@@@@BB@B@@@@@@A@@@@@@@@BA@@@@@B@B@@B@@@B@@@B@@@@BB@@@@@@B@@BB@@@@B@@@@B@@@AB@@@@@@@@@B@@@@@@@@@B@@@B@A@B@@@@@B@@@@@@@B@@@B@@B@@@@@@@BA@D@B@@@BB@@B@@@@B@@@@@@@@B@@@@@B@@@@B@@@@@@B@@@@@@@@@@D@@B@@@@@B@BB@A@@@BB@@@@@@@@AB@@B@@@AB@@@A@@@@@@A@@@@B@AA@@@@@@BABB@A@A@ABC@A@@D@@A@@@@A@@@A@@@@@@@B@@@@@@@@AB@@@@@@@@AA@@BAA@B@@@A@A@@@A@@@@@@@@@@@@@@@@B@@@@@@ABB@@@@BA@@@@@@@AA@B@@@@A@@@@B@@@@@@A@@@@A@BA@@A@@@@A@@@@@@@@A@@A@@@@A@@@@@@B@@@@B@@B@@@@AA@B@@@@@A@@@@@AA@@@@@@@@A@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@AA@@@@@@@@A@@@@@A@@A@B@A@@A@@AAA@@@@B@@@@BBDB@@C@AA@CA@@@@@@@@@@B@@@BAA@A@A@@@@@@B@@A@@DDB@@@B@B@@@@@B@@@@@@AA@@A@ABB@@@@B@@@@BB@@@A@B@@B@@BA@@@@@@@@B@@@@@@@@@BA@A@@@A@AA@@@@@@@@@@A@@A@@@@@@B@@@@@@@AAA@@@@@A@@@A@A@@AA@@@@@@@A@@@A@@@@@AB@@@@@@@@@AA@@@A@@@AA@@A@@@AA@@AB@AA@@B@@@@A@BBA@@@@@@@@@@B@A@BA@@@@@@@@@@@@@@@@BA@@B@@AA@@@AA@@@AKSRULCBME[CE@GCCAI@@@@@A@ABE@@@A@A@ACECC@ACAA@C@A@A@@@A@@@A@A@@A@@BA@@@@AA@@BAA@@@@@@A@@@A@@@A@ACA@@@@@@@@@@BB@B@@A@@BA@@@@@A@@@BBA@@@@@@@@@@@B@@@B@@BA@@BA@@A@@@B@@@@A@@AA@@@AA@@AA@@AAA@AC@@CAEAA@ABA@@BABE@@A@A@@ACA@@@@@@@@A@A@@B@BA@ABA@AAA@@AC@@AAA@@ABA@@DC@ACEAAA@AAACAA@@BCAAGIAA@CC@@@@@A@@@AB@@@AA@@@@@BBA@@A@@AB@B@@@@@B@@@B@@A@@@@BAB@@@@@@@@@DA@@@@@@BABA@C@A@@@@A@@@@@A@@@AAA@@@@@B@B@B@B@BAA@@@@AAB@AA@@@ABA@@@@B@@A@@ABA@@@@@A@A@A@@AAAA@AA@BA@@@@@A@@A@@@@A@@@@BB@@@@@@@@B@@BB@BB@@B@@@@AB@@@@A@A@@@AA@@@BB@@@@B@@BA@B@@@@@@A@@@@@@AA@@@@@AA@@@BADA@@@@@A@@@BDAB@@B@B@@BBA@BB@D@@@@@@@@AA@B@@AB@@@@B@@@@B@B@@@@@@BA@@@@@@@@B@@@@@@BAB@@A@@B@@@B@@@@@B@BA@@@@@@@BAD@B@@@@@@@ABABA@@@B@B@B@@@@@@BB@BBB@@@@B@@@@@@@B@@BA@@@@BA@@@B@AB@@@@@@@@@A@@@@@@AA@@@AAA@@@AA@@@@@@@@@@B@@B@@B@@BB@B@@@@@B@@@@@@AA@@@@@B@@@@A@@@@@@@@@@@@B@@AA@AA@@A@@@@@@A@@@BC@@@@@@A@@@AAA@@@@@@@@@@AAB@@A@@@A@BA@@@@@@@@A@B@@@@A@@@BA@A@@@@AA@@@@@@@AA@@@@@@@@@B@@@@@@@@@B@@@B@@A@A@@@@@@@A@@@@AA@@@@@@BB@BB@@@@BAB@@@@@B@@@@@@B@@@@@@@@@B@@@@BA@@@@B@@B@@A@@@@@BB@@AB@@@A@@AA@@@@@@@@A@@@@@@A@@B@@@@@A@@@A@@B@@@@@B@B@@@BAB@@@@@B@@@B@@@B@B@B@A@A@@@A@@@@@@A@B@@B@@@@@BA@BBA@@@@B@@AA@@@@@@@@@CAAA@@@@B@@@@@BA@@@@@A@@A@@@@@A@@@@@A@@@@B@B@@@@@@@@AA@@A@@@@@AB@@A@B@@@@B@@@@B@@B@@@@AB@@@BB@@@@AA@@@A@@@A@B@A@@B@A@@@A@A@@@@@B@@@@A@@A@@@A@@B@@@@@@@@@A@@@AB@@@@@@@A@@@AB@@@A@@@A@@@B@BA@@@BB@@@BA@AB@@@A@B@@A@@B@@@@@@@@@AA@@@@@@@@@@BA@@B@@@AA@@@@@@@@@@@@@@B@@BB@@AB@B@B@@@@@BBA@BBDBB@@@@@B@@A@@@@B@@B@@@B@@@@@@B@@@@@@@@B@@B@B@@B@@B@@A@@B@@A@AAC@@@A@A@CCC@AA@@@@ABCB@@@@AAA@@B@AA@@@@AA@@A@A@@A@@BA@@@CA@A@@@@@AAA@@@@BA@A@@@A@@@@@A@@@@@AB@@@@A@@@@B@@@@B@@@@B@@@@B@@@@@BB@BDB@@B@@@@B@@@@BB@B@@@B@@@@A@@@AAA@@B@@B@@@@B@B@@BB@@@@@B@BA@@@A@@AAA@@@@BAA@@@@@A@@@@@@@A@@@@@@@@AA@@@@@A@@@@AA@@AA@@A@BA@@B@B@B@@@@B@@@@@@@BB@@@@@B@@@@@@AB@@@@@B@@@AA@@ACA@@@A@@@AB@@A@AA@@AA@A@@@BBB@@@@@@BA@A@@@@B@@@@A@@@@@@B@@@B@@BB@@@@@@BB@B@@BDA@@@B@@@@BB@@B@B@@A@ABA@@BA@@B@@A@@@@@@CA@@AA@@A@BA@@A@@@@@@A@@@@@@AABACA@CA@@A@A@@@@BA@@@@AAAA@@BA@@A@@@AAAABA@@B@B@B@@@B@G@@@@@@AA@CA@@A@@@@@C@A@BB@@E@@@A@@ABA@C@@C@AD@@@B@@AAA@@@@@AB@B@@@@ABA@@@A@@@AB@@CAAB@B@@AB@@@@A@@@A@@A@@A@A@A@BB@@@BB@@BBBBB@B@B@@A@CDB@@@@B@@DB@@@@@@@@AB@BAA@@@AA@@@AA@@ABA@B@@@@BA@@@@@@@@AA@AB@@A@@@@@@BAAA@@@CB@@@@@AC@AAA@AAA@A@@@@B@@@@@B@@@@@@@B@@@@B@B@@@B@@@@@B@@@@@@@@@@@A@AB@@A@@@@@AFA@@@@AA@@BAA@@@@A@@@ABF@@B@@@BBB@B@B@B@@A@@@@@@B@@AA@@@B@@A@@@B@@@@@@B@@@@@B@@@BB@A@@@@@@@@@@@@@@@@AC@@@A@AAAAA@A@A@@A@@B@@@B@@@@@AAA@AA@AC@@A@@A@@AB@@@@@@@A@AB@@AA@@A@@@@@@@AA@@ABA@A@@DAD@BC@@@CB@B@@@@DDB@BHA@AFBB@@BHBBA@@@A@@@AB@@AA@@@@AAAAAA@@@BA@@BB@A@@B@@A@@B@B@B@@AB@@A@@AA@@@AA@@@@ABB@@B@B@@@@ABAB@@A@@@KB@@A@AB@@@B@@@@BBBD@B@B@@ABABABAB@@@BBD@@@BC@@@@B@@@B@BA@ABB@@@"],encodeOffsets:[[118519,29920]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("南昌",{type:"FeatureCollection",features:[{type:"Feature",id:"360102",properties:{name:"东湖区",cp:[115.903526,28.698731],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@A@@@C@C@AA@A@@AAA@@B@@@D@B@@A@@@E@@@A@A@@@@@A@C@@@ABAAA@@@A@A@C@@@C@@@A@CAC@EA@@AACAA@KGMP@CAE@A@A@EAE@@CBIDCBMFGDEBEDAB@D@B@BBBB@BDDHBDBBBBDH@@DF@FBB@DB@BBB@B@BBDBB@BBB@@@B@DAD@@@ABADCBCDCDCBCF@B@B@@@B@DDA@AB@B@@B@BB@B@@@B@@@@BA@@BBB@@@D@BA@@BD@F@@@B@D@AAAABABAB@@BDFBDAD@A@@AAA@@@@@@B@@@BB@@BABB@@@B@@BB@@@@DAB@@@B@@@B@@@B@B@@ABB@@CB@@E@A@@@@BC@@A@BEB@B@BBB@@ABA@@B@@AA@AABADA@BB@@@BB@B@@BAB@BFBD@BB@D@BDDDFHBBDDBDDFFFJDHHHFDI@AB@@A@@D@@A@@@@@@@@@ABAA@@AD@BCHBD@H@D@FDFJCDCDMLBBNBLHJDBBLDDDAFFDBDBB@DDDB@BBB@BAD@DA@@B@B@@@@A@A@@@C@@@ANBF@F@D@@@@ABCBEBE@@@@A@EAA@AAEEACGGGIAACAEACACAEAA@CCECGGACACA@ACACAAA@AEAC@AA@AAAAAADCDEBA@@JB@CCAEA@@CAAACA@@@AB@B@B@AAAA@AAA@@AC@@@@@A@@@C@CBE@@BA@@@@@AA@@A@@@@@AA@@@@A@@@@@A@@@@A@@@A@@@@@A@A@@@AA@@@@BAB@@A@@BA@@B@@@@@A@@AAB@@B@AB@@AB@@A@@@@@AAAC@@@@B@@@@@@A@@@@@A@@AB@@@B@@@@A@@@AA@@DC@@B@B@B@@@BB@BB@@@BA@@A@@A@@AB@@@A@@B@@@@A@A@@@@@AAAA@AA@@@A@AA@@@@@@A@@@A@@BE@@@A@E@CA@C@A@@@C@C@A@E@@@A@A@C@"],["@@C@AFEDCBQ@EACAEACAIDIDCBEDIDDFLJIHAJRHBCFEDGHFFFNDRFLBLBDBB@FB@G@@@A@A@AB@B@BAAA@A@AAA@@@A@AB@@@@@@A@A@@BAB@B@@AB@BAB@B@@@BAB@DAB@CCCG@ADCDAHCAAAA@AAC@AEGAAEGAAAA@C@A@@QFMHEBBDL@FFFDBDDFEAOCEB@E"],["@@@AA@FA@D@FFAPDFBCEACECEEK@EB@A@ADAFA]JYJDBFBDBFBR@DAFCBE"],["@@BBBDF@CGEB"]],encodeOffsets:[[[118694,29363]],[[118597,29324]],[[118595,29324]],[[118623,29343]]]}},{type:"Feature",id:"360103",properties:{name:"西湖区",cp:[115.877233,28.657595],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@BAGC@@C@CB@@A@ABA@A@EBA@C@AB@A@@@@CI@C@@@CAA@A@@A@CB@@@@@B@@@@@@@@@B@@@B@@A@@B@@BB@B@@@B@A@BBABB@D@@@@C@@@@B@@@BBB@@@B@@@@A@@AAA@@@@C@C@CBA@@@@@CB@@BBA@AACC@A@@@@@@AAA@@AA@A@@@C@@B@@@@@B@B@@@B@B@@A@ABA@[GBB@BBBBB@BB@BD@@@@AD@@@BBB@@@B@DBBBBFHBBFH@BBD@BBBBBDDBBB@BBFBB@@@BB@@DDFFDBFFDDBB@BBF@B@H@@LHB@DBBB@@FBD@DBB@@@D@@@D@B@B@@@B@BBBA@@D@B@@@@@B@B@@@F@@@B@@@@A@C@@@AB@BB@@@BBBD@D@@@B@D@B@B@@@F@B@@E@A@C@A@A@A@@@C@@@A@E@A@@@A@A@CC@EBAA@@A@@@@@C@@@A@A@@@A@A@A@@@@@@@A@@@A@C@C@@@@@A@@@A@@@@@@@@@@@@ABA@@@A@A@C@@B@@@@C@@@@@@@A@@@A@@@A@A@A@@BA@@@@C@@@@A@EE@@@A@A@@@A@@@A@@@@@AA@@@ABC@E@@@A@ABA@A@@A@AA@@@A@A@A@A@@ACA@@AA@@AA@@@@AA@@AA@@A@A@@BE"],encodeOffsets:[[118668,29298]]}},{type:"Feature",id:"360104",properties:{name:"青云谱区",cp:[115.925749,28.621169],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@@AA@@@A@@CBAEA@@@A@@@@@A@@A@A@@@@@@@@AAEA@A@AB@A@@A@C@@@@@BD@@A@@@@B@@@@@@@@@BB@@B@B@D@@A@@@@B@B@B@@A@@@A@C@@@A@A@@BABAB@@@BA@@@A@CBA@@@@@@BB@@@@@AB@B@@@BB@BB@@C@@@@BA@@F@@AB@@AB@@ABA@AF@@@B@BB@@BB@@B@@B@@BB@@BB@BD@@@B@B@B@B@@BBB@@@@BAB@B@B@@@FAD@B@@BB@@@@B@@@B@@@B@B@@@F@@F@B@@D@@@@@AB@@@B@B@B@@@B@@@B@@@@@@@D@@A@@@@D@B@B@@AB@B@@@@@@@@@@B@@@B@@@@@D@D@B@@@B@@@@@@@B@B@B@@@B@B@@@D@@@@@B@@@BBFAD@@@B@H@B@D@B@B@B@@@B@B@@A@@F@B@B@BAB@DADADA@@@@@A@A@@B@@@@@B@@@@@AA@@@@@A@@@@@@A@@@@@@AA@A@@A@@@@AA@@@A@@@AB@@@B@B@@@B@@@@@@A@@@@B@@@AAB@B@@A@@@@B@B@B@B@@@B@@AB@B@@@@@@@@@B@@A@@@@@CA@BCA@@A@A@@@@@ABABC@A@@@@@@A@A@@@@@AA@@@AA@@C@CBEDA@EUAE@@A@ABA@A@@B@@A@@@@@@B@@@@@@A@@@@@A@A@@@A@AA@@@@A@@A@ABA@@@CBA@@@@@@AA@@ABA@@@AA@@@@A@@A@@@@A@@@@@@@@B@B@@@@@DA@AA@@@A@@A@@AABAA@ABA@A@@@@@@@A@@@@AA@@B@@@@@@@@@@C@AB@@A@ABAB@@AB@@C@@BA@@A@@A@@AA@@A@A@A@@A@@@@@@@@BAA@@A@@@@@@@A@"],encodeOffsets:[[118699,29280]]}},{type:"Feature",id:"360111",properties:{name:"青山湖区",cp:[115.962144,28.682984],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@EGCCACC@A@@AACAEA@AB@@@AAA@@A@@ACBABBBB@@BA@@@AB@BA@AAA@A@AFB@@@AD@@@@@B@FA@@DA@BA@@@A@A@@@A@@@A@@BA@C@@A@@AA@@@A@BA@AA@@A@@@A@@@@B@BB@@@BBCACCE@AA@ABABBBBBC@A@@@E@C@@AB@@A@C@@AA@AB@@A@@A@@@A@A@@A@AA@A@@BCB@C@A@@@A@ADEDADCDCDABCBA@@C@CBA@@@A@AAA@CAAAA@A@AAA@@CAA@ECEAB@@A@A@A@@BA@CB@@A@A@CBA@@@@@@B@@@@A@CBCBCD@@@@ABA@A@AA@@@A@@AA@@A@A@@@@@AD@@CBABC@@B@@C@@@ABA@@BA@C@@@A@@@@BE@K@A@@AACA@@A@A@@@A@A@@A@A@@@A@@@@@@@A@A@A@@BAAA@A@A@@@AB@@A@@@@B@@@@AB@@ABA@@@@B@@A@@B@@A@AD@@@BA@A@@@A@@@@@AD@@B@@BA@AD@@@BA@@B@@B@B@@@@@@BA@B@AB@@@@@@B@BB@@ABA@@B@B@@ABA@@@@@A@@@ABA@@@ABCFAB@BA@@BADABA@@@@BAB@@@B@@@@DDBB@B@@@@@@ABA@A@@@@BA@@@AB@@A@@B@@B@@BAD@B@D@D@BBBB@@@@B@B@@ABAB@@BB@@B@@ABA@@B@DD@@BB@@D@B@@BBBB@@@@@CB@B@@@@BB@@@@@B@@@@B@@@@@B@@@A@@@B@B@AD@BBBFDDBBDBBBHHDHDLAFD@@JBJBJBDDBBDDA@A@CBABADDBBDB@DBDABBFBBBAD@DG@GBCBBDBBB@BDB@@@@AB@@A@@@@B@B@B@B@FBBB@@BB@@BB@@@B@@B@@@@BBBB@@B@@@BB@@@@@B@BAB@@@@@B@BB@@B@B@B@BA@@AA@@@@@A@@A@@@@ABAA@@@@@@AA@@@AAAC@AA@@@A@AAA@@@BAB@@AA@@A@ABE@A@@B@BBB@BBB@@AD@@AB@B@@AB@@@BA@@@A@AB@@@BA@@B@@AB@@@DAB@FABAB@B@B@@@B@@DBDBB@@BDB@@@B@@AAAB@@@@A@@@@B@BABAB@BA@@BAB@@@B@B@@B@@B@@@@@@B@@@BB@BBB@@B@@@DA@@@@BAA@@AB@@A@@@BB@@@BA@@B@@@B@@@@@@B@@@B@B@B@@@B@DA@@BBBBB@BB@@BBAD@B@@@@@@@B@@@@@@@B@@A@B@DB@@B@@@BA@@@@BCEC@AB@@A@@@@@@A@@AA@DAB@@@@@BB@@@@B@@B@@BB@@@B@@BB@@@@B@@@@@@ABBB@@B@B@@@@BB@@@B@@@@BD@@@B@@@B@@@@BA@@BA@@@@BB@@@@@@@B@@BAB@@B@@B@B@@B@@B@@AB@B@A@@@AB@@ABB@B@@@@B@B@@@@BBB@@@@@@@B@@@@B@@B@@@@B@@@@@@B@@@@@B@@@@B@@BBBB@@@@@@@@@@B@A@B@@@@@@B@@B@@@JA@@@@@@@@A@@@@AB@B@@@@@DA@BBA@@BA@@@@BB@@AB@@B@@@@@@@@AB@@@@@B@@@@@@@@AB@@@B@B@@B@A@@A@@AA@@A@@@@@@B@@@B@@BB@@@B@A@BB@@B@@BA@ADA@@@@@@@AB@@@@@@BA@@B@BD@@@@A@@@@@@BB@@@BA@B@@@BB@B@BABB@B@AB@@@@@B@@B@@B@@AB@B@@@@@@@@@@@B@@@BA@B@@@A@@@A@@B@@@@@@B@@B@@@BA@@@@BA@@BA@@@@DBB@BBB@B@B@B@@@B@@@ACB@@@@A@@@AA@@@BE@@@@AA@AB@BA@AD@@@@@BA@A@@@@B@@B@B@@B@BA@@@AA@@@B@@@B@B@@@@@@@@@B@B@@@@CA@@@@@@AA@BA@CA@ACAA@@AC@A@CAE@CB@@A@A@C@@@A@A@@CC@CAAACECBECCKCAAICKGMAAANKDCDCEIECC@G@C@GAADC@@BB@AB@B@@@@@@@@@BC@@@@BA@@BCJGEGGICEECEACCCAA"],["@@AC@@A@@@A@@@@ACA@B@@A@@AA@A@@AA@@@@@A@@@A@@@@@A@@@@@A@A@A@A@@BA@@D@BB@@BCB@@@@A@A@@@@@@@@@@B@@B@@@@B@@B@@B@@AB@BB@@B@@@@B@DA@@B@BAB@@@@B@@B@@B@B@@@B@B@@@@@B@@@@A@@@@@@@@BA@@@@BAB@B@BF@VB@FCBAF@D@DB@@B@@BB@@@@B@B@@@@@@@@BADAB@B@@@@@B@BB@ADB@@D@@@@@BA@@@@@@@@@A@A@@BA@@@A@A@A@A@@@@@@BA@A@BB@@A@@@@@@B@@@@A@@@A@A@@@A@@B@@@B@@BB@@@@@BB@B@@B@@@@B@@@@@@@@B@@@@BB@@@@A@@@@@A@@@@B@B@@@@CBCBCBA@ABA@A@E@@@@BA@A@@@A@A@A@C@A@G@A@@@@D@B@B@@@B@F@B@@@D@@@B@B@B@D@B@FD@D@@@B@D@B@@D@F@B@@AF@@@B@@@B@@@@B@@B@B@@BBB@BB@B@@@@@B@B@@A@@@@B@@BA@@@BB@@@AB@@A@@AAA@@A@A@A@@@CD@@BB@@B@@@@@@A@@BA@@@B@@@@@B@@@@A@@@@@BDBB@@@@B@@@BA@@BAA@@@BA@BB@@@@@A@@@AB@@@BA@AB@@@@BB@@B@B@@@@@B@@@B@@@@@@B@@@@@B@@B@@B@@@@@BB@@B@@@@AB@@AF@D@D@@@B@@@@BD@@BB@BBBBBA@A@A@@B@@DBBBDB@@FBDB@DDBDBB@@@DBBB@@D@DBJBB@@@B@BBDAD@D@D@D@HAB@DAFAHAB@HCB@D@B@BAH@HAF@J@DAD@B@@AHBF@@@@C@@AAB@@@@@@AB@@@B@@@BB@@D@@@@A@AA@@AABAA@@A@@@@AA@@@A@@@AAA@@AECAAAA@@AG@@AAACA@@E@@@AD@B@B@BA@@B@@AB@AA@@@AAB@@@@BB@@A@@@A@@@@@@@@A@@@@AAAB@AAAAAA@AAA@@AA@@@A@@C@@@A@A@ACG@AAAB@@@@@@AA@@@@@@@AA@@@@@@AA@@@AA@@A@@@AA@@@@@@@A@@A@@@A@A@@@@AA@AA@@@@A@A@A@A@@@@AA@@@A@A@@@A@@A@@@@@@@B@@A@@@@@A@@@@B@@@B@B@@@@@@@@B@@B@@@BA@@@@@BB@B@B@B@BA@@@ADCBC@CBABADEB@DABADGDCB@DADAB@@@B@D@DBB@FDB@@DBD@BBBDDDDDDDBAO@I@A@GBA@ABA@@HEB@@@DA@ABCBAB@@@AAAA@@BE@AAA@@A@@@@AA@@@@C@AAA@@AA@A@AA@@@AA@@@A@ACB@AA@A@AA@AA@AA@@@C@A@A@A@A@AAAMACAECAACAAAA@AAAAA@ACCC@A@@A@A@AAC@AA@@A@CAGBAB@@@B@B@@AB@@@B@BB@A@@@A@A@@A@@@@@@A@@@@A@@@@AAC@AA@@A@AB@@@@@BA@AB@@A@A@@B@@@@ABCA@@A@@@@@@@A@@BA@A@@@@A@A@A@@CC@@A@A@A@C@@@@B@B@@@@@@A@AB@@@AA@@@@@@@@@@@A@@B@@@@A@@AA@@@@@A@A@@@A@@@CB@@@@@@@BA@@BB@@@@@A@@@@@@@@@@@A@@BA@A@@@A@A@@@@B@B@@@@@@@@A@ABCBAB@@"]],encodeOffsets:[[[118671,29434]],[[118739,29283]]]}},{type:"Feature",id:"360105",properties:{name:"湾里区",cp:[115.730847,28.714796],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@A@AAA@A@@B@@@BBB@@@BA@@@@@@B@@A@@@@@@C@@@@@@@BA@AF@@@BA@ABA@@@@@@B@@A@CBA@A@AB@@@BAB@B@B@BCB@B@D@BAB@BCD@BABA@@B@@ABAD@BA@AACAEABAB@@@AA@AA@@AABC@@@CCA@@BCB@@A@@AAAAC@@A@A@@A@@AAAAAAA@@@@@CBA@CBA@A@@BABA@@@A@@@A@ABA@@@@BAB@@A@@@@BABB@BBB@@@ABA@A@AB@@A@AA@@C@C@@@@CACCAAA@@A@@@@@@@@@@AA@@@@@@A@AB@@@DC@@@C@@@A@A@A@@@CB@@AB@@@@A@@B@B@@@@@@AA@BAB@@@@@@@@AABA@A@@@AA@@A@@@@B@@A@AAA@A@@@A@ABABA@ABA@ABA@A@A@A@A@CA@@A@@A@@@@A@@@@B@@@@@@@BB@@B@B@@B@@@@B@F@@AB@B@B@BABA@A@BB@@B@@@B@@BB@@BB@B@BBBB@@@B@@B@@BBBBB@B@@D@@@B@@BBB@BBB@@AB@B@@@@@@@@@@@BAB@@@B@BA@@B@@@@@@CB@@A@@B@@B@@@B@@AB@BB@@B@@@BBBB@@B@B@B@@@@@@@BB@@@BB@AB@@@@@@A@A@A@A@A@@@@D@BBB@@@B@@A@@B@B@@@@ABA@@@A@@@AA@@@@@@@BB@@@@B@BAA@@@@A@@@@@@BA@@BBB@@@J@B@@A@A@A@@BA@A@A@@@A@@B@DBD@B@@@@BB@B@@@B@@@B@BABA@@B@@BB@B@@@B@BBB@@BBBB@B@@BBB@BDBDBDDBDD@B@@@@@B@BB@ABBB@@@BBBBB@@BBBB@BB@@B@BBD@@@B@@@FB@A@@B@@@@@B@B@@@B@@@ABB@A@@B@@@@@@@@B@@@A@@@@@@AB@@@@@@B@@@@@@B@@A@@@B@@@B@@@@@@B@@A@@@@@@@B@B@@BA@@@@@@@@@AB@@@A@@A@B@AB@A@@@@@B@@@@@B@@@@@AB@B@@AB@@@@B@@@@@BA@@@@@@@A@@@B@A@@@@@@B@@@@BA@@@B@@@@A@@B@@BA@@@@B@@@@@AAB@@@@@@@BB@@@@@@@B@@@B@@@@A@@@@@A@@@@@@@B@@@@@@@@@B@@@@@@@BB@@@B@B@@A@@@A@@@@@A@@@@B@B@B@B@@AB@@@B@B@@B@@@B@B@@AB@BBB@@BB@B@B@@@BABA@@@A@A@AAAAA@A@CBA@@AA@@@@BAA@@B@@A@@@A@@@A@@@@A@A@@@@AABA@@A@@@@A@@A@A@@@@@@A@@@A@@@@@A@A@@@@BA@@@A@@B@@@B@@@BAB@@@AB@BB@@BB@@B@@B@@@@B@@@B@@@B@@@BB@@@@@@A@@B@@@B@@@@@@@B@@@B@@BB@A@@B@@A@B@@@@@@@@@@@B@@A@B@@@B@@A@@@@@@B@@@BB@@BBB@BB@@BB@@B@@BB@@HHB@BB@@@BB@@@@@BB@@BB@@B@B@@@B@@@B@@@@@B@@@@ABB@@@@C@@B@@@@BA@@B@@B@@@@@@@B@@@@@@C@@@@@@@@BBB@DB@@@BBB@@@BA@@B@@@BB@@@@A@BB@@@BB@@@@@B@@@@@A@@@@A@@@@@@@@@AA@@@@@@@B@@@@@@AAB@A@@@@@@@AB@@@@@@@B@@AB@@@@@B@@@@@B@B@@@@@BB@@@@A@@@@@@@@@@@@@BB@@@B@@@@@@AA@@@@@BB@@B@@@BB@@@@B@@B@@B@@@B@@A@@@@@@B@@@BBB@BA@@BB@@@@B@@@@A@@@@A@@@@AA@@@@@A@@@@@BA@BB@@@B@@@@@@AA@@@@@@@@A@@@AA@@B@AA@@@B@@@@@B@B@@BB@B@B@B@B@B@@@@B@@@@AB@@@@@@@@@@BA@@BB@@@@@@B@@@@@@@A@@BA@BB@B@BB@@@@B@@@@@B@@AB@@B@@@A@@@BB@@@@@B@A@BB@A@@@@BC@@BABCDA@@@@@@B@B@DA@@B@BAB@@AD@B@@A@@@@B@@@@AB@@AAAAA@@@AA@@A@@@@A@@AA@@@@@@@@A@@BBB@@@@@@A@@@@@A@@@@@@B@@@@@BD@@@BBBDBB@@@B@@A@@B@BAB@@ABC@@@@BBBBB@D@@@@@B@@A@A@@B@@@BBBAB@B@@@B@BB@@DBB@@BBB@BABAB@@CB@@ABA@@@@D@D@@@@@B@@@DBB@@BBBB@B@@A@@@A@@BA@@@A@@B@B@D@BA@@BA@@@@BB@@@B@@ABA@@@@@@@@@@BA@@B@@@@BDD@DBD@@@B@@@@BB@@BB@@@@@@B@BB@@@@BA@@B@@AB@@@B@@ABBB@@@@B@@@@CBADAB@BAB@BADAB@@@B@@@@A@@@CBABA@AB@BABAA@@BD@B@BA@@@A@@@@@@A@A@@@AC@@@@@BB@@@BAB@B@B@@BDBD@BBB@@@@@@@@BBFAD@B@@@B@B@B@@@B@B@D@@@BA@@B@@@@@@B@@A@@B@@A@@@@@ABAAA@@B@@@B@B@@A@@@BB@@@@@@BA@@BB@@@BA@@@@@@@@B@B@B@@@@BB@@@@BAB@BB@@BAB@@@@B@@BB@@D@@@@@BF@B@BB@@BB@BBBBB@@BB@@@BA@@@@B@@@BBB@B@@@@@B@@@BABA@A@@@BB@@B@B@@@@B@B@B@@@B@B@B@@@@@@A@A@@B@@@FAB@@AB@BA@@@@DBB@@BB@B@@BB@BDB@@B@@@BAB@B@BB@@B@AB@B@@@B@BAB@B@BADB@@@@B@@@@AA@@A@@@@BBB@@@@@@@B@@@@@@ABADA@A@@BA@AA@@@A@@@@A@@@A@@BAB@BA@@@@@@B@@@AAA@A@@@BA@@@@@A@@@@@A@@@@@@AA@A@AA@@@@@@@@A@@B@B@@@B@@A@A@@AA@@@@AAAAAAAA@A@@@@AA@@A@A@@@AC@@B@@@@A@@@@@A@@@@@@@@@A@@@@AA@@@A@@B@@@@A@@@@@@BC@@BA@@@A@@@@@@@A@@@A@@@@@@A@@@@B@@A@@@@@B@@@@B@@C@@@AAA@AA@A@A@A@A@@@A@@AA@@A@AA@@ABA@@@@@A@ABA@AB@B@@@@@@@@CACA@@@A@@AA@@AA@@A@@@A@@@A@@@B@@AB@@@@@@@B@@A@@B@@@@@@@@A@@@@@A@@@@@@A@@A@@A@@@AA@@@A@AAAA@@@BA@AA@@A@@@@BA@@AA@A@ABAA@AA@@@@@C@@@A@A@@@A@C@@@@@@AC@@A@@A@@AC@@CAA@@AB@BCB@@@@A@@@@@A@@@@@@@@@@A@@BAAAB@A@@@@A@@AB@BAB@@@BAB@@@AA@@@@@@A@@A@@CAA@@@BA@@@AB@@@@A@@@@BAB@@@B@BAB@@@B@@B@B@@BA@@@@@B@@@@@B@@BAB@@@@@B@BA@@B@@@B@@AA@A@A@A@@@BAB@@ABC@@@ADABADA@ABA@@@@AA@A@A@AA@BA@@BB@BB@@@B@FB@@AB@@@BCB@@@BB@@B@DBB@@DAHAH@@CBCAAEAAACBCAA@ACCABCBADAB@B@CCAACCIAIAIA@@ECKBGCGCAGAAACCAECAA@ABCA@A@@@B@@@A@@@@@A@@@@@@A@@@@AA@@@@@ADA@@@@A@AA@AA@C@@@AA@@CCA@@@AB@BA@@@AA@@BABA@@@A@A@@A@AA@A@C@C@ABC@AA@@@@AB@@@BA@@B@@A@@B@B@BA@@@@@@@AAACC@@@@@A@@BA@A@@B@BABC@AB@@ABADEBA@@B@BA@@B@@@@@B@BA@@@A@AB@BA@@AAA@@@@@@@BAA@B@@A@@@@A@A@@@@AB@@A@@BCB@@AA@@@BC@@@@B@@@B@B@@A@@BCB@@@@AB@@@@A@@B@BA@@BA@@@@@A@@B@@@@AA@ACA@@B@BA@@@A@@@AAC@@@A@@AA@@@A@@@A@@@@@BA@@A@@A@@A@@B@@AB@@@@BB@@A@@@BD@@A@@@@AA@@A@A@@@@A@@@@@@@@@@A@@@A@A@@AADA@@@A@@@@A@@@@@A@@@@@@@A@@@@@A@@@@ABABA@A@A@@@AA@@BC@@@A@@@@@@A@@@@BAB@@@@@@@@@AA@@@A@A@A@A@@FB@@@A@@@C@A@@@AB@@AB@BAB@BA@@BB@@B@@B@@BBB@@@@@@@@AA@@AA@@@AA@@AA@A@@CBABABED@@A@@@A@@@BA@@@BB@@@BABABA@@@@CAAAA@@@AA@A@@ABA@AAA@A@@@@A@@A@A@@@AA@A@@AA@@@@@AB@@@AAA@@@ACB@A@@@@@A@@@AB@BA@@A@@BA@@@@@A@@AB@@AA@@AB@@@BAB@B@B@@ABA@ABAB@B@B@@A@@@A@A@@B@BCBAB@B@@@@@@A@AA@@@@A@AA@@AB@@@@@B"],encodeOffsets:[[118508,29366]]}},{type:"Feature",id:"360121",properties:{name:"南昌县",cp:[115.933742,28.558296],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@AAAA@@AAA@@@A@@@AD@@@C@@@@A@A@AB@@A@@@AA@@BE@A@A@@@@A@AACBCBABC@ABA@AB@@@DAD@D@@AB@@A@CBEBC@EBC@@@A@@@AAAC@A@C@A@A@A@AA@AAAA@AA@@AA@A@A@A@@AA@AA@A@@CBA@C@CBCBA@A@@@C@E@@@A@A@AB@B@@@B@@BBBBBB@@BB@@B@@B@@@@@B@@@B@@@@@B@@@@@@@@@@@@@@B@@@@AB@@@@A@@B@@@@@B@@@@@@@@@@B@@@@@@@B@@@@B@@B@@@@B@@B@@@@@@@B@BA@@B@@@B@@AB@@AB@@@B@@@BBB@BA@@BA@@@EC@@@@A@@@AB@@BBDBBB@BBB@@@@ADA@@B@B@@@@A@@B@@A@A@AB@@A@@@C@AA@@A@CAAC@@@A@@@AA@@@@@CBAB@@A@A@@@AAAA@@A@@@@@A@@B@B@B@BCB@BA@@B@@B@@B@@ABA@A@C@C@G@AA@@@AAC@@AAA@@A@@A@BA@@@A@@B@B@B@B@BA@@@A@@A@A@A@@@AA@@AAA@@@E@C@@B@@AB@DAD@B@B@D@B@BAB@@ABAB@@A@@@CA@A@@@A@@@A@A@@@A@AA@@AA@ICA@@@@@BA@@ACAAAAAC@CA@@A@CAC@@AEAC@A@@A@@@C@A@CAC@CB@@@B@D@BAD@BADADCFCDCDGHEHA@AB@@@B@B@BBHBF@B@B@@@H@D@H@D@B@BBH@D@DAD@BADADADADAB@BAB@DCHADAFAB@FADABADCDCD@BA@AB@BABAB@@CBQJCBEHEDABCFAB@B@@@@AB@B@BAB@DB@@B@@BB@@BHBHBF@FB@@DDDBDFHDBDHDD@@BDBBBD@DBD@BBFBB@BBH@F@H@DAFEJCF@@ABCDEDMNCDGHCDABCBEFCB@BADAB@DAD@B@D@@@D@BBBBF@@BDBDBBBDBD@BBBDD@@BDBL@HBB@D@B@BBB@B@DB@@D@@@H@F@B@B@D@B@F@FBF@BBPBF@B@F@BB@BD@BBB@@@@@@BDBDBD@@BB@@@B\\HB@BAB@@@@A@A@@@A@A@@@@@AD@@@B@B@@BB@BB@@@@@@@BDDBBB@AA@@DA@@@@B@DAD@D@@@@@BB@BB@@@@@@A@@AA@A@@@A@@D@@@@@@CAAAB@A@B@A@@@AAA@@@AB@@@@A@@@A@@@@@@@@@A@@@@DAB@@@@BBB@D@@@DDJ@@@@@BBAD@B@FAB@B@BAB@@@DAD@@@HD@@BA@@BA@@@EB@@A@@D@@@AAA@@A@@@ABA@@@@A@@A@@@@B@DAB@@@B@@A@@BABA@AB@B@@@D@B@@@B@@@@A@A@A@@B@@@@C@A@AA@@A@@@@@@@@@A@@B@@@AC@@@@D@B@@@@BBAB@B@BF@B@@@@@@B@B@@@@B@@@@@B@@FBAB@DB@@@B@@B@@@AB@@@@@@@B@@@BB@A@@@@@@B@@@@B@B@BB@@BB@@@@BB@@AD@@@BA@@BABAB@@@BAD@@@@@@@@@@@@AB@@B@@B@@@@@@@B@B@BAB@ABBB@@@BB@@@@A@CB@@AB@B@B@B@@@@@B@@@@@B@@@B@@@@@B@@BB@B@@BB@@@@ADB@B@@B@@@B@@@BD@@BADABAB@@@@@@@@@@A@A@@B@B@@@B@B@@AB@@@@@@@@@@@B@@@@@A@@AB@@A@@@@@@DA@@B@@@B@B@@@@@B@@BB@@@@@@AB@@@@@@@@@@@B@@B@@BAB@@@@@@@@A@A@@D@B@B@B@@@DD@@@B@B@B@@B@B@@AB@@@@@@@B@@@DBBA@@@@@AB@B@@@BAB@@A@@@@BAB@@@BBD@BB@@@@@B@@B@@@@@@@@BB@B@@@B@A@@A@A@@BA@@@A@A@@BAHADBB@@@BBD@BBB@B@@@@BDDBDB@BBBBB@BBDBBBFDDBNBBB@B@B@B@B@B@D@@BBB@@BBBB@B@@BDA@B@B@@BB@@B@@B@BBB@@BB@B@D@@B@@B@@B@@@BB@BAF@@BBBB@@A@ABAD@BCB@@A@GF@@AB@BAB@H@B@JBPCACCCCCCAA@AAC@CA@ECA@CAC@A@@@A@CBCBA@CDCHABCBA@CFABAB@DADCD@B@@ABA@A@A@A@@A@@@@AB@@A@@@@A@@@@@@A@A@@@A@@@@@@B@@@@@BA@@@@@@@B@@@@B@@@B@B@@BB@@@@@B@B@B@B@@B@@BBB@@@@@B@B@@@BB@@@@@@@B@@B@@@BB@@B@@BB@@@@@@BB@@@@@@B@@B@@@@A@BB@BDH@B@B@B@@@DB@@@B@@BB@BBB@BBBB@BBABB@@@@@B@@@@@@B@@@B@@@AA@@@@BA@B@@BBA@@BA@@@ABA@A@C@@B@@@FB@BDBB@@BH@@BBBBFD@BB@BB@@B@@@B@@B@@B@@@BBBA@BB@@B@B@@C@@@AA@@A@@@A@@B@@@@A@BB@@@D@@E@GA@BA@C@CBI@E@GBG@ABA@C@A@GDA@GBEBCBA@GBC@C@C@C@CBAAA@@@A@IACAC@@@AACA@@A@CACAIA@@ABCFCDBBBBBBB@@BBDBFB@BBBDBDB@BDBDHHFDDDB@FBDBDBFBDBBBHJHHBDFFBBB@FBB@@@@@AFAFAD@B@@C@E@E@MA@B@@@D@@@B@B@@A@A@@@CBC@ABA@AAA@@@@B@B@@@D@B@BA@@DBF@D@BBD@@BBBDB@BBDBB@@BDBBB@BHDFDBBB@BBBB@@BBD@DBDBD@B@DDFBBBBBHDDDD@DBF@B@B@FAB@HEHCDADC@@D@B@BBBBB@@BBB@DBB@D@B@DAD@D@B@BBDBD@J@B@BBB@D@F@FBD@BBB@BBBBB@BFDBBB@B@@BB@FBHBDB@BB@@DFLBB@@D@BBF@BABADAB@FAFABAB@BABA@ABADADCJC@@B@@@@B@@@@@BB@@B@@@@BBB@B@@B@@@B@B@@BFBB@@@@@@@A@@@AB@@@B@@@HDDB@BD@H@@BB@@B@BBB@BB@B@BBB@@@BA@@BA@@@@B@FDB@@@@BBDBB@@D@DBD@BBDB@@@@@@@A@AAE@@@ABCBADCDCFCJCB@B@F@DDB@DBD@DDBB@B@@@B@DBBBBBB@@BBBD@F@@@DBB@BDBFFBBBB@@BH@B@DBB@B@B@B@BDF@BDBDDDDHDBBFBPBH@D@B@DBJBDBB@DBB@B@HDDBFDDBF@BBB@@@@@BB@BBD@D@BBB@BDDFDB@B@B@D@BAF@D@BAB@BBDBH@@@@@@A@CA@@AA@CC@@AA@@@@@ABAB@DAB@B@@AB@BA@A@@BC@ABA@@@A@A@CBA@ABC@ABA@AAE@@AAAA@C@@@@@@B@@@BB@@BB@BBBB@BA@@DBB@@AAA@A@CBE@A@A@AAACA@AAACA@@ECA@CCAAAAAA@CAC@A@A@@B@B@B@FBD@B@@@BABABAB@B@BBDB@@BBBDDDBB@@FDBBB@@D@BBB@@B@@@D@B@BAB@@A@G@@A@GCAAAA@A@C@@@ABC@@FAB@@A@@@GA@BC@AB@@ABAB@DADCBA@@@C@@@@AAC@@@@AA@@A@@@C@ABAAA@@@C@A@C@@@AA@A@GACAC@@@@A@@C@GB@BA@EBA@A@EAU@A@A@@AA@EA@@@@ABABGFAD@@A@AA@AEC@@A@AAAAAAECACGA@AA@AAAAAC@@@A@A@A@ABABABADADCFADA@AFABABC@@@ABGBCBC@A@AA@@AA@@A@AAAAA@ABAA@@AAA@A@AA@@AA@AA@C@CBA@E@EBA@@@@DAB@@@@@D@B@DADABAB@BAHA@@F@HBJ@DBB@@BDD@@BB@EBEBAAE@CBA@CBC@A@@@A@@@AB@LDB@D@B@F@B@@@BBB@@@DADAB@HE@AB@B@@AAABC@A@@BE@@DCLC@ADADEBC@ABC@ABABE@A@K@A@A@A@E@@@A@ACCCAACCACAC@GAEAC@AAEGCC@@AAA@AAC@C@E@A@A@@@@@BEBABABGB@B@B@@@@@BCBA@@@A@ADC@AB@@A@A@C@AAEAAACCEAAAC@@AAAACCCCCAA@@ACC@CCCA@AACAECA@AAEA@@@A@@@@AAAA@ABA@A@@B@@A@AB@@@@@B@@C@@BA@CBA@AB@@AAA@A@AB@BAB@BABA@@B@D@B@@@@@@CDC@A@A@@BADC@@DB@@@AD@@@@@BDB@@@D@BBBB@@@@B@@A@@@A@A@@@A@@@@AA@AA@@A@C@AAA@AAAA@@@@@@A@@@ABC@@@AAA@A@A@A@@CA@@AA@ACAGCA@C@A@GEA@A@E@I@A@GDABC@A@AAC@IAAAA@AAA@AC@@@C@@AC@@A@@@@AAAAEAC@A@@@@A@AB@@A@@A@@AAA@@AA@AG@C@AA@AA@@@@BC@A@@@A@@A@AAC@@@EACAAA@@AECGAEAACCCE@AA@ECAAECECCAGCMEA@GAEAGAEAA@EAE@@AC@AAEAA@@@@AAA@C@E@C@A@AAAEECCA@EG@AAA@CA@@C@E@C@C@A@IBE@@@CBABE@C@M@CAGCIACCC@AECCCECAAACAEAACCCE@@@AAACEA@AAGACAGAA@ABA@@@ABAD@B@DA@CBABABAF@@@@@BA@@BA@A@C@ABC@A@A@A@A@BBA@ABAB@@@@A@A@ABGBA@@@EB@@@@@BA@ADABCBA@A@A@A@C@@@@A@@@C@A@AA@AAA@AA@@@@A@@@AB@@AAA@A@@BABAB@@A@E@A@C@EAA@A@A@@@E@A@@AAE@E@A@CAEAC@A@AA@@A@AA@AACCA@@@A@CBA@@AA@@AACBCAABE@EBE@CBC@ADCDE@A@A@A@AACAAAAA@@ABA@ABABGB@@A@E@C@CBA@A@ABAAAACECAC@AAA@CAE@A@CAC@CBC@A@C@ABABCDEDABABCFCBADADCDAB@TCD@DA@@B@@@@A@A@CAA@AB@@A@A@A@A@AA@AAB@@@BA@A@A@@@CAA@@@ABAB@@AB@D@B@B@DBBA@BD@B@D@B@D@@@@@@AAAAC@C@@@C@@BCBADC@AB@DAB@H@B@B@B@B@F@D@D@B@@@DABA@@BA@@AAAEAAAECAAACAA@@AACAA@A@ABC@ADEDCDEFI@C@CBEA@@AAAECAF@@ABA@A@C@ABC@A@ABABEDA@@BCFCDEHCFAB@@A@C@@@GBA@E@G@A@A@ABEB@@A@CB@@A@A@CBEAA@CAA@AACCCAEC@ACAE@A@C@A@A@A@"],encodeOffsets:[[118803,28966]]}},{type:"Feature",id:"360112",properties:{name:"新建区",cp:[115.815277,28.692864],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@AA@@@A@@AA@@@AA@@@@@AA@@@@A@CBB@@BB@@@@@@@@BA@@BFDAD@@@@AB@@A@@@CAA@B@@@@A@@@@@@@A@@@@@@@ABCAA@@AAA@AAAA@@CBA@@@A@A@A@@@A@@@@@@@@A@@@AB@@A@@AA@@B@@@BA@@BB@A@@B@@C@@@AA@AAA@@A@@@A@@@@A@@@@AA@A@@@A@AB@@ABA@ABABA@@@@@@B@@A@BB@BA@@@A@AC@@AAAC@CA@@@A@A@A@ABEBA@CB@@A@@BA@@@AB@@A@@B@B@@AB@@A@@BA@A@@BC@@BA@AAA@AAA@@@@BAF@B@BB@@BA@AB@@B@BBB@@@B@@BBDBB@@B@@B@@@@B@AB@B@@B@@@@B@@@@BB@@ABA@A@A@@@AAA@@@@@A@ABA@@@@@A@@A@@@AA@AA@A@@A@@@@A@@AA@@AA@@AAEAA@A@A@A@@@@@@BA@@B@@A@ACA@AAAC@@AA@C@AA@@A@@DA@A@@BA@@EAA@@@A@@AAA@@ABB@@B@B@BBB@@@@AB@BCBABCB@B@@AD@BA@AB@@B@B@B@B@@BA@@@A@@@ABA@@@@@A@AB@@@A@@@@@A@@@@AB@@@A@AA@@@A@ABA@@@A@AB@@@@@B@@A@@B@@AB@@B@DB@@@BB@@@@@@@BB@@A@AB@@A@ABA@@BB@@@@@@BBABB@AB@@@@@@@@@@@@B@@@@@B@@A@ADA@@BB@DB@@BD@@@BB@@@BD@@@@@@@D@B@@@B@B@@@D@@@@BBB@AB@B@BBB@@AB@@@@@BB@@BAB@@B@BB@B@B@@BB@@B@@@@BB@@@@@@@@B@@@@@B@@@@@@A@@@@BA@@@@@@@A@@BA@@@B@@@B@@@B@@@BB@@BB@@@B@@DBDB@@@@@@@@@ABAB@BAB@@@@@B@BA@@BBB@@@BB@@@B@@@B@B@B@BBBB@BB@@D@@@@A@@A@@@@@B@@@@A@@B@@@@@@@@B@@@B@@@@@@@B@@AB@@AD@@@@@@@B@@A@@@@B@@BB@@@@@B@@@@@@@@@B@@@@@B@@A@@@BD@@B@B@@@BB@@@@@BBBBBBBBB@@@@BB@@@B@BA@@@A@A@@@@B@@@@@@B@@B@BBB@@@@@@@B@@@@@B@@@@AB@@B@B@BB@@A@@@@@@@ABA@AB@@@B@@@B@@B@@@B@@BAB@@@BCBAB@B@@@@A@@@@@@@A@AA@@@@@BB@@B@@A@@@BBABDBBB@B@@@B@BAB@@@BAD@B@@BBB@@@@B@@@@@B@B@@@B@@@B@@@BB@@ADA@@B@@B@@B@BB@@@@@@ABA@A@@BA@@@@@@@A@A@BD@B@BB@@B@B@B@@@@BB@@B@@@BB@@B@@B@@B@@@@@BB@B@@AB@@@B@B@@@B@@@B@@BB@@@B@@@D@B@@@@@DAB@@A@@@@B@@B@@@@B@B@@D@@@AD@BAJ@@AD@DABAD@BAH@B@@B@@A@@BA@@BA@@@A@@@@@@DCB@B@B@@@B@@@B@@@@@@@@BDAB@B@@AB@@@@C@@@AB@@@B@B@BBB@@@@B@@AB@@@@@@BB@@BB@@BAB@B@@@BBB@BA@@@@@@B@BAB@B@B@@@B@@@@B@@D@@B@@BAB@@AB@B@@@@@BD@@BB@@BB@@BB@B@BA@@@@B@B@@B@@BDB@@@B@B@@@BB@BB@@@BB@BB@@BB@@@@BA@@@A@C@@@@@ADB@@@BBB@@@@@A@@@@B@@@@B@B@@@@BB@AB@@B@@@@@B@@B@@@B@@B@@B@@@AB@BB@BBB@@B@BB@@@B@@BB@AB@@@B@@@@BB@@@BA@AD@@@B@@B@DBBD@@ADAB@@@B@@DBB@@A@@@A@@@@BA@@@AB@@@BB@@@BB@@BA@@B@@@BB@@B@BAB@@@B@@@@@@@@AD@@A@@@@@A@@@@DA@@@AB@@ABA@A@@BBD@@@@@B@@@@@@BA@@@@B@@@@@BB@@@@B@@@@@@A@@B@@@@@B@@@@BBA@@B@@@@@B@@BB@BB@B@BB@@B@@@@@@@@B@@@BBB@@B@D@@BBBBBBB@B@B@@@B@@C@@@@D@B@B@BBBBFH@BB@DD@B@B@B@@AB@BBB@@BBB@@@@@BB@B@BBD@@@DBB@BBB@@@B@@@B@B@BAB@BAB@@@BA@@BA@@@@@AB@B@BAD@@@F@B@B@@B@@B@@D@B@BBB@@BB@ABA@@BBB@BBDB@@@BBDD@BB@BBB@BD@B@BB@DAB@DAB@@A@ABA@@DBB@@@DBBBB@BBD@B@D@B@B@@@FDBBB@FBF@DBB@@@B@B@@@FBBABBF@BBB@B@D@@E@AACBABCBABA@@B@D@B@BB@BDDBDDD@BB@B@@@BJ@BB@BBDBBB@@BD@BBBBB@@@@BAAA@@BA@@BAB@F@@@@A@@@@A@@@A@@A@E@C@A@A@@PIFKBBBBD@DB@@B@BD@@@B@BB@@@J@@BBBBBBBBBDB@BB@AD@B@@@B@B@@@B@@HBB@@@@A@A@@@A@A@@AA@A@@D@BBHDBBFDBBJHB@BBB@DBBBB@DA@@D@B@DAB@B@@@B@@@F@BAB@B@B@@@DAB@B@@@@@B@DBDB@B@BBD@B@@B@@@HAB@\\ATDHBHBDBB@GJAA@CCOMIEEEEEAAAA@@@@@C@@@AA@ACA@CCAA@@@CA@@EA@@A@@@ABAB@FEHEBABCHIDEFIDCBABEDCDEDCBADABAJEFADAFAHAD@HAPAT@D@HAH@DAB@BABABCDCDCBA@@@A@E@E@A@A@@DC@@@A@A@A@@EI@A@A@C@CBIBI@@BA@@@@BABBB@DBBBDBBDBB@@@@DDBBBBB@DBBAFAB@B@@@B@B@FDBB@@B@B@@AD@@@@@B@BBBB@BBBBDBFBJBF@F@B@D@D@BADAJAB@B@FAL@D@BCFADAFAF@BCFAFADAHAHAB@D@F@D@DBFBHBBFLBDBBDDBBDBBBB@JDDBD@B@F@B@B@NIZOJEFC^WJGFCf]ZQBAlFH@DB@E@@DCDE@CDEBC@A@ADE@A@C@A@ACE@AEG@AAAAC@A@ABE@AFGBA@EBAAC@@AC@A@ABA@A@ABGAE@C@CAE@GBEAEBCAG@EEOECEAMEEAC@GCCAGGAACECCEIACCEAACIAAAEA@@CAA@AAGBIA@A@A@A@@@AAA@@@AA@@@@@@@ACACC@A@A@@ACAA@@AA@@B@@@@@AA@@@@@@@@AA@@@@@C@@BA@@BA@@@@@A@A@A@AAACAAAA@@BA@A@CBA@CAA@CAA@@@A@ECCCAA@AA@AA@@@CA@@AA@CBA@A@C@A@A@CB@@A@A@A@GGA@@@ADA@CDA@@B@@@D@B@D@@BBAB@B@D@@@BB@@B@@D@BB@@@@@D@@ABCDCBA@AB@BA@@BADB@@H@@@BA@EB@@AD@B@@@D@BBBBBHDB@@@@H@BA@ABA@C@@@A@@@AA@A@CA@AAEC@@AACCACAA@@CAAAA@A@ABABAB@@A@C@EAA@A@A@@@@B@BBD@DBBBBBBDDB@FD@@DBBB@BDBBB@B@B@BAF@D@BBB@BA@CA@@ABA@AA@AAA@@AA@@A@@@@@@@@DBBBB@@BF@BAB@BAD@BAB@D@B@B@@AB@BAD@@@BABA@@BA@A@CBA@AB@B@@@@BB@@DDB@@BB@@D@B@@@@G@CAAAA@ABC@E@ABC@A@A@A@ECCC@AAA@A@CAC@AAA@@@@A@AAE@CAECCAGCA@A@CAA@CAIACAA@C@G@OAEAAAGCCCCCCA@ACE@A@A@A@AAA@C@AAG@@AAAAEECA@AAA@C@@@EACAA@@AAAAAA@C@A@@@AAACCC@CAA@CCE@A@A@IDEDCDCDABAD@B@@BF@B@B@@@@@@CAAAC@CAC@@@AAAC@A@@A@ECA@@@@@AB@@AB@@A@AAA@A@@AAA@A@AA@@AG@C@@ACAGC@@A@@@A@@B@@@B@@@@@@AAAE@@@A@A@@@AA@A@AA@@@@@AA@@A@@@@@A@@A@@@IDCDCBAB@BABABA@ABEBEBA@CBABABE@AAC@@@AAEK@CA@@ACAGAEAA@@AA@A@AAEC@AAAAA@AAA@AAC@E@E@CAA@A@A@IACAC@A@A@CBC@C@A@CAA@CAA@AA@AAAAA@C@@@CDCBGDGFA@EBA@A@E@CAC@CCGCAAAAEACCA@C@CACAC@AA@@AAAAA@AAECGC@AAACA@AA@CAAA@DABB@@B@@@@B@@D@@A@A@@@@@@@@@A@A@@@A@@@B@@B@@ABA@@@@A@AA@@@@@@BAB@@@@C@@BABA@@BBB@@@@AF@@B@@B@@@B@@A@BD@@A@@@A@A@A@A@AAA@CA@@@@AB@@AB@@@@AB@@A@@@@A@@@@A@@@@B@@@B@@@AAB@@A@@@@@@@@@@@A@A@@BA@@@@AA@@@@@A@@B@AAAABA@A@@A@@@AAB@@A@@A@@@@B@@@@@ACA@@@AB@@@@@@BA@@@@@@B@BCB@@AA@@@AAB@A@@@A@@AA@@@A@@@@@@@@BB@@BB@@@@B@AA@A@@@A@@B@@@@@@A@@@@@A@@B@@@@@@A@@@BA@@AA@@@@AB@@AB@ACB@@@@A@A@@B@@B@@@@@@@@@IB@@A@@@@A@@@@A@B@A@@@@@@@@@@@AAAA@@@A@@A@@@@@A@@@@@@@@A@@A@@@@A@@A@@@@@@@A@AA@@@@@A@A@@A@A@BA@@BA@@B@A@A@@BA@@@@AA@A@@@@AA@AB@@@A@@@@@@AA@@@@AB@@AB@@@@@A@@@A@@AC@@@@@A@@AA@@@@@A@AA@AA@B@@@@A@@@@@"],["@@D@@CEBB@@B"],["@@ACCB@B@BFA"],["@@ACAA@@AC@A@BA@CBA@AB@@A@A@ABA@@BA@A@AB@@@B@B@@@@A@@B@B@@BB@B@BBBABA@A@@B@B@B@@@HEAA@CAKAKAQEMCEEGECHEFADQGBIJGKICEJCFCDAJCJCZI^INGREAA@A@@BC@@@@ACA@@AAAAA@AAA@A@@AA@@ACACAC@@@@@@AA@AACA@@A@E@AAEAO@AAE@E@E@A@C@A@A@E@G@@@CA@@C@AAA@A@A@CAA@GAKAC@@CCAA@AACACAAACAC@@AEAA@A@C@@@C@ABC@CBABC@ADAFEDABADCHGDCNMFCDCBA@@DEFIBE@C@G@EAG@AAAAE@AAC@CACAAAC@@CCCGCAEGACCC@CA@@EAEAGAG@@AA@@@AA@@CBA@A@ABA@@C@AAA@AAAAAA@AAAAAA@A@C@A@EDA@@@A@@@@@A@@A@@@AA@AAAC@@A@@C@ABA@A@@B@BADA@@@@@CBAAC@@@ABA@AA@@A@@@A@@BA@@@A@@AC@@AA@@C@AB@@A@CAAA@@A@ABADABA@AAA@AAAA@A@@@ABA@A@A@AAAA@CC@ACCAC@AAAA@AAA@A@GBCBABEBABABEBCBA@AAC@@@A@AACAC@ACCAECCAAAA@A@@@AAAACA@A@AAAAAAA@@@@A@@@A@@@ADAD@B@@@B@B@@@B@@AB@@A@@BA@@BA@@@@BA@AB@@C@A@ABABIDCBA@A@@@AA@AA@@AC@@@ABA@EB@BA@A@C@@@@@@A@@A@@@AAAAA@A@@@@AAA@@ABAB@@AB@@B@D@@@@B@D@@A@AAAB@@@@A@@B@@B@@BA@@@@B@@@@AB@@@BAB@@AB@@AAA@@@A@AB@@@@A@@@@@A@@@@@@A@@@A@@@@AAB@@@B@@A@@@@AC@@@@A@@@BA@@AA@@@AA@@@@@A@A@AB@@@@AA@A@A@A@@@@AABA@@BA@@@@@A@@@@AA@A@@BBBA@@@@@A@@@A@@@@BA@B@@B@@A@@@A@@@A@AB@B@@@@@@B@@@@B@@@BAB@@@@B@@A@@@@@A@@@AB@@@@A@@B@@A@@BBB@@@B@@@BA@@@D@@B@@@@@@BD@@@@@@@@@@B@B@@@@@@@ABA@B@BB@@@@BB@@B@DB@@B@@EB@@@@@D@D@D@BADB@@@A@AAA@@CC@@B@@@B@B@B@@@@AB@@AB@@@AA@@@A@AA@AA@@AEA@A@A@A@A@A@@AA@A@@@@@@A@@@AAA@@A@@@A@@AAA@A@@BAB@@@@@BABA@@@@A@C@AAA@@BA@@B@@AB@@A@@A@@CC@@@AA@@@ABABA@@@BB@@@B@B@@@@@@B@@B@@@B@B@@@@@B@@A@A@A@A@A@E@A@CA@@CA@@@@@A@AAAAC@AA@@@@@@@@A@@B@@A@@AA@@ABA@@@AA@@AAA@@@@@A@A@@@A@C@AB@@A@@B@@A@@@@@BBB@@@@BB@B@@@@@@@@@@@C@C@A@CCA@@BA@AB@@AA@@A@@@AB@B@@A@@@@A@A@AA@A@@@A@@BAB@@@BBBA@@@AB@@A@ADA@A@A@@B@@AB@@AB@@@BB@@@B@@BB@B@@BD@@@B@@B@BBB@BA@@@@@A@@B@@BBB@B@@@@BB@@@D@@@@@@@@@@@@@@B@@@A@@@B@@B@@B@@@@@BB@@@@B@@@@@B@@@BBAB@@@@@@A@@@B@@@@B@@@@B@@@@@@B@@@AAB@@@@@@@@@B@BB@@B@@@@@@@@@BB@@@@@B@AC@AA@@@B@@B@B@@@@@A@@@@BA@@@@@@@@@AB@@A@@@@@@B@@@B@@@B@@@@BB@BB@B@@@@@@@BB@@@B@BD@@@@B@@@BBAB@@@@@@@BABB@@B@@@@B@@@@B@B@@@@B@@B@BA@@@@BB@@@@B@@B@@@@B@@@B@@@@@B@@A@@@@@A@A@A@@@AB@@B@B@DBB@B@B@@@BA@A@@@AA@@AA@@@BE@A@@BA@AB@@@@@B@@@@B@@@@DB@@B@B@@AD@D@B@B@BAB@BABABABEBA@CBAAOAC@E@A@IDA@AB@@@BCF@DAD@D@D@D@B@@A@@B@BA@@@AAA@@@@@@BA@@@AB@@@BA@@@A@@AAAA@A@@@A@@@@@@BA@AB@@@A@@AA@@@@@BA@@@C@@@A@@@@@@A@AA@@@AACA@@AA@A@@@@B@@@@@CAA@@@@A@B@@A@@@A@@ABAA@@A@A@@@A@@AA@@@ABA@ABA@@@AA@A@@B@B@@@@@@@CA@@@@D@@A@@AAA@AA@AAA@AA@@A@@@ABA@@@A@@AAA@@A@@A@@B@@@@A@@A@A@@@@@@B@B@B@B@BA@AB@@@@@B@B@@E@A@@@AA@@@@AB@@AAA@@A@@AA@A@A@@AC@@@AA@@AA@@AAA@@CA@@@@AAAAAA@@A@@DA@@@@@AB@@A@@@@BA@@@@@@@AB@@A@AB@@@@ABA@@@@@AA@@B@@A@@@@@@A@@@@BA@@@@@A@C@A@@@@A@@@@A@@@@@@@A@@@A@@AA@@@AA@@ABA@AB@@@@@B@@@@BBA@@@@@BB@B@B@D@@BDB@@B@@@BB@@@BF@DBB@@B@B@@@B@@@FBB@D@B@B@@@BBD@BBB@BB@@@B@BAD@B@@@D@BBB@BAB@F@DAF@DA@@B@@@BCDADA@ABA@ABAB@@@B@B@B@@@@B@BA@@@B@@AB@@BBB@@BBB@B@BB@@BDBBB@@@@@B@@@B@@A@@BAB@@@B@B@B@@BB@@AB@@@@@@@B@@AB@@B@@B@@@B@BAB@@@B@@AB@D@B@@ABAB@B@@@D@F@@@@BBB@BBB@@BB@@BB@BBBB@@@BB@@@ABB@BBD@BBDBHDB@@@BBDB@@@B@@B@@@B@@@@B@@@@@B@@CB@@@@ABABEA@@@ACAAAA@A@@@@B@@@@@B@@@B@@@@@BAD@BAB@B@@@@@B@@BB@B@BB@AB@@@B@B@B@@BBB@@@B@@@BA@@@A@@@@B@@@@@B@@@B@@@B@B@B@B@B@@@B@@@@DAD@@@BABAB@B@B@B@D@B@DBD@@@DBB@@AB@B@@@BABC@AB@B@@AB@B@B@@@@B@@@BA@AB@@@@@AD@@AB@@@@BB@@B@@@@@B@@A@CB@@@@@@B@B@@@@BA@B@@A@@BA@AB@@@B@@BBB@BBB@@@ADA@@@AH@DAB@B@@C@@B@@A@@D@B@D@DB@B@@@FBBA@@BBD@@BB@DB@@B@B@B@B@B@@BB@DF@@BB@B@@A@@B@@BB@BB@@B@@A@A@@@@BABBBAF@B@@ABAB@@A@@@A@@@@@@@BB@B@@@BCB@@A@C@@@@@AB@@AB@A@C@@@@@@AB@@@@AB@@@@@@@@@A@A@@@A@A@AA@@@A@@@@@@A@@A@ABC@@AB@A@@AB@@@@ACBA@@B@BAB@@@B@BBDA@@AAA@@@@A@@@BB@@@@@BA@@@A@ABA@@B@@@@CA@@@@@@@@@@@@DB@@BAB@@@B@BAB@@BB@A@@BA@@BC@ABAB@@C@@@@@@@B@@BB@A@@@B@@A@@B@BAB@BA@@@B@B@@ABB@@B@@ADAB@@A@@@@@@BDA@@@@@B@@@B@@B@@@@A@A@@B@B@B@BBABABAB@@ABA@@@@B@@BB@@@@@@@A@@@A@@B@BADA@@@BB@@@@DA@@BA@A@@@@@B@@BB@B@B@@@DA@C@@B@@@@ABBB@DB@@AB@@@@@B@B@B@@B@B@@@@B@@A@A@A@@BA@BB@@@@B@BBBBBB@@@@@@A@@@@@A@@@@BBDBBF@@@@BB@DDB@@@@BBB@BBB@@@BDBBB@@BBB@@@@BBB@@BB@@BBB@B@@B@B@B@@@BB@@BBB@BA@@B@@@B@@@BB@@@BBB@BDBB@@@@BBB@@@B@@@@@@BB@@@@@@BBB@@@@@BD@@BBB@@D@DB@@BA@@DBBBB@@BB@@@B@B@D@BBBBBB@@@B@@@BB@@BABB@@BBB@@@B@@@BBBABBB@BAB@@A@@B@@BD@@BBBB@@BB@@B@@B@@B@@@@BB@@BB@@@B@@B@BB@BB@A@@B@@@@@@BB@@@DBB@B@B@B@B@BAB@BAB@BABAB@@@B@B@BBB@@@@A@@B@@@BB@@B@B@BA@B@@@@@@A@ABB@@B@@@@A@A@@@@B@@A@@BA@@D@@@B@B@B@@@D@@CD@@A@@B@B@@@@B@@B@@@@@@@@B@@@BBDBBD@D@@D@D@@@BBB@@@BAB@B@BA@@A@AAA@BA@A@@B@@@BA@A@@B@BAB@@@B@@@B@BA@AB@B@DAB@DA@@@@B@BBBBBB@@@BB@B@@@BDBB@BB@@@DA@AB@DD@@D@BA@BB@@BBB@@A@ABFBDBBBB@@ABCBA@@@AB@BA@ADC@ABA@A@C@ADA@A@A@ABA@A@@BAB@B@DAB@@@@A@@@@B@BAB@@A@@BEB@@A@@@@@@@D@@@@B@@@@A@@@@B@@A@@AA@A@@@AB@B@BBB@@@B@@@@A@@@@BA@@BBB@@@@@BBB@@@@@@@@ABADA@A@AB@B@@@B@@@@A@ABABAB@BA@@@A@ABA@A@@BA@@BB@@BA@@@B@@@@AB@@@BB@@ABA@@B@@@@@B@A@BD@@B@BB@@A@@B@@@@BB@@@BBB@@B@B@@@@B@@B@B@BBB@BA@@@BBB@@B@BBDB@@@@ABABAB@@A@@A@@AB@@B@@@B@@@FCBABADA@@@BBB@@BB@@B@@BB@@B@@@@@@A@AA@@@AA@@@AA@@ABA@ABA@@BA@@B@@@B@D@@@B@@EA@@@B@B@B@B@@BB@@@@@@@@A@AB@@@@@B@@@@B@@@D@@AB@@B@@@B@BABAB@B@@B@@@@@B@@@@@@@B@@@@@B@@@@@@B@@CBBB@@@B@B@@@B@@@@@@@@B@@@@@@B@BB@@B@@B@@@AC@@B@@@AA@@@@BA@@@AB@@@@BB@@@AB@@@@B@@@B@@@B@@BB@@@D@BB@@B@@@B@@A@AB@BDB@@BBA@@B@B@B@BB@AB@B@B@@@@@@@B@@@B@B@@@@B@B@@@B@BB@BD@BB@L@F@@A@@B@@@D@B@@AB@BA@@D@@@@AD@BADA@@BC@@@@B@B@@@BB@@@B@@BBB@B@BA@@@@DCDADAB@@@@@@A@@@@B@DAB@B@@@DAB@@AB@B@B@@@BA@@CGAAAAACCGACA@AA@A@A@CBAFCFAHCNEDAJCDA@@BF@F@B@BBF@DNO@@@G@AAE@AAACCEECAKFABEBKD@A"],["@@FADHE@@BLCFABALEEECC@@AA@@A@EAAAA@AACCGDCBCD@BDHDD@A@BBD@@"]],encodeOffsets:[[[118668,29502]],[[118595,29324]],[[118596,29319]],[[118625,29346]],[[118623,29343]]]}},{type:"Feature",id:"360123",properties:{name:"安义县",cp:[115.548658,28.846],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AAA@@BA@A@@@A@@@@A@A@@BA@@@A@A@A@A@@B@@@@@B@@@B@@@@A@A@@AA@@@@@@A@@@@@@@@@A@@@@@@@B@@@@@B@@@@@@A@@@A@@@@@@AA@@@@@@A@BB@@@@A@@@@@AB@@@AB@@@@@@A@@AB@@@@@A@@@@B@A@@@B@@@@@@@B@@A@@@@@A@@A@@BA@A@@B@@@@@A@@@@@A@@@@@BBAA@B@@@@B@@BA@@@@@@@@B@@AA@A@@@@@@@B@@@@A@@@@A@@@A@@@B@@@@A@@@@A@@@@@@@BA@@@@@@@B@@@A@@@@@@A@@@@BAA@B@@@A@@@A@A@@@@@AB@A@@E@@@A@@AC@A@AA@@AAAAA@@AAAA@A@@AABAA@@A@A@@@@@ACCCAACACACA@AA@@@AAAAA@@AA@A@A@@@AAA@@@AB@BA@A@A@@@A@@@AAA@@@@@AAC@C@AB@@@B@B@B@@AB@B@B@@@@A@I@@AA@AB@@A@@@@B@@@@@BB@A@A@@A@@A@@@@@@BB@@B@@@B@BA@@@@@A@AB@@@@A@@AA@A@C@@B@B@B@B@B@@@@@@@BAA@@A@@AA@@@@@@A@A@A@@@AAAA@@A@@@AAA@@BA@@@A@@@@AB@@@DA@@@@@@@AB@@A@A@@BA@A@@@@@@@@@@@ABA@@AA@AAA@AA@@@C@@@@AAAAA@AA@@@@A@@AAAAA@A@@AA@@AA@@@A@@@AAB@B@BA@A@A@ABA@@@E@A@@A@@@@A@AA@@A@@@@@@AAA@@A@AA@@@A@@AA@@A@@A@@@@AA@@@AA@@AAAA@@AC@@@AB@@@BA@AAABAAA@A@@@A@@AA@AA@BA@AA@@A@@@A@@AAAAAAC@A@A@@@A@@AA@AACA@@AB@@CAC@@@AA@AC@@A@@@@AA@A@@@@A@@A@@@@A@@@A@AA@@@@AAACA@AA@@A@@A@@@A@@@AB@@AAA@AA@@A@@@A@A@AA@A@AA@@AA@@AA@A@@A@AA@@AACA@A@@AA@AAA@A@@A@CCA@@A@@E@AAAC@A@@B@@@@@B@@@@@@@AAAAAAA@@@@@AAB@@AB@B@B@@@@A@@A@A@@@@A@A@A@@@@BA@@CAA@AA@B@@A@@@@DCB@@A@A@A@@AA@@@@@B@B@@AB@@C@@A@@A@@CBABA@@@@B@@@B@@@@@@AA@@@A@@B@BA@@BABABAAAA@A@A@@@@B@B@@A@@@@A@@@A@@@@CB@A@@@@B@@@BABC@@@AA@BA@@@A@A@@ABA@ABA@@@@BA@@@B@A@@AA@@@@@@@D@@@BABAD@@AB@@AB@A@@AA@ABA@@@A@AB@@CA@@@@@@@@@@@@DB@@@@@AB@BAB@@@B@@A@@@@AA@@B@@@@@BB@BB@AC@A@A@@BAA@@AA@@@A@ECBA@ACAA@A@E@@@gD@@E@@BB@A@@B@@@@AB@@B@@@@AB@@@@@@B@@@@@BA@@B@BA@ABA@@@@BB@@A@@B@@@@@BA@@@@B@@B@@AB@@@B@B@@A@A@@@GHAB@@B@BA@A@@B@@BBBB@A@@B@B@B@@@@@B@B@@B@B@@@B@@@@@B@A@@@AB@@A@AB@@@B@@@B@@@@B@@@@D@@C@@@@@@BB@B@B@B@@@B@@B@B@@B@@B@@@@@@B@@B@@BA@@@@@AA@@@@AB@@A@@BB@@@B@@@BB@@@@@A@@BA@@@B@@@B@@@@AB@@A@@B@B@@BB@@@@@B@@B@B@BB@B@@@BB@@BB@BB@@@BBBB@@BB@@B@@B@@ABA@AB@B@@ABCD@@@@B@@@BBAB@@@@@BB@@@A@@@AB@@@@B@B@@@B@@@B@BBD@AB@@@BA@@B@B@@ABA@@@@BAA@B@B@F@DA@AAA@@@@@BB@@BBB@@@@F@@@D@B@D@D@BAB@@B@@@@D@@@BBB@@AB@@@@B@@@@B@@@B@@AB@@BFBB@B@@@BB@@@@D@@B@@@@@@BA@@@@BB@@BBD@BB@B@@AA@@A@@@A@B@@B@@B@@B@@B@@BB@@@@@BB@@BA@@@BB@@@B@@@@B@@@@@@@@BB@@B@@BD@@@@A@A@@@@@ABA@A@BB@@@B@B@@BBBBAD@B@BA@AB@@A@@A@@CBADAB@JAB@@BBBD@DBB@@@BB@@@@B@B@@@BDB@BDBDB@@@BB@@BA@@B@@@B@@B@B@B@@@B@@@@B@@AB@B@@@B@@DB@@AB@@A@@@ED@@A@A@CBE@@@@B@@A@@@@@A@@@AAA@@@A@@AC@@@A@@@@AA@@@A@@@A@A@A@CB@@A@AB@@@@@@@BA@AB@BA@CDA@ABC@A@A@@C@@ACCCAA@@CAA@C@G@CBA@@@A@EBC@EBA@@BC@C@A@@@AAA@EBA@@AA@@A@A@@@@@A@A@A@AA@A@@@A@@@AB@@ABABA@A@A@A@@A@@@C@@@A@@@@@A@A@A@@AAAAA@E@@@A@@@A@CDA@ADAD@B@BABABAB@@AACB@@A@A@AB@@A@@BA@E@@@A@A@@B@@@B@@B@B@@@BB@@@@@@BB@@A@@@@@@@@B@D@B@@A@AB@@A@@@A@@@AB@@@@@BAB@@@@@@@B@@@@D@BB@@AB@BABADB@@@B@@@@B@@A@@@CD@@AD@@@@@@@@BBB@@@B@@@@@@@@B@@@B@@A@@@@@@@@@@B@BA@@@@@@B@@@BBBA@@B@@@AA@@@@@@B@@@@@B@@@@@B@@A@@B@@@@B@@@@BAB@B@@B@@@@AB@@@@@BBBB@@@A@@@@@A@@@@BBBB@@BBB@@@@BBA@@@@@@B@B@B@@@@@B@@@B@@@DAB@D@@@@@@@B@@@B@B@@@@B@@BBB@@B@@A@@@@B@@BBB@@B@BB@@@@BAA@@@@AA@@@@BB@@@B@BBB@@@@@@CBA@@@A@A@AB@@@@@AA@@@@@@B@@@@@B@@AA@@@@@B@@B@@@B@@@B@B@B@BAB@@B@@@@@@D@D@@BB@@@B@B@BBAB@@@BA@@@BB@B@@@@A@@B@@@@B@@@B@@@@@@@B@@@@A@@B@@@@@B@B@@AB@@@B@B@D@@B@@BBD@A@@@@B@@@B@D@@B@@BB@BAAABB@@@@@B@@B@@@@@@@AB@@A@A@@@@@ABB@@@@@BB@@B@@@@B@@@@@B@B@@@BAB@B@@DD@@BF@BBBF@@D@@@D@@@@@B@BBA@@@@@AB@B@@@B@@B@B@B@DA@@@A@A@@@@D@@@BA@@@@@@D@@@BA@@@AA@BC@@@A@@@@@@BD@B@BB@@@BAAA@BB@B@@@BB@@DBD@@@B@BB@BD@BB@@B@@DADA@ABA@@@A@@BADEB@DAB@B@B@BB@@BDBB@@@BB@@@B@B@@@@DBD@@B@@@@@@@@@B@@@B@@B@D@@@B@@BD@@@@B@@@@B@@@B@B@@@B@@BB@@B@@BA@@@BB@@@BB@B@@@@@@@BB@B@@@B@@BB@@B@@@BB@@@B@B@@@@@@BB@@BB@@CF@@@@@B@@AB@BA@@B@@B@@BAB@B@@@B@BAB@@AB@@ABCB@B@@@BB@@@B@@@B@B@@B@@@@@@@B@@B@@B@@@@@BAB@@@@@@@@AB@@A@@B@@AB@@@B@@ABA@AB@BA@@BCB@@@B@@AA@@@@A@@BA@@B@@@@@BA@@B@@@@@BB@AB@@AB@@@BA@@@@@AB@@ABAB@@@BB@@B@@ABAB@@@B@@@@@BA@@@@@A@@B@@@@@B@@AB@@BB@@A@@@BB@@@B@B@@@@BB@B@@@@BB@@@@ADA@@@@BABA@@B@@A@A@ABAD@@@B@B@BAD@BA@@@ABAB@BA@@@A@@BAB@@ABBB@BA@@B@B@@A@A@@DA@@@@B@BA@@@@@CB@@@@@BAB@@AB@@AB@@@@@B@@@BAB@B@D@BA@AB@@@B@B@B@@A@A@@@A@@@ABAA@@AB@@@@AB@B@@@B@BA@@B@BA@AB@BABABAD@B@@@@A@@B@@@BAB@BAB@B@@DDB@@BD@@B@@@BA@BBBBABB@BAB@@@@A@@B@@@@D@BB@FB@@@@ADAB@B@B@BA@@BBB@B@@@B@@@@@BB@B@@BB@@BB@BBBB@@@@B@@@@B@@@@B@B@D@@@D@@@B@@@@@BA@@B@@@@@BA@@@@B@@@@@BAB@@@@@BA@BBB@BB@@@@@@@BB@@B@BBB@@@@@B@@B@@@BBB@@@@B@@@@B@@B@B@D@@@@@B@@@BBB@@@B@@@BAB@@@B@@AB@@@B@@@B@@@D@B@BAB@@@@BB@B@@@B@@A@@@@B@@@@@BAB@@ABA@@@@BA@@BA@@B@@@B@@@@@B@BA@AB@@@B@@@B@BA@C@@B@@@@AB@B@@ABA@A@ABABA@@@@BABBB@B@B@D@@@@A@@A@@@@@A@@@A@A@@@@@BA@@B@@@C@A@@@@@@A@@@AA@@A@@@AB@@@B@@A@A@@BA@@@@@C@@@@BA@AB@B@@ABABADCB@B@BADAB@@A@A@@@@BAB@@@@@B@B@B@@@@@@@B@@A@@@AB@@@@AB@@ABABA@@@AB@@@BABA@@@@BC@@@A@@B@@BB@@@@@@@AA@@BA@@@@B@@B@BB@BB@@D@FABD@@@D@B@@A@@@A@A@@@@@@@A@A@AAA@@A@@AC@B@@@B@@@BB@@@@@BB@@@B@@A@A@AA@B@@@@B@@@B@@BB@B@B@@A@@B@@@BBBB@ABAB@@@FBB@D@B@@@B@D@FA@@@@@A@@@@BAAAB@@A@@@@@@A@ABA@@@EBA@CB@@@@@@AAAA@AAAB@B@JA@A@@@@B@@@DBBCB@@@BB@@@AB@@@B@BAB@@BB@FFTCDADCBA@@C@BE@G@@DA@@@A@@DAB@BA@A@CB@@@@@A@@@@A@A@@@@B@@BBBBB@BBB@@@@BBB@B@@@B@BAB@BABA@@B@B@@A@@B@@A@@@@@@BA@AB@@@BB@@@B@@BB@B@@@@@@B@@@@@@BB@@@BBB@@@B@B@BABA@B@@BAB@@@B@@@@@DDB@@@@B@@DA@@F@B@@A@@BC@@BA@@B@B@@@B@BBB@BBB@BB@B@B@@@@@@AB@B@@B@@@@@BB@B@@@@@B@@@@D@@B@BD@@A@A@@B@@@@@@A@A@@@A@A@@@AA@@A@@@@@@B@BA@@@CAA@@@ACAA@@@AA@@@@A@ABA@@@@@@@@@A@A@@B@@A@@AAACAA@CC@@@A@@C@@@@CACBA@@BCBA@@@AB@BBBA@@@@B@B@@AB@@@DB@BBAB@BA@@AAAA@AB@@AB@@AB@BABB@@BB@@DBD@D@RA@E@@@@BA@@B@BABA@@BB@@@B@BB@@@BB@@B@`IFBBBDB@B@BBB@@BB@@AD@@@BAB@BBB@B@BB@@BB@D@B@B@B@@ABA@@DCBA@@@B@@B@@@@@BA@A@ABA@@DC@A@A@AB@@A@ABA@@@@B@B@BA@A@@B@@@@@BA@@B@@@@@AA@@@@@@@A@@DAB@@@B@@A@A@@@AA@@@BA@@B@B@@@@AB@@@@A@@@@BA@A@@@@@A@@@AA@@AB@@@@@@@@A@@BB@AAADA@@@@@A@@@@BA@@@@B@@@@@B@@A@@AA@@@@@A@A@A@@@@@@B@@A@@AA@@AB@@@@@@AA@@B@@@@A@A@@@AB@BBBA@@@@B@@@@AB@@@@A@@@@A@@@AB@@C@A@A@@@A@A@A@@@A@C@EBAA@@@@@@@@AA@AACAC@@@A@ABA@A@@AA@@@@D@@B@@@B@B@@@@B@@@B@@A@AAC@@BBBA@ABAB@BADA@@B@@@@@@A@@BABC@ABA@ABABCDA@@@@@A@@A@AA@BA@@@A@@BA@@@AB@@@@AAA@@@@@@@AA@@AA@@@@@A@@AC@CCC@A@@A@@@AB@@@@@@@@@@AB@BA@@@A@@A@@B@@AB@@A@C@A@AB@@@B@@AB@@@B@@@@AAAAA@@AA@C@@@A@@@@@C@C@@B@BA@@DA@@BABA@AAA@AA@CA@@AAA@@@A@A@ABAA@@A@@@@B@BA@@@@@C@A@AAAA@@@@AD@BA@ABA@@@@BA@@@A@CAAA@A@@AC@@@@A@@@@@@@@B@@@@@B@@@@A@AA@@@B@@@@@@B@@BB@@@@@@BB@@B@@BBBB@BA@@B@@A@@@@@@BA@C@@BA@ABA@@@CBA@A@@@@@@@CBADAB@@AD@@@@@BAAB@A@@@@@A@@A@@@B@@@AA@@BA@@@@@A@@@@@AAA@A@@AAB@@@B@@@@@@@A@@@@A@@AB@@A@@@@@@@@A@@B@@A@@@@@@A@A@A@A@AAA@@@A@A@@@@@A@@BBA@@@BB@@B@@@@@@@@@BB@@@@@@@A@@AAB@@A@@@@@B@@@@BB@@@@@B@@B@@@@@@A@@A@@AB@@AAA@A@@@A@@@@B@@@@A@@@AA@@@@A@@A@@A@@@AA@@A@@@@BB@@@@@@@A@@AA@@@@@@@@@@@@B@@@@@AA@@@@A@A@@@@@A@@@@@A@@BA@@@@@@@A@@B@@@@@@@BBA@B@@@@A@@@@@@@B@@B@@@@@@@@@B@@B@@@@@A@@@@@A@@A@@AAB@@@@@AA@@A@@@AB@@A@AA@@A@@CAA@A@@@@@@D@@@@@@@@A@@@@@@@AA@@@AB@@@@@AD@@@@@AA@B@@A@@@@@A@@@A@@@A@A@@@AA@@AA@@@@A@@A@@AAA@GG@@AA@@@AA@@AA@@AAA@AA@@A@@@A@@@@B@@@@A@@@A@BA@@@@@@@@@@@A@B@@@@AB@A@@AA@@@A@@@@@@@A@@@A@@@@B@@@@A@@A@@@A@@@A@@@A@@A@@@@AA@@AA@@ABA@@A@AB@@A@@@A@@@@B@@AB@@@@@B@B@@@@@B@@@B@@@@B@B@@@@B@@B@@@ABBB@@@@@B@B@@B@@@B@@@B@@@@AB@AB@@@@BB@@AB@D@BBBBB@B@B@B@@ABAB@@A@A@A@@AA@"],encodeOffsets:[[118440,29506]]}},{type:"Feature",id:"360124",properties:{name:"进贤县",cp:[116.241288,28.377343],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CAAAA@A@@@A@@@@@@@EA@A@@B@@@@@A@@@@@B@@@BAAA@@@@B@@A@AA@@@A@C@AA@A@A@@@@BB@B@@B@@@@A@A@A@@A@@@@A@A@@@A@A@@A@@@AB@B@@@@@AA@@@@B@B@B@@A@A@@@A@@@@BB@@@@@A@A@AB@A@@@@@AA@@B@@CA@@@A@@A@A@A@@A@@@@C@AA@BABA@CAA@A@A@@@@@A@@@@@@A@AAAA@@AA@AAA@@@@AAA@AA@@C@@@A@@@@@AA@@@@@@@@@AB@@A@@@BA@AB@A@@CAA@@@@AA@@AA@@@@@@AB@@AA@AC@AAA@@@@BA@@@A@AAC@@@@@@AB@BA@@@@@A@@AABAA@A@@A@@@@@A@@@AB@@@@AB@@A@@BAB@@A@A@A@@AA@@CA@@AA@AA@BC@@C@AA@@@C@@A@@@@AB@@@B@@@@@@A@AA@@A@A@@A@@@BA@@B@@AB@@@@@@B@@@@@@B@@@ABBB@BAB@BAB@@@B@@@@B@AA@@@@DA@A@A@@BA@@@@AABAAA@@BA@A@@A@C@A@A@C@CBCBABA@AB@B@BABADAD@BABCDABABABABCBGB@@C@C@GBI@C@C@C@A@A@EBA@ABABEFGHABCFAF@@@BBD@B@DABELEJADCDABA@CBEDCBCBCDA@CBA@CBCBABIDCBEBEBA@ABGFCDCDABCBCDABAFABA@@BCDAD@FAD@D@BAB@BADCDFDBB@BB@AF@D@DEJCFCDCF@BAD@B@BBBBD@BB@DBBBDBBFBBBFBB@@AB@@ABCB@@A@C@C@E@A@A@A@A@G@A@CBA@@BCDABAD@@@D@@@DBDBB@B@@@@C@A@C@A@C@@AABCAA@A@C@A@@BA@AB@B@@BB@D@@@B@BAB@@A@BBB@@B@B@B@B@BA@@BBB@D@B@B@@A@@@CBC@SDA@CBCDCBABEDADABCBCFADAB@B@D@BAD@DBD@D@BBF@DBB@BBDFDBDBBAB@B@BAB@D@D@F@BA@AHAB@BAB@BB@BBBBBD@B@B@B@BCFCD@BAD@DAF@FAFBBADBD@BB@@BB@DAB@@@B@DDBBB@@B@BB@@B@BBDBF@D@B@FBF@BB@F@@@B@B@B@FBD@B@F@B@@@BABA@AB@B@BB@@BA@@B@@@@@BBB@BBB@@B@B@D@@@B@@D@B@B@B@B@DABABCB@@A@@@@FA@@B@HABAB@B@@@@@BABAB@AAB@B@B@B@D@BAD@B@B@@AB@@A@@@@BEBABADAB@@C@ABCBA@@B@BAB@HBDBHBBBB@DFBB@B@@DFDDBBBFBDBBFDDDFD@BDDBDDJBH@D@N@DAFAB@D@@AF@J@B@D@D@F@DB@@DBB@BFHB@DDFFBB@B@B@D@F@DBB@B@@B@FBBBD@@BF@FBB@FBHBFBHBB@NFHDDBFDFDBBFDB@@BDFDDBBBFDHBF@@BBDBFB@@D@BBB@@@@B@@@BAD@@@@BBB@@B@DBHB@@BB@BB@@@BB@@@BAB@@@@@@BBDBFBB@B@@B@@@BD@@@D@@BDB@BBB@BBJBD@BBB@D@BAHCB@J@F@B@B@HFB@D@B@HDDB@BBB@@DB@@@B@B@BBB@B@@AD@B@@@B@@@@B@BB@BBB@B@D@BB@@BBB@@@@@B@@@B@B@@@BA@@@@@AAAAC@@@A@AC@@@@C@@B@@CA@@CDAB@@@B@BCD@D@@@@A@C@A@@@ABABA@ABA@@B@BBB@BA@@BAB@DAB@@@DA@@@@@A@@B@BA@@@@BAB@BBBBB@@@@@B@@FBBBB@FDDBBBB@DD@DDD@BB@DBDDDDBBBB@@BDBBDFBDBBBF@B@D@B@BA@@BCD@B@B@@ABAD@@@@A@A@A@AHABABAF@@@@B@B@F@D@D@BBB@BB@@DDFHBBD@FBHBD@DBDBBDDBDDHDHFDDB@BBJDHBHBFBBBB@FAD@@K@C@CB@@ABAB@BA@@DA@@@@@@@BB@BB@@@BB@@@D@@@BBB@@@BB@BB@@BAB@DABA@@BB@@@@B@B@BAB@B@@BB@@@@BA@A@@AC@A@AB@@ABA@A@@B@@@B@B@BA@@B@@@@B@@@@AB@@@B@BB@@@B@@@B@@@DA@@@@AA@CAAAC@@@ADE@@DADEBABCBC@ABA@@DANCDADAD@FAD@FADAJAFAHCJAB@BABABADCBAFGB@BA@ADCB@@@BCBA@ABC@A@C@A@@AE@CA@AC@AA@@A@@@A@@BA@A@@@@@A@ABA@AAA@@AAAEACAA@ABA@A@AB@@@BAB@BB@@B@B@@A@@BA@A@A@A@A@C@CBABC@A@@AAAEACCCACA@@A@@@A@C@C@A@A@@BADCBCFEBAFADCD@@@@@BC@@BABCBEDGBA@A@@@@@C@@@EBAFKBA@G@E@ABA@@@AB@@@BEB@BGBA@A@ADC@ABC@@@ABA@@BA@A@@D@FCJABAHCFCDCHC@A@A@A@EBA@C@@@AB@@@@@@@BBBB@@BBB@@@B@@@BAB@@ABA@@DA@ADA@@@@BCBA@@@A@@@A@@AA@@@@BA@@BABAB@@ABABCFCHGHEHGBADCFEB@@A@@@E@@@@B@@@BD@@BB@@BA@@FAB@@@BC@@A@@@A@@AB@BAB@@A@@@@CA@A@@@@@A@@DB@@@A@A@@@A@A@@DA@@@B@@@BB@@@@A@@B@BAF@@@@@BA@@AA@@AA@@@@@@A@B@A@AACCA@@@BAB@@@@@@A@@@A@@B@@@@AA@A@@@A@AAA@@A@@@C@C@AA@@AC@@@@@@A@ABA@@@@B@@D@@B@@A@@@A@AA@@A@@@@BA@A@A@@A@A@@A@@@@B@BA@AB@AA@@@@@A@AB@@BBB@@B@@@@A@@AA@@@A@A@A@A@@BA@A@CBC@A@A@@AAA@@A@AA@BA@A@@AA@A@A@@@@D@@@@@BAAAAA@@@A@@B@@@B@@@B@@A@@@AB@B@@@@B@BB@@@B@@A@@@@@A@A@@B@@AB@@@AAA@@AAA@@B@B@@@@A@@@A@@CAAA@@A@A@@@@ABA@@B@D@@@@AB@@@@AA@@@AA@AB@@A@@@@@AAA@@@A@@@AAA@@A@AB@@A@@CAA@@BABA@@@@@@AA@@CA@A@AB@@@@ABA@@AA@AA@AA@@@AAAC@A@A@@AA@@A@@A@AAB@@AAA@@A@A@@@A@A@AB@@@@A@@A@@A@@@AA@@@@A@@AA@@A@@@@@A@AAAA@@A@A@@AB@@A@ABCB@@@A@@A@@@@@@@@B@@@@CAC@AA@@BA@@AAAA@@BA@CBC@AB@CAAAA@A@A@A@@@A@ADA@@BA@AA@@@AB@@A@@@A@@@A@@A@AA@@AA@@@AB@B@@@@@B@BB@@@A@@CAA@A@A@@@@@B@@BA@@BC@AA@@@ADA@@BABEA@@@@@@DAB@@A@@@A@@@@A@@@AB@BA@AB@@A@A@A@@@A@@@A@@@@AA@@B@BA@A@@BCAA@@AA@@AACAA@@@@@@@A@@B@@AA@@A@@A@A@A@CAABA@A@A@CBA@ABC@@@AA@@@@@@@@@A@@@A@@A@A@@@ABA@A@@@@DB@@@@@A@A@@AAAAAA@@BA@EBA@AAA@@D@D@BAFCB@B@D@B@D@BAB@@AA@A@A@@AA@AA@@@@A@@@AAC@AAAAA@EA@A@ABC@A@A@A@A@A@A@@@A@@AA@@A@A@@@A@@@@@AAC@A@AA@@A@AAC@A@A@AA@@@@A@@AA@A@@@A@AAAAAAAA@@@@A@AAA@@AA@@@@A@@@A@@C@A@AB@A@@@@@@@A@@BCBA@A@@@A@ABC@@@@@@@A@@@@AA@@@AA@@@@@@A@ABAAC@@BA@@@C@@@@@A@BA@@B@@A@@@@AA@AC@@CC@A@@AE@@@AA@@A@@@C@@AA@@AA@AAC@A@@BA@@A@@ABA@@@@@@A@AAA@@@B@@A@@@@A@@@AA@@@AAA@@A@A@@AA@AC@@A@@@AB@@AB@@A@@DAB@@ABA@@@AAA@@B@@A@@@A@@AA@@AAA@@C@AACAA@AAA@A@A@CB@@A@@AA@A@@BA@C@ABA@A@@@ABA@A@C@AAC@ABA@@BA@@@C@@@A@A@@AAAAAA@A@@@AA@@AAC@A@AAA@@@A@A@@@A@AAAA@@A@CCC@@@@AA@A@A@@@@@A@@@@B@@@@@@AC@@@@AB@@@DAB@B@D@@@@@@AB@B@@@@@@AA@AA@@@@C@ABC@CA@BAAE@AAA@A@@A@@@@@ABBBBD@@@F@F@@@@@@A@@@AA@A@A@A@A@@A@@@BA@A@@@@A@@@ABAA@@AA@A@@AC@@BA@ABA@@@AAA@A@@AABA@AA@@@AAA@@@A@A@A@@AA@@B@@C@AAAA@@@@@ABA@AB@BABA@@@A@A@@@@@AAAAA@@A@A@@A@@@A@@@@@AC@A@@@C@@@C@A@@@@@@B@@@@AB@@AB@@A@@@@B@@@B@@DB@B@@ABB@@B@@B@AB@@@@BB@@@@@B@@@B@B@@B@@@B@@@@@@BA@@@@@@@@B@@@@@@@B@@ABB@B@@B@@@B@@@@AB@B@@AAA@@@@B@B@@A@@@A@@A@@AB@@@@@B@@A@@BB@@BA@AA@@@B@@A@@@@@A@@A@@AB@@A@@@@@@D@@@BA@@@@AAA@@@D@@@BA@@@@A@@@@C@BA@@@@ABA@@BA@@B@B@B@B@@@B@@A@@@@C@C@@@A@@@@@B@BA@@BBD@@AB@@@@@AA@@@@B@B@BA@@@@B@@@AA@@@@@A@@@@ABAA@@ABA@@@A@@@@AB@B@BA@@@@@CA@DA@C@@AA@@A@@@AAAB@@@A@@A@B@@AB@@@@AAA@@@@@@B@B@@A@@@@B@B@@@@@@@D@BB@BB@@@@CB@@ADABABA@C@@@A@@@@@@B@@B@@@BBBB@BA@A@@@@@A@@AC@@@@@@A@@@A@@B@@A@@B@@A@A@C@@@A@AB@A@@@C@@BA@A@@@@@@ABA@@AA@BA@@@@B@B@@A@@@@@@AAA@@@AAAAA@ABC@@@A@@@A@@BB@@@@B@@@@A@C@A@@@@@@B@@AD@@A@A@@@A@@@AAA@A@@CE@@@@@@B@@@AA@@@@@A@A@@@A@@@@@A@@@AB@AA@@A@@@@A@@@A@@@@@@A@A@@@A@@@@@AB@BA@AB@@@BA@@@AB@BA@@@BB@@A@@B@@AB@@A@A@A@@@A@@@A@A@@@@@B@@A@@@@AB@@@AAA@@@@A@A@@@@@A@@@AA@BB@@@@B@@@B@@CA"],encodeOffsets:[[119080,28900]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("萍乡",{type:"FeatureCollection",features:[{type:"Feature",id:"360313",properties:{name:"湘东区",cp:[113.733047,27.640075],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCCGKGECICICKAM@G@G@MDKJGJIH@@YFK@CAGCGCGCIBGBCDADCH@BGJ@BGLALAL@JBHBBDDJHPJBB@@DB@@HBD@D@BBBBBD@BABIBCBA@BBB@BD@D@DABCBA@CBG@E@EAE@A@CBCBABAB@B@DCFA@@B@B@B@H@DBP@JBDA@EPEJAD@DA@BBB@DBF@DB@@BDBB@@BBBBL@DBFBAD@D@B@B@BHHBD@@DHBBDBB@DD@@B@@DA@EFAB@@BD@BBDBDFD@B@BAH@BA@A@A@AB@@ABBJ@BA@A@@AA@ACACCEEGEAA@CBC@EBC@CD@@ABIBKDEDABGNAJ@B@PBBLVNRBBBFHPFNBDBJ@FDNBFAT@@DP@HJPFHBBHHRJDBNHBBNJNLDDBBJLFL@@FPHRHJ@@DBHFNLFFFDJJ@@@DJA@A@@B@@@B@B@B@B@B@B@B@@BBA@BB@BB@@B@@@B@@ABA@@B@BBBBB@B@@@BB@B@@@@@@BBBB@@BD@@@@@@BA@@@@BA@B@@@BB@@BB@B@@AB@@AB@@@B@BBB@@@BBBB@@BAD@D@B@DB@@BC@@@@B@BBB@@@DBB@@@@A@@BA@@@@@A@A@@BCBABA@@@AAAAAA@@@@A@@A@AAA@@@@@@AA@@A@@@@CB@A@@AA@@A@@@@AAA@AAA@@A@@@@A@@@@@A@@AA@@@A@@@A@@B@@A@A@@@@@@@@B@@@@A@@@@@@A@@AA@@@A@@@@A@@A@@A@@BAB@BA@@@AA@AC@@A@@@@CA@@AA@AA@@@A@@AC@@BABAB@@A@@@@A@@@A@@A@@B@B@B@BB@@@@@@@B@@BBB@@@@B@@@@B@@BB@@B@B@@B@@@B@B@@BB@@@BB@@D@B@B@BB@@B@@@B@@@B@@@@BB@@@B@@B@@BB@@DB@B@BB@@@B@B@@B@@@@BB@@B@@@D@@B@@@B@@@@B@B@@@B@@B@@B@B@@BB@@@BB@@BB@@B@B@@BB@@BB@@@BB@@@@B@B@B@@BDB@@B@B@@@B@@AA@@ACCAACA@@@A@@@ABAB@@A@@AAAAAAA@CA@A@@@@@AA@EACA@@A@@A@A@@DA@A@@@AA@@A@@@ABC@@BA@@@@@@AAA@AC@@@@BA@@B@@@B@@@B@@A@A@A@AA@@@CAC@@@@A@@@ABAB@@@@A@@@@A@A@@A@@@@@A@@AA@@BA@A@AAC@@BAB@D@B@@@B@BB@@@BB@@@B@@ABAB@@@@@B@B@@@B@B@@AB@@AAA@A@A@ABA@@AAAAA@AA@@@@@E@A@AAACC@@@@@AB@@@B@@@@@@A@@AAAA@@@A@@@@A@@@ACAA@@@@@@B@@@@@@A@@BA@@@AAK@AA@@A@@DC@@@ABAB@BA@@BA@@@A@A@AB@@CBA@@@@B@@@B@B@@@@BABB@@@@@B@B@@@B@@ABA@A@ADCB@@@@@DBBBB@B@BBDDDB@BB@B@FADBB@@A@@@@@AAE@A@@DA@@@@@C@@BA@A@@@A@@A@@@@A@@D@BAB@BABA@CBA@A@AB@@AB@@@@A@@@A@@@@@@B@@@BD@@B@@@DB@BB@D@BA@@@@@A@A@A@@B@D@@@B@@@@ABA@@DA@@B@@A@@AA@@CAA@ECCC@@CAAAA@A@AB@@@A@@@@@@BA@@@@@AB@@A@@B@@@DADC@@B@B@BA@BB@B@@@B@@@@@@A@@@@BAB@@ABC@@B@@ABEBEBC@@BA@@BABADABAB@@AB@@ABABABA@C@@B@@AB@@@@ABABA@@BADAB@@A@@@A@C@A@AA@@AA@@@@AB@AAAAA@@A@AA@@A@A@E@G@@@A@@B@BA@A@@A@@A@A@@@ABABCB@BABAB@@@@BB@@@B@@BBBBB@BB@@AB@BAB@D@B@BBB@@@FBB@@@B@BA@@D@@@B@@A@A@A@AA@EAAAA@CCA@@A@@B@@@D@@A@@@@@AC@CA@AA@@A@@@C@A@@AA@CAA@@BA@@@AB@@ABAB@BABCBABA@@@AB@@AA@C@@AA@@@A@@@A@ABGBCBABABA@C@C@A@A@A@ABABC@ABA@G@A@CBA@@@@CB@@AB@DAB@B@@A@@@A@@@AA@A@ABCBA@AB@BA@@@A@@@@A@@@A@ADABAB@@@@A@@A@@AA@ABCBA@CBA@@@@@@ABA@A@@@AA@@A@@@@BA@@BBBBB@@@@@@AAA@@AE@A@@@@@ADA@@@A@@@@@A@@@@@A@@@@A@@A@@@@AA@@@AB@@AB@@@@@@A@@@@@A@@@A@A@@@A@AB@BABA@C@@BA@@@A@AAE@@@@@@DAB@@@@A@A@@BAB@@A@A@AACBA@A@@BA@@B@BBBBBBB@B@B@B@B@BBB@B@@@DA@@DA@@@@@A@@AAC@@@@@@@@AB@B@@AB@B@@@A@@A@@@A@A@A@@A@@@A@@@A@@A@AA@@@C@A@@@@A@@DABABA@AB@B@@AB@BADCBAB@@AAA@@@@BAB@@@@AB@@@B@BA@@BA@@BAB@F@B@BBBB@@B@B@B@@@@@@@@AB@AA@@CCAAAAA@A@@B@@CCAA@@@AAAAC@ABA@@@@BA@@@AB@B@BA@@B@@A@@AA@AAA@A@AAA@AAAAAAC@AAAACCC@@AC@@GCKEOCMCAAKCIEECAACAG@GBEBIDMAIAGAMAEACCSAIBGAC@OECAGCGGAAAAECKBEDG@IAAA"],encodeOffsets:[[116452,28005]]}},{type:"Feature",id:"360321",properties:{name:"莲花县",cp:[113.961488,27.127664],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAE@IDC@QDC@KBCHCJGJIJCDCBABEFAP@LAJEP@@EDG@IAC@A@C@C@CD@JAJCHIDI@IBI@EDCHAFADALCRATAVCRCPIBA@EEEEEGIEI@A@EBIHCHAB@LDL@F@L@HBHBD@DBHFJLL@BFDHJLJFFPPLPHTHRFH@BDFDDHJLNPPDFBFFNBNBPBJBJ@@FTHNLJDHBJAHEF@@KDI@EH@F@DBDBFFJDJBDHPFLDNBFBJ@NBJ@HBNBPCDCBGCBD@@DDBDBB@BBDBBBB@BBB@B@BBB@BBB@@@BA@@@ABA@A@@B@@AB@@@@AB@BBDBB@B@@BBDD@@@AB@B@BBBBDD@@BBA@@B@@@@B@@@BAB@AA@A@A@C@@AA@@@ABA@AB@BAB@@AA@@@@A@@B@@AD@B@BA@@@@@@JB@@D@DBDBFBB@D@B@B@@@@B@FBDAD@BB@@@BB@B@@@BA@B@@@B@B@B@@@BBBBDB@@B@D@@BB@BBBBB@@B@B@BAB@B@D@BAB@D@D@BBB@B@D@B@BAD@D@B@BBF@BBDA@@@BB@@B@B@@@@@D@B@B@FBD@B@@@BB@BB@B@@@B@@@BAB@B@@@B@B@DBH@DA@@D@FDB@@@@@@ABC@C@@BA@ABABC@@BEAE@@BABA@ABC@AB@DCFCB@BABABADCBABA@@BAFCB@FAF@B@BADA@BB@BBBB@@BBBBB@B@FA@@B@BAB@BA@@BA@@B@BB@@B@BD@BBB@@B@B@B@FADAB@BABADAB@@@@@B@@A@@@AB@@@@@AA@A@@@A@@@A@@@AA@@@@A@@@C@@AA@@@AA@@A@@@AA@@A@A@A@@AA@@@AA@@@AAA@@A@@A@@A@@@@@A@@BA@@@@@A@@@A@A@@@@BA@@BA@@BA@@@@A@AA@@A@@A@@AA@@A@@A@AB@@ABA@@BA@AB@D@@A@@BCBA@A@A@@B@B@@@FCBBB@@@B@@@@@DAB@@ABB@@@A@@A@@A@@B@AAB@@@B@@BB@@@BA@A@@B@@@@@B@@AB@@@B@@@@@@@B@@@B@@@B@@@B@@A@@A@A@@AA@@@A@@@@@@A@@@@@A@A@@A@@A@@B@B@@@AA@@@@B@B@@A@@B@@@@@D@@@@AAA@@A@AA@@@@@@B@@@BA@@@@B@@@@A@@BA@A@@B@@B@@BA@@BABA@@@@@@BB@@@@B@BA@@B@@@D@@@@A@A@@B@@@D@@B@@B@@@@CBA@A@A@A@A@@@@B@BA@@BA@@@A@@@C@A@A@@@C@C@@AAFC@@BA@@@@@A@@@A@@@@B@B@BD@@B@B@@@@@AAB@@@A@B@@@@A@@@@B@@@@@@AA@@@BA@@B@B@BA@B@@@@B@BB@@B@B@B@B@B@FA@@@@BA@@D@@@B@@@@@BA@@@@@A@A@@B@@@@@@A@@@@BB@@B@@@@@BB@@B@@@@@@A@A@@@A@@EC@AB@B@B@@ABABA@@BAB@@@AA@@DA@@B@B@@A@@@@BA@@B@@@B@@@B@@@BA@A@A@@@A@@@A@A@@B@@@B@@A@@@B@@@B@@B@@@@B@@B@@@BA@BB@@@BA@@B@@@@@@BB@B@@@BB@DBDB@F@BAB@B@@@B@B@@@@@B@@@AA@@DA@@@BB@@@BB@@@@BA@@@@B@@D@B@@@B@@BB@@BB@@D@@@@@B@@A@@AABAA@@A@A@@@A@A@@@@@AA@@@AA@A@@@A@AAA@AAAAA@A@@@A@@AA@@@@BA@A@@AAB@A@BA@@@@@@@@@@B@@ABA@@B@@AB@@A@@@A@@BA@@@A@AB@B@@AB@AA@@@@@C@A@@@A@@@A@@@A@@BA@@@AAC@A@@AA@@BA@@@ADC@C@G@AAABC@@B@BA@@BC@AB@@A@A@ABC@@B@@A@AAAAA@@@A@@B@@A@@B@BBBB@@BA@CBA@A@@AA@@AA@A@@CA@@AA@AC@@@BAA@AA@@@@A@@A@@A@AA@@AAA@@AAA@@CAECAACACCEA@AA@@BA@AA@AA@@@@@A@AAA@@@AAAAAA@AA@@@@BADABA@AB@@CB@@A@GAAA@@AACCIGCAEE@@@AACAC@A@AB@BCBA@AB@BA@@@@@A@@AACAAAAACC@AAIACACAC@@@AAA@A@AAE@C@A@AAACE@@AA@AA@A@@@EC@@@AA@@A@C@@AC@@ACA@@AA@@@AB@@ABA@C@C@CBMBCBA@K@CB@@AAEE@CA@@AA@AAEAEEAAAAEE@AA@@A@@AAAC@@CAAA@@@A@@AC@@AAAAAAA@AA@C@@CC@@A@ACAC@AAC@AAAAC@@AA@C@A@AACAA@@@A@E@CA@@A@C@@@AAA@@AA@A@AA@AAA@CCA@AAA@@AAC@@@A@@@C@A@A@E@A@@@ABABCB@B@@@DCBA@@@A@@AAAA@A@AAA@@A@@@@A@@BA@A@@@A@A@AAA@AA@@A@@AAAA@@@A@A@@A@C@C@AAA@@@@@@A@A@@AA@@@A@@AA@@CBA@@AAAAA@AAAAAACAA@@@A@@@@@A@A@@A@@A@@@AA@@@A@ABC@C@C@@@AACA@CAA@@AA@AB@@@CACAAAAA@@B@@CAC@A@G@@@CBA@A@@B@@ABCBC@@BADC@@@AAACC@@A@AAA@A@C@C@A@A@C@@@@@A@A@AA@@@@CACA@A@@AAA@CA@@@A@A@@BA@AA@AC@A@@@A@@C@CA@@A@@AAAAA@AA@@@@A@A@C@A@A@@AAAA@ACAAA@C@@@@AABA@@@ACA@@AA@@@@@AA@@@BA@@@@@AA@@D"],encodeOffsets:[[116656,27608]]}},{type:"Feature",id:"360302",properties:{name:"安源区",cp:[113.870704,27.61511],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BA@@AAB@@A@@@@@A@@AAAA@A@@@@BB@@B@@ABA@A@AB@@A@@@@AA@@@@AA@@A@@@B@@A@@@A@AB@BCBABA@@@@@A@@AA@@AA@@@BC@@@@@@@@A@@B@@A@@@@@@@AA@@@@@@@BB@@@@@@@BA@@@@AA@A@@@@@B@@B@@@@@BA@A@@B@@AA@@A@@AA@@@A@A@ABA@@A@@@@@@AAA@C@A@@@A@@AAA@AA@AA@@AA@AAA@@A@A@@@@AA@CA@@@A@@@@@@@A@AA@@AC@@@A@@AA@@@@A@@A@@AA@@@A@@B@@B@@BB@@B@B@D@B@@@BA@CBAB@@ABAB@B@@A@@BA@@@@DABABAB@BA@@BA@ABCBABAB@@AB@@ADAFAF@BA@@@AD@BA@AB@@@@@B@@@@A@@@A@A@@AABA@A@@@CDCB@@A@@@@BA@@B@@@@AB@@@@@@@B@@BAB@B@BBDB@@DDFDB@DB@@BB@@@BA@@@CB@@AB@B@@A@@@C@A@@@@B@B@B@@@@ABC@A@@ACA@@A@@@AC@@A@@@@@@@@B@@@B@@A@@BA@@B@BAB@DABABA@ABC@@@@B@@B@@@@B@@@BAB@@@D@@@@CB@@@BBF@B@@@@@BA@CAEBA@A@@ACACCAAA@A@AACA@@@@A@CD@B@BAB@BA@@@A@A@@@@@A@BA@A@@A@A@@@A@@@@@AB@DA@@B@B@B@@AB@@ABA@AB@B@@CD@@@BB@@BBL@B@@AB@@@B@@@@A@@@@@@@BBBD@@B@@@@@@B@@BBBB@@@B@@@@A@@@A@@B@@@@DDBB@B@B@F@@@@BBB@BBBB@@AB@B@B@BBB@BA@@BA@A@@@A@A@@@@@A@AB@BA@@@A@@A@@AAA@@@A@C@A@AB@@BD@B@BAB@@BB@@@B@@@@@BB@B@@@@@@B@@A@AB@B@@@B@@D@DB@@B@@B@B@B@BA@@@A@@@A@@@AB@@@@BDB@BB@@@@@@AB@@AD@B@@@BB@@B@@@BCB@@@B@BB@@@DBFBB@@B@@@@@BDBB@BBBBBB@@@BA@AB@B@@@B@@DBBBDD@BB@@BA@@@@@@B@B@@@@BBB@@@@@BA@@B@BB@@D@@@BAB@@@B@@B@@@@@B@@@@@@@@B@@B@@@B@@BBB@@B@@DBB@BB@@B@@@B@@@B@@BB@B@B@DC@@@@DAB@@AB@@@@@@AA@@AAA@AA@@@AA@AAAAA@@@A@AA@@@@@A@AA@@@@AA@C@@@@AAAB@ACB@AA@AC@A@@@ABA@A@A@@A@@BA@@@@@BAA@@A@A@AAA@@@@@@A@@@@@A@@@AA@@B@@A@@@@A@@AA@@@@@@ABA@@@@D@@@FA@@AC@A@@@@@A@@@@@@@A@A@A@A@@BA@@@@BA@BBAB@B@@@B@BAB@B@@@B@@A@@B@@@B@@A@@@@B@@@BAB@@@B@B@@@B@@@@B@@@@B@@@@@B@BA@@@AAA@@B@@@BBB@@@@@A@@A@@A@@@B@AA@@@ABA@@@@BB@@@AB@@@BA@A@@B@B@B@@@@A@@@AB@@AB@@@@A@A@AAA@AAAAAAA@@@@B@@A@@@A@@@AB@@A@@@A@A@@@@DA@@BBBB@A@@B@@@@@B@BB@@@@@B@@BBB@B@@@BB@@@D@@@@B@@@BCB@@@@@B@B@@BB@@@@@BA@@@@@@@A@A@@@@B@@@BB@@@@@@@B@@B@@@@BBB@@@@@B@@B@@@@B@@@B@@@@@@@B@@@@@@@@@@@B@@@B@@@@@@@@A@B@@@@@@D@@@@B@@B@@B@@@@@@@BAB@@B@@@B@@D@@@@@@@@B@@@@@@BB@@@@@@B@@A@@BBB@@@@@B@@@@C@@B@@@DCDC@@@@@@@AB@@AB@@@@@D@B@@@@@B@@@D@@@@@B@@A@@B@@B@@@@B@B@@ABA@@B@@@@@B@@@@@@@@@B@@A@@@@@A@@BB@@@@@@@B@@@@@B@B@@@@@@BB@@@@BA@AB@@@B@@@@@@B@@BB@@@@B@@@@@@B@BBB@@@@BB@@@BB@@B@B@@@BA@@BA@@@@@@@DB@A@@@@BA@@D@B@@@@@@@@@B@B@@@@@@@@B@D@@@B@B@@@@B@@B@@BABBB@@@@@B@BAB@@@@@BB@@A@B@@B@@BBBD@B@@B@@BB@@@@@@ABAAA@@@A@@@A@@@AA@@AB@@@BA@A@@@@A@BA@@BABA@@B@B@B@FBD@@@BA@@B@@@BB@@@@BA@@BB@@B@B@B@B@@@B@@BD@@@FBB@BB@@BBB@@@AA@@@@BA@@@@@@B@B@@@B@B@B@@@@@B@@@B@BA@@@@B@@@B@@@B@BBB@@@@@@B@BDBBBBB@D@@AD@@B@@@@BA@@@BB@@@@@@B@@A@@@@B@B@@@@@BD@@B@B@@@@BB@AB@@@B@@@@@BB@@@@B@B@@@B@BB@B@@BB@B@B@@BB@@@@@BB@@BBB@@@@BB@D@@B@@@B@@@@A@BB@@@@@BB@B@@@D@BB@@DBB@@@@@@B@@@@B@B@@@B@B@B@@@B@@@@@@AB@@@B@B@@@B@@A@AB@@A@A@@BB@ABBBABA@@BA@@@AA@@A@A@@@@BABA@@@A@@@@@A@A@@@A@A@AB@@@AABA@@@@@A@@B@@A@@A@@@@A@A@@@A@A@@@@A@@@@AA@A@@@@AAA@@B@A@@@@A@@B@@AB@@@@A@@A@@@@@@@@@@@@A@@@@@A@@@A@@@@@A@@@@@AB@@AB@@@@A@@@@@@@AB@@A@@AAC@@@@AAA@@@A@@@AB@@A@@A@@@@ABA@A@@@@AA@@A@@AB@A@@A@C@@@@@@@@C@@A@AA@@@@@@A@A@@@A@@@A@@BA@@@@AA@A@@A@AAA@@@@@AA@@A@@@A@@@A@@@C@@@A@@@@@A@BA@A@@B@B@@A@A@@AA@@A@@@@@@AB@@@B@B@@@@BB@B@@@@A@@B@@@@@@A@A@@@@@@@A@A@@@ABAB@@@@A@A@A@@@AA@@@@@A@@@B@BA@@A@B@@@@@AA@@A@@@@@@@@@@@A@@@@A@@@@A@@@@A@@@@@@B@@@@@@AA@@@AAA@@@A@@BA@@@@@C@@@A@@@@A@@@@B@D@B@@@@@@A@@@@@@A@@@A@A@@@@@A@C@A@@@@@A@@@@@@@@@@A@@A@@@@@@@AA@@@A@@@@@@@A@@@@@@@@@@B@@@@@@@@@A@@@@@@AA@@@@@@@@@@@AB@@@@A@@@@@@@@@@A@A@@A@@@@@AA@@@@BA@A@AAA@@@@A@BAA@@@AAA@AAA@ABA@@@AA@@@A@@A@@@@A@A@@@@@@@@A@@@@@@A@@AB@@@@A@@@@@@@AA@@@@@@@@@A@@@@@@@@@@@A@@B@B@@@@@BB@@@@@@@@@B@@@@@@@@B@@@@@B@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@B@@AB@@@B@@@B@@A@@@@@AA@@@@@@@@@@@A@A@@B@@@@@@@@@@@BA@@@@B@@@@@@A@@@@@@@@@@A@@@@@@@@AA@@A@@@@@B@@@@A@@@@@@@@@AB@@@@@@@@@B@@@@@@A@@B@@@@@@@A@@A@@@@@@@A@@@@@@@@@@@@@@@@BA@@@@@@@@@A@@@@@@@@@A@@BA@@AA@@@A@ABA@A@@@@B@B@@A@@@@@@@@AA@@A@@B@@CB@@@AA@@@BB@@@@BB@@A@A@@@A@@@@@@BA@@@@@@@@A@@@@@@AB@@@@@A@@@@@A@A@@@@B@@@@@@AA@@@@A@@AB@@A@@@@@A@@D@@AAA@@@@@@BA@@@@B@A@@@B@@@@A@AB@@A@AB@BA@@@A@A@@@@BA@@@A@AB@@AAC@A@AA@@@AA@@A@@@@A@@@@@A@A@@@@@@@@@A@@@@@@@A@@@@@AA@B@@A@@B@@@@@@@@AB@@@@@@AB@@@@A@@@@B@@@@A@@BA@@@@B@@A@@@@@@@C@@A@@@@AA@A@AA@A@@A@@AAC@@@ABAB@@A@A@@@A@A@@@@@A@A@A@@@@@A@@AA@AA@@@A@@A@@@AAAAA@@AA@@@A@AAA@@@C@@AA@@A"],encodeOffsets:[[116624,28239]]}},{type:"Feature",id:"360322",properties:{name:"上栗县",cp:[113.795311,27.880301],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@@@@@@@A@A@@@@@A@@@@@@AA@@@B@@@@@BA@@@@@@@@@A@@@@@A@@@AB@BA@A@@@@@@AA@@@@BA@@@@@C@@@A@@@@@A@C@@@@@A@@BA@@B@@@@@@CDCD@@A@@@@D@@A@@@@@A@AA@@@BA@@@@@@@AA@@@@@@@A@@@@@@C@@@@A@@@AA@AB@@@@@@A@@@@AA@@@@@@C@@@@A@B@@@@@@@@@@A@@@A@@@@@@@@@@@A@@@@@@@A@@@A@@A@@@@A@@@@AA@A@@A@@@@A@@@@@@AA@@A@@@@@@B@B@@@@@@AB@@@@A@@AA@A@@@@@A@AD@@A@@@@@@C@@AA@@A@A@AA@@@A@@@@AAA@@@@@A@@@@BAAAA@@CB@@@@@B@B@@@BA@@B@@@B@@@BA@@@@@BBBBBB@BBB@B@B@B@@A@@BA@@B@@@B@@A@A@A@@@@BAB@@A@@B@@AA@@@@AB@B@@BBA@@@B@@@@BB@@@@@A@AA@@A@@@BB@B@@ABA@@@@@A@@@@@@A@@A@@@A@A@@@A@AB@@A@@@@@@BA@@@A@@@@BA@@@A@A@ABA@@@A@A@AB@AAB@@@@AB@@@B@B@B@B@@@@@@@B@@@@@BBD@@EB@@C@@@@@AB@B@@@@B@@BB@@@@@@BA@@@BB@@B@@@@@B@@@@@@@BB@B@B@BB@AB@@@@B@@AB@@@@B@BAB@B@@@BBDB@@BDA@BBABB@@@@@DBB@@@@BBB@@@@@B@@B@B@@BBBB@BBB@@B@@BBB@BB@@B@@@@A@@BA@CB@@@@CDA@A@A@@AA@@@A@@@A@@@AAA@CA@@@AA@AA@@@A@@@AA@@@@@@@@@@A@@@@@AA@@@A@AB@@C@@@AAA@@@AB@@@@A@AA@@@@@A@A@@A@A@@@CA@AA@A@A@@@@@AA@@A@@AA@@AA@A@@@AA@@AA@@A@@AA@A@@@@AA@@@A@A@@@@@@A@@@AC@@@A@@@AA@@@@@AA@A@@@A@@A@ACA@@AA@@@AA@@@A@@A@@A@@@A@@@A@@@AAA@A@C@@@AA@@A@@AA@A@@@A@@@@A@AA@@AA@@@@@@A@@A@AA@@@A@@@@@@AAA@A@A@@@@BB@@@B@@@@@@BA@ABAB@@BD@@@B@@BBB@@BB@@D@@B@@@BDB@@B@@ABA@AB@@@BB@@@@B@@B@@@B@@BB@@@@@@@@B@@A@@@@@@@@@@B@BA@@@@B@@@B@@BB@@@B@@@@@B@@B@@@BB@BBB@B@@B@@@BB@@@BDA@@@@@BB@@B@@@@B@BBB@@@@B@@B@BBBB@B@@ABABAD@@@B@B@@@@AB@@@B@@A@CA@@A@AAA@@@@@AD@@CAA@C@C@AB@@AAAA@@A@AAA@@@A@@BA@@BA@A@@AA@@A@@@AAB@@@@AB@@@@@@AC@@AAAA@@@@@@@AAA@@A@A@AAAAA@@@AB@BA@@@A@@@AAA@@AAB@AA@A@A@A@A@A@A@@@A@@@@BIBDHDLAL@BAFFH@BFFJFHJJJFFFFNPHDJJB@TRBBRLFDBDFNBRAPAN@BET@DCF@FCJADCFGFA@ABA@E@@@EBABEDABCAC@C@ABADAB@BABAD@BBBBD@BBD@DA@@B@DBBBD@DBFBDBB@B@D@DBDBBBDBDBBB@@BD@@BBB@BBBBD@B@B@DBBBFBBBBBF@@BB@BBBBDBBDBDBBBDBDDBBBDBDDDBDDDBBD@FBB@F@FAHBF@B@BBB@D@@B@BADA@@D@B@B@BBBBDBBBDBBDBBBDBBBD@D@BBB@DBDBDDBBDBB@D@D@B@@ABA@C@C@A@ABABABAB@F@B@FBB@D@B@D@D@B@B@D@DB@BB@@BBB@B@D@DBDA@@D@F@BBB@BDDBDDDBB@B@@@DBBFFB@BBB@D@BAB@@ADA@@BA@ADC@@BA@@@AB@BEBCD@D@D@B@BABAD@FADAF@B@B@B@FBD@DBD@JBBB@@BB@@B@@A@@@A@A@@B@@@B@BB@B@@AB@@@@BB@@@@B@BA@@@@B@@AB@@A@@@A@AAA@@AA@@@@B@@@@@@@BAA@B@AA@@B@@@B@@@@A@@@@BB@@@@D@@@@@B@B@B@@@B@BA@@@B@@BB@@@@@@BA@@B@@@@@B@@B@@@BB@@@@BB@@@@@@@BBB@B@@@A@@@A@@B@@@@@B@@B@BA@@B@@@B@@@B@@@@@@A@@DBBA@@@@@BB@@@@@@@B@@@@@B@@A@AB@@@@A@@@@@A@@AA@@A@@A@@@@@A@AB@@AB@@A@@@AB@@@@A@@@@AA@@@@@AB@@@@@@@BAB@@@BC@@@@@A@A@@@A@@BAB@BAB@BA@@B@@@BA@@@AB@B@@@B@@@@B@@B@BA@@B@B@B@B@@@B@@AB@@BBB@@B@B@@@B@@BB@@@A@@B@@@@B@@@@@@@@A@@BB@B@B@B@@@BABB@@@@B@BB@@@B@@@@C@@@A@@DAAB@B@@@@D@@@BA@@BBBB@@B@B@BB@B@@@@B@@@@AAAB@@A@@D@@BAB@@B@@A@@BAA@B@@@@ABA@@B@@@B@@@@@BF@@@@B@@A@@A@@A@A@@B@@A@@@@@@A@@@@A@@A@@A@A@@B@@A@@@A@@@A@A@AAA@@BA@A@@B@@@@B@@@@@@@@DA@@@C@@B@B@@@@@@@B@@@B@@BB@@@@A@@BAB@B@@@A@AA@AAAA@AACC@@@A@@@@@A@@@A@@@A@A@@@@@A@@@A@A@A@@@@@@AAAAA@A@ABA@@@A@@@A@@@@B@@AB@@@@AA@@@@@C@@@@@@AAA@@A@@ACAA@A@@AA@@AA@@@@CA@@ACA@A@A@@@@@AA@BA@@@AA@@@@BAA@@@AA@@A@@A@@@@@@@A@@A@@A@@D@@@B@@@B@@@BA@A@@B@BA@@A@A@@@A@A@@@@C@A@@A@@@@@AA@@@@AB@@@@AB@@A@@@@@AB@@@@@@AB@@@B@@@@CCA@A@A@@@@@AA@@A@@@AAA@AAA@@AAAA@@@@@@@AA@@AA@@@@B@@@AA@@A@@@@A@@@@BAB@@AB@@@@AAA@@@@@@A@AA@AABE@@@@@@@BA@@AAA@@@A@BAAA@@@AA@AA@@@@AA@@A@AA@@AB@@A@A@@BA@@BB@@B@@@B@@AB@B@@BBBB@@BBB@B@@@B@@@@BBB@B@@B@@BB@@@AB@BAB@@AA@@A@@@@@@C@@AA@A@AA@@BA@A@@@@B@@BB@@@@@BAD@B@@@@@@A@@@AA@@@AA@@@@AC@@AAA@@AAA@@@AAAAAAAAA@AA@ACAA@@@@AA@ACAACCAAA@@@AAAAA@AA@@A@@@@A@@AA@@A@@AA@@AA@@@@A@@A@@AA@@A@A@@AA@@B@@A@@@A@@@@@A@@A@BA@@A@@AA@AA@AA@@@A@CA@@A@@A@@@@A@@@@@@AA@@@@AA@@@@@A@@@@@@@A@@@AA@@A@BAA@AA@@@@AA@@@A@@@@B@@B@@B@@@B@@@BAB@@BBBBA@@B@BB@@@@BA@@@@@ABABAB@@@B@B@@@@@B@@@BA@@B@@AD@@@@AB@@@BA@A@@@A@@B@@A@@A@@@AAA@@A@@@@A@@A@@@@@AA@@@A@@@AA@BAA@@CA@@A@AAA@@@BA@AB@@@B@@@B@@@@@B@@@@B@@@@DBB@@@B@BB@@@A@ABABA@@BA@@@A@AB@CC@@AA@@@A@@@A@@@@@@AAA@@@A@AA@@@@@B@BA@@@A@CDA@@@ABAB@@A@AA@ACABABA@@@A@@@A@@@@@A@@@@B@B@@ABAHA@@@@@AAC@@A@AAA@@@@A@@@@@A@@B@@@DDB@B@@@B@@@@@@BB@BB@@@A@@@@BA@@BAB@@BB@@@@BA@@@@B@@FC@@@@BB@D@@@@B@@A@@B@BC@AAA@@@A@@@@BAA@@@@A@@@@@A@@@@BBBBB@@@@@BBB@@BB@B@@@@BBB@@BBB@@@B@B@B@BBB@@AB@@A@@B@@@@@@A@A@AAAAC@A@@B@@@B@@@@@B@@@@AA@@A@@@@@@@A@@@A@@B@@BB@B@B@@@@@@@@@BAA@@AB@@@AA@@@@AA@C@@@A@@B@B@@@@@B@@@BA@@BBB@@@@@@@@A@@@@@@AC@@A@A@AAA@@@A@@AA@@@A@A@@AA@@AAA@@BABC@@@A@@B@BA@@B@@@B@@@FBBA@@@B@@@@@@BBBBB@@@@B@B@@@@A@@@@B@B@@@@B@@B@@B@@@B@@BB@@AB@B@B@DB@@@@B@@BBB@@B@BB@@B@B@@B@AB@@@B@@@@B@@B@@A@@@@@ACA@@@@@@B@B@A@@A@@@A@@B@@@BB@@B@@BB@BBBBB@@A@BB@B@B@@@B@@@@@@@@A@A@@AA@@@@@A@A@@AACAB@@A@@@@@C@@B@DA@A@@@@@A@@@AA@@AA@AAA@A@@@A@@@A@@@@A@@@ACAA@A@@@@@@@BA@@@AAAB@AA@A@@@@A@@A@@B@@A@@@A@@B@@A@BB@@@B@@@@@AA@@BB@@@@@AA@@@@@A@AA@@AA@A@A@@@@@AB@@@@@@A@@@@AA@@@ABBB@@ABB@AA@@ABB@@@@@@@A@@@@@@B@@@@@B@@@BA@@@A@@@@B@BB@@B@@@BB@@@AB@BCB@BAB@@AA@BBB@@BB@B@BA@@@@@@@@@AA@GC@@@AAA@A@@B@@A@A@A@@@A@@@A@A@@BA@@B@B@@ABA@@B@DBBB@BB@@@BBB@B@@AD@BA@@@ABA@@DA@AB@@@@@B@BABAB@BA@A@@B@@A@A@@B@B@B@@@@@B@B@B@B@@B@@@@BB@@@B@@B@B@@AB@@@@@B@@B@@@B@@@B@@DB@@B@B@BB@@@B@@@B@@@A@@B@@@@B@@@@@@@@@ABB@@@BB@@@B@@@B@@@B@BBB@@A@@BAB@@@@@BA@@BA@A@@@@B@@AB@@@@AA@@@BAA@@A@@@@@AB@@BA@@@@@@@B@@@@@@@@BB@@@BBBBB@@AB@@@@BD@BA@@@A@@@ABA@@B@@@D@@@@AB@BA@@BB@@@@@AA@@@@A@@@@@@@@@A@@AA@@@AB@BC@A@@B@@A@AB@@@@A@A@@@@BA@@@@@@@D@@@@@B@@@@DBB@@@B@B@B@DAB@BB@@B@BBBB@AB@@@@ABAB@BBB@@@@@D@B@B@@A@@@A@@AA@@A@A@AACACA@@AA@@C@A@C@A@@@AAA@A@CEA@@@AAA@AAA@C@@@A@AA@@@@AA@B@AA@@A@@@A@@@AA@@@@@AA@@A@A@AA@@A@@@AA@@@@AAA@@@@A@@@A@@@@@A@@AB@@@@AA@BA@@AAACAA@AA@@A@@@A@@AA@@@@@A@A@A@ABA@@C@@A@@@A@CBAA@@AAAA@@A@AAA@A@A@AAA@@@A@AAA@A@AB@@AB@@@@A@A@@@@@@@A@@@AA@@AA@@@A@@@@@A@@@@@AA@@@@@@@BA@@@@A@@@@AAAA@@@A@A@A@@@A@A@@@@@@A@@@@A@CA@@AAC@@@A@A@@A@@@@AAB@@@@@@A@@@AC@A@@A@@A@AA@@AA@@@@A@@AA@A@A@@AA@A@@A@A@@@A@A@@A@@A@@@@@A@@BAA@@A@@A@A@@@AC@@@@A@A@@@@@@BA@@@@@@@AA@@B@@A@@A@@@BC@@@CAAAACA@A@A@@@@A@AAA@@@A@@@A@@@@@ABA@@@A@@@@@A@A@A@@@A@A@@@@@@@AB@@@@BB@@A@AA@@AAA@EA@@C@@AA@@@A@A@A@A@@@AA@@AB@@@@AA@@A@@@AB@@C@EAA@A@A@@@ABAB@@ABB@@@@@@BAB@@A@@BB@@B@@@B@@@B@@BBAB@B@@@@A@@AA@@@@AACAA@@@AA@B@@@AA@@@@A@ABA@@@@@A@AAAB@@@AA@@@@@@A@A@@@C@A@@@@@@A@A@@@@@@@@@A@C@@@AB@@@@@BCA@@@@@@B@@AB@@A@@@A@AA@@A@@AA@@@@AA@A@A@@@@A@@@@@AA@@@A@@@@A@@@A@@BAB@@"],encodeOffsets:[[116615,28325]]}},{type:"Feature",id:"360323",properties:{name:"芦溪县",cp:[114.029827,27.630806],childNum:1},geometry:{type:"Polygon",coordinates:["@@BA@A@C@ABA@A@A@AA@AAAAA@@AC@A@@@CAAAAA@@A@A@A@@@A@B@@A@@@AAA@@A@@ABCAC@E@A@@A@A@C@A@EACACAC@@@IA@@@@@@ABA@C@@BA@@@@B@@B@@BA@ABA@@BAB@B@@BB@@@D@B@BBBA@AB@@A@@@@@@@A@A@A@@@AAAAA@E@A@AB@@AB@@ABA@@@A@@B@@A@AB@@@@BB@BA@ABCDABA@@BA@A@@BABABCB@@@B@@B@D@@@B@@B@BB@@@B@@@B@@@@B@B@B@@@BB@@@A@A@@BA@A@@B@@@@@@D@BB@@@B@@@@CB@@CB@@A@A@AAA@A@A@A@A@AAAAAAA@@@AB@@@BABBD@B@B@BA@AB@@@B@B@@A@CB@@@@@@BF@B@B@@AB@@@DABABA@@B@B@@@B@B@@@B@@@@@B@@@@A@@BA@@B@@BB@@@@@BB@@@@@@B@@@@@B@@@@@B@@CB@B@@@@@BBF@@BB@B@@@@A@AAAA@@AB@@@@@BB@@B@@@BAB@B@@@@B@DAB@DABAB@@BB@@@@B@@A@ABCB@B@B@@@B@@B@@@B@@ABAB@DABAB@B@@B@@@B@@@BA@A@CBA@@BA@@D@@B@DAB@H@B@BAD@BABAB@B@B@D@D@B@BABADAHABAB@@@B@@@B@@BD@B@@BA@@B@@ABABADABA@AB@BA@@B@@AB@@BB@DBB@@@B@D@@@BB@@BDBD@@B@@@@@BC@@@A@@@@BB@DDB@BBFBB@@B@B@B@BA@@@C@@@ABA@@@A@EA@@A@AAA@C@A@ABA@@BA@@AAAAA@AA@@@A@@A@@A@ABABA@ADAB@B@@@B@BB@@@@BABA@@@@B@@@H@F@B@BB@@B@BB@BBBBA@B@@@B@@BB@@@@B@@B@@BB@@@D@@BB@@B@B@@@@@@@B@@DBB@@B@@B@B@@@BB@BBB@@BBB@@BBB@BB@@@B@D@B@BB@@@@@@@BB@BAB@B@@@B@@BB@@@BB@@@AB@B@@A@@@@@AA@@@@@B@B@@B@@B@@A@@@@@@AA@@@@@@B@@B@@@@@@@BA@@@@B@@@@@@D@@A@@BB@@BB@@@B@@@@ABABADA@@B@B@@@BA@@@B@@@BB@@@@BB@@@@@BA@@B@BAB@BA@@@AA@@@@@BBBBB@@@B@@@@@BA@BB@@AB@@@BB@@BD@@@B@BBB@@@B@@BB@BBBB@@B@@@@B@@BBB@@BB@@@@@B@B@B@@@@@B@B@@@B@B@@@BABA@@D@BB@@@BB@B@@B@BBB@@@@@BD@@@@@@@B@@@@A@@B@@AB@@@@@@A@@B@@@@@BA@@@@@@BA@@@@@@@@@AB@@@@ABB@@@@B@@@@@@@B@@@@@@@@@B@B@@@@@B@@@@@@BB@@B@@BBB@D@BB@@BAB@@@B@@A@@B@B@@@B@@ABAB@@@BAB@@@@@@A@@@B@A@@B@@A@@@@B@BB@@@CB@@@@@B@@@BA@@@B@@B@@B@@@@A@@@@@@B@B@@@@@B@@@@BA@@@@@@@B@@@@@@B@@A@@@@B@@@B@B@@@AA@@@@AA@@B@@B@@DA@@@AB@@@BB@@@@@@@@@BA@A@@@@@@BAB@B@B@@BB@@AB@@@B@@@@@@@@@B@@@@@@@@AB@@@@@@@@@@@@@@@@@B@@@@@@@BB@@@@@@@A@@@@B@@@@A@@@@@@@@@A@@B@@@@@@@@@B@@A@@@@@B@@@BB@@@@@@@@@B@@@@@@@@B@@@@@@@@A@@B@@A@@@@@@@@@@@AB@B@@@@@@@@@@@B@@B@@@@@BA@@@A@@@A@@BA@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@A@@@@@@@@AA@@@@A@A@@@@B@@@@@@@@@@@B@@@@@@@@BB@@@@@@B@@@@@BA@@@B@@@@B@@@@@@@@@@B@B@@B@@@@B@@BB@@B@BAB@BBB@BB@@B@ABB@@@@@BB@B@BAB@@@@BB@@@@B@@@@B@B@@@@@@@@B@@@@@BA@@@@@@@@@@B@@B@@@@B@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@B@@BB@@@@@@B@@@@B@@@@@@@@B@@@@@B@D@B@@@@@B@B@@@B@@@@@@@@B@@@@A@C@A@@@@@@B@@B@@@D@@@@@B@@AB@@@B@BB@@B@@B@@@@A@@@@@@@@B@@B@@@@@@B@@B@@@@@@@@@@@B@@@BB@@@@A@B@@@ABA@@@B@@@@@B@@B@@@B@B@B@@A@AB@B@@@B@B@@@@@@@B@B@@@@A@@@@B@@A@A@@A@@A@A@@@A@@B@@@@B@@@BB@@@B@BA@A@@@@BABB@@@@@B@@@D@@@B@@@B@@@B@@@BB@@@@B@BBB@@@@BBB@@@@AB@@@B@@@B@@@B@B@@@@B@@B@BD@@@@@@@@@@D@BB@A@@BB@@@BB@@@@@BAB@B@@B@@@@BA@@B@@@B@@BB@B@@D@BB@@@BA@@B@@@@@@@B@@A@@BA@@B@@@@@B@@@@@B@@@B@@@@@B@@@@@@@@@@B@@@@B@@A@@BA@@@@B@@B@A@@@BB@B@@B@B@@B@@B@@@@@@B@B@@@B@B@@B@@@@BA@@@@B@@@@ABBB@@A@@B@B@B@@@B@B@@@@@B@@ABAB@@@@@B@BB@@B@@AB@@ABABAA@BAA@@@B@BA@@B@BA@@@A@A@@@A@@B@@@@BB@B@@B@@@@@AB@@@@@@B@@B@@@@@B@@@@@B@@BB@@BB@@B@@@@@@@B@B@@@@@BA@@BAB@B@BBB@@@B@BBB@B@B@BBB@@@BBBB@@BBDAB@@@B@@@@DB@BAB@B@B@@@@@B@@BB@@@B@@@BBB@DBBB@BB@@ABB@@@@BA@@@B@@@@@B@@@B@@B@BB@@@@BB@@B@@@BBB@B@@@BB@@@@B@@B@@@B@@@BB@@B@ABB@@@@BBB@@@D@B@BBB@BB@@B@DFB@B@BB@@B@D@B@D@@@BB@@DBDBBBB@B@@@BB@@@B@@@BA@A@BB@@@@@B@@BA@@D@BAB@@@@AB@@@BBB@BBBBB@BBB@BBDBB@B@B@@ABB@@B@@@BD@@@BB@BB@@@@@@@@@@A@@AA@@@@B@@B@BB@@B@@@@@B@@@@A@@B@B@@@@@@B@@A@@@A@@@@BB@@BB@BBBBB@@@B@@@@@@@@A@A@A@@B@@A@@@@BBB@@@@@@@@@B@@B@@A@@@@BBA@B@@@B@@@@@@@@@@B@@@@@B@@A@@@@@@@A@@B@@A@@@@@AB@@@@@B@@@B@B@B@DDD@BBB@BBB@BB@@@@@BABA@AB@@@BA@@@@@@@A@@@A@@BAB@BB@@@@@@@BBB@B@C@@B@@@@AB@@B@B@@BB@@@B@@B@@AB@@@@@@@@@@@B@@A@@@@@@@A@@@@@@BB@B@BA@@@B@@@@@@@AB@@@B@B@B@B@@@B@@@@@@@@@@A@@BABABA@A@@D@@@@BA@@@B@@@AB@@@B@@BA@@@@@@@@B@@@@@B@@@@BB@@@B@@@@@B@@@@A@@A@@AA@BAAA@@@@@A@AB@@@@BB@@@@@@A@@@A@@@@@@BA@@@@BA@BB@@@@@BB@@BA@@B@@B@@D@B@B@BA@@BA@@@A@@@AB@@E@@@@@A@@@@B@B@@@@B@B@@@@@@@BA@B@@BB@@A@AB@@A@A@@B@@@@@@AB@@ABCBB@BBADB@@BAB@@@@@BB@B@A@@BA@A@@@@A@@@@@@@A@@@A@@@@@@@AB@@@AB@@@@@@AA@@@A@@AA@C@@@A@@@@@@A@@@@B@@@B@@@@@@@@@@BB@@@B@@@D@@@@B@@B@@@B@@@@A@@@ABA@@AAA@B@@@BCB@@@@@@@B@@@BA@A@@@AA@@@AA@A@@@@@@A@A@@A@@AA@@@BA@@@AA@A@A@@@@@@AA@@@@@@@A@@BABBB@FBB@@@@@AB@@B@@B@@B@@@@@BBB@@BD@B@BA@@@@D@D@@@BAB@B@B@@A@@@@B@@A@@@@@@B@@@B@BB@@AB@@BB@@@@B@@@@A@@@@@A@@@A@@@A@@@@AA@@BC@@@AA@@AA@@@BA@A@@@@@@@B@B@@@@@ABA@@AC@@@ABA@@@@BABABABA@@@AB@BCBABA@@BA@A@@BA@ABA@@@@BAB@@AB@@@BA@@@@@A@@A@A@@@@A@@@AB@@A@@B@B@@@BAB@@ABAAA@A@@AA@@@@A@@@AAA@@B@@@@AB@B@@@B@@AAA@@@@@@@@A@A@@BA@@@AB@@@@A@@@@BA@@@@BBB@B@@@@A@AA@@AD@B@@@BA@ABA@@B@@AB@@@BA@@@@@A@AB@@@@A@@AA@@@AA@@A@@BAB@@@BAB@@@@A@@@A@@BA@@@AB@@AB@@@@B@BB@@@B@@@BABA@@BA@@@@B@@C@@@AB@B@@@A@@AB@B@@A@@@@@AB@@@@@AA@@A@@@@AB@@@AC@C@@@A@@A@@A@A@@@AA@@A@@AAAAA@@AAABA@@A@@AA@@A@@A@@AAA@@@@@@AA@@BAA@AA@A@@A@AB@@A@@@@@A@@@@AA@CB@@A@@@A@@A@A@@A@@@@@@AB@BAD@@@@AB@@@B@@@B@@@B@@@BAB@BBB@BAB@BA@@@A@@AA@A@@BAB@B@@@@@BAB@@A@@@A@A@AAABA@@B@DA@@BAB@@A@@@AB@@AA@@@@A@@@@@A@@BA@AAC@A@@CBAA@@@AB@B@B@@A@@BBB@@@B@BBB@B@B@B@@@B@BA@@@@BAB@BA@@@@@@@A@A@@@A@@@AB@@@BA@@BA@@B@B@@@@A@@@AA@@A@@@@BB@@@AB@B@BB@@BA@@@AB@@@@A@@@@@@@A@@BABAD@DA@@FA@@@@BA@A@A@A@A@@B@B@@@B@BAD@D@@@B@@@@@BA@@BAB@@AD@@@B@@A@@@@@A@A@@BAB@FB@A@@A@AAA@@CB@DCB@@AB@@@@@DBB@DBB@@@@B@B@@B@@A@@AAB@@AB@BB@A@@@@CAA@A@AAAA@@A@A@@AAAAAA@A@A@@@EA@@AA@A@@AA@@AA@@@@AABA@@A@@A@@AA@@A@@@A@@@@@C@@@A@A@CA@@@A@@A@A@AA@@@AAAA@@@AA@@A@A@A@@@C@@@CB@@AAAACA@@AA@@AAAAA@C@A@@@CA@@AC@@@AA@@ABAA@@A@A@@@A@A@@@AAABA@A@@@AA@AA@A@@@AAA@A@A@@@A@@AA@@@AA@@AAA@@AA@@AAC@@AA@@@AAA@A@@@A@@A@@A@@@A@AAABC@@@A@@AA@AA@@@@AA@@A@@AB@@AA@@A@A@@@@AABABAA@@@AG@@@@AA@@@A@@@AA@BA@@BA@@BC@@BA@@BAB@@A@@@@BC@@@A@AC@@@@A@@@@@A@A@@@@@A@@@A@@@A@A@@CA@@AA@@AC@@AA@AA@@A@@AA@@@A@AAA@A@@@ABC@@EAA@A@EA@@A@@@A@@AA@@A@A@@@@@@A@AAA@AA@@BCAA@A@AAA@@AA@A@@@@AABA@A@A@@AC@@@A@@@CCA@AA@@AAAAA@@AAAAA@A@@@@@@@AAA@A@@AA@@@A@A@@AAA@@@@@@A@A@C@A@A@A@@@AA@@@@AAAA@@@@@@@@AAAAA@@AA@@@AA@@@@A@AAC@@@A@@@A@A@@A@@A@@B@@AA@@A@CB@@A@@@A@@@@@@BA@@@A@@A@@A@@@@@@AA@@@@@AAA@@@@@A@@@A@@@A@@@A@@@A@@@A@@@@@AB@B@@A@@BA@ABA@@@ABA@AB@@@BA@AB@@A@A@AAC@@@A@A@@AA@A@A@A@@@A@A@@@CAA@@@AAA@A@AAA@AA@AA@A@@@A@A@CC@@A@A@@@A@@@C@@BA@A@ABC@AB@B@BA@@@@BA@@@A@@@@BA@@BA@@@@AA@A@A@@@BA@@C@@@@@A@@@@@A@@B@@@C@AA@@@@B@@@@@A@@@@C@@@AAAA@@@@ABA@@@ABABA@@@A@ABA@C@AAAA@@A@@BA@@@ABA@A@@@A@@@@@A@A@@A@@C@@AA@AAAA@@@@AA@A@@AAA@@AA@@@A@@@@A@@A@CBABABA@CBEBA@A@A@@@AA@AACA@@@AAA@@@AB@@ABA@ABA@@@EBA@A@AAAA@@AAAAA@@ACBABA@E@EBA@EDAB@@ABABCDABABABA@EDCDA@@BAD@BABAB@@BFAF@@ADAB@BAB@@@DAD@B@@@@A@ECC@@@CBG@CAA@A@@@A@A@AB@@A@@@A@A@@AAA@@A@C@EAA@A@C@@@@@A@A@@@AA@@B@AC@AAE@A@A@CBC@A@A@C@AAA@A@C@C"],encodeOffsets:[[116660,28094]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
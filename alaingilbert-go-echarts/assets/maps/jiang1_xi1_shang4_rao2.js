@@ -0,0 +1,5 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("上饶",{type:"FeatureCollection",features:[{type:"Feature",id:"361102",properties:{name:"信州区",cp:[117.966268,28.431006],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@BA@AB@@A@@A@@@@@@ABBB@@ABBB@@A@@@@@AAB@AA@@@@BA@B@@A@@@@A@@@@AA@@AA@@@@A@@@@@A@@B@B@A@@@@@@@A@@@@@@A@@AA@@B@@@@@BA@@A@AB@@@A@@@A@@@@@@@@@BA@@@@@@@@A@@BAA@@@@@BA@@@@@@@A@@A@@@@@BA@@@@@@@A@ABAAAAA@@A@@@@A@@@@@A@@A@@@A@@@B@@B@@AB@@@@AA@@A@@@@@@A@@@@@@@AB@A@@A@@@@A@@A@@A@B@AB@@@@A@@@B@@@@@@B@@@@A@@@@@@@@B@BA@@@@BC@@A@@@@@@BA@A@@A@@@A@A@@@@@A@@B@@AA@A@@@@A@@B@@@A@@A@BB@@AB@@@B@@@@@BA@A@@@@@@BB@@@@B@@@D@@@@A@@@A@C@@@@@A@@@BA@@@@@@AB@@A@@A@@@@B@B@@@A@@A@@@@@@A@@@@@@@@A@AA@@@@B@@@@@B@@@@@@A@@@@@@A@@A@@A@@@BA@@BA@@@A@@@@@@@A@@A@@@@@B@@@@@@A@@AA@@@BB@@@@A@@@A@@@@A@A@A@@@AC@@@A@@@@BA@@@A@AA@@@@B@@@@@B@@@@@@@@@AAA@@@@@@A@@@@B@@BB@@@@@AA@@@@AA@@@@@@A@@@A@@A@@ABA@AB@@AA@@@B@@A@@@@@@@@@@A@@@@@@A@@BAAA@@@@A@AA@@A@@ABA@@A@@@BA@@@@@A@A@@B@@@@@AB@@@@B@@@AB@@@@@B@@@AB@@@@@B@AB@@A@@B@@@B@@@BB@@@B@@@B@B@@BB@@@@@B@@@B@@@@@@@@B@@B@@@@BB@@@@B@@B@B@B@@@BA@@B@@ABB@BAB@@@@B@@@@AB@@@@B@@@@@@@@@C@@B@@@@@@B@B@@@@@@@@B@@A@@@@B@@AB@@@@@@@@DA@@@@B@@@A@@B@@ABAB@@@B@@@@@A@@DA@@B@@B@@@@A@@@@@AB@@@@B@BA@@@@@B@@@B@@A@@B@@A@@@@@B@@@@@@B@@@@B@@B@BBB@@@B@@B@@@@B@@@@@@BBD@BBB@@BB@@@@@BB@@A@@B@@@@@@@B@@@@@@BBBBA@@B@B@BAB@@@@@@@@A@BB@BA@@@@B@B@B@B@@A@A@@@@@A@@@@BAB@@@@C@BB@@@B@@AB@@A@@@@@@BAB@@@BDB@@@BABADA@@@A@@@ABAF@BAB@@@BAB@B@@@B@@@BB@@@@@BB@@@@BB@BB@B@B@@@@B@@@@@@@@AB@@B@@BB@@A@@BBB@@@@B@@@@A@@AA@AB@@A@A@@@A@@AA@@A@@@B@@@@AB@@AB@@@B@@@@B@@@@@@BFDFBFDD@CD@DBBFBFB@B@@@@@B@@@@@@B@@@B@B@@@@B@@AD@@ABAAAB@@@@@@B@B@@@@B@@@@@B@@@@@B@@@@@@@ABA@@B@@BAB@@B@@@@@@A@@@@@A@@B@@B@@@@@BABA@@@@@B@@@@B@@BAB@@@@@@B@BA@A@@@A@@@@@@B@@@@@B@@@@BA@@@@B@@@B@BA@@@@B@@B@B@@@@@@@@B@@@@B@@@@@@@@@@@B@@@@ABA@@@@@B@B@@@@B@@@@@B@B@BBB@@B@BB@@B@D@B@@@@BDD@B@@B@B@@@@@B@B@B@D@@AB@B@@@@@@C@@A@@AA@@@@@@@@A@@@A@AABA@AA@@B@@@@A@@@AB@@B@@@A@@@A@@@@B@B@@@@AB@@AA@@@@@A@@@@@@BA@@@@@@@A@@@@@@@B@@A@@@@A@@@B@@@BA@@@@@@B@@@B@@A@@A@@@AA@@@@@AB@@@@@DE@AB@@AAAB@@A@A@AA@@@H@D@F@@H@@@@BFBDBBA@@@@B@@@B@@@@@B@@@@@@@B@@@@B@@@@B@@@@BB@@@B@@@B@@B@@@@@BB@@@@@B@@@B@@@@@B@@@B@@@BBB@@BB@@B@@B@@@@@ABA@@B@D@BBA@@@@BB@@@B@@@AB@B@B@BA@BB@B@@@@@@@@@@B@@@@@@B@@AB@@BB@B@@B@@@@ABBB@AB@@@BB@@BB@B@BA@@@@BB@@@B@@@B@@B@@BBA@@@@@@@@@@@@B@@@B@B@@@@@@@@A@@A@A@@@B@@@B@@BB@B@@@@BB@@@B@B@@@@@B@@B@@@@B@@A@A@@@AB@@@@ABA@@@@@B@B@@@@A@@@@B@@@B@D@@D@@@AD@B@B@@B@@BB@@BBD@@BBB@B@@@B@@@@B@@B@B@@B@@@@@@B@BB@@B@@A@@B@@@@@BBA@@@@B@@@@@@B@@@B@@@BA@@@@AB@BA@@B@@B@B@@@@@BB@@A@@@DB@@@BB@@B@@DB@@@@BB@@@@@A@ABA@DBA@@@@@A@AA@@AA@@@B@BAB@@@@BB@BB@@@@AB@@@B@@B@B@@@@A@@B@B@@@@@@B@@@@@@@@@@B@@@@@AB@@@B@@BB@@DA@@BB@@B@@@B@@BA@A@@BB@@@@@B@@@B@@BAB@@@@B@@B@@@B@@@@@@B@@@@B@@@B@@@B@@@@@@@B@B@@B@B@@@B@BB@@AB@@B@@B@@ABA@@@@B@@@@A@@@@@@D@@@@BB@BA@@@AAC@@@@@A@@AAA@@A@@@@B@@A@@A@B@@BB@@BB@@B@@B@@@@A@A@@B@@@@@@@@B@@@@@A@@BAB@@@@AA@@@A@@@@A@@@@A@A@@A@@A@@A@@@A@AB@A@@@A@A@@@AA@@@@@@B@@A@@BAA@AA@@B@@BB@@@@AB@@@@@BB@@BBB@@@@B@@@@B@B@@@@B@@@@B@@@@C@AAAB@@BB@BA@@BAB@@@@BB@@@B@B@@@B@@@@@B@@AA@@@@@B@@BB@@A@A@@@@A@@A@AA@@A@@@@@@@BB@@@BB@@@@B@@C@A@@@A@@@B@@B@B@B@BB@@B@@@@@@@B@@@B@BA@@BA@@@AA@@@@@AB@B@@B@@@@@A@A@@@@@@A@@AA@A@@BB@@@@BA@@@A@@@@B@@A@@@@@A@@B@@@@FB@@@AB@@@@@@F@B@@@BBB@@@B@@A@ABE@@@@BAB@@A@B@@@ABA@B@@@@@@B@@@@@B@@AB@@A@@BAB@@B@@@@B@@@DC@@BBB@@B@@B@@@@ABAB@@@D@BAB@BAB@@ABBBAB@@@@@B@B@D@BB@@B@@@B@@DB@@@@@B@@@@B@@BB@@@B@@@@@B@@@@A@@@@@BB@@@@@BBBA@@BABA@AB@@@@@BA@@@@BB@@@@B@@@@@@A@@@@@@@@@A@@A@@@@A@@@A@@@@@A@@B@B@@@@BB@@@@@@BD@@@@@@A@@@A@@@A@@@A@@@A@@@@@A@@@A@@@@BC@@@@ABA@@@@@BC@@B@@@AAB@@A@@@AB@@@@BB@@@@@AA@@@A@@A@@@@@@@@@@@BA@@@@@@@BB@@@@@@@@@@B@@@@@B@AB@@A@@@BB@@@@@@AB@@@@@@@@A@@@@AB@@@@@@@@@@A@@AA@@@BA@@@@@BB@@@@@@@@AA@@@@@@@@@@@@@A@@A@@B@B@@B@@@@@@B@BB@@@@@B@@@B@B@@@B@@B@@AB@@@@@B@@@@@B@B@@B@@B@@A@@B@@@DF@@@A@@@@B@@BBF@@@@@@@@@@@@DB@AA@@@@@A@@AB@@@A@@AAC@@BB@@@@@@@BBB@@B@@B@@@B@@@@A@@@@BA@@B@@@B@@@B@B@BA@@@A@BD@@B@B@B@B@@@@BB@@A@@B@@@@A@@B@@@@A@A@@BB@@BAA@@@@@@@BA@@@@@B@@@@@@@B@@A@@@B@@B@@@B@B@B@@@BA@B@B@@A@A@C@@@@B@@A@@@@@A@@@@@@B@@@@@@ABABA@@@@BAA@@@@A@@BA@@@@@@@@B@@@@@@@B@@@@@B@AA@@@@BB@@B@@AA@@@B@@A@@A@@A@@AB@@@A@A@@B@@A@@A@@AA@@B@@@@@A@@A@@AB@@@BA@@A@@@@@@AB@@@@@BA@@A@@@@@DA@@B@@@B@AA@@BA@@@@AA@@@@AB@@AA@AB@@@@@AAB@@@AA@@BC@@@@@@BB@@BB@@B@@@@@B@@@@A@@A@@@@@@A@@@@@A@BA@@@@@A@@@@A@@B@BA@@BB@@B@@@@@DBBABB@@@BB@B@@B@@@@@BB@@@B@BB@@@B@@@@D@@@@@B@@@@@B@@@@@B@@BB@B@@@@@@A@@A@@@@@@@@@@@@@@@@C@@B@@@@@@D@@@@@@@@@@B@@@B@@@@@@B@@B@@@@A@@@@A@@A@@@@@@BBB@@AB@@BA@@B@@@AAB@@@@@@B@@@@@B@@B@@@BB@@AB@@A@@@@DB@B@@B@@A@@@B@@B@@BB@@@BBB@@@@@AA@@@A@@@@BB@B@@@@@B@@@@@B@@@BB@@@@@B@@@B@@AB@@@BB@@@@B@@@AB@@@@@@@B@@B@@B@BA@@@BB@@B@@@@@@@BB@@B@@@@BBBB@B@@@@A@A@@BB@@@@BDA@@@@@@@@@BB@@@B@@@@BBB@@BB@@BD@@BBA@@@@@@B@@@@@@@BB@@@@B@@@@@B@BB@@@B@@A@@@@@@@@B@@B@@A@@@@@@BB@@@A@@A@BA@@@BB@@B@B@@A@@BAB@@@B@@B@@@@@@@@@@@@@@BA@@@@@B@@B@@@@@B@BB@@@@B@@AB@@@BA@BB@@@A@@BA@@@@B@@@B@@B@@B@@@B@@@@@BB@@@@AB@@AB@@B@@B@@B@@A@@BB@A@@@@BB@@A@@@@@@BB@@@@B@@@@BA@@@B@@A@@@@B@@@@@@B@ABB@@@@B@@@@@@@A@@@A@@B@@@B@@A@@@@@@@@@@@@@@BA@@@A@@@@@@@@@@@AB@@@@DB@@@@A@@@@@@@AB@@@@@AA@@A@@@@@BAAA@@A@@A@@@A@@@A@@BA@@A@AA@@B@@@B@@@@B@BA@B@@@@B@@@B@@@@@@@A@@B@@@A@@A@@@A@@B@A@@@BA@A@@AA@@@@@@A@@@@A@@B@@A@@@@@A@@@@A@@A@@B@@BB@@B@@@A@@@@A@@AA@@@@@@A@A@@@A@@AA@@@BA@@@@A@@@A@@@@@A@@@@@@A@A@AA@@@A@@@@@AA@B@@@@@@A@@@@@@A@@A@@@@@@B@A@@A@@@AB@@@@A@@@A@@@@B@@B@AB@@@B@@ABAB@@@@@B@@@@@@@@A@A@@@@@A@@@A@@@@B@@DB@@@@@@A@@@@@A@@@AA@AA@@B@@@FB@A@@A@ACACA@@B@@@@@BAABAABAAAAA@AA@@A@A@A@A@@AA@@@@@@@BA@@@@@@A@A@@@DAAA@B@A@A@@EAC@@@CBA@ABA@A@A@A@CA@@CACCCA@AA@ACAAACAAAGAAAC@@@A@@@A@A@@B@BB@@B@@BB@@B@@B@@@BB@@@AB@@A@@DBBA@@@B@@B@@@@@B@@@@@@@AA@@B@@ABBBA@@@B@@B@B@@@@AB@@@@@B@@AB@@@@@@@@A@@@A@A@@B@@@@@@BBB@BBB@@@@B@B@B@@@@@@A@@@@BA@B@AB@@BB@@@A@@B@@@BB@@@@BBB@@BB@@A@@@BD@@@B@@B@@@B@@@BA@@A@BAA@@@@@@@@@B@B@@@@@@A@@@@@@@AB@AA@@@@@@BA@@@AAA@@@A@@@@@A@@@@@AB@@@@@@@AA@@@@@A@A@@AA@@B@BB@@@@AA@@@@@A@@B@@@B@B@@AA@@@BA@AB@@@B@@A@AB@B@@A@@@@@AAA@@BA@AA@A@@@@AA@B@@@@@@@@@@A@@B@@@@@@@@@@A@@B@@@@A@@@A@@B@@@A@@@A@@@@@@@BA@@@@@A@@A@@B@@@@AA@@@@A@@@@@@@@@@BA@@@@@A@@@@A@@@@@@AAA@@A@@@@B@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@@@@@@AA@@B@@@@@@@@A@@@@A@AA@@A@@A@@AAA@@@@@@AA@B@@@@A@@@AAA@@BAB@@@@@@B@@B@@B@@@B@@@@@@@@BB@@@@@A@@@@@@@AB@@@@@@@@@@A@@@A@@@@@A@@@@@A@@@A@@B@@@@@@@@@@@@@BA@A@@B@@@@@@@@@@A@@AA@@@@B@@@@@B@@@BB@@@@@@@A@A@@BAB@@@B@@@B@@A@@@@A@A@@@@BA@AA@@A@B@@@B@@@AA@@@@B@@@@@B@@@B@@A@@@@@@@@B@@@@@B@@@B@@@@@A@@@@A@@B@@@@@@@B@@BBAB@@@B@B@BAB@@@@@@@BA@AB@B@@@@AB@@AA@@ABA@@@A@@A@AA@@@A@@AA@A@@@@AA@@A@AA@@AC@@@@A@@@AA@@AA@@AA@@@AAAA@@A@@B@@A@@@@@CB@@A@A@@A@@ABA@A@A@@BA@@@@@A@@@@A@AAC@CC@@C@@@A@@@A@@@A@@@@A@@BA@@A@@@A@@@A@@@@@@A@@@CA@@A@@@BAB@@@@@@@BAA@@@@@ABAA@@@@@@@@@@@@AB@@@@A@@@A@@@A@A@@@@B@@@@@BA@@@@A@@@@A@@@@B@@@@B@@B@B@@@@@@AB@@@@@BA@@AAA@@A@@@@AA@@@@@@@A@@@@AA@@@@BA@AB@@AB@@@@BB@@@@@@B@@@@B@@@@@@@@@B@@B@@@@@@B@@@@@@@@@@AAA@@B@@@B@@B@@@BB@@B@@B@@A@@@@@A@@@A@@CAAAA@@A@@@@BAB@@@@@CCAAA@AAA@A@A@AA@@@@@@@C@@A@@@@@@A@@AAC@CA@@@@@@A@@@C@AAA@C@AAA@@@A@A@@@@A@AA@@@AAAA@@A@AAA@@A@@AB@@AAA@@AA@AA@AAAAA@@@AA@@@@@AA@A@@@A@@AAA@AAA@@@A@@@@AA@@@@@A@A@ABA@@B@@A@C@B@@BA@@@A@@@AD@@@@@@A@@A@@@A@@@A@@@@A@@@ABAA@@@@@@A@@@@@@@B@BA@@@AB@B@AA@@@@@@A@@@@@CB@B@BA@A@@BA@C@AAA@A@A@@AA@@@A@A@A@@@C@@@A@@A@@@@ABA@A@@BAA@@AB@@A@A@@@@@AA@AA@@@@@A@@@@@AB@@@BB@@@A@@B@AC@@@@@AA@@A@@@AA@@A@A@@@A@@@@A@@@AA@A@@@A@BAB@@BB@@@B@@@@@@@@@B@@@B@AA@@@A@@@A@@@AA@@@@@B@@@AA@@A@@@@A@@@ABA@@@@@A@A@@@A@@A@@@@AB@@AAAA@@A@@@AB@@@@@@@@@@A@@@@@A@@@@@@B@@AAA@@@A@@@@@@AA@@@@@AB@@@@@@@@@@B@@@@@ABA@@@@@A@@@@A@A@B@@@@AA@@A@@@A@@AA@@AB@@A@@@@@@A@A@@@@@A@@@@A@@A@@@@A@@@B@@AA@@@@@ADA@@@BA@A@@@BA@@@@A@@@@@AB@@@@@@@@@B@AA@@AB@@A@AB@@A@@@@A@@B@@@@@A@@AA@AA@@@@@@@@@@A@@@@@AB@AC@@@@@@@BAB@@@BA@@@@@@@@@@ABA@@@@@@A@@@@@@@B@@A@@@A@@@A@@A@@@@@@A@@@@@@@A@@@@AB@A@@@AAA@A@CB@@@B@BDBBB@B@HAFQBA@A@CB@@A@ADA@AD@B@B@D@@AD@@@@@DAB@@AB@DABA@@@A@A@AAA@@@@AA@C@A@@BA@@@AB@@AA@AABCA@@@@B@@@BAB@@@@@B@@@@@B@@@@@@B@AB@@ABB@@@A@@@@ACAC@@@@AB@@@BABA@AAAA@@@@@AAA@@@B@@@@@A@@@AA@@@ABAB@@@AA@@A@@@A@@@@@A@@A@@@@BAA@@A@@BA@AA@@@@@@@@@@A@@@A@@@@A"],encodeOffsets:[[120814,29084]]}},{type:"Feature",id:"361103",properties:{name:"广丰区",cp:[118.19124,28.436285],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@ECA@AB@@GBABC@EAMEAAA@AA@@EACAA@C@CBABC@AACAA@E@C@C@A@AACACACAECCCCCECAACAA@A@@@@@ABABCBEDCD@@@@A@EG@@CEECEEGECAAAA@C@GAC@AA@@@BBBBBB@@DDDBB@BB@@B@B@@BB@F@@B@@B@B@B@@BB@BBB@@@@@BA@@@@B@B@@@BA@AA@@A@@@A@@@@@A@AB@@@AA@@@A@@@@@AAA@@@A@@@ABA@@@A@A@AAA@@B@BA@@BAB@@@B@B@@@@AB@@@BBB@@@@A@A@AB@@A@@BAB@@@@BBBB@@@@@@@B@@A@A@@@@@@BB@B@@@@BBB@@B@B@@@@@BB@D@D@B@@BBBDBB@@@D@@@BB@B@B@@@@@@B@@@BA@@BC@A@@@@B@BA@@@ABA@@BA@@B@@B@B@@@B@@@@B@@@B@@@B@@@@@BA@@@AB@@@@BB@@@BA@@BAB@B@B@B@@AB@BAB@BBB@@A@@@A@@BB@@B@@@@C@AAA@@@@AAA@@@@A@@@@@@BAB@@@@E@A@@B@B@@ABB@@@@BBAD@@@@@@B@@@D@B@@AAA@@@@B@@@BB@@DB@@B@B@D@BA@@BA@@@@@AAA@@@@@@@@B@B@@A@AB@@@@B@DD@@@B@@@@C@@@A@@@@B@@BBB@@@@@@B@@A@@@AA@B@@@@@BB@@B@@@@ABA@@@@B@@BB@@@@@@@@AB@AA@AAA@@@@B@@BB@@@@ADAB@@@@@@@AAA@@A@@@@@@B@@@B@@@BABBB@B@@@@@@AB@@B@@@B@B@@B@@AB@@@@B@B@A@@B@@A@@@AB@@@BBB@B@@@@@A@@BAB@@AB@@@@@@B@@@@ABA@AD@@@D@@@@BB@BB@BBBB@BBB@BB@@BBBB@BBBB@@@DB@@@DBDBBB@@BB@B@B@B@B@B@B@B@B@D@B@B@BBD@BBDB@BBBB@BBB@@AB@DABAD@@@@ABA@@BCD@BCD@@A@CBC@A@AB@@@B@F@B@BBB@B@@@D@B@BA@@BA@ABBDAB@@AD@@@BA@AB@BEB@B@DAB@@@@D@@@@B@@B@@@DBB@A@@@E@ABC@@@@B@B@BA@@B@@D@@@@B@BBB@BBDBB@B@@@B@@@D@@@BA@AB@BB@@B@@@@@B@@@@BBB@BB@BB@A@@B@B@B@BCD@@@D@@@@AB@@AB@@@@DBBFBB@@AB@@@B@@@@@@@@@@@@B@@@BD@@@@@@@A@A@@BA@@B@@B@B@@BB@B@@@B@@AB@@@B@@@@BABB@AB@BA@BB@@@@@A@ABAD@@A@@@A@@@AB@@BD@@@@B@@A@@B@@BBA@@B@@@@@B@@A@@BA@B@@@BABB@@@@@B@B@B@@@@@@B@@AD@@@B@@BB@@B@@B@@@B@B@@BBA@@BAB@@A@@@A@@@A@AA@@@@@AC@@@A@@@A@A@C@@@A@@B@A@@AA@@@@DA@@@@@A@AA@@@AB@@AB@BA@AB@@@B@@@@A@@A@@A@@@@@@@A@@A@@A@C@A@@@AA@BA@A@AB@@A@CA@@A@@@@A@@AAA@@C@A@@@@B@B@@@B@@ABA@C@A@@@A@@@@@@A@AA@AA@A@@@CB@@@@A@A@C@A@@@@A@@@@AB@@AAA@@@@@@@A@@@B@AB@@@A@@@BA@@@@@AA@@@ABA@@@CA@@AA@A@@@@BA@@F@@A@@@@@AA@B@B@B@@@@AA@@AC@@A@BA@CB@@A@@@AA@@@A@A@@AA@A@A@@@A@ABADC@A@A@A@C@@AAA@A@C@@AA@@CAA@@@A@C@ABA@A@ABA@AB@@AB@@BB@BAB@FCHA@@B@@@@@@B@@B@@@@A@@@A@@@AB@AA@@@@@A@@@AB@@@B@B@@AB@@A@@B@@A@A@@@@@A@AB@@@@A@@@A@B@@A@@A@A@@B@@@@B@BB@@@B@@BB@@B@B@@B@BA@@@@@C@AB@@AB@BA@@@@@A@@@@B@@@@A@A@A@BB@@@B@B@@BD@B@@@@@BB@@BA@@@@@A@@@@@A@ABAB@@@@AAA@@@@BA@@@A@@B@@AA@@B@@AA@@B@@AB@B@@A@@@@@AB@B@@@@@A@@A@@B@B@@@@A@@@@A@AB@A@@@@A@@A@@@AD@@BB@B@@@BB@@@AB@BAB@@@@A@A@@@A@A@C@@@A@ABABAB@@AB@B@B@@A@@@A@A@@@A@@@@B@@@@B@B@B@B@B@@B@@AH@@B@B@@B@@@BAB@@@@E@A@@@@@@B@BAAA@@BA@@@A@@@@@AA@AAA@AA@@AABABA@ABA@@@@B@@B@@B@B@@ABA@AB@@AB@@ABAB@BA@@@@@A@@A@@@B@@@B@A@@A@@@BB@@@@@@A@@B@BAD@@@@A@A@AAA@A@AAG@CAA@A@AB@@ED@@@BAD@JB@BB@@B@A@@B@@B@@@@@@@B@@@@@@@@BB@@@B@@@B@@@@A@@@@@@@B@@@@B@BA@@@@@@@@@@AB@@A@AB@@@@@@D@@BBA@@@@B@@@@@@@@@@@BBB@@BB@@@@@A@@@@B@@B@@@BAB@@@BA@@BBA@@@@@@@@@A@@B@@@@@B@@B@@A@@@BAB@@B@BC@@@@B@@BA@@@B@@@@@@BB@@@@@@B@@@@@B@B@@@@B@@@BA@@BB@@@B@@@BB@@B@@A@B@B@@@@@@B@@@@AB@B@@@@@A@@@@@@@@A@@B@@@@BB@@@@@@@B@@BB@BA@@@@@@@@B@@@@@B@@@@@@@@A@@B@@@BB@BB@BA@@B@@B@@@@B@@@B@B@@@@AB@B@@@B@@B@@@BB@@A@@@@@B@@B@@@B@@@B@@BBA@@@A@@@@@@@@@A@@@A@@AA@ABB@@@B@B@@B@@@B@@B@@@B@B@@@BB@@B@@@BB@@@@D@@B@AB@@@A@@A@@BA@@@@B@@@@@B@@BBB@@@@B@B@@@BA@@BB@AB@B@BA@@@@@BB@@@D@@@B@B@B@@@B@@BB@B@B@BBD@B@@AB@B@@A@ADA@@@@B@@@@@@@BBA@A@@B@@ABA@@@@@@@B@@@@@@@BBBA@@B@@@@@@B@@@B@@@BB@@@@@@@BC@@B@@@B@@AA@D@B@@@@AB@BAB@B@@@@@B@@B@@B@@@B@BBB@BB@@@B@@@BBB@@@@B@@B@@BBBB@BBBB@@BB@BB@@BA@@@BB@BBB@@@BBBB@@B@@B@B@@B@B@@@B@BBD@B@BBD@@@B@@@@@@@DBD@BB@@@B@@@@B@@@@D@@@@@@BBB@B@B@BBB@BBDD@@@@A@AB@@@@@BB@BBDB@@@B@@@B@@@@@BA@@@@AA@@A@@@AA@@@A@@@BB@B@@@@@@@@A@@@@@@@@AA@@@@@@@@@A@@@@@@A@@@@A@@A@@A@@BA@@BAB@@@@BB@@@@@B@@@@@@BB@@@@@BB@BB@@AB@@@@A@@B@@@@A@A@@@@A@@A@@@@@@B@@B@@@@@AB@@@@A@@@@@@B@B@@@B@@@B@@A@@B@@@@@@@@@@B@AB@B@@@@BB@A@@@@@@BA@A@@@BB@@D@@@B@@@@B@@@B@@@B@@@AB@@@B@@B@@@B@@@B@@@D@@@DDD@BBB@@@@@@B@@@@AB@@@B@BAB@BB@@@@B@BA@@D@@@@@BA@@@@BB@BB@B@@BB@@BB@@BB@@B@@@@@BD@@BBB@@@BB@@@@@BBB@@@B@@BBB@@@@B@@AB@BB@@BA@@B@@A@A@@BAB@@@@@@A@ABA@A@@@A@AB@AA@@@@@@@A@@@@B@@B@@@@@A@@@A@@@@@A@@@@@@@@@@BA@@@A@@@@@A@@@@@BB@@A@@@A@B@@@BBB@@A@@B@B@@@@@@BA@@@A@@@A@AB@@@B@B@@@@@@AA@@A@@@@@A@@@@@BB@@@B@@@@@@@@A@@@@BAB@@@@@@@@@@@@A@@@@B@@@B@@@@@B@@@@@B@@@B@@@@@@@@A@@B@@@@@@@B@@@@AA@@@@@@@@@A@@@AA@@@@A@@@@A@AB@@BB@B@@@B@@A@B@@B@@@@B@BB@@@BB@@@BBB@@@@@@B@@@@@@A@@@BB@@@@@@@@@@@@@@@B@@@@@B@@@@@@@B@@@@A@@@@@@BB@BB@@@@@@@B@@B@@@@@B@@A@@@@@@@@B@@@@@BB@@@@@AB@@@@B@@@@AB@@@@@@B@@@B@@@A@@@@B@@@B@@A@@@@B@@@@@@@@A@@@@B@@@@@@@@A@B@@B@@B@B@@BAB@@BB@B@@@@@BA@A@@B@BA@@@A@@BAB@@B@@BA@A@@@A@@@@B@@@@BB@@@@AAA@@@BB@@@B@B@@@@BB@@@@@@A@@B@@@@@B@@@@@B@@BB@B@@AB@@@@@@BBA@@B@@@@@@@B@@@@A@A@@@@@@@@@B@ABB@@@AB@@A@@@A@@@@A@@AC@@B@@@AA@@AA@A@@A@@A@@@AB@@@A@@AA@@B@AAB@@@@@B@@@@A@A@A@@@@@AA@AAA@A@@@@A@@@@B@B@@@B@@@@@@A@@BA@@@@@A@@B@@A@A@@@@A@@ABAA@BA@@@BB@@@@@@A@@@@@A@@@@A@@ABCA@@@BA@@B@@AA@@A@@@@AA@@AA@@@AAA@@@@B@B@@@B@@BDBBBHBBBDBBBDB@@BDBDDDB@@DBB@B@B@B@BAB@DA@@D@FB@@@B@B@ABBCB@@B@B@@@@@@@AB@@@@@@B@@BB@B@B@B@@@BBB@BBBBBAABBB@A@@@@@AB@BDBDB@@@@BEA@@A@@@BBB@@B@@@B@@@@@B@@@@A@@CA@@@@@@B@@@B@@@@@B@B@@@@@@A@@@@@A@AB@BA@@@A@@B@AA@@@@@@B@@@B@@A@@B@@@BB@A@@@@@@@@BB@@@@@@@@B@@@@A@B@@B@@@@@B@@BBB@B@@@@@@@@B@@@@@B@@@B@@B@@A@@BB@@@B@@BABA@@@A@@@@A@@@AB@@@@@@B@@A@@A@@@B@@@@@@@@@B@@@B@@AB@@@@@@@@B@@@@@BB@@@@@@@@C@A@@@@BA@@@@BB@@@@@@@@@@@@AA@@BA@@@@@@@@@@B@B@@B@@BA@A@C@@@A@@@@@@@@@BB@@@@A@@@@@@@@@@@B@@@BB@BB@@BA@@@@D@@@@@@@@A@@B@@A@BB@@A@@B@@@@B@@@@@BB@@@B@@@@@@@BB@@B@B@@A@@@A@@@BBA@@@@@A@@@@@@@@@@@@@@B@@@BA@@A@@@@A@@@@@A@@@@@ABA@@@@B@BB@A@@@@B@@A@@BB@@@A@@BB@@D@@@B@@@@B@@@@B@@@BB@@BB@@@@@@A@@@@@@@A@@BD@@@B@@DB@@B@@A@@@@@@B@@BB@@@@B@@BDD@@@@@B@B@@@@BB@@@A@@@@B@@@@@B@B@@BBB@BB@@@@@BB@A@@@BB@@@B@@@B@@@B@@@AA@@BA@@BC@@B@@@B@@@AA@@B@@BB@@@@@BB@@@@@@@B@@B@@@B@@@B@@@BB@@@@@@@BB@B@BA@@B@DA@@B@@@@@BBA@@BB@@@@@B@@@@A@@BB@@@@@B@@BA@B@@@@@B@A@@B@B@B@DD@@@@@BA@@@BB@@B@BA@@B@@@@B@@D@@A@@@BB@@@@@BBB@@@@A@@B@@@B@@@B@@ABB@@DBB@@@@@B@@@B@B@B@@@@A@@@@B@@A@@@@AA@B@A@@@@@A@@@@@@@AC@AA@@A@@BA@@A@@A@@A@@@@@@AB@@@A@@A@@ADA@@@A@@@@B@@AD@@A@@B@@@@@@@D@@@AA@@B@BA@AB@B@B@@@@@@AA@@@@@AB@AAB@@AAA@@@@@A@@@@BB@@@@@@@@BAB@@@@A@@@@@@B@@A@@A@@@@@A@@@@B@@@@@@AAA@@@@A@A@@@XqBA@@@@B@@@@A@@A@AB@A@@@@@@@@@AB@@@@@AA@@@@@@@AAA@@A@BBA@@B@@@@@@@A@@AA@@@BA@@@A@@@@A@@AC@@@B@@AAA@@@@B@@@@A@A@@AAA@@@@@@@ABA@@B@@@@@@B@@@@@AB@@B@AB@@A@A@@@@AB@@@@E@@BB@@@A@@@@@@@@A@@B@@AB@B@@@@@@A@@A@@B@@A@@@@@@@BA@@@@B@@@@@@@B@@AB@A@@BB@@@@@B@@@@@@A@@B@ABB@@@@@@@BA@@@@@A@@@@B@@@@@@A@@@@B@@@BB@@@@D@@@B@@@@@@@AB@@B@@@@@B@@@@@@A@@DAB@A@@BAB@@@B@@@@D@@@@@@A@@@@@@BA@@@B@@BAA@B@@@@@@@B@@CB@@@@B@@@@B@@@@@@AB@AAB@@A@@@@@A@@@@@A@@@@@@@AB@B@@@@@@B@@B@@@@B@@@@@@@@BA@A@@B@@A@@@@@B@@B@@@@@@B@@@@B@@BB@@B@A@@@@B@B@@@@@AA@@@@B@@@BB@@@@BB@@@AB@@A@@A@@@AA@@@@@C@@@C@@@@B@@B@@@BB@@@B@@@@@@@@@AAAA@AA@@@@@D@@@B@@@BA@@@AD@@A@@BA@@@AB@B@BA@A@@BAB@@@@A@@@A@A@@BA@@@A@A@A@ABABC@A@@@@BA@@@@@A@A@@@@A@@@AB@B@@@@A@@ABC@@A@@@@@@DA@@@@@@@AA@@@A@@AA@A@@@AB@B@@@@@@A@@B@@@@@B@@@B@B@B@@@@@@@@@@@@BB@BA@@B@@AB@BB@@B@B@B@BB@@B@B@@AB@@@D@BB@@@@@A@@@A@@B@@@@@@@B@@B@@BB@@@B@@AB@@B@@@B@A@@BA@@BB@@A@B@@@BB@@@B@@@B@@B@@@@@@@@B@AB@B@@@BB@BBB@@@BB@@@@@BABAB@@@@@@B@B@@@@@A@A@@@@BB@B@@@BB@@@BB@@@A@@BBB@@ABA@@BB@@BA@@B@@@B@@BB@BAB@@@@@@BB@B@@@@BBA@@B@BB@BD@@@@B@B@DAB@B@@BB@B@B@B@BB@@@@@AB@@@@@@A@A@@@@B@@@@@B@@@BA@A@A@@@@AAA@AA@@@@@ABAB@B@@A@@@@@@B@@@@@@@@@@@@AB@@@@@@@BA@@BBB@@@BB@@@@BA@@@AA@@@BAB@@@@@@A@BD@@@@A@@B@@@@@@@AC@@@BA@@@@@@A@@A@@@@A@@B@@@@@@A@@AAA@@@@A@@@@@@A@@@B@@@@@B@DD@@@@@@@A@@@@@@A@@@@A@@@BBA@@@@AA@@@@@@@@BA@@BCBBB@@@B@B@@AB@B@@@@A@@@A@@@A@@@@@AA@A@A@@@ABA@@@@@@AA@@@A@B@@A@@@A@@@@AB@@@@@A@@@A@@@AA@@@@A@@@A@AA@@AB@B@@@@@B@@AB@B@@@A@A@@A@@@@B@@@B@BBB@@AA@@@AAABA@CA@@@@@A@@@AA@@@@A@A@@@A@@AAA@@A@@@@@A@@D@BA@@@A@ABA@@A@@A@@@@@@A@@@@B@@AAB@A@B@A@@@@A@@@@@@@AB@@@BBB@B@@BBB@@@BB@@@BBD@@A@@@A@@@ADC@@DCB@@@BA@@@@B@@@@AB@@@@A@@@@B@@A@@BB@@@A@@@@B@@A@A@@@@@AB@@@@BB@@@@@@@B@@CB@@@B@@@@@BA@@BABB@@@@BB@@B@@@B@B@@@BA@@B@D@B@@@B@BBB@B@B@@@BA@@B@@@@@@BB@@@@@@@@A@@BA@@B@@@BCB@@A@@@A@@@@@@@AB@@@@@@BABB@@@@BB@@B@@@BB@BAB@@@@A@AA@@@AB@@@@@@AB@@@@@ABABA@A@A@@@@B@@B@@B@@@@@@BA@@@@B@@B@@@@AB@@@@@@BA@A@@B@@D@B@B@@@@@@@@B@AB@@BB@@AA@B@@@@@@@B@B@@A@@@@@@A@@@@@@@B@@A@@B@@@@@@@A@@BB@@@A@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@A@BA@@@@@@A@@@@B@@@@@@@A@@@A@@@@@@A@@B@@@@@@BB@@BB@@@@BBB@BB@@@@@B@BB@@D@@B@B@BD@B@@BB@BB@@BB@@@D@@A@@B@B@BBB@BDB@BBB@@@@B@@@BB@@BB@@@B@@@@@@B@@@@@@DB@@B@@A@@@@@@BBB@BBB@BB@@BBB@B@@AB@@@BAB@B@@A@A@@@A@@@@@ACA@@@@@@@@B@@AB@@BD@@BBBBBDD@BB@@@@@BA@ABCDADA@@BA@@BC@AA@AAAAAA@@@A@A@@C@A@AA@@@@@A@@BA@@@@@A@A@@@@@@@@A@@@AB@@A@@@@B@@@@@@@@@@@@@@@@@BAA@@A@@A@@A@@@A@@@@AA@@@@A@@@AA@@@ABE@@@A@@@BA@@@@@AABA@@@@B@@ABABA@A@AA@@A@AAACCA@AB@DC@@BADABA@ABBBB@@@@BADAB@@@@@BB@@@@B@@@B@BA@@BB@@B@B@B@@@@BB@B@@@BABAD@BABCBCBABAB@@@@@B@@@@@B@@@@ABA@ADE@ABADA@@DBBB@@B@@BB@AB@@@B@@B@@@@@B@B@@B@B@@B@@@BA@@@A@@@@@@B@B@B@@@B@B@B@@AB@BBBD@@@@B@@@@A@@@@AA@@@A@A@@@@B@@@B@@@@@@@@@BC@@B@@@DC@@@AA@@@@A@A@A@@B@@@B@B@B@B@@@@@@A@AAC@@@@A@A@@@AAA@ABA@@@EE@@@@@A@@A@@AA@@B@@AA@@@@BA@A@@A@@@A@@@@@A@A@@A@@@A@@DCDC@A@C@@A@@@ABABA@AB@BA@A@@@@@BAAABEBA@ABACECE@@@AAAAE@C@AAA@@@A@CCEAE@@C@AAA@AEACA@@AB@B@BBB@@@B@DA@A@@@AA@A@A@@@A@A@A@@A@AB@@AA@A@CB@BA@AAA@IC@@AC@@@C@A@@@AC@ACCAA@AA@AAC@@@AB@BBDBD@B@BABABE@C@AB@BCDCB@DAFAJCDI@AJSBE@I@ABAB@B@@AD@BA@A@C@E@IB@@E@@FA@@BE@CAC@CA@@@@ABAB@@@@@BBBB@@@@@B@@BAB@@@@@@@AACAAAECA@A@@A@@B@@@B@D@BB@@@@B@@@B@@@@@AA@A@A@A@@@@B@@BBB@B@@@B@B@@B@B@@@@AAA@@BAB@@B@@@BBD@B@B@@@BA@@B@@@@B@@@B@B@BCBCB@BA@@@AAC@AAAA@ACACBC@AA@@@CFA@AAAAA@AAE@AAAABA@AACCEGGAAACAA@@A@@@@@A@@@A@@@@AA@@@AAGCCAGCCAKCCAEC@@@B@@AB@D@B@@A@@@A@@@AAAAE@C@A@AAEECACCGAA@CAEC@@AA@@AA@A@@@@@AA@GA@@C@AAEC@@@@@@@A@@@A@@@@AA@@AACCA@AAC@@@CAA@C@A@A@AD@@A@A@CAIGEGAA@ABAAA@@@AC@@@A@A@AAC@A@GAC@@@AABIACEE@AAAAC@AAAA@@@ABC@A@@@A@@@ECICQGAAGECA@@@A@@@CBCBAFEHEJEFAB@@@DA@@DE@EBABC@EB@@A@@DADAFA@@@@D@@@FBFBD@B@BAD@BADAF@F@@@B@FA@@DABC@AAM@GDGDCBA@A@A@@CA@@AAGCEEEAEAC@AA@@AC@CA@@@@A@@FEBA@C@EA@@CBC@AFGB@BABC@@@A@@CA@@EAA@EACCA@@A@E@GBCBA@ABA@@AC@CCEAA@A@CBGAK@AEE@@CAC@GAEAA@AB@@A@@F@@A@@@C@A@OBC@A@C@"],encodeOffsets:[[121163,28764]]}},{type:"Feature",id:"361121",properties:{name:"上饶县",cp:[117.907849,28.448982],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@BCBA@A@@CA@A@@BA@A@@@@B@@@BA@@@A@@AAD@@@@@BA@A@@B@@@@@B@B@@@@A@A@A@A@@B@@AAAB@@@@@@@@@BA@A@A@AB@AAAA@@@@@@@A@@@@@@@AB@@@AA@@@@A@@AA@AAC@AA@@@@@@@A@@A@@@@A@@AA@A@AA@@@@@@A@@@@A@@@@@B@@@@AB@@@@A@@@A@@@@ABA@@@@@BA@@@@B@@@@@@AA@@@CB@@@B@@@@@A@@BABA@@@AB@@@A@@@@@CB@@@@@@@@BA@@@A@@B@@@@A@@@@@@A@A@@@@@@@@AD@@@@@@@@@A@@@@@BA@@@@@A@@A@ABA@BA@@@AB@@A@@@A@A@AA@@@@@AA@@@@@AA@@@@@@@@@@A@@@A@@@@AA@@@A@A@@@A@@AA@@A@@@A@@@@BA@@B@A@@@@BA@@A@@@@@A@@B@@@A@@A@@B@@@@@AB@B@@@@@B@@A@@@BB@BA@@@BB@@B@B@@B@BB@AB@@@@@@@@B@@@@@@@@B@@@@A@@BB@@BAB@BA@@@BB@@@B@@@@@@@BB@@@@BB@@@@A@@AA@@@@@@B@@@@B@BB@@@@@@@@A@@@@@A@@@@@BBB@@@B@@A@@B@@@D@@B@@@B@B@B@@B@@@B@@@@@AA@@B@@BB@@@@@@@@A@@@@@BB@@@@@@@B@@@B@@AB@@A@@@BB@@@@B@@@@B@@@@@@@@A@@@@@A@@B@@B@B@@@@@@B@@@@@@@@BB@@@A@A@@@@@@BB@@@BA@@@@@@AB@@B@@@@@D@B@@@B@@@@@@C@@@A@@A@@A@@@@B@B@@A@@@@@A@@BA@@AAB@@@@B@@@AB@@@@@@BBB@@@AB@@@@@B@B@@@B@@@@BAB@@@@@@@BD@@A@@B@@A@A@@@@@@B@@@@@@A@@@@A@@@B@@@@@BAA@B@@@@BB@@@@@@BB@A@@B@@@@@@@B@@@@B@@@BB@@@@BA@@@AA@@@B@@@B@@@@B@@@@@B@@B@@@BBBBAB@B@B@@@@@@AB@@@@B@@@@B@@@@@@AB@@@@B@AB@@@B@@@@@@B@@A@@@@@@@@@B@@@B@@BAB@@@AB@@@@A@@@BB@@@B@@@@B@@@@@@@B@A@A@@@@B@@@@@B@@B@@BB@@B@@B@@@@@@BA@B@@A@@B@@BBA@B@@@@@BA@AA@BA@AA@B@@@@B@@@@BA@@BAB@@BB@B@@B@@@B@@@@@@@@@@@BBB@@AB@@@BB@A@@B@@@@B@@@@@B@@@BB@@B@@BABA@@B@@B@@@B@@@@@A@@BB@B@@@@BBBBB@BA@A@@BA@@@@BDBD@@@@@B@@AA@BA@@B@A@@@@A@@@@@A@@@@@A@AB@@A@@@@@DBBA@BBB@@BA@@B@@AB@D@B@@B@@B@BBB@B@@@B@BA@CBA@@BA@C@@@@BC@@@C@A@ABCB@BCB@@@DAB@B@RABE@G@AAACA@A@A@@DAB@@IBC@A@@FC@@BAB@B@DBH@BBB@B@BBB@B@@@@@BC@A@AB@@@@@@@AA@@B@@@@B@A@@@A@@@BB@@@@@B@@ABABA@@BA@@BAB@BA@@@A@AA@@@@A@@B@BAB@BABA@BB@@BBB@BBB@@@@B@@@B@@AB@BB@A@A@@@@B@F@@@@@BA@A@@@AA@A@@@BG@@@AA@A@A@A@A@@@@@@A@@B@@@B@B@@@B@@@@A@ABA@@BABABAB@@@D@B@B@@@B@B@@@@@BA@ABA@@A@@A@@@AAA@@BC@@B@@@@B@@B@A@@B@B@@B@@@@@@A@AB@@@@B@@@@@ABA@@@@B@@@@ABA@@@AB@@BA@@@BB@@@AB@@@B@@A@@B@BB@@@@BABAB@@@@@B@@@@@B@@AA@@A@@@@@AAC@@@A@A@@AAB@B@B@@@@@@A@@B@@@@@B@@ABA@@BAD@@@@@B@@A@AA@A@@@AA@@@A@@AAA@@@@@@AB@B@@@@BA@B@@@B@@@@@BAB@@@@@B@B@@@@AB@@@BA@@@A@A@@BA@@B@@@@@B@@BBA@@B@@@B@@@@@@AA@@@@@@@@AB@DG@EBA@AAA@@BA@@BAB@BAB@B@BAD@B@@@B@DB@@BB@@@D@BBB@BD@B@B@B@D@BCBAB@@@B@B@B@@BB@B@@@B@@B@@@BA@@DABB@@@BD@@BB@@@@@A@A@ABB@@@@B@@@@EB@@A@@B@B@@BB@@D@@AB@B@@BB@@@@B@@A@@@B@@BAA@@@B@@@@@@@B@BB@@BA@@@@@B@@B@D@B@B@@@@@DA@@B@B@@BBBB@@@@@@@@B@@@B@DAB@BA@@@A@A@@@@@@B@DB@BB@@@B@@B@@@DBB@@@BAB@B@@ABB@@B@D@B@@@@BB@@@@@@@B@@@@BB@@@@@@A@@BAB@@ABA@@BA@@B@@B@B@@@@CB@@@@BB@@@B@AB@@@D@B@B@@@B@@@D@@B@@@@BBB@@@B@@@B@@@BA@AB@AA@@@A@A@@@AA@@@AA@@@A@@BC@@@A@@@@A@A@A@@@@@A@BA@A@@@AAB@@@BA@@@@@A@@@AB@AA@@@@BA@@@@@AC@@BA@@B@@@B@@@BCBAB@@@@@A@@AABA@@BAAAB@@@@@A@@BA@@@A@@@AAA@@@A@AA@@@AB@@@B@B@@@@@@AC@@A@@@@@@@@@@@@@@A@@BA@@AAAECA@@@@BA@@BA@@@@@C@@DC@A@A@A@AB@A@@AAAA@AA@@@@@A@@@@@AA@@ABAB@@A@@@C@@@A@@@AAAAC@AAA@A@A@@C@@@@AB@@A@A@A@@D@BAF@@@B@A@CA@@A@@@@A@@C@@@@@BA@C@AFA@ABAB@@A@@BC@@BAACBAB@@AB@@A@A@C@@@AAA@A@A@E@A@@BAB@D@DAB@@@DC@ADC@AB@BA@@@@BCBA@CBA@@AA@AAAAAA@AC@AAC@A@A@A@C@A@A@A@A@A@A@A@AAA@@AACACA@@A@@C@@AAAAA@AA@AA@@AAA@AAAAAA@@AAA@@@@@C@@BCB@BA@@@@@A@@@@A@@BA@AB@@@B@@@@@AAA@A@@BA@@B@@@@AB@A@A@@@@@BA@@@AA@A@@@A@@@BA@@@@@@@AAABA@A@@@A@@@A@@@@B@@@BB@B@@@@@@BABC@@@@AA@@@A@@B@BBB@@BBA@@@@@@@@AA@@@A@@B@BA@@@@@AA@@A@@@@@ABB@@B@@@@A@@@@A@AA@@@A@@B@@@D@@@@@@A@@CCA@@@@@BAB@@@@A@A@@@@@@B@BB@@@@B@@AB@@A@C@A@AA@@CA@@A@@@A@@B@BB@@@A@C@@@A@@@@C@AB@A@@A@BA@@@A@AB@F@@@@@BA@A@@@@B@@@@@BB@B@@B@BBD@@@@@@AA@@AB@@@B@@@AA@ABA@ABA@@@A@A@ABA@AB@@A@@AA@@@@BA@@B@@A@@@@@A@@@A@@@A@@A@@@A@A@@@@AB@@AB@BA@@B@@A@A@@B@D@@AB@@A@@@A@@@@A@A@A@@A@@@C@@AAACAA@@@A@C@CAA@@@@A@A@@@AA@A@@A@A@@A@@@@B@B@@@@A@@@@@@AAAA@@@@BA@AB@@@BAB@B@@@@@AA@A@@BA@@@@@A@A@@BA@AB@@A@AB@BBB@B@@@B@BA@@B@@@B@BB@@@@B@@@B@@B@@BAB@@@@@B@@@B@@@BBB@@A@@@A@A@@B@@A@@@@AA@AAA@@@A@A@AA@@@@EAA@@@A@AA@@AAACC@CA@AAAA@ACBA@CACAEE@@EC@@A@AAA@@@A@C@@@ABABABA@@@EAAAA@A@C@A@@@A@GDCD@@@@@@IDA@E@C@G@A@@BA@EDABABA@@@A@AAA@CC@@EACAA@@@A@C@C@@BA@@@AAA@@AAA@@@A@AA@CAACA@GE@@A@@@@A@A@ACA@@A@@@AAA@@A@C@ABG@CBAFEBAB@@CBC@@@ABI@AAAAACC@@A@AA@@A@A@C@IBC@A@CDCBABA@A@@AA@@C@A@CAAAAAAA@CAAAAACC@CAC@C@A@A@A@CBA@C@ECC@C@A@CDCBGBCBC@A@CCA@@EAC@E@E@G@@@ABCBC@C@ABAB@B@D@BADBBABCDABGDEBAAAAC@A@C@A@E@A@@@AAAAA@A@A@CBADCHEDC@C@C@E@CAECCCCCE@CBC@C@CACACFEJADABA@A@AB@B@BBB@B@B@@ABA@@BC@A@CB@@CDABABABAD@@AB@@@BBF@D@@AD@B@BADABAB@@@B@B@B@@ABGF@B@B@@@BA@@BCB@@@BA@@BC@@@AB@B@L@D@D@B@B@B@@ABAB@B@B@BAD@BB@@B@@@@@BAB@@@B@@@B@@@@A@CAA@CAC@A@@@@B@B@BAD@BABB@BDBD@@ABAFA@@@BB@D@B@@@D@B@@A@@BB@@B@@@@ADA@@BBBDH@BB@@B@@@B@@@BCD@@AB@@BB@BDFJJDFBD@@@@BA@@@BB@@B@@@D@B@@@BB@@@BAB@@@@@@B@@@@CBCB@@@@@B@@@B@@D@@BBBBD@B@BBB@@@BB@BB@@@@ADA@@B@B@@AB@@A@@@A@@@@AAA@@@@AB@BCB@@@BABAD@@BHBDBB@B@B@JADAB@B@@BB@B@B@@@@ABA@@@AB@B@@B@B@@BB@@B@BB@@BB@B@BBB@@@BB@@AB@BAD@@ABCB@BA@@@C@@@AABA@AA@A@@BA@@@A@@@A@@@AAC@ABEBA@@@CBC@@BA@GDA@EBGF@@C@C@A@@@@@AB@B@@A@@@@@A@@A@@AABC@A@@ACA@@@C@@@AC@@@AA@@AGAAA@@A@A@A@@@AB@DAB@@ABA@CBAB@@ABABAB@B@B@@@@BB@B@@@@@B@@@@B@@@@@DF@B@@B@@@@@@B@@@@A@@@@@@@@@AB@BA@ABA@ABEFABABCBA@ABABAB@@ADAB@BA@ABA@C@A@@@BB@@D@B@@@@@BB@B@@@B@B@@ABA@@BA@CBA@A@@B@@@@@BBBA@@B@B@@BB@B@BAB@@A@@BC@EBABEBABABABA@CBEBA@A@A@EBABA@ABAB@@@@@B@BBBBF@B@B@@AB@@@@A@@BA@@B@@@@AB@@@B@@@B@@DBB@@B@@@@@B@@@@@A@@BB@@A@AB@BA@@BAA@@A@@AA@@@@A@A@AA@@@@@@B@@@@AA@AAAA@@AA@@@@BA@ADAD@@B@@@B@@@BA@@@A@A@@B@@@@D@@B@@@@@@@CBAB@BAD@@A@@BA@@@BBDDBBVZJH@BA@@B@@@BB@@@@@B@@@@@B@@BA@@@BB@BBB@@BDB@@@DAB@BA@@B@@@@@@@A@@@@@@C@@@ABA@@BC@@@@@AA@@@@@A@@B@@A@@A@@@@A@@@@@AA@A@@BABA@@B@@B@@A@@BB@@@B@@@@@BA@@@@B@@@@B@@@B@B@B@B@B@@DAB@B@@@B@@A@@@@AA@@A@@@@@@@@A@A@@BA@B@AB@@B@B@@B@@@@A@@@@@@B@@@@@@B@@@@B@@@@@@A@A@@B@@@@@@B@B@@@BAB@@@BBB@@@B@BA@@@A@A@@@@@@B@@B@BBDB@@BB@@@B@@A@ACA@@@A@@@A@@B@DAB@B@@@@BB@@BA@@B@BB@@@@@@AB@@A@C@AAA@@BB@@@BB@@@@@B@@A@A@@@@B@@@@B@@@@@B@B@@@B@@B@BAB@B@BA@@@AA@@A@A@@@B@BDB@@BAB@@@@BB@B@@AD@@@@A@@@@A@E@AA@@@ABCDAB@B@@AB@@A@@@AB@B@@@@@@@@AA@@@@BABA@A@@@@@@ABA@@@@@B@@C@@@@ABAB@B@D@@@B@@@BB@@@BA@@BA@@@@B@@@@@ABA@AB@B@B@@AB@@@@A@A@@AAA@@@@C@A@@B@@AB@B@@@B@D@D@@@BABA@@@A@@@@@@C@A@E@A@@@AAA@@AC@@@@@@AB@@@B@@DFAB@D@@@BA@A@@@@@@D@F@D@D@D@B@BB@BB@B@B@@@@@B@@A@A@@@@A@@A@@B@@A@@@A@@@AA@@GCA@AA@BA@A@CAA@A@A@CC@@AAA@@AAB@@A@@AA@C@A@@@@B@@BB@@@@@@A@AB@@A@@@A@AB@@@@@B@@@B@@@@A@A@CAAA@@ABA@@@@@@@@@A@@BA@@@C@@@@@@AAA@A@@A@@B@@AD@@@@A@@@@A@@A@@@@BA@@B@B@B@@@@@@AA@C@AAAAA@@@A@@@@@@A@@@A@@B@@@B@@@@A@@AAA@@@@A@@BA@@@A@@@@C@@A@@@AB@@@B@@A@A@@@AB@B@@@@@@AA@@A@C@@B@@@@@DBB@@@@BA@@@@@@@@@@BB@@@@B@@@@@@@@BBBAB@@A@AB@@B@@@B@B@B@@@@@@B@@@@CA@@CB@@@@BB@B@@@DA@@BA@A@@@AA@AAC@@@AA@AAAAA@@@@@A@@@CA@@@@@@@B@B@@A@@@@@A@@AAAAAB@@ABA@@@AAA@@A@@AA@AB@@A@@@@@@A@@@A@A@A@AA@@@@A@@KyWVA@I@@b@X@@@B@B@@AB@@@B@@B@B@B@BB@@@@@A@@@@BBB@@@@@@@B@@@@@B@@B@@@B@DAB@@@BCB@@AB@@@@@BBBB@@@AB@@A@AB@@@@@BBD@@@B@@@@B@@@@B@B@B@A@@@@AA@B@@A@@D@BBB@@@@@@@B@@@@@B@@AA@@@AA@@A@@A@@B@@@@@A@@@A@@@A@@@@@@@@@@@@AB@A@@@A@@@@A@@B@BBB@@@DB@@BBB@B@B@@CB@@@BA@@BA@A@C@A@A@A@@@@B@@@B@@BB@B@@@B@@@@@@@@AA@B@@@BA@@@A@@B@@@B@@B@AB@@BB@B@@@@B@@@@@@@@B@@@@@B@@@B@@B@BBBA@@@@B@@@@@@@@@@@B@@@AD@@B@@@B@@@@B@@@@A@@@BB@@@@@AB@@B@@@B@B@B@B@@@@@B@@@@AB@B@@@@@B@@@B@B@@@BA@@B@@A@@@AA@B@@BB@@@B@@@B@@@@@A@A@@B@@@B@@B@@@BA@@@@@@@@@@@BBA@@@@B@@@@@B@@@B@@B@@@@@@B@@AA@@@B@@@@@F@@@B@@@@@BB@B@@@@B@@@B@@@B@@@@@@B@B@@@@@@B@@@@@@AD@@B@@A@@@A@BB@B@@@BB@@@@A@@@A@@@@@@@BB@@B@@ABA@@@B@@@@ABB@B@@BA@@@BB@@@@@@A@@@BB@@ABBB@@AB@@@D@@@@B@B@@@@@DBBB@@@@@@A@@@@B@@@@D@@@@B@@@A@@BB@@@@ABA@@@ABA@@B@@@@@BB@BB@BB@@B@@BA@@B@@@BA@BB@BBB@B@D@B@BBDBBBB@@@@B@@@B@BB@@@BBB@@AB@B@@@A@@@@AB@@@@@C@@A@@B@B@B@@@B@@@D@DB@@BB@@A@@B@@@@@B@D@BAB@@A@@BB@@B@@@@@D@B@@@@@@@@A@@@A@@@@BB@BB@@@@ABABABAB@B@BA@@A@B@@@B@@B@@BBAB@BAB@@@@@@@AB@BCBABA@@BA@@@@AA@@A@@A@@AA@AAACA@C@@@@A@@@AA@@AA@AAA@@@@A@A@@A@@@C@A@@ACBCA@@AAA@@@ABA@AA@@A@CC@@@@AD@B@BA@AB@@@@@BA@@@@B@@A@@DA@@@@@@@BA@A@@@A@@@@@@@B@@@@A@@@A@@BA@@BB@BB@@A@@@A@ABA@A@@AA@@B@BA@@@AA@@AA@AA@@B@D@@@B@@BBB@@B@@@@@B@@BB@@@B@@A@@@@B@@AB@@@B@@B@@@@@@@@@@B@@@B@@B@@@B@@BB@@B@@BBB@@BA@A@A@@BABAB@@AAA@A@@@@AAA@@A@@B@@@BB@BB@B@@@@AB@B@@BDBB@@A@@B@BB@B@BB@@@B@@AB@@ABABAB@@A@C@CA@@ABA@EA@@AA@@A@AACAA@A@AAAAA@E@@@AB@BAAAAA@AB@@@@@B@B@@A@@@@BBB@@@@@BB@@B@@B@@BBF@BBD@B@BDB@@@B@@BBBBB@@@BBBBB@@B@BB@BBB@BBBB@@DBBB@@B@@@DBB@B@@@BBB@@@BB@@@@@@B@@B@@@@@BB@@D@BAB@F@BBB@@BBB@BB@@@@@B@B@@C@@@A@A@ABA@@@A@ABA@@@A@A@A@A@@@@@A@AB@@@@@BBB@@FD@BBD@@@@AB@@ABAD@@@B@@A@A@ABA@AB@@ABCB@@A@A@@@AB@@@@@B@@@@@@@BB@@B@@@@@@A@@@@@@BA@@BB@@BB@BB@@B@@@B@BB@@B@@@@B@@@@@BB@@@B@@@DB@@AB@@@@BB@@@@@BB@@D@B@@@@B@@B@@@B@@@@@@BB@@@@B@@@AD@@A@A@@B@@BB@BA@@@@B@@ABA@@@@@@@A@@@@B@@A@@B@@@@A@A@@@@@@B@@@@@BAB@@@@ABAB@@BB@@@@ABB@@@@BA@@B@@ABA@@B@@@@@@DB@B@@@@@BA@@@@B@@@@ABCBA@@@@B@B@@@@@@B@B@BB@BB@@@@@B@DBBB@@B@A@@AB@BB@@@AB@@@@B@@@@@@@BABB@@@DC@@@BB@@BB@@@@@BB@@B@@BBB@B@@B@@@@BA@@@A@@@A@@@@@B@@AA@@B@@@@@B@@B@@@DA@@B@@B@@BB@@@B@@@B@@B@@@@@@B@@@BB@@@BB@@B@A@AB@@@@@@@@@B@@@@@@@@@@BB@@@@A@@@BB@@@BA@@BB@@@@@@@B@@@@@@@BA@AB@@@B@@@@@B@B@@B@@A@@@@@B@B@@BB@B@@@BBB@@@B@BB@@AB@@BD@@@BBB@@@@B@@@AB@@A@AA@B@BB@@@D@@@@BBD@@@D@@AB@B@@A@@@ACA@@@@@@@@@BBBB@@@BA@AB@@A@A@A@A@EACAA@EAA@A@@@A@AB@D@@@@@B@@@@@BA@@@A@@B@@AB@@A@@BA@@BA@@@@B@B@@C@@@@@BBD@B@@BB@@@@@@B@@@BA@@@@B@B@B@@@BB@DBBB@@@@@D@@AD@@@@@@@B@B@BBB@D@@@B@@C@A@@B@@B@@@@@@@@B@@@@AB@BA@A@@B@@A@@BA@A@CA@@@D@@@@A@@@A@@@@AA@@B@@BB@@BB@@B@@@B@@BB@@@BBD@@AB@@@BAD@B@B@@@AB@@AB@@BB@@@@ABA@@B@@@B@BB@@@AB@B@@@B@@@B@@@B@@@B@@@B@D@B@BBB@@@BDB@@BB@@@@@B@@AB@@@BA@BB@@@@@B@@@B@B@@BB@@@B@@@B@@@B@@AB@B@@@BA@@@ABBB@D@@AB@B@@@BB@@@BB@@B@@@BB@B@@@B@@@@@BB@@@B@@BB@@B@BBBBB@@@B@B@@@B@@@@A@@A@@AF@@B@@@@BAAABAAAB@@C@A@BB@@BBD@@@@@@B@@CDGJ@@@@B@B@@@BAB@@@B@@@@@AB@B@B@B@@A@@BA@@@BB@@@D@@@@A@A@@BA@AB@@@BBB@B@@@D@@@@ABABAB@B@@ED@@AB@@@@B@B@BB@@BD@@B@@BB@@B@@@B@@@BABCD@@@B@B@B@@@BA@AB@@@B@@BB@@@@@B@@AD@@@BA@@B@@@B@@@@B@B@@ABBB@@@B@B@B@B@@@@@BB@@BBBBBBB@@B@B@@@@B@DBB@@@@BB@BA@@@B@@@@@B@@@B@@A@@@A@@@BB@@A@@BA@A@A@@@@@A@B@@@@BC@@@@B@@@@@B@@A@@@AD@@A@A@@@@@A@A@@@@@@@@@A@A@A@@@@@A@@@@@A@@@@@@B@B@@@@@BB@B@@BB@@@B@D@H@@@B@B@B@@B@@BB@@B@B@@@B@@B@@BF@@C@@@@B@@B@B@@@@BA@@B@@@@BB@@BBAB@@@@B@@B@@@@B@@BBBB@DBBBBBB@BB@@DDDBD@BABA@@B@@@B@B@@@BA@@BBB@@@@AB@@@B@@AB@BAB@B@B@@@B@BBB@@@B@BAB@@@@AB@@A@@@@@AAA@CB@@ABAB@@A@AB@@A@@B@BB@@B@B@@@B@@BB@@@FD@@BAD@BA@@BBB@F@@@DB@@B@@@BB@@BDB@BB@@@B@@BB@@@@DADABAF@@A@@BAB@@AB@@AB@@@@@B@@@@@@@B@@@@BB@@@@B@@BB@BBA@@@ABA@@BBBAB@B@BABAB@B@B@@BB@@@B@@@B@B@B@@@@A@@@@@ABA@@@@BADA@@@B@@D@@@DB@BB@@@@@B@@AB@@AB@B@BA@@@AB@@@@A@A@@@@ACB@@ABBBAB@@@B@@@@@D@@BB@@AB@@@B@BAB@DABADA@@B@B@@@B@B@B@B@@AD@B@B@BB@@@BB@@@B@BBBB@A@AAA@AB@@@@@BA@@B@@@B@@A@@@AAA@@BA@@@A@K@@@A@@AAAB@A@@@@@AB@@AA@@AAG@A@@BABA@ABA@@@@@A@@A@@@@CA@@ABA@@@AB@B@BABA@A@@BA@@@AB@BA@@B@@@@@B@AA@@B@@AB@B@B@B@BC@@@B@@B@@@@@B@@AA@@@AA@@B@@@D@@@@@@A@@B@BA@@@@@AB@B@@@@AB@B@@@@AB@@AB@@@BA@@BA@A@@BB@@B@@D@B@@B@B@@@@@B@@@@@AA@@@@BAB@@@@AA@@A@@A@A@A@@@A@A@@AB@@A@@@AB@@@BBB@@@B@BA@@@ABC@@@@@@@@AAA@@@@@@@F@@A@@@A@@@AA@@A@@@A@@@@@@@A@@@AA@@A@C@A@@BA@@@@B@@@BB@ABB@@BA@@BBB@@@@AB@@@@@BA@AB@@BB@BB@@B@D@B@@AB@D@@@B@B@@@@@BAB@BAB@@@BA@@@A@@DAB@@@B@BAB@BA@@@BD@@A@@@@@AB@@@B@@@@A@@@@A@@A@@@@@A@@A@BA@@BAA@@@@@A@A@A@@@BA@@@@AA@@@AA@AA@@AAAA@@A@@@AACA@@@AAA@@A@@@@@CA@@AA@AA@@ABA@A@@@@B@@A@A@@BAA@@A@@BAB@DABD@@@@@@@@@C@@@@@@@A@C@@@@@AB@B@@A@A@A@@BABA@@@A@@@A@A@A@A@@@C@@@@BABA@@@AA@AA@AAA@AAA@@@AB@BA@@BBB@B@@@BA@@@A@@@@@@A@@@@@A@@@BAB@@A@@@AA@@@B@@AB@@@@B@@BABA@@BB@@@@B@@@AC@AB@AA@@@B@B@@@@AAC@@@BD@@@@CA@@AA@@@@AA@@@@@@@AA@@AA@@A@@A@A@@@@@BA@A@A@ABABABA@@@@@C@A@A@@A@@BA@AACAA@A@CC@@AACA@A@@@@BB@@D@@@@CAAAA@@B@@ACA@@A@BA@@@@@A@@@A@A@@@A@AB@@@BB@@@@B@A@B@@@@A@@BA@@@@@@@AA@@A@@@@A@@@A@@@@@@@@A@@@@@B@@@@AC@@@@@@B@@@B@BA@A@@@A@@A@@@BA@A@@@BBABC@@B@BA@@F@@@B@BAB@@BDB@@B@B@@BB@@B@@B@@@@@BB@@@BB@B@@BFBB@BB@@B@B@BCB@@@@@@@@B@@B@@D@@@@BABA@@@A@@@ABA@@@@B@@BBB@@B@B@@A@@B@BA@A@@B@D@@@BB@@@@A@@@@@@@@BA@@@@@A@@BB@@@DB@@B@@@BD@@@@B@@BB@B@@BBBBBB@@@B@@B@@BB@@BF@BDF@@B@DC@@BB@@BAB@B@BBB@BDB@@B@@B@@@B@B@@@BBB@@@@@B@BBD@@@B@B@@@DB@@@@BB@@B@@@B@@@BB@@BAB@@@B@@@@@AAAC@@A@@@@AD@BAD@@@B@@A@A@A@@@A@@AAAAB@B@@A@@@@B@B@@B@@B@@@@@@@B@B@@@B@@@@@A@BA@AA@@@@@B@@@@@@A@A@@@@@@DC@@@A@@@A@A@@AA@@AA@@A@AB@@@A@@@@@@@@@@@@@ABA@@@@@AB@@A@@@AAA@@@AA@@AAAA@A@A@@@A@CAA@AA@@@@A@@A@A@@@A@@B@@@@@@B@@@@@@@@@B@@BA@@@@BB@@@@@B@@@@@@B@@B@@B@@@AA@@@@A@BA@@@@@@B@@@@@@@@@A@AA@@@@A@@AB@@@@@A@@@@@@@A@@@@@@@@@FCB@@@@A@@@@CA@@@A@@@AA@@A@C@A@A@@@@BAAABA@@BA@ABA@A@C@@BABA@@@@@AA@@@AA@AD@@C@@@A@@A@@@BA@AB@@@BA@@@A@@@@@A@@@@A@B@BA@@A@B@@@BA@A@@F@BAB@@@@A@@AA@A@@@A@E@@@@A@@B@@EA@@@@@AB@@@@@B@@@@A@@B@@@B@@A@@A@@AB@B@@BB@@@@@@@@B@B@@@@@AA@A@@B@@@@BB@@B@@AB@@A@A@@@A@@@@@@@AA@@A@A@A@AA@@@B@@@B@D@@@@A@@A@@A@@AA@@@@@@B@@@BBB@@@@B@@B@B@@@AA@@@A@@@@BB@@@A@@@@@A@@@A@A@@AA@@@@BA@AB@@AAA@@BABBD@@@@@@A@@A@@@@@@A@A@@A@@@@@AA@AA@@A@@@@BA@@@@AA@@@AB@@BBB@AB@@@@A@@@@B@@B@@@B@B@@@BBAB@@@B@@@@BB@@@@B@B@@B@@@@@@B@@BB@@@@BA@AB@@@@@A@@@@@@@@@@AB@B@@@@@@AA@@@AA@@AA@@@A@BB@@@@A@@B@@@BB@BB@@@@@D@BB@@B@@AAA@@@@@C@@@@B@@@@@@A@@B@BA@@@AA@@@BA@@AAA@@@A@A@@@@A@A@@@@@@@A@@@A@@@A@@A@@@@@@@@A@@@AA@@@@@BA@AA@@@A@@@@@A@@AB@B@@AA@@@A@@@AA@@CB@@AA@@@A@@BA@@@@A@@@@@@@@@@@@A@@@@A@A@@@@B@@A@A@@@@A@@BA@@@@AAA@@A@@A@ABA@@@B@@BB@@B@B@@@@AB@CAB@B@B@@@@AA@@@@CA@@@AA@@A@@CA@@B@@@AA@@@@A@A@@@@AB@@ABA@@@@AB@@A@@@A@@@@@@@@A@@B@AA@@@@A@@@@BA@@@AAA@@@@@@@@AA@A@@@@A@@A@@@A@A@AA@@AC@AA@@AA@@@@A@ABC@@C@@@@C@A@@@A@@B@@@@@@A@A@@@@AB@B@@A@@B@@@B@BA@@@@@@AA@@@@@A@A@@@A@@A@@A@A@@AA@@@A@@@B@B@@@@B@@@@@@A@A@@@A@@@@@@@@@@@@@AB@AA@@@@A@@@A@@AA@@@@ABA@A@@AA@@A@@BAA@AA@B@@A@@@@AAA@@BA@@@A@@@@A@@@@@@@@@@@@AAAB@@A@A@ABA@@A@@@A@@A@@B@AAC@A@@@AB@B@@@@@AA@@@AA@@AA@A@@@A@@@A@@@@@A@@@A@@@@AA@@@@A@@@@A@@@A@@AA@@@@@A@@A@@@@@@A@@@@@@@A@@@@@A@@@A@@B@AAACAE@@@@@GE@C@G@@@B@@B@B@BA@BB@BA@@BCF@@@@A@@B@@@@BB@@B@@@@BA@@@A@@@@@@@AB@@A@@@B@@@@@@BA@@@@@@@B@@@@@@@B@@A@@@@B@@@@@B@@BA@@B@@A@A@@@@@@B@@@B@@@AA@@B@@@B@@A@@@BBB@BA@B@B@@@B@@@@@@B@@BB@@@@D@@@@A@A@@BC@A@A@A@@@@@A@A@@@@ACC@A@@A@C@A@@@AAA@@@AA@A@A@A@@@@@A@@A@A@@@@@B@BA@@@@@A@@@@@@@@@@@A@@A@@@@@@@@@@A@AA@@@@@ABA@@@A@@@@@AB@@@@@A@@@@@A@@@@B@@@B@B@@A@A@@@@A@AB@@@A@@A@@@@@B@BA@A@@@@@AA@@@@B@@@@@B@@@@A@@@BA@AA@@@AB@B@@@@@@@A@@@@@A@@@@@A@@A@A@@@@@@@BABBBA@@BC@@@A@@A@A@@@A@@@@@@@@A@@@@@AEAEAAA@CDCC@ECEAEC@A@@@@A@@@@@@A@@BA@@BA@@@@@A@@@BB@@BB@@@B@B@@@BAB@@BB@@@@@@A@@A@AA@@@BA@@AA@@@BA@@@@@@@@@A@@A@A@A@@AAA@@@@AA@@@@A@@A@@@A@@@ABA@A@@BA@ABEBA@@B@"],encodeOffsets:[[120765,29116]]}},{type:"Feature",id:"361123",properties:{name:"玉山县",cp:[118.244769,28.682309],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@AAA@@@AACA@A@@@@CA@@A@A@@@@AAA@AA@@@@AA@@AA@@@@@@@AB@@@@@@@@B@@@B@@@@@@@A@@B@@@@@@@ABB@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@AB@@@A@@AB@@@@@@@A@@@@BA@@@@@@@B@@@@@@@@BA@A@@@@@@@A@B@@BA@@AA@@B@A@@@@@@A@A@C@@@@AB@B@@A@@@@A@@B@@A@@@@A@@B@@A@@@@A@@@@AA@@@@@@B@BABAB@B@@@@BA@@@@@@BA@@B@@B@B@@A@ABA@@A@@@AA@@A@@A@BA@A@@@@A@@B@@@@@@@B@@@BA@AD@@A@@@AB@@@B@@@@@@A@@A@@@@A@@@AB@@A@A@A@AAA@@@A@C@A@@@AB@@A@A@@@A@@@AA@@@@AAAB@@AB@@@@A@@@A@@DA@@@@@@@A@@A@@A@@B@@@@@B@BA@@@@@@B@@AA@@@BA@@@@@@B@@A@@B@@A@@@@@AB@@A@CD@@CD@B@@@B@@@BC@AA@@A@@A@@AA@AA@A@AA@@A@@B@@@@@@@B@@B@A@B@A@BB@@@A@@B@@@@@@@@BB@@@AB@B@B@@ABC@@@@B@@@@@BB@BB@@@B@@@B@B@@B@@B@@@B@@@@DBB@BABB@@B@@BA@AAA@@@A@@@@@@BB@B@@@A@A@@BA@@@@@A@A@@BB@@B@B@@@B@@B@@B@@@B@@@B@@@@BA@@@@@B@@@BA@B@@@B@@B@@@@B@BA@@B@B@B@@B@@@@@B@@@B@@@B@@A@A@@BA@A@@@A@AAAD@@AB@@@@@@@@BB@@@@AB@A@@@B@@B@@@@@@@@B@@@@@@CCA@@@@@A@@@B@@@@@@@@B@@B@BB@@@B@@@@A@@@@B@@B@@@@B@@@@B@@A@@BD@@@@@@A@@@@B@@C@@A@B@@@@A@AB@@B@@B@@AB@@@@AA@@A@AA@@AB@@@@@@A@@B@@@@@@@@@@A@@@@@@@@BA@A@AB@B@@@@BBB@BB@@@@@B@BAB@@A@@@@@A@@@@@@B@B@@@@A@@B@@@@AAA@A@A@A@@AA@A@CBA@A@@@@@ACA@@A@AB@AA@@@@@AAA@@@@@@BA@AAA@@@A@@@AB@@AA@@AB@BA@@AA@AB@@@A@@A@@AA@@A@A@@A@@B@B@@@@@A@A@@@@@@@BABA@A@@@@AA@@A@AAA@@A@@@ABAA@@@@@@@@@@AA@@@A@@@A@@A@@@A@BA@@AB@@AB@A@@@A@@@BA@@@A@@AA@@@AA@@@@@@@A@@@@B@@@B@@@@AAC@@@A@@BA@A@@@AAA@A@A@@@AAA@@BA@@@ABA@@A@@@@@@@@@@A@A@A@@@A@@@@@A@@@@B@@@@A@A@@B@@@BBB@@@B@@BB@@@@@@B@@C@@@@B@@@AD@BB@@@@@@ABA@@B@@@@@@B@B@@@@AB@@@@@BADAB@B@B@B@B@@AB@@@B@B@@@B@@A@AB@@@BABA@A@@B@@AB@@@BC@@B@@AB@@A@@@C@@@@@B@@BBBBB@@@@@@@@A@@@A@@A@@@AA@@@@@@D@@@D@@@@BB@@B@@@@BA@@B@@AA@@@@AA@@A@@@@@BB@@@@A@A@@@@@@B@AA@@AA@@@@@@A@@@@A@@@@A@@@@@BA@@@@BAB@@@@@@@@@A@@A@@@@A@@@@A@A@@B@@@@@@@B@@@@@B@@@@@BA@BBA@@B@@@@A@@@@@@A@@A@@DA@@@@@@@A@B@AB@@@A@@AB@@@@@@@B@@@@C@@@@@@A@@BA@AB@A@CB@@@B@@@@A@@@@@A@@@BA@@@@@@A@@@C@@@@@AA@@A@@@@@@B@@@@A@@@@@@B@@@@AB@@@@@@A@BAA@@@@B@@@@A@@@@@A@@AB@A@@BA@@@@@@@A@@@@@AB@@@@@@B@@@@AB@@@@B@@@@A@A@@BA@@@@B@@@@@@B@@@A@@AF@@@@@BA@@@@@B@BA@@B@AA@@B@@@@@A@@@@A@@@AB@B@@@@@@BB@BB@B@@@@@@A@@B@BB@@@A@@BD@@@B@@B@@@B@@A@@BB@@@B@@@@@@@AB@AAB@@@BB@B@@@@@@BB@@@@A@@B@@@@@@@@@BBAB@@@@B@@A@@@@@ABWr@@B@B@@@@@BB@B@@@@A@@@@@@B@@@@@BB@@@@A@@@@B@@@@@BA@A@@@@@@A@@A@@B@@@@@B@BB@@BA@BBA@@@@B@@B@@@@A@A@A@@BABA@@@BB@@C@@@@@@@A@@@@BC@@BA@@@@@@B@@CB@BB@@@@B@@BA@@@@@@@BB@@@@BB@@AB@@@BBD@@B@@@@@@@B@@@@@B@ABB@@@@@BA@@@@@@B@@A@A@A@@@A@@@@@A@CA@@AA@BA@@@A@@@A@@@@B@@A@AA@@@@A@@A@@@BC@@@@A@@A@@@ABA@@@AA@@B@@A@@@@CCA@A@A@@@@B@A@@@@@AAB@@@A@@@@AA@@@B@@A@@@@@A@@AB@AA@@@@A@@@CBA@@@ABA@A@@A@@@@@@AA@@A@@@A@@@A@@@@A@@@@@@AA@@@@A@@AA@@@BB@@A@@@A@@@AD@@AB@@BB@@A@@@A@@@A@@@A@@A@@@BAA@@@@A@@AAA@AA@A@@@@@A@@@@@@B@@AA@@@@@A@A@@@@CC@AA@@@@@AA@@@A@@@@B@@@@AA@@CA@@@C@@AB@@@@@@@B@@@@@@@AA@@AA@@A@@@@@@A@@A@@@C@@@AA@@@B@@AA@@@BA@@@@@@BAAA@@@@@AB@B@@@@@B@@@@@B@@B@@@AB@@A@@@@@@@@@@@@@@@@B@@@@AB@A@@@B@@@BA@A@@@AA@@@@@@A@@@A@@A@@@@@A@@A@@@@BA@@A@BA@@@@B@@@@@@C@@@@@AB@@AAA@@A@@@A@@@@@@@@@@@B@@A@@A@@@@@@@@@B@@@D@BAB@@@AA@A@@@@@@@@@@@AB@@BB@@@@@@@@@@@@AA@@@@AB@@@@@B@D@@@@@@A@@A@@@@@A@@@@@@A@@BA@@@A@@@@@@@@@A@@@B@@@@BA@@@@@@@BA@@B@@@@@@B@@ABAB@@@@@B@B@@@@B@@BB@@@@@@B@@@AA@@AA@@@@BB@@@@@@B@@@@@BA@@@@B@@B@@@@@@@BB@@@BAB@@B@A@@@@B@@@BB@@@A@@@@B@@@@@@@A@@@A@@A@B@@A@A@@A@@@A@@@BBB@@@AB@@@B@@@B@@@BB@@@BBAB@@@@B@@@BB@@@@A@@B@@@@@@@B@@A@@C@@A@@B@@@@@@@@@@@B@@AB@@@@@@@@@@@@@@@BA@@@A@@@@B@@@B@@@@@@@A@@A@BAA@@@@@@@@A@@B@@@@A@@AB@@@@@A@@A@@A@@@@B@@@AA@@@@@BAA@@@BA@@@@AA@@@BA@@BA@@@@AA@@@@A@@@A@@@@AA@@@A@@@@@AB@@@B@@AAB@@A@@BA@@@A@@A@@A@A@@@@@AA@@@@@B@@A@@@@@@@@@@@@A@@@@A@@BA@AB@@@@A@AA@@A@@ABB@@@@B@@AA@@@@@@@BA@@@@A@@@@@@B@@@@A@@AAA@@@@@A@@@@@AA@@@@@@A@@@@@@@AB@AC@@AA@@AA@AA@@@@@A@@AA@@@@@@@@B@AC@@@@AA@@@B@B@@A@A@AA@A@@A@@@AA@@@@@@A@@@AA@@B@@A@AA@@@@A@@@@@@BA@@A@@@@@AA@@A@@BA@@@A@@@@@A@@A@@@A@@@@@A@@@@@AAA@@@@@B@@BB@@@@A@AA@@A@@AA@@@@A@@@BA@@@@ACA@@@@@BA@@BA@@A@@@AA@@@@@A@@@@@@@BA@B@@@AB@@AA@@BA@AA@@@@@@@BB@@@@@@B@@A@@@@A@@@@A@@@A@@@@@@@@@@@@C@@@@A@@@@D@@@@@@@@@@@@@@B@@@@B@@@@A@A@@AA@@@@@A@@@@@A@@@@@C@@@@@@A@@AAA@@@A@@A@@@@@AA@A@@A@@AAABCA@@@@A@@@AA@@ABA@@@@B@@B@@@@@B@@A@B@@@@@B@@@@B@@@@B@@A@@@@@A@@@AA@@AA@@@@@@AD@@BB@@A@BB@@@@A@@BBB@@BA@@@@BB@@@@AB@@BBA@@@A@@@CB@@@@B@@@AB@@@@A@@B@@@@B@@@AB@@A@@BB@@@@B@@@@@AB@@BB@@@@BA@@@@B@B@@BA@@@BB@@@@BA@@@B@@BA@@@AA@@@@BBA@@@@@A@@@@@@@A@@@@@@@@@AB@@@B@@B@AB@@@@ABAB@B@@@@A@@@@@@@@B@@@@@BA@@@@@@D@B@BA@A@B@@A@@@A@A@A@@@AA@@@B@@@@A@@@@@@@A@@@@AB@@@@@@B@AB@@AA@@@B@B@@A@@@@B@@A@@@@BA@@A@@A@A@A@A@@@ACB@@@B@@A@A@A@@@A@@@AB@@A@@B@@@@@@A@@@AA@@@AA@A@@@@@@AA@@BD@BB@@@A@@BB@@@@@B@@BCA@@@@@@@@@@@@AE@AA@@@@@@B@@CE@@A@@@@BA@@@@AA@A@@@@@A@@@@@A@@BA@@@@A@@@A@A@@@A@@@@AAA@@@@@@@@AA@A@@@@BB@@@@@@@@@@@@@B@@B@@@@@@A@@A@@@@AB@@B@@BB@@@@@@@@@@@BA@@@@@B@@@@@@A@@B@@@@A@@A@@@BA@@B@A@@@@@A@@@@@@@@A@@A@@@@@@AB@@@@@@@@@@B@@@@B@@BB@@@@A@@A@@A@@B@@@BA@BB@@A@@@AD@@@@B@BA@@@@AD@@@@@B@@@B@@@@@B@@@B@@@B@@@B@@@B@@@@C@@A@@@@A@@A@@A@A@@@@B@@@@@B@@@B@@B@@@@B@@@@@@@@@B@@@@A@@@@@AA@@@@AB@@@@A@@BABAB@@ABAA@@@@A@@A@@@@@B@@A@@@@@A@@@A@@AA@@@A@ED@@@@@@@@B@@@@@@@B@@@@@A@@BB@@@@@BBB@@@@@@@@@A@@@@@@@ABB@@@@@BB@@A@@@@AA@@@@@@@@A@@@@AA@@@@AB@@@A@@@@@@@@@A@@@@A@@@@B@@@B@BB@@@@@BBB@DBB@@@B@B@B@BB@BB@@B@@BB@B@@@BA@@B@@@@AB@B@@@@@@@@@@@@@B@@BAB@@@BB@@BB@@@B@B@@@B@@CD@@@@@@@B@B@@@@A@@@@@B@@BABB@@@@@A@@@A@A@@@@@@@A@@@@AA@A@@@@@@BA@A@BBBB@@@B@@@B@B@BA@@@C@ABC@@B@@B@@@BDBB@@@@A@@@A@AB@@AA@@A@@@A@@@AA@@@@CA@@A@A@@@C@AAA@@@@@A@AA@@A@A@@@A@@@@AA@ACA@AAA@A@AB@@AA@@CDA@@@CE@AAE@@AA@@@AA@@@A@AAAA@AA@A@@AA@@@@@AC@@A@@@CA@@A@@AB@@@@@B@@A@@@@@@B@@@@@AA@@C@A@@@@BABA@@@@BA@A@@@AA@AA@@@@@AB@B@@@B@@ABAB@@@@@CA@@@@A@@@@@@A@ADA@A@@@AAA@EA@AA@A@@A@@AA@@@@A@@@@AA@@AA@A@@@CA@AA@ABA@@@E@@@ABA@@@ADAB@A@@@BAB@@B@@@@B@@@BABA@@@A@@@@@@@BD@@@@@A@@@@@B@@@@@@B@@@B@@@@@@BB@@B@@@@@@AB@@@B@@A@B@A@@@@@AA@@A@@B@B@@@B@B@@@B@@@@ABB@@@DB@BA@@@BBBB@D@@C@@@AA@@@@@BDBBB@@DDB@B@DBBBB@@AB@@@@B@B@D@@@@ABABAB@B@B@BAB@@@@B@B@@@@BB@@BB@@B@@@@@@BB@@@@BB@@DB@@@@AC@@D@BB@@@@@A@A@@BBA@@BBD@@A@@@@@AA@@ABAB@@@A@@A@@BA@@@B@@B@@@BA@AB@@B@@@@@B@@@@@@@@B@@AB@@A@A@AA@@ABA@@B@@BB@BBB@BBBB@@B@@ABAB@@@@@D@@@B@B@B@B@@@B@@ABAB@@@B@B@BA@A@@B@@@@@D@B@@@@@@@D@@@@@@@@ACCBA@AB@@@BB@AB@@@B@BA@@@@@@BAB@BB@@BBB@@DB@@@@B@@@BB@B@@DBBB@@B@@@BBBB@@BBB@@B@@BB@@@@AB@@B@B@B@@@@@B@AB@@ABB@@@@B@@@@@BB@@@@@@B@@A@@@A@@B@@@@@BC@@A@@ABA@ABA@@@A@CB@@@B@@AB@@A@ABA@AB@@@@A@A@@@C@A@@BA@C@A@@@AAA@@AA@@BAB@@@@A@@B@@A@AA@@AB@@AA@BAA@@A@@@@@AB@@@B@D@BB@@B@@@B@@@@@@@B@@@BB@@B@@@B@@@BE@@@@@@@B@BB@@@@@@@@AD@B@@ABA@@@A@AA@@A@@B@@@BA@@BB@B@@@B@B@B@@@@BB@@B@@A@AB@@@@BB@@@@A@@@@@A@A@@@@A@CA@@@AA@@@BAB@@AB@@A@@BA@@B@@A@A@@B@@A@A@@B@@@@ABA@@@@B@@@@C@@@A@@@BB@@B@@BA@@@@@A@@@@A@@ADA@A@A@A@@BA@@@BBA@@@@@A@@@ABA@@B@@AB@@@BABABA@A@@B@@AB@BB@@D@@B@@@@B@@@@AB@BABAB@@@BBH@BB@@BA@@B@@@@@BBABB@@@B@@@L@B@@AB@@BB@B@@@BA@@@A@@@AB@@@@A@@BBB@B@B@AB@@@B@B@D@DD@@AD@@@B@B@@@@BB@@@B@BAB@@@B@BBB@@BB@@BB@@@B@@@B@BB@B@D@B@@@BB@AB@FAB@@A@@@@B@@BB@BAB@@AB@D@BAB@BB@@DAB@@@B@@BBBB@@@@BB@@B@B@@DB@@BA@@BB@@@@BBBB@@@@B@@@B@@@BB@@@D@@@D@B@B@@@B@@@@@B@BBB@B@@@@@B@@BB@@@@@@@BAB@@B@@@@B@@@B@@@B@@@B@@@B@@@@@B@@@B@@@B@B@B@@@@@@@D@@A@@BA@@@AD@@@B@BBBA@A@@B@@A@@@@B@@@B@@AA@@ABA@@@BBBB@B@B@@A@@BAAA@@AAB@B@@A@@AAE@AAAAB@BA@ABABCB@@@BA@A@A@@B@@@@@B@@BBB@@@@@B@BB@@@@B@@@@@@BA@@@@BADCB@@@@@BCB@@@B@@A@C@AA@@@@@B@D@@@BBAB@@@@@@@@B@@@@@BB@AB@@@@AA@@A@@@AAA@B@@@@@AA@B@A@@@@A@@@A@@@BBBB@@@B@B@@AB@@AAC@@AABA@@@AA@@ABA@AB@@@BAB@@ABA@AB@@@B@@@B@B@B@B@B@@@B@@@B@B@BAB@@@AC@@@AA@A@A@@CA@@@AAC@A@AA@@@A@A@C@@@@B@DBB@B@B@@@BAB@@A@@@@@@A@@AC@@@A@BAB@@@BA@ABA@AA@@@@AB@BA@AA@@AB@@D@@BBBB@@@B@@@D@BB@@@@BA@@B@@B@@@D@@@B@@@@@B@@B@BBB@@@BBB@@BB@B@DBB@@@B@@@BB@@@@B@B@@@@@BA@AB@@ABAB@@BD@@@B@B@@@B@@@@B@@@B@B@@AB@@AB@@@B@@@B@@@B@BB@@@B@@@@@B@@@B@@@B@BBB@@BB@BBBB@@@@@AB@@AB@@ABABAB@@A@A@@@A@@@A@A@@@A@@AA@@@@AA@@@A@@BB@@@@B@@@@@BC@@@@B@DAB@B@B@B@BBB@BBBB@@D@B@B@B@@@@A@@BAB@@@@A@@B@B@B@BC@@@A@@DA@@@ABABA@@DA@@BCD@H@B@B@@@BA@@@@@@B@@@@ACACA@A@A@@A@@@@A@@B@@AB@@@@AB@B@B@@A@@B@B@@@@B@BBB@BABB@@@@@B@@B@@@BB@@BB@B@@A@@AA@A@@@ABA@@BC@@BA@@@B@@B@@@@@@@@@BA@@BBB@@@@B@@@B@@BB@B@@@B@BA@AB@@CDA@@BABBBBB@@@B@@B@@@B@@@@@B@@@AD@@BB@@BB@@D@BAD@@@B@D@DA@BBB@B@B@BB@@B@BA@@B@@B@@@@@BA@@BAB@D@B@FDB@BAD@@@@@BB@@@B@BCB@@ADA@A@@BAB@B@@@B@B@B@@A@@@@BABB@@@@@@@D@@AB@BADBD@D@@@@AB@B@B@B@BB@@@@BC@@B@B@@BB@B@B@B@B@@@B@D@BAD@@BB@D@@@@@BD@DAF@D@D@HABEBDDBBDDFDFDBFCDBHHDDBB@BBDBB@F@@B@B@B@B@B@@B@@B@BD@BD@D@BBBB@FDDBD@FBHBBDBDBBBDBBBBD@DAB@DAFBBBBBDDBDB@BBD@D@B@D@D@B@F@B@BBFAD@B@D@B@B@D@BA@@@@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@B@@@@@@@BB@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@@@@@@BB@@@@@@B@@@@@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@B@@@@@@@@@BB@@@@@@@@@@@@@@B@@@@A@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@B@@@@A@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@B@@@@@@@@A@@@@@@B@@@@@@A@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@A@@A@@@@@@@@@@A@@@@@@@@@AA@AAA@@@AAACC@@D@B@B@@@BBB@D@D@@@@B@DBF@@@@@@@B@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@B@@@@@@A@@B@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@B@@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@B@@@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@B@@@@@@@@@@@@@@BB@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@B@@@@@@B@@B@@@@@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@BB@@@@@@@@@@@@B@@@@@@@@B@@@@@@B@@@@@@@@@@@@@BA@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@BA@@@@@@@@@@@A@@B@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@A@@@@@@A@@@@@@@@@@@@A@@@@@@A@@A@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@A@@A@@@@@@A@@A@@@@@@@@@@@A@AA@A@ADCFGBCDE@@B@B@B@@A@@DADAB@@@BAB@@@D@@@B@@A@C@@@@B@B@@B@DAD@B@@@@@B@@@@@B@@B@@BB@D@D@BBB@@@@@@@@AB@@@B@@@@@AA@A@@B@@@B@@@BBBB@@@@B@BA@@B@@@BBBB@@B@@A@@B@B@@@@@@@BAB@@@BA@@@@@@B@@@BA@@@@BC@@BAB@@@B@B@BBB@B@@@DEB@@AB@B@D@@ADEBCBAFCBCBABEBABC@C@C@AB@@@@@B@FCB@@@BBDBB@B@B@@A@@@A@ABA@A@@BAFA@@B@FBB@@@D@DAB@@AB@@A@@@A@@B@@@BA@@@A@@@@CCAAAA@A@ACCCAAAECAAGGCECEAAGAA@A@ECAACCAA@A@E@CBEB@HAB@BBHBF@@BB@FBJD@@DDDBB@F@BBDBBBDBD@BBHDFBDBB@F@DAD@BBB@BDBB@@B@B@B@@@DCDC@@BBB@@@@@BAF@B@DABA@@@C@CDC@ABEBC@C@EBC@C@AFADEBABG@C@G@ABCDCBCBGBEAE@AB@@@D@BAB@BCB@@CBEBCBA@A@C@A@ABADCB@B@@A@@B@@AA@@@@@@ADA@@@A@@@@CACAAA@@AACAA@@@@A@@@A@@DABABA@@BA@A@@@@@A@C@@@@B@@B@@D@@@B@@@BABC@@FADAF@H@@@B@B@BABA@@B@@@FA@@B@DCDABADAF@DBB@B@B@FAF@F@@@@@H@@B@@B@@@@@B@@@@@B@B@@@BCB@@@B@F@@@@@B@@A@@@A@@B@@B@@B@@@B@BABA@@BA@@@A@A@@@AB@@@BA@AB@@@B@@A@@@@A@A@@@@A@@A@@@@A@@B@@A@@@@@@BC@@@C@@BA@@@@B@@@B@BA@ABA@@BABCB@B@BA@A@@@@@@@@@@A@@A@@AAA@@@@@A@@@@@@@AA@@@@DC@@@A@A@A@AB@@A@@A@AA@A@@@CB@@AB@BBB@@@B@@@@@@AA@@@@@@AB@@A@@AA@@@A@@@@@@B@@@@@@@@@B@@AA@@C@A@@@AA@@A@@BA@@@@B@@@@@@A@ABC@A@AAA@@@@BA@@B@@B@DB@@BB@@@@@B@@@@@@@AA@@A@@A@@BA@@B@B@BB@@@@@@B@@@@@@@@@@A@@@@@@BA@@@@@@@@@@@@DB@@@@@AB@@AA@@AA@A@@@A@@@@A@@@@B@@@@A@@@@A@@@@@BCA@@A@@@@A@A@CAA@@@@@A@@@@@DABA@@D@B@@A@@@@@@AA@@@A@C@A@@B@B@@@@@@AB@DA@@B@@AB@@B@@@@B@@A@@BA@@@@@@@AA@A@@@A@A@@@@@@@@@C@@@@@A@@@@@@AA@@AAB@@@A@@@@@@B@@@@@@A@@@@A@@A@@B@@@@@B@B@BB@@@@@@BA@@@A@@B@BA@@B@@A@A@@@@@A@@@@B@@@BA@@@@@A@@A@@@@@ABA@ABA@@@@@@@@@A@@A@@@@@@AA@@@@BA@@BABA@@B@BD@@BA@@@@@A@@@A@@@@@A@@@A@@@A@@@@B@@A@A@@@@AAA@@@AAA@@@@@CAC@A@@AA@@C@C@@@AA@A@@AB@BC@@AC@@B@@AB@B@DB@BB@F@DBB@@@BA@@DEBA@@@@@@B@@@BB@@B@DA@@@@B@@A@ADADADCB@@A@A@@A@EB@@@AA@@A@@@C@@@@FA@@BAAAAA@@@AD@B@DC@AB@FDD@B@FCB@@ABC@@AAC@@@@A@C@E@@BAB@@@@@@A@@AA@@@ABA@A@@ACAABC@A@C@@@AAACA@@@@@AD@@@B@@@@@D@AA@@AAA@AAA@AAA@C@AAA@@A@@B@BA@@@@A@@@AA@A@@B@@A@@@@@A@A@@@@BEDA@A@A@@@AB@B@@A@@@@@@@A@@@AB@@AB@@A@@BA@@B@B@@@BA@@@A@@@@AA@@A@@@@@AC@E@CA@@@AB@@@@A@@@@@@@A@@A@@B@@B@@@A@@@A@@@@@@@@@B@@A@@A@@@@@@@BA@@@@A@@@C@@AA@@A@@@@AB@@@B@A@@A@@@@@@@@A@@A@@@@AA@@@@@BA@@@@@@@A@@@BA@@@AB@@@@@A@@@@AABA@@@CAA@@@@@@BB@BB@B@@@@@BA@@@@@CACAAA@@@C@AB@@AB@B@@@@@EG@A@ABA@@@A@@A@@A@@BAB@D@@@DD@@@@B@@@BA@@@@AC@@@@@ABA@A@A@A@A@@@@BA@@B@@@@@@@@@@@@@@B@@B@@@@@BA@@@@@@@A@@@@@@@@A@@@A@@@@@@@@A@@AA@@@@A@A@AB@@A@ACA@A@@@@AB@BA@@@AAA@@@@@C@@@AB@BA@@@AAA@A@@@@@@IB@@A@@A@@AA@A@A@@@@A@@@@@@A@@@@@ABA@A@AB@B@BA@A@@AA@@@@@@BABAA@@AAA@A@@@@@ABA@@BA@@DAB@@@B@@AB@B@@A@A@@@AB@DA@@D@D@BA@@@@@AA@AAA@E@A@@@@@A@@ABA@A@CBABB@@@@@@@A@@B@@A@@BA@@@@@@B@@AB@BC@A@A@AA@@@@@@@AB@@@B@@@B@@AD@@@@@@A@@@@@@@@@BE@@@A@@B@@A@@@@@@A@@@AB@@@@@@@@@A@@BA@@BA@A@@@@@@@@A@@@@B@@AB@A@@@AB@@A@@@@B@@A@@@@B@@@@@@A@@A@A@@A@@@@@A@@@@@@B@@@BB@@@@@A@@@AA@A@AA@@AAA@AAA@AA@@@@@@@BA@@@CA@@@@@@@A@@@@A@@@A@@AA@@A@@@A@@A@AAA@ACA@AAA@A@@@@BC@@@A@@A"],encodeOffsets:[[121245,29196]]}},{type:"Feature",id:"361124",properties:{name:"铅山县",cp:[117.709659,28.315664],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@ADABC@E@AAEAEICAA@AAAA@@CBABA@@@@B@@A@ABC@A@C@AAA@@B@@@@@BBB@@@@@B@B@@ABA@@@@B@@@BBB@@@@@B@BD@B@BBBB@@@B@@@BB@BBBDB@BB@@@@B@@@@B@@B@@@@@B@BB@@@@B@@B@B@@@B@@@B@B@@@@BB@@@@@D@@@@@B@@A@@B@D@B@@BD@BBB@@AB@@AA@@AB@B@B@B@B@B@@@B@B@D@@B@@B@D@@@@B@@BDB@@B@@@@B@@@@@B@BBB@@@@@B@@BB@B@@@@@B@@@BADBBA@BB@@@BB@B@@@@@B@@@BBBB@@B@@BA@BB@@@@@@@@B@@B@@B@B@B@BB@@@DDBABBB@@@B@@@B@@@@BBB@@BB@@@B@@@B@@DBB@@@@ABB@@B@@@@@@B@AB@BAD@@AB@B@@@BAD@@@B@@BB@@@B@B@D@B@BA@@@@@A@@@@B@B@BA@@BABC@@@@@@B@B@@B@@B@BA@@@AB@@@@@BAB@@@B@D@B@@@B@@@@@B@BBB@@@B@@@@@B@@@@@D@B@@@BA@@BB@@@@@BB@@B@@@B@BB@AB@@BB@@@@@B@@@BBBBBB@@@@@@@B@@AD@@BB@@@D@@@DB@B@@B@B@BB@@@@BBB@@@B@@BB@BBBB@@@@@@BB@D@B@BBBB@@@BB@ABB@AB@@A@A@CBCBABA@F@@@@@B@BB@BB@@@@BBB@@BB@B@@@@@@B@BB@@@BB@@@@B@BA@A@@@CBA@C@A@@@@B@B@BB@@B@@@B@@AA@@A@A@@BA@AA@@A@AAA@@@@@A@B@@B@B@@@@@BA@@BABA@@BA@@B@BB@B@@A@@B@@@@@BBBA@@@@@BBB@@AB@BB@@BAB@@@@A@@BAB@BA@@@@B@D@@@B@@@BA@@BAH@@BBBD@@BB@@@BB@@B@B@@B@BBB@@@@@@@@@AB@@B@BB@@BA@@BABAB@@AB@DC@@B@DABAB@@@@@BBABB@@B@BABA@@B@B@@AB@B@B@BA@AB@BA@@@@@BB@@@BA@@@BB@@@@@B@@A@@@AA@@CAA@A@@@AB@@A@@@@BB@BB@BBBB@@B@@@@@B@@@B@@@@@@@B@@B@@@FBB@B@B@@@B@BBB@B@@@B@BAB@@A@@DB@@B@@BAB@@ABAB@@@B@@@@AB@@A@@BA@A@@B@@B@@B@@@B@B@@@B@@BB@@@@BD@@@BAB@@@@A@AB@@A@AAAA@A@@@@@BA@BB@@BB@@@BDBB@@@BAB@B@@AB@@@BA@AB@BA@@B@D@BAB@@B@@BA@@@@B@B@@@@@AB@B@B@@@@@@B@@ABB@@B@B@@@BB@B@@A@@@@@@@@@ABAB@BEDA@@@ABA@A@@@A@@@A@@@AB@B@@@BBBA@@@CB@B@B@B@B@D@BA@@B@@AB@B@@@BB@BBBBB@@BB@BBD@D@B@B@@@BBB@@BB@B@@BB@@B@@@B@@B@@B@@@@BBB@@@@BAB@@BB@@@B@B@@AB@D@B@BBB@@BB@BB@@@A@GFAA@@A@@@CBA@@@ABA@AB@@AB@BA@@@@@A@AB@@@B@@AB@@@@@BBB@@@@AB@@@@A@AB@@@@A@@@CAA@A@C@A@@@CB@@CB@@A@@@AA@@A@A@@AA@@@AB@@@BA@@B@@@@@BABAB@@ABA@@BA@@BABC@A@@@A@A@@@@@@@@BB@@B@@@BBB@@@B@@@BA@@@AB@@@@BBBBB@B@@@@BB@BB@@@@@B@B@@@@BB@@BB@@@B@B@B@B@@AB@@@@@B@@B@@B@@@B@@DAB@DBBDB@B@BB@@B@@@D@@BB@@@@B@@@@A@@B@@A@@@@B@@@@AA@@@D@@@B@@@@BB@@@@@@@@A@@@AAA@@@@B@@@B@@A@AB@@BB@B@@@@A@@AA@@@A@@@@BAA@@@@@@@@@AAAAA@@@AA@@@@BA@@AA@@B@@@@AAA@A@@@@D@@B@@@A@@@@@@B@@@B@@A@@@A@@B@@@@A@@AA@@@BA@@@@AA@A@@ABA@@B@@A@@B@BBB@@@@A@AAA@AAAA@@@@@@A@A@A@@BCB@@@B@@A@@@@@@@BD@@@@@B@@@B@@BB@B@@@B@@@B@@AB@@@@A@@@AB@@@@@B@@@@B@@@@@@@B@@@@@A@@B@@@B@@@B@@@B@@B@@@@@@@@@@@@B@@@@@A@@@B@@BA@A@@BB@@@BB@A@A@@@@@@B@@@@A@@@@@@@@@BB@BBB@@@@@@A@@B@@@@@B@@AA@@@B@@@@@@@B@@AAAB@@@@B@@@BB@@@@BB@@B@@@BB@B@@@@@B@@BB@@BB@@@@@@@A@@@A@@@@B@B@@@@A@@DABB@@B@@B@@@B@@AB@@BB@@@@B@@AB@@@@B@@DD@FA@@B@@@@@@@@B@@@@B@@B@@@@@@@@BA@@@@@B@@@@B@@@@@@@@BB@@@@A@@@B@@@@@@B@@@@@@AB@@B@@@@B@@B@@@B@@B@B@@@B@BBB@@B@@@B@@B@@B@BB@@@BB@@@@AB@@@BAB@@@B@@@@@@@@@B@@@BCBABAB@BAB@B@@@@@AB@B@@@@B@@@@@@@@B@B@@@@@@B@@@@@@A@A@@@@B@B@@@@@@@@A@@@@B@@@@A@@@@B@B@BB@@B@@@@A@@B@@@@@B@@@@@@@@@B@@A@A@@@AB@@@@AA@@@AAA@@@@AB@AA@@@A@@A@A@@A@@@@B@BBB@B@@@@A@A@@BA@@BAAAB@@ABC@@@A@@@A@@@@B@AAB@@@@B@@B@@@@@@A@@@@B@@@B@BA@@@@@A@A@AB@@A@@B@B@BAF@B@D@@@BA@@D@BB@@@@@B@@B@B@B@@B@B@@B@@AB@BA@@B@BBBB@B@BB@DDBB@BBBBF@BAF@B@B@B@B@B@@B@@AB@@@@B@@A@@@@BB@@B@@@B@ABADADAB@B@B@BADABADCDAFCDCFABAD@B@DAHCD@FADADCFADE@@@@DCDGB@FEFABABAB@@C@@B@D@@@H@DBB@DBB@@B@@BB@@AB@@A@@BA@@@A@@@BB@@A@@@@B@@@B@@@@@@BBAB@@A@@@ABB@@@@B@@@@@@@@@D@@@@@B@@@B@@A@@B@@@@@BD@B@@@@B@BA@A@@@@@ABAB@@@BA@@@@BA@@@@B@@@@@BA@@B@@B@@BA@@@@BAB@@@@@A@@AAA@A@AA@@@@@@A@@@@@@B@BA@BBA@@@ABB@A@@B@@BB@B@@@@@@B@@@@@@@@@@BBA@@B@B@@A@@@@DBB@B@BD@@@@@D@@BB@@@@@@BB@@@@@@@@B@B@@@@@@@@@B@@@B@B@@@D@@@BA@@B@@@A@@B@@@B@@B@@B@@@BB@@@B@@@@@@@B@@A@@@@B@@@B@@@BB@@B@AB@@@@@@BBB@@@@@@@B@@@F@B@@@B@@B@@@B@@@@B@B@B@BAB@B@@B@@B@@@B@@@@@BBBB@@@B@B@@@@@BD@@@@B@@B@@@B@@A@@@@@@@A@A@@@AB@BBB@B@@@D@@@@@@@B@@@@B@@@BBB@@@@@@@@@B@@@@@B@@B@BB@BB@@B@@BB@@@B@@@@@@A@@BB@B@@B@@ABAB@@@@B@B@@@@@@@@@@@@B@B@@@@@B@@@@@B@BB@@B@@@BA@@@A@@B@@@BA@@@@A@@@AA@@@A@@@@@@@A@@AAB@@B@@@AB@@@B@DAB@D@@BBB@B@B@@@BD@@@B@@@@@BB@A@@B@@@@A@A@@A@@@A@@AA@@@BAB@@@B@@A@AB@@@B@@@@@B@@@@@B@@@B@@@B@A@@@@B@@@B@A@A@@BD@@@@@DB@B@@A@@@@B@@BB@@BABA@B@@@@B@@@@@B@@@BB@@B@@BB@@@@BB@@@@@B@@@B@@@@@BB@A@@@A@@B@@B@@@B@@@@@B@@AB@@@@@@@B@@B@BB@@B@BBBA@@BBB@@@@@@B@@@@@B@@A@@@@@@@@@@@BBB@@@@@@B@@BB@@@B@@AB@BA@B@@BB@@@@B@@BB@@@BB@B@B@@B@@BB@@@A@@@@BA@@@@B@@B@@@@BA@@@@@@@BB@@@@@@@BA@@B@@@B@@BBB@@B@@B@@@ABA@@@@@BB@@@@@@@@@@BB@@A@A@A@@@ABC@@@@DA@@@@B@@BB@@@B@B@@@B@@@@@B@@@BBB@@@B@@BB@@@@@DB@@BB@@@@B@@@@@B@@@B@@@@@@@@B@@B@@BBA@@@AB@@A@@@AB@@@@@FB@BB@@B@@@BBB@A@@DA@@B@@BBA@@A@@A@@@@BA@@@@BA@@@A@A@@@A@@@@@A@@B@A@@A@A@@@A@@A@@AA@@AAB@@A@@@AA@A@@@A@A@AB@@@BA@@A@B@AA@@@@@@@@D@@A@@BA@@B@@@B@@AB@@@@A@A@AB@@@@@@A@@BA@@@@B@@@DB@@BB@@B@BB@ABABAB@@B@@@@@B@@@BB@BBB@@@@B@@@@@B@B@@@B@@@@B@@A@@@ABB@@@@@@@@@A@@@AAA@@@A@@@A@@B@B@B@D@B@B@@AB@@A@@DA@@@A@AAA@AA@@C@@AA@A@AB@@@@@@B@@@BBA@@@@@@@@@@@@@B@@@B@@@B@@@@@AB@@@@BB@@B@@BB@@@A@@@@@A@@@@@@AA@A@CB@@@@ABB@@@@@B@A@A@A@@A@@@@@@A@@AC@A@@@@BAB@@@BA@@A@AA@A@@@@BA@@DA@A@@BA@C@A@@@AA@@@@@A@@@@@@@A@AA@@@@@B@@@@AAA@A@A@@@@A@@BA@@@A@A@@@W@aJ@B@XULz@@@B@@B@@B@B@B@B@@@B@@@@B@@@BAB@@BB@@@BB@B@@AB@BA@BBBB@BB@@@@@B@@@@A@A@@@@@@DB@@B@@@@@B@BBBBB@@B@@BD@BBB@@B@B@@AB@@C@@@AAA@@@@DA@@DB@@@@@A@@@@A@A@A@@@A@@@BAB@@@BAAA@A@@@@@@A@@@@@AA@@@@@@@@@@AB@@@@AA@C@@@@@AD@B@@@BB@@@@@@@ABA@@B@B@@@@A@@BA@@B@@@@D@@B@@@B@@AB@@@@@BB@BB@@@@@@A@@@AB@@@B@@@@@@@@B@@BBBB@B@DBB@@@@@@@A@A@AB@@A@@B@@@@B@@B@@@@@BC@@@AB@@@@BBB@B@@@@D@@@B@@AB@@@@@@@@@B@BA@@BBDBB@B@@@@@@A@@@A@@@@BAB@@@B@@@BAB@@@@@@@AA@@@A@@B@D@B@@BB@@@BA@BB@BB@@DDB@B@B@DBB@B@@ABBB@HD@@BB@@B@@@B@@@@AB@@@@B@@B@B@@@@A@@@@@A@AAAA@@A@A@C@C@C@E@C@@@@B@B@@A@@@CBACE@@@A@@BA@@@@@@BD@@BB@B@@@B@F@B@D@@@@B@@@B@BA@A@@@C@C@A@@@ABA@@@AB@D@@@@@BB@BB@B@@@@@BA@@@A@ABAB@BA@@@@A@@@@@AB@@AB@@A@@A@@@A@@@C@ABABA@@@@@DA@@@@@B@BA@@@@@@@BABAB@@@@BB@@@@@@@@@ABA@@B@@@BA@@@ABADCBA@@B@@B@F@B@@B@@@@@BC@@@AAA@@@@BA@AA@ACA@@@B@B@@@BB@@B@@A@ABA@A@AA@@@A@A@@@@@A@@@@@@A@@B@B@@@@A@@@@AA@@A@@AB@BBD@B@@@BA@@@@@@AAA@@@AB@@AA@@@@@ABA@C@AB@@@B@@@B@BDB@@@@A@@AA@@CAAAA@@@@A@@@@B@B@@@B@@A@A@@AA@A@@BA@A@@@A@A@@@@A@@@@B@B@@@@A@@@@@@A@@@@A@@@@@@@@B@@A@@@@A@AA@@B@AAB@@@B@B@@@@@@B@@@BB@@@@@BA@@@A@A@CB@@@A@A@A@A@A@@@A@@A@@@@@AB@@@@A@@@A@@AB@@@@AA@@@ABAB@@@BBB@@@@B@@@@@@BB@@@@AB@@@@@B@@B@@@@AD@@AB@B@@@D@@@@B@@@@@@@A@@@ABA@CB@@A@AC@@AA@AAA@@B@@AA@@@@@A@@@@@A@@A@@@AB@@AIGUYAACCAA@@B@@AB@@@BC@ABADA@@@@@@A@@@@C@@A@@@@B@B@@AB@@A@@@A@@@BCBCB@@A@@B@@BB@BB@BBB@@@@@A@@@@B@@B@B@B@@B@@BB@@@BB@AB@@ABAB@@@AA@@@B@@@@@A@@@@@AA@CA@@@A@@@A@@BA@@@@@AB@@AB@@@@@BA@@@A@AAEAA@A@A@@@@BABAB@BAFAB@B@B@FADAB@BABABAFABAFAD@@AB@@@BA@A@AAA@@@A@AB@AA@A@@@@@AB@B@DAB@@AB@BA@@@A@A@@@AAA@@@@A@C@@@AA@@B@D@B@BAB@@ABABC@@BABABAB@DABABAFEBAB@BAB@@ABA@@@@@@@@B@@@@@@A@@@@A@@@@ACE@@@@A@@@@@@A@@@@@AAA@@@@@A@ABABABA@@BADAB@BA@@BA@CBA@@B@B@B@@@BBHB@BB@@B@@BD@@D@@@B@BD@@@BADBB@@@BB@@@@@B@@@@ABA@@@@B@D@D@@@HEFAB@HCB@@AD@DA@@B@FABAD@BB@@B@@@B@@@B@@AB@B@@BABBB@@D@@@B@@ADABA@@BC@ABA@@AA@@A@AAA@A@@AA@@A@AA@@AA@A@@@@ABA@@B@BA@@@@@A@AAA@@@ABABC@I@A@AAAACAG@@BCBA@A@@DA@ABA@@@@BB@B@@B@@@B@@@BA@@@A@AB@BC@@@@AAA@@A@@AA@A@AACAA@AC@@@@A@@@A@@@@DADA@@@@@A@@@@A@AB@@A@@A@@@A@C@@@AA@@A@@AB@@@@ACCEIICE@AAA@@BA@@DC@A@@@A@@@AA@@ACGAA@AB@BC@@@@@AA@@AB@@@@A@C@@@A@CAA@@B@BEBA@@ACACA@BA@ABC@A@A@A@@B@D@DBB@DBB@@@@@@A@@@A@@BA@A@@@@@AA@@ABC@A@A@ABABA@@@A@A@A@C@C@K@ABA@@D@@AB@@A@@DA@AB@@A@@@A@AHEBA@@@A@A@A@@BABABC@A@ABC@@@CAE@A@@BA@@BCBABABADC@@DAB@D@@AB@BA@@@A@AAA@A@ABAAACECCCCAC@CAGCCACCAEAACC@A@CACAGACACACBCBABADADCBC@GDCBEBE@EAA@CC@@CCAACCC@C@EACAC@AAAAAEAACCAACC@C@CC@@@C@CBEAECAAC@EBC@CBEAE@CCCAEACAE@CACCAACACBAD@BADCDCBEA@AAACECCCEECECEACCCACCEAE@EAEAEACAEAAAAAAA@@@CCCACICCC@@CA@CAECCCAEAA@A@CACAAE@EBCBGBAAEAEAECCAG@E@CBABCDABABEAC@@A@@@@A@@@@A@AC@@@AA@@A@@@@AACGCC@EAACCE@E@EEECCAEGCGAE@GACACAAAAEAAACAACCCCCAAE@C@CBABCBADEFCFEDEBCBABADAFADABCBCDADADADAB@@GACECC@@ECA@CBCBC@AAACCAA@A@A@ABCBCB@@ABABADADAFADADAD@BCBCBGBGDGFCDEBC@CBEBEB@@A@CBABC@IDEBABCDA@A@C@CA@@C@EBA@CD@BABA@A@ADAHBHBHADBFABCDADCB@@ABAD@DAF@BA@CDCBEBAB@@AD@B@D@B@@BDBDBD@BADAB@F@F@F@D@D@@ABA@@BBBBJ@@CDIDKGGM@@CBCBCDCDAHAFBDBB@DBFBBAD@BCBABADADCBCBCBABC@CAEBCBEBGBCBABABAB@D@D@DA@ABA@A@C@C@AAECECECCC@AAE@E@EAEACAEECCCECIE@@GEGCGGCCEI@AAA@@A@A@CBA@@@@B@BA@@BC@@@CBA@ABAB@B"],encodeOffsets:[[120284,28609]]}},{type:"Feature",id:"361125",properties:{name:"横峰县",cp:[117.596452,28.407117],childNum:1},geometry:{type:"Polygon",coordinates:["@@BB@@@BC@GD@@A@@@@@@B@@B@@B@@@@@B@@@B@@A@@@@B@@@@@BA@@B@@A@@AA@AB@@C@A@@@@D@@@@B@@@@B@@A@@@@@@@@B@@B@@@@@@@@@@B@@BB@@@B@@@B@B@@@@A@@@A@@B@@@@@@@@A@@@@@@@@@DB@@@@@BA@@B@@BB@B@@@@@AB@@@@B@@@@@@@B@B@@@@@@@@B@@B@@@BB@@@@B@B@B@D@@@BA@@@@BA@@BA@@@BBB@@@@@@@@DA@@@@A@@A@B@@BC@@B@@@@BAB@@@@B@@@BA@@@@@B@@@@B@@A@@@@@@@@BB@@@@B@B@B@@AB@@@@@B@@@BBB@@@@AD@@A@A@AAA@C@@AA@@AA@AACAA@AA@AA@AAA@@@A@A@AAAAA@C@A@@A@AAA@AA@@AAC@@A@A@A@A@A@ABA@@@A@@@@B@@@B@@@A@@A@@A@@@B@@@B@@@@@@@BAB@AAB@@@@@BA@@@A@@@AB@@C@A@@B@A@@@@@@@A@@A@@A@@@A@A@@@@GA@@@@@A@@C@A@@@@B@@@@@D@B@@BB@B@@@D@@@B@DABAB@@CDAB@@@BA@A@A@@@@@CDCDABCBCBABCF@B@@B@@BBBBB@B@BB@DB@B@@BB@@@B@@@BBB@@D@@@BB@@BBB@B@@B@@@BB@@B@@B@@B@@B@B@@BBBB@B@@@B@B@@@BA@@@@@@@AB@@@@@@@FAFA@@@BB@@BB@@@@@@B@@@B@@@B@@B@B@@B@@BB@@BBBBB@@BB@BB@@B@@BBBBBD@@@A@@@ABA@CA@@A@@@A@@B@B@@A@AB@AAB@@@@BB@@@@A@@@A@ABAAA@A@@@A@A@AAA@A@@@A@@B@@@BA@ABAB@B@BA@AB@B@@CD@B@@@@@@BB@@BB@@A@@BB@@@@@BB@@A@@B@@@@BB@@@@@@@@@B@@@@@@@D@B@@@@@BB@@BAD@@@B@@B@BD@@@BBB@@BD@@B@BB@@@@B@BBB@@B@@@BABA@CB@@@B@BAB@@A@AB@BA@A@AB@BA@@@@B@@@@@B@BBB@@@@@B@BB@@B@D@B@@@B@BBBBB@@@@BAB@@AB@BA@@B@@@B@BBB@@@@@B@B@@@BB@@BDBBB@@@B@@@@B@@B@@@@@BA@A@A@@B@@@@@BB@@BA@A@A@@@@@@B@B@@@BBB@@B@@@@@B@B@@@@B@D@@AB@D@@@BB@@@@@@@@BBB@@@B@@@ABBBAD@@@@B@@B@@@@B@@BBB@@@BBBB@A@BB@@A@@B@A@@@@@AAB@@@@@B@DBB@@@B@@B@B@@B@B@@@@DBBB@BD@B@B@BB@@BBB@BBB@B@@@@@@@D@B@B@DB@@BBDB@B@@B@@@B@@B@@B@@@BABA@@BAB@@@@BB@B@D@@@@@BB@BB@BBB@BB@B@@BB@@@@BBDB@@B@@@BB@@@BB@BB@@B@@@@B@@BB@@@@@BBB@B@@A@AB@B@@@B@BBB@@@BB@@@@B@@@BA@@@@B@@AB@BAD@@ADA@@@@B@@@B@@BDBBBBB@@B@@@@ABA@@@AB@@@@BB@@B@BAB@@BB@B@BD@@B@@@B@@AB@BB@@@@D@@@@@@BBB@B@@@B@@@@BB@@@@BB@BBB@B@@B@@BBB@@@B@BBBB@BB@@@@B@BAB@@BB@@@B@@@@B@@D@BBBBA@@B@@AB@BAB@@@BAD@@BB@@AB@@@@A@BB@@DBBBD@@@C@@@@BA@@@A@A@A@@B@B@@@B@D@BBBBB@B@B@@BBBBB@@BB@@@@B@@@BE@A@A@@BA@@@C@@@@BBB@B@B@@A@ABADAB@B@B@BA@@@@@A@AAA@@@A@A@ADA@ABADA@C@@BCBAB@@@BAB@@ABA@AB@@C@@@AB@@@@A@A@@@@@@AA@@@@BABABA@AB@B@@@AA@@BA@CB@@ABB@BBBB@@B@AB@B@B@@@B@@@@AB@@@@@B@D@@@BBDB@B@@@@B@BB@@B@B@BB@@BB@@BB@BBB@@@BB@B@@@@BB@B@@@B@@@D@@@D@@@B@@@B@@@B@@@B@B@@@BBAD@@@@@@@@BBD@BBBB@@DB@@@@@@@@B@BA@AB@@A@@@@B@@A@@@A@AD@B@@@BBB@@@@BB@@B@@@@BB@DDD@B@@@B@@@B@@@@BA@@@A@@B@@AB@B@B@@@@D@@@B@DB@@B@@BB@@B@B@@@@DBBBD@B@@@@@B@B@@@BBB@B@@BD@B@@@@AB@@CB@@A@@@A@AB@@AD@@A@@@@AA@@@A@ABA@A@@BA@@B@@@B@BB@AB@B@@@B@BA@@D@@A@ABAB@BA@@BA@@F@BA@@@@BBB@BB@@B@BA@@@AAA@@@A@@@A@@@@AAA@@@B@@@B@B@@@ACBA@@B@@AB@@@@BBB@@@@A@@B@@@@BBB@@@BA@@B@@@@A@@BAB@@@@@B@@A@A@@@A@C@@B@D@BABBBAD@FCDC@@@A@@BC@@@AB@BAB@@@@ABA@@@@B@CAAAE@A@A@@A@@BA@@@A@@FABAB@@@B@DBBB@BF@@@B@B@BAB@@@B@B@@AD@@@B@@AD@@@@AB@B@@@DBBBB@@BB@@BB@@BB@B@@@@@@DB@@B@D@FAD@B@@BDB@@B@B@@@@@B@@@B@B@@BAD@BAB@BA@ABA@@B@@@@AB@@@B@BAB@@A@@B@BBB@@@AB@B@BA@@BAB@@B@@@@@@B@@@@@@B@@@@@B@B@@B@@AD@BA@CB@@@@DDB@HF@@@AB@@@@ABA@@@A@@@A@@@A@@AA@@@A@A@@@A@@@@AAB@@A@@BA@@@A@@@@AA@@CA@A@@AA@A@A@C@A@@@A@@@A@@@A@@@A@@@ABA@@A@@A@A@@@AB@BA@@@@AA@@BA@@BA@@A@A@C@AB@@A@@BC@AA@@A@@AA@@@A@@@AA@@AA@@@AB@@B@@B@@@B@@@@@@C@@DBB@B@@AB@@@@AB@B@@ABA@@@@@A@@@@@@A@@@@AB@D@@@@A@@@CAA@A@A@A@@@@@@BC@@@C@@@@AACAA@@A@@@A@A@A@@B@@A@@@A@@@@A@@AA@C@AA@@@@D@@@@A@A@@B@@AB@@AB@@@BA@@@AB@@@B@@A@@@@@A@@@@@CBAB@@@B@B@FBB@DBFBB@B@B@B@@@BAB@@A@@AAAA@@@@@@@@B@BD@@B@@@@ABA@@@C@@AC@A@@C@@@A@@A@ABBB@@@BA@@A@@@@@AA@A@@AC@@BA@@AAA@@@A@AA@@A@A@@AA@A@@@@@B@@@@AA@A@@@@@A@@@A@@BAB@@@@@@A@@@@@@@A@@AB@@A@@AA@@B@@@@@AA@@@@@@@@@@@A@@@@@@@@BAB@A@@@AA@@A@@A@@@A@@@@A@@@@A@@@A@@AA@@@AA@@@CB@@A@@@@A@@@@@AB@@BA@@@@@B@@@B@@@B@@A@@A@@@@AAA@AA@@@AA@@@@A@@AA@@A@@CD@@A@BA@A@@@@@@@A@@A@@B@@AAA@@BB@A@@@AACAA@@@@@A@@AAAA@A@@@@@@@@A@A@@B@DABA@@@@@A@@B@@A@@@@@ACA@@@@@@@AB@BA@@@AB@@A@@A@BA@@@@AA@@BABA@@@@BA@A@@@@@A@@@@B@B@@@@@@AB@@@@A@@B@@@@@@@B@BA@@@A@@B@@AAA@@@AB@B@@@BC@@A@@@@@AA@@@@@@@A@@@AA@@@@@@A@CA@@A@@@@AA@@@@BA@@CA@@A@@@A@@A@@@@@A@@A@@@AAA@@@A@@@AAA@@AA@@AB@@A@@@@B@@@@@@@@AA@@A@@@@@@@A@@@@BA@@B@B@@@DABA@@BAB@BAB@B@@@@A@@BCBA@@BA@@@@AC@AEC@@AA@A@@@@BAB@@@@@B@B@B@B@@@B@BAB@@@B@BAB@B@@@D@@@@A@A@@@@AAA@AA@@AA@A@EBA@A@CA@@A@@@@@AA@@@@@@@AA@@A@AA@@A@A@CA@@A@@@AACA@@AAAAA@AAA@@A@AA@AAAA@@A@AAAA@@@A@@CA@A@AAC@AAE@AA@@@@AA@@A@@@@AA@A@@B@@@@A@A@@@@BAB@BBBB@ABA@@F@B@BBBBB@B@DBBBB@@@BB@@FBB@BA@@DBD@B@@@BABABA@@BA@@@A@@AAA@A@@A@AB@@@AAAC@@@ABA@@@@@AAAA@@A@@@AB@@@BB@B@@B@B@BB@@BABA@AB@B@B@@AA@AA@@@AA@@AA@@@A@@@@A@@@A@@@@@@@@A@@@@A@@BA@@@A@@B@@@@A@@AA@@@A@@@@@AA@AA@@@A@@@C@AB@@BBB@@BB@@B@@A@AB@@BB@B@BAB@@@B@@@AAA@@AB@@AB@@@B@@@@@@A@@@@@@@B@@@BAB@@@@@@B@@CB@@@@A@@B@@A@@@@BAB@@A@ABC@@@@DDB@@@BBB@BA@@B@BB@@DBDA@BB@D@@@B@@@@B@B@@B@BBB@@BB@@B@@@B@@D@B@BDBBB@@BB@@@@BB@@B@@B@@AB@BADA@ABA@@@@@@A@ABA@AB@AA@@@@A@@@A@BB@@A@ABABABABA@@@@AAA@@A@@B@@@B@@@@@@@@@@A@C@@@@@AA@@AB@@@BA@A@C@A@@@@@AB@@@AA@@CAC@@@A@@@A@A@A@@@@BD@@@@@A@@B@@B@@@A@A@@BA@AA@@A@@A@A@@@A@@A@AACAAAA@C@A@A@AAA@@AAB@@A@@@AB@@@AA@@AAAA@@A@@@@@AB@BA@@B@BA@@@@AA@@@B@@@A@@C@@@@@@A@@B@@@@@@@AACA@@@@A@A@@@@@@C@@BA@@AABA@@AA@@B@@@@@@@AA@@B@@AA@A@BA@@@@@A@@AB@BA@@@AA@@@@@@B@@@B@@@@@AA@@A@A@@A@B@@@BA@@@BC@@@@@@@A@@@@A@A@@@@@@@@A@@@A@@@A@@A@A@@A@@@@@A@@@E@@@@@A@@BB@@@A@@@@A@@@@A@@@A@@@@@A@@B@AA@@@@@@@@@@B@@A@@@AA@@@A@@@@B@B@@@@@A@@@A@@AA@@@ABB@@B@@@@AB@@A@@@A@A@@@A@@@@@ABA@@@@@A@@@@@A@A@A@A@@@AA@@B@@@@AA@@B@@@@@@A@@A@@@A@@@BC@@A@@@@@@@@@@@A@@@@@ABAAA@@@@A@@@A@@@@@A@@@@@@A@@@@@@AAA@@BAA@@@@A@@@AB@@@@A@@@A@A@@@@@A@@A@AAA@@A@@@A@@@@@AA@ABAB@BAAA@@@AA@@CA@@A@@@@@AB@@@B@@@@A@@B@B@B@@A@@BA@@@A@@@AB@@@BC@@@@@@@@@BBA@B@@@AB@@A@@B@B@B@@@BBB@@B@@@BA@BB@@BB@@@@B@@@B@BB@A@@@@B@@@@@B@@@B@B@@AB@@@@AB@@@@@BB@@@AB@AA@@@CB@@@BAA@A@@@AA@@AEA@@@@A@@B@@@BA@@B@@AB@AA@@@@A@@@@@@A@@@A@@@@@A@@@@@AA@@CA@@@@A@@AA@@@A@AA@@A@@@@@A@@@A@A@@@A@@AA@@@@@CB@@@@AD@B@@@B@B@BA@@A@@@@@@@@A@@A@@@@AB@B@@@AA@@@AA@AA@@@A@@@AB@@@@@@A@@A@@@@@@AB@@@@@AA@@@@@AB@@@@@B@@AA@@@AA@A@A@@A@@AA@@@A@@A@@AA@B@@ABA@@@A@@AA@@@A@@@@A@AA@@@@@@@@@@B@@@@A@@@@@A@@@@A@AA@@ABAAA@@@AAA@@@@A@@@@@@BA@@@A@@@@@A@@@AA@@@@B@@@BAA@@@@A@@@A@@@@@A@@A@@A@@AA@@@AA@@A@@@@@A@@@@@@AABAB@@AA@@@A@@B@@@@ACA@@@@C@@AB@B@A@@@A@@@@@@B@A@@@A@@@A@@@@@A@@@@@A@@BAB@@@@A@@BA@A@@BB@@@B@@@BB@B@@@@@@AB@A@@A@@@@@A@@AC@@A@A@A@AA@@@CBA@C@A@@BA@@A@@@BA@BB@@@@@@@B@@@B@@B@@@B@@B@@A@@@AB@@@B@@A@@@AA@@A@A@@@@@A@@@@@A@A@@@@@@@@@@A@A@@@@@BABA@@@AA@A@@AB@@@@@@@@A@@AA@@@AA@@AAAA@@@@A@@@@@A@@@@@@@@AA@A@@@A@@A@@@@@@@C@@@A@A@AAA@@B@@@B@B@@@@@@@BA@@@A@@@@A@@C@@A@@@@@A@A@@AAAA@@@@A@@@A@@@@AA@A@ABA@A@A@@@@@@A@@@AA@@@A@E@@@A@@@@@@@A@AA@@@@@@BAA@@@AA@@A@@@A@@@@@@BA@@@@@@@A@@@A@@A@@@AA@@@@A@@@AB@@@A@@@AB@@C@@@A@A@@@A@@@@@@@@@A@A@@@@@@@@@AA@@@@@@AA@@@C@@@@ACA@A@CA@@@@@BA@A@@@AB@A@@@@@@@@A@@@@@@@@AAA@@@AB@A@BA@@B@AAB@@A@A@@@@B@@@@@@@BBB@B@BB@@@B@@@@BA@A@@B@@AA@@@@AB@@A@@@@@A@@B@@A@@B@@A@@BABA@@@@B@B@@A@A@@A@C@@A@@@@@AB@@@@A@@@A@@@@@C@@@@@@@@@A@@A@BA@@B@@@BAAA@@@@@@@A@@@A@@B@@@AA@@B@@@B@@AB@@@BA@@AA@@@AA@CAA@CAG@@@C@A@@@@DA@ABABEBEFA@CHCD@@@@CFEBCDCBEBC@GDCBA@C@ABEBCDEDCBCDABCBABA@A@A@CBCBAB@B@@@D@B@B@@@BB@DB@@BB@@HD@@B@B@D@@@B@BA@AD@B@B@BB@@B@BA@@B@@A@@BBBA@@BBB@@@BD@B@@@@@@B@@@B@BABBBBB@@B@@@@AB@@@BBB@B@FB@@@BBB@@@B@@@@BAB@@@BDB@B@@@B@@AB@@BB@@A@@@A@@@@@@AAA@@@@@@@AA@@@AB@@@@@AB@B@@AB@@AA@ABA@A@@@@B@@@@@BA@@@@@@@@@@@@A@A@@@@A@@@@BA@@@A@BB@@@@B@@B@@@B@B@@@@@BB@@@@@@@A@@B@@@@@B@B@@@BB@BBD@BB@B@@B@BBB@@@BB@@AD@@@B@@@@@B@@@@@DA@@B@@A@@@AD@B@@BB@B"],encodeOffsets:[[120396,29057]]}},{type:"Feature",id:"361127",properties:{name:"余干县",cp:[116.695646,28.702302],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@FEBCBADABCBABABEBA@ABA@@AAAC@@@AAA@ABA@@@AAE@A@C@AA@@A@AAC@AAAC@E@EAA@AB@AC@AACAAAA@A@E@@@C@A@A@CAEBA@A@C@CBA@AB@@ABA@EB@BA@A@AAAAA@@AAACAAAAAAACCA@A@@@AA@@@@@@A@@A@@@@@A@@@@AB@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@A@@@@@@B@@@@@@AA@@A@@AA@@AA@@@@@A@@@@B@@AB@@@@@@@@@@A@@A@A@@@@@A@@A@@@@@@@A@A@A@@A@CB@@A@@@@@@@ABBBA@AB@@B@C@@@A@C@@AAA@@AAA@@@@@@A@@@@@A@@@A@AAA@@@A@@AC@@AB@@AD@B@@A@@@@A@CBEAAAA@@A@@@AB@AA@@@AB@B@@@B@@@BAB@@A@@B@BABAD@@@B@@@BA@@B@@@@@B@@@@@D@@AB@@B@@BAB@B@@@@AB@B@@ABA@@B@@A@CA@@@BA@@A@@A@@A@@A@@@@@A@@@@@@B@@@@A@@@A@@@A@@@@@A@C@@BA@A@A@ABA@@@@@AB@@@B@BA@@BCD@@@BAD@B@@@BA@@BAB@@@B@@@@@B@BB@@@@B@@@BA@@@@BA@@@AB@@AD@B@@@B@@@@AB@@CD@@BB@B@B@@A@@BAB@@A@@@A@@B@B@@AB@@@B@@@@A@A@@@AB@BAB@B@@A@C@@@@@A@@BA@@A@AA@@A@@@@A@@@AA@@@A@@ABA@@@@AA@@A@@@@AA@@C@@@AA@A@AA@@@AA@AA@@ABCAC@@@A@@@@@@A@AB@@@A@A@@@A@@@A@A@@AA@@@AAA@@@@@@@@@@A@A@@@@@AA@A@@@A@@A@AAA@@@@BA@@@@@A@@@@@A@@@A@@@@A@@A@A@A@@@@BA@A@C@@@@@@B@@AAA@@@AAAAAAAAAAAB@@A@@@@@A@@B@@A@@@AA@@ABA@A@A@@@AAABA@@@@@@BA@@@ABAB@@ABAAA@A@@@@@A@CA@@A@AA@@AAA@AB@@@A@@ACEC@@A@@@@@A@AA@@A@@@@@@@ADA@A@A@@@C@@@@C@@AA@@A@@@AA@@A@@@@@A@@@AB@@AA@@AB@@@@A@@AA@AF@B@@ABCB@@AB@B@@ABA@A@AB@@AB@@@B@BA@@BA@@@A@@@AA@@A@A@@@A@CDABA@CAA@AA@@AA@@@@AB@@ABAAA@CB@@A@@BA@@@A@AB@@@@AB@@CD@@AACAA@C@A@@AA@AAAACAAA@@AA@@AAAAA@AAAB@@@@A@A@@@A@@AA@@AAA@@@A@@A@@@AB@@ABA@@@@AAA@@A@@A@B@@BB@B@B@@@B@@@BA@@B@@A@@@A@@A@@@A@@AB@@B@ABAB@@A@@AA@@@@@BB@@@BCB@B@BA@@B@@BB@@@B@B@@@BA@@@@D@@@B@BA@@B@@@B@@B@@@@BB@@A@@@A@@B@@@@F@BBB@@@@B@BA@@BBB@@BB@@@@BA@@@@B@@@@@B@@@B@@@@@BB@@@BA@B@@B@B@@@@@B@@BB@@ABA@@B@@@@B@BB@BB@@BBB@@@B@AB@D@@A@@B@@DB@BB@@@BBBAB@B@BAB@@@B@B@DA@@B@@@@BABCB@BA@@@A@@B@@@@ABAB@@@@A@@D@@AB@@A@@@A@@@@BA@ABA@C@@@@B@BBB@BA@@DB@@BBB@@@@B@B@B@BB@@@@@B@BA@C@@BA@@B@F@B@@BBBB@@BB@@@D@D@@AD@B@B@@@BABBB@B@BBF@BBBA@AB@BA@@B@@A@B@@BB@@@A@@B@@AB@B@@A@@@AB@@@BAB@B@@AB@@AD@@@D@BABAF@BA@ABABCBOD@@AB@@@@A@@@@A@@AA@@A@AAA@A@A@@@@B@@@B@@@@A@AB@@B@DDBBB@A@B@@@@@@@BB@@BB@@AB@@@@E@ABA@@@@B@@A@@A@@@A@@CB@@@B@B@@@B@B@@CA@@@B@@@@@BDB@@@@@BA@ABA@@BB@@@B@@@AD@@A@EB@@AB@@AA@@AC@@A@@@@@@F@@@BA@EFCDABGHGFGHEDADAB@BA@ABAB@@AB@@@@BB@@@B@@@B@@ABAD@@@@CB@BCB@@AB@BA@AB@@A@@@A@AA@@AAAA@@@@@@A@@B@@@DAB@F@B@B@BGDCDEDGDABIBEDC@@@@BAB@@AB@B@@AD@BCD@B@BABAHA@AF@@A@@B@@AB@B@F@HABELAB@F@@@D@@@@@BABCHAFADAB@@AD@@@@C@CDEBABEFADCDAB@@@B@B@D@D@B@@@BB@BDDDBDBFBB@@@BADAB@D@D@B@B@B@BAB@@@BA@A@@@AAA@AB@@A@@B@BAB@BBBBDBFBB@@BB@BAB@B@B@@@@@BAB@@@B@@@BB@@BBDB@@DBF@@@B@D@BAD@BABAD@@A@CD@BABA@EHABCDABABABA@IBGDEBIBCBEBC@EBC@CBCBMDCB@@AB@BADADABCFCB@@CF@B@@BDBB@DBB@@@@CB@@A@@@A@@@A@@A@A@@BA@@@@@A@@A@@@ABA@A@@@A@@@@BAB@BA@@B@BBD@@@BAB@@@@AA@@@ABA@A@A@A@@A@@AB@BA@CBA@AA@@AAA@@A@AA@@C@@@A@@A@@AAA@@A@@@@@@CB@@ABA@AB@BA@@D@D@LC@EBA@AAEAGAGAICAAA@CCGEGC@B@B@@@F@B@B@B@L@BAFAB@BAD@BADCFCB@BKDCD@@AF@@@BADBB@BA@A@@BGFA@CBCB@@A@AA@@A@E@A@C@A@KCA@@B@@@B@@@BAD@DAB@DBFABAF@FAA@@CC@AA@CAI@GAE@@@GBABA@ABCBCBA@C@@@@@A@CB@@@@AB@F@FAB@D@DBBB@@BB@@B@BBB@BB@AB@BBBBB@B@BB@@BB@@B@BADADAH@B@@ADABEB@BCBEBCDCBABABAB@B@B@B@B@@BDBBBBB@@BHBBDFDBBBBBBB@@@FD@BBBB@@@BCHEBABA@@@@FBB@@BB@B@V@FBB@B@FAB@@AHAD@@@@B@@D@DBHBB@B@B@DCB@BC@@B@HHB@B@B@@@DAB@B@D@B@B@DAB@@BB@@D@@BBB@@BBBDDFDB@@@B@DBB@DBB@DAB@B@@AB@BBDBBB@B@B@B@B@@@@AB@@AB@@@D@@@@BB@@@@@@@@BB@@@@A@@@BB@@BBBD@@@B@BDDDB@B@@@@@@BB@@B@BB@@B@B@B@B@AJBH@BBB@DB@BFBBDJBBDFBDFJDDDFBBHHDBHDD@FBNFFBFDFP@FBHADBFAF@HBF@D@DBFAH@B@BAB@B@BBD@@BDAB@FABEH@BAF@B@BBDBB@BFH@BDF@B@B@D@BCF@B@BADCF@DCFCD@@@FB@F@FAB@BAFCFCDCDADCNGDABABABCHIDCFE@ADABAFEDCDCBCBA@ADC@@@ABADADADA@AFOBABA@@D@B@B@@@@@B@@BFDDBBBD@FFBBBBJABA@@@A@A@@ACAA@@@E@C@A@A@A@C@E@C@C@C@C@C@CBC@C@A@A@@BA@CBC@ABCBABC@@BA@ADC@ABADO@@DC@@B@A@@@CB@@A@@ACCCIAAACAAACGEACAA@A@AB@@AJGB@B@FADAB@B@D@FDB@LDFBB@B@B@FADABAB@@@B@DAHCHAJCB@HADAJCD@TGHAD@B@@@@@HBFBBBBBBBHFDFB@DBBBB@B@D@BAD@BAHCFCB@BABAB@BAJADAB@D@@@JJB@BDB@B@D@B@B@@@DABABAD@BABABA@C@@@A@A@@@@DA@@B@@@BA@A@@B@@AB@B@D@@@BBFDB@@@@@DAHABABABABCFABA@@BA@ADAJEBABC@@@A@@BAFC@@BC@@BA@@@@BBAB@B@@@@BD@@BB@@B@B@@A@@@A@@B@BAF@@@BEBC@@B@@@B@BBB@BBBBB@BBD@B@D@JAFABAD@@ABA@@@ABAAA@@@@@AB@D@DAB@BAB@@@BAD@@A@@@A@C@A@AAA@@AE@C@@@A@@@@B@B@BABAFG@@@A@AA@CC@A@AACAA@@@AA@CAA@@A@@@AB@BA@@B@BABCBC@A@@DA@ABA@A@C@@@A@@AA@@@A@@B@B@B@B@B@B@F@F@B@@@B@B@B@BADABCB@BC@@@@BABE@ABA@A@CAC@C@ACM@A@@@ABA@@DA@@BABA@AB@BBB@BAB@@@DBFB@@@@F@BA@@@@@A@A@@B@@@@@BADABA@@BB@@B@@@@@B@@@BCB@@@@@B@@@B@@@BAB@@A@@BA@@BAB@BADCB@@@@@B@@B@A@@B@@@B@@@@A@@@@@A@@@@AA@@BA@@@AD@D@B@F@BAB@B@@ABC@@D@@@@AA@AA@@BC@@@@@ACA@@@@@ABA@GBA@A@@@@B@@B@@B@A@B@@@@@@@@@B@@@BA@@@BD@@@B@B@BAB@BB@@B@@BB@BA@@B@@@@@BBB@@@B@BABAFC@@B@@@BABA@@@B@@B@@A@@B@@@@@@B@@@D@@@@@@B@@@@ABA@@D@@@@@@@@BB@@@BA@@@BA@@@BBB@@@@B@@BBBA@B@@@@@@@@B@@A@@BA@A@A@@A@@@@A@@BC@A@@@@@@BA@@@@@@@B@@@BAB@@AB@@B@@@BA@CBA@@@@@A@A@ADa@@BA@@@A@@@@@AACBG@@A@@@@@@A@@@A@@A@@@@BA@A@A@@AA@C@A@@@@BAB@@@BAB@@@@@B@@A@@B@BA@AAEAA@@@@@A@@CA@@@@A@@@@@A@A@@@A@@A@@@@B@@A@@@@@@AEA@@@@@@B@@@B@@A@@BB@@@@@@@AAA@@B@@@@A@@A@@@@@A@@@@@@@A@@@BA@@@@B@B@@@B@@A@A@@@@AA@@@@BA@A@@@@@BB@@ADA@@B@@@@A@@@@@@@A@@@AB@B@@@@A@@@A@@AAB@@@B@@ABA@ABA@AB@BA@@@@B@@@@@AAA@ABA@@@@@A@@@BAB@DC@A@@@@@A@@@@@@@@AB@@@@A@@@BA@ABA@@B@@AA@@@@AB@B@@A@@@@@@A@A@@@@ABAB@@@@@@@@@A@@A@@@@A@@@B@AA@@A@@A@@BA@C@ABA@@@A@@@@AB@@A@@AB@@@@@@@@@A@@@@@@@@A@A@@@@@AB@@@@@@AA@@@@@AB@@@@@@@@@A@@@A@@A@A@@@@@AA@@@@@@@A@AB@@@B@@C@@@@A@@@@A@@@@@@A@A@@AAA@@BAB@@@A@B@@@@@BA@@@@A@@@A@AAAB@@@A@@A@@B@B@@A@@@@AB@@A@@C@@@AB@@@@@@@A@@A@@BAAAB@BA@@A@@@@@A@A@@@AB@@C@@A@AC@@@AA@AA@@@AB@@@B@@@@@@@@@@AA@@A@ABABC@@BAB@@AB@@A@@B@@@BAB@A@BAB@@@@ABB@@@AB@@@D@@AB@B@@@BABB@@B@@B@@@@@@BB@@B@@@@A@@@@@@B@@@@AA@@@A@@A@@@AB@@B@@@@B@@@@@@@@B@@@B@@BA@@@A@@@@@A@@@BB@@@@@@@B@BD@@B@@A@@@@B@@@A@@@@AD@@@@@AA@@B@@@@B@@@@@AB@@@@@B@@@@A@@BA@@B@B@@A@A@@B@@@BA@@BAB@@A@@AA@@B@@@@A@@@AD@@A@A@@@A@@A@@@@@A@@@@A@@B@@@B@B@BAB@@@@A@@@A@@@@@@A@@A@@@A@@@@BAA@BA@@@@B@@@BA@@@@BB@@@@A@@BB@@BB@@@@@B@@@@@B@@@@@B@@@B@@@BA@@@A@@B@@@@AB@@ADA@@@ABA@@@@@@B@@@@@AA@@@A@@@AA@@A@@BA@@AA@@DA@AB@BCB@@@B@BA@@@@@AABA@A@@@A@@@A@@AA@@@@@AC@@@ABA@@B@BA@@@@@@@@A@@@@@@AA@@AAA@@@@@@@AB@@A@A@@@@@A@@@A@@AA@@B@@AB@@@@A@@@@AB@@@@@@@A@@A@@@BA@@@@AC@@BA@@@@@A@@@A@@@@@@@@A@BA@@@A@@@B@B@@@@BA@@@A@@@@BA@@B@@A@@@@A@@BC@@B@@@@@A@@@AB@@@BABABA@A@A@@@ABAA@@@@@@A@@@@B@AB@@B@@@@@@A@A@@B@@@@A@@@AB@@@@@@A@@B@@@BCBABA@A@ABABA@@@@AA@A@@BA@@@ABABA@@@A@@AB@@@@@A@@@@@@@BAB@@A@@@BA@A@@@A@ADA@@@A@@@@@ABADABAB@@@@@ABA@AB@BAB@@@@@@@AB@@AB@@@@@@B@BBB@@@A@@@ADA@@@@BC@@@A@AB@@A@@@@@@@A@A@@@@B@B@@@@@@AA@@A@AA@@B@A@@AA@AB@@@@@@AB@BA@@B@@A@A@@@@BB@@@AAABA@@A@AA@@BA@@@ABA@@A@@@A@@@BA@@@A@AB@AA@@C@A@A@AAC@C@A@AA@@@@@@A@@@A@@A@@@@A@@@@BA@A@@BGB@@A@@@AA@@@@A@A@A@@@A@@@AA@@@C@@@A@A@A@@AC@@@A@@BA@@@@B@@AB@DEBCBA@@@A@A@AB@@ABA@@AA@AB@BA@CBAAABA@ABA@@BA@A@@BABC@AB@BABA@@DA@AB@@A@A@@DA@@@@@A@A@@CACCAA@@BA@A@AA@@@@A@A@@@@@AA@@AAACCAAACAAA@AA@@@@BAAEGE@AEA@@CAABA@C@C@CAA@ACAAGIAC@@@@BCDC@A"],encodeOffsets:[[119671,29100]]}},{type:"Feature",id:"361126",properties:{name:"弋阳县",cp:[117.449588,28.378044],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@AAAC@@@@@AACABAA@B@@ABA@A@A@@@A@@B@@BB@@@BB@@B@B@@@BB@BB@@B@C@@@CB@@A@A@A@A@@@@@@B@@A@@BA@@@B@B@D@AB@B@@C@@BAAABC@@B@@A@@@AB@D@@@@@B@B@@@@A@@@A@@@@@@@@@@A@@A@@@B@@@@@A@A@@@A@@@@@AB@@@AA@@B@@AAEG@@AA@@A@CA@@A@@@AA@@AAA@@@A@@@@@AB@@AB@@@B@BC@A@@@AAA@@@A@A@BA@@@C@@@@CBG@A@A@@@@@@BABBB@BBA@@BB@@@B@B@@@@@BA@@@@@@BABAB@@@B@@BB@@BB@@@BA@@@@@A@B@@B@@@@@BB@@B@@A@A@@A@@C@@BA@@@@@@B@@ABA@@B@@B@CB@AA@@@A@A@A@A@@@A@@@A@AAAA@@A@@@A@@@AB@@@BA@@B@@A@A@A@AB@@AAA@AA@@AB@@A@@@A@AAA@@@@A@@@@A@AA@AA@A@@@@@AB@@@@@BA@@@A@@@@@@@CA@BA@@@A@@B@@@@BBBBA@ABA@C@@@A@A@@A@@@@A@@B@@@@C@A@@@A@A@@@@B@BAB@@@@@B@@@BB@BD@B@@@@@@@BAB@@AB@@@@A@@BBB@BBB@@@@@B@@B@@BB@B@B@@BBD@@B@@BB@B@@@B@BAB@@@BB@@B@@B@@@B@@BB@@@@AB@BA@@B@@@@@BA@A@@BAB@@@@AB@@@B@BAB@@@AA@A@ABA@AA@BB@@B@@BDB@@@ADB@@BAB@@AB@@@@BB@@@BB@DBB@@@B@@BA@@B@@B@@BB@@@@@AB@B@AAB@BB@@@@B@@BBB@@@CB@@@B@B@BBB@B@@@BA@@B@@BB@@@B@@@@BB@@BB@BA@AD@B@B@@@BBB@@ABBBBB@@@BB@@B@@@@@BB@@A@@B@BDB@@@@@@A@@B@B@B@@@B@B@B@@BA@@B@@B@D@@B@B@@@BA@@@@B@B@@@BBD@BBB@@D@@@B@B@@B@@@B@B@@@B@@@B@D@@@BA@AB@@A@@BB@@@@B@@CDE@AB@@BB@@ABA@@@ABAB@B@B@@@B@D@@@B@@B@BB@@@@@BA@A@@BA@@B@@AB@DA@A@ABABA@@BB@@BD@@@@@BB@B@@A@@@ABA@AAA@@B@@@@AB@B@@A@A@@@CAA@A@@@ABC@C@C@AAA@AAA@@@AB@@@B@@@BDD@@@@@B@@E@A@@AAAAAAB@@@@@B@BDH@B@D@DAFAD@DAHABAF@@@B@B@B@@BB@@BBB@@@J@@AHAB@B@LAB@D@D@BBB@BBDD@B@D@DAJ@B@FBD@D@B@@BBB@@BB@DBFDB@B@HDBBBBFFBBLBD@DB@@B@DBF@DBD@B@@@@B@D@@ADA@@BB@@B@@@@A@@A@@@@ABA@@BB@B@@@@B@@@@AA@@A@@@AB@B@@BBBB@B@B@@@BB@@@B@@B@B@BB@BB@B@@ADAD@@@BB@A@@B@@@B@DBB@B@@BB@@@B@@A@@B@@ABB@D@@BAB@@@@BA@@@@B@@B@@@B@@@@@@@@ADAB@@BB@@ABAB@@ABA@@@@@@@@BAAC@AB@@AB@B@@ABA@@@A@AA@B@@@D@B@@@@@@AA@@AAAA@@@@ABAB@B@@A@@@@@@@@@@@@BB@@@@BB@@@@@A@A@@B@@B@FB@@@BB@@@A@@B@@@@A@@@@@AA@BBB@@BB@@B@ADBD@DB@@@B@@@@@@BB@@B@@@D@D@B@B@@@B@@B@@@@B@@@B@@@@B@@@B@@@AA@@@@@A@@BB@AB@@@BB@@@@B@B@@@B@@@@BA@@B@B@@@B@@@BB@BBBB@@BB@@@@BB@AB@BB@@@BAAAB@@@B@@@@@@B@@@@@AD@B@@@@@B@@@B@@@B@@@B@B@B@B@@@@@@@@BC@A@A@A@@@@BB@@@B@D@@@@@@AB@@B@@@B@@@B@@@@BA@@B@@DA@@@@@BAB@B@@A@@@@@@BAB@@BB@@@D@@ABED@@A@ABA@@@A@BA@@@@BABA@@@@A@@@@@@@AB@@@A@@@AA@@@@@@D@@@@@@A@@@@@A@@@@@@B@@@@@B@@@@@@@@@B@@@BAB@@AB@B@D@@@@@@B@@A@@BB@@@B@B@@BA@@B@@@D@B@B@@@@D@@@BCB@BA@@@A@@@BB@B@@B@@@B@A@@@@@@@@@A@@@@B@BB@BA@@B@@@@BAB@@@DADB@@BB@BB@@@@A@CBA@@@AB@@A@AB@@@B@B@@AB@@@D@@ABB@@@B@B@@B@@A@@BA@A@@@@@@@A@A@@@@ACB@@@@@B@@@BBA@@@B@@@B@@A@A@@@@@B@@@@B@@@@@@@@B@@B@@@B@@@@@@FA@@AA@@B@@A@@B@@@B@@@@@@B@@A@@@A@@@@@B@@@BB@@@AB@@@@BA@B@@@@@@@@BA@A@ABA@@@@@@BB@BB@@@@@@@@AA@@@@@@BA@@@@BB@@@@@@@B@@@BA@A@@@@B@@@B@@@BA@A@@@B@@@BB@@@@@@A@A@@@AB@@@@@B@@AB@@@BA@@@@BB@B@@@@AB@@A@B@BAB@BAD@@AB@@@@@B@@@@@B@@AB@@@@@B@B@B@@@@B@@@@B@BBD@@ABBB@@@@ABA@AB@@AB@@ABA@A@@BAB@B@BADABB@@@DD@B@@B@@BB@@@@A@@@A@@A@@@A@@@@AB@B@@@DDDB@@AB@@@@AA@@@B@@A@@@@B@B@@CBEBABABA@AA@@@@A@@BBBBBB@@BA@@B@@C@@@@BA@@@A@@@A@BB@@AB@@@B@@BB@@@D@B@@A@GB@@AB@BABAB@@@B@B@D@B@B@@AB@B@@@BABA@@B@B@@@@A@A@@@ABA@A@EBA@@BAB@@@B@B@@@BA@@B@@@BBBBB@@AFAB@BAB@@CF@B@@BB@@AB@B@B@@@D@B@B@@BB@@@BAB@@A@A@EBC@@@A@A@@@A@A@@@@@@B@@AB@@@B@@AD@BABA@@BA@AB@@E@A@@@A@GB@@A@A@@@A@@@A@@B@B@@@B@B@@ABCBA@@@ABCD@BA@BBBBB@@BB@@B@@AAA@@@C@AB@@@B@BAB@@AB@@BB@@@B@B@BAB@B@@@BAB@@A@@B@@@@BB@@B@@D@B@F@@@@@B@B@D@@BDA@@@@B@B@@BA@@DB@@B@B@@@BABAB@D@@@D@BBB@@@@B@BA@@BA@@@A@A@@@@B@@A@AB@B@B@B@@@BABABABABAB@@A@AAA@A@@@@@A@AB@@@@A@AAA@ABA@@@A@@@ABA@@AC@@@@BA@@B@B@@AB@B@B@@@B@@@BB@@B@@B@@@B@@@B@B@BBBB@@B@@AB@@@B@@AD@@A@B@@@BABBB@B@B@BB@BB@B@@BB@@A@A@@@A@@@A@A@@@A@@@BB@B@B@@A@@@@@AAA@AA@@@@A@@@A@@@@@@BAB@@@BA@@@A@@@@B@@@B@B@@BB@@@ABC@@B@@@@@@@@@B@@@B@BABB@B@BB@@B@@AB@@CBA@B@@B@B@@@BB@@B@@B@@@@@B@@@@BB@@@AB@@BBB@B@BBB@@@@AB@B@@@B@@ABB@@@@@@B@@@B@BAB@@@BC@@@A@@@A@A@@@@@AB@@BB@@@@@B@BBB@D@D@@@D@@@@DA@@@@B@BB@B@@AB@@@B@B@@BB@DB@@A@@@@D@B@@@B@BAB@D@@CBA@AB@@@B@BA@AF@@@@@BA@A@@@CBB@D@BB@@BA@@B@DDDBBB@BBB@@@BFB@@BA@A@@BA@@B@BA@@BBDAB@B@@B@@@DBB@B@@@D@B@@@B@@FD@@@BBB@@BBDBDBBB@@FA@@BB@@DBBBBB@@F@BA@B@BB@BBB@@B@@BB@B@BB@BD@B@B@BBDDF@@@B@B@@B@B@@@B@BA@@FBF@B@@@D@BB@@BBA@BB@@D@D@@@@@B@@@BB@B@BBB@BA@BB@@@BB@@BDDB@B@B@B@@@@@AA@@BA@@DAB@B@@@BB@@@B@D@@@@B@D@D@D@D@D@DD@@BCBAB@BA@@@@@ABA@@BAB@FCB@FABBB@B@@@BAB@@@B@BB@@DDB@@BA@@B@D@@@@BBB@B@B@@@B@BABA@@B@@@DB@@DDB@@B@B@BAB@D@@@B@B@@@@BB@AB@@@@@B@@BFBD@B@D@@@D@DAB@@@@@B@@@@@@@@A@@@A@@@@@AB@@@@@B@@@@AB@AA@@@@@@@A@@AA@@A@@A@A@A@@@A@@@@@AA@@@A@@A@@@@@A@AAA@@@@B@@@@@B@B@@@@@BC@@@ABA@@A@AA@@@@BA@@@@AA@@@A@@AACC@AA@@@@@AA@@ACA@@A@@AA@@B@DABA@ABC@@@A@A@@@A@@@A@AB@BAAA@A@A@CAA@AA@AACC@A@@@AA@AA@@AA@AAA@@CE@@A@@@@@AA@@A@@A@@D@@@BA@@BA@@FCBA@@BAB@@@B@BADBB@@@BA@@@@BB@@B@BAB@BA@@@ADA@@@A@A@@@AB@BAB@B@@ABA@ABA@AAAB@@A@ABA@@B@@A@@A@@AA@A@@@@A@@A@@@@A@@BA@@@A@ABA@@@A@@B@@@@A@A@A@@@C@@@AB@@A@@BAB@BCB@@AAA@@B@B@BA@@@A@@FG@AB@BABAB@@AB@D@BAD@@AD@@AB@@AA@A@@@@A@@@A@A@A@@@A@A@ABA@@@CBA@AB@@A@@B@@@@AB@@AB@BA@A@@BA@A@@BA@ABA@A@@BA@@@@@C@@B@@BDA@A@@BBB@@@@@B@@BB@B@B@B@@@B@@AB@@BBB@@B@B@F@B@@@B@BBB@@ADAB@BABBBA@BB@DBBBB@B@@B@@@@DB@@D@@BB@BB@BB@@BB@@BD@@@@@B@B@@BB@BB@BB@DD@BDA@@@@BBBB@B@@B@B@@BB@@@B@@@B@B@@AD@@@@AB@@AD@@@@@DB@@BA@@B@@@B@B@@@B@@@BB@@B@B@@B@@DBB@BBB@@BB@@@B@B@@@@@B@BDB@@@@@B@AB@@BDBB@@@@B@B@B@@@B@@@@BBBBB@@@@@B@@@B@@@BB@@@BBB@@@B@@B@@B@D@@@BB@BB@@@DD@@@@@@B@@@@@BB@BB@@@D@BCB@@@@BB@@BDDBB@@@@B@@@@@BB@@@B@@B@@BBBBBBBBABAD@@@DBBA@@@@@A@A@A@@@AAA@@BABC@@B@@@B@B@BAB@BAB@BA@@B@BA@@DAB@@AB@@B@@@ABAB@BABA@A@@B@@B@@@@B@B@@@@@BA@@D@@@BAB@BA@@BA@A@@BADA@AD@B@BCBAB@BCB@B@@@B@BBB@@@@@B@@A@A@ABABCBAB@@@@A@AAA@A@@D@@@B@@AB@B@F@@A@@@A@@A@@AA@AAAA@@@A@AAAAA@A@C@A@@@A@AB@B@B@@@B@@A@@D@@@C@AACA@@AAB@@@@@BA@@AA@@BC@A@@BA@ABA@@@AB@AAAAC@@@@A@@A@@@A@@AA@ABA@@@@@AAA@AA@A@A@@AA@AA@@@@AAA@AAA@@@@AA@@@@@A@@@AAA@A@@@@C@@@@@AAA@@BA@@@A@@@ACA@A@@AA@ABA@@@AA@@@@BA@@B@BA@@@@@AA@AAAAAC@@@A@@@A@@B@BC@@BC@ABA@@@A@@B@@A@@@A@@A@@A@@AA@A@A@@@ABAB@@@@AAA@A@@@@AA@@@A@@A@@@AAA@@A@@AA@@A@@@CAAA@@@@AA@@@AAAA@AAA@@AAA@@@@C@A@A@@A@@A@AB@@ABAB@@A@@@@AA@@@A@@@@ACAAA@@CAA@A@C@@@@@@@A@A@AAA@AA@@AAA@A@C@@AAACA@@@@@A@AA@A@@@@A@@AA@C@A@@@@BA@B@@@@@B@AB@@@AAB@A@AA@A@@AA@AA@@@@@@AA@@@@@BCAABA@@A@@@A@AA@@@@@@@@AA@@C@A@@BC@A@@@@@@A@A@@@@@AA@AA@@A@A@@@@@@@@B@BAB@@AA@@@@A@@@@B@BAB@@@@A@@@@A@@A@@@A@AAAC@@AA@@A@A@@@@@A@AAA@@@A@@@ABA@@BA@AB@@@@AAAA@A@A@@@A@C@AA@@A@A@@@@AA@A@A@@@@@A@@B@@ABAB@B@@ABAB@@@BA@A@A@@DAB@BA@A@@@AA@AAA@@@@@AAA@@@AC@@AA@A@@ACA@@@@A@@BC@AA@@A@@@@@A@C@@@@@@@A@@@@@@@@AA@@@@@AB@@@AA@@@@A@@AB@@@AA@@AA@@@@@@@ADC@@@ABAB@@A@ABABAB@@A@@@AB@@@B@B@BBB@B@@@B@B@BBBAB@@@B@@@@@AA@@@@BA@BBAB@@@@A@AB@@@B@@@DBB@BA@@B@@@C@AAAA@AA@@@AAA@@AA@AAAA@@AA@@@AA@A@@@@A@@@A@@@A@@@@A@@AA@@A@@EBEB@@@@@@A@@B@@@@@@AB@@A@A@@@A@A@AA@AA@A@@@@AA@@@@AA@@A@@@AA@A@AA@@AA@@C@@@AA@A@@@A@@AA@@@ACAA@@A@AAAAA@AA@@@@ADEBADADABADCDC@@@@B@B@B@@A@@BADC@@BABA@C@A@@@C@@@AAA@@@A@C@@@@@A@@B@D@@@@B@@@@HB@@@@@B@B@@@BB@@@@B@@@@@@@B@AB@D@@@BA@@B@@@B@@A@@@@BA@BBA@A@@@@@@@A@@@A@@@BB@@@@B@@@A@@@A@@B@@@B@BAB@B@B@B@B@@@BD@BB@@BBB@B@BB@D@B@BBBBB@B@@@B@BBB@@BBBB@DBBBB@@BB@@BD@B@BBB@B@@@BC@@@@AA@A@@@A@@@@BA@@@A@A@A@@A@@A@@@@@@B@@@@A@@A@@@@@B@@A@@@A@@A@AB@@@@@AD@@AA@B@@@@B@@B@@C@@@@@@A@AA@@B@@AB@@A@@B@@A@@@C@A@A@A@@A@@A@@@AA@@@@@@@@@@A@A@@@@@@@A@@A@@B@@@@@AAA@@@AB@@A@@@@CA@@@@@@@@B@@@@@@@@@@AB@@@B@@@@@@A@A@@@A@@AA@@@A@@@@@@@@A@@@@A@@@@@@B@@@@A@@A@@@@@@C@@B@D@@@BAB@@BB@@@@AB@@A@@@@@A@@B@@@@A@@@A@@@@@AA@@@@A@@@@B@@@HCD@@A@@AA@AAA@@@ABC@@B@@@@AB@@C@@@@@A@@@@@A@@BC@@AA@@A@AAA@@@@AAAC@AAA@@A@@@A@A@@@@@AB@@@@@@@A@@A@@@@@A@A@@@AA@@@@@AAB@@@B@@A@@B@@@@@@B@B@@@@@@@@@@B@@A@@@@@A@@B@B@BAB@@BA@@BA@A@@B@@@@BA@@B@@B@@@@@@BB@B@@@@B@@@B@@@AA@@BA@@@A@@@ACA@A@@BA@A@@A@@@A@AA@@A@@E@AAA@A@@BA@@@@@AA@AAAAABA@@@A@@@@@@@@AAC@@A@AA@@ABAA@@@BA@@@ABA@@@AAA@A@C@@BABA@@@C@A@A@@@GC@@AA@@CAA@@A@@@A@A@C@@@A@@@AA@@A@@B@@@@A@@A@@BA@@@@A@A@A@A@ABE@AAEAA@AAACCA@@A@AAAAAA@@@ABA@@BA@@@@A@AA@A@A@@@@A@@@@AAC@@@AB@@C@A@E@ABA@A@@@@BA@@B@B@B@@@@ABA@@@A@@@@@@B@@@@A@@@@A@@A@BBA@@@@@@B@@@B@@AD@BA@BBAB@@AB@@@B@B@@A@A@AAA@@@@@@BB@B@@@@B@@BBA@@B@@B@BB@@B@@B@@A@@B@@@B@BA@@@@@@@@@A@@@@@A@@@@B@@A@@@AAA@A@@@@@@B@@A@@@@@@B@@@@@@A@A@@@@@@B@B@@@@A@@@@@@@@A@A@@@@@@A@@@@@@ABA@@@@A@A@ABA@ABABAD@@A@@@@@@@@@A@@@A@AB@@A@@B@@A@@A@@AAA@@@@AA@@@A@@@AA@A@A@@@A@AA@@@A@@@@A@@A@@@BA@@@@@@@A@@@@A@@@B@@@@@AA@@@@@@@@@A@@A@@@@@B@@A@@@@@@A@@@@A@@A@@@@@@@@@@AB@@ECC@@@A@@A@@BA@@@@@AA@@BA@@@A@@@AA@@@AACB@@@B@@A@A@@@@@@B@@@B@@@@@@AA@@AA@@@A@@@@@AAA@@A@@@AA@@@@AA@@A@@@@@BABB@@@A@@@@@@@A@@BB@@@A@@@@@AB@@@@@@@AA@AAA@@@@@@@@B@@@@@@A@@@@B@B@A@@A@@AA@@@BAB@@@A@@@B@@@@@A@@@@@@@@@@A@@@@A@@@A@@@A@@@AB@@@@@A@@@@@@@A@@@@@@A@@@@BA@@B@@@@@BA@@@A@@@A@@@AAA@@@A@@@A@@@@AC@@@@@@B@@@@A@@DA@AB@B@B@@@@@@@BBBBB@BBB@@@@@AA@A@AB@@@@AB@BA@@@BBB@@@@AB@@B@@BB@@@@@@AB@@@B@@@@A@@@A@@@@B@@@A@@@@C@@B@B@BB@@@@@AB@@BB@@A@@B@@B@@BBBB@B@@@@@@@@BB@A@@B@@@B@@BB@@@@@@AAA@@BAB@@@@A@@@A@@B@BB@@B@@@@@@@@@AA@@@@@A@@@C@@BB@@@@@A@@B@@@@AB@@@@@@A@@A@@AC@@@A@@@AAA@A@ACCAA@CB@@@A@@@AA@@@@A@@@@BA@@@A@A@A@A@@AA@@AA@@@@@A@A@@@@AAA@@A@@A@A@AAAA@@@@BA@@B@@A@@@A@@AA@A@@@AA@@A@@@@@@B@B@@@B@D@BA@AB@@AB@BA@@BABA@A@@@@@AB@@A@@BA@@B@@BB@B@@@BB@@B@@@DA@@DA@@B@D@B@B@DB@@B@@@@@BAB@@@@@BA@@@@AA@A@@@@BA@@@A@@BAB@@@@@B@@ABA@@BAB@BA@@B@DA@@B@@@BBHEB@@@A@@AAA@@AA@A@A@CBA@@@A@A@@AA@@BA@A@@A@AA@@@@@AA@@@@A@@@AA@@AA@A@@AA@AA@@A@A@C@C@AAA@@AA@AAAAA@@A@@@ABA@@@AB@@A@C@A@A@A@ADA@@B@AA@A@@@ABA@@B@@@B@@@B@B@BA@@B@FC@ABABA@@@@@@@@B@@@@AAA@@A@A@@@AA@BA@@@@@@@@A@ABA@@@@A@A@@@@@AB@@@AA@ABC@A@@@ABA@@BAB@@A@@BA@A@AB@@A@CA@A@@AA@@AAB@@A@@@@@BBBBBB@@@BAB@@@@@BA@A@@AC@@@@AA@@@A@@@A@A@@@AA@@@@AB@B@@AB@@@BA@@@@@A@@BABA@@BA@AA@@@CA@@@BA@ABA@@@A@A@AAA@@@A@A@A@EA@@A@@@@A@@@@@@@A@@@A@@@@@AA@AA@AAAA@@A@@B@@@BA@@B@B@DB@@BB@@B@@@@A@@@@AA@@B@@A@@AA@@@@B@@ABAB@@A@A@ABA@@@A@AB@BA@A@AA@BAAA@@@@A@ABCBA@@@CDA@@BA@ABAB@@AB@@AAA@@@BA@@@@@@@@A@AAA@@@@A@AA@@A@@AA@@ACAA@@BG@AB@@A@@@A@@@C@A@@B@@ABA@AB@@@@@BA@AA@@ABA@@AA@A@@@@ABAA@@@@A@@@@BA@A@@A@AB@@AB@BA@AB@@A@@@@@A@AA@B@@@@@B@BBB@@@BBB@@AB@B@@@BB@@@A@@@AA@@A@A@A@@B@D@B@DA@@B@B@@A@A@@A@@A@@AAA@@@@@@@@AAA@@AA@A@@A@@AAAA@@@@@E@B@BADADAB@B@@@BAA@BAA@@A@@AAAAA@C@A@@A@@@@A@AA@AAA@@@A@@AA@A@@A@@A@A@AA@A@@C@@@C@@AA@@BC@@@A@@@@@@AAAAAA@@A@@@@@A@@AA@@BAAA@@@A@@@AA@@@@A@@AABAB@@@B@B@B@BA@@B@@A@@@@@@BA@AB@@AB@B@@AB@@@@@B@@@B@@@@@BAB@@@@A@@BA@@B@@@BA@@B@@@B@@@@ABA@@B@@@B@@AB@@@B@@@@@D@B@B@@@B@BDD@B@@B@@@@@@BAF@@A@@@A@ABA@A@ABA@@@@BAB@B@@A@@BAAABC@ABA@@@A@A@ABAB@@ABAB@B@@@B@@@B@B@@A@@BE@A@@@A@A@A@ABA@ABA@@BAAA@CBA@@@@@@@AA@A@AA@@@A@AA@@@A@AA@@@@@A@@@@@AB@@@@A@@@A@@@EB@@@@AB@@@@AB@@A@@@@@@@AA@@A@A@A@@@@BA@A@A@@@CBA@A@A@CB@BA@ABAB"],encodeOffsets:[[120199,28797]]}},{type:"Feature",id:"361128",properties:{name:"鄱阳县",cp:[116.70359,29.004847],childNum:1},geometry:{type:"Polygon",coordinates:["@@DCDANE@AB@BAB@DAB@BA@@B@@@BA@@B@@@@@B@@@@@B@@AB@@@@@@@BAB@B@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@A@@B@@@@AB@@@@@@@@@B@@ABA@@@A@AB@@@@A@AAA@AA@@@@A@@@@B@@@B@BA@A@@@A@@@A@@AA@A@A@@@A@@@@BA@@@@AA@A@@BA@A@@F@FBFFDFFJJRHFHBD@BBFDHJFDFA@@FEFCBA@@@@B@@@@@B@@@@B@@@@@@BB@@@@@@@@@@B@@@@@@@@BB@@@@@B@@@@@B@@@@@@@B@@A@@@@B@@AB@@@@@BAB@@@B@@@@@B@@@@@@@B@@@@@B@BA@@@@@A@@AA@AA@@@AC@A@@AAAA@A@AAC@@@@AA@A@@@@@A@A@A@@@AB@@A@@@A@@@@@A@@@@@AB@@@@AB@@@@@B@@AB@B@@@BB@@@@B@@@@@@@BB@@@@B@DCHAF@B@@@B@D@B@HALAL@B@FBD@NDJBDBDDDBB@@BFBBBDBPXFF@@HHFHFFDFDHHHHFHDLDJFPHPJNFLJHFHDHDHDJDHBJ@JBJBHBHD@@FD@@DDFFDF@FB@BFDFFFBDHDFDLDFBH@B@H@D@LCPAJAF@BAJ@FBLBHBHDHFHFFBBBH@HBHBFBDBDB@ABA@@@A@A@@B@@@BABA@@@A@@@@@A@@AAB@A@@A@B@@AAAA@@AA@@A@@@AAA@AA@A@@@A@@A@@@BC@@A@AAA@@@A@@@@A@@@AA@@AB@AAB@@C@A@@@@@AB@@@BA@CBC@@@@B@BABAB@AAAA@@@A@AA@@@@@AAC@A@@AA@@@@A@@@A@A@@A@@@A@@@@A@A@CAA@A@A@@AA@A@@@A@A@AA@@A@A@@@AA@@@AAAAA@@@@@ABA@@BABAB@@@AA@@BA@@@AB@@A@A@ABA@@@A@@@@@@@@AC@@A@A@A@@@AA@A@@A@A@@@A@AA@@@A@@@CA@A@@@@@AA@@@@A@@@A@@@AC@@A@@@A@@@@@AAA@@@C@@@A@@@@@A@@@A@@B@AABA@@A@@@A@A@A@A@@@A@@@A@@@@@AAA@@@A@@@@AAA@@A@A@AB@A@@@@@A@@@A@@@CA@@A@AAA@AA@AA@@A@AA@A@@@AA@@A@A@@@@AC@@@@B@@A@@AA@AD@@@@A@AAA@A@@BAB@BA@@@ADA@@AA@A@ABABA@@BAB@BA@@B@@@@@@@@E@A@@B@BA@@@CB@@@AA@A@@@A@A@AA@@AB@@ABAAA@@B@@A@@@A@A@A@@@ABABABA@@@A@A@A@@AA@@B@@CB@@A@A@A@AAA@A@@@AA@@AA@@@@@@A@@@@AABA@@B@@A@@B@@@BA@@B@@AB@BAB@@@@@@A@@BA@@@@B@@AB@@@BA@@@@@@@A@A@@@A@A@@@A@A@@@@@A@@@A@@AA@CA@AA@@@A@@AC@A@@@A@A@@AAB@@@AA@@@A@A@@@A@A@@@@@A@@@AAA@@@A@@@A@@@@@@BC@@BA@A@@BA@@@@@A@@DC@@@@@@AA@@@@@AA@@AAAA@@@@@AAAAA@@@@@@ABA@@@A@@@AB@B@BAB@BA@@@@B@@A@@@CA@AA@AA@@AAA@@@ACC@CAA@@AA@@AA@@@A@C@@BA@A@AA@@A@A@@BC@@@A@@@@@A@@@A@@BC@C@@@A@A@@BA@A@A@@@A@@CA@@@@@A@C@ABC@@@A@A@A@@@AB@@ABAB@BBB@@AB@@@BADA@A@@B@B@BBB@@@@AB@@AB@@@B@@@D@@@B@@@@@B@B@@@BAB@@B@B@AB@@@D@@@@A@C@@B@BA@AAAAAA@@@@@BA@@@A@@@A@A@@AA@@B@@A@@@@@@@AB@@A@A@@@A@A@@@CBA@@CA@@A@@@A@@@@@A@@AA@@BAA@@@@@@A@A@@@C@@@@A@@BA@@@@BABA@@@@@AB@@AB@@A@A@A@C@@AA@@@C@@@@@A@ABA@A@@@AB@@@B@@A@A@A@@A@@A@A@@@@@@@A@A@@@@CBA@@A@@AAA@@A@@A@@@AAC@@A@A@@A@@AA@A@GF@A@A@C@@BA@A@@@A@C@@AA@@@@@A@A@@@AA@@@@A@A@A@AA@@@@AA@@@CB@@A@@AA@@A@B@@AB@@@@@@A@@BAB@@@@A@@@@A@A@A@@@@@AB@@@@@@C@@A@@@A@@@@A@@A@@@AABA@AA@@@@@AB@@@@@@@A@A@@@A@@BA@A@@@ABAB@A@@A@@@@@A@@@@@A@@B@@@BB@@BBB@@@B@@B@@B@B@@@BA@@B@B@@@@@DC@@BA@@BA@BBAB@B@@ABADC@ABBBA@A@@B@@@DAD@@A@@@@@A@@D@@@B@@AB@BBB@@@BA@@@C@@BAA@@A@A@@BA@@@@BA@@B@@@@ABAA@BA@@A@@@@@ABA@A@@@@@BA@@@@@@AA@@ABAAA@@@@@BA@AB@@@BA@A@@@@@A@@@A@A@@BA@AA@B@@A@A@@BAB@@A@@@@@@A@@@AAA@@@BA@@@A@CAB@AAB@@@@@@A@@A@A@@BA@@A@@A@@AB@@@@@@A@A@@@@@BABA@@A@@@A@@@A@@B@@A@A@A@@B@@AAAAA@@A@@@@BA@AA@@@@A@C@A@@@@ABA@@@AA@@A@@@AAE@CBA@@@@@@@@B@@@BA@@@@@@A@@@BA@@@@@A@@@@A@@@@@BAB@@@@@@@A@A@@A@@@@B@@@B@@@@A@@B@@@@@BA@@@@A@ABA@@@@@@AA@@@B@@AA@@@B@@A@@@@ABA@@@AAA@@B@@@@@@A@@@A@@@A@@@@@A@@@@BA@@@@@@@@A@A@@@@AA@@AAA@A@@@A@@@@CA@@@@@@@@A@A@AA@@@@@@A@@@BA@@A@@@@A@@@BB@B@BBB@@@@A@@@@AA@@@A@@@AA@@BA@@@@@@@AA@B@@@@A@@@A@A@@AA@@@@@@B@@@@@@@A@@@@AB@@AA@A@@@@@@@A@@@A@A@@@@A@@B@A@@@@@A@@B@B@@A@@@@A@@AA@@@@@BA@@@A@C@@@@A@CB@@CB@@@BABA@CBAB@@A@A@@@@@@@@A@A@@@@@@AB@D@@A@@@@@A@@B@@A@@@AB@BAB@@A@@@@@@A@@A@@AA@A@@@@A@@BA@A@@@A@A@@@A@@@A@@BAAABA@AB@@A@@BA@@@@@A@@@ABCBAA@@A@@A@@AAA@@BA@AA@@A@@@@A@@@@@@ABA@@@@B@@BB@@@B@@@@AD@@A@@@@BAB@BA@@@@@@@A@@@CA@@AA@CAA@@@AA@@A@@@@A@@BA@@@@AA@@@@@A@AB@@A@AB@@@B@@A@@@@@AB@@@@@@@AAA@AB@@A@@@@@A@@@A@@@@B@@@@A@@@@@@ABA@AB@@A@@B@B@@@@B@@@@@@B@@@@@@B@@B@@A@@@B@@B@B@@@@@BA@AB@@A@@@@@AB@@A@@@@@A@@@A@@B@@@B@@@BA@@B@@A@@A@@A@@@A@@A@@@@@@A@@B@@AB@@A@@@@A@BAAA@@@B@@@@@@@A@@@@@AA@AD@@@B@@@DA@@@AB@@A@@@BABA@@@A@@@@AB@@A@@@A@@@@BA@AB@@A@@@@A@AB@@AA@BCBA@A@@B@@@BA@A@A@@DA@@@@@AAAA@@A@@A@@A@@@A@A@@@@AA@A@@@@A@@BA@@@AA@A@@@A@A@@BEB@@@D@@A@@@CBA@@BBBA@@@@AA@@B@@@@A@A@A@@@@@A@@@AB@@@BA@@B@DEFIB@B@FI@A@@@@BC@@AA@A@A@AA@@@AA@@B@BAA@A@@AB@@@@AA@@@AA@@@@B@@A@@@@@@A@@@@A@@BA@@BA@@@B@D@@B@@@@A@@@@@ABA@@BA@@@BBB@@@@@@@@@A@@BAB@@BB@@@@@B@@@B@@C@@B@@@AABA@@@@@A@ABA@A@@@@@A@@AA@A@AB@@@@BDBBB@@@B@@B@@A@@@@@@@AD@@AB@@@BBBBBB@A@@@A@A@A@AC@@AB@B@@@B@B@B@@@BBB@B@@@B@@C@@@A@@B@B@@@B@@@@@BAB@AA@@A@@@A@@A@@B@@@D@@@@@@@@AA@B@@A@@B@@A@@BAB@@CB@@@B@@AB@B@@@@A@@BC@@@A@A@A@AAABABA@AB@@@@AB@D@B@@@FC@@@A@@@A@@@A@@@AA@@AAA@@B@@C@@A@A@CA@@A@@@@@AA@@A@@@AA@@@@BABA@@@@BABAB@@A@@BA@ADA@@@A@A@@A@A@A@@A@C@@AA@@@B@@ABA@AB@@A@@B@@A@@@CB@BAAA@@@AAA@@@@@A@@@AA@AB@@CB@AA@A@@@AA@@@@@A@@AAAA@@@@@BA@A@@@A@@@@CA@@AB@@@B@@@B@BA@ABA@@@@@A@@@@B@@A@@@@@@BA@@@ABBA@@AB@@@@@C@A@@AA@@@A@@@AAA@@@A@@@@@AAA@A@@@@@@@AA@@@@A@@@@B@AC@@AA@A@AB@AA@@BA@A@C@@@ABC@A@A@@BA@@@@@CB@@@DA@@@@A@AA@@@AB@@A@@@@B@@@@@@A@@@A@A@@BA@@@ABA@@@A@ABA@@B@B@@@@@BA@AB@@@AA@@@@AAAA@@@@@A@@B@@@@A@@@A@@B@@A@@@AAA@@@AA@A@A@@B@@C@@BAAB@A@@@A@@@@@CAAAB@@@@@@@A@@@@@AA@A@CAA@@CAA@A@A@A@A@@@A@@A@@@@@@C@@@@@@A@@@@AA@@AB@BA@@@@B@@@@A@@A@A@@@AB@@@@A@@@A@A@@@@BA@B@AA@A@@A@@@AA@@@@A@@@A@BA@A@@A@@BAA@@@ABCB@@@@@AAA@@B@@@BA@@BCB@@@@@BB@@@@@AB@@CB@B@B@@@@@BA@@B@BAB@@BBA@@@@@@BC@@@AAA@@@ABA@CBA@AB@@ABCABA@@A@@@@BA@@@@AAA@@@A@CB@@A@AACAAAA@AA@@@@@BAB@@@@A@@@A@@AA@@A@BAB@B@@A@@A@@@@C@AB@@@A@@A@@@@A@@@AA@@@AB@@BB@@A@@BAA@@@BA@AB@@A@@@A@@A@A@A@@@@A@@@@A@A@@A@@@B@@A@A@@@@@A@@@@@@@@AA@A@@A@@@@@AA@@AC@@@@BAB@@@@AC@@@@@@A@@@@B@@@@AB@B@@@AA@AB@@@A@@@@@@AAA@A@A@@@@D@@@B@@B@@B@@@@@@AA@@@@A@A@@B@@@@@@AA@@AA@AAAA@@@AAA@A@@@@@AA@@@@@@B@@@AA@@@@AAA@@@@@A@@DA@AB@@@ACAA@AB@@@@@BA@@@B@@B@@@B@@@@@@@@@CA@A@@AAD@@@@@AA@AA@@@@@A@BA@@@A@A@@@@A@@@@@AA@BAA@@@AB@@A@BB@@@@A@@@AA@@@@@@B@@AACB@@@@A@A@@@@@@@A@@AB@BA@@B@AA@@@@AA@@AA@@A@AA@@A@@@A@@@@A@A@@@@AAABCB@@@@BB@@@B@@@@@@A@@@ABA@@B@@A@A@@@@ABA@@@AA@@@AB@@@@@@@@A@@@A@@@@@@AA@@@A@@@@@@AB@@A@@@@@A@@@A@AAAA@AC@@CA@@B@@@@A@@BA@@A@AA@@@@@AA@@AAA@@AEA@@ABA@AAC@A@@@AA@@@AA@@BA@@@@A@@@A@@@@AA@A@B@A@@A@@B@@B@@B@@A@@A@@@BAB@@@@AA@@@A@@@@@@AAAA@@A@@@@@@A@@@AA@@@@A@CACCCC@@@A@@@AA@@@@A@@@B@A@@@@@AB@@AB@BA@A@AB@@@BAB@@@@@@A@ABA@@@A@@@A@C@A@@@C@AAA@A@@CGCCCC@@AC@A@A@A@ABABABCDCD@BAHEDABAB@@A@C@A@AAAAC@@AACAAAA@AAEAEAC@C@C@C@ABABAB@BCBEF@BA@CFABABCDCDABABCBMF@BA@ABE@@BA@C@GAA@A@CAA@ECAACCA@AC@AAEAC@AAC@@AAA@AAC@CAIBG@E@GBKBABCDABAB@DAD@D@DBFBB@DBB@FAD@DCDADABCDA@A@A@ABC@MAC@CAE@C@A@CBA@ABCBEDEDABA@A@CDA@A@C@CB@@@@@@@AAA@AB@B@@@@AB@@@@CAA@A@@A@A@BA@@@AAA@@B@BE@A@A@E@@B@@A@@BA@B@@B@@@BABA@@@@A@A@A@@A@A@@@@@@@BBB@ABA@A@A@@BABA@@@@BB@@A@@@AB@@@BA@@@@BB@@@@AB@@@B@@@@A@@@@@@@A@@B@@@@B@@B@@@@@AA@A@@BAB@@@B@@@@@B@A@@A@@AA@@@@BCBC@AB@@@@@B@@B@@@@@A@A@@A@@@BA@@@@@AB@@BB@@A@AA@A@@AA@@AAA@@AABAA@@@@@CB@@@AA@@@A@@@A@@@@@@AB@@ABAB@@@@AB@@@@@BA@AA@@@AAA@@@A@B@@A@@B@@@@@@A@@B@@@@A@@AC@@@@BA@@B@DB@@@@AAB@@@@AAA@@A@@A@@@AB@BA@@A@B@@@B@@@@@B@@@@@BA@@@A@A@@@@B@B@@@B@@@AA@A@@@A@@BA@@DADBBA@@@@@@@AB@@@@A@@@@A@@@A@@@@@@@BAB@@@B@B@BAD@@@@@@AA@@A@@B@@@@A@A@C@@AA@@@AA@BAAA@@@@C@A@BAC@@@BC@ABA@C@@@@A@CAAAABA@A@A@EBC@@@AAEA@@ABC@A@ABCBAA@@@@AA@@BC@@@@@@EAAA@@A@@@@@AB@@A@A@A@@@A@AA@@A@CB@@CA@@AA@@AC@AA@@@AA@@A@@AAA@A@@AAA@@A@@CD@@B@@B@@@@@@A@@@@B@@@@@@@@AB@@@@B@@@@@BB@@@@@BA@AB@B@@@@@AD@@A@@@@B@@B@@@BA@@BA@@B@@A@@@A@@@@@@BA@AB@@@@@@@@@BA@AAAA@BAB@@@BA@@@@@@@BA@@A@@@@@A@@A@A@@@@@A@@@AAA@@A@@@@A@@BAAAB@@ADC@A@AB@@B@BBB@@A@@@@@@B@@@@A@@@@BA@@B@BBBB@@@@@B@@@@@@@B@B@@@@BA@@B@@A@BB@@@AB@@AB@B@@@@@@@@@A@@@@@@@@A@@AA@@B@@@B@@AB@A@AC@C@A@@DCAA@A@@@@@@@@@@BA@@@B@@B@@@@A@@@C@@@AB@@@AA@AAACCA@@A@A@A@@A@@AA@@BA@@@@B@@@@A@@@@@@AB@AA@@@@A@@@AA@@ABCBAB@@@@A@@@@B@B@@@@ABE@@@@@EACA@@A@ABA@AAA@@BABAB@@CB@@AB@B@@@BDN@B@DBD@D@BAB@BAFAB@@@@ADA@ADCBABA@A@A@@@A@E@E@A@A@A@A@A@A@@@@B@@BB@@@B@@@D@BAB@BCB@@@BADADABA@@@ABA@@B@@@BB@DBB@@B@@BBBD@B@BDDB@@B@B@@EHABABA@A@@@@@@B@@@DBF@@BB@B@B@D@B@@@BC@AB@@A@ABA@CBC@A@@B@@@@BBAB@B@@AB@BC@ABEBIBC@A@C@AAA@AAAAA@AAA@@@A@@@ADAF@@E@ABA@@@@B@@@BA@A@@@AA@@AC@@@@@ABAAA@@@@AB@@AD@@EDAB@@@B@@ADABIFCB@BAB@@ABEBADABABABGBCB@@@@A@ECAA@@C@A@A@@BA@@@@BAB@@A@@@CB@@@@@B@B@@@DABABABC@ABABCB@@A@A@C@A@A@ACA@II@@C@A@CBIBABA@ABABA@EDGDABC@ABC@A@A@AACAA@CEGEAAAAAAEAGA@@@@A@C@GBSHC@IDCBGBA@IDGBGDCBA@@@A@ABCBEBA@A@A@EAKCA@ECC@A@A@CBEBA@A@IH@BA@@B@BBBBDHFBDBBBDBBDJDD@BB@@@DA@@B@A@@@CD@@CPAB@BCD@BAB@@ADABAD@BAD@DAB@@@B@B@DAD@D@D@D@D@D@D@F@D@B@B@B@D@F@@BBBD@@@B@B@@ABIBAAAAEEC@AACAEC@AA@@@@@A@A@C@@@ABABEP@BCBCBCBAB@B@@CD@BABADCDCDEFABCB@BEFCDGJADABABCBMHCDCBCDEDEDABA@EBE@A@@@BB@@FBB@@BB@FHBBBBBDDFBDBDDDBBBBB@BB@@BBBBBD@BFHDBBBBBBBBDBDDBBD@D@D@B@DADB@@B@@BBHDB@D@@@B@BA@@BA@@BBBD@@LLBBKP@B@@FFNVHLPXØ@B@JHJHLHJH\\THFLJB@\\VxjZRaTgTMF@@AAA@@A@B@B@@A@@AA@@@@BA@@BA@@@AAA@@AA@A@@@@B@@@BA@@@@@BB@@@@@BB@@B@@A@BB@B@BA@A@A@A@@@@@A@@B@@@B@B@B@@A@BB@B@BAB@BAB@BB@@@@B@D@B@@@@@B@@@BAB@@ABAA@@A@AA@@@@A@@@@@A@@@@@DD@@@@AB@@@@@B@B@@@B@BA@ADA@CBABA@AB@@@@@B@@@B@@@AC@@@@BA@@@@@@@@BA@@B@@A@@BA@@BA@@@@@@@CD@@@@@@BAB@ABAB@@A@@@A@@B@BBD@@@BAAA@@B@@@@A@@@@@@D@DBB@BBD@D@D@@A@AB@@A@@@@@A@B@@BAD@@@@@AA@@@A@@B@@@B@@@B@@@@BB@@@@@@A@CBABA@ABABA@ABAB@BCDAB@B@@@@ADAB@BA@@B@B@B@@ABCB@B@@BB@BCBEB@@@@JJ@B@@@@@B@@@@@B@@@@B@ADA@@BB@@@@BAB@@AB@@@@@B@@@@ABB@@BAB@@BBBA@A@AB@@@B@@@@@AA@@@@BA@@@B@BB@BAB@@@@B@B@@@@@@DABA@@@@@A@@B@@@@A@@@AB@B@B@B@@@BF@D@D@B@@@B@BAB@@AB@@A@CBA@A@@B@@@B@@BBB@@@B@@@@@BA@@BBDDD@@BB@@BDD@@@@AB@B@@AB@@@B@@CB@B@@A@A@B@@@BBBA@@@@@BADA@A@@@@B@@@@@@A@@@AB@@A@@@A@@@@@@B@@A@AB@@@@@B@B@@@@AB@@B@@@ABAB@@A@@@AB@BA@@@AB@BB@@B@@BD@@ABA@A@CB@@@@@B@@AB@B@B@B@B@BABAB@B@@@B@AB@@A@@@@FB@B@B@@@@AB@@AB@@@@@@B@BBA@@@A@@@@@@@A@@B@@@@A@@@@B@@@B@@A@@@@@@@A@B@@A@@A@@A@@B@@@BA@@@@@A@BA@@@ABA@@@@BAB@@@@@B@B@@A@ABA@@@@@A@@@@@A@C@@@A@@@@@A@@AA@@BAA@@ABAB@B@BAB@B@BAD@@AD@@BDB@@BB@@B@@@BB@@@BB@@B@@B@BAB@@A@@@@B@@@B@@B@@B@@AB@B@@@B@@@BB@@@@@BB@@@BBB@@BB@@@BA@@B@BBB@B@B@D@B@@B@@@B@B@@@BA@@BA@@B@B@B@BABB@@@@@@@BB@B@@@BB@@@@@@@B@@@BBB@B@B@@@@A@@BB@@@BB@D@B@B@@@BAB@@@B@@@B@BBBABCD@@A@BBAB@B@@A@C@@BAA@@CB@@A@@@@@AB@@C@AB@@@BAB@@A@AB@@@BCB@D@@AA@@@BB@@@B@@@@BAD@@@@B@@@B@@B@BBBB@BB@ABB@@@@@@@@A@@BA@B@@@BA@@B@@@@BBA@@BB@@@BA@@B@@AA@@@BA@@@@@@@@@@@@@B@B@@@@@@@BA@@B@B@@@BB@@AB@@@B@@BA@@B@@@@BAB@B@@B@@@@@B@@@@BAB@@AB@@@@BB@@BAB@ADAB@@A@EB@@@@@@@B@@@D@@@B@@@B@B@@B@@B@@B@@A@A@@@@BD@@BBBBDB@@@B@@@BB@A@AB@@@B@AAA@AA@@@AAAB@@B@@BB@@BBBA@@B@@@B@@B@@@@@@@AB@@@@B@@@@B@BA@@@B@@@@B@@B@@B@B@@@B@@@@@B@@@@@B@@@@@B@@@@ADA@AB@@@@AB@B@@BDBB@@AB@@@BBD@@@BBBBB@B@@@B@@C@EBCAAAAA@@A@@@A@A@A@@BADB@@B@@A@@@@@A@@B@@A@@@@B@@@B@BA@@@@@BBAAA@@@@A@@AA@@AAA@A@@@AA@@@ACCA@@BCB@BABAB@@AB@B@@ABAFA@@BABC@@@@B@@@@ABAAC@@@AB@@AB@@@B@@A@@@BDBB@B@@BB@@BBB@@@BBBBB@DBB@BDDDB@@B@@BB@BBB@B@B@BBB@BB@@@AB@@BB@@BDB@@@AB@@@B@@@B@BB@A@@B@@B@BB@@@DB@@BBB@@BBBBBF@AB@@@B@@@BB@@B@@@BA@@@A@@@AB@@@DC@ABA@@@AB@@AB@@@B@B@@@BB@@@@@@BB@@B@@A@@BBB@B@@A@@@@@@@ABA@@@@@@B@@@BC@BD@@@@@BA@@B@@AB@@A@@B@@@@B@@@BBB@@AB@@@@B@@@B@@@BAB@@ABA@@@AB@BA@@@@B@@@@@B@BBB@@A@ABA@@@AB@@@F@BBD@@B@@B@@BB@BBB@@@B@@@@@BAD@DABAB@@@BA@@BAB@@A@@@A@@@@@A@@@@@A@@B@@@B@BA@@@A@@@A@AA@@@@A@C@@@AB@@@@@@A@@BA@BB@DABA@@B@@@@A@@@AB@BA@@@B@@B@@@BB@@DB@@BA@AB@@@B@@@@@B@B@@@@BBA@@@@BB@@BA@@BA@@B@@ABADABBB@@@DA@@B@@AB@@@B@BA@AB@@A@@@@B@B@@AB@@@B@@@B@@BA@@B@@AD@B@@@@@@@B@B@@ADBBAB@@@@BB@@DAB@@@@@@B@BA@@B@B@B@@@B@@B@BA@@B@B@@A@@A@@@@@@A@@@@@@@@AA@@@@@@@@B@@@@@@@@A@@@@@B@@B@@@B@@@@@BA@B@@A@@BA@@@@@B@B@B@BABBA@@DB@B@BAB@B@@@@@B@@A@@D@B@D@@@@BB@@@@B@AB@DA@@@@@@@A@@@@@ABA@@@@B@B@@@@B@@@B@B@@@@@BB@B@@B@@BB@BA@@@AB@@@B@@@B@@@B@B@B@@AB@@@@B@@BB@@@@@BB@BB@@B@B@@@B@@@@@DAB@B@@ABBB@@@@@B@@B@@@B@BB@BA@@B@@@BB@@@B@@@BAB@B@@@@@@@B@BB@@@@B@@@BA@@BABA@A@@B@@@@@B@@AB@@BA@@@B@BB@B@@BA@@@@@@A@@@@A@@@A@@@@B@@A@B@@B@@A@@@A@@@AB@@@@BB@@@@@DAB@@@@C@@@@B@BA@@@@BB@@@BDB@@@B@@@B@@B@B@@@B@PJDGB@"],encodeOffsets:[[119491,30315]]}},{type:"Feature",id:"361130",properties:{name:"婺源县",cp:[117.861797,29.248085],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@A@@A@@AC@AAAAA@@@A@A@AAAAA@@@C@@AC@@AMBA@A@@BABAAA@@AA@@AAACAA@AACAAAC@ACAAACAA@AAA@A@@@@B@@BD@DBB@B@@@B@@ABA@A@@BABA@ABA@A@A@A@ABA@ABC@ABABCD@BCB@@@B@@@D@B@@@@A@A@C@@@@@AA@AA@@@A@AB@@@@ABAB@BA@A@@@A@AB@@ADAB@@AB@B@D@@@BABCBCBAB@B@B@D@@@B@@EBA@@@AA@@@AAA@AAAA@AA@@A@A@@BA@@BA@@@A@@BABC@A@@@@@AB@@AB@@AB@@@B@@@BA@@@@@@B@@@@@B@@B@@B@@A@@B@@@BA@A@@@@@@@A@@@@@A@A@A@@@A@@@@B@BB@@@@@@B@@@@A@CA@B@@A@@@@@@B@B@@AB@@BB@@@B@@AB@@BB@@@B@@@B@@A@A@@BAB@@@@@@BB@@D@@BB@B@@@BBB@@@@@BA@@@@@B@@AB@@@B@B@@@@@@@BB@@B@@AD@@B@@BF@BB@BBB@@B@@@BA@@@@@B@@BB@@@B@@@B@@@@A@@B@@@B@B@@A@@B@B@@@B@B@@@@A@@@AAA@@B@@CC@@@@@@@A@@A@@@AAA@@A@@@@@@A@@@@BA@@AA@@BA@@@@@A@@B@@@BA@@@ABA@@@@BA@AA@@@BA@@@@@AA@@A@@D@BA@BB@B@B@@@B@BB@@B@@C@AB@BA@@BCB@@A@@B@@@@A@@BA@@B@B@@AB@@A@@B@@@@BB@@B@@@BB@@@BB@@@B@@B@@AB@@ABB@BB@B@B@B@@@@@B@B@@@@@@BBB@B@B@@B@BBBDBBB@B@@@DBBAB@@@B@BBD@BA@@B@@@B@@B@B@@@@@@D@@@B@@@@@B@@@@@BAB@B@@@B@B@@CBABA@A@A@A@@AC@A@A@AA@@ABA@A@A@@@C@@@@@AA@@AA@@A@@@AAA@@@AA@@A@@@@A@@A@@@A@@A@@@@AA@A@@AAA@AA@@CC@@C@@@@@ABADAB@@A@@BABCAABA@A@@@A@A@A@@@A@A@ABA@AA@@A@@@@@A@AA@@@AAABAAAB@@A@@@C@AA@@AAA@A@A@A@AAAA@@A@A@@@A@@@A@A@@CAA@@@A@@@A@@@@BA@@@AB@@@@A@@BAAA@@AA@@@@@@BABAAA@@@A@A@@@A@A@AB@@@B@@A@AB@@AB@@ABAB@@@B@@@B@@@@ABA@A@C@@BA@@@A@@@@@A@A@@@@@A@@@A@A@CBA@AB@@A@AB@@@@@B@@AB@@@BA@@@A@@@AB@@A@A@@@A@@@@B@BA@@B@@@AA@@@A@@BAB@@@BA@@@AB@@BBA@BB@@@B@@B@@@B@BB@@@B@BAB@B@B@@@@@B@D@@A@@BAB@@@B@B@@@BB@@B@@@B@BA@@@@@AB@@@@@B@@@D@@A@ABA@@AA@@B@@ABA@@BA@@@@@@BA@@@@@@@BB@@@@@B@@@@@@BB@B@@@BA@@BA@@BA@@@@@BD@B@@@B@@@@BB@D@@B@@@BB@@@BB@BB@@BBA@BB@@BB@@BB@@@@@BA@@BDB@BBB@@@B@@@BB@BB@@ABB@@B@@B@@@@B@BA@@@A@@B@B@@@BA@@B@@@BAB@B@B@@AB@@@B@B@B@@BBHBBBB@@B@BBB@@BBBBB@BBB@@@DFFDBB@@@BB@BBBB@BBBB@B@B@DA@@B@D@@@@@B@B@B@BA@@BB@@DB@B@@B@@B@@B@BBB@@@B@@@B@@@B@@B@@BB@BB@@B@@@B@BBBB@BBF@BB@@@B@B@@@BB@@@B@@@BA@@BBD@B@@@@@BB@@@BB@@@@B@BBD@B@@BB@@@B@@@BB@@BD@FD@@@@@B@@@B@BA@A@@@ABA@@B@B@@ABABA@@@ABCB@B@@AB@BA@@@ADAB@B@B@B@B@BABA@AB@@@@@@A@AB@@C@CB@@AB@@@@A@@BB@@@B@@@@BB@AD@@@BA@@@@@B@@@@B@@@BB@B@@B@@@@B@@BABBB@B@@ABB@@B@@B@B@@BB@@@BB@B@@BB@@@BB@@B@@B@@@B@BB@@@@B@@A@BB@@@B@@@B@@@BB@B@@@@B@BBBB@BB@@@D@B@BBB@BBB@BBBDB@@@BB@@B@BABB@@B@@BB@@BB@B@B@@AB@B@B@@@BBB@@@BB@@@@B@@@@B@@B@B@B@B@DB@@@BBB@BBBB@@@DD@@DBB@B@BD@@A@@B@@@D@@AB@@@B@@ADABA@@BA@@B@@@B@B@@@B@@@B@@@BBB@@B@@B@@@B@@@@B@@BD@@@@B@@@B@B@D@@@@@D@@BB@D@B@B@@ABAB@@CF@B@@BB@B@B@D@B@BA@@@@BA@@@ABA@@B@D@B@BA@@@@D@@@@BB@@D@@@B@D@D@@@BB@@F@B@B@@@B@@@@@F@HB@@B@@@@@BBDBBBDBB@BB@B@@B@@@@BB@AB@@BB@BABA@ABA@@@AB@BA@@@@B@B@BBBAD@D@@EB@B@B@@@B@B@B@B@@B@B@BBB@@B@@@@@@AB@@@B@B@B@BBBPFBB@@@@BB@@B@BB@@BBB@@@@@BA@AA@@A@@A@@@@@@A@AA@@AA@@A@A@A@@BA@@D@@@BCBA@A@@DA@@B@B@D@B@B@@BBBBBBBBB@B@@BB@BB@BFBB@B@BB@BB@@@B@D@@@@BBB@BB@@B@@@@@@@@BB@BB@@@BDB@@DB@@@@BB@@B@@@B@B@@@B@@@B@FBB@@@B@@BB@BBB@BAB@DA@@DB@@@BBB@@B@@BB@BB@@@BA@ABABA@ABADCB@@B@@B@@BBB@@B@B@B@D@BBBBD@@@@@BB@B@@BAB@BAB@BBBBB@B@@B@@ABA@@@ABBD@@BB@@@BB@@BABA@ADAAA@@BA@I@A@@A@@A@@@A@@@AB@B@@@BB@@D@@@B@B@@@@@@AB@@ABC@@B@@@BB@@BB@@@@B@@BB@@BFB@@BB@@BB@@BB@F@D@BB@BB@BBBBD@@BBBBBB@@@BA@A@@BB@@@@B@@@BB@@B@@@B@@@@@@@B@@@B@@BBA@@@B@@@@B@@B@@A@@@@@BA@A@CBAA@@A@A@@A@@ABA@AA@@C@@@AB@@B@BB@@B@B@@BDD@BBB@BDABC@@BAB@@AB@@@BB@B@@@@BD@@BB@D@@BB@B@@D@B@B@@@@A@@@ABA@@DB@@BC@ABA@@@AB@@A@@BB@@@@B@@@BAB@@BB@BBB@BB@@DA@@BD@@@@@BB@@D@BBB@@B@@@B@BB@@@BB@B@@@BBADBD@@AB@D@@@BB@@@@B@@@@@BB@BB@BA@@B@@B@D@DB@B@BBB@@BD@@@BB@@@BAB@BADABA@@BEBA@@@@B@@AD@BBBAB@B@B@B@B@D@D@@@B@B@FBB@BBDB@BB@BBBDBB@@@@@@BBB@BD@@@@B@@B@@@@B@@BDBDD@BB@@@@BB@@@DBJBBB@@@BABR@NBL@LBLHLHLBHALAHAH@NFJHD@FDFDJDJBHDFBJ@J@FAFAFEDCDEBADAFALCHAHCFAH@H@HAHCFCDEHA@@HAJBJAJD@@HDPFLBPBF@FAHCFCL@HHDBJJJLLFJDH@FADAHEFGF@BB@@FFDFJJ@@HHJBJBLALERCJCB@FCJEFIDK@A@C@GBC@ADCLCDAFAJAHAJAHCHAH@JBHADAFAFANQLEBAJEJCHCHEFCFEFGBG@KIKGGIGEG@@BGDEBAHKFGBG@K@GAEECCAAAEC@EBGDEDCDCLCLATCFAFALCHCFEDAB@B@F@FBBBB@B@DADC@CBC@@@AAA@CAA@CBC@@BA@ADABAHEBAFCDADC@AAC@AAAACACAABABABCDEBE@A@CAE@EAA@A@AAAAACACACAC@C@CAAACAAAAA@AC@CC@@CA@C@@@@@C@C@ACCACCAAACACCCAEAC@@@@ABABAFADCDCBABEBE@A@ACAA@C@C@C@AAC@ACCACAACC@CCCACAC@A@C@ABC@AA@@CAEA@AC@A@CBEBIBEBC@ABABAFABC@A@A@AAACEC@AAAAACAC@C@C@ABC@KDC@EBEBCBC@C@C@CAAA@A@ABABADC@@ABAAAAAAAC@@AA@AA@A@AAAAA@AA@@@@AA@AAA@A@AAA@@A@@@@B@D@BCD@BAD@BBH@@@B@@CB@@ABA@@B@@AB@BAB@@A@@B@BCBA@@@@@@@AEBCAA@ABA@A@A@A@A@A@A@@@@@@A@AB@@A@A@AAA@@@EAC@C@@AA@@AAEAA@A@@@@@ABABA@A@CCC@@AA@CAA@C@@AA@A@A@@@@@C@A@@ACCA@C@@AA@@CB@@AAA@C@A@@BABABA@A@E@ABA@@@A@@@A@A@@@AA@@@@@@BA@AA@@@A@A@AAA@AAA@@@@A@A@A@A@A@@A@ACA@CCAAA@AA@@AA@A@A@@AAAA@AB@@@BA@A@@@AAA@@@C@EACAI@C@@@A@@@C@@@AA@@AA@C@CAE@EAA@@@@@AA@@BA@A@A@E@@@@BABC@@@A@@AAA@@@C@@@@@AA@@@AABA@A@@@AAC@@@@@AAAA@AICA@AA@@AAC@A@@@AA@@@A@@A@@@CBCBA@A@EA@@A@AB@@AA@@A@AA@@@AA@@C@@@AA@C@C@AAA@A@AA@@A@A@@@A@A@@@AA@@@A@AAE@AAA@@@@AE@C@C@ABA@@@AAA@@@ACA@@@A@E@A@A@@A@@@A@@@@@A@@@A@@@CBAA@@AAA@@@A@@AA@A@@@A@@A@@A@CAEBA@AB@@A@A@@@ABAB@@AFAB@@CACA@@CA@@AACE@A@AA@CCCA@A@@@AA@@AA@AA@A@@ABA@A@ABAB@@A@EAC@A@@@AA@@@AAA@@AACBE@C@@BA@@BA@@@A@@AA@@@A@A@A@ABCB@BA@@@@@@@AC@@@@@@CB@@@B@@@BB@A@@BAB@B@@A@A@A@@AEAAAAA@@@CA@@@AAAAAACAA@AACA@AAA@A@@JGBABABA@ABABEBE@@AAAA@@AAE@CA@@@@A@A@@B@@@B@@BBBB@@@BABAB@@A@@@A@AD@BA@@A@@A@AB@@A@A@A@A@C@@A@@A@A@@@A@ABA@A@@@C@A@AAA@AA@AA@@@AAA@AA@@@A@@@@@A@@B@DBB@@A@@DA@A@C@C@AB@@@DCB@@AA@AA@A@@A@AC@@AC@@EEACACCA@A@@@@A@A@@@A@@BA@@@C@@@A@@@@AB@BABAB@@A@ABABA@A@A@AEDEBABA@AB@BABA@ABC@CBAB@@@AA@B@@@@AB@@@@A@@@@BA@@B@@@@@@AABA@@AB@@@@@B@AA@@A@@@@@@A@@B@@@@@@AB@@@@@@ACAA@A@A@ABA@@@@E@@A@@AAA@@EC@@A@@C@A@@AAAAA@@@A@C@AAGCA@@B@@AD@B@@A@@BCAA@M@C@EBI@EAG@A@ABC@C@CBC@EDABCBAB@@AB@BA@ABADA@ADADABA@AB@@AAA@AAAA@A@A@@@AAA@AB@BA@@@A@@B@@A@@@C@A@A@@BAB@@AB@@@@@@AA@CCCA@AAAAA@A@@C@@@@BAB@B@@@@A@@A@@@@A@@@@BB@@B@BC@@B@@@@@B@@AB@B@@@@AB@@C@A@@@@@BBB@D@@@B@@B@@@@@@A@I@A@ABA@A@CAA@AAECAAECAAC@A@EAE@CA@@AAAA@AAAAA@CAA@@@@AAA@CBA@GDA@A@A@A@@@AA@@AC@AAA@ABA@A@C@AAAAE@AAAAAACCC@@@A@@@@DB@@@@BC@@B@ACBADABABA@@@@A@@A@@@@@AA@A@@AA@AAA@A@A@@@A@A@@@@BAB@@@@AB@BA@@@@@@BAB@B@@A@A@@@@BA@@@C@A@@@ABADAB@@AF@@AB@B@BA@A@ADAB@B@@A@C@@BABA@@BABAB@@A@@@@@ABABADABA@@B@@@@"],encodeOffsets:[[120419,29756]]}},{type:"Feature",id:"361129",properties:{name:"万年县",cp:[117.058445,28.694582],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@BA@C@A@@BAAA@AA@@@@ABA@@@@@A@@A@@AB@@@@@@@@A@BA@@@A@@@@AAA@@B@@A@@A@AB@A@@AA@@AB@@A@@@AA@@A@@@@@A@@@AC@@@AA@@@B@@@A@@A@ACACA@AAA@@@@ACA@@AAAA@@C@@@A@C@A@AABAA@@A@@@A@@AA@A@@@@@AAA@AAA@@@@@A@@@CACA@A@@@@A@@@A@@AAA@AA@@A@@A@@A@@@@A@@@@B@A@@@@@@AA@BA@@@@B@@@AA@@@@@@A@@@@@@@@B@@@@@@A@@@A@@@@@@@@B@@@@A@@@@B@@@@@B@AA@A@@@@A@@@@@AA@@@@@@@@BA@A@BAA@AAA@@@AA@@AAAA@A@A@@A@@AA@A@A@A@@@@A@CD@@AB@@@B@DAB@@@@@@B@B@@CBABA@A@B@@@B@@@@@@A@@@@B@@@@@@@@@BA@@@@B@@@@@@A@@@@@@B@@@@A@@@@B@@@@@@@BB@@@B@@@@@@@BA@@@A@@BA@@@B@@AB@@@B@@A@A@A@@@@AA@@@A@@@@@@@@@@BBBB@@@@BAA@@@@@@A@@AAA@@@@AA@@AB@BBB@@@B@@BBA@@@@@@A@@@AAD@@@@@AAA@AA@@@@@@B@BBB@@@B@@@@A@A@@@@A@@BA@@A@@@AB@B@@@@@@@@@@BC@@@A@@@@@@A@@AA@@B@B@BA@@D@@AA@@ABB@@@BBBA@@@@@@@@@@@B@@@@BB@@@A@@@B@@@@BB@@A@ABA@@BAB@@@@A@AB@B@B@@@@A@@@@BA@@F@B@D@@AB@BA@@@@B@@CB@BAB@B@@AB@@A@@@@@A@@@@B@@A@@B@@@BBBA@@BA@@AC@@A@A@@@@AB@@@A@@B@@AAA@@@@@@@@@@AB@@@@@A@@@@CB@B@@@@@A@@BA@@BA@@A@@@A@@@A@@B@B@B@@AB@@@A@@BC@A@@A@@@A@@D@@@@@A@@@@@AAB@@@A@@@A@A@@@A@@@C@AAG@@@@AB@@@@A@@@@@A@@@A@@EA@@A@A@@@@A@@BABBB@B@@@@B@@@A@@D@@@@@A@A@@A@@A@@@@@A@AA@@@@BA@@F@@@B@@@@@@B@@@@B@@@@@BAA@@@@@AA@@@@A@AAA@@A@@@@@@@A@@BA@@@B@@@B@@@@@@@AB@@@@B@@@BA@@@BA@@@B@@@@BA@@@@@@@@BB@@A@B@@@@@@A@@@@@A@@@@A@@@B@@@BA@@@A@EA@@A@@BAA@AA@@A@@@@@@A@@A@@A@@BAAAB@@@@@B@@A@@@@@@A@@@@A@@@@AA@@A@@AA@@A@A@@@A@@@@BAB@BA@@@@@A@@A@@ABCB@@@@AB@@AA@A@@A@@@A@@A@A@@AB@@@@A@@@B@@@@AB@AA@@@AB@B@BA@@C@EAA@@@@@@@@@BC@AA@@BABBBA@@B@@@@BB@@@@AA@@@B@@@@@@@@@@@@@AA@BA@ACC@@@@@B@B@B@@@@@A@@@AA@@@@B@@@B@@@BB@@B@@AA@@@B@@@@@@@@@@A@BA@@@@@A@@A@@DA@@@CB@AB@@@BABA@@@@@@@@A@@@A@A@DAB@B@@A@@@@AA@@@@B@B@@@A@A@@A@AA@@@@@@B@@@A@ABA@A@@@BC@@BB@@@A@@@A@@@BB@@@@@@@@A@@@@B@BB@@@@@@@@B@@@@A@AB@@@@@@@@@AB@@@@A@@@@@@A@@@@@@@@@BA@@@A@AA@@B@@@@@@@B@@@@@@A@@A@@@@AB@@@@BB@@@@A@@A@@@@@B@@AAABBB@B@@AA@@@@@B@BA@@@ABA@@B@@@@A@@BBB@@@B@AB@@B@BB@@B@@@@A@A@@@@@@B@B@@@@@@@BA@@B@B@@AD@@@@CB@B@@@B@@AA@A@A@@@@A@@BA@@B@@@@@@BA@ABAB@BA@@@@A@ABCBAB@B@@@@@B@@CB@B@B@@@BAB@@B@@@BA@A@@@@@@@B@@@@BA@@@B@@ABAB@B@@AB@@@BBB@@@@ABAB@B@BABABAD@@A@@@@B@@@@A@@B@@@B@@A@@@@B@B@@@@A@@@BAA@@@@@@B@@@@B@AB@B@@@B@BABABAB@@A@@B@@@B@@@@@AD@@AB@@@@@@BA@@@AB@@@@@B@@AB@@@@@A@A@@@B@@ABB@@@@@@@@@@B@@@B@@@@AB@@BD@@@@AB@@B@@@@B@@@@@@BA@@@@@B@@A@@BA@@@BB@@@B@@@B@@@@@B@BA@@B@@@@@@BB@BB@@B@@@@B@@@@@@@@@ABA@@@AB@B@@BD@@@@B@@BB@@@B@@@B@B@BA@B@@@@ABA@@@A@ADA@@BCB@@BB@@AB@@@BB@@B@@@B@@BB@@@@A@@@@@@@AB@B@@CB@BA@@B@@A@@@@B@@AB@@A@@@A@@@@@A@@@@@A@@@@@A@@AA@@AB@@@@@AA@@@@AB@@A@@@@@ABB@AB@@@@@B@@@BB@@@@@@@@B@@@B@@A@ABA@A@@@A@@@@B@@B@@@@@B@@@@B@@@B@BC@@B@@@B@@A@@@BB@@@BA@AB@@AB@@A@@@@B@BA@A@@@AB@@@B@@A@@@@@A@@B@@@@@A@@A@@@BB@@@@C@@B@@B@@@A@@@@@@BA@@@ACA@@@@@@@A@@A@@@B@@@@@B@@AB@@@A@@@A@@@@@@A@@@@@@AA@@B@@@BB@@@B@@B@@A@@@@@@@@B@@A@@@AA@@@@@@@AA@@@AAAB@@A@A@@BC@@@A@@B@@AA@B@@A@ABB@A@AB@@A@@@@BA@@BA@AB@@ADAB@B@BB@@B@@@@@@@@A@@@A@@B@@BBB@@B@@BDB@@@@DA@@B@@@B@B@@@@@BB@@ABABB@AB@@@@B@@@@@@BA@@D@@@@BA@@B@@B@@@@A@AB@@@@B@@BABBB@@@B@@@@@AB@@@@A@B@@@A@AB@@BB@BB@B@@@@@@@@B@@B@@@@@@DA@@@A@@B@B@@@@@@BB@@@@B@B@@@@B@@@B@@@@@@@@BA@@@@B@@B@@@@A@@B@@@@@B@B@@@@@@B@@@@@@@@@A@@BB@@@BA@@@@@B@@AB@B@DAB@@@BB@@@BBA@@@B@@@@@@BB@@@@@@@@@A@AB@B@@B@B@@@@@@@@BA@A@@B@@B@@BA@@@AB@BAB@@B@@@@@BA@@@@@@@@@B@@@@@BCDA@AB@@B@@@@@B@BAB@BB@@@@A@@@@@ABA@@BAB@BAB@BA@@@A@BB@@@B@@@B@@A@A@@B@@@B@@@@@@@B@@A@@@CB@BA@@A@@@@@BAB@@@@BB@@@@@B@BA@@@A@A@@@@@AB@@B@@@@@@@B@@@@@B@@@@B@@A@@@BB@B@@@@@@AA@@@BA@@@A@@@@@@@FB@B@@@@B@@@@A@@B@@@@B@@@B@B@@@@@B@@B@@DB@@@@@B@FBBBB@@A@AB@@@@A@@@@BA@A@@BA@A@@B@D@B@@BB@B@B@@A@@B@@@@B@@@B@@@@B@@@AHBD@B@@@@@B@@AB@@Cb@B@B@B@@@@AB@DAB@@A@@@BA@@BA@A@@@A@@@@@@AB@@@@@@@BAD@@@B@@B@@@@B@BAB@@@BA@@@@@@@@@@AABAA@@@A@@A@AA@@B@@A@@AB@@A@@A@@@@@@C@@@AB@B@@A@@@@@@@@C@@@A@@@@A@@@@BA@@@@A@@ABAB@@A@@@EDABABA@@@A@AA@@@@A@@@ABA@@AA@@@AAA@ABA@A@@@C@@A@@AB@@A@@@@@@@@@A@B@A@@@@AA@@@@@@BAB@HAB@B@@@@DB@B@@@@AD@@BBB@@B@@C@@@AD@BA@A@ABE@A@C@C@@B@@AB@@BB@@@@@B@@@@@B@@A@@@A@@@@B@AA@@@@@A@CDABA@AB@@AB@@@BA@AB@@A@@@A@@@@@A@AD@@A@@@@@A@B@@@@@BBA@@B@@@@B@@@@@@A@@B@@AB@@BB@@@@B@B@BB@DDBB@BBB@@A@@B@@@D@@@B@@A@@@@A@@AB@@@@@@@@@@@BBBCD@@@B@DBDB@A@@BA@@@A@@@BB@@@B@@@@@@B@@@@@@@@@A@A@@BA@@B@@AAB@@@@AB@@A@@A@A@@@@@@@A@@@@@A@AA@A@AB@@A@@B@@@@@@A@@@@B@@@AA@A@AA@@B@BCD@BA@BBAB@@@B@@B@@@BB@B@@@B@@@@@B@BB@@@@@B@@@AB@@@@@@B@@A@@BA@ABBBBB@@A@@@@@@@@BAB@@A@@@@B@@@B@@@@AB@@AB@@A@@@AA@@@@@@BC@@B@@@@@ABAB@@A@@@@AA@@@@A@@@@@BA@@@@@@@@@A@@B@@@@@@@@AA@@@DC@@@BB@BB@@@BBB@BB@@@BB@@B@@BBD@@BB@@DB@@DAB@@@BBB@@@B@B@B@@@BA@@@@B@@@BBFB@@@@@@AD@@BB@@@@BBDABAB@D@BA@@FBBB@@D@FAB@B@B@BABBDBB@@@@@@DAB@BAD@@D@ABB@D@@@@@BBABB@@B@@BB@@@D@B@B@@A@@@@BB@@B@@@@C@ABA@A@@@A@AB@@@@@@B@@@B@@@@@@B@@A@@B@@@@@@ABCACB@@AB@@@B@@@BBB@@A@@@A@A@@@@@@B@B@@AB@@@@A@@@@@A@@@A@B@@@ABA@@B@@@BB@@@BB@B@@A@BB@@@@CAA@@@AB@@@@BD@@@B@@A@@@@B@@@@A@@@@BA@B@@@B@BB@@B@@BAB@@@@A@@B@@A@AB@BA@@B@@@@B@@@B@@@B@@B@@DA@@@@B@ABBB@@BB@BB@@BB@B@@B@BA@@AA@@B@@@@AB@@B@@@@B@B@@@@@AA@@@@@A@@BADAD@@@@BB@@@BB@A@@@@@A@@@A@AB@@@BBB@@@@A@@@@A@@A@@@@B@@@@@@@B@@A@@@A@@B@@A@@A@@B@@A@@BA@@B@@@AA@@@@ABAB@@@B@BAB@BAA@A@@@@@@@B@BB@B@B@@@@@ABAB@@A@@@@AAB@@@BA@@@@F@B@BAFA@@@BB@B@@ABB@B@@@@BBB@D@@A@@B@@A@A@@BBB@B@@@@@@DAD@B@B@DCB@B@BAFCFCDABAB@DAB@D@F@DBD@NBD@BAB@B@B@DCBABCDC@CBC@EAA@CAAAE@C@CBC@CBABADCBALAHAF@H@JADBD@BBB@BB@@BD@BBDBF@BBDB@DDBBFDB@DBB@B@HBD@B@@AF@BAB@@ANEDABABADCDCBABADEB@@AFEDA@ABABABAD@D@D@D@FBFBBBB@BBDBBB@@BDBB@B@B@D@BA@ABCBGFABC@CDADABAB@B@B@B@BBD@@DDDDDH@@D@FA@AAA@AAA@ABA@@@@FABA@@DCB@@@B@@@@B@@B@ABBF@B@BB@@@B@B@BA@@BA@A@@@@AA@A@CAE@A@A@C@A@@D@DBB@@ABABA@@@@@@AA@@BAB@@AB@@@@@@@B@@@DA@@@@BB@@@@C@@@@B@@BB@@AB@@@BB@B@BB@@@@BB@@@BB@@@@AB@@@@@BB@@@@@A@@@@@@B@@@@@@@@@@@B@BA@@@@@AA@@@@B@@A@@@@A@A@@@@B@@@@@@AA@@@A@@A@@B@@@@C@ABA@A@@D@D@B@B@@@D@DB@@@@@A@@A@A@@AA@@@@@@A@@BAB@B@BAB@@@B@@@@@@B@B@@B@@@@@@C@A@@@AA@@@AAAA@A@AB@@@B@BBB@AD@@@@BA@A@@@@@@@A@@@@@@AA@@@@@@@AB@B@@A@@@@@@@@@@A@@@@@AA@@@BAB@@A@@@@@A@@AB@B@@@@@C@@@@@A@@@@@@@B@@AD@@BB@@@@ABC@ABB@@@@@@B@B@@@@D@A@A@@B@D@B@@@@A@@@C@AB@@AA@@A@@BBB@@@BA@A@AB@BB@@A@@@@@@@@@@@B@@BA@@@B@@@@B@@@@D@@@@@DABAB@@ABA@@@AB@BABA@@A@@@A@@@BA@@BA@@@A@@@@A@@@@BA@@@@@BA@@@@A@B@@AB@@@@@@@@ABA@@B@@@A@@@A@@@@@BAA@@AB@@@@@B@A@BA@@A@AA@@B@@@@@@@@A@@@@@A@@@@@B@@B@@@@@@A@A@@@@@B@@@B@@B@@@@A@@@@@A@@@@BB@@@BA@@B@@@B@AB@@ABA@@@A@@@@@@@@@@B@@A@@@@@B@@@@@@B@@AA@AADA@@@@B@@A@A@@BABA@CF@@@@A@@@@@@@AA@AA@@@@BC@@AA@@@A@@B@B@@B@AB@@@@@BBB@@B@AB@@@@B@@@@AB@@BD@@@AD@@A@@BBB@AB@@@@BBA@BB@@@@BAB@@@@@@@@@AB@@A@@@BB@@BAB@@@@@@AB@@BA@@B@B@@A@@@@@@@@@BB@@BC@AB@BAB@@BDABB@@@@@BED@@@@@@@@A@@B@B@@@@B@@@B@@@@@DA@AB@B@BBB@BB@B@@BAD@@@@@@AB@@@DA@@DA@@@@BBB@B@@@BA@@B@@BB@@@@@@@@@B@BA@AC@@@@ABABB@A@@@C@@@@@@@A@@@@@A@@BA@@@A@@BA@@BA@@B@@A@@@@B@@@@@@BB@A@@B@@B@@B@@D@BB@@@@@ABB@ABA@@@@@@BB@@@@A@@B@@@@B@@@BA@A@A@@@@@AB@@AD@@@DBF@B@B@DABA@@B@@@BBBBB@BB@@B@@@BA@@@AB@B@BA@@@ADABABA@@CAAAB@@@@AAABAB@@A@@@@AA@A@@@@A@AA@@@AA@@@DAB@@@@A@A@A@@A@@BAB@@@@@BA@@AA@@A@@@@@@B@@@BA@@B@B@@@BA@BB@@@B@@B@BB@@D@@@B@@@DB@@A@@@@@@@@@@@A@@@A@@@@@@@BBB@@B@@@@@@@A@@@@@@BBBBB@@@@B@B@B@@@@BB@@@B@@@@@@@@@C@A@@@@AA@BBBBB@A@@@A@@DB@@@@D@@B@@@B@BB@@@@@@@BA@@@BB@@@@BA@@D@B@@@@@@BAB@@@@AB@@B@@@D@B@@BB@@A@A@C@ABA@AAA@@@E@@@@@AC@A@@@@@@@@AA@AAA@@AA@@@@A@@@AB@@@@BBBB@@@B@@BB@@@@@B@@@B@@@B@@A@@B@AAAB@@@A@@@@BA@@@@@@A@@@AB@@@@@A@@@A@@BADAB@BA@@@B@@@@@BB@@@@@@AB@@A@@@C@@@A@@@A@@@@B@@@B@@@@@@@@@@@A@@@@A@@@@@@AB@@@@AA@@B@@@BA@@@@BB@@B@@A@@@@@@B@@B@B@@BD@@@@@@@@@B@@B@@BBB@@@BB@@@B@@@@B@@B@@ABABAB@B@@ABA@@BA@@@A@@B@B@@@@@B@AAB@@@@@@@@@@@@AB@@B@@BAB@@A@@D@B@B@@A@A@AB@@@@E@@@@A@AA@@@A@A@@@C@@@@@@@@@AA@@@@@@@@@@@A@BAAA@A@@@AB@B@@A@@B@@BB@@B@@AB@@BBB@BB@A@A@@@AA@@@@ABABA@@@@@@A@A@A@A@A@@@@A@A@A@ABAB@DB@@BA@@DCBAB@@CBABCBA@A@@B@B@B@@@@@D@@@@@@@B@@@@@BA@@@B@@BB@@@@@@@B@@BB@@@@AB@B@B@@DC@B@B@@FAB@B@BAB@@A@@@A@A@@BAB@BA@@@@B@BBBA@@@@BB@B@@BB@B@@BA@@@@@@@@B@@B@@@@BBB@BBBB@@BA@@FBB@@@@@@@B@@@@@BB@@@B@@B@@@B@B@B@@@B@@A@A@ABA@AD@@@DA@@@@@@@@@B@B@@@B@A@A@@@@B@BBBBBB@@BAB@@A@@@@@A@@A@A@@@A@AB@@AA@@@AAA@@B@@ABB@BD@B@B@B@B@@A@CB@@AB@B@@A@@@@A@@@A@@@@@B@@ABA@@B@@@@A@@@@AAA@BA@@@@@AAA@@@AB@@A@@@AA@@AA@@A@@@AB@BA@@@@AAB@@@B@BA@@@A@AB@@@BA@@BA@@B@B@B@DA@@B@B@@A@@@@BEB@@A@A@@BAB@DA@@@CBA@A@A@@@A@C@@B@@@CAA@@AA@A@@@A@@BA@A@@A@A@@B@@C@@C@@@C@C@A@AAA@@@@@A@@AA@@B@@@@@B@B@@@B@@AD@@A@ABA@@@A@@@@@@@AA@BA@@@A@A@@B@@A@AAA@A@AA@@BA@@A@@A@@A@@@@@A@@@@AA@@A@@@A@AA@B@DA@@BA@@@AA@@A@AAAABA@@@A@@@@@@@@@A@@@AD@B@@AA@@@A@A@@@A@@B@@@B@@A@@BA@A@@@@B@@@B@@@@@BBB@BB@@@@B@@@@A@AAA@@B@@@B@B@@@B@@@B@B@@@AA@@@AAAA@@A@A@AAABA@A@@@A@BC@@BA@@@A@@BA@@@AAAAA@A@@@A@@@A@@@@AA@@A@@@A@@@A@ABA@@@A@AB@@A@@D@@BB@BA@@B@@@B@BAB@BBB@@@@@BAB@@@@@B@B@BBB@@@BABABABABA@A@@@A@A@ABAB@@@@A@@B@B@@@B@@AB@@A@A@@A@AAC@@@C@A@ABAB@@A@A@@@CA@@AB@@@A@A@@B@AC@@@C@A@A@@@@@E@A@CA@@@AA@@@@@AB@@@BA@A@@@ABA@A@A@A@@AA@@BA@@BA@A@A@@BAD@@@B@BB@@@AA@@AA@AAAA@@CA@@CAA@AA@@A@@@A@@@AA@@@B@@BDB@BBB@BBB@B@@BB@@@@@C@A@A@EB@@AA@@A@A@A@A@@@AAAA@@A@A@@@@@@ABC@@BA@AB@@C@@AA@@@A@A@@BA@@@A@A@@AA@A@A@@A@@@@@A@@AA@@A@@@A@@@A@A@A@AAC@@@A@@A@@AA@@@AA@@@@@@@AA@@@BACAA@@@AB@@A@@@@@@A@@AAA@@@A@@@@@@A@@@BADAB@@@BAB@@@B@@AA@@@A@CBA@A@@AAA@@@@@@@CA@@@A@@@AA@A@@@AAG@@@A@@@CC@@CAA@@@A@@@@@A@@@AAAAA@@@@@A@B@@C@@AAA@AA@@AA@@@@AA@@A@@@AAA@A@A@@@A@A@@AA@@AA@@AA@@@@A@@@@A@@AA@@@A@@@@@@@@@@D@@A@@B@@AAA@@@B@@CBA@@AA@@AA@@@@@@A@@@AA@@@@A@A@AAAAA@@A@@CA@BA@@A@@A@@A@@@@A@@@@@A@@B@@GAA@A@OF@@@BA@A@@@@BA@@@A@A@@@AB@BB@@@B@@@@@@A@@@B@@B@@@B@@A@@B@@@@@B@@@@@@@@@BBA@@@@@A@@@A@@@@@A@BBAAC@@@@@A@@B@@@@@@@@A@@@@@@B@@@@@A@@@A@@@@A@@@@BAB@@BB@@@@@@A@@A@@AA@@@A@@A@@@@BBB@@BD@@B@@B@@@@@BAB@@@@@@@B@@@@ABA@A@@BA@@A@@CA@@A@A@@@@B@@@BAB@B@@C@ABA@AB@@@@@AAC@@@A@@A@@@A@@@@@ABAB@@@@A@A@@BA@@BA@@@A@@@A@@@@BABA@@@@@AAA@@@@@@AABA@ABA@AB@@@BA@@@@BA@@B@@AA@@A@A@@@A@@BC@ABCDA@ABA@@B@BAF@B@@@A@@@EBA@@A@@@@F@@A@@A@@@@@A@@@@@@AAB@@@@A@@@@A@@D@B@B@@@B@@@@@B@B@@A@@@@@A@@B@@@@@@BB@@BA@@@@@B@@A@@@A@@@@@A@@@@@@B@@@B@@@@@A@@A@A@@@@@@A@C@@@@A@@D@@AD@@@@@@@@@BA@@@@@@@@A@@@@@@AB@@@B@@A@@@AAA@@@A@@BA@A@@@A@@@@AA@ABCA@B@B@@A@CBA@@AA@@AAAAA@@A@@@@A@@AA@@AA@@AA@A@ABAA@AAA@AB@A@@@@A@A@A@AC@@CBA@@A@@@A@A@ADA@A@@@AB@B@@AD@BABC@@@CBA@@AA@@@B@@@C@@A@@@@@A@@@@@@@@@B@@A@@@@@@B@@@@@@A@@A@@@@@B@@@@@BC@ABE@AD@BB@@BAAC@C@A@A@A@A@@@@A@@@BA@A@@"],encodeOffsets:[[119863,29247]]}},{type:"Feature",id:"361181",properties:{name:"德兴市",cp:[117.578713,28.946464],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AA@A@@@@AAAAA@@@@CB@ACCA@@AAAA@@AA@A@@@@@C@@AA@@AA@@AAAA@@AC@@@CA@@@@@AA@A@AACAA@@AABAAABA@CB@BA@AAA@@@A@E@A@A@@@AA@AA@@BA@@@A@A@A@A@@AA@@@@@A@AA@@@BCB@AA@@@@D@@@@AB@@@BAB@BAB@@@BAB@@@BABA@@BA@@B@@A@@@@BA@@BAB@D@@AB@B@B@B@@@B@B@B@@@B@@B@B@@BA@@BC@@BC@ABC@A@@BA@ABABA@@BEH@@@B@@ABA@A@@@BB@BA@ADA@AB@@@BA@@B@@@D@@@B@B@B@@A@@@@B@@AB@B@B@@AB@@@B@@B@@@@B@@B@B@@BB@@@@BA@@@AB@B@BA@BB@BAB@BAB@BA@A@ABA@@B@@@B@B@@CB@B@@ABA@ABA@@@AA@@@@AB@@A@CAABA@@@A@AB@@ABED@@AB@@AB@@C@@@@BB@@@BB@@@@B@@@DF@@BB@BBB@@BBB@@B@@@BDDBBB@@BBB@D@B@BBBABA@@B@B@@@B@@@B@B@@AD@BABCBA@@@BB@@@BB@BD@@BB@@@@B@@BDDBB@@@B@@BB@@@@AB@@@@BBB@@@AB@B@@AD@@@@A@A@@@@@A@@@@@BB@B@B@@@@@BB@@@B@@B@@@@@B@@@B@B@BB@@@BB@@@B@@@@@@BBA@@B@@A@@@@@A@@B@@@@@B@@@B@@@BB@BD@@@@@@@@AB@B@@A@A@@@@@@@@B@@@B@@@B@B@B@@@B@@@@BB@@@B@@@B@@AB@@@@@B@@@@C@@@@BB@BB@@@@BBBB@@BBBB@@@@@@ABA@@@A@@BA@@D@@@@AB@@AB@@@@@@@@CA@@@@A@A@AB@BA@@B@BA@@B@@@@@B@B@@@B@@@@BBB@@@ABB@@@@BA@@B@@AB@B@BB@@B@BB@@@@D@@BB@@@@@@B@@AB@@@BAB@D@@@BBB@B@BA@@@CB@@@BADA@@B@B@D@@@B@@@@@B@BB@@B@BAB@@BB@@@B@DBDB@BB@@@@@B@@@@@B@B@@BA@@BA@ABA@@@@@@BB@@BB@@BB@B@B@@@BA@@@@BB@@B@@@B@@@@@B@@BB@@@@A@@B@@@@@@@B@@B@@B@@B@@@@@@B@@@BABAB@@@BBB@@@B@B@@@DD@B@@@@B@BB@@B@BBB@BB@@BABA@@B@@@@B@@@@@B@@@BB@@@DA@@AA@@BAB@@A@A@@@AB@@@@AB@@@B@@BB@@@BA@@BA@@B@@@@@B@@@B@@@BA@@@@@A@@@AB@@BBA@@@@BA@A@AB@@@B@@@@@BB@@B@BB@@@@B@B@@AB@@BB@@@@A@@BBB@@AB@@@B@@@B@@BB@D@D@@@@@@AB@@@B@B@@@B@@@BA@@B@@@@@@@@@A@@A@@BA@@B@B@@A@@@@@B@@BAB@@@@B@@B@@@BB@@@B@@B@@@@A@A@@@@D@@@B@@@@@B@@B@AB@@@D@@@@BB@@AB@@@AA@@@A@@B@@@BA@@BA@@@@@A@A@@@A@@@@B@B@@@@@BA@@AA@@AA@@@A@@B@@@BDBB@@@@@A@@A@@@@A@@@A@@@AB@@@B@@@B@@A@A@A@@@A@@CAA@@A@@@AA@B@@@BB@@@@BA@@@@@@@@BBBB@B@@B@BBB@AA@A@@@@B@B@@@B@@@B@@B@@B@B@@@@@@@@@B@BB@@@@B@@A@@B@@B@@@@BBB@@@B@BB@@@@BC@@B@@@B@@@@@@@BA@AB@@@@@B@@A@@@ABA@@B@@@@@B@@@@@@@@@B@B@@BB@@B@@@BB@@BB@@@@D@BABBBA@@B@@@BC@@@A@@@A@A@@@@A@A@@B@@@@A@@@@A@@@A@@@@B@@@B@B@@@B@@@B@B@B@@@@BB@B@@BB@@@BA@@B@@@AA@AAA@@@@@@@@BBB@BA@@@@BDB@@@BA@@@BB@@@BA@@@@BB@@@@@AB@@@B@@@@BB@@@@B@@@@B@B@@@B@@@@@@@@@BA@@@ABA@ABA@AB@B@@@B@@@BABBB@D@@@B@@B@@@BB@@B@@@BB@@BB@@@@@@@@@D@B@@BBA@BB@@@A@@@B@@B@@@@B@@@B@BBB@@@@B@@@B@@@BBB@@DBB@@@@AD@B@B@@@@@BBB@@@D@BBB@B@@B@@D@@@B@@@@BB@@@@@@@BA@@@@B@@@@@@@B@@@B@@@@@@AB@@AB@B@@@@@BB@@BBB@@BB@@@BB@B@@@DB@@@B@@B@@@BB@@@BA@@BA@@BA@ABA@A@ABA@@AA@@A@@A@@@@@E@@@A@A@A@A@ABA@@@@@@BA@A@@@ABA@@@@B@B@@CBCDAAA@@@@@A@C@@@@B@@@@@@C@@@BD@@@B@BD@B@BB@@DA@@BBB@@@@@@B@@A@@B@@@@@BA@@B@@@@AA@@@@@@A@@@CB@@AA@@@@@@@@A@@A@@@@@@@@A@@@@@@B@@AB@@@B@@@DB@@@@@@@AB@B@AA@@A@B@@@BBB@@A@@@A@@@ABA@A@@@@AA@@@@@@BA@@@@@@@@A@@@@AA@AA@@@@@C@@@@B@B@@@@A@@BA@@@AB@BA@@B@@B@@BB@@BA@BB@@AD@@ABAB@@@@@@@@A@@@A@@B@@AB@@A@ABA@A@A@@@ABABC@@@@B@@B@@B@BAB@@@B@@BB@@@@EB@@A@A@@@A@@@@@AB@@@B@B@BAH@@@B@BBFBB@@A@A@@@AB@@@BA@@@AB@@AB@B@BA@@@@@A@A@@@@@ABAA@@@BABA@@@AA@@A@@@@A@@@@A@@@A@AA@@@@@@A@@@@AA@A@AAA@@@AB@@A@@@ABAB@@@B@@A@@@@@@BA@@@A@@@@B@@@@ABA@@@@@A@@BA@@@AB@BA@A@A@AB@@@B@@BBB@@B@@@@B@BB@AB@@@B@B@BB@@BB@@@@D@BBB@B@@@B@@@@@B@@@B@@B@@@@BA@@B@@@@@@@B@@A@@@@B@@@@@B@@ABBB@@B@@A@AB@@BB@@B@@@@B@@@@BB@A@@B@@@@@@@@@ABAB@@@B@@BA@@BBB@@@@@@BBA@B@@B@B@BAB@@@@@BB@BB@@BBB@@BB@@@@@@B@DA@AB@@B@@B@@B@B@@@@BB@@@B@@@@C@@@ABA@@@A@@B@@A@AB@@@@BFBB@B@@@@BB@B@@@B@@@B@@A@AB@BA@AB@BA@A@A@@@AAA@@@A@@@@BA@@@BB@@@B@@BD@@AB@@@@@B@@@BAB@@@@@B@@@AAB@@A@@B@@B@ABB@@@@@BBBBB@D@@@@@BB@@@B@B@B@@B@D@D@B@@@B@@B@DB@BBA@B@@@CB@B@@CBABA@@@AB@@ABB@@@B@B@@@B@B@@B@BBBB@@@BA@@@@@B@B@@B@ADABA@@@@B@@@B@B@@DBB@B@BB@@BB@@D@B@@@@@@@@B@D@@@B@@@BBBB@@BB@B@@@B@@AD@@AB@@@DB@@@AB@@@B@B@BB@B@@BAB@B@@@@BB@B@@BB@@B@BB@B@@@BBB@BB@BBB@B@@BB@@B@FA@@@A@@@C@A@ABADADABA@A@@@C@ABA@@BABC@@BAB@@@B@B@@ABABA@@@@BAB@@@B@@BBB@@@@D@B@B@@@@@@A@C@@@A@@DA@ADCBABAD@BAB@BAB@B@B@B@BAB@BA@AB@B@BA@@@A@@@AAA@CAC@@@A@@B@B@BBB@DBBBDB@BBDBBBD@BBBBDBB@@BB@@BBABAB@@@BABBN@@BD@@@D@@BBBB@B@B@B@@BBBB@BBD@@@BB@@@@@@@@AB@BABCBABA@@@@B@@@BABA@AB@BA@AD@B@@@@ABABCB@B@@A@ABA@@BE@@BABCBA@@B@D@@@B@@A@@B@B@@@@ABA@A@@@@B@@ABA@@@@BA@A@@B@B@@@B@B@B@BBB@@BB@B@@B@@@@@BB@@@@@ABABCBABBDA@@@AD@@@@CA@@@@@B@@DDBDBBBB@BBFBB@B@D@BAB@BBB@BBD@@BB@@B@B@B@B@HCB@DAB@BB@@@@BB@DBBBB@BBBBB@@DBF@FBB@D@BBFDBBFDBBB@DBB@B@BAB@J@B@@@@@@@@AA@@@C@A@AA@@@@B@D@@@BA@@@@@ABA@@@A@@@@@AD@@A@AA@@A@@B@@@@@@BB@@@@@@ABA@A@@D@@@@BBBBB@BDBDDB@@B@@@@A@@BA@AB@@@B@B@D@@@BA@@@@B@@ABA@@BBB@B@@@B@BBBBBB@BB@@BAB@BABCBCB@BCBAB@@ABA@@BADABAFCD@DAD@D@BAB@H@FBJ@FAD@N@B@DB@AB@@@@ABC@@@AB@HDBBD@B@@@B@BBBB@@@B@DB@@@FD@@BB@BB@@@@F@@B@BAB@B@B@DB@B@@@@A@@B@@@@A@@@@B@@@@B@@@BBA@@@@@A@@BB@BA@B@@@@A@@@AB@@@@@B@@A@@B@@A@B@@B@@BADAD@BAB@BA@ABAB@BAFAFC@B@B@BABAB@B@BA@ABABA@@B@@B@@@D@@@B@@AB@@@B@B@@@@@@BDBBDBDFF@@BD@@BDB@@@@BBBB@@BA@CD@@A@@B@D@D@BCB@@@BA@CAA@@@@B@@@@@B@@BBB@BB@@B@@BBBB@BBB@D@@@B@B@BAB@@@B@B@@@@BD@B@B@B@B@@@BAB@@@@BB@@ABCB@@@B@@@BABA@A@@AAAA@@@A@@@AB@B@@@@@DBF@BB@@BBBB@@AFAFAB@BABABABIH@@@BBB@BDBBBB@DBBBBBBB@@B@@D@@BBBBFB@BB@B@B@@@@ABA@AB@A@@A@@@A@@DA@@@@@@BD@@@@@@B@@ADABAB@B@B@@@B@@BB@@@B@@AB@@AD@F@DABB@@BB@B@@BB@@B@D@FBB@@@BABAB@B@BA@@@BBBB@@BB@@B@@@BDBDDB@@B@BDFBB@@DB@@DBDB@@BABE@@BABA@@B@B@@@BAB@FADBB@@@@BB@@@B@B@@BB@@@B@BB@@BBDA@@B@@@B@@@@@B@@@B@@@@B@B@F@B@@DB@B@@BB@B@@AB@B@D@DBF@@@@BB@BBF@B@B@@BB@@B@B@@@B@B@@@BBB@B@BBD@D@B@@B@@@DB@@B@@BBB@@@BB@@BAB@@@FBB@B@DADA@@B@@@@B@@BB@@B@D@BB@@BBB@JD@BBBBB@@@@D@BB@@B@B@BA@B@@BB@@@@D@@@B@BB@@@B@@ADAB@@@@@F@B@BAB@@BB@@@@B@FBF@DBD@B@@BB@@B@@@D@@@B@@@DBJBD@F@D@@BB@B@@@BAB@@A@@BBBBB@@@B@BBB@@BBB@BBDDB@BDB@@@@B@B@B@B@B@@B@BBB@BBB@B@@@B@@BAB@@@@@@BB@@B@B@@@B@@@B@BAF@B@B@BABA@AB@D@B@BB@@DA@@BB@@@DDBBD@@@B@D@@@@@B@BBB@@@DBB@DBB@@DD@D@BABAB@B@@@@@BBBBF@BB@@BD@D@FB@@B@BBB@B@@@BAB@@@@@@@@B@B@B@B@B@BAB@BBBADBF@@@@@@B@DA@A@AB@@@BA@ABA@@@AB@BA@@DA@@@A@@AG@ABC@ADC@A@C@A@@B@@@BB@B@BBB@BBB@@@@BBB@BBBBB@B@@BBB@@BDBBBBBBBAB@BE@ABCAA@E@C@C@AB@BABA@AAAAAAC@A@A@EAE@CAE@A@ABABADAB@BAAA@AACBEB@@ABAAAAAAAAEAAAC@AAAACAA@C@AAAC@A@C@EAA@C@C@CACBA@CBABA@A@A@CCEC@CA@AA@AAC@CAA@A@A@@@@@A@A@A@@@@@C@@AAC@@AAA@A@C@AAC@EA@@C@CAE@CAAACACAAACAABCBAB@BAD@DABADAB@DABADC@C@CAABA@CBA@@AAAA@A@A@C@C@EAC@AAAACCG@@@A@ADABADCFCD@FEDA@C@A@@AAA@CCA@E@CAA@@AAACEACAA@C@A@CDEB@@@@ADABABABCD@FAD@DADAFCBABAD@BAH@DABABADADC@A@A@EAA@A@ABABC@@@A@@AA@@AAAAAACCCCAAA@@AAA@CBC@@BADCD@@ABAA@@C@C@AD@DAD@DADADCBC@@@@BAFA@CBEBA@C@C@C@G@C@CBABEBEBCDCC@A@A@C@A@C@EBAAA@E@A@C@C@A@C@C@AAA@ACCCAAAAEACBA@CBC@AAAAACAAACACGAEAC@CAECA@AA@A@CACC@@A@AA@@@@A@A@A@A@AE@A@CAAAA@CAGCAGDCAEECECCCAACCFABA@G@C@CBE@CAC@@@@C@A@@AC@ABC@A@@@A@A@A@A@A@@AA@A@@@AD@@@@AAA@A@A@A@@B@@C@C@CAABA@@BC@@@@@@@A@BA@A@@B@@@@A@A@A@@@ABA@AB@B@BC@@DA@A@A@@AA@@@@C@ABA@ECA@C@A@AB@@AB@@@@A@@@@AB@@A@AA@@A@A@AAA@ACBC@A@@@C@ABC@@@AA@@AA@@BC@@A@@@@@A@@@A@@@@A@@AAAABA@AB@DC@@BAB@@A@A@@@AAA@@@A@@@A@@A@AA@@AB@@@@@@@@A@@@@A@@AB@@AD@@AB@B@@@BBB@@@BA@A@@AA@@A@@@AA@@@@@A@BA@AAA@A@A@@A@A@@@@BA@A@A@@B@@A@@BA@@@@B@@B@@@@B@BDBDB@B@@A@@@@@@@AB@@A@A@G@C@AD@@CB@@ABAB@B@@CB@@@B@@ADA@A@A@@@@B@@A@AB@@@B@@A@A@A@C@@@AAAAA@AAA@A@A@A@CBA@@@@@AD@@@@A@@@@@AA@@@B@@BB@@@@BB@@@B@@@B@B@@@B@@@B@BA@ABAB@BA@@BA@@B@@@@AAAAA@@AA@AAA@@@A@@@A@@@@@A@@@A@@A@A@@@A@@@A@@BA@@BA@@@A@A@@@A@@A@@@A@A@@@C@@AA@AB@BA@@BAB@@@@A@A@@@@@AA@@A@@@A@CAA@A@@AA@AA@@A@AAA@@@@A@@@@@A@@@C@@@AA@@@AB@@@@AAC@@@A@@@A@AA@AC@@@BA@@BBB@@ABA@@@@BBB@BAB@@A@@BA@A@B@@BD@@@B@@@@B@@@BA@A@@@A@AAA@C@A@@D@B@B@@@B@@B@BBD@B@@DB@@@B@BBB@@D@@B@@BA@A@A@A@@@A@@@A@A@A@A@A@@@A@@BAB@BA@@BA@A@@BAB@BA@@BB@@B@BA@BD@BB@@BA@@@A@A@@AAAA@@B@@@B@@@@@@B@ABB@@@@A@B@BB@@B@@@BB@@@@BAA@@A@@@@@A@@@@@@A@AB@A@@@C@A@@@@BBD@B@@@@A@@DA@A@@@@DABC@A@@B@@A@@@@A@@@@@AAA@@@@@A@AA@@@A@@@@@AB@B@B@@A@@DABABAB@@ABABB@BBF@BB@@@@ABA@BB@BB@AB@@@@A@AAAAA@@B@BA@@BB@@@A@@@A@@B@@@@AB@B@AA@A@A@@BC@@B@@AB@@@@C@@@@@@@A@A@A@@@A@@@A@@@@@A@@@A@@@A@@@A@@@A@@A@@@BA@A@@@@@@AA@@@A@@@@@AAA@A@A@@@@@A@@@A@A@C@@@C@@AA@@A@@@A@@@@@AAAA@@@@AA@@AB@@CA@@@A@AA@@A@@A@AA@AA@@@A@CB@@AAA@ABC@A@@BA@ABA@@AA@@@@@@BA@EBA@@BAA@@A@C@A@A@@A@A@@@A@@AA@@AA@@AA@A@A@@BA@A@A@@AA@@@@@A@A@@BC@@CCC@A@A@@@A@@BAAAAA@@@A@@A@@AAA@A@C@@BA@A@A@A@@@A@A@@@CBABCBA@ABA@@@A@@BA@@AC@@@@@A@@@A@ABAA@BA@BD@@@@@B@B@@A@@B@@ABA@A@@BA@@BA@@@@@A@@ACA@@C@@@@A@@CBAB@@@@AB@B@@@@@B@@A@A@A@@@A@@@A@@AA@A@A@ABABA@A@ABAA@@AB@B@@AB@AAA@@@A@@A@@A@@A@@@@@@@A@@@@@A@@BA@@BA@AB@@@BE@ABCBCB@@@@AA@@@A@@AAA@AC@@AA@@A@@@CA@@E@A@AA@@ABC@AB@@EC@@A@@AA@@@A@A@@@AAA@@@@BA@@B@BA@AB@BA@@DBB@B@@@@@BA@@B@@A@ABA@@@A@AAA@@@A@A@A@ABA@@BA@@@A@@B@@A@AA@@AB@@A@A@@@A@@@ABABC@CACC@@AAA@AAAACAA@AA@AA@@@@@@AA@@@@@BAAA@@AA@@@@@AB@@A@@A@A@@@@A@@D@@@AE@@@AA@@@A@A@@@AA@@@AA@A@A@@@G@C@A@@@A@@AA@A@@A@@@@@A@A@@@@B@@@@@B@@@@@B@B@B@@@@@@@@@B@B@@@@@B@B@@@BC@@B@@@@A@@@@@A@@D@@A@@A@B@@@@@B@B@B@@AB@@@AA@@B@@@B@@@@A@@@A@@@@@A@@ABA@@A@@A@CAA@@@@@@A@AA@AAAAAA@@AA@@@@A@A@A@A@@@A@AA@BA@A@@@@@@A@@@AB@@A@@BC@@@A@@@@AA@@@A@@BAB@@A@@@A@A@A@@DCBA@A@@@A@@@AA@@AA@@@AC@@AAA@A@@@@@BA@@FC@@@ABABABA@@@@@C@@@AAA@A@@BAB@@AB@B@@@@@@C@@AA@@B@@AB@@@@A@A@ABA@@@@A@@@A@AB@@A@A@@@@@HIDC@@@A@@@@C@AA@@AAB@D@@@BABBBABB@A@@A@@@BE@@@BB@@@@@@A@@@A@A@@AAAA@A@AA@@AA@@@A@@A@@@@@A@@@AAA@@A@@@AA@@A@@A@@@ABA@@@CAABA@@B@@A@@@@A@@B@@GEA@CC@@@@DAB@@ABC@@@AA@A@@@@@A@@@@@@@@A@@@@A@@@BA@AB@@A@ABA@@A@AAA@@@@BA@ABA@@@A@@B@@A@@@AB@BABA@ABC@AB@@@A@A@@@A@@@@@A@AA@AC@@@ABC@E@C@AA@@C@@@@A@A@@AA@@AA@@AA@AACA@@A@A@@B@@C@@BA@@@C@@BA@A@@@A@ABA@A@@@E@@AAACAA@@@A@ABEB@@@B@@AB@@@BB@B@F@BBDBA@@@@@AB@B@@A@ABA@@B@@AD@@@B@@CDEDC@ABAAABC@A@@@@D@B@@@B@BA@@@@@A@AB@@@B@@A@@@AB@@A@AA@@@@@AB@@@@@AA@A@@A@@BA@@@ABBD@@A@A@@@A@@@B@BB@@@@@B@@@B@@BB@B@@ABA@@@AAA@AA@@@@ABE@@@AB@@ABA@AB@B@BC@@@ABA@@@A@A@@BAAA@@@A@@@AB@@@BAB@B@B@@BB@@@@@BC@@BA@@B@B@@@BA@@DA@@B@@A@C@@AA@A@AA@@A@A@@@@@A@C@AACA@@@@@A@AA@@AA@@@CAA@@@C@@@@@@A@ABA@@@AB@@@B@@A@@A@@@A@@@A@C@CCA@@A@@A@@@AA@@@@AA@A@@@ABCB@@@B@@@@A@@B@@@BAB@@A@A@@@@@@A@@CA@AA@AAC@A@@@@@@C@AB@A@@@A@A@@@A@@@A@@@A@@@C@@@C@@@A@@@AAA@@@@@AAA@@A@AAA@@AA@@AA@@A@A@AA@@A@A@@A@A@AC@A@@@C@A@@@@BA@@@@@A@@@A@ABAA@@@AAAAA@A@@@ABA@ABA@ABA@A@@@A@@@ADAB@@BB@B@@@B@B@B@@@@ABCA@@C@ABABAAAAAA@AA@@@@A@@AA@@@@A@@@@@AACC@AA@@A@@A@ADC@@@A@@AAA@@@@A@@@@@@@CC@@A@@AAA@@C@A@@@@AA@@@A@AA@@A@@A@@@A@@@A@@@@AAAA@A@@A@@@A@A@A@@@@@AAAC@@BAA@@@@@A@ACA@@@@@A@A@@@A@@AA@AAA@CA@@@AA@A@@@AA@@A@@@A@A@@@A@@@AB@@CA@@@@C@@BA@@B@@C@@BA@A@@@A@@@"],encodeOffsets:[[120324,29345]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
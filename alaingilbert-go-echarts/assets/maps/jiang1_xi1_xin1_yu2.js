@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("新余",{type:"FeatureCollection",features:[{type:"Feature",id:"360502",properties:{name:"渝水区",cp:[114.944549,27.800148],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@AA@@A@A@@@AAA@AA@ABA@A@@@@A@@@AB@@AB@@@@@AA@@@ABBB@@@@@@@@AA@@AA@AB@@@ABA@@BA@@EA@@@BA@@BA@ABA@@@@@@A@@@@CB@@@A@@@@@ADA@@B@DABA@A@@@@@AA@@A@ACA@@@AA@A@@AA@@@@@@@@B@@@A@@AA@@BA@@@AB@@@A@@@@@@@@@A@@@@@@@@B@@A@@@@@@@@B@@@@@BB@@BB@@@C@@@@@AAAA@@AA@@A@A@@A@@@B@@@BA@B@@@AB@@@@@@A@@@A@AB@@AB@@A@@AAA@@@@@@AA@BA@@@AA@@@@AABAA@AA@@AACAA@A@@@A@@@A@AA@@A@@AA@@@A@@@A@@@@@A@@B@@@@@@@@@@@B@@B@@@B@@@@A@@B@A@@B@@@@@@@@@@@B@@@@@@@B@@@@B@@@B@@@@@@B@@A@@@A@A@@@@BA@@@A@A@@A@@@BA@C@@@AB@@@@A@@BB@A@@@@@BB@@@@@BB@@B@@@B@@@@A@@@@@@A@@AA@A@@@@A@A@A@A@@AA@@@A@A@A@@@A@@@@@A@A@@@AA@AA@A@A@C@AB@@D@BBB@@B@@A@A@@@@@BBBA@@B@@A@B@@A@@B@BABA@@@@B@@A@@@@CA@AA@@A@ABA@@@@DBB@@@B@@BB@@B@@@@D@@AB@@A@@@@@BB@B@@@@AB@@A@B@@B@@@@A@A@@@B@@@@BB@BB@@A@@@A@@BA@AB@@A@@@@@@BA@@B@@@BBB@@@@@B@@@@DB@B@@ABA@@@CB@B@@A@@@A@A@AA@@@@A@@BB@BD@@@@A@B@@@@@@@B@@@A@BB@BA@@AA@@@A@@@@BA@D@B@AB@@@B@@AA@BA@@@A@BB@@@B@@@B@@@@BBD@@@@BA@@@ABA@@@AA@@@@A@AB@@@B@@@@ABAB@BB@AB@B@@@@DBAB@@A@BB@@@B@@@@B@@@@@B@@@@@@@A@@@@@BB@@A@@@@DA@@B@BAB@BB@BB@@AB@@CABBABB@@BB@@BBBB@@BB@@@BB@B@@BB@@BBB@@BA@@@@B@@@B@@AB@@@BA@@@@BAB@B@@A@@B@B@B@AA@ABA@BBA@@@@@@B@B@@@@@@BB@@@@A@@@AB@@@@@B@@D@BBB@@@@@@@@BA@@@@@@@@B@@@BB@@@A@@B@@@@AA@@A@@@A@@@A@@@@B@@@B@@@B@@AB@@@@@@A@@B@BA@@@AB@@@@@@@@@@ABAA@@AA@@A@@@@@@@ABA@@AB@@AABCBA@A@@@@@@@A@A@@@ABA@@@@@A@@@@@BAA@B@@AA@B@@@@@@B@@BA@@ACCB@@@@AB@@ABA@@@@@A@@@@AAA@@@@@B@@@@@B@@B@@@@BA@A@@A@@@@@@@AB@A@@@@@@@@@A@@A@@A@@B@B@@@@A@@AA@@@@@@B@@@AA@@@@BA@@B@@@B@@AB@@@A@@@AA@AB@A@@@@@@B@@A@@@@@@@A@@@@A@@@@@@A@@@@@A@@A@@@@A@@A@@@@@BBA@@@@B@@@@A@@@@AA@@@@@@A@@@@A@@A@@@@@@@A@@@@@@A@@@@@@@@AAB@@B@@@@@@B@@@@@@A@@@AB@@@@@B@@@@@@@BA@B@@@@@B@@@@AB@@A@@@A@@@@@@@B@BB@@@@@@@@B@@@@@@@@A@@B@@@@AA@@@B@@@@@@@@@@@B@@A@@@BB@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@B@@@@@@@@A@@@@B@@@@A@@B@@@@AA@@A@@@@@A@@B@@@BB@@@@@@B@B@B@@AA@@@A@@@@A@@@ABBBA@A@@@A@A@@@@@B@@BA@@B@BB@@@B@@@AB@@BBA@@A@@AB@@@@@@@@@@@@B@@@@@@@@BA@@@@@@@@B@@A@@BBA@@B@@@@A@@BB@@B@@@B@B@@@AA@@B@@BB@@@BB@@B@@@B@@@A@@AB@@@@BB@@@@B@@A@@@@B@@A@@BB@@AB@@@BA@@@@B@@@BBB@ABB@@@@BBA@@@@@@@B@@@@@@@B@@B@BA@@@B@B@@BA@@@AB@@@@@@B@@A@@B@@@@@B@@@@@BA@@B@@B@@@@AB@@@@@@@@@@@@@@BA@@@@@@B@@@@@@AA@@@@@D@B@@BB@@@@B@B@@BBD@@@BAB@BB@@AB@@B@@AB@@@@@@A@@BA@@B@@A@@B@@@BBBA@@@@@@D@@@@BBDBBA@@BABDFF@B@@@BB@@@@B@@@B@@B@@B@@B@@BB@@@@@@D@@@BA@@@@B@@@@A@@B@@B@@@@@A@@@@B@@@B@@@B@@@@@@@@A@AB@@A@@@@@A@@@@B@@@B@@@@@@@@A@@BA@@B@A@@A@@@@@@BA@AAA@@@@@A@@@A@A@@@@B@@@@@@@@@B@@@B@@@@BB@@@B@@ABA@@BA@@@AB@@@B@B@@A@@BA@A@@BA@@@A@A@A@A@A@A@A@A@C@A@@@ABAB@@@B@@@B@@@@@D@@@@@B@@@@BB@@@@@@BBB@@@BBB@@@@@B@@@@B@@B@@@@@BBBBB@B@@@B@@B@@@@@@@@B@@BB@BD@@@@B@@@@@@BDFBB@BB@@BA@@@A@ABA@AB@B@@@B@@@@@B@B@@A@@BB@@@@@@@B@@B@@@@AB@@@B@@ABA@@@B@B@@@@AB@@BB@@B@@B@B@@AB@@@@BBAB@@BD@@@@AB@@@@@@B@B@@A@@@B@@B@@@B@B@BB@@@@@@A@@B@@B@@@B@D@BA@@@@B@@AB@@BBD@@@@BA@@@ABBB@B@B@B@@@@BB@B@@@@B@BABB@@B@B@B@@B@@@@@@@@B@@@@@@@A@@@@@@BB@@@@B@@@@@@BB@@@@@@B@BA@@B@@B@@@@B@DA@@@AB@@@@A@@B@AA@@AA@@@@@@@A@@BBB@@@@@BB@@@B@@B@@@@@@@@@@@@B@@B@@@@BBB@@@@@B@@@B@@B@@@@@@@@@@B@@B@BB@@@@A@BB@@AD@@@D@@BBAD@@@B@D@@@@@B@@AB@B@@B@@B@@@@B@@@@@@@@@@B@@@BB@@@B@D@@@FDB@@@@BBA@@@BB@@@@B@@B@@B@@B@B@@@B@B@@@BB@B@B@@@@@@@B@B@D@@@@@@AAA@@@BBBDB@BA@BB@@@@B@@@BA@BB@AB@@B@@A@@BBB@@AB@B@@@@B@@@BBA@@BA@@A@@A@@@@@A@G@@@@B@B@@B@@BABABA@CAABAA@@@@A@A@@@BB@@B@AB@@@D@BAB@B@@@@@@@B@@@@@@@@@@@B@@@@@BA@@@@@A@@@B@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@BBB@@@@@@B@B@BDAB@B@BCA@@AB@@B@@@B@@B@@B@@@@@@@@BA@@B@@A@AB@@@B@B@B@@@B@@BDA@BBB@AB@@A@@BAA@@@@@B@@@BA@@B@@A@@AA@@@@@A@B@B@@A@@A@@BA@@@@AB@@@B@B@@@@@@@@@@A@@@A@@@@@AB@@@A@@@@@@@@@AA@@AB@@@@@@A@BA@@@@@AAB@@@@@ABA@BBA@@@AC@@@AA@@A@A@AB@@AAA@@AABA@A@A@A@C@A@@@@B@@BB@AB@@@@@@B@B@@@BCB@A@BA@B@@@@@@@DBA@@BA@BB@@BB@@@@@@@@CB@@AA@B@@@@@@@@A@@B@D@@@BB@@BBBA@@B@@@BBB@@@@AA@@@@BDBB@B@@@@A@@@A@@BA@@@@@@@DB@@@@BB@@@B@B@@@@BB@@D@@@@@@@B@@@@BB@@@@@@@@@@@@@@@BA@@@@B@@ABB@AA@BABA@B@@A@@@BB@@B@@@@B@@BA@@B@BBB@@@DB@@BA@B@@BBB@@@@@B@DBBAA@@AB@@A@@B@@B@@@@B@@@B@@AB@BBB@BB@@BBA@A@@@BBBABD@@@BA@A@A@A@A@@B@@@B@@B@@@B@B@@@@B@B@BA@A@@@@B@@A@@BA@A@@@@@@@ABBB@B@BB@@@BB@@@B@BBB@B@@@@@B@@BA@@@@BA@@@@@A@@@@@@@@BBAB@@@@B@@@AB@B@@@@A@@@A@@@@@A@@@A@@BC@AB@@A@@@C@@@@BB@@BBB@@B@B@B@B@FDA@@@@@A@A@@@BB@B@@B@@B@@@@@@@@@@@@@@B@@@B@@@B@@@@@B@@B@@B@@@B@BB@@@@@B@@B@@@@B@@@@@@@B@@@B@@BA@@@B@B@@@@B@@@@@@@@@@@@BB@@@@B@BB@@@@@@@@@BB@@@@B@@@@BB@@@@B@BB@@@@@@B@@B@@@@@@@@BB@@A@@@@@@B@@@@@@@@B@@@@@BB@@@@AB@AB@@@@A@@@@B@@@@@@BBBAAB@B@@@BB@B@@DBB@@@@@A@@@@@@@A@@@@@A@@@@BB@B@B@B@@@B@D@@@@@B@B@BBBD@@@BBB@B@B@@@B@@A@@B@B@@@@B@@B@@@@A@A@@A@@A@@BAB@B@FBABBBB@@B@@A@@BB@A@@@@B@BDDB@@BB@D@DBDBB@BB@@BBBABBBBB@@@B@DBB@B@DBDBBBBABBBAB@@@BB@@@B@@D@@@B@@@@@BB@@@B@@@@@D@@@@A@A@@@AB@B@@@@A@A@AAA@AB@@@B@BA@A@@@A@A@@@A@@@A@@@BB@BB@BBB@B@@B@BABBBBBAA@B@@@B@@@@AB@BA@C@@@@@AB@@AB@@A@@@@A@@@@AAA@ABA@@@@@@@@B@@A@@B@@@BA@@@B@@@B@@@@@DDBFDB@@@@B@B@B@@@B@@@BAB@B@B@@@@C@@D@@@BB@BA@@B@@@@B@@@B@@D@@@B@@@@@@B@@@B@@EB@@@@@B@BBBB@A@@DBB@@B@@@BA@@BA@@@@@AB@@B@CDB@@@B@DA@@@@@@A@@A@@BABABA@@@@@B@BBAB@B@B@@@B@@@B@B@BA@@BBB@@BB@B@BAB@@@BB@@DB@BB@@B@B@@@BB@@DDBLMB@E^HF@BAB@@B@@BD@@@@B@@BD@B@@@@@B@@@@BB@@@BB@@@B@@BB@@B@@@BB@@@B@@@BBB@B@@@BB@@BB@AB@BA@@B@B@@BB@B@B@@@@@B@@@B@BB@@BB@@FBB@@@@@B@B@@@BB@@BB@@B@@BB@BB@@@@BB@@B@@@@@@B@@B@@B@@@B@B@@@B@D@@@B@@@BD@BB@@BD@@@BB@@B@@B@B@@BBBFHPD@B@@B@@@B@BA@@@@FA@@BBB@@A@@@@AA@A@@@@DABAB@DCBB@B@@@B@@B@@@@B@@@AB@BAB@@BB@@AB@@AA@BCA@@@@@A@@AA@AA@@AA@@@@@@AA@@@@@@@@BADA@@F@@@BB@@B@@A@ABAB@BA@@BAB@@@@AA@@@@@@@BAB@@@B@BBBBBBBBB@D@B@D@D@BB@@@@@BAB@@@BBBB@@B@@@@D@@@BA@@@@B@@@B@@BB@@@@@B@BB@A@@@@B@AB@@BB@@@@@@@CB@@@B@B@@@@@B@@AB@BAD@@ADCB@@AFAB@B@@@@A@@B@@@@B@B@@@@B@@@B@@@@@A@@@@A@@@ABB@@@@@@@BBAB@B@@@B@@A@@BB@@BB@@B@A@@@BB@@@A@@@@@@BA@@@DB@@@@@@@BBB@@DB@@A@@BA@@@@@@BA@@@@BA@@@A@@B@@@@A@@@A@A@@B@@A@A@@@@B@BA@@B@B@DB@A@@@B@@@@DB@@B@B@D@@@BBB@B@@@B@@A@@AAB@BCDD@@BBB@ABBB@@B@@B@@@BB@@@BBB@@@@@DB@@@B@@BBB@@A@@B@B@@@@ABBBA@BB@@@@BB@@BB@B@@BD@@@@@@@B@B@B@B@@@@@@B@@@B@@B@BA@@@@BAB@@@@B@@@@@B@@@@@@@@@BA@@B@@@@A@@@BB@@A@@@AB@@@@@@B@@@@B@@A@@@@@A@@@A@@@A@ABA@ABA@A@@@@B@@B@BB@@AB@D@@@@@@B@A@@ABA@@BA@@@A@@B@@B@@B@@@@@@A@@@@@@@@@@BB@@@@@@@A@@@A@@@@@AB@B@@B@@@@A@@BB@@@B@@B@A@@@A@A@@@A@@B@@@@@@B@@B@@@AA@@@@@A@@B@@@@@@A@@@@B@@@@@@B@@B@BB@@BB@@BB@@@@B@A@@@@AA@@AB@@@@@@A@@@@A@@@@@@@@BA@@@AA@@@AB@A@B@@A@@@@@B@@@@@AB@@@@@@@@@B@@@@BB@@AA@@A@@B@@@BB@@B@@@@@@A@@A@A@@A@@B@@@@@FADA@A@@@A@A@A@@BB@@B@@@B@@@B@B@@@B@B@@BB@@@@@C@@@@@@B@@D@BB@@B@@@B@@@BB@@@@D@@@@@BA@@B@@B@@BBB@@@@@@B@B@@B@@@@ABAB@B@A@@C@ABABAB@D@B@B@BA@@B@@@BB@B@@B@@@BAB@@B@@HA@@DE@@BA@@BA@@@@@A@@B@@@@A@@@@@@@A@@@A@@@A@@B@B@@AAABA@@B@@A@AEAAC@A@@@@AAA@@@@@@AB@BAB@@@B@B@B@BCA@@@@@BA@A@AB@@ABB@@BAA@@@CAAAA@@@A@@@A@@@@@@A@@@AA@@A@@@@AA@@@@@@AAA@@@@ABAAAA@@ABA@CAA@A@B@@BAA@B@@@B@@A@@@@@A@@@AA@@@@A@@@A@@@@@AAA@A@AA@@@@AA@@A@@B@@@B@@@@C@@@A@@@@BB@@@@@A@AA@@AAB@@A@@A@@@A@@@@@C@@B@@AA@@@@A@@@@AA@A@@D@@@@@@@A@CA@@AAA@AA@@@@@@AA@@A@@@AA@@@@A@@@@AG@AB@ABAA@@A@@AA@@@@@@A@@@@@@@@B@@@@@B@@A@@@@@A@A@@@@@A@@@A@BC@@AA@@@A@@@A@@B@@A@@@C@A@@@@@@BB@@@A@@B@@A@@B@@@@@@AB@@@@@@@@@A@@A@@@@A@@@B@@@@@@@@@@A@@@@@@A@@@A@@@@@@@A@@@@@A@@@BA@@@@A@@@@@@@AC@@@@@A@A@@CA@A@@@A@@B@@@B@@@B@@@@A@A@A@@@@BADAA@@@@ABA@@B@@BB@@BD@@B@AB@@@BA@@@A@A@@@@BA@A@@@@@A@@@@@AAB@@AA@@@@@@@A@@AA@@AA@@A@A@@@BA@@A@@@AA@@@B@@@@A@@@AAAB@@A@AA@@AA@@@A@@@@AA@@A@@B@@@AA@@A@@A@@B@@@C@@A@@@AA@@AAA@@@@@@@A@@@A@@@@@@@A@@AAA@@@E@@BA@@@@@@B@@@@@@@BAAAB@@@@@@@DB@@B@@C@CAA@AAA@@B@@@B@@@@@@@A@@@AA@@@C@@@A@ABA@@B@@@B@BA@A@A@@@@BAB@B@@@@@B@BAB@@A@@@@A@@A@@@@@@B@@@@@@A@A@@@@@@AA@@@@@@@@B@@@B@@@B@AA@ABA@@@@@AA@@A@A@@@@@AB@@@BA@@@AB@DA@@@CAA@A@AB@@@@A@@@@@ABA@CBBBA@@@@@AA@@@@A@A@@A@@@@A@A@@@A@AB@@ABA@@@@B@@@@B@A@@@C@@@@B@@@@@@BB@@@D@@BB@@@B@B@A@@A@@@AB@@@B@BC@A@@B@B@@BB@BB@AB@@@BAB@@@B@@@B@@@@@AA@@BA@@AA@@@@A@@BABA@AA@@@@@AA@@@A@@@ABA@@@@@A@AAA@@E@@B@BA@@BA@A@@A@@@@A@A@@DABB@@B@BC@B@@@@B@@B@@A@@BA@@B@@@@BB@@A@@@A@@A@BAB@@@@BB@BB@@@@@B@DBBA@@@@BCD@BBF@B@@@B@@@@@BABABBBAB@@@BAB@@@@@B@B@@@B@A@@@@AA@@@@AB@DA@@BAB@@@BA@@@@@@@@BA@@A@@@@A@@@@@A@@B@@@BBB@@AB@B@B@@@B@@DD@D@B@@A@@@A@A@A@@@A@@BA@@@@BBB@DBBBDBFDDBD@BAB@BAB@DC@A@ABA@@B@B@BA@@B@BADAB@B@DBB@BBDBBB@@BB@B@B@D@@@BAD@BBBBD@@B@BBB@B@B@B@@@FCDCDGFEBCBA@@BABAB@BABAD@B@BBD@DD@@BBB@B@B@B@BABAB@@C@A@ABA@A@@BA@@BAB@B@@@DBBBBBDB@@DBDD@@B@DBFDHDBBB@DBD@H@B@BAB@BABAFE@@DCDABAB@BAB@B@B@BB@@DBDB@@@B@BB@@B@@B@DB@@H@B@@A@@D@@ABA@ABA@C@C@@@A@@B@BAB@B@B@D@BBB@DFB@B@@@BBB@D@FAF@B@@@BABA@AB@B@@@BBB@@@BDBB@BBBBBB@B@D@BAB@DABAB@B@FABB@@DBBBDBBBBBD@BBB@DAB@DAH@BABAB@B@DA@@A@A@@@@AB@@@A@AAGA@@@@C@@A@@AA@@A@A@@@@@@@AA@@@@A@A@AAC@@AB@@A@@A@@@AA@A@A@@@C@@@@A@@@@@ABCBABA@@B@@@B@@@B@@@@A@AAAA@A@@A@C@EDA@A@A@@@@@A@A@@A@@A@@@@@@@A@@@@@@@@BCAA@A@C@AA@@AA@A@C@@@A@@@A@A@@B@@@@@D@@@@@@A@@BA@A@AAA@@@@@AAA@@@A@C@@@A@@C@@@@AAC@@AAACA@A@@@@A@@A@@@@@@@AA@A@@AA@A@AAA@@@A@@@@@A@@@@AA@AA@@@@A@@@@@@@@@@@@@B@@@@@B@B@@@@A@@@@AA@@A@@@@@@A@@A@@AAA@@@@B@@@@@B@@A@@@A@@A@@@A@@@@@@@A@@@AA@@@@@@A@@@@ACA@A@@A@@@@AA@@A@A@@@@@@@@AA@@@@A@@@AA@@@@@A@@@A@@A@@A@A@C@@AA@@@AA@@@A@@AB@@AA@@AA@@AC@@@AB@@@@AA@@@@@@@AA@A@@@A@A@@BA@A@@@A@@A@@@A@@@@AA@@A@A@A@@A@@@AAAA@A@@AA@@@A@AAA@@@AA@@@@@A@AAA@@@@@BA@A@@A@AAA@@@@@A@A@@@A@@A@@CA@@@@A@@@AA@A@@@@@AAAA@@A@AA@AAA@A@A@@A@@CCA@CAA@A@@@A@@@@A@@AAAA@@A@@@A@@@CA@@C@@A@@C@AA@@AB@@@AA@@@A@CACA@@ABAB@@A@@@@AAAA@@@A@A@A@A@@@A@A@@AA@CA@@@@A@A@AA@BA@@A@@C@@@A@A@AA@@C@@BA@@@AAC@A@C@AA@@AAA@A@C@@@A@A@A@@AAAA@@AA@A@CA@@CAC@A@A@@@A@A@AAA@A@A@@BAA@@CAC@A@A@@@@AA@@@ABA@@@A@@BAAA@@@@A@@A@AACCAA@@@A@@B@@AA@@@@AAA@@@@A@@@@@@AA@@BC@@@AA@@AA@AA@A@AAA@@@@AA@@AA@A@@@ABAA@A@@@A@@AA@@CB@AA@@AA@C@A@@BA@A@@@A@@@C@@AA@@@BC@@@@AAA@@@@A@@@AA@@A@@A@@@A@@@@@A@@@AA@@@@B@@A@@AA@@A@@@CA@A@@BA@@B@D@B@@A@C@AAAA@@A@AA@@A@@@@A@@@A@@@A@@@A@@@A@@@A@A@@@@@AA@@ABCA@AA@@@@A@@A@@AABA@A@A@AB@@AC@@@@A@CBA@@@A@@B@@@@DB@@@B@BA@B@@@@B@@@BB@@B@@AB@@@BABBB@@BB@@B@@@ABB@BB@@@B@B@@@@@BA@@B@@@@AAA@@@A@@@@@@BB@@@@@BB@D@@BB@B@B@@@BA@@@A@C@@@@@@B@@@@@BA@@@A@@A@B@DCB@BAB@@@@@@A@A@@B@@@@@@@@AB@@@@@B@@A@@@@AAA@AA@@@@@AAA@@@C@@AAA@A@ABEAA@@BA@@@@AA@@@A@@B@@A@@@AC@@@@@A@C@@@C@@@@A@@@@@@A@@AABA@A@@@BC@@@@@@@AB@@A@@@AAA@@@A@ABCB@@A@AA@@A@@A@@@ABAB@@AAA@A@@A@A@@AA@AA@A@A@ABA@@@@AAA@@B@@@AA@A@@A@AACACA@@@A@@AA@@@A@@A@@@AB@@@@A@@B@@A@@@A@AA@@@BA@AA@@A@@@@BA@@BAB@@BBA@@@@B@@A@@@@@CB@BBB@DB@@B@@AB@BB@@B@@AB@B@@CB@@ABA@@@ABA@@@A@ABC@CBC@AB@BB@@@AB@BABA@AB@B@BA@@@A@A@@@A@@@AB@AA@@@@A@@AA@@BA@@AA@A@A@@A@@@@B@@@AA@@@@B@@@A@@@@@AA@A@@@@@B@@@@@@@B@@@BA@@@@ABBB@@B@BAAA@@@AAA@@AAC@E@@@A@@@AAA@A@@@@@AAAA@@A@@@A@@@@@A@@AA@A@@@AC@@@AA@@@@A@BA@A@E@@AA@A@AA@@@@A@AAA@@@@A@@@A@@@A@@@@@A@ABA@@@@@@AA@@B@@AB@@@AA@@B@B@@A@AB@@A@@@A@AA@@@@@@@@AB@AA@@@@@A@@A@@@@@@@@A@@@A@@A@@@ABA@A@C@AB@@@@AA@AA@@ABA@A@AAAAA@@A@@@BA@A@A@@@@B@@A@@@@@A@A@@BA@@@@BA@@@@AA@@@@AA@A@@AA@@BAA@@ABA@@@A@A@A@@AA@@@AB@@@BAB@B@@@@AB@AABA@@A@@@AABA@@BAAC@@BAAA@@@A@@@A@AA@A@@@A@AA@@A@@@AA@@@AA@AA@@@AAB@AA@AB@B@@A@@@AA@@A@@B@B@@@BB@@@@@@B@@@B@@@B@@@@AAA@@@AB@@AA@@@@@@B@@@@@AA@A@@@@BA@@@@@BBB@@@A@A@A@@@@AA@@ABABAB@B@B@@C@@@ABABA@@@ABA@@BAB@@@@@@@BAB@@@BA@AB@@@@AB@@C@@@@B@@@B@@BD@B@B@@ABA@A@@@BBA@A@@@@@@@@@A@@B@@@BA@C@C@@@@@AAA@A@C@A@AA@A@@A@@@AAA@@AAA@@@@@@ACA@@AAAA@@@AA@AA@@@@AA@@A@@@ADA@@BA@@B@B@@@DC@@@@BBB@@@@@BA@A@@AA@@@@B@@@@@@@@AA@@A@@@@A@@@AB@@A@@A@A@@B@@@@@B@@@@@@A@@@@BB@A@@@@A@@@A@@@@@A@@@AA@@CAAAA@@@A@@@A@@AA@@A@@@@@@@A@@@@A@A@B@@AA@@B@@A@@A@@@@A@@@@@@@C@@@ABC@@AA@@@@AA@@@AAA@C@AC@@A@@@AB@B@@@@A@AAA@A@@@AB@@@@@A@A@A@AAABAA@@@@@A@@B@@ABA@AB@@C@AAAAA@C@@@@@@AB@B@B@BABAA@A@@@AAAC@@@A@@BA@A@@A@@A@A@AAA@@A@A@@@@@AB@DA@AB@@A@@@A@@@@A@@@A@ABA@@BA@@@AA@@@@ABAA@@@@AA@A@A@A@AA@@@@AAAAAAA@AAEA@@C@C@A@A@@B@@C@A@@@AAA@A@@@CA@@A@@BA@AB@@@@"],encodeOffsets:[[117648,28277]]}},{type:"Feature",id:"360521",properties:{name:"分宜县",cp:[114.692049,27.814757],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@@BA@@AA@@B@@@@@B@DA@CA@BA@@@BBAB@B@@A@AA@@A@A@@AA@@@AAA@@@A@A@@B@@@A@@AAAA@@AA@@@AA@@@AA@@A@@@@@ABA@@@AA@@@@@@AA@@@@@@@@@@@A@AA@@AA@@A@@A@@@@@A@AA@@@@B@@AB@@A@@A@@ABA@@B@@@B@BAB@@@@@@@A@BA@@@A@@A@@@@AAA@@EEAA@@A@CA@@ABA@@@EFABADABA@@@A@@@@@@BA@@@@AAB@@AA@@A@A@@@@AA@@@A@@B@@AB@B@@@@A@@B@BA@CAC@CAA@A@A@A@A@@@ABAB@@@@BB@@AB@BA@A@A@A@AA@BA@A@@@AB@@A@AB@@@@BB@@BB@@@DB@AB@BA@@BA@@B@@@@BBABBB@@A@AA@@@@@B@@@@@@@B@@BA@@@@@@AB@@AD@B@BAB@@@@@@A@@@A@@@ABAB@@@@@@C@@@@BB@B@@@@B@@@B@@@@B@AB@B@BABB@A@@B@@@@AB@@@B@B@@@@AB@@@BA@A@@@BB@@A@@@A@@@@@@@@BB@@B@@@@B@@@@A@BB@@@A@@B@B@@A@@@@@@@@@A@@@@@@@@@@B@@@A@@AA@@@@@B@@@@AD@@B@@B@@A@@@@@@B@@@@A@@@@@BA@@@@@AAB@B@BB@A@@@A@B@@BB@@B@@@@A@@@@BBA@B@@@@@@@B@@@@@@@B@@@B@B@@A@@@@B@@@B@@@B@@@B@B@@A@A@@@@@BB@BAB@BA@A@@@BB@BB@BABB@@@@ABBB@@@@@B@@@@@AA@@BA@@@@BA@@AA@A@@BBB@@@@B@B@@@B@@@@@@B@@@@@@B@@B@@@@BB@@@@@B@@B@@@B@@@@@@@@D@B@B@@AB@@@B@@A@ABABA@@D@@B@B@B@AA@@B@AA@@DA@AB@@@B@@@@@@B@@BA@@@B@@@BBB@B@@AB@@A@A@BBB@@B@B@@B@@BA@@@@B@@@@A@@@AB@@@@@@@@BB@ABB@@B@@@@@@@@BB@@@@A@@@@B@@@@B@BA@@BB@@@@A@@@@@A@@BB@@@A@@@@BA@@@@BB@@@@B@BAB@@@@BB@BB@@@@@B@@@@@B@@@@@@@A@@@@@@@@BA@@@@@BB@ABB@BB@@@ABB@@@@B@@BBA@AB@B@BA@@@@@A@@@@B@@@@@B@BBB@@A@@@B@@@@@D@@@BB@@@@@B@B@@@@@B@@@@@B@B@@@@B@@B@@@@A@AA@B@AA@@@AAB@A@@BCB@B@@@@BB@@D@BBBBDAD@@A@@@AAAB@@@@B@@@@B@@@@AA@@A@@@A@@@@@@B@@@@@B@@@@A@@BAB@@@@@@@BB@@@B@@@@@@@B@@@@B@@A@@@@BA@@@@@AB@@A@@BA@@@@@ABAFA@@BB@@@AD@@A@@B@@@B@@@@@@@B@BB@@ABB@@BB@@@A@@B@@@@@@B@@BB@@@B@@B@@@@@B@@@@@@@@B@B@@@@@@B@@@@A@BB@@B@B@@@@B@@@@@@B@@B@@@@@BA@@@@@@DAB@@@BBB@@@B@@B@@@@B@@@BA@@@@B@@@@@B@@B@B@@B@@@B@@@B@@@@@B@@@@A@@B@@@@@BB@@BA@@B@@B@@B@AB@@@@@@B@@@@B@@@@@@B@@@@@B@@@BA@@BA@@@AD@@AB@@@@A@@DB@@@@DB@@A@@@@B@@BB@@BB@@BABBD@@@@CB@@@B@B@@@B@BAB@@AB@BCF@D@@@@B@BB@ABBBB@@@A@@BA@@@BBA@@BB@@@@C@@@@B@B@@@@A@@@@B@@@@ABAAA@@D@@@@@@@@B@@@@@BBB@BB@BDBB@@@BB@@@B@B@@BBB@@B@B@BB@@BB@@@BA@@BB@@@B@@@@@B@@B@@B@@BB@@BABBB@@@BB@@BB@B@@@@@B@@@BA@@@@B@B@@AB@@@@@@B@@@@@@B@@DAB@@@B@@@@@B@@@B@@BB@@@BB@@B@@BB@@@@@@D@@@@A@@BA@@@@B@@BB@@@B@@@BAB@@@@@ABA@@B@@BB@@BA@@@A@@@@@BB@@@@BAB@B@@@BB@@@@B@@@@A@@@@@@@@@CBA@AAAAAAC@A@C@@@@@ABBB@@A@@@@@AB@@@B@@B@AD@@D@@@@@B@@@@A@@@@@@@@@@@A@@@B@@@B@@BB@@@AB@@AB@@@B@@B@@A@@@A@@BA@@B@BAD@@C@CB@BB@@B@@@@BB@@@B@@AB@@ABA@@B@D@B@@ABAD@@CF@B@@CB@@@B@B@@CB@B@D@@A@@BAB@@@@@@A@A@A@A@A@@BA@AA@@@BCA@@@@@B@@B@@B@@B@@@@B@@@@B@B@B@@A@@@AB@B@D@@@@@B@@@@B@@@@AB@B@B@@AB@B@@@B@@B@@B@BB@@@BBB@@B@@@B@@AB@@A@@@@BABABAB@BBDC@C@@BB@BB@@@@A@ABA@C@@B@BCA@@@B@B@@ABA@A@C@@@B@@@AB@@A@A@AB@BAB@@@@@@@@@B@@@B@@@@BB@@@B@@BBB@@@BB@@BBBBBBBB@@@BBB@@B@BBB@BB@@B@BB@BB@BB@@DB@@@BBABB@@DA@@BA@@BAB@@@@BB@@@@@@B@@@DAB@@@B@@C@AA@@@B@@@@ABAB@@@D@@@@BA@@DB@@DB@@@BB@B@BBB@@@@@@A@A@@@@BA@@@ABBB@@@@B@@@A@@B@B@@@B@BA@@DBB@@B@@@B@@@@B@@A@@BAB@@B@@@B@@@@@@@B@@@B@@BA@@@A@@@@A@@@@AB@BA@@A@@@BAB@BA@@@B@@CB@@@@B@BAB@@@A@@@@ABADBBB@@B@@BB@B@@BB@@@BBB@B@BBDB@@@@BAB@@@B@BBBBB@B@BBDB@BBB@@@B@@B@@B@@@@@@@@B@BDBBB@@@B@@AB@@@BBBB@AB@@@DBDB@@D@DA@@BB@@B@@@BB@@B@B@@@@@@A@@@@D@D@@@@B@@AB@@A@@@BB@BBB@@@@BA@@B@@@BD@@B@B@B@BBB@@@B@@@BA@@@@@@@@@@B@DAF@B@D@BAB@FBD@BBB@B@B@B@@@B@@ABBB@@AB@BA@@D@BA@@@@A@@A@@@@@ABABA@A@@BB@@@BB@@@@B@B@@@@B@B@@@D@@@DB@@BA@@@@@@@AB@BBB@@BB@@BB@@@B@B@@B@@@D@@@B@@@@BBB@@@@@@@B@@AB@@AB@B@FADA@@B@@@BBB@BBB@B@@@@@B@H@@B@@@@@BA@@@@BA@BB@B@@B@@@B@BB@@BAB@BAB@H@B@DAB@@@DABA@@BA@@B@@@DC@@@BB@@BB@@B@B@@B@BB@@B@B@@@BB@@@@B@@@B@@@BA@AB@@AB@@@B@@@@@BBB@@@@@@BD@BBB@B@BB@@@@@BB@B@@@BA@@@ABA@AAA@ABA@@@A@@@@@@CA@AA@BA@@@AA@@@@AA@@@@@B@@A@AAA@A@@@A@A@@@A@@@@@@BA@@BAB@@A@@B@B@B@@@@@@A@A@ABA@@@A@AAAAAA@@A@AB@@AA@@A@A@ABA@@@A@A@A@A@@@@CAA@@@@A@A@@A@@@@@BA@@@@AA@@@@C@@AA@A@A@A@@@A@@@@AAABA@A@A@@@A@@@@BA@@@A@CABA@@@@AAA@A@AB@@A@@BA@@@@B@@@@AA@@@AB@A@@@@BA@A@@@@@@BA@@@A@@@@B@@@@@@@B@@@@@@A@@B@@@@@@BB@@B@@A@@A@@A@@BB@@@@@@@@@@@A@@B@@@BA@@@@B@B@@B@@B@@@@B@@@B@B@B@@@BAB@@@B@B@@@BB@@@A@EA@@@BC@@@A@ABA@@DA@@@@@A@@@@BA@@BAB@@@@A@A@@@A@@DAB@B@DBD@D@@@B@@@B@@@D@@@D@@@@BJH@B@BA@@B@@BB@@D@BBBA@@B@@BDDB@@BFBB@DDB@B@@AB@BA@@F@B@@@B@B@D@DBDA@@DBBAB@D@BAB@DBB@B@BB@@DBB@@@FABABABAD@B@B@D@D@DB@@BA@CAC@@@A@@BABA@@@@A@@@BAB@@@@A@@@A@@BAB@D@@@BA@@B@@@B@@@@A@ACBA@@@A@DCA@@@BA@@@@B@@AB@@A@@@AA@CA@@@BAAAAA@@@@@A@@FA@@@A@@@@@@@@A@@@CA@@@A@@@@@@AB@@AAA@@C@@@@D@@A@A@A@AB@@A@@@A@A@A@@@@@CAAECC@@@@A@@@A@@@B@@A@@@AB@@@@A@@@@@@B@BAB@BB@@@@@B@@B@@@BA@@BA@@@@D@B@@ABA@@@@@A@@@ABBAAAABA@A@AA@A@AAA@@AAA@@B@@@B@@@B@B@@@B@B@@A@A@@BAB@BBB@B@@@@@@ABA@@B@B@@@@@@C@@@@@A@@AA@@@@A@@@C@@@@A@@AA@@A@ABAAABAACACAA@A@CAA@@@A@AAAAABAA@@AAA@CACAC@A@@AA@CC@A@A@@B@A@@AB@@@@AA@AABAEAA@A@AB@@@BB@@@@B@B@@A@@@@A@@A@A@@@@BA@@@A@A@A@AA@@C@AA@A@A@A@@@@@C@A@@@A@A@AAA@@@@@B@@@@@B@@@@@@@B@@@@AA@CA@A@@A@@@ABAABAA@@@@@@@A@@B@@@@@BAA@@B@@A@@A@@@@@A@@@@@@A@@@@@@@@BA@@A@@@@@@A@@@@A@@@@A@@A@A@@A@@A@@A@@@@@AA@@@@@@@@A@@A@A@@A@@A@@@@@@@@@@A@@@@@@A@A@@AB@@@A@@@A@@@@@@@A@@A@@@@A@@@@AAA@@@A@@@@AA@@@@@A@@@A@@@A@@@@@@@@@@@@@@@@AA@@@@AAA@@B@B@@@@@B@ECA@A@A@A@@@AA@AA@@A@@D@@@B@@@BAD@@AB@@@B@@@@@B@@@B@@@@@@ABA@@A@@@@@BAAA@@@@@@@@@B@@@@AB@@@@AB@@@A@@@@@AAA@A@A@@AA@@A@@A@AAABA@@@@@@B@B@@AB@@@@A@@B@B@@A@A@A@@A@A@@@A@@@@A@@@AB@B@B@B@B@@A@@ACABAA@@B@B@AA@@AAA@AAA@@BA@@@A@@@@@@AA@@@@BA@@BB@ABCAA@@@@@A@AA@@@AAB@@CA@@A@AAA@@@AB@@@A@@A@@@AA@@B@@@@AABABB@@BAA@BA@@@@@AB@@@@@@@@@@@@@@A@@A@@A@@@@@@@C@@@AA@@@@@A@A@@AA@@@@CA@@@@@@B@@AB@@@B@@@@@@AAAAC@@@@BB@@@@AA@A@@@AB@AA@AA@@A@@@C@AB@@@@@@@@@@ABB@@DA@@@@@@@@AA@@AAB@@AB@CA@@@@@@A@B@@A@BDA@A@@@A@A@@@@A@@BAA@@@A@@B@D@B@B@B@B@BA@BB@BB@@BAB@B@@@BB@@D@@B@@AB@AAB@B@@@@BA@B@@@@ABB@@@@@@@BA@@BB@@@@@@@@B@@@A@@B@@@@@B@@@B@@@@@@@@A@A@@@A@@B@@B@@AB@@@@BA@A@B@@@@@B@@BB@@@@AB@@A@@@A@@@@BB@AB@@@BAA@AAB@AC@@@A@@@A@A@A@@BAB@@@@AB@@A@@@@@@A@@@@AA@@@A@@@BA@@DB@A@ABAACA@A@@@@@@@AA@A@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@@@A@@@B@@@@@B@@A@@@@@A@@@@@@@@@@@A@@@@@@@ABA@A@C@@BAA@@@AA@@B@B@@@@@BBBADBB@BABA@AA@@@@A@A@@H@B@@@@@B@@@@BB@@AB@AA@@A@@@@@@ABA@@AA@AB@@@@AA@@BAAB@@A@@@A@@A@@AABA@ACAA@@B@BB@@@@@@@C@A@A@@@@@@@A@AAA@@A@A@@@A@A@@@@AA@@@@A@@A@@A@@AB@A@@A@EC@@C@A@@@A@@A@@@A@@@@@@@@A@@@@@@AA@@@@ABA@@@A@@@@@C@A@@BCAA@@@C@@BC@@AAB@@@@@AAA@@@@A@@@@@@@@A@@@@A@@@A@@@@AA@A@@A@@@@A@@@@@@@@@@A@@@@A@@AA@@@@A@AA@@@B@@@@@@BB@@BBA@@@@B@@A@@B@@CBA@@@@@@AA@@@ABA@@@@@@@AA@@@@@@@A@@A@@A@@@@B@@@@@@@A@@@@@@@@@@AA@A@A@@@AAABA@@@@@@AAA@@@@@A@A@AAABA@@B@@A@@C@AA@@BA@@@A@@B@@A@C@A@@@AA@@@@B@@@@A@@A@A@A@@@AA@@@B@@@@A@A@@@@A@@B@@C@@AA@AB@A@@A@@BA@A@@@@AA@@AA@@B@@A@A@@@B@BA@@@A@@BA@@@@@AA@@@@@@@A@@AB@@@@A@A@@@@@A@@@ABAB@BAB@@@B@@AA@@AAACE@A@@@@A@@@@@ACA@@AA@@@@@@@@@@AA@@@A@A@AAAA@@@@A@@@@A@@A@@@@@A@AA@@A@AA@@@@@@AA@@@@@A@@@@@C@@@@@A@@@A@@BABA@@B@D@B@B@B@B@B@B@B@B@@@B@@AB@B@@AB@@@@A@A@@BA@@B@@AB@BA@@@A@@AA@@@@@A@@@A@@@@@@@@@A@@B@B@@@B@@@@@B@BBB@@A@@@@B@@@@B@AB@@AB@@@@@@@@@@A@@@A@@B@@@@@B@@@BAB@@@@@@@@@@A@@@A@@@A@@B@@@@@A@@@@AB@@@@@@A@@B@@A@@@C@@@@A@@AA@@@@AA@@@@A@@@A@@A@@A@@@AEEACAB@@ABCAAA@@@@@C@@@@B@AA@A@@@AB@@@@AB@@AB@@@@@@@BA@@@AA@@BA@@ABA@A@@AC@AA@A@@@@@AA@@@A@C@@@@BB@@@@@@@A@@@@B@@A@@@@@@@@@@@@A@@B@@A@@@@AB@@A@@@@@A@@@@@AB@@@@A@@@@A@@B@@AB@@@A@A@@ABA@@@@A@@@@@@@A@@@@@@AB@A@@A@BAA@AA@@A@@@@@AB@@A@@BA@@AB@@@@A@@B@@@@A@@A@@A@@A@@BB@@@A@@@A@@@AA@@A@@AA@@@BB@@A@A@@@A@@@AA@@@B@@A@@@AB@AB@@@@A@@@@@@B@@A@@@@@@A@@@@@@@@@@@@@BA@@@BB@AA@@BA@@A@@@A@@A@AB@@AA@@@@@B@B@@@B@B@AABA@@B@@@@@@B@@BB@@@A@A@A@@@@A@@A@@@AB@@@@@B@@@BB@@@@@AB@@@@@@A@@B@@@@@@@@@@A@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@AA@@B@@@@A@@@@@@@@@@@A@@BB@@@@@AB@@@@@@@@@@A@@@@@@A@@A@A@@@@@@@B@@@BA@@B@@A@@@@@A@B@@A@@@@@@@A@@@@BA@@B@@@@@@@@A@@@@A@@@BA@B@@@@@@B@@@@@@@@B@@@@@@@BB@@@@@@B@@@@B@@B@@B@@@@@@A@@B@AA@@@@B@@@@B@@B@@@@B@@@@@B@@@@B@@@@@@B@@@@@@@BA@@@@@@@@BBAB@@B@@@B@@BA@@@A@@@AB@@A@@B@@B@@@A@@@@B@@BB@@@@@@A@AB@@@@BB@@@@@@@@@B@A@@B@@@@@@@BB@B@@A@@A@@@@A@@@@@A@@@@BB@B@@B@@@@@B@BA@@BA@@@@DABD@@AB@@@A@@@@A@B@@BA@B@AB@@@@B@@@@@B@BA@@B@B@@@@@@@B@B@DABA@BA@@BB@BA@@@@@@B@@@BB@@BBBA@@@@@@@@@@BA@@B@@A@AB@@@@@@@BA@@@A@@@A@@@A@@B@@@B@@@B@@@BB@@@@@AB@@@A@@A@@@A@@@@@@B@@A@@@@@@A@AAC@@@@A@@@@BA@@B@@@@@AA@@@@@@@A@A@@@@B@AAB@BAB@@B@A@A@AB@@@@ABA@A@@B@@A@@BA@@@A@@@A@@B@@AA@AA@@AA@@@AAA@@A@@AA@AA@AA@@AA@BAAADB@@BA@@AAA@@ABA@A@AB@@C@@B@@@AA@@@@B@@@@@@@A@@@@@A@@@@@@A@@AAB@@@BACA@@@@@ABAA@@ABABA@@@@@A@@BAB@@@@@BB@@B@BA@@B@@A@@C@AA@@@@@A@@@A@@AAB@@@B@@ABB@@@A@@BAA@C@B@@A@@B@@@B@@BB@@AAAB@@@A@@@@@@@A@B@@@@@ACA@@AB@@@@@BBB@B@@@B@@@@ADA@@B@BA@@@ACA@@@@@A@@@@AA@A@@@AB@A@A@A@A@@@@BABA@@@@B@BB@AB@@@BC@A@@@A@@@A@@@@@AB@@@@@@A@@A@D@@@@A@AA@@@A@@@@AA@BA@A@@B@@A@A@A@@@@@A@@BA@BBA@@@BB@@@AB@@@B@@@@B@@A@AB@@@D@@A@@@@@@@@B@@A@@@@@@BD@@@@@AB@@@@AA@@@@@B@@@BA@@@@A@@@@@@A@@@BBA@@BAA@@AB@@@B@@@@@@A@@@A@A@@@A@@@A@@A@@@B@@@@@@@@A@@@A@BA@@@@A@@@A@A@@@@A@@B@@@DA@@@@A@A@@@@AB@@BB@@@@A@@A@@@@@A@@@@AAA@A@@@AAA@@@A@@A@A@@@@AA@@B@BCCB@@@@A@@@A@@AA@@@BA@@A@@B@@AA@@CA@@@A@AA@@@BA@A@A@@@@AAABAAAA@@AA@@@@@A@@AAC@@@B@B@B@AA@@@@A@@A@@@A@@@@ABA@@BA@@AA@@@BA@@B@@@@AB@@AB@B@@CB@@A@A@@@C@@B@@AABAA@A@@@AACAA@@A@@BA@A@@@@@@@@A@@@AA@@AA@@@AB@@@@A@A@A@@@@A@AA@@@@@A@@CA@@@A@@@@A@@@AA@@@@@@@AA@@@@B@@@@@@AB@@@D@@AA@@A@@@@B@@@BA@@B@@@BA@@@B@@BABBB@D@@A@@@@@A@@@A@A@A@@@A@@@AB@@@@@A@@@A@@@A@@A@@@@@@@A@C@@@@A@@A@A@A@@@A@@@@@A@@@@A@@@@@@@@A@@@@@AAAB@@@@A@@@A@A@A@A@AAA@A@A@@AA@A@AA@@A@A@A@A@CA@@A@AB@@A@A@@@@D@BAB@@AAB@AAAE@@@@A@A@@@A@A@A@@@AA@A@A@@@@C@AB@@A@A@@@A@@AA@@@AA@@@@AAA@A@@@AA@@A@@A@@AA@AA@@A@A@@@A@@@A@@@@@A@@@A@AA@@A@@@ABC@@AA@@@@A@@@AA@@@AB@@@BA@@AA@@@A@@@@@@@@AA@@A@@@@@@@@@ABA@@A@A@A@@@@A@A@@@AC@@A@AA@AA@@A@@@AA@AA@@@@@A@AAA@@@@@AAA@A@ACG@AA@@A@@@@@A@@@A@@C@@A@@@@@@B@@@BA@@AA@@@AC@AA@@A@@A@@A@@A@@@AA@@@A@@A@@A@A@@AAAA@@A@@A@A@@@A@A@A@AAA@AB@A@@A@@@@@@BA@ADA@@@@@B@@@@BA@@@@@A@@@ABABA@@@@@@BA@@@@B@@@BA@@B@@AB@@A@@@A@@@@@@B@@ABB@@B@@@BB@BB@B@B@@@BA@BB@@A@@BA@@@AB@@@@AA@@@@C@A@@AA@@@AB@@AB@@A@@@@@@@AA@@A@@@A@@BA@@@@A@AA@@@A@@AA@A@A@@@@@A@@@A@@BABA@@BA@@DA@@B@@A@@B@@A@@@@B@B@@@BBBBB@@@BABA@@@A@@B@@A@@BC@@AA@@A@@A@A@@BA@AA@@@ACB@@@@A@@B@@A@A@@@@BA@AA@@A@@@A@@@@@A@@@A@@@AA@@@BAB@@AA@@@@A@AA@@AB@@A@@@A@@@AA@@A@@@@@@@@@A@@@@@A@A@@@A@@@@@A@@@A@@@AA@@@@@@A@@@A@@@@@@@AA@BA@A@@@@@@@@@@@@@@@@@@@A@@@A@@BAA@@@@@@@@@AA@@@@@@@@@@AB@@@@@@@AAA@A@A@A@A@@@B@DB@@AB@@A@@@C@@@B@@B@@AB@@@@@@@@@AA@@A@@AA@@A@@BAB@@ABAB@@A@ADA@@@@BA@@@@BB@@@B@@B@@B@@@@B@B@@A@@@@B@@@@@@@B@@@B@@@D@@@@@B@BB@@BA@@B@@@B@@AB@@BB@@@B@@@BABAB@BA@@B@BABA@@B@@BB@BBB@@AB@@ABB@@BBB@@@B@@AB@B@@@@B@@BBB@@@B@B@@D@@B@@B@@@BBB@@@@@@B@@B@@BB@B@@@@@@@B@@BB@@@A@@B@@@@@@A@BB@@A@A@A@@BA@@@A@@B@@A@@@BB@@@B@B@@@B@@AB@@@BE@@B@@@@@BA@@@AB@BCBAB@@@@BB@@@D@B@BB@@BBB@B@@@BBB@B@@BB@B@@@B@@B@@BBB@@@@A@BBB@BB@BBBBBB@B@BB@@@@@BB@@@@B@@@B@B@@@BA@@@BB@B@@@@@B@@@BAD@@A@@@@B@@@BAB@@@B@B@@@@B@@BB@@@B@@BBB@@B@@@BA@@@@B@B@@@@@@B@@@BA@@@@@ABB@B@B@B@B@ADCD@B@B@@@@A@BB@@BB@@B@@B@@BB@B@B@@BB@@B@BBB@@BB@B@@@@B@@B@@A@@@@B@AB@@A@BB@@AB@B@B@@@B@BB@@BB@@@@@@B@@A@@B@BBBB@@@BB@@BB@@B@@B@BB@DB@@B@B@D@B@@@@BBAB@B@B@@@@@@B@@A@@BA@@@@@B@@@@@@@BB@@@@@@@@@B@@BA@A@@BA@BB@@BBA@@@B@BB@@@@@A@ABA@@BB@@@BB@@B@@B@@@BBB@@B@@@@B@@@@AB@@@B@B@@B@BB@@B@@@A@@@A@@@A@@BA@@@BB@@@@A@@B@@@@ABBB@@@BB@B@@@@@@AB@BBG|JZ@@@BA@@@@@@@@@ABABAB@@@@A@@@@@@@@@@@@@@B@BA@@@@@@@@@@@A@@A@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@AB@@@@@@@@BD@@@@@@@@A@@@B@@@BB@@@@@B@@@@@@@@@B@@@@@@A@@A@@@@A@@@@@@B@@@@@@@@BB@@@@@@B@@@B@@@@@B@@@@@@@@@UV@@A@@@@@AB@@@@@@A@A@A@@@@BDD@F@@@@BBD@@B@BB@@@@@AAB@@@@B@@@@B@@@@@B@@@B@@@@@@DDB@@B@B@B@@BBBB@BBBBB@D@@BA@@BB@@@@@@@@@@BB@@@ADBBA@BBBB@BB@@@@BABA@@@A@@B@B@BB@@F@@C@AB@@@@@B@B@D@B@@@B@B@B@BB@@@@B@@A@@@@B@@@@AB@@@@A@@A@@AB@B@B@BAB@@@B@@@@@@AB@B"],encodeOffsets:[[117327,28505]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,5 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("宜春",{type:"FeatureCollection",features:[{type:"Feature",id:"360921",properties:{name:"奉新县",cp:[115.400491,28.688423],childNum:1},geometry:{type:"Polygon",coordinates:["@@BBB@@@@@@@@@CA@@@A@@@@AA@@BA@@@A@@B@@@@@@@@E@@BAA@@@A@A@C@@@@B@BA@@A@@A@@@@@@@@A@@BA@A@@@AA@AB@@A@C@@@A@@@B@BB@@A@@B@@A@A@A@A@@B@@@@AAA@@AB@AABA@@@@@@@A@A@@@A@@@AA@@@@A@@@@A@@A@A@@@@A@A@@A@@A@@A@@@AA@@ACA@AAA@@A@@@A@A@@@A@AB@A@@@@A@@B@@A@A@A@@@C@@@@@@@AA@@A@@@@B@@@@A@@@@A@AA@A@A@A@AACAAB@@A@@AS_AA@CA@AA@@ACAA@AA@@@A@ABA@@@A@@AA@@@A@AB@@A@@B@B@@AB@@@@AB@@@@C@@@CA@@A@@@AAAB@@@@A@@@@@A@@@@@A@@A@@@@@@A@@@@@@@A@@@@@@@@BA@C@A@@@@@@AA@@B@BA@A@A@@@A@C@@@A@@@@@A@ADABA@A@@@@B@BA@@@@BG@AA@@AB@@@BA@@B@BAD@BB@@@@B@@@B@@ABBB@B@B@D@@@@@BAB@@@@BBD@@@@BAB@@@@@B@@AB@@A@@@AB@@@BA@A@A@GFABA@A@@@@BB@@BAAABA@A@@A@@@A@@@@@A@@A@A@A@@@AA@AA@@@A@@@A@@@AEA@@A@@@A@@A@@@@@ABA@@B@AA@A@@B@@A@A@@@@B@@@BC@@@@@@@A@@@A@@BA@C@@AA@A@A@@@CA@@@@ABA@@@@AAA@@@AA@@AA@AB@@A@A@@@A@@@@@@B@B@B@@A@A@A@@@@@@@A@@A@@@BA@@B@@@@BB@@@BA@AB@BAB@B@B@@@B@BFB@BB@@@@@@@@B@@C@@AA@AAA@@BA@@@@AABA@AAAB@@@B@@A@@B@@@B@B@@@BA@@B@@@BA@A@@@A@@@A@A@@B@@AAA@@@AAA@@@A@A@A@A@A@C@C@AA@@AAAA@@@AA@@@@AAA@@@@A@@@@A@@@@AC@@EAC@AA@@A@@@A@A@AAA@@@A@A@@BAB@@AA@@ABA@@@A@A@A@@@@B@@@BA@@@A@C@@@@@C@@B@@AACB@AA@@@ABA@@B@@@@@@A@@A@@@@A@@@A@A@@@@@@B@BA@@@@@@@@AA@@@@@@@A@A@@@@A@A@A@@@@AA@@@AA@@@@AA@@AA@@@@AA@@@A@@@AA@@A@@A@AAA@A@A@@AA@A@@AC@@C@@@@A@@@@A@@@@A@@A@@@@@@A@@A@AA@@A@ABA@A@@@ABA@@@AACA@@A@@@@@A@@@A@A@@@ABA@@BA@@BC@@@AB@@A@@@ABA@@B@@@BA@AB@@ABA@@AAAAAAAA@A@A@AAA@A@AA@@AAAAA@A@AAC@@@AAA@@@@@BD@B@@@BA@@A@@@B@@@@A@@B@@A@A@@BA@AB@@@B@B@@@BAD@BA@A@AA@@AB@B@@A@A@@@A@A@A@@BA@AB@@BBAD@B@@AD@BA@@B@@A@AB@@A@ABAB@B@B@@@B@BAB@BAB@@A@C@@@A@@BA@@@A@@@A@@BA@@@A@@BA@@B@@@@AB@@AB@@A@ABABA@ABCB@@CBA@AB@@@B@@AB@@@BAB@@AAC@A@A@A@@AABA@@@@@@@@@A@A@@@@@AB@@@@BB@@@BA@@@@@BB@@BBB@@@B@BBB@@@@BBB@BAB@BCBAB@@@BB@@@@B@@@BA@ABA@@B@B@B@BA@AB@BAB@DAB@BAB@BCB@BADABABADAB@@A@AB@@@@@DAB@B@@@D@B@@A@@BBB@B@B@@@@D@B@D@DDFB@@@B@@B@B@BB@@@@B@BB@@DFBBBD@BBBB@B@B@JADAB@@@BBB@BBDBDDBBB@@BB@BBDBBBBBBBB@FBFBB@F@@@@@BBDFBBBB@@@BAH@D@B@BAB@BAF@@AB@D@@@BCDA@AB@D@B@@BB@BD@FBBBBBBBBB@BDDDFBDB@@B@@B@FAB@BAB@DABABAB@@A@ABA@@BABAB@@@@E@A@@BA@A@ADADC@ABABCB@@AB@@@BC@@D@@CB@@A@@B@@BB@BD@@BBD@B@B@@@B@@@BBB@FBB@B@@@@B@@@@@B@B@B@@BBDD@@BB@@@@B@B@B@@@B@BBB@@@B@BA@@DAB@@AB@BD@BBB@D@@@BAD@BABCB@@@B@@@B@B@B@@BB@@B@BBB@BBBAB@@@B@@@@B@F@@B@B@B@BA@@BABABA@@B@B@B@B@@@D@B@B@B@DBBB@@@B@@@B@@@D@BBDDB@FBBBBBDB@@BD@B@@@BBDBBDBDBB@@B@BBBBBBBFBB@B@BBD@B@B@@ADABAB@@@B@B@@@@@BBB@@BB@BDD@@DB@@BB@@@BB@B@@@D@B@B@@@BBBBDBBBB@BB@@@@@A@@BABABAB@@@B@B@B@B@HAD@D@DBB@B@B@@@BA@AB@DA@@B@BADCDCBA@ABE@@B@@@BAB@BA@@B@D@D@@@B@B@@@B@@@B@BAB@B@BBBBBB@B@@B@B@BAF@DAD@BADADE@@@@B@B@BAB@@@@A@@@A@A@CB@BBFBDBD@D@D@D@B@@@DBBBJDB@@@B@DCB@@@BB@@@@DBBBB@D@B@@BB@BBBB@@BD@@BB@@D@@@DAF@D@B@B@BB@@B@@B@@BD@@@@BBD@@@B@BA@@B@BBB@@@B@@@D@D@@@BB@B@@BB@@@B@B@B@D@@@D@B@@@B@@@@@@@BB@B@@AB@BA@AB@@@@@B@BB@@BB@BDB@B@B@BFDB@BBDB@@B@BD@@B@FB@BB@@BB@B@@@D@B@B@B@DBD@DBDD@@B@B@B@LFDBDBB@BBD@DDDBBBB@B@D@B@B@FAFC@@B@DBB@B@BB@@BBB@@@DAB@BB@@B@@@BD@@DBDB@@@@@@BE@E@A@C@ABA@@BAB@@@DC@A@@B@@ABA@@@@@@BB@@B@B@FAB@D@B@@ADABA@@B@B@BAB@B@@AD@B@B@@AD@HAB@B@B@D@B@B@@@B@BB@@B@@@B@DAHAB@BC@ABA@@B@B@B@BB@@B@BB@@B@BBBBBBB@B@@@B@BDB@B@@@F@B@@@B@BCDC@@B@@BDBDBD@DB@@B@BAFA@@B@@@B@@@F@D@D@@@B@@AB@BA@@@@B@D@DABBD@B@@@B@DA@ADCB@B@DAD@@@BABABA@@B@@@DBB@@BFDB@@BB@@@B@@@D@B@D@B@BBB@BBB@@@B@@BB@D@B@B@B@@@@@B@B@BABADABAD@BA@@AAAA@A@@@AB@BC@@@A@A@A@C@AB@B@@AB@@ABC@@BA@@BA@@@A@A@@CC@@@A@E@@BE@CBA@ADABABCB@DAFCD@@@BABABADA@@BA@BB@BBB@@BB@B@@A@@BEBA@@B@@@B@D@@@B@DABAD@@@B@B@B@DADAB@@@@BB@@@@@@A@A@@@@D@@@@@@ABA@A@AB@DABA@@@A@AB@@AB@D@DCB@@@BABA@@B@@@@@@BBB@B@BBB@BA@@@@BB@@BBB@BAB@DB@@DBB@@B@BB@@@@@B@B@B@B@B@@B@BAD@BA@BB@DABAB@B@@@@B@@FB@B@@@@@B@BB@@@@@BB@B@B@B@F@DAB@B@@AD@BBB@BBD@@BBDBB@@BBB@@@B@BCBA@A@@@ABED@BA@C@@@A@@@BBB@DABBD@FBB@B@BBB@@B@B@@@@@B@@@@@B@@@BA@@@A@A@@@A@@B@@AD@@@BC@@B@@AD@DADABCBABAB@@@BA@@@AB@@ABA@@BCDAD@B@B@B@@B@@@B@@@BB@BBB@B@BA@@B@@@BB@BB@B@@BB@@@@@B@@@@@BB@@@AB@@@@BBB@@AB@@AB@B@B@@@BA@@B@B@BAB@B@B@B@BA@@BABA@@B@B@FBB@@@BA@@BA@ABA@@@AB@BBB@BADAB@B@B@@BBA@@BA@@D@@@DAB@BA@@B@@@BBB@BB@@@@BB@@B@@@@@@@B@ADAD@@BB@B@@@@DBD@B@@@B@@ABCB@@@B@@BB@@D@@@B@@@AB@@A@@@@B@B@D@B@BA@@B@@@@@B@BBB@BCB@BAB@@@@@AC@@@A@AB@B@@@B@@@B@BAB@B@@@D@BA@@@AA@@@CBA@A@@@@@@@@@@AB@HAHAB@BAFCB@@@@@@AA@@AACA@@A@A@@AC@CA@@A@@@AAAB@BB@A@@AAA@C@@A@@@@B@@@D@@ABB@@B@@A@@@C@@@@B@@A@@@C@@B@B@@@B@@C@A@A@AA@@@A@A@@B@@@@AB@A@A@@@@@C@@@CE@AA@AAE@@CC@@@ABA@A@@@A@A@@@@@A@@A@@@AA@@@@A@BA@@@@B@B@@@BA@@@@@@A@@@@A@@@@AABBABA@@AA@@@@C@A@@@A@@B@C@AA@@@AC@A@A@@@A@@BA@A@@@@@A@@@@B@@A@@@@@@@A@@@A@@@@@@AB@@@@@@AAA@@B@@A@@BAAAA@A@@@A@@AC@C@@@@@@@@AA@ABA@A@A@@@A@@@A@@@@A@@@@BB@@@A@@@@@A@@@@B@@B@@@@BAB@B@@@B@DA@@@@@@AA@A@A@@AA@@@@BB@@@@BB@A@@A@@A@AA@AA@@@A@@B@@@@AA@AA@@@A@@A@A@@@A@@@@@@@C@A@CB@@A@@@A@@@@@A@A@A@@@@@@@AB@A@@A@AA@@AAAA@@@@@B@@@@@B@@AAAA@@@@A@@B@@A@@@@ABA@A@@A@@@@@@AB@@@@A@@@@@A@@@@@A@@@@B@@B@@@AB@AA@A@@@A@@@@B@@A@A@@@@@@@@B@@@@A@@@A@@@@@@A@@@A@AA@@@@@@@@BC@@DC@@B@@@@A@@A@@@A@BCBA@ABA@@AAC@@@@@@A@@@@@@BA@A@@@@BA@@B@@@B@@@BAB@@@@A@C@A@@@@@@B@@@AA@@@@@@AA@@A@A@@@@A@@@AB@B@@@F@B@@AB@@@BAB@B@@@DABB@@BABABA@A@ABCBCB@DCB@@@B@@@F@B@BBBB@@@B@B@B@@@@@B@@@D@@@BB@B@B@B@BABA@@BA@@B@@@B@B@@B@B@B@B@@@@@B@BB@@BB@FAB@BB@@B@D@D@@AB@FAD@FAB@@@B@DAH@D@B@DB@@BBDDBD@@@DB@B@D@BAB@DCB@@ABAB@@A@@@@@@BAB@@@DAB@B@B@@@B@@@B@@B@@B@@@D@@BB@@@B@BB@@B@@@@@B@@@@A@@F@DAB@B@@@FC@@B@@@BA@@CA@@@A@@@ABA@@@A@@A@@@A@A@A@@@@A@@@AB@@AA@@A@@CACA@ACA@A@@@A@A@@A@@A@@AA@CACAA@@BA@IBABCDA@@@BB@@@BAB@@A@ABCAAAA@@@A@A@@AAB@B@BA@@@@B@B@@@@@AC@@@AA@@A@@@@@@A@@@@@@A@@AA@@B@@AA@@A@@@@AA@@@AA@@@@AA@@@@A@B@@@BB@@BA@A@@AAC@AA@@A@@B@@A@@@@A@@@@C@@A@@A@@@AAAAE@@BA@@@A@@@A@@A@@@@@BA@@AA@A@@@C@@A@@@BA@A@C@C@A@C@@@E@@A@AA@@AA@@@@B@BBB@@C@E@A@ABB@A@@B@BA@@@A@AB@@A@@BAC@AAA@@@A@@@A@A@@@@@BA@@B@@@A@@A@@@@BAAA@@A@@@@@DCBA@@@ABAB@BA@@@AA@@@AA@@AAAA@@A@@AAA@@AA@@A@A@@A@A@AA@@@@@A@@AA@A@@@@BA@@B@@A@@@A@@@B@@AB@@@@@A@@A@@@A@@AA@@@BA@@B@@B@@B@@@@AB@@@AA@@@@@@@@AA@@@@A@AA@@@A@A@A@A@@A@@@@D@@@@C@@A@@@@@@A@@@A@@BAB@@@BA@@B@A@@@@@A@@@A@A@@@@A@A@@@@@A@A@AB@A@AA@AA@@@@BABA@@@@@E@A@@@A@@@@B@BBB@@E@@@@@@@@BAB@BAB@@@@AA@@AB@@A@@B@B@@@BB@@@B@BA@@@@@@AB@BA@@@A@@BB@ADBBA@@@A@AA@BA@@@A@A@@@@A@@@@A@@@EB@@@@AA@A@@@ACAA@A@@@AA@@A@A@@@@@A@@B@@@@@B@@AB@BA@A@A@@@AA@ABE@@B@@@@@@@@@@BB@@A@A@@@@B@@B@B@@B@@@@@@A@A@@A@C@AA@AA@A@@@@A@@@@@@@@CA@@A@@@AB@BA@@@@AB@@A@@@@@@C@@A@@@@@@B@B@B@@A@@C@C@@@@CAA@@AA@A@@A@A@@AAA@@CA@@A@@@AA@AA@@AAAAAAAB@@ABABA@@@@ABABA@@B@A@@@@AC@AAC@@@@AA@BA@@A@@AAA@@B@@@A@@A@A@@B@@@@BB@@@@A@C@@@@A@@A@@@@AB@@@@@@@A@@A@@B@@@AA@@@@A@@BAB@@@@@A@@@@BC@@@@@@A@@@C@A@AB@B@B@@@B@@C@@@@A@@AAC@@@@AB@@@@A@A@@A@AB@@@B@BA@A@@@AA@@@A@C@@@@@A@A@@A@@@@@A@BB@@@@AA@AAA@@@@ABAA@@@@@@@B@@AA@@@AC@A@@@AA@@DA@@@@A@A@@@A@@A@AC@@@@AA@@@@@@BA@@@@@A@@@@@@@AA@AA@@@@BAD@@A@@@@@@@C@@@@@@@A@@@BAAAA@@@A@AD@B@@@@@B@@@@A@ABA@A@@@@B@@DBBB@@A@A@@@@@@@@B@@B@@@@A@@BB@@AB@B@BBB@@@B@@@B@@@B@@@BAB@BB@@BB@@B@@@A@@@A@@@@B@@@@@BBA@@D@B@@@B@@BBA@@B@@@@A@@B@@B@@@@B@@A@@BAB@B@@AB@@@AA@A@AA@@@BA@@BAB@@A@@@BB@@@B@@@B@@@@@@@@A@@@@@@@@AAA@@@B@@@B@@A@@@@BB@@BA@@@AAA@AB@B@B@BCA@AA@AA@@@A@@@@A@AACA@@@@@@@@@B@@A@CB@@AB@B@@@@ABA@@BB@@@BB@@@@A@A@@@@@CA@@ABA@@@@@@A@@@@A@A@@BA@@@@@@@@A@@@A@@@@@@A@@B@@@B@@AAA@@C@@A@@@@BAB@BB@BBA@@@AA@@A@AA@@@@D@@@@@@C@@@@A@B@@@@@@A@AA@@AA@@A@@@@@@BBBB@@B@B@@A@@@@@AC@AA@@@@B@BBB@BB@@B@D@@@A@@AA@@CB@@@B@@@@A@@AA@@CB@@@@BADA@@A@@AAB@@A@@@@BA@@@@B@B@@@@@A@@A@B@@@C@@A@@@@B@B@@@@A@@B@@@@@BA@@B@@@B@B@BB@@@A@@@@@A@A@@@@A@AA@@@@B@@@@@@A@AAA@AA@@A@@@B@B@B@@BBA@@@@@@AAA@A@@@@@@A@@@@@@@B@@A@@A@@@AB@@@@@@@A@B@@@C@@AAB@AA@@A@@@AB@B@@@@@@@@AA@@@BA@@A@@@@@@A@@@@@@AA@@@A@A@@A@@@A@@@AAB@A@@@B@@@@A@@AA@@@@@@@@@A@@@AA@@@@AA@@ABA@@@A@@@AB@BB@@@BC@@@@@B@@AB@@@@A@@@CAA@CA@@A@@@A@@A@AAACC@AA@AA@@CCA@@AA@@@@@A@A@@@A@CA@@@AAA@@A@@@@@C@AB@@A@@BAD@@@B@@@@@B@@@@@B@@@D@@@@A@@@@A@A@A@@@@@@@@@B@@@BABA@@BADAB@@AF@@@@@DCF@BA@@B@B@BAB@@EBA@AF@D@D@B@@@@AB@@@AA@@BA@@@@BA@@D@@CBABA@@B@@@@@B@@@@A@@A@@AB@AA@@BB@A@BB@@A@@A@@AB@B@BA@@@@@@BA@@@@AC@A@@A@@A@@@@@@@A@@@@@@@@@@A@@@@@@@@AAA@@@A@@AA@@AA@@@B@@AA@@@A@@@@@@A@@A@@A@@A@@@AC@@@A@A@@@@@BA@AB@@@@A@@A@@@AAA@@A@A@@@@@@@BBBB@B@@A@A@A@@@AA@@@BA@@@@@@@AA@A@@@A@@@@BA@@@AA@@@@@@@AD@B@B@@@@A@@A@@@AA@AB@@@B@@@@@@@@C@@BC@AD@@A@@AC@AAAA@@@@@@@AA@A@@@ABEBABEBE@IL@B@@@@B@DBDB@B@@@@@BA@AD@@A@A@@BA@@@@B@@@B@D@@@@BB@BA@A@@B@@BB@@@B@B@@AB@@@BAB@@A@A@AA@@@B@@@@AB@@A@C@AB@@A@@BA@@@@@@@A@@@A@A@@B@@A@ABA@@@A@@@AB@@AAA@A@@@A@@@A@@@A@@@A@@@A@AB@@@@@BA@@@ABA@AB@@@@A@A@C@A@A@@@@@A@A@@@C@A@@AA@@@A@A@CAA@@BCBABA@A@AB@@@@@@@BBB@@@@@D@@A@@@@@@A@AAA@AC@@@A@A@@@A@@@CA@@@@A@CA@@C@A@@@A@C@A@A@A@A@AAA@AAA@AAABA@AA@@@@AA@@CAA@AAA@@@A@A@@@A@@@CB@BA@@BA@A@A@A@@A@@B@@AB@@@A@A@@@AA@AA@A@@@@@@@AA@@AB@@CBAA@@BA@@@@AA@@A@@@@@A@@BA@@@AAAB@AA@A@@@@@@@CB@AA@@@A@@@A@A@ABA@@@AB@B@BA@AB@@A@A@@@ABA@@@A@ABA@@@@@A@@AA@@@A@BB@@A@@@A@@@A@@@A@A@@@ABAB@@@ABA@@@A@@BA@@@@@A@A@@@@"],encodeOffsets:[[117876,29318]]}},{type:"Feature",id:"360922",properties:{name:"万载县",cp:[114.444854,28.105689],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BA@@@@AA@BBA@AA@@AA@@@AA@BA@@@A@AA@@@A@@@@@@@AAA@A@@@@BA@@@A@@B@@B@@@@@ABA@AB@A@A@@A@@A@@@@@AAA@@@@A@@B@@@A@@AA@@@@A@@AA@@@AA@B@@AD@@@@@@A@@@AB@AA@@@A@@D@B@@@B@B@@@@A@@B@@B@@@@B@@@BB@B@@@@BB@@@BDAD@@B@@@@@@@@BBB@@@B@@@@A@A@@@@@@B@@@@@@@BA@@@A@A@@@@@@B@@@@@@@@AB@@A@@@@@@@@@@@@@@@@B@@@B@@@@A@@@@@@B@@AA@A@@A@@@@@@AA@@@@B@@@@AA@@@@A@@BB@@@@@AD@@A@@@@@AD@@AA@@@@A@@A@B@@@@AB@@@@AAAB@@AB@@@A@@@@@BA@@@A@@@@@A@@AA@@@@B@@A@AB@@@AA@@@@@A@A@A@A@ADB@@@@@A@@@@B@@@B@@@@@@@@@B@@BAB@@@B@@@B@@@B@@@@BA@@@@B@DBD@@AB@B@@BDBB@BB@@@B@@@@@AB@@@@BBBA@@@B@@B@@@@B@@B@@@@B@@@@@@@@B@@A@@B@@@BB@B@@BD@BCB@@@BB@@@B@@@@B@@@@B@@B@@@@@@B@@B@@@@BA@B@@B@@BB@@@D@@BAB@B@BAB@B@@AB@@A@@@@A@@@B@@@B@BC@@BA@@@@@A@@@@@@AB@@@@A@@AA@BA@AB@@@B@@@B@@@B@@B@@@@B@@@@@@A@AA@@@B@@@@@@AB@@@@B@@B@@@@@B@@@B@@@@A@@@A@AB@@@AA@@@A@A@@BA@@B@BB@@@@BA@A@@DABA@@B@@@@A@AAA@A@@@@@A@@@A@@AA@A@@@@@A@@@A@A@@@A@ABAB@@@B@@AB@@@@AB@A@A@A@@@A@@@A@@@@@@B@A@@A@@@@@BA@@B@@AA@@A@@@@AAAA@BCAA@@A@@AA@AA@@AA@A@@@@@@@@@AA@@AAAA@@@@@A@@@@@@B@@A@@B@@@A@@AC@@A@A@A@@@A@A@@@@AA@A@@@@@C@@A@@A@@@B@A@A@@@AB@@@BA@A@@@AAA@A@@@@@@@@BA@@B@@@B@@AB@BAB@@@B@@@BA@@B@B@@AB@@@@A@@B@@A@@BA@@BA@@BA@@B@@@@@B@@@@B@@@A@@B@@@@@@@B@@@@@@@@@@@@@@@B@@AB@BA@@@A@@@@@A@@@AB@@A@@@A@A@A@@B@@@BA@@@@@A@AA@@A@@@@@A@AB@BA@AB@@@@AB@@AB@@@@@@@@B@@@B@@@@@BA@@@@@@BB@@@@@BB@@A@@@@B@@AB@B@@@@@B@@@B@@@@@@B@B@BA@@B@B@@AB@@@@@B@@@B@@@@@@@@@@A@@BA@@@A@@B@@AB@B@@@B@B@@@BA@@B@@C@@@C@AF@B@B@FAB@D@F@D@D@@@@@B@BB@@@@B@@@BA@@@AB@@@B@@A@@@A@@@@BA@@AA@C@@@ABAB@D@J@@@@@B@B@@@BBB@B@@@@@BA@@B@@@@@BA@@JCBIBCFCFADAF@@AB@F@BBBDBF@D@B@JBBBFJBFBBHABAD@FAF@D@D@FDD@FAF@B@FBBBDBD@DABCBCB@L@B@R@HBFDD@FBBB@BDDF@FBF@BBD@L@@@D@D@BBBBBB@DAB@D@DBD@DAD@B@@ADBDBBDDDBDDBDB@DBDDDBBF@BDBDDBDBBBH@BHDDBDBDDBBDDB@BBBD@DAD@D@BBB@@DBDBD@B@D@BAB@FDNFD@F@D@BAHADABAB@BB@B@B@DDDAF@DBHBFB@FAD@DBD@B@FD@D@B@DADBD@BCDAFBDBF@BDBD@F@DDDBD@F@D@HBDDBBBBDBDBDB@FDDD@@@BDBDF@BBB@DBBDBBD@FBDBDDDB@BBB@B@B@DADABCBAFCFAD@B@DBBBBBDDDBB@DDBFAB@DD@DADCDCDIFABADBDFDDDFBBF@B@@@@DBD@@@BBB@@BB@@BB@D@F@B@@@BAB@@AB@@@HD@@BB@@B@@BB@BAD@@@DBDBB@B@B@@@BBD@BBDBD@B@F@@@@@@@BD@D@@BBBB@@@B@BABAB@@@B@@HB@B@@@@@BA@@@A@@B@B@@BB@B@B@@A@@@ABA@@B@@@B@@ABADAB@@@@B@BB@@BBBB@@BBD@BBBBB@B@@@BAB@@@@@D@@@DBFB@@@B@BDAB@@@BB@@@B@@@B@BB@B@BB@@DF@BBBB@@@B@BA@@B@B@DABBF@@@B@@ABAB@B@B@FBBBB@@@BB@@BBFA@@B@B@D@DBB@BB@@@@DBB@F@@@B@BBB@@@BAB@B@FBBBDBBBBB@@@D@@BB@@BBBF@@B@DBFBB@B@D@@@@BB@BBB@BB@BBBBB@B@BBBDDDDBBB@@BD@B@@@BA@@B@@@@ABA@@B@B@B@BCDA@@B@B@BAB@BAB@FA@@@@A@A@EA@BA@@@@A@@@AA@AC@@AA@C@@BA@ABAAA@A@A@@BAB@BABA@@@AA@@@A@@@@ABA@@B@@@B@@@B@@@@AAA@@A@@AAAA@@@B@D@D@B@B@BAB@D@B@D@BAB@BA@@B@@A@@@A@A@@@@DBB@B@@AFCD@BA@A@A@A@AA@ABAB@@A@@@@@BCBAA@@@@@@AD@D@@@@@BA@@FA@@B@@@BABABA@A@@BCBA@@@@B@@B@B@@@@D@B@@A@@BA@@@AB@D@D@D@H@@@BA@@@@@A@@B@B@@@B@B@B@B@@A@@@@AAAA@@@A@@BABAB@@A@A@@@A@@AA@@@@@ABAB@B@@ABA@@DAB@@@DABABAB@B@FBDBD@BBD@B@B@@@@A@A@A@@BA@A@AAA@A@A@G@@@AB@@@B@D@B@B@D@BABA@@BCB@@@BABABABA@@@@@A@AB@@ABAB@@A@A@@BABBB@F@@@@@@@@AA@AAAA@@AA@@B@@@F@@@BAB@@@@@B@@BB@BBBA@@B@@A@@@AB@@ADAB@DA@AB@B@DBFBB@DCLE@@@@BA@A@C@@@AHE@A@AB@@BB@@ABABADCB@@@@@B@AB@DAB@@@@B@@ADA@A@A@@AC@@@@B@@@@@@BB@B@@@BA@@@@AA@@B@@@DAB@B@B@@@D@@BB@@A@@@A@@BC@@DA@ABC@@@A@@A@@@ABABCF@@@BA@@@@@@@AA@@B@@@BADEBA@@BA@A@@B@@@FF@@@@@A@@BA@@B@B@@@@@@AA@@@@A@@A@AAAA@AAA@AB@BA@AB@@@@B@@@@BBF@@@B@@BB@@AB@@@@BB@@@B@@B@@B@@A@A@@B@@@@A@AB@@@@A@@AAAC@@@@BABA@@BAA@@C@@@ABC@A@A@@@@BA@@A@@@A@@A@@BA@A@@@@AA@CAAAC@@@@@@@ABA@@B@BA@@@@@A@A@@AB@@A@A@AB@@AAA@@A@@@@@@DB@@@@B@BA@@@@A@@@@A@@B@AA@C@A@@@@@@@@B@@@D@BAB@@@@@@@@AA@@AA@@@C@@@A@@@A@@@@C@A@@@AA@@A@@B@@A@@B@@B@@B@BAB@@@@@@C@@@@A@@AB@@@AAA@@@@@BA@C@@@A@@@@BB@@B@@BB@FBB@@@B@@@@C@A@@CC@AA@@A@@A@@@@@@B@@@@BBA@@@@@D@@B@B@@@@CC@@A@@@@B@@@B@@AA@@A@@@@@A@@@@@B@@AB@@A@@@@@A@A@@@@BAAA@@BAB@@AA@@@AB@@@A@@@ABA@A@@@ACAA@A@CA@@@@@A@@@@A@@A@@AE@@A@@@EDCBABABA@@@A@@@@AB@BABA@@@A@@A@A@@@EG@@A@@AA@@@A@@@A@@@A@@A@@@AA@@@A@A@@B@@A@@@A@A@A@AA@@CA@@A@@@@BA@@@@@AB@@A@@@@@AA@@@ABA@@AAA@@ABA@@A@@B@@@A@@@@BAB@B@B@@@@@@@@@A@@@A@A@C@A@@BA@@@@A@@B@AA@@A@@@@@A@@@C@@@@A@@B@B@@@B@@@FCAA@@@@AB@@A@@A@@@AA@@B@@AB@@A@@A@@BA@@@C@C@A@@@A@@@A@@@@@@A@@@@B@H@BA@@@@@@C@A@@@@A@A@@@@B@@@@B@A@AB@@A@@@A@@@@@A@@B@B@@@@A@@@BA@@@A@@A@@@@@@BA@@A@@CB@@@@AA@ABA@@AA@@A@@@AA@@@@@A@@BADABA@AAA@@@@@@BAB@@AA@AAB@@@BAB@B@@AA@@@AAA@@@@@@A@@@AA@A@AB@BAAA@@@@@@@@A@@A@@@@@@A@@@@BA@@AA@@@@AB@@@@@@@@@A@@C@A@@A@@B@B@@@B@@@@AA@@@AA@@@@@@B@BA@@@@@@BBB@@@@@@AA@@@@A@A@@BA@@@A@A@@@@B@@@@@@A@@@@@@A@BA@AA@@@AA@@@@@@A@@@@@@C@A@@CA@@@B@@AB@@B@@@E@AB@@@ABABABA@@A@A@@@C@A@A@@AAA@@@@@@B@B@B@B@DADADCBABA@A@A@A@@A@A@CBA@ABA@A@@@C@GCAAAA@@@A@A@C@@@@@@BA@A@@@@B@@@@@@@B@@AA@@@@@@@@@B@@ABAB@@AB@@@@@@@@A@@AA@@@@@@@A@A@@@A@@@BA@@D@@@@A@@@@@A@@@@A@@AA@@@@A@@@A@@@@@@A@@@A@A@A@A@@BA@@@AA@@A@@@A@@@@@@B@@@@A@A@@A@@AABA@@@@AA@@B@@A@@@ACB@@@@@@@B@@@B@B@@@@@@@@@A@@A@@@@@@@@A@@@@@A@@@AA@@BA@A@@B@@@@@AAA@AB@B@@A@@@@AA@A@@A@@@A@A@@D@@@@AB@B@@AB@@@AA@@BE@AA@@@@AAA@@A@@BA@@@B@@B@@A@AA@@A@@A@@B@@@@A@A@@@@@@B@@B@@@@B@@@@@@@@@@AAA@@@@A@@@@A@@B@@@@@@A@@@@@@BA@@@ABA@@BA@A@@@@@@@A@@@A@@@@BB@@B@@@@AA@@AAAB@B@@@@A@@@@@@@@A@A@@@A@A@@@@A@C@@A@@@@B@@A@@@@A@@@@A@@B@@@@@@@ABA@@@@@AAA@@@@@@B@AA@@AA@BA@@A@@@@BA@@@A@@@@BB@B@@B@@@@@B@@B@ABB@@@@@D@@B@@@@AB@@CBAA@@@@@A@@@BADA@AB@@A@A@@@AAAAA@A@A@CA@@AA@AA@@CCCA@@AA@@CAAA@@@AA@@A@@BABA@A@AA@@@AAAA@@A@A@@@AA@A@A@A@A@AA@AA@@AAEE@@AA@@AAC@AAEAECCAAA@@CBA@@@A@C@AACA@@@@@A@A@E@E@C@A@A@@C@@AA@@C@A@C@@A@@A@@A@@@@B@@@@@A@A@@@@B@@@AA@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@A@@A@@@A@@@@@@B@@A@@@AAA@@@A@@@@A@@@@@@AA@@AD@@@@CB@@@B@@G@@A@@@@@@A@@@@B@@@@A@B@@B@@@BA@@A@@@@@@@@A@@@@@@@@@AA@@@@@B@@A@@@@AA@@@@AA@@@@AA@@@@@AA@@@@A@@A@@AA@@@@A@@@A@@@@@@AAB@@AA@A@@@@@@@@@@A@@@@A@@ABA@@AA@@@@C@@@@A@@A@@B@@C@@A@@@AA@CA@@A@BA@AD@@AB@B@B@@@@@BA@@@@@@@@BB@@@@BBA@A@B@@@@@B@B@@@@CB@@@BA@A@ABAA@@@@DA@@@AA@@@@@B@@@@AAA@A@@@A@@@@A@@@C@@@@A@@@B@BA@@@@AA@@@@ABA@@@@A@@BAA@@@@@@C@CA@@@@@BAAA@A@@@AA@@AA@@@BA@@@C@@@@@BB@@@@B@@BB@@B@@@@A@@@@@@@@B@@@BA@@@AB@@@A@@@@@A@@@@A@@B@@CCA@@AAAA@@@A@AA@@BA@@AA@@@@ADA@A@@A@@@A@@AB@@@BA@@AAB@@ABABAB@@AA@BAA@@A@@@@@A@@AA@C@AAA@A@@AA@ABA@@@A@A@@@@@A@@A@@@@@B@@A@@A@@A@@@@@@BAA@@A@@@A@@@@A@@A@@@A@A@A@@@@@@@@B@@@B@@@B@@@@B@@@@@A@@BB@@B@@@@A@AB@@@@A@A@@@A@@@@@A@@BA@@@@@@A@@@A@@CA@@@A@@AA@@@AA@@AAAA@@AA@@@@@@@@B@B@@@@@@A@@@@@BBB@@@BB@@@@B@BB@@@@CB@@@A@@AA@@@@A@@@A@A@@@@A@@AA@@AAA@@A@@@@AAA@@@AB@@@@@@@A@@A@@@@A@@@@A@A@A@@BA@@@@BB@BBBB@@@B@B@@@B@@A@@@@B@@A@A@A@@@AAE@A@@A@@@@@DA@@BAB@@AB@@@@AB@@BB@@@@C@CAA@AAA@@B@@AB@@A@@@AB@@@@@@@BA@@@@@@B@@@BA@@@@@A@A@@@@@@@@@A@@@@@AA@@@@@BC@@@@@A@A@@@@BCA@BA@@@AA@@@@A@@@AAA@C@AAA@A@@@A@A@AAA@@@@@AA@@AA@@A@@@AA@@A@B@@B@@@BB@@@@@@B@@@@@@@@B@ABB@A@AB@@A@AB@@@B@@@BB@@@AB@@@@CA@BAB@@@AA@A@@A@@AA@@@@A@A@@@@@A@A@@A@AA@@@A@@@AB@B@@ABBB@@@@@@B@@@CB@@A@A@AAA@A@@@@@@@B@@@B@@@@A@@@@DAB@@@@@BABAA@@@A@@@A@A@AAA@@AA@@B@BCB@@AB@@@BABA@@@@@A@@@A@@BCBA@@BAB@@@@@B@@@D@@CB@@@A@@@@@@AA@@@AAA@@@@A@@@A@AAA@@@@@@B@@@@A@A@@@@@A@A@A@@@A@@@@@A@A@@@A@@@@@@@AAA@@@A@@AA@@A@@FCBA@@@@A@AC@@B@@A@A@@@@AB@@@@A@@@@@@@A@@@A@B@@B@@@BA@@A@@@@A@@@@B@BA@@B@@A@@@A@@@@@@@A@@A@@@@A@@@A@@@AAB@@@@@@@B@B@@@B@@@@@@AA@@AA@@@A@@B@@@A@@@@@@@@@A@BBA@@@B@@@@@AA@@@@A@@A@@@@@A@AAA@B@@@AA@BA@@@A@@BBB@@@@@@@@@@A@@@@BC@@@@@AC@@B@@@BA@@BA@@ABA@A@A@@@@AA@@B@B@@B@@@@@AB@@@@A@@@@@C@@B@@@@@@A@@A@@CBA@@B@@@A@@@@BAABAA@@A@@@B@@BA@@A@@@@A@@A@@AB@@@@@BABA@@BB@@@B@AB@@A@@@A@A@@@@@@BBABD@A@@@BAB@@@@@@A@@A@@@BA@@BA@@B@BA@@@AB@B@@AA@@@@@AA@AA@@AA@@@AB@@@BA@@@@@AA@@@@@@@A@A@@A@@@@@AA@@@A@A@@@@@AA@A@@@@AA@@A@@A@@@AA@AA@@@A@@BA@@A@AAAB@@@@@@@@@@@@@B@@B@@@A@@@@@BB@@@@A@BB@@C@@@AA@AAAA@@@AAA@AAA@@AAC@@BA@A@@@@BA@@@ABA@@@@@A@A@@@@BA@@@A@AA@@@@AB@B@@A@@A@A@@@@A@@AA@@@A@@@@A@@@@@@A@@A@AAAAAB@@@@A@@@@@A@B@@B@@@@A@AB@@AAA@AAA@@@@@C@@AC@AA@@AA@@A@@@@@@@A@@@@AA@A@@@@AA@@@@A@@@@AAA@@@@AB@@@@@AA@B@@@@A@@BA@@@@BAA@@@@B@@AA@@@@@@@@@A@AA@@@B@@@@@@A@@@@@@@AB@@@@@@A@@@A@BAA@@@AB@A@A@BA@@@@@A@A@@@AB@@BB@@A@@@A@@@AACAAA@@AA@B@@@@@B@AA@@AA@@B@BA@"],encodeOffsets:[[116885,28673]]}},{type:"Feature",id:"360902",properties:{name:"袁州区",cp:[114.427858,27.797091],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAA@@@@B@B@@@@C@CAAAA@@@@@@B@@@@AB@@AA@AA@@@AABA@C@A@AA@@A@@@A@@@AAAAAAAA@@@A@@@@B@@AB@@A@AB@@@BA@A@@BA@@BA@CB@@AB@@A@A@@B@B@@CB@B@@@@@D@@@BA@@@CBAB@BAB@BAB@@@@CA@@AAA@AAAAAAC@AAA@ECA@@@A@@B@B@@CDEDA@CBABC@ABA@@@A@@@A@@B@BAB@@@B@BCBA@@@@BA@CBA@ABA@C@CDA@EBABG@C@A@A@C@A@CBAAE@@@AA@@A@@BAAA@@BA@BB@@@BA@@@@A@A@@A@CAA@CA@@@@A@@BA@CDA@@DB@BBB@@@@BEAA@AB@@@B@B@@@@@BA@@@C@@BA@AB@@AB@@@@A@@@C@C@ABA@@@A@A@@@@B@B@B@BAB@@@@EB@@CBC@ABAB@@@B@@@@@@@B@@A@@B@@AB@@AAA@A@@B@@AA@@@@@BB@@B@@@B@@A@A@@@AB@@AB@@A@@B@@@B@@@B@B@@@@@@ABA@AB@@@@ABA@@@A@A@A@A@AAA@@@A@AA@@@BA@A@A@@B@@BBDA@@@BBD@BAB@@@B@@@@@B@@B@@BA@@B@@@BA@AB@@CBA@@@ABBB@B@B@B@@@BA@AB@@@@A@A@AB@@@BBB@@@B@@ABA@ABA@AAA@AB@@A@@@A@@@A@@@A@@B@@C@ABA@@B@@@@B@@@@B@BB@@@B@@@DAB@@B@@B@@@@@B@@@BAB@@@@BBBB@AB@@BB@@@@@@BB@BB@@@@BB@@BB@@@ABBB@BB@BBBB@@@BB@@B@@@B@BB@@@@B@@@DBD@@A@@B@@B@@@BB@@@@A@@B@@@@@BA@A@@BB@@@A@A@@B@@@DA@@@@@AB@@ABAB@@A@@@A@@A@A@@A@@BA@@B@@AB@@@B@@@B@@A@AB@@A@AB@@@BB@@B@@BB@@@B@@A@@B@B@@@@AB@@A@@BA@@@AB@BAB@@A@C@@BB@@B@B@@A@A@AA@@@@AB@@@@@B@@A@@B@@AB@@@B@B@@@@@@B@BB@@@A@@@ABA@@@@@AB@BB@@B@@@@@BB@@@BBBAB@BA@AB@@A@A@@@@BA@@B@@@B@@B@B@@@@B@@@@AB@@A@@BA@AB@@@@AB@BAB@@@BAB@@ABABADA@@B@@ABABABAB@@@@AB@B@@BD@@AB@B@@@@@A@A@@@@@@@BAB@@B@@BB@@B@@AD@@BB@@@@@B@@@B@@@B@@@@@B@@A@@@@@AA@@BA@@AAA@@@A@@@@@@@@BA@@@@@@BA@A@A@AB@@C@C@@@@@ABA@C@@AA@AA@@@@A@@@@AA@@@BA@@@@A@EAA@AAAB@@@B@@@@@@BB@@@@@@@B@BBB@@B@@A@@BB@@@BB@B@@@@@@@@BBB@@B@@B@@@BAB@@A@@@@@@@A@AD@@A@B@BB@@AB@B@@@B@@A@@@A@@@@A@@C@@@A@@@A@@A@@@@@@@@A@@@A@@@@@@B@@@@B@@@D@B@@BB@@@B@@B@@@@A@@B@@BA@@@@@@B@@@B@@@@@@@B@@@@@@BAB@@@B@AAA@@@@A@AB@@CAAB@AAAAD@BA@@B@@@@A@@@@B@BA@@B@BA@@AA@B@@A@@@@@@@A@A@@A@A@@@@@@B@@@@@FA@@B@@@B@@AB@@ABA@A@C@@@@AA@@@AB@@AA@@@@A@@AAB@@@@AB@@@@@@@B@@@B@@@@A@@A@@A@@B@B@@@@BBABB@@BB@@@@B@@A@@@@@A@@@A@@A@@A@@@@@A@@@@@@@@@AB@@@A@@BA@@A@@@B@@A@@C@@@@BABABAB@@@B@@@@@@@@A@@@A@A@A@A@@@A@@B@@@@@@@A@@ABA@A@@A@@@@B@@@@@@@B@@@@A@@@@@@@@@@BA@@@AA@@@A@@AA@A@@@BA@@@@@AD@A@A@AA@@@@@@A@@ABA@AB@@@B@@@@@@@B@@A@@BAB@BA@A@@@@AAA@AAA@AAC@CCA@A@A@@@A@@@@@A@@B@@@@@BA@@@@B@@@@@@@BA@@@@@A@@@@@@@@@@@@A@@@AAB@A@@B@@@@AA@@@@@@@@@A@AA@@@@@BA@@@@B@B@B@@@@@@A@@@A@AAAAA@@AA@@A@@B@@@B@@@@A@@@@A@A@@@@B@@A@@@@@A@@@AAA@@@@A@@B@@BB@@@@@@@@@@@AAA@@A@@AC@@A@@@AA@BA@A@A@CAAAA@AAA@AAAAA@AA@@A@@B@@A@ABC@@@AB@@@A@@@@AAC@@@@@A@AAA@AB@B@@A@@BAAAAA@@@AAA@CBA@A@A@@@A@CA@@@@@A@@@@@C@@@@@@AB@@@@@B@B@@A@@B@BA@@@@BADA@@B@@BB@@@B@@@@@@@@@B@@B@@B@@@@AA@@ABA@@B@@C@@@A@@@AB@B@@@B@@ABC@@A@@A@@BA@AAAA@@A@@A@@@@@@A@@@@@@@B@@AA@@B@@@@@BB@AB@@B@@B@@A@@BA@@@@@@BAB@@AB@@@@A@AB@@@BA@AAA@@@A@@@A@@@A@@A@@AA@B@@@@@@@@@A@@A@@@@B@@@A@@@A@@AAA@A@@@CA@@@A@@@A@@@AA@@@@@A@@BA@A@@@@A@@AA@@@@@AA@A@A@A@@@@@A@A@A@@@@B@BA@@@@BABA@ABABA@@@@@A@@BCB@@AB@B@@ABC@@BA@A@AA@@A@@AAACAA@@@AB@BA@A@@@AB@@@B@B@@@B@@@B@B@BA@@@@BBB@B@@HDB@@B@@@@@@@@ABA@A@@AA@AAB@@BA@ABA@ADA@@B@@AA@@A@@@AAA@@@@@@B@@AB@@A@@@@@A@@@@@@@@B@@@@@@AA@BB@@BAA@BA@AA@B@@BB@@@@@B@@@@A@@B@@@@@B@BBB@@BBB@@@@@B@@B@@@@AA@@BB@@@@A@@@A@@A@BA@@@@B@@@BA@@@@BB@@@@@@BBBA@BB@B@@AB@@@@@@B@B@DB@B@@@B@@B@@@B@@@B@B@BBB@@BB@@B@@@B@@@@@BCBA@@@@D@@@@@BA@DBBB@@@B@B@@@@BB@@@B@B@@@@@@A@@@A@A@A@@A@BA@AAAAA@@AA@@@AA@@A@@@@B@@@BB@A@A@@@@@@@DB@B@@@@@BA@@@@A@@A@@@A@@B@AA@A@@@AAA@@@AA@AA@@@@@CAA@A@A@@BA@@AA@@@A@@@@AA@@@@@@A@A@@B@@@@@@A@A@@A@AAAA@@@@@@@A@@ABEA@@A@@@A@@@ABA@@@@B@@ADAB@@BB@BB@@BB@B@@@B@@BB@@@B@BBB@B@@@BD@@@@@@@B@@@@@@A@AA@@AB@@A@@@@@A@A@@@@B@@@DBB@@@@BB@@A@@BB@AB@@@@@@@@A@A@A@@AA@@@@B@@@B@@@@@@@BB@@B@@A@@@@@A@@@A@@@@BBDBB@B@B@B@@@@A@@@@BA@@BA@AAA@A@A@@@A@AA@@AA@A@@A@A@@AA@AA@@@@A@AAAA@@@@@B@@@@@B@@B@AB@@@@@B@@BB@BADA@@@@BA@@@@@@CAA@@@@ED@@@A@@B@@A@@A@@AA@AB@@AB@@@@@B@@AAA@@A@@@@A@@@A@A@CC@@A@@@@B@@@@@B@@B@BBB@@@BD@B@@@@GBAB@BA@A@@@@@@B@@@@@B@@@B@@ABABDB@BBBB@@@BABA@@B@DCB@@@B@@A@A@@@@BBB@@@B@BB@@@@@@@B@@@B@@BB@@DDA@@B@B@@AB@@ABAB@B@B@@AAA@@@A@CA@@@@@A@@A@@@@@A@@@A@@@A@@BAB@@B@BBB@@@DB@@BB@ABB@@B@@@B@@B@@@@@BB@@@@@@BB@BB@@B@@@@BA@@@@B@@@BAB@@A@@B@@C@@BA@@@AB@@A@@@@@A@A@@@A@ABAB@B@@@@AB@@@@AAA@@@ABAA@AA@AB@@A@@@A@@@@AA@@@@@@B@@BB@@@@BBB@ABB@@@BB@@B@@@@@@@B@@@@@B@@B@@B@@B@@@@B@@@@@@BB@@@DBB@@@B@@BBBB@@BB@@@ABB@@@@B@@@@@B@@@BA@@@BB@@@B@BB@@BB@@@@B@@B@@BB@@BB@@@BB@@@B@@B@@@BBB@BBBB@@B@BBDDBBBDB@@B@@B@DB@BBBB@BBBBBBBB@@B@BB@@BB@BD@@B@@B@@B@@BB@@B@@@@@@@@ABC@A@@@@AA@@@A@@B@B@@AB@@B@BBB@@@D@@@@B@@@BB@@BA@ABA@@A@@AA@@@@AAA@A@@A@@@A@A@AA@@AAAA@@@ABA@@@A@@@AA@@AB@@AB@B@@@BA@@BBB@@@BB@@@@BBB@@B@@BBABB@@@B@BB@@AB@@@@@@F@BA@BBBB@@@@@@@BB@B@@A@@BA@AB@@@@@B@@B@@@BB@@A@@@@@BB@@BB@@@@@@B@BB@BB@BBB@BB@@B@@@BB@@@@B@B@B@DD@@@@@A@@BA@@@@@@BA@@@@B@@@BA@@@@BA@@@@BB@@@@B@@@@B@D@@B@B@@@B@B@@@ABA@@@@BAB@@A@@@A@@@C@@@@BB@@@@B@@@@@@@BB@@@BB@@B@AB@@@@BB@@B@@ABB@@@@B@B@B@BD@@DB@@@@BB@@BB@@@BBBBD@@@BB@BB@@@@@@@D@@@@BB@@@@BA@@@A@@B@@@B@@@B@BAB@B@BBBB@@@@@@@B@B@B@@@B@@@@@B@B@@@B@@@B@@@@@B@@DDBBB@BB@BBBB@@@A@A@AB@@@B@@A@@AA@@@A@@@@@@@A@A@@@@D@@CB@@@@@@@@@A@@A@@@@BAB@@BB@B@B@B@@@B@@@BA@@@@B@BB@@@@B@@B@@@@@@@@BA@@@@B@BB@@@@BA@@@@@AE@@@@A@@@A@@@AB@B@@A@B@AB@@@BA@@@BA@AC@@@@BA@BB@B@@A@@@@@@AAAA@A@@@AAAA@@AB@@C@@@@@@ABACB@@@B@@@D@@A@@@A@@A@A@@A@BA@A@@@A@A@AAA@@@B@@@@@@A@@@@@@AB@@@A@@AA@@@A@A@@@AA@AA@@BA@@@A@A@A@A@@@ABA@@@@A@@A@@@A@A@@B@@AB@@A@@@ABA@ABA@AB@@@B@@@B@B@@@@AD@@A@AB@@@@@@A@@B@@@@BB@@@@@B@@A@@B@@@BA@@BA@@B@B@@@@@BB@@@BB@@@B@@@@@B@@A@@B@BA@@@@@A@@@@@@@A@@A@@@@ABCA@@@B@@@@A@@@A@@@A@@@ABA@@@@A@@@@@AB@@@B@@@A@A@AA@@@@@@A@@A@@A@@A@@@AA@@@@@A@@@AB@@@@@@AA@@@A@@ABA@@@A@A@A@@@@@C@@@@@AA@@@@@B@@A@@@A@@@BBA@B@AB@@@@@@A@@@@@BB@@BB@B@B@@@BA@@BA@@@@@ABA@@@@@AA@@@@BA@@@AAAA@@@A@@@@B@B@@@BA@@B@@@DBBBDBDBB@@@@BB@@B@DBDBDDFJ@HBBB@DDBBD@@ADADADABAD@D@B@@@D@F@D@D@FBD@DBBBBB@@@B@BA@@BCDCDCBCD@@AB@B@B@BBD@BBBDDBBDBBBD@B@DBB@D@DAD@D@F@FAFAF@B@HBFBD@B@DABAB@DADBDAD@FAFCB@BA@@BA@@B@F@BA@@B@BF@D@@@BA@BB@@@B@@@B@@@B@@@@@@@@B@@BB@@@DDBDB@@B@B@@@BB@@B@@B@@BB@B@@@B@@@B@@@B@BB@@B@@@B@B@@BB@@@@@@@@@@@@@@B@B@@@@@B@@@B@@B@@@B@@@@@B@B@BB@@B@B@@A@@BA@@B@B@A@@@B@@@@BD@@@@@B@B@@B@@B@B@@@B@B@B@@@BD@@@B@@@AB@@@@A@@@@B@@@@@B@BB@BB@@B@@@@@@@@@BBB@@BBB@@BB@@@BBADB@BB@B@@B@@@BB@@@AB@@A@@@@@BB@A@@@@@@@@B@@@B@@@B@B@BBA@@@@BA@@@A@@BABAB@@@B@B@@@B@@@@@B@B@@BB@@@B@@@@@B@B@BBB@@@@@@AB@BA@CB@B@@A@@A@@A@AB@@AB@B@@@B@@B@@BAB@@@B@@@@@@A@@@A@@@@@AA@@@@@BA@@@@@@@A@@BBB@@@@@@@@A@@A@@@@A@@@A@@@A@@BAB@@ABB@@@B@@A@@B@@@@B@@@@@B@@AD@@A@A@@@A@@@B@@B@@@BA@@@ABA@A@ABA@AC@@@A@@AA@@@@AAB@@@@@AA@@@@@@@@AA@@@@@@A@@A@@@A@@A@@DA@AAC@@@AAA@@A@@@@BA@@@@@@@@@@A@@A@@@@A@@A@@@@A@@ABAA@@@@BA@@@@A@@@A@@AAAAC@@@ABA@@AC@C@A@@B@@A@@A@@@A@@@A@@@A@AB@@@A@@@@@@@@@A@@@A@@B@@@@@A@BCB@B@B@B@@@@@B@@B@@BAB@@@@A@@B@@BB@@@@@B@@@B@@A@@@@@B@@BA@@BABB@@@@BA@@@@@A@BB@@@@@BB@@BC@@@@B@@@BC@@@@A@@AB@@@@@BB@@@@@A@@B@@B@@@@B@@@@BBB@@@A@@@@B@@@@@@A@@@A@@@@@@@@@@@@@@B@@@BA@@@@@@@@@A@@@@B@B@@@B@@A@@@@@@@A@@@@B@B@@@@@@A@@AA@A@@@@@@A@@@BCAC@@A@@A@@A@A@@A@@@A@@A@@@@AB@@@@@@A@A@@@A@CB@@@B@@BBA@@B@@@@@@@BC@@@ABB@@B@@BB@@@@@BB@@@B@@@AB@@@@@BB@B@@@@@BB@@@@B@BBAB@BA@@@@A@@@@AB@@@B@@A@@B@B@BB@@@@@@B@@@B@@B@B@@ABB@@B@@BB@@BBB@AAB@@B@@B@@AB@@A@AB@@BB@@B@A@@@@@ABB@@BBDBBB@@B@@@B@@@AA@@BA@@B@B@@@@@B@@A@B@BBA@@B@ABB@@@B@@@@@@@BA@@@@@@B@@@@@@@@A@@BBB@@@@@@@@@B@@BA@@@@@BB@A@@B@@AB@@@@@@ABB@@@@A@@B@@B@BB@@@@@B@@B@@B@@B@B@@B@@B@@@@@@@B@@@BB@@BBD@@BD@@@@@B@BBB@BB@@BAB@@@@@@AA@B@@@@@B@@@@@BABBBBB@@@@B@@@@B@@@@@@B@@BB@@@B@@B@B@@@@BA@A@@B@@B@@B@B@@AB@@@@@B@B@@@@AB@B@@AB@@@@@BAB@@BD@BB@BBB@BB@@B@BB@BBB@@D@@@AAB@@@@@AA@@@@B@@@A@@@@A@@@@@@@@@@@@BABBB@@@AB@@@B@@BBB@@B@@@BB@@@BB@@@@@BBB@@@@B@B@@@B@@B@@@@@BB@B@@@@@@@B@@B@@@@AB@@A@@B@@BB@@BBB@@B@@@@BB@@@ABA@@B@@A@AB@@AB@@A@@@BB@@@@@@@BA@A@@@BACAB@A@@@@B@B@@@B@@@BAA@@@A@@AB@BA@A@@@@BA@@@BB@@@@@@BB@@AA@@@B@@@BBBAAB@@@@@B@@@AB@DA@@@BB@@@@@@@@AD@@@@@B@@@@@BA@@@@A@@@@A@AB@@B@@B@B@B@BA@@AB@@AB@@A@@@BD@@@@D@@A@@B@@@@@@@@@@@AA@AB@@@B@@ABB@@A@B@BBB@@@@@B@@@@B@@B@@B@@@@@A@@AB@A@B@@@@@@@@@B@@@AB@@@B@@BB@@B@@@@A@@@A@A@@@@@@@A@BB@@B@@@B@@@@@@BB@@@@@@@B@@@B@@@@AB@@A@A@@B@@@@@@BB@@A@@@AA@B@@@B@@@@@@@B@@@@@BA@@@@@B@BA@@@BDB@@@@@ABED@@@BB@@BB@@@B@BB@@@@@@B@@@B@B@@@@@B@@@B@B@B@@@@@B@B@@@@@@A@@@@B@BBB@@@B@@@@@BB@B@@BB@@@@@@@B@@DA@@@C@@@A@@@@BA@AB@DA@AB@@@B@@@@@B@BA@A@@BA@@DA@A@AB@@BB@BBB@B@@@B@@@B@ABAB@@@@A@CB@@@@@B@@A@@@A@@@@@@@B@B@BBB@B@@@DA@@A@@@@@@@AABA@@@ABA@@B@@@B@@B@BB@B@@@@@B@B@@@@@BB@@@BB@B@@B@@BA@ADB@@@@BA@@A@@A@@@A@@BAB@@@BAB@A@BAA@@@@@@@@@@A@@@@A@@A@@@AA@B@@@BB@@B@@@BB@@BB@@@@B@BBB@B@@@B@B@BBD@B@BB@@B@@@@@BB@@B@@ADB@A@@B@B@@@@@D@@A@@@@BB@@@@B@@@@@@@@@B@B@@@@@B@@A@@@A@@@@B@@A@@@@@@BA@@B@@@BA@@@AB@BBB@DBD@@@@@AA@@BA@@@@BA@@BA@AB@@C@@@@@BB@F@BB@@B@B@B@@@@A@@B@@@@A@@@A@A@@AAAAA@@A@@B@@AB@B@B@@@@@@B@@B@@@@B@@@@@@BA@@B@BB@@@@@BB@BB@@BB@@@B@@B@B@@@B@@@@@BB@@@B@@DA@@@@AAA@@@@@AA@@A@AA@@@@B@@@@@@@@A@A@@@@@@B@@BB@BB@BB@@B@@BB@@@B@@DB@@@B@@@B@@@@B@@AB@@@@@B@@@B@B@@@@@BAB@@@@@@AA@@AB@@@@@A@@@@@@A@@@A@@@A@@@@@@B@B@B@@@B@@@@B@@B@@@B@@@BB@A@@@@B@@@@BB@@@@A@@@@@BB@@@@@B@B@@@B@BAB@@BB@B@BBD@B@@BB@@@@@B@@@BB@ABB@@BABABA@@BA@BB@@A@@BA@@@B@@@BB@B@BC@@@@BB@@AB@@BBB@@@B@BB@BB@DD@@@AB@@@@@@B@@@@@B@@BA@@B@@A@@@A@@@@@@B@@@@@@AA@@AA@@@@@AA@@@@D@@@B@@A@@BB@@BB@@B@B@BB@A@@@@DBD@@@@@@@BB@AB@@@@@AB@B@@B@@B@@B@@A@A@@@B@@D@@@B@@@@@@B@@@BBB@B@@A@@@@@B@@B@@CB@@@@BBBAB@B@@A@@DA@@@@@A@A@@@@@A@BAB@A@@A@@A@@@@@@B@@A@@@@@A@ABA@@BCB@@A@BB@@DBB@@B@@@@DA@@@@BB@@@@@@D@@B@@BB@BA@@@B@@B@@@@@@@@@@@@BBB@@BA@B@@@@B@@@B@@@@@BB@@@BB@@@@@BB@@@@B@@B@@B@@B@@B@@B@@B@@@@A@@@@BB@@@@@@@@B@@@@@@@@@@BB@@A@@@AA@B@@@@@@A@@B@@@@@@@@BH@@@@A@@DA@@@@BC@@BB@@@@@@@B@@B@@@B@BB@@B@@@@A@@@@B@@@B@@@@B@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@BB@@A@@@@@@B@B@@@@@A@@B@@@@BB@B@BC@ABA@A@@BB@@BBD@@@@A@@AA@@BA@@@@BA@@B@@B@@B@@A@@BABBDA@@@B@@@B@@BA@@A@@A@@B@@A@A@@@AB@@@BA@@BA@@B@@@@@@B@@@@B@@@@@@@BAB@@@@A@@B@B@@@@@B@@@B@@B@@@@B@D@BAB@@@@@@B@B@BBBB@@@BB@@@A@@BB@@AB@@@BB@B@B@BB@@@@B@@B@AB@@A@@D@@AB@BBB@ABBBA@A@@@@B@@@@@@BB@BD@@@@AB@@A@@@@BA@@BDBB@@@@B@@B@BB@@B@@@@B@@@@@@B@@@@B@B@B@@@@@@@B@@@B@B@@D@@@@B@@@@BA@@B@@B@BB@@D@B@@@B@@@BB@BDB@@BBA@@BB@BB@BB@@@B@@BB@@@@@B@@AB@BCB@B@@@@BB@@@@@@@B@BA@AB@@@BAB@@@B@FBD@@@@@@@BA@ABAB@B@@@BA@@A@@@D@B@B@BA@@@A@A@@DB@A@AD@B@BAB@@@@@AAA@@AD@D@AC@ABABABA@A@@B@@@BA@@@A@@@AA@AA@@A@@A@AA@@@@A@@@ABA@@@A@ABA@@@@@A@@A@@@@@C@A@A@@B@@@BA@A@A@@@@@@A@@A@@@@AA@@@@A@@@@DB@A@@BBB@@AB@B@B@B@B@@@@@@@BA@AB@@@@C@ADA@@@A@A@@DA@@@ADE@@BCBA@@@A@C@AB@BA@@BA@@@A@@AA@@@@@AA@@ADAD@@@BC@A@AB@@AB@@@B@@@@AA@@@A@@BA@@B@@AA@@@A@@@A@@@B@@@@@@@@@@@B@@A@@@@@C@@@BCA@@@@A@@BA@@@@B@@@AABA@@@@D@B@D@BBBBBBB@DA@@@@@@@@B@@@@@@A@@A@@A@@@ABA@A@@A@@A@@@@@B@@AB@@AA@@@AB@BA@@@@A@AB@@A@@@A@@AA@@@@@AB@@@B@@C@@@@@@@AA@@@AA@@A@@AA@@@A@@@A@@@@@A@@BA@CA@@@@@@@@A@@@@A@@BA@A@@@@@AB@@A@@@@@A@A@@AA@@A@@AABA@AA@@AA@@@@AA@@@@@A@@@A@@AB@@A@@AA@@AAA@A@@@AA@AA@A@@@A@@A@@AAACA@@AAA@A@@@@@A@@@@@@C@@@BBAB@B@@A@@@@@@B@@A@A@@@@@@D@@A@@AB@AA@@B@@AB@@@A@AABAA@@A@A@@C@E@ADA@@BA@ABA@@@A@A@@@A@@D@@C@AAAB@@AA@@AA@@@A@@B@@@CA@@@@CA@@@B@@A@@BC@@B@@AB@@AB@@A@@@@@A@@@@@@@@A@@A@@@@@@@BAA@@@@AA@@@AB@@AA@@@@A@@@@B@@A@@@@@A@@@A@@@A@@@@A@AA@@@@@A@@@@@AB@@A@@@@@@AA@@@A@AA@@A@CB@@@@@@AB@@@@A@@@@A@@@@A@@@@@@A@AA@@A@B@@A@@@@@@@@A@A@@@@@@A@@@@@A@@@@A@@AA@@@A@@@@A@@@@B@@AA@@AA@BA@@A@A@@@@@@@A@@@AB@@@BC@@A@@AB@BEBA@@@@B@@AB@@@BA@@@@B@@A@@B@@@@A@@A@@@@@@@A@@@A@@A@@@@@@BA@AB@@@@@@A@@@@@A@@@@B@@@B@@@BB@@@@@A@@A@@@@@BABB@@B@@@BCACAA@A@CA@@A@@A@A@AD@@@BBA@B@@BBA@B@@B@B@@A@@@@A@@A@A@@@@@A@@@@@A@A@@@@@A@@A@@@C@@@@@A@@@BA@AAA@@@@@A@@@@@@B@@@@ABA@A@@BAB@AA@@@@@AA@B@@AAA@BAA@@A@@@@AB@@@@@@@@@B@@@@@@@A@@@@@A@@@@AAA@@A@@A@ABA@@@@@AA@@@@AB@@@@@B@@AA@@@B@@@@@B@@A@@AB@@A@A@@A@@@@@@B@@A@@A@@@@@@A@@@AA@BAA@@@@@@@@BA@@B@@@@@@A@@B@@AA@@@@A@AA@AAB@B@@@BA@@@AAA@A@@@A@@AB@@@A@@@@A@@@A@@BCB@@BBA@@@BBA@A@A@@@@CB@BABAB@@@@A@@BA@@@A@A@C@@@@@@A@@@A@@@@A@@@@AA@@@@@AA@@@@@@@@A@@@@A@@@A@A@@@@@AA@AB@B@@BB@@A@@B@@AB@@B@@@@@A@@@@AABA@@@@AAABA@@AAA@@B@B@@ABA@AAA@@@@B@B@@@@A@A@@@A@@@A@@@A@@B@@@@A@A@@@A@@@@@@@A@@@@@@@AAB@A@@B@@@@@@AA@@AA@B@@@B@A@@A@ABA@B@@@@AB@@@@B@@@@@@A@@@@B@@@@AA@@@BC@@@@@A@@@@BB@@@B@@@A@@@@@@@@B@@@@@@@@@B@@@@A@AB@@@A@@A@B@@A@@@@@@AA@@A@@@@@@B@@@B@@@AA@@B@B@@A@@BA@@@@@A@A@@BA@@@@@AB@A@BA@A@ABAA@@@@@@A@@@A@@A@A@@A@@D@@@@@@@BABA@@B@@@B@@@@@@@BA@A@ABC@@BA@@@@@@AB@@@A@@@@@@@A@@@@BBB@@@AABAAA@@@@@AB@@AB@@ABAA@@C@@AA@@AA@@@@BAB@@@BA@@B@B@@ABBB@B@B@B@@ABA@@AA@@@@BABA@@B@B@B@B@B@DBD@DBB@@A@AB@@@@@@ABA@@@AB@@@B@@B@@B@B@@@BB@@BA@B@@B@@A@@@@B@@@B@BABCBAFE@@B@BA@@DBB@@@BBFF@@BB@B@@B@@@@B@@ABB@@@@@@@A@ABA@@@A@@@AB@BB@@@@BA@@BA@@@@@BBB@@@@@B@@@@BB@@BB@@B@B@@@@@@@@@@BB@@@@@@BB@@B@BA@@@@B@@@BB@@B@@B@@BB@@BBBB@@@B@@@AB@B@@@B@BB@@B@@BB@B@@@BBB@@@@ABAAA@@B@@ADBB@@C@A@@@@@AB@@BB@@A@@@A@ABA@@@@@@@A@@BA@A@A@ABA@@@BB@@@@@BA@@@@@A@@B@@@@A@@A@@A@A@A@A@@@A@C@A@A@@@@BAD@@@@EA@@A@A@AB@@@B@BA@A@@A@@AAAAAB@AABC@@A@@A@@@@@@@@A@@AB@@AC@A@AAAAA@AA@AA@A@A@@@CA@C@@@@A@@@A@@@@@A@@@@@@A@@A@BB@@@@A@@A@AC@AA@@@@@ECC@A@@B@B@B@@@@@@@BA@@@@B@@@VU@@@@@@@@A@@@@@A@@@A@@@@@@@AA@@@@@@@@@A@@@@B@@@@@@BB@@@@@@@@A@@@@@@@@@A@@@@AA@@A@@@B@@@@@@@@@AC@@@@@@@@BA@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@B@@A@A@@@@@@@@@@@@B@@@@@BABABA@@@@@@@@B@@A@@IYH{AAA@@B@@@@A@A@@A@@AABA@@@@@AB@@@@@AA@@B@@AB@@@B@@@B@@@A@@@AAA@@@@A@A@@BA@@@@@A@@A@@@AA@A@@@AA@@@AA@@A@@AB@BAB@@@@@A@@A@A@@AB@AA@@AAB@@@BAB@@@A@@@@@@@@AA@@@@@@A@@@@@B@@AB@@@@A@@@@A@A@A@AB@A@@A@C@A@A@@@CAA@@A@AA@@@AA@@AA@@A@AA@A@AB@@@@A@@@@A@@AA@@A@A@@@A@ABA@@AAB@@@BAA@@@@@@BA@@@@A@@A@A@@AA@AAA@@@AA@@@A@AAA@@@AA@@@AA@@AAB@@@@@@A@ADCBCA@A@A@A@A@BA@@@@B@@A@@@A@@@@A@A@@@@@AB@@A@@@AA@AA@@@A@@AA@@@@@@A@A@@BA@A@@@A@@B@@@BC@A@@@A@@@@@AAA@@B@@A@@@A@A@@@A@@A@@A@@@@AAA@A@AAAA@AAAA@AAB@@@@@AA@AA@@@@A@@@AAA@@@AAA@A@@@AAA@AA@@A@A@C@@AA@@@@BADA@ABA@@B@@A@@@@@AF@@A@@BA@@@A@@@A@A@@AA@@B@@@@AB@@@B@@AB@B@B@@@AAB@@@@@@@@AB@@@A@@AA@@@@@@@A@A@@AA@@@@A@@@@A@AA@@A@@@@AC@@@@A@A@@AA@AA@C@@BA@@B@@@BCBCBCBC@AB@@@@A@AAAAA@AA@@AA@AA@@@A@@@CAA@@A@A@A@A@@C@@@ACAA@@@@CBA@A@@@@ABABC@@@A@A@@@ABA@@BC@@BA@@@G@A@AA@@@GAABA@CB@@@@A@@@A@A@AAAAA@A@CDA@A@A@A@CA@@@@@@@@@ABC@@@CAC@AA@AAC@@@AAAACAAEA@A@@AA@A@A@@@A@A@@@@A@@AC@AAE@@@@@@AB@B@@@B@@BBA@@@A@ABA@@@AA@@A@A@ABABA@@B@D@B@@@@ABA@C@A@C@@@A@A@AA@A@@A@A@@AAA@@@@@@E@E@A@@@@B@B@B@@A@@@EE@A@A@@@AA@A@AAE@AA@@ACAA@A@AAABA@AAACA@A@@A@@@A@A@A@A@A@A@EBABA@A@CBA@@@@A@@AA@@A@A@CBE@A@A@@@A@@AAB@@@@A@AA@@A@A@@@@@ABAD@B@D@B@B@@"],encodeOffsets:[[117132,28219]]}},{type:"Feature",id:"360924",properties:{name:"宜丰县",cp:[114.802852,28.394565],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@@ABA@A@AB@@A@@@A@@@@A@AAA@A@@@@@@@@C@@A@@AA@@@@A@A@@@@@AC@@A@@A@A@A@C@A@A@@@@@A@@B@B@@B@BB@@@@@@@BA@@@A@@BA@@@AAAA@@@AA@@@A@@@@@AA@A@@@@AA@@AA@@@@@@@DBB@@@@@B@@@@@@@B@@@@A@A@AA@AA@@@@@A@AB@@@@BB@@@@@B@@B@@@@@BB@@@@BAB@@@@B@@@@A@@@@B@@@@@@@BAB@@@@A@@A@@A@@@@BB@@B@@@@@@ABAA@@@@@A@@A@@@@@A@BB@@@B@@@@ABA@ADA@@BA@@@@DA@@BAB@@B@@B@@@BAB@B@@@B@@BA@@B@@BA@AB@@BBAB@@@@@B@B@@@@@BA@@BA@@@@@B@@@@@B@@@BB@@@A@@@A@@B@@@DD@@@@@A@AC@@@@@B@AA@@@@@A@@@@B@@@@BB@@BDD@@@B@D@@A@@@A@EAA@@AA@@@AA@@@@@B@@@DAB@@@@B@BB@@A@@BB@@@@@@D@@@@A@ABA@@@@AA@@@@BA@@@@BB@@B@@@B@D@@B@@@B@@@D@@@B@@BB@@B@@@@@@A@ABC@@@A@@@@@@@@@@B@DBBA@@@@B@@B@@@@@ABA@@@@@CA@@@@@@@BB@BB@@BAB@B@@@BA@@@B@B@@@@ABA@@@AB@B@@@@@@BDBB@DBB@@@@@BAB@@@BB@@@B@@@AB@@@@@B@BAD@B@@@DB@AB@@ABAB@@@@BDBB@@@B@@A@@B@B@@A@@@@B@BA@@@@AA@@@A@@A@@A@@BA@@AA@@@E@AA@@@@@A@@A@@BABA@@BBB@BBBBBB@@@@B@@B@@B@@@@A@A@@@AB@@@B@@@@EE@@A@@@@BAB@@ABCFAB@@A@@@BB@@@@@@B@@A@@DEBABA@@B@@@@B@@AD@BCB@@AD@@@B@@@BA@@AC@@@A@A@A@CB@@A@@@BB@@@@AB@@A@A@@A@@@@A@@@@@BD@@@B@BCB@BA@@@@@BA@CBAA@@@@@A@CDABAB@BA@@AA@@B@BGF@B@@@D@BAB@@@@KFCDA@EACAA@A@@BCBA@CB@BA@@B@@@BA@@@ABAAA@@AA@@@@@A@AB@@E@@@A@@@BB@@BBBBB@@B@@@@@@E@A@AAAB@@@B@BA@AB@BA@@B@B@@@@ABABABAB@@A@AD@@ABABC@A@A@C@A@@@A@@B@@@H@B@BBB@B@BAB@@@B@B@B@@A@A@C@AAC@CAEAA@A@ABABCB@@A@CB@@AB@BA@A@AB@B@@@@BB@@@B@@@B@BA@ABAB@@@B@@BBBB@@@@@BA@A@A@A@@@A@A@@@@B@@@@AB@@G@C@C@C@A@@B@@AB@@@BA@C@@@@@@A@AA@@@@@ABAD@@@BABABAB@@A@@@EB@@AB@@@@C@C@@B@@@@B@ABAD@@@@B@@@BABAB@@B@B@B@BABC@ED@BA@A@CA@@@@@B@B@@@BA@@@ABA@ABC@A@C@A@ABA@A@C@C@A@@@B@BB@BB@@@BB@B@@A@@@A@@@A@@@AB@B@@B@@@B@@B@@ABABA@AB@@@B@BBBAB@BAB@@@DBB@@BDB@@B@@@B@@B@@AFBB@B@@@@@EBA@ABA@ABA@A@@@CBADA@A@A@@@AB@B@@A@@@@@@BDA@@DDFBHFDBB@BB@BB@BAH@B@B@@@BBBDB@BBB@BBD@@@BB@BDDBBBBBDB@B@B@B@B@F@B@B@B@FBDBB@D@D@DBD@@@B@@D@@BB@@BBB@@BB@@BA@@BA@AB@@@B@@@BBBA@@BAB@B@F@B@B@@BD@BBN@D@D@B@BB@@B@DBBBJ@@@D@@D@BAF@DAB@B@@@DBB@@@@@D@@ADBD@DBB@BBFD@BBBBB@@@@@BAB@B@@@B@@@BDB@B@@@B@B@@B@BBD@@BB@@BB@@@F@BBB@BB@@BB@B@@@@B@B@BBBBB@BBBB@BB@B@@B@@BB@B@@BBB@@B@@AB@@@B@D@@B@DBD@DBBBB@@B@@@B@BBBB@@B@@@B@B@BAB@B@@BBBBBBB@@BBB@@B@BB@@B@@D@@@BB@B@BBB@@BB@B@B@F@B@@@BAF@B@B@@@BAB@@AB@B@B@@AB@B@B@FBBBDC@@B@B@@@BABA@@B@BBB@@ABA@@FAF@B@BA@@B@B@B@DCFABAB@DBB@DBJDBB@@@B@BB@@@@@BAB@B@L@BB@@B@BB@B@@@BBB@@@@BBBBB@BBDBB@DBB@BBB@B@@@DBD@D@DBF@B@B@BBB@BBB@@@HBB@BAB@JDB@@A@@@@@@BAD@B@B@BAB@@A@@B@@BB@BB@@D@BBBBBBB@@@DAB@B@@@D@B@B@@@@AB@B@B@@BB@B@@@@BAF@D@B@B@B@B@BB@@BDBBDBDBDBBBBBBBD@B@B@B@BBB@@AD@B@@@BBD@B@@BBDB@B@DBF@B@@BDB@@BB@@BFB@@BBBBB@DBD@B@DB@@@@BBBJ@@@BDF@@@BCB@@@B@@BB@BBBAB@@ABCBABABAB@B@@@BDB@B@BBBDB@BBDB@BBBD@DB@@BB@@@@BB@BD@@@B@@AB@@@@DB@@BB@BBB@@@B@@B@@@@A@C@@@ABA@C@@@@BAB@@@BABCBABABC@ADA@ABA@ABA@CBA@ABAB@@A@A@A@AB@BAB@@A@@@A@@A@@A@@BADA@ABA@AAA@A@@A@AAA@@@A@AA@@AA@@@@B@@A@@AA@@@@BA@@@@B@B@@@@@@@@@B@BA@BB@B@B@D@BB@@BA@A@@BA@@@A@@BAB@DA@@DABAB@BABAB@@@BA@@BA@@@@@AB@@AB@@@B@@AB@@@B@@@B@@AB@@@D@B@@@BA@ABA@A@A@@@A@ABABAB@@@BAB@@@@AB@@ABC@@@ABCAA@@BAB@@AB@B@B@@@B@B@@@@ABA@@BBB@B@@ABC@A@@@A@A@@BAB@@AB@B@@@@AB@@@@@@A@@@BB@@A@@@AAC@@@@B@BB@@D@BBB@B@BBBB@@BBB@B@BBB@B@B@BBBBBB@BB@BA@@BAB@@A@@@AB@BA@@B@@@BA@@D@@AB@@AB@BA@@B@B@@@B@@@@@B@@@DBBB@@B@BA@@B@B@BAB@@@BBB@@@@B@@@@B@@@@B@@B@@@@@@B@@D@@@BD@@@BBB@@@B@BBB@B@BB@@@BB@@B@@@B@@B@@B@@BB@@B@@B@@B@@BB@@@@@B@B@B@@B@B@@@@@@@B@@B@@@@@@B@@A@A@@@@B@B@@@B@@@@@@BB@@@@@@@@AB@BA@@B@@BDABB@@@AD@@@@@D@B@@@B@@A@@@A@@B@B@B@@@B@BA@@BB@@BA@AB@B@@@B@BBB@B@@@B@@@BBD@FB@@BD@@@@@B@@B@@@@@BB@B@@B@@B@@BBBB@@BBD@D@B@B@B@B@B@@@B@BB@@B@BB@@@AB@B@@@B@@@B@B@@A@@@AB@@A@@@A@A@@@AB@@@@A@@BABBB@BA@B@@B@@AB@BBB@@BD@@@@A@@@@@@A@@AEA@A@A@@@A@ABA@ABAB@@A@@AA@@@@@AB@@A@@@BB@@@@@@@B@B@B@@@@A@A@A@@@@B@@@B@B@@@BAB@@BB@@B@@BB@B@@B@BA@@@@DB@@B@B@B@@BD@B@@AB@@@B@@@@@@@D@@A@@@A@@B@B@@@@AB@B@@B@AB@BA@@@@B@@@@B@@@BB@BF@@B@@@B@@@B@@BBB@@B@B@B@@@@B@@@@@B@@@BB@B@BABB@AA@@A@@B@B@BAHEB@B@B@@A@@BA@@B@@@BA@@@A@@@@BA@A@@C@AA@@@@BA@A@@@@@C@A@AAABA@@@A@@@A@@A@@ABC@A@AB@@A@@BA@@BBH@@A@@B@@A@A@@B@B@BABCB@@@@@B@@@D@B@@@B@B@B@@A@AB@A@C@AAA@@@@@@AA@@@A@A@A@AAA@A@@AA@@AA@@A@@@A@@A@AA@AAA@@@A@AA@@@@AB@@@B@D@B@@@DA@@@@@@DA@@BA@@@AB@BA@A@@@A@@D@BA@@BA@@@A@@BA@@BABA@@@C@@BA@A@A@A@@B@@A@@@ABA@@@@@@A@@@A@@@A@@@@AB@@A@@@@AA@@@A@A@@@A@@BC@@BA@@@AA@@@@@AA@@B@@@@A@@@@@AB@@A@ABC@@@ABAA@@AA@A@A@@@A@@@@@@C@@A@@@A@AAC@@@A@@@BA@A@@@A@@@@DA@@B@@@@A@AB@@AB@@@B@F@B@@@@@B@@@@@AA@@AAA@A@@@A@@@@@@A@@@@AAB@@@H@FAB@@@@@@BB@@@B@@B@B@@B@BB@@@A@@B@@ABA@@B@@@@@@@ADAB@@@BB@BABB@@BA@@A@@AB@@A@@BB@@@@@@B@@@@A@@B@@@@B@B@@@BB@@B@@BA@@B@@A@C@@@@BB@@BC@@BA@@@@BA@@BB@@B@@@B@@@@@B@@@B@@AB@@@@ABA@@@AB@@A@@B@@B@@B@B@B@BBB@B@D@@@BA@@B@B@@@@A@@BA@@B@@@BA@@DA@@BA@@B@@AB@@@D@BAB@BAD@@@@@@BA@@B@B@@@@B@@@@@B@@@@@B@@BDABAB@@B@@BA@@BA@@@@BA@ABA@A@A@@B@B@@@@@@@@B@@B@@@@AB@B@@@@A@@@@@@B@@@B@@@B@@B@@@@B@@@B@@BB@AB@B@@AAAB@@@@@@A@@B@@BB@BB@@@BA@@@@@@@@B@B@@@BABA@@@AB@@@@A@A@@@@@@@A@A@@@@@B@@@@BB@@@B@BA@@BA@@@@B@@@B@@B@@@@B@@@@@D@@@@@BBB@B@@@@@@@@@@AA@A@@A@@A@@AA@@@B@@@B@D@B@@@@@B@@@BB@@B@@B@@@@@BA@A@@DA@AB@@AAA@E@A@@BA@ABA@CBA@A@@AA@A@@@@B@@@@@@@@BB@@@@@@@@A@@@@@C@@@@A@@@A@@@@A@@BA@ABA@A@@@@BA@@A@@A@@BA@@@A@@AA@A@@@AA@@AA@B@@@@A@@BA@@@A@@@@B@@A@@BABA@@BA@@B@B@@AB@BA@@@@@@B@@@@@B@@@BA@@BA@A@A@@@A@@@@@@@@@@@A@@@@BB@@@@BB@@B@@B@@BAB@@A@@@@@@AB@@@@AAA@AACA@@@@B@@@@@@ABA@A@@B@@@@@B@@@B@@@BB@@@@B@@A@@BA@@AC@@@AA@@A@AAAAAA@AAA@@@@A@A@@B@B@BA@@@@@A@@@A@AAC@A@@@AA@@B@@A@@AA@@@@AABA@@@A@A@@@AA@A@BA@@A@AEA@@AC@A@@BC@A@@@A@@@@@@CB@@@B@@@@AD@@@@@@@A@A@@A@@@A@BC@@@@@@A@A@@@@B@B@@AB@@@@@@@@@A@@@A@@@@AB@@@B@@@A@@A@@@A@@BA@@@@@@AB@@@@A@@@@A@A@@@@@@@AB@BA@@@@@@@@ABA@@@@A@@@@@AB@@@@@A@@@@@@A@CBA@@@@@@@@A@ADA@A@@A@CB@@@@@@B@BA@@@A@@A@A@A@@@A@A@@B@@@B@@AA@@@@AAA@@@A@@@@@B@D@B@BAB@@@A@@@A@ABA@AA@@A@@@@@@A@@B@B@BAB@@@@@@A@@A@AA@@@AA@@B@@@@@A@@@@@AA@A@@@AB@@@@@A@@@@A@@AA@@AA@@A@@@@@@@A@@A@@A@@A@@@A@AA@@A@@BA@@@A@AA@@@@AB@@@@A@@@A@@@A@A@@B@@A@@@A@@@@@@B@@BBA@@@@BAA@@AA@@@@@B@@@@A@@@BB@@A@@@@@@BBB@@@@A@CAA@@@C@@@A@@@@B@@@@@@@@@@A@@@A@@@@@@@@AA@@BA@@BAB@@@B@@@@A@A@C@A@A@@@ABA@@B@@CAA@AB@@A@@@AAA@A@@@@A@AAC@@@A@@AB@A@@@AAA@@@@@A@@BA@A@@@A@AA@@AAAA@@AB@@ABA@A@ABAAA@@A@@@@A@A@@@@B@@@@@AA@@@@@@@ABB@@@@@@B@AA@A@@@A@@@@A@@@@B@@@AA@AA@@@@BA@AAA@@@AA@AA@@@C@@@AA@@A@@@C@@AAAAA@C@A@A@AAC@@@A@@@AAA@@@@@AB@@@@A@@@AABA@@AAAAAA@ADG@@@A@ABA@@@A@@@AA@A@A@@ACA@@A@AA@@A@C@@@A@ABA@A@AB@@AB@BA@@B@@@AA@@@A@AB@@A@C@@@AAAAAAC@CAA@A@C@AA@@A@A@@BA@@@AD@@@@AA@@A@@@@B@B@@@@AA@@@@@@AB@@A@@@@@@@@@@A@@B@@AB@@A@@A@A@@@A@@@AAA@ABA@@@AB@@A@@@@@@@@@BA@@@AABA@CBA@A@@@@A@@@@ACB@@@B@@@DAD@B@D@@@@@@@AAA@AAA@A@@ACA@@@@@@@@AAAA@@@C@AAAAA@@AC@ABC@A@@@A@@@AA@@@@AB@@@@A@A@A@@@@A@A@A@AA@@AA@@@@@BA@B@@@@@@@@@@@@B@@A@A@@A@@@@@A@@@@A@@@@B@@B@@BA@@@AB@B@BAB@B@@@@@@@@@A@@A@@A@BA@@@@@A@A@@@@AA@AAA@@@AB@@@@AB@@A@A@@@A@A@@@@BA@AB@@@@A@@@A@BA@@AA@@@@B@@A@@@@A@AAA@A@@@@@A@@BA@AAD@@@@@@@@A@@A@@@@@@@@@@@AA@BA@@@A@@@A@@@AF@BAB@@A@AACA@AA@AA@@@@@B@@BB@@AB@@@B@@@BAB@@@@A@@A@G@A@@@@@CAA@@@@@@@@AFAB@B@D@@@@A@A@ABCA@@AB@@@AA@@AA@AA@@@A@AB@@AA@@CA@BA@@B@@@BB@@B@BAB@B@@AB@BB@@@A@@@@@@@@@@@AB@@@B@@@@@BA@A@A@@@@A@@BADA@@A@AB@BAD@@A@@@A@@AA@@AA@A@AA@@@@@@BA@B@@BAA@@@@AA@A@@@@@B@@@@@@@@@@@B@@@@A@@@@@@AA@@@BA@@BAA@@AA@@B@@@@A@@@@BB@@@@@A@@@@@@B@@B@A@@@@B@@@@@@A@@@@@A@@@@@B@@A@@@A@@@@@@AB@@@AA@@@@A@A@A@AB@@AA@@A@@A@@@@@@AA@A@@@@A@@@A@@@A@@AA@@@@A@@@@@AA@AA@@@@@@@@BA@@@A@@@@@@@@B@@CA@@@@@B@@@@A@A@AA@@@@@B@@A@@@@@AAAA@@@@@@@B@@BB@@@B@@@BC@@B@@@D@@@BA@@A@A@A@@BAA@@@@@@@A@@B@A@@@@@A@@ABAA@@B@@@@@AA@@A@@@B@@@@@B@@@AAAAA@CGAA@@@A@A@A@A@@AA@A@@@@EC@@A@@@ABA@C@CBA@@B@@@@@@@BB@@@ABA@C@A@E@A@@@A@@@A@A@@@A@A@A@@@A@@@@AAA@@A@A@@@A@C@@@CAA@C@A@@@@@A@@@@ABABC@A@@B@@@B@@@@@ACA@@AACA@@A@C@@@A@ABA@CB@@ABAB@BA@A@A@AAA@C@A@@C@AAC@AAA@C@A@AAA@CCCE@@CA@@A@C@AA@@C@ABG@A@CACAAACA@AA@CEA@@A@@@A@@BC@A@A@@B@@AB@@A@A@@@C@CBA@AAA@AA@EAEAEAAA@@@@AA@@@A@@B@@A@A@@BA@@@A@A@AB@@@@A@@@@@AAAA@AA@@A@BA@@AA@@@@A@@BB@@@AB@@@@A@A@AAABA@@@AB@@A@@A@A@@B@@C@CBA@C@C@@@A@@AB@@@A@@A@@@AB@@ABA@@@@B@@AB@@@@A@@A@@A@@@@@@@@ADCBAB@@A@@@@AA@@@A@AAA@@@@@@A@@@AB@B@@@B@@@AA@@@@@A@@@@@BA@@@@@AA@C@A@@A@@AA@A@BA@@@@@A@A@@@CACCCAA@@@A@A@@@CBADA@@BABAD@@A@CAA@@@AAAAA@A@A@@@AA@@AAC@A@EA@BA@@@A@@@@@A@@@A@@@@@ACA@@A@@A@@BABABA@A@ABA@@@ABA@@B@@@B@@BD@B@@@B@B@@CBCBADA@@BAB@B@BAD@D@BABAB@B@@B@@B@@@@@DAB@B@B@BA@ADEDABA@@@AA@@A@A@@@A@@@@@IBCBCBC@C@ABA@@@AB@@A@ABC@C@A@CAA@A@GCACCAA@AAC@A@ABCBA@A@CBG@CAE@G@A@AAA@AAA@@@@@AB@@@@A@AA@B@@@@AB@@A@@@A@"],encodeOffsets:[[117394,28925]]}},{type:"Feature",id:"360923",properties:{name:"上高县",cp:[114.947683,28.238061],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@@A@A@AA@@A@@@A@@A@@@AA@@AA@@@A@@A@@AA@@@@@A@@@@@AAC@@@A@@C@@AA@@@BA@AGEF]A@KNCA@CA@@A@@@A@AA@@ACA@@AA@@A@ABA@A@@AA@AA@@ABA@A@@@A@@@A@A@A@AB@A@A@@@@ABABAB@@@BB@@@@@@@@B@B@@A@@@A@@@AB@@C@A@AB@@@B@@@B@@A@AB@@B@@@@@ABAB@@@B@@BD@DAB@@CAC@C@A@A@C@ABABABEB@@A@CA@@AAA@A@CAA@ABC@A@ABCA@@CBCAC@A@A@@@A@E@@@ABA@@BA@A@CCA@EA@AA@CC@AA@@@ABAAC@@@AA@@@AB@@A@AIG@A@@C@@@C@@@A@@@A@@@C@C@CAA@A@CB@@@B@@@B@B@@A@AB@@AB@@@@@B@@@@CB@@AB@B@B@@AD@@B@@F@B@@AA@@A@A@@@A@AB@@A@A@A@@@A@@@@@@AA@@@@A@A@@B@@A@@@AB@@@@@@@@@@@A@@AB@@@@BB@@@@AA@@A@@@@A@@@@B@@@@A@@@@@@@A@@@@@@B@@AB@@@@@@@BAB@@@@@BBA@@B@@B@@A@@@@@AB@@@BA@@B@BBB@B@@B@BA@D@B@@AB@@@@@B@@@B@BABBB@B@@B@@@B@B@B@B@@BD@@@@@BB@@@@AB@@@@B@@@@B@B@@B@DB@@@@@B@B@B@B@@AB@B@B@BB@@BA@@B@BB@BBBB@B@B@@AB@B@B@B@@@@A@A@A@@@@BA@AB@@AB@@@@@@@B@@@B@B@@@BBB@B@BA@@@@@B@@B@@B@@B@@ABB@@BDB@@@@@@@B@@AB@BBB@BAB@B@@AB@@A@A@@A@@@@AAA@A@AAC@@A@@@@A@AA@@@@A@@@A@@BA@@BAB@@A@@@A@@@@@AA@@A@A@@@AAA@@@@A@AA@@AA@@A@@CD@@A@@@AB@@ABCB@@A@CBA@G@A@ABA@AB@@AAA@@@A@@@@AAAB@@A@@B@@A@@@@@AG@A@@@@@A@A@AAA@AA@@A@@@CBEBA@A@@BA@@BA@@@@@@@A@AA@@@@@A@@@C@@@AA@A@@@A@@AA@@AA@AAA@@B@@@@@@AB@@CA@@C@@@A@A@@@@@@A@A@@A@@A@@AA@@@BABAB@B@@@@@BB@@@@@ABC@@@ABA@@BA@AA@BA@@@A@A@A@A@AAC@EAA@ABC@A@E@CBA@@@@@@@@@@@AB@@A@@@A@AAA@A@A@@@AC@@A@@@AB@@@@AA@AAA@@B@@@BA@@@A@@C@C@@@@@@B@@@@A@A@@@AA@@A@@@AA@@CBC@@@CACA@@A@@BAAAA@@A@@BA@@@A@AAACA@@@@@@@@@@AA@@@@A@@AA@ACAAAA@A@AAAAA@@@A@AB@@@@CAAAA@A@AA@@A@@AA@A@@AA@@@AACAAB@B@@B@@@A@ABA@@@@@DA@@@A@@ABA@AB@@B@@@ABA@@B@@B@@@@@@B@@AB@@@A@@@A@@@@@@@A@@@AA@AB@@@BA@@@@@@A@@@AA@CA@@ABA@@@A@A@@@@B@@@A@@A@AA@B@@AB@@@@@B@B@@@@A@AAA@A@@A@@CA@@CA@@AB@@@@@C@@BABA@@@@@A@@BBD@@@@A@@BA@C@@@A@@@@A@@A@@A@AB@@AB@@CB@@AAAB@A@@CA@@AAA@@AAAA@@@AAA@AAA@@@AA@A@@AAAAAAAA@@AA@@A@AA@@@A@@AA@@@@@A@@@A@@C@EAA@@@A@AB@@A@@BABA@@@@@@@A@@A@@A@A@ADA@@BA@@@@@A@@AA@@@A@@AAAA@@AB@AA@@CA@AAA@@A@@@A@C@@@AAA@@@@AB@@A@@A@@@@@@CA@A@@@A@@@@@@@A@A@A@@@@@@A@@@@A@@@@@@AA@@A@AA@@@@@AAAC@@AB@@@@@BA@@B@@C@@AAA@@@@@@@A@@B@B@AABAA@AAA@@BC@@@@BA@@B@AA@@@@@AAA@A@A@@A@@BA@@AA@@@B@@AA@@A@AA@A@A@A@@@@A@ABC@A@@@@@@AA@@@A@@@@@A@A@@@@B@@A@AB@@@@@@A@@@@@@A@@@@A@@@AB@@AB@@A@@B@@@B@BA@@@@BB@@@AB@@@A@@@A@@CBAAAB@@@BA@@@@AA@@@AB@@@@AB@@BB@@@B@@C@AA@@AA@@@BAB@BADA@@@@D@B@DB@@BD@@@@B@B@D@F@F@B@B@@@@DBBBD@B@@@B@DA@@BBDBFDFBBBD@BB@@BB@@FFBB@@BBB@@B@B@B@B@BBB@@B@B@@@BBBB@@B@@B@BABAB@@@BB@@B@@BBDB@@BB@@DBDD@@BBB@@BB@@D@B@BBBBB@B@@@B@BA@@BCBAB@@B@@@@@B@AB@DA@@B@@A@@@@C@@@@AA@B@AA@@@@@A@@@@AAA@@@@@B@@AB@@@@@BB@@ABB@@BBA@@@@@@@BB@B@@@@AB@B@@@@@@@AB@@@@@@B@@B@@@@A@@B@@@@D@B@@B@B@@@B@B@@@@@@@@@@B@@A@A@BB@BB@@B@@A@@@AA@@@@@B@@@B@@@@@@@BAB@@AB@B@@AB@@@@@@@B@@@@A@@@@B@@B@@@@@BB@B@@@@@@@@A@@@@@@AA@@@@@@@@B@B@@A@@@@BB@@@BBB@@@@AA@@@B@@AB@@@BB@B@@B@@BAF@@BB@@A@@BA@A@@B@@C@@@@B@B@@@BB@B@@B@@B@@@@ABAB@BB@@@@A@@@@BAB@@BB@@B@@@@@B@@@@@@@@@@BB@@@@@@@@@A@A@@@A@@@@@@@A@BD@@B@@@@AB@@B@@B@BA@BB@@@@B@B@@A@@@@@@@@B@@@BB@@B@@AB@@@B@B@B@B@@@B@@@@B@@@B@@@@@BB@@@B@@B@@@@@B@@@@@@CB@@A@@@B@@@B@B@@@@@@BB@@@B@@@@@@A@@BA@AB@BA@@@@@@@@@B@@BA@@@@@@@A@@@@@@BAB@@@@@@@D@B@B@@BBBBHDD@@@B@B@BAB@DAB@B@@@@B@B@BABABCDCBCBA@A@A@A@@@@@@@BB@BB@B@D@@@B@B@@@ABABAB@B@@BAF@@@A@@@BA@@@A@@DB@@@B@D@@@@B@@@@@@@BB@@B@@BABB@@@@@@@@B@@@@A@@@@@@B@B@@AB@@@B@B@@B@@B@@@@A@AA@@@@@@ABA@@@@@@@BB@@B@@B@@A@@@A@A@@@@BB@D@@@@B@@@@@@@@BA@@@@BB@@AB@@@@@B@@@@B@@@@B@@@@@@B@BB@ABAB@B@@B@@@B@@@@B@BB@@B@@BA@A@AB@@A@BBB@@BA@AB@@@@@@BB@BABCBAB@@@B@@@@BB@@B@@@BB@@AB@BBB@@@@DA@@@BB@@A@@@@B@@@@B@@AB@@B@@@@@@A@AB@@@@@B@@@B@@@BAB@A@@@@@@A@@B@B@@@@@@B@D@@@@B@@A@G@A@@B@@@@@@@@B@@@B@@@B@D@D@@AB@@@BB@@@BA@@@AB@@B@@@BB@@@BA@@@@BBED@@A@@@A@A@@@@B@@D@@@B@@@@@B@@@BBA@@@@B@@B@@AB@D@B@B@@@B@@@@@@@@@A@A@A@AB@@@@@B@@@AB@@@AB@BB@BB@@AB@B@@BB@@@@B@@@BA@@@@B@@A@@B@@@DB@@BBB@B@B@@@B@@@@AB@B@@@B@@B@@@BB@@@B@@@B@@@B@@BB@@@FH@@B@B@@@@B@@ABABA@@B@@B@@@B@BABADAFC@@B@@@BF@@@BB@@@@@@B@@@@DBB@B@DBA@@@BA@AB@@C@@B@@AB@BCB@BA@@@@@A@@AAB@@@@@B@@@@B@@@@BBBA@@@@@@@AA@@A@@B@@@@BB@@@@@BA@A@@@@@@@A@@B@@@@@@A@@A@AB@@@@AA@@@@A@@@@A@@@@AA@@@@BAB@@@@@B@@BBBB@B@@@@@@A@@@@@@@A@@@@AA@C@@@@@@BB@@BB@@@@@BBB@@@@B@@@B@@B@@BBBB@@B@@AB@@@B@@A@@@@@@AAA@@@@A@AB@@@@@B@B@D@B@B@B@@@@BD@@B@@@@@B@B@@B@@BB@@@@D@@@@@@B@B@BBB@@@@@@B@@@BA@@B@BAB@B@@@@B@@@B@@@BA@@@@@ABBB@@@@@BA@@@@B@BBB@BBB@H@F@DBH@DAB@B@DABAB@D@BBB@DBBDHDB@B@DBB@D@D@BAB@@@BA@@B@BAD@D@DADAJA@@@@B@@@B@B@@@BB@@B@BAFCBCB@@A@A@ABA@C@@@@@AA@@@@ABABA@A@CBC@A@ABA@AB@BCDADA@@@A@A@@@AAC@@@A@@@AB@BA@@B@BAB@B@BABA@AB@@@@BB@BD@@@@B@@@B@@@@@B@@@B@@AFBB@D@BB@@BB@@B@B@B@BBBB@@B@DBB@@@BCBA@AB@BCDA@@B@B@@@B@DBDDDB@@B@B@@@@@B@@A@BBB@@@BB@D@B@@B@@@@AB@@@@B@@@@@B@@B@@@A@@@ABA@@B@@@@@@@BB@B@B@@BB@@@@@BA@ABCD@B@@@@@@B@@@@BB@@@@@BA@@@A@@B@BA@@BA@@B@@@@B@@BA@@@B@@@D@DAB@D@DA@@@@B@BB@@@BA@@B@BABBB@B@@@@@BA@@A@@AB@@@@@BB@@ABB@@@BBB@BB@B@@@@@B@@A@@B@B@B@@AB@@@B@BA@@@@B@@BB@@@@BBFBFBFBB@@BBB@BAB@D@D@@@B@BA@@BA@@@@B@BAD@@@B@@@BB@DFB@@BDBBBDBDBB@H@BAD@@@BBD@B@@@DB@@DFDDB@BBB@D@B@BBD@BBD@@@@B@DBB@B@B@BABA@AB@BA@@DAB@B@B@@@D@BB@BD@BB@BD@@@@A@@@A@@@@BADAB@B@@B@@@@@B@D@B@DB@@D@B@@@B@B@@@BB@B@@B@@@B@B@B@@@B@B@@@B@@@B@F@B@D@B@BA@@A@@A@@@@@@@AB@DAD@B@BA@@B@@@FD@@@@@BBB@@@B@B@B@B@@BBDHB@BBBB@@A@@@@@A@@@B@@@BB@@@@A@@@BBBA@@@B@@@@@B@AB@@@@@@@B@AB@@@B@B@BB@@A@@@C@@@AD@@A@@@A@@AA@@@A@@@@@@BBBB@@@@B@@@@A@@@@BBB@B@@@@@@A@@@@DB@@@A@@@@@@B@@@B@@A@@@@@@B@@BBB@@@@B@@@@@BB@@@B@@@B@@@B@@B@B@@B@@@@B@@@@BB@@BA@@B@B@B@B@@B@@B@@BA@@@@@@@B@@@BA@@@@@B@@@@@B@@@@@@@@A@@B@A@@@@A@@@@B@@@@@A@@A@@B@@@@@@AB@@BB@AB@@AB@@B@@B@@@@B@@@@@@A@@@@@@@@@@@A@@@@@BBB@@@@BB@AA@B@@A@@@@B@@B@BBB@@BB@@@B@@@BC@ABA@@B@BB@BC@AB@@@@@@B@BAB@@@@A@@@A@@B@@@@@@@@@@@B@@AAA@@BA@A@ABA@@@AA@@A@@@ABB@@DB@@BA@@B@B@@BBB@@BB@@B@@BA@@DBBAB@B@@@@@@C@ABABE@@@@@@@@BB@D@@@@@B@H@BB@@@@@BA@A@@@A@@BA@@AA@@@A@@@@BBB@@BDBBBB@@@BA@ABE@@B@@@B@@@B@@ABB@@@@@@@@@@B@@@@B@@@@@@C@BBB@@AB@@@@@B@B@BBB@@@@@@BA@@@@@BB@@ABB@@@B@@@@@BAB@@A@@B@B@@@B@B@@@BA@@@@BA@@B@BBB@@B@@B@B@@@@@B@@A@BB@@@@B@@@@@@@@@ABA@A@ABA@@B@@AA@@@@A@@B@@@@@@B@@@@@BB@B@@@@A@@@@@@@@@@A@B@@A@@@@BB@@BBB@B@B@@@@@@B@B@B@@A@@B@@B@@B@@@B@@@BAD@BBD@@BBBB@B@D@@BBBB@@@@@@@@DB@BB@B@BBB@BB@@@@@@C@A@C@CB@@A@@@A@BD@@@@@B@@B@B@DAB@BA@B@@AB@@@@@@@@B@@@BA@@B@BAB@BB@@B@@@B@B@@@@BA@@BA@@@@B@@@@@@@@B@@@BA@@@@@@BB@@@@@A@A@@B@@@BB@@@@BC@@B@@AB@B@@@BBD@B@B@DBD@BBBBBB@@D@B@@@BAB@@@B@@B@@@AB@@ABA@@BAB@B@BAB@@@D@B@@@BBB@@@DB@BB@B@B@@B@@@B@@AB@B@B@@CH@BBBBBBB@@ABBB@@B@@@@@BA@@@@B@BB@@B@@@D@BBB@B@D@B@BBBB@@@D@@@BB@@B@@@D@@BBB@@B@@BB@BAB@@@@BBB@@B@@@A@@B@@@@@@B@@@BBBA@@@@@@@AA@B@@@@@@BB@@@@A@@@@@@B@B@@B@@@BBAB@B@BAB@BA@@BB@BB@BB@@B@B@@@BAB@@@B@@@@BB@B@@@BBA@@@B@@BD@B@B@@B@B@BB@@B@@@BAB@DB@@@AB@BA@@B@B@D@B@B@@@@@@A@@BA@AB@@AB@@B@@@@@@B@@@B@@@@@@@@@@@@A@@B@@@D@@@B@DBB@@@@@AA@A@@@@B@@@AA@@B@@@@@@A@@@@BB@@BB@A@@B@AA@@@A@@@@B@@@B@@@@AB@B@@@B@@@B@@@@@BA@@@@BBB@@@B@@AB@@@@@@AAA@A@A@@AA@@BA@@@@A@@@@AB@@@AA@@BAAA@A@@@@B@@@@@@A@AA@@@@AB@@A@@@A@A@A@A@@@A@@BB@@@BB@@A@@@@B@B@@ABB@A@@@@@@A@@@@A@A@AAC@@@AAA@@@@@@A@@B@@@@@B@@@@@@@@AA@@@@@@@@@B@@AB@@@@@A@A@@A@AB@@B@@B@@@@@@AA@@@@@BA@@@@A@@@@@@A@@@C@A@@A@@B@@@@@@@@A@@@@@AAA@@@A@@@@@A@@B@@@@BB@@AF@B@@@B@@@@BB@@BB@@@B@B@@@@@B@@AA@AA@@B@@BB@B@@@@A@@ACB@@@AA@@@ABB@A@@AA@@@@@AA@@@@@@@@@@@@AA@@B@@@@@A@@@@AB@@@@@A@@BA@@A@@@@@A@BA@@@AB@@@@AAA@@@AA@@@@@@A@@@@@@@A@@@@AA@@A@@A@AA@@ABA@@B@B@@A@@AA@@@@@CB@@@@AB@@A@A@@A@@A@@@ABA@@@@BB@A@@@@@A@A@@@@@@@@BA@@@@@A@B@@B@@B@@@@@CAA@@A@@@@@@@@A@@A@@@@A@@@@B@@@B@B@B@@@@@@AA@@@@A@A@AA@@@A@@@A@A@@@@@A@@@@@A@@@A@@@@A@@@@@@@@ABA@@A@@AAB@AA@@@@B@@@B@@B@A@@@@@A@@@@AA@@@A@@BA@@@@@@A@A@A@@@@ABA@A@@@A@@@@@B@B@@CA@ABC@@DB@@@@@@BAB@BAB@@ABA@@D@@@BADA@A@@@A@@F@@@@@@@B@B@@@@@@BA@@@@@@@BB@@B@@@@@@B@@B@@@@@@BBB@@B@B@B@@@@@B@@@@A@ADE@CBEBE@AB@@@@AB@B@@@B@@@DBB@@@@@CA@A@A@@@@BA@@DA@@B@BAB@@ABA@@BAB@B@BBB@B@BBB@BAB@@@@@DB@BBABA@@DE@AA@@AA@@@@@B@@A@@B@@@@@@C@@B@@@@@C@@@@@@@@@A@@@@@A@@@@@CA@@@@@@@@B@@@@@B@@B@AB@B@BA@@@A@AA@CGAB@A@A@@@@@ABBB@@BBABB@A@@@@B@B@@@@A@@B@@@@@B@@A@@B@@@@B@@D@@AB@@@B@@@D@@@@B@@@@AB@@@B@@B@B@D@B@FBB@@AB@@A@A@A@@@@@AA@A@B@@@BA@@BB@@@@@BD@@@@@@@@@B@B@B@@A@ABA@AD@@@@B@@BD@B@@B@@A@@BABADG@A@@@AAA@CA@@C@E@@@C@@@EBA@AAC@@@CAA@AAA@AA@AAC@A@CCA@A@@@C@AACACAA@A@A@@@A@A@A@CB@@CB@BABAB@@CB@BA@A@@@@@AAAACAAAAACAA@AA@@A@C@A@CB@@A@CFAB@@EBA@@@A@CAAA@@A@ICAAA@EAIEC@A@C@A@A@@BEAA@A@AAAAA@@A@A@A@C@C@A@CA@@AB@@@@@B@@AB@@@B@@@BB@AB@BB@@B@@@@@B@@@@@B@@@@AB@BA@AA@@B@@@AA@@@@A@@@A@@@AA@@A@@AAB@@@@@@AB@A@B@@AA@B@@@@@@@@BB@@@@@@A@E@@A@@@@A@C@@B@B@@@DAB@@@@@@@@B@@B@@@B@@@BA@@@@@@B@@@@@@@@@@@@AB@@@@BBAB@@BBA@@B@@@B@@C@A@@BAAA@ABC@@@A@A@@BA@@@@ACAA@@@A@@@@@ABC@@@A@AAA@A@A@AA@@@@@AAA@@@A@A@@@@A@@A@@@A@@@@A@@A@@@@BAA@@A@@BA@@@@@@@@@@@@@@@AA@@@@A@@@@A@@DBB@@@B@@@@AA@@@A@@A@@A@CB@@A@@AA@@@@@A@@BA@@A@@A@@@A@@@@@@@A@A@A@@@@A@@@@B@@@@@AA@@A@@@A@@@AC@@B@@@BAA@@C@@AA@@@@@@@@@A@@AB@@@@@@@A@@@A@@C@@A@@@@@AB@@@AA@@@@@B@@A@@@@@@@A@@@@@@@A@@@@AB@@@@@AAA@A@A@@@A@@@AA@@@@@@@A@@@@@@@@B@@@@BB@BABBBA@BBA@@B@@A@@@@@BB@@@@@BB@@B@@@@@@BB@@BB@BB@@@@@A@@@@@A@@@@@A@@@AAA@@AA@@A@@A@@@AA@@@AA@@@@AC@A@@@@@@@A@@@@@@@@B@@@@BD@@@B@@@B@@@BABA@A@A@AB@@@DA@@@CAAA@@AB@@A@@@@@@A@@@@A@@@@@@AA@@@@@@@B@@@@@@@@A@@@@@AB@@@@AA@AA@@AA@@@@@@A@@A@@AA@@@A@@@A@@@@A@A@@@A@@A@A@@@@@AA@@@@A@@A@A@A@@@A@@B@B@@A@@@@@@@A@@@@@@@@AA@@@ACA@@@AB@@A@ABC@@BA@@A@@@@A@@@AB@@@@@@A@@BAB@A@A@@@AAAAAAA@@@AB@B@@@@@B@@AB@@@B@@@@@@@B@AAA@C@A@@@AAA@@A@@A@@A@AA@AA@A@@BA@@@CCAAAACA][@@A@@@A@BA@A@@AA@A@@BABBF@@@@AB@@C@CAA@AB@@@BB@@@AB@@ABABC@@@A@AB@@CB@B@@B@@BB@@B@DAD@@AB@@@@@BA@A@@@ABA@@@A@A@A@@@ABA@A@@@CA@AA@@@@@@A@@C@@AB@@@@@@@B@@AA@@B@A@@@AA@@AA@@@BA@@@A@A@AB@A@@@@@@AA@B@@@B@@B@@@@@A@@@A@@@@@@A@A@@A@@@@B@@A@A@EB@BA@CD@BC@ABA@@BA@@@@@A@A@@@A@@D@@@@@@AA@@BAA@@@@@@BAAA@@@@@A@@AA@@@A@@@@@AB@@C@@@@@@AA@AA@A@@BA@A@@@@AAC@C@A@C@A@AAAAAAAAA@@@A@AB@@@@@@B@@B@@A@AB@@ABA@AB@B@BA@@@AA@@E@@@CBAB@@@@@@@@BB@@@@@@BB@@BBB@@BB@@@@@B@ADB@@BA@@BA@@AA@ABA@@B@@@A@@A@@@@A@@@AAACDA@ABCB@@@@@BBB@@@@@BA@AA@@EB@@@@ABA@@@A@@@@AOCEGAA@AA@A@@@@AA@@A@@AC@@AAC@@A@@@A@@@C@A@@@A@A@@@AA@@@@A@@@@A@@@AA@@@@AAA@@AA@@@AA@@AA@@A@A@@@@@A@EA@@AA@@AAA@@@A@@@@@A@A@A@@AA@A@@@ABA@@BAA"],encodeOffsets:[[117686,28735]]}},{type:"Feature",id:"360925",properties:{name:"靖安县",cp:[115.362628,28.861478],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@CACA@@AC@@A@@@AAA@CB@@A@AA@@AAA@A@CAA@@@EDEBA@A@C@A@A@AACACCC@AAA@CACAKEA@A@A@@@CCCAC@CAA@A@A@C@@@A@A@@AA@@AEAA@@@ACA@@@CAAAA@EC@A@A@ACA@AAA@@AAA@@@@@A@@BABA@@BA@A@@A@@@@@@@A@@@A@C@@@C@A@A@A@@AA@@@AAA@@C@C@@@A@@@A@AAA@@@ABA@@@C@AA@@@@AC@@@AA@@@AAA@A@C@E@CB@@C@@@AA@@AC@@AAAAA@@AA@C@A@AACA@@@@AA@@A@CDA@@@A@ICAACA@@A@C@C@C@C@CAEAAAA@@D@B@B@@@B@@A@ABA@A@@@@@CFCBABC@CBE@ABA@A@@@@AAAAAAAA@A@ABA@@@A@@@A@A@@@C@C@A@@@ABA@AB@@A@@@AF@BABCDCDABA@@@CBA@@BAB@@A@A@A@CAC@C@GBA@A@A@A@@@A@ABABAB@@@B@@@@AAA@AACAAAAA@@A@A@C@@@A@A@@A@@AA@@CA@@CC@AAA@@AA@AABAB@@ABCBA@ADAB@@@@@D@BA@AB@@@B@@BB@@BB@B@B@B@BBD@B@@@B@BAD@@BB@BB@@D@B@B@@@B@B@D@B@B@BAB@B@DBB@@B@@BB@BDFDBBBBB@@@@B@@@BBB@BB@BBBBB@@BB@@@B@B@B@BAB@B@BB@@D@BAB@@BB@BBDBB@B@B@B@@BBBBBB@D@DBBBFBDDBD@@@D@@BFBB@B@B@F@@A@@BC@@@CB@BA@ADAB@@AHAD@B@F@@@DB@@BBBBB@@DBBB@BAD@@AB@B@B@B@@CD@@AB@@@@FFBBBBDDBB@@@B@BBBB@@BB@F@B@BBB@@@BB@@@B@D@BB@BB@@BBBB@@ABAB@B@D@@@BAD@@AD@F@BBBBB@@@@A@@B@@A@BBABAB@BAB@BA@@BAB@@@@B@@B@@BB@@B@@B@B@@@BB@BBDBBB@BBB@@BBB@FDD@BBFBB@BB@@@@AFABABA@@BA@@B@B@B@@@B@B@@B@BBD@B@BBB@DB@@BB@@@DBBB@@DDDBB@@@BB@DBD@D@BBDBB@@@B@D@B@DAB@B@BBBBB@@BB@@@@@B@BAB@HABAB@B@BBB@B@DDFBB@B@B@B@B@BB@@B@@@B@B@FFB@BDB@BB@@@@@B@B@@@B@B@D@@BB@BB@@B@BA@@BA@@B@@B@F@@@BB@@@@@B@@B@@@B@@@@@B@BBBBB@@@DADAB@B@D@@@BB@@B@@AB@B@BBBBBBBF@BB@BBF@@@BBB@BDBBB@BBB@D@@@BB@BB@@@B@BAB@@BDDBB@BB@B@DAD@@@BBD@@@@B@AB@@@B@DBDBB@B@BBDB@BBBBB@@DB@@BB@@BBB@@BB@BBB@@AD@@BDBBBFBDBBBFDDB@BB@DDB@HDD@B@D@B@B@H@B@BB@@B@@AB@@@DBDBB@DDB@B@D@@@D@BBDBFDB@@@BAB@B@D@B@@BB@B@B@D@DBB@D@DB@@DBB@@@BBBBBBB@B@BBDBBB@BB@@BD@F@DABAB@HBFAB@DADA@AB@@@BBD@B@BA@@B@D@@BB@B@FA@@FBDBB@@@B@D@BBD@DBB@B@BBB@BB@BB@@B@@@@B@@@BAD@B@B@BBB@B@B@BABBB@DBFB@@D@@@FE@AB@@@BA@@B@BBB@B@FBDBB@B@BB@BB@@AB@B@B@B@B@B@F@@@B@F@NABAB@@AB@F@@@B@BAF@B@DAB@BCB@D@BABAB@@@@@BA@@DADABAB@D@B@BAJ@F@B@B@@AB@@@@AB@B@BABAB@D@B@B@DA@@B@@@D@B@B@BABA@@BAD@D@FABA@@@@AA@A@A@ABA@@@C@ABA@@@@B@@BB@F@B@D@D@BAB@@@@A@@BA@@BA@@@@@AB@@@D@D@F@@@B@@BBBBB@@B@B@B@D@B@@@BBB@B@@@@@@AB@@AB@B@D@@@BAB@@@@A@@B@B@F@@@B@AA@@DAB@BAB@B@BA@A@@@A@@@ABA@@AAA@@ACA@A@AAAA@@AA@A@AA@@AA@@@@BC@@AA@@@@AA@@@A@@@@BA@@B@BABAB@@A@@B@@A@@@A@@B@B@B@@A@@BA@ABAB@@@@A@@BAH@B@B@B@@@@A@@B@@@B@@AB@@AB@@@B@BAB@B@B@@@BBB@@@B@@AB@@A@@BA@@@A@AB@@E@@@A@@AA@A@@AAA@@@@ABA@@@@BAD@BC@A@AB@@A@A@A@@A@@A@@@@@A@@@AAA@AB@@A@A@ABABC@@@@EAA@@A@C@@A@@@@B@@A@ABA@BAAAAAB@@A@@@AC@@AA@CC@@@ABA@ABA@A@@@AB@@@@@@ABCBABA@ABAB@@A@AB@@A@A@@@ABA@@@@BA@@BBBA@@B@@@B@B@@@@A@A@A@@BAB@@A@C@ABA@A@@@A@@@@BA@@BA@@BA@A@@@@DA@@@@B@@A@A@@B@@CB@B@@@@A@AB@@AAABA@@BA@AB@@@B@@ABABA@@B@@ABC@A@A@A@@BCBAB@B@@@@AB@BA@A@@B@BC@@@@AA@@@@@AAA@@@@@A@A@@AA@@B@@@AA@@BA@@@A@@@@@AB@@@@@B@@A@@@@@A@@BABA@@@AA@@A@@BABA@@BA@@@@B@@A@@BA@@BAA@@A@@@@@AB@@A@@@@@AB@@AB@@@@@BB@@@A@@DA@AB@@ABAB@BA@@@A@@BA@@@AB@@@BA@@@@@@@@BA@A@@@@@AA@@@@A@@@@@@@AA@A@@@A@@@A@@A@@@ADABA@@BA@@BA@A@A@@@ABA@AA@@@@AB@@ABA@@@A@@@@DE@@AA@@AA@@@@@@@A@A@@AA@@A@@@AA@@@A@@@AAA@@@@@@A@A@@A@@AA@@B@@AA@@@AA@@@A@@@A@A@@@A@@A@@@@@AC@@@A@@@C@AA@@@A@@@@@@@@@A@@@AC@C@@A@A@@@A@@A@@AAAC@@AAA@A@A@CBA@CFAB@@@B@@AB@BCBCB@@A@EDABA@GBGBA@@B@@@@@@@@B@B@DA@@B@@B@@ABC@@@A@A@ABA@@@A@@@A@A@@B@B@@BD@@@@A@ABA@ADA@AAA@@@@@A@@@ABA@C@A@A@@@@@@BA@@B@@@A@@@CA@@AA@@@A@AD@BA@@@A@C@CA@@@@@AAA@@BCBCA@@@@@@@A@@@AA@@@@AAA@AA@@A@@@ABA@CB@@C@@@AB@@AB@AA@A@A@CBABA@AAA@@B@@AB@BAB@@AB@@A@EAA@A@@@ABAB@@ABA@A@A@A@ABA@A@@@AB@@A@A@A@@BA@@BA@AA@@@@BA@@A@@A@@@@@A@@@@AA@@@AAAA@@A@@@AB@@A@AAA@AAA@@A@@@A@@@@A@A@ABCDC@AB@BA@@BA@@B@@A@@BABADABABC@CBC@@@AD@@A@@BC@@@AB@@@B@B@@@B@@A@@@A@@@@@A@@@@@A@AA@AAA@A@EAC@AACBA@AA@@B@@@D@B@@AFCBA@@B@B@DA@A@A@@AA@AA@CAAA@@AC@AAA@ABC@@@ABA@C@E@A@A@AAA@@@@A@@A@A@@@@@AEA@@@A@@A@A@ABCBA@@AABC@ABA@@@@A@A@A@A@A@@@@AAA@@@AA@CA@@CBA@AAA@AA@@A@@B@@AAA@A@AAA@A@@@@A@@@ABAB@@A@CDC@A@@BA@@B@B@@ABCBA@@B@BAB@B@@@@C@@@@@@B@B@@@@A@@A@@A@CBCBA@A@A@@@C@ABCBA@@@C@A@@@A@@@ABAF@@@BA@A@@AA@AAA@@AAB@@CBABABAB@@C@EDCBA@ADABCB@BAB@DAF@@@F@B@@DD@@@B@B@@AB@@AB@@AD@BA@@BA@A@@B@D@B@B@B@@ADA@@B@@@BBBBB@@ABC@ABCBABABA@A@@@@@A@A@A@C@A@@AA@@@A@AAA@AAA@C@A@C@@@A@@@A@@AA@EC@AA@CA@@A@@@ABABAB@@C@CBA@A@CD@BCBA@@@A@C@AACBC@A@@@@@ABA@@BA@@@C@C@E@@@A@@@A@@@EBABA@@@CAC@CACA@AA@@@CDADA@@@A@E@@@A@A@ACA@@@A@A@AAAAAAA@@@AAA@@@AAA@A@A@@@AB@BADA@GBCBA@@@A@@@AAA@@@A@A@C@A@A@A@GBC@@BA@A@C@@BA@A@ABA@A@@@ABCB@BA@C@A@EBA@A@@@AA@@@@@@AB@BA@@@@BCD@@A@AB@@AB@B@D@B@FAF@@"],encodeOffsets:[[117927,29552]]}},{type:"Feature",id:"360926",properties:{name:"铜鼓县",cp:[114.371172,28.520769],childNum:1},geometry:{type:"Polygon",coordinates:["@@BADAB@BAB@D@@BB@@@B@@@BA@@@ABA@@B@B@D@D@D@@@B@B@DEB@@A@ABA@@B@D@HBDBF@B@B@@@B@BAB@@@B@DBB@BB@BD@DBD@B@F@B@BA@@B@B@B@DAB@@A@@@A@@B@@@BBB@@BD@J@@AB@@@BAB@BBB@@@@BB@@B@@B@@@B@@A@A@@DABA@A@@@BB@@BBD@@BB@BBB@@B@@@B@@@D@B@B@DAD@BADCB@D@B@BAB@B@B@B@D@D@BAA@@AA@@CACAAA@AC@ACAAA@A@ACA@A@@@ABABABADABA@@BAAA@AAA@@@A@@DA@A@@CE@A@@AIAA@@@@CAA@C@CAA@AAAA@@EA@AA@@AA@AC@@@AAE@C@ACAAA@@@AAC@A@@@ABC@@AA@A@A@A@AACAAAAAAACACACCA@AA@@A@A@A@A@A@CBE@A@@A@A@@AA@A@A@@B@@A@A@C@@@A@A@CB@@A@AAAAAAC@@@AAA@@AA@@@@BA@ABA@A@C@AB@@@@@@@BA@ICA@ABA@GA@@A@AAA@AAA@A@E@CAC@C@CA@@A@A@AAA@CAA@CAAAA@AAAA@@@@AA@A@@@AAAA@@@AAK@A@A@AB@@@@A@@A@A@@AAICCAA@CAA@ABEBCDA@A@A@@@ABA@E@EB@@AB@BA@AAA@@@ABAB@@A@A@@@CDAAEAA@A@A@@BA@A@A@@BA@AB@@A@A@E@AB@@A@E@A@A@A@@AA@AAA@A@@A@@@CA@@@AAA@@@AA@AA@AAAAAA@@@ABA@A@A@A@@@AA@AA@A@A@@@AA@AACAC@CAA@@@@C@A@@BA@@@AA@AA@@@AAA@@@AA@A@@AAAAAA@AAAAA@A@@@@@@AAA@@AAA@AAE@@@A@@AA@@AC@AAA@@@@A@A@@@ACA@A@@@A@@@ABA@A@@@@AAAA@AECAAA@CAC@CA@BC@@@@@A@CA@@A@A@CBE@ABC@@@@C@@AIAA@C@AA@@A@A@C@CAM@AAC@@@A@A@E@ABA@AB@AA@A@@@A@@BAB@@AB@@AA@@AA@AA@@AA@@@CA@@@C@CAC@C@A@CAEAA@A@A@E@A@A@A@A@A@ACAAAACC@AAA@@C@AAA@AAA@ACAA@@A@A@G@ABA@@AAAA@CAGEEACC@@CB@A@@AB@@A@C@@AA@AACCCCAA@A@AAAAA@AAAA@AAA@@A@@C@A@A@EACAA@@@AEAA@@AA@@@C@@AAAACAAAEAA@A@AB@@A@AAA@@@E@A@CA@@@@AAA@CAC@A@A@@@EBAA@@AA@@A@AAEAA@A@A@AB@BA@@@E@AACBA@A@@@ABA@@@A@AA@ACE@@AAA@A@@A@A@@@A@@AA@@A@CB@A@A@@EACA@@C@@@@@A@AB@@A@A@AAAAC@AA@@AAAA@@AAA@@@@@BABCBA@@@A@@@AB@BA@@B@@@@A@AAA@@@A@AB@@@B@@A@@@@@AGA@@@A@@BABA@A@A@@AAAA@@@CAC@@@@@@E@A@C@CAAAC@AA@@A@A@A@CACA@@C@ABA@@AA@@@AA@@GC@@A@@BA@AB@@A@E@C@A@@AA@@AA@AA@@C@CA@@@DADCD@B@L@FADCBAB@@E@C@C@E@CBE@C@ABCBCACAACCD@BABADCBA@C@C@EB@DAB@BADCD@BCHCFCFCFGFEDABABC@EBAAC@A@CBC@EBE@E@ADDBDBH@D@D@D@FDBBBB@@BH@DBBDBBBDBDBBDBD@DDDD@D@DBFDHDDDBDBD@F@F@F@D@DAB@BABE@ABEBABCBCDABCBCBABCDCBCBA@EAC@EAE@CAEBE@CBGBCBCBCDADCBCBE@ADCDCDADCFA@ABA@ABADCB@HAFAHADCBC@C@@@C@AAC@AAC@ADABEBCBC@EAC@E@C@CBCBCBCB@@CFABABABCBADADABAD@@@DDDD@DBDBBD@D@D@DBD@@DDFDBFBFDDDBD@DBD@D@FBB@@BBBFDBFBD@DBDBBFFBDDDER@@CBCBA@ABAD@D@B@DBDDBBBBB@DBFDDBBBBBFCBCF@B@@@DBDDD@B@D@FABABADBFDFBD@BEFGFABCD@D@D@D@DAB@B@BBFDHBDDB@@F@DBBBF@F@@@BFBF@JBDBDDBDD@DBDBD@DAFCD@@@D@BBDBBDDBD@D@DBD@DBFDHBH@FADAFADB@@B@BBB@@@BD@B@B@B@@@B@BBB@BB@@@B@@@B@BAB@H@B@@@@BBB@BBD@B@@B@@@BBBBBDBB@BBHHBBFBB@BBH@@@BBBB@@BBDDBBBBDB@@B@@B@BBD@@BDDD@@@B@B@BB@BBDBFDFB@B@@@@BD@B@B@@BB@@DBB@BA@@BABA@CB@@@@ABA@@@@B@B@BA@A@AB@@AAC@AAC@@@A@A@CB@@ABA@AB@@ABB@AB@B@@A@@ACBA@@@@@AB@@A@AB@@AB@@@BB@@@@B@@A@@BAB@B@B@D@B@@B@@@B@B@BDFBD@BB@@@DAFAB@@@B@@BBB@@@@@@B@B@BAB@BA@@BABA@ABA@A@A@A@A@C@@BAB@BCBABC@@B@@AB@@AAA@ABA@ABA@A@A@A@A@A@AB@@A@A@E@A@CBABC@A@@AC@@B@B@@AB@BB@ADADCBABA@@B@B@BA@@BAB@BBB@B@B@B@@@B@BC@AHEFG@@BA@@B@DADC@@BB@@D@B@@A@CB@@CBABA@AB@@ABA@A@AA@AA@A@@@A@A@@BAB@BA@@DAB@BABAB@B@B@BADAD@B@@@B@BA@CBA@ABADA@@DAFAD@@@@@@C@ABA@ABADABABABAF@BABAB@@@@@B@@@BDB@@@DAD@DAD@BA@A@@@@BA@@BB@B@BAB@D@@@BBB@B@B@F@BB@@BB@B@@@B@B@BADADAFAFADABAB@@ABA@@BAB@@@B@H@B@@BD@B@BB@@BA@@B@BA@@BBFBF@BBB@B@B@BBB@@@@B@@BDBB@@BB@@BB@B@@DB@@@@B@B@@AB@@@D@B@@@BAF@B@@@BADABABA@ADABAB@@AD@B@B@B@HCDA@@BAHCBAD@DAB@B@BA@AB@@B@BBB@BBBBB@@@B@@@@ABAB@BADAB@@@F@BA@@BABAB@@@BBB@B@@A@@B@B@@BBDB@BDB@B@@BB@BAD@@BB@@BB@BFB@BBB@BBBDFB@@@DBB@BB@@@B@@@@@BABAB@@@BB@@BBF@B@@B@BBF@DBDBB@BD@@@B@@B@@@@AB@BABAB@@@@@@@@BBA@@B@@@B@B@@@B@@@@@@BB@@@B@@@@@BB@@B@@@B@@@@BB@D@DBB@B@B@@@BA@@B@@AB@BB@@@A@@@ABA@@B@@BBBB@@@B@@@@B@@@@@@D@@@@B@@@@@B@@@@B@B@@@BB@@B@@@BA@BB@@@B@BB@@BAB@@@DC@@@@B@D@B@D@@@B@B@@@BAB@B@@@D@@B@@BB@@@B@@@F@@BBBD@@DBB@B@B@BAB@B@@BB@@D@@B@BBD@B@B@BB@@B@BAB@D@@@BABABADAB@@AB@BA@CBC@@@EBA@C@A@A@ABA@@BE@A@E@CAC@A@@BCBA@A@@@C@@@A@A@@@@@@B@B@@@B@@A@@BA@@BCBA@@BABC@AHAB@BADCBAB@FCDA@AB@BAB@FAB@BAB@@AB@@CBC@EBADEBA@A"],encodeOffsets:[[117373,29386]]}},{type:"Feature",id:"360982",properties:{name:"樟树市",cp:[115.546152,28.055853],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@B@B@D@ACBA@A@C@CB@@C@A@A@A@AB@@ADAB@B@@@@AB@B@@@@@@B@@BAB@BA@@@@AB@@B@@BA@@@@@B@B@@@BA@AB@A@@@@@AA@@@@BA@@B@@@@AB@A@BAA@@@@AB@@@BAB@@@B@@@@BB@@@D@@ABBB@BB@@B@B@B@@BB@@@@@@@B@@@B@BA@@@@@A@B@@@@B@@@@@@@@AB@@B@@B@@A@@@A@@@@B@B@@@DABABAB@B@BA@@BA@@@AB@BAB@@@@B@@@@@@@B@@A@@BB@@@@@B@DAB@BA@A@@BA@@BB@@@@@A@A@@@@@@@AA@@@AA@AA@@@AAEC@@@AAAB@@AB@@@@@B@@@@@@BB@@@@@@ABA@@@@A@@@@A@A@@@@@@AB@@@@AAAB@A@@@@@B@@@B@@@BA@CCAA@@DE@@@A@@A@@A@@@@@@@@@AA@BC@@@@B@@@B@BA@@DBB@@@@BB@@BB@DDDB@BB@@D@@B@@@B@@B@@@@@BA@@D@@B@BA@@BBB@@@@BBBA@@@@@@B@@BB@@@@AB@@@@@@B@@@@B@@@@@B@@@BA@@@@@@@B@@@@B@@BA@BBB@@BA@@@@@@@B@@@@@@@@B@@@@@B@@@B@@@D@@@D@B@B@@@B@@@@A@AA@@@@A@@@@@@@AB@B@@A@@A@@ABA@A@@@G@A@@@@@A@A@A@AB@@@@C@@BBBA@@AA@@B@@A@A@@BA@AB@@@@AB@@@@@@@B@@B@@B@@@BA@@@@@BB@@B@@@@@@DA@@BAA@B@@@@@@@B@@@@@@@BA@@@@BBB@@@BA@@BBB@B@@@BA@@B@B@@@B@@@@@@AB@@@B@B@@@@@@@@@B@B@BBBAB@B@@@@@B@@@BB@@DB@@@@BBBA@@@@BB@@BBBBBB@@BBB@BA@@BA@AD@@AA@@A@@AAC@CAA@CA@C@@@@A@@A@@@@@AB@@BBA@@CAAA@@@@@@@A@@FBB@D@@@D@D@@C@CBA@ABA@CBABA@AB@@@@@@@@@AB@@BB@@@@BA@@@@B@@@@@@@@@B@@@@@B@B@@@B@B@@@@A@@B@@@B@@@@@@@@A@A@@B@@@B@@@B@@@B@@A@ABADA@@@A@@@A@@@@@@@@@AA@@A@AAABCA@@@@B@B@@A@@@A@AA@@@@BA@@@@BAA@@@@BA@@@@@@@@A@@A@AB@B@@@@@@CAA@@@A@A@@@A@@A@@@@A@A@@@AD@@@@@BA@@B@@BD@@CB@@@@@BA@@@A@A@@@@@@@@A@@@@@@@@@AA@@@@@@A@A@@@@@@A@AB@@A@AA@@@@@@B@@@@@@@@@AA@@BA@@AA@@AE@AACAA@A@@AA@@@A@A@@@@@A@@@@A@@@A@CA@@AA@@A@AA@@@@A@@@AA@@@@AA@AA@@@A@@@A@@AA@@@@AAA@@@@A@@@AABAA@@A@@@A@AA@B@@@@@@AB@@AA@@@@BA@@@@@A@@@BA@AAA@@B@@@@@@B@AD@@@BA@@@@B@@@BA@@A@@A@@@@A@@@@@@@A@@@@@@A@@@@@@A@@@@AA@@@B@A@@@@@@@@@AA@@A@@A@@@AAB@@CB@@A@@@C@AACBA@GBABA@C@@@A@AB@@@@CA@A@@@A@@@@A@A@@AA@@AA@@AA@AA@@A@@AA@@@@@BA@@B@@A@@@@A@@CAACAC@CBA@A@@AA@@AB@@AAA@@@C@A@A@A@A@@BAAA@@@@@@@AA@@@@@BA@C@@B@B@@@@@B@A@@@@C@ABA@@B@B@@CB@@@@@@@@@BA@@BAB@@@B@B@AA@A@@AA@A@@@@@@BA@@@B@@B@@@@@@@BB@@@@@@BB@@B@@@@A@@A@@A@@@@@A@@A@@A@@B@@@@@B@@@@ABA@@@A@@@@@@BD@@@@@@@@B@BA@AA@@A@A@@B@@@@A@A@@@A@@B@D@FA@@@A@@@A@@@A@@AA@@@A@@B@@ABABC@@@@B@@@B@B@@@@@AA@@B@@AB@@@BA@@@@@A@@@A@@@@@@B@B@@@@@@AB@@@BA@@B@B@@@@@@A@@@@@@B@@@@@B@B@@B@B@@@@B@@ABAB@@@B@@@@D@@@BBB@@B@@@BBBB@@BAB@@@@ABA@A@@@A@@@@A@@@AB@AA@@B@@@A@@@@A@@@A@B@B@@@@A@@@@@@BA@@@@B@AAA@@A@A@AB@@A@@@AB@@A@@AA@@@AB@@ABAD@@CBA@A@@@@A@@A@@@@AA@@@@AA@@A@@@AA@@A@@AA@AAA@AA@@@A@A@@@A@@A@@@A@@@@@@AA@CCA@@AA@@@@@@A@AA@@A@AAA@AA@@A@@@C@@@@@A@@A@@@@BAA@@C@@@@@@AAA@@@A@@@A@@@A@@@A@@@A@AA@AA@A@@A@@A@@AAA@@A@@A@@A@@@A@@BA@@@CAA@@@A@@AA@AAA@A@A@@BA@AB@@A@A@@BA@@@A@@@A@@@@@AA@@@@@@@AA@@@@A@@A@@@A@@@A@@CC@@@@@@BA@@@@AA@@BABAAA@AAA@@@@@@BA@@AA@@@@BC@@@A@@@@AA@@AA@A@@@@@A@AA@@A@@@A@@@CAA@A@AB@@@@A@@@@A@@A@@@@@A@@BABCFIA@@ACA@AA@@A@@AAA@CAA@A@A@@@@BA@@@A@A@A@@@A@C@@@A@A@A@@@@@@AA@@@AAA@@@@BA@@@@BA@@@@BA@@B@@A@@@A@AB@@@@A@A@A@AAA@@@A@@@A@@@@B@@A@@@ABC@@BC@@BA@@@A@ABA@@B@@@B@@@B@@A@@@@@@B@@@@A@@@@@@@@B@@A@@@@@@@@@@@AB@@@@@@A@@@@@@@A@@BA@@@@@A@@B@@@@@B@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@A@@@@@AB@@A@@@@@AB@@@@@@@@@@@@AA@@@@A@AB@@@@@@@@@B@@@@@@@@@@A@@@@@AB@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@AD@@@B@@@B@@@@@B@@@@@@@B@@@@@@AD@@@B@@@@@@@@@B@@@BA@@AA@@@@@@@@@AA@@@@@@A@@@@@AB@@A@@@@@@@@@@B@@@@A@@@@@@B@@@@@@@B@@@@@@A@@@@@@B@@@@@@@B@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@AA@@@@@@@@@@@@@@AA@@@@A@@@@@A@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@B@@A@A@@@@@A@@@A@@@A@@B@@@@@@@@A@@@@@A@@@@@@@@@A@@B@@@@@@@@@@AB@@AB@@@@A@@@@@@@@@A@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@BA@@@CBAB@@@@@BA@@@@BABA@C@ADABA@@BA@@DAD@@A@@BABABA@@BAB@BAB@@A@A@@@A@@@A@A@A@ABA@ABA@A@@BAAA@@BA@OJED@B@@@@@@@@A@@@AA@@@BB@@D@B@@@@BB@@@@@B@@D@@@@@AB@B@@A@@@@@A@@@A@B@B@@@B@@@@B@@@@B@BBB@@A@@B@@@B@BA@@B@@@@@B@@@@@@@@@@BB@B@@@C@@@@@A@@@A@@B@@A@@@@@@@@BA@@@@@BBB@@B@@@BD@@@B@@@AB@B@@@@B@@@B@@@A@@@AB@@A@ABA@ABAB@@@@@B@@BB@@@@@@ABA@@@@B@BB@B@BB@@@B@@RHRJB@BB@@@D@@A@@B@B@@@@BBB@D@@@BD@@@BUXM@C@A@AAA@A@A@@B@@A@A@@@@AC@@@A@@BA@@@@B@@ABA@@@A@@@@BAB@@A@@@A@@@A@@@@@A@@@@B@B@@A@@@@@@@@@@@A@@@@@BAA@@@@@@AA@@@@B@@@A@@A@@@AA@@@@@@A@@@@@A@BBA@@@AB@@A@@@@BA@@@@@BBABA@CBA@ABA@@@A@@A@@@@@A@@@@@A@@A@A@@@@A@AA@BAA@AA@@A@A@@B@@A@AA@@@@@@A@@@AAA@@A@AAA@A@@B@@@B@AAA@B@@@A@@@@@@@A@@@@@@@@@@@AA@B@@B@@@A@AB@@@@AA@@ABA@AAA@A@@@@@B@@@@A@@@@A@@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@B@@@BAB@@A@@BB@@@@@@@A@@B@BA@@@BBAB@@@@B@BB@@B@@@ABAA@BA@@BAB@@A@@@@@@@A@@AA@@@@BA@@AA@A@@@@@@A@@A@A@@@A@A@@@A@@A@@@@@@A@@A@A@@@@@@A@@AA@@@AA@@@BA@@@A@@A@@@A@@B@@A@@@A@@@@@@@@@@@A@@@@@@A@@@@A@@@@B@@@B@@A@@AA@@@@@@@AA@@@B@@A@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@@@@@A@@@@A@@A@@@@@@B@B@@AA@@@@@@@B@B@@@@@AA@@B@@@BA@@@@B@@@@A@BB@@@BA@@@@B@@@B@@@@BD@BA@@@A@A@A@BB@@@B@@@@@@@@AC@@@@A@A@BD@@@@C@G@@F@B@DBF@B@BBDBB@D@@BF@@@D@@@@@B@B@BE@A@E@@BBB@@A@@@AA@@A@@@@@A@@@@BA@@@@B@@A@ABA@@@@@@@A@@@AA@@@@@A@@@A@@A@@@@@BA@@A@@@AAA@@@@@B@@@@A@@@@BA@A@@A@@@@@@AB@@@@@BA@ABA@A@@@@@A@@@C@@B@@@@@@@@AAA@@@@@@@@@@@A@@A@@AA@A@AB@@@BAA@B@@A@@@C@@BA@@@@@@@@@@@B@@B@@@@ABB@A@@BCB@@@@@@@@@B@@AB@@C@ABE@CAC@EA@A@A@AAB@@@@@B@@@BAA@@@AAA@@A@@@@A@AA@@@@@AB@@@@A@E@@AA@@A@C@C@A@E@@BA@AB@BAB@DA@@B@FAFABAB@@A@@BA@AACAAACAI@A@GAA@A@@AAEA@@GCEAECCCAA@@A@AAAA@@AC@@AA@C@ABABA@AFCFEDA@ADAF@@@BAB@BAB@BABA@@BA@@H@@@@C@CAACBA@A@ABABG@CBA@CBA@AAC@AAAACAAACA@@AAEBA@A@ABCBA@ABC@A@A@AAAA@AAAAC@@A@AA@@A@A@@BABAB@@A@E@EBC@A@AA@@A@A@CEA@AAC@A@A@A@ABA@@@@B@@@D@DAB@BAB@BC@@@@BA@G@@@CAA@@@@AA@@A@A@@CACA@@AAA@A@A@ABA@ABCBCD@@EFABABA@ABA@G@C@CAA@AAGCECCAA@@@CCCA@@CAAAAACA@@A@A@AB@@AB@@@BAB@B@B@DA@ABABA@A@A@A@AA@@CCC@AAA@C@ABABA@ABAB@@ABADEFCHCDED@@A@A@A@A@AAA@@@ACAA@ABC@A@@@C@A@AAA@@AACAAAA@CAA@A@CBABA@@@ABA@A@@@AB@B@BCDA@ABA@ABC@CAECCAAACAA@AA@@@@AB@@@B@@@B@B@B@@@BA@C@C@@CA@@@A@A@A@@BA@AA@@A@@@@B@@@@@B@@B@@@AB@@@@@@@@AB@@A@AB@@CBA@@B@@B@@B@@B@A@@@A@A@@@@@A@AB@@A@ABAAABAB@@@@A@@@A@E@AAC@AD@@@@ABCAA@@@@@A@@AAA@@@@BA@A@BB@@@B@@@AA@@@@@AB@@AB@@@@AA@@@@@@AADA@@@AACB@@@B@B@@B@@@@BAB@@ABA@@@@FB@BBB@@@@@B@BA@@B@@@B@@B@@@@BBB@BA@AB@@@@@BB@@AB@@BB@@@@A@@@A@@@A@AB@@A@@BAAA@@AA@A@@@@BADA@@@A@@B@@@BB@A@A@@@A@@AC@@@A@@A@@@@A@@@@@@D@@@B@A@@A@@@@@AB@BA@@B@B@@@B@B@@B@@@@B@B@@B@@B@@@@ABAA@DAB@B@@@@@B@@A@@B@BBB@D@@CBA@@B@@AB@@A@@B@@@@@B@BB@@B@@@@AB@BBBA@@@A@@@A@@@@@@@@@BB@@@@@@@B@B@@@@A@@@@@@@@BB@@@@@@BA@ABA@@@@@A@A@AB@@@@@B@BABA@@@A@@@AB@B@B@@@D@@BB@@B@@@@@@@A@@@A@@@BB@BBB@D@DA@@@CA@@@@@@A@BBAB@@@@@@A@@@@@@@AB@@@F@@BB@BB@@@@@@@B@@@B@@@@@@@B@BB@@BB@@B@@@@D@@@AB@@@@BB@@B@@@AB@@@BB@@@@@B@@BB@@BBB@@@BABB@@B@@@@@@A@@BB@@B@@@AB@@B@B@@@BB@@BB@@@B@@@@@@BB@@BA@B@@@@@B@@@@@BAB@@@@@B@B@@AB@@A@@B@AC@@AA@@AA@@@AB@B@@B@CBAB@@@@@B@B@B@@A@@@A@@@A@@@@B@@@BDB@@@B@B@@@@BD@@@@@@B@@@@@AB@@B@@@@@B@@@@@@@B@@@B@@@@@@@@B@@@@@@@@A@@@B@@@@@@BB@@@@@@@@@A@@B@@@@A@@@@BA@@@@B@@AA@@@@@@@B@D@@@BA@@@@B@@@B@@BB@@ADB@@@B@@@@@B@B@@@@@B@@@@A@@@@@A@@@@@@B@@@@@@@BB@@@BB@AB@BBAH@@B@@B@@@@@BB@@B@@@BB@@@@@@BBB@BB@@DBB@@@@@@@C@@@@BBB@@@@@B@@B@@BA@@@@D@@@@@B@@@BB@@@BA@BB@@B@B@@@@AA@@@@@B@@@D@@A@@@A@@@@BB@@B@@B@@B@BBB@B@@@@@B@@@B@@B@@B@@@B@@@@@BA@@@A@B@AB@@@A@BBB@DAB@BB@BBAB@B@@B@BB@@@@@@BB@@@@@BB@@B@@@B@@@@B@@@B@@@B@BBDB@@B@B@BAD@FE@AB@@AB@PB@@BBBD@@@@B@B@@@@B@B@B@@AA@@@@A@@@@@A@@B@BB@B@@@@B@@AB@DABBB@BBB@BBBDB@DB@@B@@AB@@@@B@@@B@B@@B@@BB@BB@@A@@BB@@B@B@@@BB@BB@B@B@@@@B@B@@@B@@B@@@B@@@A@BB@@BB@@@@F@BAB@@@@@D@@@@AB@@@B@@A@@BBB@BB@BB@@B@@@BB@@BB@@@B@@@@@@B@@@BB@@@B@@B@@BF@@@@B@@@@@A@AB@@AB@@@@BB@@AD@BAFAB@@@@@B@@B@@@@B@@@B@@@B@@@@@DB@@B@@@DAB@B@@BBBB@B@@@BB@BBBBD@@B@B@@BB@@@A@@B@B@@@@AA@@@@@@AB@@@B@BA@@@@BA@A@A@@@@@@BA@@@B@@@AB@@@@@@@@@@A@@@@AC@@@AAALC@AA@@A@@@AA@DA@@@A@@A@BA@@B@DAB@@AAA@@@ABC@AB@@ADA@@FAB@BBB@@@B@@@BB@@@@@@@@@@A@BB@@@@@@B@@BB@@@@A@@CC@@@A@@@A@@@AB@@@B@BDF@B@B@@B@@B@@@@@@@AA@@@AB@@A@@@@B@@@BAB@@@B@B@B@@AB@@@BB@@BBB@BB@BB@@BB@B@@@BAB@@BB@B@@@@@@A@@@@@@BB@@@@B@@BDBB@@BB@@@@@@@BA@@@@B@@@@@@D@@B@BB@@B@B@@B@@@B@@@@AB@@@@@B@@@@@B@@@B@@@@B@@@@B@@@B@B@B@@@@B@@@@@@@ABA@B@A@@BHDBBBBB@BB@BA@@BBDB@B@B@BBB@BBBBB@B@BB@@@@CD@@@@@@B@B@B@@@BB@@@@@B@@@B@B@@AB@@AA@BA@@BA@@A@@B@AAA@@BAB@@@B@@BA@@B@@B@@@@@@BB@B@@@B@@@@BBB@@@@A@@@@@BB@@@@@@AB@@@AABAB@@@@@D@@B@@@@@@@A@ABAB@B@DA@@B@@BB@@@@B@B@@BB@@BBB@BB@ABB@@@@@BB@BBB@@B@B@@@BB@B@BB@@B@BB@@@@BB@@@@B@DB@@@ABA@@B@B@DBDB@@B@@@BA@AB@@@@@B@@@B@B@B@@@B@@B@B@BB@@@@B@BB@@B@@@@@BB@@B@D@B@B@B@B@@@@B@BBB@BBBDBB@@D@BABBBB@@B@@@@A@@B@BAB@DCB@BABAB@B@@@D@BA@@@@B@@@BA@@BA@@@@B@@B@B@@D@B@@AB@@A@E@@@A@@B@@B@@@@@@B@@B@@A@@@@@BBB@@@@@B@@B@@B@B@@ABAB@@@@AB@@BB@BA@BBA@@@B@@@F@B@BB@@BBDB@@@@@H@@@B@@@DC@@@ABA@@FBHB@@B@@@@A@ABADG@A@@BABA@A@A@@@AA@AA@A@@@A@@@AB@@@DAB@@@@@DD@@@@B@BA@@@AAAA@@A@AA@BA@@@@FA@@B@@A@A@A@A@AB@@@B@D@B@@@BBBBBB@@B@@@DA@@@@B@@@@BBB@B@@BB@@BB@@BB@BABBBBB@@@A@@BA@AB@BB@@BB@@B@@A@@A@@@@A@@@@@@@AB@@@@@A@@A@@@@A@@@@@@B@@@@@BB@@B@@A@@A@@AA@@A@@AA@B@@A@@BB@@A@@B@@B@@A@@@B@ABA@@@@AA@@@A@@@A@C@@@@B@@@@@B@@BB@@@B@@@ABB@@B@@BBAB@@@@@@B@@@@@BBB@@B@@BA@@BB@@@@BA@@@@@B@@@@@@@BB@@B@@@@AD@@@@BBDDBB@BHAB@@@B@FAFA@@BBBB@BB@@AD@@A@@@A@C@@@A@A@C@@@C@AAA@AAA@@CA@@@AA@B@BAB@BBDBD@B@B@D@F@D@BAFAHAB@D@B@B@D@B@D@F@@@F@F@DB@@B@DBH@B@F@BBBA@@F@B@B@@B@@BB@@D@B@B@B@@BB@B@@@DBBBDBB@@B@@BB@@@@@@B@@@BBD@D@B@D@D@D@B@B@@@@@@@@@@AB@B@@@@@BA@@@@@@B@@@@@B@@@B@@BDA@@@@AA@@BAB@@@BD@BDA"],encodeOffsets:[[118348,28803]]}},{type:"Feature",id:"360981",properties:{name:"丰城市",cp:[115.771093,28.159141],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BBB@@@B@B@B@@AB@B@@@BA@@BA@AB@@A@AB@@@@@BAB@B@@@B@BA@@@AB@@@B@@@@@BA@AB@@@BB@@B@@AACB@@@@@B@BBBB@@@@@@B@@@@A@@@@B@B@@@@@B@B@J@AAB@A@BA@@B@@@@@@@B@@@@A@@B@@@B@B@@@BB@@DB@A@BB@@@@@B@BB@@@@@A@@@A@@BBB@BBB@@B@@B@BB@@@@B@@AA@@A@@B@B@BA@@B@@AB@B@B@@A@@@BB@@@@@B@@@@BBB@@@A@@@A@@B@@@B@AA@A@@@@B@@@@@@A@@B@@@B@@@@A@CBA@@@BB@@@B@@@@@@@@AA@@@@A@@@AA@A@@@AB@B@@AAA@@@B@@@AA@@@@A@@@A@A@@@@A@@@@A@A@@@@@@@@B@@@@AAAAA@@@@@@@BBB@@@@A@@@@@@B@@@@@B@@@@A@@A@CAA@@@@@@@@AAAAC@@@@BA@@@AAA@A@@@@BAB@@@@@@A@@AAA@@@@A@@AA@A@@@@BB@BB@@B@@@A@@@@@AAA@@AA@AA@@@@@@@@A@@@AA@@@B@@@@A@@@A@@@@@@AA@@@A@@@A@@@@@@@A@@@@@BB@@@@AAABCB@@AA@@AB@@@BABA@@BB@@B@@AA@@@B@B@@@@@@@D@@@@@@ABA@@@A@AB@@@@@@@BAB@@@@A@@@A@@@A@AB@@@@A@A@A@@@@@@B@B@@@@@@@AA@@@@@@@@@A@@@A@@@@@@@A@@@@@@@@@B@@@@@@A@@A@@@B@AA@@A@@@@@@ABAAAA@@@@B@BB@@@@AA@@@A@@A@AB@@A@@@@A@A@@@@A@@@@BA@AA@AA@@@@@@B@@@@A@@B@@@@@A@@A@@@@A@A@@@@B@@@B@@@@A@@@@@@@@B@@@@A@A@@BB@@@A@@@A@@B@@@@@@A@@BA@@@@BA@@@@@A@@@@@C@A@@@AB@@@@@@AA@AAA@@@AAA@@A@@A@@@@@@@BAA@@@@@A@@@@A@@@A@@@A@@@@@A@A@A@@@@A@@@@AB@@A@@A@@@A@@B@@@A@@@AA@@@A@@B@@A@A@@@@@@@B@@A@@@@A@B@@@@@B@A@@@AACA@BC@@@@@ABAB@@@@@C@@@BA@@@@@C@A@@AA@@AA@@A@@@A@A@@@A@@@@ACB@@@BAD@@@@@BAB@B@@@BB@@@ABA@@CA@AA@A@@@@BA@@@AB@@@@BA@@@@AAABA@@@C@@@@@D@BA@@@@AA@@C@@@@AB@BB@@BBB@B@@@BADA@@@@@@AA@@@@A@@AA@@@A@@@AB@@@@@A@@B@@@AA@@@@@@B@@@BBBAB@B@A@@A@@A@@@A@@@A@@AA@@@A@A@@A@@@@B@B@B@@@@@AA@@A@@@BCBB@@D@B@@@@A@@A@AA@@A@A@@@@AA@@AAA@@@A@A@@@A@@@AB@@@B@@@B@BBBABA@@B@@AAB@@AAAB@A@@B@@@B@@@@A@@@@A@@@A@@@ABA@A@@@AACAA@@@B@@A@@AE@AA@@@A@@@@@C@A@@@@AA@@AAA@@A@@@A@A@@A@A@A@AA@@AAAAC@A@@@@@@@@B@@@@@@A@@@@@@@A@@@@AA@A@@@@@@@@BA@@@AAAAA@@A@@@@@AAAA@AA@AA@@A@@@A@A@A@@@ECAA@@A@@A@@AA@@@A@@@A@A@@A@@@AA@@@A@AA@@A@C@@BC@A@A@A@AA@@@@@A@@@AAAA@@ABA@@@AA@@@A@CB@@A@A@@@A@@AAA@@A@AB@@@AA@@AA@@A@A@A@@@A@@AA@AA@@@@A@@@AAA@@@BC@@@AAA@C@@A@A@A@AAA@@@A@@@@@ACA@@AC@A@@@@C@C@@@@@CAAA@E@@A@A@@A@@@A@@@@BAAA@@@A@A@@@@@CBAAC@AA@@AAAAAA@@CCAAA@@@@AA@AAAA@@@A@@@A@@AA@@@@AA@@@A@AA@AAAAA@@A@@@@@AA@@A@@@@@A@@AA@@@@@@CAC@A@CAA@C@CAA@A@A@A@A@A@@@AAA@@@@AC@@@A@A@@@@@C@@B@@A@@@@@A@ABABA@@@@AA@A@@@@A@@@@@A@@ABA@@@A@@A@@A@A@@@AC@A@@A@@@ABA@@AA@@@A@A@A@A@@@A@CAABA@A@AAA@ABA@A@@@A@@@@AA@@@AA@@A@@@@AA@@AA@@@C@CBA@A@@@A@A@AAA@ABAB@@@@EBA@CAC@@A@@@@A@AAA@@A@@AAA@AA@A@@A@@@A@AAA@AAA@A@C@A@@@A@@@@@A@@AAA@@AA@B@@AAAA@AA@A@@@A@A@AB@@A@AB@A@@@@A@@BA@AB@@AB@@@@AA@@@@@@@A@@AAAA@@@@@@A@BC@@@A@@@AA@@A@@@A@@@@A@@@ACB@@A@@@@@A@AB@@@@@A@AA@@A@@@@CA@@@BAAA@AA@AAA@@@@@A@@AA@@@A@AAAA@@A@@@@@AA@@@A@@AAA@@AA@A@@@AA@AA@ABA@A@@@AA@AA@AB@@A@A@A@A@ABC@A@@BA@C@C@A@@@@AA@@@AAAA@A@@@@@A@@@@@@BAA@@A@@@AB@@@@A@A@EAAAA@AAA@AA@@A@A@AAA@AB@@AA@@@BA@A@@@AAAB@@@D@B@@A@A@@A@@@A@@@@AA@@AB@@A@@@@BABCA@@AB@@A@@BA@@@AA@@@@AB@@@@@@A@@@AA@@@BA@@@@@A@@@ABCB@A@@A@A@@AA@A@@@A@@BA@AAAAC@@B@@A@AA@@A@@@A@@@@@AAAAA@A@@@@@AA@@@@A@@@A@@@A@AA@AA@@BA@@@A@@@@@A@@@@BA@@@@A@@AA@@@@A@@@A@@@@AA@@@A@@AA@A@@A@A@@AA@@@AA@@@A@@AABA@A@AA@@AA@@A@@A@@ACA@@@A@@@AB@@ABA@@@@@AB@@@B@B@@@BA@@@AB@@@@@@AA@@@@@@@AA@@BAA@@@@A@@@A@@@@A@@A@@@A@A@@AAA@A@@A@@A@@@@A@A@@BA@AB@ACBA@@@@@A@@@@A@@@@@A@A@@@CAAA@A@C@@AA@A@@@A@AA@@@AAA@AAACAAA@AA@@AAAB@BA@@AA@@B@@A@AB@@@B@B@@@BA@@@@@@@@@A@@@@@@@@@@@@BB@@@@@@B@@@@AA@B@@@@B@BB@@@@A@@@@@@@@@A@A@@@@A@@@A@@@@AB@@@B@@@@BB@@B@@@@@@@@@A@@B@@AA@@@@@@AA@AB@@A@@AAA@@B@@@AAA@@@A@@A@@@@@A@@@@B@@@@@@@@@B@@A@@@@BA@@@@A@@@@@B@@A@@B@@A@@@@@@@A@@A@A@@@AA@@BA@@A@@A@@AAB@@A@@AA@@@@@AA@@AAA@A@@BA@@B@@A@A@@@AB@@A@@@A@AD@@ABABAB@@@@CB@@@B@@A@@@A@A@@@ABA@@A@@ABA@@@BB@B@BAD@@CAA@@@A@@@A@CAA@@@@@ABABA@A@CBA@A@A@A@@@@@ABABA@@@CB@BA@@@A@AAA@@@CAA@A@@@@@@A@@@@AA@@AA@@@A@@AA@A@AA@@A@@AAAAA@CA@@AAAAA@@@AAA@@@A@A@A@CAAAA@BBA@@@@B@BA@@@ABA@@@@BBB@BA@@B@BAB@@@@@B@B@@@B@@@@@BA@@B@@@BAB@B@BA@@@@B@@@D@@@B@BB@@D@@@B@@@@AB@B@@BBA@AB@@@B@BABA@A@@B@@A@@AA@@@@@ABAA@A@@A@A@@@ABA@@BA@A@@B@@@@@BA@A@@@@@CB@@AAC@CB@@AAA@@BA@ABA@@BABA@AB@@ABC@ABAB@@@BA@@@AB@D@BA@@BA@AB@@@@@B@@@@BB@@@@BB@@A@@@BB@@@@AB@B@BA@BB@B@@@B@BB@B@@@ABABAB@B@@@@@@@BCB@@@B@@@B@B@@A@AB@@@BAD@BC@@@@B@@@DABA@E@A@@@@@@BABBD@D@@@B@@@B@DA@@BAD@@A@A@CBA@C@@@A@ABA@C@A@@B@@AB@BCBAB@@C@A@A@@AEJADAB@@@B@@@@@BB@@@@@@B@@A@@B@BBB@D@@@B@@@BB@@B@B@@@@@BBB@@BB@@@@@B@@AD@@@@BB@@AB@@@@@@BB@BBBABAB@@BB@@@@AB@@@@@@DD@@@B@@@B@@@BB@@@@@BB@@@@@@B@@B@@@@@B@@@B@@AB@@@B@BA@@BAB@@@B@BBB@BBB@@@B@@BB@D@@AB@@@B@@@BB@@@@BB@BB@@@BB@@@@BBBB@@B@B@@@B@@@B@@@B@@@B@@BB@B@@@@D@@@BB@A@@B@@@@B@@@@@D@@@BB@@BBB@B@BB@@B@B@@@@B@@BB@DDB@@B@@@@B@@@B@@@@B@@@B@B@@BBB@BBB@@BB@@@BB@@B@@@BB@@@@BB@@@@@BB@@@@@@BAB@DC@AB@BA@@B@@BB@@@BA@@B@@@BA@@B@B@BB@BBA@@@@@AB@@@@@@@B@@A@A@B@@@B@@@@@@B@@@AB@@BBA@@B@@@@@@B@@@BAB@B@@A@AB@@AAAA@@A@@@AA@A@@@C@@A@@@A@AB@BA@@@@@@A@AA@A@@@@@A@@@@@@@@B@@@@A@A@@@AB@@A@@B@@@@A@A@@@@@@@@B@@@B@@@@AB@@A@@BA@@@BB@@@@A@A@@@A@@@@@ADAB@BA@@@@B@@BB@@@B@@@B@@@B@@EBC@A@@@@B@@@B@B@@A@@@@B@BB@@BABA@@@@@@@@@AC@@@@@@@B@@AB@B@@A@@@@@A@@@@BB@@@@B@@@@@BB@@@@B@@A@@@AA@@@@@@AA@@@@@@A@@@@A@@AB@@@@@@@BBB@@@BBBA@A@@@A@AB@@AB@@@@@@@@A@@DA@A@@@AB@B@D@@B@A@@@@@A@A@@@@DAB@@@@B@@B@@@@@@BBAB@@@B@B@B@B@D@@BB@BA@@BB@@BB@B@DAD@DBBB@DB@@@@@@BA@@@AB@@@@B@@BB@@@BBB@@BB@@BB@@BB@B@@@@@@B@@@BDB@@@@BAB@@@D@B@BAHAB@DABBD@@@B@@@DA@@BA@B@@@BB@@@BB@@@@@@@@B@A@@@B@@B@@B@@@@@@@@B@@@@B@@@@@@@B@@@@@@BB@@@AB@@A@@@@@AB@@C@@B@A@@@@A@@@BB@BAB@@B@@@@@B@@A@@B@@BA@@B@@@@A@B@@B@B@@@BB@ABBB@@B@@@@@BB@B@@B@@BB@@@B@@@B@@BBB@@@@BB@@B@@@@@BBB@@@BB@@DBB@@@B@@@@@@B@@@@@B@B@@BB@@@BBBBD@BBF@@BB@@AB@@BB@@@@@@@@A@@@@@@@BBB@@@BAB@@@@@@@@B@B@@@@B@@B@@@@@@@@@B@@@@@@B@B@@@B@@A@@@@DA@@AC@@@AB@@A@@@@BC@@B@B@@@@@@BB@@@B@B@@@B@DB@@@@@@@ABAB@@@@B@@@@@@B@@A@@B@AB@@@@AB@@@@BBB@@@B@@@@A@A@@B@ADBB@B@BB@@B@@@@@@@@@B@@@B@@CBAB@B@BA@@@A@@@A@@@A@@@@B@B@@@@@@A@@@A@@@@B@@A@A@@@A@A@@@@@A@@@@@@@@@A@@@@@AB@@@@AA@@BA@@@@@@@@A@@BABAB@DAB@BAB@D@DC@C@@@C@A@EA@@@B@@@@@@BBDB@@AB@AA@@B@@@@@BB@@@@@@DDBB@DBD@BB@@@BB@@BC@@BAB@@ABA@AA@@AAAAAA@@AA@@@@ABAA@@@@CA@@AA@@A@@@@@A@A@ABAAA@A@@@@@@@@@A@A@@@A@@B@@@@A@@@A@A@@@AB@@A@A@AA@@AB@@A@AA@@@@AB@@@@@@A@@@@@@@A@B@AB@@CB@@@@@@@AA@@A@@@@AB@@A@@@@AA@@@@@@@A@@B@@A@@BAB@@@B@BA@@@BB@@ABAA@@@D@@A@@B@B@B@B@@@@@B@H@@@BAB@BB@@@@BA@A@@B@@@@@@@B@@B@@B@B@@A@@@A@A@C@@@C@@@A@@@A@@@@@A@@@@@@@@@@A@@@@@@AB@@AA@AAB@@@A@@A@@@@@@@B@@A@@@A@@@@@A@@A@@@@@@@BA@@@@AA@@@A@@@@B@AA@A@@A@AA@@ABA@@@@CB@@A@@@@@AA@@@A@@@@CA@@ACACCA@@AA@@A@@A@CA@@ABA@@@A@@@@@ADB@@B@@@@@@@@@BB@@@@B@@CF@@BBDDB@@A@@@A@@@A@@@@@BBABB@@@@BA@@@@@@@B@B@@B@@@@@AB@B@@@@A@@A@@@@A@@@@@A@@BA@BB@B@@FDBB@@B@@BBB@@B@@B@@@@@@@B@B@@@@AA@@AB@@@BABA@CBA@@@@@A@@AB@@@@A@@@@@@@A@@A@ABA@@B@@AB@@ABA@A@ABABCB@@A@A@@@@@@B@@@BA@@@@AA@@B@@@@@@A@@@@@@A@B@@@@ABA@@@A@@@@@@@A@@AA@A@A@@@AAA@AA@BC@@@A@@A@@A@@@A@AB@@A@@B@@B@ABB@A@@B@@A@@@AB@@@@BB@@@@B@A@@BAB@@A@A@@@@@B@@AA@@@BA@@@@ABA@AB@@@A@@@@A@A@@B@@A@A@CB@BA@@B@B@B@B@DA@@D@D@BABBDC@A@A@CB@AAC@@A@AB@@BB@@@@CB@AA@@@A@@@@@A@@@@@@@AB@@@@A@A@@B@@@@@@@@BB@@DBDDBBBBFD@@DBBBD@FBBBB@FD@BDBBF@@@@@@AB@@@B@B@B@@@B@@ABA@@BA@A@A@A@C@@@A@@@@A@C@A@AA@@@A@AAA@@B@@A@@B@BAD@@@BA@@@A@@ACAAA@@@@@B@@@B@B@@ABA@CBA@A@@@@@AA@@@AA@@@A@@@EBA@AB@@CD@@@@@B@B@@@BB@@@B@BB@@B@@@@B@@@B@@ABAAC@@@@@FDBB@B@@@BBDA@BB@@@@A@CA@@@BA@@@BB@@@B@@A@A@@B@@AB@B@@AB@@C@A@A@@@@B@@@B@B@@@B@@AB@@@BBB@B@@@BA@@BB@AB@@@B@@@@@@B@@@@@B@@@@B@@@@A@@@BB@@A@@@@@@@@B@@@@AB@@AAAA@A@@@@@A@@@@AA@@@@@CA@@A@@@@CDCB@@@@BBDDDB@B@@@@BB@@@B@@@B@@B@@B@@B@@@BB@B@@B@@@@AB@B@@B@@@@@@@@BAAA@@@@@@A@@@@AA@@@A@AA@@@A@@@AB@@@BB@@@@@AB@BA@@ADABB@BB@BB@BB@@B@@B@@@B@@@@B@@@@B@@@@BBBA@B@A@@@A@@@BBBA@BBB@@@@@@@@@@A@@B@@@B@@@@@@B@@@@A@@@@@@B@@B@@B@@@@BB@@BA@@B@@@B@@BB@BBB@AB@B@@CB@@@BA@A@@@AB@B@@@D@BE@AA@@@@CS@@CBCDBNAD@@A@ABA@BB@B@@A@@@@B@@AB@@A@@B@@@@@@@B@@@@@@@BBB@@@@BB@@@@@BA@@@BBB@@@BB@@@@ABB@@B@BB@@B@@@@@@BB@@@@@@@@@@A@@@A@@@A@@A@@@@@@@@A@@@AA@@AB@A@@CAA@@@@A@@@@@@@CA@@AAAAA@@ABA@@@@@A@AA@BA@AA@@@@AA@@A@@@@@A@@B@@A@@@@@@@@B@@A@@B@BBB@B@B@@@@@D@B@B@@@@@B@@@B@@@BB@@@@@@@B@@@@@@B@@A@@@AAA@@@@@A@@D@@@B@@@BA@@@A@@@@@AB@@A@@@@@@@@@A@@B@@@@@@@@@@@@@@@@A@@A@@@@@@@@A@@@@@@B@@@@@@@@@@@@A@@@@@@B@@@@@@A@@@@@@@@@@B@@@@@@@@A@A@@@@@@@@@@@A@@B@@@@@@@@@@@B@@@@@@@@@BB@@@@B@@@@A@@B@@@@@@@B@@@B@B@@B@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@A@@BAA@@ABA@@@A@@@A@@@AB@@ABA@@@@@@@AB@@@@ABA@ab@@AB@@@@@AA@@A@@AAA@@@@B@@AA@@@@@@@@@B@@@BDB@BB@@@@@@B@@@@@@A@@@@@@BA@@@A@@@@B@@@@@AA@@@@@@@A@@A@@@@@AAA@@@B@@A@@@@@A@AB@AAAA@AB@@@@BB@@AB@@AB@B@@@@@@@BAA@@@B@BA@@@@B@@A@@@AA@@ABA@A@@@@@A@@@AA@@AB@@A@AB@@D@@@ABA@CDAB@@A@@C@AAA@@@@B@@@A@CCB@@@@@@@A@@AA@A@@BA@@A@BA@@AA@CB@@ABAAABA@@@@BA@@AA@AB@@@@A@@@ABA@@@@B@@@@@B@@BA@@B@@@@@@B@@@D@@@@@B@@@@ABAB@@@B@B@BA@@@ABAB@B@@@BB@B@BD@@@B@@B@B@@@@@DB@@B@@B@@@B@@BB@@@B@B@BB@@B@@BB@@B@B@B@BBBBB@BB@@BB@DBB@@@B@B@B@BBB@@LHHGBB@@B@B@@@B@@DB@@B@@B@@@B@@BPXBB@@@BBB@@@B@B@BBB@@@@B@@@D@B@B@@@@@@BBBB@B@@@B@@@@@BB@B@@BB@@BBB@@@BBB@@@@B@@B@@@@@@BBA@@D@@@BB@BBB@@@@D@@@@@B@@@B@BB@@B@@BAB@@@BBB@@@@@@@B@B@B@@@BABA@ADA@@@@@@@BB@B@@@@@@B@B@@@B@@B@@@@AB@@@@@BD@B@@B@@@@@BA@@B@@@B@@@@@@@BBBBBBBBB@@B@@AB@BA@@BAB@@BDA@@@B@@AB@@BB@@@@@@@@@D@@A@@@ABB@@B@@BA@@@@@BB@@@B@BB@@@A@@@A@A@@@@@B@@BB@@B@@@@@@B@@@@B@@@B@@@@B@@D@@@@@@B@@@@B@BB@@@@B@AA@@@@@A@@@@BAB@BA@@BB@@B@@BB@@@B@@@@@@@B@@@@@@B@@B@D@B@@@@@B@@A@@B@@@@@@@@BA@@@BB@@@@B@BA@@@@BAB@@@BA@@@@@@B@@A@@B@@@BA@@@@B@@CB@@@BBBBBB@@@@DB@@BB@BB@@BB@@B@@BD@@@B@BBB@@@BB@BB@@BA@@@@BB@@B@F@@@@A@A@@@@BAB@@A@A@A@A@A@@@@B@B@@@@B@@A@@@@BB@@@BB@BB@@@B@BA@@B@@@BBB@BBB@@BBB@BB@@@@C@@B@@D@@@@@@@A@AB@B@@B@@AB@BAB@B@@BB@@@B@@@B@BB@AB@BB@@@B@@@@A@B@@B@DB@@@@A@@@@@@BBB@@DBBB@@B@@B@B@@@@B@@@D@@@B@@A@@@@BB@@@B@@BAB@@A@@@@B@@@B@B@BB@BB@@@B@@A@@BA@@B@@A@@@@B@BB@@B@@A@AB@@@@A@C@C@CBC@CDE@A@@BAB@JCB@F@D@PBBBDAB@FABABABA@ABA@A@A@A@CBC@@@A@AA@@C@@A@@@@@@A@@@@BAB@@AB@F@@A@@BB@@BB@@B@B@@A@@@A@AAA@C@A@AA@@B@@@B@B@B@@@@@BA@@@@@A@@@A@@@@@@AA@@@@@AA@@@@ABA@@@@A@@A@A@@@@@@A@@A@@@AAAB@@@@@@A@AB@A@@@A@@C@@A@A@@AA@@@@@@A@A@@AAA@@@@@A@@@A@@@A@@@@B@@@BA@@@@@@@@B@@A@@B@@@@@A@A@@@@A@@BBD@@B@A@@@@AA@@@@@@@@A@@@AAA@@@@@@@@@A@BB@@A@@@@@@@@A@@A@@@@@@A@@@B@@@@A@AB@A@@@A@@@@@A@@A@@A@@@@@AA@@@@A@@@B@@@A@@@@@@@@@@@@C@@@A@@A@@A@A@AA@@@AB@@@@@B@@AAA@A@AA@@@C@@AA@A@@AA@@@A@@A@@BA@@BA@@@AB@B@B@BCB@@@BA@@B@AA@A@@BA@AB@@@B@B@@B@B@B@@B@@@@ABA@@B@@@BB@@BAB@@AB@DDB@D@D@@@@@@@@@@@A@A@@A@@A@AA@@@@B@@@@AB@@@BAD@B@@@B@B@@@@@B@BB@@BB@@B@BA@@BB@@@BA@@@@B@@@@@@B@@BBDBB@B@B@@@@DB@@DBB@F@B@B@B@B@B@@@@A@@@@@A@A@@@AA@@@@@@@@A@A@@AA@@B@BABA@@B@@B@@DD@@@BB@@@BA@@@AB@@AB@BBD@B@@@@@ABAB@@@@A@AB@@@BBB@BB@@@B@@@BB@B@@@B@@@@B@B@@BB@B@B@B@B@B@BF@@BBB@@B@B@@BB@@A@@BA@@B@@A@A@A@@@A@@@DD@@BB@B@B@@CAABC@C@C@@@@@A@@FA@@@CAA@@@AA@@@@AAA@B@BA@@@@@@A@A@@@@@@@@@@@AC@@@@@@@AC@@@B@@A@@@A@@AA@AB@@@@AB@@@@@BA@@B@@@@@B@@@@A@@A@AB@@A@@@@@@A@@@@A@A@@B@B@@@B@@@BA@@@@AAB@@@@@B@@@B@@@@ABAA@@@BBB@@@@@B@@@@AB@@ABBB@@@@@B@B@BBB@@@@BAB@B@@@@@B@@B@@BB@@AB@@B@@@@@BD@@@@@BA@@@A@BB@@@@@B@@@B@@@@B@@@@@B@@@@@BAB@@@B@BB@@BA@@BA@A@@BA@@@@@A@@B@@AA@@@@AB@@@@@BABBB@@@@C@A@@C@A@@@BA@@BABA@@BB@B@@B@B@BBBB@@B@@@@B@@@@D@B@B@@AFAB@BA@@D@@BB@@BBB@@B@B@DAJCBABAB@D@@@BAB@@A@@B@@AB@@AB@@@BA@@@A@@@A@A@@@ABCBC@@B@@@B@@@@@BBBBBB@B@BDBBBBB@@B@B@BBDBFDDBBDD@DBBBB@@@D@BBB@DAFABABAFABADAHAB@B@BBB@BB@BBDDD@BDDB@BB@B@B@BAB@B@@@BBBBBB@BBB@BABCBAB@@@BBDBB@@@BAD@@@BB@@BD@@@B@@AB@@@B@@@BB@@BAB@B@@BDAB@D@@@@CBABA@@@@BAB@B@DB@@@BDBBB@@B@@@BB@@@@@B@@@B@FCB@D@B@B@BBBB@BBBBBBBB@BBD@@@@ABADEBAFCFGDARIDA@@BABA@ABAB@@ADCDCBCBABC@EBABEBCDG@CBA@ABABCBCBCBC@ABC@C@CAG@A@A@C@G@C@G@@@A@AAEAG@A@A@A@@BAB@FGHGDCDCDEBCBC@ABC@A@C@A@@DAD@DBB@D@@@B@@@@BBDBF@@BD@D@BB@@DBDBBBBBD@@AB@@@@B@JDB@@BB@@B@B@@@B@B@@@B@@@BDB@@B@@@BABA@@BA@A@A@C@A@ABC@CBA@@@AD@F@@@B@BB@@BB@@B@B@B@@@@B@@ABA@A@A@A@@@@B@@ABB@@@@BB@BB@@BD@B@@BBH@D@D@B@B@BA@@@AA@@@@AB@@ADA@A@A@A@AB@@@@@B@@@BBBB@@B@B@@@BADA@@@@B@@B@@@B@@BDDBB@@@BBD@@@B@@@BAB@B@@@@AB@@@@@@A@AB@BC@@@@AA@AAACAAA@@BA@@B@@@@@FD@@B@@AB@@AAA@A@@@A@@BA@@BA@@@A@@@AB@@A@A@@@@@@@AA@@@@@@AA@@@@@@A@@@@@@@A@@@@@@@@A@@@@@A@@@@B@@A@@B@@A@@@@@@@@@@@@@@@@A@@@@@A@@@A@@@@@AA@@@AA@@AAAAAA@@@A@@@ABAB@B@@@F@D@@@B@B@DADAD@B@DA@@@BBBB@@BB@B@B@B@@BB@@BBBBBB@@B@B@B@B@D@BBDBB@@B@@@D@FAD@FADAB@@@BA@@@CBC@C@@BAB@BAD@BADADABBB@@@@@@B@BAF@@BB@@B@@@BAB@B@@@@@@D@@BC@@B@@@B@BB@@BBBBB@@BB@B@B@D@B@F@DB@BFDDBDDBBB@DBB@FBDAB@B@@@DAB@@@FABAB@B@H@F@B@HA@@D@B@@@BADEFGDCDE@AB@FCBABAB@D@BAD@B@B@BA@@BEDCBC@ABA@A@CBC@EBCDC@AB@BABEBADCDABABGDK@@@BA@CBA@@@@A@@@@@@@@@AACA@@@@@A@@A@@D@@@@AA@@AAA@@AA@A@@AA@@@@BBB@@AA@AA@@A@A@@AAAB@@A@@AA@@@@@@BB@@@@@AAA@@A@A@@@ACA@@@@C@ABABA@AAA@@AA@@A@@AAA@@A@@AA@@E@A@A@@A@@@AA@@A@A@@A@@@A@A@AAAAA@A@@CA@@AA@A@A@@AA@A@@AA@CCC@@@A@A@@BA@A@@B@@A@@BA@@@@BA@@AA@A@@@@AAA@AA@AAA@@AAA@@@AA@AA@@A@@@@@@@A@@@A@AA@@A@@@A@@BAAA@ABC@ABAB@BC@@@A@A@@@A@@B@BAD@@@BA"],encodeOffsets:[[118873,28822]]}},{type:"Feature",id:"360983",properties:{name:"高安市",cp:[115.360619,28.441152],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@C@A@A@@@CA@@@@@A@@@BCAA@A@@@ABA@@@@@@B@BA@@@@B@B@@@B@@A@@@@B@@AB@@@@AB@@@@@@AB@@@BA@@@@BAB@B@@@B@B@B@@AB@B@@@BAB@@@@A@@BC@CBA@@@AA@@@AA@A@@DA@@B@B@@ADAB@BA@@B@@AA@@A@@BBB@D@DA@@B@@E@AAAB@@@BBB@@@BABB@@@B@@@^\\DBBBBBDD@@B@@AB@B@@BBBB@@@@BB@@@BB@B@@@B@DBB@B@A@@@@@@@A@@BA@@@A@@@@@ABA@@B@BBBBBB@@B@B@A@AB@@@B@@@@A@@B@@@B@@B@@@AB@@AD@B@BA@@B@@DB@B@@BB@@@@@@@@@B@@@@@@@BA@A@@@@B@@@B@B@BB@@@@@BB@@@@B@B@@@@B@@@B@B@@B@@@B@@@B@@BB@@@@B@@@@B@@BB@@BBB@@@@BA@@@@B@@@@@@@@@@A@@@@@@BB@@@@@@@B@@B@@@@@@@@BA@@BB@BB@D@@CB@@A@@B@B@BABAB@@A@@@A@@@C@@A@@A@@@@@@@@@@B@@@@@@@BBD@@@@BB@@B@@B@@@BB@@@BB@@BB@B@@@B@@@@@B@@@@@B@@@@AAA@@AA@@A@@@@A@@@AA@@@@A@@A@@@@@BA@@@AB@AABAAABA@@A@@A@@@@@@@@@@B@@@@@@BB@@B@@@B@B@B@BB@@@@A@@B@@B@@@@@@@B@@@@@@@B@@@@A@@@@BB@@A@@B@@@@@BD@@@@B@@@B@@@@@@BA@@@B@@@@@@@@BB@@@DB@AB@@A@@@BD@@B@@@B@@@BB@@@@A@@@@@@B@@B@B@B@@@@@@@B@@@B@@@@BB@@AB@@@@@B@@BB@@@DAB@@@@BB@@@B@@B@@A@@@A@CA@@@B@@B@@@@@BB@@@@@@@@@@@@@@B@@AB@@@BB@A@@B@@@@B@@B@@@B@@@@B@@B@B@@@B@BB@@@@B@@B@B@BBB@B@B@@AD@B@@@@@B@@BBBD@@@@AB@@@B@B@@AD@BBBAB@@@B@DA@@@A@@@AB@AA@AB@A@@A@@B@@@@@@@@@@A@@@@@@@AB@@A@@@A@@@@A@@@@@@A@CB@@A@A@@@@D@B@@B@@@@F@B@@@@A@@A@@@@@@A@B@@BA@B@A@@B@@@@A@BB@@@BB@@B@@@B@@@B@@B@@B@@@AB@@BABA@@B@@A@@@@@A@@@@@A@@@AAA@@BAA@@A@@@A@@BA@@@@@A@@BB@@D@B@D@D@B@B@BB@BBBBB@B@FB@AB@B@D@B@D@JFFBB@BBJDB@@@BBDBB@@@B@FA@@BADEB@@@DAB@D@B@@@BBB@DBBBBBDBBBBB@@@@B@B@@ADA@@BABA@ADA@@DAB@B@B@@@B@B@B@DBDBBBD@@@B@B@DDB@D@BBB@@BBB@BBB@D@@BD@BAB@F@@@D@@@F@DB@@DBB@B@@@BCHABAB@@@BA@@@@AAC@@@A@@C@@BAB@B@BA@A@A@@@@@@@@@C@@A@@@@AA@@AB@@A@B@B@@B@@@@@B@B@BA@@BA@EAA@C@A@A@@@@A@@BA@@@@@A@@C@@@A@@@A@@BC@@@@A@@A@@@@BA@@@@@A@@@@B@@A@A@@@@@@BAAAB@AA@AA@B@@@@@B@BBADHB@@B@B@@ABA@A@@B@AA@@@@@A@@@@@@@@@DB@@@@B@@@@@B@@@@@@@@@D@@@@@A@@@@D@@@@A@@@@BA@@@@@B@@BB@@BCF@@ABAB@ACA@@@@A@ABA@AAA@A@AAA@A@AB@@AB@BA@ABA@@@CB@@AB@@@@@B@BDB@@@@A@CA@@A@@@A@A@@B@@A@@BAFAF@DCF@B@B@@A@@@@@A@A@A@@@AA@A@@@@A@@@@A@@@@A@@@AA@@@@@@B@@A@@@@A@A@@@@@@@E@@@@B@@@BCBAB@@C@@@AB@BA@ABA@AB@@@@@@CA@@AD@BDB@@@A@A@@@@@B@@@BAB@B@@B@B@B@@@@@@@AB@@@B@@BB@@@@@B@@@@@B@AA@@@A@@@@@BBA@BB@@@BB@BA@@@@@@@@@B@@B@@@B@@@@@B@@@@@B@B@@@B@@@B@@B@B@B@@B@@B@@@@A@A@A@@@A@@@@@@B@@B@@@@B@@@@@@B@@@BB@D@@@@@AA@@@@A@B@@@@AB@@@@@@@@@B@B@@@@@BAA@@@@AB@B@@@BB@@@@B@BA@@B@@A@@D@@@@BB@@@BA@A@@@AB@BB@@B@BB@@@BB@@@@@B@@@@@@@B@@@@B@@B@@BB@B@@A@@B@@ABB@@@@@B@@@AB@@@B@@@@BA@@@@@B@@@@@AB@@B@@@@@@@@@@B@@B@@@@BB@@@BAA@B@@BB@@A@BD@@@B@@A@A@@AA@@@BBB@@BA@@@@@A@A@@@A@@AA@@A@@A@@@A@E@@BA@@A@@A@@@@B@@@@@B@@BB@B@@@@@B@@@@@@A@@@@BB@D@@@B@@@@@@@@B@@B@@A@@@@BB@@@@@@@AA@@@BAB@@@@B@B@@@@BA@@@A@@@@@@@@BB@@@@@@@@@A@@@@@AB@@@@@@@BB@B@@BD@B@B@B@@B@@@@@@@@BAA@BA@A@@@@@@BA@@A@@AA@@@B@@@B@B@B@B@@@BA@@B@@B@@B@B@@@@A@@@@@@BBBAB@@BB@@A@@B@@B@@@@@AB@@BB@@@B@BBB@B@@BBB@@@B@@@@BB@@@@B@@@@@@@BB@@BB@@BB@@@@@@B@@@@BA@@B@B@@B@@@@@B@@@@@AB@@B@@BBB@@@@B@@@@A@ABA@A@@@@B@@@@B@@@BBB@BAB@@@B@@@A@ABA@C@A@@@@@B@@@B@BB@@@@BB@@@A@@@AB@B@@@B@B@B@@@@B@@ABA@@@@@@@DAB@@@@BCB@B@B@@@@@@B@DAB@@@@@@@@B@@@@BA@@@@B@@@@@AB@B@@@@@@B@@ABA@@@@@@B@B@@@@@@B@@A@@B@@@@B@@AB@@@B@@@@B@@@A@@BA@@@@@B@@@B@@@@@@@@BA@@@A@A@@B@B@@@@@@@ADB@@@B@@@@B@B@@@@@@BC@@@@@A@@DA@@@@@@@B@@@BAD@@@BBD@@FB@B@BB@@A@BBB@@B@B@@@B@BA@B@@B@@BB@@@@AB@@B@@@BBD@B@B@@@B@@@@ABA@A@@@@B@B@@B@BBB@BBBB@B@BB@@B@@BD@@AB@@@BA@@@@@AA@@A@@@A@@@@@A@@@@BAB@B@@@@A@@@@@DBBBB@BB@@@@BA@@@@@@@BA@AB@@@AA@@@AA@@@@AA@@@@@B@@@@@@@@@@@B@@@B@BAB@@AB@@A@@@@@A@@@@@@@ABA@@BA@A@@@AB@@ABAB@@@BA@@@@@@B@@AB@@@B@@A@B@@BB@@B@@@BBB@@@B@@AB@@@BB@@@AB@@@@@BAB@BAB@@@B@@B@@@B@@@@@@D@@@@@B@@@@@@A@@A@@@@@@A@@@@@@BBB@@@BAB@DAB@BAB@@@B@FBB@BA@@BCB@@@BAB@@@@A@@@@AA@@A@@@A@@@@@A@C@A@@@A@@BB@@@BB@@@@BBB@@@@@@@@@@@AAA@A@@@@@C@@@@@A@@A@@@@A@@@A@@B@@AB@@A@A@@AA@@@@@A@@@@@B@B@@@@@@@B@B@@A@@B@@ABAB@@A@A@@@@@@@@@AB@@A@@AAA@@@AB@@@@@@@BABB@@@ABAA@@AA@@@A@@@@@@AA@@@A@@@A@@@@@@@@B@@A@A@@B@@A@@@@A@@@@@@A@A@@@@B@BAB@BAB@@@@AB@@AB@@@AA@ABCB@AA@@@@@A@@@@@A@@@@@@A@AB@@A@@@@C@ABA@ABC@@@A@@BA@@@AB@@CB@@AB@@A@@@AB@@@B@@A@A@@@AB@@C@A@A@AAA@A@A@@@@AA@@@@BA@@B@@AB@B@@A@@BA@@@A@@@@@A@@@A@@@AA@@AB@@@@AB@@AD@@AA@@@@@D@BA@@@AB@@@AA@@A@@@A@A@@A@@@@B@@A@@@@@@@AA@@@BA@@BB@@@AB@@AAABA@@A@@BABC@@@@@@A@@@AB@BA@@@@B@@AAA@@@@A@AA@@@A@@A@@@@A@EBG@@@A@BB@@@@@B@@@@B@@@B@B@BB@@BB@@@@A@@@@@A@E@A@@@A@@BA@@B@B@@A@@@CB@@@@@B@@@BAB@@B@@@D@BBB@@@B@@@@D@@@@B@@@B@B@B@@BB@AB@B@@AD@B@BA@@B@@@@@B@@A@@@BB@@@@B@@B@@AB@@AD@@@B@@@B@B@@BB@@@@@BA@@B@@B@@@B@@@B@@@@@@@AB@B@@@BA@@@@B@B@BAB@@@D@@ABAB@@AB@@@B@@AB@@ABC@@@@B@@@BABA@@B@@AB@@CB@@@@@@CB@@A@C@A@@@A@@B@@B@@B@B@@BB@BBBB@@@@B@@@BB@@BB@@BB@B@BBB@B@B@@@B@@B@@@@B@BBD@B@@B@@@@B@D@B@@A@@@@@@B@@@@@@@B@@@@@@@@BB@@@@@B@@@@@B@@@@@BABB@@B@@@DB@@D@@@@@BA@@@@BA@@@A@AB@@@BAB@@@B@@BB@@@B@BAB@@@B@@BBBBD@@BBB@@DBBT`@BB@@@BADBBBB@B@B@B@@B@B@@B@@@@@@A@@B@@@BB@@@@@@D@@@B@B@B@@@@AB@@@@@@BBAB@@@B@B@@@B@@@BB@BDB@BB@@B@@@BB@@@@BB@B@@@@@@B@BB@@@@@@B@@B@@B@@@B@@@B@B@@@@@@ABBBA@@BB@BB@@@@@AB@B@B@B@@@@AB@@@AAA@@@B@@@D@B@@@BAB@@B@@@BAB@@@B@@@@@@B@@@@BB@@A@A@@D@B@B@@@B@AB@@@F@@@@@@A@@@@B@@AB@@BB@@@@@B@@DB@@@@@@@@A@AA@@@@@B@B@@@@AB@@@B@@AB@B@@BABA@@B@B@@@B@@@B@@@B@@@AAB@@@B@@BB@@@@@B@BAB@@@B@BA@@B@B@@@BAB@@A@ABA@@B@BAB@B@@@B@@@B@@BDA@@@@@@B@B@@BBABB@@B@@AB@@@@@B@@@BB@@@@AB@@BBDA@@BA@@BB@@@@@@B@B@@BBB@@B@B@@@A@@BA@@@@BB@B@B@B@@AB@@ADA@@B@@@B@B@@@B@BBB@DB@@BB@@@@BBB@BABBB@BBB@BBB@B@B@B@D@B@@@B@D@@@DBB@@@@@DB@@B@@@B@B@@@D@@BBB@B@B@@@@B@@@@C@@@@AA@A@@@@@@BAB@B@BADA@AB@DBB@B@@@B@@BB@D@@@B@B@@@@@B@B@D@B@B@@@@@BAB@BA@@B@@A@@@@BAB@@@B@@@B@@@B@@@B@@@B@B@BB@@BA@@B@@@B@BAB@@@@AB@B@@@B@@@@@@@B@@AB@@@BAD@B@@@BA@@@@@A@@BBB@B@@@BA@A@@BA@@@A@A@@AA@@@AB@B@@AAA@@@@@C@A@@@A@@B@@AB@B@@@BCB@@A@@@@@ACACAA@@@@@@AJKF@FABAFABA@@B@B@@B@@@@@@BBBBD@@BB@@@BCD@@AD@@@@@@@@@@A@@BAB@@B@@@BB@@@@@@A@ABC@@@@@@B@@B@@AB@@@@@B@@@BBB@@@@@@B@@A@@BB@@B@B@B@@@@AAAAA@@@@@@B@B@@@BB@B@@@BB@@@@@BAB@@A@@@@@B@B@@BD@@B@@@@BB@@@@B@@@@B@@@B@@BA@@@B@@BB@@BB@@@B@BB@@@@@@@@@B@@@@@@@@B@@@@@@@B@@@@BB@D@@B@@B@@A@@@@B@@A@ABA@@@BB@@@AAB@A@@AB@@BBA@@@BB@@@@@@A@@@@@AB@BADA@@@CB@@A@@B@@AB@@B@@BA@@@@@A@C@CBEB@FA@@BA@A@A@AB@@ADE@C@@@@BE@@BABC@AB@BA@A@@@A@@@@@@@@@B@B@B@@B@@@@@@C@@@A@@@@@A@@@@@A@@BC@AB@@@BAD@@@@@B@@@BB@B@@DBB@@@B@B@@@@@B@@BB@DD@@BBB@@BDDBB@B@BB@@@B@@@DBB@DB@@B@@@@@BA@@@A@@@@AD@@A@@ABA@@B@@@B@BA@@BB@@@@BB@@B@@@@@@@@@B@@BB@@@@@@A@@@BBA@B@@@B@@@BB@B@@@B@@B@@@@B@@@@@@@@BB@@A@@BB@@@@@@@@@ABA@@B@@@BBA@BB@@@D@@@A@B@@@@@@BA@@B@@@@BA@@@@@@@B@@@@@@@@BBB@B@@@@B@AA@@@A@A@A@@@BB@@BBB@B@B@@@@A@@@@@BBB@@@@@@B@B@@@@@B@@AAA@A@@@A@@@AB@@@@A@@@@B@@A@A@@@@@@BD@@@A@B@@@@B@@@@@A@A@@B@@A@@B@@@BA@BB@@@CBAB@@@@DA@@BB@@@B@@A@@@A@@DB@@BB@@@C@A@@@AAA@AAA@@@@@BBD@@B@@@@@BA@A@@@AAAA@@@@@@@BB@@BB@@B@B@@@@A@B@@@@@@D@@@@C@@@@@BBB@@@BB@@B@AAA@@ABA@A@@B@@@@DB@BB@@@A@@@AB@@@@@@@@B@@@B@@@@@@B@@AB@B@@@@@@B@@@@B@BA@@DB@@@@B@B@@@@@AA@@A@@AB@BA@@@@@ABA@@DAB@@@@A@@@@@@@@DBBBB@@@@@@B@@BBB@@BDB@A@A@ABAB@BB@@B@@AA@@A@@B@@@@A@@@A@@BB@B@@@@@@B@@@@@@@@@@A@@@A@@AA@@B@@@BA@AB@@A@@BBB@B@@B@@BA@@@ABA@AB@@@@A@@A@@@@AB@@@@@@AB@AA@@@A@@@A@CB@AA@@@@A@@@@@@B@@@B@@@AA@@AA@@ABA@A@@@A@@@A@@@A@@AA@A@ABA@@AA@@@B@@A@@@@A@@@@@@B@B@@@AACA@@@A@@B@B@BAB@@@@@@A@@@@@ABCB@@@B@BBAB@@B@@@@@@@D@@@@@@@B@@@BC@A@@B@@BBB@@@@@@B@@@@@B@@A@@@@B@@B@@D@@B@BB@@@B@B@@@@@CB@@BB@@B@D@@B@@BB@@@A@@@@@@BBBA@@@@BB@BBB@@@@AAB@@@@@B@@@@B@B@@@@@D@B@@BB@@B@B@@A@A@@BAB@@@@B@B@@A@@B@@D@BB@@@B@@D@@@@A@@@A@ABAB@D@@@B@@@@@@@AD@@@@@B@@@@BA@AB@@@@@BB@@A@@@@BB@@@@@@@A@@B@@B@@@@B@@D@B@@@@@AA@@@@@AB@B@@@@B@@@AB@BB@@@BB@@ABB@@@@BD@BBD@@@@@B@AB@BABA@@@@ABAB@BA@BBBBBB@BB@@BBB@@B@@@DB@@BB@BB@B@@@@BBB@@BB@D@@D@D@@@@BA@A@A@@@@@@@@BD@@@@@@@@BA@@B@@B@@ABA@@B@@@DB@@@@@@@@@B@@B@B@@BBBD@B@@@@B@B@@@@A@@@@A@AA@@@@@@B@BA@@A@@@@@@@@A@@@AF@BBB@@B@B@B@@ABA@@@A@@@@@AB@@@@@B@B@@@BB@@B@B@DB@B@@@BBB@@@@FA@@B@@@@@@B@@B@B@@@B@@ABBB@@@B@AABCA@@AB@@@B@@ABA@@@@@@ABA@@@A@@A@@@A@AB@@@BA@@BB@@@@BA@ABA@A@@@@@@F@@@AA@A@A@@B@@@B@F@@@@@@@BAHG@@B@B@@@@A@A@@BA@@@AA@@@@@AB@@@@A@@@@BA@@A@@B@BAB@@A@AB@@A@@@@@A@@@@A@@B@@A@@@BA@@@@@AB@A@@AF@@@hC@@F@B@B@DB@BABFDB@@@B@@BB@@A@AB@DA@B@@A@@BB@A@@BD@BAB@@@@B@@@@B@@@B@@B@B@B@@@B@B@@@@@@@@BA@@@@BA@@@@@@@D@BBA@@BA@@@@D@B@@@DA@A@@@AAA@@@@@@B@@@B@@@BABA@@@ABEAABA@A@@B@B@@@@AA@@AAA@@@AB@@@@AAA@@CEA@@AA@A@A@A@A@@@CAA@@AC@AA@@ABEA@@A@A@@C@C@A@CB@@@@AD@@@@ABA@CBG@@B@BC@@A@AAA@AA@@@A@@BAB@@AB@@@@AAB@@@@@A@A@@@@A@@D@BA@@@@@A@@@AA@@@@BA@@BC@@@@A@@BAB@@A@@@@@@A@ABA@@@ABAD@BA@A@@@ABA@@AA@C@@AC@C@A@C@A@A@ABABA@A@@BC@C@@A@@@A@A@A@A@A@@@A@@@A@@@@@A@@@@@@B@@AB@@A@@@A@AA@@@A@A@A@@BAA@@A@AAA@@@A@@@@@ABA@ABC@A@@@@@A@@@A@@@@@A@@B@B@BBDB@B@@FBBABA@@@@DA@@@A@@@@@A@@A@@@A@@@@A@@CAAA@@A@GCCAAAC@AAA@BA@@A@@A@@AAAAA@@AA@@AA@AAA@AA@@@@@E@C@@@ABABA@@@A@CBA@@@A@@BA@A@A@@@AA@@@BA@@@A@@@@@@BA@@AA@@@A@A@A@@BA@AB@@@@A@@@A@@@@AACA@AA@@A@AAA@AA@AA@@BA@@@A@@ABA@@@@@@A@A@A@@BABAB@BAB@BCDC@A@@@AB@@CBE@C@EBA@AAA@A@C@@@ABC@A@A@@AAA@AAC@AA@@A@A@C@A@EA@@A@@@A@A@@@AA@CAE@@A@@A@@@AA@AC@@@C@A@AAA@@@@AAA@@@@@@A@@@@C@@@@A@@A@@@A@@@@@@A@@@@A@@@AA@@@A@@@@@B@B@@@B@@AAA@@@A@@A@@@@AB@@@AA@BA@@B@@@@C@@@@@@@@AA@@BA@@@A@@CB@AA@AB@@ABA@@BA@@@AAAAAAAA@A@@@@@@@A@@@AB@@A@@@@@AA@C@@A@@@@BA@@@@@AA@@@A@A@@@@@@@@AAA@@@@@@B@BCB@BA@A@@@A@A@A@@@@@@AA@A@@BA@AA@@@AAA@@@A@@@@@C@@@@@AA@AAA@@C@@@AB@A@@@@A@@@@A@@A@AA@@A@AA@@AA@@@AAA@@@@A@@@A@A@AA@A@@@@A@A@C@@@A@@@@@AA@A@A@A@@AA@A@@AAOW@AA@@@A@@@@AA@@CA@@@A@A@@@AAGHKG@@AA@A@A@A@A@@AA@CAA@@AAA@AAAAA@A@A@@@AA@@@AA@@A@A@A@@AA@@@A@@@AA@@@CA@@@@A@A@@@@A@@ACA@A@@A@@@ABABA@@B@@A@A@A@@BABA@@@@@A@@@@@C@@@A@@@@A@@@AB@@@A@@@@@A@@B@BA@@B@@@@@BAB@@BB@@A@@B@BABBBA@@DAB@@BB@@A@BB@@AB@B@@BB@@@@@@@A@DDB@@@A@@@@@BB@B@DB@@@BADCB@BA@@C@@@BAB@@@BA@@BB@@B@@@@@B@B@BA@@BB@@B@@@@A@@B@@A@A@@BB@A@@@@@@@ABA@@BA@@AA@@@@BAB@BB@BBAB@@@@@B@@@@A@@BB@B@@@@@BB@@@@@@@B@@B@@@@@A@@B@@@B@@A@@@@B@@@@@@@@A@@@@A@@ACA@A@@@A@@@@@@@@BB@@@A@@B@BB@@@BB@@B@@@@BA@@baB@BA@@@@BA@@@@@@B@BA@@BA@@B@@@B@@@B@BA@@BB@AB@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@AA@@@@A@A@@@A@@@@@@@AB@@@@@@A@@A@@A@@@@@@@@@A@@@@@@@@@@@AB@@@@@@@@@@@B@B@@@@@@@@@@A@@@@@@@@B@@@@@@@@A@@@@B@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@AB@@@@@@@@@B@@@BA@@@@B@@@B@@A@@@A@@@CB@@@@@B@BB@@B@@@@A@@@@A@@@@@@@A@@A@@@A@@@A@@@@@A@A@C@@@@@A@AAA@A@AB@@@@A@@@@@@B@@@@AB@@@@@B@@@BB@@@@BBB@@ABBB@@@@@B@BA@@BBBB@BB@@D@@@@@@@B@@B@DB@@@BBA@@BB@@B@@@@@@@@@@BB@@@B@@@B@@@@@@@@@@@AA@@@@@@@AA@@A@AA@BA@@@@AA@@A@AA@@B@@A@@@@AA@@@@AA@A@@@@@@@A@@@@@@@AB@@@BA@@@A@@B@@@@AAAB@BAB@@@BCAMDCDA@@DT@@@@BBF@@A@C@@@ABA@@B@B@@A@@DA@@@ABAA@AAA@@AA@@@A@@@AB@@AA@@@@@AA@@@@A@@@@B@@@@@@A@@@@A@@@A@@@@B@@@@@@@@A@AAB@AA@A@@@B@@@B@AABAA@@@@@A@@A@@@@@@A@@@AA@@@AAA@@AAAA@BABC@@ABA@@B@@@@AA@@A@@B@@@B@@BBB@@@B@@B@@B@@@@@@@BBAB@@@@@@@@@AA@A@@B@@A@@@@AAA@@A@@@@AA@@@@A@@@A@@AA@@@@@ACACCAA@@@@DADC@@@@@BB@@D@@@@BB@@@@@B@@@@@BBBBB@@BA@@@@@A@@@@@@B@@@AA@@B@@@@@@A@@A@@@@@A@@@@@@@@A@@BAA@@AB@@A@@@AAA@A@@BA@@@A@@@A@A@@@A@@B@B@D@@@BA@@@ABA@@@AB@B@@@@A@@AA@@B@@A@@DBB@@@@@AAB@AC@A@@@AAAEC@@@@D@BBBA@@@A@@@A@@A@@@AAA@@@A@@A@@@A@A@@@@DC@@BAB@FA@@B@@@B@@B@@BB@@@@B@B@DAB@BA@@@A@A@@@A@@@@BBDB@BB@@@B@@A@@BC@A@AB@@@@AB@BBB@@@B@@B@B@D@B@@B@@@D@B@B@B@B@@AB@BA@@@A@@@A@A@A@@BA@@@@@@AECA@AECA@AAEAC@AACA@@ECAAAACCCA@@AAA@A@C@C@C@A@C@C@AA@@A@@@@@@@AA@@@AA@CAAACA@@A@A@@AA@A@A@C@@@AA@@@AA@A@E@@@ABAAE@A@G@CAA@@@CAE@E@@@E@C@A@C@A@A@C@A@GBEBABC@E@C@A@A@C@CAAAA@ABA@B@@B@@DB@@BB@BBB@B@D@@@D@B@B@@@D@B@@@BC@@BA@@AAAAA@@EBEBA@@@A@GB@AAACCAA@@@@BC@@@@@AA@@A@@@@@@@A@@@@AB@@@@AA@@AB@@@AA@AA@@@@A@@@@@@@BAAA@@@AA@BA@@A@@@A@@AA@@@@@A@@@@@@D@B@@@B@@BB@@@@AB@B@A@@@BA@@@@AB@@@AA@@@BA@B@@BB@@@BB@@@BB@@@@AA@@A@@@@@A@@@@B@@@@@@BB@@@@@A@@B@@@@@@@B@@B@@@@BA@@@AA@@AAA@@BAB@@@B@@AAAABA@AAA@@AA@@AA@@@AAA@A@@A@@@@@CB@@A@@@AAAAAA@@A@C@A@@@A@@B@B@B@B@BA@@@EB@@@@ABB@@B@BB@BB@B@@ABA@@@@@CC@@@@A@CB@@A@@B@@@B@@@BBBB@@B@@@B@BABAB@@@BCHAB@B@B@@A@@@GAEA@@AB@B@@CD@@A@@@G@@@@@A@AC@AA@@A@A@E@@@A@@AB@AABA@@AA@@B@@A@AB@BA@A@@@@AA@@@@@A@AA@@@@B@@@@AA@@@@@@@@AA@@@@B@@@F@BA@@BA@C@@@@A@AA@@@@@AB@@AB@@A@@@@@ABC@@@A@A@ABABA@CDA@ABA@@@@B@@A@@@AAAAABC@@@AAACAAA@AAA@@@@@@A@A@A@A@C@AA@@A@@@@@AA@@A@A@@A@@A@A@AA@@@A@A@A@@@A@@@@@A@@BAB@@A@@@CACAA@A@@@AB@B@@CAA@@@@@AA@@@@AAA@@@AAA@A@@A@@@A@AA@AAA@@A@@@@AA@BAAA@AA@@AA@@@A@A@@A@@AA@@@CBA@A@AB@B@B@@@@@@@AC@@@@@A@ABBB@@A@@B@@@@A@@A@@@@@B@@A@AA@@@@@A@@@AAA@@@@@@@AA@@@AB@@@A@@BA@AB@BBA@@@@BB@@AB@@ABB@@BA@@@A@A@@@A@@@@AA@@A@A@A@@@@@@@DC@@@@AAA@A@AAAAA@AAA@A@A@AC@AB@@AAAA@AAAAGC@AB@A@B@BA@@@@@@A@@@@@@A@A@A@@@A@@A@@@@@@A@@@A@@@@@A@@@@BA@@@@@A@@@AA@A@@@AAA@@@@C@@@@A@@@@@AB@@@@@@A@@AA@CA@AA@@@@@AA@@@@@@@B@@@@A@A@@AA@AB@@A@A@@AA@@AAAA@AA@@AA@@A@@BA@A@A@@@A@AB@@A@@@@@@BA@@B@@BB@@@@@@A@@@@AA@A@E@ACA@@@A@@B@@@B@@@B@@DD@@@B@@A@@AA@@@@@@@AAB@@@@@@@@@@@AA@@A@@@A@AAA@EB@@CB@BA@@BAD@B@@BB@BA@CBA@@@ABB@@@@B@@CBB@@B@@@BB@@BKDBB@B@@BD@@@@@B@@@@@@@@A@@B@@@A@@AB@@@@@@@B@BAB@@@@ABA@@@A@@B@@@@B@@B@@A@A@@@@B@@AA@@@A@AC@AAAAA@@A@@@AAAAA@@@ABA@C@@@AA@@C@@@@@A@@@A@@@A@@A@@@@A@@@@BABE@ABC@@AA@@@@BA@@BAB@@@@@A@@@@@AE@@@AA@@@A@@A@@@A@@@@A@@@A@@AA@@A@@@AA@@AAAA@AA@@@BA@@@A@@B@@C@@@@@A@ABE@@@@@AA@@AAB@@@A@@@A@@@@A@@@A@A@@A@A@A@@AAA@@A@A@@@AA@@@BA@@AAA@@@AA@A@@@A@@@@@BA@@@AA@@CCAAA@AAA@AAABA@CBA@@@A@@A@A@@A@AB@@@@@B@@@@@BB@@@A@A@A@@A@A@@@@@ACAA@@OAA@@BA@@BEFC@ABA@A@B@AB@@AA@BA@@B@BAB@@@@B@ADA@A@A@@@A@ABA@@B@@@@B@BB@@@@@BBDFB@B@BA@@@ABBB@BA@A@@@@B@@@B@@@B@@@@@@@B@@A@@@@B@@@@AB@@AB@@CF@@GB@@@AA@AB@@A@@@@AAA@@A@@@ABA@A@C@A@ABAB@B@DB@A@A@AB@B@@A@@@@A@A@@@@A@AA@@@AA@@@AB@@@@C@@@@@AA@@A@@@A@@@AAC@@@@A@@@@D@@@@@A@@AA@A@@@A@A@@@A@@@A@@@AA@@@B@B@B@@@BCBEB@@@@A@@@@BB@B@@@@B@@@@A@@@AA@@A@@@@BB@@BA@@A@@A@@@@@@@@@A@@B@@@@@A@@@@@BA@B@A@@B@@BB@@B@@A@@@@@@B@@@@@@B@@@@A@@BB@@B@@B@A@@@@@AA@@AA@@AAA@@@@A@@@@A@@@@@@B@@@@A@@@@B@@@@BB@@A@@@@A@@@@A@@@@B@@@B@B@@@B@AA@@@A@@AB@@@@@@AA@A@@B@@@@@B@@@B@@@@@@AA@@@@@@@@@@@B@@@@A@@@@AA@@@@B@@AB@@AB@BB@A@@@@@@@@CBA@@AAA@@@@A@@B@B@BAB@BAB@@@B@@@B@@@@@B@@@@A@@A@@@@@@@BA@@B@@@AA@@B@@@@@@AB@@A@@@@@@@@@A@@@@@A@@A@AB@@@@ABA@@@@A@@@A@@@@A@A@A@A@@@@@@@C@@AA@A@@AA@@A@@A@@AABAA@B@@A@A@@@@BA@AA@@@A@@CA@@@@A@AA@@A@@A@@@AA@@@AABAA@AA@@CCADA@BB@@@BA@@@A@A@AA@@"],encodeOffsets:[[117831,28744]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("鹰潭",{type:"FeatureCollection",features:[{type:"Feature",id:"360602",properties:{name:"月湖区",cp:[117.102475,28.267018],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@BA@@@@BAAA@BB@@A@@@A@@@CAA@CB@@AAAA@@AA@@C@@@@@@BA@@@@A@@@@A@B@@@@@CA@AAAA@B@AA@@B@@AB@@@BAB@@@@A@@A@C@@@A@@@@AA@@@@@@B@@CB@@@@A@@@A@@@@@A@@@@@@@@ABA@@AA@@@@@A@@@@A@@@@@A@@@@B@@@@BB@B@@@BA@@B@BA@AB@@@B@@BD@B@@AB@@@D@B@@AAE@@@AB@@@A@@AAA@@BABCBAB@@@BD@@@@@@@@AB@@@@@DD@@@@@@@@@@@AA@BAB@@A@@@@B@@@@@@@@@B@@@@@@@@B@@@BA@A@@@@B@@@@BA@@BB@@@B@@@B@@@D@@@BAB@@ABA@@B@@@B@B@@@B@B@BABBF@B@B@@@@@B@@@D@@@AA@@@@@BD@@@@@@AB@AA@@@@A@@@ABAA@@@@A@B@BA@@@@@@@A@@@@C@@@@@@@B@BAB@@B@@B@@@@@BB@@A@@BBAFA@@@@B@B@B@@@BDA@@@@B@@B@B@BA@@B@B@@@BBBB@@B@@@B@D@@@BBB@@B@@@B@B@@@@@CD@@B@@@B@@A@@B@@@BBB@@@@B@@@@@BA@@@@@@B@AABA@@@AB@@AD@D@B@BB@@BBB@@@@A@@BA@@B@@@@@B@B@@AB@@AB@BA@@AAAA@@@ABA@@B@@@@BB@@@@@@A@@@@BAB@@A@@@ABA@@@BD@@A@@@@@A@A@A@@@@BABAB@@BBJ@B@BB@@BBDBDBAFAB@@@@C@@@@BAB@@@B@@@B@@BB@BABA@@@@B@B@@BB@@B@@AB@@B@@BBB@@@@ABAD@BAB@@@D@@@D@@B@AB@BCBA@AB@B@DAB@@@DBB@@@B@@ABAB@@AB@@@B@@@BDB@B@@B@D@@BD@B@BBFH@@@BBA@@@@@@B@@@@@B@@B@BC@@B@@@@@B@@@@B@@AB@@@@BBB@@@@@B@@@@B@@B@BB@@@B@@A@AB@@A@@@@@BB@@@D@B@BB@@@B@@@BADA@A@A@@@A@@@@@@BC@@BA@@BC@AAA@A@@@A@@@A@@B@B@@@B@A@AD@@@@@@@BB@B@@A@@B@@ABAA@@A@@A@AA@@A@@@@@B@@@@@@@@C@@B@@@B@@@@@@B@@@@@@@B@@@@@@BA@A@@@@B@@@@@BB@@D@@@BA@@@@B@ABB@BB@@@@@@AB@B@@B@B@B@@@@BB@@BB@@@BAB@@AB@@AABAB@@A@@@BA@@@A@@@@@@A@B@@@@@@AA@@@B@BA@A@A@A@@@B@BB@@@@@@@@@@@@@@@BB@@A@@@@@B@DD@@BA@@BBBAB@B@@@@@B@BABA@@@@B@@@@@@@@@B@@AAA@A@@BA@@@@B@@BBBBBBD@@@DBBA@@B@DADAB@F@B@BBF@F@BB@@D@@BB@@BBB@B@BB@@ND@@D@FB^@DAL@BAB@B@F@D@FA@@D@BAFAFAB@BABA@@@A@@@A@@@A@A@@@@@@@@@@@A@@@ABA@@@@B@@C@@@@@@@@@A@@BA@ABA@@@@@@@@@A@@@A@B@@BB@A@A@@BA@@@@@A@@BB@@DAB@BD@@B@@@@@AA@@@@@AA@@@B@@AB@B@@@B@B@B@BA@@@@BAB@@@@@@AA@ABA@@@@@@AB@@@@@@@B@B@AAAAB@@@@ABAA@@@A@@@@@A@@AA@BA@@@@@A@ADA@A@ABAE@@@A@@@A@A@@@AB@A@@@AB@@@AA@@AA@@@AB@@@@@@A@@@A@B@@@BA@@@@@@B@B@@@@@AA@@@A@@@A@@@@@@@@@A@@@@B@@A@@@A@@@@@C@@@@@@@@@@A@@@@@A@@@@@@@A@@B@@@BB@A@@@@A@@@@A@@@@@@A@@@AB@@@AA@@@@@@@BAA@@@@@@@@@A@@@@AA@@@@@@B@@AA@@@@@@A@@@@@AA@@A@@AC@@@@@A@@@A@@@@@AA@@@@@@A@@@CAA@A@@@@@AA@@@A@@@A@@A@@@BA@@@AA@B@AA@A@A@AB@@@@B@@@@BB@A@@@@BA@@B@@A@@B@@@@@@@@B@@@@@@B@B@@@@@@B@BA@@@@@AA@@@FB@BA@@BABAB@@B@@D@@@@A@@BAB@@@@@@@BB@@@@@@@@@@@AB@@@A@@@@@A@@@@A@@@AB@B@@@@A@@BA@@A@@A@@@@@@A@AB@AA@@@@@AA@@@@@@BABA@@@A@@A@@@@@@@@@A@@@A@@@BAA@@A@@AB@@@AA@ACA@@@A@@@A@@B@@AA@@BA@@A@@@@@@@@@@@@@A@@@@@@@AA@BAB@@ABAB@BA@A@A@AB@@@@A@@BAA@@AB@@CB@@@B@@@AA@@@B@@AA@@@@@@@AA@A@@@@@AA@@@A@A@@@A@@A@@A@@@A@@@ABA@@@@@A@@@BA@@C@@@A@@AAA@@@AB@DB@AB@B@@@@B@@@AB@@A@@@@AA@@A@A@AAB@@A@AB@@@B@@ABB@A@@AA@A@AA@@A@@A@@A@@AA@@BA@@@@@@@@@@AA@@@B@@A@AB@@A@@ABA@@@@@AB@@@B@D@@@@ABB@@@@@BAB@@@@B@@AB@@ABBBB@@@@@A@C@AA@AAAAA@@@@@@BB@@@A@@@@@@AA@@BA@A@CBA@A@A@@@AA@A@@B@@B@@BA@AABAA@@@AA@@A@@@@@@A@BB@@@BA@@@AA@@@B@@C@@@AAA@@@@@@A@@BAAA@AB@@@@AA@@@AA@A@@@AA@@@AA@@@AABA@@CA@AAA@@@@@C@@@A@A@@@@A@A@AAA@@@A@@@@A@@@@@@@A@AB@@AA@B@B@@@BA@@AA@@@@@@ABA@@A@@@@@@BA@@BCA@A@AA@AAAA@@A@@@AB@@AB@@@@@B@@@@A@@@AB@@@@@B@@B@@@@@@@@@@BA@@@@AA@@B@AB@@@A@@A@@@AC@@@BA@@@@AA@@@B@@@@AAA@@@@@@@@B@@@@@@@@AAA@AB@@A@@@A@@@AB@B@@A@@@@CAA@@@BA@@@AA@@@@BA@@CAA@@@@@A@@B@@@@@A@@A@@@@B@@@@A@@@@@@A@@A@@@@@@A@C@@@@B@@E@AAAA@@BA@@@C@@A@@"],encodeOffsets:[[119829,28854]]}},{type:"Feature",id:"360622",properties:{name:"余江县",cp:[116.85926,28.198652],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@B@BA@@@@@A@@@AAA@A@A@@AAB@@@B@@A@@A@@A@@A@@A@@BA@@BACA@@BAB@@@@A@@CB@@A@@@@A@@AAC@@@BBAB@@@@@@AB@BA@@@@@@B@AC@@@@A@@@@@@A@@@@@C@AAA@AAAA@@@@AB@@@@AAAAA@A@@@@@@B@B@@A@A@@@@@A@@@@@A@@@A@AA@BA@@@@@A@@@A@@@BB@@D@@@@@@@@@@@@@@@@B@@A@@@A@@@@@@B@@BAB@@@@B@@@B@@C@@B@@@@@@B@@@@@@BA@@B@@BB@@@B@@@@B@@@@@AB@@@@A@@@@BA@@@AB@B@@ABA@@@A@@@@BA@@@A@@@AA@BAA@BAA@B@@A@A@@@@@AAA@@@AB@@A@A@@@@@A@@@@B@@A@@@AB@@@@@BA@@BAAA@@B@AA@@@@@A@@@A@@@A@AAAB@@A@@B@BA@@@@@A@@@@A@@A@@AA@AA@AA@@@@B@@A@@@AB@BB@@B@@@B@BBF@B@B@B@@A@CBA@A@@AA@@@A@A@A@A@@@C@@@@@@B@@@B@@A@@B@@@B@@@@AB@BA@@@@B@@@B@@A@A@@@AB@@@BABA@@BA@A@A@A@@@@@@@@@A@@@C@@@@@AA@@@@A@@@@@@AA@@A@AA@@@@A@@@@C@@@@AAA@@@@A@@@@B@@AA@@AA@A@AAA@A@@@@@A@@@A@@@AA@@@A@@@@@A@@@@B@@A@@@@@A@ABA@@@@BA@@B@@@@@B@@A@AB@BAB@@@BBB@@B@@@BB@@@@@BA@@@BB@@@B@@AA@@@@@BA@@@@B@@@BB@@@BB@@@@@BAB@@@@ABABA@@@@BA@@@CBA@@D@@A@@BA@AB@@@@@B@@@D@@@B@@ABBB@@@DBB@BA@@BB@@B@B@B@B@@@BB@@BBB@B@@A@@B@D@BB@BB@@@B@@@B@@B@@@@@B@B@@BBB@BB@BB@BFDB@@@BB@@@B@BBD@B@@BB@BA@B@@B@BBB@DBB@@@@@B@@@D@@AB@@A@@@@B@B@@@BAA@@@AA@@@CAAAA@ABA@CB@@AB@@AB@@AB@@@@A@A@AB@@A@A@@B@@E@C@@@@B@@B@B@@@BB@@@@@@@@@@A@@@@@@@@B@@B@@@@@@B@@A@@@A@A@@@@B@@A@@@@BAB@@@@A@A@A@@@@A@AA@@AA@A@@A@@AAAAA@@@AA@@@AA@@@AA@@AB@@@BA@@@AAC@A@A@@AA@@@@ACA@BA@A@AAA@C@A@AA@@AAA@AAA@@@AC@@AA@@A@A@A@A@@@A@@@@@A@@@AB@@AB@BA@@B@@BBDB@@B@@@@B@@@B@B@@@@AB@D@BA@A@A@E@@B@B@B@@A@@@@@AAA@AB@AAB@@AAACEB@@ABAB@@AB@@AB@@AB@B@@@@@BAB@BB@A@@B@@@BA@@@AB@@@@A@@@@@ABA@@@BB@@A@@@BBBB@@DC@@BBBABB@BB@@@AA@@@CA@@@@@BA@BB@@BB@@@B@BB@@@BB@@@@@@@@B@BBB@@BBAD@B@B@BB@@D@@@B@@A@CAC@@@AB@@@@AA@@@B@@AB@@@@A@@@CA@@@@@A@@A@@AA@@@AB@@A@@B@@AB@@AB@@A@@@@@A@@A@@C@@BAAC@@@A@A@CB@@A@@@AC@@@@ABA@@AA@A@@@A@@@A@AB@@@@AB@@AB@BA@@B@BA@@BAB@B@B@@@B@@@DABA@@B@@@B@@@B@@@@@B@BA@AB@B@@@@@B@B@@@@BBB@@@@BB@@@B@@@B@@@@BB@BB@@DDBB@@@B@@@@B@@FBB@@@D@@BB@@DBB@@@BB@@@B@@BB@@DB@@B@@BB@B@LBBB@@AB@@AHAB@B@@@@@B@@@B@@@@@@@A@@@@AA@@@@@B@@@BBBB@@BB@@AB@@A@A@@@@@A@@@@@A@@B@@@@ABA@@AA@@B@@B@@B@@A@@@@@@@@BB@@@@BA@@@@@B@BB@BB@@@@BA@@BA@@@@BB@BAB@@@B@@@@B@BB@B@@@@B@B@B@@BB@DB@@BBB@@@B@B@B@@@B@@@@DB@@@BA@@BABBB@@@B@B@@@@ABA@@B@@@@@BB@@B@@@@@BABCBA@@@A@A@@BABA@AB@D@B@BAD@BBB@D@@@B@@@@BB@HDB@BBBBDBBB@BB@@BB@@AB@@A@BB@@A@A@A@@@A@A@A@@@AB@@AD@@AB@BB@AB@@@@AF@B@B@DAB@D@BAD@B@B@@@@@BD@FBF@@@BD@B@B@@BB@@B@B@BA@AB@@@BA@@@A@@B@@BB@BA@@B@B@@@@@@BB@B@B@B@@B@@@@@@A@BB@@@@A@@@@@@B@@@@@@@B@@@B@B@@@B@B@@@B@@D@B@BA@@B@BAB@@B@@B@B@B@B@@@@BBBDBBBBBBA@A@@B@B@@@@@@@B@@@@@@@@AD@@@@ABAB@@CD@B@@@@BBDB@@@@@@AB@@@@@@B@@@ABA@@BAB@@@@A@A@@B@@AB@B@@AB@@@BA@@@@B@@@AABAA@BA@@@@@A@@@@@@@@@A@CA@@A@@@@A@@A@@@A@@@@@B@@@@@@BA@@@A@@BB@@B@@@@@AB@@@B@@@B@BB@@BB@B@@@@@@IAE@@@@@B@@BBB@@BBBBAB@@@@@B@@@@BA@@@BB@@B@@A@@@B@@BB@@A@@@BBB@@B@@@@@@B@B@@@B@@@@A@@@CAA@ADBB@B@@@@A@ABABAD@DAB@@@B@@A@@B@@A@@@A@AB@@@BA@@@@@A@@@ABAB@@@B@@@B@@@B@BABB@@BBA@@B@@@BBBBAF@D@B@@B@@@@ABC@@BA@@BD@@@B@@BB@B@B@@@B@@@@@B@@@@B@BB@@BB@BD@B@@@D@A@@@BAB@AABA@@@@@@B@@@DAB@@@@B@B@B@@@@@@@BB@@@@@B@B@@@@B@@@@@@@@A@@BA@@@@@@B@@@@BB@@BB@@@BB@@B@@@@A@@@@@@@@B@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@BA@@@@@B@@@@@BB@@@@@@@BB@@B@B@DDBBBBBBDBBB@BB@BBBBB@B@@AFAB@BA@@BAB@DAD@B@B@FADBB@B@D@@@F@B@B@BBDBBBD@@BBAB@FBF@D@BB@BBD@B@BB@@B@D@BBF@B@@AB@BBB@B@@BDBB@@AB@BABAFABABADCBABADEF@BCDAD@@@@BDHJBBBDB@DBD@D@B@BADB@@FB@BHFBFAB@@@@BBB@BBBDBBDDBB@BB@@B@@@@@B@B@@B@@B@BAB@@BBDDDB@@@B@B@@@@CB@@@B@BA@@BCB@@ABABA@@BADAB@@@BAB@@AB@BABBBAB@DABA@@BBB@@AB@BA@@B@B@B@@ABADCFA@@BA@@@@@AB@@@B@@BD@@@B@B@B@@@D@@BB@@B@@@B@B@B@@@@@BB@@B@@@HA@AB@B@@A@@B@@@@@@BB@@@B@@@@@@@BBB@D@D@BBB@B@D@@@BBA@@B@B@@AB@@B@@@B@@@AB@B@@AB@@BBB@@@BB@@@A@@@@@BB@@@@@AA@@@@BA@@@@@BB@@@@@@@@A@@@@@@@AB@@B@B@@AB@@@@@@@@B@@@@@@@@B@@A@@B@@@@@@@@BAB@@@@@@A@@@@@@A@@A@A@@B@@@@@@@@@AA@@B@@@B@@@A@@AD@@A@@@BAB@B@B@@@A@@@@B@@B@BB@B@@@A@A@@@@@BB@@@@@BA@A@CBB@B@@@B@@@@@@@@@ABAB@@A@@BDA@@B@@CB@@@@B@@@@ABB@@@@@@@@@@@@A@@BB@@@AA@@A@@@A@@@A@@B@@B@@@B@@@@@A@A@A@@@@DD@BABB@@B@@@@@@@@@@@@@A@@BB@@@@AA@@@@@AB@AABA@AB@@BAD@@@@@@@@B@FBD@@@ABA@A@@B@@BBA@@B@@A@@@B@@@@@BA@@@B@BB@@@B@@@@BBB@@BA@@@@DABA@@@BB@@@@@B@@ABA@A@@B@@@B@B@@@BB@@@BB@@B@@B@@@@@@B@@@@B@@@@A@@@@BABB@AB@@@@BB@@@@@@@@BB@@BBB@AB@@@FBB@@@B@@A@@@A@@@B@@B@@@@@B@@@@@@@@A@BA@@A@@@@@@B@@A@@A@@@B@@A@@AB@@A@@@@@BA@@@@@@A@@@A@@@B@@AB@@@@@@@B@@@BB@B@B@@B@@B@@@@BB@A@@@@@A@@A@@@@@@@@A@@@EB@@A@@B@@B@B@@@@@BB@@@@B@B@@@@@CB@@@A@@@@@@AAABA@AB@@@@@@B@BB@@FB@@@B@@@@@B@@@@@BA@@@@BH@B@D@@@B@@@B@B@@@B@@BA@B@@@@@B@@@@@CB@@@B@@@@BAD@@@B@@BA@@@A@A@AB@@@B@@@B@@@AB@@AB@@@B@@@@@ADA@@@@@B@@@@BA@@@@@@@@@@BB@BA@@@@B@@BA@@@@@B@BD@@BB@@AB@AA@A@@@AB@@@@A@@B@@@@@B@@@BA@@@ABA@ADA@@@A@@B@@ABA@@@C@A@EB@@A@@B@@@@@@A@ABAB@@@@@BA@AB@BAB@@@AA@@@@@A@@@B@@AA@@@@@A@@@@@@@@@@ABAA@@A@BA@@BB@@@CB@@A@A@AB@@BB@@@@@@@@B@@AD@@@@@@@@@@@ABA@@B@@@AB@@@B@@B@B@@@@@@A@@AA@A@A@@@@B@@BBB@B@@@@BC@B@@@B@@@@B@AA@@@A@@AA@ABA@@BB@@@@BB@BB@@@@@@@BB@A@@A@AA@A@@@@@@@@B@@@B@@B@@B@B@B@@@@A@@BA@@@A@@AB@@@B@@AB@@@@@@A@@@A@@A@@@@@@@A@@B@@@@@@A@@@@B@@@@@@@@A@@B@@A@@@@@@@@@A@@B@@@@@@@@A@@@A@BABAB@DA@A@@@@@@@BA@C@A@@BA@@DCB@@@@@@B@B@BBB@@@BB@B@B@BB@BB@@B@@BB@BBB@A@BAB@@@@@@@@BB@@@@B@@@@@@BBBA@@@@@A@@@@@@B@@A@@@@@@@@@@B@@@B@@@@A@@@@@@@@@@B@@@@B@@B@@@A@@B@@ABB@@@@@@@B@A@@B@@@@@@BB@@@@BB@@BBB@BB@@@B@@@@@@BDBDB@@B@@@@@B@BBB@BB@@@@B@B@@BB@@@B@@@BBBA@B@B@D@B@@@DB@BB@BB@BD@@@@BB@BDBDB@B@BB@@@A@@@B@@B@@BD@@B@@@@@B@@@BB@@B@@@BA@@BB@@@BBAB@@@@BA@@@BB@B@@B@@@B@@A@B@@@@@@A@@BB@@@@B@@@@AB@B@@B@@BBBAB@@@B@DAB@@@B@@@BAB@@B@@@@@@B@B@B@B@DBDAB@@AAC@@BAF@BAD@@@@A@@@@@B@@@@B@@@@A@@@@@@@@BA@@@@@@@@@B@@@@@B@@@@D@@@A@@BB@@AB@D@@ADABC@@BA@A@@B@@@BCB@B@B@B@@@BB@DA@@BDB@B@B@@@@@@BBAB@BBB@AB@B@BBB@@BB@@BB@@@B@@B@@@BBBB@BB@@BB@DAB@@@@A@ADBBAB@@B@@B@@@B@B@@AB@@@B@BB@@B@@@@A@@BA@@@@@@@B@@@@@@B@@A@@@@@@@@BC@@@CB@@@@@@D@B@@@@B@B@@@@B@@@@@A@@@A@@@@B@@@@@B@@@B@@@@A@@@@AB@@AA@@@@@@@AB@@@@@B@@@@A@A@@@@@A@@@A@A@CB@@@@@@B@@A@BB@@@@@@@B@@@@@BB@@@@E@@B@@@AB@F@@@B@@@ABE@A@AB@BAB@DCBAD@@AB@@@B@B@@@BB@@@AB@@A@@B@@A@@BAB@BAB@BA@B@@@@B@BB@@@@B@BA@A@@B@@@B@@@B@@AB@@AB@B@@@@@BABA@@@@B@@@B@@@@B@@BD@B@@@@BAB@BAD@@@@ABA@A@@@A@@B@B@@@DB@@@BB@@AB@B@BA@@@@@A@@@@@@BA@A@@@@@AA@@@AC@@AA@A@@B@@@@B@@BB@@@BB@@@@@@@AA@@BA@A@@B@@@@@@B@@@B@@@@@A@@@@B@@@@@@@@@@AB@@@@@D@BBAAB@@@@@B@@@B@@@@@B@AA@@@@@@@@A@@@@@A@@@@BA@@@A@@@@A@@@B@@@@A@@@A@@ABA@@B@B@@@B@@A@@B@B@@A@@PE@@GE@A@@BB@@@A@@A@@@@@AA@@@@@AB@BBB@@@@A@@@@@@AB@AA@@@@@@@A@@@AAAI@ADBB@B@@@@@@B@BA@@@A@@@@@@@@B@BB@BA@@B@@@DA@@@@AA@AA@BC@AA@ACAA@@@@A@@@@AAA@A@A@@BBDB@BBB@@BB@BB@BDBB@B@@B@@AB@@A@@@A@@AA@@@A@@@@AA@@@AA@@A@@@@@@A@A@@AC@A@@@AA@@AAA@@@DA@A@A@@@A@@A@A@@@A@@BA@@@@@AAAA@@B@@A@@A@@@AA@@AB@@B@@@@@A@A@@@AA@@A@@AAA@@@A@@A@A@AB@A@@AAA@@@@BA@A@A@AA@AA@@A@@@CAAAA@A@@BABA@@AA@@A@@@AA@@CB@@AAAA@CA@@@AA@AB@BA@@@A@@@A@@@A@@BA@C@ABC@AA@ACBC@C@@A@@A@@AAC@@AAAA@@@A@AB@@C@A@@@A@AABC@@C@A@B@AAB@@A@A@ABA@@A@@@A@@A@@@AA@@AA@@@@@BB@@AB@@B@@@@BB@A@AB@@@B@@@@B@B@@BA@A@@@@BB@@@B@A@@BB@A@@B@@B@@@ABAAA@@@CC@@@@A@@@A@@@A@@A@@AA@A@@@A@A@AAA@A@C@AA@@AA@@@AFA@@@ABA@@@@@A@@@@A@@@A@@@A@@B@B@@@@A@@@A@@A@BA@@@AB@@AAAAAA@@@A@A@AB@@C@@@@AABA@CA@B@@@@A@@@A@A@ABA@@B@AAAA@@@@@A@@BA@A@@@@@@@A@@@A@AA@@@@AB@@@@A@BD@B@B@@@@A@@AA@@@B@@B@@BBB@@@@D@@@BA@@@A@@@@AAA@AA@@AA@@B@@B@@@BBBD@@A@@BA@@AAA@AA@@@AA@@@@@@@@@@CA@A@@A@@@A@A@A@A@@BA@@@A@@@A@@@@BBB@B@@@B@@BBABAAA@AB@@@@AAA@@@BB@@A@@@@@@@@@@AA@@@@A@@C@A@@BB@@B@@@@@@@@@@A@@AADA@@@AB@@BBB@@@BA@@@B@@@@BBA@@@@@@@A@@@@@@@A@@B@@@A@AA@@@@@AB@@BAA@A@@@BA@@@@A@@@A@@@A@@@@@@@@A@@@@@@AB@@B@@@@B@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@A@@@@A@@@@@@@BA@@@@A@A@A@CA@@AA@A@AAA@@AC@@AAA@@@A@@AB@@@B@@@BA@@@AAAAA@CCA@@AB@B@@A@@@@A@@@A@@@@A@@B@@@B@@B@@@@@@B@@A@@@CAA@A@C@AAA@AAAA@AA@A@@@@A@AA@@A@A@@AA@@AA@@@A@ABA@@A@@AA@AC@A@A@AAA@@AA@AA@@@@@@@@A@C@@@@@A@@@@AA@A@A@@BC@@@A@@A@@AA@@@CCA@AAA@AA@@AC@@@A@@AAAA@ACC@@A@@A@@@A@AB@BABA@@@@@C@@@A@@@A@A@@BA@@@@@@D@@@B@@@@@BAB@DA@@B@@@@A@A@A@A@A@@@@BAA@@AC@@@@@B@@A@@@@@A@@@@@@B@@@@@@@@AB@@@@@AA@@BAB@@BB@@@@AB@BA@@@A@A@A@@A@BA@@BA@@AA@@@@AABA@@@A@@@@@@A@@A@BA@@A@B@@@@AB@@@B@@AA@A@@A@@ABA@@@@C@@@A@BA@A@@@A@C@@@ABA@C@A@@@@@A@@@@AAFA@@B@B@@A@@A@AA@@B@@@B@@B@ABAB@@@@A@@B@AA@@@@A@@AB@@@@@AA@B@@@@@@AA@@BC@@BB@@@@AC@@@@@AB@@@@A@@@A@@AB@@@A@@@@AC@ACA@CA@@@BA@@@A@A@@A@AC@@AA@@AB@@AA@@EC@CAA@@CA@@AAAEAA@@@@A@@@AA@@@@A@@BA@AB@@A@CC@@A@@@AAA@@@AB@@AAA@@@BEBCBCDE@A@ABA@A@ABA@A@A@A@C@A@A@A@ADCBA@CBADE@CBCDEBC@@BA@@DADAB@D@DAF@D@D@B@B@B@BABABCBA@@BE@CB@@EBCBA@C@CAE@A@AAC@@@C@AA@A@AC@@A@@@A@@BA@AB@BA@@@A@CA@@A@CBA@A@@BABADA@@B@AC@@@C@@@A@ABC@AB@B@@A@AA@@@AA@@BA@@@AA@@@A@A@@B@BA@AAA@@@A@@@A@@BA@A@@D@@@@@BABECACAAA@@AAA@I@AA@@BABA@A@@B@B@B@@@@@B@@@AC@@B@BA@@B@@@BA@A@@B@@@@@@@AA@@@@@AB@BA@@B@BB@BB@@ABA@@BA@@@A@A@@@@@AB@@AB@@@@@AA@AA@@A@A@CBC@@BA@@B@BA@B@A@@@@B@@A@@@@@A@@A@AA@@A@@@@BA@@@A@@@DC@@@@A@A@@@A@@@AA@A@@@C@A@@@AA@AA@A@@@A@AB@@A@A@AA@@@@@CB@A@@@A@A@A@@B@BEAA@@@BA@@A@@@@@AA@@@BA@A@A@@@@@@@D@@B@@@@@@@B@@A@A@B@@B@AB@B@@@B@@B@@BBA@@@@@@AC@@@@B@@B@@@C@@@A@@@@@A@AAEBA@A@A@A@@@A@A@@@AB@BA@@BA@A@@@C@@@A@@@A@@AA@@AB@@@@@A@@B@B@@A@@@A@@@@@@A@@@@@@@@@A@@@@@@BA@ABB@@B@@@@@@@@@@CC@@@@A@@B@@@@@@C@@A@@BADABA@AB@BB@@@B@@BA@@F@BB@@@A@C@@BA@@@AAC@@@A@@BAB@@A@AB@@A@@@AAA@@@@A@@@@@BA@@@@@@A@@@@@@@A@@@@@BA@@B@@@@@@A@@A@A@@@BA@@@A@@@@A@@@@@@@A@@B@@@ABAA@@ABA@A@A@@@@@AA@@@@@A@@@A@@@@@@BB@@@@B@@@@A@@A@@@@@@AA@@A@A@A@A@@@EA@@@A@@BBB@B@@@B@@@B@@@@@@@@AA@@@@@@A@@@@@@A@@@AAA@AAA@@@@@@AB@@BB@@@@@BB@A@BB@B@@@@@@@@@@A@B@@B@@@@B@@@B@@@@@@B@@@@@@@@@@@BB@@@@@@B@@@@@B@@@B@@@@@C@@ABBB@@@@@@@@A@@@@@@@A@B@@B@@@@ABB@A@@@A@@@@@@AA@BA@@@A@@@@@@@@@AA@@AB@@@AAABAAA@ABAAA@@A@AA@@A@@@@@ACAA@AB@@@@A@@@@@@@@@A@@@@@@@A@@B@AC@@@@A@BAA@@@@@AA@@@@B@@@@BBB@@@@@@@BB@BB@@@@B@@A@@@@@B@@@BB@@@@@@@@@@@@@@@@BBA@@@@@@@@B@@@@@@@B@@@@@@@B@@@@A@@@@@A@@@BB@@@@AA@AA@@@@@@@ABA@AA@@B@@@B@@@A@@A@@@@@B@@@A@@AB@@@B@@A@@@@@A@@A@@@A@@@@@@A@@@@@@AAA@AB@@@@@@@AB@@@@A@@@@A@@AAC@A@@@@@@A@@@A@@@@@B@@@@@@@@@@A@@@@AAAA@@@@@B@@CAA@CBA@@AAA@@AA@@A@@@@@@@AA@@@@B@@@B@@BB@@@BA@@B@B@AA@AAB@A@@A@@@@@A@@@A@A@@@@@@@A@CBCB@@ABA@@B@@A@ABCBADABAD@@AAEGAABA@A@@@A@@@@@AAA@@@@@@@@A@AA@@@A@AEAC@@@@AAA@@A@@AA@@@@A@@@A@@A@@@A@@@@@AAA@AB@@A@@@ABAA@@A@@@C@@@AAA@@A@AA@AA@@AA@A@A@@@A@@AC@@@@@A@@AA@@AA@@A@@@@BC@@@@@@@BA@@BAA@AA@@@A@@AA@@A@@A@@@A@@@AAA@@C@AAA@@AAA@A@@"],encodeOffsets:[[119720,28758]]}},{type:"Feature",id:"360681",properties:{name:"贵溪市",cp:[117.245497,28.292519],childNum:1},geometry:{type:"Polygon",coordinates:["@@@EAE@A@A@EBABC@C@AB@AA@C@@ACAAAA@AA@@@@@AB@@@BBB@BA@@@@@@@@@A@@@@@ABABA@@@@@A@A@ABAA@@AB@@CCA@@@@@B@@@AA@@@@@@@@@@@@@@A@@A@A@@@B@B@BABA@@@B@@B@@@@A@B@@@@@@@@B@@AB@@B@@@BABA@BA@@BA@AB@@A@@AA@@A@@A@A@A@@@@ABA@@@@@@AAA@BAA@@@@@AB@@C@@@AA@@@@A@@@@@@BAB@@@@@@@A@@@@@@@A@@@@A@@@A@@@@D@@@@@@A@@@@@B@@@BBB@@@@BB@AB@BA@@@@BA@A@@A@@@@@@BCB@A@@@A@A@@@@B@@@B@@@BBB@BAD@@AB@@AD@@@@@@@B@@@B@BCBAB@@A@@@A@@A@A@C@@AA@@@@B@@@BAB@@@@A@@AAA@@@@A@@A@@@@@A@AA@@@@BA@@@A@@A@@@@@A@@@ADA@@@@A@@@@@A@@@@B@AA@@G@@D@DABAD@FA@@DAF@@ABADABABA@A@A@C@C@E@CBC@A@CBCB@@AB@@ADCFAD@DCFAB@DABCD@B@B@B@B@D@B@B@BAB@B@BAB@B@BCFADADAF@@B@BB@@BA@@B@BB@@B@@@DDB@@@BAB@@AB@@@@@BB@@B@@@@@BBBFBB@@DB@@BB@DFD@@BB@@BA@@BB@@BDB@@@@B@B@@AB@@B@@DDB@BBD@@@@@B@@BA@@@B@@@B@@A@@B@@@@BD@@@@AA@@AD@@BB@@@@@@@ABB@@@@A@@BB@@@@@BBA@@@@B@@A@AB@B@AA@@@A@@@BBB@@@@BA@A@@@EBBB@@@@@B@@@@@B@DAB@B@@@D@B@@@BABB@@@D@@@@@AB@BB@@@@BBB@@@A@@BA@@@@@A@BB@@A@BB@@@@@@@@B@@ABBB@@@@BB@@AB@@ABB@@@@B@B@B@@ABA@@B@@A@@AA@AB@@BB@@@@A@@B@@@@@@A@@@@@@@@B@@@@@BA@@@@@D@@BB@AB@@@@@B@B@B@B@B@@A@@@CBA@AB@@@@A@@@C@@@@@@@AB@@@B@B@@@B@@@D@@@@ABABA@@B@B@@@BB@@@DD@BBBBB@@@B@@BD@@BBB@BBB@DD@@B@@BB@@@@B@@AD@@@B@BBB@@@@@B@@@@@D@B@@@@@@B@@BBB@@BB@B@B@BBDB@@BB@@@AB@B@B@@BB@@BB@@@B@BB@@B@B@@B@B@@BBBBBB@BBD@B@B@DB@@B@@@@A@@@@A@@@@A@@@AB@@@@@@B@@@B@@B@@@@ABA@@DB@DBBBB@B@@AB@@A@@@A@@BB@@@B@BB@@BD@@BB@B@BBB@@DBB@B@B@@@@@AB@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@A@@A@@@BA@@@@@@@B@@@@@@B@@@B@@@B@@@@@AB@@B@B@AB@@BA@@@@B@@B@B@@@AB@@@@@@@B@@@@@@@B@AA@@@@@A@@AB@@A@AA@@BA@@B@BC@BB@@@@@@@@@@@@AA@@AB@D@@@@B@@B@@B@@@@@@@@B@@@AA@@B@BB@@@@BAB@BBBAAA@@@A@@@AAA@A@@B@@@B@@@B@@AB@B@B@B@@@B@@@@BDB@@@@@@@@@@BB@@B@@BBB@BB@@AB@@@ACAA@@A@@@@AB@@BB@@BBB@B@@B@@@B@@A@@@C@@A@AA@@@AA@@@B@@BB@@@@@@A@AACB@@@@@BA@@@@BBB@@@B@@@@@@@B@B@@AB@@@@@B@BB@B@AB@BAB@B@@@B@@@@@@ADBB@BA@B@@D@@@BAB@B@@@B@BBBB@BA@@B@@ABB@@@@B@@@B@@A@A@@@@B@@@B@@@B@@B@@@@@B@BA@@B@BE@@B@@BB@@B@D@BBB@B@B@B@@@BBB@@@BB@@@B@@@B@@@@@DD@@B@BBBA@@A@@@@AB@A@@AB@A@@@A@@A@@B@B@@AA@A@@@@@@A@@BAB@A@@A@@A@@@BA@@AA@@@@B@@BB@@B@@@BB@@@B@@@AB@B@B@BA@BBA@B@D@@@ADBBB@@@B@D@@@BAB@@@B@BB@BD@BB@@@BB@@@@DADBDB@@BAD@B@DAB@@@B@@@B@@@B@@ABA@@BBB@@B@@DBBBB@@DA@@BB@@B@@@BB@@ABAB@@@BBBBB@D@@@BB@@BBBB@B@B@@A@@B@BB@@@BBAB@B@@@@B@@BB@BB@@@BB@@B@B@@@@@A@@@BA@@BB@@B@@@@BA@@@BBBB@@@@B@@AB@@@B@B@@@@B@@@B@BCB@@B@BB@@BB@@B@D@@BB@B@@@@@@@@BB@@B@@BB@@@@@B@@BB@@@B@@@BA@@BA@@@@AAAACA@@AAA@@AA@ACAAA@@@B@BBB@B@@B@@@@@BBBDB@@BADB@@BBB@@@@CB@@A@@@ABA@@A@A@@@@@@B@@@B@@A@A@@@@A@A@CA@BBJBB@@B@@@@@@@B@@BBA@@@@@@@B@@A@AAA@@B@@@@BB@@@@B@@@@B@@AA@@@BHF@@B@B@HB@@@AB@@@@@B@@@@@@BB@@@@BB@@ADB@@@BB@BBBBB@B@@@@@BB@@B@@@@@@@BB@@BB@@AB@DA@@@B@BB@@@AB@@@@C@@@@@@@@B@@@B@@BB@@@@@@B@@B@@BB@@BB@@BB@B@@@B@B@B@BB@@B@@@BB@@@@BB@@BBB@BB@@@DA@B@@@@@B@BBBB@@B@@@@@B@@@B@DB@@DD@@B@@@H@BB@@B@B@@B@@@B@@DB@@@@@@B@BB@@@BAB@D@B@@BB@@@A@@BA@A@@BABC@A@@@B@@@@B@@@B@BB@@@B@@@@B@@@BA@@B@DBAB@@B@@B@@@@@@BB@@B@@BB@@@@B@@BD@B@B@B@B@@@B@@@BB@@BB@@@@@B@@@@B@BBB@@@B@B@@AB@@@B@B@@BB@@@DA@@BAB@@AD@B@@@@B@B@@@BBBB@@B@B@B@B@@@BB@@FAB@B@D@@@@@A@@AA@A@AAA@AAA@AC@@@A@@BB@@B@@@B@@@BBB@DB@@DB@@B@@ADCBA@@B@DABA@@@A@A@@@A@AB@@@B@@@B@B@@@HAB@@@B@F@@@BAB@@AB@BA@ABC@@@A@@BA@@@A@@@@B@B@@@B@B@@@D@FAB@B@@@BA@A@@AA@@@A@A@C@@@A@ABA@@AA@@@ADE@@BA@ABABE@@AAAA@A@@@AB@@A@@@A@A@@BA@AB@FAB@B@BA@@B@B@@@@@@A@AB@BA@A@@@ABA@@@A@A@C@A@A@@BABA@ABA@@HAB@@@@A@C@@AA@@@A@@BA@@AAB@@@B@@@B@@A@@D@@@@AB@@AA@AAAA@AB@@@@@BBB@BABAFADA@@@A@A@@B@@@@A@@BB@@@@BA@@CACC@@A@A@@B@@B@@@B@@@@B@@@B@@A@@AA@@@@ACC@@A@BABC@A@ABA@AB@B@BA@@BA@@BAB@BA@@@@AABA@@AC@A@A@@A@@@@@@A@A@A@@@@BA@@@A@@@@@A@@@@BA@@BC@ABA@A@A@BA@@B@@A@A@@A@@B@@A@@BA@@@A@@@@BA@@B@B@@@@@@@AA@@A@@@B@B@@A@@@A@@@A@@B@B@@A@@@A@@@@@@AA@@@@AB@@@@@@BB@@@@@@@@AAA@@A@@@@B@BAB@B@@A@@@@@@A@B@@A@@A@@B@@AA@@A@@@@@B@@@B@@@@A@@@@A@@@A@@@@BA@@@BB@@EB@@@@@@@A@@@AA@@@@@@@@@@A@@A@@@@@B@B@@@@A@@@A@@AB@A@@@A@@@@DA@B@@B@B@@@@@@@B@B@@AB@@@@AA@A@@@A@BA@@@C@@BA@@@A@A@@BAB@@@BA@@B@DAB@@@@@AAA@@AA@BC@C@@BA@A@@A@@@ABA@@A@A@@B@@@@@@@@@B@A@@@A@@@@AAA@@B@@@B@@ADA@A@@@C@@A@A@C@@@A@@@AB@@@A@A@@AA@@@BA@@@@@@@@C@ABA@@BA@A@@@A@@@@@@@@@A@@@@@A@@@@B@@@@@B@@@@@@@@C@@@@B@@B@@@B@@BA@@@@@@B@@@@@ABAB@@@@ABB@@@B@BAB@@@FCBA@@@C@@AA@@BA@A@@@@B@@@@ABA@A@@@@CB@@@AB@@A@@A@@@A@@@A@@@BA@@@@@@@C@A@@AA@@@@@B@B@BAD@@@@@@@@@A@A@A@A@@@A@@@A@@@A@@@@@ABC@@@@A@@@@@@@@A@@BABB@A@@AAA@@BAA@@@@AA@@AAAAA@@A@@@A@@@A@AB@@A@@A@@@A@A@@@@@AA@@A@@BAA@@@B@@@@BB@@A@@@A@@@@@@A@@@A@@A@@@@A@@@A@A@C@C@@@AA@@A@@@@A@@@A@@CACBCA@@@AA@@AA@ABB@@@@B@@@@@@AB@@@A@@A@@EAA@@@@AB@B@@@@@A@@A@@A@@A@@@@@@@@@@B@@@@ABABA@@@@BBBB@@BB@@@@@@@A@C@@@ABBB@@@B@BA@@@ABA@@BAD@BB@A@@@@@@B@BA@@BABA@@AA@@BABC@@@@@@@@@A@@@AA@@@@@AB@@@@BA@AC@A@BA@@@AB@@@@A@@AA@@@AAA@C@A@@@AB@A@@A@@BCBC@@@AAAA@@A@A@AA@@@A@@A@@@A@AAAAA@@@ABA@@B@@@BB@@@@@A@@A@A@@AB@BA@@@@@BB@@@@@@AA@@AB@BC@@@C@A@@A@C@CAE@CAA@@@CAC@KAAAEEAAAAGCA@A@ECCAA@@AA@AA@@@A@CAC@E@ABI@C@C@ACCAAA@AAC@C@A@KBA@A@GB@BI@@@A@AA@@AA@@@A@A@A@@BEBABG@CBCBE@C@C@ACG@A@A@@@@BABBBB@BB@F@@@@A@@@@CC@A@@@A@@BA@@B@BBB@BBD@D@D@BA@@B@B@DB@@B@B@@@@ABA@@@@@AB@BBB@BA@@B@@@@AAA@@@@C@@AA@@AB@BABAB@B@@CBA@@@AB@@AB@B@@A@@@@AAA@@@@A@@@C@A@@@A@ABABA@@B@BA@@AA@@BAF@DC@@@A@@A@@AB@@@BAB@@A@@@C@A@@@A@@@A@A@@@AA@A@@@C@@@AA@AAC@A@@@A@A@@B@@A@@@A@AC@A@@@@AB@@AA@A@A@@@A@A@A@@@@B@@@@A@ACA@@@@BA@@A@@@@@AA@@A@@AAAABA@@AA@A@@@A@ABCB@@AAA@@AA@@@@@A@@AA@@@AB@@A@@@AAA@A@A@A@@DA@@A@AA@@@A@@A@@ABA@B@ABA@@@@A@@AA@@@@AB@@AA@@@A@CAA@@A@@AA@@@@BA@@BA@AA@BC@@A@AC@@@AA@@ABBB@BAB@B@@B@@BA@A@A@@BA@@@@BA@AB@B@@A@@@@@AB@@ABA@@@@AA@@@A@@@AA@@@AA@@A@ABA@@@A@A@@AA@@@AC@AA@A@A@@AA@@@@A@@@@AA@AAA@AB@@@@@BA@@BA@A@@@@@@@AACA@@A@@@A@@@@BA@A@A@@B@B@@@B@D@@@@@@AB@@@@@@BB@B@@@D@B@BAB@AAAA@@@@@AB@@@B@@ADB@@@@@@B@@@B@@A@@@@BA@@@@B@B@@BBBB@@@@@@B@@B@BBB@@@B@@@BA@@BBB@BB@@BAB@B@B@@@@AB@@A@@BA@@B@@@B@@@BBBBB@@@B@@@B@B@B@B@@@B@@BDAA@@@@AB@BA@@@A@@@@B@@AD@@@@BB@B@@@@AA@@A@@@@@AA@B@@@@@B@@A@@AA@@AA@@@A@@BABA@A@@@@B@@A@@@@@A@A@@AA@@AB@AAABA@A@@@@B@B@H@DA@@@@@D@@ABB@B@@@B@BB@@B@D@@A@A@@BA@@BA@@@@B@@@B@BB@@BB@@B@@@DBB@@@BB@@FHBB@@@AB@@B@@BA@@@@B@@@B@B@@@@@A@@@B@@@@B@@@@@@@@B@@@B@@@@@@A@A@@@@@CBA@@B@@@@AD@BABB@AD@@@@ABAC@A@A@@@B@@AB@@@@A@@@@B@B@B@B@@@DA@@D@A@@@AAA@@A@@@A@AA@@A@@AA@@@AB@@@B@B@B@BA@@@ABBBABD@B@@@@BDBB@@B@BABAB@@ADAB@B@B@DA@@B@B@B@@A@@B@B@B@@@BB@@@@@@B@@@BA@@@@BA@@@@FA@@B@@@B@@@@@BA@@@@B@@@@@B@@B@B@@BBB@@@B@@B@BBB@@@@@@B@DAB@BB@AB@BAB@BAB@B@B@@@B@F@@AB@@@@A@A@@@A@@@ABABA@@BABAB@B@@@B@BAD@BABB@AB@@@@ABA@A@@B@BAB@B@BAB@@@B@@@BE@A@@@@A@@@@ACC@A@A@@@A@A@C@@@@@A@@BA@@@A@@@AB@BA@@@@@A@@@AB@@A@@@AB@@AB@@@@@BA@A@@@@@A@@@A@@@@BA@@@ABA@@BAB@@A@@@@B@@@@AB@@A@A@A@A@@BABAB@@A@@@A@C@@@@@A@@@@@A@@AA@A@A@@@@@A@@@A@C@A@@BA@A@@@@BA@@B@@A@AA@@@@A@A@@@@D@BA@AB@@A@A@A@@B@@@@@B@@A@A@C@A@A@@AA@@@A@@BC@A@@@ABA@@BC@ABAA@@@@@@@@AA@BA@@@@AA@CA@@@A@@@A@@AA@AA@@@@@A@@@A@@AABACA@C@@AAA@A@A@@@@AA@@@@@@@@@AAB@@AA@@@AAAA@@A@@@@@A@A@@A@@AAB@AABC@A@@@A@@@@@AAA@@@A@@@@AA@A@A@@@@@A@@A@@@CA@AA@@@@@@C@AA@@@@C@A@A@@@A@A@A@A@ABA@@BB@@BA@@AA@AAC@@@A@C@AB@@@@A@@@@@C@@@@AA@@@@@A@A@@@A@@@A@AA@@@@@AAA@@@@@A@@@@A@@A@@@@@AAA@ACAAA@@A@@@A@@AAAAA@C@@A@A@@@@AA@A@@@A@@B@BA@@@A@A@@@@AA@A@@@@@AB@BBD@B@D@BAB@@@@A@@B@BADA@@@AAAAC@AACCACAEACAAAAA@CAC@AAACCCCGEIGCCCEAACCEAEAG@E@C@A@CCECAAAAA@@@A@AAA@@@A@ABABA@C@@@A@@@CACACAAAC@CCCCACEICECCCCAC@A@@@A@C@AAAAA@@A@AAA@@@@@CAC@A@C@C@@B@@ADABCBCBA@@D@DBBBD@DAB@BBD@DBDAF@DAJCDCDEDCBE@A@@@C@@@CAACEGAAACCAAA@KKIGCG@E@A@AACAA@@A@@@@ABA@@@A@@@@B@@@BA@@@A@@@A@@BA@@@AA@@A@BCBABA@AACAACQAK@@A@@BA@ABAB@@@B@@A@@B@@@D@@@B@@@@@BAB@B@@A@@@ABA@A@A@A@@@AB@@AB@BAD@BAB@@@B@@A@@@@BA@@B@AA@AB@@@@@AA@A@@@AB@@AAA@ABA@AB@@A@A@@B@@AB@BA@@BB@A@@B@@@BA@@@A@@@@BA@@@AB@@AB@@AB@BAD@@@B@BABABAB@@@@A@@@CB@@@@AAA@@@ABAB@BA@C@ABC@C@@B@@ABABA@@B@AC@@AA@A@@@@@@B@@@@@@AAA@@@AAAA@@@AAA@AA@@A@@AA@AAD@@ABA@@@AA@A@@@AAA@BA@@@@@AB@@ABABAB@@AA@@@A@A@@@A@E@@A@@AA@AB@@@@AB@@@@ABAB@BA@@B@@A@AB@@AB@A@AA@@@@AAA@@AA@@@@A@AA@@A@A@A@A@@@@@@DADA@ABA@@@CB@@@B@@@BA@@@AB@B@@@@@@B@BB@@A@A@B@AB@@@@@D@@@@A@ABA@@AA@@BC@A@A@@@@@@AA@A@@@A@A@@A@@@AAAAA@@@@A@AA@A@@@@A@AA@AA@@AA@@@@@A@ABA@A@@@A@A@@B@@A@@@@@@@A@AB@@@BA@@@@@@B@BAB@BA@@@CB@@ABA@ADA@AB@BA@@@@BADAB@B@@@@@B@@@B@BA@@BAAA@@AABA@@BAA@@A@AA@@A@A@@AA@A@@@@A@A@AA@@@@AA@@ACC@@A@@@AA@@A@AA@@@AA@@@B@BC@A@@A@@C@ABA@A@@AA@A@@A@@A@AB@AA@@@@@AA@@AB@@A@@@AAA@@AA@@AAAA@@ABAA@@AA@@ABA@@@AB@@ABAB@B@B@@@BA@@@CB@BAB@@ABA@@D@@AB@B@B@@@B@D@@A@@@A@A@AA@@@A@@@AC@A@@@@AA@BC@A@@@A@@@AA@@A@A@@@@@@@AAA@A@@AA@AC@@@@@A@AA@@A@@BAA@@A@A@@A@@@AA@AAA@AA@@BA@@A@@AAC@@EEA@BA@@A@@AA@@@AA@@AB@AA@@@@AAA@AAA@@@@A@A@@@@AA@@AA@@AB@AA@@@@@AAA@AAA@@AC@@@@@@@A@A@AA@@A@@@@@CE@A@C@A@CACA@B@@@B@@ABBD@D@@AAAB@@A@@@@B@@@B@BA@A@@@A@@@@@A@A@A@A@@@@@@@@@C@@@AB@@@@A@@B@@AB@@A@@@@@@@@B@@A@A@AAAB@B@@DD@@@B@B@BAD@BB@@B@B@B@BABA@A@@BA@@@AB@@@@AB@BC@@@@@AB@@A@@BA@@BAB@@@@BB@BB@BD@B@@AB@@@B@BAB@@AB@@AB@@@D@@@@@B@BB@BBBB@B@@DB@B@@@B@B@B@D@B@B@@BBB@@@B@@BB@@@DB@@BB@@@BB@@@BBB@@B@@@@@BB@@B@@@B@D@@BB@@BBBD@@B@BB@BA@B@@B@B@D@@BDBB@@@B@@D@D@@BD@B@@@BA@@D@@ABB@@BBB@BB@B@@BB@@B@@@@BBB@B@D@@@B@B@BAB@@@D@BA@@@AB@@@B@D@@@B@B@@@B@@@B@BA@@BBB@@@BFD@BB@@BB@@BB@@BB@@B@@@BAB@D@@AB@BADA@@@EAA@@@AB@@AB@@@BA@A@A@AB@BA@A@@@@@C@A@@@A@A@C@CBAA@@A@@BAB@@BBAB@@@B@@ABA@EB@BA@ABA@CBA@ABCBA@@@A@@B@B@@CBAB@@A@@@AB@@@@@B@B@@BB@@@B@@@@@@AB@@A@@@AB@@C@@B@@@@AB@@@@AB@@ABABCBC@A@AB@@AB@@ABAB@D@BA@ABAB@@A@@@C@@B@B@@@B@@@@@@@B@B@@@B@B@@AB@BB@@@@BA@@BBBAB@@@@A@@@A@A@A@AB@@ABAF@@@BABABBB@BA@@BBB@@@@@DBB@B@@@@@B@DI@@@@BAB@@@@@@@B@@@@E@@@@BA@@@ICA@@AA@A@@@A@@@AB@B@BABAB@AA@A@@A@A@@AC@@@@B@@@@@@AB@@@@B@@B@@@BD@@@A@AA@@A@@AAA@@@C@A@@@@B@@AA@@@@@@AA@@A@@@EA@@@@@AA@A@C@@@@D@@A@@B@@@@AB@@B@BBBBD@BB@@@B@BAB@@@B@@BA@@BA@@BB@@@@@B@B@BBB@BAB@@@B@B@D@@A@@@@F@@BDDBBBBB@@@B@@AAA@@@A@ABA@@@A@AA@@@AC@AB@@@@@DCB@@@B@B@@@B@@@B@@ABB@AD@@@B@B@BA@@B@BB@@D@@@BAD@@@B@@BB@@@B@@@B@@@B@@@BA@A@@@A@ABA@@BC@AB@@A@@B@@@@BD@@@B@@@B@BBB@@BBB@@B@BB@BB@@D@@@B@@@BBBA@@B@@@BAB@BB@@@@B@@@B@@@@B@@@B@@B@@BB@@@BB@B@@D@FB@B@B@@BBB@@@@@@@@@BB@B@@@@@B@@@BABBBFHBB@@BCBABCDABAB@@@@AB@BA@@DADAB@@@@@@@B@B@@@B@@@@@B@@@@BBA@BBBA@A@@@AB@@A@@AA@@@A@@@@@BB@@@@@@B@@@BB@@BB@BB@DAB@DB@@@A@@@@BBBB@@@@@B@@@@@@@@A@@@@@B@@@B@@@@@@@@BBD@BB@@@@@@B@@A@@B@@@@@@BAB@BB@@@@@@@B@@@@B@@@B@@@@B@@@@@BA@@@A@@BB@@@A@@@@@B@@@@B@@@A@@@AB@@BAB@B@@@@@@BBB@@B@@A@@A@@@B@@@@@B@@A@@@@@@@A@@@@@@@A@@@@@@@@@AB@A@@@@@@@@@@@@@@A@@A@@@A@@@@@BA@@@@@AAA@@A@@@@@@AA@A@@A@@@@@BB@@@@B@ABB@@@@@BDA@@@@B@@@@@@@B@@@@@@@@@B@@A@@BBBBD@@@@B@@@BBB@@@BBAB@BBBABBB@B@@BA@@BB@@@@@@@@B@@@B@@ABB@@@@@@@B@@@BAA@B@@A@@@@A@B@@@@@@@B@@@@@@A@AA@BD@@@@@A@@@A@@@@@A@@@@@@@AA@@@@@@@@@@A@@@@@@@@A@@@A@@A@@@@A@B@@@@@@@@A@A@@A@BAA@@@@A@@AA@@B@@@@B@BBB@BB@@B@@@@@@@@B@@@@B@@B@@@@@@A@@@A@@@A@A@AA@@@B@@FB@@B@B@B@B@@@BB@@@@@@@BB@@@@@@A@@A@@A@@@@B@@@B@@@@@B@@B@@@@@B@BAB@BB@AB@B@@@AB@@@@@@@B@@@@@@B@@AB@@B@B@@@@B@@@@A@@@AB@@@@B@@@@@@@B@@@@@@@AB@@@@B@@@@@@A@@B@@@@@B@@@@@@B@@@@BB@@AB@B@@@@@@B@@@@@B@@@B@@@@@DA@@@A@@@@B@@B@@B@@@D@B@@@@B@@A@AB@@A@@BA@@@BBA@B@BB@BDB@@@@A@B@@@@@@B@@B@@A@@@@D@@@BB@@BBBB@@DAB@DB@@B@@@B@@@AAB@BB@A@@B@@AB@@@@@@BD@@@B@@AB@BB@B@FA@@@@@@D@B@@@@B@@@@B@@@@B@@@@@@A@@B@@@@B@@@@@AB@@@@@B@DB@@AB@@@@BB@@B@@A@@BB@D@@B@@@@ABA@@B@@@B@@@BAB@BB@@@@@@@@@A@@@@@@B@BB@@@@@A@@BB@@@@AB@@D@@B@@@BB@@@A@@B@AB@@B@@B@@A@@@@@@@@A@@@@A@@@@BA@@B@@@@@@A@@@@BA@@BA@@B@@@BBBBB@@B@BDB@AB@@A@@@@B@@@AB@B@@@@B@@BB@@A@@@A@ABB@@BAB@@@@@@@B@@@@@@B@@BB@B@B@B@@B@B@@@D@@@@@B@BBB@@DB@BA@B@@BB@@B@@B@@@BBB@@B@@B@@A@@BBBAB@@@B@@@@B@BB@@D@@@@A@@BB@@B@@A@@AAB@@@@@@@@BB@@B@@BBBA@BAB@@@AA@@@@BBB@@B@B@B@DAB@B@@AB@@B@@@@B@@@A@@A@@@@B@BBBBB@@B@D@B@@@@AAAA@BA@@BA@@@@@BA@A@@@@AA@B@@C@A@@@A@@B@@@@AB@BB@@@BAB@@@@A@@BB@@@@@@@@@@AB@@BB@@@BB@@@@BB@@B@BBB@@@BAA@BA@@@A@@B@BA@BBB@B@@@BB@@@@@BA@@B@@@A@@A@A@@BCAA@@B@@BB@BB@@@D@@@AB@@B@@@@@B@BA@@B@@@B@@@@BB@@@B@B@@@B@@B@@@@@BBB@@@@@@B@@BA@@@B@@B@@@A@@DA@@BA@@BB@AB@@@@@BAB@B@B@@ABABA@@BA@ABB@@@@@@B@@@@@@@@@@@@@B@@@AB@@BB@@@AB@@@B@@@B@BDB@@B@@BA@@@BB@AB@@B@@@B@@@@@@@@@B@@@@B@@ABAB@@@@@@BB@@@@B@@BBAB@@@@@@@@BB@@@AB@@@B@@A@A@@B@@@B@@B@@@@@B@@@A@@B@@@@@@@@@@AA@@@@@@A@AB@@@B@@C@@@@AA@ABAB@@ABA@@E@@BB@@@@B@@A@A@@@@A@A@@@@@@@@A@@@@@@A@@@@BA@@@AB@@@@@BAA@@@@@A@@A@@B@B@BBBA@B@@B@@AB@@B@@@@B@@@B@@BB@@@@B@B@DB@@B@@@@@@@BB@@@@B@@@B@@@@@D@@BB@@@BB@@@@B@@@@@@@BB@@@A@@@@B@@B@@B@@@@@@@@@B@AB@@@@@@B@@B@@BA@@B@@@@@@@@B@@B@@@@@@BAA@@A@@@@B@@@@@@@B@@@@@B@@@@@@@@D@@@@@B@@@B@@@@A@@B@@@@@@@@@B@@@B@@@B@@B@@@@@A@A@@@@B@@A@@@A@B@@@B@@@@A@@B@@BB@@BB@@A@@B@@@BBA@@B@B@@@B@@@F@AB@B@BCB@B@B@@@@ABB@@BB@@@@@B@@@B@AB@B@@A@BBBBA@A@@@@@@@A@@B@@@@B@BAB@@B@@@@A@AB@@@@ABA@A@A@@@A@A@@BA@@@B@@B@@@@BB@@@@A@@@ACA@CB@@AA@@@B@@@@AB@@@B@BAA@@@A@B@@@B@@@@@@@@AB@BAB@@@B@@@@@@@@@DA@@@@@AB@B@@@B@@@@@@@@@@@B@B@@@B@@@B@@ABABA@EBEBABC@@@EBC@E@A@A@ABK@CB]@EAC@@@MC@@AAA@A@AA@@AA@@@CA@@A"],encodeOffsets:[[119873,28972]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
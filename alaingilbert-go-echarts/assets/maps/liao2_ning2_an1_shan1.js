@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("鞍山",{type:"FeatureCollection",features:[{type:"Feature",id:"210304",properties:{name:"立山区",cp:[123.029091,41.150401],childNum:1},geometry:{type:"Polygon",coordinates:["@@YeGfCTQO@@[AKBAD@B@FG@ABAD@BBDDDDFGD@DAFCACCA@C@IDCBC@@@ABA@KCCBA@A@EBABABB@@B@B@@@B@@@@AB@B@@@BB@AB@B@B@BB@ABAB@@CBAB@@AB@BA@@@AD@@A@A@ABA@A@ABABAB@@B@B@@BAB@B@@DB@BA@@@@@@DCA@@@@@@@BAB@@A@@@@@@@@@A@A@C@@BC@CB@@@@GBA@@@AB@@@@CBCB@@@@ABFDBBB@@BBBBB@@DFHLFBFHFD@@BB@D@B@BG@C@ABCB@@BB@@@@@DA@BBA@IF@@@BEBIFA@MD@@C@EBED@B@BBB@B@B@BDJCDAJABJ@@@@A@A@@@ABC@AAC@A@@DA@@B@BBB@@@B@@@@@BA@@B@@@DB@@B@BBB@@A@@B@FB@@@@FDB@@@B@AFA@@BB@BBB@A@B@@@DBB@@@@BAF@@B@BB@@B@AB@@B@FBB@BD@BJA@A@@@A@@@@B@@@BA@@B@BBAB@@JB@B@@B@@B@@@@BA@@B@@@@@@DB@LA@@@@@@B@@CJ@BAB@B@BA@@@@B@BA@@@A@A@A@@@@@@B@B@DA@BB@@@@@B@@B@@@@@A@AC@CAHCCCABCEBA@@@@D@AED@AE@@@@B@BBB@CCB@@AB@@@B@@B@@B@@@B@B@@@B@B@@@B@B@@ADBFB@@BAB@BA@A@@B@B@B@@@BAB@@BBAB@B@B@@@@AB@D@F@BAB@B@BAD@@@@@@@DBBB@@@@BBB@DBD@@B@@@BD@@BBAB@DBB@B@D@BA@A@A@ABA@@@A@@@A@A@AB@@@B@B@B@FADA@@B@BA@@@C@@@A@A@@B@AA@@@@@A@@B@@C@@B@@@@A@A@@B@@@D@@@B@AC@@BABA@A@AD@B@@@BAB@BABAB@@@B@B@B@BA@@BAAA@@BA@@@AAA@AA@AAA@A@BAA@@@@A@@@@A@A@@@@@@ABE@@C@AAA@@A@@@@@A@@@AD@@ABA@@BAB@D@@ABA@A@@@A@AE@@ACC@@AAB@@ABA@AA@BA@CB@@A@ABAAA@A@@B@@@@A@ABC@A@C@@KA@@BABCBABA@@@@FBB@BBB@FBBB@@B@@@BABA@A@@BA@@DA@ABAB@B@B@BAB@@@FBD@B@@@@@BB@@@@B@F@@@BB@@BB@BBBB@@@BB@BB@B@B@F@BA@@@CBA@@D@BB@A@@B@@@B@@@D@B@BB@@@@BA@A@@BA@@@ABAD@@@BBBB@@@@B@AAA@@@BA@BB@@BB@@ABBFA@@@ABAA@@A@AAA@ABA@@DA@@@A@@A@@AAC@A@@A@AA@@@A@A@@AA@AAC@@@@BA@@B@B@B@B@@AB@B@@@BAB@BA@@DAB@@@@ABA@@BA@@D@BADADA@@D@@@@AB@@@@A@@@A@@AAA@@@AA@@@A@@AA@AAA@@@A@@@A@@BC@@@A@@BABABABA@@@@BAB@DA@@@@AA@@ACA@@@A@@@CA@@C@A@C@A@AA@@AAAAA@E@A@A@W@}BGHEHKPS@UTe@"],encodeOffsets:[[126077,42055]]}},{type:"Feature",id:"210311",properties:{name:"千山区",cp:[122.944751,41.068901],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AC@C@A@AAA@A@@@ABA@A@CAA@@@A@@AA@A@@AC@@@A@A@A@A@A@A@A@@@A@@@ABCAA@@@A@@BA@AB@@A@@@C@ABA@@BAB@B@@@BAB@@A@IDG@C@AAC@A@ED@AA@@@@AAA@@@AAA@@AACBA@A@@B@@AB@@@BABA@@@ABA@@@ABA@@@AB@@AB@BA@ABAB@BAA@@@AA@@AAAA@C@@@A@ABB@@B@BA@@B@@@B@BAB@@AB@@@BAB@@@@@D@@ABAA@@A@A@A@@@A@AB@@A@A@A@A@A@ABA@AB@B@B@B@BA@A@A@@@A@ABA@@@C@A@A@A@@@A@@BA@@BAB@@AB@@@B@B@@BB@BA@@BB@@BA@@@A@@AA@@@A@A@@AA@A@@@A@A@@BA@A@A@A@@@@AA@AAA@AB@B@BAB@BCB@BA@BB@@BBA@EAA@AA@@A@A@A@A@EB@B@@@BCBGDA@A@A@A@@AGBA@@A@AG@A@ABBNC@CB@@AB@HABAB@B@BABA@MBAB@B@BAB@BID@@@B@B@BAB@BIDA@EDDBBD@B@@@B@B@B@@@BB@@@DB@@D@B@B@F@D@B@DBBAB@@@B@@BBBB@BBB@B@@@FFBB@@BAB@BBFHAB@B@B@BA@@BBB@@F@@B@DB@B@@CB@BA@AB@B@B@BF@@B@HC@A@A@ABAFBPFB@B@BGB@FBB@B@HCBA@ABABBD@@ABADA@@BAB@B@@@@LBBFDBB@B@BAD@B@@BB@@BHB@FCB@B@@BBBBD@BD@B@@BDD@BB@BBB@@DB@DBJJ@B@@BA@@BB@@B@BB@@@@@@@BB@@@@B@@@@A@A@A@@@BF@@CBBBBBB@@@BB@B@@@@@AB@@@F@@@B@@@@BB@@@BAB@@@@@@BA@@@@@FDBBIF@BAB@@F@BBHHDB@BBDA@BBB@@@J@\\AJAHAH@DE@@JGD@DHB@BDH@@@D@B@B@B@DA@@B@@@@@@@@@@@@@B@@@B@ECKMKCA@FAB@D@JAA@CC@A@A@@DCB@BG@ABEFAHEVARDFD@@FB@DDB@BBBFBDB@D@D@B@DDDD@D@BADCDADBFFBBB@HBH@PCF@@@BAD@@FD@B@B@BAFE@@BECC@A@ABA@@@@BCBA@@ACBABABADB@@B@B@BBB@B@B@BDBDFA@AHCFAAC`ONGGAMBBE@@CEMMCCKECA@G`WFENCJCBADEHEFCB@CAIEGEAE@E@IHIJMHIBEAOMKKAKAUACAIAA@]KC@ECE@GAG@SBGBC@A@EAKCG@C@@@A@A@IEEACAC@A@A@A@ABCAA@A@AAC@A@A@C@A@C@ABAB@@AB@BABAB"],encodeOffsets:[[125913,41896]]}},{type:"Feature",id:"210302",properties:{name:"铁东区",cp:[122.991052,41.089933],childNum:1},geometry:{type:"Polygon",coordinates:["@@EF_X@HDBLFDDNNDF@@AFNAHBMH_PBDEBGD@BEBACACA@A@A@AAA@A@@@CAABABABBD@@ABAD@@@@AB@B@BDDAF@@EFABA@A@C@@EC@AB@@E@ODG@GAA@AAEECACBCDABC@C@CC@C@A@C@CCAEAAA@ACA@CEA@@ECQCUBGFEBAF@BAHA@CD@@@B@BDDB@IBC@A@EBB@LDLNFD@@@@DDFFBDDD@@FJ@@B@@@@@JLBBBBDFBB@@@@@BDF@@BBBD@BB@@DBBBB@@@@@@BB@BBDBBBDBBBDBB@@@B@@@@BBDH@BBDDDB@@BBBHFBA@@@@DADA@@@@BA@@B@HA@@@@DAD@@AD@B@B@@@@@@@@@B@@@BA@A@@@@@@DB@C@@@@B@@ACA@@@ABA@AA@A@@@BABABAB@B@BAB@B@@@BC@@B@@ABA@@BADA@@BABAA@@A@A@ABAA@@A@@@ABA@@@@@A@@@A@AA@BABAFAB@B@DALDB@BA@@D@DAJCD@B@DDDBBE@CHCCECCAC@ABCBAH@@E@ABCLA\\B@@RPDSHeZff@VST@LOFGHG~AX@@AAA@AABC@AAC@C@A@A@@@@C@@CAAAAAA@@@@@@C@@@@@A@@@@DC@A@@@AB@@ABCB@BABA@@B@@ABAB@BA@AB@BA@@@C@@AA@@AA@@AAAACC@@BAAAA@AAAC@@@ACE@@@@AAC@AAC@@AA@A@@AA@CA@@@A@@@A@@AADA@@@C@A@ABAA@AAAAA@A@AB@@@@ABA@@@A@C@A@CBAB@@@@KAK@I@IEKCCBUFOFEHI@MAGEKIOIECA@EDGFCFABIDMD"],encodeOffsets:[[126027,41976]]}},{type:"Feature",id:"210303",properties:{name:"铁西区",cp:[122.969629,41.119884],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AACEAAAAIK@@@@A@@@EI@@CCACEECC@@@@A@@@A@@@@@@@@@@@@@A@@@CBA@A@A@C@@@G@ACA@CGC@IH@@CFG@GBIB[BI@@@A@A@@BB@@B@D@@A@@@A@ABA@AB@B@B@B@@@D@@@BA@@@A@A@CAE@A@AAA@AB@@A@@D@@ABA@@@@AA@C@CAAAA@AA@A@AA@A@@@AB@@EBA@AAACAC@@A@@@A@EF@@EBAAA@C@A@AA@@C@A@@@CB@B@BAB@@A@AACACA@@@@AB@B@B@@@B@@AB@DBB@@AB@BB@BDBDBDDD@BB@@ABAB@@@BB@BDB@BA@@D@B@@BBBBBB@BBBDA@@BB@@B@@@@@BBB@@@B@BAHHEDABFFAB@@@@ABA@ABDBA@BBA@@@EF@@ABJHA@DFA@BDG@@BNLDALBBBBBA@A@DH@@BB@@DDBCFHBDABA@@@@@BB@@GDA@@@EDJFDDDDHCD@HCDDDDBB@@DB@ABBFA@@@B@@BB@B@@@@@@@@@@@@BBBD@D@DDHB@DJBB@@B@B@@B@BD@BBFAAED@B@B@@DB@B@@B@B@@@@B@BA@@@@DADB@@@B@@B@DD@@FC@@B@DBBAFDDCA@BABB@A@BBAAA@@NJBAREF@AEA@AADA@BB@@BBDFADF@@B@@@HJFFDBD@NCJ@@AEEBABIDCCI@A@A@AAA@A@AFCFAD@@@NCB@JEFA@A@@JEB@AAB@@C@@@@AA@@DABAD@H@@A@A@CAA@@ECEGEAGKCE@@AAAA@AA@AAECGEAA@AA@CCAC@ACGAA@@@@@A@@AAACAAACAAAC@AAA@@@@@@AAAA@CA@@AACAA@@CE@A@@"],encodeOffsets:[[125927,42082]]}},{type:"Feature",id:"210321",properties:{name:"台安县",cp:[122.436196,41.412767],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@BA@@B@@@B@@@@@BB@@BBBD@B@B@@BB@@B@B@B@B@@@@@BA@@@@@A@@@@AA@@AA@@@A@A@A@@@A@@@A@A@A@@@A@@@@A@@@A@ABA@A@A@A@@@@@A@@A@A@@AA@@AA@@A@A@C@I@A@A@C@C@AAA@AAA@@@@A@@@A@@@ABA@@@A@AB@@A@CAC@A@AB@@A@ABA@@BA@@D@BADAB@B@BA@@BA@@BA@CB@@AAC@A@@@A@@B@@ABA@@@@@@B@@B@BB@@@@B@@AB@B@B@B@B@@@@ABABAB@@@BA@@BB@@@@@BB@@BA@@B@@@BA@@@AB@@A@ADC@A@A@@@A@C@@@A@@@AAA@AA@@C@A@@@A@@@@A@AAA@@@A@@@GACAMBEAQTGLALAHDTFR@VAFBN@DBJGNKFA@OPAR@NBFKJEHEH@LBT@D@T[DGJCTDLRPDBXJB@R@LFJHBF@@@@DLF@P@TBXDLLJLDDJHTTTHJJOHcBKOIBQB@@YBMDAYQJKLCAEEMOOOEOKCUIAECGIGA@cETKGE@@@@MKWH_ISF]KMHQIINGHWAKBKDODOFCFKNGJCBOLBDFBLBB@PDNBPHLDLN@NAXDH@DFVFLJJDFBDBNBRBDJL@@@@DF@DALADFBDDDF@@@HBBB@BB@@ABA@AB@B@D@BABAB@BBBBDBBABCDGBKFSJ[PKNMNGLINMPIJIP@@@@@@ABABKNB@CD@BADAB@@BBFHBB@BEBABDDA@IFA@DDLNKD@@@BDD@@FFFJ@@BBBBDDFHDDFFDD`NDB\\N`N@@@@BBjR@@LCR@VJNDXH@@@@DBTLVLNHPBTFNBRBhBzB^@dPTJHDHDDDHBHD@AAGEB@@@@BC@ALC@G@@CAA@@A@C@@AAEA@@A@@A@E@@B@@@BA@@AADEVK^JTFVDZHJANEHIFCHCLEZAHIAKBSLANDFD@@LDHD@@HCDAFAB@BA@ECCAC@@AEBABAFABA@AEECCOGAC@A@ADADCJAFCBABC@ACEECGCEAAABGFKBCDGFK@ABIBE@ECCCAIAC@@ABADAB@VDF@D@DE@C@ECEECCAE@E@CAAA@CBMDEFCHEJCDABA@C@CEEACAA@CBCAE@IAA@EAGAE@C@EDADAH@FBFBB@B@BABA@CACCACCAA@@AAAACAOMOKGEEGACEIACA@CAG@E@CBE@E@A@AC@AFGDCBA@A@C@AAAE@S@C@CAAAAC@EFA@@@@B@@@BADAIEMKAAA@IGGGAECI@@MMKGEEACAEDCFC@@@@FAf@VF@ANCFEDEDIBCD@@@@A@C@@H@AA@C@@@@B@BAH@@@@@@@CI@@@@@AB@@@B@@C@@AAAADC@ABCA@BE@AB@@ADCBAB@AAFCA@C@A@EDABCAA@CAEAGAA@CC@@@@IACABCFCBEHC@C@CGEMCAAAEDUFSBOIKOQIAM@SAIIOGKECEAQ@GAEGAAB@@@@IBMBGACC@@@@AA@GCACA@@@@QEUGWGKH_DMIOKEGAGBCDAB@"],encodeOffsets:[[125383,42050]]}},{type:"Feature",id:"210323",properties:{name:"岫岩满族自治县",cp:[123.280935,40.29088],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@GFEJYFSDKDIAGAE@EOGCIAA@IFGHKRITE@IAOAQAMJCDCDKHMFMHOFIDOHO@E@ABABC@A@ABAB@@AB@@@BA@@AA@A@@AAAC@@@A@ABAAA@@@A@AB@@C@@@CBEBC@@BA@@@CBA@GAC@AB@@AB@D@@AB@BA@ABCBC@@@A@A@AAA@@AAA@@A@A@AA@@@A@A@A@@ACC@CA@A@A@@@A@@@@AAA@@AA@@A@@@@@@A@@@A@ABC@CB@@AB@@@@@B@BDBA@ABGBCBAB@BA@@@AAA@AAAAA@ACCEKEQQCEAEAACGAC@CA@A@EDEFGLORGBQBKBQDSLMDOHQJGL@@@@CHKXFLFHBHKDOAKBGFIDMPQXGRCN@RBDALEHGDIBU@]DMDGBAH@JFHBLBBB@@B@@AB@B@B@@@B@B@@BDB@@D@@A@@@@B@@B@@B@@AD@BA@@BA@@B@B@BA@@B@@A@@@@B@B@B@@@BA@@F@@BB@@A@@BA@A@A@A@ABA@@B@@@@@B@@@@@@ABADAB@BBB@@@BA@AB@BAB@@B@@BBB@@AB@BA@@@ABAB@@AB@@@B@@@B@B@@A@CB@DADBB@@BD@@AB@B@@CBBBABBBDB@@@@BB@B@B@B@@@BBB@B@@@D@B@@BBBB@@BB@@@@AD@BB@BBBB@@AB@@@D@@@B@BA@@BB@@@@BBB@@AD@B@B@B@B@@A@@B@@@B@@@BB@@BBB@@B@BD@@BB@@@@BBDBBBBBDB@B@B@@@BABD@BBDDB@B@BBBBBBB@D@BB@@BB@@BBB@B@DDB@B@@@BBD@@@HFB@BB@@@@@B@D@@F@B@@@@BBD@BBBB@B@@BB@B@B@@@BDBB@B@@AB@B@@@BB@@B@@@@AB@BCD@@@B@BA@@@@D@@DNFNALBJAB@BAHANJZDFDDJDL@L@\\BRFLHHFFPAT@JEHCJ@@@JLRNJPHHHJJRLNBLDDJILAJDHFDLHLF\\ZDVCFBJJHJA\\BPDRHNLNTBD@L@JCLARAJDJJLXTLTNPHNCVAD@DCL@@GNEJETBJDDEJLFJLFDJALCNGTKLHNHNFJFZLRDP@LAPANLFJHDNDXFNDHFHJLNTHPHJAJAF@@AZ@JD@@@@FBNDP@@@@@B@XAZNLJHLBDHPHHHBPALCJAZ@RFVFTDb@XDTFTJRFLFVKHIFGLKNKXQLIFIBGAIEGIEQEIK@AACAA@@@AB@@@@AAAA@EEBCA@@@AA@A@@@A@@@A@AA@@AD@@A@CAA@@AC@@BA@@AA@A@ABC@@@@AA@A@@@ACACADC@@@A@@BAB@@@FBB@B@@@@AAC@A@@BA@@A@@A@@@A@@B@BAB@@@DBB@FAB@B@B@@AB@BA@AB@DAD@B@BEBAB@AA@A@@@AAA@@@AA@@@@AAA@AB@BAAA@AAA@@@A@@DEFET@RFRFNBNBDCVAPITITKPCPEHALALCHC@@JEHEFCPANCHCDEHK@E@@@@BIJUJIFG@IMIUEKGGEGGHKLELEFCDEBIAIBIBKBK@@@AAAAC@AAAAA@AAAAA@A@@HCBA@@BA@A@A@A@@@A@@@AA@AA@@AA@A@A@ABC@AB@BA@@AA@A@AAABABA@@AA@@@A@@@AB@@A@@AAACAAD@B@BA@@D@BA@@B@B@DBBAB@BABC@@BA@@B@@@DB@@B@F@B@B@DCB@D@@ABA@@@AB@BIGQEGMQBGJKHIDKBMEKKMQMGMHMJGJE@ACKEKIOS]EIQOW]COOMEK@MDGBE@CFIMGMBA@@@A@@AA@GCAAAAAA@@A@EBCAAAA@@A@AB@AA@A@A@A@@@@AC@@A@A@AAAAA@@@AC@A@AAA@@A@A@CCCAAA@@AACAA@@@@@C@@@A@@@AAAA@A@AA@@A@@AAA@@A@@C@A@@AAAAAA@@@ABAAA@@BC@@DA@CBA@A@ABA@AAAAA@CCA@@AAA@@@A@A@A@@@@AAACA@@@@A@A@@CCC@@@A@A@@@@@@A@A@@CCA@AACA@AA@CAA@@@C@A@A@A@A@@BA@E@AAA@ABA@A@@@A@AAA@BA@AACAA@@@A@ABA@@@A@@AC@@AC@A@AA@@ACAA@A@AA@AA@@AA@@A@A@@@CAAAA@@ACAA@AACAC@A@AAAAAGGAAAACEAACAGEAA@A@ABABABCFGBCFGDCLGDADAB@B@D@D@D@FDD@BBD@FBLCBC@I@CCIAGEIGKIK@@@CAAAA@@BC@@@@C@@AAAA@CAA@CE@AA@AA@@A@AAAAAA@@CC@@@@C@@@AA@@ABA@CAAAAA@@@@AAAC@AAA@ABC@@AE@@@@CBA@AAC@A@AA@@CC@@A@ABA@C@A@@BAB@BA@AAABA@@@A@@@@@A@C@A@A@@BCAC@A@@@A@A@A@@A@@@A@@CAA@@@@@C@C@@AAA@AA@AA@@C@@@A@AAAAA@@ABA@A@@@@@AC@AAA@@AC@CAA@@@CAA@GAA@@@@@@CA@@AAA@@A@C@AAAAA@@@C@@@AAAAAAA@AA@@A@A@CACCAA@@BC@ABA@@@@@A@ABABA@CB@@A@ABAA@@C@@@@@ABEBCCACACAC@@@@EAAABAD@@A@@ECCAAA@AB@B@@C@@@ABBFBD@@CHADGFC@M@AAB@F@@AB@B@F@BABEBGDODCFCF@B@DB@ABA@@@ABA@AB@BA@@@@@@E@A@C@A@A@ABA@A@A@A@A@E@A@C@ABABA@@BC@CBCBAAA@C@@@A@AA@AA@A@CAA@A@@@A@A@C@@@AACB@AA@@AA@A@A@A@AA@@A@@@@@CEAEA@@AC@A@@@AABAA@AA@@@@AAAAAA@@AAAAAAA@@AAAA@@A@@BA@AA@AA@@A@A@@BAB@@C@@@@BA@@@CBC@CB@@A@@AC@AA@@A@AA@@@@ACA@@@CCAACC@@@A@@@A@@@AA@@@AAA@@@@@ABA@@B@BA@A@@@A@@@A@C@A@@@CAAAA@C@A@A@ABABA@AB@@AD@B@@KFGD"],encodeOffsets:[[126307,40973]]}},{type:"Feature",id:"210381",properties:{name:"海城市",cp:[122.685217,40.882377],childNum:1},geometry:{type:"Polygon",coordinates:["@@BQDK@I@KACMSMKQGOC[AIBIGAIDECU[YKEKGECCGBIJKCIKCMAQKIIGGOGGDGHOHGLCFCJCJIHIDSHIBKLONOJ@@AA@AABA@@@ABCB@@A@A@@@A@@BC@AB@@ABA@@@AAA@A@@@A@A@A@@@A@A@@@@@A@A@C@AB@@@BA@C@C@@@@AA@@@CA@AA@C@A@@A@@AAA@A@@A@AA@A@A@C@A@AA@@AA@AA@A@AAC@@@A@@@@B@@ABA@C@C@A@@@A@AAAAA@@@AB@@CDEB@@@BC@A@C@@@CBABA@@@@@A@A@A@ABC@@@A@A@A@ABA@A@A@@@EB@@CAA@@B@@ABA@A@@B@BABAB@B@D@FABABABAB@BC@@B@@@B@@@BABABA@@@A@A@C@A@@BABCRADGHKHKBQAWAKBMBKFQFIAOA@AIBIFOLSNCBG@A@C@C@A@@AA@AA@@@AA@@AA@@A@@CAA@@@AAA@A@A@@@A@@@AA@@@@GBC@AB@@@@A@EBC@@@@@AACBAAAAA@@AAA@@@A@@@AB@@@BA@@@@@@B@AA@AAAAA@AA@A@@@EBC@A@@@A@BDA@@D@@@F@D@BG@BB@BC@A@@@@BA@@BA@@@A@@@@@@@A@AB@@@@A@@BA@A@@BAB@@A@@@ABA@@@@BABED@@CBA@A@@@@BF@@@B@B@B@D@B@B@HBDBB@B@B@BDB@AB@@B@@BBBHB@B@@BB@B@@@B@@@BB@@B@@@@@B@@BBBB@@AB@B@BB@@@ABA@AB@B@BA@CBC@@@AB@@AD@B@@B@BB@@BBDAD@B@@@@@BB@BBB@@BB@@@B@@@BB@@@@B@@@BAB@B@@C@@@@BBDB@B@BB@D@B@DBBBBB@DB@@B@BB@@B@@BB@D@BB@@BDBB@B@B@B@B@B@@@BA@@@AAA@AAA@@@ABBBA@A@A@A@@@@@@AAA@B@AA@A@A@CAC@@@@@@@@@AA@@ABA@AAA@A@CA@@@@@A@A@@@@A@@@AAEEA@AAAAADADAAA@@@@@@@@@@BBB@@@BCB@BA@A@AB@@A@A@A@A@CBAB@@@BA@@B@@BDADBB@BA@@@BBCB@@@BABBB@@BB@@BBB@@B@@CB@B@@AB@@@BB@@@B@BB@@BB@B@@DDB@@@BBB@@@BB@@@BB@B@D@DB@BB@@BBBBBB@BB@@B@B@@BBB@B@@@@B@BBBBBB@BBB@@@D@B@@@@@B@BB@@@A@@N@PAH@L@BA@AHCFA@KBSBgBQBMAKCG@E@IHQLMDGBUBWBG@@BBBCBE@G@@@E@A@@@A@C@@@@@BDDB@BBDJLBDBBFHFFBBDFBADD@@@@@BA@A@@@BBDDB@@@BBBBA@GDBB@@@@BFBBABAD@@@B@DDJBBBDGBAAAB@@@B@@AB@@@BB@CBGBA@AAC@CBC@@B@@AB@@@@BD@B@@@D@@@BBDE@@BA@BFKBA@@B@BDH@F@@@@OBA@AAA@A@@@@@CA@@CBA@A@@@A@E@GDC@C@@@ABC@@BBBBDFJ@BA@FFBBBDDB@@@@BBBBBB@@JD@H@@@FOFABA@AL@H@B@F@BBDDF@@HHFLFAFJGBFXCD@BADC@GBOBEA@ACAO@A@@DA@MBE@KBEDA@A@A@A@@BE@E@CAA@@@A@EB@@A@@@@@@EAAA@@AA@@@@@A@A@AGG@A@E@ECA@@@@AAAA@@AAA@@@@AA@@A@@@ABA@@@ABC@@DA@@AA@A@CAAACCC@E@GAA@@@@@@BCAAB@@@B@@I@CBA@AAA@AA@AAA@@@A@@@@@A@@@@A@A@@@@A@A@C@KIAQ@ADAB@@@@@B@BA@ABABC@A@ABC@AAA@E@AAA@CCA@@@@AB@@A@AA@CC@@AAC@A@AAA@ADABCBA@K@E@A@A@AB@@BB@@F@FBBBBBBB@BADEDIFAD@B@BBB@@BBD@B@D@FEFCDAB@H@DBDBBD@D@BEJAFAF@FBBDDPFB@BD@B@D@@ABCBGDSDEBED@BCBAF@B@BBBB@B@FAHAHAF@FBLBHFHDBFFJDF@B@B@@CBA@AAGCIGEAE@CAABA@@@ABAB@BBDBD@BLJNLB@HDDBF@FCDEHGFCHCTC@B@RBDFBF@DALODAFBFJJLNVFBNADBHB@@B@@@B@BBB@@@@@@B@@@B@DB@@BBB@B@@@B@@@D@B@@@B@BCD@B@BA@@B@@AB@@A@@@AB@@AA@@@@A@@AB@@A@@BABABA@@@@@A@A@A@ABA@@@A@@A@@A@AA@@@@@@@AB@BA@@@@B@@@BBD@BA@@DAB@@AB@@ABA@A@CBABC@@@AB@@AB@B@BA@@B@BBD@D@BA@@B@B@@AB@B@@@B@@@B@@B@BBB@BBD@D@B@B@J@D@B@B@@@BB@@BB@@@B@BB@@@@@B@B@B@B@BAB@@@B@@@@@@B@@@B@B@B@@@B@@@B@B@B@@BB@@BB@@@@@B@@@@AB@@@@A@A@A@A@@@AA@@@A@AACAA@@AA@@@@A@@@A@@@AB@@@BA@CBADBHFHPLNJ`CLGXHVHRF@@@@DBDB@HBB@@@@DDHBNAJA@@@@BAHBBF@HBRDFLFPHJJTBN@JBPRJLAPETCVBFBBNDLIJALCHCPGNKNIJC@@@@FAPCFANEJAPGJKBCPKHILEPCV@D@^CbA@@PEJCJG@@@@B@BC^SLABA@@B@F@@@@@D@@@@@BAF@HC@@JCFAJADDB@@A@A@@AA@CB@BABBB@BDB@@@HC@@DBDBBBB@@@BA@A@ADA@@B@D@@@BBB@D@B@BBFA@@FEB@@@B@@@BDBDBBB@FA@@BA@@B@B@@B@BBBB@BBDBD@B@@B@@B@BA@@@CB@@@BAB@BBB@F@DBB@B@@@B@@A@@@C@@@A@A@ABAB@BAB@@@B@@@@C@AA@@AB@AAB@AC@ACAGGAAE@@@BA@AJEAAEC@@@@B@@A@@@@A@AB@@A@@A@@A@@@E@@@A@@B@@@@@AAA@@A@AAAADA@@AE@@B@B@B@@@@@@A@@A@@A@@@@@@AAA@@@AA@@AB@@@AIICAA@@CA@AAA@@ACC@AA@C@@AACAA@AA@A@EDA@AG@@AA@@@ABC@A@AAAECAA@K@@A@A@AB@@CBAB@BC@AAAB@BABGDA@A@EAA@AHA@A@OEEAAB@B@B@BGDA@@@AEA@A@A@@BABA@@DA@A@@C@AE@@@AA@AB@@A@A@ABAEGAAA@AB@@AAEE@@A@A@AAA@AA@AA@@@A@ABCAA@C@E@A@A@C@@@CA@@A@@A@@@A@A@A@@@AACCAFCB@JC@ABA@A@A@A@@JC@ABA@A@ABANAB@BA@A@ABABA@GBA@@DAD@AMBAB@H@@B@BB@HA@BB@B@B@B@HCDA@A@@@AFAB@B@B@B@@@BBB@FBB@AA@@AAB@@ADA@ABA@A@ABAB@BBB@@B@@B@B@B@B@@AB@B@@@B@B@@BB@B@@@B@@BB@@@B@@AA@@AB@@AAA@@@A@A@@BA@@BA@AB@@AB@@@B@B@B@D@@@B@BAB@@@B@B@B@@A@A@A@ABAB@BAB@B@B@B@B@@@BAB@@@B@B@B@@@BBBA@@@C@@@@BA@A@@BA@@BA@A@A@@@AB@@A@AA@BAB@@@D@B@BB@BB@@B@@BB@ABABAB@@ABA@@BA@@B@BA@@B@BA@@B@BA@A@@BA@@@AB@B@DABB@@BB@B@@BB@B@@B@@BFCB@D@BBD@H@JCB@@@BA@A@@@ABA@AB@BAD@@@B@@@BAB@@AB@@@B@DBBA@@B@@@B@B@B@B@B@B@B@@@D@@BB@B@@BB@@@B@DBB@B@BA@@B@B@BBB@D@D@@BB@BABA@ABA@@BABAD@B@D@B@B@D@BBB@B@DBBAB@B@B@D@DBFBJFB@B@@@D@H@LDFBB@D@HATAH@HBF@FDD@^LDAJIFIJKBG@IIIQKKECIEICEDQDE@@@@DADACICICEHSXFNBNCD@@@@@FCJGJCHCJCFABC@EEECIIEKMICMGIGCMAIDQLQLMRQNKTMREVILM@KFICCAIFSFIHM@@DK@CBCDUGMMOKSWSIKCIBI"],encodeOffsets:[[126072,41586]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("本溪",{type:"FeatureCollection",features:[{type:"Feature",id:"210502",properties:{name:"平山区",cp:[123.769088,41.299587],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ABA@@AA@AA@BA@A@A@@@AB@@AAA@@@@AABA@@B@@AB@B@@@@AB@A@@A@@BA@A@C@@@A@@BEB@BA@@B@@@@A@@@ABA@@BB@@B@DCDC@ABA@AB@@AD@@@@AB@@A@@BC@@DCB@@@BABA@AB@BABAB@@@D@BA@@@@@ABA@@@A@ABABA@@@AA@B@@CDA@A@@AAA@@CAA@@ACAA@@AA@A@C@@@AA@CB@@AAA@@@A@@BA@AB@BABCBAB@BA@@@AAA@CAA@EA@@AAAA@@@@@A@AEE@@A@@ACACAA@ABA@E@C@ABC@A@A@AB@@A@A@@AA@@@BA@@AA@@E@C@I@ABA@@BABBDABABAB@@@B@B@@@DD@DBBBDBB@@BEB@ACAA@@@@AA@@@@AABAAB@A@@@@@A@AAA@EAA@A@A@A@@@@@@C@@AACC@A@@E@C@AAA@@AA@A@@@ABC@ABE@@B@@@BAB@@A@ABCBEAA@@@ABC@E@C@A@E@C@A@CAA@A@AACA@A@@@@A@CACAA@@@ABABA@AB@B@@AD@@@@ABC@AB@@A@C@@@ABEAAB@@@@BB@D@B@@@B@B@B@B@B@BC@@@ABAB@@@DB@@B@@@B@@A@@BBB@@A@AB@@@B@B@BCB@@@DA@@BC@@BAB@@A@@B@@A@CB@B@B@BAB@@@BBD@@@B@@AB@B@B@@C@@BABAB@@A@A@A@CBCAABA@A@A@A@EB@@A@@@A@B@@@@B@@EC@B@@@@A@@BAB@@@@BBB@BBB@BBAB@@@B@BABB@@BBB@@@@@BBB@BBB@@@BB@@@B@B@@BABADCDCFADABCB@BA@A@@@@@@@@B@@@B@BBB@B@@B@@BBB@@@BBBB@BBBBB@BBDB@BB@AB@B@@@@BB@@BBD@@@BBB@BB@@BB@BB@AA@@@C@@BC@AB@@ADC@@@@JA@@@@XBNFJD@A@@@ABB@@@@@@B@@@@@@@BB@@B@@@BB@BB@B@@@B@@B@@B@@@@@B@B@@BB@@@B@@@BB@@B@BBB@@@DB@@B@@B@@B@@@BB@@B@@BBB@BBB@B@@@B@@@@@@@@ABA@A@ABA@A@AB@F@DAJAD@F@@@@ABA@AB@@A@@B@@A@@@A@@@@@@BA@@@@B@@@B@@@@@@@B@@@@@@AB@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@B@@@@B@BBB@@@B@@B@@B@@B@@@@@B@@B@@@@@B@@B@@@@@B@@@B@B@@DBD@H@@@DCB@B@@@@@@AB@B@@AB@@BB@B@DABBD@@A@@@@@A@@B@@AB@@@B@@AD@@A@A@@@A@@@A@@@A@A@A@A@AA@@ABA@@@AA@@AA@@@A@@BA@C@A@@@DCBC@A@AACCECEAE@@@ABAB@BAB@HBJDDBB@H@D@FBHBHBDBDBDB@BNHFD@@HDHDHDDD@@B@DDDDDFHCBBBBB@@@@@BAB@@@@@@@BA@@B@D@B@@@@@@@B@DAFD@@HD@@@@B@@@BADCBAHA@@BBB@@AB@DAB@@@BA@@BA@A@@@@@@BA@AA@CB@@@A@@BA@A@@@@BCEA@@@@@@@A@@@AHBBAB@@@B@@@AA@@A@@A@@AA@@@A@@@A@A@@@@AA@@@A@A@A@@@@A@@@@@A@@@A@@@@@A@@@A@@@A@A@AAA@@@E@A@@@A@@@A@@AC@@A@AA@@@A@AA@A@@@@B@D@BADABA@@@A@@BA@AB@@ABAB@B@B@B@DAB@@@@@BA@A@A@@A@A@@A@@@A@AB@@@B@AA@AB@@A@@@@@A@@B@BAB@@@BC@@@A@A@A@A@@CAA@CAAA@@A@A@CA@@A@A@AA@@CBA@@@AAA@C@CBA@A@A@A@A@C@@@A@A@A@@@A@CA@@@@@C@CAA@ACA@@@A@A@ABABA@ABAA@AC@@A@@AAAA@@@"],encodeOffsets:[[126721,42210]]}},{type:"Feature",id:"210503",properties:{name:"溪湖区",cp:[123.767646,41.329219],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CCGCGCGC@@ECMG@ACACACAGAGAEAC@G@A@CAICGAA@ABA@AB@B@@BFDFDFBD@B@BADCD@@B@D@B@@AB@@@B@@BB@@B@@AB@BB@@B@B@B@B@B@@@B@@@B@@@B@BC@@BA@@@A@@BA@@@@B@@@@@BC@AACBA@A@@AA@@BA@A@@B@@@@A@A@CD@@G@C@CA@@@A@AAB@@@@@BA@@@@@@@@@@B@@B@@BA@@@@@@B@@B@@@@@@B@@@@@@@@@BB@@@A@@B@@@@A@@@@@@B@@@@@@@@@@BB@@@@@B@@B@@@@@@B@@B@@@@@@B@@@@@@@B@@@@@@@@@BA@@@@B@@@@@@@B@@@@@B@@@@A@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@A@@@A@A@@A@B@@@@@B@@A@@@@B@@A@@@@@@@A@@@@@@BA@@@@@AB@@@@@@@B@@@@@@@BB@@@@@@@@@@B@@@@@B@@@B@@@@@D@@@@@B@@@@@@B@@B@@@@BB@@@@BB@@@@A@@B@@@B@@@@@B@@B@@B@@@B@@@BB@@@@B@@A@A@@BA@@@@@@@A@@BAB@B@@@B@@@B@@@@@@@@@B@@A@@@@@@@@B@@B@@B@@@@@@@B@@BB@@@@A@@B@@@DAB@@@@@B@@@@@@@B@@@@@BBB@@@@@B@@AB@@@@@@B@BB@B@@@@@@@@@B@@@BBB@@@@@B@@@@A@@@AB@@@BA@@B@@@@@B@@AB@@@@ABA@@@@@@@@B@@B@@B@@@@@@@B@@@B@@@B@@A@@@A@@@@BB@@@@@@@BB@@@@@@@B@@DBSJGTIJETJL@@@@BB@@@@BDB@@@@@BD@@@@DB@@@@JJLL@F@B@D@D@FABADCDCDEDMDUF]FGB@F@FCFGHA@@@AB@B@D@B@@BB@B@@@@@@CB@@A@AAA@@@@@@B@@@B@@@B@@A@A@AA@BA@A@@B@@@BB@@B@@@@@@@@AB@@@@BB@@@BB@@@B@B@D@@@B@@@BBB@BBBBBBBBB@BB@@@@@BA@@B@@@@@B@@A@@@AB@@@@@@@@@BB@@@DAB@B@@@B@B@@B@@B@@@BBB@D@@@BB@@@@B@@@AB@@@B@@@@@B@@@B@@@B@@BB@@B@BB@@BB@@BB@@@@@@D@B@@@B@B@@@@@@@B@@B@@BD@BB@@BB@ABBB@@B@B@D@B@@ABAB@HADAD@BAD@@BB@D@@@B@FDBBBBB@BB@@@@@@ADA@@BB@@@DD@@@@B@BA@@BAB@B@@@BA@@B@B@BB@@B@@@@@B@@@B@@@@@@BB@@@B@D@B@D@B@@@@A@AAA@@@A@@@AB@BC@@D@@@BA@@@@B@@AB@@@B@B@D@BB@@@@@B@@@B@@A@@BB@@@@@BB@@@BB@@@B@@BD@@@BAD@@AD@BA@A@A@@B@@@@@B@@@BA@@@@@@AC@@@@@@@@@AB@@@BA@A@@@@@AD@@AB@B@B@@@B@@@B@@AB@B@@@@A@@@A@AB@@@B@@@B@@@BB@A@@B@B@B@@@@@@B@@B@B@@B@@@B@@B@B@B@@B@@B@@@B@@@@@BB@B@B@@@@B@B@B@D@B@D@BABB@@B@@@@@BB@@@@B@@@B@B@@@B@@B@@B@@@B@@B@@B@@B@@@@@@AB@B@@@@@B@@B@@@@B@BB@BB@@@@B@B@@@DFVLFBNFD@LDH@FGBCDA@ABA@AEA@E@GEKEILID@B@@ABADBB@D@BABBBA@@@@PBNDXHRDTDJDJODK@@@@GA@A@AA@@AA@@A@@@AA@C@A@@@AA@AAAAAAAA@@AC@AC@@BA@ABA@@BAAA@@@A@@@A@@@@@A@@@@ACB@@A@@AAA@A@@BC@A@@@AAAA@@@@@AA@AA@@@@AA@ACA@@@AA@@AABAAA@@@A@A@@@@@C@CA@AA@A@CAAAA@A@@@A@@BA@AAA@EAA@AA@@AB@@A@@A@@CAAA@@C@AAA@AB@@C@@@@@@@AA@A@AA@@AAA@@@@@A@@AAAA@AAAAA@@@AAACA@@@A@C@@@A@AB@@A@@BC@@@@AC@@AAEC@@@A@ACA@AA@@AA@@A@@@A@@@AA@@AAA@@B@@@D@@@B@B@@ABAAA@@@AAA@@@@AABABA@@BABA@A@C@A@@@@A@A@A@A@A@A@AA@ACA@@@A@@@A@@AA@@A@A@A@@@@AA@AACA@@A@@AAA@@AA@A@A@AA@@@AA@@@@@A@@@@A@AA@@A@@@@@@@@AAA@@A@@A@A@@@A@@AA@@BA@@CCA@@A@A@@@@@A@A@@@ABA@@@@@A@@AA@A@@@@@AAACA@@FADCHAHC@@BA@A@@B@DA@ADA@@@@BA@@BA@@D@B@B@B@@@@AB@B@B@@@@@@@DCBC@A@@BA@@AC@@@A@@@ABAB@@A@@@A@CB@@AA@@ABA@@@@AA@@B@@A@AB@@A@@@CF@DCB@BAB@DA@@@C@A@A@A@AAAA@@A@C@@@A@@CC@@@AAA@A@@AACACBABC@CBAAIEA@CAICEEA@@@AACCA@AAEEA@ACCA@ACECCCCA@"],encodeOffsets:[[126711,42285]]}},{type:"Feature",id:"210504",properties:{name:"明山区",cp:[123.817214,41.308719],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@A@@AA@@C@CAAAAA@AAAAAAA@A@A@CBA@A@AAA@A@B@A@@@AB@B@B@BA@@@AA@@A@A@ABC@CF@@@B@BA@AB@@A@AD@@@@CAA@@BAB@@A@A@A@A@AA@@@@@@C@A@@@@B@B@B@B@@AD@@A@ABA@@@@B@@@@@BA@@BBBA@@@A@@B@B@@@BB@B@@@@B@BAB@@@@A@CBA@A@A@A@AB@BA@@BAB@@@B@@ABCBABC@A@@@@@@BBBB@@@B@@B@BD@@BB@@@B@@@B@F@@@B@BBB@B@@@B@@@B@@@@@B@@@B@@@@@B@@@@@@B@B@B@@BB@@@@@B@B@@@B@@BB@@@BB@@@BB@@A@@@A@ABGA@B@@@B@@@@@@FBAD@@@@@BAB@@@B@@DAB@@BAB@@@@@@@BAB@@AB@@A@CBA@@BA@AA@@GBABCDAB@@A@@@@@GC@@ECCBA@@@@@@@A@C@A@@@AB@@@@@@A@AB@@@@A@AAAAGD@BDBBDB@FFBBB@DDBB@@B@FFJDDBB@JFBBDAD@BADADBBB@@@BBB@B@@DD@@@B@@@D@BB@BB@B@B@B@B@D@@CBA@ABA@CDE@@D@@@BA@@B@BA@@@BB@@@@AB@BB@@BA@@D@B@@@BA@AB@B@@@B@@BD@@AB@@@BADCD@@@@@@A@A@A@@B@@A@A@A@C@@@AB@@AB@@@@CB@BCBA@@@@BAB@@GDGBCDEB@@DBBB@B@@@@@BBB@@@B@@@@AB@B@@@B@B@@@@@B@BB@DD@@AB@@BB@@@B@@@B@BB@@@BB@B@@@@@@B@@@BBB@@@@@@B@@@@BB@@B@@B@B@BBB@@BB@BB@@@DBBBB@@B@@B@B@B@@@BB@@@B@@@B@@DB@BBBB@B@B@B@B@B@@@@@@B@D@BABAB@@ABABBB@@@@BB@B@@BBAB@BA@A@@@C@@@A@@@BB@BB@@B@@@B@@@BB@@BB@@BDB@B@B@@FDBB@@BD@@@@AD@@@BA@@B@B@@@D@B@@DBBB@B@@BBBB@BBBBB@@@B@@@@BB@BB@@B@BBB@@@@@@D@@@BAB@BBD@@@BBDB@@@BB@@@BA@@BBB@FBB@BBB@@AB@@@B@B@BBDBB@B@@BDBD@@@@@B@B@@@B@BBBA@BB@@B@@DB@BBB@@@@BBB@@B@@@@BBBB@@B@D@@AB@B@BB@@@BA@BD@@@@@B@@@@@B@@@B@@BBAB@@AB@BAB@@BDD@@BB@BBBBBB@BBB@@B@D@B@@B@@@BB@@BB@@B@BHB@@BG@@@@BADMDKDICMBIDI@ADGBEBELMHCHCDCBK@M@UCEMEMACAAA@AAA@@CBC@AB@A@@AAA@A@GAAA@A@@@C@C@AB@@AB@B@@@DADC@@B@B@F@@@B@@AAA@A@@A@@A@@AA@A@AA@@A@@@AAAA@@@@@BA@ABC@@@ABA@A@A@A@A@@@C@@BAD@B@BBB@@@B@TDD@@@B@@@BAB@BAB@@@@@@@@@BB@@@@DB@@@@BA@AB@BAB@BAD@@BB@@D@B@B@BBBB@BBB@@BB@BBD@D@BBB@B@B@D@B@B@B@H@D@D@B@D@DAD@@@B@LCNGJGBAFGFOLMLEFIBG@MBCFODKHEHC\\GTDJADEAKCGCMCQIOCECCCECAEE@G@A@@@@BKFEFAHG@@@@LCDABA@A@A@C@AAA@@@CAE@AAA@A@AA@AAAAAABABABA@A@A@AAAAC@@A@C@CAEAC@EA@@ACCACAA@CCCA@@@A@@AAA@A@ABCACBA@A@A@@@CACBC@C@A@A@C@@BA@EFEBEBA@A@A@A@AA@@EBA@C@GBABABABABAB@@@@AB@BA@A@CBA@ABCBCB@@AB@@A@@AA@E@C@@@A@AAA@AAA@@@AB@@A@C@A@A@AA@AAA@ABAA@A@@@EC@@@@A@@@C@A@A@C@AAAAA@CA@@CA@@@AA@ACADA@A@E@@BA@A@CBE@A@C@@@A@E@ABA@ABC@ABA@GA@@@DAB@B@@AB@@@B@@@B@@AB@@@@@DA@@B@@@BAB@@@@A@CBCB@@A@A@CBCBABA@ADA@@B@BBD@DBB@B@@"],encodeOffsets:[[126806,42231]]}},{type:"Feature",id:"210505",properties:{name:"南芬区",cp:[123.744802,41.100445],childNum:1},geometry:{type:"Polygon",coordinates:["@@CA@AA@@@@A@AAA@@BABCD@@ABA@@@ABE@@BA@@AC@A@ACEA@A@AA@@@@@A@A@AAAA@@A@AA@A@AA@@EBC@AAA@A@A@A@AAA@CAE@@@A@A@ABCB@@ABABA@A@@@ABA@@@AAA@@A@AAAA@AAA@EBAAA@A@@BE@AACAEBAAA@CCC@@@AA@@ABA@A@@AA@CACAA@A@@@A@EAAA@@@A@CAA@@@A@AA@A@@@A@A@@@AC@@@A@AACCA@@A@A@@@AACAA@@BABA@A@A@AAA@A@@B@@A@ABC@@BA@@@CA@@A@ADA@@@C@CAA@A@A@ABABA@BB@B@B@@@B@BAD@@BB@BB@@B@B@@ABAB@BB@@BAB@@B@BB@B@@@BCB@@AB@@@B@BA@@B@@@D@D@@C@A@@@C@CDA@CB@@C@ABA@CDC@ABC@A@CAA@A@A@A@CAC@A@A@A@KDCBA@CBA@CBAB@@ADAAA@@@AB@@CA@@C@A@A@C@@@@B@@ABA@CDC@ABEB@@@@A@@@C@A@@AA@AAAA@@AACCAA@A@@@ACAA@AA@@A@A@E@A@@BA@AAA@C@AAAB@@C@@@C@AAC@AAA@@AA@CBEAA@A@A@E@CB@@C@A@@B@@@A@@AAA@@@CCCACC@A@AAA@AC@AAC@A@A@CC@@AA@@A@A@@B@@@@A@AA@@AB@@@B@@@B@@@B@@@B@B@@A@@BBD@B@@BB@@AB@@@B@B@@@BA@@BAB@B@B@B@@@B@@AD@D@B@DABAB@B@@@B@B@@@BB@@@@BB@BBBB@DAD@B@BBB@@D@B@B@@@@AB@@@B@DDD@@@@@@B@B@@AD@@BB@BAB@B@D@@@BB@@B@@@@AB@B@BBD@@@B@B@@AB@@@BB@@D@@AB@@B@B@B@@@DBB@D@@BB@D@@@B@B@@BB@@BB@B@@@BBB@DBD@@@@B@@B@BBDB@@@@@BBBBDBB@@@@@B@D@BDBAB@@GD@B@@AB@@CB@@CD@BABC@CB@A@@ABAB@@C@A@@@BBA@@BA@AB@B@@@B@B@B@@@BA@@@@B@BA@@BA@ABAB@@ABABA@@B@@@B@B@B@B@@@B@B@@@D@BA@ABCBC@@B@B@@BB@B@B@@A@@B@BAB@@B@@BAB@@AB@BABADBB@FBBBB@BBB@D@@@B@@@D@B@B@BBB@BB@@BD@@B@@@DB@BD@@BBFDAD@DAB@BA@@@@@CBC@ADAFDBDBB@@@@@@BDBBBB@B@DBB@D@F@B@D@F@D@BA@@B@FBDABAB@@@BA@A@@@AF@BAD@BA@@B@B@@BB@BBD@F@@@@BDDBB@@@D@@@@B@B@B@B@FBB@BBB@@@@@B@A@BBBA@B@@B@@B@@B@DB@BFA@AA@CAAACAC@@C@@@A@A@@BABABAACBA@AB@BAJ@D@F@@@BB@@AB@@B@@BB@B@@@BAB@B@D@BAD@F@B@BAB@DBDB@BB@@@FF@B@B@@@@BBBB@@FBB@DBB@BB@@B@@ABADABA@ABAB@@AB@@@B@BB@@DAB@@B@@@D@BBB@@BBBD@@BB@DB@BB@@@BCB@DA@B@@B@@ABAB@B@B@@AB@B@@@@ABC@@@A@ABABA@@BABAB@@A@CD@@AD@@@BA@@B@@C@@BA@@BAB@BCDCDA@@@AA@@AB@B@@@B@@A@@@ABA@AF@@@B@@@D@BAB@@@BB@A@@B@@A@A@@BA@@@ABBB@@@@BB@BA@@B@@@B@BABB@@BBB@@AB@B@@B@BB@BB@@@BDB@AB@BABAB@B@B@B@@DB@BBB@D@D@@@@DBB@@@B@B@B@@@D@B@B@B@B@B@DAD@B@BB@@B@DA@@BBB@B@@@DBB@B@@@BBDBB@DBB@D@@@@@@@BBB@B@B@B@@@BA@AB@DB@@@@BCB@@@BAB@@A@A@@DED@BAB@B@@@BB@@B@@A@A@ABA@@B@A@B@B@BBB@B@DAB@B@B@BBBBBBB@BBBB@D@DB@@BB@@@B@@@@AAA@CAC@A@AB@BCB@BADADAB@B@@@DADAB@@@@@BA@A@@@AB@@C@@@@BA@@@A@@@A@@BA@@@ABA@C@@HBB@BAD@BAB@BAF@B@@@D@B@F@DAB@B@@AF@B@B@BCBDB@@B@@DB@@DBB@BBBBD@B@B@D@@@B@@@@@FD@@B@B@AB@BBB@BBBB@B@D@B@@@BA@@B@BBB@BBB@@@D@F@B@@BB@@@BA@@DADABAB@DAB@B@@ABA@@@@BABABABABAHAD@B@FA@@BBB@B@B@B@FAFAFEB@@AD@B@B@D@D@DADB@@B@B@B@DADBBAB@BA@A@ED@BABC@@@ABA@ADABABA@C@@@@@@@A@AA@@C@A@AA@BA@@BA@@@ABA@@@AB@@@BC@ABABABAA@CA@AA@@@AAAACA@AA@A@A@A@@A@A@@BAB@@A@@@ADA@CB@@AB@BA@A@AB@@C@@AABAA@@@@AB@@A@AAA@AAA@@AC@AA@@A@AAA@A@@@E@@AC@A@ABAA@@AA@@AA@@@@@AAA@@A@@@@A@A@@AA@AA@A@@@A@@AAA@A@C@A@C@@@C@A@E@A@@@A@A@EC@@A@@ACAC@AA@@@@@ABAB@@@AGAC@@BCBA@C@C@@AA@A@@@ABA@ABA@@@A@@@A@A@A@@AA@@BA@A@A@@AA@GBA@@@AD@@AB@@C@A@@A@EACAAAAA@@AC@@A@@@A@A@A@AAA@ABCA@AA@C@AA@@@AAC@@BCDCDA@@B@@@BABAA@AAAA@@B@BAB@@AB@BADE@@AA@@BC@ABA@AB@@A@ABC@@AA@ABA@@BC@A@A@@BA@AACA@C@A@A@A@@@A@A@A@AAA@E@@AA@AB@@AAA@@A@@@A@@@@@AAA@A@A@ABA@A@AAA@@@@@ABC@@@A@AACAC@A@@A@A@C@E@C@@@A@@A@@@@A@@AA@CAA@AA"],encodeOffsets:[[126893,41988]]}},{type:"Feature",id:"210521",properties:{name:"本溪满族自治县",cp:[124.120635,41.302009],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@@CAAAA@A@@AA@AAA@AA@CA@A@A@A@A@AB@@@@@@@CA@@A@A@AA@@A@A@CAA@AAA@AACA@@AA@BA@CA@@@AAA@AA@@A@AAA@AC@A@@@E@@@AA@AA@@CAACAAA@@ACACA@@@G@CA@AA@@@CBA@CFA@@@C@A@C@@B@BA@ABED@@GACACAAA@@C@@@CB@@CA@@C@A@@BBD@@@@@B@BA@AB@B@@CD@@A@AAAA@@CBCBC@A@A@@@EBCBA@@@AAC@A@CC@@CBEBA@@@@D@@CBAB@B@@CBCDEBA@ABABC@CD@BAB@BE@@AC@@AAC@@@@@@A@@@ABA@A@@@AA@@C@ABADA@AD@@AB@BA@A@CB@@@BAB@@@@ABAAAA@CA@@AAAA@C@ECAAA@ABA@AB@BA@CAC@@BIAAAEAA@AA@@CAA@AA@A@@A@C@AB@@A@ABABC@A@A@@@AB@BA@E@@@C@A@AA@A@A@CC@@AAB@AA@@A@@C@C@A@A@@BA@A@C@A@CBA@A@@@@@A@A@C@@@CBA@C@C@@@@@AC@AA@A@@AA@AA@A@A@AA@@AAA@EAC@A@AA@@A@AAC@@@AC@C@A@E@E@@A@@AACC@@E@AA@@@C@AAA@A@@AAA@AB@@AAA@A@AAA@A@CAAA@@C@A@CAC@@AABC@@B@BBBE@CHAF@DBF@DCBEAECQIQBKFGJMJCBAB@B@BCB@BA@C@@@C@@BAB@@A@A@A@CBC@EBABAB@@ABA@A@C@IBC@GBEBA@A@KD_BPJJJJFJFFJBL@J@@@B@B@B@@B@B@BBDB@BB@B@B@BBDB@@@@@BDB@B@@@BB@@@BDBB@@@D@@BB@@ABAB@B@@@B@@@DB@BB@BB@BBB@@D@@BBBD@BBBB@ABB@@BFBBB@@@BB@DBBBF@B@B@@@@BB@BB@@@BBB@BBB@BB@BBBB@@AB@@AB@@@BBD@@B@BB@D@@@B@@@BB@BBBD@@BBBBB@AB@@AB@B@@AB@BA@A@@@CBAB@BADAB@@AB@@BDBBB@@BBBBDB@B@@BB@BB@B@@BB@@DDB@B@D@BBD@@BBB@B@BDDDBDD@@B@BB@@@B@@@AB@D@@@DAF@B@B@B@FBDAB@@BB@BBD@BBD@@@D@@@BABBD@B@BBB@@AB@F@B@B@@@BBB@DB@B@@@BBBDDBB@@BBBBB@@BB@D@@@B@@@@@FABAD@DCB@BA@@@A@@D@B@B@D@@@DB@@BA@@B@BBBC@@BADAB@DAB@DALCB@B@B@D@DBB@B@B@B@DBB@D@BAD@DCB@BAD@@@DAB@DCD@@@B@D@@@@C@C@@@AB@@A@A@@BA@@DA@A@@@AAAA@@@BA@AA@@ABABA@@@A@AA@@AAA@@BC@A@A@@@A@AAAB@BABAB@B@B@DBD@@@B@BCB@@@DB@@B@@AD@BAB@@@@AB@B@BBB@B@B@BA@AB@DBBB@@B@B@@@DBBD@B@B@@BD@@B@B@@@B@B@@B@B@@BB@D@B@@BBFBB@@@B@B@DBDBB@@BB@B@BA@@BB@@D@DDB@BBFADBBBF@@AB@B@BBFAB@BBB@BB@B@BB@BB@@B@BA@@B@B@BABA@@DABAB@B@@@F@DBB@BBB@B@B@B@BBD@FA@@BBB@B@@B@BB@BB@B@B@B@@@@BBB@B@DF@B@BBD@@AB@@AF@B@@AB@BC@ADAB@@BB@B@B@@B@@BDBBBB@DBB@@BB@@@@@@BB@@@D@F@D@B@B@@@@BBDBD@B@B@@AD@B@@@@BB@B@BAB@B@B@BBB@B@@@@@B@@@BB@BB@@BAB@@BF@B@BBB@B@B@@@B@B@B@D@B@BD@BAB@@@B@BAD@@AB@BBB@@AD@B@BA@@BAB@BAD@@BB@@CFABA@@BA@ABA@@@BBBBB@ABAB@@A@@@CBCDAD@@BD@B@@BBD@B@@BDBBAB@BBB@B@B@@@B@@@BD@@BBBBDBFBB@@@@B@DA@@BC@@B@@AB@HBB@@@B@BAB@@BB@@@B@B@B@@@B@@AB@BAB@B@@@BBB@@@D@DABAD@@BDBH@@A@AB@B@@@@BBD@DB@BB@@@FDB@B@@@B@F@B@D@@@D@B@D@B@B@BB@@@B@@@BBBB@@BB@B@@@@@@BB@BB@@@@B@@BB@@BB@AB@B@BBD@@@F@@@BBB@B@BB@@BBD@@BB@BBB@B@BA@@B@@B@ABBB@@@DA@@B@BABA@@BA@@DCB@B@@@BA@AB@@@B@BB@B@B@B@@BDBBBBB@@B@@BDBB@ABABAB@BAD@@A@@B@@AB@B@@AB@@ABB@@B@B@DB@@B@B@@@@@@@DABABCB@BAB@B@@ADABC@@F@BABB@BB@@@B@@DBDDB@DBDBBD@@FBD@FBDBD@B@@@BDBB@B@B@BABABABBBBBBBB@@B@BBB@BBF@D@@BB@B@D@B@BABCBKD@@@@GHEBEFAL@@@@@B@HFFDBDFDDDFJPDRDNDHBLCFIBSC[HGDGFCLEPAD@NAHEJKFKNEPEHABIHMHKDA@@@C@CBC@A@C@C@G@A@A@A@C@A@A@A@AAC@C@AAA@@AA@AAA@AA@A@A@A@CA@@AC@ABA@ABA@@BAB@@@@CA@@@@AA@@@@@@@@A@ABA@AB@@A@@@C@SCA@@@A@AAA@C@AB@@@D@@@B@B@B@BAB@B@@AD@BAB@@@@B@BB@B@@@BB@@B@BBB@@@BB@@@@BBB@BA@@@E@A@A@@@CDCB@@A@A@@BA@@B@D@D@@@BBBHBB@B@BB@@@BBAD@DA@@BB@BBBDBNBNFDF@V@NALCDGDGDKNAFAFCH@BCJAJDNCJCLCNAB@@@@AH@@CLIPVJDPALAJ@B@PBDNRXFRDN@ZBRBL@FGBGDGJOB@JAPDJDHFHD`HXCREREREZGRMB@HC\\MRATBZF\\DNMFSPYZHJHPHLAbHL@HJHNBFLBF@RCL@PDLFHHPHFDP@NEFCTGZDB@XBPAFCNEFIBI@ABG@@B@@A@@@ABA@@@@@A@@@A@@@@B@@@@A@@B@@A@@@@@@@@BAB@B@@@B@@@@AB@@@B@B@@@@@BA@@@@@A@@BAB@@@@A@@B@@AA@@@@A@@A@@@@A@@@@@@@@A@@@@@@@A@@@@AA@@AA@@@AA@@A@@@A@@@@@A@@@@@@A@@@AA@@@@@@ABA@@A@@@AAAA@@@@@@BA@AB@BA@@B@@AB@B@@@B@@@B@@@B@@AB@@@B@@BB@B@@@BABAB@B@B@B@@@@@@AB@@ABA@@B@@ABBBAB@B@@@@@@@@AA@@@@A@A@@@A@@AA@@BA@@@@@AA@@@BA@@@A@AA@B@@A@@@@BA@@@ARCRA@@@@FA@@@@B@H@@@@@\\DPKFGDEKKSKUOMMIKCMBE@WFOLONOPMRGPEFABO@MFKDIHCNCRCJQDGBMBIDCZMDAXCXBHD@DCFGJAD@@@@CDDNJFNHJBXDZGPKRM\\INAJAHEDAHGFGFGRSTIPIRODCNCN@R@J@VANGAK@GBEBEDALIJBHHFFHFNLLTN@RCTAT@ZJPD\\FXBJDHIAECGGGEEEGACKKECIGCEDKFMDGDI@MMOKOGKAGCK@GFIHIBGAGD@@@@AA@CCAAAA@@@AEAA@CA@AAA@@CACA@A@AAAA@@ABA@CBAAC@@AA@@A@A@@@A@A@A@@AAAAAAACAA@@@AAAAC@AAEBC@CBA@C@EA@@A@@@C@CBCB@@CAA@AA@@@A@A@AA@@@@A@A@C@@@AA@AAEC@@AA@A@C@@AE@@@A@C@AAA@A@@DA@ABA@@AA@@@AAAAAA@A@A@AAE@ICA@E@@AA@@@@A@@CBA@ADCDEHCFABC@C@AAACACACC@E@AAA@AA@@C@@@ECA@ABA@EACAA@CA@@@B@@CBADA@A@A@A@A@@@@@CBCBCBABEA@@CBA@CDABA@@@A@@B@@A@AA@@C@EBA@CA@AA@A@ABA@A@EA@@AA@@@A@@CAICCA@BC@ABA@@@A@A@@A@@AC@AA@A@A@A@@AA@A@A@AACA@@@EAA@A@@@A@C@@EC@@@ABA@@@AB@@A@A@@AAAACCCACAA@AAAA@@C@AAICCAA@@A@EAC@AAAEE@AAAAAA@E@AB@@C@A@CB@@ABC@MDMHGDKDQASF[JYLGDKBOEMEYMEEMKOEI@ABGDQTODKFIBICGCOMICIEQAKAKD@RBJBFDPDRAFAHEDMDM@UBWECC@AACCEA@EAIEA@@AAAAA@AA@@AAAAABA@C@@@AB@@@BAB@BA@ACA@A@@@CAAACA@AA@A@A@ABA@A@AACAC@AB@BABA@@@@AAAAAAAAAA@@AC@C@A@@@AA@A@A@@@A@AB@@EAAB@@C@C@EA@@@@AD@BA@A@ABC@CB@CCCACAAA@EKEAGCKGE@IFKFMLMNSPIDEAIAA@@CAAAAA@AAC@CAA@EBA@GA@AA@@ADCBC@AB@@AC@E@A@CAC@A@AAE@E@A@@@CB@@AA@@I@A@@@A@AA@AA@BAHQHGJKFKHOBEFKJGJI@MDO@EJINENC@KIOMQGSAS@E@E@@DEHEHENMBABEAEACEEGCAOFK@G@AAEEEICOAUBECAAICGEI@GF@L@@@@@FC@KFA@A@YBYD[DC@S@C@@@AAAAA@A@@@CAA@@AA@@@AA@@ABA@A@AAEACB@@A@E@@@A@E@ABA@C@E@A@A@@@A@ABC@@BA@ED@@@B@@A@AB@@A@A@C@@@A@C@"],encodeOffsets:[[126957,41849]]}},{type:"Feature",id:"210522",properties:{name:"桓仁满族自治县",cp:[125.361007,41.267127],childNum:1},geometry:{type:"Polygon",coordinates:["@@EMGOIEIAGAG@GBIFABEFGJEHKNGPABBDBFDBDBJDFDLFJHBDFNFP@F@JADADEDGBA@C@I@SAE@C@GACACCCCAAAE@ABCBAFALGFCBCBE@EACAEAAC@C@C@GBOFWFGBCBA@GDEFCDAB@DADBFBHLLDDDFBDADCFCF@BABAH@F@DBJAHADEFGFGDC@EBA@EAE@EE@C@G@C@C@ECGA@AAE@GDEBA@C@E@C@I@C@KFGBC@EAGAAA@A@A@CJK@@BCAA@AAA@@CAA@C@ABK@@@QAG@CAA@EBA@A@C@A@@@A@@@@BAB@BAB@@CFCDADA@@@A@A@A@CB@B@@BD@B@BBB@@BDB@@@@DBB@@@BBFB@@BB@@@DBBBD@@BBBBB@@AD@@CHABABA@EAAAC@C@CAA@@@A@@@CBA@@BCA@BA@@B@B@BCD@@CDAB@BA@A@AAA@E@A@A@@@A@AC@@E@@@CDB@JJBD@DCDIJEHAHAN@FCFAFGFSHIDEDADA@A@ABCBABABA@A@A@@A@@AAAAAAA@ABAAC@A@A@@B@BA@AB@@CAA@A@IHQJWLMBKCUKUIAAKFOBIBOB]COCSI]G_GE@OFYHMJKFGFQJCDA@CBABA@CBC@A@A@A@@@AA@@CE@@CAC@A@CAE@C@C@A@ECA@@@A@ABC@A@C@@AC@AACC@A@A@@@A@C@@A@@@A@AACC@@@AABE@A@E@AAAB@@@B@@A@@BGDIJMFQFUFA@C@A@A@CB@@ECA@AA@@AB@@A@GBA@@@A@A@C@E@@BC@@@G@E@C@A@ABABA@@@A@C@C@AAC@A@A@CBCB@BC@E@A@CBA@A@C@A@@@C@@@ABCBABCBA@@@UFWLMFSAE@MBQFGDKBGAKGIEQE_BMDODIHSTEBODE@C@BHAHGJEJ@HDLBHHLLPNP@NCJCHENCLDFJHFDLLBDFHFFHHDHBFGJNFNHPPPHVJ\\HXJLFHLDLJBTD@@BAB@BB@@B@B@F@B@@@B@FBB@DB@@B@BB@@@@AD@B@@A@A@ADAB@@@B@B@@BB@DBD@D@@BD@BBB@D@@B@BB@@B@@D@@BB@@@B@@A@@BA@CAA@A@@BAB@@AB@B@@@BA@ADABA@BB@D@B@@B@DB@BB@@B@B@@CB@@ABC@@@ABC@ABA@A@ABA@@@BD@B@@@B@B@@BD@BBBBB@B@B@@@B@@@@@B@@C@AB@@AB@B@BB@@D@@@B@D@@@B@@B@B@@@FFB@@@@B@@AB@@@BAB@B@BCFABAB@B@@@@@B@DBDA@ABCD@BA@@@BDBF@@@@BBB@@@FA@@BBB@B@@@@B@BB@BB@@@@@@@B@@CB@@BB@@ABABA@@@BB@BBBBD@B@B@@@@@D@@BBD@BB@@BBBBB@BABAB@B@D@BAB@BBB@@@B@B@@B@DBB@@BDBD@BB@@BBBBBB@@@@BA@AB@@@@@BA@@B@@@B@B@BADA@ABCD@BA@@B@@@@HF@@B@@@B@B@B@F@B@BBD@B@B@@@B@B@FB@B@@@BD@@BBB@@BBAB@BBB@B@@BB@@@BFD@@BB@B@@BBB@DBDB@BBB@DAB@@BBB@B@@@BB@BB@BBF@BBJ@RDHFHJHJHDBBFQLIJILAPEJAN@FBLCF@FAJCHETEHCPGJCXIT@DF@F@HAJ@JLBR@HAL@J@D@LDNHXFJ@HALEJGXKZCJAB@BBBDBDADB@DBB@B@B@BBD@BBB@B@B@BBD@B@B@B@BB@B@B@B@@D@BBD@@@B@BA@@@@@A@AB@BCBCAA@@@A@@BABABAB@B@@A@@@@AA@@@AA@BAB@@A@A@A@@B@D@DABBB@@@BA@@BBB@@@B@B@@A@@@ABAB@@@FBB@B@DB@@@BB@B@DB@@@@B@DA@@D@B@BBFBDBB@@BBB@@B@DA@@BH@@ABBB@B@@@BB@@@ADB@@@@BBB@@BB@@B@@@DD@@@@@D@B@BD@BAB@DCB@HEBC@@DB@@BAB@@@@@BAB@B@DABA@@@@B@D@@@H@LALALBR@XFDFFJBJ@DHFLDVTJLRJJDHJBPBBHJBHFBHCDALGNO^EF@HFJFHBPAFBB@@@@AB@B@B@D@B@@BB@B@BAB@B@BBB@@BB@@B@@BB@B@@@B@@@B@@@@@B@@@BB@@@@B@@@B@@@@@B@BB@BB@BBB@@BB@B@@B@@BBA@@B@@AB@B@B@BA@@BA@@@@BA@@BA@@BA@@@@B@B@@@B@@A@@B@@@BA@@@@@AAA@@@A@@BA@@@A@AB@@A@@BA@A@@BA@@@@@AA@@@AAA@@@@@B@@AB@@@BA@@@@@A@@@@@@@AB@BBB@@AB@@@@@@@@A@@@@@AB@@@BAB@@AB@@AB@@@BA@@DA@AB@@@B@B@@@B@B@B@B@BAB@B@B@B@BAD@@@B@@A@@@@@@@A@@@@A@@A@@A@@A@@@C@@@A@C@@AA@AA@@AA@@@AAA@@@A@A@C@A@A@@@@@@A@@@A@@A@@A@@AA@@@A@A@A@A@@@@@@@AB@@ABA@@@@@AA@@@@A@@@@BABA@@@@B@@@BABA@@B@@@DA@@B@D@BA@@@@B@AAB@@@A@@A@@@@@@@A@@B@@A@@@@@@@A@@B@@@@@BAB@@AB@@@B@BA@@@@@A@@@@BA@ADABADA@A@@@@@A@@@@A@@AB@@@@A@@@A@AA@@@@A@@A@@@@@@@@@BA@@@@A@@A@@@A@@@@@A@@@@@@BA@@B@BB@@B@@@B@B@B@B@@@@@B@B@B@@@B@@AB@@@BB@@B@@@B@@@B@@@@@B@@A@@@@BA@@@ABA@@@@@AB@@@@AB@@@@ABA@@@A@@B@@A@@B@B@@AB@@@@A@@A@AA@@@A@@AA@@@@@A@@@A@@BA@A@A@@@@@A@@@@@@@@A@@@@A@@A@@@@A@@AA@@@A@@@@@@@A@@@@@A@@@@@A@@@A@@AA@@AA@A@@@@@A@@@@@A@@@@@A@@@AB@@AB@@@@A@@B@@@@A@A@@BCBA@@@AA@@@A@@@AA@@@@@A@@@@@A@@@A@@@@@@AAA@@@AA@A@@@@@A@@BA@@BABA@AB@@@@A@A@ABA@@BA@@@@BB@@B@@@@@B@@@B@@@BAB@@@@@BA@@@@@C@@@A@@@AB@@@@@B@B@@@B@@@@@@A@@@@@A@@@AB@@AB@@A@@A@@A@@A@@@@@AAAA@@@A@@@@@@@A@@@@@AAA@A@@@A@@@@B@@@@@@@@@B@@@@@B@B@B@@@DABAB@@AB@HAB@@@B@B@F@D@D@D@B@B@@BB@B@BAD@@AB@@A@ABCBC@CBCBCBAB@@A@AAACCCACCAA@A@CACBCBEBADAHCDAFAD@B@JHVDJCJCNMTSFKAMEKIGC@GCKCDE@@@A@@@@@@B@@@BA@@BA@@@@BAB@BA@@B@@A@@B@@ABA@@@A@@B@@@@@B@@AB@@@B@@@@@B@@@AA@@@@@A@@@@BA@@@@@@@@B@@@@@@@B@@A@@B@@A@@@@BA@@@@@A@@B@@@@@@AB@@@@@@AB@@@@@@@@@@A@@@@@A@@@A@@@A@@@@@A@@@@AA@@@@@@@A@@@AA@@@@A@@@@@@A@@@@A@@@A@@@A@@@@AABA@@@A@@@@@A@@@A@@@A@@@@@@@@@AB@@@@A@@B@@@@AB@@@@@BA@@@@@@@ABA@@@@@A@@@@@A@@@@@@BA@@@@@@@AB@@A@@@@@@BAA@@AB@@A@@@@@@@@@A@@@@B@@@@@@@B@@@B@@@BBB@B@@@B@@@B@@BB@B@@@@@BB@@B@@@B@@@@BB@@@B@@@B@@@B@@@B@@@@@BB@@@@@B@@@@@@@B@@@@B@@@BB@@DB@@B@@@@B@@@@@B@@@@@B@@@@@@@@B@B@@@@@BB@@B@BB@@@@@@B@@@@AB@@@B@@@@@@@@@@AB@B@@@@@B@@AB@@@@@B@@@@@B@@AB@@@@@BA@@@@@@@@@A@@@A@@@@@@@A@@@@BA@@@@@@@A@@@@@@@A@@@@BA@@@@@@@AB@@@@A@@@@@@@A@@B@@@@@@A@@@A@@@@@@@AB@@@@@@@@A@@@@@AB@@@@A@@@A@@@A@@@@@A@@@@@@@@@AB@@@@@@AA@@A@@@@@@@@@AB@@A@@@@@@@@@A@@@@@AA@@@@A@@A@@@@@@@@@@@BAB@@@BA@@B@@@@@@@@@BBB@@@@@B@@B@@B@B@@@B@@BB@@@B@@@@@B@@@B@B@B@@@B@@@B@B@@@B@@B@@B@@@B@@@@@@@B@@@@A@@B@BA@@B@@AB@@AB@@@BA@@@@@@B@@@@@B@B@@@B@B@@@B@@@B@@@B@B@B@@@B@@@@@BB@@B@@@@@BBB@@@B@B@@@@@B@@@@@B@@@B@@AB@@@BB@@B@@@B@B@@@B@@@B@@@@@B@@@@@B@B@@@B@@@@@@AB@@A@@@@@@@A@@B@@@@A@@@@@AB@@A@@@@@A@@@@@AB@@@@A@@@@B@@@@@@AB@@@@@B@@@@@@@@@@@B@@A@@@@BA@@@A@@@A@@@@A@@@AA@@@@AA@@@@AA@@@@@@@A@@@A@@@AB@@@@AA@@@@A@AA@@@@@@@AA@@AAA@@@AA@@A@@@@A@@@@@A@@@@@A@@@@AA@@A@@A@@A@@AA@@A@@@@AA@@@A@@@@@@AA@@@@@A@@@@@AA@@@@A@@AA@@@AAA@@@A@@A@@@@@BA@@@A@@@@@AA@@@@AA@A@@@@@@A@@@@BA@@@@@@@A@@@A@@A@@@@AA@@@@AA@@@@@@AB@@@@A@@@@B@@A@@@@@A@@@@@AB@@A@@@A@@B@@AA@@@@A@@@A@@@A@@AA@@@A@A@AB@@@@@@AB@B@@@B@@@BB@@B@@@B@@@D@BA@@B@DA@@B@@@B@BAB@@@B@BA@@B@@@@@B@@A@@@@BA@@@@DA@@B@@@B@@@@@B@@@B@@@@@B@B@@@B@@@B@@@B@@@B@@AD@B@BA@@B@@@B@B@@@@@@@B@@@B@@BB@B@B@BB@@@@@@BA@@B@@@@@@@B@@AB@@@@AB@@@@@BAB@@@@@B@@AB@@@@A@@@@AA@@A@AA@@@@A@AA@AA@@@@AA@A@@AA@@@@AA@@@@AA@@A@@AA@@@A@@@@@@A@@@@@A@A@@@A@@@A@AA@@A@@A@@AA@@A@@@AAA@@@AA@@@@AA@@@@@AA@@@@@AA@@@A@@@A@@@@@AA@@A@@@@A@@A@@AA@@@AA@@A@@A@@A@@@A@@AA@@@A@@@A@@BA@@@A@@AA@@@@@AA@@A@@A@@A@@@@A@@@@@A@@@A@@@A@@AA@A@@@@@@AA@@@@AA@@@@A@@A@@AA@@@A@@@A@@B@@A@A@@@A@@AA@@@A@@BA@@@A@@BA@@@A@A@AA@@A@@@A@@A@@@@A@@@@AA@@@A@@A@@A@@A@@@A@@@@@A@@@@A@@A@@A@A@@@A@@@A@@A@@A@@A@@@@@A@@@@@AA@@AA@@@@AA@@@@A@@@@@A@@@@@@@AA@@A@@@@@AA@@@@@A@@AA@@@@@@@A@@A@@A@@@AA@@@@@A@@@AA@@@@A@@@A@@@@@A@@A@@@@A@@@A@@@@@A@@@A@@@@B@@A@@BB@@B@@@B@B@@@@@B@@@B@@@@@B@@@@@B@B@@@@@B@@@@@B@@@@@BA@@@@@@B@@@B@@@B@@@@@B@@A@@B@@@@@B@@@@@B@@@@@B@@@@A@@@@B@@@@AB@@@B@@@B@@@@@@@BA@@@@@AB@@@B@@@@AB@@@@A@@@A@@@@@AA@@AA@@@@A@@AA@@@@@A@@A@@A@@@@@AB@@A@@@@A@@AA@@A@@A@@@AA@@@@@AA@@@@AB@@A@@@@@@@A@A@@@@@A@@@@@AA@@A@@@AA@@@@A@@@AA@@AA@@@@AA@AA@@@@A@@A@@@@@@@AB@@@@A@@@@@A@@@@@AA@@@@@@A@@@@@AB@@@AA@@@@AA@@@@AA@@@@AA@@@@AA@@A@@AA@@A@@A@@AA@@@A@@AA@@@AA@@AA@@AA@@@@@AA@@A@@@@@@@A@@@@@@AA@@@@@AA@@A@@@@@@A@@A@@@@@@AA@@@@A@@@@@@@@A@@A@@A@@@A@@@@A@@A@@A@@@A@@@A@@@A@@@@@A@@AA@@@A@@A@@A@@@@A@@AA@@@@@@@A@@A@@@@AA@@@A@@@A@@@@@A@@@A@@@A@@AA@@@A@@A@@A@@A@@@@AA@@A@@AA@@@@@@A@@@@@A@@@@@@@A@@@@A@@A@@@@@A@@@@@@@A@@@@@@BA@@@@@@@A@@@@@A@@@AB@@@@A@@@@@A@@@@A@@@@@AA@@@@@@A@@A@@A@@@AA@@@@@@@@@@@A@@@@@@B@@A@@@@@A@@@@@A@@@@@@@AB@@@@@AA@@@@A@@AA@@@@A@A@A@@B@BA@A@A@@@A@@AA@@BA@ABC@@BA@@@AB@@A@@@@A@@AAA@@@A@@@@@A@A@@@AA@@@A@@@@@A@@A@@@A@A@@A@AAAA@@A@@@AA@@ABA@@@A@@@AB@@@@ABA@AB@@A@@@A@@@AAA@A@@@A@@@AAA@@A@AA@@@AAA@A@A@AAA@AAA@AA@@AA@@AA@A@@@A@@@ABA@A@@@@@A@@AAA@@A@@AA@A@@@AA@@@AA@@@@AA@@@A@A@@@@@A@@@@@A@@BA@@@@@A@@@@A@CA@@A@AAAA@@@AA@@A@A@@AA@@AA@@A@@@A@A@A@@BA@@@A@@@AAA@@@A@ABA@@@A@A@A@A@AAA@A@A@A@A@A@@@AAA@@AA@A@A@@AA@@AA@@@AAA@A@AA@@A@A@@A@@A@AAA@AAA@@AA@A@@@A@A@A@@AA@@@A@@@@A@A@AB@@A@@BA@A@@BAA@@A@@@A@A@A@AA@@A@@AA@@@@ABA@@BA@@@@@AA@BABAD@@CBCFOLI@WI"],encodeOffsets:[[128569,41910]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("大连",{type:"FeatureCollection",features:[{type:"Feature",id:"210202",properties:{name:"中山区",cp:[121.644926,38.918574],childNum:8},geometry:{type:"MultiPolygon",coordinates:[["@@B@@BB@@@@@@A@@@@@@@AA@A@@@A@A@@BAB@@@@@BB@@@@@BA@@@AB@@@@@"],["@@B@@@@@@@@@B@@@@AB@@@B@@@@@@@@A@@@@A@A@A@A@CB@@@@@@BB@@B@"],["@@B@@@@A@@@@@@@@B@@A@@A@@@@A@A@@@@@@A@A@@@@@@@AFB@@@BB@@"],["@@@@@@@@@B@@BBB@@@@BB@@@@@BAB@@A@@@A@@A@@@A@@@A@@@@@A@A@"],["@@@@A@@@@@AB@A@@A@@@AB@@@@@@@@A@@@AA@B@@@@@BABADA@ADA@CB@@A@AB@@@@@BBBAB@@ABA@@B@@A@@B@@@@B@@BB@@@@@@@@@@B@@@@@@@@BB@@A@@@@BA@@@@B@@@@@B@@@B@BBB@@ABCA@@@@@@@@@BB@@BBB@@@@CBE@BD@@@@@@A@@BB@B@BBB@DB@@B@@BB@@B@@B@DDB@@@DBBBBBB@JDBBDBN\\HABAD@TEFA@@BAAGBCDCFAHCQHG@U@A@ACEAA@A@AAA@A@@A@AAAAAAEGAA@A@@@ABABA@A@CAA@@AAAA@A@@AA@AAA@@BA@AA@@@@@A@@@@@A@@@A@@A@@@@A@@@A@@@@@@@AA@@@A@@@@@@@@@A@@@@@@A@@@@@@@BA@@@@@@@@A@A@@@@A@@@@@@AAA@@@@@@@@AA@@@A@@@@@@@A@@@@@@A@@A@@@@@@B@@@@@@@@@B@@@BAB@@GAA@AD@B@BABBB@BA@A@@@@@AAA@A@@BDB@@@@@B@@A@@BA@@@A@@A@@@AA@AA@A@A@@B@B@BA@CAAC@A@@AA@@AA@@A@A@A@@AAA@@@@@A@@B@@@@A@ABA@E@A@@@A@@@AAC@AACA@@@@@@A@@@@@@@@@A@@@C@@BA@@@A@@@@@A@@@A@@@A@A@C@A@A@A@@@A@@A@@@@A@@B@@A@@@AA@@@B@@A@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@@@@AAA@@@@@@@@@A@@@@@@@AD@@@B@@@@@@A@@@@@@@@@@@@@@@EAA@@@A@@@@@@F@B@BB@BD@@DD@@@@B@@B@@@@BB@@BB@@@@@@@@@@@@@@BDBD@@@B@@@@B@BAB@@@@BB@@@@@B@@@B@B@BB@@B@D@B@@@B@@@B@@@@@@B@@@@@BB@@BA@BB@@@@@@@@@B@@@@@@@B@@@B@@@B@@@@@@A@@@@@@@@@@B@@@@@@A@@B@@"],["@@BBB@@@@@B@B@@@@@B@@@@@B@B@B@@@B@B@@@@@@A@@@A@@@@@@@@A@BA@@@@A@@A@@A@@A@@A@@@@@@@@@@@@@@@A@@@@A@@A@@@@@@B@@@@A@AB@@A@A@A@AB@@@@@@@B@@@@A@@@@B@@@@@@B@B@@@"],["@@@AAACAA@A@G@ABAB@B@D@D@HB@D@B@B@DADEDA@C@A"],["@@@B@@@B@BAF@B@@A@A@@B@@@@@B@@B@B@B@BB@AB@B@B@@@@@@@BBB@@@@@@@@B@B@B@@AB@@A@EB@@A@A@@@A@@B@@@@BBB@B@DB@@@B@BA@@BA@@@@@@B@@DB@@@@B@B@BA@@BB@@B@JA@@B@@A@@@@@@@A@@A@@@@A@@@@@@@A@@@@AA@AAACCA@@A@A@@@A@@@A@@B@BA@@@@@AB@@CB@@AB@@ABAB@BA@A@@A@@AA@A@@@AAAAAA@A@@@@A@@A@@@@@A@@@@@@A@@@@AA@@AA@@@@@AAA@@@@@AB@@A@@B@@@@BB@@@@AB@@A@C@A@@@@BA@@@B@@@BB@@@@@@@B@@A@ABA@@@@B@@@@D@BBB@"]],encodeOffsets:[[[124549,39785]],[[124640,39820]],[[124542,39790]],[[124534,39769]],[[124568,39824]],[[124756,39852]],[[124743,39803]],[[124751,39807]]]}},{type:"Feature",id:"210203",properties:{name:"西岗区",cp:[121.612324,38.914687],childNum:1},geometry:{type:"Polygon",coordinates:["@@AC@@@@@@@@@@@@@@AA@@AA@@@@@AA@@@@@CC@@ACA@@A@A@E@@AB@@@@A@@@@@C@@@A@A@@@AAA@A@@@@@A@@BA@A@@BAB@BA@A@@@A@@B@@AB@BA@GDA@@F@@AB@B@@@B@B@@A@@@@@A@@B@@@@@@@@@@@@@@@@@@B@@B@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@BB@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@A@@@@@@A@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@@@@@A@@A@@@@@@@@B@@@@@@@@@@@@@@@B@@@BB@@@@@@@B@@@@ABAAA@GA@B@@@@@BA@B@AB@@@@@B@@@@AB@@A@@@@@AB@@@@@BB@@B@@@@B@DB@@@@AB@@AB@@@@@@AB@B@@AD@@@@A@@@@@@@B@DBDB@@@@@@@@@@@@B@DD@@@@@@@@@B@B@@@BAD@B@@@@@@@@@B@@@@@B@@@@@@@@@B@@@@@B@@ABE@@@@@@@@BA@@B@@@@@B@@@@@@@@@@@@A@@@@BA@@@A@ABA@A@@@C@@@AB@B@@@@EF@@@@@@A@@@@@@BA@@@A@BB@@B@@B@@B@BDBBB@@@@BB@AB@BB@B@B@B@@@ADB@@@@BAB@BBB@@@B@B@@@@BBB@BAB@B@@@B@DB@@RBL@@A@ABCBGBEBAB@BA@@F@HDhPF@D@D@M[CAAAICA@AAAACA@@A@CCA@@@@AA@@AA@@@CAA@AAA@A@@AB@@@@@@@ACF@DA@@@@AA@AA@@A@@@@@@@@DBBA@@AA@A@A@@@A@@@@@A@@B@@A@@B@@@AA@@@@@@@@@A@@@@@@@@A@@AA@@@@@@AB@@@@AB@BA@@BAAA@A@@@@BAB@@@DAB@BCB@BCBA@A@@@@@ABB@@B@@@@@@@@@BA@@B@@@@BBA@@@@B@@@@@@AB@@@@@@@@A@@@@@@@@B@@@@@@@@A@@@A@@@A@@@@@@@A@@@@@@@@AAB@@AA@@A@@@@@A@@@@A@@@A@@@A@C@A@@@AAA@A@@@A@@@@@A@@A@@A@ABA@@@@@@A@@AC"],encodeOffsets:[[124554,39810]]}},{type:"Feature",id:"210204",properties:{name:"沙河口区",cp:[121.594297,38.904788],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@AAC@A@A@CFA@ABA@ABAB@@@@@@AA@@@@@@@@@B@@@B@@A@@B@@@@A@@BAB@@A@@B@@@@@@@@@B@@@B@B@@@B@@@@@@@B@@B@@@@BB@@B@@@@B@@@@B@@@@@B@@@B@@@@@B@@B@@B@@@@B@@@@@@DB@@A@@B@@@@@D@A@@B@@A@@@@B@@@@@@AB@@@@@@@BA@AB@B@@@BA@@B@@B@B@@@@@@B@@@@@@B@@B@BABA@@B@B@@BBA@@BB@BAB@@@BBBBBB@@@@AB@@AB@@A@@B@@AB@@AB@@AB@BA@@BFB@@@B@@@@@@B@BD@@B@@B@@@@@@B@@@@@BB@@@@@@BBB@@@@@@@B@@@A@@@@B@B@B@@@@@@@@AB@@@B@@@@@@B@@@@A@@B@@@DA@@@@@@BAB@B@BAB@@BBB@@@@@@AD@@@@@@@@@B@@@BE@@B@BD@AB@B@B@@@@ABA@@B@@@@@@@@@BC@@@@@@@@@C@A@A@@BA@@B@@@@@@@@@@@@AB@@A@@@A@@@@B@@A@@@@@@B@@@@@BA@@@@B@@@@@B@@@@B@@@@B@@@@@@@@@B@B@@BB@BJ@DA@@F@BB@@D@B@B@B@B@DBB@@@@@B@@B@@B@@@B@@@@A@A@A@ABAB@HDB@@@@@B@@@B@@@B@@@@@B@B@@B@@BA@@@@B@@@@BB@D@@@B@B@B@B@@@BAB@BA@@@@B@B@B@B@@BB@@B@@BB@@B@@@B@@@@BB@@@@@@@@@D@AA@@@@@A@A@@AA@ABA@A@@A@BC@@A@A@A@A@@ABAA@@A@@A@AAACA@@@@AA@@@AAB@@@B@@A@@@@B@@@@@@@FE@@@@@ABA@@D@@@B@B@BAB@@@B@@A@@B@@@@@@@@@@@@@@A@@@@@AB@@A@@@@@@F@BA@@@A@@@@@A@@@@@@@@@A@@@@@A@@@@@@@@@ABC@A@@@A@A@@@@@@@@CCA@@@@@@@@@@@@@CACAA@@@@@@@B@@@@@BC@@@ABA@@@@@@BA@@BA@@@@CAA@@@@@@AA@@A@@@@BA@@@@B@@@BA@@@@@A@@@@BAA@B@@A@@@@@AHBB@BBBA@@@@@A@@@@@@AA@@A@@@@@@@@@@@@@@@A@@@@@@@@@@BB@@@@@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@B@@@@B@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@A@@A@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@AA@@@@@@@@@@@@@@@@@@@@AB@@@@@B@@@@A@A@@@ABA@@@EEBE@EBG@A@KBQAE@C@ABABA@A@A@AA@@@AACAC@AA@CCAAC@C@C@CAA@"],encodeOffsets:[[124468,39798]]}},{type:"Feature",id:"210211",properties:{name:"甘井子区",cp:[121.525466,38.953343],childNum:11},geometry:{type:"MultiPolygon",coordinates:[["@@@BB@@@B@@@B@@@BA@@@AA@@@A@@@@@@B@@A@@@A@@@@@@@"],["@@B@@@@@B@@@@@@@BAA@@@@@@AB@@@@A@@@@@@@@A@@@A@@@@@ABAB@@@@@B@AB@@B@@"],["@@A@@@@B@@B@@@@B@@B@@@B@@@BA@@@A@@A@@AA@@@A@@@A@@@@B@@B@"],["@@B@B@B@@@@A@@@@@@AACAA@@@@@AB@BB@BB"],["@@@@B@B@@@B@@@@@@@BB@@@A@@B@@A@@AAC@@@@AA@@@AB@@A@@B@@BB"],["@@B@@ABA@@@@@A@@AAAA@AA@@B@@@@@@@B@@@@@B@@B@@@A@@B@@@@@@@@@B@B@@@@B@@@"],["@@BD@@@BB@B@@A@AB@@A@@B@@@@A@@@@@A@@A@@AA@@@A@@BA@@@A@@@@@@B@@@B"],["@@B@@@B@@@@@@@@@AA@A@A@A@@@A@@AA@@@@@A@@A@@B@@A@@AA@@@@@ABB@@BA@@B@@@@B@@BA@@@@@@BB@@@B@@BB@@@@BB@"],["@@C@C@CB@@@B@@A@@B@@BB@@D@B@B@BBB@D@BABAB@F@@@B@@A@@A@G@@@@@@A@AA@@AA@@@CB"],["@@C@ABCB@BABBDBDBBDBD@BBDBBBD@BAB@DCBAB@B@B@@C@A@CAAAAC@C@@@@BABA@A@AAAAECA@"],["@@@AA@A@@@@@A@@@A@@@A@@@@@A@GCA@AB@B@B@B@B@@A@@@A@@@@AA@@@@@A@CAA@A@A@A@C@@@AAE@@@CBI@@AAA@@@A@A@@@@@@@@@A@@A@@@@@@A@@@@@A@@B@@A@@@@@A@@@@B@@@@A@@B@@@B@@@BA@@@@@@@@@@@@@AB@@AB@B@D@@@@@@@@@D@@A@@@@@@@@@AB@BA@@@@@A@ABAC@@A@AF@@A@@@A@@@@@@@@BC@@@@@@AA@AA@ABA@A@AB@@@@@@CB@@A@@@@B@@A@@@@@@@@A@@BA@@@@@@@@@A@A@A@@B@@@A@@@@@@@A@AA@@@@@@AA@@@@A@@@@@@@@AA@@@ACA@@@@@@@@A@@EA@AB@@ABA@@BA@@BA@@@AB@@@BA@@BA@@@@AAAAAA@@A@ABA@@AB@AA@@@A@AB@BA@A@AA@@@@@@@@A@@@@A@A@@@@AB@@A@@@ABAB@@A@@@@@@BA@@@@@@@A@@B@@@@AB@C@@@@@A@@@@BA@@C@@@@A@@@@@@AA@@@@A@@@@@A@@@A@@@@@A@@A@@@@@@AA@@A@@A@@@@A@@@@@@@A@@@A@A@@@A@@@@@@@@@AB@@@BA@AB@@@@@@AB@@@@A@@@A@@@@@@@@BB@@@@@@BABAB@BAB@DEJ@BABCACAAEA@CCCEKAAECK@IEAACKACACEGEEGECCCA[GA@C@AB@DBBDD@B@B@BC@ABCAGASE@B@B@B@@@B@B@B@@@@@@@BA@ABAB@@A@@B@@A@@B@@@BA@@B@BA@B@B@B@B@BBB@@@B@@BB@@@BB@B@B@B@BA@C@@@@@AB@@@@AD@@C@@B@@@B@D@BEDAB@@@@AB@BBB@@@@@BBD@B@B@@@BBB@B@@BB@@@B@@@@@B@@@@@D@B@B@@@@@BA@@@@B@@CA@@@@AB@BA@A@@@A@AAA@A@@@@B@@@@A@A@A@@@@@A@@B@@@@A@@@@@@@A@@B@@@@A@@@@@A@@@ABA@@B@@A@@@AB@@B@AB@@@@AB@@A@A@A@@@A@@@A@@@@BB@DB@@B@A@@B@B@@@@@@A@AA@@@A@@AB@@@BA@@BAB@BA@@B@@@B@@A@@BAD@@A@@BA@A@A@ABA@@B@@CB@@@@@@ACA@A@@@A@@@@@CDA@@@@B@@@BA@@BA@@B@@B@@B@B@@@@@B@@@B@@A@@@A@@@ABA@@@@@A@@@@@@@@A@@A@ABA@@@@@AB@@@@@@G@AAA@@@@AA@@A@@A@@A@@@AA@@@@@AA@@@@@@A@A@@@C@@@A@@@A@AD@@A@@@A@@@AB@@@BA@@@A@@@A@@@C@@@@@@@AB@@A@@@E@@B@@@@@D@@@B@@BB@@@@@@@@@@A@@BA@@@@@@B@@@B@@@@@@@@A@@@A@@@@BABA@@BC@A@@BA@@@@@@BB@@B@B@@BB@@@@B@@B@B@@@@@B@@@@@@@@AB@@@F@@@B@@@@@@A@@@C@A@@@A@@@@@@@AA@@@BA@A@@@AB@@AA@@@B@@@@A@A@ABA@A@AB@BA@@@@@@BBB@@@B@@@@@D@@@@@B@@@B@@@B@@@@@@AB@@A@A@@@A@CBC@A@@@A@@@AB@@A@A@A@@@A@@@@AAA@@@AA@@@@@@@BB@BB@A@@@@@AB@@@@A@@B@@A@@B@@@@@@@@A@@@A@AD@@@D@@@@@@@@@B@@@@AB@@@@AB@@BB@@@@@@@@@@AA@@@@ABB@@@@@@B@@@@@AA@@@@@@@@@@@A@@B@@@@A@@@@AEE@@A@@@@@@@@B@@@@@@@B@@@@@@@@B@@B@@@@@@@BA@@B@@@@A@DB@@@@@@AB@@@BA@AB@@@@@@A@A@C@@@A@@A@@@@A@A@@@@@A@@@A@C@A@@@A@AB@@A@@@@AA@A@@@@@AA@@@@@AA@@@@A@@A@@@@@A@A@@@@@A@@@A@@@@@@A@@AA@@@@A@@@@@@@AAA@@@@@@A@A@@A@@A@@ABA@EB@@@@A@A@E@@@A@@@@@@@A@A@AAA@@@A@@@AB@@A@A@@BA@AB@B@F@BAB@@@BB@@@B@@@BBBD@B@@AD@@B@@BB@@BB@@BBB@@@@A@A@@BA@AB@B@@A@A@@BAH\\BB@P@FADAFCBAD@B@BBPBF@@@DBBDDDJDF@FDBDBB@D@DEXADA@ABE@QCC@EDADCLADBFBDFBDAFAFDBDBH@DDDDBLBD@DDADCD@D@DFFFDHDJAJ@FBDFDPDFFDHBL@FCBGDGDEhUDCBEBEBCDAB@ZAF@HBHBF@`FDADAB@BEDCF@lHLDRDLHj^NHPFVJHNHFJBTBHDFFVXJV^ZNHXBNIAI}CG@CBADA@@FDHFHBH@JAJ@HDBB@BAH@BDBNFFGBCB@BCBADCA@BAB@BAFB@@DCBA@@FFBBB@BBB@@@B@@BB@@@@@D@@@JD@@@@DDDBBB@@BA@@@@DA@@@@@@@ADA@@@@@@BBDA@@@A@@@@@@B@@AB@D@@A@@@@B@B@D@F@@AB@@A@A@@@@@@B@@A@@B@B@@@@A@@@@A@@@@@AA@@A@@A@@AA@@AABAB@@A@C@@@A@@@@A@@@BA@@@@B@@@@@@@BADA@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@BB@@@@B@@@@@@@@ABA@@@A@@@@@AB@B@D@@@@@@@@@AE@@AE@C@A@ABCPOJC@@BABAHCLCLCLAJAJCAEBGHEFEBCAEECoMGAK@IBWBKBADDDDDADGBCBA@AA@A@C@AAA@AC@GBGBC@@@IAEDEDA@ABA@C@ACCECAE@K@A@AABADALCVCDC@EAI@A[iAAA@A@C@A@IEAAAC@AA@A@OBC@CA@AAA@EAACACAE@C@G@EAK@QA@@CAA@@@A@A@ABA@C@@@@@@@@@A@@A@@A@@@A@@@AA@@@AA@@AA@A@A@A@@@@@ABA@AB@@A@A@A@A@@@C@A@@A@@A@@@@@AB@@"]],encodeOffsets:[[[124457,39755]],[[124096,40033]],[[124450,39753]],[[124519,39728]],[[124241,39937]],[[124446,39754]],[[124438,39756]],[[124224,39938]],[[124141,40044]],[[124072,40023]],[[124493,39882]]]}},{type:"Feature",id:"210212",properties:{name:"旅顺口区",cp:[121.261953,38.851705],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@@B@BB@B@BABABAB@BA@@@@@@A@@A@@AA@@AAAB@@ABB@@B@B@@AB@@@@CA@@@B@@"],["@@DBDBB@B@DABAD@BABA@@AAABE@C@C@CAAAC@A@A@AB@BA@ABB@@@@@B@HB"],["@@FBNEJG@ABEAACEC@GBIFCH@DABBF"],["@@YBcBQ@KBEBCB@DE\\EXAHELGHOTAHBHDFHDHBHBFF@LGNIJGPCHAJC\\AT@JBLDHDFHDTL@BBB@B@BABABCB@B@BBDAB@DCBG@aBIBGBIHCHALBLDRFLJLJFJFJFHHDJFFFFHDJ@v@lBNCLCHEFGFMBABAF@J@R@D@DBDBFNDFFFBBF@FBLBL@TDLDDDDFDJDBFB@@BG@AB@B@@@@ABAB@@AB@B@@@@@AA@AA@@AA@@AA@@@BC@@@AACAA@@A@@@A@@A@@BA@A@E@ABAB@@AB@B@@@BA@@B@@@B@BBB@B@@@@@@@B@@@F@B@B@@@@@FAB@BA@@@BB@@@@B@B@@@@B@BB@@@@@@B@@@@@BB@@@B@@@@B@@@B@@@@@B@B@@@@@B@@@@B@@B@@B@@@@BB@@@@B@B@@B@@B@@@BAB@@@B@D@B@@@B@@@@@B@B@@@@@@BB@@@D@B@B@@@@@@@BAB@@A@@BA@@@@@@CAB@@@@@@AB@@A@@@@@@@AA@@@@@@@@@@A@@@@@@@A@@@@@@B@@@FF@B@@B@@@@@@AB@@@@@@@@@@@B@@B@@@@@A@@@@A@BA@@@@BB@@@@@@@@@@AA@@BA@@@@BA@@@@@A@@@@@@@@@C@@BCB@@@B@@@@@@@@@@AB@@@@AB@@@@@BA@@@@B@A@@AAA@@@@@@B@@B@@BB@B@@B@@@B@B@B@@@BA@@B@@@B@D@DAB@@@B@B@@@BA@@@@@@@A@@@A@@@A@@@@@C@@@@@A@@AA@A@@@@B@@ABAB@B@BAB@B@@@@@@A@@BB@@BA@@B@B@@A@@BB@@@@@@B@@@B@D@@@B@@@@@@@@A@@@E@@BA@@@@@@@@@A@@@@@A@AA@@@@@AA@@@A@AA@@A@@@@B@@AB@D@@AB@BA@A@@B@@@B@@@@@@@@@@A@@@A@@@@B@@AB@@@@@@@@@@@AA@@@A@@@C@@@@@AF@@@B@@@BA@@@@@@D@@@B@@@B@@@B@@A@@BA@@B@@@B@@@BCB@@@B@@@D@@@B@B@@@@@@@BB@@@@B@@B@@@BB@@@@BB@@B@@B@BBH@@@@@@@BA@@@@B@BAB@@@@B@@@@@@B@@@@@B@BA@@B@@@B@@@@A@@@A@@@@@A@AA@@@@AB@@AB@@A@@@A@@B@DC@@@@B@@@B@B@BD@@@@@@DA@@@AB@BAB@B@B@@AB@@@BC@AB@@@@A@@@AB@@ABA@AB@@A@@BA@@@B@@BBB@@@@@@@@A@AB@A@@@CAA@@A@@B@@@B@@@B@B@B@@@BA@@@@BAA@@@BA@@B@@@@AB@BA@@B@@@@@B@@@@@@AB@@@@@@@B@@@@@@AB@@@@@B@B@B@@@@@@A@@B@B@BBB@@@B@B@@ABA@@@@DB@@@A@@B@@A@@@@@A@A@C@@@@@A@@@@@A@@AA@@@AAA@A@@@A@AAC@A@@@@AA@ABA@@@@BAFC@A@C@A@@@AD@@@BC@@@@BA@@@@D@B@@A@A@A@AAA@@A@@AA@@@A@AAA@A@A@A@B@@A@AB@@A@@@AB@@@@AB@@@BABAB@@A@@@@@@@A@A@A@@@A@A@AOEE@G@G@KBE@E@GAE@KGCAC@QBkBMBIDSFSDODKAA@GAQCICCAECCC@A@E@C@AACACUOKEIAE@EBEBEAE@CAGAGAKAKAIBG@CAQSCESQECQICAACACAG@GCGEGCEIIIGKGIAK@I@"]],encodeOffsets:[[[124030,39815]],[[123937,39805]],[[123880,39896]],[[124092,39650]]]}},{type:"Feature",id:"210213",properties:{name:"金州区",cp:[121.782655,39.050001],childNum:13},geometry:{type:"MultiPolygon",coordinates:[["@@B@@@@@@A@@@@@@A@A@@@@B@@B@@@"],["@@A@@@@@@@@@@@@B@@B@@@@@@@BB@@@A@@@@@@@@@A@@@@@@A@@@@@@@@@"],["@@B@BB@@@@B@@@@@A@@A@@B@@@@@@AA@@@A@@@AB@@"],["@@B@B@@AA@@@@A@@A@@@AB@@A@@@@BA@@BB@B@@@BA@@"],["@@@@@AA@@@A@A@A@@@@B@@@@B@@BB@B@@AB@@@"],["@@@@@@@BB@@@@@@A@@@@@AA@A@@@@@@@@@@B@@A@@A@@@@@@@@AA@@@BA@B@@@@B@@@@@@@@BB@@@@@@B@@@BA"],["@@@@@BDB@@B@BEA@@AED"],["@@B@DB@AB@@@@@@A@AA@BA@AA@@A@@A@AB@BAB@@@B@B"],["@@@BB@@BB@BABBBA@@BAB@B@@@@A@@A@@A@@@A@@@@@A@@A@EBCB@BA@@B"],["@@@@ABCB@B@B@@ABA@ABA@@@@B@@@@@B@@@BB@BB@BB@D@B@@AB@BBB@B@BB@B@BB@BBB@B@BABA@ABA@CA@@A@B@@A@@@A@A@@@@AA@A@@AA@AA@CAC@A@AA@A@"],["@@AAAA@@A@A@@@A@@B@@@@@@DD@@@@AB@@A@@BA@A@@@A@@@A@AB@@@@@B@@@@@@A@A@@@AB@@@@@@@BBB@B@@@BA@@@@@A@@@@@@B@@@@@BB@@@@AD@D@@@B@BA@@BA@@B@@@B@@B@@BAB@BA@@B@@@B@@@@@B@@@BBBA@@@@@B@@B@@B@@@@@A@@B@@A@@@A@@@@@A@@@@@AAACA@@AA@A@@CA"],["@@C@A@CBCD@B@B@B@BCBCBCBCDEFA@CBA@@@A@@@@@CBA@AA@@AAA@@@GF@@@@BBB@B@D@B@DA@@DBB@B@B@B@B@BBB@BA@@BA@CBCBADADADAD@D@DABABCBABA@A@@BA@@@A@@A@CAC@"],["@@IBQDEBGACCIDIBKBKDKDGDABAB@@IDOPAD@B@B@DBF@@BF@@@@@@@@C@A@A@@B@@@@@B@@AB@B@@@@@@A@@@@@AA@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@CBAB@@@@@@A@@@@@AB@@B@@@@@@B@@@D@BA@ABBB@@BB@@@BB@@@BB@@@@B@@@@@@B@@A@A@@@@BA@@@@@@@@B@BA@@BE@C@A@A@@@@@@BC@A@@BA@@@@@@@@B@@CBAA@@@@@@CB@B@@@@@@CB@@@@AB@@AACACC@@@@IC@@C@@@@@A@@AA@@@A@AAA@AAEE@@ABCD@@EAABA@ABB@CDABADA@ADEHZJd`FFJHBHKFCBEDEJA@CBCFAF@D@F@DABCBA@A@AAC@A@A@A@ABCT@@A@@@C@AAA@AB@@AB@D@D@B@BGDI@MAeBI@GFERIPCDCDAH@HBHDDHDNDBD@BCDMFCDCFAHBDDFHBHBH@VAdGB@@B@DABOHADBDBBDBF@LGDBDBFD@DABCDC@A@E@A@ABC@A@AACAC@ABAACBADGNEDEDGAECEIAAC@CBADGX@HBJDHFDHBH@VAH@F@BDBHBDF@^IFCF@FDJFF@FAHGDCDAH@LDPJ@BBD@FCFEDE@SEEBEBAH@DDDDBDD@DAF@FDBFBNHfRJBTOHAFFB^CDMD@HHLNPDJ@F@FEBICIEMKYIACACECG@GBM@_IiKSQIC¥Id¦A@D^FDT@J@BFADKFABGHFJHHHHAFGRABABCBC@CACAAB@@@BBD@BA@C@A@CAAACBC@CFCDEBCBA@@DA@@@C@A@CAABABBBDDFBBDBDJLJHPLHHB@HJL@BBB@@BCBAD@@@DFFFHJHLHDBB@D@F@DAHBF@B@B@B@DCD@BAD@B@B@BA@CBC@@@AB@B@B@B@DCBAB@B@B@@@B@D@B@D@B@JBHBBB@@@@BB@H@DBDBB@BED@B@@BDDBBBB@ADBB@BBBD@BBB@B@B@@@B@B@B@B@B@B@B@B@B@B@DBDFDBBBBBDBBBB@B@@BB@DAB@D@BBB@DBBAB@DCBAH@BABE@AB@B@B@BBDFBDDBBB@@B@B@@@@B@@AB@B@@@D@@BB@@BB@@B@BBB@BBBDBDDFBFD@LBFBF@B@D@B@B@@CBAB@DABA@@@C@CB@B@FCFCBCB@B@@BBBB@@@B@BE@CB@DD@@B@BA@AB@@AB@B@BBBDBB@@B@B@B@BDDJDHBBB@@BBAHAHC@A@A@@BAB@B@FDD@BBB@DBB@B@BA@@BA@AAAA@ABA@ABA@A@@A@ABABAD@BAB@BB@@B@B@@@@AAEGIECEE@@AA@ABA@@D@B@D@D@F@F@FAF@B@DB@@BBA@EDA@@B@BHFBBBBBBB@@@BABABA@A@CAA@ABABADABAB@B@@@B@B@B@B@BADABAB@B@B@D@@ADAB@B@B@@@B@B@BABABAB@@@BABCDEBE@A@C@@BAB@B@DFDFDD@BBBB@@@B@B@B@B@B@@@@D@B@@BBB@B@BA@AAA@ABA@AB@BA@GE@IAECAEGI@C@CBCBA@CBABA@C@CACCCBEBA@A@A@ACCA@@A@CA@@@@@@@AA@@@@A@@C@@@EBKA@@AB@IIC@EADEBCDAB@FAL@F@DAFEBADCBAB@B@D@FAH@JKFIDE@CGAE@M@e@BGP@FIOA@JM@AKGDEDG@DEWOUPKKIEJSBCBABCDABA@@HCBABCB@EG@AB@CCAABABB@@@@B@@@BBBA@@B@@@DA@ABAHE@@@@@BB@@@@@@AB@@@B@@@FABAD@DBDBHAFBDBBDFBF@HAH@DAFAD@DEBCBG@CCCACACAEDBF@@@@@DABCBA@@D@@@@AD@@@NH@@@@@@B@@@@@@@@AB@@@@B@@@@@@B@@@@@@@B@B@B@@@@@@@@@B@A@BA@@@@DAB@@@B@B@B@B@@@B@@@@@B@@@D@@@B@@@B@@@BB@@B@@@@@@@@@@B@@B@@@@B@@B@@@@@B@@@@@@@@A@@@@@@@@@@@@B@@B@@B@@@@BA@@@@B@@@@@@@@@@BB@@@@B@@@B@B@@BB@@A@@@AB@@@AC@A@@@@@ABA@@@@@@D@@@B@B@@BB@@@@@@@B@@A@@B@@@@B@@B@@@@@@@@@@@@@@A@@@BBB@B@@B@@@@@AB@@@@B@@@@@B@@@@A@@BA@A@@@@@@@A@@@A@@BA@@@@@@@@@@BB@@B@@@@@@B@@@@@@@B@@@@@@@@B@@@@B@@@@A@@@@@@@BBB@@@@A@@BA@A@@@@@@B@DB@@@@BB@@@@@@B@@@BBB@B@@@@@@A@@@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@A@@@@AB@@@B@B@@@B@@@@A@A@@@@@@B@@@@AA@@@@A@@@@@A@@@@@@@@B@@@B@@@@@BB@@B@@@@@@AB@@@@@B@B@@@@@B@@@B@@@@B@@@@@@@@@A@@B@@@@@@@@@B@@@@A@@B@@@B@@@B@@@@@@@@@@@B@@@B@B@B@@@@@B@@@@B@@@@B@@@BB@@BB@@@@@@B@@@@B@@@B@@@@@BB@@B@@@@@B@B@@@@@@@@B@B@@@@@B@@@@@@B@B@@@@@@@@B@@@@B@@BB@@@@@@@@@@@@B@@@B@@@B@@@@@B@@@@@@B@@@@B@B@@@@BB@@@B@B@@@BB@@@@@A@@@A@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@BA@A@@@@@AB@@@@@BA@@@A@@BB@@B@@A@A@@B@@B@@@B@B@@@B@B@@@@@B@@@@@B@@@@BB@@@@B@@@@@@@B@@@@BB@@@@@@@@@@@B@@@A@@A@@@@@@@A@@@@@@A@@A@@@@@@@AA@@@@@A@@@@A@A@@@@@@BB@@@@@@B@@@B@@@@@@@@@@B@@@B@@B@@@@@@@@@@@@A@@@@@A@@@@@@@A@@B@BAB@@@@@@BA@@@@@@B@@@@BBBB@@B@@A@@B@@B@@@AB@@@AA@@@@@ABA@@@@@@@@B@@@A@@B@@@B@@@@B@@@@A@A@@@AB@@@@@@@@@@@@@@@@A@@@@@@A@@A@A@A@@@@@@@@@@@@@BB@B@B@@@@@BB@@@@@@B@@@@@@B@@@@B@@@@D@@@@@@B@@@@@@@@@@B@@@@BA@@B@@@BAB@DAB@@@@@B@@BB@@A@@B@@AB@@@B@@@@@B@@@@@B@@@B@@BB@@A@@BA@@@@@@B@A@B@@A@@@BB@@BB@@@@AB@@@@@@@A@@@@@B@@@BA@@@@@@@@@@@@B@@@B@@A@A@@B@D@B@@@@@@B@@@@B@@@@BB@@@@@@B@@@@BBBA@@B@B@@@@BB@@@@@@A@A@@@@B@@@@@BA@@@@B@@@B@@B@@@@@BBB@@@@DB@@BBBB@@B@@@@@@@@@B@@@@@BAB@@@B@@@@@@E@ABA@A@@@@@@B@B@@@B@B@D@@@@@BB@@@@@@@@@@@@@BB@@@BB@BB@@BB@@@@BB@@@@@BAB@@AAA@@@A@@D@@@@A@A@@@@@@B@B@@@@A@@@A@@BAB@BA@@B@@BDB@@BB@B@@@@@B@@@@B@B@@@@@@A@@@A@@@@B@B@@@@BBDDBBB@@@@@A@A@@B@@@@@@A@@B@B@@@@@B@@ABA@@@@B@@@B@@ABA@@@BB@@@BA@@@@B@B@B@@@@B@@@@DBB@BBB@@@@@BA@C@@B@@@@@B@AA@@B@@@B@BAB@B@@CB@BBB@@@@A@AA@@A@@A@@ABA@@@@B@@@@@@@@@@A@@@@@@@@@AA@@@@C@@@@B@@@@@@@@@@@@@A@@A@@B@D@@@@@@A@AB@@@@@BB@@@B@@@B@B@@@@AB@@@@BB@@@B@B@@@@BA@@B@@@B@@A@@@@@BB@@@B@@D@@B@DBD@@@@@DAB@BABE@A@@@@B@@@B@@@AB@B@@@@@@B@B@@@@B@BA@@@@BB@@@@@@@BA@@B@@@@@B@@A@@DA@@@@BB@@B@B@@@@@@BB@@@@A@@@@@@DA@@@B@@@B@B@@@@BB@@B@B@@A@@BAB@@@@@BB@@B@@B@AB@@@@@@@D@B@BB@@@@@A@@@@FBB@@BJ@BBD@@B@@BA@@@AB@BC@@BA@A@@@@H@f@FHBBBBN@@EBEEMM]O_GGIEUIWmOYIEAIEEEAAMWECIAY@G@EC@@AEBELEBC@EI]@G@I@@BEFC[FC@E@ECEGAcDKAIAQCMGMIKIKKOOKKEAGAoBGAA@ECAEAGDIFIfcDEAKEGEAI@VODE@E@cGICCEaCCG@G@jIFGHCDEBC@KGCE@E@ERCH@FERcBEACACECECGAEBOJADDHADGDEAQAG@qTIBE@ECAE@EHGjYNETINEJEBCAGEI@G@KDGFAPCJBDA@CKGGEOAMAIEk[ECI@mJMFGDAFBHFH@FCFEBMIK@OHCDADDJ@HALQbENAD@FBFADCBUF"]],encodeOffsets:[[[124506,40125]],[[124415,40183]],[[124961,39970]],[[124919,39901]],[[124588,40250]],[[125122,40107]],[[124594,40229]],[[124525,40194]],[[124530,40104]],[[124409,40135]],[[125233,40212]],[[124393,40128]],[[124703,39962]]]}},{type:"Feature",id:"210214",properties:{name:"普兰店区",cp:[121.938269,39.392095],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@CA@BA@@@@B@B@@@@B@@@@B@@AB@@AB@@@@BB@BB@@@@@B@@@@A@@@@@@@A@@@A@@B@@AB@@@B@@A@@@@@AA@@@@A@@A@"],["@@ABEDA@A@CCIGA@UBAB@BH^@BDBD@F@DADAB@B@NFB@B@FAF@B@BADKACAAGIGC"],["@@@G@A@OPADFDRDF@@@@HRPJHBL@D@XBFBBA@@@@@@B@@@B@@@@@@AB@@@@B@@@@@@@@@@@B@@@@@B@@@@@B@@@@@@B@B@@@@@B@@@@@AA@@@@BA@@@@@AB@@@@@@@BB@A@@B@@@B@@@@@BBB@@@@@@AB@DCB@@@@@@@B@@@@@B@B@@@@@BA@@B@@@@@@@@@@@@AB@@@@@@@@@@ADA@@@B@@B@B@@@B@@@@@B@BB@@@@@@B@B@B@DA@@BB@@@@@@@@B@@@@@@@B@D@@@B@@@@@B@@@B@B@B@DB@@@@@@@@@B@B@@D@TAB@D@B@B@BBB@D@B@HDBBB@DBD@D@DAFADAFAFE@ANBJBB@D@@AB@@ABC@@DAB@BB@BDB@@DB@@B@BCBA@@BABAB@B@BD@D@DBH@BBDDBBBDBDDBH@B@HBD@BBFFDBBBD@DBHBBDBBBF@DBBBDBDDFFHHFFBDB@BBDADAHCF@B@BBDDBFFFFDD@FABCFC@AD@BB@BDDDDFBDBH@JDHBFFDJJTC@KDIFC@A@A@A@CB@@ADABABC@ABC@CBABCDEHABA@A@CBA@A@C@@BA@@B@B@@@B@BBBA@AB@@AB@B@B@B@B@@@D@BA@ABA@ABCDCBEHAFAB@BABC@CDEF@B@D@D@F@DDFFDBDAD@F@BB@BBDD@B@HAJCFCF@B@DBDFFDF@DDHBDDDFFLFF@@BD@PDOPEDAB@B@DBB@J@D@D@@CBE@C@ABA@ABAB@BABE@EBABABC@A@AAC@A@C@ABCJ@DA@EBCAC@G@CBIB@@B@@@@BBB@@B@B@@AB@BF@BBBBBBB@B@@@BB@@@BB@B@@@@BB@@@@BB@@BB@@BB@BB@@@BB@@BB@B@@AB@@@B@@@@AB@@A@@@@@C@A@AB@@A@@B@@@B@@@@@@@@@BA@@@@B@@@@@B@@B@@BB@@B@@@B@B@@@@@@B@B@@@B@@@B@B@B@@BB@B@@B@@B@@@B@@@B@@@B@@@@@B@@@@@@AB@@@B@B@B@@@B@@@@@B@@@BAB@B@BB@@B@@@@@B@@@B@@B@@@B@@@D@BA@@B@@AB@B@@ABA@@BA@@B@@@B@@@BB@@B@B@@@BBB@B@B@BBB@@B@BBD@D@DBB@B@BAB@BABA@@B@@@B@FDV@hMRLEHKJQHADAB@L@@@@@@@N@D@NDTPNVJBBVPRTHXBN@JHRNJLHJ@L@LDRFPRRVDHDLBBRNTJZFTFP@HALAPOLKB@DA@@B@@@H@B@B@B@B@@AD@@@B@@AB@B@@@BB@@BBB@@@B@B@D@FB@@B@@@B@B@D@@A@@@@B@@@FBBBB@B@DBB@@@BB@@@@BA@@BAA@@A@A@AB@BA@@B@@@B@BB@BFB@@B@B@BBBBB@B@D@@BB@@@BAB@@@AA@@@A@AXI^KXKJEPIPGPENCFBFA@C@@BEB@@@@@BAB@@@@@@A@@@A@@@@BA@@DAD@@A@@@@@@B@@AB@@@BABA@@@@B@@@@@@@B@@A@@@@B@@@@@B@@@B@B@FGCIIIEG@CBEJENFLA@C@GECGESEUOCCAE@CFMPSBG@G@EAAIGUO@ABCBAJKFE@EBAAGKUIQ@G@G@E@EBGDODQBEBEBADAnQhOLEXWJIBALM@@HIB@FGJILKFG@E@EGaAGBC@CDCDC@@@@LGJCBAB@DCB@DEBEAEAEECE@GA[FC@EASSEGCIAEBEBAHEJCHCBADA@@BCAKA@@@CAC@I@K@ICKCMMa_EEAAAAKKAAKKCGAG@A@IBIDGHEFAREPENKVOJGDCFETWHK@@ggEEEGg_GC]KKAS@OGCIEAmAOFGFGFDMCSGOGqUIEEC@ED]@ECC@@[QMKeK_BMCwmGICI@@AF@FM@AAAAEGe@G@@@@@@BAB@@ADA@@B@@AB@@@AC@AAI@@AA@EA@@@@@B@@@@AAA@C@@@@@@@A@@B@AA@@@AA@@@@A@AB@@@BA@A@@@AA@@@@@A@A@@@A@@CB@@@@@@@B@@A@@A@@@@A@A@@@AA@@@@CB@@@BA@@@@@A@@@AB@@@@@@A@@A@@B@@A@A@@A@A@@@@@@@@ABA@@A@@@A@@@@@@BAFABA@CB@@@@C@CAA@@@@CA@@@A@@A@@@@@BA@@@A@@@AB@@@@@A@A@@AA@@@@BA@@@@@A@A@@@A@@AA@@@@A@@B@B@@@@C@A@@@@BB@@@@@@@@@@@@@A@@@@@@D@@B@@B@@@@@@@@@B@@@@@@@@A@@@@@AB@BB@@@@BB@@B@B@@A@AAA@@DA@A@ABA@@@A@@@BBA@@@@@A@@@@DAB@@@@A@AAA@CA@@@@@A@@A@A@A@@@@@AB@@A@@A@@AB@BA@@@A@@@@@AB@BA@@@@@A@A@@@@B@@@@A@@@@B@B@@@@AACAAC@A@@A@A@@@@@@B@@@B@@@@A@A@@@@@@A@@@@@AAA@@CA@AA@@@ABA@AB@@@B@@@B@@A@A@@@@@@@@B@B@@C@@@@B@@BB@BA@AB@@@@A@@A@@A@@AA@@AAA@@A@@A@@@@@@@@@@@@AA@@@@C@A@A@@@A@A@@@@@@@@BAB@B@F@@@@A@@@A@AB@@@@A@@@@@@@@@A@@@AAAA@@CA@@@@AA@A@@@@@AA@@@A@@@@@AB@@@@A@@@@@@B@B@@@@A@@A@@A@A@@@ABAA@@@@@A@@@@A@@A@@A@@@@@@A@@@@A@C@A@@@@B@BA@@@A@@@@@@@@@@@@@AB@@A@@@@@B@@@@@@@A@@B@@A@@AA@@A@@@BA@B@A@@@@@@@AB@@@BA@@AA@@@A@@@@@A@@@@@A@@@A@@BA@@@@BA@@AA@@@@@A@CBA@AB@@A@@@AB@@@@@A@@@@@@@@A@@@@@@@@C@@A@@@@@@A@@@@A@@@@@@@AA@@@@A@A@A@@A@@@@@@@@@@@@@B@B@BB@@@@@@@@B@@@@@@@@@@@@@@A@@B@@@B@B@@A@@@@@@A@@@AB@@@A@@@@@@@@@AB@B@@@@BB@@A@@B@@@AA@@@@BA@@@AAAA@@@@@A@@@@B@@A@@@@A@ABA@@@@B@@@@@@@B@@@@@B@@@@@@@@@@A@@@@A@@@A@@@@@@@@A@@@A@@@@@@@AA@@@@@@@B@B@@B@@@@@B@@B@@@@@@@BB@@@@@@@@B@@@@@@@BB@@@A@@@@@@@@@@@A@@A@@A@@@@@@@A@@@@@AA@@@@@A@@@@@A@@@@@A@A@@@A@A@@@AA@@@@B@BA@@@AA@@@B@@AB@@@@A@@B@@@@@BAB@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@B@@@B@@@@AA@@A@A@@@A@@A@@A@A@@@@@@A@@@@A@@@@@A@@@A@@@A@@@@@@@@@@@@@AA@@@A@@A@@@@@@@@@@A@A@@@@A@@@@@A@A@@@@@@@@@@A@A@@@@@AA@@A@@@@@A@@@A@@A@@@@@@@AA@@AA@@A@@@@@@A@@A@@@@@A@A@A@@@A@@@@@@@@@@@A@@@A@@@A@@@@B@@A@@@@@@@@@A@@@@B@@@@@@@@@A@@A@@@A@@@@@A@A@@@@@A@@B@@@@A@@@AA@@@@A@@@A@@@@@@@@@@B@@@@@B@@B@@B@@A@@@@@@@@B@B@@A@@@A@A@@@A@@B@@B@@@@B@@@@@@@@@@A@@@@@@@@@@@A@@@@@@B@@@@A@A@AA@@A@@@@@@@AA@@@@CAA@@@@@@@@BAB@@@B@@A@AA@@@@@@B@@@@@@A@@A@@@@@@@@@@A@@@@@@@A@@@@A@@@AA@@@@@@@@@@AB@@@B@@@B@@@@@@@BAB@@@B@@A@@@@@A@@@@@BA@@@@A@@@@AAA@A@@@B@@@@@@@@@@@@A@@@@A@@A@@@@BA@@@@@@@A@@AA@A@@@C@@@@@@@AB@B@@@@@BBD@@A@@B@@@BA@@AA@A@@@A@@@@@AA@@@@@@@@@@@A@@B@@A@@A@@@@AA@@@@@@@@@@@@@@B@@@@@@A@@@@@A@@@@A@@A@@@@A@@@@@@@@A@@@AA@@A@@@A@@@C@@@A@@@@@A@@@A@A@A@A@@@A@CB@@@@ABB@A@@@@@@@@@A@A@A@@@@@@@A@@@@@@@@A@@A@@B@@@@@@A@@@@@@@MG@@C@@B@@C@@@ABADCB@@@@E@CABFBDBDDD@DAHADCFC@EBCBG@GBE@EAACCAEAGBCACAC@ABEB@@A@@@A@@B@@@@A@@A@@@@GFAB@BCB@@A@@@ABAA@@A@@@@@AAABBBDDA@@BFHA@ADABGD@@ABCBADABADITJFLLVOXPCFH@FCHCBLN@@IPBEJO@AHf@N@F@HB@DCFEJILG@EBC@A@A@ABCDABEFCBE@K@EBA@CBADCFFBD@JJA@@BB@AL@F@@@DB@@@@@BB@@@@@@B@@D@BB@DD@B@B@BABAFDDBD@D@DABAB@DABAD@D@DHJBFFDJBF@"]],encodeOffsets:[[[125371,40337]],[[125282,40273]],[[124838,40442]]]}},{type:"Feature",id:"210281",properties:{name:"瓦房店市",cp:[121.979543,39.626897],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@E@@@@@@B@@@@D@B@@@BA@@@@A@"],["@@AD@@@@@B@@D@B@@@@@@A@AA@@A@@A@@@"],["@@HGBALEBCAEI@S@ECC]B@c¥IDIAECEGAIECeUQKaAHSJAJ@FDVIHkHGD@^EZILMH_IG@KDSJEDqNG@K@[GKCI@EBCDAH@TGFM@eQ_MGAGAC@EBCBADALABIFGBCB@DAD@DBBBDFDPJBB@D@BCFGFCBABCF@D@FBDDBD@LAF@J@DBDDBDAFAN@F@BFHFDNJZZNPFL@LGDCDGFEHEJCHC@C@CCEICAEGAQGGO@EDIVEHQHMHKJCHAFAP@RBJD^PVTRf^`TbLDBD@F@RELAJAH@lNJBH@L@^@P@XDPDRHLHFBP@VBVFHJDF@H@FCDKBEDAD@F@DBFDDHBX@R@HBHBF@DDNHHJFNAJENDFDJFFBHAFEFOLCDMRCDEF_RCDAHBDDDjPJFDJBNAJCJGHOLCJAL@JDJBFFBLBNBNFNLJJJJFJDHJDLHFDBFDJBRBFJDH@LCPGNEJ@dFzTDBNFLPBD@F@HBFDDJFL@RCH@FB\\\\DFFFNLXLPBJAVAb@Z@XENDJFFJFTBRDFLH^JXFTFNHLLFHJD@FCDEBiCSBGJELJL^TBHCNFDNDTBNEHBFDDLLJ\\ALDFFFFNJ\\RFHDDFTFNFJFHDFTP^\\HCJCHEJIHKFGDG@@DGFIDEBG@E@@@@AA@@@@@A@AMG@A@EBIBI@@BCDEHGJEF@LAL@DBFBDB@@@@LHLB\\F@@B@FBB@BBBB@B@@AB@@@B@@B@B@BBB@DAB@BAHCB@DADBDBHDDA@ABA@A@C@@@A@CDE@@B@@@@@B@@@B@@@B@B@@@@A@@B@B@B@@@B@BC@@@A@@BC@A@@@A@@BA@@@A@@@A@@@AD@@@BABCBC@ABBBBF@@@@BBB@@D@D@B@BB@@B@B@FAB@B@F@DBH@B@B@@@B@BAB@B@D@BA@@BAACB@B@B@@@B@@ABA@A@AB@D@B@@@@@B@@A@A@@D@B@@@BC@@@@B@B@@B@@HBD@B@@@BB@@@BB@B@@@@@BB@B@@DAD@B@@@@ABAB@B@B@DAB@BA@@D@BA@@@@BADAB@@@BA@@BAB@@@AAB@@@AABA@@B@@A@@@ABA@ABA@@@ABA@AB@@@BA@AAA@@@@BADADA@@DA@@@@D@BB@@B@@@BABAB@DC@@B@@A@A@@@@@ABA@A@@B@BA@@AA@A@@@@@@B@BAB@BBD@@@BAB@B@@BB@B@DBB@DB@@D@D@DBB@@BD@@B@BB@BB@@BB@@BBFBBB@B@D@BB@BBD@B@@@@BB@@BDBBBBB@BBB@B@@B@@@@@DA@@DABABA@@@A@@@@@@@C@@@A@ABA@@B@@A@@B@D@@@@A@@@@BADADABAB@@ABA@@B@@A@AAA@@AAB@@AA@@ABAA@@AB@@A@@CE@A@@@A@A@@@AB@DA@@BABAB@BAB@BABB@AB@A@@CBA@A@@@A@@@@B@BABA@@@AB@@@@ABAB@BA@@BCDC@ABADAD@@@CKCGQUOQQEKCK@I@KGMIGQ@IAMGWQSUOAAUIOMCS@M@C@M@@@@@@@KBABCRGLIFGQKgNU@ECA@@@A@@@ABABA@ABA@A@CAC@C@AAA@@@AA@A@A@AAA@A@@@A@AA@@A@@@A@@@AB@@AB@BA@@@ABA@@@AB@@A@C@@@A@@@AA@@@A@@@@@A@@@AAA@A@AB@@A@@@@@A@@@A@A@A@@@A@@B@@@@A@@@@@A@@@A@@@A@@@A@@@@AA@A@@AA@A@A@@@A@@@A@A@@@@@@@@A@A@@@AA@@AA@@@@A@@@@@A@@B@@A@@@@@@@@@A@@@AB@@@BAB@D@@@@@B@@@BA@@@@@A@@BA@@@AAA@@AA@@A@@AAA@@AA@@AA@@@@AA@@@@@AAA@@A@@A@@@AAAAAAA@AAEA@@BA@A@@@AA@A@@A@@@JADAH@D@DBFAB@@CDIBAD@B@D@BBB@D@BABAFAF@BA@ABABAB@BAD@F@DA@@@C@C@IAA@C@ABAFCPOOCC@@AE@KEEECCACCG@CCEEEAC@C@ADEDEBI@G@ACCAAA@@A@EBCACECCE@C@E@C@C@AFEDCD@BA@ABABEFGDADCBAB@BAB@@A@C@@@A@A@A@ABA@@BAB@AA@A@A@@@A@AB@@AD@B@B@DAB@B@BAFGDCBADAD@BAD@BABABC@@DAB@B@B@D@JELCD@ISCIEEGAICG@CAEACCCC@AAAC@@BEDADEBC@ECEEAECCAAA@E@GDCBCBAAA@ACEEGGEECCCAAACAE@AACAAAAG@CACAAECAE@AAC@G@AAGCCCAAACAAC@AAG@C@CACA@A@ABAB@@ABADA@@@CA@@CA@AAAA@CB@@AD@BA@@BC@A@IAMA@BEFEBCBEBCBC@C@CAA@AAGCA@C@A@AAA@A@C@A@SBC@@@@A@A@@@@@@@@CAA@A@A@@@A@@@@@A@@@C@A@@@@@@@A@@@@@@@@@AA@@CBA@A@A@@@@@@@AAA@@@@@A@@@A@A@@@@A@@CB@B@@@@@@@@A@@B@@@@@@@@@@A@@@AB@@@@A@A@@@@@A@@@@@@@A@CDA@@B@@@@A@AA@@@@A@@@A@@@@BAA@@@@@@A@@B@@@@AB@@@@BB@@@@A@@@@@A@A@@@@@@@@A@@@@@A@@@@@A@@@@@@@@@@@A@@A@@B@@@@A@@@A@@@@@@@ABEAWAC@K@GAOIGQ@@@@CECQCEOB@P@B@H@HABA@@BAB@BBB@BABA@A@AA@@@A@C@@A@A@A@A@A@@@A@AA@ACCCECEA@A@AB@@@D@BAFCFADAB@@A@ABABABA@A@@@A@A@A@CB@BC@A@A@A@ABCBABA@A@A@A@@@A@A@ABCBABAB@BBB@D@BABABAB@@A@AAAAAAGE@A@AB@FCB@AA@@CAA@E@EBE@E@C@C@A@C@@@AB@BBB@@FFFDHJBF@B@@A@A@@@AAA@ABC@ABAB@B@BB@B@BAB@BAB@BB@BAB@@ABA@A@CAA@AAC@ECA@A@AB@@@B@BGDGBAB@AA@AACGCIACA@A@A@@@AAACAAA@A@@BA@@BABA@@@CCA@@DAFA@@@A@AA@AA@A@ADEDEDA@A@@D@D@@ABCBA@AB@DA@A@C@A@E@EAKAC@AECEACACAAA@AAA@@@AA@@AA@@@C@@@ABA@@@A@@A@A@@@AACAACCEAAA@A@A@@BAFABG@ABCDA@ABCAA@AAC@A@CBA@@AA@A@AACAAAAACACECAA@A@A@A@A@A@A@A@A@A@@@A@A@A@AAC@AA@AAABCA@AACAAC@@@AFC@AAAAC@C@GAA@@@@AAGAIAA@C@A@C@A@@@A@A@A@ABCDA@A@A@A@@B@@AD@DABA@A@C@ABC@CDA@A@A@E@GACBE@C@A@CAKGIGEGEE@C@@BCDA@AA@AAK@GIA@GGOKIGIKACACEACCAABABADBB@D@@@B@@CB@DAFADCDED@DABBDBB@D@B@@AAC@A@@BADBDBD@DABABAHQBEGGGGEI"]],encodeOffsets:[[[124521,40832]],[[124382,40753]],[[124516,40347]]]}},{type:"Feature",id:"210224",properties:{name:"长海县",cp:[122.588494,39.272728],childNum:52},geometry:{type:"MultiPolygon",
+coordinates:[["@@@@@@A@@@@@@@@@AB@@B@@@@@@@@@@@@@@@@@B@@A@@@@"],["@@@@@@@@A@@@@@@@@B@@B@@@@@@@@@@A@@B@@@A@@@"],["@@B@@@@B@@@@@@B@@AA@@@@A@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@"],["@@@@@@@@B@@@@@B@@@@@@AA@@@@@AB"],["@@AA@B@@@@@@B@@@@B@@@@B@@A@@@@@@@@A@@@@@"],["@@@@@@B@@AA@@@@@@B"],["@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@A@@@@@@@@B@@@@"],["@@@@@@B@@B@@@A@@B@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@B@@@@"],["@@@@B@@@@BB@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@A@@@@B"],["@@B@@@@@@A@@@@@@B@@@@A@@@@@@A@@@@@@@@@@B@@@@@@A@@@@B@@@@@@"],["@@@@A@@B@@@@@@@BB@@@@@@@@BB@@@@A@A@@@@AA@@@@"],["@@@@@B@@@@@@B@@@@@@@@@@@@A@@@@@@@@@A@@@@A@@@@@@@@B@@@@"],["@@@@A@@@A@@@@BB@@@B@@@B@@@@@@@@@AA@@"],["@@B@@@@@@A@@@@@AA@@@AB@@@B@@B@"],["@@B@@A@A@@ABA@AB@@@BB@BA"],["@@@BB@@@@@B@@@@@B@@AA@@@@@@@@@A@@A@@@@@A@@A@@B@@@@A@B@@@@B"],["@@EBAB@@B@@B@@B@@@@A@@@@@@B@@@@@@@B@@@@@@A@A@@@@"],["@@@@BA@@@@@A@@@A@@@@@A@@@@A@@@A@@@@B@@@@@@BBAB@@BB@@"],["@@@@@@@B@@@@B@B@@@B@B@@@@@B@@@@@B@@A@@A@@@@@@@@@AA@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@B@@A@"],["@@A@@@ABA@@@A@@@A@CB@@@@@BB@@@@@B@BABAB@@B@@B@@@BAB@@AA@@@"],["@@@A@@AA@@@@A@@@AB@@A@@A@@A@@B@@@B@ABB@@@@@@@@B@@@B@@@B@@@@@B@"],["@@@B@@A@@@A@BDB@@BB@B@BA@@@@@AA@@AA@@AA@"],["@@@@@@@@@@@@A@@B@@A@@@@@@@BBA@@@@@@@@@@@@@@BA@@@@@@@@B@@B@@@@@B@@AB@BAB@@A@@@@@@A@@@@A@@@@@@@@A@@@@@"],["@@A@@BA@@@A@A@@@CB@@A@A@A@@@@@@B@@D@B@B@B@@@JC@@@@@@@@AA@@"],["@@B@@A@A@@AA@A@@A@@BA@@@@@@A@@A@@@@@@B@B@B@@@@BB@@BBB@@A"],["@@@A@AA@A@AACA@@AB@@BBBB@BB@DBB@@A@@"],["@@BBB@@@BA@@@@@@@A@@B@@B@@@@@A@@@@B@@@BA@@@@@@@AA@AAA@@@@@@B@@A@A@@@@@@BA@@@@@@@@B@@@B@@@@"],["@@A@ABAA@B@@@B@@@@BBDBB@B@@AB@@AAA@@AAA@"],["@@@@AB@@@B@@@B@@A@A@@@A@@BA@@@BB@@B@@@@@B@@@@@B@@@@@@@B@@A@@B@B@@ABA@@@A@@A@A@@@@A@@A@@@"],["@@A@A@CBAB@@@B@@B@B@D@FAB@@@@A@@@@@@B@@@@@@@B@AA@@@@G@"],["@@B@@@@A@@B@@@B@@@BAB@@@@@AA@@AA@@@@BA@@@@@@AAC@@@@BB@@@@BA@A@A@@@A@@BB@@B@@A@@@@B@@BBB@"],["@@A@C@@@@B@B@BB@DAB@B@BBB@@@FAB@BA@@@AC@EBCAC@@@"],["@@B@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@BAB@D@@@BE@A@A@C@@E@@@@DAFABAB@@@@AA@@@@A@@@@@@@A@@@@@@@@B@@@@@@A@@@@@@B@@@@@@@@B@@@@@@@@@@@"],["@@E@A@@@@BB@AB@@A@@BBB@BBBBBB@@A@@B@@@BABABA@AAACA"],["@@@AB@@A@@@@B@@@@A@@A@@@AB@@A@@BA@@@A@@@@@A@A@@A@@@@@@B@@@@AA@@@@@@BA@@BA@@@@@@@@@A@@@@B@@@@@B@@@@@@@@B@@BB@@@B@@@B@BBB@@@@@@@@@BB@@B@@@B@@@@@@@@@B@@@B@@B@@B@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@AA@A@@A@@@@@@@@@@@@AA@@@AA@@@@@@@@@@"],["@@@CACE@C@ADAL@@DBBAD@B@B@BC@C"],["@@@@@AB@@AA@@@@@A@@@@@A@@@@@A@@BA@@@@@A@@@A@@@@@@@A@@@@@@@@B@@A@@@A@@@A@@@@BA@@@@@@@@BA@@@@@A@@@@@@@@@A@@B@@@AA@@@@@A@@@@B@@@AA@A@AB@@A@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@B@@@@@@@@@B@@@@@@B@@@@@@@@B@@BA@@@@@B@@@@B@@@@@@A@@B@@B@@B@@@@@@@@@A@@@@B@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@B@@B@@B@@@@@@AB@@@@@@@@AB@@@@@@@@@@A@@@@BB@@@@B@@A@@@@@@A@B@@A@@@@@@BA@@@@@@B@@@@@@@@A@@@@B@@A@@@@@@@@@@@@@@@@@@B@@@B@@@@@B@@@@@@@@@@@@A@@BB@@@@BA@@B@@@"],["@@@@BA@@B@B@B@@@@@B@B@@AB@BB@@@@@BB@@@BA@@@ADCB@B@D@DC@A@@@@CAAAA@ABA@AA@@AAA@A@ADA@ABE@ABAF@BC@@@@B@BB@B@"],["@@B@@@@AAA@@@@B@@@B@@A@@@@@AB@@@B@@A@@BAB@@@@@DBB@@@@@BAB@@AA@@@A@@@A@B@AAA@@ABAB@@C@@AA@@@AA@CBCB@B@B@B@@A@A@C@@@C@A@CD@BABA@@@@D@B@BB@@BB@B@B@B@@@@BB@B@BA"],["@@BA@@B@@AB@D@DABBD@@@B@AB@@@@B@@BB@@A@@@@A@BA@@@@@@@@B@@@BA@@@AB@@@@A@@B@@A@@BAB@B@B@@@B@DAB@BB@@@@@@B@@@@@B@@@@AB@A@@@@A@@@@@@BAB@@@B@@@@@@A@@@@@@BAB@D@@@@A@@@@@AA@@@@@ABA@@AC@GAAAA@@AAB@@@@A@@BB@@@@B@@ABA@@BA@A@A@@@@@@B@@A@@@ABA@ABCB@B@B@@A@A@C@@@A@@B@@@@@B@@A@A@E@@@C@C@A@@@@@@@@B@@@@B@@B@@@@@@BB@@B@@BB@"],["@@AB@@@@BBB@DBDBBBBBB@@@@BBBFBDBBBBBB@B@@@B@@A@@B@B@B@B@B@B@BA@@B@@AB@A@@@@@A@@A@@@A@@B@@AB@@A@@B@@A@@B@@@B@@@@AB@@@@A@@A@@@@AA@A@@@@@@@AA@@@@@@@@A@@@A@@B@@AB@@A@A@A@@A@@@@AA@@@@A@A@A@@@A@@@AA@@A@@@A@@@@@@AB@@@@A@@@A@@AA@@@@@@A@@B@@A@@@@@@B@@@B@B@BA@@BA@A@@@@@A@@@@@A@@B@@A@@A@@AA@@@@@AA@A@@@@@AB@@@@@@A@A@@@"],["@@E@A@AB@BABA@ABA@@@AB@@@BB@DB@@B@@BB@@@BAB@B@@@BB@D@BABAB@B@@BB@@DA@BB@@@@BABBB@BB@@@BABA@CB@@AD@B@BB@B@@@BA@A@@B@BB@B@@B@@B@@B@@@@DABABABA@A@@A@@A@@BA@@B@@A@AA@BA@A@@AACCCAC@CAAAA@A@A@A@A@A@@AB@@A@@BAAA@@A@"],["@@@B@@B@@@B@DABA@@B@B@DADCFGBC@C@@AAA@A@A@@@AAAB@@C@@@@A@@E@AA@@@A@@A@A@A@@ACAA@ABC@ABABCB@@ABBBB@@B@BB@B@@@@D@@AB@@@B@@B@B@B@BAB@D@@@B@@@@B@@@BB@@@B@@@@B@@@BA@@BAB@B"],["@@A@EBQDEDABAD@BBBJBFBDBB@BD@@D@D@FAD@FBBAD@DABC@A@AA@A@CBA@AA@ABC@AAAGEA@CA"],["@@AIAAA@ABABA@E@A@ABAB@BBB@@@BA@A@ABABBD@BAB@BCB@BABABA@CBA@ADBB@BDADAF@B@DCBCB@B@B@BB@BBBB@@@B@BEB@B@B@@AB@B@@BBB@@F@DA@C@A@C@@A@A@@A@A@A@AA@ABA@A@@AAC"],["@@J@FABAJAFBHBN@H@FC@AAAAAA@A@@@@A@ACAA@GDE@A@G@CCA@A@AAAA@AAAI@A@CFC@@BBB@BB@ABAAA@A@@A@A@@C@@@A@@BCJBBB@FBDBB@"],["@@@@@@AB@@@BA@@BC@AA@@A@AAC@A@@@AB@@@DA@CBIDABAB@D@BBBDDB@FBB@BDB@D@B@FABCL@BBD@D@JABCDAF@H@LBLDD@BAB@@AAI@A@ACAA@E@AAA@ACA@CAC@C@A@AAA@A@A@CDA@@@AAA@A@A@A@A@@@@@AAA@A@A@E@"],["@@ABA@@@A@@@A@@@A@@@@AA@@@A@@A@@@@@A@@@AB@BA@@@AB@@A@@@@@A@@@AA@AAA@A@@@@A@@@@@@AA@@@@A@@@@@A@@BA@@@@@A@@@@BA@@@@@A@A@@@A@@BA@@@A@@@AA@@@@A@@@@@A@@A@@A@@AA@@@A@@A@@B@@@@@@A@@@@@@@A@@@@@@@@BAA@@@@@@@A@@B@@@@@BA@@@@@@@@@@A@@A@@@C@@@@@@@@A@@@A@@BA@@@@@@AAA@A@@@A@@@AB@@@@A@@A@@@@@@@A@@A@@@A@@@@@A@@@@@@@A@@@@A@@@@@@A@@@@A@@@@@@A@@@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@AA@@@@@@A@@@@@@D@BB@@@A@B@@BBB@@@@@B@@@@@B@@@BB@@@@B@@@@@@A@A@@@@@AB@B@@@@@BB@B@@@B@@@B@@B@@@B@@@@B@@@@@@BB@@@B@@B@@@DB@@@@@@A@@BB@@@BB@@B@@AB@@ABB@@@@@BBB@@@@@@@B@@@@B@BB@BB@@B@@@B@@@@@B@@B@@@BAB@BABABABA@@@A@A@@BA@@@AA@@@A@@@@A@AAA@@BA@@@@B@@B@@B@@A@@@A@A@@@@@@@@A@@@@@A@@A@@@@@@@@@A@@@A@@@@@@@BB@@@@@BA@@BA@A@A@A@A@@@@@AA@@@@@@A@A@@@@A@@A@@@A@A@@A@@@@@@@@DC@@@A@@@@A@@@@@A@A@AA@@A@@@@B@@@@B@@B@@@BAB@@@@AB@@A@@@A@@@@@@BA@AB@@A@A@A@@@@@A@@A@@A@@@AAA@@@A@@AA@@@@A@@B@A@@@@@A@@@@@A@@BA@@B@@@B@@A@@@A@A@@@@@@AA@@@@BA@@@@@@@@@A@@@A@@@@@@A@@@@@@A@A@A@@@@@AA@@@@@A@@@@@A@@@@A@A@@@@A@@@@BA@@@A@@@@AA@@A@@@AB@@@@A@@B@@@@@B@@@@A@A@@@@@@@@A@@@A@@@@@A@@@@@@@A@@A@@B@@A@A@@@@@@BA@@@AA@@AB@@@@A@@@@@@@A@@@@B@@@@@BA@@@@@@@@B@@A@@@A@@A@@AA@@@@@@A@@@AB@@@@B@@@@B@@@@BB@@@@@@@BA@@@@@A@A@@@@B@B@BBBBB@@B@@BBAB@@@BBB@@B@@BB@@AB@@@B@@B@B@B@BA@@BAB@BBB@D@DBBBB@B@B@B@@@B@B@@@BB@AB@BAB@@@BA@@BB@B@@D@@@D@B@@@BA@@D@@@B@D@B@BAD@B@@@@BH@@D@@D@D@B@@@@AB@BAB@D@B@B@DBB@@@@@@B@@@@BBBBB@B@F@B@@@@@DAD@B@B@DBB@@BB@B@@AD@D@BBB@D@B@@@@AAA@A@@@AB@D@@@@B@@DBBBBBD@DB@@B@@AB@@@@@DB@@DAB@BDB@B@BA@C@CDA@EFEFADAB@BA@@BAACAAA@C@A@E@@A@ACAA@A@A@@@AAB@@A@@AAA@@B@@@BC@@@A@@@@@@@@A@@@A@@@A@@@AA@@@A@@@A@@B@@@D@@"],["@@CAMGYYECM@MBKLGLAL@HJTDN@FCFIFCFAHDPBFF@J@LANCNCH@DBBFAFEFCD@F@DF@JAJCHE@E@CEE@EBEHANCHEFGFK@KAIGKGGKC"],["@@@A@@@A@@@@BAB@@A@@A@@@A@@@@A@@@@@@B@@@@@@A@@@@@AA@@@@@@AB@B@@@B@@@@@@A@@B@@@@@@@@@AA@A@@A@@@@@@@@A@@@@B@@@@A@@@@@@@@@AB@@@@@@B@@A@@@@@B@@@D@@AB@B@@@B@B@@@@B@@AB@@@@BB@@@@A@@@@@B@@B@@@@@@BAAA@@@@@@B@@ABAB@@@@A@A@A@@@A@@@A@@BA@@BA@@@@@A@@AA@@AAA@@@AAA@@@@AA@@A@@AB@@A@A@@@A@@BA@@@C@AB@@ABA@C@A@A@C@CBA@AB@@@@A@@@A@@B@@A@@B@@@@A@@@@AAA@@@AA@@AAB@@AB@@@@A@@@A@@B@@@BA@@@AB@@@BA@CA@@@B@@@BD@@@@B@@A@@BA@AA@@A@@@AAA@@B@@@B@@ABABA@@BAB@@A@A@AAAA@@ABAB@@@B@@AB@@@@@BB@BAB@@@@@B@@@B@@B@@BA@@@BB@@@@A@@@@B@@@BBB@@ABB@@@B@@@BA@@@@@@BA@@@A@@@A@@@@@BB@B@@@@@@A@@@A@@@A@@BB@BB@@@@A@@@@@A@@@@@A@@B@@@B@@A@@@@@@@@B@@A@@@A@@@A@A@@B@@@@@@A@@@@@@@@B@B@@A@@@@@@BA@@@AB@@@BB@@@@@B@@@@@BB@@BA@@B@@@@@B@@BBA@@B@B@@@@@@A@@@@@@B@@@@@@AB@@@@@@@@@@B@@B@@@@@@B@@@@@@@BA@@@@@AB@@@@@B@@B@@@@BB@B@BB@@@@@B@@@@@@A@@@B@@@@@@@BB@@@@@@@@@@B@@@@A@AD@@@@@@@@BB@@@@@B@@@@A@@@AB@@@@@@A@A@A@@A@@@@@@AB@@@@@@@@@@@@@@@@@B@@@BA@@@@@@@ABB@@B@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@@AB@@@@@@AA@@@A@@BA@@@A@@@@@@@AB@@@@@B@@@@A@@@@@@B@AA@@@@B@BAD@D@B@B@BBBB@@@@@B@@@B@@BB@@@@@A@@@@B@B@BAB@@@B@B@BAB@B@"],["@@@@A@@@CA@CA@@@BADABAD@@@BB@@@@B@@A@@CA@@@@A@@@@A@@B@A@@A@@A@@@@@A@AAAA@@A@AA@@@A@A@@@@B@@@@A@@@@@@@@@E@@@@@@@@B@@@@@BCB@A@AA@@A@@B@@@B@BAB@D@@A@@@A@@@@A@@@@A@@@A@@@@@@@@@@@@B@@AB@@A@@@@A@@@@A@A@B@@@@BB@@@@B@@A@@@A@@BA@@@@@BB@BA@AB@@A@@B@@@@@@@BB@BBB@@BB@@B@BAB@B@@CBCBCBC@ABA@A@C@@@A@A@@@A@@@A@A@CBA@C@A@A@@@AB@@ABA@A@@BA@@BA@ABA@@@A@A@A@ABC@AB@@ABABABA@@BA@A@C@@@A@ABA@@@@B@@A@A@@@ABA@C@@AE@C@A@ABA@A@ABC@@BA@@@A@ABABCBABABA@A@@BA@AD@@AB@B@@A@@@@@@@@B@@@@B@@@B@@@@@A@@A@@B@@@@@B@@@@@@@@@@@@@@@@@@@B@@@B@@@@B@@@@@@A@@@@@@BA@@@@@@@B@@@@@@@@@@@B@@A@@@@@@B@@@@@@@B@@@B@@@@@@A@@@@@@@@BA@@B@@BB@@@@@B@@@BB@@@@B@B@@@@A@@@@@AB@@@@@B@DB@@@@B@@B@@@@A@@@@@@B@@@@@@@B@@B@B@@@@@B@@@B@@@@@@B@@@B@@@@@@B@@@@A@@B@@@B@@AB@@@@@@@@A@@@@A@@@@@BA@@@@BA@@@@@AA@B@@@BA@@B@B@@@B@B@@@@B@@BF@BB@B@BAB@B@@BBBBBB@BBA@@@@B@@@@@@B@@@@@@@A@@@@BB@@@B@@@@@@@B@@@@@@@@@@A@@B@@@@@B@@AB@@@@B@@B@@@@A@@@@@@@@@AB@B@@A@@@@@@@@@@A@@@@A@@@A@@@@@@@AB@@@BA@@B@@@@@B@B@@@@A@@@@@@AAA@@@A@@@@C@@@AB@@@@@BA@@@@@A@@@@@@@@BA@@A@@@@@@A@@@@A@@@BA@A@@@@B@@A@@BABA@@@@B@B@B@@B@BAB@@@B@BBB@BBBB@@BB@DB@@B@@BA@@@@@@@A@@BB@@B@B@@@@BA@@B@@@@@@@@ABA@@@@@AB@@AB@@@BA@@@A@@@@BAB@@AB@@AB@@@B@@@@AB@A@@@BA@AB@BA@@@@@@@A@@@@BAB@BA@@BAB@B@B@B@B@DAD@B@@AB@DAB@DAB@BAD@F@DBB@F@D@B@DB@@@@@BL@@@@@BAB@NBB@@B@@D@@@@B@@BB@@B@@BBB@B@@@@@@AB@@@@B@@B@@@@B@@A@@B@@@B@@@B@@@@B@@@@B@@A@@@@@A@@B@D@B@@@B@@B@@@BB@@A@A@@B@@@B@B@@ABA@@A@@A@@@ABAB@@ABAB@BAB@B@D@@AD@@@@A@@@@BADABAD@B@BAB@BAB@B@B@@BB@@BB@B@@A@@@@BAA@@A@@@@BAB@@AD@DABB@@B@@@@BB@@@B@@@D@BA@@B@@BB@B@@@B@ABB@@@@B@@@@@BB@BA@@AA@@@@@A@@@A@@A@@@A@@@A@AA@@@@A@A@@@A@@@AA@AA@@AABAA@@A@@@@AA@@A@@@AA@@AA@@@A@@@AA@A@@@@AAA@@AA@A@@@@@@AA@A@A@A@@@@BA@@BCB@BCBCBA@ABA@A@@BA@ABA@A@CBA@C@A@E@CAC@C@@@A@A@@@CBC@ABA@C@@@AA@@@@@AA@@@AB@@A@ABA@@@ABABC@A@ABA@A@CBC@C@C@C@A@C@A@AA@@@AA@@@@@A@@B@@@@@B@@A@C@@@A@A@"],["@@AA@@AA@@@A@@@@@A@@@@@@BA@@@@@@B@@@@@B@@@@@@@@@A@@A@@@@BA@@@@@AA@@@@@@AB@@@@@@@B@@@@@B@@AA@@@@@@@@@A@@A@@@@@@@@@A@@@@@@@@@AA@AB@@@A@@@@@@@@AA@@@@A@@@@@@@@A@@@@BAB@@@@@@AA@@A@@@@B@@A@@@@@AA@A@@@A@A@@A@@@AA@A@@@A@@@BA@@A@@AA@C@@A@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@A@@@@@A@@@@A@@@@B@@@@A@@A@@@@@@@@AA@@A@@AA@@@@@@A@@@@@@AA@@@@@A@@@@@@@A@@@AB@@@@AA@@@@@@@@@AA@AB@@@@@@@@@@@B@@A@A@@@A@@@A@@BA@@B@@@@AB@@@@@@BB@@@@@@A@@@@@@@@B@@@@AB@@@@A@@@A@@B@B@@@@B@@@A@@B@@A@@@@B@@AB@@@@@BA@@@ABABA@ABA@@@C@A@A@@@@B@@@@BB@@A@@B@B@@@@BB@@@D@BA@AB@BA@BB@BB@B@B@@@BB@B@BAD@BABABADAB@@ABA@@BB@@@B@D@B@B@F@B@D@D@FBD@B@DBBBBB@@@B@@@@BB@@@@@B@@@BB@@@BBB@B@B@B@@@BBBB@@B@@BA@@BAD@@@@AB@@@@A@@BA@@B@@@@@BB@@@BBB@BA@@B@BB@@B@@AB@B@B@B@B@@@B@BA@@B@BBB@B@@AB@BBD@B@D@DBB@D@B@DBB@DBB@@B@@@@@B@@@BA@@@@B@@@B@@@@@@B@@@B@FB@@D@BB@@@@@@B@@@D@B@B@BBB@@@BB@@@@D@BB@@B@@B@B@@@@B@@A@@@@B@@@B@@@@@@B@B@@B@@@B@@@BA@@@@B@@@B@@@B@@@@@@@B@@@@@@@@@AA@@@ABAA@@@@@@AA@@@@@A@@@@@AA@@@@@@@B@@A@@@AA@@@@@@@A@@@@@@@A@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@A@A@@@A@@@@@A@@@@B@@@@@@@BA@@@A@A@@@@@AA@@@@A@@A@@@@@A@@A@@@@A@@A@@AA@@A@@@@BA@@A@@A@@@@@@BAB@@@@@@@@@@AA@@@A@@@A@@@@@@AAB@@@@@@A@@A@@AA@A@@@A@A@@@@@@@@@@B@@@@A@@@@@A@@A@@@@@@@@A@@@@@@@A@@@@@@A@@A@@@@@A@A@A@@@@BA@@@@B@@@@ABA@@B@@@B@@A@@@@B@@BB@@A@@@@@ABCA@B@@A@@BA@ABABA@A@@@AB@@A@@@@@AA@@@@A@@AA@@@@@@@A@@@@B@@@@ABA@@@A@@@@@@B@@@@@@B@@B@@@@A@@@@@@B@@@B@@A@@@A@@@@@@@A@@@@@A@@@@@@@A@@B@BBB@@@B@@@@A@@@@@A@@A@@@@@AB@AA@A@@@@A@@@@@@@AA@@@@@AB@A@@A@@@@B@@@@@@@BA@@@A@@@A@@@@@A@@@@@A@@@@@A@@@@@@AAB@@@@@@@@@@@@@B@@@@@@@@@@@@AB@@@@A@@@@B@@@@A@@@@@A@@@@B@@A@@@@BA@A@@@A@AA@@@@@@@A@@@A@@@@@@@@@@@@@@A@@@@@A@@@@B@@@@A@@@@@A@@BA@@@@@@@@@@@B@@@B@@@@@@B@@B@@B@@@@@@B@B@@B@@@@@@B@@@@B@B@BAB@@CBCB@@A@@@A@@A@@@@A@@@@@@@@AAB@@@@@@@@@@@@@@@@A@@@@A@@@BA@@A@@@@@@AB@@@@@@@@@@@@A@@@A@A@AA@@A@"]],encodeOffsets:[[[125967,40214]],[[125974,40213]],[[125356,40140]],[[125663,40220]],[[125441,40163]],[[125749,40161]],[[125399,40200]],[[125441,40165]],[[125352,40153]],[[125296,40179]],[[125464,40187]],[[125398,40201]],[[125545,40150]],[[125718,40180]],[[125724,40191]],[[125387,40163]],[[125943,40216]],[[125772,39977]],[[125243,40092]],[[125981,40210]],[[125685,40197]],[[125699,40166]],[[125507,40245]],[[126001,40217]],[[125395,40160]],[[125693,40166]],[[125365,40112]],[[125710,40167]],[[125535,40141]],[[125960,40214]],[[125715,40179]],[[126177,39975]],[[125435,40192]],[[125708,40173]],[[125244,40142]],[[125762,40195]],[[125364,40115]],[[125729,40018]],[[125757,40020]],[[125362,40214]],[[125357,40167]],[[125540,40148]],[[125774,39997]],[[125957,40191]],[[125822,39993]],[[125725,40174]],[[125453,40165]],[[125679,40167]],[[126149,39983]],[[125696,39973]],[[125533,40214]],[[125302,40115]]]}},{type:"Feature",id:"210283",properties:{name:"庄河市",cp:[122.967424,39.680843],childNum:27},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@"],["@@B@@@@@@@@@@@A@@@@@@@@@"],["@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@"],["@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@B@@@@A@@@@@A@@@@A@@@"],["@@@@@@A@@@BB@@@@@@B@@@@@@@@AA@@@@@"],["@@@@B@@@@@@AA@@@@@@@@@@BA@@@@@@@@@@@B@"],["@@@@@@@@B@@@A@@A@@A@@@@@@@@B@@@@B@"],["@@@@B@@@@AA@@@@@@AAB@@@@@@@BB@@@"],["@@A@@B@@@@B@@@B@@@@AB@@@@@B@@@@@@@@@@@@@@A@@@@@@@@A@@@@@ABAB@@@A"],["@@@@B@@@B@@@@@@A@@A@@A@@@@AB@@AB@@@@B@@@"],["@@B@@@@A@@A@@@A@@BA@@@@B@@@@B@@@BA@@@@"],["@@A@@B@@B@@B@@B@BA@@B@@A@@A@@@A@A@"],["@@AA@@A@@@@@A@@@A@AB@@BB@@B@D@@@BA@@B@@@@@A@@@"],["@@@@@@A@@@@@@@A@@@@B@@@@A@@B@@B@@@@@B@@BB@@@@A@@@A@@@@B@@@@@@@@@@A@@@@@@@@@@A@"],["@@@B@@@@B@AB@@B@@@@@B@@@@@B@@@@@@AB@A@@@@@AA@@@@@C@@@@@@AB@B@@A@"],["@@@@A@@@@@A@@@@@@@@@@@A@@BAB@B@@B@@@@@@@B@@A@@@@@@@A@@@@@@@@B@BB@@@@@B@@@@@A@@@@@@@AA@@@@@@AB@@@@@B@@@A@@@@@"],["@@BDB@B@@@B@@@A@@@@A@@@A@AA@A@AAAB@@BB@@"],["@@@B@@@@@B@@B@@@B@@@@@@@B@@@@@@@@A@@A@@C@@B@@A@@@@B@@@A@@A@@AB@BA@@@A@@@@B"],["@@@@B@BA@A@@BA@@@A@@C@A@AB@B@@BB@B"],["@@@@CD@@ABB@@BB@@@B@@@@@BB@@B@@@@@B@@A@@@@@A@@@A@@@A@@C@@@@@@AA@"],["@@BAB@B@@A@@@@B@B@@@B@@A@C@@A@A@A@@BAA@@@BA@@@@BA@AB@@@B@@A@@@A@@@@BB@B@B@"],["@@A@AB@@ABAL@BB@BD@@B@@@BAA@@@AA@@@@@AB@@@B@BA@@@CB@@@@@B@@A@@@@AAAAA@@@@A@A@@@@"],["@@AAA@@@A@@BB@@B@B@@@BABA@@B@@D@BB@@BB@@@B@BB@B@@@@A@@@A@@BA@@@A@@@@B@@AA@@@@AB@B@B@@@@@@AACA@A@A@A@A@"],["@@@@@@@@AB@@@@@@@B@@@@A@@@A@@@AB@@@@@@@B@@@@@@@@AB@BA@@@@B@@@BB@@BB@@@ABAB@BCD@B@BBB@BBBB@@BABAB@B@@@@@@B@B@BAB@HBB@B@@@BA@A@ABA@@@AAA@A@@AAA@AB@AA@@@AG@A@C@ABAB@@ADA@ABAB@@@AA@@@@@@@@@@A@@@A@@@A@@@@@@@@AA@@@@@@@"],["@@@A@AAAA@K@CBA@AD@DAFCBE@@A@CAAA@A@EFEFADADAB@BC@CBAB@B@BBB@B@BA@@BB@BD@@AD@BC@BBD@FADAFAFAFAD@@@DAD@JAJBF@DARGDCDCBAAAA@A@IBE@E@EAAA@E@CBEBA@A"],["@@@BBD@DABEBC@A@KDE@C@AACAA@C@C@@B@B@BDDAB@BHNJL@DDFB@DABBDDBDCFBBNHADHDDDJ@BGDEDALCF@JAD@FFD@F@DABCFCJADAFAB@DADA@EBAB@B@B@BABC@@BABABC@ASSEI@CCAA@@AA@@@@@@BCAAABAACAAC@ABA@@A@AA@AACACA@C@A@AAAA@E@@@ACAAECA@@@A@ABAB@@@BABA@A@AAC@A@@@@CA@@AA@@BEBCBABC@C@A@AD@BBB@D@D@FA@CBAB@B"],["@@GLSXEFCDIHUPMLOFQFEBGFCHAJ@J@BBHDHLLBBLLBBBBFFb`NNLDJDL@J@D@DB@@B@BLAD@@CBABGDIDGFABAFBFDJFHTTFBD@\\EHBF@FDBFBFAFCFA@CDA@ABIDKH@@@@CDCD@DADBHHb@F@FEHKLIJEHA@GJ@@KNABIJWXKFgPmRCBABAFAFCRCPAH@F@F@H@HJRLVBHAB@FEFILABAD@BVPJHBB@F@HAHOTEN@DBFDDVPTFHFFD@H@DKBMEIFAF@DFHJJDJEHA@@@BB@@@@A@ABABADAB@BABBDDDDDBBJDHDDB@BFBD@D@D@D@JCNAD@D@DBF@BBDB@@@FABADCBEBEDABAB@BBD@B@@BB@BBDFJBD@B@F@BABAB@AA@A@AAA@GCC@EAABA@@B@B@BDFBF@@BLBHAB@DA@A@@BM@C@IDEBC@ABCB@BA@@B@B@B@D@BBDFDBB@D@B@@BBDBB@BBB@D@HBD@BAB@@ABABAB@B@B@BBBBB@DDDF@BB@@DADEBCBCBA@CBEBCDABAB@FEHCJ@B@B@@BBBFDBDHBB@DBB@B@@@@BB@@B@B@B@D@@@B@@@BAB@@AB@@AFCB@B@BBB@B@BB@@D@B@BAB@@AAA@@@ABA@@B@@@B@@@BAB@@@@AB@@AD@@@@@@A@@@@D@@@B@B@BBB@@BB@@@@BB@@@BBBD@@BAB@B@@@@AD@B@BBB@@AB@BB@@B@B@D@DADAB@F@B@D@BAB@B@@@D@@BFD@DB@BBDD@@DBBDBB@@B@D@@@B@@A@@B@DAH@B@BB@AB@@@B@BB@@D@B@B@F@D@@@HBD@BB@@@B@@B@B@@@@@BA@AD@D@@AD@BA@@BDB@@@FB@@B@BAB@BBB@@@BBBBB@B@D@BABB@@B@@@B@@B@@D@B@DB@@B@@@B@D@BBB@@@@BB@D@D@B@B@DAB@B@B@BA@@JAD@B@D@@BBBB@BB@B@@@D@@AB@BBBBB@BDBDBB@@@@@@B@@DB@BB@FB@B@@B@BBB@@D@BDDBBBB@B@BB@B@JDDABBBA@@BBDBD@DD@@BBBBBBD@BB@@@@D@BBD@@@BB@@BB@BB@BBBBB@@@D@B@BA@BD@D@BBB@@@DB@@B@FC@@BAB@BBB@B@BAB@@@D@BAB@DAD@BA@@@CB@BA@@B@B@D@@@B@@AB@@@BBB@B@B@DBDABB@B@@BB@B@BA@@B@@BB@@@B@BB@BBBD@BB@DD@@BDDB@BD@DBB@B@DA@AB@BA@@B@D@B@DB@@B@@@BBBBB@BB@@B@@AB@BB@@@@DBB@@@@BB@@@@DB@@B@B@BBB@@@B@@BB@@BB@@D@@@@@@FABAB@B@B@@A@EBCB@@AB@B@B@@@B@B@B@@AD@B@@BD@B@@@@@B@@@@BB@ADA@@B@B@DDFF@@@B@@@B@B@@BB@@BBBB@D@BDBBB@@@B@@BBBB@@AB@@@BA@@@DBBB@@@BDB@@@@AB@@@B@@@BB@BBFB@@B@B@DB@BBA@@@B@@BBBB@B@@@@@B@BBB@@AB@@BBABB@@BD@BBB@B@BB@@@@DDB@@@@BBBBB@@BBBB@BAKEG@IBGHANC^CV@JAHCFGBKAC@QDMHQRWNOJCHELAPBLCAGEGEKLWDG@@@@HKRIPGNCTKRCLARAHAPQHKFEFCB@B@@DBDDHBBBFDFRRLFDFBDB@BBBBB@BB@@B@@ABADAHABAB@CA@A@A@@@@BA@@DAD@BAB@@@B@@@@@@@@BB@@BB@BB@@@@@B@@@B@BDBD@BD@@@B@B@B@@BBB@B@@@BB@BB@BBB@B@@@D@DABAB@@ABA@@@CBA@@BAD@HBB@DA@@B@@AD@FADA@@D@@@BAB@@@B@BBBAB@@@D@BB@BB@B@@BB@@A@@BA@@BABAB@D@BABAF@P@PGJCPENGNELGDCDCNIRBPBJBF@JSLQHGJEB@JBHDFPF@HBJBLCTCZEFIHE@@HCLE@@@ABC@@BAB@BABAB@B@D@B@BBDB@@B@D@B@@@B@@@B@B@@A@AB@BA@@@@B@BB@@B@@B@@@B@@@B@@DDBBDD@@B@BD@@@@BBB@@@BBD@@BB@@@DAD@DA@@B@@A@@D@@@BA@AB@B@BA@A@A@A@CB@BA@@@A@A@A@AA@@@ACA@@AC@@AA@@A@@A@@ABC@A@@@A@@AA@A@@BA@@@A@A@A@AA@CAC@A@A@AACAAAACCAEAAAA@CAA@C@AAA@B@@ABCDCBA@C@ADC@ABC@@A@@@DE@@B@B@F@F@B@@A@@@A@A@A@@@AB@@@BB@C@A@@@@@A@@CDCCAA@@B@BA@@B@AAAA@CAA@@@A@C@A@@B@DB@@BAB@B@@@BAB@@A@@@AA@AA@@AA@@@@AA@@@ABA@ADA@@@@@@BBB@HBB@BB@@@@@DBD@BBBH@@@DAB@DAB@DBB@B@BA@AB@@@DA@@DABAD@@@@ABA@A@@B@BAD@DEBB@@@BD@B@B@@BB@BFB@@@B@D@B@@@@@@A@@@A@@@AACAAB@DA@@@BBD@B@@BB@@B@D@@B@@AB@@@@BB@@D@@@@ABCB@BB@BBA@@@A@@B@DABA@@@@@AA@CA@AA@@A@@C@@@BC@@B@@@B@@@FD@@@@B@@@B@BBBBBBBB@BB@@@BA@@@ADE@ABCB@@AFGDEBCLGDA@@BA@A@@@@@@@C@AAAAAIECAAAA@@A@AAAACAA@A@@@AA@A@@@@AAA@IFODEHKRERIHQAE@@@@CM@IFQDARIFKBS@Y@__@CABMCAOECBKHGCACAEAO@CCAC@G@WBECACACGMCCA@C@CD@@C@IAaCAACAoIGDEND^DXAJKDWAACCUC@ABATCBE@ICCE@IACACG@C@CDAFCB_EcAE@IBCBCFEBE@E@I@GAEC@CNOBCACMGE@EBIDMNKDCBEACAACBCBCDALBHCNCXSBA@CIG@C@ACE@A@AB@BABCD@F@B@@@@ABADAFCDEBC@A@@A@ABAACE@ADA@AA@ABA@AAA@C@CDABC@CAAAEAAAAAWEA@CFADCB]DIBG@G@CAEAACAEBCBCDANADCBC@EAECAGAAAACAEACA@CACDCDCB]@I@C@ADEBI@UBEACCCCGKM[EEGCI@GBCB@D@DBH@B@BIHcZEFGBA@A@AAAC@CHO@CCAA@G@MDAAACAAEBCBCBA@AD@F@BA@A@G@QCAA@CAAQCABA@AJA@G@CAGCGEBCBEDCNEDCBCCEKAI@EDAF@FCPEBIAKAKE@@@A@EVO@CCCQAMCIGACKIKEIECE@Q@ICGGGIAaPQFQ@oCEAE@CBEBEDE@_CG@E@EBKDA@E@E@KEAABG@ACAGEACBIFKAGMIUIMEEDGPGJIBKAAGXWCG³ikQKBOEsSEEEAE@IBOJIDG@OCECCCGcCECE@AGGIEKCGAUCE@AAGCMMCACAWI"]],encodeOffsets:[[[126062,40384]],[[126165,40585]],[[126066,40383]],[[126023,40434]],[[126066,40453]],[[126034,40592]],[[125604,40466]],[[126039,40610]],[[126e3,40479]],[[125898,40494]],[[126035,40605]],[[125896,40502]],[[126007,40597]],[[125999,40432]],[[126035,40427]],[[126043,40422]],[[125997,40498]],[[126034,40434]],[[125885,40462]],[[126052,40407]],[[126046,40452]],[[126048,40413]],[[126062,40455]],[[126019,40435]],[[126043,40377]],[[125921,40462]],[[125524,40412]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
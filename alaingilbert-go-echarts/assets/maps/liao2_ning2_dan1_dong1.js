@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("丹东",{type:"FeatureCollection",features:[{type:"Feature",id:"210602",properties:{name:"元宝区",cp:[124.395661,40.136434],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@B@@CBA@@@BBC@@B@@A@CD@@C@A@A@@@C@@@@BA@CA@@A@AB@@A@A@A@C@A@@@A@@@C@@@CA@@A@@BEBA@@DADAB@BADB@@BDBED@DCDADA@A@@@@BCBADA@A@C@GB@AA@@ACCACAA@@AAA@@A@@@AA@CCAAAAAAAACAA@@BA@CBB@@B@B@@ABADCBAD@@@@CA@BA@@@CBC@A@A@@B@@C@KDABA@@@A@ABA@I@E@@@A@C@AAA@A@ABAB@@C@ABC@AB@@ABA@A@A@A@@BA@E@A@A@GBABA@A@EBI@CAC@AAAAA@A@@C@A@AA@@AEGA@@ACCA@@A@@ABCBA@A@@@ABA@A@A@ABC@@@A@A@@B@DBFBBBBBBB@@@@B@@AD@B@@@B@B@@B@@BB@@@AB@@BBAB@HDBDB@@AB@B@@@BB@@@@BB@@@@D@@BBB@B@BB@@D@BBBB@B@@@@AB@BBD@@@BAB@B@@BB@@A@AB@BA@@@@BAB@B@BB@@BD@@BB@@BD@@BB@@BB@DB@@@BB@@BB@@@@B@@@@B@B@BAB@B@B@BBB@B@BA@ABA@AB@@@DBD@@@@@BB@@@B@@D@BD@@B@B@@BDAFAB@B@@AB@@AB@@AAA@@@@B@@AB@B@@BB@@B@B@@BBBA@@@@@@@@@@@@@B@@D@@@@@@@B@B@@@B@DD@B@@B@@B@@@B@@A@@B@@DBB@@B@D@@@B@B@@B@@B@@B@@@BA@@B@BBD@BA@@DBB@FAB@B@@B@H@BBBBBB@@@B@B@@BB@B@@ABA@AB@B@@@@A@AB@BA@@@@@A@@@A@@@@@@B@BABAB@@@B@@A@A@A@@BA@@BA@@BADED@DBHAD@BBDBFBDA@@B@FAD@@CBABA@AB@@@@ADA@AFC@A@@@A@EBCBCDCHEFCDADA@ABABABABEB@@A@@BA@@BA@@B@@A@@B@@@BA@@BA@@B@@@@AB@B@BA@@B@@@@@BAB@B@D@BBB@D@D@F@B@B@BABA@@BADC@ABABABA@A@@AA@AAAAAA@GCAAAAAAAAAC@A@A@A@@BADAD@BA@@D@B@BA@@@ACAGCCA@@AAAAA@ACAA@AA@AAAC@AAA"],encodeOffsets:[[127387,41091]]}},{type:"Feature",id:"210603",properties:{name:"振兴区",cp:[124.383237,40.129944],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@C@ECABB@BDBBBFDDBBBBB@@A@@@A@E@AAAAAAA"],["@@C@A@AAA@@@AAA@A@C@@@C@AAA@@@@@A@@@A@A@@@AAA@C@AAA@A@AAC@A@AAA@@@AA@@AAA@@@AAAAA@AAAA@AAACA@AA@@@@AA@@A@@A@@@@@@A@@@AAA@@@AAA@A@@@A@A@A@@@@@@@@@@A@@@@@@A@@@@@A@@@@@@@@@@@@@AA@@AAAA@@AA@@@B@@@@@@AA@@AAA@CAA@@@A@@@@@@A@@@@AA@@A@@@@@@@A@A@@@@@A@@@@@A@@@@@A@@@@@@@AA@@@@@@A@@@A@@@@@@@@@@@A@@@@@@@@@AA@@@@@@AB@@A@@@A@@@@@AA@@@@@B@@@@@@A@@A@@A@A@A@@@@@@@@@@@A@@@@@@B@@@@@AA@@@@@@@@@A@@@@@@@A@@@A@A@A@@@@A@@@@@@@@@B@@@@@@@@@@@@A@A@@@@@@@@@A@@@@@@@A@AA@@@@@@A@@@@A@@@@@@@A@@A@@@@@@A@@@@A@@@@A@@@@@@@A@@AA@@@A@@A@@@@@@A@@@AA@@@@@@A@@@@A@@@@@@A@@@@@@@A@@@@@@@B@@@@@@@@@@@@AA@AA@@@@@@@@@@AA@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@AA@@@@@@A@@@@A@@A@@@@@@@A@@A@@A@@@@@@@@@@@A@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@AB@@@@@@A@@@@@@@@@@@@@BA@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@AA@@@@@A@@A@@@@@@A@@@@@@@@@@@@@A@@@@A@@@@@@@@A@@@@@@@@@@@@@A@@A@@@@A@@@@@@@@@@@C@AACAAAM[@A@EEIYOiK@|¬FHBLCBA@I@CDA@@B@@BBBBBDBB@BDBDBD@BBBB@BBCDEDE@CAEC@@@BAAA@A@A@AB@@A@@B@@@BA@@B@@@@BDJFA@BB@@B@B@@BB@BBB@@@B@BB@@BBDB@FABDDHDFFFBDDDDDDFJJ@B@B@B@DADAHB@D@DB@B@BADB@BA@@BAB@ADFBJBB@BB@@@DAD@BDBABB@FBB@@BABDBBBBBBABA@BB@@BDFBF@BDDBD@BBBBD@BB@@BBB@B@@@D@B@DCHCFD@FB@DBB@@BABAFBF@EFHDFBB@DBBBA@BB@@AB@@@BBB@@B@BAB@BBBAB@@@@AB@B@B@@@@B@@BB@BB@@BAB@@@B@BB@@B@BBABBB@B@BBB@@BA@@BAB@BBB@BBBB@BBB@@BBBB@@AB@D@AABA@A@AB@FBF@BB@HA@A@C@BBB@@B@DBBHCDADADCB@BABBFABBBBBBABE@E@@@@B@BA@@BF@H@F@HBD@D@B@ADAD@@AAAAA@@B@@@@BD@@@@@BCA@B@@@@BD@B@BA@@@AA@A@AAA@@@ACA@@@D@B@@BBBB@@@@@BA@@BA@A@ABAAA@GADCKCBAIA@@@DA@EAEAABGBBD@BA@@@A@@@AA@@A@@B@B@BBB@@AB@BAB@@@BAB@BA@@B@BA@@B@BA@@B@BA@@BAB@BAB@BABAB@@AB@B@DBB@BBDAB@D@B@B@DADAF@AAC@A@CAACCACCEEEGCC@E@BFBF@B@BAB@@ABA@@BA@ADC@ADABABADABABABADADABABADCB@B@BABCFAFCDEAEAEAA@CBCAC@E@C@GBABA@@@@B@@B@D@D@@@@B@@ABA@ABB@BBB@@B@@ABAB@@A@@B@@A@A@@BA@A@@@A@@@A@@@C@A@@@AB@@@BDB@@BBB@@@B@BB@@@@B@B@BB@@B@@BB@@B@BB@A@@AA@AAA@@@AB@@@BB@@B@B@@@B@@@@BB@@B@@BB@@@@BB@@B@BBB@@BBA@@@@B@@@B@@@BA@@B@BBBB@BBB@DBD@@BDBB@D@B@D@BB@BBBBCB@B@@@B@@BB@BAB@BABABA@A@A@@@AB@B@B@BB@BBB@B@D@BB@BBDBBB@BBB@DD@BBD@D@D@D@D@BADA@@BABAB@@A@@B@@BB@DBB@@@D@@AB@H@BAB@D@B@B@BA@B@B@@@@DBDB@@B@B@@BB@@@DAB@BABA@A@ABE@C@@@ABA@AFAFCFAHE@@@FB@BAB@@BAH@B@B@BBB@@@BB@@BBBB@@AB@@@@@B@@@B@DAB@BAB@B@@@BB@@B@BBD@@@B@B@D@D@@@B@@BB@BBB@BBBBDB@@DDD@B@BB@@BAD@D@B@B@DBFBB@@B@@BB@@BB@B@@DB@@LEB@@AB@BC@@@@@@@@BF@B@BBBA@@B@@@@@B@@ABC@A@CBCB@@AB@@ABC@@@@B@@@@B@@BBB@@@B@@@@A@ADABAB@@A@@B@@@@BDB@BB@@BBB@@@@BB@@BB@AB@DA@A@CDA@@@@B@@BD@B@B@DABCBA@@BA@A@@@@@@@@B@D@@BB@@@@@@@DBD@@BB@BDAB@@AB@DBBBBBBBBBDDB@@B@@@BB@BB@@BBBDDD@BB@@BHAD@B@B@BCDA@A@@B@B@BCDC@CFCCA@AA@BC@ABABC@CB@FA@AB@@@DB@@D@@@B@@@B@D@B@B@B@@@BAB@@@DBB@@A@@D@@@B@B@D@@@DCB@@@@AD@AA@@B@DA@@@A@A@CAAA@@AAA@@AA@@@AA@@@AA@@@AA@@AIIQGCA@@A@AA@AA@AA@@CCCA@ACAAACECCA@@@@AAA@@A@@AAA@@A@@AA@AA@@EC@@CA@AAAGGAC@@@A@@@@@@@@@@@AA@@A@AAA@@@@AA@AA@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@AA@AAA@A@@CGAAAE@CBG@A@@BA@ADCDCB@BAB@B@B@B@@@B@@@J@@@@@B@@@@@@A@@@@@@B@@@@@B@@@@@@@BA@@@@@@@@@@@@@@B@@@@A@@B@@@BA@@@@@@@@@@B@@@@@@@@@@@@@@@BA@@@@@@DC@AB@@ABA@@@@@@@@FI@ADG@@BA@A@@@A@@@@@A@@@@@A@@@A@@@A@@@C@@CGCOAEIMCC@AA@@@@A@AAA@@@A@@@A@@AA@@@A@@@AA@@A@A@@@@@AA@@@@@@A@@@AAA@@@@@@@@AA@@A@@AEE@@@B@@@B@B@B@@@B@@@B@@@D@B@B@@@D@@@B@@@@@B@@@@@@@@@@BB@B@B@@@@@@@@A@@@A@@@AAAAA@A@@@CA@@AA@@A@C@AAA@AAA@AAC@@AA@A@AA@@AA@@@@BA@@@@@@@AA@CCAA@@AAAAA@A@AAA@AAAAA@@@A@@@A@@@@@A@@@C@AA@@A@@@A@@@@@C@AB@@A@@@@@A@@@@@@@@BA@@@@@@@@@@@@@A@C@AAA@@A@@@@@@@@@@@A@@@@@A@@A@AAA@A@@@@@@@A@@@A@AAAAA@@@@A@@@@@@@ABA@@@@BA@@@A@A@A@@AA@A@AAA@@@A@@@@@@@A@@@@A@@@@A@@@AB@@@@AB@B@@@@@BB@@BA@@DAB@B@@@@@@A@@@@@@@AA@@@AA@AAAA@AAAA@A@@@AAA@@@A@@@@AA@A@@A@@A@@@@AA@@@@C@A@A@A@A@CB@@A@CB@@A@C@ABA@A@"]],encodeOffsets:[[[127353,41053]],[[127253,40894]]]}},{type:"Feature",id:"210604",properties:{name:"振安区",cp:[124.470034,40.201553],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@EQ@@@A@@@@@@@A@@@@@AAA@@@A@@AA@@@AA@@A@@@AA@@@@@@@@AA@@@AA@AA@@@AA@@@@AA@@A@AA@@CA@@@@A@CB@B@@@@@@@@@@A@A@A@@@@BAB@@B@@@B@BB@@BB@@@BB@@@BBB@@B@@@@@B@@A@ABA@AB@@A@@@@B@@@@ABA@@B@@B@@@@@@B@@@@@@@@@B@@@@@@@B@@AB@@@BA@@@B@@BA@@@@@A@@@@@@@CAA@A@@AC@@@@@A@@@A@@@@@@@@A@@@A@@@@B@B@@@@@@B@@BA@@@@@@@@A@@A@@A@@@A@A@@@@@@@AA@@@@@@@@@@A@@@@@@@@@@@@B@B@@@@BD@BBBBBAB@@A@@@@A@@A@@@@@@B@@B@@B@@A@@@@B@@@@@@ABA@AB@BA@@BA@@@@@@BA@@@@@@B@@A@A@@@BB@BB@@B@@@B@@@B@@@B@@ABA@@BA@@BA@@@@@@B@@@B@@AB@@@@A@@BB@@@@@@BB@@BB@@@@@@B@@@B@@A@@@@BAB@@@@@B@@@BA@@B@@AB@@@B@@@@B@BB@@@@@@@BB@@B@@@@@B@@@B@@@B@@B@@BB@@@@BB@@@@BB@@@@@@@B@BA@@@@@A@@@@@AB@@AB@@@@AB@@@BA@@BABA@@BA@@B@@AB@@@B@@@B@BAB@@@BA@@@@B@@AB@@@@@@AB@@@@@B@B@@@B@@@B@@@B@@@B@@@B@@@@@@@@ABB@@B@@AB@B@@@@@B@B@@@B@@@B@@@B@@@BA@@@A@@@AB@@A@@@@B@B@@AB@@@BA@A@@BAAAB@@A@@@@DA@A@C@AAC@CAI"],["@@CC@@CAAAAAA@AAA@@AA@@@C@C@A@A@@@C@AAA@@@AA@@A@A@ABA@CBA@@@A@@@@@A@@BA@AA@AA@@A@@AA@A@A@ABG@AA@ABA@@E@@GFEBEDEB@BAB@B@@@DAF@B@BABABA@CB@@A@@AA@A@@@CACA@@@@@A@AABA@A@C@A@ABG@A@@BC@@@A@CAA@@AA@@@@BA@ABAB@@ABB@BBB@@@BB@DB@D@BBB@@B@BBB@B@BB@@@@B@B@@BBB@@@@@DC@@@@B@FB@@GBABAB@B@@@@B@@@@B@@@@@B@@C@@@@@@@@@AA@@A@@B@B@A@@AC@A@@A@A@A@@BA@A@A@@@A@AB@@@@@@BBBB@@@BABA@@@@B@@@B@@@@CBA@A@A@AA@@@B@@@B@@@B@@@BA@@@A@AB@@@@@BBB@@@BGBABAAA@@BAB@BC@A@@@A@ABA@C@A@@@@@AAAAA@A@A@A@AAA@AA@@@ABA@A@@A@@A@@AAC@@@AA@A@A@@@AB@@@@A@@AA@AAA@@AAA@@@A@@@A@A@AAAAA@A@@@AACCA@A@AAAAAA@@@A@@EAA@E@EBA@@A@@@AB@@@A@@@AB@@A@@@@@AB@BB@BB@@C@@A@@@A@A@AAAAAA@AA@BA@@@@@@@@@A@@@A@BB@@A@@@A@@@@@@@@@CD@@@BB@@B@@@B@@@B@BAB@B@@C@ABE@ABA@@B@@BB@B@@@B@DBB@B@@@B@@@D@B@BBBBBB@B@@@@BDD@BBD@B@BAB@BB@@B@BDBBBBBBBF@D@BB@@BBB@BB@@@@@BBB@BBB@BBD@BBB@B@@BBABDB@BDBD@B@@BBB@@AB@B@@@@BB@@DB@@@B@@BB@@AB@@@@BB@@B@@B@B@BB@@@@BBBBB@@BB@B@BBB@@BB@BB@@BAB@B@B@B@@@B@@@@ABAB@@@@C@A@AAABA@@BA@E@@BA@A@ABA@AAA@CC@@CACAC@C@CA@BA@A@@BA@ABCB@BC@A@@BB@@B@B@B@B@@CD@@ABBB@@@D@@@BABA@@B@BB@@@ABA@@BA@A@C@CBA@@B@@@@@B@BABA@BBABABCBA@ABA@BB@B@@BDBB@@A@A@AAA@ABA@@BA@C@@@A@C@@@A@AACF@BC@A@CAA@@@C@@@@@@@AAA@@@@@A@AAA@A@A@EBA@CDC@@@AB@@BF@@A@A@@@@B@BABA@A@@BA@BDBBB@@@BB@B@@@DA@@D@@@BCBABA@@B@@DB@BBD@BAB@B@@@BA@@B@D@@@B@BB@@B@@@B@@BBBB@BB@BDBDB@BBB@@@@BAB@B@D@@@BDD@@BB@BDBB@@@BBBB@@A@AB@@@@@B@@ABB@@B@@@B@BB@@BB@BBB@BBBBBBBBB@B@B@@@DBBB@@BB@B@@@B@B@BBB@@@@@BBBB@@B@@@@@BBB@@BB@@B@B@@@@@BABA@@BAAAB@@A@@B@B@BA@AB@@@DD@B@BB@F@@BD@@@B@B@BBD@DBB@D@@@BBBD@@BB@B@@@BB@DBB@B@BB@BD@BAD@@@BBB@@@@B@B@B@BBB@@@@@@@B@BB@D@@@B@@AB@D@B@@@@B@BB@B@BB@D@@@BBB@BB@@B@@BBB@B@ADDF@B@B@@@@CD@B@BADB@BB@BBB@@@B@B@@BDBB@@B@@@@@@BB@@@@D@@@B@@@BAB@B@B@B@B@B@@AB@@@BA@BD@DDB@@@@A@@@@B@@B@BD@@B@@BD@BB@@@BA@@B@@AB@@ABAB@B@@@B@@B@@B@@DBB@@@BB@BB@BB@@@B@@@BA@@@@B@BB@@B@@D@@@BABAF@@@D@B@BBDDDBH@HBDBDDFDDBDBD@J@HAJABAHCBABA@A@C@G@AAAACBA@@@@B@B@DDB@B@@@DBB@@B@@BBBBB@B@B@B@@A@@@A@@@A@@@@@@@AB@@@BA@A@@BA@A@@BAD@BA@C@@BA@ADA@@BA@A@@CCCC@AAA@C@A@ABADC@@D@B@BAB@@@BA@@B@@@@@BA@@BA@@DABAB@DADBB@FABAB@BA@@@A@@BA@@DCF@B@@@DBB@@AB@@AB@@@@@B@B@B@@@B@DBF@@@B@B@@@B@@@@BBB@@B@B@@@@BBB@B@BB@B@B@BBB@BB@B@BB@B@B@@@DBB@B@DAD@@@B@@@@@B@BB@@BBD@@@BAD@B@@@B@@@B@@A@@CC@A@A@@BCBA@@BA@@DB@@B@@AB@BAB@@@B@B@BDB@B@BA@@BA@@@@@AB@CCACAA@A@ADCBADCDAHCFAFCJAD@J@DAB@B@DCFC@CDADIBAFK@CBCBCBCBCBADAD@VAL@L@LBJBHBF@F@L@H@JALADCDAFAFEHIDEDGBM@ABODK@CBKBABA@E@C@AAC@A@EBI@CDAJK@A@AA@@@@@@@@A@@AA@@@A@@@@A@@A@@@@AA@@@@@A@@@AA@@A@@@A@@@@AA@@@@@@@@@A@@@@@@@@A@@@@@@@@@B@@@@B@@@@@@AA@@@@@@AA@@AA@AA@@@@AA@@@@ACA@AA@AAA@@A@@CA@AAA@@AA@@@AA@@A@@A@@@@A@@AA@@@A@A@@@A@@@A@@@@A@EA@@@@A@@@A@@@A@@@C@@@AAAAC@@AA@A@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@A@@A@@A@@@@@@@A@A@A@@@AAA@@@@@A@@@@@A@@@A@A@A@@@@@AA@@A@@@@@A@@@A@@@@@@@A@@A@@A@@@@@@AA@@@@@@@AA@@@@A@@@@A@@A@@@@@@@@@@@@@@@@B@@@A@@@@A@AA@@@@A@@A@@@@@@AA@@@@@@@@@@@@@@AAAA@@@@@@@@@@@@@@@A@@B@@@@A@@@@A@@@@A@@@A@@@@@A@@@@@@@@A@@A@@@@AA@ACCA@AA@@@@AAAA@@@@@@@@AAAA@AA@CECE@@@@AA@@CCA@A@GAEBCA@@ABA@C@@@ABC@CBAB@@@B@B@BBDBBBBBBBBHDB@BBBB@BBB@@@BABABAB@BCDAB@@ABABA@A@E@C@C@A@AAC@A@A@AB@@@@A@@@ABA@A@@B@@A@@@AB@@AB@@A@@@@BA@@@AB@@AB@@@BA@AFABABAB@BCBCBEDGFCDADAD@F@B@@@BED@BCB@B@@A@@BABAB@DC@EBA@@@CBEACAAAC@GBCAC@CFAB@@AB@@AB@@@B@B@BA@@@A@ABABA@@@@@@@@B@@@B@@@@ABA@@B@B@@A@A@@BAB@BA@A@@AA@A@@@A@AAAA@A@G@AA@A@EBA@CA@@ABC@AAA@@@AB@@A@@@@AA@@@@A@A@@@C@AA@CA@@@AB@@@@A@@@AA@@@@ACCA@@@A@A@@@@@@@C@@@@A@@@@@@@@@@@@ABAA@@@A@AA@@AA@A@@BA@@@@@BB@BA@@BA@@BA@A@EBCB@AA@A@@@ACC@@@@A@@AA@@@@C@CA@@A@@BAB@BABA@A@AAA@A@A@ABA@A@@@@@@A@@A@@AA@@A@@CAA@@AA@@AC@@AA@@AC@@AA@@A@ABA@A@@B@@ABAB@@@AA@@@ABA@A@@AC@ABA@@@@@AAAAAC@@@AAA@A@AA@@@C@@A@@A@@A@@A@@@ABA@@CACA@GBAAA@@BA@@A@@AA@@@@A@A@@@ABC@@@A@@A@AAAAAAAE@C@AB@B@@@D@BAB@B@B@BA@@B@B@DABA@@@BB@DD@BB@FH@BB@@B@B@DB@B@BBBBD@DBJ@FAB@B@BAHAB@B@F@B@@AB@B@B@B@BA@@BAD@BAD@@@BABAB@B@BBD@B@@@F@J@B@BAB@@@B@BALCD@@@@AB@B@D@DA@@B@@ADB@@@@BCDABCBA@@@A@AA@@AAA@@AC@C@@@@@@AA@@@C@A@@@@@@B@B@@AB@DABA@C@A@AAC@@@A@@B@DCB@B@@CBAA@@AA@@A@@A@AA@@AAA@AC@@@@@AB@@@BABABCB@@@@@@A@@AA@AA@@@@@@A@@D@BA@@BA@@DADAB@D@BA@@@A@@@@@AB@AA@A@AAE@@@@@@@@ADA@@BA@KF@@CA@@@AAA@@AA@@@AA@EACAA@A@C@C@AB@@AAA@C@"]],encodeOffsets:[[[127729,41313]],[[127299,41054]]]}},{type:"Feature",id:"210624",properties:{name:"宽甸满族自治县",cp:[124.783659,40.731316],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@@@A@@@@@@@AA@@@@@@@@@AA@AA@@@@@AA@@@@@@@@@@@@@@@@@@@@@@AA@@@@@EC@@@@@@@A@@AA@@A@@AA@@@@@@@@@A@@A@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@A@@@AA@@@@A@@@CAA@@AA@@@A@@AA@@@A@A@@AA@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@A@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@AA@@@@@@@@A@@AA@@@@@@@@@@AA@@@@@A@@@@@@@@AA@A@A@A@@AA@@@A@@@@@@@@@@@@A@@@A@@@@@@AA@@@@@@ILCB@DAJ@F@BBD@B@D@FABABAL@DCLAP@BANCHCFGJEFEBCBCDKBIBG@K@E@E@GAIAKAK@K@UBC@CBABADADADAD@DELABCJCB@DEDCDA@A@CBI@C@IBEDEBGDCBCDABCD@B@BBBBDDDHFHFBDDJBBB@@@@@BA@C@A@@@ABCAA@@@CBAFDF@DBDAB@B@@@B@B@@@B@BADAF@B@BAB@D@@@B@BB@@D@@@B@BB@@@DB@BBDDB@B@F@B@@@@@BA@@@@B@BBBBBFB@@BB@@B@@D@@@B@@BB@@B@B@B@@@B@B@BB@@@B@@AB@B@@@@B@BBB@@B@B@@@BB@@@B@D@B@B@BA@A@@BB@@BB@DB@@BBBB@BB@BB@@ABA@A@@BA@ABABA@BBB@@BB@DBBABB@@B@DBB@B@@BB@BDBBBBDB@@D@B@@@@@BF@B@BB@@@BAB@@@B@BBDAD@@@@@B@@B@@DB@DBB@BBD@@BBBB@B@BB@@B@B@BBB@@@BA@C@@@AB@B@BAB@@@@A@G@EA@B@@CB@@AB@@@B@B@BABA@@@A@@@ABBBABBB@B@BB@@B@@@BBB@@BBBBB@@@@B@@@BA@@@C@ABA@AB@@CBAA@BA@@BA@A@@BABC@@B@BA@@@A@@A@@A@@@@AA@AB@@A@ABCB@@AAEA@@A@@@@@EBGDEBCH@FLDLAPDCNCBQNOFKNITDJBHFJDLBJ@HAHELIFIHIRADIDED@DAF@@@BA@AB@B@@A@@BA@@@@B@F@D@@@@BB@@DB@@@B@D@@@B@B@@A@@@@@@D@@A@@@ABAFAB@BB@@B@@@BADA@@BAB@B@@B@BB@@@@AB@@@B@B@@AB@BA@A@ABA@A@A@A@@@C@A@@@ABAB@@A@C@A@ABAB@@AB@B@D@BAB@@A@AB@B@BABAB@B@BA@@@@B@@@B@@BB@BCB@BA@@@A@@@A@C@@@C@AB@@ADA@@BA@BBAB@@A@@@@B@@AB@@AB@@@BA@@@C@@BBB@@BBBB@@@B@@AB@B@@@@@B@@AB@@@@@B@@@B@@@BBB@BBB@B@@@BA@@B@BA@@B@@BAB@@@B@BB@@B@B@@B@@BAB@@@B@HEJGLCTDHFNFHBH@JAPCPCNHHDFFDFEHAH@PBHDFFHFHNHTFGTAH@FFHDBJBVHPJFBBBDFDHBLCJELDHH@JBHBBB@@BAD@B@DAB@@@B@@BBBB@@@@BBDBF@B@@D@@@BDBB@DB@BBB@F@B@DDB@B@BBB@BB@B@B@@@B@@@BB@BBB@B@@B@@AB@@@@@BBD@@@B@B@B@@A@@BAB@B@@@@B@ABAD@BAB@@AB@@@B@@@B@@@DB@A@@B@BA@ABCB@@@BABBH@@AB@B@@@BABBBAB@@@DA@ABA@CB@BA@ABA@C@@@@@AB@BABABCF@BA@@BGDA@CBA@CBA@@BAA@BABAB@B@@AB@BB@@BB@@B@@B@@@B@B@@@DBDB@@B@BAB@@@BBBBB@@@B@B@D@B@B@@@BBBDAB@B@@@B@D@B@@ABABA@A@ABCBAB@@@B@@@B@@@BB@@@@H@B@@BB@@@B@@DD@DBD@BABB@@DB@@@BBB@BBBD@B@@@@@BB@B@B@@BBBDBB@BD@@B@B@B@D@BB@@BBBD@BB@@BA@AAA@A@A@@BAB@@BB@B@BB@B@BBB@@B@B@BCDBB@BABBB@B@BB@@@@BB@@BFB@@BBDA@@@BB@D@B@B@F@@@@P@D@@AF@@@BADAB@@@B@@@B@D@BAD@BEB@B@@BBBB@@@DAB@@@B@B@B@@BD@B@B@B@B@@EDCBABAB@B@B@@@BBB@BA@@BAF@BA@CB@@@@@D@D@DBDBD@BBB@B@@@D@B@B@B@B@B@DCB@B@B@B@BEDA@AB@@@@BBAB@BA@ABABA@@D@@@BBBBB@@AB@@@@BB@BBB@@BB@@@D@@@B@BA@@BB@@D@B@@AB@@@F@@BB@@@@ABABAB@@@BCBA@@DCB@BA@EFAB@B@BABAB@BAB@F@B@D@H@@B@BBBB@BFFBB@BBD@F@BB@DBJDBBD@@@BBBBB@DBDBDDBBBB@@@B@BA@@B@@AB@B@@FD@@@D@B@@@BBB@F@@DBBBB@B@B@@BB@B@B@B@@BBD@@@BB@B@@@B@BAD@@ADBJDDB@@@B@@BB@@FBB@B@BAB@B@@BDBB@FAD@@@BBB@@@@AB@@@B@BADCB@DA@@FBBADADADA@@@@B@B@B@B@B@BCDA@@@A@@DBB@DBFBB@BAB@FD@@D@@@BBB@BBF@D@BDBDBDBBD@D@BADEFGDCBCB@DA@@@B@@B@@BF@B@JDF@BBB@B@B@BBBB@B@@BB@@AB@BCB@@@BBB@B@D@B@@BF@@@D@BBB@@FDBBB@@B@@@D@B@B@@B@@B@B@B@@BBB@DB@@DADAD@@@B@@@FBD@B@DAD@FABBD@BBBB@@B@DBBBBBBB@BB@B@B@@@B@B@@@BB@@BDAB@DAB@BB@BB@B@BDBDB@@BB@BDBB@FB@B@@BBBBDDB@@B@@F@PCFATSJGPCNC`ARFJFLHHBLAHCRENAF@TBNEXKVE@@B@DABADABA@@D@@@B@D@B@B@DAB@F@D@@ADADAB@B@D@BBD@D@B@@@B@BABAB@D@F@H@@@D@@AF@D@B@B@@@B@HAB@@@BA@@BBB@FD@@DAB@B@D@B@VERENEJIHC@AB@@@@A@@BABBF@B@F@BA@B@@DDBBB@@@B@@@@D@B@@@B@BDDBBD@@BD@B@D@BAB@@@B@FDB@D@D@F@DBB@D@DB@@DF@@BB@@B@B@B@D@DAB@BADAB@DCRIHELENIZGPEF@`H^HTJPD^DPAJAPALEBBVJVLLDNAXKRIJGB@B@DB@@BAB@@A@AB@B@D@BBBAB@BBBBBB@@@BB@B@B@BABADABAB@B@BCFCJCTGHEBEDE@EBMBGFGJIDC@CACIIA@DC@@F@@@BDB@@@B@B@F@B@BBB@B@@ABADC@@DC@A@A@AB@@ADB@AB@DA@@B@@@B@DBD@D@BBFBB@BABADG@@BC@@AAAA@AC@AACA@@A@@AA@AE@A@@AA@C@@A@AC@@AA@A@AAC@@@ADAB@B@B@@@B@BCDCDE@@BA@ABA@A@@B@@@B@D@B@B@FAB@DBH@RB@@L@BAD@B@DB@@BB@BBBAD@@IL@D@B@BBBHBFBD@HALED@J@D@F@D@B@FAHCF@BBB@DH@F@D@D@H@DFFF@FBB@FAD@HCHEFEBCBGAI@C@EBGBA@ADEDEBCACCECCKKAGAEBC@CBADCFEHCB@DAHAXEPEHAD@D@D@BBBFBD@FAFADEDKHEBABAD@BBFBBDDDDDBHBD@F@TBJ@D@B@HAFCBCBC@I@EEOEMACIGKEECICCACAAEACBAHOLMFGHIFEBAJEHAH@HBJBJFHPFNXJJ@PKDEDA@@BCBA@ABEAECCGGCIAEBEDEJAF@JDRHTJLDD@NDJBJ@LADAFBBDBB@F@DDBFBFAHAFGHEHCNCLEHEB@JKLOJMDEDI@C@AGE@AA@@AAACC@@CAA@AAC@A@C@C@@@@@A@@@BA@@BC@@B@@@@A@@@@@AA@@@@AIKCECAEEECCA]MQCCAKMGKCIDKFG^KZCPENGJOAICCKASBY@SAOAUCUCYHKHEF@B@JBH@JW@KEIGCAOMQMIKAMBE@KEU@ACCCECAKC@@QBMB_CEBMDSCMGIKAAQIGGAM@C@CAIEK@OCA@A]@A@E@M@MCEAACCEAC@IHMFKBM@MCAIAOAIFOPW\\ODGCQGKAEAWMOAIBIH[VKFQCEAE@OEEAGCEGAEAIAK@IIMCEGAA@MAO@KAKBC@E@WDUC]GOGOGEEMIQKIGuYUOWCQEWCI@ICUGGASIGEOIEAMAOGCEKOKOEIDMBSPGJAF@VBBEAGBAR@FABGBUAEIAMCG@CBC@@@AD@B@@BD@FBB@DABC@CACCAACAA@E@@@@@GBEDEBEBGBC@C@CAGAGEGGKEIEKCKCKCG@IAOBUDCBGFCJ@T@BAHCBABGBA@EAAAIGGKEIQWGGAAKMMIMKKM@AA@CA@@AACAA@CA@ACAA@AA@@A@@A@@@B@@@@@A@@@@@@@@@@@@@@@@@@B@@A@@A@@@@AA@@@AAAAAAA@@@@@@@E@@AA@@@@@@@@B@@@@@@@AAACAA@CCECA@@AA@@@AAAAA@@@AA@@@@A@@AA@@@@@A@@ACAA@AAA@A@@@@AC@CAAAA@A@@@A@EAC@@@A@@@@@A@@@@A@@A@@@AAA@A@@@A@A@@@@@B@@B@@@@@@A@A@@A@@@@A@@@@@C@A@@AGA@@KAMG@@IEEA@@IECCGEEECGCKAICB@@@@@BA@BBAB@@@BAB@@A@@BA@A@@@@@@BA@@B@@@B@@AB@@A@@@A@@@A@@@A@A@@@@@A@A@@BA@@@AA@B@@@@@@A@@@A@@@A@@@A@@@A@@@A@A@@@@@A@@B@@@@A@@BA@@@@@AB@@A@ABA@@@A@@@A@@BA@@@AB@@ABAB@@AB@@A@@B@@A@@BA@@B@@@@@B@@@@ABA@@@@@@@A@@A@@A@@A@@A@@AA@@@@A@@@A@@@A@@@@@AA@@A@@@@@@AAA@@@@@@A@@BA@@@AB@@A@@@A@@@@BA@A@@B@@@@A@@@A@@@@A@@AA@@A@@@@A@@AB@@@@@BA@@@A@@@A@@@@B@@AB@@AB@BA@@@A@@@A@@@A@@@AA@@AAA@@B@B@@@@A@@@@B@@A@@@@B@@AB@@ABAB@BA@@@@@@@A@@B@@@@AA@@@@A@@@@B@@@@B@@B@@@BAAAAA@AAC@@@@@A@A@@@@@@@@@@B@@@@@@@@@@@BB@@@@@@B@B@@@B@@@@BB@@@@@@@@@AB@@@A@@@@A@A@@@@@@B@@@B@@@@@@B@@@B@@@@@D@@BB@B@DB@@@@@@B@@@@@B@@AA@@@B@@A@@BA@@@A@@@@@@@A@@@@@@@@@A@@@@A@@@@AB@BA@@@@@A@@B@@@BAB@BAB@@@@A@@@@@AA@AA@@A@@A@@AA@@AAA@@@A@@@BA@A@@B@B@B@@@@@@@@@@@@ADAB@A@AAA@@@@@A@@@@@AA@@A@A@A@@A@@@@A@@@CA@@@@@@@B@@A@@@@@@AA@@@@@A@@@@@@@@@@B@@@@@@@@@@@A@@@@@@@@A@@@@@@A@@A@@@@@A@@@@@A@@A@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@AA@@A@@@A@@@AA@@@@A@AA@@A@@@CAA@@@AA@@@@@@@B@@@@A@@@@@@A@@@@A@@@@@A@@A@@A@@@@@A@@A@@A@@@A@@@AA@@@@@@A@A@AA@@EAA@A@@AA@@@@@@@@@@@@A@@A@@@@@@@A@@@AAA@@@A@@@A@@AA@@@A@@@A@@AA@@@A@@AA@A@AA@@A@A@@@@@AAA@@@A@@@AAA@@@A@@AA@A@@@A@@@AA@@A@@@A@A@A@@@AB@@A@@@@AA@@@@@A@A@@@A@@@A@A@@@A@@AA@@@A@@@@@A@A@C@@@AA@@@@@@A@IA@@@@@@@@@@A@@@@@@@A@@A@@@@A@@@@@A@@@@A@@A@@@@@A@@A@@@@@@@@@@@@A@@@@@@@@@@@@AA@@@@AA@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@A@@@@A@@@@@@A@@@@A@@A@@@@A@@@@@@A@@@@@AA@@@@@@@@@@AA@@A@@@@@AA@@@@@@@@A@@AA@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@A@@@@@@@@@A@@A@@A@A@@@A@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@A@@@@A@@@@@@@@@@@@AA@@@@@@@@@@@@@A@@@@A@@@@EA@@@@@A@@@@@@@@A@@@@@AA@@AA@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@A@@AA@@@@@A@@AA@@@@@AA@@@@@@A@@@@@@A@@A@@@@@@AA@@@@@A@@A@@A@@@@A@@@@A@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@A@@@AA@@AAA@AAA@@@@@"],encodeOffsets:[[127550,41215]]}},{type:"Feature",id:"210681",properties:{name:"东港市",cp:[124.152705,39.863008],childNum:9},geometry:{type:"MultiPolygon",coordinates:[["@@@@B@@@@A@@B@@@AA@@AB@@@B@@"],["@@@B@@A@BB@@B@@@@A@@@@@@A@@A"],["@@A@@@@@@DB@@@BA@A@@@@A@"],["@@@A@@A@@@@@A@A@@@AB@@@B@@@@B@@@D@@@B@@@@A@@@@"],["@@@@B@D@@@@@@AAAA@A@@@@B@B"],["@@A@@B@@@D@@DB@@@@@@B@@@BA@@@AA@@A@@@@CA@@"],["@@A@ABABABA@A@AACACAABA@CBC@AB@BBB@BDBFDHBF@H@B@@A@@@AAC@ABABA@AB@@AAA@@A@"],["@@C@E@@BABBDHLDBHDCHBBVFNAFBDALCBAHEBCDE@AAEA@CAC@A@EBC@U@OCE@CCEAC@"],["@@SJM@KCOKAMD]GKe]UMWE]AEDCDAJABE@C@ACEE@AAA@ABE@CAAACCCACC@GAE@AAGA@CAAE@CBEHAFFDFB@RCJEDAFABIFC@AACCA@K@CCAUACCA@`@ZATELQJCBER@JDN@@@@BFGRQJQFGLCFEP@JBB@B@@B@B@@B@@@BBBBDBB@B@BB@BBDBJFBBBB@B@D@@@@@@@BAB@@CBKHADCFEH@BA@AD@BCF@B@@AB@@A@@AAAAAAAAAA@@@A@@@@@EC@@A@@@A@@@AD@@D@@@@BB@@BDBB@@B@@@@ABCBA@@@@B@@AB@AAAA@AD@B@@C@@@AA@@@@BA@@@AC@A@@@AA@@@AAC@A@@CBA@BBBD@B@@@B@@@B@@@@A@C@A@@@A@AEA@@AA@A@C@@A@@AACFC@ABA@@@@BAB@B@@C@ABCB@@CB@@A@@BABA@A@CAA@CBA@CB@@G@AA@AAC@C@@@@AAA@GAA@AA@@@@@@CB@BAB@B@@BB@@@@BB@@BBB@@B@@@BA@AB@@A@A@AB@@CAA@@@@B@D@B@@BB@DBBBBA@@@ABA@@@BBDDDC@@@B@@@@@B@DAA@@A@@B@@@B@B@B@@@BA@E@E@A@A@@@CF@@B@@@AD@BCD@B@DABCDAD@BA@B@BBD@B@DBB@BBFBDBBDBBDBBBB@B@D@DBB@@B@B@B@B@@AB@@@BBB@@@B@@@BAD@BB@@@@BB@@BD@@BB@BD@@B@@B@B@B@B@@ABA@@D@B@B@BAB@@BBB@@BAB@@@BB@BB@BB@BBBBBB@@BBBBBB@@@@BBB@ABBB@@B@D@@BB@BFDF@@@@B@@@BBB@B@B@B@@BB@@BDABB@@D@B@B@@@B@B@DBB@B@@BBBB@@@D@B@BBDADAD@@AB@BABAD@B@F@B@B@B@B@B@BAB@B@D@B@F@@@@@@@ABA@@BAB@B@@AB@BCAA@E@EDCDCPAHAFABE@A@A@@BE@A@BBN@D@HEBCDG@@ACAEBA@@D@@@@ABAB@BBDD@FB@@@BCBABB@F@@D@DBDBDBADAF@B@@@@@DB@AB@B@BA@@DABAB@B@B@@@@AB@BAD@@BBDDDBB@B@@@BBB@BBBBBB@@D@@@B@BBBBD@B@@@BB@BB@@D@@@@B@HBB@DB@@B@DBD@@BB@BBD@@B@@@@@BAB@BB@BBBBB@@@D@@@BBB@@BBB@BD@D@@@@@B@DB@@@B@@@BB@B@B@@@B@D@DB@AB@B@D@B@@@@@B@@@B@BABBB@@ABA@AB@D@B@BAB@@@DD@@BBB@D@BBB@DA@@@@BF@@AD@BBB@BBDBB@@@@BBBBDBB@BA@@BB@@D@@@@@DD@@BBBBBBB@@@BBB@@BDFB@DBB@BB@BD@@@@@AD@@BBBB@D@@JLHLFJBHDJ@D@JADKDHDHHHD@@LBLBB@DBJBD@DBDAB@F@D@DALCDCFC@A@E@EBABADCDGDGFGFCDAB@DBF@JFFDB@B@DALGHIBE@E@C@GAE@ACC@AAA@ACCCCECECICIEAAAAAAAEAG@C@C@GDI@@BC@ADEB@FEBABCFEHCDAFEBA@ADA@CBC@CBCDEHIHGJQDAB@@@BDB@F@D@FABABC@AB@BBBBBHDBFDLDDB@DABABBBB@DAFC@AB@BAVM@@@BBBBDBD@HBBBDBDB@BBHBD@FDFDBBB@F@B@BAD@JCDADA@@D@FA@@DAHCDAF@DAF@NEF@H@B@L@@@DAB@DCBCBC@@BBB@B@FD@BD@@@BB@B@BB@AB@D@@@BB@@BB@DB@BB@@BABAF@@FDB@B@DBB@DBDBDAJEBONGL@JDJFLHNDTCZ@H@LAVBRELBL@@@@@@@D@@@B@B@@@BAB@D@@@B@B@B@B@@B@B@@AB@@DBBB@BBB@@@@EB@BA@A@@@@@A@@BAB@@@@@B@B@@A@@BAB@BB@@@@B@@@BBB@D@@@B@B@B@B@DBB@@BBB@@BA@@B@B@@BBB@BBB@@@BB@@@@@B@B@B@B@BB@@B@@ABBB@B@B@BCBA@C@@@@B@@BF@BBBBBB@DB@@B@@BB@@@B@@@B@@B@B@@@BA@@BB@@B@DB@BBBBB@@@B@BBBBFBBB@@@B@@@@BB@@B@BA@@B@B@D@D@B@@BBB@@@@DBA@@B@BBBDBBBDDB@BBBB@@D@B@B@B@@@@@BBB@@BB@BBB@@@@BBD@B@B@@DBBB@B@B@BFD@BBD@@BB@@@@DABA@@D@@BB@@DBB@B@@@B@DBB@BB@@@AB@BA@AB@@@B@B@B@B@B@@ABA@@B@@@@@B@@@BA@@B@@@@DB@@BB@@BBD@BBB@BB@BDB@BBABB@@@BBBDDB@BDB@DBB@@@BA@@FBB@B@@A@@@A@@@A@C@@DA@@B@B@F@@BB@BBB@@@B@D@B@@B@@@BB@@@DBB@B@@@@B@@ABED@B@@@@BB@@BDB@BB@@@@@BB@BBD@B@BBB@@@@BA@@@@BB@B@@B@BB@@BB@@BDD@@BDB@B@@B@B@@BB@@B@D@@@D@BBB@B@@@@B@B@BB@@@B@@BB@BBABBBB@@@D@B@@@D@B@@AB@BAB@BBB@B@@@AAAC@@@AAAB@BAB@DABABAAAB@BA@A@A@@@@@AB@DAD@B@B@@AB@BA@@A@@A@AB@BA@A@@@C@@AABA@@DC@@@A@A@A@AA@@AB@D@@ADABAB@@AB@B@@ADBD@D@DBDB@@DDB@BBB@BAB@B@@AF@B@@AB@BABBB@D@@@@@BABA@@@@@A@@@A@A@ABA@AA@@AAA@@AA@A@AAA@@AAAA@A@@A@@A@A@AA@@@AA@@@@BA@@AA@@@A@@CA@@AA@@@@@ABA@@AA@AA@C@CA@ACABAAA@@@AAA@AAC@AAA@AAA@A@@@@AAA@AA@@AAC@E@AAAAAACA@A@AA@@ABA@A@AAC@ACC@A@@A@A@AAAA@A@A@C@@@A@@@AAA@C@A@@@AAA@@@AB@BAF@BAD@@@@ABA@A@A@@@A@@@AA@@A@@DC@@@@@@@@B@@@B@@@AAB@@@B@@@@@@@@@B@@ABBB@BBBB@B@B@B@@@BD@@@AAA@@ABA@@@@B@@@BA@@B@@@A@@B@@@BB@FAF@B@FB@@@B@@BBBBBBB@B@DDBB@@B@B@BBBBB@B@@@B@@@B@BB@@BB@BBB@@@B@@A@@B@@@B@BBB@@D@BB@@@BB@@@@BAB@B@@BBB@BBB@B@B@B@BBBB@@@@B@D@B@BAB@@@B@D@@ABA@AB@BBBAHA@A@@AA@A@@@@BAB@@@B@@A@@@A@@@A@@@A@@BBB@B@B@DA@@@@@A@@@A@@B@BA@A@@AAAA@@@@@@BAB@@@B@B@B@@AB@B@B@@@@BBD@@@B@A@AB@@@BB@@@@@@@@D@@@@A@@@@@A@@A@@@@@@ABABAHA@@EAA@@@@@CD@@@@A@AA@@@A@A@@A@@A@AAA@A@AA@AAC@A@@CAA@@A@AAA@BACBABC@C@C@C@C@AAC@@CAA@AAACAAAA@@A@C@AAA@AAAA@A@A@@B@@@B@BABABABA@ABA@@AA@@@A@A@ADAA@AAAC@A@C@A@CA@AC@CAA@AAA@AA@A@AB@@A@@@A@@@A@@B@AA@@AA@A@AA@@A@@A@@AA@@@AA@@@@@A@@@A@AA@@A@@BA@@B@BBB@@BB@A@@A@AA@@AA@@@AAA@A@@@@@AAA@@@A@AA@@CA@A@@BA@@B@D@@@B@@@B@@@B@B@@AB@B@@@@AB@@@BABA@@@AA@AAA@BAB@BA@@@A@@C@C@A@@@@A@@B@BAHAD@F@D@DBDAB@FBFBFBDCBEDEBA@A@ADABCBABABCBCBABABABCBABABCD@BCB@@AB@BA@@BA@A@AAEAEF@D@HDFFDFBDDDBB@D@BBD@BBEBC@C@A@A@CBAAC@AAA@C@ABA@@BABA@ABA@ABA@AB@@A@AB@@A@AB@@A@AB@@ABA@A@@BA@ABA@@AA@A@A@AB@@@BB@@B@@@B@@AACHABAFBFBB@@C@@JBABLDCDHBB@BBBAB@B@@AB@@A@@@@AAAA@@@A@C@@DB@B@@BB@B@BBB@@B@@A@AAC@@@@@ADB@A@@@@AC@@@@@AB@BBBB@@BCBCA@C@C@GAE@G@E@@AB@@A@A@@F@F@BAAAAAAAEBAAABA@CDCBCBGDAA@C@AA@AAD@B@B@@GAAE@EAA@@B@BABBBC@A@@BA@AA@AA@AAA@AA@AAA@ABA@AB@@AA@AAA@A@AAAB@A@AA@@A@A@@BA@AA@@AAA@@@A@@A@A@A@@B@@A@ABAAA@ABA@@@AA@A@@BA@@AAB@AACAA@EAGCFEE@EAABAB@@AA@CEAC@DEDG@C@A@C@@@AAA@AA@@AACAA@AACCC@AAECE@AA@@AABABAAAACABA@AA@EAA@BACA@ABC@C@@AAA@IAEABCA@AB@@ABA@BC@A@ACAC@A@BGBC@C@A@A@AIICECCCCACEECECGACEBA@AC@AA@@A@A@@AA@AAA@@@A@AA@@AEBCI@A@@A@@@AB@@A@@@@BA@@B@B@BBBAB@@D@BF@DCFCFAD@AAAAAC@CACA@AAAACAAAA@@@AB@DCJ@B@DAAKEG«GFGN@PXvGJSCG@cGWIMEeEKCuIG@EBeHSFULIHOD_AcBsF[@iF]HODO@Q@QGOIcA[AyEkKcII@GBEFCPGJGF"]],encodeOffsets:[[[126786,40742]],[[126805,40737]],[[126761,40743]],[[126545,40732]],[[126729,40706]],[[126567,40718]],[[126798,40742]],[[126686,40700]],[[126644,40783]]]}},{type:"Feature",id:"210682",properties:{name:"凤城市",cp:[124.066919,40.452297],childNum:1},geometry:{type:"Polygon",coordinates:["@@IDC@ABA@E@A@AAECECC@GAAAA@ACACAA@GACACAA@A@@UNABA@@BEDCBA@AABABA@CCAKCECCAAGAAAAA@@BADABEBC@E@A@AC@@A@CBIRGHGJCFAD@DAD@DCB@BABEFCBGDEFADABEFA@CF@BAD@@CJ@H@D@DBHBFBBBBBBJFJDFDFDDDDD@BBB@BDD@BBF@H@D@FAFGJKHCBA@A@ECIEE@CAA@CBEDEHCHCHCDABAB@F@F@BEDCDKDCBC@E@A@CBCAC@IACAA@KAKA@@GCGGGCEAC@AAC@ECC@C@C@A@A@CBCBKHCDEHADEHADABAB@B@BBBHFDBBBDFBBBBHHBBBB@B@BBDBD@BBBBD@@BBBB@D@@@B@BB@@BB@@BBBB@B@DB@BB@@B@BBD@@BD@@@B@@AB@B@B@@BBBD@BABB@BBB@@@B@B@BAB@BBF@B@@AB@B@B@B@D@@@B@DBB@@BDBBBB@DD@@@B@B@@@@B@B@@@D@DD@@@B@B@@B@BDBB@@@@@B@B@B@@BB@BB@DDB@BBBBB@BAB@B@DAB@@CD@@AB@BBBA@@B@BBBB@BB@D@@@@BB@BB@@@BB@@B@BBBBB@@B@@@D@@@@@B@DBBB@@BBDBDDB@B@@@BB@B@BBD@@B@BBBBB@B@@@BD@@@@@B@B@BBBA@@B@BB@BBDBFAB@@@BBBBBBHDB@@BB@@@B@NANHEJ@DAFCH@NFLPNDPX^RPFJT^JPFLDL@BIFIHGNHNRNLNFLANCLGJILAHNRFHHRAJA@@B@@AB@BC@A@CDA@A@E@A@@@CA@@A@@@AB@@ADABA@ABCAA@A@@@ABC@@@ABA@C@BBBDBB@@@BA@@B@@@B@@BB@@ABABBB@B@BBB@@ABA@@BAD@B@B@BBB@@BBB@@B@@@B@@@B@B@BAB@@ABGD@@@BBBBB@BBBBB@BBDBB@B@@ALALAJBJAJCFEDKFKFGLHHHFLHVFNJ@JEHIJIVAJ@@@@@FGLCFGDMDOBEDGFIF@@GDKDKBGBOFODSLSJOJUBCDMAMAQEQES@EFCF@@@B@@BB@BBBABA@@BBB@B@@B@@B@@BB@B@@@BBBA@ABAFA@C@CBA@@BABA@@BA@A@A@EBA@CA@@A@ABA@@@@B@@@BB@@@AB@@@BBD@B@@A@A@EA@@A@AB@@@B@@CDDBDB@B@@@BBB@@@@AD@B@BBB@@AB@@BD@@BB@D@BC@@BB@@B@B@@@B@@@BBB@@B@ADFFB@BB@B@@A@@B@@BBBD@BJLRFJFFHBJAHEJKJWRMLKLEHGJULJHBH@F@B@@@@CR@HBHDFHFJAH@VAVCPB`ALCB@B@FAHAD@JAD@B@B@BA@@BABAFAD@DAB@B@B@@@BA@AD@@@D@B@@ADA@A@ABADANIHILERARJFDFBDA@CAE@CBEDGF@AA@A@AD@BA@BD@DBB@D@@@BBDBB@B@BBB@B@BB@@BAB@BB@@@BBB@B@D@@BBF@@@DDBB@@@BF@F@B@D@D@@B@@BD@B@BB@@B@BBD@FBB@BB@@B@B@BBBB@@BB@B@@BBD@@@@D@D@B@DA@@D@B@B@@@@@B@B@DAB@D@B@B@@AB@B@D@D@@@@BB@@BBA@BD@@D@B@BBBB@D@@@F@B@@ABA@@B@B@D@BABAB@@@BAD@B@@@@BBBB@DB@@BBB@FBBBJB@AD@DBB@@ABAB@BAB@BBFDD@B@BB@BB@@DBBBBBA@@@@BA@A@@DAB@B@@ABA@@BCB@BCBAD@@@BB@@B@B@BA@@B@@@@@@@BD@BD@@BF@@ABA@ADCD@BABAB@FADCDA@@@ABADA@@@C@@B@FADA@@DDB@D@BB@@B@DAFA@@B@B@D@DADA@@BBBBB@@@DC@@@ABAB@@A@A@@@@AC@AB@D@@@DB@@DA@@D@@@BBDBDBHB@@FCBAB@@A@AD@B@D@@@B@DEB@DA@@B@@BDBH@@@B@BDBD@@BBDBBB@DB@@BBB@@F@@@B@D@@BBB@B@BB@@BBB@B@@DBB@@ABB@@DBBBB@BBB@DBB@B@@@BBB@B@@@DB@@@@@@A@@B@B@B@B@BDBB@@BBB@BBB@@@BBBBB@DB@@@@@D@B@@@D@B@B@@@BAB@@@@A@@FCB@@AD@BAB@@@B@B@F@D@B@BAF@B@@@F@B@@@DAFBBBB@B@BA@@BB@@B@@BB@DB@@B@B@BBBB@@D@T@D@\\CZCZAB@B@LED@@E@@@@@KHEJ@HFJDBBFDVAPBJDFFBF@B@HELBPHDFFBDBFAFABMNGFGFCF@@@F@FBTHTNRJP@LMDMFIJ@FCP@NIJIHELAFGPELILGHGRABB@@BBBB@@@B@J@@@BB@@DA@@B@F@F@BBB@D@DBB@F@D@@BA@@BADCD@BB@@BHBB@FAB@DBD@BBB@BBBB@DB@JBFBJCTONMNKLEJEF@LHHDFBFLB@BBBDDD@DDAD@BAB@B@@ABC@@@@FBD@D@@@BAFB@@BAB@@@B@B@B@@B@@@B@DBD@@BBBBBBBBBB@@@@ABABA@@BBDBD@B@BAB@B@B@BBBB@BDBB@D@@@BDB@BABA@AB@@A@@B@@@DABBBBB@BB@@BBBBB@BB@JFFBB@DFBD@BDDXFVAN@NCFCBGBECQCOAEAI@QLCLBRBJFJDPNHDJDJALEPCRSHCBAJ@PFNLFFZNNFPFLAHCZK\\ITERBLCHCNGNCD@BA@@DAB@D@@@BAF@@@@G@C@A@EBA@ABABA@A@ABAFEB@@ADA@CB@DA@A@@BABABA@@@@AA@@@E@@BA@@@A@CA@@AB@@A@A@@@C@@AA@@AA@AAA@@@@BA@@AAAA@A@@@CB@BABAB@@ABAAA@@@@BAB@FC@A@A@A@ADA@C@A@A@A@A@A@C@@@AAA@AACAC@C@C@C@@@@DAB@@ABE@AB@@AAA@A@@@A@ABABADAFC@@@A@A@A@AAC@@@A@A@A@@BA@C@@AAAA@@@AFA@ABC@A@C@A@@@A@@BABC@A@@BE@@@C@O@@E@A@A@C@A@@A@@CBAA@@EA@AA@@A@@A@@A@AAABA@AAADC@A@A@AA@AAA@A@@A@AAA@@BA@AB@B@B@BBB@@AA@@AACAA@@AAC@A@A@A@@@ACA@CAAA@AA@A@A@@A@@@@@AACAAA@AA@@A@@CA@BA@AAC@CCC@@@A@@AA@@@A@G@@A@@A@@@A@@@A@@BADABAB@B@BABA@@@A@C@A@@@ABAACAA@@A@A@C@A@A@@@A@AAAA@@A@ABA@@@CACA@@A@A@@@A@@@@AA@@AA@@ABA@@@ABABA@ABB@AB@DAB@DAB@HC@AB@@ADEBABA@ABA@@@@D@B@BAB@@ADAB@BAB@@C@@BAAABA@A@@@ABA@@AGBA@A@@DABAB@@A@AB@A@@C@@@A@@@A@@BA@@BA@ABCBAA@@@@@@ABA@AB@@@@A@A@A@@AC@A@@@@BA@@@AA@A@AAA@@A@@@A@@@A@AAAA@AAA@A@CCA@E@A@AAA@@CAAAC@@C@@@@AAEAC@A@@A@AA@AA@@@A@CBA@C@AB@@AAGAIAG@CGFKDIAKCGCEAAEAOIUGIACAEG@EBGHSSEMGEGEGCEAG@OBGFGCEEEGCMGODODIBG@GAMEGESCKDIHGFA@@@A@AB@@@AA@A@@@AAA@@@A@AB@@@AB@@A@AB@@A@@@AAA@AAA@A@@@A@@@A@@@@BA@@@A@@@@@ABA@@@A@@AAAA@@AA@AD@@@B@@A@@BA@@BA@@@A@@B@@@BAAAB@@AB@BC@@BAD@@@D@B@@@B@@@B@@ADA@AAA@@@A@@@A@@B@@A@ABABA@A@ABAB@@@BA@A@C@ABA@@BABAB@D@B@@@BABA@@B@D@@@B@B@B@B@BAB@B@@ABA@@@A@A@@BA@@@@AAA@@@@ABA@AB@BC@A@@@AA@@ABABEBA@@B@@@@C@@@@B@@@@A@A@@@C@A@@CA@@AA@@@@@C@E@A@@B@@AB@@@@ABAB@@A@@BE@CFCJCBCJQJGJEFKBG@GAICKEIAGCIJSLMPERMDADMOCKBKC@EDGFAHCFA@@@@B@@@FBBB@@DABAB@@@BAB@@B@@B@@@@BB@@@B@@A@AD@BA@AB@B@@AB@@ABBDA@@BAB@BAD@@@B@@A@@@A@@A@AAAA@@AA@A@@@AA@@A@AAABAAABA@@B@@@B@BA@A@A@A@@BA@@DA@@@AFBH@B@@@@@BA@A@ABA@@D@B@@A@@AA@A@A@AA@@A@AAAAA@@AC@AAA@CCA@@@AA@@@@@C@CBAAA@@@A@AB@@A@@A@AAE@@@@A@C@@@CAAAAAACA@@AA@A@CAA@@@AAABCAA@@AA@AAB@BABAB@@AB@B@BA@@AAA@@AAAAA@@CAA@@AA@@AB@B@@A@A@A@C@A@@AA@@A@A@@@AA@A@A@@A@A@@BA@@@A@@A@A@A@@@A@A@AA@@AA@@@C@@@@AA@@AA@AEAAAAA@@@@@AB@@@@A@E@A@A@CCAAA@@C@@AAA@@@C@@@AAA@@@C@A@ABA@E@CBABA@@@A@A@@@A@A@CBCAE@ECAB@D@@BBAD@B@@@B@DAB@@@@A@AACIACGEGEA@@B@@@@AB@@ABA@A@ACA@A@@@A@ABA@@BA@@@CA@@AB@@ABAD@@@B@BDD@@@BA@@@A@@@A@C@AB@@C@AA@@AAA@@@@@A@@@C@CBA@A@CA@@A@A@A@@A@AAAA@AAA@A@A@@A@AAA@A@@A@A@@@AA@A@@A@@@A@A@@@E@CAA@@@A@A@A@@@@@A@@BA@@BA@CA@@A@E@CD@@AB@@@B@@ABA@ABEBA@CACBA@ABCB@@AB@@AB@@@@A@@@AB@@A@ABA@C@@@CDAB@B@B@DBB@BDDDD@@@BAB@@CB@BAB@@@DABC@AB@@@BAB@@@BAB@@A@@B@@@@@@@B@@@B@@@BA@A@A@A@AAAA@@@AA@CA@@A@A@CCA@A@@@@@ABBDBB@B@H@D@BABABGDABIBGBI@C@CACAECCCCAGAG@CACCAAA@C@@@E@ABAB@@C@@@@AA@@A@A@@B@@A@@@A@@AAA@@AAA@@A@CA@@@AA@@@@A@@@ABABA@@BA@@@AB@@A@@AAC@@AA@@@ACA@@@@A@@B@@@@@CA@CACB@@A@@BA@@@A@A@A@A@ABA@A@@@A@@@C@@A@@A@@@@A@@@AAAC@@@A@A@@AA@AAAA@BC@A@ADC@@@@@A@ACEBCA@A@AA@@@AA@@AAA@A@@@CAAA@A@@A@A@@A@C@A@@BA@@@C@A@@A@A@@@@@@AA@A@A@A@A@@A@AA@@C@ABC@@AAAA@A@CAA@@A@@@AAA@@ACAA@@C@A@CAC@AAA@A@@@C@@AE@A@@A@ACC@@A@@BABA@A@@@@BA@BBAB@@ABAB@@@@A@A@@@AA@@AA@A@@@@@AA@AA@A@@@@AA@A@A@A@@@AAA@@AACA@@A@A@A@AAAAAAAAA@AAA@@AA@@A@A@@@AA@BA@@@A@@@@BAB@@@AAAA@@A@CA@AAA@@CC@A@@@C@ABA@A@@A@AAA@ACACA@@AAAAA@@@A@@@AA@@A@A@@@C@AB@@A@@@ABA@AAC@ACA@@@AB@BADA@A@@@CB@@C@@@AAA@@A@AAACB@@AB@B@BA@A@A@@B@B@@@AE@@BA@@D@DCB@FAB@B@B@BBB@@@@@B@BB@@@@@@D@@@B@DBB@D@@ADEBAAAA@@AA@@@A@@A@A@A@@A@A@AAC@@@C@A@@@AA@@@A@AA@A@AC@@CC@AA@@AA@@A@AA@A@@A@@B@@A@@A@AAA@C@AAA@@A@@@@AAA@AC@@AA@@@@@AFCBA@@@A@@A@A@CA@@A@@A@@@AA@C@A@@@A@AAA@@AE@A@A@@@CB@@@D@B@@@B@@@BA@A@EA@@AB@@A@CAA@ACA@CCAA@A@@AAAB@ACA@AAAA@AAC@AA@@AA@@CA@@@@@AB@@A@@@A@@@@@AB@BA@@@A@A@A@A@A@@BAB@@ABA@@A@@AAA@C@A@@@AAA@CA@@AC@@@ABCB@@@@AA@@AC@AEC@A@A@AAACA@@@A@AAC@A@@A@AAA@@AA@AA@@@@A@A@A@C@@@AAAAA@CCAACAAA@A@AB@CA@@@@AA@AA@C@C@A@A@@@ABA@@@AA@@@@@A@@AAEAAAAAA@@@A@AAAAA@@C@AA@@AB@@A@@@A@AA@@@A@@@A@@AA@@@CAA@AAAA@AAE@@@A@@D@B@DA@A@A@AAABA@@@AA@@A@A@A@A@A@@@@AA@@A@AAA@AA@@@A@AB@@AA@AA@@AA@C@A@A@A@A@@@CAA@A@@@A@@A@@ABA@AB@@@@A@A@@@@BA@AB@@@@@B@B@@AFA@@@@AA@AAACA@@BA@@@A@AA@A@A@A@@@C@A@AB@@A@A@@@C@@@@@@@K@KAQFUAKBG@Y@SDMCKGIEICK@MHAPIFCBCACAA@CAA@A@EC@@BEBA@AA@@ACAA@@AA@@A@@@CBAA@@A@AAA@@C@@AECA@A@AA@@ADADCDA@CB@@K@A@G@E@MFE@CBE@CBGDCB@@EBC@@@CBCB"],encodeOffsets:[[126767,41020]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
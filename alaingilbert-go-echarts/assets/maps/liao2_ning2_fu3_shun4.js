@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("抚顺",{type:"FeatureCollection",features:[{type:"Feature",id:"210402",properties:{name:"新抚区",cp:[123.912872,41.862026],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@A@@@@BC@@AA@@A@@@AAAA@@AA@A@A@@AC@@B@@@BADA@@@A@@AA@AB@@A@@B@B@BBB@@@@AB@BA@A@@CA@@AAC@@@AAAC@@AAA@BA@@AAAAA@CA@@@A@AA@AC@AA@A@A@@B@@EAA@A@A@A@ABA@@@ABAB@@A@C@@@A@AAA@C@@@ABA@ABA@ABA@A@@BA@@@BD@@AB@BBB@@@@@B@@@B@@@D@@@@@BA@A@@@@@C@EBA@A@@@@@ABA@@B@@A@BBA@@BA@@BA@@@AB@B@@A@@B@BB@@B@@@B@B@@AB@@@@@B@@@B@@AB@B@@A@@@A@A@A@A@C@A@ABA@@@A@@B@@A@C@@@AD@@@DAB@BAB@@@BCB@@@B@@@B@@AB@B@B@BCBA@EAA@AB@@AAAA@@CFCFADGLQZ@BCDAH@BBD@D@D@D@@DX@@@H@BM@CBK@MDI@ABEB@HFDVJK@@@AAE@A@@@@@A@@@A@@AA@A@AAAA@@AB@@A@@@@BA@@@@@@AA@@@AAAA@@A@@@AAC@AAA@A@@@@AA@C@@@A@AD@B@@@@A@@@@@CDCB@@@BABABAD@@CDCD@BABBAB@DAB@@@@@@@B@B@H@F@F@DBF@DBF@HBLBDB@@D@HBD@JBDB@@B@F@FBF@BBB@H@DB@@JBVB@@BBHBNB@@FBB@D@@@@BH@B@F@LA@@B@@@DABB@@@BB@B@DAD@HBB@JBNBDBD@FBJBD@D@FAFA@@B@DBNC@CA@@AC@@A@C@@@AAAB@@@B@DA@@@C@A@@C@@@AA@@@@AA@@@A@@C@A@@@C@@@A@@AA@@@I@AAA@A@@@@A@A@@D@B@@@@A@@@AA@@A@@C@A@A@ACACAA@@G@IBC@E@G@I@A@EAC@@@WBE@@@@@AA@@AAA@@@AA@@@@@@@A@@@@@A@@@E@A@AA@BABE@EAC@A@CCEBCBEFCJIREP@PBLDFNB@NHDDDBBBDBDOAI@CDEPCD@BF@HDBJDCIAADA@GEAAAAABE@ADED@DACEB@@AB@@ABABA@ABCBADA@ABACAEICBABAA@AA@@@CAA@A@A@@@@@A@AA@BA@@@A@A@A@AB@@A@AAAA@AA@A@@@@A@AA@@@@A@@@@BA@@@@@A@A@CA@@AACBA@@D@B@BB@@@A@@D@@@@@@AA@@A@AAA@A"],encodeOffsets:[[126965,42764]]}},{type:"Feature",id:"210403",properties:{name:"东洲区",cp:[124.038685,41.853191],childNum:1},geometry:{type:"Polygon",coordinates:["@@@C@ICSEK@@FCD@CCAAK@MIEEAIG_@CCAEBEFCBCBE@@BCFBH@D@B@@@DABADDB@BED@@@BAB@BABAAACABABCA@@ADBDA@@BBBEB@@ABBBCDABE@CD@@AB@BCBABAD@BABAB@BA@@BA@DFCBC@CF@BAFBBBBFB@HCBBBDJICCA@GAEC@ODCF@DBJCPCAAACACCMGA@EMKCOAO@QFIJEDAFADDF@D@BBD@FAFABB@@B@B@F@@@B@@@@@B@@@@@@BB@@B@BB@@BB@@@@F@XA@@D@FBB@J@H@F@D@JAH@@@BBBDBDB@B@D@@@@BB@@B@@@B@@A@C@@@@B@B@@B@B@BBJ@@@B@@BB@@@D@@@B@D@@@@B@@BB@@@@BB@@D@@@@B@D@@CBA@@@A@BB@B@@@D@BD@@BB@@D@@B@NAH@D@D@R@AF@F@DAB@BAB@D@DDBDDH@DDBH@@@@@@@@@@B@@@@@F@B@F@@ABABBD@B@BB@DBA@ELBFBDDB@BB@B@B@@@DABAB@@@B@@@@@@BB@B@@@B@B@@CF@D@BBD@DEH@F@F@B@DDDBJ@DEVMtAFCR]lJJFRBNBHBH@FHDJJNJZNXDTHLHJAH@H@TCVCZAVGHCFCLELCHBRBRDNALCFAJ@F@LBZDVJLFFBD@NC@@@@D@DKDI@IBE@@@@D@@ADABAB@@@B@@A@@@A@A@A@A@A@@@ABA@AD@BA@ABABA@@B@B@@@@A@@BAB@@A@@@@AAAAAA@AAA@CA@B@@A@@@A@@@A@@@A@@@AB@@AB@@A@A@AAA@A@@CA@@@AAA@@@@B@LAD@B@B@F@B@B@BB@@B@@@BB@@B@B@D@DBB@DB@BBBB@BB@B@@B@BBB@D@DBD@B@D@B@B@B@B@B@D@BBB@DAD@BBD@@A@ABA@@BA@@BA@@BCBABA@@BCJGFIDIBG@E@ADGLCB@@A@@@AB@@A@A@@@A@@@AB@B@NBB@@@B@BCDAH@B@BABC@@@AA@@@BA@@D@@@B@@A@AB@@@@@@AB@B@@AA@AA@AB@BC@ABA@AB@AA@@B@B@@@BA@@B@@@@@BABBDAB@AA@A@A@@BCA@CAAA@@@A@@ACB@DA@@@ABC@@@@AAC@A@@@C@A@A@@@E@@@A@@@ABC@AB@@C@@A@@AA@A@@ABAA@@A@@@AAA@@@@@AA@A@A@@A@A@A@C@@@A@A@@@AB@BCBA@@AA@ACA@CA@@@AA@A@BA@@E@@@AB@BA@B@@BB@@B@@@@ABA@ABA@E@AACBA@@@@A@@A@E@@@@@ABBDABB@A@@@ABAAABA@A@@@A@ABA@@B@BA@CB@@A@A@A@@@ABA@C@A@@@AB@@A@@A@@AAA@CAAA@A@@@@BAB@BB@A@@@@C@@@@@@A@@AA@A@A@AAA@@@C@ACCCA@@@@A@A@A@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@AA@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@A@@@@@@@@@@@@@A@@@@@@@@@@@AB@@@@A@@@@@A@@@@@@@@@@@A@@@@@A@@@@@A@@@@@AA@@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@A@@@@@@@@@@@A@@@@KGGCYKGC@@SKGGIGOIIC@@eMOCIAGAOCMEOCUAO@UBS@AAF@DA@ABAA@@@@AA@@AB@DA@@@A@@BADCBA@AD@AAAAAC@A@CAACCCAD@MICQAQA@@AD@BC@@FC@A@@@A@@@@@@BCBEBG@@@@H@DABADC@A@A@@@AYQAGAC@ABC@@BCFADAD@B@D@B@@AAC@ACAACAAAA@A@ABABABABA@A@A@A@AACAAAAAAAA@A@ABABABADA@@BCBCBE@C@ADEFADADC@C@A@@BABA@A@A@AACAAAAA@E@A@EBGBCBABABABAFCD@DADC@CBABCBADEJABCBCB@B@B@@@B@DADCBCDE@CBC@C@E@C@CBABC@AB@B@BA@@B@@@BABGPBBB@@BB@@B@B@B@B@@@B@B@B@@@B@B@@@B@BAB@B@@@@@@@@@B@B@@@BADAB@BABAB@B@B"],encodeOffsets:[[127051,42808]]}},{type:"Feature",id:"210411",properties:{name:"顺城区",cp:[123.945075,41.883235],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@IAEAC@CAMAIAA@GAC@CBA@A@@A@@AACB@@A@@@KBE@A@G@@A@@C@A@EA@@MAGAAA@@UAIA@@CAG@A@AAE@EAE@A@@@CAIAC@GAC@@@CAKAGAE@CAE@CAE@E@G@A@A@@@@@@@A@CBA@ABCBEBE@@BKBKBMBK@EBOJAAA@A@A@BBA@@BCB@BA@@@@@A@@@B@@BBBA@AF@JCHADCDILEHANBNDL@@@@BHHNRRFLHPJ\\FPDJDBB@BB@@@@@B@@C@HRFBHHDDDHJHJHBBHDFBB@JFVJRBNB@@B@DBFBVDBBDDB@BB@@DBDABB@@F@BAB@@@@@@AD@BBB@@@BABAB@D@D@@ABAB@@BDBB@@@B@BAB@BABABABA@@D@@AB@BAB@@@B@@@DA@@BA@@BA@AB@BAD@@@HBLDHDH@H@DCBEEGGEACBCVEN@LAB@PBT@XFNFFBF@PAL@JF^kDQBENsFU@CAICC@C@A@E@EFG@CAC@A@CDE@@@A@A@@@AAA@@@@@@@A@@BABA@C@@@A@AAAA@CCEAKA@FAB@CAAA@C@AAAB@BE@A@E@@@@@A@@@@@@@@@@@AGCCG@CCCA@C@CBA@ABA@C@EBEQ@C@C@G@MBA@@@MDCAA@@@EBEBC@"],encodeOffsets:[[126957,42886]]}},{type:"Feature",id:"210404",properties:{name:"望花区",cp:[123.784225,41.853641],childNum:1},geometry:{type:"Polygon",coordinates:["@@FKLWWMYMKQ@AM@@DA@CBC@AB@BED@BAB@@CAABCBAACBA@A@CB@BAB@BAD@DAB@B@B@@CBAAC@EB@@AB@D@BA@@B@BABA@A@@DB@@BAB@@@B@B@BC@AFC@DDBD@DBB@@BBD@BBB@BBF@D@BBBD@@@D@BABBBDDBBBB@@BABADAFBBBDDBBB@BB@BD@@B@BABBBBBBFB@B@BDB@BB@BBB@BDB@B@EBD@B@B@BBB@BA@EAA@@@A@BF@@@BAD@@DB@DB@@BB@@B@@D@@@BD@@B@BAB@B@@@BBLH@@@BBDAB@DAB@@BBBDBB@BB@BB@BA@@B@BA@@B@@@@AB@BA@@@@@@@AA@@@@CD@B@@GDABBB@FA@@BBBBDA@@BA@A@A@@@AAAB@@@B@B@@BBAB@@AB@B@@A@ABCBCD@BADAB@@AB@B@BA@@B@F@BC@A@ADBBBD@@BBBB@@B@@BA@@@ADBBB@B@@BB@@B@@@B@@ABBB@B@B@B@BAA@AA@@@A@@@AB@@@B@@A@@@A@@BA@@@BAAAC@A@CBCDABAB@@@BFBD@BB@@BBB@@@@B@BA@BBA@@@@B@BB@BBB@B@BBB@@AB@@@@B@@@BADA@@BAB@@@BAD@@@BAB@BABA@@B@AC@AA@@@@A@@@@@@B@@@B@@@@@@A@@AA@@@A@@@@B@@A@@@A@@@@@A@@B@@AB@@@@A@@@A@@@@@@@A@AAAAAAA@@@A@@@@@@BA@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@AAA@A@@@@@@@@@@DB@@@@B@@@B@@@@@@@@@@@AA@@@@@@@A@@@@A@@A@@@@B@@@BB@@@@@@CC@@@@B@@@@@BB@@@@B@@@@@@@@@A@@AA@@A@@B@BABA@@B@AA@@@A@@@@@A@@@A@@@A@A@@@@B@@A@@@@B@@@@@@@@A@@@@@@@A@@@@@@A@AA@@A@@@@BA@@@@BAB@@ABAAAAA@@AA@@@@@@@@@AB@@@@DB@@@@@B@@A@@@@@AAA@@@@@AB@@@@@@A@@AA@@B@@A@@BA@@@@@@@A@@@@B@@AB@@@@@@A@AA@@AAAAA@AA@@@@A@AA@@A@CAAAA@AAA@C@A@@@@@@@AA@@@@@@A@A@AAC@A@A@@@@@@@A@@@@@A@@@@A@@@@@@@@A@@@@@@@@B@@@@A@@@@BA@@B@@@@@B@@B@@@B@@@@@@@@B@@@@AA@@A@@@@@A@@@A@@@A@@@AB@@@@@@@@@@@A@@@@A@@A@@A@@@@B@@@@@@A@A@A@@@AB@@A@@@@@A@@A@@AA@@@@@@@@@B@@@@@@@@@B@@A@@@@@A@A@AA@@@@A@@@@@C@CA@@@B@@@@@@@@@@@@@@A@@@@@A@@@@B@@@@BB@@@@@B@B@B@@@@@@A@@@A@A@A@A@@@A@@@@@BB@@@@@@@@A@@@@@AB@@@@AA@@A@@A@@@@@@@A@@A@@@@@@@@AA@@@AB@@A@@@@BB@@@A@@@E@A@ABA@@@A@@@@B@B@@@@A@@@@@@A@@A@@@A@@@A@AB@@@@@BA@@@@BA@ABB@B@@@@B@@@@@@@@@B@@@B@B@B@@@@@B@@@@A@@BA@@@@AA@@@@@@B@B@B@@@@@@B@@@D@@@@@B@@B@B@B@BB@@B@@@@B@@@@@B@B@@B@@B@@@@@B@B@B@@@B@@@@@B@@@@BB@B@@@B@@B@@AB@@@@@@@B@@B@@@B@@@@BB@@@@@@@@@@@@B@@@@@@@@@@@@@B@@F@@@AF@B@@@@@B@DA@@@A@@@AB@@@@@BA@@@@BA@@@BB@@D@@@@@@B@@AD@@@B@@@@A@@@AA@@@@@@AB@@@@@B@@A@@BA@A@@@@@@B@@@@@B@@@BA@@BABB@B@BB@@@B@@@FHBFBLBF@P@FBHBFDDDB@HDDBB@DBFDHFFHBBBBFBDBDB@@@B@@@@@B@@@B@BD@BB@@B@@B@@@@A@AAA@@@A@@B@@@@@B@@BB@@@B@@@@@@ABB@@@@@@BA@@@@@@@@BB@@@A@@@@@@@@@@B@@@B@@@@@B@@@@@B@@AB@@@@@@A@@B@@@@A@@@@@C@@@A@@@A@@@@@A@@@ABA@@B@@A@@@@@@BA@@@@@A@ABA@ABC@CB@@ABABA@@B@@@@@B@@A@AB@@@@@@B@@@BBBB@@B@@B@@@@@@@@A@@B@@@@B@@@@@@@@@@AB@@B@@B@@@B@B@@@@B@@B@B@B@@@@@B@@@@B@@B@@@B@@B@@@B@B@@@B@@B@@B@@AB@@@@BB@@@@A@@B@@@@B@A@@B@@A@@@@@BB@@BB@@B@@@B@@@@@@@@@@BB@BD@@@B@@@@@@AB@B@B@@@@B@@@@B@@B@@B@@B@B@@BB@BBB@D@@@@BB@@@@B@@@@A@@@@@@@@@@@B@@@@B@@@@@@AB@@@@A@@B@@@@A@@@AAAAAA@@@@@@@B@@@B@@@@@@@BA@@AAAA@@@@@@@@B@@@@AB@@@@A@@A@@@@A@@@@@@@@BBB@@@B@@@@@@@B@@C@AA@B@@@@@@@B@@@@@@@@B@B@@@B@@@@@@@@B@@@@@BB@@B@@@B@@A@@@@@AA@@AA@@@@@@@@@BB@BB@@@B@@@BAB@@AB@@@BA@@@@@@B@@@B@@A@@B@@@@A@@B@@@@@@ABA@AB@BAB@B@@@B@@B@BB@@BBBBBBB@@@@@@BBB@@@@BBB@@B@@B@AB@@A@@@ABA@@@ABA@@@@B@@@B@BB@@B@@@BB@@BB@BB@@@@BB@BB@@@BB@@B@B@D@B@@@@@@@@@BA@@@@@@@@B@@@@BB@@@B@@BB@@@B@@@@BD@BB@B@@B@@@@@B@@@@B@@@@@@B@@@@@@A@@@AB@@@@@@@B@@@@@@@@@@@B@@@@B@@B@B@@@@A@@@@B@@@@@@@B@@@BB@@@@B@@BB@@@@@B@BABAB@@@@@@@@@@@@BA@@@ABB@@@@@@@@@B@@@B@@@@@B@B@@@@@@@B@B@B@@@BA@@@@@@BA@A@@B@@@@@B@@@@@B@@@@BBB@B@@@@A@@B@@@@@@B@@@@BB@B@@@@@B@@@@B@BB@@@B@@BB@@BDB@@BB@@@@@@@@@B@@@B@B@B@@@@@B@BB@@@@B@@@@B@@@@@@@@@@@B@@BB@@@@@@@@@@@BB@@@@@@@@B@PHGQD@@@@A@@@@AAA@CACIEOI[GOEKQQGMAG@@@@CKAMBMFGJKDCBCDG@IBEB@AA@AA@@@B@@@@@B@@ADA@AB@AAB@B@B@BBPIFAL@NALALA@AF@FADABA@ADCDC@@BCBABA@A@@DADC@@@@B@@@@@@ABCB@@@D@B@@B@@B@B@BBD@BB@@B@@@BBBB@@B@@B@@@@B@@A@@B@@@BA@@BBBBB@B@@BB@@@B@@@@@B@F@BB@@L@UIEC@GFABAJ@NCL@DAN@@A@G@@CW@@@C@C@CAC@ABGDC@ARYHKBCDEDE@@BBBB@@BAB@FBB@DA@A@A@ABA@@@A@@@A@@DA@A@@BA@ABA@C@@BC@@D@B@@@@AB@@@B@BAB@D@B@B@B@B@@@B@@@@ABA@@@A@@@A@@@@BA@@@A@A@@@AA@@A@AB@@@@ABA@@B@@AB@@AB@AAB@@@@AB@BA@@@@B@B@FAD@@@@@B@B@@A@@@@@C@@@A@@@A@@@@AA@ABA@@AC@@@@AAA@@AA@@@EBAA@@CBA@@@@B@@@BA@@B@D@@A@AD@@A@A@ABA@@@A@A@A@A@@@@@E@C@@@A@@@A@@A@@ABAAA@A@AAA@A@@@A@A@@@A@C@A@A@@BB@A@ABGAK@A@@@AA@A@@@@A@@@CF@@@A@@@AAB@@CB@@CAM@EDAJ@BA@A@C@A@@@CKC@A@@AA@GREDEAKEFCEGYMPEPEBEXC@GFIOUAMACKGASGGGGIAIEAGAKGG@CJECMYK"],encodeOffsets:[[126792,42639]]}},{type:"Feature",id:"210421",properties:{name:"抚顺县",cp:[124.097978,41.922644],childNum:1},geometry:{type:"Polygon",coordinates:["@@BIBKCOUIICSCQCWGMCOA@@@@ABAAABC@A@CAAB@BA@C@KJFJFL@H@FFB@BAB@BCBADEHG@KCC@MEEAUKCE@@A@A@@B@@A@@BA@@B@@A@@B@@@@@@@BAB@@@B@@@@ADA@@@@B@@@B@@A@@B@@AB@B@@@@A@@@AB@@@B@@ABA@@@ABA@@@A@@@@A@A@@A@@@@@A@@@A@@B@@@BAB@@@B@@@@@B@@@@@BB@B@BB@@BBBB@@@@@B@@BBA@@@AB@@A@A@@B@@A@@@@B@@AB@@@@A@EA@B@BAB@B@BAD@@AB@B@D@B@@@B@@@@B@@BBBB@B@@@BB@@B@@@@@B@B@@@BADBB@@@BBBBBBBBB@@@BBB@B@@@BBB@BB@@B@@@BB@@B@@@BBB@@@@@B@B@B@B@B@@@BA@@@@B@B@@@BB@@@B@@@BBB@@@@@BB@@@B@B@@BB@@B@@B@B@@BB@@@D@B@B@BB@@B@@@B@@@B@@@BA@@B@@@B@BBB@B@@@BB@@BB@@AB@@@@BBBBBBB@@@B@@@@B@@@BB@FBBBB@@@N@@BLRZNXNKXELZLDNIF@DHHBLBHJFJBHHHHBTLHBDBNPVEJ@HWDAFOFOFZNFHEDLFFBFCHQB@@BB@D@DL@@B@D@B@B@@ABIFCN@DB@@DA@@BA@B@@@B@@DE@@B@@@@@@BBB@@B@L@HBBAB@A@@AB@B@D@B@@@B@B@@@B@B@BBB@B@BBBA@@@BB@@@B@@@D@F@@@@@B@B@B@B@@@B@BAB@B@@@BCB@@@@C@AB@@A@@@A@@B@DA@@BBFA@@B@@BB@BB@@B@@AB@B@BAB@BAB@BA@@D@B@BBB@@@D@B@@@BABA@@B@BAB@B@B@B@FB@@@AB@B@B@@BBDB@@B@B@@DBB@BBBB@@ABB@BB@@BDBB@@D@BB@@DB@@@BABA@@B@@A@AAA@A@@@@BA@@BBB@@@B@@CBAB@@A@@@BD@@@B@BBB@@BBBB@@B@@@BB@@AD@@@@@B@@@@@BBB@B@BB@@B@@@@C@@@@B@@AAA@C@@@ABBD@BB@@D@B@B@@@@AB@@@@@B@@B@@B@B@@B@B@@BBBBBB@@@BAB@B@B@@@B@@ABBB@@@@@B@B@B@DB@@B@@BBBBADAFJDB@@DCF@BADCAABA@@FAAA@AB@ACBC@@DBBABABDBBBA@ABA@A@@FC@ACABCBA@C@@@A@CAGDE@AF@DADAFEFADB@DH`BJFFNJL@BBDDC@ED@@FLDT@J@D@A@ABABA@ABABC@A@@@A@A@@@@@@@@@ABA@A@A@@@A@A@@@A@A@A@@@A@A@A@AA@@AA@AAHOBA@A@@@AB@@A@ABAD@BADAD@F@D@D@DAF@DCDABC@C@A@@@A@ADADABAFIBCDABADAD@BC@CDCBEBABABADAHAFAB@F@B@BBBBBD@B@B@BABAB@@@B@DCDCBEBCF@B@DAFADAD@@CBABABAB@B@BBBBBBBBBBD@B@B@B@BABABABAB@B@BBBBBBDDB@BBD@BA@C@A@C@CBEBAD@@AD@BBDBHZR@B@@@B@BCDABCBG@@@@@AHAFAD@@@@@@@B@@@BED@@ADC@@BB@BRDRNJC@DBDDBB@D@BBDBBBBC@@BABCDAB@@@B@@CBA@@BB@@B@@B@AB@BCBE@BBT@VAP@VBPDNFPDHBJBPDfN@@JDPJJHHHTL@@HDZLHDLH@@@@@B@@@@@@@@@@@B@@B@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@BB@@@@B@@@@@B@@@@@B@@@@@@@@@@@B@@@@@B@@@@@BA@@@@@@@@@@B@@@@@@@@@@@@@B@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@B@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@AA@@@A@@B@B@D@@AB@B@B@D@@AB@B@@@BB@@B@@@BB@@BBB@B@B@@@@@@@B@@A@@AA@@AA@@B@@@@BB@@@BB@@@@@A@@BB@@B@@@@A@@@@A@@AA@@AA@@@B@BA@A@@A@@@B@BAB@B@@@@ACA@@AAA@@@@AB@A@@AB@@@B@B@F@@@B@DA@@B@@@@DBA@@@@@B@@BBB@BB@@@A@@@A@@A@@@@@@A@@@@@A@@@@B@@@B@@A@@@@AA@@@ABABA@@B@@@@B@@@@D@BA@@@@@@@@B@B@@@@@B@@@@@@A@@@@@@BB@@@@ABB@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@B@B@@@BB@@B@@A@@B@@@B@B@@@AA@@BA@@D@@@BB@AB@B@B@@@BB@@@@@A@@B@@@@@B@@@@@A@@B@@@@B@B@@@@@AA@@@A@@B@@@@@AA@@B@@A@A@@@@B@@@@@@@B@B@@@BAB@F@BBB@D@@A@@@@B@B@BA@@BA@A@@AAA@@AA@A@E@A@C@C@A@E@A@C@AACABCA@@AA@@@A@@@@A@@@AA@A@BA@@A@@AA@@A@AA@@AAAA@@@AABA@@@A@@A@@A@@B@@ABA@@B@@@CA@@@AA@BA@@@A@A@A@@@@AA@ABAAA@@@@@@@A@GA@@C@@A@A@AA@A@@ABCAA@A@A@AA@AB@@@AA@A@C@@B@@AD@@A@@@A@@@A@A@@@AB@@A@@B@BA@AB@F@B@B@@@BA@@BAB@@@BA@@BA@@DC@@B@@@@@B@B@BABB@@B@@B@@@@B@@@BBB@D@@@@B@@DB@@@BB@@@@BAB@@BB@@BBB@@BB@@@B@FAD@BAB@FBBB@@@B@BB@@BB@@@F@DCB@@@B@@@B@B@H@D@@@DB@D@@D@BABA@A@AB@B@@A@A@A@A@A@@BABABA@@B@FB@BBB@@B@BB@@@@@BB@@BB@@BD@@@@@BB@BB@@@BA@@BDB@@B@@B@BE@@B@@@@@@ADB@@F@@@B@B@@@@A@AB@AA@@@A@@BA@A@@AA@@BA@A@@BA@@DC@A@A@AA@AAAA@@AA@@AAAA@AA@AA@A@A@AAAAA@@@AB@BC@A@@@A@@AA@AB@@AA@@AA@C@A@@AA@AAA@@A@@BC@A@@@@FC@@@ACCECA@A@C@CBA@@@CCAABA@@BAB@B@@@BA@AB@BA@@@A@@@AA@@AAA@A@@B@@@@A@AB@AA@AB@DAD@@@D@BA@@B@B@@@B@BA@@@C@A@ABA@C@@AABABA@@@C@@BAAA@@@@@ABAD@@@@A@@B@@@BA@@DC@@B@@@@@@A@A@@BA@@@A@@A@@AA@@BAAA@AAC@CAA@AAA@AA@AC@AA@BA@@@AA@@A@AAAAA@AAAAA@@A@@A@ABA@AA@@A@@@A@A@A@A@@@@A@C@@C@A@@ACA@@@@AAC@A@@AA@@@C@@C@A@AAAA@AA@@B@BABA@ABA@@@@BA@@@@@AAA@@@A@@AA@@@@@@@A@@@@@A@A@AB@@AAA@@@@DABA@ADABC@AB@B@@@B@@@B@@ABA@@DA@@@A@@B@D@D@BA@@BAAA@ABA@AB@@A@@D@FBB@BBB@BAD@@@D@@@B@@A@@@AB@BA@BB@DB@@DBFBB@BAB@@AB@BA@@@A@AAA@@AA@@@@@A@@BA@A@@@@AC@A@AB@@@@AFEAAA@C@A@E@A@C@@@AAB@AAAA@@@@@ABEBABA@@AAC@C@@@AAAAE@AC@AA@C@C@AACA@@@A@@@CBA@@HCJKFICGEIEKIMSGOIICGCOO@KBKDKFGLANBREHKHIJIRKXI@KAGKCMCGAIIDEDELKJGFGOQGMDKHIFEAGCMMIIMGOIKACWHKBKBKAIDCFEHOL[C@@@@G@A@@@@@EB@@@@QBQD@B@@AB@@@@@BA@B@@B@B@@AB@@B@@B@@@@AB@@BB@@@B@@@B@B@@B@@B@@@@@@A@A@ABAA@BA@@@AB@BA@@B@@@@A@A@A@A@ABAB@@A@A@@AA@@@A@@BA@@@A@@@A@@@A@A@@BA@@@ABA@@BAB@@@@@@BBBB@@B@@@AB@B@@@@B@@B@@@B@@@@B@@@@@B@@@B@@@BB@@B@@BB@@B@@B@@@@@@@B@@@@@@@@@@B@@B@@@@B@@B@@BA@@@@B@@A@AB@@@B@@@@AB@@@@A@A@@@A@@B@@A@@@A@A@AB@@@@@@@@@BA@@@@B@@A@@@@@@B@@@B@@@@AB@B@@@BA@@@AH@BAJEJMFEDOBWAA@YCSHEDMFO@ECOGGGKEOCK@QDE@KAAEGMGIK@aGKBOGIGYGOZETMN[CYESAQB[NGDA@QNYHQFQFQFWD_GGCGEICOCIBA@IPCHAHEHK@QAYAM@QCWEMQAC@O@A"],encodeOffsets:[[126833,42540]]}},{type:"Feature",id:"210422",properties:{name:"新宾满族自治县",cp:[125.039978,41.734256],childNum:1},geometry:{type:"Polygon",coordinates:["@@KSMKGEEEGGIAKJCBAFAF@HBLMHUBI@Q@M@MDCDQPOJSJQTEHEHGHCBGFIBMB[JQNOLYHWCIAMGIECMDC@@@@BCHIDE@CGCWAWDCBYNCDAJANCHIRQDMDGDCJEL@NAPEBOFQHONMPKPEP@XAFDNJLNNVPTLLLJCLBLALAXGBDJLHPJNNJDNBHEFGJCLHNPREHIHKLCFCFJJHBNDLDBH@LWJQLIJGJGLQFMAKBEHCLAL@LPPHDJDPJTHJNFLFJDHEJILGD@@AB@D@@@B@@DBBBD@D@B@@BBDF@BBBB@@D@D@BB@@ABABAF@B@@@@BBBBA@BB@@D@B@F@B@D@B@BBEF@B@@A@@B@BBD@@@@@BAB@@@B@@@@BB@@BB@B@B@@ABA@@BA@ABA@EACA@@CAA@@AABA@@B@@@BA@@@C@@@C@ABA@AAA@EAC@@@@BA@@BAB@BBBAB@@ABC@C@A@@@@B@@CB@@AB@BA@@@A@@@A@A@@BADCB@BABCB@@@@BB@BA@@B@B@B@@@@@B@@@@@@BB@@@B@@BB@B@@@@AB@@@@AB@BABABA@@@BBB@BB@B@B@DD@@@B@@BB@D@BB@@@@DB@BB@D@@@@D@B@@B@B@B@B@@@B@@@BBB@BAB@@@@BB@BBBBB@BBBBB@@@BB@@B@@ABBD@@BBBB@BBB@DBD@BBB@BB@AB@@BB@@@@B@@AB@@@B@B@@@@A@@@CD@@AB@@A@@@@B@@C@AB@B@@@@BBAB@@@D@@ABABBB@@@DAB@B@B@D@@ABA@@@A@A@@@ABC@@@C@CBA@@BBBA@@B@B@@A@@@@BBB@BB@@B@@@B@@ABA@@BAB@@A@A@AB@@ABBBDD@@B@DAD@B@B@FDDD@B@@ED@@@@@BAD@@@BB@BBB@@BB@D@B@@BB@@BA@@BBB@@@B@@@BADA@@B@@BBBB@B@B@BBBB@@BBBBB@@BB@@BBBBB@@B@B@BCD@@AB@@@BAB@@BB@@@BAB@@@B@@BBA@@B@B@@A@A@@@E@@@CA@B@@@@A@@@AFA@@@@AA@AC@@AB@@A@@AAA@@@@C@@AA@@AA@@A@@@@AAA@@@AA@AEAA@@@ABABAB@@@B@B@B@B@BA@A@@B@BABABC@@@@CCA@@C@G@A@A@@@A@@@A@CDE@@@A@@AA@@A@A@@AAEAA@ABC@EBA@@@A@@AA@AA@@AA@@BA@A@@A@@A@@CA@@@A@@C@A@AA@@A@@@@@@AA@@@AAABA@A@@@@@A@@@CD@@AB@@AB@@A@AB@@AB@@A@A@E@A@@BABA@@@@BA@@B@@@B@B@@@B@@@BC@@BA@@@@D@BBB@@A@@BBBB@B@B@DBBA@@@BBBB@B@@@@DB@@H@B@@@@@@BBAB@BBB@@@@@B@B@B@@ABB@@B@@DB@@A@@@AB@BA@@@@BB@@@@B@@ABBB@@B@BB@BB@@B@BB@@BB@@@ABB@B@@B@@@B@@B@@@B@@BB@ADDBBBD@B@F@B@D@D@B@F@B@B@@BB@BB@@@BAB@@ABA@A@@@@@@BC@A@AAE@A@AB@@A@A@@@@@@@A@@@@@@B@BA@@@BB@@@@A@@@@B@@BB@@@@A@A@@@@@@AB@@@@@A@@@@@A@@@@B@@@@AA@@A@A@A@@BAA@@C@@@AB@@BB@@A@A@@@A@@@@BA@@@AA@@A@A@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@A@BA@@@@AA@@@@@@@B@@@@A@@@@@A@A@@@@@@@@@ABC@@@@@@A@@A@@@ABAB@B@@BB@@@@@BA@@@A@@@@@@B@@@@@B@@@@B@@@@B@@@B@@AAA@AA@@@A@@@@AB@C@@A@@@CBA@@@E@A@A@@@A@@BB@A@@B@@B@BB@@DB@B@@A@A@ABA@@@B@@@@BABA@@@B@@BB@@BB@@@@@@B@@A@@@AA@@@B@@@@AA@@A@@A@@A@@@BB@@BB@@@BA@@@@@@@A@A@A@AA@@AA@@A@@@AA@@A@A@@BC@A@A@A@@BC@A@A@@@@B@@BB@@B@B@B@@@@@DBDD@B@D@@BB@B@B@BBB@@@B@@@@D@@@@@@BAAA@AB@@@@@BBBDBB@BB@@@BB@@@BA@@B@D@B@BA@@B@B@B@@@DAB@@A@AB@BAB@@@B@B@BABBBA@@B@A@BAACBA@@@@F@B@@@@B@@B@DABBF@B@BAB@BA@@@@@AA@@AA@B@@ABA@@F@@@ABB@B@@B@@DBB@BDB@@BB@DA@ABA@@B@B@@@D@B@B@B@@@@B@BBB@@@@B@BB@@B@@@BBBA@@@BBB@@@BD@@@BAD@BA@@B@@@F@@@B@B@D@@@B@D@BB@@@@AD@B@@CBA@BD@@@B@@BBDBB@AD@@@B@BBBA@CBAAAB@@@@A@@@AB@@A@A@@@BBA@@BAB@BADA@@BBBB@@BA@A@@B@@@@A@@B@BA@@@C@@@AB@@B@@B@@ADABB@F@@BBBBBB@B@@B@BCDBBD@D@@@@@D@JBA@ABA@@@@B@@DACH@@@@B@BABAD@B@@@@BB@@B@@B@B@D@B@BA@@@@@GB@BAB@@BBBBB@@@DB@D@B@D@@B@ADBB@B@@AA@A@A@AA@@BC@@@AB@B@BBFAB@@@BBD@@@BB@@B@B@@B@@DABBD@@@@A@@B@D@B@B@D@@A@A@@@@@@B@B@DB@@@@DAB@D@B@@@BBBC@A@@@A@AB@B@@@@A@ABAB@BA@@B@@@BABA@@@@DA@@@AB@@@D@B@B@B@B@@@B@BA@AAA@AAA@AB@@AD@@@BA@@BCDC@A@C@@DAB@BABAAC@@@C@@@@@@@A@A@A@@BAB@@C@CAA@@BAB@BABABA@ABCA@@A@@BAB@@CBA@@BC@A@A@@A@AAA@CAAA@@AAAA@@@A@@@@@AD@@@B@@@FB@ABA@ADABA@AB@@@B@DD@@B@@AD@D@@AB@BCD@BAB@@BB@B@B@@@B@BA@AB@@@AA@@@A@@@A@@AA@A@@@@BAB@BA@ADAB@B@@AD@@A@@@A@A@ABCBAB@B@@C@@D@B@BBB@@@B@@@BA@ABAB@B@D@@@DA@ARC^CPCVCJCRE\\ATDPHZFF@PAP@NBNDF@RBNK@KEGGEGGEKHAHCJCJCBC@GBEDW@KNGFCDAPE^GFARAJ@LBJBJFJFVLFB@@@B@@@@BB@@BB@B@@B@BB@BBBB@@B@@@@AB@@B@@@BBBB@@BBB@BD@@@@BB@@@@AB@BB@@BBAB@D@@@BA@@H@@@B@@@AA@@@@FAB@@@B@B@F@B@B@@@BC@C@A@AB@BA@@@A@@B@DB@@BB@BD@@B@B@@B@B@BAF@@@HBBBB@@BBBBB@@DBDDBB@BB@@@HBHBLARGTARBH@B@J@H@LQVEXFZFVDRJLHJFDABADIJAFA^@PHNJJHFDFDJBHCLCJAL@JBJBDBNHR@N@L@LBJDTNTJLFHHDDBHNPLALANCHAFBHBHDLHJNRJPLNFPNLJJFFDDDVFJB@@@@@@B@@AB@@@B@@@B@@@BA@@B@@@B@@@@@@B@@B@B@@@@B@@B@@@@BB@@@D@@BBBBBB@BBB@DB@@F@B@BBDBBB@@DBBDD@B@@BBBB@JF@BB@BBBB@B@@@BB@BBB@@BB@AA@@F@B@HABADC@@B@DBB@D@B@BAA@B@@ADE@@BCBABABABAD@B@B@FAB@DAB@B@@@@@BADAB@FAJ@B@D@D@@@DAB@BCBA@AB@AA@A@@@A@ABABC@@@@A@A@E@I@@BE@E@@@@@ABAAA@A@CA@@A@CBA@@@A@@@A@@@A@A@AAC@@@A@@AA@AA@@@@A@@@A@A@@AA@A@A@A@EA@@AA@A@A@@@A@A@@@@@@AA@@B@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@A@@B@@@@@@@@A@@@@@@@@@@@A@@@@@@B@@A@@@@@@@AA@@@@A@@@@@A@@@@A@@@@A@@@@AA@@@@@@@A@@@@@@@@B@@A@@@A@A@@@@@@@@@A@@@@@A@@@@@@@@AA@@@@@@@@@A@@@@A@@A@@@@A@@@@@AA@@A@AA@@@@@@@A@A@@@@@@@@@A@@A@@@@A@@@@A@@@@@@@@A@@@@@A@@@@@AB@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@A@@BB@A@@@@@@@@@@@@AA@@@@@@@A@@@@@A@@@@BA@@BA@@@@B@@@@A@@@@@@@@@@@@@@@AA@@@@A@@@@@@@@BA@@@@B@@@BA@@@@@@A@@A@@@@B@@@@@@AA@@@@A@@@@A@@@@@@@@AA@@@@@BA@@@@@@@@@@A@@AA@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@A@@@@@@@@@@A@@A@@@@@A@@@@@AB@@@@@@@B@@@@@@@@@@@@@AA@B@@@AA@@A@@@@@@@@A@@@@A@@A@@@@@A@@@A@@@@A@@@@A@@@@@@@@AA@@@@@@@@@@A@@B@@@@@@@@@@@@@@A@@@@@@@A@@@@@A@@A@@@@@@@@@@A@@@@@A@@@@@A@@@@BA@@@@@@A@@@@A@@@@B@@@@A@@@@B@@@@@@@@AA@@A@@@@@A@@@@@@@@@@@@B@@@@@@@@@AA@@@@@@@@DC@@@@@@B@@@@@@@B@@@@@A@@A@@@@@@@@@@@@AA@@A@@@@A@@@@@@@@@@BA@A@@B@A@@@@A@@@@@A@@@A@@@A@@@A@@BA@@@@@A@@@@A@@A@@@@@@@AB@@@@@@A@@@@@@B@@A@@@@@@@@@@@@B@@A@@@@@@@@BA@@@@@A@@@@@A@@@@B@@@@A@@@@@A@@@@@@@@@A@@B@@A@@@@@@@@@@@@@@BA@@@@@@@@@A@A@@B@@@@A@@@@@@@A@@@@@A@@BA@@@@@@@@@A@@B@@A@@@@@@BA@@@A@@@@@@@AB@@@@@B@@AB@B@@@B@B@BA@@B@@@@A@@@@BA@@@@@@@@B@@@@AB@@@@@@@@A@@@A@@@@B@@@@A@@@@B@@A@@@@@A@@@@@A@@@@@@@@@A@@@@A@@@@A@@B@@@@@@A@@@@@@@A@@@@@@@@@@A@@@@A@@@@@@@@@A@@@@@@@@B@@@@@BA@@@@@@@@@@@A@@@@B@@@@@@A@@B@@@@@@@@@AA@@@@@@@@@@@AB@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@AB@@@@@A@@@@@@@@@@BA@@@@@@@@B@@@@@@A@@@@A@@@@@@@@@@@@@@@@@BA@@@@@@@A@@@@@@@A@@A@@@@@B@@@@@B@@@@@B@@A@@B@@@@@@@@@@@@@@@@@B@@@@A@@B@@@@@@@B@@@@B@@B@@@@A@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@B@@@@@@@@@@@BB@@@@@@@@@@B@@AB@@@@@@@@A@@@@@A@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@BA@@@@@@BB@@@@@@@@@@@A@@BA@@B@B@@@B@@@@A@@@@B@@@@@@@B@@@@A@@B@@A@@@@@@@A@@AAA@@@A@@@@@@@A@@@@A@BA@@A@@A@@@@@AA@@@@@@A@@@@AA@@@@@@AA@@@A@@AA@@AA@@AA@@@@A@@@AB@@A@@B@@A@@@@@AB@@@@@@@@@@@@@A@@A@@@@A@@@@@@@@A@@B@@A@@@@@@@AB@@@B@@A@@B@@@BA@@@@@@@@@@@A@@@@@@@AA@@@@@AAACAA@A@@A@CE@C@A@ADG@A@A@@BA@A@@@A@A@A@@@A@A@@AA@@@AA@@A@A@AECAECCACAA@A@AB@@A@C@@@A@@AA@@@A@AA@@A@AAAAAAAAC@@AA@A@@@A@A@@@A@@@A@@@AA@@@@AA@@@@A@@@@BA@@@@@AB@@@@ABA@AB@@A@@B@@@@@B@@@B@@@@@@@BA@@@@@@B@@@BBB@@BB@BB@B@@B@@@@@B@@@D@@AB@B@@@@@B@B@@@B@@@B@@@@@B@@@@@B@@@BA@@B@@@B@@@@@BB@BBB@@@BBB@@BBB@@@B@BB@@BAB@BBB@@@B@B@@@B@B@@@B@@@B@@@BA@@@AB@@@@@B@@BB@DBB@BBB@@@@BB@@@@B@@B@@BB@B@@@BB@BB@@BB@@B@@B@B@@@B@@@BBB@@@B@@@B@B@BAD@@@B@D@B@B@B@@BB@@@@@B@@B@@@BB@B@B@DBB@@@@AB@B@B@BA@@BBB@BBB@B@BB@@B@@@BA@@@AB@@AB@@AB@@@BABAB@@AB@@AB@BA@@@@BA@@@AB@@AB@@@BA@@B@@@@AB@@@@AB@@@BAB@B@@@BA@@@A@@B@@@BBB@B@@@B@@@B@@@B@B@B@B@@AB@BBB@B@B@@B@@BA@@B@BA@@B@@A@@@AA@@@@A@A@@@A@@@@BA@@@@@A@@@A@@@A@@BA@@B@B@B@@@BA@@BA@@@A@@AA@@AA@@A@AA@@AA@@@@@A@@B@@AB@@A@@BA@@@A@A@@@A@@@A@@B@@AB@@AB@@A@@B@@A@A@@BA@A@@@@@@B@@AB@D@B@B@DAB@B@BA@@@@@AB@AA@AAA@A@@AA@@@@@@@A@@@AA@@AA@A@@AA@@AA@@A@@AA@@A@@AA@@AA@AA@@AAC@@AA@@AA@@A@A@AB@@A@A@@AA@A@A@@AA@@A@@AA@@@@@@A@@BA@AB@@A@@@A@@A@@AA@@A@@A@@A@A@A@@@A@@@A@A@@@AB@@AB@@@@A@@@AB@@A@A@A@@@A@@B@@AB@@A@@@@@@@AB@B@@@@ABAB@@ABA@@BADA@@BA@@@AB@@AA@@AAA@AAC@@AA@@@A@AB@@A@AB@@A@@@AA@@@@AAA@@AA@AAAA@@A@A@@@@@A@@@A@@A@@A@@@A@@@@@A@@@A@@@AAA@@@AA@@AA@AAA@A@ABA@A@@AA@C@A@A@A@@B@@A@EAOBGAIEGEE@]FMPKHCBGDEAAGGIAAAOGIICQIIKUSKCGE@CAIEICEWEQ@KAKBKBG@@@C@A@@@@@ABCBA@A@AB@@@@A@AB@@CA@@ADGFA@CDA@ABC@@A@A@C@@@@CC@@A@@@AA@@AA@A@@A@BC@@A@@A@@@AAABA@@AG@@CBA@@@AA@AA@CAEAAAA@C@@@CBA@@@@@CAA@A@@A@@CAA@A@EA@@A@AB@B@@@BA@A@@@A@AA@@AB@@A@AACBC@A@@@@B@B@BA@ABB@@B@@BB@@@@@BA@A@ABABAB@@@B@@BBADADA@@B@B@@@@ABA@@@C@AAC@@@@A@A@AAAA@A@A@C@AAA@A@A@AAC@AAA@A@A@CAA@BCACACAAA@IBYDWLIHKFGBI@WEMGKCC@I@K@GBQ@KA@IBI@G@ECES@WJIDOHGDSFGFIDEBE@KDEAM@IBOFKBIJKJERAAGCGIGIGEQCI@AAE@AAA@@AAA@@A@A@AA@@BA@CAA@ACACAA@AA@@@AAA@@EC@A@@AA@@@AAA@ABAAA@@AA@AC@@A@@@AEAA@A@@@A@A@C@AAA@E@A@A@A@@@A@@@GE@@@@@AB@@ADCBAB@BC@A@A@A@@@AB@@A@@@@BAB@@A@@A@AAAA@AA@@AACAC@@AA@C@AA@A@@@A@AAA@ABC@A@A@ABABA@AAAA@@AAC@AA@@@C@@@@@A@AACAA@AAA@@B@BABA@@AA@@DA@@@A@@@@@@AAA@@A@A@@A@A@AA@@EB@@A@AA@@@@AEAC@@B@@ADCBAB@AC@C@A@@@@@ABABADE@A@ABA@A@@BA@@@A@@A@EE@@A@A@@@@A@@@C@A@@@CA@@A@ABA@@BAD@@@@A@@@@@A@@@A@AAAAA@AAC@@@A@A@@@AAC@@B@BAB@B@BAD@BA@@D@BA@@DA@@@A@AA@@ACAA@@@@A@CAAB@BABCB@@A@@@ABA@@BA@AB@B@DBB@@AB@@@@A@@AA@@@CA@@@AAA@@@@CAA@AAC@@@CAC@CAA@@@A@A@@BABCB@B@@@@ABC@@@@AAA@@@CAA@EAA@@@A@E@A@A@@@AAA@AB@@SCIACKGKKEWI[GUIOGOOMGMEICWA[EOCYIS@SBQDM@"],encodeOffsets:[[127670,42256]]}},{type:"Feature",id:"210423",properties:{name:"清原满族自治县",cp:[124.924083,42.100538],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@B@@A@A@EBA@AB@@@@AB@@@B@BABA@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@B@@@A@@BA@CBA@@BABCB@DAB@BAD@BAB@B@B@@@@@B@@@@BB@@@@@BA@@B@@@@@@AB@@@@@@AA@@A@@@@@@@A@@B@B@B@D@B@@@@@@A@@@CAA@@A@@@@@@@A@AAAB@@@A@@@@@@@@@@AA@@@A@@AA@@@A@@@AA@@@@A@@A@@A@@CAA@AAAAACA@@AA@A@A@A@@@A@A@@AA@@A@@@@AA@@@A@A@@@AB@@@@A@@@@@A@@@@B@@A@@@AB@@@@@B@@@@@B@@A@@@@@A@@@A@@B@@A@@@A@@B@@@BABAB@B@@@@@@AB@@A@@@A@@@A@@@A@A@AB@@A@@@C@C@AA@@@A@@CAAAC@@@A@@@AAAA@C@A@@@AA@@AA@A@@A@AA@@@@@@B@@@B@B@@@B@B@B@@@BA@@@@B@B@BA@@B@B@@AB@@@B@B@@AB@@@@@B@@AB@@@BA@@B@@@BAB@DABA@@B@@@B@B@B@@@@@B@@@BA@@@@DB@@B@@@@@@A@A@@B@@@@@B@@@B@B@@@@A@@@@A@@@@A@@@@A@A@@AA@@AA@@A@@@AA@@@A@@A@@A@@AA@@@A@@@A@@@@@@AA@@@A@A@@@AAA@A@AA@@@@@A@@@@B@@@B@B@@@DB@@B@BB@@B@B@B@B@@@BA@@@A@A@A@@@@@A@@BA@@@A@@@AA@@@A@@@@@A@@AA@@@@@AA@@@@@@A@@@A@@@@@@@A@@@A@@@AA@@BA@@@AB@@A@@@AA@@@@@@@@@@@@AA@@@@@A@@@A@A@@@A@@@AA@@A@@A@@@@@A@@@AA@BA@@@A@@BA@@AA@@@@AAA@A@@A@@@@@AB@BA@@@@@@@A@@A@@@A@@@AAA@@@@@AAAA@AA@@A@@@A@@@AA@@@A@A@@@A@@A@@@@@@BA@@B@@@@A@@@A@@@@B@B@@@B@@@B@BB@@BB@BB@@@B@@@@@B@@@B@BB@@BAB@B@@@B@BB@@BBBB@DDBBDDB@B@@BD@DB@@B@@@@B@@@@BBB@@@@BB@B@@@@@BB@@@B@@B@@@BBB@@@@@B@@@@B@@BB@@BB@@B@BB@@B@@BB@@B@@@@B@@A@@@@BA@@@A@@@@B@@@@@@BB@@@@BAB@@ABA@A@@@@@A@@A@@@@@AA@@A@@AAA@@@AAA@@@@@A@@BA@@B@@@BA@@@@@A@@@AAA@@@@@A@@@A@A@@AA@@@@AA@@A@A@@A@@A@@A@@AC@A@@@A@@BC@@@ABA@A@@@A@@@AA@@AAAA@@A@@@AB@B@@A@@B@@@@@@AB@@ABA@A@@@AA@@AA@@@AA@@@@AA@@@AA@@@A@@AA@@@@A@@AA@AB@@A@@@@@A@@@@AA@@@@@A@@@@@@@A@@A@@A@@@@@A@@@@A@@A@@@@B@@@@A@@@@@@@A@@@@@A@@B@@@BA@@@@B@@BB@@@@@BA@@@A@@B@@A@@@@B@@B@@@@@@BB@@@AB@@@BA@@BA@@B@B@B@@@B@B@B@@@@@D@B@@@B@B@B@B@B@B@@@@@BB@@B@@@@@B@@B@@B@@B@@@@@BB@@@@@BA@@@@B@@@B@@@B@BA@@B@@@BA@@@@B@B@B@B@@BB@@@B@B@B@@@B@@BD@@@B@B@@@BB@@@@B@@B@@@@BB@@@D@@@BBB@@@@A@A@@@A@A@A@@@ABA@A@@B@@@B@@@B@@BB@@@@BB@@@B@@@@BB@@@@@B@@@B@@BB@@@B@@@B@@@B@@B@@B@@@@@@@B@@@B@@@B@@@@@B@@@B@@@@AB@@@B@@@@@B@@@@@B@@A@@B@@A@@B@@@BA@B@@B@@@@B@@@@A@@B@@@@@@B@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@B@@@@@@BB@@@@@B@@@B@@@BB@@B@@@@@@@B@@@@@@@@@BBBABAB@BAB@@@BAB@@@B@@AB@@@B@B@B@B@BAB@@@B@@@@@@@BA@@BA@AB@@@@@BA@@@A@ABA@@@AA@@@@@B@@A@@@@B@@@BA@@B@BA@@B@@@B@B@B@B@@@@@@AB@@A@@BA@AB@@@BAB@@@B@@@BA@@B@@@@@@A@@@AB@@@B@B@@@B@DA@@B@@AB@@@BA@@@@B@@@B@@@B@BA@@B@B@@@B@@@B@BAB@@@B@B@B@B@B@@AB@BAB@B@@@@@BBB@@@B@B@B@B@B@@@B@B@B@BAB@B@@BB@@@BBB@B@DBB@@@@BB@@BBB@@BBBBB@@B@@B@@@@@@@@A@@B@B@@@B@@@@@@@B@@A@AB@@@B@BA@@B@@ABA@@@@DABA@@@A@@@@@@BA@@B@B@@@BA@@BA@@@A@@AA@A@@@@B@@@@@B@@@B@B@B@B@@ABA@@B@@AB@BA@@@@@A@@@A@@@ABA@@B@BAB@B@BA@@@@@A@@@@A@@A@A@AAAAA@@A@@@@AA@A@@@A@AAA@@AA@@@AAA@@@A@@A@@AAB@@A@@@@BA@@@A@@@AA@@@A@@@@@A@@BA@@@AA@@A@AA@@@@@@A@@AA@A@A@@AA@@@@@@A@@@AAA@@@A@A@@@A@@A@@@A@@@A@@@A@@@A@@@@@ABA@AB@@A@@@@@@A@@@@BA@A@@@A@@@AA@@A@@A@@A@@@@@A@A@@@A@@@AA@@@AA@@A@@@A@A@@A@@@AA@A@@AA@A@@@AA@@A@@BA@A@A@AB@@@@AA@@A@@@@BA@@BA@A@@@AB@BAA@@A@@@A@@AA@A@@@A@@@A@A@A@A@@@A@@@AAAB@@@@@@ABA@@@A@@@A@A@A@@@A@A@@@A@AAA@@A@@@@@AB@@AB@@@BA@@@A@A@A@@@@@AB@@A@@@AB@@@@@FA@@B@DA@A@@BA@@A@@A@@@@BC@@@A@@@A@AA@@A@@BA@@@A@@@@@A@@A@AA@@@@A@@AA@@@@A@@@AAA@@@A@@@@BA@A@@@@AA@@@AA@@@@A@@@A@@@@@AA@@@AA@@@@A@A@@@A@AAA@A@AA@@@@@A@AAA@@@AA@A@AA@@@@AA@@@AB@@AAA@A@@@@AA@@AA@@AA@@@@A@@A@@A@@AA@@AA@@@AA@@A@@A@@@@AA@@@@@@CCA@AA@@@AA@AAA@@A@@AC@@AA@@@@A@A@AAA@AA@@A@@A@@AA@@@@@AA@@@@A@@A@@A@@A@@AA@@@AA@@@@AA@@@@@@AA@@@AAAA@AAA@AAAACA@A@@@@@@@AB@B@@AB@@C@A@A@A@@BA@A@@@@@A@A@@@A@@@A@@@@@@BA@@B@@@@A@@@@@@A@C@CAA@EAA@IACACA@@A@ABCBAB@@E@A@A@@AA@@C@@@A@C@CA@@@@AAAAC@AAAC@EACACAA@C@A@AACAAAAC@@@A@@@@AAC@CACBC@C@@@CCA@C@IA@@@@A@@AB@@A@@AA@C@A@AAACAA@AC@GA@@AA@AAA@@A@@@AAAAAA@@AIEA@AA@AA@C@ACCA@@AACAAAA@E@@@CAA@AAA@AAAA@AC@@@A@@A@@A@@@@A@@A@A@@@@A@@@@A@@@A@@@AB@@A@@@A@@@A@@BA@@@@@@@IAUECCECIEKIOMMEOKQIIMKGGCGAEAGBMDKBKBMOAGCCGGKESISMICKAK@M@Q@MGCAIAIAK@IBKDGDIAECECIGMIOG]@EBIBCJABCBIEKGQIUCYEWEUFKRG@I@A@G@QASBQHKBGAGA@@A@@AAACCCA@@AAAA@AA@AAGA@@E@ABA@A@@@@A@AC@@AAA@@CAA@@@@B@@ABA@@B@B@DAD@@A@A@E@A@A@@@A@EB@@@@BB@@A@@@G@@@AB@@C@A@AB@AA@@ABA@@@@AA@@@@ACA@AA@@AAAA@@A@@@BA@@@@@AA@AA@AAAA@@@@AAA@@AA@@@@@A@@EAUKIEIEIAKAI@QBEB]HOFCBEDMH@LCXAF@HADIDIDGDGBFLHHHFFH@LMLQAE@MCMAO@OBE@YEOGSC[BQFIDUDOD]DQD@BCB@@C@A@A@AB@BAB@@A@@@A@AAA@C@@@@DA@A@ABAD@B@B@B@@@BC@@BA@A@CB@BABA@AB@@@@@BBB@@@B@@@B@@BB@@A@@BABA@@@A@A@A@@AA@ABC@ADA@@BC@C@@BA@@@CCA@@@A@@BABCB@BAB@BEA@@A@@@C@@B@@@@@B@@BBBB@@BBDBB@BBB@@@@B@BAD@@AB@DA@AB@@@BB@AD@BABABABA@AB@@BB@D@DA@AB@@@B@B@B@@@@@@@D@@BDABABA@CB@@@D@BCDAD@@AB@@C@@BA@@BBB@BBB@BABA@@@A@A@A@A@C@@@A@@B@@CB@@@@ABAB@@A@@@ABA@AB@B@B@@A@A@@B@B@@@BADAA@@A@C@A@CB@@@@CAA@A@@@@@@@@B@BC@A@A@C@A@@@@B@@C@AACB@@@AA@A@@@AA@@C@AA@@A@EBAAA@A@@B@@AD@@BBB@B@B@@BA@A@CA@B@AC@A@C@A@@C@@AAAA@AA@ABA@@H@@@@ABA@C@A@A@@@@AA@@A@@A@C@ABABA@@@@@DGCB@@@A@@B@BAB@IAC@@@@@C@C@AADC@A@AA@A@AAAA@AE@A@A@G@CBADA@@@A@MAA@A@@B@@@B@@@B@BA@@B@@@BA@KDCH@B@FAHCJEJIHAD@@AB@@@BFBD@BBB@B@B@BBBBDBB@@@B@@BB@B@@@BBB@DBDBBBB@@@FB@@@B@@A@AB@@ABA@A@@B@BBD@B@B@@BB@@@B@B@@@@@@B@B@@ABB@@DABBDB@@D@@@D@DBB@D@@AB@BBBBDBFBBBB@D@BB@@BBBBD@DBFBD@DBB@BB@B@@@B@@@@B@@BB@@BDBB@@@B@B@B@DBD@BB@@DBDDPJFFHDLFTDXFXFTFNBZJH@LFNNJJRTHFFFFHCHEFGLDLHLJFFFJLBJ@HEFKHONINERCD@@ADKLEPANCFEREPDHHHLHRTTNFFHDFJJPDV@FBHLNFJFHDJAFELCJCNDB@B@@@B@@@B@@@B@@@B@BB@@@@BBB@B@@B@@@B@DABAB@B@@@BBBB@@BAD@B@@@@@D@B@DBD@B@DA@@B@@ABAB@DAB@@@BADAB@BBD@B@B@@@B@B@D@B@D@B@DBB@@BB@B@DAB@@BB@@@B@DA@@B@B@B@BA@@@CA@@@BAAA@@@A@@AA@@AAC@A@@A@@ACAAAA@AB@@C@A@@@AAC@@@@DAB@@@BAB@B@BAB@BAB@B@@@B@BA@C@AF@F@B@BABA@ABABBB@BAB@B@BB@@B@@@B@DA@@B@D@B@BBD@D@B@FBB@@@D@B@DBB@BBB@H@@@@AB@@AB@JAB@B@D@B@B@DBFDBBDBBBDB@@B@B@@@B@DBB@B@B@B@D@BBB@B@B@B@@@B@B@BA@@B@@@BB@BB@BB@BB@@B@B@@@B@B@@DB@@@@@B@BB@BB@@DBB@@BB@B@@@B@B@D@B@B@B@BBD@@@DA@@B@B@B@@BD@BBB@DBB@@B@BB@B@B@B@BAB@@ADC@@B@DADAD@@AB@@@BBH@DBB@B@BBB@BB@@@D@BBBB@BBBD@@ABBB@BBB@B@@@B@B@B@@BBBBBBBBBB@@CBABEB@BABC@AB@@@@DF@B@@A@A@BB@LADA@@BBB@@A@@BAB@@C@CA@@CA@@A@A@AB@@ED@@B@B@BB@@@B@@@@C@@@ADABAD@B@BBBBB@B@@@B@@BB@BBD@BB@B@B@B@@@@B@B@@@@BBDBBB@@B@@BB@@B@@@B@B@@B@BBB@FDNF@@B@B@B@@@B@@@B@BBBA@@BB@@B@@ABA@@B@B@BAB@B@@@B@B@B@DABA@A@@B@BB@B@@@B@BBB@@@FB@JCH@BATCD@DAH@F@DAHAJE@@FBBBF@D@LBD@F@BADB@A@@@A@@B@@@@@BA@@@@DC@A@@@AA@BCA@@AB@B@BABADAB@DB@@DA@@D@BB@@BAB@B@BBB@@@@A@@BAD@@@B@@@FBB@@@@@@A@@B@@@D@B@B@B@B@B@@@B@B@@@@BB@@DD@@BB@B@@BBB@@B@B@B@D@B@BAB@@@B@B@@@B@BAD@@ABABAB@@AB@B@@A@A@@BC@@@AB@@A@ABA@@@@F@@@@AD@@@@AB@BADC@@@AA@@@AC@AA@@@@EAAA@@AA@BAAAB@@A@A@@B@B@@AD@DAB@BBB@BA@@B@@@BBBAB@B@B@@AB@BABB@@@BBB@B@@@B@@B@@B@@@@DD@@F@DB@@BD@B@@HAFA@@@@@A@A@A@@@AA@@@@@@A@@DBDBB@@@BBFBB@@AD@BA@@BBB@@@@B@B@BB@A@ABAD@@@BAB@B@BAB@@@BA@@@@BA@@@@B@@AB@@BBABA@@BBB@@BB@B@@@B@BB@@BB@BBBBBBB@@@BAB@BBB@B@BB@@D@@@B@@@BB@@D@BAB@B@B@BA@@D@BBDDD@@BB@D@B@B@B@D@@@BBBBB@@B@@@B@@@BBBB@@B@B@@@BAD@@A@@@@BAD@@A@AB@@@@@D@@AB@BB@AB@@BB@@A@@B@@BB@@@@AB@@BBAB@@@B@B@@AD@@@BB@BD@BD@B@BA@@@BDB@@@BBB@B@@B@B@B@@@BA@@BBLDHB@@DB@@@BDB@BB@@BD@B@B@@@BBF@FB@@DBBD@BB@DB@D@B@B@B@@AD@@@BFDLHDB@@@@BBBB@@B@B@BB@BB@B@@@@BBB@@@@D@B@@AB@B@@@BBB@B@B@@@@@BB@@B@@@@@@AB@@AB@B@@AB@@@@@B@B@B@@@B@B@@@B@@@@@B@BA@@B@@@@@B@@@@@B@@@BAB@B@B@B@@@B@B@BAB@B@B@DABA@@B@@AB@@@@A@@@@@@@A@@@A@@BABABA@@B@BC@@@@BA@@B@BA@@B@BAB@@@B@@@@A@@@A@A@@A@@A@A@@@C@@@@@A@@@@A@AAA@A@CCAA@@A@AA@@@A@@@@@AB@@@@@BAB@B@@@B@DAB@@@B@BADA@@B@@@DAB@B@@AB@BAB@@@@@B@B@@AB@@@B@B@@@B@DA@@BAB@BAB@B@@@B@@@B@B@B@@@B@@@B@@@BAB@@@B@@A@@@@BAB@@@@A@@B@@ABAB@@@@AB@@A@A@@@A@@@A@@@@@AB@@A@@@@@A@@B@@AA@@A@@@@@A@@A@@@@A@A@@@@@A@@@@@@@@@@BA@@@@BA@@B@@@@@B@@@@A@@B@B@BA@@B@@@B@@@@@@@@@B@@A@@@@@@@A@@BA@@@@@AA@@@@AAA@@@A@@AAB@@A@AA@@@@AA@@@@A@AAA@@@AA@A@A@AAAA@@@@@AA@@AA@@@@A@@@ABAB@@A@A@@@@@AAA@@AA@A@@@A@@@AB@DAB@BADA@@B@@@B@D@BAB@B@@@@@BA@A@@@A@@@@@@BABA@@@@BA@A@@@@@A@@@A@@@@@A@@@A@@@@A@@ACAA@CA@@AAAA@AA@A@@A@@A@A@@@EB@@A@@@A@A@@@@@AA@@A@@AA@@@@@A@A@AAA@A@AAA@A@A@AA@@A@@AA@A@@BA@A@@BA@@A@@@A@@@A@A@@@A@@@@B@@@@@BA@@@@B@B@@A@@@@@A@@@@BA@@@@B@@@B@BAB@B@@A@@@A@A@@@@@A@A@@@@@A@@@A@A@@@AA@@A@@@@BA@@@A@@@@BA@A@@BA@@BAB@@A@@@AB@@A@AA@AAA@@@AA@@BAA@AC@ACAAA@@AA@@@@@A@A@@@C@ABC@A@@@A@ABA@@@@B@B@B@B@@@B@B@@@B@@@B@@AB@@@@AB@@@@@BB@@B@@@@@B@@@B@BAB@B@B@B@@AF@D@@AB@BA@@BAB@@@@@B@D@B@B@B@BAD@BA@@B@B@@BB@@B@@BB@@@AB@BAB@@AB@B@B@BBB@B@@BBB@@@DB@@B@@DB@@B@B@B@DB@@B@BBB@DDDBBB@@@@BAB@BA@@BAF@BADAB@B@F@@@B@@@@BB@BB@BB@@BBBB@@@@@B@"],encodeOffsets:[[128270,43329]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
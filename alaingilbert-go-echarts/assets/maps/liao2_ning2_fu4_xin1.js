@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("阜新",{type:"FeatureCollection",features:[{type:"Feature",id:"210902",properties:{name:"海州区",cp:[121.657638,42.011162],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@FBB@B@DAHC@@BA@@BBFBB@@B@@@BBB@@@B@@BBDBAB@@CDHBCFEFEFFDBBDF@@BBBB@DBAF@FAFA@@@@B@@@H@@@B@@@BBD@B@D@@@@@@@@@B@DBB@@BB@@@B@@@DB@@@@B@HDB@DBDBD@BBFBHB@@@@AAB@@A@@BA@@@@DB@@@@B@BCAACC@@GCGAA@@@GE@@A@EECAAA@@AAAA@@CCE@A@BE@@BC@@BAKE@@@@BAA@B@EA@A@@@@C@@@AA@@DBL@B@BBB@DB@B@A@ACAACCE@A@A@A@A@A@@B@@@BAB@B@@@BAB@@C@@@A@@FBB@DEBA@A@@BAA@@A@ADK@KCGBADCFBD@H@H@F@B@B@B@BBBB@@@@DDBAD@NHHFFDF@BBB@A@C@AF@@@BC@@BBBHBDEDD@C@CFKBI@EA@CA@@@@EBCBCBA@A@A@CAGEA@AAA@AA@AA@A@@@@AA@@@A@BA@@@@A@@@@AAA@@A@C@A@A@@A@A@ABAA@EB@AAC@A@@AA@A@@@AC@@A@A@@AA@A@@AB@B@B@@@@A@BBA@C@E@@@AEED@@A@@A@@@@@@@A@@A@@@A@A@ABAAAA@A@@CB@AD@@A@@@@A@@@CB@@A@AAFA@@@ABA@@CACBA@ACA@@A@@A@@BA@CBCBA@CAAAA@@@@@@B@@@@AAA@@@@A@@@@@@A@@AE@@@ABC@A@BA@CEABA@@@@A@@@A@@@A@@@@A@@A@@@@A@A@@EAA@@A@@A@A@@B@@A@A@B@@A@@@@@@@@A@A@@@A@@BABAB@@@A@@A@@@@A@A@ABAE@@BAB@B@@AAG@FAB@@@@@@@EA@@A@@@A@ACA@@@@BB@@@AB@@EA@@@@@AA@A@A@A@@BA@@@@@@A@ABA@@@@G@ABA@BACB@@CB@@@@BAB@@@@AA@@@@@@@AA@@ABA@EB@@@BA@@@A@AAA@@@AA@BA@@@BD@@A@@BA@@B@B@@BBCB@@@@ABB@@@@@A@BDA@@B@@@@@@ABAA@@@@@A@@@A@@@@C@@@@B@@@@@@CBA@DD@@DBB@@@@@ABCB@@@@BBBB@B@B@@A@@@@@@BBB@@@@@BA@@@@BBA@BADD@B@@@B@@@@B@@BB@DB@@@B@B@B@B@@@B@@@B@@B@@BCB@BBB@@B@@A@@B@@@BB@A@ADC@@BA@@B@@A@@@A@@@A@@BA@FFDBBB@BBB@B@H@HAR@BBB@BAB@BAB@@@B@BBB@BB@@@B@@@B@B@BA@@A@@AB@@ABBDC@@DABB@@B@BB@BABB@BADB@BB@@@DDCDFHBBFB@@"],encodeOffsets:[[124530,42998]]}},{type:"Feature",id:"210903",properties:{name:"新邱区",cp:[121.792535,42.087632],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@ADC@@B@@BB@B@@@B@@@AA@A@@@@B@@@@@@CA@@AB@BAB@@ABA@@B@B@B@B@D@@ACA@@BA@@@@@@A@A@@@@@@AD@B@B@B@@@AA@@B@B@@@B@@@@@AA@@BA@@@@B@BBB@D@B@@@A@A@@@A@@AA@@A@@B@@@@A@@B@BBB@BA@@A@@@B@DBB@@ADA@@@@@@@A@@FAB@B@@@B@B@H@@@@A@@@@AAA@AA@@@AAA@@A@@AB@@E@@@@@A@@@@B@AAA@@@BCA@AB@@@A@@@AB@AAAAAA@@@@B@B@@A@AA@@@@@@@B@AA@@@@@@@@@@BA@@@@@AA@@@@@@A@@@@@@AABAA@@A@@@A@A@C@A@E@EAA@@@C@C@CAA@CAE@@@@BC@@BA@@AA@@@A@@@ADA@@@@AA@@CC@@@AB@@ADA@A@@@A@A@A@@B@@@BA@@@@@A@A@@@A@ABABA@AB@FB@@@A@@BABCB@@A@A@@@AAA@A@@BAAAB@@@B@@@@A@@A@AB@AA@@@BA@@@@A@@@@@@@@@AAA@@@@A@AA@@A@@BAA@@A@@BAA@@A@@@ABC@A@@AA@@CAA@@@C@E@@AA@AA@A@AA@@AC@CBC@A@A@CBABE@@@@@CA@A@@C@A@A@@@@@BB@B@BB@@@@B@@@@ABBBBB@DA@@BA@C@@@A@C@CBEDA@@@EACAA@A@@@AA@AAAAACC@AAAA@@@@BA@@@A@AACCAAA@AAA@A@C@CB@@ABA@@@@B@BA@@BA@ABA@@P@JK@BDAFCDCCCAEDEJGFIDJDADEB@BF@HF@@@D@@@@BBADFFBDEACE@BGDA@DAAAE@GCK@OJB@ABA@@@@@@D@@A@@@A@B@A@@@@@@@C@@@A@@@A@@B@@@B@@@B@@@@@B@@@@A@@@@B@B@@@@AB@BC@@BA@A@@@A@FB@@@B@@BBF@B@BBBB@@DBDBB@DBBBB@@@ABABADA@@B@@@B@@B@@BA@A@AAA@A@A@AB@@GDB@FFA@ABED@BACA@BD@@BDBBA@ABABC@@@@@@BA@A@@D@@@F@@@@@B@BH@FBF@D@BBF@@@@@@@ADABCDDBAB@@ABA@@BCB@@AB@@AB@@@B@@A@A@@@@@AB@@@@@@B@BB@@@@@B@@ABA@ABAB@@@B@@AD@@D@B@BAB@@@@B@@@B@D@@@@DA@@@@@BBB@@B@@@DBBAB@BBD@BBB@@@@B@D@@@@DD@@B@@@AB@@B@@@@BA@@B@@B@B@@@B@A@BDB@@@@BBB@@@@B@@@B@B@BAD@@@@@FD@@A@B@AB@@@B@@@BB@BBB@@A@@BA@@DD@@@@BB@@@A@@B@@AB@BB@@B@BAFCB@@AA@B@@@B@@BB@FB@@B@@A@@B@@ABAB@BA@B@@BB@@D@DA@@@A@@@A@@@EBE@@@@AA@@BE@@@@@A@@A@@A@@@A@ANBBA@@DBB@FBB@B@BBB@FBD@D@B@FAFA@@@@BA@A@AAA@@A@"],encodeOffsets:[[124772,43120]]}},{type:"Feature",id:"210904",properties:{name:"太平区",cp:[121.678604,42.010669],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCCFGAAA@AD@@A@@BED@B@A@AAE@ECGEMGC@ABCC@@@@AAAAA@A@A@E@G@G@C@EACDABDH@LCL@B@BB@AB@@@BABCFA@EA@@@B@@@DA@AB@@A@A@AB@@A@@@@B@B@B@B@BDFBDDB@B@B@ACAA@AAA@K@CA@@BB@@D@@@@@@BFBA@B@AB@@@@LFAB@@AD@@AFB@F@DD@@BBBB@@BBDBFFB@@@HF@@B@HBHD@@DDBBHBBBJ@LFJFBFLDD@DB@D@B@BB@BBHBD@DF@@FDB@B@B@D@B@B@B@BA@@BABCB@@@B@BC@@FBD@HDRF@@@A@A@@B@@@@@@A@@@@@A@@@A@@@AB@@@B@@@D@@@@@@@B@A@B@@@B@@@@C@@@@B@BAA@PIL@HDF@BBCBB@HC@ADFFBACEEBCAA@@@@@C@@GEE@@AFABCICJCHEFIFCDBDDDCBEACL@@I@OA@A@A@@AC@A@A@A@CA@@@AAA@@CAAAA@@@A@AA@@AAAAAA@A@A@A@A@@AC@AAAAAAEA@@@AA@@AAA@@@AA@@@@A@A@EA@@A@AAA@AAAC@@ACAA@A@@AAAA@AA@@A@@C@@@AA@@@AA@A@@@AA@@AB@@A@A@A@A@ABA@@BC@CCA@AAA@C@@@A@ACAAE@AAA@A@@@CD@@AB@@@@@B@@@@@@CB@@@@@@@BC@@@A@@A@@CDA@CB@BC@AAC@@FAJEL@D@D"],encodeOffsets:[[124640,42979]]}},{type:"Feature",id:"210905",properties:{name:"清河门区",cp:[121.416105,41.7831],childNum:1},geometry:{type:"Polygon",coordinates:["@@M@QBCF@@@@@BC@BCB@BAEAAFA@ACA@C@A@ABADKMBFADEDKA@AA@@@@@A@@@OGGC@E@CGAG@KBMBSFQFEDEHEH@@DD@BB@B@BB@@D@@@BB@@B@B@@@BB@BBBA@BB@@@@AB@B@@@@@B@@@B@BAB@BBB@BA@@BA@@@BDBB@B@B@B@B@DBAB@@A@A@@@@@A@@@AD@B@B@H@B@@B@@@B@@BB@@B@B@@B@@BBBB@@BADB@@B@@ABA@@@@@AB@@@B@B@BDB@BBB@@@BB@@B@B@BA@@@@D@B@BB@@BAB@B@@@B@BB@BB@D@@B@@F@ADBB@@BBB@@@B@BB@DCB@@B@AD@D@@@B@@B@@AB@@ADA@@H@@@B@@BA@@@BBFADD@@B@@A@@D@@B@BBB@@@@A@A@@@@@BB@BE@BDBB@@B@BB@BBBBBBDDDBBDBDBB@@@@@@AB@@@B@DBF@BAB@@B@@@B@BB@@@PGF@BADA@A@@B@@@B@@A@@B@@@@A@@B@@A@ABAB@AAH@B@@@B@@CB@@@BA@@@@@@BABBA@@B@@B@@@@B@@DBBAB@@@@@@A@E@@LB@@B@BE@@@AKA@@B@AA@@C@@@DA@CA@@@BA@AB@@A@@BA@@@@AA@@A@BABA@@AA@@@@@@B@@B@@@A@@@@A@A@@A@@B@B@@@@A@@@@@ABAB@B@BDB@@C@@BA@@B@@@BB@B@@@@BCBA@@FDBABA@@B@@AB@BBAD@B@FBAB@FC@@FA@@BB@DB@B@DA@@BDBFBB@@@@B@@BA@@BB@B@@@BA@@BCB@B@D@@@@D@B@@B@DBBB@@D@@A@A@@B@B@D@B@@BB@@@B@@A@A@@D@D@BA@@BA@AAAB@B@@@B@F@B@H@BBH@AAAAC@CAG@EAC@AAAA@@AC@C@AA@E@A@EB@@AC@O@A@C@C@@@@@ACCECAA@C@AD@DAB@@A@A@A@CBABABABC@A@C@ABC@AAECCC@EAC@A@E@IDCDC@A@A@A@GCA@ACCA@ACAAAC@@AAC@CACAA@CAC@CBAB@@C@AAAAAA@A@A@CAA@A@@@ABA@ADEBC@CADD@B@@CB@B@B@B@@@D@@@B@B@@BBABCDERGHABKHIJ"],encodeOffsets:[[124352,42735]]}},{type:"Feature",id:"210911",properties:{name:"细河区",cp:[121.68054,42.025494],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ABQ@G@G@AAA@AAACAEEADAB@@ED@@@@A@@@@A@@@@@@AB@@@B@@@@@B@AA@A@@@A@@@@@A@@@@@CBCBABA@@@@@ABABEDDB@@ABCBABCBCBA@A@IFA@E@CDABGFGD@BA@@@@@CD@BB@AFB@@B@@@BBBBCB@@@@@DD@@BAFDBBB@AB@@@@ABABBDBBB@B@@@BA@@B@@@DCBBDBB@A@@@B@@@@@B@@AA@@AA@B@BCB@B@@A@@@@B@@@@B@@@@@B@@@@@@B@BAD@B@@AB@@B@@FA@@BA@@@C@A@@B@@@BB@BB@B@@@B@@B@@F@CB@@@@DB@@B@XRLHBB@@@@AB@BA@AB@@BB@@EBCB@@@@@@BBA@A@@BA@ABA@@@@BABA@@@@@@@ABIB@@@@D@@BA@A@@@@B@@FABBJE@@BAB@@@@@@@@@@BA@@@B@@BB@B@@@@@AB@@A@A@@BBBB@@BB@@@B@@@BB@B@@@BBB@B@@@BA@AB@BA@CBCDA@A@@@@DBD@D@BB@DB@BABCBAB@@@@BBB@B@D@@@BB@@@@@@AB@@ABB@@BABCCA@AB@@BF@@@AB@@BAB@B@@B@@@@@BDBB@@B@FA@@@BB@A@EB@@B@B@B@B@BAB@B@B@@@B@A@@BAB@@C@@@@@@BB@@BBA@B@@A@@BB@@@B@DD@@@BBB@DA@@D@@AD@D@@@BBBA@@B@B@B@@@B@B@@@B@@@B@@A@@BA@@BA@A@AAA@@ACA@AA@@@@@@BA@ABA@@@@BA@@B@@BB@B@@A@@BA@@AA@@A@A@@AAA@@AC@C@@@AAAB@B@@@BA@AB@@A@@@AA@B@@@B@@A@@B@@@@@BABA@@@A@@@AB@@ABA@AB@@A@@@AAA@@@A@@@@@@BAB@@@B@B@@@B@@B@@@B@@@@@BAB@@@ABAB@@@B@@FCBA@@B@@BA@AB@@@BA@@@ABAB@@@BD@@@@BB@BA@B@@@@@B@@@@@B@@@B@@DBB@@@ADCBCAABA@@@@B@B@BB@@@B@BBB@DBDBDB@@@AB@B@@@DBBDBADA@@B@BBBB@BB@BBHFBB@@B@HAFAB@B@@@@@BA@A@@@@BA@@@@CC@@@A@@@ABAB@@@AACABA@CB@@A@@BAB@B@@@@A@@@A@@AC@@DAFA@AB@@A@@AABA@@AC@@@A@AAAAA@@B@@A@@AAAA@@B@B@DDBBB@@A@B@@BA@@@A@@BA@AB@B@@AB@@A@@BA@@@@J@@BB@B@B@B@B@@@AA@@AAACAA@@BA@@AA@A@@@@B@@BDB@B@A@@@AA@AA@@FADA@AB@@@B@@@@@B@B@@@B@B@@@BBBBBB@@B@@@DAD@@@B@B@B@H@B@BBB@B@@@DD@@@BB@@AB@BB@@@A@@@@B@B@AC@@BA@@@@BA@@@A@@B@BAB@@@B@BBD@DB@@BA@@B@@@DBB@HDDDB@BBB@BB@@B@D@B@HBBBBAF@B@DCB@B@DBDEB@@@@ALIEOBK@A@ABA@@@A@A@ABA@A@ABC@A@A@@@A@AAE@A@CBABCAEIEKEI@AAGAADA@@@@@CA@@@@AB@@@BA@BB@@@@GAEAAAC@CACAA@GCA@@@@@CA@@A@@@A@@AA@CAA@@@@@@@@@C@A@C@AA@@A@@@G@@@A@@@@@EBEBE@AB@CAAAA@@CEAAECFEFEDEGADC@@BACAAA@@@A@@AA@A@@@AA@EAAA@@AB@@GDCBA@A@EA@@@@EAAAEGDCCC@@A@@ACAABA@BA@AAAA@@@AACB@@CDAA@BA@@BB@@@ABA@A@@@A@@@A@@AAA@A@A@@BA@ABA@AAA"],encodeOffsets:[[124506,42979]]}},{type:"Feature",id:"210922",properties:{name:"彰武县",cp:[122.538793,42.386543],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@@AB@@AB@@@@@B@B@B@B@D@B@@@B@BAB@B@B@@@DAB@DAB@B@@AB@@A@@DABABAB@BA@@BADABABA@AB@@A@A@@BA@A@@BA@@D@BABAB@B@BAB@BABA@@B@@AB@@@BAB@@@B@@@@@BA@@@@@CB@@A@@@A@A@@@A@ABA@@@@B@@@@@BA@@B@@@B@B@B@D@B@@@B@D@B@BAB@D@@@B@B@B@@@@@B@B@B@BAB@@@B@B@@@B@@@B@B@@@B@D@B@B@B@@@B@D@B@BA@@B@FAB@@@B@BAB@@@B@@ABA@ABA@ABA@C@AB@@@@@B@BBDBB@B@D@BBB@B@B@B@DADADA@@DAB@BA@A@@@@@@BC@@AA@@@A@@@A@@@@@@@@@A@@@AB@BABA@@B@B@DBBBB@BBBB@BBBB@BBB@DBB@BBB@D@B@DB@@@@@B@@@BBB@@B@B@@BB@D@D@@@D@BAB@B@BADBB@B@B@D@D@B@F@B@B@D@@@D@B@B@BBB@B@BBBBBB@@AD@BAB@@@DA@@B@B@B@B@@@@@D@B@D@B@D@B@B@BB@@BBBBB@BBBB@@BB@BBB@DBBBB@DBB@B@BBB@DBHBDBBBB@BBB@BBBBDB@@B@@BB@DBBBBBBBDFBF@B@@BB@B@F@B@B@BB@@BBB@@DBBBB@B@B@BBB@DBB@D@BBB@DBBBB@@BBB@B@B@B@@@BAB@@@B@B@@@@@BAB@D@BAD@BAB@D@@ABA@@@A@EAE@A@AA@@A@@@A@A@A@@@AAA@@AA@AAA@@@A@C@ABA@@@@@A@C@A@@@A@A@@@A@C@A@A@A@C@A@E@CBE@A@GB@@E@C@A@ABC@A@A@A@@@A@@@A@A@C@@@C@A@A@C@A@A@@@C@@@CBA@C@@@ABA@ABA@AB@B@BA@@BAB@BABABABABA@@BAB@@@B@B@B@B@B@B@BAB@BAD@BAB@BAB@B@DAB@B@B@BAB@B@@@@AB@BADAB@@ADA@AB@B@B@@@B@D@B@DBB@DBBBBBBDBBBB@@@@@BB@@B@B@@@F@BBF@F@B@B@D@D@D@D@D@B@DBB@B@B@D@BBF@@@D@D@B@F@B@@@DBD@DBD@D@DBD@D@B@DBF@B@B@B@@@@@B@@@@@D@D@B@D@D@B@BAD@DAD@B@BAD@B@B@B@B@D@B@B@D@D@D@B@DAF@BA@@F@D@F@B@B@DAB@F@B@BAB@F@D@B@F@B@D@B@D@D@B@@@B@BA@@@@@@@@B@@A@@B@@@@@DA@@B@B@D@@@@@B@B@DA@@B@BAB@DAB@B@@@B@@@B@@@B@@@B@B@@@@@D@@AB@B@@@B@@@B@D@@A@@B@@@@@B@B@@@B@B@@@B@@@B@B@@@B@B@@@HAH@B@@@B@@@BA@@@@B@B@D@@AB@FA@@@@BADA@@B@B@DA@@BABA@@@A@@@@@AAA@@AC@@@@AC@@A@@CA@@A@@AA@@@@@A@@@A@@BABAB@@AB@BA@@@A@@@@BA@A@@BA@AB@BC@@@@BC@@@@@C@@@@@@BC@@@A@@FA@@@@BADAB@@@B@B@BAB@B@DA@@B@B@@@B@B@@@@@B@@@BABAB@@@@@B@DAB@@@FA@@B@B@DA@@B@B@BAB@@@B@BAB@@AB@@@BCB@BABAB@@@@B@@B@@@B@@@@@B@BBB@B@B@BB@@@@FBB@@@FB@@B@BBB@B@@@B@B@DBBBDBB@B@@BB@B@@@B@F@@@@@B@@@FA@@@@B@D@B@@@@@B@@@D@@@BAB@B@B@@BB@@@@@FA@@@@B@F@@B@@B@D@B@@@FB@@B@B@D@@@BB@@B@DA@@@@B@@@B@B@B@@@BA@@@@BBB@@@B@BB@@D@@@B@@@F@@@@@BBB@B@BBB@B@B@B@@@B@DB@@@@B@@@B@D@@@B@DBB@@@BAB@B@@@B@@A@@@AB@@@@@AA@A@@B@@A@@@A@@@A@@@A@@B@@A@@@A@AB@@@@A@@@A@@@@@@BA@@@A@@B@@AB@DA@@B@BAB@B@BAB@B@@@@@BAB@@@B@B@@@@@B@@BB@@@B@@@D@@@B@@@@@BAB@@AB@@@@ABAB@@A@@@@@A@@@@@AB@BAB@BA@@B@@@B@B@B@@@B@B@B@B@@@B@@@B@@@BA@@B@@@BA@@@@@A@@B@@@B@FBB@@@@@@@@@B@@@@@@@@@B@@@A@@A@@@@@@@AB@BA@@@@@A@@@@@@@@@A@@B@@@@@@A@@B@@AB@B@@@BA@@@@@AB@B@@A@@@@@AB@@A@@@A@@BABA@A@A@AB@@AD@B@B@BB@@BB@BB@@@B@@ADADA@AB@B@BA@@B@B@B@B@@@B@@@@A@@@A@@@A@ABA@@BA@@BA@@B@@@BABABAB@@ADABABADA@@B@B@BB@@D@D@B@B@@A@EAA@EACABADC@ABA@G@EB@A@@@@A@@@A@A@CBIBA@CBABMHGBA@@A@@KEC@AAAAAA@AA@IB@@AA@CAAAC@@AACCC@@AA@A@CBC@@@AA@@A@AC@@KCIEKIBKCAA@@AAAA@BAB@HGDCBABAHADAP@BADA@@D@BAB@DCB@@E@@@AB@@@BAB@BADAB@JG@@@@@ABCBAAA@@@C@C@E@@B@BAPBRBD@HADA@@@B@@B@D@@B@@B@@@BBB@@@@@@AB@@@B@@@@@@@B@B@@@BA@@@BB@@@B@@@@A@@@@@@@@@ABB@@B@@@@@@@@AB@@BB@A@@@@@B@@BBA@@@@@@@@BB@@@A@AB@@ABB@AB@B@D@A@@AA@@A@@A@@@@AA@@AA@@@BA@@@@@A@AFKA@@@@@@@DC@@@@A@@@@AJENGRKJMLI@@@@D@BB@BB@@@BCB@@A@@BD@BB@B@BABBD@@@FAB@BAB@B@B@HFB@FBBB@@DADABAB@DABAD@FAJCJC@@BADAAAAAA@EEBAAC@@@A@@H@@E@@DA@@BA@AJCB@LC@@@A@@HA@@@AJ@BABADA@AB@@@F@BAFADAB@DA@@B@DA@AAEBABADABA@@A@@A@@@@C@CA@@A@A@@@@@@C@AA@@A@AA@AB@@A@@AEEEG@ACA@@@BA@CCAAA@@@AA@@A@ABEBA@@@@BGC@@@@@@GCCAYOIKACB@@ABA@C@C@AAA@@@@@@AACBA@A@@A@C@@@A@CA@CC@@@A@ABI@@@A@@@AAA@@BA@@A@@AB@A@@@CBEB@A@@@A@AA@C@A@G@GBABC@A@@BA@ABA@AAA@@@@@A@@B@BU@aEWAQC@AUICAEA@@BAB@FB@@FA@@@C@@C@@@@@@@AA@A@@AA@A@@A@@@EC@A@@@@BC@@@A@@@@A@@AA@@AB@B@@@B@AADA@A@@@@@A@@B@@A@@@A@@AAAA@A@@DC@@@A@A@ADA@@@AA@@ACA@A@@@A@@DCDC@@@A@ABACA@ADA@@BAAA@@@@@C@A@C@A@@C@@@A@@@@@@AB@@@BA@A@@@AB@@B@@B@BB@@@@@@@@DC@ABCBAA@A@A@A@CAAAA@@@B@BA@@@AA@@A@@A@BA@@B@@@@@AA@@E@@@A@A@@@@A@@@@A@@@@A@AACAC@AD@BABA@@D@@@BBB@@@@@BBB@@@B@B@@@@@@BB@@@@@B@B@B@@B@@@A@AB@@@@A@AAA@@CACAA@@A@@@A@@BA@@@A@@@ADC@@BA@AB@BAB@@@B@@@B@@@BABBB@@@BAB@B@BA@@B@B@@@B@@B@BB@@@@AB@@B@B@B@@@BD@B@D@H@BA@@@@BDDB@@BABC@A@AB@BE@A@A@A@@@@@A@@B@BA@AB@B@@AB@BA@@B@@@@ABA@@B@FBF@@BB@BAA@@@@AB@@A@@A@@A@@@AACAA@AAAAAACAGJ@@AAA@AAA@@B@BAAUAI@C@CAGKACBW@@@@A@AGE@@A@@@@@@A@@A@CCIEECGCWIcYGC@@A@@@AAA@GEKEQKC@@AA@M@IAIEA@AA@@ICQEK@CAECEAA@BC@A@A@@@@GC@@D@@@GC@@@@@AB@BAAACCA@CAEDECOIOIGIEEIGUIUGSIICE@A@@AA@@@ABAB@@AAA@@BABAD@@QA@@AADA@AAAB@@A@@A@@@AAC@A@AB@B@@ABA@AA@@ABAB@@CAAB@@@A@@CA@AE@BCI@A@E@C@DQBGFIEAQEWKSEOGQGYGKCQGSGOGOGWK@AeQQIICKCEAMBIBABAD@@A@@@@@@@A@EA@BA@@BA@@A@@CAAAAAAA@AA@@ABADA@@BA@A@@A@AAA@C@A@CD@B@@F@ILGDA@AD@BAB@B@@CBABGBCBA@@F@@B@@B@B@DAB@@AB@D@@@@@@DB@@AB@B@@@@@@B@BA@@BB@@ABABAB@@A@AB@B@@B@D@@@@D@@@DABG@AAAB@BA@@BB@BBLB@@CBABC@DDDAB@@@@BEB@@F@D@@@@@ADB@HB@@A@CBC@CBABAB@BB@BBABBD@B@@A@ABA@@@AAB@@AB@A@AAA@@BA@@@GCBC@A@@A@A@@@ADA@@B@@@@@BB@@@@BA@A@CD@@A@CB@@@BB@BA@D@@F@@@@DA@@B@@@@@@AB@@AB@@BB@@AB@@@@@@@A@@A@EB@@A@@D@@C@A@@B@@@BBB@@HLBB@B@@DBLHDLIZBDDPOTUAOBQRCHCBM@GACEGCE@KD@DLJBHCBYII@EDBDABBBF@LDBFAHSDBPCFA@KAC@CBAFDFLDBBABEBICAB@BABG@KDIAKBKDCACGEAEJABGAEHABUAAB@BPJBBA@GBCBGB@ADECAEEEBCDABCDADBBAD@BABBDEJA@A@OCG@ABBB@BDB@DGBABBDBBFEH@D@BBCJ@@HBBB@DGBM@I@AJCFKCEECBCBBFFBDDAHDBENGBMAOAG@CBAB@LCBHFADBLGDKBMBILITKLMB[AEQQCOFGHSBAR[KKNGXADIZKVQZUJWLEDE@AFCB@DCBKD@B@@@BAAA@C@E@CBDBADCBGDCFA@E@ED@BGBC@CBE@CFCDCAABC@CAABC@CBCAMLMNCF@@@@@B@@@@A@@@@B@@@B@@@@ABCBABABA@CBCBEBIDCBCBCBEDEBAB@@@@BB@@@@@@@@A@@@C@@@GB@@@@@B@@@B@@AB@@@@@B@@@@@B@D@B@@B@BB@@@@B@@BA@@BAB@@CB@@CBCBA@@@AB@@@B@@AB@@@B@@@@A@AB@@@B@@BBB@B@B@@BBA@@B@@@D@B@@@@ABA@A@@@@@A@@BA@AB@BABABA@@B@D@B@BBB@BBBB@@@B@B@B@BAB@@@BA@ADCD@BAB@@@B@@@@@BDBB@B@@@B@B@@@BB@@BBB@@BB@@@@@@BA@@@A@@BA@@BA@@@@@AB@@A@@B@@A@AB@@C@AB@@A@@@@BABABA@@B@@A@AB@@@@AB@@@BA@@BA@@B@@@BB@@BB@@@H@@@@@B@B@BBB@@@B@B@BB@@@@BBB@@@B@BB@@B@@@@B@@AB@BA@A@A@A@A@C@A@@@A@ABA@@@AA@@@@AA@@A@A@ABA@@B@@ABBB@@@B@BB@@@@@@B@@@@@@@BB@BBB@BBB@@@D@BAB@@@BA@A@@@@BABA@@B@B@B@@BB@@@BB@@B@@B@B@@ABABAB@@@B@B@B@@@BA@@@@B@@BD@@BBBBBB@B@@@BABCBABAB@@@@BB@@B@BBD@@B@@B@@B@BB@@BBBBDFBBBB@BAB@BADCDAD@DBB@@BBD@D@BADABELCH@@@B@@BDDB@D@@B@BBFAJ@B@BAD@D@F@B@D@B@B@@BBB@@AD@FAD@B@@BDBBFBD@B@@@D@B@DAD@B@@@DCB@@AB@BIBA@ABBDBFDBDBB@F@DBDDBDBF@DAH@HAHCLCDAD@BBB@BBBDBFBBB@@@BBH@F@D@DBDBB@B@DBD@DB@BB@@B@@@B@@AB@B@@@B@B@BB@B@DBNFB@D@B@B@BAFAFAB@BC@AAAAC@A@CBAD@F@HBFDFBD@B@B@BCB@BEDKBAB@B@B@H@JAFAFADADADABAB@D@FDB@D@D@BCBA@AAAAA@A@AB@D@D@F@B@FCB@B@DAD@FAB@B@@@@@B@AB@@@B@@@BB@@@B@DAB@@@BC@@@AB@@@@@B@@B@@@BBB@@@@@B@@A@@BB@@@B@@@B@@@BA@@@AB@B@BAD@@@D@@@B@@@DBBB@@D@DBLBB@FBH@BABCBCAG@AAA@A@A@@B@@B@@@@@@@@@@B@@BB@B@@@@@B@@@@@B@@@@@BB@@BB@BB@@B@B@@@B@@@@BB@@@B@B@B@B@@@@AB@@@BBD@D@@@H@BBH@B@@DD@BBB@@@@@B@D@BAB@@@BADAD@@AB@B@D@B@DAB@B@@AB@@@B@@@@BB@@@@B@"],encodeOffsets:[[125139,43759]]}},{type:"Feature",id:"210921",properties:{name:"阜新蒙古族自治县",cp:[121.757901,42.065175],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@^JTpTPHOT[fGJLJJNFDNPBDRTLdTTbLXCtOb@VCZJ`Lj\\lKJCTME]GKISNkDIHITEHCZAFMRBLE@@HaLOPKPK@CAAACB@DA@A@@@@@@BAB@@@AAGB@@@@A@A@BDA@A@@C@AAC@A@@@@BA@@A@@@CAC@@A@AA@@AA@@@@A@@CB@@CBA@AA@@E@@@@AC@CAG@BCBAEAA@@@@BA@EA@@A@@@ABA@@@AAB@@@@@@AAB@@@@EB@@@@A@@@A@@AA@CBEB@@@@E@@@A@CBA@@B@@GBA@@AA@AA@CCA@AA@@@ACDCDABA@@@@@@@AHA@@@AA@E@A@@AGDC@CBAAC@AAAAAA@C@ABCBA@@@CCC@@CACEA@@A@CEAA@A@@@AB@@@ABAA@@@AA@BAD@B@@@@A@@@@ACBA@@@@@AB@@@@A@CC@@A@A@CE@@@@@@AAA@@@AADA@@AA@@EC@@CBA@@AA@@@AAAAA@@@CAEA@AB@@A@A@A@@B@B@@@@A@A@@E@@AAA@@AABA@@@A@AC@CA@A@@@@@@CCA@@@AAECCA@@ABA@AB@@A@@@@AA@C@C@@@AACAA@@AAB@DADAB@@AAAAA@@@@@@ABAAC@A@CAA@@@AA@CCAAA@@ACCIG@AA@@@@@A@A@@@@@AA@@CAAAAA@@@A@ABACACG@@DEB@A@IEAAA@@AE@A@GCE@C@C@A@@C@A@@@@E@@@A@A@AB@@@@ABE@@@AB@BA@A@ABA@ABEAA@AACA@AAAACAABA@A@@@@AB@@ACCAB@@@FE@@DE@@AA@@CA@@@A@@@@DBD@@@@@CCAAAAAAMKA@C@@@@B@B@@A@@@A@@@@A@@BA@@@A@@A@A@ABA@@A@@@ABA@@@AA@A@@@@AA@@A@@AB@@@A@BAA@@@@A@@A@AA@@A@@@BCB@BA@@@A@@@@@@AG@@A@AAC@@A@@B@@@D@@A@@@BBA@A@@@@B@BAB@@A@@AA@ACAC@A@@F@HC@C@C@@EE@@B@@@@@@AA@AA@@@@B@DA@AA@@@C@A@G@EAGCA@@@C@@@@BA@@@@C@AAAABA@A@AB@B@@A@@@A@AC@A@@ABABACACA@@@A@A@BA@C@@A@CBA@A@@@@@@@@C@A@@HAD@@AA@@ACA@@@@CBA@@BAA@BC@@@@@A@A@@@@@BAA@@@C@ABA@A@A@C@@@A@A@@@@@A@@@AA@AA@B@DANEEAA@@@AAECEEAAC@AACAEACCE@C@K@C@EAA@CBC@[BKAAAAACC@AAC@AACCACAAAEAC@E@C@AA@CAKACFGNENCJ@TBMKKIGEGGMQAAGCGAG@GFMJILQLQDUDMEQEQGUAW@S@OHEFGDGACAIEEEMOMOCEEGG@O@QD[AGECBCBGTIBSDK@C@A@E@ABKAU@UAC@O@U@AA@@EB@A@@@@@AA@AB@@A@ABA@CAAAC@@ACB@A@@AACIAAF@BA@@@A@@G@AAG@A@E@A@@@A@A@BB@BAB@@ABC@C@@@@B@BA@@@A@@AA@C@A@A@@@@B@BC@@@AACAA@@@@A@C@@C@A@A@AD@@AB@@A@A@@AB@@AA@@@@@AAAEAC@@CBA@A@@CAA@@EB@@EDA@AB@E@ABCAAA@@BA@@@ABABEC@@ABAD@@@@@AAA@@A@@@AB@@@DA@ACA@A@AB@B@@@@@B@@A@A@@@@BB@B@@@@@@B@@@AA@@@@@@@BB@@ABABB@@@BB@@@@AB@@@BA@@BAB@@B@@DCB@@D@@@BBA@@@LB@B@@AFA@@@KA@@@F@B@@@@A@ABCA@@@A@@A@@@@AB@AAAB@@@@@@AB@@A@@DA@@@A@G@BBA@AB@B@BA@@@@B@@A@@@@BA@@@A@@@@BCBABE@OH@@A@@A@A@@@AA@ABE@CAA@@@A@@B@@@@A@CACAAACCACAAAA@AAAA@@@AAACF@@AAA@@@@B@B@@@@@AA@A@AC@@@@BA@@@CCEBAA@@B@@AA@@@G@@@CB@BA@@BA@@@@A@@@CBCA@@@DA@CAAA@@@A@AA@@AABCE@@@@AC@A@@AAAA@@@A@A@AB@@AAA@C@@@@@ABA@A@@@AA@@A@AAA@ACA@A@@@A@@B@@@@AB@BA@@@CAAB@@AAAA@@@AA@A@@@AA@@@A@@@AA@G@A@A@C@@B@@@B@@@@@B@BA@AB@@A@ABC@CBABA@AAA@ED@@CAA@@@@A@@A@A@A@CBABA@@A@@A@A@C@@@A@@@@@AA@@A@@B@@CA@@@@@B@@@@A@@B@@EFGDMDYJKJKFIDG@@@AD@B@@@BB@BB@@BBBB@B@@D@@@DBD@@@@BA@@@AB@@@BAB@@@B@B@@ABAB@@BB@BB@@BAB@@@B@BA@@BB@@D@BA@@@@@BB@BB@@BB@@BB@BD@@DA@@BBB@BB@@@@@B@B@@@@BB@@@BBBABB@@B@@ABAB@B@@BAB@D@B@B@D@@@@@CB@@AB@@BB@BBD@DA@@B@@B@B@@@@B@@BB@@@B@BBFDB@@@B@BBB@@DBB@@@@B@BB@DDHD@DB@BBAB@D@BADABA@@BBH@F@HGFEFC@EBA@l\\b^L^@DJX@BFBR@\\LPHpTLFB@LFTLBDJbVZX^@\\FVHRbNNFPFCRCJIRDHATrNLDDR\\AVITWfAA@@@A@@A@A@A@@B@BADBDABC@@A@CA@@@A@@@A@@@@@A@@@@@AABA@@A@@C@@A@@BABA@@B@@A@@BAB@@AA@@@ABED@@@A@@BABA@@A@AB@@@@A@@@A@@@@A@@@A@A@@BA@A@@@@B@@@B@BB@@B@@@BAB@BA@@BA@@B@@@B@BA@A@@@@@AB@@@@A@@@ABB@@B@@@BAB@@A@@@ABABB@@D@D@@BB@BB@@@B@BB@@BB@@AB@@@@AAA@@@AB@@A@@B@BAB@@A@@@@B@@BDB@BB@BBB@B@@AB@@AB@@@@A@@@A@@@A@A@@@A@A@AB@AA@A@@@CBC@@@CB@@CAA@A@@CCA@@@A@@AB@@@@AAB@AA@@A@@@@D@@@BA@AB@A@@@A@A@A@ABA@A@A@A@@@FAB@A@@A@@EBA@@@AAAC@@@@A@@@@ABA@AA@@B@@AE@@BAB@DDBA@AA@BA@@BA@@@@@@AA@@C@A@A@AA@@@@BADABA@ACAA@@A@CAC@C@@B@B@DCDAB@@ABAB@@A@@@AAA@A@@@AAA@@A@@@A@@AA@AA@AB@B@@@BA@@@@A@A@@AA@@@B@@A@@@@@@@@A@AB@@IFAAEB@@@A@@B@B@@AC@@@@@JABA@@@@@@B@BA@A@@B@BAB@@AB@B@AA@@@@@@DAFA@@AA@@BAB@@ABA@@@@AAKGWQA@@@CA@@@@DAE@@@@AA@@@A@A@@AAA@@A@@@@B@D@@AB@@EB@@@AA@@BA@C@ABA@@@@@@@@A@@@@@A@@A@@@@@@BA@A@ADA@B@@BB@@BA@@@@@A@@@B@A@CAAACD@@A@@@AB@@A@A@AAACBABA@@@@BAA@AAECAB@@CC@@@@A@ADAA@A@@@AA@BEA@@ADC@@@@B@@AHCHEBADCF@B@JEB@B@DADABADABA@@CAFCBABA@@@@B@BADADA@@@@B@@@@@B@@@B@B@@B@A@@@@@A@@BA@@@@@@@B@@B@@@@@FC@@BABCB@@AB@@@B@@@B@@@@AB@@AD@BCB@A@@A@@@AB@@@@AA@AAA@AD@@@AA@@@A@@@A@A@A@A@@@A@@CAA@@@A@@A@@@A@C@BC@AAB@A@@B@@A@@@@AA@A@@@@B@@@@A@AAAAA@@@@DABA@@@@A@CA@@CCB@DA@@@@@@@A@@D@@@@@@B@@@B@@@@BBBA@@@@@@@AB@ACB@@@@@A@BA@@@@DAAA@@@A@AB@@AB@@@AC@@B@@ABB@@B@BBB@@@B@@A@@FAB@BA@@BB@@@@@@B@@B@@A@AB@@@@DA@@DAABB@BAH@@@@@AB@B@B@@@@B@@AB@B@B@B@@B@@@@FB@@BA@@A@@A@@B@BDB@@@B@@@FB@@@@@@A@EBH@BB@@@ABA@AF@AB@B@B@B@@B@@@@B@@BABA@AB@@@B@B@@@@@@@@@@BA@B@B@@@@AB@B@@@@BB@FB@@@B@B@@B@@@@B@@B@@@B@@@B@@@@@ABFB@DABB@D@BA@@F@@BB@@@@@@@@B@@B@BB@@@@@A@@@@B@BBDBB@DADAB@@AB@@@@BB@BDB@DADB@@AB@B@@EBBBB@@@DA@@B@@@@@@BC@@BDA@@@BBBBBBAB@B@@@B@@@@B@@@@@@@BB@@@FCBF@@F@D@B@AAB@@@@@@A@ABA@@@BBB@@@B@BD@@B@@@BBB@@@BBD@BFAB@AB@B@B@BB@B@D@B@@@BB@B@@B@@@@@ABB@@@B@@B@@B@B@@BBBB@BBB@HFDBB@B@B@DADAFA@@@@DBB@D@BBD@@ADAB@DC@@@BB@@@D@@A@@@@@@DA@@@@@@@A@@@@BA@@DC@@B@B@BBF@BBBDB@@@D@B@BBB@DDD@@AB@BAB@B@B@B@@@BA@@BB@@B@B@@B@@BB@@D@@@@BB@@BBBBB@@@BBBBD@@BDBBB@BBB@@@FBB@B@@@@@BB@@B@BB@@BB@@B@BFBBBB@BBD@@@B@B@B@BBBBBBB@@BBB@@@B@BBDB@@BB@B@@DBB@B@B@D@@BB@B@B@B@BAB@@AB@@A@A@@B@BA@@DAD@B@B@BBB@BBDDBBB@@@B@@A@@B@BB@BDDBBBB@BBB@@B@B@DBFB@@B@FCDAD@B@@@D@B@@AB@@CAAAABA@@@@@A@@A@@A@AAA@@@@B@B@D@@@@BDB@@@@F@BADAB@B@D@DAD@@BB@@B@BBBB@@BF@D@@@B@DB@@BB@@@BAD@B@@@BB@AB@@@BB@AB@@@BB@@B@B@@B@BB@@@@@@@@B@@@@@AB@@B@@BBAB@@@@B@@A@@@A@BBAB@@@BBB@B@@@B@BA@ADAB@@@B@@EAA@@BABAB@B@B@@@B@B@@@@AB@@A@@@@B@B@B@@@BCB@BA@@B@@DD@@BB@@@@CB@B@@@B@@BB@@AB@@"],["@@OLOLKPGb@@KFQAENYBGDSFGJCJMlJTHLF^SNIDkLi[_KYIUDa@sPWDaKSSKcQSACMOECIMKIHI\\ePSOGoSS]IAD@@@@BF@DBB@D@D@D@@BB@F@F@B@D@B@B@@@BB@ABBB@@@@@@@B@@@@B@@B@@@@AB@@@@@@@@@@BBA@@@@@@@B@@B@BA@A@@@@@BBBBBBA@@B@@@B@@BAB@AD@@B@BBA@@@@@@B@@@@@FA@@BB@@@BB@B@@BBB@BB@@@@@B@@G@A@A@@@A@A@EB@@@B@@@@@@CB@BA@CAA@@@B@@@ABA@AAA@@@@B@@A@@@@BB@@BB@@@B@B@@@A@C@A@AAA@@@@@AB@@BB@@@@A@@@A@A@@@BB@@A@A@A@C@@B@@@@B@B@@@@@@@AB@@DB@BC@A@A@A@A@@@AB@BA@ABA@@BB@@D@@@@A@@@@@@BBB@@A@@@A@A@@AA@@@CD@B@@B@@@BB@B@BAB@@@@EBEBA@C@C@EAA@AAA@A@EAA@CA@@ABMA@B@B@@@BB@@@@B@@@@AF@@BB@@@@AF@F@@@B@@@B@@CBC@@@AA@@@AABA@AB@BA@@@@BA@@@EAA@@AA@@@A@B@@BA@EDABA@@@AAA@@BA@@@@B@@AA@@@@CC@@AB@@@BA@AAA@@A@@@A@@BAA@B@@@EC@@@@C@ABA@A@@@A@@@@@AA@A@@A@ACB@A@@@A@A@@@@AB@@A@@A@@@BA@@A@@@CC@@@@@C@A@@A@AAC@AAA@ABCA@@A@@@AA@A@@@@CB@@@@@C@A@@@A@@A@ABA@C@@@BC@@@A@@BABAB@BA@@@A@@@@AAA@@@@@@@BA@@@@B@B@@@@A@@BA@@BA@@DA@AB@BA@@BACADCBABC@@@@@@E@AAC@E@EAG@@A@A@@@@@E@@@CB@B@@A@@@@D@BABAB@AAAC@@ACB@BD@AFCBAB@EEA@HC@@BAB@B@B@BBB@B@@AA@@@@A@@@AB@BCBABA@@A@AACAA@CACA@@AAAAA@E@AA@@@A@@EAB@@@B@B@@AD@@ABA@@QEGCC@EA@@ADA@@@A@ADAB@@ABA@A@A@C@A@A@A@EC@@CEC@GAAAA@@A@A@CCAC@KCADAB@D@BBF@B@B@@@B@BAD@B@BAB@B@B@B@@AB@B@BALFPKJ@B@@A@CFCAA@A@CDA@E@ABAAGAA@C@A@@@AAA@AAA@CCGCA@CA@@A@@@AB@@CAC@AAA@@@A@ABA@@@@B@@AB@@@@AB@@BDA@A@@@@@@B@@AAA@@BA@@A@@CC@@A@A@AAA@G@A@A@A@@@C@CB@@A@@@AAAAAA@@A@A@@@A@A@@@@@A@@@A@@BCBEB@@BBB@@B@@@B@ACA@AA@@@@@@BBB@@AB@@BBBDBB@@BB@@A@A@A@A@A@@AI@@@@@AB@@@BA@@BA@A@@BAB@@@B@@AB@@@A@BA@AACCA@A@@@BBBB@@@BA@@@BBBB@B@B@@BD@@ABBB@@@BA@@BEBCB@@BD@@@B@@@B@@A@A@AB@@@BA@@DABDBBB@@A@AB@B@@@B@@DD@@@@AB@@@@@BAB@@@@A@A@EBGBA@@@AAGEAAA@@AAAAAA@@@CBABACCA@@A@A@@B@@CACACAA@AAeBSXUJ[BCQKCqMBSCGJQDIDQOEMEaMGQEU@[W]UYIaACSKKEA@KEoSOG[KQ@EA@AIW@CK]a]k[YFUH[DUHWLEBKDIDGJAH@PEJCHAJCJALILADCFGDKDKFYH[@MESCUEMGK@I@MD@@@@A@AB@@@@A@A@A@@@CBCBA@A@@BA@A@AB@@@@@@@B@@A@@@@@@@@@A@@@@@@@C@@@A@@BA@@@@BA@@@@BAB@@@BA@@@@A@@AB@B@@AA@BA@@@A@@A@@@@@@@BA@@@AA@@A@@@@@A@@@A@@@@@@@AA@@@@@B@@A@A@A@@@A@@@A@C@C@@@A@A@A@A@@AA@A@@@CA@@@@ABA@@BGBCBCPJL@JD@HNFLDNCPALIREVDPVPTJFTDHBLBJBLHJLHCTADAJADAB@DAH@DCBCDADAD@F@B@D@@@B@DCDAB@BA@@@AB@B@@@BCDABEH@@@B@@BB@@ML@ZGJBJBFHN@NAH@BGHINOHAB@@CBC@ABABA@ADABABAB@@@BBA@@@@@@BB@BBB@B@@BAB@DA@@DBBBBD@@@@ABA@CDCB@@ABDBB@BBFBHB@@HDDB@@@@BAB@BAB@B@@AB@D@@@B@@AB@@@BA@@FABAB@BAD@B@B@@@BBBBBDBDBD@@DH@BDDDD@BBBBBBDB@DD@BBB@@BBB@BBD@DBB@@@HBDBB@@@DBBA@@B@@A@@BA@@@A@@@@BA@BD@@@JBB@B@F@B@B@@@B@@@@B@B@B@B@BBB@@@BB@BBBB@@@@DBDDDB@BB@@B@BBB@B@@@D@@B@BBB@B@BB@@B@@BB@@A@@@A@@@@@A@@AA@@@@B@@@@@B@B@@@BBB@@@B@@A@@@@B@@@@@@@BBB@@BB@@@@BA@@B@@BB@D@BB@@@@@B@B@@B@@@@@B@@@@B@@@BB@@AB@@A@@BA@@@@B@@@B@@A@BB@@@@@@BA@BB@BBB@BBB@@@B@B@BB@@B@BBB@B@@BB@@@@B@B@@B@@@DBB@@@B@@@B@BBDBBBDDDB@BB@B@B@@@BBBB@@BB@B@B@B@@@@@BB@BBBD@@BB@@@B@B@@@BABABAB@@@B@@BB@B@@@B@@@@DB@@BBB@BBB@BBBAB@B@B@@BB@@@@@@@B@@@B@BBB@BB@@@@BD@@B@@@B@@@BBB@@@B@BBBBD@BB@@B@@@@@@@@B@B@@B@BB@@@BAB@@@D@@BB@@BB@BD@@BD@D@BB@AB@@AB@@@D@D@@@B@B@B@@@B@BBB@B@B@B@B@B@BBB@@@@@B@B@B@B@@@DB@@@@B@DBDBB@B@BB@@@@@@ABA@C@AB@@AB@@@B@BB@@@BB@@B@BAB@BA@@@@@@B@@@DA@AD@B@B@B@@@BBD@BBB@BBB@BBBBB@@BB@D@@@B@BB@@D@DBB@BBB@@B@@BB@@@BB@@BB@@BB@@BB@BBB@BB@@B@BBBBB@B@BBBBBDDFBBDJ@DBB@B@B@B@B@B@B@B@BAB@@@BB@B@BBJFB@@B@@A@@BA@AB@@C@A@A@ABA@@B@@AB@DA@@B@@D@B@BB@@BBBBBB@@BBD@B@JBJBB@@@@@@@@@@@@@B@BBD@BBB@FDB@BBBBB@DBB@B@@BB@@@BB@BDBB@@B@@B@BB@B@@@B@BBD@@D@@@@@@@B@@@@ABAAABA@@@A@@B@@@B@B@BB@@@@@B@B@BBB@@B@B@BBBAB@B@BA@@DCB@BA@@BABAB@@@B@BAB@@@B@@@BAB@B@B@B@@@@@B@DAB@BB@@@B@D@@@DAB@B@BBD@B@DAD@D@D@B@BBBBBB@F@DBB@D@D@B@@@D@J@H@D@B@D@BBB@@@BBAB@B@@@B@@@BA@@@@B@@@B@BA@@@BB@@@@@@B@B@J@B@@AB@@@DABA@A@ABEB@@@B@BA@@B@BABA@@B@@BB@B@@@B@DA@@B@@@BBBBB@BB@@B@DAB@B@B@BABB@@B@@BB@B@B@@@BBB@FBB@DBB@BB@@@@BBDBBB@@@@A@AB@@ABB@@@@BD@BB@BB@BBBB@@DBBDBB@@BB@@B@BADAB@B@@@B@B@@AB@@@B@@BB@@AB@DAB@@@B@B@B@@@DEBABAB@@A@C@ABAB@@@B@B@@@B@B@B@BDBBBBBB@B@D@BB@@@B@B@BAB@DE@@B@@@DBB@@@BAB@@ABABC@@BA@@B@@@DD@@@@B@D@BBD@DBF@DBDBB@B@B@DBB@BBB@B@BAB@D@B@BAB@B@B@B@@@@AB@@@@A@@B@B@B@@@B@@@B@B@BB@@@@BB@@@@BB@@B@B@B@@BB@@BB@@B@@@B@B@@A@@BA@@B@@@@@BB@B@D@B@B@@BB@DBDBBBD@@B@@B@@@@BAD@B@@@D@@@B@@B@B@B@@@B@B@B@B@@@@@@BB@@@@B@@@B@B@@@@D@D@B@B@@B@@@@@BB@@@@BB@@@B@@@B@@@BA@CB@@A@@BA@A@A@@AA@AA@@AA@AAA@AACC@@A@@@@@@A@A@A@@@AB@@A@@@AB@@A@@BA@AB@B@@AB@@AD@@AB@@@B@@AB@@@B@@A@@BC@@@A@@BA@@@A@@B@@AB@@@D@BAB@BAB@B@@A@@BA@@@@@AB@BA@@@@BABBB@B@@BDBBBB@D@@@B@@@BA@@BCBC@@@AB@@A@A@@AA@A@@@AB@@A@A@AB@@A@A@@BA@@@A@@@A@@BA@@@@B@@A@@B@@A@AAA@A@A@@@@B@B@B@@@@@@A@A@@@A@@B@@@@AB@@@B@@@@@BA@A@ABABABABA@A@@B@@AB@DABABABAB@B@@AB@@@B@B@BB@@B@@@BB@@@@BBB@@@B@@@B@B@B@@@B@B@B@@@B@B@@@B@BA@@B@BAB@B@B@BAB@@@BBD@B@@@B@B@@@B@BBB@B@BBD@@@DBBBB@@BB@BB@@B@B@@@@@B@@B@@BBBBB@@BB@@@BB@@BB@@B@BB@BBBBBBB@B@@B@@B@@B@@BB@@B@@@B@@@@@B@@@B@@@@B@BDBBB@@BDDBBB@BDFD@@@@BB@BBBB@@@@D@BB@BB@@@@BB@@BB@B@@@BB@BB@@BB@@@@@B@@@B@D@ABAB@@AB@@@B@@@B@@@BBB@@BB@@@B@@@@@B@@@DA@@B@@@B@@@@@@@B@@B@@@BB@@@B@@B@@@@@@@@@@@@@@@B@@B@DBB@@@@BB@B@B@@BB@@@B@@@@@B@BB@@B@B@@@D@B@@@@@@@@@B@@@@@B@@@B@@@@@@@BB@@BBB@@@@B@@B@@@@@@BB@@@@B@@B@@@@B@@@@@B@@BB@BBBBDBBBBD@BB@@BBB@BBBBBDB@BB@@BAB@@AB@@ABA@A@A@@@AAAAA@@AA@AAA@A@A@A@@@@@AB@B@@@B@B@B@@@@@@@B@@@@BB@@@B@B@@@@BB@@@BB@@@@BBB@@@B@BB@@@BBB@B@B@BBBBB@B@@@B@D@BFJ@B@@@BA@@@@BBB@BB@BBBBB@@@@BBB@B@B@@@@B@@BB@@@BB@@B@@@B@B@@@B@B@B@B@@AB@BABA@@@CBC@CBCB@@AB@BB@@B@B@BBBBDBBBBB@B@@@B@B@BAD@B@B@BBBBBBB@@B@B@BABAD@BABA@C@AAA@A@ADAF@DAB@@B@B@@@BABA@@BAB@@BB@B@@@BAB@@BB@@@B@@@B@@@B@@@@BBBB@B@B@@AB@@@@@B@@@BB@@BB@@@BBB@@@BBB@@@B@@@@@BBB@DBD@@BD@@@B@BBB@@@@@BB@@B@BB@@@@BBBBDB@@B@@B@@@@@B@@DB@@B@@@@@@@B@@BB@@@@@@@BB@B@@@BB@@@@B@@BBB@@@@@@@B@@B@@@@@BB@@@@@@B@@@BHA@@D@@@B@@@@@@@@@AA@@@@BAFAFCDADADAJCFADADAB@BABADABA@@@@@A@@@A@@B@@@@@@A@@@@DENMNKDBDAD@BADBD@BADBDCDEF@DAD@HA@AFCF@B@DEHCDABCCADAF@D@B@BB@A@@@ALCDA@CDABEF@FCXKVIRYLUJYBCHWLM\\LBQTAHGPERDFR\\BNALKJSJKNALAHCAKBCGEDA@KBADAH@PBNBHAFMCABGCCEAAEDADAFFLDDEBIJ@N@HA@CAAGA@@DIAAC@G@EFAAACBAHA@CCA@AAABAH@PDB@B@FIACBA@ABCAABCDCBADCFAFFDBCF@BHADAHAB@AAOI@ABAVBBAFGHBBAFIFBDHDBLCLAJBLCH@BA@ABAJDFABAAAKCCEBEDAD@LBB@DEAOTCBGAEKCE@AABAACFCJ@ZJDAAGKI@CLCF@HDDFHBN@DADGRQPAVBPSCOACJYCKKGCA@@@AAAGK@@AA@A@@@AB@D@@@@CB@@@FAB@@@@B@@@@@@BA@@AA@@BA@@BA@@@@@@@AB@@C@@E@@@@CABA@@A@@DAB@@@DCB@B@@A@@A@@A@@@@@AB@BC@@B@B@@@@BADHD@@B@@AB@BBB@A@@BA@BB@@B@BAB@@@@AACBAAAA@@ABABADAD@DAB@@@GAA@BC@@@@C@E@@@FA@A@@A@CBCCD@BADA@@KAAAA@@AB@@ABABBH@BA@C@@@C@@C@A@@@@ABAB@@@BABABA@@AA@@ABA@@@@@@@@ABA@@CA@@@@@@@CBA@@BA@C@A@AA@@@@EB@DAHABADA@@@ABA@ABCB@HCJKE@@@@A@AA@A@GBAA@@@A@EBC@ABA@CDAGC@@A@GEOEWGOESI]KUCQCSKYKUIGAOEO@UKUKC@[KAASGAACAAA@BA@EA@@@BA@A@@@@C@AB@KGEAA@@B@BE@@@@AC@@@@AA@C@BDG@CBC@"]],encodeOffsets:[[[124804,43046]],[[125146,43060]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
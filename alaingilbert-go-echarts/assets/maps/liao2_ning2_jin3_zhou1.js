@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("锦州",{type:"FeatureCollection",features:[{type:"Feature",id:"210702",properties:{name:"古塔区",cp:[121.128279,41.117245],childNum:1},geometry:{type:"Polygon",coordinates:["@@GBEBC@EB@@C@@BA@A@ABA@@@CDA@AD@@AB@F@B@@@BCFCDA@C@EAE@C@GDABC@EAA@ABA@@@@@CBABADCBE@@BA@OFEBEBEBABIBCAECA@AACAGAEAIACCEGAAA@CAAAAAAAA@C@A@ABCBA@@DAD@BABADABCBCBABAB@BAB@@B@DD@@B@BB@@BHBB@@BF@B@B@BDBDBBBDDDD@@B@@@BA@@@@BAB@@@LLLP@BNVDFHLHDVHD@H@LBRBR@NCD@BAH@FAJCFCDCDAB@BABCDABEBIEABAHGBADA@CAG@@@@@@@EB@@@@AB@@@A@@A@@D@@@@@@BB@BB@BB@@@@@DBBA@CA@@A@@B@@A@@BB@@BABAB@ACC@@CC@@AE@@@C@@E@A@@@C@A@@@@@@@A@@@@B@@A@@@E@@BA@@@@AA@@@@@C@@@@@E@@@@AG@@@@@A@@@@@A@@@@@@B@D@B@@@D@B@B@BAD@AA@@@A@@@@@@@C@@@@@A@A@@@AAA@@@@@@@@@C@@@@@A@@@A@@@C@@@@@A@A@A@@@A@@@@@@@GMB"],encodeOffsets:[[124040,42090]]}},{type:"Feature",id:"210703",properties:{name:"凌河区",cp:[121.150877,41.114989],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@EBG@@@A@KBA@@H@@@@@@@B@@@B@B@B@@@@@D@@@B@@@B@@@@@D@@@@@@@@BB@B@@@B@B@@@@@D@@@@@@@B@@BBC@ABA@A@C@@@A@C@A@@@@@@@@B@@@@@B@@@@BH@@@@@F@@@@@D@@@@BB@@@@AB@@@F@@@BA@@@@@@B@@@@@@@B@D@@@B@FD@@@F@@BD@@DD@BD@AB@@@BAB@FAD@BAH@@@@CDA@AB@@@BABABB@@BBABB@B@@@DABBBDDDLCCCEBEB@AA@@ACAHCJEPR@@A@SBIB@B@DA@@@CBDDBAHDDBFCBDDAA@@AB@@@BBHC@@HE@@BABBB@DB@@FBHDDB@A@AB@@@@I@AB@B@DBB@@@@A@@@@@C@@@@F@D@@@B@@A@@@A@@B@@A@A@@AA@@@A@@A@AA@@B@@A@@B@B@@@B@@A@@@A@@@AF@D@BDF@@EH@DAFAFAB@B@B@BB@@B@@@FA@@BB@@DABB@AB@@@D@@@B@@@FCD@BAD@BC@@@@AAAA@@G@C@A@A@AAACA@@A@@AAAAA@AA@@A@AACA@@@@@AA@E@A@@@@@AA@@AAAACB@BAAAAJC@A@@C@C@C@I@C@C@@@@@ABA@@@CBABA@CBA@C@A@EAA@@@EAICGACCCC@EACAAACMEC@CAC@A@@@A@CBABCBKD"],encodeOffsets:[[124062,42087]]}},{type:"Feature",id:"210711",properties:{name:"太和区",cp:[121.103892,41.109147],childNum:7},geometry:{type:"MultiPolygon",coordinates:[["@@C@ABB@BBDAB@@A@@C@"],["@@CDAHADAD@BB@@BFABC@KBC@A@@A@"],["@@BABB@E@@B@D@B@B@BAB@BCBA@ABC@CA@E@A@A@@B@@AB@@@@EB@@CBE@@BA@@D@BAB@@@B@@@B@BB@@@@@H@@@"],["@@HAHA@AF@@AB@B@EI@@@@HAH@B@@AACB@@A@@AC@@@ACE@A@@A@@BA@AAC@A@C@@@A@ACAAAAAC@@@AC@@AA@@@AAA@C@@BCB@@@BA@AB@@C@@@A@@@A@A@A@@@@@A@A@@BC@C@@BA@@B@@A@@B@@AB@B@@@B@@A@AD@@@BABA@@@@B@@@BA@@B@@@@@BBBHB@@D@@@B@B@B@D@@@@BBBB@@@@BB@BBB@@B@@@BDBBB@@B@B@@@@@B@B@@D@B@@BAB@@@B@@@BA@@"],["@@FCACABCB@@AAAE@@@@@@@@ABA@@@CD@@@ACBA@A@ABA@C@EB@AAAA@@BABA@@B@HBF@@A@G@A@@DA@@@@B@B@@@@@BB@@@@BBB@@B@@B@@@@D@FA@@@B@@DDEBA@@@@@DBBB@@@@GBAEA@@@A@@B@@AB@@@@@AAA@ACA@@@@A@ADAB@@A@AB@@@@@@BB@B@@B@BD@@@BB@B@B@@BBBDB@@ABA@@@@@ABBB@@@@@BB@@@@@ADAD@@FA@@@A@@B@BBB@B@HA@@@@NA@@BB@@B@B@@@@@@C@CB@DAH@D@@@@C@C@A@A@ABCAAA@@@D@@@BA@CBAB@DEA@@ABA@@ACBA@AACEBCGAA"],["@@@@@A@AB@BABABA@@@AA@@@A@@AAA@AAA@@@A@C@@@@A@C@ABC@ED@@A@@@C@@@A@@BAACB@@AA@@EB@@A@@@AAA@A@A@EBEBCBG@@FE@ACC@E@@B@@@B@@@BA@@@A@A@@@@BA@@@BBB@@@@B@@BB@@@B@BA@@@@B@@@BA@@@C@E@@@@@@D@@@@@B@@A@CAA@A@@B@J@@A@@B@BCAGCEA@@CAA@AAAB@@GF@@GDAA@@A@@BB@CBACEDCAGCABCCDA@@B@@C@AJATAB@@@OQIFGDDB@BB@@BFAFADDKDCCACAACB@@A@A@BAAA@@AAABAB@@A@@BCB@D@@G@ABC@EBA@AB@@A@@BA@ABAB@@AA@@@BA@@@@BB@@DABCA@@@@A@@AAAA@@A@@@@C@@@@BB@@@A@@B@@A@@F@@@@@@BH@DCBABGHABFBAJAFJAJ@HCB@BDLHFJBFB@DJ@@@@BDLJFB@@@@FDDDHDNHDBLDF@D@B@JAL@F@JAD@HBPIHCBI@E@A@A@A@I@@FI@@@@DCBAJKAACA@C@@B@B@BAJ@DC@@B@@AB@@@B@@AB@@AB@B@BABBBAB@@@B@BBB@B@@@B@B@B@FA@@BBB@B@BBB@@@@@@A@C@A@@A@@@@@E@A@@@AA@@@A@@A@@AA@ED@@A@@C@C@@@C@A@@D@B@@@@A@A@AAA@@BCB@@A@@@@AA@@@@@A@@@AA@AAA@@A@@@C@CA@@A@@"],["@@K@KEUC[GT@DAHEVAD@DBJFLLF@@FDDD@BFJBBDHFFBDBH@FAF@B@DB@BP@BEFCF@@BB@@H@B@@B@B@D@B@@BBB@@@@B@B@@A@EB@@AB@@BD@@A@GCABAACH@@DB@@B@BBDBB@BA@A@@BF@@BBB@DB@@@B@B@B@BCB@BH@@@G@@@H@@@G@@B@@@@@@@BB@@@@@AB@B@@@@A@CA@@@AA@A@ABA@CA@@A@@@BJA@@@@D@B@@@BAA@@AE@@A@@@@F@@AMA@AAA@A@@B@BAB@BA@@BA@CB@@@BBH@@A@A@A@A@BH@@B@FBB@B@@BBB@B@B@B@B@@@B@@@@A@A@BJ@@@HC@C@A@@@BF@BB@@@BJ@@@H@@DJBPDLDJ@DFHDL@BDF@DAD@BDBFHB@@JBBAL@@DHG@AACEA@C@CAAAAAC@AAC@CBAACAAAACACCAC@CAA@A@C@A@EAA@E@A@@B@BGBEBCDABBBB@BD@D@BA@A@@BA@CACAC@GDCBCBCBC@A@AACCCAC@AAABA@ADADA@ABCBA@@FAD@@AD@DBH@DFLAFAFL@AHAF@JCH@@EJ@BAJ@BABAJ@DBD@BD@@AD@@@B@@A@@BBBD@@D@DB@BB@@A@@@@@AB@B@BAB@@@DBB@@B@BB@@B@@ABC@A@A@ABA@A@@B@@AB@@@@D@B@@@@BB@B@@@B@@@BAB@@@D@@@BABB@@B@@@@B@D@@BB@B@@@B@D@@@B@@BB@B@BDB@@B@@@D@B@@@B@B@@@@B@@B@B@@@@@B@B@B@B@@@@A@@B@BBB@@@BA@@B@BBB@B@B@@A@@B@DAB@J@B@@@@@BA@ABABADADABABCBA@ABC@CB@DABAB@D@B@BBBBBBDBB@BBFHDDJBFBHBDBBBB@FDDBJABAFAFAFAPEB@@AF@DABCBADA@@@@B@BAB@FBD@BAHCD@F@FBD@B@DCDE@A@@@A@EBA@@BCB@DC@@B@BAB@B@@AD@@@FAD@FAHANAB@LAB@@@H@FA@@LCDABADAB@@@B@D@DBD@NFBDBBBD@FDDDDHBJDFB@@B@FBB@D@B@DAB@BADA@@B@BA@@@@D@D@J@D@D@D@@@@BJGDABEBE@C@EACAC@C@ADAHGDABADC@A@CAACEECAA@CCCK@I@E@CA@EACDEAEFGJABAFBBDFBHDNDJ@DADAAG@CHGFCDCBABBBAAAACIEIASBA@EBGAABCCCGAE@EFEH@FDHBFBH@JEDG@GBCFEFEDAHGBAFAFABC@AAGCCAAAAKCGGMCAGBAPCHGFECCMGWQDYIIUg|[AcGCWCEGGaOQi@_Po^SFUAW@QCSGIOGKIMOKOECEAADG@AACCEEEEEGAIAAAACTcAEG@EDIB]IMD"]],encodeOffsets:[[[124007,41816]],[[123992,41782]],[[124176,42255]],[[124125,42273]],[[124175,42262]],[[124166,42132]],[[123958,41775]]]}},{type:"Feature",id:"210726",properties:{name:"黑山县",cp:[122.126292,41.653593],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BBFKDIDGBOJKHGD@@@@ABGBIJGDYHSLSFMH@@@@GD@@@@@@IH@@A@ABEB@@@@MFCB@@@@OLSLSF@AABEEQK@@A@A@@B@@@@@B@@E@@@@A@A@@AE@A@@MD@@@@@@@DBB@@@BCBA@@B@B@@@BA@@@@@CB@@@@BB@@AD@BBB@@@@@B@B@@A@AA@@@@A@@A@@CI@@@@@@AB@@@AA@@@C@@@@@@BBB@@@BC@@FEDA@CBA@AB@@@@@BCB@@ABB@BJB@@BB@@B@@BB@@DABDB@@@B@@@@B@DBDBHPJHH@BBH@BBFGN@@@@@FCRAF@@@@@FAB@@@@@@MLBJDHINFBDB@JO@IDMJKDMCK@SF@@OFBDADEBBJB@D@BBADLDDBHHAFUAAD@B@B@BIFBFADEFALCFAHCB@LAB@@C@E@C@A@@BA@ABAB@B@D@BA@@BA@A@G@@@C@@BA@@BAB@B@B@B@B@@ABCBABABCBABA@K@CBABC@CBABCFCB@@@@@@CDAD@@EHEHEFAB@@@@CDCDADCFCFCB@A@@AC@@C@CAABA@A@ED@@A@A@A@@@@C@@A@CAA@A@A@A@@@A@A@@@A@A@@@AA@ACB@AA@C@ABA@AAA@AB@@A@CBAAA@A@GAQAKBNRHHHFLJNLSAI@MDMFEHBDBL@DBBD@F@D@FBBBDBDBBD@BBD@BDDBBBBLB\\AD@DAB@FBD@L@D@F@DDFBDBBBD@BBFFFDBB@@B@FBMFCBA@B@@BBB@@B@@@@@B@B@@@D@B@B@B@BAD@@@B@AB@@@@B@B@@@@@D@@ABB@AB@DA@@@@DB@BB@@BC@GB@@@B@D@@@@@@B@B@DAB@@@@DABB@B@@@B@BDBDBABA@@@BBDB@@@B@@@@ABAB@B@BABB@B@D@@B@@A@@D@@@B@HDFBH@B@D@@@B@@BCBA@@@@@BBB@@B@@@@A@@@FF@@@D@DGDE@@@@BBDBDB@@BB@@@BA@A@A@@B@B@AA@@B@@@@C@@@AB@@@BD@B@BH@@B@@@@B@@@B@@ADA@A@@@BB@@B@BB@@@@@BB@A@B@@BA@@@BB@@B@@B@B@@B@@AB@B@@@BB@BAB@B@@@@B@@AB@@@B@@B@@@B@@@@A@A@@D@B@NLBBBBBBDD@@@@C@CA@@@@@B@@DB@@BB@@CF@@EF@@A@DBBD@@BA@@@@@BABBBBDBB@BDBBBB@FBBAB@BAB@B@@ABA@@F@BA@@@@BAB@B@@@F@@@@@@B@DB@D@D@F@HDB@F@@BB@BBJFB@A@CF@@DHDBAB@B@B@@BBBBDB@@BB@@@@B@B@@@@@B@@BJHDD@BB@BBDDB@@B@@BB@D@BBDAB@B@@@@B@BBBB@@BABC@CBA@BB@DBBB@@D@D@B@@B@@B@@@BAB@BA@@DBFDBB@@B@DD@@@@@@@BDBD@@B@B@@ABBB@@BB@BF@@@@B@B@@A@A@@@@B@B@BA@@BFBDB@@B@BBBB@@B@@BB@DA@@FD@@BB@@CBBB@@B@BB@@@@@@DFB@B@@@DDB@@@@@BA@@@@B@DA@B@@B@@@@@@ABC@ABB@@B@AB@B@@BA@@B@B@FB@D@BB@DFDB@@DD@D@@ABAD@B@DBBBBBBD@BBDAD@HC@BB@F@B@@B@@GB@B@@@@@@ABCBCDBD@@B@@BDB@DBBB@@BB@HA@@@AB@DAB@@@F@@@@@FADAB@@BB@@@B@@@@@FA@@@@BA@B@@@@A@BB@@B@BA@@B@@@FBB@@A@@B@FBABADH@DBD@@B@@F@@@BBB@DA@@DA@@@B@@B@@BB@@B@BD@DB@@B@@@AB@@@@@BBD@B@DB@B@ACB@B@@@@@HABB@@A@AB@@@@@@@BCBA@BDBB@DD@DAH@ACD@B@@B@@D@@B@@F@@A@AB@FBLHA@@B@D@@B@B@@A@@FBB@@ABBDBBBTHBB\\LD@VLVLP@PFHBVJZLTLRDVD^LTJPFXHPFHFB@@@HDBCDC@@HAF@B@B@DBB@BAB@F@B@BA@@BABA@@AA@@A@A@C@@BAAA@@AA@@B@@@B@@A@@@CAAA@@BA@@AAB@@ABABAD@B@F@@@B@@A@A@A@A@AA@A@@@A@AAA@ACA@AAC@A@AA@@AA@AAC@ACACCCG@@@A@@D@B@D@BBD@DBB@B@BBB@B@B@BAB@BABABA@@BAB@@@BAAAAAA@CAE@GAC@AAA@@C@E@EBABADAF@PAD@B@@AAAACCCCCAC@ABAHADA@A@A@@@AB@D@D@@@BAAC@ABA@@AG@CBC@@DABAJ@D@B@B@B@@@BB@BB@D@B@@@@A@A@ABA@@@AMICE@A@@@AB@B@FCBAD@B@DADADEBCBABADEDEFIBCFI@AAE@EAEAA@ABGBADE@A@@AA@AB@BCBCB@@A@AAE@C@A@ADGAAACBA@CBEBABA@A@A@@BCBC@C@AB@BC@@AA@AA@@A@@D@F@B@DBB@FAB@BA@@@AECAA@A@C@A@ABCBG@ABADA@A@@@ACAAC@@@A@A@@BABADCBABA@@AA@A@A@CC@C@A@KF@@A@ABAB@AC@G@S@gDQAQ@C@UGCIDIFG@ABADAD@DA@ABDJC@A@AAACA@@@@@ABCDIAIAI@GBKBA@CDACGZGACD@@A\\G@AJCDAB@@@@A@@@@@AB@BA@@@@@@@AB@B@@@DBBBDBB@@@BA@@AEBA@@B@F@DACCAA@AFDDBAEA@@A@@B@B@@@F@D@@@@CAC@@BAD@FADAB@BAFA@@BA@AAA@@HCDAJCHO@@@@CEVE@DBD@D@@B@HCBAB@@E@EB@@E@@C@@EAAAC@@BABADCD@B@DBD@@BFAB@D@D@B@B@DAHBL@DBD@B@BADEBABA@@GEAAMGMEEEGECICI@@@A@A@@B@@@@@@@@CAA@@JGGGFQ@@@@@EKGCAUEIMCEAUDQQKGCA@CAC@@@EFEFSB@I@@@E@AAM@GHAF@HC@@AA@@@@@@@A@@@@@A@@@@@@@@AA@@@@A@@@@@AA@@@@@@AA@@@@@@A@@@@@@@A@@@@@@@@@@@A@@A@@@@A@@@@@@@B@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@B@@@@A@@@@A@@@@@@AA@@@@@@A@@@@@A@@@@A@@A@@@@@@@@@AA@@@@@@A@@@AA@@@@@@A@@@A@@@@@@@@@@B@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@AA@@@@@@A@@@@@@@@AA@@@@AA@@@A@A@CB@@A@CD@@A@@@A@@A@A@@BA@@@@@A@@AACE@A@A@@B@B@@@@@@A@@AAA@@@A@@@@@@AB@@@AA@@AAC@@@A@A@@@@@@AA@AA@@BA@@AA@@AC@ACC@@AC@@@AB@BABA@@AC@@@A@@A@@@@@@AA@@@A@A@@@@A@@BC@@BABA@A@@B@BABBBA@@B@@@@AA@A@AA@@@AB@@@B@@A@@@@@@AAA@A@AAAA@@AA@A@A@@A@A@C@@@A@@@@BAB@@@@AA@@AAA@@@@A@@DAB@BAB@@AAA@@A@@ABA@@@A@@B@B@@@@A@@BA@@@@B@BAB@BA@@@@BA@AA@@AA@@@BAGCGCSIcO]@yAgAQAMASEOAMGUKSKCA@@@@WGMCUIQ@KD@@iQAA@@@@_M[MCA_MCCEECCEGCCAAAA@@EIEE@@CCA@A@ED@@PTEBFH@BDFHJFHBH@B"],encodeOffsets:[[125140,42491]]}},{type:"Feature",id:"210782",properties:{name:"北镇市",cp:[121.777395,41.58844],childNum:1},geometry:{type:"Polygon",coordinates:["@@IK_@BIACIAC@C@M@@FGBKBEBKCKE@DCD@@@@ABIHIFKFIDIBA@MBAAA@@@@@@@AA@A@@GDC@@BAA@@@AA@@BAB@@@BA@@@@C@@@@@AC@@@@@@@@@@A@A@@@@ABAB@@@@@@AA@@@AA@@@@A@@IBC@EB@@AA@@AB@@@A@@@@A@IBEB@@@@ACA@@@@@CAA@C@AB@@A@@@@BBB@@@@AB@B@BBDBBA@@@ABA@@@A@@BA@A@@B@@@B@B@B@B@@@BC@CA@@@B@BAD@D@@A@@@@D@@@BB@@@A@@D@B@@@@BB@@@@@@FBABADBBBFBDADAB@@ABABGDEBGDA@A@CD@DBB@BDD@B@B@@AF@DBB@BADABA@@@A@A@ABA@CBABA@C@C@@B@@@D@@A@@B@B@@AB@@GDSFCBOFOHHDFJFNJNRJJRDH@J@P@NCR@BCDIFKBKFGLCFCHCDGJAHBL@@@@BDBLHRBJADALCPCL@HJBP@NAFDNJJJJDVFXFZJZDPFLJRNFLJNBH@DBLAPA`BTDHHHBNAP@F@FFJDFNNPPLH@FBBB@BB@@@@F@B@@@B@BBBBBBB@B@B@@BB@B@@@@DBBA@@@@@BB@B@@@B@DA@ABA@A@C@@@A@A@@@A@@BAD@B@@@@@D@B@@B@@@@@@BA@A@A@AB@@AB@@@@CB@@@@@@CBAB@BA@@@@B@@@BA@@@A@@@A@AB@@@H@JBHAPT@X@VBRHRFNFVCRCRKJKNIHEH@HBHDBBLARBHBB@B@BBDAB@@@BAB@BBB@BAD@B@@BDA@BBB@@B@B@@@B@B@@@B@B@B@B@DBB@@@@D@@B@B@B@@@FCB@B@BADBD@@@BD@@@BDADEDEBCDCDC@@@@BAFEFGFG@@BCDC@@@@@@DADEBADAD@BADAL@B@BADABABADABA@@@A@A@A@ABA@AB@@AD@@@H@B@B@@AB@@A@C@ABABAB@@AB@D@F@D@@@BA@KDABGDEBKFEBCAEJE@A@A@ABCVBBEGGCAKCBCAAC@A@AIFABCACPE@@TEL@NDLCNIJCP@@ICAEAJMCGAINK@@@@@@BA@E@@@@BEDQ@E@@@@HMAE@AAG@AGGOIAGAC@C@A@@A@@@A@ACCB@@AA@@@AA@@AA@AIA@BA@@DA@A@@@@BAB@DAB@FC@ED@@A@@AA@A@@@@D@@@B@@B@@BA@@@@@@DJ@@@BB@@@@@BBB@@@@A@A@@@@AA@ABC@@AA@@@@DA@@@@B@@A@@@A@AB@DA@A@@AA@C@@@@@@NC@@@BBF@@@B@B@@F@@@@A@@@@@AB@B@@@RLFFBA@BTETKPK@@@@DANE@@@@FABAB@@@JG@@@@@@HC@@@@NGTETKZGHCJIHABA@@@@HCLGPIHAJCLCAE@A@AAGEGGICE@AEGFAOS@@FCB@B@@A@@IMGGOF@AIGCEAAEDIICCCAMEQGIA@ALKSGC@OEICCAAAEGCCCAEAAAIEA@E@A@@@@A@@@AAA@@@A@A@@@@@AKGIC_ISECASGCAGEGAG@G@EBCPGFIDIDQ@UAKAG@K@MBWBKI@C@C@AAAGC@ABA@A@@AAA@QAC@BAAA@@A@A@A@CAAAAB@@ABA@CACACA@@@AAC@@AAAAEACCA@CAM@@@@AB@FE@@@CAA@ACA@@ACAA@@@ABABABA@A@A@@CCCAA@AAA@AAAACACCEGGCIDOJA@CBB@ANAB@@@B@@@B@BYNA@EDGDABE@M@@@QAE@MAUCC@A@E@ECEC"],encodeOffsets:[[124719,42338]]}},{type:"Feature",id:"210727",properties:{name:"义县",cp:[121.23908,41.533086],childNum:1},geometry:{type:"Polygon",coordinates:["@@BCBC@@@@A@@A@@@@AABA@@@@B@BA@@CAAA@AA@A@A@@A@@ACA@@@@AAA@@A@@@ABA@@@AA@@CACACAA@A@@BA@BB@B@@C@ABC@@@A@@B@@AB@@BB@@CBA@@@A@@B@@@@BDA@A@@@BB@@@@A@AD@DA@@BA@A@A@CBA@@AE@ABA@A@@@@@A@@B@@A@ABC@@@@@C@@@AB@@A@@@@@@DC@@@@@ABABAB@@CAA@A@A@A@CB@B@BB@B@BB@@BB@@A@BB@@B@@BCBOHOFQNKDIFKBSCEBCDKCGAQGaIUGSGMGEAKEA@@@@@@AC@@@@A@@@AC@@AA@@A@AA@@A@@E@A@@@A@A@AA@C@@A@A@A@AA@A@@@A@@@A@@@A@A@@@@@@AAAC@A@@BA@@@@AA@@G@A@C@AC@AA@@AA@A@@@BAB@@A@@@@@AA@@@GAAB@@A@A@C@C@@@BFEBA@@@@B@BABA@@B@B@BBB@@ABA@AD@@AB@@AA@@@@@BAB@@UDQAI@GCA@IEKESGM@@AAA@@ABA@ABA@A@AA@@@@AAAAACAA@A@@@@@@@BBB@B@@ACAA@@@B@@@B@B@@ABA@@B@BA@ABABABABA@AB@@GCC@A@A@ABCBA@A@A@@@A@@B@@A@A@@@A@@B@@BBAB@@AB@@A@A@AACB@@C@@BA@@@A@@B@@@@@B@@CB@@BB@@KB@L@BB@@@BB@@@@B@@BBDBB@B@B@@B@@BB@@B@@@@BBB@@@ANAHALAF@@A@A@CAA@CEA@GBA@AB@@@BBD@BB@BBHBB@DB@BDB@BBB@@BD@@BHBF@B@@@@BFFPBHDN@LAJCF@BC@@@@BEDA@@@A@AA@AAAGG@@@@@BA@@@BB@@@@A@@@A@@@A@@@A@BB@@@B@@BB@@@@ABA@@B@D@BBB@BBD@@DB@@@B@B@@@@@B@BB@@BAB@@@@@BBABB@@B@@BBB@@AB@BAB@@@@@B@HAB@@ABA@ABAB@@@B@@AB@@A@@@AD@@@B@@ABABAD@@@B@@B@B@@@@BBB@B@@AB@BA@@@ABB@@@@B@B@B@B@@@B@B@@BB@@@BAD@@A@@@@@@B@BB@@@BB@@@B@@@@@B@B@@@DA@@@@BBBBB@@AB@@A@@B@DBB@B@B@B@@ABABBB@@@B@B@B@@@@BBB@B@B@@@B@@B@@B@BA@AB@@A@BB@@BB@@@B@DAB@@AD@@BB@AC@@@A@@A@@AB@B@@@B@B@DA@@BBB@@BDBBD@BBDBD@@CDC@@@@@C@AB@@@@@@AB@B@@@B@@@@B@@B@@@B@BDDBB@B@BAB@BBB@B@@BD@@@B@D@F@D@B@BAD@@BB@DBBBBB@BB@@BB@D@BA@@B@@DBBBD@DB@@@@BD@@@@@@B@BAD@B@DAB@DAB@BB@DBB@DD@DAF@DBB@DFBBB@@BBBAB@@BBFBB@D@B@@BB@@BB@B@D@@@BADB@@D@@@AD@B@BB@B@AB@@B@@B@@A@@B@@B@BBDBBBBBB@@@BB@@BB@@BB@@AB@@@@@@D@DBB@@B@B@BBBJHB@B@BB@@DB@@CDAB@@@BABABABA@@@DBDB@@@@@BAB@D@BA@@BCBAB@@@B@BBD@@A@@BBBBB@BABAD@B@BDB@B@BD@B@@@BB@BB@@@FAB@B@@@@AB@@ABABA@@BBDB@@BD@@BAB@@A@A@@@AB@@@D@@@BB@BD@@B@@@@@B@@@@@BBBBBDB@@BBD@FBF@@@B@BA@A@AB@@A@@B@@B@@B@@BB@@@@@@BB@@BC@A@@@ABABA@@@@BB@@AB@D@FAJB@@@B@BB@BBD@@@B@B@@@AE@A@@FB@DB@@@B@B@@B@@BB@@DBFA@@B@BB@BBB@@B@B@@BB@@@@BA@@D@B@B@@AB@@@B@@@@BA@@BA@@BA@@@@@@A@@A@@@A@@@@@ADA@B@D@B@@@@@ABC@ABB@@AB@B@B@BA@A@@B@B@@@@@BBB@@D@@@@@@@B@@A@@@B@@DB@@@@@B@@B@FC@@DA@@@@@A@A@AB@BB@@AB@D@DB@BB@@B@BBDB@@BADABA@C@A@@CCCAACB@F@@@BAA@BC@@BAB@DA@@@B@@B@BB@@A@@B@@BB@@BAF@B@BBB@DAB@B@B@DAB@B@DBFDB@B@@@BBBDB@BDBB@@B@DABABABA@@@A@@D@B@BB@@@B@B@@@@BBBBB@@B@@@D@@@BDB@BDBBBB@B@B@@@ABA@@B@DAD@B@@@@@BB@@@F@B@BB@@BB@B@B@@B@@A@@DB@@DFDA@B@@B@BBB@@@B@DAB@@B@@B@@@B@@BD@@@@B@@CFBB@@@B@D@@@BBB@BA@A@AD@@B@BBB@@BB@@BBABBB@BB@B@@@@D@@B@@@@@B@@@BB@BBBB@@B@D@@@DABAB@BADABABBFDBD@B@@BB@@@@BB@ADCB@@@@AAE@A@@BA@@@AB@@@@@BDDD@@@B@@BBD@FDB@BB@BB@B@BB@@@BBBB@@B@@@@B@@@B@BAB@@ABA@@@BB@@BB@@AB@@@@A@A@A@@B@@@@B@@B@@@@B@B@@@@B@B@@AB@@@DBDD@@BA@@BADBB@BBD@BAB@BBB@@@B@B@@@@@BB@@BB@B@DBB@@AB@BBBB@AB@@@BC@C@@@A@AACB@@AB@@@B@@DHDD@@@BBB@@@BB@@@B@B@B@B@@@@@@@BD@BB@@B@B@B@@@@EB@@@@ABBB@B@@B@@B@@@B@BBB@@DBBBA@@BB@@@BBA@BB@B@@@B@BB@@@EBAB@@@@@B@B@@AB@@@@@B@@A@EBEA@B@@@B@D@@@@A@A@AB@@BB@@@@@B@@CDAB@B@B@@A@@B@@@@BH@BB@@BBBB@BB@@BB@@B@BBBBDD@BB@AB@BA@ABA@AB@@B@@B@B@@BD@@@@B@@@BB@B@@B@@@DB@@BB@ABB@@B@B@@@BBBBB@D@B@@@BBD@B@B@@@BAB@B@@@ADBB@BB@BBD@B@D@BA@@B@BBB@D@@@@@@BBDCBCB@@@BAB@B@BB@BB@@@BBB@@@B@BA@@@@B@@@BBB@@BBBD@@BBB@@@@BBBH@JCLELIZINCHCFE@@@AB@@@@@@A@@@@DB@@@AB@@@BB@@@@B@@@D@B@B@@@@BB@BADAB@B@B@@@@B@@B@DB@@FCB@BBB@BADAD@BAB@@@@C@A@A@A@AAAAC@@B@@AB@@AAA@ABA@A@A@@@A@@@@@ABA@@@@AAB@AA@AAA@@A@A@@@AA@@C@@@AAA@A@@ACC@@FGFGFCRETENALAH@HB@D@FHDPH@@B@@@@@B@@BLBFCBCAELNBCBAB@D@B@BDB@BEFBABA@ADD@@A@@@@DERAN@JILGBAHGFQDCBAAA@@@A@A@@@C@@@A@A@ADA@@@ACCDBD@FABCB@BA@@B@B@DBB@B@B@BBBB@B@DA@AB@DBD@DBBBD@DBD@BD@BBDB@BDBBDB@HDB@B@B@D@DCJCF@B@D@FBD@DDBF@BAD@B@D@BADABABAB@D@B@B@BA@CBC@@B@DBBFDDD@B@@@@@D@D@B@PBD@@FAB@F@B@@B@DBD@@BBBBD@FBH@DBD@BBBB@@@B@@ABE@BBDJBB@@@BDA@BD@BBDBB@BAB@@@BAB@@B@@@@@BFA@@BBV@P@D@VBV@LBBAF@B@D@L@TCJAHSDADAHF\\BRCP@H@FHDFNPNPFFJFDBHBHCFEPGBOAG@I@G@@BAB@@@B@@@B@@A@@@A@@B@@ABADA@@@@@@DA@@@@BA@@BAB@B@B@@A@@@@A@@@@A@C@@@@@ABC@AB@@@B@B@@@D@B@B@BAB@@C@A@@@AAA@@@@B@AA@C@@A@A@@AA@A@A@AAAAAAA@@@A@E@@@@@AAA@AA@EKGOOMMCEEI@E@EBOAMGGCGASB_BOAK@CAGIMEKQMKIOEYCYIWEUEICIIMIECMBO@IA@GDKDOBKBCAIGQAKAC@@@@AKBGHIDCDGDEHKLELAJEDC@ADQ@M@O@ICGIQQIIMEMEIGC@GGGMGIEIOCMAEACKAMCCACAOCCCBKDQBK@MDGDI@CAEIAA@OAA@UASDGBKBQ@QASAUF_BSAA@@@@@G@[AA@[AKC@@@@@@QCIC@@@@GCCA@@@@IEYDUMAFA@EB@CEDQHMDA@SGMGGAOEWIEA@@@@CAKC@CEAEBSEOMB@@AAC@A@@BAD@@@AE@@@@GBA@A@AAA@@@@B@@EB@@"],encodeOffsets:[[124146,42298]]}},{type:"Feature",id:"210781",properties:{name:"凌海市",cp:[121.35549,41.160567],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@CEEC@@A@AAA@C@A@@@AB@B@BJN@@@B@BABAB@BABNHDBDBA_"],["@@VB@CFC@@@VABQHODEFHZnGn]AE@EBE@@@ADKNSZBHEDADE@CFCJAFADCBC@@AWDEF@FADEDAFAB@@@@@DCJB@BNABFBA@@FCLCJGFW`QCA@ACGCECGCCE@M@ICGEICOGIEOQQSEG@A@@CEEQGKICCCAA@AAFGBM@MCUFMLAJONWTcFAkFyDcJgNcFMCHZFPEFEFELDBJBHLDJDJFDDDCFFDJBBDB@DDBLRN@B@BHJFNEPVBFMfDVGLE@@V@@@^@TAVVNChL"],["@@BAD@@@@AAAB@@AB@B@DBDBDB@@BB@@B@BA@@B@@@@@BAB@@@BABCB@@@@@DB@BBB@B@@@@BA@@@AB@@@B@BFHA@@@@AACA@@@@B@FACC@@@A@@EBC@@@@@@AA@@@AA@A@@A@@A@@@@@A@A@@B@@CB@H@B@SS@@@BA@BD@BA@G@GB@@@@FJA@A@@BE@@BGBGB@@AB@@A@@@A@AB@@@A@CA@A@@@@@A@A@@@AACA@A@@@AA@AAA@@A@@A@AA@A@@C@A@A@A@@@C@@@GAAA@AQXuVCBMVMTILBHg^LDDCFATDLAJELCRMPEPGDA@AA@@@AAB@@@AA@@AAA@A@@A@ADAB@B@B@B@DB@@BABABA@@@@D@@C@@@@B@@@BA@@D@@@@@D@BAB@@@@AB@@@@@B@B@BAF@@BB@DAB@B@B@@AB@@CBCB@@@@@AA@@B@B@AC@@@@@AB@@@B@DA@@AA@@BA@@@AB@@@D@"],["@@AJGDOJGAC@IBE@K@IBA@C@E@KCCAMGGCCCEC@@@@EAKIAC@@@@CIA@AEEIKGACA@GDI@IBCBADABA@CBCDEDIDEBG@ABC@MDQ@QAKAG@C@UGGCGKCEMU@AKOKK@@A@AB@@@@AB@@A@@@CCCCAACACA@A@A@AAE@@AAAG@@AAA@@@CCA@@@A@I@A@CBA@@@@BA@A@A@AAA@@@AB@@A@AAA@@@@B@@A@A@A@A@@@@@A@A@@@@A@@A@A@@@A@C@@@A@@@CA@A@AAA@@@A@@@C@A@@@AAA@@@C@A@@A@@@AAAB@@C@@@A@AB@@A@@@A@A@@A@@A@C@@@@@BA@@@AB@B@BAB@B@D@BA@@@AA@@A@AA@CA@@A@ABA@A@@B@@@@@BA@@ACAC@@@ACAA@@@BA@@@C@@BC@BFA@AB@@@B@BA@@@ABA@@@A@A@A@@A@@AA@@@@AA@AAACA@@GDC@@BA@A@@DA@@@@AA@A@A@A@@AA@@@CD@@@B@@@@E@A@@A@AA@@AAB@@A@ABABA@ABABA@@B@@A@A@AB@@AB@BBDB@@BB@B@@@B@@@B@@B@BB@D@D@@BB@@@B@@B@BB@@B@@@B@@AB@@@B@@@BA@@@ABAB@@@B@B@@C@A@CB@@@A@AA@@@EBAAA@AA@@@B@@@BBB@@AB@@@@@B@BA@@A@@CAA@@@A@AB@BAB@BA@CAA@@@@@@@@@A@A@A@@@@BGFCBA@ECA@@@AACA@A@@B@@A@@C@AAA@A@@@@AAAA@AA@@AAA@@AAAB@@A@ACAA@A@CBA@A@A@@@@@EE@A@@@@@A@@CAAA@@AAAA@@C@A@A@A@AAA@A@A@@BABAB@@@@@B@@ABA@@BA@@B@@ABA@A@@AA@@BA@BB@B@@G@@@ABA@GBEDMD@@@BA@@BAB@B@@BD@B@B@D@@@B@@A@C@A@AA@B@@@B@@A@CBA@@BA@C@A@A@CBA@@B@@AB@@@FA@A@@@AB@@@B@@@B@B@BB@@DB@@BBB@@@BB@BBB@@BB@B@@@BB@BDB@B@@@D@BA@@BA@@@@B@BADADCD@BADAD@BAB@@ADA@A@A@C@CCAAAAA@B`BBBD@B@B@@EBAC@A@CCA@CMGCDEBC@C@IDCBCF@BCBGDA@CACCA@C@IDA@A@EAABA@A@CAA@@BA@AB@B@@@@BBBBB@@B@@@@ABBB@@@BB@@@BBBABB@@B@BB@@@B@@B@B@@@@ADBB@BBBB@BDB@@@B@@BB@@B@@@@B@@BB@BBB@@D@BBD@@@D@@@BABBB@@@BD@@B@@@B@DAB@@@@@BB@BBBD@@@B@BBB@BBB@BBB@BBB@B@BAB@@@@B@BA@@B@B@@ABC@@BBB@B@@@B@@A@@@AB@@BB@@@@ABAB@@ABC@GAA@@@AB@B@F@@A@@@AD@@A@ABAAAACBA@A@A@@@B@@B@BB@@@@@A@C@AB@@@BB@@B@@@@CBA@@@@C@A@@A@@@A@@@A@A@CBAB@BAB@B@@BD@@@D@BBDBB@@@FBB@@@BB@B@BBDB@@B@@@@@B@B@B@B@@@BBB@BB@@@B@B@@A@A@BB@@@B@@@BBB@@BBD@@BB@B@BB@@@B@@@BAB@BA@@BA@@BAB@@@B@B@@@@B@@BB@A@AB@@BBAB@B@BC@@B@B@@CB@@@BBB@@BD@@A@@B@@@@@B@@BB@@@@BBB@@@@B@@@B@BDF@BBB@BBB@@B@@B@@@@AB@@@BA@@BAB@@AB@@BB@BBBBBBBBB@@A@@@BB@@@B@@@B@@BB@@A@@B@B@@BB@@@@@B@B@@B@B@B@B@B@B@@@@@B@BB@@B@BABBB@@@@D@DB@DB@@@@BHBB@@@BC@A@GB@B@@@B@@DB@@B@A@@D@@@D@@@@@@FD@@@@BABA@@BBB@DBBB@@DB@BB@@BB@@@@@@BBBDD@@AB@B@BBD@@BBB@B@@B@BBBBB@@B@B@B@BAB@D@D@@BB@B@@@@BB@BB@@B@@@@BBB@B@@@@BBB@DBB@B@B@@BB@B@@B@@BB@B@B@B@BA@B@AB@BC@@@@@ABA@@@BD@@ADA@A@AB@@ABA@A@@B@@@@@@@B@BB@B@@@@@B@@B@B@B@@B@@@B@@@@@@@B@@@@B@@@BB@BAD@@ABAB@B@BABA@@@@ACBA@@@A@@@AB@@@BABE@A@A@A@@BABABAAABABABAB@BCD@@@BABC@@B@B@@@BB@@@BB@FF@@BB@@@B@@D@@@@BBB@@A@@B@BAB@B@B@B@B@@@@@BBB@B@@@B@@AB@@@@BB@@@BBBBABD@@@AB@@@B@@@BBBB@@@@@B@AB@B@BA@B@A@@BA@@FE@@@A@@DA@@BAB@@@B@B@@@B@@@@B@@B@@@B@@BDBFB@B@B@@B@B@B@@@B@@@@A@@@A@@BAD@@@@@B@@@BBDBB@BBBB@B@BB@BA@@BA@G@A@@@@BA@ABA@@AA@AA@@@AAAB@A@@@@BA@AA@@A@A@@@@@A@A@@CA@@AC@AAA@A@C@AB@BA@@@@AA@@@A@@AAB@@@B@@@B@@@B@@@@@AA@@B@@A@@@@HHBB@BBBB@@@B@FC@A@@D@@ADEBI@KCMAGEOAE@@@@@AAEAG@@AC@@AA@ACA@ACAA@GAAAA@@AAC@A@@BAB@HAB@DFB@DBB@B@@@BEBKBGBM@@A@AA@@@@@AA@@AA@@@@A@AAAAC@AA@@@@@AA@@A@@A@KLA@@AA@@DA@@@A@@@@@AB@@@B@@AD@@@DABBB@B@@@BA@@BAAA@@@AB@@@B@B@@@@AB@@@B@B@B@DABAB@B@D@HD@@BAB@BABABABAB@@A@AB@BA@@@A@A@@@A@@BBBD@@@AAA@A@@@@@@@BBBBDBBBB@@@@BBB@B@BAB@BA@@BB@BN@THLFJFB@HDJ@RBVC@@BA@A@@@@BB@@BA@@BCB@BA@@AA@A@A@AB@BA@A@A@@B@FAAE@@D@D@B@B@@@BAHB@@B@@B@@@@@BA@AB@@B@B@@BB@@BBDD@B@H@@@BB@@@@AB@@@BBDBB@@@@@@@B@B@@@B@@@B@@@BBBB@B@B@@@@DBBB@B@@@B@F@@@@BB@@B@BB@@BD@@B@@@B@@D@@B@@@@B@LFFBNHTHVHbJRHHBh]AGJKNSNUDAvURW@@@@@AB@@A@@@A@@B@BA@A@@BCB@@@@A@@@ABA@@@AB@@@@AB@@AD@D@@AB@B@@@@@B@B@B@@@B@@@D@@@BAB@@A@@DA@AD@B@BB@@B@@BD@@B@@BDBBBBBDB@@@D@B@D@BBB@@AB@@@@BDF@B@@BDTT@@AE@G@AB@BA@AB@BB@BFAD@B@BAB@B@DA@B@@DC@@B@BA@@@@@A@@@@A@@A@A@@@A@@BA@A@CB@@AF@DA@@FA@@@@BA@@@AB@B@F@B@@DAD@BABADA@ABA@L@h@B@@VdA@UJETMBKDSJO@@HGFETGD@PCLM@CBABAPIBCFK@E@AFBVJHB@BF@@@B@HBB@NDB@FBDBB@HB@@F@NDR@@nJ\\HJDNFNFDBxVJBdNJDEQAI@EBM@@BG@@@@@EBABCNIHCXDLCXE@K@C@AAQB@@ACAEI[AGI@@@@OOEEKICCOO@@@@@@AAEGDA@A@AAACA@A@@@@@KBCLQDE@@@@FI@@NO^EFA@@@@@@PAL@TAV@bAXC^BB@B@HBNFFBFAB@@B@@H@NAH@D@B@@@B@DB@@D@@FB@F@L@VA@@ENABHCJEHEDAHCDABAFA@A@@CAC@@@@A@@@CAOSBAGC@UBWBQ@@HM@@F@@@@E@AEK@C@CDC@@FGB@BC@@CIC@CA@OADML@EIQa@@@@CEC@C@ODM@BCDIPGB@F@@ACCB[FCBADIHQBAHS@K@@@@AEAGRABIU@AEEBEACSCKBWICGACCCACC@AAA@EBC@EAECECEEECEAG_REXIHKDED@@ABAEMB@AIACDNBCJHBAFA@CJB@AFGAHUOC@EF@@AA@EBCBCFEBE@CFBX@@ADCDEBIBED@DCFCBGFYAMTCL@B@@AF@FBFm^mHGYFEPCRGBA@U@@@R@@@D@@W@M@DGDCBCgKMDUUSB]@@@U@@@KFUHeCENUAFOEMGI@A@AQMAKCCA@ACIAECDECCECCICIGKIACAFKFEFEEOGY­YOCBd{\\VhJJCZXRNHDDEFGHODABBHNDHHLDBBBBDDBH@BADEBEBABGHCBEFEFAD@HCHIFG@EAGAECG@EF@FBFDHDDBAHBFAB@TAJBJFBDBBABAAABCDEDGH@DBHCBCBI@MCGCEAACEAABIBEHBFCFBD@FDBF@J@L@DD@DBBFDDFBB@D@BCDABCBGHCB@B@DBDBD@F@DAFAFCBIHIDBBBB@ADABBBB@@BB@@@@B@F@B@@B@@@@DBBBB@@@BBB@BBBB@@@BB@BDBBB@B@D@H@@@BBBB@@@@ADB@@@@@@D@B@@BB@BBB@BB@@@B@@B@@ABABABA@@B@B@@@@@BB@@D@D@@@BB@BBB@@B@@@B@@@@BB@@@@@BA@AD@@BB@B@B@B@@A@C@@@@B@D@@@D@DB@@@FCB@@BB@@@@B@@BB@@B@F@@@@@B@@@@B@D@B@@@@A@AAA@A@AA@@EBA@A@A@@@A@A@AAA@@@A@ABAAABA@A@@BA@@BA@@@A@@BA@@@CDI@ABA@A@@@@DDBBBILABCD@@@@EJ@@@J@B@B@B@F"],["@@@@@@BFBB@@DABABDEDBBDHFABD@BABBD@@AB@BB@CFA@AB@DAB@@C@@@B@BBAD@B@B@B@D@D@@C@G@CBA@@D@D@@@@A@A@@@AA@@MBBF@@C@AB@@@BBD@BA@PNTFFAFB@DLDDB@@@@FBXJPFHBNHTHB@NCRGFC@DFAB@BEVNZCJF@@@@DBHD@@@@JDRD@@@@@@LD\\BB@\\BH@@@@@B@TB`AVETBRBR@LAHATCVBB@PBB@JBBF@DCJCH@NALCRALDDPDDBDBNDLBBDBFDNJPJFNHHH@HPGPEDATEHC@@BA@@@A@AB@@@@C@@@AD@D@B@BADAB@BAB@B@@@B@BABC@AAA@CBE@@@A@ACC@AAA@CDCB@B@HCFAHCBABA@@BABCACAEAABCBAEA@@@@@@AA@@@@@A@CB@@@A@@A@@@C@@B@@@@CBC@A@A@@DBD@@A@@@A@A@A@A@@@AB@B@@AB@@@B@BA@@B@AAAC@A@ABA@@@@AA@A@@B@@@BAD@B@DB@@@@B@BD@@@@FAJAB@@@@@@B@@BA@@BB@@FAD@JA@@@B@@B@@B@@BB@@@@@@BABA@@@@@B@B@@@@@@@@D@@B@@@@@D@@B@@A@@BA@AB@@B@@BB@AD@HC@@@BBB@@@@@@B@BBNAB@JAJCLEJEJGBA@@@@DC@CKGACCACEEGGCKBC@GAGAECYQMECAKGQGKBIAAC@IGCGAGDIEKGBCAAMGKKME@@@A@@A@IECC[GMGICcMIAwUCAMEMEIC[GmI@@QMCE@@@GAA@CAEAA@MCA@GAA@@@E@@AGAUIEA@B@FELADOJABAB@DKNODC@SHEFGH@@IPCTALSNIF@VcB@UA@g@K@A@C@A@@@@FAAAB@@G@@B"]],encodeOffsets:[[[123675,42188]],[[124407,41984]],[[124125,42283]],[[124136,42195]],[[124172,42256]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
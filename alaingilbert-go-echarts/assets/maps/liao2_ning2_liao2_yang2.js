@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("辽阳",{type:"FeatureCollection",features:[{type:"Feature",id:"211002",properties:{name:"白塔区",cp:[123.174325,41.270347],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@@A@@@@@@@@@A@@@AA@A@@@C@C@A@A@@@@B@@AA@A@@@@@@@@@A@@@@@@@@@@@@QB@@AECB@@@@@AG@@AEB@@GDE@@@A@A@A@C@A@@AB@AAB@@AB@@@AA@AC@CB@BAB@@@B@@@@AAAB@A@@@@CCCBC@@AA@A@AAAA@@BA@A@GAAI@AHCRADAHAJ@DB@@@F@AD@@@@@BAB@@GH@@@@@@@BC@A@@@VNBBB@DBBBDDB@BBB@@BB@@@HFFDB@@@HFNH@@FDBBB@@BFBDBB@B@D@BADAAAABAAB@@AB@@A@@D@D@D@B@BBNA@AAABA@A@A@A@A@A@A@@@A@ABA@A@@BCCEAC@ABCDEHEJCJGVMGAED@@C@@@BAA@ABAG@@A@AACF@BA@DE@A@AA@@@ABAAA@A@AB@@ABABA@A@@BAAA@@@@@"],encodeOffsets:[[126168,42237]]}},{type:"Feature",id:"211003",properties:{name:"文圣区",cp:[123.231408,41.283754],childNum:1},geometry:{type:"Polygon",coordinates:["@@HOBMDE@@@AHGAMAGAG@AACGEIGACCME@GFGHIDGFCBBJANEHAHTD@FHAHAJA@@@@HEHADDB@D@JB@AF@@@@C@E@A@A@ECA@@@A@@@AAAAAAA@A@A@A@C@CBCBCBCBABABA@@BCB@BA@E@EBA@ABA@ED@@@B@@A@CFABA@@@C@UNIHIDGFCFAD@BBDDFAD@@@BAB@B@B@@@B@B@B@B@B@BABBB@BFPFJ@JBDBBBD@BFHFH@@@@DA@@@@@@@@@@@AB@B@B@D@@@B@@B@B@B@@@BBB@@@B@@@BB@@B@@@B@@@@@B@@@B@@@B@@A@@B@BB@@@B@@@B@@@B@B@@@B@@@B@B@@@B@@@B@@@B@@BB@@@B@@@B@@@B@@@@@B@B@@BB@D@B@@@@@B@@@@@@@B@@@@@@@@@BB@@@@BB@@@@@BB@@@@BB@@B@BB@@B@BHA^@@@BBF@D@B@BB@@BBBBD@H@B@BDJ@@D@@D@D@DBDDDDDD@BBBABABA@CDCDAF@BBB@BBBB@BBFBHBFB@AB@B@D@B@BD\\BPBDABABADC@AB@B@@B@F@BBBB@FAB@B@DBFCHABAB@D@D@B@H@H@D@BBB@B@DAD@B@B@B@BA@@AABABAD@BA@A@A@ACACAECCAAA@ACA@A@@B@B@D@@AB@CACAAA@A@A@A@@@@BAB@@AAA@AB@@AB@HAB@BAB@BA@CDCB@@ADA@CB@BBDBBBDAHAB@DABA@AAAAA@@G@C@AAA@AA@C@A@A@A@@B@B@B@@A@A@C@A@ABAB@@CBINCLU@INE\\FJBLDZARBXBJ@FFBAAECAFEQMSOKGGOGAACBE@EDEHEFAaEUGQBKCSSUE@@@AA@@@@A@@CAC@C@A@QGEBABC@CAC@ABEDEBCFADC@IHACAAGOIMKC"],encodeOffsets:[[126317,42206]]}},{type:"Feature",id:"211004",properties:{name:"宏伟区",cp:[123.196672,41.217649],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAECC@CBW@GB@@A@@AA@AA@@A@@@A@@BC@@A@@EB@EA@A@A@CB@B@B@@@BA@@BABAA@A@A@AC@@B@FA@@AAAB@@@AA@@B@AA@@@@B@@A@AA@CBE@ABBBA@@@@@AAAA@@C@@B@B@@AB@@AAAAA@AAA@@@C@CBAAC@A@@B@B@@@BAB@BC@A@AB@@AB@BC@@B@@@B@@@@@BB@BBD@@@AF@B@@@@B@B@@@@@@B@@B@ABB@B@BBB@@BBB@B@@AB@@BBAB@@ABA@A@A@@@A@ABABA@AB@@A@C@@B@BABAB@@BDA@@@C@@@A@@@@B@B@@A@@@@DA@@@@B@@@@BBA@@@@B@BHLFBJHFBDBCBEABDDF@BAA@DFB@BF@BDABIHHBBRA@C@A@@@CBCBA@CBA@DB@@BDBBB@@@@@@B@@@BBB@BAB@@ABA@C@ABA@@B@@@@@BBBBBD@@ADCBAB@BAFD@@BJJ@BB@H@BAB@@BBBBB@B@@BD@DADD@@@@@BBABB@@@@@A@@BA@ADAD@@BBB@@A@@BA@BBA@@BB@D@B@B@B@@@F@HC@@FA@BH@@B@@@@DABF@@RA@@@@@@@@@@@@@B@@@@@@@@@BBB@@@A@@B@B@D@D@@@B@B@@B@@@B@@@@@@@@@B@@@@@@@@@@B@BB@AB@B@BABA@@BAB@B@BBBA@@B@@B@BCFB@@ADEBBB@@@BHBAB@AB@@D@@@FCHBD@@@B@BADEB@@@@A@@FCB@BAB@FAF@B@@ADA@AB@BABADADADADAD@B@B@B@B@BBBBBB@@B@@@B@FDB@B@F@D@@@@@BEA@@I@CCABCFG@G@@B@BIBGEGC@SBGFGBMAIDAHEJCHGHEuISEEAE@OCKEQKYGGC@@@@IEMIGFEBEDGBEBI@GAWA"],encodeOffsets:[[126176,42121]]}},{type:"Feature",id:"211005",properties:{name:"弓长岭区",cp:[123.419803,41.151847],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@@C@@@ABAD@@A@@@C@A@CAC@CAC@CAA@A@@@AAA@A@@@ABA@A@A@A@@@@AA@EAE@@@CC@A@@AACAA@CA@@@AA@A@AAABAAA@@@A@C@A@AA@@A@@BABA@A@ABA@A@AB@@A@@A@@@A@A@C@ICAAC@AACACCAA@A@AC@@@@A@A@A@A@CB@@@BA@CB@AAB@@A@@@AB@@A@CBC@@@C@@@A@@@@A@AA@AAA@A@AAA@A@A@@@@@QAEAQAA@@@A@ABA@@@E@@AAAAA@@A@@AA@@A@AA@CDABADA@@BC@A@@@A@A@A@ABA@@@A@A@A@ABA@ABEDC@A@AB@@AA@@C@A@A@A@A@A@EB@BMJCFUR@@@@ABMRBNCPDTJLNJJF@@@@HDZHRLLFPDF@FBTFvJF@DNBDJHHFBD@BBHBHBNGH@B@@CFANGPLDJNHPBBBDJGD@BCDEFAFCBAD@DBD@BAFARHB@D@D@DB@@@B@@B@@B@@VFTTLDRAVHbFEBGFCF@FAFBDHBHPLHTPRNEFDBBFABD@ADBBL@JBN@DCHABCACBEEMPCDCICEC@CCA@G@CEEDCAC@EAA@A@@B@B@@ABA@AB@@@@AB@B@B@@BB@DBB@B@@@B@@A@@@ACC@@@A@A@CBC@C@@BADAB@@@RS@AA@@@CAA@@@@@@@AAE@AC@@AAA@AA@@A@@AA@E@AA@@A@A@A@ABAAC@C@A@@@AA@AAA@@AA@@A@CAAAAAA@AAA@A@@@AAA@AAAAAAA@ACAAA@AAA@C@A@A@AAECA@@AAC@@A@@AAA@A@AECA@CCA@@AA@@AA@AA@@@@@CA@AAAA@@@ABA@AD@BA@A@@@AD@BAB@B@BB@@@A@@@A@A@AB@@A@@@A@@@@@ABA@@BAB@@AD@@A@AB@@AB@@@AA@@BA@@B@B@D@D@@@@B@@@BB@@@@BB@B@B@D@BBB@B@BA@A@@@@B@B@@ABA@@AA@AA@BA@@@@B@BBB@@@B@@AB@BA@@@@BA@@@@@A@A@@@AACBABA@@@@AA@@BA@@BA@@CA@AAA@@@A@@@AAAA@E@A@@@ABA@@A@@@@@AAA@A@@AABA@@AA@A@A@@@AACDAB@B@@@@AB@BADABA@@B@B@DA@@@@@CB@@AB@@@BABA@@@ABA@@DAB@D@@@@B@@BB@@B@DAB@@@HB@ABA@@@A@ADAB@B@DA@@@@@ABA@C@AB@BA@@@AB@@A@C@@AAAA@@@CRGBCFQAKQSAAGGGGOKOQACQCIFIJGF@@@@IJMLABCDA@CD@@@@ABEFONQFWDC@@@@@@D"],encodeOffsets:[[126408,42078]]}},{type:"Feature",id:"211011",properties:{name:"太子河区",cp:[123.18144,41.295023],childNum:1},geometry:{type:"Polygon",coordinates:["@@BB@ACEACFBDACAEAIGEAGK@@@D@@ABA@@@CBEBA@A@A@@@A@@B@B@B@@@B@@AB@B@B@BABC@FD@BB@@@B@@B@@A@@@@B@@@BB@@@@@B@@B@@@@@BA@@B@@B@D@@B@@@BA@ADCB@BAB@@BB@BAB@B@@AB@BABEDABA@ABCBA@A@A@A@A@A@A@@@@B@@@@@@@@@@@B@@@@BB@@A@EB@BA@@BA@@BA@@@@@BD@@@@@B@@B@@B@@@B@@@@@@CB@@BD@@@@B@BAB@@@@@BB@@ABB@@@@@@BA@@A@@A@@BAB@@BD@@@@@BDBBBDDBBD@B@B@@@@@@@B@B@@@@BBB@B@@@B@@FD@A@@D@@@@C@@@@BAB@B@@@@B@@@B@@B@@AB@@@@BAB@BE@AB@@ABCDA@@@@BA@A@@@CB@BABA@@BA@E@C@@BA@A@@B@B@BA@@@@B@@@@C@@@@@A@EBE@@@@DB@BB@@@@@@A@C@C@CA@A@@@C@@AB@@@@ABBB@@DF@B@B@B@@@@A@CA@AAB@@@@@B@@A@AACBC@ABAA@A@@CA@A@@ABCB@@A@A@@B@BA@A@A@AB@@CDA@@@@@@BA@@@AB@GC@BFEEA@A@@A@A@AAAC@EBE@A@A@CA@AC@C@A@ABA@AAA@@AA@AAG@GAA@A@E@ABC@A@AAMHEBKBA@GHC@@@A@@AAA@AA@ABE@YH[PCB@@EFDFDFBBBDDFD@@D@DH@B@D@DDH@DB@@J@@F@J@JLBF@JB@F@R@J@D@DBF@DDB@FK@CF@BA@@BAD@B@@BB@BDDBB@@@BA@@BA@@BAFADJ@LED@D@@@BBBDBHCH@DJDB@DBH@FAD@B@@BBB@B@BABCBEDADAB@D@@BBBBBBDBD@DBLFJDJDFDJBF@F@BBD@DBBBFDHDF@F@D@D@B@F@B@D@FDDDBBB@B@D@BB@B@BBBB@F@FAD@DAFAD@F@BB@@@B@DBB@@B@DBB@BA@CBAB@B@V@H@B@BA@@DABA@A@@BABBDBBBBB@@D@BADADAD@B@D@D@BAD@BBB@D@@ABA@A@A@A@AB@BBB@DBB@BABADAD@B@BABABE@A@AACCAGAG@EAGBEBA@AAAA@A@G@C@A@A@AEAIAC@CAC@C@C@C@AA@A@@@@@A@EAAAAAACCCCAAAAAC@CBA@ABA@A@ACCACLGNGJEDA@@BANKHEDAHCJAD@@@LDJDB@DAD@PETEFAFBFAFAHEDA@C@AB@@@EGEG@AACAAAC@IEIEOMBAAA@C@C@C@@@@BA@@BA@BBBABBCBABC@A@A@CAEA@AA@AAEC@@MGGE@@A@ECGE@@A@@AA@AAA@CCAACAA@AAUM@@B@D@@A@@@@@@HG@@BA@A@@@@BCE@@@A@@CBIBGBCDQBGAI@@ECABA@ABCD@BC@AAAA@A@@@@@AB@BAD@B@BA@@BA@AAA@A@@@A@@@@A@AAAC@@CAB@DAB@DADA@@B@D@B@AQGAJGBAACE@@AEA@C"],encodeOffsets:[[126100,42178]]}},{type:"Feature",id:"211021",properties:{name:"辽阳县",cp:[123.105694,41.205329],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@AACCCCGDC@GDCCCCIEFC@@B@HC@@AA@@@@B@BAACEGADCC@@AA@@CGB@B@AAAAKACBMK@AH@ACB@CEB@IGBA@@FE@@B@AAB@CABAB@BA@@@@BAEEBAFCGGABA@@@A@AA@@@@A@@@AA@@CBAA@AAAAAAA@@@A@CB@@ACA@AAA@@A@AB@BA@@ACCACACACA@@ABA@@AA@CBA@@@A@@@A@ABA@@@@@@GD@@A@ACA@AAABA@@DBB@@@B@BA@CCIBEBID@@GDE@AB@@@@C@@@@@E@A@@@ABKB]TADA@@@@@IHIDOF@@aB]DC@U@ODKFGJOLADILOHIBMFEBODEB@@@@IDMJMLOHGDKDIBKJHF@D@DGDAFEDADDBJB@@@@DDB@HBFBDBB@DBBAFCB@D@B@B@BB@@B@@@DB@@B@D@DBB@@D@@DB@BBDBDBBBBD@B@D@@@DA@@BAB@B@BAB@@@B@JDDBB@DDHFBDDDBD@B@BCF@@AB@DBD@BAF@D@D@@@DBBADBBDDD@DDBBBDBFBFADB@@BHDJBFBHDBB@DBD@DBBBDBBFBDBB@BBBB@D@DABCFGNABBBDBB@DBBB@@DDDFBBDBH@HBDBFB@B@DIFADAB@BBBD@J@F@FBDB@BBB@BBBBBB@B@DBLAD@B@@B@BAD@DBF@FAD@FBFBFB@BBDBFB@B@D@BDBDFDDBBDB@BBBB@F@D@@A@AAAAC@A@ABABAB@B@FH@BDBDBD@F@D@L@F@D@BB@BB@BB@DDB@BD@JAD@B@D@DBFBFBFBFBF@D@D@D@FBD@@B@FBFB@BBDDDFDD@DBD@D@BAB@BBR@BADA@@B@BBB@BBBDBHDHFD@@@BABA@AACBABCDA@AB@D@B@B@FBFHBBBDFBB@DB@@EG@AFC@BBBFBD@@@BADADA@@FDDD@@@C@@H@@AB@@@BB@@B@@@B@@ADAB@B@@@DB@DB@B@B@BBB@BB@@B@B@B@@@BBBB@@@A@A@AAE@A@@BCB@BC@@@ABA@@DC@ABEBC@ABC@EAABC@A@A@C@@@CACCE@@AA@@AA@@@@B@B@B@@@D@@@B@@@@@@A@@BABA@B@@D@BBBBD@@B@@BB@@@BD@B@D@B@B@B@B@@@FABAACBOHA@IB@D@B@DK@ABBDBDBDBJHDDBBBBD@@@BA@@BA@@@A@EBCBE@AB@@AB@@A@@AACC@AAA@@@ABC@AB@@ADEL@@ECA@CAE@C@C@I@Q@EIAE@KA@I@I@EI@@@CAG@CCC@A@G@@C@CC@CEACAACECEFE@@DA\\OZGF@BAB@@BBB@BB@@@D@HGB@LAFANGBBB@D@BAF@B@B@HBH@BBB@@BB@BBB@BAB@D@D@@BDBB@B@F@FAD@BB@B@B@BB@B@FFAED@@HBA@@B@@A@@@@B@DC@@BAB@B@B@@A@AB@B@@@DABA@@@BDB@@@BBBBAD@DABBB@@@@A@@@@BA@BDBB@@@@@@A@A@ACE@@AABA@@@@BA@@@D@@@BDBD@D@B@@@@@@@AAA@@C@@F@FAB@@@@@D@@@@@@A@@B@@A@A@AB@B@@AD@F@B@@AB@BA@ADA@@B@B@@A@@B@DCBA@@BAF@@ABA@A@@A@@BA@@@@A@@@A@@A@A@AB@@@@@D@@C@@@@BEC@@@A@@@AAA@A@@A@A@@@@@@@A@A@C@AACCAACA@A@@@@AC@@BA@AB@@@@BB@@A@@@@A@BA@@AA@@@@A@ABA@@@@@AC@@DA@@@@@@@A@@@AA@@@@A@@@@AC@@@@B@@AB@@AB@@AFAB@@@AA@@@@@A@@@@@@@@@@@A@@B@B@B@B@B@B@B@DABAB@BAFCBA@ABA@@@ABA@AAA@@BA@ADABCB@@A@@@AC@A@@@@AB@@A@@@@@AA@@@@@A@@A@@@A@@B@@@@AA@@@A@@AECA@A@CAA@AB@AC@@A@@@AC@CAA@AA@@@@AACA@@@@@@C@ABA@A@ABE@C@A@@B@@A@A@A@AB@AA@AB@@A@A@A@@@@BABA@AB@@EACA@BA@A@@@A@A@@@A@A@@@A@@@@AA@@@A@@BA@DDA@AAA@@@@@BFC@BFC@@@@@ABDFBADDGDDBD@@B@B@@@@@AA@@@@@A@@ACBA@A@@@@@@@@B@B@B@@ABA@@@@@ABA@A@ABI@@DA@@@@@@@KBA@@C@@@@A@@@AB@@@@@AA@@@@AIA@@BAAAA@@@AB@@A@@@@@@B@@@BIB@AACA@EAA@@@BAA@@@AAA@@@BE@A@@A@CA@@A@B@A@AAA@@AB@BEA@@@A@EC@@@@EAA@@@@BA@AAA@@@CA@@A@@@AB@@@@A@@@A@AAA@@@CB@@@BBD@BAD@B@@@B@B@@I@FF@BI@MDC@CAEEGI@@A@@@CEEBAC@AA@@ACBBBB@BFE@QFABMI@@BBAB@A@BAAABB@CDECABCAA@@@ED@@CCA@@@@A@@CACB@@@@ABA@@@@@@A@AA@A@@CA@A@C@BFEBAAC@@A@AA@A@@@AACIA@CG@C@CACAA@@@@@@@@@@@@@AAA@@@A@@EBAA@BCA@@"],["@@OBKBO@QCYKIEMEMGKGSLMHKDIBECIKKE@LKNUJQFSNMLQRKNKRCRBJDNJHNHJDLNJFDJFF@FADEBIDGDIDIHED@@@@C@MDMAWEGTDFDJDJCBCB@@@@CFCRDFFJDJLFRLJJ@JAHILEJIJCBB@JBDBVBLBLBNLBPAFGJINGJ@J@FBFHFJFDBFDPJLJHFNBJ@FGPEVEDALDJFJ@L@LB@@@@BADAB@D@B@@@B@BA@@@@BAB@B@BBBBB@AB@B@B@D@@CBBB@@@B@@@B@@DBB@@BB@B@@BD@BBD@BB@@@@DF@B@@BDBBB@BBAB@@DDBBBB@@BB@@BB@@@D@@ABA@@BABA@AB@BA@@@ABABA@AD@BA@@B@@@BCD@@@@@B@@@@@D@@@@B@BBBBDB@@@D@@B@B@D@D@BBD@BA@BBB@BB@B@F@B@BBBB@@BBB@D@B@D@@@DB@@B@@@B@BD@@BB@@@@CBA@AB@@@@ABABABAB@@@B@@AD@@@B@@@B@@BB@BBB@@@B@@BB@@B@BB@@@B@@@B@@A@@B@@C@@@CBCBABC@@@AB@@AB@B@@A@CB@@ABA@AB@@A@A@@BA@A@A@A@@@AB@@@@BD@BBB@@@B@B@@BBB@@@@BBD@BB@@@@B@@CB@@AB@BBB@B@BB@AB@B@@EBAA@BA@@AA@@AAB@@B@BBA@@@@@AAAA@@C@AB@B@@AB@@@BAB@@@@AAA@C@@@A@@@A@@@@BAAC@@@AB@D@@ABE@A@A@A@@AAA@@A@AA@AAA@@AA@@E@A@@@@@AA@@@@A@C@EA@@A@ABA@A@A@AB@BCB@@AB@@@BABAB@@A@@@AAEAA@AAA@EA@@@@ABABADAB@@LB@@@D@BAD@B@B@@A@@@@BBBAB@B@BA@@DABB@@BAB@BA@BB@@DD@BF@B@D@BBDAD@@@B@BBB@BBBB@@BA@@@A@AD@@@BBBB@@@@B@AABAF@DAB@@B@BA@@@@@BBA@@@BB@@A@BB@BB@@E@AD@@B@B@BBBBA@AB@@A@@@A@ADAB@B@B@@FFA@@@BD@@AB@@@B@@@BBB@@BB@@@HAX@DAD@FDFBXBHBJ@FAHAFCFAHEIKCSDOAMNQBA@@@@VQDENI@AFAB@B@B@B@B@D@@@BB@@BAB@D@FCBAB@BAB@B@B@@@B@BAB@B@B@@@B@D@@AB@BCBADCB@@B@BB@@BB@@@BBBB@BF@@@B@BAB@@@B@RBFBRB@@@@B@B@B@BBB@B@BBB@@B@B@@B@@@D@@@D@DAB@@@BA@@B@@@BA@BDAB@@A@@DAB@B@B@B@@@@@BDB@B@DBBDBD@BBDDB@J@D@B@B@@@BB@@@BAB@B@BAB@B@BA@AB@@@BBB@D@B@@@B@BBBABBB@B@@B@@DBB@DBBB@@@BDD@@F@FBB@@B@@B@B@B@B@BA@@B@B@BB@@B@B@DBD@DBD@DBB@D@@@B@@@BCBA@@D@@@BA@C@@@@D@XCREPMFEBA@@@@DCB@DCBANKJI@@@@HEJIJERDBDPRPLHHHHBBRTBLERADQH@D@@BBBB@@@D@BA@@B@@ABA@@B@DAB@B@@@@CBA@A@CB@B@B@@AB@BGA@@A@CBA@@@AA@@@A@@C@A@CB@@AB@B@@ABAB@@A@@BA@@D@@@@CBA@A@@@ABCBABA@@B@@A@A@CBBD@B@@@B@BBB@@ABBB@@@BBB@B@@@@@BB@BA@@B@F@B@BB@B@@@B@@BB@BDB@@AB@@AB@@BB@@@@ABABBD@B@@@B@B@@@@AB@@@@ABA@@BA@@@A@AAA@@@@@ABB@@BBB@@AB@BA@A@@@@@@BABA@A@AAC@A@A@A@@A@@A@@A@@@A@@C@C@A@A@@@AB@@BB@@A@@BA@@B@BC@@BA@AB@@AB@B@@@@@B@@@BA@@B@B@B@@@B@@AAA@A@ABC@@B@@@BABC@@BAB@B@@BBBBB@@D@@@@BBB@@BB@@BB@DDB@FD@B@BBB@BB@@@BD@BB@FDBBB@B@D@B@BBB@BBBDB@BBBBBBB@BB@@B@B@BBB@BBBBDBB@@@BB@@BB@BBB@@B@D@D@BBBAB@B@B@@@BBF@B@@BB@@@BBB@BB@@BDF@BB@@@@@@B@DB@@B@@BQT@@A@CBAB@@@DAD@D@B@B@@DD@B@@@BA@@@A@A@CAA@@AA@A@A@@B@@@@@BBD@@@@B@B@@@B@@@H@@@J@H@BKDQ@@B@BAF@BADC@AB@BA@@B@B@@AA@A@@@@@BAB@@A@@B@@@@@B@@@@C@@@B@@DDD@FBBBH@@B@@BB@DA@AB@DCDA@A@@@A@@BADEFAB@D@B@@ABBDA@@D@@A@AB@@A@AB@@@@@@A@A@ABA@A@@@VBH@B@BB@BB@@B@BDBBBBBB@BDB@B@D@B@B@JDLALEJAFC@@BAB@F@B@@AB@DA@@B@@@BA@A@@BAB@AA@@@A@A@@BA@@BE@@@AA@@AAA@@AAA@AA@@C@AA@@AA@@@@@ABAA@@ACAAAA@AAAAA@AA@A@@AA@AA@@@@AAA@A@A@@@A@@@@@@B@B@@ADABABCDEDCBCBA@AA@A@@@A@@A@@AA@AAA@A@@@@AA@AA@BA@A@A@@BAAAA@AAA@AA@@@@BA@AB@@@@@@AFD@@@A@@A@B@@@B@@@FAB@B@B@B@BADBDAB@B@B@@@BABA@AD@@@@A@ABA@@@A@@AC@A@@BA@A@A@ADAB@@@@AB@@@BA@AD@@AB@@C@@DA@A@A@A@@BAB@@@AA@AB@@@@A@@@AA@@C@@BABA@@D@@A@A@A@A@A@A@@@A@CAA@@@@BAFBBA@@D@B@@@BAD@BA@@@@BC@@@ABAB@BABA@@B@BEBCD@DA@@@@B@@ABA@CBCECAA@@ACA@@C@@@AC@@AA@@AAA@A@A@A@C@@@A@@@CAA@AAAAA@EAABCBA@ABA@@BA@AA@@@BA@A@AB@@@@A@AAA@@@A@AD@DABAB@@A@C@@@A@A@@@A@A@A@A@@@AB@BABA@@BABAB@@AB@@A@A@@B@@A@@@A@A@A@@@ABAB@@AB@AA@@B@D@@@BABA@@@BDAD@BA@ADC@@DA@@BA@@@AHC@@BACA@A@C@A@@@@AAACAA@A@@@@CAAAA@@@@A@@C@CAA@AA@@A@A@@AA@@AA@A@@@C@A@@AC@A@CA@@A@A@A@@@BA@@@CA@@A@@BA@@@A@A@@AC@A@ABA@@@@@AA@@A@@@C@ABA@AAA@@BC@@@A@A@@@@C@CCA@@@A@@B@@A@A@C@@@AA@A@ABC@CAAAAA@@A@@A@@A@@@A@A@@@ABABA@C@A@CBC@@@A@@@A@A@ABA@AB@@A@@@A@A@@BA@@AA@@@AAC@AB@@@@A@A@@@A@@@A@@@A@@BA@@BBB@@@@@@AB@B@@AAAA@@AA@A@ACAA@AA@AAAC@@BA@@BABC@ABADA@@B@B@@ABA@@@ABA@@BAA@AAAA@@ACAAA@@A@@@A@@@CAAA@@@AC@A@@BA@@BA@@AAAAA@@AAA@AAA@A@@AAA@@A@@A@A@E@AACAA@@A@@AAEA@AA@BAA@AA@AACAA@@@CA@AAA@@AAAA@@C@@@A@@@ABABA@@AA@@@C@@AAAC@@A@@A@@@ACA@A@@@@CAAAA@A@A@@ACAAAA@A@@@@A@A@A@@@IAKEIIEIEIIOIOAUDUBG@IBGECEAG@GDQ@@@@@A@EAGIGKEQESISEWCa@SCUEQEY@IBKDOBGAGGGOACGKKIYMWBA@@@@@O@MCEA@@@@ICY@@BE@IBIBOGSGKMGIGEMCWEMCGCEIMK"]],encodeOffsets:[[[125840,42160]],[[126203,41710]]]}},{type:"Feature",id:"211081",properties:{name:"灯塔市",cp:[123.339312,41.426372],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@ABA@C@A@EBCB@@@@CEDACE@@@@@@ED@@GBIBDD@DBDBDFDBDB@FD\\@HBB@HDLBDBBAD@BA@CBADAFE@AB@B@B@@@D@@@D@DBB@BBB@@DB@BB@@@BB@BB@@@@BBB@BB@B@@BBB@BB@B@@@B@B@BBDBDBBBBB@@B@BBFBB@BBD@BDDBD@@AB@B@BBDBBBBB@@DB@FBDBJ@F@D@BBB@BBB@HBD@B@DBDBB@BD@@BBD@DB@@BB@@AB@BA@A@@@A@@B@@BB@BB@@B@@@B@B@BB@B@@@B@@B@@@BBB@@@@@BCD@@@BBBBBB@B@BB@@BD@B@@BDAD@@AB@@@DFBFDBBB@@BBDHHFDLHBD@BAD@B@D@BBD@A@@B@@@BB@@BB@@B@@AB@DCDA@@BA@@@@@@@@@@@@BA@@@@B@@@BBDB@@B@@@@@HCHEB@@A@@@@A@@@@@@@@A@@@@@@@@AA@@@@@@@@@A@@A@@@@@@@@A@@@@@AA@@A@@AA@@AAAAB@@@@A@@@@@AAA@@@A@@@@A@A@@@@@@@@CAC@@@A@@AA@@A@@@ABAB@AA@@A@@@AA@AA@@@@A@@AA@@@@AA@@@A@@@@AB@@@@A@A@@@@@A@@@A@A@@@@F@@@@@B@@AA@@@@@C@@@@@@CAA@EAGD@@A@A@@@A@@@ACECG@@@@@@B@@@B@@@BB@@@@B@BAB@@@B@B@@@D@@@@@AA@@B@BAB@B@BA@@B@B@B@@@B@BAB@@@@@AC@@@@@@B@@@@A@@@@@C@@A@@@A@A@@@@@A@@@@AB@@@@@@@@@@@@AAA@@@@@@B@@@B@B@@@@@@@@AA@@A@@@@@@BABA@@@A@AB@@B@@@@@@DA@@@A@C@AHAB@F@@@B@@@@@@FD@D@@@@@D@B@BAD@B@B@@@BAB@@@BBDDDD@@@@@B@@@AEA@@A@@@BB@B@BB@@@@@D@@@B@@@@@@@@DDA@@@BBDB@@@@@@AB@@@B@@@@BBB@B@@B@@@D@B@BADAD@@@@@@@B@@B@@@@BB@@B@B@@@BAFCDA@@B@@@B@@BDD@@BBB@@@@@ABCBCB@B@@AD@B@@@@@@D@@BB@@B@@B@@BB@@BB@@@BA@@B@@@@BBB@@B@B@@@@BFF@@B@BA@@@@@A@@@@@@@@@@B@@BBB@@B@B@@@B@@@B@D@F@F@@BD@@A@G@A@A@@B@H@F@B@@A@@@@A@AAAA@@@@@@B@DB@@B@@@@@@@@AA@@@@@@A@@B@BABADABB@@@@A@DA@@BACCA@@A@@@@@@FAAC@@@@@@DCA@@A@@@@@A@@@A@@@@@@F@FAB@BBB@@@B@@@@@FABA@@@@B@@@@B@@@@B@@@B@A@@A@@@A@@B@BB@@@@@BB@@@@B@@BB@@B@@A@@@@@AD@B@@@B@BAD@@@B@@B@@@BB@@@@@@@BA@A@@@@B@D@L@D@FA@@BCBCBCBA@A@@@@GABAHE@@B@@@BB@@B@F@@AB@H@BB@@FBB@@B@@@@B@B@@A@@AA@@@@@@@@A@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@A@B@@@@@BAB@BBB@@@@ABB@AB@@@@@@@@@B@@@@@@@B@@@@@B@@A@@B@@@@@@@B@@@B@@@@A@@B@@@@@B@@@@AA@@@@A@@@@@@@@@@@@@AB@@@@@B@@A@@B@@@@@B@@@BA@@BA@AB@@A@@@@B@@@@@BA@@@@B@@@@@@@@@@A@@@@B@@A@@@@@A@@@@@@B@@@B@B@@@B@B@@@@@@@@@B@@@BA@@@@@@B@@@@@@@@@B@B@@@@@B@@@@@@@@@@@@@@BA@@@@@@@@@@@AB@@@@@@@B@@@@@@@@@@B@B@@@B@@@@B@@B@@@@@@@@@A@@@@B@@@@@@@@@@@B@@@B@@@@@B@@@@B@B@@@@@@@@@B@@A@@@@@@@@@@@A@@@@B@@@@@B@B@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@@@B@@A@@B@@@B@@@B@@@B@@@@@@@B@B@@@@@BA@@@@B@@A@@BB@@B@BB@@@@@@B@@@@@@@@@@@@@BB@@B@@@@@B@@@@@B@B@@@B@@@BA@@BA@@@@@A@@@@@@@@@@A@AAC@@A@@@@@@@@@@@@@A@A@@@@@A@@B@BA@@@@@@BB@@@@@B@@B@@@BB@@@@@@B@@@@BD@B@@@B@@@@@B@@@BA@@@@B@@@B@B@@@@AB@@@B@@@@@@B@@@@@@AB@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@B@@@@@@@B@B@B@BA@AA@AA@@BA@A@C@AB@@A@@DAB@@@@AB@@ABABA@@B@@@BBB@@DB@@@B@@@B@@ABA@@BA@@B@@ABBB@@@@@@@@D@@@B@@@B@@B@@BB@@@@@@@@A@@@A@@@A@@@AB@@@@@BBB@@BBB@@BB@@@@B@@@B@BAB@B@BABA@@@@@@AAA@A@@A@@@A@@@AB@BA@@B@B@@@B@@@@A@A@@@A@A@@@AB@B@@@D@@@B@DB@@B@@@B@@@@A@@@@@AEA@@AA@@@@@A@@@@B@@AB@B@@@BBB@B@@@B@@AB@@@BA@@BA@@@@@@AA@@@@A@@A@@A@@A@@@@@ABAB@@A@@@@AA@@@A@@@@@A@@B@@@B@B@B@@BB@B@@@@AB@@@@@B@BA@A@@@@BAB@@@@@@@@@B@@@@@@B@B@@@@@B@@@@@@B@B@B@D@BA@@@@B@@B@@@@@@@BDB@@BB@AB@@A@@@@@@@@@A@@BAB@@@@@@A@@@@BA@@@B@@BB@@@@@B@@@@@@B@@@@@B@B@@@BC@DBA@@B@@@@@@@@@@@@C@@@@@@@A@@@@A@AA@@@A@@@@@@@@@@@@@@DA@@BB@@@@@@AA@@@A@@@@BBBB@@@@@@@A@@@@@@@A@@@@B@B@@@B@@@B@@@@@@@B@@@@@@@DA@@@A@B@@B@@@@@@@B@@@B@@@BB@B@@@@@A@@@@@@B@@@@@@B@@@@@@@@BA@@@@B@@@@@B@BA@@@BBBB@BB@BBB@BBBBBDFDF@@BB@@@BB@@@@@D@B@@@@@@@BB@@@AB@@@B@@@B@@B@@@@@DBB@BB@B@@@B@B@BABAD@B@B@@@B@@B@@D@@@D@B@@@BA@@@@@AB@@@B@@A@@@@@@@@B@@B@@BBBBBBBB@@B@@@@@@B@@@@@B@@B@@B@@B@@@@@BB@@@@@@@@@@BB@@BB@B@@@@B@@@B@BAB@B@@@B@@@B@@A@@@A@@B@@@B@@@@A@@B@@@@@@AB@@@@A@@B@@@B@@@@@@A@ABA@@@@B@@@@A@@B@@@@@B@@@@@@@B@@@B@@@BA@@@@@@@B@@B@B@@B@@@@BB@@@@@@B@B@@@B@@@@@B@@@@@B@@B@@B@BBB@DBD@@@BB@@B@@BB@@B@@B@@@B@@@@B@@@BB@@@@@B@D@@BB@@@@@B@@B@@B@BBB@@BB@B@@BB@@B@@B@B@@BB@@@@@B@@A@@@@B@@A@@@@B@@@@@@@@AB@@@B@BAB@B@B@@@BAB@@@@@@@BA@@@@@@@@@AA@@A@@@AB@B@@ABBB@B@@@@A@@@A@@@A@@@@B@BBB@@@DA@@@@@@@AAA@@@A@C@ABA@@B@HGDE@E@EHA^EVENCFCDCDCBCBA@E@C@C@A@EKKII@@@@CA@@@@AC@@@@A@AC@@@@AA@@@@IKFSJIHSTICA@@@A@@@@@@AA@@@@@@A@@A@@B@@@B@@@@A@@@A@@@A@@@@@@@AA@@@@A@@@@@@B@BA@@@@BA@@@A@@@@@AB@@A@@BA@@B@@@@@@A@@@@AA@A@@@A@@@@@@@@@AAAA@@@@@@@BA@@@A@@@@AA@A@@@@@A@@@@@@@A@@@@BA@C@@@AB@@@@@AA@@@A@@@@@@@AA@@@@A@@@@@@B@@@@A@@@@@@@@@A@@@A@@@ABA@AB@@@@@@@B@@AB@B@@@@A@@A@@A@@@A@@@AA@@@@A@@@@@A@@@AB@@@@@AA@@@@AA@@@@@AA@@@@@@@@A@@@@@C@@@@@A@@@A@@@@@A@@@@@@@@A@@A@@@@@@@A@@@@@@BA@@@@B@@A@@@@B@@@@@@@B@@@@A@@B@@@@A@@@@@A@BB@B@@@B@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@B@@@@@@A@@@@@A@@@@@@@A@@B@@A@@@@@@@@@A@@@@@@@A@@@@A@@@@A@@@@A@@@@A@@@@AA@@@@@@@@@@@A@@@@B@@@@@@AB@@@A@@A@@@@@@@@@A@@@@A@@@@A@@@@B@@AA@@@@A@@@@@@@@B@@A@@@@BA@@@A@@@@@AA@@@@@A@@@@A@@@@@AA@@@@AA@@@A@AAA@@@@@@A@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@@BA@@@@@@@A@@@@@@@A@@@A@@B@@A@@@@B@@@B@@@@AB@@@BAB@BA@@@@@EBCBI@C@EBAB@B@BAB@B@BA@@@@@@@@@A@@@AAA@AAA@AA@@@AA@@A@@@@AA@@@CA@@A@AAA@@@AA@@A@@@A@@AA@A@@@@@A@@@@AA@@@A@A@@AAA@@A@@@AA@@@@@@A@@@@@@@AA@B@@@BICMEWA@@@@IB@@@@CD@BA@@BAD@@@D@@BB@B@@AF@@AB@@@B@B@@BBA@AB@@@BAB@@A@@@CBA@@BA@E@A@AB@@EDIBKFKBICA@A@C@A@A@ACA@AAAACA@A@AA@@AAAA@G@UA@@B@B@BAB@B@@@@@@@BAB@@@BAB@@@@CB@ACBA@@@A@CBAFEBC@AB@@@B@B@DC@CBAB@@CAA@@@AG@AAEAC@CC@@@A@@@D@@A@@@@@A@@@@BA@AB@@@@B@B@@BA@A@@@ABA@@BCDABE@ABA@@@CRALG@I@@@G@@@A@@@A@A@@@@@AC@A@@A@@BAB@BA@A@@@@BBB@FBDCDFF@D@HDB@DFDJDCDODFNAFBDADGBCDM@IAK@AABCC@EEI@WAQAYBKCIA[EMF@JKVMDAJ@DA@AB@B@B@D@B@BA@A@A@@@@B@B@B@DBBB@BBD@H@@@BBBB@BABCBA@GBCBAACAAAA@@DCB@BA@CD@DABA@ABA@GBA@@BA@@BBB@BA@AB@@@@@B@B@BBBDBDBA@@BC@A@A@@@@BDB@BBBDBFDDBDB@B@B@BABC@ABABBB@@ABA@A@A@C@CBA@A@AAC@G@G@A@C@C@A@ABGBEDCAA@A@EBA@AA@A@E@AA@A@@BCDABABCBOA[AACA@C@A@A@@BEAGAEAAAA@AA@AAA@ABEDCDCB@BABAAA@ACCCCCCCAC@C@@@@CI@ACA@G@C@AAAA@@AAA@C@E@AA@@]@GB@A@AA@@A@AA@@A@@A@@A@@@@AA@@@@AA@@@@@@@@A@@@@@@@A@@@@@A@C@A@@AA@A@@@@@A@@@A@@@A@@@A@@AA@@@A@@@A@@@A@A@@@A@@@A@A@@@A@@@A@@@A@@A@AB@@@@A@@@A@@@A@@@@@A@@@AA@@A@@@A@@AA@A@@@A@A@AA@@@C@A@A@A@@B@@@@@@@@@@CB@@A@@B@DCBGFEBEBEAEBSFOFC@CBA@ICKC@@C@IBGDCBGFMLAB@@CBIFMHKHBDDD@B@BAB@BAB@DBDBBBBDDDDBBBBBB@F@B@@@@@BBBD@D@D@D@DBD@JBFB@B@B@B@D@H@BBBBBB@FAHAFBH@HBDBBD@B@BAFABABA@C@CBABABA@CAA@AAA@@B@B@B@BAB@BC@A@AAC@ABC@C@A@C@CBCBABC@@@AAAACAAAAB@@@BABCB@@ABA@G@U@A@A@AB@DABA@CAA@@@AA@C@A@@AAE@C@EBCBC@EBE@A@AA@A@AAAC@A@A@AACCECC@A@E@A@C@C@E@E@GCECAACAC@AAE@E@IAECICICKECAC@CAAAAAAA@@@CBABCFCDABA@A@AAA@AA@C@EBG@CAA@IC@CDGAGACAA@@C@C@KFI@@F@B@@AB@@AB@@C@AAAACCIGCACACAAA@BCLA@C@A@@JGBAPBDABEB@@A@A@A@A@C@A@C@@A@@AA@@@AC@AAAAC@@@@AABAB@@@B@@@@A@@@C@@@A@A@A@@@@@BB@@BB@@DFBD@D@@@D@B@BADBB@FAD@BADAF@BCD@@AB@B@@ADA@AD@@@BBF@B@B@B@@@BABABB@BBF@DAB@FA@@BB@@KBA@KDUD@@ABBBFFBBHFBBDB@@BBBDDFA@IAEBABAB@@AB@@BBDD^MFJFHA@DDCBAEEDFHCDDFIDBD@B@D@D@FDDBBDDD@LBB@BA@@@@@@GAAAEEAAFC@BDA@@@@@A@@^MBD@@@D@DA@@B@@BB@@DA@@BBDCBBB@@B@@FBLD@@@BDF@@@@AB@@BFABCB@@@@B@FF@BB@@@DH"],encodeOffsets:[[125934,42493]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
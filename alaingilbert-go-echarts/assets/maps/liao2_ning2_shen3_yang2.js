@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("沈阳",{type:"FeatureCollection",features:[{type:"Feature",id:"210102",properties:{name:"和平区",cp:[123.420368,41.789833],childNum:1},geometry:{type:"Polygon",coordinates:["@@GEAAEAC@GA@AACEA@AA@@@AAAAAA@@@@A@@@E@@A@@A@@@@A@@@@@AA@@A@@@@@A@@A@@A@@KA@B@@@@@@@@@@A@@@A@@@@@AA@@@@A@A@@@AA@@CB@@@@@@A@@A@@@@@@@@@@@A@AA@A@@@@@A@@@@@@B@@@@@@@@A@@@@@A@@@@@C@@@@@AB@AA@@@@@@@@@AD@B@@@@@@@@@B@@@@A@A@@BCBABA@@@A@A@A@AB@BC@@@A@@ABC@@@@@A@@BK@@@@@AA@@A@@@A@@AAAC@@@@EI@@@AA@NEF@FAB@BAD@BAEEBA@AB@B@BAB@BA@@@B@@@@B@@BAB@@DDBB@BA@BBBBABBFFBB@BECCDAAE@@@@@A@@LAFAHAAA@A@@@@@@@@A@CB@@AB@@AA@@@@AA@@@@@AAB@A@@@@@@@@@AA@@@@@AB@@@@A@@@@AAB@@A@A@A@@@A@@B@@AB@@A@A@A@@AA@@A@@AA@@A@@@@@C@@@@@@@AACEEE@@@@@@GBCBCB@@A@@@@@CE@B@@A@@@@@C@ABA@IDC@@@@B@@@@A@GBABA@B@FN@@@@@B@BA@@B@@@@@B@@@@A@@@@@@B@@@BABCBC@CB@@A@AA@@@@@A@@BABA@@BA@@@@B@@@@A@@AEAA@@@A@@B@@@@@B@@A@@@@@@@@@AA@@AA@@@@@A@@AA@@@A@@@@@@@A@@@@A@C@A@@A@CAA@C@A@ABCBCDA@AB@A@@@A@AAA@AA@@@@@A@CB@@@@@BB@@@A@@BA@@@A@CC@A@@A@GD@@AAAD@BDBBDC@@@@@@BFFHHBB@B@@CBFFC@@@BB@@D@B@AB@B@BB@@B@@ABABNFDDHH@@HLFH@@DDDDDDDBD@HB^DA@@D@@@B@@@@@@AF@@@B@@@@@B@@@@@B@B@@@@@BB@@B@BBB@@@B@@@@@@B@@DBB@B@B@@B@@BBD@BBB@@@DBB@@BD@B@@BB@@@@@BB@@BDD@@BD@@B@@@BDBD@@BB@B@@BBBB@@@@@@@BDDBB@@@@@@BB@B@@B@@BB@@BBBBB@@@@@B@@B@@B@@@@BB@@@@@@BB@@B@@B@@B@DD@@B@@@@@BBB@BB@@@@@@B@BBB@@@@@@@@@B@B@@BB@@@@@B@B@BB@@@@@@B@B@B@B@@BB@@@@@@@@@B@D@@B@A@A@A@@BA@C@A@@@@@@@A@@@AAA@@AA@A@AACAEAGAA@CA@@A@@@@@@BC@@BA@C@@BC@@BCBC@@@A@@@A@A@A@BB@@@@@F@D@D@@@AK@C@@@E@@@C@@@@@@@A@@@@@@@C@A@A@A@@@ADE@@DC@A@@B@BAJG"],encodeOffsets:[[126409,42751]]}},{type:"Feature",id:"210103",properties:{name:"沈河区",cp:[123.458691,41.796177],childNum:1},geometry:{type:"Polygon",coordinates:["@@EICCEAGC[CIAEAK@[BE@C@C@SIA@IHABA@@@@BCD@@CF@B@@@B@B@B@D@@@@@@@B@@@@@@@D@@@F@@@DBL@@C@C@E@@@@@A@@A@B@B@B@@@B@@ADAD@@AD@@@DAB@@AD@@@@@@@BB@@DBBBHBFBD@B@BBB@@BB@B@@@B@@@@@@@B@DAB@@@B@B@B@@B@DBB@@@@@B@DB@@FBFB@@B@@@@@@@BB@@B@@@@@B@@@@@B@BBB@B@@@FBB@DB@@@BBAC@AA@@@AAA@A@@@A@@AA@A@A@@@@@ABA@C@@@A@CAA@@ACAAAC@@J@@@F@H@@@B@F@@A@E@@@G@A@E@AB@H@@@BC@@DC@ABA@AB@@@@A@@B@@@@@@@BA@BB@@@DBBBB@@B@@B@@@@@@BB@@@@@@@@C@A@@@A@@F@B@D@AOF@@@D@BBB@B@BBB@BB@@@@@@@@B@@@@B@@@@@@B@@@@@@@BBB@@B@@B@@BB@BB@@B@@BB@@@@@BB@@@@B@@AB@@@BA@@DA@@@DBD@BBBBBDBCDBB@B@@BB@@@BB@B@BDAB@@@@@B@@@@AB@@@@ABA@A@@B@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@BB@B@B@@@B@B@@@@@@@B@@FA@BAB@@CDCD@@CDABB@FBBBD@B@B@@@BBD@B@B@@@@@@@BB@@@@B@@BBBB@BB@@ABCBCB@DDBD@@BB@DBHBDBB@DBB@B@B@BBB@F@DBF@DBB@D@BBB@@@B@DBDBJHDGBCBCBGBCBEB@@A@A@A@A@C@ACE@CAA@@@A@@@AB@@A@@BA@@@ADA@@BABAGEECIEIIIGCAA@AAIASEMEGAECCAAAGEAA@@@A@C@AAAEK"],encodeOffsets:[[126481,42769]]}},{type:"Feature",id:"210104",properties:{name:"大东区",cp:[123.469948,41.805137],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@A@@@@A@@@@AA@AACA@@A@@AAB@@@@@@A@@@@B@@A@@BAB@BCD@@AD@@G@A@@B@F@B@H@@@F@BE@A@@@G@E@@@I@@@BDBBBD@@BB@D@B@@@DAB@B@@@@@B@BBB@@@B@@@BBB@B@@BBD@ABB@B@BBBBB@BBBBBB@BB@@@@@BBBB@B@@BB@@@B@@BB@@@B@@@@@BBB@@@@@B@BBB@B@B@@BD@B@BBB@@@@@@@B@@@BBD@B@B@@@@@@BB@DBB@B@B@B@B@@@DBD@B@B@@@B@D@FBB@@@@@B@@@F@DBB@B@B@DBD@@@@@B@@BDFHDH@@BD@BDFDHHLBEB@@@@@B@@@@B@@@@CF@@@@DEB@@B@@@B@@B@@@B@@@@@B@@@@@@@@BBBB@@B@@@@@@@@BDB@@@@@B@@@@B@@B@AAB@@A@@AAAA@@@@@@DAB@@@@@@@BBBD@@BB@@@@@@BB@@@@@@DABBDF@@BB@@BB@B@@@BBB@@@@@@@BBJBD@BB@@BBFDH@@@@D@D@F@B@F@D@L@H@N@D@D@B@B@D@B@B@B@@@BB@A@@@AA@@@@@AA@@@@@@@@@@@A@@@@@AA@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@A@@A@@A@@@@@@@@@@@@AAA@@@@@@@@@@@@@@@A@@@@@@@AA@@@@@@@@@@@@@@@A@@@@A@@@@A@@@@@@@@@B@@@@AA@@@@@@@@@A@@A@@@A@@@A@@@A@A@@AA@@@@@@@@@AA@@@@@@@A@@@@@@@@@AB@@@@AAA@@@A@@@@@A@A@@@@@AB@@@B@BAB@B@B@@AB@@@@@@@@@AA@A@@A@@A@@@AA@@@@@@@@A@@A@@@A@@@@@@@@@@@@@BABA@@@@@@@@@@BA@@@@@@B@@B@@B@BA@@@@@A@@@@B@@A@@B@@@@@@AB@@@B@@@BA@@BA@@@@@@@@AA@AA@@@@AA@AA@@@A@@@@B@@@@@@@@@DA@AB@@@AA@@B@@@@AABA@C@A@A@@@@A@@AA@@A@@@@@A@@@@BA@@@@@AAA@AA@A@@@@@@B@B@@@@@@@@AB@@@B@@@@@@@AA@@@@@@@@A@@AB@@@@@B@@@BAB@@@B@BAB@B@@@BAAA@@@@A@@@AAA@@A@@BA@A@BB@@@@@@@@AB@@@@A@@A@@@@@@A@@@@@AB@@@BAB@@AB@BABCBABABADCBA@AB@@A@@@A@@@A@A@C@C@C@A@A@@@A@A@A@ABABCBC@AIGCACAA@@@A@AAC@A@CAE@CAE@A@AAA@A@A@CAA@CAGACAA@@AC@CA@CDADABA@@AAA@AA@AA@@@@@AA@@@@@@A@A@C@AA@@A@A@C@AAEAA@BADC@@DCDC@@BA@AEB@@@A@@@@@@@A@A@@@A@AAA@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@AB@B@BA@@@@BA@@@@@A@@@@BAACA@A@@A@@AA@@@AAADCCAAAAA@AAC@C@@CB@@AB@@A@@BA@@@@@AA@@@@A@@AA@@@AAA@@AA@@@@AA@AA@@@@@@A@@@@@@@@A@@A@@@@@@@@@AAA@AAA@A@AAC@@@E@BPC@A@E@@@@B@@@B@D@@@@"],encodeOffsets:[[126447,42792]]}},{type:"Feature",id:"210105",properties:{name:"皇姑区",cp:[123.442378,41.824516],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@AB@@@@@B@@A@@@@@A@CBA@A@@@EBABA@A@A@CB@@A@@@C@@@ABGBC@@@EBA@@@A@@BG@ABG@@BA@A@A@A@@B@@@@A@@@A@@B@@A@AB@@@@@B@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@ABBBBB@@@B@@@BD@@@@@@@@@@B@B@@@B@@@@@@@@B@B@BA@@BABABA@@B@@BBB@@@@@@BBABABCBA@@BAB@@@@@@BDA@@B@@@@@@@BA@@@@@ABA@A@A@A@A@@@BB@@BBB@BBB@@@DBBBNDHDBAB@BA@@@@@@B@HBBBB@BABAFIB@@@@AB@AA@A@@@@@AB@@@B@@C@A@A@ABC@@B@@BB@@@B@D@@@B@B@B@@AB@@DD@@A@@C@@AB@BA@@@@B@@@B@F@@@B@B@@@BBB@@B@@BBB@@@@B@@@@DBB@@@@@HD@BB@@@@B@@@BA@A@CAA@@@A@A@@@@@AA@@A@@BAF@B@@@@@B@B@@@@@@@@@B@@@@@B@@ABB@BBB@B@@@@@B@@@@@@@@B@@@@@@@@@@@@JBAD@@@@@@@@@B@@@@@B@D@D@B@BAD@@@B@D@@@D@B@F@FAB@B@F@BF@@@@B@@@@@B@BABABA@@BA@@B@@@BIAAAEBE@ABEBA@A@AB@@C@A@@@A@@@@A@@@A@@@@A@@@@@O@E@@@C@@@@@A@@@@@@@A@@@@@@@BB@@@B@J@@@BA@@AA@AAA@@A@@A@@@@BB@@@A@@@@@@@@BA@@B@@@@@@@@B@@B@@FLB@FAHCJC@@B@@BDD@@B@@@@@BA@@@@@@@FD@FAF@HAH@BABBCDFAB@B@BA@@@@@@B@@@@@@@B@@A@@@@B@@@@AAC@A@@@@@@@@@@B@@@BH@@@@@@BAB@@@@@@BBB@@@@B@H@B@H@F@@@@@@B@@@B@@BB@@@@B@@@@@@@@@@CAA@@B@@@BAB@B@@@@@BA@A@@B@FAB@@@@@BA@@@A@@B@@@B@@@B@@@BAB@D@B@@@@@@@B@@B@B@@@@B@@@B@B@D@@@@@@@B@@@@@@@B@@@@@@BB@@@@B@@B@BAB@BB@@@@@@@BBB@@@@B@D@FCBB@AAC@A@@@@@@BAB@@@B@B@DA@@@@B@@@AA@A@A@@@@@BBB@@@@@@@BBB@@@B@@@@B@@@@B@A@@@@@AAA@@B@BDB@@@@BB@BB@AAC@@CGEGAC@@@A@@@@AC@C@A@AAA@C@E@@@A@@@@AA@E@C@A@@@A@AAC@C@@@A@A@A@AAA@CAA@@@@@@@A@AAC@A@@@A@@@@@@AA@A@AAC@@@A@AAA@A@A@@@@AA@A@@@@@A@@AA@@@A@@AA@@@AAAAA@@@@A@@AAAAAAAA@AAAAA@A@@A@@CAA@EA@@A@A@AAA@@@@@A@@@@@A@@@AA@@@@@@A@@@EAEA@@CAA@@@@@A@CAA@@@@AC@A@@@@@@@@@A@@AA@A@A@A@@@@@@@AAA@A@@@@@A@@AA@A@@@@@@@@@A@AAA@@@@@@@AAA@AA@@@@A@@@CCA@@@@AA@@@AA@@@@@@AA@@@@A@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@BA@@B@@@B@@@@"],encodeOffsets:[[126363,42815]]}},{type:"Feature",id:"210106",properties:{name:"铁西区",cp:[123.333968,41.820807],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@ABCBCB@@@@@@AA@@@@@@AA@AAAAA@ICEAIAOCE@@A@@BAB@@A@@@AB@@AB@@@BA@@@AA@@@C@A@AA@@@A@@AAAAAA@AAC@CAA@ACAABC@EFA@@B@@@@@B@@@B@@@B@@A@A@A@@@AACA@@@A@@@@BA@A@AB@@AB@B@@A@@BA@A@@AC@A@C@ABC@AACKGECGGAC@AA@AAECEA@C@@BA@@BCAC@@@AAC@@AAA@A@AAAA@A@@DC@A@@@@AA@A@@@AA@@@A@A@@A@A@A@@@AA@@AAA@@@AB@@@B@B@@ABA@@AA@@CAC@AA@@ACA@CACAA@C@GAA@AAA@AAC@E@I@CAEAA@@CA@AAAAAC@A@ABA@@ACCC@AAC@AAAAE@A@AA@AAAAACAC@A@A@A@@@AAAA@AA@@@AAAA@AA@@@@AAA@@A@@AAA@@CA@AAA@CAC@@@C@@@A@A@A@@BEFCBAB@DABC@ABCAKAGCA@GA[@DFHBBB@P@JBj@F@Jg@YLQHqLUEBDR\\HL@NBFDnLD\\JVHoTeMGHpNTCVClZp^fA@@@@@BBBB@@@@ADAB@B@@@@@@@@@@BA@@@@B@@@@@DDBAB@@@@@BB@@@@A@@@@@@BBBBA@@@@@@B@@B@@@@@@@B@@@@@BDFBB@@@B@@B@@@@@@@@@@A@@AA@@@@@@B@@@@@@@@BBB@@BB@B@@B@BB@B@@@@BB@@@@@@@@@@C@A@A@@@@@@@BFBB@B@BBB@@@@A@@@CBA@A@@@AB@@B@BB@@@@@@@@BA@B@@B@@@@@AB@@@@DBCD@BA@AB@@A@@B@@A@ABABCBABA@@@@@@BAB@@@@BB@@BBB@DBDBB@D@H@F@B@B@H@@@B@B@B@F@B@DBB@B@B@@AB@B@@@@@B@@B@@B@LAD@B@BD@@@@@@DABABAFAB@F@H@D@@@B@@@B@D@D@BD@B@@B@B@BADA@@@@F@D@ABDDB@B@B@@@@@BA@@@@B@@@@@@A@@B@@@@A@@@@@@BA@@D@@@@@@@@@@AA@@A@@AA@@@@@A@@@A@@@@DC@A@@@@BA@@@BB@B@@@D@B@D@FAB@@@B@BD@@@@@@B@@@@A@@H@BD@BB@@@@@BA@@B@@@@@B@BABAB@B@@AB@BBD@@@@@@@@@@B@@@@JHFF@@@@@@A@@@A@@@@B@@FB@B@@@@A@CBAB@@A@@@@B@@@@@@@BDDBB@@@BA@@BA@@@@B@@@@@BB@@B@B@B@@@@@@B@@A@@B@@@@@@@A@@@@@@@@@@@@@BAA@@C@@B@B@B@F@@@BA@@@@@@D@B@@@@B@@@@@@A@A@@B@@@@@@@BBB@@@@@@D@B@@@@@@@@B@BBBBDDF@@@@@@A@@@@@@@AD@@@@@@BBB@@@B@@@D@@@@@@D@@@@@@A@@A@@A@@@A@A@A@AB@@EBFDDBFBDBDBDBDBB@DBJBFBH@B@BBFBB@B@B@DBB@B@B@@DB@D@B@@@@DD@@BB@@BB@@@D@D@HA@@@B@@BD@B@@@@D@@@@BB@@B@@@@BBB@@@BBDA@@B@@@@DBBFDFBB@BBD@BBB@B@DBD@B@B@D@F@JBF@D@D@B@B@@@@@BAB@@@B@@A@AAA@A@A@@@A@@@@@A@@@A@A@@@@BABCBA@A@ABA@@D@@@@@@@@@D@B@@@@@@@@@H@H@D@B@J@D@FAB@F@@@@@D@B@B@DAB@@@B@B@B@B@BAB@B@@@B@@@@@@@@@AB@@A@@B@BA@@B@@@@@B@@@B@B@B@@@@@D@B@@@@@@@B@@@@@F@B@@@@@D@B@@@D@B@B@B@@@B@D@B@D@B@D@@@B@D@B@@@B@@@D@@@B@B@B@@@B@D@@@F@D@F@B@@@@@B@BB@B@@@BBB@B@D@DBD@F@D@D@B@@@B@B@@AB@BABA@@B@@@@@@@B@FELE@@@AB@B@BA@@@@@@BAB@@@@AB@@@B@@@@@@AB@B@B@B@@AH@BAH@@AB@@@B@FA@@D@HABA@@D@@@B@@@DAB@B@B@BAFA@@B@B@DAB@@@@@B@@@@A@@@@BA@@@@@@@@@@@A@@@AB@@A@@@@@@@@@@@@@A@@@@@@@@@A@@@@B@@AA@@@@A@@@@AAAA@AA@@AA@@@@AAA@@@@@@AACC@A@@@@@@AAAA@@@AAA@@ACAC@@A@@@AC@@CC@AA@@A@@@@AA@@@AAC@@AA@C@@AA@AAC@AA@@@@A@AAA@CA@@@@@@@@A@@AA@A@AA@@@@@@B@@@@@@@@@B@@@@A@@@@BA@@@@@A@@@A@A@A@@BA@@@@@C@C@CBA@@@C@@@A@A@ABA@@@A@@@A@@@ABA@A@@@ABC@@BEBC@@@CB@@CB@@A@CBCBGBA@@@A@ACCC@@A@@A@@A@A@@@CA@@AA@@A@@@AAEC@@@@CA@@CAA@@@A@C@@@AAC@A@@@EAE@A@A@@@AA@@AAA@A@@@@@@@A@A@CA@C@@BA@@@@BC@A@ABABA@@@ABADEDEBABE@@DC@ADE@@BCBCBA@ABEDCBE@@BECAK@QDIBEBG@A@A@AA@@@ABCBEHGHIDG@@@EAACCE@MASEG@KBMDMF@@CBG@CACC@A@ACCC@@@@@AC@@AA@@@@AAA@@@@@@@@A@@A@@@@@@@A@@@@A@@@A@@AAEBCBE@C@C@A@CA@@A@A@@@A@@A@@AA@AACAIAAACAA@C@A@A@A@@@@A@A@C@@@A@@@@@@AB@BAA@@@@@AB@@@A@@@@B@D@@@@A@@@@B@@A@@@@@@@@A@@@CBA@A@@@C@A@CBABCAAAA@AAA@A@@@A@EAC@CAA@@A@@AA@@@A@@@@BAB@@AB@B@B@B@@@@A@A@A@@@@@ABABA@@@@@AA@@A@@A@@@@A@@A@A@C@@@CBA@@@ABA@@BAB@DA@@B@@@@AA@@@@@@A@@@@BAB@@@@B@@@@@@@@BABAFABABA@@@A@A@A@A@EAAAAAAA"],encodeOffsets:[[126106,42655]]}},{type:"Feature",id:"210111",properties:{name:"苏家屯区",cp:[123.344062,41.664757],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@@@@@@@@A@@@@AA@@@A@@AB@@@@@B@@@@A@AB@@@B@@@@@@@@@BA@@BAA@@CA@A@@@@@@@AA@@@@@ABC@A@A@A@@@@@@@@A@@@@@A@A@@@@A@@@@@@@@@A@AB@@@@@BABA@@@@@A@@B@@A@A@@AA@A@A@@@A@@@@B@@@@@B@@BB@@@@@BA@AB@B@@@@@BB@@@@BB@@@@@BB@@@@@@AB@@AB@@A@@BA@@@A@A@AA@@A@A@@BA@@@@@@B@@@@BB@@FB@B@@@@@B@@A@@@A@@@CAA@@@C@@@A@A@@B@@@B@B@@@B@B@@A@@@A@A@@@ABA@@B@@@B@@@BB@B@BB@@@@@@ABABA@A@ABA@@@A@@@@@AA@@AA@AA@AA@@@@A@@B@@@B@@@B@@@B@@@@@@A@@AA@@@@A@@@A@@@C@@@@@@A@AA@BA@@@AB@@AB@BA@@@A@@@A@@CA@AA@@A@@@ABAB@BA@@B@@A@CB@@@BA@@B@D@BAB@@BBB@@BABA@A@A@@@@@@@A@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@A@@@@A@@@A@@B@@A@A@@@A@@@@@AB@@A@@@@@A@@@A@C@@A@@A@@@@@@@AA@@A@@@@A@@@@AA@@@@@@ABA@@@@B@@@@@B@B@@@@@@@@@@@@@BD@BBB@@@@@@@@@@@@B@@@@AB@@AB@@A@@@A@A@@@@@A@@@@@A@@@AA@@@@@@@@@@@@A@@@@@@@AAA@@@AA@@@BA@@@@@AB@@@@A@A@@@@@@@A@@@A@@@A@@@A@@@@BA@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@A@A@@@@@A@@@@@@B@@@@@@@@@@@BA@@@@@@@@@A@A@@@@@@A@@@@@A@@@A@@@@@@@@@@@A@@B@@@@@@@@@A@@@@A@@A@@@A@A@@@@@@@@@@@@A@@@@@@BA@@@@@@@@@@B@@A@@@@@@@@@@@@A@@@@@A@A@@@@@@@@@A@@@@@@@AB@@A@@@@@@@@@A@A@@@A@A@@@A@@@@@@@@B@@@@@BA@@@@@@B@@@@@@@@A@@@@@AB@@@@A@@@@@@BA@@BAB@@AB@@A@@@@@A@@@@BA@@@@@A@@B@@@@@@@@@@@@@B@@B@@B@@A@@@@@A@@@@B@@A@@@A@@@@@@@A@@@@BA@@@@@A@@@@@@@A@@@@@@@@@A@@BAA@B@@A@AAA@AB@@@@A@B@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@BB@@@BA@A@@@@@@AA@EA@@AAG@A@@BE@A@@@AA@@A@@@GFABHB@@@@@BABADADAD@@EBC@K@C@A@@@@@@BAB@@@@@@A@@A@@@AA@@@C@ABA@@@A@C@@B@@@@@BA@@@AA@@@A@@A@@A@@@@AAA@@@@B@@@BB@A@@@A@@@@@@A@@A@@@@@ABEB@@@@A@@@A@AAA@EBE@@@@@@@@B@@@B@@@@@BB@CD@@@@@@BDEB@@@@@@@BB@DDAB@@CBB@@@@@AACBABABA@@@@B@@@@B@@B@@@@@@A@@@CAA@@@@@@@BBBBB@@@@@@BA@E@G@A@@@@B@B@H@BC@@AE@E@C@A@@@A@@@A@A@@@AA@AA@@@@@@@@@@@@B@@@@ABA@@@EE@A@@A@A@@@AA@A@@A@@@AB@@A@@AA@@AA@@@@AA@@AC@@@@@@@@ABC@@@ADADABA@@@@A@AA@@CC@AA@@@A@@@CBEDAB@@A@A@@@AA@@@@@AA@@@@@@@C@CBABA@C@@@A@@@@AAA@A@@A@@@A@@B@@@@A@AC@A@@CB@C@@@@@@A@@@C@@@@@A@@A@AAA@@B@@@FB@B@@@A@@@@CCCCAA@@A@AB@@A@A@C@ABA@C@@@@@C@C@@E@@@@A@@@E@A@GB@B@D@B@@CB@@@@@@@AA@@B@B@@ABAB@@@@@@@BB@@B@@@@@@A@A@@@A@@@@@@@BB@B@@@@@@@@@@A@@B@@B@@@@@B@B@@@B@@@@D@@@@@B@@A@@@@@@@BD@@@@A@ABA@@@A@A@A@@@ABA@A@ABA@@@BB@@@@C@@@A@A@@@A@ABA@@@@@AA@@A@@@A@@@@@@@DHDF@B@@@B@@@B@BC@BH@FBB@D@@@@D@@@@@B@@BA@@@@@E@@@@@@B@B@@@B@@@@@B@B@@A@@B@@B@@@B@@B@@B@@BB@@@@@BBB@@B@@@BB@@BBABA@@B@@@BB@@@B@@BD@D@@@@@@B@B@@@@@@B@@BB@B@@@@@B@@A@BBBB@@BB@@@BB@@B@@@@@B@@@@@@B@@@@B@@@@@@@@BB@@@@@@@@@B@@@@@@B@@@@@@BA@GFGD@@@@A@@@CAAA@@A@@@@@AB@@@@@@@@@@@@AB@@CBCDA@@BA@@@AA@@AA@@A@@@@B@@@BAB@@@BA@A@@BA@@B@BAB@@@@@B@@DBBB@@B@B@B@@@@A@@@A@@@A@@@@@AB@FED@BADB@BBB@DBD@BBBBBBB@@@B@@BBB@D@@@B@@B@@AB@@A@@BA@@B@@@BA@AB@@@BF@PDJBFBJDB@BBBBB@@B@@@@B@@B@@@@A@ADAD@B@B@BBBBBBBFBB@B@B@B@@@B@BABABEBA@A@@@@@@A@@@@@BA@A@@B@@@@@@@BB@@@@@AB@@CBA@AB@BA@@B@DA@@D@B@B@@@@B@@B@@@@BB@@B@@@@ABAB@B@@@@@B@B@B@@A@A@A@A@@BA@AB@@@@@B@@BB@@@BB@DBD@FBB@@@B@B@BBB@BBDBBADAB@D@@@B@B@DA@@B@@@@@@@@@@BA@@@@@@B@@C@A@@@@@@B@@BA@@@@B@ABA@@B@@@@B@@@D@B@B@@@@@@B@B@B@DBBBDBBBJBD@BBB@@@BB@@@B@B@@@DBB@D@D@F@DAFABB@@@B@@@B@@B@@@@@@@B@@@@B@@@@@@B@BB@@@@BB@@BD@@@@D@DD@B@BDDDBH@DA@@NENCLAH@TFNBF@DDBB@F@@CHGJGHAFAD@B@@BBB@B@H@FAJARCL@DBTDNFFBBABA@@@AA@@A@ABAA@C@@@AA@@D@EEDA@@@AAAGGEE@A@@@@D@ACCA@ABCBB@@HCB@@@@BDDB@@@B@@AB@@@A@@A@@@@DAB@@@@@B@@BBB@B@B@@@BBAB@DCDABAB@D@B@DBB@@@@B@D@B@@B@@@@@@@B@@@B@@BB@@@@@B@@BB@@B@@@@@@@@@BA@@@@@A@@@@B@@BBBF@@@B@@A@@@@@AB@@ABAB@@@B@@@@BBB@@@DAD@DABA@A@@@A@@@@B@@@@@@A@@@@@AB@@A@A@@@@EMA@B@BAHAB@@@@@@A@@D@JCB@BAD@@@@@B@@@@ADF@@@@B@@@DADAHA@@@@@@FFDFBB@@@@@@D@@@@@B@@@BB@@@BB@@BB@B@B@@@BA@@@AB@@@B@B@B@@@BA@@@A@ABA@@@AAA@@A@@@@@A@@@@AA@@@A@ACB@@A@@CC@@@@BA@BB@@@B@@@AAAE@@@A@@@@@A@@CEAC@@AEAA@A@@A@ABA@@@A@@A@@@@A@@@AA@@AAAAAC@@AA@@@@@@@@@A@@A@@@@@C@EAA@@@AA@@@@@A@AA@@A@@@@@AB@@@B@@@B@AAAC@@@@C@@@A@@@@@@@A@CE@@@@@@B@@A@@@@@@@@@CAA@AAA@@@@B@DAPCJC@@@AB@@@BAD@DAB@BBB@@@BA@@CG@@AAB@B@@@@@B@@A@@@@@@BAB@B@@@B@@@@BA@@@BB@@B@@@B@@@@@@ABB@@BB@BB@@B@@B@FAFA@@B@@BB@@@D@B@@@@@B@@@B@FADA@@@@@A@@@A@@@AB@@@@@@B@@B@BD@B@@B@@@@@@@B@@@@@B@@@@@@@@@@B@@@@D@B@B@BA@@BBB@BAB@@@B@B@B@D@B@B@BB@@B@@@B@@@B@BA@@B@@A@A@@@@B@B@@@@A@@@A@@B@@@@@@@BB@@B@B@B@@A@@@@@A@@@@BA@@B@@@BA@@@M@A@ABA@@B@BAB@B@@@B@@A@@@AB@D@BAB@@@@@B@B@@@D@@AB@@@B@@A@@@A@@D@BAB@B@B@@AB@@@@@B@BBBB@B@@B@@@D@BAB@B@@@@@@B@@B@@BB@B@B@B@BAB@@@B@@@@@@B@@@@D@@@@@@@@B@@@B@@B@F@FBB@@@@B@B@@B@@@B@BB@@@@@BB@@@@@D@B@BAB@DCB@B@B@@@B@@@@BB@@@@@@BB@@@@@@@@D@@@@BBB@B@@BBBBB@BB@@@@B@@@B@BA@@B@@B@@B@@@B@@B@@BB@B@@@BD@@B@@@B@@@@BB@@BB@B@@@B@@@@@@A@@B@BA@A@@B@DADBB@@A@@@A@@@@B@@@@@@B@@BC@@@@B@@@B@D@@@@@@B@@@@B@@@B@B@@A@@@A@C@@@@@@BBD@BDB@@@B@D@@@B@@AB@@@@@@BB@@@A@@B@@@@@@@@@@B@@@@@@@@B@@@@@B@@@@@B@@@@BA@@B@@@@@BB@@@@@A@@@@D@@@@@@AB@@@@B@@B@@A@@B@@@@@AA@B@@A@@@@@@@A@@A@@BA@AB@@@@A@@@@@@D@DB@A@@B@@@@A@@@@@A@@@@@@AA@@AA@@@A@@@@@@@@@A@@@@B@BA@@@@@@@@@@@@@A@@@@B@@@@@BA@AAA@A@@BAA@@@@@@B@@@@@@@A@@@@@A@@B@@@DA@@B@@@@A@@@@B@@BB@B@@@@@B@@B@@@@@@@A@@@@@A@@A@@@@@@@BA@AB@@@@@@@BAB@@A@@B@@A@@@@@@B@B@BB@@BBB@BBD@BBB@@@B@B@BA@@B@B@@@B@@@@@B@@@@@B@BA@@@@@@@@B@@@@@B@@@@ABA@@@@B@@@B@@A@@B@@@@@B@@A@@@@B@@@@@@B@@B@BB@@@BB@@B@@B@B@D@@@B@@@B@@AB@@@BB@@@@B@@B@@BBB@@B@@@@@@@@@B@@D@@@B@B@B@D@BA@@@@@AB@@@@@BBB@BB@@B@@B@BB@@B@@B@@@@@BAB@@A@@@A@@@@B@@@@A@@BA@@@@@@B@@@DB@@BA@@BABAB@@@@@B@B@@@B@@@B@@@BBB@@B@@B@@@D@D@F@@@B@@@@A@@@@@A@@@@@A@@A@B@@A@@@@AA@@@@BA@@@@@AB@@@BAB@@@@@B@@@BB@@B@BBB@BBB@B@@@B@@B@@@@BB@BBBB@B@@@BBB@B@B@@B@@B@BBB@@A@@B@@AB@@@B@@BB@B@B@@@BB@@@@@BB@@BB@@@B@B@@@B@@@BBB@@@B@B@@BB@@@B@B@@@@@B@@@BB@@@BB@@@@AB@@A@@BA@A@@@AB@@@@A@@@@@@@@B@@A@@@@B@@A@@B@B@B@@@B@@@B@B@@@@@B@@@@@@@B@@@B@@@@@D@@@@@B@@BBB@@B@@@B@@@@BB@@BB@@@B@@@DAB@BA@@B@@@B@@@B@BAB@@AB@@@@A@AB@@@@@@@B@@@@@@ABB@@@@BA@@@@B@@@B@@B@@@@@B@@@B@@@B@@@B@@@@@B@@@@BB@@@@@B@@B@@B@@@BFAD@BBDA@@@A@ABA@CBC@ABA@ADAB@B@DABBDABADB@@BA@AFC@ABAD@DAB@@C@@A@AAEAA@@A@@@A@@A@@@A@AAAAAA@@@@BA@@AA@@AA@@A@A@AAA@@@A@@@AB@@A@@@A@@@A@@@AAA@A@C@@@A@@AA@A@@@@AA@@AA@A@@@A@@A@@@@AA@A@@@A@@AA@@A@A@@@@@AB@@A@A@A@A@A@@@@@A@AA@@A@@@AA@@A@@@AAA@AA@@A@A@AA@@A@AAAAAAAA@@A@CAAB@@A@A@@@@@A@@@AA@@A@A@AA@AA@@@@@@A@@@A@C@ABA@@BC@A@ABA@A@AFBB@@@@@BA@@@A@@B@@@@AB@B@@@BA@@B@AA@@@A@@@@AAAA@@AAA@A@@A@@@@@A@@@@@A@@BA@A@@@AB@@@B@@@@@B@@@@B@B@@B@@@B@BA@@B@BA@@@A@@BA@@B@@@@@@ABA@@@AB@@@@A@@@A@@B@BC@@@@@A@@BA@A@@@@@@@AB@@@@AB@@AB@@@@A@@@@AAA@@A@A@@A@@@@A@@@@@ABA@@@@@@@AA@@@@AA@@@A@@@@AA@@@A@A@@@A@@@@@AA@@@@A@@@AAABC@A@C@A@A@A@@@@@@A@AAA@@@@A@@@A@@@@AA@A@A@@@@A@@@AA@A@@@@A@@@@A@A@A@@@@BAA@@A@@@A@@@A@@B@B@@@B@@A@A@@BA@@@A@@@A@A@A@@BC@@B@@@@@BAB@@A@@B@@@@@@@@BD@@@@@@AB@@A@@@@@A@@@@B@BABC@@BC@AB@@C@@AA@@@A@@A@@AA@@@@A@@AB@@@@A@@@A@@@@AAC@A@A@@@A@@BA@@@@@AB@@C@@@ADA@@B@@@B@@BB@B@B@@A@C@A@C@A@@@A@@A@@@@A@@@A@@@@@A@@@AAA@A@@@AB@@A@A@AB@@ABA@@@@@CC@@A@@AB@BC@@@@@@AAA@AAAAECA@@@C@A@@AC@ABC@CBGBA@AB@BA@C@A@A@@@AABAA@@AA@@AAC@@@AA@@@@@@@A@A@@@A@C@@@@@@@AA@@AA@@AAA@@@AA@@@A@@@A@@@A@@@@@A@@BA@@A@@@@@AA@@C@A@AA@@A@@@@AA@A@@@A@A@CB@@A@@A@@@@@@@@BA@@B@@@@A@@@@@AB@@A@@@@AAA@AAAAAAAAA@AA@@A@@@C@A@A@@@A@@A@@AA@@A@AB@@A@A@A@ABA@@@A@@B@@@@@@A@@@@@@BA@@@@@@BA@@@@@A@@AA@A@@@@AA@@AA@A@@AA@AAA@@@@AA@@@@@A@@AC@A@@@@@A@@A@@@A@@A@@@A@@@@AA@@AA@@@AA@@C@CAA@AAA@@@@AA@@@@@A@@@@@A@@@A@A@@@@@@@AA@@@@@AA@A@@@@A@@@@@@AB@@A@@@A@@@@@@@A@@@@@A@@@@B@@A@@@@@AB@B@B@@@@A@@@A@@@@B@@A@@B@@@@A@@@@B@@A@@@A@@@@B@@@BA@@@A@@@A@A@ABA@@@A@@@@@@AAA@@AA@@@@@@@@@@AA@@@@A@@@@AA@@@@A@@@@@A@@@@A@@@AAAAAAAA@@@AA@@@@@@@@@@BA@@@A@@B@@@@AB@@A@C@@@C@@@@AA@@@A@A@C@ABABA@A@@@A@A@@AAA@C@@@@@AA@@@A@@@A@@B@@AA@@@@@@A@C@@@@@A@@A@@AA@@CECEAAAA@AAA@AAAA@AA@A@@ABA@@@@@A@@@@@AB@@@@@@@@@A@@@@A@@@@@@@@B@@@@@AAA@@A@@@A@@@@@@@A@@@@A@B@@CB@@@@@@A@@@@@@@A@@@A@@@A@A@@@@@@B@@@@@@@B@@@@@@AAAA@@@@@B@@BB@@@@@@AA@@CB@@@@@@@@@@@@@@@B@@BBB@@@@@@B@@@@@@@D@@@@@@@@@@A@@@AB@CAD@@A@A@"],encodeOffsets:[[126419,42468]]}},{type:"Feature",id:"210112",properties:{name:"浑南区",cp:[123.449714,41.714914],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@B@@@@@BA@@@@B@@B@@@@@@@@@BBA@B@@@@BB@@@@BB@@BA@@DAB@@@@@@@@@@BBBGBEBKB@@@B@@@@BFCBDDAFA@EAAEBAAAAAB@@AAACC@@BA@AA@@@@@@A@@ABA@ABA@A@@BABFFABC@ABA@EBE@MFB@@B@@FJ@@@@BDBB@@@B@@@BB@@B@@@@AL@@@B@@@@AD@BB@@@D@@ABAB@B@B@@@B@BADA@AB@B@@@@@@A@@@@@@@@@ABC@@@@@@@@B@@BBA@@@@D@@@@@B@@@@@B@@@@@@@@@@A@@@@B@@@@@B@B@@B@B@@@@@@@@@@@BB@@@@@@@DA@@BB@@B@B@@@@@BB@@@@B@@@B@@@@@@@@@@@@ALB@@@BB@@@@B@@@@@BB@@B@@@@@B@@B@@@@BF@@@B@@@@@BBBBBB@@B@@BFBBD@BHBD@FBBBHFB@TJD@D@F@\\AL@FBJB\\DHDFBDDFJFLBB@B@D@B@@BBHFBBDBFDHBNFTFJBBBB@DBJHJJJFFDHFABAB@@CB@B@@AB@@@BA@@B@@@B@@BB@DDF@B@D@B@B@B@BA@AFADAHADADCH@BADADAB@B@B@B@B@@@B@B@D@D@D@B@B@@@B@@@BA@@BABCDABABABADABA@@BA@AB@@BBB@@@@@@@@B@@@@B@@A@@B@@AB@@@@@@@@A@@@@@@@A@@@@@@B@@@@@@@@@B@@@@B@@@@@@@@@A@@BA@@@@B@@B@@@@@B@@B@@@@@BA@@@@@@DBBB@A@@BABB@@BB@@B@D@@@B@BF@BB@@BB@@B@@BDB@@BBB@@DBDBD@BB@@B@@ABA@@B@BA@ABA@ABA@A@A@@@A@@@A@A@@@@@@@A@@@@BA@@@@@@AA@@@@@@B@D@B@@@@@B@@@@@B@@@@@@@B@B@@BB@B@@B@@@@@BB@@BB@@@B@@@B@@BB@@@B@@@B@@BB@@@B@BBB@@@B@B@@@BB@@BB@@@@BB@AB@@@@@@A@@@@@A@A@C@@@@B@@A@@@@@AB@@@B@@B@@@@B@@@@@@@@@B@@A@@@A@@B@@B@@@@@@@B@@@@@@@@B@@@B@@A@@@BB@@@@A@@@@@@@AA@@AB@@@@@BA@@B@B@@@@BB@@@B@@@@@B@@BB@@@@@B@@@@B@@@@B@B@@B@B@@@@B@@@@B@@@@@@A@@@A@@@A@@@@@@@@@A@BB@@BBB@@@@@@B@@@@@@@@@@AB@@@@@@A@@@AB@@@@@B@@@@@@@B@B@@@@@@AB@@@@@@@B@B@@@BA@@BABABA@AB@B@@AB@@@B@B@B@@A@@@@B@@@@@B@@@@AB@@A@B@@BBBBBB@@@B@@@@@@BB@A@@@@BA@@@@@BB@@BBB@B@@@BA@@B@@B@D@@@@@B@@@@@@BB@B@@AB@@BBAB@@A@A@AB@B@@C@@@@B@@@@@@@@B@B@@B@@@@A@@@@@@@AA@@@@A@@@@B@@@@@@@@@B@@@@@@@@A@@@@@A@@@@@@@@B@@BBCDEAKBGHIJ@FD@F@FBFBDBBBBBBB@JRBB@PJDBHBH@DABAD@D@DAFAFAB@BDDDDDFBFBFFD@HBFBHF@DCBDBHDKHHPFBH@BDFBFFDFFBBBDFDHAJABAF@JAH@F@F@H@HBF@F@JHB@DBFBHEBEDMDIDMDGDdBXJB@@@B@@@BA@@@@@@@@@@DA@@@@@A@@@@B@@@@A@@BA@@B@@A@@@@@@@A@@@@@A@@BA@@@@@AB@@@@A@AB@@@@@B@@@@BD@BBB@@@B@@AB@BA@@B@B@@@B@@@@@BAB@@AB@@@B@@A@@@A@@@@BA@@B@@@B@BABA@@@@@A@@A@AA@AA@@A@@AA@@@@B@BAD@@@@AB@@A@@@@@@B@@@@A@@@@B@D@@@@@@@@@AA@@@A@@@A@@B@@@BABB@@B@BB@@B@@@D@@@B@@@@A@@@A@AB@@@@@B@B@B@B@B@B@BA@@B@@@@@B@@@@@@@@@@@B@AA@@@@@@@@B@@@BA@@@@@@@AB@@@B@@@@@@AB@@A@A@@@@B@@@@B@@@B@B@@@@@@@@@@@AB@@@BA@@@@@@B@@@@@AB@@@B@BB@@@@B@@@@@@BB@@B@@@@@BB@@@B@@@B@@@@B@@@BB@@@@A@@@@@@B@@B@@B@@@@@B@@@BCB@@@B@@@@@@BB@@B@@@@B@@BB@B@@BB@@BB@@@B@@B@@@F@@@B@@@B@B@B@B@@AB@@@@A@@@A@@@@AA@@@@@A@@@@BABA@@B@@@@A@A@@@@@@@@D@B@@@@@BA@@@@B@@@B@@@B@@@@@@@@A@@BA@@@@@A@@AA@@@@@@BA@@@@@@@@@A@@A@@@@A@@@@@@@AA@@@AAA@A@@AA@@AA@@@@A@@@@B@@C@A@@BA@@@@@@@@B@@@@A@@B@@@@AB@@@@@@@BA@AB@@@@AA@@@@@@A@A@A@@A@@A@A@@AC@@@AA@@@A@A@@@A@@@@@@@@A@@@@@@@@@@@AA@@@@@@A@@@A@@ABAA@AA@@A@@@@A@@B@@@@DD@@@@@@AB@@A@@@@@@@AA@@@@B@@@@@@@@@@A@@@AA@@A@@@@@B@@@@@B@@@@@@@@A@@@@A@@@@@AA@A@@@AA@@@@@ABA@AA@@@@B@BA@@@@@@@@@@@A@A@@@AA@@A@@@@@@@@@@B@@@BA@@@@@@@A@ABAB@@@B@@@@@B@@@@@@@@@@@@@B@@A@@@AA@@@@AA@@@@@@A@@@@@B@@B@@@@@@AB@BA@@@A@@@ABA@@@@B@@@@AB@@@@A@@@AA@@@@A@A@AB@@A@A@@@@AA@@AA@@AA@@@@@CAA@@@AAAAC@@@@A@@@@BABA@@@@@A@@@@AA@@@AAA@@@@@@B@@@@@@@@AB@@@@A@@@@@@@@@@AA@@@@@A@A@@A@@@@@A@@@A@@A@@@@@A@@@A@@BA@@@@@@@A@@@@@A@@@@@@@AB@B@DDD@BBBA@C@@@A@@B@BA@@@@@@@A@@A@@@@@@A@@B@BA@@B@@@B@@BBBB@@@B@@@@@BA@A@@@@@@@@AA@@@@@@@@@@@AB@@@B@B@@AB@@@B@@AB@D@@@@@B@B@BAB@@@@@@A@@@@@@@@AA@@@@@@@@@@A@@AA@@@@@@@@@@@A@@@@@@A@@A@@A@A@@@@@A@A@A@@@A@@@@@@@@AA@@CA@AA@@AA@@@A@@A@A@@A@@@@@A@A@@A@@A@@@@@@@@AB@@@@@@AAA@A@@A@@@@@A@@@@@A@@@@BAB@@@@@@AB@@A@A@A@@@@@@@A@A@@@@@A@@@A@@@@@@@@@A@BA@@B@@A@@@@@@@@@@A@ABABA@@@@@A@@AA@@@@@AA@@A@@@@@@@A@@@@@@B@@A@A@@@@A@@A@@@@@@@@@@@A@@@@@@@A@@B@@@B@@@@A@@@@@@@@A@@A@@@@@A@@@@AAAC@@A@@A@@@A@@AA@@@A@@A@@A@@@@@@@@@AB@@@@@@@@A@C@A@A@@@AA@@A@@AAA@@@@AAA@@AA@@A@@@AA@@A@A@@@A@@B@BA@@B@BA@@B@@@BAA@@@@AA@AA@@@@AA@A@@@@A@AAAAAA@@AAA@@@@A@@@ABA@ABAB@BA@@@@@@@AB@@@@@@AB@@@@A@@@A@@@@B@@A@@BA@@BA@A@@@A@@AAA@@A@@@@@@@@BB@@BB@@@@B@@@@A@@@AA@@A@@@@@A@@@@@@A@@@A@A@@@@@@@@@@A@@@@@@@ABBD@@@@A@@@@@@@A@@AA@A@@@@@@B@@@@@@BB@@@@@BA@@@@@A@@@@@@B@BB@BB@@A@@@@@@@A@@@A@@@@@@BBBBBB@@B@@@@@@AB@@@@@BA@@@@@@@A@@A@@@@@@@@@@@B@@@@@@A@@A@@A@@C@A@AAA@@AA@A@@@@AA@@@@A@@A@@@@@@A@ABA@@@@@@@A@@ACA@@A@@@@@@@@A@@@A@@@AA@@AA@@@@B@@@@AB@A@@@@@@AB@@@@@AA@@@@BA@@@AA@@@@A@@@A@A@@@AA@@@A@@@@A@@A@@@@@A@A@A@@@@A@@A@A@@@A@@@@AA@@B@@@@@@@@A@@@@@@AB@@@@@@@@@@AA@@@AAAA@@A@@@@@@@BAB@@@@A@@@@@AB@BABA@@DAD@BAB@BAB@@@@@B@@A@@@@B@@@@AB@BA@@B@@@@@B@@@B@@@D@@@@@B@@@@@@AB@@@@@@@BA@@@A@@@@@A@@@@@A@@@A@@@@@@@@B@@@A@@A@@@@@@B@@A@@@@A@BA@@@@@@@A@@AA@@@A@@@@@AB@@@B@BBB@@@@@@AA@@@AAC@@A@A@@@A@@@@@A@@CACAEAAAAAEGGEECCAA@CAGCA@CCECGAEAO@E@KAEAGA@E@@@A@@AAA@A@BA@AB@@A@@@A@@@@@A@@@@B@B@@AB@@@@A@@@@BA@@@@@@BB@@B@@@@@@A@@BC@@@A@@@@C@@@AA@@B@@A@@B@@A@@@@BA@@B@@@B@@C@A@@@@@ABE@@E@@@@A@@@@@@@@@@@@@A@@@@@@@@@@A@@A@@A@@@A@@@@A@@@@@@BA@@@AA@@@A@A@@A@@A@@@@@A@@@A@A@A@@@@@A@@@@AA@A@@@@@A@@@@@@AA@@A@A@A@AA@@@@@C@@@A@@@@@@@@A@A@A@@@@B@@B@@B@@AB@@@@@@A@@@@@A@A@A@@@A@@@@@@@@@A@@A@A@BAB@@A@@B@@A@@@@BAB@@@B@@@B@@@@B@@@@B@@@@@@A@A@@B@@@B@BAB@F@@@B@@@A@@A@@B@@@BA@@B@@B@@@@@@B@@@@B@@@@@@@BB@@@BB@@@@BA@@@@B@@@@@@@@@AA@@@@B@@@B@B@B@B@@@B@@@@@@@@A@A@A@@@@AA@@@@@A@@B@@@@@B@@@@@@@@@@@@@@@@A@@DBD@@@@@B@@@@@BBB@B@@@@@B@@@@A@@@@@@@@@A@@@@@@@@BB@@@BB@@@@@B@@@BA@@B@B@B@@@@@@@@A@@B@@@@BB@@@@@@B@@@@@@@@@@BA@@B@@@B@@@B@@@@@B@@@BB@@@@@A@@@@@@A@@@A@@@@A@@@@@AB@@A@@B@@@@@@A@@@@@@B@@@@@@@@@@B@@B@@@@@B@@@@@@@B@B@D@BBB@B@@@@@@@BB@@@@@@B@D@B@BBB@BBDBB@@@BBB@@@@@BBB@BBBB@@BBB@@@@@@@BA@@@A@@B@@@@@@@B@@AB@@@@AB@@BB@@@@@@@BA@@@@B@BB@@@@B@@@@A@@@@CA@@@@BA@@@@@@@@B@@BB@BBBBBA@@BA@A@@B@@A@@B@@@BBB@@@@@@@@B@@@@@@@B@@@@@@A@@@@@@BA@@@@@@B@B@@@B@@@B@@@@@B@@BBA@@@ABABA@@@@BB@@BB@@@@@@@@@A@@@@@AA@@@@A@@@@@DD@@@@@@AA@@A@@@@@@BB@@@@@@B@@@@@@BB@@@@@@@@@@A@@@A@@@@@CA@@@@@@@@@@@BBB@BB@@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@A@@@@B@@@B@BBBBBBB@@@@@@@B@@@B@@@@@BA@@@AB@@@@@B@@@B@@@@A@@B@@@B@@BB@@@@@@@@A@@@A@@@@B@@@@@BBD@@B@AB@BA@ABA@A@@BC@A@@BA@AB@BC@A@@@A@@A@@BA@AAA@A@AAA@@A@A@@B@AAB@@A@A@@A@AA@@AAC@EA@A@@BABADCDAB@D@BBAB@@B@@AB@@@B@@@@A@@BA@@B@@@B@@BBB@A@A@A@AAABA@@@A@@@AA@@AA@A@AABC@@B@@AA@@@AAAA@@ACAABCB@D@@A@E@AB@@A@ABA@@BABC@ADCDABAB@@@@ABA@@BAAA@@@A@A@@BABB@@B@B@B@@AB@ACAA@AB@@EAABAHC@@@ADC@@@@BB@@@@@@B@@ABA@@@@@AB@@A@AB@@AAAA@@AAAACAA@@BA@CBAAC@A@@KGAA@@A@A@ABA@@@AC@@C@@@@AA@@AA@@CCA@@BC@A@@AEB@@@B@FBB@@AAA@A@A@AAC@F@ACA@AAA@AAAA@ACA@A@AEAAAABA@A@AC@@AAAA@AACCAAEACBABAB@@AAAACCAABA@A@C@@ACAAC@E@AAA@AAC@AA@@AA@CACCCD@BED@@A@A@A@@BA@AA@@CB@B@BA@A@AB@@A@CBA@@@A@@@AA@@@@A@@@@AA@@@@@A@@@@@A@@@A@@@A@@@A@@@@@AA@@@A@@@@@AB@@@@AA@B@@@@A@@@@@@@A@@B@B@@A@@BA@ABA@@@A@@@A@@@ABA@CB@@A@@@A@@AA@@A@@A@@@A@@@AA@AA@@@@@C@@@@@A@@@A@@@@@@@A@@@@@A@A@@@A@@@A@A@A@@@@BA@@@@@@BA@@@@@@@@@@B@@A@@B@@@BAB@@@BA@@B@@A@@A@@AA@@A@@@@@A@A@@@A@@AA@A@@@A@AA@@A@@@A@A@@@A@@AA@@A@@@@AA@@A@A@A@@AA@@@A@@BA@@@@BA@AAA@@@@AA@A@A@AA@@A@A@AA@AAA@@@@@AA@@@A@A@AAA@AAA@@@AA@@A@@@@@A@AB@@A@@B@@@@AB@@@@BB@@@@@BA@B@@@@B@@@@@B@@@@@B@@A@@@E@C@C@@@A@@@@AA@AA@@A@@@A@@@A@A@@@@@A@ABAB@@AB@@CA@@A@@@@@@@AB@@@B@@A@@@@@@B@@@BA@AB@@@@A@@@@AA@@A@AA@@@AAA@AA@@@@A@@B@@@@ABC@A@A@A@@@C@@@@A@@@@@@@@@AA@AA@@@AA@@@@@AA@@A@@BA@@@A@@@C@A@A@@@@AA@@A@@AAA@@@@A@@@@A@@@@@@BA@@@@@A@@@@BA@@@A@@@@@AB@B@@A@@@@@A@@@@@@@@@ABA@@@@@A@@@@@A@@@A@A@@@ABA@A@@@A@AAC@AAA@AA@@AAA@A@@@@@@@@BA@@@@BA@AB@@@@@@A@@BAB@@@@@@B@@@@B@@@@@B@@@@@@@AA@@@@@A@A@@AA@@@@@@B@@A@@@CB@@A@@@@B@@@@@B@@@@@@@A@@@@B@AB@@@BBB@BAB@@@@A@@@@@@B@@@@@@@@@@@@ABA@@@@@@B@@@@@@@@@B@@BB@@BB@@@@@@@B@@@@@B@@A@@@@BCAC@@@@@@@@B@@A@@BAB@@@BB@@@@@@@B@@@@ABB@@@@A@@@@BA@@@@A@@A@@B@@@@C@@@@@@B@@@@AA@@@@A@@@AB@@@@@A@@@@@A@@@@@A@@@@@@A@@@@@@@@@@@@AB@@@A@@A@@@@A@@BA@@@C@A@@@A@ACC@AA@@@@@@@D@B@@@BA@A@@@A@@@@@@A@@@@C@A@@@A@@@@@AD@@@A@@@@A@@@@@@B@@@BA@CACBA@@@@BABA@@@@B@@@@A@@@A@A@@AA@@A@@A@@@A@@@AC@@A@A@@AA@@@@A@@@AA@@@@AB@@A@A@@@A@@A@@AAAAA@AA@A@AA@@@@@C@@@@@@A@@A@@@@A@@A@@A@@@A@A@A@CDA@ABA@C@@@@@A@@A@@@@AAA@@@A@@@@A@A@@A@EAE@A@@@@A@@@A@@@@@@C@@@@@@A@@@@A@@@A@ABA@A@A@A@@AA@@@@A@@@@A@A@ABC@@@A@@@@AAAAAA@@@@@A@@BA@A@A@ABC@@@@B@@@BA@@@A@@BC@@@A@A@@@@@A@ABC@A@@B@@@BA@@@A@A@ABA@@@AB@B@B@N@@AB@@A@@@AB@@@@@B@@@@@BA@A@A@@@AA@@@@@@A@@@@B@@@B@@A@A@@@@@@B@BA@@@ABA@@@A@@@A@@@AAA@A@C@A@A@A@@@A@ABA@AA@@ABA@A@C@@@@@@A@@@@@@@@A@@@@@A@@@@@@@A@@@@AACA@@@@A@@@@A@@B@@@B@@@B@@@@CBEBA@@@A@@@@@A@C@@@A@@AA@@@EBEBA@@@@AA@@AAA@@AA@B@@@@A@@@A@@@AA@@B@@A@@A@@@A@A@AB@@@@@@@BA@@@@@A@A@BB@@DH@@AB@@A@AAA@CBC@AB@@A@@B@@IDODCBA@@@@@BB@BBB@D@@@@@@@@@BA@@@@@@@DFB@@@@@@@B@@@D@@@@@BDBBA@@@A@@@A@@B@@@@@BB@@B@B@@@@BB@@B@FBD@@@@@B@@@@B@@@@@@@@BB@@BDBBBB@@BB@@B@@@@@@BB@@@B@BAB@@@@BBBBF@@BDDF@@@B@@@@@B@@BFBB@@A@@@A@@AAB@@@@DD@@@BA@BDB@@@B@@B@@B@@@@@B@@@BB@B@@AB@B@B@@"],encodeOffsets:[[126368,42699]]}},{type:"Feature",id:"210113",properties:{name:"沈北新区",cp:[123.583196,41.912487],childNum:1},geometry:{type:"Polygon",coordinates:["@@AC@@@AA@@AA@@AAEA@@@C@A@@@AA@@AAAB@@@BAACA@@@@@@AB@@@@A@@@@A@@@@@AA@@@@@AB@@@B@@@@@@@@@A@@A@@@@@@@@@A@@@@@@@@B@@@@@@@B@@@@@@A@@BA@@@@BA@@@@@@A@@@@@@A@AAA@@B@@@@@B@@@@B@@@@B@@A@@B@@@@@@A@@A@BAB@@@BB@BB@@B@@@@@BBAB@@A@A@ABA@@@A@AB@@A@@@@@A@@BB@@@@@@@@@BB@@@@@@A@@@A@@B@@@@@@A@A@@@@@@@@BBBB@BB@@@@B@@A@@B@@@@@B@@@BB@@@B@@B@B@D@B@BA@B@@A@@@BB@@A@@BCB@@@@@@@@A@@@@@@B@@BBB@@B@@B@@BBB@@@@@@@@AB@@AB@@A@@@A@@B@@@@A@@@@BA@@@@@@B@@@@ABA@@@@AA@@@@@@@AB@@@@@@@@@@ABAB@@@@@@@@@@@@B@@@B@@@@B@@@@@@B@@B@@@BB@@@@BBB@@@@@@@@A@@BA@A@A@ABA@@@A@@B@@@@@B@B@@@@@B@@BB@B@@A@@B@@@@@@@@@B@@@@@@BB@@@@@@@@B@@BB@B@@@B@@@B@@@B@@@@B@@@@@@@@BB@@@@@A@@@@@@@@@B@@B@@@@@@B@@@@@@@@@@@@@@BB@@@@@@B@@@@@@@@@@@@@@@B@BB@@@@@@@@@@@@@BB@@@@B@@B@@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@B@@B@@@@@B@@@@@@@@@@BB@@@@B@@B@@@BAA@@A@A@A@C@A@A@C@C@M@G@K@C@E@A@E@C@C@@@@@CGAE@AA@@AACAI@A@@@@@@AA@A@@@AAA@@AA@@CEAACB@@@@@@AA@@@@@@AA@@ACAA@@@@@@A@CB@@@@@@BBBB@@@BA@BBA@@@@A@@A@@@@@A@AC@@@@@@@@@AA@AA@A@@@@@@A@@@@@A@@@A@@@@A@@@AA@CF@@@@DE@@@@@A@@A@@@@@A@AFGKCGCEAAA@@A@@A@ACA@@@BB@B@@@@@B@A@@A@@@@@@A@@AA@A@@@@@@AA@A@@@@@B@BBB@@A@@@@@CBA@A@@@A@AB@@@@@@@BBD@BAAEDC@A@@@@@AA@A@@@@@@AAA@ABA@@@@A@@A@@A@@@@A@@@@@@@A@@@@@@@C@A@A@@@A@@@@@@A@AA@@@@@@@A@C@A@AB@@A@@@A@@@A@@@@B@@AB@@@@A@EBA@@@@BAB@@@@A@A@AB@@A@@@BB@D@@@@@@@@A@@@@@AA@@@A@@@A@@@@E@G@A@G@A@@@@@AA@A@@@@A@AB@@@@@@AG@@A@@@@@@@@@@@@BBD@B@@A@@@@@@BA@@@@@@@A@@@@@@@ABA@A@EBDCAAABG@GBE@EBC@@E@@@@@@AB@@@@A@@@CC@AA@@@IDGDEBA@C@@F@B@@@@A@@@@@@F@D@@@@@@B@B@@B@@@@@B@@@@@@@@@@@@@@B@@@@BB@@@@@@@@BC@@@AB@@@@A@AFD@@BB@@@BB@BBD@B@@BBBBB@B@@@BB@@@@ABA@@@@@@B@@B@@BB@@A@@@@B@@@DB@@@@@@@BA@@@@@@@@B@B@@@@@@AB@@A@@@@@B@@B@@@@@@B@@@A@@B@@@@@@AA@@@@A@A@@@A@@@@@@@BF@@@@A@EBA@@@@@@DB@@BB@@B@@B@@@@@B@@@@@BB@B@@@@@@@@CBA@@@@@ACA@@AA@@@@@A@@@A@@@A@@@A@A@A@C@@@A@A@@@@@@@@@@B@B@@@B@B@@@BA@@@@@A@A@@@A@@@A@@@@@A@A@E@GBC@@BCBA@A@CBA@ABAD@@@B@@@BBD@@@@@B@@@@@@@@@B@@@@BB@BBB@B@BA@@@@@@@DDCB@@@@A@@@CBAB@@CBAB@BA@@@CBA@ABA@ABC@CBA@ABA@AB@@A@A@EAEC@@@@ADA@@@@@@@@B@B@F@@@@@@A@@D@@@@@@A@A@A@A@@@@@@@@B@@@@@@A@A@@@@B@@@@@DB@@@@@@@@B@@@BB@@B@@@@AB@@@B@@@B@@AB@B@B@@@B@BA@@@@@@@A@@@@BEACAA@@@@B@H@BA@@@@BAB@@@@AB@@C@A@A@@@@@A@@B@B@@AB@B@B@B@B@@@@@@ABAB@@@BA@@@ABA@ABAB@@@BA@B@@FI@A@AB@@@@@@BFBD@BBDBBBD@DBD@@BB@@B@@@B@DBB@@@B@@@@@@B@@@@@@@BA@@B@@@@DF@@@B@@@@@@@B@@@@BD@@@@B@@@@B@@A@@@ABABA@@@@@@@DFB@@@@B@@@@CDA@@@@BA@GF@AA@AAIFBBBDBBBB@@@@@@BB@@BBBB@B@@@@B@@@@B@@BB@@@@B@@@@B@@BBBDB@BADAFCBDDHDD@@@@B@@@@@DDFDBB@@@B@B@B@B@@DDBDDFBDB@@BBBFF@@HHFDBBBBBD@@@@@@@BA@@B@@@BAB@@AB@@A@@B@@@B@@@@@BB@D@B@@@@@@B@@BB@B@@@@@B@@B@@@B@BAB@@@@@BB@@BB@@B@@@@@@@@ABA@@@@B@@@B@B@@@@@@B@@@@@@@B@@@@@@@BA@@B@@@B@@BD@B@@@@BB@@@B@@@B@@@@@@@B@B@B@@@@@@@@@@@B@@@@@@BB@@B@@@@@B@@@@@@@B@@@B@@@B@@@@@B@@AB@@@@B@DNCF@H@D@DADCDGFEJAD@DBPPHBL@JAHBHBDB@H@PBFDFFDDBD@BEAC@A@ABAHAH@HBFFHFDFF@JCLCF@DBBB@FBD@BDBHDDDB@DBFAFAF@F@F@JDDFFDDBDBHFDBF@H@F@DAD@BAB@BADCHENGB@DAB@BBB@BBAK@K@E@CAI@E@C@@@@@@A@@@A@@@@@@A@@@@@@B@@A@@B@@A@@@C@@@@B@BA@@@@@A@@@AAA@@B@@@@@BA@@B@@@BA@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@A@@B@@@@@B@@@@@@A@@@@B@@@@@@@@@@@@@@@B@@@@@B@@B@@@@B@@@@@@@@@@@@@B@@@@@@B@@B@@@@@@@@@@A@@@@@@@@@@BA@@@@@AB@BA@@B@@@@@@A@@@@B@@@@@@@@@B@@@@@@@@@B@B@@@@@@@@@B@@@A@@AB@@@@@@@@@B@@@@@@@@@BA@@@@B@BABAB@@@BA@@B@@@@@@@BA@@@@B@@A@@@@@A@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@BA@@@@B@@@@@@@B@@A@@@@B@@B@@@@B@@@BA@@@@@@@@@@BA@@@@@B@@@@@@@@B@@@@@@@B@@@@@@@BA@@@@B@@@@@@@@@B@@@@@@@@@@A@@B@@@@@@AB@B@@@@@@@@@@@@@B@@@@A@@@@B@@@BA@@@@@@@@@@B@@@@@B@@@B@@@@AB@@@@@@@@@BA@@B@@@@AB@@@@@@@@@B@@@B@@A@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@A@@@AB@@@@@@@BA@@B@B@@@B@BAB@@@BA@@@@@@@@B@@@@@B@@@@@@@B@@@B@@A@@B@@@@@@@B@@@@A@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@D@@@@@@@@A@@@@@A@@@@@@@AB@@@@@B@@@B@@@B@@@@@@A@@@@@A@@@@@@@@@@@A@@B@@@@B@@B@@@@@@@@@@@@@B@@A@@@@@@@@@@AA@@@@@@@@@@@AA@@@@@@@@@B@@@@@@AD@@@@@B@@@@@@A@@A@A@@@@A@@@@@@@A@@BA@@B@@@B@B@@@@@@@@B@@@B@@B@@AB@@@@BB@@@BA@@B@@A@@B@@@@ABB@@B@@@B@@@@@BA@@@@B@@@@B@@B@@@@@B@@A@@@@@ADA@@B@@@B@B@@@@@@@@@AAA@@AB@@@B@@@@@BA@@@@@AB@@BB@BBB@@@@@@@@@@@@AA@@@BA@@@@B@@@@@@BB@@B@@@@@@BA@@@@B@@AB@@@@@@@B@@@@B@@@@@@BA@@B@@@@@B@BB@@BB@@@@B@@@@@@A@@B@@@@@@A@@@@B@@B@@@@@@@@@B@@@@@@@@B@B@@@@@B@@@@@@@@@A@@@@@@@@B@@@@B@@@@@@@B@@@@@@@@@@@@BB@@@B@B@@@D@@@@A@@@@@@@@D@@@B@@@@@@@B@B@@@B@B@BA@@B@B@@@B@@@B@@@B@@@BAB@@@BA@@B@@A@AB@BA@@B@B@@@@@F@B@@A@@@@BA@@@A@A@@@A@@@AB@B@@A@@@@B@@@BA@@B@B@B@@BBA@@@@B@@AB@@@@@BA@AB@@@B@BAB@B@@@BA@@BA@AB@B@@@WIcAHCNCJCNCFCFAAGAE@CGA@I@EAE@G@G@E@EBG@IBEBABICGCEAAEACEEEEAACG@EAGOLGGCCADA@CGEEAGAC@EEEAEACCCCACA@EBEBCBC@C@ABCBG@GACAOIA@QA@IAAAAAACAEAEAE@C@@EJIHGLAFBDCAA@@@A@@@@@@B@@@@@B@@@@@@@@@@A@@@@@@@@@A@@B@@@@@BB@@@@@@B@@@@@@AA@A@@@@@@@@@@A@@D@@@@ABAB@B@@@BAAA@@BA@@@AAA@@@@@@@A@@@@@C@AA@@@AB@@A@A@AA@@AA@@@@B@@A@@B@A@@A@@@@A@@@A@AAAA@AA@B@@@BA@@@@@A@@@@@A@@B@@@@A@A@A@@BA@@@ABAB@BABA@AB@@A@@@A@A@@@@@@BA@@@@@@@A@A@@@@@@@A@@@@BA@@B@@@@@@@BA@@@@@@@@@@@A@@@@A@AA@@AAB@@@@@@@@@B@@@B@@@B@@@@@@@@A@@A@@@@@@A@AA@A@@@@@@A@@A@@@@@A@@AA@@@@@A@@@A@@A@@A@A@@@AB@@@@A@@BB@@B@@@@@@@B@@A@@A@@@BA@@@A@@@@@@@@@@A@@@@@@@AA@@@@B@@@BA@@@@@@@@@A@@@@@@AA@@@A@@B@@@@@BA@@@@@@D@B@B@@@@@B@@@@A@@BAA@@@@AA@@AA@@A@A@@@A@AAA@@@A@@AA@@@A@@@A@@AA@@@A@@@A@@AA@@A@@@@@AA@A@@AA@A@@@@@@@A@@@@@A@@@@@A@C@A@@@@@@@BB@@@@@@AB@@@@@B@@@@@@@B@B@@@B@@@B@BAB@BAB@BABA@@@AB@BA@@@AAC@CACA@@AA@AA@"],encodeOffsets:[[126547,42895]]}},{type:"Feature",id:"210114",properties:{name:"于洪区",cp:[123.308119,41.793721],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@EGGK@@GGCCMEEAMESCAF@@AFCDAF@BABADAD@@CF@BCD@@AFABCFCFAB@B@@ABAB@B@BAD@@@@AB@@@DDBB@B@@@@@@@B@B@BB@@BB@@B@B@F@FB@@B@D@BB@@D@B@@@B@DB@@DB@@@@FDBB@@B@@@BB@@DB@@B@B@@@@BB@@@DDBDB@@@B@HADADAB@@@DA@@DA@@D@FA@AD@BA@@B@B@BA@@B@@@B@@@B@BAB@B@@@D@@@B@DAD@D@@@@@B@@AB@B@B@@@B@@@@@B@@A@@B@@@@@@A@@@@@@@@@A@@@@@A@@@@@A@A@@@@@A@@@@@A@@BE@@@@@@@A@@@CB@]CGAC@CACCCCCC@@"],["@@AAAABA@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@A@@ABA@A@@B@@KFEFA@@@@@@@A@@@ABABA@@BA@A@@@A@C@C@E@C@CAC@A@A@AA@@A@A@@A@A@@@@@A@E@C@E@@@C@A@@@A@A@A@@@C@@@A@@@A@C@A@@@C@A@C@A@C@A@@@A@A@A@C@@@A@C@@@@@A@E@@@@@A@@@@@@@A@C@@@@@A@A@A@@@A@@@@@AB@@A@AB@@@BA@@@@@@@@A@@@A@A@ABA@A@A@A@@@A@CBA@A@C@@@@@E@A@EBC@I@A@C@G@G@@@@@@@@@A@C@@@@@@@@@C@@@AB@B@BABADAB@@@@@B@B@@@B@@@@@B@@@B@BBB@B@BA@@@A@AB@@@@A@A@C@C@E@IAE@C@A@A@C@CAA@A@AAC@AAA@EAECAA@C@@A@@@CBAA@@A@AA@@@@@AA@@A@@C@@@@@@AAC@@@A@@GBC@C@@@A@@AA@@AC@@C@@A@C@A@@CA@A@A@CAA@A@A@EAAAA@G@EAIACAA@CACACACAEACAECGF@@@@@@@@BB@@@BB@@@@@AB@@@@@@@@@BC@@@AB@@B@BD@@@@@B@@A@@@@@@@@BBB@@@@B@@@BD@BD@@@@@@@@@@B@@@@@B@@@@@@A@A@@@A@@@@B@@A@@B@BBF@@@B@BBDA@C@@@BFBD@@BA@@B@@@B@@BA@@@@@@BBB@@BB@@@B@D@@B@BBB@FD@@BB@@@@@@B@DH@@A@@@@@@@@B@@@B@@AB@@@B@@A@@@CBA@@@@@A@@@@@@@@@@B@@@B@@@@@B@@@@@@ABABA@A@@AA@A@AA@B@@@@@B@@@@@B@@@B@@@@@@B@@B@@@@@B@@@@@B@@@B@@@BA@@@@D@BB@@@@BB@@B@B@BAFCF@DCFAD@D@@@@A@@@ABA@@@A@@@@@@@@C@AE@@D@@A@@@CBC@A@C@AB@@@@@@@D@B@B@@A@@@A@@@AACB@BA@@@AB@@@B@@A@@@@@@BA@@@C@@@@@@B@@BD@@@BB@BBB@@BBB@BBBBFDD@@@BBHBB@@@BA@A@E@A@@B@@DDBB@BB@@@DABA@A@@@@B@@ADBB@BAD@@BB@AB@@AB@@@@FD@BFDBBBBDB@BCBABEDIH@@@@@@EDCB@B@@@BA@ECEE@@A@@@ABCBGB@@@B@@@@@@@B@@A@@B@@A@ABA@@@@B@@@@@HBJBFFA@@@B@@@DBD@@@@B@B@@@@@BBB@BB@BBB@BA@@DA@@@SJIDGDA@@@B@BFBFB@@@A@A@@@@B@@@B@@@@AB@BA@BB@@B@@@@B@@B@@@B@@@BA@@@@B@@@AB@@@@AB@@@BA@A@AB@BB@@@@@@B@@CD@BA@@@@@A@@@@BBB@B@BB@BB@@@BBA@@BB@@@@BADA@A@@BA@@@A@@@AB@@@D@@@B@@B@@B@FBB@@BBBBBBBBDDF@B@@@@ABA@@BC@AB@@@@BDBB@BBB@@@@B@B@H@BAB@BAB@BFD@BB@@@BD@DCB@@@@B@@@D@B@@@B@D@F@@@@@@FAB@@B@@@@@@AD@@@@BB@@BB@@@B@@@B@B@@H@BAF@D@DA@@F@FA@@@@@@B@@D@B@@BB@@@@@@B@@@@@DD@DBB@@@@@@GD@@@@@BBBBDB@@BB@BBBB@@BB@@@@B@DBDB@@@@@@@@@BA@@D@B@@@@@@HFB@@@@@BA@@@@@@B@HD@@@@@@@@CFB@DE@@B@@@BBBBB@@@@@@@BABA@CBBB@@@@@CHB@BA@ABA@@@@@BDBD@BB@@@@@@BB@@BB@@@BBD@@@BBB@B@B@@B@@@BB@@@@BDBD@B@@@BB@B@@AB@@@@@@@B@@@H@B@@@@@DJBF@@@@@@A@BB@BDDBB@@@@@@F@FA@@@@@@BB@BB@@@B@@@@@@@@@BB@BBB@@@@@@B@BAB@@@@@B@@@@@@@B@@@@@@@@B@BAD@B@@@@BB@BBBBB@@@@B@B@@@@BBBBFBBB@@@@@@@@A@@BB@@DFB@@@B@@@BA@@FAB@@@@A@BB@BDFFJEBBB@@BHEB@@A@@B@DC@@@@@A@@A@CE@@@@@@B@BABA@@B@@@@A@@A@@@@@AC@@@@@A@@@@@@@A@@CE@@@@@AB@@A@@@@@@@A@@@@A@@@A@CAA@@@A@@@AA@@AC@CACAAAC@AACAE@@@@@@BAB@J@@EA@B@@A@@BABAB@BA@@B@@A@@BABA@@@@@@@A@A@A@ABA@@@A@AB@@@@@B@B@D@@@BA@@@@BA@A@@B@@A@G@A@@B@DBFB@A@@B@@@@@@@B@@A@A@@@A@ABA@@@A@@@A@@BA@@@@@AA@@A@@@A@@@@@@A@@C@@@@@A@@B@B@@@@@@@@A@@@@@@B@B@B@B@@@@@@@@CB@@@@@@@@E@A@A@@@@@@B@BC@@@@FDFBB@B@@@BAB@BAB@DAD@BAB@BAB@DA@@B@@ABADA@@BADA@@B@@@@@DACC@@@@@@B@@A@AAA@AAA@@@@@A@@@@@@@@@A@@@@AC@A@@@A@@BCBAB@DAB@B@DA@AD@HAF@B@B@@@@@B@@@B@@@B@B@@@@@B@@A@@@A@A@@@A@A@@@@@@@@B@B@@@D@B@B@B@@@B@@@B@@@B@@@@@B@@BB@BD@@@@B@DA@@@@@@@@@AAA@@@@A@@@@@A@@@@AA@@AA@@C@@@@B@FAB@@@@@AE@@@@@@B@@@B@B@@@@@BB@@@@@@@AB@@@A@@@@@@@@AA@@@@@B@@@BA@@@@@@@A@A@@@@@@B@@A@@@@@@CA@@A@@@@@@BA@@AA@@@@A@@@@B@BA@@@@AA@@A@A@AAAA@@@AAC@AAA@@A@@AC@BEB@@@@@BA@@D@@A@@@@@@A@@A@@A@@@@@@@@@@@@@@@@A@@@@@AA@A@@@@@@@@C@E@@@@B@@@@@@A@ED@EK@@@AA@@@@@@@@@@AB@@A@@@@@@B@@@A@@A@@B@@@@BB@BBB@@BB@@A@@@I@A@@AA@@@@@@B@@@@@@@B@@@@@D@@@F@P@@@@@B@@@@@@B@@@B@@B@@@B@D@@@BAB@B@FABAF@FABBJB@A@@@AB@@AB@BABA@A@A@@@@@A@@E@@A@E@ABA@E@E@A@C@@@C@A@@BC@A@A@C@C@A@@@@@A@@@@@@@@BCIA@@@@@@@@@@@@@A@@@@@@A@@@@@A@A@AAA@BA@@@A@@@@@A@@@@@@@@@A@A@@@@@ABE@AB@@@BB@@@@B@B@@@B@DBB@B@@A@@@A@@A@@AGC@@@@A@CA@@@@@A@@A@AA@@@AA@AA@@A@A@@@E@A@@@A@@@@@ABA@@BD@@@@BC@@CA@@BA@A@A@@@C@A@@@A@@AA@@@AD@B@B@B@DA@@@A@@B@@@@@BBBA@@B@@A@EJABABA@AAGAA@@@@@@@ABA@ABGCMCAACA@@A@AAA@AA@@AA@@B@B@B@B@B@BA@@@@B@@A@@@@@@@AB@AC@@@@@@BA@AB@DABABAAA@@@@@@AA@AA@@@ABABAB@@ABA@A@@@@@@@@@@A@@@A@A@@@@@@@@C@@A@@@A@@"]],encodeOffsets:[[[126309,42742]],[[126316,42838]]]}},{type:"Feature",id:"210115",properties:{name:"辽中区",cp:[122.765409,41.516826],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@AB@@CB@@C@A@C@AAAAACAC@ACA@@@CA@CAC@A@@@CA@@A@@@AAA@EDBBA@ABCD@BA@@BAFB@AD@BCDBBBB@@@DA@@@A@@B@@@@DJ@@@@@@G@ABA@@@@@@DBBG@@@@D@B@@C@ADCJCFEFMD@BUEe@EB@@@@EDCDBFBDFFLHNN@@DJBFHHJHB@BBNLJFCBAB@@A@@@@@EB@FBDBBDBD@T@F@BB@B@D@BABCDEH@BBDB@F@F@DAF@H@DBB@BDFJBDFHHFPLPNDBBBBB@@BBDDDBBD@DABABA@A@EAEAG@CBCB@F@DBFBH@FBB@JBFAD@DBBBDFF@D@DABCBIDGFEDCFAN@DBBDBF@F@DBFDDF@F@DCFC@E@UCA@CBAB@BD@JBDBDD@FAFAJ@BELCHADELAHBBFBHDFDDF@BADABEDIBCDCB@B@BBDPHDDFF@BABEBABABBF@@BDDD@FABA@EBCBGD@@GCKC@@ECMCKBATBLGJYBKFGDEDGJMFIBYGUCSE]IULCFBB@@AB@@A@@@@F@BB@@@FBBB@@@D@BB@DB@@@HKD@BAD@@@@FABH@BGCGACCAB@@B@@BB@@BAB@@@@ABA@ABA@@@@@AB@@@B@@A@A@@@@B@@AB@BB@@@BB@BA@ABA@CB@@@B@@B@BB@@BB@@@@BA@A@@B@@@D@B@B@@@@B@BBB@@BBBBB@B@BB@@@@@@@BA@@@A@@B@@BBB@B@@B@@A@@@ABAAABA@@@@BABAB@@AD@@@B@@B@B@@@B@@B@@@@B@@@@B@@BD@@ABABA@@B@@BD@@DD@BBD@@BB@@AB@@BBB@@B@@@@B@B@@@D@BB@@BB@@A@@B@@@@B@@@B@BB@@@B@@@@A@A@@@@B@BDFBB@@@B@@@@AB@@@B@BB@@@B@@@DCB@@@DAB@B@@@B@@B@@B@@B@@@@@@B@@@@@@@BB@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@A@@@@@@@@@@@@B@@@B@@@@B@@B@@@B@@@@B@@B@@@@@@@@@BB@@@@@@B@@@@@B@@@@B@@B@@@@B@@@@@@B@@A@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@A@@@@@@@B@@@@@@BB@@@@@@@@@@@B@@@@@@@B@@@@@@@BB@@@@@@BB@@@@B@@@@@BB@@@@@@@@@B@@@@@B@@@@@@BB@@GDE@GB@HBN@B@F@@@JTAFEFE@@D@DBB@HDRLCRBVDFJNVFDBLH@F@@@@ERHHIH@@BB@D@@@@@@A@@@@B@B@@DJDJHFFFNFNHBBHFBCFFNJBBBDBHFNDA@AHA@@BBJDAK@@@A@A@A@@@@BAB@@@@A@@F@BA@@@A@@B@B@BA@@B@B@@@@B@@@@B@D@@C@C@A@AF@@@@ALC@@BAD@@@@A@C@@@@BA@AB@@BB@BA@@B@DA@AB@@@AA@@@@B@B@B@B@BBB@@@@@@@CAAAA@@@C@@@CD@@@@@A@@DA@@FA@@FD@@BA@@BB@@B@@@@@CA@AB@D@BDB@@@@ABA@A@A@@@@B@BAB@@A@@B@B@BBB@@FBBB@@@@A@@@@BA@@@BB@@B@@@BFA@@@G@AB@@A@C@AB@DABDB@B@@@BABE@@B@@@@@BAB@@@@ABCB@@@@B@@FBBB@@DD@@B@@@B@HA@@AAA@B@C@@@@@@@BA@C@C@@@@DBDAB@@@@@CG@@B@@@DCBA@@AAFED@DABABCHU@A@@B@B@B@@B@B@@B@B@BB@@BD@BBHBD@@BBB@DB@BB@@B@BH@B@B@@DB@@@B@@B@@FFHLJH@ABA@AB@@@HBJB@@@@@@B@@@@@B@@B@B@D@BC@CDBF@BB@B@DD@B@B@F@B@BE@DDB@B@HBDBDB@BBD@@@ABIB@HCTCPAFA`ERCH@XED@REFCHAJAPABAJAB@BBB@DBBBBD@@BB@D@@H@PED@DBFDD@BEBCB@@@B@@@B@@A@@B@B@@@@@@@@B@@B@@@@AB@@@@@@@@@BA@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@AB@@@@B@@@@@D@BA@@D@@@DADB@@@@@@@@BA@@@@@@@@@@BA@@@@@@B@@@@@@@DAD@@@@@B@@@BA@@B@@@B@@@B@@AB@@AB@@A@@B@@A@@@@@@BA@@@@BA@@@@@@@ABABA@@BG@ABA@ABADC@@HGDCD@HCBD@@B@@@@B@@@BA@@@@B@@BB@@@@BB@@@B@@@@BA@@@@BA@@@A@@@@AA@@BABEF@FB@@@@EC@@A@D@FA@BBB@B@@BB@@B@DB@B@@D@@@BB@@B@@BBBB@@BBBDD@@@@@@ABAB@@@@@@@@BBBBDBFFDD@@@@B@PDD@@@B@@@DA@@@@B@L@@@B@BBAA@@AA@@@@BA@A@@@@B@BADABABAB@@@@AB@@@BAB@@ADCCA@@@@BA@@@@A@@@@AAB@@@@@@@@AAA@@@BA@@B@B@DA@@B@@@@@AA@A@AAAAE@@@@@@B@B@D@@@@@@@@@@@AA@@@@@AAAA@@@@AAA@@AA@A@@@@@@A@@@@@@@BB@@@B@@@@@@@@A@@@@A@@AACE@A@@@@@A@@@@@@@AA@@@@@@@ABAA@A@@@@B@@@@@AA@@@@A@ABCC@@@@A@@@@@AB@@@@@@@@@@@ABABC@@@@AAAA@@@@B@eo]kYUDSDoMHGfNpSUG[IKCCmAE@MGKQ[ACI]`KLACCFCAAAAB@BAAA@AHCCCA@ECECAAAAA@AAAACACCA@@@AAA@@@@@@@@A@@@@@@@@@@@@A@@@@@@A@@A@@@AAA@BA@@@@@@@@@@A@BA@@@A@AABAAA@@@@@AA@@@A@@A@@@@@@@@A@@@@@@A@@A@@@A@A@@@A@@AA@@B@AA@@@A@@@@@A@@@A@A@A@A@@@@BA@@@C@@@A@A@@BC@A@@t@BKXAAIHE@E@C@C@AACJCCEDCEGFCBFDACCB@EGEI]NCCAA@@BA@@BABAFAJBB@CEACAA@@CAAAGEAAEEAABA@@VCLCB@LA@@AA@@EBA@CBE@AAA@BABA@AAAAA@@A@A@A@@@AAA@AAA@A@A@@CCA@@A@A@CB@BA@@@A@@@AA@@A@@BG@@@@D@@CCEC@@CBCBAB@@C@EAAA@AED@BFH@@CAA@EAACAAEGEAA@A@C@A@@BCBADABBD@BABAB@@C@GEGCCAAA@AAA@A@AB@BC@AAQ@ABA@A@CAC@CCCCECCAAA@AE@E@AC@EAC@C@C@E@EAEAEAEACAC@A@C@IBC@@ACA@CAAA@@AAAC@E@K@C@E@C@CACA@AEGA@A@ABAB@B@BBDBB@B@BC@E@A@AA@ACAAACCCECA@A@C@AEACAAAA@AEAE@EBC@EAE@CBC@A@AA@C@KBCAA@A@AAAA@AAA@ACAEAE@I@C@AA@ABABCJE@C@AEACAGAG@CAAACECC@@AACAA@CAAABAHMDEBA@C@CAAAAA@CAEAAAACAA@CAC@CAAGCEAIAGC@AA@BCAEAEACAACCC@CCAABCAA@C@@@C@CBE@AAC@CBA@@DE@A@AACCCACGECCA@CAICA@@@A@ABA@A@"],["@@ECA@ACECACAC@CCCJAHA@@FC@@@@@@DFCBDF@@@@DAFAB@D@B@BAB@CG@@A@@AEEA@@@@@DABAAE@@BA@@@@CE@A@@KCEA@@@AA@AACDAA@@CB@@AA@@@AB@@C@C@@AC]N@@@B@@@@CB@AEDBBFFBBHB@@@@@@ABA@KAC@CCAACCGFBJWBALs@@@@BAD@@@B@B@@@D@@AB@@@@@B@B@B@B@@@B@@@@@B@@BBA@@@BB@@@B@@@B@B@@@BB@@@@@@@@B@@@@@@B@@@@B@@BB@@@@B@BBBA@B@B@@ABB@@@@@@@@@@@ABB@BB@@B@@@@@@@@@@@@A@@@@A@@@@@@@@A@@@@@@@@B@@@@@BA@@@@@@@@@AB@@@B@@AFAB@@@B@@@@BB@@@@@A@@@BB@@@@B@@BB@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@BB@@@@@@B@@@@@B@@@@@@BB@A@@@@@@@@BA@@@@@@@A@@BA@@@AB@@GDBBBBB@BBBBFDFDB@DDGD@BBBABA@BBBBEDDDKB_LJ^VFrKRGZKh@@I@EAi@I@OAAGACE"]],encodeOffsets:[[[125591,42200]],[[125921,42507]]]}},{type:"Feature",id:"210123",properties:{name:"康平县",cp:[123.343699,42.72793],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@B@@@B@B@@@B@@@B@@B@@BB@@@@@@BA@@BA@A@@@A@@A@@A@@@@@A@@@AB@@BBA@@@A@@@@@@@@@@A@@@B@@A@@@@@@A@@@@AA@@AAA@@@@B@@AB@@@@@B@@@B@D@B@D@@A@@@B@@@@@BB@@B@BBB@@@@A@@BA@A@ABA@@BA@@@@@@@@BB@BDDB@BB@@BB@@B@B@@@@@B@BBB@@@BB@@@BB@@BBHD@@B@@@@BB@BB@BB@@BB@BBBBBBBB@@BBBB@@@@@@@@AB@@A@@@DDBB@BB@@B@@BB@@@BB@@@@@@@@@B@@AB@B@@AB@@@@D@@@B@B@B@B@@@B@@@@AA@@A@A@A@@@A@@@A@B@@D@B@B@B@@@B@BB@AB@@@@BBB@@BB@BB@BB@@B@@@BBB@@BB@B@B@@B@@B@@B@B@B@D@B@FBB@B@B@D@D@D@B@D@@@D@B@B@D@F@B@D@HBB@B@B@@@B@B@D@@@B@BA@@BA@@BA@AB@@ABA@@BA@ABA@@BA@@BA@@BABABCBAB@BA@AB@@ABDDF@B@@@@B@BCBABA@AB@@@B@@@B@@BD@B@B@BBB@BBD@BBDBHD@@DB@@BB@@@@ABABA@@@ABA@@B@@BDB@@BB@BBBB@@BBBBBBBBBB@@BB@@B@B@B@D@@@B@B@BBB@B@@A@@BAB@DBBA@@@AB@@A@@B@BB@@BA@@B@@@BB@@@AB@B@B@B@BBB@@@@@@@BA@@@A@@@AB@@@BB@@B@@@B@@@@BB@@@@@B@@@DA@A@@@A@@B@@@@@@@B@@@B@@@BA@@B@@@B@@B@@B@@@B@@@@@DCB@@@B@B@B@BA@@BA@@@@B@B@B@DBB@@@BBD@F@B@BBB@B@@@@@@@B@@@@BB@@A@@BA@@DA@@B@D@D@B@B@BB@@@B@@@B@@@@@B@@@@@@B@@BBAB@@@B@B@BB@@B@@@@@@@@@@@@@@B@@@@B@@@@@B@@B@@BB@B@@BBB@BB@@B@@@@@B@@@DB@@B@@B@@BB@@BB@@BBBB@@BB@@BB@@@@@@@BB@@@@@@@B@@ABAB@@@B@@@BBBBBBBB@@@@B@@@B@BA@@B@@@@@B@BBBBBBB@DDB@@@BBBAB@@@B@@@B@@@B@@@BB@@BBDB@BB@@@BBBB@@DB@@@@B@B@B@FB@@B@D@B@B@B@@@@@B@B@B@@BB@D@B@DBB@DBB@BBD@B@BBB@DB@@DBD@@@B@@@B@@@B@B@@@B@B@@@DBB@DBB@@@B@@@BBB@BBB@BBB@@@@@BBB@@BBBB@BBDBB@B@@B@@AB@@@BA@@B@@@@@@@@DBB@B@B@BBB@BBB@B@B@B@B@B@BAB@B@B@B@B@B@B@B@F@B@@@B@B@DBB@B@BBB@DBB@B@@@BAB@BAB@@@B@@@BB@@BBBB@BB@BB@B@@BBA@@@@@A@A@@@A@ABABABA@@@AB@@A@A@ABE@@@ABC@@BA@CDA@A@@@ABA@CBA@AB@@A@ABA@A@C@@@A@ABA@@BABA@@@A@@@A@@AA@@AA@@@A@A@@@A@AB@@A@ABA@ABABA@@@ABA@A@A@@BA@@B@@@D@@@@@DABBB@B@BBB@BBDDF@BBDDFBDBD@BBB@@@BBB@@@@@@@BB@@B@@B@BB@@B@BB@@BB@B@@@BBB@@@@@B@@@@@B@B@@@@@B@@@B@BBB@@@B@@AB@B@@@B@B@B@@@B@B@BAB@@@F@DA@@B@@@B@@@@@B@@@B@@@@@@@B@@@@@B@@@@AB@B@B@@@@@D@@@B@@@@A@@B@@@@@B@@@@@B@@@@@B@@@@B@@@@BB@@@@B@@B@@B@@@@BB@@@@@B@@@@@BB@@@@@@BB@@@@@@BB@@@@B@@@BB@@@@@BB@@@@B@@B@@B@@@BBB@BB@@@BDDB@@B@BB@@B@@BB@@@@@@@BB@@@@BBB@@@@@@BB@@@BB@@@BB@@B@B@@B@@B@B@@@@@B@@@B@@@B@B@@@B@@@B@@@B@B@@@B@@B@@@@B@@@@BB@@@BB@@@@BBB@@BB@BB@@@@BB@@@@B@@B@@B@@@BB@@@@@BB@@@@@@B@@B@@@@BB@@@@@@BA@@@@B@@@@@B@@@@@B@@B@@B@@@@@B@@BB@@BB@@@@BB@BB@@BBB@@BB@@@B@@B@@BB@B@BB@@BBB@@BD@@BBBDBDDB@@BB@BB@BB@@BB@B@@B@@@@@@B@@BB@B@B@B@BB@@B@B@BBB@B@DBB@@@B@B@BBD@B@@@B@F@DBB@B@@@B@B@B@D@@@F@@@B@B@@@D@@@BBD@B@D@B@B@B@@@B@D@B@D@B@BBB@B@B@@@B@D@B@B@BBB@D@BBB@@B@@@@B@@@@@B@@@BB@@B@@@BB@@@@B@@@@@B@@@B@B@@@DB@@B@@@B@B@D@B@B@B@BB@@B@@@F@B@@@BB@@F@B@BBB@@@B@D@BBD@B@D@DBB@B@B@BBB@@@B@B@FBBBB@B@B@@BB@A@@@@B@@@@@B@@B@B@@@B@@@BBB@B@@@D@BBD@B@B@BBB@D@B@B@BBB@BBB@@@BBB@@BB@@B@@@BB@@BB@@B@@B@@B@@B@@@B@B@@@@@B@@@B@BBB@B@@@@@B@@@B@B@@@B@@@B@@@B@BA@@B@@@B@BA@@B@B@B@@@B@B@B@@@B@@BB@BBB@@@B@BBBB@@BB@@BBBB@@BB@@B@@B@@B@@BBB@@B@B@B@B@@@B@D@B@BBBA@@B@B@B@@@B@BBB@@@B@@BB@B@BB@@B@@BB@B@B@B@B@@@BAB@B@FCDCDAFABABA@ABABA@@BA@@@CB@@@@A@@@@@AB@@@B@@@B@BA@@B@@@B@@A@@B@@@B@@@DD@@@@B@@@BA@@@@B@@A@@B@@@@@B@@@@@@@DBBBB@DB@@@@DB@@B@@@@@@@@@AAC@@A@A@A@@BAFAB@B@BAB@@@BA@@DABADC@ABA@@B@@@B@B@@@@@B@B@@A@AB@@@@A@@A@CAC@@A@@B@B@B@B@B@@@BA@@@@@@BA@@B@B@B@B@D@B@D@D@D@B@@BB@B@@@B@BB@@B@@@B@@BB@@@@@B@@@@@@@@@@AB@@A@@@@@A@@@A@@@@B@@@FA@ABA@AAA@AAC@ABADA@A@A@C@ABADC@@AAAACAA@C@E@C@A@AACCAACCAAAAB@@CHIFMBCBCDCDCB@DAB@DCBABAF@BAF@B@D@B@H@@@BABA@A@A@C@AB@@AFADAFAJGHGDA@A@A@ACCACAAC@AAA@C@EBA@CA@@AAAECE@C@ABABADCFADAH@BAD@@ABA@A@ABA@@AAA@A@@A@C@A@C@@AAEAKECACAAAAC@ABC@ACA@@@ABA@AJCBANAJADA@ABAAAACIEECCCECCCCA@A@C@AB@@AB@D@BAF@B@@C@A@AAAA@@ABA@CD@BABABA@@BAF@BA@@@EB@@@B@@@BAA@GEC@EAA@CA@AA@@AA@BCBCBA@AA@@AA@BC@A@CBCBABADE@@@A@AAA@ABAB@@AB@@ABAAA@AAAA@CAA@ACA@@AB@BCFCBCFEDC@A@A@A@A@AAAA@CAA@A@C@ABABC@A@ABA@C@A@GAAACAAAA@@C@A@ABADEDADABAB@@@BA@A@@AA@C@CAAAAC@C@ABA@CAA@ACAGAGAACCEEAAAA@ACAAAEC@@@A@C@ADCFABAHGDE@A@A@@DCDABAJ@BABA@@@C@CCCCACAAACAGBAA@@AA@ABABABADAF@DAFAFABADABCB@BCBEAAAAA@ABABCBA@A@AA@AAAA@@AB@B@FABADCBABCDGBABA@@@@FBFBBBBBDDBBBDABBDDFBBB@B@BBB@D@B@D@BAFAFADAB@@A@A@ABA@@AC@AAABA@E@ACC@ACA@A@@@AAAA@A@A@I@EAG@A@@@AA@A@@A@@@@@A@@@A@CA@@@A@@AA@A@@@@@A@@@A@@@AAC@@B@@@@AAA@@@@AB@@A@CA@@BA@@@@@A@@IB@@@@B@NC@@AA@AA@@@A@A@@A@@@A@@AA@@A@A@@@EAA@CDA@GJIFGBMCMCQCQ@I@IAY@WIUGUIOGGC@@@@AAKEKAKBSDWBKACCACAAABA@@@CA@@@AABCAEB@B@B@@C@@B@@@BA@AD@@ICGA[G@@ABA@ABGBCBMFULSEOSGGCMKG@@@@EAGEIGKGOMOIOGMAMASAMKKMOKW_MMKKOEKLCFCLGFIDEAC@OCA@A@@@@@A@A@@@C@@B@@C@A@@B@@@@AB@ACC@@@@C@EBE@@AA@A@AA@@@BA@@BB@@@B@@@B@@@B@A@@@@@@BB@@@@BA@A@@@aAA@OCBABA@@GAA@@@@@BGE@IEGEICC@@B@@@@@BA@@@@@A@@@@@@@A@@AA@@@AA@@@AA@@BA@@@@@@@ABB@@@BBD@BB@@B@@@@@@@@BA@@@A@@@@@@BA@@@@@@@A@@@@@@@A@@@@B@@A@@@A@@A@@AA@@@@@@A@@@A@@@A@@BB@BB@@@BB@@@@@@@@@@BA@A@@@AAA@@@A@A@A@A@@@A@@@@@A@A@@@@B@@@@BB@B@@@@B@@@@@B@@@@@@@@@B@@@@@@BA@@@@@A@A@@@@BA@@@AA@BA@ABADABABGDGFC@ABE@CBCAABA@A@ABABA@C@@AAAA@A@AB@BAB@B@DBF@BBDBBB@@BA@AB@@@@@B@@A@@@@B@@@AA@@@@B@@@@@B@@@B@@@@@B@@ABAB@B@@@@@BA@@@@@A@@BA@@@@@@@@BA@@@@@@@@@AB@@@@A@@@@@@@@BA@@@@@B@@@@B@@A@@@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@B@@@@A@@@@@AB@@@@@@A@@@@@@@A@@@@@@@@@A@@B@@@@@@@@@@@@A@@@A@@@@@@@@@@@@@A@@@@@AB@@@@A@@@@@A@@@@@@@@@A@@@@@A@A@@AB@@@A@@@A@@@@@@@A@@@BC@@A@CA@@@AB@@@BBBB@@B@@A@@AAAAAAAB@@CEAA@@ABA@@@AAAA@@@@A@@BDBED@AA@@@CB@@AB@B@@A@C@CAC@@AFC@@@AA@A@CDCBCD@@A@IDAAAA@AAA@@A@@@EBEDCBABA@@@@A@@@@CBC@@@AB@@@@AAC@@AA@@BA@CD@@@@@@@@A@@AABA@@@@A@@@@A@@@CBA@CBEBABE@@@A@@BCBABABI@@B@@GB@@@B@@KDA@ID@BAB@@CB@@@FG@@@@B@@BDABFFB@BBBBCBAB@@IDIDEBC@ABCBA@ABCBCB@@AAEAA@GEA@A@A@ABA@EB@@C@AAABA@A@@AAC@@@BA@AD@@A@@AAAC@@@@@KJINQLMHIF@B@@B@@@@@CD@@@@@@B@EL@B@B@@@@AB@@B@@BB@@B@@B@@@@BB@@BB@C@A@A@@BAA@BA@@B@B@@AA@@@@@@@@AB@AA@@@@@B@A@@AA@@B@@@@@@A@@@AA@B@@@@@@@@@B@@A@@@A@@A@@AB@@A@A@@@@@@@A@@@A@@B@@@@A@AA@@A@@@@AC@A@@@@A@@CBGBC@QAOAABA@@@@F@D@D@@BBABAD@B@@@@IHA@CBABA@AB@@A@@B@@@FA@CDA@ABC@@@CBABO@CBGBABABCDGHA@ABB@BB@BB@DBALLJJFLD@@BDB@@@BB@@D@DAB@B@@BD@DDBB@@BDBB@DBB@@JAB@@BBBBBBBD@LF@@@BB@HANGBADAB@JADAB@B@@@B@@@@@@BFAH@B@BAD@BCBABD@F"],encodeOffsets:[[125788,43744]]}},{type:"Feature",id:"210124",properties:{name:"法库县",cp:[123.440294,42.50108],childNum:1},geometry:{type:"Polygon",coordinates:["@@GAOOCAC@IBEFCHCDCBC@G@E@MDA@CBC@GAK@GBGAGCE@GDEDCF@FFLAFCFGBE@IAGACEAEEEC@GBEDGBG@I@G@ADAHBFDFBDADED@JAPIP@BOLUPQNEFIHIBMCQGGAK@GFGJONOJB@ABEBBHMA@@@D@@@@@@BDMFBDOF@@@@BBAB@@@@ABADAB@@ABCB@@@@A@A@C@AAE@A@@@C@@@A@CBABC@@@@@A@@@@A@@ABABA@CAA@@@@@@@BBB@EBAAA@@@AAA@AA@AA@@@ABC@EBMMC@CA@@E@O@SIECGCIEK@A@@AA@A@@AA@@@@@@@EAA@A@C@GDA@CBE@EDEB@B@@@@@@A@EBC@C@A@@@C@@@@B@B@@CBA@@@E@A@@BA@@@IB@@@A@@AA@@ABC@GDEB@@AAA@A@@BA@@A@@@@@A@@A@A@@@CBA@@@AAA@AA@@AAA@AA@@@@B@DA@@@@@@E@A@@@B@@A@A@AA@@@CB@@AA@E@@@@A@AB@@A@CBAAABA@C@C@CB@@@BBB@@A@A@@@@BC@@BA@AAA@@@EBC@EACA@@ABB@@B@BBBCB@@@@@@@BGB@@@BB@@@@B@BCB@@A@C@ADA@A@ABABA@AA@@CC@@AA@A@@A@A@@AAAA@A@@@@BBB@@DB@B@B@@A@C@C@@@A@C@ODMDQS@@CACA@@AA@A@AA@@@IDA@@BC@AB@@EB@@A@@@@@EAG@AA@@@@AAA@A@A@A@C@ACAA@@E@EBGBCBE@ABE@@@A@A@C@C@EBA@C@CBC@EBEB@B@B@@@@@B@@@@BBEDC@A@G@@@A@CIA@@@E@@@@@@CA@@@A@A@A@@@@@A@AA@CC@@@@AB@@@@A@@@@CB@@AAABA@@AAB@@@AC@@@@A@CAA@@A@C@@ABA@@@@@@A@IBCBC@CD@BABA@A@@@ABCHA@EBC@C@AACB@B@BD@D@@@@@CBA@A@DD@@@BEB@BCBA@GB@@@@ABB@B@DB@@DCDA@BB@@B@@@@CF@BB@@BB@BBB@@@QHBBB@BB@@ABRFJD@@BBB@JFJBN@B@@BD@RLLFHFB@BB@@B@@@HDdZXJHDFDJFDDB@@@@B@@@@B@@@HF@B@B@@X@DALBBH@D@DBJBVABA@@@BB@BBB@BI@BHBDBBBB@BBBBD@B@@@BB@@@@BA@@B@@B@ABA@@AE@EAA@@@AB@B@@A@@@ABA@@BA@A@@BABA@@@@B@@@@@B@B@BAFA@@B@BADAB@@CAAC@@@@ABG@C@A@C@@A@@@A@A@AA@@B@@A@@A@AA@@@A@A@@@ABA@A@AB@@A@AAAB@@A@@@A@@@A@ABA@@BAB@@CD@B@@@B@@AB@@@B@@@BB@DBDB@@BB@B@B@@A@@B@B@@@AA@A@A@@@@@A@@A@@@@A@A@@@A@AA@@@@A@AA@@C@@@ABABC@@BBDBD@B@B@@B@@@@@@B@@B@B@@@F@@@BB@@@@A@@@ABB@@@@BB@@B@@ABA@@@B@BBDBB@B@B@B@ABAD@BCD@@@@@@@@AAA@@@@AA@@B@@@BAB@@A@@B@@@@B@@@D@@@@B@D@B@D@@@@BBAB@@CB@BDBAB@B@B@@CDCD@@@B@@@BDB@BB@@B@@CB@B@B@B@@CD@@@BBBBB@@@B@@@BA@@@@B@@@@@BCBBBA@@@A@A@@BB@@BB@@@@@@B@@AD@@@@@BFD@@B@@@@BBB@@@BBB@@@@@@D@@@@D@@EB@@EAA@AB@@FBDBVJ@BRDXBbFV@@A@AB@@@@@B@BBB@BAB@@AB@D@BAHAH@B@D@B@@B@B@@@BFADA@@B@A@@BB@@@AB@@BB@B@@@B@@AJ@B@B@@DDB@@D@B@@@D@BB@B@DABB@@@@@@BB@B@D@DAB@BA@BDJLZPDBHD@@@@@@HD@A@@B@FABAB@@@BB@@B@BBDDB@@A@@DB@BFHFF@BB@@@BAB@@B@BB@@B@D@@@@B@B@@@DBD@@@@@@BB@@@ABCBABABBF@BCB@@@@@B@@B@BA@BB@@@@@@@@@DCB@@AB@@BD@BB@@@@BA@@D@DA@@@@@B@@B@BADAFCFA@@B@@@BB@BBBBBJCB@@@DCDADCB@B@@B@@ED@BD@DBD@B@@@@ABA@@DA@@B@@BFCCA@AB@@@@@BBBB@@B@BA@@BBDF@@BABBBBBB@@@BA@@@AAAA@@A@@B@@DBB@@@AD@@B@@@@@@@B@@@B@@@A@@BB@B@@@@@B@@@@@@@@@B@@@@@B@@@@@BA@@@@B@@@@@@@@@@@@@B@@@B@@@@@@@@@@@@@@AB@@@@@@@@@B@@@@@@@B@@@@@@@BA@@@@B@@@@@@A@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@@@B@@@@A@@A@@@@@B@@A@@@@@@B@@@@@BA@@@@@@@@B@@A@@@@@@B@@AB@@@@@B@@A@@@@@ABABA@@@A@@@@@A@@@A@@@@@A@@B@@B@@@A@@B@@@@A@@@@BAB@@AA@AAAC@AAE@C@ABA@ABAB@B@BB@BD@B@BABAB@B@BADBDAF@BAD@HEHCBABABCBAB@@ABB@@B@@A@@B@B@@@@@B@@A@@@@A@@@@@@@@@A@@@@@A@@@@@@AAA@@@@@A@@B@B@@@@@B@@@B@B@B@B@@@B@BB@@B@B@@A@@@@@@@@A@@A@@AAA@@AB@@@B@@@B@@@@@@@BB@@@BB@@@B@@@@A@@B@@@@@@@B@@@@@@@B@@A@@@@B@@@B@@A@@@@@@A@@@AAC@AA@@A@BA@@@@@@B@@AB@@B@@BB@@B@@BB@@@@@@@B@@@@@B@@A@@@@@AD@JDHFJFF@AH@@@@B@HB@@ABABPDB@bB@@B@B@@A@@A@@A@@@@B@A@@@A@@@A@@@A@@AB@@A@@BBB@B@@BF@FAD@@@@@DD@BBA@@@@@AB@D@@@@AD@@@B@B@@@@@B@B@PDD@FBJCHEDKDELKPFLLNNX`PLLNNLTBNBNBPHPJPNLHJHHFFB@@@@LHDNHHPTTFVKNEDAHABAB@BA@@\\HHBJD@@BCB@@A@@@AD@@@@A@AFADBBA@B@@DB@@B@BABBBDDDLBXATCLALBLFBB@@@@HDPHVJVHXJZ@JBJ@R@RDNDNDHAJEHIB@DCB@FB@@B@B@@@BB@@@B@@@BB@B@@@B@@BBB@@MDA@@@@@JA@@@B@@@@AB@@DBB@@@BA@@@@BB@B@@A@@@BD@B@@@B@@@B@@@@@BBB@@@B@@DBB@@@B@@@@@B@@@@BBB@@B@H@FBJ@B@B@B@@@AAA@@A@A@@DEDCDADCFCDALEBA@A@@BAD@DAB@B@B@@@JCHCFAJCBADA@AAA@A@ACAICA@AACAC@CA@A@ABAFBF@F@B@DAFGBCDGBADAFAHAD@HBB@D@B@BBD@D@D@D@BCBABAB@BA@@@AB@@A@A@AAAA@C@C@E@C@A@@AAA@CDGBA@CBC@CB@DAF@D@D@DD@@D@F@B@DC@A@I@ACC@A@A@AB@DBF@H@FBDABADABABA@CCCAACA@A@ABABC@@D@BAF@BBD@@BBB@@BDDDBBD@B@D@FCDAJADABA@A@AACCCACEC@@A@]AEACACA@AA@A@C@@AC@AAA@CAA@AAA@@@CDA@QKCAaaEECBEJ@@A@A@IA@BA@@B@B@BA@C@A@A@A@@@@BA@A@A@EBA@A@@B@@BD@@B@B@BAB@@@DBFH@BBB@B@@EBA@@@@B@@@B@@AD@@BF@B@@@@A@A@BB@@BB@@BBBDA@A@AB@@@@BBBBB@@@CHABM@A@@@DA@@@@@@A@A@A@A@@A@@A@@@AB@@C@@@@@@BA@A@@@ABE@A@ECQG@@@@@@GC@@@@@@BA@@@@@A@@@@@A@@B@@@@@@A@@A@@@CBA@AB@@BKC@AC@CGBIBA@@@@@BC@@@AA@BA@@@ACGJCCGAC@AEKCC@A@@GBKBWBA@C@AAB@B@@AAC@A@@B@AC@@@AAA@AA@A@ABC@CBE@@BA@@BA@@@A@AA@@A@@@@A@A@@@@B@@AA@CC@@@C@@@@ADA@@BA@A@A@@A@@C@AAA@@AAA@A@@CC@@A@@@@AAAA@A@@BA@A@@@@AD@@@@@@@@AB@@@@@A@A@@@A@BAA@ABAACBCAA@@@@@CCAA@@AAA@@@AA@@@A@@@@AAAA@@@AE@@B@@@BA@@BAB@@@B@@CAA@AAA@A@@B@@AB@@@BA@@@@CA@AAAC@@DB@@@@@@@AA@@@AA@A@AB@AABA@A@@AB@@A@@DA@@@CAA@@@@@AA@@@A@@@A@AC@@A@@@AA@@A@AAA@@@A@@@@E@A@AAA@AACAC@@@AA@@AA@@@AA@A@G@@@ABA@@BCAA@A@@@A@A@@BC@A@A@CCA@@AA@AAAAA@BC@C@@@@@AA@@@CAA@@A@A@@AAAC@@AAA@AACAAAA@A@A@@AA@@A@@CCA@@A@AAAB@@@BAB@@A@@A@AA@@@A@AB@AAB@@AB@B@B@@@B@BAB@B@@@@@BA@ABAACB@@A@@@@@AB@B@@@BCB@@A@@@AD@BABADAB@BA@A@@BAB@B@BC@CBA@@BAAACBA@CAAA@@@A@A@@A@@AAA@AAA@C@A@@BAB@B@@@A@@A@@@AA@@A@@@@@AA@@A@@AAA@A@@@@CB@@@@A@@@@@AAC@@@AAAA@AA@@@A@@@@@@BABAB@@@@AB@@@B@@A@@@AB@BAB@B@DA@@BAB@@AB@B@BAB@@A@A@A@@@AB@@ADA@ABAB@@AH@D@F@@@BA@@B@@B@@BB@ABA@ABAB@BC@@@C@@@@@@B@BA@@@A@@B@@@@@D@B@@@B@BABE@@DB@ABA@@B@B@@@@AB@@@B@AA@@BAB@BAB@@@@C@A@AB@@A@AB@B@H@@C@@@C@M@AAE@C@A@@A@A@CKCG@@AECIEKGGCCGKGICEACCAAAEAA@BBA@@@@@EB@@AB@@A@A@@AA@@@@A@@CA@@@B@@CC@@@A@@BA@@AA@@AACAB@DA@A@@AAAA@@ABC@@AAAAAC@@ABA@AA@@@ACGO@@BCDEBCBCBCDGBC@@KAIAAAAKAGG@CEAA@@@@@A@@C@CGA@@@AC@C@@AE@@JA@@@A@C@@@@HA@@@@AC@AB@AAD@@BB@@@@A@@@@BAD@BA@AAC@A@@B@HA@ADAAAA@@ABA@ECCGCCA@AAC@EAACAE@KDIDE@CEGEEEGAG@GBAB@B@BBDAFC@CAECCEAE@O@GCAGAGAIBK@"],encodeOffsets:[[126351,43161]]}},{type:"Feature",id:"210181",properties:{name:"新民市",cp:[122.836723,41.985186],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@CAA@AAA@@@K@A@@@@@CB@@A@@@C@OCA@@@@@CCEECAAAAA@@@@@@@@BABA@@@@@@CCAA@AA@AA@AA@@@AA@@C@@@@ACAA@@@AA@@@AAA@AEBC@B@@@FD@@@@EAE@AFAB@@BB@@@@@B@@AB@@@@AB@@@@@A@@AA@@@@AA@@@A@@B@@A@@@A@@A@@@ACGDC@CDGH@@CDAB@BAB@BAH@@ABAB@B@@@@@@AB@@@@AB@@@@@@@BA@@@@BA@@BA@@BA@@@A@@@A@@@AB@@A@@@@@C@CB@@@@@@A@@@@@@@AB@@@@@@@@@@AB@@@@@@@@CACB@@C@@@ABC@@@@@A@@@@@BA@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@AB@@@@@@@@A@@B@@A@@@@A@@@@@@A@A@@@@BA@@@A@@@A@ADAFC@ECCA@FBFBD@B@FBD@BAD@D@D@D@DDDDDDBDB@H@@DDCFAF@D@B@DBBD@F@BBBBD@F@DDAF@D@BH@DAD@DBD@D@@F@FF@@J@H@F@@@DLA@@@@BFBFJA@FB@@FBF@@BDHFDDFDNGDFDDBFBF@@DDD@L\\@HSFFHKDFPE@@BJVBHHLKDHHC@EB@DC@FRa@I@AHgGI@@@AH@BBBABABA@ADCLCH@BA@@CAEACAEGCORCDCDBJBTL@VJDBZBDFAHADAFCF@@@BABCBJJCLDLHPNJ~ejW@QZ@H@P@XIKIRCAILAG[JEAGAIDC@APK@@LLFFC@ABB@@@@B@@VJtTTAPA@@@@BA@@A@@@@@AB@@A@@@A@@@@A@@A@@@AAB@@ABA@@@@@A@@@A@@B@B@@@A@AEAEA@@@B@HCJCTI@@B@@CB@@AAA@AAAA@AA@@@@A@A@@@@@AC@C@@@A@@EBAEAI@G@@@@@A@@B@BAB@@@@AB@@@@A@@@@@@@A@@HADABA@@B@@@FFFDB@@A@@@ADAFC@@@@@@JGFCBADA@ACAAAAAEC@AEC@@@@BA@@BAA@@AC@ABA@CA@BA@@@@@@BABCB@@A@@AAACC@@@AB@F@B@B@@A@@AAAG@A@@CCAEAA@AAA@AA@AAA@@A@@AC@@@A@@@@D@@@B@@A@@@@B@@@@A@@BA@@B@@ADABB@@B@@@B@@@@A@A@C@@@@@@BAD@B@D@DA@@B@@@@CF@@B@D@@@@@@B@@@B@BA@@B@@@@@@CBCDE@CDEBE@A@A@AA@@A@@A@@A@C@@B@@A@@@A@@@A@@@@@A@@@@@AA@@@@@@@@A@@@A@@@@@A@@@@@ABBB@B@@BB@B@BABA@@@@@@@A@@@@@A@@@A@@@@@@@@B@@@@@B@DA@@B@@@@A@@BA@@@A@@@A@@@@@@B@@@CGA@@@@@@@AA@@ECA@AAA@@@@C@A@@AA@@AA@A@@@@B@@AA@@@A@@@AB@@ACAE@@D@B@AC@A@A@@AE@A@AB@@@@A@@B@@@B@B@@@@@@@@A@@@@@A@@@@@@@@C@@AAC@@A@@@@@AA@A@@@@@@B@@@@A@@@@ACA@@@BA@@D@@A@@@@@@@@BA@@@@A@@A@@AA@@@@@@@@HEFA@@BAB@B@B@@@B@@@@BB@@@@@@@@C@@@@C@@@A@@@A@AA@@@@@@BC@@@@@@B@@@@@@@CEACAA@A@A@@@@@@A@C@@@@@@@AA@A@@@@@@@AB@B@@@@@@@@A@@A@C@@@@@@@AB@@E@A@A@A@@@@DB@AB@@@@@@@@@@@@B@@@@@@@A@@@@BA@@@@@@@@A@A@AA@@A@@@@@A@@B@@AB@@A@@AACC@A@@@@@@@A@@B@@@BADAB@@@@@@AEA@@@A@@B@@@B@@@@@@@EEIG@@@@@A@@@@@@@@C@AAA@@BA@A@ABABA@@@@@A@@@AB@@@@A@@AACG@@@@B@@A@@@@@@@ACA@@@A@EBC@A@C@@@A@A@@A@@AB@@@@@BCD@@@@@B@@@B@@@@BB@@@BB@@B@@@@@@@@C@@@AB@@@@@@@B@@A@@@@B@@@@A@@@@@AB@@@@A@A@A@CCBAC@E@@@@@CBABA@A@@@@AACC@C@A@@@A@@@C@G@E@A@EBABABCB@@@@@@ACA@C@KBA@@@@AA@@@@@A@A@@BA@A@A@CAA@E@A@A@A@@@G@A@A@E@G@C@A@CA"],["@@OFG@@@@CAA@@ACAACAA@AAA@IBABOBIBGBEDQFC@WFG@QD_FEBOBSDGDA@AJ@B@@AC@ACACAGAA@A@CCF@@A@A@E@A@ACCA@A@@AAEDCD@@A@C@A@AA@@@@@A@@@@@@@IAGA@@A@@BAB@BIGGKEE@@@AA@@@A@@CA@A@G@@A@AA@@ACAA@AA@@ACAG@AAC@@AAA@A@@@@A@AA@A@A@@@@BGVADABCBC@EFBB@@ABCD@@A@@@DH@@@@A@CBCA@@@@@D@DAB@@@@@@D@A@B@BB@@GBA@@@A@@@CC@@AAEA@@@A@@A@AD@B@@A@AB@@@@A@@@AFAB@@A@A@ACCBA@@B@D@BA@@B@H@@EB@A@@@AA@@A@@AB@@@@@B@@A@AA@EA@AAA@A@@@@BA@ABA@@@@@@B@BAB@B@@A@ACC@A@@BDB@@@@A@@@AA@@AB@@EC@@EB@@CB@@@B@@@@DC@@D@@@B@BBDB@@@@@@A@AAA@A@A@A@@@@@BB@@A@@BCBA@@@ABA@@AA@@BAB@@@@@D@B@@C@AB@@KD@B@@E@@B@B@D@DC@A@@@@@@A@@A@A@@@ABA@A@@@@B@@ABE@@@@B@@A@AB@@@@@B@B@B@@BLICAA@@GB@BCBEMAGACAAMIEEAD@@ABABCFABA@C@CAK@GACBA@A@C@C@A@EB@AC@CAA@C@CDABAB@@BDBB@FD@@@@FA@@F@FA@ABGDA@@@@CAC@CUFDF@@@@GPIDCBGD@@BB@BAB@@EBABA@CBEBC@AB@@BD@D@@C@E@@@A@A@@@@BB@BFCAEC@BBBDDCBE@A@@@ABBF@@AB@@A@CAAACA@@A@A@@B@@@@@@ABA@@B@@@@@B@@A@CBID@B[H@BC@BDYHDHCB@DABAL@HBJBJCJAD@B@@@@DBBB@B@BIDAC@BCBC@CBAB@BEHCJDJVHD@R@RBhCT@H@D@@BBABAB@@@LEB@D@D@@D@B@BBB@@ABABCDABAB@@@B@B@@BDDB@B@@@BCBAB@BAHAD@B@B@D@BBBFD@B@@ABA@EBA@CAA@E@C@@@@BB@@BBB@@ADA@@B@DADAD@@@B@BABABAF@DABBDBBCH@B@B@DBF@B@BA@ADADA@@BBB@@@BCFABAH@BBBBF@FBF@BEJADEJCFCFABABADCFCBCBA@C@ABEDA@A@@B@@@BDFNJ@B@@AB@B@B@B@@A@C@A@@AAA@@A@A@A@C@I@ABCB@@AD@DBH@@AB@BBDAB@@C@C@A@@B@@@B@BCBGBAB@BBDDDDDBDBB@BA@C@OBE@CBABAB@F@F@DB@BBD@HBF@DBB@BBBBAB@@A@AB@@ABABABA@ABA@A@A@AAA@A@CAC@AAC@A@C@@@@B@@DHDDDB@BBD@BBB@@BBB@D@BBB@BDB@BBB@@@B@B@@B@B@B@B@BA@@@E@A@C@ABAB@BA@BB@@AB@@BBDB@@B@@@@A@@@AB@@BB@BB@AD@B@B@@@BB@@ABAB@@ABA@E@A@ABA@CAA@A@E@GB@@CDADCB@DAB@BAD@F@B@@BBHAB@B@@BDCB@D@B@BBB@@@@BAB@@CBAB@BB@@BBBBBBBDB@@@BB@@AB@@AFBB@@@@@@@B@@@BCBAJANAFBLDJDRJfR@BXLPHPHTHRHLDZHRHPHTFXLRFFBEJAHCRD@F@B@J@ADF@@BDB@@@B@@BADB@@BABA@@BBB@BA@@@ABAB@D@BB@@B@@@@BA@BB@BCBBB@@RB@@BCBA@AB@BB@@BABA@@B@@BB@F@JDTJVHVJJHFFHJPJPJFDFCDBB@DDBBABA@@B@@@@HD@@C@@@HD@@@@@B@BADB@FBFDDBL@BA@@AAA@AARG@@A@AAA@@AA@@ADE@@@@@AA@@ACBCD@@CAA@A@BA@@@@HAB@DA@AFA@A@@CCB@B@DA@@@@C@C@@A@ADABBD@D@FAB@DGBA@@B@B@BA@ADCD@DAJAB@@@@@@@AB@BD@B@@@BB@D@B@@D@@B@@BA@BB@BABB@@DA@@@@@B@@A@@B@@D@@DBBB@@@@@B@B@B@@@B@@D@@@@F@@@B@DJB@@@H@B@D@FCAA@@@@@A@@@@@A@AFAFAD@DAD@B@FAD@D@B@B@@@F@BAF@DAHAFAF@@@BBBDD@B@B@B@B@BB@@@@BBH@FB@@@@B@@@FA@@BAD@@AB@JC@@B@@B@BBB@@DBDB@@RTNCPCD@B@@@D@D@B@@@@A@ACA@@AA@A@@B@B@BB@BB@B@@@@BBB@@DD@@BBB@BABAB@B@BCD@B@@@DA@A@A@@A@@A@@HA@A@@@@@@DAAA@A@AA@BA@@DBFBD@FA@@B@BBB@@AD@@A@@B@B@@@AA@A@@DAD@D@B@BABBDAB@@@BAB@@@@@@FBB@@DA@@B@@B@B@BA@@@B@F@@@@@@@CBA@@@@@BBB@BB@@BBB@BB@@B@DA@@B@B@@@@B@@@@@BB@@AB@B@BB@@FAHCD@BA@@BB@@@B@@JA@@B@@AB@F@@@B@DA@@@A@A@@D@@@B@D@D@FAB@@@@@@@@AFAFCF@DAB@HCD@B@B@FB@@@@@@B@@BB@B@@BB@L@JFHDFDTJP@F@@@DBD@NNFAD@BA@@B@@BBBB@BB@@B@BBFAA@AA@@@@@@B@DBB@BABA@@@B@@B@@@@@D@BADAB@@@D@@@B@F@BBD@B@B@@@@@DABA@@BABCBA@@@@BAAA@@@@PEACNEAC@@@@@@@C@@NBAGFABAA@PIPMHIHEL@HBRHNDJAJGFERMVOPK@AJOBO@IFCBCACCEAEBGBCH@J@H@HAFCHAD@FFBFDFHBJBF@HADEBEEK@EDEFCHCF@HDHBHAL@HBD@DAB@@C@A@@A@@BA@@@@@A@@@A@@@A@@@@@@@A@@@@@A@@@AA@@@@@@@A@@@@@@@@@@@A@A@A@@@@@@@A@@@A@@AA@@@@@AAC@@@A@@@AB@@A@@@@@@@A@@@@@@@A@@@@A@A@@@A@@@@@AB@B@@@@@@A@@@AA@@AA@@@@A@ABA@@@A@@@@A@@@@@AAA@@@A@@@@A@C@A@@A@@@@@A@@@AB@@@BA@@BA@A@@@AB@@A@@@@@@ACAAAAECGG@@EEAA@AA@ACCEACCC@@@A@A@A@A@@AAECCC@@@@A@@@@@CCCGACEDCBABA@ACAA@@@A@@A@@@@@AA@@@A@@A@@@@@@AAAAA@@AA@@@@@@AAAAACAAEEACA@@A@B@@A@EB@@AB@@A@@@A@CE@@AA@@@B@@@@@@A@AAAEAA@A@@A@A@@@@@AAAA@AAA@@@@@ABC@A@A@@@@@@A@@@@@@@A@@@@@A@ABA@@@@@@@AA@AAA@@@@@@@@A@@@A@@AAA@@@@@@EBE@@@@@@@AACC@AAAB@@@@@@@AECI@@@@A@G@@@A@@@@@@@A@@BA@A@@A@@@AACAC@@@@AA@@A@@@@A@AAA@A@@AC@A@@AA@@AA@@@@@@AAC@CA@A@@@@AB@BABA@DG@@@@A@AA@DABAB@@@@@@A@AAAA@@A@@@CFA@DE@@@@@@@@GCA@@@@@@@AB@@@@A@GE@@@@@@@A@CB@@A@@@@@@@@CACAA@@@@@AA@@AAAAA@@AA@ACAA@A@@@@HC@@@@@@AA@CCC@@@@A@@@@@@@AA@@@A@CA@@@@@@@EBE@@@CBC@E@ABG@@@@A@A@@@A@@AA@@AA@@@@BC@@@@@@@AA@EB@@@@@@@E@C@A@@@A@C@@@A@@A@CDC@@A@@AAC@AEA@ABA@ABG@A@A@@@@@AA@AAAAC@@@@BAD@@AB@BA@@@@@ACEACAAAAAA@AA@EAA@@@@AA@@@C@@@A@@B@@@B@@AB@@@BCBAB@@@@AA@@AB@A@@AAA@@A@AAA@A@@B@@@@@B@@ADC@@@A@@@@A@@ABAB@B@@A@@BAOBSBsSUI@@C@CBA@A@C@CBA@A@A@EBBHIFH\\KBBJQDLJWJO@G@Y@@RiX}fMIGOCKDKIIDABA@A@@DEBEBCBGCEYACAUIK@ASAIDCDCPQHDBFBDBF@DB@@ADGDKBCB@BABAAA@ABG@@J@hHBGJ@b@EQD@@CFAD@GGLCGKAGIU@AF@EOLCEGTE@GK[C@CC@@AEAECCCEMHECCCGEAC@@AE@EA@@EIBAEAE@@@@KB@C@@@E@G@IE@@E@EC@C@CAC@CBG@@A@CBECCE@C@AAAAE@C@AA@C@A@CBEDECC@@@GCACACCCC@C@C@C@CBC@AAC@E@AACAE@EC@"]],encodeOffsets:[[[125983,42737]],[[125857,42748]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
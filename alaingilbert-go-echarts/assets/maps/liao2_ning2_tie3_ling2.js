@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("铁岭",{type:"FeatureCollection",features:[{type:"Feature",id:"211202",properties:{name:"银州区",cp:[123.842305,42.286129],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@QFBBEBBDABB@CBB@ABEBAB@@AAA@C@@@@H@J@D@BB@FFBBBBHBB@FD@@A@QEAB@@A@AB@B@@BB@@A@AB@BABAFBBB@J@D@D@BBDBJDFBD@DBF@D@F@BBDDDBDFBDBDB@BBBDB@@@B@@AB@@EBAB@@DLB@@@@@B@@FDDAD@@AB@BA@@@C@@@AA@ACA@BABBDDBDB@B@B@BBBA@@BB@@@DABAB@@FB@@@ADBBDB@@@FAB@@@BA@@BB@@FCFA@@AABABAB@DCB@@@@@@@D@@@@A@@BAB@@A@@B@@@@@BA@AB@@@@@A@@@@B@@@BB@@@@BB@@A@@@@@B@@B@@A@@B@@B@@@@B@@@B@B@@@@@BA@B@@@@@@B@@@BA@@@B@@@@@@@A@@@@B@@@@@B@@@@@D@B@BAB@BAD@D@B@B@B@BB@@B@@@DCB@@A@@CABE@A@AA@AA@A@@@@CA@@@AAAA@@@AA@AA@@A@@A@AAA@@@AA@@AA@@@@@AA@AA@@E@A@AAAAC@AAAA@@@@@ABAB@@@@BABB@@ABA@@BA@A@@A@AB@@@@@@A@CA@@A@A@@A@ECG@ACIL@LA@A@A@@D@@AJADAD@@@A@@A@@A@@@A@@@@@HA@@A@GAE@ABA@ACF@AAAA@@@A@A@@@@BB@EAACEAA@@ADE@BCBAA@@@A@@@AB@@C@@A@@A@@@@A@@@@B@B@@@@BBA@@@@@AA@A@@@A@A@@ABA@@DB@@@@@AB@AAAA@A@@@@@ABADAB@@@@@@@@@CA@AA@C@@AAA@@A@@@@E@A@@@A@@B@@AB@@@AAA@@@@ABC@@@AB@@@B@D@B@@@@@@@@A@@A@AA@@@@@AB@BA@@F@@@DA@@BA@@BAB@B@B@DBB@B@B@B@B@BBB@B@@@H@HDD@B@BA@@DC@@B@B@B@BAB@@@B@@ABA@A@@BAB@FAD@@A@@AA@A@A@@@@@AC@A@@@@A@@@A@@B@@@B@@@@AB@B@B@@A@ABAB@@@@@BBB@B@B@BAB@BBB@@BB@@AB@B@B@BBB@@BB@BAAAB@@A@@BA@@@C@@AA@AB@@A@ABAD@BAB@D@B@@@@@B@@AAA@A@@@AAAAA@@C@CAABA@@@A@AAA@A@@@A@ABA@ABA@@@AAA@A@C@A@@@AB@@C@C@A@A@A@AAABA@A@@B@@A@A@@@A@ABCB@B@BA@@@C@A@G@C@@@AA@AA@AAABAA@@A@@@A@@@C@@@A@AB@@A@C@@@AAAAA@@@A@AAAA@@A@C@@@A@@@@BA@@B@@A@CAABABABEA@@A@AA@@GAAB@@A@ACC@@AA@@@C@@@EFABA@@BCA@@A@A@@@ABA@AB@@A@@@@C@A@@AA@@C@A@A@A@@AA@@A@AAA@@BABA@A@@A@AAA@C@@@FCB@AACB@ACB@@@BA@@@@@A@@AA@A@AAC@CAA@@@CAC@C@CACAC@C@C@@@@@AB@@@D@@@@C@AAAB@AA@BE@C@@DA@@@@AEA@AA@AAA@A@@@A@A@A@A@@B@@@@@BAB@@@B@@@@@@AC@A@@A@A@@@@C@@ACBAAC@I@ABA@ABABA@CB@BABABA@C@E@C@@@@AA@@@@IBCDC@@DA@@@AB@AC@@AAA@AA@@A@A@C@@@@@@B@B@@AB@@A@C@@@AAC@C@A@CA@@@@A@@BC@ABA@@BA@@@A@@@AB@B@@AB@BA@A@AB@@C@AB@@AA@@EAAB@@@B@@GDJPSBFHDB@B@BFJ@@AB@@ABAFBHBD@FBDBBBBF@BBB@DA@@@AB@@B@@BBDBD@FB@@BBB@B@D@BBB@B@DBB@@A@@BBB@DDBBBBDBBBB@@@AB@@A@@@GBA@@B@@ABCB@@ABABADEDDBBBKDBD@@EB@B@@BBBBA@FFEBGECB@@@@ABBB@@@BA@@BAB@B@@FABBED@@HC@BEB@@FAFABDD@A@AB@BAB@@A@@@TJJNBDHDD@NANJD@LH"],encodeOffsets:[[126795,43292]]}},{type:"Feature",id:"211204",properties:{name:"清河区",cp:[124.159191,42.546565],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@BABA@@@CAA@A@@BAD@B@D@B@@@B@@@B@BA@@@@B@@AB@DABA@@AA@A@ABABA@C@A@ABC@ABA@@BADA@@@@A@@@@A@@CA@@A@AA@@@@@AAAC@@@@@@@@A@A@@@A@ABA@@@A@@@A@@@@@@AA@A@@@A@@@ACA@@@A@@AAA@@@AA@A@A@@@AAAA@@A@AA@AA@@A@@@ABC@E@C@ABABA@A@A@ABCBABABA@@@A@A@AA@@C@A@@BA@CAA@CBADCBAB@B@B@@ABCD@@CB@@A@A@@@ABCAA@@BA@CDA@A@C@@@@BABA@C@@@@@@BA@@DABADAB@@ABC@C@A@@@A@ABA@AB@@CBCB@@A@@@BB@@@B@BABABABA@ABEBA@A@A@AB@@@B@@C@CB@@CB@@@@ABA@A@A@GDgHqJ_DKB@BABAB@B@@@BA@@BB@BB@@@@A@@@A@AB@@@AA@CBC@A@C@@@A@@@C@E@@BA@@BABE@AB@@AB@B@BA@@B@@A@C@A@CBABA@A@ABA@A@A@C@@@ABA@ABCBA@@@ABAB@@A@A@A@@@A@A@@A@@A@@@AAA@A@A@@@AA@@@AA@@@AA@AEDOF[FUFSLCJAJ@@A@A@@B@BA@@@E@C@ABA@@@ABA@@@@@@@A@A@A@A@A@A@ABA@AD@B@B@@A@@@@@AB@@C@ADAFEBA@@@C@AAC@CB@@AD@@@B@BA@C@@@@B@@@@A@@@@B@@C@@AABA@CAAAABC@@@@BABB@CBAAA@G@@AA@@@@@@@@B@@BB@BA@@@ABCB@@A@@AAA@@@@@@A@@@BBB@@BAD@@A@@@@AA@@@AA@@A@A@@@@@A@ADAB@@@@@@@B@@@@@@@BB@@@@AB@@@@@@BA@@@@BB@CBA@@BBD@@@@BDBD@B@@@BB@@@@@AD@@BF@B@B@@@@A@@BE@BBCBB@BBBBBBC@A@CEA@A@ABBD@@@BA@@AC@@@@@BA@@@@CB@A@A@@A@@@@B@@@BA@@@@BB@@B@@@@@@BB@B@@@@@BBBADA@@B@@DBA@@BA@@@@BBBBDFJ@BHL@DBBBDHPFL@@@@DJDBF@F@D@@@F@BBFBB@B@DCDBF@DB@D@BBD@@BBD@@AB@BB@AB@B@DCB@@BB@@BBB@@B@B@B@B@FBBB@@FFBBBBD@D@B@B@B@@BBDDD@FDDFDFBNBLBBBFBDDDDBHBBBBB@BCB@FC@@B@DB@@B@B@@@B@@@B@@AB@@A@@B@FC@AF@@A@@@@BABAB@B@@@DBBBB@@@@A@@B@B@@BBA@@B@@BAB@@@BBA@B@@@@@@@BB@DAB@@@@A@A@@A@DAB@BB@@@@@@@@@ABABADA@@BAB@@@@@@A@BAA@@BC@@@AB@@@FB@@B@B@D@@@B@DAB@@@B@B@@@B@D@B@D@B@@AB@@@BBB@@@FB@@B@D@@@@@BDB@B@B@@@BA@@@A@@H@BAA@AABA@@D@@AB@B@@@B@@@B@BA@@B@B@@@B@@@@AB@@@B@BBBBD@@@B@@AB@B@@A@@@A@@BA@@B@BAB@B@@@@AB@B@BA@@@@@C@@BCB@ACBAAAB@@@BA@@B@B@BA@@B@F@@A@@@A@AB@@@B@BABB@AB@B@BA@@BB@@B@BAD@BB@@@@B@BB@@D@DBBAB@B@B@@@@@B@@AAAB@@@B@B@@AB@@CB@@@B@@@DABB@@BABB@@B@BB@@@BB@B@BAB@DA@@@@B@B@BAB@B@DA@A@@@AB@@ABADB@@DAB@B@@B@@D@B@BC@ABABEBA@@@ADAHAJA@BB@B@D@D@B@B@B@B@B@@@B@B@D@F@@@B@B@D@BBH@BBD@HBDBFBD@HBHBF@F@DA@@DA@ABADA@ABAF@@ADAB@DCFC@ABA@@B@@AB@@ACA@AAAA@CB@@CAA@@@@AA@A@@AAA@A@@@AA@@@AA@@BAB@@AA@BAACB@@A@@BA@A@@DA@@@@AA@A@@DCAA@C@@BAB@B@@A@@BA@@@A@A@@@AAC@@@C@AA@CA@@A@@ACB@@A@@ACC@AA@@AC@AA@@@@@BF@BBFDD@HQ@CB@BAAAEAAA@@GBA@GAE@A@C@E@G@E@GB@@CAA@CB@ACB@A@@@@@@AA@@A@@B@@@@A@@@A@@A@@BA@A@ABA@@@CBA@A@@A@AB@B@B@@AB@DCD@@CBA@C@A@A@@A@A@@DC@@DBB@@@BA@A@A@@@A@@@@A@ABA@A@@@A@@AB@@@BCBA@@B@B@@A@@@@@@A@A@@A@@B@@A@@BA@@@A@@BAB@BBBBBB@@B@BAAA@A@C@ABABABAAAAA@@@A@@@@BA@ABA@@D@@C@AB@@A@@BA@A@@BAB@B@@@@A@@@AAA@AB@@A@ABA@@@A@@AC@@BA@@AA@C@@@A@@BA@@AA@A@@@@@A@AA@@@AA@@DC@AB@DA@A@@@A@@@A@@@A@A@@@A@A@@@A@A@@@AB@BE@@@A@A@A@A"],encodeOffsets:[[127407,43452]]}},{type:"Feature",id:"211221",properties:{name:"铁岭县",cp:[123.728933,42.223395],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@AACB@@AA@BA@A@@@@@@@@A@A@@AA@@@A@AAC@A@AB@B@BA@@BAB@@@@A@@EA@@A@AACACAA@AA@@A@A@@AA@@@A@CAAAAAA@A@A@AAC@EA@A@@ABAD@@AB@@AB@@AB@B@BC@AAC@CBA@AAC@A@A@A@A@A@C@A@C@CAC@A@AAA@@@@AAAA@AA@ACAA@CAC@A@A@@@AA@@A@@@AAA@A@E@A@A@C@KBA@@@@@BB@B@@DB@@@BBB@B@B@BA@@BA@@B@@@B@@@B@@@B@@@BA@B@@DBB@BBBBBBB@@@@@BA@AB@@@B@@A@A@@@ABAB@BABC@@BAB@B@@@B@B@B@B@B@@@BA@@@A@ABCB@BC@@@@@AF@JCJCLC@@@@@MDC@EAKEUIYCKAE@I@EBKDMBQCQAGAKDKFEDGDUHYBUDSDG@G@IBKGSGWCYMMIIIGC@EAGAGAMEQIIIEK@OBE@EAMEWES@OAA@KBM@UFADBDHFFHAFCDG@G@GCKCGA@@C@ABA@@BAB@@AB@@CB@@A@@@A@ABA@@BC@@@ABABABABA@ABA@@@A@CA@AA@AB@BC@C@A@ABAB@@A@AAC@@B@@@@A@ABE@@@AACBCA@@AAA@CCAAUCEACAA@@@MAQAUIIEA@EAGCAAIGIGCGCCGGEAOGA@@B@@@@A@ABA@A@@@@@C@A@@BA@@@A@@BA@A@@@A@@B@@@@@@@@@@BB@@@@@@@@@BAB@@@@A@@@A@AA@AA@@@A@@@ABA@@@@B@@@@B@@@@B@@@@@@ABA@@@@B@@@DABAAC@CCA@A@@B@@@@@@@B@@@@@B@@@@@@AB@@@B@@@B@@@@@BB@@@B@@@@@B@@@@B@B@@@@BB@@@@@@@@@@@B@@A@@B@@@@@@A@@@@@@@BB@B@@BB@@@@@B@@@@ABAB@@@@@B@@D@BBBB@@B@DB@@@@B@@BB@@BB@@B@@B@B@@@BAB@B@@@@@BB@@B@@@@@BA@@@@@A@@B@BA@@B@@@B@@ABA@@@@@@@AA@@@@@B@@@@@@@BB@@@@BB@@B@@@@A@@@@@@@@@@@@@A@@@@@A@@BABAB@@@@@@@B@@A@@@A@@@@@@@@B@@@BB@@B@B@@@@@@@@@@@B@@A@A@@B@@BAB@B@@@@BB@@B@B@@B@@@@@B@@B@@@@@@@@@@A@@@@@A@@@@@BB@@B@@@B@@@@@@@@A@@@@@BB@@@@@@B@@@BA@@@@@@CC@@@@@AB@@@@@@BB@@BBBBA@@@B@@@B@@@@B@@B@@@@@@@@@@@B@@@@@@B@@@B@B@@@B@@B@@BD@@@B@BB@@@@B@B@B@@@@B@@B@@A@@BAB@@@@@@A@@B@@A@@@@B@@A@@@@@@@@@AB@@@B@DA@@@@@@B@@B@@BB@@BB@B@BB@@B@@B@@@@@@@B@@B@@@@B@@@@@@@@AB@@@@@@BB@@@B@@@@AB@@@B@@@@@@A@@@A@@@A@@@@@AB@@@@A@C@@@@@@@@@@B@B@@A@@@ABAB@@@@@B@@@@BB@@@@@B@@@B@@A@@BA@A@A@A@@@A@@@E@@@A@@@@A@@AA@@AA@@@AAA@@@A@@A@@@AA@@@@@@@A@@DA@A@@@A@@@@@AA@@@@A@@@@B@@@@@AA@@A@@@@@@A@@@A@@AA@@@@A@@@AA@@@@@@@A@@A@@A@A@@BA@@@@A@@@@@@@AB@@A@@B@@@@@@@@@@@A@A@@@A@@A@@@@@@B@BA@@B@@@@A@@@A@@B@@@@@@AB@@A@@@@@@@@@BBA@@@@@@@@@@@A@@@@@A@@@ABA@A@A@A@A@A@@@@@A@@B@B@@@B@@A@@@C@@@A@@@AAA@@@AAAB@@A@@@@B@@@B@@BB@@@@@@@@C@A@@@@@@B@@A@@@@@@@@BA@@B@@C@ABA@@@@@BB@@@BB@@BBBB@@@@B@@@@ABABA@@@A@@@AB@@@@@B@@@BA@@@A@@BA@AB@@@@A@@@A@A@@@ABA@@B@@BBBB@@DBBBB@BBBB@B@@@BDR@F@JCPJHFBBJ@LB@LBJFBDHFJBH@LGPCHFJHBLJFFAPCL@PFKHEJHJJHEHAJCLCH@B@@@@A@@@A@AB@@@@A@@@@B@B@B@B@@@BBB@BBBB@BF@@@@CB@@@DAFB@@BBABBD@@@@@@C@@BA@@@@D@D@D@DBDBD@D@DB@@B@DBD@BBB@B@@BB@@@@@B@@A@@DA@BDABBA@ED@@D@B@BBB@@@@BABAB@@BB@B@BB@@BB@B@B@D@@@BB@@@B@D@@B@@@BAB@BA@@B@B@@@DB@AB@BAFE@@D@@@B@@BD@BDB@@@BAHB@@BBB@@@FBBABABADBB@@@@AB@@A@@B@@@D@B@@@BBBBB@@@B@BBBB@@D@B@@@BAB@@@D@@@B@@@B@@@BBBABBB@@BBB@@D@H@B@D@@@B@@A@ADABAB@@@B@B@@@@AB@B@BABBB@B@B@D@D@@@BA@@B@D@B@B@BB@@B@BAB@BAB@@@B@B@BBB@@@B@BADBD@@@BBBB@B@@@BBB@BA@@@@@A@C@A@ABC@AB@B@BA@@BBB@@@D@@AB@@@BA@BBABA@@AA@AAA@A@A@@BA@@AA@AAA@ABA@A@A@AA@@@@A@AB@B@BA@A@A@@B@@A@@@A@@@@B@@@B@@B@D@@B@@@@@B@BBB@@@BC@EBA@AB@@@BAB@BA@@@A@ABA@A@A@@@CD@@ABA@C@GCG@@@A@A@AAA@A@A@A@A@CAA@A@A@AB@@AB@@CB@@E@@@ABA@@B@@@@BBB@@@@B@@@@@@A@C@A@@@A@@B@@AD@B@@B@BB@@A@@BA@@@@B@@@B@F@@B@@@BB@BD@B@@BDB@@@@@@@@A@CBAB@B@@@@@BBBBBA@@B@@@@CA@@AB@BB@B@@@B@B@@B@@@@AB@A@@A@A@@@@@@B@@B@@@@BD@@@BA@@B@@@B@ABADF@BC@@BBDFBB@FAA@@@@@B@B@@BBBBE@BDB@BAF@HBB@@@GB@@@@B@@@B@@@@BB@@@C@CBIB@BC@@@@B@BKBK@DJ@BDH@F@BB@B@@@DBB@@@@@@@BAB@@@@BAB@@AB@BA@BA@A@@A@AB@B@@@@BBBBD@BBBBB@F@@@BBB@@B@@@@BB@@BB@@B@BBB@@@@BB@@BBB@@B@BB@B@@DB@@@@@BBBB@@B@BAFDB@@@BA@CD@@A@@@AAA@A@A@C@C@ABA@ABA@C@@@@@A@@@@@A@@@@@@B@@@@@@@A@@AB@@A@@@@@@@@AAB@@@@A@A@@@A@@@@@@AA@@@@BA@@@@A@@@@@BA@@A@@A@@A@@@A@@B@@@@@A@@BAB@@@@A@@@@BA@AB@@@B@@C@@@@@@@A@CDA@ABABBB@@EBED@@AA@@AB@@A@EB@JBFDFDDBF@FBFDFBD@B@FABBD@BDBDBDBF@FBDBDDBBDDDBNLB@BADC@AAAB@AA@@DADAFBBB@@@D@@@BDBB@@DBBAB@D@BA@@FAB@DBB@@@@BBBA@@DA@@B@D@DBBB@BDBBB@BB@BA@ADAB@B@B@B@@@B@B@B@B@B@HF@BABEF@@@BBAB@D@D@@B@@BB@@F@@@@@CA@A@@D@@@BA@@BA@@@@@A@@@@@@AAAA@@@A@@AAB@@@BB@@B@@@DABDDALCPE@@@AAA@@@AB@@@B@B@BADB@@B@B@@@B@@@BAB@BB@@B@@@@@B@B@B@BABBB@DB@@D@@@B@@@AAA@@AAE@@A@@@@@B@@A@@@@BA@@B@AAAABA@@@A@A@@@@B@@B@@B@B@@B@@BA@@A@@@AAAA@@@A@@A@@B@@CBA@@@@AAA@A@AA@C@EB@A@@@AB@BADBB@DADAB@D@@A@ABABA@@@@A@@@@@A@@BAAA@@@B@BA@@@@@E@A@A@@@@CAA@AAA@BA@@D@@@@@@A@A@ABA@ABA@@@@DABB@@@A@@@@@A@@@A@@@@A@A@A@AA@@@@@A@A@AACBA@@BA@@@A@@@AB@B@BB@@BA@A@@B@BA@@@@@@@@@ABA@AA@@@@A@A@@A@CA@@@@AC@@A@@@@@C@C@A@A@A@@C@GAA@@@@CGFGLELAPBX@PAVAJDFFFDFDDBB@BB@BBBD@DBBBB@D@@@B@@@BA@@BA@A@A@@@AA@@A@@B@@@@@BAB@@AB@@AB@BB@@BA@@@A@@BA@AAAC@@@@AA@@AA@ABA@@AA@AAAA@@@A@A@A@AB@@ABA@@AAA@AC@A@A@@@A@A@A@@@@@A@A@@D@BAB@@A@@@@@@@A@A@@D@B@B@D@B@B@@@@@B@@B@@B@BA@@BA@@BABA@@AA@AB@DCDAB@@A@@BA@@D@B@@@@@@A@@B@B@B@@@@BB@BB@@@@@B@@@@BB@@BBB@BABA@@B@FBBAB@@@@A@@@A@@@ADA@@@@AA@@@AB@@@@CDCBC@A@AAA@ABA@A@@AC@@@A@C@@BA@@A@A@@@G@A@AAA@A@@@A@A@AA@A@@@A@A@A@AA@@AA@EAAAC@A@A@@A@AAA@C@AA@AA@@@@BAAABA@@@C@A@@@@@@B@B@BCB@BAB@BAB@@@B@BA@@@@B@BAB@D@B@B@@B@@BBB@@BDBB@B@B@@@BB@B@BDB@@D@@@BBD@F@@@BB@@@BB@D@B@B@D@DBFBDBBAB@@ABC@@AAAA@A@A@A@@BA@BD@@@B@@@@@@AAA@A@@B@BA@@A@@AACA@@@BA@@B@D@BBBAB@DCBA@A@@E@@A@@B@AC@@BA@@B@B@@@@CB@BAB@@AD@B@B@B@@BBBB@BBA@@B@@@@@B@D@BD@BB@@@A@@@A@A@@B@B@@@A@A@@@@A@A@AD@B@DBD@BBBBDB@@F@FBB@BB@AB@BA@A@@BA@@DABAD@@@@@BBB@DBH@B@B@BBD@@@B@@@B@FBB@@@BADAB@@CB@BABCACDA@ABA@A@C@@BA@A@@@A@@@@BD@@@@B@@@BA@@@@B@D@BBB@DAB@@B@@@@BB@@D@@B@B@@B@@@B@D@@ABA@@D@B@@@DBBDBB@@B@@@BC@A@ABCB@B@BAD@@A@@BA@@@AB@BA@@B@@@B@@A@A@@A@C@CA@@AA@AAAA@@AA@AAAA@@@A@@LENCLAVBTBPDLBPBPDHGFI@O@MLININANAVDD@@BB@B@BB@BDBBB@BBAB@B@B@D@B@@@D@@@@ABAB@BA@AB@BA@@@A@ADAB@D@D@DBB@F@@@@@A@@AB@@ADABA@@D@D@B@B@@@@A@@B@@A@@D@B@F@BBB@DAB@@A@@BAD@D@BAB@D@BBB@B@DA@A@@@A@@B@B@B@@@@ABAA@AAAA@@AACC@@@@@A@@AAAA@@@A@A@AAA@@A@A@AA@@AB@@A@@ACA@@@@B@B@BA@@BABA@@BA@@@A@@@@@ABABABABA@@@@B@BAB@@@B@BA@@AA@@B@@AB@B@@A@@@@AAB@@@B@D@B@@@@BDA@BD@B@B@B@B@@AB@DAB@@ADBBAB@B@@@@@DBB@B@@@@@BABA@@B@B@D@@@@ABA@@BAD@D@BBB@D@@@BBBAB@@AD@B@DABBBB@@BB@@F@@BBB@@FBZDPDZDJDN@HKFEDGEGEEGEQSIIMMKEG@YIMASEWEWESCKEGCEEOICCCA@@AAC@CAA@A@A@@@A@CA@AA@@AA@@@@@@A@@@AAAA@CAC@EACAC@AAAA@@AAC@A@AAEACAAAAAA@@BC@A@CAC@@@C@@@CA"],["@@A@@@A@AAC@@A@@A@@@@@A@@B@B@@A@@B@@@@AB@@@B@@@@@B@@@@@@@BA@@@AB@@@B@@A@@@@@@B@@@@CB@@@@@@@@@@AB@@A@@@A@@@A@A@@BAB@@AB@@A@A@ABA@@@A@@BAB@@@@A@@BA@@@@@AB@AA@A@A@@@AB@@A@@@@@@BA@A@@B@@@B@@@B@B@@AB@@@@@BA@E@@@@@A@A@@@ABA@@B@BA@@@AB@@A@AB@@A@@@A@@@A@@@A@A@@@ABA@A@@@A@A@@@@@@@A@@@C@@@@@@@@@@B@@C@@@A@A@@@A@@A@@@@@@@@@@A@@@@@@@A@@@@@@A@@@@@@B@@@@@@@@@A@@@@@A@A@@@@@@@@@@A@@@@@@@@@AA@@@@@@B@@@@A@@@@B@@@@A@@@@@AA@@AAA@@@@@A@@@AB@@@@@@@A@@A@@@@@@@A@@BA@@@@@AB@@@@@@@AA@@A@@@@A@@@@@AB@@B@@B@@@@@@@@@@A@AAA@@AA@@B@@@@AB@@@@A@@@A@@BB@BB@@@@@@@@A@A@@@A@@@CB@B@@@@@BA@@@@@A@@@@A@@A@@@@@AB@@@@A@@@A@@@AA@B@@A@@@@BA@@@AB@@A@@A@@A@@BA@@@@A@@@A@@@@@@A@A@@@A@@@AB@@@B@@@@@@@B@@B@B@@@@B@@@@A@@@@@C@@B@@@@A@@@@@@@@@B@@B@@@@@@@@@@BB@@@@@@@@@@@BA@@@@@@@@@@@@@A@@@@A@@A@@@@B@@@@@@@@@@@B@@@@@B@@@@A@@@A@@@A@@@@@A@@B@@@@@@@B@@@@@B@@@@@@C@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@B@@A@@@@@@@A@@@@BA@@@A@@@@@@@A@@@@@A@@@@@@@@@AB@@A@ABA@@@A@A@@@AB@@@@@@A@@B@@@B@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@BA@@@A@@@@@@@@@A@@B@@A@@@AB@@@@@@@@A@@B@@A@@@A@@@@@A@@@@@@@@@@@AB@@A@@@@@@B@@A@@@@@@@@@@@@@A@A@@B@@@@A@@@@B@@@@@@@@A@@@@@@@@@A@@@@@AB@@@@@@A@@@@@@@A@@@@@@@@@@A@@@@AB@@@@@@@@@@AB@@A@@@@@@AA@@@@@@BA@@@@@@@A@@@@@AB@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@B@@@@@BA@@@@@AB@@@@@@A@@@AB@@A@ABABA@@@@@AB@@@@@@@@A@@@@@@@@@A@@BB@@@A@@@@@@@@@A@A@@@@@@@@@A@@@@@@@@@A@@@@@@B@@@@A@@@ABA@@B@@@@AB@@@@@@@@@@@B@@@@@@@@A@@@@A@@@@A@@@@@@@@@@@@@A@@@@@@AA@@@@@A@@@@@@@@@@@@@@@A@@@@@@B@@@@A@@@@@A@@@@B@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@AB@@A@@@AB@@@@A@@@BB@B@@@B@@@@ABA@@@@@@D@@@BA@@@@BA@@@@@@@@B@@@@B@@@B@@@@@@@@D@FBJ@D@F@LBLAAA@AAA@CBA@MHGFCDABA@ABC@CBE@G@E@CAGECACAECCEICE@E@E@EBEBCAA@@FAB@BB@BBCB@BGBA@@@@BBD@BABC@AB@@@@@B@@A@@AC@BBA@@BBD@@@@GB@@@@@D@B@@IB@@BF@@@DBD@@B@DHD@@@@B@@@@BBDFH@BHBLBBJBLB@@ADCHADADADCFAD@@HPBD@@B@@BAB@BD@BBBB@BD@BA@@BBBB@@@BCBA@DBBB@@BB@@AB@@@B@@DD@@@A@@DB@@@B@@B@@BB@B@@@BA@@FA@@@@B@AAB@FBBBDBBDDFHJHLDDHHFLDJBF@@DHDLB@B@@@@B@DBF@B@N@D@@@DG@A@A@@B@BA@@B@B@D@@A@ABA@AB@@BBA@@@A@@B@@BBBB@B@@@@@@C@AB@@A@@BAD@B@BAA@BB@@@BBB@@@BBB@BA@ADD@@@@FBBB@@@B@@D@EBA@A@@BF@B@B@@B@@B@B@DA@@BB@@ABB@@@@B@B@@@@F@B@@AB@@ABABBB@@@DA@A@A@@B@AFA@@B@@B@@@BA@A@ABA@AB@@AA@AB@ADA@@BA@@@BB@@@@A@@B@@@B@@@@@@@DCDAB@@A@@@@@A@@BA@@B@B@BB@@@B@@@@@B@@@BA@@B@@HDB@@ABBB@@@@@@@@A@@@@B@@@@@@@@@B@@@@@@@@@@@BAB@@ABA@B@@@@BA@@B@@A@AB@@@DB@@@AB@B@@@BDJADD@AAE@@A@AAB@B@FB@B@DB@B@B@@@AC@C@AFB@@@@AA@A@ABBBAA@@@EA@A@A@@B@@@BB@@B@BAB@D@FA@@@@@CF@@D@@@@@B@@@@BD@@B@F@B@DA@@AC@@DHBA@A@@AC@@ACB@@@BDDFA@BBDFA@DDD@CEDADF@BB@CGB@@B@@J@@DD@B@@@BBB@@@@B@@@@@@BAD@@B@HDAB@@AACB@DABB@AA@B@@@@BB@@@@@AC@AD@@@BB@@B@B@@B@@@@@B@@AB@B@@BB@@B@@B@@D@B@@BB@@FD@@HHB@D@@DAB@@@@B@@B@DB@@@@@@A@A@@@@BABB@D@@BBD@B@B@BBFA@A@C@EAFDBBBBA@DDAB@@BBBBABEB@@BBBF@@BBABB@DDA@DF@@BAB@DAHE@@FDHAB@H@B@@@\\AJ@@BD@A@@BABFB@@@BEACDDFEB@@HPOFEB@@A@AE@@I@@@BDC@E@E@@@BF@@A@A@@@BB@B@@AB@@A@@@A@@@A@@@@B@@EB@BA@BBOD@BCB@B@@AB@BDHBJDAFH@@C@@BB@@@B@B@BH@B@F@@@B@@@BA@@LAB@J@B@@B@@B@B@@@D@B@B@BADAFA@A@@BAH@B@B@D@BBBBB@B@B@BEJ@@CDEDGFA@A@DP@@@@B@BAB@@BAB@@@@@BBDB@@BB@B@FAB@B@B@@A@@B@B@B@D@B@@A@A@AB@@AJBB@B@@@FIDAFFbbDBRLB@DC@@B@BBB@DBB@BBD@@BD@B@B@@BDBDBFB^BB@@@@AAA@@ACACAAA@@A@CDCFEDAF@HDDBFBB@B@@@BA@CBEAC@AAACC@@AA@A@ABEBCBAFAJAFADABAAA@CAAAACAC@CAACAAAC@@BCBABAD@D@D@DA@@BB@DDD@DBBBDD@@@BAB@DC@A@C@AACAA@AAAAA@C@AB@@AF@FB@@DABABABABCB@FA@A@A@C@A@AAAAA@A@ABABADABABAB@B@B@BABAF@B@BC@C@@AAAAAEACACAA@EAAA@C@@AA@AB@@CDABCBEBA@AAAA@@BC@ABA@A@E@EBA@A@@B@B@BBDB@BD@D@@@B@@CBA@CAE@CAA@@AACCAACE@C@@@AB@D@B@D@@@DBDBDBBDB@B@BAB@@ABE@@ACCCAC@@@A@@BABAD@BBD@DBFBDDDBFHBDBB@@B@DA@ABCB@@AB@FCDC@@@A@AAA@C@C@@@@AABAB@D@DBBD@B@@@D@BBDFDBDBBFBBBDDDBDFDFB@BBD@@@D@DADADABC@CBCAABG@A@AAA@A@@@AB@DABADCD@F@FBBBHDB@D@BABA@C@A@ADIBCB@BAD@BBD@B@B@B@@@@ABA@@AAACAAA@MKCACCAACCCAEAE@CACACA@AACBA@E@AACCEAE@EAECCCEAE@I@@A@ACCA@B@@EA@@BABA@C@@AA@@ABAAA@A@A@ACCCAAABB@BDB@@B@@@D@@ABA@@BC@CBEC@@@A@@@@KA@CA@AB@FA@@BA@@@A@ACAAA@ACACCECACCAAE@C@E@CAC@EAICCAAAC@C@I@A@AABEBA@ABAB@@@AA@@@ABAB@@@BARFB@@@ECA@GAAAAAEEA@@A@C@I@G@@D@B@BB@@BAFABAA@DAA@BAACFAAARE@@B@KGC@MIMBC@GCACIMSI@@B@@@BA@ABAB@C@ACEBEB@@FA@AGD@@FCAAEB@@@ABA@AB@@A@@AABA@@@@DAHFFAEEB@AAAA@@@AFA@@ACLCAACAFCBCBABA@@DABA@@@AB@HA@@B@@@BA@@A@AACAAAAACCA@AA@@@BA@CAA@A@AAC@A@A@AA@@EAC@CAAA@@@AA@@B@@CBA@AAE@AAAAAC@EACAGBEBA@@BA@@EI@A@ACAEGTAIOHC@@@A@@BAFB@@BB@@BAD@@@BAB@B@@ABA@@@ABA@@B@@@B@@AB@BAD@@AB@@@@@DBB@D@D@BB@@D@B@@@BA@@@A@A@@@@D@B@B@@@BBB@BB@@BDA@@B@@CB@@CDAD@J@@B@@B@@D@F@D@B@BABA@ADAB@BABAB@BAJ@D@BBDA@BD@@@@@@B@BB@D@DGDKBIFGIGGIFILGOEK@ODEBIEAKIGGEODKHG@IAGEACIEKAA@@KAIEAIGDO@I@ECQ@A@@@AAAAAA@AACA@@AAAA@@"]],encodeOffsets:[[[127358,43042]],[[126712,43077]]]}},{type:"Feature",id:"211223",properties:{name:"西丰县",cp:[124.727392,42.73803],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABA@C@A@CDC@A@A@@@CAA@C@AAA@C@CAGC@A@A@AC@A@AA@@@A@A@C@@BA@@AAB@@AA@@A@A@A@A@@AAAAA@C@CA@@@AA@CAAAA@@@EBA@BBA@@@A@A@A@@B@B@@AB@@A@@BAB@@A@@@A@@BCB@BAB@@AD@@C@AB@@A@A@AB@@A@@BCAA@@BABA@ABA@A@C@A@@@A@@DA@@BA@@@A@@@A@AB@@A@A@@@ABA@@@A@AAA@@@@@EBAB@BCD@B@@@@@B@BABA@CB@@A@C@A@AA@@@@E@A@@@A@@@AB@@A@C@@B@D@@AB@@CDA@@@E@A@A@A@A@E@@@A@@A@@BA@@@@@AAA@@AAC@@AA@@@A@A@GAAAAAC@A@@@AA@@@@@A@A@A@A@@A@AAA@@AA@@@ACA@@@AAA@@AAAAAAA@AA@CAAA@@A@@@A@ABCA@@AAA@CBEAA@ACA@A@AA@@@B@@@B@@A@ABA@C@@@C@@BA@@B@@@BA@@@ABBB@@BDB@@B@@@@AB@@@B@@@BABABA@ABCD@@@@A@@@ABA@@B@@@@BBAB@B@@@B@@@@CB@@A@ABABABA@@@@@A@A@A@@@@B@@@@@BAB@@AB@BA@A@@@AB@@@B@@BB@@B@@@@@B@@@@B@@@BAB@@A@@B@@A@@@A@A@A@A@ABC@@@@BA@AB@@ABC@@@AAABA@AB@BA@@BABA@@@A@@BE@CBA@C@AACBA@@@AA@@@@AB@@C@A@A@@B@@A@@@A@A@A@@@@BA@@@A@@@@@AB@@CA@BAAGBA@ACAA@@@A@@AA@@C@CA@@E@A@A@ABEBEBA@EDA@EDEDEB@BCBCBABA@@@AB@@@BA@@BA@@@AB@BEDCDA@CB@BE@AB@BCBAB@BCB@@CBE@E@GAGAC@EACAGAC@AAG@AAC@A@A@@@E@C@A@A@@@A@A@A@A@A@C@C@A@A@@AIBGBCB@B@@ABAFAB@BADA@C@@@@AA@A@CB@@CAAB@BA@@B@@@BCBA@A@ABA@A@@@@@CBA@ABA@@BA@@@B@@B@@@@BDB@@B@@@@@@@@D@@@BD@@B@B@@@B@@@@DB@A@AB@@A@@BAB@BABABAH@B@B@@AB@@@BA@@B@@AB@@@BA@B@A@CBA@A@ABC@C@ABA@ABC@ABAB@BAF@@ABAB@BAB@B@BABAB@B@@@@ABCFCDADGH@DABCDA@CDCB@@ADIJCDCD@@EFA@CB@BAB@BB@F@@@B@B@@B@@@BD@@@@B@@D@BB@BB@B@B@DBB@D@B@@B@@@@@BCD@@A@@@C@ABA@A@ABA@A@AA@@A@CB@@@@B@@BA@@@BBBBBBBD@@DB@@DB@@BBDDB@@@ABB@@BB@B@@BBBB@@@@@@B@@DF@BBB@@FHDBB@DD@BB@HDDBJFDBD@B@@@@B@@BDBD@D@DBF@JBD@@AD@@DD@@BBBBB@@B@BB@@@B@BAH@F@@ADAB@@@BAB@@AD@B@@@BAB@BA@@D@F@DAD@FBB@B@@@D@@@@@BBD@@BBAD@B@D@BB@@BADA@BBBB@@@B@DBDBF@DBB@B@D@BBB@BBB@B@BBD@B@BB@@BAB@BALFVLAP@HDLDJHLJLFDLHLHTLLR@DAJCF@@A@@@@@A@@B@@A@@@@B@@@@@@AB@@@@B@@@@@@BBB@@B@BBB@BBB@@BB@@BA@@B@@BB@@@@@B@@@@ABA@@BA@@B@B@@@@@B@@@@AB@@AB@@@@@B@@@B@@A@@@@B@@@@@@@B@@BB@@@@@@@BA@@BA@@B@@@@@@@B@@B@B@@@@B@@B@@B@@B@@B@@B@@@@B@@@@@B@@B@@BB@@@@@@B@@B@@@@@BB@@@@@@B@@@B@B@@BB@@@B@@B@@@@@B@B@@B@@@BB@@@@B@@@B@@@B@B@B@@BB@B@BBB@@@B@@BB@@@@@@@BB@@@@@@B@@@@@@@BA@@@@B@@@@@@A@@B@@A@A@@AA@@@@@@@@@@B@B@B@@@B@BB@@@BB@@@@@@B@@@@AB@@AB@@@@BB@@@@BBB@BBB@@BBBB@@BB@B@@B@@BB@@B@@@B@B@@@@@@BBB@@@@@@B@@@@@@@BAB@BA@@BB@@B@@A@@B@@AB@B@B@B@B@@@B@@BB@B@B@B@@@B@B@@@@@BABA@ABA@@B@B@BA@BB@@@@@@B@@@B@@@BB@@@@B@@@@AB@@@BA@@@BB@@@BB@@BBB@@BB@@@@@B@@@B@@@B@BA@@B@@@BB@@BBBB@@@BB@@B@B@B@@@BBB@@BB@B@BBB@B@@@B@B@@@BAB@B@B@B@B@@@@@BB@@@B@B@@@BB@@@B@@BB@@@@BB@@@B@@@B@BB@@BB@@@@@B@@@B@@@B@@@@BB@@B@B@@@B@BAB@@@B@@@@@@DBB@@@@BB@@B@B@@@B@BBB@@@B@@B@@@B@B@@BB@@@@BBB@@@B@@B@BB@@BB@@@@@BA@@@BB@@BBB@BBB@@@@BBB@BB@@BBBBB@@B@@BB@@BB@B@BBB@@@@@B@B@@ABA@@B@@A@@BB@@BB@@B@@BB@@@BB@B@@B@@BB@@@BB@B@BBB@B@D@BBB@B@@@B@DBB@@@B@@B@@@@AB@B@@@B@@@B@@BB@B@@@B@@@@B@B@B@BBB@@@B@@AB@B@@BB@B@@@@BB@@B@@B@@BB@@BB@B@BB@@@@BB@@@B@@@@A@A@A@@@CB@BA@@@@B@@@B@BB@@B@B@B@B@B@@AB@B@B@@BB@@@@@B@@@@BB@B@@B@@BB@B@DBB@BBB@B@DBB@B@B@@@B@FAB@BAB@@A@@BAB@B@@@B@@@BB@@@BBB@@@B@B@@@B@@@B@@BB@@@BA@AB@@@@@B@@BBBBD@B@@BB@B@BB@@@@@B@@@@@@@@AB@@B@B@@@B@B@@@@B@@AB@@@B@BBDBB@@@@BB@@B@@BBB@@@@BB@@@@@@@BB@@B@@@B@D@B@B@B@@@BBB@D@B@@@BAB@@AB@B@B@B@D@B@BAB@B@@AB@@@B@@@B@@BB@B@B@@BB@B@@B@@@BB@@B@@@@@@B@@@BAB@BBB@@@BBB@@@BBBBB@BB@@B@@DD@BBB@BBBB@BBBB@BBBB@BBBBB@DB@@B@B@BBB@BBB@@B@@@BB@DDB@BBB@BBB@@@DBBBB@@@DBBBB@@@@@B@B@B@B@B@B@B@B@B@B@D@B@@@B@B@DAB@B@B@BAB@D@LDB@BBB@BBBBDB@@@@@@B@@@@B@@@B@BBB@B@@@D@@@B@@@BB@@@@B@@@@@BB@@B@@@B@@B@BB@@BBB@@BB@BBBBB@BBBBB@BBBBB@DBB@BBB@BB@@BBB@@BB@@BBBB@BB@BB@@BBB@@@B@@@B@BAB@B@B@B@BBB@B@@@B@@BB@@B@BBB@@@B@BBB@@@B@@@B@@@B@@@@@B@@BBBB@@BB@@@@@B@@@B@B@@@BA@ABABAB@BA@@B@@@B@B@@@B@B@@@B@B@BBB@B@BBB@B@@BB@@@BB@B@@@B@@BBB@@@D@@@B@B@BAB@@@B@D@BBB@BBB@BBB@B@@@B@B@BB@AB@BA@@D@BA@@B@BAB@@@B@BAB@BA@AB@BAB@@@B@@@D@BBB@B@B@B@@BB@BBB@@@BBB@BBDBB@BBBBDD@@@BB@@BB@BBBBBBB@@@B@B@B@B@@@B@B@BAB@@@BABA@@B@B@BA@@@A@A@@@@AAA@@A@C@@BA@@@@@AAC@AB@@AA@@AA@AAA@EA@@AAAAA@AA@AA@A@@AAA@@@AB@BA@@BA@@@A@AAA@AAAA@AAA@@A@@BA@ABA@AB@BA@@@ABA@A@E@CBA@E@A@@@AAA@@BA@AD@DA@@@A@@@A@ACAIECAECCAAAAA@A@AAC@AAAACAA@AAACAIAEA@@A@@A@ABA@A@A@A@A@AAAAA@A@@B@@AB@B@DAB@BA@CBAA@AAA@EBA@EBC@C@C@A@C@C@CC@@AAAABAAA@A@A@AB@BA@@B@B@B@BABC@@@ABA@AB@@A@ABA@AB@BA@@B@BAB@B@BABCBA@A@A@C@AB@@AB@@AB@@A@@A@A@A@AA@@A@BA@@@A@A@@BA@@B@BAB@@@@A@@@A@@AA@A@@@A@AB@@@B@@@B@B@@@BAB@B@@@BABA@@@ABA@@B@@@@@BA@@@@@@AA@@A@@@A@@@@@A@@@@@AA@@@@@@@@@A@@@@@@@@@@@AB@@@B@@@@@@A@@@@AA@@@@A@@A@@@@BAA@@A@@@@@@@@@@@A@@B@@A@@@@@A@@@@@@@@BA@@B@@@B@@@BA@@@@@@@AB@BA@@@@BA@@A@@@@AA@@B@@A@@@A@@A@@@@A@@A@@@@@@@AB@@@@@@A@@@@@@A@@A@@A@@@AB@@A@@@@A@@@@A@@@@@@A@@@@@AB@@@@@@@@@BB@@B@@@@@B@@@B@@@B@@@@@@A@@@@@A@@@@A@@@@@A@@@A@@A@@AAA@@@@@@@@@@@@@BA@B@@@@B@@@B@@@B@@@B@@@@A@@@@B@@@@@BA@@@@@@@A@@@@@@A@A@@@A@@@A@@@AA@@@@@@@@@A@@@@@@@@@A@@A@@@A@@@@AA@@@@A@@@@@@B@@@@@@AB@@@AA@@@AA@@@@@A@@@@@@AA@@@BA@@@@@A@@B@@@@@@A@@@@@@@A@@@@@@@A@@@AA@@A@@A@@A@@@@@@@AB@@@B@@@B@BA@@B@@@@@@@@A@@@@@@A@@AA@@@@@@@@A@@@@B@@@@@@AB@@@@@@A@@A@@A@@@@@A@@@@@A@@@A@@@@AA@@A@@@@@A@@@A@@@@@@@@A@@@@@@B@@A@@@@@@@@@@AA@@@@@A@@@@@@@AB@@@B@@AB@@@@@@@AA@@@@@@@A@@@@@@@A@@@@@@@@@@A@@@@@@@A@@@@@@@A@AA@@@@A@@@@@@A@@AA@@@AA@@@@@@A@@B@@@@A@@B@@@@@@A@@@@AA@@@@@@A@@A@@@@@@@@@AB@@@@A@@@A@@@@@A@@AA@@@@@AA@@@@@A@@@@@A@@@@BA@@A@@A@@@@@@@@A@@@@@@@A@@@@@@@A@@A@@@@@@@A@@@@@@A@@A@@@@A@@@AB@@A@A@@AA@@AA@@@@@@@A@@@A@@@@@@@@@@@@B@@@B@@@@@@@B@@@@@BA@@@@@@AA@@@@@A@@@@@@B@@AB@@@@@@A@@@@BA@@@@A@@@@A@@AA@@A@@@@@AAC@@@AA@@A@@@@@@A@@@@BA@A@@@@@A@@@@@@@AA@@A@@@@@@BA@@@@B@@@@@B@@A@@B@@@@@@@BA@@@@@@@AA@@@@@@@@@A@@@@@A@@@@@@@@@@A@@@@BA@@@@B@@@B@@@@@@A@@@@@@@AB@@@@@@AB@@@@AB@@@@@A@@A@@@@@@@@@A@@@@@@BA@@@A@@@@@@@AA@@@@@AA@@@AA@@@B@@A@@B@@A@@@@@A@@@A@@@AA@@@@@@A@A@@@A@@@@@@@@@A@@B@@AB@@A@@@@@@@AA@@A@@@A@@B@@A@@@@@@B@B@@@B@@@BAB@@@@A@@@@AA@@A@@AA@@A@@@@@@@@@A@@@@@A@@@@@@@@@A@@BA@@@A@@@@@@AA@@@@@A@@@@@@@@BA@@@@@@@AA@@@A@@@@@AA@@@@@AB@@@@@@A@@@@@@AA@@@AB@@A@@@@@@@A@@A@@@@A@@@@@@B@B@@@@A@@@@@A@@@@@A@@@@@@@@@A@@B@@@@AB@@A@@@A@@@A@@@@@@B@@@B@@A@@@@@@@A@@@@@A@@@@@A@@@A@@B@@A@@@@BA@@BA@@B@@@BA@@@@@@@A@@@A@@@A@@@@@A@@@A@@@@@A@@B@@A@@@@@A@@@A@@@A@@B@@@@AB@@@@@@@B@@@@@@A@@@@@A@@@@@A@@@@@A@@B@@A@@@@@AB@@@@@BA@@B@@@@@B@@@B@@@B@@@BA@@@@@@B@@@@@@@B@@@BB@@B@BB@@B@@@B@B@B@@@B@@@@@B@@AB@@@B@B@BA@@B@B@B@BA@@B@BB@@B@BB@@@@BB@@B@@B@@@@BBB@@BB@@BBB@@@@@B@@@@@@BB@@@@@B@@@@@BB@@@@B@@@B@@@@@@BB@@@@@@@BB@@@@@B@B@@@BB@@@@B@@@@@@B@@@@@@@B@@@@AB@@@@@B@B@@@@BB@@@B@@@@@BA@BBA@@B@@@@@@A@@B@@@@A@@@A@@@@BA@@@@@B@@@@@B@@@@@B@@B@@@@B@@@@@BB@@@@B@@@@@B@B@B@@@@AB@@@@@B@@@B@@@B@@AB@@BB@BBBDB@B@B@BA@@BA@@BABABA@AB@B@BA@@@A@@B@D@@@BAB@@@B@@@@@B@@@@@@AB@@@@@B@@A@@@@B@@A@@@@@A@@@@@@BA@@@@@@B@@@@@B@@BB@@@B@@@@@B@B@@@B@@@@@B@@@@@@@BA@@@@@@B@@@@@B@@@@@B@@@B@@@@@BA@@@@B@@@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@@@@B@@@B@@@@AB@@@B@@A@@@@B@@A@@@@@@@@@A@@@@@A@@@@@@@A@@B@@A@@@@@@@AB@@@@@@A@@@@@@@A@@A@@@@@@A@@@A@@@@@@B@@@@A@@@@@C@@AA@@@@@@@AA@@@@@@@@A@@@@B@@@BA@@@A@@B@@A@A@@B@@@B@@A@A@@@@@@@@B@@@BA@@@@B@@@@@B@@BBA@@B@@@@@B@@@B@@@@@@AB@@@@@BA@@@@@@@A@@B@@A@@@@@A@@@@@@@AB@@@@@@A@@@@B@@A@@@@@@@A@@@@@A@@@@B@AA@@@@B@@@@A@@@@@@@@@AA@@A@@@@@@@@@@@A@@@@@@@A@@A@@A@@A@@@@A@@@@A@@@@A@@@@@AA@@AA@@A@@@@A@@@A@@@@@A@@B@@@@@@@@@@@@A@@@@@@@A@@B@@@AA@@@@@@@@@@A@@A@@@@@@AB@@@@@@A@@@@@@BA@@@@@@@@@A@@@@A@@@A@@@@@A@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@B@@@B@@@B@@@@@@BB@@@@@B@@@BA@@B@B@@@@AB@@@@@@@@A@@B@@@@@@@@AB@@@@@@@BA@@B@@@@@@@@AB@@@@@@AB@@@@@B@@AB@@@@@@@@@@@@AA@@@@@AA@@@@@@@@@AA@@@@@@@@@@A@@B@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@AA@@@@A@@@@@A@@@@@A@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@A@@@@A@@@@A@@@@@@@@A@@A@@@@@@@@A@@B@@@@@@@@A@@B@@@@@@A@@@@@@@A@@@@@@@A@@@@@@A@@@@AA@@@@@A@@@A@@@@@@@AB@@@@@@@@@@A@@BA@@@A@A@@@A@@@@@A@@@@@@@A@@A@@@@@@@@AA@@@@@@A@@A@@A@@@@@@AA@@@@@@AA@@@@@A@@@@@A@@A@@@@A@@@@@@@A@@@@@A@@@@A@@@@@@@@@@@AB@@@@@@@B@@@@A@@B@@@B@B@BA@@B@@@@@@AB@@@@@BA@@@@@A@@@@@@@@A@@@A@@@A@@A@@A@@@A@@@@A@@@@@@@A@@@@@@@@BA@@@@B@@@BAB@@@BA@@B@@@@@BA@@@@@A@@@A@@@@@@@A@@@@@@@@BA@@@@@@@@BA@@@@@@@@@AB@@@@@B@@A@@B@@@B@@@@AB@@@@@B@@@@A@@@@@@@AB@@@@A@@@@BA@@@@@A@@@@BA@@@@AA@@@@@@@A@@@@@@@@@A@@@@@@@AA@@@@A@@@@@A@@@@A@@A@@@@@@@@AA@@@@@A@@@@@@@@@@A@@@AA@@A@A@@@A@@@@@A@@AA@@@A@@@AA@@@@A@@@@@A@@@@@@@A@@AB@@@@@@@@@@A@@@@@@@@@@@AA@@@A@@@AA@@@@A@@@A@@@@@A@@@A@@@@@A@@@@@AA@@@@@@A@@A@@@@@@@@@A@@@@@@@AB@@@BA@@@@@@BA@@@@@@@A@@B@@@@@B@@@B@@@B@@@@@B@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@@BB@@B@@@@@B@@@@@@@BBB@@@@@B@@@@BA@@@@@@@@@BB@@@@@@B@B@@@@@B@@@B@@@B@@@B@@@B@@@B@@@BAB@@@@@B@@AB@@@@A@@@@@A@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@@@@@AB@@@B@@@@@B@@@@@@@B@@@B@@@B@@@B@@@@@B@@@@@BA@@@@B@@@@@@@D@@AB@B@FAB@BA@A@A@@AA@@A@@@@C@@@ABABA@AB@@AFA@@BA@@AACECEAA@@@@@@FC@A@A@@AC@@CAAA@AAA@@@A@@AA@A@@AA@@B@BAB@DC@A@AB@@A@BD@BB@@D@@ABAB@DC@@B@@A@@B@@@B@@@B@@A@@@C@A@A@A@@BADAB@B@D@@@D@@@B@@@B@@BB@DBB@BB@@@@@BB@@B@@B@B@HBDBBBBBBAD@B@@@BA@@BABAB@B@DB@@@@BBBB@@D@@@B@@@BB@B@@H@B@B@B@@@DBB@BAB@F@B@B@DABAFAF@@@@A@@@A@@@ABABE@A@@@A@@BAA@@@A@@@@A@@AAA@AA@@AAGA@@@@AA@@BAB@BABABABAB@@A@@AAAACCA@GEC@AACC@AAC@@@@FADA@@@AB@@@@@@A@@@@@@@@@AB@@@@A@@@A@@@@@A@@@A@@@A@@@A@@@A@@@@@A@@B@@@@A@@B@@A@@@@BA@@@@@A@@@@@@@@AA@@@@A@@A@@@@@A@@@@@A@@@@@@@@@@@@A@@AA@@@@@A@@@A@@@@A@@@@@@@@AA@@A@@@@AA@@@@@A@@@@A@@@@@@@A@@@A@@@@@@AA@@@@A@@@@@A@@@A@@@@@AA@@@@@A@@@@@@@A@@B@@A@@@@@A@@@A@@AA@@@A@@@@@A@@@A@@AA@@@A@@@A@@@A@A@@B@@A@@@@@A@@BA@A@@@A@@AA@@@AA@@@A@@@@@@@A@@@A@@@A@@@A@@@A@@AA@AA@@A@@A@@@@@A@@@@BA@@B@@@@AB@@@@AB@@@@AB@@@BA@@B@@AB@@@B@BA@@B@B@@A@@B@@@B@@A@@@@@@B@@A@@@@BA@@@A@@B@@A@@@@@AB@B@@@B@@AB@@@@@@@@AB@@@B@@A@@@A@@BA@@@AB@@@@A@@@@@AA@@@@A@@A@@@A@@@@@A@@@AB@@@@@@A@@@A@A@@@A@@@A@@@A@@@@AA@@AA@AA@@@@@A@@@A@@@@@@@A@@B@@A@@A@@@@A@@@@A@@@@@AAA@A@@@A@@AA@A@@@A@A@A@AA@@@@@@B@@@BA@@@@@@@A@A@@@@B@B@B@BB@@B@B@H@@@B@B@@@@@D@B@@@B@@@B@B@@@BAB@@AB@@ABA@@BAB@B@@AB@@@BAB@B@@@@@@AB@@@B@@AD@B@B@B@@@BA@@@@@@@@@C@@@A@ABA@@B@@@B@@AB@@@@@BA@@AA@A@@@A@@@@AA@@@@@@@A@@@@A@@@AA@@@@A@A@A@AA@@A@A@@BA@C@@@@@AA@A@@A@A@@AAAA@A@@@AAAA@@@@CAKGEC@A@@BC@@@A@A@A@CCAA@@AACCA@@EAE@AA@@A@A@C@@AA@@ACA@A@@CA@@GAKCAA@@AB@@A@A@A@@@@AAA@A@@CA@A@@ABA@C@@AACA@@A@@BC@@@A@A@@BAAA@@BA@@@@AA@@@AB@@@AA@@BAA@@ABA@@@C@@@@BAB@@@BC@A@@B@@@BC@A@@@A@AA@AA@A@@@A@@@AA@AAAA@@C@A@A@A@C@A@@AC@CCAAC@@@ABA@A@A@ABC@@@AA@@A@@@C@@@AAA@A@AAA@AB@@A@AAAAAAA@@AA@@A@A@@@AAA@@AA@AB@BAAA@@BA@@@A@@B@@A@@B@@A@@BA@A@ABA@A@@BCBAB@A@@A@A@A@@A@AA@@ABC@@BA@EAAA@@A@CACA@@@B@@@@B@@B@@@B@B@B@@@@EBGB@@@AAC@@CAE@@@CC@@@@@AA@@@@A@@@AAA@A@@AAABA@@BA@A@A@ABAA@@A@@@ABA@AAA@CBC@@BA@A@@@@B@BA@BBABB@@BB@BB@F@@B@@BBD@@B@@B@@CDABA@@B@@C@@B@@E@@@@@AB@B@BA@@B@@AD@@@B@BA@A@@BA@ABAB@BC@ABA@@@A@A@@@A@ABA@C@A@A@A@@@AA@AA@A@@AC@@CA@@A@@A@A@@@A@A@A@A@A@C@@@A@@@@B@@@@A@EA@@A@@@C@AB@@@B@@A@AAA@A@AB@@AAC@@@CB@@CAA@CBABABA@A@@BB@ADB@@B@@@BCD@@@@AB@@@@A@@@@B@@@BCAABE@C@KAC@E@AAEA@@IFGBCBE@G@CBC@SDABG@IDA@@@BB@@CDCBABC@AB@BG@A@ABA@A@@@CA@@E@ABEBC@BB@@ABBB@@BB@BDBB@@BFBB@@@@B@@DBHD@@@BA@AD@@AB@@@B@@BB@@@BBB@@@BBBA@@@BB@@@BAB@@@BAD@@CB@@AB@@@B@@ABABE@ABA@A@@@AB@@C@ABC@A@@@AF@B@@@B@@@D@@ABABAB@B@DA@EBA@ABCB@BA@AB@@@B@B@@@B@@@BDD@BA@@B@@ABABA@EB@BA@@B@@@B@@AF@@ABAD@@ABA@A@ABAACBA@GBA@A@AB@@ABCB@@CD@BCB@@@@@B@B@BABA@AB"],encodeOffsets:[[127739,43535]]}},{type:"Feature",id:"211281",properties:{name:"调兵山市",cp:[123.567117,42.467521],childNum:1},geometry:{type:"Polygon",coordinates:["@@CEB@CCA@BAAA@@AEAA@@FABAAAAA@@BACCB@AAAAECFBD@B@B@AE@A@A@AAC@AC@A@BA@A@@B@B@@@@@@@CAA@@@@A@@A@CB@@@CGA@GC@@EA@@AA@C@@@@AA@@@AA@@@ABA@@@A@@@@@AA@A@@@AA@@C@@BBD@@@@A@@A@@A@B@@BAACBA@BD@BA@CB@G@AC@AB@@@@@@@A@@A@AA@@A@C@@CI@@@@AA@DHA@@ACECBDFC@CCB@CEAAB@CEAC@@A@BD@@BD@@@BABCG@@BD@@CBA@E@A@@@AC@@@@@A@@@@@CE@@D@@@@EBC@A@ABA@@@AA@@A@@@@B@BFB@@B@ABAA@B@BBB@@@@EA@B@DBD@@A@A@A@@C@AEAA@A@BBB@@@BF@BCCIBAC@@A@A@@B@@CA@@A@@B@BA@@@AB@@@@@AAB@BA@AB@@@@@@@@@@A@@@@@@@@@A@@@@@@B@@@@@@A@AA@BA@GC@@@AB@@A@@@A@@@@@A@@AAA@A@@@AB@@@B@@@@@BA@CBCD@@@@@@A@@@A@@@@B@@A@@A@@AB@@CB@BBAB@@BA@@BAB@B@BAB@@A@@@@AB@BEA@@@@B@BCB@@A@AAAB@BA@@BA@E@@@@@@A@A@@A@BA@@AA@@CBA@A@@@@AA@A@E@@AB@B@FAC@@@@A@@AAEA@@@@CC@BABA@AA@@A@AA@@A@@ABB@A@ABC@AB@@@BAD@@@@@@@@AAAAAA@A@@@AB@BCA@@AFABA@@@A@C@@@@@A@@@@B@@ABA@@@@@@@@D@@ADA@AB@BAB@BAA@@@AA@@@AB@@E@C@G@@BA@AB@BCB@BA@@B@@@B@B@BA@ABA@A@@BA@AB@@CBA@A@ABA@@B@@@BA@@@A@@B@@A@ABAB@@@@@@@B@@BBB@BB@B@@BD@B@@@@@B@@A@@D@@B@B@BB@@@BB@@B@@@@@BB@@B@@@BB@@@A@A@AB@@@B@DBB@BBB@BB@@@@B@B@@BBDBB@DABBAB@@AB@DADA@A@AB@@@BABA@CBABABC@@B@@@BA@AD@@A@A@@B@@@@@BA@BDAB@BAB@@@@A@A@ABA@@@A@A@A@@BA@BBA@@B@B@@BBB@@@@BA@AB@@A@BB@B@BB@DD@@@BB@@BB@B@B@BBDBBBB@BB@@BDBB@@@B@BB@DB@@B@@B@@@@@DADB@BBBBB@@BB@DDB@B@D@@AB@B@@@B@B@DB@AB@BA@@H@B@B@@B@@BB@@BB@@D@DBBBB@BBB@F@@@@@@B@@BB@B@BB@@B@@@BD@@B@B@@@B@@BB@@@@B@DB@@B@@CB@@@BA@@@BABBBA@@B@BBB@@B@@B@@@@@@CA@@BDBBB@@D@@B@@A@@BA@@@AB@B@BBB@DB@@@A@@BA@AB@@A@@@AF@@B@@BBBB@@@@@B@@BB@@B@BB@@BBDD@@@@B@DBDABBBAB@ABB@@@B@B@@@@@A@@B@@@@@@C@@B@@B@B@@AB@B@BB@B@@B@@@DD@@@BBB@BB@BBD@@@@BB@B@B@@AB@BC@@@@@D@@DDB@@BA@@@@@@B@B@@B@@@BBB@@@B@@AB@@AF@DAD@BAB@B@@BBB@B@@BDA@@@@BBD@BA@A@BBD@B@XALAHA@@@BDDFL@BBDDHIDDH@B@@ABB@@B@@AD@@@@B@JAHA@DBDD@AL@@BAB@DA@@B@@@@B@@@@A@@@@B@@@@@B@@@@AB@@@@@@HD@@@@@@RHFDB@F@BA@@B@B@@A@@@@D@@@BA@@B@@@@BB@B@B@B@@@@@@@CB@@B@N@BADG@@A@AAAA@@@@BAB@B@ACAA@@AA@@AAB@B@@@@@@AAE@@BC@@@A@@@A@@B@FA@@@AAA@AEGCA@@A@ABA@A@@@AC@@@A@AA@AC@A@@@@BA@AA@ABA@@@@@COB@B@HEFCDC@@FI@A@A@AAAAA@A@C@A@ABG@AB@B@BEBC@A@A@A@C@@@A@AA@@@@A@IBA@KB@@A@@@A@@@E@AAGA@A@@@A@@AD@@@EGCBAICG@ABA@@@ADA@APCAAB@@AFA@@@A@@B@@@B@@@B@@@BA@@@AAA@@B@B@@@AE@@F@F@D@AC@@J@@@BFB@@@FAPEGO@@FACEDCFB@A@@EABA@AB@C@@AI@[B@@A@G@A@GBEC@@GFCBA@AB@@"],encodeOffsets:[[126630,43421]]}},{type:"Feature",id:"211224",properties:{name:"昌图县",cp:[124.111099,42.785791],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@B@B@@AB@F@FADAB@@@@@@A@@B@@@@@@A@@B@@@@@@@@@BA@@@AB@@AB@@@@@@@@AB@@@B@@A@@@@@@BA@@BA@@@@@@B@@AB@@@BA@@@AB@@@@@BA@@@@B@BA@@@@BA@@@@@@BA@@@@B@@A@@B@DE@@@@B@@A@@@@@AB@@A@@@@BA@@BA@@BA@@@@@@@@BA@@@@@@B@@@@AB@@@BA@@@@B@@A@@B@BA@@@@DA@@B@@@B@@@@@BA@@B@BA@@B@@@@@B@@AB@@@@@B@BA@@BAB@BAFAB@B@BAD@@@AA@@@@@@B@@A@@@@BAB@BA@@@@@AB@@A@@@@B@@@@AA@A@AAAA@@AA@AAAA@ACAAA@@AAA@@CAAA@@AA@@CA@AA@@AA@AA@@AA@ACA@@AAAAAAAAAA@@AAA@AA@@AAAAAACCAAAAAAAAA@@AA@CCAA@@@@CC@@AAA@@ACA@AA@@AA@AAAA@@AA@@CACC@@AAA@AACAAAAAA@AA@@AA@@AAA@@AA@AA@@AA@@AAA@@AA@AA@@AAA@AA@@A@AAAAAAA@AA@@AA@@AAA@@AA@@AAA@@CC@@@A@@B@@@BA@@@@@@B@@@B@@AB@@@BA@@@AB@@@@@BA@@@@BA@@B@B@@@@@@AB@@@BAB@@@B@@A@A@@@@BAB@@@@@@@@A@@@@B@@@@AB@@@@@B@@@@@BA@@@@BA@A@@@@@A@@@@@@@@BA@@D@@@B@@@@@@A@@@@B@@AB@@@@@@@@A@@@@@@@@B@B@B@@@@@B@@@@AB@@@@@@@B@@@B@@@@@B@@AB@@@@@@@@A@@@@@@@@B@@A@@@BB@@AB@@@B@@@B@@@@@@@B@@@@@B@@@@@BA@@@@@@B@@@@@@@@@@@@A@@B@@@@@B@@@@@@@@@B@@@B@@@B@B@@@@@B@@@@@B@@@@@B@@@@@B@BB@@B@BA@@B@@@D@B@BBB@@@B@B@B@B@B@@@@@@@B@BBB@B@BBB@DBBBB@B@BBB@BB@@@@@AB@@@B@@@B@B@B@B@@@@@B@@@BA@@@A@AA@BA@@@@@@B@B@BAB@@A@ABA@@BA@AB@@@B@@@B@@@@@@@B@@@@@@AB@@@BA@@B@BAB@@AB@BA@@BA@@BA@@@AB@@A@@@@@A@@@@A@@@@@@@@@@AA@@A@@@A@A@@@@@@AA@@@A@@@@B@@@BA@@@@@@@A@@@@@@@AAA@@BA@A@A@@@A@@@@@A@@@A@@@@A@@@AAA@A@AA@@CAA@@A@@A@@A@@@A@A@A@@AA@@@A@@@@AA@@A@A@A@@@AAC@C@A@@@A@@@AA@@AAA@A@@@A@AAA@@@@@@@AA@@BA@@@@@A@@AA@@@@@@@@@@A@@@A@@@A@@@A@@@@@A@@@@@AA@@A@@@AA@@A@@@A@@@ABA@@@AB@@@@@@@A@@@@@A@@@@A@@@@@AAA@@@@@@@@@@A@@@AB@@A@@@AA@@@AA@@A@@AA@A@A@A@@A@@A@@AA@@@A@@A@@AA@@@@@BA@@@@@ABA@A@@@A@@@@BA@A@@@@@A@@@@AAA@@@@A@@@AA@@AA@@A@@AA@@@A@@A@@@A@@AA@@@@A@@@AAA@A@AAA@@@AA@@@@BA@@B@@AB@@@@AAA@@@A@@@A@@@A@@@@@A@A@@A@@@@A@@@@@@@@A@@@@@A@@@A@A@@A@@A@@@@@@@@@A@@@@@@@A@@@A@@@@@@@A@@@@@A@@@CAMBAA@A@@@@AA@@CBCAAB@@A@@@EB@AAA@@A@AAA@C@A@@AAA@@AACA@AAA@@@@@B@@@@C@A@@@AB@@@AC@@A@@@A@@@A@A@@@@@@A@A@A@@@A@@A@@AA@@@AA@BE@@@C@@@A@AA@@@@@@AB@@@D@@@DA@@@AA@AA@@@@BA@@AA@@@@BA@@B@B@B@BA@@@AC@AACAA@C@CCAAA@@@CC@A@@ACAA@@BA@AACAA@@@A@@AA@@@C@A@ABC@@@ABA@@@A@ACCA@@A@AB@@AB@BCBA@A@@@A@@@@BABC@C@A@AA@@@ABAB@@AB@@G@AAA@AA@@C@@@ABEDCBA@A@E@@A@@AAAAA@@@@@@B@@ED@@C@AB@BA@@B@B@@AAACAAAAA@AAA@A@@BA@@B@B@@ABAAA@@AA@@@C@A@@@CA@A@@@AA@CB@A@@AA@@A@@@A@A@@AA@@C@@@CBA@A@A@@@A@@A@@AA@@ABA@AB@@A@@AAAAA@@A@ABA@@@@B@@@AAC@AA@@AAABA@A@A@@AB@@@ACA@@@@@BA@AB@@@@AA@@@@A@@AAA@A@@@@@@AA@CA@@C@C@@AA@AA@A@A@AB@@ADA@@B@B@@@@A@@CA@@C@C@CAA@A@@@@C@A@AB@BA@AB@@@@AB@BA@AB@@@DA@@A@A@A@@@@A@A@@AA@@AA@AAA@@CBAA@@AA@@@A@@@AA@AAA@AA@@AAC@@AA@A@AAA@@A@@B@BA@AAAAA@@@A@AA@A@@@AB@@A@@@AAA@@BA@A@@AAAA@AAA@A@@A@A@A@@AA@@@A@@B@B@@A@@BA@@@AAC@@@@@AA@AA@@A@@@A@@AAA@@CA@@AA@A@@A@@C@@@A@@@C@@@@@A@@C@CAA@AA@@CB@@@BA@C@@@A@@@@@ABA@A@@BCAC@C@@@AA@@AA@@C@@AEAAAAA@A@@BA@A@@@AB@@@AE@@@@AAA@@@AAAAA@A@AAA@ABA@@@A@CA@@A@EAA@C@CB@@CAA@A@A@@@@@AAA@A@C@AA@@AAAAAACA@AA@AC@AAAACA@@AACACACCCAEAC@AB@B@B@BA@EDA@A@@@@A@@ACCA@AAA@A@A@A@C@AA@@ACAACBA@A@A@@@@@CCAAC@A@@@AB@@ABABC@AB@DADCFCDADEF@BABABEBIFABE@E@C@C@MBC@MBG@EAA@A@A@O@e@IAA@OBI@QAKAEACAKAOCG@GAGAC@@AA@CAA@CAE@C@C@K@A@EAECA@EAGAGCCAAAKGCAA@GCAA@@@@GEA@GEA@KICEA@IGEECAAAAAA@@AA@AA@@@A@@B@AGAEAA@@AECEAA@@CGKKCC@AAAAAA@AC@A@AA@@A@A@C@@@@AAA@@@AACC@@@@A@@@AAAA@@A@A@@@AAAA@@A@A@A@ACEC@@@@CA@AEAOKAAKA@@@@WCMAI@C@IAI@@@C@KA@@A@@@@@E@A@EAKCGIAEBE@C@@AA@AC@AAE@ABC@@@ADAB@B@BDBBBDD@DABABCBABCBEAG@E@CAA@@B@B@BDD@B@J@BCDA@E@C@@@CCC@C@E@CBA@@DAD@DABCH@DBB@BB@D@F@D@D@B@BB@B@B@BA@@B@@ABA@ABABADC@C@C@C@AAA@C@A@GAC@GBEBCBABCHADEHCBA@E@E@EAAB@B@BDBD@DBBBB@JDDB@B@BBB@BCBABIDEBGDID@@A@A@A@CBC@AB@@@BABKFCBEDCDCBCDCF@@@B@BB@BB@@BB@B@@@BDB@BDD@B@FABBB@BBD@@AB@B@B@BA@CBEBEBABC@A@C@A@AAA@A@AACEACBAACAACCAAAAEAEA@@@@ABABCHADABCDABEBA@A@@BB@BB@BBBB@B@DABABAB@BBBBAFADA@ADCBABEBEBCBE@CBABABAB@BBB@@BBHADBBBDBDBDD@D@D@@ABABI@ABCBCD@@@B@BCFGHABEBCD@B@D@B@@FDBBDB@BBBBBFFDDBBBHBHBDB@DBB@BAD@D@BBBB@D@DBB@@@BAB@@A@ABCBCBCFAB@B@B@DB@BBDBBBHBB@D@B@BAB@D@BABAD@B@B@DBB@BB@B@B@B@B@BCDEFADEDADA@@BB@BDB@DBB@BB@BBBAB@BA@@BA@AB@BBB@B@B@@CFABABAD@D@BADB@@BB@@BABADADB@@BB@@BDBB@FBD@HFB@AB@@A@@@A@@F@@ABE@AB@@ABABABC@@DAB@BB@BB@B@B@DA@E@ABC@A@@BA@@B@D@BDBDDFDDDFDJFBDBBAB@BCBIBMBABID@BAB@B@@DB@BAD@BBDBBDBDBLFFBBB@@@D@B@D@BB@B@BB@@AB@B@BAB@BC@ABG@CBEBCDABAB@B@DDFBFBB@@DBB@FAD@B@BBD@BBBDDD@B@B@BCBGHIHEBCBEB@BA@@B@D@B@BABAB@@G@A@C@A@E@ABE@ABABCDA@CBA@CDCDADADENGJ@DA@BBBBDDBBDDBBB@D@F@D@B@DBBBBB@@CDAB@B@D@B@BCBAB@BBD@BBB@BAB@B@@@B@@BB@BB@B@@@B@B@@@@A@@@A@A@AB@@A@@BA@@B@B@@@@@BBB@@B@@@@@BA@@B@@AB@B@@@B@@B@@BB@B@B@F@B@B@@@B@@@B@B@@@B@BBBBB@@BDB@B@@@B@@@@ABA@A@A@AB@@CB@@@@AB@@@@@@@BB@@@BBB@@@BB@@B@@B@@@@@@@B@@A@@BA@ABAB@BA@@B@@@B@@@B@B@@@D@B@@@BB@@@B@@@B@@@BA@@BA@A@@BAB@BAB@B@@@BBB@@B@@@BB@@B@B@@@BB@@@@B@@@B@@BB@@B@BB@@BBB@B@@@B@@BB@@@@BB@@@B@D@@@BBB@@@@BB@@@BBBB@BB@@@@B@@AB@@CD@@ABA@C@ABA@A@A@ABA@ABA@@BAB@@@@@B@B@@@B@@@@@@BB@@@@@@@@B@@@@@B@B@@@@@B@B@B@@@B@@B@@B@@@B@@B@@@@@B@B@@@BB@@BBBB@@@BB@@@@B@@@@@B@B@@B@@B@@@BB@@@@@B@@@B@B@@@@BB@@@BB@@@B@@@BBB@@@B@BA@@@@BB@@DB@@@@@@B@@@@@@@B@@@BB@@@B@B@@@@AB@@@B@@@BB@@BB@@@B@@BB@@@@BB@@@@B@@@@@@@B@@CFAB@@@@@@@B@@@B@@@@@B@@@@@B@@@@AB@@@B@@@@@@BB@@@@B@@@@@@B@@@B@BB@@B@@B@@BB@@@@@@@@B@@@@@B@@@BBB@B@B@@@@@BA@@B@@@@AB@BAB@DAB@@@B@@B@@@@BB@@@@@@@@@@B@@BB@@@@B@B@@@B@@@@@B@@@@@BB@@B@@@@@@BB@@B@@BB@@A@@@AB@@AB@@@B@B@B@@A@@B@B@@AB@B@B@B@@@B@@@BB@@@@B@@@@@BB@@@B@@@B@B@@B@@B@@@B@@@BB@@@@@@B@@B@@@@@BB@@@@B@B@D@@BB@@@B@@BB@@B@@B@@@@@B@@@@BB@@BBB@B@@@@BB@@@@BB@@B@@@@@B@@@B@@@B@@@@@@ABABCB@@AB@@@@AB@@@B@@@B@B@BABB@@B@BB@@BB@B@@BB@@@B@@@B@BAB@DAB@@@B@@B@@@@@B@@@BB@@@@BB@@@B@@@@@B@@@BB@@B@B@@BB@@BB@@BA@@@@BAB@B@@BB@@BB@@@B@B@@@BABABAB@BA@@@C@C@C@A@C@AB@@@@AB@B@B@B@@@B@@@@AB@@A@@BA@A@@BABA@@@@B@@@B@@@BAB@@AB@@@D@B@B@BB@@BBBBBBBB@BBB@BBB@@@BBB@@@BB@@BBB@B@B@B@@@B@BA@@D@B@D@B@B@B@BBB@DB@BB@@B@@@BAB@@ABABCBAB@@@@@B@@ABABA@@BABB@@@BB@@B@@@B@@B@@@B@@@BB@@@@BB@B@B@@@BB@@@B@@@BA@@@@B@BAB@B@@ABABADCB@B@@@B@@@@AB@@ABA@AB@B@@@BAB@B@@@B@B@@@B@@@@@B@@B@@B@@@BB@@B@@@@BB@@DBB@@BB@@@@@B@@@B@B@B@D@B@B@BBB@@@@B@@@@@BDBBBDB@BB@B@B@@@BA@ABA@@BAB@@@DAD@DAB@D@BBB@B@@BB@@B@@@B@B@B@@ABC@A@C@A@ABAB@BA@@B@@BB@BB@@B@@@B@@@B@B@@@BA@@B@BAB@B@B@@BBB@@@B@BB@@BB@@B@@@B@@@B@@@@B@@@@@@@B@BAB@BCB@B@@@B@@@B@@@B@@A@@B@@@B@@@B@@@B@@@@@@B@B@B@B@B@B@B@D@B@B@@@B@F@B@B@@BB@BBBBBB@B@B@B@BA@@BC@A@A@CAA@A@ABA@CBABABCB@BAB@B@@@B@@B@B@BBF@DBB@BBB@BB@BBB@@@B@BA@A@@BA@A@AAA@C@@@CBA@@B@@@B@@@B@@@@@B@@AB@@@B@@@BBBB@@BB@@AB@BAB@B@B@@BB@@@@BB@@B@@ABABAB@BAB@B@@BB@BB@@@BBB@B@B@B@D@B@BB@BB@@@@@ABA@A@A@A@A@A@A@C@A@A@A@CAAACAA@@@C@A@C@A@A@@@@B@@BBD@BBDBBBBDBB@B@B@D@B@B@@@BA@@B@@@B@BBBBB@B@@@B@@@@AB@@A@A@AAA@ABA@@BA@@D@B@@BBBB@@D@D@F@D@@@BB@@@BCDABA@C@A@@@CB@@AB@@@B@BBBBBBBB@BBB@@@@B@@@DCDAD@@@BB@@@B@B@B@B@DBB@@@@B@@ABA@AB@@ABB@@BBBDB@@B@@B@@@B@BABA@@B@@BB@@B@BB@@B@BA@@B@BB@@BDFDB@DBD@@@B@B@BADAB@B@B@B@@B@@@BA@A@A@C@ABAB@@AB@BB@@BB@B@DAB@B@B@BBDDDDDB@BBBBBD@BBD@BBBB@BA@@@C@C@@BABA@@BB@@BB@BBBAB@B@@@B@@@B@@BA@ABEDA@@BBBBD@BB@FBB@@B@@@B@BA@@B@BB@BB@DBD@BAB@@CBCBABA@AB@BBB@BB@D@DBB@B@DBBBB@@BBBBBDB@BB@BAB@B@B@@@BB@@@BBB@@FD@@@@B@@@@@@@@A@@@A@@@@DA@A@@@BB@@@@@B@@@@@@@B@@@B@B@@@B@@@BA@@B@@@@@B@@BB@@@B@B@BA@@@@DBBBBDBB@BBB@B@@@@@BAB@B@@@@B@B@FAD@@A@@FCB@DCBA@@@@@@@A@@@@A@@AB@B@@AB@@@B@@@@@BADAD@@AB@B@DABA@@BAB@FCB@BA@@BAB@B@@BD@B@BB@@B@@@B@B@B@@@FCDA@@B@DCD@BA@@B@@BBBBB@@@@BABAB@DABAB@@@BA@@@ADADA@A@@A@@A@@A@@AA@@@@@@@@@@@@AB@@AB@@@@@@@BAB@@AB@@@B@@AB@B@@AB@@@B@@AB@B@BAD@AAA@AA@AA@CECC@AA@@AA@AAAAC@AAAAAA@@@A@@@A@AAAA@@AAA@@@C@A@@@A@@@A@@B@@@@A@@@AB@@@@A@@@@@@AAA@@@@@@@@AA@@@@A@@BA@@@@@A@@A@@@@A@A@@CA@@@@@A@@@@B@@@@A@@B@@@@A@@@ABA@@@AA@@@@@@C@@@CB@@@@@B@BA@@B@B@@@BA@@D@@@BA@@@@BAB@BA@AB@@A@A@@B@@@@A@@@@@A@@B@BA@@@@B@B@@@@@@@B@@A@@@@B@@@@@@@B@B@@@B@@AB@B@B@@@@@B@@A@@B@B@@@@@B@@@@@@@@@@@B@@@@@@A@B@@@@B@@@@@@@@@BA@@@@@@B@@@@@@@BA@@B@@@@@@@B@@@@@B@@@B@@@@@B@@@@@@@B@@@@@@@@@BA@@@ABA@AB@@A@@@A@@B@@@BABA@@@@BA@@@@@@@A@@B@BA@@B@A@@A@@@A@@@@@@AA@@@A@@@@@A@A@@@A@@@@@A@@@@@AAA@CA@@A@A@C@AA@@@AA@@@@@@BAB@BA@@@@B@D@BAB@B@@@B@D@@@B@@@BAB@@@B@DBBB@@B@B@DAB@@@BB@@@@B@@@@@B@BB@AD@DADA@@DCBADAB@B@BAD@BABAB@@@B@@B@AB@@@B@@@B@@BB@@@@@@@@A@@BADCDAB@@ADAB@BABAD@BAHA@A@@A@@@@@@@@AA@@@@@@@AA@@@@B@DCB@BA@@B@@ABAB@DEFCDEDABA@AB@@ABA@@B@BA@@@ABABABAB@@@@@BA@@@AB@@A@@@@@AB@BA@@@AB@@@BA@AB@@@BA@@B@@AB@@AB@B@BA@@B@@@B@@@BA@@B@@ABAB@@@@A@@BA@@B@@A@@@@@@DA@AB@@@B@@B@@BADC@@FCBABA@@BA@@B@BABA@@BAB@@@DC@@B@BABA@@@@B@@AB@B@@A@@B@DBFFBBB@@@FD@@DBD@@@F@DBD@D@@@B@BBB@DBD@DBB@B@D@@@B@D@J@L@B@B@B@BBB@D@D@D@B@FAB@BBB@@BB@@@@@@@BA@@BC@A@@@@@@@A@@@A@@@AA@AEAACC@A@@@@HBD@BB@@BA@ADC@A@@B@@B@@@@AD@@B@D@B@@@@@@@BA@AB@@@B@@@@A@@B@@@B@@A@@@ACAAE@@CE@@@@BA@@@ADA@@BABA@@BA@@CA@A@@B@@A@@A@A@@@@A@@@A@A@@@@@@@A@@@A@@@@@A@@@@@A@A@@A@@AAA@@B@@@@@B@@@@A@@@A@A@@@@@@B@@@B@B@@AB@@@@@@B@@B@@@@B@@@@B@@@@@B@@@B@@@@AB@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@B@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@@B@@@B@@@@@@A@@@@@@@@@@B@@@B@@@B@@BA@@BAB@@@@@@B@@B@@@@@@@B@@@@@@@@@B@@B@@@@@@@B@@A@@B@@@@A@@@A@@@@B@@ABAB@@@@@@B@@@BB@@@B@@@@B@@@@@@@@@@B@@A@@B@@@B@FA@@@A@@@@B@B@B@BD@@BB@@B@@@@BB@@@B@@@B@@@@@@A@@@@A@@A@@AAA@@@@@@A@@@@@@@A@@A@@@@AD@B@@@B@B@@B@B@@@@B@@B@@@@B@@@B@@@@@B@BA@@BA@@@@A@CAC@@@@A@@BA@@@@@@@@D@B@@B@@B@B@@@BAB@@@@@@@BAD@@ABA@@B@B@B@@@@@@B@B@B@B@@@@BD@@B@@AB@@@B@B@@@B@@@BAA@@A@@A@@@@A@A@@@@@AB@@AB@@@B@B@@@@B@@B@BB@@B@@@@A@@@@B@@@B@@B@@DBB@@BB@B@@@@@BA@@@@@A@@@@BA@@BA@@@B@@B@@@@B@@@@@B@@B@@B@BBA@BBABB@AB@BA@@B@@D@@@B@@@@@@D@BBD@@BA@@@@BB@@BD@@@@@B@@@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@BB@@@@@@@@@@B@@@@B@@@@@@BB@@@@@@@B@@A@@@@@A@@B@@AB@@@@A@@@@@@@@@@A@@ABA@@@A@@A@@AA@@@@A@A@@@@@A@@D@BBB@B@B@@@@AB@@@@@AAA@@@AAA@@A@@@AA@@@BA@@@AB@DB@B@@@@@@@BB@@@B@B@BA@@@@AA@@BA@@B@BC@@@@@AAA@@BA@@B@B@B@@@B@B@@@@BB@BB@@B@@A@@BA@@@@BAB@@BB@@A@@B@B@B@B@@@@@@B@@A@@@@B@@B@BBB@B@B@@BB@B@@BB@@A@@B@B@@AA@@C@@AA@AB@@AB@@@@@DDB@@A@@@@B@@@@@BB@@@@@B@B@B@BB@@@@B@@@@B@B@@@@@B@@A@@@@AA@@BA@AA@@@@AAA@@B@@@B@@AB@@@@B@@BBB@@@@@@CB@BAB@B@@@BBB@BBBBB@@@BAB@@B@@BA@AA@@@BC@@@A@@D@@@B@@@@BB@@@B@@@B@@@B@@@AA@@@@B@@A@@A@A@A@@@@ABC@A@@AA@@DC@@@@@A@@@@AA@@@A@@AA@@@A@@@@@@BA@@B@@A@@@@@@@A@@@@@A@@BA@@BA@ABA@@@@B@@AB@@@@A@@@@A@@@@A@@@@@@A@@@@@@@@@ABAA@@AAAAAC@@BA@@ACA@AA@@AA@@@A@@BABCB@@@AA@@@AB@B@AA@@@@BA@@@@@A@A@@@@@@@AA@@A@@@@A@@@AA@@@@A@@@@A@@A@@A@@@@A@@AAAAA@@DADA@@B@B@B@@@@@D@AAACA@@@AAB@@@BAFAAC@@A@@@@@@AD@DAAA@@@@A@BADAB@FCHAJCBA@AA@A@AAA@@@@@AAA@@@@AA@@@A@DCBBFCDCDAB@@@@BB@BB@@BD@@BB@@BBB@@@@@@@@@B@@AB@B@@AB@@@@AB@@@B@@AB@B@BA@@@@@@BA@@B@@@@A@@B@@@@@@@@@B@@@@@@AB@@@B@@@@@BA@@B@@@@@BABABAB@@ADCBABC@@BCBABA@@BA@@BA@@BABA@ADAHC@@B@@@BAB@B@D@D@"],encodeOffsets:[[127339,44160]]}},{type:"Feature",id:"211282",properties:{name:"开原市",cp:[124.038268,42.546307],childNum:1},geometry:{type:"Polygon",coordinates:["@@OCOAKAOCSAUAKBMDKF@@@B@@BBBBB@@BB@BB@BBB@@DBD@B@@@@B@BA@@@A@@@ABA@@B@@AB@@@BC@ABA@A@AD@B@BAD@@A@@@AAACCA@@A@C@@@AB@BC@A@@@A@@@@A@AC@@@AA@@@@@AA@CBA@AAC@A@@@@@AB@@A@@@@@AC@@@@@B@@@BAB@@@D@BAB@BCBBDADABA@@DA@CBAB@@A@EAA@@@A@@@C@AAA@A@G@CAA@AA@@@@C@ABCB@@AB@@@BABA@@BAAA@EAE@@@CAAAAAC@CAA@C@@B@B@B@@B@B@@@A@A@@@@B@B@@@B@@AAC@@A@C@A@@@@@AB@AAA@AA@AA@A@A@C@@BA@ABA@@D@@A@A@@@AB@@BDA@@@@BF@@@@BABCDA@ABAAC@A@@@AB@@B@BD@BB@@@ABA@@@@BBB@B@@@@A@@@C@@AAB@@@B@B@BBBBB@@AD@BA@ABCAEACAC@A@A@C@A@@A@@AA@@E@C@AA@@C@@@CA@A@AAA@@A@A@A@CA@AA@AA@@@AA@A@C@A@ABA@@@@@ABA@@@A@ABA@ABA@ADA@A@@@@@@@@B@D@@ABBBAB@@@@BBB@@B@DBB@B@BB@B@D@BBFBB@@BB@@B@B@B@B@@@BBBB@B@@@B@B@BBB@H@@@B@B@@@AB@@@D@B@@BD@@@BAB@BBB@B@BADCD@D@@A@@B@@BB@@@@CB@B@@@B@@@B@@A@ABEAA@@@ABABA@AA@@AA@@@@@A@@@@AAA@@A@@A@A@A@@@@B@@@@A@C@@@AB@@@BA@CBCDA@@BBB@@ABAB@@AB@@ABA@@@@AA@@@@@A@A@C@A@A@C@@@@B@B@@@@@@@BA@ABC@@@@B@B@@@@@B@B@B@@@B@BBDB@BB@@AB@BA@@B@B@B@B@@BBBBB@@BB@BAB@@BB@@B@@D@BB@BAB@@@B@@AB@@AAA@@BA@@BA@AB@@@@A@@@@BB@@B@@@B@BAB@@AB@@A@@@C@A@AACAC@AA@AAAA@CAECECEEICUBOBW@OAKBKFEHDH@@@@BB@H@DB@B@B@D@D@@@@@B@@@BD@@@@DBB@@@@B@B@@B@@BAB@B@@@@@@@@ABA@@@@BAB@@AAA@A@@B@@@B@@AB@@ABBD@B@B@B@@@@BBB@B@B@@@@@@B@@@B@@@@@B@@AACB@@@@AB@BAB@B@B@B@@@@C@@@ABB@BBB@DB@@@@@B@B@F@@@@ABA@@@B@BB@AB@@@@@B@@@@@ABAB@B@BC@A@CBCBA@CAABA@@B@@@BFAD@B@@B@BBB@B@@B@DA@@@AB@@@@B@@BBBB@@B@@@AB@@@AA@A@@@@AA@@@@@@B@B@@ABBBBBA@@@AB@@@@@BA@@@@@B@@@BF@BB@BB@@A@@@C@@@CAA@AAABA@A@A@@@@@A@@@AAA@AB@@A@@@A@A@@@CAABA@A@@@A@@B@@BB@B@@OFKDCBACCB@@A@@@AA@@A@BB@@@B@@BBBB@@@@@@@B@@@@AB@@AB@@C@@@@BDB@@@@E@@@AA@@@AC@C@A@AB@A@@FEBA@AGEA@A@A@A@A@@@A@A@A@A@CB@BABA@@AAACA@AAACAC@A@@@CB@@ABAA@@@@AA@CBA@EB@@A@CBAAA@CA@CA@A@@@C@@AAEACBCB@@BBA@BB@BCDABBBBDBB@@AB@B@@A@A@A@C@AAC@ABA@ADCJ@B@B@DABABC@A@GCAAEAE@C@CDABCBA@@B@@@BBB@B@BAHBBAD@DADCBCBCBC@@@C@AAA@CECECACCAAEAAAACECAC@A@C@@@AACCAC@A@ABBB@@@@@D@DBB@B@B@@CDEDA@@BA@AD@BCBA@@@AAACEGCACCEACAC@AAC@ABAB@@@B@@BDDDBD@@AF@BA@ABA@A@ACCACACA@@C@A@C@A@@B@@@DDFBBDDBB@@BB@DBF@DAB@DA@@@C@C@@ACAAAA@A@@@@BAB@F@F@BAB@BAD@@BBBBB@FADABADC@@BAB@@BD@B@BB@FBBBDBDBFBBBB@@@DADA@E@ABABA@A@A@ABABCBABAB@B@BBBBB@B@B@D@B@BEBA@ADABABABCB@@EAE@@BA@@B@DBBBB@BBBBD@B@D@BCDA@AB@@C@ACAA@CCC@CAA@@CBC@C@C@ABABAD@@BDBBBDDBD@DBBBBB@DBBABCBEBIBEBABADAF@B@BBB@@DDBB@BBDAF@DAB@@A@A@EACAGCE@CBEFCD@D@BB@BBBDBD@@BB@BFDBDDDBD@B@BABCBIBCBEDC@A@C@AACCAC@DAFBFHJLDFBB@F@@@@@B@@@LBD@@@J@JBD@J@NBXD@@@@LBBBPLFB@BDB@@@@FDBDB@B@B@@@BBBB@@B@B@@@BBBB@@B@@@@@DDBB@@B@BB@@@@@D@B@BB@@B@BBDB@BBBB@BDDLLDH@@BBDFBF@@BBBFBHA@@@@B@@BBB@@BB@BBBBDBFFJHB@DFLJB@HFB@HF@@@@BBHDB@DBLHBBDBHDHBFBB@FDFBB@L@D@D@F@DBB@DBB@@BD@HBHBH@PDLBDBFBLBRBJ@PAB@JBf@P@B@B@B@FBH@NAD@NAD@D@F@F@BAJEFABABA@AFEBCDCDEBC@CBAD@BABA@@BA@@B@D@BBDD@@@@B@B@B@DABBBD@@BBD@B@B@B@B@BBB@DD@BB@@@@@@BCB@FABA@A@A@@BBDBFDDBDBDBD@BB@BDBB@BBDB@@BDBBBBBBB@@BBD@B@B@BB@@@@B@B@B@DB@@DAD@B@FBB@@@DBB@@@B@BAB@BBB@B@BBBB@@B@BB@@@@BF@@A@@B@@@BAB@@@BBBBBFB@BD@@@BB@@BB@@D@D@DB@AB@B@BA@@@@B@@@D@B@@A@@DA@@BBB@DBD@@@@B@@@@@D@@@B@@@DB@@@@BBB@@DB@@BB@BB@@@B@@@BBB@@B@@@@BD@B@@AB@@@BA@A@@@@B@@BB@@@B@B@BB@B@BBB@BB@BB@B@@AB@BB@@B@@@BA@@B@B@@B@B@@BBBB@BABA@@@@BB@BBB@B@@BD@BB@@BBB@BBB@@B@@@B@@BB@@BBDA@@BB@BBB@@BB@@@B@B@@B@B@B@@@CB@@A@@BABA@@B@@A@@BABA@@B@B@D@@B@B@DBD@D@@@DB@@@B@@A@A@@@CB@BA@@B@B@BBBB@@BD@D@@@DBB@@B@@@@B@B@BB@@@B@@B@@B@@A@@BAB@@@@B@BD@@A@@BB@B@B@BABB@@BBD@BB@@A@@@@@AB@B@BB@BBBB@@@BA@@BAB@BB@@BB@@@@B@@@B@BAB@D@@@DB@@BB@B@@@B@@@BB@@@BDAB@@B@@@BDB@@B@D@@@B@@BB@BBBA@@@A@AB@@AB@B@BBB@BBBBBDBB@@@A@AB@@ABAD@@@FC@@@A@@@@B@BBBB@@@BF@B@B@DAFCBA@@D@@@BBB@BBH@@@BA@@BABA@@B@@B@B@DADAB@@@@@B@@@BABADA@@BA@@B@BB@DD@B@B@@AB@B@@AD@B@B@D@@BB@@@B@@BBBD@BAB@@BBBD@@@BDD@@B@BBDDD@B@DBBBD@@B@@ABA@A@A@@@AB@@@@BB@@AB@@@@BBB@@B@@CB@@C@@@A@@B@@@@B@@B@B@@@D@@AFB@@B@@BB@@@BB@@@B@B@B@@@@@@@@B@B@@@B@@@BD@@B@@BA@@B@D@@@@@@A@@@@BB@BDBBB@@BB@BB@D@B@BBB@@@BB@BFA@@B@@@BADBDA@@BB@@@@@BBBNADB@@B@@@@@B@@@@@@@B@@@B@@@@@@@B@@@@@@@@@B@@@@BB@B@@@B@@@@@B@@@@@@A@@BA@@@@B@BAB@@@@@@@@A@@@@AA@A@A@@AA@@@A@@@AB@@@BAB@@@B@BA@@B@@@D@B@B@B@B@B@B@BBB@B@@@B@B@B@@AB@B@@@BBB@B@B@B@B@@AB@B@@@B@B@@AB@@@BA@@B@@@@BB@@@BB@@@@BAB@@@B@B@@@B@@@B@B@B@@@B@B@@@B@@AB@BA@@B@@@@@B@@@B@@@B@@@@@B@@@BA@@@A@@BA@@B@@@@@B@B@@@@BB@@B@@BB@@BB@BB@@@B@@@BBB@@BB@@@BA@@@@@@BA@@@@B@@@BAB@@A@@BA@@@@@A@@@A@A@@@@AA@@@A@@@@@@AA@@@@@AA@@A@@@A@@@@@@@@@A@@B@@@B@B@@@@@B@@@B@BA@@B@B@@@BA@@B@@AB@@AB@BA@@BA@@@@@AA@@@AA@@@@@AB@@A@@BA@A@@BA@@@@@A@@B@@@B@@@@A@@@A@@@@@A@@@A@A@A@@@@@A@@@@AA@@@A@@AE@@@@@A@@@A@@BA@@BA@@A@@A@@@@@@@A@@B@@A@@AA@@@AA@@@@AA@BA@@@A@@@A@@@@AA@A@@@@BA@@B@@@BA@A@@B@B@B@@@B@B@B@@@B@BA@@D@@@B@@@@@@@BA@@@@@@@A@@B@@@@AB@@@@@B@@@DEBI@CKQSKKGKGECIKGKCICK@GBOUKKEABA@AB@@AAA@C@AAA@A@AAA@AAC@A@A@CAE@CACAA@@@A@AA@ACBAB@@AAC@A@C@AB@AC@AA@@@@C@@@A@A@EAC@CBE@C@@@ABA@AB@@A@C@@BA@AB@@A@CB@BE@G@ABA@@@A@@A@AA@AAAA@@CC@@BC@@AC@IAE@C@CACAC@@@A@@A@C@CAIECAGCA@@ACCA@CAEG@@AA@ACE@@@A@@@@A@AA@AA@A@@AA@BA@@A@CCAA@@CA@@CA@@ACAAAAAA@@B@@AA@@@@@DAB@@@BBB@B@BAB@B@BAD@@@B@@@DC@A@@@@@AA@C@A@CAA@A@A@@AAAC@@@@A@@C@@A@@@AA@A@@@E@A@@ABA@ADAB@FE@@DCDCJIBC@@DADCB@DCBA@CHGBCDCDEBA@@@@@ABABA@A@ABA@ABABA@@BE@ABABAD@BAB@BAD@D@BAB@B@DAB@A@B@@A@@BA@@@AB@@A@@BA@@@A@ABGBABA@ABA@AB@@@BAB@A@@C@@A@@@A@A@@@AC@@C@@@@@@@@@@AA@AC@@@@@AA@@@B@@AA@@A@@AAA@@@AAAB@@AACB@@A@@@A@@DA@@BA@A@@@A@BB@BA@@@@@A@A@A@ABCAC@@@AAA@@@@@AAC@ABA@@@AA@@ABA@A@@BAAABA@@@A@@B@B@@@BE@A@@@ABA@A@@@AB@@A@BBABBDA@AD@@@D@@@@ABA@A@@B@@A@A@ABA@@@AB@@@B@@@BA@A@@BA@@@C@AAAAA@@@A@@B@@A@@@A@A@@@ABA@@@A@@@A@A@@BC@@@ABBBB@ABG@@@@B@@AB@@A@A@A@AC@@@@C@A@@@EA@@A@AA@@A@@BA@C@A@C@A@@@A@A@@@A@CBA@@@C@A@A@@@EA@@A@@B@@AD@@BB@A@B@@@@A@AB@@CBABAB@B@@@@@@@@AAA@CBB@@@@B@B@@A@CBA@@A@@@@@@@AAB@A@@BA@AA@@@AB@AA@A@@@@B@@A@AACA@@A@A@ABAB@@@@@BE@@BEDA@@@@BA@@BA@@@A@@@A@A@@@CAA@@@EDA@ADA@AAAAAGCCCCEAAAKAMAEAECCC@ECCAC@AA@A@A@C@C@AAAAEE@@AAEAA@A@A@A@@@AA@AA@@AA@CDA@A@@BAAA@@BC@AA@@AC@A@CCAE@CACDA@A@EAAAE@@@C@E@E@CACI@@@@EKGOACAA@CGK@AEIACAA@A@@B@@AB@CA@@@AB@BCAA@A@@@@@AAA@@@@@@@AA@@A@@B@@A@@@A@@B@@@@B@BDA@@@@AB@@@@D@@BB@@A@@ACBAB@B@DFB@D@AAAAAAA@DAAAF@@AB@@@@@@A@AAE@@BC@@@@A@@A@@@AACAC@@@@AC@AB@DAA@@A@@B@@A@@@@A@@B@@A@@A@@@@@@@A@@@@@@BABCB@@@@@B@B@@@BB@@B@@B@@B@@@BC@AA@AA@@B@@@@@@@BB@BB@@@DABA@@B@@AAA@@@A@@@@@@B@@BH@B@BBDAA@BA@A@@D@BABBDBB@BA@BD@@@@A@@B@@@@@@A@@D@B@@A@A@@BC@@DAD@BBD@@@B@FABEBCD@@@BA@@@@B@@@@A@ABCB@BAB@B@B@B@B@B@@@@@@@B@BA@@B@BAD@F@@@B@@A@AB@B@@@BIDITKVE\\EPEFC@BBB@@B@@B@@BB@@B@B@B@BB@@B@@@@BB@B@@@B@B@B@@@BABA@@B@DABAB@BA@@D@B@B@B@BAB@B@BADAB@D@B@@@@AB@@A@ABA@@BAF@BA@AB@@AF@D@@@B@@@D@B@D@DAB@@B@@BAB@@@B@@@@@AAA@@AB@@A@@@ABABA@ALA`CrIhGHCB@B@B@BA@@@@DA@@DAD@@@@A@@BAB@B@B@FABAB@BABABA@A@A@@AA@@B@@@DADA@@BAB@BAB@@@B@D@D@BA@@BABCBA@CB@@A@@@@D@B@BA@A@@D@B@B@DCB@@AB@DBBA@@B@B@@@DA@@DCBA@@@A@ABADABCDAB@DBB@@AB@D@@@BBB@B@@@B@BABADABAB@B@B@BABAD@F@D@BA@@B@@@BBB@@B@BB@BB@B@@@B@BBB@@B@BB@@@B@@DB@B@@@B@@@BBB@@@@@@@B@@@B@@AB@B@B@@@B@B@@@@@@D@BB@B@@@@BBB@@@DB@@@B@@B@@@@@CBAB@@AB@BAD@B@B@DABAB@B@BBB@@ABCBA@@BA@@@@@ABA@@@A@@@A@C@A@C@AB@@@BBB@D@@ABABA@@@@B@B@B@B@@AFA@@B@@@B@B@@@B@B@@@B@B@@@B@@@B@@@BCBA@@BCD@@BB@@B@@B@B@@@@@BBB@@AB@@@B@@@DBB@@AB@@BD@@@B@@AB@B@BA@@BBB@B@@@B@@A@A@AB@@@BAB@@@BA@@B@DC@@@AB@BAB@@@@@B@@BBBBABABAB@B@D@BBBABA@@@AAAAAAA@AB@@@B@@AB@@@BA@@@@BB@B@@@@@@@@BA@A@@@ABAD@@A@@BB@@@B@B@BAB@@@@@@B@@@B@BAB@@A@CA@@CD@@@B@BB@B@D@B@DA@@DC@CBA@@@A@ABAB@@@@BAB@D@@AB@B@BAB@@@BB@@@B@@@@@@AB@@@BB@@@@@@@BDA@BDAB@DB@@HAF@H@F@D@B@F@HBB@HA@@BBFBBBABA@@DGRC@ECAAE@@A@@@@BBD@@BB@@BDD@BB@@@DA@BB@@@DBB@@B@D@@BD@B@@@B@B@@AB@@@BA@A@AB@@@DBBCD@@@BBB@@@@CB@@@BAB@@@BA@BDABB@@BA@AB@@BB@@B@@B@@@BBB@BB@B@@B@@B@DB@@DAB@BB@BDB@B@A@@BA@@B@BADADA@AFAFCFCB@FCB@FAFABAB@B@F@@@DBD@@@BB@@@B@@BBBDB@HABB@ADB@@BA@@@@B@@@B@@A@@B@B@B@@@B@@@@AB@B@D@@@BA@@@@BB@@B@DABBD@B@DAF@@AB@@@B@BA@AB@@ABAB@BABB@@D@BA@@BAB@@A@@D@BAB@B@B@B@@@B@@@@AB@@@BA@A@@@A@@A@@@@@A@@@AA@@@A@@BA@@B@B@@ABA@@BA@A@@@@@A@@B@B@B@@@@@B@BABABAB@@@DA@@@@@A@@@ABAAA@@@@@AB@BA@@B@@@@@DCBAB@BABA@A@@@A@@BA@@@@@AA@AC@@AABA@@B@@A@@@AB@@AD@@@D@B@BAB@@@@A@@@A@@BBB@B@BDB@FBDAB@BB@@DBBAB@@@B@@@BBDBB@@BBBBBBB@BB@BB@@B@BD@@B@@BB@BBB@@@@B@B@B@B@@@@BB@@B@D@BBBBHBB@B@@@B@@BD@BB@@BB@B@@@@AB@@@BB@@@F@B@B@B@B@F@@@B@DC@@BA@@@C@AD@B@@@BA@@B@@@B@F@@@@@BBB@D@B@@@DAB@BA@A@A@@@@@ADC@ABAFA@@@@B@BBB@@@B@BA@@B@B@@@BAB@@@B@@@B@@AB@@CB@@@B@D@B@B@BAB@BA@AB@DB@AB@@@BAB@B@@@BAD@@@BC@@BA@ADA@AB@@@B@@@BA@AB@@@BA@@@A@AB@B@B@@@B@AAB@FA@@B@BBDBB@@B@@DBD@B@BBBB@@@B@B@B@BB@@BA@BB@@AB@@@D@B@B@@BBB@D@@B@B@BHDDBD@B@BBD@B@DB@@B@B@D@DCB@D@B@BABAB@BA@A@A@A@@@@DA@ADC@@DABA@@BAB@B@HAB@DABBBAB@B@BA@@BCBA@@BE@@@A@@@AB@@AFAB@BABA@@@AB@@ACC@A@@@A@@@A@A@@BAB@@ADABAB@FAB@@C@ABABABA@@@C@@@A@@@ABE@@B@D@BAD@@@BA@@B@B@BAF@BABA@@@A@@BA@@DA@@BC@A@@BA@A@@AA@@B@AA@A@@AA@A@@AA@@@A@@BA@@BCB@@A@@GCCA@@@A@@A@EA@AA@CA@AAA@@AABA@@AAD@FABAF@@@DB@@B@B@BAB@H@@ABAD@BADADC@@AA@@@E@@AA@A@A@@@AAAA@@@@BABCBA@A@A@@@A@A@ABA@A@@@AB@BA@@@AA@@ABAAA@@@A@@@A@A@A@@@MEECA@AAA@@@@A@A@@@AA@@AA@@@AACAAA@@@@@A@A@@A@A@A@A@@AAC@AAA@@@A@@@AAAAA@A@ABCBABC@@D@@@@@@A@@AAA@A@@@FC@@BAB@B@@@DB@@DBD@@@BA@AB@@@AA@AB@BC@KAAB@B@@@@ACE@@@@BAD@BA@AFABADA@@AAAAAAAAAA@@@A@A@A@@@AAA@AAABA@@ACAAA@AA@A@C@@AAA@AAA@A@CAG@AA@@A@@BC@CBCBA@@@CD@BA@ABA@A@A@A@@A@AA@CAA@AAC@@AA@A@A@@@CB@@C@AAA@A@A@C@A@A@@@A@A@@AA@CA@@AAA@@A@A@@@@CA@@@A@A@@@A@AA@@AAAA@@AAA@@A@@@ABA@A@@@A@A@A@A@AAC@A@A@A@A@CAA@@@A@A@@@CAAACAAAECCAA@A@C@A@A@IBA@@BA@@B@@G@A@AAA@CAA@C@@@A@EAA@C@C@AAA@C@A@@@CBA@@@A@@@AAA@A@ABA@AAAB@BABABA@E@E@@B@DABA@@@A@A@ABA@ABA@A@AB@@A@CB@@@@BD@B@@@B@DA@@BBBBBBD@@@BB@D@BB@@BB@@@B@@BBAB@@B@@D@@ABA@A@A@@@CBA@@@A@@AA@CBA@A@@AA@CAA@C@A@C@A@A@@@A@A@C@AAA@CBAB@@A@CBA@AB@BA@@@CBA@C@CAA@C@@@@@A@C@AB@@AAAA@@A@A@ABCBA@@@A@@@@AAA@A@@A@@A@A@@@A@@@A@@@A@@@ACADMDIFKBECIEGEIKMAG@ECUIOEIGCEESMQSKGGGCGFOFQDEBMFOLKBC@@DCFQJMPMLGFE@GAIIKEEIEGKCKM@ICYCOCYCEA@@AA@AE@@@AA@@AAAACBA@C@@BA@ABAA@@C@A@AAC@C@AB@@AB@B@@C@A@A@@@ABAB@@@@A@A@CA@@@@A@A@ABCA@BA@CBA@@BA@A@A@A@C@@ACB@A@@A@C@A@@@A@BB@@@@@BA@A@@BA@@@BB@@ABA@@@A@ABA@@@@@ABABABAB@B@@@@@B@@AB@@ABAB@@ABA@A@@@@@DB@BB@@@BA@@BBB@B@@@BB@B@B@B@@BBBB@@@B@@@@DDBB@@BBBBB@AB@B@@A@A@A@@@@B@@@BCBA@A@AAC@A@ABC@C@AB@@@BA@CBA@AAE@A@C@@@@BA@@@@B@@A@A@C@C@@@ABCB@BA@@BB@@@@@E@A@CAC@C@A@CB@B@B@@ABA@@BABA@AB@B@@C@@@A@C@A@A@A@AB@AAACA@AAAA@A@@AC@UCMBMBMJKJ@N@PEJGH"],encodeOffsets:[[127306,43215]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("营口",{type:"FeatureCollection",features:[{type:"Feature",id:"210802",properties:{name:"站前区",cp:[122.259033,40.672563],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@E@AEIAGCCCGGI@@KKGGIEKGGCIEYKCD@ZL@J@H@@QHFJDLHFDABBLK@@DBD@@BB@B@@@BCB@B_@BCE@@CB@@@D@@@B@@CD@@EQ@Q@M@@J@HHB@H@JG@Q@DFHLBBDF@B@B@FCH@BAHALAHJR@@ZD@Cp@@@F@@KR@@@@BF@@B@BABCA@F@H@JW@@^@H@@[@CFABCDGBE@@@IAGCDCEECAIC@O@AAAAAG@C@C@G@CBCD@DBDHHADCDE@G@EE@KAEACNCAC@@A@@CA@A@@BCBCBECFHBFBF@D@B@D@BHFH@D@D@DC@A@AEIAC@A@@DALALAB@BBBD@H@J@@B@BBB@D@BB@@DDB@@B@BA@@B@@B@DDB@BBF@B@BBD@BAB@D@BABAB@@A@A@@@@BABCR@JB@L@DX@V@X@BG@E@K@GBM@A@C@AAGAI@SAM@@@CAGAIAIAG"],["@@GDQBK@A@A@@@E@OA]@@@A@@@A@[@GBEDFFDDDDBJBD@LADDDFB@BDDBBB@BEBAJBD@BA@@LBB@@@@A@C@@B@B@B@D@FADAD@@B@BABABCDEFABABB@BB@@BADABA@A@@BCB@BA@@F@BB@BPIAKAA@A@A@@AA@@@@B@@@@@DAH@B@B@B@BAD@BBB@H@BBBAB@@@D@B@B@B@@@HAP@D@D@B@LBD@EICCCE@@ACAACEAC@@AA@AB@@@@A@@@@A@HCB@ACB@ACFAACEBACE@CE@CA@@@@@CA@A@@BA@CA@B@@@@A@@AAEFECIGFE@@B@BAB@DAAC@ANC@F@BNC@KQ@BFBBMDA@C@ABED@@CCC@@AB@DA@CC@CABAB@@AG@@@@ED@@CE@@DA@E@@@@@@EI@BCBC@CI@@EI@GA@C@CIB@BIABEC@SA@JH@@L@@@@@H@P@@I@@@B@@B@@@B@@BDBD@@@DBBDF@@@BBB@DBBH@L@B@NFDBNFB@PHBBB@BB@B@B@@@D@F@BADCD@B"]],encodeOffsets:[[[125345,41826]],[[125205,41686]]]}},{type:"Feature",id:"210803",properties:{name:"西市区",cp:[122.206419,40.666213],childNum:1},geometry:{type:"Polygon",coordinates:["@@@C@@ACAC@@@A@@@AA@@@J@@@@O@G@@@@@KG@@ITBD@AFJB@AJA@WbMFJBLJLDJTPBBD@HAcmKQvWMOHI\\ajvhQ\\fKHX`nS@A[cuCEaoJ@JQCABEKCBQDMBI@W@}a]@AKEIGOB@@G@GTaXDBBDAJGDO@SDYCGHSbDJ\\JAJ³zKNIPKXAJ@DBLJHHNR^DL@HAHIJL\\CXBJDJHFHDF@F@@@HCLGTQ@ANLVQDBCBXCBCJAH@B@HCFIAA@CAA@A@@CEAA"],encodeOffsets:[[125164,41651]]}},{type:"Feature",id:"210804",properties:{name:"鲅鱼圈区",cp:[122.121521,40.226661],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@EAE@GBEBCBAB@@A@CDC@CBIAC@@@CBABAB@BCBAB@BAF@B@B@B@BADA@ABEBC@A@ICC@EBAD@@AB@H@DABABQXNDTTFLCxCZD\\DP`HrPRCJMPQFAB@LBBBHFLNbZCBC@IBePIPJXZPXCROPQXB^NVGRED@HAD@B@FABEAEAEBEFC@@@CACDAB@BCBIVBBCDEBCBCB@DEFAF@LAFADCDGDEBCFAD@D@D@@@D@LBFBHFHHDBFBDABAJEDAD@FDF@D@LBFFBBBAFADALA^AT@DE@C@A@@KOEGCE@A@EHIBCAOBEDGHGBAA@M@QBGDCHAHCBEBI@E@EDEBE@GCM@GACAAAACKACCCCCCKAG@ECECE@CBAHCBA@GECCEAEBC@IEECE@EAAAIGAAIG@@ECAA@CBAFADC@CCAEEACIC@EHCF@FDJCBEBCBADAN@LCFEFEHG@CCAAAC@@AKCIN_AICHKDGAAACEEA@E@GFE@IHEBGAA@GACE@OBCMA@KHMXCBCECM@ACIACBE@IGCAI@CJCF@BCDABCBEBG@EBA@A@C@C@A@CBCBABCB@@A@C@ECA@CA@@@@"],encodeOffsets:[[125056,41131]]}},{type:"Feature",id:"210811",properties:{name:"老边区",cp:[122.380087,40.680191],childNum:1},geometry:{type:"Polygon",coordinates:["@@IAAASWOSWSYU@~@XAJCNARLDAFDBIRI@bpDFv\\d@BmTW_LG[egRiu[bGJNPuXLRdnGBC@AASOCIIKAKEIaN@X@D@DHBJ@@FJ@@DADADJ@@F@@@@F@B@@CF@@DC@@F@@H@@BA@ABDBD@@DCBA@@BD@DD@@FCBAD@B@NCAAAER@@LMD@A@EMD@BBDCBA@ABA@@@EFJHFDFEBB@@@B@@A@B@@DAB@@@BDB@@@@B@@DDFF@BDFABDEBBDA@BDA@GDB@@@@@@B@@A@@BBB@@BDDFBBBD@@DFDDFJD@HBD@DB@B@@@DBF@@@B@@DHJA@@@D@@F@@@@B@@D@BBB@@@HBB@HDBBLEB@@@DD@@D@B@@@@AB@@@@BBBB@B@B@DAAEGA@CFADC@@@@AABE@A@@@ADCA@@@@@@@A@@@@@A@@@@@A@@@@AAEFAF@@@@A@AB@BAD@B@@A@AB@AKLAD@DFBBB@BA@B@@BBDJRA@@@@CIFAFCDCDC@@@D@@B@F@@@HCBAB@DA@AA@@AAA@A@@@A@@@@A@@C@@B@B@BAHCB@@@B@DHB@B@B@BA@@B@B@B@B@@AHBD@B@D@B@DABA@@@A@C@A@C@@DADC@BBABA@AA@@A@A@A@@@AB@@C@@@@@C@C@@@A@@AA@@@A@A@AEC@A@@BC@AB@FCBA@A@@CC@@ABGDGGA@CCLCB@NC@AA@BECBAC@A@@FAFAD@BBA@@@@BH@B@B@B@B@@@@C@@DACEB@@A@@A@EA@@@G@@@A@A@@@@CA@@@ABABA@A@@@AAA@@@@A@AA@@ACA@AACBCBCEBAD@CCFAACA@@@@@@AAB@AA@AC@A@A@@@@@@@AAA@@C@AA@A@@A@A@ACH@BC@A@A@@@A@@AAA@ACHA@@@EC@CAA@B@@A@@BECALE@@GEAA@@B@N@D@B@IGEEIGBA@@BBBA@CE@EAOMQOCGEG@AYaBAH@@AB@BADAACEBAA@@@AACC@Q@ABMB"],encodeOffsets:[[125322,41492]]}},{type:"Feature",id:"210881",properties:{name:"盖州市",cp:[122.349012,40.40074],childNum:1},geometry:{type:"Polygon",coordinates:["@@DABAD@FAJCD@N@@AB@B@@CBAAGAK@@AECE@A@A@AB@BAFBD@HDB@BBB@B@@BBABA@A@E@AACEIAC@AAA@@@AAC@ABABAFCFADABCBA@E@@CAAAE@CAC@C@MBIDC@C@C@C@EA@ACAGCICAACCCCACBA@ABABCBABAB@@@@@AA@@A@@@A@@@@@A@@@@@@BA@@@@@@@A@@@@@ABAB@@A@@BA@@@@@@@@BC@CB@@AB@@@@@B@@@B@@@@A@AB@@@@A@AF@@@DEBEAMDOFOHOJIFWL]LWJ@B@B@@BB@@A@AB@@A@@AC@A@A@AAAAA@A@@@EA@AAAA@@@A@@@ABA@@B@B@BB@AB@@AB@@@@AA@@A@CAA@A@AAEA@@A@@@@@@BC@A@A@@@A@@@EAC@A@A@@@A@AA@@AA@@A@A@@BA@@@C@@BA@A@A@A@G@@@A@@@CBA@KLOPKBGBO@SEYESIQMAA@@C@CBAB@BCDAD@@ABA@AB@B@@A@@B@@ABABA@@@@@@B@@@BAB@DB@A@@BAAABA@ABA@ABAB@@CBA@@B@@@B@B@@@BDF@@@BA@@BB@AB@BB@@BA@BB@@BB@B@BA@@@AB@BA@ABCBCBAB@@@@@B@@C@A@@@@BA@@@AB@B@B@@@D@@@@@@@B@@ABABCB@@CB@@@@A@@@@AAA@AAAAACA@AA@@A@@A@C@AAA@@A@C@AAAEAAA@@AA@@AAA@@A@AC@@AA@CAC@C@@@CAA@CAA@A@@AA@A@AB@@C@AAA@ABA@@@@@@@@BBB@@ABA@@@@BAB@B@@@@@B@BA@@@CDA@ABAB@@A@@@AAC@@@@@CB@@CBCBAB@@@@BB@BAB@@A@@BAB@B@@AB@BAB@B@@@BA@@@ABBB@@A@BB@@A@AB@@AB@@A@CBAB@@@@ABC@@@ABA@CBA@A@A@AB@B@@A@C@CB@@@AAA@@@@A@A@@A@@AA@@A@C@GA@@@AA@A@@@@@AD@@A@C@@@@B@BA@@@@@A@C@A@@B@BAB@BA@@@A@A@A@BDAB@@ABC@A@A@ABA@@@A@A@G@CAE@A@A@EBA@A@@@AAA@C@C@@@AA@A@@E@AAAA@BADADAB@@C@@B@@@B@@@B@@AB@@@B@@@BAD@@@B@@ADA@@@A@A@A@@@@B@@A@A@@@A@@@A@@@@@A@@@CF@D@B@@@D@BAB@BCBGCCACACBA@GDABA@CBA@AAA@A@@@@A@@BA@@@AAAAAA@EAA@@@[EKAKG@@@@CAEACAK@KBE@IFGHCFAD@@AJAJ@F@BNH@B@B@@@@BB@@@@@FAHCFEJCH@@CHEHGLIJGFIDGDFJDBDBFAD@^SFAF@DBFBJFHLBDTZ@DBB@B@DERAFAFCFA@A@G@SAE@EBCBkhQX]HJÔDPCRWBABA@C@GBA@@BCFAD@JDB@D@FABAB@BC@A@A@A@ABE@ABADA@ABABADA@@D@JBDAD@DCB@@@BADAFAHAF@FBB@@@@@DBB@FDD@B@@@DABADADAB@D@D@B@B@FAH@FADABADC@ADEDIJ@DBJHF@DAJBBDN@FDADWDGN@LNBAD@PDFHBB@HBFAJGF@HEF@B@FFBDBBCHGLJD`BJMLD@BD@BBDB@DGHEFEFKDM@CBABADAFIDECE@GD@FJDBDFFDB@DCDEBAB@DBBFD@@JHBBJHBBFBF@FDJFD@FAFBDDHFB@DABGDAF@FDFDH@LBDDDDDDLBBDBBDBHBN@HDF@FAFCF@J@FADABGDGHCRAN@B@ABGHCHAFBPADGJ@F@BDFFHLP@@@B@DCFS@]BKBCBEBABAAEEKAC@E@ECC@CBIFABCBEACAGGGEEAKAC@@@C@C@C@EBADCFCHCDEBKBE@EBCFA@ADADCFADUAAJADA@CBBD@D@@EDAFBFBFAFEBA@C@GBC@QFUH@FIJIFAF@FHLJB`MD@J@BF@HAHKTIVATBFFFFDJBLCPAPGJAH@^ZNRANQRBHZRJFLFH@PI@@@@bWHSH@@@PAJHLF@Bb^ZVXTPTTXBBJBNABAR@D@BD@B@@BBFABDCBABA@@BG@ABZb@BFHDHRPPNFB@A@@DC@@ACHCLEAE@@@G@@@EHC@@@@AABA@@AA@A@@B@@@@@ACB@ACIB@@ACAC@@ACEI@AEBU@@CACMBCI@ACEXAD@HA@@JF@CBGD@FA@@B@D@B@@AB@@A@@DBB@@BD@B@@@BA@@DADA@@ACA@AA@A@@BA@@@@DBB@@AB@DAB@FAF@@@BBD@B@D@D@@@B@@@@@AA@A@C@A@@BADAAA@@B@BA@@A@A@@@@A@@DAB@B@@@B@BA@@D@@ABABC@@@A@A@@D@DABADA@@JBHBNBHDTFHDDDLHLDF@HANAD@\\CRAF@L@VATEPIDIBAFGBGDKBCDIHIJAL@JBJ@RFB@BB@BB@@@BB@@FB@@@BB@@BBBBBB@B@B@B@@@B@@ABC@@BABAB@DABAB@@@BC@AB@B@@@@B@@B@BADA@@@AAC@@BA@@B@BAF@DBB@DC@@B@B@@@F@@@BAF@HCD@BABA@ABAB@@ABABA@@DBB@@BB@B@@BB@@BBBBB@B@@BB@BB@@@BB@@BADADADA@@BBBBB@BBDBB@@BB@DB@@@@BDBB@@B@BBDA@@DBBA@@B@@ABAB@BA@@AAAA@@ACC@@@AC@@CAAA@CA@@@CA@@AC@AA@AAAA@ACA@@@AAA@@@A@A@@@A@@@@@C@A@@AAB@AA@A@ABABA@@BE@@D@B@@BB@B@DA@@BABA@A@AD@@CFC@AD@@ABAB@BABA@@HBB@BA@ABABABAD@B@BAB@BCBEB@@AA@@A@A@CBA@@AC@@@A@@@@@ABAB@BAB@BA@@@@B@@ABA@AF@@@B@B@B@D@D@FAB@BADA@A@A@@@@D@DAB@BCB@DCBBFA@@@ABA@CDEB@BADCB@@A@@@C@AACAAAA@AACAA@AAA@@@A@AAAAA@A@A@@@@BA@@A@@A@@BAB@@AFC@@FBBA@@DDDABBB@@@D@BBD@@@BBD@BBB@@BB@BAFCDA@@D@B@BBB@BAB@B@B@@@@ADAD@@BH@BA@@B@B@BAAA@@BAB@@A@ABAB@@AD@BA@@@AB@BA@@DAB@B@B@BAB@FABBB@B@@@DAB@BA@A@@@A@ACAAAAACAAA@@@@AA@@ACA@@@AA@AA@@A@@@A@@@AB@@@@A@A@A@ABC@@AA@A@@A@@AB@@A@A@@@C@@BA@@AAAAA@@ABC@@@@AA@@AAAA@@@A@C@@@AAA@A@@@A@A@AAA@@@@CAAABAAADA@@@ABA@@AC@@AABC@CDAB@@@@A@A@@@A@@BA@@BABA@@B@@ABA@@AA@AA@@@BA@ABAB@@A@@AA@ABABCBA@@@@@@@A@@@@@AB@BAB@B@B@B@@AB@@@AA@@@EB@@A@@@A@A@A@@B@@@@AB@@A@A@AB@@AB@@ABC@@@AA@@@@A@@B@@@@CA@AC@@@A@A@@@A@ABA@@@AA@AA@AAAAA@@AAAA@A@@A@CC@@@@AAA@A@AAC@@AA@BAAA@@BA@@AA@A@A@@@@@AAAAA@@@A@@AB@ACAA@A@@@EAAAA@@A@@@A@@BA@@@@CA@A@@AACA@@B@@A@@BA@@AAAA@@@A@@AACA@A@CAAAA@@AA@@@A@A@@@A@@EECCA@A@@@CB@BAA@@@@@A@@@@@AAC@@@ABC@@@A@A@A@@@A@ABA@@DAFAB@@@@A@ABABA@E@@@@C@@@AA@@AA@@@A@@AA@A@A@AA@@C@@A@@A@@A@CA@@@@AAA@@BA@@@AAA@AAAA@@A@@@CAA@C@A@@@ABA@@BCBA@A@CAC@@ACAAC@@CCA@@AACAAA@@A@A@@AA@@@AB@@A@AAA@@@AAACBCAA@A@A@AA@@A@@BA@@@C@A@A@@@ABA@@D@@ABC@CBA@ABC@@@A@ABA@A@AAA@AB@@EDA@@@CA@@A@AAC@C@@AABA@C@@@A@AAAAA@@AAA@@AA@@C@AAC@@@@@AAC@AAAAAA@@CCC@CAAA@@ABAACBICA@A@@A@AAAAACC@A@CA@AAA@@@@AEAA@@ACA@@@A@@@@A@CACA@AAAAA@ABA@@@C@@@AAAA@AA@AC@A@C@IB@@ABA@A@A@CBA@A@C@C@A@@A@@A@AAC@A@@@A@@@CAA@C@@@@AA@@@A@@@AAABC@A@A@AAAA@@A@AAA@ABA@@@EA@@A@AC@@ABC@@BC@C@@BAB@@@@A@A@@@@A@@AAC@GA@@C@E@A@A@C@@@AAA@@@A@@BAAA@G@CBA@@@@BA@@@C@A@@@AAACCA@@CCAAA@@CEC@AC@@@A@A@ABC@A@E@A@CBCBC@A@A@@@AAA@@BA@AAA@C@@B@@A@A@AB@@ACAA@@A@@A@@A@@AA@AAA@A@@@C@@@@@@B@@@@C@@BA@@B@@A@AB@@A@@@A@@@AB@B@@BB@BA@ABA@C@@@AAA@A@AAA@A@ED@BA@@BA@AB@@A@@@C@A@A@A@@@AA@@@@@AAA@CAACGCAAEAA@@@A@ADIFG@EBABADCFADAB@DADAFABC@CA@@ACECCA@AAAAA@A@A@ABAB@BA@ABC@GAC@A@AAA@CAAA@@@A@CAAECAC@A@C@A@A@AB@@A"],encodeOffsets:[[125461,40959]]}},{type:"Feature",id:"210882",properties:{name:"大石桥市",cp:[122.509006,40.644482],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AAA@C@@@CBEDABA@@AA@AAC@AA@@C@AAC@@@A@AACBCC@@ABEA@@ED@BA@AB@@@BB@@@AB@@@@@B@BBBBB@B@B@@BB@BBBBD@BBBBBBD@B@D@@@BA@CDABA@CF@DAB@B@@EBAACDA@ADA@CBC@@@@@@B@BCBABA@EBC@C@A@A@A@@@E@@BAB@BA@@@@@ABA@ABA@AB@B@@@@@B@@BD@@AB@D@B@BB@@BA@AFADA@ABA@C@ABABAB@BABA@GA@@ABABA@AB@BC@@BED@DC@@B@BABAB@@CBA@A@@AA@C@@@AF@@ABAB@B@BBBA@BB@@@B@D@@@@@B@@@B@B@@BB@B@@DB@BBBBBB@@BBD@@DB@@B@@DBBDB@@BD@@D@BD@@BBBB@@ABA@AB@BA@@@ABCA@@CBAAA@@@AAAC@@@@CAA@@AA@CAAAA@AAAA@@CBCBCBAB@@AA@@A@@AAA@@@AAAAA@AA@@AA@A@@AA@CA@@ABAB@BA@AB@BABABC@GDE@AB@@E@@@A@A@@@CDA@CAE@ABA@@@AB@@BD@B@@CBABA@@@@A@@A@A@@BAD@@A@ABCBA@ABAB@@AD@BA@@@A@A@A@A@AAAA@AA@@A@@EA@@AA@@A@@AAAA@QEI@IAK@IBGJCJADCLAHEHABCJOJSFUBK@E@QB[DC@MBGBE@KCKGCCGCSEGCMAGAIA@@CBABCBC@@@@B@B@@ADAB@BC@@@ABA@@@A@A@CB@@@B@@B@B@@@ABA@@@BBCBAB@@@B@D@BBB@@@@A@@@C@C@A@C@AA@@E@EBA@CBA@@BA@CA@@@@AB@@@BBBB@BD@@CBCB@@AB@@A@C@@AA@CA@@@BA@@BA@C@A@@@EBC@AH@DIE@@GBC@WBDF@BDJNABD@DV@FA@BFJBD@@BDBD@@JABDA@BD@@@@A@@@@BBB@@ABBB@@@@GD@F@@@H@@BFKFGDBD@@CD@@@BF@@DABAA@@ABJHFFJHA@C@M@A@@@BBHF@@KFDBAF@@@BA@B@DBD@@F@@GBBDB@BB@@@B@@@B@BADG@BDB@B@@@@BBBD@@@BB@B@@@@@@@B@BBDB@@BBA@B@@@@B@BDEBDDC@ABDFDADABBB@BD@@BBB@@@@@BB@B@@@BABAB@B@@DB@@@@@B@B@@@H@@FBB@@@@BA@DFCB@@@D@@A@A@A@A@G@@A@@B@AAC@EBEB@@@BBDDAAFB@@BMDA@KDDDB@HHHCBA@@DD@@@BABEDA@@BAD@@@BFD@B@B@B@@BB@@@B@@@D@D@@@@@DA@@B@@@B@B@BB@@BABAB@ACDCB@@@D@B@D@B@@ABCBA@C@A@C@GA@BA@A@A@A@@@ABA@A@A@CGA@@@A@GDABA@A@@@@DB@@@@@@B@@@BBB@BB@@BCBA@ABGD@@E@A@@@@C@@CDCDEDEBDJ@@@@QBCIAA@@@AABA@AACEC@KBBLA@@B@BA@C@ABA@@B@B@@E@EBBF@B@@B@@@@@B@@@@@B@@@@@@@B@CD@B@@@BAFBB@@@@CDEB@DHBBFCBA@A@A@AA@A@@A@@B@@A@C@@@CC@@A@KFAAGCA@GA@@A@AAC@@@@A@@E@@@@C@@IBCG@@@A@@AE@C@@@ACAC@GAC@C@KAA@C@C@O@GB@@A@A@A@C@@@A@ABAAG@A@AAC@ABA@A@A@G@CB@@@@A@@@@@BB@@@B@BBBBLOJ@AAAE@@@ABA@AD@@@BABCBAB@@AAA@BABAFEDCBABA@A@AC@CBEBC@A@A@A@@@@D@B@@A@KA@@ABC@IAABAFA@AACC@AEACC@BAB@BCB@BABEBGBC@A@AAABC@ADMDADABCBGDIDUJ[PWR@@MHMFEBSHMHABABEDAD@BBDHHDBLFjTBBJFHJDDBDFFFHBBFDDBF@HATIFANAFADBDBBB@B@BAHGHEFADELAHBD@NDJBDBBDBB@B@D@FCNMLMPKHCHADAB@DBBBDFDLBBFBJBN@FBPHH@HBFAF@BAHGDCD@BBDBHJFDDDBBPLPRFDDADA@AB@B@@DB@@@BDMDBDBF@LFFH@F@DCBCGGAC@CDCDAH@D@D@H@BBBB@B@PJDDBFFCDHDJB@@F@HADCBADE\\@@@@G@]X@@I@G@EDBBA@A@AE@@A@@Q@@LE@@@o@@DYC@@IQBGBKBG@ADG@E@A@ACEAAGKCER@H@@I@GGA@G@IN@R@R@@FC@@DA@@@C@@@A@@DF@AD`@@ADA@A@@@AAA@@AC@CL@AKBAECKGICGE@RG@I@K@@YDCZLJFHDLHJFHHLL@@HJDHDDBHFJ@B@FBHBJBJBH@D@@BN@TBJBH@B@D@BAN@H@L@FAHW@U@W@@B@B@@B@B@@@@@@B@@@@@B@@BB@BBBB@BBB@DAJ@@@@A@@BADB@A@@@@B@HBF@D@DDBBDBB@B@@BB@@CD@BA@@B@BA@@B@@@BB@@@@BB@BB@BB@B@@B@FDF@B@H@BHB@B@@@@@B@@BB@BB@F@@@@B@@@FAB@@@B@DBF@F@@AB@B@B@B@FCLAF@NAB@@CB@P@DB@BFBPAHAD@BC@ADCEWHAEIEBEKGG@@CEAC@A@E@A@GBKB@BAPE@E@@@GIC@@AAAAAA@@@@CAACAAEEB@@AEIACAA@AD@BA@@D@D@HCF@B@@@B@B@DA@@DB@@@@B@B@BBB@PA@@@@@ECG@A@AB@LAAEB@@AF@AC@A@@@C@@@AAC@@@@BA@@@AD@DAD@BBB@HADAA@@A@@BA@@@A@@BABBHAACAACI@C@A@@BCBAAAAE@@@@AAHCB@AAAA@@A@CCAA@@B@B@@A@@@@CCABCEAAEEEGAAACIKAC@ACAAC@@@@D@B@@@B@F@@@H@F@DAAA@AH@XAVAHANCRKJGF@H@LDNBRAhATALAB@DEBGB@@A@KBG@O@MB@@@A@@A@A@@@@@A@C@@AA@AAAAAAAA@@@@@@AAA@AA@A@@@AAA@AAAA@AA@@ACAC@A@A@@A@@AA@@A@AA@@A@CC@@@AAA@@AAA@@@A@@A@@BA@@@ADA@@@AA@AA@@AA@@AABA@A@@DAAA@@B@@AAABCAC@@@AB@@A@@BADAB@B@B@B@@@BAB@B@@ADA@A@@AA@A@@@@@@@@B@BBBCBCBBBBB@FFBB@@B@@@@@@B@B@@@@DBB@B@BBB@BA@@BB@@@@@@@@D@DBB@B@B@@B@ABB@B@@@@B@B@B@B@AABA@@B@BBB@BB@@B@@A@@@A@A@A@A@AAAAC@@AAC@A@@AA@@@AAA@@@CAA@AAAA@C@A@CAAA@A@AC@A@@D@@@@ABA@A@@@A@@A@@A@@@A@@AA@@AA@AAA@@@@A@C@CBAA@@AAA@@@@ABC@@BA@@D@DAB@@A@ABAB@BA@@A@@A@ABA@@AAAA@@@A@@@@@AA@@A@@@A@@@AAA@@@AGAAA@AA@@@BAA@ACA@A@A@CAGAA@A@C@A@A@A@@@E@@A@@B@B@DA@@FCBA@A@@B@BA@@B@@@BA@AB@B@@AB@@@@@BAB@@@@@@@B@@@B@@AB@@A@@B@D@@AAAH@@A@C@E@@@CB@ACB@@@B@D@FA@@B@B@@BBBBB@BBBA@@@@@@@AB@@A@@B@@@B@@BB@BB@BBBBDABB@@@@D@FAB@@@@@BAD@HA@@@@BB@@B@@@B@B@B@BB@@B@DB@@@BB@@BB@@B@@BBB@@BB@D@D@B@H@DATMPKJEJA@BPBJBRELENALAXBRBLALGHGBCDQBA@AB@D@B@B@@@B@BABA@A@@@A@@@AD@@ABABABABA@E@C@ABABA@A@AB@B@BA@@@AB@DB@@FA@@B@B@B@BAB@B@B@@@D@BAB@B@B@@@@@B@BADA@@D@B@D@@A@@FADC@@BA@@B@BBBBB@@@B@D@D@B@BA@@@A@@B@@@D@BBB@B@@BBB@@BBB@D@B@B@B@@B@BB@B@BB@@@BB@D@B@@BDB@@B@@B@@D@D@B@@A@@BAD@B@B@@@@@B@B@@@B@B@B@@@B@B@BB@@B@BA@@BAD@@AB@@@B@B@@@DABA@@B@BA@BBB@@PIPMLKJATGJCJGDIDIDEHKPGHGHCMIKQ@I@@DIFG@IBSEOGEKGQE[AK@K@ICCCCEIYBMBG@ABAAIBKEMCM@@@C@@B@@A@A@@DC@ABA@@@@@AA@@A@@@ABA@@@AAAAC@@A@A@A@@AA@A@AA@AAC@A@@A@E@@@@C@A@@@@AAA@GE@@C@AA@@A@A@CCA@A@AA@@AA@@AAC@A@AAAAAAA@A@CCAAC@A@CB@@A@A@AAEBA@ABA@A@A@CB@@ABA@@B@@ABC@@BA@AB@B@BA@AB@@BBABA@A@@@ABG@@AC@CB@B@@A@A@A@AB"],encodeOffsets:[[125801,41304]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("朝阳",{type:"FeatureCollection",features:[{type:"Feature",id:"211302",properties:{name:"双塔区",cp:[120.453744,41.565627],childNum:1},geometry:{type:"Polygon",coordinates:["@@HF@@@@@@BBDDDBBB@@@@LJ@@@@CB@@@@FH@@@BA@BB@BB@ABA@BD@@@B@@A@ABA@@@CBAB@@AB@@CF@@C@@@A@A@@B@@B@@@AB@@A@@@ABBB@@ADA@A@A@ABA@CBC@@BA@C@@@AA@@C@@@@BA@C@A@A@A@A@@@AB@@@@@B@@@BA@@@@@@DBB@@DB@@@B@@@BBBB@@D@@AB@@@@@BB@@B@@@B@D@@A@@@BBBBA@B@BB@@BAB@B@@@B@@A@@BA@@DDDB@@BB@@B@B@BBBBD@B@B@@BB@@@B@B@@@@@B@DA@@BA@@BA@@D@BBB@B@B@BBB@D@D@@BBBBBB@B@@B@@@@A@ADCBABABA@A@A@ABA@@BAB@B@@@BC@A@@BAD@@@B@DA@@BA@A@@D@B@@@@B@@BB@A@ABCB@@@@ABA@A@CA@@@B@@ADABCA@@AB@@AB@@A@CBA@@B@@@B@BBB@B@@@@@B@B@@@B@@B@@BABBB@@B@@BDABB@@DBB@@BB@DBB@BGJIRGRDJJH@TIRBH@^BTFVFJFXNBDHLAPEFEFCDU\\CXFTJJJ@JMLAFKHETCBMFCJJDFH@DOFIHCFEHAJEBAHKDK@UAEIQAK@CBEFEBCRKFQGMMIUOGKAC@AAQAc@K@EAMDGHEZKLI@ECEECSMECAC@ABANERILGLIBE@CAEOQAA@@@@GGCCEKFGHAL@FBJ@LADA@K@EQEGAAAAEAMAIQUIICE@GHK@CAEECIEIGGECECG@A@MIIWIGGQOGGGBGLOTUBW@IBY@UB@@GFCDBFFTCLIHGFGPK^EHILGHQRGJDHK@@N@B@bYISA@BGHFNLADBDFOP@@EEA@ABCB@@CCCBABABA@ABB@@B@@BBB@@BB@BB@BB@JHDDDDFDFHHF@DG@@@ABE@AB@@@BA@@@@@@@BFDH@@@BBD@B@BBB@@@@@DB@@B@@@@DD@@@@DABBAB@@@@@B@@@@@@@@DB@@@@@@BB@@@@@@DB@BHF@@@@DB@@@@@@"],encodeOffsets:[[123340,42601]]}},{type:"Feature",id:"211303",properties:{name:"龙城区",cp:[120.413376,41.576749],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCIG]SAACCCCGCE@AAK\\CJCHARAHBb@B@D@@@@BD@@D@@@D@DABADADADABA@AF@D@AJN@LBHDDFBD@DADMHCD@FDDDBH@NCJAFAHBJBPDF@D@FGJIDCDAD@FDFHFLFP@HBH@HAHELCFABEAGCIEEAEDGDADCFAF@J@R@FADGHCHGTAF@F@DFJBFAFADEJEJAHANCHEL@FBHFFFDHDJBFAFCBGBIBCDCDCDATKNGHAF@FDDJBDFBH@HBJDLFLLLFLDRDXFHFFFFLFDBBDBZLJFBDHJVdFLFNBNBLFVBF@FAFIRAF@HBFFFFBD@F@HEHGLMPGNCPCR@NBFDFFDF@FADGHMJCD@D@DHBFBR@JBJDLHJJJLLPBDHABCFEHEHGXQB@HEB@DAHBFBDAHC@@JILOFKA@CAA@@AA@CA@@AACB@AA@@@AABA@AA@@@@A@@@A@A@@@@@AAA@A@A@@@AB@DAB@@@BA@@BA@@DBBABC@@@A@@DBB@B@BA@@@@DABAB@A@@AA@@@@@@A@CB@B@@AB@@C@A@@BC@AB@D@@A@@@ABA@AB@BAB@B@B@BABADABCB@@@@@@AA@A@AAAA@AC@C@A@AAA@A@A@AAC@@@AB@@AB@@CBA@@@@@A@A@@@A@@AA@A@C@AAAAA@A@@@AA@@CACC@@AB@@@BA@@@A@A@AB@@AAA@B@AAAA@@B@@@@C@A@@@AA@@A@@@@BA@@@CA@AA@A@@@A@@CA@@AA@C@@@@B@@A@@@A@@@@BA@@B@B@B@B@D@B@@A@@D@@@BB@@D@B@@AD@DAB@BAB@B@B@BC@@AABA@@B@@@BA@@A@@@@AB@B@@@D@@@DE@@BA@@BADA@@B@BAB@@@@A@@ACB@BAA@@AAAB@@A@@EG@@@@DA@@@@KI@@@@AACACCAA@@@@@@GE@@@@@@CA@@@@GE@ACA@@@@@@AA@@@@@@CA@@@@@@@@@A@@@@BAAACB@@@@CC@@@@@AA@@C@@@@AA@A@AAC@A@@CGAE@@@@@@B@@A@@BAF@BA@@H@@CGEEGECCCCCIGA@@AAAA@@AA@AA@@@AA@@AGHAA@BA@ADEAAD@@AFCAA@AACAAE@@@CAEGKACIMCG@AKICASEDIHODELC@@[CC@CCFCRAMGCACAA@@AAA@A@@AAAAAACCAA@ADC@C@AAAECC@EACA@CAAICICGCE@CAABE@EAC@ECOIEAGAECAA@A@E@G@ABA@AFAJCB@DCDCDA@A@C@E@A@A@C@EAE@A@@@@@@AACEAAC@A@CAA@C@CB@@EFABA@A@C@ABCAA@A@AACAA@A@CBCDEFCFADCDEDEDEDABC@ABC@GAA@AA@@AAA@A@C@A@ABABC@GBC@A@C@GBEBA@CAA@ABC@C@CBABABABA@@@ABA@C@@@@@C@@AA@A@A@AAA@C@A@A@A@AAA@AAA@ECEACCAACE@C@C@ABI@A@ACA@@AAA@A@C@A@CAA@A@CBEBC@QDC@EBA@GBGBKBCBCAE@AAA@CBC@A@CA@CAE@C"],encodeOffsets:[[122997,42403]]}},{type:"Feature",id:"211321",properties:{name:"朝阳县",cp:[120.389754,41.497825],childNum:1},geometry:{type:"Polygon",coordinates:["@@MEEEGEG@CD@@@@CBABA@ABAA@@A@A@ABC@@@A@@BE@@@A@@@AC@@AACCCAAA@@BA@@A@AAAA@@@C@@@A@@ACAAA@@@@A@@@@AAAA@@@A@C@A@A@@@A@@AA@@AA@A@@A@AAAA@A@A@@CA@AC@AAA@A@@@A@A@@@A@C@A@ABA@@@CAA@A@IDC@ABA@@B@@CB@@AB@B@BA@@@@B@@@B@@@@AB@BAB@BBB@@@BAB@@@B@DBB@@@BD@B@BD@BB@DBB@DB@@ADABA@@@@B@B@B@@@BB@F@B@BB@@B@BAB@D@B@D@BBB@B@@BB@@D@@@BB@B@BDBB@@@B@D@@ABAB@@@BD@@BD@FBBB@@BBB@B@BB@@@@A@@B@@@B@@AB@@BB@BBBBBCD@B@BAB@BB@@B@B@BB@@@@DA@ABAB@BCD@B@B@BAD@@A@GBCAA@A@A@A@@BAFCJCLCLELI@EAEEIIIIGGGEG@EHAFCJ@BCJCJCJENGNEJGJAF@@CB@@AAAB@@C@A@AAAB@@A@AB@B@@@@@@BB@@AB@@BB@@DBA@AB@@@BB@@B@@B@DB@BBBBBD@@@@B@@B@@@BB@@A@@B@@@@A@AB@@B@@B@@@@AD@D@@A@@@A@@@@@@A@@A@@@AD@B@D@@@@AAAB@A@@@@A@@B@@A@@@@@AA@@@@@@@B@@A@@@A@@@@@@@@B@@B@@@DAD@D@@@ADA@AAA@@@AB@@A@A@CAA@@BA@A@A@@AA@@@A@ABA@CBEBC@CBA@ABA@@BABAB@B@@A@AB@BAB@@AB@@@ACBAAA@A@@@A@@@@@AB@@C@C@C@C@CB@@@B@@@BDB@@@B@BA@@@@@AB@@@@ABCB@BA@@D@B@BABIFCFEJEN@PBFBJFP@HCFAFADGJELEFIJKFQJUDE@EDEHCH@H@L@FAFEJEJEJGHEDAHCFGJCFEH@DEH@FKLMJEJCDJHLJHJqH]DMHCBA@EDIJWVAB@BEBMJ@DBFBDFLANBFBFFLDFHLFP@N@LAJ@PAL@N@F@F@H@HAJCNCJAHAJAHAL@JFJFHLLFHBD@FCLCHGJAJAJ@FAFJHFDFHBD@HBFALAJ@@B@BB@@@@B@B@BB@@D@B@@@@B@@@@@BAB@@@BB@@B@DAB@BA@@@@BBBB@@@@@B@B@BBB@B@B@B@B@B@@B@@BB@@B@@@@@B@@@DDBAB@B@@@@@B@DABB@@B@BAB@@@@AB@BA@@@@BB@@BBB@BBF@BAB@@@DA@BB@B@B@@@BBB@@BB@@@@B@BB@@BA@@@BB@BB@BB@@B@B@DBBB@@BBB@B@B@DBB@BBB@DBB@B@@@@B@@BB@DB@@BB@@@@@B@@@B@@A@@D@B@@@B@@A@@DB@@D@@@B@@BD@@@B@B@BA@@B@B@BBDDB@B@B@B@B@@A@@B@@@D@BADAB@@@B@D@BA@@PFNHB@FBNHLHLB@DEDBJAHGLKLGLMNMJMJEJGFGJCDCDCHAJ@FBJDHDHHFDFlhBC@@@@HIHC@A@@@A@@AC@@@A@@@@B@D@B@B@@@@@@@B@D@B@FCHAHCPCRGN@J@H@RALF@BB@@BDB@B@@@BFF@@D@BB@@@B@BFFB@BBB@DBB@BBBBD@BBBBBBDBBBD@@@@B@BBBFDDBBB@@CFIJERCJCNAJDLDJB@NNRPJJLLHJJLBBNHBBLLJL@R@NHJBB\\D^FJFFJHJZPPP@@BDDBBADBD@B@F@DAF@D@L@D@D@DAFAJA@@B@@@B@@BDFBB@@@@FBF@B@DD@@@@@BA@A@A@ABABCB@DABADA@GDC@CBA@C@A@AAC@AAABEBEBGBABCBA@@B@@B@BBB@@@@BD@B@B@BBD@@B@@@ABA@@B@B@D@BB@BB@@@B@DBB@@@B@@@B@D@BADB@@BA@@BAB@@@@CBAB@@A@@DA@@@A@AB@BA@A@A@@DE@ABA@A@@BAB@D@@@B@D@B@@BD@B@BB@@@@BBB@B@@AB@B@@AB@@@BBB@D@@@@A@@B@B@DB@@@@BAB@B@@@@AA@@A@A@@BA@@B@@@B@BA@@@@@@B@BA@A@C@@@@D@B@B@B@@@BA@@D@B@@AB@@@B@BAB@D@B@@@@@DAFBDA@C@@DC@@AA@@AA@@CE@@B@BABA@A@A@@AAAAA@@@ABA@@ACA@AACA@@AC@@A@@@AAA@ABADE@@@@BABAA@@A@@@A@AB@@@@AB@@@B@B@@@@AB@@@@A@A@@@AB@@A@@@ABA@A@AA@@@@@@ABA@@@A@@@A@@DAB@BAB@B@BA@AB@DA@@@A@A@@@AA@@A@@@@B@B@@@B@@A@@@A@@@A@A@AAC@C@AAA@@BAACAA@@BADC@@@@@@@@BB@@@@@BD@@@@A@@B@@A@@@@A@@@BA@@A@@@@@FABAB@@@BA@@@@B@BA@@@@@@A@@@@@@@BA@A@ABA@@@A@@@AB@B@@@@@B@@@BAB@@@@@B@B@@@BA@A@@BA@@@A@A@A@@@@AA@A@@AAAA@@@@BAB@BAD@@ADBBAB@@A@@@@B@B@@@B@@A@@@@B@@AB@B@@A@@@A@@A@@A@AA@@A@@@@@AAAA@DA@@@A@@BABAB@@@@AA@BA@@AAA@@AAAAEAIGBACKOIKIIKGICIAQ@EAGA@C@CDCNIHGBC@ECEEEECMAQ@ODMDOHKNGHGFE@C@EAEEAE@GBEJQBE@EAEEUAKAMEMEKUcGIACIEYKCAAAECEKEEGEWEQCKCKEKKKEICGAG@EAACCIECE@GBMHSLCBCDCDADAJAHEDEBIAGCECEEAG@EFKDGBMBGFIFIBCBEAEEI@C@EBEHSDGHGBC@E@Q@IBEDEBCHCFCFBJFHDFBBADEFKBG@GAG@GEOEKEGECC@CBCDIJEHC@E@OCIAGAEBIBMDG@CACC@EDCNGBC@CACCEGCKAM@BIC@E@@BABCBCBCBABCBC@@@C@@@AC@@@@@C@AAaBGBQDGDIL[BBF@HDDDDDBB^TJHDD@DBF@DDBB@D@DAB@BBF@DBDALAHAHAB@FAD@RCD@FADAB@B@DBB@D@B@B@BB@@DB@B@BAJ@B@D@DDFBBDDFBFDB@BBB@BBB@B@B@D@B@BBB@B@B@@BD@@@@@D@B@BA@@B@BABABADAD@D@BAB@DBB@FAHAD@B@D@HAD@BABAB@D@B@B@BB@@BBB@HBD@BAD@BAFCFCFCDCBCDEFEDCDAB@B@DBBBB@B@DBBAD@B@B@BAFE@@DAD@B@DBB@D@BBDFBB@@@@@@@BBF@F@D@B@B@F@D@BCBCDCDA@IDEB@BAB@B@H@F@BBBFDHBFBPJFDD@FBF@BADBF@HDJDJDBB@DDBFBD@FDBB@B@DCD@BBBDDBBBBBB@@@BBB@BB@DBDBNHQBEDDDD@\\D@@KDCFGPCJTFDBLJ@BDHJNBDHLBF@D@@BFDBBBB@DBBE@@BCFBBCB@@ABBHG@BBAB@BABADADD@@DABAB@FF@@POCECAKBEMHG@ATBZJ@a@A@ML@CGHIRQHGJKFGL]HOHEJGDKESAEDCHE@@VAZ@JAX@VAPSHKHAHHRPHHXJJJ@N@BDHDFHFJHJFFDBF@DGLVAH@FAPALGPGPCTAX@NAHEHGHGBGBEBKHMPIFCD@F@D@@B@@@BBB@B@@DB@BDDBB@@B@BBFBB@@@BA@@@A@@@A@@@@B@@ADA@C@A@@@@@A@A@@BCNBJBTARABA@@D@@@@@BB@@BBB@@@B@B@BB@C@C@@@@BA@@@A@@B@@@DA@@@A@A@@@@AAAC@A@ABABABA@@BA@A@AAA@A@AA@A@AA@@AC@A@ABA@@CCAA@A@@@@A@@AA@@ACA@@AACAA@AA@@ABAB@@@@EC@@@@@@@C@@@CB@A@@@CA@@@A@@@AHAB@D@@A@@AAAG@@@@CAA@@C@C@@A@AAABA@@@AAA@@@@@A@A@A@A@A@A@@@@A@A@@@@AA@@@A@AB@@@AA@@@A@@@A@@AA@@B@@@AAAAAAAA@AAA@@BA@@BA@AB@@A@@BA@@@@@AA@@@AA@AAA@ACE@A@A@@@A@@A@AA@@@@AA@@@A@@@@@AB@@@AC@@AA@A@@DA@@@A@AD@@A@ABAAA@@BAB@A@@AA@@@@@@A@A@@BA@AB@@AB@@ABA@A@@@A@@AAA@A@@AC@AA@@AA@A@@@A@@AAB@B@@@@A@A@@AAA@AA@@A@A@A@A@@@@@A@@@CAAAA@A@@A@@AA@E@@AAAC@A@C@@AC@@@ABA@ABADAB@B@@@B@@@B@@@@B@D@@B@DA@@@@@AA@@A@@BAD@B@@@@@A@@A@AA@@@B@B@B@DABBBBBAB@@@BC@@B@@@@E@ABA@@B@HBD@BA@@BABA@@@@AA@@BA@@B@@@@A@@@AAA@AD@BA@@@A@AB@@A@A@@A@ABA@A@AAA@AAA@AAA@AAA@@@C@AA@AAA@@@@A@CBA@@@A@@@AC@@A@AAAB@@C@@@C@AAC@@@AA@AAA@@@A@@A@@@AA@@@A@@CA@AAAAAA@CA@B@@A@A@@@@A@@AAA@@@AAABAA@@A@@A@@AABA@@@@@AA@AAAA@@@@@ABAB@@AB@DBB@B@BAFBB@B@JCD@B@DDDBB@HCDA@ADEDAJCD@D@FADCBA@ABABA@A@A@@IM@A@ABA@@B@D@B@BBB@@@B@@@CAAAA@AAA@A@AA@@C@A@@BEB@@A@@@A@A@@BA@@@AB@@@BC@@@@BA@@@A@@@AB@@ABA@@@@@A@AA@A@A@@CAE@A@C@AB@@@AAA@AA@AA@@@A@@@@A@AA@@A@CA@@@A@@A@G@E@A@AAAA@@C@A@AAA@A@CBA@A@@@C@A@C@C@A@AA@@@@AB@BBD@@@B@BB@@DBBB@BB@BBBA@AB@B@B@@@@A@CA@@A@A@@A@@@@C@@@CAA@C@AA@AAA@@A@AA@@@CA@@A@AAB@AA@C@@AC@A@AA@@AA@@@A@C@AAAA@@@AB@@AAECI@GFED[DGA@G@ICICC[GOEEK@ABCBAAA@A@@BA@AAA@A@ABCAAAAA@@@AA@@@AB@AA@@A@@@@A@@@A@@E@@@AA@A@A@@AAAA@@BA@@@A@@AC@@@A@A@A@A@@AA@A@@@A@A@@B@@C@@AA@AA@@CAA@A@A@@AA@A@AAABA@@@@A@AAA@A@SCWCYCWGKBKGAG@G@IBK@ECGEEICE@GB[JGHCJGDCBAAA@C@CAEAA@@@ABA@A@CAA@A@A@@BA@@@@AEC@@A@A@A@@@@@AC@@@@AAAA@@@C@A@@CAAAAA@A@AAAAAAA@@AB@AA@CAA@@@@AAEAA@A@A@A@@B@BA@@BC@A@@AA@@@A@A@A@A@A@@@A@CBA@A@A@A@@@@C@@A@A@@BA@AB@@AAC@A@C@A@@AA@A@@@@A@@AB@@A@@@A@C@C@@@@B@BA@@@@BAAA@A@AAA@A@A@AB@BA@@B@@A@@AA@A@@@@BA@@@@CAAACA@@@@AB@@A@@@AA@@@ABA@@A@@C@@BAB@B@AA@@BA@ACCAAA@@ABA@@A@@@@AA@AA@@@AA@@@A@A@@@@AA@@A@C@@@@@AAAAACAA@@AAAIEQKKOMCKAGBEBGHEHITK@ABKDKFIFABODAD@@CBC@@@@D@BC@A@A@@@A@@@AB@@A@@B@B@@@B@@@BA@A@EB@BB@@@B@FBBBBBD@@BB@@@ABA@C@CA@@CAAB@@@@BB@B@@C@A@A@@@A@@@DA@@@@@A@A@A@@CAAC@AA@@@AB@@ABBB@@C@A@@@A@@@@F@@A@C@A@@A@@CA@@A@ABA@AA@@BA@@@AAAAA@@@AA@@@OGGEAEBE@IAEAAOAA@A@EBA@@@@A@@AA@A@A@@A@@A@@@@BA@ABC@A@C@@@@ACA@@CACCA@A@A@@C@CA@@@AAA@A@@AA@A@@@A@@CAA@@@AB@@GBE@AB@@A@EC@@A@C@C@A@@@@ADA@@A@ABABA@@B@B@@A@@@AA@@@@A@@BA@@@@A@@@@A@@D@@A@@@A@@@A@@@@@@@DB@@BBB@B@B@@@@B@@@B@B@@CL"],encodeOffsets:[[123139,41943]]}},{type:"Feature",id:"211322",properties:{name:"建平县",cp:[119.64328,41.403128],childNum:1},geometry:{type:"Polygon",coordinates:["@@DCJADAHEDEHELEJCNK@@B@@@@@B@DDBBB@@@BABAB@B@D@B@BAB@BAD@DAD@F@B@HAB@BAB@@AAC@@@@DC@@@A@AAC@E@C@CAG@@@C@ABCEACC@@BACECAAAA@E@A@CAC@AA@ABA@ABADA@A@@@@CACAAC@@ECA@EG@AAAA@@A@ABA@@AAA@@CCCAC@@@C@A@ABAB@@@HBHBD@D@@@BC@A@C@@@@B@@@FA@@@@@D@@@@B@DA@@BBBBBBDDLKA@@AAA@@AC@A@@JEJFBBFB@@@A@A@@@A@@B@@A@@@C@@@@BABAB@B@BBB@D@B@@@@@@@F@F@D@B@FAD@DAB@@@@@AACAA@@@@AD@BABAB@BA@ABCBABC@A@@@E@A@C@ABCBABA@AD@B@DB@BB@BAB@BDB@@@BABADC@@D@B@B@BA@@@@@AFEBABABA@@@A@ABA@@B@B@DAF@B@DBB@DBD@BAD@DAB@HBH@DB@ADCB@@@B@BBBB@@D@BC@@D@@@B@A@ACB@FEDAD@@AD@F@BABCDCB@B@BB@@BABE@A@@B@DBHDDBB@HBFB@@BA@@@AB@@@@ACAAE@A@@BA@@B@D@B@F@BBD@BBB@B@BAB@BABAF@BA@@B@BA@A@C@@AA@@BAB@@AB@JDF@FBF@@@BAB@B@DBD@B@@@@@BAA@@@AAA@@@@AB@@@F@@AB@@@FBB@BBDBB@BAD@FCB@JBBB@DBB@@@@JAF@D@BAD@@@F@TBR@D@J@JBL@J@FCFGBG@KBKBKBI@AAKAG@CGIEKIMGG@G@EAGAEBGDC@@DGDIAECE@GBE@GBGDEFCNEJEBAFALGLKHIFMBK@E@EDCHGJALAFIAECEEIBEFGHEFCJEHAJADCFGBEBKAICEAEDCBALCLCJCFEHEBEFEDKBKAGDGBEBI@ECICKACCCBEDEDCFCLCH@BALCNGJKDGBK@KAI@A@EFG@ABCDAHEJCFKFSBKFMDIFID@@A@G@CBA@@@@@@@@B@@@@BB@@BLBFBB@FBBBFD@@D@@@BB@@@B@BDD@@B@BA@AAA@@AACCEE@AA@@ABA@C@ABE@ABABAAA@A@A@@BA@@B@@@ACA@@CAA@@@@@@@@AE@A@ABG@CAAAA@@GAEA@A@@B@@A@@AAA@A@A@@A@@B@BABA@@D@@@B@BADB@@@@@A@@@@@ABA@A@@@@D@LBB@B@B@@@@@CA@A@@AA@@AA@AA@@AA@A@AA@@@A@A@@@A@AA@A@IA@@ACBEAA@A@A@@EBB@@ABABA@@@@AA@@A@A@A@@BAA@@@@AC@@@A@@B@BAFC@@@@AACCA@A@@A@@AA@AEC@@AAA@@A@@@@BAJA@@@@GAA@@@FGFCFC@A@@@@DA@@@@@AA@@@@A@AAE@@BAACBCBAGDGJ@@@@ADkgCEGECGCGAI@EBIDGDCDCHIHEFINININMHKLKHKBGAIFC@CKAKGMGEAA@MGOE@@ABC@A@@@A@CBABC@@@A@@@@BA@A@A@A@A@CCAAA@A@@@ABA@A@@@C@@AA@@@C@@@CA@@@BA@@@A@C@@@@BA@@@A@@@@@A@@AA@@CAA@@@A@@A@A@CAA@AAA@CAA@A@A@AA@@AACAA@A@@@AAA@@AAA@@B@@AA@@A@A@@A@@AA@AA@@A@A@A@@ACB@@A@ABE@AAA@AA@@AA@@@@ABA@@B@@A@ABA@@@AACBA@@@@@A@A@ABCC@@A@@@@@A@@@AA@@@AA@A@A@A@A@A@AAA@A@@@@@A@AA@A@@B@@ABA@C@AA@@A@@BA@A@@@@@A@@A@C@@@AAA@A@@@@@AAA@@@EEKEKGGCMCGGMKGCCAABC@@@A@@@ABA@@AB@@AA@@A@@AB@@A@AA@@@BA@AB@@@B@@A@ABA@@@A@ABA@BB@@CD@@AA@@BA@@A@@B@@@@BB@@@@@@A@@@@D@B@@@@A@@@@@AAA@@@@B@@C@AA@@@B@@AAC@@BB@@B@@@BA@@@A@@@@ACAA@AAC@A@C@@@A@@AAAAAA@GB@@C@A@AAA@C@A@A@AB@@A@@BA@@@A@C@A@CA@@AB@@AA@@@@@@BA@@@@@A@@BAB@@CB@@A@@@@@@AAIEAAIGIOEKGGCEECAASSEKGOGUIWAI@A@AAMEIGO@@AI@C@E@@@@@A@@@@@A@ABC@@DIDELIFGDI@M@CBGACCCCEG@EAKCGEEIAGCAGEIAICCAECICMEKEGAG@GCKEGGIECCAAACGCK@I@CBA@CBEBOBQ@K@M@QBIAGAKEKAKCC@GAOEGCIEE@MFMFMFOFE@GFEH@HBFHFHHDDFFDFBBCFGFGFIDEDEDEDCFEBC@IAGAICOAC@@@AFAD@B@@ABA@EBCBA@ABC@A@A@E@@@EACA@@A@A@CBAB@@C@@B@@@@A@@D@@A@@@@@@A@@C@@@@@AA@@@@@@@DABABC@A@@BC@@B@@A@A@A@A@G@CD@@A@A@CBA@ABC@@@@@ABAB@BA@@BBB@@BB@@@BA@@BA@BDA@@B@B@D@B@D@@@@ABEBA@@BA@AB@B@B@F@@@BAF@B@@BB@@A@@AA@A@ABA@AA@@A@C@C@A@E@@A@@@@@CA@@B@@C@C@A@A@A@AB@@@B@@ABABAB@D@@@B@@@B@B@BA@ABA@@B@F@B@B@@AB@BA@@B@B@@BDAB@@BJBDBNFJLJNHNDFBBBDF@HBF@LCLCLCHB@@@D@B@B@B@B@@@B@@B@BAD@@@B@@AD@BC@AB@BABABABABA@@BAB@BAB@B@@A@@@A@CA@@@@ABA@@B@@@@AAAAAA@@@B@B@@@@@B@@AAC@@@@@A@@B@B@B@B@@@B@BBB@@@@@B@@@B@@@BA@A@ABAB@@AB@@AB@BA@C@C@A@@B@B@BADA@A@A@A@@@@@@@BD@B@@@BA@@@AA@@ABA@A@@@@@A@@@@B@@BBB@BB@@@@B@BB@B@@@@CBABA@@@AB@BB@@BB@D@@@B@B@@BB@@@AB@@ADA@@BADAB@@CD@B@@@@@BB@B@@@BAB@B@B@B@B@B@D@@BB@BA@@BAB@@@B@B@@@B@B@B@BBB@B@@BB@B@B@B@@@@@BA@AFA@@LA@@@B@B@@DCB@@BBBBB@B@@DADAB@BAB@BABAF@@@BDB@BBBBBB@@@B@B@B@DCDA@ADABEDAB@B@@B@D@FBB@@@D@@B@@@@@B@@CJAB@@ABEBIDA@E@@@CDEDA@A@A@C@A@ABG@@@C@EF@BA@ABA@@BABA@K@@BA@AB@@@B@@A@ABC@C@G@C@@@@@ABCBCBABA@C@ABA@@B@@A@ABCB@@A@AB@D@@BBABADABADABCBCDA@@@CBAB@@@B@D@@ABA@ABCBAB@@@BB@@@BB@B@@@@B@@@@@@@@B@@@@@@A@A@@BA@@@@AA@@@@BA@@@@@@BA@A@AA@@A@@A@@@AA@@AAAA@@@A@A@@@A@@@@BAB@B@@@B@@@B@@@@CD@@@B@BBB@BBB@B@@@@AB@BABA@AB@@@B@B@@@B@B@BB@@BB@DBB@@BBB@DAB@D@@@@@BADBHBB@D@DABCFCB@BADABCBAD@@@BBB@@BBAB@BA@AB@B@BABABAB@B@BAD@DBB@BFDBBBDBB@B@B@@BBBBDBFBHDDB@B@B@D@BADADABCFABABA@A@A@CAC@A@A@A@ABAB@BBBB@DBBB@BB@@BD@BB@@D@BBBF@D@BB@BB@B@BBB@BBBBB@BABABCBABC@EBABEBABA@A@A@CBCBCDABCFAB@B@D@@@@@@@B@DBBBB@B@B@BBBB@BBB@@B@BBBHDBBBBD@BBDBDDBBDBBDDH@DBJ@B@DBB@@@B@B@FBB@DBBA@@BAB@BA@@@@B@BBDBDBBBDDDBDBB@@BBBD@B@B@@@@AB@B@@@B@B@B@@BBB@BBBB@BB@BB@BBD@BBB@@BD@B@B@BABA@ABA@ABABAB@@@D@B@BB@@BAB@B@@A@AB@@ABAD@@@B@B@@@@BBB@BBBBB@BBBBBB@@@BB@@@@B@@B@@B@B@BB@BFBB@B@BABAB@BAD@B@BB@@BB@@B@@@B@@BBB@BD@BB@@B@@B@@@BB@@@@@B@@@B@@@BA@@B@@@@@BABABABABA@ABA@@@A@@BA@AAA@@@@BA@A@A@@@@B@@AB@@AB@@AB@@@B@BBB@@@BB@@@@BB@@B@@B@@B@@B@@@@B@@@B@@BB@@B@@B@@@D@BA@@B@@@@@B@@@B@@BB@B@B@BAB@B@BAB@DAB@@ADA@@@AB@@@@@B@@@@@@@B@@@BB@@@@B@@@B@@BBBB@BDB@BBBB@B@DBD@BB@@BB@BBBBBB@BBB@DDDBBBBBBBBDDDBDBBB@BBBBBB@BBDHLDDDD@BB@@@BBBBDDDFBDBF@B@B@B@@@B@@@@@BBB@@@BA@B@@B@@AB@@@B@@@@@B@@@@@BDB@BB@DB@@@@@B@BBD@@@DAB@B@@@BBBBBB@@B@@@H@BAB@@A@AB@BA@ABA@@B@@@F@F@D@@BDBFDBBBBB@@@B@LBF@B@BADABEDA@AB@BAD@BADAB@@@BB@@B@BA@CDA@A@AAA@A@CBCBCDED@@EFCBADCBC@ABC@AAA@C@E@@@E@E@C@A@CACAA@C@A@CBA@A@CBCBA@AB@@ADABCDABCBA@ABAB@@@D@D@F@DBB@BDDB@BD@@@B@BABABABCBCB@@CBC@K@CBEBAB@D@BBD@D@BABCDAB@B@B@DABA@C@ABCBAD@BBB@@BLBBA@@BAB@@A@A@C@ABA@@@A@A@@@CDA@C@E@E@KAE@CB@B@B@B@DA@CBGBED@B@B@BBBDBHBFDBBBDBHBD@DBDBDDDFDFFHHDDHDFHDHBHBHBNBFBFHDFBHBD@NBH@DBBBFBFB@@FFHFHDBBFDFDFHFF@B@B@@BBDBBBB@DDDB@@@@B@@@@@@@BB@@B@B@@B@@B@B@@@@@B@@BB@@@B@B@BBB@D@B@@@F@B@DAFABA@@B@@AB@B@B@@@B@@BB@B@@@DBDBDBHDHDFB@@J@JBJ@H@D@DBB@BBBDDDDBD@B@D@F@DBB@BD@BJRBBBBDBJ@FB@@D@BBD@JFB@B@BBB@BBB@DB@@BB@@B@DBBBHDLFFFDBDDFJHL@@DF@D@D@BBDBD@F@D@DBF@BBB@BBA"],encodeOffsets:[[122372,43405]]}},{type:"Feature",id:"211324",properties:{name:"喀喇沁左翼蒙古族自治县",cp:[119.741223,41.12815],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AA@A@@@A@A@@AA@A@EBA@ABAAAB@@@BB@@D@D@J@B@DBD@DB@@@C@C@@@AAA@@@A@AAAA@@AA@AAA@@AAA@@@A@@@@@C@@@AAACAAAAA@@@@@@@@@@AAAC@@AAG@@A@@CCA@@ABA@AAC@A@@@@@A@A@A@AAAA@@AAA@AAA@A@@A@@@@A@AAA@@A@A@C@A@@BABA@A@AAC@A@@@A@CBA@@B@@ABA@@@AB@B@@ABA@@@@B@@@B@BA@A@@BCD@@@B@B@@B@BB@@@BAB@@@DABABBB@@@BAB@B@B@BA@A@@@@@@@A@A@@B@B@@@BA@@@@@@@@@B@AB@@@B@@A@@B@B@@AB@@@@CB@@C@A@@@A@@BABAB@@@@ABCF@@@BA@@B@BAD@@ABA@GBABEBEBA@@@@@@BE@CBA@EBA@EDCBCBA@A@@@@A@@AAA@CAA@BA@AA@AAKKECGCGCIEGCG@CBGDIFMJKJQDIDKABLDFDHBD@DAHADEHEDK@@@A@CBA@A@@@@@A@@@A@CA@@CACAA@@@AAABA@A@@@AB@@A@@BA@@@AAA@CAABAA@@@@ABA@AA@@A@AB@@@BA@@BA@A@@@@@ABA@A@C@A@@@A@ABCD@B@D@@ABA@A@ABC@@@A@A@ABABAB@@@BB@B@B@D@@BBB@B@@LJTBHFBBBDBDDLTJ@@@@HFFB@@@@TLTAJDPTLL@B\\HDAB@B@BBBBBBDBJ@BBB@@B@@A@ADA@ABABC@@BA@AHABAB@BB@@BA@@@C@@@CAECA@@AEA@@@A@@@BC@@@@D@@C@A@C@@@A@@DAB@@ABC@AB@@A@@DA@@B@@AD@B@D@@@BCB@@@BA@@BAB@@@BAB@@@B@B@B@BCB@@@@@@A@@@@B@B@BBB@@@B@@@D@@@B@B@B@@@BCB@@@B@BAB@@A@A@A@@@A@A@@@@@A@AB@@@B@@@BB@@B@BBDBD@@BB@B@@@B@@@@@BB@@@BAB@@@BABAB@D@@@DEB@D@@@B@@@@A@@B@@@@A@@B@@B@@BBB@FBB@@@ABBB@@DB@@@@B@@@@ACA@A@AB@@@@@@A@@@@@@@A@@@@BAB@@@BABDBBBBB@DBP@F@HBL@DBH@PHPHPHLDJF@@FD@@BBHDHNBF@F@BAD@@@FCDEFGDIDKBKFIDIH@F@DAHAHIHGBM@O@IBGDEJGF@B@B@@AD@B@B@B@@@@A@ABCAA@@BA@A@A@@@ABA@A@CBA@ABA@A@A@CAA@@B@@A@A@A@AA@BA@ABABABA@C@A@@BA@A@@@@@C@AA@@@BC@@BA@@@ABABA@C@@DA@A@A@A@A@A@AB@@@BA@A@AAA@@@@DC@A@@@@B@BD@@B@BB@DB@B@BB@@BA@@BBBB@@@@DB@@@B@B@BBB@BA@ABABAB@B@BDBBB@@@D@@A@AD@@@B@@AJDJBRERIHENEHAFBHDFFBHBJDFDD@@BDDFBB@LALEFADMNILGHIHCDMJQLQJSLOHQ@M@KAGBEDGDALCJHNDDHFLFJHFJDH@LEHGHEFCFCJDDFHNFHFB@LJFFHHBDNRNLLJHFF@JFHDPFHBD@LDLBLFHBJBRAN@L@R@PAFADAB@DAJ@L@HDBDBBDDJFHHLFHDH@HBLFNFJDFDDBJDJBHFDBBHFJHFLDFBH@DFDDBDAH@D@NCJEHKJCFCJ@@AD@B@B@@@@@B@@@@@F@DBJ@@HPFJBN@B@BBJJXHVHPFLTTBBFDDFHHFLJPJHBBJFBB@@@@@@@BA@@DA@AB@@@B@@@@AB@@@@@@BB@@BA@@DBB@D@B@@@B@@AB@@@BAB@B@D@B@BBB@D@@@HAB@BBBB@BB@@@D@B@D@BBB@DB@B@@B@@@B@@A@@@AA@@AD@BB@@@A@@BBD@@@@A@@B@BB@@@@B@@@@@@A@C@@B@@@@@@@AA@@@@@AB@@@AB@@BB@@DC@@AAB@BAB@@@B@BAB@@@@A@@BAB@@A@@BBB@@@BA@@@BB@@BA@@BB@BA@@B@@@D@BADBHDNLHHNDHDLHLFFFBIBKAE@GACEGECIGBE@EBIBIHIDGDK@EACEGKKEGEI@IBKBGBIBGDIDMBI@G@G@E@E@MBK@OBI@K@MEOGKCEEKAEAEBMEKACAE@CNIFA@ABAXUJIFCB@DANG^CrGGIKIIGDCFINILK@EFG@CFGDEHIDEBGFCHGFIFIFIBE@E@K@GDGFGFCF@VCRILEJIFEFKHIBCBEDE@GEOAIAE@OFMFIDEJEBA@A@A@CB@@ADABA@@@@BA@@@@B@@A@A@@CA@A@@@A@@@C@AAA@A@A@AA@AAAA@A@@AAA@@BA@A@CBA@GE@@@AA@@@A@AAAAIAG@E@C@A@CAAA@AA@@A@@CA@A@@A@A@@@C@A@AA@@@@@BA@A@ABAA@@AA@@AAA@A@A@@@A@A@AAA@@CA@A@C@@@A@@@@@AC@A@@CCAAA@@AA@@A@A@@@ABAB@@A@@@A@@H@D@FA@@@@@AB@@@B@@A@@@@@A@@AA@ABA@A@@BA@@@ABC@A@@BA@@@@@A@@@A@A@@@A@A@A@A@@B@B@B@@@BABA@AB@B@@@B@B@@@@ADC@@AA@@DC@AAA@A@@@A@@AA@A@A@@BAAA@@BAB@@A@ABA@@BA@@@@AA@AA@BAACB@BA@@@@@A@AAA@@BA@@BAAAB@B@@@@@BC@@B@B@AA@A@@BAD@@@@@@AA@@AA@A@AA@@AA@A@A@@AAAABA@A@@AAA@C@@ACA@@A@AA@@AA@AA@@@@@BA@ABA@@@@@AAA@@AA@AA@A@A@@@AACA@@A@@A@EAC@A@@@AA@@@BAAC@@@AA@BABA@AAA@@@ACC@@@A@@A@@AAA@@CGCEACIIECAAGECCCEKECACAE@EBCDCDCHCLIHGDC@E@GCGGCIGEEAIAGA@@GGEMAIEA@ABC@C@A@@@@EA@@AB@BC@C@@@ABCB@@@@CAAC@@@@A@@BBB@@BB@B@@C@A@CAEBC@@@CAAAA@A@A@A@C@AAA@A@@BABA@@@A@@@C@@@A@AD@@@B@B@@@@@BA@@@@BABAB@@@BGD@BA@ABC@A@A@@B@@CBAB@@A@@BA@AA@@C@A@@@ABA@A@C@C@CAA@A@AB@B@@@B@@@B@B@FA@A@EAA@@AA@@@A@@@AAA@A@A@AA@@ACA@@ABA@@@A@@@A@@@A@@BA@@@A@@@@@A@@@@@A@@BA@AAA@@@A@A@@@@@A@A@C@AB@@ABA@@@CB@@A@A@@@C@@AAAC@@@A@@BABA@@BABA@@@A@A@@@A@@@ABAA@@A@AA@@ABA@AB@A@@AC@@AA@A@AA@AA@@@AA@AAA@@@A@@@A@@@@@A@@@A@@@ACA@AAAA@ABCAA@AA@@C@A@@@A@@@C@AA@@@@@A@C@AAA@@@A@@@@@A@A@AACAAA@ACAAAAC@A@AB@@A@ABAAA@ABA@@@@@EC@@@A@C@@BAACCCA@@@A@@B@BABA@@BA@C@A@@@@@ABABBBAD@@@@A@@BABGBABA@A@@@C@C@AAA@A@E@IBA@@BADA@@B@B@B@@ADA@@@AB@@CAAAA@A@@@CA@@@@@AAA@@AAA@CAAAA@C@ABC@CAA@C@A@CBA@CAA@@AAA@@@CAC@@@AAAAAACAA@A@A@A@@AC@@BA@A@AAA@@A@@@A@A@@@AA@@@AAA@@A@C@"],encodeOffsets:[[122570,41817]]}},{type:"Feature",id:"211381",properties:{name:"北票市",cp:[120.77073,41.800683],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@BB@@B@B@B@B@@AB@BAB@B@BAB@BA@@B@@@B@B@B@B@B@@@B@B@B@BBB@BB@@@@BB@BB@B@B@BBB@D@B@B@FBB@D@B@B@D@@@BBBB@BB@@B@BB@BB@@B@B@B@BAD@@@DAB@B@BAB@B@B@BAB@B@D@B@@@BAB@@@B@BAB@BAB@BA@A@A@@AC@@@A@@BAB@B@D@@@B@@@B@@A@@@AB@BABAB@B@@ABBB@BB@@B@B@@@B@B@@@@@BA@@B@DAD@@@DBBBDBD@B@@@BABAFAB@DAD@B@D@DBB@DABAB@@A@@AAAAA@A@AAAAB@@ABA@@BADADABAB@BAB@BA@@B@BAD@B@DA@@D@BA@A@@BABABABCB@BABAD@DA@@BAPGJMHG@ABG@MGMAEAIHI@YNK@@AA@@@A@@FGBADC@A@@@ABA@@B@@ABADC@C@A@@@C@A@EBCBCDCDA@CBG@CBABCBIBCDSKGGIAKAIAKCGESSIUOCOFUJQBKDOCMEKGMC@@IIKDODAHA@AB@BA@@@@DB@@B@B@@BB@B@B@B@@@D@D@B@@@B@@@B@B@B@@@@A@@@@BB@@@@@@B@@@B@@@@@B@@@BB@@B@@A@@@@@@@BB@@@B@@ABB@@@ABA@@@B@@B@@A@@BA@A@@B@@A@@B@@AB@@@D@@@@@@@B@@@@@@@@@B@@@@A@@@@@@BAB@B@@AB@B@DADA@@B@B@B@@@@@BAB@@@@@NCJ@L@NHVFTDNF\\@ZGLELCHCDEBCJKBKDIBIDGFI@OBGHIJCLCFAXKVG\\CVGZEB@FAD@FEHE@G@EAG@AB@BABC@A@CBAAAA@@CGCCCA@@A@A@@A@CA@@AA@A@A@@CAAE@A@A@@AA@@@A@@A@A@@@@AB@@CAC@AAA@@BA@@DA@@@@C@A@A@C@A@AB@@@ABABA@@@AA@BAAA@A@@AA@@@@@A@A@@@@AAA@AA@@CB@@ACA@@AA@@AA@@AAA@@@@B@@A@CA@@AB@@A@A@@BA@AA@@AAA@@BABA@@@A@A@@BA@A@@BA@@B@@A@@C@CA@@C@@@@AAAAA@@AAA@@A@@@ABC@@AA@A@@A@AA@@ACAA@@AA@A@@@A@@B@@A@A@@AA@A@@AAA@@A@ABA@A@@DADAAC@A@@@@C@A@AAA@@@ABC@A@C@AAA@@AAABC@@A@A@AB@@A@A@C@AA@@A@C@A@AAAA@@A@A@@@AA@BAA@@CA@@A@@@@AAA@@A@@@@@AC@@@A@AA@@@BAB@BAB@@ABAA@@ACCAAAAA@@@AA@@AAA@AA@AA@@AAG@@@@@AB@@@@A@ABADC@@@A@@@@AA@@BAB@B@@@@@@C@A@@@AFBFAB@@@@A@@@@BA@@@A@A@@@@BAFA@@A@@A@A@@@AAAB@AA@@A@@AB@AACA@@AA@A@A@@@AA@@@@AAABA@@@@FA@@@@@A@A@AA@@AAC@@@@@@A@A@A@A@@@A@@A@@AA@A@@CCCG@@@A@@BA@@DABBB@@@D@D@@A@@BAA@AA@ABA@@AA@C@AAA@@AA@@@@A@A@@@A@AAA@ABC@AAA@CAAB@@AB@@CCCA@@A@@BA@A@@@@@@A@A@@A@@@@A@@A@@@@B@B@B@@A@@BA@@AA@@A@@AB@BA@ABA@@@A@@@@@@AA@AA@A@@AAA@A@@AAAA@ECC@AA@@@A@@CCAC@@@@A@@B@@AB@@@BBF@B@@A@CD@BAA@@@@AA@@@AACECAAABCBABA@ABCB@@C@A@@@AAAAA@@A@@@A@@@@@AC@@@@@@AAAA@AAAB@AA@@AA@AAA@@@BCB@B@@AAA@A@@@C@A@@AADE@@@A@@C@@AA@@@A@@@@AA@CBA@@@A@AAA@@@@ACBCE@@CA@@@BA@@@@A@AAA@@AAA@E@@@A@@A@@@@@ABC@C@AB@BA@@A@A@A@AACA@ACA@A@@@C@@@AA@AAAA@@@@@A@A@@AAA@C@@@@B@@ABABABCBA@@@AAACA@ACAA@@A@A@ECCAA@A@CBA@A@A@CBA@AAA@E@AB@@AA@@@AB@@@AAA@@@@A@@CBA@AB@@ADB@AB@@E@A@BDDBDD@@@B@DABCBAB@@CAAAA@@@AAA@@C@CBA@@AAA@@B@B@B@@@@CB@@EDA@@@@A@@@@CA@@@A@@@BA@@@@@@@C@@@AA@A@@@@@A@AB@B@@A@A@ABA@@AA@BAD@B@@@@@A@C@ACB@B@@@@@B@@@BB@@@@@@@AB@@AB@@AB@@@@@A@@BA@@@A@A@CB@@A@@A@@AA@A@@@AA@AAAA@@@EBCA@@AA@@@AA@A@@@A@@CEA@@@BBF@@A@A@@@C@AAA@@A@A@@IAEBC@A@@BA@@A@@B@BABA@@B@D@@AA@@A@@@@A@@AA@@@@AA@@@@BA@@B@BABA@@@E@EAC@AA@@CAAAAA@A@@@@@A@@@@@AC@@AAA@@C@@@A@@B@@@B@BA@AB@@AC@@CAAA@@ABAB@BA@@B@@A@A@EB@@A@@AAA@@A@C@@A@ACA@A@ABCBA@AAAAA@AB@@@AC@A@A@@BADA@AB@@A@CBA@A@@@@CACA@@B@BABABA@A@@BADC@@CA@@AAA@A@IGAA@A@A@AA@CAC@@@@@@@BA@@AA@@AA@@AA@@A@AAAACAAAA@@@@AB@@@@AA@@@BAA@A@@A@ABC@@C@@@CAAB@@C@A@A@@AA@@AA@C@A@EAAA@@BAAA@AA@AACEA@CAE@CBC@@CAA@CAAA@CBA@CBA@C@ABA@@@@@@@AC@@@@CAC@AACA@@@AB@@A@CAA@@AAA@AAAA@CAA@@BC@A@A@C@E@C@A@@AC@@@AAA@ABA@A@AAACC@A@A@@@AA@@@@@@A@@@ABA@@@@@@BAD@@@@@D@DC@@ACAC@AACCA@AA@AA@@CBA@A@@@A@A@@BB@@@@B@@BDA@@AC@@BA@CBA@@@A@@AA@@A@BA@@BABA@@@@AA@@@A@A@A@AA@@@@@A@A@A@@AABABA@@@A@A@AAA@C@AB@@@BA@@AAAA@A@@B@@C@@@A@A@@@@@A@@AA@@A@@A@A@@@@B@@@BC@A@@AA@@@A@A@@@A@A@A@A@@A@BA@@B@@ABA@@@AAA@A@@A@A@@@@A@@BCBABA@@@A@@BC@@B@@@BA@@@A@@BABA@A@AAAAAA@CAAA@@A@@@@@C@AB@@@B@@@B@@A@@@A@A@A@@@@A@AEACA@AA@@@A@@@@A@@A@@@A@A@@@A@AB@@CB@@@B@@EF@@AB@@@B@AABA@A@@B@A@@@@AAAA@@A@@@A@@B@@ACABAA@A@@AA@@@@BA@@@A@@@AAA@A@@@@@A@A@A@ABA@A@AB@@@AA@A@@C@@@@A@@AA@@EEA@@A@@AA@@A@A@@@ADAB@@C@ADA@ABABABBBABABAB@@@B@B@BAFAB@@A@@B@@@B@@ABBD@@@@ABABA@A@AB@BC@ABA@@A@@@A@@A@@@@@@@A@@@A@@@@A@A@AA@@@@@A@A@@A@A@@@@@@@AB@B@BA@@BAB@B@BC@@AC@@B@BA@@@@D@@ABAA@B@@A@A@A@AAA@@@AA@A@@AA@A@A@CAA@AA@@@@@AAA@A@@A@@@AAA@@A@@A@A@@AC@C@A@ABA@A@A@@@AA@B@BAB@@ABABAB@B@BBDBB@@@@@B@B@@CB@@A@@@@B@@AB@@@@@D@DAAA@A@@@A@AA@@AA@@@@C@@@ABQBSBIAMAAD@@@B@B@@@@@B@DCB@BA@@@@@@B@@@B@@AB@@A@EAAAA@@@AACC@ACA@@@AAA@A@@@AC@E@C@EDOJGNALAFAHGHGHGFMBW@SBODOHKHOBEBG@UB@HDFJJRVBJBNBFBBHBRF@F@LCBKBI@EAK@GBEHFLDDHH@@@@BBPRBF@DAFKJKHQJMFAB@BBDFDTNFDDF@FKJYLGFCHBN@F@LBdBR@BBDHLVPNJHNERQLADEFAF@DBLJRBF@VCLGLABIFGBEFGDEJCPG@CEIIEDANSDGFELKBINI@IIESDWV[DCFEFEBOGKACWMIEUESE]AG@QASJG@IIQCQHIJAHELKPIJ@@GDCBEAGACBA@GFA@WRGHGFEFADBJBFBB@BB@BB@@ABB@@B@@A@ABAB@@@B@@CBB@BB@B@@@@@BB@@B@BB@@@@B@@@BA@A@@BA@@@@@@BA@@@A@A@@@@@@BA@ABCA@BC@ABA@AB@@@@BBBB@@@BBB@@@@@B@B@B@@AB@@@BAB@@A@A@@@@@A@AB@@A@@@@@A@A@@B@@@B@@AB@B@BAB@@@@@@B@@@@@@@ABA@@@@@AB@@A@ABEB@@@@B@@@AB@@B@@@@@@BA@@@@B@@C@@A@@@@AA@@@@@@@@CDAB@@BBBDAB@@BB@B@DBD@B@B@B@@@B@@@BA@@@A@A@@@@@@BB@@B@@@B@B@@CBA@@BABA@A@ABA@CB@@@B@@@B@@AB@B@@@@B@@B@BAB@B@@@BA@@B@@@B@B@@A@@B@@A@A@@@A@@B@@A@@B@B@@@BB@ABAB@@@@CFAB@BBB@B@@@BD@@BB@BD@BDB@BB@BA@@B@BBBB@@@B@BABABA@@@DF@@BB@@BB@@CD@@@DCBEACB@@@@A@C@A@ABA@@@A@@BA@C@@@AB@@A@A@A@C@@@@@@D@BABA@@@@@@@ABA@@@A@@@AB@@@B@BB@@B@@A@A@AB@@@@CAA@A@@@@B@@C@A@AA@@A@@BA@A@@BA@A@AA@@@@AAA@C@@AA@C@A@@@C@A@AB@@@BAB@BCF@@@B@BABA@@B@B@@CB@@@BA@AB@D@@A@AB@@AB@@CAABC@A@@@A@@@A@CAA@@@A@@AAAC@A@A@@@AB@B@@AB@BAB@DAB@@@BBDBD@B@@@BB@@BD@@@B@@BAB@@@B@@BBB@BA@@@BBA@@B@@CB@BABA@ABC@C@@B@@@BBB@D@FBB@B@@@@B@@@@A@@@IBA@@@AB@@ADAD@@ABD@@BBBBBDD@@BB@FF@DB@@B@HB@@@@BA@@BE@@BA@BB@D@@BB@@BB@B@@A@AAC@@B@B@@@@@@A@@AC@AA@@@AA@AC@@AE@BC@@B@@@DBDBDBDDBBBDBB@DB@@BB@H@B@@@@H@BA@ABA@GB@@AB@@BB@F@@@@AFAB@BBB@@BDFB@B@@@@BBD@B@BBFDJDB@B@DBDBBBB@BB@@@@AB@BABB@@BDB@B@@@BA@@BA@BB@BADA@AB@@@BBBB@D@B@B@B@BABAB@B@B@D@B@BB@@BB@@D@DB@@B@@@@BBDB@BBBDB@D@F@DB@BBB@@B@B@BB@@@@@D@@@BB@BBD@@@B@DAJCDADAFCFAF@DAB@BAD@F@H@D@B@@AB@D@DBD@D@BBD@@@B@@@AB@BA@@B@B@@@B@BA@ABEDCB@@CBA@@@@@BB@@D@B@B@DBDBFDB@@BBB@@DDBB@B@@B@BBB@BAB@BAF@D@B@B@BBB@@BBB@@D@@BBB@@B@D@B@B@DBDDB@BB@B@@ADAB@@ABADAB@@@B@@DJ@D@@@BAB@B@@@B@BBDA@@FAB@@@B@B@@@DABAD@B@D@@BB@@@D@D@B@@B@@BBAD@B@@@B@@@@BB@@@@@B@@AB@@@B@BB@@F@B@BB@@@BBBB@B@BBBADBFDBABBB@B@D@B@B@@@BB@@BBD@DDDB@@@B@BBBBB@@@BA@@BEFAB@BCDCFCDF@DAB@@@@BB@DBD@@@@@B@B@BB@@BBBB@B@@@BA@@BA@A@@BA@AB@@A@A@A@ABA@AAA@AACC@@A@@@A@A@@AA@KFIHCNA@NLPJ\\HLHJFTJTHXJPBNDRJPHPRRNbKD@NGRCLATJFBFDHFNJNDVJTJDBRJPTVFNBPDLJLLHNPDP@PAP@DANCJ@PA\\PRBPBLFC@A@@@@@@@@@FFBB@@@BAB@@A@ABAB@DBBBFB@BD@@BF@B@@@BBB@@@@BABAB@BABCB@B@FBB@DBB@DBB@@@B@@ADA@@@@B@B@BBDDBB@@BD@@BBB@@@BAB@@@@@@AAC@@@A@@@@@@B@BAB@B@@@B@BBDB@@B@B@B@@AB@BAB@@@BA@@BA@@B@@A@@B@BA@@B@@@@AB@@@B@BA@@@@B@@AB@@@B@B@B@@@@@B@@@B@@@B@@@BB@B@@@@@BA@AAA@@BA@@@@@@B@@B@@B@@@B@@B@@@B@@@B@@B@BBB@@@@@BBB@@@B@@@@@@C@AA@@@@@@@B@@@BB@@B@@@@@B@@A@@@AB@@A@@@ABBB@@A@B@@B@@@BA@@B@@A@@B@@CB@@AB@@@@@@@@BB@@@@@B@@@@@B@B@@@@BA@@@A@AB@@@@@@BB@@@BB@@@@@BA@@@@B"],encodeOffsets:[[123788,43286]]}},{type:"Feature",id:"211382",properties:{name:"凌源市",cp:[119.401574,41.245445],childNum:1},geometry:{type:"Polygon",coordinates:["@@@D@BDD@@B@B@B@BBB@BB@B@@@D@@@B@@@B@@BBB@@@BBBBBB@@@B@@@B@@@B@B@D@@B@D@@@@B@@EBAB@@@BE@ABCBAA@AA@A@EAAAA@A@CBCFABA@@@CB@A@@@A@A@@C@CBA@@BAB@@@B@@@BAF@@ADA@A@A@CD@@A@A@A@@BAB@@@@A@A@@@CHA@@B@@@BAB@B@BC@AAC@AAA@A@A@A@@@AAA@@@A@@B@B@DAB@BA@@B@BAB@B@B@DAB@B@BBD@B@@@B@B@B@B@@B@DBB@BBBD@@@B@D@D@B@@B@BB@@BDBD@@@BAB@@@BBB@@D@B@B@@BFDFB@@BBBBD@B@BBB@B@@B@B@@@BABA@@@@BBBB@@B@@@B@BBBDDBBDBB@@@@BBF@@BB@@@@@B@B@@DD@BBB@D@@@BBB@@AB@B@@@BABB@@BDB@@BB@F@BB@BD@@@BAB@B@B@BA@BB@DBB@B@DA@BBBB@@BB@@@@A@A@@BAB@B@BB@@D@@@@AB@B@F@@ABAD@BAF@B@BA@@B@@B@@BD@D@HABB@@BBA@AD@B@B@B@@BB@FBBBDBBBB@D@@BB@DA@AB@B@@B@B@B@@@@@@B@@CD@@@F@BBDBB@B@@BB@@@D@BB@B@FBPFBB@@@B@BD@AB@@@B@@@@BA@@BCB@DADAD@FCB@@@BD@@D@DAHABA@@AEHAD@B@D@V@B@@BB@B@@@@AB@D@@@BB@BBAB@D@B@DBBCBA@@D@DB@BAB@@A@@@B@BAB@@@@@@DA@DBB@@@@AB@@@ABPFFBDBB@B@@@B@@@H@@@B@BD@FDJBFBHDDFDJHDDFH@DADEHEDOFC@GBE@MBQBSBUDQD@B@@ABEDCD@B@@ABA@@@CBAB@B@BAD@@@B@BABABCD@@@BBBDH@B@@@B@DAB@B@BAD@@@@@BB@D@@@@BB@DDBBB@BBBB@BBD@BB@@B@BB@B@BB@BDBFDD@@B@BBB@@BB@@DDBD@@BB@B@@B@DAB@BA@AB@B@B@D@BADBB@FBD@@@B@B@B@@@B@BA@ABAB@D@BAFAB@BADAB@BAD@FBBCB@BAB@B@@BB@@@BAD@F@D@FBD@D@FB@@@AAAAAA@@AB@@@B@@A@AD@FBDBDBFHDJBDFDJBDCFAH@HDHBHDDDFDDFBFBH@PAL@R@LBHBFFDHFNBNBN@L@JANBLJHDFFJJJNFHNLLFLBJFHDFDDFFJDL@HGLGHKFKDEBCD@FBDDBDDH@HATBVANBFBDDBFDBCD@B@D@@@D@B@DAH@@@B@@D@H@B@ABBB@@@BB@@@@@A@BF@@@@@@BB@DBDBAAB@B@@D@H@B@B@B@B@B@B@BBB@@B@@BBFFCB@B@@B@DDDBBA@@@@BBBB@@@B@BB@BBBBDF@BBB@DBBABBBABBB@DAB@@@D@@CD@DCFGHAJALBJ@DBDBDFFB@BBLBLALAL@J@F@HBNBNBHDDFDHBHBLDDFDF@DA@EBG@ECGDEDCFCDBHBBFDHDJCJCJGLCH@@EH@DAFDDFBBBDBDDDJBHEDIFKDKHC@EFAHBBHDN@L@PDHB@@PBNBJFJFLJDADAD@DBB@B@BBBBBB@B@@@A@@@CBABABA@@@A@@BAB@B@B@D@D@@@@AB@@D@@@@@BF@B@D@D@B@@@BBB@BAB@B@@BB@@@AA@@@ABE@A@@@E@A@ABAB@@AB@FABA@@@@@C@A@C@A@AB@ACB@@AB@@A@@AA@@AA@AB@@ABABA@@@@D@BAB@DAB@B@@@DCH@B@B@B@B@@@@AD@@AB@D@BABA@C@@@@@@BB@@@@D@@@@B@@@@B@@@@CB@@@@@@AD@@@BADAB@B@@@DBFB@@F@B@B@D@BAB@DAFAB@BA@@@ABCBE@@D@PBJDHBJBD@FADEFCFCFCJCHEHEDEAACEEECCGGGEAE@GFGHEF@PENENENEGEKIMKMQACGGEEKIA@GEMEEGCCDIDEFEHGFG@KCGEIIGKEGECCGMDIBKHCFCHALBN@R@PGTKRIRKNIDCJGHGJKNMBCFEBK@KAACEAC@@CCCEAIAGEEGCEAGBMFGFQJQFIAIC@BA@@@C@@B@BC@@@A@AAACA@A@ABAB@BABA@AAA@A@@@A@@C@@A@AA@AB@@AA@@A@ACAA@@A@AC@@A@A@@B@D@@C@@B@BBB@B@@A@@BAB@B@B@B@B@B@@CD@B@BABA@@B@@AD@@A@@BBD@@@@@B@B@@AB@D@B@BABABAB@@ABBB@B@B@@@@AB@DBB@B@B@BAB@DAB@B@BA@@B@B@B@@AB@DBBAB@@@@@@A@A@ABC@@@A@AHEFIHCJAP@N@HAJGBGBG@C@EJGJCLELAJCHCFEDC@E@@BC@A@EAEGMGCAA@@EC@@IEKCOGOGOGG@CAK@GAE@O@CAA@AAAAACAB@@A@AB@@@@@B@@@@@@@B@@@@A@@B@BDB@B@@A@@@@@CA@@AABA@@A@EAA@AA@@@AA@@@@B@@A@@@@B@@A@@@C@A@CF@@C@A@ABAB@@A@AB@@A@@A@@@@@A@@@AAA@@ACAC@A@AA@@A@@@A@@BAB@@@@@B@B@@@B@B@B@@@BA@A@A@@DA@A@@@A@A@A@@@C@@@A@@AA@A@A@A@@B@@@@@@@DA@A@A@A@A@@BA@A@@BA@AB@@A@@DA@A@@@C@ABC@@@AB@@CB@@@BAD@BA@@BA@CB@@@D@B@D@@@@C@@D@@A@@@B@@FB@BB@FDDB@@D@@@B@@AA@@ABABABGB@@AD@BABAB@BCB@@@@AA@AAI@CAAAAAAAA@A@CB[G@AKKOSICSBSK@@@@EAGE@@@@SICKACACAAGESAKI@@@AAA@AC@A@A@A@@A@@BABABAB@B@@@D@BAB@B@BA@@@C@ADCBAB@@@B@D@B@B@BA@@@@B@B@@AB@@A@@BAB@@@BBB@BA@@@@BBBADBB@BB@@B@@AB@@@BA@@B@B@BABB@@B@DBDB@@DBB@@@B@@@@@B@B@DAB@@@L@FCFGBCBG@CACCGCEAKCC@G@EBIDGBECGAACEECECKMCKEKCK@IEGECCCCAC@CEICC@ABC@CAC@A@AA@@A@ABCD@@GBCAABA@A@ABAA@@@A@@@AECCCA@A@A@AAAC@@@@@AFAD@ACIEGIICGCGIIGGKCK@G@MCIGKACGEIGECECKCIEGAG@GDG@G@IAKAICIGGCGCEEGEKKIOIMGEECIIGEKE@CA@AAAA@@AAAACA@@DAB@BBB@AAAA@@CAA@@ABA@@@A@@@A@@A@AAA@@@CB@@A@@@@A@A@@EAC@C@CAAAA@ACA@@@AA@BA@@@C@CBEBC@A@BB@B@B@BAB@BA@@@AB@BCBAB@@ABA@AB@@C@@@C@@@CA@AA@AA@@BCCA@A@A@A@@@@AAAAA@@A@A@@AA@@@@AAA@A@EEGKAFEDADEBG@C@I@KAI@K@KDKDGDG@EACCGCGEGACA@@CDABCB@@@B@D@D@DBB@D@B@B@@@B@B@B@@CBA@A@@@AB@@@BBB@@@@@DCBABEB@B@BA@@BED@B@@@B@@DDB@B@DBBB@B@@BB@@BBNJHHRJHJDHHNFJAJEHCFGFKDIBMCEACAIEKIAAGCICICMGMGKCIAC@CAE@CBGDABCDGDEBIBEAGAEGCCCC@BA@CBC@C@C@@@@BBBA@BBA@A@ADAB@B@BBB@BAB@BADAF@@A@IBGD@@@BAB@@ABCBCBA@@BC@ABA@@BA@@BA@AAA@A@A@A@A@A@A@CCAAAAA@AAA@ABA@ABA@@B@BA@CB@@EFABA@A@A@@@ADCBA@CBA@AA@@AAA@A@@@AB@B@@@B@B@@@B@@A@@@@@AB@@A@@BABABAB@@CBA@@@ABA@ABAB@@ABA@A@AB@BA@ABA@@@AB@B@@GDAB@@A@@B@DAB@D@BBB@@@@C@@B@@@B@D@@@B@@AFAB@@@BAB@B@@AB@BBBA@@BABA@@BA@ABAB@@A@C@@@ABCB@@A@@@CBCA@BA@A@E@GBC@AA@@@@A@C@CBABABCBA@CBA@EBA@A@A@A@A@A@@AE@C@AAA@A@CA@@@@AB@@"],encodeOffsets:[[121749,41733]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("阿拉善盟",{type:"FeatureCollection",features:[{type:"Feature",id:"152921",properties:{name:"阿拉善左旗",cp:[105.666275,38.833389],childNum:1},geometry:{type:"Polygon",coordinates:["@@AEAE@G@G@E@GBI@IBEBGDEBCBEBEBE@E@GBC@CBEAGCE@EACACEEEEACECCCEEEEEICEAC@CCG@CCECKAECGIICCIGIEGAMGGAEAGAIAGAGCEEIAICICGAGCMAKCE@E@EBGFE@E@E@EBKBC@IDCBIBC@G@MCEAAAAGAACSCS@Y@eAEAAAEAIEG@@KIIC@@KAGBI@Q@EAM@MAI@IAI@G@MAK@M@M@G@I@K@M@CAK@M@MBGAQDM@C@C@GBGDGBIFCBGDGBM@EBEBIBG@GAE@CAEAE@K@EBG@GAGAIAEAG@G@I@IAKAGAK@I@G@IAGCEAICEAEAGCKEGCIAI@EAG@KCKAGCI@M@EAIAIAIEEAGCCAEAECGGCAICGEIAICIAOCMGSGQECAECIAKAI@EAKCCCCAGGIGMKEEq[aSMG@@@@@@@@YIGCE@MAI@I@AAA@E{CQ@IAaIS@o@I@WmYGEA@GAeA}EEíQO@eA»EQAđEEGUBMoCMEcGAC@@@AAC@CAE@AAA@@@GBE@A@C@I@CBC@@@AAA@@@A@@@AACAA@AA@@AACCCC@@AAAA@AA@@AA@AAEAEAC@EACAA@CAA@A@C@A@AB@@A@@@ABC@ABAB@@A@@BA@@@A@@@A@A@A@@@AAA@EAICC@EAAAC@AAA@@@@AA@GGECA@@AAAEEECA@@BAB@@iOEAICGACA[K­IA@EDSLQJKHOJABGFKFMJIFMHGFMFMJWPKFABOL}lg\\]VCBSNaXIFylA@MHIHEBKHCBGBOJKFOFKHEBEDIFGDIDEDCDEHEFGLGJILADEHAB@@@BABGNEFqh­QNË´GHEDCDGBODIBKDIBKDIBOD@@¥^iL±`C@UFQFWHRqNKDC@A@A@ABA@@@@@@B@HAB@B@B@B@H@DADAFCN@D@@@@@B@@AH@D@@BB@B@BAB@@AB@B@BAB@@@B@B@BA@AF@BAB@D@D@@@@@B@BA@@H@BAD@DAB@FAF@D@B@BAB@DAL@DA@@BA@@B@DAD@FAFAFAFAFCDABABABABA@ABAB@BAP@ZBD@@BHBHALALAJCHAHCD@BADAHBFBLBJ@NBL@F@JBJBHBF@DB@BBJDDBNFLDDDNFNFD@HDTHLFLDLFTFLDJDLFD@BBDBFBJDDBB@LBPFNFB@BAHBDBNFFDDBNFHDFFAF@JAJCJALCNAPCNAJALAFAD@JCJALAH@JAJALAJAH@HAFAJCFAF@DCFADADABA@CJADCFCHCHCDCFGJEHCFELEHCHCHCFCHAHADABEFGJEFEFCFGHGHCFGNGDCBGHGHCDGDADB@ð Ôü¦ǌĖDH®ÜÆø@DB@|V~V fXJ@BD@BDBBBBFHFLJRDHLTBDFNDD@D@F@FAF@FBBBFAL@HAF@LAJCLAJAF@DDHBF@FFJDHFLDJFPBD@BAFCFCLELCJELCJEJCJCLKXCHAFADEHCFIJGJIJGHGHGJILGHGHGJGJIHINUXILIHGJGJGHGJIJEHILGHGJIHEHGHDFDHFLDJFJFLFJDLFJDLDJBJBLDLBJDNDJBLDJBNFVDJAB@@@BB@B@JBxPXZDBDbBJDjBJBPJ|FFRVjtCHGXUz[ENOjW|GXOdAFIZSrENALf¢HXRlRnZFT_P[Pw\\dTlVTLbbRXpNfFRBB@¦FFD@DBdNPH^L~ZRHFBPvNfJi|IlIdLNBBP\\ÆlUNd_P®@BEfG~AP@A@AHCJCVtS^QZujYR_XONA@oqvininw~ADYuKN»MUAA@CVIhqnNlAT@TC`I\\Mj¡ÜUTmvSV_d©´qvYZICZoxGJiprcXo^KHKFk\\»GDubÁYP©ĘUnEJ_ObKXaDAIʔ©þefKXBƼHtAbFpJ`D¶T¸P¶P´V¬T´P^BJBIñCëĨQ¤MFAĪNĖH`C}Ǟż`VLSM¹E_AGCWYÙIsAIH}BSBGXáHmE_L]RgLWJWP]NS^wFIvZkdÏae¾­FKHKBC`y@AO²wTIgRKuJGVMFAacxgTMrcWWRC®GB@\\SPIòw®M¤M~GGjM\\YA@CE@EBEHGHAH@F@dPnsHEH@zE@KDEBEIo@C@CHGNEDEFCDGDCFEHEJCDEHEJCDCHAH@FAFCHAZBJBFBDDDDB@FAHAFELCH@F@¤]NCt[XIFAv@BCBEFGHA@G@ALyIAOCA@GAYF_F]C]AIAm@mlEFGDÉjMAIECEAm}H}DIAGGCGbLYX]RSPSHCHA^@îD\\]LGFCfCJBFBRPÜXÌCðQ IDI@@VDDGOSqucgGIICSKQIg\\ujH@DANAF@DAMD@^EDAZBXB`@RBN@@GEA_[Wg]W[u@AMK{SYQIQDKDA]I]QQICCGI@AIMC]VkDIGO@AOMIUBEDKLKXiaSEIOOGSBADQRULCd_ZSJCD@NB@EBC@ABE@EBCBE@@@G@G@EAE@E@C@EBE@C@A@C@EBC@CBE@CAA@AGAEEBIHGHGDGBABCDG@O@K@C@I@GAGAEAECI@CAAACAC@C@C@AAA@@ACFCFEDGFEFEXCD@DAJCHA@@BGCIACAACCAAEAAAAAAACA@@EC@AAACAAAA@CAACEAAKACBE@E@ABEBEAECEACSI@AQEKE@@@@SIEAgMEA@iSSGQIA@@CBIACGACAA@@AA@IE@A@A@@GCAAAE@@AA@AAACA@ACCCCAA@CB@BCBA@A@ACC@@BGBI@AAQBA@A@@DGBADCFEBA@@DGJKN_@@@@rYB@HCPCPCLCB@PEFCFAHCHCLCJAFCD@B@B@B@B@HABABA@@@@BAB@DBD@B@B@BCD@B@B@DBD@@@DE@ABCAC@AAEAAA@@AA@GCGC@@@@AK@CLW@E@@BCHK@OA@EAGAEC@@ECGCKAOBEBoSQMECKAU@iFIDOLqPGDIGCECEAAEIEEEGAGAAEECAEAAAEEAAACAA@AAAAA@AEEC@CCACAAAAGCC@CAAAAAAAGAA@CCEGAAAAE@EBEABA@ABACEEA@EBA@CCAAC@KACGA@G@C@CAAEECAA@@AC@A@MDKBOBEBSDABA@@BEDEBEBGBE@K@GBE@@BCBEBA@IBE@MBI@K@GBGBIAMAIAKAKBOCKAMCWCIEEAGAEAIBMCG@IBI@MCI@ECECCCCEAEBE@A@IBG@EDIDKBK@G@ADK@KAGAG@I@IBK@EFKFIDOCKACCEEIAICCAACAICGAGAICECGCECEAIBEFGBEBK@E@GBE@E@MBGBE@KBGBCBEBIHGHAFCDADEDGFCAIAGAE@ECAAMIEEEGCECEGGACIMEICEEGAGCECIAEEGAAGEA@EEEECEAE@I@@AEAAAACCACAA@EEKCG@I@C@CAGACAAB@DADC@@CCCAAAAAACAABABCBAHEHGB@@AAKAC@CAAGGCECCACAEAAI@ACCACKCAAA@C@IAEACCAGCCACCGBG@KAMGCAICCCEECCCEEGAEEEEECAAAEAGEEAECGECCGCEEGECEAAAE@GBEBCBIDCFIFGLKB@FBF@F@HBHA@@@A@AACAAGCAAAEAEACACAAAACCCCCACAAAECCACAGAC@AC@AAEACCCEACACACAECCACCCCCCACCEAACCCEAAACBC@A@AAAC@E@G@CCCCCCCACAGAEECAE@E@EACA@C@C@C@EBABCDABADCDCDAACAAACAAACAC@CAE@ABADCBABC@CBA@CBC@E@CACAGAAEACAACCAACECCACCEAAA@A@A@A@ABAFGDIDEBA@EBCAAACCCCCAACEACCCECAAECCAACA@@C@A@CBC@CBA@AACCAAAEACCC@CCACCACCCEAAACAC@C@C@A@CBC@CDEBA@C@A@E@C@ABCBAFCDCDADE@C@CBEBADCDADABC@AAEAEAAAACAG@EACAAAACACAE@C@A@C@EBCBCBCBCBCBA@C@CBE@A@I@A@C@A@AAE@G@EAAAACCAACAGEECECAAAGBA@CBCBCBA@CBA@CAACCCCCAAC@A@C@A@EACGE@CBE@CBCBABCDCDC@CBC@CBCBCBABCDC@CCAAAECCCEACBA@C@C@CACAACAC@ADEDCACAE@CAC@A@C@C@C@C@ABC@ABCBA@CBA@ABEBABC@C@C@AAAGICCEGACACACAC@AACBC@CBCBABADCBA@CBE@A@A@ABABADABABABCBABABCBGBEBCDE@CBABADCFCDCDABABA@ADA@AB@BCDEBCDCBC@ABA@@ACCGAAAC@@@@AA@AAGAC@CAEEICGAA@CGMACACEIAE@E@@A@EEIGKECAIEMEICKCKEEEEEACCCCCGECGACAEGMAOBGEQ@C@IAG@IAG@IAGAGBIAE@IBIDIBGFMBIDGDGBGDCFGHKHKJGHIBAHGDCDEBAHKFGHIDEFEDADIDEFGDCDG@GAECECEEEACACACCECICGECEIGGCEEICECGCECGAE"],encodeOffsets:[[108340,38851]]}},{type:"Feature",id:"152923",properties:{name:"额济纳旗",cp:[101.055731,41.95455],childNum:1},geometry:{type:"Polygon",coordinates:["@@UWW]Y]CCAA@AA@EEQW@@A@AA@AQUMMAAUWOQAAOOUWAAY[AAOQMM@@@@OOAAGIEEQSmGIA]EăeAAGEAAa[GE]WOMicYSAAAA_YóAsAI@[J©b_JA@¥b@@KD@@mNGDIAA}GC@cCāYK@SAs@eAU@M@eAK@Aw@}A@@aLA@KFACA@ABIB[JAB@@GBQFYJA@kNCBA@UHQFYJMDMFYHQFSHA@A@A@cNmPM@uCA@YA{C{CCCUMGESI[Q]SQKWMgYKEgYYQ]Qi[iFoHwHA@iFkFJQI@@@ĸÖÞKĊWTÍö¡ºAB¥ÑDƁZŽVěïŁFsB©BãFĝFAġECqQK@ACECCCGMWIOKSIBGBGDKBCBEBGBKDGBIBMDSFCBEJCFGLEJGLIPCFGLIPQ^ADOXOX@@A@eOgOgOUGQGMEWIgO[IKEC@cMCAcMUIOE@E@C@A@A@A@@@A@AAA@C@@@AAA@A@AAA@A@A@A@A@C@@@A@A@AB@@ABAB@@A@@BA@@@AB@@@BAB@@@BA@@@AB@BA@AB@@A@@@@@AB@@@@A@@BAB@@AB@@AB@@ABAB@@ABA@@@A@@@@AA@@AAA@A@@AA@@A@A@@A@@A@@@ABA@@@A@AA@@A@@B@@A@@@A@@@AAA@@@A@AAA@A@AAAAAAC@AA@@A@@@AAAB@@A@@@A@@@@B@@@@AB@@A@A@A@A@@@A@@@ABA@ABA@ABABA@@@A@A@ABA@A@A@A@A@A@AA@@BAB@@ABABABABCBCBABABCBCDCDCBCBCDA@ADEFEDADCBCDCBCBEDCBEBABABA@@@CBA@ABC@ED@@GD@@EDCBC@A@ABA@ABA@ABCBE@ABCBABA@ABA@@BCDADABABABABCBA@AB@BA@ABADABABABABAB@@ADAFADAB@@ABCD@@CJEVCTEVETEVCVG\\WEUEOCQCQEwMoM@@@@LMRSHINQJMHENQDANSNMDCEAOGKCSG@@C@QGWIkOA@gOSGQGcKAAÛ{ã}AzA@D@VAZ@T@@A\\@RA@RAP@R@RAP@R@R@RAP@R@R@PAP@B@R@PAR@R@R@PAR@R@J@H@NA@@@@@KDUHGB[JIBKBC@]DABA@YDIBA@QNEDGFIFABSPGFOLEDQNABSNCB]J]JUFA@eXSLIDYPhaRrABy`½ÀOPųŴcb@@ËÌGH@@@@@BBDBD@B@@@B@BAB@@B@@BB@@@B@@@B@@@@B@@@B@B@@A@@@A@A@@BA@ABAB@@ABA@AB@@@B@B@@@@AB@@A@A@ABC@CBAB@@@@AB@@BB@@AD@B@@@BB@@@BB@@AD@BABAB@BA@B@@BFDDDDBBDJFHFFFFDFDDDFDFFJFHHDDNJBBNJHFJHTPNJJHPL@@bZHFHFFDFFLHDDFD@@dZHHRLPNRNPLRLPN`XPLCFIPAB@@@BIRKRIRIRIRKRIRIRIRGLAFKRIRKRIRIRIRIRA@IRIPABIRIRIRCFGLIRITKRIRIRIRKRIRIRKRCHEJIRKRELKRMZEHELGLIRGLEJKVKTMVKTKVMVCHGNGNCHYjMXCFCHEHIT¶CHQ`EJcxGNSbABQ`@@ABsqc|KTWjWhELOZMVELCFCFELKVINEJWhCHCFCHKTCHGNELQZGREJMZGNA@³Ąe°M²M²O¶O®OIfC²Q´M²OZCK²MMLAK\\EªM¤K¤OM\\AjG|G¦OTB\\A¶ClC CL@E¤CAzCAVCEZACCnE¶G°GE`A°KtAĂM¢EÀGH@¬GdCJAhAEECEpCjAzCZAP@HAJBjDzLNTDTDdF^DRFlF^DLNô`nJxFxJ\\FxHXBp@ZAA^A~A\\@rA`CfAlAt@pCP@N@RAXAANNLNJHFJJLLNLLLHFRPT@\\@^A@jCfAf@Ad@\\AtA`@CnAjACX@h@p@ÞCjGH@X@OpIZCXEMlIvIÒ[|KlGxI¶WO\\EZGOVE^EMTERCOQXCQXIX@\\ApCVA¶IN@pEvCRAN@VAZCR@ZGHGVQRQLMTSTQNMTSPORQLMPQTQNOROJMRONKNMHINMJILMPKJKpu¼¿JOfA̖áüfæOpAÊAǸñB@AAG@GAA@CAO@CASCSAI@C@ECM@EAG@A@GCO@K@@AK@CAMAEGwFG@ADCDEHKJOBAHOXaBCBAHK@AHKBABCDE@ABCDA@AHMBAHK@AHKBA@@BALQBCV_BCBADGBCBABADEBADE@@FIBC@AHUDG@ABCFSBEDIDMDKFOBEBE@@@ABCAY@E@E@E@OBE@A@E@A@SA@BIAC@Q@W@K@A@G@C@E@A@W@CDKBGBOBEBCFY@CBE@CBC@AFS@GBGBGBA@GBEBGDMDODI@ADQ@EBE@EFW@@BKBCBE@C@ABCBK@ADQBE@C@@BCBG@A@@BAHEBAf_DAFEBANIFEGESSEEUUA@KMA@IIAAEEII@@GGCCGGQOAAQQ@@OOCCQQA@IIAAAAA@YKAAGCqW@AICAACAA@UKCACAGC@@SIA@EC@@CACAA@AAGCA@CCA@@@IEIC@@mW@@SIA@AAGC@@CAMGA@KECACAGCICMG[MCA@@AAICIEA@EAaWECMISMEACCOIAAAAGCOI@AECGCUOiYOK@@GECAAAOICCA@AAIEMIA@CCaUGCoYCAGEEAu[ECIEA@MGKECAMGCAOGA@YOMEAACAOGOGECOGCAOGGEGCICCACACAA@YKKEOE@@AA@@CAICEAKEKEGACAYKOEIEeOGCQGC@@AEACAGA[MGACAGCAAEAkW@@KEECA@@AC@AAAAGCA@@AUKCAEA@AGAGE@@MG@@YMOGAAEAAA@@CA@@AA@@CAA@@@IEaQEAAAOG@@IEIEICAAWKKEAA@@g@AOGSKA@SKGCAAeAAA@UKGCAAIEEC@@CA}eA@CAKGCAECOIQI"],encodeOffsets:[[104389,41689]]}},{type:"Feature",id:"152922",properties:{name:"阿拉善右旗",cp:[101.666917,39.216185],childNum:1},geometry:{type:"Polygon",coordinates:["@@ÅKGCACCCCAE¯ËÙIGSQGCKKUMUOSOUQSOYQOIA@CBABGHIFKHGDKFGBE@CAGAECCACBED@@IDEBEB]FMD@@CBC@IDGDC@G@C@@@A@@@AAAAA@AACCC@A@CBA@CBA@A@A@AAA@EAA@CECAAACCAACAAAAACC@CCCAAEAECEAECQM@@AA@@@@AAC@EAEDA@ININMTMXKPCHCFADEHEFA@EDODQFSFKDODGBOFIBGBEDGBGBG@C@CBI@IFCDCDADABGDMDOFKFIDKBIBCBC@GBEBEDGFKFEBC@CAEAGAE@C@EBGBGBC@G@I@IBGBEDEBGBE@EAE@AAEBCBCBCDE@EDCBABCDEHAFAH@DAB@BB@FBJ@JBJBFBFD@@DDHHDHBBBBBDBD@FCH@DAHAHADCJABCBCAE@ABC@KBFMBEBE@E@KAGBGDEBGDC@GBA@@B@BAB@@AD@FCf¨FNBHFHHTBFA@ABC@EAICWMYIOGKEgOYI{YMG@mGaEsIaGcGMCO@@@AFFLDRAdE^ADADADIH]LaPcNQFA@A@EECMIOEI@@CAEE@@CCG@EBOHMJGDSL@@OH@BCBCBABCDEN@PB^@NAN@D@B@BDDHHHFNLPNNLHHBDBD@DCDAD@D@DBDBD@DADAFCFADCFCFAD@BCFAH@D@F@F@HADCFCHCHAD@DBFBHBF@DADABCDAFAF@B@F@F@F@ǌĳCEBE@G@E@E@CAEAE@IAIAEAGCEAKAKCIAIAGAI@EAG@G@GAK@GAG@CAC@E@E@IBI@IBEBIBG@IDKBIBIBE@C@E@E@E@C@EBGBE@EBG@G@E@E@EBG@I@G@I@A@@@@@CB@@BäKBFWBĭpCFo¼ZADwLáXOFCB@@@@AB@BÛ¤çXZ[HéØL}NJmRcDoCM@CCEEeAYDA@KJGBEDCB@@@@DLFFLDPBRAJCHEFEJENCJ@RANDPDJBPHNJ\\NTFnPjRPDPFTJRJfNTNvThVTVl`B@@DBBBFDBBDB@@@B@RBJ@B@J@P@D@HBJ@P@JBN@HBN@NBJ@H@HBFBD@DBHBJDFBFDJDFDFBDBFDFDJHLHLHHFLJXRHDFDBBBB@BBFDJBJDHFR@DFTBBFR@DHT@BDPBFDLBFBDBHBBBDBBBD@BBBBDDBDDDDBBBBBBBDDDBBDBDDBBDDHFDFDDBBFDHFDDFDBBJDZLJF\\NDB^N`P^N^NXLHD^N\\NDBZLDB@@RH@B@ŢRJIlEjEB@xGpGjEj\\^RZRhZLFhZXNRL^T\\RTJHFVNDD|D|DZBB@vDN@nOdMB@B@B@TGREZGNENCZIREVGB@DAlMB@ZIREHA@@BA\\IJABAB@BDLEB@bK@@~Bx@BL@fBN@V@fBt@TBL@ĂZdDD@~HBBJHCnM@@LC@@¦aB@`Iªa\\IJ@tBôB`ZBBBBZTjdPN^XHFb\\BBHFBBĄf^FJBnHRTFFHJBBPP@@@@NNPRBBZ\\BBVXPPBBPRVXBBNNRV@BBBB@@@RXFFB@@BBBDDZ^X^VXRJPJFDDBLHDBB@~fDB@@FDJFBBHDVLB@BBfBBHDTLB@TLPH@Bh@@BBLFXLBBJDJFJF@@PHBBFBbRJF@@B@DB@@BB@@DB@@BBFBBBPHZN@@NH@@HFHB@BFBDBVL@BB@HDBBBBD@@BB@FDLF@@lXFBBBHDDBHB\\NHBDBFB@BD@RHHDfPJFPFZLDBHBLFLFFBJDDB@@BB@@PFLFZLB@DBDBDBJDHDHFPHDBPHFDPHPHDBBBNFZPB@PHDBNHDBLFNHB@JFFDv\\FBHFDBpZHDbVDDB@NJJFBBB@DDPJBBDBHF@@PLjZVPHDFD@BPJHDBBBBPJDDFBTNNJFDbXFBB@JFJDBB@@DB\\NNHJDHDDBDBLFB@NHDB@@HDBBB@TJ@@nX@@JDJF@@B@DDB@HDBBB@DBDB@@FDB@TJ@@HDDBDBVLB@DBBBJD@BrXHDBBZLB@BBBBJJB@RRDDPP@@RRBBRPHHDDHH@@JJFFBBJJB@LNB@VVFFTTHFEFMJABEFCBe`ABGFAB@@@BAHAD@@@DAFCR@BALAD@B@DAFADAL@@EX@FAF@FCR@BCJCPCNAHAF@HABAHAH@HET@BAD@DAF@DEZADAFAPAHCL@D@X@B@F@D@H@B@L@X@RBDAJB@@T@B@F@BAF@P@F@F@FBZAD@B@@AFAFEPCLCNCJAFETAD@BCHGV@BADEJ@@CFABCFABABADCHABADU`ADKRAB@@ABGL@BGLABGN@BCBAD@BCFADABGL@BGLABADWbGPABIPGLCFCD@BEHHxBFBN@DBL@@@LDP@H@BBH@FDN@F@DBJDTBT@DBP@DBB@HHBBÊwLCjQƼÅƺÏrQbLWPa`FIVmªėZOÂvaHC¼l[LELGp]dWqjoHIpwDYJZYruª³`cTUnuVS¢ÛNiJ[D_@SBSMkrmJgDUB@VB¼NLMvZBCx}jmjmrupB@PM`WZQviRYT]sDUDIBGB@@BOH}Fe@A­`OcVMkÅO[AAKMJcJkj{eIuMOEAQG}Y]KOGcMCAC@E¥EA@QAeEoMWaQaSKkUcSx[\\O`OESYQmQkGWe¡BKFMTqJYBEPcHWX{PiFM\\VyHWDGisQUEE{IAOAICiAICaCAYWwOIAA@A@@AOFKDMDMDMDMDKBMDOFMDMBKDMD[HMDMDSFE@GBEB]@QBiBC@M@OBO@M@O@M@MBI@I@@@KAG@EAIAKCCAāsÙs·eOE@mGAAOAMAEAGAC@A@EAECECEACACAEAI@CAEAIAI@EAK@MAGAI@GAE@E@ECGAE@CAEAEAGAEAC@EAG@GAGAG@OCIAEAICE@GAGAECGACACC@BA@@CA@@AåÁAA}gAAAAqAAAA]OYMoCAAAAACACEEEAECEAECCCAAIEGEECCAECIGCAECECGECA@@CCGEGCCC@AEEGECAACCCCEEEAACCECECEEEACAECGECCCCGECEECCAAAAAC@E@CAEBE@E@EBIBE@I@EBIBE@C@IBK@MDG@IBEBI@EBEBG@GBGBI@EBC@IBIDEBGBEBEBEBC@MBKBEBMBKBEBMDI@GBIDKBE@MBKDG@KDMBEBMBKDE@IDMBSBKBEBKBKDG@MBKDE@MBIBEBGBIBGBGBMBKBEBMBKBE@KBMBE@GBEBEBI@CBC@EAE@C@C@ABEBGDGDEBIFEBABGFGFCDEBEDIDCDEDEDIFKHEBKFIFEDEDGDEDCBGDGDGFCBGDGFEDEBEDGDIDEDKFIDGDCBGAEAEAGAEAGAKAQCKCE@MCKAEAMCMAOCIAGAIAGAEAEAECECIEQIIEECIEIECAIGKEECGCKEEAECGAIAE@GCGAGAG@@@KAIAEAKAKAIAIAKCMCMAA@EAKAMAQCKAE@GAGAGAE@IAKCE@GAGACAIAGAKAKAEAKAKCMAIAGAE@MCKAGCKAKCECKCIAEAKEKCGAGCE@C@A@@@EAG@GAIAI@KAKAMASAMAOAMASAIAIAI@IAIAKAIAKCICGAGAMCOAMCMCEAG@CAA@@A@AFEFCDCHGFEHGJIFCNMDCDAHIJGHILIJILITQPOBALKHIBA@@VGFARILIB@FEvW@@FC"],encodeOffsets:[[104525,39825]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("巴彦淖尔",{type:"FeatureCollection",features:[{type:"Feature",id:"150802",properties:{name:"临河区",cp:[107.363918,40.751187],childNum:1},geometry:{type:"Polygon",coordinates:["@@CM]NKWOD­fakEB@@KJA@@@@@DDJHDDBB@B@@A@CDEBYOA@INAD@BAJ@LBHBDDJDLFJDFBDBDH@NHFAPHDABB@@B@@HFJLL@FBD[DCDK@ED@DC@@DEFGFCBCBC@A@DHBJDDA@A\\IRALADCNMfCFEFFFCHFH@PG@@BBABF@@BD@BBD@DABAB@DBB@@ADFB@JADDDCDC@@BB@@BAHAHAFAFADAD@BBFBDE@AB@@AFDBB@@BCBC@@@@@RLB@DDFABDD@F@H@BADAFBF@HGD@BADA@CBABCBC@AB@HABABAFABAIEAAACC@BCD@@AB@RDDAB@RDAFRHFFLA@DR@@@BBRBNGB@@@@HF@LBhDhAA@ZBXL`@BNZBHED@BFA@BCDB@B@BBCB@@@BEFB@@@BF@BH@BD@@@BDFDFFFHHBBDHBHLZ@BJVDL@@DJBDHNBJRRFDBBJJLHD@BCB@ND@@PLFFJDDJDHBFT|HVF@XDH@D@F@LBD@D@FBNDEPHDMHA@ABCDGFAF@H@B@DHPBFF\\@@@@BBFFBFBDCB@H@D@FBP@B@B@@BDB@@@BBB@B@B@BBBBD@BBF@DBD@B@B@BBHB@@DADAB@D@LDB@BBBD@B@@F@B@BB@@BBB@DBD@DBB@B@@BB@D@B@B@@@DCBEBAB@FEB@@@BBFBDBB@DBFDB@FBDBJDFBBBB@B@BBDB@@@@B@BBB@BAD@B@BAFADC@@BAFA@@BAB@@AD@BA@DB@F@BB@@@BB@B@DAF@B@F@BAFBNFHBD@@BB@BBBBB@@BB@B@J@BBD@D@B@FBFBB@B@BABABBFBBBB@DDDB@@@@@D@@BBBB@@B@BBB@FBBBFBF@D@B@DFDBDBDBB@BDD@B@HBB@@@@@PDDBD@HDD@BBBBB@B@BBB@DA@@BA@ABADABC@CCEBEDCFEDADA@@@@BADAB@FAD@D@DBBBB@DB@A@@LCA@@AFKGetaKC@CA@@A@AHC@CBAB@A@BA@A@ACA@@A@ABA@AAAAABA@DCF@FBDAHA@CDAAACCEB@C@ACC@@AEAIAEAIAGAC@AACCECA@CAEAEACACCA@EBABCACACACAABC@@FGDG@C@ABCB@DAFA@@@CDGEABCRBBCD@BAAC@@F@CCHID@JGFEJIEABEA@BADBDAB@B@DC@AHE@A@@@@KC@AEAHBBCCCA@@G@ADCAAA@AIDSBEBC@AAE@AA@@AOiCBEA@CDICG@@JA@AAC@GCI@AC@@AGCEAACEBA@AC@@AGAANM@CAGGEBCCIEEAABAK@ACACC@G@IAC@CAA@CCEEAGCAECAA@@@@GIGCEGOC@CCCDEEDEAGDKAAAFEKEAEHCVA@CBEVIBA@ANE^CB@LBFBJ@BC@@AEIg@EBA@@AICAECOEGCGEEGIEAEIEGEICKIOICAIEIECA@ACCECECGEAA@AH@DCBADE@@DIBCNFDD@BFEHIBE@AGBC@IDC@C@C@ICYOCAAACCCGACCEAC@@@A@A@ABCBGDC@A@AA@AAKEKESICCIEAACACCECAA@AGICCCCCAAAGCGCA@A@C@MBA@EFKJABEDC@CBC@C@C@EAEAGACCKIEEACA@CECIAC@AECCCECGEC@CAMAEAE@GCKCEAECEAAAIGCCIIKMECCCEACAEAG@GAYDGBQDIBEBC@MBA@A@IAAACAC@A@A@C@EBIB@BCBEFCBABC@CBE@GAE@AAECCACCAAAGCEACAGACEKEIIUEGIKCEAA@AAAAEAEACAA"],encodeOffsets:[[109828,41564]]}},{type:"Feature",id:"150821",properties:{name:"五原县",cp:[108.267561,41.088421],childNum:1},geometry:{type:"Polygon",coordinates:["@@q_CiKICu\\oXFDFDDD@BDBJFJFDBPJLJJDHFJFBFJFFHHFHDPFFDDBBJ@@AB@FJhBF@@ADI@EAKAA@]DMF@BABUJAF@DUBGDBFLFEFBBLBHCFBFCCFDD@DPDFHHDHJ@@@@BBFDDBBHFFDDB@DBD@JBH@D@BDBDL@ABBBFFDJADHFBH@DMNBBBH@@BDB@FABDFBHD@BD@@BDJ@HBD@BIB@@DHCJ@DFBDAPj@BB@@BBF@BADAFCTBJB@BBCD@B@HB@DDADGAFB@BLD@@@@@BGF@BCDA@A@CBCAABB@AFFBIJEFIHC@GJDDE@@@BDABC@ADQAADFBCH@D@@EBCBA@AD@B@DCHEH@@ADBBBDBDBDADAB@FDBBDBDBFBF@DDBDFBD@BBDBHBJBFBJBF@@DD@B@DFADDBBCB@DGBCBEAE@CDB@BABBBBB@BAB@@@DB@B@BABB@A@AB@DGD@B@BB@@DLDsbHfEL@BB@KD@@DH@FCB@FAFAHHH@@B@fKFEFAdUBBBABADADADAHEFABCD@BADCJGJGJGDEFEDCBADCFABCBAD@FAD@DCFAHGACF@DE@CHEHILEHFD@DEHDFEDAB@@FB@DADGFEAACACDA@AC@ADCCE@A`@@@RDJFRBP@PAZBNGF@B@FAJDDBPJFDBFD@BB@@F@RHXHDAB@BAH@F@BECKAGACCCBCAA@EDGACDCH@FBTNHADBDDLFBD@@@BTLBBABBBDBBBABBDB@@C@AHCB@@CBADAB@DENFJDJDJH@B@B@FCDADCDCJ@@BBF@D@DBBB@DBDF@FDDB@@F@B@BA@ABAF@B@@CC@AABEBADAB@D@@B@B@DHDF@BCBBBHBFPFJLIPDBLGBM@KHKBADCF@BBDD@BFBJAH@FAD@DD@FcRCNKDEB@FDH@BJAN@DBBBJALGDEDCFEREHIDEBAJA^EJBVABABCB@PFJ@B@DIDCF@B@FAFEFALGFAB@B@`ADCHEL@JCB@HFF@DEDGLIDEHADAH@HBF@D@FCFEDBFDFFHBF@B@L@HAHCHAFBBBDBFBFCDAFALCPGJCJDF@FCFCD@JBFBFF@BBBFAFAH@HBD@D@B@F@B@D@FBB@DBB@DBF@B@BBD@F@B@FBHBBBFDD@H@FAB@J@FBFBJAHALCBAHBJEHKHEF@FADADCBADADB@@FFJ@DAF@D@FAFAL@DB@@@@FBB@L@J@FEFC@EDEBG@C@E@A@CBCDEBCBADEHMDEFCB@F@BBHDFDJHDBHFFFJBJ@H@FA@A@AIGA@@ASGIE@@KI@ABA@@BABABA@ABA@BBADCLEBAB@@AB@ABFAF@FBHNB@D@HA@ADABADAFA@A@A@A@@@@A@@@@@BBCB@@A@@@CE@AACAC@@AAA@A@CACACACA@AAAABCE@C@CBCBCAAaACLCBI@@@GGAAC@AACABG[KA@SKA@@@KDcBcDH[BGD@DEJMDA@A@AAADABBDABAAEB@A@AC@A@AC@@CB@AE@A@ABAD@@@BC@I@A@AAAC@AA@@OEGCeAGAAC@CC@EBYIE@EAAA@@@CBABCB@@@B@@@@@@IBA@E@E]PAEA@K@KA@GGD@ACABA@C@ACC@A@IIAMCQEG@Y@YAGA@@A@mGAAAACCAEIG@CAAC@OQCAOBCCG@G@IDCCD@ACACEAA@KDC@CFECCBCA@@@AK@AAC@A@@CIAAAAACA@GBAAACAKD@B@FE@AFCBC@KEG@GBA@BCACGCOAGAQEAAGCACE@CBWFADAAGCBAD@BA@@@ABCCIAABC@C@AACSCEEQOKDI@EAIECAW[ACEEECCI@CMOGAGESEK@A@GCGAC@E@M@QDMBK@I@O@UD_FGBUFEBABC@[NEDMLEBC@KBG@ABEAKAKC_IA@[KCAUBG@EBCBGFEDCBIDWJURABABSCMGAAKKACAEBCJM@EQMOECDIPGDIDMFEBI@ODKDOFKBE@GBG@IAEZKBssACI[CA"],encodeOffsets:[[110291,41813]]}},{type:"Feature",id:"150822",properties:{name:"磴口县",cp:[107.008248,40.330523],childNum:1},geometry:{type:"Polygon",coordinates:["@@BM@A@A@AACAcB@B@AK@ADCBBBB@@@A@AA@ES@AEBC@CAFKBALITIHEJSRMRE@CDYBaDmekEEMQSUyIMAGAaE[EUWHALDHIDALIGEGEECO[@@K@C@AD@BGBCACAA@ABE@CBAE@C@I@E@AEAEBCSEU@CESAMWKMIYMaQAA[eYcBC³_@HM@QA_@WAYACB]FC@NCBE@MBCBG@i[vhRJTLJDHJdhrvPTCHUC@@CJJïRËDÛWQOEAIAeDEDKH[^íC]@GBGDOTQTW^KZaDHHHJB~C~GBnDFJFNBÊiHCFEnkn@JB^B^D`EZEHBB@PDJBKz@B@HGBEHAFADu@EBWJs\\MD£^E@G@KDEFGBEBA@CCCCEAIAYAGBEDEBG@GBCDIDGFCFIDGFEFCDCHEDCFMFGH@D@DJpAFCF@LyFG@GFmtcOE@G@GBGHAF@FDFB@DDFFFBDFJFFFAF@HBFDFHDLBCFADAH@FFHFDHDJ@JA@BEDA@CDCHBFFJJH@FDHAHDHJFPDLAHCF@@H@@BFBF@HDFDDJDDBHF@DBJDLDB@BYRMLAH@HFHJDNBHAHDJBD@TFQhBHDHHDTFZh@eX`hG@HHADD@DFDFHDFFD@BCDC@CDFFDDBFHHBEFAJ@HAJFDDFFAFKDCDTFPAPAJATAR@VAXHFNhAFYDODKAO\\SwG@GGE@EFEBG@EHCDEFAFFP@R@dIBEDABA@CFAFCDCBA@AMMBKBCNIJEGCGCAE@C@IXILWGMIO@ABG@CHOBEDCBABAHAB@DEFIDAFAH@NANAJIHEHALAPBN@\\@BDHJJE@HF@PDBB^DTBRAJ@MOAAGBAMAGFKJGfEJGH@BA@EB@AAAA@@AAC@EAABECAEIIAAD@HCJAF@BECA@ADBD@BC@ACAD@DDH@D@@@FBBBA@@@B@@BB@B@B@B@B@@B@B@@B@FBDBFE@AB@DEEBA@DC@AAAAA@ADA@@AAB@B@BB@BD@@AA@@A@@@@A@@A@@@BCE@AECCAC@AACB@@AABA@ADAB@D@TAAC@AD@FAB@DB@DJA@@@D@@BBBAB@B@@@@AF@DAD@D@@@HC@BEDA@@BA@BB@B@B@BBB@@@BABA@ABB@@@ABA@AA@BB@@@@@CB@BABA@EAED@BA@A@IB@B@@@@DB@@CB@BA@@BA@@B@@@@BBBAB@BAD@@@BAD@DAD@B@@BBBF@D@H@F@FBFBDBDB@B@FAF@DBB@D@B@B@DDF@FBB@DADEBEBCB@@E@C@A@ABFJCP@@@J@BIBAHHADAhC`CJCxSTK`GMBUBUHGFFQTIF@FANCJADAJAT@NAL@VAZANAHABBbCJ@JIDAD@F@DCB@FEB@@ABC@AAC@@DCD@BAAAAA@CBADAD@B@B@@AA@@CB@FCBA@A@CBC@CBADCBA@C@ABC@EBCBADABCBCDA@AC@AACA@AAC@@DAD@D@NHJEHAFAF@FC@CJEDABBDCDCH@BAB@FAJ@F@FBFDDB@BD@R@D@BDBBB@FABAF@F@DAHAD@D@DB@@DB@BFDB@FC@@BBBBD@DALAB@BADAB@DA@A@A@E@C@E@EBABA@ACCBC@CDCDCAC@@BAD@@AA@A@ACCCBCDEDCBC@CDERALAD@DEB@@ACA@CDEDABEBEBCBAB@@@AEAMDAAICG@@XAAAYIAB"],encodeOffsets:[[109679,41526]]}},{type:"Feature",id:"150823",properties:{name:"乌拉特前旗",cp:[108.652114,40.737018],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@C@GAWIKGMKCGEGKKGAG@GDADSVABCFEFK@GBWBODST@LADCHDNFTBFCR@BGLCFQBSCGCEDQAC@MIUNSNOLWXKJCA_ZC@CCCA@CB@@EDA@AK@@DA@@BB@@BA@A@@AC@BABAEA@DC@gGYEIAK@CBCDCH@@KdEVANC`@DeXkVGDK@[DIBKBEDYLIHEFKZGRIRUPABIFMBKDE@CBIFOHWBQBKAKGGC]@[@EAE@W@IFKFSHK@GDIDKDUFYDuFsCQCE@CDCDCPBFFFBHBH@JGPKPMN@@JRcLFFTDBD@B@DADBBDJAD@B@@ABC@ABHDBBBCXEDAF@BDHDBBRFHBPBHDBDADB@HAH@LFD@DABEF@@E@ALCDBBBAB@HDBBBBBJB@DB@D@BBL@@B@@DBDAFDDED@LCB@FBBDBDC@DDJCH@H@DDPADBPRD@BB@DJHBFDDBBBBnHB@@@HBZBZ@H@RFNDJB@J@BDD@B@DABDB@BHC@HLBL@B@BF^O@F@FAB@J@@@@A@@@A@ADAB@D@@BBFBF@ZJFAD@@DBDHBfBHDPF@@BBD@BB@B@B@JAD@@C@AB@B@BBFA@@DD@@B@BBDB@A@BFABCBAACBBB@B@BCBINCFC@AHG\\dCdALC@@B@TLB@\\LAHDBBBD@BBHH@@J@DADKbBBBADAD@D@DDFBABB@BDBDBDBDBB@B@BB@@BDBD@BDF@@B@@@DAAA@@@@B@@@@@@B@B@BEBCBABCB@BGBC@A@GMEAE@EBBAA@@BA@ABKFCDAB@AAB@BABABAB@@AB@BLJ@@JFTH@BB@JH@B@BEBG@I@IAEEGECAIGECGCAAE@A@EDCFGNCFAB@@HAB@HOB@DFBBDBDBF@DBD@@RABAHETABDBCB@D@DAD@DA@@BBB@DAB@@@FBDBD@F@F@DAB@B@FABB@D@BD@BAD@D@JAFABADCD@BCLGHCBALEB@@@BADC@AB@BBDBJABAZLHBB@AB@@@B@FFBJFP@@FJAB@AFC@@HBBBBDFDDFDDBBBD@DBDDBBJDHDHBJHRPHHDDDBPLDB`PFBD@LFB@^FJBB@BBX@BBB@LAF@JAJ@JBLDLDDDDDHFFDFB@@BBD@DDBDDBDBDBDBHBFBF@BBBBBBBBD@DCBAJBD@BBB@DBNLAFJDJ@BDFBF@FEþoRIXBXBNFDDBDHADCH@DBDCFEFCFALDFBP@FEDALDF@P@VNDACG@GbET@AAEC@AHELCZIFIBCFGF@B@B@DADCDIB@NBAAZFrHCJJBpFBLDBLBXDTADFBBfABB@AD@JBBDD@JLHHHFDBBBH@D@L@F@DAB@BBB@B@DAHADABC@EBAFAF@DA@CBABADAJ@BAFCFCDEFAB@BAH@F@F@B@L@DAB@NNDBB@LABBDGEGKCKEAGDAD@F@FB@BBDDFD@DCFBB@HBH@B@FAP@LDHBFBFBDADAXBBIEE\\EFBFADABADBFFGBEFDDH@B@BBKPDHH@@GJB@LGN@HDHBDBFDDLFJLLIPC@E@CACBE@EBADGDCBCBAFEHBDBFB@D@BK@GBCDGJDFD@FADADBHAD@FADCBEBGJEHCJABAB@F@HB@@FKCOBA@G^OKI^BCwCkNKEMFK@@IXQPMDCGOJMHAHIKOCWXURIGGDCPBNAR@Z@BANIdL@XDNILAFJJPHAJ@HHBOLCNMJWG_HSDGBCFKbOPERYNERLFA@WKCHGCQAK][HW@AFUI[eGSCGIICDIICBGIKIB@@C@@@AA@CAEA@I@C@A@C@AACACAACAEAC@A@C@AB@@A@CBCBAB@BC@@@E@GBCAAAAAAAA@CAACAAA@CAA@C@ABAA@EAICA@A@A@AACAE@A@@AA@CAA@A@CBADAB@@BB@@A@ABE@@@C@AAA@CB@@CAA@A@GCC@A@A@A@A@AAAAA@A@C@AACA@AAA@A@A@@@A@A@@C@C@A@A@EBKDI@E@E@A@A@CBAB@@C@E@CAOEKA@AA@ABKAG@I@A@GDA@@A@AA@AAA@A@CAICOCEAKAI@aAA@AAKBABIDA@aAF[OECK@WDIDQHEBKFSJ@@@A@E@GAAA@EAAAAA@@A@EBA@@BA@ABA@A@A@@ACC@AAA@@C@@@AA@@CA@@@AAAE@@ACAAAAACAA@CAA@@C@A@@@AB@@AAABAA@CAB@BA@AA@AAC@@@@@AAAA@@CBC@@@A@A@@AA@AA@ACA@@IAAAA@C@AAA@BAAAGE@A@@@ABECA@AA@@@@A@E@AB@@AB@B@BAB@BC@AB@@A@AAAA@@@AA@@AA@A@AAA@@@AAA@@@AB@@A@@@A@@B@@AA@BABA@A@@BABCBE@@@EA@@A@@@A@AACAC@@@A@AB@BA@A@A@ABAE@@@I@@@ECAAEA@@@@AA@AB@BABA@@DA@@@ABABAB@@ABA@CAE@@XAH@BMRFCw@A[F@A@@D@AABCAAHSBGBAPiBCACECKC@ADAFA@@BED@DBVGDA@ABCAAACGEB@a]PMHFACEMCIGQEOCGACCGEGQEMGG@A@@FDDDHBFCFGBCAKCWCEHGJADGHEDMB]CEA@@[EOAUHIHMHQLCBGBEBQ@IAGHOFIEEGCK@CAACGGIKH@JBN@VCHGDABA@QBYEUDEBGBK@G@EDAHEFEDGDS@QASCDMEFILEDGBG@OAQCM@UDKFIHSNABGBA@GBKAICI@sLQF@@CC@CHOFGBEBGACCCIAW@GBM@E@IDQBSBC@EAEEICCAC@EDEDAB@FALDNBHCHEDK@KAKCGACBA@AJEHGJCBEBABG@"],encodeOffsets:[[111494,41546]]}},{type:"Feature",id:"150824",properties:{name:"乌拉特中旗",cp:[108.513645,41.587732],childNum:1},geometry:{type:"Polygon",coordinates:["@@DCB@FCPIJING@ECEPMDCfCDADCBQDCH@L@BA@C@WJOAAE@AEBAQABUFG@CGEBINECCa@IDCAGAOAA@C@AK@C@A@AGE@@@@C@C@QR[SA@ACDABC@CACBCL@BGIKKAEAAECEDGFGDAD@BAACACFABGFAD@BCDA@G@A@CACD@@SH@F@BBB@BAFBBCACAALG@@GAE@A@ABIBGDIFAHAFCDEBC@GBCACBEBC@CEHIDCHAL@@A@CEACAGAEFABADCDCHAB@FAFBD@D@FODKJIKKECCAEACCG@GHM@KIA@HG@CGLOAAA@G@CCFEHAEECAABCBEBEA[FFFAJWACBCBEAEAGAKCO@EBA@G@GAA@EACDC@CEAC@AEAE@C@CBBHLFLDFHCHAAKBA@CAMMA@CBK@A@E@E@G@ABA@EBCFEDEDABI@CBABAB@DCBE@EBAB@FADCBGBCBA@A@AAA@CBE@K@C@G@AACAGEGGIKC@ACIAC@@BAAeBAACESBWCKACAAKoEIADIqGYEBBMAA@CJCDCBA@A@E@EHADEJYJKDGF@BFDBBS@aF@HDHCBUMO@E@KCCBEFO@EAKCEBEDEFCDCAG@CDGBACCCMEWAWAQJýpEFE@EAACI@ICBEMKCAA@AAC@IAABCDC@AAAAAAAAE@EAGACACACACAACCCC@AA@@EAECGECCCCKCKCIAI@IBE@KBA@AAW@AAA@IA]EA@KEC@EA_OCAOKCACCGGQOIGGAGCICAACCCAC@AACAECCCCEAAAA@GD@BEA@IB@EO@IEEA@E@A@@BAA@GAYKABIBCAAAA@@BCDAB@@A@KFABGDKHADC@CDABEBIBC@C@ABC@@A@CAAEBA@A@CBE@E@C@CAEA@@A@CBA@AA@@CBC@CBC@A@ADCBAFSBGBA@QC@CAE@CACAAACEA@GPA@GB@@ADCFAD@D@B@F@DAHCF@FEDEFI@K@A@EA@@@@CAK@EBEBC@E@CBI@EE@@CACBABCDCBEBE@GFGLIFGAABKDGBIBEAEAI@A@EBG@C@ECAAGAEAA@E@C@AAA@E@CAA@CAA@EAC@A@E@A@C@C@GAG@EBEBAA@AEEEAIAC@EDEDE@ICIDOHKDEBCBEDEACAAAEAGBGDGBK@A@E@GAEEECCAEFEDC@E@GAG@CBGBCFKJCHCFE@GEA@IDK@GFCD_BA@A@EBKHEBEFEBA@E@CDCJA@I@OEA@ADABUBIA]FIBABCFGJQFEFCDCFKHIBAACAM@IB@ACG@EFALCDMdQ@ECCC@EBG@IBEA@ACCAAE@CDABGL@LANKHCAJOIKOEAEAGAAADE@GC@C@A@AC@A@CBABAFBBD@@DA@E@AB@BABA@E@@@CAECE@AC@CAACAC@E@AA@@DIDCBCDC@E@A@AIGICICMECFA@CBAB@DA@GD@B@DA@ACBAAACAAABAAASK@A@@ACKECCCAGBSMEAG@CDBDCH@FBBADDDBDBHDLAFE@G@ABA@CBWGQGE@@@AAC@AEECOICAICEBA@E@MHYAOBO@QAIEQC@@_@@BDFCD@BBDB@DCDBBBEFCHCBA@@EA@CBEFGCCFC@GEKFGJGF@DCFE@BDGHEBCDC@EBC@ABADEBCDABCDEFCFIHIHIHCDABC@ADEBGFCBCBCBABABAAcVEBEFeLA@@@GGBGBE@EDA@ECG@BCAA@AACAC@C@EBA@CBAB@@@@CBCBEFCDAFDF@DADCBAB@BAB@@CBA@AAA@A@AAAAC@GCC@CAOC@@@@A@GAA@C@ACA@CACACACEA@C@E@EAAAEAA@AAA@@@AAAA@@@C@@@@CACCA@AAEAAAABABA@A@EAEAA@C@C@AAI@A@A@@AA@AAAAA@@AC@GAMEEAABE@A@E@CBA@A@@A@@AAE@A@@CABC@@BA@AB@@EBAB@@CDEBABA@C@ABA@AAA@@@@@CAAAA@A@AAEAICCAEAA@ECCAA@CAEAAA@@A@EFA@ABAFCD@@A@A@C@A@@AA@A@CAC@CAA@AA@@AAA@E@@@@AACAAA@KCC@A@CBCB@@GAAAA@A@C@CAE@AAC@AAAAA@A@A@AA@@A@ACA@KBME@BEBBBBDDBAFXTLLFHHNPXBFbAFBH@F@F@^HRFB@BBDDDBBBBBBB@@@@B@@@BBB@@BELEBABC@@AAB@@ADA@C@OFCDEJB@B@HNJ@@DBDNFFBJDFBADABADEDRH|z¦LzBBQHUHGBK\\QfAHABOF_LIDJF@BA@@B@B@@@BDB@DA@AB@@@BDB@B@@@BBBABAB@@BDAB@DABAB@@AB@D@@@B@BB@@BBB@@@B@@B@@@B@@B@@GFBBDFC@FBFJ@D@BDD@FGFA@C@BB@B@BB@CB@H@D@DECCJEFD@D@@BCBADDDAFABEA@BADC@EBA@ADEGEDIBECIDQ@ADCDEFEDABCH@BJDT^^@BD|ªJf`JBJ|@@UWyY\\CHIPLKr@DABE@EAE@CDADE@EFAFABCBC@EBADCD@DADADG@CDBDK@CD@@@F@@DDAFCFEDEDCBE@CBAFEB@FADIHCDEDAFCBADCD@DCHAFABCAGD@HIDCHAFILEFKFBD@DADHJGJIBIFGJCPGHGHELALEHEDQFEHAFDH@HCJABGBOZAFGPUV]XMXAJ@^@ZCLYlGHCHAH@PIFGHKPAHFLCHGPGJAJER@DHJ@HBFCHD@HRdVJVnN~TÐfCÖƄDH@C¦C¤GCRAH@pRrB^P`PJHZB°DfHJªRNVAVCbOnW¸@¶F´F¾AVCM´UHAM\\CZB@BH@RGnK^S¬f¬j\\bH\\GZTbA²I¶K¸IEZAXAdA\\H`D^BJpSjITKJi@ABABAB@@AFA@@BE@C@ABA@A@ABCAA@EAC@C@C@CDCFGPM^aJIIT[fsPUzėA@DMDIC@mO@AWyCIQeIQYaKMB@]eCCoy¹OYICA@GAECGCOCIKAABIBGDAE@ECAA@@CCACCC{ICCCCAE_A@@IVCVCJIFCHEDEJQDGFGBEJON_~[j{@@WUUQGGTOROJGJIVSPKNI@ABG@ABA@A@E@AAEAE@CBC@@FE@CBABA@CBABA@A@A@AB@@ABABAFAF@DAVKDADA@@BA@AA@@ABA@AA@@AA@@@@A@C@C@@AAA@AA@A@AAABABABA@A@@@A@AA@@CD@@@BA@A@ABAAEBAB@@A@A@A@@@@@A@CAA@AAA@ABA@AA@FA@@@A@@@AAA@@BC@@@AAACA@A@@@A@ABABIA@DADC@ADEBC@EBA@AACAA@A@A@ABA@A@C@C@CAEAACCCEAAV[B@NGVgQQzM¸BA`Cr@ DB@BIBIHMDCGEIEGCE@CCECDCBC@ECI@AAE@ACG@ACAEDCAAA@C@A@AACACAACCGCG@E@@ABEDC@@"],encodeOffsets:[[112261,42423]]}},{type:"Feature",id:"150825",properties:{name:"乌拉特后旗",cp:[107.074621,41.084282],childNum:1},geometry:{type:"Polygon",coordinates:["@@GGIAK@KAG@[CSAK@QAEAKBK@ICWKEG@AOUAE@@@AAGAGCEAI@A@IBGBE@CACBCFK@A@GAICGHA@GIFGIAC[@M@OAKBGBGFIJMBMBG@EBCBEJCFA@GBABABCDAFGP@DAH@BJPHNKXWJ@J@DBFHDHDIFMJADALNN@BABCDEDEB@DABCBAFcJQ@O@EEEBCFGD@FAHEF@FHF@HxH[TBPCLCPEZgBEMWGUBQ@SBIBOBOBSEDCLCBEEECCIEGBI@EBAFGGAECCEEDCD@DC@AECCEEGEC@CCCGB@GgHW_@fYgSEGCCGAGRgSEC@IAGCGBMAICEG@GBGNKZQ@ACACKAI@CGECAICCCCE@GAEAE@@@GE@GDKBOCIECGBGCG@EIGEIAEDGDCB@FC@AIBI@GCECEG@EBGBCDEKAGCCEAE@GBEEEIECEEAEECC[ZiNH}H£N­NñxOJ[TA@­HQDXXqdSNwhdbEBUNIHvQLhSJ±xP@B_zADGLEL½®bfcÐYluEJ]xMTO^IXKXQhK^F`GnWâAHATG~BJJtZÚDXBHF`NºKTHFF@¶C~\\fTtT@@h^¤j¨nĖ¢BBdf^nTŦD@jNĘ¤dlPÄnǔÐxXŪ¢NF¶f~VNDƔ¢Ƕl|FhFF@ȀDGAE@GGI@CFQBIHIHODGEKBGLOHGJE@OBGDGHGZkDK@Y@]BINW^WVUHOBEPYHABADI@GCGBEFGREFCFGBKFKHGHGDOHIJEJAHIGIBC@CACLEFEJKBEDGJC@GHCDBBABEDG@CDCBCDABEFCDCJGBC@EFABEDAF@DAFCFCDEBECC@@@E@@DCL@ACDCH@BCBC@CDCBCFAD@DABABEFEF@BCDCF@FBF@BA@CLqKJODGZ[zXV@@{IIAe_©IC{@A]]CSI@ADGBAFCFEDCBCR@JCFDJAFCFHBCB@FAD@BC@AFBBABECCBCDA@AC@C@FEDIFD@C@C@GDAA@@A@AAAD@B@HE@ECC@A@CEIEAD@CEAAHE@@@AA@@@A@@@@A@@AA@AA@@A@A@@@CBA@@BABA@CBAAC@@BABAAA@A@@@ACA@A@@BAB@@CCA@A@@@A@AB@@AIEJC`KPEBABGReL[HAVGRGAAKyy¥{QGFCBCBABCEAICEAMEAC@CI@GMA@A@FIDCPED@B@BC@@BA@BD@BAFAFK@AA@AA@@A@@@@@AAAAAACACCAAA@QE]GE@E@G@EAaBAEOWGMEGKKWSBECAACAAFA@A@ASKGBCBBDABG@@AEACCAE@CAEEGOIG@AAA@CDG@CDI@CB@DBF@D@BGBOD[BGAC@EBCA@ABE@CC@A@EAA@AHAAGA@AA@A@AAA@MK@AAA@@@AGICEOCQAKCOCKKCEACCIBEFIAGBC@AEBEFCBGAEGAACOAGCACBCDABCBEDE@C@@GEBG@BD@JEAE@C@EBE@A@@@C@EACAGEIGIGICCAAEAECEB@FCLETK@MHMRUcQSWEMCKAEAEA@[M]KiKS@@@@FNKBEA]@B@C@GGICKCEACAI@MAE@KEGAUAWAYAMA@@E@AAC@CE@GDC@C@ABEC@@C@GDE@@@@AACCCACAAAAC@GGB@@YL[CCCSGBWLSP@HBO[AG@@EM@@@@IIOGGCI@K@SAC@A@KCIC@@OEQC_GCEGE@AAACAEEMGIICAGCADA@A@A@ABB@ABA@@AA@ABBBCBMEWMiS@AGAEEKCGEEE@AAB"],encodeOffsets:[[109265,41836]]}},{type:"Feature",id:"150826",properties:{name:"杭锦后旗",cp:[107.151245,40.88602],childNum:1},geometry:{type:"Polygon",coordinates:["@@YJQFQNITGFSJKJABELDBD@FA@BFTB@@B@B@@AAAACD@BBLA@A@BdBD@B@B@BANBAZJBBWB@@DHBJCBBNBF@@A@ABADAFAFCBCF@DDB@BA@CFC@KBQBCF@DADCDCFADDDBDB@B@@BC@AB@@BDCDCD@DADDD@BABAB@F@F@D@F@B@BCBA@CBABA@KBCBC@AAAA@@EDA@EC@ACA@@CAC@C@GBCBE@E@ABEBA@AAACC@Q@C@@ACAECEAE@I@EBA@ABG@CDCDAACBIF@DEDE@EBGBIFMGC@C@CB@@BD@BDBBBD@@BCBADADCBABAD@FAD@B@DABCDAB@DAD@D@BABEDA@@DB@@BA@A@C@CBAB@DBBBBABC@CD@@BD@BAD@BA@EFA@CDE@C@CBIJI@aDAAGBMBYBUBK@MBS@IBCBIBMDEBE@SJERHEVGVANA_HSLwTID_DgDCBGBBGJA@A@I@@DOEIBAB@D@F@@@DAFAFABC@CAA@ECE@C@A@A@CAA@CBE@E@ACACAEAEAE@G@C@E@AA@AA@C@CBC@AB@@C@ABA@ABAA@@@@@AB@@AB@@ADA@@CA@@@@@AJAB@B@@AFCFBB@BA@ADA@@@@A@@ABBB@BA@@A@BAB@BA@A@@AA@A@A@AAAB@@AB@FC@AGD@@C@C@CBE@@B@@A@A@ABAA@@@C@@IB@CCAA@EBC@@BBDSBC@A@CB@BABBB@@DABBD@DBFD@BDF@A@@@BB@@@@@@BB@@BC@@AAAA@A@BB@@CB@BBBBB@BCDB@FACFA@@BEFCAEAA@@@@A@AA@A@A@A@A@@AA@@@B@AAEA@@C@G@CCC@DB@BADC@CA@BDBAFE@IBGDC@BBJJBFFDBAFBD@BB@@BBBBA@@FABG@IHeFIHELBHBNHABBNPI@QBSA]CAAOCE@GBDHBJ@H@BELADBD@DAFAH@J@BBJDFBHBH@B@@BFPV@BFHXLJDL@LAFBRBL@TB\\DH@LBL@JBHHBA@BFFHFLDFFHB@BjTXNNFDAAABAB@@BB@BAA@BAB@B@B@BCHDDBJJNHFFDBBB@BHFDF`HRDPF@@JDLDB@D@TBL@J@HDPHJJ@@@@FN@@BHP\\GAO@KTAXTHDD\\DZK@@HA@HBDBBDBDBDDBB@@@@CF@H@DD@AF@B@DCD@HDFD@BBF@@@NBZBXBVBHBLFF@NBJ@DBFBLDJDHHD@A@^@FBLAEM@@@@TjL^L\\NB@BFBFDLFNTXdRQVGN@NSLKFEDA@DFBFBFDBJDJHJHHFDBFBD@@@B@F@FAD@F@FB@IACH@FA@HD@F@FCDABADCDADBBHDPBBFHHBDAFEFA@BADBHEJAFDJBDDFLLPDLDRBPDDFHJ@B@@BB@BNLB@BBB@B@@BHBBBBGB@FBB@D@@DAF@BDBFAD@HB\\APCHA@A@CAE@CDAJ@DCH@DCB@BBH@PJFHBF@DBFDDFB@BH@BAACDAHATL@BNFLAB@@@@A@AAO@E@C@GDAACAEEEAA@@@@E[AEGO@C@A@GBEHEDCBAB@NGGCFOMCEAC@C@KAE@C@G@WCE@GUS{AECGCIICEEOK@@MCA@ADC@KGIIAAECQQAIGMACCI@@CKIU@AKYAGCGAAGGEECECE@A@@ACG@@AAE@@A@FE@A@@DAAAA@A@DC@AEB@AFCAGMY@AK_AW@YBBCgAg@KE@G@@A@MHQAAA@@Q@@CKBEEQGBEQCA@CBQCA@@BC@ADD@BDBBJFABEBABABGBA@@BADADAB@DCBABC@GHE@EACBABG@E@C@ACEBCCA@QK@@@@D@DA@AA@CABE@@BAF@ACAE@ABCBCBEBEBGBG@AA@@AD@DCCCBC@IEABC@@AA@CBABA@CAC@AAC@@AEAB@AH@@OEGDGEEFEDENeDMBCBKJQB[B@CCAICGB@D@DADAHEFE@CD@@CFCL@DC\\CAC@EKKEI@GA@@@AACBOGEBMGG@ACACCEEICKCIACAG@KBI@ABCJMB@ZPFADCB@@@@AAACCIGCC@@@@B@LI@@FADAHJBACCB@FFHC@ADEEAAQBEBICGAIDI@EEKAG@KhOpWBIKKIKEMKSC@ALEBGCGIKEAACEEK"],encodeOffsets:[[109732,41431]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
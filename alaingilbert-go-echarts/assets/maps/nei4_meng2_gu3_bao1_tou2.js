@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("包头",{type:"FeatureCollection",features:[{type:"Feature",id:"150202",properties:{name:"东河区",cp:[110.044106,40.576319],childNum:1},geometry:{type:"Polygon",coordinates:["@@EPKRABURMHA@SHOFWBGD@@C@CBC@@@AAC@CAIQASEGAICI@GB@ECEAGA]GIBIRGAYAYF@A@@MDKHBDAD@BIBUJI@E@CGMGME@@QNOHKCIIGEOBa_AB[LUH_JEFFF@@H@J@J@PBD@D@@@L@@O@@B@DE@AFAAAB@L@@C@ABCAAD@BB@@D@@A@@@@DA@@@A@@@G@@BAB@BD@@BFABABCBBCB@B@@HA@AB@@@DB@@^G@DEJA@@@@@A@ABAB@BA@@BB@AB@@@@@@AB@@@@@B@B@B@@@BAB@B@B@@@@@@@@AB@BA@ABB@@@@@@B@B@@@B@@@@B@@B@@@@@BAD@@@B@@@B@BBB@BB@B@@@@@BB@@DD@@@@DA@@@AP@@F@DHGFCB@FEDADAB@D@TDJBHBFABBD@FBAFDFB@B@DABCDFD@@BBB@BDFB@BD@@PdFHQLJR@FINBJFTBERRBDNVCNBFB@A@C@A@@@A@ABABAB@D@H@D@HCB@B@B@BBFDDBHDBBB@@@B@@@B@@BB@@@BB@@@@BB@@B@@@@BBA@BBABBB@@B@@@@@@@BB@@AB@B@@A@@@@@@B@B@@AB@@BB@@@BADB@AB@@BB@B@@@AABA@@B@B@B@@@@A@A@@A@@A@@B@@AA@@@@AA@A@@ABA@@FCDANGDAJCDCB@BAB@FCB@B@B@DABA@@B@@@B@B@BABAF@BA@@@A@@@@@A@@B@@@B@@@@@BABB@@B@BAB@AAAA@@BAA@@@@AB@@A@@@A@@A@A@@@@B@@AA@@@@@AEB@A@@@@@A@@B@@A@@BA@@B@DBB@B@@ABAA@@A@@AA@@@A@A@A@@@AA@CC@@@ABE@A@AAAB@BABA@@@AA@@AB@@A@@HAD@NPhDFB@ABBB@FCB@D@D@B@F@BB@@BBBB@@DDBBB@B@BB@@FADBB@BBB@D@BB@@B@@@BA@@D@BAB@D@D@DAD@B@B@HAF@FAD@H@@@FBFBB@FBB@B@DALAB@H@D@B@B@@@AA@@AC@A@@@A@@A@@A@AA@@@A@C@@@A@IIA@AACDA@C@@@EC@@@AAAC@AAAAEAA@@@@A@C@ABAB@@@BABAA@@ADA@AA@@A@@@AB@@AAAAABAA@@A@A@C@AAA@ABCAA@@BAB@B@@A@@@ABA@@BA@A@AAC@ADA@AAEB@BAB@@@AAA@@@BABA@CBG@A@AAAACGG@A@ABBB@BC@CDCFCACACAEEECCCAA@A@AC@@A@@A@@BEO@SIZ@GEAAMGEDGBG@A@A@CEACCEAC@CAKA@MMECA@A@A@SD@GCA@ACAC@CAC@C@A@IAE@@CAADACICBCIAAACCEEIACGUABADAD@H@BHJBD@F@FBBBH@F@DABO@DR@PSJWF@BEQE@IIAB@AA@GJA@IJGGCCA@CAA@C@A@MH@@"],encodeOffsets:[[112897,41486]]}},{type:"Feature",id:"150203",properties:{name:"昆都仑区",cp:[109.837707,40.642578],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@MBAA@@AAWBECCAG@I@AHSD@FC@C@GB@@QBUBKBC@CBG@I@K@G@G@G@C@]BK@K@I@E@c@A@BHXPBT@DEB@JB@@DFHTDB@@@DHDHB@VF@@D@KHGRDBEA@DDL@FAFGNEHABA@CFADBDHDBBCBEBOBIN@JBDFF@BJBJ@LBFBPDJDDBB@B@BBB@@B@BB@HCB@J@H@LBBAB@@BLBPFDBF@D@@@BADAB@B@F@F@J@LCFAB@B@D@D@@@@B@B@@@B@BBB@BDBBBD@B@B@BBBBB@B@B@B@D@HDB@B@DB@@DAB@BBD@@@F@BAB@@@AA@@BABCDAB@B@DBB@@BB@F@DBBBB@B@B@JDFBB@AB@B@DBB@DBBDBBB@DBBBBBBBBAD@H@F@@ADA@ABAD@D@BA@@B@D@BBDBFBDDBDBBBD@B@D@J@B@BF@DBB@@D@A@DMREJGCMJGH@FONKJ@HEJ@HEAGHS@EBCCAEIGCBEE@CGYGFA@@QB@@EB@A@@HWA@B@BABKJI@@D@@ED@@@@@BC@@REAC@A@AODBA@CH@@CH@FAB@@@@AAG@@@AB@AACBEBEB@@A@@A@CACAB@A@G@@DABA@@@IG@GB@@AI@IAC@G@C@@@IAG@A@C@@@A@A@@A@EE@@K@@@@E@E@@@@AK@@@IB@HAB@@C@A@C@@K@@@AC@@@C@A@A@C@@@@L@@CD@@EA@K@C@MB@@A@C@SB@@@AE@@A@GAC@@@A@E@A@@AC@I@AAAA@A@@A@@@@@@@@@AB@BCE@@A@@@@B@@@@@@@@@@A@@A@@E@AQAKBEMA@@DB@@B@@@D@@GBAGK@CDGB@BBD@BABABA@@@A@@@@B@@@B@@@@@B@@@@@B@B@@AB@B@@@B@@@BBBBB@@@BBBB@@@@@@BABAB@B@@BBBDBD@@@H@DBB@D"],encodeOffsets:[[112410,41585]]}},{type:"Feature",id:"150204",properties:{name:"青山区",cp:[109.901572,40.643246],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@@@ABDE@ADG@@HSBEHMFI@@F@BC@@@@H@BABADABABAD@@@D@BAA@DAAAB@BA@AFCAAB@@AAC@@B@@AF@@A@@D@@@TANAB@F@PC@@HAJCD@@@LCD@@@CA@@A@@BGB@@@ADAAAADABEB@AC@@ABA@AH@@@B@@@B@@C@@B@@@@CA@@ABCDBBAD@@@@KBABBBEF@@C@AP@@@@K@@@CAC@O@I@I@GE@EA@@@CBWDy@@AQEKCMGGCKGEAKGGA@@WAQ@SB@FCHBPB`B@P@@ER@@B@L@B@F@@@B@H@@@@@BF@B@N@J@R@BJCFFLDB@@BBFB@@B@B@ANE@@B@BF@ARGAAFB@AF@@@@KBC@@@CDCBKFQHC@OB@FC@@DBP@@@D@B@DA@GBA@@J@@BL@@@@@F@F@@L@@@FFB@@@@B@B@@@D@BBH@J@@@D@HBD@JBJ@@HAH@@J@@ABCB@@@H@BBABD@D@BB@@@FAFADABBA@@B@@BH@B@@A@EBG@@DG@@DABPC@B@BBDQF@@AD@@@@C@@FC@@@IJALABA@B@GX@@@BFA@@RA@@NA@@PAB@@@ZTFBBBjEXCJ@NATClGB@ZERCdVHTV@ZUBAHELBTBN@DB@DKCQ@EAKECA@CCECOIACceKSCE@@KUACMWUMA@EHEF@@CPA@@FEAGDG@CBICAMC@C@@@GLGF@@QKGDA@OM@@A@CEGGGGACFAACAAAAAA@A@@@@@@@@CGACEDA@UL@@A@s^MFABGD@@A@EGC@@@"],encodeOffsets:[[112525,41619]]}},{type:"Feature",id:"150205",properties:{name:"石拐区",cp:[110.060254,40.681748],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABCBA@A@A@EDA@ABA@CDIDCBMHCBED@@AB@BB@B@@B@@B@@BA@@@@BB@@@@B@B@@A@A@A@@@ABBB@@A@A@@AA@@BCAAB@@A@@AA@@BA@A@@@@@@@@BA@A@@BA@@A@@@@@@@AA@AAAB@AAB@A@@A@@@AA@@@@AA@@A@@AA@@@A@@@A@AAGCCAECAAA@A@A@GDC@G@C@A@ABAB@B@B@@@B@D@BEAMAUDCMQAFQSAIEMAEJQ@KIGRcEO@@CD@FLDDDGACB@@CAGBACA@AAE@E@ADAA@AA@A@@@A@AAABA@ABCCGFVNNXBDLV@@DFLTdfBDPJFDDDB@FDBL@FDRCLA@@@jBCH@THPEj@^AXFVHHNGVBRBH@N@@@D@D@FBB@B@BAHCFAB@B@B@JDFBBBFBD@B@DBDBD@JBDBD@D@B@LDBBB@DB@BBBD@BBDB@@@BDDBBDF@BBFBBB@B@B@H@B@B@@B@@ADABCD@@AB@BBD@FABABA@@@ABA@@BFDZ@JFFVHJITNLF@HDFAFCH@PKFCBEHALGBBJFDBB@BADEZIB@NBRG@GDEJAJB@DCDCDBBHANDHAB@DBBDBBRC\\JNFHEHMD@LDJ@NGFDDBHAJKTGLF@@NADCPDJDHCXC\\RH@B@@CBABELBHERIH@JEF@NA@ADAC@A@@@@A@A@@AAAAACBCAC@@DC@CB@BABADC@@BC@AB@BA@@AA@@BAB@@A@@@A@A@@EBA@G@@@CACAA@GCGAAACAKA@@CAEEC@@@A@A@A@@BADABAH@@BBAD@D@DABAACC@@A@A@AB@BC@A@A@A@E@@AA@AEAAAAGGCAACAAEA@A@G@CAA@AAAA@@CA@CA@CAAA@AE@@AAAA@A@@AAAAE@A@AAA@AAA@AAAAAAAAA@A@C@@AA@@A@@BAB@@A@@E@EAEAAAECAA@@BA@@BABCFC@@B@B@BB@@F@FABABADABADADABA@CDCBABA@A@@IIAAA@CBA@A@ABA@@BCA@@A@AAA@ABE@C@@A@@A@AAA@@@@AAA@@A@I@A@AA@@@AACACAAACAAAC@GAA@AAA@C@@BA@A@C@A@C@AAA@AB@@AB@@AB@BAB@B@@AB@BCB@@@F@D@B@B@BA@@@A@@@A@ABA@ABABA@CBABABA@A@ADABAFADCDA@@@AG@CAAAA@@@@@@A@@@AA@E@@@A@AA@AA@C@ABA@A@ABC@A@A@C@CB@@ABAAA@CA@@AC@AAA@@@A@AB@AABABCBABABAB@B@@CB@BAB@DCB@B@@@BB@@BB@@B@D@B@@@B@@@B@@BB@@@B@@@D@@@B@D@@@B@B@BAB@@@B@B@@@B@@@B@@@B@B@@ABA@ABA@@@ABCB@@A@A@@AC@@A@AA@@@@ACAC@E@@@AA@@AA@@@@A@@@@AA@@CA@@AAAACAA@A@A@@@A@A@@@A@A@C@G@A@KBCBA@A@EAA@EAEA@@G@C@EBE@GBA@A@C@CBC@C@A@ABC@@@AB@@A@@@AAC@A@AAA@CAEB@@AAA@A@AACC@@AAAA@@AAE@A@C@C@A@EDA@AA@BEAgCMOC@GB@@@BA@@BB@@B@@ABABA@BB@B@BAF@B@@DDB@@B@@@B@B@B@@BB@@@BB@AB@BA@A@CAA@@@AB@@@BA@@@@B@@@@@BFA@B@@@@BB@@@A@@B@B@@@@B@@@BA@@B@@B@AB@@BBBBA@ABA@@@AAAB@@@@A@@@A@@@@B@@@@@B@@ABE@ABABA@A@@@A@@@"],encodeOffsets:[[112914,41602]]}},{type:"Feature",id:"150206",properties:{name:"白云鄂博矿区",cp:[109.973803,41.769511],childNum:1},geometry:{type:"Polygon",coordinates:["@@F@LBB@FBPBD@B@BBDBB@D@BBF@D@F@D@H@D@FAB@B@B@B@BBFBB@B@D@D@DAD@DAB@HADAHADADAB@D@B@JCB@@@B@F@B@D@JCF@BA@@@ABC@AA@@@@A@M@@@AA@AAAAEABA@ABAA@CECA@A@A@@AA@@@A@@@AA@@@@A@ACA@C@@@A@@@@B@@@BA@@@A@EAC@AAA@@@AB@DE@@@A@AAA@A@C@@ACACCEAA@@@@@A@AAE@AACEACAEAEAE@C@G@CBBA@A@@BAAA@A@A@AAA@@AAC@AAAAAACGAEAEAAAGAAAAAAAAAC@AAEAGAAACAA@AAAACAIGQCEAA@CAE@C@CCCCGACACEIAC@A@C@A@@A@@@ABEDEDGDC@CBE@ABKFEBABADA@@@C@A@@BC@CBCBODEDC@GDEBABCBEBCDCDCDEB@B@@@BB@@B@@AB@B@B@D@DAH@B@@ED@BABAB@BAB@@AD@B@BADAB@@@BADABCJABCFCBCBEDCB@BCBABIDA@EFCBABEFADCHA@@@@B@B@@@DBB@B@@@B@@@BABAB@B@B@DAF@FABAB@BABAH@B@@@HAHAFABCJAJ@DABAFAB@F@F@DADAD@BAH@D@HARE`EVARCNALBDLPLPhzJPTIM]QaGOO]pFKNY`@D@DARADAJANCBAFAB@@@LBJBF@N@@@BBB@"],encodeOffsets:[[112589,42822]]}},{type:"Feature",id:"150207",properties:{name:"九原区",cp:[109.967449,40.610561],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@@@A@A@A@@@@BA@@@@@@BAA@@AB@@ABABAB@@@@@B@FI@C]HC@KD@@C@IDGB@@ODE@A@MBSB@@C@@@@BE@@BA@@@BD@BA@BBED@BABA@BBCBB@ABC@@@C@ABABCBABABG@@@@@ADE@@@EJGNAFGT@@CH@BCFBA@@F@@@D@FHB@@@HCBANEt]B@@@VKB@FCBDDH@@@@@@@@@BBBBBBBBDEBBDHHHHDFB@@@PNB@HCRL@@HEHK@@D@D@BNJDDAH@HCFB@EB@DO@@FEFGB@HEDDBAB@BABBB@@@B@B@@BBBBCF@F@BBB@BDHADB@@DAHBCCKC@EDCACA@CE@AAA@AC@CEADCBA@A@CEBEEAC@AAEBGAIASCC@A@CBCBEFA@EDGH@C@EO@@B@@CB@@@@CC@@AA@@@@A@A@@AAA@A@A@@@A@@BC@A@@@@@AA@@@@@@A@@@A@A@@@@A@BAB@@ABA@@@@@@@@@A@ABA@A"],["@@GAsSCD@FGAE@]MUGKDABKA@@@I@@CMCCEGMCOD@BMHKJAB@J@FOHMAGAQFA@QNMNAHA@BDIV@ROLCB_EA@KGQAUNYPA@GBUOEEQAEAMCMKKMKKIMQBIHMASBKBBCC@uDG@M@QMGEONb^A@HFBDBBAD@BCBUHCAC@AF@@EBCB@BLDFDBDADOjABAHGTBBADBBC@@@@B\\E@BDxQEANG@WB@@BF@DAB@BA@ABAB@B@@CB@@ABABA@@BBB@@@@FBBBFD@@J@@@F@AB@B@B@BABA@@B@B@@BDBD@B@B@@@BB@@F@@AFADAB@@@BABABB@@BA@@@@B@@@BA@@B@@BB@B@@BB@B@BBB@@BB@@B@BB@B@BA@@BADA@ABA@A@@BA@@B@F@B@@B@@BDBAF@B@@@BHFBBABB@BBD@B@BBJB@@DB@BBBB@@BB@B@@@D@DA@@BBBB@@@@D@BBB@@BABA@DBB@ABBB@BA@@B@@@B@DB@DBB@DBBBBBDB@BF@BB@B@@DB@@BB@@D@@@BB@BDD@BB@B@B@BAB@@AB@FAB@@@BBBBFBB@BB@H@F@B@@TILEFARGJCXCL@FD\\PEbBB@JCBALABBB@bBIA@AEEAC@IJMPAFADAAAGCACBCDEB@BAFGHMBE@ECK@CFBCAHQLGC@@@UEA@CGCG@@A@SCEG@CA@@IFA@CASWOAGB@d@F@J@L@L@^AD@H@H@H@L@J@H@DAD@LAVARA@@HAD@D@@ETCBGJ@H@DBFDXABB@@BBNA@@@CAA@C@G@@ACACAA@@@ABABA@A@@@@A@AA@A@@AAAA@A@@@A@@@ABA@@@A@A@@@@@A@@@@@A@@@A@@B@@@B@BABA@AAC@AHADCL@BHHA@@@C@@@AA@@CB@FNLARB@B@FB@@@@B@@@@@@@@A@@@@@@BF@ADA@@B@@@@@@@@@BB@B@BB@B@JBD@@@B@F@B@@BD@H@BF@@B@@TAD@B@@@NAD@L@B@PAD@RGLEDADC@@D@LA@@@@BEA@BEHBBQE@@A@AF@BMA@A@@@EAAA@@CAEKDEAIQ@I@M@A@E@@A@@@@@G@A@@@E@A@K@AQ@@FO@A@A_AODG@EO@QDO@IC"],["@@L@AOK@@@@@@D@B@B@D@@BD@@"]],encodeOffsets:[[[112620,41570]],[[112442,41483]],[[112474,41593]]]}},{type:"Feature",id:"150221",properties:{name:"土默特右旗",cp:[110.524262,40.569426],childNum:1},geometry:{type:"Polygon",coordinates:["@@MDI@IB@@SNCFBJBFAFGFABA@EBMAA@MCQIEEIQAC@C@@EEOA@@K@KBCBIDC@KJGNCJB@AB@FCDCHGHGDABMFMBKFA@UBEBKBEDA@EF@@IHCDEDKBC@CAG@MEYQICGCGASCE@MB@@KDGDEHSTMJGFA@@@@@IFMBSAOBA@EBCDEBCFGJAFCHCDEFADKLCBCDGDQLGBKFEDKHEBIAAAA@KUEKCCC@GBCBEFCHADADAF@HBJDH@F@BABEDEB@@C@CAEA@@EEAEACACIAI@ODMDIFIDGFGFCBABCDEJEL@DGH@@_NCBGDCDIBC@CBCDCDEVADCFCDE@ECMGYOGCECAAA@G@C@CDCH@HAFKR@BEJAD@FAD@FCHAFABCDABCB@@@@OECAEAKEKEMKKGCAC@CBCBCDAD@FBTBVBL@FAHNGB@D@B@DBB@DDHHJIB@HIB@@BBAJJF@FR@AXETI@OCQP@BA@C@EAGAA@E@EACGI@A@GBCBCBAHVBDFJDFBDBBDJDADJCBBB@DF@JBB@D@D@DBD@DB@BDB@HTCB@B@B@FDNNB@BL@DBDDFBDDFB@B@H@HAFCNHBBHFY@TJP@AF@@@BB@@@BDB@B@DBDDFFBFBDBDEDCD@DADA@AA@B@BHHBDBB@B@BAH@DABAB@@B@BB@@A@ABA@BF@BCB@BBD@B@BAB@@AB@B@@@BA@A@AB@@BBAD@BBB@B@D@B@BB@ABBBBB@BA@@B@@@BB@@BCB@BB@ABAB@@A@AB@B@D@B@@B@FBBBBBD@BB@B@@FD@@D@B@DCBBB@JJB@@@D@B@@@B@@B@BB@@@@B@@@BBD@@BBB@B@@@B@B@B@DBBBBB@@DB@@BB@@@@@B@@B@@BB@@B@@@FBDBD@@@@BBB@@@BD@@@B@BA@AD@B@@AB@BAB@BA@A@@@A@@@A@@@A@A@@@A@ABA@A@@@C@A@@@C@@@A@@@A@@AA@@@A@@@A@C@A@@@AA@@AA@@A@A@CDA@ABA@@DA@A@ABABABADABBBA@@B@B@@BB@BBD@@DBB@BBBA@@DAD@B@B@D@BAB@B@BAD@B@@BBBB@@@F@B@@B@@@B@@@@B@BBDBH@@B@@CBCDEBABCB@B@BABABAB@DABAB@BAB@B@B@@@B@@ABA@A@C@E@@@A@ADA@@BA@A@ABA@@BA@@BA@@BBB@B@D@B@D@BAB@@@DBB@BBB@HBDBBBDBBBDBD@B@@BBB@J@B@@@BB@B@@B@BBB@@@@BD@F@BAB@BBB@@@DB@AB@BAB@B@DAB@BBJJ@@@BABABCD@DABCBCBABCBABABEBE@@@AAA@A@@@EDADAB@@AB@@BBFDBBFBFBF@@@@BA@AB@@@BB@@BD@B@B@BBBBBBBBB@BBB@BBB@F@BBBB@@@BBBBB@@BFB@BB@DDBB@@DB@BB@BBB@D@H@BFBBBBDDBHHBBBBBFB@@BF@B@B@B@D@@ABAB@B@@@DDBBBA@C@CBCAA@@BGBABC@AB@B@B@@@D@FFDB@@LBDBBBHBHDB@DBDB@@H@B@FA@@@B@B@@@BA@AB@@BB@@ABA@@BAD@@CDABABA@@DCD@@BDADBDBBBB@@@B@B@@B@D@BABAFAFBLALODAJEB@@CCCFCD@@@FABIBCDMDADBLC@@B@B@BBB@HCF@B@B@H@HAB@FAFAFABA@@\\FH@B@@BB@BA@@LAD@@@@AA@A@AAB@HADBB@DBBB@BB@BBD@BBB@B@@BBBFFBB@@B@@@BABA@ABC@A@CBA@@@AB@@@BAB@@BD@@@@@@D@@@@@BDAB@DD@@B@@@@BA@@B@@B@B@@@@B@B@BB@B@B@B@@BB@BAB@B@@BDAB@BABA@@B@BBB@@AB@B@B@@@L@L@@@IIAAAA@AAA@@AA@AB@B@B@B@@@B@DC@ABABABC@ABEBCDA@@BC@@B@@@B@DABABAD@BAB@D@DBHH@BB@@AB@B@B@@BDDDBBB@B@B@B@@D@LI@@BADBF@B@B@@@B@BBD@B@B@B@F@B@@@BAB@D@D@@@@@@ADA@@@C@@A@A@A@@@A@@C@CBABAD@BADABABCBI@A@AAA@@BABC@EDA@AB@@A@@BABA@ACACAAAAAAA@C@AB@B@@@BC@@BA@@@C@@BA@@BA@@BAAAACAAB@CCAA@A@C@AB@BA@@AA@@@AAA@ABA@AAA@A@ADCBC@ADA@AHEDCDA@@BABAD@B@@A@@@AA@AAA@@@@A@A@A@@@AA@AA@@A@@C@A@A@@AA@@A@C@@@A@A@@@EB@A@C@AAA@@A@A@A@A@A@@A@@AA@A@ABC@C@@@CDK@@@EBC@EBC@C@ABGDM@IBA@ADI@C@EBC@C@A@@@A@CFOD@BA@ABE@A@@IEDCDABAXUNKFEDCB@bNLDDFBFB@@@@AFMBEBC@ABCV@LB@A@A@C@ABA@C@AOADO@@BI@@A@KAQCOAAA@ABKBK@I@A@A@AUGJGPKDCJGDABABABAL@H@@CD@B@B@BAB@B@F@DA@@@@@A@A@C@E@C@A@A@CB@@C@A@ABAPQDADAFAB@B@B@B@DBD@LDTFHBB@DBBBBB@BDF@@DD@B@@F@JCT@@@@@@ADIBEDIBECABEBCBE@@@@N@@@V@B@BCBC@ABA@AJOBCDCB@B@D@FBFBBDB@DBF@FBJB@@POHG@@DCBABADA@CACCAGECC@A@@@CFIBADAN@RBAJd@H@B@@@BER@D@@GBC@CD@BKBA@CDG@A@AA@@@JM@A@CBBDC@ADEBCFCFC@ABCjF@AIODQ@A@AE@S@@@CA@@DS@C@CBA@C@A@@IIFGFCBE@@I@Q@ABCDQA@CE@A@C@C@GC@ACAMEMECCC@C@MADEEAQ@@@K@CCCC@@@CCA@ANCCOKCGC@ACAEEIG@@GIACGMAEEIACGAGAK@]DIFIFCFABCB@BA@C@@@C@KE@@CCIIGEOQEECCMC@@A@EBIDIHMNCDIF@B"],encodeOffsets:[[113550,41231]]}},{type:"Feature",id:"150222",properties:{name:"固阳县",cp:[110.060514,41.034105],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AB@BA@@B@BABA@EAC@ABA@@DCBABC@@@AA@A@G@A@A@A@AAAE@ACEAACC@A@@CAAAC@AA@ACAA@AAKCA@C@C@CAIAC@CACAA@C@EAAAEAICA@A@A@EBGDABA@A@EAC@C@@@M@G@QAUAMHGGEUBW@]FiGO@SDGiA@@CM@SAKAGFABYVU@GScUQDYFA@kHSDMBI@WDiFAAEAYS@@A@OB@@MBEBZHDHF@AFHDFJDBAD@FGTBHGFI@GFI@MLEPG@IHDNIHQFCNB@@@JAJLAHJDCJJDHJTDfHJ\\EV@BGX^\\BLDRGHLD@XEBQKMFQZOFaPELADCHGTH`IXMNKDAPGGI@GBIOEIKBMJWCK@cMJABY@Q@MBOACDHHQJWVDXLPGJGBINHPCDONWR@JL@NELFlMxDAD] LJ]P@HABDPEL@@@@KHBBBDADEAABA@AAE@G@@TC@BD@D@B@HCBADC@EBAHEBBDBDABC@CBEHCHDFBFFBLBJLAHK@ADBD@DADCBBDB@\\TRQD@D@@@@@HF@B@B@DBLD@B@PBHBDBJCb@DDMFAJHF@DEHAVRBABBFF@BBIP@X@DABK@G@CDARCDCBeDCDONDF@FMHIJOJEDA@CD@@CDAF@BF@H@HDDDBBBDBD@B@B@DBBDBFCDB@BDH@BBF@BDJ@FADCDFDDDF@HDJFHFCDGNAJAJA@@@V@\\AFCFGDCNKFEFADA@@@@pGJCQF@H@KKTIZDHAPANHH@@@@@B@NHJ@DTNF^AD@ZE@CDKBJlSHeMHAFEAQNQIQVOCCB@P@IF@HD@FFDAFJHD@ZN\\B@PBFFHL@DATL@@JWWK@IZKFJF@nEfBBDLA@AT@HKAMHCHDLAXORFDBBNL@JLL@HH@@FPMHBDEDALFF@@NN@BB@BBB@@BVAXCAIRADCFGBCBABADADAB@FAH@B@DAD@L@FAR@DCF@HAJAH@DAHCJEDCDA@@hMCMLHHENB^KJIJACEP@AOL@DGR@@@F@B@@@BCH@HHBA@BNC@@ZCFABABA@A@@CERCbEBODADAB@B@B@D@B@D@DADADADABA@@DADABA@ABABCFEFCDAHAB@BADCBCDE@ADAXKBCBCBABADAFAHAB@B@BABA@AB@@@AIFGJE@@@@BCB@BAFAF@BAB@HA@@BABADCFAB@@@B@@ADAJ@FGHABABA@@@AEGECAABAB@DCFAFABA@AAAGGAA@AACCCCEAA@EBEDCDCDANCHCD@DBB@JCB@BB@ABBB@D@B@LF@@B@B@FAVWDGBE@@BEBALYBI@WBCD@`@HAF@@@F@DBB@@KDIWKAIDO@CACBA@GBCAIEKBC@AFE@CCE@GPC@CDCAE@GBCJWHMDGDAH@JK@ABCCGGEC@CCUM@C@AAC@ABAHAB@AIAAEG@CBABKDCACIAIMOOGBOEAAECAE@CCC@G@ENIDG@GAEGCFKAGA@@G@AFGBKJGBELIJA@@DB@@DBF@JEB@BBBDLDD@F@@@HF@BDB@BF@FAJAFAHDF@FB@FDFL@@HFBBAD@NA@AAKFADEF@FABGH@FA@ADA@EEGDADEBEOMAKBCBAACBAACA@G@AAEC@KCOECEA@@@ADAD@TKAIBECCBC@ICA@E@AA@AGBEHCAAC@GCEGC@IIK@K@@@A@A@A@@BA@AAA@@@ABABA@CB@AA@A@ABA@@AA@A@A@A@@A@A@A@@A@A@@@@AB@@A@@A@@@CCA@CB@A@@@@@C@@@@C@@AA@AB@@A@@B@@AB@D@BAD@BABAB@@A@@@AAEEAA@AA@A@AAC@AAA@@AAACAA@CAGBA@BBB@B@@B@@C@KB@@ABA@@AA@G@[E@@ABEBEBEBA@GBG@A@A@E@GDA@AAA@A@@@KDCACBCNADAJEB@@C@EDDD@DA@IFCBKPKBEAEBABABCB@BMBE@IFG@QJGFKAAFAB@DA@G@[QWDGDICOCCDMB@@KESHILGBCAECMHI@KCC@GNGFME[IQDAAACCAA@GBMCGBAADCDC@CIAIBCF@HQHMAA@YJCFABA@CAIEAAKHGBAFEDOLG@EDEBGCE@MKJSGIEUIEY@EC"],encodeOffsets:[[112873,41839]]}},{type:"Feature",id:"150223",properties:{name:"达尔罕茂明安联合旗",cp:[110.432626,41.698992],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@ĜS´IF_BQ@G@CBG@ABCBC@C@E@EBABEBA@CBIDIBABEBG@G@@@ABGBA@ABABA@EBE@C@A@ABABA@A@@@A@@@AAA@C@@@A@A@@B@DGBCFEBADAFEB@JCBADA@ADAFCDADADEBADIBABC@A@@BABC@A@ABC@@BA@ABABA@AFC@@@ABG@C@C@A@ABA@@@AA@@A@@@AFADCDCDCFADABAFAHCD@FCPCDADAD@@AB@D@@@B@BCBAFALEBAF@DAD@HCFCFCBA@@B@@@@B@D@BBDFJBDBDDHDD@D@DBF@DBBDFHRBJBDBB@BBBBDBBBHBF@BBDBBBBBBBBBHBBBFBFDHBBBBBBD@BB@@BB@B@B@BBBAB@@@BABDAH@D@ÊBhYZ[ZoDCCe@E@AAC@CAG@ACEAGAG@ACG@A@A@CBAHCD@@@BADEDEFCBAJGDE@CBEBO@CBGBCDEDCBCBA@AAAB@@ABABGBCFCB@DAHCHCBAHCNAHAHAD@DABA@@@A@@@AAAA@AAIEIIS@GBEAGAOGA@CBCBE@KOMBCBGGG@Q@MFM@KJI@IDAGCCMEMDMEM@SJQ@mTG@CCIF@G[@CFC@GCOEG@KFIEG@CE@@MFE@IFCIIAOBKFUDKBKGEFECGDCCwiSYKoK[YUACECIBGBAI[E@RIDoH@@@@CBEBEFMLCDEHED[BU@@@Cq@_DB·AyNRRUhMHA@U\\BBDFDDBBBF@D@D@D@BAB@B@B@BBBBD@BAB@FADCF@BCDCBB@AJAB@B@B@@@BDBBB@B@@AD@@BB@B@@@B@@EBB@@BAB@BBB@BBB@D@B@@@@@B@B@BA@ABBFAB@B@BAB@@C@@DB@@B@B@@@BABABABBB@B@BBBB@BB@@@D@D@B@@B@@BB@@BAB@BB@@BAB@@CBCBULCBE@EBABAB@BA@@B@B@BABAB@DABAB@DEF@@AD@DBFBF@B@F@BAB@BAH@BMJOLUTIJIHQPSPHHVRXV@@i|}\\M`IPAFEHCHIRCFGFEDIJUDUD@JB@F`BDDDD|JDDBDDD@@BBFDF@CBAHAJBBJLPDHDFDHBB@JDPZ ºpzDD^fÒ«ocdWdiNYJSHsZAz\\LFTIH@TGJOD[ro"],["@@AA@@@A@ABABAD@BAB@BA@CAI@@BABAHABANCHCJCBCDABCDC@AFEBCBE@AACAA@C@CBA@AB@@C@@AE@CACBC@C@A@@@@CBM@ICA@OEOEa@oBOUHEUOGCCAG@A@K^aIKEkI@CDGAAC@G@CCQMWUPMACDIAEEAMAGA@EMCIBCFOJUJgTCBYRCB_T[@SDA@CD@F_PEDFDABUDKFI[GO_DYDID{PBZEHCDADCHMDOPEACDKCEFABABABGDK@@LaBAQCGKSMOG@SJ@@GDIDIBCBG@GBA@WHA@E@CBA@ELCFCBABEBC@E@G@A@A@@B@B@@BHBJA@IDE@CBC@C@E@CAIE@@K@K@G@C@IBC@EBC@ABAB@BDH@BBB@BDDHHBD@@@@AF@D@B@B@@@BM@A@CDCBA@A@ACCAE@CAE@C@G@GBCAAAAA@A@@AAA@IBG@E@AACAAAEBC@@@CCAAA@AAA@CBEBABO@A@ADA@A@ABA@B@@B@@ABA@GA@BDDDDBBA@@BC@@@A@AAA@@ACA@AA@G@A@AB@@@BBB@@@@C@C@AACACACEAACAQCAA@AAAHC@@BA@CBA@A@A@AAA@CE@KBC@@BAAAAECOE@AA@AC@CAA@@A@E@A@@@OIGGC@@AA@C@A@A@A@CCGCA@@A@@@A@AA@AAC@@@@@AA@@@A@A@C@A@@AA@ABA@@@AA@AAI@CAACCAAA@A@@@C@A@A@A@@@@A@@BC@ABA@@B@@@B@@@B@B@@A@C@A@@@C@CBC@A@A@E@AAC@@BA@@@ED@@C@ABA@CDA@CB@@@BAB@@A@A@ABAA@@ABA@@BA@A@A@ABEBEB@@@@A@@@@ABA@@@AA@@@A@@@@BA@ADABA@@@A@A@C@@@C@@A@AB@@ABA@A@@@AB@@AA@@@A@@@ABEBCDA@@@A@@@@@@A@@@@JGDCB@DC@A@@@A@@CA@@C@@@A@A@@@@A@@BADAFA@AB@A@@AA@A@A@C@A@@A@A@A@A@C@A@@AA@A@@A@@ACA@@AABAA@@AA@@AAACACA@ACA@AAAAAAA@@A@@@A@ABA@AD@@ABA@@BC@A@@BA@C@A@A@E@CBCBC@@@A@AAAAA@CAA@C@A@@@A@@BABABA@A@GBEBCBABABADADWLCB@BCFADCDABA@GBCBEDEFADAB@BABCBCB@@ABCBCBCBCBC@A@C@A@A@A@CBCBAPaFQDDF@@@BABABEBYD@@MD@AABGGG@AD@@A@E@@@Q@CHK@BPO@DFIBIJ]LMAGFKGDNgN@@CBCDIFGDCBG@IBGBE@CDQ@EBK@C@CBA@G@EBA@CBCBABABADEHCDQBBJWDUB@AA@AAA@@AMM@@EEBKFCACNGEO@@GGK@IKK@AMCAQEWPKBGCGDBNGLS@@BKBACeAmFE@EIYL@JXLIX@@SKCBK@EGAE@O[AYMC@IGBEEC@EGCE@JO@A@DDUPJRMRBREFGBNGfkTAICL@DYFC@]BMECSI@MGA@@@@@G@MGOBGBYCSJLLG@J\\ABAHDJDFVB\\ZpLZLjTxDDHCFDFELHLAVCLEPAJBDJJEF@NE@@DFH@JFLEH@PFHDD@DE\\@@HJEDDH@nSR@TIN@NFNCNFDDBHJCJ@LIN@NER@H@HHDANALPF@DADAB@PHHBFBHAT@JJJFBBB@BB@B@@@B@@ABCBC@GBGBMBGDABGDGDCBA@EDADAHAB@BA@BB@BABADCDCFADAH@DAPAF@DCFIHABEDCFCFAB@@C@GDAB@D@B@BDH@BBHBHDF@BBH@DBD@B@FDfCDYpY\\gZÉAF@FBFBDBFBBD@BBF@B@B@@@@BBDFBDBD@@@D@BBB@B@B@@CFA@@B@@BB@BBD@F@B@@AB@@A@@@@@@B@@@DDB@B@B@@B@@B@@@B@@BB@@@B@BDBDFB@AB@BABFBBBBBB@@B@@@N@B@@B@@BAD@B@@ABE@IDC@A@E@A@@@A@IDA@C@A@CBCBGBCBGBA@CBC@CBC@C@A@A@EAAAA@A@A@A@EBC@G@C@E@C@E@AAC@A@CAAAA@C@OAEAA@KAE@A@AA@@M@E@IAKA@@A@EBABMDIBCBQBCBC@_@MZELoP^HPRbN^SJIOgyKOKOACBKDMBQFU³JěTqpC\\IPSHG@SJKEy[YBGtITMZcjcXpdÑ¬A@LNZbJRRfDJXz@BnPD@CJCNB@yĘOVetS\\JIJ]bONEHCD@D@D@DBD@FBBAD@B@BAB@B@DAF@@EB@BA@ABAB@BIjSLiJoTH@VD´JHfXXBxBdXpn\\LH`Tr¦j\\HX¦b¤bpPxB¸D¶FL@DZAPNnr `¨bª`@@¤`FCzUzWbYrUZUHIFCFERQLKHGBAHCn[RINEXW~y\\YboNOX_HKn{XGjMSFEZ[LMHIAOCYCeCSBEDOPwTNq@@LMV]LQHQBCBEJU@[@OBs@O@QNOLINO\\[rmFCDCDEBCFGBABCRSDEHGPSRUFEDGZ_NQNQBAFIDCB@@CBABEBCDCDCBCBC@APWLODCDGBEFEFCFIBCFMDGBCDEBCHGDCFCFEBBF@N_HODGLYFMDGPcbHKDEPMDCNMJGPOTQjCJELGJELERUfmFGFC}~UČs@@@@FCLGEGFO@OFQJCNKDGPQJCEGDILOA@DCDCBCB@BAB@BADADCBAFCDABCBCBABG@CBC@CDEBCBA@A@CBI@M@C@ABA@CBA@E@ABA@A@A@G@ABA@A@C@CAE@A@ABG@CBE@CAIAG@AAAAABC@A@AAA@AAA@A@AAACGAA@G@C@CAAAACAA@@A@@@ABI@A@A@@@A@ACACA@AA@@A@AAAAAAAAEAAGCAAAAA@AA@A@@@E@@AA@@A@K@A@AACAA@AA@AAAACAAECAAAAAAACAA@ACC@ACC"]],encodeOffsets:[[[112303,42871]],[[114062,42375]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("赤峰",{type:"FeatureCollection",features:[{type:"Feature",id:"150402",properties:{name:"红山区",cp:[118.953854,42.296588],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@A@@@@B@@A@@AA@A@@@A@CCCBCBC@AD@@@BB@@@B@@B@B@B@@@B@@@B@@@BC@IB@@@BA@A@@@AAA@@@A@@@AB@@@@AAABA@ABA@CBABA@AB@@ABABA@@A@E@CMFIBEDCAID@@BBCBAB@BB@BBA@@B@@@B@F@@@@BDBB@@AB@@B@DBB@BB@@@@CDA@B@@@D@B@B@@@B@@@BB@@F@BB@@@BE@A@@B@@@@@@@B@B@@BB@@DC@@F@@@@@AB@@@@BBBB@@AB@@@BFDBBG@CBC@@B@@BBC@A@B@@B@@@@DBDB@@AB@B@@A@A@A@C@@@DBB@B@BB@@BB@@B@@BD@@@@B@@@B@@@BA@@@ABA@@@A@@B@@@BA@A@@@A@@B@@A@AB@@@@ABA@@A@@@@@@A@A@AB@@@AA@@@@B@B@@@B@B@@@BBAB@@@DA@@@@LCBA@@BA@A@@B@@@@@BBDD@@B@B@B@B@@@@BB@@B@@@B@B@BBB@DBBB@@B@BA@@@ABA@A@iKAFBBB@@BEDBXAPFF@@@@DDLPRVB@BBC@TZFF@@@@RTNPXJ@@JHBBA@IDKFSDIDHFFDHHHDABBF@@B@@BBF@@B@@@DFDDBB@BB@HHBBBBB@@B@@B@HD@@B@@@FBLF@@@@@BJF@@B@B@@@@@DDDB@BB@@BBB@D@@@BB@@AB@@BB@DDDB@@BBHF@B@@B@@@@@@@@@@B@@GBABBB@@EDCB@@A@ADBBA@CFIHEDC@AJEBGD]LaPSJHXA@BDBA@@B@DCBABAB@B@DAAF@BADG@BB@D@B@@TC@BDCDA@@BBCD@BA@BBF@B@@@@@HEBA@@AABA@@BB@@@@BAB@B@HEDABAB@B@@@B@B@@@BAB@@@BABABAFA@@DAB@BA@ACAJGB@F@DB@@@BAB@BBDB@@@@@@B@@BD@@DD@@@@PAN@N@F@D@BAH@H@DCB@@ABBDBFBP@F@FAFAHADAD@B@@@DCBAJAHAHCLAFAF@HADAH@NADEA@B@B@N@H@D@D@N@F@@@HBF@B@DB@BLBTFH@@DB@NBD@JBFBPBJ@H@FBFBBBB@BAD@@@BC@GDABAFADCD@DBD@@C@A@A@A@A@@AA@@AAAACACA@AAAAA@@@A@@CAC@C@DEB@@@@@DA@@@A@@@A@A@@@@@A@@@@CA@@A@AAA@@@A@@@AAA@C@@@@@A@A@A@A@@A@@@@@@A@@@A@@@@@A@@A@@@@A@@@@@@@A@@@A@@@A@CBC@@@ADA@@@@B@@@B@B@@@BKA@@@AA@@AAEAB@FABB@@@@B@BI@A@@AA@@@C@@@D@@GAAK@MKA@IA@@@EC@CAA@@@AACA@A@@CCA@@@UF@@@@A@@@CGA@IFCC@@M@@A@AOBAA@@C@AA@@@AGBC@AEBAFAB@@@@CACACAACC@@AE@A@@A@AAA@@A@AA@@@G@KB@@@AAA@A@@A@CAE@A@AAA@@A@@@@@@@AB@@@@A@@@AA@@@@@C@BABABB@@D@BBBAF@B@BA@@@@A@CAA@@@@CF@D@BAD@@@@AB@BAB@FA@@B@@@@B@@B@@BBABA@@B@@BBB@@B@B@@@F@HAFCRE@@@@@D@@@B@@@@FCB@B@@AB@DAD@F@B@@@B@DADAB@@A@@AAA@BA@@@AA@@A@@A@@A@@@@@A@@@AAA@@@A@@@@A@@AAA@@@AAEB@@ABAB@BA@@BAA@@@CA@@@A@@@AAA@@@@@@AAA@@A@ACA@@@A@@AAA@C@@@@A@@BA@AB@BA@AB@BA@@@@@A@@DAF@BAB@DEBCBA@@DABAB@BAA@@@@A@@@@A@EA@@@@@@BADAB@DABABA@@A@@@@A@@@AB@@AB@@@@@@A@@BA@A@@B@@AB@@CB@@A@@AA@A@@@@@AAAAAAAAAA@AACA@A@@AB@B@@A@ABABA@C@@@@@CD@@A@@@@@@A@@AA@@BEB@@A@@@@@@C@A@A@C@A@A@A@@@@@@ABA@@@@@@AA@@@ADC@@C@@@@@A@@@@@A@BC@@@@@A@@A@CB@BA@CAA@@A@ABA@AB@@A@E@A@@@A@@BA@@B@@AB@@@@A@@AA@@@A@@D@BAFEB@BA@@@ABABC@ADA@@B@B@@@B@@ABAA@@A@@B@@@@@@AC@@@AA@CAA@@@@@A@AAAA@@@A@AAA@A@AAA@@@@CAAA@CAA@@@AA@@@@A@@AA@@@@@@@A@A@A@@@@AA@AAA@@@@AGBC@A@@BA@AB@@A@@@@@CBC@A@@@EB@@CA@@AA@A@@@ABA@@@@CA@@@A@AA@CAAAAC@@@AAAAA@@C@@AA@@CA@AAC@C@EBIBC@AAA@@@CE@AAAC@@AA@ACA@@@AA@@AA@@CBE@@CAAA@@AC@AA@A@AGEAA@AAC@@@A@CAC@AAC@AEACCAA@@AACAA@@@@A@@BA@A@@@AAAAA@@AA@AA@CAA@CC@@ACA@@@@@@BCB@@@@A@@BA@@BC@A@C@C@A@A@AAAAA@A@@AA@A@@@A@C@CAA@AA@AAAA@@@E@A@AACBA@CAC@@@A@AB@@A@AAAAEAA@C@A@C@AB@@@@A@A@CB@@@@@@CDEFABCFABA@BB@B@BA@@@"],encodeOffsets:[[121761,43057]]}},{type:"Feature",id:"150403",properties:{name:"元宝山区",cp:[119.288611,42.038902],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@DCB@FAH@FADA@@B@FBD@B@FAD@D@N@D@FBDBNBB@FAB@DBB@HBFBB@DBDBDB@@B@F@@@@ABABABA@BBD@@B@DBDBB@HHFBB@DB@BABA@@B@@CDADABABA@E@ABCDC@EF@@ADABCH@DA@@BAD@@@B@D@B@BEF@@HBDBJBFD@@@@EBABA@@@ABEBAB@BC@ADCB@@@BBBDBB@@@GDC@AB@AA@CBABADAACFABA@CBA@@AA@@@@AA@A@@A@ABA@AAAADABABAB@BABADA@@BCBABC@MBG@A@GBA@@@A@BA@@BA@@AA@@BA@A@@@AA@CAABAAAAA@@@A@@AA@@C@@AA@AAA@@A@@@@A@@CABAD@G@@@A@A@@@CA@@@CB@@@@A@@@@AAC@@@A@@AA@A@C@@@AAA@AAA@C@CBA@A@AAA@AAABA@@@@@@B@BABABCDABCBADAB@BABADCFABA@@BAB@@ABABA@ABAD@B@@CB@B@B@B@@BBBB@@@@ABAB@B@BD@@B@B@B@D@@@BA@@@@@AD@@A@@@A@CAA@A@A@@@A@C@C@A@A@@@A@A@AB@BAB@D@B@BBB@@BB@@@BAB@@A@C@@BA@ABA@@@ABA@BBB@@DB@@BD@@@BBBB@B@@BDBBDBB@@B@B@@DB@@@@AB@B@@@BBB@@DB@@FA@@B@D@DA@@@@B@@@BAB@@AB@D@HA@B@@B@BBB@@B@@B@B@B@@@@@@@B@@BB@@@@@BB@@B@DBB@BB@D@@B@BBB@B@BBB@@@B@BB@B@B@@@@BB@DBB@@D@@B@@@@A@@@@BB@AB@BA@@@A@A@@@CB@BADAB@B@@ABA@EFABC@@@@B@@BB@@@B@@A@@BA@@@AB@@@B@@@B@F@BA@@BAB@B@BB@DBB@@ADAB@@@@B@@@@ADB@@@@@B@@@@@D@@@CD@B@@BB@@@@@@AB@B@@@@B@B@B@D@B@B@D@@@@@@@@BA@AF@@BB@@@B@@@@B@@@DC@@@@D@B@BABAB@@@@ABA@@@BDBBBB@BBBBBBBB@B@@@@@BBB@@@BA@@DA@@BA@@@@BAB@@@B@@@@A@@BA@@B@@@B@@B@@@ABABCBA@CBAB@@@@@@FBB@@@@@@B@@B@ABA@ABCB@@ABADCFA@ABE@CB@@@B@@@@ABA@@BABA@@BAB@@@B@@D@B@BB@@@B@@DB@B@BB@BB@@@@@@BB@B@@@B@@DB@@B@AB@@ABA@AB@BA@BF@B@@BB@BB@@@@@@B@@BB@B@@@B@@@@@BB@@@@BB@@B@@ABB@BB@@@BA@CBCBA@@@A@E@C@CBA@@BA@A@ED@@@@@A@@@C@@@@QFEDGBE@@@A@A@@@AA@AA@@@ABAB@AA@@@@A@@A@@@EBA@ABA@@B@@C@ABC@E@@D@@B@DBB@@@@@ABA@E@ABAAC@@@AAABABD@@@@@B@@B@@@B@@A@@B@@@@@@@BB@BBB@F@DBB@@@@BBB@B@@LAH@@@B@@B@BB@BBB@@@@BBF@@DDBBBDBD@D@@A@EBABBFD@HA@B@@BBD@@@BBPA@B@BN@@@DDJEB@DH@@B@@@@@VE@@B@DD@@@BDBBB@@B@DBD@@F@@JBB@NLL@BB@HC@@@D@@@B@@BB@J@@A@A@@A@BA@EBABF@BB@@B@@LB@A@@@A@A@@@A@@B@BC@@D@DAB@@@B@@@B@@@@@@@B@@@@@@BB@@@@@B@@@B@@@@@@@@BB@B@B@B@@@@@D@B@BB@@B@@@B@BBB@@@DB@@@@@B@@@@@B@B@@@B@@CB@@@@A@CFD@D@DB@@@B@@BBBB@BDBDBBBBB@@BB@@@B@B@B@B@D@@@@@@@B@B@BBBDBFFDBDBBBB@FDDJB@@B@BBF@@@B@@@@BBD@@BBB@B@@@D@@BB@@BBB@B@DBB@@BBD@@B@ED@B@B@DDDAD@@@BDBDFB@@BDB@@BB@BB@DBB@B@B@@BFB@@BB@BBB@@BBE@MAAB@@A@@BCD@@@DB@@D@@@@@B@BA@B@B@D@D@BA@@B@BADAB@D@DBDFBBB@@AB@JAH@H@DAFC@AB@@ABADAFAD@D@B@DBD@@B@B@@@D@@DH@B@@VCHAD@B@FA@@@@BILE@@PGBGHCJCFFFAXBDFFJBBBB@@E@@BA@ABA@CDBLBFDVAB@JA@B@@BD@AFC@AD@@B@@B@@@@FDB@FF@BB@HBAC@@BCBBBAPDB@D@^FFB@DB@@@H@LADA@BDA@@AA@@BA@A@A@A@CBA@CBA@@BA@ABADI@@BE@ABA@A@@vJbIB@JAPCBD@@@B@D@B@B@BA@@B@@BB@@BBD@@A@@@A@@T@BBDFB@@@@@`GL@B@B@BBJE@@BAJC@@ECCOEEAB@AAA@AAE@C@C@EACAC@A@C@CCE@@GKEICCCAEEKEGCAACAA@@@AA@@CAA@AAA@AAA@A@IEC@AAC@@@EAI@CAAAAAIQ@AACA@CAE@C@A@C@CACCACAAA@CAC@G@I@IAI@@@EAGCGCCACACA@@A@A@@AA@@@A@A@A@@BA@@@ABEBCBA@E@@@A@C@A@AAA@A@@@A@@AA@@@@@A@A@@@@AA@A@@@AA@@@@@@A@@@@@CACCA@AACAAA@@@A@AEEEGECECAAGCGEEE@@EAEAAACAG@MAC@GAEAGCAEAEAMAGAGCGEGGCCCGGEEECCCACAC@CACAGACAAECGACAAA@A@A@AFCHADAB@@C@A@A@ADAF@LBF@F@D@B@DC@@B@B@@@B@BAD@B@B@@@BA@AB@AAAK@@AA@ABCDABAD@B@BA@C@A@ABADCBA@A@CAC@A@CBAFADAL@D@DA@@DADABABABA@A@A@@ACA@CC@AAA@C@E@C@C@@BABAB@DABADCBABC@@BAB@DADAB@B@DAB@D@B@DBDBB@D@F@F@@@F@D@B@BBD@BAD@DABCDAFE@@FCDCDADAB@B@BBB@B@DCB@@A@AA@@A@@BABC@ABC@ABAB@FCBABC@A@AAE@K@A@@AAAACAAEAC@@@C@E@E@@@AB@BAB@@ABAB@@@BA@A@G@@@AA@AAAA@A@@@ABA@C@@AC@A@A@@@@CAA@@ACA@A@@@@@A@@@@@A@@BA@@@AA@B@@A@@AA@A@@@@@A@@@A@A@AAEACCECCAAAA@@A@@ACCCCGKAC@AAAAAAAA@AAACCCACAAAAAACACCA@AAA@AAAA@AAA@@AAC@CAA@A@AA@ACA@AAAAA@@@A@@@A@@A@@A@@@A@@@@@@@A@@@@BA@@B@BC@@BA@CBA@A@A@@E@I@A@@@IDQF@@@ACBAAGAA@CBA@MBA@G@E@C@AAA@C@KAC@A@@B@BA@@@AB@BABD@@@FH@@BB@BB@@B@@B@@B@@B@@@@@BBA@B@AB@@A@@@BBB@BHDA@BCDB@BDEB@BA@GDEBEBE@@@AACAA@C@IDC@A@A@A@CCA@@@@@D@B@@@AAA@ACAA@@A@G@@@@@AADA@E@ABA@AA@@C@@ABEC@@CA@@BC@CAC@ABE@A@A@A@A@BA@@BA@CCAAAB@@@B@@C@A@AF@@A@@@AA@@@AB@@@AA@@AA@AAA@@AA@@@ABA@ABA@C@@@@@@AC@AKGAAAACA@A@@AA@A@@@AC@@@A@EACAA@GBC@@AA@@AA@CBC@AAC@EBCB@@AB@B@B@@AB@BA@@@@AC@E@A@@D@@@@A@@@ACC@A@C@@@@CE@@@@A@@BC@A@@C@C@CACC@CE@AB@@AB@@A@@@EE@@CBGC@@A@A@@B@B@@BBDD@@ABCBA@@ACAC@EBC@C@A@A@EBAB@ACB@@@B@@CB@@@@BB@@@@ABCB@BB@HD@BA@MHAB@BB@A@A@@@@BDD@@@BA@AAA@A@@@@@@BB@@B@@@BBA@B@@C@@@ABC@AB@B@@@BB@B@B@@B@BBD@@@BAD@B@@AD@@@BA@@D@DAD@DB@@@"],encodeOffsets:[[121963,42986]]}},{type:"Feature",id:"150421",properties:{name:"阿鲁科尔沁旗",cp:[120.0657,43.872298],childNum:1},geometry:{type:"Polygon",coordinates:["@@ICOIK@IGQACBWBHNGLAJD@ADEAEPAFKDEAWFaVGFWPKHQLzvAhRhP@HV[NDFKJMJWTUFSF]N@@[EFCLGHMPA^DtC@EFCFEAkGQC@¥QYDqHsJPEFMDMJABB@BBIFMLMJABOJ@@NAJDJBLB`EFD@JAJLHDD@FFFEHBDA`JDEFI@AN_ND^EFEFKNDBX@H@B@@A@AB@NR@B@@@BA@@BABBBB@@B@@AD@@BBHBBBB@LJFH@BFF@BBB@B@@VNHFB@BBLFB@B@D@NFJBXFHBLBFJEHABCRBF@@DD\\RKNGDGDPFLBAFPBC^MAMPC@UEGHS@_ZW^EXDDARCBILFH@DADDHCFHD@@AJFB@DBFEBBDABO@CDABEHAD@BEBGDGDIDGFCFGD@@°EBBFLTCJAJCB@B@@G@@BAFC@CBC@E@CBE@E@@@C@ABAB@BEF@B@@BDD@BBD@DBBBDDAB@B@BEDC@ABABADCBADEFCFED@FBB@DFJFBDBDDEDABCBB@DFDLFDFFDF@@CD@F@HEHPFDPGDBNCHAHCFBPAB@B@@@@CBA@C@C@@@AB@BEB@@@BABABCBE@CBADA@@@C@CBABA@AAAAAAA@@BEBABAD@@ADABBFA@ABA@ABA@@BCB@BA@@BB@@DBB@@@BBB@B@B@B@@BF@@ABBB@BA@A@AB@DA@AB@@@BABABI@ABAB@B@B@B@BA@BB@@@@BB@@@BADAD@BADABCBB@@B@DA@CB@BA@@BABABC@@B@BCB@B@@BBAF@B@B@BCB@@@@@B@F@F@BB@@DDB@@@@BBAD@H@B@DAB@BCB@@A@A@CBA@ADABEFABABABAB@DBD@BB@B@@B@BB@@B@BABABA@@@@BCBAB@@AD@@EB@@AB@@@DAFABC@@BA@CB@@@BAB@BCDEDAB@BB@DB@B@@AB@@AB@B@B@BDFA@AD@BAB@B@D@BABB@ABBBBDAFBBBBDD@@CB@@BD@BDB@B@BDB@BFF@BA@@HBB@BAFBBFH@@F@FBBBBBB@DBD@FDL@B@@DBB@FBBBBD@BFA@ABAFCBAAA@ABCBA@ABAB@@DD@BDB@BBBDD@B@BBBB@BBCD@BBBAFBBB@@DA@CDCFDBBBDBBA@AB@NAFBBBHDBDH@DBF@BBHAB@B@ILABCDCDADA@AD@FA@CB@DBBBBADC@CBCB@BEF@DGBEBCBABBHB@@BFBBB@BBBABA@ADED@DAD@B@@B@B@@B@DFBAD@BFD@@@FA@ABAHA@@DCBEH@DA@C@A@A@@BAFABA@ABBBBJA@AD@D@DABABC@@B@DAB@BAD@D@BDBAB@D@@GJCBABA@ABA@ABCAEBGBADADCBABC@ABCDCB@@CFADAB@FCB@DABA@ABCAC@EBABCDED@FBDAB@BBBFF@B@HBBADA@CB@BDDB@@BCBAB@B@B@B@B@B@@@B@H@DB@B@BBBBBBBABBBBDBB@@DA@@BBB@B@DA@@F@B@@@B@D@@CBA@C@CBC@A@@B@DAD@B@FABAB@BABEF@@ADABA@ABID@D@@MJ@BDF@B@FAB@DDFB@@DE@E@C@EAC@E@CACAA@A@ECC@I@C@C@GCAAC@gLABAF@B@DBDAD@B@DADBD@BA@A@C@AB@@AFABABABCBA@A@ADAF@BEBABCDA@CBGD@BABCDA@@DDB@BCFCFABABED@BBB@JA@AFAB@BABED@B@D@B@DAB@BBB@BCD@BBDADBBAB@DABBBCBABE@CAQESGaKiQEDONA@C@CC@@AAAAACA@CAAA@E@@@AA@CAC@GAAAC@A@AACCA@C@AACAAAA@AACBABA@CBG@ABAFCBKBABABCDA@@@EAA@@@C@@@@B@@@D@@CD@D@@AD@B@@CDABABABADA@E@E@A@AB@BA@EBC@A@AACAA@CAAAA@C@ABA@CBEB@BAB@@AAB@AA@@AAA@A@ACIBCBGBCBAB@@A@@F@BABAB@@AB@@ABCDABCF@@ADB@ABABC@CFA@@DAB@@ABABAB@@@B@@@BBDBB@@@BCB@@@B@B@@DD@B@DABBBAD@@ABEDCBC@@AAAA@A@@@ABA@@FA@@@C@@@A@A@A@AB@@@@ABABCB@@AAEAA@@@@@A@@BA@ABA@@@ABADEB@@A@@B@@ABABAB@BA@@BA@A@AAABA@G@AB@B@B@@ABC@AAA@@BA@@BABABIDABA@@@A@ABC@AB@@@BBDBB@BAB@@CBOH@BAB@BAB@BBDB@@BB@@BB@AB@B@BDBBB@B@D@LDB@@BB@B@BAB@B@DA@@BBDBB@B@BBBB@BB@@BB@B@B@B@D@B@@@@@BAB@@C@ABAB@@@B@BA@BB@B@BCBAD@@@BB@BB@@BB@B@D@@@BBBDDBBBBB@DD@@@@@A@@@@@@@A@@@AB@B@@@@@B@@@@@DC@AB@@@@AB@@@B@@A@AB@@@@@BA@@B@B@@@@AB@@@B@B@F@BA@@@@B@B@@@DCB@@A@ABA@A@@B@B@B@BAF@H@BB@@BAD@B@@@@@B@B@@@B@B@@@B@B@DB@@B@@@@@BBB@BB@@D@DB@@B@@@B@D@@BB@BB@@D@B@@A@@B@B@@A@BB@@@BAB@BAB@B@D@BAB@@@B@B@B@D@B@B@B@L@F@F@BAB@BADAB@FAFAJ@D@DAD@DAHCFADAD@DAD@D@D@D@D@ABBD@@@BD@BB@BB@@B@@@BADADAB@B@BBB@@BD@BBB@B@B@B@DB@@B@@@@@@EBABCBEBAB@DABAB@BABA@@@A@A@EAC@ABA@@B@@@BA@@BA@@@EBA@ABCFCD@@A@@AA@AACCA@A@C@CBAB@@ADCBADABCBAB@B@B@@AB@@A@E@A@@@@@B@BBBB@@@B@BBBBB@@@BABAB@@ABA@@@@BB@@BAB@@@B@D@B@@C@ABG@AAC@@@A@@@@BB@@BAB@BA@BBAB@BCBCDABABC@@BB@B@DBBBDB@BBB@BAB@BA@@B@@@B@BBDBBB@B@B@BB@B@B@BBBBBBBDBBBB@DAFCPGDAF@DAFCDCHA@@DAD@BABCD@BAB@B@DBDBFBHBDBD@@@@B@@ABAB@D@DA@@B@BB@DBB@@@@B@B@@DDBDB@BB@@B@@@@@AB@@AB@B@BA@@@A@ABA@CDABA@ADA@@@@@CAA@AAC@A@G@GBABGDEBAB@@BB@B@BF@@@DBB@FDFDD@BB@@FDB@B@D@FBGDEBEDA@C@C@CB@@C@C@A@@BA@@@CCAAABA@A@A@AAC@KA@@@B@@BD@@ABCBAB@BCF@BA@ABABAB@BEDABGFEDIFED@BAF@B@BA@@BDR@DDPHFDFTVLPB@DBDBBHBBABB@@BD@B@BBB@@BDBDB@B@B@@BBB@B@@@@BBB@BDDDB@@BD@B@@BBF@D@BBB@FD@@B@@@BA@A@ABADADABADCB@BAB@FAJADAHAB@BAB@B@FAFADAB@B@DAB@B@BAB@B@B@BA@@BAD@DCB@B@BBD@BABAB@B@CBD@JEJIDE@EAEBIAIFICGHA@KF@J@NER@H@FELAHERCFFAFFFPDFJFFJHHHHHCDAHHDAFEHCJ@PAD@HMLIPFDLD@@CHCJKFOJMBQJEAGBKDOL@F@A@B@@@@EBA@@BADBB@BBD@@BF@F@DBF@BBBBDBB@@B@BBB@D@B@BBBBBD@D@@BF@BAHCHAD@B@@B@B@B@BBDBB@BDB@@BB@ABCBMDJDTE@PJDĊe²S^{XMgnY@@BARMFEHAFKFGDCF@DAFAJEH@FEDCHANBHABCBCBELCFEHCFCFEDIHIDGBEFCDCDKDMDI@E@G@GHE@GDGFEHEFC@EJIJOHQFED@DG@AA@BAAAB@@A@@AA@AAAB@@A@AACCGBCD@BAAAD@FABEBABA@ADCBC@AB@BGAA@AB@@AB@@AFEB@DC@ADABAAABADBACDAAAFCCA@A@EBGD@BCD@BCJAAABA@EB@@A@AFGA@@AD@@AFC@AD@DD@CF@BAF@B@@CDABB@BB@B@@AB@B@@@B@D@@A@ADAB@BBD@F@AA@AB@@BDA@AD@A@D@@CJ@BAH@DA@CD@@ALAFBB@@AFAD@DCD@BAD@DA@AFABAD@B@D@@ABABDFA@AB@BABBBAAABAFBDC@CB@DABCAADAF@@CA@ACFG@AB@@AHEAAB@B@D@A@FA@A@@B@HABB@AD@BA@@@@B@J@@AD@@@FAFA@AHABCD@@@@AFAAAF@BABAB@BAD@AA@@D@B@@CB@@@HC@ADABABAB@@AD@B@B@BADB@CB@B@AA@AD@BAB@BCA@@AA@DADC@AFEACDABCFBFC@CB@@AF@BCBCBA@CFA@ABADC@@FBFCACFABABB@CD@B@BA@@FAHEHKFGFCH@@GFBHADEBA`SNIbY@EFAFEHGNEFID@AEDAB@LA@ED@DACGFCDEJQAI@IAECDAEF@BI@CHAJC@IBKHG@GNK\\O\\KTGVIPCHAL@HAJBD@@@@@D@EKEGEKUOAIGO@ADGJIFILDHBJDNEDMHCF@LIHAJ_A@@ADEDAXCL@DGBAF@BADADCF@@EBE@ABC@ADEFCFCJATCD@B@FBD@RI@CAE@CBCDABAF@DBB@DCDABC@CDCAE@C@@IC@AEEBABABCBAFAJ@HGDABAAEBC@AHCAAFEI@HEAAADICDEKA@@AA@AHDDCFB@ADADCBBBC@@CCBA@AC@@ADA@ALEE@AAABECHEQcHGpoIBCGE@AB@@GEC@@@AFC@EBABE@KBA@ADAAGFABA@@@IFCBKB@BAF@BEBAJ@DABADA@@BCJEBCDCFA@AA@@EAEKAHIVYNOrq`BLAL@^YH@NB@@LhJBE@AHMBCFIJQT@N@FAF@J@F@J@D@J@F@J@DBF@P@JBHAVY@EAUCmGDQJ{V]_@@Q_ASwMQYGKCe@MX_NQLMPQ\\c^eFERSFQDC@@HC@@@@@LO`UBBG@I@@MGIEBikLGLKTUTSPMN_XMRKTQGEb[PE\\IXiXgXFAOUAAARE@@CCEIKGMBII@AKEDKCA@A@@CCABGGKCgG_MM@GAE@ECICSCKM@AMCEAE@IACEQGKEOIECEEEEQA_GGCIESEMEAE@GAIEKKICCCCECECUEAAEAIIAEGEEAMEA@OIYWAIIIKKOGOMQCOG]KEA_QIC]@i@aOSKYKIAQCgCUAcAGEQGMEUEICGGCE@AKAWAKCMCYAA@WFYCIBKAKAIDIFUHYHIHEBGBEBQEI@a@[@OCWAIAE@OBKAWASCUCMA_HMAKAOAA@"],encodeOffsets:[[123013,44421]]}},{type:"Feature",id:"150404",properties:{name:"松山区",cp:[118.916208,42.299798],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CACACCAEIAC@ACECCEA@A@C@E@GCCEBAG@A@EA@@ICAAA@@@ABC@A@AAC@A@AA@@@@AA@A@@A@A@@@@@A@@@A@AAA@@@@@ABA@E@ABA@ABAAC@A@@@C@C@@@AB@@AB@@@B@@@@AB@@A@A@E@@@A@@@A@CB@@A@C@AB@@GAA@A@C@AB@@@@A@A@A@ABA@CA@B@@AB@@@AA@AAA@ABE@AAA@AA@@A@CB@@C@CACBA@@B@@@B@@@B@@@BABAD@BABCB@@A@@@CDEB@@ABABA@A@A@C@AB@@AB@BAB@BA@IFABABA@E@C@AAA@C@AAED@@A@ACEAAEC@IAE@MCEBGCCCCAQBCAG@ACI@EFCBCDABMEACMEKE@I@CIC@@A@@EQLGBE@KBMCKAKCEEIEAA@EBGBEHIBEBICMGMCCIIEGICKAIAM@KBO@EAG@IBI@S@UBK@KDEFCH@DBDDFFBPDDAHDFBFDBD@DCF@HF@FAHAFBDDBF@F@F@B@JCDADCBEDIFKFOFA@OFODMBMDGDEFAHCFBF@HBDHLDLFJJNDLDLBJAFCHEDED@BIHKFODSDSBOFC@IDGBKFGBEHEFGJGFGFMHKDOHKBODIBGFCHCJ@HDDFFJDJDPFPHNDLDF@PDRHRJNJJJHJDJDFEFCFEDGBEBGDABCBIFIHGJILGHEJCHCHAL@FBFBFFFHHHHFLBH@@@HEHELEJ@@IJMLMLOJSNGDGFMHKHIHGLKNIJGFA@KHIJSLSNOLQLKJKJCDINENEJGLEJABMLKLMNMLOLKHMBMHILGPAJBJHFHNEBABJLHDBBDH@D@D@@D@B@DBBBF@@@FB@@BB@B@B@HRBDBD@FB@@BEDCFCDAHAF@J@F@DBD@DAJCLAFBJBDADABAFEFCFAB@TDJ@FBBBD@BAJCB@DAF@F@D@D@R@VDPDR@D@DBJ@D@H@HCJCHBPDH@@@L@D@F@FB@@HHDBJHPPHDDBL@JBD@BABB@@FBJBLFJDDBDBF@HADBJDF@HDHFBBBDB@BBB@B@D@D@B@B@B@JBF@F@B@B@BABABB@@B@FBD@FABADAB@DCB@D@PDD@D@F@D@BB@@@DBDFDDAD@D@@@D@DAJAB@D@DAJIVQBABABABCDA@@B@FANCB@B@B@BBL@F@DANEH@LGHCD@FAL@D@H@@@LBF@H@R@LCHEJCD@J@HBJ@FBPDD@DCDCJCDAD@FADCB@FAB@D@D@JANAD@DAHEBA@@DCHBVCFATEDCD@HC@@BB@DJJBBDNRGLHB@BAFA@@HBJDD@F@DAB@BQBA@C@ADALCDCD@@AJ@F@FBFB@BDADCB@@@D@FC@@D@B@DBD@D@DBD@HBBBBBB@@ABCBABA@@BAHCDAHCF@HAF@H@BBBBBD@BDFFDF@F@DBDDBDBB@@@@D@HBDBD@@@B@BAFEDCBCDI@@DE@@ACAA@A@C@A@ABA@ADCBADCBC@CD@DABA@AFAB@@@B@@@D@DDLBFADCF@DADCBADDD@DADCH@D@DAB@LCDBD@JH@@BHH@HFHBHFHAJ@HCJAFBD@@@H@B@DAB@D@FBBB@@B@@@B@B@DB@BB@B@DABA@AA@@ADCB@FAD@B@B@LGD@B@D@B@DCBEBABCPOBA@A@CAA@A@A@@EADA@@ACACEA@AB@VOBABABABC@@BA@ACAHEDD@BPHB@JFDAF@FAFABA@CCABGDA@BB@FB@@B@@DD@DDBBD@B@BBFBDDDABCBC@AB@DDDBF@NGAAB@B@@BBB@@BB@@B@B@BA@@B@@BB@@F@BDB@@BD@@BBHBDBDDB@B@B@B@@@B@DAB@B@B@BB@@D@DBD@BB@@@@B@@@DADABCDABABABABC@@LGBAB@H@D@F@BAFBF@@@BBB@@@@F@@@@HDD@D@D@@@D@DAJCFAFAHCFAJG@@BABAB@D@@AB@@A@A@@D@B@B@@BD@BBF@B@DBBBDA@B@AB@BAFIBCDC@AECG@AAA@C@E@GACAIDCDCAECAACG@ECKAAACFC@AEC@EFIAE@CFM@@FB@BDBD@D@HDRHH@B@F@FA@@DBT@D@B@@BBD@BB@B@B@B@DAB@DBDAD@DCBAD@DAPB@@BAB@FBF@FBB@@@BAFCD@B@D@B@@BAB@@BBB@DBDBBBDBB@HDHBD@B@BBBB@BB@BA@@BC@AB@B@@DB@@BD@@@B@B@BB@@@@B@B@@@BBBBDB@@B@B@BA@ABA@BB@B@B@DA@@B@F@BDB@@@B@B@DBDBB@BBDB@@BD@@B@F@@@FD@@@BAB@@BBDBBBB@B@BB@@@B@DB@B@DADAD@DABBB@@@@@BFB@@BFDBHBD@BDBBBD@D@@@N@BBBB@BB@@@BBDB@@F@FBF@DB@DA@@@@B@DBFDDBDDBB@D@BBB@@@@@B@BAB@BDBHDBD@BBCL@B@B@@BDBFDB@@D@BCBCFAD@DBHDJHH@@CDAHBDDDDD@BD@BJ@D@DAJGB@F@BB@B@BAB@BB@B@DAJE@A@AGAAA@AD@@ACC@@DEAC@CBK@C@C@AHCDAF@FBB@B@D@@@B@DAB@DBDD@@HCB@B@@BH@BDAJBFF@FBDADBFDHBF@BD@XBH@DFHBNAB@HDDBDCD@F@BFBFHLBJFD@BAJ@FCD@FBFAVLFH@B@@@JFD@LSBUBEBAFI@APAFMLKPID@FMFBBDBJA@JGBGACAA@@BDBLRDFBF@@DL@DBBA@CFDANA@B@DBBBCB@B@@H@JO@CBJDb@FCJ@JBBFLANARCPPLCK`AFLF^_JKJBNDN@NBJ@FBAADGBADIAE@QEEAMDE@KDGBEDCLAZKBCVDJDHW@CNA@@H@HBJ@DAXEVDBB@@VBHDDFJ@HBLCLFZZFF@@AJHD@@LHHDJADEDA@CDANEHCHCCOK_CAECEOGGRMBAPANAFCNOdoBB@A@@@KBGGGAGAA@CAG@AMQ@KGQQGEEBCEGEEMQGCGBOE@@IAQ@KCGICEEEAKHWBC@ADG@KA@@A@CAMAIGEAADGAACEAGEEG@KDG@A@K@CAGAGGCE@AAAICC@EEAK@C@AIIIC@@IDAB@@IFAAA@A@K@_H@@@@A@CEAAS@@@@B@@@BC@AA@@AA@@@AB@@A@A@A@C@A@@ACODIBA@aJuI@@@BAB@BAF@@CJAB@BAB@@AB@DAB@D@B@B@BAB@@BB@@CB@ACBKBG@@@A@@CEA]EC@A@OCABAAAD@@BDGAA@@AEEA@EC@@@@@AA@@@BCD@BEC@@AA@B@@IBACUAEAKDCB@BAB@@AF@@@AAAAEICEWAEBEEIDGDAHOH@@KFAJ@@@@EBA@C@GBUD@@@ACG@@@C@@@A@AC@CAA@C@C@EBCBAB@BA@@BEDCBG@G@IBA@@BA@AACECAC@A@CBABA@@@ABC@C@A@A@B@@A@A@@@@@CA@@C@@DC@AB@@@BANBF@AA@@AA@AAA@@EA@AA@A@A@CAA@@AAA@@CA@AA@CECA@A@@BCCC@C@A@AFCA@@@AC@AA@CAA@A@AA@@AA@@@C@@@AAA@AC@AA@@@@@A@@AE@A@AA@CIECA@AACACAEECAAA@A@A@A@@@@@@C@CAC@CDEBABCB@HAD@@C@ABA@AAEAEAG@I@OAEAIAC@MAA@@CG@SEKA@ACAA@E@GA@@E@M@C@C@G@M@A@A@B@CFMBG@CBGBE@EBKBGDGBIBABCD@@A@C@CBGBEBEBE@O@EACAAA@BA@CDG@G@ABC@E@M@M@OB@@@@CC@@AC@@@A@@@@A@AC@ABA@A@@CAE@A@IHDB@BABA@CB@@EBABABAB@@A@AB@@A@A@@@A@A@ABCBGFA@A@AB@@@@AA@@ABBB@@ABGF@@@@A@E@AAB@@ADCAA@@CBCD@ASD@@@A@CAAH@BC@ABECBA@A@ABABCDA@@@ABACB@GWTIbO^KHCFABID@FCJGDEB@AABCB@@@DAFC@@AABAHA@@@A@@@@@@@@A@@@@AGEAA@@CACCA@@AA@@BA@@A@@@CAA@AA@@ACACC@@@@A@A@@@IE@A@@@@KEEA@@A@@@GCA@@@@AA@AAAAGGA@@AAACCCE@@A@@@AE@AA@@@AEBAGCGGABA@AB@BAAC@@B@@BB@@@B@@ABABCB@@@@GA@@@@C@@@A@@@GBCBIBI@BCGAQCAAO@GAIC@EIE@OBIDEgDCG@CBCfEKCKIGGFAACHGOGUAEKJCKGBEAC@AIEICAE]KBACCEE@IEC@@AAC@A@ACBCHGCCA@@@@@AA@AAAA@@AB@@@BAB@@ABA@ABA@@@AB@BA@A@@A@ABABA@@@AA@A@@@A@@B@@AFA@@AA@@A@GDC@A@A@A@A@ABA@A@@@E@A@ABA@EDCB@@AAA@@AA@A@ABABA@ABC@C@C@C@C@C@@B@BC@A@@@@@E@@@@AA@A@@@A@@@@@E@EA@@AB@@@@A@CAC@@BD@@@BB@@B@@@@@@BA@@@AAA@AA@@@@BDDBCBC@@@C@A@@@A@CB@@@A@@A@@@CA@@A@@BA@@@A@EAAA@@@@AA@@A@AAC@CAC@AAAAA@AACA@@@ACACACA@@CAAA@@A@@CA@@@AAAA@A@@@A@@AAAAACA@AAGE@@E@GBC@A@@ACAAAAAA@A@@B@@A@A@A@CD@@@ACC@AA@@@C@AAEAA@A@@@A@AAAA@@@@@@CCAAA@A@@AAC@AAAB@@A@AAAAA@@AA@AA@@AAC@@@AA@@A@AAAAA@@A@@C@A@@@A@@AA@@A@@@@@@AABA@E@A@A@AA@@@@C@@@C@@@@@ABCDCDAD@B@@@BBBBBDFBD@DBB@H@BAB@@ADB@@B@B@B@@B@AB@@CACCA@AB@BC@A@@@KAA@MGA@EACACEA@A@@AAACA@AAAC@A@ABA@AA@@A@A@AB@B@B@@BBBBBB@B@@B@BBB@@@@BA@@@@BB@BB@@@BB@BBB@B@BBB@BB@@DB@@BB@@@@AFADBDABC@@CA@C@AFG@CP@BGB@D@@IBCBI@GD"],encodeOffsets:[[121365,43123]]}},{type:"Feature",id:"150422",properties:{name:"巴林左旗",cp:[119.362931,43.960889],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@QNAB@BEDABGBWHcNEFKLABCPCDOLBVCPAB@@@DAB@@GN@BAB@B@@ABAB@HCJ@B@D@@CLANAF@BCBA@ABCDA@EBA@@BI@A@@BCBA@C@A@ABUDAB@BEFAD@D@F@B@BA@AB@B@@ABEDCD@@@B@BBBAB@@@@A@@BC@G@ECEACBAAA@A@@ABAAC@A@A@AAAA@A@CEAACEAEAAAAFUIIEA@S@MPSAW@EQI@A@@@G@KCAC@A@AA@@AAE@EAAA@F@B@B@DBBKRGBCBGCAAACAA@AA@C@@A@@A@A@A@A@A@CAA@A@C@A@EDCB@@@@CAC@A@C@A@A@@BA@A@@AA@AA@AA@A@@@@AAAA@@@A@A@A@C@A@C@A@@A@AACAAAAA@C@@@@@@@@AAAGCCAACA@@A@C@ACBC@AB@@GA@@GB@@ABAB@@A@A@AAAAAAAC@AA@AA@CA@@ACBA@@@CBADC@EBA@@@ABGFADA@AB@@C@G@ABABA@@BE@AB@@AAA@A@@CCA@@A@AAABCAC@C@C@A@EBA@CAABCDA@C@C@@@GHAB@BCFKEAAA@A@ABEBCBABAFEHAD@@A@A@@@A@AAAAACAA@A@AA@BA@AEE@AAC@@GAAAA@A@ABA@ADCB@@ABEFA@EJABEBABAB@B@B@@AB@@@B@BBBA@GB@@WHC@UHCBSJWN^RCDIFk`AB@DA@EDADABC@EBA@A@@B@B@H@D@FJJBBDBBBBD@DCL@D@BFHBB]ZCB@@MASLDZBBIbE\\AJBBBDBDBDBBDD@B`gTG@CAAAA@A@A@A@AA@@A@C@@A@@@@AACCA@A@AAAAA@CD@@cjIJiR@ByFSBBBBDDD@DDFBB@F@D@BAFABBF@B@@DBBD@F@BBBBH@B@D@DFJ@@AD@DADABE@A@CB@B@BBBBBADAD@DBBABCDGDAB@B@BBD@@AD@BABEBEDE@@F@D@BCDFDBD@BBBFDFH@H@DBDBB@BAB@BAD@BBD@BKFABABAD@BABC@A@@ACBC@CBCBCBEBC@AD@@EBC@CACAA@EBSC@A@AA@EBA@EAC@CBBDBD@D@BEDBBABDBVBBDBFJLDDDHDHFH@J@FCFCFGBMDBDAHDH@FEFKJDHDBBFBFCJCDAHCFCD@B@FAJGFIBK@ADABOFCB@@BF@B@D@@AB@B@B@B@DCDAB@F@@CBEDABC@CBABA@CBA@GDCBADABA@@@ABCHCBAB@BEBCBCH@FEBABABA@@@CBEBGDA@@BCBCFA@AB@DABA@@DCNIDC@A@CBAB@B@BBBB@FBDBFDDFAPABABFDEHABC@ADGJA@EDCB@BBDAB@FAB@B@B@BDF@BCFE@@@A@GBA@A@CF@DD@HH@BA@BB@J@BBDB@DBBCD@D@B@BBBF@F@@B@B@B@BBBBB@B@FDB@DBB@BB@@ABAB@B@BABBB@BBB@@BBBBB@@DB@FF@@@BFDHBDDD@DFB@B@@@@@B@A@@B@B@@BB@@B@AB@BBB@DB@@@B@@@@D@@AB@@BBB@@B@B@BBBB@AD@@@BB@@@@BBB@@@B@B@B@BCB@@BBABC@@BB@@DBB@@@BA@@BADABA@ADCFA@@DAB@@@BAB@BA@@BB@ADAB@BAB@FB@AD@B@DBBA@@BABAFAD@D@B@BBDAB@BABJBD@B@@@BB@@AB@D@BBB@BBBBBDDB@DFBBD@F@DBB@DDDH@BA@@B@DBB@@DBBBFFBB@@@DBBB@@@D@BAFA@@D@BBDBB@B@HBBB@@@BFD@D@BBBB@B@D@B@B@@BBBDDBBB@BBB@BAHIDAB@FBD@B@BBBBB@FD@B@D@D@B@@F@RDFDBBRBD@DAB@BBB@D@BDBHDDBBB@JDD@FDJB@@B@FDB@BBHDDBBBD@F@@@@BB@BB@@DD@BB@D@BBB@BBBBBBF@FBFBD@B@BBBDB@@BB@@BA@BBBBB@FBBBDB@BBB@F@@FHCD@@ABCB@BAD@BCD@B@@BBBBB@AB@BB@BBD@BBB@AD@BBD@B@B@B@D@BBBBDBDDD@D@@@BCBBD@D@BDD@DBBDB@@@@@BCD@@@BBBABB@@@@B@@BBDB@B@B@BDBDB@FBBBBDB@D@@CDA@BD@DADBB@BBBDB@B@B@DABA@GDEDAD@FBBA@@BABA@@DA@C@A@@B@BA@CBA@A@C@CACBA@AD@BBFAF@D@@BDB@@BABCBADADABABA@CAABABC@GB@@CBEDAB@D@@EBA@ABC@ABADA@A@C@CBE@C@A@CAAAA@AD@BDFBDB@@BAB@BBFAB@BBDBBDBDBB@DAH@DD@@BB@@BDBBD@@BDBB@DBF@HCBBD@H@B@BBBB@@BA@ADAHCB@@A@ABABADCDAdKBALBFBPAN@HAF@DADABAF@L@FBB@B@BAB@FBDBB@D@B@DCD@B@F@DBDBB@@JAB@@BB@@D@FBDAB@BBBBBBHDD@DBBBDAB@D@B@D@H@DBH@BBB@NFB@PA@@DB@@BBB@B@DAB@F@BABBDBD@D@@BB@AB@B@BB@BF@B@F@@A@GBEBGDE@E@EAG@MBA@@@@@HDB@@B@@ABB@BBF@D@BB@@@F@@@B@@CDAB@BAFD@HALAXAJ@FBb@JPDHDJ@@BDB@B@BB@@BBA@BB@@BA@AFADAB@BAD@B@BBDBB@DBBBB@D@FAB@@ABAB@F@F@B@BCBABABADC@@@ABC@@@CDC@@@C@@@A@@D@@@B@FB@@B@DCBABALADABEBAH@DAB@BADABBB@BBDBBBD@B@DDBBB@D@BBHBD@DBB@@B@@@FBBDBB@BDBBBB@@DDD@B@PMFCjRbLTHRFDBF@BADAAABA@CBAAABCAC@ADC@AAA@ABA@C@A@C@AFCBA@ABABEB@@IAA@AFCBABADEDE@ACA@CB@DCBA@AHCDAB@DCBAFA@ABEBCB@B@DABABABABE@@BAD@B@B@@AACBC@C@ABCAC@C@ABEBAhKD@BBHDD@D@J@D@FDB@B@DBDBF@D@FBD@F@F@@CA@CE@CBA@E@ACE@ANI@@@CJCBAB@BABC@@FEBA@ABABA@E@ABC@C@AB@D@DAD@B@DA@@@C@A@@@A@EB@@C@AAA@AB@@CA@CAAAAAABAAAAAAA@A@@C@G@A@@@A@A@A@A@ABADA@AA@CC@ADAB@BCAA@G@AEEAA@ABAAC@EFCDCBAFAD@DBBAB@BA@CDA@EBABCDE@@DADCBAD@BADABCBCHAFADBBAB@BAB@BADAHI@@@CBACA@A@CBC@ABA@C@AD@BABA@C@CBCB@AIAABAB@BABE@AB@B@D@B@@CFGDA@CB@BGBAB@@E@@EC@ABCEA@C@AA@A@@@@ABC@CFCBCB@BAAA@AAAEA@AA@AGBADAFAHA@CFE@ADADAD@BCAAAA@CDAB@@EBCB@BCDCDCBAJKA@A@GBAAE@CAG@ACGCAAEAMBA@@BABCAAACADEDCB@@CA@AABEAA@ADCAAA@AA@A@ACCAA@ACA@ACC@@BABAB@DABAB@BBDABEBAB@AEC@AAAA@EAA@CA@K@ECC@CAA@AAAAEAE@@@EGAABE@AAA@GB@@AEE@ACA@A@ACA@AAC@@DA@@CCAAAABEACAABAA@BA@A@C@ABA@ABCB@CE@A@A@ABA@@BA@@@ACAA@@ABAFCDC@ABA@A@@DAB@@AD@BABE@C@@BA@@FA@@BC@@BADA@A@@B@BABA@A@AA@@A@AA@A@@AAC@CBABABABAFEBABCB@DAB@B@@@DA@ABA@C@A@GBCAA@@@@CA@CA@@A@E@E@A@@@@DA@A@A@ABEAA@@@ADA@A@AD@BABA@AB@@ADAB@@C@AA@DABABC@ABCBC@A@@AA@@@@AAB@@A@A@A@ABABAJ@BABA@A@@BAB@@CBAB@B@@AAABA@@AE@@@A@A@AAA@A@@AA@CA@@AB@@ADA@AB@BAB@BAB@AEBABC@@BCBAFA@AB@BBBBBBB@BADAD@@@B@BCDAF@DABABA@A@@FA@ABA@@D@D@B@DA@@@@@ABAAODEBGDGAMHCCOOEFG@G@EDC@@CEEEECCKCEA@DABAFCCCCAEAEI@CAA@EFCDEFEBCDABCBABAD@FC@A@ABACCAACAC@AAC@AC@@@AFE@ABABAD@@@F@F@DAF@D@DAD@BE@AH@@@@ADABIDIKSAEFA ¯@@HCDEHEJCHCHCFA@ABCFGBADCP@BAACFAAE@CEABI@@GCDECGBC@CEGJKDABQCCFWX]`YT@HGVFD@NONBD]OABEKAOEHCHCLM[QCC@@AEDQBAFGEIKAGAWEIAMEC@A@A@KEAAA@GEUM@@@AAA@AEE@AEGKIA@AAGAAA@@BC@@@AA@AABA@AB@@A@@@AMQA@@B@BA@G@W@CALMFEFEC]`MBMJ@FEICB_ACFGEE@ECCKGBI@IEC_FKAIAICMB@@LMWUcHoHEF@BCBCBCB@D@BA@GBA@EBA@ABCBABCDA@CBEBCBCBAB"],encodeOffsets:[[122459,44692]]}},{type:"Feature",id:"150424",properties:{name:"林西县",cp:[118.05545,43.61812],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@CCAAAA@A@@AAA@A@AAA@@AABA@@@A@@@AA@@A@@@AAA@E@@@AAAA@@ABA@A@CAA@A@EBA@A@A@@B@B@B@@A@@BA@@B@BA@A@AB@@AB@B@B@B@B@@ABA@@BA@C@ABAB@@A@A@A@A@@BA@@B@B@D@B@@@D@@@BA@@BAD@BA@@@AB@@DF@@EB@BA@CDEDBBABB@@BB@AB@@@@B@BBDD@@AJIRW\\I\\FFAA@@CAA@@@AAA@ECIAAAC@A@AAABBB@BAFBBCF@DDBBFDJFDBDBHDF@DHB@LBHCDJA@FD@DDFCJDN@B@DDB@AHDD@DBF@DJ@BF@FDBCDCBOF@BE@AJEAETFFLB@B@B@LEBCD@DDBE@GFKN@DAB@DA@@DABGDCHGFBDCHAFGFGDCFEF@DABADADBDCD@DABADBBBF@J@HEH@DBDADGBIJEB@BIDG@CD@DGBCHGDCBADAJCFCFCHDFELCDCFAH@DABAFA@GAE@CAA@@@AD@DGDAFCBCD@FAF@HDDBF@BADAFCFDD@DEDABCNBBCDAB@@@DIFGBEFEBEFAB@DKBE@CFIFCFGD@DLB@FCLBLCFKC@@ABABHPLFKNOJ@H@@ICMLONKPKT@FEBCLIHOROF@B@@A@@F@B@B@BBB@BBF@B@BAB@B@D@@E@E@OA@BBBBBFF@@@B@BAB@@AB@BA@BDAB@B@@@F@@AFAJADAFAJA@@DAB@DCFoA@ABK@A@A@@BCBEDEDCBCBCDA@@D@B@BB@BB@D@@BDAB@B@@AD@B@B@@BB@B@@DB@B@@@B@D@@HBRMJOD@JAH@L@DBF@LCLEDCHEVBL@LCBCHBDDF@DCH@DBFA@BDDDDFBDBHBFBDBFAHAHAfC@BFBHDBJIDHHCDFHH@HDH@DHDLTBHR@DDBFBDDBDFBHEHFLFBDFAHA@@BD@@@@@D@BAD@B@@B@DD@@@BA@@D@@BBB@BBBBB@BB@@@B@@BBB@@@@BA@@B@D@B@@@@B@DBB@@B@@@DB@@@BB@@B@D@BAB@B@@B@DB@@BBB@@BBB@F@@@DDB@B@B@B@@@@@@D@@BB@BAB@@FDB@DDB@@@B@B@BDBFB@BBB@B@D@BAB@B@@@B@BB@BB@B@B@@@B@@@BBD@@@BABBD@@@B@B@BA@@@@BABAB@D@BBDAF@BAB@B@@@B@B@DBB@B@BABB@@B@JADAB@@@BBBBB@B@DADAB@BA@@B@B@D@B@@A@A@@@@D@@@B@BCB@B@B@@@B@@@B@@@B@B@B@HEBCDCJ@BCF@@@B@D@LHPBHCF@JDH@JCF@LAD@D@F@FEFEDCDDHDDBBFFFFF@HFDHBFDJ@DDD@DBF@DBD@DCHJBDHAFD@@@@@B@B@@ABAD@B@BDDB@@@B@@@B@B@@@@B@B@BABB@@D@B@DA@@D@@@BBB@BDF@@BB@@BDB@@@B@@@B@@BBBB@@@BB@@BB@@@BB@@B@@@@@BA@CB@@AB@@@DBB@BBB@B@@@@@BCF@D@B@BBB@@@D@DAD@BB@@DBB@B@B@@BB@BB@BBBBB@DBBBBB@@BB@B@BFB@BBB@@@B@B@B@BBBB@DBBBB@BA@ABA@@BC@@BADCDABA@@B@BBB@D@B@B@B@B@B@DABAB@D@@@BB@BB@B@@B@BBD@B@BBD@B@BB@ABB@@@@BD@B@BB@BB@B@@@@B@D@B@@@BB@@@@B@B@D@B@@@BBBB@@BBBBBB@B@@@BB@BBB@DCF@@BBBBB@B@@@D@B@@@B@B@@@@B@BBB@BB@DFDBBDDBBB@BB@DBDAFB@@B@@@F@@BB@@@B@@@@ABA@ABA@A@@AAAC@@@A@@AA@@@A@AFAHEDAD@D@BAB@B@BCBEBA@@DABAD@DB@@BBB@B@@AFCDAF@DCFCBCDCFABCFCF@FCHAJBDBFEBCCE@CAACCCE@GBC@@BCFCFAFGFCHAAGAEAGBCBADE@GBGDCJEB@FCHC@CBEJIFADEFECGBGDEBEDGFEHE@@BCBC@G@CCC@@CEAEAGBCBADEHEJE@CDEHEJCDEJKFEFABIFCJKPKNIPCNCDMBM@CDIBIBKDUE@CAECECEAE@GAECEBABC@G@KCGCCEG@G@EFIFEDGBIDGDKFGBGD@@E@I@EAECEC@CAAACEACCCCAEAA@AECAAGCACCAECEACEAC@CC@AAC@EACC@D@DADCD@FBJ@FAFCFCDEDCBAFEFEDEBIBIAM@S@GAGCECAE@C@EDEFEGCECECECCA@@CC@C@CBEDEBCBE@ABEDGDEAEDGDCDAJEBC@CBC@GBEAAAE@CBEFC@EBEFEFCFADA@A@A@AAE@EBEBEDE@CDEDCFCFA@AAABGDCDAAC@EACBCBCBC@CDCHEBCD@BAAECC@EAGCEAC@A@EBCBE@A@ACCAG@EBEDCFCDA@A@E@CDCBCDEDEHABA@CBCDCBA@C@EBCBCHEFABCFC@@DAAABEDCBCDABCBCBCDAFC@@HCLCDCAE@EBCBGFGHA@ABCBEBGDEBCBEBCBABCBABABCBEFCBEDCBCBADGDCDA@@ECGCAG@GFGneCAAEAEBCBCJGLIFCEAEAMHA@MBMAMCMAQ@MESJKBMBOBQEKGOAKGICI@KAKBC@OCMGEGMCGEGA@@IGCGE@GCGEEEGECEAOAI@EECAIDGIIAGDUAGDMFGHCFCBA@EDGDGLEBCEGEI@EDC@GEQBODECI@IDEBCBABA@BDDL@JHHDHBDBHBDDFHBBFAFCF@FGFABCDAFABEN@FABCDAJ@DBD@DBFHHDDBBB@BH@DBFB@BBDFBF@JAFADDHBD@H@DGJCBENEAGBCHCF@D@HAF@FEF@JAJ@HCF@LDND@EH@FAHEHBD@DAB@DBD@F@DABCAEGGFANGHINEFCDFB@DDRCDCLAJ@FAAOLMVQHEDCP@EKQGRKRKGEdM\\KFIJAJEKKxO@OVMDIAGUD@@K@IDGBE@QAQ@Q@QEYBQEMCQAKCOBMDQ@GAAAEAIBUJ@@_@UFKJSFWAMDSCEBOHEFCDO@EBIDCBICKAOCS@QAC@E@@@@@K@A@C@E@EBEBG@A@I@A@EAEACAECA@ACECCC@@ECAAA@@BCAGKCAOEK@I@C@[GMIGAOCSCUMW@q@KLcHIHHLBPDHABBFBB@DBLEDAHCBCBCBAFAPA@KFCIMAAPAF@HCDCJC@G@CB@DFFABBJJHFFBFABCFKDCDEDGEEFGB@DADDBBBFJCBG@BBADCBGFJFFBCFADC@MGCBGFCHAJEBAB@BODIAGF"],encodeOffsets:[[120833,44445]]}},{type:"Feature",id:"150423",properties:{name:"巴林右旗",cp:[118.66518,43.534414],childNum:1},geometry:{type:"Polygon",coordinates:["@@U@I@EBG@A@MCG@C@CBCBA@B@C@GFEDCBIBK@C@E@EAGAG@KGIAG@SAYEA@GCEAICSG@CEACA@C@AEEEAC@GAECEAG@GAG@G@GAGASEKAGCKE@@IAKAIAUI@@OCK@ECICOCOAKA@@G@KDIBC@QAYD[DKFQHOFU@M@SBcF[DE@QCkFEAeAiCGAKAA@MAKCcBA@YBODKOQHQLKDODOBQBC@EBOBOD@@IBSBSDYDAAMASCGC]CQ@QCOBOCS@IFQBU@MF@@BBDALLQFUFOHADAFMH@@QFO@aDIB@@VCBHCJUN@PwPLLIFIBEJ[LcNHFQLQLRHFLO@CDGFURKNBPEBI@KBCDQDCCA@CEEDMFGJMHEBHHBFADCBE@C@CAA@CBC@GAGFEBG@@FMCKCE@GDI@IBE@EFE@GBC@E@GDADBHMFAFIDCHG@C@GACCEBIBE@EAAC@AEACAG@@AAACAGCEGCAC@CAI@CBADEBM@AFEBCBADEBEHE@EDEBAAEGCCGACAGAGCIGK@CC@AABABADCF@JDJCFAPFR@HCD@FFJFHADKFCHCH@FABEDGDEHCNBHCVBHJJCHBJFD@FBJBPDFHFFFHFHDF@DHJH@@HBHFNDFHNHPDD@LALBJ@JDLHPBLHRFPANALATINFR@NBNDNBNAB@NGFBFBEDKJIHADADBFBFDBmfEH@HBHHDFD@@CBCDCHABADCDAFEDAFADABABADABADAFADCFAHAFAD@BGBEHAHAD@FBFCDKDGD@@EDCBADADADCBADCDAFBBCB@@EDADEBGFADAD@F@DABCDAD@DABGBCFCFADCD@D@F@BCBEDCDAF@FBHDD@B@BAFAD@F@BBDDFBH@FDDBFABC@ADGFCD@DADADADBD@FBDCBCDAHBB@BEBEDCDCF@DCFAFAF@FBF@B@B@BCBEBEDEFAF@FEDAF@DBFBBAF@HAD@DADIFCBCDCHBFCFCHAF@BAFADCFAF@D@DDD@@DBFDFDFDHDEFCF@F@DBFFDHDHBT@N@JBJAFAFCFEBEDAFCDCDEBE@EAI@EDCBC@C@CDDFBD@BBD@@DBDDFFBFDDBBDHDBBFD@BBBBFDDDDFBBDBB@DFDFDFBJ@F@@@HCHALEHCJCHAFCJEFEH@H@DFHDLDH@D@BAFAFDHBF@FBFDFDDBF@CVALAJCJ@DANCNMDODMJOLILEDAJEBEFILCFIDGFCF@DIFGFCFABADBHBFDF@@DD@D@HADAD@@GFEFCHAFCFAHDHEFCFEBIJAF@DGDEDA@IFCDAH@HCFABADBHBFBHGBEDEHEBEDAD@@AD@HDFDDBB@DDFADEFCAIAGBEDE@EDADEBCDADEDCDE@CBED@BABGBEBEBCDCDADAD@DBHEJAH@D@D@DBFBDDDBHIHEDAFGDCDADAD@DCFEFCF@HDHDFHFJDBDFBDHDDFDFBVHHBJAHCDCHCF@JANCH@H@HABDHBFBJ@JAJCPAJCHCFADADCF@FBFBFBFBNDFAFBHBF@HBF@H@JCDAHGJEHDHDHB@B@DBDBFDDHDDD@BADBDBFFDHBFBDDFBFBHDJBLBBANAB@@DAFCDAD@DAH@FBDBFBBAH@HBDBFDFHDDDHDLDH@H@BDFDHDH@HBLBF@HAD@HBJBLBJAHBDD@BBF@DGVCH@FBDDDHDHDHADBLDLDN@F@JF@FBDBDHFBFFFDDJFNDPBHAJALBJ@LAJEHCHCJIBE@GFBJHHDJBJBLBN@JAHFJBFBLBJBHBHDHFHBHBH@LBJCLCFCFAF@LADADCDCFAHABAAA@A@CBA@@AA@@A@C@IABA@ABAAC@A@A@CBCBEBA@AB@AA@C@ABCA@@EBA@ABABCA@@AB@@ABA@A@@BA@CB@DEBCB@BABC@AB@@A@@AA@CA@@AD@BAAA@@DA@A@A@A@A@@AA@A@@A@@A@@BCA@AA@A@A@AA@AA@@BA@@@C@@A@@@A@@CAA@ABAA@@@AA@@@A@AB@A@@@@@A@A@CEC@CCGAEC@A@@EEA@@CA@AAAA@@AA@AAABA@A@ABABA@@AAA@CAA@ECA@A@AAAAA@A@A@@@@EAEAAA@C@C@ADCAA@AC@A@IAAB@@AGGC@@CDEB@B@HAB@@@F@DE@ACE@A@A@ABA@EBAAC@ADAFCB@HIBCD@BAFGECBABABOCEECCAEAA@AA@A@ABADAB@D@JCDM@CB@BA@CBAB@DEDA@AB@HCFADA@@B@BABAFA@EDGDAFA@ABADADGBA@@B@BABCDAHCB@DAB@BADAD@BAFCDA@@@EBADC@C@A@A@ABA@@@C@AAE@@DAPEBABCL@JAHEBI@E@ADCDEBGDCDIAEAECACGLIFE@ECGBGACNCHADEDE@E@IEGCGCGCCIKAEACUACABAAAFC@A@CACACDAD@FBB@FAB@@B@BTDFAB@DBDBD@FA@@BCD@FADADADAD@DA@BB@D@BA@ABCBABALE@AAC@ABC@ABA@AAAAC@C@GEGECAA@AACECDC@A@C@EF@FCFABA@ABC@@AC@A@ABAHCDCBAAA@CBCBCAAAA@A@ADAB@F@BABC@CBC@@EI@C@C@AAGAA@A@EACCA@@@AAEBABE@A@C@EAACE@CCCACAATAzE@AjQJIdi@@DCB@BBBBB@B@DDBB@@@@@BD@B@@@BBB@B@B@B@BBDBH@hS_@ACCAAACACACAABIF[JaAACYTKNB@@DA^YAAEG@A@CDK@CACAACAAAII@E@C@G@A@AB@B@FAD@BABCFCB@@CBAl_JEDC]QXMTIDAVGD@XG@@HAB@AA@A@A@@BA@@@A@ABABAFABAFIB@FEBA@@DABCB@BAB@B@BBHB@@BD@BFF@BABB@@B@BBBBDBBBBB@@@B@B@@@BCFGBEBADAFABAB@B@BBLFDE@ABAHG@@D@D@B@DCBADBB@FAB@D@D@D@DBBABBB@@@DB@DB@B@BB@@BAF@@AB@BABAH@D@@@BAB@BCHEBA@@B@FAD@BCDA@@B@DA@BB@@DBBB@@BBDBBBBBBB@B@@@BABA@@HA@@HB@@BAD@DA@B@D@BB@BDDBHDBB@B@@@@@@D@B@BBBBBD@B@BB@D@B@D@B@B@B@@@B@BB@B@@B@B@@BBBB@@BB@B@@AB@B@D@B@D@DB@@@@DAFCB@D@B@B@DBB@B@B@B@B@@@@BD@B@@BBBBDBBHDDAHALQAA@C@A@A@EBBFBF@BB@@BBB@D@DB@L@H@@@BRJ@FBXOT@N@TFBJJEVBBBBBFDFBBDFB@B@BB@B@B@BBDAB@BB@B@BBDAFBFDH@D@@AB@@@@@BAAA@A@A@@DCFCBA@@@ABAB@@A@A@E@CBCFE@ABAVCBAB@D@B@DA@AB@J@@AB@FAB@DCBAB@DA@ABEBMDK@@@C@ADI@GBABA@@@ABA@AHM@@BA@C@@BADOAUPKDCDOBALKFEdMXGHABAFC@ABARMB@BADADAFADAB@DCBADABAB@FAB@HAB@@A@CDADADA@AFEpGdGXVKNPIBANINKJEAAA@BANINCFEOtIrGZC¦RD@RlHFBDEFED@CsB]NOHGDKFE\\@@^MTEVEXSNILICE\\MGUO@QgBgyuRKLGXOHEbUXEFBLCBEFOFBBCC@BIHKGMXAAEIGMCKBC@BRUBUEUEGE[EcKGBGFGAGAiOEASEGEO@KGCACCQEEAMCKAW@KCG@GBOAKCI@GCGAECIBOEG@G@CAuKKAI@IAMEQGIAK@S@UGSKGCECMCWEYCcCKACAGAICEAE@A@WEaGC@EAA@A@A@S@SCK@GBABI@G@ECA@G@OLEBG@MDMDOBSCKEEAECM@G@O@oBcAG@ABC@EBIBE@C@GBA@A@K@I@GAAACAG@M@"],encodeOffsets:[[122271,44305]]}},{type:"Feature",id:"150426",properties:{name:"翁牛特旗",cp:[119.00658,42.936188],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@CAAEAC@@@A@ADKAAC@CAAGACA@ABA@@@@@A@AAC@A@CAACCCAE@C@A@@B@@CCAE@EAE@@@CAAA@@A@@AAAAAM@@@C@C@AACA@AACAGEC@AA@AE@@@@A@AACBC@CBCBA@A@@C@A@@AAA@A@AACAAA@@BA@A@@EC@@E@A@@@AC@@CAAAA@CACAA@A@@@A@ACE@A@@@CBA@A@A@@AAB@BABA@A@@@CAAAAA@@A@A@@@@@AAA@A@@@C@@AA@@CA@A@@BAD@@ABA@@AAAAAA@C@GAGCA@CAAACACAA@AA@@BA@AA@C@A@C@EDAB@@A@EAE@EAA@AB@@OACBC@ABCDC@CBCAA@CBA@A@A@A@@AAC@AA@C@S@CA@@EBE@A@G@QGGCC@C@CA@AEA@@EN@DBFEJ@FFD@BEDBDBBDL@FDHBBFDDBDCJCDBHBF@D@B@BBH@FD@BCDADEJABA@@B@ACBAACAA@E@AAC@@AA@A@C@@@@B@BA@@BC@A@ABAB@@IHEBGDEBEBIDCBC@@@C@C@C@GC@@@@@E@@A@AA@@E@EAABE@C@G@A@ABKH@@ADABABABCBADCBCB@@A@@@@@AAC@CAC@@@AAA@A@A@CBA@@@A@A@A@A@CCCAGAAA@@AC@@CA@A@EA@@AA@@@ABA@A@@@AA@@AA@AA@A@BBMHE@CACCA@@BADADCBCCEAAAA@C@AACCC@@CA@@@EAA@@ACBAHDB@DABEBEBE@CBIEA@OG@ACCGFDB@BAB@@ADABABABUPA@@BFBBDBD@@CBFB@@@B@BBB@D@BABOPADABAFCDA@C@A@C@KHA@A@C@EBA@CD@BB@@BABCBA@A@@ACAA@A@@@A@@@AAEAC@A@CBA@G@@@C@EAIBGDI@GBGEGAGEG@AG@@IGC@CAKDA@CBC@G@CDCBC@CCABCDCBE@CDEBKACCC@@@A@@@A@EB@BABCBC@@DADCDABCD@BAB@B@B@D@BBBBD@@CF@@CJADCDEFABA@@@C@CAGAC@@@@@AAACCCCAE@E@ECCE@AACAAAAG@E@GBE@GDCBGDAB@@ABABAD@BA@AAAAGAC@CAC@C@CAA@C@@@EDC@@@A@CDCB@AEAEAE@I@@BC@CDKDCB@B@DABARA@CBE@C@ICGA@@EBABA@KGQHCMAAII@CAA@@GDC@CDSFEBUDGACD@@ABGFCBC@MBIBC@C@A@EBA@CDEBC@CBIDCDCDC@OCEAI@GAI@C@IDGFKDQ@G@E@KA@@G@C@K@EBC@GDKHG@MFCBE@K@AAA@A@A@MDEBA@@@CBADABABABURIJCBC@A@IBCBC@@@C@C@CBECAC@C@@AAC@E@C@C@OCC@A@CDA@CBABEBC@EAA@@@AAABABA@A@E@E@IAA@A@A@C@C@A@A@AAA@ACAAGEGCE@ICCAGBE@CACAICKEIAEA@@AAABC@IAK@CAGCOOIGCAGG@@EAE@C@K@@@G@OCGAIDGDG@C@I@CAC@Q@OCUCQ@C@C@E@E@CBA@IDABC@AAEAI@SCA@EBEDEFABCBCBIAEAKBIDCBC@CAE@I@E@GBCBEDCDAF@DBB@D@DBB@BBDBD@@B@BBF@@@FDDBD@DAB@B@HCD@J@@@FDDBF@FAD@@BB@@@AF@FCF@B@@NHDBHFDBB@DF@B@@T@D@DBB@DBB@@BDBBF@FBF@B@@DBB@BBB@FFDBB@HFBD@@JJFJ@BBBB@JFDF@BB@BBBBBD@H@BD@BB@BDDBBD@FDPHBB@BDBDB@BB@FDFBDBLBDD@BBFBD@BDBB@HDHBFDJBFBFDHFLFHBD@DBLJBB@H@RHFFD@B@DBDB@B@@BBBDFB@BAF@B@DBB@@B@FBBB@BBBD@BBB@H@DBF@B@@@BBDBB@@@HDB@FBD@D@D@H@B@D@F@B@BA@@DB@@B@D@D@B@BBD@BB@@BBDDD@B@DBBBB@B@@BBBBB@B@@B@@BB@B@DBDABBB@@@B@BAB@@A@A@@BAB@@@DABBB@B@B@B@@@BBB@BB@@@@@B@BBBFDB@B@B@BBBBBBB@D@@@BB@D@@@B@B@B@BB@DD@@@BBBDDHFFFBB@@BB@BB@@BB@FD@@DDB@DB@@BBBBB@BBD@D@B@B@D@B@BB@BB@@BBB@@@@D@@@BB@@BBBB@@@B@@@BB@BBBB@B@B@@B@DBB@DB@DB@@BD@B@BBDBB@@BB@BBDBB@BDB@B@B@BB@BBB@B@@BB@B@@BBB@B@B@@BFBBB@@@B@@@@BB@@B@BAB@B@B@DB@BBB@@B@@@B@@@BCB@B@@@B@B@@@B@@AB@B@B@B@@@@A@@@@A@@A@@@@@ABABABABA@@BA@@B@@@HCB@B@@AB@@AB@@@B@BA@@@@@A@@BA@ABABA@@@A@@@AB@B@BAB@@@B@B@@@@A@@BAB@B@B@B@BBB@BBD@DBBB@DB@@@BBB@A@@@@BA@@@@B@@@@BBB@B@B@@@@B@@@BB@@@B@BA@@@@B@@@BBDBD@@B@@@@C@@B@@@@B@@BB@@D@B@B@B@D@BABAD@@@@BBB@B@BB@@BBB@B@@B@@@BCFABB@@BBBB@@B@@AB@B@B@BA@@@A@@@AB@@@B@@@BBDBBAD@BA@@B@BA@@B@@BB@@@B@B@B@@@BA@AB@D@B@@B@@B@@@@AB@B@B@@@@AB@@ABCD@B@B@BB@@@@B@@@BAB@@EBCD@B@@@@@BB@@D@@@B@@AB@@CB@@@@@@ABBB@B@B@@@@AB@@@BB@@B@@ABCDA@AB@@AB@BABEB@BAB@@@BEA@BA@@@@B@@C@A@ABIB@@AB@@@B@@ABC@ABA@AB@@ABA@ADAB@@A@@B@B@@AD@B@B@BBB@@@B@BAB@B@@DB@BAB@BDB@B@@@B@@CB@@@@@BBB@B@D@@BB@BBBBBB@@B@B@@@B@DBB@@BBBBAB@B@B@B@@BDFB@@D@@@@B@@@@@B@@BBBB@@BB@BBBBB@BB@@@A@@B@BB@@B@DBB@FBFBD@BB@F@D@B@@@AB@@@B@@B@DBB@@@@BB@AB@B@B@@BB@@B@@BB@AB@@@@BB@BB@A@@BCBA@@B@@FBBBBB@B@B@BA@@BBB@BB@BBBBB@B@B@@@@B@@AD@@@B@@BBDF@@ABABA@AB@B@@@@BB@BA@@B@B@B@BADADAB@@BB@@D@B@@B@B@B@@DD@@@B@@BBA@@D@@@BB@@B@B@BA@@D@B@BBB@@@B@BCD@B@B@@DJ@BBBAB@B@@@B@B@@ABABA@C@A@@@@BB@BB@B@@@BABA@G@ABA@EBABABA@A@A@@@@BA@CD@BCB@@@BABAB@@@BB@DD@@@@AD@@@BDB@@@B@BEDEBEFA@@B@BB@@@BB@@B@@@BB@B@BBBADBJ@B@@ABA@@BA@@BABABA@A@A@A@@L@DBD@B@D@B@@@B@BB@B@BBF@@BBB@@@B@DB@DDBB@B@@@B@@@B@@B@@BDB@@B@@D@BBBBBFHBLDBBDDBJBF@DFHAJBHBPDB@JBJ@TCFBD@BGBAH@BD@B@BB@FACG@EDCDDBB@BBBFDNDNJBD@BBLHBR@NCPALDRBNDRFZARFR@R@RBF@HAJCL@JAbCP@RE@@NGBEBCPGVEREKKCBAA@@NEV@RAJET@PDPARDR@^DHDTDNBBBZCTCTAJA@@PCPAFAD@RAPAPCLCRKRGLPPCZAB@dALDNBB@LBHBjDfBFBlERDF@\\CdETAN@V@PERGLE\\CZCRBD@JALCH@@@LBPBPDJDFDL@PD@@VJJBLBJB@@LFHDLBTFHBHBH@H@HBH@FBFDHBD@FBFF@B@DDBFB@DTHJDFBHDB@ZFTBH@JBLHH@HBFBF@D@L@JADAFCHED@A@B@DADAD@H@NDB@H@FAJ@V@N@H@DBBBHBJ@L@B@B@HAD@F@JAFAD@BAH@dBpAP@H@N@FDFBLFTDPANCNCH@FAPKH@B@FDH@J@BAHAL@TDT@B@B@B@FBD@bHXFB@F@FBJDHBDBLBdDZDXFNDFDHDTLVHT@L@JBRHNFJBJ@LBvLDBH@H@PFJAFDHBHDJ@LDPBHAH@LDX@LBNDFBRFDDDBLHP@HFTFFBjPHBHBHEHAdL\\FHFVFVFVAAQD@LANDJHBFDARBJHL@PJJDB@PBLBNB`GNBVDTDXBLBPAF@JBXBPD\\@b@J@RFFAHAFAJGZGVGJEJCLBLBJAZDXEB@ZBNDLDXBLB@BFBrA^D^@@@rALBTBdDN@HBTBJBVFNB@@dBD@@@PDADIBXFXLfHLEPGFBHB@C@ATDFDHFrDdVH@RALRXGFB`D@@@A@C@AAAAC@C@C@C@C@AAAEECAACA@G@A@AA@@CACAC@ECOCEAEAGAEAGAC@CAAACCAAEGEEGOAACCCCCEEEAEACCEAACAGEECAA@@@ABA@A@@EC@@AA@AB@@A@AA@@AAAAAMGAC@@@CAAAA@A@AA@@AC@AAE@CBBD@@@BAB@@E@A@E@CAE@C@C@GAAAAAA@AAECCAAA@@AA@AB@@A@A@A@@@@BAB@@@@@@AAA@@@AB@@A@@@@ACAA@A@@@A@@@AB@@ABEBGG@C@A@CCC@AAA@@AAA@@AAA@@C@@AC@@EAA@G@AAA@GCA@AAC@A@@@A@AA@@AACA@@AAA@@@@AAA@@A@@CEC@A@@@CCC@AA@@@AE@@@@@@A@A@A@@@A@@@GACAA@MAAAA@CA@ABIbFAA@A@A@AB@@AA@@CAA@@BA@A@@@CAA@@@AC@C@AAC@A@A@@AAAAA@@@@AAAAA@@@AAAAA@CAC@CA@@C@EA@@AAA@A@AAC@C@A@A@CA@@@CAA@@@@BAB@@@@@@AIE@AEAA@A@@AA@@@@AE@AA@AAC@A@A@@@AA@A@AA@@AA@@AA@@C@AAA@CA@AA@@A@A@@BA@@@A@AA@@AA@A@A@A@@@AAA@AB@@A@AAA@@@CA@@A@A@@@@AA@@BEAA@A@AA@@@@@AA@@@@A@@@A@@A@@AA@@@CAA@@@A@A@@@A@A@@@A@@@AA@@CC@@AA@@@@@A@E@A@@@A@@A@@AA@AA@@@A@@@@CA@A@A@A@A@A@@A@GCAAAA@@BA@@@@DA@@@@@AAA@A@@BA@C@@@A@A@@@A@@BA@@@ABA@A@@@A@A@@A@AACA@@AC@EA@@C@@AA@@CAA@AA@@AAAAAAACAA@@AAA@CB@@C@A@A@A@@AAAA@@AA@C@EAA@CAAA@AA@@EAC@AAAA@E@A@AA@@A@EAA@A@C@E@AA@@A@@A@@@ABE@A@@@A@@CEAA@@@C@AB@@ABA@@B@B@B@DBDBDBDBDAD@BA@A@A@@@AA@EAEACAC@CAA@ACBA@AA@@AAAA@@@A@@@ABCBIJA@@@@@A@@BAB@@A@@@AA@AAAAA@A@A@@@@A@A@@BCBABDF@@BDIFA@CCAAAAAAECACAACAAAAAAAAA@AAACE@A@AB@AA@@AAA@EAAAAACCCA@A@@BA@A@AAAAC@EA@AAC@@@A@A@A@A@@@AA@@@A@A@@BABABABA@ABABA@AB@@ABEBCDABAHABA@EAC@A@A@AAACC@AFGMKEIAKeOEFMJKCGKUKLQIAICECU@GWIEIISKKI[A@@A@GBEDC@EBO@_DGDGDSEKCK@OBK@G@C@CC@AHEFA@CCACAG@IDCFCHCBE@ICWGCDAFFFBD@DC@CBE@GACICCOCCEC@A@C@ÿ¤C@QAqMA@_H}YKDSF]GcOG]EQ@C@GCCEEBGKYFGDMSKK@KGDUEG@@@ACCAAHGB@@AFC@GGGBIAACAIAA@IFC@G@CDA@IA@B@AC@@AAAIAACBCHAF@FCDCBEJEBIFG@MCEECGBEBEDOACBGDE@CCG@GDKEEGBCCA@@AIBEAE@CBABC@CIG@EBC@CDABCK_@@@AK@A@KBIHGDIC@EDC@CI@GMI@IAE@OIC@ICG@C@W]@@E]BWRU`[X_HGXATCFEJK@UACIMWOSG@@QOOEGESC@@CC@AEI[QOKSMICGC@BAAcpMPEDMBOBABQNHHFPFDDBL`DPGDGDMFCB@DCBCFIBGCKG@@GCBI@@EEYYKEKDGAI@CEGCUA@@AAUCWFCBI@GAG@@@MB@DGXICUCADYLKBCDAFCH@LCFBNFF@RBFCJABCHBBEAI@MAM@MCIAIL]`KEBEL_KDOOQDMBKBAEIAI@EDa@ICDAP@@I@GA@A@ADAA@C@AMBCBDEB@AA@CCK@@AECEKQCA@AB@DBHBHA@IIBCAAANE@EJCLONKBEBOJ@BEFAVATA@KEC@I@@@AEGUKEBEAC@EDI@ABC@IEKAEGEA@A@EDCACCC@GBAAMEG@CAG@WACE@GAECCACBEAE@AEBIACG@@AA@A@GD@@CCCAA@CBA@@@C@A@A@EAE@CBGD@B@D@DAL@DBDCF@@DD@BC@@BBBHB@B@BIFCBA@A@@ABA@A@AAAE@A@IHCBC@I@@AACC@CCCCGACB@DG@IGGCCAC@EBADADC@"],encodeOffsets:[[122055,43578]]}},{type:"Feature",id:"150425",properties:{name:"克什克腾旗",cp:[117.545797,43.264988],childNum:1},geometry:{type:"Polygon",coordinates:["@@IHOBIASISGWGWGOGG@AAWKGEA@ICG@GCGAA@@@EA@BA@EDA@C@C@@@UEoNEBO@mDIJILA@YDA@O@]@KBABKFICMECCCE@@@@@AA@@@@A@@@@@A@@@@@A@@@@@A@@A@@A@@A@@@@@A@@@@AA@@@A@@@@AA@@@@@@AA@@@B@@@@@B@@AB@@@K@@B@@A@@@@@@@A@@@@@A@@@@@A@@@AB@@@@@@@@AB@@@@@@@@AB@@@@A@@@@AAB@@@@A@@@@@@@A@@@@@A@@@@@@@@BA@@@@@@BA@@@@@@@BB@@@@@@B@@B@@B@@@BB@@B@@@@B@@@@@@@@@@@@CAA@@@A@AAA@@@A@@@A@@@@@A@@@C@C@C@C@CAA@A@C@A@C@A@@@ABABA@ABABIBE@@@A@@@A@@@@@A@@@A@@@@@A@A@@@AAA@@@A@@@A@AAA@A@AAA@@@AA@@@@AA@@@@@@AA@@@A@@@A@@@A@@AA@@@@@AA@@AA@@AA@@@@@@@@@@@A@@@AAA@@@A@A@@@AA@@A@A@@@A@@@@@AA@@A@A@@@A@@@@@CAA@@@A@@@A@A@@@A@@@A@@@@@@@@@@AB@@@BA@@@ABA@@@@@A@@@@B@BAB@@@@@@@@A@@B@B@@@@AD@@A@A@@@AA@@A@@A@@A@@@@@@@A@@@A@@@@@A@@@@A@@AA@A@@A@@@@A@@A@@AA@@@@@A@@A@@@@A@@A@@@@@@AA@@@@AA@@@@ABA@@AA@@@C@@@@@@@AA@AA@@A@@@A@AA@@AA@@A@@A@@A@AA@@AAA@@@@@AA@@A@A@@@A@@@A@@@@@@A@@C@@@AA@@@A@@A@@@A@@@@A@@@@A@A@@AA@A@A@A@@@@AA@AAA@@A@@A@@@@AA@@@A@@AA@@@@A@@@@@@AAA@A@AA@@@@A@@@@A@@@@@AB@A@B@@A@@@@@A@AA@@A@@A@@A@@@A@@@@@A@@@@@A@@@A@@@@@@@@@A@@@@AA@@@@@@@@@AAA@@@A@@AA@@A@AAAAA@A@@@@@@@@@@A@@BBB@B@@@B@B@@@B@B@@@B@B@@@B@B@@@B@B@BB@@B@B@B@@@B@@@B@BB@@B@@@B@@@B@@@BAB@@@BAB@BA@ABA@@@A@@@@@A@@BA@@@@@A@A@@@@AA@@@A@@@A@@@AAA@@@@@A@@BA@@@@@A@@BA@@@@@@BA@@@A@@@@AA@@@A@@@@@A@@@@@@BA@@@A@@@@A@@@AA@@@@@@@@@@@@@@@A@@@@@@@@@AAA@A@@@AA@@A@@@@A@@A@@@A@@@A@@@@@A@@@@@A@@@@AA@A@@@@BA@A@@@A@E@EB@@AA@@@@@@@A@@@AA@@A@@@@@@@A@@@@A@@A@@@A@@A@@@@A@@@A@@A@@A@A@A@A@@@@@A@@@A@A@@@@AA@@@@@@AA@@@@@@@@@A@@@AAA@AA@@@@AA@@M@A@@AA@@@@@@@@@AA@@@@CB@@@@@@A@@AA@A@@@@@A@@BB@@@@@AB@AA@@@C@@@AAA@@@A@ABA@@@A@@@A@@@A@@AA@@@A@@@@@A@@@@@C@@@@@@B@@A@@@AAA@@@AB@@A@@@A@@@A@@A@@A@A@A@@@@@A@@@A@@@A@@@ABA@AAA@@BA@A@@@A@@@@@@@AB@@@@@@A@@@@A@@@@A@C@@@A@@@A@@A@@AB@@@@@@@@@B@@@@@@@B@@@AA@@@@@A@@A@@A@@BA@@@@@@@@BA@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@A@@BB@@@@@@@@@@@AB@A@@A@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@A@@@@@A@@@@@@@B@@BA@@@@@@@@@@BA@@@@@A@@@A@A@@AA@@@@B@@@@@@A@@@@@A@@@@B@@A@@BAAA@@@BBA@ABC@@@A@A@A@@@A@@@A@@A@@A@@@@@A@@B@@@@A@@@@@@@@@@B@@B@@@@@A@@@@@@@A@@@A@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@A@@@@@@@BB@@@@@@A@@@@@AB@@@@@A@@@@@@@AAB@@@@@@@B@@@@@@@BA@@@@@A@@@@@@@@@@@A@@@@@@@@@@B@@A@@@@@@@@@@@@@@@A@@@A@@@@@@@@@@BA@@@AB@@@@A@@@@@@@A@@A@@@@A@@@@@@@@@@BA@@@@@@@A@@@@@AB@@@A@@A@A@@@@@@@@@A@@B@@@A@@A@@B@@@@@@@@AB@@A@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@A@@@AA@@@@@@BA@@A@@@@@@@@A@@@@@@@@@A@@B@@@@@@AA@@A@@@@@@A@@@@A@@@@@@@@@@@A@@B@@AA@@@@@@@@@@@@@A@@@@@A@@@@A@@@@@@A@@@@@BA@@@A@@@@A@@@@@@@AA@@@@@@@@BA@@@@@@AA@@@@@@@@@@@AA@@A@@@@@A@@B@@A@@@A@@@@@@A@@A@@@A@@@A@@@@B@@B@AB@@@@AA@@@@@@@@@@@A@@A@A@@@A@@@A@@@@@A@@@A@@A@@@@AB@@@@@AA@@B@@C@@@@B@AA@@@@@@@A@AA@@A@@@@A@@A@@@@B@@A@@@@@@@@@@@A@@@A@@@@@@@@B@@@@@@A@@@C@A@A@@@@BA@@@C@A@A@A@A@A@I@A@A@A@A@AA@@@@A@@@A@@AAABA@@@@@@@@AA@@@@@@A@@@A@@@A@AA@@@@@@@@@A@@@@@@@@A@B@A@@B@@@@A@@A@@@@@A@@A@AB@@@@B@@B@@@@@B@@A@A@A@@@A@@@@@@@@A@@@@@@@@@@A@@A@@A@@@@@A@@@@@@@A@@@@AA@@@A@@@@@@@@@A@@@@@@@@B@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@A@@@@@@A@@@@A@@A@@A@@BA@A@A@A@@A@@A@A@@@@AA@@@@@@@@B@@AB@BABA@@A@@@@A@@@A@@@@@A@A@@@AA@@A@A@C@@@A@B@@B@@A@@@C@@@AA@@A@@BA@@@@@@@A@AA@B@@AA@BA@@@AAA@@B@@@@@@A@@@@B@@A@@@@B@@@BA@AAA@@@A@@@B@@B@@@@A@A@@@@@@AA@ABC@A@A@AAA@C@AA@@A@@@A@@@@B@@@@@@B@@@@@A@@BA@@@A@@A@@A@@@@B@@@@@@@B@@A@A@@@AA@@@@@@@B@@@@AB@@@@@@@@@@B@@@@@@@BB@@A@@@A@@@C@A@@@@@@AA@@@@AAB@@@@A@@B@A@@@AA@AB@@@@@@AB@@@@@@AB@@A@@@A@@@@A@@@@@@@@A@@@@@@B@@A@@@A@@@@@@B@@B@ABA@@@@A@@A@@@@@AB@@@BAB@@@@@@@@@BA@@AA@@AA@@B@@@@@@A@@BB@@@@@@B@@B@@@@BA@@@A@@@@@A@@@@@@@@@@@@@@BAA@@@@A@@B@@A@@@@@@@@@A@@B@@A@@@A@@@@@A@@@A@@@@@@@@A@@B@@@@@@A@@@@AA@@@@A@@B@@A@A@@@@@@A@@@@@ACA@@@@A@@A@@C@@C@AA@@A@@@@@@@@AA@@@@@@A@@A@@AA@@@@@@@@B@@@@@@A@@@@B@@@AA@@@@@@@@A@@A@@@@@@AA@@@@A@@@@@AA@@@@@@BA@@B@@@@@@@BA@@@@@A@@A@@@@@@@A@@@A@@BAA@@@@@@A@@@@@AAB@@@BC@@A@@@A@A@A@A@A@A@A@@BE@A@A@A@@A@@@AA@@@AB@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@AA@@AD@@@@A@@@@A@@@AB@A@@A@@@@@@@A@AB@A@@@@A@@@AAA@@@@BA@@@@@AB@A@@@@@@@A@@@AAB@A@@A@@@A@@@A@@@@@@@@AAB@@@A@@A@@@@@@@A@@@@@BB@@ABA@A@@@A@A@AB@@@@A@A@@@@AA@@B@@A@@@A@@B@@A@C@A@@@@@A@@A@@@@@AA@@@A@A@ABA@@@A@A@@@@@A@A@@AA@@@A@A@@@@@A@@@A@@@@@AA@@@B@@A@@A@@A@B@AAAA@@@@@A@@A@@@@A@@@@@@@AA@@@A@@A@@A@@@@@AA@B@@@@@@@@A@@@AA@@B@@A@@@@@A@@BA@A@@@A@@@A@A@@@AA@@@AA@@@@@@@A@@@@@@@A@@@@@A@@@@A@@@@@@@@@@@@@@@@@@A@@@@B@@@@A@@@@A@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@A@@@@A@@@@A@@@@B@@@@@@@@@@@@@@@A@@A@A@@@@@@B@@A@@@@AB@@@AA@@@@B@@@A@@A@@@@@@@@@AA@@@@@@@@@@@@@@@A@@@@@@@@A@@B@@@@@@@@A@@@@A@@@A@@@@@@@@@A@@@@@A@@@@@B@@@AA@@@@@@@A@@A@@@@@@@@B@@@@B@@@AB@@@@@@@@A@@@@@@A@@@@A@@@@@@@@@@@@A@@@@@@C@@@A@@@@B@@@@@@@@A@@@@A@@@@A@@@@BA@@@@@@@@BA@A@@@@AA@@@@B@@@@@@@@@@@@A@@@@@A@AB@@@@@@@@A@@A@@@@AB@@@@@@@@@@@@A@@@@A@@@@@@@AA@@@@@@B@@A@@@@@@B@@A@@@@@@@@A@@@@@@@A@@@@@@A@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@B@@@@B@@@@BAA@@A@@@@@@@A@@@B@@AA@@@@@@@@@A@@@@A@@@@AB@@@@@@@@@@@BA@@A@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@A@@@B@@A@@@@AA@@@@A@@B@@@@@@@@A@@@@A@@@@@@BA@@@@@A@@A@@@@@@@@AA@@B@@AA@@AB@A@@@@A@@@A@@@@B@@@@AA@@@@@@@@@@@@A@@@A@@@AA@@@@@@@@B@@A@@A@@@@@@@A@@@@A@@A@@@@@@@@AA@@@@@@@@@A@@@@@A@@@@@@A@@@@@@@@AAABA@@ACB@@A@@@@A@@@BA@@@@@AA@@@@@@A@@@@@@@B@@@@A@@@@@@A@@@@A@@@B@@A@@A@@AA@@AA@@@@A@@@@@@@@@A@@AA@@B@@B@@@@@@@@@A@@B@@AA@@@@@@A@@@@@@@@@@@@@@@BA@@A@@@@@@A@@@BA@@@@@@A@@@@@AA@@@A@@B@@@@A@@@@@@@A@@@@@@@@A@@@@@@B@@@A@@@@@@@@@A@@@@@@A@@AA@@@A@@@@@@@@@@@@@@@@AB@@@@@A@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@AA@@@@@@@@A@@@@@@@@A@@@@@@@@A@@@A@@@AA@@C@@BA@@@A@@@AA@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@A@@@@@@@@A@@@@@@@@A@@A@@@@@A@@A@@@@@AA@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@B@@@@A@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@A@@A@A@@@@@@@@@A@@@@@@AAB@@@@@@@@@@@@@@@@A@@@@@A@@@A@CAA@A@@@@@A@@@A@@@@@@A@@@@@@@AABA@@A@@@BA@@@@@A@@A@@A@@@C@@B@BADABBB@@BF@BBDBBBDAF@BAB@BBB@@BBBBDBBBDD@DBBBDDBDF@FAD@DDB@FDD@DAD@BAB@BCDCDKLA@CD@B@DABIB@BAF@BADABABA@CD@BAB@DCBABABAB@BAB@DB@BDFBDBB@AB@FABABCFAB@D@DBB@BGDABBD@@DDBBBBANPbwNQOMZkBRVc`A@SRgAiDC@CB[BsNUDSBBEqaDKM[jARCb@Z]CDADITznL^BFBD@@BBBXBFR\\DDBD@@BDNTBFFJDFBDBFFNDJ@@@@RjBD@BZTGfG~@NBFH^@@@BB^@JBF@L@\\BP@JIjGhAF@@WLOHcXMJYNUPaV_JKDWJMFEBKDCBmRKFA@@@@@DFFNK\\HVQJ`QJIBUDCH]n@@iFETEPW^KNIJCBYJBXBN@DB\\X¬ZBL@VB\\ZFbDRBTbdZL^|`v`n\\B@RTErHjLfT`@HADºX®O\\MlSjOB@TLTJhVs¸@B@@LP@FCDABADAD@FAD@DAB@B@BBP@JBB@BB@FB@@BBAB@BB@BBB@B@B@TCD@B@@BBBBBBB@@D@DAB@B@B@@BB@@@@@BB@@@B@B@BBB@@BBDB@BBB@@@B@B@BCB@@@D@@@@BB@@AB@B@@@@FB@@B@@@@B@@CB@@AB@DBBBBBB@@BBB@BBB@LDB@B@BB@@BBB@BBB@@@ABAB@@@BA@@B@@@BAB@B@@BBB@CD|LlHD@RE@@ZPB@@¢@@«|A@@HERUAHLDZHRJ_r@BLTN\\SXABbl`RBX@@U^@@§f@BGX@DCPEVAHdTB@~nBBPPFFJLPRFFDDJLBBHHHJBBBBRTJLJLJLHHDD\\^DFJJPPDFFFFH@@FBTRFB@BBLH^TLHZRB@H@H@FD@FBfBhBnBB@RBZBDR@JBH@R@RBdB`BF@J@B@@@LCRE\\@VAZGF@FBH@L@BBB@@AB@@@BADA@@FCDCBADEB@BA@@D@@AB@BABABABABA@@@A@A@AB@@ABAB@@@@ABA@ABC@@B@@@@@DA@@@@@ABAB@@AB@@A@@A@BA@A@A@A@@@@AAAACAA@@@@ADA@@B@B@B@BADAD@@@BA@@BBB@@@BA@@B@B@DA@@@@AAB@@@B@@AB@B@BABAB@BAB@BA@@B@B@B@B@@@B@@@@@D@B@B@@@BA@@@@B@DAD@B@B@@@DAB@@AB@D@@@@@@AB@@@@@BA@A@@B@@@F@@@BA@@BB@@BAB@@A@AAA@@@ABC@@DABAB@@@BAPED@@@JGBAJGSg@@AACA@@@@@A@@BABAB@@@DA@@B@@AB@@C@@@@DA@AB@BA@A@@BA@@LDTFBBHCDAHEHEDEBADCB@BA@AB@B@D@D@B@DA@@B@@ABA@A@@B@BA@@@@@EB@@@B@B@@AB@@@AA@@AC@@@AA@@AA@CACAA@AA@AAAA@GA@@@A@@@A@A@A@A@C@A@A@@AACAAAAA@@EMAC@AAE@@@@CACAAA@@A@AAAA@AAA@CBC@AA@@AA@@AC@A@@@C@CCAAA@@A@A@AB@@@@C@A@@@ACA@@@AAA@@@A@ABC@@@ABAAC@@@CAAA@@A@A@CB@DCDADAFCFCDA@AB@B@L@BAB@pDE@CBA@CB@BIBEBCBIBE@@@E@@@ABAACB@@ABA@@BA@A@A@@EEAAAA@APBF@F@@@@C@ABA@A@AAE@AAA@A@A@A@EB@@@@APEPQJGDKFA@ELSLOPMNKJD@@@GPILMKEGOBABA@@LDDEAKDK@EKA@CHCDEJEDEF@LA@CBAFEFAFEHAJE@C@@BADCAADMBAFC@CCCDEBEBC@AAECC@GBE@EDCDABEHC@CBC@@B@DBF@HBB@BEBA@CBGDEDCFKCEDGDEDEBIBCDAHCDGHA@CDCH@JC@AFAJIHABCAC@CFG@G@IAEAABCBA@CDCACBCBCBA@CFEDEHCHEBEDGACHEDGHCBA@CB@@CBA@CLMHEF@CA@CDCFA@K@A@AKAEEFSFBBIF@@APEDADCCA@EAEI@@CAE@CCCBGA@CCA@M@ICEDCCC@@EIBDCAG@KGA@CCEAGACECCIAECA@CDEAABE@AAABABBB@D@BBJBFDB@BB@@B@DB@@BBEEJ[X[JQBI@@CCAAA@@@@@BAA@@AA@BAAAFCDCB@@AFA@@CE@@BA@@B@@ABC@AB@@A@@@C@@@A@C@A@AB@@AB@B@B@B@@@BABAD@B@@AB@BA@@@A@A@A@ABA@@BAB@B@@A@AB@@AB@@@@A@A@AB@B@B@FAB@B@DBB@B@BA@@BBBB@@F@B@BB@@B@@@BB@@B@@@B@BA@BB@BBB@B@BB@@@BBBBBDD@@B@@@HEJBPC@ABAFABIDGHEDANHD@BCDEEAIEHEDABCAAH@DAEIAACABC@CHAFEHFFCDCLCDEBAAEEEIGAIBAEE@CDAH@D@DIDC@GBEBONBDJLEB@BOBEDADADABGFCAK@CAAAEBACGAOGKJGdGLKr@X@VNTDPDHBNJ\\HD@J@L@PFDBHLDB@AB@BBFD@@DDFDBDB@FDDBFBFBB@J@B@H@FAFAF@D@B@L@@@@@F@D@RBT@PDLBJDDAJCFAP@DCFEPGFATDNCXBTELIVE`@@@VIJAFBBBAK@AACMIMCECAA@AAACCCD@FDHEBA@@A@AACG@ABAHC@EASDI@IAA@OCGAIAGBCEE@IACAACCAAKEGAAAA@A@CA@@@CA@AA@@@@A@@@A@@@AAACCA@@C@A@@AA@AE@AAA@A@@A@A@@@A@C@AAC@C@KB@B@B@B@BABA@AB@@AB@BA@@@AAIBCAA@A@AAA@@A@@@AA@@A@@A@AB@FEFAFC@A@A@@CA@A@@BC@@@@CCA@@A@@BABA@A@@DA@ADCB@@A@@B@B@B@BABAFAB@BAH@B@BA@A@@@AAAA@@A@@B@D@B@BABA@@@A@A@@@ABAAA@ACI@@@A@ADC@A@A@@AA@A@A@CB@@A@A@AA@@A@@@CB@AA@@@A@@CC@@@A@A@AA@C@@@AA@@BABCBC@A@A@A@AB@@AAA@@@@@ABAB@BABA@@CEAA@@@A@@BC@@@A@@A@A@A@AAAAA@@AAA@AB@@A@A@AAAAAEA@@@AB@DA@AB@A@@AAA@@@@BAA@@AA@@@AA@@@A@ABAA@@A@@A@CAA@@@@A@@BA@@A@C@E@A@@AACAE@EAA@C@AA@@A@AB@@@A@@AAAAA@AAA@@AAAA@@@A@@@@@A@@C@@@EAAC@@@A@A@ABAAAAA@@AA@C@A@@@A@AA@AAAA@AAA@@@C@AAA@A@@@@DA@@@A@@@ACA@ABA@ACA@@@ABA@A@A@@AA@A@A@ABC@@@A@AB@@@BABCB@BA@@BAB@BAD@BA@@@A@@BA@@JABAB@D@@@@A@@B@@AFB@A@@BA@AFABA@ABA@@BAB@DCBA@@@AA@@A@@BA@@@@@A@AAABA@@@@@@DA@@BA@@@A@@@CA@@A@@@@@ADCFA@@BA@A@@@A@@A@@A@A@ADCBA@@BA@@@@@A@ABA@@@@@AA@@@@A@CBAB@@A@@@A@A@A@@AA@@@AB@@A@AB@@ABCAAAC@A@@@A@@BA@@B@@@B@@A@A@ABA@@@AA@AA@AA@BADE@A@@@AA@A@AA@@AAA@A@AA@@@@BCBA@A@C@A@A@A@CA@@AA@@@@@@AD@@@@@@AC@CAAA@@A@@@@@ABA@@@A@@A@@@A@@A@A@A@AA@@@@@A@@B@@A@@B@A@AA@@A@@CAACAC@AAA@AAA@A@A@A@AB@@@B@@A@A@@@A@ABA@A@@B@@@B@@ABAB@BAB@@@B@@@@ABA@@@A@@BA@@BA@A@GD@@A@@@AB@@ABABABAB@B@@@@@BB@@@@@@B@@A@A@A@A@@BA@@@A@A@@@A@A@AD@@A@@@A@@@AA@ACAA@A@A@ABA@@@AA@@@@@A@@AAEA@AA@A@A@AA@@@AAA@@@AAA@AAAA@A@A@ACA@CAAAA@@AA@CAAAA@C@@AA@@CCAA@CAA@@@@A@AAAAAA@@A@@@A@@AAAA@@AA@@C@@@@@AA@AA@@AAAA@C@A@A@C@C@AAA@AAAA@@CAA@CC@@ECA@@AA@@AAA@@AAEEGECCAA@A@@CCA@@A@A@A@A@@@CAA@@C@A@AAAAAAA@A@A@ECAA@A@A@@@@AAA@AA@@A@A@A@A@AACB@@A@AB@@@B@BA@ABA@@@A@AACBCAA@A@@AA@@@@AAAAA@AA@A@AACAA@C@CCAA@@AAC@AAA@C@C@A@@@CA@@ABA@E@C@A@G@C@C@C@EAA@GC@@A@CAAA@@A@E@CAG@A@AAC@AA@AAAEAA@@@AA@C@ABE@AEAACAA@@@ACACAA@C@EEQGG@A@IAKCAC@GAKEGEECEAIAECGAGCA@CA@AACAE@ACCKACAEAECA@@ACACA@AAAOGECC@AACC@AAAC@@A@GACAAAAA@@ACEIEA@AA@AEIII@@ACGEA@CAEEA@AAA@CA@@@AAE@EAECA@AA@CAA@CAC@S@@@@ACEA@CAGECAMG@@@ADE@EBE@@A@@AC@EBE@CAEC@@I@C@GDA@A@CBC@CAEC@@E@AAA@@@ACAC@AAA@C@CAA@C@@EAC@CAQA@G@A@AAA@@EA@@E@AACAA@C@@@@C@CCGAAGCIK"],encodeOffsets:[[120625,43638]]}},{type:"Feature",id:"150429",properties:{name:"宁城县",cp:[119.318876,41.601375],childNum:1},geometry:{type:"Polygon",coordinates:["@@KCG@K@MEICCE@EBC@EAOACCEGCE@E@EDIDGBIFKDA@KDKDOBC@E@IAIBM@MBKAK@QCA@MAIAMDMBO@I@IAMBE@MBUBIDKDOFIBIBI@IAS@M@I@KDIBEDEFIFABE@GEGMGCECI@G@K@GAC@C@GAKAK@KAMDMHGFABCBCDGHEHCFADKLINEHCJAJCHDFHPBF@DADABC@G@CAEAGCEACAIAEAGAMEWGMCI@KCI@ECAIAIAMGOECIAC@IAMAKBKDMDMDGBEBKFQFONKFGDIBAAC@MBOAIAGAIAI@KAOBQ@ODO@IBC@MF@@IBI@C@G@MAOCA@C@K@A@I@GBGDGBA@EAI@GCGEEAICE@E@OAK@I@K@SAICC@K@KAMAOCGAICCAEAA@ECCG@G@ECCACEEIEGAMAIBGDIFGFKHIJEHCDIJCJ@J@H@J@JAH@J@HJJJHFFBDAFCDGDGDIDCH@DBLFPBL@JEHEHEDIHEHKJKFMDGBIDIDMFOJQBIDCH@HDDJDL@J@HBHBDFBFFJHHDFHJJJBFAFCJEJEFCNEH@J@LJLHBBDN@DBBB@@@D@@DBBBDBBB@BDBBBB@B@B@DADA@AB@B@BB@@DBB@B@D@@@B@@B@@BD@BB@BDFFBD@@@@B@BBBB@@BABB@A@@F@D@BAF@HADABAF@DCBAD@DCFBD@DAL@BBB@BBF@N@H@HBHBF@B@DA@ADBD@J@LCDBDDJHFD@FBDHDDBD@@BDB@F@HAHAF@JCD@DBBDF@DDBDBDBAH@BDBNBDBBDBBBBF@DBBFFBDBBBAD@@@D@DA@@DGDCDC@EBAB@BHFHBDDF@@B@B@D@D@DDFCFAB@F@DBBBAJ@@@B@@B@B@@B@H@@@FB@@FBBFBDDBD@F@BBDAD@B@HBD@@@DAFBBDBDLDDBFBFBB@B@D@BB@DBJFBFDFB@BH@D@@@FAFAF@FAF@D@D@DBHDFDFFBDDBB@DBBBB@D@@BDBBBFBBBDBFBF@F@B@@BA@AB@BAB@B@DBB@B@@@B@@BB@B@@@B@@BB@BBB@B@BBBB@DBD@@@@B@BBBDBB@BB@@@BCF@BBBBBBB@@@BBD@@BDB@BDBF@BBBBBFBB@BBDB@@BD@BABADABBBAD@@@B@@FBHD@@@DAHAFHD@DBFCDB@@BD@D@BB@D@B@B@BDBFDD@D@DDHFF@DBB@F@BC@CJ@NADCF@DBFDJBB@DA@@DBB@@@BA@@BA@@B@@BB@@BB@B@@@@@BB@B@BBD@B@BBBB@F@B@@B@@@BBBBBB@B@B@B@BBB@BBDDD@F@BBB@@BDBDBDBBB@BB@@@FAB@B@BBDDDBB@D@BB@BD@BAB@B@@@FDDBF@@BD@@AB@FCDAB@B@B@B@B@B@B@B@@BB@F@FABAFAD@D@D@FAD@DABABADBDBBBD@B@F@B@D@B@BDD@BBF@DBDBDDD@F@FAH@FAJAD@HABAJAF@@BB@FAF@FBD@D@D@DDD@D@D@BBDBDBD@D@B@B@D@BBDBBBDDBBBBB@FBF@JFBFBBDBFBF@D@F@DBFBHDFDDBB@@@D@FBD@DD@B@D@BBBD@D@B@JBDBBCDCBAB@@C@@@C@A@CBAB@DBB@BBD@D@D@D@D@FBCDFAD@FADADADCFEBAEACABA@ABEAAB@DCB@BBFEGA@@DCB@B@H@F@DDB@DDB@BA@AB@BADABCBABADCB@@ABAFG@AB@@@FA@@BABBD@BBB@DBDBBBHFBBB@DDBBB@BBB@@@B@BADADAB@B@D@BBDBB@B@D@B@FABA@@D@BBB@@@DADADAD@D@B@H@@@B@D@B@B@B@D@D@D@F@F@F@HBH@J@DAH@D@BAHBD@DBHDD@FBHBB@DAB@D@D@BBDBD@@BDBJBDBDAF@@ABA@@BA@CDABAHADADBB@DBB@B@B@DABADAB@FCD@D@B@@@BAD@B@FAD@D@@BFBDBL@F@DABADABAB@FCBBD@FBD@DBBBDBBB@BB@FFDBDBBADADAFAB@D@AAACCCACAAACAC@A@A@@B@@ABA@AB@AA@CAA@E@A@A@@AA@C@AAI@CCGACCAAACCCAAAC@AAAAGCAA@A@AA@AAA@AA@A@A@AAAAA@C@A@@@@@@@C@ABADEBADCDADAB@B@B@BAFABAFAD@BADABABA@AAAAA@AAA@A@AAAA@@A@CAEAAC@@@AAC@@AA@@AAACAA@AA@ABABAB@B@B@D@DBB@B@B@BABADEBABCBC@A@C@A@ACAGCEACAAAAA@@@A@AAAACAAEC@AAA@CBC@A@ABABABA@A@ABAB@@ABAAA@@AA@A@@BCDABABC@ADADEBA@C@CAAAGBC@A@@@@@CBA@CAA@AA@CAA@@AA@@A@A@A@@@A@A@@BAB@BA@ABA@@@@@AAA@AAA@A@A@@DC@@@@@A@@@A@@@ABA@A@@B@@@B@B@@@B@BB@BB@@B@@@BB@@@BBB@B@@A@@@@B@@A@@B@@B@@B@@AB@B@@@@@@@@A@@@@@@A@@@@@@AAA@@A@@A@@BADABAB@BA@@@C@A@@BADA@@B@DCDABABCBABCBAAA@@@CBAB@@@DABAB@@@@AB@BAD@B@BADADABA@@@@D@H@D@D@BAB@@@@A@@BAB@@AL@B@BA@AB@BAB@@AFED@@@H@BAB@D@B@B@B@FCDC@@F@B@JCFABA@@BADI@@@A@@@@@AC@@@A@EAC@A@@@@ABAFCBABCB@DC@C@A@A@A@@AAAAAAA@AC@@E@ABABA@ABA@CBCB@@@AAAAA@AA@CD@@@A@A@@KB@@EB@BAB@@@@A@A@A@A@@AA@A@AAA@A@A@@@A@A@@@A@AB@@ABA@@AC@A@A@A@A@A@A@AB@@A@A@@A@@@@@ADC@@BABC@AB@BC@@BA@@A@@AA@A@@@C@A@@AA@@ABA@@B@BADA@@@@@AAAA@@@@@AAA@AA@@@A@@B@@@@@B@B@BA@@BB@@B@@A@@@AAC@@@@@@B@B@B@B@BC@A@A@AB@D@D@B@@ABA@@BA@@BABAB@B@@A@@@A@@@A@@@@AA@A@A@@@A@A@A@AB@@@@@D@BB@@@A@@@@@A@A@@BBBBBB@@@@@AB@BA@@@@DBB@@@B@@@@ABA@ABA@AB@BABABABA@ABAD@@ABC@@@A@@BC@A@AA@@@A@A@A@A@C@@@A@DGDKDK@KAE@GCEAAEAMCMGKIEIAMACAI@@BAAC@@@A@AB@@ABA@@@A@A@E@AB@BAB@@A@A@A@AAAAAAAA@A@CAC@CBCBKIIEIEMAOA@@GAOCK@M@GCAABGFED@LGLCJEFCAGCICCCAAAEACCBE@CFG@@DGHKDIDICICGAEGACAEDCDCFDH@FAH@FCBE@ECCCAKAGCGCEGCMAMAGAE@I@K@KBKBKAAAA@EEACAC@C"],encodeOffsets:[[122102,42307]]}},{type:"Feature",id:"150428",properties:{name:"喀喇沁旗",cp:[118.701937,41.927363],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAAAA@@@@@ACEEACA@@AAC@@@AA@@@C@A@A@CA@@AAA@A@@BCBCBA@A@A@AACA@AAACAAACA@@@C@@AACAM@@FAFEACFKBECCCECK@KBE@AFEFGFEBE@KBG@MBG@ABGBEBKFIFIHKLEPCP@BALFPFP@JAHGJGHIHKJQJIFMDGBC@KFGFBDELEFAHBH@N@N@HAD@BCHEFKFIDEFCFCLAHBHDJDLBJ@DDLBH@NBFFHFPLNHJJFJFNBLBVAPATBNBL@LALCJIDGFIBGAI@IDKHG@ANINID@DANALDLDJFJHDHBDJLFNDLFJ@HBJDHBD@BBDFLHLNJJHHJBHB@@JALCHB@BB@B@@BB@@BB@BBD@@BB@BA@ABABAB@BABA@ABCBA@C@@BA@A@C@@@A@CFABCBCDABEFA@ABA@ABA@CBCBAB@@@BCB@@@B@BA@@BCBABCBCDA@C@@BBB@BCBEBABA@E@@@A@@BABEBCBID@@@BB@@BE@ABC@@@DBBJ@B@@BD@B@@F@HBB@DBDBD@HBB@JDB@DBDBFBD@DBD@@@@@@D@D@@@BGBAB@BA@AD@B@@BBBBB@B@@@BB@@@DBBB@@BD@@@@B@BDB@B@@AB@@CBA@@B@@@BADBB@H@D@BDDBF@BDBDB@@@B@@@BBD@B@@AB@@C@A@AB@@CBC@A@@@CD@@ABA@@BA@@BAD@@A@@@AB@@A@BF@B@@AB@BAB@@@@AB@@AB@@A@A@C@@@A@@@@@A@@BMDI@E@IAK@IBIFEFEBIDABCFDDF@H@BBH@R@NBVDJF@@HDDFAH@BHDBDBBB@DDB@@B@B@@@BA@@B@@BB@B@@AD@B@BCBADAB@@A@E@@@CB@@EB@@AB@BA@A@A@@BA@@B@@BB@B@@ABABCBABABABABAB@D@@BB@B@B@@@B@BA@@@A@ABCBA@@B@@A@CBA@@@@@JD@D@JLFNFBDNFBADCDAFEJ@BDH@DBRADBDDHDFANDF@JBD@BFFBBDB@@@FCBBD@B@BBD@F@B@BABAJEB@@ABA@ABA@@BAD@B@B@B@BABA@@FADC@@B@@@DABA@ABCBA@A@@@A@@@A@@@AB@DADBD@@@DAB@@@BBB@BBF@BAB@BBB@@B@@BA@@@ADBB@BAB@B@B@@@@@BAD@B@B@HB@@BAD@B@@@DAB@@@B@@@F@B@B@@@BA@@@@@A@@BA@@BA@@D@D@@@B@D@BBBAB@BAF@B@BA@@@@B@BBB@@@B@@@@@B@B@@@@BBB@@@@BBB@D@BBB@D@BA@@B@BBJD@@FBB@H@ABDFHDF@D@B@B@DFFDBDD@JBBFDDDBDB@@HCJ@DAJA@@@CHA@ADOH@BED@B@@DD@BAACBCBE@@@@AA@@CA@@AAA@AAA@A@AAA@@A@@AAA@@A@@B@@A@@A@AAA@@@@AAAAAAA@@@A@ABAB@B@@@BBB@BAB@D@BB@BDBBB@BB@B@DFDBFBB@NHB@LB@@B@D@@ABAB@DDDB@@BAA@@@@A@A@AA@BC@@BA@A@GAA@CACCEAAAA@A@@@ABCDCDCBA@@@@D@@@D@@@@@BBB@B@F@B@BA@B@@@@B@@@BB@@@B@@@B@DB@@@BBBB@B@BB@@B@@BD@BB@@BBB@@BBBB@B@BA@BB@BBD@BB@B@BBDD@@@@@@BBBBB@@@B@B@FBBBD@@@B@@BDD@B@@DCB@B@B@@@@AB@B@BBBBDB@BB@D@HAF@@@HFBBB@BDBBBB@@@B@@@BBBBB@@B@@DB@@@BBDB@@DBDBDB@B@@DBBBB@BBBBD@DBD@BBB@@@BB@@@@BBFBB@@@B@@AB@@@DB@@B@@@@B@@DAB@@@B@D@@@D@DACAAC@@@@BBB@BB@@B@@A@@@@A@@@AA@@C@@AD@DBB@@@@@BA@@FBF@@@@@B@@@B@B@@B@@F@@@@@B@D@@A@AD@D@D@D@D@D@BAB@BABAB@B@@BB@BB@@DAFCB@BAB@F@@@B@B@BAB@B@B@B@D@HCB@@@BB@@EB@BA@@@@B@@@BBB@@B@BABAB@@@@BABA@@B@@AB@BAB@BA@AB@@A@@BB@BB@BBB@@@@B@DDGHADBDB@D@BB@@FD@JFFDDAB^LBFJDJF@BBDAFLHIDFLVBPHGHBDEBHHLJLDeFAD@DDHhCCFAJ@PJF@FJDHBP@BBRDHBADJ@JADAHA@@B@@@D@@@@@HB@@@@DABABA@@@A@@AA@@@AD@BB@ABAB@BAECGEJCTCLEJCB@AAIG@@WIMOQS@@@@EESYD@AAA@QUKOCC@@@@EEBOAWFC@AA@AABEjLB@B@BA@@B@@A@AA@AA@CAA@A@A@A@@@AA@@A@@A@A@A@A@@@CCAA@@@@A@@@@BAB@@ABKD@@@@CB@@A@AB@A@@@A@A@@@A@A@@B@@B@@BAB@B@@@@@@@@BB@BA@@@@BAB@@@@AB@@@B@B@@A@@@AB@@@B@BA@@B@@A@@@A@@@A@@C@@AA@@@AA@@AAA@A@CA@@D@B@B@B@@@@ABA@@CACA@@@@@AA@B@D@AA@@@AD@DAH@AAEC@A@@BA@@AAAA@@@@BA@@@@E@@@CD@@AA@@@A@A@@@@@@@AB@F@@A@@AAE@@@AA@@A@@@A@A@C@@@A@B@DC@@@@AAA@CAA@@@BA@@AAAC@@@@@E@A@@@AB@AAA@@ABADAAA@@JCDBFCJANE@D@F@BB@BABA@@BAB@BADAB@BAB@BABB@@@@BA@@B@@@B@BB@@B@B@@A@@JAD@@A@@@A@@@A@@@A@A@AA@@@A@@A@@BCD@DADADDB@@@B@B@@BB@@@@A@@B@@@B@@@B@@A@AAAB@BADEBAFEDC@@@@@@DAB@B@@@@@BAD@B@D@B@FBBBBBB@@@BAB@@@D@DBB@DABBB@F@@@B@BB@BBBB@DBD@B@@@B@B@@BB@B@BBBBB@B@D@D@B@D@@AB@@AB@@@@@DA@A@@@@B@BD@@DDB@DBB@@BBB@@BBBB@B@@@BAB@@@B@@B@DBBB@@BBDDFB@BBD@BBD@D@B@@BD@BBBHF@B@BBBD@@BB@BB@DF@DA@@BB@@BB@@B@BDB@@BD@BB@BDF@@B@BBD@JAFAD@D@B@BA@@B@BAB@@AD@B@@@BA@A@@AA@@AA@A@A@CBA@ABAB@B@@@B@B@D@D@B@@@B@B@B@DBB@@@B@@@BC@@@@B@@A@@@C@A@A@AC@@A@ABABA@@@@AAAA@@@A@A@ADA@@@ABCBAB@BABA@@BA@AB@BADEBCBA@ABABCDABADCBABA@A@A@@@@B@BABBB@BBB@B@DAD@B@BBB@BB@@D@B@B@@BB@@@D@BB@@@@@B@@A@@D@@DB@@B@B@@@H@C@ABDB@@@B@@B@@@BB@BBB@@@DB@@BB@@@B@BBBBBADBB@@B@@@BAB@@BB@@AB@@ABB@@@B@HAB@H@NAD@BADA@AB@BCBA@ABABABABCBB@BAB@B@BB@B@@B@@B@@BB@DAB@BADEBBBCBADAB@@BBAD@HC@@A@CAAA@A@@DABCD@@ABAFABA@@B@BAFA@@@@ECIACAGA@@FE@A@A@C@A@@BC@AB@@CDGBABC@@FED@DCBAF@B@BABABCDC@@@AB@BA@ACAA@EAGGA@CACAA@@@AC@AABABAB@B@@E@A@@@CACACAA@EAGAA@CAA@EBA@MACAEAC@M@C@C@EBA@C@EAA@@@CBEBG@EBA@CD@@@@A@@CBC@C@CB@@A@@BC@@@ABC@A@@AC@A@AA@A@A@@A@@@ABAD@BA@@D@@@@AAB@A@@@AA@@A@@@@B@B@BBB@@A@@CC@A@@B@B@A@@ABANGB@@AGCA@@ADABA@@@@AA@@@@DA@@@A@@DA@BBAFAB@B@D@D@FAD@DB@BB@DABA@@CCAA@@@A@AB@B@@@HDDA@@FF@@B@@@BA@@BAF@@DDDDBD@D@@@@BAD@@@B@@F@@D@@D@B@D@BD@@B@@@@@@CB@F@D@@B@@B@@ABA@@@A@ABA@@DAFAD@BBD@DAB@@BB@@BD@HAB@DBFBB@@@D@@B@@@BBB@@@BDBBBBBLH@BBD@@@@@@@DAB@BAB@B@@BB@@BB@BBB@@BB@@A@@B@@BB@@B@@@BEB@D@@@@A@@BABBDDB@@AB@@A@B@B@B@BAF@BBD@DAD@@DB@@FDBA@@@DB@@BAB@B@FCBBB@@@@H@B@@@BBBDB@BB@@A@C@@@@@B@DDB@B@B@D@JCD@B@DBBB@@F@FAFAHCB@@AFAACA@DC@ACBAGA@AA@@B@@@BAA@B@AA@@@@A@@@@AA@@@@AA@@AAA@@EG@@C@BA@ABA@@B@@A@AB@D@LBD@B@BBD@F@H@B@NAB@DAB@HBBBDA@B@@REJC@@B@J@F@@@BA@A@A@AAA@@@A@@@A@@@@@AB@@A@C@@@AA@@@AA@@@A@@@A@@A@@@@AA@@@@AA@@A@@A@@A@@AA@A@A@@BA@@BA@@BA@@@A@@B@B@B@@A@@B@BBB@@AB@@@B@BAB@BABABABA@A@@@@@AB@@A@@@A@@@A@@@@AA@@A@@@@AA@@AACA@AA@@@A@@@AA@@AA@@A@ABC@ABABA@A@AAAAEA@@A@A@AA@@@@A@@A@@A@@AAAAAAA@AAAAA@AA@@@@@A@A@@BCBA@@BAB@@@@ABA@AA@@A@A@C@@BABABAB@BAB@BA@A@A@AAC@@AA@AAC@AAAA@@AAAAAA@AA@@@A@A@A@@@ABA@@@@@A@AACAA@@C@A@EBCBCBABCACAEEA@@AAACAAACAC@EAC@AAEDA@ABCBABCBE@K@CAEA@AC@C@EBA@C@AB@@A@C@C@EDA@CBABCBA@A@A@CAA@CACBGBABCB@DAB@@AB@BE@CBCAIACA@AC@CAAAC@C@A@CBA@GAEAC@GCCAC@GAABC@G@CBI@G@GAE@E@E@C@C@C@A@A@A@C@A@@@G@A@C@C@CBCBCB@@A@AAC@@@ABEBA@C@A@A@CAAAC@A@A@CBCBABA@@@A@AAA@AACCA@AAGEAACACAA@AAC@AAAB@@EB@@A@@BEHAB@BA@CDABABADCBABA@@BABA@CCA@CCE@G@A@A@CD@@HBEFAAA@CDA@BBAF@BABDBFBABEFCDCBCBEBC@EBDCEAC@C@C@C@C@AAA@CAA@AB@D@B@D@@@DA@ABCDADCAIAA@C@C@AA@A@C@ACCC@EAC@@@A@CAECGCEACAE@C@E@EACAAAAEIEE@EAA@AAAACCAACAAAC@A@A@C@C@CACAAAC@C@C@CCC@C@C@EAE@EBA@@AE@IBABGBC@IBEBG@EBE@C@CCCACAE@AAC@ACA@C@A@E@A@C@AACACAABABCBC@EBC@C@C@EBABEBE@A@@AA@A@A@A@A@A@A@A@CBEDA@@BC@@AE@CAEC@@A@A@ABC@@AAAC@A@CACCAAA@A@EB@@A@@AAACACACA@AA@AAE@C@CCAAA@AAA@A@A@A@AAAA@A@@@AA@E@A@AA@A@AAC@A@AAA@@@@A@A@@AA@@AA@@@AB@@AB@@A@CA@@CBA@IAECCAE@CDMBI@@DADE@A@CAE@GECCC@C@ECCA@A@A@A@CAAC@C@@AA@DCAE@CGCBEBG@C@@GCEA@@@A@@BCAABABCBA@AAC@@CAAAA@EAAAAA@AAEACA@AC@@AC@A@@AAAAAA@ADE@A@@AAA@CAAA@A@A@@C@CAA@AA@A@AAA@AAA@@@A@@@AAA@@@A@@@AAA@C@ABA@ABAB@@AA@E@E@EACAAAEAAACA@AC@A@AACAA@CAACEEECGCCAC@C@E@EBE@EBEB@@C@G@@AEAECEAAI@CAAC@A@A@EAEACAKCACACEACB@@C@GAA@C@CBAAE@C@CAACAEEA@@EA@@G@A@@@@A@AA@@@I@ABAA@C@EBADECE@C@C@C@A@AE@CCGAGE@ABAFAD@DCHC@CB@@C@C@@BCAACAEAAECAE@AAAAACCAMACA@ABGCACACA@CCEAA@CDC@IBEBG@G@ECA@AC@CAGCAC@EECIGCCCAKDI@C@CA@BCBA@E@GAGAG@M@E@AAA@AAK@CBC@EACDC@ABCDE@ABCBGBE@ABC@E@@@@BAAAB"],encodeOffsets:[[121199,42582]]}},{type:"Feature",id:"150430",properties:{name:"敖汉旗",cp:[119.921603,42.290781],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@B@@A@@@@AA@@A@@A@@@@A@@B@B@@AB@@@@@A@A@@@@@A@@@@AA@@@@@@@@BA@@DA@@@AB@@@@AB@@A@@@AA@B@@@AABA@@B@@@BA@@B@@@@A@@@@@AA@@A@@@A@@@@@@BBD@@@@@@@@A@@AA@A@@@@AA@A@AA@@@A@@@A@@@@A@@@AA@@@@A@@@@B@@AB@BBB@@A@@@@@AAA@@A@@@A@@@A@@@@@A@A@A@@@A@@BA@@@@@ABA@@@A@@B@@A@@@ABA@@@@BA@@@AB@@AB@@A@ABA@@BA@A@A@@@CAAAA@@@A@A@ABA@@@@@@@@B@@BD@B@@@@A@AB@@A@AA@@AC@@AACCAAA@A@@@@@CB@BA@@@A@CAA@CAA@EAA@A@ADABA@ABAB@@@@AA@A@@@AAE@@ACA@AEAA@CBABAB@@@BA@A@@AAEE@@@@@@@@B@D@KEOAQA[OOBI@MDCBO@OBO@OCGMKKKIOCMAUEOSQICASIUIMCMIGEECEASIKBQDMHC@aLQMOQOGQIMCOAWISGSIIEKG[GOIMKB@DMJGLEB@@BB@B@@@B@@@DDBBB@BBB@BAB@B@B@@@BAB@@AB@B@@AB@@A@@@AAAAA@@AAA@A@@@@@C@CAA@@A@@A@CBE@DCDEDC@ABAFE@AB@@A@@AAAA@A@A@@CACCC@AA@@AA@@A@A@C@A@A@AAABECCAABAAA@A@AA@A@@AAA@E@@@AAA@@@A@@BA@@@@@A@@A@@A@@@A@C@AB@AA@@@@A@C@C@@AA@@@C@ABCBA@C@@@A@A@@BA@EB@AC@A@A@@@ABA@A@@@CCI@@@A@@BABCBA@@BABC@@@AAAA@CCCAA@A@C@A@@@AA@AC@@@AA@AA@AAA@A@C@E@ABA@ABA@AAA@@@@AAACC@@AA@AA@ECCACAA@A@C@@@AA@@@@B@DA@@DAFCBAB@@A@A@@@A@AB@@ABA@@A@@@C@AAC@C@CAC@A@@BA@C@G@E@C@ABA@CBE@EBEDCBCBIDCBA@@@C@AAA@@A@@@C@@@@AAA@A@@@AA@ACAE@C@A@ACAAA@AC@A@@A@@@CAC@@@AA@@AAA@C@A@A@A@ABABA@A@A@C@A@AA@A@@BAB@BC@AAAB@@AB@@A@@@ACA@AA@BA@ABA@@@@AAA@AACACAA@A@ICECAAA@C@AA@@@@@AEAAC@@AA@ABABE@@@@@EAA@@BA@@HAB@BAB@@A@G@@A@G@A@@AA@@CAAACAACCCACACA@@A@@@ADF@@BD@@BBB@@B@@BBD@@@B@@@@A@A@@@BD@B@BA@A@@AA@@AC@A@@AAB@@AF@@AB@@@@GAA@@@CAE@@EAA@@CCAAAA@AC@BA@@BCBC@@BA@@B@JA@@B@@@@@@A@@A@A@EAC@A@AA@@A@@@@DAD@BABABA@@DA@@@AB@A@@ABA@AA@@@A@@BA@AA@@@C@@AA@@A@@@AACAC@A@@BA@CBA@ABA@@@@@AC@AAA@A@C@@A@@A@AAA@@@@AB@DABAHAFAFABABBD@BBB@D@B@DAD@HCB@BCBA@CDABABAB@B@B@@A@@@@CCA@E@EA@@@@AACE@AA@@@A@@@IBEBCBC@C@K@C@E@CBE@A@C@CAABCAAC@@OOYOGIEIIE]E[CAAGI@M@QIKKKAAMGAAIKGIKKIIQOMMA@CICKBIDMDIFQJIDE@@AACAECAA@A@A@@C@AACAAAAAAAC@AAAAA@CAA@AAA@EE@A@A@@AAC@@@EE@A@@@ACA@AA@@AKEQBG@I@M@QHODGDGBEDA@C@A@@@@@@@A@A@C@A@@@@@@B@@BD@@@B@@@BABADBDAB@@BF@B@B@@B@@B@@@@CB@@@@@BEDEDEH@@B@HB@@@@IBAB@@@@@BB@BB@@FD@BBB@@@BB@B@DDBB@@@@EDABA@@@@B@@BD@@@@BB@AB@B@B@@@BB@@@@ABAB@BA@FA@@@B@BBBAFBD@@JBB@B@@B@B@@@B@B@@BBB@B@@BB@@BBB@@BB@@@BDB@@@@A@A@A@KAC@@@@@@BAB@B@@@@@B@@@@CAABA@@@C@@@ABABA@@@@BB@B@B@BB@@@BA@@@@BFBHB@@BBBB@DAH@B@BBF@@@@@@@@BB@DB@BD@@A@@@AB@@@B@BBBABAB@BAF@B@DAB@BB@@BFFDDBB@@BB@BABA@@@CC@A@A@@AA@@C@@@ECAAEAA@EAKA@AA@@A@@A@@@@@@@@@AB@D@H@BC@EJCJENALETELIDGFCBAD@BEH@F@BBJ@LALCHILMHKDABG@KDEDCDCFAFDDBDDLDJ@FAJAFCHBHALCLEFAFGFEFIDKDKDABCDBFDFBJALAFEHCDIBGBIFEDGFEHAFFJDFBFEJKBIBGHCD@F@FALENGJKLKHEBABIFMFEDCFAH@HAF@HDFBFCJCH@@CDAHBFBH@F@HHHJNFLHJ@DBHBL@BAJALAL@LAHEHEDI@K@IAI@C@Q@SAE@@@C@ABC@E@IB@@@@AA@CAAIAA@EDC@ABA@CAAAA@EA@@A@@BE@@@A@@B@@B@BB@@B@AB@@@@A@C@CAA@A@AB@@E@EAE@ICA@@BA@AB@@BB@@@D@BABA@@@ABE@ABABA@ABA@A@AAC@AAE@A@C@A@@@AB@@@BBFDB@B@@A@@B@@AB@@EAGAA@CAGCCAA@@@@BAFAB@@AAA@A@CDADABE@C@@BC@CBEFA@BDB@A@@@C@@@ADC@@@AAAAA@@@A@CD@BCAG@GAA@CBC@ABC@CAA@CAA@E@CBA@A@@@AB@B@B@@ABABABEF@B@@@@ABA@A@C@@@CDABAB@@A@ACA@ABA@@ACAA@C@@BABABAD@B@D@B@F@@@BADABAD@BABA@ABABC@@B@@B@DBBB@@@@A@CBC@EBA@C@E@E@@@@@@@A@C@A@AAA@A@ABAB@@@@@D@@@BA@@@@B@@@B@B@@EAAAIEIF@@@BBD@@BB@BB@KLCCAAAAAA@@CBA@@@@@@C@@@@EB@@A@@@@@@D@BAD@@C@C@GAGA@@A@AB@B@B@D@@BDDD@DB@BB@@AB@B@BB@BB@BFHB@FD@@BDDBDB@@@@@BCBAB@BAB@BBBD@DBB@F@B@BBDBDFAB@@DDFBAD@B@D@@BH@D@D@FBD@B@B@@CD@@@@BD@BA@ABA@GBA@E@C@CBC@ABA@ABA@C@A@A@ABAB@@A@AACCA@@@@@A@@@MLIDKFGFCFGFCBIBCDFFDPFDJDJJ@B@DBLFFD@JDBB@BDFHHHBDBL@B@H@LCH@FFBHDFBBCHBBHFBJBN@D@BB@@LCH@BADGXBLFFDFHJLDR@JB@@PFHAHDNRFFFHADFFRHHR@LNR@BBH@DBBBHHHAH@L@@HDJDTNPL\\RFJ@BDD@@TDHFPFRP@@THXPJNBD@VILEFSDWBGHW`_\\QVAXF^@@X^D@H@JDD@PJF@JBJ@HNJ@@DCD@FJDHCJGLAB@L@@B@@L`ADCB@DAD@FJH@DADAB@DBFAFBJ@@DBADFHLFHCH@DDF@HCDAPBFCFAHAFDDF@NEHAJIFAFCDEDE@GBADBDJBBB@BD@@B@AJBB@DCH@D@JEB@JBDBBBAJHH@HED@BA@GHBBDD@B@@FHCVLHL@TLCNEHLZAHFFDD@H@DFRH^dP^HTELC~Z`GB@rNRBD@Ā£D@B@D@DFPDDDDJHBF@DAD@@CACEEBEDCXHJDF@DADGDEJCH@DBDB@DEBGF@BDDD@H@L@PAL@LDTFHCHC`CP@FAD@FCHAB@@@\\BLJTLJJJFHXV@FDJDJBKRVLHLLDNIFEfPBLFJNLDBZmVe@AEC@Ug[uX]BA@AJI@AWM@@S]EOHE`QB@PMA@OG@EAA@@@A@@BA@APH@@BABQD]BANITODCTLkDEjw@CBEHaFM^mC@BENoBEHEJKBABAJMFKHSPOBO@@@@DCD@BABAB@BBB@@B@@@DABAB@B@@@B@@B@@@B@B@BAFABABABA@@BBB@@@BBAS@AKBA@A@C@AAA@@@AA@@@A@@@A@AB@D@TE@C@CBO@EBA@A@C@@A@AAA@@A@C@A@@@@FGBCBEHGEE@AhEJCJEDIDG@@GIAKLMNMPU\\SDAhYBUTSFEHBLCD@DAFAF@BIC@^QFBNKNGDAZK@@`KTIB@LELCAO@GAY@EQJAAAAAAC@AA@@A@ACC@@AAAACAAAAA@CB@BE@@FGNCr]JETKFIDA@ABABABC@@@ABC@ABADAD@BAFA@@@@@C@@BABAAAYMA@@AB@DAB@B@BADCDCBAB@@AB@BAF@B@NAF@DBFDDBBAHAA@@@BB@@"],encodeOffsets:[[123788,43286]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("鄂尔多斯",{type:"FeatureCollection",features:[{type:"Feature",id:"150602",properties:{name:"东胜区",cp:[109.963333,39.822593],childNum:1},geometry:{type:"Polygon",coordinates:["@@IAOBO@c@IBEBIBI@K@I@IBGBIBIESGA@WCIAEA@@K@IAE@G@G@GB@@ACADBDE@ECEGA@ÅMãIK@QHKHCFCJAF@FCFEN@BGZIV@B@F@@G@j@@q@csK@CH£ªgjW°APABK@G@GB@BE^^htDdETR@AFCJCB@HEFAJAHAB@JGLAH@H@D@HCLAH@HANALBP@VEFCT@PCVCDAP@^BHBDDJDLDLBLBD@JAHATCB@XBLBJAF@NAJAVCDALCF@JAPEH@FAFC`EB@DCDCFCHCLETGD@DCHCHAD@H@BBPDLHLFFDFDFFDFBJBBHHJJDDBIFEJIFCLGHCHCJENIFAFGFCHAHAHAH@HBB@DCD@DAD@HALAJ@RBFBPDH@L@JBLDD@JBHDJDDBD@H@B@@ADAJENALAJ@FAJ@HBL@H@H@FBBBTAFAHBFAB@FANCL@D@JEN@NBJDPJFAB@HBF@FDLJFDBBHFFH@HCHEDCD@JBJD@LAZDPBNCJFFDBBH@JAJBFDDFBH@JCFEJ@BJAH@J@LBD@DCJGBEBCJGLOHEHIHIJCJ@J@FBDDFBBDDADAB@B@HENGJEPIHENCN@XHNDP@NFL@PFFB@@JCPEFEBMBMHKHEBCTGPILAHAB@FCBGBCDEHKLILG\\IL@NBH@NBD@D@FAD@HAB@DBDAJAB@RADGDELCPGLCJ@LBB@@BDE@CB@@IAG@ABG@ABCDEBADCBAJEFAF@D@TFDDDBJAHAF@FCHAFADAD@FEB@DA@CDABAFCF@B@@AJCBAAA@@GAAAAA@ADC@A@@@@AAG@@@@G@ABA@@AAA@@A@A@@@AAAEE@A@A@@@@CBC@@ADEBAB@@A@AB@AA@A@AAAADC@@A@AACCCCCAAA@CAA@C@@@@ADC@@@AAACCAAA@A@GBIDE@E@ECCCA@DCASIACRU@A@BA@AA@ACAAEAIAA@EBC@ICEAC@C@C@ABAD@BAD@@GJ@@IDGBUJKBCBADCDMLA@AA@A@C@A@@A@EBCBA@@@BB@D@B@BCBGAC@CAAG@A@@A@@BABADADABCD@BB@DB@@@@A@ABEBABEDABA@@@ABABA@A@ADC@CBC@A@C@CBSAEBEBEDCBE@C@@C@@BC@A@@E@@ACA@ADIBC@IDCBAAAAA@@BACC@@@EAA@CAAA@K@@A@AAA@C@CAAAAC@AA@A@@CABA@A@AAAAABC@A@AAC@ACC@@@ABA@@@A@ABC@A@AGBEBE@EDC@GDE@CABAC@AAK@EBIBEBGHGDE@I@IBM@MFGDA@ECKIMWGCQSMIECAAIAWKI@I@MAO@I@AA@@SKCwZEB§ZgEKFCB]P_LWJWAYJ]L[Fs^w~[Fsa"],encodeOffsets:[[112301,40698]]}},{type:"Feature",id:"150603",properties:{name:"康巴什区",cp:[109.790076,39.607472],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAE@EAG@IBE@I@IBA@IBEDADILCFCFCJU\\SRSLKLGDK@EBE@B@WBQ@SAIBgDCAGAIJKTABEFAHKBA@EBG@ABBHHDFBF@DBDFADA@CBFF@DHA@FAFCHCF@@JBPBVFH@@AJCDBDBJBDLP@DDFDBDFDDHDDFBGBDHABEB@DJAGJEHEJCAG@EAtb\\Ex}t]\\E^KZIXBXI`K^ODALEhF¨YFAYDAjkAADELGAWBG@CHEBEPQHC@G@EAEAEBEDEFC@M@KAAACCC@G@IFIBCDGDEFABG@CAEECCEA@EBA@AFE@ADCFADCDGBEBE@GB@B@N@FEHGFEBABEFIBQFKBGBEBGFCBAFIFGFIJEDG@G@ECMAA@ADDB@FELADW@U@M@OBKFCFCH@BCFGDA@IAAC@EHEACMBEA@@ACEI@DCHMAECKDG@G@KBACKEACACEC"],encodeOffsets:[[112437,40534]]}},{type:"Feature",id:"150621",properties:{name:"达拉特旗",cp:[110.033833,40.412438],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@AAC@A@C@A@AACAC@ABGAC@ACCAAA@CAAAC@C@A@E@EDABCB@DCBA@EFC@CBEBGBEDE@GBIBCACCSEC@E@EBIFABCDABCFAD@BAH@BBH@JA@@DCF@AA@KAI@KDOHKDCFCHQBA@IBCBCAA@GBC@EBC@C@MAG@MAK@[JKHKJGLCFADAHEDA@GBKBOJSHADGFGLANANEFOFID@@EAOEK@MEO@MCWGM@MDGFOJIFMHGFA@A@CBCBACEACCEAI@I@IDGJGJGFKPIHADAFIHCDC@KAI@G@IB@AFIDE@IAGCEECIAIBG@AAECIEMDOAYCKBC@AI@IDCFCDG@GEGGEAAECKIECE@GAA@EBOIICMAM@IFC@K@MDEBA@EBGAEBSBAAEAG@G@K@GAI@EBI@KBMBIFCB@BA@G@C@CAICGCIAC@KCIAK@G@OCEAQAI@KBGBC@CBC@CDA@GAG@GBGBGBEDEHEBMJIFGDGDKHEDIJEFAJCCIIGGAAAICEEEECECKEKGOCAAG@C@GBGDCDC@SHKFGDEDCDCDA@_FEDEBG@OFIBE@KDCBUDIBMBE@IBKAWAA@SDGBIBC@KAKAKCICCCGA]AO@CBUDODS@EDUFO@KAMBGBG@KBGDC@G@G@KBIHA@GBIBEBGFA@IDED@BAD@D@DBF@F@D@DBDAB@FBBDFHDHBABBJFHHHHFBLHHFLHLBDBJDNHF@HCBGDEFEDWDCGCGWCQBGFA@QDIBECKCKBGDE@GBKDU@GCWKMAKDKLKJEJCJBLBLHJDLELA@GJCHALBX@HGDGBCDA@@BA@ABCB@BBDADCFCBBHCB@FEFAJBL@DHPAFDDJHFHBDBDDFJV@DDD@FDFGBBDTLFDHFPJHD@@@H@RBD@J@NANCN@@CNERMLA@OB_BKBMBMBK@K@IDMFIDGLEREVI`AV@DAF@D@D@DADAF@DAF@H@BCBADEBEDEFEDIFQJCF@F@D@NDFHDBDBBBD@FADADDDTDNDB@JEB@J@DBDFBDHFJHHFDDBF@F@DFFFFBFJ@DBCDADAFADBBBBDB@@@BC@C@C@@BBD@BA@ABCBABAFA@@BAFADADAFCDADAD@FDNDJDRFRLBH@TMJGLEVCN@RDPBH@HAFCJKFECNTDRBT@HCFCFEBGFCH@L@HAFAVCZFRAB@BAHCDG@UAM@ILGHJDHBB@DDLFHJFPEHGJBR@FAHADARKNGJGVGPB\\F@@FB^DNAFCHGBCHIFGXDLDDBHADEAECGCC@EB@H@NHRFFHDHBDDHFPHRDJFNBDRNN@H@vCD@ADLATANBJGRAJNLLLNNLNDFBRBFFVPHAB@ZOVMRBLHB@`FDAPK@QJUACB@BGNMRMB@REHBNBPG@E@IBALING@APCNDFHDDDN@@@J@@LBBALCVH^NF@HB@EDCtTHBJDP@RCP@TAR@XB@@HBLHFBLHHDNHLDRF@Bz@XCDA@@B@FE`IVG\\KBAb`PAHFJJLDPGRM@@NFNHDHF@J@VIJA@ABCACLGNC@@@BZEZBHBJQJA^HHBFBFDA@@HDJBJFHBTJRDBD@BB@@D@DAD@@@HCXAPETGB@NGVQBALQFO@@BG@EAKAUAS@EBCDCDADAD@DBLHNLLFLFFBDBPF@@@@DABADCBABEDG@EBC@EBCFI@ALQBE@GDGDCD@H@B@BBFDHDZPNHFDF@DCDEBCFUDCDCDAD@JADCHCDA`M@@HG@CFKFIDCBADAHEHEJCJENCPCJ@JBBDBDBFFF@@FBDBD@@@FAFCBA@A@ECGAI@GBEBCBCDGFEDAHAD@DDFLLVB@BBJBFALGFCLEHARKHCDCDALKBCFEDCDGBEHIDEFADCFAB@PATBNAJE@@@@B@HENITSFGHCLC@OBE@CBEBABCBCBA@ACGBA@ABALCJIDCBA@ABAVGBA@@AC@CBABAF@DC@@BABADABA@@BCBABCDA@A@A@CBCBE@C@C@AAEACACACBC@ADCBADAB@@@BAAAEG@A@A@A@CBC@ABC@AB@@@BA@BB@BBB@B@@@@A@CAA@AAA@@BABCBCBGFI@AB@D@D@B@@ABA@@B@B@BB@@B@@A@AB@@@DA@@BA@AB@@AB@BA@AAAA@A@A@C@AB@BABA@C@A@AA@@AA@@A@E@A@AA@A@ABABAAAAAACAABAAAC@CAAAAA@ABAFAB@@ACE@AA@A@CB@@A@@AAAAAA@@BABA@A@CA@AB@BAB@@@AA@AB@@@B@B@BA@A@@@@CAC@AAAC@@@A@C@@D@BA@@AC@A@@BAD@@A@CBABCBCA@ABAA@@@A@@AAAAA@AAA@A@CAA@AAC@@@@@@AB@AA@AB@B@@@@A@@AAA@@@BA@A@@ABA@@A@@@@@@BC@AACCCAC@ABA@AAC@A@C@A@CD@BADBBA@AAAICECGEAEQYKMOEECMKIOC@BEEKIEGAAGIKGA@AAA@A@AACCK@A@A@AAC@AACAAAACCCCA@EAAAC@@AAK@@AAAA@AAI@C@CAA@ABABC@CACAABAAA@AAA@ACAICC@E@A@E@AAC@E@G@A@A@C@@@AAAACACAEAC@C@CAE@E@E@C@C@A@AA@C@@CAB@EAAAC@AACAAAACAA@ACAAAC@A@C@ABGBI@GAG@C@ABC@CBA@G@GBC@C@C@CACA@@E@A@AAA@EAAAA@@C@@BAAA@@A@EBAA@@CA@@AAA@ABC@A@CBC@A@CAGAC@AA@AAAAC@CAAA@@@@CA@@@A@@AA@@AC@A@CAEC@@@A@A@A@@AA@@@A@@B@D@F@B@F@BAFCD@BADCBABCFEDCBABCBA@C@CBC@CBCAAACAAACCABA@CBCBCBEACCEEGEEGGCACCEGEACACAA@@CAAAAAAACEE"],encodeOffsets:[[113052,40780]]}},{type:"Feature",id:"150623",properties:{name:"鄂托克前旗",cp:[107.477514,38.182362],childNum:1},geometry:{type:"Polygon",coordinates:["@@JMLApKPCNAJAHARCJABAAAACAA@@@A@ADC@AHGBCJKNSDCLS@@ID@@IBE@cKeIUCQGAAA@_QOGUKA@cKKA_C]CcAE@IBIAICMGMEIAK@OBI@@@SHEFGNIRKHYF@@MBE@cBWA_ESGGCAACCGOIMIGIAKBO@@@KEIGKGKCA@Q@G@KHMNEBEDOFQBCBWDQBIDQLEBIBOAAAcAG@AB@@CBGPIL@D@BHLLRBJDJ@FCFCFIHAD@H@RCLEFCHGD@@C@C@E@E@C@AB@@@BBJ@@@J@@ABSZEJ@@@B@@@L@@D\\@B@@@BIBLKBMHMJG@CBAB@@B@@B@@@@CBA@C@GBEBEBEB@@EBCBC@A@AAGEAA@AA@@@]LYJKFC@ABCDIHIDC@CBKBG@A@A@CAAAAAAAA@aJ@@SBC@KDIDEBGB_D@@KDKBC@EDIDEDIJOPOLIDSFE@ABAD@DHHDD@@EFC@K@C@CGEKCEcOKCEAA@A@ABGDEDGFCBODKB[HQFIB[HUFMDCBEDre^KNGFc@A@WEG@CCCEEEA@AAA@MDYFMDA@CBEFGFAB@BBDJVJP@BLPT^DDBBABA@@BGD@D@@@BABADCBABEBABE@CBC@C@CBE@C@E@E@CBC@CBC@CBABADABADABABADADADABADABADABCBADCBABCDCBEDCBADCBCBABADAF@B@DADCBCBCBABCBADCBCBADABADCBADABADAB@DABADCBCBCBCBCBEBABEBC@CBC@CBABE@C@C@C@E@C@CBCAEBC@CBCBA@CBABEDC@E@CBA@A@CBA@E@CBE@EBABEBC@CBCBCBABABCDCBADCDADADCD@BADCBE@CBC@CBC@CBCBCBADCDCBABCBADADEDCDABADCBCDC@ECEACAC@C@C@C@CB@@C@CBE@C@CBE@E@EBABABADABCBC@CAAACCACAAACCAAACCAA@A@@AA@A@A@@AA@A@@@A@A@@@A@@@AB@AAAA@@@@AAC@AAA@@@C@A@@@C@E@A@A@A@ABA@AAA@A@@@A@AAA@@@A@A@A@ABA@@BA@@@A@@@A@A@@@A@@@AAAA@AA@@AAAA@@@A@ABA@A@ABA@@@@@ABABA@A@AB@@ABA@@BA@AB@@ABA@A@@@@@AA@@A@@@AA@@AAA@AA@@@@@AAA@@@AAAA@@AA@@AA@@AAA@@AAA@@AAA@@A@@AA@AA@@C@A@A@A@@@C@@@A@A@ABA@A@ABA@A@@@AB@@AB@@A@@@AB@@A@@@A@A@A@A@A@A@A@@AA@@BA@@@A@A@A@@@@@A@@@A@AA@@@A@A@@AA@A@@@@@AAAA@C@A@A@A@A@ABC@A@C@A@ABA@A@A@A@@@ABA@A@A@A@A@ABA@A@A@A@ABA@C@A@CBA@A@C@ABA@A@A@A@A@A@ABC@A@A@A@CBC@E@EBC@EBE@CBEBEBC@EBEBEBC@EBEBE@EBCBEBCBCBCBC@ABEBE@G@C@CBG@EBCBEBEBCBCBEBEBCBE@E@ABC@C@EBEBC@EBC@CBC@A@CB@@A@CBA@C@CBGBIBKBE@CBG@C@EBE@EBC@EBE@EBEBEBCBEBCBCBEDCBCBEDCBABADAB@BCBAB@BABCBABABABCB@@ABCBCDABCBG@A@C@CBA@CBCBCBA@CBABA@CDA@CBCBE@C@ABC@ABE@CBCBC@A@ABA@C@CBA@ABC@CBABABCB@@ABA@C@ABABC@A@@@CBA@E@CBC@A@E@ABC@C@ABC@C@CBA@A@A@C@@@A@CBEBCBEBCBA@CBABABCBEBGDABCBEBEDCBCBEBCBCBEBCBA@CBEBEBEBEBCBCBEBCBEDABEDADCDCDCBAB@BCDADCD@@CDADCDCDCBCDCBCBEDA@@@C@EBA@ABADC@@@ABAAEBE@EBGBAFABCDABADCD@F@@JF@@bT@@NH@@Ü@@TL@@FD@@DD@@Zl@@FNj@BB@@BBB@@@BBB@BB@@@BDBBBDBDBB@BBB@@BDFhLÀB\\H@@@@ĜNV |@@@B@@BBBB@B@BB@@BDBBDBB@@BBBB@BBB@B@@BB@BB@DDBDBDBBBBBBBBBBBBBDBB@BB@BBBB@@BDDD@BBB@BBBBB@B@@BB@@BBBBDFBDDB@BBB@@BBBB@@BDBBBD@@@BhlHHBBdWJG`WFCFCVQB@JGBALGFEBAFCVOPKJGTMFCJGBALI\\SLGDCHEFCTO@@B@N@F@H@H@T@D@L@H@D@B@B@JCHARGJEnQDA@@FAxWD@`DDBXBDBPBF@NBD@DAHELGHEFDNJFANATADAdCF@DAD@TARAL@PAGTKHADAHE^OFC@@F@D@B@fEJARAF@PAJARAZCF@B@HBNFDBD@DBNDjPHBPFLBNFNLNLJDLFB@FDDBFBHDPHFBFBBBJDDBBBB@FBHFLDHDDBDDJFNHFFTDD@BBLNVDTDDBBF@BBNBPBLBB@DFbBD@HFCRQHELKBATOJS@ABABCBC@@DGLUBA@ABC@@BCHMBCBCBEBABE@AHCLGLEBARK@@BAHCJEBA\\SPGBAFCbUNIHCNIPIJEJGTLhNTHDBFBFBnVHDB@BBHBFBDBB@DJDDBFDDLJDDJJXVBBHGJIDCLI\\SJGFCHCFCHCFCHC@G@E@CACFG@AFQHOBC@ABEBABCBC@A@AFK@@RS@ABABABCDGHKBABABCFGBAACACHABABAHKBCDGFID@RIFABADAB@@@NEHCLEF@JACQ@@DEBALMHKDAJMBABEHKZMFCLIDAVOBADCH@HAFAbGFANCHAB@D@HCPCFAD@HAFAJAFABAB@BCDEB@BCDCBCB@DCFCHCHCbKHCDANIFALEXMHEDCJKFC@AREPETIXGRGZWNO@AFE@ADA@CB@DEBCBAFCBEDCBADEFEFIFGBADCBAX]NOFEBAFEAAHMD@FEKKJQAE@IBGCK@ED@BBFQBUDGDAACBM@ACK@ECCUkCCIMCGICFCBAEEIEMSUiCIACGQAEEMCGCIAGBAAKCBACCA@ABAB@GG@@AACEAEAECCECCCBC@A@EAE@A@E@AAGBAIGBC@A@AAEAEFKCGSIKGAAKEMGMGAAA@GACAC@ACOMDC@I@KJELGDADAICKE@ABK@GBM@C@G@ABCDEBEBABEDEBADGCAFCDCFCNILFFCEGBAB@HAD@B@FCDABCGQDABCBADE@@@ABMBCBGBGGGAA@EBCFCF@L@JE@AAA@C@AA@@ADC@@JCHBDBJC@ABABA@AB@B@BAB@BADGB@@AFA@AJIDAJMBAHABAB@NK@AFEACDCBAB@@CB@BC@AHCFCBAFGPWLM"],encodeOffsets:[[111053,38659]]}},{type:"Feature",id:"150622",properties:{name:"准格尔旗",cp:[111.240171,39.864361],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAACCCECAAAA@@@A@@BA@@BABA@@@AAAA@A@CA@@A@CAAACCCACAA@AAAAACAA@AAA@A@A@A@A@A@AA@@AA@A@A@AAC@C@A@A@AA@AAAAA@AB@@A@AAC@AAAAAA@AAACCCEECCAAA@EAA@A@A@A@AAA@AACA@@AA@@ACACAACE@ACA@@AAA@AAA@AA@AA@AAA@@@@AA@AAAAAA@AA@@A@AA@@AAAA@AA@AAACAACAC@A@AB@@@@AA@A@@@A@@AA@@@@A@@@@BA@A@A@@@@AA@AAA@@@A@@B@@AB@@@@AA@@@@@@A@@@@BA@A@@@AA@@@@@@A@@@A@@@@@ABA@@@A@@EC@@@@ECC@CCFC@ABAACAC@A@@@A@@BA@AB@@A@@@A@@@@@A@@@AA@AAAACACAA@@AAA@@@A@@@A@@@A@AAA@AA@AAAAAA@@CECCACCAAAAAACCCAA@AC@AAAA@A@@@@@A@@CAAAA@E@CCA@CA@@A@AAAAA@A@AB@@A@@BA@AB@BABEBKDEBA@@@@@AAA@@@@@AA@@AA@@@@@@A@@@@B@@@@@@@A@@A@@@@@AA@@C@@@@@A@@@A@A@@AA@@@AA@@A@@@@BA@@@@@A@@@@@A@@A@@ABA@@@@@@A@@@@A@@@A@@@@@AB@@@@@@@A@@@A@@A@@@A@@@@@A@A@A@@@@A@@@@@@A@@BA@@@AAA@C@@@A@@@@@A@@@@@@A@@A@@@A@@@A@@@A@@@@@AAA@@B@@@@@@A@@@@@@@@@@@@B@@@@@@A@ABA@@@A@@@A@@@@BA@@@ABA@@@@@AB@@A@@@@B@@A@@@@B@@@@@@@@@B@@A@@B@@A@@@@BA@@@@@@@A@@@A@@B@@AAA@C@@@A@A@ABABCBABCBCBAD@BAB@@EH@@@FADEBE@CB@@ABAB@@@BABAD@BABABA@ABABABCB@@AD@D@DCBAD@@@B@@@@DDBBDBBBB@@BA@@BA@AB@BABCBA@ABABABABAB@B@B@@AB@B@B@@BB@DAD@B@B@BAD@@@BABABAB@@CDABADCBCFEDABABABAB@@ABA@A@AB@@AB@B@@@B@@A@ABGBGJGDABA@@@AA@@@AA@@@A@@@AB@@E@A@A@A@AA@@A@BA@A@AAABA@A@CB@BA@A@A@AA@@AA@AACAA@@BAB@BCBCDAB@@@@BBD@B@BB@@@BA@@BC@@@A@@BDBBBAB@BA@A@A@A@A@AB@@FB@B@@@B@BABEDGDGBABE@CBE@A@C@A@E@C@A@EAAAAACAE@ICA@E@A@CBEDA@GBABCD@B@@@D@@BB@@@BAB@D@@@@CD@BA@@@@BBB@@@BCF@B@FBF@B@BCBEBC@CBEBEBABAB@BBD@FFRBFDFBBAB@B@BBB@BDB@B@BBBADAF@BADCDCBIDGBE@CBA@CBABKBG@A@IDABCFEJABABCB@@@BFDFFBB@D@DENADGFADADEF@@BAAB@FEJEJAFCH@DCD@F@FADCBBBD@BBFCFCDD@NBJDHADG@GDC@CBBDE@ADGBIHAHCDABGHADAHGHCBGLGF@@GFEFAFK@GACC@CCAACIBEAIAEAACIEAEGACBKFKBGB@ACBE@CBCBA@C@A@CBEDGDCDEBA@KACA@@E@AGAGFA@CCIFCBCBEAIBCBIDCG@AAGIAC@CBCECE@EAE@C@G@C@C@CBAB@@ADG@ACGDG@G@G@CDCCG@G@IBIDGFCBCDEDCDCDEHA@G@EB@DCBCL@H@H@DCDABC@ANAL@HHBHFJFHJDH@@CFDHDHDHBHDB@BAAAA@@A@BD@BAJAB@D@BAFBBDB@BBF@B@DCB@BDH@D@FAF@DBDBBJADC@CB@FAB@ACB@BA@AB@BCDCDCB@FDDBF@B@@D@BB@B@FAF@BBBB@DBBA@ABEB@BHBB@@D@BABA@AAA@ABE@@B@@CB@@@B@BABMDCBABIBABAD@B@BAD@B@B@@AB@B@@DD@BBD@B@BADBBBB@B@BABDB@@@BBBD@BBBB@D@DBB@B@BL@B@BB@DBB@F@@DDAB@@BBBBABCD@JADCJ@BDB@BF@@@@BAD@@@DD@F@DAFCFAFATBDAD@B@D@DAD@BCB@B@BABA@@B@BAFCBAFABAB@@@@@CAA@@ADCBABCBCBA@AB@@@@BBHDBD@HBDA@A@A@CAA@@B@DAFAB@@@@B@D@BBBB@NKDCBCDALAVIHAJC@@HI@@BC@ABCBAD@D@D@FBJDD@FAB@JBFBBBBDB@@BABB@V@DQJBBTCDB@DDFDF@F@JCHAB@B@BBDDBB@B@@CD@B@@D@B@DBB@BBDDDDBD@B@BD@BCBB@B@BBBA@@B@BA@ABCF@BD@DA@@@@@B@BFFBB@B@@@B@BB@BB@@AB@B@H@@H@BB@@@@@BCD@BBBBBHB@@BBABID@BD@D@BBDBB@BBDD@BBDAH@BBDBD@B@B@D@BBD@BBBFFBDBBBBBB@DB@DBDBFBFHDDDBHHFFFHDFBDAFADAD@DABDBBDBBBDBBAD@DAD@D@DABADABCDEFADABCDABC@EDABE@A@E@C@A@@@@B@@BB@@@B@B@B@@FDDBB@D@@BB@@BB@@@B@@D@@B@BB@DBDBB@BBBD@HBDBB@D@DAB@D@BAB@BB@@DB@@BBFAB@@@BBAB@@@DB@BBFBB@BBB@F@@@DBDBD@D@D@HAH@B@DAD@BAD@H@HBJ@HABAD@B@D@BBDB@BBBBDBBDBBBD@BBFBA@DB@@@DBBB@D@D@F@F@F@DBD@D@FBDBDBBBBB@@D@B@B@H@F@D@BBF@B@F@D@JDDB@BBB@BBBABBBBD@DADAB@BBB@D@DBJ@BBBBB@@BL@BD@BBFBB@DDDDBBBBBD@BBD@B@B@BDLBD@B@BBB@BHBJLBHHBJFFLAFD@JPNLFDPFLNRZBFHFFDJDBB@BABCAABC@@D@B@D@BBD@BAB@BBDDDBD@BAD@@@@@@@BB@BA@@@BAB@@B@BB@@@B@@A@A@@BBBA@@B@@@@D@BBB@DBB@B@BBB@BBBB@@@B@@BBBAB@ADADAB@D@BC@AB@@@BBD@@ABC@@@@D@B@@BDBBD@DB@@@@@BABA@A@@@A@@BBB@@A@ABA@@BDBB@B@BA@AB@BBBB@BB@@@DAB@B@@BDF@BA@EBAB@BBBBBDBD@BBABBBBDBBBBABAB@B@BBBB@F@B@@@BB@@BBB@D@B@BA@ABAD@B@B@B@BB@BABA@@BA@@BAB@@CB@@A@@B@BA@@@AAA@A@@@AB@BA@C@C@A@@BEJAHADADAB@@BB@BBB@D@B@@A@A@AAA@@AAB@@A@@BAD@BAD@D@B@B@BFHBBAB@@A@CBABCD@BADBDBDBDBF@B@D@DAFAD@D@B@BCBADABAD@@ABCBABAB@@CDE@ABAB@DBD@@ABUHAB@BABCDIJKDAB@BABDH@BABADADABAF@DAF@P@@NAF@TDHBHDJDZRNFH@DBD@LAFCDCJG@@FEB@FCLAFAVAB@LENANEBAHCHGDGDC@EBAA@DIHMLID@JCDALAL@@@PBFF@@@DBDJRFFRJNDB@NBFAB@BAHEBEAEAIDETM@@JAJ@NC@AJEDCNMJGJCFAB@@@NDDDFFPRHFJJDD@@LFD@@@D@B@@ADABADEJEJE^CL@HBHBBDFJBFHNBDHJ@@JHFFDB@BHDLD@AD@FAD@RE@@B@BC@@@@@C@GAEAUDGHC^@pEnKB@`SFCD@VKlUHAPIDAJCHE@AB@HGHIB@DGJODEFIHIRIHEFE@@LG@@D@FCLALAF@HAD@LAF@JAJAJ@FA@@TCHEFAPCHGBEBCDEBCDCJIFCFE@AJKFEFCDABCFCJIHCHKBABA@ADCDCBABABABEBAFEBADCDEBCBE@CB@BGBCPUDCB@BAB@B@FBB@FADA@@BA@@BA@EBA@C@G@A@ABCBCBA@AB@BA@ABABA@A@AACAABCBC@A@A@E@E@C@C@A@C@CBI@A@EBAAC@E@E@E@ABCBC@CBC@IBC@E@CBC@C@A@C@ABADCDADCJADABABC@C@GAC@A@ABCBADCBE@E@C@AACEEAA@ABA@AFE@C@C@C@AAAECCAE@C@CAA@CAA@@AEECEACACACCGAEACAC@C@G@A@A@CAEAAAC@A@C@CBABCBABABC@@@@@E@@BCAEAC@AECCAAAC@EBC@EBA@C@AAAAAA@ABC@AB@@ABCBEBABA@A@C@@@CACACCACCCAC@GAEAGACACAA@CAAAAAAA@A@C@A@@BEBABCBA@ABC@AAC@AAAACGGCC@@@@CCACAAAC@AA@AAAAAC@A@A@A@EBAFI@@@@@@DI@A@@AG@C@C@A@@DIP@HCBMFIB@RAHA@ADADCBC@ADGBCBABADCB@BADABA@@FAJ@BAB@BAFGDCFABAH@DAJCBA@ABC@ACAAACAA@C@ABA@CBABC@C@A@CC@C@A@CBABCBAB@JKLS@A@@@EBCFEBCFADAP@HAFABC@CAE@I@C@C@CCCEAC@CBCBAFCFCHABCDA@C@ABC@C@C@GEAC@E@ABCFGLKBCBE@EEKCGAG@G@MAGAEEI@@GMGO@EBENKBEB@DI@ECIAG@EIIECIAG@E@SGA@IAGCGCECEEKGGGEGCEEEAC@C@EBI@G@AAKCOEMGEEESEICWAGBG@QBC@KC@@I@A@AACAAAC@C@C@CBEDABE@CBE@ABC@CBC@E@CAEACAEAI@C@CAA@A@C@CBI@A@A@EBE@C@EFEBCBEAGAECKCK@G@E@EBGDEBE@CAECECGGAAEAGAA@ICGACCCEEEGMACIM@@CCCCIIAGBGLE@AAC@AEEQEI@A@CBCBA@CDADEFCDCDCF@@CDAD@DAD@DBB@B@BBDBDFFBHDDBDDD@D@BCBCDEDCBEDA@C@A@A@CCACGCA@AC@A@CA@A@EAC@C@ADCD@BAHBBBDFBBDEH@HCDABKF@B@@DHDHBD@D@FAF@@LD@@BBD@BBFFJBFAJBFBDB@D@D@BAFBF@@BDDBDBB@BDHBF@FCD@DBBDADCDGBABCB@BBDDD@B@@BB@@@B@BBB@F@@@BBB@BBBB@BBDBB@B@@@BBDAB@D@B@B@@B@BADAD@D@BBB@BHBBB@@RLDBDB@BDBB@DABABBB@@B@@@BA@A@AB@@@@@BB@BBBB@@@@@B@B@@@@@B@@@@D@@@B@@@B@@@@B@B@B@B@F@@@B@@@@A@@A@@A@@@@@A@@BAB@@@B@B@@@@@@B@D@@@B@B@@@@B@@@BBB@@BB@B@@@B@@@B@@BB@BB@BB@@@B@@A@ABA@@@A@@@@B@B@@@@B@BB@@@@B@@B@B@BAB@@@BB@B@BBB@@B@@@BAB@BAB@B@@@@@BB@C@CDCDA@EDCBCD@DAHABABCBEAEC@@GEICECAC@KAECCGCA@KCGCKA@@E@GA@@KBC@E@GACAC@E@CBCB@@A@@@E@SEKACB@BAD@HBDAHGDO@CA@A@@@A@@DAHADA@C@AC@AFE@C@A@AA@C@EAAEA@A@@GEEAMBA@A@CE@@IACACC@EIKQICCG@C@@A@AAACCA@AACACAEAAA@@AAABA@A@@@ABA@ABA@A@A@AAA@AAA@A@C@GAC@AAA@CAAAEAAAA@A@A@A@A@A@CAA@@AA@AAC@A@A@@BA@A@A@ABC@C@AAC@A@CAC@C@EACAA@ACAACCACACAACCAAC@AAA@A@@@A@A@@@A@A@AACAA@AAAAAA@AAA@A@AAA@AACAAAACCAAAACAAA@A@A@A@@AA@AA@@@AAA@AACACA@@@A@@@A@@BA@@@@@AAC@@AA@@AAAACCAAAEACACAA"],encodeOffsets:[[113496,40394]]}},{type:"Feature",id:"150624",properties:{name:"鄂托克旗",cp:[107.97616,39.08965],childNum:1},geometry:{type:"Polygon",coordinates:["@@JgMGDoDkIe_[HODO@AEFABEFMPW^ABCDABEHEJEFCFABCDAFEDABADCFA@@DCB@BEF@BMPYXQHWHSJOFQF@BEDILCDGFWNKFEBMJCBGDaLGDGDEDCDA@ADCDADA@CFADA@ABEBIBEBGBC@EBODGDC@A@GBMDEBaHEBGBG@CDABUPCBKJEDYNGLAFABINCBGLKNABCF@@DRIBE@KFGDMF@@A@CBABEBQJC@EJCHADGLABABGBBDBDABEHADABABGLCHADABAB@BQT@@EL@B@BADADABAF@BADGPER@BEHBD@D@F@HGDEDGDEDGDEDIH[TKJCDIJGHAAWUIICCKICCAECCCIA@CAEAGAAAA@GCmUEAEACASGgMSKIHIFOJMJGDMJaVEDABOH[TABIFGDAB@@QLABKFKHGD@BAFABAFADADGNAD@@AD@BABKVCH@@ADADAB@BITSPABKLGFQRED@GACEa@CAAAKAOAM@AAECASCUCKMAAC@SCEEMGIECCCAGCKCGEEAA@AACAICAAEAEAOGGCEACAECA@KEICMKMKMEKAOEGAiOMCCAC@CAMEGAA@E@YDQBIBOBE@QBIBeFA@C@E@@@ED]PGFCBGBSLHOBK@QBSBC@CBE@cDCBSBMBEBMIECGFKHGFCBC@MAE@OACAWACA_CC@wXEB@@CBmRIFQHGBIDA@A@C@G@K@C@S@G@G@E@M@A@@@SPEDGFCDKH[TKJABIHEDSNIHOLUPEDABEFKHABIHA@UREDED_XIHcXBBTRjdjdvf\\V|JPDXF`HrDRBPBRIv@@FBPIJILCDGLBJDFBFCPBHBHAFCPCJFBFDFFDJ@@DMLDFV@PFDEHIDIHIFDBDZE^nNBPJtBFnjAHMHIJBHBLEH\\\\E\\ÛµM^e^iPmTMF]TudAXC@FNZvQRA@ABBV@B@`BNH`BLZV@@ONEHDfIPEVCRAVCREPAHAFEL@LFTERMR@RAPHTi¨UFKXEdUDKVF@VAADADBD@@DDD@B@BAHEDAD@D@DBFHFBBBBB@@@BEBCBEBIB@@A@ADCD@BDHPHB@JDBBDBBD@DAD@BA@AD@B@BABAD@B@B@@A@@BA@@@ED@BAB@@@FAJ@PABAFAHAHAHA@SBIB@@@HAFCj@B@B@B@BA@@@@B@BAFCBCB@BDF@@@B@FHHDDB@B@@@BBD@BBDH@DAB@DBBNJFFHJhNCNDBDHFDBBDBB@@DBBF@DCD@FDB@DBH@H@L@J@BCFNMRB`BP@BAJHDJLC@A@A@AA@B@BA@@@CA@@ABCBCBC@A@EAA@A@@@A@CAC@C@A@ABCBCBC@C@A@A@@B@B@@@@@ACG@ACACACCA@AAA@A@A@A@AECCCAAACCEG@@ECACAECAAAAACCIGEC@@ECICECKECACEACAA@A@@AA@AAC@CAAAC@EAE@A@@IAKCMEIAEAICKCQEAA@IIACAA@KCMECAE@AAA@IAA@EAAAA@@B@@@B@@@BA@@@@B@@@DA^A@@FA@@LAHEZCTCAAAA@AC@AA@CBA@A@ACC@A@KCBCFIBAMAC@IDYTc`KDQVCRABHTPPFJbTWjKLCLAFJVPN@BHPCJUlD^JN@BHJDDRJ^R^JCBCLJRZR|TNL@B\\v^XXh`\\FB´`Zs@AlMÆmàËTG´spWêeDAÖ·ÊF¸Ft[¾cnYzKtKXEbGtK°InC~E²K¾aPCZE²_pKhK^GTCĎĒdKLCJAOªS¦QĎgZCPA\\EÒwDEfoRW@_@ABTKFCk~adSZJVIrY@Q@ASH]YSU[O[KSMEMa]OcCEIGBUBIDi@MCCSFe@QaIQUG_PdIsMKC]KGOSUUN]K\\EIOOOMOKA@AMIWGMUB_GmMWB@@KBMBOCq]g]g@KAODWDYGeggOJ}@]@K@yO^}TwkeAIW@KB_B[HQBGAs@AG@FUGKIGEACYSW`qACGoG{FMGIUe@AJOW]CGCGAGBIBCSSDM"],encodeOffsets:[[111037,39324]]}},{type:"Feature",id:"150625",properties:{name:"杭锦旗",cp:[108.736208,39.833309],childNum:1},geometry:{type:"Polygon",coordinates:["@@UJYIcT}blEDSLA@B@`QXepCFÑx[FOBYDčh¥R©TPIBKDcLđčSD]HgLoL±`YFOD½b±L}FmD¯JsLaHWFsLyLmZd½s\\·EEÉÕ¸CBéfoX³tSHßÌÅnkN@BYtADZd\\fBBbRZNNJXLBNFT@DFVDTFAFB@B@F@J@DBFDAF@BAB@DBDBHA@ABCD@L@@@P\\FDHFHFKJCBGJKCGBVX\\FbFHBNBzJTVNRFFflCnAbCZ@DZIFLDFBBLFHJHDFABKD@LTFNJLLLAJoXgP@LBHFL@FCJBJDHAJAFBRFBCF@BGDEEA@DDABGICBbl®ePCLX^MDNBBBDBFBFBB@BBBDFJLFHJVFJFLBDBHBDDFBHBBDDDBFDBBF@HBF@DAD@BADAFEDA@AJAFAD@B@B@D@DBBBJBB@B@NAD@FAJARCHAZCHBH@FBDBFBDDFDLNJJDDJHBBFBFDFBLDHDF@FBNBDBD@HFFDDDFD@BBDDJDFB@BDFFLJDDHBFBFBD@D@D@DAD@FCBALIFEB@NAD@B@B@HDHDBBDBDDDDHJ@BBBFDDDDBBBJFDDTJLFLFBBB@@B@BCDAHAD@B@B@B@@BDDFBDDHDDBBDBZPJDD@D@D@JCD@HA@BAFGJEF@ACCMEADCJ@@CFABCDG@@BBBHFpWv[JDjLDr`DBJ\\BDttLAFYJBH@HAF@LAPELCPCJ@FANEJCHCJODCPFRN@FINADBFBDLLBBNHTDBABAVQXIJCDAFCHEDAFAH@VADB\\LB@`JLDLBFBBAH@LAD@FANKFC\\MD@BAFAVEHA`EVCP@J@L@NARCN@F@D@HBHDB@L@TFHFHBNP@DDJFDFFBDX\\DBJFFBJ@LCRPdKIQ@@NMLOHO@IAGAGEEAEDODCDCF@RDtDvEZCVELCJCHCL@TGLEJEX@F@FB\\@^@HDLHLBRAXAPGJEDAF@LCNAJEBAVOJQHQLYFEJGZKFCLAJA\\CL@HClUfW@CD_BMFULc@@DGDCDAL@JBZFhHD@@CFBABABD@@BB@B@@AA@@AB@@CL@@BCB@FA@@DDBDDD@`YDBLIXWPKTMVMNJD@RBFCHDTDRADEHK@ADQAEESCMDGBC@KTSPCXAHAL@FEDEBATUBCHCH@HBLLFHDHNLLHXJHBD@H@H@BAFADAHIFGBIB@DAHBLDLBL@FCDGAGCMBK@EBAFCFCD@DBJDFFFBD@TARAJCF@N@HAX@JBDDBDAHAFEHGP@DDD@@REtKJ@JDLBHAB@HABAG@KAEQCQCICM@EBCBCDCBEBCBCBE@AB@BEBADABAB@@AAC@AD@D@D@@A@@CAAAAABCBEBCDCCAI@AEEEEE@C@EAECCGEIGGEACCECAI@A@IFA@MCSCCCBCBC@EACAAACGCCE@M@C@EDERIJEFCFEFCFABCDA@A@GBE@CBEBC@C@C@CBE@CBUJ_FUFQHKJCNEJCL@L@NANALA`APAB@NKFQDM@@DMBM@M@IAC@Q@G@@GCOIGEECSKACHACE@ECC@CIUCEACACEGIGCCBEGO@CAKBIFE@EDAAGDADEBCAC@ADABAB@@AB@DCHAHC@GAWBKDGHIB@FKCKGIAKAKDIFILILKLCNBXLHDV@LCHAF@HCLALDFDJARCB@HERAXDDHDHXCFCFEHCDA@GGECMAIACGKEKGGAKGEGGEGAIBAGAGCCEAA@EBAAC@C@C@EAE@C@CBCSQcFsC]gF]@AHAH@L@BABOX¯hi¤©DGlHMDGBUOE@A@M@GVcAAKK@@qGOAW@Y@IAGCECAGVKLu@AAiFIBKQ[WAIBUBM@SFCDICC@GDEHSCiAGAGACKGCUIMIIMEQIUOc@ABALGCCBAFDBAUQicXaQKT_DM@KFAF@@IDAEMAEGIcSiQMCKEEEBI@@OQEUK[CQ@[J@B@RqZ"],encodeOffsets:[[111511,40349]]}},{type:"Feature",id:"150626",properties:{name:"乌审旗",cp:[108.817607,38.604136],childNum:1},geometry:{type:"Polygon",coordinates:["@@BCHUDQ@OMWKGCCSC@@Q@QFQBI@@@M@SACCICMIMKSCmIWGUEaGQ@QDs^OHeT[LKTCDMTILADGH@BCD@B@B@@BBBDBBABIBQDGBIBMBODoLKBINKNOXEHABEDGD@BADA@@DA@ABCDBDEF@BMLA@ABGBABINCBIJ@BEB@BA@CHABA@ABA@A@@BABAB@BIDCAGAID@@CD@BB@@B@DBB@BIFK@E@EDAD@FBBHHAHAHADAN@B@@CFABADCBHRADCBEDA@C@GBA@ABFHEDKEMJEDCDEDDBCHABCFAFABAFCFAD@B@H@DAN@HAL@BLFJDCBCBKHIF@L@JCDPNBDD@DBHBB@BBNHNHLFBBLHTJDHELBFBF@B@BADJHABBH@B@F@BBF@F@BADDDFDDDBFBFDFBB@@HHA@AB@BDBBDDABLABBHDJDHFNBFHRBDDJVjNTJFFFABEDJDDHJNDDVlDD@FDL@BANBDCBCHAVERAAC@@FDLAH@JBFIRLLEFC@GNBB@BCPGP`\\JfClCpNHIhCNTTADAJBHDHDHX^IP@BVfHJENH|HpBD_rTXDZFBJHHLEVH@@BBtAHGRA\\A`@LXBJlfSx]~P@z@L@^I~PhhHfCZCXBP@L^h^hDrAPAN@LA@NXHnA`NVXHNJ@BLBNPPPJP[F^LVMTVHP^LLDtNJc`OVHJRRbf@TEDD@NCjAJAVJHDFPdb^FNTN\\L\\PTV^ZTG\\IR@\\DVLRFPDBbO|_\\H@NZ@~PVCjIF_SYJi`U^_DBbTLfEH@fDBB@BAPLnlOB@^MdQZGRIJADAJCJGAU@ECY{CCHOLK^ULGTSba~DH@JJBL@JCJKNIHIFEF@LBJLJLBRBjDR@B@B@lMNOhcDQRSRCBD^MFERObY@GCGDEECFEHCBEBEE@AGI@@G@ECECAAAADIBI@UKLK@G@kjYhW@@NOCWwmSBSMCKDUbQ@@CSE@IEwYGMIQHURMQYGMF@PAFHFAJ@HBHCDADCBGDA@CDCECHMVMNSDAFA@@JCXjFTAFGJGFEFEDCJEPGHCHCL@BPFOBCBGOEGGACEEMYSc@A@CAA@AACACACAAAA@CCACEAAAAAACCAAAAAEACAAAACACCACCC@ABABC@AACCBA@CDCDCBE@CACACAAACCAACCCE@ACAACCAACECCACAEC@@ECCAAAAAAAAAEAYOMMG@g`ABAB@@ABABABEFABCDCBCBC@CAAAAAA@AAACCAGEGGGGGGAAG@EAECEE@@GEEGECIEICIGGEEEACAE@C@@BC@ECCIEECGEGGKIGEECAAEEAGAE@GAGAGAICGEC@@EAIAG@I@G@GAEAGAKAMCKCKCIACECECGCG@G@GCGCG@AAABEBGBEDCJGBAHELIJGJGHGFCHABC@CCCEEKGMEIECCEIGECCAACCCAE@SFGBEBYVQPCBGBGBCACAAAKGEC@@@@@@@@@@@AGEGGGEGGCAACIIGGGGGGIEGGAAECIIIEAAGEGGCE@C@CAECGEEEGCGEIIICCIEKCMEIAA@AAGEA@esQOMCA@IEECAACEEEEEEEECIAGAECECCAEGIEICKCCC@A@EFEDEBCDE@I@GAC@GAGAICECCCGIGKEIIOGIGKEGCICGMI_AGQkMK@AACECEEAEAGACAEEGEGEIAIAEFEHEHIJI@CACEGGGCGEGEEEEEKECECGAEACA@@CC@CBEDGDGBG@EAACE@C@E@CBCDEFGDA@AFEFEFELI@@FCgLG`SxWBALETGJQQqSWjw@A@@AGE@E@I@C@C@A@EAC@C@I@AAGBG[A@CG@GCEIMKICCAABEDIBGCAGCMEEBEBGBG@CAGCGCCAAE@GBMAGCECKCGAAIAI@CAE@KGKE@@ECG@C@CBAB@@MNINCNCJAPAHWPMJILGV@@ERCFAFAVWZSNGF@@CDEBGBCBaP@@E@k@QAdBAHG@@DEBEBE@AFI@MACAGIMYmKWEOAQ@OAE@WG}@[@A@}BWHSDKBC@ABIAG@IKKKOGKCK@OB[BIBC"],encodeOffsets:[[111397,38636]]}},{type:"Feature",id:"150627",properties:{name:"伊金霍洛旗",cp:[109.74774,39.564659],childNum:1},geometry:{type:"Polygon",coordinates:["@@AACFADAB@FA@@BA@A@@@@@@B@@@@@B@@A@@@@@A@@BA@@@A@@@@@@@@B@@B@@@@B@@@BABA@@BA@ABA@ABA@A@ABA@A@A@ABC@@@CB@@ABCBA@@BABCB@BA@A@CBA@ABA@A@@@ABA@A@@@A@@B@BA@@BAB@@ABC@@@A@A@@BABA@@B@BABABA@A@@@ABA@@@ABAB@BA@@@@BA@AB@@ABC@ABA@AB@@@@AB@@@@@@@B@B@@@@AB@@AB@@CB@@@@AB@@A@AB@@@@A@A@A@@@@@@B@B@@@@ABCBCB@BA@ABA@@BA@@B@B@B@@@@@@@BA@@@@@@@@@A@@@@@A@@@@B@@@@A@@@BB@@@@@@B@@@@B@@A@@BA@A@@BA@@@@@AB@@A@@@A@@@@BA@@BAB@BA@@@@@@B@@@@@@@@@BAB@@CB@@ABA@A@@@@BA@@@A@AB@@AB@@ABA@@B@@@@A@@BA@@@A@@B@@@@A@@@@@@@A@@BA@@@A@@BC@EBABCBDBJHZRAHKBC@qCIHORULEBK@IKKFuPkLMHBAAA@A@@AAA@CACAA@CACAAAA@AA@AACBA@A@C@A@C@AAAAACAAA@@A@A@@A@I@C@EBKFKFMBIDEDGBADADAJ@H@HBD@DADABADCDEFI@C@ABA@CDAFABA@AHMBABABCBABABCDGBA@C@ABE@ABC@ABABABCDGFEBEBEBCBCBA@A@A@A@@@A@ABCBEBA@ABC@A@A@@@A@@B@@C@A@ABGBC@ADEDCBABAB@A@DA@AB@@AC@@@@AAB@@A@CBCBA@EAA@C@@@E@I@E@@@AAAAAAAAA@ACA@AAC@A@CAE@AAA@A@@@ECE@AA@@AAAAA@@AA@A@@@ACA@AAA@AAA@@@A@CAC@A@@BA@@@CAA@C@A@A@A@AAAAAACAA@CAA@ECA@CCAAA@AAA@A@EAA@A@A@A@A@A@E@A@@@AAA@@@A@@A@@AAA@CC@@A@AAC@A@@A@@@@AA@@AA@@AAAACAA@AA@@AA@@AA@AAG@@@A@@A@@@A@C@A@A@@@A@AB@@ABA@@@@@C@AAA@@@CAA@AAA@@AA@AA@@EAAAC@CAA@@@E@AA@@A@A@A@@AA@AA@@@@@AA@@@A@@@AAA@CA@@A@AA@@A@A@AAA@@@@BEA@@E@CA@@E@A@@@A@AAC@A@@@C@@@A@AAA@@@ACC@AA@@AAA@@@A@A@ABAB@@@@A@A@A@@@@@C@@@AAA@AAA@@@A@@@@@AB@@A@@BA@A@A@C@C@@@C@A@ABC@A@A@C@@@C@A@A@A@@AA@@@A@A@AB@@A@A@A@A@@@@@@@@@@B@@@@A@@@A@A@@@@@@BA@@@@BA@@BA@@@@BABABAB@@@BA@@B@@AB@@A@@B@@@@A@A@@@@BAB@BAB@@A@@@@@AB@@@@@@@@@B@@@@A@@@@@@B@@@B@@@B@@A@@@@@@@@B@@AB@@@@@@AB@@@@AB@B@@@B@@A@@B@@@B@@A@@@@@AB@@A@@BA@@@@B@@AB@@@@@B@@@@@BA@A@A@ABA@@@@@@B@@@@@@@B@@@@@B@@A@@@@@A@@B@@@@AB@B@@A@@B@@A@@BA@@@AB@@A@@@AB@@A@@@@B@@@@@BA@@@@@@@A@@@A@@@@BA@@@@BA@AB@@A@ABA@@@AB@@@BA@@B@@@BA@@BA@@@@B@@@@A@@B@@@@ABA@GDA@A@AAAAAA@AAA@@CAA@CAE@AAA@CAA@AAACAAACAA@A@A@C@C@AAAA@@@ABA@@BA@ABABA@@@A@AA@@@AAABABA@ABC@ABA@ABABCB@DCBABABAB@BAB@BAB@D@D@B@D@B@@@BBB@BBB@@@B@@@B@@AB@B@BA@AB@BAB@DAB@DABABCB@@ABABABABABCBABADA@@BADADCBAB@BAB@DADADADADCFCDABAB@BAD@BAFABADADABABABA@@@A@A@@@@@AAAAAAAAACACACCCCCACAAAA@AAA@A@AAA@AAECCACAE@ECCA@@@AA@@A@A@AcUCAACC@A@A@CAA@C@BKFEXW\\gEEy@IJCHCHQHWHC@QCQMAA@AAEAA@@AAAAAAACAAA@eOSUWW@@ABAA@@AAAAA@@AECA@AACAACIE@@CAC@C@EAAAGCCCECAACCAAAACCCEAAACCCCCCCEEACCACCCAACACCAACAAiAGSEKEKGEGBYF@@C@MY@C^SJGAII]AOK@GDGDOHIFCDEFEFIHEHSBEWiID@@EBCBMTUNGNFDCD@DCBAHCDCBGDGAI@EBEGOBE@HNRZQNGVJRHNxZJFF@DT@@aRCVDLTNTAxnDXMP@@gXiZ@l@HKLVLJ@JABCBBDBDF@F@HJ@BHF@AFAFGDEFFDCFDH@HaZQPEF]NACQDQTCRgdMPkNA@A@Q@iCQAKAKIAI@KFEJEJGLMDI@IAKIIG@}CabSTKH]VKLGPDD|DZ@FBVIHIDCBIBQJYHcR]NA@kPmOKABA@CA@eFGKeaSCA]`_VIjTZE`iJUD}OY@@M[G{`aPCA@@AJFFLFNDjRdTHJBFFNCB@JE@EB@LCNS`RLWbjdVRABECABDDKHAB@BPdJVFRJNNJVJHDDLHBHBjBTDFGHCD@JDDCTEN@VAJAXBR\\ALEJBj@BKvULBHFDHDJBZ@X@PBrH@@LLBBUd@H@N@BPFAVCHGNkL@dtr@@@iH@@@@E@AJUHY@AFMDE@EBEDIDELGRGL@äJÆNB@FHFDF@ACBCBD@@HAH@H@F@JBL@@@FBJBXDB@THJFJAHAJAJ@L@J@JAFAJAd@P@PAJBFBH@DBFIFGHIIB@CFABACGHAEACCCGECACECCCO@CKIACACAID@BG@UEOAIA@@DEDGBE@EGB@CEEDAB@BCCECAE@EAGCAGBAH@FAB@LABGFEBALSJIHBDBhCJATBR@XAA@F@FAL@HCLKTKTQV[DIDEDEJKBCFCJAB@JAJ@F@JAH@FBF@FBFDBDBDLFBDLAH@H@LCFDNBDG@CFJBD@@FBNABDGF@FBDJBB@HCDE@ADGDELEPAN@V@X@BCFK@ECABCB@NBFDH@H@FCJIHEJEBEDAHEFAHALAREJAFEBAFAHEFG@E@M@AHAF@FAHADCBCDEBCF@BEB@FAB@DFFDBF@DAHEBCFCHADEJ@J@HDDBDBB@L@NEDCFAFBFBF@F@HGDORAFGF@DAHBXKHCFBBilCBDxTL@@BBJ@P@NBJ@J@XLJBBBFDNJRTHDNXLJFDB@HCNEN@JAJ@F@HCHGFAJAFAL@BBD@ABDBF@HCD@FCF@FAHABCBAJABADANCBA@A@A@@DA@@@AF@BAB@BBB@BA@A@CA@GA@AFABAB@AA@CAAAAE@EBA@A@@A@CA@E@CAECA@CDCDADA@@BABA@BDA@EBA@@DCDIBAAAC@CBE@E@CCG@ADA@C@AAE@ACAAABE@A@CBABI@AACB@@@BBBB@ACAAGCGCGCGDE@@CGGIIEGEGA@GBKBMD@BADC@C@G@GDKDA@CFAH@B@FGDCDCFCDCDAHEJCJAH@H@DDDCH@H@H@HCBDH@BC@@BADAD@D@H@D@F@FBF@FDAD@DBDHJBBH@CDAJADBJAFADEDDJ@DEBBHBHF@@@DBLBB@FADCHCFCDAB@D@B@DADAF@DA@BHALALEDAHBBFJFBDFBJBFBJABDDB@DDDHBL@BEFEHE@@HEHKDAHGBGBCHGBADCBGJGHABCF@ACDAD@HCH@BCCGAI@MCCEDEDAAC@AADABC@E@EDC@CDGBEFIFI@EBAAB@@FEBCBCHEBCFM@C@CAAEEEC@A@@DABABAFIDEBAJCB@H@LABADAB@DAF@HAJCDADCBC@ABEBCAA@A@ACA@AAA@A@ABAAACEAEEQ@EAC@ABABAFAFADAD@FADA@A@AAE@E@ADE@A@@AA@A@@B@@ADC@@@@@CBA@A@@AA@@@C@@@ADCBAHAB@FCDAB@F@B@JDF@DBBBBBFBB@D@F@B@D@B@F@DAF@BAHAHCFCBA@A@A@@@AEA@@BAB@B@B@B@B@@ABAAACA@AB@@@D@@AB@@A@@AAA@C@AA@@@@BADCDA@ABA@AB@@@AAAAA@AA@@@@IEEAE@E@E@E@CBIFGJ@BAB@BCDCDKDODKDK@M@A@A@CACAA@@AAA@A@A@AAAAAAAAAACACA@AA@CAA@AAA@A@EAA@C@AAA@CAA@@BA@@B@DADAD@BABADA@AB@@A@A@@@A@@A@AAA@A@A@A@A@AAA@@AA@AA@AAA@AAAAA@CAAAACACAAAAAC@CAAACAAACCECECAAA@GA@@"],encodeOffsets:[[113036,40255]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
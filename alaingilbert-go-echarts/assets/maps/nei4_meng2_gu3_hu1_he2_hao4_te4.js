@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("呼和浩特",{type:"FeatureCollection",features:[{type:"Feature",id:"150102",properties:{name:"新城区",cp:[111.665544,40.858289],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@EAAFADA@@BAFAB@@@@@B@@@BA@@@@D@@@B@@A@E@@@EAG@A@@FAB@@@@@D@BBD@B@@@@@BA@@BADAFAD@BCF@BAB@BAD@@ADA@@BA@@@@B@@@@AH@@@@AD@D@B@BALC@AH@@AB@@ABEDELCHEHGFCLGFAJGFEFCDADCBEDBFAHABB@JEB@BBH@F@^FxAB@BB@@PDB@JBF@LJBAB@D@B@B@BBBB@@B@H@LAD@FAB@D@HBB@@@@B@@CBA@AB@BAB@BA@AB@@CBA@A@AFABABA@A@ABEF@@A@@B@@@@DBB@BB@@B@@@DAB@DAB@JBD@@ABAB@FAD@BBB@BB@@@D@D@BDB@B@@@B@BEF@BAB@D@@@BCD@BBD@@@BA@AB@@EB@@C@A@A@@@AD@D@@@@B@D@FB@BD@@@DBDBDDDBHBB@BB@@B@@B@B@@BB@@B@BB@@AB@D@@@B@@AB@@@BB@@B@@B@FD@BBBBB@@FB@@HADDF@@FB@@BF@AF@BF@LHD@JF@FJ@RL^FAH@JBNJAHF@HBDADBDAHAFFBFNAFKBCHTFDCJCJFAHAJLBD@TJBFDDAFBFLFBDFHFFTGZB@@@BDBBF@@rSRUVYdOxWf_XJcP]QAacF_ROBCDUZ]TE@@@GCIAC@AAAC@C@CBCDA@A@C@GDA@AAAA@@B@FC@@AIBA@GAAGA@@@@C@@@EE@A@@BC@@FAB@B@BADAB@B@B@B@DAB@@@AAAAAB]GQUQSIKEAC@AK@OBEBSAE@ACKBkCK@@@gAAB@DSA@L@LO@CRWGDIIAMABAA@BK]GBE@ABGOADMG@@CKAQC@AE@@IACIC@@AKAI@@@AKCGACAIAA@CAGAA@A@IACA@@@@E@CAKAGAA@C@GAAA@@C@A@@@@AC@EAC@@@@@C@EACAIA@@@@@@GA@@C@EABCBC@@@@BE@@AAGAA@A@@@GA@@@@EAIA@@@@GABAGA@@AA@@"],encodeOffsets:[[114357,41793]]}},{type:"Feature",id:"150103",properties:{name:"回民区",cp:[111.623692,40.808608],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BABE@AB@BCBEFKMC@@GA@@ADC@C@A@@B@@@@AB@@@DC@@@A@@@CACA@B@@CA@CAA@@@A@@AA@@A@@@@@@@@C@@@A@A@AA@@@A@AAA@@@AA@@AB@@G@EAE@C@@@A@@@@@CAC@A@@@C@@@E@GAAAEAC@MA@@@@G@C@C@IAK@@BADAB@B@BC@ABABB@FF@BA@KHA@EDIFEEAAACOEA@@@A@CBGDADAF@B@@ABABCDEDABADEHCDEDGF@DEHADDDEFBDAD@BGDC@IDCFEBA@EAE@CBADE@CAIFKAECG@KDABIDCFABEBABA@CFADDFCDBFCDCFDDABGF@FBFBDAF@@DDDF@H@J@DDJDDHJEFDJHBHEHEH@LBJCJAF@HAHC@GFEFAJCLAFBRBH@FCF@JBDFBHADBH@FDJFFJ@DFNFLAFFFBJBBDBCHKLILGHEDABABGAEFCDABCDCFEHEBIHEDKHEFGDGFKFCBA@@BA@@BGD@BK@A@A@CBC@@@@BG@@@@@A@@B@@AB@BC@@BC@ABA@ADE@ABCBEBC@AB@@A@@@@@AAC@A@C@@@@BA@EB@H@FB@@F@B@@@@A@@@C@@B@@A@@@A@@"],encodeOffsets:[[114348,41802]]}},{type:"Feature",id:"150104",properties:{name:"玉泉区",cp:[111.673881,40.753655],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@BB@D@BAC@@AB@BABB@ABBBBBBBCDBBA@@@@cKA@IEADC@AF@@EBB@F@@BRDJDBA"],["@@AB@DA@QCAABAA@@AC@I@CAA@ABADCBA@@BA@@@ABQAWCGA@@BC@AAAA@C@@ACHGAGXA@QAKeAA@@A@ABAFABIPA@ABC@CAC@ABA@CAAB@DBB@@A@GAAAAB@B@@@B@@@B@BA@@B@@DBB@@BB@@@@BBD@@B@D@BBDB@@B@@ABA@@B@@@F@BBBBB@D@DB@@@BBB@@@AB@B@BB@BAB@BB@B@B@@@@BA@@B@D@B@@A@ABAHC@AH@@FHCDC@ADCB@FD@B@@@@@BBB@BBDFDD@@@F@@@BF@DDD@@@BFBDFD@BAJ@@MCIFFBJJCDEAEAADA@ME@MQAE@@A@C@@D@@C@EBAE@IAMAKCGE@@KAMCABCAADAAAAAA@BAAABA@@BD@ABC@A@@AABHJJJHFaJAAEOAAGBCBECDCAAAAGAAELIA@SDDHFRB@BFDJFNBBBB@@H@D@DA@AFB@CLB@BADFHABBB@F@@ADCFDD@B@DDD@BDDBBBBGDBDJCLDBDD@@@H@FADBDPBFBDBHBBBRCAA@CFABADAAAB@@E@@BJADBL@JBD@D@H@@@@@NBD@FBBBHBF@@@D@@@B@D@DB@@@@B@@@D@F@FBH@@@BA@@BB@@B@BBB@@@B@@B@B@B@@@D@@@@@@B@@@BB@@@B@@BB@DDB@@@ADBDB@@B@@@D@@C@@BA@@@@@AB@D@D@BC@@HB@@NDBC@@B@@CBA@@@ADC@A@C@E@C@A@@BC@@@@BE@A@@RD@AEAA@BAHB@AC@@AA@B@@AB@@AE@A@@A@@@@A@@@BCDCFALE@AEA@@@A@CGC@@BA@@@@DI@@B@BAA@@A@A@A@@@AHBGE@@@C@@B@@A@@B@@A@@HAB@@@@@BEFABAHBF@BC@@@AEA@@BA@CE@@D@@MA@EA@BE@@GA@@@ACACABAC@BEDGJD@@DBAFHBDBAFD@@@@CBBBA@ABC@AACA@AA@@CBC@AAAA@@@@@A@AAEEIBADCBB@BFB@AB@BAFBBALSDGD@@@AA@BEABGJQ@@DGP@MO@AI@GCVkIEOCGAABABCBABE@C@C@@@@BF@CB"]],encodeOffsets:[[[114230,41675]],[[114383,41621]]]}},{type:"Feature",id:"150121",properties:{name:"土默特左旗",cp:[111.163902,40.729572],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@GCIESAA@MEK@A@@@MDKBCHC@AADAOAICIBIBEBIDKD@BEBODOFGBOFWH_JK@M@OJABEIMBANWEA@KLKNEFCFGA@CIC@aEC]@KFCFGbEA[FAGMDLPH@@FC@ABIA@AKA@BCAB@@CDGEBEBBEBCA@BCY_@A@@k@D@@DC@AFBBAF@BA@@@AF@@KCAFA@CAABKA@AA@@@@@IAAFF@AHA@ABFBAF@@@B@BD@@@@@AJB@ABB@@BEA@BLB@@BAB@B@@@FBCFBBBB@B@@JB@BF@@@KB@BD@@BBB@ANBADAB@B@BA@A@@DGABLCD@B@@ADA@AH@@A@A@@BIA@AAABCACAFGABAIAFEAAA@IAACCA@@@@C@@@IA@@E@AB@@@BCA@@@BIA@@@@G@A@E@BCA@@BA@A@@EIABCEC@B@AAB@AIA@DE@AA@CEABCGAAA@@@@EHC@DEAA@CCCAHADCAAB@@CD@BA@@AIAEFCIE@@AE@BCC@@AC@@@A@@CA@@BE@DBEBCBEJECAFF@ABA@@@A@@A@@ADIABBA@A@@B@B@BA@@A@AAA@@CACEEAC@@@C@@@A@@@A@C@A@@@E@A@BBD@@B@@@DCA@BG@@B@@A@@@EA@@G@EK@@@A@AA@@A@@@AEA@BA@@@A@@DGAADCA@ACA@@AACBABIA@AC@@BA@@ACDA@@@AA@BC@BGB@@AA@EABAF@B@@GHEGI@EF@FBAACEA@OE@CG@BEE@@@ADGAAFEECA@@C@AHEABEC@AFE@@@@CA@@A@@CA@DKAABA@@A@@BCCA@AAAEABAA@BEGA@@@@CA@@LA@CGABA@@BA@CA@E@CDECE@AF@BDA@DMB@DF@@BMA@FAD@@@BEAAJGA@BIAC@@BA@@B@@A@@@EA@B@@CBADAAA@ABA@@@K@G@C@@AECA@GABC@AA@DI@A@@C@@CCAA@@E@@@@A@@CE@DGC@DGA@BGIAA@@AE@@FKAAH_C@AAAA@@@FSGACGCA@@@@OqBM@ABIDBDMA@OKEFCB@@AAACAAIEUKCAQ@AF@@A@G@c@BIQAM@CBABEJ@D@@@BDDHFDBBD@DCBABABCD@@GHOP@@IAEAE@CAA@ACEAEAC@A@A@CDADIP@BAB@BADADA@U@@@M@@@@@AFADAFDBAFCJAFCJ@B@@@@S@IDE@@@@ACC@@CE@AAAAACAA@GASEKCC@CAA@A@A@A@EBCBCBORAB@B@B@DA@@D@B@B@D@F@D@B@B@@@@CBE@A@A@ABA@A@C@@DG@K@ABABABCBIHCDOLIHVH@B@B@B@JALAL@BBBPBRDLBB@@@AJ@@CPPB@B@DAB@B@D@B@BKAU@AD@BADAFEN@B@@A@AECEKCaMA@CDEFMLWVABCBCDJF@@@BAF@BABC@EP@D@B@@@B@DAD@F@DCJ@BAB@JCNAH@B@DAD@FAD@F@@CL@D@@@DAD@B@BBB@@@BB@B@B@B@B@@@BB@B@D@BFA@@B@B@@@D@B@@@BB@@@B@B@DB@@@BBB@@B@@@B@B@B@@B@BBB@@B@@@BA@C@ABAB@@CBCDGF@BCB@BADCD@B@BBB@BAB@BBB@B@@BB@@ABA@@B@D@BBBDDA@BBBDBBAB@@AB@@AB@@@D@@AB@@AD@@A@A@@B@DBBBBBBDBDB@BABAB@@@BA@@BCB@FADAB@@BB@B@BAJADABCBABC@ABAB@D@DB@@@B@B@B@@@@D@@@@DB@@D@B@HCB@DBB@D@BBB@BBD@B@@B@BBBB@BBDBBBBAB@@@B@BB@@BB@BD@@@BB@@B@@BDB@B@BDBBBBBB@DB@@@BGJBJLF@@F@BAX@HDNJL@D@BDB@HEHAFADDHBHGFAVFHJB@DDZ@BABAD@JED@HDJCDBJEF@BALBBDBDPBFCBBH@FBJ@@@L@NCF@CFb@XVCZNLbHVJPBPBJBFRDDIb`V@@rKhF\\CLCPBJ@PTAFFDBFFDEHJ@BAD@DAB@DEFGDE@CB@H@JCHGHAJGD@D@DE@@DAD@B@D@F@HBFCB@D@HEHBN@F@FFFBBDD@@BF@BBHHFAD@BB@BJ@FEACB@HBB@@CBA@@D@DA@ADADBHB@CDAD@F@FDJ@BED@NOJAPCFDL@RADBF@DBD@NBJADBBBJHDDDFBAH@DBBBABC@ABJDHCHCJ@DBF@FFFD@DFLBBPABCDA@I@GCECC@@BEACAE@EHEBACCDEDCAEDCCEBCDEB@BAFABADEJCBALCH@FDLBJEDBF@BCDAF@FBB@FADEJCD@HC@ABCACFECCBCFG@CHEFCDCFGBCBAFCDCBABA@@@ABEBCHCDAB@@@B@PFBDBBFFJEFCB@LGB@@AEEA@BABAD@@A@ABABC@ACAIB@AF@@@BABBBCBADEB@DBAQAAAGACAECOCAEBG@@@C@ACKCIDACHCAAAACC@ACC@C@ACCDEBC@@@EAABAEGBC@AKA@DEA@BCBC@G@@@AAAAEMCIAEA@EQCGTCFA@@BED@BCJFB@dL@@@@NDLB@@HFLDNBJBF@AB@F@DC@@@@D@BF@RB@NNFB@BCFBFBDCIIEAJEND@@BI@AECACAE@@C@CCE@@A@@@E@@CCCEAAA@AA@@@@A@C@@EDABCD@DCEG@@BGD@BGBAB@@@@A@C@AB@@A@@A@A@A@@ABA@AAAA@A@@B@@AA@A@@CAC@A@AAAAE@@@A@@@AB@BA@@@CAAAC@A@@@AC@A@@A@@AA@CA@@@AB@@A@A@@@A@@@ABABBHBB@@@AA@CBADBB@BAD@DBD@BAB@JOBABEBAB@@@BBLfRBB@HWHBDG@BD@B@BB@BAD@@HBXDRBBA@@B@@AB@DABCBAB@DBJ@D@@BB@ABBBRDB@@CBADAE@@A@@D@D@F@BADABABAHBPDJFJQRKR@T@V@ZAP@FE@@Z[@@@EF@SKCHESAICIGcK@DUBG@ABA@CA@ACA@@@BEAADOR@AE@IXEFBFQ@AIAAGUKEC"],["@@GEIIGIICQC@AE@KJBFHBBBBBCDFDDAHABBFPBBbI"]],encodeOffsets:[[[114476,41479]],[[114242,41689]]]}},{type:"Feature",id:"150105",properties:{name:"赛罕区",cp:[111.701355,40.792667],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@GB@@@BA@@@@BA@@@@D@@HFGA@B@@@B@B@BB@ABA@@@CJ@@@@AB@@HD@D@B@@FB@BKFEBCDAD@@B@@@@@@BB@F@@BA@@BA@B@@BD@@BGAABB@FB@BQC@@@BAF@@@@AD@@@B@D@F@D@BCD@B@@AB@DA@@@ADELFBB@@@BB@@HBABHB@@@@JBFB@@@@HB@@B@B@HBBB@@AF@@@@ADADFBD@@@HB@@@@@@JBDBFBD@@@@@D@FBD@@B@@B@D@@@BBHBD@B@HBLBDBF@@@@@DBJBB@B@HBDBB@JBDBHBLD@B@@BJBL@@JDBD@JF@@BRDLB@DH@CNPBAH@BAF^HALB@ABNBJBCJXHDQP@@K@KTB@CBAhB@@L@lDLABDF@TBFAPAL@@BDFBJLRTRV^HBABBBB@@A@CBA@A@A@A@CBABA@A@EB@@AD@@@BFF@@D@@@@@HBBB@HABBJ@@EDA@@@BBBBB@HCD@B@B@DCDAD@D@BB@BBDDJ@H@@\\E`UfI@@lPJFHD@@J_@@NSBC@ABEB_LSLU@KAEBAZUX]AsNMAEEKJ@@CABEAE@GEEGGECCGACC@EAGIG@IDEBCCGAMACGAA@@@C@OIGGsIA@MCIGAC@ABAJALK@@BE@QTCF@DA@IFCDK@AFADGIEACEEKAEAAAACBK@GHMBG@AAG@AEDIBEFAAKAAACDMFEBA@G@AAGEOAA@CACAWGKA@AOB{FAJWDBAEBGCIVITOLGA@@@ACDMDODKEK@@EMUFEBIBODeBGHIC]MMCEAGC]MA@EABAKEEC@@KETi@@GEWACJKAAA@@Y\\@@EFO@YBU@S@Q@QLIRUlHDJ@@BNPO@CH@@IRAHFB@ABB@@C@CHKTABEAABA@@BEA@AAACDABFJBF@B@B@@@@BBBBD@DA@@BBB@BD@BAD@BABAA@D@@C@BECAGABECA@@ICCHAFD@ABDBDB@B@@HB@@AFB@@FNB@@@CF@@DAB@@FB@B@@ADE@GAABEBAF"],encodeOffsets:[[114383,41725]]}},{type:"Feature",id:"150122",properties:{name:"托克托县",cp:[111.194312,40.277431],childNum:1},geometry:{type:"Polygon",coordinates:["@@DFBBEAE@@FHJGF@HA@E@ABFBB@@BA@AHD@@ABB@@B@DC@BB@@AD@@BJBBADABB@@DB@BDBBCHB@CB@@@B@@AFB@B@@@BB@@B@B@@FLH@@@FB@@B@@@@AH@@ADB@C@@@AC@AAB@F@@@B@D@B@@@B@@@D@@@D@FBDFDB@@BB@B@BB@@A@A@AB@B@AAJBBC@@@BB@@@B@BAE@BEFDFIDAFACAF@@AB@@DB@@@D@@BD@ADF@@BF@DJFEJB@BB@@ADC@@BADBBCBGDD@DBBCFD@FG@@@@BBHBADFB@DBBF@@CJB@BBA@B@AFDADJB@FB@B@@AB@ADF@B@H@@@@@JB@A@@DB@A@@BAF@@@JB@@D@@@@@DBBDJBB@BBEFJBABHBBEBDADBB@BJB@AB@B@@@BGB@BC@@@ADCAKHB@CB@B@@A@ABABCMA@BAA@AC@@ALA@@E@@AIA@@@AAAAADEEA@@A@A@AB@@KA@AFB@AA@BAA@BI@@@@C@@A@A@@BEEABAB@BGE@BEJB@@@@B@@BLBBADBB@BELD@@BE@@B@@ABEAABED@@CC@l@@@T[GUGOICNOOQ@@AOGBA_@EH@Am@@AACCGA@AECMKCAGAA@EAC@@A@@AABA@G@KDO@C@GACACEGA@GGCCC@IC@@A@gK@A@gÄIJKGO@A]CT[BAYmIU@@CABI@GJC@C\\IF@HCD@D@BBD@HAhI@UAAQS\\_Wa@ATAJIBAJ@AAGAOGIAAACEFCAACCA@CBC@CBCBG@IBI@CBK@IAI@A@GAA@AB@@ADA@A@@@MCA@CAA@A@C@G@G@A@C@ABAB@@A@A@@@A@@@A@@@@AA@A@C@A@E@GAA@C@C@A@A@CACCAA@@GAC@@EACCCAA@@BADC@@BA@A@@@AAA@@@A@CAE@@@EAGAC@@@A@@@ABEAACAA@GC@CAE@CAQ@@@@@E@G@C@@AAA@A@@@A@@BA@A@CAAAA@E@AAA@C@ECCAGEIC@@OIGLGDIJEDADCBEDEFIL@BEFEDIJCDADCFADAFGHODEBGFSD@@EBI@IBIBE@KBC@GBE@KBKBEDC@@@KH@@EFGFQJGJEJCFIPCHA@GJGHA@@BGFIDCBOJGBkVULC@ED_TA@mLoF]@GDCHBVBF@H@D@@@@ADA@@@QFC@EBC@@BDPMD@BDB@D@@DDDDL@@@R@FBCFNBD@D@DDNFNFDB@BHDD@D@B@F@@DRBDCBAR@J@@@AFEDEHJJ@@@B@DAB@D@DCT@@DB@@T@F@@B@BCRJP@BiEAD@BEDEDADCF@BCDAA@D@BIN@@B@@B@BCH@DABALC@@DAD@HC@DBVLJFBBBDBB@@DAFEPLB@CNCAAJ@BANPr@@@@DBDHHBET@@B@BB@B`DBGLB@EF@@BB@JBAHB@CHD@CHF@@DB@@@@@@FB@DB@DD@@@@BCJB@@BADHBB@FD@BD@H@L@@@B@BAB@BBBCDA@@@AFB@@B@@@@AB@@AD@JB@AHBBIFB@A@@BC@ENB@AE@@CNA@CCB@ABEF@FDDCF@B@@DAB@@ABHB@DKB@@DB@@@@HBAFB@ABFBBB@BDBAD@@@BB@BALB@CDB@@@BB@@D@@F@BED@AFFBBGD@@@DBFFBEHBBC@@F@AFH@@DPFB@"],encodeOffsets:[[113953,41512]]}},{type:"Feature",id:"150123",properties:{name:"和林格尔县",cp:[111.821843,40.378787],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@A@@BABA@ABA@A@A@C@A@AAAAECA@AEAA@@AA@@CDA@@B@BAD@@@DBF@B@@ADABA@ABA@GDA@A@@@EAE@A@AACAIA@@CBIBEBC@@@@B@@@B@B@@ABABCFCBAB@BA@ABCBEBABCBCBCBEBEBC@A@ABA@@BADADA@@@A@@BA@@BAB@BA@@BBB@@@BEDADCB@@ED@@ABAD@B@DAD@BA@ABBBEHBXcrNPBDm`¥BC@GCCAA@@@@@CF@DeBCBQQSC@EIEQDCIE@C@AA@A@A@A@A@A@@A@@@@@C@AB@@AB@B@@@@A@A@A@@@@AAA@@@@A@@@A@@AA@@A@@C@EBA@A@@@A@A@AA@@AAC@@@A@CAA@C@A@A@A@A@CBA@ABA@CD@@A@CB@@AB@@@BA@@@AAA@EDAB@D@@AB@@A@A@CAA@@@CBA@A@A@@BA@AD@@ABA@ABA@AB@@A@@@@@@AA@@AAAAAA@C@CB@@CA@@A@AAA@@ACBAAA@A@A@ABA@C@E@AA@@CCA@@@C@ABA@A@AAAAA@C@A@ICCBC@A@A@@@@BAB@@@BA@@@A@C@AA@@AAA@AA@AA@@ACBE@@BA@C@A@A@@AAAA@AAA@@@A@A@@@AB@@C@AAA@@@C@@@A@@B@B@@@@@B@@@B@@@@A@AB@BA@A@@BBB@BDFBB@BDFFhBXW@AISA@GcFOBiJIB[DA@C@ABEDCBABGB@@AB@BB@BB@@@B@@@@ABA@ABA@@AC@@@@@@@@BBBBBD@@@B@BB@@@B@BA@@B@@@@DB@BB@@B@@@DADABABC@IDA@CAEAEAAAA@A@A@@BAB@@@BB@@B@@@@CB@@@@@BB@@B@@A@CDCBEBCBCBA@A@A@AAC@AAE@E@ABA@ABABABA@DDBBEDDFBBJBPHHBBBI@ABIJSB@BXb[`RTBB@VgJGBC@AAC@C@GDE@[J@DID@HAJDB@@JVZnABS\\^D@BHPILÃJ@h@BhLB@@@JDD@DDHHB@FHBDBD@H@DCP@L@HABBB@@@BD@FBB@HBDBNLFD@BHBDDBB@@BnG@@FB`HABP@@PRMPJDHPHVS\\@BZ`ADB@ADAFFAFACH@DA@DB@ALB@BJBBAD@@EG@KONCBH\\EFBHaDELE^@FD@bJD@DHBDEFELMLKB@XFBMNAFJBAPIN@L@`IXGPEHAPEPCFA@ALCJCFAJAJAJDPBCBBBD@DGLANC@@B@L@NFB@TBJFHDFDVLBHJB@BEREAWF@JBFQ@CPBBAF@@B@BDB@@DAB@BAHCVL@HdDJBJFTDGTLE@@FBBLBDIXBHF@@SjLF@@FDLFABFBB@^NHDFBND^NJDHGfAPCJAFAVEFN@@FLCLCPCND@B@@HBPKJSJUHDFAABXCBI|EPA@@BSBALGF@FCTKNIXQB@BCFWJQACFI@KAAIEC@CACCCAI@G@G@C@ABAD@FADE@CBA@AAA@@BA@KSA[@A`IAKdOJMLSRANSKARIX@X@dUN]BCVOTMlQfI@EBA@@B@@AACEMAACQ@AA@B@@CFC@AFAHGBAFCFCD@FCBA@AB@BADE@@BA@E@ADEFAF@H@BAH@FA@C@E@CAAIEAIMAB@a_A@BQFMw\\CBAFeFWD[BCZIFOJWDCAEAE@@@AAA@@AA@@@@A@@A@@@@@AAA@AAA@@AA@A@@A@@AAC@A@A@@@AA@@@AAAA@@AAAAAACAAAAAAAAA@@@@BADABA@AD@@AB@@ABABC@ABC@@A@@AA@AA@@A@AAAAAA@@A@AAAA@A@@AAAAAA@A@@A@@A@@AAC@CA@@C@EAAA@@AA@@AA@A@AAA@@AA@@A@A@AAA@AA@@A@@@AA@@@@C@A@A@@@A@AAAA@@A@@@AA@@A@@@AA@@A@@A@@AA@@A@@AA@AA@@A@CAA@A@A@@AA@AA@@@@@@AA@@@@A@A@@AA@A@@AAA@@A@@AA@A@@@@A@@@A@@@@@A@@@@@AB@@AAA@A@@AA@@@AA@@A@@@AAA@@@A@@@AA@A@C@@@@@@A@@@@@A@@@A@@@@@@@AB@@@@@@A@@BABA@A@@@AA@@AAA@@@C@@D@BA@@@@@A@@@@AA@@A@@A@@B@BA@@@A@A@@@@@@BA@@B@@A@C@@@@A@AA@@C@@AA@@@AA@@AA@@A@A@@A@@A@@A@@@A@@AC@@AAAA@@AA@@@A@@@A@@@AA@@A@@A@@@@A@@AA@@@AA@@AA@@@AAA@@@AA@@A@@A@A@@BA@@@AA@@AA@@@@AA@A@@A@@A@@A@@A@AA@@@@@AA@@@AA@@A@@AA@@AB@@A@@@@@AB@@@@@@AA@@@@@AAA@A@@@AA@@A@@@@AA@A@AA@@@@AA@@@@A@@AA@@@@AA@@A@@@@AA@@A@AA@AA@@@AAAA@@@@@A@A@A@@@@@A@A@A@A@@AA@@AAA@@A@@@@@AB@@@BA@A@@@A@A@A@@@A@@@A@@@A@A@@@@@A@@@A@@@@@@@AA@@@A@@@@@AA@@@@AA@@@@AAA@@@@@@A@@@@@A@@@@BA@@AA@A@@@@@AA@@A@@A@A@A@A@A@A@C@A@@@@@@A@@AA@@@@@A@@@AA@@A@@@AA@@A@@@AA@@AA@@AA@AAA@@A@@AA@@@@A@@AA@@@CA@@@@AAA@@AA@AA@@AA@@@AA@AA@@A@@A@@A@A@@A@@AA@@AA@@@A@@@A@A@@@A@@AA@@@A@A@@@@AA@@@A@@AAAA@@@AA@AA@@A@@A@@@@@AAA@@@A@A@@AA@A@@@@@A@@@A@A@A@@@@@A@@@A@@@AA@@AAAACAAAA@@AACAAACCAAAAACAA@ECG@@CCACA@ILcC@D"],encodeOffsets:[[114781,40940]]}},{type:"Feature",id:"150124",properties:{name:"清水河县",cp:[111.647609,39.921095],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCC@ABABCBCDABC@C@@AAAC@A@A@CBCBEBC@C@A@ECCAAAAAA@CDC@CA@AEA@ACA@A@@AA@AC@ADCBABCBABABC@GBA@CBE@CAED@@ABEBCBCBEBE@CAC@C@AACAGCCEA@CAACAA@AA@ECA@GCCAAACAACCCA@C@CACAAAEAAAECAAC@C@C@CBABABABABC@C@AAC@C@C@EBC@CBCBCBE@CBEDCBIDKBGBC@GBA@CB@BCBEDCDAD@BCBCDCFCBCBCDEBCBEBEDGBG@CBCB@@EBEBGDABEBGDE@E@C@A@CBABC@AB@@YCEAACEECEE@E@A@C@CBC@CBEBCBEDEDEBABCBC@EBE@CAC@I@CAE@E@ABABABABCFABADCBE@C@C@C@A@G@EBCBCBC@ABC@EDGBEDEBC@E@E@C@C@EAG@@@A@ABAAEAEAEAGAICIEGCICGCGCKAGCIAG@EBC@@C@A@@@BKTILA@ABADAB@D@B@DDDB@D@D@BADAB@BAD@B@DBBBDB@BAD@BABIDCBG@ABEBCDEHABA@ABI@EB@@ABCBABA@CDABABADCH@BADCDCB@BGBQBA@EJANGDO@CJ@@@B@D@DBH@@@BCJ@@@@@@EJAB@F@B@B@BBDBBBBB@@BBDBBBDDD@@@@DDHHBDBB@BBD@BAD@BABADABAF@@@B@D@BBBBBBBDBB@DBDBHBFBHBD@DBDDDBBDBD@D@@@D@BABABAFAD@BA@@BAD@BBBBBBBD@B@FAD@FAD@BBDBFD@BBDBFAD@@@F@@@@ADABABADAB@D@D@BBDBBBF@D@B@B@H@DBDBDBFDHBDBDBDDFFF@BB@DBB@DBD@F@DBFDBB@B@D@D@DEF@BAB@BBBFFBD@B@D@FAFCDABAD@B@BBD@H@DADABCBIBCDCBCDAB@B@D@B@DAD@D@FAD@JAD@DADAD@B@F@F@FBDAB@F@BAJ@D@D@B@D@D@F@F@B@BADADBBBD@B@BABAB@BABA@@BABADAD@B@B@H@DAB@FAB@@AB@@CBEBA@EAA@A@ABA@CDOVADAHA@@DAFADCFCDABEFABAFABABABCDCD@BABABPJ@@JDHFDBFDD@B@BBF@B@BBDBB@B@@AB@@@B@B@BB@@@D@H@F@@@@BR@DBF@DHDB@DBBBAF@B@@@B@@BDBH@F@@BF@D@B@@BB@B@@@BAB@@CDAB@@BBDDBD@FD@HB@@BBDDDBB@B@D@D@B@HBF@B@D@B@B@@B@@B@@@B@@@B@B@@@BABAD@B@H@H@D@B@B@DBB@ND@@B@B@BC@@BAB@HBB@J@JBL@DAJ@JAH@DADAD@DAB@B@BABABAB@BAF@F@BBD@BBB@B@B@DADAFADADCB@@@@AA@@A@@@@DA@@@@@AA@@A@@BA@AB@B@B@BBFBFBDBB@JCD@BABABC@C@@@AA@@ACA@@@@@AB@@A@A@@AAA@@@C@AAAA@A@@@@@@D@@BB@BAB@BA@@@@@A@@AAA@@ABA@@HABADAFCBAD@B@\\CJAjIPAdE@HTBBJX@AWEgCE@AAACE@AAA@AB@B@@ABAB@@@@@@A@@@A@@@@@A@AB@@@D@@@B@BBD@@@BA@@B@B@@@B@BBB@BB@BB@B@D@B@@AF@DA@BB@@BBBB@BB@@BBD@B@@@B@@A@@BA@A@@B@B@D@DAJDB@D@B@BBBBB@B@BAD@@@B@DD@@BBF@D@B@BAB@B@B@BBDA@BB@BBB@@@DB@@DAD@B@BBBB@BB@@B@@@@B@@@BAB@BAB@BA@@BCB@@AB@B@B@DA@@B@DBB@B@@@BA@@@CBAFCB@BB@@B@@A@@BA@@DAB@@@DCB@BAB@DAB@B@B@B@D@B@DBB@@@D@BB@@BBB@B@@@B@B@FAD@@@@BB@@BB@@@B@@@@@BB@B@@B@B@B@@@@@@ABA@@BAD@@@@@B@@@@B@B@B@B@BBBD@F@DJRCJF@FTDRRDAfA@CDE@@@@B@DBHDD@¦An_ACMOdqAWFGAABAB@@ABC@C@ABCBA@@FC@@DABCFC@A@@AA@AB@@ABA@AB@@AB@@@B@BCBC@AB@BAB@D@FAFADADADABAFADABAB@@ABADADEBABA@@@A@A@@@A@@D@FAJADA@@JBDBBBB@F@FB@@B@B@HCB@BAB@BABC@@@AAE@C@@BC@A@AB@DC@@BB@@BBBFB@FDBBBBB@D@B@B@B@BAB@BA@AB@D@@CdDJKAACCACCCCCGICC@@CAAAAAAACCAE@AACAACEACACAACCACAE@A@AAA@AAC@AACAC@ACC@AAAACCCCCCACCEACAA@ECAAC@@AAACA@AAC@A@@CGAC@AA@AAAAA@A[ACAAAC@A@CAA@C@AACACAE@AA@AAIG@@@AAAAACCACCCCACEACACACCCAAGGACICCCCC@@ECCACCECAA@CECECAAC@@@CCEAEA@AAA@CAA@C@C@CAE@EBC@CBC@CBE@C@AB@AMAAAAAA@A@A@C@@AA@CBC@CBC@C@C@AAC@@AEAC@GAE@CBC@@@CBABCBA@ABCB@ACQ_ACACACA@@A@@CCCACAEAC@CACACCACCAECCACC@ACA@@ECACACBA@ABEBCDCDCFCDEBCDAAEAAAECCAACA@EDAFADABC@C@A@CCC@AAAAECEC@"],encodeOffsets:[[114617,40563]]}},{type:"Feature",id:"150125",properties:{name:"武川县",cp:[111.451303,41.096471],childNum:1},geometry:{type:"Polygon",coordinates:["@@KEAIHI@A@@CAA@AAAACA@A@ACA@AA@@@AA@@C@@AAA@@AAA@@@A@ABAACAAAA@AA@A@AA@C@AAA@AAC@A@CAA@GDA@C@@@CA@@@@@@CB@B@@@@C@C@A@AB@@A@E@A@A@A@C@AAA@@@A@A@E@CAAB@@KJC@@@@A@A@AAACACC@AA@A@A@@BA@@AGGCAC@A@ABC@ABABCBA@@@A@@@AD@@CBADAF@BADABAB@BCDA@@@A@A@A@A@@BBB@@BB@BBBBBJJ@@JJD@FHHDD@BBGDAFBHB@@B@FDB@JADDDAFBJSLC@CB@B@@FBFDDP@LFDBBH@B@BDABBDABADBLPNAFCFCBFH@FCB@BEBG@AHEBE@CFEBBL@BMBC@ABEA@GK@CE@EEAE@GCEBIBEBE@@ACA@AGE@@E@C@KCACAAA@IFE@CA@@CA@@IBKJAFIHALEH@B@HB@BHELHDBF@HCHMJ@F@HDD@DBFFDBBPFHAPPJNJBBDCDALAB@DFHBBBJA@GBAB@BBD@B@DVNDDD@HFDHAD@BILG@CBCHGNIXAD@HBFCD@DOD@HDF@DEF@BADFLBJAD@HABBD@DCPBJXLCJ@LA@CAE@@@E@GB_@C@AD@XAJKZABAF@@AFCHUXEBA@A@@@KEA@C@A@AA@BAAA@IDA@CAC@GDMDCBCDCDAF@FBBDFDDBD@BBBHHBB@BABEBEBCDA@ABBBFDFH@B@@ABABGBEHI@CB@BA@@@A@EBCDABAB@@GBA@ABE@EBABA@AD@@@@IFEHBJB@D@B@DBB@BBBBB@@@D@DADAF@B@B@D@B@@AB@D@@AB@BA@@BCB@BAB@@@B@@@BBBBBB@BDB@BDBDBBB@BB@@BB@ABBB@@DB@BB@@@@BBB@@@B@D@B@B@B@BB@D@B@B@B@@BB@A@@BEBCBAB@@@B@@B@B@@@D@@@DB@@@B@@@BCDA@CDIH@@@@@B@@@@B@@@B@DCFABA@@B@@@B@@BA@@B@@@BAB@BA@@B@BD@@@D@B@B@@@B@BABCB@@A@@B@@@B@@B@@AB@B@@B@@@@@FAFABAB@B@B@@AB@BA@@BBBAB@B@@@BA@A@@DAB@DCB@BAD@@@FC@@B@@AD@BBF@B@B@D@DAD@@@B@D@B@@@@A@A@@@A@@@AB@BAD@@AB@@@@@@B@B@B@D@@@BBBDBBDDBJ@BBB@@B@@AB@BBB@@@B@D@B@B@@BB@@@@D@BBB@@B@B@@@BB@HDDDB@B@B@D@B@@BD@HHPJ@@B@F@B@@@BB@DBDB@@BPFFDBBBB@AD@LAF@@DBB@B@B@BAB@DAB@@GDBB@BBBRDDBBBDFDBDBBBD@D@@@@@AA@A@@BAB@H@B@@BDB@BB@BBB@@@D@@AB@AACCCC@AHBB@BA@@@AA@B@BAB@B@BCB@P@BAFADAB@BBB@BBDD@@D@FABBDBBBF@H@JAB@BB@@@BBBBBDBHAH@D@F@DBF@DBBDB@B@DADCB@N@@A@@@A@A@CBE@@@@ACGGCC@AAA@ACG@ABABAD@FAD@JAD@H@L@L@@@JFDBF@D@D@DAF@JCB@AIAG@@@A@AB@B@H@F@D@FABADADEFKB@DAF@B@XGB@HAH@DAJAJCHC@@TIH@NPLTDHBRbA@KL@HCBABABAFELDDCFBPONCDGBCDCFGAY|OJCZC`CHPJ\\LEVCBAECFC`O@EDCB@TC\\@`SDAZQDAhSVIPIDEJAND@FHBNBFBBFCJBDONXVRNDDH@D@BBCH@DlJLFbJL]B@H@DBHDVPGFPVpAb@PFPFB@JDN@DA@@DBF@FBDBBDTLBA@@FAJCHANAFBFADDHJFDJBJBH@FABCBCDIB@DBF@D@HBH@FHLBXAB@HE@UFA@FJ@@EZA@HBDT@NSCE@@JAXC\\AAA@ADBP@LFTBHAVKDBF@@BBFN@@ED@H@JAB@B@B@@H@D@@F@NBL@AOFBLBACHA@@FI^DGPK@AAA@C@@BBFDBD@B@BAB@@B@@AB@@ZDD@BAD@DBB@BABCB@@AAG@C@GBA@AJK@CFB`FLBBA@A@@B@B@@@@AB@@@@@BCB@B@@@@AAAC@@@BAB@@@AAB@@A@AB@BABABADA@A@@B@B@@@@A@@@ABBB@B@@AB@B@@@@AA@@@BAB@@@@AA@@AB@B@BAB@@@FEBADCDAF@FCD@FCFBF@D@D@DCFBCDB@D@D@DBDAJAB@BA@ABAFABCDABABMACAAAG@EE@AAEGAEACACAABITFHCL@JJD@@AGCZaO[l½AECA@A@@YASHEEEGACKEAEBECCAESIC@KABIBGIEIDCDSEDGLABEEMEABEBGACBCAC@GGEIBAM@IBG]EQKI@@EIEC@KGE@@ABEE@@AA@@EE@CCGB@@EA@@AAAA@AECA@@@@AA@@A@@BA@@@A@@@CBA@@AAA@@@AA@@@A@AA@@@AAA@GACACCCACA@@C@@AEAC@A@@@@@@CBC@@B@B@D@@@FA@@BAB@@A@@AC@ADC@A@@@CBA@AFE@A@A@@@ACA@A@C@C@@AAA@AAC@EBA@AB@BC@IAA@CBA@CB@@A@@@AAA@CA@@@@@AB@@@FEBAB@B@BABABEB@B@DA@@BAB@@ABA@ABAB@DA@@@A@@A@GAC@A@EBC@KBG@A@@@AAAAA@A@C@A@ABKIE@IAA@OC@@AAA@wB]EE@G@AAA@IFA@CBGFKHKJGLADACIAEAEEKBMECEI@EECI@EAGBCAGCEIAE@EDG@QAEAKBIDEBEF@HGDGBE@IBIDKAG@GFGFGACIFEGICCCI@CCBADOBAAEK@CECEEE@CAI@GDGDICBAD@BAAACAG@ABCECCIGAACAIBMAC@CAE@CAQBK@ECODIBMPC@AFI@ECE@C@CB@DGACACB@BCBC@@@AB@DA@GAA@BDEFI@@AAAC@EBGGAAE@@AC@ACEAEEE@M@GAGFC@A@EDGAE@C@A@C@CB@@CFC@C@IHGBGHIDG@A@@DCFEHCFA@CBC@ABI@FGECAEECBEOSI@OAKD[DgEqL@@_UJaCCEQIAOAOAUIaGMKDYWUa@DEE@MDK@@@I@EAG@AAEDOAACACKAABE@IFCAIDGCC@IFC@ABABY@CCA@GIUEEBGHGACCEBGBGFA@ACC@K@MIGCW@ABE@@@"],encodeOffsets:[[113493,41804]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
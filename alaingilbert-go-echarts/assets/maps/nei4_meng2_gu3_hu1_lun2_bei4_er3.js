@@ -0,0 +1,5 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("呼伦贝尔",{type:"FeatureCollection",features:[{type:"Feature",id:"150702",properties:{name:"海拉尔区",cp:[119.736176,49.212188],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@SAC@C@GBABENABA@A@EACAKEEECEAAAC@E@AFC@@CCAAC@CCGCA@@AAAAAKCEC@AEEoU_MIAQAQCkIA@QCKA@@DB]RmÐĘl¤@B@@DfZJFXRJFJHBBB@FDD@F@@BBLAHADDH@NAVEL@FAJXEG\\`LRbKNEjjRPLJNPNrH`RlC@@DZxŦP±BA@@¼©BCJsLK¥r^ĠÄnD@VBF@@@EA@ADBB@AADAB@@BB@DCD@A@BAB@AAA@@AA@@AB@AAA@@@BAA@A@@@@@DA@@A@@A@AAA@AB@BB@@@@@A@@B@B@AAAA@@BA@@A@@AB@B@BBB@@AA@@AB@B@BAB@ABBBBA@@AABAA@A@@AB@B@DAC@@AD@A@@@B@B@AAB@B@@ABBB@@@@A@A@@C@AAB@B@@AC@@AA@@AB@BAAA@@@AB@@AA@A@@@@AAACB@A@@@@A@@@@AC@A@@B@AA@@B@@A@@ACB@A@@@@B@AAA@A@DA@@C@A@@A@@@ACAA@A@AAAABCCAD@BCD@@BD@@@DAAA@@@AAA@@@AD@@AB@@AA@AA@@B@@ABA@AC@AAAAC@A@BA@ABA@@@A@ABABA@AB@B@BAB@@BBB@@B@BBB@@C@@BABBD@B@B@BAAA@ABA@CB@DAB@BA@@BA@@@AB@@AA@A@@A@A@@@A@@@@A@@@@A@@BABAB@@@@@AA@@@AB@BB@@B@B@@@AA@@@@BAA@@A@@B@@AB@F@B@@AB@@AB@@A@@@A@@@AD@B@DBBA@CB@@ABB@A@@@AB@BA@@@AAAC@@@@A@@A@@AA@A@A@@@@@A@@@BAA@BA@@@A@ACB@@AA@@@@C@AB@@A@CA@AA@BAA@@@@ABAA@A@C@@A@@@AB@@@B@@A@@@@@AB@@@@A@@AAAA@@@A@ABA@AAA@@@AB@B@@@GI@A@ABANEB@B@@DADDBB@F@HDFBF@JBTDJDDFFDJDHDFBHBFBBBDD@DBBDBD@HEB@D@DAHB@DBBFBDBDAF@DADAB@BBABAB@BB@B@D@D@DDADBDDDD@D@DAD@DDDBHDBD@FB@F@DBDFFBFBJBDBF@F@DAHCDBHEFAD@F@D@BD@DCDEB@DDBF@FAF@N@F@HFACAAIISSUe@IBK@@KCYG]GKG]QkYsEGSUaUaW@@uBKUBYBiDaGs@YB]AOAE@EAUYCBYBMB_DAB¡LMBoDYD]GqOqQA@EBOCCGEAAAiWM_ImGUDAAA@GA@BGBGAI@AD@BAB@BFBFA@AD@DBDB@DCDC@G@AB@BDBB@ADABGBA@CCOD@@A@AB@DDBDBDDBB@F@HDDBBADABGDA@@BCDBD@D@FABEDAB@BDBDBBBF@DB@DB@@BB@@DCBEBAF@DFDFFBB@BABGBIAA@C@CBCB@B@BCHEB@BC@CCAAKESGCAIC"],encodeOffsets:[[122599,50354]]}},{type:"Feature",id:"150703",properties:{name:"扎赉诺尔区",cp:[117.670248,49.510375],childNum:1},geometry:{type:"Polygon",coordinates:["@@BCJIDCFEBAHAFAFABA@@@AB@@E@A@EAA@@@A@C@CCEA@A@@AAACCA@AAAAC@AA@EACAA@CA@@A@A@CAACCECGCEAEAE@CACAA@A@BA@A@ABARKFABCBADIBADCDCBABC§@@AANyJIB®FJÛx@@@@@@@@MDqJTIVABTXGP@@GXCBEHUVGH@@XTFÂZ²cDIHETING@@FBABJF@@@@IBMFSTKNNDT@H@H_hQXEJAJAB@JALIDBB@FBHCDAN@HAD@DA@@DC@@EWEQ@AAC@@@@@CAACCGGEEAA"],encodeOffsets:[[120574,50624]]}},{type:"Feature",id:"150721",properties:{name:"阿荣旗",cp:[123.459049,48.126584],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCA@CAAACAA@IE@@GEEIAAGIEE@@ECAAACCAGE@ACAAA@@EGCCCEKQECA@ACEA@AA@@ACACAAACCCCCAECSOEEA@YUCAQSECYWAA@@ACCAOOCAIIKIKGMKKIIGAAAAA@@AA@AAMGgU@AGCSIMCSECAMCMCKAOCAAA@CASCA@AAC@EACAA@AAA@A@CAAAC@AAC@CAA@C@CAC@AAC@CAA@C@CAA@CAC@AAG@CAA@AA@@A@C@AAC@AACAC@AAC@CAA@CAC@AAC@AAC@@@CAA@CAC@AAC@CAA@CAC@AAC@CAA@CAAAC@AACAA@CACAA@CAA@CAC@AAC@CAA@CAC@C@AAC@C@CAA@C@C@CAC@A@C@C@CBA@CAAAAAAAG@CAGCKCEC@@@@@@@@IBEACDO@GBGDE@CACACACBC@CAADAAAAI@CB@DCBGA@C@AEAGDC@ABE@CDA@@@EBCDGAI@G@EBIDDBG@CAEAIBIGO@EBCAEBABCDACAAC@C@CBADC@@@G@CBCBCFBBCBE@AB@BABC@A@ABB@EDGDQDKDA@@@GDEAKHIH@FABEAG@G@IBEAAAEBGBWBCDABAFCDEDBDCDGDGD@B@FEFABB@A@@@@@@@@B@@@@@BCBAA@@A@@B@B@@@@@@A@@@ABA@A@A@CAA@A@A@A@A@EFADA@@@@B@B@BAD@B@@@@@B@@@@B@@B@@@@@B@@@@B@@@@@@B@@C@@B@@A@@@@@@A@@@@@@@@@@A@@@@BA@@@@@@A@@@@@A@BA@@@A@@@@@A@@@@@@@AB@B@@B@@@@B@@B@@@@@AB@@@@@@@@@@@B@@B@@AB@@B@@@B@BA@@@@BB@@BA@@@BB@@@@@B@@@@A@ABA@@B@@@@A@@@@B@@BB@@@@AB@@B@A@@B@@@@A@@@@B@@@@A@@@@@@B@@@@@@EBC@@DBDH@ADDBD@@FAD@FABABDB@DDHD@BBCFBDDCBDABBBB@CDADEBIFCDCDBBABCBABF@ABAD@DED@DBBBBCB@@@@AFABCBGBAD@D@DAHCBEBB@ABEDEB@BBBEBC@@DAD@DAB@@CBGF@BABG@CBFB@BCBAB@DCDABABCBD@@BADCFAFEDBD@B@@ABABBB@D@BCBA@AB@FADBBCD@DALADBBBLABEBADDDBD@D@BDD@@BFABADBFF@ADDBBDCDDHAFCFEBGB@BCBBB@FCBBDADA@CDDJC@C@DD@FADA@C@@DFBAFGDIFCBAF@HADB@@@@B@@A@@@@AAB@@@@@@A@A@@@@BA@@@@@A@@@A@@@A@@B@@@AA@@@BB@@A@@@@@@@A@AB@@@@@@@B@@B@@@@@@B@@B@@@@@@B@@@B@@@@A@@@@@@@@@@@A@@@A@@B@@AB@@@@A@@B@A@@@@AB@AA@@B@@@@@@A@@@@@A@@@A@@@@BB@@@@@A@@@AAA@@B@@@@@@BBA@@@@BA@@@BB@@@B@@@@A@@BA@AB@@A@@@@B@@@@A@@@@@B@@BA@@@A@@@@@@@@@@B@@B@@@@B@@@B@@@BA@AB@@@@ABAA@@EDEBABC@@@@@@AA@@@@BABB@@@@@@@@@@BA@@@A@@AABA@A@A@@@@BB@@@@B@@A@@@A@A@A@@BB@@@@@@DABAB@@A@@@A@A@A@@@@B@@@@@B@B@@@BA@@@@@@B@@@@A@A@@@@@@@A@@@@@@@@@@@@@A@@@@@@BA@@@@@@B@@@@BB@B@@AB@BA@@@@@@@@@A@@@@@@@A@@B@@@@@B@@@@@@AB@@A@A@@@AB@@@@@@@@@@@@AB@@@@A@@@A@@B@@@@@@@@@@A@A@@@@BA@@@@@@@@@A@@B@@A@@B@@@B@@@@@@@@@B@@B@@@@B@@A@@@@B@@@B@@@@@BA@@@@@@B@@A@@B@@@@B@@@@@@BA@@B@@@@@@B@BB@@@@@@AB@@@B@B@@@BA@@B@@B@@@A@AB@@@@@B@@@B@@A@@B@@@@AB@B@@@B@@@@A@AB@@@@@@BBB@@@@@A@@@@B@@D@@B@@A@@@B@@@@BA@@@@@A@@@A@@@@BA@@@@@@@ABAA@@@BA@@@@@@@A@@@@@A@A@@@A@@BA@@@@AAB@@@@A@@@@@A@@@A@@@A@@@AB@@@@B@@@@BA@A@@@@BA@@@@@@BCB@@A@@@AB@@CDAB@B@B@BB@B@@@@@AB@@A@@@A@@@AB@@@@A@@@ABB@@B@@A@@BE@CBCB@@@B@@A@@BC@@@ABAB@@@BA@@BA@@@A@AB@@@@@@@B@@@@@@@B@@@BA@CB@@A@A@C@@@AAA@@BA@@@A@@@AAA@AA@@ABA@A@ABA@A@@@A@A@@@A@A@A@@AA@ABC@@@A@@B@@A@A@CBAB@@@B@@AB@@@BC@AB@@ABA@ABAB@BAB@F@@AB@@@BC@@B@B@BCDAD@@@@BB@B@@@B@BA@@BB@@@@BA@@@A@@@@B@@@@@@@@BB@@AB@@@@A@@@@B@@@B@@@@@@@B@@@@B@@BAF@B@B@@@B@@@B@@BB@@@@@BB@AB@@@B@@@@BBB@@@@BA@@@@@@B@BB@@@@BA@@@BB@@A@@B@@@@@B@@@BABA@@@BD@@@@@D@@BB@BB@@@@B@BB@@BB@BB@B@@@B@@@@@@@BB@@@B@BB@@@BBBB@BD@@@B@@@B@@BB@BAB@B@@@@AB@@@BB@@BAB@B@B@@BB@@@B@@A@@BA@@@@B@@@BA@BBDDB@BB@@BDBB@B@B@@A@@@@B@@@@@B@@@B@@BBBB@@@BAH@@@B@@@BAB@D@BABAB@@A@CB@@A@@BABAB@@A@A@A@ABA@ABA@A@A@@@AAG@ABABC@ABA@A@A@@AGCC@@@C@ABC@CBA@A@A@A@A@EBABABCBA@ABCB@@AB@@ABAD@BABA@@@A@C@A@A@EAC@ABC@A@@BA@@@AB@B@BAB@BB@@B@F@@ABC@CBA@@BAB@@@@@B@@BBABKFABA@@@CB@@@@@BABC@IBA@@BABA@ABA@@@A@A@GACAAAA@EG@@A@A@C@A@C@CB@@@BB@@@CDCD@B@@C@CD@@A@@BABAB@BA@A@C@A@A@A@C@A@CACAA@C@A@C@A@A@AB@@CBEBA@@D@@@BCB@BCBA@EBB@BD@B@B@B@D@@@BB@@@D@B@B@D@B@@@BB@B@@AB@BAB@@@BB@DB@@B@@B@B@@ABA@A@EBABCBA@@AA@AAC@CAA@A@A@A@A@ABA@AB@@@B@BA@EBC@EAABA@@@A@AAECA@CAA@AC@@@A@ACAAA@@AAIGA@@AA@A@AAC@A@A@CACAAAACA@CCA@EBABABA@@BA@CBA@A@A@EC@@A@CBA@C@C@CA@A@DEBAFGNOBUBMCIBIBMJGCGEMBCAGFG@IFCFEFI@MACACGGGCEACUDAFEHQBICC@GBCCWBGHKH@DI@CJGDQBCHEHGFICM@MAMECCCHIFIDIBOAE@ODID@BKBMFGFOBACKBCFAJKFGJETDFBDADFDDTAFFJIJKHCFAHAD@FGHQHBN@HMLCRE@QBEJHDFPADAJGDMFAHEHMJEF[AKEC@EAOFIAE@ODFH@HMJGLBBDJDHIDSDBFCHDFI@ELCPAJIFC@BDHDJAPBNDNB@AFCHCDEDAD@JAJ@F@FEDABBF@DABCBE@ABADABCBAF@D@HCPCDCD@HABCBCL@B@JDH@H@J@HC@@DC@A@CD@JBDAFCBAHDJBXDNDLFBBFDF@HCJKLDFAL@J@LBJHLBLAL@AJDHFBDDLBBDFDFDFAHAD@HFHDFBFABCN@D@FDFDD@FBPAD@DAD@DBDBF@HFFHD@F@DBHJBDFDFDD@ABCBCBABCBCBABAD@F@F@BAB@BDBHDB@@D@DB@DB@DBDD@B@@DABADGF@BBDADDFAFBBBB@BCDEBAACAC@CBABG@CBEDCDDBDDBDDFFFD@F@JBD@FBFDFDDBBABADAD@H@HDD@FADABBD@B@DCB@FAB@BAD@BBH@H@B@DCDABAHBFBDBAB@FBH@@CBADCB@BAD@BFDHBHBHAFCL@DAB@@BCLNN@BABCFADDBFBJDL@BBHBDBHBJ@DDLBFDDDCDDDFBFBJDFHNFB@ADEDEBGBAD@BDBFDFH@FDFJHBDJFFB@DDHDDTDF@DDHDDJHFFBD@BEBAHAH@FDDDJAH@FFBBHDBDABBBHDBB@DAD@FDBHFJDJBFDHJJDHBLJJDFDBBADBDBDABADDHB@F@H@JHF@BAFADCF@JBFBBDDDBB@DDBLFHHNBDB@DBBDB@DAFFFFFL@FCFAHAF@JBL@JDDBDDHBDBFDFFJEBAFCFAD@BABAB@@BBB@B@BBDFHBB@BB@FBBD@@B@@BA@BHFJCDBD@DDBHHB@DFLBJHD@DED@FFCF@F@FDDFDD@DA@@F@F@LBHBDBHRD@B@DDB@HBD@HBHHJ@F@FADAF@D@BD@FAF@BHDFBBFNPLHHBF@D@LDFDFBFBDBXL@BDBDAHCB@BD@BD@@DB@LBDDJDH@FBBDBBDDBFBBL@H@JAD@DBBB@BDBBBFDF@F@DCD@D@@@B@DCLAFBDCDCLEF@HBBADEFARA@M@A@CDABCDAJCBA@CFCDBDHLFFHFBD@B@@FJFHBFDD@HCH@HBH@NDREFCB@DABDJ@HDDBJCF@JBDALHJ@FC@ODMFMDC@@FCD@DCFAFE@AAC@GAE@G@ADADAAACA@A@@@A@CBAAADC@AB@B@HCBAFA@@BA@EB@B@F@B@DBDBD@BBB@BB@BB@@@B@B@B@@@@AB@D@BBB@B@@A@@BCDABAB@DDB@F@B@B@B@@@DADAB@@C@A@@BAB@B@FDB@B@BCBA@@@@@C@AAAAAA@AA@@AE@AB@BA@@@A@AA@@A@A@AAG@AA@ABA@AACAC@EAAAAABA@A@A@ABAAAACBA@ADA@ADABABCBALAFA@@@G@CBACGAEBAFABEDABADGDCHAFADABABCBADAFC@CDABC@CB@N@DAFAHGHEHAJDD@DDBBJDNFFF@DDBF@F@B@FDFAHCAEBCJGBCAEFEJAJADADBFBBDBDDB@BB@B@BBB@@B@@@B@B@@B@DD@@@B@B@BBBB@B@B@BAB@BA@ABAB@BAB@B@B@B@@A@AAAAA@A@ABAF@B@B@@AB@@AD@D@@A@@@C@@CAA@AA@@BC@A@@DABA@ABAB@@@B@@@@ABAB@F@@@@A@@@AFCBAFCBABBDBB@@@B@BABAFABAB@BBBBBBDBD@FBB@B@B@DA@BB@BB@@B@@@B@@A@@BEB@FC@AB@B@B@@@@@DA@@B@B@BB@@B@B@B@HDFDDBB@BAB@BABC@A@A@@AA@C@@@ABA@A@A@AB@B@B@BBD@FBD@B@B@D@D@B@DBBBB@BAF@B@BBB@B@D@@AD@FCB@DAJA@EAGBABAACAADE@CEC@ECMAGBC@ECCAEE@EACE@EBCDC@CAEAAAAB@@CDA@AD@@@@@@A@@EE@@@C@ABAF@F@D@H@FAB@F@B@BADAD@DC@ADAB@BEBABA@AAA@A@ABCDCBEBCB@BAF@D@FAF@H@DB@A@AFI@A@ABAF@BA@CCEB@@AFAFABA@A@A@AD@BADADCBAB@BA@@@ABAB@D@B@BABE@@BADCDABA@@BC@C@E@CA@CAGC@@A@A@CC@C@ADA@@BA@EAC@AAACECCCAC@AA@@@@AC@EAA@A@@DADCDCB@ACB@AAA@C@@AA@@@ACAAAABABA@@@A@AB@BABA@AAA@AA@AACBC@C@E@@AACAA@@@AAAA@EBA@@A@AAEA@AA@A@CCEAA@@@A@CBA@A@AB@BABAB@DA@@BABADA@@BA@A@A@@DA@AFA@@@AAI@A@A@@B@B@FA@@@G@@@AB@D@JC@@B@@AAAAA@A@ADADCB@AG@AFGJCB@BEBEBC@G@C@ABABAAACCAA@A@EAC@@FI@@BA@AF@B@@@BCBAB@BA@@B@FBB@BC@@BAD@HABAB@@AB@D@@AB@@A@@BADAD@D@FAD@DABABC@@BABA@@D@B@@AAA@A@C@AAA@C@ABADAF@@A@A@C@@@ABAB@@AB@@AB@@ABA@@@@FABA@AAED@FABCAE@AEE@@BADCHADCDABMNEF@BC@AFE@ABCD@DBH@FC@CD@DBH@DAHBF@FADECAACBCBAFABA@C@CCEAAEAGC@CFADCBCBE@@AICIB@B@B@ECAEACCACC@CCKDC@EACEAA@BE@EBYEE@AB@DCBAHCHCBAAG@C@CEC@EBEBCJ@JEHD@HDDP@J@LDJADHNHLDH@@@@A@ABCAMPKDK@ELCPDN@DITCLFVCNADANAHAFAPBFENBFEASPCBGDEF@FDDAFCDBBBFDHBJ@DCB@FBDGJEFCJ@B@B@HBVEFCJJFBHDJHDLLBL@@FJH\\]J@HAHNPNNAZCPIBGF@H@BEDAB@HDFMHAN@RBbGHDH@@BJBFEHCBFDBDDHCHBDF@F@FJBDAJ@PB@FH@RBD@BAB@DEF@@FDDZDfOFE@AAB@@@@A@@@A@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@AA@@@@AACCA@@AIGAAGEAAAAA@@@@@@@ECCAAAC@CAEEMEA@AAGEGCKEA@A@@AEA@@CAAACAEC@@A@AAA@AAA@@@IEEAAAAAA@AAEAECCCCACAAA@@A@CAAACAECCAIECACAECAAA@AAAAA@@@A@@@@AA@CAA@CCEACA@@AACAA@@AA@AAA@C@AACACACA@@CA@@AAA@AAA@CA@@CA@@CAC@CACAA@A@CACACAAAA@A@@AA@AACACCA@@AA@@@AAAACCA@@ACACCAACCA@AAAAA@GEAAAACACCCACAAA@@CA@AEACCCAAACAEA@@@AA@AAA@AAECECCC@@A@@AA@AA@@@@AA@@AAAAEA@AIGMGCCKGIGGEAAIG@@CCA@AAAAECCCCCA@@@EC@@CC@@AAECIICC@@A@EEAAGCCC@@EC@@@@CCCAAAAACACCAAQMIGGAAA@@C@@@EAA@EGA@@AAAAAA@CCA@AA@ACA@ACACC@@AA@@CA@A@@A@AAAAACAAA@KICCAA@@ECEC@@A@AA@@CA@@AACACAAACAAACAAA@@A@@@AA@@A@CCCACCA@AAAAA@@@@AA@@@@AA@A@@@A@@@UO@@GEGGEC@@A@KIECMICASKGCECA@AAKE"],encodeOffsets:[[126731,49363]]}},{type:"Feature",id:"150722",properties:{name:"莫力达瓦达斡尔族自治旗",cp:[124.519023,48.477728],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@BA@@@@BAA@B@@@@ABA@@@@@@AA@@@AB@@AB@@@B@BB@@BA@@B@@B@@@@@B@@@@@@B@@AB@@A@B@BB@@@B@@@@A@BB@@@@B@@@@@@@@@BBB@@B@B@@B@@@@A@@D@@B@BB@@@ABA@@@B@@@BB@@@B@@@@A@@@@@@B@@B@@B@B@@@@A@A@@@@BA@@@@BB@BA@@B@@@@BB@@@@@@@@B@@@BC@@@@@D@@BA@@@@@DBB@@AB@@B@@AB@@@@@@@@D@@@@BA@ABB@A@@B@@AA@@@AA@@BAB@@@@@@A@A@@BAB@@B@@B@@@B@@@@BABB@@A@AB@@@B@@A@@@A@@@@B@@BBB@B@A@@@BBDBB@@@B@@@@@@B@@@@@BBA@@B@@@@BB@@@A@A@A@A@@@@@AB@@@@B@@B@@A@@@@B@@@@A@@@@B@@B@@@@@@BB@@B@@B@B@A@@BB@B@BB@@@BA@@@AA@@A@@@@B@@DB@B@@AB@@ABA@@@AA@@@@@BA@AB@@@B@@@B@@B@@@ABE@@B@@@@@B@@AAABAAAB@@ABAB@@@@@@D@@B@@AB@@@@@@AB@@AAA@@@A@@@@@@BA@@@A@@@@B@@AB@@@@AA@@A@@@@B@@@B@@@@D@@@@B@@@@@@C@@@A@@@@@@B@@D@@@@@AB@@@@B@BA@@B@@D@@@@@@@BA@A@@@A@@B@@B@D@@@@@AB@@A@@@A@@B@@@B@B@@@@@@B@@A@@B@@B@@@B@@@@@@@@B@@@@@@@@@BBA@@@A@@B@@A@ABA@A@@@@B@@@BA@A@@@@B@@@@B@@@@B@@@B@@A@ABC@@A@@AAABA@@@A@@BB@@BA@@B@DA@@@@@@DBBB@@@BB@@ABA@@@AA@@@@@@@B@@BB@@A@AB@@A@A@A@A@@@A@@B@@C@@@@@@BB@@B@@ABCB@@AB@BBBBB@@@@AB@@@@@B@@AB@@@@BDDB@B@B@BCB@BB@B@@@@B@@@B@@A@@@A@@@@B@@@BB@@@@BA@EDEBAFA@@@@@A@@@AAA@A@@B@@@@@@BBBBB@@B@@@@@@AB@@@@B@@@B@@@@@@BA@@@@B@@@@@B@@@@@@@BA@A@A@@A@@@@A@@@AB@B@B@B@@@@AB@@AAA@@@AB@@@B@@F@@@@@CB@BA@@@DB@BA@@BA@EB@@@@ABB@@@B@@@B@@B@@A@@@AB@@@BAB@BC@@@@BB@@@@B@@@@BB@@BBBBA@@BC@ABB@@@@BFBBBBBDB@B@BB@BAB@@@BB@@@BB@B@B@B@@B@@A@@@AB@@A@@B@@@@@@BB@@B@@B@@@@CA@@@@ABB@@BB@B@DB@@@@AB@@@B@@@@D@B@B@DAB@BBB@DB@@D@B@@@B@B@@@@@@@AA@@@@@ADB@@@@@A@@@@@A@@B@B@B@@@@@@B@@@@@@B@@@@AB@@@@B@@B@B@BBB@@@@AA@@AA@B@@@BAB@@@@@@B@@@@@@@@@@B@B@@BDBBBD@BB@@BB@B@@B@BB@@@BC@@@@B@@B@BBB@@@BA@@@@A@@AF@BB@@@BB@@@@@@AB@@@DA@@B@F@@BB@@B@DAD@@@BA@ED@B@BDD@B@@BDB@BB@@BB@@@@AB@@A@@B@@@BBB@B@B@@@@@B@@@@BB@BAD@BAB@@@BAB@DA@AB@BABAB@BC@@D@@@B@@@D@B@@@B@BAB@@@B@@BB@@B@@ABBFBFBBBFF@@BBD@@@H@@@BBDB@BDFDDBHBFAF@H@J@@@@FD\\JDBBBFBF@BB@@ABBBDFHJFDBBB@@BBBBBDHHDDDB@D@D@F@DBB@@@B@B@B@B@FB@AB@F@NEF@BAD@BAD@BABAF@B@D@DABAFAD@DBB@BB@@B@BBB@D@@@D@BA@@B@D@B@FA@@L@@BB@BB@@H@BAHABAB@BAB@@@BAB@D@B@B@B@@@@@@@BAB@BAB@B@BA@@B@@@B@@@BAD@D@B@@B@@@BB@@@@@B@@@BBB@B@BBDA@@B@@@@B@@B@B@B@B@B@@@@@@B@@B@@A@@@@D@BBB@@@@@B@BB@@DBDB@BD@@@@B@@B@@@@B@@B@B@@@@@@BB@DAB@@@@@@@@@B@@A@@B@B@@@@@@@@A@@B@D@@@@@BB@@B@@@B@DBB@@B@@@@@@B@B@@@@@@BBA@@B@@@B@B@NHFBB@N@D@L@LB`DHBD@LBD@DBB@@@@BBB@B@@BJ@BAB@BBFA@@B@B@BADBDBBBDFDFB@BJDF@D@F@T@F@B@BBD@H@J@D@L@BBD@B@FBF@@@JBD@L@NAVBD@B@@@BBHFBDBB@BBDBD@BBD@B@B@DADAB@DCLAH@@@BB@DDDBBBBBBBDF@@BBBB@DBFBBBBFDB@DDDDB@@D@HA@@BABKDGBAB@@CBA@GFHJB@HLJL@@@@HDBBdjNPX\\BBLNB@BDBBDD`^jDHBB@@AbQTKDCB@@A@@@p@Z@h@@B@@@@@BB@@@@@BB@@@B@@@@B@@BA@BB@@AB@@@BA@@@BB@@A@@@@B@@BB@@@B@@AB@@BB@@@@A@@B@@A@@@@@@@@BA@@@B@@B@@@@CD@@ABA@@@ABA@A@@@ABBB@@A@A@CBAA@BA@A@A@@BB@@@AB@@@B@@@@A@@@@@A@A@@@B@@B@@@BA@AB@@A@A@@@A@A@@B@@@@BB@@@@@BA@@@A@@@@@@B@@@@A@@B@@A@A@@@@@A@AA@A@@A@@@@@A@@@A@@@A@@@@@@@@@A@@@@@A@@@A@@@@@AA@@AB@@@@@@HFFFDBFDDDDDLJBBFDBBJHFD@BNJBB@@NMDCBARQJIPO@@NODABAJKBABAHIFGDCFILMBABCDADA`SZOB@FCNIPIHDB@BBLDLDVJFBhPVHJFDBB@HBFDDBFBDB@C@EBEDCNIF@FAJCTGNGPIFGBEAEACEC@ABCDCBCDA@A@C@CCAAC@GAECC@CAA@A@ECCKGGECECECE@A@CD@FCLALCFADCBAACGKCCACAE@IBMBO@MBIBE@GAECCKIOOIICEDE@E@CAEEEECC@CBGD@HBHFHBHADEJMFKJQJUFSDE@G@ICEGAGAA@QDIBIBIFEFCBEBG@CFEFCDABAHCDAH@FBFDDHBJDDF@F@@@@C@C@EFEFGFCFELGHCFCFIBEFCHADAH@D@FDFBFBHAFADCACCCACCC@CDCFABECCICGCAAG@CAAA@CDAHCF@H@DADGBIBE@C@AAA@ABC@ABC@CA@ACIAG@KJEBC@C@AC@CFKFGJIBGBE@C@E@CBEDAFAF@L@DADEDCCECCE@I@EACC@A@EHCLGB@NIJIJEFC@AB@BA@AB@@@@@B@@AB@BABA@@FEDC@AB@@A@A@A@A@AAA@@@A@AAAAACAAAECCAAA@@AA@@A@@@@AA@@A@ABA@C@@@A@@BC@@@@@@BA@A@@@ABA@@@A@AAA@A@A@CAA@A@@@@@@@A@@@@@@@A@@@@@@@A@@@ABA@@@A@@@A@C@C@A@@@A@@AA@@AA@AAA@A@AAA@A@@BA@AB@@@BAB@@@DAB@@@B@@AB@B@B@BAB@DA@@BAB@B@@AB@@@@AB@@ABA@@@@@ABA@A@A@@BA@@AA@A@@@AAA@@AAA@@@AA@@A@@AA@@A@@@@@A@@@@@A@AB@@A@@B@@ADCBC@AAAA@E@GBI@EACAAC@@BCDAFADABCBACACAAA@ADG@ABCACCAOGCA@A@CDCFCFAFAAGAECCGCA@IEKKECCECCEEGICEGAGCEA@@AC@EBABAF@F@DAB@@ABABCAACCCAAA@C@ADADAJ@D@D@BA@@@C@ACAE@C@GAK@A@ECAACA@CDEDCF@DAD@HABAACBE@K@GAGAIAEECGEAC@CDEBGBC@A@IAAAAIEE@KAEBIBGBG@CAAACAGGCIEGGEGAIGCCAAAC@K@CBC@GCAGAKAI@G@GAEAECIIEGCEEAE@CBKBIDIDABMFOBIBMDODEDEFMDSFEFCDAFBFJHDH@FCFGBE@KCECK@M@G@EAGACAECGCGEIAMBIDE@MAK@GBKFOHQHSJMDKBKCSGC@KCMCMEKEECCMI[MaMIMGOAO@IAGEAG@EDEHIR[HGDKBKGEIEEEAE@GJKDGCCGCIKCGCMAIEGIMEEIGEGIAKEUWEEISGGAIAGCGEGAG@KDCHI@GCGCCAAIEO@]@G@GBE@@@CACC@CBADCDABABC@A@E@EAACAICG@EAAA@A@ABADADAFAPG@@BA@A@CBAAA@A@@AAAC@@CKCIGGECECECCEBGDE@EACECGAE@ID@@CBC@C@CACAA@AAEAC@KCC@GCCECIGMCI@E@GFSDIBAFIDCBC@EAC@AAAOIA@OGKIIEIGOIAG@IBC@A@ADEBGBKDEBEAEBKBGDGBC@A@CEAAA@C@E@C@AAK@@A@@A@@AAAA@A@A@AAC@C@@@A@C@AAAEEMIACAA@IBQ@CAMAEIEGCGECECACAACACAICIDCBC@AAE@ACAICGCEACC@C@CBCDABCBA@CAAAACAA@EDIDGBC@ECCCEIII@AGCQGIECC@CBEFCHCNAJCJEB@FCBCBC@C@@CECC@@AAECEACCCEAE@EBIBGBE@C@EBABCBA@IBE@@DIDCLEDCDABC@AACAACAQCMEGCIEGCEAE@E@CCC@CA@C@A@ABEFEDEDCBADAFCBABABABE@EBCAECIAGAG@A@CBEDEDC@EACACCACCCC@GBEFEHAVEHEDCBADCBC@G@C@GCECCCCAC@CBCDEDEDADADAJAFCDADCFMBEFAHCDCHCBC@EACCCEAKAA@AAECAA@A@CBCDE@A@CA@EAG@AAA@@CAC@CDCDAF@J@D@BAHAFCDCDC@C@A@A@ECACCA@OEECAC@C@CDCFC@CBAACACGCCAGAA@AAACACBABABABC@AAACACCCAEA@AAABE@AACGCICKEECMGAAAC@A@ABABADCD@@C@@BA@A@AAAAAEAM@CAAACAAA@A@@BABAHAFADAB@J@DABA@@BA@A@CA@AAAABC@ABAB@@A@AAACAE@CACAGECCGCECAAAC@CBCACAACCCAC@CBC@IDCBE@C@CACACAAC@A@ABCBABC@AACCAA@CACAA@AA@A@AAA@C@A@CCAAAC@CAC@EBC@EBC@GAEAE@CAAAAABCBC@AACCACAE@EBGDC@E@E@DDBDBDBDBD@D@D@D@@BDBBB@DBH@B@DBB@DB@BBBBB@B@BA@CBEFCBAB@B@D@BBBB@BBDBD@D@D@H@D@D@BBFBJBLBHBD@BBB@BBBBBD@B@B@D@BABC@ABCBCBABAD@FADAB@DBBBDDBDBD@F@BBDBBBBB@B@B@DABAB@DA@CBABA@A@AAAAA@A@AB@@@@@BB@BB@B@@@B@@BB@@A@A@A@ABA@@BAAC@@A@@@@B@AA@@@@BAB@AA@@@AA@A@CA@@A@@AA@A@A@ABA@AB@B@BBBBB@BA@ABA@A@@@C@AAA@@@ACACA@A@AAABA@ABA@@BA@@BA@A@A@A@AA@@A@AB@@AB@BABA@A@CAC@@@ABAB@BBBBB@@BBAB@@CB@BA@@B@@BB@@B@@@@@B@AB@@A@@@A@@@A@@A@@@A@@AA@@AAA@ABA@A@AB@B@@@@@BB@B@BB@@@@@B@@AB@@@BB@@@@@DBB@B@DABB@@B@@B@@@B@@@BA@A@ABA@AB@@@B@@@BBB@@A@@B@@A@@B@@AB@@BB@@BBB@DBB@@B@B@B@@CBABCBABABBB@BBBD@BBDAF@F@B@@@B@BB@B@B@@ABC@ABA@@B@@BBB@D@BB@@@B@@A@AB@B@BBB@@@BA@@BC@A@A@AAA@A@@B@B@@DDB@@B@BAB@BBB@BABABA@A@A@AAAAACAAA@A@A@AB@BBBDD@B@BA@ABC@AAC@A@ABABAB@BB@@B@B@@@B@BA@ABA@AB@BBB@BD@B@BBBB@BA@ABA@A@@B@BA@CDCB@@A@A@A@@@A@@D@@A@@@A@A@A@@@A@CBABEBEBCB@@ABCDCBEBC@ABABBBBBBD@BABABA@AAAAA@@A@AAAAAA@A@A@A@AB@@@DBBBD@B@@D@DBDB@B@DC@CBCBCBABADAD@DBDBBBDBB@B@BCBA@C@C@E@EBEDAB@BAD@@BBBBB@BBB@B@BB@@@B@@BD@BAB@BA@@BA@A@A@@AC@@@A@A@CA@BC@ADCBABA@ABABAB@B@B@B@BABCBEBABAB@B@B@BBBFBFBBB@B@B@BABC@ABCBADCDABABB@BB@@@BABC@@B@BB@@@B@BA@@B@@@B@@B@B@B@BDBBBDBDBB@B@@@DA@ABBBBB@@BA@ABA@A@CBABA@A@@AA@A@A@@@@BA@@@B@BB@@B@B@B@@@BB@B@B@B@@AB@@AB@BCFABA@C@A@C@AAC@A@CBCDABABBB@BD@FBDBBBFB@BAF@@ABCBCBAB@B@BBBBBBDBDDBD@D@FADADAB@BB@DAFABBFDDBBBD@BCFCBIFKDCB@B@B@B@BAF@B@B@B@BABA@E@GBCBAF@@BB@D@@@@BB@B@BAB@@@FAF@DBBB@FBH@DBBBBBBDADADEBI@CD@B@DFDFD@DB@@BBDBBBD@BADCDCBCDCD@DADADEFC@GBGB@@EBIBCBGHKHEDC@A@@BEFePYCCC@EE@CFA@ABC@QAG@@EOAI@CBIA@E@ECEGAGDCCCAAEGDEFIA@AG@GCaHQAM@GBENGCA@CBAFG@E@AHOJYDMBOMGMGBI@[^IG@EK@KACKIGGCEAIIEDUFGAA@A@I@EDIFCHEAA@CDI@GAECAACAEDCBECE@CFAHODBTEFMAEFOAEBGBMBCBMBUDKESDCJM@OCKD@FCLOLBNAD@B@B@@G@KCMGCGIBKCI@O@CC@GGCIFI@ADAF@FFD@D@DBHABGDGDABCDA@@BFFAZ@FAFB@FBBD@FCDDL@DDDDBBDBFFDA@A@A@DJBJ@@AFADCDEB@DHDFBBBDF@D@DABEBABADBDDBCFEBE@GACBG@CAC@@DEDG@CAC@AD@BEF@BADE@MFANCBCDGBCDAB@@FF@BBFADEBC@BF@BABEB@@@@AB@BA@@BA@@BA@AB@B@@@D@B@BE@CBAB@B@DBB@B@D@BBB@BA@C@@@ABAB@@ADABCBC@EBC@C@CBAB@@@BA@@BC@A@@BA@ABGBC@AB@@ADA@EAA@@@ABA@ABAD@@A@E@@BAB@@EJ@@BD@F@BBBDDBBABAB@B@D@HADAFAFA@IDEH@BBHA@CDCB@B@BBBBB@BA@@@IDC@A@@B@@@H@@EBA@A@@@@B@BBJ@B@@EB@BCB@@@B@BAB@@CBABAB@@CBA@ABABA@@B@BAB@D@B@@BBDF@D@BBBB@BF@B@BB@FAB@BB@B@@BBBD@BF@D@D@DABBB@@BBB@BABABA@@B@B@@ABABBBBBBD@@B@@BD@B@BBA@BDA@CDCDCB@@@BBB@FBD@@@@BBD@DBDDDFBB@BBD@FAB@@CB@B@DDDB@B@@@HDDBB@@D@F@DAD@@ABCBCDAB@@AFABA@C@A@AB@B@@ABA@ABCDCBABC@@B@B@BABEBEB@BA@DF@DABE@AB@B@BEJ@B@BCAG@E@EBC@E@ABA@ADAFCDAD@B@BBB@BABABAFA@CB@BCDC@CBABA@E@A@EBG@C@E@E@AB@B@D@@FF@@@B@@@@C@@BCB@DA@BBBBBF@DCDAD@FDFFBF@BFDD@FADBHDN@FFD@DCFBBBDABABBH@FD@@AD@BAB@BADAB@DAD@BABADCFCDAJG@@BA@AB@BAB@B@DADABAD@DA@@D@@AF@@@BAB@B@@A@@FAB@B@@@FANEDAD@BA@@B@@AD@B@LAD@BA@@BA@@B@B@@B@@B@@@BAB@@@@@B@@@B@@@@@B@@@@@@AB@@@B@@@B@B@@@B@@@BA@@@@@BB@@@BB@@BA@@B@@AB@@@B@@B@AB@@@@@B@B@@A@@@@@@D@B@@@@BBA@B@@B@A@@@B@@@@@@B@@B@@@@@@@@@B@@@B@@@@@@@@@@@@@B@BB@A@@@@B@B@@@@@@@@@B@@@@@@@@AB@@@@@@@@@B@@@@A@@@@B@@@D@@@@@@@@@BABB@ABB@A@@@@@@B@@@@@@@@@B@@A@@@@@@@AB@B@@@@A@@B@@@@B@@D@B@B@B@@@B@@@@@@@B@B@@@@@B@@@B@B@@@@@B@B@@@@@@@@@B@@@BA@@A@@@@@B@B@@@B@@@@@D@@@@@@@@@B@@@@@@@@@B@@@@ABB@@B@@BB@@A@@@A@@B@@BB@@@@@BA@@BB@@@@B@B@@BB@@AB@B@BAFCBCBAJCF@JANABABHBDBHBDDBDBFFDBH@HA@@DLADJH@BA@@DBD@@CDCB@B@DB@@DBBFBDB@BFBDD@B@DBBBD@BRD@@BA@@B@VJHDBB@FFBBB@@ABABA@GDA@@D@BCDAD@BAB@B@@BDDDDBD@BB@B@@ABCB@B@@BBBBD@BB@@A@CB@B@B@@CBA@AD@B@B@@ABEBABA@@B@BDDBDAD@@DDDBBD@DCF@B@@BBB@D@B@B@@BDBBBHBTBD@DBB@DBBAHCF@D@XDBBFDDBFBBB@@B@BABAB@BBFBD@B@HJBBB@@BBBB@DBF@@@B@@@@@@F@BAB@@@BDAD@BBBAB@B@B@B@@@BDBDBBB@BB@BBB@@B@BBB@ABA@@B@B@@BBFBBB@@@B@B@@@@@B@B@B@@AB@@A@@B@@A@BBBD@DBD@BA@@B@@ABBB@@@@FF@@@@@BABA@@B@BBB@@BD@@@@CDA@@BABAD@B@B@B@BAB@BA@@@ADABGDA@CDAD@BABADAB@@@@@B@@DD@@@B@B@@@BBB@@@@@@@@BBB@AB@B@@@@BB@BDB@BB@@B@@@BBBB@@BBB@@@BA@@BBB@@@@@B@@@B@@@@BBBB@B@@B@@@@@A@@B@@B@@@@B@@B@B@@@@@AB@@B@B@@@@B@@@BB@BBB@@@@@B@@@@B@@@@B@B@@@F@@B@@D@@@@BB@BBB@@@B@@B@@@@B@@@B@@@B@B@@@B@B@@BB@F@BA@B@@B@@@BA@@@@B@BA@@B@@@@AB@B@@@B@@@B@B@@@B@@@B@B@BAB@B@@B@@BA@@BBB@B@@@B@BA@@@B@@@@B@@@B@@AB@B@@BB@B@@@@@@BB@@@B@@AB@@@B@@BB@@@DB@@B@@AB@@@@B@@B@@@@B@@@@DA@@@BB@BB@@@B@@@@@BB@B@BB@@B@B@@@B@A@@BB@@@B@@@@BB@@@@BBBB@BAB@B@B@@@BBB@BB@@BB@@@B@@B@@@@@@@@B@@B@@@@BA@@@B@@@@@AB@@B@@B@@A@@@BBA@@B@@BBB@@BB@@@BBB@@@@@@B@@@B@B@@@@@@@B@@@BA@A@@@@@@@DB@A@@BB@@B@BBB@@A@@@@B@AB@@B@@@B@@@@@@B@@B@@@@@@@@B@@B@@@@@B@@@B@@@B@@@B@@@@@B@@B@@B@@@@@@@BB@@@@D@@@@@@@@@BB@@@@@@B@B@B@B@@BB@@@B@@@AB@@@@@@B@@@@@@BBB@@B@B@B@BA@BB@@@@@@@@BB@@@BBBBB@@A@@@@B@@@B@@@@@@@BAB@@@@AB@D@@A@@B@@B@@B@B@@@@@@@B@@@B@@@@A@@BA@@@@BA@@@@@@@B@@D@B@@@D@@@B@@@@A@@@@B@D@@@B@@@AA@@A@@@B@@A@@B@@B@@@@@@@A@@@@B@@A@@B@@B@@@@B@AA@@@@@@@@B@B@@B@@@@BA@@BA@@@AB@BB@@BA@@@@A@@@@AB@@@@@B@@@@@@@@@@@BA@@B@@B@@BBB@@@@@@A@@B@@A@@B@D@B@@@BABB@@BA@@B@B@@@@A@B@@@@B@@@D@@@@@B@@@B@B@BBBA@B@@@@BBB@@BB@B@B@B@B@B@BBFBD@@@@B@BBB@@BBBABBD@@@BB@ABB@A@AB@@ABBB@B@BABB@@BB@@@BD@@@D@@@BB@@B@D@BA@@B@B@B@@B@BB@BB@@@@@@BB@@B@@@@BB@B@BAB@B@@BB@@BBABB@AB@B@B@B@@AB@AAB@B@@@D@@AD@F@BD@@B@@@BBB@@@B@@D@BB@B@@BB@D@B@@BB@B@@@B@FBBBB@D@AAB@B@B@F@@@@BB@@@B@B@@ADBB@AA@@BAD@B@B@BA@BB@@A@BD@@@@AD@@@BBB@B@A@BBB@B@@@B@B@B@B@@@BB@@B@@AD@AB@@BB@AD@@BB@B@DBBABBB@@BDABB@@BBB@@@B@B@@@B@B@AAB@DA@@B@BB@@B@@AB@@AB@@@BC@@@AB@B@@@BBBA@BB@B@B@@@B@BB@A@@B@@AB@@BB@B@@ABBB@@ABB@@B@D@@@B@AAB@@AB@B@@@BBB@B@@ABA@@D@B@BBBA@@@@@AB@BBD@D@DAB@@ABABAB@@A@@B@@AA@B@BA@BD@@AB@@A@A@ABB@@BBB@BB@@B@@@B@D@ABB@@@@AB@@@B@B@@ABBB@@@B@@@B@B@@@@BB@@@@@@BBA@@D@@@@@BB@@@@@@A@@BB@@BB@@@@@@@D@@B@@BAB@@BBAB@B@@@BB@@@@BA@@BB@@@BBA@B@@@@D@B@@B@@A@@BB@@@BB@@@BB@B@B@@B@A@@B@@@B@@BBBDBA@@@@B@@ABB@@BB@@B@@BDABB@B@@@B@@@@BB@@@AB@@AB@BB@B@B@@BBB@@@BA@@@AB@@ABB@BB@@@B"],encodeOffsets:[[127322,50531]]}},{type:"Feature",id:"150724",properties:{name:"鄂温克族自治旗",cp:[119.755239,49.146592],childNum:1},geometry:{type:"Polygon",coordinates:["@@EFI@KAKBEDGAC@AFGDGF@HABCDGRODKFC@WWCACGC@K@GC@EAAIEAEAE@C@@EBMAEA@BBBBBDPDDBBBB@D@DA@ADCBI@IDCBCBCBAB@@@DEJEHGFQFILADE@GBAACBBBGBAAI@ODIB[DABBD@DB@BBC@@DD@@BGHDB@BCAADEBCDBBCB@DADDDCDFBCD@FEHAFAHIDBFE@CDCHBA@DC@AFFDA@A@CHDHGD@JGF@AMHCDBDBBCHFLJJCBBBAB@BIDC@KDIH@DA@BDA@@DB@ABBDA@C@CLD@GF@DCB@HE@ADKH@BEBGHOFEHFBELEBEDDFB@@BEDCBABBDAB@@BD@@@FCA@DDB@FCBABIJGNEDCJC@@DADBB@BC@CBDDA@CBA@AF@BEDDFGDEJEBBDADC@GBF@ED@BGDB@CBABABA@EBKJCFBBABBBBBB@@BA@@BCBC@BBA@BBEAAFC@ABDFCFBDGD@JA@@BB@@DB@ABC@CBGFADDBCB@DD@@DFB@DBDABAFBDGB@DD@@DCACBDD@BEB@DG@CDCHBFGFBBC@AB@D@DA@AD@B@@AFFDCB@DEDCF@FBDEBHDAFD@BCBDD@B@CDB@CB@DBDEDAACBEBABB@MBKA@B@@@DCACACBFBC@CACAA@EBKACD@CE@@A@@CAA@A@@BB@ABCCMAGDBFCBBACAC@B@@AECEBCCCFCAAAFAAAAA@AB@AAAAEDCACBAACBADCCCB@FAAADD@DDCBDBABCACBFBGBDBADE@ECEAC@BDAFDDEDECGAAAEA@FC@ADA@GHCACCFCAAOBIADF@DGADAAAEACA@AGAADDFKA@AD@BE@CKAADAHECDGACCAEFDFE@AAIFABCABABCEBBAE@ACAB@BA@AAAB@DD@DFEBCCCFC@@BA@AAABC@CDEABAIGDA@@E@ACADI@GDDDEBD@CBGAAFABCEKGC@CACDDBO@AHO@C@@CQEGCGAGFC@ADABEBAACDMDACAEG@ECCDC@E@GBC@FDC@A@CACAEA@CG@BFCCEBCBBDGADAEACAEHD@@DC@AEBACAABBBMDGCGAKB@AABBDCB@CG@BDE@EAADB@ABCBIBFDEBAGCB@DA@ACCB@DCA@CDAGCBDCA@CC@EDEGKFOBK@FDED@CE@BEEAEDC@ABDDE@BBE@BDIACB@BC@AAABE@KAGGMBHDIACBHHGACHED@HQEGBAECB@DBDIEEDEF@GEBBBADCACA@D@BHAAD@HA@CEAACBBBC@C@CA@DD@CBABCB@BH@@BC@@DC@@AA@@BACCAE@AHCB@CEBDDCBDBGBCAEGG@BBEBAAC@CBCF@@MDID@JMAJDAFEC@FA@AAAAC@ABDBABAAEACBFB@BD@DFCBCAC@@AA@CCKHBDGDDDABAAE@AAG@ABDBEBFDCDEEA@@DQAEAG@EBGHADBFD@EHIBABCACCFAAAMACBDFADC@ABEAEBCAEB@CE@@EAAA@C@A@@F@BE@AACAADCAADFB@BEAABDBEBFBCB@DFBBBG@DDCBC@@BACCBDDBBA@CACBFBFDD@@BCBD@CDAAGDEAADFDABCA@AADBBEB@DD@@AH@@BCBI@@BEA@DFBADD@BBAB@BA@@AC@AAABDDABBF@DC@@BDBIBA@BDEB@DHABBEBCDJA@BGBABHBCDDBCB@BD@DBABHDG@JBADEAABF@@BEBDBB@@BG@DFGB@HE@BBECB@AAABE@@BEAEDA@BBBDE@AD@@ABA@BD@D@@BBABABC@BBCBEDEA@@GA@AC@CB@DDBBABB@BA@GACB@BA@BBF@B@BDD@ABCBAA@ACACDBFBBD@B@EDC@BAAAC@@BA@AABAC@CAAD@DKAADDDABD@BBCBC@C@BBABA@@ECACDD@AFC@C@GBBBA@CADA@AC@CB@BILFB@BE@C@HFCBCCCBHDED@CEAIB@FCAACCB@BEAC@DFAFCNEAABJBADI@EA@BA@{RCBA@CBD@ABCAABAAAB@BCBCAAB@B@B@ACBC@A@C@C@C@GAMBABA@A@EAABC@@AC@@@AAABEB@AABOBB@ABBHC@EDBJA@@B@FEBCBI@CBG@ABFBEBBBABECACIBGB@FECFEG@CFC@DFOABDH@@@KDBBFA@DI@BBD@@B@BGAABDBCFECADH@EDAAG@@DC@@DF@ABA@GF@CE@DBADCACBFBCBCAABDBG@HDC@C@@B@DEC@A@@CBBBEAGBBBD@@BABIBEFCAEBDBE@DDC@DBB@@BE@ABBBABAAGDABBD@DGA@BCBCB@BAAIBURIFGD@FEHAFAAE@ADGCBFK@AFADGDDBGD@B@@@@A@A@CFABDBCDAFEB@BEDA@@BEHDBGDFFABD@CBADF@JJBFG@DDC@DBBDDBFAEDJ@CD@DDDEBFBFBD@BBD@AFH@FFABDHCD@DE@CBCDC@BFCBDDCBLBFDJDFDF@@DFBHAFBADF@EHFFE@FBADJBAD@DECKJGD@FMD@DE@CDI@IHEDFHIACD@@BDKDCDBDF@GFCFIDDJCDEDBFFB@FBHEDABC@C@FDEFCD@DEBBHCHEHIFEAIAEBAB@@GDAAA@@DC@BDBBE@@DE@ABABABCBE@ABCDCB@DFDJ@N@FCDDEBABDBAD@BABABHBHDBDAFC@AFBDCACB@DA@BDDBBDC@GBAF@DFDE@@HFLNJIB@LBBGBEF@JIDIFGBE@AECAG@EDF@@HEDBBABF@BDJDFDABF@AAFBCJDABDABBDFABD@@JDDFADFBJDDABDBDF@DBDDHHNBDDF@FABDJDBADBBD@BQN@@|fn^NH|dÎ`~NZŚªĮÒôZLlP°~¨\\@@LBRDB@lJRDRBJB`NpVFF@BFDLDBBBB@BB@HDDDD@BBDD@@ED@B@FBDBBDFFFLFDBFBB@B@BAFMBAHAD@D@TBD@JDDBTHLFBBDDD@@AFADG@A@ADADAD@B@JBHABA@AAAEEEC@CBEFADA@CA@@AA@@CCAE@AACACA@ABAFCBA@E@CACDC@AB@HCBABCAACC@G@EAACCCACA@CBAB@@@PCDDB@HABABCA@CA@ABAH@D@DC@CCACAC@@BEBEA@ABA@ABCJ@HBHA@AHBB@BBVCnH`JXNjBBFBDHPDFAB@rRrP^HZCpCNA¢KBA`CNAZADAZVFBF@PB^BZAt@bHjCZAVALvA@@bXbVTV HtFlZ^RLH^HZHLD@@AL@JVfTTJJBBBD@@@E@ATELABKBIDE@ADGAAA@GEIC@CG@A@CE@@HELCHA@CHCDABAMGAACA@CBEDIEQIECADIBIJA@ABE@CHKCMFCFCHCNCJ@NDDCDCLE@GLEDEDCBEHGLCH@DCHEBEEGACMC@EHENIJABKGEKEAEBEHAP@JCJAHBPALAJCDGL@@A@EJIAAE@ICCCBEIAG@AQDAD@FGHEDEFALAAGDGDEDCOGACNE@KVCJEDA@IECMMAAEEEI@IECBAJIHG\\ATD@E@AB@FIPDJ@HGNBFILGBA@@BKDKJARGFGHAPDAEAIIGCEBG@ADEGGBGCGGE@CGCDEDC@CECFAHEBDNEJDFCRCF@@EDEL@OKECEA@IBAECBCIEKGCCIAIEMAEECEACAKECGCQAOAJEHENCPINCRMH@NBJCHCJGBICI@CLIDCBEJCJAVCPBD@FABE@ECE@KEK@E@EEGEEIMEEMCIG@C@@@A@@@AFKHA@CEKKIGALCNMNCD@@ICKCIACAIAE@GFEE@MCCAEKEEIIGGDCBKCI@GFEJI@ICEHMFAAGCGCI@C@IDCCIEICCJETEACCEGG@A@G@CBAPC@INIAC@GDCXM@GEGGCECBIEC@GFGCAAGIG@ENDXCPDDIAIDE@GNBHDLERIJGPAPDPIDEJDRBNLLDNLJFJALCFABDPBPBJCFAF@HCPEPALBR@@CRCH@LEJ@NCJDNAPFBDDHLJB@B@HMHE@EDKLMHIAIGCEMICJKRYTIEG@@FGDG@@N@JEBMCAIA]MMOFGAKEEAK@EQAOIEGAAM@IJIAKCKCOOOAOO]ISFQCDGCKEGDKEKMG@CFE@GKCGCFOBGHAEOGEAIDG@IFC@IAEFGTA@G@ADIR@JGAGCIEGJGDEGBGAECCEDCAA@@CEMAKCECEAICQAECEG@IACDCA@GDQBUCO@EDM@IGOGMACEICDGB@DCEGI@GEIEAIEGBEGCKACIQCKNKAQDIBGFCDEBGAEBM@IAIEI@E@EEGCAEE@FEDEAEHE@CCEKGIQAABIJCJEAEAECEGAEEAEAG@ELGHC@I@EFCJDHBP@JGBGAGDEPANCFGAEEACGICCEGEIAEHEBMB@@EACALA@C@IHEJE@CDC@EDGAEHKFEHCB@@C@EDEBABGFELCJ@JBHAHDNAJALFR@NFHCfKBC@AGG@GEE@AACGCBEBGHA@AMEEGCIICAEDCEGGACIE@@GAAGMMOUMKC@CDEBECCACSACACEECIECABGEEGE@GCESIE@AEEGEAGEA@GDCDIBGFOBKBK@GAMEK@ECCGGB]BW@MBKHIHCDGACEICAEWICEECMBGCKDI@CAIAGC@MFECMAGAK@ACGDKRABEJCGGEGDEPCDEGCCC@MEEAC@IFOBCEA@CDGFCCC@GPCLGRCFA@@B@CAAAAA@@CA@A@CECCCE@A@A@AEAA@@CCAA@CHABA@@@A@@@CA@@AAACCA@@A@CA@@@CAAAAAC@A@@@@@C@IDC@ABE@C@EB@@G@E@E@A@A@IBM@GDABGJCBA@EFEBG@ICEAGECG@@ACC@GAEAAAA@C@CAAAIGIAQICC@AEAECEAC@C@A@A@AACC@@AA@Q@GBA@A@A@EBE@A@A@A@@DC@@@@@A@@@@@@AAMICCGICA@@ICKECCÃl·heFBF@E@IAEFMBQEA@AHCFADBFABJBDBDFCDAD"],encodeOffsets:[[123213,48768]]}},{type:"Feature",id:"150723",properties:{name:"鄂伦春自治旗",cp:[123.726201,50.591842],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@RD\\DPAhEZAZBPBT@TDXDFD@P@JNDNFTBT@xNN@XABNC^@DJJRDNFHL@V@JLJHFJHLLHHDFNBRCLINKRIDC^OZGNETGR@RDNDRHN@PARMLITWDGhgDAZM^MRKLPPJ`F^D`HRB|@hCh@R@NDHHJXFFRDRBRBXDPCFEFAXDHDJBJEPEXCLBRJTJNBN@NENEncHCHKDQBCXE\\MFALALMV@PDJBFBB@BAAGDMBIFGFAD@`PFAlGTEN@PPNXBFPN@D@JCdCLHXBFLPNHZF\\@PBJHN^VBD@\\CVI\\A`DVEPCZMPMJOJGDCJGZUDARAFBJHLBjBjAH@hPH@JFPFXLTJBBFLLhBFDBPFXDRAXATAfGZERCVAXHRJ@DAVBNHJXVJJHDRFRBPHJHFLETENGJQFMDCLJDJF@NDJDDpNB@lDRBJFFJHNNLDBhE\\DTBPJHH\\^BRAHCJCPQfBFDLHLJH@RDLJFHFJHJNNXTFXAPCBK@G@WBCdA^FJDR@UFFJNLDRAnQPBHJVBNEdgRIHFLJ\\HZDRKRGDEBA@A@@BA@A@@B@@ABC@@B@@@@AB@@@B@@A@@@@@A@A@@@AA@@A@@@C@A@A@A@@@A@@BA@@@A@@BA@C@A@@BAB@@AB@B@@AB@BA@@B@BAB@@@@@@@@@@A@@@@@@B@@@@A@@B@@@@@B@@@BA@@B@@AB@@@B@@@@@@@B@@@@@B@@@@@@@@@@@@A@@@@@@@@B@@@@@@@BA@@@@B@@@B@@AB@@@@@@@B@@@@@@@@@@@B@@A@@B@@@B@@@@@@A@@@@@@BA@@@@@@B@@@@@@@B@@@@@@A@@@@B@B@BAB@@@@@@@@A@@@@@@B@@@@A@@@@B@@@@@@@@@@A@@@@B@@@B@@@B@@@@@@@@A@@BA@@@@@@B@@AB@B@D@BA@@@@@@@@B@@A@@@@@@@A@@@@@@@A@@@@@@B@@@BA@@B@@@@@BAB@@A@@B@@@@@@@@A@@@@@@B@@A@@@@@@@@@A@@@@@@@@BA@@B@@@B@@@@@@A@@@@@@@@@@@AB@@@@@B@@@@@@@B@@AB@@@@@@@B@B@@@@@@@B@@@@@BAB@@ABA@@@AB@@@@AB@@@B@@@@@B@@A@@@@@@@@BA@@BAB@BA@@@@@@@@@@@A@@@@@@@@@@BAB@@@B@@AB@@@@@@@B@@@@@@@@@B@@@@@@@@A@@@@B@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@AB@@AB@@AB@@AB@BAD@BAB@B@@@@@B@BAB@@@@@@@B@B@@@@@@@B@@@@@@@@A@@@@@@B@B@B@@@@A@@B@@@@@@AB@@@@@@@@A@@@@B@@A@@@@@@@@@@@A@@@@B@@A@@A@@A@@@@@@@@@@@@B@@A@B@@B@@@@@@@BA@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@AB@@@@@@@B@@@@@@@BA@@@@@@@@@B@@@@B@@@@@@A@@BA@@@AB@B@BA@@B@@@@@@@A@@A@@@@@@B@@@@@BA@@@@B@@@@@B@@@@@B@@@B@@A@@@@@@@AB@@@B@@@@@@@@A@@@@@A@@@@BA@@@@B@@@@@B@@@@@@@B@@@B@@@B@@@@A@@A@@@@A@@@@BA@@DA@@@AB@@@@A@@B@@@@@B@@A@AB@B@@@@AB@@BBA@@BABA@@@@A@B@@AB@@A@@D@B@BAB@@@BAB@B@BA@@A@@AB@@@@@B@@@@@@A@@@@@@@@D@BA@@@@@A@@@@@@AA@@@@BAA@@@BA@@@@@@BAB@B@@@@A@@B@B@BA@@BA@ABAB@B@@@B@@A@@B@@@@@@A@@@A@@B@BAB@@A@@A@B@@A@@BA@AB@AABAB@AA@@@@@C@@B@BAB@B@BA@@B@BA@@@A@@B@@@BBBBB@B@DA@A@@A@@@B@@AB@@@@@@@C@@A@@@@BAB@D@BABB@@@@@@BA@@@@@@B@@@@@@@B@@@@A@@@@@AB@A@A@@@@AB@@@AA@@@@B@@@BA@A@@BBBA@B@@@@B@@@B@@AA@@@B@@@BBB@B@@AB@@@BAB@B@@AA@@@BA@@AAA@@@BA@@@@@A@@@@B@@@@@BB@A@@@@@A@@CC@@A@ABA@@A@@B@@@@@@AA@A@@@B@@@A@@@BA@@@@E@@@@@B@@@@AA@@@@@@@@@A@AAA@@@B@@@A@@A@@B@@A@@A@@@BABA@@@@@A@@@@B@@@@@@@@@B@B@@@@A@@B@@@@AA@@@@@A@@A@@@@@@BAB@@A@@BB@AB@B@@@A@@A@@B@D@@@@@@B@@B@@@@@@@B@@@@@@@@A@@@@B@@BB@@@B@@@AA@@@@B@BA@@@AB@B@B@A@@A@ADB@@@A@@@@@@@A@@@A@@@@@AB@@@@@BA@@@@D@@A@@BAB@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@A@@@@@@@A@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@A@@@@@@@@@@@B@@@B@@@@A@@@@@@@@@@@@A@@@@@@@@A@@@@A@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@A@@@@@B@@@@@@@B@@@@A@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@B@@B@@@@@@B@@@@@@@@@B@@@@A@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@AB@@A@@@@@@@@A@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@A@@@@@@B@@@@@@BB@@@@B@@@@@@@@@@B@@@@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@B@@@@A@@@@@@@A@@@@@@BB@@@@B@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@BA@@@B@@B@@@@@B@@@@@B@@@@@@A@@@@B@@@@@@@@@@@BB@@@@@@@@@@B@@@@A@@@@@@@@@@A@@@@@@@@@BAA@@@@@@@@@@@@@B@@@@@B@@@@A@@@@@@A@@@@@A@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@A@@@@BA@@@@@@@@@@@@@@A@@@@@@@AA@@@@@@@@@@@@B@@@B@@@@@@@@@@A@@@@A@@@@@@@@@A@@@@@@@@@@@@@AA@@@@A@@@@@@@@@@@@@@@@AB@@@BA@@B@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@A@@@@A@@@@@@B@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@B@@@@@A@@@@BB@@@@@@@@A@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@AB@@@@@@@B@@@@@@@@@@@@@@@AA@@@@@@@@@@B@@@@A@@B@@@@@@@@@@@@@@A@@@@B@@@B@@@@@@@B@@@@@@@@@B@@@B@@@@@B@@@@@@AA@@@@@@@@@@@@@@@B@@AA@@@@@@@@@@A@@@@@@B@BAB@@@@@B@@@@@BA@@@BB@@@B@@@@A@@@@@@B@@@@@@@@@@A@@B@@@@@A@@AB@@@BBBA@@@@B@@@@@@@BA@@A@A@@@@A@@@@AA@A@@@ADA@@AA@@BAB@B@@B@A@@@@DA@@@@A@A@@@@@@AB@@@B@@@@@AA@@B@BAB@B@@@@@@AB@BB@@@@@AB@@@@@@@D@@@@A@@BBD@B@B@B@@@@A@@@@AA@@@A@@A@@@A@A@AAA@@@@@B@BA@@@@@A@@@@BAD@B@BA@@@B@@@@@@B@@@@@B@@AB@@BB@@@@AA@D@@@@@@ABA@@ABA@BA@@A@@@BAB@@@@@A@@A@@BBBA@@@@@@@@B@@AB@A@A@@@@@@@B@@@@@@A@@@A@@A@@@BA@@@BB@@@BA@@B@@@@@@AB@@@B@@BB@@A@@@@@AB@@@B@@@@@B@@@B@@@@A@@@@BAD@B@@@@A@@@@@BB@@@@@@@BB@AB@@@B@@@ABB@BB@@@@B@B@B@@@A@A@@A@@B@B@B@@@B@@@BABA@@A@@@@@BA@@A@@@A@@A@@@@D@B@@@@AA@A@A@@@@@@AB@@A@@@@B@@@A@@A@@B@D@BA@BB@@@@@@@@@BA@@A@A@@@BA@A@@A@@@BA@@@@BB@@BA@@@@A@@@AABA@@@AB@BB@@@@@AB@@A@@@@D@@@@@@@A@AA@@@@@@@A@@@BA@@@@C@@BA@@A@@@@A@@B@@@@@@@A@BAB@@@BB@@@@DADB@A@A@@BAA@@@A@@@@@BAB@@@@@@AAB@A@@@AB@@A@@BA@@B@B@@@BA@@A@@AB@@@@BB@@@@@B@@@AA@@@AAA@@A@A@C@@@A@@@@@AAA@@AB@B@B@@@@AB@@@@@@@A@@A@@@@BAB@B@BBB@@AB@BA@BB@@@B@@@@@@@@@B@@@B@@@AAA@AA@@@@BA@@@@A@A@@A@@@@B@DAB@@@@@C@@A@@@@@@B@BA@@@@@A@@@@@@@AD@@AB@@@D@@@@A@A@@@AB@BAB@B@D@@ABA@@A@A@@@@@@AB@@@D@B@@@@A@@@@@@A@@@A@@@@@@AAA@A@@BA@AB@@@B@B@B@@@DB@@@@B@@A@@@@AA@@@@D@@@@AA@@@@@@A@@BA@@@@BBBAB@@@@@A@@@A@@AB@@@BAB@@@@@A@@AB@@@D@@@A@@B@@B@BA@@AA@@@@@@@@A@A@@@@@BABC@AB@B@BBB@@@@A@@AA@A@@D@BA@@B@B@B@AA@@B@@AB@@@B@@@@BB@@@@@BA@@@@B@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@BA@@@@@BB@@@@@@@@@@@@A@@@@@@@@B@B@B@@@@@@@@@@A@@@@@@@AA@@A@@B@@A@@@@@@@@AA@@A@@@@@AA@@@@@@@@@@BA@@@@@A@@@@@@@@@A@@A@B@@@B@@@@A@@@@@@@@@A@@@@@A@@A@@@@A@@@@@@BA@@@@B@@@B@@@@@@@@@@@@AA@A@@@@@B@@@@@@@@@B@B@@@@@@@@@@@@@@@@A@@A@@A@@@A@@@@@@A@@@A@@@@@@AA@@A@@B@@@@@@A@@@@@@A@@@A@@@@@@@@@@@@A@@@@@@@@@AA@@AA@@@@@A@@@AA@@A@AAA@AAA@@@AA@@@@@@@AB@@@@@@@B@@@@@@A@@@@@@@@@@@@@ABA@@B@BA@@B@@@@AA@BAB@@BB@@@@@BA@B@@BB@@B@@@@A@@AA@@BAB@DBBAB@B@B@B@@A@@@@@A@@@AB@@BB@@@@B@@@B@@B@B@B@B@@A@@B@@@BBB@@@BB@@@@@ABA@@BBD@B@B@BA@@@@@@@AB@B@DB@@@@@@ABA@@B@@@@BBB@BABAB@@@BB@@@@BA@AB@@A@@@A@@B@BBB@B@BA@@B@@@BB@@B@BB@@B@B@B@@@BBF@B@BAB@B@@@B@@@B@@@@B@@A@BB@@B@D@BAFAB@@A@@@@AAA@@@@AB@B@B@@BBBB@B@BA@@B@B@B@BABA@@BA@@D@@@B@B@B@@A@@@AB@@A@@@@BA@@@@@@AAA@@A@AB@BA@@BAB@BBBBD@B@B@B@@A@@AAAA@A@AB@BAF@B@BA@AAA@@CAA@@@@A@ABADADCBA@A@A@A@AAC@@@A@ABAB@DABA@@@AAAAABA@@DAFCBAAA@ACCAAAA@@BABAB@BABAB@DBB@B@@A@@A@AAA@@A@@DABC@@DAD@B@@ABA@AAAA@CBC@@AA@BA@CBAB@B@BABA@A@AA@A@EBA@AA@AA@@BA@@B@@ABA@@A@ABADC@@@AA@A@ABA@AA@@@ABAB@DAB@@A@A@@A@A@@A@AB@B@FBBBDA@A@@@A@AB@DBB@BA@@DBD@D@F@BA@@@A@AC@AA@@@A@@DAB@BBBBB@B@@@BBDBB@@@BA@@B@@@@@BBA@ABA@@@@BB@B@BA@@BA@@@A@AB@@@B@BB@BB@@@BBB@BB@@B@FAB@BA@@BAB@FAB@BA@AA@AA@@A@ABA@@A@@@@BAD@@A@@@@@A@A@@B@@@DDBBB@B@BAD@BABAD@@@@A@@A@C@@AA@@A@@BA@@A@@ACBA@@A@@@@@ADAB@B@B@BBB@@@B@@A@@@AAA@@@A@@@A@@@A@@B@B@D@@A@@A@AAA@A@@@AB@@A@@@A@@AB@BAB@DABAH@BA@@AAAAA@C@@A@@B@D@D@B@@BB@FAD@DBDDDBBBTJBBBBHFDBBBDBD@FAFABABC@AAA@A@CBABAD@BB@BBB@DBBB@B@B@BA@A@E@AA@AACA@AAABABAB@@A@AA@CAAA@CBABADA@@@AA@AAA@E@E@AA@@@A@@BA@A@@AAA@A@C@A@@A@@@ABADADAD@@@D@DBB@@AB@BA@ACAC@E@CBA@ABC@A@AA@@@AB@HAHCHCLEJEFEBAHAD@FAD@BBB@DBB@B@B@BA@@@CCAEEEAA@AAAAAA@@A@A@AA@@BAB@HBN@F@DABAD@FBD@BA@@@AAAEAA@@A@@@ADAFAFAFCDAB@B@DBFBD@FAHADCBCBCACACCACAC@CBA@ABABAB@@ABA@E@C@AA@A@ABABADAB@D@D@@@B@DC@@@A@@AA@@AC@@AACAA@ABA@ADADA@A@@A@A@ABCFCDCBC@@@AAAECEAA@CCCA@@AA@@@ADCBABG@A@AD@JCDAD@DBB@B@@@BA@AAAA@AAE@C@@@@A@ADCBA@A@@@AAAEACAAA@A@AB@DAB@DAB@D@B@B@@A@@@A@@B@F@B@@A@A@ABAB@B@B@DB@BB@AB@@@B@@BBDBBBF@D@@AB@@A@AAC@AA@@A@CAAACAA@@BABAB@F@B@BA@AA@AAE@C@E@AACAAA@@C@A@@A@@BABA@A@AAAA@A@GBAAA@@@@AAC@CAAGEAC@CAA@ABE@AAC@KACAAA@A@C@AB@@@B@BBD@BBDA@ABABA@C@AAA@AACAEACCEA@AAAACBE@@@A@A@C@CBADCLGBA@C@CAAGAC@C@ABCFADADA@ABCAAAAAAAAACAC@A@CBABC@C@A@@A@AAABA@@@A@@B@@@B@@@B@B@BABAB@B@BA@AAAA@AAA@A@ABA@A@A@AA@@@A@ABC@AAA@A@ABAB@BADA@ABABA@AAA@@CAA@@@AA@@@A@A@@@ABA@A@AAA@@A@A@A@A@A@A@A@A@A@A@E@C@@@AB@BCB@BA@ABABABA@AD@B@D@B@D@BBB@BB@@D@B@B@B@B@BABCDC@CBCBA@C@A@C@A@AA@@@A@AA@AAAAAA@AAA@A@C@AAA@A@AACAA@A@AAA@A@A@AAAAACAEAAA@A@A@@B@FABAB@DAHAB@B@@AB@AA@AA@@AAA@@@AAACACCC@@AAAC@CAC@A@A@C@A@C@C@C@E@C@E@CBC@EBCDC@ABC@A@C@C@A@C@A@A@@AA@C@AA@@@A@@B@D@D@D@D@D@DADAD@BAB@BAFAB@@ABA@@@ABADA@@DAB@BA@A@AAAAA@A@A@A@A@AACCCACCACACCE@EACAC@A@C@C@A@A@C@GAC@A@E@AACAAAACA@AA@A@ABADADABAD@DCDA@AB@@AAAA@CCAACAA@CAC@CAAACACAAAAACAA@GCEAC@C@C@GBA@ABA@EBGBA@A@CAA@AA@A@AB@@A@AAAAAA@A@@A@A@A@ABCBC@@D@BA@@BABADCDADCBA@@@A@A@@AA@AAA@@AC@AAC@AAAAA@ACAA@C@CBC@E@@BA@@B@B@@@B@@AB@@ABCDCBA@CBC@ABC@A@CBCBCBABADA@CBA@A@C@C@AAC@EAEAIEC@A@CACAACCAAACCCC@ACACACAAACEAC@ABA@@DAD@DAD@B@BBDBBBB@D@B@D@D@DADAFAFABADADCBA@A@CAA@ACAC@EAA@CACCCA@A@ABCB@BAHADCDAD@BADBB@F@D@BABA@A@A@ABABAB@DAD@DAF@D@DABADABCBABA@AAA@AA@AAAC@A@A@CAAACAAAACCC@CACAC@CAE@CAC@EAA@CACAC@AAA@@A@ABABCBABADADADA@@BABCBAB@DABA@A@A@C@AAAAAAACAAACACAAA@A@EAA@CBABABCBEFCBABCDABADABAD@DADABCBCAC@AAE@EAEAGCAACBEBAFCHE@A@E@GDEFEDEDEBEBCBEBC@E@AECGAAC@CDC@C@AACC@G@E@C@CCACAAG@CACAAA@CACCCCCEACAGAEBK@KBC@AAAC@C@AFAD@B@BC@CAACAE@C@CAAC@ADCAAKGA@@AC@A@A@A@@@A@CBA@CBI@CBBDDBHAF@B@DBBD@DADEBIDGBE@IACCGCGCCCGIGGKIECCAEAG@G@KAECAC@EDCFAD@F@FBDBFABC@ACCAAC@E@GBGAEAECCAE@C@CBCBC@AAEEGAECAAACCIEGAKAEECKEECEAI@I@A@A@C@ABAB@DADC@KBMBI@KBGAKCECAEBABADADBD@F@BABC@A@@AACAEBGDC@CBC@EBC@E@C@ACACBEBGBCHEBCBCBCBCHEJGDC@CACACECA@EAIBEBKFMHKFMJIFE@E@C@CA@C@CACECCAE@EAC@@A@C@@D@FAHADADC@CBEBAHIDEDGBEBC@AA@ICIAECEA@AAABCDCBAF@FAD@D@D@D@JAFCBA@CACECECGCE@I@E@C@EAACACAC@CBCDCDCBABADAFADC@@@CCAEAECE@C@E@ABA@E@CAC@CAACAA@ABABABAF@D@BAD@D@D@F@BABA@CA@EAGAC@C@CBEBEBE@EAGACCCACCEEEGACCAC@E@EBC@C@CAAA@ABC@C@GAEBEBIDE@E@CAACACACBEBCBA@C@CAEAACEAACAABCBABAFCFABA@A@AAEAA@AAC@AAABABADALEFCFCBC@AAAEAE@E@EDABEBEBGBCAC@AAAA@GBCB@DADCHCFCBA@C@CACCCCCGACAKCGAC@CAGEAACAE@C@EAABA@@@CBEBG@IBCBA@C@ECCEAC@CACBE@A@ADADABA@E@CCCCAAAC@E@CBADABA@C@AC@AAC@ACCGCEACAEAEBKBIBG@EAAA@ABCDED@HCJCBADABAB@FCDADADAH@F@J@FABABCBE@@AACCC@I@G@M@EAC@EAC@CBC@EBK@C@IACA@C@CBCBC@AAA@GACAAA@EBEBEDCFAFBJAFAFABEBGAEAGCGGECOCEACAAA@C@EBCDEDCBCAACACCCCCAE@MAIACAA@@A@ADADCD@DAD@F@DBD@BAHALAFCBA@A@AAAGCKEGCCAAA@A@CHEHEJCF@J@BAH@FAH@JAP@ZAHAFCDEBEAAGEGCMEMEE@C@IBKDGDADEHEDEBA@EAGCGAEEIECEC@G@G@EAAA@ABCB@FEFAFAHAF@F@FBD@F@HAFAHEHE@ADIBI@GAICEIGKGKCKCK@O@KAICGCCC@GBI@GBGCCOQCEEBODKBK@G@CAO@KAA@EACCACBCBAHGHCHCBABE@EBC@@DCF@DAB@FAFGFAFAD@D@FA@@@CAAMIGEACACBCBELGLEFCHGFGDGDEFGDCBEAEACCAC@QBG@CAAAAA@EBCDCJCNIDA@C@A@EECCCGCMEK@SASAMAMAKCA@CAEACAECGAA@CAIEUGgOEAUIKCKCAAA@GCOJMJEDA@YP_TCBCBADABKNEJCDEHGJABABILABCBMP@@OPIJQRABCDMN@@AAMI@AECIGAAECAAKICCCCECCAEEGE@@@@@@BA@@BB@@@@B@@@B@@@@@B@@@@@@@@@B@@@B@@@B@@@@@B@@@@BBBB@@@@@B@B@@@@AB@@@@@@A@@@@B@@@B@@A@@@@AA@@@@@AB@B@@@B@B@@@BAB@@A@@@AA@@@B@B@@@@@B@@@@@@A@@BA@@A@@AB@B@B@@ABBDAB@B@@@AABA@@B@B@BA@@B@BA@@DC@@@@@AA@@@B@@A@@@@@@B@@@@AB@@@@@AA@@BA@@@A@@AA@@@A@@B@@@AA@@B@@A@@BA@@AAB@@AA@@@@@@A@@AA@@@@A@@A@@@@@Ag@Y@o@@@@@BA@CDSLaR@BA@GAiC_]CCAAACA@KMAAW[MOciAAGC@@@@IKGKA@GIAq\\ABzLbKN^@NGNHFHXQHUBCHKHMHBFWPKVMHMPDLCLNPFF@JKBAHL@ALJHGDCHOBGBADFD@DE@BJLHCFANLROLCTHJQFMRLHGPXHDBTTIRXNAN[L@TQRUROJMBMCiDULCNOFECMHMNIBOTSfUfANQDaVvNT^nj\\BBP\\[N[NU|MHHdSFS`aNBP]H@ROJAE_@EHLLOPFPKNHNKH@PJJ¹SAFCFILCFADDHFL@FADCFEJGLCHALCFEH@DBDFDFBTBNBAD@H@FFFDFBF@FCFEHEHCH@FBFCN@J@JBJFTBLBFAFCFGBKDM@cAS@YCKAIEGEMEGAG@IFG@IAMAOEG@E@CDADCJEFOJKFKBKBM@SDMBQAsCmBSBSDWDgJQB[DgD]AU@OE@@@C±NjĿİmTOhp[t}bODRFZi^Ê£°µÆ¢×ô£¶"],["@@A@C@AAK@C@I@G@C@AAA@E@S@E@C@E@IC@AEAECACAAACBC@A@A@AB@AE@ABA@AAI@@@AAA@A@@A@CAC@KAC@GA_CKAK@C@M@A@EAMGA@A@@@A@@@AB@A@@@@A@A@@@@@@@@AA@CAA@@@A@@@AA@@@@C@A@@@@B@@@@@@A@A@@@@BA@@@@@@@@@A@CBA@@A@@@@A@A@@@@A@@A@@@@A@@C@@ACACA@@AAA@@@@@A@AAC@@@@@@BA@@@@A@@@@A@A@A@A@A@@@@A@@A@@@CBAAA@A@AA@@A@@@@@A@@A@@@AA@C@C@AB@@A@@@A@@@ABA@A@ABA@AB@@@@@@A@A@A@C@A@AB@@A@ABA@ABGBABG@@@AAA@@AK@@@EBA@C@A@@@ABC@@@C@A@AAA@@@AAA@CAC@EBABCBC@A@E@ABABC@ABC@ABE@MFE@A@@BEAA@A@A@A@@@A@CAE@C@C@A@CCGCCGAAAA@AA@AAECGICEAABA@@AAE@EAAACA[IEC@@@@@I@GBEAEAGCCCE@ACAAA@@G@@@C@AA@@EEAAEAEAAA@BA@@@AA@@@A@@BA@A@A@@@A@C@@@A@@@CD@@ABABA@ABAB@@CBA@A@@BA@ABC@AAA@@@@@A@@@@@A@AAA@A@@@AB@@@BA@@@@AA@@AAA@AC@@@ACC@A@AFCB@@A@@BC@C@AA@@AE@A@@@CB@@A@@B@@@@A@@A@@AAE@@BB@@@@@AB@@A@AAA@@@@A@@D@@A@@AAA@@@@AAA@@AAC@AACA@AA@A@@@@@@@@@@@@A@@@@A@AB@@A@B@@BB@@B@@A@AAA@A@@@@A@@A@@B@@A@@@@@@@@A@@@@A@A@A@@@@B@@@@@B@@@@CA@B@@@@BB@@@@@@A@A@@@A@C@@@CAA@AAA@CBA@A@C@@@@@@A@@BA@@@@CAA@A@@AA@BA@@@@DB@@@@@AA@@@AA@@@@@@@AB@@@BA@@B@@@@AA@A@A@A@@A@@AA@@A@ABA@@A@ACAAAAAEA@A@@A@BAD@@AB@AAAA@@AA@@@@@A@@A@@A@@D@@ABA@A@@BA@@B@@@@AA@@@A@@@A@BA@@@@FAB@@AB@@ACA@@B@@ADA@@@@E@@@@A@@BA@@B@BB@@BA@@@@@A@A@ABA@@B@@@@@@BB@B@B@@A@@@@@@@A@@@@@A@@B@@A@@@@A@@@A@@@@@BA@@@@@@@AA@AAAA@@@@@@@AB@B@BB@@B@@@@@B@BEFAFCB@@A@@A@@A@@@A@@B@@@B@@@@A@@@A@@A@A@@ADA@A@A@ACAAC@@@@BA@@@A@@@@BA@@@@AAAA@ABA@@DABA@@@AA@@A@@@@D@@@@AB@@@B@B@B@B@@@BAB@@@AA@@@A@@@@@@BB@@B@BA@@AA@@A@AA@C@@@@B@@C@AB@@AA@@AB@@@B@BABB@@@BD@BAB@@@@A@@@A@@A@@@@@@A@@B@B@@A@@@A@@B@B@BAB@@@@AB@@@B@AA@@@@@@@@A@@@@@@@@@@A@@@AA@@@@BA@@@@@@@@A@A@@@AB@@@B@@@BA@@@@C@A@@@@AB@@@B@B@@A@@@@@@@CA@@@ABA@@@@@BA@@@@C@@@@A@@@@B@@@D@@@@@@@@A@@C@@@@@@A@@@A@@B@@@BB@@@@BA@@@A@@B@@@B@@A@@@@B@@@B@BB@@BA@@@@@@BA@@@AC@@@@@@@BABA@@BABBBABB@@@A@@@@@AF@BA@@A@@@@A@@@A@@BAB@@A@@@@BB@@B@BA@@BA@@@ACA@@@A@@B@@@BB@@B@@A@@AAA@A@@AB@A@A@@@@AA@@A@@@@A@@@@A@@B@@@@@@A@@B@@@@AA@@@@@BA@@@@B@B@B@B@@@A@@A@@A@@@AB@A@@@@@A@@@@A@@@A@CAAA@@B@A@A@AA@@@A@@B@@@B@@@@A@@BAB@@@AAAB@@@@@A@@@AA@@@BA@AB@B@@@@@@@BA@AB@@B@@BB@@@AB@A@BAB@@A@@C@@@@@@@@@BA@@@AA@@BA@CA@@@@B@@AC@@@@@D@@A@@@A@@@@@@A@@A@@A@@@ABA@@A@@B@@A@@B@B@@@@@@A@AA@@@@A@@@@B@@@@@@A@@AA@@A@@@B@BA@@A@@A@AC@@@@B@@A@@@@A@AA@AA@@@@@@@@A@@@@@AAB@@@@@@A@@AAA@B@@@BA@@@A@@@@A@@@@@A@@@@AB@@AA@@A@A@@BA@@BA@@B@@B@@@@B@BA@@@@@ABB@A@@B@@A@B@A@@AAA@BA@@BA@@B@@A@@AA@AA@A@A@@ABA@@BA@@A@@A@@A@@@A@A@BAAC@@@AA@@AA@BA@@@A@@B@CAAA@AA@@@A@@@@B@AA@A@A@@A@@AA@@A@@AB@@@@AA@C@@@@@@AAB@A@@AA@@AB@@@@AA@@A@A@AB@AA@AB@@@AC@@@@@@@A@@AA@@AB@@@@@@@AA@@@@C@@@AB@A@@@@A@@A@@A@A@@@A@@@A@AA@BA@A@@@A@@B@@A@BAC@A@@@A@@@AAA@AA@@AA@B@B@BA@@BC@@AABA@B@@BA@@@@BA@ABAB@BA@CBC@C@AAA@@B@@@@ABAAA@C@@@AB@BA@A@AA@@A@A@@BA@BBA@@@C@A@@@AA@BA@AA@BA@A@@AA@@BA@@@@BAAA@@@A@A@A@@AABAA@@A@A@@B@@AD@@A@@BA@@BA@@@AAA@@@CBA@BBA@A@@@A@A@@@A@AA@@AACB@AA@AAABCAA@A@@AC@@BAA@@BAC@@BA@@@AA@@A@A@A@A@@@A@A@AAB@A@A@AA@@C@@B@@C@@A@BA@@AABA@A@C@AB@@BBA@CA@BA@A@@@A@@A@@E@A@A@A@BBC@A@AAEAA@@@A@A@@AA@C@A@@AA@A@@A@CA@@@A@AA@@A@@@ACE@C@@BC@@@A@A@BBA@@BA@A@A@A@@BAAAB@AA@@AA@A@ABA@A@@A@@A@@@AA@@@@@@AAA@@A@AA@A@A@@@ABC@A@@@AA@@C@@@C@@A@@AA@@AAABA@A@AA@BA@@B@BAA@BAA@@C@AAABAA@@AA@A@A@@C@EAAAA@A@A@A@A@A@@AA@AA@@@@@AABAAA@A@@@A@@@@@C@@@A@@@@@@A@B@@A@A@@@AB@@AAAB@@A@C@A@@@@BA@@@@B@@@@A@AA@@@AA@@@AB@@@@@@@@@@A@@@@@A@@B@@B@@@@@AB@@AAA@@B@@AB@@AB@@@@@AA@A@@@@@@@@@BBA@@@@@@AA@@@@BA@@@@@@B@@@@@@@AA@@@@BA@@@B@@@BB@@A@@@C@A@@@@@@B@@A@@@C@@@A@C@@@@A@@@@@@AB@@@@AB@@@B@@A@@@A@@@@@@@A@A@@@@AA@@@@BC@A@@B@@A@AB@@@@@@A@@@A@@@@@@BA@AAAA@@A@@A@@@@@@A@@AABA@A@A@@@AA@A@@@@A@@@@@@@BA@@A@@@A@@AA@A@A@A@@@@@@@AA@@@@@@@@C@@@@@AA@@@@@@A@@@@AA@@@@@A@@@A@@@A@@@A@@@@@A@@@@A@@@@@@A@@@@A@@@@A@@@A@@@BAA@@@@@@BA@AAA@@@AA@@@BCA@@@@@@B@B@@A@@@A@@@@@@@A@A@@@A@@@@A@AA@@A@@AA@AA@@@AB@AA@@B@@@@AA@@@BA@@@@A@@@B@@A@@A@@@@A@@@@@@A@@@@A@@AA@@AAA@AA@@A@A@A@ABA@AA@A@@A@@A@@A@@@A@@AB@A@@@A@A@@@AAA@A@@A@@@@@A@@AAA@@A@@CB@@@@@A@@A@@@@A@@A@@BA@@@CA@@A@@AA@@@A@@BA@@@A@@A@@@@A@A@@AA@A@@BA@@@A@@@@@@A@@ABA@@@A@A@AA@@AB@@@AA@A@ABA@A@@@A@@@A@A@@@A@@@A@A@@B@@A@@@ABA@@@@@AB@@A@@@@AABE@A@@AA@A@@@A@A@@@A@@@A@@@@@@AA@@@A@AAA@@A@@C@@@@AE@@@A@A@@@@@@A@@A@@@@@A@AAA@@A@@@A@@A@A@@@BA@@@@A@A@@@@A@@A@@@@AB@@@@@A@@@@AAAAA@@@@@A@@@A@@@@AA@AB@@A@@AA@AA@AA@A@@@AA@@ACA@AAA@@@@@ABAA@AA@@@@@@@@AA@A@@@A@A@@CC@@@A@@@@BABCBA@ABCDCB@HCBABC@@B@@ABA@A@A@A@ABCBA@AB@DC@@@@AC@@AA@A@AB@BA@A@@@@EE@@@@AABA@@@AB@@AAC@CACAAB@@@@AB@@@BA@@@A@A@A@@@@@A@A@@AAEAAA@@@A@AB@BAA@AAA@@@AA@AAAA@AAACAC@@A@A@A@A@ABAAC@CB@A@@BA@A@E@@@@A@@@E@CAA@AA@AA@AAGIA@C@EAAAA@ABABA@@@AAEACAECAAWCC@E@GDABCAA@CAC@SAGAAACA@AA@A@C@A@AA@@@ADE@CACCACC@@BCACCC@A@AB@BAFABA@@@A@ABCB@DA@@@A@ADAB@@@AAC@AAAA@@@ADABA@@@AAAC@CACCAC@@@ABA@ABCDC@A@CB@HCB@BABA@@AAEA@EAAGCUIA@@@AB@@QC@AACAA@C@ACCEA@ACAEAAA@CA@@C@ADADC@@AC@CB@@AIGBCCK@@GBG@CAEECACAACAGACAGABMBIBE@IDABADEDABA@A@@BA@@AA@A@@@@@AA@@AB@@@@A@@AA@@@@B@@@BA@@AA@@@AA@B@@A@@@@@@@@@A@@@@@@@@@C@@@@@A@@@A@A@@@@@B@@@AB@@A@@@@@@@@@A@A@@@@@A@A@@@A@@@@@A@A@@@@@@@A@@@A@A@A@C@@@@A@@A@@@@B@@A@A@@B@@@@@@@BA@@@@@@@@@A@@@@@@@@BAA@BAAAB@@@@@@@@C@@@A@@@@@@B@@A@@@@@@@@@A@@B@@@@@@A@@@@@@@@@A@A@@@@@@BAAA@@@@@@@@@@@@@A@@@A@@@@@@@@@A@@@@A@@@@A@@@B@A@@@@AAB@A@@A@C@@@@@@@@BA@A@@@@@A@@B@AA@@@A@@BA@@@AB@@AA@@A@@A@@@@AB@@A@@@A@A@@@A@@@A@@B@@@@A@@@@@A@@@A@@@@@A@AB@@A@@@@AA@A@@@AB@@ABC@KBA@C@@BA@@@ABC@CBMFEB@@A@A@EB@@@BA@A@AB@@E@@BC@@@CBC@ABCBCBA@A@ABA@@BAB@@IHCBEDCDABABC@CBA@CBABA@ABC@@BC@IBCBA@EDC@@BC@A@A@AAA@E@ABA@AACAA@C@C@A@A@C@EAC@AAA@A@A@@B@B@BAB@B@@@DBB@@@B@BADABA@ABA@CAECGCA@A@A@@@AAA@A@@@CB@@@@A@A@A@@BEDA@AF@@@BA@@@A@@@AAA@@ACBA@A@A@EAC@CAAAAAAAA@ABEBABABA@@@A@CAAAABEDABED@B@@@B@@E@A@AB@B@@A@@@A@AB@BABCB@@@BAD@@BBB@DB@@@D@@@BC@C@@BA@@BA@A@E@AB@B@BBBBB@B@BA@A@A@A@ABA@AB@BABA@ABA@A@A@AA@A@A@A@@CCA@@@@A@A@@@AA@AAA@A@@ACAACACEACACBIBIBEFBFADIHADBFGDEBECA@E@E@CA@CEEMEICAACCC@ICGBGFGHEBCBM@A@@DADCB@DEDCBABADABCBEBGBCDCHABCBAFEBABBFDHAB@D@H@@EBKBABADABCB@BCB@BABBDBBAB@B@B@BABBBBBFBD@DBBBB@BAB@@BBH@B@B@BB@@B@B@@ABA@@BBF@@BBB@BBBB@B@D@@@@ABADA@A@ECA@A@AB@@@B@DA@CBCB@@A@A@A@E@A@CCA@ABCBAD@@@BA@A@AAC@A@@B@@A@A@A@@@A@@AAAA@AAC@CACAA@E@A@A@@FAB@@EBABGDA@A@@BCDBBAB@D@B@@@BDBBBCBCB@B@HBF@HBD@BEFEBCDC@ED@@CDENCN@PEDI@KGCBIAE@IDCAGCI@ACCBA@EDQFMCG@GAG@GDC@ECGAIE@EA@C@EAEGKECGCAED@DABIDCBADCB@D@B@NQBEBCFABGAE@KFCDCDEAKBCDA@@@C@C@CDE@E@ECAACA@AAACAC@IBG@K@AAAECCAAACEAG@ICCCKAA@@CC@@AACA@GDCBCA@AWKCAEAEAECKCC@E@GAKGMOAEEAGC@ABE@EACC@E@CBEBE@I@GGGAC@GAA@CCA@C@GQCAGAKAE@E@@@CBC@ECCC@E@EDEEEC@CFC@IGKACEA@GGCA@CACDCEIAGB@@AA@@@ACEAA@@AAAEGAC@A@AAA@AA@ABABC@EBEDABIFEEECCAGACCCAICK@IAE@GBEBEDK@EEEEBE@CCAAA@CCAMAGGKECA@CAACCACEAIAE@CDEBABE@IGG@E@A@CGBCBAACACBCAAECICKIGAICGIECIAICGECA@EBC@CAAGCAABAACGCAAEEG@IBCCECG@GBABAFC@EAGECIGCCCE@SCCCCG@CEAIEACIGCE@EEGECCA@ABCHAFAFCBCA@MEEGICEAEACCDCCCECKACCI@GACAGAAAK@ICEACABCDEBA@AMMDK@AA@CBK@EDGBGAGAEC@ABC@ADABCDA@@AG@EBACAEAGAABCBCDA@G@G@AAC@ABA@EBA@CDA@C@AACBEBC@GCG@C@CBABABCAECECEAC@IAE@C@EECEACCCCADCFCDAH@BADAD@DBBBFADC@AAAAABECEBCAC@AHEBCBA@CA@C@AC@CCAA@@C@CA@GCCA@ABA@A@E@EBCBADADABADADABAC@ECECACGICAE@C@EGGEE@CACAC@CBC@OBEAC@ECECC@M@ADEBEAGCGEC@GBEBECECACKACCEACGBIK@KBKAIGKAI@K@EBKCILGDE@ECAAKEMCWCIAGCABEDCBIAC@@D@BCD@@GDI@G@G@ICA@K@ADADGBC@CDODGDC@E@ABADCBAB@BAFADCBE@AACBEFE@I@IBC@CBCFGDED@BB@BHHFJDEVGHGF@JAHIFAJABK@IBODAFDFLJGFEBBHHNUAYBQHKDWFSNHHDNEHAFBDDH@JAHCBBHFF@FDFF@JDHJFDDLNLBJHHMHIFCHI@E@IHMHM@]AIFMLKFFDNFRBPBJFJHDFLDBF@NBNFJAJNRPNFLCJFJKTO@MCICMFCFKBARDLKAMD@HIHI@OHQJCH@LCPHHAJBHCHOXJFDLQDKLUHQHSBUPUHALKDMFBFGRDNEFEBFLAFKHAHCLBLINM@OCKFMB@F@FDDKJLPFRGFIDCV@HEDBFIDK@@J@LIBGL@HAHGHAHAHMFMFKLQJQLQAKCC@ALDJLJHLCH@LJDFJPD@ARIN@FJRDTGJ@RIJ@HFLBHDPA^HJINGRFRB\\@LITEHBNERDNEHGBCNMBINCHFJFNFHDNBNNFLLBRBEDB\\BFHDLFFJFHLDT@HCLFHFT@TCFHHKNKAGLEPIJGBCBEF@JHJJBFLPHDNBHJJ@NBFJTABHIB@F@FBJFBHC\\@JDFHRALNNDHAINI@Q@GHCHHFHFNN@HEHEJFHZDPFN@TFHNBHFFCJ@FDHABRJLHHGLCH@DJHDDHCLDHDJHFHDNAT@FLCJAFHJDJLRL@FCDGTETEXAPBFJFHTDTCTCHJFBFJLDJLHDIHCDAHGFGJ@FFHHLGFIDG@EDAHBHCDDFDJOH@BADHDHDRRCH@F@J@L@B@BIHBPDHJHDDJBRCBFBHDB@FBD@LBDCXJJFTDBJNBHNDHHJ@LDBHEHDNAHDJAJIFGLCDBDHBJJDDBNIJIAM@ODBDBJUJM@GAAFKDI@GDGFC@EEECECAGMCCCGBICMBECBGCEE@QKGCOB[AKH@DAHCDGF@HGNGDKPE@KHG@EDCAI@KEEEGKGCAG@EECMDKCKGKKOI@@QBGCKIMGMGAGKEGMIIAGICO@QI@E@EGGGAECIDGBOJCHOJGDIJIF@DMLCRGFMFI@GCI@IBIHC@INIAICCFCJKFAHIBO@EEKEACFQGIMM]AECMEMDODWCKBK@WDDJBFEBIBIBMEKCSDEAEHGBECA@OFILMHGDBHFJFHJDJDTJTBLRCHIF@BH@LFPDHALBFFNJBCHDDFDFDBKLIFIBDHDDAF@JDBPDTFF@JHJDBFBHBDCLJFJFDBILBFDDBFAJHDRLJJAJEFCJM@CLBNCLA@ILCHQJ[HG@QDUBGJ_B[D[BQD@L@J@BFJFLCFBLJXAJDDCF[LKAkTE@WJAHEBBJCFIHO@KNCH@@JLHHDJX@JHNFFDNFIJ@HAD@HCFDFJB\\HFV@JALBJFNHBPFPNFDBBNKBGL@JEFKBGBMP@LDJ@LDJEDJHDTHPHXBF@BGJAJFLAJ@NDHBLBHCPGHICEAEGGJANAPCT@LHFGLCFGTELIVFNDTCBJNFJFLARBNBJF@JBHHDAJJJ@@@@@@@B@@@BBB@@BBBBB@B@DBHAB@BBB@J@FBBBBB@BBB@BLLBBBB@@D@DBB@B@D@B@B@B@@@BBB@FF@@B@F@FAD@JAJ@B@B@@@B@@B@BADA@@B@@ADABAB@@@BBBBBLB@BBBDBBB@B@@@@ABGAABA@@@@B@BDBFFDDBBBB@BBB@BAB@BAB@BBBB@FDD@@@D@B@D@DAB@B@D@PFB@DBFFDBBBBB@BBB@FBBBBB@BBDBJHBDBB@B@@ABIBIHAB@HCBEFABCBCBCBA@ABB@B@BBB@BBB@BBB@AB@@CDAB@B@B@@@@ABA@EBA@C@A@@@AB@@AB@@BFBB@B@BBB@B@BBB@@BB@DBBFBDBBB@BB@AJ@BA@EBCBM@@@@BAD@BABCBABA@A@C@GAE@KBIBC@AB@@@B@BBBB@BBBD@B@@@D@DABAD@DED@@@BDBBBDBBB@@DDBB@B@B@B@B@D@DAB@B@@BBDBDDBB@B@B@BCD@BCBAB@BAD@DADADCDABEBCBC@ABABABADADBD@BABA@CBE@CBE@C@A@A@CBADCBCDAB@BAB@@ABC@C@E@C@C@CAA@M@A@A@C@C@A@A@ABEFABABKBC@A@C@AAMCA@A@@BA@ADEBABGD@@AB@@@BFDDDBB@BDBDDFBB@DBDDDBBD@@@B@B@B@BCF@DBHBD@DFFDDBBBBDBBBHDBBFDB@FD@@BBDBDDDDB@@B@B@@@B@D@BBHBD@B@DBH@F@HBBDBDBDDBBHBFDB@BBB@@B@D@B@BBBBBJHBBBBBBFFDDB@RDF@B@F@JAFAD@BCD@BC@@BB@BBJ@FBBBDBB@BBBBFBB@B@H@BDDDFBDBD@@DBBBDB@@BBAF@BABABCFAB@B@D@DBB@@@BB@B@HBB@BB@B@B@DAHBBDBDDDBBDFFDLBBB@DDB@@B@BAD@BABDBF@D@L@H@JBFBB@F@D@F@B@DBB@FBF@J@D@BAJIDABAD@D@JAD@DCDABAJ@DBBBJDFDDDDDHBF@JEHEDADAF@HADCDAB@DBFBLBD@FALBBAF@DCDA@C@CB@BAD@DAD@B@BBBBBFDBBBH@F@D@DBBBDBDBF@FAD@H@DAFBJDD@BAD@FCB@D@DFBD@FAF@DDBFFDBF@BBDBB@@BCDCD@B@BABGFAB@BDDDBFDBDBB@F@@BBDBFBBBBBDDDD@F@@@BAD@BAD@DAD@BBB@BBBBB@B@B@B@@D@@@D@B@FFBBFDFDBB@BBDBD@BBDB@BDB@BBN@B@BBDBHBLDDBBBBB@BDDHJB@DBB@BBB@DDB@JDDDBBDDB@DBDBBB@@@@BBA@DJKLBHJFCHYCIEEB]DBFEDKD@LKVML@BLBXHIHAFDJKLFFCJDFKJWFDJKFJVCHZfFD^@^N`IH@DFALODADZT^BJDHRN@LC^FBBL@D@XDDDDJANID@JIJBH@@IDCLLLGDALFBCJFBAFFBXA`^L@@DKTPJAHF@LFDDELOJLTCDBHCDCDANDTGDOBGDEGCEMDOPEEBWGM[@YHEFDJ@TFDKHCLDD@JHDBLABGHABCNBBRBHAF@VT@BPLELBNGFCLIDOH@BIBCFEHDHIFAFNNNFB@HB@HLHN@FFCJDFJB@@LLJBHJPLBBGF@B\\XF@FH\\VvLFHPL@DKHADCLBNBFBDDDDBPDD@DBDB@B@D@BHDHBBBBB@@HBLD@HDF@BDBP@FB@@DAFAD@NB@@B@DA@E@A@ABAB@P@NAD@DABCB@B@B@@@DBJBFB@@DCNGFGBQNG^EPGDKCMCIBIJMPGJAP@XEVEPKPIHMAICOEM@KDMHQDGTUJETQPKDCXCFB@@@@@@@@@@B@@@BB@@DBB@DBB@JDFBB@ZFHBRBVARIJCPEVAVCVODCF@VBNBH@HBPELMVGTEXEVBV@LAJEAACGFIPGRGTEZENALA@@F@L@DBVJTLXJZF\\ANCTEN@`PND`DPIVCNAVBJDFNJLDB¤µØó¡¶Å¤¯Éj]EYCQP~a\\soPgnSŀįMi²@GDEHEJIDEBCAECCc@_@OAg@ECBG@O@ECGGEIAIAO@QAKAEAACDEDCBEBEFCJAJCBEAEBIBEAEEC@CBGCCAG@GEC@CDCHEBACCCCGGDAFENE@CEEIGCGCGIKQMKIGAK@K@IBG@IAICKEEEAE@GAGCCGEEEKHKFIBKBKDEBABBF@FCBEBIDCDDF@FCBCBODGDC@GBQ@IBGBCFAF@HBH@LCDGFEFCNCHGDGDKDGBG@GAGEEGEEOKCE@ED@HCFE@E@E@EGMCG@OGGGIIIIS@GFEFCHAD@DBFFDDFA@CACAIAIISCK@CDCJ@JBF@@CFGDE@EAEDEFAHAJABCBODEHAH@P@H@BE@IHIFCLEJAJAJ@FCFKFK@GCGCGBEBKAECGEKCK@IDGHELGHADEAGDEFAFBFDHDH@PALAFGHEBGJMHIJAXCL@JDJHJLLLJHFDJBNBL@LBDDFHDDFBJ@JCBEHIDAJ@P@HCLAJ@DCDEHEHBJFLJLHJDLBJBFFFH@H@DEDCD@FBFFBX@RBLALCFEBGFALAHAJAF@FDBFBHDBFBJBPEFCBCCEAEDEHIFAL@THXHRHLBX@V@P@JBJ@JBH@AGCEECAG@EFGDCAIBMFIFEFCJAHAJAHCHCJ@HAJCF@FBHDHHFDBBDBRHHHFD@FCFEFGFGHEJ@HDHFHFDBD@RAHBFDFJDJDHBBBCFCDEDEDCFEJKJAHAJDFHHPHLDNFDF@DABO@QBIBEBCFAHANAHBDD@DB@FBDFFHF@FBH@HFFDDHBJ@NAHAL@L@LDFBDBDD@FDFFDHBLBH@FDDDFDHDDD@FºTII@OLGGMLMEOPOKKFG`@BFPI@Q^GAObMT_TEGcNGV{\\M\\MO[AAi[]mSuMbURCBMVeTePSJANMNGFDPEDMVKjCNDNAPIVQRQ@S\\KBMWMJQSSCAWGHOKGNQREGIDSPKKQBMDEKGAIF@@CECBCHAPADGHCIGBKK@BGLA@IEEMODKCKNONGLUXOAENGLGDGVARGGWGEHM@MM]aLyKBAr[BHEB@DA@@BAHALCBA@AB@@G@CA@CCCCA@ECAAAAAE@CAAAA@@CEAAAAAACACCA@@A@@BGDK@CBABC@C@A@AAC@AACAC@AAAACGEAA@@A@C@UAMBK@C@IA@@E@EA"]],encodeOffsets:[[[126429,52519]],[[127687,50879]]]}},{type:"Feature",id:"150725",properties:{name:"陈巴尔虎旗",cp:[119.424026,49.328916],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BA@@B@@BBABA@@BB@@BD@@BA@A@BBD@@@@B@B@@A@AA@BA@A@BBA@A@@@B@C@@BD@CBA@A@@BB@B@ABBB@@ABAABAA@ABA@A@@BB@@BA@AAA@A@@BB@@@AB@@BBBBA@A@@@@B@@@@AAA@@BBB@B@BB@@@CB@@@@B@B@AB@@B@BBA@@BB@@BB@BBA@ABB@C@CDA@@AA@CBBBA@CA@BFB@@E@UAC@mğÃq]¦KLItAD»ª@@AB²ťOYw@CD@QkG_MqMOKIQOiiMFaLQ_KH[WFBI@EFKBU@MCGBCBGAK@AE@C@ECA@AAIGIEWQIEeY@CA@£@ėknÏ^QCA§[¯}kOYKÑóĭř©Y}MÍ_{cMGm]{eGFdC@Å@{@³ó´¥V¾A@kÊµìsDJƄ@BVOHsFDRCBC@D^SŞgnAXhVADh@BJ¼[X@@AB@B@DADCBC@CBA@AB@B@BBBBB@@DBB@B@@@B@BABABAB@B@DABB@@@@@B@BA@ABCBABABAB@@CB@B@@@B@@@@B@@@BABADAD@@@B@D@B@BBABABE@CBCBADBB@@F@B@BA@ABAF@@@B@ABADBB@@B@B@BAB@BB@@@BABA@ABBB@@BBB@ABA@AAAAA@A@AB@B@FBB@B@@A@A@A@A@A@@B@@DBB@@@@BC@A@A@CBAB@DABABA@@B@@BBB@DBD@D@DB@@B@D@D@D@B@D@FADABABADAB@D@J@H@FBHBH@B@@BA@A@CB@BA@BBBBB@@BBB@@JD@@B@D@FAFAB@B@BB@@D@D@@@B@@A@@FA@@@@BH@@B@B@@@B@@@@CBA@@BCDABC@CBABAD@DBBBDBBB@@DBD@B@@@BB@@A@ABAB@BA@A@AACAA@@B@DB@BBFD@@BB@@@B@@CBAAA@A@A@@B@@B@DBFAF@B@B@ABCBAB@BB@DABCB@DADBDBBBB@@ABAD@@@@B@BADA@ABEBGBAB@@@B@@@@@B@F@BAB@BB@DBB@DBH@B@@B@BC@@@A@C@A@C@AB@DABA@ABA@BBDBD@BBB@B@D@@@B@@@@BA@A@C@CBAB@BB@DBD@@AB@DA@@B@@@@B@D@@BBBFBBB@H@@@BBB@DBBA@@B@DCD@HBHDDB@B@@@B@B@@BBHB@@BBB@@@A@@BA@A@ABA@@@@BB@@BF@@@@B@@ABCDCB@@@@BBB@FCB@@@@@@@AB@BABBB@@@BBBD@DBD@DAD@B@B@@ABAB@DBBB@BBB@@B@DBDBB@@@B@BAD@B@B@@@D@BA@C@@@@B@@@BBAB@B@@CBA@ADABCBC@AAA@AAA@A@@B@@@BB@BBF@B@BBD@BB@@BB@@B@@@@ABAD@B@B@BB@BCBAB@@A@AAA@A@AB@D@D@D@@BBABDBDBDBDBBBBBDDD@B@BA@AB@B@DB@BD@@A@ACA@ABAB@FBDBDDDDFBB@D@DAFEDCB@B@BB@BAD@B@B@@BB@@B@B@@ABA@CBCBABAB@BAB@BBB@@B@BABEBADAD@@@BB@@BB@F@F@DBH@BB@BA@EDGDAB@D@BBBB@DBDB@A@AAA@@ACB@B@BBB@@BB@B@BA@@@A@C@AD@B@FBD@FABADCDAFADBB@BABBB@BBB@B@@B@BADAB@BBD@B@BA@E@@B@@A@BB@@D@FBF@H@B@@A@@@@CC@@BA@@D@DB@B@B@BABCBC@A@@@@B@@B@D@D@DCB@DCFAB@BBB@@BABCBCBABB@B@B@HCFAFABBBBBB@BBA@@@AAC@A@AB@BBBBBDB@B@B@@A@A@ABAB@@BBB@@B@@A@A@ABBB@@BABADABAB@@@@BBD@B@BA@A@ABC@ADAB@BBB@D@@BA@CB@@BBB@F@DABEBAB@B@BBDBF@DAFAD@D@D@BB@DBBB@BABA@A@ABADAB@B@DBBBDDD@D@BA@AB@D@B@@B@B@D@BDBB@F@B@B@@BABAB@BBBB@D@D@BCB@BB@B@@B@@A@A@ABABAD@DBB@BAD@DAB@BBA@CD@@@BB@@@FAD@D@@@@@@A@@AA@@A@@AB@@@D@BB@B@@@BCB@BB@BB@ADAB@@@@B@@@@ABE@CB@@@B@@DBB@D@D@B@DBBB@B@@B@@AB@BA@B@@@B@@BBB@BBB@@BA@A@A@A@@@@@BBF@@@@@BA@AB@@@BB@B@@@@BAB@B@BBD@@@@@B@@A@A@@B@B@B@B@B@@CD@B@B@BBB@@@@@AAAA@@@@@AB@BBBBBB@@B@@@@AAA@A@@D@D@B@BA@@@A@AB@@BBB@DBBB@BAB@BBB@@@@AAAA@AA@A@@D@BBB@@DBBDBDDFBB@B@D@F@BBB@@@B@@AAA@@@AB@B@@BB@@D@BA@BB@@B@@@BABAB@B@@@B@@@BBA@AB@BB@@BB@B@F@F@B@@@@AAA@A@@B@B@B@@BBBBD@@ABA@C@CD@BBBD@F@DADABABAB@@A@@A@@AB@D@DBDDDB@@B@B@@@@C@A@@B@BB@B@B@B@@B@B@DCB@B@BBB@BADBB@BB@B@D@@B@B@BA@AB@D@BB@BABAB@@BBBADAB@BBCB@@@B@BD@B@BABAD@BBB@@BABA@@BBBD@DAF@B@B@BAAA@AB@B@BBB@B@@ABAB@B@D@B@@B@B@BA@@B@@B@B@BAD@@@DBBBBA@@@ACA@A@@@@B@B@DBFBB@BAB@B@@A@@@@@A@@@AB@B@@@B@@@BB@@@@@B@@@@A@@@AB@@A@@@@@@@AB@B@@@@@@B@B@DAB@D@F@D@DABC@AA@ABC@CBAAA@@@@@@ADAB@B@D@BABAB@BA@@@A@@B@B@B@BBBB@D@@BBB@B@BA@@@A@A@ABABAB@B@BBDBB@@@B@@AB@@@AA@@A@A@EAA@A@@@@A@@@@BAB@B@D@DBBBB@@B@B@@@D@@@@@@B@D@D@@@DBF@F@B@B@BBAB@@@B@@B@BCDADA@@BB@BBB@@B@BAB@B@@@BB@BBDBBBB@@B@BADABA@@AA@@@@B@B@BB@DABBB@@H@FA@@@A@AB@BBB@BA@CBA@C@ABAF@@@B@B@@BBD@BBBBBB@BBD@D@B@B@B@B@BB@@ABAB@@BBDBDBB@B@B@@ABABADAD@B@B@D@BB@@B@@@@A@@A@@A@@@A@@B@B@@AB@@@B@B@B@@@BB@@BBD@BBBB@@AB@BA@@BB@@@BBDB@B@@@B@@@BA@B@@@@@D@@@B@BBBBBBB@F@@@B@BD@B@@ABA@A@@@AB@@BBD@BADABC@@BAB@B@B@BD@B@BBDBB@D@@B@@@BA@@BCB@D@B@@B@@AD@@CBAB@D@@@B@DABBB@@@@B@B@B@BBBDB@DBB@BBAB@@B@F@D@DADCB@BBBBB@@@DBD@DB@@DB@BA@AB@@C@@@C@A@AA@@AAA@A@AB@@@B@BDBFBFBD@DAB@@@DA@AB@@@B@@@B@@ADA@@BA@ACAAA@@@@@AB@D@BB@@@DBBB@@BBBA@@BC@AB@DBB@B@BBBDB@@@BA@BB@@@@B@D@B@B@BB@@@BB@B@@@D@B@BA@@BB@@A@@B@@B@@@B@DA@@@B@@@@@BA@C@ABABABABB@@BB@DBBB@B@B@B@BB@@@B@J@@@@@BD@B@@@BA@AAC@A@A@@B@BA@ABAB@BB@BBD@B@DBBBB@BBAB@@BB@@B@D@BB@@@@@@@B@@CBCB@@EB@B@@@B@@@@@BB@FAB@@@B@@B@@ABAB@@@@@B@@@@@BA@@BB@BB@BB@@@@@ABABAB@B@BBBB@DBDADADAFCBABEBA@@B@@@@@DB@@B@D@@@HADAB@BBB@DD@BD@@@D@@C@CAA@@@AB@BAD@FAB@D@BBB@@@BB@D@B@@@@B@@B@@BAB@BC@AB@B@@B@@@BABCD@@AB@BBBB@DBB@@@@@ABA@A@@B@@BBHBBB@@@B@@FBDBD@B@@@B@HBB@@B@@A@E@AB@@@@@FBBBBB@FBHBF@@@B@@BABC@AB@@B@@BD@B@@@BBD@B@BB@BBBB@B@B@BA@A@A@@@@BAB@@@B@DBDBBDBBBBBBB@B@@A@C@@B@@B@B@B@BBBBBBBB@BBB@@@ABA@A@A@A@ABA@A@ABABBBHL@@@BDBBBBBBB@BA@@BAB@@@B@@BBB@B@B@BCBA@A@A@AB@@AB@B@B@B@@BBF@B@@AB@@@BA@AB@@@@@BDB@@B@BBBB@B@@@@@@@@CBAB@@ABA@AB@@A@@B@@B@DBBB@B@B@@ABAB@@@B@@HDB@B@B@@B@@CBEA@@@@@@@BBBF@F@D@D@BBB@BBH@F@FAB@D@BBBB@@@D@B@@BBB@B@BABCBABAB@BAD@DBBB@B@B@@LHŮnHNDEnHXPrbVNZLRPBBEH@KLTRbLRB@BAFAB@DDHBF@BBDAHAD@BAFCFCB@BDB@B@D@B@@B@BD@B@DCD@L@JCHAB@HEFAB@FBF@D@@C@GDCF@F@DB@BBFBBD@FBFAB@BADGBCD@D@FB@@AMPYLSNWXm@@UKkS@EH[@AxZaDA@@DATIB@¾s_ĂW`BzH JZBxbZAClLJBDj@JR@\\C~EBDBBJJBBBB\\NBBH@H@D@B@D@D@B@FCD@BBTPDBBDD@B@D@FCFCBAB@DDD@LBF@B@F@DADAD@FDHBDAF@B@D@NJJFHDFBTBDAB@DDDBBD@BBBBB@BD@NBBDBBFBDA@AFBPYz@vj¦L¦gHgTVXpNh[`uĘ@ADIDE@EIE@CBCAGBC@AACCE@EAICGYIEAEGGECAMCGCGEGAGECGBGBMYO@EGEAKGO@EEAMEA@ECAQCCaEQSCKCE@CCIGIKBQAG@ACCEMYGCFCHI@KDELMDMFEJEHCDCBGAKIEIIEEDIHELQKACCEICIBGCKIMCKICEAMBIABOCGECDMAKAGKE@G@K@C@M@AH@HINC\\@JGHMFEFGAEPICEBG@EBGDGFCCCa@OEI@KAEHQBKBCDU@G@IIIEGEEAAIBEHEECG@AIECEIOAECGBGE[GGBKBGEGCKAGDMDEAE@ICKFIAEAGCGEICGKG@GDSBMDEACA@@ICÕa¹ÓsAIO_spMBO@O[K[YavUzAAB@CI@C@F@@GEE@M@E@EBE@CA@CFADC@CACC@E@C@EBGFCAGDCBE@E@CAIAEAEACECAE@A@@EACGCCACCC@CBC@C@CCACBCCCC@C@A@A@@ABABAAAA@CBCBE@CBCAEAAA@CGACBC@A@GFC@CAAA@CCCAAEAGAEAGCICECCEICSCIAE@EAGCE@A@CABC@CA@A@MFAB@B@BHJ@@A@A@@B@@BB@BAB@B@B@@BBBB@@@B@@A@@B@@@@@BA@@@A@@B@@@BD@B@B@AB@B@@B@ABB@@BDBB@@@BAD@@@@@BB@@DA@B@B@@ABB@AB@@B@@@@@B@B@B@@BB@@@@B@@D@BB@B@@ABA@@B@@@BAA@BA@@DABCAA@C@@B@@@B@@@BA@@BA@@BA@E@A@@BA@@@@BB@AB@@@@BB@@A@A@@@AAA@@B@@BB@@@@A@ABAB@@@B@@B@@@@@@B@@@B@BB@B@@BA@@B@@AB@@ABA@CBA@@DAB@BBBABA@A@C@AAAB@@@DA@AAA@@@AA@AA@ABA@A@@BABAB@B@B@@AB@BABB@D@BBBBD@@BAB@BA@@@BBB@@BA@@BC@@B@@BB@B@@BBCB@@C@@AC@ADC@DBADBBBBB@B@DB@B@@@BB@D@@@CBB@B@BBA@@@@@@BDA@BB@@@@AB@@B@AB@D@@B@@B@@@@@@BDABB@B@@B@B@"],encodeOffsets:[[123270,50518]]}},{type:"Feature",id:"150727",properties:{name:"新巴尔虎右旗",cp:[116.82369,48.672101],childNum:1},geometry:{type:"Polygon",coordinates:["@@¨Ú ÌrÀPX²æ\\h®ÞªØvƄȐNRXQǖùĢ¥pUQ¾cºaWJCPEIkGUAA@A]{OaISSYKMjETERA@@BDDBDBD@^@\\JBf@AAh@`S@BDABGlk@@HGVUFGDAHW@@HOSWBAJUSrINC@@@@@@@@ÜwEI­JAzIMBBMWIS§Ł@@BOHJ¯PNefoB@AABC@@AA@@}×áBGAA@@tchsrgTevuDAMIHAGE@IESOQJ@@UEMDIHEIICILCJKEMUGEOIKNAIEJOI@@M@CH@@OJIHEIOKQMGAECE@IIEGKHIIEFE@O@IAIFCMCEMUG@@@@B@@CBG@ICIAEgCKICQHABKAGKGMQKKGQA@BAC@@GKCDCFBFMEIBG@ABAB@DA@@CCA@B@@A@ABCL@JCBGEIBKGC@EJCBCIEJAEEHCECHCCIFEAIJE@GHEAGNCB@DAP@BORI@GGCROX_KI@MQGBKJAACMG@AGIEEDIOADIGGLAAKDIJ@QMFINGNBAEHEQEDMZIGGHKLCGCMABGL@NKRABCBALEBGBKLGFHPAL@AKJ@FCHDHDEHF@FFJCJ@LEJ@BED@J@HKDAHEIEHOAENMBEPDGIHEHDDEH@HECEBCHDFCDBFBBCGEDELFBCFACGBCL@BCIINFDJB@BIAGCGAELDFEGCGA@CPBBCGEBE@@DEA@EEGCEEGGɣȁFSAGGGOESA_EğįǉĶWPxxEBƭ¶X½H]@W@OA×GEaGSÇ_ÑakMTŝ|ŕ«SKAAQIţĹ_G[Eå°ë²¹ÁÏ@@Ƹ@@A@A@@@A@@B@@@@BB@@@@ABA@A@A@ABABADAB@B@@@BB@BBDBDB@@B@@@@B@@@@@B@@@BB@@@D@@@B@@B@@@@@B@@AB@B@@B@B@@@BB@@BB@BBBA@@@AB@@@@@B@@BBB@@BBB@B@BA@ABA@@B@@ABA@@@@@AA@A@@A@@@@@AB@B@@A@C@C@EbĆÞzĜRDF@oɌFPLPT\\Z`@@NR|¤¨VXĜĦRX}¨¤Ęż"],encodeOffsets:[[118976,50255]]}},{type:"Feature",id:"150726",properties:{name:"新巴尔虎左旗",cp:[118.269819,48.218241],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AAAB@@@BA@@B@@@@@BABABABAB@BaqAAcOgIS@]SA@MAKJWJWN]D[TcMQ@IIQAQBICOYaWSEQIQ]aWmSI]JSEOK@SU[MQMQMYAQCIIUA]I_AGMMAGBGGADG@GCCDIEIBGGEICEGCDGGEAGICM@AIMEFIKCDEIC@EOCKGQEUBOGSCMBKDCIOEAFcAAAKMI@IAgCCDA@@@@BA@@@A@@BC@@@A@A@@@A@@@@A@@A@@@@B@@@@@@AB@@A@@B@@@@@BA@CBC@C@A@ABABA@ABA@A@A@AB@@@B@@@@BBB@@BB@@B@@@@@B@@@B@BA@A@@@AAA@AACAA@@@ABA@A@@BABA@@@B@BB@@@@@@@BA@A@@@@@@BBB@B@B@@@@CBA@@@@@@AA@@@@@A@@B@@@BA@A@@@@@@@@B@@@B@@@BB@A@@BA@@@@@@BB@@BA@A@ABA@@@AB@BB@B@B@B@BB@@@@@@@@@@@BA@ABABA@@BAB@BAB@BA@A@@BA@A@A@A@@BA@@@A@AAAAA@@@CAAAA@A@A@A@AB@BA@@BB@BBB@D@@B@@@B@@A@@BA@@BA@ABAB@@A@ABA@AA@@@@@A@@B@@A@@@@A@@AA@A@@@A@@AABA@@@A@@B@BA@@@BB@@@@BB@@@@@B@@A@@BA@ABABA@ABA@A@@@A@AA@AA@@@A@@@AB@@@@@B@B@@@@@B@@A@A@@B@@@@BB@@BB@@@B@@AB@@@@A@A@@@AA@@A@A@C@C@A@A@@@@@A@BB@@@B@@@@@BA@@@ABA@@BABA@ABA@A@ABA@A@AAA@A@@@A@A@@@@B@@@BB@BBDB@@B@@B@@@@AB@@@@A@AB@@A@@@@B@@@@@B@@@@@@AB@@A@@@A@@@@@ABAB@@@B@@@B@@B@@@@@@@B@@AB@@@BA@@@BB@@@@BA@@BA@AB@@ABAB@BA@A@CBA@AB@@A@A@A@AB@@A@A@A@@@A@@@A@A@@@@@@B@@@@@BB@B@BB@@@@BB@@@BA@ABA@@@A@CAA@@AA@A@@@A@@@A@@BAB@@A@@@A@@@@A@@@@@A@@@@@A@@A@@@A@@A@@AAB@@AA@@A@@A@A@@BC@A@@@A@@@@@@@@A@@DA@@@AA@E@@@@@@BB@B@@B@@AB@@@@@AA@AB@@A@A@@@@@AA@@@@AA@@AB@@A@@@@@@B@@@@@BA@@AA@@@@BPRƇgPmRWH@VUÒGETaĹĎŭƊsxoxQJ·FDM@@@@B@@B@BB@@@@CBC@CAA@AAAAAACAC@A@AB@BBF@D@B@BA@ABCAABA@ABABADC@CBABA@AB@B@DA@CBCAC@EC@@A@C@A@A@ABAD@@CBAAA@ACA@@@G@E@IAE@CBC@EBCBIBC@A@C@AACAA@C@AAA@@A@@BC@A@@AAAAA@ABABABCBA@AAAA@AAAAAA@C@A@ADA@C@G@C@A@CACAC@C@@@CBE@C@EBCBCDCBC@A@AACC@@@C@@AAAAG@E@A@A@ABADABA@ABA@AAA@A@CBCBC@AACAAAA@A@AB@B@@DD@B@DABCBCBC@C@CBAB@@ABBB@B@BABA@C@A@CCA@CAA@ABAB@@@D@@ABA@@BCA@@ABEDA@A@C@A@CB@@ABB@BD@B@D@BBDAB@BA@EBEBC@C@A@AAAAC@C@GAEBE@C@CBABA@CBC@ICIAE@C@A@@BAF@BABCDA@CBC@C@AAA@CAAAAAAAC@A@C@C@A@CAA@@AAC@AAA@@AAAAA@CAC@A@A@EB@@C@@@AA@AA@@A@@A@@@A@@BA@@@ABA@A@A@@@@@@@@B@@AB@@A@A@@BA@@@@BB@@B@@AB@@C@ABA@AB@@@D@@ABA@A@@@@@A@@@AAA@@A@@@A@@BA@@@A@@@A@@@@A@A@A@@BA@@@@B@@@B@@@@A@@@A@@@A@@@@@A@@A@@@AA@@@A@@A@A@A@@@AAAA@@C@@AAA@@A@@BA@@B@@A@@AA@@A@ABABA@A@A@A@A@ABA@@@@B@BB@B@B@@@@B@@@@@BA@A@@@AAA@AAA@@@AA@@BAB@@A@@@A@@@A@@@@A@@AA@@@A@A@A@@@C@AAA@A@@AA@A@A@@@@@@B@@AB@@ABA@A@@@@AA@BA@A@@@A@AA@A@@AAB@@ABA@@@A@AA@@A@C@A@@@@@@AAA@@AAAA@@A@@@A@@@ADA@A@A@@@A@AA@A@ABA@AA@AA@@A@C@A@ABA@@@@B@BA@ABA@@AA@AC@@BA@@BAB@B@@@BA@AB@@AA@AAA@C@A@ABA@CBA@A@EAC@A@@@A@AAA@A@A@@@ABABA@A@@@AACAA@A@A@A@AA@AA@@@A@@@A@@@@BBDB@AB@@A@A@C@A@AA@AAC@@AAA@ABA@@BAB@@A@A@A@AA@@AA@A@@@@AAABA@A@@B@BA@A@@@A@A@A@A@@B@@FD@@@B@@A@A@EAA@C@@BCBAB@@A@@AAA@@@AA@A@A@C@@@A@@@A@@A@AA@A@@@AB@@AB@BA@A@A@A@@@C@A@AA@@@A@@BAB@@@@A@@AAA@A@A@A@A@A@ABA@CB@@C@A@ABA@@BA@@B@BA@A@A@CAA@@@A@A@A@A@@@AAA@@@A@AB@@@B@@@BA@A@@@AA@@@AA@AA@BA@A@@@A@@BBB@B@@@BCBABABC@@BAAA@AA@@@@@A@AA@@@@@@B@B@@AB@@A@A@AA@@@A@@AAA@CB@@AAA@A@A@A@@@@BBD@B@@ABA@C@A@A@A@@@AA@A@@A@A@@@A@@B@@BBB@BB@@@B@@@B@@A@@@C@MEaCwEOC_AMACCCF@@AFHFADOA@DHBHDEFKCBFDHBHAJA@CIMEJJADK@ADDHEBADKECFHFADEACAEDGCADDFGFG@CFGCGFHJOCAFMNBFGPJFGFCBGLI@C@AFI@KFI@IDEEE@FGGCGCEDI@BLK@OBEGKHALAHKFABADQBMLK@AHNBHDKDGLHHYJCNRFGFBFMAMHEJRNI@CJBLKBHHCJPBCJFFHJ@BNHBDIBALRH@NLJW`QPHD@HQJAPO@CBA@MDBHGF@HIFBJEFDJGDFDGDFFIBJFADID@FHDALFJAHIDK@AD@B@BA@B@DD@@CBA@AB@BAHFJENEACDLD@HD@ABB@HRLLNRLHBHALGBDRLJhDBFDJ@JAH@DA@@@@@VHFNNDEDBJ@J@PEFJFGJHLJF@JDFBFNHLRJPGFIJ@PG@@D@NJ@IPJFMBJLFPVHFNILKDDJJJGFCJFN@VI@PRFT@JHFGBNJCBuvSfqhgtsd@@BBAHØâ~@@BB@@ADBBA@epMfOI°GAP@@¨łJTNX@@¨JDÀ²NJv]@@@tQHSLGV@@ªRH@XACLQDUFILKF@JMDBFVDDLSGIHTPOZ@@m@B@@@@BBBBDBFDFDDBAB@BA@A@C@C@AB@@@BDBFBD@@BBBAB@BA@@BABBBBBFDHBFBFBDBBDBD@B@@C@A@ABBB@D@@ADBBBBBBBB@B@B@DABBBBBD@D@FDD@F@BBDBB@B@BABAB@B@B@F@DBBBB@BAD@@@DBB@D@BAB@BABABAB@@BB@BBB@@B@BB@@@B@B@BCB@B@B@BB@@@B@B@BD@BBB@B@@AAA@C@@DABBB@A@@BABBBB@B@B@BAB@B@@B@B@@BBBA@@BCD@D@D@BAD@D@B@DAB@@@B@@B@D@@B@BBD@D@B@B@@BA@ABA@@B@BB@DBB@BB@B@BA@AAEAAAA@A@A@@@@B@BB@@B@B@@@B@@B@@BB@B@D@@@DBB@DB@@B@DAD@B@B@BBB@B@BA@@BBBBB@BBB@BB@B@B@@@B@B@BBBB@D@B@B@DBBBB@BA@@@A@A@@B@B@BBBBDBB@@@D@@AB@BA@AAAAA@A@AB@D@B@B@@@A@AA@@BABBDBBBB@@@BA@ADAB@BB@@BB@BBABADA@@D@H@H@JAH@B@B@DD@@B@@AB@@ADADAD@B@B@D@BBB@DAB@DB@@@D@B@BCD@@@BBBDBD@D@@ABA@@BCBAFCD@J@F@FDDBBD@@AD@@@BD@FD@@BB@D@B@@B@BBB@BBBD@B@@A@@@A@A@A@@@@@@B@@B@DDDBBBB@BAD@DAFBBBBD@DABAB@@AB@D@BBBJ@DAFAD@BBBD@BCDEDC@@B@@@BB@DAD@D@BB@D@BCD@B@BADBBB@BBD@@B@B@@E@A@CBA@ADB@B@D@DAHAJAPGHCB@B@DB@@D@D@@@BBDA@A@@BCDAD@DBDBBBBB@@A@AB@B@B@@BBB@B@BA@ADEBCD@B@DA@BB@@B@@CBABAB@B@BBFB@BBBBHBFBBB@DBB@BABCD@@@B@@BBB@DB@B@BB@@BB@DADABA@C@A@A@@B@B@B@@BBDBBB@@@FBB@B@BABA@@B@B@B@@BB@@BAB@@@BB@B@BBDBBB@B@D@B@F@@BDBB@@BBHBB@BB@@ABA@ABA@ABA@@BB@@B@@EBAB@BBBDB@@HB@@@@@B@BBB@@BBDAD@BAD@B@B@D@B@@BBB@B@B@B@B@BBBB@@B@@@@A@@@CAC@CBC@@B@BBBH@B@DB@@@BA@ABA@AAEAEACA@BAB@BBDFDB@D@D@BADADAB@B@F@D@BB@BABCBEBA@AB@@BB@@DBD@FBD@DAFABCB@@@BBBB@@BF@@DBBBH@BBD@B@D@F@B@B@H@J@D@D@FBDBFBDDDBHD@@@@@B@@E@C@AD@BDBBBB@@@B@@CBAD@BBB@@BAD@@@B@B@@DBB@@@B@D@B@@@BBDD@B@@@B@@B@B@BB@@@@@B@@@BA@@BAB@BAB@@@B@@B@@@BAB@@AB@B@@AB@B@D@D@D@DC@ABAB@@@BB@BB@@@BA@AB@B@B@D@BB@B@BAB@B@BBBBBB@D@F@BBD@BB@BB@BBD@F@@@@@FAFAD@BBBDBBD@D@FADBF@DDDBB@B@@C@ABAF@@BB@BA@@\\WI»@ACgBgUBWhmTŝC]D@DACQtEPGAU@IƃCt¶ëÉlB@U½¦ô³´|@Æ@D@cHE@@RM@AACCAABICACEBE@CCMAGGCCCAE@ACACCBICEABCCEIC@@ACEBACBAACCBDIEABBE@BAECICACE@BAAAFC@GE@FCH@DBBFF@HAJEJC@IFEHAAA@KJAMIEK@GF@EC@CBEHAD@ACCAACB@@CDADBACBED@BEACGCGABABA@ABCCABAFACCEDM@I@EC@CDADCBAF@DABABABAF@@CF@AAACD@@CB@BBHC@@BAFAJBFBJEFGDGAGFA@CDCFEECD@D@BAFCAG@EEAAEFCDCCIJCDEHEE@ACDCLCAC@@DCJBEGFCJGJ@DCF@@CNC@EHCLIFD@CBCIABCEAF@EEFGE@BCEAGBEA@CE@ECICECKADACCDAAED@DCDAF@@CDCCGBAEEG@BEC@AAC@EAEAFACC@CDCI@FCEBCAACCAD@CCH@AEIIE@BCDAC@BAEEHCCAFG@AB@FC@AFABEDCCABADEB@B@@@@@@AHCCAHCBCBEL@AEHDBCF@BBBEFG@EHCJEVQJABB@ADADA@AHB@CACBAHCBBBAAABAF@@AA@CAD@CCF@CAFADBFEJABA@AC@AAHAFBAADA@@@BFD@C@AD@D@GCH@CABADBDAEADADBBCCAF@@DHEB@BAE@@CD@@CH@BBFCG@BCFDDECABAHB@A@AC@AAJ@@CEBAALC@@G@ACPBCED@DEH@EFFD@EHAJABDFDBAAAFAEABAH@DAJ@DAFA@E@AB@AIFCD@AGBAA@PABA@BFABABB@@D@@BD@BAFBB@B@BANAHBD@D@D@B@D@DA@B@A@ABADBDA@ABABBBADBBAC@DAB@DA|QB@@AFBJ@BCIABAFBDMBECED@FB@ADABDDB@EJAFB@DFCGCDADDDAGED@F@@AEAJK@ADAD@@BCBDBB@AAHAD@D@BEC@DCDB@FB@BAAAD@D@DAAAC@BACCBCLB@CBCDBD@ABBBB@@AD@BBABD@FCA@C@AAAEDCDB@BBBDABAC@ACA@E@AAB@@ADAHBB@@AAAABCA@CDAD@@BHB@@FBFCDAAAD@BABAAA@@@CACB@BA@@BCF@ACAAB@FCFB@AF@BABBA@FDAAF@@GHACEH@@AA@CAFA@AE@BAFBBCIAH@GCBACAC@@ADACADCGABAHA@AIBDCFAAAGB@CFAACB@JACA@AD@@CAEBACCBABBD@@BB@@ABAAAC@BCEA@CFB@AJ@DA@AG@@BC@@CFAAABC@BDBBAECBCFBHCBBDCC@DA@AC@ECEADADBB@AACCDABD@AD@DACCH@AAEA@CDAEAFACABAFB@AEABCDBBCDBBBF@@A@EB@D@B@BB@FF@@DFADBFAFBBAD@BCCEDANBBBEBDDDBBAJAFGC@AEBCHGFAH@FBRB@CB@FFDCECFACABAH@BBF@BBBACCHCACLGDDB@@BD@DBDACEC@@AEADAFBBBBACABAD@BBBBB@@EFDBEICNB@IJCNC@@DEDAD@BBFAAAH@FHDBHACADACCFA@DDABGF@DBBD@AB@@BD@@CD@@AG@@ADABADAC@@CDBD@D@AADABBDFB@@GBCGB@A@CDBDBBCAAFA@HFEFCJFAC@CDABFHARF@GFCDGHBGGDAJBGCNAHHLBF@BABBD@@ADAJBACF@AAF@CCBAD@FCFBAFF@@DFCECL@PALEFHFCD@@DDBACHDCB@DDB@CDABDB@@CDABHFAECJADABAA@BCFBF@ACH@@DDAACBA@BLAHBHDNCAABADBABBFD@@CC@FGDBFBCBHBACDAFADDAEH@@DFBDBDBB@D@ECD@HAF@D@DCFDH@BFBDNCDCBBFABABCD@HEHBHDRF@DD@P@BGP@CADCDBD@LHDFBABEHBDAC@FACCHCJ@BCBDF@@@CBJHABFBDCD@BABBB@@AD@DEDDFACEC@@CBABBB@@ABABDF@ABFAADABDBBAJEBBF@CEFEDBBDCHFDBGBCLB@DAFC@@BLBCEBCHB@BDBFBBBCBHB@CCEJBPABBEDDDDBHGB@BCD@@EFBBBHBFDFCCCBEACD@FBFDF@BCCAHAEADADBBACADACCC@BCBB@EDADDBCDABBDADBFCBBBBA@@BBBBBEBBBDBDEDDFAFD@BA@D@DBABDAAEHCNBDDBAA@@AB@B@DB@@@BF@@DDCLBFAB@DBDBD@EADADBDB@C@@@ALBNAA@BAFADABBFCAC@CDAA@DCA@C@ACADC@BEGCFAAC@EDEFC@CDAECBE@@@ABCB@@C@CBAD@AAHEAEDGDCH@@CFA@ACCDADB@CC@@CHAACBEBAAC@CEA@CC@@CDACABCHEDAD@BAA@@CA@@AB@@IHCACDECEBAD@BEFBAAB@AAD@DA@AB@@AA@AAAABAAADELIFAB@BABADAA@HC@AFCE@HAD@BCACFAFIHCCEFC@ABEB@DAB@CCDAD@@AAABC@CD@DIFCHMJIBADA@ECA@CDB@E@@AC@@BAACBADAFC@AA@CEFCFAFKEAFGPEHGFA@ALGBCF@@GDA@CHEC@DKD@B@ACBAA@@CB@ACB@@CJGLCD@JC@ABAAADAIIEKDGAAACDCNG@BHE@IHCCGDGB@B@ECBED@@CABDGDCF@AEJCBGBEFG@EDCEADCCCBC@CDAAADCFABCDB@ACAHG@AC@@CD@AAA@@CACBA\\CJAPCJ@BBHAAADABBHAF@BCJKREHEFGFI@C@@BADADADAJCJ@DABCB@@C@CAAAACCCOAAAAAAA@C@@@EBADCDABCFCBEBAAA@CCACCC@ABCGEAA@CAC@AA@GEAAC@EAG@EAEAC@EBC@C@C@ABABA@CAAACACAAACCEAC@C@IA@@C@AACAA@AAC@C@I@EAAA@ACCA@"],encodeOffsets:[[122993,48737]]}},{type:"Feature",id:"150781",properties:{name:"满洲里市",cp:[117.378529,49.597841],childNum:1},geometry:{type:"Polygon",coordinates:["@@¿±ICADABCDCDABCJABADEBQLAB@B@BABB@B@DBDBF@FBFBHDFDDDBB@D@B@BB@@DBBBD@FBBD@BBBBB@DDBB@BB@B@DF@D@D@B@@BB@F@B@FA@@B@@ABEBEBGBABEFCDIJADBBFFHHDDBB@D@@@@BD@BFRFX@@CD@@CBC@GBM@CBGDEAA@CAKJIBA@IBIBWFgRG`G@S@MCLMTSNEJA@@@@IEBAEA@@MHSJGFCJ±dÁYSEWklAHCB@A_Tg@BBe@A[I]@C@CACAAC@@QBSFiFLNTZJTPb^|@BBBHVJl¤A\\@¾A¼AD@Z@P@ZItS¨eYdO\\I¤[ĺD@@@B@D@D@B@@BB@@BBB@@A@A@A@@@A@@BABBB@B@@B@DBB@@B@@@BA@A@@AA@A@@B@D@BB@B@J@BFBBD@@B@BD@FBB@B@BBBBB@B@FB@@n@@PYSOJGTHCKUCAENC@ILEJKVERCDKWBG@©Q@@HUTKRG@s@@u^MI"],encodeOffsets:[[120650,50592]]}},{type:"Feature",id:"150782",properties:{name:"牙克石市",cp:[120.711775,49.285629],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@EAGAA@GAACCC@GAI@@LCBEEC@EBC@A@CFACEAIG@@@BIBGJIFGBOE@GEDGHGTELGHCECG@BCHIJCPGRCFAFEFEDEFAFCAGFCBGFGAACEKGECAEAGAIECM@GGGAE@MCMAMAIDODEFOHeDQAKIKCOECBG@@EKAEEKGMAWFCEKIIAMDOBGCC@GAIFGFIBADIFICO@I@ADO@AGBE@@CACGGAACSK@IIAE@I@GGMAOQWG@CGGOBQA@CFEACDI@ADG@]@CICIAK@I@@GGUOAOACEGKCEIC[@CFKDKAMGMIGIMAGCIDUHO@ACG@GCI@CCCGSAKCACIGAKECA@SBIBKBKCIAECCCIDGDGAMGI@EHGDI@IJQDCLADOBKDG@EBQDKHOD@HDDEDCH@DFBADEP@JBDFF@NDDHDCFODCFFHHHIDAFQBCLDH@BBLBHDNEF@NHDJBDBJ@LCHDNAFDDFXJBFJDDFHBDCJGLGNAX@^AHADHFDL@NFHBL@LAPAHEJADCHCB@HFFBFHBFF@TJDF@HHFFFAHDBJFFDDFDBTBBDDDAFCF@DLDVNNPHNBB@HF@DJHBFHCDBFJDDJFHNF@BGBAHAFHDBD@BFF@HHH@BADeLGDMEQ@KEIBMBGCGBIAI@KDEFAHABCF@F@DA@GDEFGLBFCH@FCD@DIFGF@J@DKBDBFB@@NAFAFGJBHFDFJDDHFBBFEHMDOBCFBHAHIHO@GAICED@F@JGDKH@FBHBFFFHBDFBFBFIFIDAJBBJRLHDF@DGFBFCFEFF@BFHDFFF@J@JFJBN@FAHBFADCHEJARCLBLMRDDJLBHDAFFHBJJFHFJ@FHCDA@CHJDDFNBPHJHN@FCP@VDRAHCB@CDBD@JFHFDRBJDFBFDLDNBDF@@BBCDDFFDHBHACFIHFHDJBHIHQ@CJ@B@HSBEHBF@JED@JCHBJHFFPGBAHEPHDLD@HEF@DNHFLCLFHDLCHRDTE^JPPPBPPLDLDJBJIN@BBFHPJRB@FBLFFBLEHNP^NJBDBANIFM@@@CHEH@@FHSJQZILJDFNHDBJGJKNCL@FGFGNA@A@KICGACOEMBICMDI@KFG@QD@DQ@KAOBOFGDE@EBIDOAOAACEBKDIBIEMKKCMKQAICCFOJOCOBIHQJKFGCMA@HCFBJCJOCWDMC@FJHBHDBEH@HFDAJFDHDFH@HWNCD@HBDMJ@JODAB@D@H@BHHDFBDSFIFDDFJDJCD@J@DDJDHBHEBGNDF@JIJEF@HDJALCDHHJJFFFLDBNDF@EF@HBFBJBDDJDL@JC@MDMNKDHBLJFL@DGBEL@B@@@B@@@DJHNDFFJNFFFH@F@FFL@LDF@FAFEBC@OAUDIBIDAFCDKJ@DDJAJIHGDIDMAG@QNMDOJMDGFIFPBRBHDFDBLBDDFFFNBJFJBDDLHJFADFDAB@JFBFDPLK@CF@FE@QDEDICMFACGFEBFD@DCDCFHD@DHFDHAHHHCF@BAHDFJHBJBFOCGBEHQHIBCLAL@@ABKHEJMAGHI@OCEJA@@B@FSC[BGHIJABFD@JFJFFBBNNFD@JCBIFUD@LMFBDPHCDCFCHBHKBEBCFGFEHC@CBBRH@JBAFDDJDF@BBIJ@F@BK@CHIDKBOBGAIBIDO@GBAFBFLFHFALIBMJGF@FNDBDFHAFGFCDG@KDGHAFCDCFKF@HKFCDCDMCI@MDGDEDEDDNGL@DAF@BIBAJCJDBJFFRCJAF@DDBBBNHABCBGD@DGBKDGF@@DFB@H@@DJDHFB@BBCH@BCFAJALKBSF@B@DDJA@BByuVZbL\\\\@PAPoN`tJPtBºÔbÖJD@@DBFBNCTAHCH@HLJDHFHDFBJBLEJDF@FBNCHCLBHDHFLAHA\\HHFHAFDPBFJFDBJH@FDGFAFBJFBHFJFJJH@V@DCLARAFGLBJ@PFb@DDEDCHAH@FAHDFOJBFEHEFGNIH[@MDGJG@@B@N@D@L@HLFBHBLCNFDDHAPJBNAFBJDDLJNDLAHDJFJDDLBKRGFCJFFJJJFBLAHCDGDIFEFCNKNCF@LGJEDHDNZDFBDH@RBLAHJDJ@DDFDLRTbFDDBRFDB@NFFB@FHPBLHF@FZPANAHDHHFHBHFHDNDDBHFFHFBZJDHBJ@FDFBD@BADBHAD@DJF@FCFCJ@BCH@FBFDBLFHHA@GJD`LJKHEHQHINQBCP@DDDABGDIBI@KF@JCFEBBJDDFDAHEF@DAFA@GJENMDEBGHBDFJDBDFBFGDKN@DiOThFJ@B@B@@GJAHGRIVEL@B@BA@ABAB@B@BBB@B@B@@B@B@DBBB@BDB@B@@JDB@BBB@DBHBDBBB@@BBB@@B@@AB@@@BA@@BB@BBB@@@BD@@BBAD@DB@@BBF@@@BA@AD@BBB@B@BADA@@D@@ABCB@@BF@B@@CBCBCD@BBBBB@@@BCD@@A@CB@@AJ@BA@ABG@@@@BAD@BGFGLCFEJCDCBAB@@C@C@EBC@@@@B@@DBBD@@@B@@CBABCFEDCBABCFAD@DBJ@@ABC@CBEB@@@BABA@CDE@CBCBCDCBA@CBHFBBJL@B@@KJA@A@CBKBC@E@A@A@A@CACAA@G@A@A@@BABAB@@ABEBA@CHA@@D@@ABC@ABA@GH@@A@E@ABA@ABEHAB@BBBD@BBJF@BA@@BABCBGDA@ABCACBABABAD@B@@ABABA@@BCBA@AB@@@BADAB@@C@A@@D@DF@LCN@JFJFAFBDREXHfJHA@CDCLDLFFFHBLAHHEHBDBDZIH@HBDHHHHDADE@IFDDH@@FCHAFDDHAHFJDDDFCD@FHHBFAPAH@ABAFF@F@D@BFDDAFJBFAFCBCB@\\KBAHARKXB@@RBXENBHAB@DB@@HFJ@DBFBLDNBLLTFDFADBFCFbL@BDHJFL@TCJCJHLDRITJL@LDDBHBBFCNjPHFDHFFL@PFZ@JBNFJ@XHFAF@HENFTHDJAHTNN@bJH@TC^AJD\\@VFFBL@RGP@VDJH@@@DFJHDHF@FBBAF@PARAHHBP@FHAJDHFDDF@H@@@BBDBB@FFBD@NEZBD@DBDFDDFBHBN@JP@@BBB@JCFAD@DBJAHDF@H@F@DAD@HHJ@HAV@RBDFLHJDFFBDDFXFR@JCJFDHDDHDD@F@@DD@FDAFBNBBBF@DCFAD@@ABJHHBBBAJDBPDJJPB@@DHHJH@JBLBJH@BBBFBF@B@FCD@B@F@FADJD@BBNFHBHDDHFDTBFAHALDJBDJ@FJ@D@DFFBNBFDL@LADAF@FCFEBCACBCN@JCVCPGTCD@DBDDBDBBJBBDHBBD@BDBFBFBFAAB@BDBHDDBDD@BBFBHDHNDHAN@HBDBB@L@DBFBD@DDDBBBAFEJBFJDBBHDBBFBHDDBLHBH@DAHBBFDBDB@DAD@LDDBDAPCNATKfODAJ@F@DGFMFCfAJDHDTFPBFDFHDDFDPB^JDDJDLBFDD@DCN@DBFHL@B@BANOL@RAFDJDH@P@FDD@D@FC@EAIFIHMBGNBPCHIHKAOEGJIBMAGDICIIGCECCBA@ABC@A@AA@CCA@AACKEEACCACCCAAABG@C@A@AAAA@GAA@A@@A@@AA@C@C@ABADEBABA@ABEAA@@CAAACA@@ACACCECC@A@G@AAAAEAA@AAAACAA@EAI@AAA@@ADC@ADC@EBIBE@A@E@QCA@CCEEAAAAAAIGAAAA@A@A@C@AA@AAA@ECGAAACCCACAAA@G@EAG@C@AACAG@A@C@A@@@A@AA@CCCCCAAA@@ECA@ECAAGCAACAAAAACCEE@CACAG@CDE@A@A@A@A@@ACCACCCAA@EACCCA@AAACCEC@A@@BA@@HCBAFABCB@@AB@B@NDBBD@B@D@LABABAFEBAB@B@D@D@B@B@N@B@DBD@D@F@D@D@BA@@BA@ABADCDABCDAB@B@D@F@DAF@DAB@BA@AACBCBCBABABAD@DAFABADCBCBC@CBC@ABADA@ADC@A@A@AAACCCAAA@@@ABA@C@C@A@A@A@AAACC@@AACAAACA@A@@FC@CBCBA@C@C@@@AACAAA@AA@A@A@@BAD@JALAF@HBD@B@B@BADABA@ABC@A@@N@DAFAB@@ABIA@@AAACAEAAA@CAA@@AA@A@AAA@A@AAAAE@@BA@@BA@@B@D@B@FAB@BA@@@@@A@ABADC@@BAA@AAA@AAA@AAA@A@BAB@DADADABAFEDA@GBAJGJABA@@@AAAACIGCAAAA@AAAA@EAA@AAAAACAEECAA@OEC@A@A@CBC@A@C@@@C@ECA@AA@ABA@ABA@AAA@AAAAACCEECA@A@A@@B@BAHBBA@@@@@AAACAAA@AKAAAAA@A@@BABABC@@@AB@BC@A@AA@@@A@A@I@IBC@EBE@A@@@EEA@AA@@A@A@A@C@A@A@CAC@@@AAAAKK@AAA@AAAAAEAI@A@AAA@GBCAA@A@AAAA@@AA@A@@@A@@@@@@IIBIGCAG@IIEMAQAKBIEMEAISDMCUEKJSFEHKDEHKGS@ODMBIBHHBFDFGJOHGDKAGAMCI@KBIEIBAHE@WAOGSGGCCIIFKCI@KCO@ANAHELIFK@AHMLAAECOMOEGAEMAIBK@IEU[GIACEDE@GBC@GJIMEECMEIGW@CIGGIK@@DGLMP@JGDEAIFABGXIF@lSLB\\KDECCBIIWAKDEEKEI@A@I@KRC\\A\\C`AHIVARCH@\\GRIDGJKB@DKAMDKN@DIFEBIIIQKGCBIAECCAEJKCAIEIEDKACAGAEICIGE@SEOCCA@IBECCCGJAJELKCACECEGCADMIEEKAGBOCKEG@@AJEDGKQSASIICICEGEIAGHCNGJKPEB@FDHAFGFBTCLDNFJAJAFAAECIXCL@LAXDPCNCNFFD^BNNHJERBDLFFFP@JABGLEDIDEJDJBJMD@JGJAJ@HDJ@NEHEDQNK@CJEJIHCPIDGPIHAJCFDHBHH@F@FRJP@JDBHJJHNLFBHNHNHLJHDRA@@PJLLLHLDNCFD@FBHHDHLFFLFJ@DBFCH@LGF@LOHCHM@GHEDCBG@CLG\\BPAHDRLF@DFAHFDNAJDHADDNDBHFDFDFFD@HEHCJ@LCBEHBN@VIAIACPCN@JBJIAMCCIIGAACDCHKJEBICIBGCMFGAGKCI@GGMCAGIMCAESIIDWAC@KAC@ECAAGAEQDIACCIGCGAOJG@A@A@K@I@EDGQQGCGCBC@APGCICEDCAGBGFCH@JCHEGKEG@EHIHEBGDCJGGCIKKCEIEAGISDSDSCEGEIOAWBSFSFCHEDK@KQCIGIBEDIEKS@MBGCGECICGDKCGGCCIG@KDGHKGQIBACG@EDIEEAGGMSEM@OEYCEGFIFG@GMMGEGEDGHGR@J@JMGBMCKMQBEGIC[@GDEAAI@E@EJAAGSBEIMAI@GIMAGCKOAEIIIGE@AFADIHOJKFBHMLGLEGSDS@GEKEGDS@KCEGEIKEGCAEA[FCQAKAEKMMMAGCMEIEGEMDAJMNADGHMFQCMFGASFKJ[@QAQEMHIJ]GOBGCKAGEI@QJI@SHQCEIM@QJ@BOCEIIC@KDGGKKICIBKD@LDRBRKRILKNENEBGBGHGBG@GHKJA@K@IL@JCAEFC@GDUJCHEEQKOLICC@E@ENALEPDN@JMAKDKBGLGBEEKFAFECMHQAENELCBKVGVOTARGVGLKRCCKIEPWDGAGBIGGDO@KDGRIPGJ@JG@GNCLBCKBQLADENEJDNDP@LSEIDIEKOMMQBIEIAM@MAEKCCEIGIEOAQAMEECLENKJE^BN@NGJGF@J@DGJENGGGAIMKCKECGIICE@CE@EEEAGDABG@ICGACBEFGCMGGTMXELCRGZAVBGMAGFAHEKICEBEPCJAL@BABIJEBG@IHEHGFUICGEAGA@MAMCOAIBGCACD@JEBIDOFKJ@CEDGAETCJCCGCIAAHKNI@GEGPCF@JBPEFBD@LF\\BFENIFGBGNEHCBIBCEOGCFIRAF@DQNK@GAMRGHG@EBCBGDELGJIEIBECSECBCACCEFSHILEBIDELABDPAHENELA@AJCPCF@PBJAJCJEDGDDNFNBN@JDHEFGDGRAHCDIJ@@CLGHGXADDHAD@JDRAFGBEVCBDDFHHDHDBNBJ@FEDEJEH@HEDBNAHFHDNIJAJANDVAPAHMBEFA@C@E@CICCGFAIGACHGFCI@S@ICCCBEECCEGC@ADGBKJ@JG@E@ICEFI@ICOIBOEEEAIDCHGAK@@EKBEFECGCGIEEEBGDICE@EEEKCCGEAGCE@EEGCDAFE@G@@ACEEEGEAHIFCHAIOFE@EAICGGA@AHKDEHCAI@CFGDC@GAGAIBCACDA@GHK@GFEHGHODI@AAEEICG@@AA@@CAA@C@A@E@A@AA@@CAAAA@A@C@E@C@A@CBGBA@A@A@A@K@E@@@IB@@CBA@A@A@EA@@@AA@@CBA@ABC@@@AEAMCAA@@A@A@E@E@C@A@A@CACAA@AACA@A@A@CBA@A@@@@CA@@@A@A@@AC@AA@@A@CBAB@B@BAFC@@@@CEA@@AAACA@AAAA@EGAAAAC@@@@A@@BA@AAAAAAAAA@@A@CE@@@G@AA@@@@CB@BABA@@@A@AAE@A@A@@@A@@@AAA@@AAA@AAAAA@EAACC@@@A@EBA@M@A@AC@A@@@AAAAAA@A@AA@@GACA@@AA@@@A@A@A@A@AA@@@@A@AAA@@C@CCCAA@@@AAA@@@@AB@AAA@EAACAC@@AAQC[AIBCDIACEGAACIAK@@BBBKHIHOBEDIAMCKIMEEEBGGGECMCI@GBADKAGA@@ACOCCCCDKBBBCFQ@WFMAI@AEEDAAGCC@KAEBI@AAEAAEKDCAGIEAKBG@GB@DGFG@ECGFG@CDE@CDCACH@@@BWLCBMAIDEFIHECSBODG@IC@@CGSDMGM@CGCCIBMEGGEBGCQDGBGB@FE@EBIDA@KFC@CFAJGBE@MDADIB@DGDI@QEIBE@EBKAEDIACDCFABMACFKAE@GBEFE@EDQHM@IJMHEHQFE@CBEDGBMHEFI@EBKAEBIDA@IAMAAAQ@EAK@ACIAOBGDKBABC@Q@QCGAGBMC_AMC]ICEBAKQC@GEGGAMDE@E@CHEAA@IMKGCECE@WEIOKIEEBC@ABGJEHC@IGAKUJG@QAKEE@CJKDGFAAIBGOCAACAA@AAA@B@A@@EBCDGBGEEQEKEO@EAEAMACEKEG@AEQGKEIBUDOCK@SGEBU@MEKBC@A@SCGAQG@@C@GGG@EEEGAAEIIBEEKGEBICQAEBKAICCCI@CEAG@@Q@CCA@E@GAE@GECCMCEGG@@G"],encodeOffsets:[[124153,49126]]}},{type:"Feature",id:"150783",properties:{name:"扎兰屯市",cp:[122.737467,48.013733],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@QBSEGBKAEGGBQRKDSFELULCACBGAGDKACBQCEEE@EBCABEI@ECCEE@WCCHG@GCGFQBGEWBSCECBGKIQGCIMIICECAACA@@@A@@CAGAAAA@@AA@C@ABC@A@GAA@C@AA@@AA@@@AB@B@@@@A@@A@CAA@@A@@BA@@@A@@@AA@A@@@A@E@C@@@AAEC@@@A@A@@@A@@@@@AA@@@E@E@E@A@A@@@A@@AC@A@C@AA@@A@DA@@@@@@AAA@@@CBA@E@EB@@@@A@A@A@@BAB@@ABC@A@C@A@C@@@AAC@C@AA@AAA@@@@@A@@@C@@A@C@@AIAA@A@CBA@@@@ACCA@@A@@ACA@A@ABA@GAA@A@ABA@GB@@AA@A@@B@@A@@AAEAKAGAQCAAA@@BA@@BAB@@ABA@A@AAACAAAA@@IAA@C@A@@@@@@B@@AB@@A@A@ID@@ABG@A@A@AB@B@@A@CBA@CBABA@AAA@A@@@ABABAB@@AAC@ABABAD@@A@A@ABA@CB@BA@CAA@ABC@AB@B@@ABABED@@A@@@A@@@A@A@@BB@AB@@A@@@AC@@A@@AA@@@CBA@CBGDA@@@@B@BA@C@IAEAE@E@AAA@@@AB@BC@@@CBA@A@C@AA@@CACBA@G@EBAAC@C@A@EB@@@BABA@G@A@A@@B@@A@C@@@A@A@AB@@E@A@@B@B@B@@@BC@C@@@CB@@ABABA@C@@@CAAAAAC@AAC@CBAAC@A@ABA@AAA@A@ABA@A@AAA@A@ABA@C@ACA@I@AAAA@@A@EBA@AAC@AAAAAA@@A@@BA@CAAA@A@AA@A@AEAAA@A@A@@@AB@BABA@AAAAAA@@BA@@AAEC@@CAA@AAEACBC@AA@@BA@AA@A@AA@A@AA@A@@@A@AC@@CBA@@@@A@AA@A@A@@AAAA@@AB@@A@@CAAA@@A@@@ABA@A@@@CC@A@@AAAAACA@ACAE@@G@CCACCACACA@AC@C@C@AAAB@BE@GBCBCA@AB@@@C@AA@@@@BB@BA@A@@AA@A@@BC@C@ABA@A@A@ABBBC@C@@@@BAB@@B@AF@BA@@@@@AA@B@BA@AAC@CBA@@@B@@B@@@@@B@@ABC@A@B@BB@@A@ABA@@@@A@@AB@@@BBB@@ABCA@B@@@BA@@@@BA@A@@BABC@A@ABA@@BAAA@@@AB@BA@@BCBC@CDCBA@@@@@A@@@A@ABA@@@A@@BCDA@C@@B@BABABABABAD@BABA@C@ADA@ABA@@D@BAB@@E@@@@BABA@C@CBE@C@AAAAA@C@C@C@C@A@AAA@AAAAA@EBA@A@@@E@CCA@I@C@A@C@A@EBAAABA@@@BB@@@BCBA@@@@B@@CBC@A@@B@D@BA@ABE@@@@B@B@@@@@BC@A@A@@@EBA@A@@BAA@@ABC@E@ABA@ADEBCDCBA@A@@D@BABC@C@@BA@ABCB@BAD@@FD@@@D@B@@@B@@@B@@@BA@@@@B@BAB@@A@@@@BA@A@@@AFABC@@@AB@BA@ABA@GHC@C@ABA@@BA@GBE@ABAD@BBDCB@@@D@@A@@B@BA@A@@B@B@@C@@B@@@BABA@CBA@A@ABA@CAA@@@A@@B@@E@A@@BAB@@CBABA@C@C@C@A@CBABA@A@C@ABCACBA@IBC@ABA@@@A@@@@@A@@@A@@@@@A@A@@BA@A@@@A@ABA@ABABC@EDABCAC@A@@@ABA@E@AAAB@@A@A@@@@DAB@D@D@FABABABCBA@C@C@A@A@AAAA@ACAA@ABG@@AA@ACAA@AAAAAA@ABCBEBAB@B@@BBBBBBB@B@@BA@CBA@AB@B@BABAD@DADBBAB@@AB@B@@@BBD@B@B@@@BAD@B@BB@@DDBBB@B@DADABABCFAB@@@@@B@B@B@BB@FDDD@B@BBB@B@BBB@@FFB@@@HBLBD@BBB@DF@@B@B@D@D@@@DBBBDBBBH@@BBBDB@B@@@@@FF@BBDB@BB@@BBBB@BBF@D@B@D@BABB@BFFBB@@L@B@FAB@B@B@D@BBHDBBBB@@@B@BGHABADCD@D@BHDDBB@F@H@HAB@B@DBBBDDDDB@DBB@D@AB@BADCDCBABAFAD@DBBDBFBBD@FBDBB@DCDADABCD@B@DCDABCBCB@BADCBEBC@C@MHlz§eCFA@ADADAB@BABAFED@DHNDDBF@FDB@BHHBB@@HDHB@D@B@@A@ABEDEBA@ABA@@BB@@BBB@@@F@BABCD@@A@ABA@A@E@G@IAACA@GBAAE@A@EA@AA@ACA@CAA@CBCAE@ABCBE@A@A@A@CA@@A@C@@BCDA@A@GBAAA@C@B~aJDDLFJD@@DBHJDDNJBB@@@@@@@B@@@@CD@@@B@B@BAF@F@B@BAB@H@RBB@@DDBBB@B@D@D@FBFDFB@BDDRJJBJHBBDBD@B@BBFBHBD@BD@@DHHFFBJDH@FAFEB@DAHIBAHCN@JAB@B@F@F@H@@@FAD@F@BAD@JCD@@@@@B@D@BBBBDB@@B@@D@BB@DDBB@BB@@D@@@B@@ABGB@DBBDD@@BBBFB@B@F@DDFD@D@BDB@@BBBBDBA@@@H@LCPABCDKRCJIJ@HCFGJ@NHHBHCJCDDFDJBLDLAJATAB@FDBLJHBDLDTBDHDDJ@HDH@BDP@VGJCHDNBHJNJNHLBLCDE\\@JDDFHLDFPBPBHV@HJ@L@JBJD@D@^CH@BCJBDEF@DRBPAHH@DXHPRNBHHJ@F@JB@JTLBDHBDHDB@@AFBHP@BCJ@P@JDJEBCJAHEJEHBD@HDPANCJBLJDFXENBLHFFLB@FH@DAPFLDLJRBfCPGFEPCJCNBNBNDF@HBHHN@FDBJBHBFFDLHDFBBEHAHEDBHEDEBCFEFEFEBQDOHIDGJADH@FDGDKHSFGHCHHFF@APEHIJAHAJ@@H@BJDFEB@D@BAD@FFDAFKD@@BJ@HDDBDHBB@HBFB@@@HH@FHNDDDHFF@HBF@B@DDR@@@BHDFJ@DDJDLBFARBJDFALHFFJAFJBBFHFFH@HHD@@@RHHBTDB@D@LANFV@FATHL@PDVCJALFRHBFH@LFDFNBFBFBP@LFRFFFAHCHAD@FB@A@B@BBB@DBBBPDAHBJEBCHIL@DFFBL@RIHLVHB@JGDIFAH@BADFFLJJPXFF@FDHDNL@JBBGF@D@FCFBNHHHFD@LRABDF^JND`BNDHAHBRDR@D@BALAHCPAJBBDL@FBR@BBNBJBB@JCFALBFAJ@FENGHAFCDAF@REFGNGJIN@RGFCF@FEHAF@LBDENBBADEDCJBFCLBFAF@JARFJ@HC@CJABCNCF@HABIDED@LEB@JCFAF@@EHAHARCHDFAHHNFJADDDHN@NHTCDH@@JDH@PCTAFDJGFEJCNBDAXK@A@@DGDBDCF@DCH@HEFDH@HE@CHAH@LAFBHJDBLCBFFBBBJ@FALBD@HDBBFCBFJ@NBXER@DEAALADCDDPDBD@@HBLBBCHAJ@NDFDHHAHFFNFLJNDJBFCPAJGLGAA@AL@JBBDHBDFJBDCJA\\BRDBB@@BDBDFBB@BBA@@B@@B@BB@@B@DBDDD@@@BB@B@B@@B@@B@B@B@B@B@@BB@@DBHB@@BBB@B@BBBB@B@@@BBDB@N@B@FAB@@@D@BDFBB@BBBBB@BB@@BB@B@@@B@@@B@BBF@B@B@@ABABA@@D@@B@@B@H@@DFB@@@BBBBBBBB@BAB@@@B@@D@BBBBFHB@BB@BDBBB@BB@DF@@@@EDABA@A@AB@D@BB@@BBD@@@B@B@@DB@@@@@BAB@D@B@BDBBBB@DBDBB@B@D@F@F@B@B@@@BBNDFB@B@@AD@BAB@DB@@B@@FBB@B@B@DA@@JA@@F@L@B@B@B@B@HADAB@D@F@D@B@B@BBDB@@BBB@F@B@D@B@DB@@BB@@DHFJBF@BCJGPGHEF@HGL@HCBBDADBJBH@HCDEH@DBJGDCFGL@BHBDHBJ@FEFJPGBEDGJFBFHFFBD@@@HEFCBHDFFF@HDFBDHLDFF@FDFCJAHFFJFDHDHEFAFFL@@BLGHCDBJFFPFJADP@JEJDF@J@FIHI@ALCH@BHDDFFDAFDDJDT@J@EDGHBDJHEBDHJD@D@F@BDBD@D@B@DAB@@@FDB@B@B@DAB@@AB@BABAFAB@DDB@BDBBDBDBB@B@D@BBB@B@@BB@JHBB@@BBDB@B@B@@BDB@DBB@FDBBB@@@B@BAFBD@FAB@@A@A@@BAB@BAB@B@B@B@B@DBD@BBB@@BB@DABAFAB@B@BA@@@A@AA@@@CAA@@A@@BA@ABA@@@AAA@@A@C@A@A@C@@@A@@A@@@C@A@A@AACA@FAB@DA@ADA@A@@@CB@FADA@@BAB@B@D@B@D@B@DBDBB@D@B@B@B@D@B@B@@ABABA@AB@@@DCD@@@@ADCDC@@A@@A@@DAD@B@D@B@B@@@FHB@BBDBHBB@B@@@B@BAB@BA@AB@JAD@BA@A@@@@DA@@B@BALEBAAA@@@A@@@@BA@AB@DAD@BA@@@E@AA@@ABA@A@ABA@@B@@AB@D@BAD@FBB@B@D@B@@@B@BA@ABCBA@@BA@@DABAB@DABABAFAB@B@B@B@B@DAD@BAD@@@D@HD@BB@B@B@BAD@BABAH@BB@@B@B@B@BAB@BAB@B@B@@@BABA@AB@@@DAB@@@BABA@A@CBA@A@@@A@@BG@A@@AAAA@@@A@@@A@@@@@A@@B@@@@A@AAAAC@@AAA@CCAAB@@A@@@A@@B@@AB@@@@A@@AA@@@A@ABA@AA@@A@@BA@@@@@ABA@AAA@@@A@@@A@@ACA@AA@A@@AAA@@@A@@A@@@@@@@A@@@AAAA@@AA@@A@A@@A@@AAA@@@C@@@@AC@@B@BA@A@@@A@@@@@AB@@@AA@@B@@A@@A@@A@A@@@@B@@A@@A@AA@@@@@A@@BAA@@A@@@@AA@@@A@@@A@@@A@ABE@AA@@@@@@A@@@@@@@A@@@A@@B@@@@@BA@@AA@@@@@@@@@A@@B@@@B@@A@@A@@AB@@A@A@@@AAA@@@@BCDC@A@A@AD@@A@@BA@@@EBA@ABABAB@BA@@BAD@@A@@BA@@@A@@BADAB@B@@@@AB@@@D@BAB@@BB@B@B@@@B@B@@@B@B@BAB@B@BA@@BBB@BB@@B@@@B@@AB@BB@@D@B@B@@@DAB@@A@@@A@@@@@@@A@@@@@@BAB@@@B@@AB@@A@@BABA@@D@@AB@@@@A@@DADAF@@AB@@@@AA@BA@@B@@@@@BA@@B@@@B@@@BA@@@@A@A@@A@A@ABADC@@BA@@B@@@DA@A@@@@B@@A@@B@B@@A@@A@@@@@BA@@B@@@B@@@B@@@@@B@@@@@BA@B@@B@@AB@@@B@B@@@@@B@@@@@@@B@@A@@BBBA@@@@@@B@@A@@B@@@B@@@@@B@@A@@A@@@B@@@@AC@@@@A@@B@@@@@A@AA@@@@@@BAB@@@@@@A@@@ABA@@@@@AB@@@@A@@@A@@@@BAB@@@A@@@@AB@@A@@@A@A@@BA@@@@@@AAA@@@@@@@@AB@@A@@@@A@@@@@@AB@@@@A@@@@B@@A@@@@@A@@@A@@B@@@@A@@A@@@@A@@@@@@@@@A@@@AB@@@@AB@@@@@@@@@B@@A@@B@B@@@@@@@@@@@@AB@@@B@@@@@BA@@@@@@@@@@@@BA@@B@B@@@BA@@@@@@@A@@@@@AB@@@@@@@B@@@@@@@@@B@@ABA@@@AAA@@@@@A@@@@B@@A@@@@B@@@@@@@@@@@@@B@@@@@@@B@B@@@@@@A@@@@B@@A@@@A@A@@@@@A@@B@B@B@@@B@@@BABA@C@@@@A@@AB@B@B@@@B@@@@A@@A@@A@@B@B@B@BA@BB@@@B@@A@@@@@@@@A@BA@A@@B@@B@@@@D@BAFAFC@@BBBA@@@@BAB@@A@@@A@@@A@@A@@@@A@@@@@@@@B@@@B@@AA@@@@@B@@@@@@A@@B@@@BAB@@AB@@@@@@A@@AA@@B@@A@@B@AA@@@@@@@AB@BB@@B@@@@@A@@A@@B@@@B@@@@@B@@@@@@@@AB@@BBA@@@@@B@AB@@@@@BA@@@AB@@@B@@@@@@@@@@@B@@@@@@A@@@A@@@@A@@@@A@@@@A@@@@A@@@@@@BAB@@@@@@@B@@@AA@@B@@B@@@AB@@@B@@@B@@@@@B@@A@@B@B@@@@@@@BA@B@@B@@@@A@@A@BC@GBEDAJEHCBEEA@CD@B@BC@ECCD@D@CIDCB@BCACDA@EAADA@AHAFADEBECGDCACCABCE@AEBCBAAE@@CC@A@CACCCBCFABAAKAABCBK@CDCAABC@EBAB@DA@A@CAABABA@@@AACFCBEDEBC@AC@DABABADC@CBADA@AEADAH@BA@AHEDA@@BA@CBC@CD@FAAA@AFAFCBAA@FADABG@C@CBCHADABABE@@@@DAAAAA@CFC@CBCBAE@BADABAAADCDCJEFABCDCA@AABAACCDACDEAAC@CG@CCABABA@EBC@EC@CABCG@AC@CD@FA@@@@@@@A@@@@B@@@@@@A@@B@@@@@@AB@A@@@BA@@@@AA@@@A@@B@@@@@@AB@BAB@@@@@@A@@@@AA@@B@@AA@@A@@B@@A@A@@@AA@@BA@@@@A@@@@@@@@@@BA@@@@A@@@@A@@A@@@@ABA@@@@@@B@@@@@B@@@B@@A@B@@@@@B@@@@B@@A@@B@@@@@@@@@@@@B@@@@B@@@@AD@@@@A@@@@A@@@@@@A@@@@@AA@@@@@@A@@@@@ABC@A@A@A@@B@BCFEB@B@B@B@B@DBB@B@B@BA@@B@@@@@@@@A@AB@@@BBDA@A@@@@@A@@@@@@B@A@BAFE@E@AHCHCDCACFCDCBEBADCXAHAFABBFBJAH@H@FBBA@EJGLGFBHC@@B@LCRCHCFCA@BAB@D@BA@ABAF@DAAADEDADAH@@@D@BCDAD@D@BBBDDCBAFADBFAP@JHJAFBDBH@CAJCFAH@J@HBDCFA@@B@DCF@BAD@HCFB@B@DHBDA@CDAJ@BBBBBCDBD@DADBDBDBF@HCHAP@DCFBJA@@EEIKAACI@EACCGGOCGEGEGCEACGOAA@AAA@C@AAA@AAAAA@CAA@@@AAAAA@AAAAC@AAAAA@AAAAAAAAA@CAAAAAA@AAAAAAAAA@AAAACAAAAAA@AAA@@AAAAAAAAAAAA@AAAAAACAAAAAAAAAAAAAAAA@ACA@@AAAA@AAAAAAAAAAACAAAAAAAAAAAAACAAAAAAAAAAA@AAAAAAAAAAAAAA@AAAAAAAAAAAAAAAAAAAAAAAAAAC@AAAAAACAAAC@AACAA@CAC@AAC@CAA@C@CAA@CAC@AAC@CAA@CAC@AAC@CAA@C@CAA@CAC@AAC@AAC@CAAAC@CAA@CAE@IAA@EAKCIAGCC@AAC@AACAA@CAA@CACAA@CAAAA@A@AACAC@A@C@C@CAA@CAC@@AICEAAA@@A@CAAAA@CAAACAA@CAAAC@AAAACAAAAAEAEC]KAAOEQESG_KSEUGSEIAEAYG]G[IQCA@A@@@@@AAA@CAA@CAAA@@ECCACA@@@A@@A@AA@@A@AAECAA@@AACACA@@A@AAAAA@AA@@AA@@A@AACAAA@ACAA@AAAAAAA@AAAAAAEA@AA@AAAAAAAAA@CCCACCCAAACAGE@@A@AA@@AAAAAAACAA@@A@CCCA@@A@@@@@@@AAAAA@@@A@AAAAAA@AA@AAA@AAC@@AA@A@@@A@SGKECAEAUIWIA@SGAAMEEAGCKCEAEAA@GACAC@AA@@@@A@EAEAEACAEAEACACAA@GCA@GCAAC@CACAAAUGEAA@GCC@CASGAAA@A@QKAA@@A@KGAAA@AACEA@@AAAIKACCE@@@AAAAAAC@AA@@AA@@AA@ACAA@AAAAAACCA@@AA@@@AA@AA@A@@AAA@@AAA@@@A@@@@@@AA@@@@@@@AAA@AAA@AAA@A@A@AAA@A@@@A@A@A@AAA@C@A@A@A@A@A@A@AA@@A@AA@@AAA@AAA@@@@AA@@@AA@@AAAAA@AAA@AA@AA@AACAAAA@@A@@A@@@@@A@@@@@@AAAA@@@@AA@A@@@@@A@A@@AA@A@AAA@C@A@A@A@AAA@@@@@A@A@AAA@A@AAA@A@C@A@@@A@A@@@@@@@@@A@@@@@AA@@A@@@@@@AA@A@AA@@A@@@A@@@A@@AA@@@AAA@@AA@AAA@@AA@@@@@A@@A@@A@@@@A@@AA@@@CA@AA@AAC@@AA@@@@@@@AAC@AAA@AAA@@AC@AAA@CAAACAAACAA@CAAAA@ECA@@@@@@@AAA@@@AAAAA@@@AAA@AA@@CAAAA@CA@@AAAAA@CAAA@@@@EA@@CA@AA@CCC@ACA@AA@@A@@AA@ECCACAEC@@CC@@AAA@AAEC@@@@MEeMeMcOgSCCeKICECQMOIECOMKOUUKECCWKkSMGOIWG_CWCEAkIOECAWKKEICGGGEMIKIKMCCAA@AIBGFIH[JONEDGDIAMFYCE@ARK@qPGHODOAGFABEBoPEFEBKCYFWAZgXmF]EM\\WDO@aASHOOQC_K_MyYCEKI@IGGBEEGCEOE@IIIKOAYTUIQ@@UTQE"],encodeOffsets:[[124544,48310]]}},{type:"Feature",id:"150785",properties:{name:"根河市",cp:[121.520388,50.780344],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@EBIABECCAEC@E@E@BEBAG@OBEBGAEGC@EDCCICGEGBCCBEDG@EG@CCJEF@BCGCGGCGGAG@YJACACFGGGKBGAEEKEKCCD@DGBeIWGQFACBEIEIEM@KDE@@C@CB@A@AB@@C@CAA@E@C@E@I@A@A@@B@BABABA@A@ABC@@AA@ACCCAAA@CAA@CACAG@C@C@G@A@C@C@CAC@C@A@A@EB@@A@CAECIGCAAACACCCACAA@A@C@@B@@A@@F@@@@ABGBE@A@AAAAAABABCFE@A@@AA@AC@AAA@ABA@ADADABA@ABC@GAA@A@C@@@@@@BABADB@@B@@@@@BA@@B@@@B@BBB@@@B@J@@AB@BA@C@ABA@@@AB@D@@A@EDC@AB@@@BAB@@ABA@C@@@@BB@BB@BBD@@@BAFABCDA@@BBH@BBBDH@@AB@@BB@@D@F@B@B@@@@@BB@HB@@BHBDBBB@@BB@FBBBDBB@BBDBDDDDBDBDBF@D@DBB@DB@BBB@BB@JFLDB@@BFBBBBD@BBB@@D@BBD@DDDB@@BB@@@BA@@@ABA@C@@@@B@BB@@B@B@B@D@D@B@BAD@BAB@@CBEB@B@@DBDBBBBB@B@BAB@BABABADCHCFA@CBABA@A@C@E@A@@@@@ABBBFBBBBB@BABADA@A@ADCBEFEDCDCBCBAB@BAB@B@BADADA@CBABC@A@A@@BCBABABA@AB@B@B@BB@@B@B@BA@ABC@A@C@C@ABA@@BAB@FAB@BCDAB@@DD@B@B@@@BCB@BA@A@A@EAA@AAA@CEAA@@AAG@C@A@CAA@AA@ACCCEAAC@E@A@C@A@E@A@A@ACA@GAA@CAEAAACC@CAC@ACCCAEAC@GAGA@@A@AAAAG@A@AAEGEC@@A@@@AB@@ABA@A@A@@@A@CAA@A@AAECG@C@A@C@CBABABA@CBA@A@A@ECG@A@GBC@IBAAA@CAA@A@A@A@@BABABA@A@AB@@@BA@@B@@ABCBAB@D@L@@A@A@CB@@A@A@C@A@ABABCBA@C@A@@@@B@@@@@FB@@BADA@AB@@AB@DBD@BBBBDBB@DAF@BBD@BA@@BAB@FAB@@A@KBC@CBA@A@ABC@C@@BCA@B@@@@ABKDCBA@A@A@@BCB@@@B@@@B@D@B@@BB@BBF@FBD@BBBB@HDBBB@@@@B@@@D@B@@AB@B@B@BBB@B@@D@@@@B@@@BA@EBAB@@AB@B@@BB@@FBDBHBHB@@BBB@@@@B@D@D@BABAB@@@@@D@BA@@BELAB@B@BABADABA@@B@B@@BB@@ADAB@BABAD@@@BBB@@@B@@@B@@BDBD@@@@CF@BB@@BADAB@@ABA@@B@B@@ABABA@@B@D@B@D@@CBIF@@B@DHBB@BBB@@J@BBD@B@D@BBFFD@BBBBBBFD@B@BAF@DAF@BBBB@BBB@BDB@BBBBD@FBB@DB@BBBBF@BBBAB@B@@@BHDB@JJBBB@F@@@BBDDBBBBD@B@HBB@BBB@@B@DAD@B@B@BJJ@@BF@@BBHBD@FBHBB@@@@B@D@BFDDFLHDBFFBBFABBA@ADABABA@AAC@AAC@A@EBC@Q@AAC@A@C@EAC@E@C@@AA@EACACACCA@MCAAA@AAC@E@GAIECCE@GCCA@@@C@@@@@@ABAD@BCB@D@B@BAFABGB@BBD@@@@ABGBADIBCFAB@DBBBBBBDBB@@D@BABADGBCF@B@B@BC@@BBFBBEBC@ABCB@@EDCB@BCBAB@DA@A@CDA@A@A@AAA@EBC@C@A@CCAAC@G@GBABCB@BAA@ACAAAC@CACACAA@ACAAG@I@Q@CAA@MEGAC@ACA@A@ECCACCEAC@QL@@@BAB@@K@GBGAG@C@CCI@MAG@A@GCACAEA@AAA@ABA@C@AACACEA@CAC@A@EDC@EBA@C@A@EAGA@@ACGGAAA@AAA@ECEAAAIBA@C@CAA@AAEAA@E@A@ABA@ABC@IBEBI@C@C@CAEAA@C@CAA@@@A@ABABA@CDCDCBC@C@C@A@C@A@IBCBEBFHBBADFNBFBBB@L@J@H@@@B@BJB@HDHB@@@B@FBH@@DD@@GD@B@@@@@BBDBD@@@FBD@DBDDJFF@@DJ@@B@H@D@B@BB@DBB@@DBB@H@HBAF@BB@DBB@BB@BBJ@DABCBAB@@@@BB@F@BDBBBNHDFBBBDB@LDD@FDBBBBBDDF@BNFB@BBDDDL@@BBDB@@@B@B@B@BDHBB@B@BADAB@D@DBBFF@FADCBE@ABAB@@FDBBD@HDFDJ@FDD@BBBA@@BAB@DBBBBBDBF@B@JJDBHFJDB@FD@@BBAHBBF@DDFJ@@@BABAB@BBBBJL@BBDBDFFDDBF@D@DBDDBBD@FCBA@ABAB@B@@B@@JDDBJBJ@B@@@BD@D@@@B@D@D@BBBDLDDBBBD@FBH@BABABABCBAB@F@BBBHBDHBBBDAB@DBD@@NJB@B@DBD@BBBFADAB@F@B@HAFT@HBB@JDJFDBRJBB@BDD@@@B@BB@D@REF@DAD@B@F@D@J@DBD@NAF@F@PBD@D@D@FAF@B@FBDBBBBBB@@@F@D@HADAT@H@BBBBDF@B@DBBFD@@@BFFHDB@DC@AFADCBAB@@@BBB@HB@AD@BBD@B@DBD@NIDCD@B@D@BBD@FDDB@@B@@@HGDAHCDADAHCDAFAJAJC@@BCBCDE@A@ABEBA@@@@@A@@@ACACAA@BAFAB@B@HGBABAF@H@D@B@HAFCDC@@@AAAGICCA@@AB@HAJCfEB@DEBE@E@CA@@AA@AAAA@@D@FBD@HBD@BA@@D@DAH@@@D@HBRHLADAB@HDB@DBRBDBDBBDJDF@FBF@BBN@DAFAD@D@B@@CDA@AB@@ACACE@@ACAC@ACE@A@ABABAFCD@BA@@@A@C@AFABA@E@@HDBBF@L@FBBBBBL@FAD@B@@@DDDBPDD@D@DABA@CHI@@DCB@@ADAD@PCBA@AB@AEB@@ABAB@D@D@DBFBD@NHJ@FADABC@@JCHCB@D@B@D@BBBDDFBD@BABA@@B@B@@BBBBB@BBBD@BCD@B@BBDDB@@DBDAFAD@B@DAHAB@D@DAF@BBJBDB@BBBHFBDFFDDHDBDBDB@FBDBBDBF@BBBDDDBBBBDBBDH@BABAB@B@BDBB@BBFDDB@@B@AB@D@B@B@@LP@@B@DFBBBBDDFB@@B@@@BH@@@@A@@B@@A@ABABCBJHBHEFCB@@CBA@A@A@@B@@@B@@CF@@@B@BABAHAHA@EDA@@BA@@H@@@B@BEL@@@@@B@@JN@BB@@@B@@BD@B@@@FBB@@BB@@@@D@B@@B@@B@@@BA@@@EH@@@BD@@B@@BB@@@@@@@@AB@BCBCB@@A@@@@@@@@@@B@@@@@B@@BF@B@@DB@@DDB@BBB@J@B@B@D@@@B@@@@@DA@@B@B@@@@@@@@@B@A@AF@B@@@@@@BDBD@@ED@@@@FB@@BB@@@@BB@@@@@D@@@BHF@@LB@@DB@B@@B@@@@@@@@B@@CB@B@B@@@BAD@B@@@@ABPL@@BB@@@D@@@H@@A@@@@@@F@@@@@BLF@@B@BB@@@BB@@BB@@@@@@@@@DB@@D@D@@@B@FBD@B@BB@@B@BB@@@@DD@@@@@B@L@D@@@@@BB@@B@@@@@@HL@@BB@B@@B@@@@BJB@@@@B@@@F@B@@@B@@@B@DAB@@@@@@@@@@@@@F@@@B@@@DBB@@BB@@@B@@@BBB@@BB@@@B@DAB@D@B@@@@@B@@@DBBFB@@B@@@@@@@@D@@BB@B@B@@@BBFA@@B@@@B@@@BBHBB@B@B@BB@@@@BB@BBB@@@@@@@@@@B@B@@@@@JE@@B@B@B@B@@A@@@@@@B@NB@@B@B@@@@@B@B@B@@BB@B@B@@@B@@@@@F@JC@@B@B@DBB@B@@@B@@BB@@@@@@@J@B@@@@@BBB@B@@BB@@@@B@@@@B@BD@BB@@@@@@B@@B@@@BBB@FD@@@@B@@@@@B@@@D@DBD@D@BAFB@@@B@@AF@@AB@@@@@@@@@B@@BB@B@@@@@BB@@@@@@@B@BB@@B@DBDB@@@@D@BB@@B@@@@@T@@@BB@@@@BB@@@B@@@@@B@@@B@@@D@B@@@F@@@@@@@BDB@@@BB@@@@@@@@@B@@@B@@BB@@@@@B@@A@@B@B@@@@@B@B@@@B@@@@@H@JB@@@@@B@B@@@@AB@@@@@@AB@@@B@@@@@@@@BB@@@@@@@@BB@@B@BBDDBBB@@@@B@@@@@B@B@@B@@B@B@@@B@@@@@@@B@@@H@@@@@B@@BB@@@@XJB@@@@BB@@@@@B@F@B@@@B@@@B@@@BAFA@@@@DA@BF@BBB@BBB@DB@@@@B@@@@@HB@@B@@BB@B@@@B@B@@@FB@@@@BBB@@@DBB@@@BDB@@B@@@@@@BBBD@BB@@BB@@@@B@@@@@@@@@@@B@@@@@B@B@@@B@BD@BBLDB@DD@BB@@@BBB@@@CD@@A@@B@@@@@@@B@@@@@B@B@@@F@@@H@@@@FB@B@@@@@B@@EB@@EN@@@BBFGFDFJDL@ZE@@B@@@B@NHBBBBBBJJB@@@@BB@@@@@@@@@DB@@@@FDDD@BAB@FD@LD@@BBFA@@FA@@B@JBTFPABBB@RFFJ@@@D@BCDIJAB@B@@AJCD@@BLSHCHADMLGB@B@@BD@FCBBDVJBFBD@B@@AB@F@B@B@D@@@@EL@@@@@FGLA@Xb@BCJEDLHBBB@BBCHBL@B@@@@EL@BON@@@@AF@DAB@@BBBB@D@@@@@B@B@@@BBDCDCBIJG@@B@@AB@@@B@@@DBDHFJDF@FBBBBBT@LCH@BAB@DAR@BAJC@@@@LBHDN@@@DB@D@@@@DD@B@@@@CD@D@@@@@BFFA@ABBB@@FJZJRCNBFH@@@BPJLHB@BBHBJDCPB@ABB@A@@BJBD@B@\\J@b@B@@@B@D@B@@L@B@BBDDFH@BNLBBBB@@@B@@@@EDEFCBQN@@@@@B@B@B@@@BDFJDBBDBDBB@@D@@BBDDBB@@@B@@@BB@KjohP]dV¥`UFA@@B@BADAH@B@BBFDF@B@@@BCBA@IFC@@@A@ABAAG@ABA@CBABG@UF@@A@A@_YC@C@E@MAIBC@@@A@CA@@AAEA@@AAA@I@C@CAA@GBWQ@AEBABMBI@C@K@EBIACAICA@A@QX@B@BAF@BON@@B@BBB@DBBFBDBDLP@@BB@@@BBB@@BB@B@@@@@DAB@BA@@BADAHAB@BAB@@@@A@@@EBA@K@C@OBA@CBOJ@BA@BL@@@@RHBBVAD@D@D@FBF@BBB@FB@@DB@BBB@DBDDVB@HFB@JCDCFEDAB@HAFAFBD@F@HBJBD@FBHBDDDDFHBBDFBNB@HDDBFBLFLBD@^DFRBB@B@B@@@B@@ABELDBBBF@HB^BDBB@D@JHNBB@NBDAHCFEFCBAPEHADAF@DBB@DBBBB@@B@D@H@F@D@D@BBBHFDBJDNFjA`J@@JDr¤P^\\xRh@NDFJHFHpN^N^HX@DCBAF@B@B@@DF@@@BABCB@DCDCD@@@@CFBDABIL@@AB@F@@@@AB@@FBF@DDB@@@BB\\@@@B@BBB@HF@@B@BB@@D@B@HB@@@BDAvEpINCZEPEVGpSJELA^BRBGPBLBZFjZJNDNBJBHDHVFP`FHRF|dPHTLXdGRERhFlJTNKLINXH`RJLFRXLBDP^BBJH@BCXADBBJF\\DP@FCRoBCLGLObMpFPADAvBfL\\BVENKRSH[@IBKPKH]BCPA`DJIJUFCbED@BAJMHOHCJADBVLVZN@LMFMDQJOVSRKDE@GACKSCEACFKDGIUAEJIfGlGXAXANCXCtaP@^F\\BfDLDJF^@\\@dMAQ^IRBVBXDhND@JITIHCLEFMEKkMCILQFQ@IBQAUCICAq_IKCIUGeG[CGGCY@[CA@AC@CBC`e@CAE@CACa@ABCD@ZCTGB[@OHKLODM@YBKLMBG@ELINKRFhF\\ABMDGAIAOAMAKJSBK@GAGIIWMIQCQLGLEPIDQHQFK@QEK@ABM@@BARMLKFIFINMHMBIDKHIAMEKOOSWAA@C@AVGDAFIJMFM@MDSHKJKLKJIluFMAOFMLE^MHENQHIKISMKIACAK@A\\MZI^QJGLOBCBEACACGEWGGAGGCGROVIDA@MCM@AIIMKCCQGACFG@@EAIACA@@A@A@A@ADCBC@MBO@A@AB@B@B@FCBA@@@MAC@EBCB@@EAO@CA@ACE@GKCGA@@AAAAGAGC@A@C@ACACAC@OCCACCACAEAMDKBCLG@COKEGuK[UEGE@[W@AHEAAOKGIIAKK@@IACEDIEEM@KG@GGAA@MEMMBEJECGFGDEJA@APGJCDKHEAMFKOK@AUSE@GBQAAADMBAHGBAAKGC@ICCDKLGEC@SCIFEZG\\@HNAXFFPONCDFFHHCPAHCCSBMDCDCAGDCKSPIFKCCKEE@BGOILS@CK@_]WBEABEEADIEABKHCKKDKJC@@AGJI@IJCBMCICCWCC@K@AA]EKDM@GQIC]AYSBCPCBKCEG@_J]M]@ECYeDGIULECIXELICEDIEELKCIBEJGWGKA@ANKLU@KLCFCAE^CFAJFZDDGIEAGLKCIB@AA@@@@AACACAA@CCAACCICA@CCA@AAA@CAA@GICC@AAAAACAKCGACAAAA@M@AAA@ACA@AC@AACAC@AAAECECAAEEA@C@@@C@@@@A@A@AAAAA@AAA@ABC@CBC@ABC@A@@@ECCCCAAAAEACAAA@@@EAAACECCACC@ABAHEBA@A@ADCDC@AA@CAAAE@CAEECA@CBE@EACCEC@A@EDC@ABC@ICEACBG@C@EBE@CACAAACAC@E@G@AACAAEAAAAA@C@CBC@ABA@@D@DCBCDE@ABKAEBC@KAEACAA@CBCDGBE@CBCBGFIFE@GACCCCECICAACAI@ABCBCDC@IBC@C@ABCBIJABC@I@E@EAA@CAA@E@C@E@A@EAIAG@K@C@E@CADDDFJHDJCJBHANIJFHBPGLGJODMAAHGNEJBJ@FEDC@C@ECO@G@ICECQBK@MPABA@K@EGCAM@CDC@ECKAICCC]IOAECCCEGECOASEGCICeBEDENCHE@I@CBePSLMBODCBCAKCC@CBA@ACECAABG@CAGKGCAGCEAAAGCAAICAEFIBEAACACCC@EACAK@A@CAGAM@GBMCCGAGAE@ACCCAGCCA@ABAEBEAEACA@AACGAACIAAAACCCCAC@SDOHUDIDM@ADBDADEFEDE@CBKBK@ECMAEACEC@I@@ECIIAKCGBEBSAECCGGCGAMEAAC@CIEBE@A@C@EDA@E@EAAA@AIGKAIAG@GICG@@OAIIOCCABIAAGAIGBA@@BCDE@CAEAAAMBEECC@@CE@C@GCCCCGIEIDQ@WECEACEEICKGCEQAU@GBI@GGC@CBE@G@E@GCIBCAC@EBIDA@AA@@IOM@GAEACCCECAC@YAMFC@EA@EAAAC@A@@@GCEECCGBIEGO@GABGBQ@OBEAA@EGEGCEI@C@@IGUCO@QHK@EAUE[@IC]BSDG@aIM@SMBGCISGMEGFE@EBWGI@MEIAY@OEK@EECGGEiODMAEGACAKCK@SIQJKCIGIDSDK@IECG@AaKDEAEBCCESEKKMAKCEACAI@GE@@CAA@GBMAWFQA@@WAQLGBAB[LA@ADED"],encodeOffsets:[[123924,51721]]}},{type:"Feature",id:"150784",properties:{name:"额尔古纳市",cp:[120.180506,50.243102],childNum:1},geometry:{type:"Polygon",coordinates:["@@IyG_AāX`½tA@SJCB@@CBYbw@BG\\@FlTVL@@WnMXKTOZBN@@EAC@C@ADCHABA@EBEAC@AAAE@ACAE@E@CD@H@DC@E@EAA@EBGFA@GBIDK@C@CDA@C@@A@AA@C@A@A@ACA@EDEDABC@GBCBAAE@GACCA@EBABA@KQQaKSLG@FAAQOYKUMqaWOmGCFMmGŭKGAB@@A@@@A@ABC@CBA@ADABABAB@@BB@B@B@BCDAB@D@D@FBBBDDBF@B@D@B@DBFA@@F@DDB@B@B@@@BB@B@B@@CBEBA@@BCBADA@CBABA@ABCBGDCB@BA@@BA@AAA@C@@@ABABA@AF@@@BA@EAA@A@ABABAD@B@BB@BBHJAD@@AB@B@DBB@B@@BDB@D@F@F@BBA@ABA@EAA@AB@@@BBBDBD@F@FBDBB@@BCBAD@DBFABCB@DB@BBDBBADADABAD@BB@F@BABCDA@E@C@C@A@C@A@AB@BBB@@B@@B@B@B@B@BB@BBD@B@B@BB@@ABA@AB@B@@BBB@@@BBA@@BG@A@CAA@A@ADABGBAB@BBDD@DBD@D@DAD@DBF@D@D@D@F@B@@@@D@@ABCBADABBF@D@B@BFB@B@B@B@BB@BBDBD@DBF@D@DBD@B@BBFDBBBBBHBBBBDBF@FBB@@B@BA@ABE@EBCAC@E@E@E@CBCBCBAB@B@BBBBBD@B@J@DBB@BB@BAD@D@@@D@BABCBC@CBC@A@ABADBBDBF@DADB@BABC@C@CBA@CD@B@B@DCBA@CBC@CACAAAA@A@A@A@C@A@C@ADCBCBEBE@AAEAAA@AAA@C@CCACACBC@AB@BABCDC@A@CAA@AABABCDCBCAACAEACBCD@D@F@DADA@CBA@E@EBAB@@AB@B@@@BA@C@A@@BA@ABCBC@A@AAECAAAA@CBC@ACAAACAC@G@C@AAA@@ABABAD@B@@A@A@ACACACAE@E@CBGBC@EBC@CD@B@D@@@BBBBB@@BBADAD@B@F@BCBCBCAC@EAC@E@ABC@EACACAECGCCCCCCCACAACAE@EACBE@ABCBAB@B@BBBDBFBHBDBDBDBBB@BABABCACAGACAEAE@CAC@OBC@GBC@CBAD@B@BBDBBDBFDH@FBHBDBBDBDABABABC@E@IAE@EBC@CBADAB@D@DAFCBC@C@CACA@C@GAACACACBC@ABCBABCBADAB@BBBBBDBB@DBDBF@BBD@BD@DDDBFBD@BBB@B@BBBDBD@FAD@F@FBBBBB@FAB@DBBBBDBF@DADAD@B@DBD@DBD@D@F@DABAJGHCDCDCDAH@F@D@BDBB@B@BABCDAFAB@BBBDBDBF@FDBBBB@B@D@BAD@D@D@D@@DBHBFAF@FAF@DBBDBBFBDB@B@D@BABCBABC@CBABADABBBDDDDB@D@DBB@D@DBF@HBDBBBBD@B@D@B@BABABCDAB@B@BBBBBBD@B@BABABABABEDABC@A@A@EBG@E@C@ABCBABAB@D@BBBFBFBH@PAFBFB@BDBBDBFBD@D@BBDDDBD@B@BBD@BABA@ADAB@B@BABBBBDFBHBDBDBDBB@@DBDBDBD@B@BABABABABC@C@GACBABAB@B@B@@BBBB@D@B@BBBDBDBD@H@F@F@LBFBBBBB@DBB@DBBBD@BADCBABIBCDAB@B@B@D@B@BABCBCBAB@B@BBDBB@@DBDBDBDD@BBDB@BBBBB@DBD@D@DAF@FAB@D@BBDBBBFBBBFDFBFBFBB@DDB@@D@B@DEFAD@B@D@@BBBBDBB@D@D@BADCBEBCBCDCDAD@B@D@FDFBDD@B@BDBBB@@DBFHDFBB@FBDBDBDDBDDHBHBD@DBBBBD@BAB@BGDKDCBABABAD@BBDBBBBDBBBD@J@H@F@D@D@H@F@DBDBBBD@H@F@JADAF@DDDBHFBBDBBBD@DBBDBF@D@DAH@B@@BBBBB@J@D@FDDBDBBD@DBB@BBDB@BB@@DBB@BBDDBDDFDFBFFDDDBBDBFDFBBBBBBBBD@H@D@BBBFFDFBBADABEBCBCBCBAB@B@BDDD@D@F@DAFBBB@BABCDCBAB@BBDBBJBD@DBB@D@B@DAB@BB@@@BCBCBCBEDAD@FAD@D@BAB@B@DBBBBB@BB@B@B@BABABAB@BA@CBEDABCDEBADCDAD@BABBD@D@B@BAD@D@DDBBDBBD@DD@FA@C@@DAD@DDFAB@B@BA@CAA@EBA@CDAD@D@DBHBDD@@BDB@@BBB@B@D@B@B@B@BB@B@B@B@BABAB@@@@AB@@BB@@@@B@B@B@B@@@BAB@@@@@B@@B@BAD@@@B@@D@B@@@@B@@@@BBB@FABB@@@@BDDDBBBD@BBB@BB@BBDBDBDBFBHBDBB@BBDBBBFDBBF@DBDDB@@B@@BB@@BBBBFDBBBBFBBBDDBBBB@BBF@BBDBD@BBB@BDBBBB@B@BB@BBD@D@B@BB@@DBBDBBDBB@DBBBF@B@B@B@D@BB@BBBDBB@@@BDDFDDBFDDBB@B@D@D@NDJDHBBBDDBB@B@B@BCDABA@@B@BBDBBBBB@DBL@H@DBNDF@BBF@BAD@BAD@D@FBJDHBJFHFDDDDBD@B@@@B@@ABA@C@A@C@@@CB@BA@@BBBBBB@FBB@F@DAB@B@BBFBDDDDJFBBDBB@DBD@F@FBB@BBB@BB@BB@@BAB@BBB@BDF@BBB@D@@@BAB@B@@@B@@@@ABA@E@EBC@ABA@@B@B@BBBBBBBFBD@D@@AB@BA@@F@H@D@F@DBD@BB@B@B@BADADAD@@@B@BBBBBB@B@BBF@F@FAFBB@B@DB@@BB@@@B@@@B@B@B@@@BB@@DAB@BA@@BABA@AB@@@B@@B@@BD@BB@@@@@@@@A@ABA@C@GFA@ADABAB@BBBBBDFDDBDB@@@B@D@B@J@B@D@B@@@BB@@@BBB@BBBBBBBBD@B@B@@@BA@@B@B@B@B@@@B@@AD@@@@@B@@@BB@@B@BB@@B@@BB@BBBFFB@@B@@@BA@ABABEBABC@C@A@E@C@A@A@C@ABA@@BA@@B@@@B@BB@BBBBB@BBB@B@B@B@B@B@FALCDAB@D@B@@@BB@@BBBB@B@B@D@F@@@BAB@BABCBA@ABA@EBCBA@AB@BABAB@B@@@B@@@B@@DDBB@B@@ABCBEDABABABAD@B@DBD@@@@@BAB@BCBABCBAB@B@@BBBDDBBBB@FBJBB@BBBB@BB@@B@B@@BBB@B@F@FAD@BCJCDAD@DAB@D@DA@@B@@A@@AAEAA@@A@@D@F@LB@@D@DAD@F@B@@BB@BDBB@D@B@B@DAB@BCBA@EFEDA@EBCDC@EFCBADABCB@BAB@B@B@BBB@BBBFDBBFBDBDBD@JBPDB@BBB@@BBB@BAB@@CDAB@@AB@@ABCDABA@CBA@ADCBADAB@@@B@B@@BBDDFDDBHDDBB@FBFDHBJBFBF@J@H@HAF@F@H@F@B@FBFBB@BBBDDBBBBD@DBH@FADALAFCL@DDH@B@BB@BDDBBBDBHDHBHDBBD@F@FAFAF@DAB@DBB@BBBBDBBBDBHDFBFBDBBBFFDD@BBB@BABABABCBGBCBC@C@E@CBEBC@MDCBA@AB@BAB@B@BABAB@H@D@B@B@BBBFDDBFBDBDBD@F@D@HBD@F@H@HABAHBD@D@FBD@H@D@DBD@BBB@BD@B@DB@@B@@BB@@BF@BBB@BAD@DAFA@AF@@@B@BBBB@BB@B@BBDAB@DABBB@DDDDFDDDBDBJDH@HBD@DDBB@DBB@DAFCHCDAB@BADABABAB@B@@@BABCDABA@A@@BABCB@@ABA@@@@B@@@B@B@B@D@@@BBBBBBBBB@BB@DBBBB@@@@B@@@@@B@@CBCBABAB@B@B@@@B@B@@BB@@DBB@DBD@B@DBB@BBDB@BB@FBDBB@DDJFDBBBBBBD@@B@DDFBBBDDBBBDBB@@@B@B@B@D@B@BBB@D@@@BBBB@BBB@BBD@DAD@B@BABABB@@BBBB@D@@@B@B@@@@BB@@@@B@BBB@@@@B@B@BAB@@@BBB@B@B@@@BA@@B@B@@@@ADABBB@@@D@BADCDQNABA@AB@D@BBBBBHJ@BBFDD@@BD@B@@BBDBBBBDBB@BB@@B@D@BABAF@@@BJDB@BBDBB@@B@B@BCD@@AB@B@B@BBB@BBBBBBBJHFDDBFBF@BBBBHBF@RBHBFBBBBDBD@FABADGFEFINEJ@B@@BBBBDBBBD@F@FAHANCD@H@FAJAFAH@H@D@D@F@BAB@DBFBHBD@LBJBNBFBDDFBLFJFDBDFFHDDBDDBB@BBFDFDBBFBB@HFNJBBBHBDDDDBDBF@P@JBPHFBFDBF@HDFBDHFDBBBB@PCF@DAHBB@BD@@@DBDBH@BDBJFB@BB@FBFBBBDDHBFDDDBFBHBV@PBFBJBRAD@D@BBD@BFDJFHDFFFDBDBBBR@N@NDD@DDBB@B@BCFCDAB@BADCD@@@DABBB@BB@BBB@F@DBHBDBDBL@NBH@JBLDHBLBPDLFLDDBB@HFDBBDBBBDAF@DADADKJCD@B@B@D@BDBDDDBHDN@PBJ@FAJAFAFADADAF@FAF@LAJADBD@NFBDDF@B@D@D@D@B@D@DADADCDAB@BB@@BHFDBDBFBF@XEJAH@D@NDHDPDbHJDJ@P@JBF@BBFBFBHB@@B@@@@@DDDBBD@@@DCH@DDJBBADAD@D@DBFBFBBDBDDHHB@BBD@F@B@DBFBFDHFBB@BB@BBBBD@DBFDFFHJBDFLBFFJBFDB@D@B@B@DABGDGFEBODCBEBCBABADABA@@BA@CH@B@B@B@DBBBDB@DJBBLLBB@BFDDDFDHHDBBBBB@DB@@BDBDBFBB@BBBBBDBBBBD@BBD@DAHAHADAFAB@DBDB@BBB@D@B@DABCBEBE@ABAD@D@@@DFDHFFBDBHBHBDBDDDF@DBDBF@HAH@DADEDEFADADBDBDBDDBLFBBD@DBDDBBHDDDDD@D@D@DADEFEDIHGFCBILCDCHKPEDEFABCDAD@@@BBD@D@B@BAB@B@B@BAB@BAB@BBD@@BD@BBBBB@BBBBBBB@B@D@BB@@B@@@BB@BBHDDDBBBDBB@@@B@@@BAF@DABABCBABEBG@QDG@C@A@KAC@C@G@CBA@EDA@ABAB@DAB@@@BABCFADAHAF@DABCDCDABC@@BE@A@IBCBC@KBKDKDEBIDGDEDIJCBCBA@EDCBCDAB@@@BABCDCDAB@BADBDBF@D@F@H@D@B@@CBED@@AB@@ADAD@DAB@B@BBBBDBDB@BBB@F@T@D@FADBD@DDDDDFBB@BAFAHEJAD@D@D@BB@BBDDFFDDBBDBB@FBDB@B@@@B@B@B@D@@@B@@DFFFDDHFHFDDFDFDBBBB@B@B@LBD@D@@ADABABABADADAB@D@D@D@D@DAD@B@B@DBDBFBB@BDDBBFD@BFDDBFDDBBBDDDD@D@BADCDGHEDCBIDA@AB@BAF@B@BBBBDBBBBDBHDLBJDHBDBD@FBHBFBDDDBFFBDBBBB@BABAFGFGFCBCBGBC@C@C@A@G@GAC@CACAE@AAC@C@A@CBA@ABABADCDABAB@BAB@BC@ABA@CBCBCDABC@ABA@EBA@A@A@ABE@E@IBG@E@IBGBE@CBEDEDEBCDABKNCFEDCBEBI@S@K@MBI@GBGFGDIFKF[JMFGBOHIDKFSHEDEBEDMLCDEFCBEBC@G@EAGAC@CAEAA@A@C@CACA@@CAA@@C@@AACAGCECSKIECACCCAEAG@GACBC@C@A@A@A@E@CAEBE@GBG@E@C@A@GAE@UDI@G@IBSAIBUDOBGBC@C@AAKCIAKEKGQIUIOESIKCQKKGECEAGACAG@IBQBgFG@WDI@E@EBE@E@G@KAG@G@G@C@E@EBCAA@I@A@I@C@IBGBKDKFKFIFAB@DCDADAH@B@HBHAD@DABEFADADADEJ@F@H@FFJDJDFDHDHDH@BBD@D@BDDBDDJDFDDFFFFBBB@DBDFDH@D@BADEDEDCFADCH@F@D@DADAFENKPILCBCDEFCFCJAF@D@BBBBDBBDDD@DBB@F@J@T@FAF@JBB@FBPFB@F@L@HBHDB@BBBBBDBDBDDBBDDBFBDBHBLDHBFBFDBBFDFDHBFBJ@H@D@@@BAF@DAHCHADAFABAD@B@D@D@FBDB@BDDBF@BDDBDBBDFJHFFFDHBFFBBDFBBDDTFJBHBFDFBHDJFHBF@FBLBNBH@FDHBNJBBBB@D@FAF@DALAB@D@BBD@BB@BDFBFDFBF@FBD@D@D@F@F@F@B@D@H@F@B@FBD@BBD@BBDBBDDB@DAD@BADAD@DBH@DDFHHDD@DBDBDBDFDHFFBDBF@F@D@L@B@JFHDHDJDHDFBDDFHBDBHBHBF@@FHHJDHFFLJDDNNNJFBFDFBF@BBD@DBDBBBFBBBJDJBHDHBFDDBFDBDDDBDBFDBDBDBD@DBH@F@DBD@DDBFBD@FBFBFDDDFBBDDDFFDFDDBFDFBDBDDHBDBFBF@DBBBDBF@B@DBB@DAB@BBD@DBDBFBDDFDDBDBFDD@DBFBF@FBF@H@HBF@H@FBDBDD@DDDBDBDBF@D@FBDBDBFBDDBBDDDDBF@DBD@FBF@HBHBHBF@DBFDDBBB@D@DCB@B@B@BB@FDBBBB@BAH@BABADGHAB@DBDDBDBF@FBFBDBBBFDHFHDFDFFDBBBDDDFDBBDFBDBHDFBFDFBDBF@FBF@F@FBFBDBFBHDHFLDLFHFHDHFHFFDFBFBD@F@DADAF@DAD@FBDB@F@D@DADADCDADCDCBEDCBEBCBABAD@DBBDBFBDBFBFBFAHBF@D@DBDBD@FBH@LBFBFBDBDBD@DBD@DBBBBB@B@BAD@F@BBBD@H@FCFAFCFCFAD@HBH@H@F@H@FAH@FBDBDDHDFDBDFBD@DBD@D@FAH@JAH@HAD@DAFAD@HCF@FAN@D@DBD@F@J@J@H@F@HAFAH@FAH@F@DBFBFBDDDDDBDDFDFDDBDBBBF@DBH@F@DBH@F@D@D@DC@A@C@CDCDAF@F@F@FADABABA@C@C@EBEBCBADCFAD@FAFBF@FBDBFDFDDBFBD@F@FAFAF@FAD@F@FBFBF@DBFAF@FAH@HAH@FAH@H@F@HAH@H@F@FBFBFDFDFDFDDDF@FDD@F@F@DAFAFAH@F@F@DBDBDBDBFBFBFBHDDBFFFHHFHHFHFDDDDBFBFBFBF@H@H@J@J@JAJ@H@FCD@FAHAFAF@F@F@HAJ@H@D@H@B@F@BBDDDBDDH@FBFAF@FAF@H@H@F@FBHDLDTDjLFO@CFILIHAFAD@HAHADAF@FAD@FCFCFCFCDCDCBCDADADCFAFAF@HAF@DADADCBCDABAFCBADCBADADCDADCBAHCDCBADCBABC@AHAFAH@HBHBJBFBD@D@D@DAD@DADCDCBADCDAJEDAFCFADCFAFABABC@C@AAC@A@E@A@CAACCCAAC@C@C@C@C@CBCBCBCBC@CBA@AEAEAG@CAG@GCAAAAACBC@C@C@AAE@CBCDCDCDADCNEFAFAFAJAFADAFAFAFADAF@DADCDCDCDABCFABAF@H@FAF@D@FBFBHBFBD@D@FCBAJIFCBC@CBCBCBC@ABCBADCFCFEFA@EBEBC@ADEB@BA@AACEECCAAACCA@CBCFCPGFCBEBAFAFCHCFAF@HADADADABC@C@CCECEACCAC@CACAEACGACACAA@A@CCCCACCCA@A@C@ABCAEAAACCCGCE@EACAEACACCwOaEOEGEEIAKKKKQGMMMIIEE@MAQIQQUOISCMEWQ_CCAFO@AGGGKBEVMJS@CGCICKCIIS_[OSGGAQGIEECSCKKAC[SKIOEKAS@KAEACAACAKCAGCCACGCEAKKMCIAEGCQEICKEGEGGEEIGMIECECKCMAAECGGGQGMAM@M@I@GAICY@K@MCGACCAEBGAAECG@MCAC@E@E@EAAGAQKKGECOAIAKEICIIACAEEEICYESEOCQGOGEC@A@CNIDCDADAHAL@FADALGJCJAN@ZDLDL@NBDAJDLHB@HATGPEHEHAHAPAH@D@DADIBMDG@AAA@CBADCF@HBDAFCFGBADADABC@E@ABAB@HAJCDAD@LBJ@FBFADABA@CBCF@HAXAF@BABABA@M@IBEDC@C@A@ABAF@L@JANCNCDADCDAFAHBH@HCFCHEFALBPAJDF@D@BCBA@EBCFANKR[PCZH`FTAZMPOJEZAbBjDPCFGFKZKVKDCaiGQXKVMXSLOLGHENS@[COBGFA@A@@GAA@C@@@AAA@@@GEA@AAA@@@[@AA@@A@CCE@EA@@BA@@@@@EBA@@JKBAACDE@@@@DCDC@CDABA@A@@CE@@@A@ABEDA@CGWM]M]oEGIGCE@MQg[wO]q£IC@@_IiBMEICCAGEAA@A@C@C@E@G@C@AA@AACAA@CAE@CBGBOFABEDEFGDCBMAA@MAIGC@A@CA]AGAE@AACAFKBA@@@A@@@A@AAAEQ]CC@KAKEEACAGCA@AMCEAAEGCCCCGAEAC@IAGAE@C@EAEBGBA@CBEFCDIDA@GEA@CUAC@CAA@ACA@@EAA@AAE@EAC@C@C@UBAAQG@@@@AKB@@APIDAB@PAD@L@B@FA@@B@@@@@BA@ABABGBC@AB@@ABA@C@@@@@AAA@@AA@A@@AA@@KOACACAECAA@AAA@@@PM@ABE@A@ARWB@B@JDDBJBFAL@D@J@NABAFA@BXRHAB@DBD@J@B@BB@@FBBB@@DBB@@@D@JANBF@D@D@`ZB@B@@@VEH@BADAB@BAH@BBBAB@@@D@JEB@DA@A@@@ACEAE@A@ABGBC@A@AB@VE¦_U^cOpgLiA@@A@@@A@@AACCAA@@@CA@CACAAAICCE@A@@@A@A@A@@@@RMDAFEFC@@@@@A@@AAAAMK@AEGCCAAA@K@@@@A@C@A@@@A@a[IA@C@IA@AB@A@BAA@DOICGAAAA@KGOI@A@@EGMAQDYIEI@@AABAB@EE@A@@@@@CDC@@@@@ACC@@@@@CCA@@M@GCKA@@@@IDABQ@CBA@ABG@KDS@AAAAEAE@ICGEAC@C@@@A@@BA@@@AH@JIDADCAC@A@@@A@A@@@@@CAAAA@@BA@CBE@@@@PM@AFK@@@@@AAKDGAAA@AAKGFCDI@AWaB@HK@E@@@@FK@@@@@C@A@A@EBA@@@AACAEUIACDA@EAC@@@AHANKBCDGTGAK@@DCBI@@@ABAJIDC@A@C@@EIQEA@AAOBSEIAA@@@EB@@EBAA@@KCC@@EBA@ACCEC@@@@CA@@@@@@@@A@@A@@A@IIAAAAAAMGA@@@A@@@YFK@ICCEHEAE@A@@FM@@FA@@@A@@@@@AEA@@@@@G@@@E@@@A@A@@@@@A@@@@@@@AB@@@DC@@A@AA@@A@@ACCA@KCAAC@@A@A@@@A@A@@@@@A@@@@@@@@@@@A@@A@@AA@@AACAA@@@@@@@AA@AC@@A@CA@@A@AA@@@@EA@@A@A@@@A@A@@AA@@@GA@@@@A@@@@@CAA@AAA@AAE@@ACB@@@@EBAB@@A@@@A@@@A@E@A@@@@@A@@A@@A@WI@@@@AA@@@A@@@@@G@@@A@@@@@@@A@@@A@AA@@@@A@A@@@@@A@@A@AACCAAA@@@AA@@@@@@@@AA@@@@@@@@@A@@BA@@@@@@BA@@@@@A@A@@@@IAG@@@@@A@@@A@A@@@@@A@A@@@@BA@@@@@A@@AA@@@A@@@@@@@@@A@@A@@CA@A@@@@@@@E@@@A@C@@@A@@@A@@@@@A@@AA@@@@AA@@S@@@@@A@@@AAC@@@@@CACAA@@@AAA@@@@@@@A@@A@@@@@AAA@@@A@@@@@@@@BA@@BE@@@A@@EAABC@C@CAC@@@A@@@@@A@@@@@ECA@AA@@A@@@@A@@@@A@@AACA@@@@@@A@@A@@AA@A@AA@@@@A@I@@@@@@@A@@AA@@@A@A@CAA@A@@@IDE@@@@@A@@@A@A@A@@AA@A@A@@@@@A@A@@@MAA@@@@@@@@BA@A@A@A@@@IF@@@@A@A@@@@@@@@@@@AA@AAA@@@@AAA@A@A@GAAA@@A@@@A@@@EBAA@@A@A@A@@AC@@@@@@@@@@AA@AECA@@A@@@@@A@C@A@CBA@@@A@@AA@AA@@A@@@A@@AA@CA@@A@@@E@@@@@@@@@@@@@A@CBA@@@A@@@A@E@@@A@@@@@IA@A@@A@@@@AAA@@GK@@@@@@@AA@@A@@@@@C@K@A@@@@CC@@@@AAA@@@AAA@C@EAA@@@C@C@@@CA@@@@@@@@A@@AA@@A@@AAA@@@KE@A@@@@@E@@@@B@@@@G@@@C@@AA@@OKBA@@@@@ABC@A@@@A@ADA@@@A@@@@@@A@@@@ACA@@KA@@GE@A@@@C@@@@AA@@@@AA@@EA@@@@FC@@ACAC@@@@@@@ABEB@A@@@@@@@@@A@A@@@CB@@@@A@@@C@A@A@I@A@AAA@CC@@CA@@@AAE@@@A@@@@@A@@@@@@@@B@@@DADA@ABA@@@@@@@@AA@@@AC@@A@@FG@@B@@A@@@AA@@@@A@C@@A@@AA@EA@@A@C@@AA@@@A@@AIM@@@A@@@@FK@A@A@@@GB@@AB@FCB@BGBGBA@A@A@@DE@@@A@@@AB@B@B@DA@@DAFEAGIGDABABAB@@@@AB@@@@@AG@@A@@@EACCAAAACEA@@@KO@@@A@A@CBAA@@@CAECAAA@CA@A@ABABA@ACGAAACAACACCAA@AAEACCAEAA@ACACGCCCEEACGEAA@ACAIAAAE@CBC@A@GBCBA@C@EBCBCA@@CAAC@A@ADC@AACAAA@AAAA@@@A@AB@BA@AACCEACAAC@A@C@A@GDID@@ADCBEBI@MGC@EACAC@C@A@AB@BA@BFA@@BABODC@CB@BA@CD@@GJ@DABCBC@C@OCCACC@@A@C@EBK@AAAAEAK@E@AAGC@@@FABEB@B@D@B@@ABC@EDABAB@B@BDF@BBDBD@@DFDB@BA@@BCB@DA@C@C@EBCBM@AAE@EAE@ICACCACAQACAA@GCA@CBKBQGGAC@@@G@CBC@@@ABC@GAC@EAC@@@BBBBB@@BB@@D@FAFCFA@eFIDGBA@@BB@DDHJBB@B@@CDEDGBA@C@G@E@ABABGHA@A@EBABB@DBDB@B@@@B@@@@ABAF@B@BCFADAD@@IDIBEBCBGDCBCBGDCBGH@@A@@@CAECC@AAC@A@C@CDMJC@CAA@C@AAC@@BGAA@AA@@A@ABCDEB@BCDA@GCEE@A@@ECAA@C@ACEAAAAG@S@CBGBC@E@@@A@AAAACAEAA@E@EBC@C@C@OAE@E@MBC@CAI@C@E@A@C@CBE@QFC@A@@A@A@@CC@AAAQICAIEICA@GAS@BE@G@A@EBABCAEAAC@CAA@A@MI@@AC@CBAACAACGGAAA@A@EBADABABABA@AAG@EACAACCCKAA@A@C@C@A@@@CAC@@A@I@IACAIC@@@AA@A@AB@BABEDC@AACCCAC@E@CAECCECAAAK@AIAA@ABABA@A@@EICCE@AABGAA@@ECA@ICGECAIIA@E@CAAAAACAA@AB@@ABAAC@ECI@ECGCC@AAEC@@BABAF@DABC@EEEAA@C@CBABC@A@AAACG@A@A@A@A@@CAAA@@CKCCAAA@ME@ACEACAAAAECC@KCA@ACAACEMGAACA@A@EAA@@@@BADABA@CAI@AAAA@CAA@@ABEGAG@A@CA@@AA@CAAA@C@G@A@@@CI@@EECIAC@CAC@E@@ACAC@A@@@@@AHC@@CC@@AG@E@A@@GAGCA@AIA@@@G@I@K@A@AAAEEMBCAAEGFADAJAB@D@B@D@D@D@DADCDCB@BABAB@@@B@DBD@B@FBDBD@D@J@FAJAD@BAB@BAB@F@B@FBBBB@DBD@B@JABBFBFDB@BBB@BBHHBD@@HBFBB@D@B@FAD@FCB@D@DBB@DFDBBBD@B@BAB@BBB@BFBDHDB@H@NBJ@DDD@H@HBHAL@@@BA@A@@RKD@FBDDDBFDB@B@BDD@HBNFB@DBR@J@H@BBBDB@DBDBDBD@BBDB@BBB@ADABAHAH@D@BBDDB@D@D@FAB@BBB@B@B@DCB@B@@CBADA@ADAFC@@DABAD@FAAAAE@AD@@A@A@ADEHABCBA@A@CA@CAAAAAAA@CBADEJABCHABA@@@@AC@AHABABE@A@A@CDA@ABCBA@@@@@@@D@@DBHDF@DDJFHBF@D@BBB@BBNDB@DDDBDBFBB@@BD@F@D@FBD@B@D@BBR@D@FAB@D@BBD@BBB@BABABCB@AAEBAAEECAKGCEEC@A@C@A@@A@GAEAC@GAAA@@AE@@II@A@A@ABC@C@AA@AAA@GAA@C@AAAACCAA@@E@A@AAIIA@GC@A@@@ABAAA@AAEAA@ACAA@EAC@AAAAA@ACA@AAA@AA@ABE@CBE@A@AECAAAAAAC@EEAAC@A@C@AAI@@@AA@AAACGA@@@JEDA@@@C@A@C@AB@BABA@@@A@AB@BA@@BABC@AA@@ADE@@@@ACAC@@@A@@@A@@AA@A@@BCBA@ABABC@@AA@@@A@AB@BABCBA@A@ABAFK@AB@@A@C@@@@BABA@A@C@C@A@@A@AA@@GAGACAEA@@AA@@@ABA@@BAFAB@@A@@@A@@C@@@@AAA@A@A@ABA@@@A@C@@@A@@A@AAGCA@AA@AAC@EAE@AAA@@@A@C@A@@@A@@DA@AB@B@B@DALCBA@@@@@ADB@AD@D@BAB@B@DAD@LAB@@@BA@EBA@AB@@AAC@ABE@CAAACAA@AAC@CBA@@BAB@BC@AA@@E@@@@@A@@B@D@B@DABABAB@D@B@B@@@DAB@B@@@@K@CBADABA@@@AB@@A@@BAB@B@BABA@AB@B@B@B@DBB@BBJAD@HAB@H@FDB@B@B@DAB@BABADAD@B@D@H@FDBBB@B@DBB@@@B@B@B@BA@@BA@@B@@@FDFHBBB@H@BBBBB@@@HBHBD@FBDBDD@BBD@DDDBBFBDBB@HBB@BDB@B@F@B@D@B@F@D@BBDFDD@BBBB@DBB@D@H@BB@@BBDFB@BBB@FBB@B@B@@ADA@A@@@A@ACC@@BADC@ABA@EBA@AB@BAD@D@B@D@BAB@@A@A@AA@@A@A@ABAB@BABADA@AB@B@D@BADAB@BCBC@A@ABA@ABADADADCFCFEDABCB@B@BCBA@AAAAAEAAABA@@@@B@F@D@B@B@BADAB@DEDGBCBABA@ABA@A@AAAAACACA@@@AFADA@@BA@ABC@A@A@C@C@A@A@AA@@A@A@@D@B@BA@@B@@A@@AA@@CACCC@AAC@@@AA@AACAAEA@AA@KCIEA@@AAA@ACAA@CAC@E@CACACACCACAC@AAAACAA@EAA@@AACAGA@AA@@GAA@@@@A@A@E@C@@@AA@@BA@@CGAA@AAG@AB@DCBABE@A@@AC@AAAA@@A@@D@B@BA@@BA@A@@BAD@FCB@@@@CBA@@B@BAD@B@@ABA@@@I@A@@AA@A@A@@@AB@@A@@@@@AA@BCBA@A@@@@D@B@B@HBD@BAB@BABCBCB@BAB@BBD@@BBB@@@BEFADABBBBBBBB@F@HABA@@@@@EB@@@@AD@B@B@DBDBDDDBBBDBJHFDDBB@@@FAB@B@D@D@DBD@D@B@H@D@D@H@DBDBB@DBB@BBDDBDB@@BD@BAB@B@BABA@A@AB@B@J@F@D@F@B@DBD@@@BAB@D@@@BABC@A@@BAB@DA@AB@BABA@@@ABCBABADADBBAB@HCDABA@AB@@AIEAAC@AA@ABAFGBAB@BAF@B@@@HGB@BAD@BA@@@CB@DGB@FABA@@BABA@AB@B@H@B@DBDBB@B@B@F@D@LADAB@B@LI@@@AIKAAGEDAB@DADCDADAF@DCB@BA@A@@FADAD@BA@@AI@CBCDEBADAFCDEBADA@@@A@@ACCA@@@A@@D@FAD@D@@@BADADCFIDEHKHE@ABC@A@@H@BAB@@ABI@@DAB@@@DC@A@@AAAA@ADCDADA@@@AAE@@DABA@@@CB@BC@A@AAA@ABCB@@A@@AE@AA@@CBCAA@@AC@@A@AAA@@AB@@A@@BA@@@AA@AA@@AACAGACAA@AAA@IC@@@ACA@AAACAA@A@@@@A@AAA@A@ABABAB@@A@AFKJUHQBGHI@@@A@AEISgjP@CLMHCAECECAEIACHGFANCFMHIB@BE@CFEBGECCCAIFADE@ILEJ@JAHCBACC@CDORAJMRGFGLGKIC_HIB@GGKECAAE@EDGė_vg\\oMUWSGh¥h¥Kuiy@OZEA@BCBEAAAACMAC@@AAAAA@AACCACCA@CBSAEAGCIEMIC@A@E@CBGAECC@CBCBE@A@E@KAC@CCA@ABEDEDC@A@C@ACCASOAAC@EDA@C@C@A@C@G@G@AA[MAAAAIIAAAC}F[DQ@Ii@CIAkKDYBwaYA"],encodeOffsets:[[123086,51189]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("通辽",{type:"FeatureCollection",features:[{type:"Feature",id:"150502",properties:{name:"科尔沁区",cp:[122.255671,43.623078],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@E@aFC@QBWJ@@EIGMCD@EiFUHQ@WAA@iE@BUBE@SDOB[HQAK@_D@ACBE@C@C@EAEBAAC@E@@AE@S@C@MA@B@@C@aAODUF@AA@EBI@A@A@A@CDABEB@@@@@BMHM@CB@@A@A@A@@A@C@EAEA@M@EBKD@J@D@@@@ABAA@@@@A@@@A@A@C@E@E@E@A@A@A@A@G@AAA@@@C@@@G@EEC@CAA@GBA@@@@AA@@AA@E@A@@@AA@@@@B@@@D@BA@@@A@@BAB@B@B@@@B@BBDAF@BAB@@ADEBA@A@A@C@AAAAAAA@AA@C@A@AAE@A@A@A@@@@A@@@A@A@@@@C@EBABCBABCBCDAB@BABA@@BA@A@@AA@A@A@ABCDAB@@KDABA@A@AB@@AB@@KBABIDABAHAD@DB@@BB@BBD@HBRDHBF@BBFAVCPC@DABAF@BSFKB@@AHQHGBAAA@CDCA@F@@AP@BEFFFCB@D@FNBFDFBH@NDB@HDV@D@PAD@LBFABADCFAPGFAFCH@JBD@D@HBDCPAHH@BC^OF@B@HDD@@VJ@DJ@DBD@@BE@CDE@G@@@VB@BABG@@BKB@A@@GA@D@@YCBAGCCA@@OAG@A@CDC@A@E@@@FBAFO@AHO@@BE@EAA@@AEA@EC@KBMD@@@@@AEBEBIB@B@JC@@DHAGJ@RADAH@BB@@BB@CJBB@@@@DAP@@FA@BJA@BDC@BD@FA@ABBB@@GBKFEDA@@BB@@@DADAD@FAD@BB@@@B@@IDEBCF@@CBA@EBJA@J@F@FCFEBCBDDBD@@BD@DAD@D@D@DAFAH@@\\ITIJFGFLADBFA@@BVSLBRBXE@gB@GA@@EU@ADGDI@@LAB@HJ@ZBBEBAJBD@FDFFFFFDBDBH@B@@BJBBBBVHJDFGB@@AD@ZB@@B@@AFAJAAA@A@C@A@AFIDCBEBEVFFBD@`DLBB@FC@CB@@AD@B@FABAH@AADA@B@@FB@A@@@DDBLH@B@B@BDB@@EJDH@@CBA@A@ET@@@@@DAA@D@@@BJANABHE@A@AH^@@BO@@BP@ABQ@@AK@@@DDZ@H@BAFB@@@@@BAHG@ABIAAACPFB@BGA@FID@@HD@BA@@BAFUEBB@@BDBBABA@@@FF@BEBEBABA@IHEDEAA@@B@BA@@BUFEDOJBBPLGRABCFB@ABAB@DEBC@AB@BMF@@KDABABCBCBIB@HEB@B@@ABBBFAJLJLLAD@BA@@FABF@BH@VBADJ@@@@BA@IBCB@BAF@F@FBF@@DB@@@BBB@BD@@AH@ADF@H@F@D@@FD@J@@B@ABBB@@@D@@AF@FCACB@DB@B@@ADZCD@N@DAFAHADBD@@BD@BB@FFCB@F@@A@AFEhIHAFKD@DCBEBC@ADE@CB@HE@@BABA@@BA@AH@BC@@B@DA@CE@@@B@F@@AD@A@H@@@@@@@D@@@D@@@D@@@F@@@FB@@B@@AAAFC@@B@@@@@F@DB@@B@BA@A@@B@@@B@AAF@B@F@B@B@@@@@@A@@F@JBNFADB@PDBBB@B@DB@@BBA@BBB@BAA@BCBB@ADCBEHBRFB@JED@ABH@LBFGBA@@@A@ABA@ABAA@DCB@G@@G@G@C@G@@A@A@BAC@@AD@BA@@DCB@@@@C@C@@B@BKBC@A@C@@BIBGBKBE@@NBN@HBF@DGFB@@D@@HT@@@BBL@D@NBLBH@PBB@AJ@HIAABB@@B@@C@@DLB@D@BD@J@AFAFH@@@HB@@AD@B@B@BA@L@@BF@F@D@@BC@AHD@AL@@A@CB@@@B@BD@FB@@FBB@J@BDN@DB@@@DE@@FAB@BAB@@AF@@@HAH@BAB@@@TF@BBARHF@@AOD@RF@@B@DBADABD@AH@DHD@@GJ\\ZA@CA@@A@@B@@@@@B@@B@B@BB@B@BBBA@@@CAA@ABABLBADGACD@BA@@@DDEF@@DDD@DBD@D@BBB@D@HADBD@BB@ABBDEBAB@@AA@@AHBF@LBH@DAB@FABC@@@@B@BBBBDBD@FD@@D@D@@@BBFBD@B@A@BB@BBH@@FB@BHRAHBFEDD@D@@BBDADB@BBB@BBBBD@FGDABADAD@BAB@B@HB@BBBB@BBB@DBD@B@B@JAB@BB@BJ@D@H@BBABAD@D@@@D@@@@@@D@DADA@@@C@ALCB@DBF@F@@BXB@@B@D@DA@A@AB@@@@E@@@AMA@APB@@@@BEJ@BB@AB@@AAAAC@@BAXB@A@@A@@AB@B@B@BANB@D@DFBBHJA@DJBCPBBLDFF@HAJA@IFKFBBBFEJF@DB@DFHHJDDBBNLNHPLVLNDHBTB@@J@F@AF@@BBD@H@H@B@JAPBRJDBRDB@@@TCHED@BAHEB@DG@@@@DAREBAHBPDnLbHJDB@B@B@\\L@@@BB@LFB@B@LDLD@@LCDE@@FAB@FDB@HBJ@FCKKGGGEaYB@RCGACCA@GCEOAEECCKQG@AGC@ACAAA@AA@@AA@@AAB@@AB@@@@A@A@A@ICC@DACABCF@@@AGAO@E@@B@B@BAB@B@D@BA@@@A@C@C@A@AA@A@C@GBA@ABA@CA@@AAC@[IEADCBA@@I@NMHIG@@GDB@AB@@G@EDCB@@CE@A@A@DCB@AEAAAIDB@AB@AA@@@@@A@A@@@@@A@@BAB@B@D@B@@B@@FABBBB@@B@F@B@B@B@@A@MPB@B@@FDFALCBAD@BN@BBB@@B@@A@@@@B@D@BBHF@@B@@ABAD@BAB@@FB@@@B@@ABA@@D@B@BAD@FA@CBAB@@G@AB@BCBC@CHJB@@@B@@AB@D@B@BBBBB@HJDBHF@@DB@B@BLRJCB@CFB@CFB@@@H@@F@@HB@B@@B@DATGHJB@B@EIH@AEA@@ADB@ABEB@HIA@BED@DAF@@C@@@@CA@@ECA@@A@@@@B@FA@@JAFADEFAH@FADBBBBADA@@D@FA@@DA@A@@@A@A@C@A@@F@B@FADAF@BBF@FBD@JB@@H@FBF@DB@@BBAB@@@@AB@D@BB@BBBBD@B@BBBBBBDF@@@BCDAB@B@BBDBBB@BBH@F@D@DAH@B@HAB@FCBAHGDCB@B@@BB@DBFDBBDBB@BB@@B@D@B@DAHCB@B@@BBBDBF@B@BADA@@DAD@DAH@@@FADADABA@CBA@AAE@@B@@A@@B@BB@@DBD@B@BBLDH@F@B@B@FCDCB@DAB@JAD@BADADADAB@DAD@@@D@B@B@BBB@@BA@A@C@A@CBCBA@AB@@BBD@F@DB@BF@DAD@D@@A@@@A@@@ABAB@B@DBB@@@B@B@B@BCFCBA@AB@AAA@A@C@@A@@@A@@B@FC@ABA@A@AAA@@@@@ABAB@@@P@D@@@DABA@@@AAACCAA@A@@BADABAB@B@BB@BBDBB@@B@B@D@HAB@BAB@@@BA@ABE@@@CBABCDADADAD@DBBB@B@D@BAB@BDBD@@@H@FAF@BCACAA@@DAAACAECCEACDCH@H@JAB@@AC@CACACAFE@A@@A@BC@CAAE@I@@@@G@@AADADEC@@@M@AA@CB@FMQDBS@AB@@@@@AAB@@@CA@@@BA@IEBA@@D@@I@AB@@@AYIBKD[FE@A@C@GAA@EACA@@EAECyOUAM@MBA@MF@C@@AA@@CA@@@A@@AAA@A@A@ABA@A@A@A@@A@A@AC@@AAC@A@A@ADEBA@@BA@@@AA@@A@@@AB@@@@@@@@@AAA@@@@AB@@@BA@C@@BAEG@@ABABABULAB@@EGCC@IAEBE@C@ABCHE@@DG@C@AAC]@oES@M@UAi@G@ODWBCGK@CCMJULYA]N[@Q@[O@C@ASIYCOEE@M@GFIDKGWI]@QAAAMEKKECGC]EYG@GT@BOFEDEBAHUBIKIU@SKS@@SDMEK[@qOABCVAFA@@@AA@@@A@AECCECAAAA@C@qOCAKCA@A@UKBAB@@A@AAAA@E@AAE@@A@@C@@@A@ABA@@@A@@@AAA@A@A@C@EDCBC@E@C@CBC@E@A@AB@@@B@F@DA@A@I@@@I@C@M@A@G@AAEAAAA@@AAAA@C@A@@@A@@@CAA@CCA@CA@@A@AAA@A@CBA@A@E@@@CJGAE@ALK@C@@ABA@AB@@C@@@@AA@AM@EAA@OAA@C@EBE@A@CAC@E@LAB@BE@E@AN@@CM@BAB@B@B@BC@@A@FMEIA@@A@GNIHC@@@@DAHWJ_EGIO]GkDS@A@@ICSHOUEU@SEC@CB@@@AC@@@A@@BAB@@ABA@EBA@CBA@@AA@A@A@@@ABA@A@ABC@A@A@A@@@A@C@@AC@@@AB@@@BA@@BA@A@A@A@@@AA@@@@@AAAAAE@I@G@A@E@@@@AAAAAAAIUAEAGCIM@EGKMK@"],encodeOffsets:[[125058,44411]]}},{type:"Feature",id:"150523",properties:{name:"开鲁县",cp:[121.319308,43.601244],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AADGFDKNDTUE_@]RQACD@BADDDHCBDDBDADBH@ACDA@ADB@DADFBADD@@ALABF@BDBBCD@DDFABB@DDBDABCC@@AD@BADBABF@CBFD@BC@DBBABBBA@AD@FDBB@BDBHBFH@FB@BBB@AADABB@BHBD@DBHAL@F@D@BAD@D@F@DCD@@@D@@CDB@BB@FCHBBDFADFADHBFAD@BABAPV@J@BNP`bF@L@GplPAFbJDK^JHIDB@BHADF@@pW¦A\\\\IP{pBiPIJGZS~@A@@CaB@DD@AFB@BBD@CFCBA@CFEXJIIDBD@CGCMHEFCFFPQFBRKGCDCBA@@BAB@BA@@@C`URIL@@@FBJEA@DCTKBABA@AFIBAZABC@AFGDCC@BC@ABEGA@AAA@CAAEABAOK@CBA@A@A@AAABA@EB@LKDEB@BDAEB@@ADEH@DAHO@AI@Pa@@LE~O@@D@BA\\E@@pKRC@@^GB@REBBB@B@BA@A@A@@@C@EB@AE@E@EBE@ADAJAB@@A@@I@BCUAG@@AAEEB@@ABC@KBIKIKEBAABA@@@AFA@GJADADABABALC@@NE@ABAD@FA@CBABAA@DEBAHQOKAAPIFCVE@AB@@A@AB@FBFCJGB@BAFAFA@AEE@@B@BAAAAC@@AAVFBE@AB@@AGC@@JC@EHB@AEADOBBJBBAH@BG@A@@@@EAABG@Y@CC@@L@@BR@BAO@@AP@@A]@BGB@F@AGMBIB@A@@@CBB@C@@@@FSB@B@DA@@CGFI@@CA@A@A@AKGCA@C@@@BEA@@@ACBBBG@ABEBA@C@@BA@@DEDA@KA_CC@EAUEAFAFCDEJ@B@B@D@BBBIBEB@BA@@@YAC@@BA@EHICUGAAAAAI@@@AAGACECEEEEECC@IAABAFYAI@@GBA@KJ@HCBCV@@FB@@HhAF@AWAQTKAU@@EBCAKBHEIESJ[J@@BGBE@C@C@CBC@CAC@@ACCCDAFADE@E@E@IIBFAB@DA@@DEFAJC@@@A@@AAC@EBC@CBCB@@A@@AB@FCLEHA@@AABAB@@EACD@ACB@AIB@@EO@CB@@@@AADIA@@AA@@ABGBC@QHIGB@CD@@I@AJAFAFA@B@@@@NCLAD@@FFB@BB@FBF@@AP@BGP@BEEA@@F@B@D@DCB@H@PB@@DBHDABZD@@@CHB@@@BLA@AH@BA@AUA@@H@F@DCF@@AC@CAI@@CUI@@CC@G@APED]@AGGOBCDGAC@C@IAG@EDEBOHEBCDABEBKAC@OBC@U@GCA@MCG@EAECMA@E@CDAEEFE@ABO@@@EDBDCB@BBHARGBG@@LATE@ABEBA@CODUDEBAAE@GAQCGAC@AAA@@AA@@CBCBGBAJCBALAcEGAABEB[AE@cG_A@CD@JAGAJ@EGDGCCIBICCBGFEBQAOBCA@EBAAACBAAE@CC@@GG@KCIBKBEXOBADG@IACCCKHIHG@G@E@ECMFAFKA@HA@@DC@AHCFDB@@DDDDCPINFD@BBBIFA@@@EAIFAB@DSDA@GFKGEFDHABBD@DBHEJB@@F@FAFD@AFDBABABKFSL@BEHA@C@ADDAKPAHAD@BBB@JEAC@A@E@@BC@ABJD[DAEGDE@@DiPGDOD@@@AABE@ABAAC@BBA@@D@@@AA@@AE@A@A@ADOLGHDDFA@DB@DNCA@FC@CF@BCD@LA@AHBBEJCBARB@EH@DD@AFCDAJCBABE@KBEBABOHQHI@A@G@QAU@aBMDA@@@ICG@SGA@ECICAAE@KCKDOBMFCAE@A@IDM@G@GBKECAG@GBABCBGAIDIBE@ADS@EBIAIC@@CAG@CAA@@@EAAA@@@GmOaKaA]@U@EHEFUJC@SHOG@@]MEAKEWGcOO@KAAHM@C@A@KAC@C@K@A@A@AAA@C@MCCAGEKIEEECGEKECAA@CAAAKACA@@AAEAG@G@OB@@C@]CQFMBOAI@AA[KEA[UCCCCBC@AICSUWW@@_CEAWHKQQBG@cUqCGEECSC@B@DGAEAOHKFeGWKWEJABCOC@@C@cA@@MAUEIASAGAM@cCSAKAqB@@]@]CqBEADFHHJDVFNFRHHFdBVBhDRDJBZLTLbPj@^@JD`RFB^LPHRDPNPHLLJJBJZXPJB@NFFBHFBFJJFBBBVFFDFDDDDDLJFLBJ@HBFNFTFJFHD`HRBFFFFFDPJLFRHDFJBF@FBND@BLNTDJDFDF@HBN@`NhHLDHHBADD@@@BDBCLLF@BJJNALHFJDD@@QFBBVBBPWEWhWj[JOFa\\HFSRQLWNM`ONSTSVKLKHlAjJFNH@@@JAHDTHLAXAJAKFA`GD@bIJA\\E²AD@fEJHNODW\\UXO@@lJNB]fKNY^CHM\\"],encodeOffsets:[[123998,45031]]}},{type:"Feature",id:"150521",properties:{name:"科尔沁左翼中旗",cp:[123.312264,44.126625],childNum:1},geometry:{type:"Polygon",coordinates:["@@FEBAB@@A@@A@@@CADC@@AA@@E@BABE@@BC@@@@@@AAEAKCA@@@@ADKH@BG@@@A@@@A@A@@B@@C@@@A@A@@A@@ABABABA@AB@@AB@@@@@@@DADAHADADAHADAFADADABADAB@BA@ABAB@D@@ABA@A@A@ABABA@A@AB@@A@@DABABA@@DC@@DARO^WCAEA@@@@A@EA@@AA@@Q@A@EEECB@C@G@DCDC@@BA@@@@BA@ABA@A@@@AB@@@@@@A@@BABCDG@AB@B@@@@B@@BB@@F@BCHB@@DBDBDGDG@@BA@ABA@@@ABEBA@@@@D@D@BAB@D@@AACBABCBCBAF@@A@@D@BCFB@BHA@E@@@A@E@@@CBA@@@@HKFGBEBCBC@@@A@@BA@A@@@@@AAAAA@@@@@@BCBC@@AC@@BA@AB@BGBE@@BEF@B@D@B@FBDCB@DC@@A@@@@C@@@AD@@ABCBA@A@@DA@A@E@@AE@ABCFABABA@CAA@A@C@A@G@ABACA@@CCA@@@@AA@@@@A@C@C@ABAAA@A@C@A@@@C@@@ABC@@BAB@B@@A@ABAF@D@D@@C@A@CBC@CA@@@@A@ABABA@@@A@@BABABCBA@@BA@ABADAB@AA@@@@AA@@@@AA@@CAF@BBDB@@BBB@@BJA@C@A@@BCBAB@@AB@BI@C@A@A@A@A@@BGA@GAA@C@@@A@@@BC@A@A@AA@GC@A@C@C@AAC@A@E@A@C@AB@A@BA@@@@@@@A@@@@A@@A@@@@@@@@AA@@A@@@@@@@@A@@A@@@@ABA@A@A@@@@@A@@@@BABCDA@AB@@ABABAB@@AB@B@B@BBB@@BB@B@@BB@@BBB@@B@@@@A@@@AA@@@@AA@@@BAB@@@@AB@@ABA@AB@@ABA@@@@@C@@@@B@@@BA@@@A@@@@@@BA@@B@@@@CB@@A@@B@D@B@@@B@@A@@@A@@BA@@B@@@BB@A@@@@@AAA@@@@@AB@BAB@@@B@@@@A@@@@@@@@A@A@@@A@@AA@@A@@@AB@@@B@@@@@BB@@@B@@@@B@B@@A@@@@@A@AAA@AA@@A@@@@BAB@@A@@@@@A@@@A@@@@BA@@D@B@@A@A@@@A@A@A@@@@@@@@@@@A@@@C@@@AA@EEA@@A@AA@@A@@@A@@B@@@BA@@@@@CB@@AB@B@@A@AA@@@BA@@@A@AB@@A@@FC@@@A@A@A@A@A@@BA@@BA@@@A@@AA@AB@@A@@B@B@@AB@B@BC@@@A@@@AAAAA@@BA@@B@@@D@@A@@@@B@@AA@@AAAA@AA@@@@@AB@@@B@B@@AB@@@AA@@A@@@A@A@@@@@AA@@@C@@@A@A@@BA@@BAB@BA@@@@BA@@@@ACAA@@A@AAC@AAA@@A@@@@BA@AB@@AA@@@A@@@A@@@AB@BAB@@@B@@A@A@A@A@@@A@@@A@@@@@@A@A@@A@@@@@A@A@@@A@A@A@AAA@A@@@A@@A@@@@AA@@B@BAB@BA@@@A@@A@A@CAA@@@@A@@BAB@BA@AB@@A@A@@AACAA@CA@@@A@A@@BADAB@BABA@@BA@AAAAC@C@A@A@A@A@A@AA@@AA@@@@@A@CBA@AA@@A@A@A@CACA@@A@A@AAC@C@A@ABA@A@A@A@A@AAA@A@@@A@@BA@@@ABA@A@AAC@@@AAA@@AAA@@CAAAA@A@A@A@@B@@AD@@@BB@@B@@@BA@@BA@@@ABEBA@A@A@C@EB@@C@ABA@@@ABA@A@A@@@ABA@@@@B@@@BB@@@@B@@@@AB@@A@AA@@A@@@A@AAA@ABA@@@A@@BCBABAB@@@B@DAB@BAB@@A@@BA@@@@BA@@A@@@@@@@A@@@A@@@@@@B@BECKBEE@@@@ABA@ADEB@@CBADEBCDGBA@@BEBA@@@@B@B@BBD@@B@@@@@@@@@@@B@BB@@@FBB@B@@@B@B@@@DCDA@AB@@A@@DAFCBA@@@AB@BA@@B@@ABA@A@@@A@@@A@@@AB@AA@@@AAAA@@A@@@AA@@C@@@AA@@A@AA@BA@@@@AA@C@@@CA@@AAAA@A@@A@@@@EBCBGB@@C@ABG@CBEBODGBGBGBKDGBE@@@@@MDKBC@ODcFEBA@A@A@A@@@A@@AA@AA@@AAAA@@@A@@@@@@@AA@@@@@A@@AA@@@@@A@A@C@A@A@AAA@@@A@@@A@AAA@A@EAEACAC@@@AAA@@@A@@@AA@@A@A@A@A@CAC@GCC@AA@B@@C@@@@HOhFDBD@@@@GBA@B@mHC@CBODGB]JWFCCA@A@AAEECAIB@B@B@@@@BBWJ@D@@DDBBKDGB@@ABGDSLUNCB@@EDGDKHCBwKIHJA@I@AB@HAFVdADIL@@@@CFCDIDE@E@@BGCC@AAAAAAAA@@EAA@A@@@A@@@@@A@CA@@A@AA@@ABA@@B@@@@@BABAB@@A@CC@AA@@BAB@@ABA@AAACA@ABA@A@@@CB@BCBAB@@ADOA@@CBEDAACBA@ADA@@@@BABB@@@CDAAC@A@A@ABC@@B@@@@GAA@A@@@ADLVBZ@@A@@B@JC@@@ABJFB@@A@@DB@@A@BB@@@@A@@BATRCENA@@DBBN@@@D@CFCBBB@@@H@@J@F@BB@DADB@@@@BEFDBDBDBD@@BA@IBG@G@CDBDDFFDDBBBCB@@BBBDADE@EBG@@@C@CA@ABA@A@C@AAACAC@CBCBCBADAB@D@@AF@BAB@@A@ABA@GBC@A@A@@@AAAC@AAAA@A@ABCBAB@@@BBBDDBB@B@@ABCB@@C@O@@@A@AB@B@@@@BB@B@BAB@BEDA@@@@B@@@BD@B@B@BBA@@BABEDADA@A@A@@@A@CAA@A@AB@B@@@B@@@BC@C@CBE@@ACAE@C@AA@@BAB@DADAB@D@B@B@@AA@AAA@A@C@@@C@CBA@CBCBCBABC@IBA@CBA@CDEDA@A@E@G@KCAAA@C@CA@@AAA@@@@BA@@@BF@BAB@DABCBCBEB@@G@CBC@CB@@CBABA@E@CAAA@AA@A@GDCBA@C@A@@@AAA@CAAAECCAA@@AA@A@CDGHABEDA@GBA@G@CBC@E@G@AAA@AAAC@A@ABADC@A@@CEAAAAAAA@C@AAAAA@@A@CBA@@@@BAAA@@CAE@EAG@@@IAC@EAE@AAE@CBEBA@E@@@@B@D@B@B@@@BCB@@EBC@@@CBABAACAEBG@EBCFEBIB@@EBA@@@@@@BB@FD@@DB@@@@@DE@CBC@AFB@GJA@AF@BCA@BB@BFG@FJA@A@GISHCBA@@@@AGA@@@EG@@@A@DEA@DEA@IDKQ@A@ACA@@GECAGIA@AAAAA@C@A@@BA@@@A@GI@DADADA@@B@HA@AB@DEBC@ABA@C@@@AB@BA@@@A@@EA@ABC@AB@BA@@@GEAAC@A@@@@@@BA@@@AA@AAMC@ABKDEBEC@@@AOA@N@BA@A@A@E@A@@@AAAAEB@@@AA@C@A@A@AB@@@B@@@@@B@B@@@@BBA@@BCABJBBBFA@CDB@B@F@@DA@CD@F@HA@@BCA@HH@GJMNJ@@@ABCDFB\\JD@BB@@DBB@BAB@HAD@B@B@@B@B@D@D@B@@ABC@A@A@ABA@A@@@@FBPBH@@E@ADDBCBD@JDB@B@B@@@@@BA@@BA@BB@@BB@@BBBDB@BHD@BRHDLFDBFFPHDB@DDHBQDA@bZHFHHLLEDI@GAA@ECA@EB@@CFKD@@KCKCA@A@KEA@@A@@[KA@A@A@ICaGmKOCGAABQFCB@@@@CHA@GFABC@GFSD@@A@QCCAQIOAIBA@G@G@C@AA@@BEE@I@@@SAGAMCUKOKMGMKAACCGIEG@CCAE@FIAEAALEJEB@BI@GEEKCAADOIA@CIBAGEA@C@CMAABA@A@A@@BB@@@@BWAAB@@BDBB@BA@@BAAI@AF@@@@OA@BNB@B@@@F@@A@@B@BCBC@A@@@WA@AE@E@CAA@KD@B@D@@CBCBC@@@@@@@@C@@@CBCBAAAG@C@I@@AAAA@IBA@A@C@CAA@AAA@AA@AGAA@A@ABC@CBABCBEHC@AAAAA@AAA@BCAC@AC@C@FCAEBGGQ@AEA@@AG@AAAB@A@C@EAAA@@C@C@@@ECC@CAAAAAA@@@@@ADEBA@CBG@KAE@GA@BB@@BA@ABCFAA@BAAC@CAGBC@A@AAC@C@CAC@CC@@FECC@@B@@ADCHBBCKABABAB@DB@@B@AA@A@AAAA@A@@@@A@@@@@AB@@@DBB@[YHI@@GC@CBGC@BABCCAA@@@QEC@BP@@GEBQAAE@@S@@BA@ABG@G@@BE@@BA@ABA@EF@@C@@CAM@ACI@A@EA@@EAC@@A@A@@DAB@@@BKC@BGD@@AC@E@E@@AK@B@@A@A@ABC@@GA@@G@BEBEI@C@@A@CKA@CD@@@@AA@BAJB@GBIA@OAG@KAMAC@K@AA@@S@@GC@@@EACHE@GAM@MA@@AFALAHAJ@@@D@BADALA@@@@D@D@@A@CD@@ABC@@BD@ABB@B@@@@H@D@H@HH@A@CDB@AB@BAB@B@B@@ABEHKAG@BAC@IFA@QEGAAFCD@BAAADB@ABA@AAB@AA@@CAA@A@AAOCA@BCMEIAE@@@@B@@@@A@A@E@A@E@BBA@@@A@@@@BABA@@@CAE@@@@@A@@@EDBB@BA@@@EA@@E@@@C@@@C@@@C@@@@@@@G@B@C@@BE@A@@@F@@DCBA@@@ADG@@BAB@@ABAB@@GFA@@DCF@BADAFCDC@ELGBgJEF@B@BE@A@ED@EAAC@@AC@CAGBEBCBM@C@YDBC@@@ACAA@BDEDE@@BC@@@A@AA@B@AI@C@@EC@E@G@E@BCG@@BC@@AAA@A@@CA@@A@@F@D@@@B@BABA@A@AAQFA@]H@@QDoL@@[FABC@@@}PKF@@ObJ@@BGPCBG@CF@BA@BFACA@CFKLA@@FABBB@B@B@BAB@DPLABFBBB@DBB@BHBAF@BADD@CDEH@BADYBABEJ@BABABSLCDB@IFEA@@K@QJ_V@D@@ABA@AB@@ABCDHDQLEAOREEEDGFDNDHC@CAJJWIEF@DABED@DAC@AEA@BCCA@Db@@@B}YTIHOJjoAO|[J[¥BoX@@CEGB@ACAGJ]ICLaIAHurRPiP[vEJ@FBl@PEn@@@@~TLNNPfI R@@ZDnTlRLF@XDDV@HDbRhdLNTNTNNLNPWL\\X@B sNHTIPIOIX]LG@@JGJGFCHGPIC@^UfIBAxMæF\\JVF@AbJHDHFPJ´dBBL@JE@@@@@@BABBD@F@F@@BBBBBFDFB@@FBB@BABBDAJ@D@BA@BB@@B@@@B@@@@B@B@@@B@BBBD@@BBB@@@@B@@B@@B@@@B@@D@@@@@AB@@@B@@BB@@BBBBBA@@@BB@@BBB@BBB@@A@BBDBD@BBBA@@B@DC@CB@B@@@DBB@ABB@@B@B@@@BF@B@B@@BAB@@DBBB@@@ADA@@D@@AD@@A@A@@BAB@BBB@BBBA@BBAB@B@@B@BDBDBB@DAPGDC@@B@DAhGPABAFAD@FCHALCDCXULI`QNCJCA@F@VGPCVG@@JALCHCF@JCJAHABAB@DCNIFCF@BAF@@@B@`EJAD@FAJEFCB@HCD@DAD@DAB@BAB@BA@@H@LbDD@NAbGNAhIABA@BBRKFATKBA^KPCB@H@FBF@LBL@R@HBJ@D@H@B@L@T@^BD@Z@H@X@t@D@B@P@B@H@D@J@B@N@@@B@PFNFFDJD@@@@B@@BB@B@FDBBB@D@BBD@FBD@F@FBD@FBHBD@BBFBF@PDHBB@FBFB@@FBD@@@FBDBD@DBD@BBHBFBDBB@HDBBD@DBF@DBB@DBF@B@DBDBF@DBHBD@DBF@JDJBB@D@F@D@FBB@P@D@D@FBD@F@D@BBDBBB@@@@DB@@@@DDBBDDB@FD@@@DBB@DBB@B@BBBBDBBDD@BB@BD@BBBBBBB@BBB@@FD@@DD@@BDBBDDBBB@FFBBBBDD@@NNDDFFDBBBJHFFNLNJBBFDXHJDjLZHHBNDjLFBJBNDNHDBJFJDbRFBJDLH`PJFLJTNLDHBJFXNXPLF@BD@BBDB@BB@@@B@B@BB@@BB@@B@B@BBD@@@BB@@BB@@BB@B@@B@@BFBBBB@LF@BLFJFLFTHXD@BD@FBLDF@BB@@F@T@F@B@RBRBD@LBF@F@H@H@@@D@B@PGAAHC@@@@AC@AAC@A@EFG@E@AGGEGIABADEFEBCFA@A@C@CDAA@CACAEAAAA@A@C@@@@@BCHK@ADGJM@ALQ@@EOCIC@@CBG@G@@U@QEC@@@@C@ABEBABCBE@ADEDIBIDGBEBCBCIB@E@@HCJ@LAB@P@D@DCHAJEDCJCFCB@B@DE@CB@J@DAH@F@B@F@JB@AB@@ABA@@BCDA@ADABA@@@AB@DEFEDC@@@@BA@@@ABABADABCDEFEFEDEFE@@FE@ADCB@BCB@@AB@@A@@BA@@JKX[@ADAFGBAJIDEDCHKBABGDIBIBIBCBEBIBIBGBCACJDDBBOBCHSB@PCLCDAB@@CBADGA@JEFAHCHARC\\GHAB@@AF@JCLABANCFAHB@@@@CCCC@@BCEBAE@AAE@C@A@CDE@AkQ@@@@A@@@@@ACAA@A@CAE@E@A@@CECG@@@EAA@E@@CECECG@@DEBCGIEAEHAA@@CCGBKI@@@EBA@CDE@CA@AAA@@@@A@@"],encodeOffsets:[[126289,45129]]}},{type:"Feature",id:"150522",properties:{name:"科尔沁左翼后旗",cp:[122.35677,42.935105],childNum:1},geometry:{type:"Polygon",coordinates:["@@D]@A@@B@@AB@DCDAFCBAFADCDAFCBAFC@@@CAEAAAE@AAC@AAA@@AA@A@@AA@@MUDAdS|DFIPCPMJOBG@OJKBCf_@@J[BEpVBF@@@BA@@B@@ABA@@B@@AB@@@@@@@B@@@B@B@BBDBD@B@B@@P@@@AB@BABABA@@B@@ABA@@BABABC@@BC@@BA@AB@DK@@@ABA@@BA@@BABA@@@@BA@@B@@A@@@AB@@A@@@ADABC@@BA@@BCBAB@@ABCBAB@BCDA@ABAB@@@DCB@@@B@B@LFFFFFFFFFBDBAHEHEBCB@BCHGBABA@@@@BABAFEDCBAFEDCBABCB@DCDEDA@@DCDAHAFCD@JCFCHAJ@L@J@@@B@H@@@B@@@B@DBL@J@N@PBN@L@FBJ@N@N@HBJ@D@@AB@@A@@@A@A@C@A@AAAACAACAAAC@AA@@@A@@B@B@D@B@D@@@B@DBBBDBB@B@B@D@B@B@B@B@B@B@B@BA@@@@A@@AAAA@C@A@A@A@A@AA@@A@@AAA@@@ABA@ABABABA@@@AA@@A@@A@@AA@A@A@ABA@@BA@@AA@AA@A@@@A@@BA@@@A@@@@@A@@@A@@@AB@DA@@D@B@BBB@B@@AB@B@@A@A@@AA@AAAA@AAA@CAE@AAA@A@@@@A@@@ABA@ADABABADAB@BAB@B@DBB@B@D@@AB@@A@A@A@AAAAAAAA@@AA@A@E@A@@@A@A@C@A@A@A@A@A@A@A@@@@@@@@A@@@A@@@A@@@AB@@@@A@@@A@@@A@@@ADA@ABA@A@A@@@@@@@A@@A@@@A@@@A@@@AA@@AAA@@@A@AA@@@A@ABA@A@AB@@A@@@A@A@@@A@@@AA@@AAA@@@AB@@ABABAB@D@B@D@BA@@@A@A@A@@@AA@@AA@A@AAC@A@CBC@CB@@A@AB@@AB@BAB@@A@A@A@@ACAAACA@A@@@@@A@@A@AAA@A@C@A@A@A@@@A@@@@@A@@AA@CA@@AA@@@@@AA@@A@@@AA@@@@A@@@@@A@@@A@A@@@ABA@A@@@ABAB@BA@@BA@@@@@A@@@ADABCBABA@@@ABA@A@A@@B@@A@@@A@@AA@@A@A@A@@A@@A@@A@@@A@@@AA@@@A@@@AA@@A@BA@AB@BABA@@@A@@@@BADABABA@@BA@A@@@AA@@ACAA@AAA@A@A@C@A@C@@@ABA@@@A@A@A@A@AA@@AA@@A@AA@@A@AAA@AAA@AAAAAA@AA@@A@A@A@C@@BA@@BA@A@@@A@@@A@@B@BA@AB@B@@AB@@@BA@@@@@A@@@A@A@ABA@@@@BAD@B@D@D@D@@@B@@ABABABA@A@@@A@A@@AA@@AA@@@ABA@A@@B@@AA@@AA@@AA@A@@@AA@@A@@@@@A@@@A@@A@@A@@A@@@A@@@@@AA@@@A@CBA@ABA@@@A@@@A@@AA@A@@AA@@A@AA@BA@A@A@A@@@A@@BA@@@@BA@@DABABA@@@@@@@A@@@A@@@A@@@@@AA@@A@@A@@A@@A@A@AA@@AA@@@@@A@@@@@AA@@@AA@@@A@@AA@@@C@A@A@@A@@A@@@@@AA@@@@@@@AA@@A@@@A@@@@AA@A@@@A@@@A@@A@@@@@A@@A@@A@@@A@@@A@A@ABA@@@A@AB@@@@A@A@A@@BA@@BA@@B@@@AA@@@AA@@A@@@@A@@@AA@@@@A@@@@@A@@@A@A@@@@@AA@@@A@@@@@@@@A@@A@@A@@@@A@@BA@CBA@ABA@@@@@AB@@A@@@@@A@AAA@A@@@A@@@@@A@@@@@@A@@AA@@@@AA@@A@A@@@A@@@@A@@@@@AA@@@@@@@A@@BA@@@@@A@@@@@A@@@@@A@@@A@@@@@@BADE@@@A@@@@@@@A@@A@@A@@A@@AA@@@A@@AA@@A@@@A@@BA@@@@@A@A@@AA@@A@@@@@@@A@@@@@@@CA@@AA@@@@ABA@@@A@AA@@A@@@A@@A@@AA@@@@@A@A@@@A@@@@AA@@A@@@@AA@A@@@@@A@@@@@AA@@A@AA@AA@@A@@@A@A@@@@@AA@@@A@@@@AA@@@A@A@A@@@@@A@A@@@@@A@@@@@@@@@AA@@@@@@@A@@@A@A@@@@BA@AB@BAB@BAB@B@B@BAD@B@BA@@DC@@BA@@@A@@A@@AAAAA@@A@@A@@A@AA@@C@A@@@A@@A@@A@@AA@@@A@A@AA@@AAA@@@AA@@@A@@@A@@A@@A@@@A@AA@@A@@@AA@AA@@A@A@ABA@AB@@@BAB@@AB@@A@@@A@@@A@@A@@@A@C@@@A@A@@@A@@@AB@@ABABAB@@AB@@@@A@@@@@@@AA@@@AA@@A@AA@@A@@A@@@@@@BA@@@@DA@@BAB@B@B@BA@@@@@A@@@ACA@AA@AAAAA@@@A@A@@@A@@@A@A@E@A@A@A@@AA@@@@A@@@ABA@@@AB@@A@@@@@AA@AA@@@@A@A@@@AB@@@BA@@B@B@B@@@B@@A@A@@@A@A@@AAA@@@A@@EB@@A@@@@@@B@@@B@@@@@BA@@B@@@@@@@@A@@@@@A@@AA@@@A@@@AAA@@@A@A@@AA@C@C@C@A@C@A@A@A@A@@@AB@@@@@@AB@@A@A@A@A@A@@@@BD@DBB@@@@B@@A@@B@BA@A@@@@@A@A@@@A@@@AB@BCDABCB@@AB@@A@ABA@A@EBAB@@@B@B@BD@BB@@@@@@@@A@@@CA@@@@CAA@AACA@@@@@@A@@@@@A@@@@BA@@@@@AB@@A@@@@@CC@@A@@@A@@@@BA@@@A@@@ABA@@@A@@@A@@B@@@@@B@@A@@D@@AB@@ABAB@BABABEBCBCDEDA@A@AB@@A@A@A@A@A@@AA@@@AAA@A@@AA@@@A@AAA@@@A@A@A@@@ABAAA@C@A@@@A@A@A@A@@@AA@AA@@@@AA@@@AA@@AAAA@@AA@@AAAAA@@@A@AAA@@AA@@@A@A@A@@@A@A@A@@@ABA@@@A@@@ABA@@@A@@@A@@@A@A@@@A@@@@@A@A@AAA@@@A@@@@@A@A@@@A@@@@AA@@@@AA@@AA@@A@@@AA@@AA@AA@@A@AAA@AAA@A@C@A@AAA@A@C@AAC@@@A@A@AA@@A@@@A@A@@@@A@@@@@A@@B@A@@AA@A@A@AAEAA@A@@@A@AAA@A@A@CAC@A@C@AAC@A@@@A@AAA@E@@@AA@@A@E@@@A@@@AAA@A@A@C@A@A@@@A@@@CA@@A@A@@@A@@@@@A@@@@@AA@@A@@@AA@@A@@@@@A@@@@@@AA@AAC@A@AAA@A@C@A@@@A@A@A@AAA@C@A@C@A@@@A@A@A@C@A@C@AA@@C@@@A@A@@@E@@@C@A@A@A@@@A@A@CAE@A@@@A@C@AAA@A@@@A@CAA@A@AAA@A@@@AAA@A@A@A@@AA@@@@@@@@AA@A@@AA@@AAAA@@AA@CCCAAA@AC@@AA@AA@@AAA@A@@AA@@@@A@@AA@@AA@AA@@AAA@@@@AA@@AA@@@A@@@@@AA@@@@A@@@@@A@@@@@A@@B@@A@@@@A@@A@@A@@@@A@@@@A@@A@@@@AA@@A@@@@AA@@@@@AA@@@@AAA@@AA@AA@@@@AA@@A@@A@@A@@@@@@AA@@@A@A@@@A@@@A@@@A@A@@@A@@@A@@@@@A@A@@@@AA@A@@@AA@@A@@A@@AA@@@@@@AA@A@@A@@A@@@@@@AA@@@AA@@A@AA@CC@A@@AAA@AA@@A@@@@AA@@@@@AA@@@@A@@A@@@A@@A@@A@@@@A@@A@@@@A@@A@@@@@A@@@@AA@@@@@AA@@@@A@@A@@A@@A@@@@@@A@@@@@A@@@@@A@@@@@AB@@@@@@A@@@C@@@@@A@ABA@@@@@A@@@@@A@@@@@@@A@@@A@@@@@A@@@AB@@C@E@@BA@A@A@A@@@A@A@A@@@ABA@@@A@@AA@A@A@@@A@@@@@A@A@@@@@A@@@@AA@A@@@AAA@@AAA@@@AAA@@@@AA@@A@@@@@@AA@A@@AA@AACACCEAC@ACEAC@AAA@A@AAABA@C@@@@@C@@@AB@@AB@B@B@BA@@B@BABAB@BAB@@@BAB@@@B@B@@@B@@BB@@BB@@@B@@@B@BA@AB@BAB@@@D@B@B@BAB@@@BAB@DAB@BA@@B@B@DCB@@AD@BA@@F@BAB@B@@@BA@@B@BABABAB@@@B@B@@@@@B@AA@@@AAAA@@AAAAA@@AA@@A@@@A@ABA@AB@@A@A@CAA@AAA@A@CAA@A@@@A@E@A@A@A@A@A@A@A@A@ABA@A@A@A@A@A@AAA@AAA@A@A@CA@@@@@@@@@AB@@A@@BA@@@AA@A@CAAAA@AA@AA@AA@@@@A@AAA@AAA@AA@@A@@@A@CAA@CA@@A@A@@@A@A@@@A@@@A@@@C@CA@@CAA@AAA@C@AAA@CAA@CAA@C@A@@AA@A@A@@@@@A@A@A@C@A@@@EAA@A@A@@@@@CA@@AAAA@@A@@ACAAA@@AA@@A@@@A@@@A@@@A@ABAA@@A@CCA@AAAAAAA@@@@@A@@@ABA@@@A@@@@@AAAAAAAA@@A@@@A@AB@BA@@@@@@@A@@A@@@@@@AA@@AA@@AAAA@@AA@@AA@@@AA@@@CA@@A@@@@@A@@@AAA@AA@@@AAA@@@AA@@@@@A@@@@@@A@@@@@@@@@@@@A@@@AAA@A@@@A@AB@AA@@@@@@@@A@@@@@A@@@A@@AAA@A@C@C@A@@@CB@@AB@@@BA@@A@@A@@@@@@@A@A@AAA@E@C@AA@@A@CAA@A@A@@@@@AB@@ABA@A@A@@@A@CD@@@@A@@@A@@@@AA@@@A@@@AB@@A@@@A@@@@@@@A@@@@B@@@BCB@@A@@@@@A@@A@@A@@@A@@@AA@@A@@B@@@B@@AB@@@@@@A@AAA@A@A@A@@B@@AA@@A@@@AB@@AAA@@@@BA@@B@@ABCAA@AB@@@BA@A@AAA@A@@@C@A@A@A@@@AA@@AAAAAAAAAA@@AAAAA@@AA@AC@@@AB@BA@@B@BABA@@@@AA@@CA@@GCCAAAC@AAA@AAA@A@C@@AA@@@A@@@A@@BABABADA@@@@@@ACEAC@BA@@BABA@ABADABAB@@AB@@AB@@AB@BAB@@AB@BA@@BAB@@AB@@ABA@@@C@A@A@@@A@A@A@GAC@A@E@C@A@A@C@@@C@A@C@C@C@A@A@A@EAA@C@A@A@A@@@@AA@@@@A@AAA@@AA@A@@@AA@@AAAA@@AA@AA@@@@BAA@@A@A@@@A@A@A@CA@B@@@B@@@B@B@B@@@BB@@@@@A@@@A@A@A@A@@@C@@A@@BA@A@@BA@@@@@@@@@A@@A@@AA@@@AA@@AAACC@@B@@@BA@@@@@@@@AAAA@@AAAAAAAAA@@AA@@AAAA@@A@@A@@@GCAA@@AA@@A@@A@@AA@A@A@@@@@A@AA@@AA@@ACAACA@@A@@@@@@B@@AB@BAB@B@@AB@@@@@AA@A@AA@@A@@@@@A@@@BC@A@C@A@@@A@@@@@A@@BA@@@@@BB@BB@@B@@B@@@@@@@@BA@@@B@@@@@@@@@@@@B@@AB@AA@@B@@@B@@@@@BB@@@@B@@@BAB@@AB@@@@@@AA@@@AA@@@A@@@A@A@@@A@A@BB@F@BA@A@C@C@@@AAA@A@@@CBABABCB@BA@ABABAB@@A@@@AB@@AB@@AB@B@B@@@B@@@B@@A@@@A@A@A@A@@@ABA@A@@BCBCB@BA@@@A@@AAA@@AAA@A@C@@BA@@B@B@BABAB@@@B@@@BA@@B@@@@@BA@A@@B@@@@AB@@A@A@@BA@@@@B@@@@A@@@@B@@@@@@@@@B@@@@ABA@@B@@@@@@@BB@@@A@@@@@@@@@A@@@@@@@@@A@EAA@@@A@@@@B@@@@AB@@A@@@AB@@A@@@A@@@A@A@A@A@@@A@A@A@@@A@@@ABA@ABA@@B@@@@@B@@@@@BA@AB@B@@A@@BA@AB@@@@A@@@C@@@A@@@A@@AA@@@@@A@A@@@A@AB@@@@A@A@ABA@A@ABA@@@CBA@@BA@@@@B@@AB@@@@@@@B@@@B@@A@@B@B@@@BA@@@@B@@@B@@@B@@@BA@@@@BBB@@@@A@@B@@@BA@@@A@A@AB@@A@CAA@@@C@A@@@A@@@@@CAA@@@A@A@A@A@AAA@A@AA@@@@E@@@A@@@C@@@AAA@@@A@AA@@@@AB@@A@A@A@@@A@@@@@CBA@@@AA@@C@A@A@@@EA@@A@C@A@@@@AE@A@@@@@EB@@@@A@@AA@A@A@AB@@C@@@A@@@@@A@C@A@@@@@EB@@A@@@@@E@A@@@A@A@@AA@A@CAAACAA@A@@@A@A@AAA@@@EA@@A@EA@@@@AAA@A@A@AAA@@@@@A@@@A@@@@A@@A@ABABA@AD@@A@@BA@ABA@@@A@ABA@A@@@CBA@A@@@EB@@A@CBA@@@@@A@ABAB@@A@@@@@A@A@@@A@A@@@CBA@A@ABA@A@@@A@CBAB@@@@EB@@@B@@AD@@@@@@@D@@@@AD@@@@ADA@@BAB@@@BAB@@@@@B@@ABA@@BA@ABAB@@@B@@@B@@@@BB@@@BB@@DB@@@BD@@@@BD@@BB@B@@@@@B@@ABAB@@CBA@A@@@CBAB@@@@EBA@@BC@A@A@@@@@AB@@A@@@A@G@GB@@A@A@@@A@A@@@A@@@A@A@@@A@A@@@@@A@@@@BC@A@@@A@@@A@A@@BC@@@@@A@A@@@A@@@A@@@A@@@A@A@CBA@ABA@@@CBA@A@@@@@C@A@A@@@CB@@@@A@@@@BA@@@@@@@@@ABA@@@A@C@C@A@C@A@E@A@C@E@A@ABA@E@A@CBA@A@E@C@E@@@ABE@CBA@C@C@C@A@A@C@A@A@A@A@C@ABA@C@CBC@ABA@C@C@A@C@C@@@@@A@@@@@A@A@A@E@CAA@C@C@CAC@C@CAC@CA@@A@E@A@C@C@@@E@AAC@A@A@A@CAA@C@C@C@C@C@A@A@E@E@AAE@@@A@A@@@AA@@@@A@AACAAAAAAA@CAA@C@A@C@A@@@A@ABAB@BC@@BABC@ABA@@@@@ABA@A@A@ABA@C@ABA@ABA@ABC@ABA@A@A@A@A@A@A@A@@BA@AB@BABABABA@ABA@AB@@A@ABAB@BAB@BA@@D@B@DA@@D@@@B@B@D@B@B@D@@@D@B@B@@@B@@@B@B@B@D@BAB@D@F@@@HAB@F@DAF@B@D@B@B@B@D@B@@@B@B@@@B@D@B@@@@@B@BAD@B@@@B@BBB@@BB@BB@@B@B@B@@@B@@@BBB@F@FBB@@@B@BA@@@CBA@ABC@A@CBA@A@@@@@A@A@@BA@A@@@A@A@AAA@AA@AACAA@AAC@A@CAA@AAA@A@A@AACA@@AA@AA@@A@A@A@E@AAA@@@AAECEAAAAAACAA@@AA@@@CAAAAAA@AAA@AACAGACAA@AAA@A@CAA@AACAA@AAA@@AA@AA@AAAAA@AA@@A@A@A@C@A@C@A@C@@@@@A@A@A@AB@@C@@BA@ABC@@AAAAAAA@A@AAA@A@C@@@C@A@A@E@A@C@C@A@A@A@CAABA@A@ABC@@@C@C@A@@AA@A@@@AA@A@@@A@@@@CAA@C@A@AAA@CAA@AAA@AA@AAAAAA@AACAA@A@@@ABABA@@B@@@B@@@@@@@@@B@@@B@@BB@@AD@@@@@@@BABA@CB@@CBCBCBA@A@A@A@AAC@A@A@CAAAA@@@@@A@@B@DAB@BAB@BAB@BA@@@A@ABA@@@A@EBA@@@ABA@C@A@@@A@A@A@C@A@@@A@A@@@A@@@A@A@@@A@ABA@A@A@@@@@A@A@A@@@C@A@ABA@C@A@@@A@C@A@A@A@@@A@@@AB@@@@A@@@@@AB@B@B@@@B@B@@@BA@@D@@@@AB@@@@A@@@A@AB@@A@@BA@@@ABABA@ABA@A@ABABC@@@AB@@@BAB@@@B@BA@@BABABCBAB@@ABA@ABABCB@@@BA@@BA@A@CBA@CB@@A@A@A@ABA@@@A@C@A@A@A@A@@@@@A@@BA@@BA@@@B@ybUNOLk^ITC@@C@AIAGGMBA@C@E@ECC@@@A@CACDA@KBEAK@ABCDG@E@BEc@W^BBAB_@MVA`CR@\\EHNBDF_DQb[ZU@kBSGe@A@@BO\\EL{HE\\IHOLGBIDABGJIHAHMP@DOEDHOFM`@@ALABIR@`FRCJGJEFA@ADCJABAHBDBB@FAFOXEFMTGRGXAR]XIZBF@@JL@DDHBBAHDL@BCPCHB@BAB@@@@DA@ADE@GNAXBN]RGBMLA@KDUDGBAHS@EFB@ILQLABBDMJGBOJJNcJOJlU^]NJ@LAFDAFEAMDALTGKHLGDDBD@JCHABWPAFALDJ@LHH@@DDF@BBDABBAB@FDBPARBFAHEDAJDJADDCHFHI@HBIBC@@D`BdHF@\\BFABAHBdF@@BA@@BAB@B@BALC@@BADCBAB@B@B@@BB@B@@AB@BA@ABADCDABADABAFAD@@@@@@B@B@@@B@@B@B@B@F@BBB@D@B@@BBBBBBB@B@D@B@BABCF@BA@ABE@CBAAA@@@A@A@A@AB@@@B@@ABC@@@A@@@@@BB@@B@F@B@@BB@@B@@B@HAB@DBD@FFH@@@D@@@B@BBH@B@B@B@B@F@F@F@D@B@B@@@B@@@@@BBBA@@@@@C@ILCFAN@B@BF@F@D@BB@B@B@@@DAN@NG@A@@@@FABADCB@B@B@J@FAB@@BVEPCbBD@@@@ANBD@T@F@@BF@D@BBFAFBD@D@F@DA@B`CL@RB\\GPATCF@VA@AjFB@XBR@VGjE@FDCHNFJ@@XIRAD@bEF@F@L@LNFHN@DJBHBFJVBBBBBB@B@@F@B@H@J@F@BBBB@B@@@@BB@@B@B@B@B@@AB@@A@@BA@@D@@BD@B@@@B@B@B@D@BAB@B@BA@@B@B@B@@BB@DAB@FAB@BA@@BA@AB@@@D@@B@@DAD@TFV@VFGPDT@JB@T@lC^HJPFHI`GXCB@@@@GDMJ@H@BB@FJENB@@@ADA@A@A@ABN@@DM@@B@FAFA@KBF@D@DBB@F@FAD@B@PBB@FBN@@BBB@@@@@DA@@BAB@BD@L@BKF@HBDI@@F@B@B@DAB@B@BBB@@@DBB@DDB@DB@@B@@@B@D@B@BB@BB@BBFBBBH@B@N@D@J@@@J@B@B@@C@E@A@@BAB@F@D@DAD@F@D@DAFCD@B@B@B@BB@@B@@@B@BAB@@@D@@@@BF@BBF@B@BB@B@BA@ABVLB@B@LDDBrPD@B@BBDBDFFD@B@B@@BB@@B@BEDUBArP\\@FLCN@TT@TLV@LJAJGVABCFEFAPS@@HZH^FHDFDLLNFBBRB^@XJLHJCHEN@F@PFZDTJ@B@D\\PR@\\@^MZBVKNIDDL@DHXAPCH@j@VBN@T@pF^@BD@B@DCH@@GFAD@B@DAFBF@JDDFH@@BAVKBABABA@@FHAB@@@DAB@@A@@B@@B@BB@@@@@@@@A@@B@@@BB@@B@@AB@@ABCF@B@B@BBD@BD@@B@B@BB@B@B@B@BAB@B@B@BB@@@B@@DB@@BB@@@DNEB@NAN@VBzPFDFB@@DBFBB@HBD@B@F@\\ELCJAKUBC@@B@B@HB@@@@@AD@BAB@B@D@BBDC@@A@BA@A@@B@BCB@DABBFCDA@@PBBC@@BADA@ADA@@B@B@BAB@BDBBB@BA@@BA@AB@@BDDB@@@BABA@A@@@@@AB@BA@@BBB@@@DBB@@@@@B@@@B@B@FB@@BBBBBBBBD@HD@AF@F@JCDCDE@@@@JKBCUcBE@GBAJ@B@IJGxLDALGHCFC@@DAVMTKHCBA@@HALCAACC@@@CXIAA@@@@@A@AJADBFFBBB@B@DDXE^IHAPCDAD@nGA@B@HA@@@@ACECPg@G@@D@@@@A"],encodeOffsets:[[126264,44596]]}},{type:"Feature",id:"150524",properties:{name:"库伦旗",cp:[121.8107,42.735656],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAA@AAA@@AA@@AA@@AA@@A@@AA@@@AA@AAA@CAC@@@AAA@@@C@A@@AA@AAAAA@AAA@AAC@AA@@A@A@A@C@@BCB@@A@@@@@@@ABA@ABA@@@AA@@A@@A@A@@BA@@BAD@B@BA@@@@@@AAA@A@CACAA@@@@@CA@@A@A@A@A@@@@@A@AAA@A@A@A@A@A@AAA@@@A@A@A@@@C@C@@@A@@BA@@BAAC@C@@AC@@AAA@@AA@@@C@@BA@A@@AAA@@@@A@A@@@@@@A@@@EPGFFFEDCCCLFAKPH@@BMPDTYGBFA@@@ABC@EB@BBBDJHDDBBB@BAH@@@B@@BBBBADBABAD@BB@BFB@B@DHBBDFNA@EACAECEDK@E@CBABEDL@D@BB@FDD@BF@@FJ@@@@FADC@AFABCBCDABCBC@IDGBABABDBHDBF@FE@E@A@G@@@CFABCFA@[LEDSHDFA@AB@@AB@@AD@@BFFFADGJZF@@CBA@@D@BAB@@@@NHB@CRA@AL@BFEFFDB@BJHNJ@F@@KNEPFDFFDD@BJEFC@@FAB@@@@@A@ABGDCB@BNCF@B@@@B@@@B@@@@@@B@@@@AB@B@@@B@@DD@B@B@@AB@B@BAB@DABCDAB@DABAD@B@@@@DBFBDB@@@@@B@BABA@@B@@@BB@@B@D@FAH@@@B@B@BBB@D@DB@DABDBDB@B@DCAC@A@ABCBCJFB@DCHB@DAB@BEAC@@F@FDBB@AFD@ADADDABBD@DD@D@@ABB@@BD@@BCAECEAAACACB@B@@CNCDINCJAFQDADCBABC@@B@N@BBDCB@@@B@TB@AJEB@D@BABB@@B@JALAF@D@FAB@HIB@T@F@BDBF@DBFFDBJHJHFHBBF@DABB@@BD@@B@@@@@@@A@@B@@@@@@B@@@@BC@@@@B@B@@@B@@A@@B@@@@@B@@BA@@@@@B@@@@A@@B@@@@@@A@@@A@@@@BB@@B@@@@@@A@A@@@@B@@@@B@B@@@B@@@@BA@A@@@@@@@BB@B@@B@@@@@@@@AB@@@@B@@A@AB@@@B@@@B@@@D@F@FBB@@FF@@@J@X@LKLCFCDADAFAB@@@BBB@B@B@@@@@@@BBT@BHT@@@BBFBFBDDNBDrBdBL@@[\\GHEFkZONGDEBM@AHC@BBFFAXBN@VCLFHIN@@AB@@@DANNRH@L@vHVBB@BDDHDDBB@F@HCDABADCFABELABCHKRCJ@D@D@D@DDD@BJBD@B@JB@@JAB@LGFALM@AF@FAHAD@PC@@D@BAB@DBD@@BHBFBD@DBTJD@DBD@XEDAB@BE@@@CFAXMJ@B@F@@BHBBAF@KDBBBBB@@B@DBJHBB@@B@BDBBDBB@FH@DA@@B@B@B@D@B@@@DBBJBB@JDFBB@DBBDBBBBBDDF@BBB@B@@@BBD@B@@@DBD@BDFBB@BB@BDBBDFDDBB@BBB@B@B@B@@@BB@@B@@@BB@DD@@BBBB@D@DBB@@BBDDDDBB@B@B@@AD@B@@@BANG^B@DBFDBBD@JAH@J@H@PCF@B@L@fQFBFEhILEZCFALFb^@@BTDDGJ@DCDGPCHCR@BJFCH@FHDFHJNIHBFPFCNTNBLHDCJ@BD@JDCBAB@JANDA^MV]kPIdIIMPIHANIACBARKJKA@FET@BGHAVCLCB@NKHA^QAMBWHMF@BCB@@C@@A@ABA@DGDO@ACKBGAACG@CIK@@AEJY^WBQHWHQNSFEPWBE@EAAACBGBADIBCB@FEHIDIEQ@_JQBABK@@N_PECGPF@CNOBGJGHIBAJCHAPKJGF[|GFKP[@AB@f@THlAV@\\YRa`CCEMAFG@[DQB_NU`@BAAAX]d@AFF@H@DCBAL@FBLAB@DCDBB@@@D@FDF@D@B@NAHHJB@B@DD@JSl]PKVMzaA@A@@@@@AA@@@@@A@@BA@@@ABA@C@A@C@ABA@@BCBC@A@@BA@A@C@A@@@@AA@ACC@@@AAG@A@G@@@CAC@A@@BA@@@@@A@A@A@A@@AA@@@@@A@@@A@AA@@AAA@@AA@@@@A@@@@@A@@@@@A@A@@AA@@@@@@@@@@@@AA@@@@B@BBB@BBHADADABG@EAA@KACAC@@@AACA@@A@@@C@@@C@ABA@A@@B@@AB@@A@@@A@@@A@@AB@@@@A@@@@AA@A@@@AA@@@@@A@@B@@AD@@A@CBA@@@A@@A@@@A@@BAA@@@@@A@A@EBC@CBA@A@EDA@E@C@C@A@@B@BBBBB@BABADC@C@A@ECC@A@ABCBCBCBEBEBIBG@A@A@A@ABCLAFA@ADA@A@C@EAECGAE@C@AB@D@BBDBB@BADA@EBEBABA@A@C@A@MECAA@A@@A@A@A@@@ABA@@@A@@@AA@@ACAC@CAA@A@CACAC@E@G@AA@@A@AAAEACAAA@AAC@CBKDGDGBG@CBE@CACAAC@C@EAAACECCAAA@BABAJA@@BA@CD@@A@C@CBA@C@@@A@C@EAAAAC@@@ABC@EBC@@AA@AA@A@C@A@E@C@C@ABA@I@EBAAA@@@@CCAAC@@@A@@DGFKBABC@A@CAC@AA@CAC@CBCDABA@ABA@AAEAACAA@AA@@A@AA@@@@AC@AAA@@@AA@@@@BABADABA@A@@@AAAAAAA@@AC@@@A@@B@@A@@@A@A@A@@BABABA@@@A@AA@@@AA@@A@@AA@A@A@@@ABAB@@@@@BAB@@A@ABC@@@A@AAA@AAA@@A@@@@@@@A@@@@A@@A@A@@AABA@@@AB@BAB@B@@@BB@@@@BB@@B@BAB@@@B@D@B@B@B@B@B@@ABA@@@A@@A@@@AAA@@@A@AA@@@@AAA@A@@@A@AAA@A@@@@@G@@@A@@AA@@A@@@AB@@AB@@A@@BA@@@@BAB@@@@AB@BABA@A@@B@@@BAD@@@BAB@@@@AB@@@BA@@@@B@@AB@@AB@@@B@@A@@@@A@@AA@AA@@AA@@A@A@@@A@A@CA@A@@@@@A@@BA@ADCBCB@@A@@BA@A@A@A@A@@AAAAA@AAA@C@A@@@ABABABA@@BAB@@@B@@@@@BAB@B@@A@C@@@A@@@AB@AA@A@A@AA@@@A@@BAB@@@@@@A@@BA@@@A@@BA@@B@DADA@@DA@@BA@AB@@AA@@@@@AAA@@@@A@C@A@@@@@A@@@@BA@@@A@@@A@@@@@A@@@A@@@@A@@A@@@@@AA@@@@@@@A@AA@@@A@@A@@A@@@AAA@@@@@@A@@AA@@@@@@@A@@@CA@@@A@@@@@AA@@@CAAAAA@@@@AAA@@@AA@@@@A@AAA@@@C@@AC@CAA@AA@@@@A@@@A@AA@@A@AA@@A@@AA@@A@@@A@@@@BA@@@A@AAAAA@@@@@A@@@A@@@A@@AA@@BA@A@@@AAA@@BA@AB@BA@A@@@A@AA@CBE@CB@B@BBB@B@DABABC@ABABA@A@@@AAAAAA@A@A@ABC@A@A@A@@@AAAAACAAAAAA@A@@@AAA@@BA@AD@DAD@D@@ABABA@@BA@A@A@A@@@A@A@@@A@@@AA@@A@@AA@@@@@@A@AAA@A@@A@AAAAA@@AAA@A@@B@@A@@@AEI@A@C@A@@@AAAAA@A@A@AAA@A@@AAA@@@A@AA@@@@AA@@A@@A@@A@A@@@A@@A@@A@@@@@@@A@A@A@@@A@A@@B@@@@@B@B@BBB@BBB@@BBBB@B@@@B@BAB@BA@@BA@AB@@AAA@ACAAAAA@AA@@AAC@AAAAACAA@AAA@@@A@@@@@A@@A@@@@A@@A@@A@@@@A@@@@A@@A@AA@@AA@@@@@@A@@@A@@@@@A@@@@@@@@@A@C@@@A@A@@@AAA@@@@@A@@@A@@AA@A@A@@A@@A@CAA@@@@A@@@@@@@@@@@@@@@AA@@@A@@A@@@AA@@@@@@@A@@@A@@@CB@@A@@@@@A@@@A@@AA@AA@@A@@@A@@@A@@BA@AB@B@C@A@@@A@@@@AA@@AAA@@A@@@AAA@@AA@@@@AAA@@A@C@@A@AA@AAA@@@@ECACA@AACC@AA@AAACA@@@@@@A@@@A@@@@@A@@@AA@@AA@@@@AA@@@@AAAAAAA@AAAA@@@AA@@AA@@A@@AA@AAAA@@@AA@@@@@A@A@@@AAA@@AA@AACA@@C@AAA@A@AAA@@@A@A@@@A@C@AA@@A@ABA@A@A@ABA@@@ABA@@@A@A@@@A@A@A@@@A@A@A@@@A@@@A@AA@@@@AA@@A@@@AAA@A@@@A@@BA@A@ABABABCBA@@BA@@@@BABABABAB@B@BAB@@@@A@@@A@@B@@A@@@@B@@@B@B@@@@A@A@A@@@@@@B@BBB@B@BA@@@@BA@@@@@AB@@@B@@@B@@AB@@@B@BA@@B@B@BA@@B@@@BBB@@@B@BA@@B@@ADAD@@AB@@A@@@C@A@AACA@AA@A@AAAB@@@@ABA@@B@@@@AB@@@BA@A@ABA@ABC@@@A@@BA@@@@B@@AB@@@B@@AD@@@BA@@@A@@BA@@@A@@BC@@BA@@BA@A@@BAB@@@BA@@B@@@BA@@B@@@B@B@B@@@@B@@@DDBBB@BBB@@BB@@BBB@@@B@BAB@@@BA@@DAB@@A@@@A@@@A@@A@@A@@A@@@@@AA@A@C@C@@@@@@A@A@@@A@@A@@A@@@@A@A@A@A@@@A@A@A@@@@A@@@C@@@ABC@A@@A@@@@AC@AACACAA@@AA@A@C@A@A@@A@@@@@AB@@AB@@@@A@A@@@AA@@AA@@AA@A@A@@@AA@@@@AA@@@@AAA@A@@@A@@@A@A@A@@@@B@@A@@B@@A@A@A@A@ABA@C@A@ABA@A@AAA@CAA@A@A@CACAE@CAC@AAC@A@@@@@CC@@A@@@AB@@ADAB@BA@AB@@A@CA@@A@@@CFA@ABA@A@@@A@@A@C@AAAAAAAACA@A@A@@@A@A@@@A@AB@B@D@BA@ABABCF@@A@A@A@@@A@CBA@@BA@@AA@@@A@@BA@A@@@A@A@CBABA@@@AA@@AAACCA@@AAAAA@@AAAC@@A@@A@BA@@BAB@@@@@AACAAA@@@@AAA@CAA@EAA@AA@@A@A@A@@AA@@@AAABA@A@A@CBA@@@AAA@AAAA@@A@@@CBA@@@A@A@@AA@@@ABABA@@@ABA@@@A@AF@B@BABCB@@A@@BA@I@A@A@@@@@@@AA@@B@@A@A@@@A@@B@@A@@@A@@@ABAAA@@A@AAC@A@C@G@I@C@@@A@C@C@A@CAE@A@AAAA@A@A@C@CBC@C@AAC@A@ABA@C@@@C@A@@AAA@CBA@@@@@A@A@A@A@AB@@A@@@A@ABA@@@A@ABAB@@ABA@CD@@ABA@A@ABAAA@A@@@AA@A@A@A@@@@AAA@A@@@A@@@@B@@ABBBAB@B@@A@@@@@@@C@@@AC@A@A@@@AAAA@@@@AA@CA@AAA@@A@@AA@A@CAA@AAAAA@ECA@AAC@AAA@@@@@@@@@@@@@A@IAIAA@C@AA@@AAAAAA@@AAA@C@@@@AB@@CBA@@@AB@BAB@B@D@@@BAB@@AB@@@@AA@IEAAA@A@@A@@BA@A@A@A@A@A@A@A@AAA@CCIAACEACAAAAA@A@AAAAA@"],encodeOffsets:[[124205,43414]]}},{type:"Feature",id:"150525",properties:{name:"奈曼旗",cp:[120.658282,42.867226],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@ABC@@@CBA@C@ABA@@@ABA@ABA@ABCBCBAB@@AB@BA@BBBBB@B@BBBB@@@BA@ABCBA@CAC@A@C@CBA@EBABAB@@A@C@CAAACA@@C@CBA@@@AB@@@@A@A@@@A@A@@@AAA@AA@BA@A@ABABA@@B@@@BA@@@A@@@C@A@A@AB@@@B@@BD@@@B@BABA@ABA@ABA@@@A@AB@@A@C@A@A@ABA@A@A@ABA@A@CB@@C@ABA@A@A@@@AAA@@A@AA@@AAAAA@@C@A@A@C@A@EAA@A@C@A@AAA@A@A@@AAA@@@@AAA@AAA@A@A@@@A@A@A@A@A@@@A@@@ABA@ABA@A@ABA@@BA@A@A@A@@@AA@@@@@@@@AA@@B@GBABCAECCAE@MBA@E@ABA@@BA@ABCDCDABA@A@CBA@@BB@ZNBBABAB@@@D@@@@EBABC@CBAB@BAD@B@@ADABAB@BCBEJSLIFq^MDEH@@AFA@@DBBBBDBBBBB@@DD@B@BB@@BBDBBBBIBR@FBZ@HBPKDKFA@SJ_L@@YLCBMHMLEA]RD@AJE@EBCBC@KDGAEFSTAVgZCB[TOVMNKNBLHJ@@CHCJIFIDgF@BFFGHAFADEH@@@@@B@D@BB@BBB@@@@D@BAB@FAP@D@DSFC@A@@B@B@@@B@@BB@@B@BBD@B@B@LA@BBTAA@@A@AA@@ABABABEBABA@A@@@A@@@@A@@@ABABA@C@@@AA@AAA@ABABC@CD@@@@APOPGTELINABABILGFAFMpAFD@]nENGbAF@DixCFKlSCDSPMJABC^ARAB@@OG@BAB@@@B@@BB@FPHB@ONA@_RGFFPT^@@XN@BIJ@BABW^\\vVhD@BF@UfYnCAEH@BDDBB@B@B@BBD@FABGBABCBADAF@BA@@BABAB@BABABABAB@@@B@B@@BB@@B@B@B@B@@@D@BBB@@FBDBB@B@BAB@@@BDBDDBBBBFBB@BB@@BBA@@B@BDFBB@BBBBBBBBBDBBBBDFDBBBBBBDDB@JEAC@@CEBADA@AB@B@@@@@@B@BBBBB@BBB@@B@@@BA@AB@@@@@B@JIDABA@@B@@@B@BB@BB@@BABBDB@DBD@DBFBFBB@@B@@@B@BABC@CBCACACACAA@A@A@@@AB@BA@@B@D@@BBDF@@@B@@@BAF@B@@@BB@@@BBF@D@B@B@FBB@@@BBB@F@B@BB@BBD@FB@@BBBDBB@FBD@B@@BB@BB@BB@B@B@D@@@DAB@BB@@BBBDBBBBBB@@BBB@DB@@BB@@@DB@@FBD@@DBBBB@@@@B@B@@@BAB@B@@AB@@@B@@@B@B@@@DAB@@@BBB@B@@@@CB@@@@AB@@BBBBHDB@@@@B@B@B@B@BDB@@@@@B@@BBB@@BB@@@@B@@@B@F@B@@@@BB@@DD@@BB@@B@@@B@B@@@B@B@@@B@DB@@B@@BB@@@@B@@@B@@B@@B@@@@BBB@B@FB@AB@@B@@B@B@@@DB@@B@BBB@@@BAB@BB@@B@B@B@B@@BB@@B@B@@AB@@@B@BB@@BDBB@BBD@@@BB@@BB@@BBB@B@@B@@@B@BBD@BBBF@@B@@B@@BB@B@FB@BJF@B@@@@A@AB@@@@BB@D@@DBB@B@D@D@BBB@B@BB@@FBD@@@DBD@DBB@BBBB@@B@BBBB@@@@BBBB@BB@B@D@BBD@D@@B@@BB@D@@@BAB@@BB@DB@@BA@@B@B@BBBaEAJ@BDBB@BBNBB@DBHB@@B@@@B@B@B@@@@@@@BF@@B@@BDD@D@@@BFD@DB@@@BB@B@@B@BB@@DBBB@@BBB@@@B@D@BBB@HDB@BBH@B@FB@@BD@@@DB@BB@@BB@BB@BBD@DDB@D@H@AHAF@BA@@B@@@B@@@BBBBD@@@@@BA@@B@@BB@B@@@@A@AB@@@@@B@B@BA@@BBB@@BBDBFDBBB@BBBBHBD@D@F@DBF@B@F@@@BA@A@@ACDAF@BBD@@BB@@B@BBBBB@D@@BDNHBBBB@BB@@B@BA@@BBB@@FD@@@BAB@B@@BBFDHFDBBBDFBDBFFFDFDDDDBBHPFFFHBBDDBBDBD@HBFBHBFBFBPDFDD@DBDB@@BBB@H@B@BDDBFFBB@B@D@D@D@DBDBB@B@D@B@@@@XXTVJD@BADDDDD\\VFB\\LBBJ@PBNARE^DD@@@PAH@H@FBBB@@DBLBBBDBB@DBLFHFFDFFLJHFDBNDD@B@BBB@B@L@D@D@LBB@D@N@BGLBP@dPXHLFFB^N@@PHTGD@VIFEFGV@^@bBbLnP@H@@BBFB@@B@DBH@DB@@JDJBFAT@BCF@JAJCHBDABAHAH@DBLFHAH@N@JCB@F@DBNEPALCLDF@BBJDFDB@THH@JD@@B@NCbAV@RBH@B@J@RGPGBAFALAF@BADABIDCBEC@@CFGA@BQDAFIAABGB@@KDC@ADED@@EDBCMA@@CEBCCHGPKBCB@B@F@@BB@@B@@@CB@AAD@BBBAF@BA@B@@PCHCjO@CF@HCBF\\CICBAD@@AF@B@D@FB@IAA@ABCBGLOCBBCD@B@FG@ATKLEBABACABEC@BE@E@EA@FIAG@CACBACGFELHHEB@TC@CBAJEFB@@B@JEAA@AECJMDOCCCC@@CADEBGD@@CB@@GLBBENEFDF@H@H@JGLGSHBKNCFBBEECKBI@CBBM@IBADAICC@@ADIGCAKSMDMOEAEJGIMEGGC@EDGIE@ADQDGHODC@CHICCAS@@a]KEEBYDKFgJEFEAeRK@A@E@ODG@I@G@IBC@AAECCAA@H]BM@A@@@ABC@@@A@AAACCCCAA@@AA@C@CAAAA@@CCA@@A@@@AA@@A@@@A@A@AAA@AAACCCEAAACA@@AAACE@AAC@C@@@AAC@A@@@AAA@ACEACAAAAACCAA@EAICA@IAAA@C@@@A@C@A@A@AB@@CEGA@CAAAACA@@@AAIGCAA@@@AAAACALE@ABGA@AE@A@I@WNEB@D@@AFA@CBWFC@CAC@SICAC@EAGA@AC@CAA@ABC@@@ODC@GBEBE@@BKNEBKHA@IB@@IAA@C@IA@ACC@C@C@C@CDILQDGBAFKBADEBCBADC@G@EAACCCGACA@UAuGK@G@MQBM@C@@BA@@JMEGDK@UAMBWEEAAD@BGN@FAHCPMlYFEHG\\[@@AKAcqACCMACAEAE@A@@GS@AAS@A@@@@@@@A@AAA@A@@BABEBCDCDELK@K@W@I@@EE@@AA@E@E@C@@@A@@@A@@BAB@@@@A@@A@@B@@@@@@A@@@@AAA@@@@@@B@B@@A@@A@@@A@A@@@@@@A@@B@B@@@@@@@@AA@@A@@B@@@B@@@@@@@@AB@@@@@@A@@@@AB@@@A@@@@@AB@@@@A@@@A@A@@D@@A@@A@@@@@@@@AB@@@@@@@A@@@AC@@AACBE@AAEGIGIGCAEECAE@CA@A@E@SJA@GBA@E@CBEBK@I@AA@BA@A@CFABIA@@S@A@@DAAC@A@M@AD@BADABCRCBEDIJMDCDM@@@ADADBBBFBFDDB@AC@@AA@BA@@@CCCC@AACBBCBCC@BEA@CA@E@ED@FB@ABA@CGACDA@IEADAD@B@BBDCDA@A@ACACCBA@@C@CAA@A@A@A@@BG@E@C@AA@@A@@@AB@BA@A@A@@@@CAEACA@@@@@ABCBA@CBADCBA@CBA@A@ABA@@@A@ACC@@@A@@@ABA@@@@@A@@@@A@@@A@@@A@E@MD@ADAHCBAB@@@@@A@EB@@EDIF@ACCEEECFOLM@@@EMIIG@ACAEEEF@ABKB@DQA@MG@@@@BA@A@CB@DA@@YEHIBCEEAE@@BC@@BA@@BAB@CETGFC\\KB@DEBADE@@H@B@F@F@@EAEGCCABABAHAJCD@DABADCDABABED@BC@E@@I@@EE@@ACC@EAAC@K@FCBADAF@L@FCFDDBFBB@EMACGA@C@AEA@AAAC@ABABBCAAAA@@@A@@BG@AAACAGCCIAA@AFAD@BA@@B@AEZHCSNO@AG@LOEBDKDDFCEEHEFOAAC@AAAAA@@@A@AA@@A@@@A@@@AC@@@@AAA@AAA@@@A@@@@@@@A@@AA@A@A@ABAAA@AAA@AA@@CA@@@@@A@@@AAA@@@A@@BABABA@A@@@A@A@@AA@@ACAAA@@A@@@@@A@A@AAA@@AAAA@@A@A@A@@ACACCAACAAAA@@@A@@@A@CA@@A@@@@A@A@@A@@AA@A@AAA@@@AAA@A@@@A@AAA@AAA@@AAB@@@@@@AAB@@@@A@@@A@@B@@AB@@@BA@@AA@@A@@@@@@A@@@@@AA@A@@@@@A@@A@CAA@@@AB@@A@@A@@AA@AA@@@@@@A@@@@@@BA@@@A@AA@@A@A@@@@@A@@@@@BB@@@B@@@@@B@@@BA@@AA@@@AAA@A@AAA@@@@C@@@AAA@A@AA@@ACACCCA@@@@AAAAA@@A@@AA@A@A@A@A@A@@A@@@A@A@E@A@A@IA@@C@@AAB@@@@@B@@AB@@@BA@@@ABCA@@A@CAGA@@A@CAC@AAA@AA@@AA@ACCA@ACAAAA@ACCCC@ACG@@ACACACAAAA@@A@A@C@ABA@ABEB@@AB@@A@@BA@@@C@A@@BA@A@ABA@AB@@@@CAGC@@GAEAAAA@CABA@@DADCB@BA@@@@ACAACA@@CBA@AB@@@AAA@AAA@@@@@@AB"],encodeOffsets:[[123933,43258]]}},{type:"Feature",id:"150526",properties:{name:"扎鲁特旗",cp:[120.911676,44.556389],childNum:1},geometry:{type:"Polygon",coordinates:["@@Z]LM^eMAkI@@PVWX[PCGMIeFC@±B[FIBaJC@_HEBLIBWBKBGSCA_VKP@@@@@GD@@CDERQTEF]f[dORKNMRW`@NDfHLRZxNBTR`@@`U^I|CRHDnBV@FUZGBIAO@E@CAI@E@I@C@I@E@I@E@EBM@S@IREJADGN@BAFgIK@@MAG@]ZK@KB_AqrMPUZGJLBBF@FB@@BEBCDADIFAD@@CBABCBI@ABAFE@ABA@ALED@J@@ABEBBHCB@BAB@LAFAB@FED@B@@FD@HA@@BHFADJopGHRdGFFDBABBF@KF@BCB@BD@@BABDD@@ADAACDCB@BEACDGC@BBB@@LBCFJDBCBBGFJ@EFBBGD@BADBFABCBGHI@EBABADABABFF@BJD@@@DBFCD@DADCBCDA@CAE@ABCBAD@DBF@DQJC@EAA@C@SDIBEDEDCF@BAD@BAF@FE@CDCBABE@ABCHK@WDCBCF@BB@I`GBKJE@GDCNMFICGAKCEJIJCH@BHPBJVPFLFHFLC@@@@@C@IAGBK@GBODUJSH[L[PML@HGHAL@JIDGB@DAJE@BFDCBF@JBJIRCFEDDHCBC@@FKBA@CBBFC@EJMFGHEFEB@FaZMJ_TABCFGBEA@HG@EDEHGLGFEB@@ABA@C@@DAAABEBBDEDEA@@CDAB@BEB@DABADADE@@BA@@DEDEAADCBBDEF@BCDCBB@@BB@ADA@ABC@@BBBA@A@@DCAABA@A@C@@BA@ABABCB@BGD@@A@@DA@C@@@BBC@ABA@ABABE@BBEB@B@@C@ADGB@BEBEB@@C@@BI@A@@@@@ABC@@BAAGBA@@@@BEBB@C@A@A@BBGF@BA@@BEHBDB@@DE@CBBBADCBA@@DCDEAABBBABAAABA@@BEBACAB@BC@A@C@ABEB@BCBC@ABC@CDC@EB@BA@EAKB@BC@@DCBG@ABI@@DC@B@C@@BCB@AA@@BBBE@C@AAA@CB@B@BC@A@@@A@A@@BA@A@@AAACB@DA@E@ABE@@DCCC@@BED@BC@@BB@EH@B@BA@@FABBBIBADC@ADC@AH@F@BDBEDBBCBBDCAABBBABCB@BCDA@EF@BA@@BA@@BBBAHA@@BADCD@BABABAFEBC@BBABC@ADDHBD@B@BA@BB@BBB@@@BA@BBABB@@BCHC@EFGRIPIJ@FEDGFEFCH@HGF@H@H@FCJCNCLCDEDAFCHGJCJEFEDGDEFKDAFADADGBMAGBCDEFG@IFEBCBE@CDEHELGBEFQNAB@@mZhWN]|±TĉfIC@OSF@BAAABB@×¶jr´@@¬X A@ØA@@P@rAX@p@TLDXlVFbTVX@F@B@lA^N@JCACDBDABBDCDC@@DBDCDBJEFA@@@A@E@CDCDABCDCB@B@@@DBBBBAB@DAB@BA@GD@B@B@BBBAB@DA@@BEDA@ADABDB@D@@@DBPDD@B@D@B@B@HBBAB@BBDAB@@BBABABDB@@DBBB@@@@BAB@@@HBDDBCNDFCLDFBNAFBB@B@DAHCdOB@B@B@B@BBB@@AB@@@@AB@B@@@@@BA@@AA@@B@@AB@BAB@BB@@BBB@D@@B@@@BB@@@@@@@B@@@BAB@@AB@BB@@@B@@BBBBFBHBDAB@BBB@B@@@DBB@@@@ABA@@BAB@B@F@B@B@B@DAB@@@B@D@BBB@@AB@@A@@@@@A@@@@@@B@@@@@D@@@B@B@@@@@@@AA@@BA@@B@B@B@BAB@@@B@HABABABABAB@B@B@@@B@@@B@@@@@B@@@@ABC@CBA@AB@@@@@B@@@B@@@B@@@BAB@@@@@BA@@A@@@@A@@B@@@@A@@BA@BB@B@B@B@BBB@DFBBDBBB@BB@@BB@BBBBD@BBB@B@B@B@B@B@@@B@D@B@B@B@B@@@B@@@@@B@@@@@@@B@@@@@@@@@D@B@@@B@@@B@B@B@@@@B@@BA@@BB@BB@D@@A@@BCAC@AAG@@AC@AAA@A@EAAB@BA@A@@@A@A@A@@@ABA@ABA@CBC@A@@BC@@ACAEAAA@@C@A@@@@@AC@C@@@AA@A@ABA@ABA@@@@@ACCCACCA@@A@@@ABADAB@@@@@@@BA@@@@@@@@@AFAF@B@BA@AB@@@@@BAB@B@F@@@BADCB@@@B@BBBDBBB@AB@@JD@@BB@@@B@@BB@@B@@AB@BC@@B@B@D@@@DABAB@DAB@BA@@B@B@B@DBDB@@BAB@BA@@@A@@@AB@FEB@BADABABA@A@@BC@@B@B@B@BB@@B@B@D@F@@@FAB@B@B@B@B@BAFC@@B@D@B@@BB@@@BB@@@B@B@DCDAB@BA@ABA@HAFAD@B@B@B@B@B@HCBA@@BBBBNLHDBBB@@B@@@D@B@@@BADAD@BBB@B@@@B@B@D@FAD@B@BAD@B@D@BABGJCF@@CDGH@BA@AB@@@B@BBBDFBBCCCAECA@@@EBA@A@E@@@ABA@A@@BABEDAB@B@@@BBBBBA@AD@B@@@BBBBBDF@@AH@DCJCJ@BB@B@@A@@BA@@@@F@@@AB@@@@BB@@@@B@B@BB@@@BB@B@B@DFBA@@B@@@B@@@@BA@@@@B@@@@B@B@@@@@B@@@@@@@B@@@@@B@@DA@@B@@B@@@B@B@D@B@@@D@BBB@BBBBBBBBB@B@DAB@B@BBD@B@B@LCB@@AB@@@BAFBBAD@@ABAB@@@DABB@@D@FBB@D@FBB@DBBBDBB@BB@@D@B@@@@@B@BAD@F@B@B@F@B@@@D@@@DAD@D@B@BB@@B@D@@@D@B@@@@@BABA@A@@DB@@B@B@@@BADCB@BAB@B@BBBBDB@@@AB@@@@AB@HA@@B@B@H@B@BAD@BAB@@CB@@@B@FFDBBB@@B@BAB@B@B@B@BB@BBBB@B@B@B@@@FFBBB@@@BABCBABADAB@B@D@L@@@B@@BDABAD@BABA@@BA@A@ABADCFA@@@A@CB@@AB@@@DAD@DBB@B@B@@BBB@B@@@@B@D@H@@@DADAB@B@B@@@@@BABA@AD@DABAFA@@BAFBB@@@BAFA@@@A@AB@BAHCB@BAB@@AB@@@B@F@@@BAFCBA@ADAB@BAB@B@B@B@@@BAB@BA@@@A@@D@B@B@DBD@B@@@@B@B@BB@BB@@@B@BBB@@B@BADA@@B@BBD@B@@@B@B@BB@@@@B@@@@@@A@ABA@@@@B@B@B@@@B@DAB@B@@@B@B@BAD@BBB@BAB@@@@@@AB@B@B@BBBBB@BB@AB@B@@AB@BA@AB@B@BA@@BA@@DA@@@@@@@A@@@A@@@@@AAA@A@AAA@AB@@ABA@@@AB@B@@@B@@C@@BAB@B@@A@@@A@A@@BA@@BABA@@DA@ABA@@BAB@BAB@@A@@@@@A@@@A@@BC@AB@@AD@F@B@B@B@BA@BB@B@@@BADA@AB@@@BB@@BB@@BAD@BAD@@@@BBB@B@@B@@@D@D@@@DADA@@BA@AD@@A@A@AB@DAB@@@DBB@B@D@B@@@B@@A@@BA@AB@B@DA@ABCB@@@B@DAB@BA@@@@B@B@DAB@@@BBH@D@BA@@BA@@BABA@@BA@@@ACA@A@@BA@@BC@A@A@@@AAA@@@G@@BA@@B@FA@@B@@@@CBA@ABE@A@@@AACAAB@@@B@B@HC@@@A@@@A@AB@BABA@@BBB@BBB@BAB@F@@@BBD@B@B@D@@@B@B@B@F@D@D@H@@@B@DA@@@@@@@A@@@ABA@@B@B@D@@@D@B@B@@@@@D@D@B@DAB@@@BBB@@@B@B@B@B@D@FD@@B@@@B@BA@A@A@A@CBA@AB@@A@AB@BABA@@B@@@HEBA@ADA@@@CBA@@B@D@DA@@BB@@BBDBBBB@B@@@@@B@@A@@FC@A@A@ADAFA@@B@B@@@DCHC@@@A@A@AA@@A@@BAFABAB@B@DC@A@@@A@A@@@AB@BA@@B@@AD@BABA@@FABAB@B@@@AAA@@AA@@A@@@@B@@@@A@ABEA@@@@@ECAQDILEKEAECG@MAC@K@@DA@C@ABAAC@AFCF@@ABADAH@B@DBDBJCBAFBBA@AF@DADCFAHI@AB@H@B@B@BA@AD@FCDC@@AA@AHCB@D@B@D@@C@@@ALA@ADEFABADAH@FCBAHABCD@HGFE@@DCDC@C@CFEHABADCD@D@FC@ABAH@FCLGVMXE^IhQfDJGH@FA@ADAFBJAH@FAFALANCBAB@DHJAHBFAHBDABCBE@AAGJuD@FBDDL@BAFAJ@FCLBBBHBBAFAFBL@DAH@BCDAFABCFEBCHBBCB@FADCFAD@DCJAB@BDD@JCD@HAD@BED@D@DBB@FDD@@A@@JI@CD@@CD@D@DAHCDE@AFCDC@CHCBAD@DADCCCGFUHJMGA@ADEDIIOQYBA@ACC@AACACCAA@CBCAAAE@@C@ABABAD@BAB@BAFADAD@DAB@D@H@DBBDD@FAFADCFB@C@ABCDEBC@AKEAADE@C@CCMBEBGAEII@AFABGLCDEFCB@FCDG@CDIHEAG@IBA@CCABEDADANGFC@ADCBA@@@C@E@AB@HEBCBAFCBADABEDAD@F@FBFDDD@BJ@B@@AHA@CDEFEDCBCDA@A@AHCHCDAF@BAD@H@FALC@@BDDBHADA@AJCDAD@@A@CBADCLEDANC@@XCD@DAHCDAJBFAD@DAH@DBtQVGPCBAB@DCBABABA@AH@\\CD@@@mRIVKJEFAHBZM^MJCRI@@faVS@@j@@ANWDCTc@AECMKAA@A@aAARIFAHEbSkª}@AFcDSP]LS¢OJGDQcMhmAM@ClkJABAB@@A@AV]PKH@VC@CDG@G~aVULIFCFC@AHE@Ad}RG[FSHOZDJ@JAL@HCHANERGDCTSBA`]`_RQ@OCDOHCBA@CACA@A@AA@A@AB@AABAAA@AAA@AB@@@B@BC@@BC@@@CB@B@@AACA@@BA@AA@A@E@@A@@@A@AA@BAA@CA@@A@A@@DCDA@@@ABAAC@CAAAB@@@AA@AAA@AA@@A@@ABAAAA@@AA@@@A@@BA@@@@C@@@@A@@@AA@@@@A@@A@AA@@ACAAA@@@A@A@@@@@@A@@@AA@@AABC@I@CBAAABA@EA@@EAECAAAA@AE@E@C@AAAB@@@@@@IFK@AA³cOIGEGCaI@BUE[IåEwNABeJ]VD@OJGHEDIHIH@@KHW^PJOJSJMGt@A[WXKMOMKSMSMKMgcaQGCU@CC@WKEkQmSYC@@QeJMOKM}S@@@@Fm@OAk@EFI\\ujOQOvqBGBEkOHoK@E@_aMO@A@IOUABABC@EBGABCCEEBACGAEDA@@ACA@DC@@@C@CDE@C@C@ABC@E@K@GBCAC@GA@AAACBBBA@AAA@@EEGGACA@AAAECC@@BABAAABCAD@@AECDAE@BACAABC@@BD@ADCBCA@CAAEBCCC@ADCA@AAEKB@BC@BCEABC@CCA@BCBBDG@CACBCAACGDCCBC@ADCRB^Q`@VFCSLMECHBC@BN[DG"],encodeOffsets:[[124007,45013]]}},{type:"Feature",id:"150581",properties:{name:"霍林郭勒市",cp:[119.68187,45.533962],childNum:1},geometry:{type:"Polygon",coordinates:["@@KCSo@W@qBO@@@×B@B«W@@Kª\\J_vüČ@@fh[jjhXG_WFAB@iFA@@\\K_@AA@EAMBEAKCEDMCADCCGA@@A@AB@@@@AACA@@CABABAAA@@BAAC@ABAAA@G@A@A@C@ACCAO@C@@@CCABABCB@FC@AB@@CBAAA@A@A@AHCB@@ABA@CBAAAAA@C@@@ADADCBADCDCF@B@@@B@FEAIDCAC@CD@DCACBAACDCDB@I]MB@k@A@EUWaSUEWk"],encodeOffsets:[[122506,46496]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
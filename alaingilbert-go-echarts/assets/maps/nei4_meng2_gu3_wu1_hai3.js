@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("乌海",{type:"FeatureCollection",features:[{type:"Feature",id:"150302",properties:{name:"海勃湾区",cp:[106.822778,39.691156],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@GBIDCBC@WDEFEFCHEFEDBD@@BB@B@D@DBDBDBB@DDJBFBFBH@H@J@D@L@PCHADABCHGHGHAJFFHB@BBB@DAF@DAD@F@D@B@DAF@F@D@FBF@F@H@H@@AFAD@FAF@BAD@FABEJADLDB@D@BDB@B@DAB@@BBDB@BBDBDSFYBG@KB@@EB@B]@C@@@A@@B@@A@@@A@@@AB@BBFBB@JBB@BBF@DBNFLDB@DBJB@JBBRFLDJDFBJBNFLDJB@@@BBF@FBDBB@DBD@BBB@@@BBBBDDFDBLFFDJDFD@@FDJHDDBBBBDBBFBDFD@@FHDDBBDBDDBFB@B@B@B@BBB@DDDBDB@BDH@B@@@@@A@AB@B@D@D@DADABAB@D@D@DBB@@@B@B@FBB@D@DADABA@@DB@@B@@A@ABBB@B@D@IKGCBI@AAOA_NQEMADI@K@G@G@CAA@ECC@CDE@AA@CA@CAAAECCGCADMgMGIEEMIAA@CBA@CCGAAC@AA@@A@A@CCGG@E@A@@CE@ADADABE@A@A@@B@@A@A@A@ADiBE@G@@JATAB@BGBGBGBEBA@OBI@E@@BA@AFC@@B@@AB@@@@A@ABCBA@A@ABCB@@ABC@CACCAAAICA@OGCG@ADCBCB@@@JAFADAFA@A@@AAAAEAEGCAC@C@CBGFABA@C@CC@@ACBCBCUBE@LUVCFcLWVEj§GSBO@QNQFQES@KFKBEC@@@IFmXAJ@JAVBDAN@BA@G@U@C@MAW@@AB@BABABABC@A@A@A@AAA@@AC@AAA@@EC@@AABA@ABA@A@A@@AAAACC@AA@@CBA@@@AAA@ABCDE@@BADC@A@CBEÇEA@E@[@aA@@O@U@GAAB@B@B@FBB@DBDBFBB@B@BFDPNBBBBBB@B@@@B@D@@@B@@@B@@ABCFABADAD@@KJE@EDGD@B@HBHJHBFHFLBLDPFHBBD@DDDBF@DBD@F@HCLCFCDAHABABABCDABABCFGLAF@DBDDFBFAFAF@B@FAFBDBLFBBDDBB@BBDBBB@BFD@@DBBBBBBBFBBBDDBBBDDJAH"],encodeOffsets:[[109359,40643]]}},{type:"Feature",id:"150303",properties:{name:"海南区",cp:[106.891424,39.441364],childNum:1},geometry:{type:"Polygon",coordinates:["@@GBGAOAEDEAICKACHKDIJOJEA@FA@@@A@@@@@C@@@@@A@@A@@A@ABA@@@@BA@@@@BA@@BA@@B@@@@A@@B@@A@@B@@AB@@A@@@A@@@@AABA@@@@@A@AA@@A@@@@@A@AB@@@BA@@B@B@B@BAB@@@@@BA@@B@@@B@@A@@BA@A@A@@BA@@@@BA@@B@@@@A@@@@@@@AB@@@BA@@@A@@@@AA@@@@@A@@@@@ABA@EB@@C@@BA@A@@BA@A@@@A@A@@@A@A@ABA@@@C@AA@@@@A@A@ABA@A@@BA@@BA@@BA@@BA@A@A@ABA@ABC@KDEBGBA@CBMHCDEDQRIRGXCTITIPIRGbAJCFAJWRA@ULKL@NDLBJELABGF@JDNDFDHNTBHBHANCJAH@J@@@JDLBBDJ@HGHGHGLCF@FBFDDDF@DCFELCFADEJKJGBK@U@KBCFCJA@@PGLAD@@@FKX@DBL@@@@HDHDB@@BB@BBBF@BBDAD@BCFAD@D@F@@@BBBFFBDBB@BBB@B@@AB@@BDBD@BA@@BAD@J@BBDDFBB@B@BAD@BAB@B@BAHAD@BADAD@D@BAD@@AB@BHBV@P@@@bB\\@F@B@ÈFAF@D@BCDAB@@CFAD@BBB@B@@AB@DB@@BDDBBBB@@@B@BAB@BABBB@@FD@@BB@BBD@@BB@B@B@B@BADABABABA@@BX@NBD@V@H@B@@ABMACBU@IBInWJE@@D@BGFODQBUDQFUJOCeFGPM@@YUAKG_AM@_@AAUBAB@RQYuEMD@BWvc^SNEnSjOf]N]Ü¶F[[[FGAKAGJINGBGmiAEIsAOM]mFYACECGJCJGJCFOEU@CENK@CI@ECCEAEIEODED"],encodeOffsets:[[109592,39978]]}},{type:"Feature",id:"150304",properties:{name:"乌达区",cp:[106.726099,39.505925],childNum:1},geometry:{type:"Polygon",coordinates:["@@IBKDGDGDEBEDOFA@KDODODGDA@qZ@@@@M`ILCH@@ABEFCDABCH@@@BABBR@BAJAH@@DD@B@BABADA@@DBBDDDD@BDBBB@BBB@@BFBBHD@@@B@BJFB@@BB@DBHBBDAJ@DB@RJTHjTB@NFhFBTJ@@@@LFRF@BTJ@CBEHKDEBABADCBABABABGDCDEDK@G@EAC@CAECC@CACGAOEKCKAGEAEIGAG@G@AHCFCF@LI@@BCBCBADEBA@@@A@@@A@@@C@A@@@AAAAAAAOMEC@A@AAAAEAC@CAA@E@A@ABA@ABA@@BC@A@CBCBC@ABCBG@A@ABA@ABC@A@AAACEAC@A@IBC@AB@@AACAC@@BA@@@AAA@AAAACEEAA@A@@@E@CBC@@C@CAA@A@C@ADA@A@C@CAA@AB@@@@ABABGBA@A@A@A@C@ED"],encodeOffsets:[[109281,40390]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("乌兰察布",{type:"FeatureCollection",features:[{type:"Feature",id:"150902",properties:{name:"集宁区",cp:[113.116453,41.034134],childNum:1},geometry:{type:"Polygon",coordinates:["@@IGQQBSBMIIGEIIAAAEGCEBX@LCLALCLCFGBCAI@EAG@GBA@E@G@EBE@CI@C@A@M@C@CACBC@A@A@C@A@A@E@A@A@A@A@A@A@A@C@E@C@A@AB@CEMQiKaMBCDCBNhFN@F@DAB]JofCDEF@DCBE@CDKBEBGDCDBB@BFB@FCFBJDFJHHB@@BDDDJDAPABABHHOFJHEJGLFBHDLBtL@DDHAHRBBBFH@D@DEL@BBJIBIZLHHDGDSE@LBRYBAFGFEFSAGTEAEAENbH@F@DAFCFAFC@CBEASE@@GFAF@DGFAFADABEBAH@B@@@BD@HDDCH@B@F@NDHBB@BADAD@D@BBD@DDB@D@DADAFCRGHCD@D@DD@AJJB@BBB@DADAF@B@BDBFDLBFBBBBNF@FBFBFDDBDJFFBD@DABAB@HED@NGHCD@D@D@JAJ@JC@@B@DAB@PC@AJAFAL@B@@A@BLIBADCB@HI@CDEB@@ARBXIVWVBCEAA@A@A@ADGHIDEDCAKAIAE@AKGAOCU@S@GCcBCBA@WZDz@^B^@D@NA@E@C@E@WBI@U@GBIBU@MAGGOAEBUYO"],encodeOffsets:[[116031,41960]]}},{type:"Feature",id:"150921",properties:{name:"卓资县",cp:[112.577528,40.894691],childNum:1},geometry:{type:"Polygon",coordinates:["@@CKSAE@SLUFI@GMCKFEQEKFEDA@INGNALSJEBEIULQBG@WHIDC@CCMBAA@CEEEAEAA@ABGBGFE@CDA@GCA@AB@AQ\\QHEVADE\\SHANABe\\kBMT@@R`@Z_EFXCJAFWIUIYCMESV@B@F@JBTNLGh@@[BC@OQEU_EIKWKCBI@FLBFMNBtW^YVABBF@LKVKTA`AF@BADMT@@I`@@GCIEkO@@eJ_V[FSFY^CVADQPE`bdRBO^dWIe`wXcPUZQVqT@@k¾P\\YbHDbL@CFEDBFBJ@DABABCBC@AB@F@B@DABCFEBCBC@ABCBADCDC@C@ABCBCBAJ@F@FBFBBDBD@BDBBDBB@D@BAB@BBBBB@DABCB@B@DAD@@AB@BAB@B@BAB@@ABDDDBBBBDAF@BBDABBBBBBB@@AFBDCB@BAD@@BBDBBB@B@@BBD@BCH@BBFDBBD@B@B@DBDBB@DAD@F@FDBBBB@@BAB@B@@ADBBA@@@A@@BAB@BAB@DB@@DE@@B@FAD@BAD@B@@A@@@AACAA@@@C@@B@DABAD@BAB@B@@@HB@@B@@@@@@ABA@@B@FAB@DA@@BBFB@B@BB@BBHDDBD@B@J@B@D@D@@AB@J@B@FBPDB@@@DABAB@B@B@B@D@B@@AB@BADAB@@AB@B@BAB@@A@@@AAC@AB@BCB@B@@@F@B@B@DBB@B@@@BA@AAA@@@AD@@GD@@J@@BA@@B@BAD@BBB@B@B@B@@B@B@@FFB@@@B@B@D@HA@@BBJAB@BBB@@BBB@B@@DBB@F@FAHAFAD@F@B@@@BA@AB@B@B@@@D@B@B@B@B@B@@@DBB@B@BAB@@@@AB@BA@@BCB@BADCAABABADADAB@F@B@@@BBB@@@BCB@BADAB@B@@AAA@@@A@@B@DAB@@@BAAA@A@A@@HED@FAB@BAB@@C@ABABCBAB@DA@@@A@A@@@AD@DA@AB@B@B@B@BAFCBABA@@BA@@D@B@DCB@D@@@B@@@DA@@B@@@B@B@BAFADAB@@A@ABA@@@@@A@@@A@@@@BAFCB@B@B@@A@@@AB@@@B@B@@@B@BA@ABAA@@C@@@A@@@A@@BC@@D@BA@@BA@A@A@A@A@@B@@BB@@@B@@A@A@A@@BABA@@B@B@BBBBD@FBD@BABA@@@C@@B@@AB@@@@CBC@@@A@A@ABABABAB@BA@@@AAC@@@AB@BAB@@AB@@@B@FB@@D@BB@@B@@@BADCDABCB@BABABABA@@B@HBD@DBD@FAB@B@D@@@BAB@@ABAB@@@@@HF@@@B@@@@BBB@@BB@BBB@@@D@B@BB@@B@@@B@B@@@B@@@BB@@@@D@BBB@BAD@B@D@B@DA@@@@B@BB@@B@@@BABADA@@DAB@@A@@D@D@BABADADA@A@@@A@@BA@A@@@C@@BA@@@A@@@A@ABCBAB@BAB@@ABAD@F@@@B@BAB@@@B@BB@@@@HB@AB@@@@@@@B@B@@@MOG@aAGoUYaHMAGDA@GAEAJIFGBCBA@CACDAFBDAFCFDNBDCB@BBB@@@D@B@@@BAB@BBD@B@@AB@@C@A@C@A@A@@B@HAB@@@BAB@B@B@@ABAB@@ABA@@BA@A@@DCBA@AFAD@@AB@@A@A@@@@@A@ABA@AB@B@@AD@@@DBB@B@B@@AB@BA@@@@B@B@@BDBBBB@BAB@@B@@BBBB@B@@@B@AB@B@B@@@@@@BB@@@@@D@`CBA^RRAjCZINEfQ@BH@XDVD\\FZBVC@AB@B@D@BAHBB@FALEB@@@B@B@JBB@@B@@DBBBBB@B@BBB@@HHBBBD@BB@BB@B@B@F@D@BBD@FBF@BBB@@B@D@F@@@BB@@@B@B@@BB@@DD@BB@@@BB@BB@B@BBB@AFADA@ABC@EBABCFABEH@BCBABMHABABCBE@GBEBCBA@@BB@BBBD@@DFBB@B@BAHAFAD@BCBGBDDBD@DFLB@BBBBD@B@BAFADADADAB@B@B@B@@@B@BABABA@A@@BA@CB@BAVCHAD@F@B@FBFBLDB@HBBBB@FBB@DBF@B@H@BB@@BBDFB@DBHBNDBBB@BB@@@BA@@B@BBBDB@BB@B@D@BAB@B@B@BB@@BB@BDNDJBB@BB@@BH@DAD@B@BADABCBCBA@@HKFKX[E[IIN[IEHIDCJ@HUBBdhff^BTB@QD]BG\\gF@jEvENU@CG_IAoKmM@O@CLK`@TEnKPCbDXJDQdYAKAO\\aLQDCBCWAYM[AO@KIJUBARUNQE[GCEBOPECEECCEAMCGCGGCCGUES@UBIDCHG@Q@C@ILIB@FEH@@A@AAA@A@@@A@ABC@@@AACAAA@@CAAAEACAACC@@AA@@@A@@@@BAB@BA@@HAFAB@FG@C@@BCBAB@@A@@AA@@AAE@C@I@A@CBCBABA@C@AB@@@F@BABABABCFA@ABC@A@CA@@A@C@C@@@A@CCCACGAACC@A@E@EAA@AA@CCEAA@GCA@CAEC@@CAA@CDA@CBC@@AA@@@A@A@CBA@A@@AA@AA@@AAAACAA@@A@@AC@@A@B@@AAAKXQfSH@@QIAFAD]DQEEVADGFC@W@@OIKBIABBABAD@D@D@BABCBC@A@AAACAAACACAEAAACEA@AAECAA@AACBCFCDC@AAC@A@@BABA@A@A@A@@AA@A@AA@@@C@@AAA@A@A@A@A@@E@A@A@AABA@E@A@A@@A@A@A@@A@@@AA@A@IAIAE@A@@@A@A@C@C@CBA@E@ABC@CDAB@@@BAB@B@@C@C@ABE@C@A@@AAA@AA@@AC@A@AA@@AA@@AA@BA@A@A@@@AA@A@A@A@AAAAA@@@A@A@A@@@@ACAC@AC@A@@AAA@@A@@@A@@@AD@@AAC@ADA@A@C@CAC@CEGACCEBGDGAC@BDIDSHKIIGEWQCWBUAKICU"],encodeOffsets:[[115252,41638]]}},{type:"Feature",id:"150922",properties:{name:"化德县",cp:[114.010437,41.90456],childNum:1},geometry:{type:"Polygon",coordinates:["@@OGMGY@IMIM@AGAKESPeHYGSBMZ@L@B@@AXBBDTDRHvKDKB}KQTSPkPDHYZSREFGPABZ^@BMTQfUPOP@BA@\\dSFUHKFbPKPEB[DI@[TCD@TQTEDo\\QBSHAD[nCHNPHHABAB@DPIJN@@HHAJDJ@@BDETA@@PEN@@[V@PBDQPFVE@CBBNIDC@BBABzcHoAG@[@_NYH@DCF@LBNQFCPTMBBHTDD@@@@B@VE\\GHCBCDIb@RQDCbJ\\HfJLJPL^@@@XYFGDCPQ@@DGJSFIDANGJCBAB@@BB@@@DDB@H@B@@@B@@BBBB@D@DBD@DIBEBCDG@AD@DANAB@BA@@HAD@B@F@FAN@JBHADAJCHANCB@D@@@NM@PZFLB@@PATAPAFMFMJUDK@@BCBC@ABI@E@CBK@A@A@C@C@CB@DkXB|[AAH@zUBABJIP\\N@LLDFAZZV{Z@`YR`@JBHDDGDIDAHN@JFGLBND@BB@@@@H@NUnMoMTS^@@@@DBBB@BTNDBDDXR@@BH@@DRBLDT@H@HBN@H@P@LF@NCDAHADAF@PEHAR@F@HBJ@F@LBNBB@D@@B@J@@BBDBBBFBD@DBB@D@B@B@@A@A@E@AFDB@BBDDBBB@B@DAB@TB@ABC@AB@@@DA@@DHJTBBDJBD@@BBLLHHDDDDDB@BB@BBBBB@BBBB@@B@D@BBBBB@BB@@B@BDBD@BB@@@DB@@D@FBHDBBBBBBB@DBDBFDF@DBB@B@BBB@@@BD@@@@B@B@B@D@DB@@BAB@D@@@HEB@B@@@D@@AB@@ABCBA@AB@B@B@@@B@BB@@B@B@B@B@@@FA@@AH@@AJBAZKJCDC\\OFCBAVKLQBC@@@E@GBW@GC@DgUp@Z@ZTDBfL@H@B@@@B@F@B@FOpAHEVCF@B@@wBMPGJ@BAB@B@BABE@C@C@EAA@@BEDABEHEJfJ^HB@FCBADBtRB@BBDBB@BBB@B@DAD@B@BB@@BB@@@@B@DAH@B@B@@B@@@BBB@@BBB@B@B@@BBBD@B@BBDDB@F@D@@@BB@BB@F@DAB@@BB@@B@B@B@@BBB@DBB@@BA@@B@@@B@@DBB@PFBBB@@@DHDFBBBD@BDFHLBDB@DBBBDBBBBBB@@BBBBDB@@D@@BD@DDDBB@@B@BBB@@BB@BB@BED@B@B@@@BBBBB@@BBD@BBDDB@B@@@D@F@BAB@BA@@BAB@B@@@BA@@@CB@B@B@HAB@@C@AB@@@B@BAD@B@BAB@B@HCPEVENCBAJADADALC@@DBLBBBZRBAXK@@DGFK@@ECIGA@AAECMMFGBCDGFGDE@ADEBADEB@BEWopL¢XXF@@@@FAHAH@DAD@D@HEFALA@@BA@@@AA@CA@@@@D@DAH@B@HBBABG@@HAJCCGA@@AAA@AA@A@BEEAC@KAE@@AZUCED@@C@CBC@EBIDSG@G@C@EA@@@@AGACAICM@@G@@@@AC@@B@@KA@@@@@AA@A@@MA@ABA@AAAAAAE@AAAAA@E@C@CA@@A@@@AA@A@@G@M@C@@@CD@@@@@@AC@@@@AAA@@B@@A@@B@@C@C@C@C@E@E@A@C@EAG@CAABABAD@BABA@CBM@G@CBEAC@@AA@AAG@KBGAOGQGIACAI@K@EBG@KBKHUL_PMDMBMBK@KAKEMGGEICOEIAKCICGC@A@A@E@A@@@C@A@A@AA@AAAAAE@@@@AABAAA@@AAAA@A@@AAA@AAAA@@BGBADCB@@@@AA@@AEM@A@A@@BA@@@AA@AA@AAA@@A@C@AA@@@A@A@@@AA@@AA@A@ABA@@@@@CBABABA@C@@@CBABAA@@@AACACAE@AAECGACA@AAAAACCEAA@A@C@@@C@@@AB@B@BABABAB@@A@@@A@@GA@A@@@@@@BAD@@A@@B@BABCBAB@HCDADADADA@@@AA@@@A@A@@@C@AA@@BA@AB@AA@@A@@A@@@C@ABABA@A@@@@@@@A@ABABABA@@BAB@B@@@BAB@BA@AB@B@DB@@B@BA@@D@@@BABA@AB@BA@ABCDA@@BABA@A@@D@@A@@@@B@AA@A@A@@B@@AAAAA@@@A@C@@AA@@A@A@CB@@CAA@C@EAA@CAEAECA@AAAA@AA@AAAA@A@@A@@@@A@@AAA@AACEAA@A@@BA@@BA@@@@A@A@A@E@E@G@A@EB@@AA@@A@A@C@EAECE@cEAA@@ECGAA@CBEBCBCBCB@@C@A@A@CBABA@ABA@EDCBA@A@E@@@ABA@@@@B@@@@A@@@@A@AA@@@@@@@CBABA@ABAB@@@BEAE@A@A@@@@AA@@AAAA@@ABC@@@AC@GCABA@@@AB@BA@ACA@@@A@ABC@@B@@@@@B@B@BCB@@AAA@CGAAAA@@@A@@B@BAB@@CB@@CA@@AA@CAAA@@@C@@BAB@B@B@@@BA@@AA@@@CACA@AAAAA@CAA@A@ABA@G@A@CBC@EBC@@AA@@A@@BAB@BAB@@@A@A@C@@A@@@A@CB@@CBADCBAMIGGGIIIECIMIMKMKMGIKGEEIGIEUQMKAACCIGKKCEEEIIMKCCEI@A@AC@GCCAAAACAC@@@A@@BA@A@@@@@AAA@@AA@A@C@ABA@@BABAB@BC@AB@DABA@A@@@AACCEAC@@@A@@DA@@@A@A@A@@NCD@@@BA@A@AACAEAC@@@ACCECEBEIICM@WKGASBUFC@CAC@CBGCIC@E@C@@AECO@I@GeAa@@AAA@@CKCGGOMWACaBCQEU[S@@Y@CA"],encodeOffsets:[[116771,42641]]}},{type:"Feature",id:"150923",properties:{name:"商都县",cp:[113.577816,41.562113],childNum:1},geometry:{type:"Polygon",coordinates:["@@kQaBA@cJGH@@WRifABmFqBoVEBWB@@iAWKEQAICIGOESCO]Q@JQX@@@LAfSFWJCBAR@J@NJRNTJFHDxEELCFBP@F^TJFENABKLGHIJICaMYRENFHcBO@Q@]@SIIE@CCMLSBOBKEQ@@ESA@A@UBO@]TOLQLOHI@@@IA]_AGAKGBKFEFCBIFBHbHJFCL[F_JRFLRAXQNWDEWKBAHYJOFUBYISEDADCbOjALG@UUM[GKA@@UBKBYLQ@_OEE[QqDIJBLFNlTN@XCRDPJTV@D@FoXSCCICKOEADSVWP@LPBV@J@^DD@zDPDTFVC\\KtSTKfHVR\\F`HLTEV@D@DGJkL_YAOAMaIGXSPE`PNLTBBGDQJEFAKMaOEKC@FA@@JCd@@APJLYf@LHFFJCJOTeLA@UKJYWILgJAlUFGHIEIMHCEODE@CIRICE@WBQXaFKVDPLREAEAUQ@@GAU@WNMXM^BV@X@FQh@BABKTcRCV\\RRMHMV^EFKHMLOJjHPPUVKJwnC@NEDGDYvBFHRZRNFlXJXA@OD[HgJGDSPCFDDBDDFDDDDDFFJLJLFJBT@XITAJ@X@tLB@JLDDINABWFQ@mXN^GN@BBBJPELMAIJC@CFo`]^XJDBpN^TLJBAJCVXFFlrLNTPB@PLDD`RbTNHH@B@tCCRACB@B@B@`AN@d@RAD@RAXODOREAM@KDE@CZG`M\\@H@pBdGyBAAAD@JCAMDAF@EUROAC@O\\U@@FM@OB@FSAC@@CIBIGG@@IMOJ@CBABAGGMODG\\mBCTGRAp[FCRS@SDC\\SJ@\\CFALOaOLEVGTE[cB@@APOVOReNS@AY]BAHOFETQZYCGlOTORS~LLALCGuCQCSAABW@@@A@KNYTAZHfGTOLFHB@BJNJNZ@NHPHDBZ@@@\\TFVDRbABDNXHPDHDL@@BB@Bb@fB@H@JDPBF@@@D@FJDHDDAD@DBD@VETAHBXLN@JDFJFA@@@A@AA@AADCDALKBADADCFCHGB@BABADAA@@A@A@AHGBCGA@@DEFKSCQHCGA@@ABCHICAAAEC@@@A@@BCBABABA@@DEDEBCDCBCDC@ABADEDABEDCBCFGHIDEFGFGDEFIBABAK@M@SAKACAA@@@AA@A@A@A@G@A@ABA@A@@DABALS@AUY@@EAA@@@AABAAA@@@C@A@E@ABE@C@CBC@A@@BABEDEDIBADE@A@A@EAe@S@A@@C@AAE@A@EAC@A@C@C@@@EFCB@BIDA@ABWDKBA@@@A@@@@@@B@@oH[DCBC@GBA@@@ABA@@@A@@@A@A@@BA@A@ADAB@BA@A@@AA@AAA@@@A@A@C@A@@@ID@BA@AAABC@CBA@EAA@A@AA@@AA@@C@A@AAC@@@AAAA@@A@@@C@A@CEAA@@CB@BA@AAA@@B@@A@A@@@A@@@A@A@@@A@@@A@CBA@A@@@AAB@@A@A@@C@@@A@A@@@A@@BA@A@@@A@AA@AAAA@AA@@CB@@A@@@A@@@A@C@ABC@@@@@@B@@A@ABABABEDAA@AB@@A@@@@A@@@@@@A@@A@@AB@@@@@C@@@@@BA@@@@A@A@A@A@A@AB@B@@@@A@CEA@@@CA@@AA@AA@CA@@C@A@AA@ACAA@A@MACBAA@@A@@@A@@A@A@AAA@@AACAA@C@CACCA@ICOAA@AA@@@AAC@A@@@AAACC@AC@GCCCCAAAAAA@EEEEAAA@I@C@E@ABCBEB@@CBCAA@@@AAAAACAC@CAGACESCKAAACACCAAACCGCEAGCIACAGAEAA@ECCAAACCCEACAACIAEAEAC@A@A@@pILA`GD@FCBATI@ABBBCF@@BH@B@DA@@@@@AB@@@FAB@@@@AA@AA@@CB@@A@@A@@@AA@@A@AA@CAAAAA@@@@@AB@@@@@A@@@A@AA@@BA@A@@A@@A@A@@@A@@@@A@A@@@@A@@@A@@DA@A@@AC@A@A@A@@DB@@B@@ABCAA@A@C@@@A@C@@@EA@AAEAAAACCE@CEAAAAAAG@A@A@@HEBA@AAA@AAC@ABA@AA@@AA@A@C@C@A@EACAA@AA@ACCAA@@A@A@CBA@AAEAAC@@@ABA@@BEKG"],encodeOffsets:[[116630,42299]]}},{type:"Feature",id:"150924",properties:{name:"兴和县",cp:[113.834173,40.872301],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@@A@@@ABABAB@BADEBCBA@@AABABA@@DA@A@@BAHDDCDEZFB@@@NED@BAFAD@HED@AC@A@@@AG@@C@@BCBA@A@A@@@G@AEA@@AAHBBEKA@@J@@ACA@E@AB@BC@@@@@CACN@D@B@@AAOBA@ABAB@@@BA@@BAFAB@B@@@@@@D@BB@@@@D@D@@@DA@@BB@BB@BB@@D@B@@B@B@D@@@@@@B@@@D@B@B@B@BBBB@@@@@@B@B@@A@@@@@C@@@@B@DB@@@BBB@@@BBB@DB@@B@@@B@@@@@@AB@@@@@@B@@@@B@@@@@@@@@B@B@@@@@BAB@@@@B@@@@B@@A@ABA@@@@AAB@@@B@@A@@AA@@BA@@@@@A@A@C@@BA@@B@@@@@B@@@BABADCFCDAB@@A@AAEBAACJIHEBC@CCGACECGJKI@MBQAKCICIEIIGGGUKOCIAG@MAU@Q@KBIBKCGIAMCKIKKKEGGGMQCEGKKIOIMCK@MA_DOECIJGJEJCbOVKPKROLKFEDELKLIFCFELGVKPMJGNILIRMNGLKHG@ADKBM@C@KFIFKFCFGBCDED@BAB@@@BD@BBBBHBBB@B@B@BCBABA@@@@@CBADABABAFE@@B@BAD@DBF@B@D@BAF@F@DANABAB@HFD@FCFABA@@BAB@BC@A@@@AG@CAIA@@AAA@@AAA@@A@@@E@A@@@@A@@@@@AB@B@@@BA@@@E@C@A@KBA@A@@BADCBABADCDEB@@AAA@A@A@C@E@ABC@CBADA@A@@@@CAA@AA@@@@BA@@@AB@@A@CDELCJCHCDCDECIACAEOEIAAAACC@AAAACECAAACC@AB@BAB@HCFC@@B@@A@@@E@@BEBABCBA@CDE@@FABA@@@@BC@ABABAB@BABABABA@EDCDCHC@@B@BBHFDBD@BBDCDAB@@@@@BBBBBBDBF@@@@AB@@A@A@@@@AA@A@ABA@A@@@@@AB@@@@C@A@C@EBCAIEEBEFEHCJMJGJEDEFEHK@@@AIACAK@GAE@IAGBKAMAICGAECIECAECCECAACACCG@CBEDMBEBCBKBCBE@ABCAEAI@E@A@C@C@EACCCAECEAGACCEAMACCICCEGAECCCEEGECBGBCDGFEFEHGHEPEJGJGJS@I@MAMGIGGIGKKKAMCIAIAGAIAKCMCMCI@GJKBG@CBG@I@E@I@GAQCKCEC@@@@A@@B@@C@A@A@@@@ABAAA@@AA@ACAAA@A@E@AGEGIIGKE@@IEMGIGQKMIEEOGOEUEOBIFIPEHEJEJIJKJIFKHKHSTKLYFDfIDMHEJIX@\\YXATGAINIBC@Q\\OAGCBH@@C@CAC@A@A@@@C@@@CAAAA@AAA@C@AAA@A@A@A@@@AB@@A@AB@BADABA@CD@@@B@B@@A@@B@@@BB@@@AB@B@@A@A@@@@BA@@BBB@B@BE@A@A@A@EDA@EDA@ABI@CBEBEBABA@EDC@GBE@EDC@ABCB@@C@@@ICAAECCAA@A@A@@@EBABA@@@A@C@A@C@GBC@CBEBEBABCDCBKHABABABAB@@A@EBABA@C@A@C@C@IAA@CBGDA@GBCBK@C@CBCBA@EAC@A@ABA@CDA@CB@B@HAFAFADCFADADCD@BABBDAB@@@@@B@D@D@B@B@@ABA@@@A@A@A@CAEBA@A@@BCJABAB@B@F@B@BAD@BAB@D@DBF@@@D@H@B@HBD@D@HB@V@NPHJFJCX@@JPBDDDBFDFHPBL@JCXQVA@@HFDBABLVGVB@@TLPHBHDHBDGTFDHF@P^JTHHNCJAFMHAFEPADMJODCHADDFJRH\\JLHLDPLVGDKHGJOGCA@HFLDBLJPBLABBFBBDHHHHJDJFFARETCD@PRAFI@a@MPjJYNIFDDBBJBKDANEHFJDJH@PKZA@@ABKXdNIZmFKBOFGBGD@@K@KBIBMBMHGJCJDLBDPNHFRL@@BBBDGLAAGDADKMAAOCU@IL@BGRDRVLQd@@CBK@OCEBCBGDOBSAE@@CAEAEEGA@QAWCM@I@GAGCKCIAIFID@@PZEHEDA@G@OCUGI@SBEACH@FADVJATADELELAFMJEHIJyLMB@@KDFPJFBFBDGBFPFFJH@@TLHDBRDNNRTDfjIJ@@V\\HJ`RPJVDFELEHABLBH^`JB@@J@PGRKPK^SP@VAB@B@FT@@FRALAPKTDN@DJFTJ^@R@P@dAEGFMZQbNJDJIHGLKBAFMIE]S@EAODEFKwFGCIEMSIQ@M@IBQDAXITEBe@K@@RW@I^RDPFTHPDJBJFRXLjB@@XAFApUrAnEBAjeXQ@@HGdIB@bAlRLH"],encodeOffsets:[[116636,42303]]}},{type:"Feature",id:"150926",properties:{name:"察哈尔右翼前旗",cp:[113.214733,40.785631],childNum:1},geometry:{type:"Polygon",coordinates:["@@ICQCAAM@C@ECOGOAKFQH@AHKPWAOIY@@UE]FSGaFKH_F@BABCBWJaPKH@@MJMNKVC@K@GIKKW@OBA@CDCFWJMP@@@JIPOFEHAD@@A@MDOHULSLYHCJILIHM@W@C@G@EFA@KJ@J@D@RGHCDAJ@VFTHVDDHHHDNDFBDDFFFDPOFAHDF\\MRQVABIVLJP@\\BZNXBADCDKR[bBPBLcZCRWIaCODmLSF_@KL@D@PnNpLJBH`@DMVuFiFE@[hAHC^@RSAADDHDJJHNBL@R@LDJJLDJBB@XAR@DBABCBADADADX@@H`ATFJCF@ANJ@BCVDKDDBFDJC@FDCJDB@VFXCFAFH@H@BBLJJHANCZPJJND@@@ABGFABABCBEHE@CBEHE@@TFFBDAD@BEDEBE@C@EaGFMFBFBHSTBFEHEBEZAAQ@KTFHCGCKGJYJAAI@AFK@C@CEGAAQABGCG@CsKKAGCEAHKFIIGPEGGBABABOICCCAC@@GAIGCEAIDE@EEA@AAADCHCFALADCF@DA@CFEDCpe^IBA@C@EEMMgDADCNALbRjFN@DBAB@D@F@D@B@B@B@B@B@B@B@F@B@B@D@B@B@D@DADBD@N@B@D@J@DF@FAH@F@B@HAH@FBJ@DBHADEDKBKDK@KWFAHDBFBBJJHFJJANATRRJHZPAVBFHPBH@NAVAJ@H@VAJ@X@F@D@FMBC@]@]Ay@YC@XABADDd@H@TDVBPLH@BBFBJBLCDCFGJCH@B@B@BBBDFRRD@@@EHB\\GTCTCVMIC@ABAHB@AC@@AB@@@AABAB@@@DA@A@@@ABBHCHDB@BADABBDFB@@@BB@MDAJBBAFB@BNBDADDB@@DABE@ECA@@BC@@BC@@D@DD@AD@BAB@DFBD@D@JJNLDBFBVHHJRVIRFBTAJ@VHPDH@B@FCFGOY@@JCJEJBLDHDHBJ@N@XDRBB@FHBFBF@DF@TBPAHCDAFAPDL@DA@@RcUKCQHQ@AJKV@PDBBLNBCHCBBHKACAA@@QKGEOMACCKDIHINGNAJALAL@@@HCHAPELAnEJYcMLWBA@@YBOLG@CIEIFGBMLCIAAACCJEZMiINOb@J@BEOQC@SDQFEBIEICGGGGACEAAAKBOAKICAEK@GDBPHHILGHCKUCOGKIKG[IQCEBCDGPCNIBCFOBENGBEDIGMSG]I@OGEECHSACCGAGOGSK@@UAUHAKABEC@GB@RUDW@IAKGOCEAECCACIO@@DWEIGIMOU@A@cTSD_HYPKH]DKLKAG@GKEIDYOQKEOCBgMGKQE@QCEBIDA@ICKMCCGCUQS@A@SHCBIHABWPYAKJBBCBOHGHOH"],encodeOffsets:[[115958,41705]]}},{type:"Feature",id:"150925",properties:{name:"凉城县",cp:[112.503971,40.531555],childNum:1},geometry:{type:"Polygon",coordinates:["@@FEHANC@AB@B@BAB@DALEDALEL@B@F@HDF@B@JBDBBBB@D@BAB@T@B@@@FBD@B@B@BBHDB@B@B@DAF@B@D@B@B@DAB@@@DC@C@ABABADABADAD@BAF@B@FBF@FDB@BAB@BCBC@CBADADA@A@ABCFGBADALIFADAB@FCBCB@DABAB@@BB@DD@@B@BAFAFANA@@B@J@B@B@B@DADABA@CBABA@A@C@@AAAAC@AAA@GDI@C@C@CACA@AA@AAA@@CCCMECCAA@GBABA@C@AAEBGDGDCDA@EBG@K@EBCCCCAC@@ECACCIGCCA@CB@B@@CBAAA@A@ABKACAA@I@E@@@GDE@I@CCE@E@GA@AIGEGCGKEEIBILEJC@GKC@@BOLAJADCFC@ADAFCB@NAJA@A@@BADBBABABADG@AFKDGBA@C@CBA@C@AA@A@EAA@AA@@@ABE@AB@@CBGBC@@FIDIHK@A@AHOAE@A@EGAYGE@MEIEIAMCIAICIAAAEAOCICGAIAKECGAI@M@KGMAGAGCE@ACIAICKCEAGACCGAGCGGMMaCMACEGCGAEGGICWGGAMCICE@ECWGECOEWKGCGCKAAAEAIAKAI@I@KBIFEFEDIHAAGHEFGDEBEBCBIHADGDGDCBEDA@ABEDCDABGDEFCBCDEDEBEFEBCBGDEFABABADEDGBC@@@A@OFEBOFIBA@C@C@GDIDGBIDEDG@ABKHE@GBCBKBGBABYDK@EBIBMDCBEFCBEDEDCBABEBKFEBE@EDEDAD@BABCDMHCBKBE@EAE@A@KAcEI@KCEAAAGCICEEECCEKGEAGCOCEAGCCCCACCECMGGAI@I@C@EBCBE@A@A@GAEAG@A@A@A@IXEPYJADC\\EXEfAB[DNxEARB@b`A@NBBJJFBB@D@F@DEBG@ABG@E@EBCF@B@FAB@@CFABA@@BABEDC@EDEDABGHEB@BED@DA@B@@BDRBBFNBD@BA@@@AB@FeJkRSNUPADM^cVW@W@QJLBMTQBKTINcPBL_J@BB\\LTB@@AB@BBB@DAF@BC@EBCBAD@H@H@J@DBDDDBD@JFBB@LEJBDIREXADA@WRMJSLEDE@KHABAT@@@BLBXHDBDBB@PBHFBBH@B@FANEDCBBLBBBFEJAFC@BBH@BAHGN@HALBDBBFBLBFFBDJFCHEB@BCLED@JCBE@SD@RAF@@KLIBAB@BBDJHNDB@tJHHPJD@@@B@HBBDBNDHADCF@JJHBH@FDDHBDDHFFHHFF@FBBA@DDAXLJL`FFVPRD@\\A@@HgMKAS@I@E@ATUNFZDVJXJBEDIEW`F@YQ_@@NSlAf[BABMTGF[BCFURGR[@BBAB@HDB@DCF@HEHABAB@FBFBFF@DBBNADDD@JCXGH@RAVKFJFATIBKHMJMB@FCLERFEFDLHNJ@VETKF@TBDLDVLJVBXARDFXJHLJTGJCACD@HBHCFADDHBDFD@DBD@B@B@BCD@BB@@BC@@B@@@B@@@BB@BB@D@@BBDBD@@@@@B@B@B@@BBBB@B@B@B@BBB@@B@B@B@@ABB@@BB@@BBB@D@@BB@@BBB@BB@D@F@BAD@D@@@@ABA@A@@BADCD@BAF@B@DAD@D@B@B@@@B@F@JBJBB@B@@B@@@BB@B@B@@@@B@B@FABBBB@B@F@@@@B@B@B@BBB@BD@@@B@@B@BBB@@@B@B@BABAB@@@BBD@BCDEDADBD@BBBFDBBB@DFBBFBDBDBBBDBBB@B@BADADABC@C@C@ABABBAAJJL@PX@D@HEBCFURF^CBCBERJ@@TGReLWBB@BA@B@@@BD@@@BB@DBBBBB@@BBB@@BB@B@DAB@B@@@B@@BD@DAB@DCB@DB@@@A@CAA@@ECAE@A@A@ABADABAB@BAD@BABC@AAACCEGAAACCAACGKACAA@AAA@A@CAMBA@CBABABABA@A@ABCBA@C@A@ABCACAC@ACA@A@A@AB@JE@A@AFK@ADEBEDG@A@@@@EAC@EBGBCBEFAB@@AB@@CBE@A@E@G@ABIFCBA@AB@@A@AA@A@ABCBE@C@ABCBG@ABA@ABCBEBABCBCDC@@BC@@D@BA@@FCB@DDB@DGB@BABADAB@"],encodeOffsets:[[115542,41590]]}},{type:"Feature",id:"150928",properties:{name:"察哈尔右翼后旗",cp:[113.191035,41.436069],childNum:1},geometry:{type:"Polygon",coordinates:["@@LGFEU]GNQN[QDUdQLSBA@ARg@E@WAUN]NWXMV@HB@@VRFBFBKQCOLUbERWXA@DFQJDJF@PCDFNGFJGJEHkVIBKhXJIZVLB@fKPSDIEIGE@KZeIKBO@@Dc@IB@@ELDPFNbBLFERIHCAAKSOMF_TOHWbJBNBP`ZlKHI@C@CFUKS_G[EUQeGSLsT[LUDSEOCyCC@]CI@U@OA@KXOTUBCPFDLDJTDpW@E@CSUOIQCWDM@kSEMAKJIrC\\RFF`PR@ZKLAVA@@LB\\HVN@VKHiBaPCDCBTFZJVAPEZIBGLAFXXCRMBWKQQE`I\\EDKIEaGAGJEDAUCOI_QGIU[@@JIeiSCMQCMAQGCSK@@IGEEEOHAACAEIEEOLC@@NAzKJIFGNIBEFKFKBCBSUIBC@EDGJQQUGIUGEACAMKIIC@C@EA@CBA@ABCC@@C@CD@@AD@@AB@FDF@BA@CA@CCCBMA@AEAABIACB@NAA@@A@CEAACBABA@GCGDAA@B@@@BCB@@A@ABBB@@A@@BD@@BGAAB@BJDUNSDSD[HGA@F@@QCQUAUXWJQA@BA@CF@DGJA@CDABKJ@A@BA@K@EBIB@BODA@CBA@@@IDI@IBC@C@C@GDMHC@GFA@ABCBC@EAIEACCCAEAE@EMEAAAAAECKAEACA@E@CBCBA@AAA@II@BCCC@C@GDQHEDCBCBC@A@CCC@AAC@C@CBABA@GAMCE@A@G@CDGCC@@AMCIIYOMDGBIIAK@A@GEGEBWDUEA@ICCD@EIDECCALCUCADI@BME@IDSE_B@GW@BCBCBCDABACAQ@WBA@IAKCIIKCQ@K@MAIGCICGBC]AeecgAAGVI@CDGJJFM\\JJF\\W\\ELGLDC@DADADCHO\\@@GFC@AB@@@BDD@B@B@BADAB@@@BBBB@BBDBD@DB@B@DBB@@@BB@BDDBBDFD@BB@B@BBB@B@@@BADCB@B@B@DBDBFHDDBB@BDDB@@BA@ABAB@BBBBBBBBD@@BB@B@BH@@@F@BBDADB@@B@B@B@DBB@@@@@@B@B@@@B@D@@BBHDFFDBHFB@@B@D@F@B@@@B@FBBBDBB@@B@B@@@B@B@BA@BB@BB@@@B@@@B@@@BBBBB@@DAB@BA@@@@BB@B@BBB@B@@@DAD@@BB@@B@BBB@@BDB@BDB@BB@@HCDAB@DBB@DB@@B@@@B@@@BB@BBB@B@@@@BB@@D@@DB@@B@@D@@B@BB@@DBDBBB@BA@AD@@@B@BAD@BBB@JBF@D@@LFTH@@@DB@@D@BBD@D@B@BBB@B@@BBB@@FB@@BB@BD@@@D@D@FAFAD@@@DAB@DADABA@ADABA@ABA@@DBD@@HJDDB@BDDF@B@F@B@BABA@ABEDABAD@@@B@@@BBBB@B@BDDDB@BBBDFDBBDD@@B@@BFDFDFD@@BB@B@H@F@B@BADAB@B@B@BBB@@@D@D@BA@ABABA@ADAF@BCD@DAFAB@@BB@B@@@@@D@B@DAF@D@@AF@@@B@BB@@B@@@BA@CBE@A@@B@@@B@@@@@B@B@@AB@@CD@@ABA@@BA@GB@BA@@B@D@D@B@BABA@@BA@ABA@@BAB@@@D@BAB@B@B@B@@BB@B@F@DAD@@AB@@A@ABA@ABEDABAD@D@DE@CBEBA@GBAA@@ABCBA@ABAB@DCDCDGH@DCBADAD@B@F@B@DBD@BAD@RBNADABAFEJENEHEJAPHN@H@V@X@N@R@H@f@LAZEjDBj\\VPLHDDBDBN@HAJAHAJ@BAB@@AH@BABAH@@@B@BABAH@DADAHAD@DA@GFIDABERCHABB@Ut@BHB@`JTCHLBFHDJRH@JGH^\\Cd@@@@@BBBHDBbZBB²bpNJBljRACDAPKPMBYJMNQFGBAHILAB@pTLFTNDBHERMDAF@J@\\BRLCP@NeDFLIDAHbZ^]p_DED@JINBFKIOAA@AHMM]nWR@XEBAJMCCIKA@sKW@I@SBWJS@IAKEKIEICECCCCCEACCCDETOHChI\\GPCB@IWkWMEYQGQAEZuHCFCMD@xmLIVUOOiGPINK"],encodeOffsets:[[115969,42692]]}},{type:"Feature",id:"150927",properties:{name:"察哈尔右翼中旗",cp:[112.635577,41.277462],childNum:1},geometry:{type:"Polygon",coordinates:["@@UDYA[EUCWCG@@AeRMFYJiDQB]QAB_DC@@@@@A@@A@@@@A@A@A@@B@A@@@AAAAA@@@AA@ABA@AACA@AA@A@@@@@ABA@@BA@A@A@CA@@C@@BA@A@@BAB@B@B@@@@@B@BA@@BC@EB@BABCD@@@BAB@@AB@BA@AB@BA@A@A@AB@@A@GBA@@@@B@B@D@B@DA@@BA@C@AAA@AB@@A@C@@@A@AAA@CDMAECEDCBEACBBD@DABADEHIJFBHBB@HCNBbGVZHpbBH@NP@@A@A@@@@@@@A@@BGA@@@@AAA@@@A@ABA@@@E@C@AB@BA@ABA@ABAD@B@B@@@B@@AB@@@D@@@BAB@@@B@@@BCBCBABABC@C@@@@BA@CB@@CBABAB@@A@@@AAA@@@@@CBA@C@A@C@ABA@AAC@@@@@AA@@A@@@A@A@@@A@@@AAA@C@@@A@AAA@@AA@AA@@@@@A@@GE@@@@A@AB@BA@AB@@C@A@A@EBC@CAC@GAA@@@ABABABABA@ADCBCDAB@@A@@@AAC@@@EAA@@@A@@BA@ABA@@B@@BD@B@@ABA@ABABAB@B@B@B@@AD@D@@A@@BA@@@@D@@ABABC@EAC@AAAAA@A@@@ABAB@@@B@B@BA@@@A@@AA@@@@B@B@B@BAB@@ABC@@@AD@@@B@@@B@@@DB@AB@BABA@@@A@A@@@A@@B@@@BA@A@A@EDAB@@@@@B@@@B@@@@AB@B@BA@CBEBABA@A@@@A@@@CB@@A@@@C@A@CDA@C@@@AB@@ABABEDABA@A@A@A@@BCBC@@B@@@B@B@@CBA@ABADAB@B@DA@ABA@EBC@GF@@@B@BBBAB@@A@CBA@@@@B@@BB@BA@A@CBABA@AD@@A@AA@@A@E@A@CBCBABABBBCDABA@AD@@ABA@@B@@A@ABA@A@CA@@A@A@A@A@A@C@@@A@A@A@@BAB@@A@E@C@EBGBEBE@A@CA@@@AAA@AA@AAA@IBAA@@GBC@A@A@@@A@EE@@@A@AA@A@A@A@AAC@ABA@@@AB@@@IC@@HC@@B@@BB@BAB@@A@A@CAA@A@E@@@A@A@ADA@@BBD@B@@@BA@ABA@A@@BA@CBABA@@BA@C@A@A@A@A@ABCB@@A@OCEAA@I@A@@BC@C@A@I@A@C@CAGCAAA@@A@AEAAA@@CBA@EBA@@@AB@B@@@@A@@@GA@@A@A@ABC@ABCBA@@@@D@@BBBD@B@@@BA@C@ABC@EBA@@@CF@@CAA@ABA@AB@@@B@@ABCA@BA@A@AB@@@BA@I@A@@B@@@BBFBD@BBH@DB@@DFDBB@@@BA@E@C@C@A@CBCBA@CD@B@B@@ADCB@BC@AB@@ABC@A@ABA@AB@D@B@BBD@BADABAB@@BBBBB@B@@BBD@BABABABAD@BB@BB@B@@@BADAD@D@BAB@@B@BBDB@@@@@@BBBBBBBBB@@B@@FBBBB@BB@BHBDBBB@@BBB@@BB@@B@BB@@@F@B@DB@BBB@@DBD@BB@B@BAB@@@B@B@BBB@B@BDB@@@B@@ABGBAB@@@BBB@BHB@@@B@BBDDB@B@@@B@@BD@@@DDBD@BBB@@@@BCB@B@@BBBBD@BB@B@@B@@@B@DA@@@BBFFL@@B@BBB@BDBD@BBBDDBB@@DAD@BB@@BADCDCDAB@BABBB@BAB@DABAD@FCB@B@D@BBF@D@D@BB@@@B@@CB@@@B@@@B@D@@HBDDBDBD@@@B@@AB@D@BAB@@B@@@DBBBBBD@B@@BBBB@F@B@BBB@@@FAB@B@BBB@DBFFB@B@B@B@HEBAD@D@BBBBBB@D@BAF@BBB@BB@DAB@B@B@BBBBDD@@BABADAB@B@B@D@B@B@BAB@BBB@D@DAD@D@BBDDDBBB@@BBABAB@B@BBDALABABAB@@AB@BBB@B@BABAFABCB@BA@CBC@E@AB@@ABA@@BAB@BBB@BBB@@DBBB@@@B@@CBABC@C@C@@@@BA@DB@BBB@B@B@D@D@BBBB@AB@@AB@BAB@BCBCDCB@@@BAB@@@B@B@B@B@BABAB@@@BD@BB@BBBAD@B@BAB@@@B@@DB@@@B@@@BABB@@D@@@D@BCB@BBB@@@BDBB@DBB@@B@F@BBBBBFDDBBBD@BAB@B@B@B@FDBB@B@@FBDDD@B@B@@@B@BDGLEFDLVJFXBXE^RDHIL@BDI@@@@@B@HBAFJEDDBGL@FNLB@BA@AB@@@BBD@FAB@FBD@B@B@D@F@@@@ABC@CBEB@@ADCDA@EBCBGBABBB@@BBBB@BAB@B@@@@@DBF@BDDJDB@L@D@BBF@DBBBFDBDB@@BD@FBFBB@B@BBB@DD@@B@F@@@D@B@BBBBF@@@BBAB@BB@@DBDP@HGBA@@D@D@BCB@FCHE@@@AB@@AB@BAB@BAH@B@D@D@B@DBFBB@BBB@BD@@BB@@@B@@@@AB@BB@@B@BBD@B@BA@@B@@B@D@D@DAHADALBP@JDBBDBDBD@F@H@D@FB@@B@BCB@D@D@@@BABA@@@A@@BAFCB@@@B@B@F@FBBAD@L@DB@BBB@@@@B@BABA@@@ABC@@@@DAB@@AB@BADAB@B@BC@C@@@A@CB@@AFABA@@B@FA@@@@BAB@FCB@DAHAFADAFADCFCFABAJAFAFAF@@@BCFC@AB@D@DAF@D@DAD@D@@BB@@BB@@B@BBHBB@B@D@B@D@@@BBB@BBB@@@DBBBB@@@FBF@@BHBFBD@@BF@D@FBD@D@B@DBDBBBD@BBDBJBHDJBHFPBFBF@F@@@@BBBD@B@B@@@BBB@BB@@@DB@@@BADCFAB@@@B@BBB@B@@BB@BBDVBRFLNF@@B@B@J@BAB@B@DAFAB@F@FAJ@FBD@B@@@B@BAD@FAB@H@HAF@DBDAB@J@J@H@D@DALALAF@RAVADB@@JDD@DBBBB@BBDBDBDBBBBBDBBBFD@@B@B@B@FCB@JAJ@@@B@@BJBB@@BB@BBD@B@BB@@L@@@@@D@QmfHPXZGPjVZExOPGHBJB@@BADGFQBAJCHEB@@CBCBGBC@CBGBA@A@A@@BGBA@ABG@@BA@ABIBGBI@GAMACCCKGUOi[CAFiBY@K@e@G@Q@M@W@U@GGMBOFIFGFMFIBEBABCAM@QBC@AAC@C@A@E@ABCBCDA@CHGDCDC@CBABAB@DABA@@BBHAB@FADAF@@C@CBCBAFCBAB@BAB@@@BA@@BC@C@E@AAA@@@A@A@ABA@A@C@@BA@AB@BAB@@AB@BA@A@A@C@C@AB@@AHAB@@AB@BA@@DC@@BA@@@A@A@@@@@A@@@AB@F@DAB@@A@@@AA@@A@A@@BE@@@CBE@C@A@C@@@@@AAA@@BABE@CDC@ABEBCB@BABAB@@A@C@C@@AA@A@A@ABABC@A@A@E@G@AAA@@ECECEC@AA@@@CCAAECACAAA@CCACA@A@AA@A@@@A@@BCBAFCBAB@BA@A@A@E@ACEACA@CCGI@@AC@CB@BAB@BABCB@BABC@CBA@C@@BCBE@E@C@C@@ACA@@AA@@EA@AA@@@AAA@A@A@CAC@A@CA@@C@@SGKE@@@CAE@IAA@ABC@A@A@@BCB@@AAACACA@@AAA@@@@CA@@@CA@@@CA@@A@@A@A@AAA@@A@@@A@@@AA@@CAA@CBADC@GA@@ACA@ACA@AA@AAA@@@AA@@BC@C@@@AAA@A@AAA@@@@ABA@CB@@AAAA@A@@@A@@@A@@AAA@@AABA@A@@@A@A@@@AAACAA@E@A@@@A@E@C@AA@GECAEEGCAA@@@C@A@@@A@A@@@@A@CAA@A@A@@@CACBAAE@@@G@@A@AAA@@ACAAAAAA@ABABAB@@AA@CC@AAACCEGCACAA@A@A@CDAB@@A@A@AAA@A@@AECACCAACA@@A@@AA@C@ACAC@CAAAA@AA@A@@BABC@A@A@ACC@A@@BAD@HE@@P[DGBCBC@CCD@@ABADADCBABA@C@CBG@@AA@@AAACICM@AAA@@AAA@A@A@ABC@A@A@@ACAAA@A@AB@@A@@AAA@AAMCGACAA@CEAA@@AAG@A@E@CAA@EAA@AAGAA@KCEAEAA@E@C@GBUDABA@@DAB@@@BABABABA@@@A@A@A@A@CBCBCBEBABA@C@AAAAA@EK@CACCCHADA@ABCBEBG@A@AAACE@@ACAAA@@AB@DAFAHAF@DABABANGBADA@AFGBADEBAFAD@BAB@BCBEA@AAA@A@@AAA@@A@@ACC@@AA@@@A@A@@AA@@E@C@A@@@AA@AAE@EAC@A@C@E@A@AAAA@@AACAAGG@@AA@A@AAAAACA@@@AA@IAA@A@@@A@KFEBA@GAABC@A@A@@B"],encodeOffsets:[[115276,42012]]}},{type:"Feature",id:"150981",properties:{name:"丰镇市",cp:[113.109892,40.436983],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAQ@IAQ@IAWCMCM@ICIAI@Q@GAOCSCM@OAI@IB[DIBU@KAGCGB@@C@A@A@EFGHGLCDENCFCJERADAJCFEH@B@BAB@BABADAB@BABCFABCDABCDIFCBC@@@ABABGH@BAD@DAJADIDGAGAKCIEA@ICIAEAOAW@ICE@YCQCMCEAUEIAOCMCC@OEIAMCG@KAKAKCA@EAI@OAK@YEM@KAA@KAMCECGAIAOEKAKAI@OE@F@BBFGP@B@BGLCJEJ@@ADAH@DA@@BAF@B@@BBB@FBB@B@@B@DAB@D@DABCHEL@BCHABABABCAAB@@@BIBMBA@EDCB@BEDCDIBKBAP@@LD@HIDKFAJFJLFDHFHJH@BHBF@F@DDJ@F@HC@@F@J@B@DBLBBAB@B@BBDA@@@ADAB@DDJHDDDB@FD@DBDDAD@F@LAH@FCBCDCHAHBF@B@DABAB@HBBDDNFDD@DB@BBB@@BDBDBD@D@J@HCB@BBD@BBBB@@@D@BABAB@DABCBCBA@A@A@I@A@@@MBEBEBABA@@@CCA@@AA@ABCBA@ADEDA@CBEBKJCBABEHAD@B@BCBCBAB@DADADA@ABA@ECE@EAA@E@ABC@CBABCBABAB@B@DCD@@A@CBA@A@C@A@E@CBA@A@A@GCAAA@A@C@EA@@A@S@A@ABC@A@AACAIAA@E@GCE@A@K@KFCBKFCBA@ABA@A@@BMDGBEFA@CBABABA@CHA@CCA@ED@@ABC@@@AD@@CDADADABAFAD@BAB@BAHAD@B@DAFAD@B@BBBB@@@BAB@DAJEBAH@F@B@F@DA@@BA@@BAFEDAHAFAD@FB@@@@@BCHAFCF@BEL@B@BIFA@@B@B@BDB@BBDBDAD@B@B@DABAD@B@BABABABAB@DABBN@D@BBB@BBBBDHLBDDBBDBBFHDDBB@BADABC@ABA@ABCBAB@B@B@BBFFD@@BB@D@BFDDBB@HDB@FBDDB@@BBB@F@F@BDDBBDHDBDDB@@@D@D@B@@@DBB@D@BAB@DEBABABA@A@E@@BAD@B@BADADAB@J@D@F@BB@@BB@@@BA@ABAD@@@DEHA@EBGB@@ABA@AB@@@@@B@@BB@@DDBBBDBFBB@DB@BBBD@B@@AD@B@B@@@BBB@B@BD@X@N@JGJKDIZGTKVKPGNCB@@@BCFGPEJO@I@@NOXIDEDCB@PAX@LLHJL@D@LUNMNI@@LGbOXIDABA@A`ELGbETH^EVF@@JZBPOXGL@BRGLEPBPHFDD@N@BBRDJDPGHGPGDAAALIZBXOBAJGDATGB@T@VRHDDDLNJDB@JCFARDF@LRNHAhPDLFPRCZFJHLH@LBLK^CLGZO`GTCdS@G@CAC@G@A@G@C@@AE@C@CBA@ABC@A@A@E@ABABADI@AB@B@FADBB@B@B@@@B@BA@@@A@A@C@C@A@@@@BAACBA@ADCBCBCDEBCBEBE@G@ADAB@DCB@BAB@D@FBB@DADAD@L@DAHAB@HCDAB@JBD@D@B@D@B@BAFAB@@@BABABABALGDADCBAFAFADAD@HAD@B@D@B@@@B@BAFA@@B@B@B@DBFDBBJD@@D@@@DABAD@FCF@HAD@FCB@BAFAFADAJ@BAB@FCB@FCB@B@B@F@@A@AAA@AB@@A@@B@B@@@@ABA@@A@@A@@@AB@@@@A@A@@DCB@BABC@ABAB@@@BA@@B@B@B@B@BBD@B@BBB@BBDB@@D@@@B@B@D@DBD@@@AGHDPBR[D@JAJMHBBSZW@[JWFINGJCCeZEBIAECGCGMQKOQQKEOGOCWIKAKEKCSEYIMCQGIICEAKGM[IMEIEKGOMCCAAMIEAECIEKGECGCSOCAKIEGEIIGOKSEYGGAE@GAIAMAQCKASAE@"],encodeOffsets:[[116212,41302]]}},{type:"Feature",id:"150929",properties:{name:"四子王旗",cp:[111.706617,41.533462],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CAAAA@@@BA@A@CBCBC@A@@@AAAA@@ABCBABABA@AAC@AA@A@AAAA@@BABABC@AAC@A@A@CBAB@BAB@D@BA@@BAD@@ADABC@@@A@ADCB@DADAB@D@D@F@B@@A@@AAEC@CA@@CAG@AACAE@A@@@AB@J@B@@A@@@@AAAAECE@C@CBA@CACAA@A@C@AAECAAG@ADC@AA@@@AAACAAA@@BC@ADAACBE@@AAAAAABAAC@ABEACAACACCBA@@BA@A@ABA@ABA@@BC@C@ADABA@CAAAA@ABA@A@CAAACCA@AACACEAEAE@I@ABADAD@B@DCDCDABAD@BADADEFADCBA@E@A@@BADADABCBI@EACAEF@DaK@BC@IIK@GDSEAJBBBDBDBFFHBBF@@FBHBBBDANABCBADEBAB@BABA@IBCBCAC@C@A@DCEACDC@C@E@EAEDC@EDE@CBCDABEF@@A@ABA@A@@BB@@B@@A@AB@@B@@B@@A@A@@BA@A@AA@B@@@B@@A@A@@@@BCBABABABA@@B@BA@BB@@A@AB@@D@BB@B@@A@A@AD@@@@A@@B@@A@A@@@@BABKA_EEA@DIL@BAB@H@DBH@BA@ADABA@CAC@ABC@YC@@BA@@@AA@ABA@C@CAAE@AD@B@BBL@HO]CEJ@@GBBDKAEABPK@MAE@@@@C@GA@A@A@IBG@C@@FM@AE@AE@CAULGBSAKEO@CA@BBB[BWDIB@@DFMTS@AC@GYB@FI@@EEB@VGFA@WBKAEGG@GAC@E@CAA@CJADADEBG@IAIAECGICCEBEAMBGBIDEB@@ABSKACCAEAE@CA@@@B@DADBD@DBF@@@DA@@BAB@D@DBBBD@BAFADEF@BCDADCBADIDGDMDABGBABAB@@BJ@DABA@ABC@ABAB@B@B@@BBDD@BDD@BBBBDBBBBBBFDBBBDBB@BBBB@DBBBB@L@B@@@BB@@@F@@@BBBB@BBBBHDBBBFBBBBBB@B@BB@@BDBDB@B@B@@@B@BAJ@B@@@BB@DBBBBB@D@D@HBBDHBB@B@BBB@BBB@B@BADBBBB@BBHBJ@DAF@DAH@B@BBF@D@D@BAB@B@H@B@BAB@B@FAB@DAB@B@D@NAJ@D@BABADCF@DAD@DAHABADADCBEDABCDCBABA@ABA@ADCDCDB@KPCJFHIDORCHMLIDER@PEPFHKHED@@@@ċt}V~EDEHenQVKFIFKHIFiDSROPIHMNCDONCFGLaOdCHENKZCHGPM`E@AAEFEDCDGHADCFADCHENADEJEDEFAFCHCDKPOX@BADADCDCDADAFAB@DA@CDEJABMRMRY`CHEFQVOTGHCFQTADABEHADCFCDEDqn[\\MPKJMP@R@PAt@P@\\IVAFADGRKRU^KN@@MrSOxCPAFDTDfDZBPGJKNY\\EFTiNWHm|GLW`MPap[Z}zWXMFQJm\\GDABGHKLQREFEDGJYVqVaZyXyVEDFBPFfPHdLVfP`|bzVLjtpNRd|JLJNC`BB^`T`^lfd`vnVT\\XjTNHRFNLLLxNZRFRgrĨÇªńEöąAEBCnoNIJIPKDAVOHEFELCFANENEDALCtSLE^IfMFGHKFIJKPUDGHGPYNQPUJMRYFIDCT]`mRYDmB@PYLMDE\\iNSNUHIFIBCX_BEPSJMBCFE`e`cBAJIHIRSLMHKLKLKTULOBCDaVEhKhM^IDA`I`IXGnOhM@OC_^KREdMVGpQB@FCRKHEZQvcTMdWyVMHEHCNAKJAPC\\EPCMdG`ExMnIHAhIHCFCHCFAbUZOCGEKCGIQIUAEFABADAiJCPcHSXsL[@@BCDKJSPgFMJ@@A¢IczI\\]^kVUX[^[XQbEjMC@QBkiIAoM±aAAaYCAAGAA@@@@c@[DG]IHG@IQGCAEGKSD_IA@G@AVsA@@@IAGAOHwPYFiUHOWYOeGRnC@@@@@K@@@AAA@C@AAA@@AA@IA@AA@@@I@IBA@EDA@A@A@@@ECAACAAAAACACACAAAA@AACAC@IC@@CAUBQBE@KBKBCBC@G@I@I@A@CBCAE@GBG@A@EBC@ABA@@@A@C@EAI@EBE@A@EBCBA@A@ABI@A@A@@@MEEKAQUAC@AAA@@@AAA@A@A@@BADEBC@A@@CA@@A@@AAA@A@@@A@AACAA@@@@@EAEAEEOAGCIAGAIAC@AACAAAC@C@A@CAC@E@CAE@@ACAEAG@@AE@E@@AAAA@C@@AA@AAA@A@@@C@A@C@AAAAG@A@AA@@AA@@AC@C@CBC@E@CBC@A@@BEDAD@@E@EBEBIBABEBEDCDEBCBEBGBCBA@EDA@AB@@@@EBA@@@ABEB@BA@@D@B@@@DADA@A@CBABA@@BA@CB@@@@AD@B@@ABABA@@@@@AA@ACAK@C@ABEAE@A@A@@@A@EDAB@@@B@@ABAB@@C@C@A@ADA@@@EAC@G@E@C@CACAAAICO@KACBGBCBC@C@A@@@@AB@@A@AAC@A@AA@@ABA@@@@@A@@AA@@ACA@AAA@EACAA@C@C@A@G@ABA@ABA@@BA@@B@@GFEDA@ADC@C@@@ABGHO@AC@CA@@ABAAA@@E@AAAAA@C@@@E@A@@@CCA@AAA@A@EAEAC@@AA@ACECAACAE@AAC@K@A@ICCC@AAE@C@@@@@ABA@AAAAA@@AABAHADAFAB@DCBC@@FADAD@BA@@@@@E@C@A@AAC@EBA@EAC@A@@BAB@@AKAEMK@AHCCIFBEGAA@@@@@J@ACK@GJQCF]AWEWUICKFEHKACA@@@A@A@C@CCEA@@@AAAECA@A@A@A@ABC@AACAECAAAA@A@E@AA@CAA@CA@A@@AA@ADA@A@C@@@CA@BA@A@@@A@@CA@@@A@@BA@A@ABCAA@AAAC@@A@@BABA@A@A@A@A@A@@BA@A@@DADCDA@ABA@ABA@@BAA@AA@A@C@C@A@AAA@ACAB@@A@@D@D@D@BADA@@@A@@AACA@@AA@AAA@ABA@AB@BA@@BAF@D@DAB@@ADABABEBA@A@AAA@ABA@@BABABABKAC@A@ABABAAA@@AACACCAAC@C@CBC@A@AAA@ABA@A@C@A@A@A@CBABAB@@CCAAAAA@A@A@CBA@@AAA@ABE@A@CAAAAAAC@C@ABGFA@A@A@A@EECAA@AAA@A@EB@@A@AAA@E@A@AA@AA@C@AAAACA@@A@@@BA@A@CBA@@@A@@ACACCCGA@@@C@A@@@A@@DA@@@A@@AAC@C@E@AAC@A@A@EDC@ABCBA@ABA@AAABA@CBCDCDAB@@AAC@CB@@AACCAA@AACACA@AAA@@@EKAE@A@@CBA@@@A@@@@AAAC@AAAA@@@ADA@A@@A@AAC@CA@C@@AC@@@A@@@ACAAC@A@A@@GA@AAA@A@@BAHABA@@@A@@CA@A@AAA@A@A@A@@BA@A@AAAC@CA@@AA@ACAA@E@@@A@@A@AA@@AA@AA@@AACAGA@AAAA@AAEA@@@AA@AAAAAAAA@@@@"],encodeOffsets:[[114627,42325]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
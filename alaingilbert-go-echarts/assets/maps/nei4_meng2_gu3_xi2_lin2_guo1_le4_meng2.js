@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("锡林郭勒",{type:"FeatureCollection",features:[{type:"Feature",id:"152523",properties:{name:"苏尼特左旗",cp:[113.667248,43.85988],childNum:1},geometry:{type:"Polygon",coordinates:["@@kWGCÙOQAA@C@oEA@¯MKAA@@@IbBBHLDFRXBDEJMPQRCFABYLKFMFePCBKFMDSJAB@@Q`EJAFKRITCDMBgBSB^VFFDBJHLJABEBEDOF]PDFDHDHHL@B@BFHDHQlDDVPLLNJAD@BCTMDTsN]FGDA@@@AFCZAD@B@DAB@DCDA@~CDEDEBEFeXQJ@@CHABCFEHOMID]JuPEB@FEbANAJAHQnABILADABKNABAB@@BDFHDJFJBB@@@BED_RYNIHYNKHSLC@ED[R@@LHB@LLQFC@wRCDa\\IHGFEFCB@BGPEJGNEJITGPO\\GNIPAD@BABCHEDUNOL[R[RKJCBABGDIDOHWLA@SJeESAC@KAAWA[AO@SAG@GAC@I@C@A@eCI@MEME@@QBMDA@WDODGBGBGBIBPkJFXBFBDLdBHBHFXBF@@GBW@IB@@£FF_BiDQ@A@AB@AWEYGyOA@M@WBK@EBgBSBE@C@SBE@M@FoBMBeBA@WBC@E@A@CBOFEBGDCBA@EBA@@@CBEB@@GDIBEDIBGD@@GBA@ED@@EBC@[@K@M@CAG@AC@W@aAS@S@WAA@C@iFK@aDKBmDMBiDA@AD]VAB_ZABABIBABIBEB·jmNOFA@X[FE@yLC@CBwL£X[FODCBOBgJWFuLSDABsB[BK@CBCNADAD@DENAD@DCBMH{dYPEBuHSDeFYBGBKB@@ADKh}kzILgx[be\\{nOJKJg`OJQHUD]F_P{Vi\\g\\_dGHKNGXEHWNKNYZMPQdQdKrCPAVDZQ\\gf_ROD¯^CPU|X\\xR^JTd~LZfRdPtXNfRRVAPALGCTAVPhb`~LFANLXZlt~LPlxHHVX^npHR\\`X^JRXPr` zr\\N~X\\JzZvR®H^D~aZM¦A^A¾C¾AÀCAfP\\DTEqqPInaNOPSNOr{\\a_ªm|YxQºaSXG´B~AtI¢QT@bG~Q ]^CECV@dAC´E®EF@TAChA|C@AnéNf[FC\\OJUhEaÂ¯BAxiJV}ŌçÒąfq@AB@B@FKbwLMFKfQRGLE¤mZ]^_ScqJeVMAc@Gp_oJIDA~`Ih]¤`TIW­HEHM\\cXaB@DGBAJWFUBCTwf]NaDG\\QnY@A^qHQBEZm@AJI@ALOHGFG@AFO@CHA^KvSDADAHEDATOnaBCDIDI@ADEDKBEDIFM@ABBDDD@@BBB@BA@@@@B@@CBAB@@@BAF@BDD@BD@B@@@B@FEFCHCDCD@FAH@F@BBFBD@@@DBNFDBB@B@DCDCD@BADBB@B@@@D@@@@ABA@AAAC@AA@A@CDGBAAC@@BA@ABAB@BA@ABA@@ECAE|u\\YJB@T]JEyNo^Uwwrgd[JERCpEHEnW@@B@^_hQKOAA]OMGCAaAUAE@I@AECE@AAA_MiQWIOGICiOGAICCAAAA@AAC@UGA@C@MCEAIC_GGACAkKICEAGASEUEC@CCGGEGII_OMEQGYKIAcGOOA@@@YYEESOKKCCECCCAACAEEecE@[AO@GAC@YCOCC@YEOCQC]EkKKAKCICaIIAC@KIAmCE@G@C@CC_OKGA@@@IEC@@AUEKCCAEA_IEAGCaIw_kY"],encodeOffsets:[[117154,43811]]}},{type:"Feature",id:"152502",properties:{name:"锡林浩特市",cp:[116.086029,43.933403],childNum:1},geometry:{type:"Polygon",coordinates:["@@A[@CAMAWZIDAJILMX]FOFSjE@@^mDGVCJARI_W[gEEAUCGALGHG@KBQBKBIBKLCFa@G@BH@@@BBLiMICKABD@LDBBBFBRHEBCBCBIDcPCBEBGDCBaJeLE@KBCA]BAB}BcBg^`GDAB|n`HPJ\\BJMLEBcP[DAH@F@@ABA@AB@BAL@B@D@DAL@@CD@BGJ@@@BCBAB@@ABAH@BADGXCR@BCLAHADIJCl@BBF@@@B@B@@ABC\\AJAR@D@DABIN@BAH@@@BAJ@HDJ@FAFDDFDDDBF@FCFBHBDDD@BBHDN@LBJBHBJ@J@HBDQFQFODKDKNIRGLKRKPGLGJ`YLOFMFQHEREPEVELGN@@@@@@eXlGFEBCD]RWPQLgZÅCDEHEFAFGHID\\IDqTqCgAE@G[SVW\\s|¹z_NVdcBYBDqB_BQBPBNBLBHBJDTIBUDUDKBaHHNBB@BDFLP@BDFFJLT@@_fMPUZZbltTX@@@@DDJJFHJJVVLLRR|JNB@BLNFHLPHJDBFHNPBFPRA@@BAFAB@BABGB@BAB@B@B@@@@AB@@BBDDBBHD@@JBB@BDFHBDDBBALED@BAB@@@BA@AJ@D@B@DA@@dJ\\J@@B@BXF|BX@FFz@VDRMXCHWNwf]RYRUNaVczUfkGNELCFUrI`GR@DGHEHGHBHJ^FVDJQRY^GHABIDIBDBFFFFFBJFHFLBP@HFHBJAFAFFHABFHF@FCBCHDDDB@HBDADHH@DBBD@DDD@@FAH@H\\tLRDF@BNDTHZLVJfVfHNNZFJLTVhzJJfFjf^ZnffJfHbHZHB@VUü÷HGLKPMROj[dWrW]hFªNÚSxrZAERtfTP]LeNXLDBLFnZ`RJ@rBD@F@T\\BDB@TCH@jEÎUJAFA|GDA¤AEKNUTUVIRB\\YFGNCNMDG@MJIBKHGHCDIHEFGFEB@@@@AHGDEVIPARFPIHMBCIKFMAKFKOCBMLSCGEYBIGEEK@KAASIECEGEAoqegCQAAks@AQcwy£iw@AnitqPO\\@aAS@CC]AELD@¢YjZFZBdILQ\\cbkhsRYZ]¦VSDCÊZbNPH@@@@@B@A¨e@@V]@@AW_QakBATWM[KS@A`qQIYGKCBGVFQ@GB@¬{@@¡@@AYO@@QFC@kG{KDCA@AA@@@ABA@A@@@AB@@A@@BABA@@A@AAA@AA@@AAA@A@KCA@AAA@AA@@AAAAAA@CBA@@DA@@@A@@A@@@EA@@@@@ABA@@AA@@@@@C@@DA@A@A@A@@AA@ACAAA@@AA@A@A@A@@AA@@@@A@@AA@A@A@CBC@@@AAAAAA@AA@C@SDA@A@A@AAA@@ABAAA@@EAA@@AAA@IAO@A@ABA@CBC@EBCBCBADC@EKO@@@At·gUSISKA@iPkT[N­P¹WBC@GS_KeGiFqQSA@m[u_{_]YKcaASCQEa[YUAK@YAW«"],encodeOffsets:[[119152,44228]]}},{type:"Feature",id:"152501",properties:{name:"二连浩特市",cp:[111.951002,43.6437],childNum:1},geometry:{type:"Polygon",coordinates:["@@gA@QCA@CAG@eEß[GAwSYIQGKC@NC\\@RALClI¨@DCfAJ@D@NAREZCL@BCRAF@BLnBH@BDTDRBFBLDXBFBL]\\spOPEDABgXWPIHKFIH|EBAB@@[[GcIA@EB[F£ZOFYBUDgDWBIBcDA@OFaNCBA@MBEBBFBB@BDHBDMFbABK@I@A@M@C@AEISA@ABKBuPuN[HIDYJYJEBbABCDABOZCD@@TRxZJZTHTAGBT@TJf|~²@DD@B@XL\\J`HHDD@TDD@HTBDVzH\\@DC|Ap@@LAHAZAfETCvGFAZO|cNGDA@CBCFM@CBCBCDMDAL@\\AtABATCvKXEhIPADAPC\\E¤WxKDAD@zKF@\\EWB@PEDKBK@EBm@CPYP_BC@@Be@MBK@KDwBg@@@AESAG@A]AESsK]SqEMAEOCMIaESHcHgHiDMBMYIeKLSLUV[\\cPSLM[_ACCCq{UWACA@@AUGKCKCEAWGiUKUIicQ"],encodeOffsets:[[114895,44058]]}},{type:"Feature",id:"152524",properties:{name:"苏尼特右旗",cp:[112.641783,42.742892],childNum:1},geometry:{type:"Polygon",coordinates:["@@UWIDABKI]SoMCAWIaYBGJCEKfC@MDOQK[AI@E@CBQNGFCASMKEoSA@KBGJABEHMRINAZONOLCBDD@iNaFWR]\\W\\UV]l[^yJd¡J@BI@ENOhITCLAD@@K\\WtGTOdIDjCBABEBBFJVJRDHFLDHYPaVEBGDEDGDgJGBmJwN_FcHNOD[FODIBLMBGDGFUNzcXSNudYRGFQLEDA@oRUHcNQF]LD`@PgNmPWH_J_JCB]JgNgLUFCbADKPSVKLKLGLKNQTGJIJAB_d_fEFADINOTAFW`ADEJGJMVMT[jCFKNOZA@CnQZ_nS^CDEJQZINOVMROZGHCHOVILEJGLEHeN]JKFsTKDCBMFMFEBKDEFGFUPCBOLIJMJmpADBFõĆŃF©ħÈqQh@@^NfRvN²^~NhHxLVDDB@@DCPYBADCBAaFAZIZIJC\\GvMvOLABAB@JTBFD@N@B@J@L@BAaNEACCG@AAAAEFANAB@DAbMPEB@dCJAXAhCVCZAPE¤Y\\EFAB@dJ\\H \\@@BAFA{JGLEJGXOhWBAFCPOto^[AKAECWAKAECQCS@AAGKm@ABEDQ@ADKFYBQ@M@CBIDe@CJ§DkBK@QD[@MLDRHZJxTHBà\\fFH@DBB@RDB@hdRjVJVLjXHFBLDLDVH@BB@BDVXr|DDBD\\`KNOT[dU\\KVKTfLZJANCNGjGhGdFTJbDNPBFFNTrL^TtBF^@BBHFT@B@@AhCx@LAL@NAf@@ADO`OZ@DAn@FALCLnM¸iFAJABAJABABA`YBA^UBCB@jCNAnCLAbCL@jED@B@XBT@T@bBX@D@BH@DBN@L@\\@D@FA@@FCB@HA@@HCJAFCJAHC@@FADA@@B@FAB@DAHCFAPEDAB@F@D@XAB@fANApAEN@F@TAD@F@TAhAFAL@XAN@B@zPZHXF@BBAB@R@jC`AE¤E@@JAX@HA@@AEEWAGAGKcACAEEWlIOJAHAHAHAPCXCB@NCRA@@NFNFJ@fDB@D@J@D@HBH@TBP@\\BXBBLBD@TBfFTIB@XKPGJCHCBADALI\\Q\\QPKVMFCDGBA@ABCJOHMP[HOJSFIHMFIHO@ADAFEHEJGb[DCxQD@REKKA@KG@@\\QFCD@TKLGZMJGZM`QFC@A@@AAEICIEGAC @@BABALMBABCJKBARmBGBIBMFa@EFAvO^IJCPNFGDEBADG@@RIfWFEFAFCDC}B@DC@CBA@C@ABCDYBE@@B@HC^EtMSNCDS@ABCMIKKUOCCRkCGEG@A@AGKCGCGCE^OPEFCFABAKIIGCAEE]UTAhANADCJSLQBEFIR_@@BATINCLEDAfONELEZKBADERQNOFIACQWCEGKAAJa@@B@LB°NQGOGGCIC]OkUAA@E@IOCMCICSCGCA@C@GAG@A@CAC@EB@AC@AAC@C@@@gFKBK@C@A@AA@AAAACAA@@A@A@A@A@@@C@@AC@C@AAE@A@IKACCIACKEA@iSGCHG@@DCBABAAAGA]I_I@A_IGAYIUOIOGAA_QAAAAEEIKECGIGGEE]_EEIIGGWSAA_gKDIMACA@HImcYQQMEEIGSMGEUBO@G@_@I@WA[AMAC@ACECGIEEY]C@@AB@@AECACMKEGII@@QOMMAC@@OEcMQGWQOIA@UBE@IIGEEESQNUDEFGCCEECCAAECAACCAAQY@@@ANWLUHI@AgWA@CAIESIiUM@_@G@M@SBuOC@AEG@EAE@KACWEOAC@@@@AA@ACSWAAAA@@BW@C@AACCEEMEIACACA@G@MGaS_QCCOKA@SOKMkqEE"],encodeOffsets:[[115958,43036]]}},{type:"Feature",id:"152522",properties:{name:"阿巴嘎旗",cp:[114.950248,44.022995],childNum:1},geometry:{type:"Polygon",coordinates:["@@]BO@IaCgCKAA@C@QGA@@JSB]B@@C@DA@@@qQEAcMGACU@E@WAÕCBBLPgR]`A@@@mXGFoFQDIFc\\qhxx]VMpzIFS^@IA[Z{vBFFD@@AB@BABA@AB@BAB@@BDABCH@D@BBBD@BB@BAB@B@@C@@@A@A@CAABC@CDCDA@A@CAMECA@@C@EAAAE@G@EBC@CDGDEDEFA@@@A@C@@ACC@ABE@A@@BADA@@@A@@B@@AAA@AC@CCAA@BENCJAFCLCF@BCJCJADmbSPCBGFCBCBuT]LGB@DEP@BEHGHKP@BIJ@BYnAFGR]r@BmZ[RCHMbe^SxADEVIXABCHA@Wb[dGNGFX®SJ£_g^_J}CBIJpo`@HBdUNIfrTd]`Y^£nKFQHeRELKNaxELA@A@@BerÑĆŋèU~IwjAB°ÁbgFVI[PEDe\\Mmê@BB@F@lO^UdC®QhE~\\BB¦z|PL`VZRRLxPLB`F\\FFNFBTFPJ@TJJZLBP@LHD^AvBvPjAXJHZ@v^@@tV~|XR`HPZ@dTÞz`hT\\FNNJJb^tbrd\\`zfhThTnJhN `FB°XPnLRÐg°]¦c`GzGTATDHR@´L´Lb@\\BH@FEDGDCJQJQJORcfHOJQ@@DCRIoHCBA|{RSB@FG^]XYFEFGPQ@@HGBCFC@ABABA@uAe@G@I@QAI@A@@DA@AB@B@BAB@@AAEAA@ABA@AB@B@D@DA@@DA@@FEB@DABA@@DC@ABADC@@@A@@@A@@DA@@B@D@@@B@BCBCDADADA@@D@DAB@BABA@@BE@@DAB@JCDA@@@ABEDADA@@B@B@B@DCB@DAB@B@DDPKZO@@ZQBABADA@EBC@@DCV[VSBCPU@ANMFGDCHC\\I`KJCPEB@DAtQ^IFATGB@oXM^OHCFA\\KZI@@YGaGeGeIme]YieeEIyIUgKSEIMYGMUeeUIYKSGMC@ACEKQ[s@GBG@EC@CCC@AA@CGGBCAC@GCACCDGDA@EGEAEGBEEEBIBGAGEO@KAGEIEEAEEEECAJAJCBAHGZ]RQCIEUI]AGHGFGHG@CHQJ_VqDEFKHMlVedybUVMZQ^QxeXMDGNWCQ@UEy@EAWE{AWA@@@[IcI@@CBA@C@I@@BAB@@A@ABC@KFABCAACEGACA@IA@@GCAACCAA@@BA@@@@@A@ABA@AHABA@ABABE@AB@OQAEMOEGCAGIKOEGKM@AMA{IQQKKUUIIEGIICC@@@@SWksYaVYNO`e@@KSEICE@AKOCE@AAAGMbGLAVCVCJACSAIAGAKAMORA`ArACZAdAUc`Mºyt{X[TUH\\F@hBrDrSJC[JCHGBEFEFGDCÆhYRKXO^QDCFAHEkfW@@@@@@HMFKFUFOFQRGNEPEZK_HIHKLOLQHKJQLMLCPCREREAC@G@IAIAGAI@KCMAG@ACCACAGDE@EAECCECCCBE@ECI@GBI@A@@BG@AJMBA@C@CBQBID[BA@@@A@A@@AE@ADkJIBCBGDK@ADQHWBC@ABGBA@@BADA@A@@HI@ADC@@BK@C@C@ABK@ABAB@BA@@@EBG\\CdOFANKAII[GO_{m@@QKcWGE@@}AA@mA]AO@UAA]AWAYAK@SPABA@@@@BCBQNADQNSP}MAAWEA@qKA@GGE@YCG@A@DS@[BS@]B"],encodeOffsets:[[118417,44156]]}},{type:"Feature",id:"152525",properties:{name:"东乌珠穆沁旗",cp:[116.974494,45.498221],childNum:1},geometry:{type:"Polygon",coordinates:["@@VFV@V@LIVGRWBWKQvVfJHNNJNHV@XBPBPHLHLRHPNPNNLXCPMLrKjGTECHBH_DABEMGGSS]AEABAAEA@EB@AA@EGA@CBA@AC@@A@ADACABCE@ACCEDAE@ECDAAA@CC@CAAB@C@EC@e}ipBzUpAomwNBqKeKGB@C@RoxMMa@A_Q[DBK@K@CNOAA@CBA@CA@AA@CB@ACB@@A@A@A@AB@AAAAAACAAACA@@@MAO@G@K@OAG@I@ELAPCPEZEDA@@BADA@ABABA@EAC@AD@BADAB@B@F@B@@AB@DA@@@A@@@A@AA@ACA@@@AAC@EB@AA@CCA@E@C@A@@@@@@@BC@CA@AEECA@AA@CACC@A@AAAA@AAA@@A@@@A@CAA@A@A@AA@@BA@@AC@A@ABAD@B@B@DDB@B@DADEBA@AAC@ADCBMBC@CAA\\YAKAQAKECIG[SAEIwM©K[EOKGUCGCCCGEFGBCA@@CD@B@H@BAB@DCD@BCDCB@DCB@@C@EE_@CASC]COAWAKACC]AQEgCcAG@AC]EEOOsuOOIGOQQgcBS@CJa@ABCBEMQemCC@@IBIBUDABC@YCY@E@WAkAECwY@@ig\\ieg@@ċû`u[IL©³iqØµA@BABB@AICX]JCB[NEBCB\\QFIDYJCBUHUHGDEFEH@BGHADM@E@oBE@KAm@KGQKGEA@MIAAABGBEDSJA@EBA@A@C@eDWBG@GBA@]CA@E@GIAA@C@AICGY[]DE@qHC@SAM@MAwEk@U@S@@@A@kQUGKEGASGKEIACAKAYE}MIAA@QCGAGACAMEEAEA]KSGaMEAMEA@GCWEIAGAUGGAA@GAIAqMMCGFEDOLWPEDEDEBEB[L[LQFKDIFqX[POHEBA@aRQJcACAA@A@@BA@@@@@AB@@@@@@@BA@AB@@@BC@A@@@@@ABA@@@@D@B@BA@A@@BA@A@CBA@CB@@@@@B@@B@B@DB@@@B@@CB@@EB@@A@A@C@CBA@@@AB@@@@@@BB@@@@AB@B@@@@BBBBB@@@BB@B@BB@BBB@B@@BDBF@B@@@@@@BA@@@AB@@@@CBAB@@A@@@A@@@@@@B@@@B@@A@@@AB@@A@AAA@@B@B@@@@@D@BAB@@@@A@ABA@@BAB@@@@@@@A@@@@@@A@@@ABA@C@A@@@A@A@@B@@@@B@B@@BA@@@AA@@@B@@@@@@A@@@A@@@@@@@A@A@AB@AA@@B@@@BAAA@ADA@@B@@A@@B@@@@@B@@AB@@AB@@@AA@@BA@@@@@A@@AA@@B@@@@CBCB@BABB@@@@@@B@@@@@@@@@B@@CB@@@B@@A@C@@@@@ABA@A@CBCB@@@@@B@@@BABC@@@A@CACAA@@@E@C@@@@@B@@B@@@@A@@@@@AA@@A@@@@BB@@@ABAAA@@@A@@@BB@@@@A@@@A@@@A@A@@@@B@@@@@@@@A@@@A@@BA@@@A@@@ABAB@@@B@@@@ABABCBEB@@@@AAC@ECE@@@A@@AA@@@@@A@@@@@A@BB@@ABA@@@@@@@C@@@@AA@@BA@A@AAA@KAI@UAE@_CABIFAB@@IHGDEFA@A@@@A@A@A@@@ABA@A@@B@@@@A@@@A@@@A@C@E@A@A@@@@@AB@@@BC@@@BB@@A@ADA@@@@@AAA@B@BA@@@A@@AACA@@C@A@A@@@A@C@A@@@@@C@@BA@@@@@A@ABA@@@ABA@E@A@AB@@C@A@@AA@@@AB@@CBA@AAA@ABA@@@BB@@A@@@A@A@C@C@C@AAAAA@A@A@A@A@@@@B@@@@@BA@@@@@@@@B@@C@A@@BA@A@GBG@E@EGAG@A@A@IBIBC@KBMBKBIBCBCCA@AAECECCC@B@B@BA@@@A@@@A@@@A@@@@@A@A@@@@BA@A@A@@@@BC@@@B@@B@@@@A@CAA@@@@B@@@B@B@@ABC@@@AB@@GBMDA@EBKBa@@@GAYEWEMCiMQKMGcWUIC@AAICSGICCAqUEAKCQGEAWI@AC@C@KA_CIAE@A@SOCAGACAE@A@EAC@KEGCCAQEGAMACAAAIQ«sAAECIEOG[OAAOACASACAI@gEGAA@UAkESCMAEAÓ[A@AAA@OIsaIGKGAA@@SAI@MA_AG@A@KAA@mCQAOAG@IAI@GAeIKCA@UEMAMCyMMCiIKACAEAA@G@GAoCC@WCA@iCUACAUAUAGAA@UAC@CAMEEAGAICC@ICGAYIIAYGMEGA_IEAEAGAOCICKCEAMCA@EA@AA@aICA]@KBA@E@OBMBE@E@OBWBU@OBuDKAAAIAGASEMCGAKDEBSDOD}NGBWFMDA@GBIB[HE@GBIDSDABA@@@AAO]AECGEG@AACAACG@@AA@CGKACGOCGACO[E_AKCW@A@ACQC[AI@A]GYGEAOECAC@EAAAEAMCEA@@£BCB{HEBIBÍViFG@SDA@ACS[E@C@qAI@_QmYKECAWKfM^KSOseFQYBqTwÙ©MgE^qXcXi\\QPONKLGHûøUVA@@@YJ[LEBGD]PWNpA@SHEB]JsRCBA@OFID_L[JGDCDEHMN@BOVADUTU\\CD@@AD@FCBABABYR@@YPOLCCA@A@CBA@CDA@A@A@@@CBCBAF@B@@CBIDA@CB@@AF@@ABABA@CBC@@@CBCBCBADADA@@@C@A@@@CB@@@B@@@B@@CDAB@BCD@@ABCBA@EF@@CB@@CBC@A@A@@BAB@BBBBF@BA@ABA@A@@BCB@@@BBJ@R@J@HBf@vABAB@BEDADGH@@OREHEFWZ]^EHA@QT{|ABGDpQJCD@@IRGPeQdIPIRIRCDCHEFN@`@¶C¨AL@´A°X°ZxL|N²V°V¸Rj^h^zzxZTrh¸bVRrZFAN@nOTDZLDf^rfLtXPNLh^tACPGE`VPMHMLS^mZD`H\\DTLX`ŤƘPBdFTTòîvXp\\VpPzBZBBZDLDPJDHJPPFbBTHDFFLR`PHXAfIXExCĲQºM^AB@FĜNXA¦C°CPCFPRxOlb NTHJVZw°dFfBnTbHTCTAL@hDbBTE\\G`T^EXGPIRYDU@SRMpePKnS`@dDXFZLRTbCR@PCTE`DXDXH\\PJHGPGPLTPD\\FLJFFHRXVVJ`FZ@nAN@JNJT®@VDVLRPRNbRn`xJbA^@TB^JXLh`dFfH\\NxbÄO\\AbEraXM^A EÆIXEz\\t^ZBTI`gdCAXEnCrETDDPAJQTALHJLPbXhjVTATDZB`BHKJiCMQQ^QVBZETPPLZFfLXBHOt{RKh_DIBOfBXRTBNLR@VGV@fMf@RHEBMHGZOTEXOVQZCVTMNSbVxb\\R\\C^CbE^EVFVFCPCfU^CTBPDPB"],encodeOffsets:[[122496,47742]]}},{type:"Feature",id:"152528",properties:{name:"镶黄旗",cp:[113.847287,42.232371],childNum:1},geometry:{type:"Polygon",coordinates:["@@NSpmNMVG@@@@@AAC@AMHKIEM@BGJCHCCCAG@IQ__ZY@U|YYEBKC@K[MJOAIAByVG@BB{\\WAClA@@D@D@D@B@BAL@D@FAJ@BADAD@@CLIVENENOBSBOB@@KAYE@OMN@@C@A@MDGBIDCBGBIAM@EBE@A@C@GB@@ABA@MBCBC@@BCHADAFCJC@CAC@A@AA@AA@@@A@G@A@CC@@A@@AA@ABIDMHCBEJITCH@@ORCDEHWZ@@]@OKKIeI[GaICDQRa@CJADGD[HUFA@@@@@CCGSAASNWPQBC@QBc@M@_BA@A@A@DQBDsDBDBDFJFNDFBD@B@DAX@@BBBBTXBDB@@B@@D@PBXFBD@LBF@FHBFD@vPTAN@H@`@N@jVTJJFDBB@hX@BGJKVMX@B@@RZBBDDBBFDBBDDFFDDEHCFMVTRFFHFJJF@VAB@PJXRRHdNPF@@BDNNRP@@JJFHNLBDFD@BA@@BD@Z^FFHJFDBDD@NB\\BXBJ@`@H@P@VAHFTNJHFFRNZRndGJB@BDJNLC`hBBXTHHJJFF^`FFHHHJFDJLFFBBBB`RBBPHPJVZJHB`J@B`J^JHBBBABABCD@@GHHDjTB@LFBDDJBDJLB@F@BBD@D@@BD@@@B@B@B@B@@@BBBDBB@BBBB@D@L@LAhE@@D@D@BBD@@BFAD@DBB@H@HBD@B@HDTDJDNDPD@J@FBBlV^PJDHDPHRHB@@@DGFIP]PYNAR@XARAPAFAJCrWFCRKFEB@FCJGDAFCFEZOHE@@LAJARAbEPALA@@B@D@B@BAFCRMDCDALIVQBABADCFEFEHGHILKBAJKFEbc`]HIHGHGHGDCLKFGBAHGJITWLM@@DAPIHE`QBCFGDEPSNQBA@ADCDCPSJMDEBAAC@AB@DC@A@A@@@AC@@@@EB@@AB@FA@@@A@C@@BAB@B@B@@A@@@@A@@@A@A@A@@@AA@A@C@@BA@@B@B@@@BA@C@@B@B@BA@@@A@@CA@@@AB@@@BA@AB@@@@@B@@BB@@@@@B@@A@@@AA@@A@@B@B@BAB@D@B@@@B@@A@@AAAA@@A@AA@A@A@@B@@@B@@@B@@@@@B@BABABA@@AAAA@@A@A@@@CDA@A@@A@A@A@A@@@ABAB@BA@@@A@@CAA@@@@A@@DADA@A@@@A@@CA@@@A@C@@BABAD@B@@AB@@C@@AAA@@@@@@A@@B@@@B@@E@A@ABA@A@AAA@A@@BA@@B@@@B@@BBA@@@@@@A@AA@@@AB@BABA@ADABABA@A@@@@@A@@B@@@B@@@B@@BB@@BB@BA@@@@@GBA@@BA@@@A@AAA@AB@BAB@F@@@@AA@@A@A@@@@@@BBB@BABA@@A@@AC@@@@A@@B@BA@A@AA@AA@@@ABA@@@@@AC@@A@@@@BA@@BC@@@@@@BCDA@@A@@AAA@@@AB@B@B@BA@@B@@A@@BA@@AA@@@@@@@ABAB@@BB@@A@@@AA@@A@CB@@A@@@A@A@@BC@@B@@A@@@A@A@@BA@AAA@A@@@C@@DA@ABAB@@AB@@@@@B@@ADA@@@A@@AA@@@@B@D@@AB@D@BA@@@A@AKODCLKAUAQAQ@AAS@OAKWE¡WoKXpAFA@CFABCF@BCFEHCHADEHNNFDBBB@JHFD@@ELCH@@WLABYQAAKACA@@KDCBCBIBABMDUFOFGDA@A@ABA@C@ABA@@@A@@B@DA@GBA@A@A@@D@@AB@@A@A@AB@@ABA@ABE@C@@@A@A@CCAAC@AA@@AAAA@A@@@A@AFC@AAAA@@AA@AAA@@@AACC@CAC@@@CA@ACAA@AA@AAAACAAACAA@ACGKCE@AACAACECG@@A@AAOEA@CA@@@A@@@AB@@AA@CAA@AA@@@A@A@AA@@AA@CBE@A@@AAA@@C@E@A@CCAAA@C@AA@AA@A@A@AA@@AA@A@@@AA@A@G@CBA@@@@@AA@@AAA@C@CBA@A@AAA@CAAAA@sQCAABEDA@]GeIFIFGBAFC@AB@FBD@D@F@BA@A@ABA@AHINOxA@@@ADEFUBGPo@E@A@E@A@@@A@GeKCAYSY@o@VChD@@HAX@H@F@@ADKRULABED[PCDIDYLABBI@@BG@@EB@@A@A@A@A@@@AAA@@@A@A@A@@BABAD@BA@@BC@@@A@A@GF@@C@A@AB@@CAC@A@A@A@@@@@AC@@A@AAA@A@CAE@ECCACAA@AAAAAAGCEAC@@@CA@@A@@AACACA@@@AAA@AAAAC@A@@@AAAAA@AAAAA@@ACACCCCGGKKAA@@ACCIAAISCG@@CB@@A@@BAD@BSAA@CBA@A@AACCAAA@EC@B@F@B@BA@A@C@A@CAC@EAAACAAA@@@I@AC@A@MAKAE@I@GAE@Q@GBOFE@CBGBCBMDE@@K@O@GAM@G@GCSAKCQ@@AG@@WQCCCASM@AAACA@@@@T]"],encodeOffsets:[[116813,43038]]}},{type:"Feature",id:"152531",properties:{name:"多伦县",cp:[116.485555,42.203591],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@@A@A@ABABAAAB@ACBAAA@A@@@@@A@A@@@A@@@A@A@@@EB@@A@A@@@A@A@@@@BA@@@A@C@E@CA@@A@@@A@CAC@ECA@CA@@AA@@@@@ACCACEECEA@AAA@E@A@E@ABC@K@IBI@KBMDGBKAC@MEEAIAQAKAM@KDMHGDEFEHEHCHAFGJGLAFCFEHIFKDGBEAGAEAGAEEEGCIEIIGCEKGKEIAEAICEAKCI@G@IDIBI@MAIBGBIHCD@HFBFBHDHFFDHJJLBHBLBJAFIHEBI@MDMFILKFEDIAG@IAIAMCUAA@CBCBIAUGGCECEIAACKEOAKCGEEKEIEUCIAEEIEeOSA@AAC@@CAA@AC@A@@@A@@CAC@@ABCD@BAB@@AB@@ABAAEAA@@C@CAC@AA@@@AAAAA@@@ABC@AAA@@AAA@@A@A@@A@CC@@A@AAC@@@@@@@BCBC@A@A@AB@@A@@@@@AAAAAA@CEAAA@@ABABA@A@A@@@@FA@A@@@A@@@@@AA@@CC@AC@@@@AAA@CA@A@@@@@ABAA@@A@A@A@AAAACCAA@AA@@BA@@@@CAAAA@CAA@A@ABGAI@GBCBCDEFA@CBE@ACAEECGAI@K@KDE@E@KAIAICAA@AGEAAC@AACCAC@A@@BA@@D@@@BA@@@A@@@@CAAC@EA@AE@@@A@@@A@A@@BCBADAJEB@B@@ABABA@@BCHE@@DABCBA@ABA@@@A@@BC@@@A@A@CA@@@A@A@EA@A@@A@AAA@@@@ABADCAASU@@E@CA@@AA@AA@AAKM@@e@FH@Bq@A@EBS@W@@Ka@AB@@@B@@@B@@@@AB@@@@@B@@AB@@A@@B@@@@@BA@AB@@AB@@BB@@@@@BB@@BB@@@@@BB@@@@ABAB@@CDABA@@@@B@@@@@@@@A@@@@BA@@@@A@@@@@@@B@@@@@@@@@@BB@A@@@@@B@@@BA@@@@@@@AB@@@@@@@@@BA@A@AB@@AD@F@J@@@DAD@BCJAD@BABCFGFWTKJADCFEJAH@BAHCDEFGFCDCFEHEHAFAFCHCDCHEJENENIJCJAFADAJ@FJ@@BPjHL@@NXDDDHR`BBJTHPDHTffDDRJDBPJHB\\HND@BFLBFLDDBHDLFTJB@HLBJ@RR@FJDFFV@@TNFB@@@B@@@B@BBB@BBBFDDBBBFDDBfP`NdDLNDFHRT\\NT@@DF@HDTHVABCTAdC`HHhjADBDLLJJHJ@@@BA@@@@@B@@B@@A@@@A@@@@@A@B@@B@@@@@@A@A@@BB@@@B@@@@@@@B@@@@@BA@@@@B@AB@@@@@BB@@@@@@B@@@@A@@@@@@BB@@@@@BA@@@BB@@B@@BA@@@@B@@B@B@@@@@@@BB@@A@@@@B@@@@@A@BB@@@@@@B@@@@@A@@B@@B@BB@@@@@@A@@@@@@@@@ABB@@@BB@@@@@@B@@@@@@A@@@AB@B@B@@@BB@@@@AB@@AA@@A@@B@@@@B@@@B@B@@@BABA@@@D@@@@A@B@@B@@DA@@@@@@B@@@@@B@@@@@@@@B@@@@AB@@@@D@@@AAB@@@@@@AB@@B@@A@@BB@B@@@@@@@@@@@@@@B@@@@B@@@@@@@BA@A@AD@@BA@@@BB@@@@AB@@B@BAB@@@@@B@@@B@@AB@B@@B@@@@B@@A@@B@@@@B@@@@@@BB@@A@@@BB@@@AB@@BB@@@@@@A@@@A@@@@@@@@BBB@@AB@@@@@@@B@@@@@AA@@@@B@@@B@@BB@A@@BB@B@@A@@@@@@@@@A@@BABB@@@@@@DZD^@@B@^EjIxKHAFAPARC\\]DCRUFENQBA@T@B^E\\CHAFAXCZCPCVADB@B@@@BB@B@B@BB@@@B@@A@B@@BB@@@B@B@B@@@@B@@CB@@@B@@@@@BB@BAB@@BB@@BC@@@BB@@BBA@@B@@B@B@BA@@B@BB@BC@@BAB@@BBB@@B@@@@@@@@A@A@ABA@@BBBA@A@@@@@@B@@D@@@BA@@BB@B@@@@AB@@@@BB@B@@A@@@@B@@BB@@BB@AB@@@B@BA@@@@A@@A@@@@B@@@B@@@DB@@@@@BA@@@@BD@BB@@BB@@D@@B@@D@@@@@AAB@B@@@B@@@B@@BB@@@@@@B@@D@@@@BBB@B@@B@@@@B@@@@B@@AB@@BDB@@@@@@A@@B@@@@@@BB@@B@@B@@C@@@@DABA@@@@B@@@@@BBB@@@@AB@@B@@BA@@B@B@B@@BB@B@@BBBD@@B@@B@@AB@@@BA@@BBBB@@B@@@B@@@B@@B@@@B@@@@@@@B@@@B@@@@@BB@B@B@@@@@BBB@B@B@@BB@@B@BB@B@@@@@BB@BB@@B@A@B@B@@@B@@@@B@@B@@@@@B@BB@@@@@B@BB@@AB@BBB@B@@BB@B@@BBBABB@@@@B@@B@@B@DABBBAB@@@@@BB@B@@BA@@B@BB@@BB@@@B@@@@@AB@BB@@B@@@@B@@@B@@B@@B@@@@BA@@@B@@B@@@BBBA@@B@@BB@@@BA@@B@B@@A@BBB@@D@@AB@@BB@B@@B@A@@BA@@@@BB@@B@@@BBB@@@@AB@BA@AB@@@D@@@@A@@@@@AB@@@@@@@@A@@@AB@@@B@B@@A@@@@@AB@BB@ABABAB@B@BABA@A@A@A@@B@@A@A@@@@@A@@BBB@@A@@@@B@@@@@@@BAB@@@B@@A@@B@@AB@@@BB@@B@@@BB@@@@B@@B@B@@B@BB@@@@@@@A@@B@@@@@BA@@@@B@B@BA@@B@@AB@@@@@@C@@@A@@B@@AD@DDBFFBB@@@B@@@@AB@A@BA@@@@@AB@BA@AB@@@B@@@@@B@@@B@@@@A@@@AB@B@@@B@@@@A@@@@B@B@@@@@@@@@B@@@B@@@B@B@@A@@B@BAB@@A@A@@@B@@B@@@@A@A@@@@BCDDBB@DBDBB@DBB@DBDBD@FB@@FB@@BDBD@@BDBBBB@B@@@BBB@@@BB@@B@@@@@BB@@@@B@FBB@BDJHD@D@B@X@B@@BBJPZhDC ^@YDaBQiN\\CLrbAFTAVCtM\\ADAD@jChBTQB@d_QUlANYRPxMOaBMAAAACC@@ACBAHC@AAA@C@CBADEBABA@EBAA@CAEAACA@@CBA@ABABABADA@CBA@ADCB@BABABC@ABE@AJABA@C@ADCB@LKDCDC@ABA@ABC@CCC@ECA@CBC@ECECAACAA@CCCAACAAAAA@@AA@ABA@ABEACAAAC@AAE@@AABABC@A@AD@DCBAFA@@QWGI@A@@AEAO@E@@BEH_BAFM@@BSBUBG@IJSBIDIBCJU@GBIAKBEBIBCFIDK@K@I@CAK@GAKCICCCCEAGAK@KFK@GAIBEBQDO@O@YBO@K@M@I@QHOBICA@JKPU`gNQB@H@L@J@TBBADKL[BAFEBIBC@AHCHAJAHGFKH]FGAKBQAIEI@AAGEMCGCMAOAODG@OKQEGEEGAIAKAGBKHEBG@I@CCIECMCIEGGEMEKAI@QDKDEDIFCDKDGCEAICEAEAGCCA@GGGCCAC@@EMAAAEACACCG@@ABABGAC@O@AACBAAI@C@MA@A@CBC@A@@@@"],encodeOffsets:[[119496,42951]]}},{type:"Feature",id:"152527",properties:{name:"太仆寺旗",cp:[115.282986,41.877135],childNum:1},geometry:{type:"Polygon",coordinates:["@@O@CEIKKIIEMEIEMCE@MDEBIFC@E@GA@@ECCCACAGBI@GAGCCCAKCEAIBGBSDM@GCEGCCAAGEMGEAIEAEEK@CCQKGGC[DKCEACAIEIIUI[CGAO@e]MMOIOISGECWCOAGCOCOIECGCOEECOGKEIGSIIEOCCAIAMAKCGAGCGAE@GAKAMEIAI@EAA@UBIAKCECGECCA@EGACGIDATOB@JOhEHE@@@@@AF@AA@CAE@AECAAAA@@@A@AA@@A@@@E@CAAACACEEAECC@AAAB@BCDEDABCDCDEFGDABAFGPKJG@@@@@A@@EACCGCA@CAA@@@EAG@K@G@KAA@A@A@A@C@E@C@I@C@A@A@C@GAE@CAA@@BA@ABADA@EDCDMXEPCFIFI@MEECIIAAAC@A@@@CBE@A@A@@@ABC@A@A@AAA@@@ABA@A@A@AAECCAA@A@@@AEECA@AA@GAIAAAC@IAMAABGAE@GACAA@KAA@C@IA@@@D@B@B@BBD@D@DAB@D@@@BBD@B@B@B@@@BEFCDCDEFA@GDKBMBS@A@]JKFCBEBA@I@C@A@Q@GCA@IBABABEBABMHCBA@C@A@A@I@EA@A@OC@A@E@C@ACCCEEQGIAG@GDIDCBEBGFIDKDEBQAG@GAMAK@QAG@I@]BG@OBK@G@GAE@KCOCQ@IAG@KAGCCCIIIIEACAE@MAGBCFAD@D@FBFHHFBFDFFDFDFBDDDDBFBHFDFBDDFB@@JBHBJBH@H@J@BDR@BBJBV@NAF@B@FAJAJCRCJAL@JDHFL@H@D@HCLAHADEHELGFEFOTAJC@AB@@BBB@BBB@@B@@G@C@CB@@AB@@@BBBDDAD@B@B@DPA@@B@@B@@B@TEXLPB@@B@BBDBDDBB@BB@FBB@F@BF@BBD@@BDBB@BBBB@BBBBFFBBFDBB@@BB@BCFABEJAFCFCDCHEJCH@LDR@DCF@@@BBD@B@@B@B@B@@B@B@D@@@D@BAB@BABABIDA@@BA@@B@F@B@@@BB@B@BAB@B@B@@@@B@BA@@@@BC@@@@@@BABA@@@@@@D@@@@D@D@@@@@@B@@AD@BAD@@AD@@@BDBB@@@@BA@ABA@A@A@@@BB@@H@F@JAFBD@AB@BEFHD@@ABCB@@A@C@A@AB@@@@ABBBB@@@@B@@@@@B@BA@@@CBA@A@AB@BCDABA@AB@D@@@BBB@BABABAD@B@B@B@@@@B@@@@BB@B@B@AD@B@B@D@BBB@BAB@DAB@B@DA@@DADCL@DCH@@@@@B@BCFABABADAB@BAB@@AB@@AB@AA@A@AAA@A@A@@@@@@A@A@@AAA@CBAB@BA@@B@D@@A@AB@@ABAD@@@@CAA@A@C@A@A@@@ABA@@@@DH@B@H@FB@@@B@D@B@BB@@BB@BBA@@@CDA@@@@@@BB@@@@@AB@@@@AD@BA@@@CB@@@@@@BB@@@B@@@@CA@@@B@@@B@B@@AB@AAA@A@@C@A@ABCB@BABAH@B@@E@@@@BDB@B@@@BAB@@E@A@E@A@A@@B@@@B@DB@A@BB@B@BAD@B@BBB@@AFABDH@@@BBB@@@B@B@@AB@BCB@B@B@B@F@B@B@B@BAD@B@@BBD@BB@@@B@@@D@@ABA@@@@@@B@@@@@B@@BBAB@@@@@@\\JPDB@HETIJKXDTDXDBKB@BGZ@DBRFFBTBNB@@@@FBH@D@dDVBDL@NDBHDB@TK@@@A@MjD^BFL@@B@F@B@L@P@LMD@`JHEB@ROPMJCLPTNLF@@DBP@@@V@|OHQD@JCP@PJPC@@ZCXN@BJDBBf@TBP@REPGNF\\NDBVHJBBBFFPN@NTR\\B@NNBDLN@@LNH@LBFB@@PUdEX@POWkRmXK\\YBABB@A@@@@DH@DDJBFDFAB@@AD@B@BDJBBHPBD@BB@N@dBJ@B@\\DBB@E@G@AB@JEbS@ABB@AIYRQB@GXL@BB@BBTJLFBBDHBHB@bF@@@@U^RrZJDF@L@D@LAF@F@@AB@FEFCDCECAAA@BADEFGDAJG@A@@BE@A@AIGCCAA@@AK@EDIBCBC@CBAAA@@CIIaGU@CCGB@ACUOAA]M\\al{Bc@@Me@@@@ACCIEMAI@E"],encodeOffsets:[[118583,42918]]}},{type:"Feature",id:"152530",properties:{name:"正蓝旗",cp:[115.99247,42.241638],childNum:1},geometry:{type:"Polygon",coordinates:["@@GKOi@AI@@BGDIAC@KAI@EAGAQIMIKAAAMAGAK@EDKFADABIFE@GAC@CCKEA@GGI_OA@FBJFNDJBD@@@@Nf@@Adk|[b^NBBVPBDA@DH@DHVJbDJ@@BBAB@DADADCJ@FBL@@BBDDJH@B@BAF@@@BIHCBEHCFABB@BBFDCDEDEFA@@BE@E@KBC@K@E@ICqY]QV@@@@aEA@AGCGAAKESIAAA@@AWKHA@QRJZ@BAA@BaTIFA@@B@H@FAA[CA@I@cAM@A@@AACGOAACI@A@ABC@@BACEAECI@CCG@@@@@BAAAB[ZWLQnXlOPW@cFOVTTDBFHB@@@@D@D@H@B@F@DBD@B@D@@@D@F@FDBJJPRJHJLFFHH@@DDFD`FB@HBHBrLFDBBBDFH@DBDHLBDJP@BDDFFDDTT@B@B@D@JAL@H@B@B@L@DSLEDCBGFA@LHJHFDBBJPBBBDBBFJBDBHBB@H@JAF@BA@@@CBC@CB@B@B@BB@@N@F@F@JEBEBGBIDMFWHC@CBA@EBoHCBC@CBABgPEBaPMFMFWJEDIDCBIFGFMJQLABKHGFIHABA@@FDFFJFDJFLFDDFBAFAH@BADG^@DCNAFBFBFBFBJDHCDINKLDFBDPVCBIFKHWNGDGFCBE@QAUAM@C@GFGDIFOHAB@@GBK@@@ADIHADEFIJCDQVEJEPBNLLHHLLLJJJFFHHFF@@AR@JAJ@B@FAD@J@@JLJNDDILKLGHADAH@D@BABIRGNEBcFKDA@BFAX@L@BADKZCH@B@DAD@D@DCVADB@fbBBNNFDB@FJHJBD@@DBHLHJBFPT@BCBADILQTEFMRSA_AI@QAOAA@I@G@KBeHIB¯\\C@UAuGcEI@UAM@IAKDODcJaHULSJDBDDB@BDJTDDLV@@BBDBJDHBjPJDPHXJjR`NBB@BDFBFJ@F@VBbBDBNH^PÖDXBF@V@DHBdNFBrR@@B@CD@@@^ATAI@@HBRD@B@LBhDbDJP@^A^AT@\\AT@CB@H@ZDF@HHB@rLB@XFBB~NTORMBCRMDA@A@@B@BATOL@ZBXB^BBVBP@^BnBB@~B@@HFdXRL@@BAHC_h]dA~ABA^ADBLAF@fKbIDAHCFADAdOJCDADAFAQGEAAACA@KACLBJDjNAK@A@@AGH@b@DELKJALARALAH@HGKHBVDFBhFX\\RIGUL[EMCE@@@@B@LEnQDALCFANEXILC`IbUVOZMNIdWPGXK@@BEHgJi@IAO@[@KAE@IA]@A@@G]AE@MH}HeYS@AACQi@@@@CIEMAEACCEEIAEMSAC@@ACCCQ[AEAWAA@@ACAEK]ymJSBCYgIOAA@@@A@W@A@CGCCI@AAA@E@A@@A@@A@@@@@AA@@A@@AA@A@@@AAAAAAC@@ACAC@@EA@@EAC@CACAA@CAA@CACAA@CADC@A@@B@B@@@@@@AA@@@B@B@@@BA@A@AB@@@@A@A@@@A@@@A@@@@@@@@@A@A@@B@@@@@@A@@@ABA@@B@@@@@@A@@@A@@@@@A@@BAB@@ABA@@@@B@@A@BBA@@@@@A@@AAEECA@CBC@@@AB@@@D@@@@@@@BA@@@AB@@A@A@A@@B@@A@@@@@AB@@@@@@@A@@A@AA@A@@@@A@@A@@A@@@AA@@A@@BA@@@AB@@@@A@@BA@A@@@@@@@A@@B@@@AA@AB@@@@@B@B@@@@AB@B@B@B@BA@A@ABABABAA@@ABA@@@@B@@@@A@A@@BA@@B@@@@@@@@@BA@@@@B@@@@@@C@@BAB@@ABA@@@@AA@A@@@AA@@A@@B@@AB@A@@@@AAA@@BA@@@CA@AAB@@@@A@AB@@A@@AA@@@AB@AA@A@@@AA@@@B@@A@@A@@@@AA@@@A@@@@@@AA@@ABA@@@@A@@@A@@AA@@A@AB@@AA@A@@A@@@@BAAABA@C@AA@@@@A@@A@BAAA@AA@A@@AA@A@AAA@@BA@@A@A@@@@AAA@@@@@A@@@@A@@A@@@A@A@B@A@@@AAA@@A@@@@@AAAA@@@AA@@@A@AAA@A@@@@@A@AAA@@@@A@@@A@@@@@@@A@@@A@@@@A@@@A@@@AA@AA@AB@@A@@BA@@@AA@@@ACAA@@@AAA@@@A@A@AB@@AA@@@BA@@@@AA@A@@@@@A@@B@BA@C@@D@@@@AA@@@AA@@@@@@@AB@@@@@@@CA@AA@@BA@@@@@@A@@A@@@@AAA@A@@C@@@@A@@@@A@@AA@@@A@@@A@A@BB@@@@C@@@@AC@@@AA@@AAC@@A@@B@@A@@@@CA@@A@@@A@@@@@@BB@@@@@ABA@@@A@@BAA@@AA@@@A@@B@@@@AAA@@@@BA@@@@@AAA@@AB@@C@@@@A@@@@B@B@AA@AB@BAB@B@@@@@@@@@@AA@AA@@BA@AD@@AAAA@@@ABA@A@@@@AB@AA@@AA@@D@@AA@@AA@ABA@@A@@@@@A@@DA@@@A@@A@A@A@@@A@@AA@B@@@@A@@AAA@A@A@@A@@@ACAUBODYDWDEBGB[D]F@A@SABMREFQVCD[^QDOBEBGBwLiJ]FA@@@C]CY@@@@@@AAAB@@@B@@@@@@@@@BA@A@@AB@A@@AA@@@A@@@@@BB@@@@A@@@@@@@A@@BA@AA@@@@@@@@@B@@@B@@@@A@@AA@@B@@AA@@B@@@AA@@@@@@@A@@A@@@@BA@@@@@@AA@A@@BA@@@A@@@@@A@ABA@@@BA@@@@AA@@B@@AC@@B@BAB@@@@@@A@@@@@@A@@@@@@@@@@@@A@A@@AB@@@@AA@@B@@@@A@BB@@C@@@@@BA@@@@@A@@@@@@A@@@@@A@@@@@@@CB@@@AA@B@@@@@@C@@ABAB@@A@A@@@A@@@@@@AB@@@BB@@BA@@@@AA@@A@A@A@@B@@@B@@@@A@@@@@@@AA@@A@BA@@@@@@@@B@@@@@@@AAA@@@@AB@@@@@A@@@@@@@AAB@@@@@A@@@@@@BA@@A@@@@@@@A@AA@@@@@AB@@@AA@@A@@AB@@@@A@@A@@@@B@@@@@@A@@@@A@@A@@@@BAA@@@@@AB@@@@A@@@@@@@A@@@A@@AB@B@@@@@@@@AA@B@@@@@B@@@B@@@@AA@@@@@B@@A@@GIIIKKACBCgiGGD_BcDSBAGUCS@GCE@@MSS[GQCEKMcC_MeOCAECAACAECAA@AAA@A@A@@@A@@EASM@@EUCEEIQ@@QAIGKA@SIKEGCCAKCAEEK@AMC[GGAOICAQICCeSeCGGOISAAQ_CGCCMW@@"],encodeOffsets:[[118720,42977]]}},{type:"Feature",id:"152529",properties:{name:"正镶白旗",cp:[115.029848,42.28747],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@BAJGHELGBARKNIHEJEDAJCFCXINENEbOFAhOBADAD@DApGFAB@DAD@XGNEJCHAFAFA@I@E@E@MA@@A@A@ADAD@DA@@B@@ABE@I@GAAAGACEIAAACAAIOAAECIGKGB@HEDAFCTK@C@K@A@A@GBK@I@C@A@ASSCCEECC@AIOACGKAC@CEGACAAECqKGAGAA@_EECCC@@GGEEIKIGOQIICA@E@E@C@@@C@AAC@C@E@A@G@C@C@@A@EGCASS@@EAKAG@KM@@KMACMMA@Q[S@MOMEEAAIAUGCA[MMEOHQFO@SAe@AAIC@AWMYD@@ODOIO@IDC@GR{PU@@@O@CA@@KESMKOIDONQPA@GF_IC@KNO@K@A@E@A@@@EK]AiC@N@B@@SLA@GCCA@MCKUAcCC@G@EA@@@@MASAEAQECAY@AHA@ALWCSCWCILSJGFA@OC[IA@@@@BCD@@@@BB@@C@@@A@@B@B@@BBA@A@C@CBA@@B@BBB@@@@C@@AA@AB@FA@AB@@BDBBAB@@A@@@A@A@A@AB@B@@@BCBCD@@@B@BABEBEDEFCF@@@BA@A@A@@BF@@BB@@@@B@BB@BD@B@@@BGBG@[BCTAJ@FAD@D@DC@DFYV@BF@LBD@FBAFB@B@@BBB@BB@DHIDGB@@AHABGAA@G@CBC@@@@@DBB@@B@@AB@@KBEBGFC@C@CBG@GBEB@@@@BL@PBT@BBRBRBVKLCDLP@B@B@@ABC@A@@BC@A@@@@@BB@@@B@@CB@BA@@@@@A@@BA@AB@BCB@@@D@@@BBB@BAB@@@B@B@@@BA@@@AD@@@B@B@@@BA@@D@BB@@B@@@BA@@AA@AB@B@@@@@@BB@@AB@@@BA@@@ABA@A@A@@B@@BB@BB@@@CBAD@@@@@@AD@@AB@@@@@BD@@B@@@@AB@B@@BBB@@B@BABA@@@@B@@D@@BB@@@ABABA@AA@@@@@@@B@BB@@B@@E@A@ABA@@BBB@B@B@@AB@@AB@H@@@@ABA@@AA@@AA@@@A@@@A@@@@B@@@@@BABABCB@BABABA@@B@@BBB@@@@@@@AB@AA@@@A@@@AB@@@BBB@B@BAB@B@B@FA@@@A@@@@B@@@@B@BB@@@DA@@BA@C@ABAB@@@D@B@@DB@@@B@@@BCBCB@@@B@@B@DB@@@B@@ABA@AB@B@@@B@B@B@BB@B@DC@@B@B@@@BBBB@@ABABABA@@@@@A@@@A@@@A@@@@B@BBBB@@@BBBB@@@BA@@@A@C@A@ABA@A@@@@BB@@B@@@BA@@@@@A@@AA@@@@@A@@BAB@@A@@B@@DB@@@B@@ABA@A@@@@DAB@@A@A@@@AB@@@D@BBB@@B@B@B@@@B@@@@@@BA@A@A@AB@@@D@B@@EBA@@BA@@F@@D@@B@@@B@BCDA@@BBDABCFINOTCDCD@BABMROTCFEHAD_RGFOJCB@@KNSXIJGHABEHKLCDGHGHGHGJ_^adEFILABKLGJGHEFEFCDABABURKJCBCDQNEDABA@C@A@@@KBOBaFQBIBKB@@GFYPEFEDCBIHEDA@EFQLEDqXIDEBOBQBWBQ@MBOZO^EJCH@@pFD@B@RBÚPHDlXlZx`bJHDFB`JFBDBLDVF@BD@JF@@B@LH`PDDD@H@F@nDJBLD@JBbJJDLDLBlL^FRDPDZFD@PDZDD@HBP@\\BF@fdFFDBBBDDFDDDLLTPFFZZ@@B@PPdHJBZLRHNF`PJJFHHHDDD@VFTFHBFBJDlLDBHB`HJDFBNDD@B@VHD@BBB@@@KUCCISACA@CCCATIVKbGdIPCLCJBN@VBJ@dFvHVBD@°[JAfGLAH@J@B@PBRBJ@`BTBNQFERSJKBCDA@AOSAEGIGKCA@@ACGIEIA@ECMMAAeaA@BCDU@C@CBC@C@ADGLYBC@A@KBWAEB@LCdEFAHMJQBA@A@CBGBCHGLKJKCCIMIK@@@IBC@E@ABI@IBQ@@EEGGEEIIKIKKGGKKAMFOFIRUDCJIFEBCJGBC@@L@HA@@BAPGJEHCHED@N@VBRBF@DAHEHCXMLGJEDAOUACCELKJMDCCGAIAEAEAEBEDM@CH]BC@ABGBEEACCKEIEECEICE@E"],encodeOffsets:[[118180,43524]]}},{type:"Feature",id:"152526",properties:{name:"西乌珠穆沁旗",cp:[117.608911,44.587882],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@BA@AAA@@@C@@@@A@A@A@A@CC@@E@A@AA@@AA@AA@@C@AA@A@ABC@A@@@AA@@A@@C@@@AA@CAA@@@@@@A@C@AB@@A@@A@AA@@@A@@AAA@AAAAA@AA@@@CB@@A@@CCA@@@@ABC@A@C@@@@AC@@GBEBACKEGEGFEAACCCEACA@CGQSACKCGG@GCG@EGDCGGJCAIGCEA@AeDGBGBEBCAEAGACAEACCCC@AEBCAG@CDE@CCGAADKDK@UAGFCDKFKDE@CAK@G@IBC@IPQNGAA@@B@B@BB@BBDDD@@@B@D@@BB@@BB@@BAD@DBB@BBBBBB@@@BBDBDB@@@@BF@BBDFN@@BBBBDBBB@@@B@B@D@B@B@B@B@@@B@@HBB@BB@BBBB@DBDBB@@BB@@B@@BD@@BB@@A@@BA@A@@@A@@F@@@@ABA@@@@BAB@BA@@@CBA@C@C@A@A@@BABA@CDABCFGFGFCBGDAASEKC@@AB@@@BABA@@BCB@@@@@DA@@BA@@@CB@@A@ABAB@@@B@@@@DBBB@@ThIHABIH@@C@OFAB@@A@ABCB@@AD@B@@BB@B@BA@AB@@AA@@AB@@E@@@A@@@@BAB@@@@A@@B@@@@C@A@@BA@CB@@A@A@C@CBA@@@@@AB@@A@A@C@@@@@A@@@A@A@A@A@@@ABA@ABA@ABABA@A@@BA@@@A@BB@@@@CBA@A@@@AB@@A@AA@@AB@@C@CBABA@A@A@@@CB@B@@B@DBBBBB@@@@@B@B@BABB@@@@BA@@BA@AB@B@@@@CB@@@@A@@@AD@BAB@B@@A@AB@BA@@B@B@B@@ABABABABABA@@BC@@@ABA@CFABCDED@@CBAB@@A@@BA@AAK@G@EAE@YHUB[@QFKD@@A@I@E@_AcAQAQ@G@IAQ@CYAQAA@mAgAeAEAC@EG@G@A@YQKG]SKGAAA@QESEA@@EGEECEOOIICE[]CCGGIKIKIKQSAAAAGIGGAAIKCCEEOQIKEEOOAA}mA@cSBGFUDO@CHW@A@@@@OGaMÉYCDUT¥Y^QZgtal[dKRcJYAEiY¡ZC@KBFD^@DBT@b[OPsrmj@Bxjz¤xRd@BltBBDRfhprFBFHFDTJBB@LFLHFAJFZDHKTANPDELBLENJLADGNOJQEOBUJCFGH@B@@A@EFEHGFCJGDGHALIJ@NCHMNMDEH[ZQAUJSVMVFL@@FBNDFBBBFBD@DBPFFBZH^H@BBJD\\DR@B@BDXBLF`P\\BDDHHPBDHL@DBB@@DHBBBD@BFHDHBFP^BB@@B@BATCJCHAF@\\GJAHAB@NCXEHA~MPCTCFALCHBNDTFHBJBBBLBvCPAV@XAPAF@F@NAPAF@B@LA^@DBbJB@@BFBB@NDFBLDJDPDHBFBFB`JHBNFZHJBZJHBJDD@JDHBFBNFDBD@VBB@HBVBVBDBVBjDB@XDD@pDHBH@B@FBDBLBjJNDzNNDNBVFB@LDfJHBJ@JBH@PBRBnDB@LBB@H@`BNBJ@TB@@BBLHJHtbPJB@BBB@Ô\\FBNBTDlFVBB@HBhFJ@DBTBDBPBBB\\PPHJFFDBBt¬JRBBDBNBHBRFDBHDLFD@FBB@F@DBHBDBTPB@F@JB`DLBD@D@@BXJFBRHLDFBrVDBJDTHJDBBD@VJdXNHRLjNNDXFZFHB@@b@LAFAB@NCHA@@BA@@D@BA@@@A@A@@@A@@B@DBB@@@@@@AA@@@D@@A@@B@B@B@@A@@B@B@@@@@B@@@B@@@B@@@B@@A@A@ADDFDFDBBB@DDDAJALANALAD@JAJAB@B@H@HBFF@H@HAB@B@@AB@D@@@@A@@@@@@B@@A@@@@@A@@B@B@B@B@B@BBBBD@D@D@B@B@@@B@@@AA@@B@BAB@BBB@DA@@BA@@B@@BB@D@@@BAB@F@B@BA@@B@BAB@@@@@B@@AD@@@@@B@D@B@@@B@B@D@@@DBBB@@@B@@ABA@B@BB@@@@B@BCB@@@AA@@D@@A@@BA@@@@B@B@F@D@B@@@B@@@B@@@@@@AB@B@BA@@B@B@B@@@B@B@FEHCJG@@BAJEBA`DF@VBJ@LBB@BBB@B@@AB@@B@@D@@@@@@@B@BA@@AAB@@@@@B@@@@@B@@BB@@@F@FDD@BB@@@@FADABABA@@@@@A@@BABA@@B@@@B@@AB@@@B@@@@@@@@@@A@@B@B@@@B@@@B@@@@@AA@@B@@@B@BBBA@@A@@A@@B@@@BB@@@@B@@@@@@AA@@@@@D@F@@@B@DBDBB@@@D@BA@A@@@A@@@@DADAB@B@BA@@@@D@B@@@@A@@DA@@@A@@@@@@@@@A@@@@A@BA@ADADA@@@@@AB@@BB@@@@@B@@AB@@B@@BA@@BA@@@A@@@@@AB@@@@AB@BCB@BB@A@@@AB@@BBAB@B@@@@@@@B@@@B@@@@@@@@A@@BB@@B@@AA@A@@@@@@AB@B@@@B@D@B@BA@@B@@@@@@@@B@@@@@@BA@AB@BAB@@@@@BA@A@C@@@@@A@AB@BBB@@@BA@@B@@@@A@@@A@@@@B@@@B@@@BADA@@@@BA@@B@@A@@@@A@E@CA@AA@A@AAA@@A@AAA@@A@AAAA@@@@@ABA@@@@AA@@@@@@BA@@B@DAD@B@B@@@FA@@DA@@@A@@CAA@A@@@@A@@@@DAB@DAB@B@@AB@B@@A@A@C@@B@BA@@@@B@D@@A@@BAB@@A@@@@@@BA@@@@B@@AB@B@DBdBRIbQB@FAPG\\OrWJELCRE\\K\\KFAFAFCFCXOPKFCHENDrNJBHBB@HBVHHBJBXFHDB@NFFBbNTH^LFBFBNFDBHBHBRDB@JB~NZFLBDBJBLFTHHBLFVHlRB@@@T@V@l@xFNBN@TBD@rGF@^CZ\\DHBJD@B@JBHF@B@^DB@HAH@XAfCD@B@B@FAB@TIFCHABABBNJB@HFRLLHn@LBF@pAF@N@BCHG@AFGFEHCVGVGDAZIJCRE[DAFA\\MDA^IWNCDABAA@@AA@ACA@CAAAA@A@A@@@@ABCDGBG@AAE@@@CACAAAAA@C@A@AAA@@@AAACAA@AAE@C@EAE@@AC@AAABC@AB@FA@@@@@A@B@EPKLCHAFBRINAPILEDIDG@@KCECJONK@GBC@ODIFGBEGCBGDCGGGGIGEEEIOCEEBEEEQDGFKBEFG@Q@MFI@E@@LGBDHEJBJAJBF@FCFIJIFC@DAA@A@ABABC@AAA@A@CDC@AB@@ABA@A@A@ABA@A@CBA@A@CBEBEBA@A@ABA@GBCBIBEBA@ABA@CDABCBCBAB@B@BAB@@A@@@ECA@AAC@E@AA@@@AAC@@CACC@AAA@A@@A@A@AA@@@A@ACACA@AA@AAA@C@@AA@BAAAAGCACAA@KOSUCEGECO@CCQ@AB@@A@ABE@AFCJEFCHEBAFC@ABABABAB@@ADE@ABADABA@@AC@@@A@@LBD@BBB@B@B@BABBDD@@B@@AB@D@D@@@DAD@D@B@FCFAHCEAC@A@A@EC@@AAC@ECECA@CA@@E@@A@AAA@@BAFAHCBAHAH@B@D@BBB@DB@@@@B@BCB@BADCB@BAB@@@B@@A@ABA@@BA@@@@A@@@AAA@ACCC@@@A@A@@A@CAA@@A@AB@@C@CBABA@@@A@@C@CAGAEACACAA@A@ABC@ADABC@CB@@GBCDEDCBE@CBOHEDCBA@AACAAAAAAA@A@A@AAAA@A@A@AAAC@A@A@@@AB@@ABA@AAA@ACAAACAA@A@@AD@BABADCDA@ABAAAB@@ABA@AA@@A@@B@@@D@BBH@BAD@@@@A@C@A@@BA@AA@@A@@B@BA@@BABA@A@@AAAA@A@A@@AAAAA@@@@@B@F@B@@@BA@@@A@ABADABABCDABC@@BADAD@B@B@DDBBB@@BB@@@DCDEBAB@FA@@B@@AB@@A@@@AB@BAD@FBB@B@@@B@BA@ABABA@CBAFADABAFA@@@@@@@AA@@C@A@A@AAA@AAC@@AA@A@ABABCBC@A@@@AA@@AAAC@@A@@ACBAC@C@C@C@C@CBC@CBEBGDCBC@CBC@I@EBEBA@CBABA@ABE@E@K@A@A@A@C@A@A@A@@@A@ABC@A@A@ABA@AB@@A@@A@BA@A@@@@BA@C@@@AAA@@AC@A@@@A@@@CAC@@@AAA@AA@@@@A@@@CAA@A@@@A@A@@@A@A@@@@@A@C@AB@@AAG@E@ABA@A@A@@@@BAB@B@BA@CD@@A@A@@@@@ABE@A@A@@@A@@B@@A@A@@@AB@@@@A@@B@BA@@@A@@B@@A@@BCD@@@@A@@@@@A@A@@B@@@B@@@@@@@B@@@@CCA@AAAACCAA@A@@@C@AAA@@AAA@@A@@BCDA@A@AAAB@@A@A@@BABAD@@@BA@A@@@@@A@C@A@A@AAA@@AAA@AA@A@AAAAC@AB@@C@ABA@A@AAA@@CA@K@C@AAACA@A@ABAA@@AA@@AA@AC@ABA@ABA@APGDA@@BA@AAAAC@A@@BAD@BAB@@@B@BAJCBABA@AB@@AB@BBD@BA@@@A@ABAH@B@BABBB@B@@AB@@ABABABA@@@AB@@@FABCBA@@B@BAB@@AB@@@@@B@FBBB@@DABABA@@@@BAB@B@B@@@D@@@B@@EB@BA@@B@B@BB@BD@DAFCBA@@BCAABA@C@ACC@@@A@A@@DA@A@@AAAC@A@@@A@@BABABA@@BA@CB@DED@BABAA@BC@@DEBADCBA@@BA@@BABA@A@EB@@@BADAHADAJA@@CICGIOa@EAI@WBKBGBC@BE@ABADC@@@A@@@E@@AAC@E@AAA@BA@@@AA@GC@@@@B@NAH@FBF@F@HCFAHAB@@@@E@AAEA@@A@ABAA@@AC@C@CAAAABE@A@CBA@A@AA@@CA@@OBA@MEA@AAG@CAG@C@A@C@A@CBAACAC@GCAAAAAAA@CBEAC@@@AA@@BA@IA@CACAE@A@C@CDA@C@A@CAEAA@ABA@A@EAK@E@ABCBCBE@GBM@OBEAKAABcLCBCDABAB@B@BA@GDCB@BAB@@AAAAA@G@C@AAGDE@CAA@CA@AC@AAAC@@AA@@CCG@CBA@CACAAAAC@ABAAE@ABA@AA@ACCE@ABCB@BBDBB@D@F@DAD@B@B@BCBAD@BAB@FA@@@CBAFCDA@@HAD@BABADBB@BABABCBCDABA@AA@AC@@@CBEAE@ABCB@DADBD@B@B@DAB@@A@AB@D@B@@CB@BA@AB@AA@EBCFCHCB@BA@C@A@ACAAA@AAABC@CACB@DC@@@CCAAAAA@ECACA@A@A@ACAAA@@@A@@A@BAAA@A@@DC@A@@@@CAAA@CCC@A@CACDA@A@@@CCCACACAA@A@C@A@A@AAC@ABCA@AAC@AAA@@ABAA@AAAA@@@ADC@ABC@ADABA@@DCEG@@@EAA@ACAAAEAA@AAAAB@@AA@@AA@ACAAA@C@EAEAE@AAAAAAA@AAC@A@@ACC@@AAA@@A@@E@C@AACAGCAAA@ECA@@@IAECC@ICA@AACCAGACC@A@AAA@CBC@QAAAECQCE@@@@A@C@C@AECA@AAAAA@C@EAA@CBGJABA@AAA@AACCAA@AA@A@C@A@A@AA@A@CEC@A@@AAGAA@A@CAAAC@@@EBABC@@@A@AA@C@@AAEEAACA@@AA@C@AB@@ACGCCA@CAE@C@AACEA@CCAAAA@AABGBEBCDCDCBKBE@EBEDKDIDKAG@GAGAGEGCGAIAKAEAIAGEIBM@KAIAIAGCIGEA@HAFIJGDGDIFKBI@KAIBGBOAMCIECCEEAEGEACAC@EIEE@M@KCKCCAGBGCGCCCAC@EDGHU@CAE@ACCGAIBKAIAGAC@GBE@KAGAG@GCECACG@G@KCGCCCGCCEAEAC@GBGAAAEAC@EBG@CBCDCBE@CA@MBABKAIAGCEAEACCEAGAECAEACBC@ACCGCCCAEAC@C@AGAGCGCIFGHCBIDG@E@GAE@GAEAEBMCEAEAEAEAE@CDCBEBGDIDOBIDIBI@EAGAACGBG@G@MDIBE@GDCDGDIBGAUGEAECCCCGEAACICGECECG@GDEFEDE@CBCBCDCHCBEFCJGAGCCACAE@C@C@CBGFIAG@CBCBCDCDCFAFAHABAA@A@AA@@CAC@ABCB@@ABAFADA@A@ABC@C@CBGFEB@B@B@@BB@@@B@@BDBB@@@BAB@BAB@B@@A@@@A@@AE@@@A@@@EACBCAA@@AAACAACCACEA@@AAA@A@A@@A@A@@@A@C@@@A@A@AAAA@@DE@CAA@AAA@@A@A@AAAA@AA@AA@A@@@A@C@A@A@@A@@A@@@A@C@A@@A@A@@AAAA@C@@A@@A@BAA@@A@AAC@A@AAC@A@AA@A@@AAA@@C@A@ABCBA@A@A@A@A@C@A@AAA@@@ABCBCDAB@@AD@@AB@BABA@AACAA@AA@A@A@A@A@@AA@AEA@A@AAA@@AAAACAA@AAAAA@@AAA@@@A@AAA@CA@@ABC@C@C@@AA@A@A@CDE@A@@@@@AAA@AAA@C@@BA@@DAB@@A@@@@@AA@@A@@AA@@AA@@A@AA@AA@@@A@@@A@AC@@AA@@CE@AAA@A@@@CB@@C@A@CA@BA@A@A@A@@A@A@@@A@@@A@CC@A@ABCBA@@@A@A@@@@ECGBACGICDC@CAE@CAC@CCI@ECGAEC@GEEEEAECAGCCCCDEFEFE@C@C@KBE@IDG@ICE@GDOAKGC@A@@@E@ADI@CDADGFA@A@A@@@A@@@A@@@A@A@A@ADA@@@C@@@@@@B@BA@C@A@A@@@ABA@CBCBA@A@AAAA@@A@CBIBA@@@AAABA@A@CAA@A@@@A@A@ABE@CBAAC@A@ABAB@@@@ABA@A@@@C@AAAB@@C@AA@@A@@@A@A@A@@AAAA@@@A@A@ABC@A@A@AAA@AEACA@A@@@A@CCA@EC"],encodeOffsets:[[120723,45172]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
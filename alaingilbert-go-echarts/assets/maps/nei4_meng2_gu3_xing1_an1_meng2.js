@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("兴安盟",{type:"FeatureCollection",features:[{type:"Feature",id:"152222",properties:{name:"科尔沁右翼中旗",cp:[121.47653,45.060837],childNum:1},geometry:{type:"Polygon",coordinates:["@@ID]NYNGAEBIFULQJn@@C@[DG@@BABABABCDA@ABODUHsRCAG@CBC@EBIACBGDCBC@WD@@MDCBKFCDAB@D@BC@CBID@BCBGBCAAC@@KDEBG@C@ABE@CBGDGD@B@BCBADCDEFCF@DGB@BA@I@@ACCECEAE@C@CBAFCBABEDABADGFA@@B@F@D@@ABCD@BEDMHCBCBAFDB@DAB@JBHGFCJ@DCHEDA@EDCFKDAHEB@BJJBFAHAFDN@D@DCFBBLF@BADCFAD@B@DEACDEBEBC@ACCAG@C@A@CBC@CBEBABA@ABC@ABAB@B@DF@BBDBDAB@DBBDBD@BDD@BABRZJPCJCF@BHBINVGHEDDCDCBC@ABGD@DCDED@BCFGDCBC@C@@DC@@DIJ@@@BC@ECA@CAC@C@AFC@GBC@IDC@ACA@IBCDC@EBCDEBA@ADGAADEFADEBCBADG@CBK@EAEBABGAAAKAEDI@EBABK@CCEAC@IvBH@BAFADCBGAEBGAIBCGA@ABMDKBEBEBG@IBEACB@BEBG@IHeCgR]JWFUNKHEDG@AB@BEDC@C@CDABGBEF@D@DCDCD@@EFGHC@ADGBABEDG@CBABEBCF@BKB@B@@@DC@A@C@A@GD@BBB@@CDEDC@@BABA@A@G@A@@BGJEBCDCBE@@BABEAABIDCACAA@G@CBAB@BE@ED@BBDAB@B@DCB@@@LBD@NDHBFLFKFCJBRFD@@@@B@AF@B@B@@A@@@@@@BB@@BB@BB@@A@A@ABEB@@ABABC@@BA@@@ABA@@B@@@B@B@@@BCDA@A@ABEBAB@@@BB@@B@B@B@@GDCD@@A@A@@@EBCB@B@B@BED@@@BA@@@@@A@A@AACAAA@@AA@@CBC@A@@@AB@D@@CB@BABGF@@A@@@ABABA@@B@BA@@BAB@D@B@B@BABA@@@A@@@ECC@A@A@A@A@@@A@AA@@A@CBA@C@C@@@@@A@A@C@@@C@A@A@@@AB@B@@@B@@@@@@CBA@@@G@C@C@E@A@A@A@@@C@A@A@C@AA@@E@A@ABA@AAA@AA@@ABABA@@B@B@@@B@@GDA@A@@@A@BBBD@B@@@BAF@BAB@D@@A@@@EBA@@@AB@@@H@@BB@B@@@B@BAD@@AB@@@BDB@B@@AB@@ABAB@@AB@@ABC@G@AA@@A@CBA@A@@@@@ABA@CBA@@@A@AD@BCBA@A@@BAB@@@BA@@@A@C@A@A@CA@@A@CBA@@B@B@BC@@BAB@@CBCB@@C@C@@@A@@@@AAA@A@@C@ABC@AB@@AA@@AA@@A@@BCBAB@@A@A@@AABA@A@A@E@C@@BA@@BAD@@@B@@@B@@@@@BA@ABA@AB@@AB@BCB@@ABAB@@AB@@@B@B@@@BA@A@AB@@@DA@@@A@A@@B@@AB@BA@@BBB@B@BBB@B@@@@@B@@@B@@@@@@CB@@AB@@ABA@A@@BABA@@BA@A@@BAAA@AAAAA@A@A@@B@@@@A@ABA@AAC@ABA@A@@@A@A@CBA@@@A@A@A@@@@@AB@B@B@@@@A@@@@@AAA@A@@@A@C@AAA@@@CBABA@@@AA@A@A@@AAA@@A@A@A@@A@C@CAA@A@C@@@@B@@ABA@AB@@A@A@A@A@ABA@CB@BABEDAB@@E@A@@@A@@BA@ABA@GDABA@@B@B@@EBAB@@A@EAAB@@EBABCBC@@BABAB@@@@A@A@A@CBCB@@G@C@A@@@@@@AAA@AA@A@A@CAC@CB@@A@@BA@@D@B@@EBCDAB@B@BAB@@ABABC@ABCB@AA@@@K@C@A@A@CBABABADAB@@A@AAEE@@A@A@A@A@AA@AAAA@A@A@A@ABA@@@AACAEEA@@@A@@DA@ABC@ABA@G@A@A@@@GBA@@B@@A@@B@@CAAAAAA@A@ABA@CDAB@@A@A@@@CA@@@BABAB@@@@A@C@@@C@A@@@AAA@C@C@CB@@C@@@A@E@A@A@E@C@ABA@@@@@A@C@@@AAA@CAAACAA@EAC@A@EAC@@@AACB@@A@AB@BC@ABEAAB@@A@@BA@KDA@A@C@AAA@A@CBA@A@AAAAAAAAA@AAC@@@A@C@A@A@@@A@@@@AB@@CA@@@@@A@@@@@@@A@@@@@A@A@@@@@@A@@B@@A@@A@@@A@@@ABCEA@A@A@@A@@AAA@A@@@@@AA@@@@BA@@E@@@@@AB@@@BA@A@@ADIDI@CBG@@CEAAAA@A@@@ABCB@AAAA@A@@@ABAFCBA@AB@B@BA@@F@B@B@FA@@B@FDDBDDAACEAA@A@A@@BAB@@AHGDC@@DEHIBA@A@C@ABC@A@ABC@E@C@A@A@@@AAA@ABCBC@A@@@A@C@@@AA@AAGCMKAAAA@@ABGDA@A@A@A@A@C@EBGBB@BAB@@ABADC@C@A@A@@AA@@A@@AA@C@A@@@EDABA@A@A@A@A@EB@@E@C@A@A@@@AAA@A@A@@@AD@@@BABABCBABA@EFA@@B@@@B@@ABA@AB@@CACAA@A@A@@@ABA@CBA@ABCB@@C@A@A@@@ADA@@BA@@@AA@@@A@@AA@@IC@@BAA@AAACAAA@@@A@CDAB@@E@A@A@AB@@@@A@@BABA@E@EB@B@@@@@@@@AB@@@@@@A@CBAB@B@@@BB@DDDBDD@B@@@@AB@BAB@B@BBB@@D@D@@B@@@@@B@DB@BBBFBD@@AD@@@BAD@DAB@BAB@B@@@B@B@B@@@BABA@BB@F@BBB@BBD@@BH@BBDAD@@@BC@A@@AAA@@AB@@@A@@A@A@A@@@A@@@A@C@@@@@@@@@A@@@@@@@A@@@@@A@@@A@A@A@A@C@A@@@A@A@A@A@A@A@AAC@AAAAA@@AA@@AAACAAACEA@AAA@A@A@A@@AAB@@@B@@A@@@@B@@B@@@AB@@@@A@AB@@A@@@A@@@A@@@@@A@@BAB@DAD@B@@A@@@@@A@@@A@@@A@A@A@ABABABABGBA@@@A@ABA@A@A@@@AB@@BB@@@@@@A@A@@@C@@@@@A@@@@@@@@B@@@@@BA@@BA@AAC@A@@@A@CBA@A@A@E@A@A@AB@@AB@B@@A@CA@@A@A@AAA@CBGAEAAAAA@@@A@@AAA@@BA@AB@@A@@@@@@@A@@A@@@AC@A@AA@@AAA@ABA@@BA@@@BB@@AB@@@@A@A@@B@@A@@BA@AAA@A@A@A@cPGDCBA@@B`[L@@EBjA@EBX`WH@@xZFDlBXBF@Z@ZDD@BAVCJAJA@@DDfnNRAFAD@BIb@DAThdRPRJHPPtvPPFFD^@BBHDdFhBRD^BDBLBXDPD^BT@DF`@F@DA@CDA@CDADC@CDA@ABG@A@C@@DB@ADEHHFDDHDVDLHFPL\\NªJxBF\\TJHFDBLBRBL[ZBB@DADANCD@BBD@BABCFCBA@A@CCA@A@C@AB@B@BBD@@AB@@BBB@B@B@DBB@@@B@@@BB@BBBBBB@D@BD@DBBB@FDBFB@@DAD@@@@@@B@D@F@B@DDB@@BFAD@BB@@B@BDB@@B@B@@@B@@@@BAB@DBB@B@BBDBBB@@B@FADAB@@@DBBBD@D@D@B@@ADAB@BAF@DA@@BCB@@@BCDEDCBABG@ABC@@@C@A@GAA@G@AB@FCLIB@BAB@BAD@HAB@@ABADCDCBABCB@BA@CFEBCDEDCDGBADADADAHEHCDCBAFELIDADCFEDC@@@@BARKRMDAHAHCLEXIBBB@BBD@BBD@B@BAD@BAHA@A@@AA@@AAAAAAACACEE@@@AACCG@AAA@A@C@C@C@AAACA@@HAN@JA@@DB@@B@BA@@F@B@BAB@HD@@B@DA@@BADABAH@D@B@B@BBBDDBBBFB@@BB@@@BB@DAB@BBFBFB@BBB@@B@F@D@B@BBD@D@D@B@D@BAB@@A@@BE@AB@@@@@DBF@B@B@DA@@B@BBB@@@BCDG@A@A@AB@@@D@B@DBB@BBFFB@B@BABAB@DABBB@B@BBB@BB@@HBB@BBDBB@DCB@D@BADADCB@DAB@BA@A@ABAFAD@BA@A@A@@@ABCDA@@@C@@FABA@A@@@A@@AA@A@@@A@AAAAC@A@A@@BABA@@@AGCOKO@@EB@A@@ADADCHC@@BA@A@AFGBCBCBCBCDABA@ABAB@BAH@@AB@@EB@@AB@B@BA@@BA@@AA@AAAAA@AA@@@BABABAFABABA@@@C@C@AAA@C@AAACAAA@A@@@A@EA@@@EACAA@A@@AA@@A@@@AAAA@@ABCDE@CBE@@@@F@B@DAB@D@@@@@@@@AAA@AAAAAAA@AAACA@@AACEA@@@@ADEBA@C@AB@BAB@@@BAB@BAJANADAH@BAB@BALMFIBABADCFCPKVMPADB\\LDBHDTH`NnMNCJCVGFAFALCDABOBO@GBAFM@ADE@CBCBCBCBCBAFCAAZWxD@vN@@VFVDHBH@bDBANALAXOJEFAJKHIBCHGBCNEHEFABAbDV@NBB@J@Z@D@B@D@FBFBFBJBLBFBD@FB@BBBDBFH@B@@ABB@@@@BDADBD@F@DAFAD@BDB@B@DBB@D@BBJF@@B@@@DANAB@@@B@B@@@BBB@@@B@B@B@B@BCDAB@B@F@B@B@BB@@BAA@@AA@@A@@@@BA@A@@BAB@@@@ABA@@BABA@@BB@@B@B@B@B@@@D@@@@A@@@C@ABA@AB@J@BAD@@ADABA@A@@DAD@B@@@@@D@@@BAB@@AB@B@B@BA@@BBB@B@@ABA@@B@B@@@BA@ABAB@D@FB@@B@@AB@DA@@HBB@@AB@B@@@D@@@@@@AB@B@N@B@BBD@@@F@DABA@ADCBAFCJIXKJEDAXQNINIFABAHAHERURKHCLIJEHGFAD@J@DBPBDBBB@@F@BFB@B@BBB@DA@@TGJGDADAFCDCJKDCBADA@@B@BBBADB@@F@F@F@@@BABAB@BADABCB@B@JBHG@ABAB@F@B@BCDABA@G@A@C@A@A@@BA@@@@ACDANGB@@@DCBABEBC@ED@B@BAFA@CDCDCB@FAB@DB@@FAB@DBJB@@B@BA@@@A@A@@D@B@F@@AB@@AB@@A@@@ABA@AB@LABBB@D@BAB@DA@@BADAB@B@B@@AD@B@@AAAACAA@A@AAEACAA@CBCDALAFCFA@@@@@A@@@A@C@E@@BABA@@@AA@CA@AA@@A@A@A@A@ABA@CBCBA@@BAF@@@@@BA@@AA@@AC@@@A@A@A@AAE@@@A@A@@DCB@@@B@DBB@BAB@@AB@@A@@BAB@@@D@@@DBD@D@BAB@@ABAD@@@DFBB@BDBBBBDB@@@J@@@@ABA@@FCDADCH@B@D@J@@@DBD@B@DADABABE@@@A@@DE@@@A@KCA@A@A@@BA@AFEB@BCBA@A@A@A@C@ABEFC@A@@@@A@AAA@@AAAAA@A@AB@@@BAB@B@@@@CFC@@B@F@BAB@D@B@@@BABCBA@@@ADC@A@@AAA@@@AA@@@A@@DAHAD@D@B@BA@@@AAAAC@A@A@@@A@@@A@@BAB@B@BAFCB@@@B@@@B@@@BA@@B@B@B@B@BBB@B@B@@@B@@A@C@ABA@ABABAB@B@B@B@@@FCBAB@@BB@@BB@DDDBDBB@B@DAD@BBD@B@B@@@BADADABAFB@@B@@AD@DAB@D@BBDDB@DBHBBDDBD@B@@BBBBBDBD@D@@BB@DBBBFBBBFBD@LBB@B@HAF@HADAB@@A@@@A@A@@BAB@B@BBBAD@DBB@D@HAB@@@B@@ABCB@@A@A@ABA@@@A@ACAACAAACA@@@IAC@EAEAA@ACICEAEA@A@A@AAC@@B@@A@@FAFCDADA@AHILGB@BAHB@@DBFBBBLJBBH@F@D@JADAFIDA@@@A@A@AA@EIAACCGECAAAAAIKCCA@@AA@CACA@@@A@@BMB@@@BAJAHAJ@^FD@NKXSFEBAFGDABC@AJQKYCI@ABA@@HS@ABCfDBAFCBAFEB@BA@ABAHCBAD@B@D@D@B@H@DA@@BADE@A@CB@AABE@G@C@CDCB@@APMDIBADEDG@@BABA@AAA@CB@@ABA@@BABCHKBA@CBABCFCDG@G@EBABCBADADAD@JE\\ONEBAlCD@NA@@f[TAXAJEFAFADAHCLC@AbMPAxAtSjObIQFAbGhVF@@BBBA@@BNC^BNBVBJBD@VBRBjFJBL@TBBD@HBDBbJDBLBDBF@FB@@BFBBBDBH@DBBPLVPXPPJBBpBLBL@@@@BB@AH@D@F@D@@BBF@F@B@H@DBF@FBB@D@F@B@BBH@F@DBD@H@HBD@F@NBBFQPAtKHA\\E@@@C@UF_W[YkWCCDGBAAYBEKKOOUaaLuF_B@@WeEWGWCIq_BGRDUMECCB@@UCGDA@EC@@EBA@ABCABEAAIQDAPC@EBCAA@AAA@@DA@CBGFAB@BBDDD@HCB@B@FIAI@EBCBI@C@@DCD@D@BA@AB@@CDC@@@A@A@A@EA@@AAC@A@A@A@CBADCDCDAFKDCDGDEBC@@@CBKEEIMBEBIBAHMBAPGNCPAHBNBh@J@HEPICCCAWESQACKDCBOBKJGDGDEDSgCG@ADEDIBBZFLB^DL@BBR@BCHMHMM@DIB@CCMIAAaIA@QKGCBEAIAIAAOSGIA@BI@EF[HkF_dUBABGJWEKGIPCrOONK@@L[`]DOGiKCCCTSHGbGJ@T@\\EZJLDOB_Ao@[Ao@A@A@A@G@E@IAscKGCEJWHAAAB@BAgJMBaHMBC@aCKG@@@ABA@ABA@CBC@CBC@GDA@EDIFEBC@IB_FA@@@E@ABE@EDMJCDA@ABGBIBIDE@GDKDIB@@UHODUHE@B@IDMD_RKJWVCDKDGBEDC@EBABOBgHCBA@@@@PQR_`_^ABSTCDQHMFGBGDK@IBI@YCGPET\\QHc~@BGF@BEDEDKJUV}b@HCH@DUDG@OLU^@B@BA@ABIBkl@DBNgndNCRIH¡PKTO^CTEd@B©~laTGFEBQJBB@b@BBBNLFD@BSdCDMX@Bi@@@UTeb@@QJ"],encodeOffsets:[[124256,45960]]}},{type:"Feature",id:"152223",properties:{name:"扎赉特旗",cp:[122.899656,46.723237],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@M@K@ABABC@A@@@AAA@@@@B@B@B@@@B@@@@@@@AA@@B@B@D@@@@@AB@@D@@@@@@@D@@@@A@@A@A@@A@@B@@BB@@@@AB@@@@@A@AA@@@@BA@@@@BB@@@A@@@AB@B@@@@@@@@@B@@A@@@A@@@@A@@@@@@ABB@@@@@A@A@@B@@@AA@@@@D@@@B@@A@@@@AAB@@A@@A@A@A@@@@AB@B@B@B@@@A@AA@@@@@@A@@@@AB@B@@ABA@@A@@@A@B@B@BA@@@@B@@B@@@B@@B@@@@AAA@@B@@@@@@BB@@@BAA@@@@A@@@@@@@AA@B@@@B@@@@@@@@ABB@A@@@ABB@A@A@@@AB@BB@A@@A@A@BA@@BB@@D@@@@BBA@A@@A@@@@AB@@B@@@A@@BB@@@A@@@A@BB@B@@@B@B@@@B@@AB@@@@BB@@@@A@@@@@A@@A@@@@@@@@AB@B@@@AA@@@AB@@@A@@@@A@@B@B@@@@@AB@@D@@@@@@A@@@@B@@@@@@@AA@@B@@@@@B@@AA@@@A@@@@A@@A@@@@@@@@AB@@@@A@@@@BA@@@B@A@A@@A@@@A@A@BAB@@ABADC@@@AAA@A@@@EBA@@@A@@HED@@A@@@@@ABABABCB@@@@@HCB@@A@@@C@@@@DCB@@AD@FCD@DCB@@ADAB@B@B@B@B@@@@AB@B@@@@AA@@AB@@AB@@@BA@@B@@@B@AA@A@A@@B@B@@@B@@@BCB@@AB@@A@@@A@@D@BA@@@A@A@@A@A@@A@@@A@AB@@@B@@@@A@@B@@BB@@A@A@@@@A@@@A@@@@@A@@@BA@@@A@@@@@@BA@B@@B@BBBAB@@@AA@@@@@@A@@@@@@AB@@@@A@@BAB@@@BB@@@@B@B@@@B@@@@A@@AA@@@AA@@@@AB@@A@@@@@@B@@@@@@BBA@@@@@A@@@A@@@BA@@AA@@@BABA@@D@B@@BB@@A@@B@BA@@@@@A@@A@@@@@A@@B@@AAA@@A@@B@@@@@B@@@@A@@@@@@BB@A@@@@@A@@A@@@@A@@@@B@B@@@BA@@B@B@@A@@AAA@@@A@@@A@@A@@B@@A@ABAB@@@B@BA@@@@B@@AB@@AA@@@BA@@@@CA@A@A@@B@@@@@@BB@BA@@BB@A@@B@@A@@CA@AA@@@@@@@AA@@@@AA@@B@@@@@@@@@BB@@@A@@B@@AB@BAB@@AA@A@@@@@@AB@B@A@BA@@@@B@@@BA@@@@BB@@B@@AB@@@A@@@B@@A@@B@@@AA@@@@@@A@@@@AB@@@@ABA@@B@@A@@B@B@@@B@@@@A@@B@@@B@@AA@@@AA@@@@@@BAD@@@BA@@@@@@A@@A@@@@@AD@@@@AB@@CBA@@@@B@DB@AB@@A@@@@@AAA@@B@@AB@@BB@@BB@@@@@BA@@BB@@BA@@@A@@B@B@@A@AA@@ABA@@B@@@B@@@@@@B@@@@@BB@@ABB@@@AB@@@@@AA@@@@@@@@@A@A@@B@B@@@BB@@B@D@@A@A@@A@@@@@B@BA@@B@@B@@DA@@@AA@@@A@@@@@@@@AB@@@@A@@@A@@B@@@@@B@B@@@B@@B@@BA@@@@@A@@@@@@@@A@@@@A@@B@B@B@B@@@@A@@@AB@@@FAB@BA@AB@@@DB@BA@@@B@B@@@@BBA@BB@@@@@@AA@@AB@BA@@@@A@@@B@B@B@@A@@@@@@A@@A@@@@A@@A@@@@@@B@@A@@A@@@@A@@@A@@BB@@@@@@B@BB@A@@@@@AB@@@@@@A@@B@@@@@@A@@A@A@@@BAB@A@@@C@@@A@@@@AB@@@@A@BB@B@@@@AB@@@@@AA@@B@@@BBB@B@@@B@@@B@B@@@AAA@@@@@B@@@@AB@@AB@B@@@B@@A@A@@@@D@@@@A@@A@@@A@@@BAC@@@@A@@@A@@B@@A@@@@@AA@@@@@@AB@@@@@A@@A@@D@@@@A@@B@BAB@@@@@@AA@A@@A@@@@@AB@@@B@BBB@BB@@B@@A@@@@@@AAA@B@@@D@@@@@@B@@B@@A@@AA@AB@B@@@A@@A@@@@B@@@B@@@@BB@@@@AB@@@BB@@B@@@@@@@@BC@B@BBD@@A@@@@A@@@@A@@@AD@B@@@B@@@@@B@AB@@B@@@BA@@@@@AB@@A@@AA@A@@@@A@@@@@@AB@@@BAB@@@BAB@@@B@B@@@@A@A@@@A@@@@@@@@B@BABAB@@B@@A@@B@@A@BB@@B@BA@@B@@A@@@@B@B@@@@@AB@B@@@@BAB@BB@@B@@@@AAA@@A@BA@@B@@@@@@B@@@BB@@AB@@AA@@@@@@@@@AA@@B@@@A@@@@@@A@@@@B@@AB@B@BAB@BA@@B@BBB@@@@@@BA@B@@@B@BAB@B@@@B@@@AA@@BA@A@@@@B@@@BABCDAF@@AB@@AB@@@BBB@@@@@BCBA@AB@B@@@FBB@D@F@@@BA@B@@@@@@@@BBB@@@@AB@B@@@@@@BA@A@@B@@@@B@FAB@B@@@@@@B@@@@BBB@@@@@@@B@B@@@BA@@@@@A@@B@@@@@@BD@@A@@A@@AA@@@@AB@@@@BB@@B@@B@@@@A@@@@@@B@@@B@@@BA@@BA@@A@A@@@@@@AB@@@B@@BB@@@@B@@B@@@@A@@@@@@B@@@@@@@@AA@A@@A@@@AF@@BB@@@@B@@@@B@@@@@@@D@@@@B@@BB@@@A@@@@BAB@B@B@@@DA@@BAB@BAD@@@DAB@@@@BBAB@BB@@FA@@BA@@B@@A@A@A@@@@@@AA@@@A@@@@A@@@HAB@F@B@@A@@AABA@@D@@@B@BBB@B@D@B@B@@@B@FCBA@@B@B@B@@@HBFBD@DAFAB@H@B@DA@@B@@B@B@@B@@@B@DA@@B@D@@BBADA@@DABAB@B@BB@@BB@@B@BA@@@AB@BA@@@AB@AAB@@@B@DBB@BA@@@@@A@@@A@@@@@@DAB@DAB@BAB@B@@B@@BBB@@@HAD@B@D@D@@BB@@@JBB@B@B@@@D@BBB@B@BBBBBB@AB@@A@EBABCBE@C@A@AA@AAGCEC@@A@@A@@@C@C@A@@@AA@GCC@AAGEEAgSQMAA@@@@@AA@A@@C@@A@A@@AECc[B@@A@@BE@CBE@ABCBAMA@SAE@CDCDCDC@@FC@@@C@@@@HCCAZG@@AECCEEAOH@AAB@@ABA@A@@B@BBBB@@B@@@B@@@@CB@@A@@DBJ@F@B@D@BAF@D@BBH@JB@@NCD@B@JDB@DBB@B@BBDDDB@BBADDA@FDBB@A@@B@LFFDDB@@@B@BDDLK@@BBBBGHJ@DBDAPFBCA@AAB@B@@@BCDC@@@A@@@CAC@C@@BC@ABA@A@CACCE@AAA@CAA@E@ABADAB@BA@IAA@@CM@@CKCK@@@A@CBAUAAAC@@@@AHO@EB@BCBCB@BCBAHGLOBMEA@A@@DCB@@A@@BC@A@ACE@@@AB@@A@@@A@A@@AA@@@ACA@@@CB@@A@@@@@G@C@A@ADCDCBA@@B@FA@CBA@AAG@EAC@AFALCD@B@BA@@@ABA@A@A@@DAD@@@BABA@CBG@AAA@@@@@A@A@A@C@@DABBB@FBBBDDBBB@B@B@BA@ABA@ADABAD@H@BADABAFAD@DEB@DAF@B@BA@A@A@@@AB@FAHCJAPEFAFABAB@@ABACK@A@A@@AAA@AAA@@A@@@ABAFCDADCDE@@@ABA@C@A@E@@BA@AB@F@BA@@@CB@DCDCBA@@B@F@B@F@BCD@B@B@DDDBBB@@AB@BABA@@B@@BBB@F@HB@@B@@@@@B@B@D@BBB@B@BBBBBBBBD@JBB@BDBDB@B@DABAB@B@@B@@@B@@AB@@@@BB@@@@@@AB@@@@BBB@B@B@@AB@B@B@BBB@@BB@BBB@@@B@B@BCBAB@DB@@B@@A@A@ABBB@DBB@B@B@BABA@A@A@A@@B@B@D@DAB@DAB@B@FB@@B@BBD@@@B@BBB@@@B@B@D@B@FAFAB@BBD@BBD@B@@B@BB@@BB@@@B@BA@@@@B@BBBBBB@@BBD@B@@@@@FC@@DA@@@@B@B@DAF@DBB@B@D@@@@AB@BBB@DB@@DDD@BD@BBDBB@B@BBBB@B@BB@@ADABBB@B@@@@CB@@A@@@@B@@@B@BBBBBB@B@BBDBBB@B@DAB@@@DBB@@B@B@BBBB@BAB@DADBB@DBDFFDFBBDBBBB@AB@BBBBBB@B@@B@@@@@B@@@@@B@@@@@@@BB@BBBABAB@@@DDBBB@@BA@@@A@A@@@A@@BB@@BD@BBB@BFB@BB@@B@@ABAB@@@B@@B@@GHA@@B@@B@DB@@B@BAB@B@BBFDDD@@@B@B@@A@@@AB@@BB@@@B@B@@A@ABA@AB@BBD@@ADCB@@@@B@B@@BA@AB@@@@BBBAB@@B@@A@A@@B@@B@B@@@@BAB@@@@DD@@AB@@@BB@@B@B@@@@@B@@BBBB@@@@A@AB@@A@CA@@@B@@@B@@@BB@B@@@BAB@B@B@@BB@@B@@B@@AB@@A@@BB@@@B@@@BA@@@@B@@@@DD@@FB@@@B@@BBD@B@@B@@@@@@@B@@@@@BBAB@@@@A@A@@AA@@@A@@@A@@B@B@DBB@D@FABAD@B@B@BB@@B@@A@@@@AA@A@@@@B@B@@BBBDBDDB@@@BAB@@@B@@@@@AB@B@@@BB@B@@@@@DADA@AB@B@B@@@@B@BBB@@B@B@DAFAB@D@D@@@B@@@BAAA@@@AB@B@@@B@BDD@@B@@@BAB@@@@BBBBD@DBB@BB@@FABABAB@@ABAB@B@@@DDB@@@BA@A@A@A@@AAB@@@@@B@@BB@@BB@@AB@@C@@D@@@@AA@@AB@@@B@@B@@BD@@@@D@@@@A@@@AAA@@A@AA@@@@BAB@@AB@B@@BB@DB@@@AB@@ABA@@@ABAB@@A@AA@@@@@C@A@@@@A@@@A@@C@@A@@B@@@B@F@B@BAB@B@@BB@@B@@B@@@BAB@@@@CA@AAC@AA@@@A@@@@BA@@B@DBBBB@@@BA@@@@AA@AAAA@@AB@BAFA@A@@@@A@AA@@@@@A@A@@@AB@HC@@B@@@BB@BBBB@@@BD@@B@BBB@@@B@BAB@@@BBB@@BDB@@@B@@B@@@D@D@B@B@FDD@@@D@B@BB@@BAF@BFLX`DF@BPhAZA`@@]F@F@D@FABAHABABABABCBGDEDCDEDCDEDABA@ABABABA@C@KASAUAIAA@E@GAMAOAEbCPAFFHBB@BADPE@@DCLCBAJAFB@@B@HAFAFAB@BAD@BADABAHGDCF@BABADCJEJEHEHEDABCHADCDAFCBCBABEBC@EBE@@ZED@D@FAFABADBBAFAB@F@B@DBB@BBB@B@D@BBFBB@D@B@BBFBJDD@DHBBD@J@@BB@@@BDBB@@B@B@@@B@D@BBDABB@@@B@B@@@D@@@@@BB@@ABABC@@@@B@BB@@B@B@@@BBBB@@@@@@@B@@C@@AAB@@AB@@BB@B@@B@@@B@@ABA@@@@B@@@B@@B@@AB@@@B@@B@B@B@@A@@@AAA@@@@BA@ACA@@@@@A@@B@B@F@B@@@@@@BAB@@BB@@B@B@B@@A@@@A@@@@B@B@B@@@B@@@@@AC@@@@BA@@@@F@B@F@@@B@@B@@@BA@A@@@@B@@B@@@B@BA@@B@@BB@@B@B@@B@B@B@@@@A@@AC@@@A@@B@B@B@DAB@@@AD@@BB@@B@B@D@@@B@BD@BBBB@BBB@@AB@@@BIACAG@@BA@A@@@A@EAEEK@E@E@CACAAACMOAA@ACC@ACIAGAC@@CCAAGICIAQACACCCGGEEACCG@AAEBIAA@ABADEDEBADADCB@DCHEHCBADAH@HAFBLBR@P@F@HANAF@D@D@F@BBDBDBBBDDBF@BDJBDBD@B@DAF@BCFADABADAD@D@B@BBBBBBDDBDB@BB@@B@B@@@B@FBB@NAH@BBBBBBBBBDAB@FABAH@F@FBF@F@FADABC@GACAECE@ACCCCAAAC@C@C@ADCBCHCBANCDAJCFCDA@A@CCEKIICCAEAA@GAEAE@KAC@MCCA@A@@@CBCBCDCDABCDAD@BAF@DAH@V@T@L@D@BA@C@A@A@@A@AAAAA@@@A@@A@A@ABA@@BM@IBC@@@@A@AA@EBCDQ@GDMK@A@@ABI@GBEBKAA@A@AB@@@BABGBC@ABC@A@A@C@@ACA@CCEAAAC@AAA@AA@AACCE@AEMEKGOAGA@@AC@I@U@[@iA]@C@A@A@QAc@s@@@A@BA@A@@A@@@A@@@A@@@EDA@A@@@A@@A@@A@I@sCQAE@A@eCEACUCECAA@@OAAAC@CAK@EASGCAW@OBA@G@YC@@E@A@@AAA@@AAA@@A@@@A@A@AAAAAAA@@@A@@BAB@B@B@@@@CBA@@FC@AAAA@@C@CBI@C@ABK@CBE@EAAMEEACAIAICAA@@KCEAEAC@DI@AAA@@@A@@@AB@@AA@A@@@A@A@IC@@CACAA@AA@AC@CDGDEACAB@@AA@AAA@@@CDA@CAA@CFCAILUP@BABABEFCBCD@@C@@@[COAC@]EK@C@sGSAHS]CA@AB@@@@AH@BA@ER@BAB@F@BCV@@A@A@EAC@E@IAC@G@C@GAE@@AAA@@C@@@DK@GBABI@@DM@@@IBC@CBKCAW@WCC@I@A@A@IAG@E@CAC@AA@@BO@@@@@A@CAABA@EHa@ABE@CDW@A@M@Y@A@A@@@a@Q@[@AA@A@@GBE@@@@@AAC@C@A@ACA@@AA@@@ABA@@BEBABCBC@A@@BA@@@@TUNODAxBALMLKLM^_DEFEFEHGFCHGLIFE\\YRQDCPU`iNUDCDEDEECKEECkYCA]c@ACqCBGRUCgEYCgeIIG£KěWGaC@@A@@@SÜGr@JCXGtAJEVCXInCXCNAH@B@D@DAB@@CHU`IRKRCJADBBBB@BBB@DBBB@BD@BBBBBFFFHB@FFBBB@DDDDFD@BB@@@B@@@DAB@BABAJC@@HCFAFELCHCJGB@D@DAF@@@B@F@@@JLCFBBEBBBFAB@BBGHB@CF@@D@EJ@@B@B@BBDGLRB@DFBBB@@D@F@R@FA@@DB@AN@@AACFB@B@B@GFGFGFCBaDGB@@QnORSAGDIHCDGR@B@@AB@@AAAAAAABAB@B@@BBCDDBBHC@BFBHDCAA@ADD@@@@ABBHA@IFB@A@ABAB@@A@CAEDA@@@CBCB@@@A@AA@AAC@ACAA@A@AA@@AO@@DE@AD@@ABAAEDECIFE@GDAA@@GBGAA@KECDG@@ECA@JE@AI@AA@@@[REC@CC@@AFBBAIC@AI@@BGA@AA@BFABC@@D@@@@AEKBEG@C@AHDB@B@B@CAC@JGB@BE@@B@@EA@BEA@BEAAACDADEFBBAAAFEDBLABBB@FEEEDAAAFCBADADABA@ADCAA@AA@@@CEMEYQA@IEAA@@CAAAAAAEC@AAC@CBC@A@A@@@CACAAA@C@@CAEA@AA@AAAACACAA@C@@BAA@@C@@AAAECCACCAAC@A@@AGBA@@AA@AAA@AAA@M@E@A@AACAAACCA@C@@@CB@@A@@@@@A@@ACAA@A@@@A@CB@@A@A@AAEA@ACA@@ABA@A@@@A@A@@AAA@@AC@@A@A@@@AAA@@@ABABABC@A@C@A@A@A@C@EBA@AB@@CBA@AB@BC@AB@@A@@AGAA@A@CBC@CBE@A@ABCBA@E@EBC@G@@BCAC@A@@AAAA@@A@@AAAAC@A@C@ABCBCBA@A@CAC@M@E@EBGBE@GDEBGD@CiFODMFSHKDiA_FQCSCG@MCG@KC]AKBMDCBED@@A@A@ABHHBBB@@@BDYFABSDXaJSFE@WL@D@BCDG@GBKBIHCFEBAB@@G@AAE@GCIB@@@@@@CACECCEEIEG@SDCCE@EBI@QB@AACCAC@GBCBEBGBC@GAC@CCACCAEAAACAEB@@CBADADAFCFIF@B@B@DBHADQLABGFIFCBMJGFCDCFEDEFI@I@GBEBEBGBE@A@A@CA@AA@@AAA@@BA@A@A@A@@CA@@I@EB@@C@C@C@@@ABABCAA@CBC@C@CAA@A@A@ADA@ABCF@BB@@F@BA@CBA@A@AD@@@@AB@B@BBFC@A@EB@B@@ABCD@B@F@BADAB@BA@ABCBEDABABABABA@C@ABA@E@A@C@AACAC@A@C@CAA@@DABCDCBABABAB@@CB@@E@@@A@@B@BB@FDBB@B@B@DCBCDEDEDABBBD@@B@B@@CBABC@CDCBABEFGDABC@A@C@EBC@EDCBAB@D@BBB@BBBBB@BAB@@@@ABGBABEDA@AB@DCBCBABABABADABABAB@@@DADADA@ABBDB@AB@DBDABBH@F@DAF@DCD@JAB@B@B@@AD@BIBEBAB@@@@@BDB@DCF@@@BIDEB@@ADBL@@DD@@B@@BHB@@@BBBBB@B@BADBD@BBBBFADBB@@EHGDAB@@DHCDBDADFB@J@F@BDDBD@B@@@BABDFYREASDCHC@E@GACBABED@@ED@@@FCDCACBADEHA@A@ADK@EF@@@@@@@B@B@@@BBHEHEDAFBFAHGDAHBD@@AJC@@@ABA@@@@BA@@@@@A@AA@@C@@@A@A@AB@@@@@@A@@B@@@@A@E@E@AA@A@E@AAAA@@AA@A@CB@A@@A@@@A@A@ADA@@@@@@@@@A@@@@B@@A@@@@B@@A@@D@BA@@@AB@@AA@@A@ABABA@AB@@@@@@@BA@ABE@@@@AA@ABA@A@@@AB@@@@A@A@G@A@@BA@@@@BA@AB@@A@A@@B@@AB@@@@@B@@ABAA@@E@CAA@B@@@@B@@@@@@A@AA@B@@@@@@A@@@@@@@@@A@@@EBABA@@@A@CB@@@@A@AB@@@@ABA@@@A@@B@@A@@B@@@@@A@@AB@@A@A@@@@B@@@@@@A@@B@@@@A@AB@@ABB@B@@@B@AB@@@@@@@@A@@@@@@@@B@@@@@@A@@@A@@@@BA@AB@BA@@@@@@AA@AB@@ABA@@@C@@BA@AAA@CB@@CFA@A@CB@@@@AA@@A@C@A@@@AAA@A@@@AA@@@@@@A@@@C@@@AA@B@@A@@@@B@@@@@@@@@@@@@@@@A@@@@B@@EB@B@@@@AB@BAA@@A@AB@@@AA@@@@@@@A@@@@@@@A@@@@B@@@@@@AAA@@@@B@@A@C@A@A@C@A@@@@B@@@@@@@@@B@@AA@@@@@@A@@@@AA@@@A@A@A@A@@@@@A@A@CBC@@@AA@@ABAA@@A@AA@@A@B@@AA@@@C@@@E@@AA@@@@@A@A@C@ABA@AAA@ABA@@@@BEBA@ABEAA@A@CBA@AAA@@@A@@A@@CB@A@@A@A@@@@AA@@@@@@@A@@@@@@AA@AAAA@A@@A@A@C@A@CB@@A@@@GC@@A@@@@@AAABB@@HCBANIHBHEL@@CD@FJDDFADCFALBBCD@BD@HJFB@DBDADJ@FBPDBBCFE@@BAAODKH@DOAIDJFADKDC@QCA@M@A@A@EDOBCLQJMBBJFFAJMAICKDEAADE@KOECQGMPADOA@BABAD@BBFDDB@A@ABCDIBIDA@E@ABABEBED@BABE@CBABADABADAB@BEFCDAFAB@DEFCBABAB@D@FDDDBFB@@DDFDBDDD@@@BB@BB@@A@@@AB@@B@BBB@A@@@@@DB@@@@@@A@AB@@@B@@B@@AB@@B@@BB@@AB@@DB@@@@@@@B@@@@BBB@@BA@@BB@@@B@@@BB@@@B@B@@@@@@@B@@@@@@@B@@@BB@@@@@@B@@@B@@@@B@@@@BDD@@B@@@@BAB@@@@@@BB@BB@@@A@@BCB@@@@@BB@B@@@@BA@@B@@B@@BBB@@@@@@@BDB@@@B@@@@A@A@@@@B@@@@@B@@B@@BAB@@@@@AA@@@A@@@@BB@@@AB@@B@@BA@@@@BB@B@B@@@@@@@@BA@@@@BB@@B@@BB@@B@@@@BAB@@@@BB@@@@@@B@D@@@@@BB@@@@@B@@B@BB@@B@B@@@@B@@B@@@@@B@@@@B@@AB@@@@BB@@D@@@@BB@@@@@B@@B@@@@@@@B@@@@B@@@@@@BA@@B@B@@@@AA@A@@A@A@@BA@@@@@@@BD@@@@@@@BBBB@B@B@BB@@@@@@@B@B@@@@@@@@@@A@ABAA@@@B@@@@@@BBB@@B@B@@@@@@@D@@A@@B@B@@@@@@ABA@ABAB@@B@AB@@@@@B@@B@BB@@@@B@@BB@@@@@@B@@@@@B@@@@B@@@@@@B@@@@@@@@@B@@@@B@@@@@B@@B@@@@@@@D@B@@@B@@@@BB@@@@AB@@@@@@@B@@@@A@@@@@@BA@@BB@@B@@A@@B@@@B@@A@@B@@@B@@D@@@@@@B@@@@BB@B@@@BA@@BA@A@@BABAB@B@@@@@B@@@@@@@@BB@@@@B@@@BB@@BB@@@@@B@@@@@@@B@@B@DDBBBBA@@B@@BBB@B@BB@@@@@BB@B@B@@@B@@@@@@@@@BBFBD@@BDB@@@BB@@B@@@@@@AB@@@@@@BBB@B@B@BA@@@@BBB@D@B@@@@B@@@@BB@@@@@BB@B@@@B@@@B@B@@A@@@@B@BB@B@BB@DBD@@B@@BBB@@@B@B@@@BB@@BB@B@@BBD@DB@B@@@BBBB@DBB@@BB@B@B@@BD@@@BB@@@@ABA@ABA@@@@BB@BB@@@BBBBBBBBBA@FDJDNJDJRHLJAHFDTDXAHFRAHEHDH@DGXDF@DFFDJ@AFDBFAF@FFRDDALBHCHBDADBVKFKTELCRQHAFHLBHATFRA@@RFVS@@JRSVBZLPJJ@JPFDFFHAFHH@JLJDFzZ`N`LRDPPTGbBP@XCN[^FnEhWYXBZELDFAFEpOFABAHEPBPCHGrOL@BQF@ZDNEJBHCFCPM\\IJGHEJA@BBBDDLNLJNJHFHHJDLFXLDBPFlJFBXD`DXHPJNHlTXLDDLFVVLPPNFDPJRNFDJDfLDDhTdPfNfNNF@@"],encodeOffsets:[[125357,48487]]}},{type:"Feature",id:"152202",properties:{name:"阿尔山市",cp:[119.943575,47.17744],childNum:1},geometry:{type:"Polygon",coordinates:["@@CACCGAE@CBIAA@A@CAA@AA@@@@A@A@A@CA@@A@CB@BAB@@A@ABA@@AA@@@C@CDCB@@A@A@@@ABA@AAA@A@A@@BA@A@@B@@@B@B@@@@A@C@A@@B@@@B@@@@@B@@@@@B@@@@A@A@CBGDA@A@AAAAA@A@@@A@ABA@EB@@A@A@E@A@@@@BABCAAB@@@@@@@B@BB@ABEB@@@B@@CDAB@BA@@@@BA@@AA@AA@@C@@@CA@@@@AB@@@@AB@BA@CA@@E@C@@@ABC@A@@@@BA@ABA@@@A@A@C@CAGAC@A@@B@@AB@@B@@@A@@@@@A@@@A@@AA@A@@@A@@B@D@@@@@D@@A@AB@@@@A@@@A@@@@B@@A@@@@@@B@@@@@B@@@@@B@@B@@@@@@BA@@@A@@@A@@@B@A@@@@@A@@@AB@@@@@@@@A@@B@@A@@@A@@BCBA@@@@AA@@B@@@@@@A@ABA@AB@@B@AB@@A@A@A@@B@BA@A@@B@D@@@@@B@@@@A@A@@B@@@@CBABA@A@@@@@AB@BA@@@A@@@@B@@@@@@AB@@@AA@A@@@A@@BC@E@A@@@@B@@@@A@A@@@C@A@@@@@@BA@@@@@@@@A@@CBAB@@@@@AA@ABA@@@@A@@ABABA@AA@@@@A@@BA@@@A@@@A@@BA@@A@@A@@@AACBAA@@AB@@A@AA@@@@@@C@@@AAA@AA@@C@@@A@@@@@A@ABC@@@C@@@CAA@@@CBABAB@BA@C@@@A@@BA@A@@@@@A@@@@B@@@@A@@@@@BB@@@@A@@@@@@@A@@@@@ABA@@@@@@@@@A@A@@@@@C@@BA@A@@@@@A@@@@B@@A@@BA@@@A@A@@@A@AB@@C@@@@@A@CBAB@BA@@B@@A@C@AB@@A@@@@BA@@@A@@B@@@@A@@@@B@@@B@@@@@@@@A@A@@@BB@@A@@@EDABCA@@@@@BA@AA@@AB@@@@@@A@@@A@@BAAABAA@@@BA@C@I@@@ABCBABA@ABA@A@A@C@C@A@EDEBCBABCDA@ABADAH@BAFGJAB@H@BAD@DEJABABCBCBAB@BADABA@CBC@A@EBABA@@@EB@B@B@@A@@B@@@B@@AB@B@@@B@BA@@@ABA@@BABA@@@@BA@@@CB@@A@@@CBAD@@ABC@@@BB@@@@@@@@@BC@C@A@@BABA@A@@@A@@B@@@BA@@@@@A@@@EAC@@@A@@@@@B@@B@@@@A@A@A@C@@BA@@@AB@BA@A@@BABCBA@ABA@C@A@A@AAA@A@@AA@@AA@AAAA@@A@A@A@CAA@A@@BABCB@@A@@AA@@@AB@@@@A@A@AA@A@C@@BA@@AAA@@@@B@@@@A@ACA@A@C@C@C@A@@@A@A@@A@@@@AA@@@AABC@A@A@A@@BA@A@@@A@@B@@@BA@@@A@A@@@@@@@BBA@@@A@A@A@@AAA@AA@A@EAQDT]DKv`T@PWBYFUTQLIN]`UTK^HJGLBNPHPLMXWhGP[pKPALQV_dafCDEZAF@@A@@@@@@@A@@@@@A@@B@@A@@@@B@@@@A@@@@@@@A@@@@@@@@B@@@@@@A@@@@@@@@B@@A@@@@@@@AB@@@@@@AA@@@BA@@@@@@@A@@@A@@B@@A@@B@@A@@@C@@@@@@@@@A@@@@@@@@@A@A@@@@B@@@@@@@@@B@@@@@@@@AB@@@@@@@B@@A@@@A@@@@@@B@@A@A@@@ABA@A@A@@@@@A@@BA@@@A@@BA@@@@@@@@@@B@@@B@@@B@@A@@@A@@@@@@@@@A@@@@@@@@B@@@@@@A@@@@@@BA@@@@@@A@@@@A@@B@@AA@@@B@@A@@@@@@@@@A@AB@B@@@@@@@@@@@B@@A@@@@@@@A@@@@@@@AB@@@@@@@B@@@@@@@@@B@@A@@@@@@@A@@@@B@@@@@@A@@@@@@@@@@@@@A@@@@BA@@@@@@@@@@@A@@B@@@@@B@@@B@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@B@@@@@@A@@@@@@B@@@@@@@@@@@@AB@@@AA@@@@@@@@B@@@@@@A@@@@@@@B@@B@@@@@@@@@@A@@@@@AB@@@@@@@@A@@@@@A@@@@BA@@@@@A@A@@@A@@@@@@@@B@@@@@@@@@@A@@@@B@@@@@@@BB@@@@@A@@@@B@@A@@@@@@@@@@@@@@B@@@@@@@@A@@A@@@@A@@@@@@@@B@@@@@@@@@BA@@@@@@@@@A@@@@@@@A@@@@@A@@@A@@@@@@@@@@@@B@@@@@@C@C@@@@@@@@@@@@B@@@@@@@@@BB@@@A@@@@@@B@@@B@@@@A@@A@@@@AB@A@@AB@@@@@@@@@B@@@@A@@@A@AA@@@BABA@@@@B@@@@@@A@@@@@@@BB@@@@A@A@@@@A@BA@@@@@@@@@@BA@@@A@@@@@A@@@@@A@@@A@A@@@@B@@A@@@A@@@A@@@@@A@C@@@@@A@C@@@@@A@@@@B@@@@A@@@@@@@A@@@C@@A@BA@@@@@AA@@@@A@@@@@@@A@@@@@A@@@@@A@@@C@@@CB@@A@@@@AA@@@@@AACBC@AA@B@@@@@@@@@@@@@@A@@A@@@@@@AA@@@@@@@@A@@@@@A@@@@@A@AB@@@A@@A@@@@@A@@@@@AA@@@@A@@@@@A@@@@@@@A@@@@@@@@B@@A@@@@@@@AB@@A@@@A@@@A@@BA@A@A@A@A@A@A@A@@@A@@BA@@B@@@@AB@@@B@@@BA@@BABA@@@A@@@A@@@A@@BAB@@@@@BB@@@B@B@@@@@B@@@B@B@@@B@@@B@@@@@@@BBA@@B@@@@A@@@AB@@@@@B@@@@@@B@@@@B@@@@@@@B@@@@@@A@@@@@A@@@BB@@@@B@@@@B@@@@@B@@@@@BB@@@B@@@BB@@@@@@@@BBA@@@@@@B@@@B@@@@B@@@@@@@@B@@@@@B@B@@@@@@A@@BA@@@AB@@@@A@@@@@A@@@@@BC@@@@@AA@@@AB@@@@@@@@@@BB@@@@A@@@ABA@C@@@A@@B@@@BA@@@@@AB@@@@@@@B@@@@B@@@B@@@@B@@B@@@BA@@@@@@@@@@B@@@B@@@@@B@@A@@A@@AA@@@@AB@@@@@B@@@@@BB@@BB@@@@BB@@@AB@@@@A@@@@@@B@@@B@@@@@@@@B@@A@@@@BB@@@@B@@@@@B@@@@@@@B@@B@@@A@@B@@A@@@@@@@@BA@@A@@@@@@@AA@@@@AA@@@A@@@@@A@@@@@@B@B@@A@@@@@A@@@@@@B@@@@@@@@@@B@@@B@B@@@@@B@@@B@B@@B@@B@@@@@@B@@@@A@@@@@A@@@A@@@@@AB@@@@@@A@@@@A@@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@@@@@B@@@B@@@@AB@B@@@@@@BB@@@@B@B@@@@@B@@@@@@BA@@@A@@@@BA@@B@@@BB@B@@@@B@@@@A@@@@@A@@@A@@@@@@B@@@@BB@@B@@@BB@@@@@@BB@@@@@@@B@@@@AB@@A@@@@@@AA@@A@@@@@@A@A@@@@@@@@B@@@@@@@BA@@@A@@@@@A@@A@@A@@@@@@@AB@@@@@@@A@@@@@A@@@BA@@@@@A@@@A@@@@@@@A@@@A@@@A@@@@@BB@@B@@@@B@@A@@@@@A@ABAA@@A@@@@A@@@@@AA@@@A@@@A@@@A@ABA@@@A@@B@@@@B@@BB@@@BB@@@@@B@@@@@@@BA@@@@@@B@@@@B@@@B@@@A@@B@@AAA@@@AA@@A@@@@A@@@@@@@AA@AA@@C@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@BB@@@B@@AD@@@@@@ABA@@@@@AD@@@@@B@@B@@@@AB@@A@@@@B@@@@B@@@@@@BB@@B@@@@AB@@@A@@AA@@@@@B@@@B@@@B@@@@@@B@@@@@B@@@@@@A@@@A@@@AB@@@@@B@@B@@BB@@@@B@@A@@@A@AA@@@AA@@@A@@@@B@B@@@@@BB@B@@@@B@@@@@B@@A@@AA@AAAA@@@@A@@@@B@@@B@@@B@BA@@BA@A@A@A@@BA@@@A@A@@@A@@@A@A@AB@@@@B@@@BBB@@@@BA@@@A@A@A@@@A@@@@A@@@@A@A@@@@B@@@BBBA@@@ABA@@@@AA@@A@@@@AA@AA@@@A@@@@BA@@BA@@@A@@A@@@A@@@AA@@@@@CBA@@@@@@@@B@@@@@@@@@B@@@@A@@@@@A@@A@A@@A@@@A@@@@@@B@@@B@B@@@@@@A@@@@@AB@@@@AB@@@@A@@@AAA@@@A@@@A@AB@@@@BB@@B@B@@@@@@@@B@@A@@@@B@@B@@@BB@@A@@@@@A@@@@B@@@@@@BB@@@@B@BB@@@@@@@@B@DChDJBJ@LNBBdBBEPFDJLCNATDPHVARFLHPD@FJDCFLDEJNFBJN@JDBHHFCHHDDFFJHHJAJFDCHDH@BCHHHANBHN`B^JVBJJRDZBRNRN\\NTVL@FPITJ^nTbXR^RJTFbXPZJDRARBJJR@dN\\S^CXMXILINBB@^TT@hJdPBBbr@ABABABABA@A@@@@@AB@@A@@BABBB@DD@BBBFBJ@D@D@BBB@DBBBD@@@JBD@D@FBDDBBDBDBBBDBB@BABAD@D@D@FAD@FBFBH@FBD@BBHFB@@BBD@DBBHFAD@BDDBDDDB@BBFADADEBADCBCFA@@D@B@BB@AFBNBFA@@@DBFBFJFBB@FHDL@D@DHDBXXD@LEPCHQDCBA@GHEHCBED@HBFCLALBJ@FEBCDCCECAIABAAEBCDEBGB@RFNAFEJBF@E@EAf¸gÄkbIA}D@B@BBHAB@B@DC@AD@B@@@DBB@B@B@F@DABAF@DBDAB@DBB@BDB@@BFBB@F@BBHAB@BDJBH@F@B@B@BAB@@@DCBA@A@E@@AA@AA@@AB@BAB@FAFCBAB@@@@A@CGAGC@@AAGG@ACA@EAECCGM@CFCBEBA@ABABCBCB@DE¨fykNGD@D@FADABC@ADADABADC@C@ADCBABCDC@CAAAC@EACEACAAA@CBCBEBADADCBC@ABAC@A@CAA@CCCCAACAA@A@GBG@E@A@CAGC@A@CDCBCBAHG@A@A@@AAAAGCAAC@A@A@A@EBA@K@@@AAEE@AAAABC@A@C@E@AAA@AA@AA@@ACAAAE@@E@@@@@ACAAA@AG@AACAAACA@@C@C@A@A@@@CEA@AAC@KAGA@@A@EE@@AA@A@AAA@A@ACCECA@@A@A@A@A@@@@BADEBABABC@C@AAACA@CA@@A@ABC@A@@@A@AAC@A@@@ABA@@BAAABC@CBCBA@A@ABAB@DAB@@AA@A@AAAAAA@@@ABAFADABAB@BBBB@BBBBDB@@BH@BAB@DB@BBBBBB@B@D@D@B@DABABABA@E@C@CBA@C@@B@B@@@BABBF@B@BA@@B@D@DBBAFCD@BABAB@BAB@@@B@B@@AB@B@@@@@B@@@B@@@@@B@@@B@BAD@JAB@DADBBAD@B@B@BADAB@D@D@D@B@BADA@@BA@AB@F@@@@AB@@@B@DBB@BAB@B@DAB@BA@A@@@AD@@@@A@AB@B@@A@AB@@@@C@@DAAC@ABCBAF@HAB@@AB@BAD@D@HGB@BAB@@ABA@@D@BABE@@B@B@@A@@B@@@BA@A@A@@B@@A@@@A@@@A@@@A@C@@EC@@BC@ADABAB@@AD@D@BA@A@CB@B@DADCFABCB@BAF@D@BA@@BB@AB@B@FA@@B@B@D@@A@@@@@A@A@@F@BAB@@A@C@AB@D@DA@@@A@@B@DA@A@@AA@@B@BABBFAB@D@B@D@J@B@DDF@@@B@B@FAB@BBBBB@BBB@D@D@D@D@B@BBBBD@F@DAD@B@BA@A@@F@@@BA@A@CB@BAB@BCD@B@BA@ABCBABABABA@A@AD@B@DC@AB@@@B@BAB@@@B@@@@@B@DADCD@DA@AB@@ABA@@B@BB@AB@BAB@D@BA@AB@B@@A@@B@@A@@@ADBBA@@AA@A@@BA@@@B@@B@BAB@@@AAA@B@D@BA@@@A@@@@@AA@@@B@DAD@BBB@@A@ABB@@@@B@@ABEA@@@BA@A@@D@D@AABAB@B@B@BAD@D@@AB@B@@BB@B@@AAA@@@@BBD@@@A@@BDBDAHAF@@ABABBD@D@D@@BDBDBDBBDDDH@@@BFBDB@BDBBBB@@@BDD@@B@B@BA@@B@@@BBDB@@@BA@@BB@BB@BB@B@B@@B@B@@B@DA@@BDB@@@B@B@@B@BBBB@B@@BAB@@BBD@DAFBBBB@DB@@FDBB@@AB@@BBBBBBB@BA@ABA@@B@B@B@BBBFB@B@@B@BBBDBB@@AB@@@BBBBBBD@BBB@FAB@@@BBBBJ@B@BDD@B@BAB@B@BBB@B@BAB@B@BBB@BAB@D@BBDAD@BBD@BBBBDB@@D@B@BABA@@DA@@D@D@@A@@@A@A@AB@F@@@BAB@B@@@D@B@@@@AB@B@H@B@BA@A@@FAB@D@D@BBFAH@B@DADB@@BBD@B@B@DA@@D@@ABA@@B@BBF@F@FBJBD@B@@A@A@@B@HCDAB@DA@@B@@BB@@@BD@@B@@@BAA@@AB@B@@@B@@@B@@@FCBABA@@@ABAD@BAB@DBB@@ADAB@BAB@B@@@BCBABAD@BB@@BABABA@@B@B@BBB@BADAB@DAB@@@@ABAB@B@H@BA@@JCB@B@@@BA@@@A@@@@B@D@B@JB@@BBBBBDBBB@B@BA@@BA@AB@@AB@BBRDHBLBFBBB@@@BA@@@@BBB@@HAB@BAB@B@HBB@BAB@B@BD@@@BB@DD@B@@B@DAB@B@JB@BD@B@@@@D@@@B@@@@BB@BBBD@D@BB@@D@B@D@B@D@BA@@BA@AB@B@B@@@@@FAF@B@DA@@B@BB@@@@@@CBB@@@BBD@B@D@@BB@@@B@B@F@F@F@@@B@@B@@@@@B@@@B@B@@FDBB@@D@F@B@@@B@B@@B@@@B@@AB@@@BB@DBB@@@@B@@A@A@@B@@BB@@BBD@B@HBB@D@BAD@B@@BB@BBHBDB@@@B@@DBBBB@AAAAAAAA@A@@AAA@@A@@B@BAB@BA@@@@AA@@C@@AA@A@A@@AA@CAA@AA@A@@@ACAC@AA@@@AAA@@AA@@A@A@@@A@AA@@@AC@CAA@@A@AAAA@@@@@@BA@A@@@A@@@A@A@@A@@@@AA@@@@@A@@A@C@A@AA@@@@ABA@A@A@AA@@@@@@BA@@@@@@@AA@@A@@CA@AC@EAAA@@@@@@@@A@@@A@A@A@@A@@@@AAA@A@AA@@@AB@AAAACCA@@@@A@@@@@@@A@@@@AA@@AA@@A@@@@@AA@@@@@@@@@A@@@@@ABABA@AB@B@@AB@@A@@@AAA@@@@@A@@@@C@@@@A@@@AB@@@@A@@@AB@@@@AA@@AB@@A@@@@B@@@@@@A@@@@@@BA@@@@AA@@@@@A@@@A@C@@@@@@@AA@@@@@A@@@@@@A@@@@@@@@@A@@@@A@@@@@@A@@@@@A@@@@A@@AA@@@@@AAA@@@@A@@@@BAA@@@BABAB@BA@@@@@@@A@AB@@@@C@@@@@@@A@AA@AA@@@@@@@A@@BBBAB@@@@@@@@@@@@A@A@@@@@@AAA@A@A@AA@A@@@@@@AC@@@@@@B@@AB@B@@@@BBB@@@@@A@AB@@A@@@@A@@@@@@A@@@@@@@AA@@@@@A@@A@@C@@@AA@@@@BA@@@A@@A@@@@@A@@@@A@@A@A@@@AAA@@@@A@@@@AA@@@@C@A@@@@@@@AA@@@@BA@A@@A@@@AA@@@AA@@A@@B@@A@@@@@@A@A@A@@A@@B@@AA@@@BA@@A@@A@@B@@@B@@B@@@@BA@AA@@@@A@@@@@A@@B@B@@@@@@A@@CA@A@@@@@@AA@AA@@@@AB@@A@@A@A@@A@@@@DA@AB@@@A@@AAA@@@@@@BA@A@@A@@@CC@A@@A@@@@@@A@@@A@@@@A@@A@@@A@@@@@@@A@@@@@@@A@A@@AA@@A@@@A@@AB@@AA@AA@@@@@A@@@@@@CA@@BA@@AA@@@AA@@BA@@@@A@@BAB@@@@@@@CA@@@@B@A@AAA@@@BA@@B@@@AAA@@A@@CCACECCC@@EACACC@E@CBABADAFE@CBABEDCFE@ABABCBABCBADAF@BA@AFCFABABAF@B@JCJADCBAB@A@CCAE@ABCBA@AA@ED@@@@A@E@@BA@CBA@@@A@@@EAA@EAAAA@A@A@ABEBA@AAACACAAC@CBEBCBCBA@ABGBKBCBA@A@A@CB@@A@GAEAAAA@AAE@EACBIJ@@A@E@AA@A@ACACAA@CCAA@AAACACAE@CAA@CBAAA@ACCA@CAA@@EBEDE@ABADA@ADCFA@A@AAA@E@CAE@EBIAABA@AB@D@@ABAB@DABE@GBE@EAEBA@C@C@A@EC@@@BBB@@@FCDEDGDC@@AA@AA@@ADA@EDC@@DDDDDDDBB@B@BEBADCB@@CDCBC@AB@B@BA@EBC@@@A@A@A@ABABA@AAAAA@AB@@BD@BABABED@BCBABA@AACAA@CBCBABCBAF@DABABC@CAABA@@B@B@BDF@@BD@B@BA@@BA@CBA@A@@BABABA@@BCB@B@@@BBB@B@B@BA@ABA@A@IFCBC@@@A@CAG@A@C@ABG@ABA@@@CAE@C@@@CAA@AAAAA@A@@@KDCDC@A@AB@B@BA@@@C@AB@@AB@@@BA@@@AAA@CACAG@GCA@C@ABA@@BAB@@EBA@A@@@@B@BCBE@CB@@CAC@GBAB@@@B@@A@@@CAAAGEKEC@AA@@A@@@AB@@A@@@AAAAAA@A@A@@AA@@A@AAAA@@@A@AA@EAAAAA@AA@A@A@@@ABC@C@A@C@A@AAA@A@@@ACA@@@@AAAA@ABC@C@AAK@A@AAA@@A@A@AA@A@CBGBA@C@C@A@CCAAA@@AAAAAA@@A@C@A@ADA@CAAA@@@AAA@CAAEAAB@@ADC@@@A@AAA@@AAC@E@CAAAAAAA@A@A@AC@AA@@AA@@@C@@@AC@@AAM@A@ABABA@@@@AAAACECAEA@@@AA@@A@@DEBA@A@@@@A@@@A@CA@@EAG@CAAAEEA@CA@@ACA@AAE@C@A@AAA@EEAAOKAC@@AAA@@@A@CBC@A@K@@@CB@BC@A@C@AAC@@ACBAACAAAAAAA@@@A@@BC@A@A@AAA@A@@ACCEA@CCA@@A@A@KAA@AA@@AAA@AAC@@CAE@C@AA@@CCCAKEAA@@@CAA@AA@A@@AB@D@F@L@HABA@@BA@CBCDAFIBADADAFABADCBABAFADAFADADAB@@AB@B@@@D@D@BADAFA@ABA@A@CAA@C@@@ABADADAFGB@BAD@@@@GA@@AECAC@@@ABAB@BABC@A@A@@AC@@@A@@@ABABA@ABEBC@A@@BC@A@@AA@@C@AAAAA@@@AABA@C@@@ACCAE@ABABABAAACECCECC@A@EA@@CC@AAA@@B@BCBA@CBA@@AAAAA@AC@A@A@E@C@CB@@ABA@@FA@@@A@@AAA@@ABABA@A@@BABA@C@AACFEBADADA@@BE@@BA@@B@B@B@DADCDADEBABA@ADADCDC@ABA@@@AA@@@ICAA@AA@AAC@CC@@A@C@C@A@@@AD@BA@A@A@A@CAA@@@C@EA@@A@@@A@@@@@C@@@@@AA@@A@@@A@A@A@A@@@@@A@A@@BA@@@A@@A@@A@A@A@@@A@A@A@AA@@@@@A@@A@AA@@@@A@@@A@AAA@@@C@@@@@@@@A@A@@@A@@@@A@@@A@@AA@@@A@A@@@@AA@AB@@@@AA@AA@G@IBG@EBCBQBKBE@EBG@IAA@OCI@GAICCAC@G@A@CAEAAAA@C@GBA@EAAAA@@CAAA@@@AAA@A@A@ABC@EB@@ABA@AAE@C@A@A@ABCBABC@AB@BAB@BA@@@A@CACAE@@@A@AAA@C@I@A@EBA@@AA@CEAA@@IDCBCBA@A@@@AAAAAAAAEAAAE@C@M@@@A@AGA@@@AAA@C@A@CBC@AAC@ABAB@@C@C@C@A@@AAC@@CAEAAAAA@@@@@@ABBB@B@@@B@@KJEFAB@B@DAB@@@@BB@DA@@BCB@BAF@BA@@BC@CBqRGDEBA@ECC@E@KBK@A@C@@BC@@@@BABQLEDABA@EFADADABED@@IBODA@ABA@CFCDABABA@CBG@A@CBUHCBKDEBOFOFCB]DG@A@CB"],encodeOffsets:[[123272,47876]]}},{type:"Feature",id:"152221",properties:{name:"科尔沁右翼前旗",cp:[121.952621,46.079833],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@B@@@B@DAB@@AF@B@@@@@FCB@PBBCNORHFDLPF@BCFBLCJDNBBIEEAINARIDKPAFCB@B@N@B@RDD@LCBCIEJCPB@CLGPCBB@AF@DEAAOCEAI@BCAC@CEAGIC@@ADCAABKDEBCCEIC@EDC@@FKAGJGBMDA@GA@BABB@@@@B@@@HD@@B@@@DAB@D@B@B@@@@BBBBBB@@B@@@@B@@@@@@@B@@B@@B@B@@@@BDA@@@BB@@@B@BBB@DAB@B@FBBAB@FA@A@@B@BAB@BBB@BAD@B@B@@@@@B@@BF@@@D@@@B@@BA@B@@@BBB@@@BBBA@@BB@@D@DAB@B@@@@@B@B@B@B@@@B@@B@@B@@@@@@@BB@@@A@@@@@@@@@A@@B@D@B@B@D@B@@@@A@@B@BB@@@@@@@A@@B@@@@@@@B@@@@@@@B@@B@@BAB@@@BB@ABA@@@@@AFA@@@A@@B@@@@@@@@@@@@@@@@@@A@@B@@@@ABB@@D@@@B@@@@@@@BB@@B@B@BB@@B@D@B@@@BB@@@@DAB@B@DE@@DAB@BBB@@AD@@@B@BA@@BAB@@B@@@@B@@ABAB@@A@@B@@@B@@@@@@@@A@@@@@@B@@@@@@@@@BAA@@@A@A@BA@@BAB@@@@@@AB@@@@@@@@A@@B@B@@@BA@@@B@@@@@AB@@@@AB@@@B@BA@@@@BAB@@@@@DAB@@@B@BAFA@@B@@@@@@@@@B@@@@@@@@ABBB@@@@@@@@A@@A@B@DBF@@@BBBA@@@A@@@@BA@@@AB@B@@@BAB@@A@@B@@AB@H@B@B@@@@@BA@@B@B@BAB@@B@@F@BAB@@A@@@@@@BAB@BABAB@@@BB@@BA@@B@@A@CB@@@@A@@B@@@@A@@B@@@@@@@@@B@BCB@B@@@B@@@@BDAB@B@@BB@BB@B@F@BBBF@F@B@@@@@@AB@@@@@@@BAB@B@@@D@@@BBB@@@@@B@@A@@B@BA@@D@BI@@ACBGHCBGAEBEFCFGAG@A@@@A@A@@@@@@FEL@BCB@B@FGBCDADBDC@E@@FC@@FCBADAHBF@D@DGTCFBZQCEBA@A@@@AACCC@A@E@IEABCACDCCG@@BAHCFG@@AABCAEAA@AACBC@A@AAAAA@A@@GA@AA@@@CC@@AKBC@@FAJC@A@@DE@CCA@A@@@@BAFAJA@ABC@@@A@ABA@IDC@CBE@C@EAGBAAC@CBAA@ACBAB@BCBC@C@@BABABABCBABABADADA@CBAB@FCBAHABA@@@@BA@AAAAA@AAA@A@CBADAFCD@FAD@B@D@BAHCFEBADADCD@BADA@@@A@AC@AABAFCFCDCDA@C@A@AAAECA@@A@AB@@@F@@@DA@@BABABADADCBA@CB@DBD@B@D@DBBBD@B@F@B@BAD@B@BABABABAFCDABAB@@ABABC@A@E@ADCBA@@@AFAB@D@AE@A@ABA@@@@BCB@B@DAB@@A@EA@@ADEBAB@BCB@B@B@DBD@D@DAB@DBBABA@@D@D@D@@@FAJ@@@DB@@@B@B@BAB@@BB@BB@@BDBB@B@F@HAFAFAHAJ@J@FEFCDEDCHENIDAJEHEBARKBCAG@C@A@AJEDEBEBCBCDA@@FADBBBFBDBBDDDD@HBD@HAFADAHAD@DBBD@BRAJ@FAF@DDTCH@JFFFDDDFDB@@@@@@JAHDF@BBH@@@BAFADEJGLAHAH@DC@A@CXKF@TEbIWTCBAZEAC@@A@AAGGBAB@B@@@FCDANCLA^BLDH@NDH@TDRD`EjBLCTGNEPCjE@DHCFAHCF@HAFAF@N@D@DBB@B@DADABAD@B@D@BBBB@@@BB@BB@BB@D@DB@AH@D@FAF@B@DABAB@F@DAD@DAB@B@HB@BB@@@BAD@@ABAB@DA@@BAB@FAD@B@B@B@D@B@D@BABABA@@B@BB@@B@B@@@BD@@BB@BB@B@@@B@B@BA@@DB@BFBBBB@B@@@DAB@@@B@B@DB@BB@@@@@B@@@DA@@D@B@DDBBDBBBB@F@N@B@BBB@BBB@@BB@HA@BB@D@BBDDDBFDBB@BD@@@BB@AD@B@DBDBBBBBB@@BFBDB@@@DBBDBDB@@B@B@D@DAD@BBD@BFBBBBDB@@BBJFB@ZRNFDF@@B@@BBBCD@BABCBCBABEDBBCBFFEFA@AAKBCAEFBBABEACFCBBDBBAFB@AFB@@FA@@@AFA@IHD@DBA@A@A@GC@B@DFHLABF@@@@@CD@BAAEB@@BHB@AJ@@BJDABEA@BD@@DFD\\Q@@B@@BBJF@@IDB@FH@DCLFB@HBHA@@BBHCF@JEFDFCBBBA@@BCF@@CP@@BB@@B@BBBBDD@BBB@@B@B@@DADA@@B@FCDBB@@@BABAB@A@JEB@AGBA@@@@CC@BBBCDAGAED@AGCADCAA@@@ABABABBBBBB@@BA@@@AHQDCJGHCTBPQRm@@HAbCDAHEHEHEA@A@A@DEBB@@BMA@@CB@@E@Q@E@CA@AACEA@KQCHAAA@A@@@FIC@@@DEA@HGAAA@EBAAFAAADEIK@@E@A@@@E@CBC@A@IHGDKDEFEBGD@@IDABABA@CB@@A@@@A@@AECCCCCA@AAEEA@EGEEAAAA@AACA@AA@CAA@AAAAABCDILQJQV_DG@@BA@C@C@ABGDMDWJmDWFUBIHsDW@ICAIAE@CBA@C@IA_GwGkGgESEGEEA]IUEMESIKEECIGOE@B@H@J@DFHDDPVJLBJ@B@B@H@@@D@B@F@B@FBD@H@D@B@B@D@D@B@@@@CACBI@SBE@GBG@E@I@GBGBQDIBKBE@E@KBC@GDQFIDUHQHEDGBCBEDA@AACCB@B@AAB@@ABBHCBAB@@AB@@@AAAAAAA@@@MCEAKEA@CAC@CAC@C@GBMBEBKBEBE@E@EBEBC@ABC@ABEBC@@@A@C@QECAA@s@M@i@A@Q@A@I@C@CAA@A@CAEAACAACEACAEAA@C@CDI@A@C@@ABEDC@G@E@EAQEKBQAGCMEKCKCEBCFG@GDCBGBEA@BGBI@@CEAC@CAAAE@CAA@MBE@G@EBGBEBOFCBGBKJGBGFEFAF@JDDCBE@EBC@G@A@ABA@E@C@EBCAABK@ABADCR@@CACEECAA[AG@I@S@E@EBKEKJECADEC@HAHBD@F@BFABDBDFFBD@@@@DD@@BB@@@@@BBB@@@BFD@B@@BDAB@@@D@B@D@@BBB@@@ADBB@@@@@BAD@B@@@BADABABCH@B@B@B@@ABABA@@@AB@@@BBDA@@BSD@DAB@BA@@@A@CBA@A@@@A@A@@@AB@@@@CB@AEAAAA@ECIEEEEAMGECECEA@@C@C@CB@DCDGBG@@@@@AAAB@B@DABGCAAA@CDABCBCBABA@CAA@A@CBA@A@AAE@ABABCDABCBABA@AB@BEH@BABA@A@A@AA@@AAA@C@C@@BCBEFABABA@@@A@@AECA@A@A@A@A@A@GAAAA@EAA@@@AAC@A@A@EAAA@@A@CBA@@@AAA@A@E@E@@@@@AC@AAAAAAA@@@AB@BC@AB@@A@A@A@A@@BABA@AB@B@@@DABA@@@AA@AACCAC@A@A@A@@BCAC@AAACECEGGEGAACGCCAA@A@@@ADAB@BA@@@@D@DBD@BBLBP@F@FCH@HEDADAHB@AB@@CAC@EFCDCDCBCBABA@A@@@@KGKE_O@@BA@@DGFGBABA@@F@BABA@@@@AA@@@A@@B@@@B@B@B@BABBB@@@B@@@B@B@@@BA@CBAB@@AA@@A@A@A@ABA@@BAF@BAB@BAD@B@B@@@BAB@@ABCB@@A@A@A@@@CBAB@@ABABA@@DADB@@BB@@BBB@B@BAB@@ACA@@@A@@@A@@B@D@B@BAFABAB@DAB@@A@@BA@ABA@C@AAA@A@AB@@A@A@A@CB@@ABA@@@@DC@@@A@@BA@@B@@ABA@@@A@A@C@@@AAC@A@@BA@@BA@@@A@A@@@ABAB@@@@ABA@A@@B@BAFA@@BAHB@ABA@A@AACCA@A@@BAB@H@B@@@BABABA@@@A@A@A@@@@@A@@DABA@@B@AC@AA@@A@@@@@A@@BA@@BA@@@@F@D@B@B@B@@@B@@AB@@A@AB@@AF@BA@A@@@ABAD@@@DADA@C@A@@@@D@B@@AAAAAAABADAB@BAB@B@@C@A@A@ABADABBB@D@@AB@@@BA@@@@@A@@@A@@@AA@AA@@D@BA@@@AB@D@@@BAB@D@FA@@HABAFA@@DAB@B@F@B@J@B@BA@A@ABC@ADBB@B@B@BB@@@@DBBBDB@@@@HA@C@ABABABAF@R@B@DADA@ADADADADAJCDA@@@@BADC@AB@@ABABABABA@ABAB@FAD@B@@C@@AC@@FCFADAFABADCBBFBD@B@D@B@B@D@D@F@BAFACAEACACABA@@A@QGECC@G@E@@@A@@@EAA@@AAA@A@AAE@A@C@AAAAAAA@A@C@AAAAACEAAAA@A@E@A@AAA@E@AA@G@C@C@CAAA@@AA@A@@@E@AAA@@AAAAG@@@@AACA@@AAAACA@AAA@@@CB@@@@A@A@CA@@ECEAA@C@C@CBA@A@A@A@AAAA@C@@@@EAE@@@A@A@A@A@CBA@AAA@@B@BA@ABCB@@EAA@A@@BA@AB@@@B@B@@@@ABE@@@@@@F@@@BA@@@CBC@@@@BABAD@B@DIB@@B@DB@@BDB@BB@BA@@BA@@B@B@@AB@@A@EA@BABA@A@A@I@CBC@A@I@A@AAABAB@@CBABA@ABABA@@@@@@BB@BB@B@@@B@B@@AF@@@BA@A@@BAB@@@BBBBB@@@@ABAB@FIAA@AAA@AAA@@@AA@AAA@@@C@AAAAAAAAAAA@BAB@@AB@BA@A@CBABA@A@CAA@A@A@@BCBCBC@ABA@@@@@CAABA@AB@B@@BBAB@BBB@@@BA@A@A@A@A@GAA@A@A@ABA@E@A@@D@BK@M@CAA@A@AD@FC@CF@BBF@B@BAD@BA@A@CBGBA@@B@BA@@@ACAAOBQBA@@AA@@AB@@A@@@A@A@A@@A@AAE@K@A@A@CAC@A@AB@@AA@AA@A@@@C@ABA@CBEB@@@BEDAB@@@@AD@DA@SJ@@CFAD@@B@AFAB@@A@@BCA@@K@G@A@ABA@CAEA@@CBA@CA@@ABCB@BA@A@A@@@@@@ABEBA@@ACAAA@AAA@A@A@AAA@ACAAA@@@AAA@A@A@A@CCGBK@@A@A@A@@@A@AAEA@EAI@CAA@A@A@A@AAAAA@A@@@CBA@A@AA@AAA@AAA@AAA@@@@@@@AB@@@B@B@BCB@BA@@BABE@AB@@@DAB@FBBBD@F@F@BB@@@A@@@ABA@AAC@@L@BA@@BA@CB@BABAB@BABCBABAB@BAD@BAB@@@@ABEBADA@A@@@@@AAAAAA@@A@AB@BC@AB@@C@A@ABA@@BA@@@A@@A@EAE@@A@@BA@@@AA@AAB@HA@@@AB@@ADA@AAA@AAAAAAAB@@@BA@@ACAAA@@A@@B@@@BBBB@BB@@@J@B@@@@AB@@AB@@A@@@@AAAA@@@ABA@AB@BADA@@@@@A@@BAA@AAAAAAA@@A@@@A@AA@BA@@@A@@AA@@AAECAC@AC@AAA@@A@@AA@@@@JEB@CE@@E@BABA@ABAB@@C@@@@CAHGBAA@A@@A@AHGBC@@C@A@@AA@G@@AA@A@@ACBA@AAA@@@AA@@AA@A@@AAA@@@EAA@AAAC@@AAAAAA@@AAE@@@AA@A@@@A@A@A@AAAACCACA@A@@@AA@C@AAAAAA@A@@@AE@@C@AA@@AACCAA@@@@A@@@AAC@A@@@@B@BA@@@@@AB@@B@@B@@@@@D@BA@A@ABAB@@@AAAA@A@@AAACACA@A@C@CAA@A@A@ABA@AB@BABCBA@A@@BAB@@EBA@CDCFCB@@@@@BD@@B@@CBABA@A@A@A@A@@@ABABA@ADGDABC@A@EAA@C@ABAB@B@B@B@@@DBB@F@H@BA@@@@@A@CAAAA@C@KBA@A@@@A@@@A@ABCBADCDCD@B@H@@@BDDBBBB@B@@CD@@EB@BCDA@A@EBA@C@GDABA@AB@D@BADMLABA@@@ABC@AAK@@AABC@E@C@CBG@ABC@EFAB@@C@C@A@A@ECCAC@C@C@C@A@A@I@C@A@A@@@C@CAA@KEGCEECC@A@ABCBC@ABE@E@A@AA@A@KCSCC@A@A@C@A@CEAAA@C@C@GA@@GACAC@@CA@@@A@@@A@@@A@A@A@A@EBABC@CBA@EDEDCBA@@@CAA@E@C@ABABABA@@AE@A@A@CCC@AAA@@@C@C@ADABEBA@A@A@ABA@A@A@@@A@@@A@@@@BAB@BCD@@A@CBE@A@GBC@@@@BA@A@AB@@ABCD@@@BADA@G@ABA@A@AAGA@@@@CCAA@@GDA@A@A@@DA@@@AAA@AA@@CAAAAAAAA@A@A@CB@@ABADABA@ABC@CDABC@EC@@A@EBABABA@EBABABA@@BABABABABABABAB@@A@@D@BAB@B@F@BBD@BBB@BAB@BAB@@@B@D@@ABC@A@@B@@@@@B@@@BA@AB@@@BAB@@E@GBA@ABCF@@BBB@@B@@@B@BBDB@@DBB@@@DBF@B@@ABC@A@@BCBABA@ABCBGBA@AAAAA@@@EBCBABCAE@AAA@@AA@@@CBCA@@@@AAAECCCAAACAA@@@C@CBGBE@CBCB@@E@CAA@A@ABCDAB@F@B@B@@A@CAA@A@A@ABEBA@AB@@CFAB@BBD@BAB@B@B@D@BAFABAB@D@@@BBB@B@@CDA@AD@B@DABIDAB@@@B@D@@BD@DCDCB@@@BBBBD@B@@@BAJABGDCFEDABADCDCBEBA@CAA@A@@BEBA@C@E@CACAAAC@ABC@A@A@AAA@@BA@ABA@IAAACAA@A@@BABA@ABG@A@ABAAC@@A@@AB@@CBE@ABABABG@C@A@A@A@A@AB@B@@@D@@CB@BAB@B@BAB@F@@@B@DADA@ABA@A@AAA@C@AA@@AAA@GFCBEBED@@AFABGACBQF@@CBADBBB@BBAB@B@DAB@@@BAB@D@H@D@DABAB@F@D@BBB@@@BGAA@@@CAACCAGAA@C@C@A@A@A@@@IDAACEAA@A@A@@@@@AA@AAE@GDA@AAA@A@C@E@C@A@A@EHCB@@QECEE@CABEFC@EFCBGBGDAFI@@@A@C@A@A@C@@ECCAAAAA@E@AG@@AAAA@E@GCCCCEBAF@BAHAB@BE@CBEFEDA@A@AAGAC@@DAAA@AACBCLEAAAAIGAA@G@@AAABC@EBE@A@AA@AAAAAAAAAE@C@AAACAAA@A@E@ECKG@AECAAA@CAC@ECA@AAA@AAC@EAA@E@AAGC@A@A@AAA@AA@C@A@CAA@A@@@C@A@G@G@A@IGCEC@A@ABE@C@E@CAA@CCAM@@C@AAKEEE@@A@GBA@A@ECIICCGG@@C@ACIACECCCAKCC@IAEBG@@C@AACACE@E@A@@@EAC@AAEACACBC@A@E@CBABA@@BC@G@KDA@AA@@AC@@AAC@C@AEAAABCAIFKAGBK@CEIAGCEDEACGMKCDGECGIBECAGMIEII@ECBEEIGACBKMAA@@AAA@A@E@A@A@CBADA@A@A@A@@@A@AA@@A@A@@@A@MBCB@@A@@@IEAAC@A@CAA@A@ACC@EBCBE@C@CACB@A@@A@BA@@@AEGCAAA@AEAC@EAKAIAEAEAEAC@A@C@Y@I@A@MAU@aCABEBGFMFADGHADGJILEBIFWPKBMBABaCG@GAUCUE@@uMC@wYXBBEDABADADADAD@DCF@BENAB@HAPAPCBKDEBEBUHIDMDmN_MSGGCCA[KCAOBUNOLEDCDABABEJKNABA@ABG@CBMBIBABA@AB@@A@ABA@@B@DABCF@B@@B@DFBB@@DBBB@BBBBBBB@BBB@B@@@@@@C@A@CBA@E@@@@@AF@DCFAD@BB@BB@B@@@BB@@BB@B@DBFB@@B@@F@B@@@BBBDBBB@B@DBB@B@D@D@@ABABEBABABAB@@B@@BBBBB@BBB@@AB@@ABA@A@@BA@@FA@@BG@ABA@AB@BABCBADADADADEH@B@BAB@@GDCDCB@BB@A@@FP@PLHD@B@@ABAB@@@B@BBDBB@B@B@@@BBB@@@B@@@BABEB@@@D@@CBAD@B@@@B@BABC@EBAB@B@BABA@CBA@CDCBABC@A@CDA@CAAAA@GA@@AAA@AAA@A@AACBA@ABABA@A@EEAAA@CAA@C@@@A@@B@B@BCHAD@@A@AAA@@@CBA@A@E@CA@@@@A@@BAF@@@BA@ABC@A@C@C@C@AAA@C@E@A@@@AA@AEAEAAAA@CBA@@A@@AA@@EAAACAACAAA@A@C@G@ABCBAB@@CBA@@@GCA@ABA@E@@@ABA@@@CA@@IBM@GB@@DBBB@B@D@D@D@BBB@BDHBD@B@@FFBDBDBBBBBB@@BB@@@BGBABC@ABA@C@AAC@AAA@AAWJKFGDGBCBQNQLAB@@@@CDEFCDCBKJEFABCDGDGFCBCBCBABCHCDCFADEF@DABA@ADABCDCDAB@BA@GBC@ABA@ABA@KJEDA@@B@HBB@H@B@D@@AD@BAHABCDCFAD@@A@AD@@CBE@ABA@CB@BA@C@C@C@AACA@@A@CBEBA@@@AACAAAA@A@CAA@AB@@CBA@@BA@E@A@A@CBABC@@BBD@FABAB@BCBAB@@CBYFOFODKB@F@JBH@P@L@HBP@N@@DBBBDBBBBBBBA@@B@B@B@BA@BDA@@DBBB@@DAB@DBBMP@D@LAL\\C`R@BNbwNQpD@A@LHfrLAxMpnoByVoAjf~D@@F@DBADBD@@DBBCBFDF@CBDFBDF@ADDBCB@B@BD@@BAB@DHB@FBBA@@FFBBBBABFT^HTNHAFCBG`JBDh``LPERABUbG^BJUFABJJtpFNAPUlB^TNcJC\\BxTTC@A@FBB@B@@BBB@BB@B@B@@@B@AA@@@@@@B@B@@@B@@A@@@AB@@@B@B@@AB@B@B@D@BA@B@@BB@@@@@BB@B@@@B@D@D@D@B@B@BDB@@@@@@A@@B@BB@@AB@@@D@BBBB@B@@@@@BA@@B@@BB@@@DABA@AB@B@DBB@B@B@@@BBBBB@@BB@@BB@B@BBB@B@D@B@BAB@DABA@AB@B@@ABA@@B@@AD@B@B@B@@@@@@AA@@@@@B@@@D@FB@@B@@@@@B@@A@@@AB@@@B@B@BA@AB@D@D@@A@@@@@@@@AA@@D@BA@@BCDA@@B@@@DA@@B@@A@@B@BA@AB@BA@@B@@A@A@@@ABA@@@A@@@AB@@@@A@AFA@@B@BAFAB@D@DAB@BABC@ABADADABABAFI@CBC@A@GBAHIBE@ABGBCBAB@DCBADAFAFCB@D@D@B@B@B@BAB@BADABA@@J@D@B@@A@@BBBABB@AB@@@B@@@@@@@BA@@BBB@@A@@@@DBBAFC@@B@@@AA@@B@B@@@@@@@@@@A@@@A@@B@@@@@@AB@@@B@@A@@B@@@BAD@B@@@@AB@@ABADAB@@@@@D@@@BAB@@@B@B@@@B@@AB@@@@A@@B@@@@@B@B@@AD@@@@@B@B@@@@@@@@@B@BA@@@@B@@@@@@@B@@@@@AA@@@@B@@@@@@A@@B@@@@@B@B@@AB@@@D@B@@ABABADA@@B@DB@@D@@@D@BAB@@@@@B@@@D@@@BBB@BB@@D@@@@@@@BBB@@@BA@@BBDABB@@B@@@@BB@@AB@@@B@@@B@@AB@@@@@BBB@BABA@@@B@@B@BAB@@B@@@@BADA@@@B@@@@@@B@@A@@@@B@D@@@B@B@@@@@@A@@B@F@D@@AB@@@B@B@@B@@BA@@@@@@@A@@B@@@B@@ABA@@@@B@B@BADA@@@@@AB@B@@@@@@A@@@@@C@AB@B@@A@AB@B@B@@@BAA@@@BAB@BAB@@@@@@@@AB@@B@@B@DA@AB@@@B@@@@AB@@@@@@@@@BA@@B@@@@@B@A@@@B@@@B@@@B@@A@@@@A@@@@A@@@@@A@@@@@A@@@@B@@@@A@@B@@@B@@@@@BAB@@@@C@@@@@C@AB@@@B@B@@BB@@@B@@@@@B@@@A@@@BA@@@AB@D@HBDBD@B@B@@@B@BAB@@A@@B@D@BA@@D@F@@@DBB@@ABA@@@@BA@@@@DB@@D@@@BBB@@BB@@A@@B@@ABADC@@@A@@FABAA@@A@A@@@@@@BADBBA@A@@B@F@B@B@@@FAB@BAB@@@B@B@BBBBB@B@HCDAB@B@@@@@@A@@@@@A@@@@@A@@@AB@D@B@@@@@@A@A@@@AB@B@@AB@B@B@BBB@BA@@B@B@@@DADCD@@@B@@BB@BAB@@@BA@ADAB@@@DBB@B@B@@@@@BBB@DBB@B@JBDAF@HBDDDBDAB@H@^CDAPEPEFALCDAVGDAB@H@DAB@BABADCDEB@BAB@PCJA@@FCBABCBCFEB@BAFCRKBA@A@@D@@AD@B@L@LAF@D@FDB@FAHCrQDAD@@AB@@ABE@ADA@AB@@CAA@@@@BA@C@ABAFELI@@@A@@@AAABA@@@@@@BBBBFBDB@@BD@BB@D@D@D@@@BABAD@BBD@DAB@D@B@BB@@B@BHB@@@N@D@F@BBFBBBBBBBBB@@B@B@DADAJC@@BBDFB@@BB@FAB@J@D@B@BBB@@@F@DBDBB@@@B@@ABA@ABAD@BADABAB@B@D@F@BBB@BA@@FAD@BAB@B@B@BB@@B@BB@DB@BBFBB@HAD@B@BBFBDBB@H@D@DBJDHBJ@PDB@JBH@FAF@LARADAFAH@JAH@B@@BBB@@@@BAB@@B@@B@B@@@B@@BB@@@B@@@@@@B@@@B@B@@@@@@D@@@B@BBB@@@B@@@@@BBB@@@@B@@@@BBB@B@B@@@B@B@B@@@@BB@@@B@@AB@B@@@@@B@B@B@B@@@B@@@BB@@@@D@@@@@B@@@B@@@FBD@@@B@DBB@B@B@B@@ABC@@B@D@D@B@@@DDD@BBB@@BBBJD@@B@@B@@AB@BCDCDCB@BABABCFCBCDCBA@A@A@@@AB@@AF@@CBCBABEFBD@B@DABAB@@@BABAB@BB@BB@@@B@@EB@@AB@BA@@D@D@F@B@BBDB@BBBB@@AB@DABADA@@@BB@BDD@@FBB@D@FDDDDFBBABABAB@BBFDD@B@@@DABBB@@B@BBBBD@@@BB@@@BAD@@@BADAF@BABAB@B@@@B@@BD@@@B@BADABA@AB@B@@BDFD@BB@@H@@C@ABA@EHCBCBAB@B@@@DBB@D@BAB@BEBCBABC@C@@@A@A@@BA@CBCBEBCBEBABABCDABEBCBCBABEJCBAD@DAB@@ABGBK@E@C@A@@BB@B@@BBB@D@@BBLFDBDD@@BBD@F@DB@@BD@BBB@BB@@BBB@L@B@BB@DDB@DFBD@@@BBB@B@B@BAD@@@B@@BBBBBBDBBBDA@BD@BBD@B@D@@ADA@@L@B@D@DAB@@@B@BB@@BDPLBBFFB@BBB@D@F@BBB@BD@@DBB@FFBBDBH@FB@@DBB@@@B@@@@@@BABCF@@@BB@@B@@FBDBDFBBBB@@@@ABAB@B@BBN@BD@@B@@@D@@BB@@BBD@@B@B@BBBBBBBDBF@D@BB@@BB@B@B@@CD@BA@BBBFDBB@BB@@B@BB@DCB@B@B@D@BB@BBBB@BB@BBDDB@D@D@B@HADAB@B@@B@B@BB@BBB@L@BBD@D@BAB@BB@B@@B@BD@@B@B@BBB@D@B@D@D@BA@@B@B@B@@BBBBBFBB@@B@B@@BBBBB@@@BB@@@B@BBBBBBB@@B@@@BA@@B@@@BBD@LFHFBBDB@@B@@@@A@@BAHAD@DB@@DAF@DA@A@A@@B@B@FA@@BA@AB@BAD@B@HDH@DBDBB@BB@@B@@A@@BA@@BAD@@@B@@A@ABAB@D@DCLC@@B@B@BBBBB@DB@@D@F@DB@@B@BAH@BAD@B@H@DBB@@@D@DAJEB@B@BAB@@A@A@AAA@A@@@ADA@AB@BABA@AB@B@DAB@@AB@@A@AAC@@CE@A@A@AB@BADBD@BABA@CBEDABADADAB@DBBBB@BADA@AFCBABA@AAC@@BAB@BBBBB@BABAB@B@B@@@D@FAB@@A@ABAD@DADC@@DABCFA@A@AAACCCCCC@CD@FCB@BC@@BBB@@BD@HCFCDC@E@@AA@A@@FDB@D@D@B@FAFBF@HAF@BA@CBABA@@@CBAB@BAJBFAF@DBF@B@BBB@B@DEBCB@BCBAF@FCFA@@BB@DDBBDB@BBDAB@DBF@DBDBBB@BBBDDB@DBDB@B@BBBF@B@@@JIDAFBF@BBB@BBFBHBB@@@DAB@B@B@DALAHABAB@DADAFADAD@BBBDBDBBB@FABAB@B@B@BBFBB@FB@@"],encodeOffsets:[[124242,48062]]}},{type:"Feature",id:"152224",properties:{name:"突泉县",cp:[121.593799,45.38193],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AB@AA@AE@gUaHEBRaJiPsTwBOBaN@BKDGDCBEBEBIFWBSBe\\@@MBC@kDABMF[PIFC@CBCBABADAB@F@HCHEDADAB@DABGLADAB@@AB@BA@@DBB@BABAB@@CHCFABCJON@BA@CD@D@D@HAFBBA@@D@BCFAB@@CBG@A@C@C@A@C@ABGDAB@BABA@EFABEDABeCAD@BGT@@AB@BDJLZIR@BADCBEHABEFWTMLC@]EI@GBIBAB@@A@AN@@@B@@DBDBB@@BB@DDJLBBBBDBHFDDBBFJB@@B@B@B@@CBEJCBIBC@E@G@AAKIAAEACA@@GAABA@KHGJ@BCBCBEDEB@@@BA@@@BD@B@B@BFBFBJDBDB@FBFBD@JB@@B@BDBBBDDB@B@B@@AB@B@B@BA@AD@BA@@@A@GBC@A@CAC@ABAAA@A@AB@@@B@B@@@BA@CBGBE@GBA@A@KAC@EAAAEAAACAA@@AC@C@CAAAAA@AA@C@CAACGACAA@CCAAC@A@CBC@@BA@@@EAABCBCBAB@@A@A@C@AAC@CBA@A@CACACCA@@AA@@AA@ABED@@A@A@A@A@ABAB@BAB@B@D@BA@@@A@A@A@AAA@A@A@A@@@AB@@A@@@A@@@A@EDABA@A@AB@@@B@@@B@@@B@BBDBB@B@@ABA@C@C@GBCB@@@B@@BB@@B@BB@@@BCD@B@@ABADAB@@A@C@A@ABE@A@@@ED@D@@A@A@AB@@A@@B@BBBBB@BB@BBB@@@@@@BEDAF@B@D@B@B@BABADA@EF@BAB@@@B@BDB@L@B@@CF@@@B@@AFABCBCBA@C@CA@@I@C@A@G@CDCBED@@AB@B@@I@@@A@ACAACA@AAACE@@C@AB@BA@ABC@C@CA@@C@@@A@AB@@@BA@@BA@ABA@CAA@@@A@CD@@@B@B@@BF@B@B@B@B@@BD@@BB@@AB@@@@E@AB@@ABAD@DAB@B@B@B@B@BB@@BDBB@@B@@ABAB@@@F@D@B@@@B@@@@EBEDKBCBAD@DBBBDBF@B@BBBBDBB@BA@C@@BA@A@A@CBAB@@CBA@ABC@A@AAKBA@@BAB@B@@@BA@@BA@@BE@A@C@@@@B@B@@ABA@@@IACAA@EB@@CAA@EBA@CDCD@DEBABA@C@@FADAFABCD@@A@MHCBBD@@@@AB@@@B@B@D@B@HABCBADA@E@A@AB@BGHIAA@A@ADCBABA@ABAB@@E@E@E@@@CAABAAA@@@CBABCDILCDEDCBCBIHSH@@CBA@AAA@A@AEE@@@AACAOACAI@C@EBGHIFKJGDQLQVGFGBABEBMJMJWRCBIFWLIJEDABCD@BABCBE@@@C@AAA@M@A@A@@B@@@@C@@@A@A@@BA@GA@@CBA@@BA@@@EAC@A@AB@BAB@@A@A@@@AB@BA@A@AA@@ABA@A@A@@BA@AB@@C@@@@@A@C@CB@@@BABCB@BC@ABI@A@@BAB@B@D@@@B@@C@@@A@A@A@A@@@AA@@ABAB@@AB@B@@A@AB@@@BAB@@@@@BB@@BB@ABBBLNDAHBFJAFFDJ@FJNJBHFDJADHHFDCNLDHFBFCHDJBDFL@HALBJEDBBABBBFD@D@BB@@BD@@BBB@LCH@D@@AB@BADAF@B@D@DADBFBBBD@FB@@B@F@F@BDBD@B@DH@FAJBD@LDDBDDDFJBBDD@@@HHDDJJFDB@B@HAB@@@FFLFBBD@@@BNDDB@DBF@D@F@BAB@D@DFJHB@H@H@B@D@@@B@B@DBB@D@B@@BBB@B@B@BHDBBF@B@FBD@BBB@BBB@FDD@DBB@BBFD@BLHFDF@B@B@BBBDBBD@F@BBBBBBBB@BBBB@F@FAD@BABB@@@HBBJHBBBBKFADBD@BBBCB@@BDBH@B@BCBEFAF@DAFA@GBABE@ABDFDDHDF@B@BB@BH@@B@FBBBBDBFD@@@D@B@B@D@B@@EJCBAHAHED@FEDAFDBF@DFRF@@DAFGB@B@D@F@D@B@B@BBB@HCF@BBB@@B@@@@@B@BBBDFBBJC@@B@B@B@D@D@B@HBDBBDDB@@B@HB@A@@AA@A@C@EBABA@C@C@G@CBA@A@@BA@C@ABAAAA@AABCDA@@REDAHBBABE@@FCFADAHEB@BB@@BBD@B@BBB@B@BAB@BC@C@A@@@EBA@A@ABA@ADA@@@C@@@ABAB@B@B@B@D@H@BABABAF@DA@@BA@@@BD@BBBAB@H@BAB@BA@AB@B@DBBBJBB@BAB@@AB@BBB@B@D@BAD@BBDBDBF@D@B@FA@AB@B@DBB@FADADCBCBAFCDEHCBABI@A@@@AACAA@A@@DADC@CAC@@@C@A@@BAJCBA@C@ABCB@DC@@@AAA@A@@@CBABABE@A@C@A@ABA@AAC@ABADE@@BAB@FABAB@B@B@DBB@@@@A@A@EBADCBAB@B@DBF@@@DADAF@HADAD@@@B@DBBBDBDDBFBB@@@@DBDA@@B@@BB@BBF@DBBADAFA@@B@BBBBB@HADABAB@BADA@AB@D@BA@@@AAE@C@@AA@CA@AC@A@A@@@AA@AA@@DEBAB@HAF@@@BA@A@@BAB@@A@@@A@@@@@AB@D@BA@@@C@A@@BA@ABA@AAA@AAC@A@E@ABA@A@CB@@@BABABABABABABA@AB@BABAFAB@BABAFAB@@@FDD@BADCD@BAB@BABCBA@@DAB@B@B@BBBBBBDB@@BBB@BB@@B@@CB@B@B@HC@@BBDD@@@@HBBBB@B@BAH@B@BC@A@@DCBA@@BAB@B@@A@@D@HAB@F@DAB@@@DC@ABA@A@@B@@@B@@@B@B@B@BAB@B@B@FABABCD@D@@@B@BBD@DDB@B@F@@BB@BABABAD@F@B@DB@@B@DAFCFCB@DAD@BAFAB@B@B@B@@@B@@@B@@@B@@DD@DBHB@@HBD@D@B@BBDFB@D@B@B@D@TDLDB@B@@B@B@FAF@BADAD@B@BDDFFHDLFB@DBD@@@B@B@D@J@B@B@D@D@D@D@DBFDB@B@D@D@@@BAFED@BAH@DAD@F@D@BA@BL@BBD@BA@@B@BANKBC@A@CBAB@BAHCD@B@FAB@B@DC@AFA@@DC@@@AAAAACC@A@@@G@ADCDCBCDABAB@@@B@@@B@B@LAD@B@BBDBB@@@@@B@@A@G@EAA@C@@@A@A@ABABAD@B@FBB@D@BAHCBCB@BABA@@B@B@B@B@B@BADA@@@AC@@A@@@@DADEDCB@FA@@BA@AB@B@DABA@ABACCAAACACBC@CBABC@C@CAA@B@BADA@AB@@BG@@BCBA@@@GEB@AA@EAA@A@AAEA@@@@DEECE@CA@@CBE@@DA@ADB@EHG@FKD@BCA@EAE@@AGC@@AAA@A@CAECAAA@@A@ACC@@AAAACA@AA@AAC@CAAAA@@ABABCB@A@@@A@A@C@E@C@IDA@CBA@ABAB@BA@A@@@A@A@C@AAA@C@@BA@@@E@A@CDA@A@A@AA@@@CAA@A@A@AAAA@C@AAA@@AAC@AGGA@@A@AFEBABADAFAF@BA@ABA@@B@B@B@BA@@@AB@B@D@BADA@@@ABA@@BA@AA@GCACAADEBBF@@INED@DEFEDC@@F@tUTARAJCDADAD@B@DCB@B@D@LEB@TDDBP@J@VERCD@LC@@D@ENFFDBAH@F@@HBJ@B@@BB@@B@B@@D@B@TFPFB@D@D@BBD@@@@BBB@@BB\\DHCBA@@DBB@B@TENADAHHBBLAFAHAB@DBDBFCDCDBB@F@@@B@B@@@@CB@DABAB@@@BBDDB@B@@B@BB@B@HABCBAD@d]HGHYBCFGBEBCBCDGBCBCJUBEDIAA@ED@NG@@DOBCAEM_@GBCC@[EAAEDK@C@EA@@H[@E@A@@@ABEBG@CF@@CD@BE@CJ@VQ@KBE@AAC@A@ABAB@@AHMHMA]@AFEFCBA^YTCNGnUBALAbIYfGH@B@HABAD@LCDAHABADADC@ABCAE@C@CTaBCBC@@A@@@@@ACIGAAGIECIM[]AI@EAIAG@G@c@[@CFsB@AEMAE@C@GAG@C@CAE@G@AAA@E@C@A@EAE@CAG@A@E@E@AA@@@C@E@CBGA@@A@@K@KAoAAAOIWOUOOKAA@CAGACAAAE@@EAE@CAKACAaICAGAC@ASAK@IAiEQAUAC@IAUAMA]AMD"],encodeOffsets:[[124757,46297]]}},{type:"Feature",id:"152201",properties:{name:"乌兰浩特市",cp:[122.093123,46.072731],childNum:1},geometry:{type:"Polygon",coordinates:["@@KAG@@@CDGBABE@GBE@A@@@CEFECADAEC@@QEKEBA@@@CAA@ACC@C@@@CDA@CI@@@AAC@S@AC@ABAF@L@BAIEKACCAA@@FC@@AEMCIDCCBEMBBEBCDCI@EDEBGCCC@CIA@CC@@AGACDA@AB@BAHIHAFCB@LD@AFA@@FCHEA@BBDGA@DAD@BKCAB@@GAE@C@A@A@@B@BAHBJ@B@@A@AB@BA@@B@@@B@@E@CBCDABC@CBAD@BABCF@@A@CAGA@@AAC@@@AB@B@@@BA@@@A@ABAB@@GBCB@@C@C@GBA@ABC@ABA@CB@BA@CAA@IDC@IC@@C@ABGFCB@@BB@@@B@@A@CDAAA@KBAAAA@@EBC@C@GBC@@B@@CBA@IFA@CB@@@CC@@FA@@BA@SB@B@DAB@@@B@@@B@B@BA@CB@B@@@B@B@@@BA@@BC@C@@DA@@B@B@B@@BBD@@B@@@@ABAB@@@B@@BB@@@@BBBD@@@B@B@BA@@BAB@@@D@B@@CD@@@D@@A@A@CB@@@@@B@B@@@B@@CB@@@BABA@AB@@AB@@@@@@F@FB@@@@@DBBBBB@B@B@B@DAD@D@B@FBFD@@DBB@B@@@@@DA@@B@BBB@BDBB@BB@BD@B@@H@BBBB@@BB@B@F@@@BBB@@BBDBD@D@H@B@@B@FBB@B@B@F@BBBBBDFBBBB@B@D@BBBBBBB@B@D@BBF@B@BBB@BB@FB@@B@@@F@H@D@FDRHB@@@ABDBDBFBDBEBABE@C@C@A@A@C@A@C@EAAACDABEBCBEBED@@BD@@@DA@C@EBA@AB@BABABABAB@BA@@BCDAB@@@@CBIDCBCBCBCB@BCBCBA@Q@E@ABABAB@B@DGB@@@@CAAACA@@@@AAA@A@A@CA@BAD@B@BABA@I@A@E@A@A@CB@@EBABGB@@EBC@A@AB@@C@A@@B@@ABC@@@BBB@@B@@@B@@@B@@@@AB@@A@@BC@A@AACBAB@B@B@B@DA@A@ABA@CBABBBBBBB@BA@C@@@@@@B@DCBCB@@C@AB@B@@@BABE@@BA@@B@BA@@BA@@@A@A@A@C@E@@@@@AB@@AB@@@B@@@@@BB@@BBDA@@@ABCB@@@B@@@@@B@B@B@@ABABAB@@A@G@A@AB@@@BDBBD@B@BAB@BGAAB@@EBABA@@@@BAB@B@@A@AB@B@@@B@B@@AB@@AB@@@BBD@B@@@D@B@B@@AB@BA@@@AB@@@B@@CD@@@@AB@BA@@D@B@B@BA@@B@BBB@B@DAB@BAB@@@BA@CBA@ABEBABA@C@A@@@@B@@@B@@DB@BA@ABA@A@AA@@AA@@CACB@@ABAB@BA@AB@D@@@B@B@BA@AD@BA@AB@@A@A@C@ABA@ABE@AB@@AB@B@B@B@BB@@BA@AB@DAB@@A@A@@@A@@@A@AAABA@A@A@@@A@@@@B@@BB@@@@ABABE@@@ABABEHCH@@AB@@`PLFLH@@@@@BABABADCDCDED@FBD@DA@@BGACBCBGFG@EDE@O@KAAAC@CAC@@@@@ABA@CB@B@@@BBBDDDHBBFHHHDFDFBB@BBDAD@@@B@B@BBDDDBBB@@B@@ABCB@@A@A@@BABAB@@@B@B@B@BA@@BADA@@B@@BBBBBB@BBD@@@@F@F@B@B@BB@@B@DAB@@@BBFBB@B@D@BB@@B@FBB@BBHBB@B@B@B@B@B@FD@BB@@@B@BABAFEDA@AD@D@B@BB@@BBB@B@B@BA@AFG@ABAB@BADABADCBABAF@BBB@B@DAB@B@DBB@BADADABADCB@BBHDBA@C@ABABB@@@@H@HADC@CDAD@D@@@FBFDFDNHFBFFJFFDB@BBFB@BDA@@@@BA@@B@B@@@B@B@DAB@@@B@@ABA@CTC@AB@AC@A@@BA@@B@BABA@@@A@A@ADGBABABC@A@@@ABC@A@@@@AABC@@A@AA@@@C@A@C@@BAAC@@@AEC@A@@AA@A@@@@AA@@CC@@@@ACEEACACEB@A@EACBG@GFDBCFDLILFFAF@T@J@H@\\BBBFDDFDB@@DQBCBAL@BADBFAD@F@B@BAB@H@D@FAF@DACC@IBEFEHEHALIHADAPEFAHAFAH@F@NAB@DBF@BBDBD@FB@DJ@HA@AFBHADAHCH@DEFALDLDNFHDRBLARFFBF@H@D@FCBA@@@D@BCJ@D@DBBBFBDDFBBBDFBDBB@B@DBD@J@B@R@B@j@N@t@B@DBRFD@B@@@D@FABAD@BAD@FAFAF@F@FALAFANAHAD@D@DBD@DBB@LFFBND@@B@BBBBBB@@A@@BA@ABGDAA@BA@BBA@A@DDBBB@FCDAHAFCRGVGJCREHCD@LAF@F@LAJARCHAHAJ@F@H@HAF@TAJ@DADB@@@@@A@C@C@A@A@C@GAC@E@A@E@A@C@@@G@A@AAIIKOUCCEG@C@I@G@APFJHFDLFTJNFVF^JFBHFTFhFlHxH`HJBD@B@DAF@JBDBHqTÛ@@B@HeX©HeHgRFUAA@@AA@AAABEAABE@G@AAA@@A@GI@EEI@CCE_kw@EMGIQGGGAER[LKJQAIJGDKJIBKDEHE@GDA@ABA@C[SAAEC@A@@@A@@@@CAA@@ACEB@GCICEAAIMADIFE@@HCBG]CDOC@BEK@@GQAG@iCE@I@MAA@A@A@aAE@@B@DANAD@F@BCHGBBF@DBHC@EBE@C@C@E@AB@@AF@H@F@HAHAFCHAHAHADCJyHIBCBCBKLIHQNEFEDMHGH@B@D@F@B@@EFCBADCBA@CBA@CAICGEGEEEEGAAAAAGACGIEGACAAAAEAA@A@C@CBC@CB@@A@@@@AA@@@ABA@@BAF@B@H@HAHAF@@BBBBB@@B@@@HADAD@@BD@DAF@B@BAB@BAHAF@@@B@HAF@B@DAD@@@D@B@@@FCJAFCDCDCBCDABEDCFABABGD@B@@GHCJo|]BGACBFC@I@C@CAQC@G@K@EAA@@@A@MDQBKDKF[BA@@BA@@@@@AB@@ABABAQ@"],encodeOffsets:[[125302,46957]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
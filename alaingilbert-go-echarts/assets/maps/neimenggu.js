@@ -0,0 +1 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)};B?B.registerMap?B.registerMap("内蒙古",{type:"FeatureCollection",features:[{id:"150100",type:"Feature",geometry:{type:"Polygon",coordinates:["@@FCBGDEJBH@LBFHJBN@NAHCBCAUF@@FJ@@E\\AAFDFT@LSCEJAvE@AC@@AHBJ@RFNBHAXKLB@B@FN@BEVADB@J`BAORDACHAFI`DIPK@EAABBFHBFA@DPBXBHGCSLO@CfJN@@CNEECDACAFCBAFGJCFC@CHCJITEFAVBBCFBCBNBPCDCLIBCBKCEAMG@MYBIBATHHCJ@JJB@JGHKFGFI@AAKGIAEPU@CEAACDMDEFEDI@CGA@AFSJQAEDAHC`ELENDLE@AOBCA@APABAOCAEHGRKDGLCDGNBNMTAHELEH@FEHC@E@EFCD@HGB@HFDALBFCD@DAHADCBEFAFAFEDE\\GDE@WE@GAGI@IIEEIDEACBCCEDCLELOHEACCENO@EDC@CD@JCPA^IB@DEDCHCLETEvXBBF@FADGFC@EDGCE@AL@FEHAEEBKAKBCAMBCPI@AAEEGR@NDBABKACOMEI@CBAJADCFAFEDGJKBEHCJKHEC@ACDCDMIAACIGLIBA@MAEA@ABG@AEBEFAAAIAECEGGCEEIE@EKU@CDIAG@KCCGCE@YQKECGIBCJALKBO@G^EBIFGBGHCBEKKCEQACCAEDQHM@EAKEBIBEFQCSHIBMGSAQGUEDWLGJA`S\\SL_HOACFKAIGEICEE]@CBANG@CBEAC@CCBE@AGGBECGBMBCRCJCAGDEHAHELADADEBIJMFCPADAHMHMBAL@JCFDFHH@JCLEBCAGFCPGLM@IBCLGHAFKNIFEFCJ@^KVCFEHC@EMU@K@CFEHCHGXMBCHI@EFIXAHADCCICCMC@AAEIEBEIKBGG@MI@A@GTADBFAPDBDFBNADADKIAACAI@GACDEAG@ANCD@LDD@@MDGPS@CGQ@CGGFC@QDEVAJEFQBOFGCKEGGMCIUSNIFMOIKOUGAACGAAMEG@MGIGKAUMBIGQG@@A@CFICIFACEDA@CDC@CGCIEACEGAEEIKE@GQO@GQIGGGEAOEEBABA@SKIBCAECAO@GQi_AMEGECCM@KCEEGOMKWIIUYGEEEKWIMI[EIQQKCKEEEGSGEA[CECQEOMI@AQQMUKM[QIGAEKGEACCKCCIAQDUDKAMEE@GBYCKCQBKJMUgGKIEIAGCEG]WACFMNKJKEMIG@ENEBIKWGCGDIHG@ACC@UFE@MIEDGAIGAESNMBM@[NMAQGCEA@IIECA@KEKKMCQKG@C@KJ[@UFWLaFMJQVKHUJOBMF]NQ@KF_EKOK@E@UHSLMDgCCDILEFE@U@kROBgC]IkU]GQBCHWZAJBFFBPEH@FBBFADGDWJEDCFEDODEBKJEPEFEDWFCBADALCFWBALBTAHGJAL@DJJHLNPDF@HIP@FBDDDTHXFLJ@JKZBDBDF@VAD@FDDH@HALEHAHFT@LJZPZDBZFFH@DGLADBFHJBLCDGJBNAJABQHEDCFChGX@R@L@R@REJ@DFD@BELEHEZGFEBKACDQXKZQVITMNWRQNMRMLS`GHMHUDkBVeVADCRGJ]^]RGHKHgT[RaPeL_FeF_@QLCFBFBHJLDJ@FEFEAICOK@TBNMDLPdBAFRBPHVJ@BB@BBBBR@@FT@DE\\@CHIJJJEf`@EVJRiCADKHGPC@@FILBBCL@DA@ALC@ANUBAFm@BG@AWAI@IL@FDFJFDHcdYEAAMCG@GHO^cAERD@KbS@IDE@IOECsMQDSX@FAB@F@TWB@FS@qhVFClrHEZPDAPaAK^CCAEqOytLDELA@GPAVCRQFFF@BB@HFAJ@BB@DFF@HDBBDE@QNKPBHADDFCJFFDJED@DEFAB@BDHHBBDIL@DCFDDEPEFIDBHD@BDCB@BG@CBKBGCO@MJC@@EIGAAEBGIGAGDEDE@ADCDENGJG@ABHLRPFBDFFDJBADGD@BBJBB@FDD@JADDDADBJIHGDG@ABBBFDDBDR@JHDBBJB@HCDBJBDHHFHCHEDFLGHG@EJI@EFCBBH@FKBE@ABEAAGE@ECAECCSCIFM@EC@AEGEDCCG@EA@CAAMFICG@IFGLIH@JGL@HBBBDCLDFDHCNMJ@NHPVJH@D@V\\JBBDCDCRDHDBBHABEBCDBD@FVNDDHBHLAFGLKBCBUpBPCB@DQFBHDD@DEF@HDFBHAT@HCTDFTJAL@JABOAKB[@CBAB@XCLGVEHCNYZCBE@IEEBIAGBI@YHIJ@JLN@DJJBDCDKDEDLJBFMHCFUFIHKDIAILKFBBEFDHJ@JFNCL@JCHGFBFHNJBFFDBF@LNBMHBBH@BD@BQNADD@NGBDEHDBH@JGB@AFB@NED@DAHAFCXKPBTC@GJC@J@BHHRDAFDNFBDHNFLBZRJ@DJXLDDXA@HCJEDBFXFFJHDJ@CCNAJHFAIKLB@A@ADAFCR@JCF@FFJ@LFVADDFDRAF@JBJFLEN@@GBGOSCIDCVCdALFNBZECQBCT@LCCECMK|SLHJHBJ@LHVdA@ILAFANMJDFAHBNQNCBEHEDK@YQ`EXCDBDDLfLEXCAE@AbODIBArCRKRI\\STGVKVIPIDEHANDBDHDNBD@DHANML@BPPVTDBRBEH@FlJJFdJL_PBZV@BEDFHLNAhNPBDANBHDDHPFVEPCNBRPPDPA"],encodeOffsets:[[114172,42326]]},properties:{cp:[111.670801,40.818311],name:"呼和浩特市",childNum:1}},{id:"150200",type:"Feature",geometry:{type:"Polygon",coordinates:["@@ĔVfXDĈÀr¦jƠ´ǆPZAPNnrĈª`ªbHAqRG\\GTKbKLEdUjaJI^WbSrs¼áÆkXS\\eM£jÏIOFUjURYHOFWDqNWHOBKHDKHMXONSNMFKVWXc^mliNUL]VW`VaJKTOLMH]DCBAHMT_Nk\\ehuJIBKN@@EACJAJCFGhClYZ_TQRAVO@MCOgl_NGl_zSl@JOLKZEHVGTSNODcJQVOLWJWxuJUBSAYF]DaAUFIOc@MCEMEHOAMGOMKgKIGCKOOHIBINQbKNIJUAIDEAKBMEBOAgMBKMEGFC@AYUOAK`cIIEkI@EFGQACAUSOO@ANKBMACACC@MAGCACEAGAGBCFOJUJULSH[TQJQLqDABCJaP@BBFWDKFKeCCCAWD_FR@ZCLGFAFMDMRGAEBICMNEBKB@JcBGU@KAIIGKG{TLDNDFKDS@ADDRWFM@MGcBQBEBADDLPRAJBFO@KFIEIAE@QBECCCUBKEI@CEE@KDQ@EDCB@B@BKAHJ@BABE@EGMBDDI@GCEIYG@CFCDI@GWBCCWKCII@YQKAMECGEACMBEQCGG@A@IID@HSDOAWLEDGBCBC@MFA@BEA@IHCBGAFIACMHC@BCRM@AACG@AANGMA@KAEECAEMIGIC@GHIDK@MDIEK@AGFEAALEFGDCJBLCJGVEDENGAEKIHELCBCKK@CKM@IFEFCJCPCH@HAJBFAJFF@DAZYDMFGHUDK@WBADA\\@LAPBBA@IBKSICEDS@GBSAGCE@GFE@CCCAGRE@CDAAOVoDALAHKBEGKGACCUM@EACDCFABAAGCACGDQDCACIAU[C@G@UIGO@MNIDMCGCEDKACAA@GHK@IJGHKJEH@JDNEBB@DFBH@DDFCFH@BFDN@JETDDDBFFDF@BHFBBAF@LA@EAGDAFEJ@FIH@HGEKFCDGEGGGACAIDC@GIAAAGC@ICQCAECAABAH@HCJGAIBCCCBC@ICC@EAAAI@AHCBCIAECCEEAQOGKBAH@HIFMDCBCF@FCHCHBHJFABBJH@FD@NIP@HDLADAH@@ADAACC@AGJCFEFOCCDE@CJKACGACG@ABAFE@CFCCIEEDICEBCAGLORMF@ACCA@GEE@CAAI@EB@GAAE@EERDQBUHOB@FKKCzsrPBFDDL]bBBOOCFYqGDkUErgT@@EXA@S@EBA@ETWRCtNFDJPF@JCT@LaC@FQdBP]HGH@NDBBZFdcCGIECE@EJKJ@XB@BAHn@BEVABMD@BKB@@CDKAAJK@ED@HOLGBCjDIQFU_@FeIIJIDG[@CFS@@EQ@AAAAA@@AUIOGQABEcAKONCAM@SAAII]SiW_OI@ED@DD^EHEBGACAECEIEEseKCG@CHQLEDyN]AE@ABATLPRN@HCBsLKAAABER[FI@EACUMMCcCQBKHGRCDQ@KEODGL@FJNLJFF@HADSHI@Q@KBE@AABEFGACSCUBGBEFHR@FADE@EAYII@GDCRABEBEAMGSOKEOGIAIAEBSPCDAXEDMFEAIGCWEAGD[RIDQ^ABEBCAEICAI@ID[xclSPCBKIUiE@QNOPAF@DRTBFCHCBMAUSKCWBYJMLmrMLKDa@GDEHB^@FEDC@KCYOUIMASAIBMFCF@BLFDD@DEFMHCD@DJH^NDFCDUNKDK@GAAGCEiSWEKAEBKFIHABBBTBDBBFALETQZAN@PCFIJOLQFiJWDEACAOiG[GQEEIEG@GBebCBGAGGC@aFKDCB@HADCBSCeSEBUVIFMDG@ICKMOU@CJO@CACK@EBk`YJoLiFgBOC{_KIKCYEIBaPG@OEEICIEAILMDeAMAyUCAMDG@kYIAE@KNAFFLCDWBWAGBWRSJOH@DVl@FABGBOIIUEAGHINIHWP]JK@KAGC[]CAQFMCGEKMQYKGI@KBcJ_F_@]ACCISMFGF@B@BPNNPCLGHKDGJDHPPFHDHGNOLIJENNLfCHFGLKHgPKHIJEJGRAJAZR\\FRGbLRXNVDRJTTxjNGnSdCbTE`BRGdBXB^HDDdCBR@VF\\RRDtAJCLHTHEDBHJFDHDD@RGH@HADDNDFJFTADLL@HFBB@HPFLBTEJFDJENc`DRGF@@LH@VEBQKCBGDU\\KDOBEFIDCDIZAJEF@TCZENQNSFkJKFOAGIKGOBGJ@N@PSPO@W@QBMDSNIPAPBVQ`C^DLRNjDTFNTCZSRSLajIfINOHGLCXQXOHGJENATEFIB@FEBBLKFEH@FBFHFLBJLAJKA@DBDGJFHDDHBLJD@FCLMD@D@DFDT@@P@TFLC\\@EPCDHF@DEH@JALR@AD@DJDIR@ZSBEBATCDODYBCDONDH@DMJKLSJKL@DRBHDDHDNFLX\\FLDNDZAN­CuE_@[F{B]FCyNRRKR]HQLCHAHDFLJLTFJAVENIlGVO^AJARBJAJIHUZGNBVCLONQHGL@FOL{tppavCF{\\K^az[ZEJ@PDJHHLFRHrHLDTHNN`f\\J®Înx¢bVzrPmâQARkKTHJ@NIHKFEDGLBNELIHAHBPEJMDKJCJ@FFL@JALMVEfSLiJWJQJ"],encodeOffsets:[[112009,43467]]},properties:{cp:[109.840405,40.658168],name:"包头市",childNum:1}},{id:"150300",type:"Feature",geometry:{type:"Polygon",coordinates:["@@hPR¯RD^RVLFpNHhfprVTGHCI]BuFMCC[CkASMFUaKa[Y[CUJIDbCPR[AIGCUIEEAGJGP@FG@AQKG@KHG@CCBMPCLIDYPWVKd£HMGYDYNWHXMğ_w@[¨}vWeP_Ü´FWWY@aPKHKgcaÓ[qF}[CCE@@BC@CB@JCJEBCFEBECCCOBG@@INKCAIBGGAIECOACBC@ABAFC@OCKBIBAAGAEEC@GDADBDABEBEFQNKBIAEDILG@EACBERGBGJEAUHE@GBIAEBEFkNUNWVIRKl]vGbGZWRWLKL@NDLBJELIH@JDNVbDPG`@TJX@HOPKR@FJP@DO\\EJKJSBU@KBCFCJDBCHIRI\\CHBNTJDH@FSLYHUBOHE@}XSDCFAHIPCB[FKFORIDKPQ~@XPXNNXNAJDDŌ¼IbAHBHHJPFZRJJDH@FCFMFUNQHKHCH@PJVBNBbAVCPSVCH@DLHBZAxGfIP"],encodeOffsets:[[109312,40816]]},properties:{cp:[106.825563,39.673734],name:"乌海市",childNum:1}},{id:"150400",type:"Feature",geometry:{type:"Polygon",coordinates:["@@@EAAHGBGFAAGFBCKE@AEHAACA@MB[PA@GCEBABBFCBCAIKC@EDKCIAKJCKGK@CHGGGF@KEaC[OYBQF_BO@OCGMWUsKOSiUcMaUSI]FMHeLQMOQOGQI]EWISGiWYGQIKKDMJGLEDBD@HFDBBAD@JEBACECAE@CAAAKBHINQCGGEEACAG@KEK@CEG@CACBCAGBAA@IAADO@CBG@GBECOJM@AKGI@KIWBKKIEMCRIBA@CDEA@UCUBQDcPGA@EEAEEK@EEAEEAICG@IDIA@AFE@CBCEEDEIEQEGEGAGGACDGAGPE@IKACECEGEICADJBDFBFC@BHC@GECHABSCAGCCCEC@HKLABASCAJGHG@BEGACMFKMCECHETED@HBNAJCHKJECCMAGIYFoBUUYOMSIE]E[CIK@_IKMMOIIKSUkgCICKBINiNOAAIEAEICMIKCEE@CEAEE@CEEKEqBQHWFMHG@G@A@BHADAD@FDLUTJBIBABJHBFD@FFGFABBDF@DBEFF@BNNB@HFDJJUAAHCAGBEDHDAB@BNDBB@RBFFJCBBFCFCPNNBDCBCCAEC@MGSCCCAB@NC@EJQxELULAFEH@HBVALCHILMHaJEDGJAFDDJZ@FGXBHALCLGLMLaLABCDBFDFBJCRILQDOJGFEHAFLVEJUDGHCDAXENGJKLkXEDCFAHAVDFBFCJGLAHD\\HHXdBLBNCVAXAHEHEDI@C_DAA@CAAKAMFMEIBDD@BKACB]EAD@FADIBEDCBICM@ADFJCDaKCJEAGJI@GDGFDDE@ADC@ECEFSCKDOCKBCB@DABCDEHCBE@GHECABECE@EL@NCFAFKHHD@@cDIAEDAF@FQIIFFJKLIIEB@CEBA@AJG@OCCD@FFLDBAFTTHDEHADTDFDDFABJF@ZBNCDBFMDOBIDI@CDKEMLIDSLKLMDEFAEASCQU_IGgSUIWCCCISCCSAGEEEmCEAOGOGG@OHM@OEUKAEKMi[KEUAQCGCAEAEE]CGEGc]ACGWAAQGAC@CFCLCBC@CDCbBFCNADA@CCOFEHABIHGAKBEFATALEFE@CIK@QBAJEJKTEPDbBFAZUHCHBFCBAACFMJEDGAUGECI@I@GDAHE@IEEBIAGIEBKAC@GCIGIIIMUIGIMSMCEMCEGEI@CHGDKAIBICC@CGGAEFGFAH@HCFGBC@CCCKM@CFKEOGGKG@ADGDC@KNI@EEMEEEC@EBC@CMSEI@CFEGcCKACMIIEGCAEEAEQFMJGJATGFCDCEI@CCAAKKCACIE@ABADANBD@JKDI@GYMAEIIACBKFEDGAGTWCQDKAEGEAEHKCIDGBGF@JJD@BCH@@ACCAAJEBGPIFGDEAEDAFCDAJCJET@DA@ADANANMXALGXEHQOAAAJGHG@E@CIIE@QJCGED@CKBGFUCGDIAKBAA@ANQKC@CHCIG@AL@AGH@BIJ@HGFA@EAE@EBAFB@EFFDCFBHINMDIACM@DGHW@KAMEGSEYQEIE[@E@CDC@KFC@ECCICKDKISKuIY@IEBGFElUEQCCKECCBIR[HSCIEMKCEDCDCFDH@FANIBIGAKIUUE[CI@cDKACAGIAGKCS@WICEBOAOEIMCE@oTWHOBSAeDI[FO@SAaDUBoPIBgAW@UFUPGBGEGMGCEC]@cEK@KAMDMHQNo|ETCHLVBF@DADEBKAMEaIeMmGECEaGOECIA[CsNkPONSJ]BOAQC_CFMBWHU@MAUCW@GBGDGBQAUKOCE@OAa@SAICyGcMCG@MKMIEUCQF]T]`CJAJJJHFFBDEJYLCH@DH\\BL@JEHEHOLQRKFiN]P[FCH@HDDJD^BHBDFBFFJHHVZBFAFITEFCNEH@J@LJLHBBDALEACFKBECIGWBE@AFMLwHYJSNKLEPCPANL`@JAHORURQJWJKBSLBDKRAHBH@dENEFUJEFCFETPxD\\FHFPTXTLZDfCTBZBXEJIJQBGASDKHI\\SVCXHJFJHVfDLFJFZJVHLXRHJDH@JETDBBDBBBFBDCBGHMFG@UVQHEFADMLE@BFCBGDG@CDSHBDKB@@DBDRPBHDNBTHRD@HIHCFJFBFFB@DDBADED@TFNHFBHMFE@EFEHEBBHADEFI@ABMDeAIBUNIDEHDDxDVDJFHDDFAH@BTN@DADBDAFGJQDADE@ADBDIHGHBJADOHC@@EQLYDYEKCQMBMJODOK[SUUEIAYB@aBKDEFCHBHJHPDDANFFDBDCJ@HF@FAHAFBDDBF@XIJ[PqP[FGDEFENBNJPDLFJJNDLFVENURKFPQFSH[^GFMH[LeHKNCJ@HDDPJxVRDPDdRNJJJHJHPILaPSNGJQTMZALBLHLPPFLBPKTEJIJ[XsbMHUP]dUNIJSLcZQLWTCDINKXMVONgfOLKHMBMHILGPAJBJHFHNEBKJOBIAgQoOOGIAaQiKGFE@YEoNFUVYD{BMHWICCEG@GMGHCMBABGBCDI@CDJHMCeAMHUBWGAACIEE_GI@FINE@CCC@GECKKBCAGWMG@KGICKGAAICBECG@ICGKGVBTDLCDEBECMFIAGBCCA@AEI@IACBEBMQGOA@AEAECO@CCCBEA@BKAGBGAE@@BEACBIASBABA@AAE@AAAB@DECCD@BA@@BCB@BE@ADK@@BABCABBGBA@C@EACB@BGB@BAB@AA@@DKBABCBCAEDCACBCBC@@CCB@ACBCCE@@CAAABACCBCCC@ABAAC@ADAA@AG@A@AAGDGEGBABE@MBM@KACCBAIC@AABAA@AA@ABBDE@A@@ACAGACBG@ACEBICCHIAAAI@@BOAABCACD@DEA@BC@AAIBICC@@BB@CBCA@DEAADDBC@E@ACCD@CCBCDC@@AEBBBE@CHCCABBFE@@@C@EDE@@@BAACEBCEEAAGEEDACA@AECFCAACBECDCM@ABI@CCABC@@@ACD@@AIB@AEACD@CAAE@@@A@AAA@BBABMB@ACBABI@ACC@EBGAIA@BEEAEKCDE@GCC@CAABCCABACA@B@AC@ABBCAACABCG@@AAA@@@DCA@AEBAACB@BG@CBAAABCCCD@CC@@BA@BDCACCADCA@EAAABA@BEAAEBAAABAAEA@AICACGBAAABCABAC@CECAABC@@A@AAB@CGBBAC@@AACABAAC@CCC@AAGBCIEEB@BCB@@AAAEAAB@@E@KA@CC@ABAACAABAJBD@DDHBRFDJBBBCHHLBNFREPGHMNIFGLIPIHAFBDDDHD@DELCNEHFF@DAPRbwNSOMZkBRVyteC}FQDsLgF@EqaDKM[lEt@X]O\\zpPdFbZfZjbZVKClJdDzBd@RKxOVit_Tq\\kNOJMDÁtJVK\\HT©t_HatiFIfov[JDX²zD`XJd°rnºVTEtRV`ANºX®O²spu¶NRAFELEVD^JDADDB`CFHJAFBDJFFBFCD@F@DHDEDDHFFPDDBHDBBGJ@BDBCD¨TVEZR@èä«|]¬AJxV_tZpUZbn`PBXU`§fShV~nxz®z|PT\\xȸ^bIp@ZGdBRMHGJEHGBEDCDGFCBCDA@C@CEGBCJCRABA@AHCLEN@JCH@NCDENA@AACDE^KVOUgEEDEHABEBAHGbL\\ONQRADCDCBEF@EIICEEIE@OIGIYMGAEAIAAGAGEACHMNGVGTALBHAVGFCFCb@HAHCF@FDD@DCRBDFNHNBHBNChCNDBBBHGHDF@FBDDBTDHHADNBTTHPfJbFLLBL@THNRJbZjP~QjA^C\\Q^B^FpIPETLNR\\HRHVTNHRGNCZ\\lXJTOR@PNN^AF@DNLBFAHFBH@HJNPFBN@HBDIACCGJED@PANQXFHFADIBMHBLAHCDAFBHABHH@HDJGJIFEJIDADBHEBCHED@DFFJBFFJFBHFDRHBEJAJCbGN@RCL@F@CDBFF@LERAJEFAHGHBHCZF`N\\CROTEVH\\NHP\\bXFPE\\JDJBNELB^FTX@VDHJRDpFVDJJDPC`RHtJHJT^JJZDZANGJS@QP@¤VDAFHFBfJZJPBVKRAFEDEDAJBBCXVRHX`PLXFNTP@TCZBJNPFrJJL\\R\\BZH\\NRRDNAbN`HnHDEHBB@DDDBDFDBFHJ@DEBBHADBDFD@JE@KJAF@DADADE@ADWBADBHAFBHBBEDGLEAEDKBGFAFEBGBEHEAKDKEAFHJAFBDCDDHLDLAJLLFF@JAL@DBDBDCJCDGJGdKVBRAXANEVBFANDJCLBHDAJBBHBHAFFRHHA\\BRHRAHDRALD@DDJ@F[HSAQBHDBDND@HEFAH\\CXAnBRb[JCJORAFKHAFEF@BDHCF@BDFALKHICCHKBCDCBKCYRAFQ@AB@DABG@CFYJDJ@BUJAHBFDDADFD@D@PDBBDAJABDHBDDBBD@JADGDADBFEHFD@FDFJHNKLGDBJAFCHGHA^AZHND^GTBNAPE`EXIPAJ@@HFBBDCJADHLAHDDQHINKAGB@BADGBILEAGEIDOPCFI@FD@BDFAFEBBDAJMBEACNOLNFBFCFDJHB@FFFFDFAVKNCLGPCBCHCLFTD@BCD@HABBBFB@DFHFBCHEBMJCAKAODOHBDFBHFF@PHPDWLQ@EBECE@GAK@BFGDCJGFSRQJALABJflrHFDF@FHBJH@DFBBDHFDHH@NFDERM^EVGNCHANGHBFCD@FCBADCHEBKCEFGCIFG@ECAHC@KR@JE^@BCBANAFEF@LAFDADDHTHBBFJHFFB@BFDBFDBAF@HFF@DABEFAHAHADBFAF@FIJMRRHAD@JCDMFIHSDMJMAIDSL@FIF@FBHFXDFNDDJBJEPADLDJFFDHDDNADży`{DAqr[TOBCBABBDCBB@CB@@@BED@AA@AB@@C@@BCB@DABAF@DAAALCDAJADEFCPBJADA@ABC@CNEDERGAAFE@CFE@EFA@GD@AADA@ABCD@@ADACCDAACBCBCBAFI@CDCAID@@IDCAGDAAABCVQ@EDABEFABEB@@EB@@AB@AAB@@ABAACB@@CB@@CFADGDBDEA@BAAABAAABAAAACB@@A@A@ECAAC@CF@BAAAD@FAAEDEAEFACABE@CLQFAAABAJCBED@@CDCBIDCDADAB@BABA@@D@AADAAEDAAABA@ADCA@BCDBAAHEB@DDBCFAH@@ADCBB@BD@BAB@@@B@D@@CDAFBD@B@@A@AB@BBBCD@AAF@@AHBDCF@DA@AFCJAHB@AJABCF@DAB@DA@CH@@CJB@ABABDDAAADB@CDB@AD@@@AC@@B@BABB@BFAAAD@ACB@DABCAADAD@BCAAAADA@ABC@AD@FID@@AF@BCJB@AD@BAB@J@@AD@@ALA@AFADCD@@A@@D@DCB@DEDB@AD@AAF@@ADAAAHA@ADABABAB@@AHBDAB@@A@@DAAA@AD@FEAABADCBBBAAADAAID@BCFBFCACD@@AF@@CB@DGFCFEF@DABA@CFAB@ACFBBAD@@CJA@ADABAAED@BCB@AAF@AABA@ABABB@CDB@AF@@CBCDBBAF@AABADBFC@AB@DAD@B@D@@AD@@AB@@AB@@AFAAAFBLEBA@CHA@AB@HEDIDA@ADA@AA@D@ACBAA@@CA@FABAC@B@DB@CBAHAACFBCCBADB@AD@BCBBBCC@FC@AB@@AD@BEC@B@@CB@BBD@HC@CFBDAAC@@A@@CA@@CD@B@@CA@DCDBAADAC@@AA@F@@AA@BCD@CAHCCA@AAABAACD@C@DCCAB@@CD@CA@CCDAABAC@FA@EBAA@BELADEBIFKNSJILG^MZIFA\\KVCFBLAJBBAAACECEACCC@AGEEIICCG@EEMFMHEDEBARBJDF@HCBABIPEHGLCHaFEXEN@DEHCBADABCH@@EFOJIbGLBF@PI@CAIDEFAHBFCDABA@EDCAIGCEE@CFEDANAFGHCACBGDABEDAGAFEAAADICDCKABACA@AHDDCFB@AJCBCCCBA@AC@@APIE@AAABECJEScxwIBCGEDCAEECFE@EBABE@KFEAGFABKFEBGHCDENAHEBCHEDCJEAGAEIA@@hstq`BXA`YNhJDIXkb@PCZALDF@PB`BDATWDCIELV[_S_ASuM]gAs£FQLI@LO^UFWM@i¦sN_^Q\\SbafMfwFENQbDFKACCCWKIIEIGCQ@ICGKBKACSKkGYMcEOESCMGQICAICkW[UOASHCAYIYACAAGKISCIEKKQGCEIMECEAO@EAQICGIGEIDICQCEDS[YGCWOWCICKKWKMKKEEAJQ@iC]KMIKESAYBYCOIKIMIIEWEUIIEIIXB\\AT@fHP@F@HCdAZDbJN@TGRBPDVJ`LNBb@bHhAP@HDbRlDZP`@NJHFDBDANKH@BBDHNHFDZFOKGQAKAGGASCKGMCGCEEKCQKIKGCGSAICCI@QIGMGKECMCECAEBEKM@EKEI@EGG@ACEKGE@CAEE@QIBMEEIAGCI@MCUUE@IDCAAEAKMKGIAKCIMMOI@CCGEAOAGEIEG@GAMMAKMEOOICCEEAYDIC@EDI@ECCQACK@OISAQAC@ADECEOCWIEECESISAMEBKEIDED@RFLC@E]GA@BGGCEDIJA@CFAAEKA@GFFJGFKCOO@EACYUACAKOKCEHM@EDIRE@EAG@CEGPQP_XiDs¥dmWMS]COHE`QPKQI@I@CPHJqf]VBaBEjyJOH[T]PgDQHY`cDGN_POBOHABED@DBBFGFBBD@LCDBFC@UQBGAACBCXEDcEC@ER[CE@AfEVIFOMWtuk@UPGJQFBRCD@FCF@BCAGDAicCwSGMEEIGBCFI uBGJGHQPE@ADE]OLEFEJE\\CNHDA@A"],encodeOffsets:[[123785,43285]]},properties:{cp:[118.956806,42.275317],name:"赤峰市",childNum:1}},{id:"150500",type:"Feature",geometry:{type:"Polygon",coordinates:["@@DFAHFJG\\PFHHHFXDBDJFT@PELAFCD@XDVJDA\\CXBDABCJ@HEFBFDHEXAB@DENJDAF@FFH@HHB@FGDAHAL@DBLEHIHEBEBAHAHBDFDBXCBERGJ@HC@CB@RIH@NKFAHADCPBBDDD@DBBHCHBF@DB@CDAD@HAR@DCJFFADEF@FECODCDABCFC@CFEDCDCFA@EDERAD@FEFDLABDJ@HCBADC@CFAD@FBF@PMJABAR@HAFICCDIAC@GBAJAFOEGF@HC@CFEFDNAHDDA\\@FA@CDAR@LAFBH@JDDA@IDC@APKFEBEJ@LFB@HE@EJCD@LG@CACNEDC@A@CDCJELCECBCBGGEAG@GBEDCHEIE@EEIBECEHEGE@CFIAEJCFEF@D@JDLCF@BCH@FEFAJKL@BAJCBCBEFAJABA@CJAFEJGJ@HEHATOFIDGDEHAHEH@FGJAJE\\OvQfQhDJGJ@FCHBJCJ@JCVCFCFHJAHBP@FKAILuHBDDL@HCJ@FAJ@JDLAD@J@HAF@BEJALMF@^MJABDF@HCF@HADED@NFF@JIDGVGBC@CHEBEPEFECCGFUHJMGAHQ[gBEEICEIBGCAC@CbKNBFDLCFCFB@GHKMGDKCQDMKQFABELENKFGFOHEAIBKAC@EHATMFG@KJCDGJCFIFAHBJDFFJ@HEDGPO@CJCHCJADAH@REDDJ@DABCPCBGDC\\K`ENCJ@TCFB_LKfCÊHBg|ujAfSOAgĶÏJy\\q¢OJGDQcMhmAQlkJAZcPK^AFU|a¨XUfcNcXDZARChQª§@OMdKhcbQØoZQITMTCJEÀROXAt[BA^KPCbDBĢBRFlVtJjJpNbLVD^VDNV`rpZVjbbLânRJJFJDbRPFlXj^TFbTp^THFFlZ`NvNb@zFV@D@RGAAHCCQFG@EMQIANQFA@IDAQGG@d{IYC@BSU@UE@EFKVwIB@ERCbAjWD@DI^ARB£VACNFBOJWdI@CDIJEÀeHBGGBCEBCM@IDGkQCCCS@AGM@EAA@EKSFIGIEAEHEEGBKI@EFOCAAAJIEADCGAFKUGDMH@BGBKAAHKlONGDABCF@BIFIsICGAAAS@KIB@K@JIN[DBBDFABCPFHODEDIH@BAF@@AACHKF@@AD@BCFDHA@QBATaBECEDGACDCFSTBJGA@@ED@@AHK@EAEBEFADCAWBAKG@IBCAA@G@CBEFABEN@BQA@@CNSHCIGF@JHJA@EFGB@D]A@MA@IIC@cGEBILMFCLFFD@ACCDAHK@CDA@ADABEH@DED@ACHEE@ACDABDDAAEACDC@CHA@C@C@GIGAEDCBCDAACDGFE@GDC@EFCDCCGBAHAACECHCCAC@AE@CJGCEKEBC@AC@EHI@ACG@IC@AFC@AIA@AFEBCKG@AJEDCCK@ICCGBSEIAIBKACDC@EAKIE@ABADBFABKDQBKDCDBDABICGDGFCLED@EDECKBEE@ZmJD@DPB\\WBE@ABAKSAKCCAA¥\\RIAKKUASD_p_GYS]hU|DFIPCPMJOBG@OLOf_LapVBFGL@FDLPLKJOFMVYRSJGPFZ\\RMRSlkrUĂHBE@IEGKE@AN@NFXAA@CCMACC@AHIACEAGDCEBE@A@CFAL@DCEESEBEHGHCPBBA@CAEEES@I@C@@EBA@EFG@CAAC@IE@CDGCMFENA@A@EEAGAKDGHC@KGACEAK@AAGCCE@EBGHG@AHIFKACEAACECA@HG@AJGBEKEM@EBGAECICEEAA@IDE@CLGBIHAL@BAFG@CCCBGCCICAEODCACC@E@ELI@ECEGC@CCCAICCMCAE@EDMFCCEEAE@ACCADKDEAKCAAGCABC@GFIACGCBICAA@ECK@AC@EMECCAGCAK@AABGFCREDCBCGGIAECGCCACKCCGBEHE@@OJIGEAAFCJC@CECECO@E@ACDGCCEBAH@BG@AEGBAH@@OE[@CDIBJBAFI@KLQH@DFDQGIDCCMDGLEHSLIBQE]AMMMGK@KDWAGIIEgG@CWGgGYAGAC@WIEgG_YGCIMCGBEGGUOQ@GCQUKIIMEGJyAOCGGEUiAE@MZKD@FDB@HERCVINCJGF@@AIKKDUEQ@QBQE@@DGKCCCMG]CgM]ASMI@OIGBGGGDMOKCIIMA@CACK@EBCDUEIAEDE@EDEAGBADEBGCCFEAEBIAADIBABQAQQCCJGECSIMCC@GJEKMPMRED[A[@]ACACKIGAOLB@MGDKMDAQQQKAGKMLECIQDFHCFDFCDA@CCE@C@BJG@IAYTAD@FKBMHEEG@AB@FEJMJ@DCD@BKACDGBO@GD@DIJQASHCDELANCB[AaGODWES@OBSBOGiKOLWFMFI@MDMFCNGLIL@BLT@BGFQHWDW@cFKDMBGDe@uHW@UFO@[AcEwCYAECIGAI@GLSFSFO@KHGLOFCPAT@lCbAPAJFF@TDDAFUBKAEICWGECAOCIGIUMcMKEGM@WFMEEaAO@IBKACA@CUEMKGAEDBJAFUJMAICGRIBODS@OB_BEBADALQJMFGNURWFM@GDACDQFKBIEICYBGAKCCICCABTADABG@WEKCKBCDA@C@BCCEA@AFEBC@@EA@QDIDM@ABDHCFG@MCYLQBE@GTEDYGI@AF@BDFAFQFE@OEEAAEBEEESEUACGEEI@cJM@CAACAKMKADAJGFOBE@EACEDOACO@[DCACEAEN[AIIACBGFE@GEEGGCAAJIEGACBIFEACCCE@EHGBICCIDCFADDRAD@BCAAGCUCCCFGDCFEJCNIECGAECJOBIECIAGFAFAFIBGC@ADABEPGBCCE@EBE@ECCCACGIC@CIEaMECACBCCE@CACBIDE@CODBLKHC@CCACBMACIGGACFCNEDK@ECAGIGBEEI@ICEAGSIIB@FHLAHEDKIIECEEKIGM@MCIC@AEEIBGCC@EF@GCCEGCA@EEEWU@EIMSOC@OGUEQFO@C@ECEASLIPEB@FE@BJEDCP@HADEJG@KGGJOFELOHCDCNNHDFAHCFA@CAAAKA@CACM@BMQIK@@ADE@CCCOEG@ADWDkKECGJI@EFEBAAAGGGG@CBAHGJODI@IDIIKI@ADAIGYGOBIEEBEAKFAJGFO@AAFMCAGAe@IACAAEBQAEBC@GACMBKDMJEBG@AGEAABAHE@CKMI[KaGEEGADIFAHADAQKBE@GESIMSICCEGUGECMEI@IFCBCABEJCUGYCM@CDICEC@ICA@CMEGAACECC@CAG@KEAGFG@CGG@GEEE@QMIA@CCAGCE@GCA@@EFCCA@CE@AKCCCBGAA@BDADKECKQOAKM@QAADCDEAYGW[EMGKKBGDEDOFaMNIGGGBCEGDKDGDOLA@BBHDBDCBS@KDCDOEMDKAEBEFG@CB@BBFEFIDI@YHEAGIaCGEEAM@QHGABCCCCF@BCBMG]DGFEFID@B\\PCF@BOFGRIHAHvEJADJHFFHNTDxdjCBBHADE@EDC@QDEAIROH@VlsvNXEPUJeF@BDFQ\\@FFDCdWFADBDHBRA@VEDCAKDC@AAHEAECAC@AFGBAPOPM`CH_dGZCROhS^G\\IPizAFAbUe^IrOG@D@JRJOL_RGFDPT^XNcnt¦CWjO`ORFH@DBH@FQFCJ@FGNDFPLBLBDZVBD@FPPLDHEEIHEB@FLBBDEB@JIFCHDAHB@^H@FKDQEC@CFFJALNFTBTJDFFFXJPDDFCF@BBDBRJT@PDLRBDD@FCJ@FJDZCFBDFJDPPNFBLNNHBH@JFHFPBFBDH@DPJNNDJBLHJNLBLBFDBJCF@VVNDJ@HDJBFFANRJF@BF@DHFFLBDH@FHJ@LF@FLNAFBFFDNDFDHLHNRJJ@DDBJHTHDJLRLLDFFHDNDLHTDHBBHBLHRPLYEECMGCGAAG@MLCBCAGEMI_@YOkCaQGCO@gBaGa@MA_KUIOCQASHM@aIYCcBGDE@O@eGS@[BWAJJJFVJXFJFNJLJPJZDZATBLFNJ^LjDR@IFBLFNLXLLLJDXDXPHD\\ZCTDFDRCJFJJHDHRJFBP@FBFDJNDFRHLLJFTDLJBHDBZBZJDBTGPB\\VlXJDDBRJNHTDPFdFZNlHTLBDALHLJDT@HFBFLLXLFF@BELaCMREFexeNab[T]RM`¥t@jXNE]VKP@KJER¤Bt^hvNBTT``U\\KFJCDSXc@OAE@KCYBODa@WlCJgIM_ZWB_Asrgt@@JBBFBHIFCDGFADGFMBCFGDAHEFALEBBHEF@LAFAB@FEFFDBFCDHFADJwxTdIFFDBABBF@OJ@BD@@BABDDADID@BEACDGC@BDBABLBCDJDBCBBEFHBCBAFCBAHBDGDEHMBCBEF@DFFHDBJCD@FABCBEDGAEBCFBJ@DOJE@KAaHIJEP@FG@ADCBABGDCFM@WFEFGbKDGHOFAJABGDE@ICQAABCFGFENFN@FDHJDFJHF@BDDBDDFDFBBABIAKBEAUD[LEBYJ]NKHIJMTELAJCFKBAFB@AB@FEBD@ABBBDC@DDBC@@DA@DBCDD@C@BDABBB@BDBGDDBC@ADB@@BE@B@@BD@CBBBCACDB@@DA@C@@DB@@DB@@@BDCBEA@DGDC@AAA@@DA@D@AFC@@BA@@BEDD@ADAAADC@@BCAABDDEABDGBAB@DCAA@D@ABEBB@@DB@ABBDC@B@@BCB@BCBCJGFA@@BGB@DABKFEABBEB@BA@@BA@@BC@@BC@A@C@CBA@@BEDCAABBBE@ABCAAD@DE@@BCA@DAAAB@BABBBE@BBA@ADC@BFABCB@BIB@DC@ABEABDA@EB@DABCBE@EFEDCHA@@DE@@BC@BDEDEAADC@BJCBBBABAACDABBBEFC@@BBBCB@@@BA@CBGA@BA@ABABCB@BGBBBCB@BE@BBC@@BCACFA@CDC@@@@BC@CDEB@BKB@BC@@BI@A@ABC@@BIAADE@@BC@EJC@@BAD@BCBBBBBADC@CBBBADCBA@BDC@BBEB@AAAABA@@@BD@@C@@BCA@DCABBCBACAB@BIA@DG@@DCBA@CBE@ADIB@BGAIBED@BCBE@CDGA@BE@BBC@ADAAA@@B@BA@C@EACB@DC@A@@@A@ABC@@AAACD@BG@EBADCCA@GFBBCAADB@CD@BABBBCBBFCBBBC@@@ABABA@CBCBCDAJCD@DC@AFIDABBBEBMRBDAFDBEBBFCFBFEBC@BBABE@@DBDDB@F@B@BA@BDBBABBBABBBABB@CFCACHEB@DA@@DA@BDAB@BA@BBA@@BA@@FA@AFEBAFCB@FURADBBCBBHCD@JC@BJCD@DEJABADADBDCBDDCB@BC@AD@BCBBBC@@HEB@FEF@DEFBBQHCFMF@DCHEBE@OAEDCFIBCBKDBBCBE@ABCBA@AD@@@DA@@BBBC@AF@@A@@DAACDAAABADSPq\\rCB_|Żz_Mg^­^f¦ÂK¬ZH_vþČÀÈYhhhøĎFAF@DACCBALBACBAACL@@AHAN@DAB@@CHCJFBBHAB@BDHDLBLBB@BCDAL@HAHBDA@CJ@ACTCLGD@D@FMF@FABAAADCBBF@BBNL@BJFJBD@T@B@D@D@D@BBBADDD@@IGYDE@GFMBIEGAEEACA@EDC@AMKJGBANADEL@FEDBFHHDDDBBFEF@RGLDFADEPKBGVBPAHEF@DBBFCJEDNCJ@LCFDVPBJCFBJC^KTQP@DHJMGI@KBKJ@B"],encodeOffsets:[[122875,46647]]},properties:{cp:[122.263119,43.617429],name:"通辽市",childNum:1}},{id:"150600",type:"Feature",geometry:{type:"Polygon",coordinates:["@@AEEGQEI@OHKNCDINAHBHBFT^@FWPE@CAEEICAC@AACOAEHAJDFFBBDEH@HCDMJJTAPRFHHJBFAPDDF@D@NNLHBF@FCHB@HQJFJDPBFLFP@BBCJBH`TDDFBFCDB@DEBFF@FH@@PCACD@DJ@DH@FFHGB@DFBAJFBBBCJBBWNCDCNEDEA]QAOAEMGSGQAGAOBQCI@GDgGCDALBDAHGDO@CA@CPE@C@AC@AFI@CA@IGC@AGEEAMBGEMCCC@EIKUMK@@CEEGCMGOFICQAQIK@IESD]EEAMSEEGAI@GCGICKOMAA@EACOIBCAEKKKUMKBCDCACGAKGMGEK@EAESAEG@ISUEEOAIEKSMG@AKGEGKKCGBCGCBGCEDC@GAEBEKGC@CCFE@EAEDI@COGAC@GACOOQSECAC@AMCOIEBMJQFEACCABAAKACCCBG@AACBACE@AACBGAC@@AG@AACB@BIBGFEFCDCBIAIFIFCHGREBIBKRKHALEFDFFDDDEFMJCDAF@PCLabIF@DKDONCBCCIBGAA@BIDIACYQKAUBQPEJCDKD[H]@ICAIQYGYTIFC@CCCKAEEEKCCYMSOKCEJAHEBADEBBFED[JOLSFEHIDEHIDGHMHEJIFE@@DQLAHCBBBABKHGLOHGHUHEDNJZRAHOBqCIHOR[NK@IK­bMHBACEOEIEACBIAIMGAAF]BOFUJODAT@TBDAJGJOBGDEFALSJMFIDQR]HQ@EFOH_HIHEBCEAIFSAO@MKWEKCEEEAEEKCU@eUMAM@EAIGICCCKGCCAIAAG@GDE@GCOGYEEEMEmEQIMDMCGDI@OBI@EAMBABC@MRGDCHCBAFGJAHGDAFID@FGHKFADC@KHKPIDC@CCCEYGIO@KCAKHCA@AFOFGLIFCP@JDVIFCPSRMVIJG\\MJG@CACEE]SEAMGSGCKGCOGMISCBK^]\\gEEy@IJGPQH[HQCQMCIIKCAeOkmC@_WQCIESQKO_]KOiASkGEeHMY@C^SJGKgHSBGOEIKg@EEKEKKKIIEKOO@ADEAEEBKJE@CAGCEEOSEE]QEEGCYOMMG@ibSTGDGAIIKGWWAAMAYWcUMKCIBG@EyiKMAGGgCGKEIAY@MCaGaICEKU@OISDMBEDC`YJGRONEBC@CIIcSQSMKaHqhKDK@WOWUggQMYWKGOMCEAMOUIQIICCUI[IIEesQOMCQIWYECQCOIEGIEUGCC@GLODE@UEYCEGKIG{eQGe¡MKMOGWQYAIAEVUJI@CS[QSEKKGMCGE@CL]AGCIBMJMb_²gJQQqSWjwAIY@gAQqIMKIEEFOBGCAUISFG@SIEGBUAGKYAAIAMAcQK@CBOPINCNEZAHeZILStAVWZ_XQFaPq@QAdNOJW@MCKIMeEOCG}@BWHSH]AO_gCKBkNgDQ@OMWOKSCQ@QFQBW@SAMG[UMmMaGQ@QD©zePOBUUCoeKkEEOBIAICMGMEIAeBSHEFGNIRKHYFwDWA_E[KEEGOIMIGIA[BKEUOMCY@KHMNKFOFJIDWNIB}EEDQ`@BT^FZCFMNAD@ZCLINGDW@ABBV[hDh@DP[RMDBBQDWHK@KI@@^MLID_DGECAaJWB[JLULifeLAHLLEFO@C@MYuUC@WPÛlve^KNGFe@_EQQ}PMLADLZpABIF@FCFEDMDiFKBEDUbOP]VADCPKFOLOVCHEFWLWHM@_BULaF_JOLIPEJ]HWRCHQPCDC@KECASBgFGJGBCAIKKKCIAMGCICSDOCGDE@CAEGA@SHIHEBKGCGOMCCM@IBQHKAIBEACKI@ÅVRaNQ@UFSHOFsJaHLUHaRUVYTQBeRuNQJODgHUBYJu\\ZQL]bQRGDUHADG@YFAFMP@FĪÌJHZlpVdFhVĞĜNV |drXZFLRXDDFLhl`^vf¦JPXÌBRI|JAHEPMFC@EDCHAJHRBLCPDD@BEBAD@DAPBFDBJHHJADBML@JH@PADDFDFADEFADI@IDAD@@AF@B@^E~\\rbÔhdGLOL@bXZEXÛ³O`fuX§~@\\`xNĠWGMXCZHZGNc¤ULOXCZKJODAH@FDDH@LGH@RL@BEHO@IHBHFFVJHDBJQ\\OaDCIJDVZ\\b\\bLEVTNlB\\DDDENAvJ^GDHUSoqeGgoMKEU]QQCQ°gOcdKRCRGRARBHHJNDLL@LMbGFBfAT@NDLAN@PEV@HDbBHJJ\\LTRNPTPPFPBTJPPNFXTLVDVFNDBFTNRNJPJLDPB\\@NFTXNL^NHVHHHPF\\\\ZNPRbTDFDJF^Fj@J@JNRJRDDPLHBdDJDRfHHPBXBJDHDNPDDDBNCTGJ@PHNNHJBVEXO\\BLEHIPANLTDNFDLHHHHDFA@ABIBADDP`TX@Lh@NHTEJBJFJCPBTFBLHHBH@TARG\\M\\SPCH@H@FDDJD^RnRVDHNXTlBDNJLDPAHCJGTEZFP@xMX@NBRLPPXVZLlJPLLHFPHJPNHFTDL@FCVQPCLBPFHHNPNN~bBDGTLZJHfRL@jM\\OZKZ@NDNJJHLZBJAHG\\@PDHDDJ@NCRINAF@HF@FINERBZDLLNLHJBTBNAvOPAXILGHKDANDRP@BITBHDFHHJFZHZSbOZO`@\\L`HNFJB\\JL@NGTMPQBETAD@LH^NXAZIJEFARDFHJFDBF@\\IPAT@dFdB\\@DB@LDLBDFBDBVETKNGhEDCDMCSBIJMHEXIhEH@LRCbGbOJ@LBPLHBF@\\SFAjCPBJAbKNYr]XGNQBE@CKWBCFABDALIFAP@fBHCJMNGDE@CACEC_EEC@AFEJKFCN@NJHBHAJClkBC@EOMAEBEJGFAF@ZF\\ALGDIAACAMC@ABCLCX@LPCNETMbgDCH@HDrJLAJADE@GCG]oAGAGDQFGVOR@LBJ@\\FDBBNFHFDN@FANIPILE\\AhLzMvUBCFMNGHAFBLRF@bSHADBDJHFVNLDHN@RAJBHBDBDJH@F@LKDG@SDGDCjBfHJAHADCDIJKDAF@FBBH@HEJ@DDDJ@FARMFEHSFCH@f@TBbHB@FGBG@ICYBON@BBDFDJFHLHT@RGPAVA\\BRDLFH@DCXeDEJCrGR@TDVFTBBEACCEEE@GHILGHBP\\DHRdHXJVFJDD^B`@`EdILAJ@LHRZLNHFNDREDB\\^HDDBT@^IXOJGJMHGFBJVPJHABA@EUk@CPGTIXQHAXBXADCEKBELMF@JBlZH@NCDBzVNBfBNCJKFBDJFJPFH@bOJAZFLDLJ|`PDhAjEpKZIl_FAL@BD@DIP@DPVLNJDH@NCJEVUFAfTTDDABC@GDALCbED@HHHBDAfaHAH@JFFFHRH\\PjDBFBXCZCbKPKJIDE@OBMRYFSBKAECASAAABAJGLEFALBXFjTDFBHHBL@LCVMDCCE]MIG@CDCNGFE@CCCKE@ADENEJATBNBVJZPLDD@FC@EA]FGHCb@LCNKnqNKZIXALDVTNBDADGAEQS@CBEPORMF@VjLJDATOdk\\wJCJ@DBFJDBFABANYFEF@^SHCDBDBBVJHFBNEFCBWDCTOFAJBJBPHLFTPNHFBFABADQHCJ@ZJFBF@BC@EGQFEHAVATDBDEHAFBBF@LAR@J@TGBC@GEEKIIM@EHKPCLFR@DCHQLGRAdDNDLFLL@FEJQ\\AFBBLBtKDA@GQMIKACBUH@^BzMVKBCDGD@JBFBtfFFFJJFDBJAFGC]@CFCJ@`PjX^T\\XJDFBFE@EEMIKAIDEPKD@^@pElKRG`M\\Q`Oze^]HIDQBCbSWlAZENGDET_NKNQRMXQNMJSRULYRWDCLBFAHEFYFGFK@AEC@CFI@Q@Q@K@QHWDgDEFCRGBABIAMHIDCAKGIAEBCHKAECEYECAOYGSAWEMBGFGBC@OCGECC@UBE@ACACLY@IKIWESGCCAC@EJO@GCEMOGKII@CBKHIBGASBKXADEBKBCDAXEFCFEFOLIFAPCFCDEFCXIHCBCAEEAG@OFEAAEBIXYDG@KJMJCXAFABCA[CGEAC@GDITKFMBGEAC@EBERSDI@EISAG@UCMMWGO@EBENKHO@EEQ@EIIECIAG@E@oQ_YQW@IBIAUCOEMMK]IWAaD[CECEAG@KH]FIAICSAEAcDOHCBEAMEWCM@MFKBIEOMaICC]kQQAGBGLE"],encodeOffsets:[[113793,40312]]},properties:{cp:[109.99029,39.817179],name:"鄂尔多斯市",childNum:1}},{id:"150700",type:"Feature",geometry:{type:"MultiPolygon",coordinates:[["@@\\@PBJHN^VB`CVI\\A`DfIZMPMJONKd]DARAPJLBjBrAhPH@JFPFnXFLLhBFDBPFXDRAlCQVAXHRJ@DAVBNHJb`HDRFRBPHJHFLKbGJQFMDCLTJ@NHNpNFJFNXNLDBhEpFXR\\^BRAHGZQfBFLXJH@RDLJFRNXfTFXAPCBS@WBCdA^FJDR@UFFJNLDRAnQPBHJVBNEdgRITP\\HZDRKRGLSFCAG@IDMDANGBADAFCF@BAJCFABCD@@AHADEF@DCJCBEJEDC@AFA@CNAHGNI@ALCBCA@@AFGREF@BAFAFE@ED@BCB@ACH@BEHA@AJADCBEH@@CLG@CHAFGF@JC@CD@@AFA@A@@AABCFABADABCJC@CFAAAHIAABCFAFEFDD@DAAADACABAHABBBAB@DCCADEFBBADBFCD@@CCABA@AD@@ACCCBA@BACAB@A@BAE@B@AA@@EAB@AAB@AADC@AF@BAAAAAJC@AD@@BD@@AB@DBAAB@DCB@@AD@@A@ENE@AAA@CD@B@BBBABAA@DACCDA@AB@@ADBBCB@BCAA@A@CB@BDB@BBBA@AB@BCDB@AB@BAFBBABBBAAADAEA@ABACADAGADCH@ACB@CAB@BCBBBC@AA@ACD@AADCL@AEDAD@BABBB@@AB@@AB@@ABBBAB@BAC@@AACDGDBDCC@DA@AFA@ADBFCHBD@@AAA@AIADA@AFAL@AAD@BCCB@ADAAAD@@@DAC@B@@A@CBBBAB@@AFB@ADAB@BAHA@ABB@@F@ABFBD@CAH@DCA@BACAF@AAC@BA@AB@AAHABBBAC@BCA@BABBBAA@@CD@BCD@CA@AABBEAAB@A@DABBDADBBEC@DAAADED@BCDBCEI@CCF@BAAABAHBDCBBB@D@ECBACAHACADA@CDAF@@CBAJCBAC@@AH@@AC@ACBEF@FBBAAAD@ACBAF@CAFA@AF@AAC@DGD@DBAEJABCFBDAAABABB@AF@AEBAECBCAADAAEFACAF@@@AECAAABAC@@ASKBABA@ADADA@CF@DD@EP@@CBABB@DF@DAFDBCHBDABAFB@@CDBBHCBBDC@CFBFABBDBF@HBLA@DF@JC@ACA@AD@FDJAFEH@FGCA@CFCJDD@BACC@CDAF@DAACEA@ALMAI@CJEAGLGIKJGJBEC@AFEJABCACIBAABEHCBEIBECADCB@CFEAAEBAA@@BCHA@CCABAHBF@@CBAZBBA@AEC@ADAPHFABBEBBBDADEB@DDDBBBJADCHADACCE@BADA@A@AHFD@NE@AGA@CEA@AFAHBB@AI@AH@@AGACBAALEJAAAGABAH@DBJALHVLRNN@HCBCAIDCFBDJD@DA@IGCACFC@CGCBEFC@ACAM@AABEAAG@AAFEHAJBBABCCAI@KDCA@AZIVKHGRCFBHBFA@CCAMGEEC@@CXBLCNBBA@AIE@ADAVIRDNCDCDGACEECAGBKHE@EA@CDCFAH@FEAAACECEBCHC@@ELKBEWMAAFGBKNCHAHBBAACA@KA@CFE@AACICAA@CNCF@BCJ@@EDAHD@DFDHBDA@GACAECEDCH@DAAAAAO@GEE@@ADC@CAAO@AIKKAGBGAOCEG@ABDNCBCBSGIGCEBQTO@GAAOAABCFCHCBECCCECODC@AA@E@AH@JECCEAEBCABGAGJEDEACGCBIACS@I@EHEDEH@LBDBJ@DEFGBOBCA@CECCE@GGWOKBAZG@CCGECMGYAS@[JO@KC@AZAVGBENG@CCC@IEGMKOCcAKCEEACBCHCJEDC@CKGMCMISGG@MBQFIAACBA@CGE@EDGFADCNK@AEMAECEIAMDABAFIHEBKBGDGHGBKAcKEEMKGEECEI@ABAPCLFN@VIJG@EACOCKG@CDCVIN@FADIZEJEFGACAACEAIEGOG[EOEAAFINGDEHCBCAISMAMDCDIJILGHAFE@G@ACQAGEEDGNI@G@GDEJKJUBI@AECGAACDKAEQ@C@GIKACACIGGICGAaDCE@C@ALABGECI@CAACDEAAMGIAYFBDDBPADBBHADWHOAKGGCKMYUICO@KAECAC@EJEJ@JDFABC@ACCEAMBGAOGE@KDC@GGOGKUCQWMEAW@EBCJsFSEECAEDCL@HABEAACAcJI@ACACDMLMFKVQ@CACACECGAODKFgXOFE@GAAKECSC@A@CVEFMJKJSBEA@SEKE@A@EFEF@RANAFCBA@CMKMCS@EACGAGBCLKNG@CICKCC@IBMAGG@ADCRCN@BABEGAKAMDKBEAOGQUGASBECBEAQHU@IAAGCCBKDGAMGCEDEPI@CCIAIXMFCBEMCKDABKDGBICAA@GBCXMBA@GIKKCWEMIUAaHE@IIAG@MJE@IGEAAI@CBCFA@ECAGCCQGEA[FG@GC@AFIVGTKHCX@FAFKAACCc@OCQDO@IACA@C@CDGAC@GACAAMDEDCFAF@PAFABEBMCUOUEECBMJMIGGEcE@CLEHANBVEHE@CcS@EHEHEJCP@PC|CHAFCDEBEAAGEUISEMBKDGDGLEDGBUGSQYAACDCLGTCVBHANGHEDKBQAIMMKGKCWC[AQGCC@GDYCCSWaHK@gCKEACBCJIRIDODCLARKTC@@@CAAUOAC@GNMRINONUDCBEAEEE]BCACCBI`S@C@GIGUIsCiGç§iv¡¢PADFHA@ADAAAH@BABEF@@CDAH@BAA@BCJADEAAB@@AD@ACD@CEBAAADAACB@CA@CA@@Cû@CB{dsE_]£OM_eNGVIDA@IKIBCGIKIO]AMDMBK@GOKWEUBUCEUBYEIEKKA[KS@SIKBEA@AK@[GǛҤVJNL@FCFEFGFMR@HDHLLBD@`DFJDRFBBGJKHIPKJCRDFHHPHZJDF@DABaBODCFE^BDHB@FBDNL@FBPFFDDRBVCL@L@RFDBDDDLFDHBTB\\T@FAFMREJJT@FS`CHALIN@DBDLFbDAL@FJLBF@FCFKPCHBLCN@TJjBFAFCFGBKDAYCKAIEGEMEGAG@IFG@gIG@E@CDKTOJWHYBSDMBEmBgFNQBż؜RCLMV@bH@IFWFGFAD@`PrITEN@PPNXBFPN@NCdCLHXBFLPNHZF"],["@@R@ZNJBbE\\FpGnARD\\TPDL@XEL@jRJH\\^JHJDLD`@^AfIxAH@JHDDNBXCV@ZJ~PL]LIvKRKTQHELCTADAJIreFEDGHANAHBXFL@LCNMnWDIAG@KKK@K@GJU@A_EIECEBKAKBCPMNEUPMJG^A^HJCLKHGJWVOBKBEHG@AACQQ@CBCVKFCBEHCNGXEJG@GGKACKCICIQ@EOK@QCECCWGMGwOaEOEGEEIAKW]GM]]A_IQQUOISCMEWQ_CCAFOOUBEVMJS@CQGKCIIS_[OSGGAQGOISCMOg]OEkCICCOCAKECGCEAKKMCIAEGC[ISKGGEEcYYEEMGGQG[AW@QEe@UECCAO[GAC@QAAGAcWYCUIIICIEEIC}OgS@A@CRMHCZCPITEN@rHR@JDNHHAtUdCDADIBMDIAABEDCNBJEHIDAFE@GDAHANEZBL@DADInEDC@WBEDCBIF@VA\\GLGV@HCNIFApDDE@EBCFANKR[PCZH`FTAZMPOJEZAFPCLSpWDCaiGQXKVMXSLOTMNS@[COBGJCvEpIxOaLApDGPBLBZFjZJNH`DHVFP`FHRF xXdMdhFlJTNKLINXH`RJLFRXLBDR`JHCZADBBJF\\DP@FCTsLGLObMpFTCvBfL\\BVE`_JqPKH]BCPA`DJIJUFCfELOHOHCJADBVLVZN@LMFMDQJOh_DE@GSaJSK[JIOXAXAfGtaP@^FFLDJFz@dMAQ^IHhND@JITITIFMEKkMCILQFQB[AUCICAq_IKCIUGKGG¡C]CAGBC`e@CCMa@AFCZCTGBkT[DM@YBKLMBMZUzL\\ABMDGCYCYJSBK@GAGIIWMIQCQXMPIDQN]@QEMBMTOLKLSNMHMBIDKHIAMEKcgAA@EVGJKJMFMDaRWFMAOFMjSHEV[kaACAMvW^QJGNS@IIIWGGAGGCGROZK@MCOIIMKCCQGACJKNGFGBQNG^EPGDKGWBIJMPGJAP@nKPKPIHMAII]@KL_X]ncDCXCTHnLZDVARIZIVAVCZSF@tFPELMVGTEXEVBV@LAJEEIFIbO|MRAPBjVXJZF\\AbIN@nT`DPIdEVBJDFNJLVF\\DPAhEZA~DlHFD@Z\\JhBxNfABNCbJJRDNFHL@`jdLNNBRCZUt]ZGbMR@RD`LN@PA^WX_hg^O^MRKLPPJ~JrJ|@hCz@NDHHJXFFRDdDXDPCFEFAXDHDJBZKXCLBfTNBN@\\KncHCHKFUXE\\MŻ؛HsAOE@KDEROFIAECC@OAg@ECBWCMQGkCQCACHIDKFCTEBEAEDOAEECBKEK@GEC@CLIBAOOJGNE@CEEIGGOIK]WGAW@IBQAICKEEEAMAGQOKHKFgJABBLSHCDDLCBSFGDC@YBQDELBP@LKJM\\GD[JG@GAGEEGYW@ELCFE@K@EKU@OY[IS@GLILADBJJFACQOi@CDCTBF@JQAKDEXEBCBODEHAX@H@BOHIRITCJ@FCFKFK@GGOBE@QM_@IDGHELGHADEAGDEFAFBNHH@\\CNMBGJMHIJAdCJDplJBZBLBNPFBJ@JCJODAZ@HCVAHIHEHBbXJDVDFFFH@H@DIH@FBFFBjBXEFEBGFA^EF@FDDNDBPDPEFCBCEKLOFAL@lPRHLBn@P@^DH@AGIIAMJK@WLOFC\\EPGJ@REF@NFPNǜң\\HL@@BFBLATJT@LB\\LLJFZFVAFVDVAXFPL@HALCNBNP^LJHJADLJ@JCBUJMH`fPN¤`^tF|cDAü@@DB@@DDBA@BDCBBBABDFC@BDC@@BA@BBCFIBADB@ABG@CB@DE@AFABG@BBCB@BGBCEOB¢¡ju¦} f^BODCNILAJCbOPIFGBEAEGG@AFGFE@EEIAMCCAMWQCEGMDC^IJEBAIOCCCIHAECCecCEDOGKICKF@HBHHPADEJMFKJQJiJM@ICEGCI@QDIBIBILIBEBKFELGLENBFDDHBJDDL@@C@IRQ`UHOFCXCLFFBNCDCACCCEG@CJEBECCSIG@CAAA@CDAHCN@DADGBIBIAEDI@CCCIAG@KJEBC@C@AC@CFKPQBGBSBEJCR@DAHICECCUACE@EVKXSJETOLK@ICGWQAAHWCQBI@KEKAEDC`MFEDICGGCAEBCFGBECAWBICACFEJCDEIEFOACCAOGCA@ADGRGAGAECCSIQOCEIIKOOEGE@EBARCDC@EIG@C@AHCR@BA@ECA_AKG@CDEJCHAJC@UCYAEMIACHW@ICCOEKAWFG@IEGGIQOGMKCE@KBG@GCASCQ@MCECIIIMEA_HYLYD]HKJaJIJAFBFNP@FCFMBQGY@MAKCUMIAWFSAK@SHuZMDKBcKYGMEQI[MIMGOAO@IAGEAG@EfsDKBKGEIEGK@GJKDGCCGCIKCGEWOUUUIAKE[]ISGGCQCGEGAG@KLM@GCGEEIEBCACC@CBAHEDE@G@EECWEAA@CFCJCPGBC@GCGGUGGKGIIBGDKACECGAE@MFC@qOO]CI@MFSDIHKFG@EACQMQGoaAGBODGDSDEBWHUGG@OCKCGCUEEMICE@IBUAMAEQIUSCMCIDCBEAECCWICC@GHIBAAEAAEAOHKBECIMIKGCQGIECC@CHIHCXELEHGBGGIQKEK@EDQBODEBKFODCTKBECEUEgSUEGA@C@CBENONIDCBK@IEQAMBEHI@ECGGECC@GBEFEHAVEHEJIBK@KCEGGACBGDEHGREJELWRIHCBCAICCEAOCECAC@CFI@EQCCG@CHEVANEHG@MIEOEGG@GJGBEACACUGAACGBADCBESM@IACcQOIAGLKBCACGCQAECACDCTEJ@FCBGECBEDA@CAAMC]SCE@KEECAGBQFI@GCEE@AFK@AEEMEAOECGAWDWECCDIEECAE@QFK@HLDL@HBDDBRDFBDF@DQL@FBDHDX@VFXDFDDH@HQJETHHNBHFBD@FCHGDGCEB@@FJA@GDEA@CDAAAMECBCDDHGBGAEGE@IFIAEHKAABADDDBBGFBDD@ABE@AEAAEBCD@BFBADBBNBBB@DIDBFEFDDHB@FIFAB@DFDR@D@BD@BGDABBBHBCFBDABCBGAAB@BFFALEBCAEGE@ADFF@DCBIACBCFBHGDBDHDBDGBEHIBCDG@KFIDIHIBCDFHADABECEGC@EBDLLD@FOFCFAHBDFH@DEBG@E@KFCHDDJDBHADCBOASN@FADKFADBFNF@DADIDILDDEB@DB@FABB@DDDJFD@DCDDBBMFK@JBBF@BINE@MAGFCDBDJBLFAHIFADHNHBJAHCB@BBCLBFFFBFCFCBYL@B@FAF@D@DCBMBCBAFDJADAPDBRDDDBDCHEBI@CF@DLHDJBBBFQRAHADEFcHKJQLGBI@c]ÁqaoQwkweqgSQ{UEo]WQE@UOOMg[WMy_GEU[YU[e£oËgSGQ]]KYGmGMBCAEEG@]MQSCOQcOUKUCMMY_kqySQIGiiOKQG·cuMmQ[EuWQK]a·cSCQG]SOIwi_SIKSIIIÙWkQ]eWIKWa]cEKCKA]OUMS@AEGIm@GEOKOIGaq[{eEAECËu]O[I]OSSU[WUgW]cQIqQUEe]YCDGBEFGBEDE@CDGBED@DEBAFGBCDGCIDKBQ@GAAPKB_HaPY@GBIHWDIFUNUFqFRSHMLE@[FG@GCUCCBCHILBHABSASDS@KAMHGBAACGGGUCOKQAYKIAEEKCKEKAIEBCGCCEAKGI@CCIECEAGC@IGGCEECEEBGCGBKTWCC@CCEKBEHKDOCMDIIK@WBAEGACBCH@DKNSAIDCHSNQ@MDS@QGWGMGSAUAKDECA@KBEWECEAEE@GII@CA@CIEOEM@KACCDA@AGCBC@AO@GE@EAASBCAKABCCACDO@GFKBOEAC@EQCGBGIAAEBGAGBGBAABCICeIEHE@GIQAADOFI@CDEBGDGACDIBCFKFK@EFGFG@EACBOFCD]CCAMHICUBKAEBADK@ADGAEBEB@B@DC@E@CDCBC@GCGAO@EAC@A@GDGACCK@ACIBICACCBCAAEC@ACCCC@CDC@CCB@@AMGAAM@AABCE@@EE@ACEB@CEAAACAAAGACGGGCGGAEEKGMAADK@EDCABAEABDEAIBABC@CBBBG@ADB@AHA@AAADCAE@BFGBBBGBBDCDBBCB@BEBBDQHE@BDG@AFIDGDG@CB@BE@CFEBADEB@HG@ADMBIACBQAECACGBG@ECIAWDBBABCBABGD@FIB@FMBE@EDEAMJID@FIBGHABFF@JAB@FCBCBCFKFGHI@SHADBFCB@DED@DC@BDCBKDGAABE@CDGDI@ID]DGBG@ABIBMHK@OBABAHCFBHGDM@WIOEED@BBBHFGBEN@JCDBJAHBDFF@BCJGL@FLH@BBD@FRNLDXFZLPLXLNDT@HDDLEJBLZHRBFJBJGPXL@HW^OLePOJ_ZqXONanIFOH[RebEJHJPRVRDHY^KB}Ig@MBOHDYDEDMPKFKDSBSAUJKFSB[NcVeLiF]JCNHRMJ[AcbMP_LIEEKeUIOQIO@GHOLQGagaDaIKGQGMDMAm_aQIIwAKEOMOGYEEHMFKBCDMHGBKBQDGHGDCDCBeMK@GACECAWAMB@AEABCKE@A@ABCIE@AACEAACE@@A@CC@ACIA@AAAGACCI@B@CAB@CC@@ICCEBAECD@CAA@@AA@AAA@@CCABAAA@AEA@AA@@CC@ACC@EAAAD@CA@AC@ACE@EA@CA@A@AAABAAACABAAC@ECB@IA@@A@@ACAFAA@AABACABCACBEECBA@@B@CCDEC@FABCAABABBBCC@AABACC@AA@@AK@AADCG@BACABACA@ACBAGAAC@GAAAC@BAGCACG@@ACA@EABECAADAE@AABCCBBAA@AAEB@CA@CACBCAABAA@BKAAAA@AACACBECC@@AAA@ACCIB@AC@ABA@AAA@AB@AAAC@A@A@BA@AEBA@BAGA@BC@@AAA@@EBSCEE@AAA@AC@BA@AABC@CBACC@AB@BC@@@ABACD@@AC@BACAA@ABBB@@CA@BE@C@DACCEBB@CDA@@CCAABCCABC@@@A@AAGABAAEBAC@@CEBACAABA@BDACA@AB@@AC@@BAAADAABCAADBBAAABAC@@AC@BAACA@@CG@C@BA@ACBCAE@@AA@DACABCEBACGCDA@EC@GE@@B@@CB@GCBAE@FCAAK@OKC@EBGA@CC@CAOBIESCS@CDG@CA@GICA@ADC@aCKKEAG@CEKFKAK@GACBBDABEBCAD@G@CAEHKBIFGB@BFF@FA@MECBCDBBCBBHEBAAABCB@D@BABBDGBADJB@@GFGJIDMGG@ADABJB@DIHCBCA@CEAC@CDDFEDIDECA@AFCDDD@BABC@CAM@@FKHGBKA@DHDBBGD@DEBCFBBFC@BBBKJQFK@@DFBBDCBC@ICEDC@@ECAAEKBDC@AG@@BD@ADG@CCCB@BC@¨Ƈ­j@VUÒGETaĹĎơǂoxQJĥJ@BDBGBEAEEGACBBL@DKBEHKDAHEBSEC@CFCBGE]AQFOBICIA@ABEAACAEFEBEGCAMDK@ICG@SDGFGBA@EEAEQACBCFCBEAMDGEC@ADDF@DEDKBEDABBDADE@GCEACD@DCBEBGDKBABDFBJABABSDICKAOBKFWEI@ABAHEFIBGAIGM@EAAACGECIAKBEECBGBADEBBDIDCHC@ECBE@AEBADA@C@EC@EGKDAACGDKB@DFB@BC@IEDC@CCAE@OCADEB@A@CACC@CBCAG@ACECEDC@CCBECAIBCDC@AEFADCAACAODUCGDMCECA@ABDDEBGAAEAACBCDC@CAACC@EDI@@BFD@BOAEFEEI@ECEFI@CADC@ACAI@OFCDABUCCB@DC@EECBA@BHKHECAC@@AFA@CAACM@A@BHABI@CCC@ABFFABQEIOCmCSMMMɣȁFSAGGGOESA_EğįǡņxxǹÐ½Hu@ķOǑkMƽŕ«UMQIţĹ{M̝ȨƸE@BDIDEH@DNF@D@BHBAFFBBB@DADDDBDGHA@CCADI@EbʀĒXDoɌFPzƖƦRX}¨ʬώÞĦʒΖNRXQ˨ŵǜ³PEǨEź·¤[ĺN@B@DFG@@FHD@BABEA@HN@HLNBDDJB@BXPCDI@ABNH@DCFBBHFHBPFBDBFE@ALBBFFAJDD`HJCH@FDHAFBHAHEFDBDHCD@BB@FFBD@AC@CF@B@CDBBD@FADFDED@RAHABFPBEFJF@BABKEC@@BDHHBJDJAHBDABBDBFD@B@DBFH@HDDA@C@@LFHABCCC@CJ@CABAJFBCFADFHET@RAHDDCHCV@DB@FCF@BBBHBD@DGHET@JFBDAFJDBB@FHDBFG@@BJFDBJCFBBBBHCDAHBBJ@JCFBBFCDID@B@BJAFB@FCFAFBBHD@BMBADB@\\EXKVBBEDAD@HDDDCFBBD@FIBCJABDEDAFDFTJBHEHHFBBBBHCBA@GB@D@DHHBD@DCFB@FLF@NBDPHABGD@DEBADBBLDBFTAF@BD@JDDIACBCBBDNB@BEBQGAFHHF@JCNAFB@BEDGB@DNDHAFADCDDBFFD^BV@JBJDHFHD@BI@AFDBDBBCBAFBBBAF@BFBH@FF@DFBAFCF@BLEN@FGFDDCD@FBAHBDHBHBJFZCFHR@PFBEBAHB@FADCBGBCB@DHFD@B@HEH@@DQL@DB@HEJ@B@@DOF@FH@BCHAB@CFBBFADBEFFDCBCCC@ADBJI@@BFB@BE@EBCHCBBDRDP@JANGV@XDABEBABFFBBJDF@LCNBBAFABHD@B@BEFEDIBAHBHFJBBBGFCAEA@FJFBDCBEAABFBLAD@GHFADCDAJFB@BCD@@BAFCBEBIF@HAD@BVFCBK@CHEBJDLBKDADB@HBFCB@@FDHDBPDHED@TH@FNFIDBDFBIHDBHCCFBBBDLBJADCB@FDBDLDDAL@BA@CB@BBADIHGBGCAB@BRDDDDCH@BDCBABEACB@L@DPHDDDDF@BCB@FDD@CGDAJDHHFBF@DAJID@BDAHBBB@BAFKDADADB@DIHAF^F@BOHAFDDHD@AAC@CB@FDDA@E@APBHCDCDAL@BAJFCHBHABEB@BZB@ACCBAHB@FABIB@BB@H@JGHADDABGD@BB@PEFABBDFBAAE@CB@FHD@BA@CDABDB@@EDB@BABCFABBBHABIDAJBEDDBJADGB@HDXCD@BFDBDCBEFAB@FDHDD@DCF@@B@HFBJ@CF@BDBH@BCDB@BB@BGBAHBNCBBEDBBNA@ACCF@BDCFDBDCBBABIB@BDBL@DBDDBAB@BDFBABE@BBF@BCB@BDFAFBB@@CH@FCHBAAAA@ADBFDAEJ@BEB@DJJ@@AEED@NNFBN@DB@CDABF@DB@DCF@BBCDDBP@@AAC@@F@DHCBGD@BBBJ@HCFCAA@AJBJFBEB@BB@FB@HCNBBHD@BCF@BB@BCDJABBCDDBFCFBBBCDBBPADAACD@FBBCD@FBAFB@HAFDBA@ACCHBJ@DA@ADADB@BEBAD@@JAN@DABEKDCA@ALAHEBAFBBFFBBABGBAD@FDFACAKABCD@HBDB@H@@J@JBH@DBADHEDADFHAHLB@FCBAAAB@DB@H@@NA@@@CB@DBDMHAD@BHFDRBBBCDLFD@DEJAJBAEFAF@JFBBCFHF@DF@FFJ@BFEBABBBFAFEDAD@BDFNDEB@F@@BGHALBBF@FFFB@DL@JEFDLDDDA@IBECC@AFJDJBTIBCEC@AF@BBFJA@CBAFBFFD@DH@HDHABDF@GDCFHF@HN@BFI@ADED@BNDDB@DJD@BMH@DJABBCDADFFEFBFFBHCJEFIB@FBRCDBDFD@D@@CAGDAJAHBB@BHBBDABCDA@DGHBDHBCBABRJLBJBABGBBHBBHBPBADEBB@NDFFD@BEDAJDHJD@@EB@BJJFODCDJPHF@DAD@BDBD@FMF@BB@JCDFDDFKHABHFCF@BLDBBCBEA@BBBP@RDRADD@FBBFADEDAFAFD@DQFEH@HCFAJ@FDFDBRBLADDF@BFKDEHWLEDI@CDCHKACDAD@DDBHJCHBHBDF@L@BBCBIAAD\\HEHBJEDBDFDJEFABHADEDS@CB@BDB@JNDEFDB@BGBIACFID@BBDHBPAJBT@@@@DGHABBLFD@HLDXDJHFLFDLBBBABGBEBMAK@GDED@DDDVBBDAH@FEDKBCBADBBDBN@@BABMBCD@HCBIBIEK@E@IHKBIEAKCACAGBGJC@GA@CFGBCAAICCBCD@NADSDABADGBKFKGAABIECUCAADCF@@CCCGCK@YFCD@FFHCJ@F@BGDQCKBICUMKMIAO@GF@DFDZJBBADABYIMA_DCBAFBFFD`JDHCDEBOAOBEFAJAFCBG@GC@KECCAGBEDGHADRJLBJVBHFDTAHDBBALDDDBRCRDJ@DALILGDCLAF@FDBFIN@BFDJBHFBHAJ@HDBHBRCJBDFJD@FEFIDEHBBHHLBZFDF@JIJBFDHADKJEB]DGF@DBDFBdBLJDNFJDL@BEFAJBDRFHDHRCFABEBKAEDAFDD@HFDHBN@RBFBDDFRADSJAB@JADIHBFNHJLDBLBVCVNTFFH@FEFAF@DDDJBFAJQHEF@D@LFDHJFFHDFDLDHHFTDDBDFAFYLCFDHFDFBh@TFH@TCF@bXBJANBBDBN@NHDLDDJFV^JHLFDDDF@NHHDFBBCFQHAB@DHDNAHD@BABIHBFBBRDNABBKHGHCT@DHH@DEHWNEHCH@XFFJF@FE@@DAHDFADG@GBEH@HDLDBHDH@FB@FCF@DF@FABBAHF@BDJ@NTNHTFNJLDHHZTDDHVLJBLNTBH@JHJJHNHL@`JHJ@DGFBHFD\\DVFVCbNLJFJADG@GBADDDHBPA`VFBPBHDBJFL@FCHQBCB@DDFLDNCVBFD@DELBFHDX@JD@PGHBBFDIBGFCDCFNRD@V@DBBFDDDHCTFLJJEFKDO@G@CDADFFJBXELADDBJ@HCFEBOFCFAFDFBDOLCHBJML@BJJTDDFBDFBLATITCACGA@AJ@LBRADBDLAJQLULKLADDJRJdHDBBDADGHGHGBGJAB@DFFVLVHPDP@jAPDLNBLCVERDPDDJFZLJ@VEHBJH\\LLLBFEFOFWDYHEL@RLHNFZBVAN@ZBLDJXAJENFJALBFHJHFNFTBFFBFAJQ^GHMJ@JBDFFJH@BGDCD@HFDPDJFLDRLFHLFHHDF@NDJFBFBHADAD@DD@HHDAHBHCJ@HAFURCBAFLPBHDDBFFDHJ@HCHTLENBDDFLJJFPFhFHDDHAHOPOXBDHFJ@\\GRAPCT@NARFPB^F`RXZJFFDJDVPFNHFdDPHLFFTJJFD\\EJBBDDRPHNbHFNDvF\\@D@FPFHNNFD`@RDFF@DOV@HDDLBPFbBjJPD^LNLDFCNQT@HLHHDN@ZBPCTG^CN@RFFJ@F@RIPBBPJLBXEVAXdBNDPDJJCLFPCPDLTPRDLFHFFFPHFFJNTh@DAFULYHEDEHEHBLDDFLLLBD\\VFJHDHBHJLD^IJDBD@D@FEDEBGBAH@DNJZHDBHJBH@ZOR@HDHPHJDVR@LADaZMPOXMLEHBJAJCHBFBFJLBLPJFHCPEDGDYDK@UAMBKHITETIJCBeF]JQHONOHGFCFIJAFDN@RKJEN@DDFHFdAHBDDHJBDAFIV@JTRPH@J@BNP`XDDBXMT@PAJ@FFLFHLJNHFFDHAFKLWLAH@DFHFDtPRHJHFHADAFGFKHSDUASEMBEDKRYLKD{HIBUNQVIFOBmBQBeVqR`]ZIDC@MAeIKIMGeWQCW@Q@[BSAgDgBmHIAUEiW]cWQEQBµNiAWBQAM@]HWLKHGT@VIPIR@N@FZrBLFHDJHJTPHN@FQREL@NCJENU\\MLGPALFHLFrALBXHR@PFDBHNJHfLTLNF\\BhOJ@JBDDNVNNZRHJDDfJfRfFVFNJDDAPCVDHHFRFzBPDFDFF@DERBLPRDLHHNHJBX@rXDDHLDPBFRZdbNJRHPDNHjPJFJRDBPFRBHDFVFJTV^RLDPDLDN@JD\\PNFvFJDLTBPFNHJNFrJJFFD@DCJJHBDAJMP@DBDDBVFLH\\TRPHFbPXBPFv\\^TLDD@XEJBDB@NMTYNAD@DFDVHT@PDZD`JHF@DALBBL@XMJAXB\\AFBDBTPRDlEfIN@LBh@\\EN@PFVRPJjDD@HC@IHER@LEDUBCFEJA"]],encodeOffsets:[[[127444,52594]],[[124037,54557]]]},properties:{cp:[119.758168,49.215333],name:"呼伦贝尔市",childNum:2}},{id:"150800",type:"Feature",geometry:{type:"Polygon",coordinates:["@@¶C~\\fTtTh^ČĖ¢ò^nTŪjNĘ˨ĲxXȬäƢ¦ȲrnFȄHRdVJV¬bÐfCÖƌDĄGĚMpRrB~`JHÊFĒdNVAVCg¸@ĪL¾AƠ{ZBèBRGnK^S¬fîbH\\GZTȤiXAdA\\HàPRIXIjITKFeNUBK@IEK@EDILINCFIAOBGJGFKAMHKFCLEJG@MGILSlBQRnáqOUya¡mw­Í[I_eMMSGKCqGQGKEGGCI@OFI\\YbyL]|[DEbuoo|sPK@EHKRGPMDKAUHMVYJGBIAIBQBIP]HUJkFMBUEIKSKICEBGDGRK^GLQQQzMD^E|A\\E`@vF®DBMCYCMEKW[EKCMCGGCQA@CLKTILKNI@CCGPMDCZAPCDCBSFATA@YJQIC@CBCQ@BK@IFG@CGEDCFO[@KDSEO@@@CSCEC@C@KNEDC@KIGACCEGHIAC@CLBBIIKKAGEAE@EFGLEAKFA@EJAFEBSFMHIPGRWDWHKPGJMJebiTKTQDYMSSEiCQMCKD]R_AUBOJOTMNCRAX@P@TO@O@MHIPALHHJPBLElITERMFMDY@SFEBIJYDCJCFEPALCV[HCDARLFA@UKG@@HECQd_FMCIIESFKAOE@GAAGEK@CKSBIECECMBC@GHG@QCCCGIEAGFCSGKGIDsBQC[QUEQ@AcDCC]GWAcAQH_AFaScDmTMHwiSSQIUCWMKQHaEQQ[BYBIHQFIJILGhOLGHKGEeDMKFMJIPKHMCGEGOOCGHILCHGDKMOOM@CDCRGMcQcCGO[GAKHGJ@HFFDFBDAFSAUESCQ@qHIDCFWfCDG@KEQC[AUBOBQHS@KGEGCICEAAM@APDZ@JAHEHA@aGSAe@G@EDGTEFQNEBI@CC@CFI@GAGEAE@CBILCJCDGBIBeGiACDCH@TCHKLE@G@CICAGAIAQBM@GKCUMGECICAGBaTE@KQEAGBMHENADuVyNgK[BKFOJMJEBM@ECEGAMCA[EI@KAQ@UPEHCRBHBH^pDH@HCFIBKBqIGCG@CDahSNMFODKW@KDAD@BNDDBBBCJKH[BYEE@EBIHAFBFPN@FADklIDGBGAMIM@EDILEF@BFD`FFDBD@DCFMHMPEBcAO@EBKJCBAEBADLX@DAFMRWHq^MZaLIBOAiDEB[TE@GAOKKAI@aPaHQDKG@gFWJGFINAJDTCNCDgFMHSLUFCAEAACCK@KCA[@cAcES@OB[JE@CAIEEGQCEBIFYJWB]MKGC@SBAFORSNMHK@[IIAME_G[K_@YPaPYTYGIEGGCEAGJS@AQOMCCBGLKHWJOBuPMBSAIAKGKMCKAYFQJM@EGEE@MBQJMDI@CCCG@OH[BGAIKYIGMIMCY@YL[PiNK@eQIGKYHSAC}aMMMOGGOEKAODUREDK@SCGEOMGIEOKGOKkIYKWUOOQKMAW@wNO@YESFIHGDOBKCMIACSkMWCGQUQmC]CIECG@G@OD[T[NQHSBG@GAKGEAASDOEIAIFIGS@Mg@KSWO_CCABAJ@BEBGCGGKGECCMKSBMJOFGAKP[FWAUGIMMOGI@SHMDCACCMOGCICWAOAGGQeICcCQIIGIQMO@K@IEiE]CICEaSOQYM[[OEGGUGMG]MKSWME[@OA@b±EÑ^Æ¼®¼K^ş^ÏFç[__]DijĕCċĴg¨ÐMB~Âg~yzBF|I`FdUU¤@B@ZO@@TBcPeT½fK@EFQFMBIAGCCGC@Q@SFC@GHE@CBAFCDC@ABCAADCABDIFBBEBBDA@ADED@BA@BDADOBCDJtAB@BADED@DAJIH[DIDWBymSE@CCifgNÁNaLYFBJñxm^¯H¥`uRKRWJWBUTwjß¯«~«vC\\EXA`C\\Wj½°bdcÐÚa|CFQ`Wn[F`kŪjİHjPÒ"],encodeOffsets:[[107760,42754]]},properties:{cp:[107.416959,40.757402],name:"巴彦淖尔市",childNum:1}},{id:"150900",type:"Feature",geometry:{type:"Polygon",coordinates:["@@BMp¹þ­ÒľGTQØá|mVOFAú¸éÁBAFm¦ÕvüĕDe|QļEoÜy¼qĬmÜcnK\\M|ec¬u¢ğªKezGNQfwPO^aZWXOSERMPMBYfsPA|ZXP\\UnBRNC\\eDFLIF@B`P^Tlx`VPXVNVF`RRRL\\\\b^tŎQARAl]N\\~Q@EdM\\EVA\\B^HTLRTNFXAVENGNWBOHSTITEVGXONKNKVE`CED\\CHMQSEQFUHMFQAgRQZGTGLKDQDGJ@dVPNPDTNHBZC`KTKR@RDHJ@JATDFFFL@~O[fwzjN@zQT@|F@LFDnFb@RDJFDDDJLhDHFHXLLJRFNJTFNBXERK|Sh]^QD|SAVVjH@X[wBU\\AFIDMCGHKRhL^FJEbNPNFjVNLXZXfFZBPFHFBNBFApaPAPBWRFRLTEJEFEDGAECEICWQEOHKf[UoèjbEHERC@CCARAHBDIRCGOC@@E[CBAZUCCDAHoYAIcG@@AC@@BKA@AC@@MEBOIOACCG@Q@CDACAACDU@]CABCFCD]DIACCSBwUI@YBKBaT_PMD[DK@KAKEUMICeKQG@I@A@GEECKCEGEBGHEGSBEEEGA@ECAMHMBK_MQAK@AFAFE@AGA@AHCHGPGHCAAC@EADCCC@EDC@CDEHCHEFBHARUDABGCC@EAAGBOCSGCECAACA@IKACDC@@aBAAG@KEiEQGIDKFG@KFKFG@CDA@@CA@CBEDADOAEEBEKECBADECGB@B@FCBAAIKFCBCAEGCBEHAAECCCCGAEBQBIBAABCFAG@@CDIFEUQGIOMIMaiYWSMiciiMKIM@AQICGBECE@EBCDCLKGQDA@ERCBEEOIG@CCATQbYAEJKGAJQSCQHEIJMKIHIDERY|mAOCCCBSFCLUUYGAAI@GDURaASCM@IJMFcFC@@BNMBCBC@EBEHGCG@KFOBIACCE@IEG@EGCDEAEBG@EBA@@E@@G@EBEECACBE@IB@BCBIHAA@ABAA@@AA@BAC@BA@@I@CDCEEAACKAGEWAEIIAIE]GAGEGKEMIMMU@KFI@CCCGMoEIIGMEcKKEIKGOES S\\OB@BCNBFABAHACCEBCGGEBAEABCAA@CCA@ADCAGD@BA@E@E@EAEIEEMEACCAKHEBCCG@EAAK@MEEGIBIGBCBEBABCFCHK@CHGHDHI\\FjOAGG@DK@KEAAAHBBEKA@@J@@ACA@GDCAGT@AQJIJA@DBBANDDBFH@@J@DDB@BEB@FJDFBB@BABB@@D@BABBDEAAD@ACBA@GFANKDA@OJIHEBC@CCGGGGJKG@OBQAKGSEIQOUKYEkAgDKCGIAMCKqyKQ[SMCYA_DOECIJGvYfWhgd_LGVKwNGTSDMB]LULKFIFAB@BFDJFBFEDGPKR@fGNFNGFG@AUCEEI@@AFCBYTWAE@KBGFEGCDC@EDELCRGHICICIYGKIKK@CTI@GLWHCDGJGFKLGTJBBJEJHF@BEACBEBA@OBCAIEEBENIJMXSNQMEK@WCGBKA_GYOIKEKBIPsCO@USeCQCIIKAESUBGLQNMXKTOJS@WAMGIGGUSYESCyOI@GJ_DO@QAQCQGABE@A@BCCEEE@GYWcSoc_MUEOBIF[lIJm`_`@OGOYaQQKEOGY{SQGIICEAKGMiOUMUSo]eYKIKQYSmMK£IMcAcG]ASBeFU@SEGBG@MNKPENCFCJI`IRCFQXMJIDILAHAJADIDOCaMIAmC{K¯]MeAYEgCuQaCwOWKwOEAOC[GKECGAI@YGMAGEOESGQI[KUSsKUGGaKeI]K}]]G_AKB_XAAMNWJKLSJQNKFEFYRQHQRMBeNSBiRG@MJgJYDK@]HYRWLQFEDCHQLCBKB_CmEKCYK[WMEUEKGMIMGGAW@IDG@OCM@AFANERIFUBCF@REDHH@DHR@DOTCH@NC@KCC@MD@BBHCFBD@HBJBDJBCLCBMBEAACOCEBCASB@H@BNJH@AHJLAFJFBF@BNDDDDJCDGBWBEJ@FGJADWNGHGDEF@D@LNV@FGDEFUD]LI@EDEFMJELGBKHAD@JKNOHEDBHADKFIDG@EGECIDK@ABGNGNCBOBEDINAJCFCBKBGFGBCFBHIDQDADANDHAFHH@BAFDDD@FBDAH@BMDA^@FFJDHFBJELBDGPK`[T_TIBKHCXVFRHTBNHJATGRDFEJAFABL@FGNCRBFDDRBDFLLAFGDAHEHAJ]F@HAPKLIBADHJFDLZRF@HDDD@LBHCJ@DLV@FJFFFHDFHFDJBBBEBAHBDH@BADF@LADKJJHBDJBCNCDBDD@GFILGDAFILCHEFEBCDIBAB@DFJPNBDALABMCQ@FHBF@BOJADBNADBLALFFGBEFK@@BDFCHBDADEBCHEBE@AAuWSFKFGDCDCFA@]JOBIDC@@DCD@FMPDFBDGFKPKFCDDFADBDCFFJJF@JHJHBF@@XCF[HCFEFEBEBAFCDGBCBC@EDKACBGEA@GHC@ED@F@FGDEFG@KFGFSBMNMACHKDCHQLGHBFPDABOB@BDBPA@BKFMCKF_FGDCBBFIRET@BHB@DCJEFCFCNBDFB@DOVBFHJBL@BEJEHGLIHA@III@GDSGABAJNZH@BNDFALADONODMADAEAADUAEBSFIJGD@DEDIDEHABEDDBCBFDMF@DM@eI@DKPDTGHWAOA@CEBGAAEBAFBL@JO_CEJGBBDQCBP_A@ICAUBAFM@@E@AKAWLGBMAQEI@GA@BD@@BuFIBDFKTS@CEBE[B@FI@@EE@BVADGDMBM@IAEGKAG@IACFAHEDCBK@OCQOMAODUFOECC@CCAOCANBLCFBJIVMJaLMRAJGJPPDLJHhLNLHPBNGPNFDF@NPdEJBVCbE^BZATIVwvIXKXUPIRCdMPSTUHGYFKLIPk@yTk`MHk`hDP@NUPQBSRY`kZgDEHIDIBBD@FM@ALIJgv[fMlS`GNABCDG^KNSPILUb_UXK^MVkj]nWdUXELMNMTWPGNCLGALGPMXCrEXGPQZiVEVJPiÐN¤[fWTÅl»âqtaT]XIJibcVKFaLSL[HQHrGB²tdLVfP`|bz vt~d|TZC`¦¬T¦¦ÖÌöàx\\RFNLLLÔhRF"],encodeOffsets:[[113819,44422]]},properties:{cp:[113.114543,41.034126],name:"乌兰察布市",childNum:1}},{id:"152200",type:"Feature",geometry:{type:"Polygon",coordinates:["@@F@BDFBBD@BJFAD@B@BLFADFB@BNAXBDBDFHBL@fNDADCHCHGRCLAHANGDCLANEFGZFPHPNLFxBJJbRn`NBNCRHLHbJbCbhRHPKHGP@RJJPfVFLJF`KNOda\\BNIGQDM^IjEfKdU\\MTALEVITBTALCLENOFCZCCPGNAh@~JLAZ]CGUQOQGIFIfa\\QPGJEbmPMrW`YPIfOPKX]@GWKHOAIEIQAYGAKFICKGCS@MCWKOKYKWEKCQM@EAC@AKG@EHKDI@AEEACBGAIDC@IFMHAGEAA@AFCPFXJN@HCAGDEBGBAPAL@NGJABAH@HA^CJCJ@HCDCF@BAHBLCDAACD@@CFC@CDAAEBCTGJ@HGLEDEDADABK@EECBCJGHA@EJCLGH@FCF@NA@EJA@EJC@AFAACBAFBPCXFHABDFDRBDAJBNABCH@@GFABCFADEF@@ADAH@HCJCBEH@ACF@RGACFA@ADAAADCACHAAAHAAA@AA@HADBBCDBBGA@BCH@AADAD@BAJAFBACFBABDBFCL@BCNBLHFFHBDHHHDHHBBBDBBBFB@DFABDF@@FF@ADBBN@BBNH@BA@DDD@DCD@DDBDD@BFDBDABDJDJABDL@DDHBHCB@D@FBP@HBHDD@DADCF@D@@C@AFAFAHBBCL@BCFALBVAJDNGDB^DDCPEDAFBH@HEFEL@LEDEJADCHBHCFADCJ@PEBCRBHJF@FGfJJDADBBHAHAHBFABBHJHARD@FBDPFLAHEP@DCDBADLBDBTABB@FHFP@@BADHD@BCBDDLBN@PFJF@DDBJ@HJF@BFDFXFAF@LBFDLCVBTBNHXHRHT@NCR@TMDGJCTBLM@CDGDAHBBFXAL@JJNCPDLCFGLADF@DDDSXALDHAHFFFDDFHH@JHDFBFDDJ@DHJBLDFHDADJFLBLFLDFFJBZLRBPLVDHHDHBBHANGLBT@TCTBBAAGJKDGDAVDHDH@\\EF@NKTGQrEVEVMJEXCJGHAZ@bO`GLABOHBR@LAJCHDDCHABEFA@CFCHADCF@FCHAFEHADC^ZfVFrRJdR^hXXVV\\TT^P\\J^PÌvFDFBFB@YDI@AAAF@B@AAH@ABD@D@CA@ADBBACABABB@CF@@CA@B@@CB@AAF@@AAABAE@HACAA@DABCC@FABDB@@CD@ACB@@ABBBGD@AAFDBCA@@ADB@CDBJ@@AAADAAABAA@@AFB@AB@AAD@AAA@@AA@BABC@B@AEAJIAEBIHED@@CHGJC@EFENCJGH@B@@AD@@EHAAC@AF@FE@CFA@CC@@CDCDB@CE@BCH@@AA@FEDBD@CGBAD@@AAA@ABAHBDC@ACBCCB@DA@AA@@AD@FACCA@AAB@BEFADAAABACA@CDBFAIIDBFEBAC@DA@AF@DABACA@AB@BCLCCCHC@@AADADGFBBA@AACBAFDFA@ADAACBAD@@DD@BAAA@CHBDA@AHAAAA@BA@CB@H@@AAAH@@CJABCFBABHBAADCA@FACCBAAA@ABBDBBC@AB@@AC@BAC@AABADBBA@AFBF@CAFCB@BCD@EEBCAABAAAD@@AFACC@AD@HD@AAAD@BBACDAAAFBBADBCBDBDAA@@CH@B@ABB@DEACAAJCD@@EHC@BCBDBDCD@ADDADBAEB@BDBCCAB@A@@ALEFBABB@HABEB@DELEFBFGNBHA@BBBFA@BC@@BLA@BBBB@D@BCFBCEDDB@@AD@DCC@@AD@BDBAB@@AC@@CF@BB@BB@D@BDBCF@JEHAHBFADCAGA@HAJ@ACBAJBJ@JEXDZEDDFAFBLGJDFEBCJ@@A@APEB@BDPATDJ@LFHYCCOG@MMCOIgSSO@AC@@CIEc[FSDEMA@SAE@CRO@CHCCAZGAEIIAOH@@CBCFDB@DEVBLAXDTCTFTPBBBAVLDHLKDDGHJ@DBDAPFBCCAD@FIAKDI@CGQAG@AJE@IMgBGUAEAHW`eBMECFEBECGBCAECC@CBA@KJMHA@CBCCSXEDIHADGBGAC@INBHHD@DABEDANARGDENABCAA@CDCZAL@FGHCEC@CDADIECAAPMFIBMJCBCJIP@HCLHABCDBDPBF@JBHHPBFHHCD@@DABBBABDBFAD@JFB@FEFBBENDDC@EH@LCPDFBZALBDFFAFFFBPEJAHBFAPHFL@DFBBBCFBDCBADDFFBFDAH@DFBDDCLDJJLHF@DHF@BBDBBFCHFABC@@BFDHHHC@BIH@BFBHALJ@BCDBB@DGDBFEFD@CDFBCBD@ADDDABBHDDCBEA@DDBFADDDAB@@BADDDFB@BHD@BB@BAAC@CJBPCFBBAACB@LJB@FAAFD@JED@BFB@NCH@B@@ED@HFADNFH@JGHDBI@@FDBED@AABABBBDD@@CCAAAFCHDBCFIG@@CC@BAH@HABDFAACGC@ADAFDDAAACEJECA@CJEB@HJFBFADDDBBBJ@LDF@BBHAr¦PhCz[FCRELUN]X³MKxHJAFbOJAHBTEJCROF@HGTKVQVKFKDO|MJ@^HJDNDDHPDFFRB@D@D@BFGHBDDEB@FB@DCB@BBAB@BFA@ECC@AL@@@ADFBBA@AH@ACBAN@BBCB@BHADFDAAELAADBBH@FHFBDKCKBEAKEKASCESWGWEEGICIAQCGKKGICIASJMXQNGPARDb@fCJ@FDJHJZANIPAHBFNNBZ@LFFNE`BFADABC@GGOIKAG@EFGJEbMDC@COOICYGQAQCCCBGFGNGLCr@P@BA@GECC@@AFaCA@EJkK@AAFcACDCFYCCUK]CAĵCA@BCC@KDAAęOACUCGEkGWIiBgCGE@GEGBAF@BEFCCKD_BKoS[GDI@IG@QGGCCDGDIC@ACACDEAEFCAqnsE]EIHS_CK^Eb{EECLmD]GICBO@AAELqD[@½@AEADKAKCCAAJUîÿ©gae@sCTMqoɃwƇAACEBUKM@EEI@Ccqw@EMGIQGGGAER[LKJQAIJGDKJIFQHE@GDCBEcY@CEACGB@WIAIMADINIBG]CDOC@BEK@@GÏIC`CHGBDRIBUBCdOlyHMDkdYRGH@NQNCBEAQIGEMOEMOUCCEAC@ODE@ABAFC`FF@HCHBHG\\ARAH@LCJINOLEHIH{ÃHUAQC@YACD_LsJKeAMHUBCEFECADAECQEKE@GCIDE@CI@@@EAS@ACBCR@BAIEKAEEFCAEMCIDCCBEMBBEFGI@EDEBKG@CIA@CC@@AGAGFAJIHAFCB@LD@AFA@@FCHEABFGAAJKCABGAM@ALBLED@DIBEFGBGNSECFCBABKDG@GBOHGAIDMCC@MJBDEDO@CCIBOBQJCB@CC@@FCBSB@FAB@HEDAFCBC@AF@DFDCFBBDF@FCD@FCHGB@FKJKBEAADEDGAED@DGB@FIDCH@FIBLHCHI@EDK@CBO@CACDMHDFAJEFDFCD@FIAICEMGGADABKFIAEDKDCAEDBDADBDG@MAEBE@AFY@GAAD@FC@CHBHAFOFCDCEaDCABCAEUAIACBCCE@CBIDGHAD@DUJEJB@AFABY@EBICI@IFA@DIEEICIGE@ECGBK@@GAGQAEAE@ECGBCCEI@AF@FEDIFAJDRB@KNABEJEFGJCDGFEEGDGBEDEMCBCCCJABCDACGCCDAEGB@FFL@DCCEBCHEBCIGACBCCCGIEACCLECEE@HG@CCAJIC@@CJKSCGACAEEGACEEEIC@IGGCA@CGAAAACGABCCGEAAIDA@ADBD@FGIOMAGBEECGFMAIAHCBBGDE@GEBSGDEECIAIB@DA@ADB@EHG@FKD@BCMAGEEAIECACGGGOEDGQ@QFGFKAEBE@GDC@AAAKKCAGIG@CNKLADEHABALCDGICCEDEHB@IRENOF@tUfCrQTDTBJ@|MENJHANRBDFD@fLLBD@DF\\DJEFBhIJJZEFBDBJGNBBCJCHFBDD@HABCFAleHYViPeAA@ED@NGFSOeBKaGEDK@IAH[@IDQF@@CD@BIJ@VQ@[T_A_leTC~_nKYfGJ@ZGLCFCFIAMXi][ekEi@c@[FwQ¨U@YF_W[Yo[¥FQ[OUaaRBWeQyq_X­MECCBUCGDGCMBBEAAIQTEBICEDABKHAFFD@JCHIAIBIBMDCJAFI@KCG@GNKTaBOOSDOLQ^KPAVDr@XOGEWEUUOFOBSNMHWoHQ\\HHR_M@FIQMcK[OBECS[_V·fWL_MUSONKL[`]DOGiKCCC\\[bG^@\\EZJRÉEčcOMJWWBP¿QIFSDSNJYR×paRgdcLN@PkhcNQDYBWCMddeWV§{bEV]BOLYdIBklBRgndNCRIH¡P[rIzĵÐBhTPeiB{vhGAÉeDKL`EASDI@MD_F[LCDAHODADCBI@CCQFG@CBIBGDID@DOPCHGFI@EEICGAEBEJIDCHID@LEHSNGB@FBDALBJGFEPEHMLKFAFEBLRCNDRCLNHGL@HEAEDKDECOAABE@GDGDEBADBDHDJADFFJAF\\hGRHBINVGHEDDEFOFAFGF@DADUHCHKJOEC@EFGBE@GDE@ACIB]NE@KNIBAFE@GBI@C@KBICI@EBI@GDK@CCGAKvBJELO@GAIBEGEDUDIDI@IDGAEDI@IHgCeRuR[PIFIBEHG@GFGBCFCHEJSPGBGFI@IHEFIB@DABIBEBAFADIDABK@ILEBEFG@ADE@KDICC@E@EFIDBFEJ@DHFGFDFAFFJ@FJFGFCDAF@HBHHFAHADFDKDIFCD@D@BCDMFBD@DKHC@ID@FGFA@KEI@AFEFOL@BCD@JCBICG@EAKBQ@CB@DEB[@CBGCMBECEF@DGDE@FHEPIBAB@HBDCJDDEJGBQ@ABIBONE@EAC@EB@DCDABGDI@ACKBECEFC@QBCF@FEBCDCDEF@DEDADCBCDDPEFE@CFEBIECDQ@GBC@CB@DCAE@GAGDAA@CCCACOACDGBEBMLG@QJA@@DGDI@QHAFWDCACEGAGBABAFGFGJKFCAK@GBCBEHA@GGG@EEE@CBMICFA@WBGFECEAGFI@ADCBWA[DCBUIWCC@EDKBOFS@IEACWCGEGGOEH[EIBGCE@ALILAJ@NHGI@CROLSD]AIDE@GAAUOECKDI@MDFCDE@GCCE@IFOBI@IAABAFQLAFEBKCQHE@EFEEGCEGCAEFK@CFMBABIF@BNL@BCD@FDBFBBFFHAJEN@HCFHZ@JC@CCABAAC@C@C@A@S@C@IAIE@AMKAAE@AACDBBABEBE@ENC@C@KHSDBDI@@DCBGAGBK@CBADA@KAKAGCACA@GBAAIEGD@DA@CBM@GB@BK@BDABBDKAABDDCBE@EBč÷~^lBxBZDpIxCJIdAXhdR´¶lźURMBCBBDEJPNZDLHPnBjX¼l`Fj[ZBDCH@LCDBFADCFEBGCEBABBFABJDF@DFFBDHFBFJBDADN@FDLBFHGFSDBLIJVDLHBDAPBB@JBBMPA\\\\C`RPfyLQpprJAxMpnoBwVqAÄBBADBBHBBDADB@FDBHJFBDCDDB@BADFDBLBBJD@DT^HTLH@HC@A^DDDh``LPGTUbG^BJWHJJtpFNAPUlB^TNcJC\\BxTT¯X]DKv`T@PWBYFUTQLgnUTK^HJGLBN`TÀKPAL¤G`KDEFC@GDI@AHGBGBGD@FE@CD@AEBCFC@AFKD@FEBABC@ABBBCBKBAFADCAADC@KBBDADAACB@BEACFA@BBCACDC@GBI@QBEAMBCCI@@BCCK@AAC@CBGDM@CBEHCDC@CDFBH@B@@DCBBB@DC@DB@FFB@FB@@FGDA@BCC@@BIBCFFDBAF@CCBAHFBCD@ABDHEBCCA@A@@DCBF@FBBBE@ABAAC@C@@DADFBB@EF@BDBEBHF@BCBACA@ABCBCAAB@CCBE@BDEBAACCC@GDFD@BADB@@BCACA@ACCC@A@A@A@AHCBAFB@BCDDB@@CDB@BC@ABDD@BEAAAAB@BDB@DIE@DADIDE@EBFBABE@AAC@BFCBAAEEEDAEEBA@@BABACC@@FCBABEAEBBBD@ADDBCBFDGDFBDABAACDAHBL@LBLEDFH@FBLLbDD@BCB@JD@HDBH@DCT@TDJFPADBD@@DHBFAD@PLL@BBEDF@ABHDA@@DA@@@HFD@@FCBHDBDFAADDBCBB@@BF@DBDA@BABD@H@@DB@BDABD@@BD@ABBBABCABBADBBBCBB@AD@@BA@@BDBCB@AABBBBDFA@DD@ABBFABHBBBB@@@D@BADDBADB@DB@DCA@FADDCBD@F@@ADB@@AABAB@DBABD@@BC@BDBA@@D@@ABAD@BDDAD@BA@BABD@@BBB@BFFTDFA@@BB@BD@@AHBABB@FA@BABB@B@D@BB@BBAB@BBB@BAD@@BJADD@BBB@BD@FDDADBBBB@BBLB@ABBBADBDADBB@@DFABBB@ABDAADBBF@CBBBFDBA@FDB@BH@BDHDABD@BBHBD@BBBHDA@BDBABDBABH@CDBBL@@BB@@BDDABBBD@ADAAABBBADEBD@CFDDA@@@ABFDAFBDADDBABBBB@EBDB@BB@@@JBA@FDD@BBBABDBBBABBB@B@@DFBH@@DD@@BDBC@BBFBD@BDDBBDFB@BBBABDB@DB@BBB@@BB@DBC@FDABDFJD@@DDA@DBA@J@DDHBBB@BJBBDD@@D@B"],encodeOffsets:[[122766,48692]]},properties:{cp:[122.070317,46.076268],name:"兴安盟",childNum:1}},{id:"152500",type:"Feature",geometry:{type:"Polygon",coordinates:["@@LGYcC[JeHODiBQPgLYBQ@QNc@YASEUGGMCK@KFSAIBEBaDO@YBi@I@QHOBKCrDRiHGDOHCRCNSH]FGAKBQAIQiCMC_DG@OQYEE]EGBKHMBI@MIGWEGGEMEKAI@QDQHMJKDcMKE@GMOQeCDEBKIFQAWDQASGGKMMI@kDaHOASGIAQAYAKDULEFOXAFGJMXEHIFSFMCEAGAKMISMMWM_IUCG@IDIBWAQDML@H\\NFDRVF^AFIH]FMFILQJ[CWEUAIDIAUGMGEIEMKcEEUK_EOKeOSAAEEAAGGCBCFABADCAEOECEBEACCA@CECGADKBCMODGFC@AGIGEBCAGCEGCBAGCEACBGAQBCBCDEFKBCIMEI@cDUCICUQAC@ABAD@BCCACIAG@CDERGDENKDGDIAEIAAACAFGUWIAQSe@FJq@GBk@@Ka@CJCB@DEDBDFDKJ@BC@@BBB@A@BIHADAXCLGN_ZKJKTCRCDQPIN[rK\\IJGTAJ@HGDYCOAGA_S[ESAEDKFCFOFKAYQI__AMQKIaQMCE@SFIFI@GAIGCKBQAGGEQEeHM@GCIKIGMGOGGUCQKGGC[DUGIEIIUIcEO@e]MM_SSGECgEGCOCigWwMOEaEME[CUBUEECMIOUHAPMLQhENGCMIGAE@IS]hmZSWMqCi@SCEHKHWnIFI@MEECMOBQBEAEBIGOIGICYEWAoGBNANBLUVSFcB]JUJc@GCMD]PWA@QO@ACIIQGIAG@[LGF[JQA]CoADOAaGoCGCMMOKCASAGBCFANBFHHLFFFDFFJVNLPFd@RF`BVEpIh@JJT@TGXKTMLOTAJEBHFOBABFHAD@HPADBTEXLRBLLPBHTVRDFKRAFUd@LDRCJBHFB@FAJOHAJ@DJABBABCB@BCB@DH@AFCJ@BFB@BGB@BXAJBGJHDEDG@AB@DBB@DIBEHED@DBFEL@BHB@ROpOXMA@CCAEFAFCBCFKAGB@DXB@HFFGDBBABAFEBBDCA@FABAEE@EDCNE@DFADQBBLCRFNEJ@LALBBFB@B@DCB@D@DEFBBEB@DKB@BBDCACB@FCBDFIBAFGFAFKFINE@HD@DDD@DOB[BGpCBDDYVAB\\D@FD@HPQDCJGAQBDB@DQDGFaFçiVpe\\GLFPXRJDDFBFCHEFIFSFQKQEXOAOBobEBMAEAEGAOEYWeWYMKiUMEOaMIF]EgKLQHGNDJCBEV[xA\\@WiGUUB{TC]Rg^{TQLWFMASEMIQEKIWKEGCGKgCICCIEQCa@mEEC@K{ES@R@yMyix\\e}PK@EECEBS@IGIEAKAQ@SL_LYDGASMOCOMcUI@CHCRKLSHYHQRBhERGNEVFRRTGN[DCF_DUFMLMLWPUHSFSJGTAPMXMHUFWBMEQSSK]G[AUB[FcN@F}RM[k^QBBōRsa][[QKQQE_MUWUUOw_k]S_O@AJEEKfCD[QMmA[VWO{YOBetAZONQNFTWPYX]bOPexMRyHf©L¡Ġ«vd{f[NmLÛdīnr»ÛzFpĻ{RCfûĖu¥ÖEnABÂ·êùEBUP{n×âSRĽHÑý®ºoAN`èlôfXTxZJb²IBT@p|ĪĆ²@DXL\\JhL\\D`H`G°Kh©Êq[b½g`OJQHsJ_P{Vi\\g\\_dKNGXEHWNKNgjcOAVDZQ\\gf_ROD¯^CPU|XnnLZfRdPtXNfRRVQÐr¸`~LFANft~LPlx^`¾HR\\`bpXPÒr\\NbzZvR®H^DoʄOfP\\DTEq{naºÑ_æxQºaSXG´B~AÖ[T@bGÞo^CECʌ]lO^UdCÖW~\\¨|ò¼xPNFNZHPJ@TJJZLB\\HD@vPjAXJHZ@v^tV~|XR`HPZ@ļÐhT\\Fb^tbrd\\`zfhThTnJhN¦b°Xønŀ¦c`GITDHR@ĨX~BǔG°X°Z´Z²V°V¸R|ľì|¸bVRrZFAnOTDZLDLtztACPGE`VP[TS^mZD`LpLX`ŤƘtHĆĂvXp\\VpPzBZBBZDLDPJNXPFbBTH\\rPHXA~OŪUÚOŴTXAĖGPCXOlb NT^dw°dFfBnTbHTCTAtDbBTE\\G`T^EXGPIRYDU@SsPKnS`@dDXFZLRTbCR@dIxHXH\\PJHO`LTPD\\FRPHRXVVJ`FZ@nAN@JNJT®@VDVLd^bRn`xJbArB^JXLh`NpĂWraXM¾GÆIXEz\\t^ZBTI`gdCAXE ITDDPAJQTALTZbXhjVTAnF`BHKJiCMQQ^QVBZETPPLZFfLXBHOt{RKh_DIBOfBXRTBNLR@VGV@fMf@RHEBMHGZOTEXOVQZCVTMNSbVxb\\R\\CI^ElLCPCfU^CTBvLl@LIVGRWBWKQvVfJHNNJNHnBPB\\PLRHP\\^LXCPML°YCRDCCB]D@@E@AKGGSS]@CICAAAKECBC@ACADCACIEAGECA@BCACE@CCBAACÃrBxUpAomwNBqIoRozKOe_Q[DB[NOAA@IAABOACKGCUJIAKTCHEEGKAECM@BCACEIEACGEACEE@ICBAAEBAFAHDFADEBCAEDC@KDGAC\\YEik_W»AiOmKGYCOMFIACDANAVQkŹ³µQgcBWJcDIwoJYCwAkA}]ggZg¿Çċý`uYGL«¥Á]e®h]`NBCCMGCECIEKCHSAICIMCAACKCOAG@CBCHC@ETKJCNBNITCJGNEDC@IBCQGNQJI@EBEAEBCBGBGFEBA@CEE@GBECAAGEA@AEAGEEIKEICCGBCECKBE@EFMBABAD]@IFQ@@LGDDB@FEHDJEHDF@LMJABEDC@EDGAMHGBMDUH]FQNAFC@GCKACGGEACEA@CIGGA@ECEGEkqIeBABKRITQHEDIHCAEL@HBF@FDFAR@XKOCOGE@GEEAACPGPCLBDBNIFADGEAEG@CEAAABA@GDC@ASCKEGDADODKHMDULEBECEE@EGACIDEAEMEPKDMFBNABIACFABECE@AECJ@DEPOJCHFFBJKHABC@AHALBJMRGCCBGGKBCDIACEA@GI@OBWJ_FOFMBSA]HMCYG]BGBGHEDIBCAKHMLIGCE@EECFGAEBCHCBC@IACCAACCGBABIACCA@O@CECBCCCAEBGVI@ACIZIDEH@BA@CBAR@BEZQLDDADCLADGJDLGBKCE@ADECG@AFEBELGBEPQDI\\IQamAWB[DBGFE@GMCACGCRATB\\G@ECI@CKCQBGCQBQG[AGBQGEEGBGAAABIGCKAIDMCEBUAMFWBQBUAcLIHCHIDCDCACAK@IBE@KEIKKBKCCGDCACBEGIBELFLCFBFGHAFABEHELAFCFBHKFCAAAGBEAGBCXABCF@BCBC@CBELIF@@IECACBCAGFA@CGIAEECACCC@CAAFGGCGmM_BaCMQQ[MYG[A[QIKqIOEIMYASDO@MSWEOKW_QGWUADIACBCFEFQBULOAYIeIEAEGCB£UO@@RITMHYBYCIIS]GIsIQGD_COIIUCoEQCGIUCW@ESA]FKAMCI[IOFWE[aGO[MUGSFQP[D_MYEGDGAGHEBIFQBKFE@AEDCE@K@QDM@aHIDIBAFQGECAGIEEEIAEE@CFCDGFAAGBCJCFIJEHICI@GGGBAAGBEDCBGAKNGJABCGEWEMROBC@IFDHBDCJGAQAOOGIG@EABGAEMK@CBEM]OMQ@SPWI[kDYHMGQSMGUGQ[MQSKOFoJ]E]A[R]DiB}RiOaYQIGM@SAKKKaEeIGOSSMABCGGSCCAAC@ECEFE@IAAKC_BYFEAMAMGCEQACDC@ECE@GDGBa@EDEDUHGBKASBUHIFGHCHBDHFHBBBBJBFNHJZJH@PJFFFJDFJE@AFCDCDQBMR[PaKGHABAFGBCFFFVhUP]LCFBD@BMBCFMDG@IDM@KFGD@BABQBIDADFH@D@DCBADEDCHCDAFGHIFGHQNcAYHo@aJȷ]w[OSy{­wy}mgUT¨eV_AW_OamVYYo`swUBI^«¬{çã@YQUF§SDCCA@AHIAAGCCAOCEECGFCGC@C@EDCAEEECIEAIBEG_DCABCICC]FUFKBEMQvµo±t­P¹WBMU_QFsUS¹m¯qcI_WyCW±C\\IpuJejEbs`GªsGSL[IUÂsNCPIlMr[`SjsPULw@QAcCyIcDkLYUaYiYeEaOcyoP[ ^@WFskN\\CLrb@FhEtKRC~EfDzsQUlANYTPxMQaBO@CEEFGDMFK@CGCCCACBEJGJOHKJENMHGFOEQAMGKDGAAIAECAQCG@CACBID@"],encodeOffsets:[[119700,43406]]},properties:{cp:[116.090996,43.944018],name:"锡林郭勒盟",childNum:1}},{id:"152900",type:"Feature",geometry:{type:"Polygon",coordinates:["@@Ģ[²O¶OƌoŀaK²MªO²QĎY¤OM\\AjGâWæClCȼUVC´GCĪQĈMÐMtAǤ[Ǝ[CîK¬GtFÈZTDNRFÊVNô`nJxFÌXXBAĮGE A¸GANd\\VVtnÚAEŘEĲKŎCrGX@OĐkȞªUZGĖiĀk²UXIX@\\AĺU¬GlCZG^YRQLM~tqJM`[z}PKz¼¿JOfA̖áüfæOúCʺĿƼÅǰãIʔ©þefKXBƼHtAbFĆbĮ`´V¬TĤ\\BIñCëĨQªOĪNĖH`C}Ǟżh\\F@OÑGiiįlũE_\\XmR_DEb{ÙdÏac¾¯XiD[B_FWD[¬u¬}°àxiVSXAXILQvQ¦_°Gn]òwIAZEbKÂMhMjeDDF@nTzXAJC\\CJGBI@CFCBC@ABAIsDCPABCACB@@AFCBCB@ACFAAAJEACDBBCDBBAD@DCBEDAF@HGD@TER@D@DHHDJBNAREFEL@¾efSdOA@SP@@Y@AV£cV_E{JEyA}zÁhA}ÏNh§ČĳĖDji^C``è\\ÐEŠ]L]­»»ÅÒ]²F@@@aKCOIMIMQESCAEMCUKUWSMEOOSIOAOESOMOSQ[KIIAGAOAYFU@OBMCK@MBSAeHENa@KKKMCGIAGBQHQDQLQdcJOHeBAEKG@CDGTUDOBUAaAMIU@ODGLGRGVMNEDE@ECGIIYQOEGIAGBGJaŋ»CCBIWMMMOW@WR}LOJCPQLE\\EDAJOBGDED@PC~WF@PGVAZGTK@ECGSIAMDGJ[JQDGUESKKAUDoSQMECKAU@iFIDOLyTIGCEQWIQIGGCKMCGEEGCACCCOEEEIAMOQ@DECEEABKCAAC@KIE@KAEIGGAiH[HABQHaDOF_DU@ODIAMAUCKB[EeG]KIBMC[BMCI@ECIGEKD_HUBUDKCeBUL[DOCKKSAICCOGYGMGKEIBMHyFGBQBQFQPGNMJ[EUO[aKQEIIMK]GIOKIKCUKMIY@QEMJE@@IGEGFGHEHGBAAKCIKMGMKAEEIS@ICISKOBKA[MMMQYYQEAYQQQCGBMDMPULK\\DHA@CCEIECKGKWQSECKEGMEUOEGCEKMACBGAAQ@KKOEEEUCCA@GBKHGLIACEGEQBCDCDEDM@ICKAAICGISKAC@ER[BIAAU[MIEEAEDMAEECIEMIIKCG@MBGFKBQPKDIBIBALGBCAGCGAAUEAAEM@OJSDO@SAUIIWOAKFMBIOO@MIIDQLMDOJM@COKS@IGAEHICMAUFMHW@CU]EKAEDKJIBOJGHIL_^[PUBCIQES_}AKQMYMWIKCKE]]CGKWAOBGEQCqCOBIAOH[RoZc`_T[VWNWDCDG@GAEOUO_W[WkAEAMBiDMFIFQD[ESCGKKWWEIEICOM_WUQGMGgIMISEsOK@MHcDQFIBK@SEAAKq@AEEQEGKIIC]@§Gg@oAgBGAoFeR[DWDGAOC_BYE_A]EK@Q@gMaOaC_I]ASCaOMKUKmK}[UCI@QECCic¡waMćMkK@Wu_IAíMýQáGģGEGUBQ}Ec_GiBMGOOaKI@OJI@gM_[CD¡e¯IčÀőĄ_R½OR[fQ\\ƑŘKFVŁYF©`TAP@NGXANBJEJ@FCHALE\\E`CHANERINGFADAP@ZFTIjENCLHF\\zZd²hPFJ@rXFFcäGzI`]tU\\SbObUTώȪ²äÆø~V~V¸rJHnDDAVDNALCdG\\FXJRRjIZELm¦]fÉÖ¡®XnFLFJRldÈABËj³`SDDBeB]Aġ}Ùs·eë]YEYMcGGqOiEwMYIïËÏ¡w]oCAAEIaUqSMCEQMQUOKOIMI_[EAIAQB¥NPiNÍZ«X_DeH_FNJ_D]FW@kT[TUJk\\aTjiVUJUµ[}cm[YMSGgI{I{MMSQmK_ġ]»_G@ECFGjgFC¶¯XY\\I^S¤|[ÅSMEI¯ËÙqi©i[EBIJUNSJMBKCIEYLqLYJQ@CCGEE@MDICOMIEIMYMSOKCGDINWbczEHGF{T}RUHaDMJGJqTuNMJQH[EYF_BGBKFGBSCIDGFKDIHGNCPB@ZDPDFDLLHLBDBJETGVABCBIAJMB]@kNCJCf¨XxCBSEWMóMuGOSO@AFFLDRAdE^ELIH£jSFA@EECMOYMKG@EBpEFENBnAbBDBD@DEH@DDLAHKVKZ@XO^F\\IPAH@ǞĳCKBS@cGSGkII_BPU@QDSBDBäµJĭpsÂZÁàĻdOFáªçX­béØL}NJmRcDoCçGáKeAYDMJQHDLFFLDPB\\ENKXI\\AhJPHjXTFb`JfTfNTNvThVTVl`LRÐHhDfL\\NPJ|ôJRzxXRLFźØRH@ɜÖÞKĊWTįŰ§ÑDƁZŽVěïǟJãFĝFAġ¡o»d³öǍÝBOAICK@OHIFAHGBENOIGAMBCCKGO@CBCBKHO@OCFk`[ZWNeRYHMHINMJKNGPGHCJgÜĉu¸ËʅğU̎ZNÅ¤\\å õ¦½Àɿɾ@BDJAFDBD@@FEBIFCHIBEDAJDDAFEFBBÊ¬ƒłƷˀƍɲşȞßŐ¸Ùń"],encodeOffsets:[[99505,43823]]},properties:{cp:[105.706422,38.844814],name:"阿拉善盟",childNum:1}}],UTF8Encoding:!0}):C("ECharts Map is not loaded"):C("ECharts is not Loaded")});
\ No newline at end of file
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("固原",{type:"FeatureCollection",features:[{type:"Feature",id:"640423",properties:{name:"隆德县",cp:[106.111595,35.625914],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@@@A@A@ABABABEDAB@@AB@BBB@BBB@B@DAB@D@@@B@BB@B@BABC@@BADCDAB@BA@@@AACAA@@AA@A@ABA@A@A@@@A"],["@@CFAD@BBD@BHG@@@AAC@ABAC@"],["@@BB@DBD@@@@BF@@@HAD@FA@@@@BADAHAB@D@D@BBBBB@@B@@@@@B@@@@@B@@@@@@@B@@@BB@@@@@@B@@@@@@@B@@@@@BA@@@@@@@@@@@@B@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@B@@@@@B@@@@@@@@@@BB@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@A@@@@@@B@@B@@@@BB@DBDBBB@BBD@DBDB@FADCBCFCD@@E@EBE@C@@@ECAAAAAC@CBCAGAGCCC@@@ABAF@D@FAFCBAA@EAA@@E@CBEBCAA@@CC@E@A@@AC@E@CAE@CAEAC@E@A@A@EAC@EBAD@D@D@BABABCDCBADBBD@D@BDBB@F@D@DAD@BCDC@C@CA@@CEC@ABCBCDCBABGDE@C@EBA@CBEBC@EBG@I@CAQFKDK@I@GBEBC@IBC@A@E@EBC@EBCDCDCBCD@@C@GC@@C@E@G@EAAA@ABEAA@A@A@CE@C@C@GBCBEFAACKAE@C@CAGAKAA@ACAAAEAGS@EAAGMCAA@C@KJAZ@JAF@FBFDDAB@BAD@B@F@F@F@D@BABCDCAOGADBDDDFF@DC@C@ADAF@@ADDDBB@D@BA@ADAB@D@BADADADAD@BDDBBDDDBB@@@D@D@DBB@@BDFBB@@B@D@BBBDB@B@HBB@@BDDBBBB@D@B@BABAD@B@@@B@BBB@BBBHHBDFHDFFF@BBB@@AB@BIJAB@BEH@@EN@D@B@BBBDJ@BB@@@BBBBB@@@ADAFDBB@B@B@B@FAB@@@@B@D@BDHBDBBBB@@D@@BXQ@@D@BB@DBBBDB@B@D@HAHAB@D@@@D@F@BADBBBD@H@B@DCFCBAD@B@D@B@BDDDDB@BHDB@HFHRBJ@HBBDAD@BBD@DBBHAD@@BBBBBD@BFDFBFDHBB@NCF@FCD@FCHBB@D@F@DCLE@AB@@BAFB@B@@@DED@B@B@B@B@B@F@B@BAB@BAB@BADBDBBBB@B@B@@@BABA@@B@B@B@@AB@@@B@B@B@@ABAB@@@B@BB@@DBB@BB@@@F@@B@@@B@B@@ABAB@BBB@@ABCBEB@@@BB@@BBB@@ABAB@B@@@B@BC@A@@B@B@DBB@B@@ABA@@BA@@BB@B@HCJAF@F@FEFADAFAB@@C@C@A@ABA@A@CBA@A@G@A@A@A@ADA@C@A@@AA@A@ABABE@A@A@ABAB@@A@C@A@@@ABCB@BAB@@@@A@AB@@@@@@ET@@ADAD@BBBB@F@B@BBAB@@ADAB@BAB@B@BA@@BA@@BBBB@BB@B@BA@@BA@A@A@@@EDABABC@@BBB@@@B@B@BB@@BB@BHBDBD@B@B@@DB@BFCBBBA@@BAB@BAB@B@BA@AB@AA@CCEAC@A@AF@BAB@BAB@B@BDBD@@DBB@@@@B@DCB@@ABBDDD@@DB@AB@DA@AD@@@B@@@@@B@@@BAB@B@BA@AB@BABADA@@@ABA@@@BB@B@BE@@B@BB@@@@BA@@BA@A@@@CB@B@B@@@B@B@@BBD@@B@@A@A@A@@B@BAB@DAB@BADA@@BBB@@BB@@@@AB@@ABA@A@@FBD@@AB@@AB@@BB@BBB@BDBBB@@@D@B@B@B@BDB@BB@@BBB@@BB@@@B@BA@@BABC@@BAB@BBBBB@D@D@B@DAB@@ADA@AB@B@B@B@BBB@@@F@D@@@B@B@BBB@BAD@BBB@B@F@B@D@B@D@B@BB@B@B@BB@D@@@B@@D@BBBBA@@BCB@B@D@DBBAB@@@B@@@DBB@B@@CBCBADEBC@C@@@@AAAA@ADAB@BAB@DCAC@A@E@ADADCBCB@AAGCAAAA@A@C@A@E@AAAAABC@ADE@ABA@A@ABCAE@A@ABCBA@AAA@@@ABCBAFEBA@A@AAA@AAAAA@@@ABABADCHGDCBA@A@A@AA@AAAACA@A@ADC@@BC@ABAAC@A@ABABABADAFABC@ACCBAF@@A@A@ABCBADCD@F@FBBAB@@AAAAAGE@A@ABCBCBC@A@AA@A@E@E@E@KEE@@C@AAA@C@A@ABANMBGBABCFABA@C@CB@@CBCBADCFABA@ABC@ABC@IBADG@C@CBGBABAFEAA@@AEAA@A@A@AAACAAAC@C@CACACAECAACAAAGEC@AACA@CAA@EBC@E@A@A@EAA@C@CBCBABABABABIAA@AAA@A@A@A@@DAAC@AAA@AA@AA@CD@@I@A@A@ADADAD@BAD@FBDBD@H@D@FCDAHABA@C@ACG@C@ABCBE@CBADAFCFAPAB@BGBE@C@A@A@A@ABABCBABA@CAA@CAE@A@ACCC@C@CAC@A@CAGEEEGCAAACAAAA@@C@E@E@C@CBC@EBCBC@CCGAC@C@E@E@A@@@G@CBEDCD@DADAHAH@DC@@@@A@A@@@@CAAA@@@@A@AD@PADCDE@AACA@@AE@@AIACCIAAC@GACCEC@@CEEEEEEEIECCEAG@C@BC@CB@AC@AAA@AAAAC@C@C@ACCAABCAEC@CDE@ECACBC@CDCBAAE@@E@C@CBCD@@@D@B@B@FAD@DEDAF@FBBDDBB@BABG@ED@FBBDF@B@DABA@C@I@E@A@A@ADCHEL@B@@DDBD@DABCFCFABABBDBF@B@B@DCFAD@F@BCBC@@@ADD@@@@@BABB@@B@BBBB@B@BABA@ABA@AAGH"],["@@A@@AA@AAAACA@@ADA@AD@D@B@DABAD@BAD@F@HAF@H@@BJBFBBB@B@BB@BDBF@@@F@DBD@DBH@B@BABA@ABAD@D@DBD@FBHFDDDDBBB@B@B@D@B@D@B@BBD@D@BBBBBBD@B@F@FDHBDDDD@@B@FBB@B@@ABAB@BBB@BBD@B@DBBBB@@BBBBBB@B@B@B@DB@@B@B@F@D@DBB@DDBBB@BBDAB@DAB@FBHBDB@BB@BDDBBBD@B@DADAF@B@BBBBBBD@F@HBD@B@DABA@ABA@AACAAEGACAA@@@C@AAA@C@A@CBAB@BA@A@AAAAAAA@ABCBA@@BA@@@A@C@A@C@@A@AA@@A@@B@BAD@BA@CBAAC@ABC@@B@D@B@B@B@BCBADEFAFCBA@CA@@CACAACAAA@C@C@CAAA@A@A@ACCECAAA@AA@C@ABCBABC@A@AAA@AGCCAC@AB@BBB@D@BADABEBCDA@ABAA@@@A@ABABAAC@A@E@CACE@CAA@@@E@GACAEAAC@@BA@AEAEACACAAB@@@BADCFABC@AA@AAAAA@@C@A@C@A@C@AC@C@A@CA@@@A@CBCBABC@C@C@A@A@ABCA@AA@ACAAAAA@A@A@A@A@AAEAGAAAAAC@@@A@A@C@C@@@"]],encodeOffsets:[[[108619,36271]],[[108618,36301]],[[108616,36310]],[[108445,36266]]]}},{type:"Feature",id:"640402",properties:{name:"原州区",cp:[106.287781,36.003739],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@AA@@AAA@ACA@A@A@C@@@A@AAACA@AAA@@AA@@BA@@BC@EA@@@BAB@BA@@B@@A@@AA@AA@@CBABA@CBA@ABA@@@@B@B@BA@@@AC@AA@A@@@A@A@A@@D@@@BAB@@AB@@@@AAA@@@AFA@A@@A@@AB@B@@CBABABA@@BABA@A@AB@@A@@@@@A@@@C@@BCBA@@BCA@@CCACBA@@DA@C@A@@A@CA@@ACACA@A@ABA@ABE@@B@BBDDF@DBBA@@BABA@A@ABA@AB@@ABAAED@@@B@BA@@B@@A@AAA@C@ABA@@@GC@C@AAA@@A@@@ABCD@@ABC@CBCBEBCBMBC@AB@@ABCB@@@B@B@BDBBBB@@B@@ABA@@B@BB@BD@@@B@B@@BBD@B@@@BDA@@B@DA@AB@DAD@@BB@@B@B@@BDD@@B@DB@@@@@B@@@B@B@B@BBBBB@B@BAB@BDBDBDABCBBABB@@BDBBD@BBFBB@@B@@@@@B@@@FBB@@BDDB@B@@B@@ABAB@B@@ABEBA@@@@DEF@BBDB@DABB@@BBBB@@BA@@B@@B@B@BBBDBB@B@@@@DB@@@@@B@D@B@D@B@LDB@B@BBH@HB@@B@@BDBHBBBBBBBBFBF@B@B@@A@ABA@@BAD@D@BBDBD@DADAB@@@BB@DBB@@@@B@@@B@DABADAFAFADGH@B@D@@@BCBAD@DAD@@AB@@A@@@A@A@CACBEBABCFAF@@@F@@@D@@EJABADADAB@@ABA@C@@@CB@BA@ABA@A@C@A@@B@BAHBB@BBBADBBBHBJ@BBB@DA@@DAB@B@DB@BBBBB@BBD@HFLH@@@@@DAR@D@BABABCB@B@@@@BB@BBF@@@@HDB@B@BBB@HL@DAD@BABAD@@ABA@CDABABA@A@@@AB@BAH@BHF@B@@B@AB@D@DAB@@@B@B@BBB@@@BB@@@BBHH@@@B@@ABAD@B@B@D@D@@BLBB@B@@@B@BDH@@@B@B@@B@BB@@BB@D@B@@@D@@@BCHABA@@B@@@J@B@H@D@B@@AB@BCHGF@BA@@@@@ABQZCF@B@@@BBB@@@B@BABEHCDAB@@BB@@BBBD@@@B@BCJAF@@@B@@BB@@@B@@@B@BGVAD@F@B@@C@C@AB@B@B@H@J@BAD@BCHAB@B@@CH@B@@AB@@@@@BAD@@@@ENABAB@DAD@@EB@@@J@BAB@DABA@@B@B@@@B@B@@AB@@@B@@@BBD@D@@CFIZAB@@@BA@ABC@@@ABABADAD@DAD@BBB@B@B@@BBDFBB@@B@B@BB@@@@@DDDJN@@B@BABADABAB@D@B@@@FABAD@PP@@@@@BB@B@HBBAFBBB@@BDFN@@BBB@BBB@@@BBBAD@BABBDAF@D@DADB@ADBD@HFHFB@F@HENCFCHCDB@@BDAFBFF@D@DDDA@@DBBABBBCBCDC@CB@DEDEDGDCFBDBXJP@NCVHDB@B@@DDDDFBHBNBLBB@DBD@BBB@B@BAB@BAD@DBnI@FBDJJBL_H@@BB@DDFHFHDJHDDFBFDDDLFLDDDHFFDD@BBB@FAFCF@JAFBF@B@B@BDBFAF@BDDBBBD@DDH@NDHBDFCHCLB@BDDL@HCN@BCDADD@D@DAZBJALHLHDFNBH@@@BA@@@A@CEEEC@C@EBABBLDLALCJEBAFAD@DF@HBNDFFFBFCDBBHDFBLB@HCLAHFDFBNBBFAJALF@D@D@BF@RC@APETMFBFBN@DCD@@D@NAFAD@@B@B@BAB@DABABAB@BAD@DBD@DBBBDBB@BABA@@BCDCHGLIDCDCDCDAD@BBDBBBB@B@@@@CBADA@@B@BB@@@@BB@AB@@@B@@@B@B@B@BADABA@A@C@A@A@ABCDABABCBA@@B@B@B@B@@BB@@BB@B@@@B@@@A@@B@B@@@@ABBHDH@@@@BBB@@@BCB@@AJIBCD@D@DADAFAF@DAFCBC@@AAAC@CBCDC@A@@@AACAA@A@C@CAA@@@@@A@@ACCCAAEAEACCCCAAAA@AA@@A@A@A@@AAACAE@A@@@@BA@@B@B@B@@@B@FEDC@C@C@CCACAAE@CA@@@ABABA@A@A@@@@BABBF@BA@@BAAA@A@ABA@A@@AAA@EBE@E@AAA@E@CACC@AA@A@@BABA@@B@DAB@BBD@FBB@B@@@BB@@B@B@DADAHKDEB@DDBDDABAFAD@BCAAACACBEFABADADEAC@ACAE@C@ABEDEBC@EBEBCBCCBCACBM@KAc@A@CBAAE@K@CCC@@@CA@@@AA@@@@A@@A@B@@@@A@@@@@@A@@@@@@@@A@@@@@BC@AB@BG@A@A@@@@A@A@@BA@@@@@@AA@@@@@AB@A@@@@@@@@@AB@DCBA@ABA@@B@@@B@@A@@@@DAHE@CBGAC@CBCBE@CCAADCFCDABA@C@ABADAH@BADEDAB@@@BADCBABA@AB@BA@@B@B@BA@@@@@AA@A@@A@@BA@A@A@@B@@A@@@@A@@@@A@A@@@A@AAC@@@C@AAAA@ABA@C@A@A@@BA@@@@BA@@BA@@@@@@@@B@@A@A@@@@BA@@DCBCBA@ABABA@A@AB@BC@A@A@A@@BA@ADA@@BAB@B@B@B@@ABA@ADCB@@@BAB@B@@A@@BA@ABA@@@A@@B@@@@@B@@@BABC@A@@@A@@@@@@B@@@@@@A@CAABABADCFADAB@@AAA@AAECA@ACE@A@@A@AAAA@AAA@A@AA@AA@A@@@@ACACAACIACAEACACAAACAAAAGIAAAAEIAA@A@AAA@A@AAC@A@A@GEO@CDEBK@M@AAC@ABEBC@ABC@A@CAEBCAABA@@@AB@@A@AAC@CAA@A@A@AAABABCBA@AA@@A@GAG@AAAAC@AA@CC@@@CBE@A@@AAA@A@@A@C@AAA@GAAC@EAEBCB@AAA@ACG@CBEHEDAD@D@BCBCBCFCFEDGFGDEBA@ADKBIFIDGBIBIDA@ABABABCB@B@@DBFBB@@F@JCRKHKHMHEBCFEFCDEBCFCBGBG@@@C@A@A@C@@CCA@@AA@CECIDCBA@AA@A@CCCAEGACCCC@A@@@C@ADABC@CAEAEAGAC@CDAACCAGCGCI@C@@A@@B@@EFABGFABEHGFC@CDCB@@A@EAC@ABCDCBCBCBCBCACA@@CE@G@CCAEAA@ABE@CACAEACAACAAC@@@@D@BA@EEC@C@C@A@@AACAA@AAA@A@@CAAACBAAAC@C@@BABCDCBC@AA@ABCBA@AAAAA@GDI@E@E@CAA@@CCAE@C@GBEDEBE@E@C@C@ABCB@@CAABGDADGBGBIFA@C@E@C@CA@@@@AE@AAABC@AAAAC@CEI@CAAAAAC@ABEBELUB@@GBCB@DADADADCBAD@DEFC@A@CBA@ADEBAAACCEAA@AFCFCBC@EAECAAEEAAA@C@CBCDEBEFEDEDEFCBCBA@@AAA@CAGA@AAC@CA@A@@FEBA@C@AAAAABABC@AA@C@CBCBC@A@AAAA@E@C@@AAA@EBA@@C@AECCEAEAEAAE@ECAA@CB@DA@@BC@ACACA@ABABAB@D@DABCDAB@HCBAD@DAB@DABBLHDBDDDBJFBBB@B@@EBA@CB@@@BD@@B@BAEK@MBCBCDCDCBCBC@AJIBA@CGGACAC@AA@EBIFOPGFAFCDC@AADAAECAAAMEECCCECCABCBCDAACCACACEAECCCCE@CB@@CDADAF@BGBCBDD@DCHABABCBA@AAIAEACCCACAA@SGC@IFG@GACAE@ACAEEECAC@C@ADCFABAD@DA@A@CA@@A@@@A@ABCAC@A@A@AD@@ABAA@A@CA@@@C@A@@A@A@AAAA@C@A@C@A@E@A@A@AAC@ABA@AAA@A@@@C@E@@@A@AAA@A@A@A@@BCB@BA@CBA@C@C@A@AAAAA@AB@@ADAB@@ABA@@@A@"],encodeOffsets:[[108661,36629]]}},{type:"Feature",id:"640424",properties:{name:"泾源县",cp:[106.330646,35.498159],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@@CAC@CAEBABCFABCBEAAACBABCDA@@FCDA@A@@@@AAC@C@AACCAACCE@CBACAAAC@C@C@CCC@AAABEBE@CB@AACCCAAACEACCCECCAACCCCAGAA@CAC@C@A@A@@CAAA@AAECCAECACEAAB@BE@CAAA@@ECA@CAACCGCACC@AAEACCCCAC@ADGDC@ACECE@EAC@A@@@AAC@CACCCAA@ABA@@@GFGDI@ECAEDKFIFGBKACEACCACCCCACC@@K@CBCBAD@D@FADABGDC@E@CACACBCBCBCDADCDAFEDCBC@AD@DBDBF@D@DABCBCBCDABADCFC@EAE@CBCDAFEDCDEBGF@FBDBD@DCDADCD@@AD@@CDENCFCBE@C@E@E@EBIHAD@DAD@FAFABEFCDED@BAB@DBDBDLHBF@B@FABABEBABAFCPAF@D@B@FAD@D@DAB@D@BAD@BBBBBBDBBHDFFHFDBB@D@DBD@D@DD@B@BBF@DBB@DABABADAB@B@B@B@B@DAFAHA@OBEBEDCBAB@DAFAD@B@DDH@B@DABGBCBEDC@G@C@CAEAC@ABC@CBCB@B@B@B@JC@@DBBB@@BBB@BBDCB@@@B@B@BBB@BBBAJABABABABAD@D@DBB@F@B@B@FAD@FBB@DDBBBD@HFBBDBBBFDDBDBDBD@D@BBDBBB@B@B@BBBBF@@BBEFABABAH@D@DCHAB@JAD@BAD@BABEBCDABAD@DA@@D@DABEBADABAHMNAB@B@B@DBB@B@DF@LFF@F@F@B@B@@B@BADADAD@B@BHFBBBB@BA@ABEAE@C@CDABAD@B@B@BE@ABDD@BADEBCBABABAB@B@BBDAB@BAD@@CD@B@BDBBBBBB@@B@B@BABCDGHCDABAB@B@@BBBB@BBB@B@BABEFABAD@B@@BB@BABAD@B@BBFAD@B@BAB@BCF@BADBBBB@B@F@B@D@BBBBBHDBBA@ADCDCB@B@F@BBDCDA@ABA@CB@BBBBB@@@@@DD@D@DBFFBFBDF@DBHBH@JED@THB@DBDBDDFBJBBBB@DABABADG@CCCDAHA@ABEBCDC@@DAF@DDDDBFDFDBDBBDCBADADDBFDDDFDNFBBDBBFCBBBD@DCBEHEPOJEFAB@@CBA@AAA@C@CFKDCBCBA@@AAA@CAAACAC@A@@AAC@G@E@@BCB@B@BADEDBBE@@B@D@B@DADABC@A@ACA@@@ABCBAF@JAF@B@BEAGB@B@@FD@@E@AB@B@@@BF@@BB@ABA@ADC@@@CAGCE@CBADABAB@JDDBDBBBBJBF@BBBBBFA@@DBBBDBBF@DBDA@AB@BBBLDDDH@DBB@B@BAF@B@@@CA@A@A@CBAB@@@BB@@B@@CB@B@BDDBBBD@B@B@@BB@B@DAB@BB@BB@BA@@BAB@D@F@B@BBD@DBBAD@BADAB@D@FBD@BA@CBC@E@C@AB@B@HFJDBAB@BABAB@@@BBB@B@DA@@CCAA@ACECC@@CECECCAACE@AAAAA@AA@CAA@A@@AA@AB@@AAAAAACAC@@A@C@C@A@C@C@A@A@A@CBCDCBA@A@AAAAA@A@ABA@AD@DDD@@@B@BA@CAC@A@ABCBCDC@ABA@ADAD@DADADA@@BAAAAA@AAAAACAAA@@AA@@@A@CDE@@DCHCDCBABABABA@A@AAC@A@A@G@A@A@CBABADABABABCBEBIBE@ABA@A@AAA@ADCDABAB@BA@ABAA@@@@@EA@@EBCBC@CBAB@@A@AB@@@@AB@@A@@@A@@@@@@@C@AAA@@AACAC@ACIAG@@AGAAACAEACBEBA@ABAAAAC@C@ABAB@BA@@@CAAEAEAACCCCC@@CC@@@A@ABA@ABC@ABA@@B@FAD@DA@A@AAC@@AAACBALCJEDCBA@CBA@EBCBC@AAC@K@@@A@A@@B@@@@A@@@@B@@@@@@@@@B@@AB@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@BB@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@B@@@@B@@@@@@@B@@@B@@@B@@@@@@@@@B@@@@@@@@@@@@@B@@@B@@B@@@@@@@@B@@@@@@@B@@@@@@@@B@@@@@@B@@@@@@@@@B@@@D@@ABCAKAGCIAIJAAA@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@A@@@@@@@@@@A@@@@@@AA@@@@@@@@A@@@@A@@@@@@@@AA@@@@@@B@AA@@@@@@@@@A@@@@@@@A@@@@@A@ABCDADELSFI@E@ABC@A@AB@@AB@DCBA@ACCAA@AGOA@ACAMACBAB@BAB@@C@@BABA"],encodeOffsets:[[109055,36237]]}},{type:"Feature",id:"640422",properties:{name:"西吉县",cp:[105.729085,35.963912],childNum:1},geometry:{type:"Polygon",coordinates:["@@CC@AMICACAE@ACAAA@CBEFCDCDADADCDABEBA@CD@@@@@@@@@@@B@@@@@@@BA@@@ABAB@B@@@B@@B@@@DBB@BA@@B@@@@BB@B@@@@B@@@@@@@B@B@@AB@@A@@@ABAB@B@@@@D@ABCBCDODE@C@GBABABADEBBHCBCAE@CF@BBBHBF@D@B@BD@D@DDD@BBBBFADCBCBCAAAAC@CACCCCAIDGB@D@BBHDHAFEDG@IAEEE@ADCBAFCBABE@CB@BADBFDJBDFD@B@@@DADBDDBBD@DCFADCBCBE@GBCDABCDC@EAICE@@@AB@D@@BD@B@F@D@DAF@@BBHFHDDAFADBBFDDDDDH@FBBDFJJF@@ABA@AAEAABABCBE@KBA@GBEH@DABAF@FBBDDDBDBBDDBBDBBFDDDBBDBD@DBBBF@@AFABCDEAEAABCDC@EAACAC@A@CCAEBMPEBC@ABEACBADCDE@C@C@AACC@@@@@@AAAACBEBEBGBAB@B@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@B@@@@@B@@@@@B@@@BABC@E@A@A@ACBC@CACAA@@C@EBAD@DAF@BADABEBAA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@A@@@@@AA@@@@@@@B@@AA@@@@A@@@@@A@@@@@AAA@@@@@@A@@@@A@@@@@A@@@@@@A@@@@@@AB@@@@A@@@@@A@@@@@@AA@@@@@@AA@@@@@@A@@@@@@A@@@@@A@@B@@A@@A@@A@@@@@@@A@@@@@@A@@@@@@@@@A@@@@A@@@@@A@@@A@@@@@@@AA@@@@@@@@@@@@@@A@@@@@@@@@A@@@A@@@@@A@@@@AA@@@@@A@AA@@@A@@@AA@@@@@@@@@A@@@@@@@A@@A@@ABA@@@@A@A@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@A@@A@@A@@@A@@@AA@@@@@@@@A@@A@@@@@@@@@@A@@@@@@@@@A@@A@@@@A@@@@@@@@@A@@A@@@@A@@@A@@@A@@@@@@@@@A@@AA@@@A@@@@@@@@@A@@@@@A@@@A@@@@AA@@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@AA@@@@@A@@@A@A@@@@@@@A@CAAAEAAAAA@C@ABA@@ACEBC@E@C@CAEACBEBC@C@E@@CFM@CDC@C@A@A@ECECACACAAE@@C@ABADCL@DBDBDHJBDBB@D@DAFADCFABCDEBG@EBIBEECAAAEAC@ADCBEDE@EAGCIAECCCA@C@CDCDE@EAC@AB@@ADCBG@C@A@EBABABCBAFG`ADABCBCBEAECAACCE@@@C@@H@HDJBP@DBDAFKHEDKAAAA@@@EBADADCDADAL@X@DAD@H@HADCB@@EAIIA@AB@FAHADC@C@EAECCCIIEC@AAACEEKEKEEEBABABAFABADADABBDDDBBNP@@BB@BCACACAE@CB@DBBBDBB@DCRAD@BCDADADADDB@BHDFBBBBBDDDBBCDCBADCHABBBDBD@B@F@DCHCD@BCB@BABIDGDEDCAEAGBC@GDGFADCBAAACEAE@GDABGBGFAD@BCBADAFCJEJAHBDDBFDF@BAD@DBAB@@@@@@AF@@AF@HAJ@F@BABADCBEFCBCD@DABBDBBBBBFDBBD@BBJBLBFAD@JDNATAL@D@F@@@@@BBB@@B@@@@BB@@@@@B@@@@@@@B@@@@@A@@B@@@@@@@@@@@B@@B@@@B@@@B@@@@AB@@@@@@@@@@@@B@@@@BB@@AF@B@D@B@BB@@BABA@@@AB@@@B@B@@@B@BB@@@@@B@@B@@@@@@@B@@A@@@B@@B@@BB@B@@@BAB@BB@BB@@@@@B@B@B@@BBB@B@@A@@@@B@@@B@@@B@@BB@@AD@@@BBB@@@B@BB@@B@BBB@@@BAB@B@B@D@B@@@@@@BABAB@DABA@AB@@@B@@@B@B@BB@@BBBBDFD@@BBBB@BB@BA@@B@BB@D@BDDBBBBDBB@D@B@@BB@B@@AB@@AB@@BBB@B@B@BBB@@@ABABA@@BB@B@B@D@F@D@BBB@BB@D@B@B@BBBBBB@@@@B@@@BABA@@@@@AB@@@BA@@@A@@B@@@B@BB@@BBBDFFBDBDBBB@BB@DB@BBDDDBB@@@@BBBBB@BDDBB@BBB@@@B@BB@B@D@B@B@@AB@BB@@B@@AB@@ABA@A@@@AB@@@@B@BBB@@@B@@B@B@B@B@B@@@BB@@B@@A@@BABAB@@@B@@DB@BDB@DDD@@@D@DABA@A@@DCBA@@@@@@BB@@B@@@BA@@@A@@B@@@B@BB@@BAB@@B@@BB@@B@@@BA@AB@@AB@@@@@BBB@B@DA@@B@@@@@@BB@@@@@@BBB@@DA@@B@@B@@BB@B@B@BDDBDBDBD@DBDBD@@@BB@@B@DAFABADABB@@@@@B@B@@A@@B@B@B@BAB@@@B@@@BJDLBDDDBB@BBB@@BBB@@DCBCDABCBCBCBCDCB@D@B@D@BEBED@@@BBDAFCHEJ@BA@@D@@@BBD@DAB@@@@D@B@B@B@B@BA@ABBBB@B@@@BD@@@B@@BBD@BB@B@@DBBBBDBDB@@BB@BBBBB@BB@BA@@BABABABAB@@BB@@BB@BBDBB@BBB@B@@@@ADB@B@B@BB@D@B@@@B@DAB@BBABBBBDBB@@B@BAB@@@BB@B@BD@DBFCBAB@BAFBD@@BB@BDBDB@BDBB@@BB@AB@@B@DBB@BBB@BB@B@B@BBBA@@BABBDDDB@BB@BBB@D@B@B@DBFAB@B@@CDAFAB@B@B@B@B@B@BA@A@AB@BABC@C@C@C@C@AFE@@FCBAD@B@BAJIBA@A@@BC@ABC@CAAB@B@DA@@@ABAB@BAD@B@BCDAB@BC@C@AFABAB@BA@AB@@AAA@@@A@@DC@@B@@AD@DA@@B@ACBCD@B@B@B@DA@C@@@CBA@ABAB@B@B@BB@@@@@AB@@@BABBB@@@BABA@AB@@AB@DBB@@@DABA@AD@@ABC@A@ABA@@BA@@BBDD@@B@@@BABA@@@@BBDDBBBB@@DBBBDBBBBB@@DDBB@B@@@BBB@BDBB@BBD@@@BBBBDBB@BD@@BB@BF@FBD@B@D@DABADBB@D@D@DAB@B@B@B@@@BBB@@@@B@BBBB@@BDBB@D@B@BBD@B@@@B@@ABAB@BA@AB@H@DAD@@ABABABA@ABA@A@A@A@@AA@A@ABABABA@AB@BA@@@AEA@@@ADAB@@A@@AAC@A@@A@@@A@AAAE@AAAAA@BA@@ACCAA@CAAAA@A@@A@AAACA@A@ABABAB@B@D@@@BAB@@BBFB@BBFDBAD@B@BBBB@@B@D@BA@AB@DABBB@FBB@BAB@B@BBB@FA@@BBBBB@DBB@BBB@DCDCFA@A@C@A@AAC@@BA@ABA@ADABABABABA@@B@BD@@B@@BDD@BDBB@B@BB@@@BBBBB@BBBFFB@DDBB@BBB@BHBB@B@@@HDBBBBBBDBBBB@B@@BB@@B@B@@AD@BBDBB@BBBBB@@@D@B@@BB@@BBBBB@F@B@BDDDDFBDBDBFB@@@F@J@@B@@ADAF@B@@BBBB@@@DAFABCDAD@B@DBFBD@BBD@JB@@BFBBAB@BAB@B@@@B@@BDJ@ADA@@B@BBBA@@B@B@D@D@F@@@HBB@BABC@@B@B@@@BH@BB@B@BGDCD@@BDBFDD@BBB@H@F@HCDAHALCNCDABABABCDABAB@B@D@NED@B@BA@@B@DADCDA@A@IBA@@BBB@@@LCB@HBB@D@HU@A@A@@@A@@AA@@@A@@BEDI@A@A@@ACAA@@AA@@BADCFGBA@A@A@@AA@A@@@ADERYBA@@@@B@@AHEDG@ABA@@@A@C@G@A@I@@@AB@BADG@A@@@C@@@A@CAA@@AAA@@@@A@A@@CG@A@A@@@AAAAK@@@C@C@A@ABCBA@@@A@@GGAA@@A@@A@@AA@A@A@A@@BA@C@CBAA@@@@AGE@ABG@ABA@@B@B@BABADCB@BA@@BCBA@ABC@CGKA@AAA@A@GC@@@@AE@AAA@@@@@ADABABA@A@CBQ@C@@@@KGGEC@AAA@AAAAA@@C@ABA@CB@@CAA@AAIAGAABCAA@AAABG@A@AB@D@B@B@BAB@@ADA@@D@B@BA@@BABCBCBAFI@@@C@@@E@@BEDEBAFADADBB@B@@@B@@@BA@@BC@CBCDA@A@@@C@AHGBCBEBEBCBA@C@A@@@A@@A@CAA@@A@@BABC@CACAC@A@CBC@AB@BAB@@@@A@AAEAEAAAAAAGACA@AA@@@GAG@AAA@A@KCA@C@A@C@A@@@@@A@@C@@A@A@CAAA@A@A@AA@@@AB@@AAAA@@AACBA@AC@AFE@C@@B@FABA@@@ABABA@@@AA@A@CC@AA@EA@@A@@@@@A@@@AAAE@AACCA@AA@BAAAADCBCACA@ABA@A@AAAAA@A@A@A@A@@@A@@@@CAA@@@CC@AA@A@@@AA@@BC@CBAB@@C@AB@AC@@A@C@AA@@@A@A@@ACA@@A@AB@BA@@@AA@AACA@A@A@A@@DABA@@BAD@NADAFADADAD@BA@@DCBA@@B@@@BB@B@DHD@@B@BAD@B@BBB@@@@AB@@A@A@@@ACA@@@A@AACACAGA@@AA@@A@A@A@@AA@AD@BABAFC@@B@B@B@@AB@@A@AAAA@AA@AB@@AB@@A@ABA@ABABC@@BAAAA@E@A@AA@ABCBC@@FS@@@@@@BAB@@@@@BA@ADABA@@B@D@B@@@BABAB@B@F@BABAB@B@@BB@D@B@BCB@B@B@H@B@B@DAB@B@BAB@D@D@@@BABEBCFE@E@EBEDI@G@AAA@@AB@@AB@BA@A@CAA@A@@@@BADA@@@A@A@AB@BA@AA@@AA@@A@AFAD@BA@AAA@AB@BA@A@@@A@@@@E@@AAA@CA@@AAA@@@A@AB@BA@A@A@@@A@@BA@A@A@@@ABAB@@A@A@A@AACACAABA@ABA@ABA@E@A@A@A@A@A@C@CF@@A@A@BE@AA@@BKFCDE@C@A@GAEDC@EDE@MDA@GAECEAEC@AACAAAA@@BCAGCAC@AAC@CBAA@GAIGQGEA@GC@ACACCACA@C@A@C@ABEDCDA@G@C@AACAABE@C@@@C@A@GBGBC@A@A@ACAA@CAAC@@@WR@AC@@@AAAAACCG@A@C@A@@A@EBA@A@A@A@CABEBC@@A@AAAA@@A@@ACIAA@A@A@CFM@@FG@ABAJI@ABA@@AA@AEECEEGACGGAA@AAA@A@A@@@ABCBA@A@A@CAAAACC@AA@GAA@A@ACAAC@A@@@AACE@AA@CAC@C@@@A@CACCAA"],encodeOffsets:[[108359,36432]]}},{type:"Feature",id:"640425",properties:{name:"彭阳县",cp:[106.631809,35.858815],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@AE@C@AAAA@C@C@@B@@@BBB@@AB@@@@B@@@B@@@@BBB@@BBBBB@B@@A"],["@@AAIACACBA@A@AA@@A@ABABA@ABICGEA@A@@B@D@FAD@DABC@EAC@A@CBABC@ABCAC@AAA@E@C@A@AB@@ABA@@AAAA@CBA@A@@AA@A@C@AACAACA@A@@DA@@@AA@@A@AB@D@B@BDB@@A@E@ABA@A@CAG@CCKCAA@ABAB@AC@CAECAAACA@@EBAAAA@AAEAIAACACAICA@ABCBAB@DDFBH@D@@CD@BAB@BAA@@AE@@A@A@@B@FC@@EA@A@BHAFA@E@IBE@ABAD@B@@DB@B@BADCBCBA@C@A@@@AFCACFABA@A@AD@@@F@HBD@BB@D@DBBBDBB@BB@@ABADCDEL@D@DBB@BAB@D@BBDBDHH@DABIJ@BADADCDCDADAD@NFLABA@@@AC@@A@@DAB@FA@A@AAIECACCCAKGAACBA@CBC@ABGDA@CBADCBC@A@ABAB@BDBDB@BAD@@CBA@@DBBFDF@BBBFBFDFFD@B@DB@FAB@BB@@@D@FBBBBB@D@DADAD@B@@BADABBBBB@B@DABEF@@@BDBD@BBB@BH@DBB@BB@DADAFEFCFCFEFADCDAD@B@BBFFBBFDFBD@DADEBEB@FBDDBBABCF@BAB@D@BEDCFC@ABCDCBCBCBA@AD@HA@KVAFAF@BBDBBBB@DFJ@DBDBB@BADBB@BBF@@@@DBD@F@D@B@JEHAHABCHCBADB@@DABAD@D@F@F@FAFCHAD@F@DB@DB@DBF@F@J@HCB@BBBBB@DABAB@@BADCDADAB@@@DBDBBDABBDB@@@BBB@BBBBD@BB@D@D@D@FFB@@A@C@@D@BBBDDBFBDBDBF@BAB@FBDB@D@HDF@@DBDBDADADADADCBAD@FBB@@@DADCD@HEFGBAHEBAFE@@@AB@@@@DDJDHBHDDBBDCD@HBFBFBDBD@BABCD@@@B@D@DDBDFHDBDDB@B@@BABCDDJDFB@@BB@DD@@@D@B@B@D@@AHAHEDADCFEDEFADGFGNGLQLIDE@@@AAAE@CA@A@ADABAB@BCBAJAJCHEJAJCL@BABCFEHCHEFEDADADADC@C@CBGFAF@DDH@BBB@BDAFAFBD@BB@HBB@B@D@BB@B@BB@@@BAF@D@@DDB@@BBDBB@BBH@H@BB@@BABADABBB@B@B@BBB@DBD@B@BA@@B@@ABBBADBF@D@BAD@BADAF@BBD@B@NALCF@DFP@H@B@BBD@B@BBB@B@BBBFJBBBBHJBBBBBDBBBDBDBFBDDJBBBDBD@@@@@BBBB@@B@BBB@BBBBBB@@@@BDF@BDBBF@BBB@BA@CBEBCDABABBB@D@B@@@@B@@BB@@BBB@B@B@@B@BB@@@@B@B@@@B@@@@@B@B@@@DADAB@BA@ABABADCB@FAB@FC@@B@B@@AB@@@BCB@@A@@@@BC@AB@@@B@@A@@B@@BB@@@@BB@@BBB@B@BB@D@@ADA@@D@@@DBDBBBBBBF@@@B@@BB@@B@@BAB@B@BB@BB@@@B@@@B@@@B@@@@@B@@AB@BABAD@BBD@@AB@DA@@B@@BB@@@@@@@@@B@@B@@B@@@@@B@@@@@B@@@@@B@@@@B@@@@@@A@@B@@@BAB@BA@@@@B@@@B@B@@@B@@@B@@@BAB@@@BA@@@@@@B@@@@@@@@@@@B@@@BA@@@@@BB@@@@@@@B@@@@@@@@@@AB@@@@@@@@A@@@@@@BB@@@@@BB@B@@@BB@@@@@@BB@@@A@@@@B@@@B@@A@@@A@@@E@@@ABA@A@@@A@@BA@A@@@@B@@@@@B@@@@@B@@@BA@@@@@ABA@AB@@@BA@@@@@@@AB@@A@@@@@A@@B@@B@@@@BD@@@B@@@BB@@@@@@@B@@@@@BA@@@@@@@B@@@@@B@@@B@@@B@@BB@@@@@@AB@@@@@B@@A@@B@@@B@@@B@B@B@B@B@BB@@BB@@B@@@B@B@@BB@BB@BBB@B@@@B@@@BA@A@ABABA@@@A@A@C@@BE@AAEBE@A@ABA@AB@@@B@@@@@BB@@B@@@@@@@@B@@@BB@@@@B@@@@B@B@B@@@@@BA@A@A@AB@@ABA@@B@B@BABAB@B@@AB@@AB@@@B@@@B@@@B@@@BAB@@@@AB@@@B@@@B@@@BB@@@B@HBFBD@B@@@D@B@BDDBB@@@BB@@@B@@@BA@@B@@@@@BAB@@A@@A@@@A@AA@A@@AA@C@@@CBIBA@AAE@A@A@@BEBA@@B@BA@@B@B@B@@A@@B@@@B@@@@B@@B@@D@DBF@B@BB@@@@@B@@@@AB@@AB@BAB@@AB@@@@@B@@@@@@@B@@B@@@@B@BB@@B@@B@@BB@BB@@BB@B@@@B@@@DB@@B@@@B@@@@@@B@@B@@@@@@@@A@@@@@@B@@AB@@@B@B@@B@@AB@@@@@@A@@@@B@@B@B@@BBBB@BAB@@ABABA@A@CDG@C@@BADEDEBCB@BA@CBA@A@C@@@C@AB@B@@ABC@@BA@@B@@@BBB@@@D@@BBA@@@@@A@@@AB@BA@ABABAB@B@BA@AB@@@@@@B@@@BBB@@BB@BB@B@@AB@B@@AFAB@BAB@BAB@@BDBB@BB@BBBB@@@@@@A@@BA@@BA@A@A@AB@@AB@@@B@@A@@B@@@@@@@@@@@@B@B@@B@@@@@B@@@BAB@@A@AB@BC@ABAB@@AFAHEB@BA@AB@@@@@@@@@B@@@@ABB@@@@@@@@@@B@@@BC@@@@@@@@B@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@B@@@@@@@BA@@@@@@@A@@@AB@@@@@@A@@@@B@@@@@@@@BB@@@@@@@@@@@@@BA@@@@@@@@@A@@@@@@B@@@@@@@@B@@@@@B@B@B@B@DBD@@@B@@BB@@B@@@@@@@B@@@BA@@B@BCDADA@AB@@B@@@@@B@@@@BF@B@B@BB@BBD@@@BA@@BA@@B@@@BAB@@@B@@@BCB@@@@AB@@ABA@A@A@@BA@@@@B@@@@@@A@@BA@@@@B@B@@AB@@AB@@@@@B@@@BA@@@B@@@@@@BB@@@B@@@B@@@B@@BB@@B@@@@@@B@@@@AB@@B@@@B@@BD@DB@@B@@B@@B@@B@@@@B@@@@A@@@@@AB@@@@@@@B@@BB@@@@@B@@A@@@A@@B@@@@@BABCBAB@@@@@@@@@BB@D@DBFBHB@@B@@B@BB@@@@@@@@B@@@@A@@BCBABABA@ABC@GBEBE@C@@@A@@@A@@@@@@@@A@@B@@@@@@@C@@@@B@@@@@@@B@@@@@BABC@@B@@@@@@@@B@@B@@A@@BAB@B@@@@@@@@B@@@@AB@@B@@DBB@@BBB@B@B@@@BA@@B@@@B@B@@@@@B@@B@@B@A@@AAA@@@C@@@ABAB@@ABA@@BAB@BA@CB@BC@@@@@A@@@ABC@A@A@A@A@AB@BA@ABA@@B@BAB@@A@@@@@@@@@@BA@@BA@@@@@@A@@@@@BA@@@@@@@@@@B@@@@@B@@@@@BB@@B@@B@@@@@@@@B@@AB@@@@@BCB@B@@A@@@@@A@@@AB@BC@@BABC@@@@@AB@@@@@@A@@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@@BB@@@@BB@@@@B@@@AB@@@@@@@@@BB@@@@@@B@@@B@@@B@BA@@@@@@@A@@@A@@@@BABA@@@AB@BABA@ABC@ABA@@B@@AB@BA@@B@BAB@@@B@@@@@@@@AACACAA@@AAAC@@@@B@@@BC@@@AACAA@A@AAA@ABA@@@ABA@@B@@A@@@@@@@@@AB@@@@@@BB@@@@@BD@@@@@@@@@AB@@A@A@@BA@@B@@@@ABBB@@@B@@@@@@A@@AA@AAC@A@@@A@@@A@@@@B@@B@@@B@BB@@@@@@@@@@@@ABA@C@@B@@@@@@@@@@@@@@D@F@B@B@DBB@@B@@@B@@B@@BBBD@B@@@@@@@@@@@BB@@@@@@@@@BB@@BB@@@@B@@@B@@@@@@@@A@C@A@@@A@@@CAA@@@A@@@AA@@A@A@@@@@@@@@AAA@@@A@A@A@AAC@@AA@A@@@@@AB@@@@@B@@@@AB@@AB@@A@@@@@@BB@@B@@@@@B@@@B@@B@@BB@BBB@DBDBB@BDB@@@BB@@@@B@@B@@@B@@@BADADC@@@A@@@@A@@A@@A@AA@@@@@@A@@AA@@C@C@@@AA@@A@ABA@C@C@@@@@@AB@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@AA@@@@A@@@A@@B@@@AA@@@B@@@@@BABABABABABA@AB@B@D@B@D@@@B@B@@A@@@CBI@C@AB@@@@B@@@B@D@@@@@@@BBA@@@@@AB@@A@@@@@B@@@@@B@B@@B@@@@@B@@@@A@@BC@A@A@CACAA@A@@@@@A@@B@@@@AB@@@@@@A@@@@@@@A@@@@@A@A@A@@B@@AB@@@@@AA@@ABAA@@A@ABC@@@@@B@@BB@@@@@@BA@@@A@A@C@A@A@@@BA@@BA@C@@@AAA@A@@@@BB@@BA@@AA@A@@@AA@A@AA@@@@@@A@@@A@@@@@@@@A@@A@@@@@A@@@A@@@AAAAAA@A@A@A@@@A@@@@@@AA@@@@@A@@@A@@BA@@@@B@@@B@B@@A@@@@@B@@B@@@@@@@@@B@@@@@D@B@B@@@@@@@@@@@@@@AA@@AA@@A@@@@A@@@@@A@@@A@@@@A@@@A@@@@@@@@@@@@@A@@@AA@@A@@@A@@@A@A@@@C@A@A@A@A@@@C@@@A@@@A@C@@@A@@A@@@A@@AA@A@C@A@@AA@A@@@@AC@@@A@@@@@BA@@AA@C@@@AA@@A@A@@@A@@@AAA@A@@@A@@@@@C@@@A@C@A@@@@B@@@@@BABAB@@ABA@@B@BAB@B@@@B@@@B@@@B@@@B@B@@@BA@@B@BA@@@A@@@@B@B@DBB@B@B@@@A@@@@A@@@@@AA@@A@@@AB@@@A@@@@@@A@@@@@@@@A@BE@ICGGG@@ICGA@@CEAEA@@@AAEDA@@@AC@GAA@@CACACEAE@G@UACC@EDAF@D@FDD@FCDEDACA@CBAAEAA@@BBB@D@BE@AACDABAAHU@EBE@@FGFCJCDBNCF@BD@FDFDDFBHAH@FFBA@A@@BA@@@@@@@A@@BA@@@@@AB@@@@A@A@ABA@@@@@AB@BA@CEIAGCBCDC@AAACAAIE@BCCA@A@@BA@@@ABA@@@@@A@@@@@A@@@@@@BAB@@A@ABA@@BA@A@CC@@AC@@@A@@@@@@A@@CAA@@CBA@@CBC@C@C@C@EC@C@CDEDEBCBE@EAGCEEECCCCEA@ABABA@GA@@EDA@@ABAACC@BABABEBC@EEAECCAAAE@AA@CBADEFADAFAF@FAD@FAD@BBBFBBB@DABCDA@C@EDEAEA@CDCBCFC@A@A@CC@AAA@A@AB@D@BCJ[@ABBABBB@HD@@A@@DAAABA@AAAB@@@@A@@AE@@@@BA@A@@@@B@@@@A@@@@BADE@@B@@A@@BA@@@@BA@AB@@AB@@@@@@@BA@@@@@A@@@@@A@@B@@AB@@ABA@@B@@@@AB@@@@A@@@@BA@@@@@AB@@@DAFDFBHDBA@@DCBCBC@ADEBCA@AAAC@A@ABAFAH@D@BC@A@CCCECC@@@@@@@A@@@@BCB@@AB@@@@@@CBAAI@GBEDA@C@@@AAAA@@@CBABAB@DBB@B@@GBEACBABAB@DBD@BA@@AAC@BAFBBAB@@G@CEC@CDCBABAA@@@EB@A@EBEAEEAABCFCDCBCAACAGAG@CBA@A@@CAMBEBA@DJ@HCDGDEBCDE@@@C@AACC@CBGAGAE@E@GBK@GAG@AAAAAA@GBCDEDCBGBGAEAG@EB@@A@@A@CAE@@CBCJA@CDG@A@A@EA@AAA@A@CFGBEDCAAABA@@BA@@@@@A@@AAA@@@@AA@@@@@@@@@@B@@@@@B@@@@A@@@@@@@@@@@@BA@@@@@@AA@@@@B@@@@A@@@@@A@@AA@@A@@C@@@A@@@@AA@@@A@@@@AA@@@AA@@@@AA@@A@@A@@@@@@A@@A@@@@@@@AA@@@@@@@@A@AA@@A@@AA@AA@@IBEFAB@B@HA@@BAACAAAA@ABABA@@DC@AAA@AB@DABE@AA@@AAA@ADCBC@AAAAAA@B@D@D@BCBEACCECCAC@AFA@C@AA@ABGAE@E@@AE@EBABA@AA@A@CCCCAA@ADCDB@BF@D@BGBCAE@@AA@@C@A@CBEBE@CACAAAABADA@@A@AAAA@ABAB@DA@GDCFGDCBIHCBEBEDGDEDEBEDEDCF@D@DABA@AAAAEA@BA@@BBBBBDB@B@BA@@@CBA@CAC@AAAE@CACCC@A@ADEBC@AC@EFA@@ABCAA@@@@CBA@AA@AAAAAI@CB@BABAFABABA@@A@A@EAAA@G@CBCDCBE@@@EDABADGJCDADAHAN@B@HCBC@EEAEAE@E@CCAAB@D@D@D@DADE@@FABABC@A@A@CA@E@EBCDA@CAA@AAAA@AAAA@@CACBA@@BEBG@C@C@ADCDEAEBCDCDCBG@@@CAGBCBA@EBEAE@G@ABC@CBE@E@AA@ABI@G@E@CAAA@AHADAF@HADCAGB@AGAAAK@@@CBC@E@E@EAA@CAAA@@@ABA@@@AA@CAAAAAA@@A@@DA@@@@ACBA@@CAOCG@A@"]],encodeOffsets:[[[109061,36594]],[[109030,36579]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
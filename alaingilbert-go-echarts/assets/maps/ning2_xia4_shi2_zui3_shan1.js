@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("石嘴山",{type:"FeatureCollection",features:[{type:"Feature",id:"640202",properties:{name:"大武口区",cp:[106.367958,39.01918],childNum:1},geometry:{type:"Polygon",coordinates:["@@KM@@IVKF]NIFiRbGDA@ABCHEHCFW`@D@DAHBB@@@@@B@B@@CB@B@@@D@@@@AB@@E@AB@@@@AB@B@B@@ABA@CBA@A@@B@@@BABCBI@ABEH@BABA@CBGFCB@BABABAFADCDABABA@EB@@CBA@C@A@@BA@ABABGHEBABC@AB@@@B@@@BAB@@@@A@C@A@C@@BA@@@@B@BAB@BCFABA@@BA@CBA@@@CFCBABCBCBA@A@C@A@A@ABEB@BA@@@@BBB@B@@AB@@ABA@EBABCBABA@C@C@A@CBE@A@EB@@@BB@B@BBA@AB@@AA@@ABED@B@BBB@B@@A@AB@@A@@@BB@@@BAB@@@@@BA@@@A@@B@@B@@@@@ABAB@@AB@@A@@@AD@BBBBBB@D@BBBBQ@MEGBEJJDIF@DEHMDC@C@BDDDBBBBBF@@@JBFDFFFFFB@HFBBFHBFDJDFBHFHDFFJJNBDHHDFDFFHFFNJBBFDF@HBJBDBHEFCBCDCBEHGJGFADAHALAF@HANAF@F@HAF@L@FAHAFEJAFBFDHDFDJDHBHBJDDBBBDDBJFJDFBDDLCPEJEL@FAL@J@JBHBH@LCL@B@HALCLCJ@FAH@J@BAFBFDFDDFDFDJ@NDJ@JAH@NDJAFBHBFBJFXDNDLBPDLALBJBNBJBHAHAL@J@NAF@JAB@FADA@AF@HAL@F@HAFAFAFC@AB@BATCFAPALANCB@D@@B@AAMAEACAAA@AAAAA@AA@@@A@CAC@A@AAACEAACCCAAA@AAA@A@A@C@A@A@@ACAAACCAAACAACCACEAACICCGKCEAC@ACM@ECKAA@C@A@A@@@AB@BABAFAFADCDABABCBA@A@@@A@A@E@A@E@AAA@AA@CE@@AA@AAIESCKIGKM@CIIAEECAAAEAAACCAAAE@ECCCAEBETYHGLAPAJCFGFENGJK@]@OKIMIfBAW]KMIMGACBeg"],encodeOffsets:[[108995,39869]]}},{type:"Feature",id:"640205",properties:{name:"惠农区",cp:[106.781176,39.239302],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACCKCGKIDMIKDEBAGEKEIDEAA@E@EBAAKOmyE@G@IB_DIBeNJLJ@P@^ILMHEFEHIDOBKBGHSZAFBFDDFDF@BBDBBDBBBFBBFDBFJJ@DLNJHDLFTBJ@BBB@@DFB@@BBB@B@F@B@F@B@B@@@BABADABCBCDEBEBABABA@@B@@@B@B@DBBDL@FDN@BBDDFHLDDDJBBDFDBBDDBBBDBBDBBBD@@@B@B@D@B@BBB@BBBDBDDBBDFBB@B@BBD@D@B@@BBB@BBBBB@BBBDBFBN@BB@DBFFBB@D@D@HHBBD@LBDDB@DAB@FFBDFAB@BABFBFAF@BBBBFHDDB@HBBBBBBBDBD@HDBBBBBDDDD@FF@BBBBB@BBBBDBBFFBBFBDBFFBBBHFHFFFJBBDFDFJHHCrOPKJCjEV@LBFDRNpTFAPALBHDFD@@FDHBFBB@B@DIDELAV@L@HALIFIBCDEFKDE@CCECCAE@EDEHKHGHG@GCIAACK@I@@@IBGDIBMAGAGMSCGCECM@IHEBAFKAICK@MLKVKB@XQBIDEBIHaJQJOJSDSHWJQRQFCDC@AAACMEKEGLE@G@ISMDIHGAACGDE@GAGEEGGEEIIEGAIAIAGAUGKEHGDCBE@CDEBMHEDABE@A@C@C@A@ABA@AHA@AB@@BBB@B@@@@B@@@@@@A@@BA@B@ABA@ECA@@BCA@F@BCBA@@BE@CBMDCBA@EBCB@@CB@@A@ABDDBBFFDFDBDFFA@B@BBBBDABB@@BBD@B@@@@C@BD@B@@CBA@@@@D@DBBDHBHB@@@BB@@@@@BB@@B@@D@@@@B@B@BB@A@@BCD@BCHCF@@@@AB@@A@AAC@AAC@C@A@A@@@AAABADB@A@@B@BAB@@AA@BA@@BADAB@@AAA@@@@@C@@@@BA@AACB@BC@@BC@A@C@AA@@AA@A@A@A@CAC@A@A@A@A@A@@@A@@A@@@C@@@A@@@AB@@A@@@A@A@@@CDABA@@BA@ABECAAA@AAAAGA@NCCCB@@EAABCCCCSQJCGG@I@CCDEB@Ce^A@CEAEMJMJA@@@GEKCCCAGCG@ED@AI@CAIEIEGA@@@CGAHCDC@AACCACACED@@CBOPCDKLQPMDECEE"],encodeOffsets:[[109141,39991]]}},{type:"Feature",id:"640221",properties:{name:"平罗县",cp:[106.523474,38.913544],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@{MUě@@@@KF@DGDILIHADBD@F@J@L@JIFUFODGDGBIDIBG@CBC@EACB@BBBDHDVBDBN@@CBYBWAQDUFSFCBEFOHC@IBG@U@E@OB@@ABCDMHGBC@CBA@EBKAEDC@AACMIGME@@AAC@C@C@C@EBCBABA@AB@@@@BB@@@BBB@B@B@B@B@@BB@DFFBB@B@@@B@D@D@B@B@BABA@EAE@OAI@K@EBG@ABA@ABABABAB@@AB@D@JCB@DD\\@DAB@@A@CBSBK@A@ABA@@B@@AB@BHLHP@B@@A@@BA@A@C@AAC@A@AAAAC@C@CAE@E@E@EBEBIBGBEBC@C@A@AA@@AA@@AAC@AAC@C@A@E@E@ABC@CDA@ABAFB@LNCDC@G@GAA@A@CA@AA@AA@AMC@@@@AA@@A@@AABE@GBA@@BAB@@@D@@@B@BA@ABA@CBCBA@AB@BA@CDABABKFEBABABCAGAC@C@AAA@EBA@CBA@@FCFAFCDCFA@@BA@A@@@C@CACAAAAAC@ECGA@@AA@@AA@AC@ECA@A@C@ABC@A@@BCD@HCBI@C@C@ABC@A@A@CBGBI@I@SDK@IBGBC@A@A@A@CB@DAD@@@BA@ABC@ABAB@B@FAH@H@B@@@@C@A@C@A@ABE@A@C@C@C@CB@@A@AAA@E@EBA@A@CBA@CBEFABABABG@ABC@ABEDCBEBIDGD@BABA@@BA@@BAB@@C@@BABABA@GBCBEBGBA@AAA@AAGBIBGBGBKDEBABA@@BC@AAAAA@@@GBGAE@E@EAA@KLEHEJCDAJADAF@HBFBBDFHFFFHDDDHFFDFBHFFBBBDBFFFFBFFHDFDDFFDDJDDBNHLBH@HADDDBHDDBBDBF@J@DBBDBDLDBBDJ@BBBFBDDDDFHHBB@DBDBL@BA@GHGFABADABBBBDBBBBDBDD@@CDCBA@BBBDBH@D@D@JDHFL@FBBD@D@NCFG@CJEICFIHANFR@AAAAC@A@AAAA@ABC@@B@@@BA@@BABA@@@@A@@@@AB@@@B@@A@@@@BA@A@@AA@@B@@@BAB@@@@AAA@A@AFCBA@@BB@@BAB@AAA@A@@A@@FAB@F@DAB@D@D@B@BADABAFAB@BA@@BA@@@AAA@A@@B@@AFABAB@B@D@B@B@DADABADADE@@B@DAB@@AB@BADE@ABA@A@A@@B@@AD@B@D@B@@@@@BA@A@@@A@@BAD@BAFAHGBABAB@@AB@D@B@DA@@FAB@BABADCBCBEBABA@ADAHEDAB@BA@AFGBAJ@DABA@A@@@AB@B@DAB@BA@@@A@ABA@@@@BAF@@@BA@@@@@C@@@ADA@@@A@A@@@@AABG@C@CX_DEFGDGBAB@HCajQJE^MLEJU@@LNfhDAHBJNLNX^ABJA`CJAH@F@nzLPBBFAF@B@FBJCLFHFABCFJLCNLJDHDLBDFFFDNCROLKDCPODA@@FCBDBDDDBBD@DCBGDH@@B@FHFJBJ@DBJC@@FDHBHDDLDHF@@B@NINIBFDFB@f]@DFADC@D@JHHIDTRDDDDBAFB@@DADD@MHBBBBBB@BBFDBAB@@AB@BADC@@B@B@@@B@@@BA@@B@@@D@@@B@@@@B@@@B@B@B@B@BBD@D@B@B@BBB@@BBD@B@D@@AD@@ADABBB@@A@@D@@@@@B@BB@@BABC@AB@@ABB@@BA@A@AB@A@BCBABB@@B@B@D@D@BBD@BBB@@@BA@@@@DEDG@ADC@AB@A@@A@A@A@@C@@@@AA@@A@@@@AA@@A@AGCGAA@C@C@@B@DA@@@AACD@@@@@@AAC@AA@BAACAA@A@AEBCECACEEEAACCBAB@@@DA@@DAFAB@DANCDAF@@AB@DA@A@EDB@AB@FDB@BAA@B@@AB@@@@@@@@A@@A@A@AA@@BAB@BGB@BAB@D@D@B@F@BAFCNGFADCF@DAHCFGHLBVBHBJBJFHJJFFHHFFBH@HCFDHBBGHCJTN@J@HKFFHFLDNBB@BNGDAB@HAFALCD@BAB@BAB@B@B@@AB@@AB@@AB@@AB@B@BAB@B@@@@@BBD@@@B@BAB@B@@@B@B@@@B@B@@AB@B@@AD@@@FAB@BA@@@@B@@@@@B@@B@@B@@@B@@A@@BA@@@@@@B@@@@@@AB@@A@@B@@AB@B@B@@AB@@@@A@@@AB@@A@@@@BA@A@A@A@AB@@A@@BAB@@@@@B@@@BBB@@@@@B@BA@B@@B@@@B@@@BA@@@AB@@@@AB@@@@@@AB@@AB@@A@@B@@A@@B@BAB@@@@BB@@@@@D@@@@@B@@@B@@E@@JuAQAOCQGqE_CWIO{[UueicicSQAAAAGGgk@A@@ACAAAC@@AAAA@@AA@ACAACCEAAAA@@AA@@@AAAAA@AAA@ACCAC@@AAAAA@@AAAACAAAAAAAAAAAAACACCCA@@AAA@@@AAA@AAAAA@@AAACCA@AA@@A@AAAAA@@"],encodeOffsets:[[109319,39680]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
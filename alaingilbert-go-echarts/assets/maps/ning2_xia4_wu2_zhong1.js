@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("吴忠",{type:"FeatureCollection",features:[{type:"Feature",id:"640302",properties:{name:"利通区",cp:[106.212613,37.98349],childNum:1},geometry:{type:"Polygon",coordinates:["@@OI@ES@C@E@GAEAO@SCAACA@AAA@KBAAUAE@WC@A@EBMFABE@CBCD@@CDA@@BCBCFAB@B@@A@CDID@@AB@@C@@@AB@@A@CBCBA@ABC@CBC@oHSB@@@@@BBD@@A@@@A@A@@@A@@@AB@@BBB@DB@B@@@@CBAB@@@B@@@@B@B@F@@@@B@@@@@BAB@B@@@BAB@@@@@BA@@@@JDFBDLLBHLHBDADAF@HBDB@FDDDDDCB@@@AA@A@@@EACAECA@A@CAKJQDSLQRWHSRMNEHPRTP@RKPITHPITAbRCLINIPB\\@VCTLLTPVRTMLRJRHPNDO\\LZTB\\CTITELSFGBIDW@QFKES@KBaMQF[FsJiPOUß@c@BE@CDG@GDAHCJANELAL@FAHAJAHCBEEGEGFEDABECA@CBGFEBIBG@E@EDG@IACG@ECEAG@IAG@I@EBEBG@G@I@KDKBKAE@G@I@IAEACEEGAIGEGB@AGACBCAAAG@GCIA@@GAAGGCIEEDCGECEAGAEAEEICMBKCICK@CGIEAEKCI@GAGDGDMDGDEFADEBEBAFBFBHBHCF@HDHDFEFBFAHCFIF@JCHGHAH@HCFGBCDGDEDCHAFEHBDBDFFAH@HDHBFFJHFAHCJI@I@IDEHKDKHIDGFKCAHDFCDDH@@@@@@BDFFFDFHDFDDDFBDDD@BBD@H@J@F@D@DJLBFDF@JANAB@H@J@L@HBDBHDJHN@L@XHXBJBHBNBP@FBHDRFTBLDHBNBFBL@PBH@HBFDHABA@CDEBA@ABABCBA@A@A@@@AB@B@B@BCHA@GBECAAC@CB@D@D@@@D@B@BBD@BBBBB@BBBB@BDDF@DB@@BB@B@BBHF@B@BBB@@@NBD@B@@ADAB@@AB@@E@KBIEGBI@@@@DFDBBBBDDABAB@@C@GAGAE@@@@@ABA@C@B@C@A@A@EBKDIDGBCBA@GBA@@BBBBBBB@B@@ABABAB@BAH@FAFBDDDB@DBHBH@J@BDDFFBJBJDJHDBLLHHB@BBBBFDD@B@BBD@HBP@HBD@@@HDBBB@@BDBBFDD@D@BBDDDB@DDD@BBF@HDHF@BBBFHBBF@JBD@DBBB@BBB@BDF@@BFADADADBDBDHFFBFBDBDBBBBB@D@@AHAD@F@F@D@BBFBBBDB@HDB@BBDC@ABCBADALADADADADABABABADEBC@ABE@ABCBC@@BA@C@AFGJBH@UTtCD@@E@C@A@C@CAG@E@ABMK@C@@A@CBEBAHA@AB@@AAAC@C@@A@ABAB@BAD@BA@@@AB@@C@@A@@@BA@ABAAGBAB@@C@ABE@@FDDBPHB@@@DAPI@A@A@ABCDE@@@CBAB@DADCFENE@AB@EGAAGIAAACC@I@C@C@JGBCDCHGB@TBD@R@B@B@DAL@@A@A@A@@@A@A@ABC@A@C@A@E@ED@B@D@B@N@@@D@BAB@BA@A@A@EBa@E@CBA@ABAB@BAB@B@AAAK@@AAQOAAAEAAA@CBA@EAA@C@@@OFA@CAAAKACAA@G@Q@K@EAC@A@A@E@A@@AE@@@B@BAB@@@@AA@EA@@@@@A@@BABA@@CA@CAAAC@AAAACBADCB@B@B@BA@@@A@@AA@AA@AA@A@@@AF@@AB@@A@AC@AA@@AA@AB@@@@A@A@@B@BEDC@@B@BAFE@A@CBAD@@AFADA@@BAB@@@BA@@@A@EVGTAJALBDCN@AKh]bQBM\\A¤HtJEleXLVGPWjçzHOjAW½©NGHG\\iz{VcFIBEBCDGBABABCBA@CBABABCBCBCDEIOGOEMIOGOIQBGDGDGBIDIBADQADKLWN[BE@ABOBESCWEUEGA[G[E"],encodeOffsets:[[109196,38240]]}},{type:"Feature",id:"640303",properties:{name:"红寺堡区",cp:[106.062113,37.425702],childNum:1},geometry:{type:"Polygon",coordinates:["@@LDBFJFDHL@JDLDNAJDFFFBHBFBFDDHFCJFHDBHHB@@JBHDH@BBDBDAHB@BHAHFBJFHDFFBJBJ@H@F@LBLALCJ@H@H@FAFAJ@H@JBH@FBFDH@BD@JCH@F@FAHAJEFAH@DDBAFCBEFFHFHAFGDIBGBEBK@KBMFIBGDCB@HCH@DAFd@à@PVjOtI\\EREbNLAT@LFREX@JCHATEFKJSDSA[YS[KCPOMQGQINKQSOUKSSKUD[@OAMJKJQDBaJSGOJSLO@QSOOQFGNMTQXGRQTKRCLIA@A@C@C@A@EA@AA@C@@@AAA@A@KJAB@@@BA@ABC@@B@@BB@@@@@@A@A@A@A@AA@@AB@@@@@BB@@@D@B@@@@@@B@@ABA@GB@@@@@B@@@B@@@BA@@@AA@@@@AB@@@BB@@@BB@@@@@@CB@@@B@@@B@@@@@@A@AA@@A@@B@@@@BBBB@@@@A@@@C@@@AB@AAACA@A@@BEJMUQAAE@ECIIAAAACA@@DCTK@AAAKEA@GAUCI@E@ABABAH@DABA@AAA@GCCAAACECAKCMCKAGACAAAOEMCCAGCAAE@IEOEICABCB@BE@EBEBCDADAD@BABABE@ADA@@BADCDA@ABCFA@ADA@EDA@CB@@C@ED@BABCB@A@@@C@AA@CBG@A@A@@@@D@B@@@AEB@@C@A@@EGBGBCDAB@BCBAA@ABEB@BAAC@@@AJE@@@@@AE@EDE@@ABABAB@@ACAACABCDA@@@@@A@CBA@@A@ADEC@AA@@@EBADAF@B@AE@AEBAACBECA@@C@ABAD@AA@C@A@@AA@AAACA@A@A@ADG@@DGBA@EAC@E@A@CB@@@@C@ABCBEBC@AB@@A@ABA@AB@@AAABABC@A@@B@FEB@BAB@B@@A@AFBB@@A@CEECA@A@@@AFABGCC@A@AD@BA@ABEG@@@@A@@@ABA@C@@@AAIAAFE@@@@@BBB@BB@@@L@FAB@B@@BFBBA@C@ABCDABAB@DBBANCHCNCBABA@A@AEC@@DGDGDEBE@AAAAABAB@@A@@@A@ABA@ADCBA@CAC@AFIBABA@CAA@AB@DCBA@C@E@ADE@CDABC@ACEBA@ABADBBA@@BCBABA@ABEDE@AACBC@@@CB@DC@A@EBABKBE@@E@A@CCECCACACAE@A@CBC@AA@@AAC@@AAA@@A@CBE@K@EAI@CBCBC@KC@ADABE@@CCAAG@AAEAGG@@C@GAE@EBGDABI@EACCCAAC@CLQFGLO@EAACBADEFCBABA@A@BGEC@@ABCFMPA@CBABAFCDABCBKBQ@eBG@A@MCA@GCMCUHKFODQHaJIDEBA@OFQDGDCDA@EBCBID@BGPEJADGDADADEN@BDD@BAB@B@BBD@D@DADEFCBEBCBCBEBC@I@CB@@A@E@K@W@I@SAAAECGEA@C@C@ECAAAEEE@AA@A@@@I@A@@BA@ABA@A@A@ABCBED@@C@C@C@A@A@@@CCAAA@CA@@AAG@G@C@@@A@A@@B@@EAM@A@A@@@CBABA@A@C@C@G@A@CBA@CBA@A@@BCBCDEBEBCBA@@DEBA@A@C@EBA@A@GDA@C@GCC@CAAAC@A@ABC@CDGBA@C@C@CBCAC@CAC@G@IAG@ABGDC@KAI@CAA@@AC@AAA@CAE@@@A@AB@B@@@D@@BBADC@CBABABA@@B@@@BBB@@B@@B@@@BAB@@AB@@A@A@G@@@@@@B@B@B@DABAD@BA@E@CBCD@B@BDF@@B@D@B@B@@@@@@B@BAD@DA@@BAB@@A@A@A@A@E@@@AD@B@@BB@@@BAD@@@@BB@@DC@@B@@@B@@@@@@B@@AB@@ABA@EDA@A@@B@B@@@B@@@B@@BBB@DB@B@@CDEDEBAB@@A@A@@@A@ABABAB@B@@@FBDA@ABA@A@@@ADBBBF@B@BAB@BABCBABAB@@AD@B@B@@B@@B@@@BADAB@D@@ABA@CHABCDORBBBBB@FBDBF@D@DBF@B@D@BBF@B@B@BBDBD@@@B@DBB@B@BBD@B@BBBBFD@@DD@BJ@FBDBBBBB@@@@BD@@BF@DBB@@@@XAX@JAL@XA|EGD@`A@@B@BBHFBBDDHDDBBB@BBDLLOLAFIFE@AHEDA@@DG@GFID@AG@E@MJA@ABEBMHA@ECC@ABADMFEACBIFEDID@@KFIH@@GHFLDN@BNAFAH@DAF@HAD@F@D@P@@@@FBDENEDGRDD@@RPxLB@@B@@LEBC@A@AB@B@D@@@DDBBB@B@B@BAD@B@@@@B@BBB@BBB@BBBB@CXZBLBLBFBD@XBJ@@@B@CDGNCDEFABCBCDCBA@CAIBEDAB@BD@NDHAH@F@FBB@HFDB@@F@BALGD@HDF@HDDFBDDBDB@DAF@BDDBD@D@DDBD@BBBDBBDBDBD@HBBF@BBD@JBDFBF@JCFADBDB@DBBDBJDBBJ@BDBDBD@FAFGFAFCFEBKDGBG@E@EBCBCDABCBAB@B@B@DAFEDCDCD@BABEDABADCDCDKN@DABBL@BHHJLNDBDD@RCV@HBHBD@JFDDDBDDFDBDBBDDDDFD@@@@CGDCCEBGLDHEJCLGLCFGJCJ@J@DIBGGEEIAECG@GBGEEACACFGBEDGFCHCDCHADE@GBGHGDG@IJEDEBGAEFECECG@GDEAGAGAEBEFAFABCFEHCNCHCHCHBJ@"],encodeOffsets:[[108729,38366]]}},{type:"Feature",id:"640381",properties:{name:"青铜峡市",cp:[106.078817,38.021302],childNum:1},geometry:{type:"Polygon",coordinates:["@@@K@I@GBABM@ICEAEIK@C@C@E@I@GAC@ACCACCECCCEEGECEEAC@@ECCCCCAAACECCCCACCIEC@GAGAU@QDC@FDCH@B@DAB@B@BADAB@FDBBBBB@DCHBDBDAB@HGBGDG@CCCHEJE@IA@@M@OBCBCF[@AGOB@@C@BDA@@BIBAA@@AACBAAA@EGEBLPCDA@@B@@A@C@BBCBGBC@@AAB@GBC@GCOKKQUKQIUACWDG@KBBDPJFJELCDCFAFADABKFJFBJEJDJDDBLFLBdCF@DF@ADHBKD@DD@BDCHBBFBHLHBDF@D]PG@EDAAMHDFMBIDEFOQOUE@MFSDIBEACAEAGDGBG@[DEBCAIEKCGCSEGAEDCAG@GB@BA@CAA@A@CBC@C@A@A@ABA@@@E@C@GBA@A@A@CCCAC@EBC@AACAGAAAEBA@GAIBCAGAGAAAC@C@CACACACAEAGAEAACAC@AAAG@@@ICSAM@E@C@KAC@A@@@FHBJBFBBBF@f@ZDTDTBBBHBBFBNDH@D@JADAJCD@LAFAF@F@F@HEFAF@F@LDNBHDHBJDJDJBFFHDHBJBHBFBHBNHHBJFJHDDJJDHBFDLDF@DDH@DBDDFFJFFFFDDFDBDFFFFBDBD@FDFBHAF@DAD@H@FAFAFAFADCFAHAF@JAJ@H@F@H@HBFBFBFDHDFDHDFFJDFHHFJFDDHDJDFBDBDBDFFDFDFBF@HCHCDEHCFCJCBEFCFGJEHGLABCFCDGHABGJIHGLGLEHCDAHCHCHAJENAHCJAJ@JBFAJBHBH@JBH@JBH@J@DFRAHBPHNBFBDDHHFDDDDBDFFFFLFLDJDNFJFDBLFJHFFB@@@@FBFFJBDBDHN@DL@RApEH@D@@C@C@CBAF@BA@A@A@EB@HADCJCFAD@B@RE`ILEPEXIDABICGUeoB@NCPG^@@@HRFPHVBDBBBFFHHJB@dKF@@BBF@LDAB@DADEBAB@D@B@NEHCREDAXPDBFFDBAHAFFFB@HDNNB@BDBB@@FB@@B@B@BBB@@@BAB@@@@A@@@ABC@A@A@@@@C@E@@A@@BADC@A@@AC@@AA@@C@A@A@@@@C@A@@DA@ADE@A@A@@AACAAAAA@@B@@@JANAD@HFB@FBDBD@DAB@BABAB@DA@A@@@CACACBAFAFAHAAAHAD@BABoBA@@@@BBFDFBDDBBDBHJDB@BB@D@BBD@B@BA@@B@BCBA@@B@DAB@B@@A@CB@@@@@BAF@DAB@DAD@B@B@BB@@F@DBF@B@B@ACAKF@DAB@BABAB@D@@@@A@@@A@AB@DADAD@FALCB@DCFADABA@ABAJ[DG@A@@@A@@AA@A@@@ABABE@@@A@AA@EECCAAIGGKCEACAC@C@CBAAAA@GCA@ACAAAE@A@C@E@EBCBG@@@CAAAACACAEAEAGEACACBCBCBCAE@@CE@AAA@AAACAC@IAE@AAEGAA@AGEGCE@AAC@CCA@CCAC@A@CCCAECA@AA@AAGC@@C@GAO@GAC@AAA@C@ECAAAAA@GGKKCAIGICIAEACEACI@G@GACAA@CCACBE@EBG@ABABABA@@@AAAAAAA@AB@HAB@DAHAJCLCFAB@B@D@A@D@B@BA@@@@F@HBHBD@@@BABACCAAAAEC@C@@J@HAJFLAF@@@BA@@BABC@@@AAC@M@@AA@A@AGEAAA@A@@AA@@CCEACA@AA@AAAAA@AAC@A@A@C@@@C@CDAD@BBFDHAB@DG@A@A@ABA@@B@B@B@DABABAB@FADCB@BACGAE@GAG@OAKAEAMCGAKESCQAG@EAOAMAGAIGW@W@KGMCIAGAC@G"],encodeOffsets:[[108650,38615]]}},{type:"Feature",id:"640323",properties:{name:"盐池县",cp:[107.407358,37.783205],childNum:1},geometry:{type:"Polygon",coordinates:["@@GDGBIDEBCBC@A@CA@A@@CACEAAA@AAAAC@AA@@A@@@@B@@@B@@@B@@A@@@@@@BA@@B@@@@A@@@@@@@@@@@@@A@@B@@@BB@@@A@@@@@A@@@@BAB@@@@@@@B@@A@@@@@A@@B@@@@@@@@AB@@@@@@@BA@A@@@@@@@@@@@@@A@@B@@@B@@@@@@A@@@@@A@@@@@@BA@@@@AAB@@@@@BA@@@@B@@A@@@@@A@@@@@@@A@@B@BA@@@@BA@@@AB@@@@@@@B@@A@@@ABAB@@AB@@@@ABAB@B@@A@@@A@@@@BA@A@@@@@@BA@@B@@@@@@AB@@@@A@@B@@@@A@A@@@@@AB@@@AAB@@@@ABAB@@@@AB@@AB@@@@A@@@AB@@A@@@AB@@@@@BA@@B@@A@@@@BA@@@AB@@@@@B@@A@@@@@@BA@@BABAAA@CAC@@@CACAA@E@C@A@EAC@EAE@C@A@GACAA@A@E@A@A@AAC@AAA@A@@A@@@@@@CA@@A@@@A@@AAA@@@@@@A@@@A@@@A@@A@@AA@@AAA@@@G@@AABA@@@@@A@@@AAA@@@A@@@A@@AC@AA@@A@@@@A@AA@AAAAAAAAAAA@AA@@@@AA@@A@A@@@A@@@@B@@@BAB@@A@@BC@AB@B@B@@A@@@@@AB@@AB@BABA@C@ABC@ABABE@A@A@A@AAA@A@CAA@@@AA@@AAAAA@A@@@A@A@@BEBGBAB@@CBAB@B@@A@AAA@A@@@A@@@ADCBCFA@@@@BA@@BA@A@@@@@A@@@@@@@@@@@A@AB@@ABA@@B@@@AA@ACACCG@A@A@ABC@A@AB@BA@ABEBEBA@AAA@A@A@@@A@A@A@A@A@@@@A@@AA@@B@@AB@B@@@BA@ABABA@@@A@A@A@@@ABA@@@A@CBA@ABA@A@@@A@@@CCA@@@A@@@ABABABABABC@ABA@EBABA@A@AA@AA@CA@ABAB@@AF@BA@A@@@ABA@A@@B@BADAB@@AA@@@AACA@@CBA@CBA@E@A@C@E@EBEBCBE@CBA@E@CBEB@BAB@D@B@@@B@BADABCDABCDEFCBEBABADA@A@CBCBCB@BA@ABC@AB@@@BABA@ABA@EBABA@C@C@C@ABC@CBCBCBABAAA@AAA@C@@@AA@A@C@AAACAA@CAA@AA@A@AB@BABAD@DADCDADAB@BBB@D@F@DA@A@A@ABABEBABA@@AA@@A@A@A@E@A@C@A@@A@C@C@A@CDADABA@CA@@AA@C@C@CBCAAAA@AACAAA@@AA@A@A@ABA@AAAC@A@A@ABEFGFCFGFIHA@ABA@A@CAA@C@C@AAC@C@C@@@ABA@CDCDGFIHEDAB@@A@@A@AA@@BGFABC@ABAB@BCBCDADABADCBCB@@@@CBE@E@C@C@EDEDCBCBA@@BAB@BABAB@@A@CB@@AAA@@@@BCBCBA@@A@@A@@@A@A@A@@@@@@AB@B@A@@@A@A@@A@@B@@@@@B@@ABAB@DCBAB@AA@AA@AAAAC@@B@B@BAB@B@BA@@@@AAAC@@CAC@EACAAC@@@@ABA@AAAAA@C@@BCDCBCBEDE@CACECCCCG@@AACACCCC@A@@CCBA@ABADABADADA@@AAAAAEAC@C@C@A@E@@A@ABA@ABEDCDCFABAHBBBB@BADABCBE@IAA@ABADBB@D@B@@@@A@@CAAA@ABKHAHBD@B@DBDBB@BA@@@AAA@E@A@A@E@CAC@C@ABABC@@@AAA@A@CAA@AAAAA@@@ABA@A@ABABEFABCDAF@BAAA@@AAACCA@AA@AAA@AACACACACCA@@AACAC@ABAD@DAHAHABCBCBAAA@AAC@EAE@CAECCACAA@AD@BADAFAD@@C@A@CAABABABCBCBEBCBA@@@@BABAD@B@D@BBDDD@DBDAD@BAD@B@D@F@BABCDABABBD@BRLHDFBLJ@BDBPHB@BBBBAH@D@B@DBFFPBDBDDDFFLAPhF@B@HFFBRF@BDB@BDB@DABAD@BAB@@@BB@B@@B@@@@AB@BBDBB@@AD@BBB@@BBAH@BBDBBBBB@B@DB@B@BABABAB@B@B@D@@@B@FCBCBCCL@@GJAFADCDGFMLGFEF@BBJ@H@F@B@FPJ\\F\\HHBVFXFTDAFAP@BAFM\\KXCLBCRABCJAJCHCHAHJRHPJPFNHPJPCFADADADABAB@DABADABABCHADAFEJUdy|[jGHMH¾ªJZÆ]~E|@@F@jAJC^CnC\\D\\@HBJBBFB\\FZHTFLF^NNR`dFFJN@@FJHRDJDHDJ@@LTLb@ZiBgF@@UOMR@@IL@HAZAHCpBRCJDNBNS@cASIA@YbKrBbBFBBBRDH^tBBB@BBB@KNAF@BBhTFBDDBB@Biq@@@DAP@R@BBHBDFNVNHF@@D@DAD@FAD@FAFAD@D@BAF@F@DAFAFADADAFAFADAFAH@DAD@H@F@FABAD@DADADAFADAFAF@FAFAD@FAFAFAD@FAFADAF@FAD@FAF@D@DAB@B@B@D@BAB@B@B@B@B@B@BAD@B@B@DAB@D@B@BAB@B@B@B@BAB@B@B@B@B@BA@@B@B@B@B@BAB@D@B@D@BAB@B@B@B@D@B@BB@B@@@@@BBB@@@B@B@@BBB@@@B@@@@@B@B@B@@@B@@AB@@BB@B@B@B@B@B@B@@@B@@@BA@@B@@@BA@@BA@@B@B@BAB@B@BAB@B@@@D@@@B@B@B@D@@@BBB@@BB@@@BB@BB@BB@@BB@BB@@BB@@BB@BB@B@@BB@B@@@@BBB@BB@@BB@@B@@@BB@@@@B@B@BA@@BAB@@AB@BA@@BAB@B@BABA@@@@B@BAB@B@BAB@@@B@BB@BB@@BBBBB@@B@@@B@B@@@B@@@B@@AB@BAB@B@B@@@B@BBB@@@B@B@BBB@BAB@B@B@F@D@@@B@D@@@B@BBD@BB@@@@BBBBA@@B@@@B@@@B@B@@@BBB@@@B@BBB@@@BBBDDBBDBBDBBBDDDBBDBD@DABABCBABAFAF@F@DAD@F@DAD@@@DAD@D@D@D@DBFBFDD@DCDABCBADCFCBCBCDABADADCBCDADADAD@DAD@DAF@DABC@ADCBCBCDCBCDADCBABADADADAD@FABAFAF@DAF@B@DAB@B@DAF@D@FCBADAB@DADAD@FADBDAD@F@D@D@D@F@BADAD@DAD@FABAFADADADADADABCBA@CBABCBABCDABCBABCDADABCDABADADADABC@C@ABEBCBADADABCDAFCDADCBADABCDABABCBABCBABCBCBCBABABCBABCBADAD@DAD@DAF@F@D@F@DAD@D@DAF@BAFABADABCBA@A@@@CHC@AB@BAAACCS]KO@AIOIUAC@ABAHEFEDAB@NCZENCB@BBB@FFDFDDH@XFB@d@HELMf]qFCDANCVE\\GJARE\\GLAPCDAHEFCHCBAB@B@DADCHE@@D]KYGWG]KSgg@@K@KDCHCFEDADCFC@AAC@EACBCB@@BD@F@BABC@C@CCEEAAA@@ABCFCDAD@BBB@D@D@B@@A@A@C@@G@E@MG@@KEI@I@I@@@I@@@EAI@GAM@KAIAoMIKG]BM@A@A@@BGCWCMGOMMQKGEEAIAI@GA@@GAKAIAGAI@EBIFKHIBG@MAM@CACEAEDEBGDI@CzIF@DA@EAUCCGB_FEAYYA@AA@@ACGGGECCECCCEAAA@CDE@AGEGCKCCCeBAAAAAAE@IBCBCBCBA@A@A@@@DCFEBC\\eDAJAJAnLFBBAFGBGAAACACAGAGCE@E@ACKAG@CCGCGACECCCAECMACGGGG@@KGGEkY@@GCGCIAKCGGEECG@G@K@S@EBI@I@IAGAGAIMyESKWAEEI@E@GBCBGBOAIEICEAC@GBADG@GHWL[FKLILEBCJC@@@@@@BA@@@@@A@@@@@@@AB@@@@@@BB@@@@@@A@@@@@A@@@@@@@A@@@@@@BA@@@@B@@A@@@@@@@A@@@@@@B@BA@@B@@@@AB@@@@@@A@@@@@@A@@@@A@@@@@@@@BAB@@AB@@@@@B@@A@@@@@@@@AA@A@@B@@@@A@@@@@@@AB@@@@A@@@@BB@@@@@@@@@@@@@@@@@A@A@@@@@A@@@@@AB@@@@@B@@A@A@A@@@@BA@@@@@@@A@@@A@@@@@@@@@@B@@@@@@@BA@@@@A@@A@A@@@@@A@@@@BA@@@A@@@@A@@A@@@@@A@@@@B@@A@@@@@A@@@A@@@A@A@@@@AAE@SFGBECICIAKAEC@C@EJGPGHCBA@@BABADCDADAHEDCDADADADABADC@@BAD@B@@@BBB@@A@A@@@A@@@@@A@@B@BAB@@@@@@@@A@@@@@@@A@@@@@@B@@@A@@@@@@@A@@@@@A@@@BA@@@@B@@@B@@@@@@A@@@@B@@@@@B@@@@@@A@@@@B@@@@A@@@@@@@@@AB@@@@@@A@@@@@A@@@@@A@@B@@@@@BA@@@@@@@@@@@A@@@@@A@@@@@@@A@@@A@@@@@@@@@AB@@@B@@@@BBA@@@@@@AA@@@@@@@A@@B@@@@@B@@A@@@@@@@@@A@@@@@@AA@@B@@A@@@@@@B@@@B@@@@@@@@AB@@@@@@AA@@A@@@@@@@@@A@@@@B@@@B@@@@@@AA@@@@A@@@@B@@@@A@@@@@@@@@@@@@@BA@@@A@@@A@@@@@@@A@@@@BA@@A@@@@A@@A@BA@@@@B@B@@@@AB@AA@@A@A@AA@@@@@@@ABA@A@@B@@@@AAAA@AA@AAA@@@@@@DAB@@@@@@@AA@AA@A@@@@AB@@@BA@@@@AAA@@A@@B@B@@A@@A@@@C@@@@@BCB@@@@A@@@@@AA@@@AA@@A@@@@@A@@@@A@@@@B@@@@A@@@@@A@@@AA@@A@@@A@@@@BA@@AA@@@@A@@AA@AAA@@A@@A@@AB@AA@@AA@@AA@@@@@@@A@@@@AA@@@AA@@AAA@A@@A@AA@@@@A@@A@@@@@@@@AB@@@@AA@@@@@AA@@@@A@@@@@@@A@@@@@@@A@@@@A@@@A@AA@@@@@B@@@@@@@@@@A@@ABA@@@A@@@A@@AA@A@@AA@@@@@AA@@@AA@@@@AA@A@@AB@@@BA@@B@@@@AB@B@@AB@BA@@@@@@BA@@B@@A@@BA@@@@@@@@@AB@@@@@@@@@@AB@@A@@@@@@@@B@@A@@B@@@@A@@A@@A@@@@@AB@@@@ABA@@@@BA@@@@B@@@@@@@B@@@@@@@B@@A@@@@@@BA@@B@@@@@@AB@@A@@@@@@B@@@@@@A@@@@B@@@@@@@@@@@@@@@B@@A@A@@@@@@@B@@B@@@@@@AB@@@@@@@AA@@@@@@BA@@B@@A@AB@@A@@@@AC@A@AA@@AA@AB@@AB@BABA@@@ABE@C@@@A@@@@A@A@@@C@@@A@AA@@@A@@A@@AA@@@@A@@D@DAB@@BB@@@BBB@B@B@@A@@@AC@AA@@A@@ABE@CBADCBABAD@BABA@A@@@@A@A@ABABEBA@CAA@ABCBA@C@"],encodeOffsets:[[109844,37990]]}},{type:"Feature",id:"640324",properties:{name:"同心县",cp:[105.895309,36.95449],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@C@E@I@C@@@@@@CDEDCBA@@BG@C@A@@@QG@@A@E@CBA@@@A@@AAAMIA@CACAABA@EB@@A@@B@DABAB@@A@GAA@A@C@A@@B@@AB@B@B@D@B@BBB@@@DDF@@@B@@ABABCBEDAB@@@B@@@BBDBBBBB@@@JB@@BB@@B@@B@@@H@D@BAB@BA@GDCDA@@BAB@@ABE@C@@@AB@@ABAD@B@B@B@B@BADAD@BA@@BA@A@@@E@EAA@CACCCAA@A@AB@BA@@DAHADDD@@DBHBB@@@B@@B@@@D@B@B@BAB@BA@AB@BB@B@AFCDABC@CAABAB@@@FAD@D@B@B@B@F@DAD@DABABAB@@@B@B@DA@@B@BA@@@CBAB@@AB@@ABA@MFCDCD@B@@CD@@GL@B@BA@@@AB@@@@A@ABABADAJA@BB@B@@@BEF@B@@AB@BA@CB@@A@@B@B@@BD@@@@AD@BBH@@@BDBBBA@@@A@@@A@@@A@@B@@@@@B@@A@@B@@@B@BCDBDDFA@CBA@A@ABAB@B@@@BB@BBBBB@@B@BAB@@ABA@IDA@@BA@@B@B@@AB@@CBC@A@ABA@@BA@@B@@A@A@@@A@@@ABA@@@A@AB@@A@A@AAICABBBAFDF@B@@AB@@BB@BDD@@@@@BA@@BA@A@A@A@A@@@@@AB@D@@@B@@@@B@@BB@D@D@BADAB@BBB@B@@B@@@B@BA@ADABCB@BA@E@A@ABA@ABAB@@B@BBFBB@FF@@BB@B@@@@@BA@A@A@@AGAAAA@@@A@@@AB@@BB@@@BFBFBB@@BB@@B@@@B@@A@G@A@A@A@AA@@AAA@@@ABCD@@AB@B@@@B@BA@ABC@ABAAC@A@A@A@@@ABAB@@ABABCDEDA@ABA@A@A@A@C@@AC@@@EC@FBBBDBD@B@BA@AAC@CCCAAA@@IEC@AAA@@@A@ABBBB@BBBBBBB@CD@B@BDD@@BBB@FA@@D@@BBBABC@ABCBB@@BD@@@BBBAB@B@B@BB@@@D@BB@@BD@@@DBBBCDA@AB@BA@@BA@AAA@@@DC@AAAAAA@@@@@A@@@@BA@@BBB@@AB@B@@@BBD@@@B@@ABA@@BCBNLK@GB@ACAA@@@A@ADABA@@@FD@@@@@B@@A@ABA@@@A@@A@AA@@AA@A@@B@@@B@@@D@BBBB@@B@F@@BB@@BBBDB@@@@B@@EBAB@@@BABABCDA@A@@@AA@A@@AAA@A@A@@@A@@BCD@B@B@B@BB@FAB@B@BBBBB@@@@B@BCD@@AAA@C@@@@B@BA@@@@@CBA@@@@B@@@BB@FD@@@B@@C@@@EAAAA@@BA@A@@EAA@A@@@@@@A@ABA@@BAB@@@BB@@BDBBB@B@@ADA@@@GBC@EB@@@B@@@@BDCBE@C@AAADC@ABADDDABCAOCBCDEBG@AGAGCKCGBKCAACAC@A@BCAABABCBE@A@CBC@A@CCA@@BA@CBAFA@CIAFGH@@A@E@@ACCCBCBCB@CCA@A@A@ACEGA@AC@CCCEAKGBAHCBCACEEACAA@CDC@@@AA@A@E@C@C@CBCBEAA@A@CAAA@A@AFCDEB@@CA@CA@@IFEHA@A@AAAE@CBIBE@C@A@AAEAA@@CAA@A@ABA@A@C@AC@CEGACC@ABAACCIGAAAACB@@AD@BAB@BCBABBDBBBDBBAFCFBBAFKHC@@B@D@BCDKLGFEBID@@E@AA@@AEAAAAAACBC@AAAACAA@ADABIDGACBEBAAAA@ACAAAGGACAACAAAACAEABCBGD@B@BFD@BAD@DABADADCH@FBBAH@DAH@DAHFH@@FBB@BABAB@B@DFBD@@KHCD@B@DBBBBBDBFBDB@BBDB@BB@BBB@DBD@DD@BDBDFBBEDEFCBCBC@CBE@IDEF@B@J@B@B@@BAB@BB@@D@@AB@BA@@B@BCB@@BB@B@D@@@B@B@FCDABADAB@D@JCB@BBAB@BADBBBBDDDDDBFBJBB@@BBF@B@BBBDBBBB@B@B@BDDBHDHDDBDDBBFBDBBBBDD@FBFBFDFFDD@DDF@D@FABBBAD@DBBBB@BBBB@B@DBFDB@@BD@DBB@@BB@G@G@UV@F@FELADQPCLILEHCDDLa^_NSTKJCR@H@DHBCRSLcLUPUPaXG`]NCX@JAJGBIHON@@GAA@CBE@E@C@AAC@A@@@GB@@GBGDSJE@[ACAE@OBA@CBC@EDEB@BADAF@BA@@B@@@@ABA@@BA@@@ABA@@@@BA@A@ABC@C@@BC@@@A@ABBAB@@DB@B@@@B@@@@A@@FB@@@@B@@@B@B@DAB@D@@@@@@BD@B@DBB@@BBB@B@@B@B@BB@@@@BA@@@@BB@@B@F@D@FAB@B@D@DBB@B@D@B@BBDBD@@@@BBB@@DBDFBBFDDBBBBBBBBBBBFBBBB@@@B@BB@@@BB@@BB@BBBBBB@@BBB@BBBBDBBD@BDBBBBD@BB@@BDBHDHBB@@BB@DFB@FBD@BB@@LPFCHC@CG@CCDCDE@A\\sBA@M@@C@CCACBGECEEBCD@DCBABCBCFEFAHEFEJGFI@EH@F@B@F@F@D@D@B@JBHDH@F@HBHDFCHBHBJ@D@HJFD@BF@B@@ABCBA@C@A@A@A@@@@H@B@B@@@BA@@BA@A@@@AA@@@AA@A@@@AB@BABADAD@BCAA@@@C@@@ABAB@@@F@DBB@BBD@@BB@DBJ@LBD@HCBAH@JBH@D@DBD@DBDAD@D@B@HADCD@BAB@D@BBDBD@HDD@B@HCB@B@FAD@B@B@FA@CB@DAFAFADCDA@AB@B@DAB@DAB@H@D@D@B@B@BADA@@B@B@N@FB@@@AB@B@@@D@H@H@BB@@DBB@BBDD@@B@B@D@D@D@@@FCDABAB@B@B@BAB@@AB@J@@@B@B@@BFFBFBBFDD@D@B@HFFDBBTBJ@X@L@F@B@@@DAJ@D@FADADAFADAFEBC@C@CAC@A@ABA@ACC@AFMBCBCHCBCFIHO@AJCDAFAB@DCHCRCPEB@FAJCbIRGPCLEVGNDHDB@NDB@H@fAR@LADABADCBEBADAB@NODEBA@@FDAHB@B@BADAFEBCDABB@FKPEHKR@DBDDBDDFBJ@BAHCFAF@HBD@@@HHFBBBH@BBDD@@AFCB@BLDD@DADAJ@FBL@F@DAB@@@BB@BD@BB@@BBD@DAB@F@DBDBDBFDDDB@F@@@AFALAB@F@BCDA@@D@@ADBD@BCFAF@BABABAD@@ABCAAB@BABDF@BADCB@DCF@B@F@DABCDA@@BBB@DABABEJ@BBD@DABCD@BAB@B@B@@@BA@ABBBBB@BAFCFCHCH@@FD@B@BABABMDGDMDABCAA@ABCBAD@B@DABEA@AA@A@EBK@@@A@@AAA@A@@@@EFBBBJ@B@@@DAB@B@@@B@@H@AF@BABC@@B@BDDAHEB@B@@@BDBFF@D@BA@EA@B@BA@A@ABA@EFA@@@@BADABBB@BA@@BAB@B@BA@@BADAFAD@B@D@@A@@D@B@FBD@FABCH@@CH@B@B@BDBBB@BBB@@@B@DBBC@AB@B@DB@FDDABBFA@BBFA@E@CBAB@F@@BBD@CF@B@BB@DAB@@@@@B@DCBABDDB@BA@ABAB@BF@FCF@@B@@@@IF@B@@BDABA@AF@BBBDA@ABADCHAHA@FB@D@@@FA@B@@@A@C@@B@B@H@DAB@@B@D@@@BDABA@AFCD@@@DAB@FCB@BCB@DEBAB@DCBC@AB@BCF@BABA@ABCBCDCFAFAF@@ADABAJDPFJFF@BBHDDBNDPFBBDBHBLBNDLDDBDFBBDBHDB@BBB@BA@CBGBABAF@J@VDHBB@LFBB@BSLCD@@DBBBBBJJFDF@BBVRINAF@@@BDBBB@BBA@@D@@@B@@@@@AAAA@@@@@AB@@@BBB@@@@@@@@A@@@A@@DA@@@@@@AA@@A@@A@@BA@@@@BB@@B@@A@@@A@@@A@@@@HAB@BA@@@A@@@@A@C@@@A@@A@@@@BA@@BBB@B@B@B@@@@@@@AA@@@AD@BAB@@A@@BALIB@B@BB@@D@B@@BFBB@D@D@B@B@DBB@B@FDDBFB@@B@B@@B@@DACCCCECA@AC@GBEBCACKGAGKKACCE@I@@B@@A@@@@BA@A@@@ABA@A@@@@@A@@E@A@A@@@@@@A@@BADA@@@@@ACAA@AA@@BA@@B@@@B@B@@@B@@@AC@A@@@@TApGD@DAD@BAB@DADAB@@@BA@@D@@@BA@@JCDCB@@@@ABADEDA@AB@DC@@DCDAF@BANEFAB@D@@XBFBVAB@LBB@BDBBBTDP@FBHBF@D@T@@A@E@GAI@AFEHENKHEDCBCBEHI@@KDDDADA@E@A@@@C@A@ABABABA@A@ACAA@A@AAAAAC@ABGAA@@AA@ABC@@AAAC@ABA@@@@@AA@A@@A@@BA@ABCBA@CCA@ACA@AQEEAGEA@E@OgKBEECCACACEOAE@C@A@CBGAAAAA@OGCA@AKIEAGCQK@AACBABADCBA@A@E@C@ABC@ABCAC@CCCAC@A@C@ABCBA@A@@CBA@A@AA@@AA@@@ABA@A@@BA@A@@A@@@@BAB@@AB@@A@C@@@AAAACC@@BABA@@BADEBEBGB@@C@ADGFE@EBABEBCDCBCDADE@CBCB@D@BAD@BABCDEDABCBC@ABCBCBA@C@C@A@AAA@ABA@A@A@A@@@AB@BC@@@A@A@ABAA@@A@CBA@ABABABA@CA@@CBCBABADADABA@C@C@CBAFCHCBA@A@AEECCACCAAACBABCBCBCBE@@@A@A@A@C@@A@ABABA@@@A@@@A@A@@BA@ABABABA@@@A@AAA@AAAA@@AAA@AACAG@ABABE@@@AAAAIA@@A@@A@@@@EBC@@@ABEBABA@@@@@AA@@@A@A@@@CBC@CA@@MCGA@@A@@@@@AB@@@@AB@@@@AA@@A@A@@@@@A@@BA@@@A@A@A@A@E@ABA@A@CDEBABCBA@AB@@AB@@@@@@@A@@@@AA@@@AA@@AAAA@@AA@@C@A@E@C@A@ABABA@A@CBC@@AABABABC@@@@@AB@A@AAA@A@A@A@AAA@AAA@AACAAAA@A@A@C@ABCBCFADABCD@B@@BBB@D@B@B@BA@AB@@@@@B@BBB@@BB@@@@BA@AB@@CD@BGD@BABCD@@BB@@@B@@@BA@@@A@@@AB@@@BA@A@A@A@ABA@EFCB@BCDABCBCDAB@BAB@BAD@BAB@BCB@@CBC@ACAC@A@A@ABABABC@A@A@A@ABABABA@AFEBABC@CB@BA@ABABA@@DA@A@@@AA@CAAC@@@@@@@@@AAC@A@@@AA@@A@@@ABC@@BA@@FADA@AB@@@@@@BB@@@B@@@@@@@DCBABA@AD@@@D@@AB@B@@@B@@A@@B@@@@@B@@@@@@@@B@BB@@@B@B@FAD@BADABADCBABA@C@@BAB@@@B@D@FAB@@@BAFAB@B@BA@ABAB@@CBABADCBC@E@@@@@A@@A@C@@AA@@AAA@A@ADCBE@A@@@AA@A@@BA@AB@@ABA@@@@BA@A@@A@@BA@A@@@A@A@@BCDCDADC@AA@@AB@BA@@@@@ABA@A@@@@@@@@@@@B@@BB@@@@BB@@BB@B@@B@@B@@B@B@BBB@@@@B@@@B@BBB@@@@B@BB@@DBB@@@B@BB@@D@@@BAB@BAB@BABABCB@DABABABA@@@A@@AAA@CB@@A@@@AACAAA@@@ABAB@BABA@A@@BABADA@A@A@A@@A@@@@@AA@@@@@@@@A@ABCBAB@@A@@@@@@@@@BABA@A@@@@@AA@A@AA@@A@@AA@@A@@A@@@@@@@@AA@@@@@@@A@@BA@@@@@A@@@@@@A@@B@@AFCB@FCHAFAFAB@@A@@@A@@@@A@@AA@@@BA@ABA@AB@@@@AA@@A@C@CAC@A@A@@@A@@@C@@AAA@@@AAA@@A@@BAB@D@B@B@B@D@B@BBB@@@@@@@AA@@@A@@@@@AB@@@@A@@@A@@@AB@BADCFADABA@A@A@@@@@A@@@@@@AB@@@@A@@AA@@@@A@@@A@ABA@@B@@A@@@A@@@@AA@@@A@@@@A@@@A@@@@@A@@@@@A@@AA@AA@@@@@@AB@@@@AB@@AB@@@@A@@A@@A@@AB@@A@A@A@@@AA@@@A@A@@@@@A@AA@@@A@AB@@AB@@@BA@@@A@E@A@CAA@AA@AA@@A@@A@@@@@A@@@@@@@A@@A@@@A@@B@@A@@@@@@A@A@@B@AA@@@@@@A@@A@@@@@@B@@@@@@@@@@@BA@@@@@@@AA@A@@@@A@@@@@@@@@AB@@AB@@@@@@@@@@@@@AA@@@@@@@@@A@@@A@@@@@@@A@@@@@@BA@A@@@A@A@@@A@A@@@@@A@@A@@@@A@@@@@@@@@@@@@A@@A@@@@@@@@@@@@A@A@C@C@@@AA@@@AA@AAA@C@@@@@AA@A@A@A@A@A@AA@@@@@ADCBEBABA@AA@@CAE@@@@B@@@@@B@AA@A@A@@BA@@B@@ABA@AB@@@B@@@BA@@BA@ABA@@B@D@@@DA@AB@@A@@@C@@@@AAA@@@A@ABABA@CB@@@@A@@@@@AAAA@@A@@@@@@@@@A@@@ABA@@BABCDCBAB@@AB@@CDABABCB@BA@@@@B@@@@A@@@@@@@@@AA@@@@@A@@@@@@@@@@AA@@@@A@@@@@@@@A@AAA@A@@@A@@@@A@@DED@@ABAB@B@@A@@B@BAB@@@@@@@@@@@B@@A@@@A@@@A@@@A@@B@@AB@@@@AB@@AB@BA@@@AB@@@B@B@@@BABA@C@A@A@AA@AAA@CAA@@AAA@A@ABC@CBCBA@@@A@A@@@@@@A@@@A@@@A@@@A@@AA@@AAAABA@@@A@@BC@@@A@ACAC@AA@BA@C@GFEBEBA@@@A@@AAA@A@A@A@@AA@@A@A@A@@@A@C@@@@AA@@@A@@BA@@@AA@@@@@CAC@@@AA@@@CBCBABA@@B@@AB@@AA@A@E@@@A@@A@AAECCCCAAA@@A@ABA@A@AACA@AAA@@@@B@@A@@BA@AFC@A@@@A@@B@@@@@B@@@@@B@@AB@@ABE@ABA@ADEDGBA@CBA@@@@A@@@A@A@AB@@A@CBA@@@@A@@AA@@@@@@@@@A@@@@@@@AA@@@A@A@@AAB@@@@AD@@@@AB@@@@A@@@@@@@A@@B@@@@@@@@@BA@@@@@@@@@@AA@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@@AA@@@@@@A@@@A@@A@@@@A@@@A@@@@B@@@@A@@AC@A@CCIAGCAC@GBIDGBC@ABA@AAEAC@A@A@ABABABABCBC@ABE@@@A@A@@A@A@C@@A@@@ACM@K@CAAE@A@CAA@C@MECAEACCA@C@C@CBEACDABE@IBCBCA@BQLABADABAB@@A@@@A@@@@A@A@A@E@@@@@AA@EAC@AAEACC@@@@CBA@C@C@CAA@AA@AA@@AA@C@C@C@IBGBC@CBC@AAC@A@@AA@@A@@@@@AB@BCBA@@BA@AAA@@CCAA@@AC@AAAA@A@A@CBA@CBC@C@@@C@C@AAAACACCCCA@AAC@GAA@GAGCEAIEE@E@GAG@E@@@CBEBCDADABKLKFAB@@A@@BA@A@@AA@@A@AAA@AA@@@@EA@@@A@@@GBCBA@A@@@@@AJCR@BEDCDADAB@@A@A@@A@@@ABABA@A@AAA@A@@A@A@ABA@AB@@A@A@A@AA@AA@@A@A@@@ABC@@@@@@A@@@EDA@@@A@A@AAAAAA@@@AFE@A@@@@@A@@A@ABA@ABA@A@ABABA@C@A@A@CBCBA@@@A@AAACAA@@AAA@GBA@A@CDA@@@A@A@@@CAAC@@AA@A@C@A@A@E@A"],encodeOffsets:[[108762,37477]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("银川",{type:"FeatureCollection",features:[{type:"Feature",id:"640104",properties:{name:"兴庆区",cp:[106.28865,38.473609],childNum:1},geometry:{type:"Polygon",coordinates:["@@GABOCoAQ@EIHGFGBGB@HAPAHABAFCDAD@DCBABABABABC@ABABCBA@ED@FANGHIHGBICGCMCIE[QABBHGCsRNLndDFDFBDDDC@EBVA@FFCDUNEHQVGDGWKWIAM@I@IDA@EBCBGBEBIBC@ABBBHJ@BBFBF@BADEF@@CFADADADCFA@CFAH@BAFCN@BADAHAFCH@D@B@H@@@L@BBD@BBDHPDH@BNBXBPDNDD@DBBDBAJJ@FBBBBBDDDB@@B@@B@BAAAH@BAF@@DDAD@@BBB@@BABBBALHBEB@@ED@BAAAAGH@@CDB@@B@@BB@@BBAF@@@B@DA@@B@@AB@@@B@@@B@BBB@B@BABBDA@@@E@E@A@AAAAAKK@@@@DA@@B@@AB@@@@ABABABK@AA@@@A@AAAA@C@@@AB@H@FAD@B@B@DBH@B@BB@@JCFAB@B@B@@A@C@CACACGGCEMMBA`@F@H@@FH@B@B@B@D@B@B@B@B@BBB@@BB@DD@BB@@@@AD@@ADAB@D@DA@@BA@@BA@@@AA@AA@A@@@A@@BA@@DABAB@B@B@B@@BB@@B@B@D@F@B@B@BBBBBBBBBB@BB@@B@B@BABAFABAB@BAB@@BB@@@BB@B@@@@@BA@CBGBAB@@@B@@BDBBDB@B@@@BBDAB@@@BA@@B@@B@@BHD@@@BB@@BA@@B@@@B@@@BDD@@@B@@AB@@ABEB@@ABDV@D@@@B@@CBA@@BAB@FCN@B@@@BB@B@HAHAD@B@D@D@FBFABBB@@@B@@B@@@B@B@@@BB@@@B@HAD@D@B@D@B@B@BAB@B@D@D@B@D@@AB@BAB@B@FBBBB@B@DAB@@@BB@@@B@@BBB@B@P@D@BALCB@B@FAB@B@BB\\FADELABCFCH@D@FAPCJIPAH@FBFHJFPDFBHDNDLBFFJFRBDFHFFXLLDFDDBLNFJ@ADAFBD@DAH@JAJCHAHCPCVEJE@I@K@I@EACBCJGJKHC@CLEGA[K¿EgAC@@AA@AAAACACAAAC@@A@@AAA@A@@AA@AA@@AiEM@@Yk@@CC@@EC@@SK@@Û@@MG@@aS@@IEAD@B@B@B@@@BA@GDAB@@ADCF@@BB@BB@@@AFAB@A"],encodeOffsets:[[109029,39259]]}},{type:"Feature",id:"640105",properties:{name:"西夏区",cp:[106.161106,38.502605],childNum:1},geometry:{type:"Polygon",coordinates:["@@Wa@@ML@XA^OLQNkhA\\@@GDIFMFUHIB}X[J_LWJOFOFIDEBAD@BBDBBBFBF@B@BEBGFCDEDAB@DABCB@@CA@@AFABE@ABCFA@CBA@A@AAA@A@@@A@AA@@A@CBAB@DAB@DAB@DCDA@@@@B@D@BBDDBDDDDBB@DAB@DABADAD@DABBHBBFDFDHFDBBBDDBBBB@F@HBF@B@B@D@B@J@BAF@D@DABADADADADAD@F@D@B@DBFBDBDBBDBFBH@DBBBBBBFBF@BADCBCBCDABAF@D@DCFCBCDEDABAD@B@D@F@B@DABCF@DAD@D@B@D@DBDBDBBDFDDDBBDDDD@DDFBBBDBBD@BAB@DAD@D@B@DB@BDDBFDBBFDDDBDDFBBDDDDBDBBAD@FABCFCJEHAB@B@@D@@@B@B@F@DAB@FANEB@@@B@@A@@B@B@@A@@NEJABAF@J@FAD@BA@@AADABAPEFGBA@@@A@CDADAB@BADC@@DCJCJCHCHANCF@JADAJAB@H@F@D@B@D@BAD@J@B@NCBAH@F@D@FAJAFAH@FAFBDAH@D@HAJAF@JAL@D@F@DAFAF@HCD@HAJAJED@@@`zDHFHTCFNNCpKDBINBGs@QPYCGIY@@M]AEUoAE@COwCM@@@AAGAID@BAL@@A@CSBAIEYAEAGACAMCK@@AICI@AAC@CAAACEI@ACCKOIMCGOWCEAAEGCEIO]i@AA@EGCGIOMQEGAC@@KOU_KQEICC"],encodeOffsets:[[108613,39250]]}},{type:"Feature",id:"640106",properties:{name:"金凤区",cp:[106.239679,38.47436],childNum:1},geometry:{type:"Polygon",coordinates:["@@CE_o]nABUHABXbDDFJLRV`LP@@BDFHNRJPDHFHB@@B^jJPDFFHBBDFPXDHJNLPDD@BFJBDBB@DBD@BDJBJ@@DLBNBDBHBFFZBJTA@D@BK@ABC@BJBH@B@@DNPx@DBFVpBFN^@@JZDH@AXkDCDADABAJEFCFCDAFCFCHCPIIMBSHAJQLKEIHEbW@A@GAI@E@A@@IIKKCCEECAAAECICCAIAAA@ACCCG@ACGGOAC@AAC@A@K@@@G@A@CDGBEBGBC@ADMBE@ABGDEB@DEBCBCBCDE@@FEBC@AAEAE@AGIAABAQ@_F@@A@_MEBSH@AGMEM[AoCOsACHAKQEDKOO[S]oTCCGKIQAA"],encodeOffsets:[[108647,39231]]}},{type:"Feature",id:"640121",properties:{name:"永宁县",cp:[106.253145,38.277372],childNum:1},geometry:{type:"Polygon",coordinates:["@@EEAAGKCCAACGAGBGFM@@LCFAFCBCCEAECAEEECIEEEGEMMEGCECBEBCDA@KDEBC@CBCBA@@B@B@@@B@@C@A@ABABA@CBE@BLBDA@A@E@CAE@@@AAA@A@C@CBA@CBE@AB@@@@A@@D@BA@A@CBA@@@ABADA@@@ABA@C@AAC@A@@ACAGICAAACCEAECAA@@@@ABApABC@GBBBGBEBEBABBDBD@D@@@BCBA@ABABA@CBC@CAEAA@GEC@MBIB@@A@@@BBBBDBBB@@@B@BCF@BCB@@@B@D@@B@B@D@@@BB@@BD@@@BCDAB@@@BF@D@@@@@@B@BAD@B@@@B@@A@AB@@A@AAA@A@@@EA@@AAACA@MMGCA@EEBEBGCAEECAWOCBQFGDMFA@C@A@ABCFCBA@CB@KAE@AE@cLA@GIEGAEAAACGUEOGQ@@]@OHMDA@pVfDHAJCBWJOFKF_JQFA@C@EBIDCDGBA@@F@B@BABE@AB@D@D@DC@G@oFQBK@BBDHFJBF@DBDBH@BBB@@@@BDBBDHBD@@AB@BADCDADCFADA@@BCB@BABABCBCDEDCDABAB@DCFADAFAHADABABADABABCBABAB@B@B@BAF@DABCDABABAD@DADBD@BBDBDBDBDFHDDHJBB@B@D@DADABAF@BAB@DABAD@BAD@B@D@D@D@D@BBD@DBFBDCDCF@BBDBDDBDBD@D@B@DAFBDDFDBBDB@DCDADABADAD@DAD@DCDCDADABAD@DAF@DHFBD@F@@B@DC@CBA@CBA@CBADAB@@@BBB@@@B@B@BBB@B@DAB@DEBAF@BABE@@DB@@DABA@CBAFCDCHEFA@A@AAEAEAAAC@ABCFAJCPEPEXI`K\\I~WJAVGNEJEHC@@B[lgRMPKB]@WNK@@BAVGBA^m`pDFBBJRHLDDpST^P\\LPFCLRGBBDPtpD\\BFNHN@BTGFA`NB@@@`ER@D@JAFAHADAFAB@JCJ@N@JBLXHXHCRUFGVMDCEEB@UFAD@CCACCECEmcMKtQEEMQGKKAEDSAMGIGMeDCPACKAC@AAEACCGACCCICQICAMGEEEAAACCCCEGAACACAEEACAC@CAAKOEKGKIMCC@@C@CAA@C@GK"],encodeOffsets:[[108799,39119]]}},{type:"Feature",id:"640122",properties:{name:"贺兰县",cp:[106.349861,38.554599],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@AAA@AAAAAAAA@A@A@A@E@C@A@AA@@AA@A@A@A@ABCB@@AB@@@B@@@BBBB@@B@@AB@@AB@@CBC@A@CB@BC@@B@@A@@ACCA@@AA@AAA@A@A@A@C@A@A@A@G@@EG@E@_@ABNNDFHHBDBD@D@D@BA@A@A@EBID@@AAA@G@CAA@A@C@EBG@A@@B@@@DBBBBB@@@B@@BALABAB@B@@A@@BA@@@CB@@@@LLBBBB@B@B@F@F@@CBAAABA@A@AAA@@@A@@@A@@BA@@@CBA@@@E@AB@AA@@AA@@@CA@DG@BHBBABC@@FA@AFKGABAAAB@@AA@AC@CB@CE@ABG@BBABA@@@@AA@CCACAAAA@EIIABACCAC@MCOCWAMADHDD@BBBJBDBJDFDBBDBFFDDLLJJ@@@B@FBJ@H@BaXGFFJKLIRGBATJNOJGDEDEDCBEDEDIFABCBCBCDWl@BOZ@RHtAJMCAoLMDEMSDEGCG_y@@C@IFIBGBC@GDE@EBCBE@C@K@IBE@IBGBC@G@CBEAEBG@EBIBEBC@E@G@ABMDA@I@C@ABC@A@C@E@G@A@IBCBIBE@MDGBGDIDIDCD@@CDABA@CBCB@D@B@@ABEHOFABCBBB@@ABC@EBI@E@ABIBMF@@@BA@A@@@@BA@@@A@MFEBA@CBE@A@A@@@C@@@@B@B@BBBFBDDDBFDBDDBBDDBFBBBBHBD@D@FAD@DAB@DADABCDAB@BBF@DBDBDBBBDBBBDCBCDCDABCBADAB@F@D@D@DDBFBF@F@DBFFHBDBDBDDDDDDH@F@D@BB@B@BADBDBBDFDDBBDFBDDDDDDDDBFDDBDBDBFBDDBDBF@BBDD@HBDBDBFDBBDBDBDDDDBBBBBDBDBFBFBBHDBBBD@B@BB@BBBBD@@AB@BAFALCHAHAJAHABBB@BBB@HAFADAHAB@BABA@AD@@@BA@AB@@AB@BA@AHCJCFADAFCBAD@BAH@BABABAFEDAB@DAB@B@FAF@B@BBB@@@DAD@D@D@B@F@BAB@D@B@D@@@@@@A@GBG@E@ABABAD@BAB@@A@@BC@CDAB@B@B@D@HAJAL@TCJ@J@HADAB@B@D@BAD@D@J@DA@GDC@AB@D@BAD@B@B@FDD@@BBB@@BB@@HBFDD@BBBBDBDBD@@@B@B@@AB@DEDCBEDE@EB@DAB@FAB@BBD@D@HBDBBABAFALEBABADCB@@ABAB@DADAB@BAB@@A@A@@@C@@BA@AB@HAF@BA@BB@@@BB@@@@ND@BBBB@@BDBB@B@HBH@D@DCKMA@BEBAB@DCD@BAF@F@B@D@D@BBD@BB@@BB@@BBB@D@D@FAHAJAFAFAF@F@F@DBD@D@BBBBB@D@BBD@B@B@@AB@@@@AGOGK@ABA@@@AB@BAB@L@TADAB@@@BA@CC[@CDA@I@CBA@@BABABABAB@BAH@FAL@J@PBF@FBB@BA@A@A@A@C@C@A@@@AAAEE@CAA@@@A@A@A@AAA@A@@AA@@@@BAB@BADAFAD@D@D@D@BB@@NFJHDNBBD@FCLBFAB@DAD@HANGDCBA@@PAF@V@H@JAD@PGFEDATEVERCXBZADA@@AMACCUCGAAEIKMCAECKCWKEEEGACEQEIAECKCMAGCEEOGIAE@EBGJODIBO@E@CDGDEBAFKBC[EAAA@A@EBA@A@KDABC@O@A@A@AA@@@A@@AA@@A@CBA@A@AAEAA@A@ABA@@BC@A@C@C@A@A@ABA@A@C@A@C@C@GBA@@@A@@A@@@A@A@@@AA@@@A@AAEBEAC@C@A@C@GBGBA@A@@A@@@ADM@EBA@AB@DA@@@A@@@CCUBA@@FABA@@BA@@@A@@CC@A@@@A@@@AB@@AA@@A@@GC@AA@@@@AB@@A@@BAAC@A@@@ACAAAAC@@@A@@BAHADAB@@A@@@@@AAA@@A@@AA@ABA@ABEBABABA@"],encodeOffsets:[[108994,39389]]}},{type:"Feature",id:"640181",properties:{name:"灵武市",cp:[106.340053,38.102655],childNum:1},geometry:{type:"Polygon",coordinates:["@@BAF@B@D@DAF@B@DA@@B@D@BABAD@B@BA@@DABABADAD@BAB@DAD@B@BAB@D@DADAF@BAD@BAD@F@DADAB@DCB@BADAB@DADADAB@DAD@B@H@DABADCDABA@@DABABABADABA@ABADA@ABABCBADAFCDADAFCDADAFADAFAFAFAF@FAD@FAF@FAD@H@DAF@LAJAHADAD@B@DAB@@@DAB@@@GEUMEMACAG@A@QBO@C@@rj@AAACCEAgSAAE@MBLA@AAA@AA]sCGAQAAAEAaLqZaB@TJdBT@AMCMDIAQDoBGBY@GJK@@NQVP@@hEjA@YKaKS@@CICGCIGQEI@@IMEE_cMQ]MKESEYG[EEAAAAI@GC[D[DmD]BI@i@E{@}FÅ^IYXiBPyGièOXUHWKkfIFs£G[BANaRg^BLM@CDKAIBSBUH@F@B@@AB@@A@AB@@CBEB@BC@AB@D@BEFABA@@@CDAFA@@@@B@B@@A@@BBB@@BBD@@B@BA@@BE@@B@@@BBBB@@BBB@@@B@@ABA@A@A@CDABBDBB@BBDBB@DDB@@ABAB@@@B@@@@FBB@@B@@A@ABA@@@F@@BB@F@B@B@D@FBL@R@H@B@DBLBBBDBB@PE@@D@B@FBB@DAB@BBBFBBRPBB@@BLBBA@A@ABA@AB@BAB@D@FAb@F@B@BABA@ABC@@@M@A@C@A@C@@F@F@B@D@BAD@B@B@B@@@B@B@BK@CBA@A@Q@C@SAA@GHCDADIHD@D@J@D@BDBBHJBBFHA@@BMFEFCDCBA@AB@D@@CFAD@B@B@BOJCB@@A@OGCAEC@@AF@B@DA@ABBHAB@BAB@@B@@@@DA@@B@@ABC@ABA@AB@B@BD@D@BB@BA@@BGBABAF@D@BD@L@AN@B@FBH@D@D@B@D@FC@sDVSG@IAEH@B@DAB@@ADAD@BAF@BADCFABABABCBCBCBCBKBCBABAD@BCDAB@D@DBDBDDFHLJHBBDDFFB@@B@B@@AFAB@B@@@BBB@@@B@@@BCHI\\AB@BABDFFHNNHFFFJFFDFFDBBFDFADEDEBKD@@ENAHBHDHBBDDHLBBFFHLD@B@DBD@@@DDJNHLFLLPBB@DBDBDFFDBDBBBFHDDDDBBFBFFNHDBRJJDDDBDDHBDBF@BBDDLOBCDNfJHNHTBFCLBHLNRFFHDAGBA\\RJFNDHDJDHAJGHGBM@EFCB@DABABAD@BABABABADA@CBCDCBEBABGBO@GHAHAHEJG@FBRDpAPHB@BBABE@@A@@AAA@@DEBC@@BAHCB@@A@@@A@A@ABC@@@EDCBCBADCBABEHAFAF@FABBBA@@D@BCBAB@FAD@@@B@FCDADADCDADCDCBCDC@@DCBCDC@ABADADCDCBCFCBAFCDAFADADAFAFAFAFADAB@DAFADADAFADADAFCFADABAHCFADABABADAB@DAFADAFADAB@@@D@B@B@B@DAD@D@BAD@D@"],encodeOffsets:[[109203,39149]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
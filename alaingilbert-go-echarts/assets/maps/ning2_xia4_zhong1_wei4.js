@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("中卫",{type:"FeatureCollection",features:[{type:"Feature",id:"640502",properties:{name:"沙坡头区",cp:[105.173721,37.516883],childNum:1},geometry:{type:"Polygon",coordinates:["@@D@B@@A@@@@@@@AA@A@@AA@@@AA@@A@AAA@@A@@@@A@@@CAAAA@AA@@AAAA@AA@C@EAA@CAA@A@A@@@E@@@AABA@A@ABAA@AAAAA@ABA@A@@@AA@A@@@A@@BE@A@@A@AA@CA@AAA@@@@@AAA@A@@@@AAA@@@@@@@A@@@@@@@@@@@A@@@C@@@C@@AAAAEAEAA@AB@B@@@BAB@B@@AB@BAB@@@BABAB@B@@@DABABA@A@A@@@A@@@@BA@A@AA@@CBA@A@C@E@C@C@@@A@A@@@@@@B@BAB@@AB@BAF@B@@@@@@FB@@@B@@@@CA@@ABA@@BC@ADAB@@AB@B@@@D@@AD@D@BA@@BB@BB@B@B@@AB@@@BAB@@@BAFAB@BA@@@C@@@A@@A@A@A@A@A@A@@@AAA@AAAAA@@CA@@C@ABAA@@A@@@@A@ABA@@@AA@@AA@CA@@@CAA@@@@AAA@@@@@AA@A@A@A@@A@@AA@@A@@@AA@@A@A@A@AA@@@@@@@C@AA@@AA@@AA@BC@A@ABA@ABABA@ABA@@@AB@@@BEBA@@B@@A@A@AB@@@BA@A@A@A@@@CBA@@B@@A@AAA@@@AB@@@B@@@B@@@@@@@@AA@@A@@AGCA@@@A@ABA@CB@BABE@A@A@@BA@AB@B@@A@C@EAA@@B@@ABABA@A@A@ABA@@BCB@B@@EAA@@@A@A@CBA@A@@@AAA@@@A@A@CBABBBBDDBBBBDBDBB@DDDBD@DBDBB@BBBDBFBBBDBB@BBB@BAB@BAB@D@D@H@BD@D@DAB@D@@BBL@F@L@HAL@JCD@BBB@@B@B@@@B@BABBBB@@D@@BDB@@D@@@BB@B@DAD@DAB@D@D@D@B@DAD@FABADADABCFCH@H@JBHFLLDHDNBBD@B@FBBB@FENAF@@@D@@AFAD@FBBAB@B@BAD@D@D@B@D@DABAD@B@@@@GAA@A@MHKJABCBABCBEBCDABCDCF@B@B@H@@ABC@ABA@AB@D@BBBD@B@@@@B@@@BBB@B@@ABGD@@UJED@@@@B@@BBD@B@B@@@B@DAB@D@B@B@D@BABABA@C@@@ABQNA@CDCBCD@@GHABEDABAB@@CCAA@@A@A@AB@BDBBB@BABA@CBABCCAAA@ABAACCAAGGAAA@ADAF@D@B@@@@ECAA@@@@@@CDA@CBCBEBEDA@@@AAA@AAA@ABA@CB@@AA@@@A@@@AA@A@AAA@AAAA@@@A@@BA@@@AA@@@A@BA@@@A@@@A@@@@A@A@ABEDMF@@@BFJ@@@@GB@BBB@@@@FCB@@@BD@DAF@D@B@@@FAH@BCNCHADB@HABA@@D@BCB@@B@@A@AB@BAB@BBBB@@D@D@@@B@@A@@B@@@B@BBB@B@B@@GD@@@BBB@@ABC@AB@@@FAD@DAD@DBBCBAFIDCD@DAFAHAL@BAR@B@@@BBB@@CBA@CBE@A@KAC@C@C@A@EBA@A@A@E@C@G@GBA@A@CBA@CBA@CBC@E@A@ABC@ABABE@EBABA@CBABA@ABABIDEBI@EBEDEBCBABEDEDABA@@@A@GCAAA@@@A@A@A@@@AAACCA@@@@AACCACAEAAAAC@C@C@CBABABABGDKDIDCB@DFZ@@CB@@E@@BA@ABC@ABA@@@C@EAC@E@A@A@A@@BAB@@@BAB@@A@@AAA@A@A@AA@A@GAKAA@C@A@@AA@A@A@A@A@ABIHIHAB@L@B@l@D@HAFADCDEDGBI@KBCBEDGDEDIHCD@BBFBFDF@DAFCFEHED@BED@@CBEB@@@@A@@@@@@@@@@B@@@@A@@@A@@@@@A@@@@@BB@@@@@@@@@@@@AB@@@@@@@@@@B@@@@B@@A@@@BB@@@@@@B@@@@@@@@@@@AB@@@@@B@@@@@@A@@@@B@@@@@@@@@B@@B@@@@@@@B@@B@@@@@@@@@B@@@B@B@@@@@@@@@B@@@@@@@B@@B@@B@@@@A@@@@@@@@@@@A@@@@AA@@@@@A@@@@@@B@@@@@@@BA@@@@@AB@@@@@@A@@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@@A@@@@B@@@@@B@@@@@@@@A@@B@@@@AB@@@@@B@BA@@@@B@@A@@B@@@B@B@@A@@B@@@@A@@@@B@@C@@@A@A@@@@@A@@B@@@@B@@@@B@@AB@@EDABGBCDEBCBCBAB@F@FADCBCBADAD@D@BBHAB@DBH@D@D@B@@@@@@AB@B@@@@ABA@@@BB@BADCDEDEBEBYJ@@EFABCDABC@IBO@ABCB@BAB@BA@A@@@@@@@A@@A@@AAAAAAE@A@AAA@AAA@@BADABA@@DABADABABADCBCBCBCBABC@AACCECGEE@IAICCAAAAA@EAAICIIEAEAAAAAAEGCECECCAI@G@C@A@OEIACBC@C@@@CAAAAAA@CBCBI@@@GAA@ABCDCDA@A@@AAA@AEACAC@IB@@C@C@A@AAAAC@CACBC@C@A@CBABCBAB@B@DAD@@@BKHGJGDCHADAFKVAD@PHTLPDHADBBBBB@DBB@D@HA@@D@@BBD@B@D@DEDABABAB@B@B@B@BBB@BDBDBHBH@FCRKBAB@B@B@B@@@B@B@D@BBBBBFDBFBFBBB@D@DBD@D@D@DBBDBBDDBDDFBD@D@D@J@D@BBDDFDDBDDDDDBDBDAJAB@BBBBBF@BDDBD@D@DAD@F@D@B@@C@C@CAEACAAAEAIBC@CDEBG@CBGDEBIBEBCBADAFADEJADAHBFDDDDDFDJ@JBJBDDBFDFDBFGDIDMBM@GDCDIBIBGBCACAIAG@I@GDE@@@CFCDCDEBQB[@C@ODKBA@A@GAICCAEAE@CACACB@BCDCBGBEDADCBG@GBAAACAAAACAE@C@A@ACACCACCGAIAE@EBGBI@SCIAMBEBEBCBADCB@DAJAFEFEHADABC@C@CACAE@cGICAAAAEAICGACCAACCG@MCCCCEEAEAG@@@E@E@GBGBC@A@_COCKAIAG@I@GAEDADCBCBCBABAB@DBF@BFDFFBB@BB@FDHHB@@B@@B@BBD@BBFBD@JDFBB@BB@@B@B@B@@@B@@@B@@AB@@@BABAD@BA@@B@@@BAB@D@B@B@DBB@DBFBD@FBFBBBB@@BB@@BBBBB@@DDDDBB@@BBB@DBBB@@B@@@B@BB@@D@DAJ@D@B@F@HA@@B@BBF@DBD@BB@@D@HBFdDNNpVAFHĒFRB¼FfBP@îRF~FfBHBB@HFnZXJ@p@T@bJJBR@|DFB@BBJ@J@NBF@HDZJ@@@@@@@@NHbTr\\FFNLJHHHDBDDLDFBJ@LBJBFDDBRFTHNHPDJBJDJBHFJDDBHHFDFBDBHDFBJFJBJBFBN@J@HDLBLDH@FBJ@JBHDLFHDFBFBJDFBHDJBH@J@L@HBLBJBJ@H@H@FBJBHBHBH@FAL@F@FBDBF@HBH@JAFAFAN@HAHCDAJEHAHCHAD@KMCCACB@@@D@BBD@DABCHCHCLIBADEAEBCBCAABCBGEGAKACAAECACBCBAHEDEBCDG@EBE@A@@CAAACMAE@CBEBEBADC@A@GCC@AACDABC@@BABC@CCEC@AAC@EBE@CAAAACFEHC@ACECEAABC@A@@EAGACAAC@EB@BA@@AA@C@AB@FADCD@@C@C@EBGFG@ECCACBI@C@A@AAA@A@M@CBAB@BADAFAH@HCLFFBHBD@F@JEF@FFDDFBFAD@D@DAF@FAF@D@D@DBFBF@F@BAD@DCH@DABCBCB@BAFCI@@A@@AAA@A@A@CA@AEBCAACACA@CCBA@ABADADGBCBA@A@@BADAK@@GA@CCAECIAA@AQDA@C@@EBM@A@@BCB@@CAAAAE@IAG@A@M@EBC@@G@aB_@C@ABAQHGDABAJIDEB@FELCDALENURIPKB@@A@A@A@@@A@A@ABA@@BA@@@C@A@C@AAA@AA@AAAA@CAEAAIE@@A@AACA@EAABO@A@A@AA@@A@A@A@@AA@ABAB@DBB@B@BA@A@C@AACEE@A@AACAACA@AAAACAA@A@CACAEEECCAGAEEEEEAAACCQ@CKECAECWMOa@EAA@GAEACCECECAAAKIKKGQ@@EQAGAC@CAA@AAACCAC@@CAGBC@A@CAAA@AAC@AAAA@CA@AAACE@A@AA@AKBE@@AAABA@ABAAA@A@CCAACAACAAC@AAEBC@GAE@A@C@A@A@@AC@@@E@CBCDC@EBE@CBC@EDCDIDC@CAC@G@GACACAA@AAA@AAI@CAAAEACACA@AECA@AAAA@AACAAACAAAAG@G@CAA@A@@BBBAB@AC@C@AACAA@ADEBA@AD@B@BC@A@@@CCA@GBA@IWDEMOEEWUKKCAAAC@I@G@HMBEFU@ED@BAFEBA@ABC@E@G@@HEB@DDB@@BBB@B@BB@D@DBHAFB@ADE@CBABAB@BC@A@GBCDCDC@A@A@ADC@@@G@CBE@ABCDCBAB@D@@@DABAFCBABATGBAHADAF@D@DCDGFIDEBA@@FBNDDBAAD@@@@@@AAA@C@A@A@@BABABA@@@A@@AA@@@A@@@A@@A@A@@A@A@@@@AA@@@A@@@A@@AA@A@@@A@A@AA@@A@@@@@AC@CA@A@@@A@@AA@A@ABA@@@AA@@AAE@AA@AA@AA@@@@@A@@AAA@@@@@@AA@A@@@AA@@A@A@@@@@AA@@@@@A@AA@@A@AA@@@@A@@A@@@A@@@@@@AA@@@A@@A@@@AA@@@@@@AA@@@@@A@A@@@C@@@A@A@@@A@@@@DAB@BABA@@@@A@B@BABABA@A@@@A@@B@@@D@@@@@BAA@@A@@A@@@@@@A@@AA@@@AB@@A@@@@@A@@B@@@@A@@@ABAB@@@BCDAB@@@B@@@D@FAD@DBH@@BB@@@BB@@BBBB@@@B@BBBBB@BB@@@@@@@@B@@BB@@BB@@BA@@BA@@@@@@B@BAB@BAD@@BB@B@@@@@D@B@@@BB@B@@F@@@@BD@B@D@B@@@DB@@@B@@@B@@@BBB@@B@BB@@@@@BB@@B@B@B@B@@@@BB@@BB@BB@@B@ABA@@@AB@@A@AB@B@@@@@B@@@DA@@BABAB@@A@A@@@@@A@@@A@@@A@@@@BA@AB@DBB@DAF@BADAB@B@@@DA@AB@@A@@@@@ABABA@@BA@@@@BBD@B@@@BB@@B@B@B@@@B@@BD@@@@@B@@@B@@B@@B@B@@@@@@@B@@@BB@@B@BABAD@@ABABAB@BAB@BA@@B@BBB@B@@@BBD@@@@AB@DC@@B@B@@@B@B@B@@@BAB@@@@@@@@B@B@B@@@@@D@D@@@BBB@BBDBB@B@BBD@@@@@B@@@@B@@@B@@@@AB@DAD@@AB@@@@@@@@B@BAB@@@BDADB@@BAB@B@@@B@@@@@B@@B@@@@ABADA@AD@B@@@@B@DBB@@@B@B@@AB@@AB@@AFAB@D@B@DBD@DB@@@@@A@@@AB@@@@@@@@AB@A@B@@@@A@@@@@@@@@@@A@@@B@A@@BA@@@@@A@A@@@@BA@@@CBA@AB@@ABA@@@@@ABA@@B@BA@@@@BA@@@ABABCBEBA@AB@@@DE@AB@D@B@@@B@D@@@BB@@@@B@@@@A@@B@B@@A@@@C@@B@@@B@B@@@B@@@BA@B@B@BB@B@DAB@@A@@BA@A@A@@BAB@@@B@B@@@@@BB@@@@@@B@@@B@@ABA@@@@D@BC@@@@@AB@B@D@B@@A@@BABEBA@C@@BAB@@ABA@A@@@A@AB@@ABAA@@@AA@A@@BABA@A@@BA@@@AB@B@B@B@B@DAB@D@B@B@DADBBAB@BB@@BA@@@BB@BA@@B@B@@@BABA@@B@B@B@D@@@@@@B@@@@@@BB@@@@@B@B@BB@@BB@B@@@BAB@@@@@BB@B@@@@D@B@BAB@BC@@@@@@BB@@BBB@@B@@BBB@BBB@@BB@@B@@D@BBB@@AB@B@@A@@@@BA@@BAB@B@@ABA@@BA@@@@@AB@@@BAD@@A@@@@B@@@@A@@@A@@@@BA@@BA@@@@B@@A@@BAA@@AB@D@B@@AB@@@B@B@@@B@B@B@@BBB@BB@@@BA@@@AB@@@B@@A@A@A@@@@B@@A@@@@DA@@B@@@@A@@B@@@@A@@B@@A@@@@@@BA@@@A@@@A@@@@@AB@AA@@A@@A@@@A@@A@A@C@A@@@A@@@@@@A@@@@BCB@@@@AAAA@@AA@@BGDA@@B@@A@@@@@@AA@@A@@A@@A@A@AB@AA@A@A@A@@AA@A@@@AA@@@@A@@@AB@@@@A@@@AA@BA@A@@BA@@@@@A@@@@A@A@@@@@@@@BA@A@A@A@@@A@@@@@@@A@@@@@@@@@A@@@@@A@A@A@@@AA@@@@@@A@@@@@A@AA@@@@AA@@@@A@@@@@@ACBAA@@@@A@A@@BA@C@@@A@ABA@A@A@A@@@A@@@A@@@@@A@@@@@AA@@@@AABAA@@C@@C@E@A@A@@@AB@@@A@A@@A@@@@@@A@@@@AA@@CACA@@@A@@@A@@@A@@@A@A@@@@BABA@@@@A@@@A@@@A@@A@@AAAA@@AAA@@BC@AB@@ABCB@@@BA@@@@@@B@@A@AB@@@@A@@@@BA@@B@@@@AB@@@@@BA@A@A@AAAA@@A@@BA@@AA@AA@@A@ABEB@@@B@@@BAB@@@@ABA@A@@B@BA@@@ABCA@@A@@@AB@@CB@BA@AAABA@A@ABC@@@@@A@@@@B@@@@@@AB@@@@A@A@A@@@A@@@A@@@@A@@@@CAA@@@A@@@AAAAAA@@@A@@A@AA@@@@@A@@@@@AA@AA@@A@@@AA@@@A@A@A@A@CB@@@@A@AB@@A@A@A@@@AB@@@BB@A@@BA@@@@BABA@@@ABA@AB@@@B@B@B@B@@A@@@A@@@@AA@@AA@@CAA@@@A@@@CAA@@@A@A@@@ABA@@@@@A@@@@AA@C@A@@@@A@@@A@@@A@@A@A@@@@A@@@@A@@@AACBA@A@A@@@@@@@@AA@@@AA@@B@@@@A@A@@A@@@@@A@AAA@A@@@A@@BA@@@A@A@@@A@@@ABA@@BC@ABA@E@C@A@ABAB@@@@@B@BA@@@@@@B@BABAB@@@@@@@B@@@@A@@B@B@@@B@@@BA@@B@@@@@@@@A@@@AA@AAACAA@@A@@@@B@@@@@@AA@A@@@@@A@@@@@@A@@A@A@@A@@@A@@@@@AA@AA@AA@@@AA@@AB@@A@@@@@@A@@AA@A@@BA@@AAA@@@@BA@A@@A@@@@BA@@@@A@A@A@@A@@@CAA@B@@@A@@AA@@A@AAA@A@@@@A@AA@@AA@AAA@AAA@@@A@@A@@@AAA@@A@@@A@AA@@@AA@@B@@@@AA@@A@A@@@@AA@CB@@@@A@@A@@AAA@E@@AA@AACAA@@@@A@CAA@CAA@@@@@C@A@A@@AA@C@C@@CEU@IBABABCBABA@A@C@@@A@@BIDMHEDC@CBA@@@@AA@AAACECA@@AA@CK@C@@BADADA"],encodeOffsets:[[107934,37589]]}},{type:"Feature",id:"640521",properties:{name:"中宁县",cp:[105.685218,37.491546],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@I@AFEJCF@DAD@DADAFEFCAACECA@ACCC@CAA@AAA@@ACAAAA@ACAEACAAAA@C@ADCLG@@ACCEA@A@ABABA@EA@@EGBG@CBG@CBGAA@EDGBCBCBA@CBC@AECQNCDGDA@CAABABE@E@ED@BBDAF@BADABADADAB@H@BCD@BCDCFCBGAAAA@AA@C@EBEBCDCBCDC@ACAC@ACAAC@CCC@CBC@AACEAE@@BCBADA@AAA@AA@A@A@@@A@A@@@A@@BA@C@A@@BB@AB@@@BA@@@@@AB@@AB@@@@@B@@@B@@A@@@@@@BC@AB@@A@@B@@@@AB@@AB@BA@A@AB@@AB@@@@@BA@A@@BA@AAC@@@@AA@@AA@AAA@AA@@@AA@AA@@AA@@@@@@ADA@ABA@C@@@@@@AAA@@@@A@AB@@A@A@@AA@@A@A@A@@@@AA@@@@@@@A@@@@C@A@A@A@@@ABAB@@A@A@@@ABA@@A@@AB@@AAA@ABCACBA@A@C@A@CBA@A@A@A@A@@B@@AB@@@BABAB@@@BBB@@B@AB@BA@@B@B@@@BAB@BA@AB@@@DABAFAB@@@BA@C@A@A@@B@@@@ADC@@@@@AB@BA@@@A@@@@@@@AA@@@@A@A@@@A@AB@@@B@BAB@@@BA@CBA@A@@A@A@AAB@@A@@@A@A@@@A@@@@D@@@BA@A@@@@B@@A@@@@@AA@@C@A@@@A@C@A@@BCF@@A@@BABAFADAB@B@@AB@@@@ABA@@@AB@B@@@@AB@BA@@BAB@D@@AB@@@@@B@B@@@@AB@@@B@A@@@B@@@@@@@@@@@B@@A@B@A@@B@@@@@@A@@B@@@B@@@@CAC@CAA@C@A@EB@BA@@BA@@BA@A@@@A@CAA@@@@@@ABCB@BCBA@@@@@AA@@@@@A@@@A@A@AB@@CACB@A@@BA@A@A@@@@@@A@@BC@CBA@@B@@A@@@A@@@@@@A@@@@AC@A@AAAAC@AAA@A@@@C@C@@@@@A@A@A@@@@@@A@AB@@A@A@A@@@A@A@@@CDA@@B@@C@AA@@A@A@AAA@@@ABA@ABA@ABAB@BC@ABABA@@@AA@@A@@@@@@@A@A@@@@AA@@@A@@@@@C@@AA@@@A@A@A@@@AA@@A@C@AA@@@@AB@@ABAB@B@@@@@BA@@BCB@@A@A@CBABE@CBA@CAA@@BAB@@@@@B@@@B@@@B@@@@@B@BA@ABAB@@CB@@A@@@@@A@A@@B@BA@@B@@AB@B@AA@@AAA@@AA@@@@@A@A@A@AA@@A@@@@AAA@@@AA@A@@@A@@@A@@CA@@A@C@A@C@@A@@E@@@@AAA@@A@C@@@@@A@A@@AC@ABA@ABA@@@@@@@AB@@AB@@AA@@AA@@@A@@@@@@A@@AAAAA@A@A@@AAAA@@AA@@A@@AG@CAC@EBC@@@A@@@A@CBAD@@A@AB@B@@@B@@A@@@@B@@@@@BA@@B@@BB@@@B@@@@B@@@@BB@AB@@@@C@@@A@@@@B@@@BABABABA@B@@@@@ABABA@CB@@@@@B@@@B@B@@@D@@@B@B@@@@BB@@@@@@BB@@B@@@@B@@BB@@@@@@@B@@@BB@@@@@BBB@@@BBB@@@@@B@@B@@@@@B@BB@@B@@@BBB@@@@@@BB@BB@@@@@B@@BBBB@@BBF@BB@@B@@AB@B@BBB@@@B@@@BDBD@@B@@@@@BB@@B@B@B@@@BBB@@@B@@@B@@BB@@@@@B@BB@B@@@@B@@@B@@BB@@@B@@ABABAB@@@B@B@DBB@B@@@@C@BBCAMCEA@@ABCFEJCHCDC@E@CBGBABSHABABEDABCB@@C@A@ABCDAD@BAF@D@H@@CD@B@B@BCDCDAD@H@BADA@ABAB@DCF@BEAGBCAC@A@@A@AAA@AA@CCA@GF@@@H@FAD@BABEFABC@@FEVAFGNH@J@D@BBDBLLXVFFNPCFJXB@HAB@DD@@B@D@@A@ABCB@FABCB@DBBBD@D@@BBAAA@AB@B@DBH@H@BBBBBDBBBD@BBBBBB@FD@BDBDBFBBBDBJ@BBB@BBB@DBDBHBH@D@DBD@JCDCFCD@DAF@FAD@DCDAF@@@D@@BB@B@D@B@F@HBD@FABBD@BBBDDBBBDDB@B@BBBAB@BABB@@AFBLB@@B@BDFBB@BDBB@BB@BBD@BBBDBB@D@HADB@@BDDDBB@BBB@DBDBHFR@@HRLLLJBBDBDFDFBDBF@HBB@FPbXNFDDBLF@DDRBDBBFFFFBFBHDDFFBFBD@D@BBBBDBB@BDBBBBD@B@BFFBD@B@D@BABA@A@CAA@AB@BBB@@@B@B@BB@@B@B@BAPBB@FDBBBB@@@JFBBBF@DBBBBB@@BBB@B@D@B@D@@AB@@AB@B@B@B@@@B@B@BA@OLQJMVKFCBKDEFA@CFIJABCBGHRAB@B@DA`@b@HD@FAN@B@H@JBF@BBBB@DA@AD@@@BAN@FD@B@RC@BBBDJBFDDB@@HL@CBAB@@@BABADCHCBAB@BABDDB@BDBDDBFA@BDBB@B@B@BB@@@BJ@EDABA@ADADCBG@CDC@ABE@E@EACAC@C@E@EBE@CBC@C@EBEACCEEE@IFE@C@GAEAKEGDG@EBCBABA@AB@D@N@BBB@B@B@DAJBDDD@FEHAH@F@D@DC@CDEBA@@B@DBB@@ABA@@FBDDBHBFB@@@BADBBDFDF@BGDEFBDBBDBF@FAD@BBD@DF@DADAB@@ADCBBD@BDD@H@BCDABAFAF@DBFDNBBDB@@@BAF@FCHADCFGFABADBDFDBBBDBLFHAHADBBADADBFCFABKJGDGDADCBC@AAC@@@A@BDDDLND@N@RCHBNAN@L@DBN@L@J@H@N@N@L@NBH@J@JBJ@NBN@FBR@J@HALB@@JDLJB@D@LBD@F@N@TBJD@@H@BB@BBDBDFBHBFBDBDBDBDBD@D@BBHBHBDBJAHBB@FABBHBDBBBD@FAD@DBDDB@B@B@HAD@F@@@B@BAB@B@D@D@DAB@B@DBB@@AHAH@DBFCHBTFHDLDJFDBFA\\CH@HAHCFBDBFBJATCNEF@PVPRFEJCNACENGBBFCH@^O@CCEGAGKEAAADGACC@@CLCGABCE@@CDEAcEKAKCCCIFIAIIELEBABCBEDEDCFKEIOIACLAH@XCBDJVLRRVLLDP@HAD@HBA@BD@HADAAAD@B@@@@AB@DCKOFAFHB@BBDABB@@BBJA@AB@ACD@@@PABH\\@DEDAPAN@@@JBF@FIDGDDH@HCHA@GBAACACDG@CAAAACA@EBABC@A@ABA@C@ADGECACMCIKGG@AAKBA@CLMDCDCBCBAFCBA@ADCDCFCBE@C@A@ABADABADCDAFAF@H@HALCFADEBEHEBE@EACACACI@AAICCAAA@CCACAEBIDE@EAAC@IAC@AAEGAC@CACAAAACAAC@CA@C@CACCC@ABE@CCACAACCEGCE@GCC@KHABE@@@CAGEA@EAE@G@GBMCC@@ABAFCJADBB@DADCDABAFEDCHMDCA@@@I@WAC@EAKAKAYADWA@AA@AAA@AAA@A@A@@A@C@ABA@A@A@AACC@@C@A@A@@B@BADKF@@@AA@wKQO@@CCHQFCFMAC@E@@O@C@E@C@GBE@CBG@EBMB@ACMEKHG@@JGLE@@JCFCJEDAFBNEBCBAD@FDB@NGFABAB@NIF@H@@BJCHEH@@CB@FCBGF@JEBEPKKKAC@AAACAGCCCAAGEAAA@@@_BC@H{FWBK@IBW@WB@@@@AA@CAE@@AC@@@@AAAACAEAI@@ACC@@ECAAAAA@C@AAA@A@CAA@@@C@CAAAA@A@E@AAC@A@E@CAC@E@CAEAA@AAAAPQDCBADGB@BA@@@CBABC@A@@@AA@@@@A@ABC@@BABADABA@ABA@A@AAEAABC@@B@B@BAB@AC@E@@@ABABABAB@@@B@B@@@BAFAFCDC@@@ACAA@AA@@@A@@@A@@@A@AB@B@FCB@BA@@BA@@@A@@@@A@@@A@@@CD@@AA@@@@BC@A@@AA@@@ABC@@F@B@B@B@B@@@BA@AB@@CBC@A@A@@@@A@A@C@A@@@CE@A@ADCDA@AECGIC@I@GAGAEDGCGAE@G@GCIAA@C@C@E@E@A@E@G@@FEJIHEFGFEBEFADADABCDC@ADFFFDAHBDDDD@@@@NAB[t@BCFCDDDH@@DGDEDKO@@AAC@EAA@CEA@@AA@GAGCCA@AA@@AACAACA@AACCAAAAAA@AA@@AAAAAAA@@AA@@A@@AAA@@@A@AAEAAAAAAAAAAACAECAACECA@@AA@A@@C@CAAAA@C@A@A@CAC@A@A@EBC@E@A@@@AA@@@@AB@@@@AAA@A@@@@AAA@AA@CAA@C@@A@@@@C@A@CBA@A@@@A@@@@@EA@@@B@@A@@@A@A@@CA@ABBAB@@@D@@AD@D@BAB@B@@A@@B@BA@@B@@AB@BA@@@@@AB@@ABEBC@AFAFCD@DAB@PAF@DB\\BF@TIHCHA@@HA@@B@D@BBD@F@F@DAB@HB@@PMJGHABI@IDW^MH_bWVOVOdKTKDQGA@C@GDQLITS`Mb]CKDCFGJKDKROBCFK@E@EVUH@H@A@@AA@CAC@@AA@ECCAA@A@AA@AAAAA@CBCAABA@E@CCE@CCCEEECEAEAC@ACAACAEAAACCCAGCGCCAACA@A@A@AACAAA@A@AAE@AA@IAEACACCCCAAAABC@ABAAAA@IDC@A@CBABCBEDA@A@@@C@A@A@@AA@ADA@@@ABA@@BC@@@AAA@AB@@"],encodeOffsets:[[108275,37804]]}},{type:"Feature",id:"640522",properties:{name:"海原县",cp:[105.643487,36.565033],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAA@A@C@A@AAA@@ACACCAAAB@@ABAAA@A@A@@AAA@AAA@C@AA@@BAA@@AAACA@ACACA@@AC@EAABA@ABEDCAC@@A@AAA@@A@ABA@@@AAACAABAAAA@CBA@@@A@C@A@@A@A@ACA@B@@A@A@AAA@CAAAA@@AA@@AA@ABABABAB@@ABA@@AAAAA@AAA@@CACAAAAA@CA@A@@AAC@AA@@@C@@A@@@AAAAA@BABA@A@A@A@C@@@@@ABABADABAHBB@B@@@BAACA@BA@@DBB@B@B@FCDADEBADGDABIBABCBCDAD@D@DAD@BAB@F@B@DBBBDBBB@BBBD@BBDBB@DB@AB@DABAFABADABAB@B@B@DDDBDDB@@BBBBD@B@DBBABBBBBDBFBD@D@B@D@B@DADADABADCDABCDCDABCBEBABADABAD@B@BAD@D@DABABC@CB@@CBC@E@E@C@A@C@CB@BABGBA@CB@@@B@B@DAD@BBBABABC@CDADBB@B@@B@@BC@C@@BA@@B@@C@@@CB@BA@@B@@AB@B@@AB@@A@@B@@@BB@A@@B@@ABA@@A@@AB@BAB@B@@@B@B@@@BAB@BABA@@B@B@@B@B@D@B@D@B@B@B@B@D@BBDB@DBDDB@BABADCDADC@E@E@EBA@A@@@@FABAD@D@D@D@DAD@@AB@DAD@D@F@D@BBH@DBD@DBD@B@FCBADEBA@ABABCBAFCBA@@BAAC@C@AAC@EACAA@A@@A@E@A@@AAABCD@@ABABAB@DAB@BBDBDBDBB@DBDBBBD@F@DBB@B@@@B@@@BA@A@@BABCF@D@FBFBBBD@DAD@B@DAD@BADADADADADABCBCDCDCDEDABCDCDABCBABA@A@CDABAFCBA@AB@BABABABCBAFADCBABA@A@A@CACAEAAACAAAAA@@ACAAAACAC@ABABA@ABC@CACBE@AAA@CA@ACA@@@@A@@B@BAB@@@@AB@@A@@AA@A@@@A@ABAB@B@@@B@BA@@BA@AAA@A@@@ABABABABA@A@AA@@@@A@@@AB@B@BAB@B@D@DAB@BA@CBCBC@A@A@BB@DBBDFDBFBDBB@DDF@DDBBDBD@D@B@BBAFABAD@B@BBFBB@DABABABABCBAFADABAD@@BBDDB@BB@BADKJCDE@EAA@A@EAAD@@ADADADADAD@@E@@B@D@N@D@BABEAC@C@CB@DABE@ADA@C@CACBAB@BBB@BDB@@@@@B@BADBDDF@NBFDFBB@B@BABADABABCDABCDCDEDCDCDABABAD@BAD@BCFADADAB@D@B@D@B@BADBB@BBB@@@BBADAB@B@@@B@BB@@B@B@DAB@B@@@B@FB@@@ADA@AB@BAB@B@B@BABA@@@AB@FBD@B@@@@ABAB@@AB@B@F@BA@ADAB@BAB@@@B@HD@BB@@@BB@@@@@@@@@A@@@A@@BA@@B@BBB@@@@AB@DA@@B@B@B@B@@A@@BAB@B@@@@AB@FA@A@@BA@@B@BAB@BABAB@BAB@D@@ABB@@BB@@BBD@@@@@@@B@@B@B@B@BB@@B@@@BB@@BB@@@@B@B@BBB@@@@B@BB@@@@BB@D@@DBB@@BB@@B@@AB@B@B@@B@@@BBBAD@@@DB@@BBBB@BBB@B@@@B@B@B@B@B@BB@@@D@@@B@@ABABE@A@@BA@A@@BA@@@A@AAAA@@AB@@A@CBC@@@C@@@ABA@@BABCD@@AB@BA@@DB@@@@@A@@EA@@@@@@@ABE@ABA@@BA@A@A@@@@B@B@@@D@D@F@D@B@B@DA@@BBB@B@@A@@B@@@B@B@B@BABA@C@@@ABABA@A@@BA@ABA@@@ABA@A@@@ABAB@FBFBBBBB@@@D@@@D@@@B@@@@@@@@@@@B@@@@@@BB@B@@B@B@BB@@@@B@BBB@@DBBB@@@@BAF@@@B@@@BBB@@B@B@BAB@BBBBB@AB@B@BABBB@@F@@@B@B@B@DBB@FBD@B@@BBBBB@@BBB@BBDB@@B@@@@@@BB@BBB@@@BB@@B@@BB@B@@B@@@@@@@BA@C@CBCBAB@@@DDLB@@BB@FDBDBBB@@B@@B@DAD@FCNGJC@AB@@@D@B@B@BADABABAJAV@DF@@@D@DBB@@@B@B@D@@@@BB@DBB@D@B@@B@DBBBB@@BF@B@BB@@@BB@@@@@DAB@@B@@B@B@@@BB@@@@@AB@@B@@BBB@@@B@@@BB@B@@@BB@@@B@BBB@BBB@@BB@@B@B@@B@B@BBB@@@BB@@@B@@@ABB@D@@@BB@B@B@@@@@AB@@@@@BB@B@@A@@B@BB@@AB@@@BBB@@@B@@@@B@@@BA@@BB@@B@@BBBB@@B@@@@@B@@@BB@B@@@@B@@@@B@@@@@B@B@@B@@@@A@@@@@@BB@DBBB@BBB@@B@@@@@@@@@@AB@@A@@@A@@@A@AB@@@@@@A@@@@@@BABA@A@A@@@@B@@A@A@@@@BABAB@D@F@B@BAD@@AB@BA@@B@@@B@B@@@B@@AB@@@B@B@BBB@@@@@B@@@@B@B@@A@@@BB@@B@@B@@@@@@B@B@B@DABB@@B@@@@@@B@@B@B@@@@B@@@B@@@B@@B@D@B@@B@@B@@@@@B@BA@@B@B@@@B@DB@@B@@@B@DB@@BB@@BB@@@@@B@@@BA@A@A@A@@@A@@BAB@B@@ABAB@@@@AB@@@BAA@@A@@B@@@B@B@BA@@B@B@@A@@D@B@B@B@B@@BB@@B@@@BBB@@B@@@@@B@@@@BBB@@@@B@@BBBBBB@@B@@@B@DB@@@@@B@@B@@@B@@@B@B@B@@@@@BA@@@@@@@A@@B@@@@@D@BAB@B@BABBB@@ADA@@BA@@B@@@DBBA@@B@@A@AB@B@BA@@@@BA@A@@@A@@FABAB@@@BBB@@BB@@AB@@@BBBBB@B@B@@A@@@@BA@@@@@AB@@A@@B@@@@@BAB@@@@A@@@@B@@A@@DABA@@BAD@@AB@BB@@BBBB@@@BB@@@B@@@B@@@@@ABAB@@@@@B@B@@@B@@@B@@@B@@DBDB@@BB@@@@@B@@@@B@@@@B@B@@BA@@B@B@F@D@@@@DB@ABBB@@@@BB@@@@B@@@@@B@@@B@@@B@B@B@B@BAB@@@D@B@@AB@B@@@@@BBDA@B@@@@B@@@@@BB@@@@BBB@@@@@B@@@@@@@BB@@B@B@B@@@@@B@@@@@@@@@B@@@@@@@B@@@B@B@B@B@@A@@@@@@B@B@@@@@@B@@@@AB@@@BABB@@B@@@B@@A@@B@@@B@@B@@B@@@BBB@@@B@B@BBBA@@B@B@BB@@@@BB@@B@@@@B@@@@AB@HC@AB@@BB@BB@B@@A@AD@@@@@B@@@@B@@@B@D@B@B@@@@B@@@BB@@@BBA@@B@@@@@B@@@B@@AB@@@@@@@BA@@@@B@@A@@@@B@@A@@@CB@@@@@BA@@@@@@B@B@BA@@@A@@B@@AB@@A@@A@BCBABAD@@BFDFBBD@DAD@DDD@BBBDD@DB@BCDADCDADAF@F@DBBB@BBHBDADEDC@ADC@A@GBABCBCBABC@ABEAC@AFCF@F@BABADBB@HCDCRM@A@AHCDABABFBDBBDBBBBDHHBBDB@BBBBBFADAHBJCBABCB@DBBBBBD@DABBBBBBBF@@BBF@@@JCFAHELKDC@A@C@AD@LGBEAADEBEAAACAAACBADA@ABA@ABC@@DABBBBJHDDBBBAD@BDFH@DBDD@B@B@BAB@B@DB@@BBBF@B@B@DAFAJ@DBFBBB@B@FGJE@@DBB@@DA@CFED@B@BBBDBB@B@FBDADAD@D@F@B@B@@B@@CD@DBBBDFFBDADGDABLHFBDD@DBDB@FHBDB@B@B@DDA@ADADDDBD@@@F@BG@EHJB@DEBAB@DAB@@DB@D@BAD@D@BAFADABBBADB@D@DBBBLDHALDHDHB@BAHCFADPDDBBACCBCBAD@BCBBD@F@DAAC@@@@@A@@FAD@HA@@B@BC@@@AAACA@AA@@A@@BA@AB@BAB@@@@@@@@BBB@FB@B@@AB@BBFB@@D@@@@A@@ECA@@A@@@A@@B@DA@@@@B@@A@A@@D@B@BB@@DC@A@A@@A@AAAAA@A@EBA@@A@A@A@ADC@AB@@@B@B@B@BB@@@BBB@@B@B@DCBABA@A@@BAFA@@@A@@A@ACAA@@AA@@@E@AA@AA@A@C@@@A@@@AB@B@@BB@@B@BB@@@B@BAB@@@@A@@@@EC@@B@BABCB@@@B@DB@BHAL@MKDA@AB@BA@@@A@@AC@A@@@ABA@@AA@AB@@A@@B@@@@@B@BBBB@BCD@@B@BBB@@AB@@ABAB@DCAACA@@C@@AA@@A@C@@AAA@A@A@ABAA@@C@@AA@DABAD@BAAA@AC@@@EBA@AA@@CC@A@ADCA@AAAAAAA@AABAB@@@B@BBD@JF@@BBDBDDD@BBB@@A@AACACAA@EFD@@D@@BD@B@B@B@B@BAB@FCDCBABA@@BABA@@B@B@B@D@BBBAD@BAB@@A@A@@@ABA@@DCBA@@B@BB@@BBB@B@B@H@B@@@@A@@@AA@@AA@EAEA@A@@AA@@BA@@B@@@B@BBHB@BB@B@B@@A@@@@@AAA@@EEA@EAAAA@@@BABAB@BAB@F@B@@ADABABCB@@A@A@@@AA@A@AAA@CBABC@C@A@@AA@@@@@@A@@@CBA@@@@B@B@B@B@B@@AB@@A@@@@CC@AAA@@BA@@@ACEBEAABAJDBBB@B@@@BAB@@@B@BA@@B@@@B@B@@@@AB@@AB@BAB@D@DA@@BA@@@A@AB@@AB@JCB@BA@@BA@A@AA@AAAAA@@A@@@ABABAB@B@DAB@CEACDC@A@A@@@AB@@@@A@@@@@AB@@@B@@@B@@@B@AACA@A@@AG@ABC@@@@AC@@@A@AB@@@DAB@@ABA@@@AFE@A@@@AAAB@BIBCBABAB@@@@@BA@@B@@A@AHK@@DC@@@ADCDCNEB@BA@@BA@@BADA@@B@@A@AB@@C@A@A@@BABABA@CBC@C@E@A@A@A@CBC@E@@BABADBD@BADCBEA@A@@ABAB@@ABA@A@A@A@C@@@AA@@@A@GACA@@CCBCBG@CB@@ABAB@B@DBDDDBB@FBF@@@B@B@@AB@@ABCBC@A@A@A@A@ABCBA@@BA@@D@F@BA@@BA@AB@DCHCB@@ABA@A@C@G@@@AA@@@AA@@IA@@A@AAAAAC@A@@@A@@BAFCDABABA@@@A@@CE@C@@AA@A@A@C@A@ABA@@@AB@D@B@B@HBB@@@BABA@C@AB@@@FAB@BADBDBB@NJBB@BB@@@B@DAF@B@@@RH@@B@D@H@@AB@DAFCDC@@@@@@@D@J@F@D@B@B@B@F@B@B@D@BBB@@BDDB@@B@B@@@B@DCB@B@HAB@BB@@BBBDBBB@@@B@DADAB@B@D@B@BABAB@B@BAB@BAB@@@@B@@@@@BEF@B@@BBBBBBB@B@@@B@FC@@B@@@@@@@AD@B@@@B@BB@@BBBB@B@B@@@BAB@BAB@B@@@@BBB@B@BABAB@B@@@BB@B@@@BABCDCFC@ADQBI@@@@B@B@DAHA@@B@@@B@@F@@B@@BBB@B@BB@@BB@B@@AB@@@BALELKBABCDCFADA@@F@H@HBF@F@JFFBHDHBB@HBD@BBB@DDDDDBBBBBD@D@@@D@D@DAB@DAB@B@B@BB@BBD@@BBDD@@BB@BAB@@ABADA@@B@@@@@BB@@BB@D@BBD@DAD@HAJAD@D@D@B@@BB@@BBBB@DBD@D@B@DA@@@@DDFBBBD@FBB@@B@@@@@F@B@B@B@@B@@@B@@@BABABCBARK@A@@BC@ABM@C@AA@AC@A@CA@@A@@@C@C@ABABCBABAF@B@D@DA@@BA@A@@B@DAB@@C@A@ABAB@DABA@@@A@ABADAD@B@BAA@A@A@C@C@AAC@@@EAEAG@E@@ABA@@BAFAB@DCDE@CAACA@C@A@CA@A@@@A@C@A@C@A@@ACA@AA@A@@@ABCBCBE@C@AAA@C@EDIBC@CB@BABCDIHEDIDA@GDCB@@@A@@BABAAC@@@AAEACAAACBA@ABA@A@A@@A@@AA@@@@A@@A@@A@@ABCBCDABAB@@@B@@A@AB@@@AA@@@ABABADADABEBCDA@AD@@CB@B@@A@A@@@@BA@@@@@A@@@A@@A@AB@@AA@@@A@@@@@A@@A@@@A@@@CDC@ADA@CB@@ADEDCBA@E@@@C@C@A@A@@BAB@DAB@FA@@B@FAB@@@DBHDJ@D@DAFADEBCBABA@A@A@@@AD@BADADABADA@A@@@A@AB@BA@@@AAAA@@@@A@AAAAA@@CAA@AA@@@AB@BCBE@M@CC@CDM@EAEASNOF@BQDE@@A@C@CKEIBEBAAAMCEGEKBGDA@AKCEAGCAEDEAEEMCGAE@@CBCBEFADIBKCKAKBAFAD@D@FFDFB@@@B@@A@@AGEMGCGKBKAIBY@C@CCCCBADM@GDK@CC@AKAGDEDACCG@MCG@CACAACC@ABEAEACA@A@E@EAIBE@EDEBA@AAC@ECGECCKCKECCECEACCIGGCGECE@CAA@@`GAKIIAC@EmJCAC@ABA@ABA@A@AAC@CAA@KAMAGAEACCCC@@@ACAUGMDO@WICAEACDCHCFCFA@@DCDADADAAABCA@@CBCCC@E@AEBEAC@@CAGDEDMDGFE@A@GEGEC@CA@BCACBC@E@CBAAABC@ABAA@@A@AAA@AA@@EMAC@@AAEAABGAA@A@@A@@@@OOC@ABEB@@A@C@A@ABCBABABA@@@IMCC@C@@@@AAA@A@@@AACEAA@@@A@AAA@ABC@CBCBCBABA@@D@BAB@@A@@BAJYDE@@@CAC@A@@@A@@BA@@@A@A@@@A@AB@BA@CBA@A@I@@FA@@BC@CBABAFM@@@@BC@A@@@@BA@@@ADG@@@ABADG@ABC@A@I@G@A@ABAD@D@@@@A@EBCC@A@GAA@KD@@A@AA@@AB@J@BCBCDCBA@@@ABA@C@MFC@A@A@ABCBADABABCBMDKDGBCBGDE@G@A@AAC@ECCA@AC@CDAHA@A@@AAG@@A@A@@@ADABA@GA@@E@C@C@A@A@@@ABAAA@@@CB@BCI@AA@@@A@A@ABA@ABEA@AA@@IAC@AACAE@C@ABCDCBABE@C@@AAAA@@@ABEBC@@@AI@E@@@A@AEACACCECCACA@E@A@AAAA@@AA@@@A@C@@AAAA@AAAAC@ABC@@@A@AA@@AA@A@AACAAAAAAAGC@@A@A@GA@AAA@AAACCA@EEAA@AAAAA@A@@AAA@A@CA@ACC@AA@@@ACA@@@ABABABABCB@BAB@BAB@@BD@B@B@D@BEBCDCDA@AAA@CAA@AAAA@@EBA@AAA@A@ABA@EAA@AACBA@@BABC@A@@@AAAAA@C@ABECAAA@AE@AA@AB@@C@A@A@ABAB@B@BDBBB@B@BB@B@BBDBB@DBBD@@ABB@BBBBF@BB@B@B@@@BB@D@BB@@@BA@CB@B@@FB@B@@ABA@@BABABAB@B@BBB@@@B@B@BAB@BABABAB@BC@CBG@A@@BABA@AB@BA@@@A@C@AAA@C@A@CA@AA@AA@A@A@@A@AA@@A@A@A@A@CBC@C@A@CAABCBC@A@C@EAE@@AAA@@ACA@CAAAAA@@C@AAA@CA@AAA@A@@@AAACC@@AAAACAAACA@@AAAACCAA@@@@ABAB@@A@@@CCAA@@AB@@AB@B@BAD@BC@@BABCB@@A@CAA@@BA@@BABAB@@A@AAAB@@A@@B@@@@AAA@A@A@AB@BAB@D@@@DCBA@A@A@C@ADBDA@@@CBC@@BA@@@CD@@@B@@BB@BA@@BABA@ABEB@B@DADA@CBADA@C@ABA@AB@B@@CBA@A@BB@DAD@BAD@@@BABIJABA@C@ABED@@EF@B@D@D@D@DADABA@@B@BABA@A@A@A@A@A@EBCB@DA@A@EB"],encodeOffsets:[[108123,37106]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
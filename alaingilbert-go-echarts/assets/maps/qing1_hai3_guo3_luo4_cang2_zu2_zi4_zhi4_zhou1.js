@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("果洛藏族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"632621",properties:{name:"玛沁县",cp:[100.238888,34.477433],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AB@BAB@BAB@@@B@BA@@B@@@BB@@BB@@BABA@A@C@@B@B@@BBB@@@@B@B@BADAB@B@@@B@@@BA@@AGCE@AAAACECA@A@A@ACAACAAA@A@@@A@@A@AAA@@ACC@C@EAAAAACCECCCACA@@A@CBABAB@BC@A@AACAACACEUCQ@A@CBCBEBABC@CAA@EAAACCAGGACCCCIAAACGK@ACGCIEEC@CBC@IACBEBCBEFAB@BAB@BCFAF@BEB@BABABA@CBI@ABAB@@A@uR]KoGc@aIOM@MX[MkCOeUKQQMO`MCA]YLUD]DQTKDMLmtCZUL@NCd]LORELKbU^GDuXKZMTSBWC[RMEV@@UVMBQMMB@Ndh_TIVOHMZDtBlB`DDTHJNEnR`KPQdCBCDAB@D@D@DBDFBFDBDBD@BBH@BBBBBB@D@BBHFDDBB@DAJAD@DCFEBC@SHCBEDKDEDCFADAF@FDFBHBDADHRO`E^NTLHAtJPBFLDHJ@JALDNQVHd@XKNcH_ROpeS_@OX[T]IUBWT[BaTW\\JLJHFDJFJFDBFFDDDDDDDDBDBFBD@F@BABCFABAB@@AB@B@BABAB@@@B@BA@ABABEBGDSR[ZIHMLORWZKNGHGJABGHMPKLA@EBA@AH@F@@DBBBBFBBFBFFBFBD@BBBDBFFF@BBBBFBBBDFBDDBFFFDFDDBFBFBF@H@F@FAFAFAFAHAHADCFAJAH@D@FBD@FBF@FBH@DBF@FBDBFBFBDBHBFBDBHBDBHBFDFBH@HFH@DBF@J@H@H@FAH@F@FAH@DADAFADCDCDCBC@CDCBE@CBG@EBC@CBCBCDEBCFIBADCFGDABCBABCBEDABCBCBCDEBCBADEBEBI@KDEBCBCBCDE@CDEDGBCDCBCBCDAHGDGDC@BFDDDDBDBDDLBD@LDFBFAN@DAH@R@N@HBF@HBDBHDDDDDBDDJDDDFBBHDD@DBBDBDDBBBHAD@FBD@DBHDDBHFDDBBDBD@DAJAB@B@BBDDBBBBDFFFDDDDDDBBFDDFBBHFFDFDDBHBDDFBFBHDFBDBFBJBFBHDFBFBHBFDDDBB@FADCDADCDCDEDCDCDCDCDEDEFCF@DAD@HADABAHAB@F@DAF@DFJBD@FDJBF@DABCDE@ODAB@BDDBDABCDGBEBIBEAGDMJED@@AAEACCCCCCCEAEAGEEEAEAE@G@CBEDEDEBEFCBEDEDEBG@EAC@E@G@CAGBE@GBEBIDEDCDEDCBEDCDCDCBEDCFCBCDCDEFGHEDCBCBEDGBGDCD@DAFCDAH@FBDDDDFBBBDDDDFBDFBDDDBDDFFBF@DAFADAFADAFCFADAFCFCFCDEFEDGHEDCBCDEHEDEDCHCBEDADCDADCBCDEFEFCDCHEDADEFCDCFCDCDCBADADEDAFADEFADADAFADCHAF@FA@CBGFAF@FABAB@B@B@BDBDADBLJ@B@FBH@DABIDCB@@ADCHAF@BBBBDBB@D@B@BADEDED@@@F@DAD@@ABA@A@@@AA@@A@A@@@CB@@AB@B@BBD@BB@D@FF@@BB@@@@@D@B@D@B@B@B@@@BA@@BCD@B@B@@@BA@@@@BAD@BAB@@@@@B@B@B@BAB@DBB@@BD@@@D@@BB@@BB@@BB@@@ABC@@@AB@BADABAB@BC@AB@B@F@@AB@BA@A@@@A@A@ABCDC@ABABBFBFBPBFBBBBBHNDFHDFHJDDBJFDDFFD@JBF@PDF@D@H@FBD@DATKFAFAJAD@JDJDF@D@DABADABEHEDAFENCFAHCBCDALGHAJ@DAD@HCJEB@DAB@BAB@LAFAB@FBD@B@DCBCB@D@BADAD@DCFAJAFADBJBDDFD@@D@D@LHHBJJ@@BBA@GBGDABGBCDEBEBCBC@ADCDABA@@B@BDBBDHDDF@B@BBBHBHDDDFBFDHFDBFDDBHDDBDDHBDFBBBD@F@H@DADCFADCDCFEFEFEFEDEFCBCBEDEB@@CBABE@GBG@GAG@K@EBGFCFABAFADEHADCBCBC@CD@D@BCNABCBCBABCHEHABCLADAFEJADAFAD@FAD@DCJ@B@FADADAB@BABADCHCFA@ABA@CF@BA@CBA@ADHV@DJ^J^BG@EBOBU@E@ADCBADC@A@AB@F@B@BA@ABADCDABABA@@@AAA@@@ADA@AD@F@FAD@HCDAHADCDCDEBC@G@C@C@CBCHCDCHADAD@FAF@J@FADAF@FCFCDCFEBCDABEDEDEBCDCFEBEDE@EDEDCDEDAFGFEFCHGDCHABCBCDC@CBADCD@FCBABC@@BEDCBAD@FBBBB@BAB@@A@ABCBA@C@A@ABCBA@@AC@AAA@A@AB@@@B@BA@@@A@@@AD@@@D@B@@A@AAC@A@@A@@@AAA@A@@@@A@A@A@A@AAAB@BA@AHADAF@HAD@B@H@BB@@B@B@B@@@BA@@@@BCBADA@AB@@@@EBGBCBCDEBCBABCBEDCBEDADIDCBCDCDCFED@FADADCDADCFADEBA@CDADCBADEDEBADCBCBCBG@ACCACAAACBCDAFAFBF@BADCHE@@BEHGDCF@FABC@C@EDCDCFEFCDEDEDCFANGFADAH@BBDAFAFAH@DAFAH@HAF@D@F@JBFBFBHBFBFBJAFAFAHCHCDAFADEJGFCFADAF@FATCF@HAF@D@J@H@HAFBD@HB@@D@FBJBD@FAFEBCBI@EFGBEHEFCHCDCDAJCH@H@D@DAHAJAHCHAJEJEFEBAFEBA@E@C@A@C@CDADAHEBA@EBCBADCLAF@JDDABABABAF@BAFGBAB@D@FDFBDBD@B@NGF@B@D@BADADCDADABC@C@ABADAJCDADEBCBEDAFCH@DAFBDDBDD@D@HBBBFCBAFCHAFDFFFFHFHBFBF@FCDCDAFADAFADAD@JDD@J@FCBAHCDAFCDCDCFEDAF@FDHBH@HCDAD@D@FANEHCHEHEHALAFAF@BBFBJBDBB@DAF@B@FBF@DAF@D@BAB@HAB@DABB@F@DBB@BAF@BBDHCFAD@FAHAHBFBFDFDDBFDLDFDHBDDFDDFDDFFDDFHDHDF@FBBBJBLBHDDBF@BDFBDBDFFNBHBJAJBFBH@N@J@FAJBF@FBH@HBHBLBHBF@FBJ@H@HAD@H@FAHCF@HBH@D@J@HBH@HBFAFAJAB@@@BBHDDBBBB@D@@BHBB@B@BBD@D@B@BBB@DBB@@BBBDD@@B@B@B@D@DBBBDBDBB@BBDB@ABGAE@E@EDCFCLALBD@D@@CBCCECG@ACG@CDAFABADCBA@G@AACACCCAIACBCyDU`AxAjIb_^SpuRGNQJAxKNUFiFCB@@B@BBDBBDDDDBBFDFDD@HDDD@BBBBBDBB@DDBFBBHBD@DBBBB@B@BA@@@C@A@A@A@AAADE@AAA@ABA@E@ABABABCBAB@B@B@H@B@DBDBB@B@@@BCFEB@B@@@BA@A@ABABA@AD@D@@A@A@@B@B@B@DFB@B@@A@@AC@@@A@@@@@A@A@@A@@ABA@@B@ACBA@@BDB@@@B@@@BA@EBADC@AFCBA@@@CBA@A@AB@@A@CD@`FNCZI^VnOXNJLRTPBfVdJPJPJ`CTSBM@WTwFKBgdWCiFMNYCahqB@BADCFCDAB@B@BBDBBBBDDDBBBBD@DB@ADAFAB@BA@ABABAD@@AACAA@CBAAC@C@GBCBCBGBC@A@C@@AC@AAC@CBE@A@AAA@ABA@ADCBABC@ABKDOAKAK@O@M@KEOEOAEMMCK@OEQGOEM@C@@A@CAECGECAC@EBC@CACACBCACAE@CAA@EBEBG@IBIAC@AAACAC@E@C@ECEAGCAECEC@@CA@CACACCCEAAA@A@ECCACEAE@I@CACCCCCAG@IBE@CA@CAAEEA@E@G@CAACACCIAC@AACACAEBCBEACAACAECC@@@CAAC@A@CBCBCBE@E@ECEAAECEEAC@CBCBAHGHEDAEGCCCEECCCGAEA@A@CFCBC@ACCAEAAAC@C@EACCCCAC@AAA@@@EDCFEDEFEDEFEDEDIHGDIFMFGDGFEFEDEHCFEHEHGFEFGBEFADCDEFCDCFCDEBCDEDCBE@E@EBE@EBM@I@I@I@G@E@GBG@K@E@G@I@I@IBQ@K@MAIBG@K@I@O@UBI@IAGBO@U@EBEBGDEFEP@FCBCBIBCBG@CAE@ECEAE@GAEAGAE@E@EAE@E@C@EBGBE@EBC@E@GBE@GAWAC@EAC@GAC@E@GAEBIBCBEBEBE@CBEBE@G@EBGACACACCAE@CBEBA@EDEDCJCFEACAECECCECCCCECEBEDCBCHGDCBC@CACAEEAGCGAE@E@CAE@GAE@EAC@E@C@EAEBC@E@EACCECCCAC@IBCAKEAGAMECBCBEBCBCBEBIHGBCDEFAFCHABCBEBC@GBC@C@G@EBG@GBE@EBEBE@EDCBGBCBEDCBIDC@EBGFEDCD@DDBF@FBD@FDJBF@HBJDHBFDFBDDHDHFDDDBDDDDBDFFDDDDDFHFBD@BBBDHBJ@F@DDDBFBD@DAJALADEBA@KDGDQNEBC@CAECGCECGEEECCEACACAEACCCACEACCCCCCCECECCACCEACAICCCECC@EAGAEAE@E@CAGAE@EACACACAEEAAEG@CEECEEGCECCC@G@GBE@CBEBE@EBGBCBEBEBEDEBEDIDCBCBEDADCDEBCBEBCDCBGDE@A@ABABADCBCAA@CACB@BCBA@IAE@CBC@CD@B@D@DGLABCDA@C@CAGBEBGDCDEHEJEBC@ABCBCDCBEFEFCBEDEBEBCBEDEDGDGFEDC@EBCDGBGDEBEDEBGBEBEBEBIBG@E@G@EBEBEBEBCBEBADCBEDEHEDCDCBCFEDC@GACCACAECEAAAEACCAAEEAEAIAGACCIAGCEAGAI@C@EBE@EBE@E@GBE@I@EBI@G@GDGDIDCBCDCBADEDGDCBCBEBG@C@C@C@EAECGCCACCEACAEAGAECEACAECEAECCCGAECEACEECACAC@C@E@EDGDEDCBCDEBC@CDCBC@@BBDBDDB@FAD@D@D@FEFCBCFEDCBC@E@A@EBI@CBIAOBEAEAECCAAAEACBCJODGBEBCBCHMDG@C@C@C@G@CDCBGBCFAF@BADCDABCBAB@FAJ@D@F@H@H@FBDBF@J@FBHAD@FAFABCACACACAGACCE@C@E@E@GAE@EAGAC@CAGAE@EAE@ECGACCE@CBA@EBABEAIFEBC@ACCAEAC@CBCBE@EACECEAGAE@E@G@IBAB@@EAGAE@G@EAE@G@E@E@GBC@GBG@EBEBGDEDIDEDCBCDCFILADCDGBE@CBIBEBG@CBC@I@GBEDCFCHADAFAFADABCFADEF@@A@ABCBA@C@C@E@A@CAAAAC@ECC@ECAABCBEBCBC@A@C@ABEFABA@GBQFCBADBF@FA@ABGBA@KCA@EAC@EAAAACCGCE@CBKBOAACAA@AACA@@C@A@ABGAC@A@E@C@@@@@DEDEBG@@B@@@B@@@@@BAB@@AB@@A@@@@B@@ADC@AB@@@BA@@@A@@B@@@@@B@@@@A@@B@@A@@B@@A@@@A@@BA@A@@B@AA@@@@@A@@@@@A@A@@@@BAB@@@B@@@AAB@@@@ABB@AACIGEGECECECACACCCAE@CAKAAEMAC@A@@@AAABADGHU@C@AB@FAH@DADADEBEAA@CCAAACBA@C@C@A@ACCCCK@ABCBABCCA@CDECCCACAAA@EBEDECCCC@A@A@@B@@@BA@@BABAB@@ABAB@B@DAD@L@BA@A@ABA@@A@@@@A@@@@AAA@@@@A@@AA@AB@@@@AA@@AB@@ABA@@BA@@AA@@A@A@@A@A@AD@@@AA@@B@@@@A@@@A@@AAC@@@@AB@@A@@@@@A@A@@BC@@A@AA@@BABA@@@A@@@AA@@@AA@C@@B@@AB@B@@@B@@@BA@@@A@@AAB@@@BAB@BA@@BABABABAB@B@BABABADA@A@@BCB@@A@@BC@@@A@@@@B@B@BA@@BA@A@ABCBAD@BABCBAB@@@BA@A@@DABAB@BA@@@@D@BABABA@@B@D@B@DADAB@@@BAB@B@BAB@@@B@@@B@B@BABBB@BAB@BAB@@@BA@A@@@@BB@@B@F@B@B@B@B@BAB@BC@@B@B@@@B@B@B@BADAB@BA@@B@"],encodeOffsets:[[101958,34726]]}},{type:"Feature",id:"632623",properties:{name:"甘德县",cp:[99.900923,33.969216],childNum:1},geometry:{type:"Polygon",coordinates:["@@WMGaaIQ@YaySNuWKgVsIGXOLeDcF@fKLoGMh_CEFD^qCIHjpg@HUTOPCDKN]FSDUGMJblKTWCM@_AMPOPeNCHCLIHU@aBmfY\\a@mIkHOWQKSKGSIAMGICYMBmJaAAEYCUJGPA@@@@L@DABADA@A@E@ABA@AFABCBABABA@A@I@FFDJDH@BHLBDBBDJDDBDHHDBBDBB@FBB@DADABAFAD@D@BDRFVBDBDDBBBB@D@@ABABADAB@@@DBDBDDDFBDBBBB@F@DDD@BB@BBB@@@@B@@@BBBDBBBBDB@B@B@FDBDBB@BDFBH@@AB@@A@@@A@A@CBABA@A@@@@@AA@AA@A@@@@D@BABAB@@AA@@AA@@A@@@ABA@@@A@ABA@ABA@@BA@@@ABA@CBABA@A@A@@@A@A@@@ADA@ABA@A@A@A@E@A@@@AA@@@@@BAB@@A@ABA@ABA@AAABA@A@@@A@@@A@ABA@A@AB@@A@CBCBA@C@A@@@ABABABC@@@@@ABA@ABCB@@@BAB@@A@ABADABC@ABAD@B@BAB@@ABA@A@@@@@@B@@AD@@@BA@AD@@@BCBABABABA@A@ABABABAB@@ABA@AB@@A@BB@@@B@@AB@@A@@@A@A@@BA@@@@DBB@@B@@B@@@B@@ABAB@@BBB@@@AD@@@B@B@@@@@BA@@B@@D@BB@@@B@@@B@@A@@@BB@@C@@B@B@BB@B@@@BB@@AB@@AB@BA@@BB@@B@@A@@BBB@@@B@@B@BB@@@@@B@@B@@@AB@B@BABK@C@CBA@A@AB@BA@ABAB@@AB@@A@@@@B@BDDDDCFAF@FBBDBDBDDCF@DDBADABAD@BDLDDBDB@D@D@B@DABBDB@DBBAFCFCBCBG@EBA@@B@DGVCHABBB@B@@@BBDFNBBBL@DBFDDBDBDFDFDFDFHJHBD@BAA@B@@A@BB@@A@@@A@AB@@@@@B@B@@@@@B@@@@BBA@@@@BAB@@@B@@@BA@@@@BA@@@@B@@A@@@@@A@@@@B@@AB@@A@@BCD@BA@@@@@@BA@@BA@AB@@@@A@@@A@@@AHCFCF@@@@D@F@B@D@HBBAB@D@@@DBBBB@DBBBAPAL@DDFDHBDBBFBD@FBB@LDB@HABAB@@EAEBCDAREHAB@BAFEBAD@B@D@DAFADABADB@FDD@FBDBBDBB@F@D@D@B@DABAB@@@FEBCDEBABCBEBEBCDGDEFCHAJ@D@DAH@FAJADAF@HADCBCJKDEDCDAFCJCFCHCFAFAH@HAD@HAF@F@H@F@FBH@F@HBFB@@BAJAH@F@F@HBFBFDBD@FAFAD@DBDBFDD@BADEFBJAFAB@FAB@DDFBDDH@FBF@FBFBH@DBDBH@FBF@H@F@F@DDFBDBHBDBDBDADEBEBC@GBEAI@E@CAEAG@G@E@C@I@EBA@ABADCBCDABE@EBADAHCD@D@H@D@D@DCHGNADADAFCHIPADBDBFBBDDBFBFAFBPAJ@DAJ@F@B@FADCDEFADEDEFC@C@C@EBA@CCCAAA@@ADCD@DADCFADCDCFCH@F@F@DBDBDFDDFFBFDHBDDFDFBFDDBFBFDHBFBDBFBDDDBHDFDFBD@D@D@H@FADADAHCFCBCDADCDAJCHCHCH@J@FAJ@F@HAF@F@FAF@FAD@J@HBFBHDJBDDHBJBFBFBBFDBBDBFBBDFBFBDDDHBD@FCDEDADCFCFGFCDABCFADAFAFAFAFAH@F@H@JAFAFAFAHAFAFCFAHCHADCFAD@FCHEHCFCFCDAFAFAFCDAFEFEDADCDABAD@FAFIFGDCHCFAHADBD@B@DCBAHK@C@C@ADCD@DAF@JBB@DA@ADADBB@DBDABCBABAB@F@HCDADCFADAFADCBCFCDADAJCFCFAFCFAFADAHAFAF@FADAF@HAH@D@DDDFFHDFFF@DFHBBFFDBDBDBFBF@HBDBF@F@FBHBFBD@FDDDJDDBFBDDDBFDFDDDDDDDBDDFDBDDFBDBDBFBDDFFHFFDHDFDDBD@FARMHCLCB@FABCBKBI@CACAECC@C@EAICGAA@AACGECECCCCEEACCCCCCACCGEGCCCEAECGAICGAE@IAECC@EAE@CA@CDCFCHEFAD@JCDAFCDAHADAFCF@FAFAF@HAH@FAH@D@D@HAD@FADABADGBEFEDCHAJGFADADAFADADANFHBFBBLAD@JBDDDFDDDFBF@D@FAFBD@F@D@FBF@HBF@DBF@F@HBHDFBBFBD@DADCDGHADCDAFDFDFDDFDDDDFBFBDEFIDCDCF@FABAF@DBFDDDBDBHBFAH@F@FADAF@FAFADAJAFAHBF@D@HBD@FBD@XBHBF@HAF@D@FAF@HAFAD@F@F@FBF@F@HBFBHBF@FBFDF@DBH@DAJADADA@EFOFEHCFAFAV@P@HAJBJ@VAP@J@L@H@JANBL@R@JAJ@J@H@F@L@H@HAF@H@J@J@J@N@FAF@FAF@F@DAFCDCFADCDEDCFEDCBCFEHAFEHEFGFGDEFGFCFEHEHCNEJEHCJGFCFCFEFCFEFCDEFC@@@@@AAA@ABCBC@CCCCACAAAE@CACA@AACAC@CCCAC@ABABCDAHELEFAF@BADCBA@EBCB@H@F@DABABCF@F@DCBABE@ADCBCDC@A@CBADEFCJEHEDEHIDEDE@GAC@CBEDAFABA@C@EDEDGD@BBFDDCDCDAFC@@HAFA@@@CAC@AAGAC@CBEBA@@HEDEFCDAACAEACBEC@ECGGCECCAE@EAEAE@EBAFCBADCDEBCBC@@@C@C@ADC@A@AAEECACACACCMAC@E@G@ECG@EBEBA@A@AAACAAA@AAA@C@AACKKCCAAAG@C@@DABABADA@ABC@AAGAC@CCG@CACAC@ACACCE@CAA@ECAAEAA@CACA@CCECAC@AACA@EBC@G@CAAAAA@A@ABCDA@ABADCBEDABABABABEB@DSdEJGNcNuUmJQF}C[IQOOMaEMKGU{MAKNOCI]JUMWEUKOSgEIEYq]OIOOEAAA@A@EAACA@AACA@@AA@A@ABA@ABGBCBC@CB@@A@CAA@WUYCLOCGQDY][IOJIVK@SILW[BWFUAGF@hI@YWS@MJGCDaKUDC^BFS\\CD]bIHK}sUi"],encodeOffsets:[[102664,34457]]}},{type:"Feature",id:"632622",properties:{name:"班玛县",cp:[100.737138,32.932723],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@ICICC@CBCBCBCAEAC@EBCBCDCHCBA@A@EECGEEKIGAE@EDCBADAHAJ@J@JADCAGECC@CCEEECAABEDEDQDOFIDEDGFEDEDEDE@I@GDEDEB@@ABGAEC@CBCHG@@LGLKROFIHUDK@G@EAIEGCCCAE@GBIHIHGDEBCBEDEDEBEBGAEEEGCIAKCI@IBKBM@I@GCM@GBKBEAECI@IFIDGFKFI@CA@@@BAA@A@A@@@A@@@@@@AAAABGDI@CBA@CACBCB@BAFABGDEB@@CAC@CBADABCBCB@@AB@@@@A@@@A@@AAA@AA@@@AA@@ABABA@@B@@@B@BBB@@@B@@@BA@@BA@C@A@@@C@ABE@A@A@AA@@A@@B@@AB@BBBBD@B@@@B@@@BA@C@@@A@@@A@@AAAAAC@AAA@A@ABA@A@@@@B@@B@@B@B@B@B@B@@@BA@AB@@AAA@CA@@A@CAA@A@@@@@AA@@@A@A@@AA@@A@@@A@@@A@@BA@@BA@@@@B@BBB@@DB@@BBBBB@@BA@@B@@C@@@ABA@@@A@C@A@A@@BA@@B@BAF@@ABA@@@A@A@@AAAAAAAA@AAA@@@@@ABAF@@ABA@@BA@A@EAA@@@A@@@A@@B@BAB@@@B@@@B@B@@@B@@DD@@@B@@@B@BA@A@E@A@A@@@ABCF@BA@@BBB@@@B@@B@BBBB@@B@@BB@@B@BA@@BA@@B@@A@@@AAAAA@@AA@A@@AA@@@AAAA@@AAA@@@A@@@A@@BA@AB@B@B@B@BB@BB@BB@BBD@@BB@@@DBBD@@@@@@@@ABA@A@C@A@@@A@@@@@A@@@@B@@@DBBAB@B@B@BA@@@@@A@@@AA@@A@A@@@A@@BA@A@A@A@A@AAA@A@@AA@A@A@@B@@A@@D@@@B@B@@BBB@BBF@B@@BB@BB@@@B@@@D@@@BA@@BA@@@A@AAA@A@@BC@ABC@@BA@@BAB@@@BB@@@@BB@@@BAB@B@@ABBB@B@B@FFB@@@@B@@@BA@A@EB@@A@A@@B@B@D@B@BA@AFCDGF@BA@@B@B@@@BA@@@ADA@AB@FA@C@ABADADCD@DADA@ABE@@@ABABC@CBCBBD@DCB@BAB@B@BADBD@DADAHCDA@CBAACBCBADABA@AD@@C@@@ACEECCAACBE@ABBDH@B@ABC@ADC@CD@D@F@BADCBABAF@DCD@BEBCBCBBD@BABEBEBADABAACAAAAAAAAAAAAA@AAAAA@AA@AA@AAAAAA@AAAACAAAACACA@@AA@@A@@@AAA@A@CACAC@AA@@A@@A@A@A@C@A@A@ABC@ABC@@@AB@@@@@BAD@@@B@@B@@BB@BB@@BB@@B@@B@B@@BB@BBB@BBBBB@BBBBBBBBBBB@@@BBB@B@DB@@B@@@B@B@@@BBD@BBBBBBB@@@BBB@BBD@@@@@@B@@@B@@@@@BBB@@BDBBB@@AB@@ABA@AB@BA@@D@@@B@BB@BD@B@B@@AB@@@@ABA@@@A@@A@AA@AAA@@@A@AAAACACCCACAGAE@C@CAAACCACACEAEAECCACCCCEAGAC@CCAAACEEEAC@CBABCBCDCDCBEBC@GAIBE@GBEBEBCDEBEBEBE@EBKFCBGBCBEBC@C@C@@A@CBABE@ABCBGACA@CAEACAG@E@EAE@CCAABCBC@CAAAE@CAEAECCCEAC@C@CBG@CBC@CCAAAACBCAC@CBCBE@CACACBEBEAC@EEMAECGACACCCBCBE@AAE@@CCACCCC@AABCBABABC@AAAAACAE@CBC@EDCBCBEB@D@B@DBB@DABCDCDAB@D@DABA@C@C@EAE@GAG@G@IBG@EDKDCBCBC@CAE@EAEAC@EBA@ADCD@D@BE@ABE@CACAC@E@@B@B@D@D@D@DAFCFCDCFCDEDCDEBG@AB@DBDBBBBBB@B@B@D@DBDBBBBB@D@B@D@D@DDBF@DBDDDFDDBDDBDBD@DBDDBDBDDABDFB@D@B@FAB@F@BD@B@BBFBB@D@DAD@DAH@BAF@@@FAFAF@HAFADCFADAJ@DCDABADCDAF@BAFAD@F@D@D@D@D@D@B@@ADADADBD@D@F@FBDBD@D@D@DAH@DBDBDBDBDBB@@@BABCBEBABEDCBCDAD@F@DADBDBBBB@@@B@BCBABCBCBCBEBCBA@A@C@AACCCAABAB@D@BABABABCD@BAD@BBD@B@DBD@BA@A@C@C@A@AB@BAD@B@BABABCBCBCBA@AAC@AACAAAACC@ABADABEDEBABCBC@C@C@AAC@CCC@A@ABCDBB@DDDBBDB@DDFBD@D@DDDBFAB@D@BBD@DABC@ABCBC@ABCAEAA@A@@@A@A@A@ABA@A@@@AB@@A@@@A@A@AB@@AB@BAB@@ABA@AB@@A@A@@B@@A@@@@BA@AB@@AAEACACACBC@CBC@A@E@C@E@A@E@CAA@CBA@ADADA@EBCBCBCBADAB@D@FAB@D@DAD@BABABADAD@D@D@D@F@DAF@F@BCDABABA@E@ABABABABCBABCB@@BF@BA@CBCBCBA@CBADABAD@BAD@DABABA@CBA@A@CBADAB@DAD@B@B@B@B@B@B@B@BBB@@@@@@AB@B@@@B@BB@@B@@@B@@@B@BA@@BABA@AB@BAB@@A@AA@@@@C@@@A@A@A@@@A@A@A@@AA@@@AA@@AA@@A@@@@@@B@B@@AB@@@BA@A@CB@@GCA@@@CA@@CBCB@BADAD@D@DAH@D@@ABABEBEBCHEFCBCBABCFCFA@@D@B@BADCBCD@B@DDJ@BAFADEJADCBABCBA@A@C@EAECI@AAA@AEGMAAAAACCAACAACAC@ABA@CBA@AB@@ABBDADABIHCB@BAD@D@DDJABABCAIBABABABCBCFED@B@BFH@DAD@D@DAB@DABABABEB@BAJ@D@F@BDBBDBDBDBDBDBJ@FDHDFFLBFBDAFBDBHBBFDBB@B@DBD@BBBFDBBDBBBBBFBDDDBDDBJFDBD@B@D@DAB@FDJ@DCH@B@B@BBBF@H@DA@FAB@F@D@B@BCD@D@BBBD@B@B@B@BB@BADCFABAHAB@BBBD@FBD@DABADCBABCBABABC@CBCBCHEDCFADAFAFCBA@ADCBAJ@B@JEDALAB@B@@@B@BD@BBDADADABCHAF@BDBBBBFBDDDBFDBBFBBFHFHFJDHHJHLBD@FBH@B@@@BCBCDAD@BAB@B@B@FBD@B@BDDBB@DBF@@D@B@JFDBB@B@HADABAD@H@B@@AFC@ABA@A@@@ADABABABC@CDC@A@CAC@AB@DAFBJ@@ABABEBCBADAHGFEDCDADGHGDADADEDCBCBCBC@AD@BAFCDCFABA@A@C@C@@AC@@@AB@DCDAB@BADCB@B@B@DFBBB@DAB@HAFABADABADEBAB@@A@ABC@@AEBEBC@@@ACG@A@ABA@ABA@ABA@@BA@@D@DBH@@@DBFFDB@BB@@@BAF@HDLDJ@DABABADCD@FAFCHGHGJGDEFKHIFCDAHBF@FADCDCBGDI@GBC@EAE@G@GBEDGDCDIDEBADEDEBEBEBCBADGBCBCBEDCDEFGDCDCDEDCDCFCHAHBFBFFBDBDDDBFDFDFDDFBFDHBDDHBD@FBH@L@F@J@FBF@F@HBF@J@FBF@B@FAB@DBD@DBFBDDHDBD@B@B@DBD@FBDBBDF@@BBBDDJBFBBBBBDHBFBBDBBBDBD@DBDBDBBDBFBB@HCBADE@ABCAA@@FABAB@@@BBBBBB@DB@@BB@B@B@@BB@BBB@DB@@@@JEHE@@BBFBBBB@BAB@DBLBJBB@D@BBB@DDDB@@D@REH@D@@D@BAJ@F@BBDDBJDD@B@D@JAD@BBD@DDBDBBDBBBB@D@B@D@B@@BB@BBDBDD@@BBF@@@B@B@FAB@B@@AB@BCB@BADBJ@D@BDDB@D@B@DBB@BBB@F@DBD@DDDDFDBBH@B@B@B@@@B@@@BADBB@@@@DDB@@BB@BBB@HB@@BBDBB@DB@@@B@B@BBD@BBD@B@BAD@F@@HFBBDF@B@B@DEJCDCFADAF@D@BD@JABBD@B@DAJEJAD@@A@@BCBA@CB@DCDAFC@@@ACCB@@CBE@EBAB@BABABAJCFADABADEDABA@A@A@ABAB@FAB@BA@A@AAC@ABADEBAAACAAA@C@A@ABABAB@F@B@DA@A@C@AA@AAAA@A@ABCDABCBC@CD@FABABABCBA@@FAB@BA@ACG@A@AD@@AFA@ABE@ABADADA@ABGBC@ABGD@B@BABCBAJCBCDA@A@C@AAAAACC@A@@BAF@F@F@B@@A@AAAACAA@A@A@ABCBAD@DAH@B@BA@A@ACCCC@ABEBA@ADABABEBCBAD@@ABCBAFAB@HBF@DA@A@C@A@C@ABCBE@AFAF@D@BABA@AACAEBAFAHCDBDFBDBBB@BBD@DBDDDFFFDBHBD@DDFDDBH@D@FBFBFBDDDBB@@B@BBBHHDBFHPJBBD@BABADEDCBCBEDEBCBADABAB@BCBA@ABADABA@A@C@CAOAM@G@ADABAD@BADAB@HBN@DBHDFBBBDBDDBBFBDA@ABA@CBA@AFCDABABADABAH@D@DAF@D@B@HFB@DAFCFAB@F@FBFBB@D@DADCDCBCBEDCBC@CBCBCBCDCHIBAFCBADCBAB@B@B@HDDBFBFBJ@B@BADCBEBGBCDCFEDCFCB@BA@C@ABABCFAJCFABABCBA@ABCDABAB@BBBFBBAF@DBD@F@BB@@@@@@@FCBCDCBCDCFCBADCBCFAJ@DABADAB@@BBB@DALBD@DBBBD@BB@@BD@@@@D@B@BCDCFAH@D@DB@BBFB@B@@@BAD@D@@@BB@B@@@B@BDBBB@@FBB@BBBDBDBBBF@@B@B@@BBHF@@@J@@BB@@@BADAB@@BB@DB@BBBBH@B@B@FADADABA@@@@AA@@B@@AB@B@BCBA@@BAB@@@@A@@@@B@@A@@BA@ABA@C@A@A@@@AA@AA@@BA@AAAA@@@@A@A@@AAA@@ABA@ABA@@B@B@@A@@@A@A@@BAB@@AB@BAB@@A@@@AB@B@@@BB@@BA@@DAB@B@DADABAB@A@@A@@BAB@D@BAB@@A@@B@B@B@BAB@@A@@BC@@@A@A@ABA@@@A@@AA@A@@@C@@B@B@DBBBB@B@BBD@HAB@B@BBF@DBBDDDBDDBDBF@B@LADBDBD@DBB@B@DABCBCBABAB@F@F@D@FBHDBBD@DAD@D@B@D@DDDDB@B@F@H@DBH@HAJA@@B@BAB@BBDA@@BBBBB@@B@B@@B@D@@@B@@@@@@@@@@AB@DA@@@@B@DBB@@@B@BA@@DA@@B@HB@@B@DAFABAFC@@B@@A@AAC@A@A@@BAB@PBB@B@@@@C@@BA@@DA@@@A@@@@ACAAAC@ABE@E@A@A@AICA@AACGACAC@A@C@C@ABC@ABAHIBA@AAC@ABAFGBCFCBA@A@@A@@A@@AAA@A@A@E@C@A@@@E@C@A@A@A@E@A@C@ABE@A@CBC@CBA@A@EAC@A@CDGBCBA@@@AAA@A@@BA@A@@@@EAA@A@IDGBIBEAECG@A@AFCBEBEAGGQAE@ADEBC@GEIGMAE@CHAHEDAH@XA@@NAHCFADCHCJAFADAFG@@HGDCHCJEDCFGDEDCLCJAFCFCBCDEAKAG@G@GDCFCJEHCDC@ECGAIAICIEQACBEDEFE@CACIECEAEACBGDG@IBGAGAG@IAMAKAGCGGGIIIGEECECEMSGGEEECG@GCEEIGCGEGECECIAM@KBKAG@ICGGEE@I@KBCDCBA@AAAA@@CABA@EBCBCB@B@B@DBDAB@BAB@BAF@F@F@BBDBB@B@BBB@D@BB@@BB@@@@BADAF@BABA@A@CA@@@@AAB@@@BA@A@@@AA@CAA@CAA@@@AB@B@@@D@D@B@@ABA@A@@@@@AC@@@AAAAAA@AAA@AB@@@B@@@B@B@@AB@@A@CAAAA@A@@BCBCDCD@B@@@D@@AB@@@DA@A@@@E@AAEAA@CAGAGCECOIIEIEMGIIUYUOEGGGC@EAG@G@A@C@ECIAEAEBCBE@CAIEMIYWIECAC@EDIREHEDA@C@A@GAECCBGBCDEHAFAH@DCBCBA@E@EBCAEBABCBABA@E@EAC@C@ADCBEDI@EBADADCBEBGDC@"],encodeOffsets:[[103255,33433]]}},{type:"Feature",id:"632624",properties:{name:"达日县",cp:[99.651392,33.74892],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AACCACAC@A@EBG@E@C@AAAAAAA@A@A@E@AACCACAACEE@CACCCCCECEACCACAABEBEBI@CCCGECCCAE@@BCBADABCDC@C@@B@FADCFAF@B@D@B@@@@@BAB@@@@ABA@@@@D@@@@@@@B@@AD@B@@BB@B@@AB@@@BA@@@ABAB@B@BCBA@A@@@A@@@AB@@@@ABE@G@EBEBA@A@AAC@CAE@A@E@CD@@CBADADABADA@CBC@A@C@AAAA@ACAAACAA@ABA@ADABAD@BAF@DADABADADCBABC@C@A@CAACCAAAAAACCAC@E@C@A@CAAACAC@EBADCBADCBADCBA@@ACAC@CBCDAB@D@D@DBD@D@DABADCDADAFCDCDCFEDADCFCBCBEBCBAD@DADADABA@ABCBCBC@CBC@C@CAC@CAA@ADAB@BABABC@ABE@EAC@C@C@E@C@IBABABABA@AA@B@@A@@@@@A@A@@BA@A@A@A@@AAAC@A@A@C@@@CDADA@A@@@ABAB@@@@@@A@@BA@A@A@ABC@@@A@@@@@A@A@ABEBC@A@A@A@A@A@ADC@CB@@AAC@A@@@A@A@AB@@AD@@ABA@ABA@A@A@@@ABC@@BABAD@@CB@@@D@@ABABA@C@ABA@C@CAA@ADA@ABA@ADAB@BCB@@GDAB@@C@A@AACBA@@@ABA@AAABA@@DA@ABC@ABCBEDABCBEDCBA@CAA@A@C@CAAACCECECECEACCCAAA@@A@A@EDABABCD@BADCBCBA@A@CAC@AAA@AA@CAA@CAC@AACAAAAC@A@C@C@CAC@CAE@CACBA@CBABABADABC@A@CAC@C@C@A@C@CBA@C@AAA@CAA@CACACACAAAA@ABAB@@@@BD@B@D@BADAD@BADAFAF@DAFADADADADADCDCDADCDABCD@BAD@B@DBBBD@D@DAD@DABBBBBBBBBBBBBABADABABC@CBC@E@CBCACB@B@D@D@D@D@DADAD@D@D@DBD@BBDBBBB@BABADCBCBABCBCBC@AAA@CAA@A@AB@BAD@D@B@B@D@DAD@D@BABCDCBCBAB@@@DDBBD@FAB@DCBADABADABABCBCB@D@BABA@ABA@CACBABEDCBABAB@BABA@ABIACBG@GBEBEBGBGBC@E@C@C@E@C@C@AAA@CCAAA@CAA@ABABABAB@@CBADAB@BAD@F@@CHABCBA@AAC@CACAA@A@A@CBCDABADAD@BAF@F@BA@ABABA@CAE@ICIAE@A@CBEBABABABADAD@B@B@@ABCAC@A@A@CACAAAE@CBC@ABC@ABCDCBCFABCBCBC@AAAAACACCAAA@@@A@A@CAACAC@A@C@CBC@A@C@A@A@ABABADADEBCBCBC@A@C@CBEBEBABABEBEBABAD@DABAB@B@B@B@BBD@DAB@DA@ABC@C@CBEBAAC@C@EAE@EAG@E@GBC@EDC@A@C@CBABCBCBE@C@EBEBCBAB@DABABADCBADCBCBCBEBE@EBE@CBCBADADAFCBA@C@C@A@E@CBCBABABCDADABAD@DABCBABCDCDABCBABABADEDCDABAB@BB@@B@B@@@B@B@F@B@D@@@D@B@B@B@BABABADCBCBC@A@CBGBCBC@AB@@@B@BBBABAD@BADCBABAB@B@B@BBB@DBB@DBB@DBB@B@BABBBBBBB@B@B@B@BABAB@BBBB@BBBBDB@B@@@B@BABABA@@D@@AD@BAD@FCDADABABCDC@C@C@CBC@A@CBABCB@BABA@ABA@C@AAC@CACAA@A@CBE@CBE@E@@BAB@BADBBADBDBD@BDBDBBBDBFBDDBB@BBBBB@D@BEFAD@DABAB@DCDAF@BAB@DBB@BDBD@DBD@DBBBABABCH@D@D@BBBDBB@FBDBB@DB@B@BABABABAFAB@B@D@B@F@B@B@FBD@D@DABABABABEDC@CBA@EBCBCBADCDA@ABCDAD@DAF@B@BAF@BADABCDA@EBE@EBC@ABAD@B@BBB@B@DBD@D@D@BBD@B@D@D@BBDBBBD@DBD@FBF@D@BBDBD@DBBAB@DAB@BABBDBD@B@D@BAD@D@D@D@DC@C@C@A@@B@BABABABCBABC@C@A@ABA@@B@B@D@DADBB@D@BBD@DBD@B@BABCBA@C@C@CACBC@CBCBABABABABCDA@A@C@C@CBCBADADCBA@A@A@C@CAABCBAB@BBB@B@BABABABAB@B@DADAB@DADCDABABA@A@C@AAAAAACAE@C@CBABABADAD@F@DBF@B@D@BDDBBDB@D@B@DBB@B@BDBBD@B@B@D@BAD@B@HAFADAFABGFEBEDABEBCDCBCBEBC@GDC@AD@BAD@D@BABADAH@D@FAF@FBDBBD@D@DBF@D@H@FBFBHBFBD@DBDBDBFBBB@B@BADABABCDE@C@CBC@CB@BAB@B@DADABEDCBEDCDEDCBCDCDCBEBEBCBAB@B@B@@A@@@@@@BA@@@AB@@@@ABABCBCDCD@@AB@@@@AB@@@@@BA@@@@B@@AB@BAB@B@@@@@B@@@B@@@D@BBD@@@BB@@F@B@B@B@@@@@@@B@@ABABAB@DCDCBCDCDABCDA@ABGBE@ABCBCB@BA@@@@@@@A@@@@@@@@@A@@@A@@@A@A@@@A@@@@@@@@@@BA@@@@@@@@@A@@@ABAB@@A@@@@@AB@@@@@@@@A@@@A@@@@BA@@@@@A@@@AB@@@@A@A@ABC@A@@B@@A@@@A@A@@@@@@@A@@@@@AB@@@@@@@@A@@@@@@@A@@B@@A@@BA@@@@@ABAB@@@@A@@B@@AB@@@@@@@@@@AB@@@@@@@@@BA@@@@B@@@@@B@@@@@B@@@@@B@@AB@@@B@@@B@@@B@@@B@@@@@BA@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@AB@@@@AB@@A@@@@@AB@BABAB@@ABA@@@AB@@A@@@@BA@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@A@@@@@A@A@@@@AA@@@@@A@@@A@@@@@@@A@@@@@A@@@@AA@@@A@@@@@AAA@@@@@A@@@@@@@A@@@@@@@AA@@A@AA@@AA@@@@A@@@A@ABA@@@AB@@ABAB@BABABAB@DA@@B@B@B@@@BBD@BBB@BB@@DBBBB@BBB@@@B@@@@@B@B@@@B@@CD@BABABABAB@BAB@B@B@B@BAB@B@BAB@@@D@B@@@BAB@BAB@BADADADAB@BAB@BAB@@@BA@@BAB@@@B@@@@@BCBABCDCDADCDADABC@CAA@AACACCAACAI@EAC@EBCBABADADABCDCFEBCDCDEBEDC@ADCBABCB@@@BBBBD@DBDBFBDBD@BDDBBDBBBDDBBBFBDBDBF@BBFBD@DAFADADCBABCDCDCDCBCBADAD@B@DAD@B@D@B@B@B@BBD@@DFBB@DBB@@@BBB@B@@AB@DB@@DB@DBB@B@DBFBD@DBDBB@BD@BBB@BBD@@@@FFBDBDBD@BBF@HADBB@JADAF@BAD@BCFAFAD@DABAD@BAD@DADAB@DCD@BBDABA@@@AAA@@@@@A@@B@B@@@@@BA@AAA@@@A@@@@@A@@B@@BB@BB@@@B@@@B@@@@B@@ABA@@B@@A@A@@@@@ABA@A@@@@@A@@AA@A@@B@@A@@BBB@@AB@B@@@@A@@BA@@@@D@B@@@@@@A@A@A@@@A@@B@@@BB@@B@@@@ABC@@AAB@@A@@BB@@BB@@BA@@@@@ABA@A@@@A@@@A@A@@@ABAB@@@B@B@@@B@@A@@A@@A@@A@@@@A@@@@D@B@@B@BB@@B@@@@D@B@@@B@@AB@B@@@B@BB@@@BBB@BBB@@@@B@@@B@@@@A@@@AB@@A@AA@@A@A@@@AB@@@BBB@@B@B@@@B@@@@@B@@@B@@BB@@@@@@BAB@@@@@B@@BB@@B@@@B@@B@@B@@@@B@B@@@@@@A@@@@BAA@@A@@B@@@@@B@@BB@@B@B@B@B@@AB@@@@@@@B@@@B@B@@B@BBD@@AB@@@BBBDBFBFBBB@B@F@@@BD@@@BD@@@B@@B@D@FAHAHCFAJEFCDCBDBD@B@@BBD@BBBB@BCF@B@BB@B@B@DCB@DCBABAD@B@@CBC@A@AD@B@@@BA@A@ABABCFE@ADAB@B@D@D@B@D@B@FBDBDB@D@@@FCF@B@FABAB@BB@D@BAH@DBFD@@JAL@JAJABABC@CBEAC@@BAB@@AFABCBCDEDEBCDGDCFEFEFAH@D@D@JBFBF@D@HBDDBBBDDBDDFADCDCDCAC@GAC@EBE@E@CBCBAFEFCDCDCDCBCDCDEDIBC@CBCDCBC@ED@FBFDFFFDFFBDFDFBHDJAFCDCFCDADADAHAFBDDDDDD@D@DBDBHDDDDFBDBF@FBFBFBF@JDDBDDDDDBDFFFFFFDDDDBFFFBF@FAD@H@D@F@DBHDDHBDHFFDHDHBJBDBFFDDBBBF@F@D@F@D@F@FBD@BDFDFBDBDFDDDDDFDJDHDHDFBDBF@FBDBFBDDDBFDFBFBHDDBHFFBFBFBFBJBHBH@J@F@F@D@H@H@HDFBHBJDLFFBFDFDFBFBFDFDFBFDDBFDDBFDFFDDHBFBJBH@HADCBEFABCH@@@HCBBABCD@D@HBBBBBBFB@B@@@DABA@CB@BA@@B@BCD@BBB@F@HBBBD@@F@BBBBBB@@D@BB@@B@DADAF@FADCB@H@D@B@@@@D@@@BBBBB@@@B@DB@BD@BBBB@BBB@@@B@B@DATGDEFCLCFCDATGD@FADE@CBCBI@CAACCGEAAC@A@AAAA@AAG@AACACECEAAC@C@C@CBADCDARcLOQ_FmIMSGCCA_AkCsNYPGJU`Scg@MNARNNAVU@@FUN\\QXDTANSLYvWHCV]LaFKPQ^KDc@MVKDYnsNKLCRS^CVCZKB^NDP_RNLRfVDPNlW\\@NPNbJd@pH^LvQB@@@BABAJ@DAB@BABA@AFA@ABEDE@ABA@ABAFEDAFADAJBD@DAD@J@B@B@BABADABABEB@BAF@B@B@BCBA@C@K@@B@HOVIZDBFbBnIAZNJDNHJBHTTLRLPXlGnJb@Z[nebAV@JGDKDGfMPONO`BN@XDLSakNIVHTC^ELMDCPOVSGh@ioJGrDC]FE`DNgpHLK@edEfCPKHWtJhUXLMvzTZbR@bJHbXNVj~tGLaJC^[DET]ACDLVCbHDNIT@ZXJ@@gHEVBXE\\AKXTJL@JUPI\\JZ^RCDHKPZDXVB@DBB@@@DAD@ACAE@AAA@@BC@CBA@@BCBA@EBABCB@BAFAB@D@F@B@BA@AB@B@@AB@DENKXGTIBUCIBQFQHKJGPELABADCFAD@DBD@B@B@DABAB@BADGBIDEDEFEDCHCFAB@BCDA@CGE@ABC@EBE@C@CBABABADANABAFCBG@_AUIMMGmOeGUEKCYCID@JLVENINIHQFOHMDUTIDWBSDMDSHG@BCBC@EBC@EBEBE@E@E@C@E@CAEAEEGACCGGGCEEEACEE@C@C@GBCBEDEDEDE@EDE@C@CBEBEBC@EBGACAEACAEEECCGECCCACCEECEAEAC@E@E@ABEBGBCBCDCBCDEFEDCBEFGBAFKBADGDGDGDEFGDCFEBCFEDCBCHIFEDCFCDEBCDCDEDEFEDGFGDCDCDCDCDCBCDCFEFEBCFEBAFCFEDEFGDCBCBCDGDEDCBEDCBEHIBGBAF@FAB@BA@A@AAC@A@CBABAHC@A@CBEBCDEDCFI@C@A@ACEAAGE@@@EBC@A@AAC@AAC@A@A@A@@CAA@CAAA@@GAA@AAA@@AA@CC@@@@AABC@A@@@A@@@A@A@AAGCACECC@CAC@C@EAA@AAA@C@A@CCAACC@I@CAABA@ADA@@BA@A@EBA@A@@@E@AA@@CCCAAAA@@AA@C@A@C@A@AACAAAACCCC@AAC@IBC@A@C@ICCAAC@A@EBI@A@CC@G@QFC@@@CACCA@AAC@A@IAKACAA@ABA@AAEAAA@@GFIF@@@@CAA@AAA@@AA@A@A@@AA@@CAAAAAA@@A@ABEB@@BBAD@BCFABGDA@EACAAAACAC@CACACAAACEAGAACAAAAAECIACAA@@CEAAAC@EAC@C@A@AACGCCCEACAC@CAA@EBA@E@EAI@E@GAE@E@EAI@E@K@G@EAC@GACCGAECEACCCECEAECCACACEEEAGAGBEDCDCDCFCDCDEHCFCDAFADADCHABADAFAFCFCFABCFCJCDCHAF@H@HBF@FAD@HCJAHCDCDEBE@GACBEDGJELCFIHGHGHEDEBC@CDABABCBI@KCGCE@AB@@A@@ACAEECA@@G@CAC@@@AB@@AB@BAB@BAB@B@BDH@B@@ADAFBF@@AD@B@BA@ABCFABCBABEBGBA@CBA@AACEA@A@A@CDABA@CBCDA@@B@@BD@@@D@D@BABEBCDEDABC@@BADADADCDCFCBCBGHCHCBCDEFGHCBABADAFAB@BI@EACBA@@BBD@D@BCD@DADABABCB@B@@@BAB@BED@BA@G@C@ABCBGBA@A@CAIEA@C@@@AE@CAACC@A@AAC@E@A@ABA@ABCDCDA@A@@@AAG@EACGKGICGEIEGEGAAAECAAECCACAEAACA@ABEDGBABCBCAC@AACA@@@A@A@KBCBIFA@I@ABCD@BABEDEBCBEBCDGFADAD@DADABABADABCDABCBC@EAC@AA@ABABGBADEBC@AAAA@A@A@C@AA@A@CDC@A@A@C@EBA@ECBG@E@AA@A@A@ADG@CCI@EBA@C@C@AACECAICCCACCEAAAAACAAAECAA@AAC@C@AAAECAAAGACBEACAEEKCECG@EAIACACACACACCA@A@E@CBI@AFABABABA@CBA@C@CBC@CEG@A@AFCDEDABABABAJADBBABACI@C@CBC@ADAJGBABCACBA@@BAB@DAB@BAD@DBBBBDDBBDBBBBHNBFB@BBJ@FDFBD@B@B@DABADABCFIBCBE@ACI@C@ADCDABC@A@A@CB@DEDEBADADAFEDGFAFABABA@@@CBG@C@CBCBC@ADADA@@@@@@@@BA@AB@@A@AAA@@@A@@BA@@@A@C@A@CDE@CBA@C@A@CACACBC@E@AAC@C@@@C@ACAAAAA@@@A@ABA@CBA@AAC@@@AEAEEAAAAEECC@AAABA@A@C@AACAAAAEE@AACACBCBIAEAGCEACACCAC@E@ABC@CBEHABCDA@CBC@CAICCAACAACAACCAA@CBE@C@CAA@ABCDABADCBCDABCDABEFA@CBEACACACACAACCGCAAEACAC@C@ADABA@C@AAACCA@CCAABAHAD@JABAB@DC@CBC@C@ABCBCBCDCDA@C@AAE@E@CBC@ADABCDABC@ACEAAACAC@CAA@CBE@C@AAEACCACCEAACCEAAAC@E@CBI@CAC@AACCAACCCA@@E@CBABEAA@A@EAAAABGDABC@CCAAC"],encodeOffsets:[[102162,33587]]}},{type:"Feature",id:"632625",properties:{name:"久治县",cp:[101.482831,33.429471],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBCBA@A@CB@@AB@@AA@@A@A@@B@@@BA@ABA@@BA@AB@@@B@B@@@BA@A@@@AB@BAB@BB@BB@@@B@B@@B@BB@BAB@@BBB@@B@@@B@B@DAB@BAB@@@BA@@@@@@B@@A@AB@@ABADA@A@@BA@@@BB@@@@ABCBCBEBA@A@G@AAAAA@@CAA@@BABC@A@@AA@@@I@@GEAA@@@A@AE@AACACAAA@AAA@EA@AAACA@@@A@A@@A@@@CBC@A@@@AEAAAA@@C@CBGDEDC@A@A@C@@C@@AA@@AACAA@CACBK@CAA@AA@CBABCBI@EBADCDABEDCDADCDADED@@@@@@A@@A@EAC@CBEAAAEAAA@ABCBAD@BABADABEBIDEBADAB@B@DABA@EDCDEFCDADAHAFCDABA@I@EAEACAGCA@A@A@ABCDABEDABGJCDADADAD@DADCDAFADCDCDCBC@A@EAEAE@A@EBEDCBA@GEA@C@E@CBC@G@ABCBABABCBED@BAB@DAB@BCBEAAACCCAAAEAGCCAM@GAA@CBABC@ABCB@B@HBNBP@D@D@BABCBAB@BABADA@ABCBABADCFAFADCDCFABABC@AAOIEGCAGGAA@A@AA@CACCEAEAEAC@G@CAECCCC@GACAEECECCCAC@AAA@AAACCECAGDEBABBFBD@BABABC@E@EB@BAFAD@B@D@B@D@BCBE@GAA@EBABAD@BC@ABADAFABCB@BABAF@BDDDD@B@BABA@G@CBC@ABAD@B@B@BBBBDBB@B@BA@E@E@E@AB@@@BDDBBBB@B@D@BCBADIDABADABA@C@AH@BADAH@BCBCBAB@BAF@BEB@BC@@B@BDH@BABA@EB@@ABADABABEBC@@DADADCBAD@B@BBBBBB@@B@D@BCBA@E@A@ABAB@B@B@DBBDBBBABCFAB@BBD@B@BABA@EBA@AB@B@B@BABCBCFABCBEBIDABABABA@AB@FAF@DA@DD@B@@EDCBCDA@@DABAD@@@BC@IBIFCBA@C@AAIBC@GDABAB@D@BBD@B@BABA@EBE@ABAHGJAFCDAFCDCFCHADADCDEHCFEFEDABEFADEFEFCDADCDCDCDCDCDEHCHEFCFCFCDADCFEDCDEFGJADCDEFADEFCDEHCFCHCHCHABELABEHAFCDEFCFADCDADADAHAF@B@F@FBDBFDFFFDDDBDDHFDDFFBFBDBFBDAH@FADAFAF@D@DCF@FCFCFCFAFAD@H@D@DFFBDFFDFHHDHBDFHBFBF@D@F@D@F@FAFAF@FAD@FADADH@TGNCTCXAJCVSNCPGREJGJMFMKU@IJCZDLDVFfHnPNHJNBV@`AHEDABMBCBABABAB@D@DAF@FAD@BHF@DCBADA@EBGDCDEFCFCFAJCHABA@ABCBA@A@C@CAC@EBCDABKBOFIHGLERARDJAVSJWHMLCFA@@BA@A@@BABA@E@C@A@EBABA@ADAB@FABAD@@AB@DAD@@BB@BBFBDDAHABAB@BAB@B@@BB@BD@BDBBB@F@BBBFBPPPJr^ZJFhFPTVLXFVN^IDJMPBL|NHVNLbFPNRP\\J~DREnIvVdMHMFITc@CFABABABABAFCDABCBAB@DCBAB@B@BBBB@D@HAD@FDBBBD@DBDF@DDBDBB@FBBBFDB@DBF@DDDB@BBDBD@DDH@DBDBH@BAD@BCBABABCB@@@DBHBBDDLLBD@B@DBB@BBBDBBB@B@BABAF@FDH@F@H@FBDDNBDBDBDFDBF@B@BCD@B@D@D@@ADADCFCDABEDAB@FBFBF@FBFDDDFHHFDD@@@BE@C@C@CDEDCFAF@JDB@D@BC@A@C@EDCDA@C@A@CDCDEFGBE@ABAD@DBBBDABABCFEDC@A@@DCDEDABAB@B@BAB@@ACCACACCAACAA@C@@@C@ADAFCDCD@BAD@BAB@@CAA@ABA@@DAB@B@@@DABCBCBCBABAFCDABAFAB@DAB@BABABA@CBC@ABABABCBCBC@CBGBG@CBABABCDCFCDABABCBCBEBCBCBCBCDE@ABADADADDDBD@DBB@D@F@BBDBDBBBD@B@BADCBCDEDEBCBCB@@DDBFBF@FADABABCBA@C@C@K@CBABCDADAFAHAJAJCNALAH@H@HBHBHDD@DBB@@AB@DABC@CAC@ABABAD@DAF@D@FBD@B@B@BADAFEHADAFBF@J@FABCBC@CACEEACAA@C@CDCBCDA@@DAD@DC@ABA@C@ABAFEDCDADETMLGB@BABBBC@A@ABC@C@@@CACACACAEAEACAEAC@EBC@CBCBCBC@CBE@EAC@AC@A@A@A@C@A@A@@AAA@C@C@C@AA@AAA@CACCEAACCCACAAAC@C@CBC@G@C@CBE@E@C@C@C@ABADABABAFCDAFABA@@AAAAAAAABA@CBA@AAAA@CACCCAAC@C@EAA@A@CB@BCFCDAFAD@F@B@B@@BAD@B@B@BBBDDDBFDDBDBDBFBD@F@B@DAD@DBF@D@F@BJDDBBBBDBDBBBBBDDBBB@DBD@DADCDADABADCBC@ABAD@DADADCDAH@DAB@D@B@FAB@D@B@DA@@DC@ABCD@FAB@B@F@DABBBBDBDBB@BABABA@CBAB@BBB@DBBDBBBB@BB@BADCDABABADADAD@@@D@DBFBF@D@BAHEFCB@BBBBDDB@BBBADABAB@D@DAF@FAJCFAFAB@DAB@BABADAB@D@D@D@FBHBF@FBHBHBD@DBFBDBFDBBBBDBBDBB@D@D@D@DAFAF@D@DBDDDDDBBD@BABAFCDAD@BB@@@@BBD@LDBBD@D@J@D@B@DBD@@@@@DDFBDDDDDDFDHBHDLDHBD@DABABABEBABABAD@D@DBD@B@DABADCB@D@THF@D@D@FAFCFGDCBE@E@G@CBABG@AB@FADADBB@FBBDDBBD@BD@DBD@F@DABABCBCBA@A@ABA@A@CAE@CACBC@AAAAE@CAC@ABCBEBADAHCD@FCF@BABABABABC@A@CDEBCBABC@ABA@AAA@A@ABCDCBABA@EBA@AAAAAACCCAA@A@A@ABCBABC@A@CAAACACAA@A@C@EBC@A@A@CBADABADABC@@BCAA@AACAE@A@CBA@C@AACACAABADCBABA@C@AACAAAAAA@C@ABCBEBC@CDE@EBC@CBCBCDCBAB@D@@ABC@A@AB@BA@@F@F@FBHBBDBBBD@BBB@BBBD@F@LCJ@DADAD@BADAB@D@BBDBBBBBDBB@BADABAB@B@FBBBF@DBB@D@BADADAHCFAF@DAD@D@DBD@FDB@BAD@B@B@B@BDBBBBBB@BBB@BD@B@D@BBB@BB@BBBDF@D@BBDDDBDDDBD@D@D@B@D@D@D@BBBDBD@FBD@D@B@D@BABABABC@E@ABE@CAC@AEG@C@E@I@G@E@GBKAGAGAECG@AAEAO@I@A@EBABAB@BAB@D@@@@A@@@A@@@@@B@@@@A@@@A@@AA@@A@A@@C@@@@@@A@@@@AAA@@@BB@B@@A@A@A@@A@AA@A@AA@BA@CA@@A@@CA@A@A@ABA@C@@AA@@@A@@@CB@AA@AA@@@AA@A@A@@@@AA@@@@@@BA@@@@@A@A@@@AB@@@@A@A@A@CB@@@@C@@@AA@@AB@@A@AAA@@@@@AAAA@AC@CAC@GBA@EAAAC@GAIACACAAAAGAC@C@CACAAACACCAACAC@@ACCEACCCAACCAAACAC@EACAACAACAACAA@CAAE@G@CAE@C@EAGCGACAEAEAG@C@EAEACACAC@C@CAGACBC@ADAFKFEDCJEDAD@BAFCDCDEDAB@D@D@BBD@D@F@F@J@F@J@FADADCBABGBAFAB@F@BADADAFADAFEDGBEBCBCAC@ACCCCCCACA@CACAAAAA@CBABC@C@C@CAE@ACC@CCGCCACAI@AAACAGEAC@CA@AAC@EBA@AAAAEEC@GACAGACCIC@@@A@CBAHQBCBCAAAC@ACCCCEEECGEGCAAECIAC@EABA@AFKBEBE@I@EBEBCAA@@AAC@CACCCAAAAEAAAICAACCCCACEEGAA@EACAAA@EAC@CBAB@D@BADEBCBCDCBABAD@BADABABA@E@CBABABAHABC@EFCDGFCFEFAFEFADC@CBABCBA@CBABCBABADCBCDAD@BADCDCBKBCBABAD@BABCBCBCBA@CBC@G@AB@@AJ@DABABABC@A@C@C@ABABAB@B@HADADADABC@CBCBCBAB@BABCHADEHGHABEDA@A@CAC@KEC@A@C@IDCBC@EAE@E@OBCBCBGDC@CBC@C@CACACCCAC@E@K@AAGBA@CAC@EECCCCAGACA@CCCAAA@ADADABABC@AAC@CBCBC@CDI@AAACC@AAA@C@C@CBG@E@A@IBC@C@ACAC@ECAACAA@A@@BABABADADAD@BAD@DAD@BADCBCBE@C@ABABCF@BEDABAB@F@D@F@B@B@BABEBCHABABE@ADABABBBAB@BA@@B@@ABA@@FAF@@ABABCDABCDC@C@AB@B@B@F@@@B@@CB@BB@BB@BBB@B@B@B@@ABCFABA@A@C@C@@@EBE@EB@@AB@BABADADEHAD@@CBABADAB@@A@A@CAA@ABA@CBABA@A@C@G@C@ABA@@@AB@BAB@@@@A@A@@@AAAAAAC@A@A@@@@@AB@BA@@ACAAAA@AAC@A@ABA@AAA@AAA@AB@@CAA@A@CDEBEDC@ABC@A@AB@@AB@@AD@@@@GAA@@AAACGCI@A@E@@A@A@AAABE@A@A@CAA@A@@@@@@B@BBBABAB@B@D@F@BAB@B@@@BA@A@GAAAA@ABEBE@C@ABAAC@@AAAAA@@AACAAAA@AAC@AA@@AA@CAABA@@BA@AAA@A@@BC@AAAAAAAA@A@CBC@K@A@CA@@CCAA@@@@DAB@@ABABA@C@@@A@A@@ACA@@AA@C@CBC@A@@AA@@CAA@@EAA@AACCCAGAEAC@AAAAAA@@@A@A@ABABABA@@AA@@EECI@A@@BC@@@@@ACAGGAC@@@@@ADABADC@ABA@@AC@@@@@@B@JBD@DBD@@@B@B@BAB@BA@@@@@EAA@AACAAIC@A@@@ABA@A@A@E@ABADCBABA@@B@NA@@@@BA@@AAAA@A@A@@B@DC@@BA@@@AA@AC@A@@@@B@H@F@F@@ABAB@BABC@CAAACAA@EACECAA@A@ADIDCBADAHADABCDEBC@C@CBCBE@C@C@AACA@CAC@AAAAAAC@CBA@CBAAC@CCACCCCCAC@C@ABCFKBCAK@CAEAAAA@@@@@@A@@@C@A@@@@A@AA@AAAA@@CBAAA@ABA@@@IBGBG@CAG@E@A@A@CCCCC@A@C@C@CBC@AAGCEAC@E@E@A@ABABADADCBA@A@CAC@CACAKBA@E@CACAACCCACCAE@AAA@A@GBC@AAA@A@AACAA@A@@@@D@@@BBB@@@B@@AB@B@B@B@@AD@@@BA@ABA@A@A@@@@BA@ABC@A@AB@@@BB@A@AB"],encodeOffsets:[[103434,33855]]}},{type:"Feature",id:"632626",properties:{name:"玛多县",cp:[98.209206,34.915946],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@\\AXSVA^J\\SPW`@fTPo`QdGLM@WGcRUCMBK@IGIKCAEIOBsKGMSF]P_GQBCACAGCE@EBEBCSHCBA@A@@@A@AAA@AA@AACA@@C@A@@AAAA@A@@@C@@A@C@G@A@CDEBE@CBCBA@@@AAC@@@AAAAAAE@@@ACAA@G@EAA@ADC@A@AB@@ADAB@BA@C@@@AEAAAAAAA@G@CDCBAAAGD@@G@ADEBAFCDGBG@IAEAGACCEEECCAECCAECEAECECEAEAECECEAKEICGAEAGCG@G@C@E@E@I@G@GAIAEAEAEAEAGECAGCEAEAECCACCEACAEAE@CAEAGCGCICECCCCCECACACCECE@AAC@E@E@C@E@C@EAEAACCEECAIAGAGCECGEACCGGCCAE@C@G@C@EBE@EAEECACCECEEEECECACCCCCAICE@EAEAEAE@CAEACCCCAGAC@C@CCCCCCCEAGBCBCBCBEDCDEDIBGCEAECACEEECEEECEAC@@FADCDAD@DADCJCFCDADCDCDCDEDEFABAD@D@FAF@FBD@HBDCDCDCDEBCCCAACAACCGAC@E@EAIAC@C@G@EBEFEFCDCHADCFCFADADEB@BA@AB@@BDAF@DADABIBIBK@IB@@ECCAG@ABC@A@@ABABA@E@ADE@E@@@CCACAEAA@C@A@C@C@A@A@CB@BEFADAB@B@BAB@@A@C@@B@BAD@DA@C@ABABCDA@CDA@A@A@@A@ADE@AAAAAC@AA@@@AACACCDEDIFEBGDGBEBC@A@@@@A@@AC@@C@@A@@@E@AAAEAEACAAA@A@@BA@@AC@A@AA@A@@@A@@@@@@B@@@@CDA@@@@BA@@AA@A@@@A@A@ABA@A@CBA@A@C@C@A@CBA@A@C@AB@@A@@@A@A@C@E@C@A@C@CBA@C@CBC@A@A@C@ABE@C@E@@@A@A@A@C@AB@A@@@@AAA@A@@@A@@@A@A@A@A@@@A@@@A@A@@@A@@@A@@@A@A@@@A@@@AAA@@@AAA@A@@@AA@@AAA@A@@@CA@@CAA@@@@@AB@@A@A@@@A@A@@@A@@@ABA@@@A@A@AAA@AAA@@@A@@@A@AB@@A@@@@@CA@AA@@@A@@@A@AB@@A@AAA@@AA@@@A@@@ABABA@CBA@@@C@A@@@A@A@@@@@AA@@@AA@@AA@A@@@ABA@@AA@CAA@@AA@A@A@A@A@@@A@A@A@A@A@A@@@A@A@ABA@A@@@A@A@A@@A@@A@@AA@A@@@A@ABA@ABA@A@@B@@@@A@@@A@ABA@@@A@@AA@A@@AA@CAAAA@A@AA@@A@@@AAC@A@A@@AA@AAA@A@C@@@AB@@@B@@@B@B@B@@@@@BA@A@ABA@ABA@AB@@A@@@AB@@@B@@@BB@@BA@@@@B@@@@A@A@AA@@C@A@ABA@A@@@ABA@A@CB@@@@AB@BA@@BA@C@@@A@@@A@ABA@AAA@A@@BA@@BA@ABA@A@A@@BA@AD@@@@AB@@A@@@AAA@@@A@@@@@AB@@@B@B@@@BA@@@CBA@A@A@A@ABCAC@@AA@AA@AA@@AA@A@@@AB@@@BABAB@@A@CAA@A@A@C@@BC@AB@@A@@@@B@@@@@B@B@BA@@@AB@@AAA@A@@@A@A@@@ABABABA@ABEB@@A@@@AAA@AB@@@@@@AB@BA@ABA@@@A@CBA@@@ABA@@@A@EAA@A@@@@@A@CDA@@@C@A@AB@@A@AAA@A@@@@A@@@@@ABA@@@AA@@@AAC@A@A@A@A@A@E@@@A@ABA@C@A@A@ABA@@@A@AA@@A@A@AAC@A@A@@@AA@A@A@@AA@@A@@@A@@BA@A@@@A@A@@@AB@@A@@BAB@@@BA@@@CA@@A@@@@B@@@B@B@BA@@BA@@@@BC@@@A@@@CCA@@@@@A@@@A@ABA@@B@B@@A@AB@B@@@@@@@B@@AB@@A@A@@B@@@@BB@@A@@@AB@@@@@B@@@BA@AB@@AB@@@@@BA@@@@BA@@@@BAD@@@@B@@B@@A@@@A@@@@B@@@@@B@@@@A@@BA@@@@@@@@B@@@@@@ABA@@@@@A@@B@@@B@@@@ABCB@@@@@@AB@@A@A@A@@@A@@@@@AB@@@@A@CB@BA@@A@@@@A@@@@@AB@@BB@@@@@BA@@@@@AB@B@@@BA@@BA@AB@@@@A@@@A@@@A@@@@AA@@@@@AB@@AB@@@B@@AB@@@@@@A@@@@B@@@@BB@@@@@B@@A@A@ABA@@@A@@@A@@@AB@@AB@@AB@@@@@B@@A@AB@@@@@@AB@@@@CA@B@@@BA@@@@@@BAB@@AB@@A@@@A@A@A@A@@@A@@B@@B@@@@@BB@@A@@@@B@@@@@@@BA@AB@@@@A@@@A@@@A@@BA@@@@@AB@@@BA@@@ABB@@@@BB@@B@@@B@@AB@@EA@BA@@@@@@B@@@@@@C@A@@@@@@B@@@@BB@@@@@B@@A@@BA@A@@BA@@@@B@B@@@@A@AA@@AA@@AB@@A@@@A@@@AA@@A@@@@@A@@B@@A@@A@@A@@@A@@B@@AAAB@@A@@@ABAB@A@@A@@A@@@@A@@B@B@@@@A@@@A@@@@@@BA@@@@@@AA@@A@@AB@@A@@@@B@BA@@@@@A@@@@@@@A@@B@@A@A@AAAB@@@@@@@@@B@B@@@@A@@@A@@@@BA@@@AB@@A@E@@@@B@@A@@@@@C@@@@@@@@@@B@@@@@@@BA@@@@@AA@@A@@@@@AB@@@@A@@@@AAA@@@@@@A@@@@B@@@@A@@@A@@@@@@C@@@@@AA@@B@@@@A@@@A@@@A@@A@A@@A@@@A@@@@B@@A@@@AA@@A@@@@BA@A@AB@@A@@AA@@@@@A@AB@@@@@@AAA@@@AB@@A@@@A@AA@@@@@@A@@@@@C@CAA@@@A@@@AB@@ABA@AB@@A@AA@@AA@@A@C@E@@@C@@@ABA@@@A@A@@@A@@@ABA@@@A@@@A@A@@@@@A@@B@@A@@AC@@@@@A@@BA@A@@@@A@@B@@@@@@A@@@@A@@@@@A@A@@AA@@@@AA@@@AB@@A@@@ABAB@@A@@@A@@@@A@@A@@@A@@@@@CB@@A@@@@@AAA@AA@@C@@@@@@@@@AB@@@@A@@@A@@@@B@@A@@@@@AA@@@@@@A@@@@AA@@@@@@@@A@@@A@@@@B@@@@A@@@@A@@@@A@@AA@@A@@@@@AA@@A@@A@A@@@@A@@B@@@B@@A@@@A@@@@@@AA@@@@B@@@B@@B@A@@@@@A@@@@@CA@@A@@@@A@@B@B@@@@@BA@@@@@A@@@@A@@@@@A@@@AB@@@A@@@@@@AA@A@@@@@A@A@A@@@@A@A@@@@@@A@@B@@@B@@@@@@A@@@@@A@@@@@@A@@@@AA@AA@@@@A@@A@AA@@@@A@A@@@@@@A@@@@@@@@A@@@@BA@@@@@A@@@A@@@@@A@@@@@@@A@@A@@@@@A@@B@@AA@@@@@A@@@A@@@@C@@@@@AAB@@ADC@@@A@@@@@@A@@@A@@@@@@@@@BA@@@@A@@@@A@@AB@@@@@B@@@@@@@@A@@@@A@A@@@@@@A@@@A@@@@A@@@@A@@@@@@@@@A@@@@A@@AA@@A@A@@@A@@@@A@@@@@@@@AAAA@@@@AA@B@@A@@@A@@@A@@@@@@@A@@@@@A@@@A@@B@@@@@@@@AA@@@AB@@A@@B@@@@@AA@@@@A@@@@AB@@@@@A@@@@@A@@A@@@@ABA@@@A@AB@@A@@@@A@@@@@A@@C@A@A@@A@@A@@@C@@@A@A@@@AB@@@AA@@A@@A@@@A@A@@@@@A@@@@@A@@@AA@@A@@B@@@@A@@@@A@@@@@@@A@@@@B@@A@@@@@@A@@@@A@@@@A@@@AB@@A@@@A@@@@@@A@@@AA@@A@@AB@@@B@@A@@@@AAA@@@@A@@AA@@@@@AAA@@@AAAB@@A@AAA@A@@A@@A@@@@@A@A@@A@@A@@@A@@@@@@@@A@@@@AA@@@@ABA@@@@AAAA@A@@BA@@AA@@@@@@@@A@@@@A@@@@@@@A@@@@@@@A@@AA@@@@B@@A@@@@A@@@@A@@@@@@AA@@@@@A@@@@AA@@@@@@@@@@A@@@@AAA@@@@AA@@@@@@@@@@A@@@@@@@A@@A@@@@@C@@@A@@@A@@@A@@@CA@@A@A@@@AAAB@@@@A@@@A@@@A@@@A@A@A@C@A@CA@@AA@@A@CAA@A@CACA@@A@AA@@@A@@@C@CAC@@ABAF@DCFADADEDADCFEFEFCFEHCFEHAD@F@HAD@FCH@FCFADAD@F@FAFAD@FAFADADCFABADGDCBCBEBE@GACAECECCCCCECCICIAK@E@G@EBCAEAACCCCEAE@CAE@CBI@EBGBIDCBCBCDCBCDADCDAH@DADCDADEDCDIBC@EBC@CBG@EBCBG@GBEBCFABCDAHABAHAHAD@DADAHCFAFAF@D@JAFAFBF@DAFAFCDCDCDADCDEDCFMHCBGDG@EBEBE@C@IBE@CBE@EBC@EBCDCFAH@DBD@DAFAFABAFABAFADCFCDGFADEDCDCDEBCBCBEBC@CDGDADCBADCDAFEJEFGFCBCDCBGBCBEBEAECIAE@EDEBCBA@@FADEHEHEJCDADBFDBJDDDBDDDBF@BBB@@B@HBHBB@D@F@DBD@B@BBDDDFDDBBDF@DA@A@EAA@AB@@ABADABC@AB@@@@BBDDDF@@@BED@@EBGFCB@B@D@D@FA@@FA@@HAB@BCBABC@C@E@C@C@ABABADAFABCBADADC@G@E@GA@@@@CDABCBCBA@GCEAABEDIBC@EBG@GDIBEBCBGDEBIBCBCBEBE@G@G@EBC@CAIACAGAC@GAEACCEACCCCAAC@A@@@ABCDAD@BABA@A@ABABAB@BCBABA@A@GBCBCBA@GDCB@@@BB@@@B@@BDFB@DD@@D@B@BB@BBB@FBD@BB@BBB@D@DBDAB@@BBDBBB@DBDBB@D@B@D@@@DBBB@@@B@@@BB@BBBDBB@BBDBB@B@B@BBBB@B@@BBBBD@D@BAD@B@BBB@B@B@B@BDDBB@BDB@B@B@B@BABABA@@BABAD@B@D@BB@@@BBB@BB@B@@@BABA@@B@@@BBD@BBD@BB@BB@B@@AB@B@F@D@BAB@@@B@@@B@HAD@BEBG@CB@@EDGBI@KDKBGBEBIBCBE@GFCBEFCBEDCBCDCBGBG@EAEACAG@GAOBCDEBEBGFEBCDGDCBCBCDCDCFCFAD@DBFDDDHDDBBBB@BAD@@B@HBNDD@FBHDD@BBDBDB@@BB@AD@B@B@@A@@B@F@D@D@HDDBBB@BBBAB@B@@DDBD@B@DBD@DBDBDHJDDBDBD@BBBBFBD@@BBB@@D@@@@ABCAC@C@E@C@A@OAEAC@@BA@AB@BADCBEDABA@ABC@EBCBC@CBEDCDKDCDABCBIBIBG@GBABGAGA@BCBC@C@G@MBMBEBC@CBABGBA@CB@@@C@AECACACAAAA@@@CAA@@CA@@@AAA@G@CBA@@FCBABA@@@A@CACC@C@GBIBGBOBEDADEHCDGFCDEFEBGBIBCBCDCHAD@FCLADIHAB@DAJ@F@FHNFFDFDDDDDDBBFFFHFBDBDDDDD@D@D@H@F@LBD@DBHDHDBBBDBD@HAJAB@B@B@BBBB@DBD@B@@@BBB@@@BB@@@D@DBBDD@D@B@@@@DBF@F@B@@B@FBH@BDJBDBB@B@@ABADADAB@BCDCDABADAF@D@B@HABC@@@C@@B@@BD@@LHFDB@D@@BB@DBBBB@HBDBBAB@B@DDD@B@@@B@F@B@FBB@B@B@D@HAFADALEBAB@LBDBHFXXd`ZXBBDBRLpZ`t\\BDFBDBBBBBBB@DCNAF@@ADAB@@@B@B@F@BBBA@@@A@ABABEBCFGDA@A@@BDD@B@@AB@B@@@DDBDDFD@@@BAB@BDBDDB@BB@B@D@B@BBBB@A@AD@BB@@DBB@@DBBBBBBBBB@B@B@B@@@@DAB@D@H@D@D@JFBB@@@FBB@B@F@BBBBD@BADABAD@@BBB@FBFBDB@DBDDD@BBBDHBD@B@FAB@B@@BB@DBBFFBDBBAB@D@D@BAD@DAB@@B@@@B@@B@@B@@@BBB@@BB@@BB@B@@@@B@@B@@B@@@@B@@@@BB@@@@@@@B@BB@@A@@@B@@@BB@@@@B@@B@@@@@B@@BB@@@A@@@@BBA@B@@@B@@BB@@B@@B@@@@B@@B@ABB@@@B@BB@@@@@B@@@@B@@@B@AB@BB@@@@AB@@B@@@@B@@B@B@@B@@@@@@@BB@@B@@B@BB@B@@B@BB@@BBB@@B@@B@@@@BBB@@@@BBB@@B@B@@BB@B@@BBB@@B@@B@B@D@@B@B@@B@@@BBBBB@BB@@D@@B@BBBBB@BB@@BBBBB@B@BB@B@@B@BDDBB@@@B@@A@BB@@BBBBBB@@@@BB@LP@BB@@@BB@BBB@@BBB@@B@@B@B@@@BBB@@@BB@@@BB@@@@@@B@@B@B@@@BB@BB@@@BBBABBB@@@@BD@@BBABBB@BBB@@@@@@B@@@BB@@@@@B@@BA@@@@B@@@@BBB@@@BBB@@B@@@@@B@B@@F@DBB@BD@@@B@@@@A@@@A@@@@DA@@L@@A@ABA@@B@B@@A@A@@B@@A@@@@@CBAB@@ABA@ABAB@@A@@BA@C@@@ABA@@B@@A@@@ABA@@@@@@B@@AB@@A@ABABA@@@@@A@BBAB@BB@@@BB@@@@@BDB@B@BB@@BA@AB@@@B@@@@A@@@ABA@@@A@BB@@BB@BB@@D@BDP@FBB@BBB@B@BAB@B@HB@HFFFDBF@H@F@DCBCDCBEDCDAFAFBDDFDBDDBHBD@F@LBHAF@HAJAH@FAHAFAH@FAFAF@FAHAHAHAHCHCFAFCFAFADCFAHCFAJCJAFAHAH@DAF@FADAHAFAHAF@F@H@FBJBD@DFFBDAHADADAFEDCDADAHCFAF@HAJ@F@F@D@FBF@FBDBDDDF@F@FBDAFAD@FAFAFAFAD@D@DAH@DAF@FAF@F@FAH@F@DAF@DBF@F@D@F@F@D@H@F@FBHDF@FBFBHBFBDDDDBFBDFFBFBJBD@H@D@F@H@D@FCJADCFCDE@C@EACAGACAEACACBEDCFEHAF@HAF@F@FAHBJADAJ@H@H@J@F@F@H@F@D@H@HBHBDBHBFAHEDEDEDCBAFEBADCFADAHCHADAFAHAD@JAD@H@F@DBFBFBH@FBDAFAHADADCDCFCDCF@D@HAD@F@FBD@FBH@H@FAFEFEFEDADCDADAHCFCFAFBHDDDFDFBDDHBF@FCDGDEFEFCHCBCDAF@FAH@HAF@H@F@H@FBH@HBJ@H@JBF@F@H@D@JAFAD@HAJ@D@FBFBFBHBFBFBFBFBHBFBD@F@F@HAFAF@FBDF@FBD@J@F@F@@BBBDBBDD@BB@@BB@DDBBDBB@D@D@D@BAFADADAB@B@@B@BBD@@DB@B@@@BB@BBD@B@DBBBJBBAD@BB@BB@B@B@BAB@DABA@@D@B@B@@A@A@@DA@ADA@ABAD@BBNF@@D@BABAB@B@BBD@DAD@@AB@FED@HAD@D@BBB@@BAD@B@BBDBB@BABA@@B@B@@@B@@BD@B@B@BDDBD@DBF@B@B@FBDBDDDDL@F@D@FFLBDBFBBBBBJBDBJBDDL@FBDDDDDDHDFBBDFFFBDDFDHBB@RBLAJELAH@HHJLFLDJHDJDDJBHF@HAHBJFBDHFDN@J@NBDADIFANALCTCDEAIEIEKMIAEFANEDKJID@HBFBDDBBDHBDHBDCDADGFCF@DAFAFAHADAF@F@FBHBJ@HBH@F@F@HAF@D@D@HBDBFBD@FBFBHDFBFBFDFBH@D@F@FAF@HALAFADCHCFAHCFCFADCDCDAFEFAHCD@FAF@J@F@F@HBL@FBFBD@FBHBFBHBJBJBF@JBH@LBFBF@F@F@D@FBH@HBJBDBHDHDDDDBDDFDDDBDFHBFDFDDBDDDDDDBFDDBDBDBFBH@HBF@FBFDFFHDFDDBFBF@HBH@F@F@F@F@FBJAF@F@FBLDD@HDHBFBFBHFFDFDDDHDDBFDDBBFDF@DBDBHDH@HBF@D@DBHBF@F@F@D@FADCFADAFBFDBHDF@FBFBHBH@HBDAJ@F@JAHAF@DAFADCHCFAFAFCDEBC@@BABADCHCBAB@D@FADADABABADCD@BAB@BA@CB@@@BBB@D@B@FAB@BBBBBBDBBBD@D@DBD@BB@@BBH@D@BBD@F@J@DBHD@@@BABABEFCDABABCBAB@@@@BBBBBBHFDBBBJ@HAJAF@DAF@B@DAHCDAH@DAD@D@FADAF@FAD@B@B@DBB@HCHADAD@B@DBF@FANAH@B@B@D@JCD@LBFBJDDDBDDP@DH@BADAD@DAB@@ABC@A@ADAFAJ@B@DDF@B@FABAF@BAB@DABAD@D@BAHAD@FBNBDBDBBBBBB@D@B@F@BBD@FB@BDD@@@B@B@@@B@BBB@@BBBB@BBBB@@@BB@DBBBDB@B@B@BBDB@D@B@@BBJBH@F@RAD@FBDBB@D@DBDBD@HCB@D@BABE@C@CBADCDAD@BAB@BBB@DDFDBDFBDBBBFABADA@@@C@A@ABABADBFAB@B@DCDADCJCHCHAPGFCHCLELCPGfQNCTI@ABA@@BABADAD@DCB@@@DAHE@ABABA@A@@B@BABGBI@AB@@AB@@AFO@@@@BCDIHE@@B@B@B@@BD@@@BAB@@@@A@A@@@AB@@AB@@@@A@@@AA@AAA@EAC@C@A@ABC@@@A@E@C@C@C@A@ICQEIAEAECGACACACC@A@AAG@C@CAAAEEGAAIC@AAA@E@@A@A@GBIBGBC@EBE@G@CBA@A@A@C@KCG@GBKBA@CI@AACACGEAA@CCC@AF@JCFCB@@A@C@ADAB@DADAB@F@DAB@FADAD@D@DBJ@@@DABALBD@F@L@DAF@J@FAD@BA@@@ACAAAA@@ABEBCDAB@BABAD@BADAD@FABAB@B@HBB@B@BABCDCBCB@B@D@DCAA@ACAACFCDABA@C@ACCACAAAC@EACAA@@@ADCBADC@@BA@@@@@@A@AA@@A@@A@BA@@@A@@@@AAA@@CA@AA@AB@AAAA@A@@@B@B@B@B@@@@@@AGCGC@@@@AAA@CAAA@@A@CA@@A@@A@@CAEAgEA@A@EBGEACA@ACCEAACAACCAACAAMMIEC@AAECGCECGCEAACCECEEEEEEEEECCKMAAGG@AI]I]@CGUBCB@DAB@@ADEB@BAB@DEDGBCBA@ABABCBC@E@ADI@CBC@EBCBEBCFIBEBCDKBAFGDGBADADABADM@A@CDCD@DADABCFGBCBEBADEHEFAL@H@HBH@HAF@BADA@@FAFCDADAFEFCFEFEFEDEDCBCDEBC@C@G@EACAACEGACCCAGCCAECCAGEECEACCGCGAAA@A@ACEGCACCA@A@AB@BADCBCD@DAFAFADCHABAHCHAB@AA@@IIGAKGC@C@@@ECCCIACAEBIBEBCDC@CBABC@A@ADCDA@C@EAA@EBKBA@ABA@CBA@IFGDC@CBI@GBKHCBADGDEBMDEFCBGFAFCBABCBC@E@ICICC@IBEBEBSLCBC@EAG@C@E@OCE@IAC@EECCIECAICEGGCCEGMAAAAEAOAEAEAAAAB@BCDAD@B@B@B@@@BABA@@BE@A@A@@BADA@ABCBABA@@B@@AD@B@@AA@@AA@@AA@@@C@@AC@@AA@CBA@A@A@A@A@@@@BA@ABC@A@@B@@A@@@A@ADC@AB@@A@@@A@A@A@C@A@C@@@@AA@@EEC@A@@AAC@A@ABA@@DA@@B@B@@@BB@@B@B@BA@@BC@C@E@@FCFCBC@A@A@CAAACAA@ABEDGBC@@DAJCBA@CAG@E@AKICACBCA@A@A@ABABA@EBEHEDAB@@EBEDGBCBEBCBCFEBCBEFCBCBCDADCDCDEDCFEBCFCDGDCFEFEDCDABCDCBCFCDADGFCFCFGDCDAFCHGFCFEDCDEDEBEBCDEBEBCBEBCBE@CAEEECCCACCEAACCECCACAACECCAC@EBGDCBE@CDCHCHAFCDADAFCHGFEDCDCDADEFCDADCDCFCDAFCDCFCJCFAHAF@HADBH@F@D@FBH@FAFCFCDAFEFAFCFCDAH@F@FBFBFFBHBFDFDDDDDDFBBB@@FCNIHCFBJAFAHADCBAACCC@ABAPCF@DCBA@CAECI@EACEI@CBE@C@EBABGBABC@GBC@CDEFEFCDCDCDCDCFCDCDCBCDCBC@EAACCECGAEAEAGCEAIAEACAEAGCEAEACCGACAECECGEAACEECAACCCCCCEECEAAAACCAAA@A@IBCBC@CAAACCGECAGCCAC@EAC@GBAACAACACCAC@GCAACECCCIACCCCCGCCAGAE@GAM@Q@G@CBM@EBEAKCC@KACCCACACCEC@ACDCHGHCBADADCDADCHCF@DCFADADADCF@LAJAFCFABADCFADADADCBAFADABADCBEHCDABEJADCFADAD@DAD@FAH@DAFCD@DADCDCDCDEBCBCBG@EBE@G@EBG@G@I@E@CAG@GEG@EAECGACAGACAEAGACAEAEACAEAE@CAG@EAE@EAC@EAC@G@IBEBCDGBGBEBEBEBEBE@G@E@EAEACAECECEECAACCEAAEAAAAAE@EECAAA@AACAEEEEAAAAEAACA@@@EBGB@FAB@LKNOHGBAHIHGLMXYPQNKJG\\YTQHCFABABAB@@A@A@@BABA@A@ABA@@BABADEBA@A@EACAEACCCCCCCCCEECAIEIEECIGIKX[bS"],encodeOffsets:[[101283,35287]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
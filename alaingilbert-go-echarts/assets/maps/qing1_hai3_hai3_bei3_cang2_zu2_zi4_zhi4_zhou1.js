@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("海北藏族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"632221",properties:{name:"门源回族自治县",cp:[101.611539,37.388746],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@C@E@IAGAI@ICIAICIAIAKCICKCKAKCKCMAUAEBIBM@M@G@E@E@IAG@G@GAGAIAMAE@C@C@K@MAKAIAICA@IEIGGGCEAAEE@@EAGCGCGBGBGBEDGBG@E@M@I@GBGAABC@EBCFGDGFIDIBIBKDGDGBIDID@BIDGD@BEDEDCDEDE@EBI@G@K@EBKBGDIAIAGBGFGDGFEDGFABGFGHIDIDGDKDIDKFIFEDCDABGDEDGD@@C@C@A@GBGBEDEBGDEDEDABAD@BADAHCDCDCDCBCBCBCBABC@CBG@GBE@I@EBCBEBGDEDGDCBADCBADA@ABEBEBGDGB@@CB@@@D@B@DABADADABCDCDEDABC@E@E@IAE@I@IBG@C@E@E@ABAB@B@B@BBBBD@D@DA@CBIBCBA@@B@BBB@B@B@@A@CBGDCB@@AB@D@BABC@A@E@CAEAA@@A@C@C@ACCCACCCAC@A@E@AB@@IBEBCBEBGBEBEDABA@CBC@C@CBABABAB@@@BADC@EAC@EAC@C@C@EBE@G@CBCBGBCBE@A@EAEACAAACAA@AAABABABADCDADCBCDADCBA@ABC@G@AAE@C@EBC@ABA@CDCBC@GBEBGBCBCB@BAB@DAD@BABABCAAACAAACACAE@CAC@GBC@E@A@ABA@@B@@@BBBBBBD@BAFEDEFADCDCB@D@B@B@DBBBD@DBHBD@D@D@D@B@DABCBCBCBC@@@C@C@CAABC@CBEBCBEBCBABCBCBADA@ABA@CBA@CAA@@@CAAAEAAAA@A@ABCDCBCFA@ABEBEBC@C@CAG@AACACAC@E@E@E@CBC@ABCDCD@@ABC@C@C@CAA@CB@@A@CBCBE@EBE@E@@@@@@@ABC@E@GBGAC@@@AAAACAE@GAI@G@G@G@G@CBE@E@C@C@ABCFGDC@C@C@C@E@EBABCDCFADCDAFADABE@CA@BA@CBEFEDGBGBABC@A@ABABADABA@C@A@A@CACACAAAA@C@ABA@A@AA@AAC@ACAAAC@A@CAAACCCEAAC@A@EBC@EDEBCBCBE@AAGAGAE@CBABABCF@BCFA@A@E@GACAC@E@E@G@E@C@C@C@A@EAEACBA@A@ABADA@A@E@AAA@ABGDEBGDABA@AAGAECCAE@MCG@IDGLEBI@QAOAMBA@EFAHBB@D@DA@ABC@AB@BCBABABC@A@C@A@CAEACACAA@C@GDEDEBKDCBIDIDGDC@CBABAB@@@B@B@B@@@B@B@@CDIDCDEDCBABAAA@E@EAC@EBCBCBCFCDABAFABCF@BCBA@C@CBGBCBA@AB@@@B@B@BBFBDDDDDFBB@@@@B@@A@A@E@G@CBC@ABABCDCB@BA@C@CAA@@BK@CBC@@@CB@@@@AD@B@@ABABCB@@C@AB@@@BABCFA@@D@B@@@B@@A@CDABA@A@@B@@@@BBBDDBBB@B@@AD@B@BBBBB@BD@@@@@@B@@@@AD@@@BBB@BBB@BNDB@@BHD@@B@D@B@B@@B@B@@@B@@BB@B@B@B@@CDAD@@@BBB@@B@BDGNHBDBB@B@HB@@BBB@@BBB@BBB@B@BBDFF@@BB@BDD@@BB@B@@@@@BAB@HAB@B@J@B@B@@@B@@BB@@F@DBFBB@BDDBBBFBB@BBB@BBBBBB@B@@@@@B@B@@@BDBDDDB@@F@B@DB@BD@BDDBBBBDDB@B@BDDBD@FBB@D@D@F@D@@BBB@@@BABAB@B@@@DA@AD@@A@@B@@@@@BAB@D@F@@@BABAFAD@DAB@BA@@B@@@B@@A@A@@@@DABBB@@@B@@BBBB@B@@@D@@@B@BAF@B@DA@@BAB@@@BABCFEBCDABAB@@@B@B@B@FB@@B@FBB@FBB@BAB@BAB@B@@@B@@@BBB@B@F@D@@@BBBB@@@BB@BB@@B@B@@@BAB@B@@@D@BBBBDBB@B@BA@@B@@@D@B@@@D@B@D@@@B@@ABA@A@A@@AABA@@B@BA@@BA@AFCBAB@D@D@F@B@BBD@@@BAB@B@B@BBDBFBB@DBD@D@D@DAB@B@B@B@BCDCBE@@BAD@FBF@B@DB@@B@BA@@BA@ABAB@BB@BB@D@@@DCB@D@F@BA@@@@@@@B@@@@@B@@@@A@@@@@@@A@@B@@@@@@@B@@B@@@@@A@BB@@@@@@@B@@@@@B@@@@B@@@@@@@AB@@@@@@@@@@@@@@@@@@A@@@@@@B@@@AAB@@@@@@@@@@@@A@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@A@@@B@@@A@B@@B@@@@@@@@A@BBA@B@A@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@BBA@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@B@A@@@@@B@@B@@@@@@@@@@@@@@@@@@B@@@@B@@A@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@A@@@@B@@B@@@@@@@@@@@@@B@@B@@@@@@@@@@@@B@AB@@@@@@B@@@A@B@@@@@@B@@@@B@@BA@B@@@@@@@@@@@@B@@@@@@@@@@@@@BB@A@@@BBA@@@@BB@@@@@@@@@@B@@@@@@@@@@_L@@A@C@@@cV@BAB@@@@G@@BA@@BAB@@@@@B@@@B@@BBA@@@@B@B@BAB@@@@@BA@@@AB@@@B@@@@@B@@A@@BAB@@@@@@AB@BA@@B@@@B@@A@@@@B@@@BA@@@@B@@@B@B@@AB@B@@@B@B@@B@@B@B@B@BA@@BAB@B@B@@A@@B@@ABADA@@B@@@@@B@@@B@BABB@BBD@DBDBD@BDD@F@@@@@DDDDFDDBDD@@DF@BDD@@@B@B@@@BA@@@@B@BBD@BBB@BBBBB@BBF@@ABBD@BB@@@BB@@@@BFBBB@BBB@@@BB@B@B@@BBDBB@DD@@B@D@B@@@BBB@B@B@B@DB@@@B@@AB@@@B@@AB@BA@CBC@ABA@E@AAA@@@AB@BCBABA@CBC@A@@@ABABAB@@E@ABA@@@AD@BA@@BEDA@@@I@A@E@@@ABABA@A@A@A@C@AAC@@@A@A@CBA@CBA@C@A@@@A@@@@B@B@@BB@@@B@@ABAB@@@B@@BB@B@D@B@B@D@@C@A@CBC@@@A@A@A@C@EBA@C@CBCB@@@B@@@@@BBB@B@B@B@B@@@B@B@@EFCBA@CBABA@ABAB@@@B@B@@@D@BA@ABA@EBABA@A@@B@@@@DBB@@B@BBBB@@@@B@@BBBB@BBBBBFBB@BB@@@@BBBBBBB@@BBBB@BBBFBB@@@@AD@@@BA@AB@@A@@BAB@B@B@@@@B@DBBBFBHBJBB@DBFABB@@@B@@@BBD@@@@@F@B@FBH@@BBBBHDBB@@B@B@DB@@BB@@B@@AB@@@B@B@D@B@@BDDBB@@FBBB@BB@@A@@@@B@B@B@DBHBB@BABA@C@A@C@ABA@@B@D@D@H@B@BABABABAB@FAB@DABADCBABABAB@BADAD@F@F@JAF@DAD@BADAD@D@B@FBF@BAD@FAHCFCFCHCDABAD@FBFBF@DBB@FBD@D@DAB@BC@ABC@A@@@AD@DAHCF@F@D@FAB@BA@A@A@A@A@ABABADAB@BA@ABA@ABA@@BAB@DBD@D@DADAFCFADAF@DAD@BADABAB@DAB@BABABCBADABABADAB@F@D@B@@@DFFDNFDDBBCF@DBDBHDDBBBHBDHFDDDBHBJBHDBD@DBF@DBBHDNDHDHFFFHFDBFDBBBDAD@BDDBBB@B@B@B@D@DAB@D@B@DBB@F@BABA@C@A@ABABA@ADABA@@D@BAD@BAFBD@FAHAB@D@DBBB@BDAFAF@D@DDBBB@B@HCFAJCDADADAFAFCFCHCFAFAHCHCJCF@D@FDB@FAJ@B@D@HAJCHCDAJAD@DAF@BADEBEBCBCBAHADADABAFCDAB@BA@@@A@C@ADEHKDGHIJKJK@@@@@@BAB@BAD@B@@@B@B@@@BAB@@@B@BA@@B@@ADA@@B@@@@AB@@@@ABA@@@@BA@@B@@A@AB@@@BAB@@AB@@@BAB@@A@@B@BAB@@@@A@@BC@@@CACCCCCCCEECE@A@GBEFALEL@HAXGLCLCbIXEREXEJAzMJCFAHGDEBADAHADADAFADABACEAIACBE@IDGBA@EBAB@N@LBNDNDHFH@DBDBD@FEBABA@@@B@DBD@@@BBB@@BB@@@@@@@BB@B@@BB@@BBBB@@B@@B@@B@@AB@BBB@@@BBB@BBB@@@BBB@@@@@B@B@@@B@B@@@@B@@@B@@@B@@@B@BB@@DD@@DD@@BB@@B@B@B@@B@@@BD@@@BB@BB@@@@BB@BB@BB@DB@@@@@@@B@@BB@@@@B@BBB@B@B@BAB@B@@@@B@@@@BBB@B@@@B@@@B@B@@@BBB@@BB@BB@@BBB@B@BBBB@@B@BB@@BBB@BB@@BB@@@B@@BB@B@@B@@BB@B@@B@@BBDDB@@B@@@@D@JDFBFDFBLBJ@HAJCD@D@FBH@HBHAFAD@LA@@F@B@@A@@@@@@@AA@@@@@BAA@BA@@@A@@@A@@@@B@@A@@@ABA@@BA@@B@@@@AB@@@B@B@B@@@D@@@B@@A@@B@BCB@@CB@@AB@@@BA@@@@@A@@@AAE@A@A@A@@@AB@@A@@BABA@AB@@@BAB@@@B@@@B@BABAB@BAD@@@B@@@BCB@B@@@B@BCBCB@@A@@@A@@@C@A@@AC@@AE@A@@AC@@@A@@@@@A@@@A@@BA@@@A@@@A@@@@@@BA@@@A@@BA@@@@@A@A@@B@@A@AB@@@BA@@@@BA@@BA@@BA@@B@B@@@B@BAB@@A@@@@@AB@@@@A@A@@@C@A@@@A@@AA@AAA@@@A@A@@A@AA@AAA@@AAAAAA@@@@AA@@AAACACAC@@@A@@@@@@B@@@@@@@@@@@B@B@@A@CBC@A@@B@BAB@B@B@@ABA@@B@BA@A@ABE@@@@@@B@@@@@B@@@B@@@@@B@@@B@@@@BB@B@D@@@B@@@@@BA@@BA@@B@@@BA@@@@@@@A@A@A@A@@BABC@@BA@@DA@AB@@@B@@@B@@@@@@@@@@@@AAAACAA@A@@AA@ABA@@@AA@@A@@B@@AB@B@@@B@@@B@BA@@BA@A@@@A@@@A@@BA@@B@@AB@@@BAB@@@B@@@BA@@BBB@B@@@B@B@@A@@B@@A@@B@B@@@B@BA@@B@B@BBB@D@BBB@B@BBB@BB@@B@@@@AB@@CAA@AAA@A@ADCB@@A@ABA@ABABA@A@A@AB@@@BAB@B@BADCB@DABABCBCBA@ABBD@B@BAB@B@BA@A@ABAB@@ABA@@@AAACCAAAA@ACACCA@CCAEACBE@ABA@A@@BA@A@@@AA@@A@@@@@A@@B@@@@AB@@@@@BA@A@@A@@AA@@ACAA@AA@AAACCAC@E@E@C@@A@@@A@@@A@@@A@A@AA@@AAAA@@AAAC@E@C@E@A@@@AB@@@BA@@B@BA@@B@@@DBB@D@B@FBHBDBB@DBBABABADABAD@DAFAVITIPIDAJCBADCDABABC@G@ADCDABA@@@AB@@A@A@ABA@@@@BCB@@A@@@A@@@A@@BA@@@A@AAA@@AA@AAA@A@@BABADABABADABADA@@B@@A@@B@D@B@F@B@B@BBB@B@@@BA@@BAB@B@BAD@B@BBD@B@BAB@B@@@BAB@@@B@BAB@B@D@B@BAD@DAB@DAB@B@FAB@B@@@@AB@@A@ABA@@BAB@B@@A@@@@BA@@@AB@@@BA@@B@@@@ADAB@BA@@BA@@BA@@B@BAB@@AB@@@BAB@@AB@@@BA@@B@@@D@B@D@B@B@D@B@BAB@D@B@D@B@B@D@B@B@D@B@D@BA@@@A@@AG@C@@AA@A@AAC@ABA@ABAAC@A@A@A@@BA@@B@@@D@B@BAD@DABA@A@@@@@AAA@@@@@A@@BABAB@BAB@@AB@B@D@B@B@BADADADAFEB@@@B@@@B@D@B@B@BADA@AB@BAB@@@@A@A@AA@BC@@@AB@@@B@DAB@DAD@B@BA@@@ABA@A@@BAB@B@B@B@BA@@B@@@B@@A@@@A@@@AB@@@DAD@B@BA@@@A@@@AB@@@B@B@FAB@B@B@B@BA@@BA@AB@@@@@@@DAB@B@@@B@BBJBB@B@@@B@BABAB@@AB@@ADA@@B@B@BA@@BA@@BA@@BA@@B@BBB@B@@@B@@@@AB@@@B@B@@@BA@@B@@@@@BAB@B@@@B@@@BA@@@A@@BA@@BA@@B@DAB@B@BA@@@@@@@AA@ACGG@AAA@AA@AC@CBC@@BABABABADCDAFCB@DAFCFEDA@@BA@@B@@@B@B@B@@A@@B@BA@ABA@@B@@@B@@@B@@@B@@A@@@@@A@@@@@AAA@A@A@A@@@ABA@A@ABC@@BA@AB@@ABA@A@@@C@A@C@A@@BA@@@@@@BAB@B@HAD@FAB@B@BA@@B@BAB@@@BAB@@@B@BA@@@@@A@@@A@@B@@@@AB@BA@A@@@A@@BC@C@@BA@@@ABAB@@A@@BA@@@ABAB@@@BA@@@@B@@@B@@@BB@AB@BADAFABA@@B@@@@@B@@AB@B@BAB@B@@@DBD@BBD@DBB@@AB@B@DABAB@B@@AD@BAB@@@BBB@BBB@@@@@BABADCB@@ADADCD@BA@C@@AA@@BA@AFE@ADADADCBABA@@@@BAB@@@B@@@B@BA@@@@B@@A@@BCB@BADEBA@@@@@A@@B@@A@@@@@C@@B@@@BA@@B@@@DADABA@@B@@CBAB@@AB@B@@@D@B@B@@@DAB@D@B@DBD@BBB@@@@@BB@@@@B@B@B@B@BAB@BA@A@A@@AA@@BCDABCBCBG@A@AAAAC@@@AA@@A@@@@@@B@@@@@@@@@B@BAD@DAB@@@B@@@@A@@@@@A@@@@@A@@@@BABABABAB@BA@ABA@A@@@@@A@A@@B@@AB@BA@@B@@@B@@@B@@@BA@@BC@@@A@A@A@@@@AA@@A@@A@A@@BA@@@@B@@@@@B@B@@@@@@AB@@@@@@AB@@@B@BAB@B@@A@@BA@A@@@A@A@AB@@A@@B@@@@@@A@@@C@A@A@A@@@C@A@@@A@@@A@@@AB@@A@@BA@A@@@A@@@@@@A@@@@@@@A@C@@@A@CB@@A@@@A@AB@@C@C@A@@@A@@@ABA@A@A@AB@@AAA@@@A@A@A@@@ABA@@@A@A@A@@@I@C@A@CBA@@@AAA@@@AAA@EA@@@@A@@@A@@@@@A@@@@@A@@BA@@@EB@@A@AB@@A@@@@@CEEC@AAACAA@EAC@C@A@CBA@@A@@@A@AAA@@@@A@@@C@C@CBEAMAEAA@@@AA@@@@@@A@@@@@@@A@A@@@A@A@AA@@CA@AC@A@A@@@ABCBEBA@@@A@@@A@@@A@@BA@A@A@A@@@A@AB@@C@@@A@ABAA@@A@@@@@AAA@@@@A@@A@@AA@@@A@A@@@AB@@C@A@A@@@@A@@@@ACAA@@CA@@A@@ACCCCACB@@@C@EBEBEBEDEBEDEBG@A@EBC@EBC@ABIAEBCBCDCBGBC@@@C@CBCDCBCBA@CBGBC@CBG@A@E@EBG@CBCBA@CB"],encodeOffsets:[[104847,37998]]}},{type:"Feature",id:"632223",properties:{name:"海晏县",cp:[100.99426,36.896359],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAGCCAECA@GCG@E@GCGCGAEECCECIAKCIAAAAAAC@EFEBCAA@A@AFEHGHIBC@ADEBC@ECC@CBEBGBCAC@E@ABC@AA@ABCBA@BDDFCFIHGFGDMDWJMLEJEBCBGDEBE@KEQIECUESGSGMCMAU@_BWBKCQESCYDID_ZGFGFABiLTEBÅ^}PɅªBLĞǸBF@@BB@@BB@@AB@BCB@@@@ABABC@ED@@A@@B@@@D@@@BABA@@B@B@@@B@@@@BB@@@D@@@@B@B@F@DBF@D@@@BBB@D@B@DB@@DBB@BBB@BBD@@@B@DDDBD@BBBB@@BB@@BB@@DDDD@B@D@@@@@B@B@BAB@D@@@B@BB@@BBB@@FD@@B@@BBBHDBB@@BB@@BDB@@B@@BBBB@@@BB@BB@@BBBBBBDB@@@BB@@B@BBBB@BB@BB@A@BBBDB@@BA@@BBB@BBB@B@@@B@@BB@@@BAB@B@@BD@@DBBB@@@@AD@@@FBB@D@B@B@@@DAB@@BBBDBBBB@BBB@B@@@BAB@B@BBB@@@BDB@B@@BDB@@D@@@@DBB@B@@@BBBB@@DBB@@B@@BBDB@@BBFDBBBBB@BB@@DAB@@@B@DD@@@B@@AB@@@@@B@@BBBBB@@@BBDB@BBB@D@@@BB@D@@@B@@BF@B@B@@BBB@B@B@B@BB@@B@BB@B@BAB@@@BAB@B@BA@@BA@@B@B@D@BA@@B@@@BBD@B@H@D@B@@@D@B@D@@@BA@@BABAB@B@BAD@BB@@BAB@BBB@D@B@@A@@B@@@D@@@B@B@J@DBD@B@B@@BB@@@B@B@B@@@B@B@@BB@@@B@BB@BF@@BB@B@B@@@BB@@@B@@B@@B@@DA@@BB@@@BBB@@@@BBB@B@@@B@BB@BB@@@B@DBD@B@B@BB@@B@BBB@@@BB@@BBB@@@B@@@BBF@@B@@BBD@@BB@@@BB@B@@@BBBB@FDB@@@BDA@@@@@@DABBB@B@@@DBB@@@B@BAB@BA@A@@B@@@BB@@B@B@@@D@HBDA@@B@@A@C@ABA@AB@@@@@B@D@BCD@BAB@BCB@@AB@B@F@BAD@B@BABAB@BAB@B@BABDD@B@@@BBD@B@BAB@B@DBBAD@@AD@@BB@B@@ADAB@B@BB@BD@@ABAB@BA@@B@BABA@AB@@BD@@@B@B@BAD@@@D@@CBABAB@D@BAD@BAB@B@B@@@@BBD@@@BB@BBB@B@BA@@B@BAB@@A@@@AB@@AD@BAB@BADAD@B@D@@@B@@ABAD@@@BBB@BA@@BA@C@C@@BCBA@CA@@A@@B@BAB@@@BB@@B@@@B@BABA@AB@BA@@@@BB@BBDB@BBB@B@@@@B@B@BB@@B@B@BB@@@B@B@@@BABABABAFADAB@BABAB@BA@CDA@@BBB@@@BAB@@ABA@@@AB@@@BBBBB@B@BB@@B@B@B@@@BA@@@ABAB@@CDAB@B@BA@@BADA@CBABA@@D@@@HAB@@@@C@@@AB@D@@@D@@AB@D@B@@BBAB@B@BAB@@@DA@@BEB@@A@A@@@@B@BAB@@@@A@@@@BB@@B@@@BB@B@@@@@@B@B@B@@@B@@@@D@@@BBB@@B@@@D@B@DADBBA@@B@@A@ABCA@@A@CB@B@@ABBBAD@BCBABAB@B@@@B@B@@AD@B@@@@AB@@A@ABAB@@@DAD@B@BA@C@A@@@@BAD@DABCF@BA@CBEDA@CB@@@@@B@@@BBDBB@@@D@@DBD@@B@@@B@@@B@B@HDD@B@@BBF@B@BB@@BB@@B@F@B@B@@@@B@B@B@B@@AB@B@B@BBBBBFBBBB@BDHFBB@@@@BAB@DAB@@@DA@@B@@AB@@@@AB@@A@@BA@@BAB@@AB@BA@@DA@AB@B@@@BA@@@@B@@@B@@@@AB@@@@@B@B@@@@@BA@@B@BAB@B@@@B@@@B@@@B@B@B@@@@A@@@@DAB@BABA@@B@BA@@B@A@BA@@B@B@@@@@@@@@@@B@B@@@@@B@B@@@@@BB@@@BB@@AD@BA@@DBB@B@B@@AB@@@B@B@B@@@B@@ABAB@@ABA@@@AB@@@@A@@BAF@BBBAB@DBBAD@@AB@BABA@@B@@A@@@A@AB@BAB@B@BAB@@AF@B@@@B@D@BAD@B@@@B@@@B@@A@@D@@@@AB@@@B@@@B@@@DAB@@@BABC@CB@BABAB@LCB@@A@A@A@@@AAA@A@A@A@@@A@A@@B@D@DB@@B@B@@@B@BAB@DADA@@DBB@@BB@DBHDB@@@FAD@@AB@B@B@B@FABAB@D@BAD@B@B@@BDBDDB@B@B@B@B@B@BAF@B@BBB@FBD@B@D@@A@@BABABABA@A@A@@BAB@B@BA@@@A@@AAAA@ACA@A@@DCDA@ABA@@@@@@BA@@BC@@@AB@@A@@@ADC@@@AB@@A@@@A@@BA@A@A@@@A@@@A@@@A@AB@B@B@D@DBDBB@BBB@D@D@B@B@BA@@B@BB@@BBBBBBBA@@BABA@E@@BAB@B@DBB@@@BABABA@@B@FAB@@@BADEBCB@@C@A@@@@BA@ABC@A@A@@AC@C@@DEBA@@B@F@D@H@@@BAB@B@@@B@@@@A@@@C@A@A@ABA@CB@@@@AB@AA@A@@BA@AB@BA@@BA@@@CBA@@BABBB@@@BCDABADA@@@A@@@A@A@AAA@A@AB@B@FAB@DAF@BABA@@DCFCBABA@@B@@@@A@AB@B@F@@@B@DAFAFAF@D@@@AA@AB@@@BCDA@ABC@C@E@C@CBGACBE@CBABABABACAAAA@AA@A@E@C@C@A@A@ABA@A@@AA@AAACAAA@C@A@C@C@C@EAAAAAAAAA@AACA@@@A@ABADAFCB@BAB@D@FABCFCHCDAB@DCFC@CDC@GACBCBEFCH@DADGDIFI@EAI@M@ANGHCDCDABEACAE@CBC@GBEDABABC@AD@FADABCDEBALABCHEJAFBBHHLFBF@FCDEHCFBLDH@J@J@HCLIFEBAFAFADA@A@AA@@CB@BABCFAJEJCFCFAD@B@DBD@DBDDDBDDFDDBD@FBHAFAHCFAFADAFAFCHAFALEJCJAFAJCFABABCBABIBEBGBCDCBCDCFGDCDCFEBC@CBI@A@CBEBEBG@C@C@A@@@CBABADABAD@BABE@ABEBCDCDEBCBCDCDAF@DABAFEDCFCFAFAFALAHBH@H@D@F@@@DABCBCDGDA@A@A@AAA@AA@@ABAFAHEDCBABAAA@AAA@@BABABCBC@AB@BAFAHADABABABABABADAD@FAB@DCDCDE@C@@@CCCCAEA@EBCFC@E@GDCDG@IDIHKDEHKDEDEBABG@CAEAM@ECCCCEGAEAK@CFGHCDI@G@EBCBIBIBEFEDC@CEGACBE@GEK@EAAI@GEE@EBCJEDA@A@A@@@A@@@@@A@A@@@A@@@@@A@@@A@@@@@@B@@@@A@@@A@@B@@A@@@A@A@A@A@@@@BA@@@A@@@@@A@@@A@@A@@A@@@A@@@@@@@@@@@A@A@A@@@@B@@@@@@A@@@A@@@@@AB@@@BA@A@@@ABA@C@@@C@@@A@@@A@AB@@@@@B@@AB@@ABA@CCGEIGOOEECAIAEAMAICMEKOGKAEAE@@@A@@AA@C@AA@CCAAGAA@EAEAICGCEEACGCEE@C@CECGCECEAA@IEECEECEGEGEACAACCGGEEECCAA@IAI@MAC@GCGEECGAICEEA@C@ICKAE@A@@@A@CDCFCDADCDCBG@CBA@EDGDGFABCBEFADGBEDABEBC@EBEFCFC@@@"],encodeOffsets:[[103389,37706]]}},{type:"Feature",id:"632222",properties:{name:"祁连县",cp:[100.253211,38.177112],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@DABADADAJCHE@CB@BCBA@@BA@AACAAACCA@@AA@@GEEA@@AC@ABC@C@AAA@@@AAAA@@@AA@@@A@A@@@A@A@A@@AAACA@@E@A@@@A@@AA@A@@@A@C@A@A@@AA@A@A@@@A@ABA@ABA@AAAB@@AB@@ABA@A@@@A@@BA@@@AB@@@@A@@@A@AAA@A@@@AB@B@@A@A@@@C@@BAAA@@@@@AAA@A@@@@@A@@AA@@A@AA@@A@@@A@@@@B@@A@AA@@A@@@AA@AAA@@A@@A@@AA@@A@@@AA@A@@AC@A@@AAAAA@AA@@@@AA@@A@@@@@@AA@@A@@AA@@@@A@AA@A@@@@@AA@A@A@@A@@AA@@@A@@A@@@@A@@@@A@@A@@@@@AA@@@AA@@AA@@@@@A@@@A@A@@AA@A@@@@@A@@AA@@@@@AB@@@@A@@@@@@@@@A@@@@@A@@@@@@@@@@@AB@@@@@@ABA@@AA@@@@@@@@@A@@@A@AB@@A@A@A@@BA@@BCB@@A@@B@@@@@B@BA@AB@@@@A@@@A@@@A@@@A@A@@@@@A@@AA@@@@@A@@A@@A@@@A@@@AA@@A@@@@@A@@@A@@BA@A@@@A@@B@@@@A@@B@@@@@@@@A@@@@@@B@@A@@B@@@@AB@@@B@@@@AB@@A@@@@@@@@@@@@B@@@@@BA@@@@@@@@@@@@B@@@@A@@B@@@@@@@B@@@@@FBBABA@@FCDCB@@@BA@@B@@@B@@B@@B@BFBDDDFBB@H@DAD@FCBABAD@FDDBFB@@@@B@@@B@@@BA@@BABB@@@@@@@B@@@B@@@BB@@@@@BBD@HBDAFADADAD@F@DADADEBC@AFAF@B@BC@ABCBCDCBAFCHADADADADCDADADCDABAD@@@DAFBF@BB@@BBD@DCBCBCBA@ADCBAFCB@BAB@D@FADAD@BABCBA@CDADABADEDABCBADABADABAH@B@D@D@DAD@DADDBBFDFBB@DBFEBABCBCDC@ABABCBC@ABC@A@C@CAABC@@@A@ADABABAB@B@D@DBB@B@BADABADCBABC@ADCBABCBABAFADAD@DCBCBCBCBE@EAE@EAA@A@@@ADAFAD@BABC@@@A@A@A@@A@@AAAEA@@AACC@AA@C@A@A@@@A@@BA@@@AA@@CAA@A@@@AAGCAAAA@@AG@E@A@E@@@@AC@A@@@A@@AAEBCAA@IAGAEAAACAA@@@@@@A@ABA@AB@@@BAB@@A@@BC@@@@AAAEAAA@AA@AA@AAAAAA@@@@AAA@EAAAAA@AAAAA@@@A@@A@AA@A@AA@CA@@@@@AB@B@BAFAB@BAB@@A@C@@@A@A@@BABAB@BADAB@DAFE@@@A@A@@@A@A@A@AAA@A@@@@@A@@DADAD@B@FAD@B@B@B@@@D@DAB@D@@@@C@A@A@C@AAA@@@A@@BABA@@@A@@AA@@@A@A@@B@@@B@D@B@DAB@DAB@B@@@D@BBD@B@B@B@B@BABA@@F@B@J@@@B@FC@AB@@ABC@@B@BAF@@@BABABA@@B@D@DAB@BADA@ABA@@B@BBF@B@BAD@DAB@@ABA@@@A@@BA@@@A@@CAA@A@A@A@AA@@A@C@A@@@CCA@CAAA@@@A@AAA@@A@AAA@AAAE@@@@AA@@A@@AACBA@@AE@AAAAA@AAA@AAC@A@A@@B@@A@@@A@A@@CC@ACE@@CCCAECCCCC@@@@E@C@ACC@CACAC@AAA@BA@A@A@@@A@@@@@AB@BCBA@@@AB@@@@A@ABA@AB@@A@A@A@AA@@@@A@A@@@ABA@@@A@A@@@A@@B@@A@@@A@@B@@@@A@@@AB@@ABA@@@@@@BA@AB@@@@A@@@@@A@@BA@@B@@A@@@@BA@A@A@A@@B@AA@@@A@@@A@@@@BA@AB@@AH@@@@@BA@AdU@@D@B@@@`K@@@@@@@@@@@A@@@@@@@@A@@A@@B@AA@@B@A@@A@@@@@@@@@@@@@A@@@@@@@@@@A@B@@AA@@@@@@A@@@@A@B@@@A@@@@@@@BAA@@@@@@@@@@@@@@AA@@@@@@@@@@@@@A@@@@A@@B@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@A@@@B@@@@A@@A@@@@@@@@@@@@@@@@@@@@AA@@@@@B@A@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@B@AA@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@B@A@B@AAB@@@@@@@@@@AA@B@@@A@@@B@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@A@@@@B@@@@@@@@@@@@@BA@B@@@A@@@@B@@@@@@@@@@@@@@@@@@@BA@@@@@@A@@@@@@A@@@@@A@@@@@@AAB@@@@@A@@@@A@@@@@@@AB@@@@@@@B@@@@@@A@@@@@A@@@@@@ABE@C@A@CD@@C@A@@AAAA@AB@BAB@@ABA@@@CAA@E@EAC@AB@@AFCDADA@A@A@A@CBC@C@C@CAA@EACAAAA@A@A@AB@@C@AAA@E@C@C@A@ABED@BAB@@ABA@@@ABBB@@@B@BAB@BA@@@C@A@C@@@A@C@@@A@@@ABA@A@CAAAAAC@@@A@A@AB@@A@A@@@AAA@@A@@AAAA@@C@E@A@A@AA@@A@@@A@A@ABA@ABA@EAA@EAA@@@EAA@A@A@@@A@ABCBADEFADAB@@A@AB@@CBA@E@ABA@@@C@@@A@A@AA@AA@@@A@AACB@@@@@B@BA@@@A@@@ABA@CBC@EBABAB@@E@C@A@AB@@@@A@@@@BC@@BCB@@A@A@ABAB@@A@AA@@@C@E@C@CAA@EACCC@A@ACAACAACAACC@@ACAA@E@@@CACCCA@A@@@A@A@@@@@AAAAAAAA@AAA@EAAACAACA@EACAE@@@AA@@@A@@@A@A@I@ABA@GBA@A@@@@@AAA@@CC@AAA@@EEAC@A@AAA@AAA@AA@AA@@GAA@A@CAGAHMACA@@@AA@A@@BCDC@@@A@A@AAA@@@A@@@A@AA@A@C@A@@@GC@AA@MC@AAA@AAA@A@@BC@@@@@A@@@@C@@AAAAA@A@ABC@@@AAACAACAA@@@@@AB@B@BADCB@@@@A@@@A@CB@DEBA@A@@BAD@@@DABABA@@@ABC@@@@DA@@D@DAL@@AA@AA@@ACAAAAEAAA@A@@AABC@A@AAACAAAC@CAC@CAA@AA@AA@@CCACCAACAACA@@ABC@C@CA@CACAAACACAA@AB@BA@ADA@@BCBA@C@E@E@A@CACACCAACAAACCAAAA@A@@C@E@EBEBCBA@@@E@A@A@@B@B@@A@@@ABABEDCD@@ABABE@CBA@EBA@A@@B@BBB@B@B@B@@@B@@CBABCBAD@@A@AAAB@@AB@D@@AB@@ABA@@BAB@@@BBBA@@B@@A@@DAB@B@B@B@D@@@B@@A@@@A@A@AB@@G@C@E@A@@@ABCF@@@DBD@@@B@BAD@BAB@@@@@B@DA@ADCFAB@@A@EBA@@@ABABAB@@A@CAA@A@AB@@@FABAB@@ABAAAAAA@@AAA@@@ABA@A@C@C@A@AAA@CACAC@A@A@A@@B@B@@@B@@@B@@@B@BAB@@@B@@@BA@@B@@CD@B@@@BA@@B@@AD@@AB@@@@@@AB@BCBCD@@@BDB@BBBBB@@@B@@ABA@A@AB@@@B@BABABABAB@@@BC@A@C@EAA@AAA@E@ABA@A@A@A@A@A@CCCA@AA@A@C@ABC@A@ABEBA@A@A@A@@BC@EB@@A@GCCAA@@AA@CA@@CBCBA@ABA@@@A@A@@@CAC@A@@@@B@B@@@B@B@BBB@B@@@B@B@BA@KDA@ABABA@@DADAB@@A@CB@@A@@@A@@@A@@B@@C@@@@BA@@@A@@@A@C@ABC@A@@@A@E@@BA@ABA@A@ABA@@B@B@@@BA@@@ABABA@@BC@ABCAA@ABAAE@AB@@@B@@A@@B@@AB@BA@AB@BA@@@A@A@A@@@A@@BA@A@A@CA@@ABC@@BA@@A@@AA@@@@A@A@@@@@A@A@@@@@@@@@@@A@A@@@ABB@A@@@ABA@@@ABABA@CB@@@@@B@@A@A@A@@@A@@@A@@@A@A@ABA@@@AB@@@@A@A@@@@@A@@B@@A@@@A@@@@@AB@@A@A@@BCB@@ABA@@BA@AB@@AB@@@BA@@B@@A@@BA@@@CB@@A@CBA@AB@@BD@B@@@B@BAB@@C@AB@@ADCBCDA@CB@@A@@B@B@B@BA@@B@B@BA@AB@@A@C@@BAB@@@BC@ABA@A@C@A@A@A@A@ABA@ABA@G@CBC@A@@@ABABA@@BA@A@C@A@CBA@AACAA@@@ABABGBCB@@AB@DAB@BABABABAD@@@B@BBBA@ABAB@@A@E@A@@@ADABCBEBMHA@@BA@ABA@A@@BA@@B@@A@ABC@AAAB@@AB@@A@BB@@B@@B@D@BBB@B@B@B@B@@@B@@DBBB@BBB@@@B@BA@ADAB@B@@@BB@B@@@B@B@B@@@F@@@B@B@DBB@@BBBDBBBFBFDD@@@B@@@@A@@@ABADA@@B@B@D@D@DBD@@B@@@DBB@B@B@D@F@B@@@@@BBAF@DAB@B@@@@@BB@@@DBB@BBB@@@BBD@B@D@BBBBD@BDBDBBBFBBBDB@@BB@BB@@@HDFBDB@@@BABABABABAB@B@@@FABADA@BD@B@B@@@BAD@B@B@B@B@B@BAD@BAB@B@@AB@D@@@@@BCBA@A@@@AA@AA@A@@@A@A@@BAB@B@B@BBH@@@F@BAF@@@DA@GFABCBABABEFCBA@A@A@A@C@A@A@ABAB@@@B@B@@@BBBDBBB@B@@BB@BADAB@@A@ABC@A@CBCBEBIBGBC@GD@@CDAB@B@B@@@BBBDDBB@B@@@BAB@BCD@BA@A@EBA@AB@BA@@D@@@B@DBB@B@B@B@@@BA@@BC@E@C@ABE@A@A@AB@@@B@@BB@B@BBB@@@@@BABAB@BCBA@A@A@A@AACAECAA@@A@@@C@CBA@A@A@@@AAAAAAA@AAAAA@@AA@@BA@ABGFCB@@CD@@AB@@AAC@ECA@@@C@A@@BCDAD@B@B@D@BBD@BAB@BAB@B@@A@EAC@A@E@A@I@A@@B@@ABABABADABABCBA@ABCBC@C@A@C@A@AAA@@@A@A@ABA@@B@D@@@B@B@@@B@B@BAB@@AB@@C@AA@@AAE@EAA@A@C@A@EBAAABE@A@AB@@CDAB@D@@AB@@ABCBA@A@A@CAC@A@@@A@ABABA@@@A@EAC@AAC@A@A@A@@AA@BA@@AA@@@@A@A@@@GCCAA@CAA@A@ABA@AB@@A@A@CCA@AAC@@@AAA@ABA@@@CD@BAB@@A@A@@@A@ABCD@BAB@@A@A@@@A@A@C@G@C@A@EBA@C@A@A@AAAAE@AAC@A@A@A@@@A@ABA@CFA@ABAAA@AA@@G@CA@@A@A@ABCB@@A@AAA@A@ABA@@@A@A@AA@@AB@@ABC@@@A@C@@@@BA@@@@BABA@ADA@ABC@AB@@ABA@@B@@AB@@@BA@A@A@@@A@ABA@A@ABA@@B@@@BA@@BEFCB@@AB@B@B@@@BABABAB@@C@A@A@A@CBA@E@C@CA@BA@@BA@A@C@C@@@ABA@@B@B@@@@AB@@A@CB@@@@CD@@AB@B@B@@@B@@CBAB@BAB@BABCBA@A@@BA@AB@@A@@@C@A@A@E@@BA@@BA@AB@@AB@@@B@@A@@@A@@BCBABA@ABA@ABA@ABEBA@CAA@@B@@ADABCDA@@B@B@@BD@@@B@@A@@BIBIBEBA@C@A@ABA@@BAB@@@B@BA@@@CDA@ABAB@@@BC@ABCAA@A@C@A@A@ABA@AD@@@@A@@@A@@@AA@@A@@AC@A@CA@@A@A@A@ABA@AAE@C@A@@@A@@BA@ABABA@AB@@CAABA@CBA@@@C@@@ABA@@@@B@@ABA@@BC@ABABA@CBA@A@ABABA@@B@@@BA@@BA@@@CAA@@@ABABEDA@@B@@@B@BA@@B@@AB@@C@A@A@A@C@A@A@@@@B@@ABCDABABA@EFA@AB@@CA@@ABA@AB@B@B@@AB@BA@@@A@A@@@C@CAC@A@A@A@ABABCB@@CBE@E@A@CBA@A@@@A@CBE@A@A@A@A@A@A@CB@BC@C@A@CAA@@@A@ABA@@BAB@@ABC@CDA@AB@B@B@@@B@BA@A@ABAAA@A@A@A@CBAB@B@D@BA@@@C@A@GAA@@@C@A@AB@@@BA@@BBB@@@BA@@BCAC@A@A@A@ABEBA@ABA@AAC@AACAC@AAA@A@ABCBABAD@@C@A@C@E@EBA@A@AAA@@@ABABADABA@@@C@C@@@@@AD@D@BA@ABEFEBE@ABEA@BA@CBAB@@ABAB@BABA@CBA@C@A@CAC@CAC@A@ABKBEBGBA@A@@A@@AA@@A@C@G@A@A@C@ABA@AB@BA@ADCDCDA@@@C@CAE@@@ABA@@BA@A@AAA@@AA@ABA@A@CAECC@CAA@C@C@A@E@@@@@@BBBBB@B@B@@@DA@@BABCBGBAB@@AAC@CBEKCBC@A@I@EBEBGFCDCBAAECA@C@EBABEAC@ABAB@DA@EBKJEBE@CDCD@BABCDEBG@A@KDQFGBC@EBCBC@EFA@E@AB@D@FBJBPBFDHBLDJN^@FBDGPDFAP@DADGLAJ@BAHCBCBA@ABE@IBIBEBGBA@ABADCFABCDC@A@@A@ADC@A@AAEAA@ADCBA@A@@C@CBEBA@EDABA@E@@@AAE@C@EDGDCDADEDEFCFCH@FAH@FAFAH@FIHCBCBA@GBGAG@E@IAGAE@E@E@CBEBCBEBGBGDEBEBGBG@GBE@G@I@EAK@GAI@GAGCGCGCKAGAIAG@G@GBCBGBEDGBGDGFGDKDGDGDIBI@MBIBIBEDEBKDGBGFGDGFEDGFGDADEHEL@DFDJNBFADKHEDCJCBSBMAIACC@GGGKESAIAUIWBEC@IAOAGCEGAIHCBUCQKGCKIEEECOEMCICKAGAGAMAKAMAIAK@K@KBEEA@AAA@ABSFGBE@ABADABA@CBIBAB@BADADADCBADC@ABC@AAEAG@KBCAAACCC@CAIBABEBCBADA@A@EACCCAEACACACACBC@CBCDCDABE@ABG@A@CDEBABG@CBC@GDIFADBBBDABBD@F@DDFDBDDHHFBDDFDHFDBHFFDDDBFDH@DCHEBEDE@EBGBEBC@IDCBEBCBCFAJ@FFFFDJBFBFBFDFDFF@DDHBFHFFBFBH@FBH@F@H@LAH@HAHBDDDD@HCDEFADEFAFCFAHAFAFCHCDADCDABEDCDCDGFEDGFGDIFEBEBMFGDIFGDEDGDEDGBGDA@IBGDIBIBG@IBK@G@IAOBG@E@I@GBGBIDEBE@EAEAG@K@KAIAKAE@I@IBEBE@GBIBE@IBGBGBGDCDGFGFCHCDADAD@FAD@FADAD@DCFCDCBADA@EDEBGBG@GBI@GAGAKCICIAI@G@KAGAE@I@GBKBIBIBIBKDKFIDKHGDGFCDGDEDIFEDEFEDCDCHCJCHEDEFGDEBEDE@KBIBIBKBK@O@O@K@KBKBIDUDIBGBGBKBKBK@IBEBEBKDIDA@GBIDKBMFMFGDIFIFKHGFIFIHGDEDGDGDIFEFGFEDGFEDEDIFG@G@E@EBE@GCAC@CBG@G@GAGEAGBCBCBCFCFCFCDGHEFCBEBG@G@G@C@IBI@I@EBE@G@G@G@E@M@EBE@CBGHADADCFCDCDCFCDCFCDADCBIBC@IBEDEHAFADCD@HBHBDDDBFBD@F@D@F@FAFAFADADCFEFIHC@O@IBABA@ABABEJEHAFABABEDA@CFAB@BAD@FD@B@BBBBHHDFBBBBD@J@DBB@B@@DBB@HBDBBDBBBD@HBFDDBBBBBDBF@FBLBD@BBDDFHBFDFDFLLB@JFHFD@FBLBBBDBDBBDBBDBDBD@FBB@DDFFDDDFFBFDFBBDBBBBD@FBFBLDHDBDJHBB@BBB@BBB@D@B@N@J@D@DABABABCBE@GBCBA@AB@F@BBDDFFHBBNPDBHFFFFDFDHHDDHJBDDDBDBDDJBHBFBFDHBFBH@D@DAF@DCH@FAD@DAF@DAF@D@DAFBDBDDDDFFB@@FBHBF@JBF@FBH@F@FAF@HAF@FAF@J@F@FAH@D@HCD@FAHCJ@DAF@F@F@HAHEFGDADEDCBE@C@C@EBCBCBCHCFAF@H@JBFBF@DAB@FCBABECGACCI@C@C@EBCDEBCDAFCFCFCDAFCDCFCDAFCHAF@J@HALBJ@HBJBH@J@J@FBH@HBH@F@F@DAHCFAF@FAF@JAB@HCFCLGJG@@JKDADAF@F@D@HBDDBDDJFLDDD@L@R@B@DFBDD@DBDD@B@FCFGNADGHAD@DDDJDHBFBJBRBFDFD@@BBFBD@LBH@DBDDBFDHDDFHFBDBHFFDJFDD@@FJDJBFHJBDFFFBJDLD@BBD@DBBHBFBFBBDBBDDBHDDBDBBBDDF@JBH@D@@DBJ@DBD@DBDBB@DADCDAFATFFBF@H@DADAJBF@FAD@BBDA@AD@DBD@HC@@@AAAAABCDCBCFCBABA@CCAECAA@CDAFCHANCFADABABCDAFDD@D@FAFABCBC@CD@F@HBFBD@DADAB@BABADCF@DADCBABEBEBABEBAFED@DCBCBC@CBCBGJCFADADAFCDCBAD@D@F@DA@ABA@AHEDAF@DAD@FCDCDADAJAFAD@NAF@J@J@D@B@JIDABAB@BAFBD@D@D@B@DBDBJHBBB@FABAB@@@H@H@D@JAHAHAB@FAFADAHAD@DCDCBADEBGDCDCFCFCFAHCF@F@FBJ@F@F@DADAD@BADC@EEEEEECGEGCGCGCECECEEGCCCECACCCCC@E@CBCFEFIHEDAFCDAHEJEFCLGJGHEJGHCHCDAFAFAHAFCHCHEJIJGHGHEFCFGFCDCHGJGJEFCHEHEJGHEFEDCHEFCDAFAFCLAHAJ@HAH@FAJAJCJEJCDAFCFAJEHAHAFCFEB@HELIJGHENELILEJEJEHCJGFCFCHCDCDCDGDCBCBEBG@G@GBEFIBCHCDCJCHALCFAJCFCFEFCDCDEFEDCFGDIBIBGBEBKDKDIDEDEFCHGFCFAJ@J@LBJDHDLBHBF@HBDB@@D@F@HAD@DAB@BABA@CBC@CBA@ADABA@A@@CEAA@C@AB@AE@ABCFI@C@E@CAC@C@A@CBADEBCDEDIDAHIBADABADAFCDADAFADCBADADGBABE@CBCDABCB@BAD@FCFCDAJEDADCDEBAFEDCBAD@F@D@BAB@DCFCFEHELELEFCLCFAF@HAFCFADCDCDEDE@EFGHGFGFCHEJEJEFAHCJCJALCHCJAF@DAFADCDCDCHCDCHAHCJ@JAH@FAF@H@HADAHCFCFA@@DCBCJGNGHCJGJENGJCJEHEJAFCJCHAJCLCLCLAJAHAHALCJCDAFAHAFAHCHCJAHAF@FAJ@F@H@F@F@DAFAFEFEDEDADEFEDCBAHCFAFAJ@JBH@H@F@H@DAF@FCJEFCFCDCFEFCFAHCDAB@FAFCFGHCDCFCFA@AD@FAHBF@F@@@HBFBHAD@HCDAHCHE@@@@FEBAB@B@B@BA@@@AA@AAA@CA@A@ABC@ABADCFCDCDAF@DAF@D@B@BABC@@BA@ABC@CBA@ABCBCBE@@BCBCBABADAB@@@D@D@H@DADADAFADA@@DAFABABCBADADAFAF@B@D@B@B@B@FBBDJDDB@@@B@BBF@@@LBF@D@@ADCHADCBCB@@AB@@@F@@@DAB@@ABA@AB@BAB@B@BCB@B@BABBBAB@BABAB@B@@@B@@@B@@@BA@@B@@BB@B@@AB@B@@@BBB@B@B@BA@AB@B@@@B@B@BA@A@ABA@@BA@@B@@@B@BBBBB@BBD@@BB@@@B@D@@@@ABABA@AB@@@B@B@BA@@@@B@@@@@@@@@B@@AB@@@B@@BBBB@@@@BB@@BBB@B@B@BBB@BB@B@@@@AB@@@BB@BB@@B@AB@@AB@@ABA@@@AB@B@B@B@@ABA@@@AAA@@@A@@BA@ABA@C@ABA@A@ABC@C@C@CBA@ABC@@@ABA@A@AB@@CDABA@@B@B@@@B@@AB@AA@@@A@@@A@@BABA@@B@@A@A@A@CAA@A@@B@@@@@B@@BBDBDBB@@@@@AB@@EBC@A@A@ABA@@B@@@B@@BBD@DBB@@@BB@@@B@@ADAB@@@@ABA@@B@@@B@@@B@B@@@@A@@BA@@@@BABAB@BA@AB@@@@@B@B@B@@AB@@@B@@@@@BBBA@@B@@@@AB@@@BA@@@@BA@@@AB@@AD@@@@ABCBA@@@@@AB@B@@@BA@@@A@@BA@@BAB@BABADAB@@@@@BBB@B@B@BABAB@@@@@B@B@@@BAB@@@BA@@@A@AA@@AA@@A@A@A@A@@@A@@@@@@A@@A@@@A@@BA@@B@@AB@@AB@@AB@B@@AB@@@@BB@@@BA@@@@@A@E@A@@@A@AB@BAB@@@@@B@@@@ABA@A@@@A@AB@@A@A@A@@@A@@A@@@AAC@@A@@@A@@BABA@@@AB@@@BA@A@A@CB@@A@@@@@@B@BB@@B@@@@AB@@AB@B@@@B@@@B@B@@@@@BA@A@A@@@A@A@@@A@@@@A@A@@@AA@@@A@C@A@AB@@@@@D@@@@@@AB@@A@A@@@A@A@@@A@A@AAA@@@A@@BA@A@A@@@A@@@@B@@BB@B@@@B@BBB@B@@@BBBB@BBB@BBBBB@@@@B@@@@A@ABA@A@@@@@@B@@BB@B@@@B@@AB@@ABA@A@AAA@@@A@@AA@AAA@@@A@@BA@@B@@@B@@@@BB@@@@BB@@AB@@@@A@AB@@ABA@@@A@@@AB@@A@@B@@@BAB@@AB@@ABA@@BA@@@AB@@ABABA@@B@B@@BBBDDDDFBDDHDBBFBDDDBBBBFDFJBJ@DBFDBFBNAFAFDHBD@HFFFDDDBD@BAB@BCFIDEFGDCHAHEDEBEBAH@J@J@JBJD@@BBBBDAB@BADCDCBAFGFCB@FADAB@B@D@DAF@HAFA@@DABADCBCBEBCBAFEDADEBAB@D@D@FAF@FAD@DAF@DB@@FAJ@JAL@DAF@BAFADADCBCBAB@BAB@DBD@F@BAFABADABADA@@BCBC@C@EBE@CBADCBCBC@ABC@CACAAAC@CBEBCBA@ABC@GBE@CBCBEBE@AAC@AAC@@AAAAAABC@ADADA@AFADCB@D@@@BBDBB@BDBDDDDDBBBBBBD@D@DCB@F@DBB@@BDBDFFFDBDBDBD@@ABA@A@ABC@CBCDCBADCDCFCDCBCBCDC@ADEBEDIFKDABABCBCAA@ACACBAAA@@A@AACAACACAA@AA@@BABADADADAB@DAFAFCHCHCDADCDADAB@B@DBB@B@BABCDAB@F@H@DBD@D@DAFAFAB@@A@C@ABEDAFABAHCDAD@B@BB@@BDDBFBFBFDDBDDDBBBBBB@D@D@B@BABC@A@A@AB@B@D@F@B@B@BABA@C@A@CAEACAEBABA@A@A@CCECE@CBABABAB@BCDBB@F@D@D@BADABA@@D@B@F@FAB@BBBB@BDBDDDBBB@@AH@F@BBB@BDBB@BDBD@BB@@@D@BAD@D@BABCBABCBABAD@B@D@DAB@@A@ABC@ABAD@B@BAB@@@B@B@D@H@B@D@H@HABCBCDCHCB@DADCBCBADCDAB@BC@ABABABCB@@AB@BBBBDBD@B@BADAP@HFJBD@D@BAFEJCLCHAB@B@DFDD@H@BBD@@@@@@HDBBRLFFH@D@DBBAF@B@DAB@BAFC@@DAB@BA@@D@HBHAHA@@HAFCB@@@B@B@JB@@BAB@@@B@B@@@@AB@@@B@@@LG@@HC@@@@F@@@DAD@@@B@B@D@@@J@B@LB@@B@DF@@DBB@B@B@@@HC@@B@HCHE@@FAFAFEHEBAB@@@FBBBB@D@BAFEDCFEFC@AHEB@HCFCFGFGBC@@@E@@BC@C@@FA@@F@BA@@@CAC@ABEBA@@@ADAFADAHEBA@AB@@@B@D@BAB@DCBADKDC@ABADA@AB@BABA@C@@BABC@@@ADA@@B@BCB@B@D@@@BADABCDCBB@AFAB@B@BA@@BABA@@B@BABBF@D@LAF@DBBFDDDDBBDBB@FDB@DBB@FBBB@@@BBDDBFBB@DBDBBBBDBFBD@@DBB@BB@@@@BB@@@@D@@@HA@@H@DADADABA"],encodeOffsets:[[103341,39092]]}},{type:"Feature",id:"632224",properties:{name:"刚察县",cp:[100.145833,37.32547],childNum:1},geometry:{type:"Polygon",coordinates:["@@AC@@AA@@AAGCAA@AA@@@EC@@AA@AA@@A@A@@@CBA@A@A@A@@@@@C@ACCCC@@AA@@AA@@AAAAC@CACCA@@@C@AAA@AAA@CA@@CAA@C@A@AA@@C@E@CAE@A@A@@@@@@C@@AA@@@@@A@@@A@AB@BA@A@@@C@@@AB@@@FCD@BABA@@@@DA@ABA@@AA@@AA@@AEĝǷAKKBœȧ¬»\\GBEBGDABGFGJAD@DCFCDEBEBGDCBE@I@E@QXa`]G[B@@EJFRENUEQBWN@BOC[D}rgPMXEDYJIHA@EBcHm^cBIHCBA@ABCHCDEBGBEBC@EDEBCDCHCFOFMDKDGBEBEAE@A@EAA@IDCBEBA@IBE@CB@DBHBP@DCDSBUDCBAJFVBRBHADAB@DFJ@BEHAD@DBBBF@BKLCDCDCFCD@DBBBBFBDBBB@BAJ@BBBDBBBBD@L@BFDDF@BBF@DF@FDDDADEDABADAD@H@H@BEHCB@D@D@B@DGFCBAJCBEDCDADCBAVCVBbBDBDDHDFFDDBJBL@LFNFHFFDDDLFBDDFBDDBHHBBBH@D@DBF@F@J@F@F@F@FAJAFAF@HCHADCFCFCFAFCF@F@FBDBDDDDDDDFFDHDFDHBD@@FFDHBHDJBJDFDDBDFHHJFDFFDDJDJBHBHBHBFBFDFBDBDDBDBD@DBD@FAFADCDCFAF@D@DBFBFHFDDDDDDFDFBFDHBF@HBFAFAJADAFBD@DBFFDDFDHHFDFFFDFDFFFFDFDFDFDDDDDDDBFDFDFBJDFDF@HDHBFBJBH@F@F@H@HDFBFFBFFHBDBF@F@H@F@HAJAHAFAFEJCHEFEDIBG@GBIDIFCDADAFCFAFCHAJAHAH@LAFAH@H@FAH@FBFDFDBDDF@FFFDBDBD@FADADGDCBEBGBEBUDWJUDKFMNaJeHS@]JWHQHO@GUSQE@W@KPEdAT[LUTWPSDUHWLCBEFADE@E@GBKJC@EDGD@JFHBBNFJBFHCLKBIFEL[NKFADDVZZP^fJ@\\KVC¸^XAZFrEXCRBPHPLNFXNZF^BHAF@D@FDF@F@BEFAB@DBBB@BDDFBD@B@FFF@BBFDFBDFFBP@@@@@ED@@FLDAD@BB@@BAHADABA@AB@@C@@@A@AAAAA@A@@@@F@B@D@D@B@DBD@FDDBB@B@BAB@@BB@BBB@B@@AB@BA@@F@DBD@@@B@DCDCBCB@@ABAB@BAD@B@B@H@D@B@@@BB@@@BB@B@HAFALABAB@D@DBD@DBB@D@B@DAB@BA@ABABA@@BADAB@@AFBBAF@FAFEBAB@@A@CBC@@@@D@D@@@B@BABCBABA@@B@BBB@B@FAF@D@B@D@@@BCBADABAB@B@BBD@DBBBD@BBB@BAB@FABAB@B@B@D@DB@AB@@A@@AA@AB@@A@@BAB@D@@@B@HBB@D@@@B@@A@C@ABADAB@B@B@B@BBBAB@B@@A@A@@@A@ABAB@DCD@BA@@BA@AB@BAB@@@B@DBB@D@D@@ADAB@B@B@B@B@B@F@DAB@@@B@B@DAB@F@F@DA@@DABABAB@B@B@D@DBD@@@B@B@@@B@@ABA@@@A@ABAB@BA@@DB@@BAB@FEB@BABADCBA@@@A@@B@B@D@B@B@B@D@@@BA@@@AB@@A@A@@@AB@FCBABA@@B@DB@@B@@AB@@A@@@AB@BABAB@B@DAB@BABAD@@AB@BA@@@A@@B@BA@@D@@@B@DAB@BADB@@BAB@BABAB@@AB@@@B@D@F@BBB@BAB@B@B@@@DBB@D@@BB@@@BB@@B@@@B@@@@@BCB@BAB@B@D@B@B@DBBAD@@A@@BABAB@DC@@B@@A@A@@BA@AB@BAB@D@B@FAJAJA@AB@@@@A@@AC@@@A@AB@DCBABC@@@AB@DBB@FABAB@BAB@BAB@BADA@AB@@@B@@@@A@@BA@@BAB@@AB@@AF@B@B@D@@@B@@@BAB@@AB@B@DABA@ABA@ABADA@@@A@@@A@ABA@@DC@@@@DAB@@@BA@@@@@A@AB@BA@@D@D@B@B@@AB@@ADBD@F@B@DAB@B@B@D@@@BABABA@A@@@A@ABA@@DAFE@AB@@A@@@AB@BAB@B@BAB@@@B@B@B@@A@@BA@@@AB@BA@@BAD@BAB@BCB@BA@A@@B@@A@@D@B@@@D@BA@@BA@@BBB@B@@@B@BAB@B@BBB@@@DABAB@B@@@DBH@@@BBB@BBBAB@DEB@BAB@@@B@B@B@D@BBF@BBBBB@B@D@B@FAB@D@H@D@B@B@@@B@B@@@BA@ADCBAB@@@B@B@@@BA@ADC@@B@BAB@BB@@D@BBB@DDB@B@@@BAB@BAB@B@DBB@DBHD@@B@B@@@@@BB@@ABB@@BB@B@B@D@BBD@FBB@@@B@BABAB@@@B@D@DBB@B@B@DABA@@BA@@@CBADC@@BAB@F@BABBFAB@D@B@B@FBF@BB@@BBD@@@BA@@BA@A@A@A@@@A@A@@@C@AB@BAB@B@@@B@BBB@D@B@D@D@DABAB@DABABABCBABABA@@@AB@J@B@F@B@D@FBB@@@@ABA@ABA@AAC@A@C@A@ABCDC@AB@D@@@B@FDD@BB@@BA@@DC@@DAHEBAB@@AB@@BB@BBBBB@BBBBBB@@B@B@B@DAD@@@B@@@BBFDDBBBB@B@B@B@DA@ABABA@A@@@@AA@A@AAA@@@A@@BAB@B@F@BAD@F@D@@AB@@A@@@A@A@AAA@C@A@@@CB@@ABAB@FAB@B@@ADC@ABA@A@@@AAACCAA@A@@@A@ABADC@@HCD@HAJAFADADAB@D@BAB@@@BABC@AAA@@@AAACAAA@A@@@A@A@@BABAB@B@D@B@B@B@B@DAFEBABADABAHEB@@C@@BE@A@E@@AG@A@A@ABA@AB@B@@@B@B@@BBB@@B@B@DA@A@@@@@CBA@@@ABA@ABC@A@A@A@A@A@ABC@A@@@A@AACB@BCBA@E@@@ABABABABABA@A@@CAEAGC@@A@@AAA@@CAAAEAAACACA@AACAA@A@C@AAC@A@@AA@AAA@C@@AA@@@@A@A@CBE@AB@A@@@@@A@E@C@A@AAA@C@@@AC@CAC@C@A@A@@@CBAB@B@@@B@@A@@@C@ECEAAACAAA@AA@CAA@A@@@E@@@A@A@A@@@A@A@@A@@@ABABCB@@A@A@@AA@AAACA@@@A@@@A@A@A@AAA@A@C@AA@@@AAB@@@BA@@BABBD@BAB@@@@AB@@AB@B@BAB@@AB@NGFADABABC@@B@F@B@@@BABAB@AA@A@A@@BCBABABA@ABA@CBA@@DAHABABA@@B@DBBBB@DAB@D@B@B@@AB@BABA@@B@D@DAH@B@BAB@BAB@B@B@B@D@B@B@BAD@@A@@BA@AD@B@@@BAB@@A@A@AB@@A@A@A@AB@@@DAB@DCDABC@@BAD@@@BA@A@A@@@AAC@@AAGEACA@AAEAAAAA@A@A@ABA@@@A@A@A@A@@A@A@E@A@@@AA@@AAA@E@AA@@@ACC@G@A@A@@@A@@@AC@CA@@@C@@AAAC@A@@@A@@@@DAB@FCDAB@@ADEBA@CBC@A@@B@D@B@@A@ABC@C@@BABAB@@@BA@@@@@ABC@@@A@A@@@ABABADA@ABCAABA@@@ADAB@@@DBBAB@@@@AB@AABC@C@A@C@@@AA@AA@@C@@@@@@A@@@A@A@A@@@@A@A@@A@@@AA@@A@@B@@@@@BA@A@A@@B@B@@@FA@AB@@C@@BA@A@ABAAA@@@A@CBA@@@C@@@CBA@@D@@@@@BA@G@@@CB@BADAB@BC@AB@@A@ABADC@@BABA@@B@@A@@@A@A@AA@@A@AAAAA@A@@BA@@B@BA@@BA@A@@AA@AB@DCB@@ABABA@ABABCBEBABABA@A@@@A@A@@AAA@A@@@AAA@A@@@@@@AAA@ACAAAA@@A@@B@@ABAB@BA@A@A@@@AA@@A@@BA@A@AB@@@DBB@DA@AD@D@B@@AB@@AAA@A@@BCBA@@@A@@@C@ABCBC@ABA@ABC@@BA@@B@@@BA@A@AB@@A@AAA@AAA@@C@AA@@@@@A@ABA@ABC@A@CBABADA@@@C@@BC@A@A@A@@AC@@BAB@BA@A@AB@@ABABA@@ACA@@A@ABABC@@@AAA@@BC@@BCAA@C@ABA@A@AAC@A@@@ACCBA@A@ABA@ABABA@A@ABC@A@E@ABA@@DA@ABA@ADC@A@C@A@@@@BAB@BAD@B@@@@AB@AC@G@C@@@A@AA@@A@@@AB@B@@ABA@A@A@@AA@C@@@AAABA@C@@@@B@AC@@A@ECA@AA@A@@@AAA@@A@@AC@AA@@@AE@AA@@A@@@A@AA@@AA@@A@AAA@@@AAA@A@C@CAA@@@A@@AAAA@@@A@A@AA@@@@AA@A@@AA@@CB@@@AA@@@@A@@AA@@A@A@A@@AE@@AAAA@@@A@@AA@A@@@A@A@A@@@A@@AA@A@C@CAI@A@A@@@C@@@A@@@@BA@C@A@AAA@AB@@AAC@ABA@A@ABAB@@AB@@C@A@C@@@A@C@G@A@C@AA@@A@@@ABC@A@A@@@AB@@ABA@A@AB@@A@ABA@A@@A@AA@@A@A@A@AAA@AA@A@E@@AA@@@C@A@@A@@@CAA@ACAAA@@A@AAAA@@@A@@@@BA@@@A@@CCA@@@A@CB@@AAA@AAAAECAA@@CAAA@@@AA@CA@@AAAA@@A@A@CA@@@@@CA@AC@@@ACA@A@@AA@A@ABA@A@@@AAA@AAAAAACAA@@BA@C@@@A@A@CAA@E@@BC@@@@AACA@@AC@@@ABA@A@@AA@@@A@@@AAA@AAA@AB@@AA@ACAAB@A@@AAAA@AA@A@AA@@A@@CAAAAAAA@@AAA@@A@@AAAA@@@AA@"],encodeOffsets:[[102849,38041]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
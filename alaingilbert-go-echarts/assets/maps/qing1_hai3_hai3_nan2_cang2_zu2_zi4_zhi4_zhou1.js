@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("海南藏族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"632521",properties:{name:"共和县",cp:[100.620031,36.284107],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBAB@B@DCJCJAFGV@D@BAFAFADADAFAHAB@FGR@@@DAD@@ADADAFENABADENCF@DENADEJGVCF@BADCFCLAD@BABCH@DEJAFADK\\CHADCJGRADAFELADGTADADAFADADCNABAD@@@@AD@BA@ABIFGFC@GFIFABGDA@IJABCDKJCDCDGFIJCBEFABABABSRUTEDEFGFUT@@CP@H@@AB@D@D@@CDA@ABA@A@A@AAC@CB@@@@@B@@B@@@A@@B@B@@CDAB@@@B@@@@@@ABA@@B@@@BB@@BA@A@AB@@AB@@AB@B@@@@AB@@@B@@@B@@@B@@@BA@AB@@@B@@A@C@A@@@A@C@A@@@@@AA@A@@@AB@BA@@A@@AA@@@@ACB@@@A@AA@AB@@A@@@A@@AABA@A@A@@@AB@@@@AB@@AB@@@BA@AB@@@@A@A@@@@A@AA@@@A@@@@@@@@@@A@@A@@@A@@@@@@ACAA@@@A@CAAB@@A@@AABA@A@BBC@@BCB@@A@@@AA@@@B@BA@@@@B@@ABAFABGN@@ABABAB@FAHABA@C@A@A@@@@B@@@D@@@@@BAB@@@B@@ABA@ABA@AACBGFCDGFADADABADAB@B@D@B@B@BADADCHCDADIHABQJMHKHCBED@@A@@@@BBBFFBDDBBDDF@@BB@@ABCBKJCBGFk^CDOLGFOJABABA@@@A@MIECECMISKGEKGCAECGCAAMGCCMGIEAC@@CAA@CC@@@A@AAA@AA@@A@CAC@AAA@E@A@@A@A@@@A@A@A@GCCACAACCAAAEEAACAE@CA@@@ADABA@C@E@GAK@GACCCAAAC@EBEBE@A@@A@C@@@CBA@A@AAAA@@AAA@C@A@A@@BA@AAC@CAA@C@@@@@@A@A@AAE@ABC@A@@@A@@@AC@CACAA@AAACAC@C@AAA@C@A@ABC@C@AB@@AB@@ACCAAAA@@AAABAACAACAA@AAA@@@@AAE@C@U@C@A@A@@BC@A@@@@A@CBC@@@A@AA@EAGCCACAC@AAAA@EA@AAAAEEAA@@A@ABAAA@AA@@@E@A@A@@CAEC@@ACAEGE@CAC@AAAECEAEAECCAAAACEGEEAA@@BABCDIBCDCBCBCFC@ABC@CBCBABA@A@@@ACA@C@AAA@@A@A@C@AAE@@AA@@A@AAA@@IEA@A@@AAA@AAAAA@@@@C@@@A@@@@@@CAA@AAAACAA@@AAA@A@ECA@CAA@ABA@CB@@C@@@ABA@A@AAA@A@C@A@@@A@@BCDC@ABE@A@A@A@ABIFA@AB@B@@DD@B@F@B@@CBA@@B@B@B@B@BAB@BA@A@ADAB@@ABAD@BABC@@B@B@DBF@BCB@@AAC@EAE@EAC@E@A@ABCBABAD@D@D@BA@ABC@AB@B@BBDAD@BA@AB@@CDABE@@@A@CDABADAF@BAB@DAD@@GBA@@BABA@A@CBABCD@F@@ABAA@@AAA@@C@C@AAA@@AAA@C@ABAAA@@@@AA@@GAK@A@AAA@A@AE@CAECCCCEGGCCECCAECECIMAAAE@AAACAC@EAG@A@A@ABAD@F@F@BAH@@ABA@CAC@CBABCB@BAD@B@B@@@@CAC@C@A@C@EDABAB@@@D@DBDAB@BCF@@AB@D@B@FAFAF@DCFAFAFABAB@B@B@B@B@@@BA@CBEBABA@@@@B@F@B@FAB@B@@@B@BD@@B@@BB@@@B@@@BAB@BBDBB@BABADCDABC@ABI@CBA@QPAB@@ICCA@AA@A@ABA@A@@BABEDADAB@BAD@BA@A@ABA@GCC@A@A@A@@BCBEBGHABA@AB@@CAEACAAA@AAAAA@AA@I@EAA@C@A@@BABCBA@C@C@@AACAE@@A@AACACCA@A@C@@BA@ADA@ABC@AACAC@@@CBA@@@CBA@CCCA@@A@A@C@ABA@AB@@A@AAAAA@CDCDCDABA@A@E@ABEB@BA@A@CCCCC@AAE@C@AAA@C@EBCBCBA@CDEDA@A@AAA@ABC@CDIDCBA@A@C@CCGAAA@AAC@C@@CCAAA@C@E@A@CAAAA@A@EAEAA@EAA@A@@@@@@A@A@@AAE@A@C@C@@@CAECAAA@A@C@CDCBIJEDCJ@@CBA@C@CBIB@AEACCC@CAC@E@AAC@ACAA@A@ABC@A@A@CAACCACA@ACACAAAA@EAC@AAEAC@@E@G@A@KBA@A@EACBC@ABEDABABELCBA@ED@@A@A@AAA@A@CDKFC@E@C@EBE@IBEDC@A@@@AEAACAECA@AACAIAE@GACCGDCACCEAEAAAABQDA@MCA@AB@@AD@BBDB@BBABADAFAF@FAH@HAFAHAFCFEFCDGDGBGBIDGDGDGDEFCF@HBFDBF@HBFBHDH@J@N@NDNBLBHDFDDBBDBH@FAH@JAHB@~XXL@\\bVFVE\\KJA@GBCB]FC@EDCD@FCHADCHAJCFCBC@ABGAE@EBCBCFADAJADADAH@DDBFDDDBDADAFEFIL@BDBFBDBD@H@P`Jþ|jªXüÒbjZV\\RPFBZFJANFNFJDJDRHHLLHNHFDBL@DCLMHGHILGTATCNF\\JLINGHABGFIHQLIHEFEDGFCDYPQJ[Ny`e`A@E@GBABEJIVEVCDGFAF@JAHCD@@EHMHCDMBODULQLKFYBOFELCHKFODWNQFCDEBBF@FITBBL@JCPG`CHCF@LFTJNBJFLHBF@JBDHJHH@FBDBDBHDD@D@DBJDF@DBL@D@DFTPfHPBJDHDFDL@FBBDBDBDFD@FBDF@F@FDFHBHBFCD@FDHHBBBD@DBB@@DBDAHADB@BADBBFBN@LAH@HBDAF@FBDFBBFHHH@F@JBFBH@FBF@FBJDJ@FDHB@@@FCFCBAFAHCF@FBFBDADCDADBJBDDDBB@FADCDGDAB@FBD@FAHABAFCF@FBB@D@DBFAFAFCB@DAF@JAB@FADAJCB@FBB@F@FBFAHALCNCPEDEDGDCFAFCD@FAHAFADCDGBAB@DAJGdAn]dGFAB@JGZIFCNWhO~q\\CPD@AXMRAVFFMEQFI@@\\A^Hb_RWF@J@F@DAHCFAFADCDE@CBCHIHEBAHCFAHA¼[Ȩ«ŔLAɆ©~OÆ]FASjKBAHEHE`YJCZCTDRFLDXA`AV@NBNDTHTHVFFDRJLFF@FAHCDAFAFINKXINCHCHEJGDECEACAC@CAG@EBCFCFCDC@CDE@@DEFCDAHCDCB@BCBEBCHG@@HEFE@@@CECGGAA@C@E@GDMDGDEDIDCFAHCJEJGHGFCBCBC@EDEHADADA@CAC@EFIBIDKFGBC@A@ECEACAC@E@CCC@CDAFACGEI@CBCFCHCDA@E@CBABEBCHE@AAEBADADABAAAACECAE@CDCFAFCDA@CBEFADADAB@DCDAJAJAD@@A@@BCBCB@HEDAJ@FCBABEB@DCJEHGDC@A@E@EAEBEBEDEFCDC@AECCC@CBGDGDAHABADADCB@HEB@D@H@F@HADCBC@C@@@CAEAC@EDCFGFGDCBEFGBCDGHGHGDCFCDAFARCLCHAPCLAJAFCBEBCDEBCDC@CACACDE@@DEDIHETGFCHABABE@CAA@A@@@@AACA@AA@@ACA@@@@@@A@CB@BA@AB@@C@A@@AE@C@CA@@A@AAA@CACAA@AA@@AA@@AA@@@AA@AC@@ECAA@AA@@A@@@A@AACAACA@@@E@AAAECACAA@@AAAA@@@ACCA@@@@@ACAACCAA@AA@CBC@CBCBAAA@A@C@AAAAA@AAA@@@A@@AC@C@A@A@@AA@C@CAE@@A@@AAA@@AA@@@A@@@CAA@@@AAA@A@CAE@AAA@@@A@E@C@A@A@A@AB@@A@ADA@ODABEBGBIDkPC@@@A@@@A@CCAAA@A@CA@@A@C@AAA@@@AA@ACA@A@AAA@@A@EC@@C@@@@@AACCA@A@A@C@ABEBCA@@CAA@AAEA@A@@@@@CA@@@@@A@@@@@@@@A@@A@@@@AA@A@@BA@A@AA@@@A@@CA@@@AA@AA@@AAAAA@AACCC@@@C@A@AAA@AEAA@@@AB@@A@C@C@A@A@A@@@AB@@@B@B@BAD@BABA@@B@@ABCB@ACBA@@@AB@B@B@@ABCA@BAAA@@@A@A@A@A@A@@@A@@@@@@A@ABC@@@A@@BABAB@@AAA@@@ABA@C@A@A@ABA@ABC@A@A@AAABEBA@CAC@CAAC@@ACB@@AB@D@B@@ABA@A@A@A@A@@@AACACA@BA@A@A@@@A@C@@BAB@BCBC@@@A@@@A@ABA@ABC@@BABCBC@ABA@E@AB@BABCB@AA@@@@@A@AB@@AB@@A@AAAAC@@BABA@ABC@A@AAAA@AAB@@AA@@@@A@@@A@@@@@A@@AAA@@A@A@AB@@A@A@@BA@A@A@@@A@@BA@A@@@A@@@A@@BA@ABA@@B@B@BABA@AB@BC@@@A@A@@@A@AEBEBC@C@@@CCAACACAA@C@CAC@A@A@C@I@A@A@E@AACAAACAA@AAEBA@KBC@CBC@AAGCAAA@A@A@@AECAAAAA@CCECAAACAACAAAA@C@A@A@EDCBAB@B@B@BABA@A@CBE@CBCDEDCDAFADGDCBCBA@CAEAEAIGAAC@A@@BA@CBCDCBGBA@CBA@G@C@EACCCCAACMCE@ACCCAICCACAG@AAAAACCAA@C@A@C@CACAACCEGAGCI@A@CECAA@CAEACAA@@AAAAA@AAAAAA@IBAAEAAAAC@AAECEEGCCGGCGGEAEACAA@CBCBC@A@E@ABEB@HCFEDABABC@AAEACAAECCCAAAE@A@CBE@ACIACAA@AA@CE@A@A@AAE@ACCA@ICCAA@AAAAAAC@A@I@C@GAAAMAGAC@C@ICICAACAGCACAA@AAC@AACACAAECEAECKEAAAACGAAAE@A@C@ABCBADEDI@ABE@C@A@AAA@AA@C@C@A@A@ABAAC@CAEAGAEACAGACAGCAAAA@CAC@@AAA@C@AAE@CAE@A@CAEI@AA@KAC@K@C@IBC@IDC@GBA@CAAA@AAA@A@CA@@AA@A@C@I@C@ECCAAA@CAABECGBGAAAACCKCCAEAEAE@A@GAACACAE@C@CACAACAGAGAI@C@I@CAAAAA@CAAA@AAECC@C@AA@A@C@CACACEA@@EBC@ABCDCBABC@CBCAB@A@AACACCAC@E@EDEDEBADABCBEBG@CBGAE@CAAC@CAQBCBC@EDCDCBCBI@EAA@C@C@EBG@CAA@E@EBA@A@C@C@@AECCAKCC@IAE@A@CCGEEGCCCCCCAAEAC@OAE@EAC@C@A@EBCDCDCBCBE@AAC@CEEEEE@@A@"],encodeOffsets:[[102701,36622]]}},{type:"Feature",id:"632522",properties:{name:"同德县",cp:[100.578051,35.25479],childNum:1},geometry:{type:"Polygon",coordinates:["@@GDCBC@EBGBG@Sx@XANST_DOIOIcIeUOAQSIKWMmP]UYJMD_EC@@D@BA@@B@BAB@D@@ABED@BCDAB@FAB@@A@@@A@AC@@ABBDA@@@AB@BB@@@@B@B@@@@@B@@BD@@@BA@A@CEA@A@A@@@@B@BC@C@@BABAB@B@BAB@@A@A@EFAD@@A@A@CACAA@G@A@A@A@ABADABAB@B@FAB@BBB@BCFBB@B@B@B@B@D@@ABA@A@AACAC@GAAAAECCA@CAAAAA@ACCGCC@ECECAACCCCAAAC@A@AA@EDEjMVwLIBMRQHov]Ta`iJwB_BVCzADBDBJDDBDBD@B@HABCDABEBCB@DDH@BDHDFAD@DC@C@KAKBEDCD@F@FBFAH@BCAEJ@B@@@BA@@DAB@BBB@BAB@BAD@@@B@BA@@BA@@BA@ADAB@BAB@BAD@DBD@BBB@@BBB@DDBBB@D@DBBB@@BB@BAB@B@@BB@BBB@B@B@@AB@@@BA@@B@@@B@@ABA@@DAB@B@B@B@@@@@D@B@B@BBD@FB@@B@@@BBBBDBBBD@D@@AB@@@@AB@B@@@D@D@@AB@@@@AF@BAB@BA@CBC@ABA@@BA@CDABA@ABA@C@A@A@A@EBA@A@A@ABI@A@ABA@AB@B@@@D@BABAB@@CBA@CBA@AB@@@B@@@B@@B@DB@B@BAFAB@@ABABEBCBA@ABCB@BAB@@@B@@DDB@B@D@D@B@B@BBBBB@@BDD@B@B@BABABADA@ABA@A@A@@BA@AB@@@BB@@@@B@BA@ABAB@@AB@BB@@@@BBD@@BB@B@@@@BBBBBD@B@@BBDBBB@@@@@BA@@BA@@@@F@BBD@@@@@B@@@@AB@@@BA@CD@@@B@@@B@B@@@@@B@@@B@B@B@B@@@@@B@@@@@B@@@DBBAB@@CF@@@B@BBBBBBBB@@BD@D@@B@@@B@B@B@B@B@B@@B@@BB@BBB@@@D@B@B@@B@B@B@@@DABAB@@A@ABA@ABCBA@CDCBCB@B@@@B@@@BBB@@BB@@BB@BBB@@@BAB@B@@AB@B@B@@@B@@@BAFAB@BBB@D@D@@@@BD@D@BAB@B@@@B@@@@AB@@@@@B@BA@@D@@@@AB@@AB@@@B@D@D@@@B@@DDB@@B@@AD@@@B@B@@@@@D@@BBBB@BBB@B@B@@ABAB@AA@@@ABA@A@A@A@CBA@@@A@@B@@A@@B@@BB@B@BA@@DABAB@@ABABAB@@AB@@@@@@ADA@@B@@@BDB@BB@@BB@@BB@@BBB@@@BDB@B@BBB@@CDAB@@A@@BA@A@C@A@E@A@C@A@A@AB@@@@@@@B@@BB@B@@B@DDB@D@@BB@BBBB@@BB@B@B@@@B@@ABABCB@@CB@@@B@@@BB@@@@@BB@BB@@B@BB@@BB@@D@@BB@BB@BFB@@D@F@@@DBD@BDBBB@@@BBBAB@D@B@D@B@D@@@BB@@BBB@BB@BBB@@@DBB@@@B@BBB@DBB@@BB@B@BBB@@@BB@@B@B@DDBBB@@@BBB@DB@@D@@BB@@BB@@@BB@@BBBBBBBB@B@@@BBB@@@B@@@@BBBB@BAB@D@@ADAB@@CBABEFABABE@AB@@ABBB@D@@@B@B@@@@@@A@C@AAA@AAA@EB@@A@EBC@EBC@C@A@ABE@@BA@@BA@@B@B@B@B@B@@@B@@AB@@A@@@A@GAAAA@AA@AAA@@@@AAABA@@BA@@@C@A@A@A@AB@@A@@@@B@B@B@@AB@A@@ABAB@@@BAB@BAB@BAB@B@B@BAD@H@D@D@DDH@DBD@BBD@FBBBD@BADAHAH@DAD@BBDBB@B@BAB@BA@A@C@C@A@AAAA@@AC@ABK@AAAAAAAA@A@AACBA@A@AB@BAB@B@B@B@BDF@BBB@B@BB@ABCH@DAB@DBL@BBBBBB@BBBD@@@B@@AB@@@@G@ABK@A@CBA@@B@B@D@B@@@BABC@EBCBAB@DBBDBFBFDBB@B@BCBEBEBGDGDCD@B@F@B@BABABCDC@CBE@A@EBGAA@A@CBABAB@B@D@B@D@BAB@FAB@@BD@BBDDDBBDBBD@BBB@D@BBB@@BBDB@@DBD@FAB@B@FAD@DA@@DBF@BB@BBDBB@BAB@@ABABIFABCBCBC@@@ADCDAB@BADAB@D@B@BBH@B@FADBB@B@BDD@BB@BBB@@@BBB@DBBBDBBFBDBD@DABAD@@@BBB@D@BBB@B@@@@@@@@B@@@BB@@@@B@B@B@@AD@D@@@BB@@D@B@B@@BD@BBDABBD@B@@BBD@@@B@BBBDFBD@H@FBFDDFDBD@DAF@B@B@BD@DBBD@BAF@DCF@DBBBBD@H@B@BBBB@DAD@@@BA@@@@@ABABA@@BCB@@@@@@@BABBB@BB@A@@A@C@@@B@BDDDHBBB@B@BAD@F@@@D@DB@@BBBD@@AB@@FBF@B@D@JAB@BB@B@@B@B@DCBA@ABA@A@A@A@@@A@@@AF@JEBABCDABAB@BAB@FAHG@CBCBCBCBABABA@ABCFCFGDEHIDCNKLGBA@CBAA@@AC@CAAC@@CAA@CACAEA@A@C@AA@A@AAA@ACA@@AAC@C@@@E@ABCBA@EDEBEDCBC@AAA@@AC@CAE@@@CBCBEBCDCDEBC@CBC@CAAAAA@@A@A@I@@BAAA@AAA@ABAB@BC@ADA@@@A@C@EAA@@BA@@@@@@D@F@DAB@BBD@HAB@FBF@HBB@BADAF@B@D@FBFBF@BAB@@B@BDBB@@B@DABBF@BBDDBB@BB@@BDBBB@DB@B@@@D@@DD@BBDDBDD@BB@@@DABA@@B@@A@ABA@A@A@ABAFAFCBABC@C@CB@BAFABAFCD@BAFCFADABA@@D@DAD@B@B@B@B@@@HC@AB@B@BADADAD@B@B@BAB@FAFAB@FAD@HEBABCDABABCBCBE@CAC@E@CBCDCBABCBAHEFAJCDCDGBCBCAA@@CC@A@@@A@AD@HCD@HAB@BADADAD@B@B@B@B@HGD@BBDAB@@AB@BAB@B@DBB@D@DAF@D@FADCB@B@BB@@BBD@DAB@BBB@D@J@BBB@FBF@D@D@B@HCD@BABBF@B@B@B@DCDAB@FCD@B@D@D@B@B@B@DBDBB@BBF@B@@B@BBB@@BBD@FA@@BBDAB@BAF@F@B@B@BBFDBB@BB@@@B@B@BABA@@B@B@BBF@FDD@DAB@@@BBB@DADADADAB@B@@@B@BDBDB@BBB@FA@@DBB@FBBBD@@@B@FDB@B@F@D@D@B@HBFAN@D@DB@@@CACAECC@C@C@CBC@EDEBGBCDEBAJW@AB@@ABA@@BA\\MFAPIFODMX@D@TAJ@N@\\CFARAHAXCF@BA@@@@@A@M@G@CBA@@^BlLJEDAJAHCJAHCFAJA@@FKBCBAHBJ@J@B@DCD@DAH@BEBADCBABACAaeaeQSEC]WGCIGcUMOAAEAECECECCCCCCCECCCECECGCAACCE@CCGA@@ICGAE@MCICA@@@CCKGECOOEIAAGAC@ICCACAA@C@EBGBCBAABADADC@A@ABABC@ABA@@BADC@A@ACCAAAAC@AACGAACCCAECAA@@@@BABA@A@CDC@A@A@@BAAAB@AC@C@AACAAAC@AAA@C@@@A@A@A@C@@@ABA@A@@@A@A@A@C@A@CB@BAHCBCD@B@JAD@B@DCDGBCD@@@BADCFE@CB@@EDEDABCDAFCFAFAHAFCDAJEFAFAFCFAHEHAHAFAFADAFCFAFEBEBCDADAHCHAFAF@DAF@F@HBF@H@DAF@F@HAJ@JCDAD@F@D@FAH@DCHAFAD@JCF@FADAFADAFADAJCFCFCDAFCDADCBCBCHEDCBADCBEDABEBCBCDEDCBCBCBCFCFCDCJC@AHCDAFA@@FADCHAFCHCHAFCFAFAFAHAH@D@FBH@F@B@DBPAJABCBAAAACEE@CBCD@BADCFGDE@ABALCFANDFAF@H@LBF@HBFBFAH@DAFCHE@ADCD@DDDDDBBBB@F@LGDAH@BAB@BGBADABA@A@A@CACAE@@@C@A@A@@B@@AB@@C@A@@@AAC@ADEDI@@JEBCBEDI@ACGCCCCGECCAA@@AA@A@@AAACCC@AAC@CAC@@@@@@CE@@C@CBA@MCcCS@AC@CAGCECA@@@CAC@C@CBA@EBCBGFEFEBEBCDEBCBCBCDEBGBEBC@C@C@CAEAG@CAC@C@CEGGAIAEDCDEDC@G@CAGCACAE@EAEAC@C@EAA@C@C@G@C@GAECGACEE@@CECEAECECAEGCCECCACCCAEAEEEAEAICCAEAEBE@E@CBEBGDCBCBCDEHEDGFEFCBGBEBC@IBC@G@E@E@C@G@EAEAEACAE@IAG@G@G@G@"],encodeOffsets:[[103330,35491]]}},{type:"Feature",id:"632523",properties:{name:"贵德县",cp:[101.433391,36.040166],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@H@D@b@B@@@FCFCDADBDBH@NBD@JAB@DAHCLG@@B@B@DBB@D@BB@ADAD@BA@@BA@A@A@A@@@AAACAAC@A@A@A@ABA@@AA@AACAC@ABCBEBAHABAB@DAB@B@@A@A@AB@@A@@BAACBAAC@A@C@ACGAAAA@C@E@A@C@ABA@@AA@@AAAAA@CA@AA@@AB@ACAKCKCKEECACAACA@GCAAC@AEEAGAECCAEAAAACCEAAAC@A@AE@C@AACCCCACBC@ACCEGGCAEACCCECAAGACA@@@@@ABE@GBCBC@ABABA@ABE@C@A@@I@@@@D@@A@CCACCAE@C@EBABAB@ADE@E@CCEAEAA@A@AAEESEEAAEECCAECC@ABADADADAD@DAB@@ACA@CGCAAOIEAA@CCAAGCAAC@C@A@CC@AC@GAGCCAACAC@CCAA@ABCBCBC@CAEAC@C@C@CACAIGCDABEDEDGDCBC@AACAC@C@E@EAAAAB@BCBADCBCDAD@BABAF@F@B@B@@EFCBA@A@ECEA@@AA@BCBEDAFADABABBDADAB@B@DADA@CBAAC@CBA@@@AAAAA@CAA@A@CBABE@C@@BAFCFABA@CB@@CDGHA@CBCBABGDA@ABADADADADAD@F@DBBBBBBB@F@BB@BBB@DAF@@CDA@AD@BAFAF@DADADADCFEFADCBEBA@CBA@ADCFA@CBAB@B@B@B@BBDBDBBBD@B@B@DAB@@@F@@ED@@@B@@BD@BABCBA@CB@@AB@BABCBAB@FABCDC@A@C@ABA@AB@D@DAFABCBEBCAE@AB@@CDA@MNABCBADCDCDABKHIFA@EDC@A@A@@@BA@CBEBEAEAKAABA@E@A@E@C@G@CBA@ADCBAHEDCDCBC@C@@AAAAAAAAAC@A@C@A@EA@AAAAC@A@AB@@@D@@EBABABBD@DADAD@BBH@B@BAB@@AD@@CBA@AB@B@B@D@B@BADCHGJ@BADABEBEDGDEDAF@@C@@@C@@@ABAFAD@B@F@DBBBF@B@@CBE@EBABA@@DBB@@FBFBHFFDBD@@@FALAH@DABCBCFCF@@BDFHBF@B@D@BAFGF@@@BBDBLDBBD@@FTDJDLBD@DBF@D@D@D@H@H@HBBBDFF@D@BIHCBEBGDCBCBGBGDGDGDC@@BBBBDDBBL@BAB@BABA@@B@@@BBB@BAB@B@BGFB@DBFDFDDDDBFBB@B@@DBDBDBBBBBFBNBDBF@BBFBDBDBB@DBFCBCDMHCBABCBGHABCAAAAACCCAOCC@C@KAG@I@E@CBMAK@AB@@A@@B@@@@@@@B@@BB@@@@@@@BA@B@A@@B@B@@@B@@@@@B@@@@@@@B@@BA@BA@@@B@@B@@@@@B@@@@@@@@@B@@@@@@B@@@@@@@@B@@@@@@@@A@@@@@B@@B@@@@@@@@@@@@@BA@@@@@B@@@@B@@@@@@A@B@@@@@@B@@@@@B@@@@@@@B@@@@B@@@@@A@B@@BBA@B@@@@@@@BB@@@B@B@@B@@B@@@A@@BB@@A@@@@@B@@@@B@@B@@@@@@@@@@B@@@A@@@@@@@@B@@@@@@@@B@@@@B@@A@@@B@@BA@@AAB@@@B@@@B@@@@A@@@A@AB@@@@@@@@@@@@ABB@A@@@@@@@A@@@@B@@@@A@@@@@@@@B@@@@@@@@@@A@@B@@A@@@@@@@@@AB@@B@@@@@A@AB@@B@A@@B@@@B@@@@@@@@@@@@@@@BA@B@@@@@@B@@@@@B@@@@@B@@A@@@A@@@AAA@@@ABA@A@A@@A@@AB@@A@@@@B@@@@A@A@A@AB@@@@@@@B@@@@@@AB@B@@@AA@@@A@@B@@@@A@@@@@AB@@@@@AAB@@@@@BA@@@@BA@@@CB@@A@CD@@AD@@@J@B@@@BBJBB@BA@@BCDAB@@BDBDDD@D@B@BB@AB@BCH@@@@A@@@@@@BA@B@@@@B@BEVAP@DB@@@@BADB@@@BD@@@BB@@@@BA@@BA@@@@@@B@@BBB@@B@@BB@@@@B@B@@BB@AB@@@@@BB@B@BBB@@B@@@B@B@B@BCBABAD@B@BDD@B@B@BCB@DCD@@@BCAE@CBCBC@E@A@A@CAEACACAA@CAE@@@AB@B@B@D@@@BA@A@E@A@IAC@A@C@ABCBCD@@A@C@ECA@A@EAE@@@A@CCCAAA@ACAAA@@AA@@@AA@@AC@@@AA@AA@CC@@@AA@@A@@ABA@A@@BA@CB@@A@@AAAAAA@AC@@AA@AA@A@AAE@@@ABA@CD@B@BAB@B@@ABABABA@C@AAA@C@A@A@ABA@ABEB@@ABADABCD@B@@AB@B@@@B@B@BABAB@BA@@BA@C@A@AB@@A@@@CB@@ED@BA@@BCD@@A@A@@@@@AAAA@AA@A@C@K@G@A@@@A@A@A@CA@@CCA@A@@@A@A@A@A@ABA@A@A@@@EAAAA@@AA@EAAACAA@@AA@A@@@A@A@ABCBCB@BABAB@BAF@BAD@@@B@B@B@DABEBABABABC@ABA@@@A@EAA@C@@@A@@BA@@D@@@BADABA@ABAB@@AD@@ABCA@@AB@@@B@B@B@@@BB@@@@BBB@BAB@B@BAB@@@B@B@@@F@BABA@@@A@@@@@@@@A@@AAA@A@A@ABABAB@@GDIDCBC@CBA@@@@B@B@@@B@B@@ADA@@BABABA@A@@@AB@BAB@@@B@@@B@@@BAB@@@B@@@B@BAB@@@B@BA@@B@B@BB@BB@@@B@@@@@B@@@B@@B@@BA@BBB@BB@B@BAD@BABAB@@BDBB@B@BA@@BA@@B@B@@@@BBA@ADABA@@B@FAB@BADADAB@@AD@BAB@B@B@@@B@@ADADA@AB@@@D@B@@@B@BABB@BDBD@B@@@B@B@B@BAB@BA@C@A@@BA@BD@@BDDBD@DBB@FABABBB@B@D@BAB@BAB@B@D@B@BA@@B@BB@@BABA@AB@@@D@BAB@@@@@@@@B@@@B@B@B@B@B@@BBABB@AD@BA@A@A@@B@@ABBDA@AD@BA@@@ABABC@ABA@A@@@A@@B@@@B@B@B@D@D@BA@@B@@BBBFB@BBB@D@@@D@DDBBB@BBBB@@BBB@@B@@DB@@@B@@BBB@B@@AB@B@@B@@B@@@@B@@@@@@B@@@@@B@@D@@@@@BFBBBB@DB@@DBFABAD@B@B@B@DDBB@@@@D@@@FDB@@@BB@B@BDB@BBB@@B@BBD@B@@@DBB@B@BBDDB@@@B@@@D@lOJCHAFABAPCB@BCB@@@BAB@B@B@D@F@B@@@B@BBF@DBB@B@BB@@B@DB@@B@@@B@@BB@BB@@@BF@DBD@B@@BB@B@D@D@@BB@@@B@BBB@BBBBD@B@B@BBDADAD@DAB@@BBBDDBBBD@@@@B@DD@B@@BBBB@@BBBDFDBB@B@F@@DBBBBD@B@B@@@BB@@BBBFD@@BDB@@B@@BB@@BB@@BBB@DBDBB@BBB@@@DBD@F@@BB@D@@@BAB@@ADAB@@@@@@@DB@BB@@BDBBB@@@@@BBBB@BAHAHCF@B@LAH@BAFABABIBGJDNEFB@@H@HAFGD@B@DFFF@DBFBBDBH@H@FBH@DBBDBD@DBDALBDBBTDHDHFHBJBPBDBBFL^HRHLFD@BFFDDDBNHD@B@B@BADADC@C@ADCJCLCHCPELAH@RAN@D@FA@A@@@EBEFCFELIJEDAJANAJAJAHAHEHEJCJAFCD@F@FAFAHAD@B@FAJCNE@@LCLALBFAJ@F@JBJDFDF@JAPCJAHCDADC@CAE@C@G@GBG@GCI@G@G@A@EHBHBJ@J@FADCDC@ADADAD@BCBAFEH@DABA@@BGAGBCBEBCFCDEFGFIBCDCFCHELEBC@ACC@A@CBC@CDG@AAACCAACCCCAAAEBEBE@E@K@@@C@CDA@ACKCK@E@GAAGCSCE@AA@ACG@ECE@I@A@A@CAEAECG@EACAGBG@I@EDEFGFGFGACGGCG@CBEFCBCDEDGBCFEBCACECCECECI@AAG@E@CDEHE@@BCAGAE@EAABEBADAHCBADEACEAI@GBC@AA@ABAB@NCDAB@@A@A@@@A@C@@@@@@BCB@@AD@B@BABABA@AB@D@@@B@BCBCDA@ADC@@BC@@B@BA@@@A@AAC@AB@BADCBABA@A@A@@A@AA@@@A@ABCDC@AAA@ADE@AAA@AB@BA@@@@@C@A@C@C@@ACA@@CB@@@AC@AEE@@@@@A@@BADABABA@@BAB@B@B@@ABA@AD@DCB@DCB@@ADBFAB@B@@ADA@@BABA@@@@BC@@B@B@D@B@FCB@B@@@DADAD@BA@@BA@AB@J@B@@AB@@CBA@@DABAB@@C@@@A@ACAA@AA@A@CA@A@A@A@A@CBA@A@@BC@A@ABAA@@AACCAA@@@AAAAAAC@@ACGEACACACA@AAAA@C@AAAAAGGEGA@ACCCECAA@@A@A@@C@@CA@AAAAC@A@@@C@@AA@A@@AAB@A@@AAAC@@@@A@C@@@ACCAAAA@CAA@@AECACCCE@C@GBEDIBCFGDE@A@@@@@@GA@@A@CC@@AA@ACB@AABA@A@A@AA@@AA@@@AB@B@B@@@@A@A@ABA@C@@@AA@@@BA@@BAD@@@B@@AB@@@@A@A@@BAB@@@@@@C@@B@B@@ADCB@DA@@B@@@BE@@BA@@@A@A@AB@DAFAB@"],encodeOffsets:[[104127,36616]]}},{type:"Feature",id:"632524",properties:{name:"兴海县",cp:[99.987965,35.588612],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAECCAGAKAIAA@ECECGEGCCEECCCEECCCGAECKCECCAECECEAGAGBEBC@EBGDAC@ABE@AAA@C@EAACBA@GBA@ABC@E@CBE@EAA@E@CBA@CAIAEAAAE@EBKBGBGFGFGDMFEBC@C@CBGDG@GAECE@CBEFCDCDEDCBGDABEDI@C@ICC@CBEBCBEBCBCDEDE@EAGAGEEEEEECGBEDABEDAAGAC@C@ACCCEACBG@EDCBAFADCFCBIDCBAB@B@DADCBCBCDCBABC@A@E@MHA@C@CAEAECC@A@ABEHABE@ABABABCBICE@KBCDABAD@FABGFCBCB@D@D@B@D@FABEFABEFIFIFGBGDIBGBCBC@G@G@IDCBCDGDEDGFAFEH@FAJADEFEBC@IAEAC@@@GAC@EAGBG@I@C@E@GBE@SDEBE@CBEBEDIHCFEBCBGDGDEBEBIBEAEAGAEAEAIAE@C@E@GBG@EBCBG@EBEBCBAAG@CBEBMHEBCDCFCFEDEFCDCD@F@DADEBE@CDGHAF@@GFCDABE@EAEBCBADBDBBBDDD@BAHADADCDABCFCFABCDCB@DABCFEBCDCBCDCBEBC@EFCDCDADCDCJCBAFCDAFADABADCFADADAH@F@@A@@BCBABAD@@@@AB@@A@A@A@@@AAG@A@C@GBE@CBGB@BABA@BB@B@B@B@B@B@@B@B@BB@@B@@@@BBD@B@BA@C@@@C@@B@@@B@@ABA@@@A@@B@BBB@BBD@@ABAD@B@B@DABAD@B@BA@ABA@AAEAC@ABCDAF@@ADABEDC@CDAB@DCDADADGBCDGHEDEFEHCBCFCDCF@FCFAFEFCDADCFCFAFCBADEFCDEDEDE@CBEBI@E@EBC@CBGBCDGDAD@D@D@D@HADCFCDCDGBCBGDC@EBE@C@@BCB@B@@BB@B@@ABABCBCDAB@BABA@E@A@@B@BCDABCD@B@FAVAP@FAH@BHHBBLNDDFFFFFFFFDFDFBDFBHDFDHDFDBBD@JFNNBBBDDBBDDBBBDFBDB@BDHFFAB@B@hFFBDB@@@BB@@@DBB@@@BBDBB@BB@@@@HDHD@B@@@@A@A@A@A@@@B@B@BB@BBAB@@BDB@@BB@B@@B@@@B@@A@BB@@@BBB@@@@@@@AB@@CDABCD@B@@BBBD@FBDBBBDDD@B@DABCBEDBDDB@BBBCDC@A@A@ADCDADABA@A@GAA@A@ABEBC@CBABC@ABABA@CBADAF@BB@BBDB@B@@ABC@EBI@E@CBK@E@C@KAABCB@@I@CAC@C@CBEBA@CBE@A@CBCBA@CB@B@D@BA@EDIDE@@BDD@DBBHFBDBD@BDJB@LAHAH@LDD@B@B@B@DAH@F@FAD@HAJAHAB@B@@@@FBB@BJDBBFHBFBB@D@DBH@B@BDDDBDBHBFDFBJBRFJDB@D@D@D@F@B@@@D@BAB@D@D@FBB@BBB@@B@@@B@@A@@BA@@B@@@B@B@@A@AB@@C@@AA@A@A@@@GFCJAD@@@@EP@BA@@BA@@BAJAHABA@@@@BABAB@BGFCB@@A@CDC@CBABAB@@AB@BSJMDeROHKDKFGDEDOHGBGDIDCDCBCDA@A@EBCAABAB@B@B@D@@CBABEBAACAEAACECCCA@AAA@ABC@CBCDAB@D@DAFABC@A@GDC@CACA@BB@HF@BBD@DABBBBB@FBBDBBB@DDDFDBBBB@D@BBB@DBDBBBDF@DBD@BBDBDDBB@DBBBDTTFJBB@D@BADAF@D@BDHDJ@D@@ABADAB@@@H@DBDDD@DBL@B@DCDCDABGBEBABCD@B@D@DADCFCBAFBBADCHABBFCBCFCDEFCFADCFCFCDCDCFAFMHK@G@E@G@GBEDCBCDEBEH@@ADADAFADAFBH@F@BHDFHBB@DADAFCL@FBDDH@DAJAJADEDABGHADCDEBC@EBCDABDBBDDDBBFHDFBFDBBF@H@F@DAFADADCFCDABCFCDEHCDCDEDEHEDCFCFGFADCFCDCDAFABCD@F@FBFDFDBDFDDBDBDDDDDDDBDBDCLCDGLGHGHIHKJGFGFIFEDEFGFGDEBGBIBGBEBGDABEDAJDJFLBHBJ@HADEDDDHBF@JBDBBBB@FDDBBBBF@@B@D@FCJAF@FAD@F@D@LEDCB@B@BBB@B@@@FCB@DAFKBABAFCBAD@DAFBB@B@LAB@H@F@@@BDBF@BBD@FBBBBBDBDB@BDDDBB@D@B@BAD@B@BBBBDD@BBF@D@DBD@DDFB@BJADAD@B@DA@@DIFCJIDADCD@B@B@BBFDDB@@D@D@B@F@BB@@@B@B@@@@B@B@FBB@FBFBB@B@BBDBB@F@D@B@BBDD@@@DBD@BBBHBDDD@B@B@DAJCDCD@BAB@BBB@B@FCDCB@DADAFAD@B@BBD@F@BBD@DDDDB@B@@AFABAF@B@B@BADCDCDCB@BBBBB@@@BAB@BAD@B@B@@@DBDDB@DA@@B@DA@@D@DBBBD@BAB@BCB@@AD@B@B@DDDBBBB@@@BFBD@BD@D@B@DABA@AB@D@B@FBJ@B@@BBBBB@BBBDBFBDB@@BAB@BAHGFADA@AB@B@B@D@HDB@BAB@B@@ABC@ABABCFCBA@AB@B@BAB@B@@BDBJD@@BAROB@DAJ@BAD@BADCBCBA@AAAAC@ABA@A@@@A@@AA@@@AC@@A@A@@@ABA@E@A@E@A@@B@BAFADAB@@A@@@A@A@A@ABABABEBEDE@CBEBE@E@A@CBA@@DE@ABAAC@C@C@@BABAFCD@B@D@D@DB@@@@@A@ABC@ADABADAD@DBB@BA@@BG@A@E@EBCBAB@B@H@FBD@DBBB@BBFBBJNFDFDDBFDDDHHDFDDFDDBF@@B@BBB@B@BBL@HB@@B@@B@BBBAD@B@BB@@BB@B@D@DB@BB@@BBBA@@@EDCBADAB@B@BA@AB@HA@@BC@CBA@ABEBCBADCB@@@F@BADC@@BAB@@ABCAC@A@ABAD@BAB@@A@C@CBCBADABAB@F@D@FBF@FBD@BB@@DA@AAE@C@A@AD@BA@ABCBA@@BABCB@B@@ABA@A@A@A@A@AB@DA@@@A@E@ACC@@@ABAB@JEBAB@B@B@F@BAD@DC@AB@@@B@D@B@B@BBB@B@BA@@D@@@DAB@BAB@DBB@FDB@B@BB@@BBBDBB@BBB@D@@@@B@@@D@@@@@BBBB@BBB@BB@B@JF@@BB@B@BB@@BF@BBD@B@B@@@BB@B@DDB@B@@@BABABAD@DAD@BEDADADCDADCJADAB@@BBFFFHBDBBDBFDFBFBFDBB@BBD@DHFBFBD@@FDDB@@@B@B@F@@BBB@BBBAB@@@BBFFBBBBB@@FBBBBD@DBDBHDFBB@@B@B@@AD@D@B@@B@D@@AB@B@D@V@D@F@BB@@@@BB@BBBBDDBBBBABB@@BBBBDD@BA@@BA@@B@DAD@B@B@DBB@B@DBDBDBBB@DBDBD@@B@@@B@@@BAD@BBF@B@B@B@@@@D@B@DBD@BBB@@AB@B@D@B@BB@@BBBBB@B@DA@@D@B@@@@BAFAF@FBDBBDDBD@HBL@H@F@DABCB@B@@DBF@DBBBFFBBDBBDDBDBHDB@B@B@@@B@B@@@@B@FBB@BBD@D@BB@@BBB@B@B@@DDB@DB@@BDJFNHDDNHBBHDFDDBLHHFTLNJFDFDNJB@@@B@BABAPIHEPKDCl]HEDALIDABA@@AA@@CEACCAACEEAA@A@@B@@@FCDALGNGRIBAJGBCDCDGBCBC@A@A@A@C@ABABCBABCBCHEDCHEDABBB@BAB@BA@@@A@@BA@A@@@@@C@@@A@@B@B@D@B@BABG@EBABABA@@HMBABEBA@@@A@@B@@A@A@@BB@@B@@@DA@AD@AAB@B@BA@BB@@@BADBB@@@B@DB@B@@@@B@@@B@@@@B@@@@@@@@B@@@B@@B@B@@B@B@@@@@BAB@@A@@BA@@BA@@@@BA@@B@B@B@BA@BB@@@B@@@BAB@@B@B@@DA@B@@B@@BB@@@ABA@@B@@@BBB@@@@B@D@B@@@B@D@B@@@@A@@BAB@@A@@@A@@@A@@@A@@BA@@@@@ABA@@BA@@BAB@B@@AA@@A@@@AB@BA@@@@@@@A@@BADC@@@A@AB@@@A@@@@A@@@@DAD@BBB@B@B@BAB@DC@@@C@CBA@@@GDO@@VSHEFEFCVSTQBABABAFEDAJIHEDCDCLIDCBAJIB@HCBAJEHED@HEJEBAB@@ABC@@@@BCBADMBCBCBEBCBCHSBCFKBEBCHQDIBCDGL[BCBEFI@CDGBA@ABCDKDEBC@ADEHUFIBCFM@CDEFMBCBAFMBEBCBC@@BC@C@@HQ@EBABGBEBCBCBEBE@A@CHUBEDIDI@C@ABADAB@@@BE@ABABE@CBC@C@@AA@@CAC@CAAAA@@ACAA@ECC@AA@A@@@A@C@@@@CGCC@A@AAA@C@A@AAAA@AACA@@@A@A@A@C@A@AAAAAAAAA@@@@AAB@@AB@@ABAB@@ABC@A@A@@AA@A@C@@BA@AB@@ABA@@@A@@@AAA@@A@C@@@CE@AAAAA@@IAA@A@AAAAEE@@CCC@CAA@C@A@@@AB@@G@@@@B@@@@C@@@A@@AA@CAAAA@C@C@EB@@AACA@@@A@@@AB@BABADAD@L@FAB@@A@@@A@@@@@A@ACA@@A@@A@@@@BA@A@C@A@@@AA@AAA@AA@@C@AAA@A@EAA@AAAACGAC@A@@@@DABABA@@BA@@ACAA@@CAC@@@E@C@ABA@A@AACGCC@A@A@@@D@BB@A@@AAABA@A@@@@@@DA@AB@BABA@@@@B@@A@@BC@CAAAAA@G@C@AAAA@CDE@CBE@AACCAC@@A@A@ABE@CACECCCAE@E@GACCEAA@A@A@@AC@AA@C@AACBAAC@@AA@A@C@@@AA@@C@C@@BA@A@A@@@@@AA@@A@@@@@@@@@@AAA@A@CAA@A@@BCBA@CACACAECAAACAA@AA@@A@AAA@@ACC@A@AAABC@E@AAG@A@A@CBABC@ABADCBC@@D@DADABAJEBABA@@BA@AAAAC@AAAE@CA@@CBC@EBA@A@EBC@CA@@CAAA@@AA@A@CAA@AACCAAACCAC@AAC@@BA@EBA@A@C@A@C@ABABADAB@B@HBFAB@F@DAD@DCBABA@A@A@E@ADCHCHCFAFADA@A@AAAECEACAAA@CBADAFAD@BA@A@@@A@C@A@AB@DAB@L@BAH@@@@@BA@@@A@@ACAAA@AAAA@AAK@CBA@CDGBAA@@A@AAA@ACE@A@A@A@ABA@ABAB@B@DABBB@B@BBBBBB@BAL@BBD@@BBBBB@D@D@B@B@@ABA@A@AAAAC@ABC@CBGBGBC@AACAA@EAC@AAC@CCG@C@C@C@GBC@A@A@ABA@ABA@ABA@A@@BABA@@@BBA@@@A@A@A@@B@@@BAB@B@B@D@@@B@@AB@BABB@@@@BB@BBBB@BBHBB@@@B@@@BA@@@A@@@A@A@A@A@AB@@AB@@AF@BAB@D@D@FAD@FAB@@@FAB@BBB@BBD@B@@@@@@@@A@A@@@CAABA@@BAF@BABAFEBADA@@BABC@@@CBA@AAAAA@@@@@A@@AA@A@@@AAAAAAAAA@@AA@@A@@AA@@AC@@@CAA@AA@@A@AACCA@A@@@AA@@A@AAA@A@@AA@CAA@AAA@@@A@CA@@A@AAA@@AAA@AA@@A@@@C@A@C@A@CBAAA@A@@AACA@AAC@C@@@E@CA@AEA@@AAA@@@CA@@AA@@A@AA@@AAA@@@@A@@A@@@A@@DA@@DABABA@@@A@@@A@AAA@@AAAAA@@AC@A@CCA@@@@AAA@@@A@@@@@@BAB@B@D@B@F@B@D@B@B@@AB@@@BADC@@AA@A@ACA@A@@AA@AA@@AA@@AA@@ACA@A@@@AB@BC@@@@@@BA@@BABABA@@BABA@CB@@A@AAA@@@AB@@@@AB@@@B@DAB@B@B@B@BA@@B@@BBABA@@@A@AAA@AAAAA@@@C@@@@@A@A@@BC@@@AA@CC@@@A@@@C@C@A@@BA@@BA@@@@@CB@@A@A@@@@BA@@@@@A@@@ABA@A@CAC@@@@@C@CAA@ABABE@A@@@A@@@A@ABA@@@ABA@A@@AA@AAA@@AA@@AA@A@@@A@@@ADADADCB@DABAB@BAB@@@BABA@C@@@A@A@AA@A@C@@@A@AAA@@AA@@@@A@A@A@A@A@A@@@AC@C@@AA@AAAAAA@A@A@@DE@@BAAA@C@@@A@@@@@A@@@@@A@A@A@A@@@A@@@@@A@A@@@A@@DCB@@A@@BA@@@@@A@@@@AC@A@E@@B@@AB@@A@@@@AACAAA@@@AACAAAA@@@@@AAA@@AC@A@@A@@ABA@@BABAB@@A@A@@A@@A@@BAB@@AB@B@B@BAB@BCBABA@A@A@ACC@AA@AAAAA@A@C@C@A@A@CC@@@A@@BA@ADABAB@DAFABABA@@BABE@A@ACAA@@@@A@@@A@@BAB@DAB@DA@@BABA@A@C@@@ABAB@BAB@J@BAB@B@B@FAB@B@B@D@B@BAB@BADCB@@AB@BAD@DAB@@ABA@ABE@@@@BA@@@C@C@@@ABA@@@@BA@@@CACAAACAA@A@@@AA@@EAC@A@A@A@C@@@@@A@A@ABA@CB@BA@@@A@@@AB@@A@@BA@@@A@AAA@AAA@@@ABA@AAA@@AACAC@A@AACCA@AA@@AA@AAC@CBC@ABA@ABABCB@@AB@@AB@@A@A@@BC@ABA@AAA@ABA@CB@@A@@@AFIAAA@CACAAACAC@A@A@A@@@CCAA@AA@CAA@AAA@C@C@AAA@A@GA@AC@A@AACAGCAA@@A@IBEBEBGAG@GAI@C@G@GAE@GDEBG@C@GBG@I@EAE@GAKAGAGAG@EAE@IAEBI@M@G@EAIAIBGAMAEEACACCE"],encodeOffsets:[[102502,35714]]}},{type:"Feature",id:"632525",properties:{name:"贵南县",cp:[100.747503,35.586714],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@B@DB@@B@D@VGDAFCB@@A@@DCHEBA@@@A@CA@CA@@AEAC@A@C@CAAES@A@ABABC@@@A@C@A@ADAHCB@@@@A@AAC@@@AB@B@@ABA@C@A@@@@@C@@ACACCAEGAAA@GAG@CAA@@AAAC@@@@A@IAA@AA@AAC@C@EECA@AC@AA@@AC@AA@@AA@AA@A@@@A@C@ACE@ACA@A@@@A@@AAEDA@ABCBC@A@A@CBA@GAG@M@I@C@AACCA@C@@@CD@@A@A@A@AB@@@DABAF@DCHCHAFEPCJ@D@B@BABA@A@CAA@EBC@CAC@C@@@A@AAC@CBSKaSA@AA@@DENMFG@ABGBEBEBEDI@CA@@A@@@ADABCBC@@BA@AAA@@@A@A@@@CBAB@@C@@@AA@@AA@EC@@EACAAAAAAAA@AACCCA@AAA@@@AA@C@@@CBA@EAA@C@E@E@CBAACBC@A@GAC@@@A@@@A@@@AA@@CBA@A@CBA@@@A@GDKFCBADBBBL@@DHDLDFBDDD@D@DCH@DAFAJCD@BAD@FAF@L@BBJAD@B@@BBA@@BCFCFEFGLCDABB@@D@@@BA@ABA@@@A@@B@@@@B@B@@B@@A@ABCA@@@BA@@BA@BDBBBBB@@AB@D@NARFJBTDH@JDH@H@JB^F@@L@LBB@HDD@B@DAFAB@TGbGB@FAF@@BbZPJBBHD@@B@BDHFDBDDBBBFBHBB@@BDFN@@@@B@@@@AB@D@DAD@JADA"],["@@A@GDEBEDCBEBEBEBG@EBG@EBE@G@G@@AG@G@EAG@GA@@C@A@E@CBE@IBCDEBADADABADCFEFGDG@G@GBE@GBA@A@IBGBA@EBEBIFCDCBGDEBGBC@GCEECCE@EDCDCD@@CDCBEBCBCBE@@@E@E@GAG@CAI@G@E@GBE@CBCBG@IBE@E@C@E@I@E@G@E@C@A@E@E@G@G@C@GAG@EAI@G@E@I@GAG@EAIAG@C@EAGAG@EAEAGAC@GBGDEDCBCBADEFCBGDEDE@C@EAE@G@EBE@E@IBC@GBEBE@EBABC@IDEBG@CBE@E@E@EBC@GBEBEBGBC@CBC@EBC@GCCAAA@ACEAAACACEECCCACAE@G@E@A@C@C@E@EBE@CBE@E@IFEBA@E@@@C@C@EBEAGAGAG@G@EBC@IBEACBC@C@GDE@CBE@E@EBEAG@@@C@G@C@IEAC@@CAC@M@EBGAA@C@C@E@A@A@ECA@@@C@AAEAA@CA@@EBA@AAA@ACACA@@@A@A@CBCBCBCBA@AA@@A@CBC@ECE@AAA@A@@@ABABA@A@@@A@@AAAECAAA@A@E@E@ABA@CBAA@@EBC@AA@@AA@A@AA@E@AAA@CACAA@A@A@C@C@A@C@EDA@CBCDA@A@A@E@AAABC@GDA@C@C@E@EAA@AAI@C@A@AAA@CBC@AA@@AAA@A@CDEBC@E@CBC@A@CAA@A@ABA@@BA@CBAAC@GHA@A@A@A@C@CBCBABA@GBC@GDC@@B@B@@@BDD@@BBADADCHCDIDEBGFABADABCDAD@D@FBD@DAFADADABCBADABGFC@EBA@EBEBA@ABA@A@C@CBCBABA@A@@BGD@@A@A@A@A@C@CBC@@@ABCBEBEDABC@EDABEBABA@@D@DADABEDEBAB@B@B@BAB@B@BA@@@ABCB@@A@@ACCCAAC@ACC@@@C@@@ACAA@AAAC@@AAA@CAAC@AAEBA@C@AA@CA@A@AA@ABE@EAEAC@A@E@CBABA@GAE@EAA@GBC@AAA@CBE@C@@@@@@@AB@@BB@F@D@B@@CB@BADA@AB@BBB@BBBAB@@@J@B@BB@BBBB@DAD@DADCFCDADAFAD@D@@BF@DBD@@BB@BADCDAFCF@FABAD@B@F@@@DBD@BB@BDB@BBB@B@@B@D@BFBDBDBB@DB@@BDDBD@@BB@AB@DABKHMLCDGJCFEHEDAD@BABABABADADAD@DGHEBA@ABA@ABCBADABIFE@@B@@@B@@@B@B@BAB@BABCDA@A@@@@AAAA@IBC@A@E@EAABABCB@@@@@BBDDHBBBBB@FBB@B@BBD@@@BBB@BBB@@B@@@B@D@BAB@@@@@BB@@@DB@B@B@@@@@B@@@BA@EBK@C@CBABABA@@B@@@B@@DBBB@@FAD@D@B@BBDBB@@BB@@@D@@@@@@A@@H@@@BA@@B@D@B@DBD@DD@@FFBBBBB@B@JB@@BBBB@BDF@@D@B@@@BB@B@@@B@@AB@BA@@BAB@@@D@BBB@@@B@BAD@BA@AB@BA@@BA@BB@@@@BBBBBBBB@B@B@D@B@B@B@@DBBBB@BB@B@B@DBB@B@BDDDH@@@@@D@B@@@BBBD@FDB@DB@BB@BBDBD@DB@@BB@@@DAD@DAFAB@BAFFFFFDFD@BBF@DADADCDCFAB@D@D@FBF@PBD@FBBBDDDDDDFHHFDDB@F@JBD@LDDBFD@BD@D@B@B@FAF@B@DBH@FAD@D@B@FBJ@DADADCFCD@DARADBD@BB@DBFAH@DAHAFADCBABCFCF@F@FBDDDDBBBB@A@DBDAD@BADADCBAD@FA@@FBBDBD@D@D@BBBD@D@FDBBB@BB@DBBBBDBJ@D@J@HBHBDBBBBD@D@DBFBDBDHBB@F@FBFBDBLDDDBBBBAHDHAFBB@DBBDBFDD@J@D@B@B@@BB@@D@BBB@BBBDBB@HAD@JCD@JAD@L@D@LBB@@BFJDBB@F@DBF@BBD@B@BB@@BD@DBBBBHDDBHBDBFBHBFBDBD@BBBAB@B@D@D@B@@BBB@B@B@DAF@BCJCFABAD@B@D@BBFBBDHBBBBLFFDFBFDBBBDBD@BBD@BBBBDHDDBBBJDJDD@D@HBNBBBHBD@J@B@D@BBBBBBB@DBJDB@DD@BBF@B@B@BDFB@@BBBBDDJ@BAF@D@BBFBBDDFDBBBDBF@BADABCBEFGDA@AF@B@F@BADAD@DBBBDBFHFDHHHDDFHDFBF@BBDBBFBBBJAB@BBBB@BBBBB@BB@DBFBDBB@DBDFB@J@HDHBDFBDDBDBD@B@D@B@DBBDBBBBH@DBDBJDDBDD@BDFDNBBDDDDFBD@H@B@DAB@HADADCDAB@@AB@D@BBJHFBFBDBB@DADAHCBCBEDCFCDCDAF@DAB@B@BA@A@A@ABADAFCB@B@D@B@BBDBBBBDBBFDDDB@BBBBFD@BB@B@B@BBHDBBD@DAD@LAB@FABBB@DBBBDBBBF@B@B@J@D@B@B@D@DBD@B@DBDBBBDD@@D@D@FAFA@@B@DAD@DAJCHC@@BABABAB@B@B@BB@@@B@@@@@@B@@@B@BA@A@E@@@A@A@@BA@A@ABA@AAA@A@@A@@A@@@A@A@A@@BA@@DBBA@@BC@@BABAB@BABC@A@@@CB@@AB@@@D@B@FBB@@@B@BAD@BABABAFABA@C@A@A@A@@BC@ABE@ABABA@ADADABAB@B@@@B@B@@BB@DBBBFBB@@BB@BBFB@@B@B@B@BAB@B@B@B@@@B@B@DD@@DBB@B@B@@@B@H@L@D@B@B@@BBBBB@@@@B@B@@@DC@AB@@AFC@@DA@@B@@@BAB@D@B@@AB@@ABABA@A@A@A@@@ABA@@@ADCBABCBA@@FABAB@BAB@B@D@B@BBD@B@BABABA@@@ABA@A@ADCB@BA@@F@BBB@B@@BBB@@BDB@BBBB@BB@@@DAB@@AB@B@BA@@@BB@@B@@DDB@@BBB@@D@@BB@@B@@BB@@BBDB@BBBDBDDB@@@F@FBB@B@FDD@B@@@DCDABAD@B@D@JBB@F@B@B@@A@@@C@A@ABA@@F@DBB@DBDBFBDBB@B@F@D@DADAF@DB@A@@DC@CDA@A@A@ACC@A@ABCBADA@A@A@A@A@@@AA@AAA@A@@A@@@@BAA@@AA@A@@@@@AA@@@AA@AA@@@A@@@@B@@AB@@A@@A@@A@@AC@@A@BC@A@@A@@CBOFU@A@A@@A@B@@A@@@@B@@@@@DG@ABAA@@A@A@CCCACAC@@BADC@AB@@AAAAI@A@@@A@I@@BC@@DCB@@@DA@@B@@A@@B@@A@@@@BA@B@@@@BA@@@@B@@@@@@AB@@@B@@B@@@ABA@@@@@@@A@@@@@@BAB@B@B@@@@@@A@@B@@@BA@@@BB@B@B@BA@@B@BB@@B@@@B@@@@A@@@@@A@@@@@A@@@@A@B@@A@@@@@@@@@@@@@@@A@@@AB@A@@@BAB@@@@@A@@@BA@@@@@@@@B@@@@AB@@@@@@@@@@@@A@@@@@@B@@@@@@A@@B@@@@@@@B@A@BA@@@@@@@@@@@@BAB@@@B@@@@@@A@@@A@@BA@BB@@AA@@@B@@@@A@@A@@@@@@@@@@A@@@@@@B@@@A@@@@@@@@@@@@AA@@@@@@A@@@@@BA@@AB@@@A@@@@AA@A@@@A@@A@@@@@@@AAB@AA@B@@@@@A@@@@@@A@@@@@@@A@@@@@A@@@@A@B@@@@@@@@A@@A@@@@@B@@A@@@@@@@@@@@@@AA@@@@@B@@@@@@@@@@A@@@@@@A@@@@@@@@A@@@@@@@@@A@@@@@AA@@@B@@AAB@@@A@@@@@@@A@@@@@A@@@A@AB@A@B@@A@@@@@@AA@@@A@@@@@@@AB@@@BAL@NBDAF@J@H@LBD@D@PDDBDDBBBBDBBAHGDABADANGDCDAAE@CAAACACAE@AAEACAMAEAAAAACAC@CA@A@EACACCECECCAA@HE@A@ABA@AAA@A@@@AB@BA@ABA@AAKCAACAA@AD@HCHCHCHADADAHCFADAJG@A@CEEACAA@G@G@G@C@C@CAE@CACCKCIES@@ACCAAKAC@A@@HEBE@A@C@AAEEGAC@@DEDEDABA@CBGBK@E@@ACECGEEAEA@@AA@CB@BAFAF@DA@@@AAEAA@C@E@ABCBEBA@@D@@@D@@@BEFCHCFCFABABC@AHIDGBC@A@A@C@A@ABAB@DA@@BC@@BA@A@AAG@ABCBC@CACBABAFA@@@C@@BAB@D@BBBBB@@F@B@D@BBDBBBBBBBB@@@DADCDCDGFABCD@BAB@D@H@D@F@B@FABBBBLBFAFAF@DAB@@B@B@D@FCB@JELGBADCDCBCDABANMB@DC@@BAF@DBFADABABE@C@CBAB@BAD@B@D@DCBA@EBADABA@ABA@@DAB@DABA@AAC@@@A@@FC@@@E@@BA@C@A@AACAAACAC@A@A@A@ABADAB@DEBCB@DAB@FADABCFEDEBCBCBC@CBEBE@ABCB@DC@@BE@CAA@AAAE@A@AAAAAA@C@EBCBCBCBCBCBAB@HCBADADAB@HGDC@@DAB@BADEBE@AD@F@BADAB@B@DBB@BBBB@@B@DAD@BBDAB@BC@C@ABABCACBABABCBEFCDA@ABB@@FBFDB@B@DAFE@@@A@A@EBEBA@ABCDCDABCDA@ABAAE@AACAAEAI@AAEA@A@CAA@@@E@E@@CCCECEECECEAI@GBC@CBCDCDCBCDEDCDCFCDCDEBKBEBEAGCCACCAGAECAGEEAEAGBEFEBCBCDCDCDEBA@@BEDC@EAE@@@CD@@CAAB@@C@C@ABA@C@EDGFCB@@AFAD@BABABCDA@A@QCAACC@@@A@@@@@A@@@AA@@@A@@@@A@@BA@A@A@AB@BAB@@@@@@A@@@A@@DA@@@A@A@A@@@A@@AA@@@A@@@A@C@@B@@@BA@ABABA@AACC@@A@@BC@AA@EG@@@@BADA@ADABA@A@@EGAC@@B@FA@@B@BCDEDADAB@@A@@@C@C@ADC@AD@@@FAF@BAB@@ABCHAF@@AF@FCDA@AACAC@A@ABA@AB@BABCFEDA@@D@BEBCDADCHCDA@A@E@A@ED@@AFABGBC@EBC@CBCDCDADCDABEBC@CBADCF@DA@A@CAG@@AA@AC@AAIAIAKCKEEEGCECKCEAIBEBEBIBEBG@G@CAE@EAGAEAE@ABC@G@E@E@A@EBE@IBEBEBEDEBEBGDIBGDABC@IDKDGDIBIFCB"]],encodeOffsets:[[[103958,36172]],[[103744,36210]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
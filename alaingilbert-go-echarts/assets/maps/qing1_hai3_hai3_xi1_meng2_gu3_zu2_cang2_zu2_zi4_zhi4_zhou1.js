@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("海西蒙古族藏族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"632821",properties:{name:"乌兰县",cp:[98.480195,36.929749],childNum:1},geometry:{type:"Polygon",coordinates:["@@_IOG@C@CAEACAABCDABA@EBKBEB@B@FBHFNBFAJCPALADCFAD@BA@ABAD@DABCDAB@DAHABCDABCDAF@BEDCDAB@F@DAD@BEDCBCDCBABKDCBA@ADAF@D@@BDBF@BABABIHKJEFGHCFEFCF@D@D@D@D@BEDAB@DAD@B@LBDBDBD@B@BADBFAB@@EBABAD@B@@@BBDA@@BA@A@GBIBAB@BAB@BAB@B@B@@@BQDABABA@CDC@A@A@CAAAAAAC@AAAC@CAABA@@AAAAA@C@C@A@AA@CAA@E@A@@AAKGWAMACEGMWCECK@@MQCCEAYGECIGIK@AA@I@E@EBG@A@A@C@@@C@EAC@EBE@C@CAA@A@IAI@E@C@C@A@@@CCCAECEAEACAQCA@AAA@C@A@@@CAAAC@A@A@C@A@C@A@A@A@AAEAC@E@A@EB@@A@AAA@A@C@A@A@ABA@AAG@A@CAABA@A@ABC@A@A@@AA@A@C@@@A@E@EBA@A@@@CA@@A@AAA@ECE@CA@@EBA@@@AAAACAAAA@@@E@A@AAAAA@A@A@@@AD@@AAA@GEA@C@@@CBEB@@E@E@A@A@@@CFA@@@@@AAA@A@EAA@AC@@E@EAABAB@BC@E@A@C@A@C@AACAAAA@EBA@CAC@AAEA@@ABCFA@A@@@CACCAAA@AAA@ABC@G@@@CFA@@@E@CBA@AAAACEAAC@A@C@C@CBEBA@AB@@@D@@ABABABA@@@AD@D@BA@ABA@A@E@A@A@@@AB@@@BA@ABG@ABA@@@AB@B@BABCBC@@@C@@AA@C@@@CBABA@ABCBABC@C@@@CAA@A@A@C@CAGCAAA@ABC@AB@BA@@D@@AB@@E@@@AB@D@@@BA@A@GBA@A@A@ABA@C@EAABA@CBABA@AB@BA@@@CAA@CAA@EBA@C@EAC@C@EBCDCBCBADAB@@GB@@ABABEB@BCF@BA@ABA@EBABADAB@@QJABGBEBEBABEBA@GDEBC@A@K@C@OFKBEBQAE@EBE@ABKDCBOFCBGFADADA@AB@@AB@B@DCDABEDABADADA@CDCBCBA@ABA@ADCDADABC@C@MDEBCBEBCDCBAB@DADIJCD@BCBE@MAM@UBYDOAmMSSGGGAE@cBgN^³ACCCq]éÙ{GEKEECIOCG@E@KBCFIBC@CAICGIGMIICEA[@]ByBMAQE[KKCMBÃhÓhÁ\\aHç°MHÝxABC@CCKKOUECC@A@}dulIJ¯tKFŃŊ²ŖÔƦDALAREBAJC^KNE\\ERCRCFAF@H@N@P@NDVFdHRDR@ZAH@PBD@H@H@L@\\BbDdDJ|FH@HBJBDBFADADA@@HEBCDADEBABAD@B@FDDBDBD@D@B@BA@A@C@ABAFAB@B@BBFDDB@@BAB@BC@CB@BAFBBBBBBDBD@@B@FAB@BCDCBAB@@A@AACB@@AB@BAD@@@D@B@@@FFB@B@B@HAFAFAFA@@BABADABADGDAFCDCHCFEFEBAB@@@H@D@DADC^OBABCFGFCDADAB@D@B@BDDBB@DBDBBBDDBBLDDBD@H@B@BBBDDHBB@BBBF@PDD@@BDB@@B@@ADAD@BABB@@DDBBB@NDHBF@DBJFJJDFBBDBDBB@DDHBDBDDBB@D@B@B@B@B@@L@NC@@B@@CB@@@@@B@@B@@BBB@FAF@FAFCDAD@F@FAB@D@BBDDBBB@@BDAB@FADABA@A@@B@B@D@D@FDF@DBDBBBBBDBHBH@@@@B@BKDAB@B@DABADAB@@@DABCD@B@@BBFDBB@@@B@D@DBBDFDDFBDBB@B@DCDADAF@FCB@DAH@VADBH@PBF@J@B@DBJBF@JDB@BBB@BDDDDDB@B@D@B@D@LDDBB@@B@B@L@D@F@XANE^C^@RBJBHBFDJDLBF@FAH@BBFBFDHJJDDBFDB@BBD@B@DABADAB@BBB@BBD@BBDAB@D@@@DBBLHFFBB@@D@@@B@@@@B@B@FBBBDBBBBF@DBDDFFBDBB@D@DBB@BDBB@B@@BBDBD@F@H@DFHLRHDNFLFBB@@@DAFA@CB@BAB@BDBDD@BBF@J@FDJBHBDDDDDJDHDD@FBD@B@HAFAJ@B@HDDBDBBANADARBHBFBF@JBF@XA@A@A@CBA@@DAD@FATIDAD@B@JD@@B@DCBABCBE@@DADAF@D@HDF@FAFAJCJAH@FAF@F@FBFDDBF@H@D@DAFEDAD@BFBHBFBDFJBDDJDH@DAB@BADABB@@BJ@BADAD@B@BB@@@B@DADBDDNBDBBFBJDbLlNVFtPdHJDH@JAF@HCF@F@FBLDDBF@DBDBZLTLFDBBDDH@XFBAFIDGBCFMHEFIHIDG@CAE@EDCFEDEBCDIBCBC@A@ABADCBADGDCB@B@@@@B@D@DBBBBDBD@HFRPFFFBRBH@RAHBFBHBHDB@F@F@F@B@DBBBFBD@F@FAJAF@J@ZBJ@PDBABCDEBAB@PBB@B@LGB@F@D@B@DCBAF@HBFBBBDBBDD@DCDEBEBCDCD@FBF@HCNGJCDADABCDEHEDADABABAHAPIFAJCFCB@HBJFDBB@BADCDAB@L@B@PGFCDCD@@@B@JFFBF@D@JAJCJCLALAFABABABCDCBALELIPKBC@E@ACCGCCEIEACACBE@CACCC@ABCBCFGBCBE@@LEBCBCAE@EBABCB@D@D@FB@@bgEkLWP]BgFCtJLD@@@@xTLGV{BIR^_DCHCFIBAFADADAD@H@L@B@@AAS@C@AAAAAAA@AAC@AAAE@EACACACAI@A@AAMICACCA@A@CBA@A@CCEEAEACAACC@AAA@CACCCCEEEAEACBABCFAH@HAHCFCD@F@F@H@D@HBNBLBNFNFBBDBDDB@@BAJ@F@@FD@BBDHPDJBFDDBDBBLFD@RDH@FDD@LBLHNBH@HBH@RAFCNKLAHCJAJ@HBB@HCJGFCD@B@HJH@J@FCHCLCHADAFBBDBFDB@BH@D@TBDCREXMPCLEDGFKPEZALERKVKPCNADCNGFG@@DCBG@IBEHEDCFUJUFIBAHAF@B@f_z_\\MRIZODCHEFCFEJGRKJGHEBAHGJMIKE[DMBSHSJKHGNGDK@CAKECMGKGGKQGICICMEMEIBYEEAQOU[iYÑaû©Wiý{"],encodeOffsets:[[101369,37380]]}},{type:"Feature",id:"632802",properties:{name:"德令哈市",cp:[97.360984,37.369436],childNum:1},geometry:{type:"Polygon",coordinates:["@@IcCaC[AK@G@G@C@OAG@YBQ@QCcGUEMCO@M@G@E@EBQDQD[FMF]LIDABQFKBCBA@C@mCcAcAO@a@OAM@IAC@CBEDCBQFCBEBE@EBE@I@EBC@E@ICC@E@GBC@ABABEHABA@A@@@CAECKIOIKE]OOGICGAWEIAW@qC_A[@I@UAABcJIDIFmPKBG@E@IA[AOAGA[MCGCECA@IAGAE@A@AB@B@B@BBF@@@BABKDA@C@C@A@A@@BAFADA@@@A@AAAAC@C@A@CBC@C@A@A@@@@D@@@BC@A@ABA@ADA@A@EBA@@@AD@BA@@@A@@@EFEDEBGFCBCBGDEDGDIDKFEBIDEDIDEFKDEBGDGDGDGDIDEDGFEBGFCBGHGDGDGDGFGDIFCDGFGFCDCFGHEFCFEFCFCFCHAFCFADCDCFCDCBCBEDGBC@GDEBG@GBI@IBEBGBIBIBEBI@E@IAE@EAG@I@E@GBIDE@EBCDEBGFCBGDEBEDEDEBC@GDMBIBEBG@E@I@IBG@EAAAABCBCFABGDEBA@ADCDODGFEFEJEFGFIFKHMHGDGHMHIDIDKDCBQVABA@E@CBC@A@EDA@A@IAG@A@A@EDSFGFKFGBC@CBCBEBCBIAG@E@A@GBODvĆ^´^GfYZL\\PVdDZFHZATQZ]@cMmJwPoPkNIpwLhNBLId³wXkATgIcNoNKRZ±Xy^}@NZMXPLnTd\\GbGXC^k\\hJRRN_XI^QhQGPxV\\APHbGf\\J@ffBVNTVN\\pfdPvT@`\\tBABA@AAA@CBA@ABABADADAB@H@BAD@DA@AB@BEBABADCD@F@FBH@B@BA@C@I@ABAB@DBHAB@DAB@@@@AB@@A@@@E@AB@B@DAD@F@DCD@D@BAB@B@FBD@@@B@@C@C@ABA@A@AB@@@FAD@BBD@F@B@B@BAB@BA@ABA@ABCBADCFCLGBAD@DC@@BALEFAB@B@HABAFAB@@AB@BBB@BDBB@BBBBHDF@BBD@@F@D@BAFCD@BAF@B@F@NFFBD@H@BAB@FED@@C@ABA@@DAD@FAB@H@B@B@F@D@@AB@DAD@D@H@B@HAJAJKT@PENItUZAHLBPBNBBH@F@@AB@BAFAL@D@D@D@B@BA@ABA@A@A@A@A@AAE@@@ABABABCDCFCBABADA@@L@BBB@@@BDDDDDB@BBD@LAB@DBB@B@@B@@@BBB@BB@B@B@L@B@B@D@FDDBBB@BADCF@BAB@B@BBD@B@B@BA@C@ABABAB@BABAFBBDBB@DDHFDBAB@BCH@B@Br¢~lJVAF@t@rE|MQQ WdGMHAC|@BA@@B@BAB@B@B@@@BAD@B@@AB@@@BADA@@B@@@B@B@B@@@B@@BB@@@BB@AB@@@BA@@BA@ABABA@@@ABA@@@AB@B@@AB@B@B@@@BA@@B@@@@@@BB@@BB@@B@@@B@B@@BB@@@@@B@@BA@@BA@@B@@A@ABA@@BA@@@AB@@A@AB@@AB@@AB@@@@AB@@A@@BA@@@ABA@@@A@@@@BA@@@@B@B@BAB@@A@@B@BAB@@@@AB@@@B@B@BA@@B@BAB@@@@ABA@@B@@@B@@@B@BB@@B@@@B@@@BAB@BA@@BAB@@@B@@@@A@@B@@@@@@@@@B@@A@@B@@@@@BA@@@@@@@@B@@@@A@@@@B@@@B@@@@@@@B@@@@BB@@@@AB@@A@@B@@BB@@A@@@@@@@BB@@@B@@@@@@@@@@@B@@@@@@@@@B@@@B@@B@@@@B@@@@@@@B@@@@B@@@@B@D@@@@B@@BB@@@B@@D@@@@@@B@@BB@@@BBBB@@B@@@B@@BB@B@@@@BB@BB@@@B@@@@BB@@@@@B@BB@@@@B@@B@@B@@@@@@B@@@@B@@@@B@@@@@@B@@@@@BB@@@BB@@@@@@AB@@@@B@@B@@B@@B@@@@@@B@B@@BB@BB@@B@@@@@B@@B@@@@B@@@@@@B@@@@B@@@@@@BB@@BB@@@@@BB@@BB@@B@@@@B@@BB@@B@BB@@B@@@@B@@@@@B@@@@@B@@B@@B@@@BBB@B@@BB@BB@@BBB@@@@BB@BBB@@B@BB@@BB@@@B@@B@@@@BBBB@BB@@@B@@BB@@B@@B@BB@@@@@B@B@@@BBB@B@@@BBB@B@@@B@RRBF@@FR@XJNDNIHKHMF]PABQJ@LLJPHVATADJHHLB^@nEB^LJR@RIJWNWHIRJNVPZHT@TK@@@@ABAB@@AD@BAD@B@F@B@B@@CH@BEDABABGDAB@@AD@B@@@J@D@@CD@@@D@BA@@B@@@B@BAB@@@@`@@@@@FBTI@@ZIX@LEp_VAXBXINMPIK`DTLdNxVtVAPI|@DBB@D@BBBBD@B@B@B@DA@@BE@IBA@A@A@A@A@A@CBADAB@@B@BBB@D@F@B@B@BBB@BB@@@B@B@BBB@@BBBFBD@B@BBB@B@BDBDDBB@@@@EDABABA@@B@BB@@D@B@@ABC@ABCBABAB@@AA@AAA@@ACAAA@CAA@A@G@CB@@A@ADADAB@BC@E@A@A@@BABCHCFADADCFAB@@DDDBBB@B@BAB@BB@@BBB@@@BAB@BAB@@AD@B@BDDBBBBDDBBBDBBBB@B@R@J@DBJ@F@@@@CBC@EDEBCBC@OBA@@@AB@DABABABA@@@AJCF@JADEN@BAD@D@BBDBD@B@D@B@F@PAD@@@DAB@DBB@BBBB@@B@D@DBF@B@BBB@BBB@@@B@@AFAF@H@BCD@BAB@@BB@B@BBDAD@F@BA@A@@B@@@@@DAB@BCDAB@@@BBFDBDDBDDHBD@@D@JBBAD@BABADADAFAB@D@RFPHFBB@DAHAJE@@DBBBJBDBB@JCDADCBAJEFADAB@B@DBH@LCFCDCDAB@HAJBD@HBFDDBB@@@B@BADCB@HA@@FEB@FAFAJAB@F@JBJFD@B@D@DADAB@B@FBD@DAB@@@@AAAAAAC@@@A@C@C@A@AAA@AAIEO@EACEEKGAAGIEEMIECAC@ABGBGBK@AAIBEJ[DIBC@E@G@C@C@ADCFCJCDA@CAEACCE@ABEDEDCNGJCFCBABEDADCHAHAHADADIDCFAFALCH@LEDAHCJEF@DBFDFDBFFBDBFBF@D@D@DBJFD@F@XANAHABA@ECGAGEECCAA@ABE@ACIAA@CBGBEDC@@FEDAB@BCHEBAB@DAH@LAB@D@FBFBBADAHCJABA@@@AAG@AB@BAD@J@F@BAB@@@@A@C@CBC@AACCGAECEAE@A@@BAFAH@FAB@@S@E@A@CDCHGBA@A@CAACGAABAFABAAC@AAAA@EAI@CAA@CAAGCCCCECGACCAE@CEY@E@CFADAFAF@BCHSD@JAN@JAF@FAFAHGDADADAH@HBZAF@NADADADC@CBCFAJEB@@ABA@@AACAAACEA@AAAAAACEEGKIGC@G@EAEDGBGBGBCDEBCFEFGFAFCHCJAHADAH@FAHCFAFAJAJCDALAFAJCHCNADCBA@@FBJ@DAD@D@FA@@@ADAB@BA@@B@D@B@@BB@B@@@@@B@@@D@DBD@B@B@FADABAD@BADA@@B@BAH@B@D@D@@@@BB@@AB@BAD@DABBB@BAB@D@@@F@FBFBB@@@B@B@B@D@@@B@DBD@@@D@@@B@FDFBBB@@BAD@B@FADAFCB@DADCHA@@BABABAFCDADAB@DAFC@@D@FADABBB@BAH@D@B@@@B@BADBHADAH@DABAFAB@B@@ABADA@@@ABA@@BAD@@@DCBAB@BGBABCBA@A@@@AB@@A@AB@B@BAB@BADA@@BA@ABAFCDABABCB@@ABCB@BABABABADEDCDADA@ABABADADA@ABAFABABAFADCBAFADADAB@BADCB@BA@@DC@@DABABABA@@@AB@@A@@B@@@BA@AB@@ABC@@BABAB@@@BAA@@ADAB@BA@@BAB@BABA@@@@BD@@B@@@B@D@B@BAB@B@@@BAFC@@B@BAB@@@@AB@@AB@BA@AB@@ABA@@BA@@B@@A@@@@BCB@@A@@B@@@@A@@BC@C@A@AB@@A@@BA@A@ABA@@BC@AAC@A@E@G@C@A@A@A@@@E@A@C@AACCIAIAA@ACCAEACAACCAA@AA@@CAI@A@AAA@CAAAACCAACCEEAABC@@@C@ACC@CBCNSDGFGJK@ABEBCAEBABCHGLIHEBCDEBC@ADEBCFCBCBAJKDCDCBCAAACMKECAAGIQQCECMAEAG@E@AAEBCBABCBCBAB@DABA@@@E@A@ABCBCBAACGECAGEEACECCGGAECCAK@CCKACAAACCA@@@A@C@CAG@GAE@E@EAEGQBCDCBCDGDIDGDEACAG@GBCDEHCJCHCFCBADAB@B@D@D@D@@ADABAFADAH@HCDCDCBGFG@G@CAC@CFGHIDCDAJCLELIDEBA@EBEDCLGFCFEDEBC@EAGACGOAC@ABAHEDA@A@A@C@@@CBCFGBAB@B@JBD@H@F@FABAHIFEHGFG@A@C@AAC@CDGBADAB@D@T@L@F@BABADGDGBCBEFEBA@CA@AAECCAEGAEAEAAAC@ABCLKHGB@DAD@DBLFB@B@VCFCFG@@FEFAFAF@LADAD@DCB@DEDCBAFCBABAAEBEBABAF@JAB@@A@A@C@AB@BAF@B@BA@AACAEAC@C@ADCJEFCB@B@FDBBB@JBHBBB@@BBBFDHBBB@BBB@HAD@D@DBB@@AAGBCBCB@DAHBB@BAB@AACCCACAC@@A@ABC@C@AACCCCCCAKAKCCCAAAABC@CAC@ACCGKACCA@A@AB@PAB@FABCBA@K@AFABC@@@AAAIACCCCCACAGCAA@@@ABAB@D@BADCBCBC@A@AA@GCA@@A@A@A@E@CDK@A@CACAA@CFO@ADADEDABAB@B@B@@BB@B@H@BAHAJAPCFADCB@F@N@H@DAD@DALAFABABIHO@I@C@ACCCAGCAA@@@E@EBAHGDC@A@@@AA@EACAACCEAEAAEC@@@ADAH@HBBA@@AA@CBI@MDG@AEIAC@IAAAACAKCECACAI@ABALENCDABCBCDEBEBAAE@ABABC@AAC@C@A@@BAB@BAB@BCB@B@BAF@L@F@HBH@@@@@BAAABG@CAGBMBCDM@GAIAC@CEA@CUUCCIGIEKEGACA@ACCEEAE@ABC@AB@LABABCBABAB@D@DADADCBE@GBAFCDABC@AAC@A@AFCBA@AA@AAKCI@IDC@GCCEECEG@CCCGEIGCEAE@A@ADABAFAF@B@DA@ABCBG@E@A@ACAAC@AWBE@IAE@EAGAQACBMBABCACAGCA@I@EBGBA@C@EAC@GCICCCCCACAGCI@E@IAE@ACCCA@ABA@ADAB@BE@C@@AAKEMEGCKQEG@C@G@EACAC@AA@A@CA@AAA@C@CAAACEECCCAE@AAAAACAA@E@A@A@@A@@@C@@@AAEEKGAA@C@@@CBAAC@AAC@AAA@ABABCBA@C@AAC@ACAAECCIICGAEAE@ABG@EAECKCIAEAGAI@QD]F]BM@W@E@C@K@A@AA@CAKCC@A@C@A@A@CCCCACA@AAA@ICE@IACAA@I@E@OAG@CAUBG@CBA@EDE@CBCBCDA@A@CAEACCCEAA@C@C@A@@AAECAA@@@ADCBA@C@@BABCBA@C@ABALC@A@A@@G@GACAAAAACACAE@ECC@C@A@A@@@@BABCBEBA@CB@AA@AACCAAC@A@EBE@C@CBEDEBE@EBA@AA@@@AA@@@@@A@@DA@@@MDK@@@@A@A@A@A@CAACCCAGACCA@CACAAACEIIIECAE@GAMCA@AACC@@AAABC@CB@BA@@@CA@AC@OCE@AA@AAACGACAAA@G@C@CAKCAACCAACACAA@CAACA@C@A@CBCBEDEHADAB]PCDCBC@G@@@A@ABEFEFGDCDEDCBCHABCBABAB@@EBEBEBGBA@A@A@EE@@A@C@@@C@ABA@@BA@BD@B@BA@ABCDADA@EBA@@@ACACAAAAEAABA@@DADA@AB@@CAECAAA@A@EBAB@B@D@BABA@C@C@CACAECA@C@ABABCFCBADGF@@CBCBEBCAIAGAG@{E"],encodeOffsets:[[99789,37841]]}},{type:"Feature",id:"632822",properties:{name:"都兰县",cp:[98.095844,36.302496],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@@ACCAAACAA@@@E@E@IAC@ECEEAE@EBGBE@E@C@EAGAEAEAEAEAGAEAEAEAC@I@GBC@EBIBC@G@E@E@IAG@I@GAG@EAG@E@G@E@GBG@EBE@CBADGDEDEFCFCHEDE@GACCEAECCCGCEAEBEDGDCBCBCDCBEFEFEFEBG@G@EAC@EAE@C@GBC@E@CDEDCDCDCBGBEBCBEAG@EAEACAE@G@C@IBC@GBEBCBGBGDCBEBCDABEFABCDCFCFGFEBGACAGAGAG@C@E@G@E@E@I@G@G@I@CBIBGAEBE@E@GBE@GBEFCDAFBDBDBFBDBHBD@F@DCFEDCDIBEDC@G@E@C@G@C@IAEAEACEEACACCACAEAGAE@ECEAG@E@E@G@C@E@E@C@EAE@CBE@C@EBG@E@EBE@EBE@CBG@C@CBCBEBEBE@EBCBEAC@E@ECECCCAEAE@EAC@E@E@I@GBE@EBGDCBCBCDEFCBCBGBCBEACEC@IAEAG@E@E@GBEBGBCBEBE@CBG@GBEBIBIDEBGDEBCDEBEBEDEBGDGDGBGBGBEBE@EBEBG@EBGBEBG@IBGBE@GBKAE@C@GACAACECCCEAEBCBCDAFCDADCDE@G@E@CAEEGEA@ECI@G@I@K@GDAB@BA@CFCFCDADADAFCHBFBD@DBFAFADCBCBEDG@E@CCGGACCEACEECCGCE@E@IAI@KBG@E@GDE@CBGDCBEBCDIFEDCBCBG@E@C@GAG@GAGAGBK@E@GBGBGDGBGDG@EBEBKDG@G@C@G@IAEBE@EBEBIDCDGDEDGFGFCDEFCFAFEFADEFEJCBIFCBCBGBC@GDCBEHABEFCFADAFBF@HBH@BBFBFAFAFADADEBABEFEDCDEBEDGFCBCDEFGHCDADABEFADEHEDEBI@GDGBEBE@E@EDABADCBE@ACACACCGGCEGECECGDEBAF@B@FBH@D@H@D@BDD@BEDEDCDEBCAGGGEACA@@@AC@AA@@@AAE@ABCB@@A@E@@@AB@BBF@FA@@@@@@@@CAA@AAAAAC@AA@A@AAAA@@AAAACA@ABA@A@C@ABAB@BA@@@A@GA@@EDABA@C@@ACB@@AB@B@B@@@@C@C@@BABA@E@MFABA@CB@@A@@BADBJ@B@BEDABCBA@AACACCCAAAA@G@A@CACA@AA@AAC@ABA@ABAB@B@@AAC@AAC@CBA@E@GBIBC@A@CAA@IDA@ABCFABEHAB@B@FCBCDEH@BKBCBA@@@ABABC@GFEFEB@@@BBD@B@@CBABA@CAA@@@IHGFAB@DAB@BA@WFABCD@@C@ABCB@B@@@BBFDH@D@@@@A@ABA@@BABED@BAD@BBB@B@@ABA@A@A@A@@@A@@AA@C@C@A@A@@BC@AB@@ADAB@D@@@BA@A@EAC@A@C@GBC@@@AA@ECCA@C@A@AAA@EAAAC@A@A@CBCBABA@@@CAC@E@@@A@CD@BA@@BA@A@A@CA@AA@G@ABA@@@@AAAAAAA@@IAEAC@@@G@A@A@K@A@C@E@A@CAEAAA@@A@E@A@GBEB@@AB@BA@AB@@ABCDAB@@@@@BBF@@@@EDABC@C@@B@@B@@B@@AB@@@B@B@@A@@@@DABC@CBC@CBEBC@A@CBABABEB@@@B@@ABCF@@@@C@@@A@C@G@A@C@AACCA@@@K@C@C@EBKBIDEB@@A@C@EAABA@A@KBA@@BAB@D@B@AWBC@C@C@C@A@A@A@AB@@A@@@CBA@@BABEBABA@A@A@@BAB@@ABCDABABAB@@CAA@C@CAG@C@CBA@A@A@A@A@@@@B@B@B@@@@EBC@M@CBAB@@AAA@GDC@MB@@ABAB@@I@A@EBC@EBABCB@@A@A@A@C@A@ABC@AA@@A@ABA@A@@@A@EDCBA@CC@@@G@@AACAA@AAAA@AC@@@A@A@@@ACAAA@@AAA@A@@BA@@@AA@IB@@C@A@ABCBCD@@GDA@@BEAA@G@A@ABABG@C@A@@BABCBABGBCBAA@@AAACAAA@A@C@EBC@A@A@@AE@AAA@C@@@A@C@ABAA@AA@@@@BABA@ADA@@@@@A@A@A@C@ABC@C@I@@@EB@BA@AA@@AA@A@@AC@A@AA@C@A@C@EAA@@ACK@@@AA@AAA@@@AC@AA@@@A@CBADCBA@@@ABCBA@C@AACBAAABA@A@AB@@AA@@@AA@AAAAA@CAA@A@A@ABAB@@C@A@C@C@E@@@CA@@CDABA@A@CB@@@@@@@D@BA@A@KAA@C@AB@@ABE@A@A@@BCBA@A@A@@@ABABA@AB@@G@EBABAAA@@@@@A@AB@BA@@@@@AAAC@@@A@@AA@AAA@C@@B@AA@@AC@C@@A@@AA@@@A@@@CACECACAACAAC@CCA@C@A@@AAA@@C@@@CCAAA@CA@@A@C@C@I@@@AACE@@A@GBABG@C@A@AAA@@A@@@A@AAAAC@AEECGAAAC@A@@@@BA@@AE@@@ABC@@AAAAAA@@@A@@BA@AAA@A@ACACGAC@E@A@CBG@@AA@A@A@@AAAA@@AABAACA@A@@ACBE@@@A@AA@A@@AACACCCACAA@AAC@CBA@AAA@@AAA@@AAA@AAAAA@AAA@E@C@A@A@C@@@AA@@CAA@C@A@A@AACA@AEAECA@MCCBIB@@GDGHELAJ@HKHAD@@@DCBABA@@DABGBCDC@@DAJAHBHAFAJKPEHKTEDELICEAG@YLGHELADOBcBÙDgFoMGAA@CBELETBDDD@D@@C@OCGEG@MAGBG@EAE@C@GAKBCCEAGBEDODGBC@GGIAMEBN@JBHDLAJBHCLBPH^JrDBNLDLEPAJBPDLJL@DBFHHHFBFAHBDDBJHDF@F@DCFADBLDFABkHUBOAMDI@GBMBIIC@I@MDI@SDGJQBQL[FGPDLFBHPADANIDEH@L@JGNIJEHCHI`CDSLCD@DDNHBBBD@BBBBBB@@@BBD@BBFBBFDB@DBHDBDAB@BBBFDBBFBDDBBBD@BBBBDB@@B@@@BB@DBBB@BBDBBB@BB@BBBFDBB@BBBB@BB@@B@D@BBB@@@B@DDBB@BAD@@D@BBBBB@@@@BAB@@@B@@@@AB@@@BB@BBB@@B@B@@@BB@BBB@@@BB@BBBB@B@B@B@DB@@BDBB@@FBBB@@@BA@@B@@BBBB@@B@@@@BBBBBB@@@B@@AB@@@B@BBBBB@B@BAB@BAB@@@F@BBF@D@BB@B@BBBB@@@@B@BB@@BA@BB@BBD@B@@@D@@@@@B@B@B@@BB@B@BB@DD@@@D@@@BABAB@@@B@B@B@BAB@BBBBB@B@@BB@B@BBDBDB@A@@BA@@@B@BBB@@B@@@@@@@BB@DB@@@@@B@B@@@@F@@@@BDD@@@BA@@@@B@BB@B@BB@B@B@B@@B@@BBB@B@@@BB@@B@B@B@@@@BB@@BBAB@B@BB@@B@BB@@@@BB@DB@@@BBBB@BF@@C@@@@BB@@B@@@B@@@@BB@@BD@BB@@B@@@BAB@@@B@B@@@BAB@B@@@@@BB@DBB@@BBB@BA@@BBBBBBB@B@@@B@B@@@BBBAD@@@BDB@BBD@B@BDDB@BD@BB@@D@@BB@@BB@@AB@@@@ABAB@B@BBBB@@B@@A@@D@@B@BB@@@@@@AB@B@@BB@@@@@B@BBD@@@BBB@@BB@@@BBB@BBB@B@D@@@B@@@BBB@FBB@B@@BBBB@@BH@B@@@D@@@@@B@@@B@B@@BBA@BB@B@B@@@B@BBB@@BD@BB@@BBBBBBD@B@DBBBB@B@@@DBR@HBH@DCDBBAL@FBB@D@H@BBDDF@F@FBFDDBD@HDFJFRLXLPJZNLHFJJXNdNZFRB\\@T@FA@{BsBI@[@MBS@O@UCWCoGOAK@SC[I]EK@mAQAa@U@IBEDCJEVCZAPGnG^IlYº]ÎIpMnGRCV@LGDIBa@E@ABBR@b@f@PHB@nALCFa`GFGDML[^UZUZQRY^SVCDGHIVMIdE`ATQIlGfCP@FDD@DDCBADCDCBEDCDEJIDCNED@DAFC@@FAJ@HAFAFAH@J@F@J@X@NAFAHALAPCH@HAH@HAHBFBHBFBFBNCN@F@F@P@F@B@F@J@LBVDH@L@LBFBDBLBTDVFDBX@L@L@DARFDBDDHNDHBDJJBBBDFLLPBB@B@B@BBBBBBDBBD@BD@BBB@D@BBDBBB@DBBBD@BB@BBBDBFDBBBD@B@BDBDBDB@BBBBDBFB@HFDBB@DB@B@D@BBBFBFDBBD@BBBBBDB@F@D@BBDBBBBD@D@BB@BAFCBADB@BABAB@@@B@@BBDBVATATELALAHAF@HBDBNDbLHBBDBDBFDHDBHFJDTHHDLDHDHBDBTHFBV@P@DBTFLBJDJDDBF@FDH@BBFBDCLKFEJIFADEDARI\\MLKPKFEJGPODABADCHIDCHKDCDGFEBEDEDC@A@AAAAA@CBAFAD@FBBBF@FAFDPAB@PGRIHAJ@LBDAB@HELKHCFEDCDAHADAJGFCFAL@XBF@HBFDD@D@HCPCHAB@F@H@JBDAFADADAD@HALEHETEFCB@B@H@JBB@B@FCB@D@DAF@B@BARUDALCJCJCNGHGHCNGLGJEHEFEFIFEHEPCDCBCB@FAHCBADEDABABBFBH@JAJ@F@H@FAJANAHCD@FAFCFCFAHCDAHEFADCFAF@JCHAF@J@H@FBF@JBF@J@FAJAJAHAFAJAJ@HAH@FAHCD@HAFCDADADCDEDCBCDEBEDGDEDEFEDEFEHGDEDCHEHEDCJEHCHEHCHCHCHGDAHEFAHEFCJCHCHCHCHCFALCFEJCFCJCFALEJCHCFCHCDADAHEFAFCFE@@B@@@B@@ABC@@B@FAB@B@BCB@BAB@D@@A@@@C@@B@B@D@D@DAB@D@D@BBBBB@@@B@BCBE@AB@B@D@D@B@LCBA@A@@AE@A@A@ABAB@F@HBJBB@FDHDND\\HBPB\\BJBF@H@LAnOJEJCdIBAVBJ@\\@`BrDX@JBXFHBJDPH^PLFPJLJFDDB@@B@B@BAFGBABAD@HAF@D@JDF@D@FAJ@F@FAF@FADAREDAFCDAD@JBN@PBb@P@dBdBnDD@B@Óƥ±ŕńŉLE°sJIvk~cB@D@FDPVLLDDD@BAÞwNGè¯bGÂ[ÔgÄgNALD\\LRFNBzA^A\\@FBJDNJJHDHBJ@DADEJAD@L@FDHJPFDLFHF|êÚr^DDBD]´MAh@dBFHHTHTnNPBZCVAN@NBF@DA@ADCJIBC@CBADADCFADAFANCD@D@BABCDCBCB@BAB@DADADCB@BCBCBAFCBADC@C@ABA@@BAB@BCBCHEDAPEDALCBAF@FAF@RBFALAPED@L@B@D@FAHCB@FABAFAFAHABARI@@BABCBAFAB@BAB@@ADE@AFABABA@@HA@@BABCDADADCFAD@D@FBD@B@FAB@DBB@DB@@B@@ABAB@BADAB@BAFBD@B@BAB@B@B@HAB@B@@A@@@CBA@@F@@@BA@@@CB@@ABAD@BAB@BBHDDBD@B@B@B@DB@@D@D@BADABAB@BADA@@D@B@@BD@@@D@DABA@A@ABA@@B@BAH@BAB@@A@@BA@@B@B@F@B@B@BAB@@A@CBC@@B@BABABA@@@C@@BAB@FADAD@D@B@D@BBDFBBBBB@DAF@@@B@DE@@H@D@BAB@BBB@BBDDDB@@B@B@DEBA@@FBBBD@DBB@FAB@BBDBBBD@B@D@B@F@D@@ABABAFBF@@@BDB@FBB@B@BB@@@@B@DE@@B@B@F@F@@@FADA@@D@B@HFB@BB@@BC@@B@B@B@BBBBB@F@@@B@BBDBBBBB@@B@FA@@DBF@FDB@BBB@@@DB@@B@B@FAF@B@@@D@B@B@@BB@B@D@BAB@B@BADBB@H@BBB@BAB@B@D@B@B@BBB@@@FAB@F@D@FBBBB@B@B@D@B@D@B@B@D@BBDB@@B@D@B@BBB@RDDBFBFBFDDBDD@@B@D@D@F@J@JBB@B@DBD@F@FAD@FBD@@@D@B@B@H@FAF@J@B@@BJLJHFDZHFBDDNR@@DLDFNXFHBDBNHXBL@BB@F@B@DBB@@B@B@D@DBBBB@BB@BADBD@BB@BBDBBBBDBB@B@D@DCB@BABARC@A@@@A@ABA@ABA@ABAJAHAB@B@@AB@AC@A@@@ABCBAFA@@BAAEBC@A@AACACAC@K@ABC@CBAFC@A@C@C@C@CDEFEDEHGFELIJGBABA@AAEAC@@@CBEBCB@DALCBADADCDAFC@ABC@C@EBADCFC@ABEDCBADCBABG@CBADCBA@CBCBAB@@ABCDEBCBKDOBIAEEMAG@E@AFALAFAB@BADCBA@AJKFEBEBCACCCECCA@CBGBCBCBIBCDEDAFAF@HBBAD@DADEBIDGBCDG@EDCFCD@^EDAHAB@LIF[EUaU@[KWW}A@BG@IBG@EAGACCAECGCKAMAMCM@I@G@GCEAGAE@CAAE@GDEFEHCHCHCJCHAHAHCDCFEDEBEBGBE@GBG@EBEBEBCBAAAA@AC@ABC@@BAB@NDB@RCBABBFBFBDDDBHCFCBC@GAIAGEKCIBIFCBAHCFAHAJAHAFAHCHEFEFCJEHEHELIJGHGHGHKDCDKACACCCCCCCACACCCCECACEAE@E@EDCBABEDCDCDEBCHEDEDEFCFGFCDCDCFGDCDEBADCDEBCBCBE@C@E@GAECAAECEEGAACCACCABADCFAD@FADCBCHGBAFCBCBIBI@CCGAC@EDKBEBC@CAAEGGC@A@EAGBEBCBEBCBC@@FGFADCDAFCHAH@F@H@L@NGBEDEDCDCDEDEBCDEFEDCDEDAAEBADGBCAABEDADEBC@C@C@ADCBAFAHABADCDC@C@AAK@CCCAC@C@G@@BABCBA@@@CCICG@A@CBEBC@A@CAAEISSACAA@CAACCCAAAC@CAE@ACAAAC@CAA@A@CAAAAECCC@CAACAAA@EAAAABA@CAC@AGEA@@AC@A@CAEAC@QBE@G@IAAA@@@A@CCAAAA@A@A@ACAA@CAA@@A@AA@AAAAA@@AA@A@A@@@A@A@@CC@AEAC@AAE@A@C@A@AAAACACAMAEAC@GBABC@C@ABCBA@ABE@ABEBA@E@CCA@I@EBCB@B@BAD@BA@CBC@CBABG@@CCOACCCICEAKAC@IDC@A@A@G@MBEBE@CAA@C@CBGBGDA@CAA@A@C@EBE@CBEBC@C@CBG@CBGDCBA@E@CBE@IBGBI@AACAGEAAAAAA@@@@BADABABADCFEBABA@A@@GCCAI@E@C@AAC@G@AA@@AAC@CAC@C@AACAAAAAAAA@EBA@C@A@AA@@A@@DABA@ABC@CDABABCBCBEBC@A@ABGDCDABAB@@ADCFEDEBEBGDCDEBCBE@GBIBE@I@CBGAG@GAEAEAE@GCCAAEBEBCDEBC@E@C@E@EAEAG@C@CAE@GCGAGAC@CCEAECAECCAGCCCECECGEEAEAGAGCC@KCEAE@E@IBEAE@E@E@E@G@GAE@EACAECGCEEECEAE@GAG@EACACACAECCACCCCACCCCEAEEGACCCECCCCACCGCGCCAIAGAG@EAC@E@E@E@EAKAG@IAE@IAIAGAEAGAEAC@EAEAK@GAE@E@I@E@EBC@GDEBEFCBCDCDEBEDGDEBGDCDEBKBGBE@EBE@C@G@EAECEAEAGCEAEAC@EACAGAC@C@E@GBE@E@G@GAI@GAEAE@E@CBGBEBEBCBE@EDCHCBCDGAACCGAACCEAGAC@IJCLMFEBBFNJFLFJBJCFSDKDMBEBCJCBMAI@M@ECCGEAAIBG@GGEIACCCIIGKCKEGI@GBGFKBIAK@QAACGCEACEECEAACECGCCCCAC@ECKACAIACAIAAAAAEACEK@E@C@ECKCCACAC@E@A@AAE@CACCC@A@A@AAC@@@A@@@A@AB@BA@AAAAC@A@ABC@AA@AAC@C@GBC@EFA@@BC@CBC@AAA@A@ABABC@@@MEAAC@AB@BCB@BCB@@@B@BA@A@C@@@ABCBA@ABA@A@A@@AAAC@ABIAAACAA@C@AAA@@A@@@ACA@@AC@A@AA@A@CBCBEBABC@C@C@A@CAAACCA@"],encodeOffsets:[[100439,36169]]}},{type:"Feature",id:"632801",properties:{name:"格尔木市",cp:[94.928453,36.406367],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@@@CACCEAGA@GAEA@C@EBC@C@C@CBEBCBABCDCDAFCBEDCDCDC@C@CACBABABAD@DCDABA@C@C@CAE@EBE@CBCBEBCDADAD@D@FAB@@A@C@A@CAC@C@CBABA@CBC@A@C@C@ABCBCBC@C@C@C@C@CBE@CBCBABABCBABCBABA@CBGBDLCH@D@DC@EAC@E@GDG@CBEDCFCBCBEBEBEBCBAFC@C@G@C@EDEBGDGBI@IBGBGBED@BAFADCBEBGBC@CBCB@D@F@DABABE@E@ABAD@D@F@FBBDDDDBBAF@BCFABCBCBC@CBCBADAFADEDGDADCBCAA@CAAACACBADCBADC@CAAACCA@A@C@CBCDADAB@BCDA@AB@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@A@A@CA@@A@@@@@A@@@@@@AA@@@@@A@@@@@A@@A@@A@@@@@A@@@@AA@A@@A@@@@A@@AAAA@@A@@A@@AAA@@@AA@@@@AA@@@@AA@@@@@@AA@@@A@AAA@@AA@@@@@@@A@@@A@@@A@AA@@E@@@@@A@@@@@@@@@A@@A@@@@@@@@@@@@@A@@@@@@@@@A@@@A@@@@@@@A@@@AAA@@@@@@@@@@@@AAACAA@@@A@@@@A@@@@A@@@@@@@A@@@@@@@A@@@@BA@@@@@@@A@@@@BC@@@@A@@@@@@A@@A@@@A@@@@@A@@@A@@A@@A@@@@@C@@@A@@@A@A@AAA@@@@@A@@@AAA@@@A@@@@AA@@@@@A@@@@@@AAA@AA@@@A@A@@@@BCBCBCD@DAD@@@BADABCDE@GBG@GAG@C@EBABCBGDABE@@DEFCF@@GDGDIDE@IBGBG@K@IBGDEBC@E@E@C@CD@@CBE@GAK@C@EDEBEBCDADC@@@A@@B@@@@A@@@@BA@@@@@@B@@AB@@@BABA@@@@B@@@@AB@@@@@B@@A@@@AD@@AB@@@@@@AB@@A@@@@B@@@@@@@@A@@@A@C@@@A@AB@@@@A@@@@@@@@@A@@@@@@A@@@@A@@@@@@@@@AA@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@B@@A@@B@@A@@@@@@@@@@@AB@@A@A@@@@BA@@@@@@@@@@@A@@B@@@@@@@@@@@B@@A@@B@@@B@@@@@@@@@BA@@@@B@@A@@BAB@@@@@@@@AB@@@@@@@@@@A@@@@@@@@@A@@@@@A@@@A@@@A@@@@@@@A@@AA@@@@@AB@@@B@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@A@@@A@A@@@@@A@@@@@A@@@A@@@A@A@@@A@@@@B@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@A@A@@AC@@@A@@@A@@@@@@@A@@@@@@@@@A@A@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@@@AA@@@@@@A@@@@@@@AA@@A@@@@@@A@@@@@@A@@@@A@@@@@@@@@@@A@@B@@@@A@@B@@@@A@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@AA@@@@@@AA@@@@@@@AAA@AA@@@@@AA@A@AA@@@@@@A@@@@@@@@A@@A@@@A@@@@@@@@BA@@@A@@@A@@@AB@@A@@@@@@@@@A@@@@@@@A@A@@@A@@@@@A@@@@@@@@@A@@@@@@@AA@@@@@@A@@@@AA@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@AA@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@BA@A@@@@@A@@@@@@BA@A@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@AA@@A@@AA@@@@@AA@@@@A@@@@A@@@@A@@A@@@@AA@@@@@AA@@@@@AA@A@@@@A@@@@@A@@@@BA@@@A@CBA@@@@@A@@@@@@@@@AB@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@A@@@@B@@@@A@@@@@A@@@A@@BA@@@@@@@@@A@@@@@@@@@A@@A@@A@@@@@@@A@@A@@A@@@@@@@@AA@@@A@A@@AA@@@@@A@@@@@A@@@@@@A@@@@A@@@@@@@@@@A@@A@@@@A@@@@@@@A@@@@@@@@@A@@@@@A@A@@@A@A@@@A@@@@@@@A@@@@@A@@@@@@@AA@@@@@@AA@@@@@A@@A@@A@@@AAA@CAAA@@@@A@@@@@@@@AA@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@AAABA@AAAAA@A@A@A@AAA@A@@@@@@@@@A@@B@@A@@B@@A@@@@@@@A@@@A@@@@@@@@BA@@@@@@@@A@@@@@@@@@@@A@@A@@@@@@AB@@@@@@A@@@A@@@@@@@@@@@A@@@A@@@@@@@@@AB@@A@@@@@@@C@@@@@A@@@@@A@@@A@@@@@A@@@@@A@@@@B@@@@A@@@AB@@ABA@@@@BA@@@AB@@@@A@@B@@CB@@A@ABAB@BA@A@@B@@@@A@@B@@A@@@@@@@ABEBEB@B@@A@@@CB@@A@@@@@A@CBA@@BA@ABC@AB@@@BC@ABABA@@@@@@BA@@@ABAB@@A@@B@@A@@@A@@B@@A@@@@BA@@@@@A@ABAB@@@@AB@@@@A@@B@@@@@@A@@@@BA@@@A@@B@@A@@@@BA@@@@BA@@@@@@B@@A@ABA@@@@@@@@@A@@B@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@A@@A@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@A@A@@AAA@@@@A@CAA@AACAECA@AACAAAAACAAAA@CAC@A@@@@@@@@@A@@@@@@B@@A@@B@@@@@@@@AB@@@@@B@@@@@BAB@@@@@@@B@@AB@@ABCDEBCDEBEBABCFEFC@A@@@A@@@@@@@@@A@CAA@@@@@@@@@@@A@@@@A@@@@@@@@AA@A@@@@@@@@@@@A@@@@@@@A@@@@A@@@@@@@@@@@@@@BA@A@ABA@A@C@A@A@ABA@ABABCACBIAG@I@G@I@IAECCAEBE@E@EDGDEBEAG@ECIEECECCCAA@C@GAEAE@A@@ACAA@AAAAA@AAC@AAA@AAACJADABAB@@@@AB@@AB@@@BADA@A@A@A@@@AD@B@B@B@B@B@BAFBBA@@DAB@BAB@BAB@@AD@@@@@B@@@@@B@@AB@@A@A@AAA@@@A@C@E@G@A@C@AAA@A@@AAAA@@@@@A@A@A@@BA@A@AAA@AA@@AA@@A@AAA@C@AAAA@@@@A@@AA@@@A@@@A@AB@@ABAB@@ABA@@@A@@@@BA@@@A@A@C@A@AA@@C@CBA@C@@@ABABA@A@@@CAC@A@@@E@A@AA@@A@A@@AA@A@A@A@A@C@CB@@A@@BCBABA@ADCBCBK@@@AB@@AB@BA@A@@@C@ABABA@AB@@A@@@A@@@@@AB@BA@@@@@ABABCB@BAB@@ABGBA@ABC@ABA@@B@@CBC@CBC@A@A@A@A@C@A@AAAAAACAC@AAE@A@@BGBE@A@CAOCC@CAABC@CBAB@BA@ABABAB@DA@CBCBC@@@EDABEDCBCBKFE@ABA@C@ABABABC@ABABABADCBABC@EDCDA@EBCBA@C@GDC@ABA@@BAB@BABA@@B@@AB@@ABA@@DC@CB@@@@AB@@@BA@A@@BCDA@A@@BABA@AB@@@BA@@@A@@B@@A@AB@@@BABAB@@@BA@CDCDA@@BA@ABAB@@ABA@A@A@ABCB@@@@@@@BA@@@@@ABAA@@@@A@@BA@C@@@ABA@A@@BA@@@A@A@@@A@@@@BA@@BA@A@@@@BA@@BAB@@@@A@@B@@@@ABA@CBA@A@@@@B@@@B@@BB@@A@@@@B@B@@@B@@BBA@@@CBAB@@ABABEBC@CBABCBABCBA@CB@@A@A@A@@@ABC@A@A@@@AB@@A@@@A@A@A@A@A@ABA@@@@@A@A@CBA@A@A@ABA@A@@BCB@@@@ABA@CBCBCBA@ABABA@AB@@A@@@@@CDABAB@@AB@@@BA@@BA@A@@B@@A@@BAB@BAB@BA@ABA@@@ABA@@@A@A@@@A@@@@@A@@A@@@@A@C@CAK@G@IAG@M@A@AAQ@eCM@C@GAE@A@@@@@AB@@@B@@AB@B@@@B@@A@@BA@AB@@A@AB@@A@CBA@@@A@@B@@@B@@@B@B@@@@@BA@@@@@AB@@A@@@A@@BCB@@@@A@@@@@@BA@A@@@A@A@@@A@A@A@A@@@A@@@ABA@ABC@A@AB@@A@A@AB@@A@@@A@@A@@AA@@A@@A@@@@A@AB@DAD@DABABupSTOX£ÄA@CFEFCDCHEFCFEJADABADCBABCBABEBC@CBC@CBABAB@BABABCBA@CBA@OCC@E@G@E@C@E@CBCBA@ADCBCBGBG@EBIBIBIDE@EBIBCBCBMDODEBGBKBGBIBCBGBEBEDCBCBCFABADAD@D@D@DDFBFBB@DABCD@@EBAD@D@D@D@F@DAB@FAF@F@D@FAD@DAD@BAD@BCBGBCBE@E@E@EBEBCBA@E@ABABABADCBCBC@E@GBGBE@EBE@CBCBCBABEBCBADABCDADADADADEHAJCDCFEFEDE@@HBDBD@F@DAFBDAFCB@DCFAD@DBFBDBD@HAFBHBFBBBHBHB@HDBBB@BJBB@BBBB@@B@D@B@BAB@@EB@B@@@FAB@@A@@@A@@BA@AD@BBDBBDB@@BB@B@B@BDDBB@@BB@BBDBBDBDBBBADADCDCBAB@@AAA@A@@@ABCDABABCBCBC@A@@BA@A@C@@@A@@@@@AB@@BFBDAB@DAD@D@B@@FFBDAB@B@FABEBCBC@GDE@KBCBEBEBKDEBIDCBEBE@EBI@E@E@CACAEAG@CBCDAF@DDFCFADCFBFDBBBDBBBD@HFJJBDFNJNBBDDFLHFHB@@@BCBMFMFEBEAE@G@EAKAG@A@@BGT@D@BA@ABEBC@C@EBCBA@MFA@AB@@AB@@@B@B@H@@@DCFC@@@EBC@@BABAF@D@B@BBBBB@BABA@ADGFCBEDCDCDGBEBEBE@GA@@EAGCCCC@E@A@A@A@ABA@CBCBEACAE@EBCBGHEFADCBEF@DBFABEDEBGDCBCDCDCBAH@DBFBFAF@BAB@@A@A@AAA@CBEBGBE@EBEBEDABIDCBAB@DABAB@BABCB@BCFAFADAFABCJADCBCDCBEFEBEBG@G@E@CBABA@@BBL@D@DADAFADGJAD@D@BBBDBBB@@@B@@ABABGFAB@BABAB@D@BCDADADCBADCBABBD@B@BABABABCAA@C@CBA@CDADABAB@D@D@BBDAD@B@B@@CDABABK@E@CBABAJ@D@BDBDBF@H@B@D@DBDBBBDBBD@BABADAD@B@D@D@D@DCF@B@D@B@FAB@BDBB@B@B@BB@BAD@F@B@D@DF@D@DABBB@@BBBBDF@D@FBB@DBDDDBDDBDDBBD@BDDBBBDB@BDABBF@DB@DBB@FBDBD@B@DAFDBB@@DB@AD@DAFAFAB@F@B@J@FAF@FBF@FABAFCFCFB@BBB@D@FAF@@CD@F@B@DBBBD@BDD@DBDBDB@D@D@FAD@FBD@D@DADAFAD@D@DBDBDBDBBDDDDBFDDBDBBDFBFBBBDDBFBD@FABCDA@EDEDCDCD@B@BDBB@DBB@DBBD@@BD@B@D@BBBB@FBFAD@DA@@@DABAB@B@DBB@DA@AB@D@BBDBB@B@DABA@CB@DBB@DDDDDBDDDBFBHBF@HBDBF@FDDDB@D@D@BCFADCFCFCBABAD@DBDADCH@F@DBDBDDBBBDBHDBBDBDBD@DBDBBBDDDDBBDBBBBB@DBBDBB@FDBBDDDFBFBFBDBHADABABC@C@C@A@A@@B@D@FBD@FADCFA@CBGAGAGAGAEDGBCBEDA@CBCBCDADAB@BBDBB@D@D@F@B@BBD@BABC@CBC@G@GBGDEDED@D@B@@BBBBBBBBBDDB@BDB@DBD@D@BBDBBFDFDDBBB@@BD@D@D@DADADBF@DBDBD@DABAD@FBDBB@BBBBDCDEDCBAD@DBFADABGDCBADBBBBDBDDFBBB@B@F@DABADEDAD@BBD@B@@ADABBFDBBD@D@DBBDDBF@B@FADA@EBADAD@DD@DBBDBBCDCBCDADBFBBBDBB@BADABCBEDCBCBEFCBEDADEDEBEDCBCDEBCD@DBBBDBD@DABADADBB@BAF@B@BBBDBD@BD@BD@BBD@D@D@DB@B@D@BAB@FADBDBD@BBFBDBDBDBD@BA@ABC@C@CDC@CDAB@F@DADABABA@C@AACAAC@CCA@@EBCBCBCBE@G@IBGBC@EBCDCDADAD@DAFABCDC@CBC@C@CB@B@DBBDBBDDBBBDD@FBDBDBD@FAB@F@B@DADABADEBABEBABCD@@AFBDBBDDBBBDB@FBDBDB@@ABA@ABCDCDADAD@@BDDD@@@FDBBBB@F@BBF@B@F@B@BB@B@DBB@@D@F@FBF@H@DF@DBDDDDBB@DAFAF@F@HAF@FAFAB@DAD@DBBBBBBBBBB@B@B@BBA@@BAF@DBFBBBD@BABADAB@DBB@@DBDBD@BB@BBBBBB@DBDBDBDDDDBB@DBD@BADAB@B@BADAB@BBBBBDBDBFBBBDB@@FBBBD@B@D@FADBFBB@BDDBB@B@D@F@DBBBBBBDAD@@CDE@CBEBE@CBCBAB@F@D@D@F@H@DAFAFCDADAD@DBD@B@D@@ABADABAD@BABCBG@E@GBCBABCBG@CAE@CBAB@DBBBBDDFDD@DBB@@D@BABABCFABADABAD@B@DBDBDBBDBBB@@@@D@DABADCD@H@FBDBF@D@D@D@DBBBDBBBPDL@CMLMNELGj]VEZHTPRVTLZHJJBFFFBJDDBH@J@DAPALCJ@LEF@LCFBJ@N@FAHCJ@DCDCFCBCBE@CBEDE@CDCBEFEDCFCFEFAF@FAHBFBJBHBJBDDPFF@FDHBFBFDFDDDHDFFDBBBBF@D@HAFADADBFDDHJFDNHBHFJFDFHJBHDFBHBN@JBFBFBH@DAHCDCDCDCJEFCFCFEH@J@F@F@J@H@FCFAHADADDDDDDHDJHHBF@JBF@H@HAF@NCF@N@F@FALAHBH@JBD@LDF@HBFBJBFBJ@DBD@FAFCDCFEFCFAJCL@FAH@FAJ@F@JBT@N@LAJ@J@J@F@H@H@F@JAH@H@F@HAF@NAF@J@HAN@LBDBJBF@H@LBH@N@JAH@HALAFADBFAFADABCBCBEACBCBCDGDCDEFAFAFBF@DBFBFBFBH@LDN@H@H@NAHBFAF@FAFAFADC@EHEBCDCH@HAJ@F@HBF@D@JBHBH@H@F@HBJ@NCLCHCFCJCDCFCHAJCDADCHEH@LEJCHCLAHADAJCH@JALCFCDAJAJEHAHCHAJAJGFADCDC@E@C@E@CBGBEBCBCFIHCDAF@FAJCDAFC@EAGAEAEACAE@CACAGBCFEDADEDCFCHGFAF@F@F@H@FBFA@CDEDCBCHAHAFAF@FBFBBDBDDHFHFJHBHFD@H@LAJCJ@FAR@J@DFDFBH@FAFEHCFADADAF@DFFF@H@FCJ@DBH@LDH@FAH@FAFAHAHALEFAFCBAACBCB@FAFBDDBBHBLFDBFDDFFBDBDBJBHBD@J@H@JAF@H@H@TDHBVFL@LBBAJALGFCHCDCFAJCRAB@F@H@TARDJ@LBDAPBL@JCDEFEFCFEBCDEDAHGDAHEFEJCBAJCPCJENAPELCHCZITENCJELCHCLCLCJ@LAFCLCJBLCH@PCH@LAHAJAH@HCH@FAH@H@RCLCRANCHATEVGJELELCNEJCNELEHALANCHCHCFCJCHANEL@JBFFJDHDNBH@N@NCJADGFCDIDEBEDIFEHGFGHEDEHCFCHALAHBH@HAL@LBN@NBFAF@J@DAHAHCDCDCHGBEDCBCDCBEFEDCLGH@F@HCJAHCDAHAHAHAJ@J@D@FAPBfGNAL@J@J@HAHAHEDCBC@EDG@GAGCEEEEECEEEGIGCGGEACEEGCCACAIAC@C@EBEBGDEBEDCDEDCHAHALCHAHAL@LAHCF@LAJARALAFCPIPGHCNCJCJAFCHCJELGHEFANEHALCFAJ@H@D@LCDENAJGHCHALEJCLINGJALENCJ@PCJ@LCJ@J@H@PEHEJEFCBEHEFIFAJBL@H@H@NBN@HBHALBFBL@RDJ@P@J@FATAR@JAL@J@H@R@JBJ@HBH@JBNDLBFDNBLBPBHELCJGLAHAJAF@L@LCNBL@NDHABDFFBDBJBHDFDJFLFHLHH@NAF@NCFAHGHEBEAEAICEAMAGFQFMDIDKDIDMDGHIDKDIFKBEDEDIBGBEFGDIFGDEJGJE@IDE@CDCHQJKJKDIDKHODIFKDOFI@KDEBMHGDGDALIFI@ED@@A@ABAB@BA@A@E@A@AB@DC@@AC@C@C@@BABA@@D@BAB@@AAA@ABCBA@A@@AA@A@C@AAC@AA@@EAAAAA@AACC@A@A@CBEDCBAD@D@D@DABABBB@BADCFABABC@ADC@A@A@AB@D@HEBADABAB@B@B@AC@ABC@A@C@A@AB@BABABA@@@AB@BAF@@@BABBB@@AAABCBCB@BAH@B@@AB@B@B@D@FBDBFBFBB@B@B@B@BBB@BBB@D@@@B@B@BAB@BADAD@@ABAB@B@@AB@BAFABAFBDBDD@B@B@@BBD@HCFBB@@BB@D@FBFBB@@DBBDAF@@@B@B@BBD@BBB@FBB@F@B@BBB@B@BCDAB@B@B@BAD@BAF@BABABA@ABAB@B@D@BABAB@BB@BF@BBBB@@BBAD@BFBB@@@BBB@BBBBB@B@D@DBB@BBD@B@B@BDBBB@DA@@FBF@B@FBDDB@DAB@DBD@B@FCB@B@D@D@PD@BBBB@BBF@F@D@F@D@B@DA@@AA@@@E@A@ACCAC@C@ACCAACEBCAACCC@A@AAAAGCCAEAA@A@CAA@C@C@AAA@@C@G@A@AEACAAA@AAAAAACAACAA@EDC@AAC@C@C@CBA@@A@ECACCCCACAAE@AAEAAAA@EBAAA@A@@DA@E@ABCDABE@C@AAC@ACA@C@ABC@C@C@A@C@CBC@G@C@IBA@AA@CCA@A@EAAACCCCGC@AAC@CBAAAA@A@C@CCCA@C@CABCAC@C@AAAAAA@CAABA@CAAA@CAA@ACAG@E@CAAACEAEGCCCCA@A@C@EAECCEECCAC@CBC@E@ABA@CACAAEACAE@AAAAAC@A@ABAFEBCD@HAD@@@BA@EDEDMFEDAN@FAHCB@@CDABABCDCAA@@ACAA@AA@@A@AAAC@AA@ADE@C@A@ABADABABCBABA@A@A@CA@@AAA@@@AB@@ABA@@BCBC@C@AAAAAC@AAAC@@BCDA@AAAAA@AAAAACA@A@AB@@AAA@AA@AA@ABE@ADADCBA@CA@@AAAA@CC@A@ABABA@A@@@AACDA@CDEDC@AAAA@AA@A@A@AD@DCBC@CBADA@A@CBC@@FGFCBCAA@ACAA@AA@AAIACACAE@EAI@CDAFCBA@E@C@CAE@AAC@A@ABABA@A@CDEBADE@ABABEDAFEDADC@@BAAC@AACEGCCCA@@C@IAEAAA@A@ABCD@JAD@FCPEFADEBGDADADBDAFAF@DCBC@C@EAGAEBEAC@CCEAE@KCCAEACAAEGAC@C@E@CBADAB@BBD@F@BADABCFEDADAPK@A@CACACCEAEBE@AHEDEAIEGCKAGAC@C@ABABEFEFCBEBABCBAFANEJEHCHEFEFGFCD@F[BI@ECKCEEIGEEGGCICEAICGEEEEEICGCGCGAIAIBIBM@I@EBGBGBC@GCCAECCEIICACAEAG@E@GDGDIFGDIDGDGBGBEAGCCCCE@I@GAKAGEGCEECGEGEEEIOEQAG@GBEBIBG@EFEFAHAD@BCQ@IAG@EAEAGCEAMGGAIEMCGCGCECECECGGACBEBGDEDEBE@GBGBGBCDG@E@EAACCEAECCACEBCDAJEBADADCHGBEDCFAJ@JBF@F@F@JCJEFCHAPG`KJEFEJELEJELEFCHEFCDEBG@GAGEEGCCCAE@KDOAOBKAGACACE@G@CAECEECGSUGGEGEGEG@C@AAA@GBEFETMNGNGLGJEJGDEHEDIDGBKDGBEFQDIBK@IAUAMCECCIEECOEMCCCECAC@ICCGGAEAMJMDG@GACCGIGCCCCCGIECCAE@EACCCEAECECCAIGCEACAECE@EAG@E@G@EAE@EBEACAEEECAIGECCAGCCCG@EAECGACAEAGCCCCAECCE@EAE@C@E@EBEAGBCAG@E@G@G@E@CAEBECMAG@EAI@EAGAEAE@CAE@C@EBEAKAEAGACAECKEEEEECECCEEECGCE@@CAC@E@A@@@A@AB@@@BCDABC@ABC@C@AAA@C@E@C@CAC@EAA@CDAB@DABABABE@C@EBCBCBA@A@AACAAAA@ADCBCBA@CACAE@CBA@CDADA@CBEBE@CBCBCBABCDADADCDADC@CBC@CAEAECA@C@A@C@CBEACACACACAEACACCCAA@AA@AACAAC@C@CBEBGBE@EBEBE@C@CBCBCDCBEBCBC@CBEBE@E@CBCBCBA@A@@ABA@CBE@ABC@A@C@C@A@@CCAAAAACC@AAAAA@CAA@AAA@GAA@CBADCDCD@DABABCBEBC@C@EBC@E@E@EAC@C@A@AAAA@CAACACACA@AAC@C@C@C@CACAAA@C@C@CBCBA@CAC@AAACAACCCCA@C@C@ABCACAC@C@A@ADCBABCBCBCBCBADCBCBABAD@BABA@ABA@CACAECGAC@CBCDADADADADCDADCBEBCBEDABBBBBBBDDBDBDBF@F@D@B@FBD@BDDBDBF@D@DADADCBCD@@EDGDEDCBAB@@DBB@DBDDFDDBBDBBBBD@DBBDDBDDD@D@D@FBD@DD@DAFAD@DBFBDBDBD@BA@CBEBCBCDCBCDCBADCBCBEBCBAD@D@D@FBBCDA@CBE@E@EAE@C@C@CBC@C@CBC@C@CBCBCBC@C@E@C@C@C@ABAB@DADADAFABABCBCAE@CAC@CAEACCEACACAE@CAA@AAAA@CCCACACCACCECEAEAC@GAE@EAEBE@CBEBE@E@E@E@C@CAEACACAC@C@CDCBABC@CBC@AACACECCCACCCAAC@C@CBCBG@A@C@CCCAACCE@@@C@ABEBEBC@C@CBCBEBC@A@AAACA@@GAEAAC@K@GBABA@@AAC@CCACAE@GDE@A@CACAC@CBABADADCD@BADBB@BEFC@C@C@ABABAFADAD@DADADCDABCBCBABAF@DADADAFADABCBCDABA@C@CAA@CAAACBAB@BADABADADCDCDCAADBDBDFBBDBBBBAD@BADADADAD@DA@A@CAEAC@C@E@EBC@C@E@CAEACAC@CAEA@@CB@@@BBBBD@D@D@DAD@FABCDGBCBADAH@DAFAFCDCFCD@D@DAB@BABC@A@C@CACAEAEFEDEBCBEBA@AA"],["@@AE@AAC@A@@AAAAAAC@AAGACM@CDCTKDCJ_DGFGJIHM@I@KFGJCBMBCGOEACKHO\\ERKRAHITCJ@NCJ@D@JJNAHAJ@NCPBVAlGBACEAKBCDE@C@ECEIGCAACBGAEGEGGAE@CIKCKAOBIFOCKMKCAIq]GAODKAGBICKAG@IAMEBKDCHCHEFC@A@ECS@M@GAQCKHG@UGMAK@GBEHCBG@ICECEAEDA@CAI@EBM@E@IASBGAG@GDCDMAGAEEICACCG@WGIEIAG@GCEAACAG@EA]UACA@EBG@GAIAK@MBGAE@EDWFSAABCFIPMZC@GCC@EBGBEHCFCBGBEFGBIBU@Q@C@O@OAIAIDMBUHMDMDQCMEE@EEIAG@EFKDC@GCAAA@GDGDG@IACBCB@BBJ@HAJEF@DGHCD@@AA@ECGAAACEAACCC@ADCHCHGFKDEHABAAAIAI@ICIAGACAG@C@@@BCDE@GBGDCBA@GEG@C@CJEHGBECGEGGOEEEAMAIDKFKDCA@AFCBE@EACAA@AHC@AAAJE@EIG@@CKDEJGBGIQIM@EEGACIBSJCHAFAFGL@BJHBDAFKFKBU@OD[AGHG@MCKAMDCDC@AECCA@IAC@A@KDIFAF@H@DCBABGBKFKLC@IEICECC@IBKBAAM@CBIDCBCDGD@DFF@@IBIFCBC@ICCBEBGBCDA@CBGDA@KCKAEDEBC@EBEAGCGCCBGBABCAAA@C@A@CBCBA@CC@M@ECGACBIDABCLALAFCJ@D@BBDAFKHCD@J@@ABGAIECAAA@EAG@AAAKAECMOA@CFABCBEJIFIHCF@DA@ACECCCEAMBKAIBEBK@IDGAECIIBEAGCAG@O@A@CG@AI@IAG@CDKBM@EBILCBOCC@CJCFEBIIECG@C@AECA@DGHABE@ECSEE@EBAAIECAG@INEDC@EACBM@EBIBK@GBE@KAIBEBGBGBEDI@G@K@CACCCCGCGAG@KBIBE@G@KBGBEDIDEFIFCDGDEDEDECEAGCGEGAGAI@I@CBI@IBGBE@KFGFEDEDIFK@M@IAC@IBEBEBMFIDODIAIAGAMAMAE@E@E@EDGDIBCDEDCDEFEH@FDBNFLFEDG@K@M@K@GCCCEAEGCCECGAC@K@EDCBGHIFIFIDIDEBE@EBEBIBK@G@G@GCGAICGGECCGCIECEGGGEAC@EAEBIBE@EBEBI@E@E@MAGAGBICKEE@EBMDGDGDEDKHCDEFEDGHGJGHEFCDEBGDE@CBG@MDIDKFC@EFMDMHSLKDCFIFMDM@I@UGMAQAI@GFQBKBK@SDOJEFGJKDIAI@OFWDWBMDCDMFGBCHAFALCFAHIDQBIAIEECGCIAK@EAK@I@G@KDIJGFCFKFI@KGMIEEMAIBK@EDG@QBMFKBG@IDKBGFIFGDI@GAKBIDGDKFQJMAE@EGACAE@GCICECAGEGBIAE@EBG@GDCBK@GDGDIBEBK@MBUFCDIBS@GBIAK@MAI@GAIBOFGBM@S@QAKA[FMBMAO@Q@OBYAEAQBG@Q@M@MBIBMDIFMFGDKBO@IAIAMBO@M@KBKBMDQDKFKDKBK@K@IBMDI@G@IBM@IDI@EFGHAFCFAFAFAJEJCFEFKBKFUDM@OBQBI@GAECMGEGKICAG@GBKDMDIBIDI@MDKDKBIBKBI@MBQDGDMDKBIBODKFIDM@OFYDGBK@I@G@G@OAMAM@IBK@IBM@M@SBUDIBM@G@KBIBODG@G@OBG@IAGAQ@I@K@IBI@IBIBE@K@E@M@O@Y@O@I@KBQBKBKBKBM@MBODGBM@KBQDQDMDQ@gDKFUAKDEDG@KHEDEBEDG@GDKDIBICI@MAEAKAK@K@EEGCEEGEIEIAG@EHCFGHCDE@GBGBE@GBKAKAIAM@O@O@I@OAG@MBMBIBI@EBGBKBQBGBIBGBMBIDIBIBQBGBODGBGBGDEFEDMDQD]HKDIBKDKBMFMBMDIBIDOBE@G@GDKBIFGBGDGBGDEBCBGDKBIFIDEDCBEDGFCBA@BVGJABCBCBG@_DWBQFaFEBIFKFKBIBI@IAG@IAG@GAKCIAICGCEAMCQCKAIAGAQAGAIBGBE@GBI@G@KBMBKBIDKDKDSDIDIDIDEBIDKDEBIDGDIFEBE@IDE@E@G@KDG@EBCACEC@CA@@A@A@C@AAA@A@ABCBGDEFCBGHABEBA@GBC@GAE@C@C@C@CBCBE@KDBBBDBF@@ABCBCBKNAB@BC@AAEA@@@BBH@D@D@BABA@A@GAA@ABABE@@B@B@H@F@BEDA@@B@B@BDJBJBFDF@@B@@BBBBB@@@BADAB@BBBBBDH@@DDBD@B@D@@@B@@HN@@DDBBAD@@@BBH@B@BBB@BB@DDB@@@DB@@@@@@OCCAA@AAC@EBG@A@C@@AAA@G@AA@AAECCCMIEC@@GCE@AA@@AA@A@@AA@CA@@@@A@AAG@CAAAAC@C@@@GCCCGKA@AACAAACAEAAAG@A@@@CCAACAAAA@AC@AA@EAAAECOCAAA@AAA@GAA@CCA@AAGAC@GCCAA@CAE@GAEAGAICGAICIAIEKAG@EAG@GBKBGBGBK@KBKBKBGBK@KBGAIBKBIBGDMBKDEBEBGFGHEFCDGFIFGFGDIFIDODIFGDGBIDKDMBKBSHODKDMDMDK@IBGDIBEBGDGDEDCDEFGDIDIBI@KBC@ABCBCBCBA@E@A@CBBDDDBDFDDDDF@B@D@@ABADABCBA@ABCB@BAB@DBB@B@BBB@BBB@D@B@B@B@B@B@D@B@B@B@B@BBBBDBB@BBB@B@BAD@BA@@BABC@ABABC@ABC@A@CBA@ABC@ABC@ABCBABABCBA@CBA@A@CBABC@ABA@EACBCDABAFABCF@D@FBD@HDFBFBHDFBDHFFHHFHDFDDDBDBD@D@DADCDADEBCBE@EAEAA@G@CBABCB@DBDB@DBGFEBADGFEBEDEBGDABABAF@DBDDFDDDDDBDB@DCFCDAFAFAFADBF@DDHBFFHDDBD@DBD@BDFBDCDCBCBCDAD@BADDDFDBBBDBB@DAB@DBBBFBDFFJHBDBDBD@D@DCDEBE@G@E@C@ABEBCBABAFABBHBRBHBJBFADCB@DD@JDFBDBABEFCBCF@BADBDDDBFBBADCDEDCFCB@DAD@DBHDFFLDHBD@BABEDO@I@CBEDAD@BCBCBGBIBEBI@E@E@QFKBIBI@ODMDKBKBE@C@C@C@CAACCAAACBGBEBCHCDCBCBEBE@CAEACAC@E@C@E@CBEBC@C@CAACGAC@EBCBCBEDG@E@G@GAC@C@CACAC@CDEDE@CBEB@DBFFFFFDD@DCBEBE@CBABABCDC@CAECA@G@KBIBE@C@CAEACACDEDGBA@EBC@E@CAEAC@CAE@E@CBC@C@CDEHGJCHEFAH@@CJAJAD@BFBF@D@FBBBDHAHBFCFAFADADAF@F@FBF@BEDABCDCBC@C@EAC@CACAC@C@@DBDDDDFDFBD@B@DABCFABCF@DF@FBDDFF@FAH@D@FBFDFBDF@PBFBF@B@FFBBD@HDFBBBDB@FELCFADCBGBE@CBABABAH@BAFEBCDCD@BAFBBBDDFBB@D@DCBEDCB@DALAL@PALBDBBDBDBBB@HAHBFDFBF@F@DDDB@H@JABAD@HAF@F@DBFDBDDDDBDDHBF@FBDBHHFDFDBDBF@HBHDFDDFHFFHDFFDDDFBFBBDBJJNJNLJHHHLHDDDDNFLBFBH@LALAL@F@JBLBNBN@L@JAJ@LCH@L@J@H@H@H@DADAF@FADAFAF@JBLBHBLFLBLFNDFDDBFBF@D@B@DDBHBF@FFJBH@PBF@DBBBDDBDBJBTBZBT@T@R@H@D@FBDBFBHHFBBD@DBHBJ@L@F@BBFBB@DBBFBDBDBD@F@D@BBDBBBDDDBD@DBD@DBDBDBD@FBF@FBD@BDBBHBDAH@DABADADADABAFABCFCBAFCB@DAD@D@F@D@DBF@D@DAD@FBD@D@BBFDF@D@F@D@D@HAH@F@HAF@F@F@F@F@DBH@D@H@D@FAB@FBD@BBDBBDB@BD@B@DADABABAD@B@DCFABCBCBCBCBC@ABCBABCBCDBFBDBDBBBDBDFBBBBBDBBD@DAB@FBD@D@DAFBD@B@D@D@DAD@DCDABADGFADCBCDCB@B@@L@D@DAF@J@LAHAVIPCFAHAT@HBJDFBN@J@HAHAHCREHAHAH@F@@@F@FAD@H@F@B@FAHCBABAB@BCB@FGBAB@BAJ@F@NBJCD@HAFCFAJCDAJ@B@NBF@L@D@HAHCB@JEBA@@@ADAJGD@D@B@@@FCD@BAB@@@BADADA@AB@D@JCD@B@BBB@DAPCBADABABA@AB@DAB@DA@@DCB@NCB@F@LBDB@@JADAPGBAB@BABAB@B@B@DAB@@@D@B@HBB@FAD@D@B@B@D@BAD@DABA@@@@B@D@B@D@@@BBBBD@BBHDJBDBBBBBBBBBDFDBB@D@@@B@@@B@FADAD@D@B@B@B@B@B@@@B@F@D@B@B@D@FB@@D@DAB@B@DAB@D@BBB@@@BBD@B@@BDDBB@@@D@@BBBB@B@BDBDBBB@BBDBB@@@BBB@BADBBB@JDDBFBF@B@@@H@DBD@FBHDDBDBDBDDBBB@BBDDBDDHDFBB@DBDBBBBB@BBH@F@FAHBJ@LBB@B@@BD@BBJBBBDBFBB@DBD@BBD@BBB@B@D@D@BBBBD@BB@BD@HDBBDBBBB@FBHBH@BBJDFBDBJFFFDFDDDBLLHFDDBDBBDDBB@BBBB@DBDBFFHHFJHFDHB@BBFJDFBDB@D@DB@BBDDFRJNJNJPLDFFDBDB@@@D@H@FBDBDBF@BBFFDHBDDBBD@DDBD@FBH@FFHDDBH@FAJELAJCRAH@NBLBXHPDFBJBJDHBFDHBFBFBD@DBHDHBFBB@B@@@DABADADAJCHCF@DBD@D@DAFADCDIDGDAFADAFCDAD@D@B@LFFBB@F@BABADCDEBAFCB@L@HAF@DANCJCBAB@D@D@D@DBBBBDBBFJBBDBFFBB@BBBHDFDJFJDLFLHFDFBDBF@D@DBD@FBRBD@JBFBHBD@J@DBD@D@B@DBNDD@JBFBFDB@BDBBHJDFDDFHNPFFDDFHDDJJDDHHJLDDHFHHDDDBBBD@D@H@R@FBD@F@DBDB@DBBBFBBFDFJFDBBBBFFHJBBHHLJDFDLFJF@JJJNJJDHJTDHDFHLHHFDDDFFBDHFBBBBBB@BBF@BBBBFBH@BB@BBFJ@DBBDB@DBB@BABORCFEHAH@BAXDTNLRHZBpBT@NHTVHJJLDDD@H@F@LCHCPIPITMNIDEFCB@D@NBLBNBJ@LAJ@JAJ@FAHAHCJCLGPGFAHALALAX@J@HADAFAFCLAJAH@JBF@HELC^GLEJAH@F@RAHDJHHLDFHBH@DCJAF@FDFDJDJB^@H@HEPCNAR@DDDDAJENKTEJCHEJKFEHAL@L@HBFDJFHFJFJHHHT@JDJBHFFBNDZDRBFPDNHLBLBHBLBTBPD^FPHFFJFLFNLHF@NGHAJDLBB@LIRARDXFNHLHJLJDBD@F@\\KPEPGXKjU^U\\YXYT[HOHE@@RILELMPOHIHAXSJKbAhABA|@@z@d@LAbKdQ¸q`OHAD@BH@@JZHLJBjAPEJA`CLCL@JCJ@FBNJVHJDHFPFL@LB@@J@JCFBJ@NCL@N@NDDHDDDB@DCFAJ@HFBNDR@ZA^@H@@FGLGF@JFHDLLJHJPFJDPDLFJHNFRFH@JBL@LGXKNGZCHCHGHCHANA^@\\BN@J@FBHDHAPAPCJAJAJ@JBFDJBH@NBN@L@HA\\@JBHBJAHAJ@JAL@HANAB@J@JBJ@J@H@J@H@JAJ@NALAJCHAH@HAH@NCJALCJAFAL@HAHALAHAJALCLCDCLGHCFCDAFCDEDCFEHGFCJCDAB@DELIHIFCHGFCFEBAFAD@FGFCJGLE\\SDCBCHEFEFCFCF@B@BAD@D@BABADAB@D@B@D@HCBEBCFCDAFAD@HCDAFEHEFCFCNCDCPKDCHEHEJIFAHCHEHEDCJEFCFCNGNSFEFCBAPIFCPOFCDAHCFGDCBE@C@G@EDGFCFCNEB@DAD@D@FADABEJMTKLGNILIHGFAD@HADCHCNELENEHCLCJAFAFAJ@FAH@RBDADCBABEBGBCDEBEHIJGJELCHCF@FAH@PBHBNBH@H@R@DAHCFAPEH@F@LADCFADAFAFAHCNEHAFAHAF@HAHAHAVEFCFAPCJAFAH@PCFAF@HAJAFAJ@F@P@F@HAFAHAJAB@DAFADCFEDCFCBAF@FAJ@PAFBH@J@HBHBFBPDN@JBH@F@H@J@H@XBH@F@F@ZAFALAH@PCHAFAHCFAFAJCHANEFAFADAFAFCDAFABCFADCDANMJIDCJEDANINEFCHCB@LGRKNEZITCVCL@BA@CCC@EDOHeJkRBSF_JcNJUHGDCTUZ]RQVYVY\\]NKHCHEb_DEBK@mAG@O@e@aAQBAF@b@JAHC@KDUHQNmJo^ÍZ¹JkH]HmBODYFUDIFCJAV@b@RBnBL@^F\\JTDL@PBpHXDVDP@T@NA\\@J@tA|AB@@E@SA[EQMYMcIWEIKGYMOIWKQKIECE@GACCCAE@E@ECEAC@A@G@CAA@EBKAADC@CAG@GAQ@C@@@AAAAA@C@AACAAAA@AA@@AAC@@AA@A@A@@@A@AAAB@AA@@@A@A@@@A@@@@@C@@@AAG@@AAAA@@@AAA@EAA@A@@@A@@@C@AAA@AAA@A@@AA@@AA@A@@AC@A@A@@@@AA@@@ABA@@@@@@AAA@@@@CB@@@@AA@AA@A@ABABA@@@@BA@@AA@@AA@@@CA@@AACA@CC@A@AAC@ACA@A@@BCAA@A@@@A@A@@@AAAAAAA@AB@@AAA@AA@CAA@@A@@@@@ABA@A@@@A@A@@BA@A@@@AA@@AAC@@AA@@@@@A@@@AA@@A@@D@@@AEA@AA@A@@CAA@@A@@A@@A@AA@@A@ABAAA@@AA@@@@@A@A@AA@@A@@@AAA@AA@@@@A@A@AAAA@A@@A@A@@B@@A@@CC@A@@E@@@@@@A@A@@@@CAA@@A@@@@@@@AA@AAA@@@B@@AB@A@ACAC@A@AAA@@@AAAAA@ABA@A@A@A@A@@BABA@A@@@C@@CCA@@A@AAA@@@A@A@A@@@@@C@@@AAC@AAAB@@AA@@A@A@@A@AA@A@AAAC@E@AAE@@@A@ABA@ABA@A@AAAAA@@@A@@BA@@@A@AAAA@A@@A@@@AAAA@@@AB@@A@@AAEA@@AAAC@@CAA@A@A@A@AA@AAA@@A@AAA@@A@@@A@AA@AAA@@A@@BA@@@@@A@@BA@A@@A@AAAAC@@@BC@AAACCA@@@A@AAC@A@@@AAA@AA@AAAECAA@AAAA@AAAC@AAACAA@@A@@@AA@ACAA@AACAACCEAAAECAA@ABAACGCCAA@ECAA"]],encodeOffsets:[[[94015,33745]],[[97847,36876]]]}},{type:"Feature",id:"632823",properties:{name:"天峻县",cp:[99.022984,37.300851],childNum:1},geometry:{type:"Polygon",coordinates:["@@GDKBMLEDQBG@GAG@MAKGKAC@ECG@QCC@KEAAACCCAECIGOAC@AEC@@@EBI@AA@CCCAAAMEMEKAMAGAC@G@E@E@C@EDGDGBG@EBADABBDBFFFDFDDBD@DBB@BDDBBBDBFFFDDB@B@DAB@B@DDDBNJBBB@J@DBDBDBFBF@BB@BBD@BBBBBBB@B@DBT@BA@K@G@C@CBCBEBABEJGDCD]`QAJU|KHwS@@@@KCsIEDAhO^KXFlah@@EAC@C@A@ADAB@FBFADADKF@@AFADEHADAD@BDDBD@DAFBDBDJFDFHDDD@B@FADOLKJKFABCDADABABEBKBKBIDIDIBC@E@EAIEA@@@C@CDEDOHA@K@A@CBCDABA@CAIEGAA@EDIDEBOJGBABABCBCBGFCFADCBCBIDMHGDE@EAC@CDADAFCFCDC@ACCAAAEAGAE@ABCDA@C@E@A@KHA@A@OAA@ABCFADABOCI@YAI@E@IBEBE@C@EAAACAA@E@E@E@A@GCGAEAGAQBG@QAEAEEQOGEC@CAAAAA@C@C@A@@A@A@CDCHABCDAB@B@BADADCJADCFEFCD@FBF@DCHGJEJGFENADCHEJABWEG@CCAAECSKYKCACAE@CAKCEAE@E@GDE@IBG@ICcGsOUEkMaKICEAAAACCMACBC@C@A@@AAA@C@CBABI@@AA@BABC@ABA@CCGCIACEIACAEAGAEC@CBEFCBC@G@E@CAECEAE@E@EBG@IBIDEBEBE@GCC@E@CBCB@@AFADABCDA@@@ICA@C@CBSJEBC@CB@@AB@D@B@B@BBDDB@B@B@FAHAD@BCBA@E@EBABCB@B@BBFDFJHHFDD@DFHFDDFHDD@JCJ@LDBBB@@BABED@B@BBD@BADCBEDAB@HAFCDCBCBC@A@ABABADABKBA@@BAD@BBFFFDD@BDBHBLFJFJHDDVV@DFB@DBDBJ@HCNADANBH@DAHBBAB@@@@G@GAE@K@E@ABA@A@ADA@ABA@AB@@@B@DBD@BADAB@BBFABAFCFADADCBMDKFAB@BBJBDFDLDDBBBBB@JBDFJ@BCH@NAJ@DBB@@ABGAG@CB@B@@FDBBBFDFBDDBFBB@@B@@@BCDGHAB@F@F@@BBHDDBDD@B@D@JGPAJABEBKBCBC@CBG@M@E@A@CDEBODIBGBABG@A@A@@AA@A@A@ABCBCFCB@BEP@DBBBD@D@BCL@D@F@B@B@BB@HDB@@B@BADADCDABC@A@AB@B@@BBHDDBDBDDDDJBBB@B@@ADEB@B@LABADEBA@OBA@@B@BDBBDHLDD@BBD@DADBBBBDDLDLBDBDDDDBD@B@DAD@B@BD@DBDBDDBBA@ABA@GACBA@ADADBH@BA@CAC@C@GBA@AAA@AACGAEAA@@AAGAIAA@AAECA@A@EDIFCD@B@DBDBFBD@BABA@E@ABA@@B@D@B@BA@IBE@ABABAFBFABABEDABCDCFA@CDC@CBKBE@EBEBEF@@EHEDUDA@A@KECAC@CBA@GHKLAD@BBDBBBFBFFHDBFDBBB@@DABEFAFADCHCHABABE@K@S@C@A@CBABCH@DBD@B@D@BEHGHEFGJABEBE@G@C@IAA@A@ABEHAD@D@@@D@B@BCBGFAB@BBDHPBDBH@FADCFEFEDKHCDAF@FABCFKJKFIDCBCDGJEH@DBD@D@HEHAHCDCDGDG@CBEBABCB@BC@C@C@A@A@CBABEDGDIDGDCFAD@HBHBDCFCHCJCHADCDADHRBF@F@FBF@HBH@D@D@B@@DBBDBBBDDL@DBLDDBFHHDDDFFBHFDBHFBDABADAD@B@B@F@@ABCBA@ABADADABADBF@B@FBHBFDNDFRRHJBBFDNLBDBBADCDCDILABADEDADCF@BADCFADGFKJGHADABBFADAF@BILEHCHMTAD@DDD@B@D@@ADBBFFDDBBDDBBBB@DBB@B@BBJ@DB@@BBBDDBBBDBFDD@BBBBJDJBD@B@D@B@F@@@B@B@B@D@H@F@BBD@BAD@@AB@B@BAB@@@BA@@B@B@DAD@@@B@@A@@@@BA@AD@@@@@BA@@@AB@@AB@BA@@BABA@@BA@@B@@A@ABA@@@EDAB@@A@A@ABA@C@A@@@A@@@AC@@@@ABABA@AB@@ABA@CB@BB@AB@@A@ABAB@@AD@BA@@BAB@@A@@@@BA@@B@@ABABABCB@@CD@@ABA@CDABA@CBCBEBABCDEBABABEBAB@BCBCBABAB@BCBCBCDCFABABABABA@AD@BA@ADABCBEDAB@BAB@@CBABA@ABA@A@@B@BA@@B@@@BABADABAHA@ABCD@@C@AB@@AB@B@@CBAB@BA@A@EBABCBG@CBGBCAABA@@@A@C@G@ABA@AACBEBC@@@EDCBA@CBCBEDABABAB@@GBCDCBA@EDCBEBA@C@AB@@AAEAECA@@@C@@@C@CAA@@@C@A@A@A@@@A@EAEAE@@@C@A@ABA@AACBC@ABA@@BA@@A@@C@C@A@G@ABA@@@CBABC@ABCBEBA@A@C@CAC@@@A@@@@@A@A@@AA@C@A@@@ABA@CB@B@@EBC@C@CBI@EA@@ABCDMBGDIDEBKBCBIDIBEBEBGDEBG@CBGBIBGDEDEBEHEFADCFADAHAHCHBF@F@HHDLJFHDFBBBBBBB@DFBBDBBB@@AB@BA@IFEBAD@DCDCBCBMBE@YBGAG@CBCBCBGHEBEBE@IBM@IBC@GTADE@EBCBEB@D@FFZ@DBFDDHBFDDDDDBHDBB@DBJ@FBB@BB@BBDABEBABBBDHBB@D@BABGHCD@D@B@F@TA@EBG@EBAB@@@BBFDFBFDHBD@BAD@D@D@B@@A@ABE@I@C@ABA@@BBH@B@@ABIBGDCBABEAEAC@A@KBG@CBA@ABGFADA@CBEF@@CDAFAH@DBBDJ@BAF@BBBDDFFBHDH@FABGBMBWBE@C@IECAC@C@E@EACAEAAEECECCAE@IFGDCBKFG@KDEBEBCDCJCBGBGBGBCDCBAFABEDIDMHCDCFAF@BDFBDBF@DCBIDEDCD@B@D@D@H@FADCJI\\AFBJ@BALAHAH@BBDFDNJFFHJBBLHFFBD@FFPBJ@BBB@B@B@D@D@B@@BDBBBB@B@@A@CBC@EAA@A@CBCBC@A@C@IEIAE@A@IBEBEBA@EF@@GBA@CDABA@@@A@CAECGAC@IAGBA@CBCDEDKDG@CAA@A@CBEBIFABCDCBIDA@CAIAAACA@@IFGBCBA@EAOGQEC@A@EBCBCBABABC@ABIAC@@@@@@BEBA@@AGBC@EBA@A@CAA@EDKAUPIVI@ID@VHTJVENGFGNBRDPFPHZNTT^DRIPIPBPDRFLIVHDNDBT@TLLZZ\\`@@BBDB@BB@BBB@@@BDBBBBBB@BA@@@@@A@@BCD@BA@@@A@A@A@A@@B@BBD@@@@AB@@A@A@A@A@ABA@@@@B@@@B@B@@@B@@A@@B@B@@@@@B@@AB@@BB@@@B@@AB@@@B@@@BA@@@A@A@AB@@A@A@AB@@AB@@BBDB@@@@@B@@@BAB@@A@AB@@A@@@A@@@@@@BBB@@AB@@ADA@A@@@@@A@AA@AA@@@@@AB@BA@@@A@@@A@ABA@ABABA@AB@B@@BB@D@@@B@@AB@B@@@B@@@BABABA@ABA@A@A@A@@B@@@B@B@@@B@@BBLB\\DdHbFb@R@JAPIJIPC^@`DR@`BfBXAPEFA^@^@zCfKlO\\K\\GXGRBLCH@F@JBH@HBHBHBD@FADADAFCDCBEBG@@BABCBEBCBCFCDAHAHAF@HDDBHBJBHBHBFCDCFEHIDCFCFCHEFAFCBBDB@BD@BCBCFCD@F@H@HAHADADAFADCDAFEDAFEDCDEDCDGDCBCBCDCDEDCDAFCFAJCFAFAJAHAFAFAF@JAFAHAFAFADADCJCJAHCFAJAJCJCHCHCJEFCFCHAFCHCFAFCHCDADAFAFALAFAFAHADCLEFCDAFAF@FAFAJAHCHCDADCBCDEBEBCBCBCBE@ECICE@C@CBEBCFCFEFAJADAH@H@H@J@JAHADAHEDCJCFCFAF@HAD@FAFAHAF@D@F@F@F@F@DAHAFAHCJCFADAFAD@FAHAFAHCHEHCFCHCJEHCFEFCFEFCFEFADCHEFAJCHAFCFAFAHAHCFCDAF@HAFAH@HAHAJAFALAFCDC@@B@FBFADCBCDABAFAJCFAFAFAFAD@F@LBH@FAF@JAFAFAHEDELIDABC@A@C@C@CBCBADCBADALADADCFGBADADCD@FBF@F@FAHEDE@CFGHMDCFEFEFEDCDAB@@@@EBC@ABADEB@FCBABABEFGFIBABAB@BAJAP@D@JGFEDEBCBCBEBE@E@E@C@EACAECCACAG@GDCBCBEFGFCJAD@JADABCDCDEDCDEDCDCDEBCBCHGDAF@FAN@F@H@H@H@F@FAJ@J@JAD@H@H@H@FADAFEHGDCDEDEDEDADAHAFBBH@H@HAH@DBDHDF@FAF@H@H@JEFCFCHEFCHEFEJEHCHCFCHCJGJEHELGJEJEHCNENELAJCHAB@JCLCFAFAJAL@LALAHAHAJAVCJCLALAL@P@P@L@LAJAJALAF@FCFAHCFEFCDGDIDGDCFCFEFCJEFCHCDCHEHCLGJCLELCJAJAJALAHAJ@F@HBLBH@J@JBJDLDHBHBJ@HAH@HAFAFCB@BCDADCDE@CBCBC@EBC@EBCBCDCDGHEHEDCHCHAHAJAF@JAHAF@FAJAJ@F@LBJBLBL@H@FBFBF@FAJCHAHAJ@F@H@PAJBH@L@JAH@JAJAHCJAB@HCHAFCHCFCHCJEHCNEFAFAJEHCHEFCHEDCDCFCBADCBCDCDGBEBEBGDEBEFEBCFEDC@GCCCCGAGBG@KBG@E@G@EAG@EAEAGEAECG@CEEECECEAEAIAECEE@EBIDEDAFADAJCD@FAHAFAF@FCFADG@CCGAECCECGECAGEECCCEAGGCCCACE@C@EACBAACAABCJEHCD@DAH@BAFADCB@H@BAF@BADCDCDAD@DADBDBDBFBDBDDFBB@B@BCDAFABAJADBD@DDBBDBLAH@FBBBD@BAD@BCDABCBCBC@ABAJADAB@BABCBAF@HATEBAB@BBB@FFLAL@L@JBNBLBNBHBHBLBJDNDPFFDFFLJHDRLVDDAJGHBDFBHBP@JFDXAVJJBTBLFHH@HDDJBNBTADADIFCLGBCAEIMEC@CFKFGBCHCHEFCHEHCHEHALCFAFCJAJANAJ@JAHCHCLCHCHEHCHAFCHADAHAH@H@JBHBLBHDHDHDHBJ@HBL@FBJ@H@F@HAH@HAFAFAHCHAFADAFADAF@F@F@HBJBF@H@HBHAB@DADAJG@EBGBE@EBG@EDGDEFEFCBCDCHCFCD@F@BB@@F@B@BAFCB@FADAD@@@@BABCD@BBBBF@B@BCD@B@BB@D@DCBADEBCBAB@HAFAJAJAF@BAB@DADABG@ABIHKBC@CBOCEHOAC@EM]CIAKCGAEAOAI@E@CBAF@B@FED@DAFAD@HARELCB@H@FADCBA@ADCDCF@FALIFAB@@CBABAD@FBBAFAD@B@FDBBDADCHEFAFAJ@B@D@DA@@FC@@@@O@EACEEAECAAE@EEA@C@EACC@AAACAA@EBAFE@E@ECC@E@GB]AYEWMMEOKOGQAWDqFYEWB·]UD[LI@eO]YYCUBCLE\\MFKJELADKEGIAMEAAEG@IHCFCD@LIHAF@F@BCFEDAXKVGTCXOVS\\KBSFcLOX@F@TRHVP@RGXG^IT@fGbINMLEVCXIVCFAHAFADAHCBCBC@EACACECEEE@CCCACEAE@EBG@E@GBGBE@KBGBGBIDGBEDEBEBCDCJEJCHAH@JAFCFEDGFIBEBEBGBI@G@E@G@EAEACEGAEEEEAGCG@E@E@G@IAEAGAGCE@ECICEAECECCACCCCCCCECECEEEEEECECEEECGGECCCEECAC@EACBIBEBEBGAE@GAECEAECCCCCCCGEAEAE@C@CBEDEDCBCBE@EAC@CACACCCCAEAECEAGAGAGAIAICCCEEECGIEGACCCCEAICIAGCGEE@@ACCGCECGEECCCCCCACAC@E@EDEBEDEDEDEBCDG@GBEBEBI@E@E@E@E@I@EAE@C@CAGAAGGCAACCEACKECCECGEMEKEK@IACAECCECGACACAaDUBUDABCDCFCDABIDAHE@C@A@C@CDAFG@A@G@GBCBCBAFCBCCCECE@@CAE@ACEEC@A@KACAACAAA@ABI@AAACAEAAAAA@CDCDEDCDCLK@AAEAA@CBCFG@AEI@CBABCAGAQEUBIDAVCTADC@CAOAG@CA@EDEBEBCAC@A@EAE@EDABGBEBC@EAA@CBCHCDEBA@CACCIACACBCDCBEAEAE@GDEBABEDED@@A@CG@ECIAI@EAE@EAGAE@I@EGGEGAACEEAE@CBGAG@KBM@EAAABC@ACAGBCBCA@@AA@CACAAGGECC@EDGAGACE@E@ECEEAC@CECACAAA@ECKCECGAIGOOeES@C@CAK@CCEAI@C@CCCAGACAC@EGGGIAC@IAEKGIEMASIKEE@GD_DOHIDK@AAJS@EAEFASAC@G@@ACAAEACEACBGBKDGDEDI@G@GIA@C@EDIHGDA@GAI@IB"],encodeOffsets:[[101458,37843]]}},{type:"Feature",id:"632825",properties:{name:"海西蒙古族藏族自治州直辖",cp:[95.356546,37.853328],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@GDC@C@ECGAE@WAK@EBEDIHCBGBCBCDEFGDKLGFA@CBKAI@GBQJOHA@OBECEBE@AAEAC@EBAB@DBBBB@B@BCDCFAFEFCHCDGLCDGJCDABCBOPIHEFOLKL[NQJCBCFEBIJEFKLCDEAAAG@ECE@CAICICKASECAO@U@EASGCAGAGCKCGCSGICGECACGAEACACGAaKMCCAGAE@GBKBKBSFSBUBCAAA@@@A@@BABA@ACAABEDABA@@A@CACAACAAAC@E@A@ACAAAAC@AAECEAAA@A@C@ACAA@CAGEA@AEACAA@ACACACA@A@AACAAECCAAA@AAAC@AACAA@AAAC@A@CAA@AACC@AAACAAAA@A@A@AAAKOEKACAAIIACCGGMCCCAQECBK@K@W@CAUESCKACAEAKAK@G@UCKAI@E@A@E@O@E@E@M@MDEAEAGAEAGAGBG@GBG@ODKBGBEBMBW@I@E@I@G@EBEBGBI@EB@@EDCBC@MFCDIJCFCDAFCDCDABCDABK@UDSDYJMFQLKHA@GDEDMFMJCBIFCDIJMNCBCDEBADEBCBEDEBCBEBEBMFGBIDEBEBGDEBGBODG@KBEBYBE@E@G@WAG@I@G@E@G@IAM@OCEAGAGAI@G@EAOBI@EBE@ABEDCDEFCDEBCBA@IBGBEBGBE@O@E@I@EBIBGBE@EBODG@EBIBODEBEDUFGBGBGBE@GBEBGBMFGDEBEBCBEBCDKBE@G@OFEBGDCBQ@G@G@MAGAOAG@EBE@GDKDIFIHGJAFCFADAHAFABCDCBQAG@EBI@EBEBIBKDGDMFKFMFGDCDGBC@EBGHKJMJKHSLINAFCBEBC@C@CBA@MFEDEDCH@F@H@DAFCDEHGDCBEDOPEDOJABEDEFMTMHEDEDIFCDGFGFGDEBIJGFGFCDOLCDMDEDEDGFEFCBGDC@EBCBEDADAFGDC@A@C@A@CBABABC@C@ABA@E@EDEDEFGFADCD[TKFIHEDEHC@EBABEFEDGHEDGJKJCFA@CBIDEDGHEFCDCFEDCBEDGDKHCDKDKDIBGBKBGBGBK@EBIBKDIBMDG@GBG@GBIDKBMBI@IBG@I@G@I@I@IAI@A@MBGBK@IBI@GBIBGAIA[@GBK@M@MAG@IAECIAI@IBIBODOBGBGCEAI@M@[A]@MBGBGDGHGDYDMHWLKHK@IAG@QEMEIGKEOCICOEGIKICKEG@IHEHK@EG@]@YBQ@MCEA@GBIDE@CCACCCGMCM@K@MDI@EAIDI@@@KAK@OEGEICUGMIEAI@IDK@KD_DIBOFiBIAGKIY@@AGC@GB_P·rcRaLKBc@y@@{@BAgBaBILWTGBGJOPKNKFQJ@@GFGPS\\WZ[Z]ViVWLOHOF[LE@C@CAKIGIGKEMCWBQJQ@KAACKBIHG@MGEMKKEIEEEOG]EOCSAKAGAKAKAMGOCAECQCYAMEEAGCI@IGSGGEIEIEGCIAE@G@KBKFGLEFIDGFILSFMBICCCCQ@MBODGFG@]@IAICECECE@IBCDG@GACEGKIGGCQBE@G@IBKF]HKDGFE@IAG@IBKBEDEBCBGBI@W@KBKBGBEBOHKHIDGDGBEBI@IBI@KBI@MAKAMAC@A@EDCFMJSNOJOJGDKDE@G@C@CCIKGISUMGS@oAYAQGMKCSBW@ABGFGDEPQBA@AAA@CCAAA@CEIAAA@@AAGAEAA@AAE@AAAAAAAGEACEECCECGGGKCECGISCGIIIMIIE@EICKCEKIGGAAGIEEAAAAECEIECAAAEAA@CCACAE@C@EAQ@G@C@C@AACACCGGGECCIKGGCCIICCEGCCEEMOEGCCCEGIAAACA@ECEAIAC@MCCAA@C@C@CAI@C@GAEAIAC@QAEAC@CAC@E@CAEAECKGKEICIEECGCAA@AAAEECAAAEIAAACAACAC@C@C@A@ABIDMDCBE@GBK@A@EDABCFCDABABE@A@EAKEA@C@C@CBEDCBEBCBCHCJCDEBCBC@C@CAE@GDIDCBCBABCB@@A@A@EAGAGCCAC@EAEAGAECGAICIAEAOCWGKAMAG@QBIDKBIFEBG@CAGCEEG@EAC@CA@CACCAACCGEEAAE@CACAEAG@C@@@A@ACECCEOKMIMIQICEAC@ACAC@A@ACCEEIAAA@CGGEEIGGEECACAA@AA@AAACCAAACCCGEKKCACCCEEEIECAEAICAAG@GAEAA@AACAAAGCC@@AAAC@AAAAC@C@A@A@AAC@AAC@CAA@EACAAAIAAAC@@AA@A@KAI@GAEBE@G@AAA@AAAAAC@CAACECGACCCAAA@AACCCACACAGCEAC@CAG@@@A@E@EACAICA@AABC@AAA@A@@AAAC@AAACACA@A@AAAAA@@@C@@AACC@AA@C@AA@@A@AAC@A@CBA@A@CBC@@@EAC@A@A@C@E@A@@@A@A@A@A@A@C@C@CBEBA@@@A@@@C@A@CACEAAAAAAAACAIAGCAAC@AAAA@@C@A@C@A@@@@@ABCBC@ABC@A@A@C@C@EBA@GAA@C@@@A@CBA@A@A@ABABA@ABOHCBIB@@CAKAE@A@MDA@CD@@CBA@CBA@@BABABCBABODCBA@AAA@C@IDC@A@@BCBCBAB@@A@ABC@ED@@A@C@C@IHCB@B@@ABIFA@GDGBC@K@E@MAA@I@CBIDEBEDGBC@IDMAE@I@ABA@ABEHA@ADA@ABABGDEBA@E@G@C@EBE@@@E@G@GBGBQFGDGBGBI@M@EAICGAS@GBEBODUJGBKBI@E@CBC@K@AB@BBFAFABAFBD@D@DAB@FCFAD@B@HBB@D@BAD@D@BADCBAD@D@DBD@DBBBD@FABCBC@EBC@E@CD@BAB@DADABEBABEBC@A@E@IBE@E@CBC@CBCD@FABADABA@EDCDCF@DABC@EBC@E@C@C@CBABCBA@CBC@C@EBABDDFFBBBB@BADADEDCB@FADBDBDBDBD@FCD@D@DAFABAB@D@BBD@FBD@D@BAF@D@B@DBDDDBBBDABADABG@E@CBCBEBABEBCBABCDADAB@DBDDBBDBB@DCFEFCBABADABADCBE@CBA@E@CBABCDCDA@CBE@ABCD@DBF@D@D@FBB@JABADABAD@DDBBDBBAD@BCBCDCBEDEBCBBBBDBBBDDDCFEDABCBCBADCDADADADAFADAH@F@F@FAF@DBH@D@DAF@H@BAJAFCFADCD@DADCD@BCDCBCDCDCBCDEDABCDAFCBABCB@B@D@DABABCBC@GDCBABCBCBCBC@E@CBEBE@E@EBEDEBC@CBIBCBE@C@C@CBCAC@C@C@CBA@CBCACACCCECCCCEDC@C@E@C@CACAE@CACAECCACAC@E@CBC@CBE@E@C@CDAD@B@FFDBD@DBB@FABABCDCBCBC@E@CBEBABCBCBCFADADABEFABE@CAC@CBC@EBABEDCBEDCBCBCBEBC@E@C@CAEBE@CD@D@D@FBD@FBFBDBBDD@DBB@D@BAD@BDDDBDBDBBBBFBBDD@FCBAD@DCDABCBCBCDCBADABCBA@C@GDAB@BAD@B@DAD@BEBC@C@ABEDABG@EAE@EBEBEBCBEDADCDAFAFAFCBEDCDE@EAE@E@CBADAHADCDCBCDEDCBCDCDC@C@C@C@EBCBCBCAC@CAE@CAABCDABC@CD@B@D@D@D@BE@CBCBE@A@EBCDABCBCBGDE@CAE@C@EBCBEDE@CBC@ADCBABGAE@C@EBAB@DBF@B@BCDADABCBCAA@E@A@CBEDAD@B@BADC@C@CACACCCAAACACBEBCBC@E@E@EAEACAC@C@E@GDCBCBADC@CBE@I@C@G@CAABCDCF@DAFAF@F@BEDC@E@EBEBC@G@CBCBEBCBE@C@AACACAABCBEBCBC@E@CAE@AACDAB@B@@@J@T@T@V@TAT@V@T@T@J@J@P@F@T@P@D@T@T@V@T@TAV@T@B@R@T@V@T@T@T@H@V@B@B@DADBDAD@B@D@B@F@D@B@F@F@F@F@F@F@D@F@F@F@F@F@F@F@F@F@F@F@F@D@B@F@D@F@F@F@F@F@F@F@F@D@F@F@F@F@F@F@F@F@F@D@F@F@F@FAF@@@F@F@D@@@F@F@F@@@F@F@F@F@F@F@F@D@H@D@F@@@F@F@FBF@B@D@B@DBD@B@D@B@BBD@D@B@B@B@BBD@@@D@B@B@BBB@B@B@B@D@B@DBB@B@D@B@B@BBD@B@B@B@B@DBB@B@B@B@D@@@B@BBB@B@B@D@B@DBB@B@B@B@B@B@D@BBB@B@B@B@B@B@BBB@B@B@B@B@B@B@BBB@B@@@D@B@@@DBB@@@D@B@B@B@B@@@BBB@@@B@@@B@B@B@@@@C@E@EAC@C@E@C@C@G@A@G@A@@@AAK@M@MAM@OAM@MAO@MAM@@@MAOAM@MAC@@@ICE@ICC@ICEAGAC@ICGAE@AIAI@GAI@GAIAG@IAG@IAG@IAG@IAGG@O@MBM@OBM@MBC@oBGFIHCBIFCDGDCDGD@BEBEFEBGFCBGFEDEFEBGFCBGF@@EDEDEDEFGDCDEDGDCDmbg^EDA@@@CFABEFVRHBEDO@@BAFBB@B@@AB@@@BB@@BB@@B@@B@BBB@BAB@BAB@@@B@@@B@B@BBB@B@B@BAB@@@BA@@BBBDBBDDBDDBBDBDBFDF@BBF@BDHBHBDDHKDABE@C@CBC@C@C@CBG@EBA@E@C@EB@@C@C@@@GAECCAA@@@@BA@@@@@ADABCDEDCDCDCDABADAFAF@D@D@F@B@D@D@F@F@FBD@F@B@D@B@D@D@D@J@D@DAF@D@DBF@B@BHFBDBBDDFDDBDBBDDBBDF@D@HCFAH@H@F@H@NBF@F@H@HBH@JBFAFAFAD@JA@@F@JBF@HBFBHBDBH@H@JALCJAF@H@HBF@FFBBDBFBFBF@HBH@F@J@LBF@H@H@FBFADAACEAECBE@ADAH@FBHDF@F@DABADADAD@FCRGFCF@FADBDBFBHBFBFBHAHADBH@FBDBDBDBDBF@B@F@H@J@J@HDFBDFBDBD@DBDBBFDDDFDFBF@FBFFFBFBDBFBDD@DCFABCDAB@D@BBDBB@JCDCDADBDBDDBBDBBBDF@FBF@J@FAJ@HAH@HAF@FBDBD@DDDBFBD@H@H@F@DBDBFDBBDD@D@DABADCB@D@BBDDBH@FBFBHDFDFDFBDDDBD@F@FAFAH@F@F@HBJBH@L@HBJ@DD@DADADCBABAFBFBBDDDFFDDFFDDBBDFDDBDDFBHBF@HBD@DDAFABADABCDCDCDCDCDEDCDCDCDADCDAB@BDDFBD@D@HBF@FBD@FBFBDBDBF@H@F@D@DADAFADADAF@BAH@D@F@F@H@F@F@HAFAHCFAHCHCFAFAF@F@F@FBD@HBD@FBHBH@FAH@F@FDFBD@FBF@FBF@FBH@H@HBF@FABCCEAACC@CDCFBFBDBFDFDFBFBFBFDFDDBJ@FBF@HBFBF@HBFBD@HBF@HBFBHBHBH@H@F@JBF@FBFBDBFDNDBBF@F@D@FBH@F@FBF@FBDBFBFBHBDBHBD@FBH@F@FAF@DAF@F@DAF@F@HBF@D@FBD@H@F@FBD@FDDBDBFBDBD@D@FBD@FBH@F@F@F@FBF@D@FBF@FBF@DBH@F@D@F@DAHAH@F@F@F@D@DBDBDBFFBBDDDBFDB@FBF@FBF@FBHBD@FBF@DBD@FBFBF@DBF@FBF@DBFBF@DBFBDBDBDBFBDBHDDBDDDBDBFBDCDAD@D@FBDDBDBBFBBBFBDBF@D@F@D@DCFAF@BAFAFAHABBDDDBD@DCFAHAF@D@D@F@FBDBFBDBDDBBDBDDBBBDBDFBD@F@D@BDBDDBDBF@DBFBJ@D@D@HADBFBDDBDDDFDDBDBDBDBHDBBDDDBFBD@D@BBDBFDBBF@F@FAF@F@D@DBBDBDBDBDBBFBJBDBDBD@FBDBDDDBDBHBDBF@F@FBF@HBH@L@F@D@F@FAF@F@DBFDF@BADCBBFBD@DBDBJBD@DBDBB@FBDBDBFBBBD@D@B@D@FAF@FBB@F@B@DBB@DBB@DBDBD@DBD@D@D@D@DBD@BBBBFBF@BBD@DBF@B@D@D@FBD@DABBD@B@FBBBD@DBFDD@FBB@DBFBBDDDBDBBDBDBF@DAD@DAD@FBD@F@B@F@F@H@D@D@DAD@BADB@B@DCDBBDBBBDBDBBBDBDBF@BBD@D@LBDBDAFBDAD@D@DAD@DAD@D@D@B@F@B@F@FBDBDBF@DBD@D@B@DAD@FAD@DADAD@FADADAB@DAH@D@FBDBBBBBD@DDFBFBDBDBBBFBFBD@D@DBF@DBF@FAFAD@D@D@FBD@FBD@J@D@FBB@D@FBDBDBF@D@FBD@DBBD@DAD@D@B@F@DBDBBFBFBD@F@FBD@DBDBDBD@D@FBDBF@DBB@D@FBBBBDBDFBB@FBB@FBBBDBD@FDD@DBDBBBF@F@D@D@DDBDBDDBBBD@DBF@FBF@D@FBBBDDBBFBD@FAB@D@D@D@DBDBBBDBB@D@F@F@B@DAD@D@BDDBFFDBDBDBD@F@FAD@D@H@B@FBDDDBFBF@D@BBDAD@DBD@J@D@B@F@D@DADBDBDDDBDDDBFBFB@@B@B@B@DAF@B@DAD@BBB@BBB@F@DCDAF@BABBD@BBB@D@B@DADABAB@@@BBBBDBBBDBH@D@D@DADAFAD@DBDFBBBBDF@DDBDDDDDBB@DBBDBDDB@@DBD@H@D@DAB@DBBBDBBBBBHBDBFBBBFBD@BAD@B@B@BBDBDBDBD@DBDBF@D@B@@@@@B@BBBD@@FBJBJ@J@J@HALBJ@H@N@LBH@LAH@D@H@HCB@BAD@B@HBD@D@D@F@DAD@DBDADBF@DAFBHADBF@F@D@D@BFFBFBHBFDDDDBFBFBF@DABAFADFADADCBCDADAH@DADCDCD@B@DFDD@B@FBF@FD@D@DFBF@FAF@FADADBFAHADBBBFAFCF@DB@FBD@D@FBDBDADABAD@FBDDDBDHFDDFDBDFDDDDB@D@BBFDFDBBDDDDDDDDBHADADBFBD@H@FBDBD@FCDABBD@DBF@FAD@DAD@DBDBABAFBDDBDBH@FAD@F@D@D@F@FCDABABADD@DDBDAF@F@F@FBBBBBFBFAFCFAD@F@H@FBFBFBH@F@HDFBFBF@FBBBDDFCDADEBADABAFAJBFDDDDDFBFBFADADAD@F@FBDBD@HBFDDBDDBDLBF@FADADAFCF@DBDBBDDBF@F@FBBBDBHBF@F@D@HB@D@BHFF@F@D@FBHDF@D@H@HADAF@D@HBFABAFCDAF@LBHDDDDD@DDBF@FADAFAFAD@DBDBDCBADAFBD@F@D@BAFDBF@BBDDDHDFDDDDDFDDDDBFBJ@J@F@HBHDHBHBF@H@F@F@DBDDH@JCH@HAHADCDEBE@EDCDGHCDCDAFADBFFJDFDDBD@F@F@B@F@LAR@PALAJCJAHDJDFDJAL@FDDDFF@FDBFBPHLDHBDDDHDDFBJBL@H@LEJGJGNO@A@@@@AA@@AA@@A@@@@AA@@@@A@@@@@A@@@@AA@A@@@A@@@AA@@@@A@@AA@@@A@A@@@@@A@@@A@@@A@@@@@A@@@@@A@@@AA@@@@@AA@@@@@@A@@A@@@@@A@@@A@@@@@A@@@@@A@@BA@@@@@A@@@@@A@@@A@@@A@@@A@@@@BAA@@@@A@@@A@@A@@AA@@@AA@@A@@AA@@@A@@AA@@@@@@A@@@@@A@@@A@@@@@@BA@@@@@@@A@@@@BA@@@@@A@@@@@@BA@@B@@AB@@@@AB@@@@@@A@@@A@@@AA@@A@@@@@AB@@@@@BA@@B@@AB@@@B@@@BA@@B@@@BA@@@@B@@AB@@@B@@@@@B@B@@@B@B@@@BA@@B@@@B@@@@@BA@@B@BA@@@@BA@@@@@@@A@@@@@@BA@@@@@@B@@AB@B@B@@AB@@@B@@AB@@@@AB@B@@AB@@@BA@@B@@A@@@@BA@@@@@@@A@@@@@A@@@@@A@@@@BA@@@@@A@@B@@A@@@@@AB@@@@@@AB@@@@AB@@@@A@@B@@A@@BA@@@@BA@@@@@A@@@@B@@A@@@@@A@@@A@@@@@A@A@@@A@@@@@@@A@@B@@A@@B@@@B@B@@AB@@@B@@@@@B@@AB@@@B@@@@AB@@@@@B@@@@A@@B@@A@@@@@@BA@@@@@A@@B@@@@@@A@@B@@@@@@A@@B@@@@A@@@@B@@AB@@@@@@A@@@@@A@@@@@A@@@A@@@@@@@@@A@@@@@@@A@@@@@@@@BAB@@@@A@@@@@@BA@@@@@@@@@@@A@@B@@@@@@AB@@@@@@@@@@@A]AWCQCcEuEgMoVIbGJ@T@JAJBL@BBJC\\MHA\\URCR@@@@@H@N@^J`JJB@@@@@@@@@@@@@@B@@@@@`DF~FH@HBF@H@ZBLB²F@jBlBBBÖDF@@@TMNIHEFA~_eNGJEV@|@T@@@M]A×GmAE@A@GACA@AAACEAECCAA@C@ABAB@BAD@@ABABCDEBC@CBC@C@ABCDE@C@C@CDGDCBAD@FADAJE@@\\]~Y^K@ARCLCBADAHAFADAP@FCFBB@FBFBFAJ@PAB@HADAF@BABAD@N@LCFAH@DBF@D@D@D@B@DBRFDBJFDDB@zHB@dDÆNJBzFŒ^|HJCD@F@F@B@FANBF@LADAL@L@JAD@NDF@D@F@HDDBD@JBHBJBFMFMHOr¯p«ët·jDIHGLKHGDG@G@EAE@QA}@EBIBCBEDCNG\\I@@@@@BBB@@@BB@B@D@B@BAB@B@@@@@B@@D@@BDB@D@BBBDD@@BBB@@BBD@HBD@@@B@B@@B@H@@@BB@BB@@D@F@B@B@B@BB@@BB@BB@DBBB@@B@DAB@HCF@B@B@B@@@B@BBFDBBB@D@L@B@B@@BB@D@FB@@DBDD@BB@B@F@HBB@BBB@DBF@B@JDB@B@B@DAD@B@HBBBF@H@B@BAF@DAD@JBBAB@B@F@B@DBDBH@H@J@B@B@DD@@D@B@D@D@D@B@@@BC@A@@BA@@B@@@BBD@B@B@D@B@D@D@D@HAD@F@BBD@H@B@FBD@D@@@B@BA@AB@J@D@@@DDB@@@F@@@FBB@F@D@FAB@D@BAB@D@BBB@HC@@B@B@BBFDBBB@H@D@B@@AB@B@@@B@@@@BBDBB@@B@B@B@D@DAD@D@F@HBH@H@DBB@BBB@B@B@@@B@@B@BBDD@D@F@D@DBBBDB@BBBDDBD@FAD@@BB@@FDJBH@HBDDDBBBD@J@JADBD@DBB@D@B@DAJ@@@BB@FBB@@BBD@D@B@FBF@B@@@D@D@HBFBB@B@D@F@B@FBB@D@D@D@B@BAB@BADABADAFA@@B@FAD@JDB@B@B@BAB@BAH@B@F@F@DBBB@@BBB@@@DADABA@A@ABABA@AB@DA@@BCBAFCB@DAB@@@DBDBDBDD@BBBB@@@NGF@@ADCB@B@BA@AB@@ABANEBAB@DAB@@@BBBBFBB@J@B@DBB@FDDBFDJDD@JDD@FAB@D@DBB@DDB@@@B@BCBCBAHGBADABA@A@A@A@A@@BADA@ABCB@@C@@@AB@B@BC@@BBB@B@DAB@DAB@BC@@LBHANADABA@A@@@A@A@@DCDAB@BC@@B@B@@@BA@@B@FDB@@@B@@@BCBADCBADA@ABAAA@C@CA@@@@@CA@@AA@@@ADGFE@CBEDGBE@A@ADADCDABA@@BA@C@A@C@@DCFA@AF@B@D@D@DBBB@@HBF@B@B@B@BABABABABA@A@C@@@@B@B@DAD@@AB@@ABAB@DAH@BADA@@@AB@DCBABADG@A@@@E@@BABADAJED@J@D@@@BBB@DD@@BB@@@DBD@BB@BBB@BBB@F@DBFBDBLDF@BAB@B@BABB@@BDBDBBDD@@FB@@BBBAB@DADAFADABC@CDCBCB@F@D@FBD@@BFBDBBBF@D@D@F@B@B@DABADABAB@B@B@B@@@DC@ABAB@@AB@@@F@F@BAFCB@B@B@@BDBBBB@DBB@DB@@BA@ABA@@BAB@DADAB@D@DBB@BB@@BF@@BDBBF@@BDDB@B@BB@@BADCBAB@@AD@D@@@D@B@DDFDB@@@@@BAFE@A@A@AAA@A@C@A@ABAFABA@ADCB@BAB@@@B@DBBBDBBBD@@BFDD@D@BBB@DBDB@BBB@BBB@B@@DBDBBDFDDD@BB@B@B@FBB@@AHAFA@@@@DCB@BA@ABC@A@C@G@E@A@@B@B@D@B@B@DADAB@BB@@BBBB@BBBBBHFDBDBDB@B@@@BFFBD@@BBD@B@FBF@F@B@BBBB@BDD@@BBBDB@BBF@D@DBD@DBB@DD@@@B@BAB@BB@BBBB@@@@@B@BB@B@DBBBBBDDDDB@DBBBB@F@B@FAJCD@B@D@B@B@BADAFBF@@BBBBB@BBB@@FBHBDBBBDDDBBBBBBD@@DDB@@BBB@@@B@FBBBB@@LBDB@@FAB@JCD@B@B@DBB@@BFFDHBBDF@@BBBB@B@@@B@@ABAB@D@B@@@DB@DFB@B@F@D@@@DB@@DD@DB@BDB@D@BAD@F@D@DB@@DD@BB@B@@ABCDC@@BAB@F@B@DA@@@@BAA@@C@@@ABABAB@DADAB@@ABAAA@ABEBALEBABAFAB@F@H@HEDA@AB@@@D@J@FAFABC@C@@D@B@FCFADCDAB@F@H@J@D@D@@AB@FCB@DAH@BAB@DA@AB@@@BBBB@@BBB@F@DBBBD@BBD@D@B@BAB@DEBAFEBABABABC@@BAAC@A@@BCBA@ABA@ABE@C@ABE@CBCDGBCBC@CBEBG@@BC@CB@AA@A@AAA@ABC@ADCBCDCBABAB@@@BAB@D@B@BBFBB@HBDBD@B@D@BBD@FBDBB@JBD@D@@@DADA@ABADA@ADABABABCB@NOPIRO@AB@B@@A@AAA@@CE@A@A@AFC@@[s@_uScOoeM[SUUMeAe@[IHeGaBO[wUOHRRgJ]`WMQQgIl[D]HWHac[mSOKNWMY~@z]²WYLQpMdMhJSlBxW´JcAKgMxKJolMpOxOnIdN^@RYBSGYYEcCOUK[ZYHe]]³uą"],encodeOffsets:[[98423,38089]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("黄南藏族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"632322",properties:{name:"尖扎县",cp:[102.04014,35.943156],childNum:1},geometry:{type:"Polygon",coordinates:["@@KCC@C@E@@@AAA@CCCACAGCC@GAA@C@AAA@AA@AA@CAA@A@@A@AA@@A@A@A@A@AB@DC@@@A@@E@AAC@@@AA@AA@@@A@@AAAA@@@A@A@A@@@A@@A@@A@@@A@@@A@AA@@@@@A@AB@@AB@@@B@@AD@BA@@@@B@BAB@BB@@@@@AB@BB@@@AB@DB@@B@@AA@@A@@@AB@@@D@BBB@@@BA@@@@@AC@A@G@C@CB@AGAA@@AA@@AA@A@@AAAAA@@EAA@A@@@C@A@A@AA@@@@@A@@@@@@BBBB@@@@B@@@BA@@@@@A@@AAAA@@@@@@BAB@@@DC@A@@@AAA@@AA@A@@@AB@@@BA@@B@@A@@A@@AAAABA@@@@@A@CA@@@AA@@@ACA@AA@@AA@@A@AAAAAAA@A@A@@A@@A@@AAA@@AA@A@@AAA@AAA@A@@@AAA@@@@AA@AA@A@@A@AA@@A@C@@@@BA@@@A@@@GCCA@AA@AA@@A@@AAA@@AAA@CA@AA@@A@@CACA@@A@CA@@A@A@@@CBA@A@AAABA@@AABAA@@A@A@@@A@A@A@@@A@@@A@AA@@A@@@AA@@@@CAAB@@A@A@A@A@A@@AB@@@@@AACAA@@AA@AAA@@BAA@@@A@@@A@@A@@@A@@@@@@@@BA@@AAA@@A@@@@@@A@@AA@@@A@A@@BA@@@@@@@@@A@@@AA@EB@@@@@@AB@@CBAB@@@BA@AB@B@@@@@BA@@BAAA@ABC@AB@@A@@BA@AB@@@BC@@B@@AB@@AB@@@@A@@BA@AB@@@@@@AB@B@@A@@@@@@BAB@@B@@B@@@@AB@BA@@@A@EDA@ABEA@@A@@BA@AAABA@K@A@@@CD@@A@@A@@AC@A@A@A@A@A@@B@@@@A@@@@@A@@@@BA@@@@AA@@@A@@@@@@B@@@@@@A@A@A@A@@@A@@AABA@@AABA@@A@@A@@BA@@@@@@@A@A@@@@@@@A@@@@@AA@@@@A@ABA@@@@BA@@@A@@A@@@AAABC@@@C@@@A@A@AB@@A@A@@@@BA@@@AA@@ABAB@@ABA@@@A@A@A@@@@BB@@B@@A@@@E@@@C@A@A@@A@@A@A@@BA@EB@@@BA@@@A@A@@BAB@@@@@B@@A@@@A@@@@BA@@@A@@@BB@@@BA@@@A@@@@BA@@@@@@BA@AB@@@@@B@@@@@@A@A@@@@BA@A@A@@@@B@@@@B@A@@B@@A@AA@@@@A@@BA@@@@@@@@B@@@@@@A@A@@A@@ABC@@@A@@@A@@@A@A@@@@@A@@@@@AA@@CB@A@@A@@@A@@@AB@@@@A@@A@@@@@@A@A@A@@D@B@BBBBD@@DD@@AB@B@B@@@B@@BBBB@@@@AB@B@B@@@B@@@BDBBB@@BD@@BD@BBB@@@B@DBD@B@BB@B@@BCBA@C@CB@@AB@@C@CBC@A@A@A@CB@BA@CACA@@A@@@@@ABAB@@@D@@@@CBABA@@@CD@@AB@@A@@AA@A@@@E@A@AA@@@@AB@@E@AB@@A@@@ABC@@@@BAB@@AB@@A@AAA@@@AB@BA@C@EA@ACCAA@A@@ACB@AA@AACAA@A@CAC@@A@UF@A@BA@A@CBA@ABGBABAFAD@BBDBD@BBB@@AB@B@B@B@BBDDBBB@B@@@B@B@BAB@@ABC@CB@BAAC@A@CAA@A@@@KHGDCBA@IBC@MAG@CACACBEDED@@A@a@C@G@A@A@EBCBA@@B@B@B@@AB@@AF@@A@@@CBA@CD@BA@A@@@@D@@@@A@AB@@@B@B@@A@@BA@@@C@AB@@AB@@B@@B@@@DAB@B@B@B@@A@A@A@@B@@BB@@BBB@B@B@BA@BDA@BBB@@DDB@@@HB@@@@@@@BCFEHADCJAF@H@DDFDDDBBF@@BB@DBBBBDD@B@@@D@B@@D@BB@BB@A@BB@@@BBB@@@D@@@BBDBB@BDB@@@DB@B@@@BBFDDDBDB@FHHHBBBB@B@DBBBBB@BDBDBDHFBD@@BDBBBB@B@@BBDDBB@@BBBAB@D@@AB@B@DAB@B@B@B@B@@D@BBBB@DB@B@B@@@DA@ABCB@@AB@DA@@BA@I@A@@BAB@@ABC@CBCB@@A@A@EDA@C@A@A@@@AD@@@@ABAB@@CB@BA@A@EBCA@BA@CDA@CDC@@BAB@BA@A@A@AB@@ABABCBAB@@@B@@@@FF@BBD@@A@@DB@BD@@@D@D@B@D@@@@ABA@@BBB@BCF@BBB@BCDAD@B@B@@BBB@@@@B@BABABCDABA@@BBD@B@B@@ABA@@@AD@@CD@BCBADADA@@@C@A@@BABABABA@C@@BA@AD@@@@@@@D@B@@@B@BA@CBMDA@AB@BBBD@HAJ@FBBDCFABGDCBABAFBB@FBFBHAD@@GFCF@D@FBH@BDJDFDFFDBDADEFADCHCFADEDAF@DDHHHBDEHEHEHCF@F@JAHBHBD@FDHBFBF@D@B@B@JDF@FDH@BBBF@TDHDBBFBBBD@PBHADAPDJADBP@J@B@D@FCD@B@BDDDDDD@DA@CAC@A@CBODCDCD@F@HBDDBH@H@J@DDDFBDBDADCBIAEECCC@C@@BCBADAD@HHLFDBHCLAHIDG@ABYJSRGJ@H@RB@@D@X@LAHFLHH@BAPKLGFARATCDAAAEKDIRIVEUU@C@CP@PAB@LEHCB@NEDCFAJ@L@PBDCGUIGEE@@PSDCDM\\DF@B@DABCDQBGDEDIREJC@@@@JG@@@@D@JABARA@ABAB@Z[@ADY@AFEHIB@BAB@N@JANED@\\CJABC@CEC[GQCI@CCA@@ACAGEA@CACA@ABCBEFGBCDAB@BABADG@AB@DBBBD@B@@A@@JFD@D@BAD@DAD@D@PJFFFFBBB@BAJIHCNKDG@AHOBOAKAC@MCGGCCAG@[BOACCAABE@E@CAA@@BMJK^IBG@@@@BA]OEMAA@ABCBCDCBA@A@@BAJMTQVKRQDC@@"],encodeOffsets:[[104576,36632]]}},{type:"Feature",id:"632321",properties:{name:"同仁县",cp:[102.018323,35.516063],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAA@A@AAC@A@AA@CACAA@@AAIAGACBA@C@A@A@@AAACAAAC@C@A@A@C@A@E@CAA@AAAAAAAAAAAACAAAACAAACCA@CACAA@CAA@A@A@ECCAA@CBA@A@A@CAA@AAAACAA@AAEB@@CBA@A@C@C@CDCBA@@BABC@C@C@CBABABA@ABA@A@A@C@AA@A@CAA@CA@CCAA@C@A@CBA@A@A@C@A@@@CCAAAEBEBA@A@EACAEAEAC@C@C@A@E@A@C@A@C@A@C@AAAAAECCCCEAECAAAA@A@C@C@@ACAA@@E@CBCBA@A@A@A@A@AAAAAACAAAA@CDADABABA@@B@@@@ABC@@@IDC@CBIFEBABADAB@BAB@BABC@AB@B@B@BDB@BBB@B@BADADAB@@BBBBBB@B@FAD@BADABC@ABABABADAFAF@@@@CACAC@C@C@ABA@EFA@ABE@A@A@ABABABA@@@A@AAA@ABC@ABCBABA@CBEAABA@A@@B@BAD@FAD@B@B@@@DBB@@@DFH@B@BCDADAD@DAB@BC@CB@@ABAB@BBH@DAD@DAB@BBB@D@BAJCFAF@B@@@BC@E@A@AB@@ABIBADABA@CBCAA@@B@@BB@D@F@BDF@@AB@@@H@BDD@@@@A@A@A@A@CAA@CA@@A@@A@@@B@@@B@DB@B@BBB@BB@@@B@@@B@@@B@@BBD@JDB@B@F@@B@@@BB@B@@@B@DDDBD@DBB@@@B@FB@@D@@@BBBBBB@B@@@@ABAD@B@B@@@B@@@@@B@@@B@B@@@B@@@@@@@DA@@B@@@@A@@@@B@@@B@@A@@@AB@B@@AB@@@@@B@@@@A@@BA@@BA@@@A@@@@@A@A@@B@@@@A@@B@@A@B@AB@@B@@B@@A@@BB@@@@@@B@@@B@@@B@@@@B@@@@@@BA@@@@@A@@@AB@@@@AB@@@@@@A@@@@B@@@@ADA@@@@BA@A@AB@@A@ABA@@B@@A@@@A@A@@B@BAFAB@BCF@DABABAB@@@B@D@BAB@B@@@B@B@B@BABA@ABA@C@@@CB@@A@@@AACACA@@@@CNAD@@C@AB@@ABCD@@A@@@@B@@@BBB@@@BA@@DA@@B@B@@ABABADADCDADAB@D@@@BB@BDBBBDBBDH@@BB@B@B@B@B@@@B@@A@@BBB@DAB@BABAB@BABA@@BA@EDABABABCDCBA@C@C@@@C@@@E@EDA@@@AF@BA@ABA@CB@@CBCDA@GFCB@BABCDCJ@D@FADADA@A@@A@@C@@@A@@A@A@@BA@@@@B@@@@@@@@AA@CC@AA@@@A@@BA@@@@@AA@@@A@@BABAA@@@AA@A@@@A@@B@@A@@@AA@A@CC@AAA@C@ABAAC@E@A@ACCACCCEE@@AA@@@AA@A@@@A@@@@BABAHABAB@BA@AB@@ABA@@@@@@@@@@A@AB@A@@AAAA@A@C@@@AAAA@A@A@@AA@@AA@@AAA@A@AA@@CA@@CA@@A@@B@@A@@@A@@@CA@@A@A@AAA@@@@@AC@@AA@A@@@A@AAA@A@AA@@CAEEG@AAA@AAC@C@A@AAA@A@A@@@@AA@A@C@@@@AA@@@A@@@AB@@C@@A@@EAA@@BA@AA@@A@A@ABABA@A@@@A@@AACACC@@A@@AA@@@A@@@@@A@@@A@@B@@A@@@@@A@@@AB@@A@AA@@A@@@AA@@AA@@@@A@@A@@@A@AA@@AA@A@@@CAA@ABA@A@A@@@CDAD@@A@A@@BA@E@A@C@G@E@@@A@EAA@A@A@C@A@IFAB@@C@ABAB@@EBE@EB@@A@A@@@@A@AAA@AEA@@@@@A@@@ABA@@@@@AA@CCC@@@AAAA@AA@A@@CA@ACA@A@@A@@AA@@AA@@C@AA@BAAA@@@@@@@A@@@A@@@AAA@@@AB@@CAA@A@A@@@@@AA@B@@A@@@@A@@@@@@A@@@@A@@A@@A@@@@@@@A@A@@AA@@@@@@AAAAA@@@@@B@@@@A@@@@@AA@@@@BA@@B@@@@@B@@@BC@@B@@@@@@@@A@@B@@@@@@@@A@AB@@@B@@@@@@@@@B@@AB@@@@@@@B@@@@@B@@@@A@@B@@@@@@@B@@@@A@BD@@@@A@@@@B@@@@@@@@@@AB@B@BA@@B@@@BAB@@@@A@@BAB@BAB@@@@A@B@@B@@@@A@@@@B@@@@@@A@@B@B@BA@@@@@@@@@A@@B@@@@@@@B@AAB@@@@@B@@@@A@@@@@@@@B@@@@@@AB@@AB@BA@@@@B@@@@AD@B@@@BA@@@@@@BA@@@B@@@A@@BA@@@@@@@@@@@@BA@@@@@AB@@@@@@@@@@@@@@@B@@C@A@AB@@A@@B@@AB@@AB@@A@@BA@BBA@@@AFAB@@BBBBBBBB@@@BBH@BBB@BB@D@B@D@B@BAD@B@D@BADC@@D@B@BAHAB@@@D@D@DDHBBBBBB@BBD@FBB@@B@@@BAB@@@DABAD@@@@@@FBBBD@FBHBB@BAB@BCD@BA@@D@B@DBD@@@F@@BD@B@@BBBBB@BBBBBB@BBDBB@@DBBBF@@AB@@@BABCD@@@D@@BB@@@B@BABADB@@BBB@BCFAB@BA@BD@D@B@DAB@BABABA@AB@D@B@D@BAB@D@B@@B@B@B@@@@BD@@@DBDB@@BA@@DBDB@@B@B@BBB@B@@@BBB@B@B@BBB@BBB@@@B@@B@@BBBB@@@@B@@@B@@@@@AB@B@@@BB@B@@BDAB@B@B@@AB@@@@BB@B@D@B@@@BA@@@AB@@A@@@@B@BAB@BA@AB@B@@@@@B@BBBABB@@B@@BBB@@BBB@@@B@@@@B@@@BA@@BA@@B@B@D@B@B@@@@@B@@@B@@@@BB@@A@@B@@@@@B@@@@@B@B@@B@@@@DDFBBBD@BBBAD@F@DBB@BD@BBB@@@BB@B@@@B@BCDAD@B@F@BAB@B@B@D@DBB@DFFBB@B@BAB@B@D@B@B@F@BAD@BAB@BA@A@C@@BABAB@B@B@BBB@BBBABABA@GBA@AB@B@@@B@BBB@BA@CBCBEBCAA@A@AB@B@BADAB@BBB@@A@@@CBCBABABA@@@C@AAA@IAA@@BA@ABAB@BABABA@A@EAA@A@@BEBA@EBK@GBC@CBCBA@CBCBA@A@E@CBABCDA@E@E@ABABCB@@A@CCCAA@@ABABCBA@A@AAAACAC@A@ABA@A@ABAAA@@A@AACACA@@CBCBC@AAA@AAEAA@GAA@A@A@@@@BB@@B@@A@@@A@@@AB@@@BAB@BB@@B@@@B@@@BBBB@@B@@B@@BAB@@A@@B@@@B@@@B@B@BA@@BAB@@ABA@@@ABA@@B@@@BA@@@@B@@ABAB@@@BA@ABABA@A@@BA@@@@BA@@BABC@@BABA@ABA@@@ABAD@@A@AB@@@@@B@BAB@@A@A@@@@@@B@@AB@@A@A@C@EBCAEBC@GAA@ABC@A@A@CAC@CAA@A@@@CCAACAA@AAA@CAE@CAC@C@A@ABCBCBA@@B@@@BDDB@D@D@BBHDBBDDB@FBPJBBHD@DDB@BA@CBC@CBCBCBAB@BDDBFDDFFBBFFFTBF@B@BBBBFDF@D@FCF@BBABAFAD@F@DBBDDDB@@@@C@@J@@@@B@DAF@BABAB@BADAD@HAF@B@@@@DBHBBBFDDDBDBFHDFHDD@BADBDDDDDBBD@F@@B@BBDBBDFBDBBFBDBFDHBFBBFD@BBHDB@BDDBDBFFDLDLBLBDA@@BB@@BDBB@BBBB@@BB@@AB@B@D@B@F@DBBBBDH@B@D@BBDABBDAB@@@BA@@B@B@BVEB@@@BD@D@BBBBD@BBBA@BD@@@BBBDD@BFBD@B@@ABA@@B@BBB@@@BA@@BA@A@@D@BA@@B@@@BAF@@@BA@@@@BBB@F@@@B@B@@BB@@@BA@@DC@@B@BADA@@@@@C@@BABA@@@@B@@@DBDBB@@ADAB@B@B@D@DAD@@@BA@@DAD@B@DA@AA@A@@A@AAC@C@A@@AA@AAC@@AC@@AACA@A@@@A@@@A@ABA@@@@AAAA@@@A@@@A@ABA@@CC@@ACAA@A@A@CB@B@B@@@@@@@@BB@@@@@BA@@B@@@B@@@@BDA@@BB@@@@B@@@@@B@B@@@B@@@B@@@D@BA@@@BB@B@@@@@@@@A@@@@@@B@@AB@@@@@BBB@@@@AB@A@@@@@@A@@B@B@B@@A@@B@B@@@@@@@@A@@@@BAB@@A@@@@B@@A@@B@@@B@@A@@AA@@B@@@B@@A@@B@@@B@@@@A@@@@BA@AB@B@@@B@@A@@FAB@@AB@B@@@@BB@B@D@@@F@@@B@@@@AA@@A@@B@B@B@@@B@BA@@BABA@@BB@@B@@A@@B@B@@@BAB@B@@@D@@@D@BABB@@B@@@@B@@AB@@@@AB@B@B@@B@@B@@@@@B@@@@@@@B@B@@@@@@AB@@@BB@@@ABBB@@ABBB@@@B@@@B@B@B@B@@@@A@@@@@@@@B@@BB@@@@AB@@@@@B@@@@@B@@A@@@@B@B@B@B@BBD@@@BB@@@DC@@B@L@B@BABBB@@AB@@@FBBAB@FCB@@@B@@ABA@@@@@AA@@@BA@A@@@@B@@@@ABA@@@@@@BAB@@AB@@@@@BA@@BA@@@AD@@A@@BAB@@AB@@@BAD@BAB@BB@AB@@A@@@@@ABAB@@A@@BADA@@BA@@@@@@FAB@@B@@@B@@@@@@@@AB@@@B@B@@BB@@@B@@@@B@@@BB@BB@@A@@@@@@B@@@B@@@@B@@@B@@BB@AB@BBB@@BB@DBBB@@@@A@@BB@B@B@B@B@@@BADB@@@@BB@@B@@@BBB@@@B@@@B@B@B@@@B@B@@@BBBA@BB@BABBB@B@DA@@B@B@@@DBB@@@DBDB@@@BB@@BDBB@BB@@BB@BB@@@BBB@@BDBHD@@B@@@B@@A@@D@B@@@BBB@@@@BBBB@@B@@B@BB@@B@B@BBB@BB@@@BBB@@BB@BB@@@@BB@B@B@BBBBBBB@@@BB@@BBB@BD@@B@@B@@DBB@@@@@B@BABB@BB@@@@BA@@@AB@@A@@B@@@BBB@@BB@B@@@BCD@@A@AB@@@@@@BBBB@@@B@@@@AB@@A@@@@@AAAA@@@@@@@B@@@@BBB@B@D@@@B@B@FB@@BBBB@BB@B@@BB@@BB@HB@BDAD@H@B@D@@B@@@@AB@@A@AAC@@@A@@B@@@BB@@BA@@@CAA@@B@@AAA@@B@@@@AAA@ABA@@@@@ABC@@BA@@@A@@BA@@B@B@@@@BBB@@@B@@@B@@@@BB@@@B@B@B@@@B@BB@BB@@@B@@BBB@@D@BBF@@@@B@@CDA@@B@B@B@B@BB@@B@BB@B@DBB@@BBBB@BBD@B@HBD@HDDBDBDDB@BB@@F@D@D@LD@@JIFONKFG@EGCWCECCEDEB@TGdAR@NAFA@CAAEAIECCMGAGDILKBKDCDAN@PFB@JHNLLHLBNAFABCFGBEBADEBGBIBEBIAEAIAGAIDEBEDEFEHEJEFCHCHIHAFEDCHCJEFADAH@L@HBJ@J@H@L@HAFAJCJCH@FAPELCJCHC@EFQBIBCLILI\\YPKXKLCDE@A@ACIBIDAJDHDJBDADEBCCGGY@C@CAE@E@IDEBE@EBCAEAC[QGECGDIPMPCDCFCRIHADAJEJ@BB@E@GEE@CA@CCAAA@@AA@@@@@A@AAAA@@A@A@@A@@@@@AA@A@@AA@@@A@@@B@@AA@@AA@A@A@@@AA@@AAA@@A@@@@A@@AA@@@@@A@@@@A@@@@@@@AAA@@AAA@AA@@A@@@AAA@A@@@@BAA@BA@@@@@A@A@A@A@@@@@A@@@A@@@AA@CBI@@CAC@GBGDGDGDUFQDQFGBCDODIBM@KAG@EBIHABA@@@A@C@CACACAAAAA@AAAAAAACBCAA@CAA@CC@A@C@CBC@C@C@AAAAAAAC@AC@@@A@A@ABA@A@CAAAACA@ACC@C@EAEBCBABCBABC@A@CAC@C@A@A@ABABABAB@FAF@FAFAD@B@BA@@BABA@C@C@E@A@ABADABA@@HCDCB@@A@EAGG[AOAA@@C@IDEBC@ABC@CAE@C@AACAA@AACAACAA@CA@@A@@@A@ABA@@DAB@DABADABABA@A@A@ABAB@DCFAHABA@@DAFCDCFAB@D@D@FA@@D@B@DCDAD@DBJHB@B@D@FEHCDCFCPKRGDABA@A@AACAKBEBC@EACEEAC@EDEFCF@FABABC@EBC@MJIHG@ADADABC@ABABADA@AB@DA@@BA@CBC@EBCBC@C@C@A@ACACACAAAACAAAAAACAGCCCC@CAEAA@CAA@A@A@A@ABABA@ABC@AAEAA@CACBCBABCBC@CAAAA@AACAAAAC@CAE@G@C@EAA@C@AEECACAECCCE@AAA@AAA@A@C@C@ABC@AA@@AAAA@CBCBC@A@A@AACAA"],encodeOffsets:[[104766,35960]]}},{type:"Feature",id:"632324",properties:{name:"河南蒙古族自治县",cp:[101.617503,34.734568],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@A@@@@@@@@@@@A@@@@@A@@@A@@@@@@@A@@@AA@@@@@@@@@@A@@@@AA@@@@@A@A@A@@@AA@@AA@@A@@@@@@@@@@@A@@@@@@AA@A@@@A@AAA@A@@@A@@@@@A@@CA@AA@@A@@@@A@@@@A@@@@@AA@@AA@@A@@@AA@@@@@A@@A@@AA@@@@@A@@@A@@A@@AA@C@C@@@@@AA@@AA@@AA@@AA@AA@@AA@A@GFABAD@FBD@DBBAD@DCBABAACACEACBABI@A@AAACAA@A@C@CBABC@ABAD@B@DBFBFBDBH@DAF@BEFEFGJCBA@C@C@AAAAAC@EAAAAAAGAGBAAA@A@C@GBA@CBA@EDIDOJILAFAFCDADCF@@BBBBHDDDBF@BABCBCHAFCDEBA@EBEDE@A@CBAB@D@F@DADAFEBCBC@AAG@EBI@I@A@ABABEBEDC@GFCB@DA@AD@D@DA@@@CBABCACACCCAEA@@IAC@E@ABCD@D@DBFAB@BABCBGACBA@AB@BAD@BABCBC@I@EAGCAACAAAA@@AA@ACECCCA@C@C@ABCBA@A@CAAAACCAEAGBEBCDAD@FFHBF@BADADEDGBGBIBMBG@ABM@QBGDC@@@ADCBC@I@G@G@G@E@ABABADABABEBABA@GBK@G@EBE@CBCDCDCDCB@@CBEBC@A@ABC@C@@@A@ABC@A@A@@ACAA@A@C@C@EBCBGAA@CAEAGAEAA@EBAB@@ABBB@BDB@@DAD@B@@BBB@B@BADCBC@C@A@A@@B@B@B@BFBDF@B@BABABABC@A@CAECC@A@C@EBCBC@E@GAABA@A@ADABAD@BA@@@A@C@A@KAABA@ADC@CBEBCBA@CDCDCD@B@B@BB@BBBBB@@BAB@BCBEBIBA@ABA@ABC@ABA@@@A@AAAACCAAA@A@A@C@CBC@CBABABAB@B@BBB@@BB@B@@@BA@@B@@@BBB@B@B@B@BBBBBBBBB@B@BAB@B@BBB@BBB@BAB@BCBABABCBC@CBC@C@C@A@A@A@A@A@C@C@G@A@ABEBGBCBAB@B@@@@@@A@A@AA@AAAAAA@@AAA@AAAAA@AAA@@AAAAA@AA@@A@@AA@AAA@@@AAA@C@A@AAA@A@@@EDGFEFGFCBEBE@E@CAAC@CCCABA@ABCBG@CAE@CBGDCACAGAGAI@CBAB@@ABCBCAECICGAA@A@CBCBCDEBC@A@IAE@CAGCEC@DAB@F@F@FADABCBGDG@CAACACABEBEDA@EDEDABAFADADEBCBGDCDABAFEFGFCBC@EBCDCBEFC@CAGEGACBGBIDGDEDGHED@@@DFNHPFR@PDLNNBFFPFP@L@N@PBLBLCPAL@BADABCD@BAB@BBB@B@BAF@DBD@BBD@@@D@BADAHADAD@H@DBDAB@DBBBD@BC@ABAB@BABA@EBCB@BCAC@AAAACCACAACAAAA@A@CBEDCDABA@grDbMZENDjcXAhELH@HAFAD@DAHCH@H@H@H@JBF@DBFBFBFBH@D@F@F@H@D@JAD@FAHADAFEHEFCFGDCDADAHCFADAF@F@FAFBDBJDFBFBFFFBDBDDDBFDDDFHDBDFBFDFDF@@FFBDDHBF@H@D@H@D@DBB@F@DBDBF@FBFBDHDDBH@D@FCDCFCJBHBFH@D@DBD@DBHBF@D@D@DADAFAHCFADADADCFADAFEFEFAHAD@FAB@D@DBD@D@@DBDFBH@DBDT@dDNDB@DAD@@@DF@@@@@@BD@DBD@BDDBDBB@@@BBB@@BBDDHFDDDDDH@BCJAFADIF@@CJCF@BBD@B@@@B@DA@@BA@@@@B@B@D@@BFBD@D@B@BBBFAB@B@BADE@ABA@AD@B@D@B@BAFCFCDABAF@DAB@LDB@FBD@B@BAD@BCBAB@BAHCD@FAF@D@BAB@DI@@BADBD@@@BB@JBB@BBBB@D@LBD@DAB@FCDAB@BBB@B@D@BB@@B@@DBBB@BBDDBBBDDB@BDBBBFBDBDBBBB@BB@BBB@B@@B@B@D@FCDABAD@DAB@DABBB@BBDBFFBB@FB@BBB@B@H@D@HAB@B@B@@@D@DB@@F@DADADABAB@B@BB@@B@BAD@D@DBDA@BB@@BD@DBB@B@@ADAB@B@B@DBB@F@H@L@F@H@D@DBFBHBLFFDFBD@@BBA@@BAAEBAB@BA@@D@B@@BBBBB@D@B@B@@BBBBB@BBD@D@@@B@@G@@BAB@DAD@DAFBL@DAD@FCB@D@@@DDBDBF@HBBBBPHHDFDD@@@DBDABBBBBBB@B@BB@@BB@@@BB@BBB@D@J@P@FBB@BA@A@E@@@AB@LAF@DAB@@ABEBEBKBAD@BB@@BAB@@BDB@DB@@BB@B@@@BA@ABG@@BAD@BBB@BBBB@@BABCFAB@DBB@BB@BB@@DA@@BCB@BA@@BBBBB@BB@@@@@B@FCBAB@BBBBB@B@N@N@N@NAL@D@B@D@DBB@BAFAB@JBBADADCD@DABA@@D@B@@@DAB@@@B@@@B@B@@@@@@@B@B@B@@@@@@B@B@@AB@@BFAB@B@@@@@@@@@D@BBBA@@BB@@@@@BAB@@@B@@@BB@@BB@B@BB@@@@BB@@@B@@A@@BAAC@A@A@@BA@@D@@@@A@@BA@A@@B@@@B@F@@@DB@@B@@@@A@@@A@A@AB@@@D@@@BA@@@@AA@A@@DAB@BA@A@A@@BA@@@@F@@@@@@@@A@AA@@@B@@@B@@@@@@DB@@@DA@@J@@@@@BA@@@AA@@A@@@@@@@AD@@@B@@@B@@B@@@D@@@BB@@@B@@@B@@A@A@A@@@A@@@@BA@@@BB@BBBBD@B@B@@A@@@A@@@A@@@@B@@@BBB@B@@@@@@B@@@B@@AAA@@@@B@@@@DBB@@@BA@@@@@AA@@A@@B@BB@@BB@@ABA@@BA@@B@@@BB@B@@@BAB@@@@@BB@@@B@@B@BA@@B@@@B@@@@BB@@BB@@@B@@@@AB@@AB@@@B@@B@@@B@@@@B@@@B@@AB@@A@@B@@ADB@@@A@@@A@@@@@AA@@AA@BA@@BA@A@@@@A@A@A@@@AAAA@@BA@@B@@A@@@@@AA@@@A@CBCBAB@@@@C@@@@@A@@@AG@@AA@@@@A@AB@@@B@B@@A@@AAAA@@A@@BCFEB@@A@@@AA@@@A@AA@@@@@A@@@A@@@A@@A@A@A@@BA@@A@@A@@A@@BA@@BA@@@@BB@@BBB@@AB@BA@A@@@@@A@ACAA@A@@BA@@@@@@ABABABA@A@@@A@@AAA@A@AB@B@@@BA@@@@@C@AAAAA@@A@@AAA@@@@@AB@@@@@D@BA@@@@@@@@A@A@@AA@A@@@AB@@ADA@@@@@ABA@@@@A@@A@@A@@@AB@@AAA@A@@@@AAA@@@A@@B@B@BA@@@@@A@@AA@@A@CA@@@A@A@@@@@AB@BBB@@@BA@@@A@@@ACC@@AA@@BABCDA@@@AAE@@@A@A@@BA@@@A@A@ADC@@@A@@A@A@A@AA@@@@@ABC@@@@@@AAA@@@@@@A@@@@B@D@@A@@@@@A@@A@@A@@@AB@BA@@@@AA@@BAFC@A@@@@@@@@C@A@@A@@@A@A@@HCB@@AB@@A@A@@B@FAB@HCBAB@DBB@BA@@@@@ABE@A@CBE@ABA@@B@B@B@BBBDFBDFFDB@HANAF@DAD@BA@BD@BBDBB@@@F@B@B@FB@@B@BAB@BAB@B@DD@@B@B@D@BABABA@AB@@ABBD@DBDBB@B@FAB@@A@E@A@AB@BA@AAAA@AE@C@AB@@@@@@@@@@A@@BB@@@@B@@@B@B@@@B@@@@@@BB@@@@@@@@@@@B@@B@@@@B@@@@@@B@@@@BA@@@@B@ABB@@@B@B@@@@BB@@@@@B@@@@AA@@@@@B@@@@@@@BB@@@@@@B@B@@@@@A@@@B@@@@@@B@@@@@@@@B@@@@@B@BBB@@@@@BBB@@@BA@@BBBB@@B@F@@@F@B@@@B@BBB@F@DBB@B@BBB@B@BB@@@@D@@@B@@@BAB@DAFAFAB@DADAB@FADAPGDAFCB@D@B@BAB@BAB@BBBBB@@@BABABA@ADCBABADCBABADCB@@CB@NEFCFADA@AB@B@FD@@D@BB@@BFBB@BB@LCDAFAF@DADADAD@BAB@A@@A@A@@@A@@@@@A@@@@@A@A@@@@@AB@@A@@@@@@@A@@@@@A@@@AB@@A@@@@@@AA@@@@@A@@@A@@@A@A@@@@@A@@AA@@@@@A@@@@@@@A@@AA@@@AA@@A@@@@@A@@@A@@@@B@@A@@@A@@@@@@BA@@@@@@BA@@@@@A@@AA@@@A@@@A@@@@@A@@@@@A@@@@@@B@@@@AB@@@@AB@@A@@B@@A@@B@@A@@@@@@@@AA@@@@@A@A@@@@@A@@@@BA@@@@@A@A@@B@@A@@@AB@@@@@BA@AB@@AB@@@@@BAB@@A@@B@@A@@@@@A@@@@@A@A@@@A@@@@@AA@@@@@@A@@A@@A@@@AA@@@@@@A@@AA@@@@@A@@A@@@@@AA@@@AA@@@@A@@A@@@@A@@@AA@@@@@AA@@@@A@@A@@@@A@@A@@@@@A@@@A@A@A@C@A@A@C@CBC@CBE@E@IAEBCBCBE@A@CAACC@CBC@EDCDE@EBC@CBCBCBCD@@A@CBCBC@@BC@ABC@CFCDAF@DADAFBF@F@BABABC@E@CBCBCBCBEBEBAFAHAHADC@CACAC@CBCFAFA@@F@FAFAF@FCHCFA@@@@B@F@T@B@D@HANAF@H@D@@ABAB@DBB@BBDBB@BAB@B@DBDBD@D@D@BAD@DAB@D@BBD@B@BADAB@B@D@DBB@D@@@@@B@D@D@D@B@D@@ABABA@A@A@A@AD@BAB@B@BADABABABCBABAD@F@B@D@BBBBD@B@B@FEFGBGFCHCDEDCB@DBB@D@@@RDBBB@BQBCFEFGHODEDABC@CAAAAC@@A@A@@BCBCBABA@@@A@@@AAA@AA@AA@A@@@A@@BA@@@A@@B@BABAB@@@BA@@@@@@AA@A@@@@@AB@B@@@@@@A@@@@@AA@@@@@@@BA@BB@@@B@@AB@@A@@@A@@BA@@BA@@D@@@B@@A@@@@@A@@@@@@B@@@B@@@@AB@@@@@@@@A@@B@@@B@@@@@B@@@@@B@@@BA@@BA@@@@@AA@@@@@@AB@@@@@B@@@@@B@@@@ABA@AB@@@@@BA@@@A@@@A@@@@@@@BA@@@@@@@@@@ABA@@@A@@A@@@@A@@@@BA@@BA@@@A@@B@@@B@@A@@@AB@@@@ABA@AB@@@@@B@@@@A@@B@@@B@@@@A@@A@BA@@@@A@@@@A@@@A@@BA@AA@@AA@@@BA@@@C@@B@@@B@@ABA@A@@@AB@B@@@AA@@BABAB@AAC@@@@@@AD@@@@@@@B@@@B@@@BA@@@@AA@AB@D@@@@@@A@@AA@@@ABA@@@BB@@@@@B@BA@@@ABA@A@@B@@@@@BA@A@@B@@@@@@@AA@@@A@AD@BA@@B@@@D@B@BA@@BB@@A@@B@@DA@@@@@A@@@@B@BB@@BABCB@@A@A@@B@@@@AB@@@@@@B@@B@@@@@@@@A@@@A@@B@B@@@@@B@@@B@@@A@@AB@@@BA@@AA@@@@BAB@@@B@@@BAB@@A@ABA@A@A@@B@B@@@@AA@@A@@@@@@B@@@@@BA@@@@@A@@@@@A@@@@B@@A@@@@@@@@B@@A@@@@@@@@B@@A@@@@@@@@@@BB@@@@@@@@@A@@@@B@@@@@@A@@@@@@@@@A@@@@@A@@@@@@B@@@B@@@@A@@@@@A@@A@@@@@A@@@@@@@@@@@@@A@@A@AA@@@@@A@@AA@AA@@@AA@@@@@A@A@@AC@@AA@@A@@@@A@@@AA@@A@@@@A@@@@A@@@@@AB@@AA@@AA@@@@@@A@@B@@@A@@@@A@@@@@@@@@@AA@@B@@@AA@A@@@@A@@A@@@@AA@@B@@@A@@AB@@@@@@A@@@A@@@@A@@A@@@A@@@@@@@A@@@A@@@@@@@@A@@ABA@@@A@@@@@C@@A@@@@AB@@@A@@@BAA@@AD@@@A@@A@@B@BA@@@A@@@@@AB@@A@@@@@@@@B@@A@@@@B@@@@A@@@@@@@@@A@@B@ACB@@@@@@@@AAA@@B@@A@@@@BA@A@@@AAA@@B@@@@A@A@C@@B@@ABA@A@@@A@@@@@@B@@@@@B@@@@@@@BA@@BA@@@@@@BA@@@@BA@@@CCA@A@@CAA@A@CAA@ACE@AAGAA@AAAAA@@AA@ACAA@CAAAAA@CAA@A@E@@@A@ABA@CAC@C@A@EAA@AAAAAAAAAAAACA@GEIECAAC@C@C@C@CBCBA@AAC@CAC@ACAC@EB@@A@C@E@CBC@CBABABABE@CBCBCBEBEBEDC@ABC@C@C@EBCBC@GBCDCDC@K@IBG@CB@@A@@@@@AA@@@@@@@AB@@A@@@@@AA@@@@@@@@A@@@@@A@@@@@@A@@@@@A@@A@@@@AAA@@A@@@AAA@@@A@@@@B@@A@@@@@@@A@@@@A@A@@@@@@@AA@@@@BA@A@@@A@@@A@@@A@@@@@@@@ACBAFC@ABC@A@AAC@AACAA@A@@AAAAAC@A@A@ABABABABABABC@A@C@A@A@C@A@C@A@AAA@AAAAAAAA@C@A@CBA@C@A@ABA@E@A@EBC@C@A@C@A@CAA@AAAAA@AAACA@G@GBCAA@@@@A@@@@@AAA@@@@@@AA@AB@AA@@B@@@FCJGBAFCDEBC@CAGFK@CC@KAI@CAEG@CBEAE@ACGAAAK@@C@A@CEAAA@@@E@EBC@CB@@AA@@A@ABA@ABA@@BA@@@ABA@A@A@ABA@A@A@A@A@A@A@@@@@A@@@@@A@@@A@A@@@A@C@A@@@A@@@@@@AA@@AA@@@AAA@AA@@AA@C@AFCD@DABA@CACAEAC@EACAC@E@CAEACAC@GAG@G@EACACC@EBOFMDA@IFIBGDC@C@CBADEDEDGBEBEDCBCBEACACCACE@EBC@E@EBABADAFADADABAD@D@D@B@BCBGBODE@C@C@@AA@BCBCDEBCDCAEACAC@AAAACIGICC@CBCBADABE@IAG@@@AAA@@@AB@@A@A@@BA@A@@@AAA@A@A@A@A@@AA@A@@@AAA@AAA@@AA@@@@BA@@BA@@BAB@@@@ABAB@@AB@@A@@@A@@@A@@@@@A@@@A@@@A@@@A@@@AA@@AAC@@AC@AA@AA@@@@A@@AA@@A@C@@@A@A@A@A@A@CAA@@@A@AAA@@@A@@@"],encodeOffsets:[[104206,34942]]}},{type:"Feature",id:"632323",properties:{name:"泽库县",cp:[101.466689,35.035313],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@B@DAB@BA@A@@B@@BD@B@B@@A@GDCB@B@B@D@B@@ADAB@B@BFTBB@D@D@BBDBF@@DBB@@D@B@@ABGFCD@@@BA@EDCBUHC@A@@@CAA@CBIBC@CBC@A@@B@@A@ADA@@BA@ABE@C@@BA@@@@DBD@D@@ABAD@BBD@BBB@@AB@@ABC@C@@@A@@BED@BA@@@A@A@C@A@CBBB@@BH@D@BCBE@CDAB@DADAFCBCDCBCDAD@DAD@FADAHEB@BC@@F@B@F@BCBGDCDCBADAFC@@@CBEFADABA@@BAB@B@BBDBD@BCBEDE@@BE@GBAD@BA@ABE@EB@@C@@BCD@B@D@D@@@BA@CBCBCFADA@@@EBA@@@BDFH@@@BABCB@BCBAB@@@@FHB@@BAD@@@BD@BD@BABAB@BAB@@A@@@@D@B@@@B@@BB@@@B@@@B@B@B@@CB@@@B@@@B@@@@A@ABA@@B@B@BAB@@@B@@B@@@B@@B@@@B@@@@@B@@DDBBRDB@B@DCBABA@ABCBE@@DAHEFCD@B@BAD@D@@@BADB@@DC@@F@FBD@FC@AB@FADCDCDCDAFAFEHAFBFBHFDBBFBHDDDBHDFBFALAFADCDCDEDCFCDCDADCDCDAD@HAJ@FBFDFDDFDFDD@@@F@F@@BB@D@BFBBBJ@FBBBBD@BBFBBFBF@D@D@DBBBD@DAHCFCFCBADCJHDBDBD@D@D@FBDBD@DADABAB@DB@DBDBDDBHDHBD@@@@AB@DADABAB@D@D@DBF@DBB@BBB@DBBBDD@@B@B@DBD@DBB@B@D@BAB@HBD@FADBFAD@B@B@@@BA@@@A@@@@B@B@@@BA@A@A@@@@BAB@@@BCBA@@B@BAB@BA@AD@BA@AB@@A@@B@@AB@B@BABAB@@A@@BABA@@@A@@B@@A@@@AB@BA@@B@BA@@BA@AB@@A@A@A@@@A@@@AB@@@BA@AA@@@@AA@AA@A@@@A@@@AA@@ABA@A@@BA@@B@@@B@@@@AA@@A@@B@B@B@HBB@FBBBB@BBD@DADA@@DBDBBBB@@@BBAB@B@BAB@B@BBDBDBB@B@BABADAB@BB@DBDDB@@@DABABAF@F@B@DCBADAF@B@B@DADAB@DADAD@HAL@FAB@FA@AB@B@FBB@B@BABA@ABABAB@@AB@JBB@BBD@@@B@BABADADA@@B@@@AA@ABABC@A@ABAB@B@DBFADADAB@@AAA@A@A@@@ABAB@HAB@BABAAA@AAA@A@A@ABABA@AD@B@B@@ABA@ABC@A@E@A@A@C@ABA@A@AAAEE@CAA@C@C@A@ABA@A@E@ABCDC@A@A@@@AAA@@A@AAC@@AAA@C@EBCAA@AACAACE@C@@A@@@@A@A@@@@@A@@@@@AB@@@AA@@@@@A@@@A@@@@@A@A@C@A@AB@@AB@@A@@@A@@A@@@A@AA@@AA@AA@@@AAABAAA@@@@@A@A@@BABA@ABA@@@@@@BA@@B@@AB@@A@C@A@A@@A@@A@@BA@A@A@CB@AA@A@@A@@@ABA@@@@A@@@A@@@@@AAAA@@@AA@@@A@AAA@AAA@A@A@AA@@A@A@AAA@A@@@CACA@@AB@@CACA@@C@@A@@A@A@A@@@@A@CBA@A@C@A@CBAB@BABA@ABA@C@A@CACB@@ABADE@AAA@AA@BCBA@A@A@@AA@@@C@@DCBA@A@@BA@@AEAA@CA@CAAAA@AAAA@AAAAA@AA@C@@AE@@@C@CAA@C@@@ABC@ADA@ABA@GAEAC@AAEA@@@@@@BCBA@C@@BA@A@@@AA@EAC@AAA@AAAAGACCC@C@@@A@GBABA@C@@@CDABC@A@C@ABA@C@A@C@A@@AAA@AAG@A@@AAAAAAAA@@BABE@@B@AAB@@AB@@@BA@@BA@@@AB@@@BAB@D@@@@A@@@@@@@@@@@@@@BA@@@@B@@A@@@@@@@@@@B@@AB@@@A@@@B@@A@@@@B@@A@@@ABC@@@@@A@@B@@ABA@@BA@@@@@@@A@@@@@@B@@@@@@A@@@@BA@B@A@@@@@@@AB@@@@@@@@@B@@A@A@AB@@@@@@@@A@@B@@@@@@AA@B@@@@@BA@ABA@AB@@@@@BA@A@@@AB@@A@ABA@@@@@@@@@@@A@@B@@@@@ACB@@@@@@A@@@@@@@AB@@@@@@A@@@@@A@@@@@@BA@@@A@@@@@@@@@A@@BAB@@@@@@@@@@AB@@@@@@@@@@AD@@A@@@A@@@@@AB@@A@@B@@B@@@@@B@@A@@@@@B@BBBB@@@@@@BB@@@B@B@@@@@@@BB@@@@B@@B@@@@@@@@B@@B@@@@ABB@@@@B@B@B@DB@@BA@@B@BB@@B@@@B@@@@@@@B@BB@ABBD@@@BB@@BB@@@BB@B@BDB@@DB@B@@BBBBB@@D@DDB@@B@@@@AB@B@@@B@@@@FB@BBB@B@B@@B@B@@@FAF@FA@@BABAD@@@BAJEB@D@B@B@B@FBB@@@F@H@D@B@F@B@@AB@B@@@BCDC@@B@B@B@BAB@DB@@B@B@@BB@@B@B@@@BB@@@@@BB@@BB@@B@@@BBB@@@BA@@B@@@@@B@@@@AB@@@B@@@@@B@@@B@@BB@@@DDDBBB@@@B@@@BABAB@B@B@BB@@BAB@@BB@FB@@@@DA@@B@@@B@@BB@@@@@D@BBB@@@@@B@BBB@B@B@DBD@BBB@BFHBF@DB@@B@BBB@B@B@@@BBB@@BD@@@@B@BBB@B@@@DB@@B@@@B@@@@AB@@@DB@@DB@@BBB@B@BB@@BB@@BB@@@B@BBBBB@@D@B@B@BB@BB@A@@B@B@@@@@@@@B@BA@@BAB@@ABABABGBA@A@@B@@@B@B@@B@@BB@@FFDDBDDD@B@B@FBDAB@B@DBB@BDDB@B@@B@@@BA@@@@B@@@BBB@@B@ABAB@@@B@@BB@@@@B@@AB@@@B@@BDDB@@B@@@@@@A@@@@@AB@@@B@BB@@@D@@@@BB@B@BCBC@E@CDIDCBA@ADAHEB@DCDA@@DAB@BAB@@ABE@@B@FCF@@@D@@@D@D@B@DADCBABABAFCB@@AB@BA@ABABA@ABA@CAA@AB@@@@A@@@A@A@A@AAA@@CGAAACAAACA@@A@@@CBABCDCBCBCBABA@@@A@AB@@CB@@A@@AA@A@@@A@@B@@@DCBA@@BAD@@@BCDM@@@@DBDBBB@@B@@@DA@@D@B@BAB@BA@A@A@A@A@@@ABA@A@C@A@@BABABA@CDE@ABABE@A@AB@B@@@B@@@@AB@BAB@@@BAB@B@@A@@B@BC@@@@@A@@B@@@@@@@BA@@@@BA@@B@@@@@B@@A@@@@A@@@@@@A@@@A@@@A@@@@A@@AB@@@@AA@@@BAA@B@@@@AB@@@@@@AB@B@@@@@B@@@B@@AB@@AB@@@@@@A@@@@BA@@@ABA@@B@@@@A@@@A@@B@@@@@@AB@@C@@@@@@@A@@@A@A@@@A@@@@@A@@@A@ABCBA@@@@@AAAAAAA@@C@@@EAA@@@A@CAC@CACCA@@@A@A@@A@@@AE@A@A@ICC@AA@@@A@@@A@@@A@@AAA@AAA@A@@C@A@@@A@@@BB@@@DBB@DBB@B@B@B@@@@@CC@A@G@@BA@@CE@A@E@CAA@@@AB@DBDAB@BABCJABA@@BAB@F@D@@A@@@ABEDEBI@A@CAA@ABA@CBC@CAG@ABABA@@DAD@@ABA@CBCBCDC@A@AEG@C@@AA@C@@@A@ABC@EBC@A@AB@B@BAFBDAB@BADABAD@BAB@BBB@@@B@BABABAB@B@F@BAB@FEB@BAD@D@D@DBDB@@@@BEBEBCBABABAD@BABC@ABC@E@AAAAAAA@@BABCBC@A@AAA@ACA@A@A@ABAD@BA@ABA@ABABCBAFAJEDAD@JC@@D@BA@@A@AACACACCAA@C@C@A@A@AAAA@EA@@A@A@A@A@@AA@A@AAAAAACA@A@A@A@C@AA@EAC@C@C@AAA@CAAA@C@AACAAAA@AAAAAAAAA@CAA@AAAACCACCCAAAA@AACA@@BADG@EAGGEICIAAGM_KKAGBEFEHKNILMBA@CQDE@GAGBIBKBKFIDMDK@GAE@EBEB@@@@A@@@@@A@@@@AA@@@A@@@AA@@A@A@@@A@@@@@A@@@@@@@AB@@AB@@A@@BA@G@EEGCCAA@CBC@CCGCGEEAA@CBCBC@CAC@CBE@GAE@CCCCCACCAEA@AAC@EAE@AA@@ACBC@C@EBEBEDA@C@CBA@@FCHCB@DCBEAEACECEACC@A@ABABABCBABA@C@A@EAE@E@I@GBC@CAAAAA@E@AAAA@EAE@EAE@CAEBC@EBC@EBEBG@CBC@C@CAECC@C@C@I@A@EAAA@ABC@CBCBCBE@EBCBKDA@@AAAAE@@AAC@@@ECA@A@@BCBEBEDMFA@@DA@CDABABCDABABCD@BABABAB@@A@AAAAA@ABA@ABA@C@A@EDCBOHCBEBA@CBCBA@EBEBCBA@AB@@A@@@C@@@@@AAA@A@AAA@A@CAE@A@AAA@@@A@E@@@E@A@@@AAAA@@AB@@A@AA@@@@A@AAA@@@@@A@@@@@@@@@@A@@@@A@@@B@@@@@A@A@@@@@@@AA@@@@@@A@@@@@B@@B@@A@@@@@A@@A@@A@A@@@A@BAA@@@@@AB@@@@@A@@@@A@@@@@@AA@@@@@@@@@@@A@@A@@@@A@@@A@A@@@A@@@@@AA@@@B@@@@@@@@A@@B@DBFB@BB@BABA@@B@B@F@BA@EBA@A@CACAC@AA@BA@@BABABABC@A@A@@@CCA@A@ABA@ABA@@@EAA@A@E@@@A@CAAAC@@AABC@CBE@MBGBA@ECCEEAACAAA@A@A@@@AB@BAF@D@BAF@B@@@@ABA@CAA@ABGDA@EBA@@@@B@BA@@BA@GD@@@B@B@@@BB@D@@@@@@@@@@BEDAB@@BB@@@@ABA@@B@@@BB@@@@B@@@@@BC@A@@@@@@B@@@@B@BB@@@@@@AD@B@@@@BBB@B@B@@@@B@@CD@B@B@B@@AB@@@B@B@@BF@B@@CBADAB@@BB@@DD@B@@@B@@AB@@A@AAA@@B@@@@@B@B@@DBB@@@BB@@@B@@@@ABA@A@@@@B@@BB@B@@B@B@BB@@BA@@B@@@@BB@@@@@AB@B@@@@CB@BA@@B@@@BBB@@@B@B@@@@@@B@@A@C@@@@BA@@@@B@BB@@@BB@BBBBD@@@@@B@@A@@@ABAB@B@BB@@@B@@@BABABAB@B@@@@B@@AB@B@DB@B@B@@@@@BABA@@BA@AA@@AA@@@@AB@@AB@@@BB@@@@BB@@AB@B@B@@@@B@@@B@@@B@@@@BBB@@@B@@B@@@BA@EFAD@@@BB@BB@BB@@@@A@A@@BAB@@@@@BB@@BH@@B@@@@@D@@@@@BADADAB@@@B@@B@@@@@BA@@@AB@@BBBB@@B@B@B@@@@@@BAB@@ABB@@BB@@B@@@@@B@@@B@@CA@BA@@@@BA@@BA@@@A@@@@@@A@@@AA@@@A@@BA@@B@@A@@@A@@AA@@A@@A@@@A@@@ABA@@@@A@@AA@@@@A@AB@@A@A@@A@@@AB@@AB@BA@@AA@@AAA@@@@BB@@B@@@@AB@@A@CA@@@@@A@@B@BB@@@A@@@A@@@@A@A@AA@@A@@@@@@B@@@B@@@BA@A@C@AAAAA@@A@@AB@@@@@B@@@B@B@BA@@@A@@@A@@A@@@C@@@AA@@@A@@@C@@B@@@@@@@BB@@B@@AB@@@@I@@@CB@@A@@C@@@@A@@@A@@@B@@B@B@@@@@@E@@@@@AB@@@B@BABA@CB@@@BBB@@@@AB@@C@@@A@@B@B@B@@@B@@A@@@CA@@E@A@@@A@@@@BAB@@@B@@C@@@AB@@@B@BBDAB@@@BA@@@A@@A@@A@@A@AAA@@AA@@A@@@A@AB@B@BB@B@B@B@@B@B@@AB@@A@A@ACA@A@@@@B@B@@@BD@@@@B@@@@A@A@DRFRBBBDBFBD@B@B@B@@@B@@@@@B@@@B@B@@@B@B@B@@@@BB@B@@@@@B@@AB@@@D@@@D@@@B@@@@AB@@BB@@B@BB@@@@BBBB@@@@@B@@@BB@@ABBBBB@@BB@@BDDBBA@@@@B@@A@@@@@@@BBA@@@B@@BA@@@@@@A@B@@ABB@A@@BB@A@@@@@@@@B@@@@@@A@BBABBB@@@@@@@BB@@@@BB@BB@@@@BB@@B@@BB@B@@B@B@DBBBB@BAB@@BB@@BB@BFBBB@@@BBBBBBB@BBBABBB@B@B@B@@BD@B@B@B@B@D@B@@@@B@@B@@@@@@BB@@@@BB@B@B@@B@@@BB@@BB@B@@@H@BDBJLHHLJDBBBF@TBH@DDCDCBED@B@@@@@BA@@B@B@BBD@BBD@DBB@BB@@BBB@@@B@@@BB@@@@B@@@B@@@@@B@BB@@B@B@BB@@BA@@@BBHDHBFBDBB@@BDB@@D@D@BBAB@B@BA@A@AB@@@DCB@@A@A@@BCD@B@@A@ABA@@B@@A@A@AB@B@@AB@BA@@@@B@@@@@BB@@@AB@@@@@@B@@@BB@@@@B@@@B@ABABA@@@ABA@@BCBABEBAB@@A@A@ABEDIFA@CBAB@@@D@BA@ABA@ABA@@@A@ABABABCBAB@BAB@D@B@B@F@B@@@@ABB@@@@D@F@@@BA@@@A@@B@@@B@@@B@@@B@B@@@BB@@BA@@@EAABCBA@E@@@@BCBABABA@CA@@AB@DA@@@C@@@ADABED@BA@ADAD@BABKF@@AB@BEB@@ABCBEBE@ABA@CBE@EAWCC@EAKB@@ABCBCF@FAF@BABAB@BAB@DBB@@BBB@@@DBBBDBB@@B@B@D@B@@BBBDBBAB@B@D@B@B@DABBBBF@BAD@D@BA@A@C@ABA@CB@@@B@B@B@B@BB@@BB@@BA@@@CBED@@A@BDAB@B@@B@BBB@@B@@@D@@A@@BAB@B@@@@BB@B@@@BA@CB@@ABB@@B@@@@ABABAB@AC@@AA@A@@@A@@@@@CAA@A@@BCDA@"],["@@H@FAJAFAFAJAFBLDFDHDFFLFLDJBJBBBD@@BDAB@D@B@B@@@B@@AFC@AB@@@D@D@BA@@BA@@AA@AAC@ABCBA@@@CAC@C@@B@@AD@F@BAB@@AB@BC@@@@EMAC@@AAAGAEAACCCAGEACA@@@GCAAOIaY@AE@EBA@aHSHA@EBCBA@C@GCA@KAK@@@]EIAG@G@ICG@SCIAQEMBC@A@@BA@AAAAACB@@AB@@A@@DBBAB@@@@AA@A@@@@@@AB@@@B@BAB@@A@@@CA@BADCHKFEDEDE@AB@AA@@@ABCAI@A@KBE@EBC@ADCBIBE@CDG@C@CCCACCECKCG@@AKAABCDALEHCB@@@B@DAB@B@DA@@BB@@B@@@B@@@D@HBB@D@DABBDAF@F@D@B@FBB@DA@@D@B@@B@@BB@BDBDDBBB@BBBBBBDBFB@@FDB@@BB@@B@@@DA@AB@D@@@B@B@@BB@BAB@@ADADCB@B@@@BB@@DCJAFAFAFAH@BEHMNCF@@BBB@bTTLDAD@BBB@@@D@D@DBD@FAB@DBB@B@BA@A@A@CDIFOBEDGDG@CBEBA@C@@BAB@B@B@@@DC@@D@B@DDBBD@J@N@H@HBB@DAB@B@D@DABAB@FCBB@@@B@@@BDB@BDF@B@D@B@@@BBBB@@BB@@BBD@@BBD@@BDBFFD@D@BBB@@BBB@J@B@@D@BB@BB@DBH@HBB@BBFHDBBDBD@@@D@@B@DC@AB@B@DB@@@@B@@@B@B@@BD@@BBABABA@@@@@AA@BA@@DAB@@A@@@AAA@@@@@ABA@AB@@@@C@@@AA@AAA@@@@ABAACB@@@FCDA@@B@@AA@@AA@@A@A@A@A@A@@DAB@BAD@B@B@@A@CBC@AAEAABA@C@A@A@C@ABAAAACAA@@@A@C@A@AA@CAAACA@@A@AA@@AA@CBA@ABABA@ABE@EDEDABA@@LAFBD@XDFBF@DAB@BAF@FADABA@@FA@ABA@@LEBA@ABCBCB@@AFCBABC@@D@@@B@@CBA@@DBB@BABADA@A@@F@B@DABAFB@@B@@AA@@A@@@A@A@@@A@@@A@@@AB@@@B@@A@@@E@C@@A@BA@@@@@A@E@A@A@CBA@ABADABABABAB@@@B@BAB@BAB@@A@C@@BADAB@JEFCBAB@B@@@BAFABADA@AB@BA@@B@BABAA@@@A@@@@@AA@@A@@@@@@@BA@@A@@A@@@@@A@@B@@ABA@@@ABAB@B@@@@AB@BAB@@@@ADC@AB@B@@@DA@C@@BAB@B@@A@ABAAAC@C@@@CA@AA@CAEAGAGCAA@@B@@AA@@A@A@AA@@A@A@@@@@A@@@A@@A@@A@@@A@@AA@AA@@AAA@CAC@AAC@A@A@AB@@A@@@@@AFCDADCCCG@SAE@AACAKIGGIKCA@A@G@@@AAA@@AA@@A@@@@A@AAA@@@@AA@@@@@@@AA@@@@@@A@C@A@A@A@AAC@@@A@A@AAABAAA@AAAAAAA@A@@AAEA@AAA@@AA@@BA@AAAAA@C@A@AA@A@@AA@@@AA@@@@AAA@@A@@A@@A@@@@@@AABAAAB@@@@@@@@A@@@@@@B@A@@AB@A@BA@@@A@B@@@@B@@AA@@@B@AA@@@@@@B@@@@A@@B@AACC@AA@@AA@AAAA@BA@@A@@@A@@@@AAAA@@@@AAA@@@AA@@BA@@@@@A@@@C@@@C@@BA@@@A@@@@@AAA@@@@@A@A@A@@@A@A@@@A@@@@@A@@@A@A@AACAEACAAEQCQB@B@@@@@@A@@C@@A@@@A@A@@B@B@BDB@B@@@BA@@@A@AA@A@A@A@@A@A@@@@A@@AB@AA@A@C@@@@@@@@@ABAAE@@BA@@@A@A@@@@A@A@A@@@@@@@A@A@@@A@@@A@CB@@A@C@@@ABCBC@CDCBABIAA@EBABA@CAC@A@C@K@MBM@M@M@A@A@AAAAA@ABEDA@@@@@A@@AAAAA@AB@@ADA@AB@@CA@@AAAA@CAA@EBADAB@@AAAAA@AAC@AB@@AH@BAB@@A@A@@AA@@CCA@AA@AB@@AAC@ABALAFAF@BA@CBE@KBA@@B@@@F@BABA@EAO@I@C@A@AAA@@A@@AA@@AAA@A@AAAAAACBCA@@C@ECGCOGAAAA@GAEACCC@@C@A@EDC@CBK@EACBC@CBA@AB@@@HA@@@C@C@AAA@AAAA@@@A@A@CAAAA@AA@C@@@ABA@ABBFAB@@AB@AC@EAECKEGAEACAC@G@E@K@G@E@A@CAA@A@A@CB@BA@A@CAC@@AA@@ACBCAC@C@ABA@@@AAA@A@ABCBCBCBE@@@CAC@@@A@A@A@GBC@G@A@A@AAA@@EAAEECAAAA@AACBA@CBC@ABCBEDC@A@A@@@@AAA@AAAA@AACACAEAAACA@ACAACAACCAAA@AA@CA@@@AAC@A@A@AAA@CBEDA@CBC@KAC@A@AA@AAA@IAA@@C@CAAB@@CJA@ABC@E@EBC@GDABA@ABADC@ABA@C@EAA@KCA@CBE@ABCBEDEDABA@C@A@C@@BAB@BCFABA@A@EBAAABCBABAHA@ABG@CBKHE@A@AACACCCCC@CD@BGFEDCBG@EBEAGAE@KAG@E@EBMCEBKDAB@BCFEHCDABC@AD@DFFBDBBABADIBOBCAA@E@G@EAC@G@GBEBEBEBEDGBGDEDGBCDEB@@EBCBGD@BIDCDEDEDADADADCDCFADADAFCBAFCDABCDGFADADCDCBEDCBEDEDIDCBEBCBEBCBEBE@IDC@EBGBCDG@EBC@E@C@CBIDI@GBE@E@CBG@E@GAE@E@CBE@EBGBGDCBCBADAFEFEBEDCBEBEBGBGBGFEBEDEBEBIFCBEDGBEBEBEDCBADCBCF@FA@@DEFCDAB@@C@ADCHCDA@C@IBA@C@ADGDABA@@D@B@D@B@B@B@@@BAB@B@@@D@B@B@B@@@DBB@BBDBBBD@B@DBDA@BBAB@@@B@BCD@D@BABAB@@@@BBFDDBDDBBDHBBD@BBBBDD@B@BCDAB@@AB@BADAB@B@BCDCBABBBDAHAFAD@B@DBDBJDD@HBBBFJPPFDLHDD@@B@JDNDF@HBJD@@HBDDF@DDBBHDFDFDDDFDDDDDDDFDFDFDFBBBNPdVJHHD^XFDRTbfbfDBABABCDABAFG@CBC@CDA@I@I@GAABADEL@@IBEBGDIBGDIBCBIFkK]A@@AB@D@H@N@B@@@@ABE@WDGBQBEB[DM@I@SBC@W@CNEPOJEB[NAB@@AB@BA@@BIXABCFADAHCF@FAD@D@D@DDDBFBD@DBDJFD@H@D@@@H@FBFAF@F@DAF@HCD@D@DAFBJAD@FAH@H@HBHBFBFAD@D@@@F@B@FAJEF@F@DAF@FAF@D@D@B@F@H@F@DBDBDDFFBDBDBBDF@BBBDBHDD@FAD@DAD@HAFAFAHAD@FAF@F@F@DAH@FAJCD@BAFAF@FAHAD@JAF@F@FAH@F@FBD@F@FCHCDAFEBCDADAFCHCHAD@HBFBFBH@HBFBD@H@JBFBH@HBJ@F@H@J@FBH@HBD@H@H@F@F@B@D@F@H@F@J@F@D@F@F@JAH@DADAF@HAF@H@J@DBH@HBF@F@@@F@DADAFADADC@@DCDCFCF@DDFFHDD@HAFAHCDADCJEFAFAB@HAJAB@B@HAF@HAH@H@HCFEDEBCBABCBCFADCJAF@DAF@B@D@@@HBH@FBH@H@@BH@H@F@FAH@FAH@FAFAFADAFCFAHCB@DAJEJAHCLCJCD@BAHCJAHCFAFAFCFAFAJAF@FAB@F@F@H@D@BAF@FBHBFBF@DBH@"]],encodeOffsets:[[[103990,36111]],[[103849,36188]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("西宁",{type:"FeatureCollection",features:[{type:"Feature",id:"630102",properties:{name:"城东区",cp:[101.803717,36.599744],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@ABA@AB@@A@A@@@A@ABC@@@A@A@@@A@@B@@A@@@AB@@@@AB@@@@@@@@@B@B@B@@@@AB@@BBA@@B@B@BB@@B@@A@@@@B@@@@@@@BAD@BBB@D@@@@@@AB@B@B@@@B@B@@@@@@@B@@@B@@@BAB@@A@@BA@@B@@@@AB@@@BAB@B@@@B@@@BB@@@@BB@@@@@B@B@B@@@B@@@@B@@@@@@@@B@@@@@@@B@@@@BB@@@@@B@@@@@@BBA@BB@B@ABB@@B@@@B@@@@@@@@@@ADA@@@ABABA@ABFFDD@@DF@@@@BB@@A@@@GDDD@@A@@@@BA@@@@@A@B@@@AB@@B@DDBBBB@@BDBB@@BB@@@B@@HJB@GDBD@@BB@B@@@B@@BB@B@@@@@BBB@B@@@@@@BB@@B@@B@@@BB@@B@@BD@BB@@B@@BDB@FBHFLBD@DAPABABADCDEDAFAB@DBFFFBD@DC@CACAGCI@ABEDCFAHAD@HAHCBAFEFEDADABA@CBA@EBADAF@BABADEDCBAHIBCFGDEBCBAFGFGFM@E@A@@@CAAAA@C@C@E@@AEECGEGEECA@CCCE@@G@A@A@A@A@@@E@@@A@C@@@@@AB@A@@@@A@C@C@@BAAA@@@@@@@AA@@C@A@AAA@@@AA@@C@E@G@A@ABCB@@A@AAA@A@@A@@A@@@@@ACA@A@@@A@@@AB@AABAA@B@AAB@@ABA@ABABA@@@@@B@@@@B@@@@@@A@@@@AA@A@A@@AAA@@@AB@@@@A@@@@A@@@@@@A@@ABA@@DABAB@@@@A@@B@B@B@@@@ADAD@@AB@@AAA@@AAA@@@A@A@@AA@@@@AAC@@@A@A@A@@B@@@BA@@DA@ABC@@BA@A@@@AB@@A@A@@@A@A@@B@@@@AB@@B@@@@B@@@@@@B@@@@@@@@@@@@B@B@@@@B@@@AB@A@@@B@@@@@@@@@B@@A@@@A@@BA@@@A@"],encodeOffsets:[[104242,37426]]}},{type:"Feature",id:"630104",properties:{name:"城西区",cp:[101.765843,36.628304],childNum:1},geometry:{type:"Polygon",coordinates:["@@BAA@@CAEAACA@@@@@@AA@@@@@@@A@@@@A@@A@@@@@@AA@@A@@@A@@@AB@@ABA@@@@@@@@@@A@@AA@EC@@@A@@A@@@AA@@@AAC@A@@@@@A@@AA@A@@@A@@@@@BA@@@@AA@@@A@@@@@A@@@@@AA@@@@@A@EA@@A@A@@A@@@@A@@@@A@AAAA@AAC@C@C@AB@@A@@B@@AB@B@BA@@B@@AB@B@@@@A@A@@@@@A@@@CB@@@@A@AC@@@BA@@A@@@A@@@@A@@@@@A@EBGB@@@@@@A@A@C@AB@@A@@@A@A@A@ABAB@@@@@BBD@B@BAB@@@B@@@@B@B@@AB@@@@B@D@@@B@@ABAD@B@@AB@BA@AD@@B@@@@B@B@@@@@@@B@@B@@B@@@B@@BB@@B@@BB@B@@BD@BB@@@@B@@BDB@B@@BB@B@@B@@B@@@@@@A@AAEBA@ABAB@@AD@BA@A@@@AA@@EAC@A@@B@@CBAA@@ABA@CBA@ABAAA@@@A@A@@@A@A@AACAABA@A@@@@@B@BBB@B@@@@@@@@@BB@@@@@@@BA@BBD@@@BBF@@B@@B@@@B@@BBBB@BBB@@@BBB@DB@@DB@BD@@@B@B@@B@@BB@@@@BBF@BADAF@B@F@B@BA@@B@@@B@BB@@B@BDB@@@B@DBBB@@B@DAB@@@BBB@B@B@B@B@FA@@BBB@@@D@@@@@BA@@B@D@B@D@B@D@BBD@B@B@DAD@FAB@DBB@@AB@B@BA@A@@B@@AB@B@D@B@B@B@DAB@B@D@B@BAB@@@@A@@@@BAB@@@DABBB@BA@@@@@@DE@@B@BAB@F@FA@@@@B@@@BBD@B@B@B@@ABADC@@@@BAD@B@B@B@B@B@FAJCAAAAAA@@ACECAAA@CE@@ACAAAA@@ACCI@@C@@BA@C@@@A@@J@@@BA@@@@@@@AA@@A@@@@AABA@@@@@@@@B@@@@AB@@@ABA@A@A@@@@A@A@@@AB@@C@@@B@@AD@@@@AB@@@@@@@@@@@@@@@@A@@A@AACB@@BAA@B@A@@@@AA@A@ABCB@@A@@BA@A@@@ABE@CBABA@C@@@A@@@A@A@@@AB@@A@@@C@A@"],encodeOffsets:[[104167,37498]]}},{type:"Feature",id:"630105",properties:{name:"城北区",cp:[101.766228,36.650038],childNum:1},geometry:{type:"Polygon",coordinates:["@@EBABA@@@IDEBA@A@A@A@A@C@AB@@@@CDAB@BA@A@A@C@AA@@A@@@@@EBE@A@ABA@@@CF@@@@@@ABA@AACB@@A@AB@@@@@B@@A@ABA@C@A@A@CBA@A@A@C@A@A@@BA@@@@BABA@A@@BA@CAA@EBC@CBA@A@C@AAC@A@C@A@C@A@@@AB@@@@C@@@A@AA@@EBA@A@A@A@A@AA@@A@CBA@@@AACAA@@@A@ACA@@@AAA@@@A@@@ABA@E@A@E@CBABE@AA@@@@AA@@@AA@A@@@C@@ACA@@CAA@AA@@A@AAA@AA@AA@@@C@@@A@@@C@C@C@A@G@A@A@@AA@A@@@A@@B@@C@CAE@C@@@A@@@ABA@A@CAC@A@C@@@A@@@EBABC@A@@@A@A@A@AB@@@@AB@@A@EBE@E@C@CB@@@B@@@@@B@@@@@B@@BBA@@B@@A@@@@BA@A@@B@B@B@@@B@@AF@D@@BB@@B@@B@B@D@@@B@@@B@@@@@BB@BB@B@@A@@@@@BD@B@@@@@@A@@@A@@@A@@@@DA@@HA@@B@@@@@BA@ADA@@BABAB@@ABA@@@A@@@A@AB@@AB@@@B@@@@@@B@@@@@B@@@@B@@@B@B@@B@@@AB@@BB@A@@B@B@@@@@B@@@@@B@@@B@@@B@@@B@@@@@BAB@@B@@B@@@@@@B@BBB@B@B@B@@BB@@@B@@BB@B@@B@A@B@@@@A@@@@@AB@@@@@BA@AB@@@@@BA@@@@@@@@BA@@@@B@BA@ABA@@B@@A@@B@@B@@B@@B@@B@D@@@@@@A@@B@@@@@@@BA@AB@B@@A@@B@@A@B@AB@@@@@@AB@@@@@B@BC@@B@@AB@@@@@A@@@@@BA@@@@@@@A@@@@@@B@@@@@@@@@B@@A@@C@A@@@@@BAB@@@@@AA@@B@@@BBDA@ABA@@@A@@A@@@B@B@B@B@@@@BA@@BB@@@B@B@@@B@@@B@B@@@@@@@@@@B@@@@@@@B@B@@B@BA@@HB@@B@@BB@B@@@@@ABAD@@@@DB@@AB@BA@@BB@@B@@@BB@BB@@BB@@@@B@@B@@BB@@@@@BA@@@@B@@@BAB@BA@ABA@AB@@@@@@@B@@B@B@B@@@B@@@@@BB@@AB@@ABB@@@@@BA@BBA@@BABAD@BA@@BB@@@AB@@@D@@B@BBBABB@@B@BBA@@@A@ABAB@@CBA@A@@BA@@@A@A@AB@BAB@B@DABAB@BAB@DAB@BABAD@FE@AB@@@B@F@B@DA@@B@@@BB@@@@A@@@@BBB@B@@BB@@@@BBB@@BBAB@@@@@B@B@@@@B@@D@@A@@B@@@@@B@@@@@D@B@@@B@@B@@@AB@B@B@@@D@B@D@@BB@BA@A@@BB@A@@B@@@@@@@B@@@B@@@@@@AB@@BB@B@@@@B@@@@@@@@B@B@@@BB@@BA@@@B@@@BAB@@B@B@@@@@B@@B@@@@B@@@@@BA@A@@BA@@@@B@@A@@@@B@B@@@@AA@@@@@@AB@@@B@@AAAA@@@@AB@@@@@@@@A@@@@@ADBAA@@AA@A@@@@BA@@@@BAB@@@B@@B@BDB@@@@B@@A@A@C@@B@@@BAB@@@@AB@@@B@@@B@@@B@@@@@@AA@@AA@@@B@@@AA@@@@@A@@B@A@@@@@B@@@@@@BB@B@B@BBB@@BA@B@B@DD@@B@B@@@ABA@E@ABBD@BA@ABB@B@@B@@BB@@A@@B@@B@ABB@ABCDB@B@B@@FA@A@@B@@@@ABD@DBBDABABABABA@BBBBB@B@D@B@B@@BABAB@B@BABAB@@ABA@@BABAD@@AD@B@BAB@@@D@@ABAB@DAB@@@BBHBB@@B@B@@B@B@D@B@@@@@B@@A@ABA@A@A@AB@@@HABBDAB@@@B@@@@BBB@@@B@@@@@B@B@BB@@@@B@@@@@@@@@B@B@B@@@B@@@@BB@B@@@BB@@BA@@@@@@@@B@@@BAB@@@B@BA@@B@@@B@B@@@@@BAB@B@@AD@@@BB@@B@B@@BB@@@@@B@@@BB@@B@B@@@@@@@B@B@@@@@BB@@B@D@B@B@B@@@@@@A@@@@D@@@B@@@@A@@D@@AB@@@B@D@@@@ACA@A@A@A@A@@@G@C@AAA@@@A@@BAA@@A@A@@AA@A@A@CAIAC@AAC@A@A@A@@@AB@B@B@B@@BB@A@BBB@@@@@BC@A@@@@@@B@@@@@BB@A@@B@@@@@BBD@@@B@@@B@@@BBD@BAB@@@B@BCB@DGDE@GACACCACCAECI@IAAACEGAC@A@@@ADEHGJGFCBA@@BBHFDFBBDDD@DADE@A@CECAIAIDE@@BAAC@@@AA@@AAC@@@AA@@A@@@AA@@@AA@@@@@@@AAA@A@@@@@AAA@@@A@@@AAA@@ACCBA@GB"],encodeOffsets:[[104231,37511]]}},{type:"Feature",id:"630103",properties:{name:"城中区",cp:[101.705298,36.545652],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@@@@A@@@@@A@@A@@A@@@@@@A@A@A@@@@@@@@@@AAB@@@@@@@A@@AA@@@A@@@@AA@@@@SJEDAB@BABABFHDF\\dA@@@CCAAAACC@AAAA@AA@@@AA@@AA@@@@@@AB@@A@@A@@AA@@@@@@@AA@AA@@@BB@@A@A@ABAC@@BA@@AACB@@AA@AA@@@A@@@ABB@A@@@@@A@@@@@@@@ACBA@@@@@A@@B@@@@A@@A@@A@@@@@A@@BA@@AABA@@@A@A@@B@@@@@B@BA@@@@B@@@@@B@@@D@@@CBAB@@@BAB@@@B@@@@BA@@@@@@B@B@B@@B@A@@@@@@BAB@@@@BB@@@B@@A@@@@@@B@@C@@@@@@B@@A@@@DBBA@@B@@@B@@B@@@@@B@B@@@@BB@@BAB@@AAA@A@AB@@A@@BA@@D@@B@@@@@BDB@@@B@BB@@B@@@@AB@@@B@@B@@@B@@AB@@@B@@B@@@@A@@B@BBB@@@@@B@BB@@@@@BA@@B@@@@@@@B@@@@B@@@B@@@B@@B@@@@B@@@BA@BBB@@B@@@BBA@B@@@B@@B@B@@@BB@@@BDA@@B@@B@@@@@BA@@B@@@B@@@@@B@@@B@@@B@@ABBBABB@@@B@@@@@@@B@@@@BBB@@@@BA@@BAB@@BB@@@@B@AB@@@@B@@@@A@@@A@@@@@@BB@A@@@@@@@A@B@AB@@@@@@@@@BB@BB@@BA@@B@BAB@BB@@@BCB@BAB@@A@@B@@B@@@@@B@@@@@B@@@@A@@DA@A@@D@@B@@@@A@@BBB@@@B@@@BA@@@AB@@@BBA@@B@@@BAB@@BB@BA@@B@@@DA@@B@@@@@B@@@@B@@A@ABB@AB@@BB@@@@@@@@@@@@@@@B@B@@B@@@@BB@DB@@@@@A@@B@BA@@@@B@AB@@B@B@@@@@@BB@@@@@AB@@B@@@BB@@@@@@A@A@AB@B@B@B@@A@@@@B@@B@BBBB@@@@@D@B@@@BAB@@@@@@AB@@@@@@BB@@B@B@@B@@@@B@@B@@@@B@@@@@@@@B@@@@A@@@A@A@B@@@@BBA@B@@@@@@A@@@@@@@@@@@@@@@A@@@@@BB@@@@B@@@@@@@@@BBA@@@@@@@@@A@@@@@A@@A@@@BA@@@@@BB@@@@@@A@@B@@@@D@A@BBB@BA@@@AB@@A@ABA@@@AB@@@BAD@D@D@BBB@BB@B@B@@B@@@@@@BB@B@@@FBB@@@@@B@@B@@@@@B@@@@@B@@BB@@@@AB@@@@B@@@B@B@@BB@@@@@B@D@BB@@B@@B@@@BB@@@D@@FBB@@@B@@@@@@@@B@BA@@BA@@B@@@B@@@BB@@@@@@@BB@@@@@@B@@@@@@BB@@@@@@DBBBBF@DB@ABB@D@@@B@@@BA@@B@B@@@B@@@D@B@BADAF@BA@@B@B@@AB@@@DABAB@B@@B@@B@A@B@AB@@DABBB@@@@B@@@@@@@@@@@@@@A@@B@@C@@BA@@@D@@@BA@@B@B@@@@@@B@BAB@B@@BA@@@@@A@@@@@@B@BA@B@@B@@@BB@@@@@@B@@A@@@IB@@@D@B@@AD@@@DJBD@@BBBBBD@@DFB@BBFDBD@@BBBBBB@@B@BAFAHAB@DAHCA@GI@@@A@@AA@@AAAC@@AAAACCA@@@BA@@A@B@@@@@B@@A@@B@@@CCHC@@B@@@AA@@@@CE@@CCEEBAB@BABA@@B@BC@@@@@@@@@@@A@@@AA@BAA@A@@AAB@A@@@@A@@@@@A@@A@@A@@@@@@@A@@@@@@@@@@A@@A@@@A@A@A@@@@@A@@A@@A@@A@@@A@@@ABA@A@@BA@@@@@AB@@AB@@@BA@A@@@A@@@A@@@@@@@A@A@@@A@ABA@@@@@@@CAA@ABC@A@@@@@@@A@@B@@@@AA@@A@A@AB@AA@@BA@@@@@A@A@A@@@@@@@@BA@@@@BA@@B@@@@AB@@@B@B@@@D@BAB@@@B@B@@@BAB@BA@@B@@@B@@AB@@@B@@@@A@@@@@@@@@A@@@BBA@@A@@@@@@A@A@@@@@@@@@@A@@@@@@@@A@@A@@@BA@@@@@@A@@@AA@@@@B@@AA@@@A@@@B@@@AA@@B@@@A@@A@@@@B@@@@A@@@@@@@@AB@@@A@@A@@@B@@@@@@A@@A@@@@@@@@@@@@AA@@@@@A@@A@@A@@@@@@@@@@@@AA@@B@A@@@A@@@BA@@A@@@@@@@@@@@@A@@A@@B@@A@@@@A@@@@A@@@@@@@@@@@@@A@@@@@@@A@@A@@@@@@A@@@@A@B@@@A@@@@@AA@@@@A@@@BA@@A@@@@@@A@@@@@@@@A@@@@A@A@@B@@@A@@@@@@A@@A@@@BAAA@@@A@@A@@@@@AA@@@@@@A@@@@AA@@@AA@@@@A@@@@A@@@@A@@A@@A@@@B@@@A@@A@@@@A@@@@A@@@@@@@A@@@@@AA@@AA@@@@@@@@AA@@@A@@@@@@A@@A@@@A@@@@@@A@@@@@@@@A@@A@@@@A@@@AA@@@@@AA@@@@AA@@@@@@A@@@@@@A@@@@@A@@A@@@@@@@@@@A@@@@A@@@@A@@@@A@@@@@@CA@@@@@@@@A@@@@@@A@@A@AAEA@AA@AAAACAACCAAC@@A@@A@@C@AAAAA@@@@@A@@B@@@B@@@B@@AB@@@@A@@BA@AB@@@@@BA@@B@@@@@BA@AA@@A@@@AA@@A@A@@@CBA@@AA@@@A@AA@@AA@@AA@B@A@AA@@@A@@@A@ACA@A@@AAC@@@A@@@AACAA@@A@AA@@A@@@A@AA@@@@@B@@A@AD@B@@@B@@A@@B@@AB@@@@@B@A@BA@@@@BC@@@@@AB@@@@@@A@@@@@@@@@C@@@@BA@A@@@@BA@@@@@AB@@A@AB@@A@@B@@@@ABA@B@AB@@@@@BA@A@AB@BAB@BAB@@A@@B@B@@@B@@@BA@@B@@AB@B@@@@@@AB@@@B@@AB@B@B@@@DA@@B@@@@@B@@@@@@@B@@@B@BA@@@@@A@@@AC@AAACACA@@@@A@@@@AA@@@@@@@@A@A@@A@@@A@@@A@@@@@@A@@@ABA@@@@@A@@AA@@@@A@@@@CBA@@A@@@@B@@AB@@@@A@AD@@"],encodeOffsets:[[104100,37377]]}},{type:"Feature",id:"630123",properties:{name:"湟源县",cp:[101.256464,36.682426],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@AB@BADC@@BA@A@ABCB@HAF@D@@@B@F@@@BBBBBB@@FBB@BAB@@@D@DA@ABABA@ABAB@FABADCBA@@@A@A@@AAA@AAA@@ACAA@AA@@@@BABA@AAC@C@ABADC@A@AB@@@DAB@B@BAD@FB@@BAB@DAB@D@D@B@DCBA@A@@DAB@D@D@DBB@B@F@BAB@B@@@BBBAB@DAB@BABA@@@AB@BABCDE@@A@C@MGCACCEE@AECGKGQK]AECAOAIAGAGEGCSCAAACBKAC@CACACCAG@EAG@G@CAAAAE@CEECEA@C@EHGBG@@@EAMFICAHAJABEBABG@KBA@E@GDGBABA@@DAFABGBEDSHGFCJCF@@CFBDBD@DCDADCFADAFEDIBKBODGBKDQDEBCBEDCDGHGHCHADEHAFCDEHEHCD@FBDBF@D@@@DADCDGBE@G@C@A@GFA@CDCBABGBCBCHAH@DDDFD@BCDEDCFAFAFBF@F@F@BCDGHIFCDA@AFABEDI@CBGFA@ADAD@@@BC@IBIBCBCDA@CBCBEBAF@DCBEDEBCD@DBFFDBDBBABCBCBABBF@BGFADAFAB@D@FCBGDEDAD@DFJDHEBCB@DDD@D@FBDBDDF@F@BADEHCLAJEJ@FBD@DCBCBGBCF@FADADEDGHIHIFGDEBCDCJCFCHCN@H@F@DBBHHFD@D@@EFGF@@GHADAFADA@CDGDCBEDCF@@CF@DCDEDEDAD@FBH@DBDB@DABAB@@BAD@B@FBDADAHAF@DDD@FADCF@BADGJGHEF@B@BBBADEF@FBDBBBBJBLDJBFDDDFFHBHDHDF@H@HDB@FDDBHDFB@@D@DEFEFAD@FABAFCHABCFEDABAHEHCFCB@DAH@DADCBCDCDEDCB@@@B@F@LBJDD@B@FFJDHBFDHFHDD@NBJ@JBB@DBFDFFHHDDBBBDHFHFDFFFFDJFB@FBFDHDFD@D@DFFHDBDFFHDJDFBFBB@HBBBDDB@@B@DBB@@BBB@FDD@BBLAB@@@BAB@B@@ADABA@@BA@@B@B@B@B@@@B@@ABAFADABA@AB@BA@ADA@@BABCBC@@B@BA@@BA@@FABABA@@BA@@@@@C@@BABABAB@BA@@@A@AB@BAB@DB@ADADABA@@F@D@FAB@BA@ABAF@DADAB@@@@@D@B@@@B@@@FAB@DABC@@BAB@BADAB@D@@@BA@ABA@@DA@@BA@@@A@@@@@A@A@ABABCBABGB@BABAB@BA@@BI@@DAB@@AB@BA@@@EBA@ABA@@D@BAD@BABABA@@B@B@B@B@B@BABAB@B@B@B@BABABA@@J@B@BABABABA@@B@B@@AB@B@@@B@DDB@D@DB@@BA@@FAFAB@@@B@@@BBBBB@BAJ@H@H@@@DA@AD@B@D@BAB@B@DB@@B@D@D@B@BA@@@@B@B@@@B@@@@@B@B@@BBAB@@@DGB@B@B@@@BCD@DAD@BB@AB@B@@A@@BCDA@AB@BAB@@@@@BAB@@@@@@A@AAA@A@@CAK@A@A@@@AC@ACA@@AA@A@A@A@EAC@CECA@A@C@ABA@@@A@ABB@A@@B@@A@@@CBE@C@C@@@@ADE@@B@BADA@A@@BC@AB@@AB@B@BC@A@@@A@A@A@@@ABA@ABA@@@A@AB@AA@A@AA@@A@A@A@A@A@@@AAC@@BA@A@A@@AAAA@@@@@@BAB@@@BA@@@AB@@CB@@A@@F@@@BB@@@@B@B@@AB@@@BA@@@@@@B@DAB@B@B@BA@@@@B@B@@@B@@@BA@@@A@@B@BABAB@B@B@B@B@@@@A@@A@AA@@A@@@@A@@DA@@@@@A@@AB@@AAEAA@@@@A@@BC@@@@@A@@@A@A@A@@@@B@@@@A@@@@@@BAB@@@@A@@@ABA@@BA@A@A@@@A@ABABA@@B@B@B@@BB@@BAB@@BB@@@BB@B@DAD@B@@@BA@@BAB@@BB@@AB@@@AAA@@A@@A@@@@A@@@A@@@A@@A@@@A@@@C@AA@@AAA@@@A@C@CAA@EBAA@B@@ABA@A@@BA@@@C@@@A@A@A@@@AAA@ABC@@@A@A@A@@@AAA@@@ABADABCBAB@@A@A@CA@@AAE@@A@@A@ABA@AB@@EBABE@@@ABA@@BA@@BAA@@A@AA@@BA@@AACACCAAAAA@@@@@@A@AAA@AA@ACA@@@@A@C@ABC@@@A@A@@@A@AB@@CBABA@AB@BAB@BADA@@DCB@@A@@B@B@BA@@@@A@A@A@@@AB@@A@ABC@A@@@@AA@A@@@AAAA@@A@A@@@AAA@AC@AA@A@@@GGCAA@AA@ACAAA@A@@AA@AA@@A@@@A@@@AA@AA@A@@AAAA@A@AAAA@A@A@A@@A@@@A@AAA@@AAA@A@A@AAA@A@EBA@A@@@A@ACAC@A@A@ABCBA@A@@@A@@@AC@AA@A@A@A@@CAAAAA@CAA@C@EAA@A@@@A@E@A@AAA@@@ABA@ABA@AAA@AA@CAAA@A@E@AAC@@@A@CBABC@@@A@AA@@AAA@CBC@A@AACAA@@BC@A@AA@@A@AAA@AAC@@@A@CBA@AAA@ABAFCFC@@@A@CCC@@@@@ABA@A@A@A@AAAAC@AAA@ABABE@C@@AEACAA@AACAA@A@A@A@ABCACAAAAAA@@A@@@AA@@AA@CAA@@@@@ABADCDABA@A@@@A@AAA@@@A@A@@AACA@@AA@AA@"],encodeOffsets:[[103718,37324]]}},{type:"Feature",id:"630121",properties:{name:"大通回族土族自治县",cp:[101.685643,36.926954],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AA@@AAA@@A@A@A@A@AA@@C@@@@A@@@@@@@@AA@@@@@A@@A@@A@@@@AB@@@@@@@@AB@@@@A@@@A@CBC@A@A@A@A@CA@@AAA@A@CBA@A@@@@B@@AAA@@@A@AA@@A@@AAA@@AB@A@@AA@@@@A@A@@BAB@@@@A@@@@@A@A@E@CAA@A@@@@@AB@D@B@D@@@B@B@@A@ABA@A@@B@@@D@@CB@B@@@B@B@BA@@@AB@@@@A@@@A@@@A@A@A@@BA@@B@@CDA@@BA@ABC@@@A@@BA@@@A@@B@@A@A@@@@@AA@@@@AB@@A@A@A@@@@@@@CB@@A@AAA@AAA@A@ADAB@BEBC@@BA@@@AA@@A@@AABA@A@A@@AAABA@@A@@@CBCBAB@@@B@@CD@@ABABA@@B@@@@@B@@@@@BA@@@@BAB@@BB@@AB@@@B@@A@@BA@@@@BBBA@AB@B@@@@@@A@AB@@AD@@@@@B@B@B@@BB@@@BBBB@BB@@@@B@@@BB@@@@@@@D@@@@BBA@@@BB@@@@@B@BA@@@@@@B@@AF@@AB@@@@ABA@@@BB@@@BA@AB@@A@@@@B@@@@AB@@BB@@@@@B@@@@@B@@@B@@ABBBA@BBA@@@A@@@@B@@@BBB@BDB@@@B@B@B@@@@A@@@@B@@@B@@ABA@@@EB@@@B@BABA@@@@DAB@B@@@BABA@A@@@AB@@A@@@CA@@A@AA@@C@@BABABABA@@BAB@D@BA@AB@@@B@BBBBB@@ADCBCBABCBA@@BCFAD@B@DBB@D@@AB@@ABCDC@EBA@@@C@A@E@@BA@@BAB@B@B@@AB@@BFAB@BABA@@B@@@B@@AB@@CD@@GBA@A@A@@@A@CDCBADGFCFAB@@A@A@C@A@A@A@@A@AAAA@AAEAA@CB@@A@C@C@AAABCBAB@@KAA@A@@FAFCBCBA@@AA@CAC@AAABABCDADBBAB@BC@@@@@CAA@C@@@A@@BAD@B@BEDCDC@@@A@CDAD@BB@@B@@CAA@C@A@A@CBABA@K@A@ABABCFABEBCB@B@BEDADABC@CBADADADAFCDA@ABEFAB@@@BA@A@IAABC@CDEBA@CDA@A@AB@@AD@BAD@B@@@@AB@@A@@B@@C@ABA@A@A@EBC@@@@@ADADAD@BCBAB@B@BEFABA@E@CBEBEDC@A@C@CBA@GB@@ABCD@@CB@@ADABEDA@@B@BABADCBA@@B@B@@AB@B@@BD@@@@EDC@AB@@@@A@@@CAC@ABABEDA@@BAB@@A@AB@BG@@DCFCDCDA@EBC@CBABABABABABCBGBEBABA@@BADADABAB@@BB@BBBABABCDGFEBAB@BB@@BBB@B@B@BCBCHADADCB@@E@C@G@G@GAKBEBEBEBEDCDEFABCBE@CBCDADADCFCDADAF@BAFABC@ABCBABAB@D@@@B@D@DAHAFAF@D@BAJ@DADEFCDCDEHCDADCDADAHAFAJABADABEBIDEBIBIDKFEBGBEDEBCBEBEBGDEBGBEAC@CAECCCCACCCAC@CAA@C@EBEDIDIFEBADABA@@DB@@B@BCBEBEBABEFKJGDI@I@G@KCEAGDCFEDE@EAGKAGEAIBGFADKBABCFADCBEBC@@BADABCBAF@HAD@DBFBDAFCBCDGDMH@B@NBJ@FEJCJCHCBG@EDAFADBD@HCD@DEDCDA@CBGDEDADEBC@A@ABA@EDCBAB@B@B@@DBBBB@BBBBBBBB@F@D@D@D@B@DBBDBBB@BBB@@@BAB@B@B@B@D@D@F@BBBB@BBDBABABABAB@DAFBDAH@D@D@F@DAD@BCBAD@@A@@BBB@BBBBBDDBBDBBBDDDBDBB@F@F@D@B@DA@AB@BCB@@ABAB@DBDBBBDBDBB@@D@DAD@BB@BDDBBBDDDB@DB@@BBBB@DBD@DBD@BBDBBB@B@BADBB@@@BBBFBBBBBBD@@BBB@B@DBD@B@@ADADCBABAD@DAH@F@B@B@@@@A@@A@EACCCCACAE@A@A@A@@BAB@DAHADAD@B@DA@ADEBABEBADCDEDADAFAD@FBF@B@BBBADAFCDCJCDC@@@A@A@@@A@A@A@@BABADAD@HCJCJCDALCFAFCHCD@B@DBDBFBDBB@D@B@D@BABADA@ABAD@BAB@@C@CAABGFEB@NAPBRBJ@FAHKJCH@NDF@DBFDHBBBB@BAHCFAHCBAB@BBF@B@B@BCBAB@B@DAFBFBB@D@D@D@F@H@F@F@D@DBHBF@B@B@DE@ADEBABADAF@HBHBBBF@DADAFAFCD@FAB@D@BBDFDDBBDBB@D@BBDB@BBD@BBBB@B@BAD@B@BBDBDBDBB@B@D@B@BABCBABAB@D@BAHAHAFCFEDAB@@ADBF@BABCBEDCBCDEDCBAFAF@D@D@D@D@HCDEBAD@D@F@F@DAH@H@H@H@J@HBF@DBBBBB@@D@HBHAF@D@BA@@@@@@F@F@FAF@DADAB@@@DAB@DBD@D@D@BA@@DCDCBAD@DAF@F@F@D@DBDBBBH@DBD@D@FAFABAB@DEDADCBAB@B@BBFBBBDB@@B@DBB@DAB@BAB@BCDADABADAFADAFADAD@BADBD@D@@@D@DADADABA@C@A@C@C@CACAG@CACAA@C@A@A@CDADCBCFEFCBE@AACAAAA@A@@@AB@BAB@F@D@HAD@DBF@DBDBBBDBBBDBBABA@ABC@CBA@ADADAHAFAHAD@DADCB@BAD@FAD@F@BBH@D@BAB@DABCDCDABCDCBCBABABABBB@DBBBDBFBFBB@F@DAHADADAH@F@FAD@D@D@FBD@FBD@BC@A@@BABABADAD@D@DAB@BAFCFAHAFADAFAJA@@BAF@B@D@DBDDDBDD@B@D@D@BB@FBDBF@B@D@BA@A@CBA@@DAHCDAB@@@@A@AAA@A@AB@DAJADAB@@C@CACAA@A@A@ABABAF@F@D@H@JAJ@F@JBF@F@D@BAFCDCDCBABCBCBA@C@A@C@@DA@@HAHCFAFABAB@BCDABCDAHCFCHCFADAFAJ@F@HAH@DAD@BADADADADADCDCDCBGBC@ABCBAFCFCHCFAFCHAHAAA@AAAAA@AACAA@E@C@C@A@A@AAA@@A@@@CBCBEBA@CBA@A@A@CACAA@AAACAA@AAAACAA@E@C@E@C@GBC@ABGBEBCBCBCDEBC@C@E@GAGACAEAEAE@A@AAEACAC@C@A@CAC@C@A@@AAA@@@A@@@C@A@CBA@C@@@CAACAAAACCCAAAAAEACAA@AAA@@A@A@@DCAC@CAAAACCCCA@@@AAECA@ACAAAEAA@AAA@CBC@G@@EECCEECG@AAEDEHCFC@@@@FEBGBAFCB@BCBG@GEGCACCAA@E@E@AECAAACBE@E@@E@G@GAKEGCCC@ADA@ACE@CAA@CAACACCC@AAAEDEFIBE@CACA@CG@E@E@E@ECG@@@I@G@@@C@@@E@ECCCEAC@EBE@E@ABADABEBE@E@CAEAEAA@@ECCE@CAAEAAACAAE@CBADCFCDADE@C@AAEAGAEBCBC@CECECAC@A@E@A@CACCE@A@C@EBG@EAEAECEA@GGCC@A@ABEBABAF@F@BC@@ACAACCA@A@@@A@ABC@AA@@A@@@A@@@C@AA@@@@A@@@@BAA@@@@A@@@@@@@@BAD@@@@A@AAB@A@@AA@A@A@A@@B@@@B@B@BBD@BBDBJ@D@B@BBB@@@B@BB@AB@@@B@@BB@B@D@H@@@B@B@B@BDB@B@@C@A@@@A@@BC@@@@B@@A@@@C@@@@@@B@@@@A@A@A@C@A@@@AA@@@@A@A@@@@@@@A@A@@@AA@@A@@@@@A@@AA@A@@@AA@@C@@BA@A@AB@@@@A@A@@@A@@@ABA@@@A@AB@@A@@@@@@@@@AB@@AA@@A@A@@A@@A@@@A@A@A@BB@@AD@@CDA@@@A@C@@@AAA@CAC@A@AAA@@@A@A@@AA@A@@BA@A@@B@@@BAB@B@BA@@B@@@BA@AD@B@@@@A@@@@BA@@B@@@@@BB@@B@@@@@B@@@BABA@@@A@"],encodeOffsets:[[104138,37658]]}},{type:"Feature",id:"630122",properties:{name:"湟中县",cp:[101.571667,36.500879],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAAB@BABABABAACCAC@BA@@@@@AB@B@@EA@A@A@DCBAA@BAA@@@@AB@@@AA@@@AA@A@BAB@@AACBAF@B@BA@@A@A@@@CCA@A@B@@AA@AAA@A@A@@A@@@@A@@@@@B@A@@@@B@@@@BB@@A@@@B@@BB@@B@@@@A@@@A@@@A@@@A@@B@@A@AB@@A@@@@D@B@BA@@@@@CA@A@AA@@@A@AB@@@@AB@@@@@BBB@@BBCA@B@@@@@B@@@@@@A@@B@@B@BB@BA@@@A@@B@@@@B@@B@@A@A@@@@@@BA@@@@@AB@@@BAB@@@@A@@@@@@AA@@@@@A@A@@@BA@A@@@A@@AB@@AA@@A@A@@@@@@@@@@A@@A@A@@AA@@B@@@@A@@@A@@@@@@@A@@@@BAA@@@BABA@@AC@A@C@@@A@A@A@@B@@@AA@@@A@C@@@@@A@@@@@A@@@@BC@@@@A@@A@A@@@@@A@AB@AA@AA@@@@AA@@@AAA@A@@B@@@@@AA@@A@@@CBA@E@A@@@A@@BEFC@ABABA@CBA@ABA@ABCBA@A@ABA@@B@B@B@@AB@@@BAB@DA@AB@B@B@@AB@A@AA@BAAA@A@AC@@@A@@B@@AA@@ABC@ABAB@@AB@AAB@@@@A@BA@@BA@@AA@@@@A@@@A@A@A@@@@A@@@@@@BAB@BAB@@ABA@A@@@A@@B@@A@@@@AA@@@AA@@@@@AA@@AAA@@A@@@AA@@AB@@ABA@@CA@@@@BCBA@@@@A@A@@AA@@@GA@@ABA@@@@A@A@@@@@@@A@@@@@@@@A@A@@@A@@@A@A@@@A@@AB@@A@@A@A@A@A@@@B@@@@B@@AB@BCBAA@@A@@@BB@@@@A@AB@@@@B@D@@@@BA@@@@@@@@@A@@@@@@@@B@@@@@@AB@@@@B@@@@@A@@BA@@@ADA@@@@@A@@B@@@@A@@B@A@BA@@@@BA@A@@BAB@@@@@@A@@@@B@@@@C@A@@@@AA@@@@AA@@@@BA@@@AB@BABA@@@@@AB@@@@@@@@AB@@@@A@@BAB@@@@A@@B@@@@@B@@A@B@A@@@@AAA@@@A@@AA@@@A@A@AAA@A@A@@@@A@@@@AA@AB@@@@A@@@A@@@A@@@A@@@@@A@@@@@A@A@@@@BAA@@BA@@A@@@@A@A@@@A@@A@@@@@A@@@@@@@@A@@BA@@BAB@@@B@@@B@BA@@BABA@AB@BCB@@A@@@@@AB@@GB@@C@@B@@@B@@@B@@@@@@@@AAC@@@@B@@@@AAAA@@A@@@@@A@@@A@@@C@A@AA@@@AA@@@CBE@@@A@@@A@A@AB@B@@A@@B@@@@AB@AA@@@A@@@@@A@@@@@A@@DAD@F@F@FAB@@@BA@@@@BAB@B@B@@@B@D@BAFA@@B@@@D@B@D@DBB@B@BA@@B@@@D@F@DBD@@@@AB@@@B@B@@BB@B@H@B@D@D@D@@@B@@@D@A@@@@AE@AA@@C@AAB@@A@@@@@@AA@@@@@@@@A@A@AAA@@@@@B@B@BADBBBB@B@@@B@B@@@B@BBBAB@DAB@BA@@BBDA@@@AB@D@FB@@BB@@B@B@@ABC@@BABAB@FABBB@@@@@@@@AA@@@@AAA@@@ACA@AA@@@@@AAC@@AA@A@@AA@@@AA@@@A@@@AA@@@@A@@@@@@@A@A@@A@@@BCB@@ABA@@@ABCBA@@@A@@@C@A@@A@@BA@A@@@@@@A@@BA@A@AAC@A@@@@BABAB@B@B@@@B@@@BAD@B@B@@@@@@@HAFAB@@@@@B@@@@@@B@@@BB@@A@@BDB@@@@@DA@@B@@@@@B@B@@@@@@AA@AAB@C@@@@@@AB@@@@@@@AA@@@@B@@A@@@BB@@@@@B@@@@@@@@@B@AA@@@@@@@@A@@@@@AA@@@@B@@@@@@@@@@@@@@@B@@@@@@@@AAB@A@@A@B@B@@@B@@@@@@A@@@@@@A@@@@@@AA@@@@@@AA@A@@@AA@@@@@@BA@@@@@@BA@A@@@A@C@@@@AAAAA@@@@A@@B@@@@A@A@ABAB@B@@@@@@@AA@@A@@@BA@@@@A@@A@@@@A@A@@@BAA@@@@@ABA@@@@B@@@@CAA@@A@@A@@@@A@A@@@@@@@@@@@@@@AA@@BAA@BAB@@@@A@@A@@@@@A@@@CB@@A@@@ABA@@AA@AB@@A@@@AB@A@@BA@@B@@A@@@A@@AA@AB@@@@@@AC@@@@BCB@@@B@@A@@@@@A@@@@@A@@@@AB@@@BA@ADA@A@@AAA@ABA@@@AB@@AAA@@A@@@@@@@@BAA@B@@@@@@@B@A@@A@@@@B@@@B@@@@@@A@@A@@B@A@@A@@AA@AB@@AB@@@@AA@A@@A@@@@@@@A@@@A@BAAABA@@@A@@@A@@@A@@@@@A@@@AB@@A@@@@@AA@@@CB@A@@AA@@A@A@@@@A@@@AAB@A@@@AA@AAB@@A@@@A@@A@@@@A@@@A@@@A@@A@@@@@@@A@@@AB@@@@A@@A@A@@@@AA@A@AB@@@@@@AA@@@A@@BA@@@A@@@@A@@BA@@@@@AA@@A@A@@CA@A@@@@@AC@@@AB@@@BA@@B@BBB@BA@AB@@AA@@@@@A@A@@@@@AA@@@A@@@ABCA@@B@@@@A@@@@D@@@@A@@@@B@@@@A@@AA@@@@BA@A@@@@B@A@@@@A@A@A@@@@B@@A@@A@@@A@AB@@A@AB@D@@@C@@@A@@@@@A@@B@@A@A@@@@@AB@B@@@B@BA@BB@@AB@@@@@B@@@@BB@@@@@@AB@@@@@B@DA@B@@@@@@B@@@@@B@A@BA@@B@@@B@@BBB@@DABB@@AB@@BDBAB@B@@@AA@@B@@BBB@@@@@@B@@BB@@@@BA@@B@@@@B@@BB@@B@@BBB@BB@BDDBBBBDD@@B@[cCEEGBABA@ABAFCTI@@@@BB@@@@@B@@BB@@@B@@@@@@BA@B@@@@@@@@B@B@B@@@@@@@@BB@@@@B@@@@@B@@@@B@@@@@BCB@@@@@BA@@@A@@B@@@AB@D@@B@@@@@BB@@@B@@@@AB@B@@@B@@@@B@@@B@@@B@@@@B@B@@@@@@B@@B@@B@@@@@DBDBBB@BBD@@B@@@@@B@@A@A@@@A@@@@@@@A@@@@@AB@@C@@@A@ABA@@@A@@BA@@@@@@@ABA@@@AB@@A@@@A@@@A@AB@@@BA@ABA@ABAB@B@@A@@@@BAA@B@BA@@@@@AB@@@BAB@@@BA@@@@B@@A@@B@B@@A@@D@@@@@@@@@B@@@@@@@BA@@@@D@@A@@B@@A@B@A@@@@BA@@@AB@@@@A@@@ABCB@@@@A@@@@BBB@@@B@@@BBB@@@BBBD@B@@@B@@BD@BB@B@BDB@@@B@@@B@@B@B@ABB@@BB@@BBB@@@B@@BB@DA@@B@B@@@BB@@B@@@BBB@@A@@@@@AB@@A@@@@BAB@@AB@@@@@BA@@@A@@@A@@@AB@@@@@B@BBBBD@@@@BB@@@BDDBBDDBBBBBB@@BFBBBB@@@@B@@@@B@@@@@@@@@DB@@@@@@@B@@B@@@@@@B@@B@@@@@@@@@@@@BB@@@@@B@@@@@@@@B@@@@B@@B@@B@@B@@@@BB@@B@@@@@@BB@@@@@@@@@@B@@@@B@@@B@@@@B@@@@B@@@B@@B@@@@@@B@@BB@@B@@@@@B@@@@@@@B@@B@@@@@@BB@@@A@@@B@@@@BB@@@@@@B@@B@@@@@BB@@B@@B@@B@@@@@@@BB@@@@B@@@@B@@BBAB@@B@@@@B@@@@B@@@A@@@@B@B@@B@@@@@@@@@@B@@@@B@@@AB@@B@@@@@BB@@@@B@@@A@B@@@@@@B@@@@B@@@@B@@@@@@@B@@@@@@@@@@@@@B@@B@@@@@@BA@@@@BB@@@@@@@@@@@@@@BB@@A@@@B@@@B@AB@@B@@@@@@@@@@B@@@@BB@@@@@B@@B@@@@@@@@@@B@@@@B@@@@A@@@B@@@@B@@BA@@@@@@@@@B@@A@@@@@@BB@@@A@@@BB@@A@@@B@@@B@@BA@@@@@BB@@B@@@@AB@B@@@B@B@@@BA@@B@B@@AD@BAB@@CB@@A@@@AB@B@B@@@D@BB@@@@BB@@@B@B@@BB@BB@BB@@BA@@BCBC@@@@@A@A@AB@@@@@BABA@CB@BA@@@B@@@@B@@@@@@B@@A@@B@@BB@BB@B@B@@B@@B@@@@@@@@A@@A@@@@@B@BABAB@BA@@BA@B@ABBBA@BBA@@B@@@B@B@BD@@@@B@@@@BB@B@BBB@@@DABAB@H@F@D@@@BB@@B@BBB@D@@@BB@@@@@@B@BB@AD@D@B@@@@@@BBA@@@@D@B@@@F@@@B@B@B@B@H@A@G@I@FEHEBADE@A@@AEAC@EDEBEDGBABE@E@CECEC@AEACCAAACEEEGCAAAGECACC@ABC@ABABE@G@AACAAGCCBC@AA@@AAEECACCAE@@@AAG@G@EAG@A@G@E@A@G@A@E@C@E@@@EDCDC@CBEDEFGBCB@DE@CACCG@@CGEICG@ACEGKAACEIIEGCEEGCGAAAA@A@A@GBEAIAEAECCGCEC@C@AFCBE@@AACAG@I@K@I@M@I@G@A@CAEAGCC@IBGBEDGDGDEFGBCBEC@A@@@EACACAIBC@GCCGAI@M@A@GB@@BG@EAC@A@CCCCCAEAAAGCEAC@@IEICEAECCACACAGCEEAACCEAG@GFEDCDADEJEHCFEDADAFADBHAH@@ABCBG@EFABADC@CBCB@BCDCDEBI@I@GAGA@F@B@H@HDJ@HAH@H@H@DBF@DCDCBGDIBODIBE@ECICIAE@I@EBKAKBKD@@MFIDEBA@C@GBEBEBE@C@EDIBIDGFGFGBIBIBMBIBCBIFKJEFEDAF@F@@@BEBC@M@QBG@KBOFGDKDIDCD@B@DCDCBABA@@@CFADABA@@B@@ABABA@CBA@ABAA@@A@A@ABE@A@A@CAC@C@A@CB@@@BABCDA@C@C@A@CBA@AB@@EAC@ABA@A@CB@@A@@B@BCDAB@B@DBD@BABAB@@@@BBB@DB@BB@BBB@BB@@@B@B@@ABCDABEBA@AB@BABAB@BCBC@@@A@ABA@EA@@AAAAAA@@E@A@@@C@E@GBA@AD@B@BAB@@CDABA@@BBB@@B@@BBB@@DBBB@@@B@B@@BB@B@B@@@BABCBCDAB@B@@@@BB@DBB@@BB@@B@@@BBBBBBBDAD@B@B@B@BBBBD@BBBBDBF@@@DAFAB@BBB@BBDBB@B@B@B@BAB@B@@@@DD@D@B@@EDEDAB@BBB@BAB@D@B@@BD@BBB@B@BB@@B@BAD@@BBBD@B@BAD@DBB@BB@@B@B@@ADAB@D@B@@BD@B@F@BBBDBB@@BBB@BAB@BAB@B@@BB@B@B@F@B@@@BBB@F@DBB@DBBBBDB@@@B@B@BBBD@@B@@@B@@@BABAD@B@B@BBDBDB@@@B@B@FAB@B@BBB@B@B@BB@@BB@B@B@@@BB@B@B@B@BB@B@BBBBB@@@BBBB@@B@@@B@@@BB@@BBB@@@BBBDB@BBBB@DBHH@@B@B@@BBDB@BB@@B@B@@@BBBB@@B@B@@B@@B@D@BAB@@@BA@@B@B@B@@@@@ABA@A@@@@BA@CD@@CBABA@ABA@@BABAB@DA@@B@B@@@B@B@@AD@B@D@B@@B@BDB@@BBB@B@B@@@@B@BBBBDDDBBB@@AB@@BBB@@@BB@AB@@AB@BA@@F@BAFA@@BAB@BAB@@@@BF@BB@@DBB@B@@@BADABABCBA@@B@BB@@B@B@B@@@D@BAB@BB@@B@B@B@@@D@@@B@@AB@B@BA@@@ABBFAB@DBD@B@@@B@BB@@BBD@@@B@@@B@@@@B@@@B@@@B@@B@@@@BB@BB@@A@@BA@@AA@AB@@AB@@A@C@CBA@A@@A@@AA@@BA@AA@@AA@A@A@@@ABAB@B@B@@@B@BAB@@AB@B@@@B@@A@AB@@@@@@@B@@A@@@@@@B@B@B@@@B@@@@AD@@@B@@B@FBBB@@BA@@@B@@@@CB@@@B@@B@@@BBB@@@@B@@A@A@A@A@A@ABABA@@@@B@@AB@@A@@@A@A@@@@@ABA@A@A@CBA@@@@@@@AB@@A@@BA@A@@@@@AA@@E@@@@BA@@DA@@B@@AB@@A@AB@@@@@@BBBB@@@B@BAB@@BD@B@@@B@B@B@B@BB@@B@BBBA@@B@B@@AB@BAB@B@@@B@B@B@@@BADA@A@@BA@@BAD@@@BCBABA@@@CF@B@@D@D@F@DA@@B@@@@AB@A@BAB@@@B@BAD@B@B@FD@DBD@F@B@B@BBB@@DB@BBD@@B@B@L@DB@@@BBB@B@B@@@@A@AB@@@@A@ABA@@BCBAD@@@BA@A@@BAAC@CBC@AD@@A@A@A@CH@@A@AB@AA@A@@@@@A@@@A@A@@@@@ABA@C@C@A@@@CAA@A@ABC@A@C@@BCB@@G@G@I@ABA@AAAA@@A@@@A@EBEB@@AB@@CAC@A@CCA@@@A@A@@BA@A@@@ABABABABA@I@@@ABABABA@A@A@A@ABABA@A@A@A@A@@@ABABABC@ABC@@@AB@BAB@F@@ABA@@BA@CB@@AJ@@ABA@ABABA@AHABADAB@B@B@B@@@@@B@@AB@@CB@@AB@BAB@@C@A@CBABA@AB@@ADCBA@EB@@A@@@A@C@@@@@A@CBCBE@AB@BABA@EBC@E@@@ABCBCB@BCAA@ABA@@B@B@@ABA@ABABAB@@@D@@@@AB@@ABABEB@@AB@@ABA@@@ADADAB@@CB@BABA@@BABCBEBAB@BA@@@A@A@A@A@@@AB@@ABCB@BA@A@AB@@A@KBAAC@ECA@AA@@@@@B@@BFBFHLLPNFJDNBFBJBDBFFPPJHHFDDB@BA@@BA@@@A@@@@BAB@@@B@@@D@@@D@B@BA@@B@B@@A@@BA@@@@B@@@B@@@@@@@@A@@B@B@B@@@@@@@@@@@B@@@B@@@@BB@@@B@@@@@B@@@B@@A@@B@B@B@B@@@B@@@@AB@@@B@@@@@@A@@@@B@@@B@@@@@B@@@B@B@@@@@B@@@B@B@B@FCDIFAF@HFJ@BB@FFL@HAFBDFH@DCDEFAFAJAJAD@F@HCJGDEH@DBLBFFHDDDD@FBNBF@DAHABCFCFGLCFGLCJ@JCHCD@H@FEDAD@FFBDBDD@D@@H@@ABAB@@@BA@AB@FCBABAD@DB@@B@@@@@BAD@FC@@@@AC@@@ABA@@@A@AB@DABCBA@A@AB@FCBABC@@DA@@DCBA@@HAB@DAD@B@D@FCFADAF@B@BAFE@A@ABADA@ABCBCBC@@@@D@FAB@B@B@BAD@@@@AB@@@BA@@@@@ABC@ABC@@BAB@B@DCB@FADCD@BAJBB@B@@A@@BAFEBAB@DCBEBCBCBCDAD@BABCFC@A@ADAFABADEBABAB@L@B@BADAB@B@D@B@DB@@@AA@@ABCDCB@@@D@DCFC@A@ABC@AB@@@D@B@DB@@@@D@@ABAAABCDCBABABBD@DBB@@BB@DADABE@EB@B@LB@@BADABABBD@D@B@@@DAB@FBBBB@BB@B@BB@B@B@D@B@B@@@BADEHEBCDADCB@@@B@B@B@HA@@DC@@BA@@@A@@@AB@BA@ABAAE@@BA@@@A@ABA@AB@@AF@B@D@@@B@FAD@DCBA@@BA@@@CAA@C@ABCDE@AB@DABADADABC@@AAAA@A@A@@BAB@@A@CBA@AB@BABABA@AD@@@BBB@@@DB@@B@@@BA@@B@B@BA@A@@@ABA@C@@B@BA@A@A@@FA@@B@BA@@@A@@@A@@B@@@@@@A@A@A@@CA@AAA@A@@@A@@B@@@B@AAB@AABA@@@A@@@A@@@@@A@@@@AA@@BA@@@@@A@@B@@@BAB@@A@@AA@@B@BA@@@@BA@@BE@@@A@@@@B@@A@A@@@@AA@@B@AA@@@@@C@@@@@@AA@@A@@@@@AAA@AA@A@@AA@@@A@A@A@@@@BC@@BAB@@@@@@@@ABAB@AA@A@@B@@AB@@@@A@@BA@@AA@@BA@A@@B@@A@@@@@A@@@@@AB@BABA@@DC@@@A@@BADADA@@B@@@ABBB@BB@B@B@BA@BB@@@BB@@B@@AD@FA@ABABCB@B@BBB@BBB@@@DA@@@@@@B@B@B@@@BA@@@@BB@@@@B@B@@@@AB@@@B@@AB@@@D@BAB@@AB@DC@@@AB@@AB@B@B@@@B@@@B@@@@@BA@@B@@A@A@A@@@ADA@@@C@@@AB@B@BAB@@@@A@A@@@C@A@CBA@@@@B@B@DBF@B@B@@@@@B@@@@@BA@AB@B@@@@@BB@@@BBA@@BB@BB@@@BBB@@@B@BB@@@A@@B@B@DAB@B@BB@@DBB@B@B@B@D@DAB@@@B@@@@@BA@@@@@@@@BA@@@@@BB@@@@B@@@@BB@@@@@@@@@B@@D@@@BBB@B@B@B@@@BB@BB@@BB@B@@@B@BA@A@@@A@@@@@AA@@A@@@@@AB@@A@@B@@@@@@ABCB@@A@@@AB@@A@ABA@A@@@AB@B@@AB@B@@BB@B@@@B@BBB@D@DBB@BB@@D@B@@@B@DC@@BC@@AA@@@@@@@@A@@@@@AAA@A@@@@@A@@@A@AA@@@@@A@@BAACBA@G@@BAB@B@B@BAB@@@@A@@@@@A@C@A@AA@A@@@AAAG@A@@BA@CBABA@@@C@@BA@A@ABC@@BCBA@AB@BA@@BABA@A@ABABA@AA@A@C@A@A@"],encodeOffsets:[[104170,37590]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
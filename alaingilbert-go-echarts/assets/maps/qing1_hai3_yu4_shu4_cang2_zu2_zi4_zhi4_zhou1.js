@@ -0,0 +1,4 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("玉树藏族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"632701",properties:{name:"玉树市",cp:[97.008784,32.993106],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BABA@ABA@CBEBCAEAGAA@AAAAAEAC@GAG@@@E@A@C@CDABEFGDEDGDEBCBCAC@AAACCEACCCAAEG@@CCCCCCAAAA@A@CBCAE@A@@A@A@ABA@CBCDCBCDCFABCDABEBCBCBC@C@E@CBCBCBC@A@C@G@C@C@CAC@A@A@AAC@CEC@AAE@AAA@CBC@CBCBCDEDEBEBABGDCBA@AAAAAACAA@C@G@C@C@C@ABEBCDA@A@C@C@CAC@C@GBC@CAC@A@CBABCBCB@@CDC@CDADADAD@BABABCBABCBGACAAAA@AB@@@B@BBDBDDD@BB@DBB@B@D@@@B@BABAB@BBDBD@HA@@@B@@CFB@@@B@@B@@@DBB@@DDDBFFBB@@BD@D@B@D@@@BB@B@D@B@@@DB@BB@@B@B@B@@CD@B@@@BB@BB@B@@@BA@@B@@@D@B@@@@ABA@AB@B@BAB@@@@@B@@B@B@B@DA@BB@B@@B@@ABAB@@@B@B@F@@AB@@AB@@CAE@A@@B@BA@@B@@@BA@CB@@BBFBDAB@D@NDBAH@D@HBD@BBFDBBD@B@LAFABAL@B@B@D@DDFFBBBBDDDDBBDBBBFBJDNBFB@BBD@F@DBF@F@F@DBB@DBBAHCB@BAFAF@D@PAF@DCBA@C@A@C@A@CAC@KDEBC@E@KBI@E@CAE@CAEAA@ABIDABADCHADCBA@A@G@E@C@C@G@CBC@EDGHGDCDC@A@AAAAEKCECECC@C@EAC@AAAGG@@AIBCBC@A@AAAEACAAAAEEC@AIEAC@E@AACAAEAC@AACBA@ABCAABEAC@A@A@AB@@@B@D@DAB@@C@E@EBA@CBCDEBCBC@A@KAA@AB@@EFA@GBEDA@A@E@A@ABBB@B@BABMBAB@B@B@B@B@B@DMH@@ADDD@@DFDDFFFFDB@@BB@D@B@@@D@B@@DDBBBDBB@D@D@D@BABABAB@B@@@BBB@DABABCF@BAD@DDD@BDBB@BBBAD@B@FBBBFB@@BB@B@BABABCBGDABADAB@BBDDBBBDBBB@B@BABC@AAABADAD@@A@A@EBABAB@D@BBB@B@DADABCBA@CBAB@B@B@F@B@BADA@EDA@ABABBDBB@D@DCFABCBC@C@C@A@@B@FABADCBE@@@ABAB@B@BBB@@DB@B@@@BABADA@ABEBEBA@@BBBBBD@B@DBBBBB@@DB@@DAHBB@DA@@DDBFDDDDDBBBBB@BABCDABCBCDC@ABCBABEBKDA@CBADADAB@FABABED@@CDCFCDCFADCD@D@FAD@D@D@F@F@F@F@F@DCJADAD@BCFCHCDAFABCHCBCBG@EAC@EACCECCACCCAAAC@E@EA@@AA@EACAECAEAGAA@C@@BABGJCFEHCDABCHCFCDCBABEHGFABCECICGACCECCKIECKEDE@@DABA@@BA@A@AAA@A@@@A@AAAA@EGAA@BCBABCDAFAHABCFA@A@A@A@GAOBCBA@GA@@AC@@CCEEEC@@@E@C@ABCBCB@B@DE@@@A@C@AGIAAAC@ABA@@@ADA@@@C@@FCDEB@FEFGDEBA@@FADA@@DIBC@GAGBAAC@A@@ABADABCBA@C@C@A@EBA@CBIAA@A@A@A@EACDCB@BAB@BA@EBIBCD@BAB@@C@I@AB@@ADAFA@ECAAC@A@CDCBA@CCCAIEECCAA@C@GBGDKD@@@A@A@AAACAEAA@CCA@AACBE@CDCBA@@@AFAB@@A@CBA@AACBA@A@AB@B@@AB@BBD@@DH@@@B@B@B@BABA@AB@AA@CAC@ABA@ABABAD@DAHAFADCHEDCBAD@DABEDADCBC@ABC@CBCBEFCDAFCBGJEJABC@A@A@A@A@EDKFEFC@E@A@AAAACAAEACAAEAG@G@A@AAA@CCCAE@CAAAGIAAAAC@C@I@CCA@CCACEECCEIECC@E@CBA@DF@D@BEFCDCBGDABA@C@ABCDCBCDEFCDGHCFADAFADEDA@GDCBCBCBCBA@IAA@A@CDCDEDABAFEHCFEDABAF@HA@CFIJABEDEBA@@BFF@B@DABADCBC@ABABA@CFCDGBE@A@GJCDILEFEFABABE@EBAB@B@BBB@DBDAD@@ADABA@CBEB@@AB@F@BCDGHAFCFCL@D@D@DBBABE@CBABCDGLAFABABEBEDEDCBCBABC@E@CBE@E@E@EBEAE@G@G@EAEAGAEAE@CCE@E@K@GAE@CBGBE@EBCBCF@H@DBFDDDHDH@@ADAFBHBBBDDDBD@B@@EDCDA@@DBNBD@BFDDDDH@BBF@@@DAD@H@BBBDDBDABABEDAB@@@B@B@BABEFABCFCDCDCDABCD@BA@AD@B@BAB@@ABA@CBAFBB@BBBBDDBDBBB@@BB@B@BBBAFADABILSJEBCBABKKIEEEGCAEC@AAEEIECAEAA@AA@ABACEAAA@A@AA@@AAAAAAAA@AAA@@@CACAE@AAAAACCCAGAC@E@G@C@A@CBEDCBCDCDCBAB@BBD@B@DABABA@IDE@C@CBCB@BCBCBAB@BCFABCBCD@BA@@@@B@D@B@BB@@BBBAB@BA@@BEB@BCBCB@BADC@EBA@EBCAA@C@CBCBE@@@A@BBBBFDHJBBFHBBB@BBB@@B@@EBABABAB@B@@@BBBBF@B@B@@@BADCD@@@B@@DFBBD@BBB@FFBBB@@B@DDH@DBD@@AD@BAB@B@BBF@DDDBFBD@F@B@JBFA@CDEJCBE@A@AAA@A@A@C@A@A@CDA@@@ABABA@AB@@@@@BB@B@D@D@D@@@@BB@@B@@CF@B@@@B@BD@DDBBDDFFFDBBBB@BBBBBB@DBB@F@F@B@B@@BBB@B@D@BEHADAB@@CBAAABABADABCFCBCD@@@BBB@BB@DBDBH@B@BBB@BB@B@@@B@BA@ABABEBI@ABEB@@ABCFC@IB@@CAA@AAA@IBEBC@A@C@A@A@E@EBI@MDEBE@ABCBE@ABGHEBA@CAEEAAGBKDA@AB@BABK@M@IBABAD@DKDABCDAD@BKHAB@BBFBFHFBDABEDADAB@FBF@FADGFGLGHAF@DADA@CBABB@BBB@DFFBL@JBH@JBLAHCD@FCFBD@HDJHHHLHJBFDDHBFAFCDAHALGHAJCJEJAB@D@D@@FBDBFFBB@F@D@BABA@EBABAFIFABADAF@TCFEDG@G@IBEBABID@F@BGFIJKBMHAB@@BDABEFAFKJKNGDEHOTEHAD@DA@CBABDFBBDBBHAF@DCFCD@DABA@IBEDCDCFALGLADADCDE@IDG@E@GBCBEBCFCFCDGFCFCBCDAD@FBFBF@DBH@FAD@DADAH@DCDADADEBE@G@ECIAGACAGAECIEEBCBEDADADCFCDAFAH@DAFHBJ@H@DBFBJDFDDDDDBF@FEFGDGDGBEBGBGBGF@J@J@FCFCFEBIFGBGBCHAFCHAHELAHAHCFAFAFAFEFEDEDIDGBKFAH@D@DBDDJFBDBFDHDFDDB@H@D@HAD@FBHDDDBFBLAJAN@JAF@JCFAJEHEHAJ@HBFBHDJ@H@F@LALAJCJCFA@EFIDCDCDGNIDEFCB@D@HEDCFADAP@LCLGDAD@@@BBBBD@DBB@FAHCNAJ@BBB@DAVIDADBFBHDDDBDBBBBBD@@HHDJHFDLFF@D@BAJAFCJINBJDBDAFAJ@F@FAL@FAHBPBH@HDF@HBHBF@FAFALAJADAFCFAJEFAF@H@H@BDBD@HEFADAFADAFCFADAFAFCBAFCDCHEHCDAHADADAHBFDBDBB@FCDAFALCF@FANAFBRFJDHHJF@B@@BB@@BBD@DBBBFB@BB@BABA@AB@B@B@DDDBLDDDBBDDFHBBBBDBDBJHFFHHBDD@D@FCDCHEHCFEJCHAJADCBCBEDEHEDCDANEFAH@N@DADABCHAJEFAFAD@DBFBFBB@BAHALCF@F@D@BB@D@B@DADADADIFEHADCBGFABADAB@B@BCFAB@BA@A@ABABABAFCFEBADCBABID@@CB@B@B@@B@DDFDBDHHBBA@GDGFCBCDAF@D@J@DAFCF@D@D@D@DBBBBBBJ@BBDBDBFDDBFBFBDBHDFDFBDDHFFHBF@DBDDDFFDFBDBDDFFDDDFDDBFDF@H@HBFAFCF@FAFAJAF@FBDBDDDDDDFHDBFFFFHBFBDBDBDDDDBDBDDFBDDDFDHDBDDDBDDDFHBDDBDFBDBHFFBDBDDBBFDDBDBDDDDHBDBHBDDDBFDF@DBHAFADCHnOPM^BSoKW]QFO`GISHATJxARM@CDYsEQ_QDGzMHPD^cPRBB´wPQOeHSVNPYPCTLPDfH`KDGHKUaKGCCECOKViDCPKDOM_jIBGDICScBZ]RMP[LBC`TK^FJGAIWEHMR@JTL@DSIWHQAeFCTPLpXJBcXSEGUAAKVQJ[PGJWNDBjH@NO`RtQdG`Nt@RNG\\\\EXNHKQOJaRBF^^ANQUSAKJEdLba@CAG_GBaOSAW\\UIUHSAM@@AIbUN[PQbKRYzUbM^k\\SZaTkBABCBEBCACAECE@CBCBALCHANCJCHCDCDGBKBARBDAFAFEFEDEJCNGNEB@BA@CEI@@@@AABCBA@CBABA@A@AACAAGO@@BGBCBEBEBEBE@E@IDMFSBC@E@A@A@A@A@@B@BAD@B@BCB@BAD@B@JBFBD@DABADKBAB@@@BABBB@BBHDDBD@JANEHCB@B@BDJJB@BBB@D@DA@ABA@CDS@ABAD@VBJBD@BABC@@@A@CACAE@A@ADEBADABCD@BAH@J@H@D@B@B@D@@@B@@@DCBCCEAAAACCAA@@@@@ABADC@AB@@@FAHAHADC@CAAICAC@EFEFCBCDCBC@C@G@C@CBG@CCAE@G@GAAC@CBC@G@C@C@@BC@CACBCDCCE@CBG@CDCBABM@E@@BAFGBADCBCDCDGBGDEBEHGBCBEDADADC@A@EBCFCBADADCDCBCBIBC@A@CDC@C@CCCECAA@AHG@@DCJAFAHBDA@IBCJC@CFCDABADCDEFCHCDGDEBC@@@@B@@ABBB@B@DBB@B@DAB@@A@@@A@@@@A@@A@ABCBE@ABAB@BAB@BAD@D@BAB@BADABAB@@AB@B@B@@@B@F@F@FBFBFCDE@G@CBALGD@BBAD@BFDF@DADCDADE@E@EBCBABCHCF@H@FBHBBAHCFAD@D@F@HAD@BAD@@CBA@CBABAD@BBBDBDD@DDFBF@DAD@DC@ABC@C@A@C@CBA@C@C@CBABCBABCBABCBCDADCDADA@@@ADA@@@ABA@@DCB@B@B@@B@@B@BBD@DABABADC@ABADCDCFABADADABADAD@D@BADABA@A@A@A@@@A@@BADCLEBAB@D@HAB@FAF@F@DAD@BA@ABA@C@A@ABABABAD@FCD@FAFAFAD@DAHBDAB@DAB@BA@@@@A@AA@@AAA@A@@A@@A@@@A@C@@@@@A@@@A@@@@@A@@@A@@@A@A@A@A@A@CAC@A@@@A@@@C@A@A@ABA@A@A@A@A@@@ABA@@@A@ABCBABA@AB@@@@CBA@AB@@A@@@@@AAACA@@@@A@@A@@ACA@A@@A@@A@@GECCAA@@@@@@@@A@@@@@AB@@A@@@@@A@@@A@@@@@A@A@CBC@AB@@A@@@@@A@@@A@@@C@@@A@@BA@@@@@A@@@A@@@@@C@@@AB@@A@A@@@A@@@A@@BA@A@@@A@A@@@A@@@@@A@AB@@A@@@@@C@C@AB@AA@@@@@AAAAA@@A@@@@A@@A@@A@@@@@A@@@@@A@@A@@@@@@@@A@@@@@A@@@@@AB@@A@@BA@@@@B@@@@A@@@@BA@@@A@@@@BA@@@@@@@@@A@A@@@@@@@A@A@@@A@A@@@AB@@@AA@A@@@A@@@@@@@A@@BA@@@@@A@@@A@@@@B@@@@A@@@@@A@AB@@A@A@@@AB@@@@A@@@@B@@@@@@@@@B@@AB@@@@@@A@@@@@AA@@@A@@@@@ACCAA@@@@AA@@@@@@@@BA@@@@@A@@@A@A@@A@@A@@@@@@ABAB@B@BA@@@A@@@AB@@@@A@@@@@A@@A@@@@A@@@@@@@@@@@@@@BAB@@@@@@A@@@@@A@@@@@A@@@@@@AA@@@@@@@ABA@@BA@ABA@@@AB@@@@@B@@@@@BAB@@@B@@@@AB@@@@@@@@A@@@A@@@@@AA@@A@@@@A@@A@@@A@@@A@@@A@@@A@@@C@AB@@@@@@A@@@@@A@@@ABABABA@@@@@A@@@@@A@@@CBAB@@A@@@@@A@@@@@A@@@@@A@@A@@@@@A@@@@AA@@@@@@@A@@AA@@@@A@C@A@@@@@A@@A@@@@@@@@BA@@@@@@BA@@@@@@@A@@A@AA@@@@@A@@A@@@@@A@@@A@@@A@@@A@A@@@C@@@@@A@@@A@A@@@A@@@@@A@@@CBA@A@@@AB@@@@A@@@@B@@@@A@@B@@@@@@@B@@@B@@@@@@@@A@@@@@AB@@@@A@@B@@A@@@A@A@@@A@@A@@@@@@@A@@A@@@@@@@AA@@@@A@@@A@@@AB@@@@A@@@ABA@AB@@AB@@A@@@@@@B@@A@@B@@A@@BA@A@@@A@A@A@C@@@@@@B@@@@AB@B@@@@@B@@@BA@@@@@AB@@A@A@A@A@@@A@@@C@@@ABC@@@A@@@@@AB@@@B@@BB@@@B@@A@@B@@AB@@A@@BA@@@ABA@@@A@@BA@AB@BA@@@@@@B@@BB@@@B@@@@@@@B@@@@@BA@@@@@@@A@@@@B@@A@@BB@@@@BB@@@@B@@@@@B@@B@@BA@@B@@@B@@@B@@@@@B@@B@@@@@@@@@@B@@@@@@@@A@@@A@@@@@A@A@@@@@A@@@C@@B@@@@@@@@@BBB@@@@@B@@@B@@@@A@@BA@@@AB@@AD@@AB@@@@ABA@@@@BA@@@@BA@@B@@@BAB@@@@@B@@A@@@@@@@A@@@A@AA@@A@@@A@AAAAA@@@A@@@A@A@@@CAEA@AA@@@@@A@@BA@@@@@A@@@@@@@@@AA@@A@@AA@AAA@@@A@@@AAA@@@A@@@AA@@A@AA@@A@@@A@@A@@A@@@@@@@A@@@@@@@@@A@@A@A@@@@@A@@@A@@AA@@@A@A@A@AB@@@@A@@@A@A@A@@@@C@A@@@C@A@@@AAA@A@@AA@@@@@@A@@@AA@@@@@A@@@C@A@@A@@A@AA@@@AA@@@AAA@@A@@AA@AA@@@AA@AA@AA@@A@@@CAA@A@A@A@@@A@AAA@A@@A@@A@@@A@@@A@CBAB@@@@AB@@@@@@@@A@AB@@@@A@@@ABA@@@ABABA@@B@@A@@@@BB@@B@@@@A@@B@@@B@@@B@@A@@B@@@@BB@@@@@BA@@@@BA@@@A@@BA@@@@@A@AA@@A@@@A@A@A@@A@@C@@@@@A@@@@@A@@@@@A@@@@@@A@@@AA@@@@A@@AA@@@@A@@@@@@@A@AA@@@@AC@A@@AAAAACAAAC@AA@@A@@@A@@AAAA@A@@@@@A@AA@@A@A@@@A@@@A@A@A@@BA@A@@@@BA@@@A@@@A@A@A@@@A@@@@@A@@@@AA@A@@A@@A@@C@@A@@AA@A@@@A@@@@@@BADADABAB@@@B@@@B@@@B@B@@@D@BB@@B@@@B@@@@@D@@@B@@@B@BB@@@@B@@@@@@BB@@@@@B@@@BA@@B@@ADABABAB@@@B@@@B@BAB@D@BA@@B@@@@@B@@@B@@@B@@@BA@@@@@AA@@@@A@@@@@@@A@@@@B@B@@ADABAFAF@F@FAB@B@B@@A@@AACACCCCACAACE@@@EAC@ACAC@CACACA@G@AAACAEAGCCCGCCAAAAC@C@A@CAC@C@GAACCEE@C@ABCDABCDABAB@B@D@D@DADBHBDBD@B@D@DABADEDAB@FAB@D@D@D@BABABABABA@A@@BC@AACAAAC@A@A@AB@BAB@D@BABABCDEBABA@C@A@C@A@CBABEBCBADCB@@AAA@C@A@A@@DAD@BABA@@@A@CAC@C@ABCBABCBCBC@A@C@A@AAACC@A@E@EBEAEACAAAAAAA@AA@@@@BA@@@A@@@ABABAB@@@@@BAB@@@B@@AD@@AB@B@@@B@@AB@B@B@@AB@@@B@@A@@@@C@GCCCAAAC@A@ABABC@CBC@E@EAEACAACAA@GBEAE@CAC@AAACEGCCACEA@@A@C@E@AAA@AACCCECACAA@A@CBABEBEBA@ABCBAFABABC@A@AAECA@AAA@A@AB@B@B@B@D@BBDDDBD@F@BABA@CBGBCBGBKDG@E@@@AAAAAACAAAAACAC@GAI@AAAAAAACAECCCGAA@A@CBA@A@C@EAE@@AEAC@GAC@@ACAE@A@CAA@CAAAC@A@@@CBA@EAGACCGAGCI@C@C@CBE@AACAECCCCACAEAGACAC@@ACAA@CBA@CDE@G@EBCBE@CBA@ADABC@@FC@AAA@AA@@A@AAA@A@@@A@@@AB@@A@A@AAAAAAA@@@@BA@@BABAB@FAB@BAB@B@B@@AB@@@B@BADABA@@B@B@B@@@B@B@B@@@B@@@B@@@B@@BB@B@B@B@D@D@D@FAB@DABA@@@A@@AAAACCCCEE@@ACCGAECG@@EICEAC@A@@@ADCDCDCBEDGBADCDCBA@A@A@A@A@@A@A@A@A@@@AA@@@@C@A@A@ABABA@A@C@E@A@A@@AA@A@@@CAE@E@@@AAA@@@A@AAA@A@@BA@@@A@A@IB@@EA@@CB@@A@@@AA@@ABA@CBCDAFAD@@@DABABA@A@E@C@A@ABAB@BADADABCD@BAB@D@D@D@DABA@ABC@CBE@CDA@A@AA@EAGCCAAAAA@CACBE@ABAAA@@CAC@A@A@ABAFEDCBCDCBCHADCDAFADCDADCHE"],encodeOffsets:[[99566,32836]]}},{type:"Feature",id:"632723",properties:{name:"称多县",cp:[97.110831,33.369218],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABC@IAUAC@AB@BCT@DAB@BCBC@A@AAA@IIACA@A@GDMFIBC@CAGCAAA@AAAB@@A@ABCLABCBC@EAIAA@C@ABA@ADA@C@ABA@@@@B@B@B@B@FADETCN@J@FAFAFAFAFADAH@@HPBBBD@B@BABAB@DABADBB@@@@FJ@DABA@MFMHIDCFEFEFEBCBQAABALCHCDGDIDMDGBKDABAD@DDFBFBDADAFADABSlYb[T]laNyVQZaLORM\\aVBJ@@BNGTJV[VBXPTAb`HBH@DabcKIFBLVTMR]BE]QAIbRPGLWM[FH[QMs@_McHsR_QMPG@AiMCIXOHI\\URBLVBFHWTAdWIKoSOEDBfGRJXCTK@ISQ@GNXFBJIH]ESLD_KAO\\QNY^dADTCJAHiJN`CPOLCDUjPLFDDDLHVbGLCH_LeGOCSKODOZUMGTPfOR³xAA@JAD@DAH@H@D@D@FBD@FBHBHDHDDDBDDBDDDDBFBDBFFFBBD@D@BBFADCDAFAD@FBFBD@DAFCFCDCHADCFCBAFCDADCBEJAFAFADAHCF@HAF@H@D@HBHBFFHBDDDBFDHFFDDDFDDHDFDDDFFDBFDDDNFDBFDFDFBFBFDDBFDDBDDBD@F@DAB@F@DCB@HCDCHAHAD@HBF@DBDBFBDFDBDBFBDDD@DBF@HBFAD@DADCFAFAF@FAFAD@FAFCFAFADADCFADAFAD@DAFADADEFCFEDC@CDCBCBAAA@@BCBCD@BBD@BBDAD@BBB@DBB@BBD@BGF@@@BD@BBBBDH@@@H@B@BAD@B@@BB@FB@DBBFBDABDBFBBF@BGFGDABCDAB@DCD@B@B@BDDBHAF@DBF@@CF@B@FB@@FBD@BEDABCDEBA@CB@@C@@AA@ABC@CBAB@@ABADCJ@BBH@@@BA@AD@BBB@@@BADAB@@A@AB@B@B@DA@CAE@E@AAA@A@AB@@BHBB@B@B@BA@@@@@@B@@BB@DBBBF@B@B@F@D@BAB@BADAB@@EDCDAF@BAD@D@@@B@BABAFBFBBBBDB@BBB@D@D@BBDABABAB@D@HAB@BABEDEFEBA@A@AAq@OHGVBVBLBji`OHB^[JOVcViVATIFkFWES^[LSJOBUROXABA@A@EAC@ABA@CBE@AAE@ABC@ADA@C@@AAA@CACA@AAA@AB@@IDE@C@@@AACAA@ECAB@@AD@F@DABEHGJIDA@@@AAC@CBCBAB@BBD@B@@KCCBWHOHABB@BDD@BBBD@B@BADA@@@ABA@A@A@A@CBCBEFC@CBEDEBCFGBCBE@CBE@CBE@C@GDCFCBMBCBCDABAD@F@BDJHF@B@B@D@FCDC@GAG@ABAD@H@BAFADAHCL@DBBB@F@@BB@BB@F@BADCD@BBB@@DBD@D@DAF@BBBAJ@D@FD@@DADAD@FAB@BBBBF@HD@@D@D@@B@B@FBBDFBBFBD@F@@@@BCF@DABBD@D@B@B@B@@FDD@DBB@D@@@ABBH@BDBB@HDDDB@FFBBBDBDBDBB@BFDBDBB@BABADADAB@B@F@F@BA@CDCFEBMFGBGBAB@@@BDB@@@F@BAB@BBBBDBBBJ@B@BCL@BABABC@CDA@GAC@EAIAAACAE@I@E@GDC@C@ICC@C@C@@AAAACAAEGA@A@CBA@A@C@CBADC@CBA@ABA@AF@BAB@@@@DBD@FD@B@@@@CFIHA@ABABAB@B@BAB@BB@@BA@@B@DB@B@BBBBB@D@@@@@@B@BB@@BBB@@DB@BB@@BB@@@@B@BB@@B@@@BBBBD@BBDBFBBBBBDBFDFDJ@D@BBD@P@B@F@BCBABADBBAJAFAHADCB@BAFFLBN@B@BAB@B@@AB@J@DERCN@L@BA@@@BBA@@BA@@@@@@@@B@@AD@B@B@BDJNT@@@BDAB@@BB@BB@@@D@BBB@@B@@BBB@BDBB@D@BB@@BDBB@@@@@B@B@@BBB@BBB@FBBB@B@BA@@BBBBD@@B@@ABC@@@@B@@D@@AB@BAB@@@@@BB@BB@@B@B@@AB@@@BBBB@@@BB@@@BB@@DA@BB@@@@B@@@B@@C@@@BBB@@B@@@BB@@@BB@@DA@B@@@B@B@BCD@@@B@@@@B@B@B@@@@B@@BB@BA@@BB@B@BB@@JAJEBAFAHA@A@@@C@C@ABAFCHA@@BCB@DA@@BABA@EBG@A@@B@FBD@B@JEBADCHADADG@ABC@C@AJFB@D@F@FAB@JAB@D@HDB@BF@D@BBDLL@@@@@AB@@AB@B@BEFCFAHCJCHAH@DAFAH@J@B@HEJEFCJCFCDAHEF@D@FCHEDC@CACACACACAEBE@CDGDIDIFGDIDCDCBCDCHCDCF@JAJALCHAHCHCDAFCHEDAHCDCDAFAD@FAFAF@FCBCBE@G@EBC@G@CAG@CDCDADCFAF@F@H@HCFADEDCDADEDAFCPEFAD@F@D@HBD@F@D@F@FAD@DAD@DCFCFCFADCHCDCBCBCFEFCDADAH@F@H@FAFCHCDCDCDEFCHCFAHBD@FBF@D@HCD@FAFCDCHEDCHCHAD@D@D@FDFBHFFD@D@JHAPABDHDDBH@DCFC@CDED@FCD@D@HHD@D@DCDAFADCFAHAFEBCBGJAJAFAH@F@D@F@F@B@DAFCDADAHEBE@CAI@GDADABCHAF@FFDBF@N@H@HBB@JBH@HBF@H@H@L@HAF@B@BDF@BBBBFDPLB@F@D@D@D@FAB@B@B@HADCFAB@FBHBBAB@NAFAPCFAHCBAB@FAFBVDFBFB@@JCB@B@PHB@@@JEFCDC@@B@B@FBD@BB@@BBBBAD@B@D@F@BAF@J@BDFFFFFFDFF@@@B@LBH@BJNDFBB@@@P@F@B@D@DDNDF@B@BADABABAB@DBF@FBBBJ@BBDBBD@B@D@D@BBDBD@FBBBDBD@B@@BBFBD@D@DABAHABEFADAD@B@BBD@F@FEDAB@D@HBH@FABABMDGDABCDAF@HAH@F@DBD@BBDLHBBBDBD@H@F@DBD@BD@BD@D@B@@ABABED@@AB@D@HBB@B@@DB@@BB@D@@BBBBBDBDFD@B@D@@DAB@HABADAD@FANANAH@D@D@DA@AHBHBBAHAH@JAJADABADCLCDCFCDAD@DAFAD@BAB@BAFCDABC@ABAB@@AD@FBPBB@D@F@D@D@DBBA@@@@@CA@AA@@ACAEAA@AACACCCGIACAC@CAC@C@AACCC@@@ABAAA@AAACAGCC@C@E@A@@@@BA@A@C@@BAA@@CACAAAC@GCEAC@MCGAA@@@BC@AAAAACCCGCCAE@CBCDEDEDCDCDADAHCDCFAHEFAFADCPAHBH@DBFBFBH@HADADCDAFCDAFEDAHEF@DAJAFAHALALCJ@HAFC@@DAH@FA@ABC@G@A@@@A@@BA@A@C@E@ABA@@@AAAA@@AAC@AAC@A@@@AB@BA@A@@@AAAA@AA@@A@@A@C@ABCBA@AB@BABA@A@A@A@ACA@AAACC@A@A@A@AAA@A@ABC@A@CACAA@AA@A@AA@A@A@AAAAC@AAAACAAA@@A@@@A@@AACA@@C@A@C@A@CACAA@AAAC@AA@CBCAC@A@AAA@@AAC@EAA@AAAA@C@@@CCA@CE@AA@@@A@@A@@DAHCB@DADAHAB@B@BADA@ABABABAB@B@BA@ABCDCBA@@B@D@BBDDDDFBDDFBHBD@HBDBJBDBD@FAH@H@F@FADADAJAFAHCDAFAJAHCH@FAD@JAFCBAFBHDB@DADABADC@@@@HBF@H@D@BCBCDABABEBCBABAD@D@F@D@D@BADA@ABA@GB@@EB@@E@C@C@ADAHEFA@@FC@A@@CECCAA@@@@BAD@BABCBA@@BAB@FBB@B@@CCEAACCCECCAAA@C@CAE@C@A@GAGAA@@@AA@AAECCACCCICCAAEBCDCFIFGFGBC@EB@DAFAFCF@JBFDFBFADAHADADCDAHEFEFIBEDCBCDABCHCDCD@FADADAFADCDCFCBCHEDCDEBCBEBABEBABEBE@CAC@CBGDEDCFAD@FAF@DAF@JAD@F@FAFAH@HCDANGDEFCDCBCDCDCDCBEBE@CAEBEBE@I@CBEBEDEBGBC@CBCBGBGBABGDCBADEFAHAH@DAFAH@DAD@FAD@JADCFCBCDCBC@CBGDCBCDCDADCDADAJCHAFAJ@DAF@DBF@FBDDDDBBBFAD@F@H@FBLDJDJFDDDDDFDFDDBHBF@FADADAHCBCBADEBCBCBE@EBCBE@E@EBCBCDE@EDG@EBC@G@EBCFGDEFGDEFEFEDEBCFCBCBCDE@CBEBA@@CC@@@CBADEBEDEDGDEBCDCDCDE@CBCBC@ABCBAHAHADADA@CCEBCFEDCDEDABE@E@EAE@E@E@E@GACCCEECCAACEAECCCCAAEAA@CACCEAEBEBCBG@C@ECCAACACAC@C@CAC@A@CBABA@ABCBC@C@CBCBA@CBABCBA@C@AAA@CAC@C@ABC@C@C@AAEACAAAC@AAA@AAAA@AAC@C@A@AACAAC@A@C@C@C@C@CAA@C@C@@ACEEAAAACAA@C@C@A@CBCBABCBCBEBCBCBCBC@C@EBC@ABCACAEACAEAC@AACACCA@AAEAIAGAC@CAEAC@CAC@C@E@CBCAC@CCACECEECAAAC@AAE@C@C@A@C@ABAB@BAD@BABC@C@C@A@C@ABABC@ABAA@@CA@AAC@@@AACAAAAC@AAE@E@CAAAAAAAAAGBCBCBEB@@CBC@CAAACCACAC@C@EACC@C@GBI@G@K@IAEAC@E@EBC@GCGCCACBEDIDEBEBE@CAACACBC@CDEBAFCBC@EACACCE@ADCDCBCDEBC@ACCIAKAMAIACACA@EBC@ABC@CAGAACCACBCBCFCBADADBHEBADA@C@EFAF@D@H@DADA@CDCBCBADC@CAC@CDE@GBE@CDABCBAACCC@CHCDCDABCBABCBADCAEEACCAA@CBEBE@E@I@C@@ACDCFCDADADCAC@E@CCCCAACBIBC@EFCDCBCBC@CDCBEBE@CEACAACACBCBC@CAC@CCACAAE@CAGAEEGAECE@A@C@CACGCEACCAA@CDCAE@CDCFCBCBCBEBA@CDAFAHCHADAD@FBDABE@ABCDAFABADEBEBCDAJAF@F@HDHDDBDBH@D@DAFADDF@D@DCDALAB@D@DAH@B@DAD@DABAFABADADA@EACCCCC@A@CBA@CBC@AAEAC@EAA@CCC@E@ABGBA@C@ECCCCCEAC@C@CBABADADCBABE@ABCDADCBCDABADCDADAFADABADA@CBC@CBC@ABCBADCDCDABADA@CCCAA@C@CFADADBD@BBF@DAD@DBB@@@DDDBBBB@FBDAFGBCBCBADAFAD@DABABC@ADCB@F@FHDDBDDBDADAD@FBDBDBD@DADCBABADAD@DADABEDABAD@BAFBFBDAFAD@D@D@DAD@BADCBA@ECA@CBABAFCD@D@DBB@FABABAFADAF@F@F@F@FAFADABABABCBAF@D@@@F@BADABADAD@DADABCDAD@DAB@HAD@F@@@B@B@BABABABA@@BA@A@A@A@@@@A@AB@@A@ABA@@@A@A@A@A@AA@A@@@A@ABABA@@@AAA@@EAC@CA@@A@AAAA@@AA@CAA@@AACAA@A@A@A@AAA@ABA@A@C@C@A@AAAA@@@CACAA@@A@A@@@AA@ABABA@AA@@@@A@@@@A@A@@@AD@@ABABA@AAA@@A@@@AD@@@@A@@AA@A@@BCBABAAA@ACAA@A@C@ABA@@@AAA@@ABABA@ABAAA@@A@A@ABCBC@A@A@A@ACA@A@@@@@@B@B@@@B@@A@E@@@A@@AA@A@@@AAAACAEAAC@C@ABCBA@CBC@@@C@CCAC@CBE@EAG@E@E@C@C@C@C@CBCBCBC@CBCAC@CCCAEACAC@CCAA@CAG@GAKAC@CACAC@E@A@EAE@AACCCACAAAEAACE@AAE@A@CBEBE@CBC@C@C@CACACEAAAEAACAACAAAACAA@A@C@ECACACCCAEECACAAAAC@AAC@C@A@CBC@ACACAC@CACC@@CACAC@AAC@AACCACACAACCCAC@C@CBCBCBC@A@E@AACCAAAAAECE@A@C@ACCC@CC@CAA@ECCAAC@AACCECCAC@C@A@@@EC@ACCAAA@C@EBA@AA@ADCBC@CAGAGAEAC@C@AFAHCDCDABABCACAAAEACCCACAA@A@ABCBCACCCACAAAABE@@FCDCDABCJEB@DAD@D@D@D@DBD@D@@C@ABCBABADCBADEBCBCBEBA@C@CBABC@C@ADCDA@ABCBCBA@A@A@CAC@CBC@CBCBC@ADC@CBAACCACACACAE@C@CACAACCA@@AAACCACAEBCBCBCAAACAC@C@@@EB@CBEBC@CBC@@@A@@DCFGFAFCB@DCB@BCBABABADADABC@CEAAACACAEACAC@CAAAC@A@EA@@C@GAE@EAG@C@A@ADCBC@CACCCAGGCAC@E@E@CBEDEBC@E@G@A@C@EACAGCC@EAE@CAC@CCCACECCCCC@EAC@CBE@CACAECC@C@C@G@C@C@CAECCCCACECGACBA@@BCDGBEDEBA@C@@AACCEACEACAA@GBCBC@C@@A@AAA@A@@@C@A@AAA@A@A@AAAD"],encodeOffsets:[[99686,33696]]}},{type:"Feature",id:"632722",properties:{name:"杂多县",cp:[95.300723,32.893185],childNum:1},geometry:{type:"Polygon",coordinates:["@@LEHCLGLIFAJAP@LAHCDA@CBA@@CGIIECGCCCEAECEECCCEECCCCCAEAG@GAG@CEGAEEEAIACCEAGAGCCACACAGAE@ECEAGAE@E@EACACCGACCEEECCCCGCCAGEACEEACCECE@C@EBCBCBCHEHCFALAFAJCFEBE@E@CACEGCCEEICACBEBEDCFEFCFEFE@CAECCECCEACECIAGFIHADCAACEAEBCDGFEDECCAAAE@G@GAEACACECEACAC@@@A@@@@@A@@@@@@AA@@@@@@@@@@AA@@@@@@BA@@@A@@@A@@@@BA@A@ABA@@@ABA@@@A@@BA@@@@@A@@@@B@@@@@@AB@@@@@@A@@@@@@@@@@@A@@@@CA@AAA@@A@@@@A@@A@@B@@@@A@@@@@@@AA@@A@@A@@A@@@A@@@A@@@@@C@@@@@@@@@A@@@@B@@@@@BA@@B@@@B@@@@@@@B@B@@A@@@@@@B@@A@@@ABA@@@A@A@@B@@A@@@@@A@@@@@A@@@A@@@@AA@@@A@@@A@AAA@@@@@@@AAAA@@A@@AA@AA@@@@A@@@@@A@@@@@CBA@@BA@@@AB@@A@@@@@A@@B@@A@A@@@@@CA@@@@A@@AA@@@AA@@AA@@@@A@@@@@A@@@A@@@@@@@@@@@@@@B@@@@@B@@@@@@A@@B@@@BA@@B@@@BAB@@@@BB@@@B@@@@AB@@@B@B@@@@@BA@@@@@@BAB@@@@@B@@@@AA@@A@@@A@@@A@@@@@C@@@@@@@@@AB@@@@A@@@AB@@@@A@@B@@@@@@A@@B@@@@@@@B@@A@@@@@A@@BA@A@@@A@@@A@@@@@AB@@@@AB@@A@@@@@@@A@@@A@@@@@@@@@AB@@@@@B@@AB@@@@@BA@@B@@@@A@@B@@A@@B@@@B@@@@@B@@@@@B@@@@AB@@@@@B@@@@AB@@ABAB@BA@@@AB@@AB@@@@@B@@A@@@@BAB@@AB@@ABA@@B@@@@@@@@@@BB@@@@@@@@@B@@@B@@@@@@BBBBD@@BB@B@D@@@B@B@@@@B@@AFA@@B@@@@AD@@@@A@@@A@@B@@C@A@@@CB@@A@A@@B@@A@@@A@@@A@@BA@A@@@@@@@@B@@A@@B@@@B@@@@@B@@@@@B@@@B@@@B@B@@@@A@@@@B@@@B@@A@@@@@@@@BA@@@@@@B@@@@@B@@@@@@@@@B@@@@B@@B@@@@@B@@A@@@@@@B@@AB@@@@A@@@@B@@A@@@@@@B@@@@@B@@B@@B@@@@A@@B@@@@@@@@@@AB@@@@@B@@@@@@@B@@@B@@@B@@B@@B@@@@@@@@B@@B@@@@@@AB@@@@@@@@@BA@@@@B@@@@@B@@@@@B@@@BA@@BAB@BA@@@@B@@@B@@B@@@@@BB@@@@B@@@@B@@@@@@@B@@@@@@@BAB@@AB@@@B@@@@AB@B@BA@@B@@A@@@@B@@@@@B@@@B@B@@@@@BA@@B@@@B@@AB@@@BAB@@@@@B@BA@@B@@@B@@AB@B@@AB@@A@AB@@@@ABA@@@@@@@AB@@@@@BA@@@@B@@@@@@@B@B@B@@@@@@@@@BB@@@@@@B@@@@BB@@@@@@@BB@@@@@BBB@@@B@@B@@@@@@B@@B@@@@@@@B@@@@@@@@AB@B@@@@@B@@@@@B@@@@@B@@@@@@A@@@@B@@A@@@@@A@A@@@@B@@@@A@@@@@@B@@@@@@@B@@@B@@BB@@@@@B@@@@@B@@@B@@@B@B@@@B@B@B@B@B@B@B@BABBB@@@BA@@@@@@@A@@@@AA@A@AA@@@@A@A@A@@@A@@@@@@@C@A@AA@@A@A@@@AB@@A@@@@@@@AB@@ABA@AB@@A@@@@BA@@@A@A@@@A@@@A@@@@@@@AA@@@@@A@@@A@A@A@@@@BA@@@A@@@A@A@A@AA@@A@@@@@@@A@@@@A@@@@@A@@@@@@@A@@@@@@@AA@@@@A@@@@@@@@@A@@@@@@@@@@AA@@@@@@@@A@@@A@@BA@@AA@@@@@A@A@A@@@A@A@A@A@@@@@@A@@A@@@@A@@@@@@@A@@@A@AA@@A@@@AA@@@@@@A@A@@@A@@@A@AA@@@@A@@@@@A@@@A@A@@@@@@AA@@@@@@@AA@AA@@A@@@@A@@AA@@@@@A@A@@@@@@@AA@@@@@@@AA@@A@@A@@A@@A@@AA@AA@@A@@A@@A@@@A@@@A@@@@@A@@@@@@@A@@@@A@@A@@@A@@@A@@@A@@@@@A@@@@@A@@@A@@@C@@@A@A@@@@@@@A@@@AA@AA@@@AA@@A@@@A@AA@@C@@@@@A@@@@@@@@@A@@@@@C@@@@@A@@@@@@@@@A@@A@@A@@@A@@A@@A@@@A@AA@@A@@B@@A@@@A@@AA@@@@@A@@@@AA@@@@AA@@@AA@A@@@@@@@AA@@@@A@@@A@@A@@@@@@A@@@@@@@A@@@A@@A@@@@@@@@@A@@B@@@@@@A@@@@@@@A@A@@@A@A@@@C@A@@@A@@@@@A@A@@@@@@@A@@AA@@@AAAA@@@@AA@@A@@A@@A@A@A@A@@A@@A@@@@@@@@@A@@@A@A@@@@@@@@@A@@@@@@@@@@@AB@@@@AB@@@BA@AB@@AB@@AB@@AB@@A@@BA@@@@@@B@@A@@@A@@@@BA@AB@@@BA@@@ABA@@@ABA@@@A@AB@@A@@B@@A@@BA@@@@B@@ABA@AB@@@@AB@@@@@@@B@@@@@B@@BB@@@B@@@@@B@@@BB@@B@@@B@@@B@@@@A@@@@BAB@@@@@@@@@@@B@@@B@@@B@B@@@B@BBB@@@@@BB@@@@@@@B@@@B@@@@@B@@@@@@@BB@@@BB@@BBB@@@@@BB@@@@@BBB@@@@B@@@@@@@@@@@@@@@@@B@@C@@@ABA@@@@@@@@@@@@@@B@@@@@@@@@@B@B@BBB@@@B@@BB@@B@@B@@BB@@@BB@@BB@@@@@@@@@@@@@B@@@@@B@@@@@@@BB@@@@BBB@@@B@@@@@@A@@B@@@B@@@BB@@@B@B@B@B@@@@@BA@@B@@BB@B@@@B@@@B@@@BB@@B@B@@AB@BB@@B@@@B@@@@AB@@@BB@@@@BAB@@@DBB@B@B@B@BBB@B@B@@@B@B@B@B@B@B@B@@A@@B@@@BBB@@@DD@@@@DBB@@@@BB@BBB@D@@BB@@BB@F@BBL@@@B@@@B@DAB@@@BB@@B@H@FBD@F@B@F@LAD@B@B@B@B@@@BBD@B@B@@@B@BBB@BBDDB@BBB@B@BBB@B@FBB@BAB@B@BBDD@@B@B@B@@@DDBB@@BAB@B@@@B@B@BABBB@BBB@BABAB@B@BB@@B@B@B@@@BAB@@ABBBBB@@@BBBABB@@BDD@BBB@@BB@BAB@B@@@ABAB@@@@ABABCDA@@@ABA@@B@@A@@BA@@B@@@@AB@@@@AB@@AB@@@@@BA@@@CB@@A@@BA@@@A@@@AB@@A@A@A@A@@@A@A@AB@@@@A@@AA@@@@@A@AAAA@@@@@@@@@@@B@@@@@BAB@@@@@B@B@B@@@@BB@@@@@B@@@@@@BD@@@BA@@B@B@@@@AB@B@@@@A@@@@@ABA@@@ABA@@@@B@@@@@@@BAB@B@B@@@B@@@B@@BB@@@@@@@B@@@B@@@@@@A@@BA@@@AB@@A@@@@@A@@B@@@@@@@@@B@@@@BB@@@@@@@@@B@@@@@BAB@@@@@@@@@@@@@@@@AA@@A@@@@@@@A@@@A@@@@BA@ABA@@@@@@@@@@@@ABA@@@@@C@@B@@@@A@@A@@@@AAA@AA@@@@@AA@@AAA@@@@@@@@@A@@@@@A@A@@@A@A@@@@@A@A@@@A@A@@@@@AAA@A@A@A@A@A@@@A@@@@@@@A@AB@@@@AB@@@@@@@@CH@BC@G@@@@@C@A@@@@@C@A@A@@AA@@@@@AA@@AA@@A@@@@A@@@@@A@@@A@@AA@A@@@A@@@@A@@@@@@@AAA@@@@@@@A@@@ABA@@@A@@@A@@@A@A@@@AB@@C@A@@@A@AB@@@@@@@B@@@B@@@@AB@@@BA@@B@@@B@@@@@B@B@B@@BB@@@@@BB@@B@@B@@B@@@@@BB@@B@@@@@@@@A@AA@@A@@@C@@AA@A@@@@@AAAA@@@@A@@@A@AA@@A@@@A@@@A@A@ABC@A@A@ABA@@@@@A@@@@@@@A@@A@A@@@A@@AAB@@A@A@A@A@ABC@@@@@A@@@@@@@@@A@@@@A@@@A@A@@@A@AAA@A@A@A@A@A@@@A@@@@@@@@@@@AB@@@@@B@@AB@@@@@@@@@@A@A@A@@@A@A@@AA@@@@@@@@@AA@@@@AA@@A@@A@@A@@@@@A@A@@@@@@@A@@@@BA@@@@@ABAB@@A@@B@@@@A@@@A@A@@@C@C@C@C@@@A@@@@@CA@AA@@@A@@@AAA@A@@@AA@@@@A@@@@AAA@AA@CG@@AA@@@@@@@@@@A@@@@@@@A@@@AB@@CD@@A@@B@@@@AB@@@@@BAB@@@B@@A@@@@B@@@@A@@@A@@@@@AB@@@@A@@BA@@@A@@@ABA@@BAB@@AB@@@@@B@B@B@@@@@B@@@@CBA@@BA@@@@@A@@@A@A@A@@B@@A@@@@@A@@BA@@@A@@BAB@@@@@@A@C@@@@@A@A@A@@@@@A@@@@@AB@@@@A@@@AB@@@@A@@@@@@@A@@B@@@@AB@@AB@@@@ABAB@@A@@B@@@BA@@@@@@@@D@@@@@@@@A@@A@@A@@A@@A@@@A@@AA@A@@@@@@@A@@@@@@@@AA@@@AB@@A@A@A@AB@@A@ABA@@@A@@BA@AB@@ABA@@BA@AB@@AB@@@@A@@@@@A@@B@@@@@AA@@@@BC@@@A@@@@@@BA@@@@@@@@@CB@@@B@@@@@@A@@@@BA@@@A@A@@@A@@@A@@BA@@@A@A@A@@@@@@@@A@@BABCB@@A@@@@AA@@CC@@A@@@@A@@@@AA@@CA@@@@@@@A@@@@@@@@@A@@A@@AA@C@C@A@A@A@A@@@CAAA@@AB@@C@A@@@A@A@@@A@@@ABABCDA@A@ABA@A@AA@@A@AA@@AA@@AC@@A@ACAA@@A@AA@@A@@@@@@@A@@B@@@B@B@@@@@@AB@@A@@@@@@BA@@@A@@@A@E@A@A@A@@@EBA@G@GDEDEDAF@D@B@DED@D@H@D@DEHCDAD@F@DBD@D@DEDCF@FABEBABAF@DADCB@@MCKACAC@CDC@EAEACCCAKCC@ABILEHADSHEAC@C@AFCDCBIFE@E@EACFADKJED@DBBBDABC@CD@D@DBB@DBBCBE@EFBD@DCFG@IAE@E@GDIB@@A@A@@B@@@BA@@B@@@@@@A@@@AD@@@DA@@B@@BB@@@@@@@@@B@@@@@B@@AB@BA@@BBB@BB@@@@B@@@@@@@B@@@B@@@B@@B@BBB@@@@B@@B@@B@@@BB@B@BB@@B@@@B@D@B@@BB@@@@@BB@@@@@@B@@@B@@@@B@@@B@@A@C@G@G@KBGBEBABEDCB@FBDBBADEDED@DADCB@FBFBB@DCBCDGBE@CBCDAF@D@DCDEBEAA@AECAEAG@GBEDEDE@GBADBDDFDDADABEBI@EDEBMJOHIHIHIFIHEFAHCFCFGHIHGFEHCDABEBAAECEECECC@G@EBEAC@ACCECECACAEDADEBEBC@A@@EAE@I@K@EBIA@@GAC@AACCEECECGAECCAACCE@AAACECECCCEAI@E@CAEAE@I@C@CDBBAFABABE@E@CAA@A@AAA@AA@AACAA@ACDABA@C@@@AA@ACCA@AD@B@DABA@AB@B@BADABABABA@C@A@A@EACAEAA@AA@@AA@A@CA@AC@ADCDABA@A@A@A@A@A@@@C@A@ABC@AAAAA@@@A@C@C@C@A@ACAAAC@CACAE@E@A@ECC@AAEACAC@A@AB@BADCFABABA@C@AB@BA@ADCBCDCBCDA@E@A@CDBB@DBBAB@D@DAB@DABADABA@A@ABC@A@@BABEDC@@ABA@C@CBA@C@AACAAACCC@ACCAC@A@A@A@A@A@AACAACCACAA@AA@CA@AA@@C@C@ABEBCBABCBABC@A@AAA@A@C@CAC@@AAACCACAC@AA@AAC@C@C@C@AAAAACAEAAAC@A@C@ABA@AB@F@BABA@AB@B@B@FABC@CAA@E@E@CA@@ECCAAAABA@ABCDCACCACA@A@ABCDADECICMGGCICAAAA@A@E@E@C@CBE@AAAABC@CBE@EDEBE@CBC@C@@@C@ABEBCBE@CBABADCBEBC@C@C@AAEAECCCAAA@A@A@E@C@CBC@C@C@C@C@EBC@GBEBGBEBAE@AAA@AAAAAAACAAAAAACA@CAAAAAAC@C@AACAA@CAA@CAC@CDCBCDC@CDA@CB@BAB@BA@@@A@@A@AACAAAAAAA@A@C@E@C@AA@A@CAEAC@C@ABA@CBA@C@GAE@EB@@AA@A@AACAC@AACAC@@AEAAACECEAAAC@A@ABA@@@CDC@CBCBA@ABCBABA@CBC@E@A@CBCBABC@ABEBCDADADADCDCF@DABAB@@C@A@C@E@E@C@EBA@E@ABCDCDA@E@CAABC@E@C@C@CA@@AAAA@CCAACCACCEA@@E@EBC@@C@ABA@ABCBA@AACCACAA@C@CBA@CAE@E@CAA@ABA@@BABCDEDCBCBAB@B@DAD@BADC@A@C@A@EACAEAE@AACBA@A@AB@BAB@B@DAF@D@BA@CB@@ABAD@DABCBCD@@ADA@ABA@@@CAC@EAC@CACACACACAC@A@CAC@A@E@ECCAICECCAAA@C@GC@@@CBGD@@CD@@CDCBADA@ABC@C@C@C@CAE@AAABE@EBE@CBC@A@AAA@AACCACACAAA@AAABA@AB@FBBBBB@BBA@CBAB@@@BABBDDD@DBDBD@@@BABADCBCBABABAD@B@BBB@D@B@FABADBB@B@BABA@CBCBCBCAE@ABCBABCDEBABAB@B@@@@BB@BBB@D@B@D@BAD@BABABAD@B@D@DADADABCBABABC@CBEBCBCBCBC@ABC@EBA@C@ABC@C@ACCBCAA@C@CBABCBABA@CBA@@@CBCDEDABABABA@A@EACCCAAACAA@A@AB@BABAFADABABCBEBC@G@E@EBCDE@C@C@A@CAA@A@C@C@E@C@C@@@C@AAC@AACACAA@CAC@C@CACAGCA@ABABA@ABAB@B@BCDA@AAC@CACBAA@@C@E@CAC@AAAAAA@A@A@E@ACA@AA@AAAAAA@CAAAAACAACAE@E@C@C@CB@AA@BA@ABABA@ABABA@A@A@@@AAACC@CAAAAAAA@AA@A@CAA@CAC@AAAA@A@E@ABCAC@@@@A@AAC@@AACACAEAAAC@EACAEAAACCEACAA@CAA@EBCBAC@C@A@A@@AAA@AAA@C@EB@C@A@ABA@CAA@@AAA@@@AACAAAAAAAAAA@ABC@E@CBC@EBC@AA@@C@AAA@AEACAA@ABC@EEACACBC@@@A@@A@@A@@@@@A@@B@@@@@@@BE@CBABABAB@D@BADAB@BADABADAD@@CB@DABAD@B@BADAB@@A@CAA@CAAAC@CAA@CAA@C@A@ABCBABCBAD@@@@@@@@@D@BAD@F@D@DAB@DBDAF@F@B@D@BABC@ADABABCDADAB@BAB@DAF@F@F@D@BA@CDEBA@C@C@ABC@ABCBA@C@AAAAC@CACCC@C@GBA@CACAC@CBG@CBA@A@@AA@@A@A@@BA@A@@@AAC@@@EAA@@@CA@AAE@E@EAA@C@AAA@AA@@AA@C@A@C@@AACAAAACAC@C@@AA@@A@CBCBABABCD@B@D@B@DADABCBADA@ABAB@@ADABCDCDEDEDCDABADABCBCBEBABEBABAB@BADCBEBA@C@ABC@EBCBE@ABCAC@E@EBAF@BBBBBBBBDBFBD@D@B@B@D@F@DAD@BA@CBABC@EBCBAB@B@B@BBBBDBBAB@BCBABC@C@C@@@CAABE@A@@BAB@B@BABA@C@EAC@C@C@E@A@C@GBABCBA@ADCDAH@DAB@BADABADA@ABAB@B@BB@@DDD@BBD@B@@ABABABE@ADCBCBC@ABAACAAAAAECAAAAC@A@C@E@CBCBC@A@A@AAE@C@ABCBCBABA@ABA@ADABCBAB@AC@C@A@ABCBC@EBABCBCBA@A@@@CAAACAA@CAAA@@A@AA@AAC@C@E@CAA@AAA@@AA@A@CAEC@E@C@CAA@CAEAC@A@E@C@ABCAA@A@A@EBEDGBCBC@CC@AACAC@AAAC@C@CAA@C@A@A@@@G@E@IACBCAAAAC@AAAAACCC@CAAAC@C@C@E@ABCBABABABAB@DAD@F@D@D@BA@AB@@ABBD@FABC@E@ABA@AB@D@FAF@DABB@@DBB@D@B@BABA@ABC@ABA@AB@B@@@B@BBB@DBB@DBBBDBD@BBDBB@B@B@BA@ABC@A@C@GBC@ABCBABC@EBCB@BBDBB@BBD@B@BAB@DAD@B@B@D@BA@@DA@@B@D@DAFAD@DAD@@ABA@EBE@ADA@AB@@A@A@CCC@ABA@A@CAAACAAAACCACACACCAA@C@ABCAAAAEAEAC@A@CACAAACACACACDABA@A@A@E@CCEAA@CBEDCDCBE@CAC@CAAC@C@CACC@E@EB@@C@C@@@AA@C@EAAA@C@CBCDEBCBCDEBCBA@ECAACAECA@CBCBCBA@A@A@AEAE@E@CBC@AC@ABE@CAABC@CDADCDCBAFCDCBCBCBCBC@CBEBEDEDCDCDEDCDCDEBE@GBE@E@EAEAE@ECEAAACCCCAAABABA@EDCBA@EAA@CBADABCDCBCBCDABIFCF@BABABA@A@AAA@A@AB@@CACAA@@@ABCBCB@FADCBA@A@C@E@C@E@CAABABABABC@A@C@E@ABADEDCBCDABCDEBC@EBC@C@CA@AAA@C@CACACAACCACCC@CACAEAAACAACCACCCCACACACCCACCCACACAE@C@E@EAE@CBABCBAB@B@D@D@BADCBA@C@CAEAAAEAEAC@A@@@CBCDABCDABAD@B@DAD@DAB@@C@ACAACCCEA@C@CBABEBCBC@A@@@A@C@A@AAAA@A@C@AACA@CAC@E@C@@@DFDHFFDFFDFDFFFFDLBFBDBHBFBLAF@F@DBF@DBFBFBH@FBJ@FBHDNAFBF@D@F@H@H@FBHADBHAF@F@F@DBF@FDFFDDBDDHDFBDBHBFDFBH@DDHDDBFDJHDBFFBFBDAF@FBF@F@H@FBH@FDFBFBDDFJHDBFDFDFBDDBD@FBFDDJFDHDDDDJHDHBD@HCHINBNBFHHDD@JBDFDDDNDPFFDJFDDDFBNBV@JALCJERAFCHALCHCJGFCFIHIFKHMHMHSNEFAF@HBB@B@DFHFHFHHHTVDHFFFDDBH@F@BDBDBHALBPCP@LBFDDHDFFBH@HAHCFEDGFEDKFIFKFIFEFIF_LOHGBEDIFIDE@E@E@IAI@EBCDAFGHCDCBABIFCBADDFDBFDFBDDBB@F@FCHADAHAH@HAFCFCFAHAFBDHHFDFDFDHDHDNDJFHBNHFBHDFBFBH@JBR@ADC@GBEBEF@FAHAJAF@HBHFRJPFFHFHFFDDFFHBHBL@H@JDFDDHDFBHAHAHCJCHCJEHCHCF@H@FBDBDBJJDFFDDBHDD@HAHAFAJ@N@JAJAJBHBHDHDJDFFFFHFJDFBJDHDFHHFFJDFDL@FAJE\\C@EDEHEFGFGDIFMFEBABADABAFEDEFAFAB@B@DBDBHDLFHBJCFGF@BAFBFDFBDBD@DHBFDFDJLHLFBDHBFBFBHFHHFJFLDFBFDFBLFNDJ@JALADALANCPBLANCLCLAJAL@LBJFLDFDH@HBLCHCHANAJ@HBHDFFFDHDF@HCFCHGHCJAF@PDHCNDFBLBJBDDL@F@HAJAH@FDJDJDF@JCJALAF@FAFADCFEJCFEHCFAJAH@JCNAH@F@HALCF@HEDADAHCFAF@JCD@N@FDDFDDDFFHFDHDFDHBRCJCFCHCFCFEFEHCDCL@J@LBF@JHFDNDD@FBJBF@LFLFD@JBJEFCBEDCDEFEFCH@J@J@PDNHJAD@H@JFLHHHFFJFJDDBF@ADDBFBJBPDRFPHPJJHDFLAFAFALODCJEV@bEPEVEVGRCVCRBNDXHPHPDJ@JAFA@BFHHDH@PBH@BBHDJFNDRHNJNFJAHFDN@LDFHDDH@HFAHEFIFEBCJGRERGPELIFIDOHIL@FBH@F@DAD@DALCJEJKHGFCDAJ@JANEHGJGNERERGJMLSHEFCNCPAHEHGFAHBF@HBJ@@DDBDBDDDHDJJFNBNFBHFJJFNBHHFJLPLLNHP@NAJFFJFPFLLDHALELFHJHDJBD@L@PBLDP@BABCDADEFCJ@J@HANEJBN@JCLGF@F@L@F@HALAL@NFPHJFH@LALANCH@JDH@JCFAD@JBLDRHLHHFJFFJFHDBNCNBJDFB@F@TBDLDXLLDJ@NED@B@BD@LBD@DEL@HBLBDPDFD^PLBAFBFBBLBLDNJFBD@LE\\CR@N@JDJBBDBFDFFDB@HDBDDJFFDDDDDBF@HCLADAJEH@FCFCHCHAJBF@HFDFFFBFBFDFBDDHFDFBFAFAH@H@H@D@HATARALAJAH@JAJCF@HCJCHCHCHCHALCJAHCHCHCFADCDCDCBCJIFEBGDCFEBELAH@F@F@D@FC@EBGAGAECI@CBGBEBCBGDEBCDEDEFADEDAFCBADCFCDAFCDCDCJEDAFATGHCHCJAJAHAFADAFAFAFAF@JCDAHAHAFCDCDEDCBKBEBCBG@EAE@GEEAEAECCAGCICGAGACCEAEAI@C@EACBGBC@ADIBGBEHIDCH@H@HDHBHFFBDBFBFBFBD@FEFCFEFAF@H@FBHDFDDDLBHBJBJDH@J@FAHAFAFCFADCFCDCHEDCHAF@FAH@F@HBFBDBDBFDDBFBHBDAHANEFGFCDEHGDEDEDEDEDCHCBEBCHCHEN@FBDDFBFDFBL@FAJ@F@H@F@HBFDFBDBFBDBFDDBBF@DAF@HAHAHLPJJJDJFHFHDFBJAF@HAFCFEDCDEBEFG@EBIBEBEBGDEFEBEBCBCFGDCDCFEFEDEFEDCBCBEFKDIDGBGDGDIFKBIFIDIBGBGDEBEBEDGBEDEBEDAJ@J@H@FAF@H@LAJ@HAHBFALAF@JCFCDEFCDEFEHEFCFAF@FDBF@LBJBHBF@FJFF@HCFANENEF@LEHCFCNCFCJGLEFCDAHCDEBGFCFEBGFADCDALAFCHCF@F@NDN@HAFCF@FCJEDEFCFCHEFADAHADCHEBADIDEDCDCHEDCFAF@FBFBFBFDDDDBF@F@JAFAHAHANKFGDAFEFEJGFADCBCAE@GBGBG@CBEHCHAFADBJDDDDDDBDDJFDBN@FAHAJAFBHDFFDBHFDDJHFAH@DCFEHEJEJ@DAHBNBHJFHDHFHDBHFDDF@N@LCDCFCBAHGDCJIHEHIDCHKFCF@BE@CBGBEDCDEBCBCFCDAFAJFFDHBDBHBJBFDH@F@FABCBCDC@CBGBC@CBC@EAG@CAEAE@EBCDCDADEHEDCDEDEFADAHAF@H@JCF@DCBCBCHKBKDEDCFCJAB@BA@CDCDE@CBEAGCAAACEBADAB@@CBCFGPSFGHCLMLIBEFEBAAC@@BANGLAJIHE@A@EJCBAFAJAH@H@FCDE@SBEBCBAJEBEBAFAB@BA@A@C@EAAEECAEA@@@C@CBAFIDIBIHGBKBGDCBEAECGECIAKGGGIGGCC@EAEDC@GDKBIAG@IAK@EACEA@AAA@BADAB@BC@CBEHGHKHEBC@EAE@EBABCFCBAACGEAEAE@ABALG@ABCDCBALC@CBCBAJAN@L@BA@ABAB@LCHABBFFDBB@FAHGBAF@DABAF@FANCJ@FAF@B@B@D@B@D@FAJAB@BBB@DB@@JAD@DEBA@@FABAJ@FABABAB@@A@A@@@AAAA@AAA@G@CACAA@@AAA@A@@DCDADEBABCBABABBDA@@BABCFG@A@C@AAA@AA@A@E@E@A@CAA@AAAA@AAAAAECEECCAACCC@@A@A@@@ADE@@@AA@@A@@C@C@C@A@A@@A@@@@BAB@BABA@@B@DCB@B@D@B@B@B@BBB@F@DAFIDCB@AE@I@A@EACAECC@CAE@A@ABA@ABC@@AC@CCG@C@AA@AAEEA@AAC@AACE@@@A@@DCBC@A@@@A@AAEAA@A@@@ABABABAFA@@@AA@AAA@AAEGAAGIECAAAAB@@@F@DADAD@B@DBFAB@FAD@BC@ADADA@AFA@AB@@ABAAA@AA@@A@A@C@A@@GEIE@A@@@A@ABABABCBA@A@A@A@C@AA@@AAA@ADA@C@ABA@ADABABAB@@AACACAE@C@@EC@AAA@K@CAEA@AA@@ABEBCBCBE@E@ECCAACAEAECCAGAE@CAEAEGGCMEEEAGEGAGAK@I@I@C@MFEBEBGFIFGDGBEFGDEDEDCDCFIHCBEDE@CCCAACBEDGBGBG@CAA@E@EBABA@AGCKOAEACA@A@CAAAAA@@A@A@AD@B@HCBC@MHA@AAEECCKAAAEACAACA@GCCECCKI@@BABCBGB@BABADCH@F@B@BAFIFGBAD@D@F@BABAAE@ABABCBCDCDCB@D@DABA@CAEBCB@BAF@F@D@BB@ABAEAIAIBEBG@IDEBGBI@KFIDQJG@I@I@KAK@GAKAEAGCECCCECCAEAGBCBEFEFCBEHCDEDG@I@IAEAEAE@GAKACAEBGBEDGFEDGAGCGEEGAE@CEEE@EBEBMHEDEBEHEBEDGDEDKBG@KFCDEDCBEFGDMJGBGDEBGDGFCFEDEDGHIFCDEDKHIDC@GBGDEBEBEBGBGDEDIFILCBEDCFEFKLEFEBIHCBCBGBGDCBGFCDGFGFCDCDCDGFCBG@ECGCCAIIACCECEEAACICECEEEAEAECCEECCEHIDAFCDAFAFECCBEBEBEFGFCFCJCFADEDAHCHGDCFGBEBGHIBEDKDGFIHKHMBEDI@GBGHGHEHCJEHEHEHGDEDEFGDEDCFIDAHCF@BGEIEEGCCCICGCICEAICGAIAGAIAKAI@MCG@EAGCICEACAGECEECIEIAGCEEEEACAC@ABCF@DGBIBKBKDEHING"],encodeOffsets:[[97735,33200]]}},{type:"Feature",id:"632724",properties:{name:"治多县",cp:[95.61896,33.844956],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@C@CDEBE@C@I@CBEDCDAHEHCB@AAGGACECCCA@@@@A@ADA@@JCBADABCFADEBEBABABAB@B@@ABADE@A@ABABCBAHEDABCFGJEBCBCBC@C@A@CAAC@E@E@KDGBABA@EAEACAC@EBEBIFGBADCBCBM@G@EBMFCBCDGFCFAFADCDIBGBIDEFGDGFCDEDC@C@ACGGEEIGCACAAAAAEGCCAACCKCCACCA@A@A@@BABABA@@AEAAACAC@AA@@AA@@@AIEGGICQEEAMBEBE@KDEBCBEDA@CACAAEBGBCBCBGDCFGDGDCBEDABEBEBCDEBEBCBEBCFE@GACACG@G@E@EBIFEBEDCBIBKBEBEBE@GAGAE@GCG@OAGAEBK@EBE@I@EBCBCAAIJMDIBEBI@A@CEECKGECIGG@@ACAAAAACCCGCEACACBUJCBA@AAI@MBGDEBA@CAC@AAAA@@C@CBKHKDO@CBEBCDGFC@A@EDCFMJCHCDCDEJ@FEBIDIDKBKBE@G@I@GCEAGAI@GBGFIFEBIDE@IBM@IBKBEACACCAG@EBC@G@C@GCAECGCECCAEACIAC@C@CBGLEHAJCFCFCFEBEBEBEDEBGBGFKBGDGBEDGHAHAJEFADEDE@E@I@IHEHAHAFAHAHCHCFE@EAECCCCECICEACAG@I@GAE@EDGLCDGJGFIJCDGHABEDCDKDM@E@CCGECAEGCGEGGIMAGACBI@IFGFEFCDG@EBIGCCGECAEEGCEAIBGBEBM@CAIECCCACCCCICCAEBGBGDAF@DAHAH@HBFADCDEBIHEFEFCBEHMLGBGBEBIBE@E@CACCECEAEAEAE@EBCDGFCDCDCFCJABGFCDGBCBEBGFEDEDCFIFEDE@EDGBM@MCE@E@GDEDKBCBCDEBAHEFEDAHCFGDCBEDKFIHEDMDEDGDKFE@MFMFEBGDE@IE@EAEAGAI@KAEECE@EBEDGFEFCFEDCFEDIDE@KBEBGAGBI@KBG@E@EBG@I@I@CBAFCFAFCHAFAFCFAHAHCJEJAJELCJCHAHCHCJELAFADCDEFCFEFEFCDCDEHADADAFEFCFAHAFAFAJ@FEHAFCFCDEFEDGBE@IBEAGCGEIEICIIKOBGBG@GBE@CAECAECCAEACAEAECGAE@G@E@I@EBK@EAECEACCEAM@GFGDADAFGDCDCFCFCFCFGHCFEDEHMFGBCBGAEACAECCACAEAGAE@G@EBE@GBCDGFCDEDCDEBEDEBGBEBI@G@ICIAGAKACCECGCEAG@E@EBEFEDEFC@EAEAEACAEAGEGAGCG@G@CDGJAFAHCJ@BADAHBD@F@DBJBFDFBDBHDHDJBHDDBFBFFF@HBF@FAHADAFALCDCFCDEDGBGBCBIDE@EBEBEBCBEBGBIBIBGDGDSHEBCBIFCDCDEDCBEDCDABEDCBCFEBCFCFADCFAHADAFAH@DDJBFBHAH@FEDC@E@E@G@KBAFEFCDAHEFIJADCDCDCDEBGDGDGDIBKDGBGDGDGDIDGDE@IDIBG@IBKBQBSBGBC@G@G@G@EBEBEAECCGACCEAEAEEECEGEE@IAGBGDEDEDG@IFCBKBGDE@CACCCCEECIACGCA@ECCEAEACIAICM@Q@[DKFC@EAMIKCKAAAAEBEKA]OECOCACAK@GFK@CAC@KACA@C@MFI@KCWKKCAC@S@EEAICMAMDCAEGEIIEGEKGQGKCIAC@EBIDG@ICG@MDKBKBG@IEOGMEK@KBGBE@K@E@E@KHIDM@IAMFGBI@I@EDCFCBADABO@KCOAK@C@IAGCGIKEKFGBKCEKEOEIIEMBO@MGKKKOEIGGMAIEEIAGMEMAIECICGCCCACA@CI@GAE@GAEBGHGFOBMDEDGFKTINQHQFMFIHGHMFIBI@CBEDGHILIFKDCBC@CBE@G@EAK@GJCPEJKJOFQHQFIHADEFEJGFEB@GCGGCCE@KCMGEIBMEMIQGMCIEGCAAG@OAG@GCEG@AEBIBI@OCOGWGMCQAUDQDUHUFOFaFU@IFCDKPEBEBKBCEIGOIOGQEOCIAEACABCE@CAICIEEEGGKGIEG@C@IBMGOCI@I@G@EDEFCFCDAFEDIFIAC@KEKEE@IAEAC@MCECIGE@KAI@K@CDGDEFEFEDGDEDIDQDGAECGCECEGCECCCEECM@C@IDE@EBGDCBCBGFE@KDGBE@G@MBIDG@IBEBGDEFIDEFCDEBEBE@KBIBIDE@ICICECG@IBGBE@K@CCIAKAEAMCGDOCE@IBGDGHEDGDE@GCECEEGCGAI@MBGBGDKDGAG@ECKCIEKAK@IBKBKDMDKBOAMDKBCBKBIBI@MCKEEAECEAKCIEGEEGAGAEAECGEAGKIKECECGA@BOLCBCBEFADCBABE@C@AAA@CBAB@D@F@DBDFHBBBDBFDD@LBFDF@DBDAFBFBH@F@DADCDE@EBCBCACBCBAHCFEBOFEDC@IBC@AD@B@BBBFBJBD@@@DBDDFHBD@BBDAB@@CDCBEFCBAFAB@BCFABCF@D@BABAB@B@BBD@BBF@D@D@FABEDCB@DBJ@FBFBDBDBJ@BBBB@DB@BBBADEDEH@@AD@D@BCBAB@DADCDC@@B@B@BBBB@BB@BCDCF@DCBBD@B@@@BABAB@B@BDDB@BB@BB@@DABCDCB@BAF@BBBB@@BBB@BA@@B@BDBBBBB@BBBBB@BCBAD@@BDBBD@BBBB@B@DADAD@@AB@BA@@B@@BB@BB@@D@B@BABABADABCBAB@B@B@DCF@BBBD@BB@B@BB@@BBBBD@@BBCDADABCB@DA@GDEBM@CBEFCNCF@FAB@@C@GBC@ADEFAB@B@BBDBBBBF@DBFBBBBD@DAB@B@FAD@DBDDDFFDDBF@F@D@BDBDDHDBFDFBBDBF@H@DB@BBB@DBBDBB@BADBB@BBBB@B@DBDADDBD@B@DD@D@D@BBBBBDAD@BBD@DHDDBDBB@F@BDB@DBBB@JAD@H@D@DAD@B@D@D@D@BAD@B@BDD@BBD@F@BADCBAF@B@@CB@B@BBFAB@BBFBBBF@BBBDDDDDDB@F@BB@DAD@D@D@BBD@FCB@DBBBBDBBBB@BBBDBFB@B@B@H@DB@BBD@D@B@DBB@B@FBDBHDBBBBB@D@DDBBADDFBBDD@B@DBDDD@B@B@F@@BB@@CBA@C@E@C@E@E@AAA@AA@AOCC@C@A@A@EDA@C@CAA@CBA@CCEAA@E@EA@@CBA@AAACA@A@C@AAA@CAC@A@A@AAAAA@AA@@A@EA@ABCAA@@AAAAE@@AAAA@ABABC@A@A@AB@BABABABE@ABC@ABA@A@A@CBADA@A@AAA@E@A@EAA@AAC@AAA@A@@@E@CBAA@CA@EAEAC@A@@AA@EAGDC@AA@@@A@ACCCAEAABEBABA@@BA@A@AB@BC@CBABA@ABA@A@@@C@A@AAA@AAA@A@A@A@EAEACAEAC@A@A@A@@BA@G@ABA@ADADBB@BA@AAAB@@E@ABA@@B@@ABABABA@@B@B@D@BAD@BBDA@A@A@ABCBABGFC@A@@B@B@BCD@BADABEBCDABA@AAABCBC@C@C@ABCDAF@D@B@BDDBBB@BBBB@FB@@BBD@B@D@BBB@@@BABAD@BBB@BA@ABC@@@ABAB@@@D@DBD@@CDA@@B@B@F@BABA@AB@B@BC@@FEJKJCBCHGHANCF@LEJCPELCJGPCLCJILILGRCD@DCF@JIFIHCFEHCJEHAFAHCJCFAFELCJCLGJCHCNCJCLCJENERBHBNDFBJBFAFGFGHEBMDE@MBG@KGEGEKCICEAGAIACEEACGBMCK@MAKDK@E@IBGBKBIHKDGFOAKAMAECKAMCIAG@GAI@IAQ@G@I@K@IBQ@SBEBI@O@I@QCK@EAKAGBGAM@MAG@G@K@IAEBEJGFAFEDIFGFOFG@I@I@KDI@ODI@MDKFIBMHKJIDKFGBGDIHMBCFKDC@G@I@EBKDGBMFEBGFKHIFGDEDIBIDMDGDOHOJEDKBQBIBKBE@GDKBK@GBGBKDGBGBCDCFCDAFCFAHAF@F@DBDBJBDDDFHDFFBHHHDHJFFDFFFFFDFBH@HCH@FADCDGFGBGBI@I@K@MBeHOAEBC@I@I@GBGBGBCBGDIBGDE@G@KHCDEFAFCDADCDAFGHCDCDGDGBCBI@E@EBMAM@KAK@GBG@GAKBGBEDGDCFGFEHGHEFCJAFCFCJEDCHIBMDM@G@MAGCICEEIAK@MFGBIDEDGDGDMDKBGBKFMFIDMFKDKFIFUHSFGBMDQBKDQDG@G@EBG@GDG@IBGBKBG@ODG@KDIAKDEDKBI@KDKDGDKDIFMDSFYJGDKDOFMBIFODIDABIDEFGFCBGHCBCFADEFEDEFCFIDK@OACBKAI@QCSBG@E@A@QBIDEBCDGDEDKHIBABKAK@UEGASCG@G@E@IBG@I@C@GAIACACAEACEECCAKEGAAACCEAEBA@ADBDABEDEBKFGBGBEBEBG@EBG@KCG@CAI@EDG@E@EE@CBEBCBCDEFGBE@EAGCECEI@Q@EBI@IDKBG@C@GEGAEIEGCGACACEAEAE@EBGBGBADCDCF@DEBEAG@E@E@E@EBGHEDCDCFCBEFADBHBD@DBFBDBFBFBH@FEDCBIDEBE@CBGDEJADADAFAH@D@F@D@FCDCDEBIHIBGBGDGBIFIBCBEDKDIBG@IDCBGBKBGDIDKFG@GFCDCBIDGBEDCDIDEDGDKDMDI@GAE@G@G@GAIAC@E@GAE@I@GBG@CDADGF@FCDEBEBEBE@EBGAMBG@G@M@KCG@EAEAEACAE@EAEBEBCFCDCHADADBDAFADADCBEBEBCAEBKBGBG@IBM@G@KAG@E@IACAKAM@GBI@E@MBE@GBE@G@G@IBE@G@G@E@I@I@I@KBM@S@IAE@I@EBG@EBK@IDEBEDEFCDEDEBC@CAI@EAIAEAGAE@KCC@IAG@GAKBEBE@M@E@MDE@GBG@E@IAE@GAIGGCCCCCCCCBGBEBEDG@I@E@E@I@G@EFEDEDIFCDCDCDGDCBG@EAEAIAM@GAEAGCIAEGECEIAGMGECGICCAEBCBCBE@G@CAEAACAEEGCCCECECEAGAECE@OECCIAGAIAEAGAEBE@EBEFEDCDEFAFCD@DCFAF@DAFADEDCDCDI@GDEBM@I@EAKDE@KFI@KDOBCBI@G@CAICEAEEIAIYGSKQUSOYGUFi^KHMFKNDNK@OCAACAAACAC@C@C@E@CAEAG@C@CDABCBC@@@@@ABA@A@A@CBCBADADCDCDEDEDC@CBC@EBEBEBC@C@CACCCAEACAC@C@CBCACACACAACAACACCEAAACAA@ABADABCBE@AACACACBAB@DCBABCBA@ABA@CBEBABABCDEBC@CACAACAC@CCEAACCCCCACACAE@EAA@CAC@AACACACACA@C@C@CACC@CBA@E@C@C@C@AAAE@AC@CBABAB@@C@EAACCAA@E@ABEAE@E@E@EAAB@BBBBDBBBD@D@BABA@C@E@C@CBCBC@G@G@E@C@G@G@E@GAE@ABBBDD@BBDBB@DBD@D@BABCBADE@EBC@CDCBAB@DAD@BADCFABADAB@F@DAFBDDB@D@BBBBBDBB@DBBD@D@DAD@DCDAD@B@D@BBBBDBFBDBDBDBDBD@DBDBD@D@D@FADBD@@@BABABCDEHCD@D@BBB@BDBBBDBDBDBHBBBBDBBBFBB@FBD@F@DBD@D@DADCFCBCBCD@B@@BDBBBBD@F@D@B@@B@DBFBFBH@F@DBFAHBF@FADADCBCDABAB@D@BDDDBDDB@AD@FAAEDCDEB@D@DCBCBG@ABEBEBG@IAGACBE@E@G@G@AB@FADABCBEBEBCBC@EDGBCDEBAD@B@F@DAFADCDAD@DADAFADAFCDABEDEHEDCFCDCFCDCHCFAJCJADCHADCBCDABCDEBEDGDEBGDAB@DBBBFDDDDDDBDDBB@DDDBD@DBFBDDFDDDBBHFDDFBDBF@DBFBBBFBHBBBF@DBFBB@DDBDDBFDD@FBFFDD@D@B@B@BABCBEBC@C@E@EAGAE@C@CBE@CBCBABCDCD@BAD@FBDBBDDBDBFBJDHDFDFBBBDFBDDDD@D@BAB@DADAFBDAB@DADABABCBCBC@C@ABCBADAB@H@DBBBDDDDDDDDBH@D@H@HAF@DAD@HAFAB@BBDBDBDBDBDDB@DBD@F@FBH@DBBBDDDDBBD@BAB@DAD@F@D@D@DBD@DBDDHDFDBDBBBB@HDBFDFDDDDDFFDBDDDBD@DBDBFBDBFDD@DBFBB@DBF@F@BBDBFBD@B@D@DAB@BAB@BAD@DAF@FADADAFABABCFDBDDFDD@@BDDBD@BBBBBB@B@BCDAD@BD@BBD@FBDBD@BBBDBD@D@BBBD@BBHBDBBBBBBDBDBDBBDDBD@@ABABABABDDD@D@D@DAB@BBDBBD@B@D@D@@BDBBB@DBD@D@FBF@H@D@DBB@@B@BA@CBCDGBABEDADADABADAFADCBCDCBA@CAECE@E@CBAAA@CAEAAAAB@BADABA@E@E@E@CBAB@BDBBBBDBBBBABADABABAB@DAB@DADBB@DBD@BBBADABABCAC@@D@D@HBFB@BBBDBBBD@FBDBD@D@DBD@F@D@FBDBDBBBDBBBBD@B@D@D@D@F@DAD@DAB@FADAF@D@D@DBB@D@D@DBDBFBDDDBDBBDBD@DAD@FBDCBABCBCDCDCDADAFABABCBA@EBADABABAD@DAB@FADAD@DCBADAFCDCBCBCDABADBDBDBBDBDBD@D@B@F@D@DBDBBBDD@B@DBF@L@D@DAF@F@D@DBDBD@BA@ABADEDADADB@BBB@B@DBB@BBBFBFABABEAE@ED@BADBD@BDDBBFDBDBBBDBDBD@D@D@D@BADAF@DCHADAD@BBBBBBDB@FBDBDBD@BDDBDDBABADCB@BAD@F@DBD@BBBDBFBF@F@D@@ADABCBCBCDABAHAFCFAFAF@BBBDBDBFBDBDBBBBB@DBDBD@FBF@DAFADBD@BBDDB@BDDDBDBDBDBBBDDBBBFBD@@CBADEBABCBADAD@@ABCBE@CBC@EBA@E@AAEAEAEAIAEAGAIACBC@ABADABA@A@C@GACAA@ACAACACAE@C@CAE@ICE@CBI@E@C@E@CAE@EBC@EBE@ABC@E@GACAEACAE@E@CBEDCBCBCBE@E@C@A@C@CBCDEBCBE@ABCAC@C@EBCBE@A@@@CECAEAA@CAC@C@CBC@CBEBCDEBABCAE@G@EAEBE@CBAAAAAC@@CAC@A@@@ABAF@DAB@BCDABC@CBC@C@C@CBCBAB@@@BBDB@D@F@F@DBFADAH@F@D@BB@B@DABABABA@CBA@E@E@CBCBCBEBCBABC@EBEBCBGBEBEBABC@CDCBCDCBCBC@CBGBCBC@EAA@E@CAEAE@C@EBA@EDCBCBCBCBGBCDGBEBCBC@CBE@EBCBCBABCBADABAB@D@DAD@F@BBBBB@BHDDBDDBD@@BBBF@@BB@B@D@B@BAD@BAF@BA@@@ABAB@@AB@BADAB@B@@@D@B@@@BB@@B@B@B@D@@@B@B@@AB@B@D@F@@@BB@@B@@@@@B@B@BBB@@B@BBB@B@B@@@BB@@@@@@@B@@CBAB@BABA@@BA@@@A@@B@@A@@BAB@@AB@@@@@@A@@@@@@B@@@@@@AB@@AB@@@@@B@@A@@@@@@@@B@@@@CBA@A@@@AB@@@@A@@@AB@@@@AB@@@@A@@@ABA@@@@B@@A@@@@@@@A@@@@@A@@@@@@@@BA@@@@@@@@@A@@@@B@@@@@@@@@@@B@@@@@@A@@B@@@@@@@@@@@@@B@B@@@B@@@B@@@@@@@B@@@@@@@BB@BD@@BB@@@B@@B@@B@@@BBB@B@BBB@B@@@@@B@@@@BB@@@@@BBB@@DDBFBBBBBB@@B@@B@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@BAB@@AB@B@@A@@@@B@@@@@@@B@@@@@@@B@@@B@@@@@@@B@@@@@@@@@@@@@@@B@@@@A@@@@BA@@@@@@@@@@@@@A@@@A@@@@@@@A@@@@@@@@BA@@@A@@@@@@@@@@@AB@@@@@@@BA@@@@B@@@@@@ABAB@@A@@@@D@@@B@D@@@@@B@@@@@B@@@@@@@BA@@@@@@@@@@B@@@@AB@BA@@@@@AB@B@B@B@B@BBB@@@B@@@B@@@BBB@B@@@@@@B@@B@@@@@@@@@@A@@@@B@@@@@@@B@BC@@B@@ABAB@@@BAB@@AB@@AB@@@@@B@@@@@@@BA@@@@@@B@@@@@@@@@@@B@@@@@B@@@B@D@@@@@B@@B@@B@@@@@@@B@@@@@@B@@@@B@@@@B@@B@@B@@BB@@@@@@@@@B@@@@B@@@@@@@@@B@DAB@B@@@B@@@@@@@B@@@@@@B@@B@B@@@@@BB@@B@@@B@@@@@B@@@B@@@B@@@B@B@B@B@@@B@@@BA@AB@@@BA@@B@B@DABBD@B@B@@@B@BBB@B@DBB@BBB@B@B@@@B@B@B@B@B@@@B@D@B@@BB@BBB@B@B@B@B@B@B@@AB@BAB@@@B@B@DB@@BBDBBBBBB@@BD@BBB@BBB@D@B@B@B@B@DBB@B@D@@BB@@@BAB@@AD@B@B@B@B@B@BBB@D@D@JADBB@DBD@DBBBBBB@H@D@BBBDBBBDBB@@B@BB@B@@B@B@BABAB@B@BA@@DBB@@@B@DBDBB@@BFHDD@B@@@BBF@BABABAB@@DDB@@@@@@@@@@@B@@@@@@@@@BA@@@@B@@B@@@@B@@@@@B@@@B@B@@BB@B@@@@@B@@@@@BB@@B@D@B@B@@@BB@BBB@@BB@@B@@@B@@@@@@@@@BB@@BBB@DBBBB@BB@@@@@BB@@@@BB@B@B@BBB@BBDBBBBBBBB@@BBBBB@BB@@BB@@@B@B@@@B@B@@@B@B@B@@@DAB@B@B@@@B@B@@AB@@@B@B@@@B@B@B@@@@@BB@@@@B@@B@@B@@B@B@@BA@@B@@AB@@@@@BAB@B@B@B@@@@@@@B@@A@@B@@@BA@@DB@@BAB@@@@@B@@@B@@@@AB@@A@@@@@AB@@ABAB@@@B@@@BABBB@@@@@DAB@BAB@@@B@B@B@B@@@@@B@@@@AB@@@@@B@@@B@B@B@B@@@DA@@@@B@BABABA@AB@DCB@BA@@B@@@B@B@@@BBB@@@@B@BBB@@@B@@BB@@B@@BB@@@B@B@BAB@BA@@@AB@@A@@@A@@@@B@@@B@@@B@@@BADAB@@@B@BAB@B@B@B@B@B@@@BAB@@@@@B@@@BB@BB@@@B@BBB@D@B@B@B@BBB@@@FB@@B@@BB@@@@B@B@@@@BB@@B@@@B@B@B@@@B@@@BBB@@@@@B@BABADA@@B@B@@AB@B@B@@@@BB@DB@@B@@@@@B@B@BAD@@@B@BABBB@@@B@@@B@B@BAB@DA@@@@B@@BB@BBB@@B@@@B@@@@@@B@BBD@B@BBB@B@@@B@@@DAB@DA@@@@B@BBB@BB@@B@@@BAB@@@@AB@BA@@@@B@DBB@@@B@BA@@B@@@B@D@@@B@@AB@BA@@B@@@D@B@B@DBB@B@F@@@B@@@@@BBBB@@B@@@@@BA@AB@@A@@@@B@@ADA@@B@@@@@B@@@@@@B@@@B@@@BB@BBBB@@B@@@BA@@B@B@DAB@BA@@B@B@@@@@B@B@B@B@@@B@@@BAB@@AB@@ADAB@BABAB@B@BA@@BAB@BA@@@@B@@@@@B@B@BB@@B@BB@@@@@B@@BDAB@B@B@@@B@@@B@@AB@BA@AD@B@@@@@B@F@@@B@@@BA@@B@@@B@@B@@@BB@@@@@@@B@B@B@@BBBB@BB@@BB@@@@@@B@B@BAB@DA@AB@B@@@@@B@D@@@BB@@BAB@@@B@@A@@BA@@BA@@@AB@@@@AF@D@BAD@D@BAB@@ADAB@@B@@DB@@B@@@@BB@@BDBBBB@@DD@BB@B@BB@@B@@@@@BBB@B@B@BB@@B@@@B@BBB@BBD@FDBBDBDBB@B@BBB@DBB@@@B@DBB@BBDBDBBBFDDDDBBB@@BB@@DDDDBB@@BB@BBB@@@@@BB@@B@@@@DFHJDDBD@BADAD@B@D@DBDBDDDFFBDDFBDBD@F@D@JDJ@D@F@F@F@BBDDDDH@DBD@D@F@D@DABCDCBCBC@EBC@C@C@CBABCB@DAFAF@D@@BB@@B@@@BABABAJAH@HAJ@H@JBH@FBD@BCBEBCBC@EDCBCFCFCDCDAD@DAHBBBDBD@F@HAF@BABABABCJ@N@J@DAD@FAFADBJAH@F@DADADADADAFAFADADAAAEACCCCCEEEAGCECEAC@E@CBADCFAHAFAFAFCDCBABADCHAJAHALAFADAHAH@J@F@HAD@BAD@FEFCDCFCFCJCJEFEFCDADABCBADAJAJAHAF@D@D@D@JDHBDBD@F@JADAB@D@DADAF@J@HBLBH@HBFAH@F@D@DADABABABEDABCDCFCDADABAD@F@D@F@FBF@HBDDD@DB@@B@D@FAFCD@FADADAD@DCHAF@H@H@FAHAHAHCF@FAF@F@HBRALAN@LAF@JBH@D@D@FDBDDDFDDBLDHBJBLBLBHBLBFAJ@NAJAJAFAFADADCDCBCDADCBADAD@FAD@FBDBDBFBB@FAFALCFALAFABAF@DBDBDBDBFBFBD@L@F@HBHBH@FBD@FAF@LCNCNENCPGNCPEFCDAB@DEDCDCDCDADADAF@D@DADEBADABAFAD@F@D@D@D@FALCHCFADABADEFIDIDGDEBCBADADABAACBCDEFEFABABABABCBAF@D@D@FADABABAACCAACDCHAFCF@DABAFCBEFEBAFADAHAF@D@HBFBFDFDFDDBFBD@FAF@FAB@D@DBFBHDJBLBJBHAB@FAFAB@DBDBBBBBDFDJBDBBF@DBDBBDDFFHFJFJBHBJDJBJDFDHBHDDHDFBDB@FBDDBDBBBHAFADBFBDBD@D@DCBCBCBAD@HBDBDBFAL@HAFADAD@F@BCDCDABADAD@J@H@FBD@JCLAHALCH@F@J@F@HBHDJBHDFBFBDDDDDFDDBD@DBDBB@DADCD@@AB@DABAB@BAB@B@D@B@BAB@B@B@BBD@@CF@BADADCDCBADAD@DAB@@ABADAB@@D@LAJ@JAJCHCFEDCFCHCHCFAJAHCJAL@NCNCLCPCTGLANALCJCHAHCJEPCJCJEHCHEJEHEDCFEHGHEFAFALCNAHCJALAJAHBLAL@HALALALAL@HAHALAHAH@FBH@LBJFJBJDHBJDHBFBHBF@DBB@DBHDD@HBBBB@DDB@HBB@BBB@BBPDFDBBFBB@@BBDB@BBDBBBDD@@B@H@BBFBDBBBDBBBB@HLDDHD@@D@D@BBBB@DBH@B@B@@B@@DBB@@@BBB@@BBF@HD@@FDNJDDFDBBB@@B@HBB@BD@B@H@FAD@BBB@DBPD@@@@@@CA@@A@CCA@@AAA@A@AAG@A@@BCAACC@@GM@@@A@@@C@AACCC@@CGAAAA@ABABC@A@@AAAA@AA@@@CEAEAICI@A@A@AB@FC@A@E@G@A@AF@BABAB@HBB@B@BA@A@C@CAG@A@@FBBBD@@ABALMDADABA@@AEACAALCF@DADAD@D@D@F@HBD@HAB@FABAHGDAFEHCDABAB@B@BBD@B@B@@@DBD@DFDBFAH@LCH@F@F@JCF@FAJEHCJCFALCJCFAJCJCJCTCLCLCJCLANALAH@J@HAF@HAJAHBRBHBJBLBRDNDFBHDJDJBLDHBH@JBH@JBJ@JALALEJEFAbEREXA`CH@DADABAHIAUB@DAHEFCDAFCJCJELAHCDAFAHCHAHCHAJELAHCH@F@PAJCJANCNANELALCJALC^GRCNCFCFEHCHAHAPCHARAJAJAJCNAHAJAHARALAHAFAJ@JANANAH@PBJ@P@P@N@JBLBLBHAF@HAHAF@DCHGDEFGH@JBJFHFFFHDFFL@L@LBFBNBJ@JDJALCHCH@FCFAFCLGH@FCLCVBLEhCR@NCRCRCLAN@HAPCNAN@LALALARALAJ@P@Z@P@N@F@L@F@JAJAJ@JAL@J@R@HBJBH@PAH@H@PCJALAH@N@JAVCTAN@N@JAL@JAN@NBPBH@H@J@L@HAZCPEN@JCLEPCJALANCHCRCNAJ@LAJALALCNCJ@JCJANCLCHAH@DBLJFHNHFDHBJ@RAPAN@VCLELAFEDEFIBIBEBEDEBEHGFEJ@JCN@JAH@J@NCJAL@L@LALCLERCNCLALAN@P@NAJBJBP@LAHCNEJENCJANAN@R@H@RAFBZBPAR@P@NBNA\\ELBRBT@N@HAPEJAHBJ@NBL@JBHAT@JADCVENAL@FAJAHCHCL@DAHCH@FAF@JBHAHFDBDFDJ@HBFBDFHF@NBRILEHCJCLAHBJ@HCJEHELAJCH@LANERAH@FCL@JANBFFNJLHJ@LEDEHEJILCH@J@L@FBL@JBHDFDJFJBRAJCBGDEBKBEDGHANEDCNCXAXCPEJ@JBLCHIFEPITCL@LARAHEJ@RBNBVHJ@N@NCJEDELCTKNGNCFED@LEJCNCH@DAF@HCFADCFEHGHIHGFCFEDCLGFCHCHCNCFAF@@KBIAQOIIGCCECIKECCGS[MMEA[C]FGEKEKGSSCACCCAGEKEKIGCGGIEKGKCGEOAE@GDWFEBGBWGAC]KK@QBEA[BIDEFKHKFU@MCMCE@IAUAOCGCQGGCOCSCIEOACAG@WGIAKGQGWOICGEKGAECCCGCAEIAAGIKMIGCEMIGGAACCCCEEEAEEcSECgMEAEAYCQEQECAQICACAAAKGGCIAOGaGAAE@GC[EKASBeCKAU@MCQIKAEC]SUIKGWMIA@AWICAGMC[CGMAIBI@SAUGWAsYUEOAECUAEACCSAECKBIASDM@IAE@CCOACCKCECUMGEEECCG]CC@CCC@CEEAEGGQMCAAEIMAC[[CEEO@I@EBKDYAGBKAOCMAMI]AAMOOU@WBICQGOQYSSGGACEECE@CBGBKJOC[IMCGCEISBH}BqB@DENM@IM[AACMImC_HODGBAFALIDGJO@CCIGMGUCGCCCA@@EGFADAF@HCFAJ@D@BABAD@DABADAB@F@D@DAJ@DAD@D@DADA@C@A@G@C@A@E@AFCFCF@D@F@F@FDFBJJJJPDDADCFEFEHIFIFENAPCNKBCFAFCAE@IBEJGDG@IEKJOPQ@CFBHADCPGTOVQLIHIJGDAJEHATILKHGHEHEJGHGDAJGFCLAHAFAJALAH@HAHALBFBFDFFBDFDHBDBFBLDJBD@F@H@H@FAFAHAFCFALGLAXETELEVGLCJ@LANBL@P@NBL@L@N@H@JAJ@N@V@RCNANALCPCDAREPCLCLAJELAPARAN@NAHAJBHAJAJ@PAJ@H@LFRNFF@FFNFFJDFJJBH@PAD@N@FARAF@NAFAH@JCFAPCNCLCFAHAFCHAPGRARARCL@LAL@R@JAJ@RBRBN@HBJBH@L@H@D@L@F@H@LAJCNEPAJ@LBJBLBHDHDHBJBL@PDLFHBHDFDHDDFDFDDFFFFHDDDBDFBP@L@NBDBLDJFXNXFdLJDH@J@FCJGRAHDDFDDBDBDDFHDHDD@DBB@FAHELGLIJGJCJCNBJDLFLFDFHFBD@DB@DBDBD@BAB@LAFBFDJ@JCHELAN@JAJ@FAB@JDJBFFLHDJ@NBLDHJFFBBBDFB@AHBH@HAJ@JFXBBHAH@L@PBJ@NCJCNCHF@JCNENAJAJFFJ@JGFGHKJIFAFBBL@H@F@D@DBHAFDDJCJ@LAH@FFBBBDFHDDPBLBNANEPCNCHDDBDBBDBDBB@J@FBHDFLDNBJAD@D@HAJ@LAF@FB@FBDBF@HBDBDBBBHDDBF@D@F@FBBFDPFJDHDHFLBPAJ@JBLDHBDBD@D@FAN@J@LAJBHBDANEFGHKHKHEFCHADAD@HBLAF@HBD@BBLCJCNCNCJAdLJBNBL@HAHAB@FADCBCDCFCJ@F@F@FCFGBADCB@HADAHAH@DAD@FAJ@LDDDFBFBHBJADCDCBAFEDCFCLEF@RGPGNCP@RAH@B@D@JDDDNBBBF@B@BBF@F@LEFIFGHKDCBADCFAHEH@J@JAFAHCBAB@J@J@L@L@F@HADCDIAGEIEIEGAKAGDGFCJCTITGLCBE@GCIAGCG@GBCDCDADAF@BAB@DFHFFDHBJBFAJCNELMBIHQDKDIBIBGDKDIDEHEJCNCJBJDLFFBJDJAHCBGBKDEDABAD@FBH@D@D@D@B@FGHGFKHINILELAJFJHJHLHL@J@HAHEDEBCBA@CFGHGHKNMNGNELAHAH@FDFHDJHJDFH@JALCJIDIDMFIDCJBHBDLAHADGFGJAHDHFHJDN@JAJ@JAJ@FAHCHCBGAIAEAE@KDGHMHEL@BBF@J@NAL@JAHCBCBGAGAIFGPGJGFKHMLKJGTKTELEBGDOBCHAJ@LETKRITIPCNANCJEDIJIJ@LALAFCJMNILM@GAG@EACCIAIEGCGCI@GFGPIRKHEBG@GAEKEMEIEEEBIHEPEZBVBXDXDH@PAFCDKDEDAHAHBDAL@PCHANGDCFIBABA@EBEAEAIBGDIDEDCD@BABCBGACACCECGAE@EBCBC@CBCDCFCFKDAHEHGFEHGJEHCDCDADADAB@HCHAFCHAJIAI@E@CBEF@LAJ@D@LCHCDG@OBIHEHAFBFJHHHBJ@HADADC@C@AH@DEFEDABCDA@CAG@CCCCCCGAA@EBIBAJAJAJCJEDC@GAMDIFGJGNQFKDK@KAM@I@GBABA@CD@H@LFLDJBJAJEBEGKGSEI@MBCLEL@NAHBHAF@DCDGAIAICG@ADAHCH@L@LCJAHCB@DABBBE@A@CBA@CBABA@EBG@C@ADBXJHBHBJEFGHM@ECEEECIACBIBCFABAD@BBJEjM\\XTI@aFQjX\\]TBDF\\LpkCIcASIGOJGTAhTNEDSXGb\\P@HQLEDLG\\rFPNHEIYZ@BOFUyWCMFAVCfNDOGWRMTH@ZLFF_HEP^\\GDNRBRIjILh`AJT^HJLB@DGBCBEAG@CCEAECCACAGACCGCCACACCCAECAACACEEAGACCECAACEGCCACCCACGCECCCACCEACACCCCCCACAEAGAEEEECAEGCCCCCCCAEAE@IBEBEBE@EDEBGAG@E@ECCAECCCECCEACACCEEECCAC@CAEEGGECCEAECGCCAEAEACAECCACAAAI@AAAAAA@C@C"],encodeOffsets:[[98492,34397]]}},{type:"Feature",id:"632725",properties:{name:"囊谦县",cp:[96.48943,32.203432],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@KAWGM@G@CBCB@@ADCDADAF@F@F@D@D@DAB@BAB@BADAD@@BBBDDJ@B@D@HBF@B@DADAF@FBHEL@D@F@JANAHCDA@@@CDCFA@EDAB@D@@@BAAAACCC@@@AAC@C@G@IBMBCBA@@B@D@B@DBF@BABABE@ABEAE@@@A@E@GDEB@@CDEDA@ABCAA@A@CBABA@EAA@A@AD@@@BADCJADABAB@@KCG@C@CBEBIJEJGHGFEDCDONIJ@@CDEDEHG@C@@@CFGVF\\BFCNA@@@@@CB@B@@@@AB@@@@A@@@A@@@AB@BAB@@@@A@@@A@A@@@C@A@@@A@A@@@A@A@@@A@@BA@ABA@@@@@@A@@A@AA@@A@@@@@A@AA@B@@A@AA@@A@@@A@ABA@@@C@@@AA@@ABC@A@A@@@A@CAA@@@A@@@A@ABA@@B@@@BB@@@@BA@A@@@A@C@A@@@@AA@A@A@@B@@@@A@@@A@@@A@@@A@@@A@@@A@A@@@A@@AA@@@@@A@A@@B@@A@@@@@C@@@A@@@A@A@@AC@@@A@@@BAA@@@A@@@@@A@@@A@@@@AA@@@CA@@A@@@@BA@@@@@A@A@@@AB@@A@@@@A@@A@@@@@@BA@@@A@A@@@AA@C@@@@@@@A@@@@@A@@@@A@@@@A@@@@A@@A@@@@@A@@AA@@@@@@@A@@@@@@@A@@AA@A@@@@@@AA@@@AAA@@@AA@@@@A@@@@AA@@@A@@@@@@@@@AAA@@AA@@AA@@@AA@@AA@AA@@@@@A@@A@@@@A@@AA@AA@@AAAA@@AA@AAAA@@AA@@@AA@@AA@@A@@@@AAA@AA@@@A@AAAA@AA@A@@@A@AAA@@@@CAAEIWAOAEGEMAACBGHODU@EAA@@@AAAAA@A@@@A@@@AA@@@AA@@AA@@A@@@@@AA@@@@A@@@ABA@@@AA@@AA@@A@A@AA@@@@@@@A@@@A@ABA@A@@BA@AA@@A@AA@AA@AAA@A@@A@@AA@@@A@A@AAA@@@A@@@AAAA@@@@AA@@A@A@CBCDEDCBADADABABCDCBCDCDAFAF@DAF@DCBADCDADAF@BAF@D@DADADCDCFEFCDADAFA@CBEBGDGBE@CB@@EBCBEBEBEBE@EDEBEBE@GBCAEAC@E@EBEBEBEBEBGBG@E@CBEBADCDCBABCACACCA@C@EBCBEBG@E@CBCBCBE@GBE@GDE@EBE@E@EAE@CBCBC@C@CAACACBCCECECCCAE@EAG@E@EBCBC@EAAAAABCAA@AAC@C@CBE@EACCACAEAEEECAAC@ABCDCBCDA@C@AAAAECCCC@E@EBEDGDEBGDEBE@E@C@@@ABCB@D@DAF@DAFCDCBCDADAF@DCBEBC@CBABABADADADAD@BCDEDAFABABA@CAAAC@E@EDC@GDCDCBCF@DDDDBDBBDAD@DCDCDEDCDEFEDCBE@ABAB@DADABAD@FABCBAD@F@DABCBCB@DBD@BABEB@@@BBDABADED@DCF@BBBBDBD@D@DCDAFCDEDCBEBCDADAD@F@F@FBD@D@DBDBD@B@BC@A@EAC@C@EBEBABADBD@F@@EAEAIACBABC@CAC@ABABCBCFEHED@DBDNDFB@HAFCDCBEDEF@BB@HDFHHHJP@@DJCBAL@BBBDBD@F@F@D@BB@BBB@BDBB@@BBDBBFBHBJAJAH@B@BBDF@FB@D@FAFC@@BABE@A@AD@FBH@BDD@HBH@BBDDBDCDIHCBKFGDKHCFGFCDADAF@DBD@DABCBCBAB@BB@DAD@DBBH@@D@F@D@DBBD@DBDBB@BABB@NED@JFHBD@@BAD@FADAFADAD@HBH@FBFBBABADAFDD@F@DAF@DEJAFABEBCBE@EBAD@DDHBFDFDD@B@DABAFAF@F@HBFBDABABCBE@GAE@C@ADAD@F@BCDEFABJBFDBDDFFDDDBF@DEFEFEDEFCDAFAFBDJDFFDDFHBD@D@FAFEFIDEBKBEBGDGFADADAD@F@DDFDFBDFFBDHFDBHDDDDDFFDFBDDHBDBD@F@FBFBHDF@FBFBHBDBDDDBHBHDFBDBJFFBFFH@DBH@HBHBFDDDDFDDFDDFFFDFBDDHDFDJJDH@@AB@DCBGDKBO@IBEBKJKHGDKFMHGJCFALALAJCHE@AD@BBDBDFFFFHDJBJFFDDFHFDBFBJDHDFBH@NDJ@LBJBHBJBHBJDFBJDHDJDDDHDFFFJAHE@GDCBEJCDCFEHCFCFGHGFGFIFGDGFGHAH@HCJAFGNGLEJCHCLAFGJAHAFEHCDGHGDCBCFEBIDEDEDEHAFAFAFDDEFEBCBEDCBGJDFFDDFFDFBFBFFFDJDBDFBDFDFBDJJDBHDFDH@DAHEDCDCDCHEHEDCHEDAHCHADADAJGFAFELKFEDEFCDAJKJEFCHCHAFAFAFAHCHAD@JCLGFCDCJEHGFCFCDEHEHCFAHCHANIHCFEDAFCDCLEH@LAFCHCFCFAFGFAFCNGFAFAF@FF@DBFFHHFHDHBFCHEFCHAFADBLBHBF@FBFBJBJ@H@FCDCFGDAFEFEDAHAFBDBFDDDFDHDFBLBHBL@LBJ@J@H@RIJCLEJ@HAFAJCH@FAJAJBFBAB@BAAC@E@E@ABA@ADBF@DABCBC@A@CDCDADADAB@BBFABABE@C@C@ABEHEJABA@E@G@CDABABA@AHADAB@@LJDDDFHDB@BDDBFBBBLBDDFFBBB@NGD@DA@G@ABCB@B@@@BBBBDBB@B@BDBFLPHD@BABAB@F@FBB@DAHAHCHAFBDDBDDF@FCDAJGDEDCFCFCHCFEHAHCJEHEFAFANED@J@J@L@HBHBHFFBFFDNHHBFBF@DBFBHDDBFBFBDDBFDF@F@DADAFABA@@BBB@BF@D@LBB@BFD@@@DBFBDBD@BA@ABABCB@BAB@B@DCB@BBB@BB@@B@D@B@B@BABADABAB@B@B@@@BJFHFB@@ADCDABADE@ABADADA@ADADAD@F@JCB@BABA@C@AAC@ABADADCDCDAFCDAB@D@H@F@D@HBDBDDBBBB@BBFBD@D@@BB@BBBBBBBBB@@BBB@B@BBDFAB@BBBB@FBDBJFFFBBD@BFHDFFJFLLBADAFATIJKBABCBEAA@A@AAA@@AACACAACAA@AAABEDAB@BA@@BA@A@ABCB@@ADCBADCDCDCDEBAFEBA@A@A@A@@BAFCBABAACCCAA@A@GBC@C@@AE@ACGCCEC@AACAM@CB@DCFC@@@AACCCACAAAGBEBC@@CGCGCCAE@C@GDEDAFAF@HADAF@HBL@F@F@DDF@FBHBFBFBH@H@F@FBFAF@F@F@DAF@D@BADADAFCFCFABABABEHKDCBADAF@BAAA@C@C@CDKDEBEHGDC@A@EBA@@FADAB@BABC@@BCAC@CAA@A@ABAFAF@BABAFEFEJKDCHIB@F@HADCDEB@BABAD@DABCBA@C@AEE@AB@FAFCBAJIDEB@@GBEBAFCDEFGBEBAFCDCDCB@B@JBB@DADADADAHCB@FCBCBEBCDEHGDCFEDCDADCBAD@B@BAHCDADCFE@A@CCEB@DAF@D@FDFJDDFFBDDDB@DDJ@D@D@BBBBHJBBDBF@DBDDB@BBB@H@H@FBBBBDBFDBBBBBB@F@D@FELEFCB@B@B@B@D@BAFIHIDABEDCFEDADAD@BAD@DABCFCBA@CBCDAFCDGBCBEBG@CBCBABAB@BAD@DBB@@BBAB@BA@A@A@A@A@@CG@@AC@ABA@@@ABAB@B@DABBB@DAB@@@BABE@@B@DADCF@DABBB@DDB@FBDBBB@B@B@B@@LCHCHAD@B@DBFDJFDBDDB@DADCB@D@BBFDB@BEBC@@BAJ@D@@@BA@ADCJAFAB@@ABA@ADADCFBB@B@B@B@JBDAB@FAB@D@D@B@DABABCBA@@@BBDABBH@HADCJ@@CBEB@@ABCFEHEFA@CFED@@@D@@CB@B@@AB@BBDBBHJ@B@D@B@@CFA@A@ADAD@B@D@F@@FDFFDD@@BD@@HBB@DAPAHBB@B@B@B@DEBABGBEDCBADA@ABBFHB@BB@B@B@@@BBB@B@BAB@@ABCB@@CFLFFDLJDDDFBDDHDJDFBAHEFGBADADCDEDGBADCFGDEHIBA@AD@B@HBFBDBBFBD@FBB@@FBF@D@BBDBDDDBFDDDFBD@FBH@DADADGBABEDCDGDE@ABCBCDI@C@E@E@E@E@E@C@CBC@E@CDCBCDEDCDEDC@@FCBABA@EBABCBCDAB@LCFABADABAD@DCDABADCBA@AAAAACACCCCAECC@@CBA@GACB@@CA@@AAAACAA@C@AAAA@AB@FAFABAB@BCBA@A@@@ACA@@AA@A@ABABA@@F@DABCBA@E@AB@D@D@D@DABADE@C@CAAACBABAB@FCB@BC@A@A@E@A@ABADAB@DABABC@C@AAA@A@CBABAFAB@B@@@BCBCBABBD@BA@A@AAACAAACAAC@ABABCBAHCDABABA@A@AAA@@EAAAEAA@C@ABAAA@CA@ACC@CBC@ADEBABA@CAA@A@@@ABABABA@A@C@C@CAAACAACC@@@A@C@@@A@CAA@@CAEEEECCCE@@CCBC@@NG@C@A@A@A@A@ABANABA@A@AAABAB@F@B@B@FCHAB@FE@@BAB@LBB@D@DAFADCDAB@FAF@D@@@BA@C@C@A@@BAB@B@D@FBBADBBAB@DABBD@FBBBBD@B@FBDJF@BFDBFBBDBFBBB@B@BADADBJ@@HHBB@BBD@F@DDDDFDFFLBBBBB@D@DCHCHGFCD@DAH@D@D@F@H@B@B@DABCDGBCBAJCBAB@FBDBF@DBF@J@LAF@D@FALCD@DBB@D@B@D@B@DA@CBE@O@CBEBE@ADABGAA@CAA@C@E@EAE@C@EAC@AEAMAICEAAACAAACCCCAAAAEECCC@A@A@K@ABEBKBA@C@AAECAAC@GAC@G@ABMCC@A@CBEAAA@@DAB@@A@@@AB@@A@AB@F@DB@@BA@@BA@@@E@A@A@@BABA@@@AA@A@@ACBA@A@A@@@@A@@@@BA@A@ABAB@BA@@@@@A@C@@@AB@@A@@@AAAA@@A@@@ADC@@@A@A@AA@@ACA@@A@C@A@A@@A@@@C@A@CAC@@AAEECACC@@AA@C@@@AA@@@A@DE@@@A@@GBC@CAAAA@ABABA@@@C@A@A@CAA@@ACCACAC@A@A@@BAB@A@@@CAA@A@C@G@E@EBE@EAG@C@EAC@A@C@E@C@A@CAEAEACCEEAACCAACACAAAAAAC@A@C@ABCBEDCBCDEDEDEDCD@DAFAF@F@DADABABCBA@A@@@G@AA@@@A@@@@AAA@A@@A@@AA@@@A@@AAA@AA@AA@@@@@A@AA@BA@AA@@@AC@ACA@@A@CAE@A@CA@ACC@A@@A@@@@@A@ABA@@@AD@BA@@BABCBCBA@GBA@A@A@ABABA@CDCBA@C@GBCBCBCFCDCDEBCDCBAAA@CC@CAA@G@CAAA@A@A@AAAAEAAAAAA@A@@@E@C@EBC@A@CA@@A@@A@I@A@ABABIDGBIDC@ABA@ECCAAA@@@@BA@ABADCBABA@@@A@ABA@@BA@@@A@@@A@A@A@@@CFCD@BAB@D@B@BBB@BBB@@@B@@ABADA@@B@BA@ABABCDADA@EDC@A@CBABCF@@EDCBAB@@EBC@EBE@CBC@A@CBEDEFCBC@C@A@A@CACAACAAAAAAA@A@@@A@CBA@A@AAAAA@C@C@A@ABCBABCBA@CBA@E@@AI@EAA@CBA@ABABEFABCBADADABABA@A@AACACACACCAA@A@A@CBCBABADAFAD@BAB@BABABADCDADABA@ABABA@AAE@ACGCK@G@C@CBC@@B@FGLOBIBEBG@I@CD@NCJAFAFCDABCBE@EBCBEBE@@BEBCFGFCHCHAHCFCFCFCDC@ABCACACCEA@@AEEEEEMCGAA@@MAO@IACBCAE@C@AACAAA@@@@ACAC@GBKBO@GBEDG@GBK@GFIB@@AFAHCFCBCBA@ABAHALEFCDCBCBA@EBIAABEBIBGDCBAFAFABA@@AE@EDGFCBCBA@E@CBCBAHCNAFCDCDEDGBE@E@C@CACCECA@@EEKGCC@@AC@@AA@AAAC@@@I@A@G@GDGBIACA@@IEIMEI@@COACACGAC@I@G@I@I@A@E@@@IBIDKDMHIHGJEJGHKFADCD@BAFAB@@CFCDAB@B@@A@CDCBEBMFEBC@ABA@C@AAA@A@AAA@@A@A@A@A@ABIBE@A@A@@CAGCCCAAC@E@KDGDA@CBEBEDED@@EFCD@@CDCDCFEJCDCDA@AB@@A@A@CACCCAA@CACACAAAAAAACAA@AACCA@CAC@C@CAC@AA@@EI@@CCECE@CBEBCDCBKHGHGFCBEBCBEDEBEBCDCDABAB@DCHCDEDEBGBGBEDA@EFKFA@CBEDEDCBA@EBCBE@ABC@A@A@A@AB@@A@@B@@AF@@ABADCBGH@@GDMHCBIHKHCFA@@H@BAJ@BAFBHAFCHEF@@@@@@EFKFEBCFAFEJAD@@@B@F@LBJ@B@DCDGJCD@BCH@FBR@JCJKZERCDEDQ@M@GCQK@@A@E@C@A@C@C@I@FK@ODMBKBGDC@GBEFC@@BA@AAC@GCEACE@A@@CGAAAAAACAEAEAECGGAAC@EAA@OA@@@@@@ABCBA@@B@@A@A@AACB@@CAA@A@@@A@GA@@@@@@@@@A@ABADGDCBGDCDCBC@@@C@ADCDCDAD@HAD@BA@A@CECACAA@@BAFA@@BA@A@AA@ACEA@@@AAAA@@A@CFC@@AAAA@@CA@AAA@C@@EI@@@CCAC@I@KCICKOCE@@@GBADIFK@GBI@AAC@C@A@A@@CGAGACAE@EBCB@LCJCFAFAFAHCJAJGLGRIHEBA@AAAEEEE@AACAE@A@C@@BABABCBAB@BADCBABABC@ABCBCBCFCDABABEBADCBCFC@ADCBC@A@@BACCAA@A@A@A@C@AAC@@CC@A@A@A@@@@BC@CBI@C@AAI@AAACCE@GAE@E@GAE@A@AAAA@AAC@@@A@CBCBC@E@C@EAC@A@ABA@CBA@C@C@@AAA@CAEAA@@@@A@A@CAA@@C@AAA@@ABADEDA@@@A@@@@AAC@AA@A@CAC@AA@GCA@C@EBEDAB@BABA@CBC@A@C@"],encodeOffsets:[[98498,32365]]}},{type:"Feature",id:"632726",properties:{name:"曲麻莱县",cp:[95.797367,34.126428],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@s[_oYQKCAAAYWc_WWGECAKAA@ABKFCBEBGBC@A@A@A@EAA@E@A@@@A@C@CCA@A@ABCAGAA@AACAA@@AC@A@ECKG@@AC@@@AD@@@D@BA@G@A@CBEBCBADCDC@ABABCBCBA@@@AAAACCI@AAG@E@AA@E@E@CA@@@@@A@CCCAA@C@C@@AA@@A@AA@@A@C@CAA@AA@A@A@ABABI@GACACAAGCGCCAC@KAE@G@C@C@C@CCCCCAEAEGEEAACCCCCCCEEEGM@E@EBI@CBAJGBCDK@EBCDGDCDAJAHAFAFEDCHEDCFGBCFCPAHAJAHAD@@AAC@C@E@GACACAAKGAC@AAC@C@EBG@GBEDCBAHCNCBABA@EAG@G@CBAFC@E@EAC@A@ABCBCFEBABGBA@C@CACAE@AA@C@CAAAEAC@CAAAC@C@A@C@AAAC@AAIAA@EAE@CBABABABC@A@ACECM@C@C@A@E@O@@AACEIM@AAG@K@A@@EEECEEEECE@A@IBE@A@E@C@ABCAAAA@@AAC@EAA@A@@@CDEDIF@@A@OGA@A@ID@@EAEAUCEAEBA@ABGDEBODEBMBA@ABGAEAA@EBCDGBA@A@A@EBC@C@C@E@A@OKECAAAAE@ACA@E@GBK@G@G@E@GAG@IAA@GAG@M@E@CAEEE@GBADCBCB@HBJ@DAFGFCBCBEDCBA@E@E@C@E@G@EBIBIBAHADEFGBEBCDEBCBCDC@C@GGC@C@EDC@CF@DEDCDG@CAGCACOBGB@I@CECGEEAECC@C@C@GBGDCDGFCDEDEBC@GDC@E@EAC@GAEBGDEDCFCDCDGDEDEBG@E@G@CBCBEDEFADADCDGDCDEBEDEDCDC@CBC@EBE@C@E@C@GAC@E@C@EBOFEDCBCFCBCDCFEBGDG@E@E@EBCDCBCD@DBH@D@HAD@F@HAFADEDE@EBEBC@EBCBCDGDCBGFEDCBGDGDGBKDIBIBE@CDGDCDADCDCDCJEHCJCJCH@DAFBFBDBDBDBD@DCDGFEDC@E@GFCBEDIDEDIFGFA@I@G@EBCBG@GBIDGDEBEDAFA@A@@BA@@B@@@@KKAC@A@CAEA@GCC@A@IBA@EBE@C@A@IE@B@DAD@BCHCBGBCDABIFA@C@EAA@@@@BAH@FABAB@@CBA@AD@@GBEDAB@B@D@D@@@BGBEBABIFIB@@AAA@A@@AB@@AAA@@@A@@A@A@A@@@@@@A@@DC@A@A@A@@@ACB@@AA@@A@@A@@@AA@AA@@D@@@@A@@@A@@A@@ACB@@AA@@A@@A@@AAAA@@A@@BA@A@@@AAA@@A@@@@BA@ABA@@@CA@@@@@AD@BA@@@ACAA@AB@@A@AAAEAA@AAA@AA@@@A@A@@@@AAAC@@AAC@A@CA@AAA@AA@@@AA@A@C@@AAA@@AA@CB@A@@MSCI@A@A@ABC@@@A@@@@@@B@@AB@AA@@B@@A@KDMFQ@C@IBA@@@ABA@A@AAMEKBE@ADABCBGBEBIAABCBADA@A@E@A@OAC@A@CCICEAEACAAAAAEAC@AACAA@A@@@AA@@A@A@@A@@AA@@ACA@@AA@AA@@A@A@@@@C@A@AAAAA@A@@C@AB@@AA@@ABA@A@ABABABAB@JGDE@@@@@AECC@CA@@@@BA@ABEB@BAB@DAD@BCDAD@B@B@DAB@B@FHBBBDBB@BD@D@D@JDD@D@HCF@J@F@DBBBJBFBD@HBB@DCD@BABA@ADK@A@AAIAAACAA@ABA@A@E@@CA@A@@BAHAHANEFADEDCB@@A@E@E@ABABCBCBA@AAAACEC@AAAACACACAAEEA@CCGCA@CA@AAGBA@@C@A@CAC@EC@@@A@A@A@CACBA@CDE@A@@E@C@EAAACEAA@E@A@AC@C@@@GCE@AAAAA@EBC@CBCB@@ECC@I@ABAAE@CBC@C@CA@@AA@ADCBC@A@EAAA@@AE@A@AA@CDKBGBCBE@A@GBCBAH@HBD@DC@E@C@A@AGECI@A@EBCBADCDANADADEHCD@F@DAF@DAF@DAHADEFAFCDAD@FEDADAB@B@B@B@BA@@B@BC@A@AACAAC@ACA@BAPGXGDALD@@@AAC@ABADADAD@BB@@B@JCHIFGBA@C@EBC@@BAFDB@DBBB@@D@F@JC@@BAB@BBB@BD@DBB@BD@B@BCD@BAF@BBF@DAB@BAD@FBB@B@BAPWVQPATI\\KT]XFlEJEBSjUdUPU\\IA]PGj_AiAKAUHUPGr@BBB@B@FAFEFCBA@ABA@G@CBABABAAC@A@C@CAA@ACAAAAAAEBEBA@A@A@@@CBC@ABEDCFC@@BABC@ABA@A@C@E@A@AAEAA@CAA@@@A@@@@B@@A@A@AAAAG@@BAB@B@BBF@F@DBB@@C@A@ABAB@@@BABC@A@@AA@ABCB@@A@@AG@ADIBCBA@@BADAD@BAB@@BD@@@DAB@FADCBAFC@AAC@EA@@E@ADE@@AE@CBEAGCC@A@A@ADC@CBADCBAHCHE@AAEEACABAACAECAA@@EAA@@@ABC@A@A@G@@CGAAAAC@@A@@HE@AAC@AAA@CAA@ABCAC@AAC@ADCDA@AB@BBDADADCD@FCDEFEBCBCBE@CBCBEBCDEBCBCBEDEBE@EBCBE@EBEBEDEBC@CBCAE@GAE@CCCACAEACECACAEAC@CAE@GBCBGDGDC@GDA@C@EBA@C@EACCCCAECCAECEAEAECECCAMECCECCAEECCECGCCCCECCEECGAECCACEGAEAG@G@C@GBE@GDEBGBCBEBEFIDABCDCBEDADEBCDGDCDEBE@CACAE@EBCBEDCBCAE@A@CACEAEECAEACACCACCCCACCCGAGAG@EAC@E@C@C@GBG@CBC@IOQ]dOCGyNCH`RFRtCZ@DQNwBSIGBJT_HEP^RLXTp]AONmPA@IK]GIS_BKgiJQJQACM[HO]GFE`KE@YSGQNHXCPeMUDEBDNzXEVAPY@JZGFOMqEH[CKKFGRO@a[WHCTMFgSSBIHHPTJdBDJol[KCESA[^iWER@bSJ[WiNIFAAC@ABEBADAJBDDJFFDF@FGNEHIFGAGAWICA@B@DAH@FABAB@DAB@D@BAFAACBA@GDIBKDK@G@GDCB@BDHBJBJCHCDE@GBGAMBK@KFAD@NFJHTHLAFIFIBIAKCKEG@C@@DABAB@H@JBN@LCLELMRIHEHCJBN@HCDIFIDIBIBABAJ@FBBDHDDDD@DBH@DCBADCBEFCFG@@B@DCDCBGBI@GAGGEIEAGBGFAJ@PCHGDKDC@I@KBE@AF@D@FBJIJGBEDGBGDA@CBCBCBCDGDIFGHEFGHGFCBELEDCDAD@DADAD@FBFDHDFBDBDAHADABC@CDCFCJAHBJBFAF@FABABEJCDMHGBODK@CBGAGBCBCFCLEDOBG@WCWCUAYAOFGFAJFFJFNFLFBF@HAHGFQLOJEH@HDJDHFHBJDJBD@FBH@HKNMJINEDKBKBI@IJCJIFMDMBODSJQJSLKFI@GBADCPAHKFSFSLIHKLGNELIHOHEHBJBHAHADGDIBK@MBI@E@AAK@GFGNCH@LBFBFBJAHGDGDEBI@IBI@IBM@ICEGCGBGHIHEBCBGCKGAIACDEJCNCJIJKDIBG@CEGICIEGECG@GBKBMFMHMNGLGHEH@DABADCFGFGBI@K@KGIGIGIEKBKFMJGJELGHEHA@C@C@C@G@EAC@ABCBCFALAHGDIBICEAKEICIAMDIDGFCFCJCLAHAJCJCLGRAJKNMFIDEBIAGAECGECEA@ABE@CBCBCDAD@HDHBHDJ@HAFKDSHSJIDEDCHBHBLFHFJFJBHCJCDGBE@K@K@I@I@A@ABGDEBIBI@G@GFEBCDABCDGLEHEJKFE@E@AAA@E@AAMACCICC@A@G@QBO@MDOHQHE@KFEDCDEFABCDCDIBGAEAEACCKCI@EBC@CBG@GBCBGBA@CDABEHEDE@E@I@EDCDADCDEBA@GBGBK@MAIAcKIBMDMDIDKDAAC@GAE@KBGAC@CBGBEDGFGLGLEHMFCBGAIAKBI@M@EBC@C@CAGAKCIAI@OBKAGEGCICOEECAA@E@E@CAECCAGAAACAC@GAEAC@EEAE@KBI@GBC@C@IBMAKCCEAG@E@IAAACACCACAGCMDODMFMBKAOACCEGACAAEEG@KBI@IDCCBEAG@C@C@E@GAKEAEBIJGLEHIHI@EEBIBIFMDM@IGEMDIDMDI@OAK@G@GBAAEW@IBI@GAGBGA@CEAAEAIECGAK@MCIKGEEIAICA@EBI@IBM@KBGFIDI@ECEAKBA@ABC@CACAA@@CACGECEKEKEICMAIDIDIHKJKHGFEBA@CAC@GCGCCEACACCCCEGCQBIHEDI@G@ICcKWEWMIEKCCAMAK@O@EAACCCGCEEEECCCECEGCECGCGAKEOCK@IAGAGCGCKAIAKAI@OBMFIDKBG@E@K@C@G@K@G@IAGAM@QAQAI@IBQ@K@KBK@QDQBQBOHGBEDGBEBKDMDODEBIDG@EBMBE@QBEBM@C@OBG@IAEIICEEEM@EEEQMKEG@I@OBI@IBGBIAGBMBM@QBOBKBIFKBKDODQFCBODKDMBMBQDU@M@I@IBG@M@K@K@MAO@K@MAKBI@KDUHKFSFWFKBKHEBEDGBEBEBG@G@E@C@IAKCEACAGAECACEEECEAKAGBGBG@KBIBEBGBKBEDIHCBGHIHGFGFGHKLSJGBIFCBIHGJKJURSPOHCDGBEA@DORIPFL@JCHIHAF@JBFEDEBADMLODMBEFEJGJEFEFCDCBOCIIIIEAECE@E@C@E@EDED@B@F@B@D@H@B@DCBCBC@C@CBI@CBC@E@A@CBABCBC@ABABC@I@EBGDE@CBEBFH@@DBDDDHHVHNDJ@DIPCHKJEBABCHGPD`JnDNBBN\\@JMNCFA@ArG~AJTDFDHJND\\IPALAH@DDFFFBDHHTTRZHPDRAJ@XPVNPBBJ^BNDNBPALBHCZAL@F@JFPDF\\\\BDJNBFDBRNHHBFFF@DDD@DDDH^DDFFHFVNFDLDDDPBDDF@JBN@TCJBLAFDTBDDFBVBFDPBVFtZXBVHTBJ@JANBDHD\\HNDBXJ@BJBXNLHVJ^TFDLBRJNDV@LBfDTALB\\FHDF@BBbHPHJBHDLHBBDBDBRJDBRFRFZDFBFBhNFDdTFFFBFFDDDDBBHHNJDFJHLNHJBBFJDBDHDDBFLHHFJDXPRHLHJBXHH@DBPBJFTDPDHDRHHDPDVBJBF@NDNDV@LELGFEJC\\AFBRAL@^LBDXHHAFAXEHCF@PBHFLDLHJFHHHDLJLFHFDBDDDBTTLHLFHF^E\\DFBNNT\\DHFDJLFDDDJHPJBRAJ@LLFJDHAHBNBF@F@J@FAFAF@JAFAFBD@FBHHFHFDDJDHFDHHJDHBHDH@H@L@JAFAFAF@FAJCJCJEJEHGDAFCL@D@HBFDDDFHFBDDHDL@N@L@H@FCKEMECA@EFGFEDCFCDCJAHCFCF@F@F@NBNBHBJBJBPCJCNEFAFAJAD@JBN@L@JEFCFCHELEF@HAJAJ@DAJ@J@HBHBHFHDFBFDFCFCHCDCJEFEJCFCHALAH@F@JALAH@HBHDDDDDDBL@H@J@FCHAHAFAJALBF@HAL@JAFAN@DAFBD@FCJMH@DBJFBBFAF@TFFDF@BAHG@CDBBFD@H@FDJJFADEDID@PDDAJKFAN@LADCH@JBJ@@BDHB@P@H@DBBHAFJJFDHBJCL@FAJALBNAFBDDFDBDB@@CDEJGJEFIDABADEB@NPFDLBBB@BBH@FBBDBJFHBBA@@@IDCLGBEAC@A@CDIBEBKDKBAJCDAHBFDN@D@@DABAD@D@B@DBBDBBAHADAHDHDFBFAD@FAFCLBLDB@HCDAB@DCHAFADAJDD@DAJEJA@@EE@CHCDCDAJCDAN@BBLAJAD@FDJDJFD@LKLEHABADA@C@GBEJELCB@D@JBB@DDBFD@DCNCLBNDH@HG\\BPCV@LALEBEACIG@AHKBEBEDGTIJABDFH@FJNJRAHIHCFDL@@JH@FIFBB@BGD@BBBBD@FAFED@BDBLCLEJCNBFBFFHPFHDHAFGHIF@D@DFH@HABCDAH@HCFAD@@D@H@DBHBJBJDJ@JBBBABGBCFELGHGDCD@BDDBDFBBDBBDH@FBB@@DCHG@CFEBI@GAI@ADADAJBH@HCHCB@BBHDD@LCFEH@JBFFF@NFRDNCNCVGNAJCJBPBP@D@R@V@JAHAFEHADADEFGHAFAD@HDD@NYJODEBATBXEFCF@HBNAL@JBHBH@FAB@BD^VFBH@DBBBDF@HBHFJHJ@XDHBDJDFFHBNBDCHCH@HBTAJBF@N@FAJ@DBB@FCFBFDJDH@DAFGHAL@NBVHH@LGRDHBN@T@FDB@D@FEDGDGLCFANFJBHHD@HAPCFCHAFBDDLAHBD@F@FBH@HANBH@HFPDD@@@@CCCACFSFKDAB@HBpNhEÚCdAPABCFKHGZKH@FBJDFKFCLSFGLOBIBEAGBGBI@CD@DCHABA@CB@BADA@C@@BCLG@GBIFKHGHC@@JADANDB@FDFB@BDBBBB@B@D@B@DB@@BB@@D@B@B@D@F@B@BBB@BBBBB@BB@@BB@BB@BBB@DAD@BBB@DBDBDDDBBB@@@BBBB@@@F@DA@BB@B@BDABBB@@BBBB@@@B@BBB@@AH@D@B@FBDDHDB@B@BBB@BAB@@@B@@BBBBBB@@AD@B@@BF@@AB@@@@@BBDBBDHFF@BBDBB@B@B@@@BB@BBB@D@H@BAHAB@@@DFBB@@J@D@D@B@@@DBB@BBDD@@D@@@BB@BB@D@B@DDD@BBBDDBDBDFDB@@B@@@B@@BB@@@@DBD@@BBA@@@@DBB@BBB@@@B@@BDBB@@@@B@@ABAB@@@@@B@BBBAFAH@@@BAB@BABA@@B@B@B@DA@AB@B@F@BA@@BAD@B@LBB@B@@A@C@@@@@@DAB@B@BADC@@DB@@F@D@D@B@D@@@BABAB@B@B@DBB@BBBBB@@B@@BB@@BAB@B@BABBDABBD@B@DABA@@B@DABCDAB@@@B@@BBD@@B@BBB@@B@@DL@BB@FBD@B@D@B@@B@BBD@@@BBB@@BBB@@AFA@@J@D@D@BAD@B@B@B@@@@@B@BCB@BA@A@@B@@BBBBAD@B@@@D@B@BBF@@BB@B@D@FAD@B@B@BBBDBB@@BBDAHABADABA@AB@D@H@BABAB@H@B@FB@AB@HC@@DCDABAB@D@@@JAB@@B@@AB@@@BBB@BB@BBBD@@B@B@@@D@@BBBBBB@DBBB@@@H@@DDB@DAFCB@@@B@B@BAB@@@BBD@BAB@D@B@B@B@@@DABAFAD@FAB@J@@@BABA@@NAD@HCB@BB@@BADAN@D@FA@@@@@A@A@A@@B@B@B@B@B@DAD@H@DBD@B@DB@@BABABADCBA@@BA@AB@B@B@BAFABA@AB@DA@@B@@@BAB@B@B@D@D@D@D@XA@B@A@CBA@AB@LAB@B@BAFBD@B@@@FAJCLAFAD@D@L@@@B@DDBBD@B@H@D@B@@@D@@@@@DEBA@@@A@@FABABADAB@D@FADAD@DAD@BA@C@@B@@@@A@A@@BA@@@AA@@@@AD@D@BAFC@@@@AE@A@@@@BADCBA@@BAB@@ABA@@FAHAB@F@B@@@BBFBDBB@F@D@B@L@B@B@H@@@D@FBJB@@BBBBBB@B@@B@BAH@B@@BDBB@B@B@@AB@@ADCB@@@F@D@DB@@B@BADADAB@B@D@BBFBB@BBB@D@B@DD@FBB@@D@HAD@B@D@FBB@B@@A@@@CBABC@@BAD@@AB@B@D@D@B@@BB@@@B@B@B@B@BA@@@AAA@ABC@AFCBA@AB@BAB@@@@@@CCGAE@A@@@ADABAD@@@DCBAXEB@@ABA@CBAHEJG@@B@DBB@BADA@@@AAC@A@@FAFEHED@BABA@@B@DALA@AFGDCDA@E@ABAFGBADEBAB@JCB@DBB@D@JAHAF@B@DAD@BBD@BB@@@ABABAB@BAD@BBB@@BDBDBB@H@B@BBDBDDDBBBB@DABAFC@A@AAIBC@AB@@@DAB@BANEF@B@BA@AD@D@@@@@@A@ABA@@DA@BD@B@BAFC@@HBB@@@B@@ABABAD@B@B@BAB@BDBB@BB@BB@B@BBBD@BBBB@BBB@D@@@@@@B@@EAE@ABA@@F@B@@@DABAF@BB@@B@@BBD@@B@BDHFHHDBFADCFCFC@ACC@A@C@G@CAG@E@ABEFAHCFDFDFHHDDHBDBDBDF@DABCBAFCF@F@FAHAHCJ@FAFCFGBCFEBABCDCHGFEDCDAHEFCFADCFCFEBAFABCBCBEBEAEAE@AAG@GAEBEBCDEFEBAFGDAHCD@HADADAJEDAFIFEBCFEBEDEFEDCHEHEFCHCDCJCFAFAF@FAJBH@D@H@H@LCFAFAH@HCHAHCHAHAF@L@HAHBHBH@HBD@F@H@DADAFCJEDCFADAHCDAF@HCF@H@LAJ@JBF@F@HDDDFFBDDFBDHHDDF@H@FCDADABCBEAE@CACAEDGBEBCBCDCDEDEB@@ABAHCL@J@H@J@FD@G@ABA@A@AAA@AAA@ECO@A@CA@@AAA@@AAB@@@B@BA@@B@@@@@@A@@BAB@@AA@@A@ACA@A@@@@AA@@A@@ABAAAB@@@@@B@BABAB@@@BA@@@A@@@@B@BA@@B@@@@AB@BA@@D@B@@AB@@@BABAB@BA@@BADA@@@@B@@@@AB@B@@@@A@AB@BAB@@@@KB@@C@@B@@@B@@@@@@A@@ACA@CAE@@@@A@A@@@@@AA@AA@@A@AA@@@@@A@@B@@AA@@@@@A@@A@@@A@@@@A@AAA@AAAB@AC@@A@@A@AAABAA@@A@@AAA@@A@A@@@@A@@@@A@@A@@AA@@A@AA@@A@A@@@@AA@AA@@AA@AAA@@A@@AKOA@@A@@A@AAAA@AA@@A@BA@@@A@CAACA@@@@AAAA@A@AAAA@@AAA@AAAAA@@@@CA@@AAAAA@A@@@AA@A@@@@C@A@AA@@@AA@AA@A@@AA@A@@@AA@A@@A@AA@@@@@AA@@@AA@AA@@A@AA@A@@A@AA@@@AA@@@@@@A@@@@A@AA@@@@@@AA@@B@@A@@ABAA@@@A@@@@@@A@@@@AAA@@@A@BAA@@@@A@@A@@@@AA@@AA@@@A@B@AA@@@@@B@@AA@@@A@@@@@AA@@@@@AA@@A@@@B@@@AAA@@@@@@@A@@A@@A@@@@@@AA@@@@A@@A@A@@AA@@AA@AA@@A@@@@AA@@@A@@@BA@CBC@A@C@CBAAAACEEAA@CAA@@@ABA@E@AACCGAA@ACCAC@CCAEAEAA@AA@@BCBABC@AACAA@A@E@AAA@E@@AAIEC@C@G@C@A@CB@@@@@A@A@AAAAAAAAACA@@AA@CA@@ABCB@A@AA@A@A@C@AAAA@CCCA@ABA@A@@ECCCCA@C@@@ABA@@@ACC@AB@B@HCDEFABABAB@@@B@AA@A@E@A@A@@BABC@@BEDM@CAAAAAACAEAAC"],encodeOffsets:[[99547,35841]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
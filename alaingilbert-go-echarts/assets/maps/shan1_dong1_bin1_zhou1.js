@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("滨州",{type:"FeatureCollection",features:[{type:"Feature",id:"371602",properties:{name:"滨城区",cp:[118.019326,37.430724],childNum:1},geometry:{type:"Polygon",coordinates:["@@BMC@@@@GQECDE@A@A@ABG@CAA@C@@FCBE@CAECA@C@@@ABBD@@B@AHABC@A@@EC@CBA@@B@H@@@@BB@@@@D@BHB@@LBDB@B@@@B@BA@BBHE@@@@BD@JFDAB@DBBBFHCFABA@AAAA@B@BAFD@@B@@APC@A@ADCFAAA@AAEBA@@@@@AB@CCAA@@@@HAB@@GDA@ABE@@@CC@A@BA@@C@@E@GA@AAACCEA@@@@A@@@@B@@@B@D@@BB@B@@AB@@@B@@A@B@@B@@@DA@A@@BCLA@CBKGKEME@@EAAACA@@CACACAA@A@EAA@C@AAC@IAE@C@C@EDADCFA@EFAB@@ABEBCBMDCBCBC@GDGBM@E@E@@@EAC@A@ICAB@DAB@@@DAF@@@@@DNFBBB@DBBDFFCJIDA@C@@@@@K@I@O@ENAF@@AF@J@F@B@N@@@@GB@DBB@B@D@FE@@B@BDBAFA@@BBBABC@@FDJD@BBBJAH@@@@AFIJ@BC@@B@FABA@@D@BC@@GA@A@@P@DD@BRDD@BFCDB@FB@D@@B@LBBD@AF@DE@EDAD@DB@@@B@DAD@DBBBBDBD@B@@CHALDHBDJFHHB@FJ@LAD@BABKJAD@@CBGHABADEB@FA@@BBBBBAH@@A@@CAACNC@@BAPBDC@AF@DN@@DDADBFDBBBBFA@EF@@DBF@B@BA@@@BDADABA@EAABA@AFC@CAEFCD@@ADA@AB@DABDB@DB@@A@@@FDBD@D@JBDH@B@BABB@BB@BIJFDB@D@B@@ADAFAFA@@FA@AD@DAH@H@FBLDB@D@FCBCD@DB@@LBD@BB@D@BCPBBB@ADAF@H@@F@B@BBAFB@@BGB@@@B@@B@@@@@@DCB@@@HEA@BBH@BBB@@DB@@BBAB@@C@A@@DF@@D@HB@FABBBBBABCCGACBCDCDDH@ACBAJANADGDCFCF@BBD@BBDB@AB@@FD@@@@@@@@CD@BABC@CDAD@BDB@ACDA@AHFBABABEBEBCF@@@BB@@@DABHB@C@@@ABA@@@ADED@@@@@BAHBDCA@@AFAD@ACB@BBHBD@JJF@JBBB@D@B@DABCF@DA@@BBFBBCDCAADBBFBCDDDBB@@@ACCDGB@BBFBJAJ@BCHAP@@@NB@@X@LBLI@MFI@@FIJEJEHAXA@@RAJ@@D@@FBB@JAHJDBDBB@JDB@@@@@FB@@@D@DDBAD@@B@D@@@BFPEHADAB@B@@JB@JADB@BB@B@HAB@@ACEAC@A@@D@@IDAP@@C@AA@E@@EC@@C@ABIB@HBDEH@DFB@JABBBDBDBB@@F@B@@GDAB@BF@@J@@BHBFMCABEG@A@@C@C@@DABCG@@E@@AAA@@DEA@EGA@@A@@@@@BEAEEECAGBCCAA@ECEGEC@ACC@AA@CD@@ABC@CGAAA@@BKE@DG@A@@@@A@AGE@AAA@@ABA@@@GGB@A@@H@@CEAAC@@@@G@@@AAAE@G@ACCCBCA@A@C@C@@@C@@@@@@HCPCB@@@CG@AA@GDAA@A@CEK@AFACIA@@@DAD@@BF@@@@AL@BBDA@DF@FBH@B@BAFADCF@@@@@@@@AD@DCDIB@FBFA@@@ACACE@@BABG@AF@JBBCGACA@E@CBEBACGEE@@@ABEAEBA@@@@@@@A@@@A@AA@CBGB@@@@OB@@E@GCAA@@CEA@AC@EDCFEDAB@@BLBB@@@F@B@BABCBCDA@@AAA@@E@AC@BC@AF@BA@@BA@@CAC@C@@@CBA@ACA@ED@AACEB@DE@A@@AA@AAAB@D@BC@@A@AAA@@AAG@@@AB@JAA@CAAA@@FC@ABIABA@GAAA@C@E@@F@DGAKC@@BA@ADC@ADB@IBAHBBA@MNCA@CIAC@AA@OBAA@@@@@AAG@AC@@@A@@@E@@A@I@AB@@IBCDAH@D@F@B@@A@KLE@CD@@A@IBC@CBA@E@CBCDED@@@B@BABCACC@@@AAA@AAECIC@EOACAEAADABCAC@@@@A@@FQ@@@@@@E@BE@@A@C@A@C@C@A@A@@C@CA@C@@ABCE@CC@AF@@CBCFB@CBA@@@@@@@CBCEACA@AIE@G@A@AE@IA@@@K@@B@D@@G@CBKCAA@GAAB@FI@@D@BGAAA@A@A@@O@AAA@A@ABAJ@B@@@HEDEA@@GHG@IBK@AA"],encodeOffsets:[[120854,38115]]}},{type:"Feature",id:"371603",properties:{name:"沾化区",cp:[118.098902,37.69926],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@JFBDBBHBADB@LBNDHBCFB@NBADHBADBBB@AD@@B@JDDEBCDAD@JEBDF@B@B@DBJBD@DEHOAABE@@NY@AA@I@@AAE@AB@H@@BDBD@@CH@AI@@@EBAH@HCDA@A@@AC@AC@AA@CBABAD@@A@A@AI@@C@CL@@EB@B@BCBABEAABC@AEA@CA@AFK@A@AAAAACCICG@@ACCE@AL@CEE@@C@GAA@@LIB@LA@ABC@@@@@IAAAAICAAGBAG@CA@E@@@@CBCAC@C@C@AAAK@I@@AC@E@ABC@@A@G@@BA@M@ABCC@CBACAKAEEBAC@CAABGBCACCCEBBE@IKAAAAEE@AAAEDAAECAAAAA@AA@@FA@@CCAAIKAECG@ABGE@AEAE@S@CBABCDGCEACBABABCDGCGF@DGA@AKAAB@@@DAFA@@@E@ACBA@AAA@@C@KH@BABCAEIQAABC@EC@@AECBA@CM@ECGA@ABG@C@BIE@@AH@@CC@AE@AAA@CECA@I@@CBAJABGC@@FABE@@EBAB@@K@EAEACAACAEGABCC@EDEAA@AKCA@K@ECEGGBA@A@@ACAIBA@@IA@A@CBGBOFAE@@C@A@@@BCCA@C@C@@EA@@@@A@ICA@CACAGIIBA@EA@@@CI@QB@@WBGBIFIFEJ@@EJ@NKJKAW@@@MA@@O@GBADI@IBEAAAA@CHDD@B@@AACCDCEAAABCDBDCAAAE@AB@@CDEBA@C@A@CAAIAE@IIC@GAAAA@BDC@EB@BB@CDGAAB@@@@C@CF@B@@AB@B@@@DGABA@C@@AA@@E@ADAFAFABABGE@BCBBDA@ACC@CB@DADABC@@D@@@@@@C@@EA@@BCAAAC@AAE@EDCDCHMBIBABBDG@CCCDADBDDHADABAAAAEB@BBBA@CBD@B@@B@DA@@@ICEABEGCA@G@SAIAE@A@OBKDEDA@GHGHCF@BCDGHED@@EFALABADCBEBEAAAA@AAQFC@KBE@@@A@C@@DEDCL@@AD@@AF@FA@E@@@@@EAAB@D@BGFF@DBFBHD@B@B@B@@@BABEB@BBFDABDBB@BA@@BHH@B@@@@@@ABABA@@@@@@@F@@@@B@@E@@HF@BH@B@@C@@B@J@BCA@BAXB@NFB@DB@HE@A@AH@H@DJ@@F@FALBFDFBFDFD@DA@AI@HCDEBCDATCCC@DA@@C@ADCHBDA@ECB@A@ABAFB@DB@@EAE@AD@BDB@@@B@@AL@JDFDBBF@F@B@@BC@A@@BJABEF@@E@@DBB@B@FA@@BCF@ACB@BBDB@A@@JAD@ACFBF@@EFADADB@BHFJDJ@B@B@DDBH@DBBDABDBHCB@FB@@FB@BB@PB@@G@@D@DFDHCDB@@@D@ZEB@@B@@@BBFDBDB@BHFFC@@B@@BDHBF@@CDADBJDDCDCBGB@DBB@DD@AGD@BHFF@@H@ADFL@LB@J@BBFFD@ABCAE@CDADBBLB@FBBRBFBHDDHFBB@@@@AB@@@BAJ@@BABA@A@DBB@@ABC@AA@@EA@@@@@E@A@DCDIIE@@BAB@FEDAB@FB@BLFHFFBD@@@FAJAD@DBDB@@@JBB@@AFDDDBB@B@B@FBDBFE@@BBDDB@@BABCCCD@F@@HBD@BG@AFB@BAHABA@@@GRDDLB@DBCAE@GDEDB@@AL@DB@BED@F@CHKREL@NHXHhDFLFL@B@L@H@DDFHFNAV@NFVHTFX@DABAD{fCF@DDHJPNTX\\GVIL@NHNBJALGPCJFJHJDDBB@D@DADCJEFEHDBBBPPZZNRFAJCLAN@^@`AbG`E¸E\\A^CQaACAACCCAECAA@CBADAJAD@F@@ADABCBCDI@A@AAACACAAA@AAE@CCEAG@CA@CACAEAA@AA@@@ABADE@CBC@C@C@E@C@ABE@CAEAI@EAC@A@GCM@SAI@ICU@@@CDABA@@AG@CBAFCBAGCCAEA@MBG@IBC@K@@C@A@BEOAAA@ABEDAD@JBF@@GBCBG@A@CAE@A@C@QJ@DA@ABGEABADC@CDK@@DBAF@@DCDIDM@AA@AABCBAEADE@CA@E@AA@ABA@A@E@C@CBG@CHABCFQB@B@@B@VAFDB@@D@HEAEGB@IH@FIBEAOBE"],encodeOffsets:[[121097,38753]]}},{type:"Feature",id:"371621",properties:{name:"惠民县",cp:[117.509921,37.489877],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@MCE@EAEACAE@@@CAEAKGA@AAIEGCAA@@OAEAIACAEAG@@@EAIAEAA@@@SCECCCOOAHA@AB@D@B@@CFABAL@BADCFAH@B@@@@@BCLCL@B@FCL@TAHCHGLEF@@@@EDABCDGHA@IH@B@@AFCFCDGHCFABAB@@ADADCFDBBLBFAB@BDDFDD@BAHADDDBFAD@F@D@@DEBCB@BABDHF@BBAHF@@CBACEDBDFJA@AHABBBB@B@B@@C@BD@BKDAB@HBJD@@DE@@D@@I@@F@FCL@DEFGFCDGFAFCHEJAH@@@DAF@BAH@PDF@DBDDL@N@BC@ABC@AFFN@@DBFDMPGHGHED@@@@OJID@@EBOJKDMPCDFL@BBBBB@BB@BB@@DF@DBFBNAD@D@@@@@BMPGH@@@@A@ABGDC@K@E@OAOC[@G@SDGHHJRHCD@@@@CDMD[FDPFD@@@@FDPDF@FBB@NBBBHJ@J@DDB@@FBJDJHBB@@B@@@HFBBFF@BBBHHFDFDPEBA@@@@HEHCBADDDAF@DA@BD@BBB@@AJC@AHA@CD@AADA@@AAHCBANENANBB@BBDBFDBBBBFJ@BBBBB@DAF@FEN@@CFEAAB@FBDF@ADEDGBA@AA@@CBEDA@FDDBF@DDD@BAD@D@DB@@@AB@DBD@DFDHBBBBD@@BB@@AFGDAJ@B@DJFFBBD@AH@HBBFAD@H@F@D@@@B@@CDADBD@B@BEBAD@FFDFDB@@@@@@@CDAHED@DI@GB@D@B@AC@@DA@C@CBAFEBAD@BA@@@CA@@CBAL@FCH@B@BDBBABAD@@BHBBDA@FHB@@@@@@BDFFADABAA@D@D@BBBB@BBD@B@BGDCDAD@@@DB@FB@AD@BF@@DDB@@F@LD@BB@B@@ABBD@@BBBDBBDNFBEFAF@@CB@@@LC@AHAJADADA@@D@FAFANEFA@@F@@CHA@AD@@CJ@@EA@@CFAAC@@@A@A@AECA@@O@AAI@A@ECOEAE@BG@A@A@A@CDA@A@@BA@C@A@CBADC@@AACAE@@Q@OAIBE@MPBD@JGDBHB@@B@@AF@@BJ@@B@DFB@@D@@BEXJ@FBF@DDD@FF@@LJFDDDFDF@FAHH@@@@FHBB@@RRB@DBFDB@@AHAFB@BAFE@CBAJB@@BJABA@EHCF@D@D@DA@AJAHBBCACA@BC@@BBABHBB@B@@@H@@@D@D@AJB@B@F@HHBBAHLBB@@CFCHAB@@GBEB@D@BCBCBAD@ACBO@AD@DMBB@DB@@@BGAAAA@AB@@EFABCBAHGDA@@BCLIBA@ABC@KEIA@GGIEACCGBKDG@@@AACACAACAC@CBA@@@A@@CBCFCF@@CBEC@AA@K@AC@A@@ECAED@ACCAQC@@C@OB@B@@HD@@A@CB@BA@E@AD@@AJIBE@@@@BGAIAAC@CI@ED@BAAA@AB@BECA@A@AF@@E@C@AAA@CHA@@@@@M@A@E@IBE@@BEFMP@J@L@@@@@D@B@JCDIEEACCAA@AAME@C@@@@BE@C@@BA@CBAEA@@KECAEC@AKGAAMISO@@KGCAAAEAAAAAA@CAA@C@G@@@E@G@EBIBC@A@EBWBE@CBG@aHA@EBA@A@ABKBEBABCBCBCD@@EDCDKHABA@EDIBC@CB@@A@CAOGEAAAGCEGACGGEG@AEGAE@AAK@C@EAG@@@AACCG@@AAII@@@@AAACAAIC@AQECCCC@AAE@CAA@A@C@AAEAG@GCM@I@@@@EI@ACEA@MKCCEAKCC@A@GA"],encodeOffsets:[[120384,38041]]}},{type:"Feature",id:"371622",properties:{name:"阳信县",cp:[117.603339,37.632433],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAIHC@OA@NAFBJ@P@RF@DBBB@@CDAB@D@B@DAB@@@BCB@D@B@B@BAHF@FBDP@F@BBJ@B@PB@FD@B@B@B@@BDEB@DB@@FI@@DC@@BGB@DE@@@EBMFEBEBC@@@CBCBIBGB@BKD@@A@@DE@EBAFMEACCAAA@AC@AA@BA@A@@AKCE@@@CA@CE@@ABCA@@ECA@@C@CBCDAHA@C@AAA@AA@A@C@CBBBABCEEAC@@@@@@GA@ECBAAAG@@BCBAAAACA@G@EDK@AB@DB@@D@@ABC@ABEFAB@D@DCB@@BDA@C@A@@HCJC@GFCB@D@@@@@@CACEEEC@ABAFA@C@CACB@DA@@@C@E@G@C@EBAA@GBGC@AAEECIA@I@CBEH@BA@@AC@AAAACGCEC@CAA@@B@@CAC@C@ABC@CCE@CAECB@FCDA@@BBB@HAFCBCE@AC@EBAFBDE@@FM@EBE@CAAAA@AEIAAAAECCAAAA@MAMBMFABGDBB@@CBBBC@@DGB@BID@BA@AAC@@ACBE@CBCCABGDGF@@@@ABOFECECGGAA@AEEAAGE@@A@@@AAIGICEA@@CAGJABABIBCBA@G@IBG@IBAJ@B@DAHQHENFLDNBPDN@@@B@JBD@@DF@BJJDDHFDBHDPLBBDD@BBDFHFP@B@LADAHAF@@@@AF@DAJCBABC@CBBDE@BDJBFBBNDNFLDHFFLJNHB@LFHF@BBB@HCFABA@JNABA@BHBFF@B@@A@@BADCHEHADA@A@S@GCED@@CA@A@ACCCECAOF@CI@C@@@@DAB@BEDID@@AB@N@HDDBPJFDB@DBHJDF@BBFBDLLJKB@DEBADAF@B@HCHADBLDHDDB@@BBPCJKBABAJIDCB@@@BAD@F@FADBBBDF@DB@@B@@BD@DBFLR@@LDDDB@BAD@F@BDB@@BBB@FB@F@@@BBDBB@R@D@J@B@BB@HLBB@ABB@@@BB@B@BDDDFIBAD@BHDCD@B@DD@@BH@FDFEDEFDB@@@FCBFFBF@@@AEDABHDBBA@C@A@A@AAADEB@F@BCHCHD@DBBJB@@B@F@@DBDR@B@DCAC@CAAJAAIB@@AN@FDB@J@@BBBBBB@BAD@J@JADA@ABCLA@@BA@@FA@AF@B@HCBAACF@F@BHFBBBBFFABD@DDAJCBAC@@@DCD@DE@ACBAA@CBCB@D@F@BDB@D@DBH@@FCBBLBBAH@@D@@@@@CBDJABBFB@BABA@CB@@ECABADB@I@AE@@AFE@EHED@B@DHDD@BB@DBBAFADADBH@@@BFCBC\\@B@@B@JDRFBW@ADB@A@I@AD@@@@AAGE@@GF@@@@A@@E@@@@@@@B@BABA@@@@@@@AGG@AB@@AAAACCBAE@AFABA@A@@@A@A@AGCEACAE@HE@A@CBAFB@@@@F@B@@EBE@@BC@@DKFC@CD@B@@@F@LAD@REBBB@BBFBFADABCBABKFE@@FCHGDC@ADEHGHGB@FCLCPAB@F@JBTBH@B@HDAFFBJD@@B@@C@AA@C@DAB@AA@AA@@G@CE@@CB@D@@@BAAA@@CA@@AA@AAG@AFB@G@@DA@C@@@@A@@@@A@@HA@AA@BEAAA@E@@@@GBEBCA@AADO@A@CAAC@KA@@CAC@ADEDC@A@KCEAG@G@CBC@@BEB@@EBEBCB@BA@C@A@ECJI@AAAA@BA@A@ACGIAC@C@CA@E@@@BA@@CCABA@CBAB@BC@@DCFEDBD@BEB@BAFBB@BABCAC@@B@@A@AAE@CE@@FEBAAAAECCACB@CM@@CBEABADADC@A@AF@HA@GBED@DA@KABGAAGGE@A@A@BIC@C@@@G@@@A@A@GABAAA@@ADB@BDADGAIB@BCBC@C@E@GD@FABIB@AA@BIDAF@BE@AEAGB@BA@ECCAA@QQ@@AAEG@@@@GGEBE@ECCCECKI@@EEC@CCE@EAI@FW@AC@@@EA@C@AI@@AE@@BA@@@GA"],encodeOffsets:[[120471,38359]]}},{type:"Feature",id:"371623",properties:{name:"无棣县",cp:[117.625696,37.77026],childNum:1},geometry:{type:"Polygon",coordinates:["@@DEBAMQYYOOAACAFGFEDIBC@C@CAACCGIEIDIHOBKAIGM@MJKHUW[MSIOCG@CDE|eBCBA@CEWGSEU@MBUEMEGCCG@K@A@K@KECEGgGW@MFKLQDGE@C@AFA@@CBK@@CACF@HBFAD@CKACCHQ@@B@BABG@AEA@BAHC@GA@@@EDCDDBA@AA@CCAA@@EFCAEAA@A@A@CACCBE@@AA@I@@CACAC@IBEB@@C@EAGEKE@AEAA@CBEFA@AB@@JFCJCDB@F@@@@@B@@FB@@BAD@BA@CAB@B@BA@AI@AB@@A@@B@@A@EACGGCEAQAAA@EKAAABCDCF@DBBAC@EEAAI@A@@KEKBCG@@@EEAGC@BHC@@CAA@CHADADCCCAIBCDC@@AECG@AA@@@EDGE@ACACAAE@A@@@AA@YFC@@@A@DCCGCEC@@@@HA@@OAAA@@EA@@EDAAGACCBAA@CAGCCA@A@I@ICGE@ACACBEB@FE@EABDC@IB@@@BCAAAA@BDE@AD@@EBA@A@CA@@@FE@AFIB@AB@D@@AA@E@E@AAECICK@@BA@@@A@ACC@@BBF@FA@@CEAAB@B@BDA@FCBGACD@B@DB@@CDDSDCBADCFGDJ@@BCBC@CEAECEAEBK@E@EI@@C@GBGB@F@@GCAA@MEA@QEICA@@@@AD[DAAE@@G@CACBEBABCAA@@ACCCGA@C@GF@FEF@BF@@B@JCAABDB@FA@@DABABA@AEBACIDA@@@@C@@@BGAAAKDA@EG@CAC@A@ACE@C@A@AD@DBBDA@BCFC@CD@@D@ABIDCB@CACEBAEAAEAAGE@E@BDABGDA@E@@BEB@@AB@@KBAD@BCBIBI@C@ABA@AAAA@AI@A@ECM@@BA@BJIBBB@DBDCDA@Q@AC@CE@A@@@IAAA@CGCGDADE@A@CFBB@B@B@B@DABCAAGCBBF@@E@EAAEED@@A@ADBBKDCB@DDBAB@BA@C@BBABG@@B@HFD@BDHCBBFBBABC@ABBFI@C@A@CBE@@FADE@BBGB@AC@AB@D@DHB@BBDJ@D@B@BBFDBDL@DA@BBD@BEBCBBBDABDABFFDDAB@@@@BFBD@DDFBDHJ@@@@BD@DF@BA@@@C@@BAFJBD@DABCH@@@BDBBBBBDBB@B@B@B@B@D@JF@@@@JF@BDB@B@@BD@B@FB@@BBBDB@@FB@BF@BBB@@@BBBBBB@B@BBFIBAHGFA@A@@BG@GBCBGFCD@D@BDH@B@BA@@B@JA@@GAD@F@@CDCDKDC@@@IBKAQB@@INAF@F@L@BALAJINGLCLTJFBHBNFLDFBFDADFDH@BNADAJAPCJGA@DB@D@B@@@BJAD@JABAPML@BCBKHE@IB@@DF@D@D@BBF@FDD@@BBDDHFDDDDB@@BDD@B@@@@@B@@A@@@@@@B@B@@@@A@@@A@@@@B@@@D@@@@@@@@@@@@@@@@B@@@@B@@@@A@@@@B@AA@@B@@@@A@@@@B@@B@@@@@@B@B@@B@B@@B@@@B@@@BA@@@@@@@@B@@@@@@@@@@@@AB@@@B@@@@B@B@BAB@@@B@BBB@@@@@@@BB@@@B@BAB@@@B@@BBBBB@@@D@B@B@B@B@B@B@BB@@D@DDDDFDHBJ@B@NBD@F@FALCB@DAF@NB@BD@H@B@RBFBB@L@LAH@D@FB@@DDDDFDHBD@JAFANAFAJ@F@FDB@HH@@FFDBNJFBBBBDBH@H@B@D@B@BBBBDHHFFDHFFFDDBF@DBBBD@BBHBF@JDDDBD@F@JAJAFCHAF@DBDDD@BDB@AB@BA@@B@@@D@BB@@BB@B@BB@BB@@D@DA@@B@FBB@BADA@@B@B@@@@BBDBBB@B@DB@@BB@@@@@B@B@@A@@BE@C@EDABABABDDDDBHDJ@DAFADBFFHDFDHDDBBB@@B@D@H@DBBBBNFRJHFh`BBFHHHDLHLvE[HORM|cqfYRKPG\\K\\@ZJ´P@F@PEDMYm[qÝAE@I"],encodeOffsets:[[120907,39082]]}},{type:"Feature",id:"371625",properties:{name:"博兴县",cp:[118.110709,37.15457],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@GBEAC@CBI@A@@@E@@DBD@FC@GAGB@BAHDB@BBFAB@BM@@@@DE@BDBBE@EBAJ@@BB@B@B@BAAADAP@BCA@DFN@@D@@@@H@N@BB@D@DBB@J@BBBB@HA@@B@FB@@DFD@CD@BBBH@DCBGBA@@AC@CAIBA@BAC@IB@FEB@D@B@@@DBDDDABABADCF@@AD@@@JA@GBCBCDABABA@G@BH@@A@C@AA@A@@AIG@@@MCC@GA@@C@A@E@CBEC@@CAAAA@CAEA@FE@@A@EC@CAEACA@FEAAD@@ABAD@BDBCD@@C@A@CFCDB@@BEA@@ABCA@H@HABJDD@HDDIJHHBDBBD@D@@DBHBBBAB@@JBCBBB@@CDGJCFCF@DGDADALBB@@B@@B@BC@EC@F@@D@BB@D@@E@@F@BA@B@DD@FFB@@FDLH@BB@EH@F@B@@B@@BB@BBBB@@B@@BB@@BB@BBB@BB@@B@@B@BEFAAA@CPAAA@@B@BAFD@AHB@DBAL@D@HC@A@@@@L@@JBF@@B@B@HJF@BDBFBAD@D@@@@@@AB@DEAAD@DE@@BDDF@AD@BD@B@@D@DB@B@D@D@B@D@B@@@AFF@@@@@@@ER@@@B@@D@DBBABCFBDBPB@FJDFDBBB@BB@@D@BDADABA@@@C@CFAD@D@FAB@DAD@J@BC@@DKF@L@BA@E@C@G@CBAD@JA@@B@J@BF@@@B@@@D@@BBH@B@@@@BBPAB@@BBDDJB@MD@NABGAAB@JCA@BCD@BAB@@LDHB@C@EF@D@B@BB@HABJBBAD@@EB@BB@DBB@IBA@@H@BB@@BB@B@BD@@A@CBABBB@@BB@F@@CFABD@BFCB@BDB@DA@@D@D@DB@@DBLDJFHDFBJFFBJFB@PH@@@@PFF@F@D@@C@ABE@I@EBAFBBAACBAHBJBD@@ABAB@L@H@DF@DABAFBBJA@FJ@B@B@BCBABQ@EB@JA@CBAB@B@@BB@DCB@BB@B@@@@B@D@JBH@DABED@@AAAAAAAAC@AH@T@D@VBB@@S@Q@S@@@A@QD@BAAKBCD@L@@@@@BA@EAKBAFAD@PB@@F@@A@CJBBAAAG@A@ACBED@L@H@B@BAF@@C@AACBADANB@ECAE@AABAT@@A@KF@@I@E@AG@E@ACAACAEMCEAAG@I@EMAGBABANBAAGCLQ@@DADCFGBA@A@AF@JC@@@CH@BC@A@CB@@AAGF@BIBAD@AE@ADA@GF@@A@EA@C@AEE@@IC@@IA@@Q@A@CA@I@@GA@E@AE@@EB@@CBAAGI@CACQBAC@GC@CAA@ACBEBCH@@B@C@A@AA@I@C@DMDDBAFE@CAA@CB@B@DC@A@@BEAC@@E@GD@@AEEDABE@A@@AAAB@B@D@BAAEFAAEH@BCAEI@AE@@CCCE@A@ED@DG@AB@BAAIAG@AAC@ABA@@@AB@@C@AMGKGAGDCB@@AAE@ACEAAGCCAA@G@CACE@E@CCCA@A@EBCAACAEA@AAAEI@@OAA@A@BCFGD@CAAMDGB@BE@@@@AG@ACAA@@G@A@E@GB@A@C@@@@@@@CE@AKBC@IG@@AQB@EAAE@@C@I@@AAC@GCAEACC@C@@DC@EACCCEEA@@A@@@CB@DKDA@C@BD@BGBBD@@@@@@B@@B@D@@AD@@Q@A@CB@DA@"],encodeOffsets:[[121017,37869]]}},{type:"Feature",id:"371626",properties:{name:"邹平县",cp:[117.743109,36.862989],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@EAEAABEBDDDDBBGH@DABC@AB@@BB@BAB@@@B@@G@CA@CGAAA@AB@@BD@BABA@CA@CA@A@AACCC@@B@B@DE@C@CA@ADCACEAB@BAA@AB@@ACC@A@ACBE@@A@@@@@@@AA@@CAA@E@@CC@@A@@@@A@@E@@A@@@@@@@@CEBE@A@@@@@G@@AECCI@@@@CC@A@@@A@@@@@@@E@@@EC@AF@AA@A@A@A@AAACCC@ABABAAAAC@@@A@A@A@@D@@CAA@E@@@@@MBCF@DA@A@@@AAAG@AKAGFE@@@C@CA@@BG@ACAIAA@@BBHEBA@E@AD@@@H@FBB@@@@NHDBHJBBBBLH@B@BEL@@DJJVFD@@BDSJE@KDIBG@G@CCA@I@O@I@CBABCFEF@DADAJ@BMT@@ADOLUPEDQLMFGDKD_LABKFQRIJEF@BGHOPEX@DBPFNB@FPCNAD@@CFEHAFMRABIFMDCB@@A@WJ@@@FIDE@@DDLFFDA@CBAHAB@@@DA@CD@@@@@@@BBBLPFDBD@BDBJBB@@@JCDC@GDABA@QFAFBDFBF@@CB@AFBBADCBCDCDE@ACE@C@G@@@@CCAC@@CA@@@@EACA@@@C@@AA@@CAAKC@G@AAAAB@DGB@DADA@AB@BAFA@@@C@ABA@C@EB@BI@A@@CEAE@C@CAAAA@@D@B@@IAADE@AADE@AB@ADB@@@DG@C@A@@A@GAQC@BCBCACCC@MBE@C@GAGAGC@@GFIHCBCB@@IB@@CA@@@@IGIIMAICOBC@CDCDADCLER@BAH@@CLCLCJIJEDGDC@IDMDKDABMD@@GFABMN@N@BLFJD@BB@LJFH@@BBBJDJ@D@LB@@BCDGBABABBDFDTCLC@@B@LAB@F@F@FDB@FB@@BBDBB@JFBBRLPHB@FBD@JBH@PBNBD@B@H@FBB@B@DBLJLLLLPPDDFDTD@@B@FBJBFB@@H@FBDBJBFBPB@@BBHDJFBBB@LHFBDB@@F@DBFBFB@ABGCA@C@AFB@CAA@@BIBCDB@E@AB@D@CA@AL@DBFCHCD@BAAED@BA@CACAAA@@AKA@E@EB@D@@ED@BC@K@EBAF@BABAHC@DHBB@@CB@B@BAD@FBB@HBBBB@FB@BB@D@B@B@D@B@D@F@B@DAJCB@@@B@BAD@FAB@HAB@D@B@DAB@B@BA@@JCHADA@@FADAFAB@BAJABA@@D@LAB@JAHAH@DAD@@@B@B@B@D@P@D@BBB@B@D@F@DAB@B@B@DAB@@@BADADAD@B@@@D@@@F@H@D@@@F@BBB@@@@@F@@@D@H@B@B@NFB@B@B@@@@@B@B@FBF@D@B@F@DB@@B@B@@@B@B@B@B@F@D@B@B@B@D@BAD@@@B@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@DAD@HAD@D@D@D@D@F@F@H@B@BAB@F@DA@A@@AE@AAAB@A@@AA@@A@@@C@@@A@A@@AC@CBA@EAA@GACCGCCACH@@EBCHABA@ABB@BDAAAB@D@@BH@@EBA@BD@@DN@@GFADC@A@EA@CA@E@@@@BBB@@AB@@AC@CA@A@K@ABCAE@ADAAIB@D@@CZAB@D@DCF@L@LAB@F@DA@C@GF@AS@@@EF@@E@A@IDAFCF@@C@@@@FAAC@@@@FABCB@@@HADCBABG@OBC@@@A@@ACAG@E@@B@BD@BDF@D@@@B@@@BDFFDBA@E@AD@@@@@@CA@CC@E@@BABBBB@HB@BA@GD@@DBBB@BB@@F@@BD@FA@@AWC@A@@EAAC@GC@EAAG@G@C@@ABA@@@G@EE@C@C@GC@@BG@AC@EBE@EE@@@AAC@@@@@@A@@A@A@@AQ@@A@C@A@A@CA@@GBE@A@IDC@E@A@@@@C@AEAAA@@A@BAFA@@@@GA@@G@@C@AO@@HEBE@ABA@C@@@@A@E@AGBAA@@AAEB@CG@@@G@G@Y@@AC@@@CAAC@E@I@@BAHE@A@IAC@AA@@@@EA@CCAE@AACC@A@@@A@@@AE@A@G@@AIEA@@@CDA@ED@@@@@@@@A@EA@C@@GA@A@A@@G@AD@@EA@@@@ARA@@BK@I@A@@B@DDDEBA@AB@@B@@@BA@@B@@@BA@B@B@@@B@@@@@@B@DDAB@HAFEBAB@BC@@@E@@BABADCB@ACACAA@A@CDCFA@AAE@@@@C@@CG@@@ACC@A@"],encodeOffsets:[[120671,37629]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
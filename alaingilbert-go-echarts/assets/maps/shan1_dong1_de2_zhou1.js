@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("德州",{type:"FeatureCollection",features:[{type:"Feature",id:"371402",properties:{name:"德城区",cp:[116.29947,37.450804],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@AAGA@A@@IAKA@IB@@IBA@@EA@ODA@CBC@CAAAA@I@ABED@B@@ABQ@AAC@ADADADAJA@CB@BIB@@CBBDE@@FID@@@@BF@@@@E@@EC@@F@@@FC@@F@@@@@@F@@D@DE@@JH@BBABG@@H@B@@@FIA@B@DGBBD@@@@F@@DJ@@@@@B@@B@F@@@@A@@B@@@@@BBDDB@B@@BBABB@CDABGJGFGDGBGDIBCBA@UFOBCBA@ABFFFFFDHHDF@B@@@BBB@B@@BBB@D@BB@@@BB@@B@BB@@@@AB@BAB@@@B@@B@B@B@@ADAB@@@@@BB@@@D@LAD@@@BB@@@B@@@B@J@B@@@B@@B@DBF@HBBBB@BB@@@B@BA@ABA@E@CBA@AB@@@BAF@BA@@@A@C@@@@@A@@@@@@B@@DBDDBD@@@B@@A@C@ABA@A@AACAA@@BC@@BAB@@@BB@HDB@DB@BB@@B@B@@CDA@A@A@AACCAAA@@@A@@B@B@@A@@@@@@@@@A@AA@A@@@BA@A@@CIBAA@AC@@C@@C@@AC@@@C@@DQBA@AB@BB@E@CCBDA@@B@B@@@F@FD@@DBD@@@CB@@@@BB@D@@HFB@DB@@DFA@DB@D@@B@@A@@JBB@@A@@BB@@B@@IB@@A@ABA@@DI@A@@HCBBD@@@@@B@BBBC@BBCB@FEB@@@JB@L@B@@@F@@@BBB@@B@@CB@BB@@@DJB@BBB@ACB@@D@@@B@@BBDAAEB@@@@C@@@@FF@@@AB@@BDCDAFABAFACGB@LGFC@@BA@ADA@@@@B@@ANC@@@BB@@@B@@B@BAD@BA@@@@@ABABCDAB@BA@@@B@@BB@B@BBF@BBBBB@@@B@D@@@B@@@B@FBB@B@@B@@@@@B@BAB@@A@@@A@E@@BA@@@@@@@@BBBB@@@BBD@B@BBB@@@@BB@@DBDA@@B@@A@@@CAC@A@@@CD@@@B@BBBBBDBDDFBDBDBB@@@@@@BA@@BA@@@A@AAC@EA@@A@EBE@A@A@@@@B@@@@@BBD@BDD@@@B@BA@@@A@A@A@CBABAB@@BBBBBB@@B@F@F@DADAB@@@B@@@@BABADABCDAD@BB@@@BBB@B@@B@DBF@FBF@BA@@@ABCD@@@B@BB@@BB@B@HBB@@@@B@@A@@BABABABBB@BB@B@FAD@B@@BBBABABAD@B@B@DB@@BB@BAB@@ABCBABAB@BBFBDBB@DBFDBBB@BB@@B@@AB@B@DAB@B@B@B@@BB@FFBB@@@B@@ABA@E@GBC@C@CB@@AB@@B@@B@@D@B@D@BBB@B@BBBAB@D@@@B@B@B@B@BA@A@C@A@@@@BA@@B@@@DA@@@@@A@@@@BAB@ACF@AGAAD@PACEBAB@FJHABBBDDFLCCEXE@CA@@AB@@@@@@@E@@BA@@A@@@@AA@@@A@@B@@@C@@@@@@@@AB@@@D@@UD@@AB@@ACCC@AA@AA@AACC@@EGFCCCBAACDA@BBA@@@@@A@AF@D@CAHA@@B@BB@@B@@@@@A@@A@@@@@@BBBBB@BCDEBCDANELEHILSFIJKHEHGNI@@BB@@DBBBBBBDBD@B@DBHDHDLBFBD@@FJDFBBDDBBB@BBBBDBB@BBDBD@D@LBHBD@DBB@BBBBB@BDDFBBDDDDBBDBBBBBDBDBFDB@BDDDDHDH@@DCDEFEB@FA@@@@F@JALABAPA@@F@D@D@PBLB@@BBND@@HS@@@CFA@AAAB@@@HCDA@@@@@A@AD@D@JADBBAACFABACG@@B@D@AEAACBEBE@EACCCAGBA@CDE@EACAECFCAGBCFADAD@D@@AAA@@CI@C@IAAA@AI@@MBCA@@GCC@E@AAAACGEBCGBA@AHC@CB@AM@@CU@@@AECEBA@A@CA@AA@C@E@BFID@D@@E@ACEBQCE@@FABAB@@CA@A@AGCC@ABC@@D@BDHBDBF@DADABEFADA@@@ACGB@@IBAG@@@CE@AGE@@C@@BAJAACAK@E@A@AAA@EAC@A@@AC@A@AACAAAB@HA@A@ES@CG@@EDAH@BA@C@AH@DAB@J@@EDBBA@A@E@ACAAGAEOBC@AA@IACG@AAEBAEC@I@IBG@ACAEEDAAC@AA@CDAFAFBFAJCDABA@ACACCCAEC@AECCACCECGGAAAAAGACCACAAA@@AAA@A@C@IAG@CAC@EACAECCCCCAA@@ACCAAEACCE@E@@AI@CAE@CAAAACACAA@CAICAAAABA@EAADEBCD@D@@@AAAA@ABA@AAAAAABGC@D@@KAAA"],encodeOffsets:[[119161,38179]]}},{type:"Feature",id:"371403",properties:{name:"陵城区",cp:[116.576092,37.335794],childNum:1},geometry:{type:"Polygon",coordinates:["@@GEIAGAGFECA@IIABADDBD@@DMAGA@A@A@AA@C@EA@A@CAB@@@BE@IGGCAQDAHCD@@E@C@ABEDCBG@C@QAQ@@@C@GAKAIAGAS@@@@AS@CAMAEGSAGA@CI@CEKCGCG@ACGAQA@@CIGIGA@@@AAIEEC@@A@@@GCCAAA@@@@@@A@@@@@@@@@@@OE@@A@ICMKAA@@@@@E@A@ABACEACACCE@GAG@@@AAK@@@@@@CGCGEK@@@@GMIOEI@A@@GMGKSO@@GGA@]C@@GAC@IEIGEEEGGISOECAAA@QGIBKBMH@@OHM@EBG@A@SFMJ@BIREHEF@@@@ABABCFSAEAKCEACAEASDABMJ@@@@CDG@CBYHSJSHMHGDCBCBEBGDKBKAC@A@eCMBG@eDW@A@aBI@aDK@QJGFIFEDADBHBB@D@H@BBH@@CE@@@BBD@BABEBAAAC@@CAA@@B@D@D@BA@A@@DBJ@@@FDFBDBFDBBD@@BBDDDDFDDBFBD@DBH@JBD@B@B@BB@@BBDBDBBDBHBBBBHHFDDDDBFD@BFDDBDDDB@BABCBIDEBEAEBCB@DBBD@BBFCBFBDH@JAJ@D@BFFABBH@BD@JBBD@PABFBHDB@B@F@BABCA@FI@A@CBG@@B@DABG@CB@FH@@DFTB@B@@GBABBBD@B@BBD@@@BBD@FBB@B@B@FBLBDIBAB@@@DF@BHF@@D@@BHJA@@HABD@@B@BCFEBABC@CAEACCG@A@CD@BAD@HD@B@BDB@@BABA@EF@RDFABDF@@@@CJCAEF@D@B@@BDBB@B@FAFD@B@@DV@@BNA@@DGD@BABDHFADHBBBBF@D@HD@@DBNA@@BJB@BB@J@DDJ@@BB@BC@C@CBEBADBHEDFDDBFBF@DCB@HADBDDFBF@FADABBBFC@A@@@DHABEBBDDFB@@BFBNFDF@@LPDD@@DFDBPDHDJB@@H@PFDB@@HD@@@@DDDD@@DDB@JFLFFD@@LLB@@@@@@B@@@@D@B@LDBBR@PAJA@@FAB@HCLC@@DALENGFEBA@ABC@ADOJIHAB@LADBBB@@FDNDLAD@@@H@NBPFHBNL@@BB@@HJ@@@@BBBBBDBBBD@NADAB@DABADAF@J@@@BFFBBRDB@B@DBB@BBLBBBND@@B@HBD@FB@@RDTDH@H@@@JG@QBCBCFEDEFIDCHI@@BANSKAAIACDEDIBAHGBAJEFAFARCDIBABA@EBM"],encodeOffsets:[[119755,38427]]}},{type:"Feature",id:"371423",properties:{name:"庆云县",cp:[117.385256,37.775349],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@ECOICAGCM@A@@BC@CJAFA@CB@@@@@DDJE@BPFDDDBDB@B@@DC@DF@H@T@BCBGBGFCDAB@@@BA@A@@@@@C@@DA@E@ECGEC@GACECAC@ABF@BB@D@BA@G@AA@@@C@@C@A@@BDXDB@HBBABB@ABGLCFCBGLDABBBB@BAH@L@B@H@DADC@@AAHDJHDKF@@@BBF@BBDA@@BC@@B@F@BH@@DA@A@ABABAB@@@@@B@F@@JXDHFH@BFD@@FF@@HF@@BBDBDDLAD@@BB@A@B@@BD@D@@@B@@@@BB@D@DBB@@@B@@B@DA@ABA@@CA@@B@BC@AJC@BBENKDEBKFGHKLABBBJ@B@NBB@J@F@@@F@HADAFAHEDAFAFAF@J@HBB@J@DABABAHAHEFADADADAB@FAFB@@JBHBJBDBDBHBFBBBHDHJ@@DFBBFJFFHL@@HHBDFHBBFHDHFJBD@@HLDHDFDDHJBDDDFDFDBB@@DBBBDDDBFDDBBB@@DB@DBBBBBFBHBDBFBB@BBHBFBD@FBF@FBH@FBF@B@HDFDHDDDDBDDD@@HHFFJAF@LGDA@ANKBOBA@IBCAI@@A@C@A@@CHBDIBOBIBCAMG@ECBCECEAKCMEGAEASIDKHKJMBIBK@A@K@EBEJM@@RALBJA@@D@LCDCDC@@@EBC@HB@@I@AB@@A@ACG@A@CDCHEDAHAH@@AB@B@HEBGJAAE@A@AAAAAAA@@A@AAE@@AEA@@CAAA@AA@@E@AAC@@@ACA@AIE@@@@IEC@A@A@A@A@A@CAAAAACA@A@@DGBA@CACEIAB@@@D@@ABE@@CAC@@@@GIACCE@CACAE@@@@BACCEEBAACCBAADAFA@AAC@ACBK@ACECAAA@C@I@AC@AGA@C@CBAD@@BHAAAF@BC@EF@DAB@D@J@AEBAD@BAAAAEDACG@AEC@G@AH@BAAAD@B@@ABACA@CDALCAABCECCFEFECG@@AC@@C@ADCGC@ABCJACECC@A@AAA@@A@BAA@KA@GAAA@I@C@Q@A@CAAA@@E@A@@EAA@AA@ACE@C@ABA@CCKC@@KQAE@CAC@@@AA@@CCEAACAEBE@C@AB@@A@CDIJABABILODAA@@CAGCKCCAGBGDA@E@CBABCFA@ILKKACAE@ACEGICA"],encodeOffsets:[[120209,38563]]}},{type:"Feature",id:"371422",properties:{name:"宁津县",cp:[116.800306,37.652189],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@A@@B@AB@"],["@@@@F@GUJI@@B@@DH@@FF@DLBBB@@AAIBB@A@@AIAEC@AGA@@@AA@ABA@AAI@A@@@EBMBKBCHOLOBAJOFM@@BIHIDC@ADENY@ABAFOBGBA@CHEDE@M@@AEEQ@A@CBC@EHKDE@@BALKBAFI@CBG@CAEAG@CCGCCCEA@CCAACACM@A@C@I@@BE@A@@AG@@@CSIEA@@GAMCEAGAA@KIAAEIGEAACA@@CAEACAGCC@QIG@GA@@C@MTAB@@GJCDEJCFEFADAD@RIH@@G@G@SCQC@@EAC@GAA@@@MCAAKAAAA@CAA@A@QCAAEE@A@@@IBEBCBA@CBABC@MACAAACAAAA@@@@GI@@AA@@MKGAOEMAG@@@C@KBMCEC@@AACAKBA@GBIJCP@BAD@BABEFMHKFCB@@KDGDA@EB@@IBOBQ@AAKCA@C@@@@@@A@@@@A@KK@@ECKEIEA@CC@@CCCC@@@@GC@@CAOEG@@@IAGCOCCACE@@CCKO@@CEMEEA@AA@CEABCAIBC@C@@B@B@@@@CBGD@@A@BB@BEB@D@@GT@@MCAA@@KAOAC@C@E@@@OBABKBIBE@@@@@EBA@EFCFCD@@FHBBDDBBBBHDJDFBD@FDDBBBBFB@@B@@@F@DBB@BBBFBBBDBDBBBB@BB@@BBBBDD@@BBBDDBEDACGBDDBADFPHBA@BDDDDBB@@BBDBD@@B@@@@B@DBBB@@B@BBB@BBB@B@@@DBB@BB@@@@@@BBD@BBB@@@BBDBFDBBDCFF@@ED@@BBDDFDDD@BFDDFBBDDB@DFDDBDDDDDBBBDBB@BB@BDDD@BDDEBHJG@@FCBBDBDD@BDD@@CH@AGB@JFDC@@FDFFDDBDBDDJBBFRDJBJDJ@BBB@DBBABDB@@BDBCBB@FA@@@BBF@@A@@ND@CLBBBFBAB@@ADFBBBABC@@DD@@BB@@A@@@BB@@B@@@@B@@B@@@CF@@CAABAD@BABB@@FDJFDBHFCDADBDA@@BJ@@BK@AD@BBDDBG@BHD@BDE@@BFABFFAHAAEHACCDAHB@A@@B@BAD@B@DBBABBDBFJ@BBFBFB@BJ@@@J@BBLEBBJG@CBCBBFC@@BB@BJB@@GB@FBB@@BB@@@DC@@DDD@@@FFBFHFBBBBDDDBNLLHBBABBBAB@DB@FJ@BC@@DE@@@FB@@A@DFD@BD@A@@D@BBBBABF@H@@BB@BDD@@AFAH@BINB@EB@F@D@B@BAHCFCDAD@DAB@D@F@FBFBB@FBF@HDD@DBHBJBBBF@HBB@H@D@DBB@B@L@N@D@D@HBJ@HALAHADAF@JA@A@@@AB@@B"]],encodeOffsets:[[[119751,38749]],[[119751,38749]]]}},{type:"Feature",id:"371424",properties:{name:"临邑县",cp:[116.866799,37.189797],childNum:1},geometry:{type:"Polygon",coordinates:["@@RHB@BBFDTPHJFHFFJHJFD@HB@@^DB@HH@@TPHLHN@@@BFJJPHN@@@@FLDHDH@@@@@@BL@B@@BH@HDFBDBDDFAB@B@B@F@@@@BBNLJDB@@@PF@@@@@@@@@@B@@@@@@@BBDBHD@@B@@@FDJFBB@@B@JHJH@DB@BRDH@BDHDHFL@DDJB@BHHTBFBN@DBT@@@@BTBHBJBL@H@D@@BR@R@DAHCDAF@B@D@FC@GDCBBRHDJHF@@A@@BA@D@BFBD@B@@B@B@BHBNB@CC@CABCBAJJB@FDHEHBJBHFDCP@B@TBLBP@B@PANCHCDABAFCLIDIDM@@@@@A@K@@@@@@@@BM@A@AFIDALI@AGMEKAAAKPCLAHEDCKMGGECEECEAA@@AEAE@@AABQBIGMIGEEGM@@A@@AEK@@@@AA@AHGHIBI@@BEEIKGA@OGGGAA@C@IBEDIDEDEBEBGBG@ADI@C@@@@BA@C@@@@BIBA@A@C@@@@BKNCLC@@F@FCFALGNKDE@CA@KBAAA@BED@@A@MDBAAF@B@BC@GF@@ED@B@@ACA@GA@@@E@CACI@CD@B@AG@AAC@AC@C@CAGGE@@EA@FA@@BAAABCN@@GD@DBB@@YBBF@@@BB@@@@F@FAFALEBIB@@EJAAC@CAC@S@EAAQ@CA@A@ADC@@DK@G@@AADKFADA@C@@@AIA@@@BGAEA@@AA@CA@AAA@@Q@A@@CA@GA@A@@FABABIAI@IAC@UDK@A@MCAA@@@@GAECWGIAIAICG@MAAA@@IAEAAAIAGA@@EAEC@@@@EAKGAKBKDG@@BCFK@OFQ@CBANIHCB@FAJK@@CCGGIAOE@@GAA@OEEACAAAA@EAAA@@@@ECEEAAEECGA@AAACA@YFG@EDIDA@KBSAGAG@MGKE@A@@@@CEIIGE@@AAS@C@FTOBC@G@CBCBCFADEHKDKAEFGNADAFIDA@MBI@@@@@C@I@C@BL@@@@@BHLBD@@BFBJAJEDID@@EGEAEBFHAHKJADCBAHAA@AGDQPEHI@@DHFB@J@BBF@@@@@DA@J@D@B@B@HAB@@DDDF@R@JBF@BDZ@DBDFVDHDNHPETABEPAB@@@@ABGJ@@@@ADAF@@@@@H"],encodeOffsets:[[119520,38102]]}},{type:"Feature",id:"371425",properties:{name:"齐河县",cp:[116.762893,36.784158],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@IAA@KAGAABB@BBABFLADC@ECGC@@@@@@AB@@@DE@HR@BABC@SIA@CA@B@@KH@@AACBCDA@CF@BBB@@@@BDBF@BABEACB@FC@BDBB@@ABMBKBCDAB@BA@@@@@@@AA@@A@AAAAAAA@AA@@AAK@CBA@@@W@ILAH@FDHDJ@H@B@HCJADGNCDA@@BEDCDGHEDGJGFEBEDGDMBKDGBCBAB@@AJ@@AH@NBLBD@JBD@BBN@@BD@DDNDL@DBJBFBH@@@D@N@BBBFF@@BD@B@DGFAF@@CHCH@F@@AH@@DHFHDFB@@BBJAFALAL@D@@@L@JCF@D@@AB@@@BCLBP@H@@@B@@AFHAFANAHAFALANCD@FAJALAB@@ANCB@LCB@PGHABAJCDAB@HA@ALABAFABALA@@F@DARAD@RANDB@DBB@JD@@@@D@BJCJABCDCDCDCDIT@BFLBB@@JHHHBBHL@DFLBH@DBH@@@@@F@BDLBF@BDFNN@@NFLE@@@@B@@@BAFEDAJC@@PBHDDBBBFDDDJLDDDDDFDD@@HJFDFHFHBDBFCPEF@@GFMJEN@BFNBHPNB@JJHH@@HH@@DBPF@@JBLKFE@AB@BEFEHGJEFC@@LIJCBATGLHBBBJ@@BF@DDDFH@D@BBBADAB@BAB@HF@BFF@BDA@@D@@C@IBA@EN@BAD@FBD@BDFDHDF@DBF@BBB@FBDDFB@@@BDPJBHA@KADNFADJDAB@BDBABB@BAFCLCHCDGLIJEFCD@@EJCHEZ@F@@AB@J@HBH@FBBBHBJBFDD@DB@BHVJD@J@D@@@@@J@NAB@JCBEBCHMFELBLCFGBCDEDADAH@D@PAESD@T@BB@@HFJJDF@@@@@BLFNHH@HBTBLAB@JCFCH@ZEB@C@@AEA@CBIBQBCFCFEFAAG@A@I@E@CRBB@@CDAN@DBD@F@FAH@B@D@@AA[A@C@CGA@GM@@@AB@JA@@@@@@@CHCDA@C@@JCHAJCFA@@BALEBC@@BIBC@ADOBI@E@@@I@G@@@@@C@@@CAEAOBC@EBQ@I@CGKA@CAE@A@A@C@A@A@AD@@CBAB@BC@A@G@C@AAAA@CDA@IBA@C@A@CC@AE@EGAIAGA@@A@@DMBABCAA@BDGBA@B@[FGFABCE@AAC@ACEB@ACBCBAH@@ABCAEB@ACEBAACAA@IBA@EBAC@@@@EK@CACD@CKAQAO@C@@@@BGDQDGB@BAHBHA@@F@@@B@@GDB@CBC@E@@A@@CBAKC@@IECAIE@AAAAAAAA@@ACACAG@@@AAM@E@@@GAA@IACCA@GAGEGCEACAAAMGAAAAIG@@CC@@AEBA@A@C@AAAAACCC@E@EBE@E@@@@@AACC@C@AAK@@@CBA@E@E@CACAG@AAEAAEE@@ICGEA@AACC@AAC@I@@@E@C@CAC@ACE@@ACA@CAGCIE@@A@@@CAKEE@CAGCGCA@QGA@E@M@GBG@CC@C@ABWBCEIIGICC@GAA@O@GAC@CCAA@ABEDGBI@CAG@AEEKIGECAGAGBG@E@E@CCCICGAAACCCAAGECCGEA@@CBABA\\KHA@@BE@GAEECCA@@C@E@E@A@I@C@IAAACCECCEAC@C@ABABCJATCDCBC@@AKAE@A@@@A@CBADG@ADGBABCFKBE@@BC@GAACGGCEEMEIAICGAA@UCCAE@AAIAO@@@"],encodeOffsets:[[119360,37286]]}},{type:"Feature",id:"371427",properties:{name:"夏津县",cp:[116.001726,36.948371],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACCBACDAAIMCE@@@MCC@]D@@G@AB@BEB@BCB@DC@@AC@AAC@@ECAA@A@S@E@MBE@@D@@@@A@BBE@@DSB@CD@@AF@@AI@GB@@E@ABA@KBQFGFCBIFA@IFMBE@@@@FC@CD@LABA@@AA@C@AA@EEQCC@@@@CACA@DA@@DB@@D@@A@@@@B@D@@C@A@@@@CB@@@@@@A@@@CB@@CA@@BA@I@GBCBAFHBHAAHCDCD@@E@A@AAAABC@@C@IBCBCD@DK@@AAM@@ABEBGBCCCCAAA@@@G@AJA@I@K@C@@B@@K@A@A@@CI@AAACBC@@@EI@A@BPABE@E@A@@F@HEBA@A@C@@@CAA@@@BB@B@@@@@@AB@@@@AB@@@BA@@@@BB@@@@@@BB@BBB@@B@@B@@BA@@B@@A@@BABA@@@@BAB@@@B@B@@@@BB@B@@@@BBB@D@BBBA@@@@B@BA@A@@B@@AB@@@B@@@BDB@BF@@@BB@@@BBDBFBBBBBBBBBBDBBBFFHDJDF@B@@@BAD@BABAB@@CB@@@@AAACAA@@AA@@@@@@A@A@A@@B@@@@@@@B@B@@A@@B@B@B@@@B@D@@@@DBD@B@@BABAB@@CBEBA@EB@BA@@B@@AF@BB@@B@@DDBBFDB@BBBB@BBBBBBBBBBB@@@BB@@@B@BAF@B@B@@@BBBBB@BBDBD@DBBBB@@B@B@BB@@@ABAB@B@B@BB@B@B@B@@@BB@B@@@B@BAHAB@B@@BB@@@@B@B@B@D@B@@ADB@@B@B@BBB@@BBBBDBDBB@B@D@BBBBB@BB@@BDBHDFDB@DDDDDBDDFBDBDDBBB@ADB@@B@BABCD@@@B@B@@BB@@B@D@BAB@B@F@TIFAL@J@F@@@DALEHEFCJEJCNEP@FDJBHPBH@BBF@J@FBLBB@B@@FNBPBH@@@BHLBBPHFDFDLHHBDBFGDIHKDENILAB@NATBBBNF@FB@DDDFBL@BD@DDDFD@D@B@BLDJ@FBB@DJBB@@BDHFFDJBBB@PAB@@AB@DDB@AAD@@DBBJAF@@BD@B@B@DBJD`HBBN@LBZBVCD@DAJ@LEBCPCAG@EFAAEB@L@BB@FBA@@@ADED@D@@BDBDBDAAAF@FA@A@GHM@AD@@A@ADALA@K@AB@DBBC@IB@PMPI@@LIDCHEPGJCJKDCD@BI@ABA@CA@@AF@BAECA@EAAA@AA@ECAB@@@CCCCBA@EG@BAAAAB@KMA@@@AAB@EIABAA@AB@CCGKCCIC@AAAAEAC@CACB@@C@@@AEG@CD@D@@DB@D@@CB@B@J@AC@AAK@@@@AAA@@@@@A@CGCC@@A@AAA@ABEAQG@@AAACBEDA@AHCF@ACCEA@IIB@AEB@BDB@@AAC@AACI@GBAAC@@BBBABIIA@CDEGCBACABBDC@@CCC@@AAA@IKCE@@@@@A@AA@@EBC@A@C@@@A@@@@@E@C@CAGACAECCGEEAA@A@MC@@CACAEAEGAAACA@AACCAA@@ECA@CCCCCA@@@@AAAAA@EDDB@BEDAAEBABA@A@CA@ACACAB@KACAC@AA@@AG@ADB@IBA@@C@@DA@IBQ@@BIFCB@@EDCA@CGBA@A@EAEDE@C@CBCFEBSECACEGA@DA@@DA@@FAB@D@@"],encodeOffsets:[[118812,37783]]}},{type:"Feature",id:"371426",properties:{name:"平原县",cp:[116.434032,37.165323],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@EFA@CA@ADE@A@ACGC@AKEI@@AAC@C@ACA@@@@@GAMBCCD@CQ@A@AAG@AC@@@@G@EFAAA@E@KCBKUAA@CMD@BBDG@CBCHCD@HC@@@EBS@S@A@E@UAGA@@WAACI@BCGB@@@D@BKBBDBFK@@FG@CDA@E@AGA@@BBFE@BFCB@@AAE@A@CI@AB@@CCCE@C@@AGBA@EBCBIPCDQNA@@BOFA@EBCBIDCBGFABMJIBA@@DABMBI@AEA@AHCB@@@@CDBB@B@@CBADBFBDB@@BEBE@C@C@EBA@@BBBEBCBDDHLDDA@@BBBBAFJA@BB@@B@LNA@BBBB@AFHB@DADD@D@@BAFDB@@BBBFBB@FDABE@@BB@@DAB@BAJC@CDILIDOHGFCDKJ@@OJONA@@JADCAA@@B@LKBCB@B@BC@@BGN@H@BEBE@BBCBCACA@AC@C@CFF@BBLNHJ@@DBD@NPHHFBFBRDB@JD@@C@DF@@@B@DBA@DERBFLVVPRNBBRNDFLHPN@@@F@@@@@F@B@@@@BP@@@@@@@B@@@DM@C@@D@L@BC@BN@H@@F@@BB@BJB@@DL@@@@@@HD@D@@FF@@@@@AE@@@@JC@EF@ACDA@@JA@ADAB@BIBCBCBCD@BBR@BA@@@AFCBAJ@B@BBDBD@DAB@PCB@@FB@JA@@JAB@BL@JB@B@BH@BBBBBLB@@@CHDBABBBB@BAB@BBBBB@@C@C@ADCFBB@FABBBBBJDDBB@DBDBBBBB@DBFB@B@@A@C@C@AB@DB@@BDBBFABA@AAC@A@@DF@@AG@A@G@CAAAGBCFCJEHERIL@bCJ@bAB@X@fCH@NAfDB@D@LBLAHCFADADAHCNGTGTIZGDAH@DC@@@@NIBATCFBDBFBLDFBTBDEBABA@@@@FEFGJQ@ANITEB@H@FAN@PG@@NGLAJA@G@@@@BEGEKKKICCIIQM@AMK[WAASCO@K@@@C@OKGM@@EMCE@@CIAGAE@@A@@@@@@A@@@@@AEKGKAAACMKCCGKAAOGMMGGEAADIIC@CBACDCGKCAGEBAAAQIGGCAAAAB@BCBA@@AAAA@ENGFAAAADCAC@ACCHC@CACMM@ADC@@GCAAG@C@G@GDA@CADCGCAB@@OE"],encodeOffsets:[[119288,37911]]}},{type:"Feature",id:"371428",properties:{name:"武城县",cp:[116.069302,37.213311],childNum:1},geometry:{type:"Polygon",coordinates:["@@AGGOIAECO@MFIDIFEDGFKFCB@@E@I@K@EBSJDDBDBD@B@D@H@BA@CBAD@@@BB@BBD@BBDBBDBBBFDFBBBB@@B@ACJA@DA@@DB@@BA@@BA@A@@@A@@B@BBD@BBB@BB@B@D@@BFAAD@@A@CBAB@BBDBH@B@B@F@B@BCFCDADAD@F@B@DBDAF@@@DBBBBBBBBBBDDBBBBHCB@DBCFJDLEBDABAAGD@@DBDBDFBBDDDDB@BB@BBB@DA@G@A@A@@B@B@@JBGFABAB@B@B@DBFBB@BBD@B@B@BEBA@@@@@@@DF@@@B@@AB@B@@BBDBB@@@B@@@@@@C@AB@B@@BDDBBBBDBFD@@@@@B@B@@A@@DBB@F@B@@B@LG@@BBFDFDHDFB@BB@DADABA@AB@DAD@B@BB@@BD@BBB@BBBBB@@@BA@@B@@@B@@@B@@@@@@BB@@@@B@BA@@@@@@A@@@BA@@@@BA@BB@DBBDDBFDBBBB@B@B@DABABA@AB@BA@A@@@A@@B@@BD@BDBB@B@B@@BB@BBBB@@@D@DCBCD@@ADA@@@@B@BBBD@J@BF@D@D@@AD@BADABA@CFCD@@@B@BBBDDDB@B@B@@CB@@@@@BBB@DB@@@B@B@@@BB@B@BBB@@BBDBBD@@B@@B@F@B@@@BDDBB@B@@AD@B@@A@A@GACA@@@@A@ABABAB@D@BB@@@D@B@@@D@@@B@LD@B@@@BCDGFCBABAB@B@@BBBBB@B@F@D@BBBB@B@@B@BBF@FAF@PBD@FBBBBB@@IDCDGD@@B@D@FANADBBBD@BBD@@B@@@BA@@@@@B@@BB@@@BB@@@B@@@B@@B@@D@@B@B@BADADCDBBBB@BBF@BAB@@B@@AD@@@B@@@@@B@@@@@BB@@@@AB@BA@@B@@@@@@A@@@@BA@C@@B@D@B@BAD@BB@@BB@@@@ABBB@@BDB@@B@@@@A@AB@@A@@@B@@B@@C@@B@@@B@@@@B@@@BB@@F@FBHA@@B@@AB@@@D@B@F@B@B@@BBBB@DBBBBBB@BBB@D@B@D@B@DAD@DADAFAF@DAD@D@B@BBBBB@BBBBBBDBB@B@D@HAB@D@D@D@BBDBB@BBB@B@D@D@D@DBFBB@DBD@BBB@BBD@D@BBB@B@BBBBB@BBBAB@DCDCDCDA@@DCFC@@BABADCB@BA@@DADADAF@D@D@DBD@DADADAD@D@FADBB@F@D@DAD@FAF@BA@@BB@@B@BAB@DAPAVEB@DAJAHCHAHCHEHIBADCA@BAAA@@@ACAAC@A@@@@@AB@@@@@@E@AA@@@@@I@@CE@@@@@ACHA@C@AJB@E@@@A@GH@BAAAG@@IF@@C@CE@@@@@@@@ED@@E@@@EC@@G@@@@K@@CA@AIA@@AE@@@@GAMD@@A@K@CD@N@@C@@@A@@@@@@AO@@@@@A@E@@@@@E@@OMKGCEQMAAQMUOKUAEFQ@CAB@C@A@@CED@@@ICA@QCEAEAGGMOC@CA@@GIKMAAE@@B@@AB@EAAK@A@BFEB@FBHODADKFI@CBC@UDYAKAM@AA_GICCAA@A@C@@AE@IBAA@CC@BBA@CCA@@BA@OBA@AACIEECG@AA@IA@CAA@ECIAKA@C@C@CECCC@@AAKCECCA@@EMEAASAMBA@KBMJCFGLCJEHCAGAKGECECOGAAGK@A@@AGAOEM@@@AAAAK@E@IAE@A"],encodeOffsets:[[118726,37953]]}},{type:"Feature",id:"371481",properties:{name:"乐陵市",cp:[117.231934,37.729907],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@AA@@B@@B@"],["@@AACIEKGIGGEGA@GIAAIKAA@AEII@Y@KAKEMGSE@@A@A@E@I@@@QA@BGLMDEA@@GAKCCAMKCAAAACL[AECMEECEWCKASA@@q@@@KAS@HHLNCDGFKBODBLBBFLHN@BKJCBEJ@B@BAN@@@@@@@@@L@B@@@@CNCJKJEDABCBGDMDOBA@O@KASAA@O@CDAN@FABABCJQDEBEBIFABGHABCJCFBDBJLBD@@@HBH@RJD@HDDBFBDB@@DBBBHFFJBBLJB@HBFBNDHB@@FBTJ@D@@BH@@@BAF@@@J@D@BDNDBBBDDB@DFDDDH@DBHBF@DAH@DEJABKLAB@@CFGL@FAD@D@BFRBF@@@NCFGF@DABAHEPAB@BMZCF@BCDGJAJ@@ENIPABKPGPADALAN@F@@@BBJ@BAB@BBB@@B@BHD@BFBJ@@@BAABJ@BA@AACKE@@EG@@CA@@@IJHVB@FAF@FBH@F@LBH@H@HANCD@VEJAHAD@B@J@B@JAH@D@F@HB@@BBA@@BBB@@BB@BFB@@DEDBCFHBFBBALFFBJDHBLBB@L@H@J@F@D@D@PATCDALAF@JA@@FAJAB@HAD@F@FAH@J@B@H@DBB@B@DBDDFFDBFDD@B@FBFAHALCDAD@FAHAF@VANAJ@LAF@J@ZCD@D@H@FDB@HDFDLFFBFBF@FBB@FBBBD@DDFBBBHDFDDBB@BALKHGLEFALCFMAAD@BID@@A@AB@@DB@BAB@@C@AA@@@A@CAC@A@@A@@A@@@C@C@@AA@B@A@@AC@KBCCCAAA@@GE@@EE@@EC@AEGCGIW@@@E@A@@@@BABABAB@B@@CG@@A@E@AD@@AB@AC@AAE@A@@LEGCCIBG@BD@BC@C@G@A@KBG@AAAAACBHKDADEHKBAA@BAAA@GCACW@AB@D@@@@D@@BBH@B@@A@CAAE@BAD@DBDFHBD@HFFDF@B@@CD@@@@@B@E@AEAGB@BAIMB@BADE@GAA@AGEKEA@MGKIEECGEKCMAMEAIAACF@ACDAD@BADABI@CBE@@@@BEBGBC@K@AEOEGAC@ACCAAOKGCCAGECCII@ACE@@AC@I@A@@CMAOCMEKKAKCMBMBC@OBME"]],encodeOffsets:[[[119751,38749]],[[120045,38431]]]}},{type:"Feature",id:"371482",properties:{name:"禹城市",cp:[116.638327,36.933812],childNum:1},geometry:{type:"Polygon",coordinates:["@@QBCBE@@@KBABEBABKB@BGBA@CBIDABGBOHA@KDA@MD@BA@KBIBEBC@MDKBEBGBMBEBGBCLKHABEL@@AHAH@FDFDJDDFJBBBJBLBF@BCL@@@@CJEPBF@BADA@A@A@BFABBBABM@A@C@AB@FG@ACA@ABDDA@EA@BBBBBFBABABDBD@BABAND@DLH@BDAHDB@AG@@J@BFC@@HBB@BA@K@BDGDFDCB@BBFBAB@@BD@B@@BDJDJ@B@@EF@F@@@B@DF@BHBBDADJ@BA@EDB@@D@@LFFBF@H@@@@BBABB@HBFD@@BBB@DABCB@CO@GBCDAJ@BH@@DC@BDB@@AD@@DABA@AFC@FNBDA@BDHB@B@BJBBAD@BBNC@CCEDA@@DADBFA@BB@@DB@H@@B@@B@BB@FCAA@CD@BB@CH@DA@ABA@AB@@@@@@@AK@AD@@FDFF@BBLAB@@CBBB@@B@BHBBD@BEFA@@@@BDFBD@BDDDJAD@D@@DBJA@CBABCD@@E@@@@BC@ADADGAGCA@@DBBLVDA@L@FBBEB@F@H@@D@@BBH@B@BDRC@DDNAHB@@@@B@BDD@D@BB@@FJBLD@DH@B@BCF@BDBB@FEB@PF@@BAHDCDDBB@HCH@D@H@BBHD@@CD@BNNBD@DGDDD@BBDCDBBBBHEFMB@BB@BB@DA@ABABBDBHHRJBBABHFDBHLCDBDDAD@JJBCFBHHNNPHBBHLDDNLBDBBHLFL@B@@@@@B@@@@B@@@BFBHDJ@@DFFN@@HNPLD@@@L@P@TDBB\\XNL@BRNJJDDLJLLHFBC@@@@HIBA@@@@BAFOBAFSGOCMCGEUAC@CCY@AAE@I@QCECC@@BA@G@A@A@C@ICB@@@@E@AAI@A@GE@CJ@FGROHC@BBBBGDABCLIBGEGFAFBFH@@JCFCBIAIAE@@ACGK@A@@@@AKUIAGA@@CCCAEAIAGAA@EAG@G@IBA@@@EFYDGFI@@DCFEJIHKDCDGDKBE@AAAABACA@CBCIEBCMLBB@AGOIAC@@A@CEAC@EAA@AAE@CCECGCE@AAC@EBC@AFMB@JAD@@@@CB@ACE@AEE@@GBA@ABABCAA@A@CEGCC@CAE@@AIAAKGSHABIDKJ@@EDIFGHEFAFA@@BEFKLIA@@OECA@@GG@@GGIIA@OMAGEM@AFMNIHE@@FEDOAEACEGEGECGI@@CCCECCCCIKCCECAACAGCOA@@IDCBEFAB@@A@@@@@KFME@@MMCE@AAECK@A@E@@@@AG@CAGEK@CGKAAGGIG@@AAEK@AJSDCDCDCDCBADIAIC@@@@@ICA@CAA@MCQBC@"],encodeOffsets:[[119323,37552]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("东营",{type:"FeatureCollection",features:[{type:"Feature",id:"370502",properties:{name:"东营区",cp:[118.582184,37.448964],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@M@CC@K@A@K@A@@JE@@L@BS@ABBBF@DB@FMACBABBD@B@DE@ABA@G@K@C@AFBDB@H@BBABIA@D@BE@@@OAC@EBABBL@FAB@@@@K@C@ADBLABC@@R@B@@@T@R@TA@UAC@S@G@@BBDBBBBBB@BC@AFCBG@IAC@A@@@@@@AAAA@CDA@@AA@A@AB@DIBA@@FARABADA@A@I@@EIBAABEBA@CCEG@K@A@AB@BC@IAGAABBDABEAAB@F@JAF@B@DH@D@D@HBJDFBBB@@DDBBBB@DAF@B@BAB@DAD@@AL@B@@AD@B@B@BBFBDBB@BBFB@@BFRDJ@BDJ@BBD@BFFHAD@@@@B@BBBFADCBEHBBADC@IPCCEFAD@D@DAHCJ@AA@A@@FA@A@AF@BAFILIDAN@P@DC@@D@LABBBDD@H@HB@BN@TBB@@HBD@@\\@@B@DF@DFDJH@BABCAIL@D@B@@AD@DA@AF@BB@HJAB@@CBCJ@L@@ACG@AH@@BLA@CB@HAB@@BBDB@@FEB@B@BA@@@BDBH@BEDABG@@@@@A@FJAB@B@FD@ADDBBBBDBD@FJD@@BD@F@@BBB@@DBH@@BB@F@DA@@@@@@@@@BFABQ@E@CBAH@@@@@@@H@@@@DBDB@BPH@@AFDBHBADB@A@D@FB@DB@T@@A@EHB@DBNHA@BJ@@BI@@BD@DHAFDDA@BBAB@@BBDDB@HDD@FDAB@@@@B@@HF@@ADAHFFCH@BA@@BA@@HAD@NIDAHHH@@@@A@ACC@@@@@@FA@AJ@@@@CD@FB@CF@BC@A@CC@CBG@@CB@BA@A@AB@@AR@@@B@@@D@D@D@PBLAF@F@D@@@J@J@T@L@NBB@`@@@B@B@B@@@B@B@@@V@@@B@D@@@R@D@@KJAJE@E@@@A@@B@B@J@@@HEACEBA@@C@AHAFFHFLGVVFFB@@@B@BAB@B@FBB@BB@AB@DADA@ABC@@@ABABA@@DAB@BAD@BAB@@@B@BAB@B@@@DABAB@@@BA@@B@BABABAB@@@BAB@B@BA@@@@DA@@JP@BD@H@B@B@FAF@DAB@B@@@D@D@D@F@B@@@BAB@BAB@BAB@B@@AB@B@DABC@C@@F@\\AB@D@TC|KB@@AFALA@@@@TEB@FB@FFBADAJ@B@B@@D@HD@@B@@@BBB@B@@@B@BB@@B@B@BB@@B@B@@@BAB@B@@@B@B@B@@@B@B@@BB@B@B@@@BBB@@@BBB@B@@BB@B@@@B@B@B@@@B@B@B@@@B@@@B@@@B@B@BA@@B@B@D@D@BAB@@@B@@@@@DB@BB@B@@@B@B@@@B@B@@@BAB@B@D@B@DAB@B@D@H[RkPqNsFaDSDK@OBIaEKAQCICIAEAI@E@E@GDKDCBKDCBABAAAAAA@@@C@E@AACCEAACACAGCE@AAACCCCGAAACA@A@A@CBCBCBC@E@C@CAC@A@CBC@GBCB@@]AG@K@G@EAA@AACAC@K@G@IAG@E@E@G@EBG@I@G@KAG@CAE@E@E@A@CAECCAEAE@I@CA@BEHEHAB@@CFCF_@@BI@A@BAA@@AQ@C@@AQ@JKG@@BD@@DE@@AG@@CJ@DGY@EJCA@@CDgSCIAICAA@G@M@E@Q@Q@AFEBG@@QSA@RC@@QE@A@@\\MDA@CBE@@KI@@C@K@C@AMKA@@RBHA@E@AYD@B@B@B@@AAKEBAS@AA@@DCDCF@FC@@AABABA@CD]A@TA@I@GBE@A@@SEB@@A@SD@@]FC@A@@@AA@AE@IACB@BDD@BC@E@A@C@@@I@AAGU@@G@@@I@AAA@JV@BIC@@I@KA@@CIGBCCC@@BBF@BEAAAECACAA@CC@C@@FGE@I@@B@@CIA@II@BG@AC@I@"],encodeOffsets:[[121233,38167]]}},{type:"Feature",id:"370503",properties:{name:"河口区",cp:[118.525543,37.886162],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@AA@@@@CCQK@@@@ECCAAAiQ@@@@YIWIWIA@KCAA@@@@CA@@WMUKOLA@WP@@@BC@AB@@CBBD@B@@BB@BBBBF@@@B@BA@B@@B@@DHBDBDBHKBKDEBEBABC@DF@DADABA@A@ABABA@@DQDUBAEAC@EBC@CFCCAACA@CACBADEHGBC@CBABADCJG@GSAA@EAA@AAC@@AMYB@A@A@G@@L@AMG@A@C@@NUG@BMCA@@@BD@B@@@BC@A@A@AAAAKBBF@BMBA@AA@@I@@BC@@AAA@AA@@AA@C@AA@ACA@AE@@ACAA@AA@@A@@@@@A@CB@@KBA@BGYA@@A@CNAHAFAJDAB@@@DABDBL@VB@AFEPCJABA@AB@BO@G@BHG@@@[AECCCADEBCAEASSGGA@@@C@@BFDABOFACIBEEEA@ABANCBACEIKCCCACCGGCCCA@ACC@A@CKA@BIA[@E@A@@HA@AA_@G@E@ABADA@A@@FK@@D@DJ@@B@B@BC@ABAB@DBBD@@BBD@@@BCBGDG@AB@F@@BJG@@DC@CA@AG@A@@BBF@BJ@B@@BMZ@@AFBBGPCFC@IACAA@A@E@ACIFC@CBADCFICA@@@BCA@AABCGABCMAA@DEGAMCKAA@BCGAAAACIEA@AFBPAFEJG@@JHABFGFC@@@CABE@U@AA@A@ERADGB@DAH@D@D@F@BAB@BBBF@B@@DCFFBABADBBB@@BCNCJCD@@BECA@@CL@DCDABFBAH@BCBI@@R@D@BBF@D@BAHAD@HE@IAC@CBAF@BBBPBAFB@D@@@@LAD@JAH@NFBDBHDABEDAB@DBH@@ABCB@D@@DV@JBJ@TDN@H@BBD@FBJBF@DAF@B@D@F@D@DAD@DCFAB@B@@BBB@FBDBDBB@@DBHDF@DBF@BBBDBDBBB@B@BCJADADCB@BE@C@IBCBAB@DBBFDDBDDBBBDRbzK~MvKVCfEjAVHZENGTSL@JARNLRHFXDlElSRQHaRaRORIRE\\HBCLS@EA@GECECECCGM@ADADCIA@A@A@CDA@CC@A@AA@E@EBABA@GBIBA\\BrBFCVBBD^AB@Pv@~@L@AÖAT|JnFªFBEpI~UpWxc`_VRBGDSJcFGsn_¹@AA@@KBA@M@E@A@A@ICYY@@ECQQ@A@@{{GG_a@AEA@@GCGECAKGUE_GC@CAKAA@AA@@AA@@GEECEC@@@@@@@@EEIEQKMK@@SMCAAA"],encodeOffsets:[[121659,38728]]}},{type:"Feature",id:"370505",properties:{name:"垦利区",cp:[118.575228,37.573054],childNum:1},geometry:{type:"Polygon",coordinates:["@@DWFQHI^KXCZBZDbLPBPCJGFM@SEWCMAIBMFGNKXI`C^GHCFIBI@KGUIOKGUGC@mAyB·NI@EAA@CBI@E@A@C@SC]EWG_OcSwmmqQWWeIOiEMGQI[@SBUBIBGFOC@A@A@CBA@C@A@A@AB@@A@A@@@A@A@@@A@A@@ACA@@@@A@@@A@ABC@C@A@A@@@ABA@A@@@A@@@A@@@A@A@A@@@A@A@A@@@A@A@@AA@A@AA@@A@AA@@A@A@A@@AA@A@@@A@A@A@@@A@A@AB@@A@A@@@AAA@A@@@AAA@@@A@A@AA@@A@@@GCC@@@@A@ABIBCEA@EEAA@SF@@@@KBEB@BA@{LSDC@A@[BE@@@@DADCBA@A@@BA@A@ABA@ABA@AB@@A@E@C@C@C@@@A@A@CBE@EBA@A@G@C@@AIO@@CB@@@@ABA@A@AB@@A@ABABABA@@@AB@@A@ABCB@@A@A@ABA@@@A@ABC@ABA@CB@@ABAB@B@@AD@BCBCBA@@BAAA@EAA@A@ABA@@@A@EEUUKHGEEEGB@B@DB@FABDGF@@I@A@A@@@@B@@@FIFIB@LC@Q@@@C@A@@@U@@@A@A@@@A@A@A@@@_@A@MAK@S@I@I@@@C@E@E@KBOAC@C@C@@@A@@@Q@@BA@@B@BABA@@DH@DAD@@D@BADE@@DEAC@@D@@I@@BEB@@@@@@DD@B@B@@G@GGCBMJC@GB@@AB@@ABG@EDGECB@BE@@GA@@@@@BAECC@GCA@CCAA@@BAAAB@CCBECGC@@AJ@@AI@@AGBAM@CGA@F@BS@A@@CEAC@B@A@BCGACABE@@OG@ACACA@@@@@G@@@@@@BGDAF@R@BAAE@@@@@@@@B@@C@EAA@@AG@CA@AA@@@EAC@@IC@EACACAACABCC@@E@ABAEIB@@@@@H@BAFC@AAGAC@@B@@A@AFA@EA@AC@AA@GBA@@DKB@AG@@BDH@BK@I@AD@DA@IB@GAAE@@BCBC@@BA@C@K@BJADABG@CICEE@@C@A[@@@AC@GA@SAM@@AGAG@C@ACAAKBC@@@CDO@M@CBKJEJABE@@B@BEB@@@BBBI@GDCBC@C@EBDFOD@JCDABGAAFCDEBAA@A@A@@C@GBBB@DNPBB@@@BBBBBBD@BDD@BBBBD@B@@BDBB@@BBBB@BFHDDBBFBFDD@DBNHD@BB@@FDFBBBDBB@BBB@@BBBBB@L@@BH@@@DBB@B@@@D@@BL@D@BDJ@B@B@BBB@@BB@BHL@BBF@@BD@@BD@@@@@B@BB@BFBFDL@B@DDH@BBFBDDPBBB@@BDDBBB@FBPBB@JB@@J@JBF@JBFBB@HBDDF@JDHBB@XFD@B@RDTBTBD@B@@@L@NA@@\\ENAFAFAPCPCF@LARCF@JAH@FBLFDDLFNHJHHDn\\JD@@FDFBB@\\LLFHBNHFDHDHJHFHLDDBDJLNTBBBFJRBBBDHNHLHJFJFDHBJDLBJDNH@DBHBHAFCAA@AF@@GBA@CAA@IHIFA@XNVLXN@@DB@@@@BBLDB@XJXJZJ@@@@jRBBDBFD@@@@RLDD@@@@BB@@@@@@BBDBTN@@NLRLJFFF@@@@@@@@FDFDHF@@BB@@BBB@LBDBD@`HVFLHDBHFHD@@FB@B`bHH||@@@BRRFD@@ZZJDB@B@F@N@B@LA@@BBº@p_h]LSH]@aD[LU@G@GCCIGCE"],encodeOffsets:[[121979,38748]]}},{type:"Feature",id:"370522",properties:{name:"利津县",cp:[118.255287,37.490328],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@}OuA@]BACUAEDqA[AABAJ@HABAB@F@FBBB@D@@DCB@D@B@BJBCDCB@BHNDDDFDFHFB@@FKTADZRfTNnDbDXLJXH¤PBSBÕ@@K"],["@@IEEAGCIEKCCAAB@@ABE@@BADD@@B@FB@BB@@CBADADABA@E@@@A@KA@AA@CBEFCD@FBDB@DF@@BBHDF@@@PA@@@@HADAB@@B@B@@@B@@@@@@ABBFAF@B@@FFDHABAF@D@FDBHBADIAE@@BAHAB@@DFDB@B@@EBEAA@CJCDC@@B@@@@@@E@CDEBABA@G@EAE@@CCBAAK@@B@@E@@AC@CB@@B@DJEB@BFL@D@BBBHCB@@BDH@@A@ODGD@@@@@@@D@@@D@D@BDBDADD@B@HBFBB@@H@@@@@BDFB@DG@@@@BHA@H@@AB@BB@BBF@BHB@@@@@@BCHF@AL@@BBHB@DAD@BC@@DBBD@BDD@HFDF@FBBDDHADBFFBFAF@@@@B@@@HB@FFB@CB@BB@@@FH@ADCB@@@D@DB@H@AFDBENGA@AI@@@AEA@CB@HA@E@@@AAACACAAIBA@CEG@CFGAA@AJ@B@DD@@FF@B@@B@DO@CB@JC@@@@BBDDF@BA@FHFDL@B@LD@BBBCF@FDDBAFHDBBBBDBF@F@LA@AB@FF@BA@ED@AHIBAB@DJ@B@FD@DBB@BBFD@@DG@@BF@AJD@H@BAB@DH@FDNB@DABF@@FDD@BARBFJDBBA@ALGD@@@BB@BABBDF@@@B@BE@C@@BALB@BHB@CHEHDDCBABADAFBHDDCBADAT@F@FB@BHFBAH@FDLBBJDB@DB@@EB@@BBBBBDBBFCBBFBBF@BFBBLB@JAFFADDBDADAHBB@DBDFABFBLBDDAD@AD@B@NAB@@@H@BD@BAF@D@@BJ@L@BB@B@D@DBDAD@D@@F@B@@DBHHABBJDBBBB@J@@@@AD@BKBA@KJ@@BB@H@DF@DFK@@BDFBD@@DHDJBDBBBBB@L@BEB@@DFB@BADBBAFF@H@`@BBB@@GB@F@\\@JB@ALB@D@BDD@BDBDDHHDDDBDDJLDFABMDAB@BFBFFJABDPEBAEC@AD@@@B@HHTTFBDBFABCDDFD\\B@@H@AGH@P@@ABAB@BADIFOBEA@@UAKACCB@@A@CBBIBEBGDMB@@@ZBAHB@LA@@DAB@@@@@B@@@BBB@DB@BF@@BDB@BBBD@B@@BB@@BBB@BD@@AJ@@@BBB@NA@AAELABBBBB@B@D@@A@@@AAC@@B@ND@AVH@MD@B@H@BNK@@@@H@B@BZABN@@BD@BBB@FBBHTH@DIBCBADAD@HAFGBCDADBB@BDDBED@DAD@FBDBFVARC@CB@BABAB@B@BABC@CCED@BAFAFALCLAAGACACCG@@@AA@B@@A@A@@AEAA@AAA@@@AACDA@@BAD@@A@@XOB@PKWMB@JEJGB@DBB@HA@@BEB@DBBEAGAG@CMGICKAICGAECEIGIGKGMACAAIQAEAAMSIKACCCGKGEGIGCECMGGAKE[KA@EAEC@@ICm[GCIGMGKECCKEEAG@IBE@QDKBE@ODODEBEBMB[F@@MBK@@@A@C@SASAQCA@C@WEA@GAICE@CCGAA@EAIAE@IAI@@@IAA@OAEAA@AACC@AA@AACOACAE@ACG@C@ACKAEAEA@@A@A@@@@AC@@AC@@AE@AGK@AAA@@AA@A@A@ACI@A@CAK@@@C@@@AAA@C@@AG@@@KAAAA@AA@AAA@CAAAEAEC@@AAC@MGCAC@ECEAAACCEG@AAAAA@@AAAC@@@AACAA@ACC@AACAAAA@A@@AAMO@CAAEE@AAC@ACI@ACIEQ@AA@AE@AAAACAE@A@A@ABC@@@ABK@@BC@CBA@A@ABE@CAAAACC@@AAEAICGAC@C@G@C@E@E@OE@@@@OGA@IEEA"]],encodeOffsets:[[[121525,38934]],[[121022,38272]]]}},{type:"Feature",id:"370523",properties:{name:"广饶县",cp:[118.407107,37.053555],childNum:1},geometry:{type:"Polygon",coordinates:["@@D@AAR@@@D@B@H@@CB@BB@@B@D@@@FAB@D@@CJ@@CJ@B@B@D@@@B@DA@AE@AAD@@EA@@A@AAAA@@AF@AEFAB@BA@@@@@C@@E@EB@@I@@BQB@@@DE@@C@@@A@AC@@A@@@@@@@@@@@A@ADAFAB@DABDJ@@ADAACABA@C@AGAB@BMBAA@@EBGD@DED@@C@A@AG@C@@AC@@AIDA@AAK@A@@@@@@DBF@B@DE@@@AAA@GAAAIACDC@E@CDADE@AAC@A@CE@@@A@A@@A@@@AA@AA@@ACD@@GA@CBE@ACE@AAMBA@C@@BA@A@CBC@ABA@@@@B@@AB@B@@AB@@@B@BG@@CK@A@CAG@CBE@@L@B@B@@K@ADEBG@EDSBEC@CAECC@@AAC@@@AA@AAG@@@@@@@AA@E@@D@FBBGAiGIFU@AEA@A@A@Q@G@GBAF@D@DEAAB@@@@AA@@C@CAC@A@GFBB@@DDABAB@@DPC@@D@@BBAB@HA@C@@FEB@@K@@@G@AAKBE@A@QA@A@AA@@IKGAEEBMB@@GBE@CB@@A@@FC@@NC@@@E@@ACAA@A@A@AAEAG@UAAL@@@@@F@@@@AH@@@F@D@BAPABAB@@AD@BBB@PJ@BFBBB@BFBDDBFAB@B@DD@D@FDFDBH@B@DBHDBBDF@BBF@BA@CDBHLHNH@B@DA@@B@@AB@BBD@BBHBJABA@@BCHC@@F@BDFDD@@BFJ@BFADG@BFEBBFABC@A@A@BB@BB@F@BAFCBF@@HCF@@@BDAF@@@BCDA@A@@DBB@DEFABCCCND@J@B@@B@B@D@AG@ADAFBDB@DBD@@HBDRABD@DHJBBDA@@FA@@BFF@B@@HJ@B@@D@B@RB@@JD@@JF@BFD@B@@F@BE@@HCB@BBFC@ABAJE@BH@BA@@D@BADG@@D@@IDE@@B@BABEHCDCB@@KRHDBBMAABABBHFNJ@H@BBDFFNDBBBBDF@H@@B@FB@L@B@L@D@@D@N@BJ@D@@BAHJ@@JJB@DA@@@@JHF@ED@D@@DBBBDFDBBFB@AAE@AD@DDHADJ@@LBJ@@@JD@AIUB@BBJ@@@H@@@HVBBJ@@@D@B@F@D@@ACC@ADAJBF@@BBB@@B@D@^E@@TCB@@@FA@TB@F@HAJ@B@@S^BDCB@BABA@BD@@EDEDC@CB@@BBTFABL@BA@A@A@C@BZF@B@AG@QB@NL@B@D@L@DJ@@LF@DAB@NC@[B@F@@RD@@QTB@RH@FABER@R@F@N@H@B@DBBJDJhTDC@@DBFIZ@CHI@@DH@@BF@@CC@@AH@ILR@@BD@R@@BB@ABB@J@@A`@DEDE@@BAFGFG@ADBJ@F@FBDBFDDBB@F@F@F@DBH@LBH@J@H@FAH@F@F@H@JBH@L@D@DBBBB@FBH@L@H@^B@@DAHAD@DAB@D@DBD@F@D@DADADAB@B@B@BDBBDHDDBDBBF@HDDBDBBBDFBD@B@F@D@@BBBBBBBADALCDALCHCF@F@J@FBJBJDRDLBbFBABQHUNWPQRKXERCJCHCƵUWAIAeE]EiI@AGBE@CAMEGEA@MGIEUK@@MG@@CACAIEGCEAAAIC@@AAAACCCACCEAGAKCAAA@GA@AEAECEA@@E@E@BA@@@ABA@AB@BA@@@A@A@AAA@AA@A@AA@AAAAA@A@@@A@A@@@A@A@A@A@@@A@A@AAA@@B@@AB@@AA@@@A@@@A@@B@@AA@@CA@AA@@@@AB@B@@@@A@@@@@@@A@@A@@@@A@@C@ACA@ACC@@E@CKD@A@CGF@@AA@@@@A@AG@@EMA@AEA@E@@GC@@@G@BG@@BCACDA@A@@LCD@D@BFB@@BBB@@BA@@D@@CAEDA@E@@AEAIAAD@BHB@HEREBDLA@A@AAE@@COAK@GJAEE@C@@@@@A@@A@@A@@EA@@ACBE@EBG@A@@IBBEA@@A@@@K@@JA@AA@ACD@@ACA@AAE@ASBCKK@@@@ABA@AA@A@EAE@BEGBC@G@@GaEAACAA@A@@BE@@BG@@AE@BDA@C@ADABI@ABGACAC@E@@@@GIBC@A@C@@@@AE@@B@BEAGAAKC@@@@AACBAAC@@D@@CBAB@AEB@@A@CAA@@@@@EA@AB@@@@@BKB@EC@A@C@C@@CB@ACDAAE@@AA@AAG"],encodeOffsets:[[121390,37914]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("菏泽",{type:"FeatureCollection",features:[{type:"Feature",id:"371702",properties:{name:"牡丹区",cp:[115.417826,35.252512],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@BABA@ABAFAAA@A@AAA@AA@AA@@@@AA@@AA@@AAACAEAA@@ACA@AC@A@@AA@@AC@AACGGA@CACBCDGNEJABA@GA@@C@@@IB@@G@SAAAMOACEAGBKD@B@F@BEDMH@BA@A@CB@GGEAIGBA@A@EB@B@@A@@HE@@@F@@BC@ADBBB@DF@BA@BDB@B@DDFCB@BF@A@ABA@A@@BBAP@FEFE@@@G@AFCHCF@B@F@D@@AD@DAFAD@BAB@B@BADAD@D@D@D@H@D@BAJ@D@B@D@D@F@HAD@HAD@BBB@D@FBD@@@@@@@HBBBHBD@@@@@@DFDDDDDFDF@BBBBDDDBFBFC@A@MDB@DHF@BDDA@AH@B@HB@HCDBF@D@DBB@@BD@D@BBF@D@BAD@@@@@@@D@F@D@DBBAD@B@F@F@D@B@D@F@@@@@@FBDB@BBBAFAD@DA@AF@B@HABBBAHI@@AA@@D@D@D@BDH@B@BB@@FBBFB@B@B@@B@@@A@@DA@@@@D@BCF@BADEHAB@@ABADADABADAFAD@DCFHF@HDFB@BB@FOF@H@D@DCDCB@@IJABKHOHIBAFAB@@CBCDAAE@ACCAC@@@C@CB@@KDAAC@E@C@AF@F@FDH@D@L@HCH@D@FBHDNDHFHDAHBBBNBD@H@TCJ@D@FBFBVNB@FDJHDBFFDBHFHHBB@DADAF@@@BDBDBFFB@@BFDJ@@@FFNLVNFBJDD@PFFBB@@I@GCI@@@I@EAGCCAAEAAA@ABCBI@K@A@A@A@@@A@E@@@ABABA@@@@B@HAB@B@@@@@D@B@B@B@F@B@NB@@B@@@D@B@L@B@DA@@B@BABCB@@ABABABAB@BAB@FAB@D@@@@@DADAB@D@BABAB@@CBA@@@A@CBA@AB@@@HAD@D@D@BBB@BAD@BB@@BBAB@BB@@@DBBBH@D@FA@@FBB@B@BBHBB@F@B@B@J@BA@@B@LAF@B@BAB@@AB@@@BAB@DAB@@AB@DA@@D@BABADABAB@BCDE@A@@@ABAECDEDCHAD@D@BB@@B@JARG@@D@N@HBH@L@HE@@DCDAPIL@D@DALF@NBB@BLBHAB@DEHBLCHALEBBF@@FBD@B@@B@N@ACBAJCFCBAHBL@@@F@L@@@J@B@DAB@B@@@B@BB@@B@BBAHBFB@B@F@FBDDF@D@DBBDBBB@DD@BBF@DDHDB@@DBBBBBFBD@H@DB@DBH@BDFDFBDBD@BBBDDDB@BDBB@B@@BF@J@D@D@DCBAD@HCLCB@FC@CAAAE@E@A@A@A@A@ADCHGB@@C@@@C@ABAFCHCH@LDFDAABA@CB@D@BBB@AAFA@CHB@ABA@AH@JA@AFBBANADBB@@CD@@AB@H@@HF@@@D@@DD@@@FABCDEBE@ABCBCBE@@@@@@BE@EAEACAA@EBC@@@C@ABA@@@@@CBA@CBCBE@@@@BABC@ADACECGE@AA@AA@AC@@@@CIBCDA@AB@BA@AB@@AA@AA@@BC@CIACE@A@A@I@G@CB@@EBCBI@CB@BCAC@AA@@GAC@C@AAE@C@GAE@EAC@AAEBCBA@AD@J@@AD@DKDCBA@CBAFEBB@AACEBC@G@@DA@@DA@@BEBC@AB@@C@AB@@QAE@E@C@C@C@A@CACAC@C@CAABA@A@CDA@KBC@E@GBGJ@FB@@DC@A@A@G@MBE@ECAAGEAAMCGBABA@EDI@@ACCEKC@M@C@OA@@CBCDEDC@A@@@ECECAEBEAECAGB@FAFCJ@B@D@BAAG@SGOBEBCAAC@@@CA@EBC@GAAA@@A@C@E@C@@@C@CBA@S@AA@AAAAC@E@AAC@AECA@A@C@AA@@@@@AACAA@A@C@C@ECCCGAABCBA@C@ACE@EAA@C@CEAC@EAE@AAA@CACAGEA@AA@ACAAAAACE@AAC@A@CA@@CCAGCECECAAA@AAAAAC@A@CAA@C@A@E@AACAECE@@AGIAA@GAA@C@A@AAE@E@C@CA@@CAECAA@@AACC@@AAAAA@AAEAC@GAA@CA@@A@@@AAA@A@GAAAC@@@EAEAA@AAA@EAC@@@CAA@C@CA@EHS@CA@A@A@CDKZCFEBAFBH@DCBCDAAA@CEAEEAEACB@@C@IBA@A@A@ABC@A@CAEA@@A@AAA@CCAAAA@@AAAA@AAA@C@@@AAA@@ACAAEGCAAACACCCAA@@AEA@AAAACA@AE@AA@@CACBA@A"],encodeOffsets:[[118120,35913]]}},{type:"Feature",id:"371703",properties:{name:"定陶区",cp:[115.57302,35.070995],childNum:1},geometry:{type:"Polygon",coordinates:["@@KIDAEC@AAACEC@C@ED@@CAMHA@ALADCBGBE@AAAAC@CB@BCBADBHA@ADENC@ABGBMBEEE@C@C@C@CG@GG@A@QHCBABABABBDADC@C@ABC@E@C@GBA@ABE@@@@@@@ACCA@CCAA@A@A@ABADAB@B@@@BABABAB@@EBC@E@CBAB@@@B@B@F@B@BABA@@B@B@@@BAB@@ABE@E@A@C@@ACA@@A@C@A@EAA@C@O@@@GAAAC@C@AAAAAAC@EBA@IDCBC@A@CBA@IDA@A@ABA@@B@DA@@BA@A@@@EBA@C@CBA@A@EBE@A@E@ABA@A@A@@BA@CBA@EBABGDCBC@CBE@A@A@A@CBC@E@I@A@A@@BBD@@BB@@@BBDB@BD@@BBBFBDBB@@BB@@BB@@@@BBB@@BBB@B@BBBEBAB@BABAB@@@B@BABBD@DB@@BBFB@BDBB@BFB@BB@DBDDDBBBDBFHBBBD@@BB@B@@@DBB@BBBBB@@BBBBDDB@BBB@@@FBDBB@D@BAB@B@B@JAD@@@DAFBFBBFDFB@BBDCDA@CAGBEFADELYDCB@B@B@@DGT@FDBD@B@DB@@D@FBB@BBB@FBFB@@D@BBHBB@B@BB@@B@@@DBB@HBD@FBBBB@BBBB@@DDBB@@BBFDDB@@DBD@F@F@BBB@D@B@HBB@JBBH@@DFBFBD@B@F@B@DBB@D@BBDBBBBB@BBFDFDHDDB@DB@@D@BBD@BDFBBBBDB@BBBB@HFDBDBB@BBF@FBD@FB@D@DBB@FDF@B@DABADBBDHDD@F@D@D@BBBBD@B@@@@BBD@B@B@FD@BBD@B@FBDBB@BBBT@B@DAD@@@D@F@D@B@@@BBHBD@FAB@@D@@BDDBFAPATHH@BB@A@C@ADIBE@EHADBBFAFBFFDFD@@B@D@FCDCDA@@PBD@N@D@FLDD@BJ@FCB@BAHANDBBHFBBFDF@NAH@B@B@D@@CA@@EHIHAF@D@LAB@DCB@B@BADBD@D@DBDBB@D@@C@ABABCDIBABABEDG@@BABC@ABCDCBEBC@@BE@A@A@C@M@A@A@A@G@AACAAAAAC@C@CBA@CBC@ABCBCDCAC@CCAAAACAAAA@A@E@ABC@A@G@C@@@ABEBADCBAAM@ABC@@BCBA@AAG@A@C@CAC@A@A@A@C@@@ACE@A@AACBC@ABAAA@AAA@@@@AE@E@E@CD@@CB@FADADA@CCAE@CAG@CG@CACFABABA@CCAEK@CA@A@CAC@@DAEHA@@ACA@AAC@CEAEAC@ED@MCR@AC@@@AAA@AB@@KC@GC@G@C@ABAFCDCDADCBAB@FGBC@AFCBBLA@ACIAICBFIIAE@E@@DEBC@@@CCACECAAACB@@AF@EC@A@AACE@@AG@@CE@@@D@@BC@@@A@@BA@@C@@@DA@@@GCCAYB@AAAC@C@CDABC@EAIBA@EBA@@BE@@BA@@@CDAACBC@@EA@ACE@G@@FC@CACBE@@@A@@@@@G@C@E@GDKF@@A@E@AFKDADC@AAA@E@CB@CC@C@@BC@@DA@@BA@@BCBABE@CBGADDG@@AAAIDEACA"],encodeOffsets:[[118393,35822]]}},{type:"Feature",id:"371722",properties:{name:"单县",cp:[116.107428,34.778808],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAKGECCACAA@OEECECIEGCEAAAG@A@G@A@EDEFKDCFILADCDADCJ@DGP_`ABSBIBG@EAQGOGA@MGYCI@[RORCBUX@@SAKAKW@A@EAYGCGCWAQ@KACA@@sMEAA@W@C@WAG@M@C@cCAAA@A@E@WAMAKSCBKDUHQF@@@BC@AD@@@BABDADlAFCHAP@BAHDFFJAD@B@HAB@F@RBJXAF@DFJ@BBBDCNFFLHADFDDHDDBF@@@@@@BFAF@BADAF@FAD@@AFAFABAFAD@DCBABAJ@H@D@BBF@HBDAB@F@D@D@@@B@@BDAHBBBFBDDHDB@BNAD@F@FABHBFBDJPFLBFP@P@B@@RADCJEF@@@@ABAACBCDEBGB@DHD@DAD@F@DBAD@B@D@D@D@F@BDD@F@F@FCNHF@B@DAFCFCDCH@BED@D@HAD@F@F@F@DBD@HB@@DBD@BBD@D@B@B@F@B@ACBEBAJAJ@B@DB@B@BB@FBH@FBFBB@D@JAD@H@FD@@FHALBD@BBD@D@FADCDAD@B@BH@H@D@B@FA@HDD@BGHAHADCDADAF@@CBEFCF@BADBBFBJBJB@@FBJCF@DBDDD@DH@F@BA@AB@B@@AHCF@BE@A@ABIAANJ@@D@@BHBBDDBD@BBF@DAD@DAHBF@@J@J@DDDDBDD@BB@DBFBBFDF@HAHEFGHCJCBAFCH@PCH@HAF@@@LCJEBABAFAFCBAFCJGHAHALCJAFCB@@AB@BADCHBF@@DDABFBABFD@AHDBFDDBBBDDBB@@@@DDFABDB@BDBABH@@DBBB@D@@@B@B@@BBDDBFJADADCB@@@@@@A@ABC@ABE@EDE@E@@@AFAD@DAB@BCDAFCB@D@FAD@@@F@@@F@DA@AFCAIFA@@@KT@@JD@@@@FH@FADAD@FAFADCB@B@FE@GL@BCDADE@IJ@H@FA@C@@@GL@HAH@H@DAD@B@F@D@D@F@B@B@D@B@B@F@FG@ILAD@F@H@F@F@@@HAH@@HD@B@D@D@B@DBHA@EN@D@B@DAHCF@DADA@IBELDD@A@@@@A@A@@@A@A@E@CBC@C@G@E@E@ECE@@@EAC@C@C@A@E@C@EAG@E@A@C@C@E@C@CAC@E@C@A@@@A@E@I@G@A@C@C@CAECAECE@@@E@A@M@E@GAECE@CA@@CCACAE@CBEAEAC@CAEACACDCFAHAD@DAHCB@BAF@HCFCDCBG@EACACACCCACECAACCEG@C@@@C@CBC@E@CAE@ACGCAAC@@ACECEECE@@@EDCBCFE@@HCECAACACCEAEAGEEAC@EAC@CAE@GAC@E@EACACACC@C@G@CAE@CACAC@@CCCAE@G@ABCDGDCBABE@E@G@CBE@A@CAEAACAA@C@E@C@E@E@AACACCCC@I@CAC@CAEACAA@CECAACCEAEAGAEAECECCACGBEDABEDABCDENEFCDCD@@KDIBIBEACC"],encodeOffsets:[[118986,35406]]}},{type:"Feature",id:"371721",properties:{name:"曹县",cp:[115.542328,34.825508],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAB@@CBA@A@A@CBA@A@A@A@A@CACAEAC@CB@@AAA@G@C@@@ABA@A@E@A@A@AAA@C@AAA@A@A@A@@@A@A@A@A@C@A@CBA@E@CBA@E@C@AAGCA@AAC@A@E@A@@@AB@@@B@BE@A@CBG@A@CBA@CBEBA@IDABA@EF@@EB@BCBCHCDGH@@CDABGHABEFINCDCF@HAD@DCHEHEFGBGBE@EACB@B@NAJAFAFQRAF@@AB@BCFAD@BAF@D@F@D@FBB@FBD@@BD@F@@@F@@@D@BAFAFABABAFEFCDADCF@BBD@DB@@FBBBDBFFFBDBDBD@BBD@DAFAFABAD@B@DCFADAF@DAB@DAF@D@F@DA@@HCFCD@DAD@DABCFGBE@A@E@ED@FFDCFEDCDBHCDGBGDEDGBG@G@ECGAGBCDCDOBEBCBCB@@IJCDGDEBOD@BCBG@ED@@GDEFEBEBA@CBA@GAGAECECMEG@@@GBEBGBGAE@A@ECEECC@@ECEBG@@BEBEDADAB@DAB@L@@AH@B@D@L@F@HBFBBLNDDBH@BBD@@@FBFCDKD@@C@EBGBA@E@@@G@A@AAC@K@@@CK@CIBEBE@BJUBA@@DEBE@BF@@DPB@B@BDD@BBJEHPJTFAB@@ADFDAB@BBBDBFAF@F@@AFCFG@GAEAECCECEEBA@EBCDA@GBEBEDEDGDGAEAECCAE@GBBREDAFCD@BIJ@@CBC@QB@@ED@FDFBF@BT@@@@BBD@FB@@HBFBJ@B@@@BB@B@FBH@B@B@B@F@FBBBB@BBDBDD@BBD@B@B@B@B@BBDB@@BBB@@@HABAF@@@BAD@@@B@B@@BBBD@@BDDDDB@@BBJAF@F@D@BBF@BCLABAHEFCTG@@L@DH@@@HJD@@HBL@BCD@@AE@@@F@@GB@@@@AFAB@B@HABJHF@HDAB@B@@ANGFC@A@E@ALCHAFBBDNPBBTBH@@@JA@@D@@@HBB@BAFIHMDCDADBB@HHBDB@B@J@F@D@DAB@B@B@F@DAD@DAHCBAFAB@DAB@@AB@B@B@BAF@B@F@FAB@B@DAD@B@FA@@B@B@@AB@@C@AB@BAB@B@JCB@DAB@D@DAJCB@FAD@BBBBBBD@D@BBHB@@P@D@B@FBB@D@B@@@DB@BD@B@F@F@BA@@BA@A@@@A@AB@BA@A@A@E@A@A@@BADAF@D@FA@@BABABA@A@@@ABABCBAB@B@B@DB@DDBBD@@@@@@F@BAB@HAD@F@D@BAD@D@BCACBABABADARGB@H@@HDHD@D@D@F@FFNAHABAD@FMBCB@AGBCDA@ADAD@BBBBF@HADABCBKB@NGDB@@FCD@D@DFBB@BFDCBLJDBFBJCBB@BH@CCHBDAF@BADA@AB@@AB@@CD@@AD@D@@DDAF@B@BBD@BCLCBEF@B@@@LEHCF@D@H@@@@@B@@@F@DADBD@@EH@F@BDB@@FD@DABBDC@@B@@AF@@AB@FAB@JAFBD@BADCD@D@BB@BZADBHD@@B@@C@@@DB@@AB@@@D@@AC@@@F@AA@@CEEAAAAECAACEGCC@CACACACAEAEEG@@ACACCE@GBC@EBGAC@@@ABE@ACGAA@KD@B@DAF@B@BEBE@C@EDCBEAE@C@A@EDCJA@BH@D@BAAC@@H@F@FCDCDCDCDAB@FC@ADAFCDAFCDA@@B@FAD@FDFBFBDB@BDBFDBEF@B@@@F@B@DFJCFCFGB@BCDCBABA@@@AHC@@@@BAHAFA@@DGHA@@@@B@@AAE@C@EBC@CGC@CHAFADCDABBBA@@@@FEDIBC@QA@O@O@AEEKIOACAEAGEBE@C@MB@ACACGACAEAABGAC@@@A@@@C@C@EBAAC@GAE@A@C@GBIBADA@CBCBEBABEBE@@BC@EBEBC@ABEAE@@@@@@AECCCGECBCKGEEDMACAAI@CEE@WBAI@Q@EBA@G@ABCEICEBG@ABODGBECkCBCBGBIBCBA@@@A@C@A@GBEBABA@CBA@EBGBGBAAA@EBA@A@C@A@@@IBE@A@@@E@C@K@E@C@@@A@IDEDABAFCDE@E@CBIA@AAA@E@I@I@A@Q@I@AA@@AA@@ACA@A@@CA@AACC@AAA@@AAA@@@A@@@AA@AA@@ABA@A@AB@@C@E@A@A@E@A@A@C@@BEBCBA@@@A@AB@@ADA@@@ABIBA@@@CBABA@CB@@A@ADCB@BA@@@@@@A@@@@@@B@@AA@A@@@ABA@CBC@@@AB"],encodeOffsets:[[118407,35403]]}},{type:"Feature",id:"371723",properties:{name:"成武县",cp:[115.889764,34.952459],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@MGEDE@E@C@ACE@C@C@C@A@C@ABBF@BA@@@@@GBCH@@EBGBAB@@@@GD@B@@ABABCDADA@EHEDIDCEA@E@@@A@E@AFECCA@ACAEAEAECC@EBA@@@CBEDCBEDCB@BEDA@CBCDCDCDEDE@G@@@BDABC@G@@AIBCD@F@B@DBFAFCD@F@DAFAFA@E@CBA@C@@LBBDH@BAF@B@@BDAH@FAD@HDFBDBD@@FHBFBFBDBDBD@DDDFHBDDBBFBBFBDF@@BB@DH@@BF@BD@B@BFDE@@BA@BDBBFDBDDD@@D@FA@CF@F@JBEJDABJDJ@BKBAAED@BADEHA@ABCDCBCDEDAB@B@D@HHDD@@LA@@BBB@B@@BDQ@NDC@@FBDBFDFD@BBB@BD@@GBBF@CD@DBB@B@@DFLDB@DABABEBBD@DDHH@DBF@DB@DCBCBEBA@@DC@@D@F@FBF@@@@BB@BBBAB@BADBD@B@BDF@B@@@D@B@B@BBD@D@D@BBH@BABAD@@AD@BBNABCDABAF@B@@@D@H@BAD@B@F@BBBBBBDBBDBB@D@D@@@D@DAB@@AB@B@B@HCD@@CBA@ABCBADCD@F@B@B@B@LHBBD@DBD@D@DA@@DABA@C@@@C@A@A@E@I@AD@F@D@D@J@@@NAHAXCLAD@AA@AAGAC@AAA@CBCP@H@B@F@L@FAD@H@B@B@D@D@DBD@BBDBDDF@B@BB@@B@@DH@@@D@@@D@D@D@D@B@F@FAB@JCB@DAB@D@BAD@B@JABANAD@@AAE@ABCD@J@BAB@@@FABEH@@CH@DBADF@@@D@FBPBB@@@@@BBB@@AD@BADBL@@@@AB@@FF@@G@AH@BABAN@BB@FG@AHB@CHC@AB@FFB@D@BLBBBJ@LBNBDC@@C@@ABAAKBA@EC@@E@@N@@BF@BLABBDB@LAEQLAF@H@B@J@H@D@F@FBB@F@D@FBBAF@B@B@D@B@^B@@AGAECCACAA@A@@AACGAEB@DAL@HAB@B@CCACAAACAAAAACAAAC@@ACDGACACEECCBC@E@@ABC@A@ECC@C@C@ADCAEAEACC@@CE@A@CAE@E@C@@@C@E@CBE@CDGFEBA@AHEBCBCFEBA@@BEDCDCDCDCBCFADCB@DABABADA@AB@FC@CCIH@@A@@@AD@BAL@FBBABCDCAC@@CEECEECA@ACAAA@ACCAACEAEB@DCF@DAD@FAH@@@B@D@DAD@D@D@HAAECCAA@@@A@A@@@CAACA@@AGABACA@ACEBCC@@@@AACCAACAECCABGC@AEABAECB@CE@GACDABA@@BA@EDIBKDGBGBIHEDABEDEBABABIFKD@@E@GBG@ODG@EDABIDGDEHGFGBE@ECAAAE@CAAC@ACCCCCI@I@@@AEBG@CBC@CAE@AACCCAAAG@@@CI@BMJBBAB@F@@ADEBG@@@ABAB@@A@ECGC@CCCAE@IDEA@@IAIAEAAABC@ADEFEDA@@BEBCDCBCBGHG@ACC@GEBA@C@G@G@@A@ABCDCBC@E@CAC@AACBKEG@@ECG@C@IBC@A@EAEAG@EAA@@A@ACAA@I@IBABAFBDA@E@A@A@C@C@AAC@CA@@GAC@CAE@E@E@C@GBC@C@AFG@CDEDEDCBA@"],encodeOffsets:[[118678,35659]]}},{type:"Feature",id:"371724",properties:{name:"巨野县",cp:[116.062394,35.388925],childNum:1},geometry:{type:"Polygon",coordinates:["@@BCCAG@@DG@AFEB@@A@ABI@C@AD@BBF@BC@MBABIBA@C@ABC@A@CBA@IDA@EBE@A@C@C@C@C@@@C@@@G@@CA@@@AAA@E@CCCAAAC@CAC@C@A@A@G@C@EBK@E@A@G@O@AD@DBB@BBDBH@BBBC@KBWDGBMB@@I@C@C@E@C@@B@J@F@B@B@D@@@DABCB@@CBC@C@CAC@AAKGA@A@A@E@C@CDABAD@BAB@DC@GDA@A@A@@BA@CBC@@@C@C@A@@DBDCDADAD@BAD@DAB@D@DBDBBBBBD@B@H@B@B@B@N@D@B@BAF@@ADAFCDAD@BADAB@@CHAFABABCJADAB@B@DD@D@F@F@RB@@BAD@@@BAD@FA@AB@@CB@@CH@D@FABD@BAAEFAB@DABCDCLC@@BI@C@@BABADBF@BBD@FBF@H@DBF@B@DBD@HB@@BBDADA@@DAJAD@FA@@D@H@J@B@BDFJB@DAD@@BBB@@BA@@BABA@@BCBADDJ@@@@BDB@@BBBF@DHDFCB@BADAB@@@@AFAD@DAB@D@@@@AB@B@D@@AD@FBBBDFDB@FBDDDB@@B@BB@@B@B@FBBBB@D@@BHA@BD@BBB@BBBBF@@DH@@BB@L@@AD@@BB@BBDAFA@A@@BCDEBA@ADAB@DAD@BAB@BADADABCDCDCBABCD@HCD@D@@D@F@BDDB@B@N@B@FAJ@@@F@F@D@BBB@@@DBD@FBB@B@FBFBHBBBB@HBFBD@D@FBBBBBB@BB@@B@DBFBB@B@B@F@D@F@DBB@DBDBBBBBFF@B@D@BBD@B@D@BBBD@BB@@HBDB@B@B@F@F@@DD@FFDDB@@@B@@HDFDACRBF@CFD@AFB@@FBBDFDBBBDBBF@@@@HDBB@B@AB@@@FBAFBDBD@H@DAD@DFB@BF@@DDA@CB@DB@AF@BD@E@@D@B@D@@FD@@@DAB@B@@DF@LABBF@@BD@@DB@FB@@@HH@@JD@CHBBF@BD@FFFDB@BL@HBB@FABAHADAF@BAB@@ABBFBB@BCBGC@BQD@F@@ELAFAD@D@BAD@DF@DH@B@B@@AJ@BCE@@EAC@EBACOFA@ACAEIHA@@BDFAFLHCJCB@BD@HBDCB@B@@@H@BABB@BD@@BBLH@D@HF@DKCGF@BAB@D@DE@OE@AAJ@D@@D@HBHDBH@@ECCAACA@IA@C@BAF@CADEAA@CDAFCCMB@NABBD@BA@C@E@C@@@C@C@E@CACACAG@CAA@C@CBEBE@C@ABCBABADEBEBA@EBA@CBC@C@@@ABE@C@CAA@A@AAGACACCEEECAAACACAEAGAGCCECE@E@EBGDG@@@@@@@CBC@C@GBE@CBC@C@ABABCFEFCF@FCDEBCBCBC@@@@@@@ABC@CBE@C@@@@@@BCBGBE@CBA@CACHBTBLBFBNFFBFB@@@@@@DBDBHBHCDGBABCFGJOBGBCDE@I@@BIAAAMCIAAAECIACCG@@AGGOCEEBA@C@G@E@CBA@CDEBCDCDCFCFCBGD@E@A@E@CACCCGAC@E@CBGDA@A@CABEBCBCBCDCBABC@CBGB@BA@CAAACACECACAA@AACAC@C@CACAACEEEEAAECCACCE@ACCAAACEECC@AACAC@AAAAC@@CECC@@E@G@EB@@@@A@CBCBA@E@C@C@A@IAAACC@@]AA@C@A@A@E@ABEAC@E@A@EAE@C@G@I@A@G@E@KBFRKBA@ACBAAKE@@AM@@@@FD@@FABBLAB@BD@@@CDMAKAI@AAKA@A@CEA@EBAD@DGA@BGH@@EAAM@ABABG@@B@HE@@EA@@B@@K@CAABC@@BA@AA@@@@A@OAEAC@@@E@"],encodeOffsets:[[118794,35949]]}},{type:"Feature",id:"371725",properties:{name:"郓城县",cp:[115.9389,35.575135],childNum:1},geometry:{type:"Polygon",coordinates:["@@JBRBTB@P@B@BD@@F@B@@F@@N@B@DAHCB@L@@P@B@@PBFBL@@RCD@DHBHC@@BBFBD@@PAFNBH@BDABJ@BL@V@D@@BAJX@BJFHFHDFPCB@PA@AB@NAHAF@D@D@@ED@BAL@@DF@@@DNVED@N@@N@@J@XBD@@GFDBPB@BD@FH@@HF@@IASHBBP@A@C@A@A@A@E@A@A@A@G@AAE@A@CBGAI@CBG@EBG@A@@@ABCDEBG@ADEHCH@B@D@HANADARCNC@@B@BA@@@@JAPEREDA@@B@PGTEHCVIJEJENG@@NEHE@@@@NE@ADAPIDCJIBAB@@DH@JBASC@DEB@BAPGJEB@FCVINIIIB@P@JKBA@AFCDEB@BAJGCCGC@EBERADDBDLGFCBADABADAB@@A@A@A@A@A@CBA@A@A@A@ABA@EBGBC@ABABCBCFIBADE@ACCAAAACAACA@AAECAAACAA@@@AAAAAAAAC@C@A@A@A@@@A@A@EBC@@BCBCBCDCBCFE@A@E@AJAACDGAAE@CBADA@AAE@AA@AACAA@AB@@CC@CA@CBCBE@ABE@CBCBC@ABEBABE@CBE@EBC@E@A@A@CBC@C@C@C@A@A@EBC@E@A@AAC@AF@@CBCE@AAC@@CAE@E@@@A@C@@@@BA@EDEAA@BA@ABE@CBGBABEBA@GAK@@ACAEE@EACE@AADGC@@IG@@G@@EAA@@CC@@AE@AAKBE@@CA@A@CB@@C@@EC@A@C@@@@FACE@@BCAA@@DCB@CE@@AEA@CBC@C@GACACBEEA@@A@@B@AAAGC@@@@AECAAACACEAA@EA@BEC@DEE@QABDECGC@@@A@@CAEC@ECC@@@E@E@A@ACAGA@@AAC@AA@A@C@AAC@A@C@AEEAAAACACAA@CAE@C@E@A@A@A@EACAA@@@AAA@AAAAEAC@C@EAGAA@AAGAEAEAA@A@EAC@CA@@A@AAC@E@E@@@I@EBA@M@A@A@CC@A@E@CC@C@GDC@ADABCDCDADCBCBABA@ABC@CBA@CB@BABCFAD@@@BEBCBAAA@@AC@@BK@A@@AG@@CE@AAAAA@AAC@@AGB@AC@A@AAEAA@A@@@AAA@@@CACCEAA@ECBF@FAF@@@@@@AFADAD@BAFCFADEB@@C@@CC@@@E@@GG@A@@BC@@DA@CAMBABEA@BIBG@@BAB@BGA@DEBBBA@AAC@A@@DABBBECKCG@GDEDAB@B@D@@@DA@GHCD@B@B@B@B@B@FBFBB@DEDA@KDGDC@ABDBB@@BDBDA@DB@BAF@@ABAD@AFCB@BBB@BBBBAB@@BBJ@BAD@@@BBBIB@BBF@DCDA@@BE@@AA@A@@DB@BHDD@BBBBF@B@D@F@BABABCBGF@BAB@BADBDDDBDBB@H@DBDBDBD@@@@@@BDBD@@@@@F@BBB@DAB@@@@@BAD@@AFCDAB@D@@@@@@AF@BBD@B@HBD@B@@@BA@C@A@CB@@C@@FC@@BDBBDBD@@A@C@@B@FADAB@D@BBB@FBBBD@B@B@BBDABBD@@ABA@A@A@C@A@ABE@A@AB@DBBDBHHDBBBB@@BB@HDGDPJA@@BD@@@B@BX@D@F@BB@BFN@L@BBB@@B@@@H@@@D@J@RAB@@@BC@C@A@CBABAAC@@AA@@AE@AA@@G@@DE@ABA@@@A@@@@@CB@@G@ABC@EBA@BBD@@LAH@@EFGD@@GBBBBD@BDF@H@DH@HFBBBB@F@@GB@@E@A@I@@DA@@@CAAD@@@BA@@B@BB@@BA@@FDA@D@BDA@BD@@@G@DDFFDBFDDDBD@B@BCD@@EBA@CBAAA@C@A@@@C@@@CBMFCBC@KFEFCHEFVHDDJP"],encodeOffsets:[[118447,36610]]}},{type:"Feature",id:"371726",properties:{name:"鄄城县",cp:[115.510192,35.563408],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@GFK@G@GAM@C@@@QHIBA@@@AAC@C@GBCDCFFDAB@B@@@BCFADA@ABCBABABC@@@CBA@@BA@CBA@AB@@A@@BA@ABA@E@KBA@@@ABI@A@A@E@A@GAAAA@A@EA@@EBC@G@AACA@@A@@ABAAA@@AAC@ABA@AAC@C@C@GB@@A@@BAB@D@B@@AB@DA@ABABC@A@CBCB@@@@C@A@EBA@ABA@ABABAB@BA@ADABA@@@CBA@K@A@C@@@A@@@MAA@E@A@A@A@C@@@@@A@A@GBA@@@@@ABAB@B@@@F@B@@@B@B@B@LAJAD@BBBFBBBDDBH@F@J@@DJ@H@JPF@@FBPFPDDBBD@FBDAFAD@BCHCLAJCJ@BFPBFJC@DDF]H@@@BAH@BNAFFF@BH@NNEB@@EF@BR@DZC@P@B@@N@BDBD@BBDBDBB@BJJFFNJDFFV@BDP@BDD@BFBDFHF@@FDDFB@DD@@HDHJDHB@BDLNBFBDDJHPBFJLHJHJJLJJDFXRHDTL@@N@LADBBH@BDDD@F@LA@@J@DAB@LCDBDBFLBBB@B@JCB@FAPGHABADBBBBFBHD@TBH@L@H@JABBD@@BD@B@@BLBH@PBJDDBLDBBLBFBF@D@IOCCUGFEDGFELED@DANEDA@@D@@@B@D@B@BBDAB@FA@@DC@A@AACCCECCAEECCH@@@C@@ACB@A@CCB@EB@@AA@@A@AB@@A@@BCDB@@B@@CJ@B@F@@@HA@@@EAAAAGEG@@C@GCE@AACAAHA@@HCFE@@BG@KC@AAB@FAD@BAH@@@DA@@@@B@@@B@BAF@@CH@@@BBF@@BB@@BD@BBBADAB@D@D@@A@@BA@Q@I@C@@@G@@@AA@AAK@M@AEA@@A@E@CAWA@@@C@@AB@OIHCGCA@@AA@AACAGGCAAA@CBAB@F@BAB@D@B@B@B@BA@@ACBAAC@A@A@AACAA@EAA@A@CBABC@E@AD@B@@@ACACCA@AD@@ED@@@DAB@D@B@@A@@@AAC@G@AAC@ABE@@@@@@@CBADCBE@@BC@A@@@@BA@CAA@A@E@@@@ACAC@@@@@@ACACAC@C@GAAACCCACBC@ABA@AHEDABABA@A@E@C@AAEAA@ACCAGA@@CB@B@@BF@@AB@DC@CAE@AJAAA@A@@BC@AAI@AA@ABAA@AAA@ADABEC@AB@BE@ABA@@CCBCA@AA@CACDC@C@I@E@@AA@A@CA@ACACCAA@AACACCECE@AAG@CCAG@C@EAAAAACA@@CACG@CAE@ACCA@AAACCAC@E@CCEAE@A@A@AEBGAAA@@@AAA@@@A@A@CBA@I@@@K@E@@@K@GAABEDIDABBDM@A@@@@AAC@EE@AAKFGBKDGACFA@GBKA@AAA@MKECBC@K@OJCBCD"],encodeOffsets:[[118293,36235]]}},{type:"Feature",id:"371728",properties:{name:"东明县",cp:[115.107404,35.276162],childNum:1},geometry:{type:"Polygon",coordinates:["@@CECECCGC@BEBCFEDCFAFAFAFCDABGBE@GBGBEBEFCBED@DAB@BA@DPDABF@BA@BF@@AB@HK@@B@@A@@DO@BC@@@AB@@AGB@@@AD@@CBAAC@@A@@BC@@@@@ACEMGB@@A@AEHACEB@@@B@@@J@BA@@B@@C@@A@A@@@EB@@@A@@A@GB@@AB@@B@@BABCBAA@@A@C@KDC@WHGBA@ABAB@@DBHBFDJDHD@BJDDFBBB@DDBDBD@B@B@D@BABADAB@@A@G@M@A@E@CBEBKDCBCBADABAB@BBBBBBDDHBFBD@@BBFJDFHFRDB@RFNBRJNHLLBD@H@N@BELEFCFEHIHCDIJGFABQLABIF@DAD@BBHDF@@DFBDHJBBJJLHDBHDBBB@LFB@PDNBXDNBV@N@B@DBBBBB@B@H@@@JARAL@H@J@L@@AN@BBH@@FD@@NDXFF@BB@DBBBB@BBD@BBB@D@@BDBB@B@@BBBDBB@@FH@@BBBB@@BBDB@DB@BB@BB@FH@@BB@B@@BB@BBBBBB@DD@BDB@BBB@@BBBBDDBBBBB@DD@BBBDB@BB@BBBBB@BB@@@@BBB@D@B@D@B@B@B@BDBZBJ@@BF@NBJ@F@@@BBH@DBF@DFL@@FBND@@N@\\A`@H@DBBDDFDFJLFH@JAJCHAFDDD@BBBD@@BDBAD@@@DC@CDEHEHCH@J@@X@DDBB@BCAW@E@CJ@BPFB@THBBBJBB@NBTDLBF@JBB@H@D@P@LAD@LGEGCGCMAG@E@CDG@G@K@CCG@E@EBED@F@D@BBLC@@DAD@@@D@DBBDF@BBDCDA@@BABEJAPGLGBAJI@@DADC@C@C@GPE@EAAA@CE@GGEDE@CBCBEBCBABCBCBA@@BAFGBC@ADE@A@C@@B@@CB@@@A@@@@A@AEAAA@EA@@A@ACG@A@C@C@CB@@BJ@BGAABA@G@ABEB@@CBCBEAA@ACAEA@@@@@@@E@C@A@C@E@E@ABCAA@C@C@E@C@@@@@@BC@A@CAE@A@CAC@@AA@C@CAEDC@GGAA@G@@BCBACE@CGA@NCB@D@AEAECCACAA@ACECECCCCCE@@@@@@ACAGAA@G@@@@@@AC@E@CAA@ABC@GBC@G@E@C@C@A@CBI@A@C@G@C@C@CBCBC@A@ABA@ABCBE@CBC@@@C@E@ADEDGBEH@@@F@FE@EBOAA@@@BAB@B@BAEA@EDCCA@A@ACB@@ACEA@AAK@GA@@IC@G@@CGK@@@SHEDGFABKBADE@AAC@E@E@IBAA@@CACCAC@@ACAA@@@A@A@@BC@A@@BEBA@G@@AA@AA@AC@A@A@A@A@AAC@ACCCAAAA@AAEAE@A@A@A@G@EAA@A@@A@@@AAIAE@GA@@EAC@A@@S@AD@HIJQ@A@C@C@AAA@CCE@CAG@@@E@E@E@A@E@E@M@C@E@CAECC@CAECECKGEEA@CAGAA@CAGACECECCAACAC@C@EBA@CDEDEF@@CFAFCD@BG@G@@@G@U@A@E@C@CBG@GBG@G@GAG@G@C@CACAAAECA@IGAAAC"],encodeOffsets:[[117692,35818]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
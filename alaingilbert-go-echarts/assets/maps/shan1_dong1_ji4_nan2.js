@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("济南",{type:"FeatureCollection",features:[{type:"Feature",id:"370102",properties:{name:"历下区",cp:[117.076441,36.666465],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@I@E@EAAGGCAC@CDCBC@GAABQJS@C@EBEDKCCLOPEFGHAB@B@B@BABA@CB@@ABCDA@EBECCCAAA@ABABA@C@CAEBABABE@@B@BA@CBEB@AABEB@@A@ABC@A@@@@@@@@B@@A@@A@@@@AA@@AAB@C@A@@AC@E@@B@@@B@B@@B@@@@D@@@B@B@@@BAB@B@@@@@B@@@BB@AB@@@BBD@@B@@B@B@@@BAD@B@@@@@@@B@@@B@@@@@B@@@@A@@B@@B@@@@BBA@B@@@@@B@@@@A@B@@B@@@@@@@@@B@@@@@B@@@@@BB@@@@@A@@@@@@@@@A@B@A@@@@@@@@@@@@@ABBBA@@BD@B@@F@B@D@B@B@@DD@@@@FBB@B@B@F@D@D@B@@B@@@@@B@DF@@BB@D@@@BA@@BABA@@@@@A@@B@@@@A@B@@@@@@@@@@B@B@B@A@@D@@B@@@@@BBA@@@@@@B@@@@B@@@@@ABB@@@@AB@ADB@@@B@@ABB@@A@@BB@@ABDAAABFBD@@@@@@@@@@@@@B@@@A@@@@@@@BA@A@@@@@@@@@A@@@@BA@ABA@@@@@@@@@CBA@@@@@@@@@@@A@A@C@@@@@@@@@@@@@AA@@@@@AD@@@@@@@A@@@A@A@@@@@@@@@@@@B@B@@A@@@@@@@@@A@@B@@A@@@@@@B@@@@@@@@@@@@A@@@@F@@@B@@@@@@BD@@@@A@@@@@@@@B@@@@@@@B@@@@@@@B@B@@@B@@@@A@@B@@@B@@@@C@@@@@BBBDA@@@@@@@AB@@@B@@@@@@B@@@@@@@B@@@@@@B@B@BB@B@@@@@B@@@@B@AB@B@@@@B@@@B@@@@ADBB@@@DB@@@F@B@B@@@BD@@@DB@A@@BA@@D@@@@@@@B@@@@@@B@B@DC@C@@@@D@@A@@@@@@@BB@A@@@@B@@@@BA@BBB@@@@@@B@@A@@@@B@@@@@B@@@@@@@B@@@@A@@BB@@DBAB@@@@@@@B@@@B@@@@B@@B@@@B@@BB@@@B@@A@@@@B@@@@@@@@@B@@@@B@@@@@@@@@D@B@@BF@D@@AD@DD@@@DB@@B@@@FBDBFB@A@A@AB@@@D@D@@@@A@@D@B@B@CB@DJC@@F@@@F@DB@@D@B@HBB@D@@A@@@C@CF@@A@@B@@@D@@A@@@@B@@@@@@@@@@@@@B@@@@@A@@@@@@@@A@@@@BA@@@@@A@A@ABB@B@B@@@@@@@C@@@A@@@@@@@@@C@@A@@ADB@DB@@@@@@@@@B@@@@@@B@@B@@@@@B@@@@BAD@@B@@@@@@@@C@@@@@@B@AB@B@F@@B@AD@@F@@CBC@@@A@@B@DA@@B@@@@B@FA@BB@@@@@@A@@@@@@@BB@@@@@@B@@B@BF@CSBA@AHAAC@C@@B@@@AA@@A@@@@A@@@A@@@AD@@@AC@@@@AAAA@@BCD@@@@@@A@@@A@@@@@@BB@@@@@@@@@@DA@A@A@@@@@A@@@AIA@@B@DA@@B@AA@@BA@@BA@@B@@A@@@@B@@ADAA@@A@@@@@AB@@@@C@@FAAABAAE@@E@C@CACBEB@@CB@@A@@D@@AB@@ABAB@@CA@@@@@@AB@@@@A@@@@@AA@@@B@@@@A@@@@B@@@@AA@@@BAB@@@@A@A@@@@A@BA@@@@B@@@@@FE@AA@@A@@@A@@@@@A@A@A@ADA@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@AA@@@@@ACABABA@@BA@ABADEC@EA@A@@@@@A@A@A@@@@G@@@@C@A@@@@@@@A@@B@@@F@@@B@@@@@@@ACBA@@EA@@AC@@BA@@@C@G@@@CAA@@BA@@@@@@AA@A@@@ABA@CDC@@FA@@LC@@BA@@@@@@@AAA@@C@@@A@@@ECAG@@@GAE"],encodeOffsets:[[119950,37488]]}},{type:"Feature",id:"370104",properties:{name:"槐荫区",cp:[116.901224,36.651441],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AA@@AB@@A@@AC@@@A@@@@@@A@@@@@@@@@@@@@@BA@@@@@A@@@@BA@@AAAA@E@A@A@A@@F@D@BA@@D@@@B@@@@@@EB@AA@@H@@@@@B@@BFB@C@@AAA@@@AACA@@@A@@@A@AA@@@@@A@@@@@A@@@AC@@AC@@BA@@@A@@BA@@@B@BB@@E@@B@B@@@@@@A@@@A@GA@@@@A@@@@@@@A@@@C@@@@@@@C@CD@A@@@A@@@A@AA@@@@@I@O@A@@AJAB@B@AADA@@@A@@@@@@@@@E@A@@B@@A@@B@B@@@@A@@@ABC@CFC@A@@@A@AACCAA@A@ABA@@@@BA@@@A@C@@@AA@@AA@@CCEACAA@@@@@A@@@CBAB@@A@A@@@ABA@@@ABA@@AA@@D@@@@A@@EEBA@BBG@E@@D@BG@@B@@@@AHA@A@ABIA@@[CIA@PGB@AE@@BCA@ABACECA@AA@E@@CCBACE@@A@@D@AAEBIBEBCBCBCB@@AB@BADBDBDFD@BBB@DAB@DABCH@DBLFLFN@BDH@@DBB@DD@@ADA@CDGDA@EH@@EJKPABABBF@@DD@@JHBBBBNHBBDBFBHDHFHBB@DDJBB@HB@@F@N@BB@@H@DBDB@BB@BBBBBB@BJFDBJF@@LDD@F@B@B@B@B@H@B@B@LAD@D@H@DAHAB@HCBA@@HAEOCA@C@@AK@K@@HA@@DA@A@C@@@@XAB@AC@C@@A@@@@AC@@AA@@@@AB@@@B@@@D@@A@@@A@@AE@A@@@@D@@@@@H@@@AGFA@@@@B@AA@@@AA@FCA@@A@@B@@@@@@@@A@@@@@A@@@AF@@@AA@@@@A@@A@@@A@@A@@A@@B@BCDABA@A@A@AAEF@B@@@B@@AD@@@B@DC@@B@@@@@BA@B@AB@@@@@AAB@AAB@FBD@B@@@@@D@@@@@B@@A@@BA@@A@@@@@@@@A@@DB@@@@B@FB@@@@D@@@A@FB@@B@@@@@@@@@@AB@@@@@@A@AA@@@B@@@@A@@@A@@@@A@@A@@@@A@B@A@B@AA@@BA@A@@@@@@B@@@@@A@@@@A@@@@@@@@@@@C@@@A@@A@@A@A@AA@A@@@@A@@@@@@@@B@@@@A@A@A@@@ABC@A@@C@A@CB@A@ABE@C@A@@@A@G@BC@@@@@C@A@A@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@BB@B@@@@AA@@AB@@@@@BAB@B@@FF@BABCAE@@@A@A@@@A@@@"],encodeOffsets:[[119773,37521]]}},{type:"Feature",id:"370105",properties:{name:"天桥区",cp:[116.987153,36.678589],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@EA@@@@CC@@@A@A@C@A@EA@C@@AB@AABA@@@@@AC@@@@B@@@@@BC@@@@@@@@@@@@@A@@@@BA@@B@@A@C@A@@@A@CBC@@@A@A@@@ABA@@A@@@@@@@@@@A@@@A@@@@@@@@@@@CAA@@@@@@@@@A@@@@@CA@@@@A@@@EAB@@@C@@@@@EAA@@@@@CA@@@B@@@@@@B@@@AB@@@BA@@@@@C@@@@@A@C@EAA@BBA@BB@@@@A@@B@AAB@@@@A@@@CDA@@@C@@BA@@@A@E@BF@B@B@BABCBADA@@@@BB@@@@B@@@BB@@@@@BB@@E@@B@@@B@@@@@B@@@@@@A@@@@BB@EDB@@B@@BBA@@@@@EBBH@@G@@@@@C@@@@@@BBF@@@B@@@BC@@@A@@@A@@B@@B@@BD@@B@@B@@@@DBDA@WB@@@@@D@BCB@@GB@@@LBL@@@DDBFPGB@@ABGDA@GBCBG@C@C@KBA@A@G@A@A@A@A@E@C@AB@DB@@@@FAD@DCA@HA@@@E@@@GBGAABA@CHCRAH@@@@@DBPBRDLC@BD@DFL@@@@BDFAB@JAB@DBBBFABDA@BFAD@BG@ABADBDA@DF@BBD@BDFBAHE\\EA@B@HAACB@DBBANA@CB@@@HBJBHB@FBFD@@D@B@DAB@JCB@DBBBBD@H@B@D@@ABADA@@BCB@B@D@B@B@F@DBB@HL@D@JAR@FHED@@@H@@BB@J@BG@@B@BBBABEBCHCB@@BHBL@NCF@LA@@@DH@@B@BB@FB@@H@FBBBHBD@@BLHFBD@J@BAFA@DJADEDBBA@EBCBCAE@E@@A@AA@I@@@@@@C@AIDGBAB@B@@I@IEC@A@I@@AA@AEAAAACBCACC@@@AAEK@ABAB@D@BA@@@@@A@CJAACBAH@B@@ABA@@BGB@H@B@FAH@D@B@B@AA@EAEACMGICCCICECAAAAGCCACA@@@@O@E@E@A@GACAC@@AA@CCAAAAAC@AAAAC@CDEFKBA@@@@DCBC@A@@@A@@AC@@A@@@@AB@C@C@C@ACBA@@B@B@B@@@B@@@B@@@DDBAB@@@CACA@A@@@@B@@AB@B@@@B@@@@@B@@C@C@A@@@@@CB@@@B@@@@@BA@@@BB@@@AB@@@@@BA@AD@@@@@@@@@@@BD@DB@@@BB@@@B@BA@@@@@@@@@C@@@@@@@@B@@@B@@BA@@@@B@@AB@@BB@@@@AB@@B@@@AB@@B@B@@@@@@@@@B@@B@@D@B@@@@C@@EA@@B@@@@ABC@@@@B@@@BA@@@AB@@@@@BADAB@@@BA@@@B@@@@@@D@@@@@@@@AB@@@@CBBBA@@@@@A@@@@@@@@B@B@BBB@@@@B@@AB@@@B@@@@@B@@@@B@@@@ABABA@@BB@@B@@AA@CA@@AAAAA@AA@@CAD@@@@A@@A@AB@AA@@@A@@@BA@@@@@@@@@AAA@@@@A@@BA@@@@@@AA@@@BA@@A@@@@@@@A@@@@@@AA@@@@@AAA@@CB@@ABAA@B@@@B@BA@@@@B@@@@@@@@@@@D@@@@A@@ACAA@AC@EABABBAC@BA@@AB@@@AA@BA@@@A@BCA@@B@@A@BA@@@@A@@@@@@A@@@@B@AA@@@@A@@@@CB@A@A@A@@@@@@@@@@@@A@B@@A@@@@B@@@@ABAB@@AB@@C@A@@AE@@C@A@@@@@AA@C@C@E@A@"],encodeOffsets:[[119832,37559]]}},{type:"Feature",id:"370112",properties:{name:"历城区",cp:[117.06523,36.680259],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BBDA@@BCDC@@@EFOH@BEFABCDEBABC@E@C@I@OBCDEH@DD@DD@B@J@DANIH@BIHEDEBE@G@ABA@@B@DCFGBAHBPHDJ@DD@@@BAFBFJF@@BABB@@@B@@@B@@AD@@B@JDFFJBF@TCD@B@@DBBF@BCB@AFEH@B@BHHLDFB@BDDB@HB@B@@B@B@HB@@HBJFNBBAB@DJ@BDFA@AB@BCFCB@@@BBBBA@@B@BD@@@BB@@B@@BB@@@BLED@FLDFFDB@@C@ADAFBFGB@JBLBFD@BBBB@RADCBACG@AAI@AHDFE@CDGFABA@EPMBA@@PC@@JAB@DBBBJDBDHBDADCA@@CLALDLBD@@@J@LJDJAD@DEDDJALEDKC@AE@IDAFCJBBABCDCA@@@FEFKDBJFBD@DBHHBB@F@F@JA@BF@H@@BHFD@@B@@@D@@@BB@B@@@@@@AB@@KD@@EB@@CD@DAB@B@@@BBB@@@@@@AB@@BB@D@@@H@D@@AB@@BD@@FB@@ABBD@@@@@@A@@@E@@@A@@@@B@@@@@@@B@D@@H@@@@@@B@B@B@@@@@BFBD@CFAB@BAB@@ABABDB@B@@@@BB@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@B@BCB@B@B@@@@@B@@@B@@@BBF@@E@@@@@A@@B@@A@B@@B@B@@@@@BA@A@@BB@@@@@A@@B@@@@@@A@@BB@@@@B@@@@@BA@@@@@@DB@@BABA@@BA@@@CB@@@DA@@FADADBD@F@@@BFABBBEB@@@D@@A@@B@@@@@BB@CB@BA@@@@@@BA@@@AB@@AB@@BBA@@@CBA@@@JB@B@@@B@@@@@B@BCB@@@@@@@@@@AA@@@@@@@B@@@B@@@@C@AD@@BBBB@@@@BD@@C@@B@@@B@@@B@@B@@@BB@@A@@@@DBDGB@BABDTE@@A@AA@@@@@@@AA@@@@@@B@@@@@@@AAB@@E@A@@A@@@CBA@@@@B@@AD@DE@@@BCA@@@@E@ABAA@@@@@@@@D@@@@@@A@@@BC@A@@A@@@@@A@@@@A@@@@A@@@@@@@@@A@@CCA@BB@@@@D@@@@@@@@@B@@@D@@@@@@@A@AAA@B@B@B@@@@AB@@@@@B@@@@@@B@@@@@A@@@@@@@@@@@@@A@@@@@@BC@@@A@@@@BE@@D@D@@@BC@A@GAA@C@@@CAE@@@E@@@ID@CDAA@A@C@@@@B@@C@C@@@A@@B@B@BEACAEA@@A@@@CA@@C@@CBC@@@CAE@@@A@C@@@@@@@@@A@@A@@@@@@@@@A@@@@@@BA@@@A@@AA@@@A@@@@A@@A@@@A@@@@@@@A@AB@CA@@AB@@@@@@A@@@@@@@A@@@@@A@@B@@@@A@@@@A@AAB@@A@@A@@@@@@BAA@@@@@@B@@@@C@@D@D@@C@A@A@@@@A@@@@@@@C@@@AB@@@BCA@@C@@A@@@A@A@E@@CA@@A@CA@B@@A@@@A@@@@@@ABAA@@@@@@A@@@@@AAAA@A@@@@@@@@A@@@@@@@A@@@@A@@@A@@B@@@@@@CBAA@A@@@@@D@@A@@@A@@@@B@@A@@@A@A@@@@@@@A@@@@@@@A@@@@@@@@@@B@@C@@A@@@@A@@@E@@@@@@B@@@@@@@@@@A@@@@@@@@BA@@@@B@@@@@@@@@BA@A@@@@@@@@@@@@@@B@B@@@B@@@@@@BC@@@@B@@B@@@@@@@@@@@@@D@B@B@@@@@@@@@@AB@D@@@@@@@@AB@BAB@@@@@B@@@@@@@@@BAB@@@@@@B@@@A@@@@@@@@@@@@@@BBBBD@@@B@@C@@@@@@@@@@@A@@@@@ABA@@@A@B@AB@BA@@DB@BB@@@@B@@B@@@@B@@@@@@@B@@@AB@@B@@B@@@@B@@AB@@@@@BB@B@@@@@@@@AB@@B@@@B@@BBAB@@@@B@@C@DB@@BBB@BBBB@@DBB@@BA@@@AA@@ABAB@B@@A@@@@@@A@@@@@A@@BA@@@A@@A@AAA@A@@@@@@@@@@B@@@@ABAA@D@@A@@B@@@@@@C@@@@@@@@A@@AB@@A@CBAB@@@@A@@B@@AB@@A@@@@@AD@B@@A@@@FB@@@D@@A@C@@@@AA@@@@@@@@@A@A@@@BA@@A@@@BA@@@@AA@@BA@@@A@@B@@AA@@@A@@@@@@@@@@D@@@@@@@@ABA@@@A@@A@@CAC@@A@@@@@@@@@@BCB@@A@@@@BA@@A@@A@@AB@@@@A@@@A@@D@@@@@B@D@DA@@@@@A@@@A@A@@BA@@@@@@BDBDB@@A@ABCC@@A@@@A@@@A@A@A@@@ABBDD@D@D@A@@B@@B@@@BD@@@B@@@BADCD@@@@ABELCF@DBDBB@BBDBBBBDDB@@BD@DBHBB@F@F@P@@@@@DBDBHDBBBBFDJDDDJDNHBDBF@FBB@@DDLFB@BBDBHBD@H@B@H@FBBBBBDD@@DFFLBFFLHJBB@BBBBDBBHHFD@@BBLH@@BDAFAJAL@@@DBHDDDDBB@@D@J@F@F@BBB@FDB@@@@BBB@@BBBHDH@BBFDF@DDFBDBDBB@B@@BBLHJ@F@FEHAB@D@@A@GHIFCFA@A@@CGACCEGI@EF@@CB@@CDB@BD@F@F@F@B@J@HBB@J@NDFBJBPEHMB@BDD@BBB@DBDBJFNHBBHDFDDBDBDBLFBBB@BBRDBA@QF@J@@JB@DABGAKACCA@E@C@AA@@CH@BGBCB@D@BB@@D@AE@AE@@GHB@AB@@@JGDM@ABG@C@A@C@M@@AE@E@CECC@GDC@@CIIAGCA@@@C@AKCQE@A@AFADAD@FAJ@@EH@@A@A@@@M@E@CDAB@@AB@@@@A@A@@@A@AB@B@B@B@@@@A@A@A@@@@@E@A@AB@@@BE@C@A@@@@B@@@BA@@D@B@@@@A@AA@C@E@@A@@@AFC@A@@A@AB@@C@CAC@@CFBBABA@@BA@@CAA@DC@AB@C@CBACDAAADCBA@AAEFADC@C@AFCBFPAHKNGGC@A@CRA@CAEI@@CCAA@AG@CAACMF@JAB@@E@EF@@@AMDAROKQAKCCECIIAA@@IKPQFG@CGKEE@GAACAE@G@C@BGDEDCDM@C@@A@AAEMC@CAACGCCA@@CAGCEAC@ADA@C@CACCLA@CHIOKIGAA@AECCADGBABCA@@ABA@@AADB@GAGBARCHE@C@CAEKC@C@CA@AAIDEB@@@CDEIKCAAACBE@DAFA@AAGHCCKEECGC@CBC@A@EBABEBG@@ABCAICEGGCAEICG@@WCEGCEGAGGCAAEAIACAGGK@A@AACEECBCBE@KCGEECS@@A@EEECAAAAIACMQCE@@CACAGEEACI@C@@BCB@DEGOIAI@O@CAKEGCEAEBC@CCIIBCBCEAC@EBCBCD[FECC@@@@@ABABA@A@@BCA@BA@CB@@A@@BA@AB@@@@CBA@@@A@A@ABAABICIEA@@ACEEA@ABQT@@EACB@DEHEBCCABA@@DDFHBBB@BABCDOFOFUDA@M@A@Q@BD@B@@DD@D@@@BADBD@BAF@@A@@BA@ABBBDDBFBF@BC@GLCF@R@JAH"],encodeOffsets:[[119819,37294]]}},{type:"Feature",id:"370113",properties:{name:"长清区",cp:[116.751843,36.55371],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@GEMGCAEACA@@EAgMIEQOQIGBK@MEIAA@@@C@@@UBABIFEDCDMLIFGDMBG@@@K@C@AA@@C@KAC@@@G@KHIJGLGHABED@BEDKFONUHABC@@@_DCDIF@D@HDD@@BB@BB@@BAD@@FFDHB@@FBBD@@FBBBD@DA@C@]FG@@F@@AB@EABA@CDBBIDCBCDHBJDDDBDBDFH@BB@DFHLB@BBDBHBLBB@JB@@@@P@JBBBF@DBVDB@HBJDJBNFFFHDDHBB@HAD@@AFELADABCH@BCHAB@D@B@@@BBFBL@@ADCDSDIBADAB@B@DBDDFFDDDBBJBD@J@B@F@F@D@@@DBFDBF@HAF@@GB[LABAB@DB@HFDDHFBBDDBDBBDHDJDDF@F@H@HAHBDBHFLJFF@BBH@DAJCHAF@BBBDDD@HBP@B@HBD@JDJHFJADAX@B@DDDH@HAN@F@B@RHB@HDHDDBF@LFDB@@B@@@JFHDDBB@BD@@DF@BBD@D@D@F@@@JBD@BDDBBB@HFJD@@FFBBBF@BBHBD@D@F@FAB@D@@BL@B@DDDBB@@@@F@F@FAF@D@DDBBBB@B@D@BBALOFI@@FGB@HCDCB@BC@@CCA@CA@@CG@AEMEKAK@CDGBA@CBA@CAA@AECACACBC@ABA@@DADADAFAJAACEC@AD@GGBA@CB@@@B@@@JCHCBCBCDEHEHGBADCFCBAFA@AFCDADCBABCBAFC@AB@DCD@D@@@B@BA@@B@BADADCJADC@A@AE@QAGCCCCIEOAQAGCS@ADCH@@CAABC@@D@DABEBE@ABCDCJAH@FCHEHGFCHAHAHBB@JBHBJGBA@EXDHADE@C@AB@BAH@F@FAFCJG@AJGBM@C@I@ACCC@@CFGDCPAJ@D@F@D@BAFADCBAFE@APGFE@@D@DC@AB@AC@ABG@I@QDEHKD@@AAEAECCAABAB@@AB@@@BE@AACBC@A@@@CCC@@@AACR@B@N@B@VCPEPEDCBA@AAAGACE@CB@BADDFAFG@CDAFB@@RSBAB@CMACCO@EBCBCAG@CAECCEBG@CCE@EEEACAECCCAEICCBA@OEECAI@EAAE@IAGACAG@@@EAC@ABAADE@C@AA@@A@@B@AA@@A@BABABC@@EAADA@ECCAGAA@G@CAEAAECAIAABCDIFEB@BCFEFEFKEODABCB@@CBEACB@DADEH@B@BC@C@CA@CA@OECCGECAAAQCIAAAKDAFEDCBG@CBEDBFED@NEBCBB@DBB@EFIDED@BA@@B@D@BCF@BBDBD@BMBA@@@ACEEKAE@ICEBG@MDAAMFULSJCBOBI@"],encodeOffsets:[[119577,37184]]}},{type:"Feature",id:"370103",properties:{name:"市中区",cp:[116.997845,36.651335],childNum:1},geometry:{type:"Polygon",coordinates:["@@AOBGWC@FABIHGAIAA@GAGBGBEDGHGFEDG@IBCDAD@BAFAFCBC@@@ADBB@DG@CD@BDTBHBRFPDJDDHDRBF@@B@BCDIBCDCBABA@@@ABA@@@C@C@CDA@@BEDABADABCDCBED@BEBABEDCDABGHGFCFADADGDID@@A@@@A@@DABHHC@@BFDBDFABBC@@@@BF@BDDA@DF@B@@BDBDFAB@BDB@AF@@BHA@OJB\\D@@JBBAB@B@BG@@@@@AH@@A@CF@H@AAB@FA@FB@@@@@@CB@@BB@BA@@B@BA@@B@B@@@BADA@@B@@@@@B@DBFBDD@@BB@@BB@@D@B@@@B@@A@@B@BAB@B@DBBD@B@B@@@BED@DAD@B@@@B@@A@A@@@@BA@@@@B@F@@@@@@@@@B@@CBBBA@A@IB@BB@P@J@@@@@B@@B@B@@@B@@@BDCD@@@@@@@D@@@B@@@@@@@B@@@@@HBB@@@B@@@@@@@@A@AF@@@AAA@@@B@@AB@@@B@@AD@@BD@@B@@@B@@@@@B@@@@BBB@@@B@@@B@BD@B@@BB@BD@A@AE@@@A@@@@@GB@@BFA@@@@@@@A@@@CB@@A@C@EB@B@B@F@B@BB@BB@@A@@B@@@@@B@@A@@@@@@@@@@@@B@@@@@@@@B@@BD@@@BA@@BB@@BB@@@B@@@B@B@@@F@DBBA@AEE@@@ABA@A@@@@BA@@BB@@@@@AAAB@@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@B@B@D@@@@@D@@A@H@B@@@B@DAF@B@BDAB@D@@@@BAD@B@@@B@B@B@@A@@@@@@@@@@B@@B@B@@B@B@BB@@@@B@@@D@@@@@@@@@@@B@@B@@@@@A@@@@@@@@BAB@@BBA@B@A@B@@@@@@BB@@@@@@B@@@B@@A@@@B@@B@B@@@@A@@B@@@@@@@@DB@@@@B@@@@@@@@@B@DB@@@@@@@@@@B@@@B@@@@@@@@@@@@BB@BA@@B@B@@@D@DAB@@@B@D@B@@@@AB@@A@@B@@@@@@@@@@@@@D@@A@@@@@A@@D@@B@@@@@@@@B@A@B@@@@@@@@@B@@@@@A@@A@@@@@A@@@@@A@@@@@@@@@AA@B@@@@@@A@@@@@AAB@A@@A@@@@AB@@@@@@A@@@@@A@@@A@@@@@@@ABC@A@@@A@AA@@@AC@A@@BAA@@A@@@A@@@@@ABA@A@@@A@A@@@C@@A@@@@A@A@@@AF@D@@BB@D@A@BB@@BB@@@@@BB@@@@A@@@@@@B@D@BAB@@@FABA@BFADAB@@A@AF@BABAFADBD@B@BABAB@BBDDFDFAB@DCBA@@DAB@BA@A@A@ABAHGFEPODKLDFCFAD@T@RIBAHBD@DADCEAAILCFE@E@@DBDCBAAADIBEJCF@@BLDFCBKCIFC@CBCCIKII@@@C@KAKCKB@DB@CDCBGAACICAACAA@IB@@OD@@ABON@FABEBCH@DEFGC@BBJ@BDHABCDQBA@AA@AECKAIAA@EHEACB@B@DA@ECCEEKC@KF@A@@AA@@@AA@@A@@ACA@@@ABAA@A@@DADE@ABAB@CE@ACIA@ABMAIEGA@@GAA@A@@@@AGAA@CC@AEAKCGG@A@AFGBEA@ADE@AA@CA@C@SDE@IAEEICA@@@BC@@@A@@@A@@AAAB@@IEAEBE@A@@CCI@GC"],encodeOffsets:[[119741,37374]]}},{type:"Feature",id:"370124",properties:{name:"平阴县",cp:[116.456006,36.289251],childNum:1},geometry:{type:"Polygon",coordinates:["@@KFABE@GR@BAP@P@H@DDJBH@@BHDNBNCL@@@FGLCDIJADEJIP@BABCJCFAFGJAB@@CDKL@@CFKNBBFD@BJF@BBBBDHH@@BDDD@BB@BBBBBBDDLLBBDDHFHDBBHDPNBBBBBDDD@NFJFHFF@@BBHFFFBBHHDDDLDL@@DFDDBDNNNJDB@@HDJDFBPD@@HBBBPDVFJDDBJFHDDB@@PHNDP@D@@@N@LAB@NBB@FFDLFLBB@@DFD@DBN@@@R@HAPCD@FDBBBHDJ@@DFFDBBBBDJ@BDF@DHH@BHFDDHF@@BBJJBB@@DCDAJCAADCB@BA@FBA@@@EH@^ED@B@@CACAA@EC@AA@EA@CGEE@@BC@AA@@AAA@@CC@G@CJEDC`C@@D@BAVGPMLEFC@AFCBAHGHKJILGACACEOOICCII@@QCCGAACE@ADCACAAA@CDA@E@@AA@@B@DBF@BA@E@E@KAGA@AAECCA@@DA@CG@CBA@@@A@AAAA@C@MAEAE@AFEDABC@GCA@AA@@@BABBBDB@@BDABGAE@E@ABA@A@ACAI@C@CIGBCEBA@ABCCIAICK@@G@CBAB@B@@AAAACA@AEEAIC@@@A@AAA@@@@@@EC@@@ADEDED@@CA@A@BEB@B@@CDE@@BAB@@BB@@@BAJEZAJM@@FQ@@FEDENCHA@ACGCMA@CBACBAEG@AA@C@@A@@@AE@AAB@@ECB@AAENADBH@@FLBF@ACB@@CAAACB@E@@A@CF@@@FALBBDFBFB@@@@LATGDKBMAEACKO@AOM@ACMAEAGA@@@@CCC@@@@GEEIKCECCAA@KAGCKCA@A@A@WAI@A@IBI@G@MCA@IC@@AEK@@EAEAIE@@@@@ACA@C@ACGC@EG@BFBBBDADC@@D@DID@FBHF@@B@BCJABC@@@@BW@M@IHEBABAJCLIJKBI@K@OCGCICEMCCCIMKIEECICAAOEGAUAACGG@QEIACMBIFIFCBAB"],encodeOffsets:[[119073,36905]]}},{type:"Feature",id:"370125",properties:{name:"济阳县",cp:[117.173524,36.978537],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@EBA@G@A@AH@@AB@BA@G@ABBDIB@D@B@@@@ABC@A@AB@BFLBB@@D@BDADBDBBFB@BBB@@@J@BFD@J@JA@A@ABCHBJD@@@@@@@@JBBB@@@@FBFADAD@FABCACFIB@CEBABI@C@EAKG@AC@GAAAEAG@@@EAA@@A@AG@@C@@KBE@MDK@GA@AA@GDADAFABAAA@@@AHI@A@@AG@@@C@GFADBPBF@D@@@D@@@@@H@J@@@FAJCP@BADAJ@@ADKFAB@@EBIDGBID@@@DCBGD@D@@@@@@IBA@@B@@HNB@DHD@B@B\\@BC@A@G@EBE@C@CAM@CB@DA@QA@D@F@J@BBHEBEFEDADARAJ@DFB@BD@BDBBB@DHFFBBFFFD@@@@BBFBB@BBDBFBPFB@HB@@PFJBHHDD@@ILEBA@GDMJAB@DER@PELAD@@CHALBLLHFB@@@@FDFB@@HBJBBBFBJB@@BBNBH@JDJBJBXHFDHB@@@@BBNDB@L@VCD@JBJ@JBBABA@EB@B@DD@A@AD@@CJ@@@BAD@B@BD@@@D@B@B@@LD@DBBV@FBH@B@N@NDHBNDFBJBHFNHPDBBN@JERGFAJCN@@@H@LBD@ZDB@NF@@FDBBJH@B@@FDFD@@FDDDB@DDBBFDFDHDDDDBB@D@PFB@FDJHB@LB@@HBD@B@FBXDTHFBB@DBLD@BHDB@BBFBFDBBB@@BLHFFB@@BBB@@BBB@@BB@DB@@DADAHCDAHOLCHBBBDB@B@BBD@DCF@@@@@BABCF@@@B@B@@GP@D@BBJ@@@@BDB@@BB@@@@@BBDB@@@@@@HDHFBBHBDBBBHDDB@@FBDBB@HBHC@@@G@A@E@CBGFMDEBCBC@@BABADEHGDCDEBE@@@AJGB@HGDCBAFC@@@@FEHKDGBG@SDK@E@ADKDK@A@@@@@ABGDEBC@ABKBADE@@@A@CBAB@BGKKKKKICAA@A@EAG@A@C@MAOAG@IAC@EAA@OGQKAAIEA@CAAA@@EAA@ECE@E@A@KBA@@@KDSDGBK@GBMBAAC@CAA@EAKMCA@AEEEEA@EEMGE@ICYEMCMEEACAAC@AAA@I@CAE@M@@@E@@@C@GBA@G@@BE@I@A@ADI@@@GBC@C@@@C@@@E@@@@@EAE@@@GACEGCECCKKECCC@ACAACCCCCIIECCCKIACGEKI@@@A@@@@A@EEEG@@ACCI@A@K@@@G@@@AAAACACCE@CCEAE@ACGAGAA@@AA@A@@A@ECA@AAE@E@I@C@@@AACCCCAG@C@@BKBIBEAC@@KGAA@@ECGGAAACAA@AAAGIEKAEEKCE@@CCAAAAEAG@A@G@C@GACAAAA@KECC@@A@A@C@"],encodeOffsets:[[119891,37666]]}},{type:"Feature",id:"370126",properties:{name:"商河县",cp:[117.157232,37.309041],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCA@CCEC@@ECEC@@@AIGAAEC@@MEA@YCC@KAG@@@M@IDEBQHIFM@AAOCMGGEIAEAMCGAMCM@A@G@EAU@AA@CKC@@@A@A@C@@ACA@C@AB@@I@@DC@@B@BCC@HDB@@@B@RB@BBB@@DBB@@FBHB@A@@JB@B@@@DCBEBCLBB@@@HCL@@CD@B@BDBR@BB@F@TBD@DBDIB@FA@AJKFEBEBE@@@@@AA@@E@AA@ZA@CAC@@HM@ADBBAB@@EBB@@FF@HHDBD@D@@BBD@BBHA@C@@DDJDBF@@@B@@HDB@BA@C@@FE@@HADA@E@BBCA@N@BC@AFB@BBLAB@@DCFMLKHEBEDE@@@KDMDAL@@@@@D@BABAJ@@@@@DAB@@@@@DCJ@BAHAHAFCFCFCJAF@J@DBBHHPHB@LHFJAF@@AJGJGH@BBB@@@@FL@BB@@@HNFFJHHNAJARBB@@BFBF@@BBDFFFFDT@LB@@r@@@TBLBXDDFFFDNBFK\\BDBBDBNLDBLDHB@@FBNCHK@ARB@@J@F@B@B@@@TFNHLFLBZ@J@FJ@BBBJLBBHJB@FHHHHJFLDJBBNFPAD@NANALDLBFMRGBG@C@ABIJAH@JAH@B@DAJABABAHI@C@IGIAAMAA@EAE@OCEC@@@@ECCO\\ENCDC@@@@DCQGGIHGTCH@\\@PDPBF@L@D@HCBAB@@@@@HGNO@A@@@@@CBCAMAE@CCE@@AAA@@AAAAA@AEKDCNOLCPIFA@@JCPI@@@@FCHGHGNOECCA@@EMBED@BAD@@A@MCKAC@CCE@OBG@ABE@C@@BGFIDGBEHEDCHEFE@CDK@E@EJ@@@@CF@@CC@AI@GBALC@AACD@@@@A@AAAAAGB@BIBCECADFAB@DE@BGAAE@CGBA@ADAFA@CC@E@C@EBCACCGBABC@ECCC@ABAAEAKCAENAH@D@F@B@H@@GDGAA@CAEA@@CAGCAACAGAAAGEGC@@@@@@CAAA@@@@A@@AA@AC@@@@AI@A@CHO@@@A@A@@DEBA@A@@@@DE@CAC@A@ACAAAGAKDGPCBGDCBCB@@CAA@@AA@AA@@AA@AA@EEKG@AA@AAECEAAAA@GC@AKCCAA@EASGWCEAA@C@GA@@KAA@IGECA@OEC@A@CACCGCECECAA"],encodeOffsets:[[120037,38048]]}},{type:"Feature",id:"370181",properties:{name:"章丘区",cp:[117.526228,36.681258],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@IGM@E@A@C@AEE@@@EDEBKDIFA@A@@A@@EI@GDEACAAQMI@AGAEAA@ABAD@@AIE@@@@OKC@A@GAG@E@A@OBG@G@SAC@KAKGCCCEGIOQEIACAASCWDA@IH@@OPGHGH@BONIDCBG@KAM@MBEBC@EAAB@JA@DHFFDLGDBH@BEBCBF@DABBDBJLCF@D@@FAJCBBB@@D@DLDBF@D@DGFQDABBH@HCABB@@AB@BB@ADABCHDBFD@BBBJHPLGJ@DKBDDDBD@B@BCD@FBHDDB@@DBHDBDDBD@FNBBB@@@@DCNCDCFAHD@H@F@DBBB@HFFHL@DEHORJL@@BBJJFDDDBLLRQPCBBN@@E@@F@FA@IBE@DNBB@DBHB@DB@DJ@BF@DQB@D@BHDMHGLOBAEED@B@DCDEBBF@BABCDBBCBBDDAD@A@@BCDB@DB@@AB@@ABABEA@DD@DBD@@@BAB@@@@BED@B@@@BF@D@B@@B@B@@A@C@@@AB@@A@@@@@@B@DAF@@A@@B@B@F@@@@@B@B@B@@A@A@A@A@@B@B@@@B@B@@A@@BA@CB@D@F@N@@@B@BG@@FI@EBC@CBEB@B@BRFLD@B@D@@DBBHJJ@DD@HCD@FD@D@FBF@@@N@D@B@DAH@BCNIH@@A@@BGA@HF@@BBFC@@@AAC@A@ADAHG@@DB@@B@D@FDBBDBLAHCBA@@II@E@@RABQCAAA@AAKECACACAECGCAAMGIECACAA@AAC@ACA@GNOFIAEAMCI@A@GAI@A@E@E@E@C@@ACA@DA@@DE@@FHJDFBDDH@@@BEBEDGJ@H@BC@A@GBEFE@I@KGAA@H@@@L@BDJBD@@FHFFB@@@@@@B@@LJHFBDLJDDFDJJDDDDBDDB@BDDFDLLDDDFFHBD@H@@BF@F@@@@@F@@@D@@@DAD@H@@CJ@B@B@JAF@@@HAB@H@D@@@F@@@NBF@D@JBB@BBDDBFBNFNDZFJDF@NHFFB@FFFF@BDBLNFBB@DBD@BBNAHAL@HAECACBABAHADC@AA@@K@CCIAIAA@@EGKIA@@AICKE@A@MNMBAHE@@NCBALCNCJCD@HCFCJIDIDKDK@@BG@AFQDKBCDCDCD@PAJDNBJJJH@@@@DB@@JA@@DADAJGHE@@HDHBHBD@F@NAD@DDDBDA@ARDHBB@@@@B@DCH@@A@BCA@@BCFBBF@BCJB@@@A@CB@BBDBD@F@FB@DB@J@@AFAD@B@BAD@@@B@BE@ABAB@BC@CHA@CBABB@B@HLDBB@DB@@BD@@@B@BD@F@@B@@DD@DB@D@@H@D@F@BDF@DCDCDABCAABEA@@DE@EAACBEREB@BAHCD@DC@I@@AAAIACC@CAOEAKAA@@@@@@C@@DCB@@A@GBAB@DCBEECK@CF@JC@E@@XIB@@@DANCJEBANQBEFGDE@@BCDMEOA@EMAO@CFWPOHG@AFEJIRQLEBA`KLCHCNERKFCVOPKBC@@NS@ABIBC@CFEDEBADAJ@P@J@B@DDH@H@JALCF@TIAC@@ECIUCI@@FK@A@AKGAAAAGICAMG@@@@AA@E@G@@ACCIECKB@GE@GB@ADACG@@@@@@@@AEC@@EBABCAA@C@@@@@@AAAAAA@A@ABMDG@AB@H@LABC@EACBADABAGMDAD@@CC@@@@A@@A@F@DG@K@@AE@C@A@CHACC@@GBI@AEA@AB@@@@@ABEIAEBKA@AJGDAB@TDB@L@@A@GAAGAGAA@AAD@FGFIDEBCAAC@@@ADIDACA@EEKC@@CEACBAHAB@FCDAFBDADCHCHAF@@EBABABAAGBEBAB@JADCBADADADA@C@AFQ@CI@CB@@I@CCSI@@CA@@MAQBC@MB@@EBAFEDK@A@CCEBABMHCB@BABSDE@GDKJGHEBEDADBDCDCAIGB@@AA@CBEA@AEE@@AAIBE@CCABE@CCI@AA@ACC@CCEA@CCAAACG@AAEEAECAG@EAAA@AAEAAC@CBO@EA@AME"],encodeOffsets:[[120363,37386]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
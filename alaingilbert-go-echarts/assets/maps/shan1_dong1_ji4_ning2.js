@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("济宁",{type:"FeatureCollection",features:[{type:"Feature",id:"370811",properties:{name:"任城区",cp:[116.606103,35.444028],childNum:1},geometry:{type:"Polygon",coordinates:["@@CDG@AD@@@BBDGLECECCAIBE@CAEAQ@G@Q@IBCFAFAFCBG@A@C@@BAB@@ADCBAD@BA@@BABCB@FAF@H@F@D@H@D@BBF@D@B@BBDFHBBD@DBF@JBHDD@FBFDBBBB@BAD@BADA@ADABAF@@EF@D@DABBD@B@D@BBF@@@DBBBDDB@@@@BDFDD@H@BA@@@@DADCBCBEBCDCBAFEFCFAHAFAF@JBD@D@F@HAF@F@H@H@F@F@FABAF@F@HADAD@B@H@@@B@D@B@DBD@DBDDFDDBBBDDFFBD@@BB@B@@@@B@DDDDDDFBFDDBJDFBDDH@B@B@HDB@BBH@B@@@@@B@H@FCDE@@@@BAFE@@BAFC@@FEFE@@@@BA@AB@DA@@DABAFCBABAB@FABADAFAJABA@@B@FAF@FAD@@AB@DAD@@@@@BAFCBG@CACAE@C@@DCDCBCBCBEBEBCBEDGB@BGBCBCDCDEBABADC@@BE@CBC@CBABEBEBEACBE@E@A@A@A@A@C@@@@BADA@ADAD@B@HBD@@AC@AA@CDADEBCAADCBCDABAA@ACEACAEA@@@@@AC@AAACAA@@@A@C@@@C@ADABBBBBBD@DCBA@@BADCB@D@DCB@H@D@D@BABADAD@BB@@B@@@F@B@D@B@LEFAD@BADAF@DA@@BAFCDABABEACAC@A@@AA@@CAC@A@ACBCBCBC@CBA@A@@BCBCBA@ABC@C@C@@@A@AAAA@CAEBCAAC@CBEBCACAAGEAC@@@A@A@@ACAACCAACCACBC@ADCBE@A@@AAAAAACC@@EEAC@E@@@C@CAACAE@C@C@CAAA@C@C@C@A@A@ABA@C@AAAA@A@@A@A@AAAAA@@@@ABAB@B@B@@AB@AA@@ECAACE@CC@@A@@@ECCCECCCAAE@C@AA@@@E@@@EGIEEA@GHIDCDCBCLADEF@BA@CBaNKFOJAB@B@B@HBAAR[EYCM@O@WABC@G@CBI@G@E@EAAGW@E@A@EDG@@FGHGDCHGFCBABE@CC]AKAI@C@ABE@CBC@CBABC@CBIBA@@BIDMBECBG@C@A@C@E@GBE@C@E@CBCAC@A@@@EAOEGAEAA@CAEAEAAA@@AAA@AAACA@@A@@EGCCCCGGEICAACCCCCAACAAAAFABBB@B@BC@A@E@CAC@ABA@ABABAB@B@D@@ADBDAB@B@BAB@DBB@B@DBB@B@BAD@BAB@@CBC@A@CDBB@B@F@BADAD@H@@A@C@@@ABEBABCBABABCB@@A@K@EDGBEBE@CFCFABABAB@@C@E@ABC@CB@BAB@B@B@BAD@DAB@D@@ABCDCDCB@BA@DDBB@@DBBBD@BBB@@D@@@B@@BD@DB@BBDBBBBB@@BBBBDBF@BB@@BB@BDBBBFBFBDBB@F@BD@@NDF@F@B@BCDAD@B@BDB@BBBB@B@BCBBBBBBDB@DFBDBBBDBBDABANIBADBB@@@DDBBDDKDFNMBEBEBGBFLQBBHA@CBEGFAEIEBIBGBMBABC@A@C@ABA@A@CBA@@BABABA@AB@BC@ABCBADA@AB@DCB@BA@ABADABABABABA@AD@BA@@BABEB@@CFADAF@D@@@F@FDFH@FBHBFBJ@AH@B@D@@BDBDDBDBD@D@@B@BA@CB@@@F@BBDBDBB@DBDBH@D@@AD@D@BBFAD@F@B@DBFAFBD@HDB@BDBBHBDBF@DF@BB@D@B@F@@@DCDADEF@DADDFBDDBD@DCF@F@FBBDDBBBM@E@CBBDBD@DADC@A@C@CBAB@B@D@FBFFBF@DABAAEACBAB@BADBND"],encodeOffsets:[[119277,36302]]}},{type:"Feature",id:"370812",properties:{name:"兖州区",cp:[116.783833,35.553144],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@AAC@CBABABC@C@G@A@CDC@A@CDAB@@ABCDC@AAAAAACB@B@D@@@D@B@@BBBDBBD@@B@@@@FBDBFBBDB@ABCBADCDBBADCFCB@DBBD@@BC@GAA@C@CB@BCBAB@@@@@D@B@B@B@B@FAFBDAFAFAFAB@DAD@DAF@@CDABABCFCDADADAHA@CHAFADAFAFADADCDCD@@@DBFBD@DAHEDAB@@@@C@CBA@@BC@EBE@EBA@@@ABIBEBCBABEBA@ABABEDABCB@@CBA@@BAB@@@@EFEF@@EDAB@@EFAB@@@@CFEDBF@D@D@F@B@@@B@@@H@B@BBB@DBF@@DF@BBBBFDD@@@B@BDDDDBDDDBBBBBBDDFF@DBBDDBD@B@@@@@@@@BD@D@H@B@@@F@D@BAB@F@@@D@DADAFAH@FAD@DAD@D@B@@@B@@@@ADCDC@G@CA@@@@A@CBGBADCDAH@FDH@@BDBB@BHDBBDBFD@BBBFHBB@AD@@AD@DA@@F@F@B@DBFBB@FBDB@@@@B@@@FDFBFBFBF@DBD@FBF@FDFBB@HDHBHBB@FB@@DBF@F@FCBC@EBEBCDEFCHAB@@@AIPADHB@D@DAF@B@D@@@@AB@@@B@B@B@D@H@@EF@H@@D@B@@DBF@FBFBBBD@B@DBFEBA@@@@BA@@@@@@BA@@BA@@BA@@BA@A@A@@BA@AB@@ABA@@BABABA@AB@B@@AB@DABABA@@@@BAD@@AB@B@DAB@B@BAB@FBFBDBDBBFDDBJ@DBD@HBDH@D@B@B@B@B@B@BGBAAA@EHBB@J@H@@@BCFABCBCBAB@D@@CHB@C@AHA@CDAH@D@@ED@JBBFHB@EFBBADG@E@@@A@@@AHBDF@BBBHABB@@AGB@@A@@C@@A@AC@ACF@ACCBACB@AA@@AAA@ACIBAAG@B@@AD@@AB@AA@@ACEBACE@@AE@BDC@@@A@A@A@@@C@@BCBC@@GA@BAE@@E@AD@F@F@DCBEBC@G@AAE@A@@@@CGC@MABIBCBCACEK@AEQHKBA@@HEAAMIAK@@@AAADEDGBA@CBC@CAG@CACAE@@@@@C@@@@@@ACB@@ABA@@@ADG@@@@DEDG@@BA@@BI@@B@@ABA@@@@BCB@@ABABA@ABCB@@ABA@@@ADC@ABCBABABCBABA@ABCBABABC@ABCBA@@BCB@@ABCBA@@BABABA@@@@DC@@DCBABA@@BCBA@ADAEEAC@@@@@@@@@@@A@@@@DIA@AACAAAAAAACA@@AAGEACCAAAAACA@AA@AA@@AC@AAA@AAA@AAC@AAAAAKCA@C@A@A@A@A@A@CBCBEBEAA@A@AAC@AAA@A@C@C@C@C@C@C@C@E@@AAGAEAACECACEAAECCCCAACAAAACC@AAAEMCAACCACCEAC@CAE@E@C@G@A@C@C@AAABCBEDAB@@CBE@CBABC@EBKFA@C@A@E@@@"],encodeOffsets:[[119533,36254]]}},{type:"Feature",id:"370827",properties:{name:"鱼台县",cp:[116.650608,35.012749],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CBED@CFA@M@A@B[JAOBOODH[XG@IIBE@EBAIAK@CACAIAEACE@M@E@@@G@@@G@@BG@@AEC@@A@MB@B@F@B@BC@CBGBCB@@A@@@@@A@CB@@A@@@A@CBA@@@C@A@C@A@A@@@CB@@A@@BC@@@AB@@ABAB@@ABA@CBCB@@AFAAKEC@A@I@@BA@@BA@@BKB@DA@G@@@A@@CA@AGG@O@@@A@@@G@@@A@@A@@@EC@A@G@MD@BA@E@@DC@@DA@@BCDGDAC@@AACBC@EBAC@@AAABAAAEF@AEGC@EA@@@AC@CEDAA@@AA@@AAA@@AABC@A@AA@AMAGAA@EA@BGA@@AAC@@DC@A@BGWEMACA@@C@C@GB@@C@@DC@@@@CCAC@AA@AGA@@SAIA@@@FE@KB@AHAAASDAAG@AGA@@A@@C@I@Q@AAC@@AA@@CA@G@@BG@@BB@@DA@@CEB@CA@IB@@CBB@KBA@@C@@@EG@A@@AK@@BA@@B@RBFB@@@@B@BCBODA@B@BDBBBD@FADAB@B@B@B@B@@A@@B@@CBEBCDCDCBCDAD@@CHAF@@@B@B@FAFAD@D@BAD@D@@@BAD@BABBHBFDH@D@DB@DDBJAFBB@D@FBB@FBF@F@DAFAB@@ABCDABCDCFDD@@@BC@AAIDDA@BA@@DE@BBAB@DFBA@CDADCDAF@@@@@@@D@@AD@BADCDCD@@EFBJBD@DAD@BBDBBDHBBFF@BN@BB@HDBF@B@JD@BB@DBRDBBLFDDDBB@BBA@ABA@A@@BA@@@A@C@MBKA@FA@A@A@AAAF@J@FADFBD@FBF@@@F@F@F@HBDBBBDBD@BBB@B@B@BB@@B@B@B@B@B@B@B@B@BBB@B@B@@@B@BBD@B@@@B@DB@@B@B@BAB@B@B@@@B@B@BAB@B@B@B@BAB@B@B@@@B@B@BAB@B@B@B@B@@@B@@@B@B@B@BBB@B@BB@@B@BB@@@@BBBB@@BBDBBBDDDDBDDBFJHHDDDDFH@@@BB@BDBBB@BB@@BBFBFBDBB@FBHBPFFB@@B@D@DBDAF@D@F@HAF@D@B@D@H@DA@ABABABC@@@@@A@A@ABA@A@@@ABA@A@A@A@AB@@A@A@A@A@@@@@ABA@A@A@A@A@@BA@A@@@A@AB@@A@ABA@A@@@AB@@ABA@ABABA@ABADAHGDCBENEBADIDGFQBGCGFEBM@AAK@CBCBADAB@LCJEHA^EDIFCHC@@DAXOHCHEB@LGLENGHEd]@ATODEFELEHELAVEHAPCPGJEFADE"],encodeOffsets:[[119619,35806]]}},{type:"Feature",id:"370826",properties:{name:"微山县",cp:[117.128827,34.806554],childNum:1},geometry:{type:"Polygon",coordinates:["@@AF@FAD@FCH@BCLGVELENEHCL@BAH@JANCNEFCDENABA@GCA@ABCH_@C@GAC@KGGCCEEAEAE@EDCF@@ILCLCH@HDPCF@F@DDDBDBJFHFHDBHDVJ@HA@ABINA@@BBBBD@@@BA@ABA@IBEBEB@@AB@@CDAD@FBX@@@BABCHAD@@ABABA@G@EBA@ABABAD@DBHDH@D@BADCBCB@BCDADABA@ABEBCBCBMFIFEDGHCDABE@A@A@ECCAA@AAILABCFIRGRAFERADELOCADAL@BBH@D@H@DBH@D@@B@@BBB@@@@BBB@F@B@@@B@@B@@@@@B@@@@@BA@@@@@@@@@@@C@@@@@A@A@C@A@@@A@@@@DAD@BGBC@E@K@C@I@A@CBEBEBCBCBCBEFCDENA@aPMFSFMFC@CBA@ABOJEDEHCFABCBA@IBCAG@A@UBY@@@C`GjABCFEBIFOHODGBUFKBGFKFEFCFSP@Bc^GFMHKFKHA@GFGDWPCB@@GDEDCJ]FGBIFKDA@CBABAD@DBL@BANEFDHAHERCHCJABMFAFCDGHCBAB@BABAB@BAB@BA@@B@@@BAB@B@BA@@B@B@@@BAB@@@B@B@B@BAB@B@@@@@B@B@B@BA@@B@B@B@BAB@B@@@BAB@B@B@B@@@@ADABAB@BAFCNAJ@@ABAJ@DADAB@DAD@DAF@B@DBJBLD^@DAFABEDGHCDGHEH@@CH@F@B@FHXBB@F@F@HAJ@D@HADXBP@N@ZD\\FBQAB@G@A@ABAPILEbMDAB@@AFEBCDKDADCJCHGB@FFHJ@F@@@F@@BBD@F@BBDDFDDDFD@@B@@@DDF@BDDB@FB@@BBA@@@A@ABABA@@@@BBBB@B@B@BB@B@BB@B@DAB@B@B@B@D@D@DBBDBD@D@F@DBBB@D@D@@@FBDFF@@DDBBBBBB@@@BAFCD@BADBDDDD@@@DAB@D@D@B@B@B@D@@@B@B@@@@@B@BAD@BAD@B@BAB@D@B@BAD@BAAA@A@AAA@A@CAA@A@AAA@A@C@A@@@@AA@ABC@AB@B@DAB@B@B@BAD@DC@ABA@AB@@A@@D@B@B@D@BBB@B@B@B@D@D@D@AE@A@C@@@@AE@C@@@@@AAC@A@AACAAAAAA@AAA@@ACAAAACCAA@AA@ACACB@@AD@DABA@@BADADABA@@EAMG@@@@A@GEECOIKGAEEK@EDAD@D@FAB@DADCBCDCBA@AFGBCBCDA@ABA@ABCACCCACAAAACACCCCCCCECE@E@C@A@G@@@GDI@@BABCB@FCFEBABADADAD@FBDBF@B@F@@@FBFDFBFDDCBIBABA@@DADAD@DABC@K@ABCBC@EBCBE@C@@BABAHCDE@C@CDEROLKPMT@VDHDFA@IDEGAHOBBDGBBBAHKMCEACAEAKABIND@@F@BCRD@C@CBADE@@@BBBBCBGBABADADADCFAJEFCDCDADCFCFCDC@AB@B@B@@AFC@CB@@E@@@AB@@BF@@AB@@CBAD@@A@IC@@AD@@CB@@CD@AI@@@@AC@@@CCC@A@AAACCCECCCEAEDG@ADABCC@BGBCN@D@F@BABCBABEDCDEBAJG@@@K@@AE@A@AD@@CTB@@BDHB@D@@B@B@F@J@FBLBFBB@B@LBJAH@DAF@FAFCB@@ED@@CF@@A@AA@@EA@@A@@@CD@NBHB@AD@@BD@@BD@BB@BF@@AD@@AD@@CH@@AD@@@@FB@@AB@HJFB@DH@@DD@F@@CRB@BDBDAAAD@@GD@@C@@@@@CD@@I@@BEB@@A@C@CE@@A@@B@AE@@@AB@@AB@@IPD@AFCBC@MBEBE@E@C@CBC@A@G@C@AB@@C@A@A@A@A@E@@BEBCFADAB@B@D@FAB@B@DAAC@@A@C@@@@AA@@@A@@A@BACFA@@@CBADA@@HEBADABAJEDCFA@@JCHAJA@@HAHGRCAE@@@@FABADCAAAA@CCC@@GIMICAA@I@Q]AER@JEDHDDJALC@AB@LIDEB@DC@C@AD@D@@ADABA@CBAB@BA@@B@@A@@@A@ABAHEB@FE@GBABAHDDBD@FADABG@AAEBAB@D@BC@KNEBADA@@BABEDBNDDBB@B@JBH@BA@C@ACA@AH@@@DD@BFABAACA@CAAA@CB@J@F@D@L@B@FFD@FEB@D@HD@@FAHEDA@ABCFEDCBACK@@@@@@BB@@@A@@@ABFD@@@DA@DB@BCDAFEDC@@AA@@@@AA@A@@@AA@B@A@AA@@@@@@@@@AGOCC@AJC@A@@LALAFEFEBADCBAB@@EEE@A@@@AFCL@DADADEBC@CBE@@BABA@@@@BCDGBO@A@ANB@B@@BHB@D@L@P@D@@EBC@@B@@@B@J@@A@AAIAAAA@A@E@@EAC@@AACGE@@@A@G@C@A@C@@@@BKIAGAKAOCA@@@AB@@A@A@@@A@A@ABEBGF@@A@@A@@@@@@B@@AB@@@@A@@@@A@@A@@AACAA@@@AA@@@@A@CB@@A@@@ABA@A@@@A@@A@@@@@@@@@AA@A@@@A@A@@@A@@@A@@AA@@A@@@@@A@@AA@@AA@AA@@AA@CA@@CAAAA@A@@AA@@B@@@@@@@B@@@@@@@@AB@@A@@A@@@@@@@@@@@A@@@@@@@A@@AB@@B@@@A@@@@B@@A@@@A@@A@@A@@@A@@@@B@@@@@@@@@B@@@@A@@@@@@A@@@@@@@@A@@@@@@B@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@A@@@AA@@@@@@A@@A@@@A@@@@@@@@@@@A@@@@A@@@@@@@@A@@@@@@B@A@@A@@B@@@B@@@@@@@@@@@A@@AB@@@@@@@@@@A@@B@B@@@@@@@B@@@@@@@@@@@@@B@@B@@A@@@B@@@@@BA@B@@@@@@@@B@B@@B@@@@B@@@@B@@@D@@@@@@@@@@BAB@B@@AB@@@B@@A@@DE@ABA@AAAA@A@@AAA@@@A@AA@A@@@A@@@@A@@A@@@ABABABEDCB@@@@AAA@@AABA@@@@A@@@@@@@@@@AA@@@@A@@@@@@@@A@@@A@A@@@A@@@@A@@@@@A@@@@@@A@@@@@@@A@@@@@A@@@@@AA@@@@@@@@@@@A@@@@@@BB@@@@@A@@B@@@@@BB@@@@B@@@@@B@@@@A@@@@@@@@@@@@@@BA@@@A@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@AB@@@@@@@@A@@@@B@@AA@@@@@@@A@@@@@@A@@@@@@@@AA@@@AAA@@@@@@@AA@@@@@@@@AA@@@@A@@B@@@@@@@@@A@@@@@@@A@@@AB@@AB@@@B@B@BA@@B@B@B@@@@@@@B@@@@A@@@@AA@@@@AA@@@AB@@ABA@@@@@A@@@AA@@@@@@@C@@A@@A@@@@A@@A@A@@@@@@@AA@A@@@@CAA@GCCA@@A@AA@@A@@@AB@@@@A@A@@@A@A@AA@@A@AA@@@@A@@A@BA@@@@BA@ABA@A@@@A@@@CA@@@@@@AC@A@@@A@@@@@@BA@@B@B@B@@@@@B@@BB@@A@@@@@@@@AA@@@@AC@@@ABA@@@@@@BAB@BA@@BA@@@@@@AA@@@@@@A@@AC@@@@@AC@@@A@@@@@A@ABA@@@@@@@@KB@@@@@A@@LAF@@@@A@@@@@AA@@@@A@@@A@CBAAAAA@A@@@A@A@@@@@@@@A@CAA@ABAB@@A@@BA@A@A@@@AAA@@AAAAA@@@@@A@@B@@A@@@@@A@@@A@@@ABABA@A@@@@@A@@@A@@BABC@@@@BABA@@BC@A@@@A@A@@@C@@@AA@@AAACACAA@AA@@AC@@@A@@@A@@@@@@B@@@@A@@@@@@@@B@@@@@@EAA@A@CAA@@@@@@@@AA@A@@AA@A@@AACCCAICAAA@@@CAA@ABAB@@@DABADCBABC@CAA@CBCAAA@AAA@@C@@@@ABA@CAACA]EEAA@AB@@@@DHHRBDDFBBHDNLBBDFB@AB@@@@EGAAOI@A@BGH@@@@A@HG@A@@CCA@EDKDEB@@@AA@MB@@A@@@FRBF@@GBA@A@AGAE@@A@AA@@AAA@@@@BA@G@@@@B@BAD@B@BAHAD@B@@A@@DTJ@@@@@@AB@@CJ@@@B@@@@AD@@AF@B@BAD@BABC@IAMAGAA@ABAJAFAB@H@H@D@DAJ@FAL@BFLBBB@L@@@B@@L@D"],encodeOffsets:[[119974,35385]]}},{type:"Feature",id:"370828",properties:{name:"金乡县",cp:[116.311532,35.066619],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@M@@FGBCAA@C@C@A@C@@GG@GB@@E@E@G@E@C@KB@JEHE@A@A@C@A@A@E@C@C@E@A@C@CBG@G@GBK@@H@@@DEBG@I@@JCFCBADK@@HEFA@A@CDEBEBC@CBEBG@@E@@C@@IS@@L@@EBBJED@BCBE@@@E@@@C@EBC@A@EDCBADA@CBC@EB@B@@@FCF@FAF@BAD@B@B@@@@A@CDCBIBGBC@C@C@CBC@A@@@G@EBC@CBE@CDA@BFDFBBDD@BBBDB@BDBFFFDDF@@BDCDADABEAK@ABC@@B@@@BG@DJ@DEDA@@BCBABABCBA@CDEBADCDCDCDCDAF@@ABEFADADGF@BABEFCH@DAF@D@F@D@@@D@FBF@D@BDF@@DDFBFBDBBCD@D@D@FDB@D@BA@@@FADDDFFBDBDCHBD@@BDBBBDBBBBBDBBBDDDA@A@GBK@CBA@BFDHBB@@@BBBBDDDBFBHDDBBJBB@D@D@F@B@DADAB@@@@@FAH@F@@@DDDF@@BDBB@BBDBD@BDDFFBDBBDD@BDFBDDDBFFBFFDFBBBD@D@DBDBD@BBBBDFDBDBDBB@DABA@AH@DADABCDADADADAFDBB@B@HCDAF@D@HBDDBD@D@F@B@FHCDADEDEDCDCFADCB@DAF@H@D@B@FAB@F@H@D@DAHAF@DAH@DAF@B@D@D@FBFDBF@JDFFBFDBD@BBH@HBDBDDBHAB@F@FAH@B@D@F@DBBFBB@@@BBDFBDBFBFBBBDBDBDDDDDBF@D@H@JAFAFAF@D@H@FAFAB@HC@@B@DAB@B@BAD@DABCBC@CDABEB@@ADADCDCBA@@@CBA@CBC@A@A@ABA@ADAD@BAF@D@@@BABABADEDEF@FAHAFCL@B@@@DABABADABAFABA@@D@B@@@@GBCBC@A@E@AAADCB@D@DA@@BA@ABC@A@AAA@C@AAA@CBA@A@ABAACBC@@@C@ABABABAB@BAD@DBF@B@D@@A@AAABABE@@AAAA@@@@AAA@@@AAA@A@AAA@A@A@@@A@@@A@A@A@A@A@ABA@A@@@A@A@A@ABA@A@A@A@ABA@A@@@A@A@A@ABA@A@@@CAA@@@A@C@AAA@@@A@A@A@AAA@A@A@A@A@A@A@A@@@AAA@A@A@AAC@CAAACAGAE@E@E@@@E@EAC@EABC@E@IBEBBB@B@B@@ELBNAD@B@@@B@@AB@B@BAB@AAA@CACCKEAAQCCAA@@AICA@E@CA@GAAM@@AEEAACGAAAC@ABC@CACAIFE@@DCDCBC@ABC@@@C@@@@@@BEDCBCDCB@EA@CBAAAF@@CB@@ACBJCBBD@@A@@CCDEDCBADCBA@@BABE@C@EAE@EAA@E@CAABEAICCA@@C@CCGAEAGBA@ABC@A@@@CBC@A@CBCBE@E@A@A@@BEDG@@BCDCDADCDCFADA@@@AB@@@@A@A@A@ABABC@EACAAACA@B@PCDA@A@A@@A@AE@Q@A@EB@@C@@@@B@AED@@@E@BDA@AC@@CEABCC@@AA@@A@CCACCC@AAAAADA@@EBCC@@DA@AABACC@BBA@AAACGAE@@CAA@@AEF@GI@CCAA@@@AA@AC@KCAF@JCBCBE@GDCBA@"],encodeOffsets:[[119180,35699]]}},{type:"Feature",id:"370829",properties:{name:"嘉祥县",cp:[116.342449,35.408824],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CAC@@@A@@@CA@AAC@AACA@@AACCAFCB@DADADCBC@ABA@A@CBA@@@GDA@EBEBG@C@E@EBEBIBG@C@E@CACCCCCACAAAEAEACAEAAC@A@@AAAECAE@C@A@G@EBE@A@GBCAACAC@GAG@AACECEACE@IAEECEAC@C@A@E@CBG@CBE@GBCBC@G@E@A@DFHPBH@@DHBDDJBFBBDJBNBBAJ@@@JCFADAHIPEHADABCHGDGACACA@@@@@@EAEAMEEAKASAGABD@DAB@DAFAHAD@@@@@@@DAF@DAD@B@@@@@@ADADADCFEDE@EDEFADAB@B@DAD@DAF@H@DAD@D@@@@@@CHAH@F@FDFDFHDHBFBDBDBBBDBFFDFBDBDBH@B@BBB@D@DAF@B@@@DAD@DAB@FABAFCFABABAD@B@DAFAF@D@DBB@DBHBDBD@D@F@D@D@@@D@F@DABC@AAMBA@DNEDCB@DBBCFDBE@ABD@B@@JDBBBDD@FG@CAAG@G@CC@I@BBF@@PCFC@A@ABE@DHCLE@@G@CKGAA@@ACA@BA@A@G@@@ADAAC@GACA@IDGDEKEBAC@@GBFJDB@BEBDPAB@FBD@FF@ADI@@BA@A@G@@CCEC@ABC@C@EBKB@FE@C@ARD@AHADA@EACF@FAB@@@@@D@B@@@FBF@DD@BBF@AD@DE@@BBD@B@B@FAD@F@B@B@D@D@DAD@D@B@B@FAD@FAF@DAFABAF@BADAD@DAF@BAFAD@DDBD@@DA@@BBBBD@BBBF@BBB@BCDAF@BBCHBDIB@B@F@BEFADCDADADAD@@AD@F@B@B@@@B@B@B@DBDBBBBBB@B@@BBBDBBFDBBB@BDDBBBBBDDF@BAD@F@JAHAJAB@HAHAJA@@F@HAXEPCH@D@DAB@B@D@D@DAXAF@NALABBH@NAJ@H@F@H@H@@@F@D@@@J@J@B@F@@@D@DAF@J@CFCBL@FCBABCB@BBBDBBDDBADBFAB@DADCDCBADC@CCG@ABC@CACA@BCD@BBB@DABA@ABABG@AAAA@EABCDCB@BABABAHGDCDA@A@@HEDC@@BADCBABCBA@CAE@ABA@CB@D@D@BBHBDBDBHBBBDADA@CBA@A@C@ABEBABADCBA@A@ACG@@BABCDAFCBCBAHEDCBCFCDCBABABCBCC@AAEGAC@A@A@CAE@A@C@G@C@E@GBE@EDABA@AB@@ABCDABC@@BA@AD@B@H@DABEBEDEJAR@H@R@FBDBF@JADBFDFDHKAC@A@@BCH@DCMCCAABA@ABBDBFABCBE@EAAE@E@C@ABADAD@B@D@BC@CACACDAF@N@AACAACEAE@E@CDC@CAACCEBC@CFEBCDC@C@@@E@A@CAAE@@CAEACAGCA@ACA@GACBEAE@C@A@EBCAE@A@CBC@@@CAGAC@CAAACAC@A@E@@DAB@@A@AC@C@CACAACAC@@@C@ABGI@EAGAEAG@CE@E@E@@@CBEBCDE@@FABA@AB@@ABCB@BABABABABCBAB@@ADA@CBAB@BCDABAD@@ABAB@BABA@AB@DAB@B@BAD@B@D@BANAHAJAFAFJEBFHDAB@AGRAEKHAFAFANAEMLCCCAACC@@A@CAABMJABCBAAACAAACCEA@ACAAAADA@A@AAAAAA@ACA@C@CBADA@E@E@MC@@ACE@A@CAEAEAAACA@AAA@@AAE@CAAAAA@@AAAACAAAA@"],encodeOffsets:[[119230,36076]]}},{type:"Feature",id:"370830",properties:{name:"汶上县",cp:[116.49708,35.712298],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ABC@AAAAAECEAC@GCIAE@CAADADABABCDEDADCDGFABADEDCBADAB@@DH@B@BABCDABABAF@B@D@BAB@DCBCBAAGACACAGAAAC@C@A@@DAB@BBF@DABADABCDAB@@CDGF@@@BCBCDGHABABABA@CDADFBB@BB@BAHAB@BABCBA@AAC@ADB@BD@DAD@BDH@DBAD@D@D@RFD@DBDBFBBDBB@B@BAFCBABCDABA@CFCBCBAD@B@D@B@JAF@BADA@CBC@CBC@C@@@ABAB@DBBBBBBD@BBDD@DBDBD@@@BBDAD@BBD@D@BB@@DBD@BBB@DBDBBB@BB@B@D@BAB@B@@@DAB@D@B@D@B@DBB@BBFFBBDD@DDDBDBDBFDDDDBBD@B@DADCB@BADAB@BAB@BABCBA@C@A@A@CAAAAAAAAACAC@CAC@A@CBAB@DABADABAB@D@BABABABADA@ABABAB@D@BADABABABABABCBABABABABCBDBBBBBDB@BDBBBBBDBDBB@DBB@BBBBBBFHBDBBBBBBDDD@DBFBD@D@B@F@B@D@@@DABADABAB@D@B@DADBB@B@F@D@D@DBBBBBBBBBBBD@B@DA@@B@DA@AB@DCB@DCDAB@D@D@F@B@BBB@DB@@BBB@DBDBD@B@BAB@B@BAB@BAF@DBBBDB@B@D@BBB@B@DFBBBD@BBD@DBBBBBD@B@@BD@@@D@DB@BB@@@@P@JFDLLJCFADBRNF@NARFD@PAHPDFHKH@F@J@HF@@TPFN@H@J@F@B@DDFBBBBAD@BBD@B@BDF@@DDBBDBDBJD@@PBHBN@B@FBFBBDDD@DBH@DBDBBDB@BDFDDLHFHDBFDB@BBD@FBF@JAB@D@B@B@F@BBDBDBF@F@F@D@DAD@D@D@BABCDG@AFA@@BCBEBAA@EEAA@CAA@CAC@CAC@@AA@@@AAC@C@@@CAC@E@@AACCECA@ECEEGCCCC@AAC@EAGAKAGACAAACACCCC@AAAAC@@AE@E@C@@BAIEAGHEJADEDABADABAFCBADADCFCHDDADADCB@DAFABAFAHAJADAD@HCDABAFADCFE@@FCBCBE@A@GAE@@AEAC@@AE@A@AACAEAECEECAEACCECEACACAA@AAAAACA@@AAA@C@EBE@GDEBCBEBG@E@EAAAA@AAEAGACCCACACEAE@I@AAA@A@G@@@EBC@AAAAEBE@C@A@E@C@EBEBEAAEGAA@AECCAAAGC@AAAAC@@CG@EBGDCBCHADAB@@@@@DBH@D@DCBC@@@@@A@@@A@CBC@CBC@EBGBEBC@C@C@@@EBA@A@C@E@@@A@G@CAC@@@@@@@@@AACCCAA@CEECCAAAAAACCACCCCC@A@A@@CCAEAA@ACE@@AE@CAA@A@A@G@@@A@@@A@E@C@CAEG@A@@@@@A@G@AAA@GCA@A@G@CCEAICCAECEACCCCCCA@@@@@@AAA@@ACEECCAACAECCCCAC@CAA@C@A@@@G@A@C@CBGBE@E@ABEBE@E@G@G@E@E@GBE@C@C@IAE@EBGBEBEDEFABCDADAFADCDCB@@@@ABG@C@ECAC@@@@CAACAA@C@@AE@A@C@AACBA@C@CFE@@BEBABCB@BC"],encodeOffsets:[[119227,36380]]}},{type:"Feature",id:"370831",properties:{name:"泗水县",cp:[117.251195,35.664323],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAAEACAACCA@C@C@ABEFEFCBCBABC@A@EBCAK@O@CAA@@@E@@@G@GBC@EBEBC@A@A@C@CAA@CBA@A@IFGDABCB@@ID@@EBGAC@A@A@CBABABABC@CDAD@B@DBD@@@@BDBD@BBB@B@B@@ADADABE@A@AAA@A@EA@@C@A@C@C@ABC@GBC@CBCBEDADAD@H@F@DDFDDBBFDHFDFDDBDBHB@@@@DBDBD@D@BADAFCFEDC@CBEDCBC@CBEDEDEDCDGDCDCBE@C@CCAAG@E@E@A@ABE@CDA@CFEFCFCDCH@DABCH@B@H@@@B@DCDADCBCD@@CBGHCAA@CACA@@CAICGAE@ADBH@DBD@B@@@@ABABA@@BAB@B@@AFADCD@B@F@F@BBDAH@B@D@DDHDD@B@DBH@D@B@@@F@B@@@@@@@@@F@F@BBB@DDDBFBH@B@B@F@F@D@DBH@F@D@D@D@F@B@D@B@FAD@FADHFBDBD@DBF@DDDBDDDBBD@JFBABADAD@B@BBB@FDFB@BF@D@B@F@DBB@DBD@FBB@B@BBBDD@HDH@B@HAJALCF@D@FAD@DBDBDB@@FBF@H@D@DAJEDA@@@@H@J@HAH@H@FAH@DAB@B@B@B@F@DDDBBDB@DBB@D@JFJDDBDDDDFBJBBBDDBFBBBBFDFADCDADAB@F@JBFBD@HBFAF@DABA@EBIBAFAFA@@B@DAFCBCDEBADCDADA@@B@DBJDDBDBBBD@D@DA@CFGBABAF@DBB@DBB@FB@@F@DBB@LFDBD@B@BAFCFCFEBC@@FEBADEBCFIBABCBGDAH@B@HAD@TEFAFCJCFAB@DADCDC@@DCBEDABC@ABA@ACEB@@ABCB@BA@@@AAC@@BAB@B@@@B@DD@@@@D@@@@@D@D@B@FBJDF@D@HAD@D@DAB@DABABA@AAAEAECCEAC@A@A@EBABCDEDCFCDCFCFEB@BADAFE@@B@@@DADCHCDCDADADCB@@@DABABADABC@@B@BCDAFCNK@@@@RODECEBECE@EACCKAI@AAE@I@G@CHEDADA@AACAEAG@E@A@CDCBA@A@C@ECECGECGCIASCA@GAM@KA@@@@C@C@KAKAK@C@GBC@CBGDG@QBCAA@AECG@ABIF@D@@ABEBE@@HCF@FADCDCBABC@C@AAA@@ECCCC@C@QAAAAC@EAGAAA@C@KF@@GDEBCB@@@@CBEDADC@@@ICCCAC@AAC@ABECGAA@@ECCAA@@@EEEGAEAE@ECEAAAC@AAA@AA@CACAEACACAAAAA@@AC@C@@EFABCBEBEBC@A@CBC@C@GAEBA@G@ABG@A@GBMBCBA@CBCD@BAF@@ABE@K@C@A@A@A@EBGDCBEA"],encodeOffsets:[[120162,36333]]}},{type:"Feature",id:"370832",properties:{name:"梁山县",cp:[116.096044,35.802306],childNum:1},geometry:{type:"Polygon",coordinates:["@@EBCAABCCAAACAAA@ADABEDK@DADEI@E@CBC@@@E@A@I@I@@@C@E@@@G@G@E@G@I@MBG@AAKBMBE@WBCBC@C@A@A@CBC@G@ODWFGBE@@@IBGBGBA@IBGBIBE@C@ABE@@BCFABEJADADAB@BADAH@FAB@B@B@B@BAB@D@B@B@B@B@BA@CBABCBABEDKHACCCQBAF@FHDDDIHABA@CFED@BABILO@A@JJMJUJEDA@IFOHABA@CFD@BTIAG@@CA@ABIJCDOJCB@BMF@@@@GFMF@@MHIFIFUJGDSFOHA@@@CBQFOFIB@@@@ABA@@@MDQDCBMBGBC@A@G@GDCF@BAHCFAD@B@@@BAH@FAH@DBJAH@D@BBF@B@H@B@B@B@F@B@B@B@D@B@H@JB@@CB@@JB@BA@FFA@H@F@H@H@B@@DBHD@@EH@DAB@D@DQBG@@JA@A@@BBDDDDBB@D@FBFBD@DB@@H@D@H@DBF@@@@@DBB@BBBBBBBBBD@FADAD@BCH@FDA@F@B@D@D@BD@@HDB@@ADJBB@HBF@NBDBLBH@PDH@DBB@D@PDNB@@B@BBH@BBFBB@B@L@L@F@@@LAD@HATCJAPABAH@B@D@B@BBB@@@B@BBBB@@DN@JB@JEFCFE@ADGBC@C@A@ACECGA@@AEC@@ECECEC@CDCDCBA@@LI@BBDHBD@H@@AFBFOCCBC@AD@TGIQJIFEFCHAB@@@@@@@HCTG@@BAF@HED@HCDAB@BBB@DBD@B@FDD@DBD@BAB@@@BA@CBC@ABABABABAD@BBBBBB@BBB@BBDBB@DBDDD@DB@@@BBDBBBF@D@BAB@BABAHAD@D@D@BC@ABCBC@ADAD@BBFAF@B@DABA@ABADAB@D@BBBDBBDBBA@A@CAAAC@C@ABABAB@BABABB@B@B@B@B@D@B@D@B@BBBBBBBDAB@D@B@B@D@B@BABABA@A@A@A@C@AAC@A@A@A@A@E@A@C@A@AAC@A@C@AAA@A@A@A@ABABA@A@@@ABA@A@CB@BCDC@@@@BCBABGBAFEDGDADEBABACENIBCHEBADADAHE@@BCFE@BF@@DH@@AD@BBD@AC@@@AB@D@D@HABH@@FA@E@@D@B@DA@ABACAA@CACA@A@C@A@A@A@CBA@C@ABA@C@ABABCBAB@DABAD@@@BADABABABABADABABABABABABC@A@CBABABAB@BCBABABA@A@CBABABCBA@CBADAB@D@DBD@DBBBBBBBBBDBB@B@D@B@DABA@ABA@ABABC@ADABC@C@AACCACCECCACACACCC@ACEAAE@AAA@C@A@C@A@CBA@C@@@ABA@A@C@AAAA@AAAC@CAA@AAC@CA@@A@CAC@ABCAC@A@@ACAC@CCCAAC@AAAAAA@CBABA@@D@D@DAD@DAB@BC@ABE@I@A@C@ABCDADADEB@BADCBADABE@A@AAAACEACACAC@QEC@C@C@ABCDABCDCDCBA@"],encodeOffsets:[[119112,36479]]}},{type:"Feature",id:"370881",properties:{name:"曲阜市",cp:[116.986526,35.581108],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@AC@C@ABCDCD@BABABADAB@B@@EBCB@@AAG@ABA@A@ABAB@FAB@B@BA@@BA@CBC@@@@AA@@AA@@C@A@@AA@AAAA@ACG@@AA@@@@@ACAAAAAEAA@A@E@G@CAA@E@A@CBA@G@ABC@AAA@@CACAA@@AA@AA@C@ABEDGBAB@@CA@@CAAAAACAC@C@CBCBCB@DABBDDDB@@DBHBFBDBB@B@B@DABADCBIFABABCD@@E@EBCBKDMFC@C@C@A@@C@AAAAC@C@ACCCECAAAAAE@E@IBIBA@EBC@CBEDE@C@E@A@I@CBOBC@EBCBKBEBE@GBCBEBABKBCBABABABCFADCFCDC@GBE@E@E@EBA@C@CAA@CA@C@CBK@A@@@@@E@CA@@@KA@@E@@@A@@@A@C@EBC@A@C@A@C@AAAACCCCCCA@A@AAA@@@@@@@AA@AAC@A@C@C@AACC@A@EAA@@@C@EAE@C@C@C@@@IAABAD@B@B@B@B@B@B@B@BABE@C@A@C@A@AB@@A@CBEAC@@@C@C@A@ABABABABADA@A@A@CAC@A@C@A@A@AAC@A@CAA@AA@AAACB@BABAD@@ABABCD@@CD@@@@ABABAB@@ABAD@BA@AD@@ABAD@BADABABAD@BABABADABABAD@BCD@B@@AB@BA@AD@BABAB@BA@AD@@@@AB@BA@@@AJ@@AB@@CHCF@@@@CH@B@@AB@BA@BD@@@@@@@D@@@@BFBD@DBH@DAD@DABCHCFBB@B@@BLNJBBGF@@ABGLFR@BFLBDADADAJNBD@DH@@@@@BBF@B@HADAFCDE@E@C@@B@FF@ABB@@HD@DA@AD@@@B@B@B@@@D@ACF@@BF@BDFABD@@BBA@@BC@@BA@H@BBJABDB@BB@@BBA@BDDABDE@BDD@@B@BD@@@@BA@BH@@AAGBAA@ACEGA@B@@@B@@@FCHAB@HFA@D@DBD@B@HDHDD@@BA@AFA@DB@B@DBDAFBTAFABDD@@DJ@BD@@JBB@@DF@@BB@D@BHD@RBBBH@F@DF@@@@BBD@BHD@@BEB@JCABD@BBDADAD@@EFCB@BCDBD@DDFBBBDDDHDH@F@DBD@B@DBB@D@HDBB@BHHBH@D@FDFDF@B@B@@DD@@BDDFDFAFAD@F@D@DHBHBD@B@F@H@FCFAHCB@BAFAFCH@FBD@F@JBH@D@HAHAFAH@D@FB@@B@FBD@FDFBFBFBF@F@D@FEB@BCBA@A@A@CBCBCBAJCHAD@DBD@JAD@H@@AB@D@FADADA@C@C@AACACECAAEAAAIEC@AACCACCC@CAE@CACACGEBC@EBC@E@A@C@A@E@C@C@C@EAG@C@C@E@E@A@AAGAECC@CAA@A@E@E@@@@@@@@@A@E@@@A@CAG@C@ACCCG@C@C@ABGAC@A@E@E@ADCBCBE@@@ABA@AB@BABA@@@@@AAC@CAGBCF@HBJDDB@@DBDBB@DBHGDA@@DCDABCDC@C@A@@@G@ADGBA@CDGDCDEFEDEB@DCF@BAB@F@F@H@BBDDD@F@DADCHCDCFCFCFCDAD@DAFCDAD@FCDEBEBC@A@CACAC@C@@A@AGACCCCEGEECAACCCE@C@E@GBCBCFCDADAD@HAD@BAD@D@B@D@@@FBB@B@BBB@F@BABCBC@@@A@AAA@AACAC"],encodeOffsets:[[120045,36358]]}},{type:"Feature",id:"370883",properties:{name:"邹城市",cp:[117.007453,35.40268],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@A@E@CAEAC@CBCBABABEFEDA@ADAB@@CJ@H@@@H@B@D@FDFDFDDDDDDDBBBBBBDDDBDAD@BAB@BCBADADEH@BABCDADCDCBA@EBC@C@CB@FFLBFLHPJFDHFB@@@@@NHFB@@ABCBCBAB@@ABCBC@@BA@BDBDB@@BBBDDBBBBBD@@BB@BBBBBBBBD@B@BBD@B@@@@@DBF@@@@@D@BBFC@C@C@A@A@A@A@AAC@A@A@C@@@@BA@@BAB@BCDC@ABA@A@A@CBA@A@@BAD@BBB@@@@@B@D@BBB@B@BBB@D@BBB@B@BBBABC@ABA@C@A@ABA@C@ABC@ABA@@@@@A@A@@@C@A@A@A@C@C@A@CB@@C@BBDDBBBD@@@B@B@@BDHFBBBDADAF@DBDDBFADBB@BB@B@B@@@D@DAD@BABADAD@@@BAB@DADADADBDB@D@DB@@BB@@@BBDBDAFBBD@D@B@H@D@F@F@DBD@FBDDDBBDDBFNBB@BDDBBBBBDDBDDFDBBDFDBDFBBBFBH@BF@D@D@D@D@D@D@D@B@B@BBD@BBB@B@FBFADADAB@B@B@B@B@D@B@LDBBBB@BBD@BBB@BBB@BBD@@BBB@@BDBBBBBDBBDHFBB@@DBBBBBBBDBBBB@CJ@@@@@B@@@@@@@@@@BDFFBB@BBBB@DBB@D@BBB@B@D@B@D@DBB@B@B@BCBABABABAB@D@D@@@D@FBDAB@@@BAB@D@B@D@F@BA@A@A@A@A@A@A@A@ABCBAJB@@D@D@D@F@FBD@@@B@FBB@D@BD@B@D@D@BBD@BBB@@@@@@B@BBB@B@DDDDDDBBBBD@B@D@B@D@FAD@B@@@B@@@F@@@LB@@B@@D@F@@@@@BAL@D@DDBB@DBD@B@FAF@F@F@HAD@DCDEBCDEBABABADALABAFADAHAF@FALADAFAD@PADAJ@B@F@D@F@FCDAD@FAB@JAJAF@F@BBBBDBDFDD@B@DBDBB@B@DB@D@D@D@NELCDAFAF@@@DCBABAJEDABCBA@C@A@AAAACAEAG@CA@CCACBA@CDADADAD@D@DBBBBBDB@@DB@@BAHAFCBAD@B@@BBB@@BBBD@DB@BBD@BAH@B@DAB@F@B@DBH@F@B@B@FBBBBBDB@B@@@@BB@@DH@BBBBBB@@BB@D@@@BB@@BB@@@@AD@DAB@@ABA@A@EBA@AB@B@BAB@BBH@BA@AD@FD@HBFA@@JC@@DABAHCJEB@B@DAB@DBD@B@B@D@FAFAD@HAH@@@F@@@B@DBP@L@DBFAB@D@BADADAFEFEBAD@D@B@DDBBBDBFBB@@FBDAHCFAB@B@B@D@L@F@BA@@BE@ADCDAB@DANAHAB@H@BAH@B@FAHBD@D@DAB@D@FAFADABAFE@@FCFABAFCDCFCDAFADADCDABC@EAAACAA@ABC@ADC@C@@BADAJCDCB@DCBA@ADCBEBC@@BC@CBA@@@CBCCAAAAACAGCAC@@@@@@AA@A@A@ABABEBE@AEKGGA@CCA@@AA@CAEAAAG@CAACACAAAC@C@@@@@A@@@A@IBCBCBA@@BA@@@@BEBG@ADIDCDCFCLCHABAHADAHAFCDC@@@CAAEGCCCEEIEGACAC@C@@IBA@A@MEEACAEAC@CAIEECCACACAC@A@C@E@EBEJEDCDAFAF@FBF@B@B@BAFCFABABCBC@C@A@A@EBE@CDADC@EBGBA@@@G@EBCBA@ABA@CBE@C@CAGCC@A@GBEBE@CBEBA@GBA@A@ABC@C@CAA@AA@CAACAEA@@@AC@@A@A@A@CBCBADCDABA@A@E@A@AAM@@AACCITGBEBEBGBA@A@GECCGC@@C@C@C@AAA@CAAAA@AAE@EAACBE@@@CBEBEBCBCDC@@@ABC@C@EG@EBKBM@E@E@KBG@@@E@E@E@CBC@A@@@@@GAE@CCCAEACCAA@ACEAC@CCCCACAEAA@EAG@I@E@IEEAECEAECCACAA@CAG@CAC@E@M@G@I@A@C@GACAA@C@EEE@AAEGCAE@E@G@EBCBCBGDEBGBKBC@E@EAEACAGAC@CAE@C@E@K@CAIAGCEAECA@CACAAACAAACCAA@@AACE@@CCCC@@AAGCC@AAC@AAEC@@EAECEAEAC@AAEAA@@A@A@A@AACCCAA@@CAC@A@E@@@A@A@E@CAECCAA@@@AAI@EBCFCDEDEDGAEAA@A@@@A@CAE@CBCBCBCDADCDCBABABCDEDIBOGGCCE@CACACAECECECCGAGAC@CDABED@BCBABCBABCBABABCDCBC@CAEAC@AACAGCGAG@@ADADCBEACCQECEAECEA@@"],encodeOffsets:[[119605,35998]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("聊城",{type:"FeatureCollection",features:[{type:"Feature",id:"371502",properties:{name:"东昌府区",cp:[115.988349,36.434669],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@I@@EEAC@AAKAAACBAD@BBBB@@B@@@BABA@EHAB@@@B@BHF@B@D@@ADC@G@@A@@A@EFCBCDA@CDAB@BBBAB@@ABA@A@K@@C@@CAI@OB@B@FC@CACCA@KCA@A@BDE@K@CACF@BI@@CA@@@@DA@C@C@BCEAI@I@E@@DCDA@I@@BA@@@UDE@@@CC@AB@@AAAABIKA@BDGD@BBBA@ABGB@@E@AC@AC@CBE@AACGDAACA@GD@B@B@@A@@AI@@DAAA@OE@C@@CAE@@B@@CAGGCCCAIA@BEBMBAA@A@@MBEBILEBIMCAAK@@BABA@ACGAAAAMBAAECGB@@C@@B@@GAA@ABAFABA@ABA@A@EB@BA@ABAB@@CBC@AACCCBA@I@M@Q@AP@@@@AD@@ANCLAFGHGJIHIFCDCD@BHJ@@DDFFDFBD@BBD@@@@@D@@@@BDEL@BMJCB@@@@IBA@EFA@@@ABA@ABEN@BAN@B@BDLBHBDCLA@SA@D@FDL@BBNBD@FBDANB@@@BBBDBBHFDADDFA@@D@HHFF@BEBHHF@BDBDBH@BABBDFABHHB@DBD@BC@A@BF@F@B@D@@@J@F@D@H@DHAH@B@@@@@BH@BFABD@@D@@B@@J@@FJA@@@D@@BB@@@DB@HADAB@BH@FFBABABFDFD@BFFBBBDBDFAFHBB@BB@DDDBHFBBCFAB@FA@@FCJIBAAC@ABDD@DCBDFEFE@A@@DBBB@BBBH@HB@D@BL@BGB@D@@@@BB@@BDDP@B@@ADAFGH@DB@@@@BIDEFC@C@EB@H@F@B@BADAB@DD@DAB@@@J@@B@D@BL@@@@PBBB@BLB@@CH@@DD@ADAF@B@BA@EB@@IDIBEBKDC@CB@@@B@@@@ANABCX@D@B@N@F@BADABG@@HL@DB@@BDBNZ@JB@@H@D@DAB@@A@MBADEDADADCDAB@FCDAB@F@D@@@@@FANBD@@@R@B@DBR@FA@@NAHG@@@@FAAKD@@CD@F@AAF@BF@BRJJD@FN@HIBA@@@@@AAA@@@A@@B@B@D@BGBCBCHI@@B@F@FAH@F@F@DBB@@@EKBA@AF@BEBIBC@C@A@A@A@A@A@@@E@@@CCI@AAA@@CCA@DC@AAC@@AA@AA@@@AA@A@@@ADC@@@@AA@@CA@@AA@A@@@@@A@@BAB@B@B@B@@@B@DEFCDA@@FABC@@@AAA@@AAA@@BA@@@AAA@@@CC@A@A@@@@AACBA@@@A@ACAA@@@AB@@A@@B@AA@@AAAAA@A@AAA@@A@@A@BABAB@@A@A@A@A@ABA@@BAFAD@@@HAF@DAD@@@D@BCB@BAD@DBD@D@@ABA@@BA@A@AAABCB@@@@@B@@@@AAA@E@C@A@A@CD@@ABA@DBA@@FCAAJAAADABCHA@ADAAA@@@A@EF@DB@EJ@BCB@@CBCB@@AF@@DN@B@N@FABBFBBB@FB@@@H@JE@DH@H@BDJHLJDBBBJ@HBDBD@F@@@@ENC@GJB@G@@B@@DJ@BHF@@FB@D@ACJABAAAB@D@@@BBD@B@AABCDCFAL@PJBF@DL@B@F@BC@AAG@@AGC@AA@ADAAABA@ACEAAAAA@BA@CACA@C@C@A@A@@CAA@ACGAA@C@EDBBBD@@DF@B@@@@@@@@EB@BB@@NAB@@@@A@CD@@CACCB@ACE@ADAF@LADADBBF@@HADBHABHFBB@BDB@L@@@B@@BB@@B@@HDPIFDB@@BDABDDABDB@LCFCDFBJBDDFFB@@HBHGHKDCHA@@B@@AN@HADAFAF@B@BBD@B@@ABABAB@B@B@B@B@BBDBBC@@@CAC@A@ABA@AA@GECEAE@AA@AAC@KAAA@O@AB@HAB@AAIKCCACGA@CAAAA@@C@CCAAAAAAAAAACEF@D@BABEBA@ABADADAD@FA@@BA@C@C@@CG@IAGB@@AB@ACHABFHADHFA@FC@@BBBBBB@B@@@B@@AB@@@FCBAEB@CB@AEHAAIB@FADA@@AA@C@E@@AC@E@@@AJAFMMKKAMFCBGCC@IDQIA@IAGEDE@ABADAFCBC@ACGEICIAAAAG@CAAA@A@CBEDI@ADI@ECEEECCGAEGEE@A@ADABAFE@ABC@AAC@CBEIEKEEAWEA@SEOAKBEBABADGFGHABABEBABCBED@B@DA@C@AAGBAAACACAIACCBAB@CA@eBAC@C@C@@@AC@@EDGBEDKI@AFE@AD@DM@A@@@A@CB@BA@GC@ECG@@B@BBH@AA@AGA@BE@@@AC@@@@GAG@@@@BAB"],encodeOffsets:[[118825,37142]]}},{type:"Feature",id:"371521",properties:{name:"阳谷县",cp:[115.79182,36.114392],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CAECAA@B@@@BAD@FBD@@DDFFFDD@B@@@D@DDBDBHBFDFDDBBBF@D@HBHBVF@B@DJDABFF@@@B@BLK@@B@@ANB@A@C@AB@D@@@FB@EBBLBB@@AH@B@F@@DLB@B@@@B@@@@B@@@FA@ABCBBJB@D@ABBHGHEJCFAB@@@PAF@@@@KBBBGHCFB@@DBDF@@B@BBV@B@BBDBJDT@FBR@@@@@L@BFPPPFFNLBBBDHJDFB@@@DFDFDFDDFLBB@@FDDB@@@@HDBFBJFN@BB@@@@@DHBBFNDH@BDFBL@@BB@P@@@@@@AR@BAH@@@@AF@DEL@@AFADAB@BAF@@@@CDR@N@J@B@DADDBBD@DA@@BABAB@@AFAB@B@BAB@BABEBAB@HB@@@AD@@@HAFDBBNABBBBDH@BABAB@@BLDBJNFAJKFANA@@@BBBNAFA@AJBDBDDHHDB@@@AF@DB@@@DPFB@BB@CJ@@BB@@@@A@AHCB@BDCBDHBBF@DAD@@BBDF@@@HABAB@AA@AHCACB@JLBABB@BA@@BDD@@F@VC@@B@@AJ@B@DC@CF@J@J@FBADD@D@B@@C@@B@@DJ@@ADEDBL@F@ACB@B@LDB@DDDBD@@E@APAJ@DB@@@DL@B@B@BA@@BAAA@ABADCB@DCDAFEB@@@@BH@D@BC@@@C@AGE@A@A@@BAFGB@BA@A@@@AA@AA@ABCDABBLBBBD@FB@FJ@B@BA@A@@H@HB@@@@BD@@F@@AHB@BBBG@AAA@@@DH@FHDB@@ADAB@@@B@N@@CBCF@BEJ@@AA@@A@E@@D@BC@C@K@AD@BA@A@IBEBAAIEOCEACEGEECCCECCMMAE@@@CAEEAA@GE@IN@BCJM@A@AFM@EDQ@CBK@ABK@GLIDAJEJAHABAPANCRECIEEICECGEOA]CQCI@KCEGACGGAAMO@@EEEGIKAAA@GGIIECAAGCCAUM@@ECCAAAGCE@GAIAWA@@CAGAOCGCQEGCUQA@AAGCA@A@C@C@EDMFC@EBE@ICQEGCCAGAECAAAEAEA@AACA@AA@CCE@E@G@GB@BA@CBABIHC@G@@@I@A@EB@@C@@@A@EBCAA@A@@@AAEAIAGAAAC@AAAA@@AAAAA@@AA@A@AAAA@AA@CACAAAAAAAAAB@BC@@B@H@IIECGE@@@K@CA@EAEACAC@A@EAA@C@A@C@A@IAA@EAE@CA@@@@@@G@@@E@A@A@ABA@ABC@A@A@EBCBA@A@GAA@AAA@A@@@@@AAA@A@CA@@A@A@EA@@@@A@AAA@@@CAAACCA@A@BA@@CCCEAC@A@CAC@C@CA@ACEAAA@ACACAOAAAC@ECEA"],encodeOffsets:[[118435,36796]]}},{type:"Feature",id:"371522",properties:{name:"莘县",cp:[115.671191,36.233598],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@A@A@A@@@A@@@CFB@AB@@AA@@AA@@AE@@IBCC@@@A@CDACDA@@B@A@BC@@FBB@OGAAECMCAAA@@@GCEAIEGCCDB@CFB@B@B@ABDB@@FBB@A@AB@@AB@@@BB@@B@D@@@B@@@@B@@A@@@B@@@AB@ABB@AD@@@BB@@B@@@DF@@BA@@@FB@@C@@@A@@BD@@@@@@ADBADB@BBB@AF@@A@BB@AB@BA@@@B@@@B@@B@@DF@BBD@@@@@@@B@D@@@DAB@@@D@B@@B@@@A@B@@C@@B@DF@D@B@D@@A"],["@@KEEAAAECAA@@AAA@KI@A@@GECEGEEGAAEGIM@ACEEIGIAECCA@@@GEAAECGEC@CCEACAECCACCCACAECAACEEGAACBCBGDGFCBKHA@ABMHAD@@@@BH@H@PBFBLBBDL@BFLBB@B@@ADCF@@@@F@BJA@B@D@@DBH@DBJMBBBA@@BGB@BBJ@@H@@DXE@BB@B@@D@FBFDBBDBJ@HB@DH@HBV@@BD@D@@BP@BAD@DEHCDCD@@CBCBCFAB@B@B@@BB@HFRBF@BDJBHB@@D@@BFBBB@@@DBHBJ@@@VABBB@BBBB@B@H@@BJDDFFB@@BB@HDHDNHHFFBPDB@FBH@NBBBFBDD@@@B@B@D@B@@@D@D@B@B@D@D@@BB@DBB@BAB@D@D@B@@@B@B@B@B@@@D@D@B@B@@C@@FAA@@@@@CC@@DA@@FC@BF@BHA@FD@@BD@BFD@AAB@BBDA@FHA@F@B@DE@@DA@@DBBBFBDBB@BFAH@@B@BAB@BD@B@BHA@@DB@@@@D@BBDFFBB@B@BBDBDBD@FBD@@BB@BBB@BBB@D@B@@@B@BBD@@BBBD@@BN@D@L@BAPC^AD@LAHAD@DAB@BAJ@BAB@JBFBHBF@J@DAD@BADAJ@J@B@D@J@F@@BH@FAD@B@DC@BDA@@@G@@BC@@@EB@BA@@DBD@@@BA@B@@@BF@@@AB@@B@@@@B@@B@@@DD@@@CBBDA@@A@@@BA@BFA@@@A@AB@BA@@B@@@@A@@BAB@B@DABA@C@A@C@A@ECCAAB@@CBACGBKBBDGBC@@B@B@@@BA@@B@@BDA@C@@DE@@BI@@BA@BBCB@DA@BFA@B@B@@BB@@DB@DH@@CBBHCB@BB@@BBDEA@BB@@BBHA@@@BDDFAB@BOFgGCAMGMGGCC@MEKDAL@DFJHFBBFJCP@DKLCBEFGJGJAB@P@@@@@@@@@B@@@PAJJAPAFBJFDBB@JBLBF@NBB@VCHAFA\\ID@JCXANCLKFATIX@@@HBB@PB@@FBLHFJBL@DBBFJDB@@P@DBLA@ABCFCF@H@B@BDBHDDF@DN@@BFD@BA@E@C@CBAB@@A@@DB@@B@@@@@@CJABEF@B@DADCDAF@@BD@D@BAHAD@F@F@@@BDAB@D@@ABG@@BBDBF@BA@CBBLDBBBD@H@DAF@@@BFB@@A@AJ@ACB@F@AEBAFADAJ@D@P@TBR@LAXAFBD@RATBB@DKACAGCK@A@ABM@AFMBAB@BA@@B@FEB@JA@@@@DANI@AFKAC@@@@@C@@@@AC@AACCEEECC@@GI@ADCDCJEJGHIHGBEDKBM@@BC@@@@BODC@@@@BE@ABABCBE@@FK@CBE@@@@BG@ABQ@@@@@@@OAA@@AKCE@ACGEMAACG@@@@A@@AEMAIAEGC@@@@CAEC@@AAEKCCCECECE@@A@CEGIACAAMKEEOOEO@A@K@@@@AQ@ECSAIAC@A@AAU@A@AE@AC@CA@DEHGAALA@@@@BE@O@@BADEFIHGAGBAC@A@AIDABAB@@E@@@A@@A@@@A@A@CK@@@E@ABG@@AAAKFAA@@E@@@CBAD@B@A@BM@@@AL@AKA@@@E@AECBCIA@E@AUAG@G@CAEAACCCEAEAGACCCC@@@A@C@ECEECC@@AC@EBC@A@@@A@CEG@@AACCA@A@C@C@A@C@A@CB@@AB@BA@E@A@MBE@@@OBEBA@EAA@IAA@C@EAE@GACACAIGA@QKCCKGCAECKG@@@@A@IA@AKAGAA@QCC@OCA@C@MAECGCA@EA@@ADB@A@@@CBBDDCB@@@D@AD@JF@@BB@@BB@@BA@@B@@D@@D@BB@@BB@@@@BA@@B@@@B@B@@@B@@@BC@@DBB@BB@@@D@@B@@@@BABD@@@@BB@BB@BBABB@MBGQEA@@A@A@A@AA@CE@E@@CF@@C@AD@@@@A@B@@@AA@C@@@A@CB@@C@A@@@@@@@C@AAE@@CA@@@@A@@@A@@ABA@@BAAB@@@BEA@AAA@BCCA@B@@@@C@@AB@@@D@@@EA@@B@@AE@@C@@@AA@@A@@BCA@BAA@@B@@@A@@@BA@@@@@@A@@@C@AA@@A@@BA@@BAB@A@EA@@CABAA@A@A@DEA@DC"]],encodeOffsets:[[[118268,36765]],[[118232,36729]]]}},{type:"Feature",id:"371523",properties:{name:"茌平县",cp:[116.25527,36.580688],childNum:1},geometry:{type:"Polygon",coordinates:["@@OKKDCBENIB@B@@@FBD@@@F@DBB@@CBEBA@BJGBBFA@@DFAABED@@A@@BA@@@A@A@AAAA@AD@@EEBCGGBAEGBBDA@@BA@BH@JDH@@@D@DAB@@EBC@CBCBAB@BABAFABC@E@DFBBBBBBBBBBDDD@@@BBBB@DHBBDDDJLBBA@GBA@@B@PBBLBD@BBB@@BBFDFHFB@@BAB@B@BBD@D@@ADCAAAA@A@A@A@A@ABAB@BA@C@AAA@E@EBCBGBM@@BA@@@GBCDGLGHGA@@EACEACAICEEDKDA@ACCBACCB@AA@ECOJGC@@@AA@@AA@@@K@A@ACA@EAAGGBCAGB@@AECACBKBE@CB@BDF@BDABD@DC@@D@B@@A@MB@@AAA@@F@@@@@@A@E@@CC@AACA@F@DBBDH@BBB@DB@B@D@D@B@BD@DABB@BBBBDF@BABBBCB@BBBD@BH@@BH@BADE@A@K@@CAEOIK@EBCDADBBA@C@AA@@C@A@BBABIBBDC@A@@EE@AGI@@CA@@@@HIA@HMD@F@@E@C@CAGAI@AACAKIIGACG@G@@CIFG@@@A@@EAAEAAAEBM@A@M@@CE@@BA@AD@DA@ADI@@FCAE@@F@B@@BBCB@BGBADCBBBIBBBED@@AB@CAB@BC@@D@B@B@D@FBB@B@@A@@@@@A@ADBB@B@BAB@@AB@BC@C@CAC@ABA@ADC@@@C@CBE@GB@@C@EBAB@@AB@B@B@B@B@BA@ABABB@@@@BB@BBB@B@BBBB@@BBA@@@@BA@@B@@BBBDB@@@B@DABB@@@@@B@BDD@@B@BB@@B@@AB@BB@@BB@B@@ADEB@@CBEDCFA@@@A@A@A@A@AB@@@B@@@@@BBB@@DB@@BB@@@@CD@B@@@BBB@@B@@BBB@@BD@BCDB@DD@@BB@BDJ@D@@@F@@@B@B@B@B@B@DADAJAFE@@BABFLB@B@@BFDHDBADBFDFBDDB@B@F@B@@@@@@@BAAC@@@@@@JAHA@C@E@@D@DRL@BBH@HBFBB@FB@@DB@@BBBB@@@BBJB@B@F@DP@B@@@@@H@B@BBRBDBDB@@BB@B@@@@BAB@D@@@BFFBBAJDH@BB@@@@@@@@@@BDABBBD@B@FADBBDB@HDBB@@FABBDB@H@@FD@@BD@@BB@@DB@N@DA@@R@B@P@@C@@AEB@B@AEB@FA@GC@AADADE@@@@BAFC@@DCRAB@@@PCLKDGBEBADIBGDEDADCBAFEPEHAJ@@@@@F@FB@@HDFLJ@L@L@@B@@B@JFRHHF@@@@@@DBBBNFFC@@BA@@JEDAFEDAJCCEDA@BDAB@D@D@BBBA@A@@B@@@B@@@@@@B@@@@@@B@@@@BB@@B@@@@BB@@DADBLBD@@BD@BA@@BA@BF@@BB@@B@@@BC@A@F@JB@@@@XGBD@@F@BBD@@@@@@CCG@@PCTIBE@@@@@@BCBA@CBEFGLAB@@BBDBFHFDD@@BBD@FBHBDB@@B@DBJD@ABC@G@@NMDE@@FIJGFC@@@@@@DA@@JEDC@@LMDAJGTKFA@@HEFA@@@@LCH@F@JAB@F@HDBBHBZFLBHBDABKBEAI@AA@CEEGCG@@BG@@@EDGDG@@BEHE@C@AAC@@EEAA@A@M@C@@AGAEAI@CCKCM@CAC@@AM@AAC@IACAK@MBG@@BI@@@AECEECCCECGAAA@ACW[AC@@UIGCOM@QBABKBMIGECEEA@ECCCAAQGIAEAIAIAG@AAKGEEAAIK@@@@CCCA@@@@OMGA@@KEACA@CECM"],encodeOffsets:[[119054,37268]]}},{type:"Feature",id:"371524",properties:{name:"东阿县",cp:[116.247579,36.334917],childNum:1},geometry:{type:"Polygon",coordinates:["@@GACAECCC@CAEQFMDOBABGBIBIFCBKJ@HAL@BAL@DCR@FEN@B@BINADM@@JHFB@FBBF@D@@BFNNDDDFDDFFFHBDDFFPBJABAF@J@BABC@@B@L@DADC@@@@F@BB@@BCLAFCH@FD@@B@@@D@DBDfAB@@DBADABDBJBDBDBBHABBD@B@@C@AFCDABAFABABAHGHEBCBAFALAPBTFB@XFFBLFJFAF@DBD@BAD@BEFABCB@B@BFFFHHBDDFFDF@FCJ@BCJAF@D@BBBDBH@BBBBDJFJDH@BADEDCBAB@BCFHFJBB@RJJCD@HDDANELBNLDALCPLDNDFB@BDLF@@HBPN@@@@DBDD@@@@JLBBFFLHBBH@JBJBFBJBRHBBDDFDB@FFFDJHANALAB@RPNHDVJ@@BDX\\BDB@BBDHDFDDFFFD@BBADAHALCNAHCFCFAHEHIFCHGDCFC@AB@DCHMBCDI@G@A@GCICG@EBGJKX@@@B@DAL@BB@@BBB@BBBBBBB@@@BB@@@@@@B@@ABADCLANABA@@AAACD@@EDAFBBA@AAEAC@@@@AA@ADEB@DCDABB@@LG@@@ADBB@TJD@BA@AGQF@@C@@BA@@@@@@HDFDD@BCEKBAAAA@BACAAAA@GKCEA@@AEGACACCCICGA@@AAIIAA@@GECCGE@AGG@CCE@ACIAAAAECCE@@CIAGAAECC@ODGBQ@@@M@CAC@CE@@AAEKCKEEA@MAA@KBM@@@C@O@MCOG@@CAGCIECAICUEOCAAGA@@OCEAICGC@@CAMIMMACCCCE@@CKCKCCGGAAEEGEAA@@EEEGEI@MCCACAAAAOMGCAAGCGECCAAKKCCAAAAAAA@@ACCAC@@GGACAA@AIE@AECAACA@@@@C@GCIAQBG@C@A@C@O@IBE@U@GCAA@I@E@@DQCKUESAMAEAGACAAA"],encodeOffsets:[[118915,37005]]}},{type:"Feature",id:"371525",properties:{name:"冠县",cp:[115.442739,36.484009],childNum:1},geometry:{type:"Polygon",coordinates:["@@KLMDWBIDC@[JEBGBUDA@MAE@KAIAA@CAIEEAOBIBCLCDABEJOHOFUD@@EBIBKFABA@AB@B@B@B@BBBDBBBDBB@BBBDBB@D@DBBBBBBDB@@H@BA@F@BC@@BD@@B@@@@AB@@B@BHA@BFA@BF@BEBBBB@@DCB@@@@@D@@A@A@AB@BCB@AA@@@EBBB@ABD@@@@@B@@@BA@@@A@EDABA@A@AAAACC@AACA@@@A@A@AB@B@B@B@@ADAFAB@BBB@@BBDBB@BB@@@B@BA@A@@@K@C@AB@@A@@B@B@BBD@B@B@D@@@BC@E@E@@B@@@BE@@A@@@FA@@@ABB@@B@@BH@BRCBFB@@B@@BBB@@CHADD@@BBBB@@BDDF@B@B@B@BA@AB@BABAB@BAB@DBB@BBBBDB@@@A@KBBB@B@@@BA@ABBB@@DBDDBBDB@@D@B@BAB@@A@A@A@C@@@A@AB@BBB@D@B@BBB@BBBDBB@BBBBBDBBBFBDDDBBB@@BB@@BBBBBBDDBB@BBB@B@D@B@B@D@D@F@DDHBH@@@DBD@FAD@B@BBDBFBDLCDHJABB@D@DK@I@@B@B@D@DBD@@BBDBHBB@BBB@CBGFABBBDBDDFFBDBBBD@B@FB@FED@@AHABABBBBDBBDBBBBDBDDBBBBB@BDDJ@@BDHFBB@B@@@D@@@B@B@@AB@BAD@@@B@BB@@@DDBBD@@@FBB@DBB@FBB@@@B@FBB@BB@BBB@B@B@B@B@B@B@BBDDBBBDBBBD@BB@BB@@D@D@B@B@@D@D@FBB@B@D@BBDBBDB@@@@@B@D@DBBEB@BEACB@DB@@@@@G@A@AFEDE@@F@@CF@@IH@BIBE@AC@@KD@@@@@JEFAFAB@VEVEHDDAF@F@D@BANABBNFHDJF@BDBNLDFDD@@@BFDDBDDFFB@BBPHPFNDHBF@NCB@LIJGB@@AJEFCJ@B@DGBADGJ@L@HJ@@LH@@@@BBDB@@FDDDH@B@D@@AB@RC@BDBL@BBDHBFDDJL@@B@@I@@HALA@A@CD@@BBFAHCBE@@B@@BD@@BBD@@BBAADA@BBA@@BA@BF@@BBDID@@CF@BB@DDJ@@BD@D@BBBB@@@B@B@BGFB@CBA@@LA@FRA@BBD@BF@@GH@BABC@A@E@M@A@CDWBABM@@@@@A@@DAD@LCFAJAJC@@FAB@@A@ABEBCC@@CG@@DA@AKA@AA@O@@K@@A@C@AI@@@A@CBC@@CBABC@A@A@E@GFAD@D@FEJC@A@@A@@CHGBEBC@@@ACOAC@@AA@@@@@CHA@AAKC@A@@GAGAAA@AA@CB@F@FECEDA@CCCBAD@BBJADI@EB@@EBADEAAGECACCA@@AAAEGEBACACAAEE@AECECBABAEA@EAGA@CBGBA@@C@@AA@@@C@@IB@EI@@@@AC@@@ACEB@AAG@@@@A@G@GB@C@G@C@E@I@@@C@A@EAEB@D@@AAC@CGAAGEBACBA@AAGACACE@GGFA@AEEGGC@@@EBCCCBGEAAACAA@@A@BMAC@EACAM@ACK@E@CQBC@EAWBKBQ@SAO@C@I@CBEBABBFE@A@BDI@@B@BA@AE@@E@CBG@C@AACAAKDAB@@AAEAC@AH@BA@@@CBAAC@@E@E@C@GBABC@C@@AE@CBCDCBA@E@AFIB@D@@@@A@@@CA@@@BA@AB@D@D@FABC@AE@@CME@CCAGACA@G@E@EDAD@BKBCAO@@@CAEIAA@CAKEIKGEA@@OAA@GA@@W@SJEB"],encodeOffsets:[[118227,37244]]}},{type:"Feature",id:"371526",properties:{name:"高唐县",cp:[116.23016,36.846762],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCCICE@EBGBG@@FKBALGDKBE@@@A@@@GAODK@A@@BA@@@CDE@I@K@@@CBKCBGAKAYEGAAAGCE@A@IBE@G@KD@@@@EBGF@@EBSLIHCBKN@@CDIF@@CB@@@@@@EDIHEJ@@CFMN@@@HAD@BICCAA@@@CAGAEAC@AA@@CCGEAEAC@AA@KBEHAF@DABAD@@@@@@AFSJOD@@DH@D@@@@C@AAE@@@ACWH@@@@IAE@B@D@@A@@@AA@@AE@@AAB@@ABC@@AC@KACACB@@AA@@@@@AA@@A@@A@@@@@@@@A@@@@A@@@A@@@@BABAAC@C@A@CB@ACBDFIDCBEFCBIF@@AB@@EDMEAACA@@@@@@GEQGIEA@@@@AK@K@I@EKGC@@EAE@@@@@I@GBOFEFABCDCBCFAHCJABAFCHKLOD@@A@QBCD@@EDAB@@@@CFCBBBD@@HEBA@BFA@A@BF@@@DO@A@Q@@@CBM@@HBFBF@@F@@DA@@D@DD@AF@BA@BFBH@DBF@D@LDD@@@@DHDN@@@@@BHJBDLJDBCBRJED@@@BBF@FEB@BBBB@BBBD@@@BBF@@C@BF@BE@@BAFBDB@F@BH@BN@@B@B@H@BBBDBD@D@DAFBB@FL@DABB@HA@DDBFC@@DAJE@AR@JAB@@CD@@@AB@JCA@BBH@@BBD@DBLBA@DBDB@BDBB@B@BAFABBFC@ACAFCB@BBBB@@@@DBDDDDB@FD@@BBDDBBB@BDBBFHFBDBDB@@NDB@B@FBHFDDBFBDBH@D@D@F@@@@@B@@@D@BAD@FB@@B@B@@@@DFJLB@BB@@DD@DD@ACBABDDAFHDCB@JJBAAA@AD@BBHAJ@BD@BBD@BA@ACA@BFA@JJB@DFBDE@GD@BCBAFBDBB@@RHFBBAB@BBB@@@DDDHB@@@@@B@BB@@@@BL@BBDI@A@A@@DC@A@@CC@C@@DFH@B@@@DA@BD@DBDBFBB@BJDDAFAAA@AB@FAD@D@F@FA@AA@ACAEBCDA@@@AAADC@@@@DABGB@BFJ@NABA@CB@JANIBAHEDAJCDAFAB@PE@AB@RMDCJODAFAB@HA@BD@F@DD@DA@@BDJB@F@BB@@DAAEF@AE@AB@BHF@B@DCH@@EL@AEACLA@A@C@@HAADJ@BDXB@@HBVBF@B@T@T@FA@@D@@GDCDGDAH@AC@ANCB@HDHBBCBCD@@A@@F@@@DCBADAB@AI@CC@C@IBCCACC@EAAC@@@@EBAFC@AAAGA@@@AADA@@BAAK@AEEEC@@BCL@@B@@@@@@BAB@BAB@@CDGA@@ADCB@DB@EAAA@@@@AG@A@@CA@@AEBCACB@@CBDF@DMDAAC@ABIA@A@AGAACB@ACEMD@BEB@BA@CC@@BA@ACD@@CG@@ABIDCHAP@@DDABA@CAA@AC@AE@GAAAB@A@@G@E@EAKE@@@CA@FCB@@ACICBAAAGE@@C@A@@@EFE@@@ACICI@AA@C@@AA@ABAE@ADAECHCACL@B@@AAA@GD@AEI@@@BHA@GCCB@AKG@CMCABABC@CABABAEAAAAA@AFBB@CCBAB@BDH@@EBAD@B@N@BAAABAAEB@B@B@BC@AAEFODI@@@@DK@AAEAKAIAAEI"],encodeOffsets:[[119193,37624]]}},{type:"Feature",id:"371581",properties:{name:"临清市",cp:[115.704881,36.838277],childNum:1},geometry:{type:"Polygon",coordinates:["@@GCMEAAMBABC@E@E@CBGCUFUFA@EBEBIF@@@@C@@LD@@BAFAJG@@JE@@DE@@@CFEF@B@B@H@@@@CAA@BDAF@@@@D@@BADB@@@@@BBBBABABAD@BBB@@@BB@F@B@DB@@@@BBAB@@CB@B@F@B@D@@BB@@B@B@BBB@B@B@@@BBDDBBAB@@CBC@BDBBBDBB@@D@D@D@@B@@BBA@@BA@ABCB@@@@ABBD@@BB@@BBBAD@B@BBBB@@DD@@DDBD@@BDBBBFBD@B@@BB@BAB@B@D@@@@@B@@BB@@BBB@B@BBD@B@D@D@B@B@@BBBBD@BDBB@BBDBB@BBB@@@D@D@@@@@B@@@BADA@AB@@@B@B@B@B@B@@@BAD@DABAD@BAB@@B@@B@@B@B@B@@@BCD@B@@@BBB@@BB@@B@@@B@@@BADA@@BAB@@@B@BBBB@@@DBD@H@B@B@BB@DBB@J@DA@@BA@@BC@A@CBABAF@L@@@@B@HAD@BB@@BHCDCBAFEBCB@B@@@B@@@B@D@B@B@@@BB@@@B@@BB@BB@BB@BB@BBB@DBB@DBB@D@B@@@B@DBDBB@@@DBB@@@B@BBD@FDFBB@@@DBB@B@B@@BJDB@BBBBB@BBBBDB@@@@@@B@BAB@B@@AB@@@B@@@@@B@@@BB@@@@BB@B@@@B@BB@@@@@BBB@BBDBBB@@B@B@@B@@B@BBD@D@BBB@B@BBDBB@BBB@@@BBBAB@BABAD@@AB@B@@@B@BBBBD@@@DB@@@@@@@ABAA@@@@@@A@@@@B@@@@@B@@@@@@AB@D@B@@@BD@@A@@B@B@@@@@@A@@BA@@@@@B@BBBDBB@B@@@B@@@F@B@BBB@@BB@BB@BB@B@B@@@B@D@BAB@@@BAB@@@@AB@D@@@B@B@B@@@@@BBB@@@B@@B@BBDDB@@BBB@@@BBB@@@B@B@BBBBBBDBB@@@B@BBB@@@@AB@DAB@@@BB@@BDD@@BB@@BBBBBBBB@@BB@BBB@DB@@BBBBB@BDB@@B@BBDDBBHDBBDDB@DBDBHB@@B@@@@BBDDFDF@B@@B@@@BB@@B@AE@@JCJFB@BA@@AABAB@D@@@B@DLH@@@B@@B@B@@@F@@@@@@@BB@D@@@@BB@@D@D@DB@FA@G@EB@F@F@BAAOB@J@@F@@ADBDBBJ@@DB@B@L@@@@AD@L@J@B@BIH@@@B@BBDDDDHAFABA@@BN@BL@@CDCDAJAD@@@ADBBBBB@F@@@DCDCBGGBGABEDAHAJ@B@@AB@@DA@@D@@@B@@@@A@@D@@B@D@@@@C@A@@B@@@@CA@@CB@@CDBDB@@@@DDFR@FBBD@B@@BB@BA@KDCD@@E@@F@NAJEB@JEDAHERELAB@BAF@@@HAJ@@BE@@BC@@DTA@CF@AAB@@@@@@CF@NAF@T@B@B@DB@FD@BBD@@BD@@CDA@AFA@ABAH@@@^CD@ND@@F@NDBJCBBDDABD@@@CBA@EB@@CB@@CHBDFDBTFFADEDAD@F@FCFBB@BA@CEKA@EACBC@C@CAAA@A@G@A@AM@@AAGE@A@ACBE@AF@@AAED@@@AE@A@@ACAAA@AA@AFA@EAE@A@@FCQIDACAKIACGI@A@@@@CMCG@@@@CC@K@CAE@CAGAEB@@ABEC@@C@CB@@CE@@@AEAE@GA@@CA@@AC@@AC@@EG@A@ACBA@EA@CA@GCAAABC@E@AACAACB@A@@@@@@@@A@@ACGBIAAEE@A@@@CBA@A@@A@A@@AA@ACACAQ@A@A@G@@@@@ACOE@A@A@AI@A@@AAAA@@CA@@EAA@EAGAG@AAK@CQC@@@@F@DGBIB@@@@@@BDAB@@@@@@A@E@A@A@CCEAECCAABGCEC@AA@A@@@A@CAE@E@G@EBE@A@@@GJADADAHC@A@A@@@@B@@BB@B@@@@ABGJM@@EICQI@AAEE@BBE@C@@DC@BLEB@@@@GHMB@@EBQ@CAA@Q@@@C@MAEB@@@@C@E@A@CBEDA@CBCDCBCBCFAB@N@BA@CBC@G@@@IAY@AMAC@@CAK@E@@AAC@AQB@EKB@@AB@DEAAHA@A@@@A@AA@A@CAC@@CI@CAAE@@DC@CJAA@@AEB@@AB@AAB@BCAB@AC@AA@@AC@@@AF@DABGAE@AC@@D@BKBGB@@@JA@@@IKCCAECGAAK@CA@AQDA@@BC@A@G@CCEC@@CAAA@@@@KG@@GIK@I@CHABCHA@I@EDIF@BA@IHKJA@MDE@GAMCOEOGAAA@EECCCAEC@A@@CCCEMKCA@AIE"],encodeOffsets:[[118302,37527]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
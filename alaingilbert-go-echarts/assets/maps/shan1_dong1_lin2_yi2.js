@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("临沂",{type:"FeatureCollection",features:[{type:"Feature",id:"371302",properties:{name:"兰山区",cp:[118.347842,35.051804],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@A@@AE@A@@ABC@@A@A@@A@@@C@AA@AE@@CE@@CE@@@@@G@GBA@@@A@@BA@ADCDADAB@@ADABAB@@@@A@CB@@C@@@A@A@A@A@@@A@@@A@@@A@@AA@@@CA@AA@@@@@C@A@C@AAC@A@@@A@@ACA@@@@A@@@A@ABEBA@ABA@ADABA@CBABABA@@@A@A@CAC@CACAC@CAEACA@@CACAEACAAACACACACAC@AAEAC@CAC@AAC@CAC@EAA@A@A@A@@@C@C@A@A@AB@@@@BDBFDFBF@DBF@H@FAB@BABABGFABA@@B@@AL@@@BA@G@@BA@@@@B@DCHAFCLAB@BAF@D@FDNDFFHBD@BBDDJ@DBH@FBF@DABCBAHCBDBRDFDFDBBEDADG@BB@@H@BBFDBB@BBBBHFB@@BJFABFB@B@DFBFFDDH@@D@HB@@DBBBBBFHBD@@@@@@BD@BBBDBDDJFB@@BA@@@ABEBABI@EBA@EB@BG@GBI@GBGDCDABGDGBA@GBC@@@ABA@CDEDCBEFAHPJLF@BAFB@@@AH@DBDDFHBBBDB@@ADABCFDF@BB@BDDDADDF@FBF@@@BADBF@@BD@@BB@BBBBD@FADABEFCBADEFADDDC@@BCB@@@DA@@BA@BBBABHD@FAB@@@@BF@BDB@@@@@@@B@AAD@@CB@@DFA@C@ABA@A@@BADDBDBDHHHAL@FBBHD@H@D@D@JEBCBO@@FABBDFDBHCDEFC@@FBFB@BAD@BA@@B@@@BDD@@BFDBBBBD@DF@D@@BCB@AA@@DCBAAA@@@C@@BB@BDA@AA@@BBA@BBA@BBC@@B@@@BA@AA@@@BC@@@B@@BB@BA@@@BB@@DD@B@BBBB@@E@BDB@D@@@@@BBB@@BE@BAC@@B@B@@A@@@B@@B@@@@@B@@@A@@A@@@@BA@@BB@@@BA@@@@@B@@BABBA@B@B@@BB@@A@A@@C@@CBABB@@B@ADB@@@@@BAB@@@@@B@@@@BBA@@AB@BB@@@@@@D@@@AB@B@@@@@@B@BB@@F@A@B@BFA@BBD@BDD@D@D@BABA@@@@@@B@@@@@@CHCBCBA@@B@@H@ADB@BCBD@B@@B@@BDDH@B@F@DAB@@BBAFE@@B@@@@@DAJAD@@@@@B@B@F@F@B@@@F@F@BBFAFCHCBADABA@C@CDCFGDCDC@@BCFAFA@@DADCDE@C@@@EDMBQD@FAF@DBD@J@F@F@H@H@JBB@NT@B@@BAB@B@B@@@B@B@FBB@B@BBB@B@@@B@BBBBB@B@B@D@FCFCDCFADADCD@BAB@FAD@B@B@B@B@@@B@@BB@B@B@@@BA@ABABAB@BAB@B@@@B@BADABA@ABABABAB@BAD@B@BBBBDB@@B@B@B@BAFCB@FEFCDCFCBCD@@A@C@A@@BCHEFEROBABADABAJ@DABC@C@E@A@CAC@A@ABCDABA@C@@@GAI@@@AAEACAACAAAGCCA@CEICICGCE@AGSCG@@@@ACACEIAC@ACECGCEEGECAAGCECECEAEGEEAEACCACAA@AACGCGAC@AKKAAEECCCCAAACCEAEAA@C@CAE@GAG@C@E@AAIACEOGOACAEAEACCCACBCFKBG@@BE@ABC@@BE@G@A@C@AFM@A@@BABGAA@@C@G@EAAAA@@BA@A@A@@@CCE@E@@CM@@@@@@C@A@CC@@A@AC@A@@D@BAFAB@@CBCB@@EB@@A@ABA@EBA@E@@@A@A@A@CBA@@@@@@@@F@@E@@@@@@BA@@@@B@B@@@@A@C@C@@CC@@@@@@AAA@@C@@@@B@B@BAH@B@@E@C@A@@@A@A@@BD@ABB@@B@BC@@BA@A@C@@CA@A@@@A@A@A@ABA@A@@@ABA@C@@@EB@@CB@@GA"],encodeOffsets:[[121117,35889]]}},{type:"Feature",id:"371311",properties:{name:"罗庄区",cp:[118.284786,34.996741],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@G@C@GCCEA@ACC@IG@E@@C@GBE@BHGDBDC@CBFDKB@BA@@AG@A@KDA@@JBHA@BFA@GD@@AB@@@B@B@B@D@FFFBFBH@HADQAFJBB@BJA@BFA@DB@@ABFDABF@@@D@FAHCHBHE@@DBFDA@DC@@BH@DHHADJDDBBBBBB@A@AFADAACJED@LAF@@TB@@D@@@D@B@@D@@BCB@AC@@FE@@DE@@DA@@D@H@D@@@N@BK@BF@HDFAFGBBDABMJ@@@HI@CHE@ABMBE@C@EDCBABACCC@CA@@BCA@AA@A@AAA@CAGACAC@A@@BBB@BAB@BABABA@ACCBACI@@BCCA@AAMGG@AB@B@@@B@@@@@DBD@@@@BBBB@@@BBDBD@F@B@BA@@AA@@@CA@BA@C@GBC@CBEBADAB@CAAC@CACAAAAC@AA@ECA@E@@@AB@@@B@BBD@B@B@@BH@BBH@@@B@HB@F@B@BB@@@@BB@BCF@D@DBDDDBD@DA@AB@@ABA@ABADBFBD@F@D@FBD@@@BD@@@FBFBB@@BFDHFFD@BBDABADAD@BBBB@@F@@@DBHBJBHBD@BBF@@@@B@@@B@B@@@B@@BB@BD@@@@@@@@@B@B@F@@@@@B@@@@@@@@@@@@@@@BA@B@B@B@B@FBD@DBD@BBD@DBD@FBBBD@DBDBDBDBBBDBFBDBDB@@DBFBDBD@DBDBD@DBB@B@@@B@BABADAB@BABCB@BAB@FABAB@@@B@@@@@DB@BB@@@B@D@BBD@B@D@@@@@B@@BDB@@B@@BB@@@B@@@B@@@B@B@B@B@@@D@@@DAB@@@@@BABABC@@BABCDCBCB@@AB@@@B@HAH@@@@@F@@DF@@DF@@BBBD@@@B@@@@B@BD@BA@@@BBF@@@BB@HB@@DA@@FA@@D@B@BA@@B@B@BAB@B@B@@@B@B@@DD@B@B@@AD@@A@AA@BAC@@AB@B@@@B@D@F@@@@ABG@A@A@A@@D@@@BB@B@@@@D@@DD@D@B@@@@@@A@A@@B@@A@@@@F@@@@E@@@@@@B@DAB@B@B@@@F@B@FAB@BAB@@@FA@@DADA@@BABE@A@CB@D@@B@BD@@D@B@D@@@@N@@DF@F@DD@@B@B@B@@AB@BBFBH@HQBE@ADMBEBCFGBCDGB@@@BCFGBC@AFGFEDIDEBC@@DGBA@C@AJODI@A@@DEFIDCDIB@@GAGCACCECGAKCE@GG@@GCCECCCGECECGCAAAABABCDG@EA@AAGAIEECAA@@CCCAADABG@CBG@@BMB@@@@@A@A@ABCB@D@@@FA@CB@@C@@@@@@BAA@@CA@@@A@@AAAC@BAFAAAB@BBHCBBB@@BB@@@DADADABAB@@C@ACEA@EE@@KIECCCAAACAG@@@AAGCCACCEEACACAAAEACCEIAEAECCAECCIKCCACAAA@AAACAAAAA@C@EBC@ABA@CAA@EAEECCAD@B@@@B@@A@@B@@A@@B@@A@@@@@A@@@CAA@@BA@@BBD@@BB@@@@@B@@@@@B@@@B@@B@@@@B@@@@@B@@@B@@@@ADABADADCDBFABA@CAMEEACAAABA@E@@@QACCGGGGC@@A@@@A@@@A@@A@@@@A@@@@A@@A@@@@@@AA@@@@@@@AA@@@@A@@A@@A@@A@@@@A@@A@@C@A@"],encodeOffsets:[[121081,35606]]}},{type:"Feature",id:"371312",properties:{name:"河东区",cp:[118.402893,35.089916],childNum:1},geometry:{type:"Polygon",coordinates:["@@CQES@S@KQX@@ENADEN@L@B@DDL@B@@BJ@BELKD@@QBOBC@@@CBIDEHCFYPED@@CDCBCDQHCBIFGFADAH@B@D@HADCHCFGHCDGDA@EBA@CJCDEJCF@@@BCJIP@B@DABCH@@ADCFCJEFEH@BADEHAD@@A@CHADEHADAFCN@BAFGRD@@@BBAHAB@@@BEN@B@D@B@HAF@@AD@BAF@@AHELADBDDDBDBFBFBDHPFPBDBJ@B@F@DBH@HBF@D@DBBBFDFBDBBDDDDFFBBLL@BBDDHDHBBB@DBDBBDBFFFFHFBFDFDHDBBFDFHDFDHDF@BBDFJBDBD@@@@DHHT@BDFDHDJFJ@DDBHDBBDBBBBDBF@B@@BJ@H@@@DABCBAD@B@BBD@D@B@F@DADCBI@ABCBABABQPEFGFAD@@@B@D@BBF@BDDBBLHD@LF@@D@D@@HBD@D@H@FFDHFDH@DBH@FCJADADBFBDBF@BDLBFBBF@FBJDDD@@@B@FDDDDFFDBHDH@JAD@NCdGD@HA@ARCAARAJBBI@@LKLCJAAGAA@@ACAAAACA@AAI@A@E@CACCC@@@@AACACAA@CCAA@@AC@C@CFEBC@CAEEKBABAB@FAHC@CLA@AAAFCBGD@BGAGG@ICGCG@EBIMAE@G@EBC@ADAD@NCFADADABCBE@CBE@C@@BEBCBADEHGFCDCBADCBCDADAPDJ@DAF@FAF@BBFD@@@@B@D@F@HALAFAD@B@@@@@BACEAKAQ@@@M@A@@BIDG@CDCBABCBABCB@DEFGBC@C@@@CAACCAA@@AAECGEEAAAEA@@EE@AEE@CAA@C@G@C@A@A@@BCAC@ACECGAAACAE@@@ACK@GEG@@AEKKCCCCAAA@AACAE@CAAAA@@AAE@A@@AA@@CCAAAA@C@@A@AACCC@AA@AAG@@@@@A@ABC@A@C@@@A@A@@AAA@@@@AA@ECCCAAA@CACBC@EAC@A@C@A@C@CCAA@ACAA@CA@AA@A@@@@@A@AC@C@E@C@AAAEEECECAACEA@AAEAAAC@EEAAACAACAAA@C@EBCBCBABCHE@@HCDAB@HABABA@C@A@A@C@CAE@@AC@C@ABCDG@EBA@AAC@A@AAECIAEAC@A@ACE@C@@@G@A@E@A@@@CAAAAECCC@A@CBEBG@A@@FGFO@A@S@ACOAE@@@EFIXWBC@A@C@A@IAAAAEEAA@@IGAG"],encodeOffsets:[[121395,35682]]}},{type:"Feature",id:"371321",properties:{name:"沂南县",cp:[118.465221,35.550217],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAECK@AAEACAEBCBCDI@EAG@CCGGEEC@E@G@CAC@GC@C@@@KEC@KGAACC@AAEC@ADEDCDEDEFA@EDABA@A@A@@@CAAAAAA@C@ABA@ABABAB@BABCBABA@@@A@A@ABA@ABAB@BAB@@A@A@A@@AA@@@A@A@A@A@C@EBA@ABC@CDCBEBCDEDEDC@A@A@A@AAAAA@@@A@A@AAA@A@EAA@A@@@A@A@A@AB@@@AMSA@IAG@G@E@E@I@C@CAE@EBC@ARCN@F@@@DCFCDCB@@EBEBAD@@CDCDEHCD@D@DABCBABGDEDEBAAE@E@@@A@E@E@A@A@@@@@C@IBCB@@@@A@@@EFABCBEHEHCBGBGBCBEBADAHCFEBG@EAEAECGMAAACECAAE@EBGBEAA@CCEAC@MBA@ABELCDGBGBC@CDABCBGFCDAF@@@FBJDHBBBBDDBD@DBDBHNJJLFFBD@DAL@HENAF@J@@@@@F@DADABAFADABCDCF@DBD@DAFAFADBDL@D@B@@@FAFAB@DADADADAFAJAD@FAHBD@DDBHCNBF@@@@@BBHDFDFHHFBDDDDDDDDFBHBD@DBFFBDABCDC@EDIHEFAD@DAD@BEB@@GDQHKHGFEDGDA@@BABAH@BA@EBCBC@C@C@@BCB@BABEF@@CBABCBCBGDEBE@@@C@ABABAFCL@B@@@@DJHPBFDLBBB@DBDBBBDBB@BD@@@BA@ABGBGBCBAB@B@@@D@@BBDBHHJLJHFF@@@@BBBB@DBBAFCJCFEHADCDEFAB@DAF@B@B@FBDBBHFBBBFBJBFBDDBFDFDDDFBFBTJHJHFBDBB@@DDHBBBJ@JDDB@@B@DB@@@@B@@@@@@@B@BA@@@AB@@@@@@A@@@A@@@@@A@AAA@A@@@@@@@@@@B@@@B@@@B@BA@@BK@@B@B@B@B@@@B@@B@B@D@@@@DA@@@@@A@A@@@ABAB@AAAA@A@@@ABAB@B@DBB@B@@@BA@EB@B@@A@CAEAI@A@A@AB@DA@@BCBA@@HA@@B@D@@@FADAB@BBBBB@@@BAB@DA@@D@BB@@@@AB@BE@@BCB@@@B@@@@D@B@B@FFB@@B@@FFHLFDFBF@HAJAF@H@HBF@D@L@D@DAJ@D@F@D@JBD@@@DAFCBC@@BG@CBA@CDI@C@A@EAAACCGAA@C@@@CFGBADEDCDAFCF@F@HFHBB@BAFAFATEF@FAD@B@D@F@J@F@D@F@F@BAD@HCHAHCBAFABA@@@@BADA@@@@@@FADCDABADCBCFCHGFADBDDFFBDDBDBB@BADALGHAF@@@@@D@D@FAACCA@C@ADCBA@CBAHAFCFA@@B@B@B@BB@@DBL@H@DA@A@G@CD@BADEFCB@@@BBB@@A@@@AB@FAB@F@BBD@DD@@@@@ABA@@D@@@@@B@@A@@D@DA@ABAB@FDD@@@BBD@BBBBB@B@D@D@@@BA@AAC@A@ALADBFBBBBBBD@D@F@D@D@FDJ@@HB@@J@BA@ABC@@BAB@DDDBFBFCB@@A@GBCBABA@CBCBAFBDBB@BBBB@D@BBFBDBBHHDF@@D@DDBDDBBBB@B@HCFG@@D@NEHEDAPKD@HCBKR@DRHDF@F@DCBA@ABC@GAAAEAC@AACCEEICEAG@C@QBCDADAFADBDBD@B@DALEB@DA@@DAFAFF@@FBDBF@FAJ@FAACAC@G@CDG@@@ABA@ABA@@BG@CACCEACCECCCACACAA@CCAC@CBCBC@C@A@AIGGEA@CAGIGCEEECCA@AAABC@CBABG@C@AAGDCFCBABAHCFADCDA@@BA@@@@DCHABAB@BADEBCDCFEAA@CAAIOEICCCA@@CAECCAEC@AAA@AA@ICC@A@E@A@CFABE@E@@@G@CAGCE@E@E@C@CFCBCBC@GAEACACEACCACAC@C@G@E@IC@C@CFC@ABC@AAGBE@@@A@A@@DAFADADA@CACCAE@GAGBGBC@E@GEGCGAEC@@@@@@EA@CBEDEBI@EBOECA@AAAAEGACEGIBKDKL@@AJIAQBBBQD@BGBC@cHMDC@IBG@GCCAEECCCC@E@A@@CCICEAE@"],encodeOffsets:[[121332,36234]]}},{type:"Feature",id:"371322",properties:{name:"郯城县",cp:[118.367215,34.613586],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@ECOAKAM@@BC@A@@EAA@C@AAC@A@E@AD@BABYAAB@NAB@B@@G@A@@@@@CI@GCCC@MDAJ@BABFFFJDL@BBLCD@@@FHX@T@NAXCDEBIDEFEBIDCBEDKHDB@BC@ABA@EBC@@@@BBHEBABGB@@@BJPFJBBDB@@@@LDHDLD@@JBHJBD@@@BA@CDBJBB@@DDJNBBJN@@HL@BA@GBOD@@BDEBG@EBG@A@AACAC@GBGBEBCD@F@@BD@BADA@CBA@A@AACAAACACCACA@A@CBKFABC@]JE@SFA@ODBJ@@FJDD@@BBB@BBB@BBB@B@B@BB@BDBBB@B@BBB@@DB@DBB@@@AB@@@B@@@BABFBBJPBBBF@@FJ@B@JFD@B@@@J@F@D@BABBDBDDDBDBBDBFBFB@BDB@BBB@@DF@@BBBB@@B@@ADAJCF@@A@ABAB@B@DBB@DDDDB@@@BBF@D@FBJ@B@D@D@BBDBBDDB@@DF@BDJCDBBBDH@AGB@BAB@B@JA@BB@@@@@BB@B@@BB@@B@@@@@@B@B@@BB@@BB@BDBDDDFDDBBBB@BBDB@BB@@LFHDFBJDFBD@FBBDAD@@BB@BBB@F@H@BBB@B@BBF@@@D@B@B@B@B@@@D@DBB@D@BBBBB@@BBFDB@HFFBB@@@B@BADAHAF@B@H@JBJBFAJAF@B@FB@D@FANAHADABAF@BAFGT@H@B@@DDFFFBB@DBB@BAD@FAD@B@BBBBBDBBB@BBBDDDJLDDBFDDBFBFFJDDFBBBDBDBFBDFBDDDBH@B@@BHBDBBDDFDLJ@@FFB@DF@B@DA@ABCBCBCB@@A@@AA@AAGDAAA@BBEBABD@BB@BB@@@B@@DB@AB@@@@@@@DA@@DEB@@C@A@AD@B@B@B@@@@NA@AH@DAH@BABCDBDD@@BBFDJFHBBBB@@FCHADABBBBBHDFDFDDHDDDFHD@@HHF@LDHBFDDDDBBH@HFAB@HCDCHGDEDGBC@G@C@ABGBCHEJEDARGDCDADC@@FCLKBA@A@CAAACIGAECGIS@@@C@K@ECACAAAA@C@GB@@CBC@C@GCSIAAKI@AD@F@FA@@@AAG@AAEBCFACG@ACK@A@AB@HAF@LAF@B@DDBBJAJ@@@DABAHAD@HEHCDCDADG@C@EAIAE@E@ADK@A@GBGBAFGJO@C@C@I@@@I@E@AHI@@FGDEB@HCFAHADIAOAI@CC@C@EAEAEACCIKAC@ACE@@GEAAG@EAA@G@GDG@C@EBEB@@AA@@@AAAAA@@@@@A@@AAAAA@@A@@@C@A@AAA@EAABA@A@@BABCBGBA@C@CBAFAFC@@DCBA@ABIAM@@@AA@G@EDC@C@AC@@AAAEEAAAC@C@A@ABABABEBCBEDCDCCCAEG@E@GAGEKAE@ACGAC@C@GBG@GBIBE@EBE@E@A@ABEBEBE@@BEBE@ABCBEDEBC@ABCBE@CBE@C@C@CAE@@@C@C@C@CAC@AIWACCECAECCAECCEACACACAE@A@AAA@CAC@GACBECE@C@GACACCECEFCGM@AGAKBCAICKGACAEA@C@A@EAGB]D@ACB@@@D@BAD@BC@AACAG@GBC@QAO@AB@@@D@HA@EAA@@A@AA@@C@@cDG@A@@@AA@@AE@M@@@CA@AAOBAA@@AC@I@@M@@@I@A@mBAA@AACG@A@@@IEEDEDGD"],encodeOffsets:[[121059,35231]]}},{type:"Feature",id:"371324",properties:{name:"兰陵县",cp:[118.07065,34.857149],childNum:1},geometry:{type:"Polygon",coordinates:["@@BBB@BBPAF@HBLHDDJFDBHBF@HBH@JBF@F@F@FBHDHBDBDBF@DADAFCFCDAB@NFLDJ@BE@ABADKBEDG@C@A@@B@@AH@B@@A@@BK@@@AB@BAHEBABA@ABA@E@GAE@CAECEAEAC@@@@BAB@B@D@D@@@B@@A@@@@@@@@@@@@@@@A@@@@@E@A@A@@@@@@@@ACA@@AA@@@A@A@@@A@@@@@AE@AACAGAIAG@C@@@EA@AA@ABCBCBAAC@AECGEEC@AA@EAEA@@C@@A@@AC@E@C@EACAEBCBAB@BA@@BAB@@CACCCAC@C@CDE@AAA@@@@AAA@E@A@@G@A@@AG@AAG@@@A@AAC@A@A@@BA@@F@B@FDB@@BBDBBDBDBD@BB@DBABCFADAD@HAD@B@@ADB@@B@@BB@@A@A@EACAC@A@@AAAA@@@@AC@C@@@@@A@@@ABAH@NHBBB@DD@AJ@BDDABDB@BABA@ABA@AAA@AB@D@DBHBDBB@BBB@B@@BDB@AB@@DDDBDBADAFCD@F@NABAF@DGJ@@G@@NIBAACHABECE@GAEL@@A@M@@@C@G@CB@@CF@@CF@@ED@@BDA@AC@@@@A@C@@@CA@@SE@KBC@IFBDCBEB@B@BAAAAAACCCIGBCGG@@AD@@CCBAE@CF@AGDGBG@E@C@@AECBAE@BA@@CEB@AIB@AAAEIRBBC@GAGAEEE@E@C@A@A@A@@BA@@HCB@AEB@AG@IB@LCB@H@@BB@@ALAECDAD@ACHCAGF@HAD@@@@FJHD@BDB@DFHDD@H@B@B@D@@@@BB@@@@@@BB@@@@BB@@@@@BB@@@@@@B@@B@@@@B@@@@B@@B@@@@@@BB@@@B@@@B@@@HDHHDHBD@R@@@FABBBDBFBNFDBB@BAAEDCBCBCBABC@@@@@A@@@A@@@@@A@@A@@@@A@@@A@@@@@A@@@@AA@@AC@AB@@AB@DB@@B@@@@@B@@@@AB@@@@AB@@@@A@@@ABC@@@A@GHSBE@ABEBABCBGBM@E@CEAA@E@IBEBIAIAG@A@E@GBCBABA@@@A@EAGEA@ECAA@@AAAA@A@CAA@C@C@@@A@A@A@A@C@@AE@A@AAA@A@G@EAA@AAA@@BCACEAC@EAICEAGCKE@@AAA@AC@AAAAACCCECCCA@AAA@@AA@@@A@A@@@@A@@@AA@@@AAA@@@@A@@AIBA@A@ABA@BHG@ACAADCCI@ACE@@CAACCAAAC@C@A@I@EAC@E@AA@@A@CCCCA@CAA@A@AB@B@BE@IDCB@BA@@@AAAA@@CE@@AA@ACA@AEAEACAAAACCCACACBA@A@C@E@I@@@AECCDIDGBEDA@BHBDA@ABCBCBCB@@FFFJFHEHIDC@EBE@EBAAA@AAAAAAAA@@A@@@@B@@@B@BC@K@EBM@E@CAAEIDGDGBIBMAA@A@@@@B@@@BB@@B@B@@IBE@ACAO@EAA@@SBAPKBG@@@GJA@C@@FBF@F@@ABCBC@QBA@KDEBEBCBGBC@E@EAGGGKCCCCG@EBC@@@KBGBCBEBGB@@E@@@AEAGCOEUAAA@QBK@@@A@G@C@A@@BA@@BBD@BA@@BE@@@@@ACA@C@G@@@EGCBA@C@E@E@C@CBAB@FAD@@A@AACAECCACAC@ABCB@JA@ABAAA@A@@A@CAAGECAC@EAA@C@@@KB@DMJ@HND@@@DF@BHD@D@@DHAB@BFB@B@@HFABHBF@@BBCH@BBHBFBJ@@DL@@BL@DCDCBCBAB@B@FB@@BBB@@@B@BABCD@H@BDHFHBFBH@@BB@B@@@B@BAD@B@B@@DJ@BCDC@@AC@ADCBKBEBC@IDIDA@EBA@@BCDAB@@EDCBCDEDABAF@D@DBB@@BD@D@BCBCBG@AB@@@D@@@@@DBBBBHDJDB@LFFB@@BBBBBDBH@D@D@DBDBF@D@@AH@BA@E@E@CBCBMFGBCBEBGDEBEBCBABABABEFGFABCDCFAFCHABCDCBCBEBE@EBCB@@AB@BGH@BGLABEFADAD@B@@@@@@@B@@@BA@GDCD@@A@CD@@@B@B@D@F@BADA@A@ABABAB@B@@@B@BAB@B@@BB@B@B@@DFDDBB@@@@BBB@@@@@B@B@JB@@DDBBBD@BAFAD@B@BBBFFFDDFBD@@@B@BCDADAB@DAB@@DJFHFF@@@BAH@DBF@D@F@B@L@HBD@B@@B@DBHDDBBBJHBBBB@D@DNCD@HBDBFDDFDFAF@FDDBBHBF@J@NAJ@D@HBFBF@D@BCDGDEFCFAD@BDFH@F@F@D@BBB@@@DDBDBF@H@F@D@FAH@D@FDFBD@FB@FD@H@DADCAEDGNEF@H@JDP@FBHAHAFAFAHBHBHBRDXJVFDBBBDDDBFBDFDBFD@@JHHBFBFBH@B@D@XB"],encodeOffsets:[[120837,35916]]}},{type:"Feature",id:"371325",properties:{name:"费县",cp:[117.977325,35.26596],childNum:1},geometry:{type:"Polygon",coordinates:["@@@EBECECEECCAGAC@MDCHADEDEBA@ACA@C@@@B@@AA@A@BAA@A@AAA@C@ABABAD@@EBCAIAC@G@CB@@AF@FBH@@@DBDBD@DBB@FCFG@KFEDEBEDE@CBEBEBEBGFCBCBCBCDIFGVADADCDEFEBEDCBEDSRSRCBCHCFPDHBHBFDLBDBJHFDFFHDDDFDFFDFDDDFPRBDBFBHBDBFBHBFBFBDBFBFDHBHBJBD@BBHBFFH@DBBBF@@@@@@@F@BBD@FBJ@@@@BN@@DDBD@D@HCJ@HF@BABAD@BAD@HAD@FAHAF@HCFAJAF@F@FBHBVHNBJDFBDBFBD@DBFBDBBBDDBBDBD@DBD@DBFBB@BDDBBBB@BBB@DDDDBDB@BBD@B@F@@@@@J@@@H@B@@@FBD@FBBBF@D@D@D@FAD@FADAB@H@F@BBDBBBBD@BBDBDBDDBHFDDBDBD@BADADEHAB@BGJAFAH@FCF@BAD@FBFDF@DBD@D@FBBBD@BLJNFHH@@DBHLBDBFBDBFBDBD@D@BBFBDB@@DFL@B@BDF@D@D@FADAH@D@F@BBFFJ@@HDFHHFHFBBDBDDDDDDBDBF@D@FBF@@BDBDDD@H@HADAJ@JBFBBBBBDDDDD@DABCDCDCDGLADAFBD@DBJHBDAD@JCPENEHAJAJAL@H@HAJ@NA@@BAFCHGBA@CACACAABADEFEDG@C@CACBABANEBCDIDMBADEHIDCD@F@J@HDNDJBH@JAD@ZETEPCPALAFADCPIDCBCDCBA@GBGBEFCAGAC@CACCCAAAACGAI@E@@BEDCHEDABADCD@HAHADCFKBAB@NAD@FBDDB@FBHAFAF@BBFDBDBBHNFDFBFBH@FADEBGBCFADAHAHADAFGFGDA@AA@CBE@A@G@CC@AA@@@@AACADA@BCG@@@@AB@DADADG@@@@@@@A@@@@B@BA@A@C@CCC@AAC@AEB@A@A@B@EA@@A@A@@@@A@A@@B@@@C@@@@A@@ABA@@AB@A@@A@@@@@A@AB@@@@A@BCA@@@AAAB@DD@@@@B@BA@@AA@A@B@AAAB@@@A@@@@AB@@A@@AB@@A@@B@@@@B@@@A@@@@@AA@@@B@@@@A@AD@ABF@@AA@AA@@@@C@A@ACF@@@AAAAA@C@@CA@@A@@ABA@@AA@@@D@@A@@BBB@@A@@@AD@AAB@AAB@AA@@BBB@ACA@@AD@@@B@BBDA@CB@@BDA@AC@E@@CACAACAAE@@CC@A@@@AB@@ABC@AEAEA@@EDCFGDCACEAAEB@@APADIFC@C@G@C@AGEAK@GBGGACACCCAB@@@BAB@B@DEB@CA@@DC@BBA@@@@@@@A@ACE@@A@@A@EBC@AGABAAB@@AB@@C@@DA@AD@CCBCFEBCDAFEBABC@EACAA@AAA@@AC@@AEBC@A@@AE@ECEBCCCACA@@ACEDEBABC@@CAAAGACEAC@CBG@@A@BE@AKEOIBGFEDAFCDCB@BA@@D@HAB@HAHCBADCHCHAJ@HAH@@AFAB@FAJ@BAFABA@@B@@AA@IECCCAAA@AAC@@@@@@ACEGAAAACA@@GAC@@@CGECAECEA@A@AEEBAI@@EAAGAA@AAAECAAG@@@AAH@BCFCAAECECQCCADABGDABA@CAE@EAG@CCIAC@AACEGCECM@E@CI@KCMEA@CBEDEDCBCBE@CACAGAGCEAE@E@E@IAG@GAE@GACAIECCKGGAE@OBAAA@AAWAC@A@G@EAEAGAIG@@ECCACEEACACCAACAUEWIQCGAGAGAEBEBGBGBEAO@ICG@E@MFCHBFCDCBG@C@@EEAC@EAECC@G@EBC@E@G@E@CACA@C@@AA@A@C@E@EEGACC@EBEDCFCHADC@E@EAGAC@I@MBI@E@GAAACC"],encodeOffsets:[[120592,35878]]}},{type:"Feature",id:"371323",properties:{name:"沂水县",cp:[118.627917,35.79045],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@A@A@AAAAC@AA@@C@ECA@AB@BCBC@@@@BA@@@@@C@@@AB@B@@@@CCC@AAE@A@EBA@@B@@@BA@AA@@A@EDCFABC@@D@H@BCBG@K@CA@@AAA@A@A@@@EBEDGBAB@DABCD@B@DDBBDEBC@C@@@@@E@GBKHCBABA@CACAACEECCCAEBGHEDADCDABCBCDEB@@@@@@CBAB@@@@ABEBABGDGBGDC@ABE@E@C@E@I@E@C@A@C@EBE@SFEBEBABA@GAGEE@E@EDCBCDCFABEH@D@@@DBBDHBDBB@F@B@DCJ@DAB@DAH@@ADEDCB@@C@IAC@E@C@I@CBC@K@C@E@GAG@E@IBGBE@EAECGKEE@@@AA@EEA@A@C@@@@@@A@@DA@AF@@ABA@@@@AAC@@@CBA@AB@@A@AAAAA@CBEB@@C@A@@@GB@@ABAD@@CBA@@B@B@BBJBF@D@BA@A@@FAB@@A@A@CAA@A@AB@B@@@BBBBBA@AB@B@@@B@B@@@@CB@@@@@C@A@AA@@@A@A@A@A@@@AL@@ABB@@@@@@@B@B@@B@@@@B@AB@@@@B@@BBB@@@@@@@@@@@@@@@B@@B@@@@@@@@@D@@@B@@AB@@@@@B@@BD@BBD@DBD@BBDBDBDBD@BBBB@@@@@@@@@@@BA@@B@BIJGHGH@BADAD@@DDAFCHADADEFCBCDEDAFAD@FAD@F@BAFCFAFCDAFAD@BABAHAD@DABCDEF@@CBCDCDCH@DDDD@D@FCHAF@DADBD@HBFBFBH@HAH@HDBDCFAFCDAB@BAD@D@DADBFAD@DAFAB@BCHAB@@@BAF@DBD@D@BBDBD@BBDBD@DBB@@@B@D@B@DBBBBBBBB@C@AACBA@ADABABADADA@@D@B@D@FAD@B@B@@@@A@@@@@A@C@C@@@@B@@AB@@@@@@@B@@@@@@B@@@B@@@@B@B@FBB@DBB@BBB@@BB@@BBBBBDBDBD@DB@@B@B@D@D@B@B@B@B@@@BBBBBBBBDDBB@BBBDDBBBBD@BBB@DBB@D@D@@@@@@@B@@@@@@@@@@A@@@@@A@@@@@@@@@@@@D@@@@AB@@@BA@@@@@@B@@@@@@@BBB@@@@BB@@@@@B@BBB@B@BBD@BBB@DBB@D@BBDBD@DBD@B@DBD@F@BBB@BDBB@DBB@BBD@B@BBDB@@B@@FBB@@BB@@@@@@@BA@@B@@@B@@@B@@@B@@@@@@BB@DBB@@@@BB@B@@@B@@@@@B@B@B@@@B@@@@@B@@@FD@@@@@@@@@B@@A@A@@@@@@@@B@@@@@@@@@@@BBB@BBD@BB@@D@@BB@@@@B@BB@@B@B@@@B@@@@@B@B@B@@@B@D@D@F@DB@@@@F@D@DBD@B@@@@@@@B@@@@@BC@@DEBA@AFEBADCDCBA@@@ABC@ABCB@DCDA@AFCDAD@B@B@D@DABAB@@AFCDCFCBA@@@@B@@@@@B@DBB@DBBBB@BBBD@BDBDBD@D@BBD@B@D@D@D@D@D@D@D@D@D@B@D@@@@@B@@@BBBBBB@@@B@@@@AB@@@@@@@D@@@@@@@@@@CBAB@@@@@BAB@BAD@@@@AB@@ABABEBCBCD@@@BA@@@AB@BAB@B@B@@@@@B@@@B@@@@@BB@BBBBDBBB@@@@@@@@@B@@@@@@@@A@@BC@CBA@@@A@@@@B@@@@BB@H@BKJC@ABAD@BB@AFA@IHAD@@DBDD@@ADFHNADEHCF@F@J@HCFF@DBBHAFCFABDDR@HBBB@BB@BCBCDB@@@CBBFFFCJADCBA@ABCDBBDB@FGBBDAFG@ABHDXN@JDBF@DHCFCFCDB@BBBB@@@BUDQAA@C@ABA@A@@@CFABBBD@FCD@B@@DFFHGBAHBNDDFHDHBB@DFCDDBFBDADA@EB@DLDDDBJDB@DAB@B@BB@ABA@@BBDLCHCF@DBDDFHFDHB@F@B@@AAA@@HED@@@RFHHBBBBJDF@DDLNB@FABBFJDB@@B@JGD@NEB@HBBBBDBFJ@HAB@LGB@FADCF@H@FBBCFEDKBA@@B@@EEC@CDABBB@B@FC@ADBFA@GBAFAB@HBDNBBHDLBBAJCBCAG@E@ALADFRFGJ@DDH@FALBBFBB@BAB@BC@@@@@BBB@@B@D@AHBBBB@@BBB@B@@B@@A@B@ABB@BJBDADCFA@@AA@CBBHDDB@NH@@HJHFFBDAFCFBBAFEBAB@@@LCD@DBD@LFDBF@FCHCF@B@BB@@DBB@B@D@D@P@JDBBNADA@@FC@QBABACAEE@ABKBK@@JEHBFIDE@K@A@CCCBIAE@ADCFABABAAC@@C@BI@AA@G@BE@EDKACBAAADA@@BBBAD@DC@@@AC@CBACAEBABA@AC@AA@CBAHGJAJGDIFA@A@@B@B@BBFFDBHCBBDBDBB@J@LBBBDFABFFDFBBC@GAAHBBH@B@BAH@BB@BEBCB@B@BFDHBB@D@FEB@HBDBFFFDBBB@D@BABEHEFABC@ABEDCD@B@D@@@HBD@B@@C@C@A@A@@@@BA@@@@F@@@F@@@B@HEDAB@HC@@@@B@B@@B@@AFD@RFD@@ABAJ@@@D@DBDB@DD@HFH@BADAA@BCBEBA@@B@@@D@D@BAB@DG@@@@BA@@@@F@B@BBDB@@F@B@B@@AFCBADADAB@D@BBB@@CBEFAAC@CBA@CCAA@@B@@@@C@AAEA@@AAA@@@@AA@AA@@AAA@@AA@@@AAA@AC@ABA@ACECCEC@@@A@@A@BC@G@@CAAA@@@C@A@@@@BABAB@@AFCFAH@@AACCAAA@C@@@@A@@@@@@@@AB@B@AICAE@MBACEB@@MDC@@DADCBCBIFCBCAA@@@I@A@ED@BA@AB@AAA@B@@@AGKAACE@@@@EIIGAAGCEAEAAA@@A@@@A@AAA@UE[AC@O@G@A@A@A@E@c@A@QAAAQKIKCADEDCFCDADAB@DCDCBCBCBC@@AG@CCCCESKIAKCSCG@IA@@A@@@MAQCM@M@GBC@GAGACACCAEAC@C@E@EBABE@E@AECIACAE@E@G@G@C@A@EACC@C@C@G@EBIDEACC@GBC@G@@C@@AAACACCE@CCC@@ACAC@E@C@A@A@A@ABE@C@CAE@EAA@AAECEECG@C@E@G@GAAACACCCGEA@C@@@@AA@C@EBCBC@E@CBCAE@@CECEACEEACCCCCEECACCAAA@ACAACGBE@E@A@AB@@A@CBEBCBCBCBABC@C@C@A@CAGAEAE@E@EDC@ADCDCD@B@DEF@JCD@DAF@B@F@FAD@J@B@F@DABAD@FAB@D@H@F@HBB@B@F@H@@AF@@@@ABDD@D@@ABBBBBBF@FBF@F@D@FADEBC@EAE@EA@AAAEAECECCACAA@A@@@@@@@@AEACACAC@@AE@CJE@GAACAK@CE@@BEAEAACEEAC@A@C@CCAACC@@ACAAACECCCGEAC@@A@CCEECECAIK@@CGACAG@C@C@CAI@MEBI@EBE@CAEA@@EEEBCB@@CBA@KFCBA@C@CACAEBCBCBAD@R@DBHDFFJDFBD@BBDBFBB@HAD@BABCDE@E@GCCQQ@ALGDC@OLCBGFMFC@@@EHGDA@A@AACAACCCC@@@CEGGAAACAE@A@CAAAAA@CAEAABAD@DABABAD@H@BA@EDEACACCA@AB@@AD@BABI@@@GA@@CI@E@C@C@E@CACAAAAEACAKB@B@BBD@BAB@@C@"],encodeOffsets:[[121411,36500]]}},{type:"Feature",id:"371326",properties:{name:"平邑县",cp:[117.640352,35.505943],childNum:1},geometry:{type:"Polygon",coordinates:["@@IGCAKAECGAGAOCGHABABC@CBADCD@FCFAJADABA@EAABA@AB@DAB@D@FAFCDA@A@G@CBC@@BA@IAIEAACACCA@EBG@CDKJCFEFAB@B@D@@ADCBCBABAB@DAH@F@PC@AAGACAC@IBE@A@KHMFEFCDADAHCBABGJGBEBCBCDC@@@A@@@A@I@C@ABA@EBEBEBA@AB@B@DBBBFDD@D@DABABKHADAFAB@DEBE@E@CBEB@BGDEFAFBFD@B@D@DBDBDBFDJFDBD@FBDBFBNFB@B@JA@@@DBDBDFHFJDFDDFHBB@D@@CDEDGBCBGBABGBKDEDCDCDCJ@BAHAF@@@@AB@@ABADAD@J@B@@@B@@@@@DBDBBBDBDDBH@BBFBDBB@@BB@DDB@HHFL@BAFAFAB@B@B@BBB@@@@@@BDHDDBBBBBDBAD@D@@AB@DAD@@ADAFCD@BABCDA@CDIDCBAB@@@DCD@BAD@BBBBDBB@FADCBCDCBEBCBEDCDEDABEBED@DBD@@BBBBDBDBFBDBDBB@@BBB@BBDBBDF@FBFBFFHFF@@B@DBFD@@BBDHAF@BBD@BBDDDJD@@D@BCFCDA@@@@DAFAHC@@LED@B@BBBH@FBDBBRBD@D@DDFD@@BB@B@DADABCDCDEBE@GD@@AFAF@BC@E@AJ@BDHBFB@DBRAH@HCDAD@HAD@L@LBLBD@D@@@@@LBN@HBB@TDJBHDFDDHDF@F@D@BABCD@D@B@FBHBFBD@BCBCBGF@D@H@JBF@BBJDLBD@FDFAFDFHE@BDdDNBH@D@BDNBJ@BJNHJFH@@@@DHDDDFBDBBBBRLD@D@HBJ@HBHAH@DAF@HBB@@@HBFBHBDBHBHDDDDBDDFDFBDBD@F@DAFAFC@@FADCFCFCFAFABAJ@@@HAF@FAFA@I@A@MBEBCDAHCDABA@G@E@E@ALAB@@@HMD@CECACGGEHCHEBAFAFEDAFGRAFBB@HADAHGDEDGBEBE@EBCFADCFCFADAHAHCFADADCFCFGJCBEFGFIHKBADCFALCD@DCNKDEPEJEH@FCHCFCDAFA@BB@@@@@B@B@B@L@LAF@D@FABCAI@CACBEBCHKDCDCDCBA@CCCCCACAAAAAE@IBIBC@G@GCCACAC@@AE@E@CAEACCCCCCCCAAAGEGEEGGC@@EIAE@A@E@CBGBC@E@C@CCE@A@AEK@CA@ACAE@A@CACACAEACAEACGKCA@@GGMEKI@AACAA@E@CAC@CCEAE@EBC@ADE@EBGBEHI@ABAFGBCBC@AACACCCGECAACACAC@AACAACAAAE@G@A@CBEBC@EBC@C@C@E@AAEAC@EA@@A@G@@@I@@@@@E@A@C@AAA@ACCCCCA@AAA@AACAACA@EACAC@CAC@CAAACCAACAEACAC@EACAEAICMAUGGAEAE@E@IBEBGDE@GBEBC@GBC@ABC@ABABE@@GDI@G@CACCC@@AM@@@@AI@EAC@A@E@@@@@@AEAA@CEGAEAG@AACAIAGCGAEAEACAEAEAGAEACAGAEACOQCECCCEEEECCCGCEEEC"],encodeOffsets:[[120465,35984]]}},{type:"Feature",id:"371327",properties:{name:"莒南县",cp:[118.835163,35.174846],childNum:1},geometry:{type:"Polygon",coordinates:["@@Q@IAGCC@I@G@GAEACAECICGAGACAC@E@E@AACAACCEGUACACCCIEA@@AAA@WAA@@ECC@@@G@KBG@G@C@E@E@A@C@@@EBE@C@E@C@CAAAECEAGACBI@E@AAE@CBEAE@C@EBI@K@ABE@E@C@E@E@ABE@E@EAEEA@GCA@CBA@E@EAEAI@C@G@AAC@CAC@CAGAAAC@OCC@EBEDEFCFEBABCAE@A@KCC@A@CAAAAACCCACAICEAKEGAGAUEG@CA@@CA@@@@@@@@@AAC@GMBG@IAEACAAACCCCGCE@@BC@ABIDCDC@EDA@C@E@EACAC@A@@@CAECCACAEAEAEBEBEBEBCBEBG@I@E@A@UBEBCBIBEB@@EBCB@BEDEDEDEFEDIHCBYPQJKH@@@BKH@@GJABCDEFABKHWJKDCBCFAHAB@@ABM@AAOFGDDBF@DBBBB@BBDDDDLLBF@@FH@HDL@B@@BFBDBBDHDF@BBDAD@@@B@B@D@H@DBB@DFF@BFF@@FBBBFBHFFDBB@@BBDDBB@D@@@DADEHCFA@ADABADABCD@DCHAJ@@@B@N@@BRBLDFAB@@@@A@C@EBKBGBE@C@A@@@@@ECAAE@EBE@CBI@OCCBCBADCDABCDEDGHCFABADAF@@@DAF@DAFADCBCBEBMDC@CB@BAD@F@HBFJNFAH@HDJDH@BHAHC@AHEDBB@BKB@DGDEBA@ABABFLBF@DADEF@D@DBD@@BBDDB@DBDBBB@@@@DDBD@D@F@BBJ@BDBBBBBBD@@BBBHFHBDFHBBBBB@FDDAF@DAF@D@D@FAD@@A@@BA@A@C@A@A@I@E@C@@@A@AB@FAD@B@HBFDNBFBD@B@D@FALAD@B@B@B@B@B@FAD@BABADAB@@@B@B@@@BBB@@BB@@BBDBBFFFDBBBB@@B@B@@@B@@A@ABABADADEDAB@B@@AB@BAB@B@@A@@BA@@@@@AB@@@@A@A@AD@B@BBD@DBBBDBBBDABABA@@B@BAFABABAJCB@D@BBFDDBB@D@D@B@DBDBBBD@B@D@DBF@FD@@BB@@@HBBBBB@B@DCBADCD@@AB@F@F@NAD@D@BBDBD@FBF@F@DBB@B@DAD@B@FABBB@F@B@B@B@DAD@FAB@B@BBB@DDB@BDDBHDFDDBBBDHBDDHBBBBB@B@B@BA@@BABA@ABC@AAC@ABC@A@AAA@AB@@AAA@AACAAAC@C@@@ABABCB@HC@@HCDADABAD@BAF@JAH@H@FAFAJEDAB@FCB@BE@C@A@EBEBAJGDAD@BBNHBBB@B@DBFAD@D@@@BAD@BAB@B@B@@@B@@@D@FBDBDDFDDFHFBDBBFDFDDF@DBF@D@BA@@B@DCFAFCF@DAB@@@DDDDBHB@BLDDBBBD@B@P@H@JAJAJE@@FCLCB@@@HCDABCBADCBALE@@VGFAB@FDD@B@@@DABC@CACBADCD@DABABA@@@EBA@E@G@@@CBA@A@@BADABABM@ADAB@JDB@HDD@@BH@hHD@H@@@H@F@B@DA@@B@BC@A@C@CAAAAAACCA@AA@@AA@@@E@ABCBADAFCJIJIBABCCCAAACBADA@@DAHAH@H@F@D@BABADI@ABGBG@GBGBA@@DEBABACCECCA@@@E@K@IAC@AACAAAEAAEC@A@E@C@AAE@ACE@A@@AECC@AAACC@@AAEAEAC@C@C@G@C@AAC@A@C@C@CAAA@A@CA@@@@A@A@C@CJI@B@@@@@@@@D@BBB@@@B@B@@@B@@@@BBA@@B@HA@ABABCBADA@@@C@ABABA@AB@@EBA@A@A@@@@BABA@ABAB@B@@@BA@@BA@@@@BAB@@AB@@@B@@AB@@@@@GAGEAAAA@C@EAA@CBEBC@@DCFAJAH@B@F@D@B@F@DA@@DCDABEDCA@B@@@@@@GBKDEBC@@FI"],encodeOffsets:[[122065,35954]]}},{type:"Feature",id:"371328",properties:{name:"蒙阴县",cp:[117.953621,35.719396],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@IBEFM@GBK@CACEEIKMIEDAFAH@HABCDADCDOJCDEBKBOBODSFYFC@IBG@IAMCGCI@E@C@CDGJCFABCNCJADMFABABBD@D@DCHEFCFABBBBDBD@DABGHEDAB@@MBI@GBG@K@IBIBGBMFOFIDC@CBGAADEBC@E@KBK@A@A@A@@@@@A@@AEBCBEDGDEDG@IFOFCFMLCDC@KDEBCDABGLEJEHAFIDEHEDCDCBEBGDGBCBEBEDCDEBAD@FAFAFCHCFGHCBGBA@EAQBEHCBEFEBABGFGDHFDHDBDFC@GN@@A@KB@B@F@F@HABCBGDCBADAF@N@B@JH@HDDFFHFJDDBDB@DDFDDBDBFBD@FBF@F@JAB@D@FA@@H@D@@@@@D@HBFFDDFBFAD@D@FAFBBB@BBB@F@DCN@B@B@BADADCNADAFADAFBF@B@DBFDBDBB@DBFBHFDFBB@D@FAD@DADABADADA@CFGD@@@@@@CBABCDABCDBHDFBB@@@@@@BDPRBBDDFBDBJDFBBDDF@@@DBBBB@BDFDFBDDDBBBBB@DDDBB@FHLHB@HD@@FBF@HAB@B@F@H@H@@AD@LAF@DAB@JEDADABAHAD@H@DAFALCFAD@B@@BBBBDBFHLBDBBB@@BDBJBB@BB@@@BBD@FAD@DBFBFBB@@FBBBDBFFLNFNAD@@@B@B@@@B@@@@AD@B@BABAB@BADABAB@BABABAD@BABD@D@D@D@DBD@D@D@D@@@D@F@B@B@DAB@D@B@DBBD@D@B@B@B@B@BBBBBBBBBD@BBDBB@B@@B@B@L@F@BBBBB@BPRDBBBBBB@PDDBD@@BB@@B@H@DDBDHFHDANBB@DHGA@FDDBBB@LKB@HBB@@B@@@B@B@@@B@@@@BABAB@@@@ADF@@@D@B@@B@B@@BAFDBBAFB@@BBHDDD@@NBD@BB@BFADCD@D@BBH@BCDIJCB@LAF@PAD@FBD@FADBCBCB@BD@HCCE@ABAD@L@B@DCB@@@@AB@B@HGF@DC@GDAFDBE@@@CBCBABCBAAC@C@CBCBADC@ABA@C@@@ACAC@E@C@AAAAAAA@@A@@@CBABABCDABAD@DAD@DA@A@A@C@ABA@CBAB@B@D@DABAD@BADABABAD@DADAD@D@B@F@BA@C@AAAAAAAAA@C@A@C@A@@AA@CACAC@AACAC@A@CAC@CBE@A@@BADG@ABABE@CBCAEBC@C@CBC@ABADCBEDEACGCG@GBG@EAEAGAC@CACBE@GBEDC@C@CC@CDGDCDCDA@@FEDCBA@CBCBGBA@ABCBEDCBEDEBE@A@EBC@EBCBEFCDCDAFEBCBCDGBECC@@BCBC@AHGHGJI@A@AB@@A@@@@@@@@@@AAAAC@CACACAAAC@CAC@AAC@@AA@@@@@A@@BA@@@C@@@@@@@@@A@@@@A@@@@@@@@@@@@@@AA@AA@@@@@BAA@@@@@@AA@A@@@@@@@A@A@@@A@@@A@@@@@@@@@@@@BBB@B@B@@@@@B@@@B@@@@A@@B@@ABA@@@@@@@A@@@@@CAA@@@CAICI@AAGACC@@AAACGEGISIEAEACCECECCAACAEAIAEAAGEAAAC@E@A@ABE@CBAFEDCBCFGDEDIBEAA@CAAAA@@@@EEIGIKGGCAAA@@@C@@@ABADAHAHABAB@@A@@ACA@CAAACACAA@AACKAEGOCI@@@@@ADKBEBABAD@@@F@FAHCDADABADA@@FEBA@ADA@AD@D@D@DAFAB@@ABGBA@AB@HCFCHELGRGHC@@FA@ABC@CBCFEJGFCD@DCBAACEECAC@GAEACCCCCCCCEAGGCECEAG@A@@@@AEDMAGCCC@GAEBC@IBEBCBCBCBCBA@EBEB@@A@C@K@ACBCBEBE@CAC@CDEDCBABCBEBABC@C@E@@"],encodeOffsets:[[120994,36335]]}},{type:"Feature",id:"371329",properties:{name:"临沭县",cp:[118.650781,34.919851],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@CBK@@@AAGAC@@AAAAE@E@CBABC@A@C@@@@@E@EA@@C@@@@@A@@@CBEBG@CC@CA@DGDABAPKBADCBC@@CCACCAAC@C@ADE@E@GAEAAACACAEAAA@@@I@A@CAEEA@EAA@E@GB@@A@IBABC@A@C@CAAACAA@CAA@AA@ABALAFCDCB@@ABA@CAIAEAC@@A@A@ABCBC@E@C@GCICCABC@@@ACE@A@@DABA@A@@AAAC@A@C@AB@BABAD@H@@@FBB@@@BAFEDEDAFCD@H@@@H@BBD@NFB@B@B@D@HEJGBAFEDAJK@A@@UMAAIG@E@CA@MCA@ECGCA@ACIEIAG@M@E@K@G@CAICKCOMECECA@E@E@C@ABA@CB@B@BKLMLEBCBE@C@GACAGECAGCEAE@EBEBIDSFI@ABABA@@BCZCJ@@ABC@CAGAC@C@CBE@GAE@KACAA@IEGCE@GAG@CBCBADABABC@M@IBE@CAGBEBGDA@CFEH@@GJ@B@F@J@@@J@D@DIPEHABAH@H@BCL@B@FBFBJ@F@DCHCBCDGDGFC@GBABCB@@I@IBAACCA@E@KBE@GBA@@B@BDL@BDHEBADBF@BBH@B@@EBE@C@@BLJBBTJHDD@D@DA@@HAD@B@BBDBDB@F@L@D@@JTDHBFJHBDBB@D@BABKLZODEFGJCDA@@D@PARA@@LCFK@AAI@@@ACK@C@A@KFMBCFM@@RW@L@TFTDRBHJH@@BBFFBBBB@J@B@D@BADWXEJ@F@@BFDP@B@T@BEPEH@@@BAHAF@D@BDDFDBBBB@D@@@B@F@B@H@@@DDF@B@BBDBFDJBF@B@BBD@BAB@FCHAD@B@DBD@@BF@D@D@B@B@DABABGBA@CBGD@@GFADABADAD@F@DBBDBBBBDBBFFD@BBFBBBB@DFBBFDFDFFBB@B@D@F@DBDB@@@@@B@B@@BDBB@DB@BBBDDD@B@D@B@D@FBD@DADBB@BBDDFDB@@B@@B@BB@@@B@B@@@D@BAD@B@B@@@@BH@BBBD@DDBBB@@@@DBBBBDD@@BB@@@BBF@BB@BBHCPEBBN@BA@@BABGDEDALCXILGBAFEDCBAHI@@LG@A@@LGRIZODAJGFCFEFCFCFC@ADAFA@@FAJADAFAVAB@F@J@H@FADAFAFAFAFAFBFBDBDBFDDB@@B@D@DBFBF@D@B@FCD@DCJCBAD@@AF@HDDDDDBBDBFBJBH@NA@IBG@E@EBK@K@CAMCI@A@AAC@E@GCQBQ@EBK@ACECCA@@AEAACA@@ACAQSSUACWaAC@CA@@CACCCEEAAAAACACAEAC@C@@AQ"],encodeOffsets:[[121655,35697]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("青岛",{type:"FeatureCollection",features:[{type:"Feature",id:"370202",properties:{name:"市南区",cp:[120.412392,36.075651],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@A@A@@A@@@A@ABABC@A@A@@@AA@@AA@A@@BA@@B@BA@@@A@@@A@@@@AAA@@AAA@@BA@@BA@@D@@@BDBBB@BBD@B@F@FBB@BBDBBD@@@B@@@B@B@@B@@@BAB@@BBDDB@BB@@@B@@@BAB@BBB@BB@FDRKFD@@@@B@@@@@@@@@@@@@BA@@@@@@@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@B@@@B@@BB@B@@@B@@@B@@@@@B@@@B@@B@@@@@@@@@@@@@B@@@@@@B@@@B@@@@@@@BB@@@@B@@@B@@@@@@@@@@@B@@@B@@@@@@@@@@@B@@@@@@AB@@@@AB@@A@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@A@@B@@@@BB@@@@@@@B@@@@@B@@A@@B@@@@@@@@@@@@@@@B@B@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@BBB@@B@@@@@@@@B@@@@@@@@@BA@@@@@@@AB@@@@@@@@@@@B@@@@@@@B@@B@@@@@@@@@@@@@@B@B@B@@@@@@@@@@@B@@@@@@@BA@A@@BA@@@@@@@@@@B@@@@@B@@@@@@@B@B@@BB@@@@@@@@@BB@@B@@B@@B@@@@@B@@BA@@@@@@@@@@@@@BB@@@@@@B@@B@@B@@@@B@AB@@@BBB@@@B@@@@B@@@B@@A@@@@@@@A@@@@@A@@A@B@@A@@@@@@@A@@@@BB@B@@@B@@@B@A@@B@@@B@@B@@@@BA@B@@@@@@BA@@D@@@@A@@@@@@B@@@@@@@B@@D@@@@@B@@BA@@@@@@B@@@@B@@@@@@@@@@@B@@@@@B@B@@@@@@@@@B@B@B@@@@@@@@@@@@@B@D@B@@@B@@D@@@@D@@@B@D@@@B@@@B@@B@@@@@@B@@@@@B@@B@AB@@@@@B@@@@@B@@@@@B@@@@@B@@@B@@@B@@@B@@AB@@@B@@@B@@A@@B@@@@@@BB@@@B@@BB@@@@@@@B@@B@@B@@B@@@@@@@@@@@@B@@@BA@@B@@@@@B@@@BA@@@@B@BBB@@@B@@@@A@@B@@@@@@@@A@@@@@@B@@A@@@@@@@@AA@@@A@@@@@@@@@@@A@@@@@A@@@@@AB@@@@@B@@@@@@A@@@@@@@A@@@@@@AA@@@@@A@@@@@A@@B@@A@@@@@@@@AAA@@@AA@@AA@@@@A@@B@@A@@@@@@@@@A@@@CA@@@@@AB@@@BA@@BA@AB@@@@@DCB@@ABA@@B@@A@@@@@@@@@@A@@A@@@@@@@@@@@@@@BA@AB@@@BA@@BA@@BA@@@@@@@@@@@A@@@@@@@A@@@@@CAA@@C@C@A@GDA@C@A@ACGCECG@G@Q@ABA@BB@@BBB@BD@B@BABABA@@@A@A@@AA@@AAA@@AAA@CBAAA@@@A@AAA@AAA@A@A@@AAA@@AA@A@@@@BAB@B@@A@A@@@A@AAAAACAAAC@A@A@@BA@@B@BBBAB@@@@ABABA@ABA@A@AA@@A@A@C@A@AA@@AAACCAAAA@A@A@A@C@@B@B@@@BB@BBBB@@BDBB@B@@ADABABA@A@A@AACCCAC@G@C@A@A@@B@@@F@B@@B@@@@ABA@@@@B@@@@B@B@B@B@B@@A@@BA@A@A@A@@AB@@A@@AA@@A@@@AB@B@BA@A@A@AA@@AA@AAA@@AA@@A@@ABAB@@A@AA@@@AAA@@@A@A@IBGB"],encodeOffsets:[[123187,36918]]}},{type:"Feature",id:"370203",properties:{name:"市北区",cp:[120.374701,36.0876],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@@@@@A@@A@@A@@@@B@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@BA@@B@@A@@B@@@@A@@@@@@@@@@@A@@@A@@@@@@@@@@@A@@@A@@@@@AA@@@@@@A@@@A@@@@@@@@A@@@@@@@@@@@@@AA@@@A@@@@@A@@@A@@@A@A@@AA@@@A@@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@@@AB@@@@@@@@@@@@A@@@@@ECQLF@DBB@@B@@@B@@A@EA@@A@@BBBHH@B@@@BA@C@AB@@@B@@@@HBBB@B@B@D@D@B@@BBNFBB@BEB@B@@@BFHBBB@B@BAB@B@BBBDBFBR@BBHDFR^HLBBBBHHBBFC@@\\MB@HAFADBFBB@@@@DJ@H@D@B@DAB@DABA@@BC@ABA@C@@B@@A@A@A@@@AB@@@@@@A@@@@@ABEDADAB@@@@@HAD@F@@@@@@@B@A@@@AA@@@@A@@A@@A@@@AA@@@@@@AAA@@@@AA@AAA@B@@ABAB@BAB@@AB@@@@@@A@@B@@@@A@@BA@@@@@@@@@AB@@@@A@@B@@A@@@@@AB@@A@@@@BA@@@AB@@ABA@@@@@AB@@@@AB@@A@AB@@@@A@@BA@@BA@@@AB@@A@AB@@@@A@@B@@A@@@AB@@ABA@@@AG@IAEAA@A@@AA@@E@@A@@@A@AAA@@@@@AB@@A@@@@@A@@@AB@@A@@@@@@@@@@@@@@AA@@@@AA@@@@@@@A@@AA@@@A@@A@@@@A@@@@BA@@@A@@@A@@BA@@@A@@@A@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@B@AA@@@@@A@@@@@@@@AA@@@A@@@C@A@@@C@@@@@@CA@@@A@C@A@@@@@@@@@@@@@A@A@A@@@@@@@@@A@A@@@@@A@@@@@@@@@@@A@@@@@@A@@@@B@@AA@@@@@C@@@@A@@@@@@@A@@@@B@@@@@@CB@@A@@@@A@B@@A@@A@@@@A@@@AB@A@@@A@@@A@A@@A@@B@@@@@@@B@@@@A@BB@@@@@B@@@@@@@B@@@@A@@@A@@A@@@A@AA@@A@@B@A@@A@@@@AA@@@@@@@AA@@@@@@@@@@@@B@@AA@@@@@A@@@@AA@@@AA@@@@@@@@A@@AA@A@@@@@@@A@@@@@A@@@@@@@@@@@AB@@@BAB@@@@@@A@@@@@@@@@@@A@A@A@@@@@@@@@@@@@@@@AA@@@@@@@A@@@@@@@@@@@A@@B@@@@@@AB@@@@@@@@A@@@@@@@@@@AA@AA@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@A@A@@@@@@@@@@@@@@@A@@@@BA@@@"],encodeOffsets:[[123230,36937]]}},{type:"Feature",id:"370213",properties:{name:"李沧区",cp:[120.432922,36.145519],childNum:1},geometry:{type:"Polygon",coordinates:["@@D@B@BB@BB@B@@@B@BAB@B@BBB@BB@@FBBBFFDDB@@BB@@BB@BB@B@@FB@@BB@@B@B@DAD@DA@@@A@@@ABAD@DB@@@@B@BAD@B@@@B@BA@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@@A@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@A@@@@A@@@@@@A@@@@@@@@@A@@A@@@@@@A@@@@@@@@AA@@@@@@@@@@@@@@@A@@@@@@@@@AA@@@@@@A@@@@@AB@@@@A@A@@@@@@B@@A@@@@A@@@@@@A@@A@@@@@@A@@@@@@A@@@@@@@@@@@@@A@@@@@@A@@AA@AAAA@BABA@C@A@AA@AA@@@@C@AA@A@@@@A@@@@@@@A@CAA@AG@A@@@@@@@@@@A@@@@A@@@@@@@@AE@@@@@@@A@@@AA@@@A@@@@@@@@AA@@@@@@@@@@@A@@@@A@@@@@@@@@@@@A@@A@@@@@@@@@@@A@@@@@@@@A@@@@A@@A@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@A@@A@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@A@@@@A@@A@@@@@@@@@@@AA@@@@@@@@@@@@A@@@@@@@@@A@@@@@A@@@A@@AA@@@@@A@@@@@AA@@@@@@A@@@@@@@A@@AA@@@@@AA@@@@A@@@@@@AA@@@A@@A@@@@@@@@A@@A@@@@@@@@A@@A@@@@@@AA@@@@@@@@@@A@@A@@@@A@@@@A@@@@A@@@@AA@@@@@@@@@@@A@@@@@@A@@@@A@@@A@A@@B@@A@@@@@@@A@A@@@A@@@@@A@@@A@A@@@@@A@A@@@@@@AA@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@A@@@A@@@@@A@@@ABA@@@@@@AA@@A@@AAAA@@@AAAA@@AA@@@A@@A@@@@A@@AA@@@A@@A@@@@A@@@@@@@E@C@GB@@@@A@CBCBAF@B@@@@@B@@@@A@@B@@@B@B@BA@@@@DAB@BAD@@ABCBA@CBA@C@G@I@@C@@A@EACAEBGBA@[N@@EDFFBD@@AD@B@@@DDFDBDBJ@D@BD@DAFABIXGJE@CBEBOLL`JA@@@B@@B@@@BAB@B@@@B@B@B@@@BBB@@@B@@@B@@@B@BB@@A@@B@@@@A@@B@@@@B@B@@BB@@@@@@@@B@@B@@@B@B@@@@B@@@B@@@B@@@@A@@@AB@B@@@@@@B@BBB@@@@B@@BB@@@@B@@@@@@@BB@@@@@@F@@@D@B@B@@@@@@@@@B@@@@@@@@BB@@@D@DA@@@AB@@@@C@AB@@@@@D@@EDB@BB@@A@@@@@@B@B@AA@@@@@@D@@@BAD@@@DBB@B@@@@AB@@@@B@@B@@@@@B@@@F@@@@@@@@A@@@AB@@@@@@@B@@@D@@@@A@A@A@@@@@@@@B@@@@@@AB@@@B@@@@AB@@A@A@@DB@A@@@@@AB@@A@@B@@@@A@@@@@@@AB@B@B@B@BB@@@@@@@A@@A@B@@A@A@@@@B@@@BAAAAAA@AAAC@@@ABA@A@A@@BC@AH@DB@@B@@@@@B@@@@@@@@@JBBDBB@@@@D@@@B@@@BA@@@@DA@@@@@@@@DABAB@DA@AB@@@@A@@@A@@BAB@B@"],encodeOffsets:[[123347,37069]]}},{type:"Feature",id:"370211",properties:{name:"黄岛区",cp:[120.198055,35.960933],childNum:14},geometry:{type:"MultiPolygon",coordinates:[["@@A@@@@B@@@@@@B@B@@@@@@@@AA@@@"],["@@A@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@A@@@@@@@@@@@@"],["@@@@A@@@@@@@@@@B@@@@@@@@@B@@@@B@@@@@@A@@B@@@@A@@A@@@@@@@@@"],["@@B@@@@A@@@@@A@@@@AA@@@@A@@B@@@@@@@BB@@B"],["@@B@@@@@B@@@@AB@@A@@@@@@A@@@@@@@A@@@@B@@@@@@AA@@A@@@@@A@@B@@B@@B@@@@B@"],["@@@@BB@@@@@@B@@@@A@@@C@@@A@@@@AA@@A@@@@@@B@@@@A@@@@@A@@B@@@@@BB@@BB@"],["@@DA@@@@BA@A@@AA@@A@A@@@@@A@@@AB@@AB@B@@@@BB@@B@@@B@"],["@@BB@@@@B@@@@AA@@A@@@A@@AA@AA@@@@AA@@B@@@B@@AB@@@B@BBD@@@@@@B@@A@@BA@@@@"],["@@B@@@@AB@@@BA@@@AA@BAA@AA@AA@@@A@@BA@@B@@@B@BB@@B@@@@@B@@B@"],["@@B@B@DCDABA@A@A@@@AA@AAA@AAA@A@A@A@@B@B@B@B@B@B@D@@BB@@"],["@@@BBB@BDBBBFGBC@CBA@AA@@A@A@ABCA@@CA@@BAD@@A@AB@B@BA@@@@BABAB@B@@@@AB@B@@@@@@"],["@@DB@@@DB@@BB@BA@@@A@@BA@@@A@AA@AAAA@CA@@@C@AA@@@A@@BC@A@AA@AAA@A@@BA@@BA@@B@@@B@BBBB@@@@BBB@DBBBBB@"],["@@GGGCUIGAE@IDEDCHAF@HBLBDBDFHFHHHDF@HDDDDF@FBD@BC@CAC@E@E@@BEDMBCFEDCDE@CAE"],["@@A@@CBABEAAEBE@ADCDC@AAC@EFA@A@C@KAE@CA@@CAE@C@ADAB@BDD@F@B@@AF@FABGBC@KFCBCB@BCBAD@D@B@J@DBDBD@@JFABCBCB@@@@ABBBF@@@@BBJFDABAB@@@D@B@@BBBB@BBBAP@D@FBH@F@B@FEFABGF@@ABAH@D@B@@@@@BA@A@@@A@@BBB@BAB@B@@@@@BFB@BBDBF@DBD@BCBBBBD@FADCF@B@B@@BBDB@DABFFB@B@@AB@@CBKDABAB@BD@@B@B@FADB@BBBFBB@DB@@BA@@@CB@H@DAD@DAF@B@@ADCBADA@@@@AA@AB@BAB@JB@@FEB@B@BBB@FA@@BB@BB@FA@@@BBBB@B@FADAH@@C@@BABAD@B@BBDFNF@@F@FEDCD@BB@HFH@B@BAHMLGDKPGLEDCBKBCBAB@FBD@JDF@B@FBHFDB@J@D@F@DBBB@FBDDFDD@DADEDGHADBLBHBFBB@@@@D@DAB@@@@B@BDD@@D@B@B@@B@B@BB@BDBDFH@BB@FB@@@@ABA@A@@@AD@D@BDBF@B@BBBDB@F@DDFDFDBBF@F@FAFC@@FAF@F@F@FBBD@B@BCB@BBBDBFDBDBBBBDBBBAFBBB@F@B@BBBB@@FBD@B@@A@@@@B@BB@@B@B@@A@AB@BABCD@HC@ADE@@FAB@FDD@B@@BBB@D@B@@ABGB@BB@@@B@@@@BAB@@B@B@DAFCB@@@DD@BB@B@@ABA@AB@B@BB@BCFBBBBBF@DAD@@@BADAD@JADAH@B@@B@@@@BAFABA@ABAB@DAB@BA@@@A@C@EHABCJ@F@@BBB@@BCHA@A@@B@B@BBB@@@BABA@@@A@AAAA@@A@BD@BA@AACAAABA@A@AA@ADA@A@AA@AC@@BBR@@BD@B@@@BEBEHBBDBBB@BGPABMT@DCHAHAH@BAD@FDP@BAH@DBDDBAFC@AFAA@@A@AHAF@BJHBBJBB@FD@@BJD@DB@@LB@BADHB@@AB@@@D@BBB@@BD@@BBB@BBD@B@@BB@FABC@CFBJ@@A@@@BBEDBBABC@@@CBEDEDABBD@D@@A@CAABCH@HDH@@@B@B@BEHA@A@A@ABE@@DBJBDABCPBDLAD@BB@@HBDDFFFB@@ADAABFBDCDCD@BBHAFAHAFBADJDDBBH@BDFBBEDFFA@ACE@@B@DFHBBD@BA@@B@HBLFBCD@B@FBNHBDB@LBH@DADA@EFEDAD@DA@A@@BCAABC@@FBB@BD@@DBBBA@@BDBB@@@B@NC@FHDBABBNFDA@AHABBBHPDDBF@@@HAD@H@FBBBD@@BBBB@LHD@H@PDHDFBBE@ADGDCD@HHB@BBBDA@@DB@@BADB@D@@@AH@B@@B@AB@@AFA@AFED@BDBHABCB@BABAACD@LBDCB@D@@DB@@DFBDA@@B@@B@B@@DB@B@@@@BBB@@@@@B@@BAD@D@B@@E@@@BB@BBB@D@@@@A@@A@BAD@@@@@D@@@@@@@BA@@B@@@@@@@@A@@@@@EDG@B@HDBBBA@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@BA@@@@@@@@@FAD@B@@@B@@@@@B@@B@@B@@@B@@@@@@BBFA@B@@@@@B@B@D@B@B@@A@A@@@@@@@A@@@A@A@@@@B@BAB@@BB@B@@@B@B@BBB@@@B@B@B@@@B@@BBB@BBD@DBB@BAD@B@BA@@DA@ABA@@BA@@BAB@BAB@B@D@D@BBDBDBD@DBF@BAD@D@BAHEB@BA@@BBB@B@B@B@DDB@@@BBB@@@D@B@@@B@DAB@BADAB@BABABADA@@@BB@FC@@@AD@@@BA@@@@HAB@DAD@F@D@HAN[BC@M@CDCD@\\CD@LALAHCDADCLSD@B@B@BAD@HEDA@A@AAA@A@ABA@@JGDAHCH@LAV@v@HAFAFCBC@EAIAEAACAM@CAAABC@ABAB@@@B@BADA@@@A@ACC@A@A@A@A@AAA@@OKA@@CBA@AACiaCAA@EBCADCDAD@B@J@FBHDLHPJDBLJTNPHJBPQ@CIGGI@@EIIQ@CBA@AAEACBCBAB@B@D@DBBD@B@D@D@B@BFBB@BBADAH@B@@HFB@B@FIBCB@BALDDB@BEJAD@DBBDBJFDBB@FHBBDBDAJAFAD@HAD@DA@ABAAA@A@A@@HEBCBAEKAGGSGUCEGASCCAE@IBK@I@E@GAICKGAAAAAC@CAC@CACAAA@A@E@A@A@A@AACCCCEICGCCEGACCCAACA@@GACACCEEACACAGACB@@C@ABCBA@AAAEIAAC@WECAC@GBI@I@ABA@@B@B@BDFDDBB@H@FDDBBBBBDFDDBDD@DBB@BBFBDB@@BB@B@B@FBB@@B@BAB@B@FBB@BB@FBFAD@D@FDDBDFBBAD@DABA@ABC@QEGCE@YKAAAAAACI@ACEC@@@@AAA@A@AA@AA@BA@AB@B@@A@AA@AB@BAFCAA@@CBCAAA@ABA@AA@C@AAACCA@ACAC@E@C@CBADABABANABA@C@AA@@@CLM@@@AAAKE@@CAC@GFC@AAAIAMIMKEUEKAKBGFEBE@C@IEECCCCEEEACAAKUCGEGQcCCGOEKAC@I@E@CBADEFEB@BAB@@@BA@A@AHIDCBE@E@AACACEAAAAC@C@G@A@AACE@AA@A@C@I@A@CA@A@ABA@AAAAMcGK@A@E@CAAAACACCCGCACBAFCBIDKCKEIAGBBHDLDJEHIL@@C@A@CC@@A@@@A@A@@@CD@B@@@BDH@F@BCDOPCBEBG@KBKBE@E@C@ECEAACEIACAK@E@E@CBA@@B@B@B@@ABABEBA@ADAB@F@F@FAFBH@DBD@@@BAB@DE@G@IAGEIIEICSGCAGCEECEAEAG@CAAECAACG@EAMBCDCBATGFEDC@E@CEO@ACCGAGAQ@G@GCGEIGGGAAOQECE@E@EDEBOPEFGBE@GAKIC@CBAD@FBJ@FAHAH@JABCBC@CCACCCACICA@GCCCAAAAAAAAAAA@AA@A@AAABAFA@AA@E@A@EE@@CAAA@CDA@@@KDCDEDEACEAGCRYAAIEICG@GHEJE@IKAAA@CA@@C@A@ABCBINEBCD@`AV@FEBQ@Q@A@KAEAEAaWGEGEECQKICA@G@SBGAC@E@C@EDCBCBED@@C@A@ABABABABAB@DABA@G@C@E@IJA@EAAA@IEKCCEAEAA@A@CFAH@B@BGDA@A@KGCCCCG@CA"]],encodeOffsets:[[[123214,36806]],[[123092,36751]],[[123091,36755]],[[123067,36652]],[[123219,36807]],[[123045,36773]],[[123213,36806]],[[122719,36470]],[[123066,36798]],[[123201,36811]],[[122609,36450]],[[122809,36490]],[[123077,36611]],[[122447,36447]]]}},{type:"Feature",id:"370212",properties:{name:"崂山区",cp:[120.468956,36.107538],childNum:22},geometry:{type:"MultiPolygon",coordinates:[["@@@@BA@@@@@A@@@@A@@@@B@@@@@B"],["@@@B@@@@@@@@@@@@B@@@B@@@@A@@@@A@@@@@@@A@@@@@@@@@@@"],["@@@@@@@@@@AB@@B@@@@@@@B@@A@@@@A@"],["@@@@@@BA@@@@@@@A@@@@A@@@@@@@@@@BA@@@B@@@@B"],["@@@B@@@@@@@@@@@@@@@@@B@@@@B@@@@AB@@@@@@@AA@@@@@@@@@@A@@@@@@@@@"],["@@@@@@@@@@@@@@@AA@@@@B@@A@@@@@@B@@@@@@@@@@@@@@@@@@B@B@@@@@@A@@@@"],["@@@@@AB@@@@@@A@@@@@@AA@@@B@@A@@@@@@@@B@@@@@@@B@@B@"],["@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@BA@@"],["@@@@B@@@@@@@B@@@@A@@@@@@@A@@@@@@AA@@@@A@@@@@@@@D@B@@"],["@@@@B@@@DA@A@@@A@@AA@@@@A@@@@B@@@@@@@@A@@@@B@B@@AB@@@@@@@@B@@@@@"],["@@B@@@B@@A@A@@@@A@@@@@@@A@@@C@@@A@@@@@@@A@@B@@BB@@@@@@B@D@"],["@@B@B@@@@A@@CEA@@@A@@B@B@@@B@@BBB@@@"],["@@B@B@@@B@B@@@@@@@@@B@@@@A@@@@A@@@A@@A@@@@A@@AA@@B@@A@@@@@@@A@@@C@@@AB@@B@@@B@DB@@"],["@@@A@@AA@AB@BA@A@@A@A@A@A@@@@BA@A@@@AB@@@B@BB@BBB@B@B@B@"],["@@B@B@@@@@BAB@B@@A@AB@AA@@@AA@ABA@C@A@@@AB@@@BBB@@BB"],["@@BBB@B@B@@A@A@AAA@AB@@A@ACC@AAAA@A@@@@B@B@@@B@B@B@B@BBF@@"],["@@B@BA@@BABABEFE@ACAC@A@CFABAB@@@B@B@B@DB@@B"],["@@B@DD@@B@B@B@B@BA@@@AAA@@A@@@A@@@@@A@@@@AA@@ACAAAGACCA@A@@@@B@@BB@@BBBB@BBBBBDB@@B@"],["@@B@B@B@@AB@@AA@AA@A@@@@@@BAB@@@@A@@BBB@BA@@BA@A@A@@A@@AIAA@@@AB@@@BA@@@E@C@ABA@@B@@@B@@B@BBD@@@BB@@@B@BBB@BB@B@"],["@@CAEAC@EBIFABC@C@A@A@A@C@ICEAAAC@AACC@@AC@A@@@@A@@A@@@@AB@@@@A@@@@@A@@@AACCCCEECAACCAAACC@@AA@@@@@@B@@@@@B@@@@@@@@@@@@AA@@@@A@@@@@@AAA@@@ABABA@A@AB@AA@AA@A@@C@@@AA@@@A@@@@AC@A@@@AB@BA@@BA@A@A@@AAA@C@A@ABA@@@@BB@BB@@@B@@@B@@@@@@EA@D@@@@@B@@@@@@@B@@@@@@@@@@AB@@AB@@AB@@A@@BAB@@@@@@@@@@@@@@@BB@@@@@@@@@@@@BA@@@AB@BA@CD@@@@A@@BAB@@AB@@A@@B@@@@DB@@B@@@@@@@@@B@@@@AB@@@@@BB@@BB@@B@BB@@@@@@@@@BA@@@@B@@@@@B@@@@BB@@@@@@@B@@@@@@@B@@@@A@@@@@A@@B@@@@@B@@@@@B@@@@@@@@@@@B@@BB@@@@@@@@@BA@@@@@@@@B@@@@@@A@@@@B@FB@@BB@B@FBJBH@@B@@AB@BA@@B@@@BA@@@@B@@A@@B@BA@@B@@AB@@AB@@@B@@A@@B@BA@@B@@A@@B@@@@AB@BA@@B@@AB@@@@@BA@@B@@@@@BA@@@@B@@A@@B@@@@@@@@AB@@@B@@A@@@@B@@@@A@@BA@ABA@AB@BA@B@BBB@@B@@B@BB@@@@@@BB@@B@@@@BB@@@@@BB@@B@@@@@@BB@@@B@@BB@@@@@@BB@@@B@@BB@BB@B@@BBBB@@@BB@@B@@@@B@BA@@B@@@@@B@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@B@@B@@@@B@B@@@@@B@B@@@B@@@@@B@@@B@B@@@@@@@B@@@@AB@B@@@B@@@@@@B@@@@B@@@@@@@@@@@B@@B@@B@@@@@@B@@B@@@@@@BB@@@@@@@@@@@BB@@@@@@@BB@@@@@@@@@@BB@@@@@@@@@@BB@@@B@@B@@@@B@@@@@BB@@@@B@@BB@@@@@@@B@@@@@@@BB@@@@B@@@@@B@@BB@@@B@@@@@B@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@B@@@@B@@B@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@BB@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@B@@B@@@@@@@@@@B@@@@@@@@@@@BB@@@@@@@@@@@@@@B@@B@@@@@@@@@@@B@@B@@@@@@B@@@B@@B@@@B@@@@@@BF@@@@@@@@@B@@B@@@@@@@@@@@@BBHB@DBB@@@@@@@B@@@@@@BBBD@@@@@BBB@@B@B@DABABB@BB@BBB@@@B@@@@B@@@@@@@@@@@@@B@@@@@@@@B@@@@B@@@@B@@@@B@@@@@@BA@@@@@@@@B@B@@A@@B@@@@@B@@@@B@@B@@@@@@@@@B@@@@@@@@@@@@@@BB@@@@@@@@@B@@@@B@@@@B@@@@@@@@@B@@@@B@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@B@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@B@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@ABA@@@A@C@ABA@@@@@CAC@AB@B@@@B@@CBC@CBA@A@AB@@@BBBBFDBBB@@B@@B@BD@BB@@@B@@BBBBBB@B@@@D@@BBB@@B@BB@@@DDB@BB@B@@@BBBB@@@@B@@@@@BB@@@@@@@@@@@@@B@@@@@@B@@B@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@BB@@@@@@B@@@@@@@@@B@@@@@@@B@BBB@@D@BB@@B@FBB@BB@@B@B@@ABBBB@@@@B@B@B@HB@@B@B@BAB@BBBAB@@@B@@@B@@@BB@AD@B@@@@ADB@@BBB@BAB@B@@@B@@@B@B@@@@@B@@AB@B@B@BBD@B@D@B@B@@@BB@BB@BBB@@BBB@@@B@BFD@@@BBBB@@@@BABAB@@@B@@@BB@@BDD@B@BBBBB@@@BBB@@@@@BBB@B@@B@@B@B@@BB@BB@A@BDA@@BA@A@@B@B@B@B@D@@@BA@@BBBBBABAD@@A@ABCB@B@D@BAB@BED@BA@@@@BAHF@BHG@@@@F@DDCDBD@@@FABAB@B@B@@@B@@@B@@BBBD@@@@BD@DAB@BAB@D@@@DAH@TB@@DADABA@@BAFA@@B@B@@B@@@N@B@D@B@@@@@B@@@BB@@B@@@@B@@@@@BB@@BD@@BBBB@@@@BAB@@AB@BAB@B@BBB@BB@@@@@A@A@@AA@@@ABCAAA@@@@@@A@ABC@@@A@A@C@@@A@@BCDA@AF@B@B@@@B@B@B@B@D@N@B@B@@B@@BBDAB@BA@KBCDA@@BADCBE@C@EACACAACCEE@@CCCAACAA@C@A@ABCBADEBAB@@@D@D@FBFBBBDDDBDBB@DAH@DABABA@CAQAEBC@CBAFI@A@A@AKKEE@A@C@ABADCFCBABA@CJ]DOBG@A@CAA@ECCAA@C@ABCBC@C@O@CBCBE@CBAB@RC@AB@@ABAAA@AAAA@EAE@C@A@@AAA@ABAJCD@BCAEAKBCB@D@JDD@D@DABCBE@CACEECCCAMCI@IAGBKBSJE@AAMG]OOEKAEACBIDKHEBC@AACACAACAA@E@CCEECECCAEAE@E@K@EBCBABAB@BBBBD@B@D@BA@@BABA@CBCA@AAC@C@AAAAA@@AAA@C@A@IBGBC@CBAFA@A@A@ACA@@AAABAHIBC@A@AACACAAAAA@A@A@A@CB@@A@@@A@@CA@A@AAA@ABIDC@A@C@A@AACAC@CAO@EAIA"],["@@C@A@ABA@@BBJ@BBDB@B@B@BABC@C@A@CAAAA"],["@@C@ABEDCBABAD@D@BDJBDBBB@B@BA@ABE@EBCBABC@A@C@AAA"]],encodeOffsets:[[[123629,37097]],[[123483,36945]],[[123847,37042]],[[123645,37082]],[[123474,36962]],[[123378,36827]],[[123644,37084]],[[123360,36933]],[[123483,36862]],[[123516,36968]],[[123854,37043]],[[123617,37147]],[[123634,37100]],[[123858,37051]],[[123388,36827]],[[123720,37107]],[[124303,37144]],[[123783,36758]],[[123482,36966]],[[123395,36955]],[[123623,37150]],[[123677,37092]]]}},{type:"Feature",id:"370214",properties:{name:"城阳区",cp:[120.396256,36.307559],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@A@@@@AA@@A@@@@B@@@@@B@@@@@BB@@A@@B@@@"],["@@OGECAGBODIBCBAD@F@DALEBCBABK@CCECAGB]@CAAAA@ACCEA@A@C@ABAB@D@BAB@@ABCAACCCC@G@EBEDAF@DBDFFBDADCBC@K@GBA@G@ECAAEIEEEAE@EBCDE^AHEDIBIAEAKAOCCAOEECECAACCCCGGCC]QICCCEEMPCDCBIDCBC@ABAB@B@@@B@BDD@BD@BBB@D@FAD@B@B@BB@@@BBB@BAB@DCDCBCBA@CACAC@A@C@@@C@@BABAB@B@DBBBBDBHBB@BB@B@@@D@BAB@BA@C@CAEAC@A@EDADCD@B@B@BB@@@B@B@BAB@DAB@@@@B@@@B@@@@@B@B@B@B@@@B@B@@@B@@@B@@AB@B@B@@@B@@@@@@@BA@@@@AA@@@@B@@@B@@AB@@@B@@@B@B@@@@@@@B@@A@@@@@@@@B@@@@@B@@AB@@@@@@@B@@@B@@AD@B@@@B@BB@BBBBB@B@@B@@@@@B@@@B@B@@@@BB@@@@@B@@@@@@B@@@@@@@B@@@@@@@@A@@@@@@@A@@@@A@@A@@@@@A@@@@@A@@@@BA@ADAB@BABABBBBBB@B@D@BABB@B@@@B@@@@@@@@@@B@@@@@B@@@B@@@@@B@@@@@@A@@@@@@@@@A@@@A@@@@@@@AB@@@@@@@@BB@@B@B@@@@@@@@@@@@B@@@@@@@@A@A@@@@A@@A@A@A@EFABB@B@B@@BB@@@@@@@@@@B@@@@@B@@@@B@@@@B@@B@B@B@BBB@B@DB@@@@D@B@@@@@BB@@@@B@@A@@@@B@BBB@@@@@BBB@B@@@BBB@BBB@AD@@@@BBB@B@B@@B@@@@@@@BA@@B@@@@@@@@BBB@B@B@D@@@@@@B@@@@@@BB@@@@@@@B@@A@@@@@@@@B@@@@@@@@@B@@@@BAB@@@B@@@B@F@@@FBD@B@@@B@@@@@@B@@@@@BAB@B@@@B@@B@@@B@@BB@@@B@@@BBB@@@@BB@B@D@B@@B@@B@@BB@@BB@@B@@BBBB@@@BA@@B@@@B@@@B@@@BA@@BB@BBD@B@BBB@@@@B@@@@@B@@@@B@B@BBB@B@@@BBB@@@@@@B@BAB@@@@@B@@@@@@BBB@@@BBB@@B@B@@@B@BD@@@D@@@B@B@@@@BAD@@@B@@@@B@@B@@@@B@@@B@B@BBB@@@AF@@B@@@@@@B@D@B@@B@@@@@F@@@B@@@DA@@@@@@BB@B@B@B@FAD@DBBBBBBBH@@BB@B@BDBBDBBBB@BABAD@BAB@B@B@D@B@D@@@D@D@B@DAB@B@@@B@@@@BD@BA@@BABA@AB@@@B@BD@@@@@BBBBBBD@DBB@@B@M@AF@@@DAFAB@HAFAFAB@@@@@@B@D@@@@@@@@D@B@B@@A@@@@B@D@B@@@@BB@@A@C@@@A@@@@B@@A@@@CBC@A@@B@@@BA@@@A@@@@AA@@@@A@@@@A@@@A@ABA@@@A@@@CB@@C@AA@@@@@A@A@A@@@C@A@@@@@D@BAB@D@@@@A@AB@B@@@BB@@@A@CB@@A@@BE@@@@@@@@@@@A@@B@LB@@B@@@@@@@B@@CDA@@B@@@@@@@H@@@BBD@@A@A@A@A@@B@BA@@BIF@@A@@@@BAD@AAB@B@@@@@BA@A@@@A@@@AB@BA@A@@A@BA@@B@B@@@B@@@BA@@@@B@@AD@@B@B@@@@BA@@@@@AD@BAB@DDDAB@@@BDA@A@@@@@A@ABD@@@A@A@@BA@@@A@@A@@@D@@AF@@B@B@@@@BBB@@@@@A@A@@@@F@@@HA@B@B@@@@D@@@@@@@@@@B@@D@@AB@B@B@@@@BB@BA@BB@@B@@B@@@AC@@@@@@B@B@@@D@@A@@FA@@B@B@B@@@B@@@@@@@@@@@@@D@@@@@@@@@@@@@B@@@@@@@@@@@BA@@BB@@H@@O@@@@@@@@@@@@@@@A@@@A@A@@@C@@@@BADCDCB@@@FE@@B@B@B@D@@@@@B@BAB@@A@AAG@@B@@ABC@ABA@@B@D@B@D@DB@B@@@B@@A@@@@@@@@B@BAB@DB@@BA@@BD@BA@@B@@@@@B@@@BA@@AAB@BA@@@@@B@@BB@@@@B@@@B@@@@@BBBDBB@@@@BB@@BF@BBBB@D@@@B@@@DADAB@BBB@BBBBDB@BBBDDBB@@@@@@BBDF@BBBBBBF@@@FBB@@ABC@A@@B@@@@A@A@A@@D@@@@@@@@@@CB@AA@A@@@AB@@@@@A@@AB@@@D@@@@@BA@@@@B@BBB@A@@B@@B@@@BA@BB@@@@@B@@BB@@@B@@@@@B@@B@BABBB@B@@@@B@@@@D@@CFCB@BA@@A@BC@C@@BAA@@CB@@@@@@AB@@@@A@@@@@@@@FBB@D@B@BC@AAC@CBA@@@A@AB@@@B@@@@@@@@@@@@@HDB@@@@ABB@@@@@@@@@@@@@@@AD@@@@@DA@@DAD@@ABAA@B@DA@AD@ACD@@@B@@@B@@BBA@BB@@@@@B@@@B@@@@@B@@A@@@@B@@@FFBCB@@@BBB@@BBB@@@@@@DABB@BBB@@B@BB@@B@BA@B@@BD@B@@B@@@@@@@@A@@A@@A@AB@@@@@@B@@B@@@AA@@@AB@@CBB@@@@@@@B@@@@@@@B@@B@B@AC@@@@B@@@B@@B@@B@@A@@B@@@@@@@AA@@AB@@@@@AA@@A@@B@@@BB@@@A@@BA@A@@@BA@@A@@@AB@B@@@AA@@@A@@B@AA@@@@@@ACAA@A@AB@@@BA@@@@@A@@B@BBB@B@B@@@BA@@BA@@@AH@@@@@@@@@B@@@B@@@@@B@B@@@B@@@@@@B@@@@A@@@@B@@@B@@@@D@@@@@@@B@@@@@BB@@@@@@@B@@@@@@B@@@@A@@@@B@B@@@B@B@B@@@B@@@B@@@@@B@B@BA@@@ABBB@B@@@@BB@D@@@D@@@B@BDB@@B@@CBC@@@@@@BB@@B@B@@@@BBB@@@B@B@B@@A@@@A@A@@B@@@@@@BB@B@@B@@@AB@B@B@@B@@@@A@@B@@ABB@@BB@@@B@B@D@B@BABB@@B@@@@A@@@A@@@A@@@AB@B@@A@@@@@@BAB@BA@A@@@A@A@@@A@AB@@AD@BADAD@B@@@BB@@B@B@BA@@@@@@@@B@B@@@@@@@@@@@@B@@@B@@BB@@@@@@B@BBB@@B@BA@CA@@@@@@@@A@B@@@@@@BB@@BA@@@@D@@@B@@@@@@@BA@@@@@AB@@@@@B@@AB@@B@@B@@DDFCFA@AB@FAFA@C@A@@@AB@@@B@BADA@AB@@@BB@C@E@@H@AGE@BG@A@@B@@AFC@ABA@A@C@ADABAB@@@BCBAAAAA@AB@@A@@@C@A@A@A@AB@B@@AB@ACB@A@@AAA@@@A@AA@@@@AAA@A@@@@AA@A@@AAAA@A@ACC@AA@@A@@@A@@BABA@A@@A@AA@A@@EC@A@A@@AA@AA@AAA@@AAA@@A@A@C@A@C@AAA@A@A@@BA@@@@@A@A@@@A@@@A@A@ABA@AA@@CA@B@@A@C@@BAA@@A@@@A@@@A@ABAAA@ABA@A@@@GAA@A@A@@@@@AAAA@BA@A@@@AAA@EAA@@@AAC@@@AA@A@A@@@@@@@A@@@@@@@@@A@@@@A@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@A@@@@A@@@@A@@@@@@@@@@@@@A@@A@@@@@A@@A@AA@A@@@AAAA@CC@@A@@A@AA@AA@@@C@@@AAAAAAA@@@A@@AAC@@A@AA@@@AACAAEAA@A@@BA@@AA@@EA@@@AAAA@@AA@@AA@CCEEAAEA@@AAA@AAA@A@ABA@@@A@A@@AAAA@C@A@A@AB@@@B@@@B@@A@@BCBA@ABCB@@@@@@@@CB@@@@AB@@A@@@C@@@@@AAACIA@@@@@@@@A@@@@@A@@@CAG@@BAD@@@B@BAB@B@@BDBBB@BBBBAB@@A@@@@@@B@BA@B@@@@B@@@@@@AAA@A@A@A@@B@@@@@@@B@@A@@@@BA@@B@@@@@BCA@@@B@BA@@B@@A@@@A@@B@@@@A@@@@@@@@@@B@B@B@@C@@@A@@@@@@@A@@B@@@B@@@@@@E@@@A@@@@@A@@@@A@@A@@B@@A@A@CA@@C@AB@@C@@@@@@@BBA@A@@@@@@@@BA@@ACA@FC@@@@@A@@B@D@@A@@B@@CBC@@@A@@A@@@@@@A@@@@@@@@@A@A@C@@@E@@@@@@@AA@@@@@@A@@@@@AA@@@A@@A@AAA@@@@@@@@ABA@@B@@@@@@A@@@A@@@A@@A@A@@@A@@@@A@@@@@@A@@AA@A@@@@@@AB@@@@@@AB@@@AAA@@@A@@@A@@@A@AA@@A@A@A@@@A@A@AB@@A@@@@A@@IBK_GFCFCFIJGHEHCDC@A@[E@@"]],encodeOffsets:[[[123208,37056]],[[123193,37099]]]}},{type:"Feature",id:"370281",properties:{name:"胶州市",cp:[120.033382,36.26468],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@@@BFBF@H@ACF@J@PFDB@DD@DAB@B@@A@@@CB@BBDBB@HADAB@D@B@ADBDB@@D@@ADFAB@BCHAAB@@@B@@DJHDBAD@DB@@@D@D@B@@IFBFEF@BABAFBDBFB@BDDH@BABABEAG@CFCDAFCJ@@@BAH@@ABA@A@AFDHHHF@DD@BB@B@@B@@@B@B@@@B@@@B@@AF@DAD@@@B@@B@@BB@B@D@B@@@B@B@B@@BB@BB@@BB@@@B@@@B@@@@@@@B@@@B@@@B@@@B@@BB@@B@@BB@@B@@@B@B@@@@@@@@@B@@@B@@@D@B@B@B@@@BBBB@@B@@BBB@@BB@@BBB@@BB@@BBFFFDB@BBBDB@@BBB@@@BB@@B@@@D@@BB@B@@B@BBB@@@B@B@@@BAB@B@@@B@B@BD@@@B@@@B@B@@@B@@BB@@BBB@@@BBB@B@@@BBB@@@BBB@B@@@BBB@@@B@B@BB@@B@B@@BB@B@B@@B@@JBDB@BAD@D@@@BBB@B@BBB@BBB@@BB@@BB@@BB@B@@@B@@@B@@@B@D@B@BB@@B@@BB@@@BAB@@AB@@AB@B@@@B@@@BB@@B@B@@BB@@@B@BBB@B@@@B@BB@@B@B@D@BADABNF@DAF@B@@FBEJBBBBFBB@RBD@BAJBB@F@DCFABC@@NFFBAHABA@@@AH@DCBEDC@EF@@@BA@@BA@A@@@C@AB@@@DAD@@AH@BBB@D@HB@@@@@@@F@@@@@D@FB@DB@@BB@@B@@@B@@@B@B@@BB@@B@B@B@@BB@@BBBAD@B@B@B@@B@BBDD@@BDBB@@@BBBB@@@@B@B@BB@BA@A@@BAB@@@B@@@BBB@BB@@@@BB@B@@@@@@B@@@B@B@@@D@B@@@B@B@BB@@B@B@@BB@FBH@P@N@F@BA@CFAJBF@BB@DAFB@B@B@D@B@BBAF@J@@B@BBB@B@@@@B@@@BA@@@A@ABBB@@@BA@@@A@AAABCBA@@B@@CNBDB@BB@@@BBB@@DBDBB@BBBBEB@@A@@@@A@AA@G@@@@@@@ABAHBF@DBB@B@BB@AB@@@@B@@@B@BAHEJEBAB@BADCFC@@DABABC@@@@@@@@B@@@@B@@@@@@BAB@@@@@@@@@BB@@@@BB@@@@BB@@@@@@B@@@@@@@B@@@@@@BAB@BA@B@BBDBBB@@BC@A@@B@@@B@B@@@@@@@@@BA@A@@@@B@@@@@@AB@@@BA@@@@@@@@BA@@@@DAB@@A@@@A@@B@@@B@@A@A@A@@@@B@F@D@@@@@B@@@@@BA@@@B@B@B@B@@B@@@@@B@B@@@@B@B@B@@@@@@BB@@B@B@@@BBB@B@@@B@F@DBB@@@@D@@@@@@@BB@B@@@@@B@ABD@@@@AB@@@B@B@B@BA@@@@DAD@D@B@B@@@B@DAB@@@B@@@B@B@@@@@@@@AF@B@@@B@@@@@BA@B@@@@@B@@B@@@@@@@@BBB@@B@@A@A@@@A@@B@@@@@@@@@B@@@@@@@@@@@B@@B@@@A@A@@B@@@B@B@@@@@@B@@B@@@@B@@@DB@@@@BB@DBB@BBB@@@B@BB@B@@B@@@B@D@B@@@@@@@@CAA@A@@@A@@@@D@D@J@B@@@@@@A@A@AA@@@B@B@H@LA@@D@B@@@@@B@B@@@@@DAB@B@@@@@@@B@B@@@@@@@BA@@@@B@@@@A@AB@B@B@@@@@@@@A@@B@D@@A@@@@@ABCB@@@@A@CC@CBEBCBC@C@ACAC@A@E@G@AAAAACAGA@@A@MCCAACAA@E@@@G@E@@B@DAD@DA@@B@@@@@B@B@D@@@B@B@D@B@B@B@B@B@B@B@B@@@@@BA@@B@@@@@@A@E@ABA@@D@B@@MA@@@A@A@@AG@@@@@@A@AA@@AA@@@@AC@@@CA@A@@A@AA@@B@@A@@BA@@@A@ABG@AEGAA@AA@AABABC@EBABGDI@A@@@@BA@@FCB@B@BAD@@@@A@@B@@@@@@@@BB@@@@@@@B@@A@@@@B@@D@@D@@A@@@A@AD@B@BAB@@@@@B@@B@@B@@A@@@@@A@ALAFA@AB@@A@@BA@@A@A@@A@@@@A@A@@@@@@AB@@AB@B@@AHAF@@@BA@@B@@@B@B@@@B@B@@A@ABC@@DAB@L@B@BA@@FCFAB@@AAA@@AGAAAAAA@CBC@E@A@A@AAA@@@@@@CB@@A@@@E@@@@@A@@@@A@C@A@@@@A@@@BE@@A@AAA@A@@@A@@@@@@AA@@@@@@A@@BC@A@@A@A@@@C@@@C@@A@A@@@A@AA@AA@@A@AA@@@@@@@A@@@@BA@A@A@@@@A@AA@@A@A@AAA@A@@@@@@A@@@@@A@@A@AAA@C@AAA@@AB@@A@@@A@@@A@@@AB@@A@@AAAA@@@AA@@AA@@AA@@@@AA@C@A@A@@A@@A@AA@@A@@@A@@AA@@@A@@@@A@@@ABA@A@@@@@A@@@@A@@@A@C@EA@@E@A@@@A@@@A@AB@@@@@A@@@@@@@@@A@@@@@@B@@@@A@@@@@@AA@@@@@@@A@@@@C@A@A@A@AA@@@@@@@@@AB@@A@@@@@@@AA@A@A@AA@@@@BCA@AAA@AA@@A@A@AA@@@@A@AAA@@@@@@BA@@@@@AA@@@@A@C@@@@@CAA@A@AAA@A@A@@@@A@@A@@@@@@A@@@@@A@@@@@@@@A@@AA@A@A@BAFEB@B@B@@@@B@@B@B@@@@@@@@@@A@@@@@@@@@@A@A@@@AA@@@@@@@@BA@@@@@@B@@@B@@@@@@@@@B@@@@@@@@A@@@@@A@@@A@@@@@A@@@@@@@@A@@@A@A@BA@A@C@AAAAAAAABABA@CB@BAB@@@@@B@@@@@B@@@@@BB@@@@@@B@@@@@@@B@@@@@@A@@@@@@@A@@@@@@@@A@@@@AA@@@@@A@A@@@A@@@@@AA@A@AAAAA@@A@A@@@ABC@@@A@@@A@@@@@@BA@@@A@@@@@A@@@@@@B@@@@A@@@@@@@A@A@@@A@@BA@@@A@@@A@@B@@B@@B@@A@@@@@@@A@@@A@ABA@@@A@@@A@@@A@A@@@A@A@A@A@@@@@A@@@A@@A@CBA@ABA@A@@@A@@A@A@ADCBCFCB@D@FBDBD@B@@ABA@A@C@@@AAAA@GACAAAAA@C@ABABA@AD@@@D@B@D@DBDBB@DADADC@CBA@AAA@A@@AAA@A@C@EBC@A@AAC@@ACC@A@A@@@ABABAD@DAJCDADCNOD}KQOIWAOAGBC@E@C@CBA@GB@@@@AB@@C@@B@@EDA@@A@@CBABABABA@CBABA@CBA@@@A@C@@@A@AA@@A@CCA@A@A@A@AA@@ABA@GFABC@C@ABE@CAC@CACAAAC@C@A@A@ABA@AB@@AB@@AB@BCB@@ABA@C@ABA@CAC@AAA@AA@@@A@@@A@A@A@@AA@A@A@A@@@AAA@@BA@A@A@@B@B@@@B@@@@@@@B@B@@@@A@A@C@A@A@@@@@AEBAA@@@@@@@A@@@AA@@@@A@@@@@A@@@ABC@E@@@@@@@@AB@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@ABAAGCA@H@FC@@@@B@@@@@@@@@@AB@@A@@@@@@@C@@@@BC@A@BB@@@@@@CAA@AAA@@F@@@@A@CBC@AA@@@@@A@AA@@@@@ACA@@@A@AA@@@CBEA@CA@@CC@A@CDKAC@BDABABA@ADGBCA@AFCBEB@BE@@BAA@@@@ABG@@C@A@BC@AA@@CB@ACAAA@GGC@CDCH@BAFEAGCOCG@C@KGA@AA@AC@AAEAG@C@GB@@E@CAOCAGAAGB@BCBMEAAABGC@EMDA@@@A@CA@AB@AACA@@ACA@EA@@ADBBAD@@@BCBC@CBEF@FCBCBG@KAA@ACMGEAA@C@ADKEGAA@@@ABC@AAEG@C@AF@BDB@EEFCAACE@AAGCAICBCEAGBEBGBAAC@CDCDEABACB@BA@EECEAC@GA@@ABCCKOAADCBIACA@@AF@B@B@BGBAFA@A@@@G@GCG@ADBB@D@BC@C@AACBCFAF@D@@ADABCAAF@A@@@BI@EA@DADEB@D@@@@@@@B@@A@@BABABA@ABA@@BAA@BA@BB@BBB@B@@ABA@ABA@@@A@A@A@@@A@A@A@A@@@AA@AAAACA@AB@B@@BB@@@BB@ABACODGDQNCBAE@GI@@@C@@BD@@B@@@@@@E@@BDB@BEAGBABBDG@O@ABABC@K@C@E@@BAF@@@BBBALAB@@B@@BDBCJ@F@BBDDBNBB@@BJBHDJDAHFBAFC@CDAF@B@D@BEDCDADAFABA@@DDDB@@ABAFB@BA@@F@D@BBBDJ@BD@@BDBBD@@@NB@@AD@APB@JB@DBDFD@FA@@@@HB@DFB@F@LD@B@BBD@AFGDADAFA@@FBB@B@BBBBD@FEB@B@FAF@B@B@@BB@BBB@@AB@B@@AB@B@@@B@B@B@@@B@BA@@BA@@BBB@@@B@B@@@B@BAB@@A@@@E@EBC@ABAB@BAB@BAJBJEF@B@D@LF@@DFFB@@"],encodeOffsets:[[122640,37034]]}},{type:"Feature",id:"370282",properties:{name:"即墨市",cp:[120.447158,36.389408],childNum:12},geometry:{type:"MultiPolygon",coordinates:[["@@@@B@B@@@@@BA@A@@A@@A@@@@@BA@@@@B@@@@A@@@@B"],["@@@@A@@@@@@@@@AB@@@@@@@B@@@@B@B@@@@@@@B@@A@@@@@@@A@@A@@@"],["@@@@B@@@@@@@@@@A@@@@B@@@@A@@@@@AA@@@@@A@@@@BAB@@@@@@A@@@@B@@@@@B@@@@B@@@@@@@B@@A@@@@"],["@@A@A@@@@@@@@@@BB@B@@@B@B@@@B@B@@@BA@A@@A@@AA@@@A@AB@@A@@B"],["@@@@@@B@B@@ABA@A@@@AA@AA@@AA@AA@@@A@AB@B@@@@BB@@@@BB@B@BBB"],["@@BABAB@BAB@@A@A@AA@A@A@A@AB@BABABA@A@@B@BB@B@B@B@"],["@@@A@@@A@@@ABA@@@A@A@@@AB@@A@@AAA@@@E@A@@B@BAB@B@BBB@@@B@B@BB@@@B@B@B@"],["@@BAB@BCBCAA@AAA@@A@C@C@@@AB@@BD@D@BBBDB"],["@@BBB@BBDAB@DABCBA@AAAAAA@A@A@@BA@@@A@A@A@AB@B@B@B@B"],["@@B@@@B@BA@@B@B@B@@ABA@@@@@AAAA@A@AB@@A@A@C@AACAA@EAA@ABA@@BB@@B@@@B@B@B@@@BB@@BB@BA@@B@B@BAD@BBB@"],["@@D@FAJCDALMB@BADBB@D@BA@C@CAAAAC@C@EDEDC@E@ECA@C@E@A@CACAA@IBC@E@C@ABCD@D@BBBD@BBBBBDD@H@JADBB@@BAD@D@BD@"],["@@FA@AB@@AAA@@AAA@A@A@ABAA@@@C@AAA@AA@@@A@A@EDA@MDIBEBI@C@C@GAKCE@E@C@C@CDCBE@A@GAE@CBA@AB@BEDA@A@CAMS@@@AA@A@M@C@A@A@A@A@@@A@A@E@@BCBAD@@@B@@@D@B@B@@AD@B@B@@@@B@BBAD@B@@BB@@@B@B@@@@AAA@AAA@A@ABA@@BA@AB@@@@AAAA@@AC@@AA@@@@A@@@@@@AA@@A@@@A@@@@@A@C@A@M@@@AA@A@@@EBAB@@ABCBCB@@SAG@CB@@C@A@ABA@CBC@@A@@C@AA@AA@@@A@@@A@A@A@ABEB@@C@CACDAA@@A@@BCBABA@@@A@@B@@@B@DEBEBA@@BEBEDCC@@@AA@@@BA@@@A@@@@BA@@@@B@@A@@@@@@@A@@@C@@B@@AA@@A@@@@A@B@@@@@@@@@DBB@@A@AA@AAA@@@@@@@AA@@@A@@@A@@@@@@@@@@A@A@@@@@@@@@ABA@A@@@AA@@A@C@CBABC@@BA@@B@B@@@B@B@@@BABA@AB@@@@@@@BA@A@@B@@@B@@@B@@@B@@A@@@AAABA@C@A@A@@@A@@AA@BA@@@AB@@@@@@AA@A@A@@B@@@AA@A@@A@@@@A@@@@B@B@@@BA@A@A@@@A@AA@@@@@A@AA@@A@@@@D@DA@@@AA@ACA@@@C@@@C@A@@A@@A@A@AA@B@@ABA@A@@@@@A@@@A@@@A@A@A@@@A@A@@@@@@B@@A@@@@@@@@A@@@@@@AA@@@@A@@@@@@@C@@@@@@A@@@A@@B@@@@@@A@@@@A@@@A@A@@@@@A@@@A@@@@@@@@@G@@B@@AB@@AB@@A@A@A@AAA@@@@B@@@@AB@@A@@B@BBBBD@@@@@@BBA@@@@B@@BB@@A@A@@B@@@BB@@A@@@BAB@@@B@@AA@@A@@@@BB@@B@@@@BA@@BB@@@@@@A@@@@BA@@@@AA@@@A@@@@@BDA@A@@@@A@@@@@@@A@@@@@@AA@DA@@B@@BB@@A@@@@A@@@@A@@B@BB@@@@B@@@@@@A@@@@AAC@@@AABA@@@AAA@@@AA@AAACB@@@@@@AA@AA@AA@@A@ADEE@@A@@@@@@BA@@@@@A@@@A@@@@@A@@AAB@AA@@@A@@@C@BDC@@BCBA@B@AB@BC@CB@@CB@@@@C@@B@@@@@@@@@@@@@@AA@B@@A@GC@@@@@@@@@@@@A@@@A@@B@B@@AB@DBD@BADA@C@A@EA@@@@@@@@@B@@A@@B@@@@A@@DB@AB@@@DADB@@@ABA@ED@DC@@@@@@A@@A@A@AAABA@@@@A@@@@@A@@AA@@@A@@@@AAB@@A@@@AA@@@@BAA@A@A@@B@@A@@@@@C@@BA@@@B@@@@BA@@B@B@@BDA@@@@@@@@@@@CB@B@B@@@@@@AB@D@BA@@AA@E@@AEAAAA@ACEAA@@@@@@AACCAA@ACAAAAAA@AAA@CBCB@@A@@@C@A@AA@AAE@@AA@@@@AAACAA@@@@A@@@A@@@@@AA@@@A@@@@ABA@BB@@AB@@A@@@@@A@@@ABC@@AB@@AA@@CBA@A@A@@@@@@B@@@@A@@@ACAC@A@C@A@@@AB@BAD@BA@@@BH@B@BA@ABA@@@@@C@A@A@A@@@EF@@A@CDCDAB@@@@@D@@@B@B@@@B@@@@@@@@@@@@@@@PG@@@AA@@AB@@@@@@@@@@A@@@@@@@@@@@@@C@@@@@@@@@@@@@A@@@A@A@A@@@EB@@@BC@@@A@A@@@@@@@BD@@A@@@@AA@@AABA@@A@@A@A@A@@BC@@@@A@@@@@@@@C@@@@@@A@AGB@@E@@@@@@B@B@@@@AA@A@@A@A@@@BE@@@C@@@BB@@@B@@AB@B@@@C@BAB@@@@@B@B@AC@@A@CBCCA@ABC@@B@@@@AB@@@@@A@AC@@BA@@@@@AB@@A@@@A@A@@@ABB@@@@BABA@@B@@@B@@@BAB@@@@A@A@BBC@AB@@@@@BE@AJ@@ABA@@@@B@B@B@BC@AA@@G@@@@@@@A@@@CB@DA@@@@@@@A@@@KAA@@@@B@@@@@@@@@@AF@@@BA@@D@B@@AA@@A@A@@B@B@@C@A@ABC@@@@@B@D@@@B@B@B@@@@@B@@B@DA@@D@@@B@@AB@B@B@@@B@@B@@@@@BB@@@@@B@@AB@@A@@@@BAD@D@@@BA@@@@@@B@@@D@BA@@A@@A@C@A@@@@@@BA@A@C@@@@@@@@@@C@A@@@@A@EBEBGBA@EBCB@@E@@B@N@AA@CAC@AAAAAA@@@@C@@A@A@@BAB@BA@AB@@AAC@@@@@A@@@ABA@C@A@C@C@@@C@A@C@A@ABA@ABCBA@AAAAAACCA@AA@EBED@@ABA@K@A@CB@@AD@B@BA@A@@@A@A@@@A@@@AB@@E@GB@BA@A@@BA@@B@@@@B@B@@@@@@BB@B@@@AB@@@BA@@BEBKB@B@B@@@@@BA@@@@AA@@@@@A@ABA@C@@B@B@@@BC@@@@CA@@@@@@BA@@@@@@@A@@A@@@@@@A@@@@B@@C@ABA@A@ED@@AB@@@@@BCJAHAB@FADABBBB@@BBBFH@BAH@B@B@@AB@@@BA@@@BBB@@@@BDB@@D@@B@@B@@BB@@B@B@@@@H@@BB@B@@@B@@NA@C@@@AB@B@F@B@@@@A@@@AB@@@@A@A@A@A@A@A@A@A@C@A@A@@@C@A@A@@@@@A@@@CBC@CBA@@@@F@H@@@FBBBDDBNDB@@@HBDBBBBB@B@H@F@BBDBDD@D@DAFADAD@B@D@@@DB@@BBBD@BBD@B@DABABAB@@A@A@CBA@A@@BC@@B@B@@@B@D@B@@@D@B@B@B@@AB@@GFEFAD@@ADAD@DBFDDDF@BAD@B@@AB@BEBGF@@EDCBABADCD@@@BA@@B@@@B@B@@@B@@BDBBFHDDBBBB@@BB@B@@@B@@@B@@@B@B@@BB@@@BBDBD@B@B@D@B@B@D@BB@@BB@BB@BB@@BB@@BBB@B@@B@@BB@B@@@BAB@@ABA@@B@B@BB@@BB@@BBB@@BB@B@@BB@B@BB@@B@B@@@B@B@B@@@B@B@@@B@B@@BB@B@BB@@BB@B@@BB@@BBB@@@@@B@@@BBB@@@BBB@BBB@B@@@BBB@@@BBB@@BB@@B@@@B@@@JABAD@DAFBB@D@BB@BBBB@@B@B@DFBB@@BDB@@BD@BBB@D@@@B@@@B@B@B@@@B@B@@BB@B@BB@@BB@BB@@BB@DBBBB@@BB@B@B@D@FA@@B@LCFAHIDC@AFADADAD@@@D@@@@B@@@@@B@@@@@@@B@@B@@@@B@@B@@@@@@@B@@@@@B@@@BAB@B@@A@@@@@@B@@@@@@@@@B@@@@AB@@@@@@@B@@@@@@A@@@@B@F@@B@@@@@@@B@@@@B@@@@@@@BB@@@@@@@A@@@@B@@@@@@AB@@@DAF@H@FAB@@BD@@@@@B@BB@@@@@B@@AB@@@@@@@B@@B@B@@@@B@@@@B@BAB@B@B@@@FB@B@@@@@@@@BB@@@B@@@@@B@A@@@@@@@@@@B@@@@A@@B@@@@@B@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@B@@@@@B@@@@BB@@@@@@@B@@@@@@@@A@@@@AED@@@BBB@B@B@@BB@@@B@A@@@@@@@@BB@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@B@@@@@@@@@@@@@@B@@@B@@@@@@@@@B@@BA@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@BB@@@@@@@@@@@BA@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@A@@@@@A@@@@@C@@@@@A@@@@B@@@@A@BB@FAD@D@F@D@FAD@DA@@@@@BB@@@@@B@@@@B@@@B@BBD@@@@@@@@@B@@B@AB@@BB@B@B@BF@BAB@@@B@@@B@@A@@BA@@C@AE@@B@@AAC@@ACCCB@DAB@JA@@BAD@@@@BBB@@@BB@@BB@@B@BB@@B@@BB@@BBB@@@BBB@@@D@B@@@B@B@@@B@BBB@@@BBB@@@B@@@@@B@@@@@@@@@@A@@@A@@@@@@B@@@@@@@@A@@@A@@@@H@@AB@@@@@BA@@@@@@@@A@@@@@AAFA@@@@B@@@@@@AF@JC@A@@B@@@@@B@@B@@@AB@@@@@@A@A@BBABBB@B@BB@@D@B@B@B@B@@@D@FB@@@@D@L@D@D@D@@A@@@CB@B@B@@B@DD@B@H@@@@EAE@@B@@@B@@@@@@@@A@@B@@C@@@@@@@@@AB@@@B@@@B@@@@@B@@@@@@@@B@@@@@B@@@@B@B@B@F@@@B@@@@@@A@@@@@@@@B@B@B@D@@@@@@B@@@@@B@@@B@@F@FAB@@B@@@@@@@@B@@@@@@@@B@@@@A@@@@@@@@B@@@@B@@@@@@B@B@@@B@@@@BA@@@B@@@@@B@B@@@@F@H@@B@@@@@@@B@@@@@@B@@@@@B@@@@@@@@@@@@@@@@B@@@B@@@B@@B@BB@@@@@@BB@@BB@@@@@@@@@@B@@@@@@@B@@B@B@@@@@B@@@@@@@@@@@@B@@@B@@@@@@@@BB@@@@@@B@@@@@@@@@@@@B@@@@@@AB@B@F@D@@@@@B@B@B@@@@CB@@@BAB@BA@@B@@A@@D@@C@@@@@@BB@@@@@@B@BA@A@@AA@CB@B@@@BBB@DBB@B@B@B@@@D@@@B@@A@A@@@@@C@A@@B@AAA@A@A@@AA@A@B@BAF@BA@A@ADA@@BADA@C@@@AB@@A@AB@@AB@AAC@BA@@@A@@AA@@@AA@A@AA@@AC@A@A@EB@DA@A@A@AB@B@@A@C@A@A@C@AAAD@@A@A@AB@FA@@F@B@@BB@@@@AH@D@@BB@@B@@BB@B@B@@D@@@D@@BABFBVB@@@FAH@FBBD@B@DBB@B@BD@B@@ADA@@BCB@@@B@B@@B@B@BBDABA@AB@B@@FBBD@@K@@BA@ABA@AB@@AB@BA@C@A@@B@BADB@@@BD@B@B@DBB@@@@AB@@@FC@@@A@@B@B@@CD@@@B@B@B@@BB@@BB@BD@B@BB@B@BB@@B@@@B@B@@@B@@A@AB@B@F@D@@CB@@A@E@CBA@@@A@A@C@A@@DCB@@BB@D@@@BD@@B@@@BBB@FBBBB@DAB@BBB@@BD@B@BBB@DBBB@@BB@@@@BD@BB@BAB@B@B@@BBB@BB@@@BAB@BBB@@@B@BBBAB@@@B@@BDBDB@@DDB@B@BBBBB@@D@@D@F@@@B@B@B@F@B@@DABA@@D@@A@AB@DABB@BB@B@@BB@@D@@@@@D@BDDB@@BJ@@AB@FB@D@F@FBFBD@@BH@B@BAD@DADCBAA@E@@@@C@@BABAB@BB@@@@FBCB@@@@D@B@BABAB@B@BB@@B@BABAB@DAB@B@HKBABCRDBEB@B@D@BAJGB@@C@AFE@@AA@A@A@@BABBD@B@DCJD^LVFtTPDDBB@BAJCNGHAJAN@HEJODG@EAGDENGHCLIF@PFB@@BDAFABCFCFAFAbCD@FBD@FAHCFCFCDCRSDEACECMOEECCGIECEGIOACBAHK@AACEE@@@C@ATQBADCBC@C@CCGACCCCCEACAC@CAAAAG@AAA@AACC@AAEACACBA@AFEHABA@W@CAAAAAAAC@EAC@@CAA@CBCDAJGBCBC@CBK@CBABAJGD@B@DBDBD@B@BC@A@EAAAAKGC@C@A@AB@BBBBB@B@B@@@@GFAB@@A@@A@@AAKIKICCGGEKCEAACC@@AABABAB@BABABEBCAC@AAACCEACAE@C@AACAKEGA@B@BDBDB@B@BABYHCBCBCBADCF@B@D@H@BAFEJ@DBDBBRNBBBD@H@DADCBUNCDC@IBAB@B@BBBBBBBBD@PBPAFADCDEBGBG@ÍGA@ACACBGBIBGDEBC@CCIECKAI@KBGBCAECEEAEAA@C@E@CAECAEBGCKECC@@@A@AACAAEEGECACAACACAEBE@C@C@ADE@CBABABAB@LCBADADABAFGBAB@@ADBBBBBDAHCBA@CBIBCBCBABAHGDCBC@ABE@@BC"]],encodeOffsets:[[[123735,37253]],[[123919,37297]],[[123857,37327]],[[123800,37265]],[[123836,37282]],[[123841,37287]],[[123921,37315]],[[123969,37424]],[[123756,37247]],[[123902,37368]],[[123872,37306]],[[123640,37205]]]}},{type:"Feature",id:"370283",properties:{name:"平度市",cp:[119.98842,36.776357],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@DE@@@@@A@@@A@@BA@ADC@@@@B@@@@B@@BA@@BA@@B@@@B@@@DA@@@@D@BABCB@@@BA@@BA@A@@@AB@@A@@@E@AAA@@@AA@@AA@@@CE@GAG@A@@@A@@@A@A@@@A@@AAA@AAECCAEAA@A@C@A@EB@@A@@@C@A@ACCAAC@@AC@@@C@AA@@A@@@AA@AAA@@@ODEBKDA@@@EBC@A@A@A@@AA@AACAA@@AA@@AAA@@AAA@A@@AA@A@@@A@A@A@@@A@@@C@A@AAC@@AA@AC@@AACEA@A@@@AAAAA@@A@CAABE@CBCBA@I@@@A@@@AA@@AA@AA@@A@AA@@A@A@AAA@AA@@A@AA@@A@@@@@A@AA@@AA@@@AAA@@AAA@A@@AA@A@@@A@A@@@A@A@A@@@A@A@@@AAA@A@@AA@A@@AA@AA@@AA@@AAA@A@@@AB@BA@AB@@A@A@@AA@@@@AAA@AA@@AA@@AAAA@@AA@@A@C@A@A@C@A@AACAC@A@@AA@@@A@A@@@A@@@A@@@AAA@@AAAACCEGAAAC@@@A@@@A@A@@@AB@@A@@DCBCBADAFC@@HEFA@ABA@@@ABC@ACECCAE@CBCBC@@BCFEHE@@BA@@@A@A@A@C@@@A@C@A@@@A@AD@@AB@B@DAB@B@@@BABABA@C@AAC@AACAA@@CA@@C@A@@D@B@@A@AD@B@@@@@BC@A@@@@B@@@@@@A@A@A@@B@B@@A@@@@@AB@@@@@@A@A@@@@@@@A@A@CB@@@@A@A@@@@@A@C@@@KBG@A@A@@@B@@B@B@B@@@@A@I@C@C@@@@@@B@@@BBB@D@@@@@@A@C@A@@@A@@@@AAAA@@@A@AAA@CAA@@A@@A@@C@@@A@@A@@@@A@@@@A@A@@@A@@@@B@B@@@AA@@@@@@@@@@@A@@@@@@@@@A@@@@B@@@B@BA@@@AA@A@@@@@@A@@@@A@@@@@AAB@@@@A@@@A@E@@B@@@@@@A@A@@@A@@@A@CBA@@@A@A@C@C@CB@@@@ABA@A@A@@@A@@B@@C@BAA@@@@@A@A@@A@@@@@@@C@@A@CAE@A@@@A@A@AA@@A@A@@@AA@@@@@@@A@A@A@@A@A@@@@@A@@@@A@A@A@A@@AB@@@@A@@@@@C@E@A@@@@@@B@B@BA@@@A@@@@B@@@BA@CB@@@@AB@@@@@@@@AB@@A@@B@@@@A@@@@@@BAB@@@@@@@@A@A@@@A@@@@BAD@@AACAAAA@B@@ABA@A@@@@A@@@@@@@A@@@@@@@AA@@@@AA@@@@AA@@@@@@@@A@AB@@@@@@@A@@A@@@@@@@@@ADABCB@@EDCDABA@ABIFGFAB@@@@@@@@@B@B@@@@BB@D@BBBB@@B@BA@@DG@@@A@@AC@@@E@@GAACBABGBGDCBABA@CBABEBCBCDAHC@@DC@S@A@A@@DIA@@@EA@A@A@GCAAA@@@A@@AA@AA@@AAA@@AA@A@@AA@AA@@@A@@AA@ABC@@@K@C@@BC@@E@QAA@A@AH@B@@@JAF@B@NAF@D@BAB@BCD@@C@GBGBG@EBC@A@@@C@A@CBC@ABIBC@@@EBA@A@A@A@A@CBC@A@C@ABC@A@A@A@CBC@A@A@C@ABA@A@A@A@A@CBA@@@A@A@A@CBA@A@C@ABC@A@@@CBC@A@CBA@@@A@CB@@@@A@@@CBC@ABA@ABA@A@CB@@A@ABA@A@@BA@A@CBABA@A@ABA@ABA@@@A@A@CBA@A@CBA@@@@@A@C@@@ABA@ABA@AB@@A@ABA@@@A@A@@@A@@@A@@@@BAB@@@@@@A@@@ABC@ABA@@@@@@@@@BB@@@@@B@@@@A@@@@@A@@@A@A@AAA@@B@@@@@@@@BB@@@@@@A@@@A@@AA@@@A@@B@@@@@B@@@@A@A@@@@@A@@@@@AB@@AB@@A@@@A@@B@@A@@B@@ABCBG@@AGA@A@A@A@@GAC@AABAIAA@ANDJBF@FBBD@ABCB@@@BA@@@@@@@AB@@@@@@A@AA@@@@@@@@A@@@@@@B@@@@@@A@@B@@ABA@@@@@@@CD@@A@A@A@@@@@@@AB@@@@@B@@@@@B@@AB@@@@@@@BA@A@@BA@@@@@AA@@A@@@@BA@@@@@AB@@@@A@@@@@@@A@A@@@@@@@A@@@A@@@@@@B@@B@@B@@@@@B@@A@@@AB@BA@@B@@@@@@@@@@AB@@@@A@@@ABA@@@@@@@AB@@@@@B@@@@A@@@@@@@@@A@@A@@@@@@A@@@@@@BA@@@@@A@@@A@@@A@A@@@A@@@@@@@A@@@A@@@@BA@@@@@A@@@@@A@@@@@A@@@@@@@@@AB@BAB@@@@C@@@@@A@@@@B@@@@AB@@@@@@@@A@@@@@@@@@A@@B@BAB@@@@@@@@AB@@A@@BA@@@@@@A@@A@@A@@A@@@A@@@CD@@A@@B@@A@@B@@@@@BAB@@A@@@@@@@A@A@@@AB@@@@@@@@A@@@@B@@@@@@@B@@@@C@A@@@@A@@A@@@@@@@@@@A@@@@AA@@@@@@@@@@A@@@@B@@A@@B@B@@@@ABCB@@ABABABABA@AB@@ABA@A@A@A@A@@@@B@@@@@B@@@BA@@BA@A@A@@B@@A@A@@@AB@@A@@@ABA@A@@BA@@BA@ADA@@@A@@B@@@@@BB@@B@@@BABADA@@B@BA@@BAB@@@B@@@BAB@B@@AB@@@@@B@B@@@@AB@BA@@B@@@BB@@B@@AB@@@BA@@@A@@@A@C@@@@BA@@@@B@@BB@@AB@@@BA@@@AB@@A@@B@@@B@@@B@@@B@B@@@BA@ABA@@@A@@BA@BB@@@@@B@@AB@@AB@@@@AB@@@@@@@@@B@B@D@@AB@@@BA@ABA@CBABCBAB@@@B@@@B@B@@@B@@ABC@@BAB@@@B@@@BAB@@@DA@AB@@@@@B@BAB@BA@@@A@@@ABA@A@A@@BA@A@A@AAA@A@@@A@@@ABAB@BABAB@BA@ADA@@@A@ABA@A@C@A@CB@@A@CB@@ABADADEFCHABADEFAD@F@B@BABAPAHAD@D@@@L@D@BBDBHDFBDBBFDAB@@AB@@@DBB@@@B@BA@@BB@B@@@BB@B@@@BA@AB@@BB@@B@B@@@B@BAB@@@BBB@@@BBB@@@@BB@@B@@@BAB@@A@A@@@AAA@@@A@AAA@@BA@A@@B@@@B@@@BB@B@@@BB@@B@B@@@B@B@BB@@@@F@@@BAB@@AB@BA@@BAB@@AB@B@B@DAD@B@B@@BFDBBBDFJBBBDBDBB@BBBDH@@BBHPBBFLFJBDHLDF@D@D@DBH@BBV@BAN@H@F@D@F@D@L@HBN@H@D@@ABAFCHADGL@@IPCFAB@B@DBDBBDJ@BDJBDFPFRDH@BDDFFDB@DDHFZFDBBDBB@B@B@@EBCL@@@B@BBDDF@D@D@@CH@BE@@D@B@LB@AB@@@@AB@@@@@F@B@@AFA@AFBB@TGH@@CHBDA@C@CJAB@B@BDBBD@RFDC@AB@BBB@B@@@@BA@@BVH@AA@FG@A@A@AD@@@BAA@@ABA@EBAPGLBB@@BDBFBH@@@@@H@ACB@PBDBF@HHF@@B@@AF@BF@@DCA@DD@@D@BD@D@@DB@@AB@@AD@B@HBA@JB@BBBF@B@B@B@B@@FR@@CBBD@BEDBBGD@ACF@@CD@D@@A@@@A@A@AB@H@@B@DD@B@@DD@@CDAB@J@@AB@F@@@B@D@@@BBL@@BVA@AB@@@B@J@B@@@DAN@D@JBDCN@D@HABA@ABAP@DAX@FF@@B@FBFBDBDBA@@@@BA@@@@@A@@B@@@@@AA@@@@@@@BBABA@@@D@BA@@BB@@@@BC@@@@FFB@B@B@@@@@B@DBD@BBB@@@@@@BAB@BB@@@ABB@@BB@@@BB@@BE@@@@BBDBBBB@B@@BB@D@FB@@B@@@D@D@B@BA@A@@B@DAB@B@@@BDB@BBBD@B@BB@@@B@@A@@B@@@@BBABBBA@@@AB@@@DAB@BABA@@@@@@B@@@B@@A@@B@@@@BBAD@BB@@BBB@@AB@B@@@@@B@@A@@@A@@@AD@D@BAB@AA@AB@DAD@D@DAD@B@BAB@BA@@BC@@@A@AB@@@B@DBBBBA@@@@BBB@@AAAB@@A@AB@B@BBB@@@BB@BBBBB@@@B@@@BB@@BB@@@BB@@D@DB@@@@AF@@@B@@BD@B@@BD@B@@DB@@@DB@@@F@D@@@B@B@D@BADBDAB@H@@@BAD@@AB@B@B@B@@@BDB@B@B@D@@@BAF@DBB@@@@A@@D@B@B@B@B@@AB@@A@@B@B@@@@AB@B@BAB@@@@B@@BAB@B@@@BBF@B@D@B@B@BBB@BB@@B@D@@@F@BAB@B@DBDB@@DDB@DBHBD@@@F@B@@A@G@A@AB@B@@B@@@@BAAA@@B@@@B@@@B@BB@@BAD@B@B@BBB@B@BA@A@@@AB@BBB@BBABB@@@B@B@@@DBDDB@@@@BB@AB@@A@@D@B@@@B@B@BB@BBF@@@BB@@@D@B@B@BBD@@DAB@D@BAD@B@@@@@B@@@@B@DB@@B@@DA@@B@@BB@@BB@BB@@BAB@ACB@BAB@@@@AB@B@@@@BB@@BB@DBB@B@BBB@B@BB@@BB@@@B@@A@@BB@BBB@@BBB@@@@B@@@@A@@BA@@B@@@B@@@@@B@@@B@B@BBB@@AB@@@B@@@B@@@@@B@B@@@BBB@@@@BBB@BB@B@BBB@DA@@@AB@DADABA@A@A@A@@BAB@@A@A@AAA@@AA@@@AB@D@BA@AB@B@B@D@B@D@B@BA@BB@@@ABBBBB@B@@BB@@B@B@@@D@D@B@B@BB@@B@B@@BDBB@B@B@B@B@B@D@@@B@BB@@B@B@@@BA@@@@B@FA@@B@@@@@BAB@B@@AB@@AB@@BB@@@BABA@A@AB@@AB@B@BBBA@@BA@@@@A@@A@A@@BA@@A@@@AA@@A@@B@@@@A@@@A@@@@A@@@@BA@A@@@@AAAB@@@@A@ABA@AABAB@CA@@A@@@@@@@A@A@@@@@A@@A@@A@@@A@@A@A@@A@@@A@A@A@A@@BAB@@@AA@@AAA@A@AA@AA@@@A@@BA@@@AA@@A@AB@BADADABABABAB@@A@@B@B@B@@@B@B@B@D@B@BAB@BBB@@BB@BB@@F@AB@@@BAB@@BBB@B@@@D@B@@@B@@A@@@A@@BAB@@@@AB@@AB@B@BB@@B@@@B@@@@@D@@@@B@@B@@A@@BA@@BBB@B@B@BAB@B@B@@@AA@@@A@@@@BA@@B@@A@@@@@@@AA@@@@AA@@@C@AA@@A@@AA@@@@@@AA@@@@@A@@@@B@AA@@@A@@@A@@A@@@@A@@@@@@@@@A@@@@@@@A@@@@A@@@@A@@@@A@@@@@@@AA@@@@A@@A@@@A@@A@@A@@A@@A@@@@A@@@A@@AA@A@@B@@A@@BA@@@@B@@@BABABABA@@@@@A@@@@@AB@DAB@@A@@@@@@BA@A@@@@B@BA@@@@@A@@@ADA@@@A@@BA@@@@@@@@@A@@@@@A@A@@@A@@@@@@@A@@@@@@@@@A@@A@B@AAB@@A@@@@@A@@AA@@@@AAEGAAA@@A@AA@@AAAA@@A@@A@@A@@A@@@@A@@@A@@@AAA@@@@A@@A@@@AAA@A@@@AA@@A@@@A@@AEACAAA@@@@@@@@AA@AA@AA@@@@@@@@@A@@@@@AAA@@@@@A@@@A@CAA@@@A@AAEAA@A@@AA@AAAA@A@@@A@@AC@A@@BA@@BA@A@@AA@@BAB@@AB@@@B@@@B@@@BAB@BCBA@AB@@A@@B@@@BABABA@@@A@A@@BA@A@A@@@@@A@@@@@AA@@A@@A@@A@@A@@AAAACAC@AAAAA@A@CAE@A@C@C@A@AAA@A@CAC@AAC@EAC@AAC@A@@@A@A@A@A@@@@@@AA@A@@@@@@@@@@@@@A@@@AAA@AAA@@CIAA@AAA@@BA@@@AA@@AAA@A@C@A@A@AAA@AAA@ABA@AAA@AAA@@AAAC@@@AA@@AAA@@AA@A@@@AAA@@@A@@AA@AAAA@@A@A@C@I@IBI@CBC@A@@@A@@@@BA@ABC@@BA@@@@B@@A@A@@@A@@AA@@@AA@BA@@@A@A@ABA@AB@@@BA@@AA@@BABAB@@@@AB@@A@A@@@@@@@A@@@@@@@A@@@@@@BA@@@A@@@AB@@A@@AA@@@A@A@@@@@A@@@A@A@A@@@A@@@@B@@@BA@@@@@C@@@C@@@@@@@@@@A@@A@@@@AAAA@@@@@@@@@A@@@@@@@A@@@@@@A@@A@@@@@A@@@@@@@@@@@A@@B@@@@A@@@@@AA@@@@ABC@A@@A@@A@@@@@@@A@@@@@@@@@AB@@@@A@A@@@@@@@@@A@A@@@@@@@A@@@@@@@@@A@@@@A@@@@A@@@A@@@@@@@@@A@@B@@A@@A@@@@@BE@A@@@@@@@AA@@A@@@@@@@@@@BE@@@@@@B@@@B@@@@@@A@@@AA@@A@@@@BA@@@A@@@@@@@AA@@A@@@A@@@@@@B@@@@AB@BC@@@@@A@@@@BAB@@A@@@A@A@@"],encodeOffsets:[[123166,37566]]}},{type:"Feature",id:"370285",properties:{name:"莱西市",cp:[120.51769,36.889084],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAC@@@A@A@A@A@C@@@AAA@A@AAAB@A@B@B@@@@A@@B@@@AA@@@@@A@@@@BIDE@@B@@@@A@@@@@EBBB@@@@B@@@@@@@@@AB@@@@A@@BG@@@@@@B@@@B@@@@@@A@@@@@@@@B@@@B@@@@@@@@A@@@@@A@@@A@AA@@A@AAA@@@A@A@@@A@C@@@A@AA@@A@AA@@AA@@@AA@@A@AA@@AA@@A@@AA@A@@C@AB@@IBA@CBA@DDBD@@BD@BA@@@BFD@@@AB@@@BA@@@A@@@A@ABE@@A@A@AAA@@BAA@@@@A@@@@@@@@AC@A@A@@@A@@A@@@@@A@@A@@@@CBC@EBC@E@C@C@EBA@@A@B@@A@@@@@@B@@@@@D@@@@@B@@@@@B@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@@@AB@@@@@@@@@@A@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@B@@AA@@@@@@@@@A@@@A@@@@@@@@@@@@@@@@A@@@A@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@A@@@@@@B@A@@@A@@AA@A@A@AA@@C@BF@@@@@B@@@@@@A@@@@@@@A@@A@@A@@@@@A@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@A@@@@@A@@@@B@@A@@@@@@@@@@@@B@A@@@@@A@@AA@@@@@@@@@AEA@@A@A@A@ABA@@@@@@A@@A@A@@@@A@@@@@@BA@@@A@@@@AAA@@@@@C@@AA@EBG@E@CB@@A@@B@@@@A@@@@@@B@@@@@@AA@@@@@@A@@@@@@A@@@@@@@AE@A@@@@@@B@@@@A@@@@@@@A@@B@@A@@@@@@@@@A@@@@@@@@BA@A@AB@@A@@@@@A@@@@@@@A@@@@A@@A@@@@A@@@@@@@A@@@@@A@@C@@@C@CBCBEB@BCDGJPC@@B@BBB@@B@@@BB@@B@D@@BD@@BDDBBDB@D@@@B@@@FAB@D@B@B@FBDBFDBBB@BB@@@B@@@B@B@@@B@@@BBH@HDF@@B@@BB@@B@@BB@B@F@@@BA@@B@@@BAB@@AB@@A@ADABC@@@@@CB@@A@@@A@@@AB@@AB@@@A@@A@@@@@CD@BAB@@@B@@@B@@@@CF@@@B@B@@@BA@AB@@@@@B@@@@ADA@@B@@A@@@@@@@@B@@@BB@@B@@@@@@@B@@AB@@@@@BB@@B@@@B@@@@A@@@A@@@@@@@AF@@@@@@@@@@@BB@@B@@@@@@@BAF@@@@B@@@@BA@@@@B@@@@@@@@@B@@@B@@B@@@@@@B@@@@@@@@@B@@@@@@@B@B@@@@@@@@@B@B@@A@@B@@@@@@@@@B@@@@@@@BB@@@@BAD@B@@B@@B@@@@@B@@A@@@@B@@@@@@@@@@@B@@@@@BB@@@@@@@@B@@@@@@@B@@@@@@@@BBBB@@@@@BB@@@@@@@@@@@@D@@@D@@@@AB@@A@@@@@@B@@@B@B@B@@@B@@@@@B@B@@BB@@@BA@@B@@@B@@AB@@@@@@@B@@@@@@@B@@@@@@@B@BA@@B@@A@ABAB@@BB@@AB@@A@@BAB@B@B@B@@ABB@@B@@BB@@@B@@@B@BA@@@@@AB@@AD@BAB@@@@@B@@@BAD@DAJ@J@J@D@B@BB@BB@BBB@@@B@@BB@B@@@BBB@@BB@BB@@B@@BDBB@@BB@BBB@BAB@BBB@BBB@B@B@B@D@BBB@BB@@B@@AB@@BB@BBBDJ@@BB@BBB@B@@@B@@@@@@@@@@@@@BBB@@@@@@@B@B@B@B@@@BBD@BBD@FBD@BBD@D@BBB@B@B@D@D@BBF@D@BBBBB@BBDBDBB@BB@@@@BB@@@@BB@@B@@@@@B@@@@@B@BAB@@@B@B@@ABABAB@@A@@@@BA@@BABADA@AB@@A@@@A@@@A@@BA@AB@@BB@@@BAB@@AB@@@BBD@@@B@@@BBBBBB@@BB@B@FBBBB@@@B@DBB@@@B@@@@@B@BB@@@@B@@@@@@@@@B@@BBBB@@B@@@@@@B@BBBDBF@@@B@@@BB@@B@@@BBB@B@@@BB@@@@@BB@B@@@B@@@B@@B@@@@BB@@@@BB@BB@BB@@B@BB@BBFHBB@@@@BB@@@B@@@@@BA@BBA@B@@@@B@@@@@@@@@B@@@@@@@B@@@B@B@@@@@B@@@@@@@@AB@@@B@@CB@B@@@B@@@@ABA@@@@@@BAB@@@@@@@BA@CBA@@B@@@@@B@@@@ABABABAB@@A@@@@@AB@@@BA@@@@BBB@@@B@@@B@@B@@@@BB@@@@BB@@@B@@@@B@@B@@B@@@@@@@B@@B@@@@@@B@@B@@@@@@@B@@@@@@@@@B@@@@@@BB@@@B@@@B@@B@A@@B@@@@@B@@B@@@@B@@BB@@@BBD@@@B@@B@@B@@B@@@@@@@BA@@@AB@@@@@B@@BB@@A@A@A@ABA@A@A@AA@@AB@@@BA@@@@A@@C@@@@@A@@@A@@@AAA@A@@BA@@B@@A@AB@@@B@@@BA@@@A@C@@@A@A@AA@@BA@A@@BAE@@@AAA@@AA@AAA@ABA@C@A@A@A@@@A@A@A@@@@BA@ABABABCBCBABA@@B@BB@@B@@AB@@@B@@BBB@@B@BBB@BB@@B@@BA@AB@B@B@B@@@B@@@@B@BB@@@B@@@@BB@@@@@B@B@@@@@@@B@@@DBA@ABBBB@BAB@@@@@BABB@@@@@BAB@@@@@B@@B@@@B@@@@@@AB@@@BB@@B@@@AB@@@B@BB@@@@@AB@@ABAAA@A@@BA@@B@BABAB@@A@@AA@@BA@@BA@A@AB@@@@A@@@EBA@@@@@AB@@A@A@@@AAA@@@C@A@A@A@A@A@A@CA@AA@A@@@AAA@A@C@C@@@A@A@@@AA@@@AAAAABA@@A@@AABA@C@A@C@A@A@A@@BABC@A@@B@@BB@@BB@B@B@BA@AB@@@B@B@BABCBCBA@@B@@CBA@AAA@A@@AAA@A@@A@AA@@A@A@@@@@A@@@A@@@A@@BA@AAA@A@@@A@@@@@A@@@A@@@AB@@@B@@A@@@@@A@A@@B@@@@BBBB@AB@@BB@@BAB@BBBBDB@@@CB@B@@@@DBB@BB@@@@B@@@@@BD@D@DAH@BCB@@@B@@@BBDBBABBBDBAD@DD@@BAB@B@@BB@@@BB@@@@B@BD@DBLB@FA@@B@@AB@@BDD@@@@@B@@@@@@DB@@B@@@B@BAB@BB@@@@B@@AB@@@@ABBB@B@@@B@@@@@BB@@@@B@B@@@BB@@B@B@D@BC@BBBDBDBB@DB@D@DABABABCBABAB@B@B@BDB@BA@@FADCHCB@D@B@BBB@@BB@B@@BBDB@BB@@B@ABBDBBD@@BBB@B@@BBB@BB@@@BB@BBB@B@@ABAAEB@BA@BBB@B@B@@@BB@DBD@B@@AD@@@B@@@B@B@B@BAB@@B@BFBBA@A@@@A@E@ACC@AAA@A@@@A@AB@@@@@B@@A@@@A@@B@@@A@@@@A@@@@@@@@@@BAA@@A@@@@A@@A@ABC@ABA@@B@@@BB@B@BBB@@@@AB@@@DB@LCD@B@BC@@@A@@BAB@BBBA@CB@FBB@@BB@@@BB@@B@@@@B@B@B@@B@B@BA@@B@DCBC@AB@@A@@CB@CA@A@BCD@FB@AA@@A@E@@@A@@AC@@@C@@@A@@BA@@B@@@B@BA@@@ABAB@D@DABA@@@@AA@@@A@@@A@A@A@ABA@@B@@@@A@@BA@A@@@ABBB@BBDB@B@@AB@@@B@@@B@@B@B@@@@DB@B@BAB@@D@DABC@BBFB@@BB@B@B@@@@@@BB@BBD@B@B@@BB@@B@BBBBB@B@@BB@BBDAB@B@B@FBB@B@D@@AB@BDB@ACBA@@@@D@BB@@B@@AB@@@B@B@@@BABABA@ABA@@B@B@@AB@@APAB@D@B@@BBBBB@@BB@F@B@B@@@@A@A@@B@BAD@B@@@@@@@BA@@BA@AB@@@BB@@@B@B@BA@@@AB@B@B@B@B@BBBDBB@@B@BA@AD@BCBB@B@@FBDB@@D@B@@@@DF@@@D@B@@@D@ABA@@BA@@@@BB@H@@B@@@BA@ABB@D@@@ABB@B@@@@AB@@@@AB@B@@A@CHABBB@D@B@@@BABAB@BAB@B@BAB@@BBB@BD@DBBBBBLDBBDBBAD@@@@@H@B@@BD@@BB@B@B@BBBDABBH@B@@BBBBBBDBBB@@DBDDDB@BBDB@@@BBABABD@B@B@@@@A@@@@@@H@B@BBB@D@BA@@A@@A@ABA@AD@F@DABC@A@A@A@ABABAHCB@@C@@BDD@BA@ABB@@BBB@BAB@@CAC@ABA@ABC@ABA@ABC@@@@@ABA@A@@@@@A@A@A@AAEAC@C@AC@A@G@BA@AA@BAC@BABABA@AC@BAB@@AA@@AAC@@A@@A@A@A@C@AAA@A@ED@@A@C@BDAB@@G@A@A@@E@@AF@@@E@@A@@B@@A@@BCA@@ABC@A@AAA@CECA@@@@@@@C@@AAABCBA@@BE@@@AB@BA@A@@@BB@BAF@@CA@@AFAB@DADA@@@@@@DBBBBBFBFDBBB@BAD@BA@@DBB@BAD@@B@@BBB@B@BBBBB@B@BB@B@@@@@D@B@BDFB@D@@AB@@A@ABAA@@A@A@@@A@C@A@@ACBADDPA@AFB@AF@B@B@@ABBBB@@B@B@BADG@CBAA@C@@BCA@AAA@ABAB@D@B@DA@A@C@A@@BC@ABA@CB@@A@AACA@@ACAA@C@AA@@AAAC@AC@@A@@@@HC@E@@@A@@@CB@@A@A@@@A@AAAAACB@CB@@AAA@AE@BGI@B@E@ABA@@BC@@FA@@DG@@BCB@@ABABC@@@CAAAAA@G@C@@C@@@@@@@@K@@@@EBBCA@@C@@A@@A@AA@@AA@@@@@@A@@@EB@@AA@@@@A@@@A@@@A@@C@BA@A@@@AAC@@@ABA@A@@@AA@@@C@@@AA@@@@@@@@@CA@@@A@@@C@@@A@@CB@B@@@@@B@@C@@@@@ABC@@@A@@@ABA@AB@B@@@D@@AACB@H@B@@@BEB@@@@@BCAAA@@@@A@@@C@AAABAB@@@AA@@BA@@@AB@AA@AA@@A@@@A@A@AD@@AD@DA@@BA@A@@A@@A@@D@@@@@B@@AB@@A@@D@@A@@BABAD@ABB@@ABB@A@@@ABCBB@ABA@ABA@@B@@AB@@@B@HB@CD@@@D@@@D@@@P@B@D@B@@DB@BBBGA@@CB@@@@@@DB@BABBBC@@@@C@BKAAA@BI@C@AA@BCGA@A@@@ACAA@C@A@A@A@AAA@@@@@@A@CA@C@@@A@AB@@C@@BA@AAAACAC@C@E@A@A@C@CAA@@@A@A@AAAACBA@@@BE@@@@@A@AAABC@AB@@A@@@C@AA@@A@@BA@@AE@@@@@@@A@AB@@@@A@@@@B@@A@@D@DAB@DB@AB@BBB@@@BABA@@A@@AB@@A@@@@DABAD@@AC@@AA@@@BA@@@AA@C@@A@ACA@CA@A@@A@CAA@AB@B@@DB@@EF@BBDAB@DCBAB@B@DAB@DAF@@@BA@@BABA@AB@AA@A@AA@@AA@AAAA@CAACAB@@@B@@AA@C@@@@AF@@@CA@ABCACA@@@BA@AB@CA@A@@AAAAAAA@CABC@A@@@@CBABC@@@@AA@A@@A@@A@A@@@@BA@@@@@@@A@@B@B@@A@@A@A@@ABAAA@@@CB@@K@A@A@C@@BA@@@AA@@@@CAAB@@@BA@EAAA@A@@B@@ABCA@@C@AA@@AA@@AAEACAA@@@A@A@A@AA@@@@A@AA@@@AAA@@@@A@A@@GC@@AAC@BA@@AAC@BA@A@@@A@@@ABAC@@@A@@@A@A@EADEBCBA@ABAAA@CBA@@@@FBB@@A@AC@C@@A@AA@@@@@@C@A@A@ABCBA@A@@HBBB@A@@@ABA@@BABAB@B@D@BB@@B@BCC@@@@AB@B@@ABA@AB@BAB@D@@@D@@A@@D@@@@@F@@@@BFB@@FBB@BAB@DC@A@E@@@AB@@C@@B@BABAB@BAB@@@@@@@CA@ABCBG@@F@@@@AA@@@@AA@@A@ADB@AB@@@@A@AB@AABA@A@A@@@AA@AA@@AA@AEA@A@@AA@@EC@ABBDAEA@@BABAB@@@@@DABB@A@@D@BAD@BBBA@AB@B@@@B@@@D@BA@@@B@@@@D@B@B@@ABBBAB@BABA@@B@DBBABCA@@ABCBCA@BABBBABAA@@ABABB@@D@@@BA@@@A@A@@@@B@BA@@D@@@@@@AB@@A@@@@@AA@@@A@AAA@C@@@BOBGB@B@B@BD@@@B@@F@D@B@@BB@@@BBBBB@BBBAB@BA@@BBBAA@@AB@B@BA@@D@BB@@BA@@@@ACAA@A@AA@A@@C@ABABA@@BA@AB@@@B@@@@@BDB@@@@AD@@AB@BA@ABA@@ACB@AA@A@AAAA@@A@AB@@KBC@CAC@@AA@AG@@DC@@@E@CA@A@@C@AC@A@E@C@ABA@@A@@AAC@@A@A@@AA@@@@AA@@A@@BA@GAE@@B@@C@AAAA@A@AA@@AA@IAE@@@@C@E@A@A@@B@BA@@@A@A@@@A@@@AAA@A@@A@AACA@@AA@@AA@A@A@@@C@@DA@A@@@@B@@ED@@A@@B@@A@CAA@A@C@@A@@CAABA@@@@B@DABA@@BA@@BAB@BAB@@@LC@AA@EA@A@@BABCBAAA@A@@@@A@A@@DA@AB@BC@@@AACA@A@CAA@C@AA@EBG@E@@UAEABA@AC@@@C@@@@A@AAA@@@AA@@AC@G@@B@@A@@AA@E@@@EBA@@B@B@BC@BB@B@D@B@B@D@BA@A@@B@B@BCBA@@F@B@BBD@@BBB@B@@B@@BB@@@B@@ABD@BBA@@BA@@B@BA@@B@@@DCBAB@@CB@B@BABE@ABA@B@B@@BB@B@B@BBA@@@@B@D@@@@@B@BA@@@C@@@A@A@A@A@CAA@AA@@A@A@@DBB@@@BABA@@@@@@@AA@@@@@@@DC@@@@BA@@@ABA@AB@@A@@D@@A@A@A@@@@@C@E@A@A@@B@@@@A@@@@@@@@@@@@@@A@@@@A@@A@@@@@@A@@@A@@@@@@@@@@@@@@A@@@@@A@AA@@@@@@@A@@@@@@@@@@@AA@@AA@@@@@@AAA@@@@A@@@A@@@A@@@@@@@@@@@@@@A@@@@@A@@@@@@@@A@@@@@@@AG@E@@@@@@A@A@@@@@A@@AB@@@@@A@@@A@A@@@@A@@@@@@A@@@@@@B@@@@@@A@@@@@@A@@@@@@@@@@AA@EBE@@@@A@@@A@@@@@A@@@@C@A@A@A@@@@@@@@@@B@@@@A@@@E@A@A@A@@@@@@A@@@@@A@@@@@@A@@@@@A@@@A@@@A@@B@@@@@@@@@DA@@@@B@@@@@@A@@@A@@@BF@F@@G@A@C@@C@AA@A@A@@D@@@BC@C@C@K@C@@@@@"],encodeOffsets:[[123327,37496]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
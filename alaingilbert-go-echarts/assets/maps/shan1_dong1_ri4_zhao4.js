@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("日照",{type:"FeatureCollection",features:[{type:"Feature",id:"371102",properties:{name:"东港区",cp:[119.462267,35.42548],childNum:1},geometry:{type:"Polygon",coordinates:["@@IBAB@@ED@BDF@BEHAFCBIBAB@H@FBJABGF@BBFBJHH@B@@ABGFEBKC@@C@CA@@@BE@QDIBKJE@I@AA@BEBADADA@EBAAA@MEGCIEC@A@CBC@@BBJ@DCHCFABCFOFEBCAAAAEA@A@GFMHCAC@GOEGABEBOFAFADC@CBCH@BAB@@ABBF@BAB@FABAB@B@BF@JCBDBJBH@D@DA@@B@B@@@FBD@@@@@B@@@DAD@B@B@@@B@H@D@FA@@B@@AB@@AB@@ABCD@DBB@DDBBDBB@@DBFDFBLDJBDD@DBD@D@BBBDDH@DBFBFBDBDBBBDDBBFBFBDBDDBBDDBBBBFADD@AHCDADEHCJCD@DADAB@FAFCDAFAJBHBHBFDDFBD@BB@ND@@DBDHB@ADGDAB@B@BBDDDBBD@BCB@D@HHLD@B@BBDB@D@FG@EAGGAAC@@FGDEB@@@@BB@@@DLBJ@DAF@BB@D@B@B@HADAD@FAHCHAHC@@F@F@FAB@FAFADABC@ABCBEBC@EFGDADAFA@@FEDCDE@@BCDCDCBCBCBEDA@BB@@AB@@AHB@C@@DFD@@EB@@ADBDB@B@@BB@@BD@@BB@B@@@@H@DBFFDBDBFB@@DAFDFBBDBBDDDB@BFF@B@BDFB@BFBBBFDDBDBB@BDD@@@BDBDDBBDBD@@@@@DBFEBA@C@CAE@A@A@A@@@AACBA@ADCDEDA@@B@B@DAJAFBDBD@DBB@DDFBJFHDDBHD@BDBFBHF@@@@DBF@H@F@FA@@@@HAD@HCF@@@DAFEDEDC@@BADAHCF@DDDDDB@BBBDDFFBD@@DDBFHJBDAFBBDDB@BBDBDBFBF@D@F@JADBDBBFDDBBB@@@F@BAHAFAH@LAH@D@BABABAFGDAHAD@BABCD@F@DB@@DBF@LBD@B@B@FED@BBD@DCBCF@FABBAFAB@DB@DBH@DDDDLHB@B@HC@A@ABGDEB@B@FBFBDDFL@JBBFBB@JIF@D@H@B@BA@CBABABABABAB@D@@@FCDADAFCD@F@D@HBFOBKAKEMACCISeMaCKCKGICCC@CAAA@EAGAEAIEYEUAECIACAACCCAGCMEEECGEGIUGOGO@CCQ@IBKJEFGFC@GAIAGEEIEOEQC]EICEK@ACEBQZ@D@BAACACA@SAC@AAAC@qAAAAC@ABAD@F@BCBABABIAGBCHCTMHG@E@QEOGECMIGG[YOKagSUAAIOAEGOACACCSACE]AC[DC@I@Q@Y@I@E@@@KB@FAH@@@DAFAFADEFaNGBOCC@C@MRADBDFJAF@@ADABEDBBFDNH@B@@BFAF@DDR@DABAFBFJJDDJFBB@@B@JAJAD@BAJAJCB@J@B@D@T@F@FBBF@FBFAB@H@BBB@@B@@BNDFBBBFBFBB@@DBD@B@@@@@BBD@@@@@F@@@@@B@D@N@B@B@B@@@F@D@B@@AH@B@F@B@@@@AJ@@@B@DAPBB@FMA@@C@@CA@A@@@@@A@@@A@@@C@@DAA@B@@AB@@A@A@@@@@@@A@@BEAB@@@CAB@@@@ACB@@@A@@BA@@@@A@@@@@ABA@BB@@B@A@A@@AA@@B@AA@@@B@AAAB@@@@@@@@@@A@@A@@@@@@@@@AA@@@@AC@C@@AC@A@@@@@@@A@@@AA@@A@@AA@BBA@A@@A@@@@@@@@AA@@@@A@@A@AB@B@@@@@@@AA@@@@A@A@@@@BA@A@@AA@A@AA@@@AA@A@A@A@A@A@A@@@@@A@@@A@@@ABE@@BAAC@@@CA@@@@A@@@@@A@@@@A@A@@AB@@@@@B@@C@@@AA@@A@A@@@A@@@A@@@AAB@A@B@AA@@A@@B@@@@A@@@@@@@A@@@@@@AA@A@@@A@@@@AA@A@@AA@@@@AA@@AA@@@@@AB@@A@A@A@@AA@@@@@A@@@A@@@@@@@AB@@@@BBAAABAA@B@@@@A@@@@@@AA@@B@@A@@@@@@@@@AA@@@CAA@@@@@@@@AA@A@@@A@@@AA@B@A@@@@@@@@@@@@@AA@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@A@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@A@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@AB@@@@@@@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@A@AAA@A@C@CAE@@BC@@@@@AAA@CB@B@@A@@@A@BB@@CAB@A@@@BB@B@@@@DB@@B@@B@@@@B@@B@@@@A@@@A@@@A@@@@@@AAB@@@@@@A@@@A@@BAA@BC@A@@@@@A@AA@B@@@@C@@@EA@@@A@BA@@@@AA@@C@@@@@@@A@@CA@@ABBAA@BAA@@AA@@AA@@A@@B@A@@A@ABBBAC@@@@@ABAAA@@A@@@@@A@@A@@@@@@AA@@B@@@AA@@@@A@@@A@@@B@B@@A@@BB@@BAB@D@@A@@@AA@@A@BB@@A@AAA@A@@@@@@@@@@B@@B@@@@@A@@@@B@@@@@@@B@@ADB@@@@B@@AB@@AB@@A@@A@@A@@@@@@@A@@@A@@AA@A@B@@A@@@@DBB@@AB@@@@AA@@@@@@A@@@@@@@A@@@AB@@@@@@AA@@@A@A@@B@A@@@@@@A@BBA@BBA@@@@@@B@@A@@@@@B@@AA@@@@@@B@A@@@@@@A@@A@@@A@@A@@B@@@@AB@BAB@@A@A@@@@B@@@BAAA@@@C@ABC@ABABA@CA@BC@@BAACBBBA@BBA@A@@BCBEF@@AB@BB@@@BBDDBABB@@@@@B@B@BABABA@BBABAB@B@B@B@FABB@@@BB@@@D@@@DDDBDDBBD@B@@A@AF@@A@@@@ACB"],encodeOffsets:[[122061,36235]]}},{type:"Feature",id:"371121",properties:{name:"五莲县",cp:[119.213619,35.760228],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@AC@@AA@@@ACACA@BA@@FC@CE@@@DGA@BA@@BA@@ACBAFADADCDCDAD@@CFCDEF@@EBCBCBEH@FADAFAD@BADCBEBEBA@EBE@E@@@GDGBGDEBC@CBGBA@A@C@A@@ABE@CAICK@@A@@A@@A@CFEH@@BDHBBH@FEHC@A@AC@A@AKCGGC@A@ADC@AACCAC@A@ABAHCBCA@CGCA@@MCA@@AACCEECGAGAIAEBCBEDEBA@CBCBC@IDGDCFCBGD@BCCEBAAAACCAACCCAEAEAAACCAACACAEAEACAG@ITK^@@CFAHADAFAF@F@FADBFBF@DDDBFDDFHDD@@FBFDBBD@D@FAB@D@J@H@H@@B@B@@CDAFADBB@BBB@@@BC@ADB@@@DBDBDDDFB@BFBFDFTHDFBDABKFGH@BRL@@F@@BBDDFDB@@@@@@F@DADFC@ADB@BABBA@ADC@KF@DGBBBDDCDAACBBHA@AAB@AEE@A@A@A@IM@@ABBD@B@BCBEAEB@@ABADCDABCBABCBEDCDCF@B@H@DA@@@@BCDCDADEFEHGHCB@@CBIJCDABDLADABA@ABIBI@E@@@EBG@G@IBKBKFCFCFEDCDEDCDGDEBI@EBGAGCEAAAGCAAAAEAE@IBGDCBCBABC@CBGHEHAJ@D@@@F@D@DAD@D@D@HBDBDBD@BBDDDBBDF@DBDBHDHJJDFDDHFLHDB@@D@@@H@FCBCBADEHCB@B@FBHBH@FBBADAJCFANBHD@@B@@A@@@CDADBBAD@AAB@D@@@HF@BB@D@HFCB@BFAFJBFBFA@@B@@BD@BADAJAD@@EHCJAB@F@JAP@D@@BBB@D@D@HGFABADC@AB@@BB@@BBB@@HADBBB@FBBD@BEDBFLB@AFCDEDIFIJCF@BBBBBLFBLBB@L@F@D@BEL@DBFBBB@B@B@DEB@BBD@F@FBH@HDBBH@NHD@HAH@B@J@J@L@B@J@HBRJNBNDB@HBDI@CHDHBFBJBFBD@D@B@DCDA@E@C@A@C@E@A@@G@BKBE@ABE@@BC@CCG@C@A@EAE@A@@BABADG@C@AA@C@C@IBGAIGA@WOMAQOACA@BCJAFCLCNIFABDBB@B@N@BB@HBH@DADC@@LKLGHCBAB@DAFALB@BB@@AB@ABBBB@@@B@@@@@@@ABBBBAB@@@ABAB@DB@@AB@@@BAB@@C@@FB@AB@@@B@@BB@B@@B@B@@B@@@@BB@B@@AB@@A@@B@@@@@@BB@@A@@B@AAB@@@@@B@ADB@@@@BB@@@@@@@@@AB@@D@@AA@B@H@@A@@B@B@@A@@@@@@B@@AFBJBDDDDFB@@@@HDFBHFB@BBFDBBFDDDFDB@FFFBFDD@DBB@DBF@D@H@@AF@@CB@@CD@@A@@DABA@AA@A@E@A@AA@A@AAA@BAC@CBGFCB@BB@BH@BDF@BB@B@@D@BCBGC@BA@ACAFANBFBFBBBLFL@F@B@JEBAB@DADCBABA@@BAD@BABGBABC@ABA@ADE@@BA@EDC@CBCCGACACAA@@AAECEC@@B@@BBBB@AA@C@@@C@AACDB@CB@BDDA@AF@AECCLKGA@A@@@CDCDCBC@@BGACAC@C@E@G@EAE@E@CAC@GACAE@A@A@AAC@@@C@CBC@@@@J@D@FBFBHFDBB@DD@@DFFDBDDBDDDBFDFDFBB@JFBBB@BBDBHBDBJ@B@DB@@B@F@F@DBDBB@DBDBB@HDH@D@F@LEFADCJEDAD@BADCDA@AFCBCBABCBCBCBCAEGCCAEC@AAA@ACE@EBABEBK@ADADADAF@FBH@B@DAB@@AFCBC@A@ABCDABCDABA@@LGHADABA@AA@C@AAADA@@BA@A@EEBA@CCAAA@@@A@ADEBC@EACAADA@AAC@CAEAC@AEA@A@@@@@ABA@AAA@AB@@@B@B@@A@@@@@A@CBGBA@@HEBAFE@E@A@EAG@E@CBOAA@AAAAA@@@A@C@@BABAECAI@A@@E@AABA@@@@DADABAIE@@ACAC@C@I@A@CBCDA@ADADALED@HABA@EBE@@@A@ECC@AC@GBCBEHABABABC@G@KBG@EBGBABE@@@A@AACCAECACAIBE@C@E@EACACAAAA@CCAABEACGIAECC@@ACEECCAA@ACACCCCE@GDCBAB@@CDCFEFCB@@E@GDC@GB@@@@EBE@G@E@CA@@@@GEEACA@AGCCAGCIEEACCA@CAC@CAEAIBCBA@A@@@CBCFCD@BABBD@B@@@B@B@BBF@D@DABEFCA@@@@C@CAAACCCA@A@@CC@AAAACCCAEAAAEA@CE@A@AEE@ACACCAAACEAECCB@@EACACAEECAG@@@@@@A"],encodeOffsets:[[122151,36393]]}},{type:"Feature",id:"371103",properties:{name:"岚山区",cp:[119.318928,35.121884],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@@AABA@@B@@@BD@@@BAD@@A@@C@A@"],["@@B@BABAB@DBB@@@@AAAAACB@@A@A@A@A@C@A@BBDBBB"],["@@@@F@DBB@@AB@@A@@@AAAC@C@C@@F"],["@@DUCEUCAECACCMI]EABIFGHG@E@GAUCmGGbA@AD@B@BA@ABA@ABAB@@ABAD@B@B@D@BA@@B@@AB@@@B@@AB@@A@ABABAB@@A@@@AB@@A@@@GACBA@C@A@@@@@A@@@@@AB@@A@@@A@A@A@A@@BA@@@A@@B@@A@@@@@@@@B@@A@@@A@@@A@@BA@@@A@@@@@A@ABA@@BA@A@@@A@@@@@@@@@@@A@@@CBAB@@AAC@EAE@CACECCCAEAC@AA@@AE@@CCECG@G@ABC@@@CBCBEBEJ@@ADCFAL@H@@@@A@B@CDAFCBCD@@CBE@A@C@E@A@G@IBEBCD@@ADAF@DBB@F@DBBBBHFHB@@@@A@@BA@@@A@@BA@AB@@@@AB@@AB@@A@A@AB@BABAB@@@@@B@BAB@FA@@BABAB@B@D@@CBABADAB@BGBA@@@AB@A@@A@@@A@A@@@A@AAC@@@@@@@@@@AIJ@D@D@B@B@@B@@D@BBBDBD@D@B@D@BBD@H@D@D@D@FBFBBB@@DDBB@BDDBF@@@BDF@BBF@B@D@F@BFDBBBFBBBD@BBD@J@L@F@@DBFDDDABABCF@@ABAH@HAHAH@BCJABABC@E@G@G@GBCB@@CBABBDBBDDADABIJIJEDCBABAD@B@F@@BB@@BBB@DDBBBBBB@D@D@BADA@@@CBA@E@G@@@G@C@gGG@@AC@GCA@ICA@CB@BANABCBAB@@@BAB@D@@@H@FAB@F@@ABABCBC@CDABBD@DADCB@@A@C@ECA@EBUH@@KFABCDABADCBGD@@A@KDED@@IFIBIBG@O@A@C@AACAKC@AGACACC@C@@BA@CDEBEDE@C@AB@@A@CAE@CCEECECAAACGECEECCCCAEAC@@@A@@@A@A@A@ABC@AB@@C@C@EBCAA@A@AAMGAAC@CBIHABAF@F@B@DAFA@EDA@CBIFEBEBG@G@IBE@ABC@ABCBCBGD@@GDA@ADAB@B@@@DBDBBBD@BBB@BA@@BBB@B@BAD@BBD@BAD@BABABB@B@B@D@@@@B@@@@@@BB@@@@B@B@DB@B@@@@BB@@@@@@@@BAB@@@@@@B@BBB@@BBB@@@@BBB@@B@HA@BABDA@@@@@DB@@ABBDAB@@@B@DBFB@@@@@@@@@B@@@@@BB@@@BB@@@@@@@@@B@@@@BB@@@@@@B@@BB@@@DDA@@D@@B@@@BB@@DBFD@A@A@@@C@B@@B@B@@A@A@@BA@ABA@@@AB@DAB@@A@@@A@@@A@@@A@@BA@@@AB@BAB@BAB@@B@@BB@@@B@@@B@@@@BB@@@B@A@B@@AB@@@B@@@@B@@@@@B@@@B@@@@@@@B@@@@@B@@@@@@B@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@A@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@B@@@@@B@@@@B@@B@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@BB@@@@@AA@@@@@@@@A@@@@B@@@BA@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@A@@@@@@@@@B@@B@@A@@@@@@@@@@@@@@@@@@@@BB@A@@@@@A@@@B@@@@@@B@@@@@@@@AB@@@@@@@@@@@B@@@A@@@@B@@B@@@@@@B@@@@@@@@@@@@@@B@@@@B@@A@@@@A@@A@BAA@@@@@@B@@@@@@@@@@@BB@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@BAB@@@@@B@@@@@B@@@A@@BB@@@B@@AB@@B@@@@@@@@@BB@@@B@@@@B@@@@@@@B@@@@@BBBAB@@@B@@@B@B@@@BAB@B@@B@B@B@B@@B@@BB@@@B@@@@BABBB@@@@@@@@@BB@B@B@@@BAB@@B@@BA@B@@@@@@A@@@@@@B@@@@B@@@@@@@@@@@BB@@BB@@B@@@@@@@B@@@B@@@@@@A@@@@B@@@@@@@@@@@BB@@@@@@BA@B@@@@@@@@B@A@@@@@@@@@A@@@@B@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@B@A@B@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@BB@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@B@@A@@@@@@B@@@@@@@@@@BA@@@@@@@@@A@@@A@@@@@@@@@@@@@@@@@A@@@B@@@@@B@@@@@B@A@@@@B@@@@@@@@@@A@@@@@@@@@@@B@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@B@@@A@@@@@@@@@@B@@@@@@@@@@@@@@BB@@A@@@@@@@@@@@A@@@B@@@@@@@B@@B@@A@@@@@@@@@B@@@@@@@@BB@@@@@BBB@@@D@@@BBA@A@@BA@B@@@@@@@BB@@@@@BA@@@B@@@@B@@A@@@@@A@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@BB@@B@@@BB@AB@@@B@@B@@AB@@B@@@@B@@A@@@@BB@@@@B@@B@@B@@B@@@B@@B@@@@B@@@@A@A@ABA@B@@BB@@@@@B@B@@@BAB@D@BA@@@A@@@AB@BA@@@B@@B@@@@ABB@@@B@@@@B@@A@A@A@@B@@@@@@@@AB@@AB@@@@BB@@@@@BA@@@BB@@@@@@B@@B@@@@@@@@@@A@@@B@@B@@@AA@@@@@@@AB@@@@@B@@@@@@B@@B@B@B@@@B@B@B@@@B@@@B@B@@@@@B@@B@@B@B@B@@@@B@@@BB@@A@@@@@B@@B@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@A@@@@@@@@@B@@@@@@@@@B@@DA@@B@@@@A@B@@@@@B@@@@B@@@@@@@@@B@@@@@BB@@@@@@@@@@@@@@@@B@@@A@@@@@@@@B@@@@@@@@B@@@B@@@C@@@@@@@B@B@@@C@@B@@@@@@B@@@@@@@@@@@BB@@@@@@@B@@@@B@@@A@A@@B@@@@@@@BB@@@@@@B@@@@@@@@@B@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@A@@@@@@B@@@@@@@@@B@@@AB@@@@A@@@@@@@@@@@B@@@@@B@BABA@EBA@AAEBA@@BA@ADGDAD@BCBEPEFABAFHHPD@DBNGHEB@B@BFBBDBFAPEDEBADEDG@CAI@AD@DAB@D@JFHDNFB@BBFAB@BCBCFA@ABBJ@F@LIJARCF@@A@@DBD@@@LDFAHEBA@@@AGGAIAE@AHEBAAI@E@GBAJADABEFG@ACE@AFC@@BAJADA@B@@B@@@BEB@@@@AACCAACCC@C@@@C@@AA@@A@BA@E@A@A@ABABAAAB@BABA@A@A@A@@@@AAABCCAA@@A@@ABA@@FEDA@AB@B@AAB@AADABB@AD@@ADBB@BABAD@BAD@@@B@BB@A@@@A@@B@B@@@BA@ABA@@@@@AB@@@@B@@@BB@@@@@@@@B@A@@@@B@@BA@@@@@B@@@@A@@@@B@AAB@AAB@@@@@@@@B@AB@B@@@B@@B@@@@A@@B@@@B@@@@@@@B@@@@B@@B@@A@@BA@CA@@@@@BA@B@B@@BB@@@B@@@@@@@B@@@@BB@@@BA@@BA@@@A@@A@BC@@@A@@@@@@@A@@B@@@@@A@@@@A@@@@@@@@B@B@BBB@@@AAB@@@BB@@B@@@@CBA@AA@@AB@@@@A@A@@@B@@@B@@B@@B@@@AB@@B@@@@B@@@@B@@@@@BB@BBBA@@@@D@ABAA@B@BB@A@@@@BB@@BB@@BB@ABB@ABBA@@DB@@@B@@@@@@@DB@@B@@B@@A@B@@FB@@D@@@@@@ABBB@@@@@B@D@@ABB@AB@@@B@@@@@@@BA@B@@@@B@@@B@@@B@@@@@@AA@@@@@@AA@@@CA@@@@@AAA@@B@A@DB@@AAB@@@B@@@@ADAB@BB@@@@D@@AF@DBD@B@B@BBB@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@BA@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@BB@@@@@@@@@@@@B@A@B@@B@@@B@@@BBB@@@@@@@@BB@D@@BB@@@@@@@@B@@@@AB@@B@@@@B@@@@@@ABBBABBAA@@@@BA@@@@@@B@@@B@@@@@B@@BB@B@B@@@BA@@@@B@@BB@@B@@B@@BB@B@@B@@B@@@B@B@@B@@@@B@@@@@@@B@@@@@@AB@@@BBA@B@A@BB@@B@@@B@@@B@B@@@BB@@D@@@@A@@@@BA@@@B@B@@B@@@@@B@@@@@DB@@D@BB@AF@BA@@B@@@B@@@@@B@B@B@B@B@B@B@@B@@BBB@B@@BB@B@@A@@B@B@@@@@BB@@@@@@A@A@@B@BB@@@@@BB@@@@@@@@@BB@B@AAB@@BB@@@BB@@B@@@@@@@B@D@@BD@D@@B@@B@@B@@@@@@@@@BB@@@@@@@@@@@BABBA@@@B@@B@AB@@BB@B@A@@@AAB@BA@@@@B@@@@@AB@@@B@@DA@B@@A@DB@@A@FB@AB@@@@@@@B@B@@@BA@@@ABB@CD@@@B@@@B@@@@@B@B@@DD@@@NB@EAABO@C@A@@BI@@@@@A@E@ABG@@@A@C@E@@@A@A@A@M@C@A@@@@@E@@@@AC@A@@@@@AAC@CA@EAEAAAEAMC@AA@@@AA@A@GBAAE@EAEEAE@S@C@A@I@A@IDIBABC@IBIBA@@@AAIECCIIAEBEBA@CCQ@CBEAE@@@AMGECAAFCBABC@@BEEIACBCNQD@D@PDHAbMFEBCBEBE@C@@BG@ELA@@F@J@Z@R@J@D@\\CAKE[COIA@DGFE`MHCBE@ECEGC]M@@AG@C"]],encodeOffsets:[[[122704,35834]],[[122792,35985]],[[122771,35850]],[[122263,35948]]]}},{type:"Feature",id:"371122",properties:{name:"莒县",cp:[118.837063,35.579868],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CAECAAC@A@IDABABEBABA@@@ABABCBAACAAACAC@AAA@C@@B@B@B@@A@@B@@@@AB@@@BA@A@ABA@@BA@A@CBCFCBABAB@B@BA@@@A@A@@@AAAAECEEAAAC@AA@@AA@AA@@A@A@@@A@CBABABC@EBA@A@A@A@A@C@KBEBC@A@C@EAMAECGAA@C@EBA@@B@B@@@D@F@J@B@B@D@BAB@@@BC@EBC@C@E@CBE@CBAP@FAJCFAF@DFB@@@@@@FDHBHDHFF@D@HAHAHBF@DBBD@DCBCBEBCB@@@B@B@@AFBH@BAD@BED@D@DJDF@H@D@D@DBDBBDDFDBFBHBD@DADADED@F@F@F@HDDBH@@@F@F@BADEB@F@B@D@JDB@@BBB@BFDDBFDDB@@DBDDFJJPBB@DBBEFCDADCFABA@ABGBCD@@@@AB@@CBCDEBGDABABEDCDBH@B@DAHAB@DADBB@BDBFDFFHDHJDBB@HFJH@B@B@DADAD@DBDDDB@DBDBDBDDDFBDDFBD@DAH@@AB@BAB@B@@CH@D@HBDBD@NBJ@D@D@DBHBDDH@@JLDBDFFFDDB@@@BDHFDDFDBDBBBD@@DDBBDDD@B@D@FBDFBBBFAF@@DFL@DBBB@HIF@DBF@@BDBDBDBF@@@@@@@@@BBBBDDDDFBFBFBBB@@FBF@FADCFEBC@E@E@EAE@AAAAAA@BC@C@AC@B@@E@@BG@E@A@A@GAE@G@C@A@EBC@ABCBE@A@I@C@EBE@A@E@CBC@IDE@CFA@C@CDCD@BCD@F@FBFBFBH@D@B@D@DADABADADADAF@D@BA@@B@B@FAFDHBBBDB@BBDDDBFFDDDDBDFFBDDFDF@@BFAD@D@FADAD@F@DBB@@@@@DFBDHDDDBBBHBH@F@D@H@FDDFBF@BBB@FBF@D@DAF@B@B@B@B@D@FBDBD@@DD@DDFBDBDBB@@@DH@D@HAD@BDCFAJ@F@H@D@DDDFBB@D@H@H@F@F@DBJBFD@B@FAFAB@F@F@DBDBFDDDBHBHBD@HAN@N@RDNB@@B@@@JBH@TDLDJBTLDFDD@DBH@@ADADADCDCDA@CBCBEDCDCFDBJLRLBBRBB@d@F@B@B@B@H@P@D@\\BVFB@BBB@@@B@@@BBFBFBHDBBJHFJ@@@@DFBBHL@B@@@ABB@BBAB@@AFCB@J@@@B@DBDAJEDADABC@CABECA@A@@AEEACAC@ABA@CDAF@F@DABI@ESOBC@@DKBEBG@CBCD@B@@@BAFBTBFBH@LBD@F@FADAFEBC@CBE@EDAHCFAJCAAGCG@EAE@C@AAA@CFA@A@A@AAAE@CFK@A@C@E@KAAAKKEAAAA@ADEJIJEFCDCBEA@EKCAAFC@AA@EAACAGB@@AA@AA@@AA@@BCDABEBGHC@C@A@AA@@@CBO@I@EBADIFG@@BCBIBC@AAC@@@AB@AEAEEIEB@ADAGEC@A@@AGE@@C@A@BBC@ABCACB@D@@@BA@@@GCMAEBIDCBABEAG@GAEAA@A@GDCFABADEDG@@@C@@@CAKGGECCCEIICGAGAC@CCEAACCAC@AACACAC@G@C@CBC@C@C@E@@@CBIFGHGDAD@BADADAHCJAF@FBBBBBHDBBFBHDHBFAJ@FAHCDCFCDCFCDEDELELAJAH@H@FA@@F@J@JABAB@BABCCKBADCJIDA@@DAHGFGFEBCDCDC@A@@B@@C@G@ADEDCFCDABADABADCBCBA@@FAFBDA@A@AACBA@@JNB@B@B@F@BFA@BBB@AGDABBDCCCAAHA@CLED@BCB@AAABA@BCD@CECBE@@@@@@@CACEAC@AE@@@QK@AHGLEBAACCESGCEAEAEA@CECCCACA@@A@BCD@@A@@AA@AAABCBEDC@@@A@AG@G@I@C@A@EBC@C@AAECEA@@CCEGCCAECC@CAEAEBC@E@EBEBEBCBGDE@@L]JSCCAA@A@CAC@CCCIAKCEAECCA@@AAACCA@CAA@CDCBA@@BA@@BA@@@AB@@E@C@G@A@@@A@ABC@C@@@A@@@@AC@E@@@A@AB@@C@CAGAIACIDE@@A@AA@@@@@A@@@@@@@@@@@B@@@@@BA@@A@@@@@@@@@A@@@@@@@@B@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@A@@@@@@@@B@@@@@@@@A@@@@@@@@@A@@@@@@@AA@@@@@@A@@@@B@B@@@A@@A@@@@@@@A@@A@@@@@@@@@@@A@@@@A@@@@D@@@A@A@@@@@@@D@@@A@@@A@@@@@@A@@@@@@@@@@B@@@A@@@@@@@@@@@@@@A@@A@@@@@A@@@@@@@@@A@@A@@@@@A@B@@@@@@AB@@CA@@@@@@@@@A@@@@@@@@@B@@@@A@@@@@@@@@@@@@@B@@A@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@A@@@@A@@@@@BA@@A@@@A@@A@A@A@@@@AA@@@@@A@A@@@A@@@A@A@A@@@A@A@A@@@@A@@@@A@@@@@A@@B@@@@@@BB@@A@@@@A@@@B@@@@@@@@A@@@@A@@@@A@@A@@AB@@@@A@@A@@A@@BA@@B@@@@@@A@@@@B@B@BA@@@@@@A@@AA@B@@A@@@@A@@ABA@@B@@@B@@ABC@A@AB@@A@A@@@@@A@@AA@B@BAB@B@@@@@@A@@A@@@@A@@@AA@@@@AA@@@@@AA@@@@@BA@@@@@@AA@@BA@@@@A@@BAA@@A@@@AA@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@B@@@@@B@@@@A@@A@@@B@@A@@@@AA@@@@@@A@B@@AB@B@AA@@C@@@A@AA@@@@A@@A@@@@@@A@@@@@@@@@B@@@@AA@@@@@@@A@@@B@@@@@@@@@@@B@@@AA@@@@@@@@@@@@@@@A@@@@@@@@B@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@A@@@@@@@@@@@B@@@@@@@@@@@@A@@B@A@@@@@A@@@@@A@@@B@@@@@@@@@@@@@@@@@B@@@B@@@@@@@@@B@@A@@@@@@@@A@@@@@@@@BA@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@A@@A@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@A@B@A@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@A@@@@@@B@@@@@@@@@B@A@@@@@@A@B@@A@@@@A@@A@@@@@@@@@@@A@@B@@@@@@@@A@@@A@@@@@@@AA@@AA@@A@@@@@@@@@@@A@@A@@@@@@@@B@@@@A@B@@AA@@@BA@A@@@A@AAA@@@@@@@@A@AAAB@@@@@A@@AA@@@AA@A@A@A@@@@ABA@A@@@A@A@@@A@@BAAA@A@@@@@A@@@@@@@A@@A@@@A@@A@@@@@@@@@AA@@BA@@@A@@AB@@@A@@@@@A@@@@@A@AB@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@A@@A@@@@@@@@@@@A@@@@B@ABB@@@@B@@B@@@@A@@A@@@@@@@@@@@@@@@@A@@@@A@@@@A@@B@@@A@@@@@@@@@@@A@@B@@@@@@A@@@@@@@@A@@@B@@@@@BAA@@@@@@@@@@@@@@@@@@@@@BA@@@@A@@@@@@@@@B@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@AB@@A@@@@@@B@@@@@@B@@B@@@@AA@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@A@@@@A@@A@@@@@A@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@B@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@A@@@@A@@@@@A@@@@@@@A@@@A@@@@@A@@@@@@A@@BA@@@A@B@A@@AA@@@@@A@@@A@@AA@@@AA@ABA@ABA@@B@@AB@@@B@@@B@@@B@@@BA@CBA@@B@@AB@BAB@@@B@BA@A@@@@A@D@@@B@BECCA@@AA@@A@@@@CB@CC@@A@@AA@@@@@@@AA@@@@@A@@@@@@@@AA@@A@@A@@@@@A@@@@@@@@EACAA@@@A@CBAA@BA@@C@@@@CBBA@AGBA@@@AA@A@@A@AA@@AA@A@A@@@@A@AB@@@@@@@@AA@@@@@ACAA@A@@@@@AA@@@@@@@A@@C@A@A@A@@@ABA@A@A@AAAACGACCGAACAECGCCAACA@CCA@AAA@A@EBC@CBA@A@A@E@A@AAEBA@C@CBA@A@CAE@E@EAC@CAAAC@C@MBE@E@A@@BC@CDABCDA@A@AAAA@G@@AA@@ECE@CAC@A@C@AACACAA@C@C@"],encodeOffsets:[[121600,36213]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("泰安",{type:"FeatureCollection",features:[{type:"Feature",id:"370902",properties:{name:"泰山区",cp:[117.135354,36.192083],childNum:1},geometry:{type:"Polygon",coordinates:["@@DDF@DB@@@FDBB@@AH@F@H@FA@@D@BAD@DE@G@ADA@AF@B@F@@EXBDBR@DBD@D@B@BAFEBA@EBC@C@C@A@A@A@C@ABK@CA@@AA@AAA@@AA@@@@A@A@@@AA@@AB@A@CA@@A@AACCCCEAAAGGCGCGCCAA@A@AAAECC@GBABC@C@ABCBC@@@ABABEDA@A@@@CBABA@C@AACCECAC@A@A@@@A@@BA@A@A@CCA@CACAACGAACCA@GEKCCCC@AAAACCA@CAKGG@MAEAICKCA@A@CIECCBGF@B@B@@@B@DCB@BCB@DBDEBBFBHCBBBC@OBA@A@KDAN@BDHBFBJC@@@AB@BD@@AB@AFADCBBB@@A@A@C@@@AA@@AC@@@@@@@@@@AAAAC@C@C@MBCEC@AAGBA@A@A@@@AAA@AAACEAE@C@@B@@E@CDC@BBCF@EC@G@@DEA@DA@AAAA@DC@@AKA@CAAG@@AIA@CKBDBG@CBAA@@A@EBABA@C@GHGBBBBDCBFBB@BBBBBABDD@@@CBB@F@B@FAFDBADHDB@@D@@DA@BH@@HC@DB@@AB@D@BDPA@BB@@B@@BDBB@BA@@BECA@A@@B@D@B@BE@DH@@@DAAA@@B@BA@@@AAA@A@@B@B@@A@A@A@ABA@A@@EE@C@@@A@E@@F@@@@@B@@@BA@A@C@C@@@@D@BAF@B@F@BAD@@@@@@@@@@@BAB@BCD@BA@@BADB@@@@@BB@@@@@BBBBFBD@@@DHDFBFD@FHDBD@BAFEBABBD@F@BCB@@DHBDFDFBHDJDB@D@@DADCHCDA@EJ@DCLG@CFKFGDBBFHADIHEA@ABA@AKBOHFFF@DDH@FADDBF@DBHADAD@FDPBDDNFFBD@@FBDJAJBBBAB@B@@@B@DA@@@@BAB@@AB@@@DAB@@ADB@AB@B@BABA@@@@D@FD\\EDCDAFAD@FBDCJALGFEHI@AAAJKJAB@BK@GBABCBKAC@AFCDAFCNC@@B@B@PEHADCBA@@BAFEDCFCJCFCBA@A@@AA@@H@FCNIECAA@ECG@CBABEBIFCBABGBEDGACBA@ABAB@DDD@B@@ADEB@FC@@DABEB@@@HGB@B@"],encodeOffsets:[[120073,37074]]}},{type:"Feature",id:"370911",properties:{name:"岱岳区",cp:[117.041581,36.187989],childNum:1},geometry:{type:"Polygon",coordinates:["@@I@GDCA@@IG@@CC@@@AA@GDC@GDAB@J@@@BC@CBA@ABAB@@CDCD@@ABCDABCDABCHCD@BADDJ@D@D@DBBBFBFAFABCDGDCBCBGBCDEAEBCDGBABC@GAE@CBABA@CBCBC@A@E@E@AAACACA@ECC@EAEAAA@@ECKAA@K@C@EBC@IB@@KBI@GB@@EDMDCBI@I@QBC@E@ABOBGBK@E@CA@@A@GAK@@@G@CAA@G@C@@@I@EFAB@BABAB@BBF@D@BBB@D@B@@@D@BAF@B@BBD@BB@BB@BBBAFA@ABG@@@AACD@B@BBB@@BB@B@@@FAF@@FDHJBBHJDDDB@BHFNJDBLJDJA@CDGJKFOH@@C@MDQ@A@@@KBC@@@C@MAIDAB@BCDC@CDAF@BEBC@EB@DC@C@CBE@C@@@AA@E@AECG@ABC@CFC@EAE@EBEAGCMA@DDFDD@FBD@@D@RB@B@BBBBBB@D@BABBB@DDD@B@@B@@CJ@FBBDD@@@D@BA@@DABABGB@@@@@@AF@DBFA@@DA@EDCFDLCD@DABBF@BAFABA@C@OGBAAACDC@EAABFHH@DHB@@A@CHBDDFAB@@HDB@D@B@@ABYGEB@@ABCBA@EEA@AHBB@BDH@BADIPAHERCFGNDPHJB@HJNHBDJJD@AH@DDBFDDHB@H@FB@LCB@DBDD@BDFHBDHFAFELABADBBJBRDBBDBHFDDPFB@@DDBD@D@@A@AFGBC@CDAFBDA@@DABAPCLFFEFEDE@AFAJEDCBAJBDBBFFBDBH@B@HBDBFDB@BCFB@@ADABABB@@@BBA@@@@BB@@B@DCFBBBAD@FB@@H@DBHBJBF@BB@FBJFDPFB@DAJDBFDDFDDBFBPGLA@BAB@BFBJGBCEGAAHCLEDEH@DK@CFIB@DCDGBC@CC@A@ICGCEAECACCG@@DA@A@EACBAFABE@AACGC@EECEAGC@C@@ACAEAA@A@@@@AA@@@@A@BC@AB@@ADC@ABA@A@@@@@@@@@@BC@A@E@ABE@A@C@@D@D@B@B@@A@@@A@@@@@EF@B@@@D@F@@FB@B@BAB@B@B@@@@A@AB@B@BB@@B@@A@AB@BB@C@@CGF@@A@A@C@AB@B@FD@AB@@AAAAC@@@AA@@AOBACC@A@@BA@@CGD@@AGB@@CC@@@CACGABECEBA@E@A@DA@@C@ACABAAAAA@EADAACAAHAHGD@B@BAFAB@@@BBDAH@CALA@DJB@BH@BB@DLB@BD@@CBBBBB@@CFB@CH@D@@FDEAAD@DCF@@@@AD@F@FBBDBBB@BB@@B@B@B@HABBD@DFNAD@D@D@BBBB@@@@@@@@@@BD@@BB@@D@B@B@@@AADABCBEA@@BC@@ABA@@D@AIAECG@ABMLCB@B@PAD@AADAAGAEFAAC@CDA@ADA@C@A@@@A@AHEDAFDDJB@B@LDJDFBNBH@LHDBB@DDBBBBD@DDLDHFB@DDBBDHBBBD@DDB@D@B@BAB@@@B@@@B@BBDFDDDBBD@B@BADA@@B@B@FCBABA@@D@DABAD@D@BAHAD@FDBB@B@BBBDDDHDHHHBBFBDDDDBBB@@@DBB@A@@BB@@B@@@B@B@@B@@BB@BBB@@BB@@DAL@B@D@B@B@B@D@DAD@FABEFABA@C@C@CAQ@CAWA@FE@A@E@@BCB@B@HCFC@ABC@@@EBG@E@G@@BA@CA@E@@CAE@CCA@A@GH@@A@AFCB@@EDA@CF@BA@C@CCA@AB@BABBDCHAFAHABEDAJAFAB@DDH@FBBFDMJEDG@@@BB@@@BABEDIDEDCDEFAB@@ABCDGBOFA@A@@@MDEDCBED@BBDALADAB@HALA@IBILBB@BGJEFKHIBCDADADJJDDD@FAFBHDLFDBP@J@JBHPCFA@AD@@@DDJFBHFDBDB@@DFNRBDBJBBDBFF@F@BT@FDHFLDF@DADAFFBD@B@BHLBHBDBJBFDBHHHBDFFHXD@@DHFJDBHHDFBJAD@BH@FABAFAB@D@DAD@B@@IBAFBD@FANAN@LBH@DAJCBOIMEU@O@@@GBIBKDKDKJUBE@@BK@KHM@@DGBCHKPK@AHEDEJEBADCHEBIBC@I@A@@A@@@GCEECEAEDM@ABAFEBABCBE@A@@AAAAAA@E@@D@D@BA@@CA@AACJ@DAHA@KII@CBAB@@E@ADBDAHBF@D@JBB@@@@A@GBK@IBKBCBAEAA@G@A@KEAAGAI@@@AADG@IAAA@K@A@@AFCD@BAB@B@@EDAD@@A@A@AD@BAHCRCLD@@BFB@BBJDDDJBDCDAB@B@@AH@J@BMAEC@@@@@DIB@BBDA@@@@B@ACAACAEEAACA@AACAAD@H@@GBA@ADEDCB@DB@AAA@A@CFAHEBCNDBABA@DLBDEFAHBD@BAAAAAABAABGFG@GCAOGAAE@IB@BDBADA@EAEEGAEGAC@AAEAE@ACC@EAAB@AA@@@A@C@@A@AA@A@@CG@ADADCF@JBJADA@AAAKCE@AABCACDEACBCECEAABCABCDAAAEABCBAFCDBBA@@AGBABCFIB@@ABAFCFIBCAAA@@A@@DEACKCA@@AGIGCCCMG@@IC@AAACCAAA@EEMIA@ECCAECMBAB@@@@EBEJOLWAQAOACAA@UECAGAMIGOCEGGAAMMKECA@@AA@EBEDCFEBADAB@FCFEAC@EACAACEACAE@A@EDCDGBCDCBA@@@G@E@AACACAA@AA@AACACACCACAAACCEAE@CAACEECEEA@AAECC@AAAA@EAC@A@AACAC@@AC@C@A@@@A@C@@AEAAAAA@EACBC@C@A@C@A@CBABA@IDCDC@C@AAA@ACAAACCACAAACAA@EBA@C@C@A@A@IBCDCBEBCBA@ABEDCFCB"],encodeOffsets:[[120028,36751]]}},{type:"Feature",id:"370921",properties:{name:"宁阳县",cp:[116.805796,35.758786],childNum:1},geometry:{type:"Polygon",coordinates:["@@CACACAC@EBC@E@KDIBGBA@G@GCC@ACAAA@A@EAC@CAA@CAE@A@C@E@@AEAECA@AAA@C@CBABABBBFBBBFDBDBD@B@D@DCBCBEBC@A@@BG@C@IBC@CAC@GBIDABADAD@D@B@BABADA@EFC@E@E@EAEAEAECC@EAA@@@EAC@G@EBGBGBC@G@IAE@C@EAG@EDEBABA@GDEBEDG@E@A@C@GAGA@C@C@EBCBECECEAC@@CC@@@A@ACECE@E@CAGGG@AAAGCC@A@CAA@C@CAE@G@GCCCACAACE@CACDC@ADAFE@@BCBCAC@AACDB@IFA@AC@AGC@AA@@@@CEE@G@AAQAC@AGC@A@@AE@@CA@IA@@ACI@@CC@ACEBSBEACBCAA@A@@CEB@BAB@@CCCG@G@AAC@C@CEB@GEA@FGAAEIAC@@FC@G@CB@DGB@B@DGA@DC@A@ABADADEBAD@@G@I@A@GA@FBBABAHA@A@A@A@A@C@G@AC@GAC@CAICCAECACAEAEAA@ABA@A@CBA@A@@BC@AB@@@@ABABCBA@@BA@A@@BABABAB@@AB@BA@@BAB@@@B@BAB@@AB@@AB@@AB@@@@@@AB@@@@ABEFCAA@C@AAEAEAE@CA@@@A@CG@E@@FG@C@A@A@A@@@A@@B@@C@A@E@CBC@A@CGOBBJ@@A@GBEDCFADAF@FADEDE@E@CA@@EAA@GAGAGCA@EAECE@EAC@CAE@EAEAEAEC@@A@@@@@CAEAA@EACAA@E@E@@@CBC@@BC@@BAFAF@F@D@F@B@DAFBFBB@BAD@F@@@H@BBB@B@JBFDFDBDBDDHBFBBBB@BBFBF@H@FADAFAHCF@FAD@B@BB@@DBBBBB@BBBBDBDDFDFBDBFFDDFBFBFBD@B@BBF@@BDBF@@BF@H@BAFADED@@EFCDEBABCBGDC@CBIBGBEBABEBCBA@CDCBCBGCEDCDCBABEDABCBABCBCFIBGFBHJFAB@@@D@FBF@@BDBB@BDDDDDBBBDBHBLBHBFBD@BBD@DDHDFFFDB@FDDDBB@@@FBD@D@@@DBD@B@@BB@@BD@DBD@DBB@DBBFFB@@@JAHAB@BAD@B@D@H@D@DAB@DAB@B@DBB@@CJADDFBFAF@BB@AJAJE@BDABC@A@CACACACA@@CAAA@CCAA@@BGDCBCF@HADAFCFCHAD@D@B@D@H@DBLDB@DB@@FBFDHFDDFD@BDBBBB@DABAB@H@DADAB@H@JADAFCDCDAB@L@N@JBBBD@FFBBDBDBBDBJ@@BHDHDDBBFBP@HBJFDBLDNFFBDDDHBHDDDDDBH@J@FBPFFBHBJ@F@DBFBH@B@B@F@R@D@B@B@J@@@D@H@B@DBH@@@L@HBB@@@DBF@L@HAPABAF@D@RAJ@J@DANCFC@@HAJ@LA@@JAD@FAD@L@B@LBFD@@BBFBFBD@FDB@BDBDBBF@F@B@D@DADAB@BADAF@HBD@BAHADCFAFBDCHADADAHCDCBABEAEAEAA@C@C@CCIBC@ADCDGBADCBADCBA@@DCDC@@BABAB@DAD@@A@@@IBAHCD@HCB@@B@@DD@@JH@@DBHCJ@DADEFCBAB@DAFADADCJAB@B@D@D@B@FAB@DBBBBCAA@EBEFEFC@@@@DAFEBCHEBEBCBK@ABC@A@@DE@G@E@A@ABCBAFIBADEBADEDEDCFIBABCB@DAH@H@FAECAAAAAECCAAIAEACCCCCAICIEC@A@CAA@ACCACCE@A@A@A@A@CBG@EBG@G@GBI@G@@@@@CBIFCBC@G@E@EA@@"],encodeOffsets:[[120020,36637]]}},{type:"Feature",id:"370923",properties:{name:"东平县",cp:[116.470304,35.937102],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAA@AAA@E@C@C@A@CBCDA@CDA@@BCBA@@@CBA@C@AAAAAAAAAACAC@C@E@A@A@CACBA@C@A@ABCBABCB@@C@A@E@A@C@C@EACAC@CCAAAAAAACEGAAAAAAA@CAA@CACAAAAACA@ACAAAAACAAB@@C@ABCBA@ABADAB@B@DAB@B@DAB@D@B@B@B@D@BDBDBB@DBAB@BCBA@C@@@@FEB@@AGGBC@C@A@@B@@BDC@AAC@@BG@@CE@@AEFAD@@GFCBCBABGFADMJDFABABCFCBCHEFABAHABAD@@@@CDADA@@D@BAB@B@@@BABAB@B@B@B@BBB@B@D@BBD@B@B@D@B@F@B@B@B@BBD@B@D@B@B@BABABABA@C@A@A@C@A@CBAAAAAA@A@A@C@A@C@A@A@A@AAAABABA@ABAB@B@DBDBB@D@BABCAAAACAAC@A@CBAB@BABCBA@E@EBAAC@CB@BADAD@BADC@C@C@GBABABA@ABC@E@AACAAA@@A@@CCCAC@CAAAC@AAA@AAAAAAAC@ABABABAB@BAD@DAB@@A@ABC@CAC@ECA@C@CAA@AAA@CBGDC@GFE@AB@@SHGD@@@@@@A@GBEDEFIJJRSHC@@BADDDEPEA@BG@C@GAAC@AKJ@@ABCDCD@DFDFDFD@@FD@BB@DHDF@B@B@DADCH@BEFEDIFA@@@DX@H@DBFDJ@DBDBFBHDFDL@B@@BBFAPC@B@BB@BD@BBB@@@B@@@D@B@BBB@B@BB@BFA@BB@D@HA@CD@@@D@@@DA@@DFA@AHAF@HDDDC@Q@@B@@B@@DB@@BD@@B@@BFJ@@DA@@B@@AFA@@DAFD@@@@@AF@BJB@H@BAFB@@BD@BD@@B@B@@B@BBLABBBCB@BF@BBAD@@AD@@@BDBABHFFDJDFFDJBF@DDDFDDBHBBBDBHBFBNBTBVFDLCR@@@F@JBBHDV@F@JAP@D@B@D@H@RAJBHDD@@@@@DBLMDE@@LKDC@@BAHIBEDEDIBA@AJOFIBCJIDCHK@E@@DKAMCMAG@@AGCI@C@G@OBO@AHQF@BALEBADAJEJENABDFJ@RHHBDVBHBPFBBJDFDJFNLDJDDFNJDHDPDL@J@LAJIDKBIBAFAJGN@X@@A@@D@BADI@A@AE@AG@EJC@C@CD@BCACAAAEH@@FHDBDD@B@BD@@@@F@BJBF@FL@BF@@JDB@NDH@J@JAB@J@XBB@B@B@LDHDLBB@DBFDLDFCTIBABAB@DCFEDEHGBA@@FCRE@@B@DMES@@ACFGBCRBLHFDPFXDN@J@NC@@F@F@JAPDRAHC@@DABIGMIKGIEGGIECCG@CAAAA@A@C@C@A@CAC@ACEAA@AAC@A@GAC@A@C@C@C@C@ADABAB@DAB@FC@ABABA@A@A@A@AAAAACEAAC@A@IBE@EAC@AAA@ECCAEGKGCCCE@ACAAAAC@CAG@CCCACEAEAA@M@GAOA@@ICCACAAACC@@CE@A@AAC@ABCAAAACE@C@A@E@I@GEMSO@@GEI@E@G@GLCEGOOBC@QEMBE@QMCAEBIDKKEC@I@O@@A@@ACAC@@@C@@AA@C@AAAACAC@AAC@AAEA@C@AAA@A@C@ACAAACAE@ABA@ABA@A@ABA@C@CACAA@AA@@"],encodeOffsets:[[119208,36656]]}},{type:"Feature",id:"370982",properties:{name:"新泰市",cp:[117.767952,35.909032],childNum:1},geometry:{type:"Polygon",coordinates:["@@EGGIIM@AAICM@A@CAGCMCc@AGFCFQP@@@@MLEDCBADA@@@ADCBABABCB@@A@CDCBCBCDGDCDCB@@A@@@EFCBABA@EFEDCDEDCDCFADAB@F@B@BBDDFFDFBBB@BABABCBA@CBC@C@GBC@E@ICEAA@C@C@@@@@C@@@@@CCA@@@A@A@AB@@BD@B@@ABA@AD@BA@DF@BAB@BADCBAFCD@@CDCDCBA@EBIDEDEBSFC@GBA@G@CBAHADABEJADCFABEF@@ADEFEDEDABA@C@CAKEA@CAE@@@EAA@CAA@CAE@ABABEH@DCBC@C@AACACAICCAA@@@CBCBCDABCFADEDCBA@@@EBEBABAJ@FABCBE@EBGAC@EAIAE@A@CBCBCDEBEBG@G@CBA@ADABEJCDCFCFABCFABEJABAD@B@B@F@HCF@@@BAD@BALADAFGFADEFCB@@@@EDEFAF@FBBADDBDBBDBBBDB@BBD@D@DCJCB@BADAB@D@B@D@D@DAFBB@BBBBBF@@@D@B@@@B@DBD@@BDBD@B@BBD@FBBBBD@FDBBB@FFFDDFBB@DBFDFBDBBBDDDDBDBBBB@@BBBBDBD@B@F@H@@ABCDADCHCD@F@BBFBDDFBBBD@FBDEFEDA@CBABEFCDAF@FBB@@DBLFNNBBHHDFHPNJHBDBVFB@DBPBRBXBPKFIFA@@@@BANAFDDBFDB@NJFFB@BBDDBB@BJD@@NHDDHDHJ@BB@LDBDCF@@@BB@BBADEJEDAB@BA@EJADABBHFDB@F@JABBFDDADABBB@D@BDBBB@AFBBFFBBJ@DCAEDBB@DBBBJBJCDBBBFBDBNHPHB@@@@@JDJ@F@DEFEBGBC@CFSB@HIJGJCREH@HBL@H@R@JCPGNGRKRGFC^IH@\\@ZDFBHEDADA@@HCFALEF@DCHCB@@BDCB@BCCA@AFCBAB@NBFDBBDDB@@@B@@@HG@ALG@@DCHKEECCCCBEFA@ABABG@C@A@A@C@@@A@A@A@A@@@AD@FA@@B@@@B@B@BBDBBBB@@@B@BBD@BB@@B@D@B@D@DB@@@DDDDB@FABCB@BDFHBHFB@BBJADAH@D@DBB@DA@AAA@@@AD@BDDDF@@CB@D@D@BBB@BADGD@DBBBBBHADC@AF@BBCH@@B@LCBA@AFAB@BBBBH@D@DCF@DBBB@BDBD@DHDBPFD@HA@@FBBBF@FBB@@ALAFBD@B@@@B@JGFA@@B@HMAC@ARABABEBG@CBC@ABC@AACAC@CACBABCBCBCBCDAD@@A@C@A@A@A@A@ABA@CDADAD@BADBBBD@BBBBBBDDBBDBBBBBDBB@@BB@@@@@DAB@@@B@D@D@B@@@D@B@@@B@@BD@BBDB@@B@@@@@B@@@B@@@@A@@@@@@AAAAAC@AAAACAAACAC@A@A@C@CBA@CDABABA@ABCBABA@ABABABC@ABABA@A@ABC@@@@@A@@@A@A@@BCEMKMEECAAAEA@@AAAEAE@CBC@EAC@A@@AAA@IACA@AA@AAACGKAEACAA@AA@C@EBKDEBCBG@C@GBABCBCBIFA@CBE@KBC@@BG@G@E@A@A@GBE@EA@@GCA@KGEGA@CACCA@AAAACCACCECE@AAAAA@C@@CEACEAICCAEACCAAOQAC@@@@@@AACEAGDCBADCBADA@@@@@@HCDEB@BCBCBABC@CBC@E@CAACEGEEACAA@CACAAE@C@AAEBEBCBEBCDMBCBC@A@A@ADM@C@EAA@AAAEAEBC@C@EBEACCEEGAC@@@@@C@G@@@EBC@A@IBE@E@EAC@EACACAECCCA@ACCCEIEGCEGCG@EBEBE@GB@@I@ABEBEBEDEDCDEB@@EDEBCBE@C@CAEAECCCCACCGCGACAGAEAGA@@A@GAE@CBG@GBGAI@GAC@C@QKAAAAACCECCCG@@@@"],encodeOffsets:[[120440,36548]]}},{type:"Feature",id:"370983",properties:{name:"肥城市",cp:[116.768358,36.182571],childNum:1},geometry:{type:"Polygon",coordinates:["@@M@K@A@CBCDEDCBIBG@A@CBCBG@A@ABCBA@AACA@AECCCGEECEA@@CAA@KCCAG@C@A@C@C@GBEDEDCBGBE@ADCDAH@@BBDDB@BB@DB@BDBDBD@D@BADCB@AIFIB@BAAE@EBEACCIB@DA@CAA@A@CBA@CBC@G@C@A@C@ABA@GBIB@@ABAFAD@@EB@BCHADABC@C@C@CBC@E@E@E@CACAAAE@A@A@BBDFBBBB@B@B@B@BABAB@BEDA@CBA@ABCB@B@D@D@D@D@BBD@H@BBD@BBBDF@BBD@D@B@D@D@BBBBB@DDHFDHJFHHJJLHNAJCB@@GDQBOCIBE@E@@@MDI@M@WCOEECKGQAADEHBD@@FTCNA@@@QFED@@ABGHCFEFCDA@ABABSJEDFJHF@@@@DD@D@@B@BHBFDN@BPN@BLPBDBFANCLSHKB@@@@EAEAACKAEB@@E@@D@BF@A@BDBB@DA@BDE@KA@EG@CAMBBF@BDA@FA@BBF@@B@@@BD@B@@BFHABBDDAB@DNDH@BGBMDCFEF@@ER@@INYBIFAB@@A@@AA@AB@@CF@DA@A@AFB@B@@DC@CFCF@B@@FD@@@@@@BB@B@B@@JDFBBFB@BDBB@BA@A@AB@D@HL@JDJBDDBAB@FAADJH@D@DBJBDB@B@BAF@F@HBBAAC@@CAAABA@A@@BBB@HDD@BAFCBEF@FBNBD@B@BB@B@B@@AB@DDHB@@CB@DDBF@BHBLBF@F@B@@AAE@C@AB@@BF@B@DCB@BBBDCD@BDFBBDHRD@@JJDDPJFPBDBDH@@@D@LBD@@@BBD@L@@@H@NAHCJENKDCFCJEBAVA@@D@@@B@JBNFL@HARJRPJFhNFB@@DBFBDBNHHFB@J@PADATIVKNEBBNCH@FAJDF@LBFFBD@@B@NA@AACAC@ADE@A@C@AB@@AFCJCFEA@CAA@DAFA@MFCAEFCDAH@DAFCBELCBCBAFKBEGEACEGACC@AC@CDA@KEAG@A@CGECCA@CBGC@IIACMGGIA@GICOHMDEFQBGJOBC@ACG@AAABGB@FFB@DABA@@FAZHBA@@@A@CCA@GA@EBCCGA@D@BA@CGG@EGBAFBD@DCBBABPHD@B@BABE@AAEBA@CDCCKDEFCB@@CB@AE@CBE@@@@@@HABABA@CB@@A@C@@CCAA@EDI@@@AA@C@CCA@AAABC@A@AAAA@A@AQAC@@@AC@ECCCE@CNBHDFBFAF@FBD@DED@BAH@FD@B@FBB@@D@F@DAD@D@@CFAD@FA@ABEDCD@DC@ABAJCNBD@@@D@LA@@B@R@NCD@@@PGLEHIDCB@CIKICAMIGE@ACACCGIAAGIEC@@BE@E@@@AAA@@AA@A@ADCBB@@H@BAB@BEAA@AAAA@@AAC@A@ABE@A@C@@@A@CAA@A@CAE@ABABA@ABAFEA@A@C@Q@E@A@A@G@EACAE@I@GAEAOEEAI@G@CACCCCAGCGCCEAMEKCCAIEGAO@EAAACCCGAG@@AIACCACAAAEEC@AAIA"],encodeOffsets:[[119640,36753]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
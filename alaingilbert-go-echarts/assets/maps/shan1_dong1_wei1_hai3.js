@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("威海",{type:"FeatureCollection",features:[{type:"Feature",id:"371002",properties:{name:"环翠区",cp:[122.123443,37.50199],childNum:10},geometry:{type:"MultiPolygon",coordinates:[["@@A@@@@B@@B@@A@@@@"],["@@@@A@@@@@@BB@@@B@@A@@A@"],["@@@@@@@@@@@@@@@B@@@@B@@A@@@@A@@@"],["@@A@@@@@@B@B@@@@B@@@@@@A@@@A@@"],["@@@@ABA@AB@@@@B@DAB@AA@@"],["@@@AB@@@@@@AAB@@A@@@@A@BA@@@AA@@@BAB@@BB@A@@@@B@@@@@D@"],["@@ABABABABBB@@B@B@@AB@F@B@@AB@B@AA@AA@A@C@AA"],["@@@@B@@@B@B@@A@@@AA@@@@@AAB@BA@@@@@A@@B@@@B@@@A@@AA@A@@@@@@BA@CB@@AB@B@@@@@@@@@BA@A@@B@@B@DBBA@@"],["@@@@@@BB@@BA@@@@@A@@BA@@B@@@@@@@@@@A@@@@@A@@@@B@@@@A@@@@B@@@@@B@@@@@@B@@B@@@BA@@@B@@B@@A@@@BB@@A@@BA@@@@B@@@BB@@@@@BB@B@@A@BBA@@@@AA@@@@B@@A@@AA@@@A@@@A@@BABABAB@BA@@BBB@B@@AB@@@B@B@BAB@@A@@BA@BB@@@BAB@BA@@@@AA@BC@C@@@A@A@A@A@A@G@C@C@CBC@C@A@I@ABC@A@@ACBB@@BA@GBA@@@@C@@E@@@@B@@D@@@@@@B@@@@E@@@AB@B@BA@@B@@C@@@@B@@DB@@B@BA@@@A@@B@B@@@@B@@B@@BD@B@@@@B@BB@B@@B@@@B@BB@@@@B@BB@B@@@@B"],["@@AB@@A@A@A@@AAA@@AC@@AAAAAAAACA@AAAC@@AC@@@@AA@@AB@@ABABAB@B@@A@@@AAACAAC@CAA@@AAA@A@AAAAA@A@AA@@@AA@C@AA@@@AA@AA@AC@C@A@C@A@AAC@C@A@CBAFAHABE@CBA@EAC@EEA@GDCDCDC@I@ADADMEGCKCEAKCG@C@@@BB@@@@@@ABAAA@@@@@A@BAA@@@@@@AA@ABAA@@A@@@A@AB@@@AA@BAA@@@@@@A@@@@A@@@@@@A@@@@A@@@@@A@@@CD@@AB@A@@@@AA@A@@A@@A@@@BA@@AB@A@@AB@CA@@@@@AA@@@@@@@@@A@@@@@@@A@A@@A@@@@@AA@@@GAA@C@ADAAAB@@A@@@@@ABCA@BA@ADADAACDA@@DE@@BA@AAB@ACA@AEG@IEACH@DE@ACCBIGCCBIBAFE@ABAIABA@@CC@AFAKAAEI@CAABCICCBKCEAG@E@IBEDEJABABG@EAC@CAG@CA@GMA@N@B@@@P@B@T@@AFGAGLGFK@MP@BBF@BBB@B@BVAALFBDD@D@@AJDBHBADABADED@B@@@B@@BD@BDB@@@B@HABA@EB@DCD@BDBB@BD@BCDABADD@ED@CCBABBD@@B@@BA@@B@BDAB@@BD@@A@CB@BB@@@BB@@B@BA@@A@@C@@B@BAAABD@BA@BB@B@B@@BDDBBBBB@ABCB@F@BBAB@@BC@@D@BB@ABA@@BC@@@A@C@CAA@I@I@A@A@@A@@@A@@AA@@C@E@BC@A@@AA@A@AA@@@CBABC@A@A@C@AACA@AEBEBA@EBC@CBC@A@AAAAA@EABLDN@P@@BTBDJNNLBBPHFDJDDBJN@@BB@@DDBDHN@@BB@BFLBF@BBJBHLXFD@BBFH@@D@B@DA@ABABC@@@@@@B@JGTAFNFpRTHDBB@D@DAB@H@F@F@HBPFFBBB@@@B@@AD@B@@BBBBBB@B@B@B@B@BB@B@@@B@@AB@@@J@DDFFDHBF@DAB@@ABADBFBFBBBB@@D@H@B@@@@@BA@@B@@@J@F@F@F@FBFBFD@@@@BB@B@BAD@@@BB@BBBBFD@@D@BABA@@BB@@BB@@B@@@BABADEB@@AB@B@B@BA@@BBB@B@BBBBBB@FAL@FABCFGJABAD@D@B@BB@BBB@B@BA\\SHGJANCJAF@@@B@BBFDB@B@@@B@BAB@@@B@B@B@B@B@BB@@@B@BENAD@D@@BBBBB@D@BADABAFKBA@ABA@@LBD@F@J@D@@@BA@@@A@AA@@@AAA@A@@@AA@@AAA@AA@@@A@AB@D@BAB@B@@ABC@@@@B@@@B@BB@AB@@AB@BAB@BBB@B@@ABAB@@@B@BBD@BBB@BAB@BA@A@A@AAA@@@@@AB@@A@A@@@AAAAAEEAAAC@@@ABABA@AAA@@@@AA@@AAA@@@A@A@A@A@A@AA@BA@A@@A@@@A@A@A@@AAA@@@A@A@@@A@A@@A@@AA@@A@A@C@C@CBA@EAGCA@AAAA@@@@H@@IC@@@@A@@BEBC@@DAD@B@D@D@PE@AFABC@A@A@AAACAAAAC@CBG@@FKBGBEBABEHGDCB@BCBCBC@C@@@C@C@EBAFCFEJAFAF@DB@@BFAH@FLJDF@DAFDDD@FCHBDAF@D@DBBBDFBDB@FDD@DAD@FED@B@BB@H@FDDFAFELBFBFBHAHMFCF@F@D@B@F@DCDCFCJI@EAAEAC@AC@A@E@EBEBEFCBAFAJAB@HALBB@J@DCB@@AAC@CF@J@VCB@F@F@NBB@TBD@J@FBXDBBN@B@D@BAHEBADAD@LADO@CDEDEBABE@AAC@@@A@A@@@@AAC@AAA@@A@@GA@@A@@AB@@A@@A@A@GB@A@CA@EABE@@CAC@AA@AA@A@A@@@AA@@@A@AA@BE@A@AG@AAA@@@@A@ABCBE@A@CAA@@A@@AA@@@C@A@@BAB@@A@CBAA@@AC@@CAC@KF@@C@@@@A@ADECACC@CAAA@@ABEAA@@BA@ABCBAB@BA@@HEHC@@@@@@DCD@BABA@A@I@@@AD@B@@@@A@@CCAC@A@A@@D@B@@@B@@A@@@A@A@I@C@AAC@@A@AAC@CAA@C@@B@@@@@B@B@@ABABCBED@@A@A@AAAA@C@AA@A@BB@@ABA@CCA@CCA@@A@A@CA@@@@CA@@A@A@@@ACB@@@AA@@A@@@A@@AA@A@AB@@BBA@@A@@@A@A@@@A@A@C@@@CBC@ABE@A@C@C@@@@A@@DCA@C@ACAA@@@A@A@A@AA@@@AA@@@@@A@A@CA@@A@@@G@C@A@@A@@AE@C@ABCB@BA@@B@B@@A@@@@@C@CAA@A@A@AB@@@@AD@B@@B@@@ABE@A@AA@@A@A@C@A@A@A@@@AB@DA@@@@AA@A@C@C@C@A@C@AB@B@B@@BBA@@@ABA@@@C@AAA@ABAB@B@BABADA@C@OBEBE@O@EAK@SAQAG@C@@@A@ABABA@@BC@A@@@A@@@A@A@@@@@A@@B@@@@A@@@A@@@@@A@@@@@A@@AA@@@AB@@@@@@"]],encodeOffsets:[[[125134,38412]],[[125140,38379]],[[125100,38426]],[[125105,38427]],[[125154,38395]],[[124942,38430]],[[124927,38429]],[[125015,38477]],[[125116,38416]],[[125120,38182]]]}},{type:"Feature",id:"371003",properties:{name:"文登区",cp:[122.05767,37.193735],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@A@@B@@@@B@@@@A@@"],["@@@@@@@A@@B@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@B@@B@"],["@@C@EDAD@DBBDAD@DE@CAA"],["@@DE@CCCGCAAAAAYCKAAA@A@AFAD@d@FIFKJCJAZ@F@L@NAFADCFADA@ADCRAFCBGBGKSEOJG@ACCICIIGGEICIAIASCOASA]I]KG@G@GAKASGAAMI@R@TAJ@BABEHCDCB@D@D@DBDDBBBHD@@@DCNCJADCDCDCBC@E@C@C@CAECCAA@A@A@ABEDABAD@BABCBA@A@C@EAC@A@A@ABCBABCBA@AB@DABADADADABABCDEBGBK@GBG@K@MFABK@BNDDTHNHL\\@@@BE@EFADBB@@B@B@JFA@@DCDKACBABAB@FEABB@BABABAABA@ACAI@AJBBAFCBCD@BFHHL@DC@AB@BDD@B@@A@ECCDEDC@CBEAAAA@A@ABAD@@C@E@@@@@BAJCCAUIAA@D@F@BFFHD@FCD@DDD@BAD@D@FFDHFBFDBFBBBDBDDAT@@C@GBA@EFE@BD@BAB@HL@H@JF@BEFFHBB@B@FD@BD@BA@CBA@DDFB@B@FB@BABCB@DDFHAD@BC@@BJDGFBBBBABADC@AAEEC@@CAAA@EDA@AB@B@@ANF@BI@AFD@BFAD@@F@FAFA@BHHAB@FBCD@@AAA@@DDBB@@@FB@D@DAFEFAAGC@B@FHHBB@BAHEB@E@EAA@B@FCBCGC@AFD@@JCF@@A@@@A@A@C@ABA@@@@B@@@B@@@@@BB@@BAB@B@B@@B@AF@FDJBDBBNFb@BBBPFHDBHD@JHLADBDHV@D@BCPBDDBBDFAFAZCD@PHHPPLPJZJJDLNFBB@BBBBB@D@DAD@FAB@FAFA@BDBBBD@B@B@D@BADA@@B@@B@BBB@@@BADF@D@@@BB@@@B@@@BB@B@J@J@B@DBD@B@@@D@@AB@BAA@@A@CD@@AA@AB@A@EDABAA@AAAACC@AA@A@A@@AABC@BABB@A@AD@@@@BB@@A@AA@@A@@AAA@@D@BC@@AA@CB@A@AB@@AA@@@ACBADA@DFCC@BCBADC@AACA@CA@ADC@CFAB@BA@G@A@@CA@AAC@@@A@@@AFCBCBABCGACABI@@@CCCEABKUB@A@AAA@AAE@ANOL@HEHKHBBE@@@S@A@O@@@A@MNB@HDBH@DBD@FBH@BABAFIFCJAF@H@FBLDDAJDADBB@DFJBBBLBED@@DB@BABJBAF@BEJADAHDAJDD@BCFG@BDJFH@BFB@BDA@BBB@@AF@@CB@DCBBBCBCB@@ADBBA@@@@B@@@BABBBCD@B@HB@@B@@B@@@@@BB@B@@@@@@@B@@@@@@@@@B@@B@@@@DBA@@BB@A@@BB@@A@@@BB@@@@BBB@@@@@BBA@@DC@@B@@@@@B@@@@@@B@@@@B@@@@@@B@@@@B@ABB@@B@@BAB@@@B@@@BBBAB@@B@@@@B@ABB@@@@@B@BBBA@@@@@@AA@@D@H@LDFBLDHDNFBCBCJ@D@DCDCHCB@FFD@FBB@DAF@BABGBEDAB@D@D@BBB@D@B@D@D@@BBBB@@B@@BBD@B@@B@@BBB@B@BBBBB@B@BB@@BB@DBDDBBB@B@@@BA@A@ABAB@BA@@BB@@B@@D@@BD@BB@BDBBBBBBBBB@@BD@@BB@BB@B@B@@@BA@@@@@@BA@@B@@BB@@@@@B@@@@@B@@@B@@@@@@AB@@@@@B@B@@@B@@@B@D@@AB@BABAB@@@D@H@RBTBL@FBP@F@FAPAD@B@BCBA@A@ABABAB@BBD@@@B@BA@@B@AA@@@A@ABAD@B@D@@CDABABG@@AEEAK@G@CAAE@GBALSBCFUAK@C@@@MDEPGDAROJEDALEFKBC@ABCBCBCBOAG@AEKGEIEWGGCA@CACAOCMI@@ECGGCQCCAEEMBAHOBC@CAABIAECCEC@CACACCEMCA@EC@ACK@EFCFKH@DACK@AEECEACMCQCCACGCCECICEAACAAAM@@CECCA@OE]EEAECCEAC@A@SAQAMCEEGGICE]e@FADCBA@GBKBECQGE@GDC@GECECE@E@GDE@AAAACCGCAC@E@K@O@E@ICSEGEECAG"]],encodeOffsets:[[[124965,37762]],[[124979,37772]],[[124965,37745]],[[124981,37787]]]}},{type:"Feature",id:"371082",properties:{name:"荣成市",cp:[122.486657,37.16516],childNum:11},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@B@@@B@@B@@@B@@@A@@AA@@@@A"],["@@@@AD@@A@B@@@B@@@@@@A@@@@@@@A@@@@@@"],["@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@B@@@@@@@A@@@@@@@@@@A@@@@A@B@@@@@@@@@@@B@@"],["@@@A@@@@@@A@@@@@@AA@@@@@A@@@@BA@@@B@@B@@@@@@B@@@B@@@@@@@B@"],["@@@A@@A@@@@@@@AB@@AB@@@B@@@BBAB@@@BB@A@@B@@A@@@@@@@AA@"],["@@F@D@@A@AC@E@CB@@DB"],["@@@@@AA@@BC@@@@BA@A@@@A@AA@@@B@@BBBBB@@@B@@@@@BA@@BA@@B@@A"],["@@BDDBDAAGCEC@ADBF"],["@@@@@@B@@@DA@A@@B@B@@BB@@@BA@@@@B@@@@@@A@@@@F@D@@@BA@A@@A@A@@@@A@@B@@@A@BAB@@A@@@@@@B@@@@@@A@@@AA@A@@@@@@B@@@@ABA@A@A@@@@@@@@A@@A@A@@@@@@@@@@AA@@@CB@@@@@@B@B@@@@@@B@@@@@@B@@BA@@@@@B@@@@@BBA@@@A@@@@B@@@@@@A@@@@@@@A@@@@@AB@@@@@@@BC@A@@B@@@@@BA@AB@@@@@@BB@AB@@@"],["@@@D@BDFB@@BBABAB@B@DAB@BA@AAACACCC@E@AB@B"],["@@BAHCVOBAJIBGBCBIBED@FFBBFABCIOEEC@QHGAOGCAAAGCEAG@ECAAAIAAA@@JCBABG@G@CBCDGBEBG@C@C@AAA@AABCD@D@BAAAGC@@AADKAAAC@AEECAICICC@EAE@C@GCCAAABAAAA@BA@A@A@@AAA@A@E@C@A@ABADA@ABAB@DBBABA@C@A@E@CC@EACEAABCBCBABE@IBI@CAE@CB@B@B@BA@A@A@EAGAG@G@KDGDGBABC@EAC@CAMBAAAEAAA@A@EDEDA@CBIDCDCDIDEBC@E@E@MESEQAKAMBGLDHRHLFBH@@BH@@AFADCDEFADCJ@@@JADAFCDK@IEIMGCRCHRjBD\\h^fDFHJFHDFBNBR@T@BBDDFFDFB^FPFB@DDDF@@BNBBBDFBJDFDDDDHDBRDNDBDDFFF@BDLCBG@ELED@FDL@BFDB@NDDFBDBD@DFDDDBFAJBB@DADGPABFNBFDDDRHHFD@@NJPDDBDBB@HDXHJFHFFL@BBHAPADADAD@BADELKFCBIFQPCBOHCF@N@@@DBLEVADKTAB@HBFDBH@L@FBBF@@AHABCB@DD@D@B@B@@B@@B@@CBA@@B@B@B@D@B@B@@@BBB@F@BA@@A@@@@ABC@@@@BAB@B@B@DBD@@@@@B@@@@A@AB@@ADABAD@F@@BB@@@@B@D@H@@@BB@@D@B@B@@@@BB@@B@@B@B@B@B@@BBBDD@B@CD@@@B@@D@D@B@F@BAD@DA@@D@B@B@@@B@B@@@B@@@AB@AA@@B@BBB@@@B@@@BB@@B@@DA@B@@@B@BB@@D@@B@@D@B@BB@DDB@DDB@BA@@AAB@B@@B@DBBBBB@B@@@FCDABABA@@@A@A@@@@@AD@B@DBD@BBB@@@BD@B@D@J@B@B@@@BA@@@A@C@@@@B@BBDDD@@@B@@A@C@@B@@@J@BABABC@CD@@@@@@GDGF@@ABA@ABAD@BAB@@BBAF@BB@BB@DDDDBCF@B@B@@D@@@LED@DB@@BD@@BBDAB@@@BA@AB@D@@@B@@BB@@@BB@D@BAFAD@B@B@@B@BBH@@B@BAFB@@B@B@@BB@@B@B@B@@BBBD@DB@@AFFBB@@D@BHAB@B@@@@BA@@BB@@@HB@@@BB@BBD@BB@@@@@B@B@@BD@BAFABCFCF@DCPJ@RBjL^FZBFBHDTNHBF@BABCAECI@EBAJKFAJANALAnCVANAVBNBTDJFFFLHDBDBHBJBN@P@J@J@B@@BFBLDD@B@BA@AFE@AAA@A@AD@B@BFBH@BBBD@D@B@BA@ABALABAAAG@CA@CBABCDAB@DAHAD@D@D@@@B@@B@BGF@@@D@B@B@BC@CD@B@B@BDBB@D@D@F@B@BBB@DABABCBADEDAFALADAFG@ABG@CBC@CFAF@DAB@@CBA@AACACACGEICICCAAAAC@EACCCAAC@CAE@EBABCFEHAD@BC@E@I@C@C@ABCBABCBC@E@IAGAGECEGIEEEECAIAG@GAGEKIGGEMCMAEBA@AD@D@DBJBJBF@D@BA@C@AEEAAUMGECCCEECCCIICEEAEEAA@C@CBCBADADABA@ABA@AAA@@ECCAAAEG@@@EBCF@BA@CCAECAAAEACA@AA@ABABAD@HBH@LADBDDH@BA@CAEAEAE@ANKF@BC@E@@AE@E@EBEBCBCBAJANCJCDCBCFIDGBEAKACECE@G@EBEBE@E@CAAECGACCCA@E@IDGBC@C@A@CAGEAC@CDEHEPMBC@GCGCEEEGAC@M@Q@[HKBCBIBaEM@GAIAAACAECEEGKGOACEQCaASDYBM@MAGCCACOGKECCCE@EDELC@@VATCLAJB^FVFPHTFNHVDVBL@JEHGBGAGACGEGCMEMEKGMOIU@M@ABMHMPGDK@@AEACKEKQBc@U@IAGEKKMACGEKKGEKEIC[CI@E@GFAD@J@JBFBBJFBDBBADGBK@S@M@I@gBO@GCAC@C"]],encodeOffsets:[[[125177,37655]],[[125179,37669]],[[125423,37940]],[[125326,37737]],[[125331,37736]],[[125225,37717]],[[125620,38345]],[[125526,37856]],[[125189,37640]],[[125421,38348]],[[125368,37795]]]}},{type:"Feature",id:"371083",properties:{name:"乳山市",cp:[121.539764,36.919816],childNum:9},geometry:{type:"MultiPolygon",coordinates:[["@@A@A@@@@BB@@@@@@B@AB@@@@A@@@@"],["@@A@@BA@@@AB@BB@@@B@@@BA@@BA@@@AA@"],["@@@EAAAAAD@FDBBA"],["@@DABAAAC@CBABDBB@"],["@@BA@A@AAAABC@AB@BBBF@"],["@@A@@AA@A@@@@AA@ABB@@@@B@AB@@@@B@@@@B@@B@@@@@B@@@B@BABBBA@BB@@B@@@@A@@@A@@@@B@@@@A@@@A@@BA@@B@@AA@@@AA"],["@@CB@BBBA@@@@@@@@@B@@BB@B@FD@@BDB@@@@@@B@@@BB@@@@@@BBBAB@@B@@@@@BC@@BB@@DAB@BA@@BAB@@A@@@@@AA@@@CBA@@@@AA@@AA@C@@AA@@A@@@A@@A@@A@@AAB@@A@@@ACBAA@@CBA@"],["@@B@BA@@@C@ABADA@A@AAAA@AA@AACCEAACACAA@AB@B@BBFBBB@BB@NBBFF"],["@@eOGCKGGEAC@CBA@ABA@EAEBABEBABC@A@GBEBC@ADEFCDE@GDIBENWDKBC@AAC@@AACAC@CBABCDCDCBA@C@A@CBCBMFGDEBCBEAG@E@CB@@ABEL@F@V@@BHADCBCCCGACCACAC@A@CBABIFCBKHEBEACAI@@@CA@@@CDAFADABE@@BAFABA@EDEFABA@E@E@EAACEEECAA@C@C@C@EBABWFCBA@AAA@ACCAC@A@A@A@@B@D@B@BBBBBD@LDJDBF@DABCBA@IFCBCBA@A@ECECAAA@A@C@A@AB@DAB@JBDAB@@ABC@CAC@E@CBABCB@B@BDFBBAB@B@@G@M@OBGBCBAB@D@D@DBBBBFBJFBBBDHFFBFBHBFBHDLFRHJDF@NAH@DDDDBHFFTNLHBB@B@BC@G@sMGCCAGCACGKCEECGAC@I@CAEEECIGQKOICCAC@EBCBG@@AAKFEHCDADGFYLE@E@IBI@I@E@QBGCC@E@G@C@A@@@A@@@@@ABC@CF@D@DBLABADGFABABCHGPKJADAJ@HCBMBI@ABABCLAFHPAB@@ABCDDDBDHHZJNCDB@B@BEHEHA@EB@@CDAJCBCDGB@DABFH@DADCNENCBG@OC]OCAMEKCAAGCCBE@ADCH@D@BAJA@A@K@E@C@C@E@G@EBA@CHEFCBC@C@ACA@EBABCDADMNAFAD@PADABEBWDALCDEFCH@BAL@H@DADABC@C@EEG@E@ABOLGFIDCBA@C@ECC@CBCFAJDJDPFDNBH@BF@DAFGFC@ADBJAJJVA^AJ\\NXJVHHBL@LHFPBFJJHH@@LLHFNJTLLJLFZFPAHGAA@CBA@@B@F@BA@A@@B@@AB@@@@@B@@@@B@@B@@@@@BA@@@A@AA@@AF@BB@BB@@@AB@@B@@AB@BAB@@@DBD@BA@AD@@@B@@@@BDBBBB@@B@BBB@@@BBB@@@BD@@BB@BBB@B@@@D@@@BBBB@@B@@@@A@AFE@@BA@A@ABA@A@AAA@@AACA@@CA@BA@@@ABABABC@A@@@BAB@@ADA@@CBC@@AB@@@@AB@BABA@AA@NIHG@@FCDA@@FBFHB@LGDADGDEBEDEDAF@HBLDHJD@BABE@CB@DBDDF@JAB@DBBHDHBBHJJJLFdELEJEPEDALEPGR@X@D@XBT@J@PBVFPB@@LEBBDLDBHDAFDJ@BB@@BADEDCDDFBBBBBF@BBBA@@B@BBBB@D@@CF@FBB@DD@CACCADCDC@ADG@ADAFEAABAB@LHB@BAD@@@@DAB@@B@B@FBJHCDH@D@DBFB@C@AB@@@B@@@@AAA@@@C@ABAB@B@@@@A@@@@@@BD@@B@DA@CCCBAD@@@B@@CA@@ABBBBB@HCBAAADAHABBD@F@BABG@CEEDCDDFDDHHJDBH@@BAFAD@FDBJB@DCDCBABAF@AAEADDDFFF@JE@ABGHKBKB@H@H@VAR@FBF@HFF@FEB@HAD@@@BSCCCAAAEACAAEGEEC@E@CBC@ACC@CDC@EGCEE@A@E@CBBVJDBIDAB@@@@F@D@@@BCBAB@B@BBFBDAD@FCDCFDB@@@@ACC@ABAD@@CGKEG@ADCDABEAABIJ@DB@BABBBBABA@AAAFB@EBABADALBDC@CB@IEA@A@@@AABCFEF@@A@@K[MGSGCCAML@BANEL@H@HAL@HAFADCBABABCBCBCBA@CBAB@DABADABAB@B@D@FBD@B@B@DABA@ABCBAFCBAB@B@B@DBFDDBD@D@F@D@DADCDCBCDIDM@C@@GCAACAAC@C@C@CDADCFGBA@ABI@S@Q_KcKKEOEYGQEGAmSKQCG@C@CBADC@ABC@ACICCEEWEICEACE@CCAA@CACCE@EB@@CDE@MIIC[EQ@OAKASG"]],encodeOffsets:[[[124563,37676]],[[124521,37612]],[[124586,37565]],[[124510,37631]],[[124501,37629]],[[124630,37690]],[[124400,37611]],[[124546,37614]],[[124581,37698]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("潍坊",{type:"FeatureCollection",features:[{type:"Feature",id:"370702",properties:{name:"潍城区",cp:[119.024835,36.7281],childNum:1},geometry:{type:"Polygon",coordinates:["@@GCAAGACA@DAB@N@@G@@@@@A@G@G@E@A@@@G@@AA@I@E@E@@ABIA@@AG@A@CBABA@@F@BABI@AFBH@@@BD@@N@BM@@AA@A@ADC@KBEACIACA@@AEACAEAC@GDADEBEBEACC@BA@@BA@AB@@@B@B@@@BB@BB@@@BB@@BCBADC@@@C@@@EBAAC@GBKJ@@@BBD@BHBB@@@BF@BBF@D@@CBE@ABAB@F@@DH@BFBDH@DBDADGF@DB@TXFHBD@@BB@BCHCJ@H@BBHAD@HBBABEBED@D@DDLCD@@E@CB@D@F@B@B@FDJD@@@D@FBB@BB@CH@B@DDDFDJB@D@BBADEBA@AAC@BD@BEBCB@@@N@@AAG@CBK@@DIDC@@HBDB@B@@BH@@CJ@D@BBBD@HBF@D@D@B@@ABEDE@E@AB@D@DBFF@@B@D@@A@BB@HE@@B@@D@DHBB@BAD@JB@FD@HF@HBHBD@F@H@@AIA@EF@@C@C@C@@CA@AAA@@A@@@@@@A@@B@@@BB@AD@@CB@@BH@@ADAB@N@@A@AHCF@@DFA@OBG@ABAD@B@FEB@FEBE@GH@B@@C@AD@F@H@B@@@H@@BB@B@@@B@@@BBFBL@FAD@B@B@BBCQKA@@@@@C@AAO@@B@D@H@@MD@B@ACH@@DZAL@F@N@HB@NB@L@F@@C@GH@HBF@@@J@@@@@ACAC@ABA@A@A@@@C@ABA@A@C@A@@@@BCAC@A@@@A@@@A@A@@@ADEBA@@@A@AAE@@@AAEAAAA@A@@@A@ABCBA@A@@@@BABA@A@@@A@@@@CC@@CCAA@@@@AACC@@CA@A@@A@AAAA@A@@@AA@@ABA@@@A@A@@B@@ABCDCFEBABAB@@ABAB@@ABA@@@@@@@@@@@A@A@AA@AAA@CA@@@@E@A@A@@@AAA@AAA@AA@A@A@@@A@A@@BADG@@BABCBC@ABA@@@AACCAAACC@AA@@ABA@A@A@ABC@A@ABABC@E@CCICAEAKWEC@AAA@@CAEAAAICACA@AEC@"],encodeOffsets:[[121929,37470]]}},{type:"Feature",id:"370703",properties:{name:"寒亭区",cp:[119.211157,36.755623],childNum:1},geometry:{type:"Polygon",coordinates:["@@EGC@A@CE@AA@K@AD@BC@CAAEE@GAGA@CA@C@A@ADA@@@@AC@A@@EC@ABI@EA@AA@A@@DA@@DABA@C@AD@@E@@@IABGA@C@@AAA@A@@CFE@A@CDCFAA@@@A@@@AMAA@A@C@CD@FE@ABADCAC@@@ECC@GD@@G@AFMAA@ACO@@@CAC@@@@ACBABC@G@@FA@A@@@@DAFA@EBEBCA@@@EAB@@IBA@G@@@@BC@AB@DC@E@@@@EM@G@@D@@A@A@@@@DCB@B@@BBFD@DCB@@@BC@@GK@@@AD@L@BAL@JE@A@A@AJIDBDDHA@GBBHB@FB@BBB@D@DEB@FCBM@@DA@@DC@BFC@@FODAKG@CACA@HCAACe@CAG@IA@CE@@DQ@@CKAA@@DCA@DG@ADG@A@@E@IU@DE@ED@D@@EFBCM@EAAA@A@C@EBK@EAAA@@A@@@A@A@@AG@@@A@G@E@C@@B@DA@G@@HAFEFA@EFA@C@AB@BAH@PEB@CE@GD@B@BM@A@CB@BG@@AA@@DC@@BAA@@A@@@@B@@@@B@@@BB@BDB@@@D@D@DE@@FJB@B@BF@BCBAAA@@@ABBBABBDBBB@AAIH@@EF@@G@@H@@C@ABB@FD@D@BA@CAG@ADA@B@B@@@B@@BB@@@B@DBB@B@@BB@@BB@B@@@B@@BB@@@B@B@@@B@@@BBB@B@D@@@B@@@B@@BB@B@@@B@DBF@B@B@@@B@B@@BB@@@B@B@B@@@B@@BB@@@B@@@B@@@B@@@B@B@@@BB@@B@@@B@B@B@@BBA@@B@FGDAB@BA@@DAB@BAN@BBDHDD@JB@BD@@B@B@@AB@@@B@B@@@BA@C@AB@J@BABC@AHG@BHC@CBAB@@@BFJ@B@B@BBFJDBFC@@L@BBDBBDBLDFBBD@D@BCBCDDFH@B@@BB@FFFJFHHB@D@FDBBDCBFF@BB@DBBBBBD@D@J@D@@B@B@F@@F@B@DGB@BB@@B@AJ@DH@BDPABBCD@@EB@B@@BB@@JCB@@@MFB@F@@BBB@@@@CBAB@DA@@B@FB@@DBFAB@@EI@@A@@@@@BD@@MAA@@FN@BBB@D@FFG@@BC@ACC@@DC@ECAAA@@A@A@@AEI@@BCBBD@DBB@@@B@@AB@@BB@B@B@@@BHA@DB@BBDCD@@@B@@AH@@@D@@BBDBHD@AFF@@@B@@B@BB@BHB@@BBH@@@BAFBBB@BFF@CGLCHCB@FDDBFJABIBCGCB@BGB@BD@@BCB@DA@@F@DBF@@JADLC@JJDBFDFDBDLDDDBFAFQEG@CDI@GAUA_B[BSNGCICUIaASAIIACEMI@AFCBGAOEEBMBA@ETELOFSDE@CH@L@F@B@F@nSDC@G@mAAP@DAd@V@L@b@@DBb\\NBBPONBf@P@fDTXVPHJ@@CDAB@B@B@@@BB@DBD@DBBDDTDZHKDCNKXELBFHDNDNPHTAhKLRBL@ZBJFDf@DAFANOBCACAAC@QNCBKBGAGC@E@UAGIQK[CGBEJCF@DHDDH@jSHAV@HDDFFNH^BBDDDBB@DADCDG@A@CAEM[CGAGCqJGLAFBBJCX@PDJLDT@VEXUPERAZHRBPELIDMCMI[AKBMHGDCBGBWBEBACA]QI@AKAAGECCAE@ECCE@CA@C@C@EACIMGCCACACAACCIBI@EDCBEDCDEDA@ABC@A@AAI@E@K@A@@@A@AAG@K@A@AAI@ACMAG@CAE@E@A@E@A@@@@@A@A@@AA@A@A@A@A@@@A@A@EAE@A@E@A@KAABC@A@A@C@C@A@A@A@@@A@@AA@@@AAAACAA@@@@@A@@AAAA@A@@AA@AA@@A@AA@@A@@@A@A@A@AAC@@@C@A@A@@@CAA@EAA@A@AAA@A@A@@@AAA@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@@CAC@A@C@@@AAA@ACE@@AAC@A@C@C@@@CGF@@AAKAIFEB@@B@@AB@@@@BBBA@@BA@@@AAA@@BG@ADBB@@AAACACBA@AFA@A@AEHAAGACC@AD@@@B@@A@AA@EFEFADAF@B@BE@AHC@I@ABAGECEA@E@AACEAE@CBABA@@@@B@@A@C@A@@@A@A@@@A@@@AB@@A@AB@@AB@@ABA@@BA@@FAF@@A@C@IB@J@@@B@NAHBBEBAB@BB@@DIBAB@HBPAB@ACF@BD@@A@@B@@@@BB@@B@HBJHHABAD@D@BB@L@D@DD@@DBBF@@DR@BAR@@E@@DEAGBG@CACC@@A@CF@B@@AF@@ADD@ADOD@BGC@A@@CAA@@MEAAA@@C@@B@BGBAHAFDD@@@B@BAAEB@FA@EJ@BA@AD@BC@A@AD@DEHCB@H@F@D@NF@B@F@BDND@H@@@BFFHFDB@F@F@@@@HBFBDPJBBD@DAFAHABBB@@C@ABADCFCB@DAB@DAFCDEBABCBC@@@@@@B@@@@A@@@A@A@@BABA@C@AAC@A@@@@BA@@@@@A@A@A@A@@BA@AACAGAEAA@A@C@A@@@A@C@CBC@@@AB@@@@A@A@I@A@A@C@@AC@@@@A@A@O@GBBB@@KB@@@F@@@@I@@@GB@@E@EB@DCAG@@C@CA@AA@@@@QBAAA@G@EB@DEA@@@@@DG@AA@@CBBDB@@BGBABG@CD@F@@A@@ECCE@"],encodeOffsets:[[122217,37620]]}},{type:"Feature",id:"370704",properties:{name:"坊子区",cp:[119.166485,36.654448],childNum:1},geometry:{type:"Polygon",coordinates:["@@MDCAE@@BW@A@UAS@@D@BE@A@EBA@AFD@@DF@@DE@@BCB@@A@CAA@C@@D@BB@AFA@@EC@@@@CC@@BDLDBJAB@ADCBABDBDBDJ@BCFCB@FC@@HE@@HABBB@BA@I@C@A@CEA@AKIAAGCBG@@@GICEIBGBQCA@@DCD@B@HA@a@ABF@@ABB@@D@@DB@@DC@@DA@CB@@@@@BB@@BAHA@@A@BAB@BK@A@LHFBD@LB@DF@DPDBBD@@HALAACF@B@@@@BL@@CB@BID@BK@@N@@DL@@BF@@@@DABAB@FA@@FC@@BBL@NH@@BDHBDAB@@CBA@@HEACFIBDRGHC@CB@@ADC@IDEB_AFH@B@DCB@PF@FHBH@@HDHC@C@@B@@DD@BLLILF@LFB@J@B@BEHABEB@@@FD@B@@P@DEFABA@KCIAA@MFABMB@HDB@JGDE@CCGEGEEA@@CHCFAB@B@D@DEJKDCBI@CB@B@HALA@ECEEGD@F@@E@ADBBD@B@@FE@@B@DADABIBOBA@CAIEA@AB@FBDDB@BBH@BAD@B@@GAE@EAA@ODGAM@IAEC@@A@@AA@EAC@IACACACEEC@@GCGG@AKMA@GBGFCBA@GAA@ECIGC@A@BCC@ADC@AC@@AA@AAK@IB@@CA@@AC@AAC@@@KEGAA@AD@FA@@BEDEDABMJABA@ABC@@B@DAHEHDVBD@@AD@DBH@B@D@@ADEDIBEAAD@B@BADAB@B@B@@A@@@A@CAKCA@G@A@@BA@CBGAKBCBBB@@F@@B@F@FA@AFAFCACD@DC@AD@BBBHDD@BFB@BDJDBBFBDB@@BB@BFDLXRED@DEDCBAP@F@DB@@@B@DAL@D@@E@@@AF@D@BDBB@BBBADBBAD@BAF@@AF@F@D@D@@@@@@@@@@@ANB@B@DDLDLA@@@DABCHA@G@BF@BAAEAADC@@ACAC@@B@@@@@DF@@@@@@F@BB@@@@BDFD@B@@@@B@@ABA@CBA@@B@@BB@@@@@@@BB@@B@@@B@D@@@D@@AB@@BBD@@D@@AHBBDBH@@C@GB@@CJ@@@H@@DF@BA@@B@@@JADA@@NADA@ED@B@B@@CD@@BD@D@@@B@HB@@B@DAB@B@LCD@HAF@B@@I@A@@@@@@@@@G@@@@@C@A@@DC@@@I@A@@C@@AFA@@@CAG@@@AC@@A@A@AD@F@@@@@B@@QB@L@B@B@J@F@L@@@BCHDBBBAD@@@F@R@D@BB@BA@A@A@@F@@AEC@EB@DB@@B@@CA@DC@BDBD@@HH@@DA@BBBD@B@@@@@DD@@D@B@@ABAB@BFGBAB@H@J@H@DL@B@D@@@BB@DBF@@JCD@@BD@R@B@B@@@@BDF@@EDBF@@@H@JE@O@BNB@@BBB@DDB@HG@@FPAAJH@D@AFF@AFKACH@@LDBFAD@@ADC@@FC@@J@BFBJ@BAD@@FB@D@@B@@B@BCB@D@B@@DHBHBF@BFDBD@@ABCL@B@@BDFB@D@FHF@DD@FB@@@@EDCH@BAHA@AA@ACDA@@BBH@@C@@@@FB@CFAH@B@BBRA@@@@BBB@@D@DH@DB@CFAF@@@HA@@J@@@@@@E@@LA@@AAHAP@B@B@AA@AAA@ECCC@CAGB@@E@C@AAEAC@A@@@@AB@BAA@AAC@C@CA@AAA@@@@CGCCCC@ACA@@@@@ACA@@A@A@AAAA@AA@AAA@AC@A@@@A@AAA@@AA@AAA@@AAAAAA@@@AAAAA@A@@CE@@A@@@@@@AA@AAAAA@A@@AAAAA@@@@@A@@A@@A@A@@BADCFADADA@@FABCBABC@ABA@ADABAD@BABA@CB@LDBBRB@DB@J@@CF@J@@ED@J@@CB@@CB@@DD@@DL@D@J@@DB@PMRG@ADFB@D@LB@EBG@GHECAA@ASC@@BA@@EG@C@@AEA@A@G@IA@@E@@D@@EH@@CD@@A@@B@BEB@@@BFB@@CJAH@@KC@@G@EA@@EI@BWHA@CP@@ED@BCD@B@B@BIB@H@@@DFF@DCD@BCJB@QB@N@B@FCDABBDFNBH@@AD@AQBG@@BM@CTG@EK@@CEAC@@CCA@CFCDK@@P@LA@KK@@CE@AAAI@KACIACECOLA@@NBF@@CLAAA@ACB@MJ@AE@ACGYQK@ILC@C@IIBIGOAAACHAHGL@ACG@A@AGI@@AA@@@ACA@BCC@A@A@@U@EC@@FC@E@ACE@E@@@@EC@@I@AI@@@@@AEC@@I@@DABEG@@CC@@AEG@A@@B@@CBCRDTD@@AEC@GEEGKO@AFEBA`CDABAEAAMI@CCAE@@CECG@@AIAEAANE@E@CAGECLA@MF@@ET@@C@EC@K@@DU@@CE@cBC@A@@CBOO@CBG@AF@DK@@@GBGBCD@DBB@B@@@D@@@D@BAB@F@@@BAB@@@BBB@BBD@BB@@B@@BDADBD@D@@@B"],encodeOffsets:[[122321,37186]]}},{type:"Feature",id:"370705",properties:{name:"奎文区",cp:[119.132482,36.70759],childNum:1},geometry:{type:"Polygon",coordinates:["@@GCAD@@K@E@I@A@A@K@A@@RA@@@@@E@C@@B@B@BD@@B@@BH@D@@EB@BD@@@@B@J@@CD@@@B@D@@@@@H@@@@@@@@@B@JA@E@GBC@KDA@A@CBA@@@GAA@@@C@C@@AC@@DA@A@C@@FCBMB@@CBIB@@A@@@ABE@@CG@@@I@@DA@@H@DG@CAAABG@@@CC@AA@@BA@@@C@@@C@A@@@AA@@A@@@@@@AA@@@AB@DAB@BA@@@A@@A@C@CE@A@@A@@A@E@@@@E@@C@@@@@AD@DB@BD@BCFBBB@AAEH@B@DGBA@C@@KBKCCCA@A@BM@@@@@@@@@@C@C@E@E@@BE@ABC@ABCAABAAA@CA@A@CBE@@F@@@@CBK@C@A@@CAE@O@ABCDCFC@QFFBDBDJ@D@FADAB@B@BAD@B@B@BAB@BB@@BDDBBDBBD@B@@AB@BADADAB@@CHAB@@@B@B@@@B@BBBB@BBB@BB@@B@B@F@@@@@DBB@BBB@@B@B@B@@@@@@@@@@AB@BA@AB@BA@ABABEFCDAD@BA@@@@B@B@@AB@BB@@B@@@BBBBBB@@@@BDB@@DDBB@@@@BBDD@@DD@@@@@B@@@BABAB@@@@@BABAD@B@B@@@BBBBBBF@B@@BF@B@B@@ABCF@B@@@B@B@@@B@@@BBDAD@@@@@B@D@BAB@B@D@@@B@BAB@BBDBD@@@@I@@@E@GAG@@H@DE@K@A@@MGAM@E@K@YB@CG@BDA@C@@NG@C@A@@@BP@B@D@@@@LBDR@FDNEA@FC@C@@FCFV@@J@FB@H@BCH@@CDB@CB@LB@DR@@CF@@DJBH@DBf@BDDB@GDBDBH@BLPC@ED@AED@@CB@@CN@DA@EFA@C@CAA@AEAA@AGHAB@CGACJCBIB@B@F@@IBK@A@KBC@@L@@HD@@A@@DA@CECAA@@@ADA@C@@B@B@@@@CH@N@@F@@F@D@@CBAD@@A@@H@B@JA@@BA@F@@DBFAFAB@BE@C@@B@B@@EH@D@BADA@B@@D@DB@@P@BDB@NBBEH@@@HCD@FD@@D@DBBCBAF@@EDCD@B@B@NB@B@@@B@@BBDEDCB@F@DE@@@BBB@BD@B@AHJB@@F@@@BCD@B@BA@CB@@CB@B@@ID@@ED@BC@@BCAEKC@@DGLBBEE@BEC@G@BIOB@EH@@GCA@CAA@AA@AMP@F@@I@G@@AEFC@@CE@A@@A@A@Q@C@@AC@ID@@AE@CAA@@C@A@K@@C@G@I@GBAHAAEA@AB@BA@C@@@CC@@@@A@C@AA@ACB@@GG@@ACACD@@CDB@@@AA@@CFAD@BF@@@EB@B@B@@AAAC@Q@E@@@C@ABAA"],encodeOffsets:[[122115,37496]]}},{type:"Feature",id:"370724",properties:{name:"临朐县",cp:[118.542982,36.512506],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@B@BAD@B@RBVC@A@@AAAAA@DCDEDECGE@CA@IWMGCBACCAACABCD@DCCBE@ABCBGCA@CBGAA@IHCBCBBB@D@HBD@D@J@FAFCF@FJBBDAHCDCBBD@DCFBBJHFBJHADCBA@CABDCBC@A@E@CAC@BDADE@A@@BGB@B@@CBGBEIA@@CAAA@CDBFABEDABC@C@MG@CDCAAE@I@EAABCEAC@AAA@AC@CBBB@BGBCAA@E@EDEFE@CA@HCFBD@@B@HD@@@B@B@FC@@D@LCP@BEBIFBJADA@K@CHABAJ@@CHGLBDABAD@@EBA@BFB@NHBBANFHAF@B@BBF@@@B@@@B@@@@BB@@AB@@AB@@A@@D@@BBBDBB@@@BB@@B@@@@@@@B@BA@AB@@ABA@ABAB@@AB@@IDKBEBABCF@D@@ABQLCBOAMIQCKGGCC@A@@@IBED@@@@@HBF@@NNBDAJBFABABHFBDALKJCD@BFDBFDFMFAF@FCDAD@FADEBGDE@C@A@@@@B@@BDJHJHCFGBCAE@W@ABDBB@IBA@CCBDABADA@AAAEEABDGBCF@BBLCDCBEBAJBDCDC@@@EDADAHABEDGBDFAFGDAJHDBB@@DJHHDBF@AHABAB@BDD@BGFI@@B@BB@FADBJDPBRDBDAF@DDD@F@DABJFHFDDBBJAZMDJFJFDD@@@JBJDRFLDBDHHDDF@HBHDFDLFHFDDA@@BJDDBD@FBHLLDDD@@CF@@CH@DDLDFBBFFDBDBDF@@D@B@BAHEFCFABAFCB@B@B@HBFBFDJFBBFD^HMDHBHDJ@FDF@DDXNBCF@ACHEBBJB@BD@L@P@HAB@D@HFDD@@@FBBBFBBFDFFB@DDD@D@LBJ@@@BBDDDBBBHBB@BBBAB@HAD@DBD@BAB@DFDB@FBBBBDDB@DAB@BDCFBDABIBCCCAEAEA@@@BCDCLAJFLBBFFBBFHFF@JAFCHBD@BFDH@L@B@PAJAJ@JBDADADAFABAJCF@RADBB@HJJJF@JGHGREDCHCDEDEFGDEBGB@FKB@JKNGHA@@FATAD@N@TDPFF@DBHCNCF@JAFEFABCBAPUDM@@BI@[@AAMEMGIAAIQAI@ADQDKBGBK@@@ADS@ABE@@@@GMRGJBB@BBNCADHBFA@CJA@F^BBEBEDIBK@MAAE@G@A@@AC@ADG@GI@ACC@CD@BC@A@CEB@BAA@C@A@AFCBABG@CC@A@C@IEAAAIDCBABABCBA@@@A@C@@BGCCAAAA@@OHABIB@BAD@@GLCDEBABEBIHEDGB@C@CAAA@IBA@CBACOFG@K@@A@CHE@EBEFI@C@CBCBEF@BC@AC@@A@AD@@@BA@CA@IAWAIAEE@A@A@AJABBBAAEAADA@@B@LG@CBI@K@CFBD@@OBEBCBECE@CC@E@@EEEA@G@@@AANCLCHAJ@@AA@ACAM@ANIDADFFFHBBBB@@DBBDAFBBDAHKBBBD@DBZTLABABCAA@AACCI@@@ABA@EEECGCEAAA@A@@C@M@CAGAAABIEACEEBC@@AAA@C@KI@E@C@E@@AKAABABAGEEECGAACKAEG@BA@EEAAAAA@EFA@AB@FG@AA@@EBCBA@@CCAGDAB@@BB@DEBCACAIA@BAA@B@@@@AA@A@AA@@AAAABGC@A@@@AA@A@@@@ADA@ABA@EAAABK@ECG@CHIQECEKB@B@FBHADIDABKAGCAACMGAA@EBAB@HEBCA@BEDA@A@AACB@DFD@FA@@@ABCLEFADEAG@E@CDEBA@KHA@GBI@AEACAAGAA@MFC@IHA@@@CAEIAAEBA@KMCCE@ICAAAAGGQE@@C@GF@@BB@BA@E@A@CGGECEAC@CDEDGCKAA@@AB@BAAA@A@CBA@ICCACCCKA@@FCBCBEACADCCEA@GAGCCEMCGAABGHEE@CA@C@EDC@AABADE@@"],encodeOffsets:[[121346,36974]]}},{type:"Feature",id:"370725",properties:{name:"昌乐县",cp:[118.829992,36.706964],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@EA@D@LAJ@DKHA@@@CBBBBFABAAIB@B@B@BFFJBXBJBB@@DAB@@C@@B@BD@@BADE@AFAD@D@DEJAF@FGF@D@BL@H@PEBDDAB@JAB@BB@D@DHAFCJGFABAFADCHK@@BC@AJABAPG@@BBBBDDAH@@@D@B@@ABADABABCDBJBBJFD@B@D@@DAHABED@B@B@DBB@AFA@D@BADC@@DDD@BHJH@BCD@@BB@H@F@BB@NALCJAFAF]A@EIB@DEBGABCMDAAA@IAQHHN@@@@AF@BCT@B@@ALAHCLCR@BBJJRBBHJFNBN@B@\\AJ@@CNOVABADEBEFIBE@MDGDEFFFPA@@DJFPDFHPOBAB@DFB@@@B@BOD@B@B@BBJBBZ@L@EL@PBJ@BEF@LH@DFEDGHCBFJFHJ@CL@@EH@@ADEBAFAB@@AD@DAD@D@@@B@@@B@B@@EBCFA@E@CKSBE@@BQB@FE@C@@DA@@L@@@B@JBD@F@@@BFDLHDBJHDBFDDBBAFBFFBB@DH@@FB@BBB@HA@ABAFAHBDBJDB@B@DC@ABC@CBA@@FAN@NAB@B@@DBBB@D@@ED@F@@E@@D@BF@@JABNB@F@B@BR@D@D@@@B@B@@@B@B@BB@@@BBB@BBB@B@@@BB@@@B@B@B@@B@B@B@F@L@FCBB@FD@D@FB@BB@BC@CD@DC@GD@@BB@DHFTD@F@DABABAHE@ABAAA@CKKHED@B@@CB@PAL@B@B@DA@AB@BBADL@B@@BH@B@@EP@@AH@BABC@G@ABCD@B@@HBBB@D@@@B@@@BF@BC@@DC@@FB@E@@F@FBDF@@FD@@BD@JC@CL@DAH@BB@@@M@@DAFA@AACD@BBB@FABCAAC@A@CICECCA@G@@DAAA@EAC@@@C@CI@E@A@A@E@CDAF@@@DCCK@C@CFCFABAAA@GBCAG@A@GDIDG@AAA@@ACEGSWA@@CHEBCAC@CCGEA@ACG@@@EBABAF@DA@@@CAE@AAE@@A@GA@AAC@A@@LIHAD@BBFA@@D@@@D@BCDA@AA@@A@@AAA@@A@@@A@A@@BAB@@AB@@ADDFBFAFABCHCD@FBDBFB@BB@BDDJFBLAD@BCB@B@@BN@@A@MC@@A@@AGBEJ@BA@A@EB@BADAB@H@@BB@AJ@BF@F@J@B@@BH@@@B@F@H@H@B@@@@@H@@@@MBA@CDBHB@ABCD@@CDCDBBEBEB@@E@E@AE@@@AADALAHBDAB@@AB@H@B@LDDBB@@@B@@@@A@ABABC@A@ABCFBJAFCBC@@@C@AAG@CBC@@ACCUFGBG@C@AD@BAB@BANIBAFCFC@AB@@EBCB@HBLF@@D@BBD@@BB@@DF@HADDB@D@BABG@ABB@CJEBICCC@@@@A@CF@BABA@CBAAMDCBCB@AAC@C@GAAA@AAAA@E@A@@AC@@D@BF@B@CBGDCBCEABA@ACOEA@@BDDADA@E@CA@A@ABAC@@A@ABGDABGA@AEC@AB@BAFEDCBE@A@E@IC@EA@EJA@@@IACAAGABADC@A@@@@A@AI@@CH@@A@@A@@KCKA@C@E@AC@@AGDCDIAACECC@BA@C@ABCDABCHA@A@AADC@@CCCACBC@@@C@I@AHCDCD@DA@@@ABA@IBCAGGA@GAGBABGE@A@AE@A@ACAAAA@CA@GAAAAAGC@BAB@@A@CACABAAA@BCAKCGCA@BCBAHADEDABBBC@@@AD@@@BAGG@CB@H@B@@ABED@@E@@IACCCBCBAC@A@@FCBEB@B@@FB@@GAABCB@D@@@@C@E@EC@EAEABMHCBAHCCGKMMIAAA@MAEAM@@@IAMAIBA@G@CBOBIAKAICCACAA@@AA@A@AAMCMAKAA@WA@@C@IAA@A@O@@@C@EBABA@@BA@CBA@CDEBCBA@EDABABA@C@CBE@ABABA@CBCBA@AB@BA@@BA@@@AB@@@@CBAB@B@BABABCBABC@ABA@C@@@C@A@A@EC@@C@C@C@A@@A@@EEC@C@A@ABA@@CHIAE@A@A@@A@ABAACGBCCCII@GFC@ACE@AAAAE@CDAAGABKBYSCAC@AALABGACEACBAA@CA@AAGAEECECBMJ@BBNBDB@@BI@GBKDMDBB@@H@B@FF@FF@D@@DDFAFADAF@P"],encodeOffsets:[[121593,37203]]}},{type:"Feature",id:"370782",properties:{name:"诸城市",cp:[119.410103,35.995654],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBG@A@@D@@AB@@CAA@EAAA@ACAEBA@A@@@ACA@ABCBAL@DBCBBD@B@@BABCBGBKH@@ABCBADCBAD@B@BADED@BA@CBA@G@EAE@CBCBCB@BALAFAB@FDF@BBB@BFDDBHDBFADADADADABADED@BCBCDABC@CBIFCDEBKFE@C@G@GCA@CACAA@CACAE@E@A@@@CAA@I@CAGACAAAA@AAIEA@EAECECCACCCAACECCE@@CCA@CAGEEAEAC@I@@@@@AD@D@D@@BD@B@B@BBFBD@HBD@D@FBF@F@H@F@DBDBDAH@@ADCDCD@D@@@BHBKLDDBFE@@BCBACA@@DCABD@B@D@@@DBBA@AA@AA@@@FDFDBB@@BBBDBDDHAD@DCD@FAB@@CF@BAB@BADABAHABC@AB@@ABABCDCBA@ABIFA@E@K@KEAAEAEAMAEBDB@BABD@AHADC@@@@AAAE@ACG@@AAAA@EDAH@DBD@ABB@B@BBBB@F@B@B@@BABCB@@@BC@@DA@@DE@@BG@C@E@CAA@CAC@ECEAEEA@ECCCECAAECAAA@GEEAGC@@@@EACCCCIAEA@BA@@@@@@@@BA@A@@@@BG@A@B@@BC@@@BA@@@@@@@@A@@A@@A@BCA@@@@@A@BBA@@@@BA@@A@@@@A@@@@BA@@BA@A@@A@@A@@@@A@AA@A@@AA@@@A@@BEA@@@DA@AB@@A@@BA@@CBABA@@A@ABAABA@@@@@@A@@@A@AABAA@@BA@@AKAEBCBA@ABGDKHKL@@CDCBG@GAA@@A@M@AAAACEBMJKDEDIBADB@BDRPNBXPB@JHHBJAD@D@B@@B@DCHABAB@@@BBF@F@B@DDH@DAD@@AF@BAFALH@@@@B@F@D@B@D@FCBCDA@C@C@EAIAEAGAGC@DCJGAA@MCMAQIGAI@A@K@I@I@A@G@GBC@MGG@IDEBGDCB@FAF@DADEFCBEBE@C@KAG@EASAEAAB@@A@C@AD@DAHAFCL@@ADTP@FAJCBE@E@CB@DAB@BBDBDFF@BB@B@FDBAD@NC@@FABDNAF@DBBJA@A@@B@@@@@@B@@@@@@DBBDBBD@BG@EBED@BA@ABAB@@@@@B@D@@BBDB@@@HADB@@@@B@@FDDDDF@BAB@BBDB@BB@@B@@BB@BB@@BBB@@B@@B@BB@@FBBBD@@@@@@AB@DB@DAB@DBDEBAF@D@@DBDBBBBBBB@@@BBB@B@B@BAB@BAB@B@B@D@@@BBDBB@BD@@BD@DAFADAFC@@DEFEBA@AFAHAD@DBDBDDHDBD@B@B@@@B@BA@@D@D@B@@@B@B@B@@HHFJ@@BBFH@DCD@B@D@@BDFBFD@@VADBBBFDHPBBDFNJFFLLDBNDB@@@FDFD@X@BFBHFB@FBHD@@D@JDLAPBJ@FBHDB@JDLDHB@@B@HAD@FALAHAD@BAFAF@HAJCD@RDD@HBHDJDJFDDDDLJB@HDFBB@VDD@PAR@FDNFDDFFDDDDHDLL@@FFDB@BRLPJDBB@JDD@XFFBD@DBFBDB@B@DBB@B@@BDBDBDBBDHFFB@DCHAHA@@L@@CBEH@DAEMEMCI@@CKA@AIGGAA@@CECCGM@AEO@ACMAG@ADEDEV@JAB@F@H@ZAHC@A@@DCHCT@B@H@N@@@JAD@JIFK@@@@@@DIDERSFMDA@EBAD@B@H@@JJJJDBBBABABAHDFDAJB@BBBBBB@FAFCH@BAB@DDDBBCH@DB@D@DEBAPBD@D@JAB@P@D@BCBKB@@ABABALAF@DCDEBE@A@CBKHMHG@ADAFCHADBH@BAD@B@BC@AC@@ACIAA@A@C@EB@@AEAAB@BA@CC@CB@BABEBCDCFC@A@C@ABEDCD@BEEABGICGCIA@AA@MACAAC@A@EDICA@AA@@@BABKAA@A@@BE@AF@D@L@D@BABAP@H@ACBAHAFB@ACA@AF@@@@@@@@AC@@AD@@@J@@HBFDARMHCPCBDBAA@@A@@AA@@@ABAB@BDBB@BBB@@B@B@B@B@@@B@B@B@@@B@BAB@BA@@@AAA@AAAB@@ABB@AB@BAB@BABA@AB@@@@A@@@@@@@CA@@AA@C@AAA@AA@@AC@@AA@A@C@@BA@@GABC@AKA@@CAC@AI@@ECA@IAAAIG@ABEBGB@@@BBBED@BECAAC@CBG@ACO@EBC@ABGBGDG@CNSBAHO@AAACAAAFGFA@A@@@AAC@@AQ@AD@@BBBB@B@BCB@@B@BABBBDBBBB@@AACB@@@BBBBB@@@B@BA@A@@AA@A@A@AB@B@DG@AA@AA@@@EDIBAFGD@B@@@B@@ABA@CBABAB@BABE@A@@A@@@@ABGBC@IBCBC@A@@BC@CAEAAAADE@AAAA@A@@BAB@BA@A@@ACC@@A@EDCBA@A@@@BA@A@@A@@@A@@AHABA@@@A@CAA@AA@C@ECA@EB@@CF@BGDC@ADABA@@B@BA@A@@@AAA@@@@@@BA@C@EA@@AAAAA@E@A@AABEAACAAAAAACECCAAA@ADA@A@AACEAE@E@E@EB@@EDEBE@E@AAECECCCE@A@ACAAA@E@CA@A@CBC@@B@B@BA@@@@EAA@@AEGACACA@@A@A@AA@A@C@@@CC@A@A@@A@CBC@@@@@AAAEAGAKBCHGFCBC@CCCCEAC@EAACAE@C@I@A@ECAG@E@ACE@IAC@EBADALADAFCHKLOHCNKBG@A@AEG@GAAC@CDEFE@@@MECEAAA@C@ABAB@@@DG@CBEBA@A@AA@A@@EBA@@AAA@@EBA@AAA@A@EF@@IAA@ABA@@BBB@@@@CBABCD@BA@E@CBC@"],encodeOffsets:[[122423,36581]]}},{type:"Feature",id:"370781",properties:{name:"青州市",cp:[118.479654,36.684789],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AIAAAGFBDE@ADWMCCE@ECI@GCGANC]GECAAIEECEAGAA@A@A@EDABEBEDGFABA@C@@@CECACAEEAACECK@CDG@@DE@@CCKCGKEAC@CAIC@AB@CCGEKEECGCGAE@CCGGACKCQEICIA@@C@ECEICIYNIBAACCGEIEBA@C@ECC@CBEACQCOAICCAEBA@@A@A@GEAEBEAA@CCA@C@AB@BAFADBDBHDF@J@FB@HD@HABALCHCD@DDDF@DFA@AHCDFDCDADC@E@GFGJAFCLAFADBHBBFDBB@@ABEDC@@B@BCB@JADB@FFADA@CJ@HBDJDHDDDBDAF@DABC@@@G@MFIDUDAJCHCFABCDEA@BALAFBBBNDBFDGFC@AA@@CB@@@@@@@@@BAB@BDHDFLHHDFFHJ@@FH@@BBDBBBHBDBBBB@BBBBBB@@@DKPBBBFCBCFC@CAED@BDFHB@FF@B@JBBDB@ADBDB@@B@D@B@@F@@@BPB@B@BC@A@AFAFBBBBDADGB@DCBCAAD@@AAACGBA@B@AB@BBB@@@B@B@D@@@B@@@@AB@@@@@BBB@@@B@@@B@@@@B@@BB@@@BB@@B@@@@@@@@@@@@B@BB@@@BBBBFHDFB@D@NCJCDBCHIFS@BBBFFDDDDDBD@FMDBFC@CBBDDD@@@@DBBD@B@@D@HAFFDBH@BBBBB@@@B@@@B@@@BBHC@@@ABABAB@DA@CB@RCHCJ@@@DDBBCF@JMB@DCFAFAH@BB@BB@@@@@B@@@@@@@B@@B@@B@@BB@@B@@B@@B@@@@BB@@B@@B@@@@@@B@@@@B@@@@@@@B@@@@@B@@@B@@@B@B@@@BB@@@@B@@A@@B@@@B@@BD@@CHADBBDBH@L@DHDK@@@@@BBBBBBAD@@BFE@@DTCLFDBB@D@@IFCBC@@VCJ@FBJABAH@DDADB@FB@CD@FBDDBDBB@@BBFBDDB@DBBBDBFBJBBBFFBDPA@FF@BAD@HBDDJ@F@HBB@HH@DBBFDD@B@FBJH@B@@@@@@BJC@@HBD@@@@BB@FBB@DC@@LGD@DB@HAJ@J@BFAD@HBFB@@JF@AHB@@BD@BA@GH@AO@AB@BBBBD@HBHB@@@FH@F@BB@@@BAB@J[BAB@DB@BB@@B@@FDFFABBFLFBBDD@@D@D@B@B@B@B@B@D@B@@@D@@@B@B@B@B@B@B@DE@AB@DCBCB@DFFF@@FBBF@J@BDBBBBBD@@BB@B@@BBBB@H@B@@A@@D@BEAABBB@@DBDBB@@BA@E@@@A@BFBFCDC@A@@AC@C@EBMBO@AB@@@BBB@@D@BB@@DDBF@DFDTAFCH@FABCL@@@@A@A@KF@DAH@DBB@L@@DH@@A@A@@BA@@@ABA@@@A@@B@BAD@DAB@B@@AD@B@NABBF@BDF@DAB@@HC@BD@@BBB@@B@@@BB@B@@@F@@D@BBDJAH@F@@@@CD@@EH@@AAKAEDA@GBGBC@@@@@ABC@CB@@@@@B@@@B@B@@@J@@@BCD@A@AG@ABA@CCA@G@AF@B@BDDDDBH@J@BA@@F@D@@@@@@@D@F@BA@AAEDEHAD@BA@@ACDGBEB@B@DAB@AI@EDA@CB@@GA@AA@A@@@AA@@A@AAEBA@ABCBADC@@@A@@@A@@@A@AA@@A@@@A@@@A@A@@@@AC@@@A@A@AAC@E@G@A@@AA@@@AA@@CAA@@@A@A@@@A@AICACB@D@@ABA@SBCDEDAB@D@DBD@@@BABCB@B@B@@@CCA@A@@A@CD@@C@MD@HA@B@@DBPAD@@A@@BCCA@@AM@GABCC@C@ADAT@B@B@DDBBD@ACDA@@AEHABA@AB@FAF@DB@BFA@CDCECECFCF@B@AEBCBAD@PBHCF@B@D@@A@CAAC@AC@AAE@E@AJABA@ABC@G@A@EBAFCH@@EAC@I@A@@@KB@@CD@F@@ERA@AF@TADLF@B@DEFA@@@A@A@@@A@@@CBC@CBC@@BABEFABC@@FG@@DKI@EGEIDAHGFCCEG@@KFE@AAI@OFKK@Y@AAAI@A@A@APC@A@A@@EA@CBAPAGOCEEOCI@@OBEEFECAE@OESCM@C@SBEB@@GBMHILA@ELA@AHCFEHCFCFGDCDQFGHIHE@IIGIA@CAQBE@IDABEBCBCBCBIAI@IBOBA@K@G@EC@AACDGBE@IEEEGAAEEAAEKBIDKDC@A@@FBFBDBDDJABAACDEACA@CBA@CCAAAA@ECACEA@ABC@CAC@GBA@ABAAA@GAAACACCAA@@I@KAC@C@CCA@EEECAAAEAA@E@@CCGEC@A@GBO@K@C@"],encodeOffsets:[[121334,37411]]}},{type:"Feature",id:"370783",properties:{name:"寿光市",cp:[118.790739,36.85576],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAKGEC@A@@G@EDAB@F@B@HAD@BABIB@B@FBF@BBDD@BB@D@BC@A@E@GDOAC@ABADBFA@E@EDFDFDCD@DEB@ACAE@EBA@@BABGBBF@@CBBDC@AACCA@A@S@CB@B@DDDBA@HBN@@DBAD@@@BC@OBCA@@@AGBC@@N@DC@@D@BB@B@DD@@A@A@A@ADAB@@C@CAC@A@CBCFAD@TAB@BC@A@BDJD@B@B@@@B@B@@BB@DB@@B@@BB@@@B@H@FBD@B@B@B@@BD@@@@@B@B@@@B@@@BB@@B@B@@@B@@@B@@CDABAD@BABBF@B@BB@@B@@@BBBB@@HA@@DCB@FBJA@CBA@A@AFCHBD@@ABC@GBCFBF@BABE@C@@@@@@@C@E@@@ABI@G@CACCACA@E@@B@HDB@DAB@BBHB@C@AD@@I@@@A@A@@@A@@@@@A@@DAD@B@@@@ADAH@HCBBFBL@BG@@FC@@D@@E@G@IB@BCFCB@D@FCDBDBJBB@HBB@B@@CFA@E@CA@@@@@@@BBL@BCBBJ@@BD@@@DBHB@D@@@FC@CHCFA@@BBNA@ABABHD@B@BABDCB@BI@ACCBA@EBCB@B@B@@@@@@@@@@@BD@@B@B@@@DF@@C@@RA@AJ@@@FAF@@@@D@@@@ABA@EBBFE@@BB@BB@B@BB@@FC@BBF@@BCBA@@@C@A@A@I@@DI@@DC@A@EB@@C@A@@@AAA@@DG@A@C@@@Q@BBC@BH@BBB@@BFCBBDA@@DD@D@B@D@@FLA@A@@@@BAB@@F@@@@BB@D@BA@BFA@AB@DC@@@BDABBD@B@@D@BLHBFB@A@AF@@B@@D@B@D@JA@H@@F@D@DBHBBAJ@BABCD@B@ACF@@BH@@AF@@AB@B@DBBBbF@HH@D@HAAFF@FBB@B@@BAB@B@@L@DLTA@BBF@BDB@BC@BDB@@BIB@@@L@@@BB@AFJA@@@BAH@FAFBD@@FB@@@BB@@@@B@@@@@DFFIB@HBLDP@@BF@B@BKBACQFGFA@AGC@BBBJBF@@@FCBBF@DC@@@AB@@AA@AA@AEC@C@KD@@@BCBBDAD@@AHH@@@D@@HF@B@BFB@FN@@BHB@@@@@BB@@HE@D@BLC@D@FD@BDB@BDD@@@@B@@B@@@@B@@@@@@@B@@A@A@@B@@B@@BDB@@BB@@@AB@@@B@@@B@@BA@@BA@@@BB@B@B@B@@@B@B@B@B@@@B@B@@@BBBBB@BBBB@B@@BBB@B@B@B@@ABA@@BAB@B@@ABF@F@@@FBFDFB@BHBB@BBLDHBFBDDDBDDBBBB@@JDBBFBHDJFDBDB@@NH@@VLJFNHB@HFNFDBF@HA@BjJ^FfFJBXBƶV@@DAHGFKDQDOBMBO@MCYCSACCAC@CAA@@A@@@A@ABADC@@GIUOSWeCO@e@MAOPAMAa[CA@@@a@K@UBc@CBOnBH@D@TC@m@E@A@E@KDGF@TCPEFKFSB@NAFAPFHBDABEJ@FNBDJJTBbBVJJDHDTM\\A`AVBHBJ@DCH@RFBEAECCKCACECECCAIID@CKIB@@AE@C@EB@@CDA@AC@@AHA@ADADHJABAEICAECA@GDKDDHE@AEA@AABE@A@@AG@AA@AGA@@A@AA@@@E@BEC@AGAC@AC@@@G@@BA@@@C@CDAAA@@CGB@A@@@A@AAA@@BA@@@A@@AA@CACDA@AJ@BF@@@B@BB@BBFDD@@CD@BDD@@AH@EEC@A@AAM@@EB@NB@@AC@@@@B@@@FJ@@BAAE@CA@@E@AB@@CBADA@@@@AA@AE@A@NE@@A@ID@@AA@@@AFA@@DCAAOBACG@@CBIA@@@AAA@CHA@E@@@@E@A@AC@I@C@C@AAAACAA@@AEEDAACCA@E@CGAEGEIEEA@@AA@G@CEDCDA@A@CACEAKCCAAAAC@A@KD@AEICAE@A@A@AEI@A@@BADAD@AGH@BGD@BA@A@IBAD@B@@A@@@A@A@@BA@@@A@AC@@AIAC@GCAC@ABM@ABA@CB@@ABAHC@E@AB@AA@@@A@A@A@@@AA@@A@@@A@A@@@A@@@A@@@A@@AA@@@A@@@A@A@A@@AA@@@A@A@@@A@AAE@C@A@@@AAA@@@A@@@A@@@C@AAA@A@@@A@@@A@A@@AA@@@A@@@AAA@@AA@@@AAA@C@A@@AA@@@A@@@A@ACB@BBH@DABC@C@@EAA@B@DG@@@@HE@@FG@BJ@BAACAAAABAA@B@@BBABADE@@AG@E@C@GAGAE@@GECA@@IBC@AAACGC@@@@AF@@GAAB@@@@C@AE@AE@C@CBAF@F@FCBA@@@A@C@CAE@GACAAC@I@@DG@@AA@A@AC@G@AC@@EE@AC@E@EF@A@@ED@@CD@@AAE@@A@@@C@A@AA@GA@C@AD@B@HADABG@@BO@@FA@G@@AA@K@BCAAA@@BCBA@A@K@OBA@@DA@C@GFLL@DBBAB@BGFABABCBE@C@ESCGA@@AC@@HCDC@@DADA@@AEAC@C@@EAAEDK@E@A@A@A@@@@A@A@A@@AA@@A@A@AAA@AA@@A@@A@A@A@@@A@A@@@C@CAQA@E@A@AMIB@@AEC@@@@FE@C@@FC@A@AA@CA@A@MBM@EB@@AB@DAD@BCDA@A@ICCAGAEBAB@BGBA@AAA@@EG@@CAAEEEAABCAECCAIG"],encodeOffsets:[[121587,37610]]}},{type:"Feature",id:"370784",properties:{name:"安丘市",cp:[119.218978,36.478493],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@AAC@A@CBCBABED@BA@A@E@@@CAAAA@E@@@@@AB@@@@CHA@ABC@C@@@A@@@ABAFADB@CBABG@GEC@@CCACAC@@@I@AB@BC@QEC@BE@@@AA@A@@@@@GDA@CBGFA@@@E@@@E@@@@@AB@@@@@B@B@D@DA@C@GA@@C@A@C@CDAF@BADEBGFAFABC@A@AAECEECAGAA@EFC@A@GAEC@A@ADAFA@AAAG@ABA@G@AABGHBD@AACEEEBACEAAKAI@A@CACAAAGDCAEEAAA@A@@@@BEBCJIHIBGHAB@DBBD@@BABABBFBDDAD@@B@@CDC@ABAA@@CBBBABBDCL@FAFH@B@@BAJD@@@BDABABEBCD@BBFAJDD@D@B@LCFEJGAIF@@ALAL@BFFDBABAB@RED@@CBMBAAICO@C@C@A@A@CA@@AAA@E@GDEDE@CAKEC@CAC@KD@@A@ABEFABEAEDCBEAGEGI@@MGA@@@ABAD@FB@@BEHA@@BEB@FBBBBFB@FABH@BFDLBBDHFFHFABABBBBL@@@F@D@FLJD@B@BB@@ADFFBDJFBABBBH@D@N@DB@B@BBDFDHFF@FAB@B@@DJBD@BBBADBHCB@DBFBB@BDF@BED@HJJDDADDHBBBAB@@@@B@BBFGJ@DB@BAB@D@D@FF@@@BB@D@D@D@@@FDB@B@D@@@D@B@BAD@BADABABA@A@ABADA@@@@BA@@B@@AB@@ABAB@DADAB@BABAF@DAD@B@BABAFCB@DAFADCB@DAB@@AB@BAFAD@@@P@B@B@JBD@@@XBB@LBNBNDBBB@B@@BB@DBDBJDLBJBPADAH@B@JANBJB@@N@FBNBB@BBNJLNDHGDABGDANFBFBD@@F@F@D@@C@A@ADBB@HA@@EA@A@AFED@@@BBDDADADDJB@@@FC@AF@BA@G@A@@DHHAB@@C@@B@@ADAACBCFGBABADB@HDLDDB@ABBABDBDBB@@@BA@AHDBBBBHBB@@DBBBBBDB@F@@B@BHFBAHAHBB@HHDBJAB@BA@@B@@CDCDCBGJ@D@@@D@DADBDD@@CDBBB@B@DGBADCBAD@B@@ADDDFBBCJCDBH@@BDF@D@B@DL@LB@@@@BG@@DJ@@B@B@@B@D@BCBABHDBJB@@B@FIB@@FJDF@B@F@DAFCBE@ABAD@BFB@AHCBAH@B@BD@AB@B@BDBF@B@BCCC@AB@PFBDB@BADFDAHCDAA@E@@A@CD@@BB@F@B@BB@BBBHBD@D@BBA@ADCDBNAB@DABABE@@D@B@@D@DDAJIF@DAA@BAHABC@A@CCGBE@A@@JBL@BBB@@BDD@BCD@ADB@D@JHFDB@HBB@DAHEHAB@LN@BHHHD@@FDDFDBDBJBD@FBB@@BB@@@FDJBN@HBPCB@FBF@HB@@@ABC@AAG@ACAAC@EBAB@JFDBB@PAJABABC@C@AF@@EA@C@AABCF@@@@EHCFFFDB@BK@G@ADAJ@DALCFI@C@C@ABADEDG@@FBHFHFDDF@HC@ICA@GNABANEB@JBLDB@BAFE@C@OA@C@@E@@FABAFG@A@A@IEA@KKEKJAKC@@CA@@@@DGDGC@@AGEGE@@ODA@C@AEG`BFAJCD@BC@@DAD@HGCQJADEFB@GB@DA@@BAACCG@AG@@MAK@AD@@EB@@EBABA@C@@E@@AK@@CM@@@ALC@AJA@@DK@@A@@A@E@BDKBGB@@ACCACOE@@CKAC@EAKGB@L@@ABA@A@BB@BG@AA@@A@@@@DAB@@CD@@CA@@CC@@@AA@BE@BAb@B@@G@ADC@CB@RDHAJADFHJ@@H@DABHJBBLB@DFB@D@J@B@@AAABA@GF@@GD@@EDADE@ACICACABADABCA@IBCACK@AD@@D@@D@@FB@BEA@@A@CD@B@DBB@@@DA@AF@@CE@@CC@BEB@FAB@F@@A@CT@VBB@X@@AF@DBNC@A@@@CACBCAC@@@AA@@AAC@AAA@A@@BA@A@@@EBA@A@C@@@C@@@AAA@CA@EECGAAACACAC@@@AAA@C@ACAEACAC@EAWEC@ICA@CAOIQK@ACAEE@@KKGCCCCCEECCMEECQ@OBC@UCA@EAGCA@KICCCCIEICGCGAC@QCC@IDGBE@EBABC@GBKBEBC@GBA@@@GAKCICA@GCEAI@OAKBICC@@@GCEAA@GEEA@A@WECEC@@A@MCCAKKEEMICEAAGOECAACAUB@@ECEAAC@@@C@ADC@CEGAA@@EIGG@@@A@A@A@@@A@C@CB@@A@A@@@A@AACGCCCCACAC@GBEB@BABEFCF@@EDCBEBCBC@@AC@@AAAAC@A@@@C@A@ABA@ABA@A@A@AAA@A@@AAAAAACACA"],encodeOffsets:[[121921,36954]]}},{type:"Feature",id:"370785",properties:{name:"高密市",cp:[119.755597,36.382594],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@EA@@EBCBCBEH@BAC@A@AKCE@A@CEA@@G@@B@@EECAC@CIAA@BOC@@BA@@M@@ACCAADA@C@ABG@CAGBEDCB@BGHGNAL@D@BAFCFCDE@KBABAB@BA@ALADC@O@A@IBC@C@OAABCFC@A@@CDGAACC@CBA@ADGBE@EAAAAAAA@BIECGCABABABAAICII@IG@A@C@AB@FCBENQTCFCJ@@@@@@ELIJC@IB@@M@G@A@S@GDCD@@@BGDYBG@E@A@IBU@CFCF@BBHDN@BFP@BHNDDDF@@BBHHBJB@DL@@DJFNFNP@AP@DB@D@dAF@@DV@@CL@D@@F@DS@@FE@@NKBFDBH@D@FMFBBBFBJ@@DHDF@@BFDDJ@BNFBABCB_DABEF@BLPFHHFD@BF@@SCQCAD@DA@@@@BFH@BD@@DH@AFCB@@@JD@BF@@@@J@@B@JD@@F@@F@F@BDF@D@@ED@@F@VB@B@D@ADB@BD@@B@@BJ@BHB@H@BDK@GHGBBDBBHPAJJJD@D@JKL@ZRDH@BNBB@B@B@DA@ALEFA@CD@@A@@@AF@@BBDFBHA@ANA@BB@H@DA@@B@DBBHAB@F@D@J@D@@@DJ@BB@H@FADC@@BA@@BDBLFBFA@@D@B@BGHA@@BAF@@@@@L@BAB@DBHJD@B@@@@AD@DADAHELAHCJAFER@@AFADADGN@@BBFB@BAJ@HA@EPABABA@EAAFA@C@E@@BCHEL@BADAHCBE@GA@B@DBFHB@BKH@DAB@BCDCB@DE@GDABBBEF@@CB@@ABA@IDCTAF@@@H@HDBDBBDDFJJ@B@B@B@H@B@BCDBFDBB@F@ZDB@FDB@BBB@@@FDJ@BBBBD@BBBB@@BA@@@C@A@A@@@@@@@@BA@@@@BA@@BA@@@A@@@@AAB@@AB@@@B@BAB@@A@@@A@A@@@A@@@A@@@AB@@@BA@@B@@A@@BA@@AA@@@A@@B@@A@@D@B@@@B@@@B@@A@@BA@@@AA@@A@@@AB@@ABA@@@@@A@A@@@@BA@@@ABA@A@@@A@@BA@AB@@A@AB@BCBA@A@@@AA@@A@@@@@AB@@@B@BCB@@AB@@AB@B@BA@@B@@@BA@@B@B@@@@AB@B@B@@AB@@A@@@A@@@@@A@@B@B@B@B@B@BA@@BAB@BABABABA@@DABA@@@@@A@AB@@@@A@@B@@@@@@@@@@@BB@@@@@B@@@@@@@@B@@@@B@@B@D@@@@@@A@@@@@@@A@@B@@@@@@@@@BA@@B@B@@@@@@@B@@@BA@A@@@@@AB@@@@AB@@@DC@@B@@@B@@@@BB@@@@B@@@@B@@AB@@@BA@@@@@@@@BA@A@AB@@@@@@@@@B@@@@@@@@@BA@@@@@A@@B@@@@@D@@@@@BA@ABA@@@@@@@@B@@@@@B@@@@@B@@@@@B@@A@@B@@@B@@@@@@@B@@@B@B@@@B@@@B@@@@@B@@A@@@@B@@@@@@@@BB@@@@@@@@@B@@@@@@A@@@@BA@@@@@@B@BA@@B@@@@@BA@@@@@@@@@@@AB@@ABA@@B@@@@A@@@@@AA@@@@A@@@@B@@@B@@@@@@@B@B@@@@@@@B@@@@@BA@@@@B@@A@@B@@@BB@@@@B@@AB@B@@A@@@@@@BA@@@A@@@@@A@@@@BA@@@@@@B@B@B@@@DC@@@@@@B@BA@@@AB@@@@@@@@A@@@@B@@@@@@@@@BBB@@@@@@@BA@@@@@@B@@A@@DABAC@AA@EAECIBMB@JBABBBD@HB@@@B@B@BHB@BH@DABA@@@AB@@@@AB@@@B@@@BA@@BA@@@@B@@@@@B@B@@@@@@A@@@@@AB@@@B@@BB@@@B@@@@@@@AA@@@@@@@@@AB@BBB@B@@@B@@@@@B@@@@@@A@@@@AA@@@@@@@@B@BAD@BA@@B@@@@@@@BA@A@@B@@@B@@@B@B@@@B@BAB@@@BAB@BAB@BA@@D@B@@@@@B@DAB@B@DAB@B@@@B@BAB@BAB@B@BADAB@B@@AB@B@BAB@@@DAB@B@BAB@BAD@DA@@B@@@@@DAB@@@B@DAB@D@DA@@B@D@BAD@B@B@DAB@B@B@@@B@DAB@B@B@B@B@BAD@B@B@D@DAB@B@B@D@BAD@B@D@DAB@B@B@B@B@FA@@D@JABAD@DAB@D@@@B@D@FAH@HAHAD@@@DC@ABA@A@CBE@M@ABE@I@@@ABGB@B@RBF@@@AD@@@D@L@@AD@BBB@@@B@@BBB@@BB@B@@BB@BB@@BBB@@BB@@@B@BBHDB@B@B@@F@@JB@CB@B@T@D@@CD@BGDCDAFABADAB@BADAHCHABADABB@HF@@@D@@BB@@@H@@CB@@A@AA@AA@A@CAA@@@@@A@A@@@@@@@@A@@@A@@@@@BAA@@A@AAA@CAEBGBA@@@@@@H@B@@B@B@@B@@@FAAAAAA@CACA@@AA@A@@AAA@ACDM@@@AB@DABABBB@@@B@@A@@AABAB@@@B@@A@@@A@@A@A@AAA@@@@IBEAAA@C@A@A@A@BE@CAAE@IAEB@DABE@M@O@G@EAA@@AA@A@@@AAA@A@@@A@C@@@A@A@@@A@@@@@@@@AAA@@@@AAA@AA@@A@@@A@AB@@@BABA@@A@A@A@@A@AA@A@@AAAC@@CCAAA@@@@A@A@ABCAA@AA@@AA@A@A@@@AA@@@A@A@@@A@@@AA@@AA@@CEAC@@@@@E@@@@@@@A@@G@CAA@ABG@@BC@C@@BAD@@@B@B@@AB@@A@@FED@FCDA@CBG@@B@BABGEAME@@ADEBCDE@A@IAABC@QAA@EAAAAAFIEA@@@ABE@CMEBABC@A@C@A@AA@@A@A@@@AAA@A@A@@AA@@@A@AA@@A@@@A@@@ABA@@BA@@BA@A@@AA@@@AA@@A@A@C@A@@@A@@@A@@@AAA@@AA@@AA@@AA@AAA@A@AAA@A@@@CBC@ACAIA@@@AA@A@A@@AA@A@@@AAA@A@@@A@AA@@A@A@AA@@A@AA@@A@A@AA@@A@AA@@AA@@@A@@@A@A@@@A@@ACA@A@@@A@A@AB@@A@A@@@A@AAA@@@@AAA@@@C@@@AA@@A@@AA@AA@ACAAA@ECEEAA@@AA@@AA@AA@@AA@AA@@@AA@AA@A@@@A@A@A@C@@@A@@@A@@@@@@@@@A@A@@@AA@@AA@@@AA@@@A@@@A@@@A@@@A@@@@@@@A@@@A@@AA@@AAA@@AA@A@A@@@A@C@A@A@@AA@@@@A@@BC@CBE@@@A@@@A@@@A@A@@@AA@A@@ACCE@GGCGBEB@B@BA@@BG@A@@DIBEDCDEH@FBBABA@ACGACA@AEACBEBA@AFEAEJE@@@A@C@C@@CAC@ABGCCI@@@A@@BAGBADA@EBBC@@@CA@ACBCA@C@A@CBGBA@CAAAA@@D@@@BA@A@CBC@@CCAOEI@E@BDG@E@EA@A@@A@@@EACE@@KEC@A@E@IFIAABA@ABA@AB@BAD@F@F@@@BA@ABA@@@A@A@@@A@AA@@AB@@ABA@@@A@A@A@@@A@A@@BA@A@@BA@AAA@@AA@A@E@EBA@A@EFC@AAAAA@"],encodeOffsets:[[122569,37046]]}},{type:"Feature",id:"370786",properties:{name:"昌邑市",cp:[119.403069,36.843319],childNum:1},geometry:{type:"Polygon",coordinates:["@@@EBAAGCAA@@@CBG@A@@AMB@BGBEAAC@AE@@B@@@BC@@DEBKF@BCBA@A@A@MABFI@@NDA@BBBKB@DE@MA@@KBDPDFJBBD@LBJBBF@@DL@@LKBO@@@CLED@DDB@DD@FB@DL@@FSH@DAN@@AHBRC@@BG@MACEAACBEDA@M@A@@RIAADC@CDE@CE@@G@A@AJA@A@C@ADC@@FO@@DGBAXJ@@FB@@F@HD@@LG@IB@DA@AE@@A@AFA@@@@BC@@DG@@FC@@@@FB@@J@H@BFB@BD@H@@FB@@AD@BTB@DBGF@HAH@FKAC@A@CE@BQHONA@@CI@C@K@@CC@@CA@@DA@@DI@C@@FI@E@@DI@A@@CQAAAKCA@@DABABC@ABCB@BAB@BADABADEB@@CBCBEBCDAB@@@B@BB@@@@B@@@@BBBB@BB@B@BBBBB@@B@@@@B@@@DF@@@BBBBB@B@@BBBBBB@@BB@BBB@@BB@B@B@@@BBDB@BBB@@BBBBBB@B@@@DB@B@@@@DB@BDDDDDH@@@@BB@BDBD@D@BBB@ABA@@B@@B@D@FBBBD@F@@@HADBD@DD@FBB@BBB@@@@BD@@@D@B@B@J@B@B@@A@@B@@AD@D@D@B@@@B@D@BBBBFBHBD@BAB@@@B@B@B@B@@@@AB@@@@@BBD@B@DABAB@@@B@B@@@B@@A@@@@@@@ADADABCFEDCBA@CBA@EDCDAB@B@DA@AAGBEBCBC@AAOIACAE@G@@E@E@A@ECEGAE@@G@C@CM@A@E@AMEC@E@G@A@GDCFC@@B@BADC@@BABI@@FEBA@BFABA@@@C@ECGBABAHA@@@@DB@BBNF@@BB@DB@D@AHC@CP@BCC@BE@@BA@E@@D@BD@BD@DAHBHCF@@@FQ@ABQ@@CE@AA@CC@@C@C@KAAC@C@ABGBIGGAA@@@AA@@@@@AB@@@ACE@BDA@OBGAA@ABCJ@@AAA@ABAFGAMBA@@@I@A@@J@D@BE@EB@@AB@@AB@BA@@BA@@B@BA@@B@@@B@@@B@B@@@B@D@BA@@@@@ABAB@DBFDFBBF@B@DFHFAB@B@JGD@BAFA@E@CBEBEF@FBBB@@@@A@@BCD@BDBHGBBFB@B@BEB@DADBBB@BA@CA@BAH@@BB@B@@AB@@ABAA@@@@BA@@@AA@EFBJBL@BE@DH@@D@D@B@D@BB@@DF@BBB@B@@@D@BBD@D@@@B@@@B@@@B@@@B@@@B@@@B@@@B@@BB@B@@@B@BBB@B@BBB@FBB@D@@@B@B@D@@BD@B@B@B@B@@@BB@@B@BB@@BBB@@@BBBBB@@@B@@@@BBBDBB@B@@BB@@@B@@@B@B@B@D@D@B@BADBB@L@B@F@BBF@F@B@B@@@B@B@B@BBB@@@B@B@@@@@B@F@B@FBF@DBHDN@BBJ@B@B@LBH@B@B@@@B@L@FBJ@B@BAD@BCBCFCDAFCD@FAJDJBDDBDBDBHDJNBD@F@D@DDBF@DD@FBFDDHFBBBLJ@^RDBD@D@FCZaHEBADAD@D@HBH@FBDBHDFDJDLBHBL@hA|@J@F@FBHBJDLBRDXBVBP@F@D@@PADST@B@BDBBATSDC@UDAHCFCB@B@D@BBFFD@B@@@DAHAF@hALANALGHEBEBC@G@I@I@ABAD@JODI@CGGIEMMCIFKDEXG@@NGNGNCVGJGDE@CAQCK@A@CEYCG@CCAEECC@ACGEQEOACCI@ACIAAAC@C@ABADEJO@@HKBCDGBEBA@@@C@GAM@G@K@C@E@C@E@GBM@AAU@AAG@C@C@CCEGKACEIEKAAGOAA@@CGAA@AAAACACAAEIACAAEC@AA@A@C@CBA@A@A@@BA@AB@@ABA@@BA@AB@@E@@@@@AAA@A@@@A@A@@@AA@@A@A@@A@@@A@@@AB@B@@AB@BBB@@@B@BB@@B@B@@@BA@A@@@AA@@A@@A@AA@@A@AA@@A@ABA@@@A@A@@@AA@@BAB@@A@@@AAA@@A@A@@AB@@A@A@@AA@C@@BA@@BAECAAACCEAGAC@A@C@K@@@CBCBGBOBA@A@A@EBCFEBCBADGFEBCBCBA@@DAB@@@DAB@D@B@B@BAB@@@B@BCB@@ABABA@ABABA@@B@@@B@B@BBB@B@B@@AB@B@B@BA@@B@@@B@@ABA@A@A@@@@BAB@@C@@BA@A@@@A@@BA@AD@BA@@@A@@@A@A@@@A@@BADABADAB@BAB@@AAAAAC@AAAAI@EC@@A@AAA@ECA@YCE@A@CAAEDC@A@A@G@A@A@AIICEACCACA@G@G@@BEDSJCB@BA@@DA@@FEAABAHCF@@CDADC@ABA@CLG@AGAAE@C@AHBF@DABGBC@AFKDG@AF@D@B@BEFBB@BABAFOB@@GBI@AEAAA@@HMBCBCBE@@FQBEDIBGFKBGBC@CBC@@@@@AICAG@CBA@A@K@@@@BE@AB@HG@A@A@CB@AEKECA@AB@@AD@BC@E@GAAI@@C@@@C@I@C"],encodeOffsets:[[122485,37328]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("烟台",{type:"FeatureCollection",features:[{type:"Feature",id:"370602",properties:{name:"芝罘区",cp:[121.400445,37.541475],childNum:24},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@B@@@@@@@A@@@@@@@@@"],["@@B@@@@@@@@@AA@@@@@B@@@@@@"],["@@@@@B@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@"],["@@@@@@@@B@@@@@@@@A@@@@@@A@@@@@@@@B"],["@@@@@@@@@@@@@@B@@@@@@@@@@@@@AA@B@@@@"],["@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@A@@@@@@@@@@@@"],["@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@B@@@@@@"],["@@@@@@@@AA@@@B@@@@@@@@@@@@@B@@BA@@@@@@@@"],["@@@B@@@@@@@@@B@@BA@@@@@@@A@@A@@@@@@@@@"],["@@@@A@@@A@@@@@@B@@@@@@@@@@B@@@@@@@BA"],["@@@@@A@@@@A@@@@@@@A@@@@@@@@B@@@@@@@@B@@@@@@@@@B@@@@@@@"],["@@@@@B@@@@B@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@A@@@@@@B@@"],["@@A@@@@@A@AB@@@A@BA@@@@@A@@@@B@@@@B@B@@@@@@@B@@@@A@@B@@@B@@@@A@@@@@A@@@@@@@@@B@@"],["@@B@@@@@@@@@B@@@@@@@@AD@@@BB@@@@DCFA@@@AA@@@GB@@@BB@@@A@@@CA@@@@@BAB@@@@A@A@@@@B"],["@@BA@@BAB@@A@@@@A@@@A@AA@@@@A@@F@BABA@ABA@@@@@@@@B@@B@FCB@@A"],["@@B@@@@A@@@@@@@AA@@@@A@@B@@@B@@@@@B@@B@@B@B@@BBA@@@@@@AA@@@@@A@@B@B@@A@@A@AA@@A@A@@@A@@@A@@@A@@@@@@@A@@B@@B@@B@@@BAB@@AAA@A@@@ABA@@@D@@@@@B@@@@B@AB@@@D@@@@@@B@@@@@B@@@@"],["@@@@@@@@B@@@@@@BB@@@@@BA@@@@@@B@@@B@@@@A@A@AAA@@@@A@@@@@A@@@@@A@@B@@@@EA@@AB@@@@@@AB@@ABB@@@B@ABB@B@@@@@B@B@"],["@@@@@AB@@@@@@@@@@@B@@@@@@A@@@@@@@@B@@@@@@@@A@@A@@AA@@@@@@A@@@@BA@@@@@@A@CB@BCAA@AAAA@@A@A@@@A@@AA@A@@AAA@A@@A@@@A@@A@@A@@@AA@@@@@B@@C@@@@@@BD@@@AB@B@@@B@@H@BBBBB@@DB@@B@@A@@@EBA@@B@@@@BB@@D@B@@AB@@BB@@@@AB@@B@@@@B@B@B@D@@@B@@@B@@@@@"],["@@GI@@@BEBEDA@ABG@BEE@@B@@ABC@A@A@CBA@A@C@A@@@AAA@@@CB@@@@A@A@@BCDABCD@B@@@B@D@DBD@B@B@@AD@BAB@@@@CDABGBCBC@K@ABA@@@@B@DABBF@DBF@B@FAB@BABAB@BAB@DA@@BA@CACBA@A@CB@B@@@B@@@@@@@BA@@BAB@BCDJDD@D@CDGFBFC@BFG@@AC@BBABAAC@A@AH@J@F@B@BBBDD@@@B@D@@@B@BAD@DBBBBFFB@@@@BB@@B@BABCD@@ADC@EBC@ABCB@B@DJ@@DBD@@LHBD@B@D@FBF@F@B@H@D@FBDFFDBHDBF@JXHD@NDJBLDDBJBJBLDB@FBFFBBBH@DADAD@BEJCFGFIFEDAD@B@DBBBBBBF@N@LAJAPIF@RAD@FCRKHGJIBAD@PCHALEPEDEBCCCECIAEAG@GBQ@GBWFCDC@A@CAACAC@AB@BAB@J@D@BE@C@GBCBEBKBI@OBA@ABAB@@@B@@@DALMHCDABAHAL@HAF@DADCFEPKFCKASAC@@@C@@E@@A@@A@@@@GHC@A@@@E@@BA@A@EAA@@CC@@AAA@AA@AABA@@@CAA@A@C@A@A@C@CD@D@FAB@@@H@BABAB@B@DBB@@AB@@A@A@@DCB@BABABA@@BAB@B@@@@@@@@@@E@@@@@A@@@A@A@@BE@A@@@@@@@@AAA@A@A@A@A@A@@AA@AAA@@@A@@@A@C@@@A@@B@BA@@A@@AB@@@B@@A@@AAA@A@@A@@@ABABA@@AA@@@@AA@@@@B@B@@@B@B@@AB@BCHAB@@A@AB@B@@A@@B@@EFAB@@A@CBE@CBEBAA@@AAAABA@A@@@C@@BADAB@@AEM@ACKCE@@@AA@@A@@A@@A@@DAAA@@AA@ACCAAC@@AA@@A@G@@A@@ADCDABC@ABCBAB@BAF@F@@ABC@AAAAAA@CACACA@ACM@AA@@GCI@@@A@AA@K@@DE@@CCA@EEB@@"],["@@A@@BA@@@@BA@@B@@B@B@B@@A@A@@@A"],["@@@@B@@@AA@@@@@@@@A@@@@@@@@@@B@@B@@@@@@@"],["@@@@@A@@@@@A@@A@@@@@@B@@@@@@BB"]],encodeOffsets:[[[124406,38481]],[[124405,38481]],[[124390,38484]],[[124403,38480]],[[124406,38479]],[[124439,38479]],[[124384,38479]],[[124406,38480]],[[124404,38481]],[[124402,38481]],[[124446,38460]],[[124413,38468]],[[124404,38481]],[[124452,38460]],[[124390,38477]],[[124390,38484]],[[124398,38475]],[[124438,38477]],[[124417,38467]],[[124441,38467]],[[124283,38319]],[[124473,38486]],[[124326,38515]],[[124276,38540]]]}},{type:"Feature",id:"370611",properties:{name:"福山区",cp:[121.267741,37.498246],childNum:1},geometry:{type:"Polygon",coordinates:["@@MAICKKAAA@AB@BGAE@@B@@A@@@CAA@@@@C@@@AA@@AA@A@CB@@A@@B@BABE@@B@@@@@BFA@B@BC@BDC@A@@B@@@@@B@@@BC@ABBB@BA@C@@BBB@@AB@@AD@@@BA@@@AAABC@@FAA@BBBB@@BB@@@@BAB@@B@BBB@@B@@BBB@DBB@BBBBB@@@BBBB@@@@DBD@DB@@@BCLGHABBDDDDBDH@FEDEBCJEHKPGDYBKBADAFAHCDCD@DBH@BADEBGHINQHCBQTA@WFE@IAOEMEIAMIEGKQIEEGEGAAEIEEEAG@CBOJEBBRDDPHBB@DFLBB@DDJ@@FDBL@@BB@@BJ@@@@@DBJ@@@@BB@@BJDDNDHBBD@BADCF@@BFADQJAFCL@@@B@@ABABQFCD@R@@BFJL@@B@@BBDAB@@CF@DAB@BAB@D@@@@BBB@@@@B@HABABA@A@AB@AA@CA@CA@C@@@AD@@A@BCCAAFA@AB@@GFAB@B@BABAAAD@@ABA@@B@@@BC@G@@DB@@@@B@@ABA@A@AAA@@A@@BA@@@@E@CAC@A@@BAB@B@@@BB@@BB@BF@@@BB@@@B@D@@@@B@B@@A@BBB@@@B@BB@@BB@@BA@@BDHHBBBDAJA@@BE@WBKHODGBODCBGH@@@@B@BBD@BADBBJGB@B@B@BBBB@BBBFDFBBFFF@@BFFBD@D@BABCDDDBJDF@D@B@DBHBH@@BJJTLHBBHHPPA@A@C@C@@@@@BDAB@B@@AAABB@@BB@DBBB@@FFBBB@@@@@@@AB@B@@@B@@A@@B@@@@B@@F@@@B@@BBA@@B@@@@A@A@A@A@@B@@A@AAA@@@AB@@C@A@@BA@ABA@@@@BA@A@CA@@CB@@ABABA@@@A@@@CBA@A@A@A@@@CA@A@AA@A@@@@BAB@@AB@@BBB@@@@@BB@BA@@@CB@@C@AB@B@B@@ABABC@@BA@ABABABBB@@AB@@A@EB@@A@BD@B@B@B@@IDA@A@C@A@@B@B@D@BAB@@A@ABC@@@CBC@A@C@@@A@AB@B@@@B@@@B@BAB@BA@ABA@@DBB@BBB@@@DAD@BAB@BAD@B@D@@@BA@@B@@BD@BB@D@B@B@@@B@BB@BB@B@B@FA@BB@@B@BA@ABAB@BA@@@@@A@@@A@@@@@AB@B@BAB@@@@A@@@@BB@DDBBFDDFNRFCPCJAJ@TDBFFJD@DC@GFAVFTDX@V@LBXAH@HAJEHEFEFGBEBE@EACAEEIMOEGCGAG@SFALAFADADEDEBE@E@CACACCCEGEE@ACAAE@E@CBC@ABCFEJGPKFEJGNCBANCbIVGBAX@LAX@J@B@L@N@L@LBH@B@R@H@H@N@@IAEGCCAEEAC@E@C@G@A@EAE@E@C@AACKG@@AC@CI@@C@ADABAD@FAD@BC@@DCBA@A@AA@@A@@A@EEAAAA@CBC@A@A@@@C@A@@CCAA@A@A@E@IBGB@D@BBBAAAD@@BH@AED@AEHEDCC@C@ICDC@ABA@AB@@A@@@@@@@A@@@ADAB@B@DADBB@@AB@@CBA@ABABA@ABA@E@AAE@CAEBA@C@A@@B@BAL@D@DAHABADC@@@@BA@ABC@@@A@AAC@C@C@A@@@ADCBADC@AB@B@@@@@DA@@B@BB@@B@D@B@B@DAB@B@D@BA@@@AF@AFH@BAB@FCFA@A@@BABA@CA@@AAAHA@CACAGGBA@A@A@CBA@CACCA@@@CC@A@@A@@A@A@A@@AA@AAA@@AE@@@C@@@@@A@@A@@A@ABEAC@A@@CC@A@AAAACAAAA@@AAAC@A@@@@ACAA@@AA@@AA@A@@A@@@@A@@AECC@A@C@A@AHGFEBC@E@GAAAECAA@@AE@A@CAIAA@AADGD@DARONO@E@ICOKUKMCCCCECICC@AC@ECEEC@@OK"],encodeOffsets:[[124213,38141]]}},{type:"Feature",id:"370612",properties:{name:"牟平区",cp:[121.600455,37.387061],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AC@@A@@AA@A@@AA@A@AA@AACA@A@@A@@@C@@BABC@CA@@A@ABA@@BA@@@BA@@A@@AAAE@@BB@@B@B@@AB@@@@A@@@@A@@A@@@@@A@@BA@@@@BABE@A@@@AB@DBBGHOBYEKEKISKMIGEKK@@GGIIAEEOKGK@GAUGWI[MIJEDEB@PCDABEBCJEJCF@F@@BFDTAHBFDDB@DF@BABCDCFAFABEBAF@@@JCFE@CBADAHD@BBFFJDJDJB@@NBLFBB@@F@BDAFAPALBB@BBBB@@BB@RAB@B@B@D@BB@@HDBB@@@BAD@BABB@@BAB@BA@@D@@A@@B@BA@@D@B@@AD@B@@@@@D@DBB@D@@BB@@@@@B@B@BBD@BBB@@AB@B@@BBBB@BB@BBBB@@@BBBDB@@@@BB@BB@@@BBBB@BB@BB@@@@@@BD@@BB@@@BB@@@BB@@B@@BBBDDD@D@BB@@BAEABAB@@@@CBA@BBAB@@@@FBBB@@@B@@@FBB@@@BB@ED@BABB@@B@@A@@BC@@BA@@AA@AD@B@@AAABAD@@A@@@A@@@@@AB@D@ACD@@A@AEB@A@@@@@AF@BA@A@AB@@@DAB@B@@BB@@B@@@D@@B@DB@@B@@@@AF@HB@ABAB@BBLLJDNBPL@@FDDF@FBDD@JDFDDDDDLN@QFG@@LEVCB@X@D@NEDAB@@@BGEIZBJDFDDBRDHHNLHPDDDBDDELBJADCDBF@DJHF@D@B@B@B@DAD@FAD@D@FDDB@@D@B@B@B@B@BBB@BBDDBBB@@@@BABABA@@@@BAB@@@@@BB@BAB@@BFDFFBB@DBB@FAF@B@B@BBD@B@BDBFFDBDDDDDDDDBBBBBD@@BBB@@BB@BB@@BB@BB@@BDD@DBBBB@BBB@BBBBBBBBBDH@BNHVRTN@@@@@@BBFD@@B@BDDB@@@@@@@@@@@@DDDFDJB@@@@B@@FDBB@@HB@@BBDC@@D@D@F@@D@BBBGBAD@@BB@BAB@B@BF`BDL@DT@FBFBFFDHFHDJBFBJBFBDDHFFDDBLFPJNNDBF@J@J@LCFAHI@A@GBGHIN@TAZAfA`AfCtAZBPB@@L@PBVBV@VBX@XAJ@R@V@^BB@lDBEHS@I@A@@@@D@BABAB@@C@A@CG@AE@AECKWAGAI@AAEEK@AAA@@GMACCC@@AA@@IMCAICECOGAAMKIMACAS@@@OCMAKKMICYIOIOKGOOGC@YDEBEBACCAACDO@A@CGUACBCGK@IGCCAEGAOAAa@MEAAACCI@EBEA@@@@A@ABA@AA@@A@@@@@A@@@A@@B@BAD@B@B@@@B@@@DE@IC@BED@DHDA@E@ABB@F@FFABG@AAAGG@E@AHDBBFEBE@C@CEA@@A@CA@CB@BB@@DCEAA@GBAGB@BE@E@EC@EB@AEC@BAJE@BM@@@ABAB@FCB@BB@DD@FFBBD@BCBAAAAAHEIC@AD@@ABCEGCCA@ADABA@@E@AEACCB@DAB@@AACC@@E@AAAEGFE@AIEG@K@@GBA@AACGEE@EAQ@UBG@G@A@ALGLAH@BIFE@EECCBCBF@BBEBADADC@CIACA@EBCBE@AG@CAGICGECCCCDFF@DAHABE@C@AAGBCBBBABGDA@AAAA@BB@@DA@@@C@ABDD@DCBA@@@AC@@@@@@@B@@A@A@AB@B@D@@BB@B@@A@@@A@@B@DEACAC@G@DCIGEAA@A@@@BA@C@@C@ABA@KGA@ABBBEFCB@BCH@BCDCDDBBD@DCCA@EAE@@DC@A@AA@A@AB@AA@AAEAAAACEDCFCBC@AA@@ACIBEGCCACKAAKF@@OAUEOAI@S@WAC@W@Q@OHKFCBOFIFKFcFKEIIGIAACGAGCAA@IBE@CCCAA@@DAFABC@GIKCGAE@CBCFAFCFCHCBKHA@EGEA@@CBED@@GHMJB@@BABABA@@B@@A@@BD@DA@@CB@BA@AB@@B@D@BABABA@@B@@ADB@@DBBB@@BB@B@BAB@B@BAB@@EF@B@B@@A@@@AAAA@@C@@@A@A@AA"],encodeOffsets:[[124264,38029]]}},{type:"Feature",id:"370613",properties:{name:"莱山区",cp:[121.445301,37.511291],childNum:1},geometry:{type:"Polygon",coordinates:["@@W@A@UDKF@@EH@RLVDP@J@FMPQPCBC@CHBBB@JBDBB@F@@BB@DBBFBB@H@FADEFGH@B@B@D@BDDBF@@@B@@B@@@@BBB@@BB@@BBBD@@@@@BBDBB@@BBBBBDBB@B@BDD@@@BBDAF@B@BB@@@@B@@@@@D@@BF@@BB@BBB@@@B@B@BB@@@@BDD@@B@DDDBB@DAB@B@B@HABHBD@DGBBB@BB@@DABABHJ@@FA@FDB@DF@@CL@B@@B@B@@DJ@HB@@BDN@BDBDBDBB@BBBB@BAD@BE@E@ABA@ABAD@BADCBCD@BB@@@@H@BB@@BD@BBDD@BBB@@BBCB@@@BB@@@@BB@@B@@DFDL@BFN@BA@CBAB@@@D@@@BABBBBB@@BBFADAF@DAB@@@BAFE@@@AB@@@@ABAB@@@BADG@ABA@@@A@A@@@A@A@@B@@B@@B@@BB@BABA@@B@@@@BBB@BB@@@@A@@BA@@@BB@@A@AB@@@D@B@@@B@@@B@BBB@@BB@B@B@B@B@B@BB@@@@@@@@@BAF@@@B@B@@@B@@@@@F@@@@@@@@A@A@AB@@ABABABA@CD@@@B@BA@@BA@CAA@A@ABABG@@@A@EBC@C@@D@D@B@B@D@BBB@D@@ABBBB@@BBB@BD@@DB@FBB@B@@AF@@@B@D@HG@@@@@BB@@@@FD@@@D@TBLB@AHADC@ABC@AAC@CA@AE@IAA@G@ABE@A@@BCDEPONODCDA@@NAFCJCNGJALCHCH@FBLBD@B@BABADEDKBABADAD@D@@ABABADAJADATGE_@A@ABA@AAA@@BCHAAA@A@CE@C@C@@@CDAA@@GA@@AAEC@@@A@@A@CICECC@@@@@@@@@@@@CAACA@@@ECAA@@@@@@SMUQMG@ACGAAAAAAAA@AAA@AAAAA@CCC@AA@@AAA@@AAA@@AA@AA@@ACAAAACCCCCCCCCAEECA@A@AAC@A@A@ABE@EAA@CAAEEEC@AA@ABA@@A@@@@BA@A@@B@BABA@A@@A@AACCAAA@AAA@A@A@A@C@@@CAECC@C@EBC@CBA@A@A@C@E@IG@CAEDCBCAIFKCCCACCGOMKGGQCCAECICYAFJAH@@A@CBMFC@"],encodeOffsets:[[124280,38154]]}},{type:"Feature",id:"370634",properties:{name:"长岛县",cp:[120.73658,37.921368],childNum:20},geometry:{type:"MultiPolygon",coordinates:[["@@@@B@@AB@@A@@A@@AAB@@A@@@@B@@@@BB"],["@@@BBB@@BA@@@@@AAG@@A@@@@F@@@B"],["@@ABAB@B@DB@@BB@@@B@A@@CA@B@@ABBB@BBBA@@@A@@A@A@@@A@AE@@"],["@@B@BAB@@A@A@A@AAA@@BA@AA@A@@@@B@B@@CD@BBB@@@D"],["@@A@AB@DBD@BBBB@DA@A@A@CAACA"],["@@EBE@CB@B@D@@@BD@B@@ADABBB@B@B@BC@A@AAAA@"],["@@BC@E@A@AAAAAA@EDAD@D@B@FB@JA"],["@@@B@B@B@@BB@@@AB@@@BBB@@B@@@B@B@@@B@@B@@@@B@B@@@@@B@@@DB@DBBB@@B@@@B@ACB@B@@A@@@A@A@@@@B@@BB@@@BA@@@A@@@@@A@@AAAA@@@@@AA@@A@@@C@AAA@AA@GC@@ABA@AB@BA@A@@@@AA@@@@B@@"],["@@GLBJFHDBFBDEBK@GCGK@"],["@@@BFH@B@BABABA@@DBBBBBB@BAFBB@BD@DADEBABE@ABAHABA@A@AIIEAKCC@AB@B"],["@@E@EBADAH@HFJDDF@B@JGBCBEAEAEGEC@"],["@@E@GFEDADADAN@BBBB@DABCDAB@F@B@JABABCBE@E@AAACCG@"],["@@ABAB@BAAAAAAA@CB@B@D@BBBFDDBBDAB@BAD@B@BDBNFB@AB@BABBB@@FBB@BABK@EAICG@ACEGEA@C@"],["@@EF@FBFDDDBHBD@H@DDD@BABC@ACE@A@CBADBFADA@CAACAE@AC@CBCHEBC@CCAE@IDGFQT"],["@@@F@FDDDBFBFBDB@DBBDFDBF@D@HEHGHGBGBCACAAC@KFC@CAECA@GDC@MEEBCB"],["@@@AAC@AE@AAC@@DA@EBEBC@MBIDA@@@ABGFCBA@@BAB@@ABBBBB@B@BABABA@ABADA@A@@@ABAB@BAB@@ABCB@B@BB@B@BAB@D@D@DB@BB@BB@DB@B@BADADAB@B@BADA@@BAA@@AB@BAB@BAF@BB@@BBBBB@DADAD@BCFIB@@E@CBCFCFA@ACABECA"],["@@EG@ACAE@GBGFKFABGFABGLCH@FBJFFDDJFJDF@D@DADAF@B@HABABC@EAI@G@EBE@GAK"],["@@CCAECGIEIAGAGDAF@DBDFFDFDJFRHHJDZDL@NCJEDEBCCGCEEEICE@MAIBEA"],["@@EAMAK@OBGDA@E@EA@C@C@AACACA@CDADIDEFCF@R@HFZDXBFBBF@DCHKHSDKBEDAH@FAF@LCNMDEBC@C"],["@@@@ACCA@CBEBABE@A@CDEBEAACCCEAB@FC@EBA@KF@D@DIHADABABGB@AG@G@CBADG@@BAFBBF@HBFBBD@@@DABA@C@A@@BAF@BB@BCBAB@BBADAD@@C@A@@@AA@@@@@@AB@@C@A@BF@D@F@FADBDD@F@BBB@B@FB@B@B@DBBDDDBDAJCDA@ABE@EBAFC@@BAAA@@@A@@D@BABAAA@@BABABA@ABEBADABCDABA@AACAEAA"]],encodeOffsets:[[[123588,38873]],[[123759,38985]],[[123567,38898]],[[123575,38896]],[[123570,38853]],[[123527,38848]],[[123780,38940]],[[123540,38877]],[[123541,38969]],[[123741,39258]],[[123542,39048]],[[123847,38933]],[[123578,38839]],[[123800,39281]],[[123801,39305]],[[123632,38877]],[[123527,38863]],[[123721,39217]],[[123687,39075]],[[123661,38827]]]}},{type:"Feature",id:"370681",properties:{name:"龙口市",cp:[120.477813,37.646107],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@B@@@B@@@@@@@@A@@@@@AA@@@@@@@A@@B@B@@@@"],["@@F@DAFABA@@BAAAA@AA@A@AACAAAAC@AAAA@@BA@AAA@@A@@@A@@A@A@A@@A@@D@@@BA@C@A@A@A@AAA@A@@D@@ABABAB@DABCDABAF@B@BDBB@DBDAB@BBB@BA@AB@D@FBBBD@"],["@@ECBA@AAAB@DAD@@AB@FABBB@D@@ADAB@@A@AB@ACJ@DAD@DALAFAFCPEDAJCbMJEDCBAHKEMACCG@A@A@ABS@CCGCEQO@@@AGGEIAMAAAM@CAEAK@C@K@GBEBIFEBCHGFE@EI@KEEK@IEEIGOCMFONQRCDKJEFOLA@EBA@A@A@@BGBEAICA@MGCAGGMGAAACKMIIKAC@C@IBA@M@IF@@@@GDOP@@IDCBYJMGCAKMCC@ACGAEGAI@U@C@]AEFA@IJETJJHDBF@DADADCD@B@@@PCD@@ABA@ABWLQFG@K@A@QD[HA@QBABCBA@AAA@@@AAGKQCA@EBGBABMLA@ABEDCBKFKDGB@@I@KCAACACAUBEFI@WCICEAECA@@@CAA@C@G@@C@@I@ADEDAACA@AAABAB@@CCAEAIBABGDCDA@ABEDKLADCDAHGDADABBFDDBDKFCBupWVDDLNILCNJJN@LBjBdJLRD\\IPQB_CWJYTCFCHAHBHDFFDLDl@j@DABVAP@R@TDXDLBRJRJldZDFFDDDBDBDBDFDHBL@LBLAJAJAPAPARCRAB@D@@@DFBBBBDBB@FBV@^BBBX@VBFBHBHOBEGCBA@CB@@A@A@@BABAJAFG@ABA@@DGBCBEFGDGFI@C@C@K@CCQ@EAE@CAEEE@AHCB@HBB@LANAJ@D@JA@@H@B@LARBJ@H@@AF@F@JO@ABM@ABEDGDA@C@CB@@ACAC@IM"]],encodeOffsets:[[[123320,38698]],[[123346,38696]],[[123570,38541]]]}},{type:"Feature",id:"370683",properties:{name:"莱州市",cp:[119.942274,37.177129],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@A@AB@@A@A@@@@B@FBFB@DBDADA@@@A@AAAAAA@@A@AAA@@"],["@@pFHDFFFFBHFD@BZTHFFHHLFJHJLJJFFDHDBBJHFHN\\HLJFHFTD\\FDBRDJDPJHJDDFJA@A@]@GBM@AHBFDDZB^@HBDFBJBHIFGJBTBJ@HCBADABCJSTG@EB[JEJCLSLAFFLBD@HBHAHCLJJ®LNDLB@@TBNBD@HBFFBDBFDNDHDHEFFFB@JHX@PDPBTDLFHHJHNJDBDB\\BvFDBJ@LBLFFDFHHHFDDDB@LBPDNDD@PFZH^JNF@G@OBU@A@AA@@AB@D@@E@@AA@@D@H@@ABC@AD@@@@@@A@A@ACAA@@A@A@E@@@@B@HABB@@BB@@B@DA@@BAB@@A@@D@B@BA@ABA@C@@B@@A@@@AD@@A@A@AA@@@CB@@C@ABCBA@@@@AC@@B@DG@E@AAA@@@@@A@@@@@C@@A@@AA@@A@A@@A@A@AC@@A@C@AA@@A@@@@@A@@@A@@AAA@@CBABA@@BA@@@AB@DC@AAGAGACAGCOBSBKDGBC@@BAHODGB@DC@ADA@ADA@AZEDBLHFDJDNBB@HCFCBCLI@CBGBK@ABA@ADM@@DE@@DCFE@ALMBA@@@ADGDCDKBABENM@A@@BAP@NJJKDEDQBG@@BA@EHKX@TADK@@@@DGAGAWAGDAJACIGEE@EBACACAEGMEGCIKKAEEGKKEAIAS@KEBKFOHODMBKBA@@@A@AB@B@BA@@@ABEAA@AAAAA@A@C@@BC@@DC@@@C@A@@ABABGCCDEB@@ABA@ACA@A@A@ABABADABABCBC@A@@CAAACACAAD@@A@C@A@AA@@A@@@A@A@@A@@A@@@@@A@@@AAABA@@@@BA@@@A@@A@@ABA@A@A@@@AA@@C@@@@A@@@@@C@AC@@BA@@@AB@@EKACAC@@A@A@@A@@A@@AA@@@ABA@AC@@CBCCAAABAAAAC@A@@@A@@DA@ABG@C@CAC@@@@A@@@@@AAA@CA@@@@@ADA@@A@ACAA@ABA@AA@@AA@@BAAAA@@@@@AB@B@AA@AA@AAA@@AB@@@@A@@AA@@AAA@A@AAA@A@CAA@@AA@@A@@A@A@@B@@A@ABA@BDA@AB@@AAA@@AA@@AA@@@CB@@@AA@@C@A@@A@@@@@A@C@ABC@A@CB@@AC@A@A@A@C@@AA@@E@AAA@@A@A@A@@@A@CB@@@BAA@@A@@A@CCCA@@A@A@@@A@BAAAA@AAA@@B@@@BABA@A@AAA@A@C@AB@@AAA@@@A@@@A@@@BBAB@@@@@AA@A@@B@B@H@BA@E@@@C@GACAA@CC@@CACAA@A@ABE@@@C@A@@@AAA@AAA@A@C@A@E@AA@@A@A@AB@@@A@@A@ABA@A@@B@@A@A@@@@BA@@BA@A@A@A@C@@@@B@@A@CAE@AB@@C@A@A@A@AC@@A@A@A@A@@BC@AB@@G@A@CBCAABC@A@A@@@C@E@@@A@@C@@CA@@@AAC@@@AAC@@@A@@BE@@@@CAC@@@AA@@A@@AA@@A@@@A@@AAAA@AAA@@A@AAA@A@@B@BA@BB@BA@AA@@@@ABAACAA@@@A@@B@B@@AD@@ABA@ABA@C@CBC@C@CBA@@BBBA@ABC@C@@B@@@B@@@BA@@@@@A@A@@BA@AA@@AAC@AB@A@@A@@@@BA@@@A@@@@@@@ABABA@CB@@A@@B@@ABAAAB@A@@A@@@@BA@@@A@@A@AACAAA@AC@@A@A@CBA@@@@BABA@C@C@@@A@@@EAC@A@@AA@A@AACAAA@@@@AF@@AA@@A@@AA@BA@@A@@ABA@A@@@@A@AAC@CAA@@@@@A@A@A@EE@@@@AD@@@@AA@@ABC@@@B@BAAA@@@@@@B@@B@@@@@AB@@@@@B@@A@@B@CACAEAEAA@@@EEW@CBO@AB@BABGBC@M@CDIAC@M@CB@@A@I@A@@@A@@BUB@AK@AA@@C@A@@@E@A@@BI@A@CB@DC@@CA@C@@C@AG@A@@B@B@B@@@BC@C@@DE@BDC@AHCAAFC@AA@DQ@@EA@A@A@A@E@AA@AIAB@GAA@C@@BA@@BA@@CC@C@@A@CC@@CDB@CE@@ABE@@@AE@GGE@CAOAA@BDG@@@@@G@EACA@AA@KAOHAB@FAB@BB@AB@@C@@B@B@BEHB@@BUG@AB@@A@@A@A@AAA@@BCDQEC@AAACA@A@IB@D@DCBGA@DG@SHA@EA@BEB@BA@E@@@@@A@@B@@A@@BKAA@C@@@AFG@@DC@C@E@CCAAA@@@K@AD@FA@A@A@CAAAEC@D@BDLBR@DCFIHUHMDMHMH@@WHCFELDJNNJFHH@DCJIPXPHFJDPD\\PFAFINE^DhFTFTDN"]],encodeOffsets:[[[122698,38205]],[[122591,38022]]]}},{type:"Feature",id:"370682",properties:{name:"莱阳市",cp:[120.711672,36.978941],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@@B@@A@AC@@@@A@@@A@@BAB@@ABAB@B@DB@B@@B@BBBBD@@@@AB@@AAC@@@ABA@A@@BB@ABAA@@ABA@ABAAA@AAAA@@A@@AA@C@E@@@@A@@ACA@A@A@AHAP@@D@B@BBB@@@B@@B@@@@@BA@@B@@@@C@@@ABA@@@@@@B@B@@AB@@C@@@AAAB@BB@ABABAAABB@ADAD@BB@ADABCAA@@@ABABA@ABAA@BA@A@C@@@@@@A@@ABC@@@A@@@A@A@@BABAAC@ABC@@@@BAACB@@@@A@ABAB@@FBCBAA@BFD@@BB@@@BFB@BBB@@BBB@@B@@@B@BABBBA@@B@B@@A@@BCA@B@BB@@B@@B@@B@@E@@@AHAD@BDB@@@@@@A@ABA@ABABA@@@@DA@@B@@@F@BCDA@ABA@EA@@EA@A@@E@@@@@C@@@@BC@@@C@A@ABA@@BAB@BA@A@@B@@D@ADA@@@AAC@A@A@ABAB@@AB@B@@@BAAGA@@@BABAD@B@B@B@D@@@@B@@B@BD@D@@B@BA@EA@@@@AB@DBBAB@BABADCFFBB@B@@@B@@@D@AB@B@@@B@@@BABD@BB@@ABD@BB@@HD@@@B@B@@B@BB@@B@@B@B@@B@@B@B@B@B@@BBBDBF@BB@@BB@@B@DB@AD@BA@@@@BBBFBB@@A@@BADB@@@@BB@@B@@AD@B@B@L@@@DA@@B@BBBA@@@B@BB@@@@A@AB@@@@@@@B@@A@@B@B@@@@BB@B@@B@@D@BADA@@@@@BADDBB@BBBBBB@@@BDBA@@BAB@@B@BDAD@BDB@@E@@B@@D@B@@BA@@@A@DBBB@DBBBBB@@BB@@B@BBBA@@BABAB@@AB@@E@CBA@CBA@A@ABCDA@CBAAE@@FA@@CA@A@@BBB@D@BB@B@@DDB@B@BD@B@@B@@AB@@B@@BD@@BC@ABCB@@@@@BA@@BB@@@ABAB@@A@AAA@@BCAA@CBC@@@@BA@@@@@@B@@A@@B@B@@@@@@BF@@AB@@@BB@@B@D@@@BA@@BADBB@B@B@@@@AF@@B@DABBBBB@B@@@B@DBD@B@B@F@D@D@DBBBBBB@@AD@@@BAB@@@D@B@@D@B@@@@B@BBB@B@B@D@B@DB@B@@@BHBADB@@B@DAJB@BBALD@@@@@ADAAABA@@C@@@@A@@DB@AHAAA@@CA@C@A@O@@@C@@@C@@@C@@DGAA@@@A@@BA@@@AB@BAB@BAAAD@B@@@BAA@BA@BAC@ABAB@@@BC@@@@BA@@BA@@@@@C@@@@BB@@@@BAB@@CBC@@BC@@B@B@B@@@BB@@BBBA@@B@@AB@@BB@@A@ABBB@B@D@@@B@@B@BBAD@@@@A@AF@@A@G@A@BD@BC@@@A@A@@BAB@B@@@B@@AD@B@@@@@DA@@@@@A@A@@DB@@@D@@@B@@@B@@D@@@@@@@@BB@@D@@@B@@B@@@BAB@B@@BD@B@@@BABD@@@@B@@@B@@@B@@B@@BA@@F@@@B@@@@B@@BB@@B@BB@@@@DB@ADFA@@@@@L@@@@@@D@@@@D@HBBBBDB@@D@BABA@@DA@AH@@CB@@ED@@AB@BAF@A@J@AHF@@BBB@BA@@DDABBBB@B@B@@@B@BA@@D@@@B@@@FGD@@@@@BD@@BBDBB@@BBD@B@DB@BB@BD@B@BA@@DAB@BAD@@@B@D@BCBA@C@A@AB@BBB@BDB@AD@B@AB@DCHABA@A@@@AAAA@BA@A@E@@BEA@BOBCCABBD@@@B@D@B@@@B@BB@AB@B@BA@@BC@A@CE@A@A@C@@@@@AAAA@A@AAAAA@A@AA@@@AC@ABA@CA@@ABC@ABA@AAECEAAAAACA@@@@@@CBCBA@EB@BB@@DE@ABA@@A@@@BABA@@B@@AF@@ABADBB@BD@@@@@@@B@FD@DBB@B@BAD@BB@AD@@@BA@@@@BF@@@E@@BF@@@@B@B@HA@CB@A@D@BC@@F@BBB@B@D@B@B@BB@@@BD@BB@@BA@ABD@@BABABABD@ABB@@BABH@B@D@@B@DBDBF@B@B@B@B@@@@@BAB@B@@@@AD@BAB@BAD@BAB@BBD@DA@ABA@AA@@AA@BABC@AC@@@DA@GDABAB@B@B@B@BADCB@BBB@BDBTJBBHBD@PBDB@@T@JDFDDBH@V@FAFAHCHCFCHKJORCHBBBJNDBHBF@@@@@B@HAFBJHFABABABEBABAB@D@HARFDBB@DHFDLFBBDBHDNAH@LAHCHAJETCFCFEDEDEHAD@PDH@HAF@D@BBRHFBB@DAFAHCDCBANAHAD@D@HDDBJAD@DC@CBAACACAACCA@ECAA@CDADCFCBEAECG@ABABAHCDAF@H@F@DAD@NCJ@D@B@FABAD@DBB@B@BDDFDFBBDBB@H@P@F@BC@EDAD@DABEBEDEBGFEFEDAT@PUDEDCHCDCCEBKBAFGDC@@B@@B@ABBB@D@@@BAC@@@BAB@BB@@@@B@@B@@B@@CB@ABD@B@DEBBBBB@BBBCB@AB@BBCB@ABB@B@@AH@B@BA@ABA@@B@B@@BBBB@BBB@B@B@@@FB@ABAB@BA@AAA@@B@AAB@@BB@AAF@BB@@@@B@@AAA@@@A@@B@@A@A@@@BA@A@@BA@@@@@@@A@@AB@@AACAAAA@@AA@AA@@AHGHIEAMGA@EEAAA@EEGKGIKGAC@G@ABGFQ@GCAA@CAGCCEAEDCFEDEBCAABCDAHGBAAAEC@AAC@CAIACDEHEBCAACEGAIBE@A@EAECCEAAAAAABEFGHIDE@EACCAIGIOAGA@CBABGHICMCQKEKMOACACDCDAHADBB@B@@AB@BA@A@E@EHQFC@ABABEAG@@CEA@C@IAS@CGEE@AEOEMAC@AKS@AEMGOEI@AAABEFI@A@E@CBG@EAG@EGKEKCEEI@A@CDG@ECGCAWAG@EEAEBGFG@@LMDEFGJKZeNSHIOGADEBGOCsSUE]KICCDA@C@AAAB@@@B@BBB@@EF@B@DA@IHABC@A@A@AFQCADABGLA@A@CBA@ABABA@@@AAA@A@ABABA@C@@@@@DAEA@@@@AAA@ABAB@@@D@@F@B@ABCDCBC@ABA@G@@AC@EAEAE@C@A@@EBA@@AI@@CAACC@@@@@C@@@AA@@@AAAA@BA@CBAB@@@@CB@BA@CA@E@A@A@A@@@E@C@@@@CA@AAAAA@A@CC@@CACA@AA@@@A@ABAAA@@@A@AAA@AB@@A@@AAA@AA@A@A@ABA@@AAC@@@@AA@@AACAA@AAA@C@@AA@AAA@CBA@AAEAA@AA@@A@@@AC@@C@A@@AA@CD@@@B@D@B@B@@AB@D@F@BA@@D@@@@BF@JBB@@BBB@B@BB@B@DA@@@BF@HAB@@@BB@@B@@B@@BB@B@@@BD@BB@@@AB@B@D@F@BBDD@@@@BDBF@@@D@@CH@@BBB@@BD@DAD@LA@@B@BB@BB@B@BBBA@BD@@AB@BABA@@B"],encodeOffsets:[[123554,37532]]}},{type:"Feature",id:"370684",properties:{name:"蓬莱市",cp:[120.758848,37.810661],childNum:1},geometry:{type:"Polygon",coordinates:["@@gIA@]C@@OPCBDLFRHVDLBHDLBJ@BABQT@FEFGHADEFAJAF@H@L@DBLBF@DBNBBBNFJHH@B@@RPDFDH@DAT@B@B@BDHBDFNGLABCDIFaNIDCBOFEDEBKBCBC@CBI@BDA@@B@BA@CB@BC@A@AAEBA@@BC@CBA@BB@BABFDJND@DB@BA@@D@DCBCHAF@BAN@BIPE@E@@BG@I@QAKBA@G@@@IBC@I@MBKBA@GAA@GD@BFFBF@DBF@FDR@D@L@D@DEJCHEHAFADCH@@AB@BEHIBABAB@@@B@BA@@DABHDAFGPD@JDDBFDJJJHNNDBPNJFPFD@JAPEF@JBFBHDNHnX^PFDPHVLFDB@D@B@D@H@B@B@BDB@BDB@FBB@D@BADCJIBAD@D@F@LBHBF@JAJALCLERETAVBHDD@BBLDLDFDD@RDTB`AH@B@@AFGBEDAD@D@F@FBB@FADAJED@B@NDFBD@DAFCBEJGBCBCBCAG@C@CAACAEAACAA@C@ABEDCDEFCHGVMZODAHADAF@J@D@D@BADCLEDCDEFCBAFCBCBGBEBCBCFEPKHCMQCEECAACCA@@A@@B@@@@@BA@A@ABA@@@@B@@@B@@@@@B@@ABABAB@@A@AA@@AEBA@A@A@@AAAA@@@A@A@C@A@@AAC@@@AB@@A@@@C@ABC@ABA@ABC@C@@AA@AAA@CB@BAB@@ABA@A@A@@@A@@@ABAB@@@D@B@D@DA@@D@BAB@@@BA@A@C@A@AB@D@B@B@JC@@@A@A@AACB@@@FAB@@@BA@@AABABABAB@@AD@BABA@@@A@ABAD@@@DA@@B@@AAA@@@@A@AA@@BA@@BA@A@@B@B@@B@BDB@@B@B@B@B@DA@@B@@@B@BABA@@DA@@DBB@B@@A@@B@BAB@@AB@D@@@BA@@B@BBB@@@@AB@B@B@B@@@@@@AB@AA@@@A@@@EA@@@@@@AB@@@@A@@@ABA@@@@@@A@AAEE@@AACAA@@AA@BABB@@@ABAAC@@@@D@D@B@B@OOGGAAKGISAI@@AGAG@C@A@CCEAICCDCBA@A@CACEE@AE@EEAACEAEAAA@AA@A@A@AHAAICAABC@AAA@@@@@HGDACICGKCC@M@K@E@EB@@CDAFAJ@BCLEBU@A@AJCHA@CLCFOBGAU@QAE@OGCCEECCECI@QACB[@IHA@A@A@@B@@ABA@@BCBA@@A@@AA@BAAA@@@AB@@A@AAAA@A@A@AAA@@A@@@A@@@@@@@@BBB@@@@@B@B@@A@AB@B@B@B@@@B@@AB@BAB@B@@@@DFA@@@@@A@A@A@AB@@@@BB@@@B@@@B@@@@A@@@CA@@A@@@@BCA@@A@AB@@AB@@ABC@A@AB@@AA@@AA@BA@A@@@@@AA@@@@AA@A@@A@AA@@BA@AD@@@@@@ABA@CA@@A@@AA@@@AAA@A@A@C@@A@AB@@AAEA@@BA@A@@@A@C@A@C@@EDA@CBA@@@@@@@@AB@DAB@@@AA@A@@A@@A@@@AA@AA@@C@BADCB@@@FCCA@AB@B@D@@@B@BA@AB@D@@@AA@@BA@@B@@A@@@ABA@@@C@@B@D@BA@A@AB@B@B@@@B@BAA@BAAABABBBA@AE@A@CAAB@BAAAAAAAC@@AD@DA@AAA@BACA@A@@@AB@B@BB@@BA@@@AA@A@AAA@@@A@@@@AA@AAA@@A@@@@@AA@@@A@A@A@@@@@A@@AB@AEBA@@@@@AA@@@AAA@@@ACAA@@A@@@A@@AB@@A@A@A@@@AA@@A@A@@@@@AB@@AB@D@BA@@@A@@@AA@A@CA@A@C@@BA@@B@@@DB@@D@D@B@@@BA@ABA@AB@@A@AB@@@AA@@@A@A@A@A@A@A@CAAAB@A@@@AB@BABA@A@@@AA@A@A@A@@B@@A@AA@@@D@B@@ABABDBB@@@A@C@C@EA@@A@ABADC@E@A@C@@@AA@@A@A@A@@@@A@A@A@@A@A@AAA@C@A@@@A@A@@B@B@@C@A@A@@B@@@BA@CA@AAA@AA@@BCBC@C@@@@@@A@@@@BA@@@C@A@AA@E@A@@@@@FB@@@B@@ABA@E@AAAAAA@@A@@CAA@@BBB@BBB@CEA@@AAAA@@@A@AB@@CA@@A@KIQC"],encodeOffsets:[[123674,38322]]}},{type:"Feature",id:"370685",properties:{name:"招远市",cp:[120.434071,37.355469],childNum:1},geometry:{type:"Polygon",coordinates:["@@D@BA@C@CA@ABA@A@@C@@A@A@@@@A@@@A@@BA@@@ACAAAA@AA@B@@@BAB@@@B@@A@@@AB@B@B@B@B@@@B@@BB@@@@ABCBC@A@AB@B@@ABA@@@A@@@AB@@@B@@@D@@BD@@@B@@@F@BB@@BEAC@ADB@B@@DDA@@@BA@@BADCDA@@@ABA@A@@@@A@A@A@@A@@@AA@@A@@AA@EAA@@DABAAA@AB@@@B@@ADA@C@KDA@@C@@BA@@@@AA@A@AAA@@A@@@AB@BAD@B@BB@@@@@@BB@AB@@@@@@@@@@@B@@B@@@A@@@@B@@@BA@@@@@A@@B@B@@@BBB@BDD@B@F@B@@@BABEA@A@AA@ABA@A@A@@@A@@@C@@BA@C@CAA@@A@@@A@AAA@AABA@BFAB@BA@A@AAA@@A@@AAA@AA@@@AAA@AC@AAACBAA@@@AAA@AC@AA@A@@AA@AAA@C@A@GDHDBABA@@@B@D@@CD@@AD@@@D@BBBBB@BBBAF@B@@ABA@A@@B@B@@ABALCNGPEPALLFT@JBFBLLFHBFLLDJFHHNBFBDBDFAF@HFDJIBCBBHBXBHCH@@@@CLSBW@GL@FAB@@AHCRCFILMIO@AB@@@BMNAFABCLCDCH@B@@ABKN@BEFCD@@CF@@CN@BAB@BALAH@DKJADEDGDA@MAICECKGCAYF@BCB@BCB@BCDA@CHGPAB@@ADCHALATDPBHBDBHBH@BCDA@@B@@AB@@ABAB@DB@BB@@@B@@@B@@@@@BB@@B@D@BD@@B@B@BB@B@@@BB@@@BD@@@@@B@@@@@B@BBF@H@@C@AD@@B@@B@DABAD@@@DA@@B@@B@B@BC@@B@@@BA@@@@DAB@BABA@C@@@@BA@AB@@CBA@@@AA@@AAGBA@@@@@@F@B@BB@DB@B@B@B@@@@C@@BAD@BG@C@@@BB@@@FC@A@@BB@@B@BAV@P@HJHDDXNPJ^RFD@BHPBFPFD@ZJJJRVVPBL@BXUvoDALEACCCAEBABCHCBGDCBCLKFCBAB@DCHCBAJAFBDB@DA@ABBB@BDBBBFCBCJ@@@@DH@D@B@DB@@B@FDFBJDXDJ@FEVADBDBBBLDJ@@@HALCLEDAFCBAB@NKBAHAFAB@RDHLBB@@B@BBB@DABARAB@\\GRCB@L@H@REXKBAB@BA@@DC@O@@@ADCBCBC@CAEGCIIFSJIB@FE^BD@V@J@HBBFDH@BDDLNDBNHZIDAJC@@POHC@@@@JEN@B@JAD@D@LBJJBCH@B@@AB@DADCBADAD@BABABBD@@A@ABAAA@@@@@@@@AA@A@@@@@EC@C@AA@@@@AA@@@@@A@A@@@@@AB@BAB@@AB@@A@A@G@A@AC@@@@@AAA@@@A@AAAAA@A@E@A@A@A@CB@BA@@@A@@A@A@A@@A@A@A@A@A@CAA@A@@@A@@A@@B@@@DABABA@@BA@@@AB@DA@@@A@@BA@@DCB@B@@@B@B@B@@A@@@C@ABA@AAC@AB@@AB@CA@@@AB@@@BA@ACA@CAA@@@AA@ABA@@@A@@@ABC@@@C@A@ACCAEMAECECKKMCECCIQAACI@@BK@ABI@CBAHS@AB@DGDEHK@@DEAQ@C@@@@DK@CAAAGCCSGGKAECQ@G@EBMFQ@O@EMUEIIOA@EBA@AE@@AC@AGB@G@@AC@A@@@@@@@@@@@AAA@AE@CB@CAE@@@AB@HA@B@BB@B@@@@A@@AADCBGB@@@B@B@@C@ABCB@BO@E@EDYICS@@@CAOAC@GAAASICA@AAA@AE@C@@BAB@B@BB@@@ABC@A@AAA@G@@@@@@@@B@@A@A@C@BABAAA@@A@AC@ACACCCA@@AACAAAAAAA@@@AAGBAACAAA@A@A@@AC@@AA@G@@@@@C@ABCAAAKCAAAACAC@@AAA@AA@ABA@A@ABA@ABAB@@A@C@A@AAGB@D@BA@A@@B@@A@@B@@A@A@BA@@C@A@BAB@@A@@@AG@A@@A@@B@@AB@BAC@@@A@C@@@E@@C@@A@C@@@CAEA@@@AAAADC@@BABA@@@AAACAAA@A@A@A@A@@B@@ABA@A@@@A@@A@@BAB@@AB@@A@@@@@@@ABC@A@AB@B@@@@@@A@A@EAA@@AAAA@AA@C@A@OB@BA@@BA@A@@@AB@BABABAB@@A@A@@@A@@BA@@@AAC@@@@@ABBDA@ACA@@BC@A@A@EAA@A@A@CBAAA@@AA@A@AAAAA@@@AA@@@A@AAC@AAA@@@@@@@A@AAA@@EAAA"],encodeOffsets:[[123244,37980]]}},{type:"Feature",id:"370686",properties:{name:"栖霞市",cp:[120.849675,37.335123],childNum:1},geometry:{type:"Polygon",coordinates:["@@UDI@IAKACQ@KBQBKMOOIcESMECOOEFAHCFAFAFCBC@CB@FADE@O@G@A@CAAACECEACA@A@CAC@ABEBA@C@I@MDC@CBE@G@E@CBGDABAB@BDHBFAFEDCDCB@DBBFDB@DDBBBDBDAB@DCDC@IBCAGCC@C@GBMBABCDGDEBCBA@EAQGAAC@E@GBG@OCC@GBCFCFEFEDSDIFGBGDKBG@MBGCCAAAKEECCGA@CAQEGBC@A@ABABAFABABEBIGEAGBA@@@@@E@GACAIMAAGAQDIPGLEDGDGDEBEBU@G@CAECCZ@F@FAPA@AD@B@DA@A@@@A@AHCDBB@@@B@@A@A@@A@AGBA@@B@@BF@DDAF@@BBB@B@@@@@@@@@@@BBD@@@HHA@BBD@@BFB@FAB@JPFJNV@F@PERAN@F@HDRBFHLTHDDBHBB@DCL@@@@@DBRCF@@GLCFCHA@@BGTAB@DAJ@BAL@@DJBBJRDDDFLNDLDFBFFNDBBDB@D@@@D@BA@@B@@@B@BAB@@B@@BB@DDB@BAB@@A@@B@@DBA@@BA@@BBD@BAB@B@D@@@BA@A@A@@@A@A@CD@@AB@@@B@@CBA@@B@@AB@@ABABCB@@A@@@@BB@@@B@B@DBB@B@B@B@B@@@@B@B@BB@@@B@@ADAB@B@B@F@B@B@BBBBB@@@B@BB@@@@D@@B@B@H@B@BA@@BA@ABA@@B@@@@@B@B@@@@BB@@@@BBD@D@@F@@@@@BBB@@@@@@@@BBAB@B@BC@AAABABC@CBABCDCBA@@BA@G@ADLNBDBBNHHHDBNHB@JDFBHA@AB@B@B@FAB@PKFELIDCRQPMNEPDJHFF@JFLLFJ@RSBA@AAICKAGCKGUEQCKDAPO@@^DB@hJRDLJB@@@DB@@BAB@@@B@BB@BB@DFA@AAA@AA@@BB@DB@@@BBBBBBF@B@BA@@@A@@EA@@@@B@F@B@@B@B@D@@AB@@@@@B@@@@D@D@DA@AB@@BBB@BDBB@@A@@@AB@B@D@@@@A@AB@B@@@B@D@B@BBB@B@@@@B@B@B@@B@B@B@@@BB@@D@B@F@D@BCBAB@@@FBD@D@B@@@A@CABABA@@@A@C@@BBB@@@@AB@B@B@B@@B@@@BABABA@@B@@@BBABB@D@B@B@B@B@B@B@@BB@@A@@B@BA@@BAB@BAB@@A@C@C@@@CA@@A@@@AB@@@D@BDBB@B@@B@@@B@@ABC@A@@BA@@B@@@@@B@BB@@B@@@B@B@BA@@BB@@@B@@@BBBD@@B@BB@@B@@B@@@@ABBFA@@BB@@@@@B@B@B@@@B@@B@@@@@BB@BBB@@B@@B@@@B@BBB@B@@B@@AB@@AAA@A@@B@@@BDBABB@BBB@@CBC@@BDBBBBBB@ABADBB@F@@BABAAABBBABB@ABA@@@A@A@A@@B@BABC@A@@@@D@@AB@B@@@BA@@@AB@@BB@@C@A@@BABA@@@C@A@A@@BDBED@@A@CDABD@@@BBB@@B@@@BB@@@@BBB@@A@CBA@@B@@@@@@B@DAB@FC@@@D@B@D@B@@@BAB@@FBBB@@BAB@@@@D@B@BBB@B@@BB@@@BB@@DAB@B@@@@C@@BAB@@BBB@@@@BBB@@@@BB@@@@B@B@@ABB@@BB@@BAB@D@BA@@BA@@BAB@@@DB@A@@B@@@DB@@B@@@@@@A@@@A@@AA@@@@BAB@B@B@@@@@B@CE@@@@@ABA@ABA@@@A@@@A@A@ABAB@@@@A@A@@@@AA@A@@@@@@B@@@B@@@BB@B@B@BBBBBB@@@BA@@B@BB@ABB@@@BB@DA@AB@BA@@@AB@B@B@JG\\@DARBJ@FDDDFFDDPHF@RBV@HBPADEDKB@DGBIB@V@FADK@ABIBEDC@@FAF@L@N@D@LDDHDJPCHAPCLGXAF@@AB@BIACAAGGAC@@AB@@AA@@AAA@@@A@AAB@@@@A@A@@C@A@@@A@@A@@AEA@@AA@@A@@@ABA@AB@D@DBF@@@@@AB@@@BB@BBB@B@BA@@@A@@A@@CH@D@@A@@@AB@BA@@BCBBBA@A@ABAHE@@BAB@BEDBADB@@@BC@@D@B@@DDBB@@BBAB@B@BABA@G@A@@A@AA@@@@@CBA@ABA@CDE@@BAAC@AA@@@IKAE@@@QDCREBABA@@@A@@DKBERIBCAE@@DEBC@AACGAMCCCAI@@AA@@@@AI@C@@@@AI@@AA@@AKEC@@CI@CAAEK@CAAOGCCAQFAPIDAH@FBFFFJBBFHFHJFLRFHNJJBNFPFJBF@XEB@RSDARGJMHGFABC@AAG@CDCDCBGBEBCLAZAHCLOFGDIFAFC@ECGCACCACBAHGDK@A@@CAC@CA@@@@AAAA@@A@AAAAA@CAA@AA@@@AA@AAA@@@BA@A@@A@@AA@AA@A@@A@EA@@A@@@A@AA@E@@A@AB@AAB@D@@A@ABFBAB@@AAC@C@CCAA@AA@@@AA@@A@@A@@AA@@AC@@@@@@AAA@@AAAAA@@A@@AAA@@@@CAAA@A@@AAAAA@@AAAAA@@@ABA@@AA@AAC@A@A@A@@@@AA@@@CAA@C@C@@@@@ABC@@@A@CB@@A@AB@@@@CB@@ABA@AA@BA@ABC@A@@AAGC@@AAC@A@A@A@QBA@@AA@AA@AAABKBOBEACE@@@AAKEMA@@IAICICEEAAC@E@KBIAEAG@C@MFCDOH@@A@M@EDGFADC@C@EACC@CMEQGECACDK@@@E@EAAAEGEGACABCBA@AAAAAECICGACBCBA@CBEFCF@DFJ@BAD@HCBEACACGAGACEMGCE@EBADC@KAC@GLEBKFOH"],encodeOffsets:[[123891,38039]]}},{type:"Feature",id:"370687",properties:{name:"海阳市",cp:[121.173793,36.688],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BB@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@A@@A@@@@A@@@@@@@@@A@@@A@@@@@@@@@@@@@@B@@@@@@@@"],["@@IMIKGEICOCMBKBk@k@OBK@G@aRSJIP@J@DBD@DBD@D@DCDED_LGBEAGAE@EBCB@BEBCBGJMTYfILEHCFKN@@EHAHBFFFH@XBDBDH@FCH@D@BFJDFFLHL@FBH@FAH@D@F@BEJAFBB@BFJHPFN@BLT@BBDFNFP@BFFDHT@JBD@B@DF@@BHAFAB@BEDGR@F@F@BABA@@BA@A@CAGBCBCDBDBDNPFLRLNDJDHGBADAB@BHJPJHDBBD@FCFGJEHAFBBBBBBDFFDFBB@F@JAHBDFBBADGFCFBDBJ@DBD@BFDBBABGHCBADBBADCFEFCDBFDFHDDBB@DB@HERAH@B@HBDLHHJHLFFB@BBFFB@NHFBGJGH@BB@@BBB@@BBBBBD@BA@@BB@@@@@@@B@@AB@B@@A@@@B@BA@@@@B@@BB@BA@@@@@AAE@BBA@@AA@BBA@@@BB@BABA@AB@BEA@@A@A@A@AAA@AA@AA@A@@@AB@BABA@G@@BA@A@BAA@AD@ABAA@ADAAA@AAAACFA@C@BAA@@DA@@@@AA@@@@@AAA@AB@@D@AB@@C@A@AA@B@AA@@@CDEHABALDFCDGDCDCFOVS@CBEFPPFDTNdFPJNPALAR@LDRLBJBJ@VCPGLEFAHKD@LBD@BCFAF@HDFNBDBHDHDBFBDA@GBC@AEI@CDEFEDAB@DADAHBJDFDBBBB@BABADDBHBHFBFBB@F@F@@CLBDFDRHNF@DDDFBD@D@BCHEFCN@B@@@PGDCNED@H@FBJBLAF@BGBCDAF@DE@I@@BEFABABEDEDCBA@ACEA@CCAEBGCSAE@@@EDEFIDIFABADC@OFAFCJIBIB]IUBIAIBCD@HEBE@CAEG@MAECCOCIBIDEDAD@FDD@B@DAJCHEPKBAF@H@FFD@D@BABC@C@GBK@ADGFEDCBKXCFABABC@OBCBENMBCDCBAFAB@BDD@D@DAFEDGB@FAH@F@D@D@F@L@B@B@BI@A@CDGBCF@DAHDBBLDNFDB^PPDH@DAFMDMBC@CEGBA@CHADCDABIDC@@FAB@FGFG@A@ACAMDYIGGACCCDCBA@@BAGOBEDKBABAJ@NADA@GBIBCLIHODGBABAHEBCBAAK@C@CDED@BA@@@@B@@@B@D@H@F@D@HDRAF@J@J@JAF@F@ZKHEBCDCFGLEAA[KECAC@GCCAACA[A[C]AOEA@SOEGAC@EDCBAFBH@JADAFEBG@GEMCEIEMEOAG@ODCDEHGLEBCBE@IAUEWGOCICK@QBI@OAICICKIKKIIYUCCEAG@G@eHK@IAYKEOIYQECmYSK[]SOK"]],encodeOffsets:[[[124379,37646]],[[123961,37471]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
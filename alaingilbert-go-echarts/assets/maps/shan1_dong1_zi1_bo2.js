@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("淄博",{type:"FeatureCollection",features:[{type:"Feature",id:"370302",properties:{name:"淄川区",cp:[117.966723,36.643452],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@ECCCFCBA@AA@E@GB@@A@@@@AA@A@CBCDAHHPDDADCBCBEBC@A@ECK@MCGC@@CAG@A@ADADAJ@BBN@BCBEFDD@BAFCDGAGEE@GAAE@@BGAAEE@@ACCAC@KEEAE@EE@ABA@@CAA@EAC@@@AB@@ABABAD@@ABA@@B@@@B@BAB@DAD@@DD@B@@@BAB@@AB@BA@@@BB@@@B@@ABCDCBADADCF@FBBB@B@BDBDD@BDAF@H@BADIBIHEBEAE@CDA@MDAC@@EAE@CBA@A@A@EBGAAB@@OFA@GAEGCACCG@AAACA@C@CBE@GACB@@CDGBI@GDADA@GBMB@B@F@FCFMNG@AD@BK@GAGA@BABFD@B@DABEA@A@@E@CBCBCBCAAAAAGBIBQRA@@F@DABBB@@BBBBABC@@@ACADGHK@CBIF@BLFDBFDB@FBD@DBLBLJHBF@HHBNABE@EFBBADGFBB@D@@KHGBEAGBA@IFCBAB@B@BBBF@BD@BIFMFA@DFGBA@@BDB@BE@@@ABCB@@@@DHIHABGAEAEAA@C@A@A@CDAB@@ADEBGFC@@D@@C@@@@AC@@CE@@@CB@@A@@BAB@@@@@@CB@HGBC@C@@@C@CAAA@@A@E@@BA@@@A@@BAA@@C@ECCI@AAIAKEGIACEACC@E@GDC@GCEAWAKNA@CA@@AD@@@AC@G@CBABB@AFABEDA@GC@@GACMBGE@ABC@A@ACAACBCDGBA@EC@@ECCE@CAAAAEAAB@FE@GBGDCDCBEACBEDA@GBABBDDF@@LDFFB@BDJCBC@@D@BBADCFEJEHC@BBB@HBHBBB@H@BK@A@SCA@CBIH@BLBFAJBAF@B@@@@BAB@BFJ@HA@@DDGB@D@B@DBF@@@LCHE@B@@@@B@@D@@DC@CBHNABCBABBD@FADDBB@J@FBFBF@@@DCRBB@PAB@@@DBBFCB@@@BHAB@BAA@@ADAD@CBADBFB@B@@@FD@@JCJGDBNGJED@DBHFL@B@JAH@B@HAHCB@@@@@JENHFBDADABA@@DAH@NBD@BAB@DDB@DA@@\\MDAB@DA@@F@DFH@@FCDGBCDDBDL@BBFC@@@@JIHB@N@JJKHB@DBEHFDCDFAB@DB@@B@F@@@B@@@BABAB@HBDBBBJ@BAHD@@BBD@B@@B@@B@J@BB@BB@@B@@BBJELBB@B@@D@FFABAB@D@H@JF@BCJ@@H@@DAFAD@@EB@B@B@@CHC@AC@@EBCB@F@BBB@BHFABAFGR@BBBB@@@@BBBBBD@BB@BB@@B@@B@@B@BBB@@BA@@BAB@D@@@@@B@@@HB@@@DDBBBB@D@@@DAF@BADB@B@@@@@@CDABA@@DB@D@HE@A@@@@@CLIB@NAB@DBDBJ@DD@@@@@@@A@A@@@@@A@@@@B@@@@@@@@A@@A@@@@AA@@@@@@A@@B@@@@@@@@@@@BAA@@A@@@AA@@CA@@@A@AAA@@@BA@A@@@AA@@A@@B@@A@@B@@@@@@@@A@A@@@@@AB@B@@@BB@@B@@@B@@AB@@AB@@@@@@@B@@@@@B@@@BA@@BA@@@@BA@@@A@@@A@@@A@A@@@A@A@@@@@AA@@@A@B@BCAAB@@@@@RADBFDBDDJDABF@@DCFADADA@AB@BDB@B@B@@A@@@@B@BALB@AAEB@B@AG@AHABBDBF@DABAHI@BB@H@@@BBHFNHB@H@@BBFBBBCHCF@DDPGDA@@BEFCJAAAAC@GDI@CDGFENM@@FCJEBGB@BCBA@CFAD@FCBABC@@BA@@BIBAFA@FB@N@NFJ@D@BCDADGD@B@@ADCFKAE@C@CB@AAAAC@CCEBACDCBADABB@DBBPND@F@CE@AFCDBD@DEDAAEAALO@C@@AAAAAAA@AACAGAAACAAA@@EG@@GIEEGCKGCECG@ABA@A@@@@@@@@DA@@BBD@HEECCAAMAABEBK@AFBDCBADEDGBIVCJCNEH@@@D@BA@CBEACCCGCICAC@GDIB@BCEEA@BC@IDA@A@AD@FCBA@@AAECAAAGBCBEDKBEHIHEF@D@BCDCECDCBGB@CEE@CC@CDCDGBKBA@GGCA@@E@ICEAGACBCBE@ABAD@B@DDB@FBFAFB@HJ@HE@ACC@ABABABGE@CAGGCI@@AAGC@BGBMCAEE@CB@@@@@@@@A@@@@@@@@@BA@@@@@@@@A@@@@A@@@@@A@@B@@AAACAC@ADA@"],encodeOffsets:[[121050,37257]]}},{type:"Feature",id:"370303",properties:{name:"张店区",cp:[118.017938,36.806669],childNum:1},geometry:{type:"Polygon",coordinates:["@@CD@@AECBCIACECCAQB@@@@A@BBADA@B@@@B@@B@@@@@B@B@@@B@B@@@B@@@B@@AB@@@@AB@@AB@@A@@@@@A@@@@@@@A@@BA@@BA@@@A@@@AA@@A@A@@B@@@@@B@B@@@@@@A@@@@BA@@@@BB@@B@@@BAB@@B@BBB@@@B@@DB@@B@@@BB@AB@@@@@@@@@@A@@@@B@@@@B@@B@@B@@@@B@@@@@@A@@@@@@B@@@@@B@B@@@@@@CCI@CACAA@MBA@KJ@D@@@@@BGFC@DD@@@DADB@DD@BCD@BDDBB@B@B@LBD@B@@FBDDBD@HCBAB@BADAB@@A@@@CAAAA@@ACABCEACCA@K@A@@B@HE@@@AGE@@D@L@D@@A@ABA@GBABABA@AF@DFDD@@BHDBD@H@@@BG@GDCBIJ@F@DDJBBF@B@BB@B@DDBD@D@BB@HAB@D@HBBA@A@CDBNABE@@@BN@@BBBD@@@DBB@@LA@BH@@C@@@@@I@CL@DAB@B@@GF@@CF@BA@EB@FAB@@B@FBB@@@BDBFD@H@HE@@BF@D@@F@@F@F@BDBB@PE@@@@@@PF@@HFC@@@@AEF@@BB@B@BFH@BNB@BAB@PA@AF@@@F@@F@JBDDBD@D@@A@AF@B@BAAEH@NABD@@D@@@D@@CBADAFBACNA@@@@@@@@D@@@@I@AAC@EBAD@AKB@NAHAACD@FC@AC@@CB@BD@@@@HA@@@@ACLAHAFDBBF@@F@@DD@@D@B@DB@FD@D@ACAEF@H@@DN@D@JFFB@@@FDBD@B@D@@AJAJAFA@DB@B@B@ACBA@AJ@BBD@F@DAD@BCD@BCDG@@BA@KB@DC@AFCHBD@B@DGHCJ@@CJ@D@B@@A@C@I@A@A@C@@BOC@A@A@BEEGC@AG@GE@@AB@@@@E@A@@@A@@CAAAC@CAACC@IA@@@@@DC@AAA@E@ABEDIB@@@@IDG@EBGCBEBABBDAD@BA@C@CBCF@JE@@DEBC@A@EAAA@BIH@DBBA@AF@@@@@@EC@AICA@@C@@@AAC@@@G@BC@@@E@@@ED@@AF@BAAEGEBCC@GCA@A@A@E@@@@@IBAACGAEDAFG@E@EBA@CD@@@@AB@BA@AC@@A@@DI@A@@FA@@MIC@EE@@CBA@CBCABACCGB@CBAI@ABB@@BM@A@F@GCAIGABA@A@@C@@AIBEDAF@@CBOHCCE@GDADAAAE@AG@A@MGGEAA@@G@A@@AGJABCBE@CAAAGB@BBHA@A@BF@BKAABA@@@@@@BA@A@A@ACA@@BCBCBEB"],encodeOffsets:[[120864,37610]]}},{type:"Feature",id:"370304",properties:{name:"博山区",cp:[117.861851,36.494701],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAAE@AEGAA@CACIBMDI@C@EDGBG@CACCI@CBGDABAFBD@B@@FFAPA@CBA@CFCJ@BBDADBBADD@@@BJGHEB@FBHFFF@DBBB@H@BBBBB@@@A@A@A@@BBB@B@@@@@BD@BCBABAB@@HBABABE@AAABBBFA@BGHA@A@CDF@BBB@BAACDCDAD@@DE@@JEFGB@A@A@@@A@@@@B@@A@AA@G@IDACG@EBCDC@@AAA@CBEIAAAAAGI@ABA@@@A@@A@A@ABAB@H@@DF@B@@DDABADDDBLCN@@A@C@@@CECKAAC@AB@FADBD@B@LE@CHABAD@DABC@ILGDIBEHADBBDD@D@BAFBD@B@FCFA@AB_FA@EDCBCC@@A@AF@HA@@B@BA@@BBB@B@@@@@BB@@B@@@BB@BBAB@@@B@BHDBD@BADGBA@KA@DCDCACA@ADAB@AACK@@BAE@GDOGA@CAA@@JABCDIBA@A@EI@@@CB@B@F@EIED@FCBGLG@A@ED@BI@AACAIAGHDFFDFFE@AFCHEBA@FFB@D@DAFBDFAHGA@@A@AHAD@@@@A@@@A@@@A@@@@AA@BDA@CBA@BB@@A@A@A@@@@@@@AB@@FBLJBH@DADCHAAC@A@AB@B@B@@O@ILCBLDF@DBJDB@J@F@CCE@A@DAB@B@NDBF@BABGFABAAEDIJABK@CB@DER@B@DCBCBCBABCDIBA@ABAFBHABABFBBBBB@DDFFD@@FDB@HADCDABBBDB@D@BAF@AHDNHB@@HDB@FCBABEA@BADAH@D@@B@@BC@@DBB@LMXBFBHDD@HCF@D@BDDFJBFHBLBJ@BDJFDD@@@BB@AB@@@B@@AF@B@@@BBDBD@@@D@D@HA@GDA@@@@@@BA@AB@@@DA@@F@@DD@@B@@D@@@@CD@HEFABC@@BADCB@B@D@B@FBFBHBBAJGCG@@@@DABA@@F@@ACA@AB@HACEB@NEJE@AACE@AA@A@ABADAJEB@HAFBHALG@@@CAAHEBCAAFEF@BAAMGGE@GAKIKACAC@EAA@ECCAKE@AJEDAL@HGBCBD@@D@BAAAAA@@AABA@C@EB@RQJAHABBBBDBDADADAF@@@@BFBBA@C@AECBA@AHBHBL@@ABCH@NMDE@E@E@ANAHAB@BCHCJ@HADC@@DAHBF@DAD@B@BDBBH@DDDBFHHBB@PE@@BAHBFAB@B@B@DAF@FB@@BDNCB@DCF@FBFAJGJABC@A@GBEACC@ACACA@A@AA@EDEBCBCDADCBA@@@A@@AA@@B@@ABA@@BA@A@@@ACC@@BC@CBA@A@A@@@AB@BA@@BCBABA@@BA@@D@FBB@DB@@AB@BFFF@FBLFD@DBBD@@FFBBAH@@BFHBF@HFHBDCBE@ACCFEDA@AAM@ABIBCBCB@H@DB@@HDNDL@FDB@D@FADADABCCCGOBGDCDAB@B@@B@@B@@@HAF@@AAEDABBBAAE@CFCDA@@B@@C@EDAF@DADGCACBIBI@OCCEBCE@II@ADADABCC@GBEAACCAA@ABG@AACMBC@CDGBCAA@A@KBADA@ABA@CAEECCBCACAG@ABEDCAEAA@AASFA@AAAAAABCAAEGIBSCCA@ACAI@EC@AA@GD@DG@ED@B@@G@A@AB@DC@ACI@C@EBA@CBEFO@E@AB@@@@@@@@A@@@@@OAUAIFEBCBGDA@@AC@@@A@@@@@@@AC@@@A@@GAE@K@EAAB@@A@@A@@@@GAAGC@GA@ABCCAIEEG@C@AEAABABBBDB@@EDCACAABGDAA"],encodeOffsets:[[120813,37153]]}},{type:"Feature",id:"370305",properties:{name:"临淄区",cp:[118.309118,36.826981],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@AAAAA@@BBPG@@HABC@@AA@BGE@@IA@AE@GBCAEI@I@GBA@@CHC@KD@@CAA@EAA@@@@AC@GD@AI@@@@@@@AIGEAA@C@ECAA@CGGA@GAE@I@CCGAC@ABE@@EOBACEEAAIAEACAAACAA@CCEAAA@@AAACCCEAC@@DEAA@BCCCG@ABIBEAI@UD@@ADED@JC@A@CAKESD@CF@AE@@BCAAAAAA@@@@L@GC@C@KAGACCAGB@DC@@AA@@@A@@@@BA@@@@@AA@@A@A@@@A@@@A@@@@@A@@@@@@@A@@@@@@A@@@@A@@@@AA@@A@@A@@@@AA@@@AA@@@AA@@@@A@@@@@@@A@@@@AAA@@ABGBEDE@CNA@IDEAACC@@I@GDQDA@@DCBA@ABAB@B@@GDAA@@A@@@A@@@A@AAAAG@CAEEGBC@@@@AACCA@@@@CCACDAD@AENC@EACCCCCECAEAAT@JEDGCAIDMDC@A@CEEGAAAA@@A@@A@A@@@@@@@@@@A@@@AA@@A@@AA@@@@@@A@@@A@@AA@A@@@@BA@@@@@A@@@C@A@A@@AA@ABAA@B@HABDBB@@BCDBDA@CHABCACAAEAEB@B@BADA@A@AO@@E@@@@A@C@AA@ACBCA@ACIAA@E@@EGAE@C@OMAA@CAACBABCDBDFADDD@BBBBA@@D@DBFELCD@BA@C@CHCBADC@I@MEM@A@@EEBABAJ@@AB@@ADABEDC@EB@DABADA@AHIFED@@MNEFCH@DCJ@HBDBB@BD@@@@BABHBBJHDE@B@N@@AA@BAJ@AB@DHADDABDBDAB@DA@@FFD@NJ@@EB@@@BCJ@@@BD@@BABA@@B@@C@@DAB@F@FEHCBBFDHBBJA@@@@F@B@B@B@HDD@ADHFBFABE@@BC@@F@@@F@@ADH@@@D@BB@@D@@@DBBJD@@F@@@@E@@BABCAG@AJB@BB@F@BADCF@@IFE@AD@D@DABC@CBAAABAFHDFAH@JC@@@@JAFCBAF@B@BBD@@C@@@@JBD@BDDBD@BBDB@@@B@@@B@F@@A@@BF@@HBHD@FHAFB@B@D@AP@@@D@B@B@J@D@BA@C@I@@DI@GDCHA@C@GAED@BCDA@@LAB@@CHADC@ADDD@D@B@DB@D@BH@B@@CDA@C@A@ACI@ABA@CC@@@@@@AEEB@FCR@DBBJ@J@BB@D@DB@BHD@@BBD@D@D@BB@J@HD@@AJ@@AB@BCDABBFFBB@@BBBCB@FDPFB@BBBBHD@BFCDGBBJ@@@@@FB@R@@@BC@@@C@AA@@@@@@@ACHA@AACD@B@LC@CDA@@B@@@FBDFDDFBD@@CD@D@BDBFHDD@BB@@@J@DF@BB@FRA@BH@@JADBLF@@D@@@@@@@D@BHAF@B@H@@@BBBDH@@B@@F@@AHANCBB@DHCDE@ABC@@BABABO@A@C@E@@BG@@@@@E@@@@BKVBH@FBBBB@B@B@DB@BF@@@D@@MD@@EB@@@DAF@HA@@NAFABFLH@JB@@B@BRBB@F@LABBH@@@L@@@FA@ED@B@@GBAAA@@@CD@CO@@BABACC@@AAHEB@D@DBD@@@BB@@@@BAFB@C@CBEHAH@R@B@B@B@BFV@JEjHHBAA@E@CF@B@@B@@@@@@BH@@BAP@NAFAD@D@@BB@D@DCAEAEB@@@F@B@@AA@CACA@@AABAFB@A@CB@@@@A@GAAAA@@@AAA@@ACAAAAACI@E@AA@EE@EE@CDADA@CBAF@@C@A@A@A@A@A@A@@@C@@@A@C@A@A@A@A@A@C@CC@ACEAEKAAEBCE@EA@@@AAA@@CBA\\A@IBA@A@@AAE@G@@E@@GAGA"],encodeOffsets:[[121309,37732]]}},{type:"Feature",id:"370306",properties:{name:"周村区",cp:[117.869886,36.803072],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CBA@CCA@ABC@MAG@CB@@ABCBCBEAMGIF@@@@A@GDGBA@G@IBA@K@GECAC@IFMHCAIHID@@EC@@A@A@AEBCDAC@CB@BB@ABA@GB@A@@DAAECA@@A@OBA@QACD@@E@EAEAI@A@CAKBG@A@@BCHAN@B@BBBBBBB@@@@@@@DBBADAB@FD@BF@@@@@@@@DHCB@BHAF@@HLAFDDJBDBCF@B@FAAG@AB@JBDB@BAH@@DBD@@@F@HELB@BBHBB@@B@B@@CDENA@@@@F@B@DB@@@CB@B@B@@@D@BBBBBABAD@DDBB@B@B@B@BBBE@@BFD@@F@@@@@@@B@@@B@D@@D@@J@DDBF@@@H@@@@@BAFDF@@@@@@@@@BF@@@@B@@B@@@DD@@@FBB@DB@@B@@@@@@@BF@DA@B@BDD@BA@@BBB@ABADFDBBCB@@D@DCFA@A@@@DDBD@B@BDBB@@DABABC@@AA@@BBBHB@DDBH@@@@A@@BA@AAA@@BAD@BA@CHGAACCCCFABAFBFBF@B@D@BD@@H@@DD@@@@@BF@BEBCD@D@BBBBDBDA@CDABAB@@@F@@ADA@ABEFGBA@CB@C@A@@@@A@@@A@A@B@@A@@@AB@@A@@@AA@@BABCFCCA@@@@B@JAL@@QB@B@@B@@FC@@B@HB@B@B@@HD@B@@F@B@@@@@@C@@FCB@D@@FBBJ@@@H@BBF@@B@@@B@D@BD@BBFDDB@@F@@B@@BBD@J@BGFAB@@@J@FBDDB@@D@@BZ@H@H@@@H@@DFABB@@BBHA@B@F@B@@D@B@BAF@FA@GP@@B@DH@@@HB@@@@EBABB@@@BBFB@B@D@@B@F@D@JCB@F@HA@@DBB@B@D@B@@@BR@@@B@BB@@@@@@@BD@B@@FFF@FAD@@BAH@@HDD@D@F@@AHM@ADCAA@C@@ACAA@@AM@@F@BAAMDCB@B@AA@G@CBA@GAAC@C@CA@C@AAAA@E@AACI@C@EJIDAHCH@@A@@@GACGC@AC@EC@CBEB@BABAHAB@BAB@@@@C@K@CF@BH@@F@@G@AB@L@B@DDFBADDB@BB@BBDB@@B@@@BABC@ABADA@GACCCEA@@@AAC@K@A@AAACC@ADC@ACCA@BC@C@@CCA@@CB@BADC@@@@@@@ACAABE@CB@@C@A@AACA@C@@GA@@A@@@@@C@A@AB@@AB@@AA@A@AA@@@@AA@@AAAC@AAAA@A@@A@AA@AHQBEBAGE@AAA@A@EDAFA@@BDD@DG@@@A@AFA@@BCBE@CG@@@DI@AIEG@C@A@ABEB@E@CA@A@KAIFAA@@@AA@@AAAI@A@@@@AA@C@AA@@GCABI@AACAGAA@ABAB@@A@@@E@A@@@CAA@EBDCECFGCAA@LGIIM@A@JG@I@@D@AE@ACKCADCHADC@EG@CEE@@@CBA@CB[N"],encodeOffsets:[[120685,37530]]}},{type:"Feature",id:"370322",properties:{name:"高青县",cp:[117.826924,37.170979],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@C@CBCBAB@@A@CBA@A@A@CBE@C@A@A@AAC@O@C@A@A@A@@@C@CBG@GBIBA@KBC@@@ABIBABA@EBCBEB@@CBGBID@@ABA@A@CBA@C@A@GBA@EBC@ABA@@@A@IDCBA@E@C@A@C@A@A@C@A@@AEAA@AAGAA@EAC@ABA@A@@DA@GA@CGDABABE@AB@F@LADC@@FC@A@@F@FLB@BB@BBBD@DABC@BFABC@GDEDCAK@@BDBC@A@@B@FCAADAJ@@BB@DEA@B@DDBAH@BF@NDB@HBB@D@LDFBDDNLB@DF@BFJ@@@@@JDN@HBHBF@B@D@BBB@DBF@BDDDDRF@BJDBBBDBB@@@@JJBB@@DHBD@B@@BH@F@DBL@BBFFH@BFHHHBDFHHDBBFBPHDBB@@@DAD@JAFCB@BALGDCFC@@DCDADABAFALABAB@B@FAB@bGH@DAF@XAFAB@D@JAFAH@F@@@H@D@B@DBB@BBBBFBBBDBLH@@TPNJBBLH@BFDDBLF@@FBJDB@D@FB@@F@F@N@HAHCD@DADANCDAFABA@@BAFEB@DEBCFCD@D@F@JBD@BBD@B@FBB@B@DBDBDB@@DBBBFB@@NFLFLHDAB@DK@AB@B@@C@@@AA@B@@@@A@@BA@@@AAA@@@C@A@@@A@@B@@@@@FBDDBB@BHBF@@@@DB@@A@BDD@@F@BAB@HC@@BA@G@@B@DB@DBA@@@@B@FABBB@BBDEBCB@D@BO@@@AC@BE@A@ABBBBB@BADEEGAACAA@CBIEC@@A@@F@AG@AABA@@@A@A@AC@KA@AGC@@@@@AA@@@@@G@AB@DAD@@FB@D@BABGA@@@ACBA@@D@B@FDDBF@DA@ED@B@DBH@BAB@B@F@DCRF@H@@D@ANBBL@JAH@HG@@FBFC@G@@@ABIBAB@B@BBP@@@@B@BBBHB@A@CJ@@EBAHBBGC@BE@A@AB@BBDOB@BBFE@A@AA@@@AAA@AAA@@AA@@AA@@@AAAAA@@AA@@@@A@EFGA@@AKGEC@@EA@ECCA@B@@A@EF@@@@CAAC@@@@EFDD@@A@AA@@@AABKBCHC@CDEDEHIDC@@AADAIA@@BAAAGACA@@@CACCAGAIGCJGCC@ICBA@GC@@FA@GCAA@CC@A@KACAA@GAAA@@CA@A@@EACAEAA@K@E@A@AD@@GA@AAAA@CECAA@KCIB@C@@EAE@E@KAAAA@OCKAG@GAEACAE@AAC@AAC@EAC@EAEAIAIAGAGAAA@@A@G@G@AAE@C@E@A@A@EAK@K@EAABC@IDA@CBC@CBE@A@ABA@G@E@E@C@C@C@C@C@GBC@CBA@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@A@@@C@ABC@A@A@A@C@E@A@A@A@A@@@A@A@@@CAE@A@C@E@EAA@A@@@@@A@A@A@MEA@A@G@C@@@E@@@@@A@AAE@@@C@G@E@@@C@@@"],encodeOffsets:[[120564,37955]]}},{type:"Feature",id:"370321",properties:{name:"桓台县",cp:[118.097922,36.959804],childNum:1},geometry:{type:"Polygon",coordinates:["@@F@@G@GECCA@A@@AA@E@AA@EBA@@FABE@@DE@@HA@A@CBK@@D@J@@@@@DG@@AKB@@CD@BGN@B@F@H@@AB@BD@H@H@BB@FHDD@BB@FB@D@BX@@EBC@@AE@@@AAA@AA@CC@@HABA@@GAAAAAB@@@FDDB@@D@@@@C@@B@FABECCE@A@@@A@@@CCE@AACA@@@@FBHBD@@@B@@AD@PAHABCDGB@@A@ADEB@@@@BDEB@@@@@DE@EDCB@J@B@FE@@F@@BTE@@H@DCBE@A@KBK@E@CDC@A@YB@DC@A@BJCB@BBFAD@B@L@BDBD@@BA@@BA@AA@@@@@FDBB@@F@BCDEB@HM@@CC@@AAB@FG@@AC@A@BBCB@AAA@BABGBAD@FG@BDDDDHBD@HBB@FAB@DBD@@@B@B@@@D@@@BB@@BB@A@BB@BBF@@@BD@DAB@JCD@BAFBL@L@FBB@B@F@D@F@BBH@H@B@@@BBHBHBJBJBFBFBD@FBD@BBD@BBF@DBFBHBH@LBPDB@BBLBF@F@FB@@@DJALDB@DBDFB@BB@BHB@@BCB@F@L@B@FBDBFB@@@BDB@@BBHBB@DBLBB@D@@DBBHDB@@ED@@GDBBA@@FB@AA@DCDEB@D@@@DCCA@ABCBA@@BCFB@EDBFBDBD@@F@BF@@EFBDBB@BBDB@@FDDAF@B@D@@@HBD@ND@@H@BJ@@@BBBD@B@@@AGH@B@BABADCDAHAB@@I@@BC@@DEBCBABACCAC@C@@@A@CFA@EJAD@ABB@JADBD@@BB@HADA@CAGAAC@@DEC@CA@@E@AB@@GAAAAI@A@CAC@A@@A@M@G@@C@@@EM@CDB@ABOBCBB@A@A@AAA@@BIFAF@AAACF@@C@@N@@ABAAE@ACABG@AHAHBD@@EAC@CF@@@B@J@DAD@FBHA@@B@@CDA@E@@@@AIHADCAEC@AGAA@AEACO@EDAAA@AA@EAAEBADC@ABA@@BI@@GCI@A@@A@C@CAC@AC@AGA@@C@CAAI@I@AA@CDQ@EFABF@@@@@@DDB@BAJ@BDB@D@B@DC@@@AAGC@A@@C@A@CCCC@CBE@C@AAI@@BABBDA@A@A@@CEBIBIB@BC@A@C@CA@E@@EAIEC@M@@CG@E@BFBDC@C@@ECAA@C@@@CC@@@EE@AAECGBKBBD@@@@GB@@@@ACA@@DD@@BEDC@BDGBMBA@BLC@AB@FBD@B@J@@C@@@@@@@@@MBBDEACBAB@DC@@@C@@@ACMBG@BFABA@E@@B@BC@C@CAAC@I@EE@@@E@@BOBA@ABA@AMG@AEA@A@@AE@BF@@@@ED@GE@@O@@@@F@@OAAACE@E@@@@EC@E@@A"],encodeOffsets:[[120813,37763]]}},{type:"Feature",id:"370323",properties:{name:"沂源县",cp:[118.170855,36.185038],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAC@CACAAAAA@AA@@AA@AAA@CAA@EAA@A@@@@@@A@@@A@@@@A@@@@@@@A@@BA@@@@@@D@D@B@@@@@B@@A@A@C@EBC@A@C@@@CBCBABABCB@BABBD@B@D@B@DABE@A@C@C@CBCBC@ABABCBABC@ABCBC@A@A@AB@DAB@B@D@B@BCBC@CBC@ABCBADABAB@D@@@BB@BBBBBBD@F@D@DB@B@@@DAB@BCDABAD@D@DBDABADABAD@D@@AFECCB@HCDE@GHA@A@@B@@A@CDA@K@C@AB@BDFGDC@@ADADACAEBC@EAC@OBE@KBA@IDCJADG@AAC@C@CDEB@AAAC@MA@@CCGCAA@@EAABCABE@AA@A@@@@A@C@@CE@B@@A@ABAB@@@@@A@@@A@A@@@AA@GAA@KLA@AACC@EHBCGA@MACBEGCGCA@C@G@AA@@AC@CAOCA@AAAACAOQ@AAAAA@A@E@K@A@AA@A@CAAAC@AAAAAAAA@A@A@A@A@A@CACCAA@C@A@CBA@A@E@C@@@C@C@C@C@CAC@C@C@C@ABCB@DAB@D@D@B@BBDBDBBBDBB@BBDBBBB@@@@@@@B@@A@@@A@@@@@A@@@CAAAC@@AA@@@A@C@@@A@C@C@A@@@A@CB@@@@A@@AA@CAAAAACAAACCAAAAAAC@AACAABC@CBCB@DAB@B@B@B@B@B@D@BC@CBADADADADABBD@DBDBD@BAD@BAD@D@@B@B@@@B@B@B@@@@AB@B@@@B@B@@@B@@@B@@BB@@@B@BD@DABABGRAFA@AFB@FBF@BF@@CFCNIAA@ADDBDFD@B@@@@NC@GBGFADEHADBDFJPBJBCH@BCBEFDDBFBJH@B@B@D@@H@B@B@B@DABKF@BDD@BCDA@ACAAAAECM@A@A@CFCDC@@DAB@F@DABCD@BB@@@@@@BBJBBABCDGD@D@HA@C@EHBF@JDBAB@BA@@@A@@FADC@ABAHDFB@@@BBD@BABBBFAB@@A@BBB@@@D@@DIPCBE@E@C@@BBDB@BBDLHHHNAB@F@HHFF@LGBEF@D@@H@@BDFDNFBB@@B@D@H@HAFE@@B@DBDLFBBBBB@@EFCP@BB@BD@FAF@BBJ@BDP@BDBDDDPDDCH@DBD@DBBFH@BBFDBBBHCBADBDBFC@@CAAABABAFB@B@DFHJFDBAD@BHBD@BHHB@@@@@BB@@@BAFBL@F@HB@@@B@@BD@@@@@@B@@@D@@BB@HCDAFAJEVBPB@@@@B@@@@@@@@@BAF@P@FEDAB@FAD@J@BDD@@CBAB@H@@@@AFCH@@CHCB@@BFDJ@DB@BDBTDJAFHBBADBBBBBBB@TEBBB@FBDBFCBAH@DBDBDAFDBF@DAB@BCBAB@L@BBBADCH@DADDNBBH@BAB@DBBDFBHAD@ADCBCB@BJJF@ADDFPDJ@JADADBCHCBE@CB@F@DA@@@CBED@DBFABAACBBF@BB@@BABEDDDFDD@B@BCD@DBBB@BA@@@@B@@@@@B@@B@@@@@@@@@AB@@@@@@@@B@@@@@@@@@DAF@BFNDHA@ABIHCBECEHAFCBABGBCFC@@D@DCACBIFADADCAK@ADEHAACFBBFBBB@BCBAACDDB@JAA@CABAX@F@DBHADEIGIGAC@@@A@@B@D@F@HCFABC@EBCDC@EBENECEAEEC@ADCLIBKACGEBABAAEBIACMM@@AE@G@@@@FCJA@@B@D@HDLHRDNJPBDARKBA@@@CDEBAFALAJC@@BA@@BABAB@BA@@BAB@@A@A@@@@@@@AA@@A@@AAACAA@@@CB@@@BA@@BA@@AA@@@@@A@@@A@@AE@A@ABEEGBMAAMGA@AEB@FA@@BCBAACHKDG@@BIBADGL@B@BCAIJEFA@ADO@K@CD@@E@A@A@@GCA@@@ACDE@GDBF@FEFCF@B@DBHA@AAADAD@@BBB@BBDDFBAFBJ@F@BBCD@DNHD@D@BAFCBAAEDCB@BB@DB@FJHADA@@@AHA@AB@F@BCACD@DBF@B@D@DAACDBB@DABCIGEAIGAADE@CACDADCBGACIA@EDEBE@E@I@CAC@G@CAADADAJGB@HBDAB@HDDABAF@DACDC@ADDBBBDDH@BEACHA@ECAAADCBAB@DABCDIEEAEDA@@A@DCDA@AAAA@AA@GCQACEBEDGBAA@CEEGDI@E@E@GDCFMBEGBC@@CCCA@@BCJGB@BEA@@ABCBAD@LI@A@GAA@@@@@A@@B@@@B@DAD@@AB@@@@@@@@@@A@@@@@@@@AACAAAAAA@@A@@@@@A@@@A@@@@@A@ABA@ABA@@B@@A@@DCDAFABABA@@BA@@@@BC@ABA@A@@@@BADA@@@@@@@@@@@C@@@@@@BA@@@@@A@@AAAAAA@@A@@@@@C@A@C@C@C@C@C@C@C@C@C@A@C@AAC@C@CACA@AACAAA@AACAA@CAA@@@@@A@@@@@ABEDCDED@BA@ABCBC@A@A@C@CBED@BCBCDA@AD@BAD@B@@ABCDCDABEF@BABCF@@AD@@@@A@@@@@@@A@C@CAC@E@@@@@CAE@C@C@A@@@A@A@A@@@@@A@@@A@A@@@AAA@@@@@AA@@@CA@@AAC@AAA@A@@@@@@@@@@@A@@@@@@B@B@@@@A@@@@@@@@EC@@A@@@@@A@@@A@A@A@@@@@A@@@A@A@@A@@A@CAA@@A@@@@A@@@A@@@A@@@A@@@AB@@@@@@A@@AA@EA@@@AA@AC@A@AAC@AAA@CAAACA@AAE@C@CAA@C@CAC@CAAAC@A@CAA@AAC@AAA@A@AAA@@@@@A@@A@@A@AA@@@@@@A@@@@@@@AB@@A@@B@@C@@@@@@@@@@@@@@B@@@@@B@@@@@@@@A@@@@@@@C@C@A@CAA@AAC@AAAACCAA@AAACCAAAAAAAA@@A@A@A@A@C@C@A@A@@@"],encodeOffsets:[[121121,36799]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
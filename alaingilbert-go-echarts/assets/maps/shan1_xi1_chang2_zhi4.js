@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("长治",{type:"FeatureCollection",features:[{type:"Feature",id:"140402",properties:{name:"城区",cp:[113.123088,36.20353],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@FA@@@@@@B@@@B@B@@ABC@@@A@@@@@@DK@A@@@@@@BC@@@@@@HA@@D@BDBA@ALAAGACFAACAAEBDFGBBHC@@AA@BBEB@AAA@@AIFAAC@@@@@AA@A@A@@@AC@A@@@AAGB@@@A@AG@@@@@@AC@@@@@@@@LGNIFE@E@C@AAA@@AG@ACG@@@@CECEAAAC@@CE@@@@CG@@@@B@@ABCBCEAEAK@MFIBCBCBCBAB@@@@@@A@@BA@CBA@@BA@C@A@A@ABEACBE@A@@D@@BB@B@@JA@DIABB@@@B@@@@@@@@@@@B@@@@@B@@BBA@BD@B@@@@@BBD@B@B@@@B@D@B@B@@C@A@@F@@ABCB@@@@AB@@C@EDDDBDBLB@BB@BOBC@AB@AAA@CA@MFA@@@CIIDAAA@ACGDBBFBJFB@DBB@DB@@BBDBB@@B@B@@@@A@@@@@@@A@@@@@@BB@@@KF@@A@BBA@@BE@@GC@@JD@FABBD@CELEB@@@@B@@@@@@ADCJA@BB@@@@DH@@BBF@@@@@BBA@ABABE@GBBF@@@J@@@@A@BFBDB@D@BAD@B@JC@@@@@DB@@BBB@B@@BDBDBB@BDJNA@@BJD@@HBDB@PCJACI@@CGE@@@ACF@@A@AACAA@@@@@@B@@@@@@@BAB@B@HA@@@BD@@ADA@BF@@@@@F@B@AC@@DAB@FA"],encodeOffsets:[[115850,37083]]}},{type:"Feature",id:"140421",properties:{name:"长治县",cp:[113.051407,36.052858],childNum:1},geometry:{type:"Polygon",coordinates:["@@EFEFC@ABKBEBMBQ@A@E@M@M@M@C@E@IDC@IHCBIRABEFCFCB@@ODGDIDG@C@GACBA@C@GBABEBIBMBCBQAKBGFABCFAHBHHL@@JNRRDF@BB@DH@@@R@F@J@F@H@BAH@D@HEBCBEF@FAH@BEBMFALAJDDFJHFHHDLBFRBJBFJKFEPAHCJ@@DLABADAFAFABAD@@BBDD@BBB@DBH@BBB@BD@B@BAB@BA@AB@@ABADA@AB@BABAB@BABAB@D@B@@@DBBBAD@BABAF@BAD@@@D@B@B@B@BBD@B@B@@BBDDBB@B@B@@@BA@EBCBA@CBAB@BBBD@NDB@BBCBC@G@E@@@@DCDADABEDIHADB@@B@HFHBBPBF@B@BBDBB@@BB@DBF@BBB@F@D@D@HAJ@B@H@BBD@L@FAF@FAB@B@BAB@@@@@DAB@FCHCFA@@FBB@FDJF@@@@@@LDACCCFCD@@@BA@@@@DABA@@@EB@D@@@@A@A@C@A@@@A@AAC@A@@@@@AACB@AA@@@A@@@@@A@@@@@@@@@@@A@@AAJB@CIB@@@AAA@@@CB@F@DAFBBAB@B@D@B@@AB@DAB@@AB@@@@@@@BADADADAJANEL@FBFBPHDDHJFHBB@BDDBBDDDBBB@@DBB@B@D@LBFBB@B@DDBBAE@CEE@@AA@@GEEEFIBC@ACIE@@K@K@E@G@E@EAK@GAEFBDA@@BE@EACKBEGACEGCAMCAECIBG@G@@@A@AAKCGCCEEECECAAA@GEEGACEMDIDGBABCDCJKHAFAF@BEHCNGDAJEJK@UCBCAAA@@@A@C@ABGDCB@BCBCDEBC@A@@DEACAEFBFDB@H@JC@EBEBG@C@EC@G@AEAC@@AA@@@AN@BBB@@@@A@@AA@A@@@E@EDEDG@IA@AAAA@@AAGCA@GCC@EAGAEACAECEAAAEAGAECGKAEACGAEBC@EDEDCDIHED"],encodeOffsets:[[115844,36743]]}},{type:"Feature",id:"140423",properties:{name:"襄垣县",cp:[113.051491,36.535817],childNum:1},geometry:{type:"Polygon",coordinates:["@@KCA@UCG@E@C@E@UBA@MD@@IHABCDIFCF@@CDEHCFEFKPADCFABCJKHCBQJI@MBC@QBKBA@UNWJODI@C@E@GEAFEDEDC@E@EAGECAECG@MCGNEHCBIHODGBGBM@GDMHQLGTIHAHCLBLFJRJPH@@DBJFDDLNDDDFJJHLB@DJB@BHDJBFDNBJCN@NBL@@FDFFDFHJNJJDLDDJDJCDGH@HAFFLLHPFLD^BFDFBJJFHBDDDFJL@JAD@RERAHABBB@FBDHBDDHDPBPDJBFBNDNHJD@D@F@D@LBJ@NAFED@@BHCDAFCHCNIRAP@LDB@NAPGZOFEBGJBHJDNDFHLLDFBH@P@F@H@D@J@B@D@BBB@HBHBJ@BB@BDDDBD@FAD@HICCCCNMDACAAABA@ADBJAB@NAB@@BAB@BBDHBB@D@FAB@F@JBHAJADAFCLEFINS@@@ARGNIPODCFEHMFEB@DCBAPANGNCJDJ@AEGMAEBIBCBK@MICSEOGKGIIKKCGIMEKCOAOHQFO@ADGFODSDEC@BAD@HMJOBA@@NIXOHEPILGJEJEDGCAACKMICA@E@E@A@E@A@E@AAC@A@@@MGAAKAAC@EC@GCC@EAKGA@CAMECC@@AAECIBAH@HGFAH@FEBCAAB@BBBDBE@CJCDADADIFA@GB@@ABA@A@A@@AEBIAC@C@cGGAGAEAAAA@E@QCOC@@GASC@E@AAAE@C@C@E@C@CBCAKAAAMAA@GAECA@ECKEEAACA@CEAAHACCGAEAAE@EJCJBBALAFA@@B@HAAK@@@@AC@CAG@G@E@EI@CAG@@@C@G@E@GAOAKA"],encodeOffsets:[[115692,37271]]}},{type:"Feature",id:"140424",properties:{name:"屯留县",cp:[112.891998,36.315663],childNum:1},geometry:{type:"Polygon",coordinates:["@@MEEEQCMCCAGASBEBIDCBKT@B@@@BEJ@TGNMD@@K@E@MCA@IGA@E@EAA@CGMEM@C@CFGLANAB@D@HAFABEDEBI@ACAEG@AHGAE@SAEEEECCCIACCAGCGDA@CDMFG@@BCAMAEAC@A@K@EA@@Q@A@IAEAA@A@GACACAA@EGCCIIEBECECKEICSEQGKGIAKCQAKCOKGCIEC@SCQDWJUBUBOBOCIAOIECQG@JANCPCL@LALBJBPLNDNDLBD@FBB@LAF@DA@C@@@EAC@CCECCCEEEEEEGCGAC@EBE@M@EBE@EBI@CBI@A@@@@@CFC@ODODGBKBIFBB@BCLAFBD@HFJFDBB@B@BC@ECGEA@A@ABABCDCFIHCD@B@BBBB@D@BBBBBD@B@BA@C@CBCBCD@HBNCNCHJJJJHHDDHHFHLLJNBDJLALCL@NLNHJDDDJ@L@J@RN@VDTFVHJHPHRJBBHDJBHCDCDMBMFOHCDAPIF@PAXAPCLBL@LDF@TFRHB@NFDDDDFHBF@@FLBJ@HAFAFBD@FBJBNBLDJJHJH@BHAHAFAJGHSRKNGHCN@HAHAPCJGDAFGHMNDH@FDDBHFFBF@D@FCFCBEHFF@D@J@PCXIVMB@LARAD@NAJ@RIDALGDIBADEBCLOFEDEFGDC@@DEJEDCBAJG@@NCB@VAF@D@F@H@VDB@LDLBPBHBF@H@D@@@H@DBJ@@ABE@@@@BGBARGLI@IAMAAEK@AFOFCJGDCBEBA@A@MAG@ADG@AB@@ADK@A@AAA@@A@@@A@AAA@@@B@DCB@@A@A@@B@@@AA@A@A@@AA@@A@@A@@@@A@@AA@@@@A@@@@@@@A@A@@@A@@@A@AA@AC@@@@AA@@CA@@@@A@@A@@@@B@@@@@@@B@@AA@@@@A@@@@BA@E@AAAAA@AAA@@@AA@@AAA@@@@@B@@@@A@@A@@@@@@A@@AB@@@AA@@@@AAAB@@@AA@@@@@A@@@A@@@@CAY@QAG@C@O@I@CEIAEAAECCACAA@@@@@KEGC"],encodeOffsets:[[115716,37087]]}},{type:"Feature",id:"140411",properties:{name:"郊区",cp:[113.101211,36.218388],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAAACE@ACA@AC@@@ABEBCBABA@@BAD@F@DBB@D@@AHAHADAZAD@F@TBHFBBLJBBBBFB@I@@D@DBB@DC@CAC@ABC@C@C@C@A@A@C@ME@BI@AE@@OF@B@AEH@D@AEA@@CA@E@@A@C@A@AAA@ABAC@@KAO@C@CJBH@NBAC@EE@@CE@@AQC@ED@@A@AG@BE@A@EAA@@@EAG@I@@AEC@EG@GEB@EL@AGNDBDBDB@@CB@B@@@ACLCDARDD@NBD@NBD@BHHALAFDBBPA@FH@AI@C@GAK@A@EAA@EBABADAJCFAFA@AB@@A@A@@@AAAAAAAAACE@A@ABA@ABG@@BCAC@@@A@CAC@@CCAAC@@AEAA@A@ICE@AAA@GCEGAC@EDIFEJCJ@BG@C@K@OAACE@AA@AAAACCA@A@EAKAC@A@A@CA@@AACACCAACC@AAAEGGICCOGADAD@BA@@@@@DH@@@@DF@@BDBBDFDF@@@@DH@BBH@@BB@B@D@FEFMJKH@@@@@@@@BD@@@@@@BHB@@@A@BH@B@@@BBD@@B@B@B@@B@@@@BDEBBJ@@BB@BFAAAB@@BD@AGHACEFABBBDEBBDBHKB@BABACC@@@GB@@@@@@AD@@@@@@@BCL@@@@@@@B@@AD@BA@A@@@A@@@@@@@EB@@EBA@CB@@BDA@E@@@@@E@@ACB@BC@@A@@GBA@A@AB@@@@@@A@@@@@@@BBBD@B@BE@BD@@F@DH@@DJIBODA@AC@GC@AI@@MBCI@AAAACAC@@@AAA@AA@@C@@@@IDA@C@ABC@A@ACAEB@@@@@@I@@AEHAF@BABAB@AA@@@@E@AA@@CG@@@@AAB@DIBC@@@@@@@A@@A@KFDFC@AAEBC@@ID@@HF@@AB@AAB@@@LE@@A@@A@@@@B@@@@@@@B@@@@@@A@AA@CAAA@@CAA@CAA@IEEAAAHCBDB@BBJCDJ@@B@NEB@@DBB@BBAD@PA@AAAA@AKKC@@@@@@IEECA@EA@@EBGDEDA@CB@@@@A@ABA@A@EBE@EBK@C@AAG@A@I@GBC@C@E@A@AAE@CAA@@AA@CAAAA@E@OABDFH@BBH@J@FDRBLADQZFFNFHDLF@@@@B@DBDBFDBBBFFJ@D@J@P@DBH@RBZ@D@@B@@@B@@@@@B@@B@@BABB@@@@BB@@A@@BB@@@@@@@@BB@@@@@@A@@@@BB@BB@@B@@BB@BBBBB@B@FAB@@@@@B@@B@@BA@@@@@@@A@@@@@@BB@@@@@DB@@BB@@@@BDB@@B@B@@@B@@@B@B@@@@@@@B@@B@@BB@@@@@@BB@@@BB@@@B@BBB@@A@@@@B@BA@CDA@@@B@BBB@@@B@@@BB@B@BCL@BA@@BCH@BBH@N@BABAFCDIHEDEP@BFLBBBN@JKJQHABAH@@@@AF@B@F@F@HBH@DBD@@@@BLLBB@@@Z@JEHEF@@DBLBF@DBF@BHDJ@L@B@FDBBBABA@CDCBA@@@AAAB@DEAEAG@A@ABA@CHCLETK@@@AEAQC@A@CFA@CG@ADC@E@@AAACECACACC"],encodeOffsets:[[115786,37264]]}},{type:"Feature",id:"140425",properties:{name:"平顺县",cp:[113.435961,36.200179],childNum:1},geometry:{type:"Polygon",coordinates:["@@AG@@AG@A@MAI@CJOBCDMIMKMAICAA@I@EACAEGAA@ABADEBM@G@E@CDE@CDEBC@EAC@AC@C@C@AA@A@A@AAAC@C@A@@@AACG@E@CBCBCBA@@@IACACECGEECEAAAA@CACAA@IACA@AAC@E@@ACAA@ADAB@BBDDDDDBB@BBJ@FAHADAB@DGDC@E@@BGB@BA@AA@CB@@GBA@ACA@AACDAB@@CEAAEA@@@@@ABALIJG@ABA@AAACAGAUCMCCAA@CBGBA@@A@A@A@@AAC@EBG@ABA@CA@A@AB@FEBCBAFC\\E@AB@H@BA@AAA@ABCBCBCB@BBDBJBD@D@DAB@BCBANABCAA@@EE@ABABCBCAC@AB@BBB@@ADA@@D@D@@@@CACC@C@EEA@AFA@@ACCA@CA@@IAA@EBC@MAA@AB@D@B@@C@@AAAAAA@EB@B@DC@IAG@A@CAAA@AFCHCBABABABEDADBB@BEBA@@@@BK@CE@C@@@@ADED@D@DCBABA@C@E@E@EBADGDCBI@G@@CCAABA@ADAB@DA@C@@@CCEICA@CAC@A@@AMEMMAOB@RIRCT@LAHIGG[EJIJGJMAG@AACOKMAA@SHKBCBOBGBKAIGAK@MBEDGIAKEEKEKMAMHILGDKBQAQCQ@MPKJQASGEAIEMGAD@DBB@DCB@@E@EBBFBBADCBC@@TMHSF@HAB@@KJGFEDCDIFOHGFOHIBABC@QEGCQEM@CTGD[CGFGL@DBDBFBBBDBFBHADEHGJAB@BIHCP@D@JA@@PMHKHGHEDCD@BEHCFCH@DAFEPKHCBIDMDODCBC@GBE@GBUBABIFGDKLBH@@@HBDBBD@D@DHD@FBDJH@FCJ@LBADGFCBIDEDCFGDEBM@CBAAAGG@KD@@@BBDHJDH@BADGHCHABAFE@AACC@@C@EB@@A@A@EBE@A@@E@@FK@EGAGBEAACGFAJCDOH[BA@CAAH@BAB@B@BDFBBBBBBBB@B@@@B@BA@@BEBEBIDCBABAB@FBB@F@BBL@H@DBJNN@@XNDEFGAI@GBCBAVIF@F@J@RHRDHBPAFAD@JAJBJAF@HA@@HBLBPIBAB@PK@@VCFAJCRCbFHPFHFHDFFJBDLLBBFLBFBBFHDP@F@TJVNFBBLAPCFB@LBH@B@VADBFBBABAB@B@DE@CBAAAAAB@DADG@CDEFG@A@EHCNKDIFAF@BFB@BAB@BAB@B@@AB@BBB@@@B@DF@TAP@P@TDB@LFJJDBFHDHDFFNFJHLFLRFTERALCZGP@NDFBDDDDB@FDBBNNLBBA@ABALFLFD@BAAEAI@AB@DAH@J@H@BAFAB@FFFJDHAFN@N@T@NCJALERKLIJIJGVMVEJBF@N@N@L@DGFIFOFQNQHODQ@C@K@A"],encodeOffsets:[[116462,37237]]}},{type:"Feature",id:"140426",properties:{name:"黎城县",cp:[113.387155,36.502328],childNum:1},geometry:{type:"Polygon",coordinates:["@@O@O@SBE@BF@BBBDB@BABA@AB@B@@@B@DAB@B@@AD@F@@ADABCDABAB@@A@ABA@CB@@E@CDGFEHCBEFABCDIJEJC@G@I@GAKAKCA@GB@@C@CH@BAF@FBBAHCBA@EAAFENEZABOEMEMEEAIAIAKBIBOBC@E@C@CHIFIFKHOJGFWPMJ@@ABIPGNC@ABD@CFCTEPCH@BEPGRBPDPFLJNDHLLJJLHPHTFJD@NALADAJBFHNBFDHFLFLHPHHJFRDFDFBRLNFLFVFRBFHDH@H@F@D@NADAFCR@PJLJHJFJNEP@N@HBD@FBFHHBBRHFBFBLHJJDJAJELGJGJIJILAN@BFHFFJFLDRFFBAC@@BAB@RADBD@FBHGDCJIFANGVCRCJCFAFCNIBAJGLGLIBAHIFGTGNANGJGHGDCDMFSHQFIBEDGHIFIJKJGJADAHCLCAKKMQDQDMBYHQEOKIKKSMQDOLCH@NBNFPFR@BOGQCMIGUSKKGMCOHKPKN@PCPCP@PAVARKBMCI@GCOCKHGTCTBNGHKHGJMLKBMBEAEACACAAACCAAAC@C@E@CBGDC@A@AAC@KECCEA@@CBCFCBCC@A@GDAHAHBHGH@BA@EC@CDA@@CBC@I@A@CEIAA@C@A@E@ECC@KCAIAAE@CBADBJFHBL@DAJIHAD@FAB@DABMBQFKNGBECGEIEEA@EBABG@I@G@CBA@@BBJBFABC@KEKEAB@BABKAMMAAECA@CCCCEAMCO@YHKDQBSFQEEKGKEIEMCECGEGCAIIKEA@SC"],encodeOffsets:[[116147,37271]]}},{type:"Feature",id:"140428",properties:{name:"长子县",cp:[112.8779,36.122334],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@YBOBIBIDEDABGL@LDF@BBDFJ@DBD@DAPCJ@DEFGBS@KBGBEDCBA@KFQDI@MAKGCAKCAAK@IDGDEBCBIDQBEBIBIBMBGBIDCBMJKNCBEJOFKESEKCICMFGNEF@BGHOPAHEJ@@@@@@AHIHKLUHKJQBQIQEE@QAOCGA_GMC@@MCQCCAQCIGIGGBQBK@EJ@HBJ@JCLKNEJBFDH@@@@BDHJNHHDNDHDFDNFHDPHVJNFHFDBHHBHBH@D@HCL@BGPIB@DDNAF@FBHBDFF@N@DBPRHFDPJJBPDPAVAVAXIRCTDD@JFHDPLLDRBLDJBLHRHTFJDLFFDFDFAJJDDFHB@DBDBHBB@B@FBJBB@R@@@FBL@B@D@FBNBDB@AH@NEDCB@HCHDDBBDDJDDFFFFTBF@HBBGH@BFBDJ@FAFCBABE@G@CBABMHKDED@N@NFDHB@FBF@B@JHB@NDF@L@@@NCHM@SFI@A@@@ALSDAJCFATAHBDBNDRDRYBCAKCQ@E@IAG@AEGACAAEG@G@AA@BCJGFCBABCDC@C@@F@H@D@DAAAA@MCC@AA@ABADAB@DAFAB@@A@@@A@AAACCAA@@@A@AAC@A@A@A@A@C@@BC@ABEBA@ABCAACA@@A@C@A@ABABA@ABABA@@BCBAB@BA@@BABA@ABA@C@@AAA@AAG@CAA@ACCAA@@BCBABEBEBCBACK@@DIBGFOLEEIIAQAAECKGGGEEICCBIBKNEFA@ABG@EFEDAFA@G@CBG@A@G@E@I@E@Q@@CGA@@ACEQQIM@@GKAGBGDEGCUCQCIAOAI@A@G@Q@"],encodeOffsets:[[115623,36785]]}},{type:"Feature",id:"140427",properties:{name:"壶关县",cp:[113.207049,36.115448],childNum:1},geometry:{type:"Polygon",coordinates:["@@PCAMDKBABMBABIBO@C@CBWKQEUCIAKEOESBEBGBEFGBAFCFGNY@@CKIGQGC@IDILKBKCGCAAAAA@A@@AA@CAA@E@A@CAA@ABA@MBA@AB@@E@Q@@@A@C@EB@@IFIFKFABCBGDONEDEDQFIAKAU@OBKFKD@@GFA@EDC@ODIBOBA@QAICGGIMCEACACEKMKKAKAG@QBOBEBKD@@@@MLEFABAH@FBLDHENGNMJGDIBGDEBO@G@ICEAEAEACCB@@CGCGBECCAA@IGEAIGGAICABMBKDABADILABADGJCDCD@@EF@@@DBB@DAB@BC@C@A@A@I@A@@C@EACAAA@EAEBA@CBOCI@CBC@CJCHBBB@@JCHCF@F@F@@@BBB@@@B@@A@AAM@@B@@BB@@BDBFH@D@@F@DAHAF@FIDG@A@ECEABFBDCF@@@BADCFADADA@CDAH@B@D@B@@BBDBDA@VILIFCBMHGDAFE@EBGBILCDADABCHCJFNBDFHHFB@BBFDFDFFDDDHBL@B@B@@@HAHDJBFNDDBFHBDFHLABD@FAF@@CBEABF@HBL@F@F@H@F@L@LF@DJ@BADEJFFHF@@BB@@FF@DBFBBB@@BDFBB@P@L@DAHI@IDEFCJ@FBDFHHDB@BBF@JDB@B@FB@BD@BBDD@@BD@D@B@@BDAD@@DBB@\\APGDCBIHEBDFBHAHB@FEL@@@FB@F@FAB@B@@@FAD@@@DDBBF@BEBADGHGBC@ACGGIAC@A@@LCH@BHBBDAN@FAHCDEFCJCDAHEBCKAI@EDG@CIEAC@CGC@C@AAAC@G@@AGLKHCJEBAVAHAF@HAD@DAPCNCJCDALGFOBE@CDGDEFG@ADCFCHGLGNG@OB@@I@CDOJG@ABAHIFGBCAGAEACAAAEAC@CHKHE\\DHCDSN@RFHDRFD@BAJAPGHEPGJEDCFCHELI@@BA@GTENG@SD@DABCAAAEFAF@@@DA@CAA@CBCNHJFFBTHRBLINOR@RDRBLAHCJKNGNBFLFLLFJB"],encodeOffsets:[[116368,36833]]}},{type:"Feature",id:"140429",properties:{name:"武乡县",cp:[112.864561,36.837625],childNum:1},geometry:{type:"Polygon",coordinates:["@@ECQCIEGGGOEKEKCGI@ICMDMHOBABCDA@EFGNEFCDOPMJQH@B@@MTEJKFEDCBIBGBIAE@A@EBC@A@GAAC@ABA@AA@MBA@IBCA@BABBBDBCBMNDDDDGJC@EBC@CACC@AAAI@GAGAA@AAC@A@I@C@G@E@O@G@EAKCGKCECMGIIAAHEFYPOHMBA@KCO@QBMJGDEDCBGD@AC@EFMBI@KAC@E@C@C@G@KDS@O@OL@BCJAFCBCBOBM@O@C@I@EAGCMESAQ@KHABKPCDKN@LDHJLHR@FBD@@DLBFBDP^HHADABEBEBIHCJ@DBD@DABABCDCDADABCDKJCL@HBJCRGRGAIAIBGJGBA@CF@@ABCP@DCDIBABGBIDCBGBECCCCCA@E@KBEDEDEDEB@@EDEBG@AAMEG@OBAD@D@FEBC@MDEAECIEEAE@M@ABAFBF@B@DEDMH@@ADAPCBE@A@I@E@M@EBABA@CDCD@@AB@BEB@@ABA@A@ABG@A@C@@AA@CAC@A@ABCBCBGBABCBABBBAHAFCLEDKFQ@M@SFGNDPBLCNENCJEHABGHMHIFAJDHBDDTCbALAFMHSFQ@OFIHCLBD@@D@B@JAHAD@BBFBFBB@BAB@B@BBD@D@LBLDHFFDH@JCD@DAHBD@H@D@BABA@A@EACACAC@A@CDADAB@JBF@D@DADABCBABGBG@CBCBCD@D@F@F@H@HADAHAFAH@JCLCHAFAH@DBDDDHHFLNJNRFR@JBBHAF@HHFLBJAB@RINM\\QRAPCTBRENKB@LM@@@@HGJO@IAQ@K@CNCPBLBHCDEFMLGJGDG@EDOJIJCDAR@TDPB@@PEJAJKBCBCFEBAHEJENAVAFEHGDI@EEUAACGAKAABQ@AJGHOBGAKAEGKACAKBAFILENIFGBCFIIMA@@AB@TEDATAHBDBB@DHJFFHLL`VJLDDRNZNNJ@@HDTNLDDBVETAV@NDV@P@D@PCFCJGLCTBB@F@B@DJFFdCZDDAHAHCFGB@D@BD@B@BADAD@BADAB@DBDBD@N@NBDD@HCDCDCHAFDBD@HBDB@@@DI@AHa@A@@BCB@FAHBBAB@BAJADAJ@@@PD@HDJBL@HTCDK@KDCD@DADB@FBBFFHBDBDBFDHJB@B@BEBADCF@@@DB@D@DBDDDDDB@DFD@DAJBB@DHHJDNLLBBHBJBPGDGJODEDEFGECCCDEHAF@DBPK@AJGJGBAHGFGDCTIA@@ACEA@BCHCFE@A@CAEAKIK@CGK@AEAQEKCIEEEEG@ABMJKJIHIHIFKBICIIIKGEAEAQGAAGGAE@EAC@G@MFOIMIEIGIK@ODQBEBC@M@C@E@GCGEGQAUEKEMEQKEA"],encodeOffsets:[[115979,37575]]}},{type:"Feature",id:"140430",properties:{name:"沁县",cp:[112.699226,36.756063],childNum:1},geometry:{type:"Polygon",coordinates:["@@KAODWBOBE@OJCBGDEPANCNCDGDIAGCAAQIOGIGUGSEUCM@CLCLGPERAFEL@LDPFTDNDRLNNHDNCHIFKFEPAN@H@JCDMHGHJHPPHNBDFLHJHHNJFFFHPLJLFLFNEBWJMDSJMLMJIPCHDNDNFPFPBLBH@PFPJLBBPFPH@@@@BBBD@@BBBBBBBB@BB@BB@BBB@@BD@BB@@BBB@@BBBDAD@BABADABA@@BABCDABADAB@BAB@BBBBB@BBDDFB@B@BBBB@@BBBB@@@BB@BBBDBD@D@BA@A@A@@@A@CBA@@BA@BD@BBD@B@@@BBB@BBB@D@D@@BDBD@B@@BD@@@BBD@BAB@BCD@BADAB@DAB@@EBG@ABA@CBA@C@@BCB@@@B@@FBB@DBD@FD@@BB@BBBBFDFBDBD@@BBBB@BBBBDB@@DFFDDAJELEHKPMHOHIBMHGHEFEPIJILELKHKJFDHHHBPCNILENCRBRDRHLFLNDLBADABAHADADABAB@D@DBB@@BD@B@H@BAB@B@BA@@FA@ABA@@DCDCB@BAFAN@F@J@B@F@DABOBC@@NGFC@C@AAEBEBAN@F@FBJFFDFBNCD@FA@E@CBCPAH@NFBBH@FAFC@@FAFCFCFCLAF@B@DDDDFDHADAJCHABAJADC@CDOBA@@DEB@HAHIJAJBHBHQDQAI@GDKLIDCBABCDCDCBABA@CAC@CDIJGFAFABABCGGO]ACAECK@@AC@EGQIKCG@KLMDCLOBALGR@TBNFHDFBJ@D@P@N@PADADABEDI@APKP@T@LCH@GICMAMAECIAOCOCGACCGEAA@AAGBQBQFC@IBK@EICCACEGIIEAEC]AKCOEKGEKBE@GHGDCCICIKCICMIGICEEEEC@@AK@MDMAICMAECIAGA@CIA@GKIICECCKMCCIECA@@OGQIEIAKDKBGEBGBGB@AIGIGCIAKAMAI@EACBEBE@GAIEK@@AEEGCCCCMEA@QGSEE@KCK@"],encodeOffsets:[[115334,37304]]}},{type:"Feature",id:"140431",properties:{name:"沁源县",cp:[112.337446,36.5002],childNum:1},geometry:{type:"Polygon",coordinates:["@@UBUBQAE@I@U@SAE@OFCHELINMDGBKBMFMHOHQLWZKJEDGFQJG@K@WAS@[@IJIFEJELEJADGPGPAFEJCNEJCNAHAFAHEPALELELOHYHKDCDIJGDEDGFKFKJEJCBELIPCHAFETEDCB@@@@EDOBI@IDKDIFEHMJ@DC~@FEFEJKHIJ@B@LAB@REPKNGBOBI@CTDPBV@Z@FBBDDLJDJAFGHGHCJELC@C@GAABADBHFL@DDHAFABEBAD@J@RHPFBPFPHFH@D@BADDN@HCHLJLTHVELELFHNHP@NEPGV@LBRDBDDFHHBDIJGFEDGJDLHHNHFHBHBH@F@DCDCBIJKLIJGJEL@JBDLDN@RAZBL@TDJBLFHFHCJCH@DHDHHDJFH@HBLFHLDHHDDDLDF@L@PBN@JBFCB@L@DBJEDIDMDMHELELGJAPAT@PELIFIPKB@NEDALEPKNQDA@GDADCF@D@B@BBB@@BDLFHDDBDFHJDJBP@JARERAT@JCNGLGJGLAV@TDLFJJFFHHLDLILGFKJKJIFOFEHGNGJAPGNGLOFGFKBICCEE@CA@ACAA@AAAAA@@ACACCEAEAA@AAA@@ECC@CAA@EA@@@A@@DA@AD@B@DAB@BAH@FA@@BA@CBABC@ADC@ABA@AAC@A@@AC@@@AACAC@@@C@CAA@AAA@A@@@AAC@AACB@@AB@DAB@@@B@B@B@@A@CACACAAA@@A@@AAAA@@AAAAA@A@CEAC@AAAAA@ABA@ABABCBADCBA@AB@BABCBA@ABCACAA@@AA@AA@@AAC@@AA@AAAA@@AAAAAAAAA@@ACAA@@@@OGOEAAIKEO@OAGAKEOEOCMCMDGJONINKTINCXIFAEMEKIKOKEGEEMIGGGIEKACGMOOIGHGNGDC@I@GBMFOLEJEDGCMMGKMCQCMESCO@KFKBEFQHODKDK@Q@I@KCICCGIKM@MDKBKIKACIMKKEGGGCCGGIIIIEDEBSBO@S@"],encodeOffsets:[[115115,37216]]}},{type:"Feature",id:"140481",properties:{name:"潞城市",cp:[113.228852,36.334104],childNum:1},geometry:{type:"Polygon",coordinates:["@@EGGOaEQDIDEBUD@@OLA@ABOJKAGA@@GBE@IBIAIBC@EBOBGAQCQGI@E@E@UJABAD@HBJEHCFWM@@MMG@@EOBAAECKBGBAGC@MAC@MAC@QCCBKDBD@@A@A@@DA@ACACMCBHK@@FFA@HFHD@BF@@@JBH@F@@BB@F@BAFH@@B@BC@@FRD@BF@@DF@@FBDMAG@IA@D@DBP@LD@AB@BBB@B@B@D@BF@B@@DB@BFC@G@BFA@E@@PF@@BAJF@@N@D@B@B@D@D@DAD@BBD@DCDA@CAC@@@@JEAAAAAKIAAGESAE@C@YBCBGBGB@BC@A@CAE@C@AB@@ABABADAF@B@@BDB@BDF@BDBB@BDDDBDBDFBB@BF@D@BCH@@DEB@D@BRDFB@B@@SLKFGD@DAB@B@BBHBFCFA@BB@B@@ABCD@DABABAAECA@K@I@GC@AAE@CAEAK@CE@GFIFY@@@A@KAGBA@@@EBKBABIAID@FBFFBHBDDGBBBDFB@BDFBLFFDB@FDHBB@NBBBLBDBDAD@F@D@D@F@BB@B@FTDHB@@PDRDF@B@BBFBHBHBdHD@D@JBFA@BB@B@B@BA@@HAB@JEBCBCDCDIF@CAAA@ABADBFA@EBGHE@GBGJAFDBB@@DDNFDBB@LHFBD@HDD@@FBDLBBBNH@@B@D@BBF@B@F@B@F@F@B@JDLNBDDBD@F@D@PAJALAJBJBFBNFNFPFBAFYFMBEFBB@DABGAA@EBE@ADGD@@@HAB@LDLBHBJ@H@D@FIJIDCBAFEDAFGHEDCF@@@DAB@BAB@@@BABADCBABC@@@EBC@@@ABA@C@A@@@ABAB@BA@ACAAA@AAE@C@A@@AA@ABA@@@ABA@ABA@AEA@ABEJELCDMFGB@H@FEDCH@BC@CBABBBBDAF@@C@ABABAAAAEBC@U@AAG@KEAODKBAAMEIU@S@ECOEGAAAEEKAAKKACEICEEG"],encodeOffsets:[[116101,37129]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
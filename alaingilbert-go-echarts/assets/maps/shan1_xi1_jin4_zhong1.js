@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("晋中",{type:"FeatureCollection",features:[{type:"Feature",id:"140702",properties:{name:"榆次区",cp:[112.708224,37.697794],childNum:1},geometry:{type:"Polygon",coordinates:["@@HKDMAKCICOACBI@KRYJQBKEMIIKGICKG[ASAQJYZONUNGHA@CDCBABIJABWFQJGFQNCNEFE@CBUFQESAKHA@MLOHKBCBSBC@OFA@UFIFA@OJCBABEDKFGBODG@@@A@@@E@E@AAIAEBA@AHAN@D@@AL@@@@@DA@@@@BCJEDIFIDCBABCBSLA@SJC@A@YDCBQCGG@@@@CEAAEE@@II@A@@@@CE@@@A@ACEBCBMBK@E@EAC@@AAQCY@A@_FCBEDCHELIHOCMISKKACACCCCCAAACACAC@C@C@C@C@C@E@EBGBI@BDBB@BAD@D@B@D@BBBDDBBB@BBD@DBD@BBF@DBDAHBF@F@FBDDBDDF@BA@A@A@CBA@E@E@AB@@ABAF@B@D@DAB@BABCBCDAB@B@D@D@B@BBDBJ@H@@@F@D@DAFADADABABABCFAFAD@B@DAD@BC@@@@B@BA@CAA@A@EBGBC@A@AB@@A@@D@B@D@FBF@DAF@B@@@@@F@FAD@D@DBDBBDBDBFBD@FBFAF@F@D@D@DBF@HBDBDBDBBD@H@@@@@@@DCBCBC@EBEBABABABAFAB@FBB@BDBFBD@BD@B@D@HBBBBD@B@D@B@FBDBBBBD@H@D@DABADCBABCB@DBB@DBF@@FBBBFBB@D@D@FBF@J@F@B@DB@BBFBD@FBH@F@D@B@B@BBBB@DBB@BBBBB@DBBDDDBBBBABABC@ADABADBD@F@D@D@BAB@BABCBABAB@B@BABABADCB@B@B@BBB@BB@BBD@B@DBB@DDDBLHBBLFDDFDBDBB@B@DIRGJ@HFL@BHHLFBB@@BBBD@B@D@D@BDDB@D@JBJ@B@@F@BADADBBJ@B@JAFAFBDBB@BD@BDDB@@BD@BBBBBBBB@D@D@FBF@DBBBD@@B@FDDB@@B@DBDBBBD@BBD@DA@@@@@@D@FAFCFAFCFCDADEFED@B@B@@D@DADADADADJAHAFCDAFAD@DBD@D@D@FADADCDCDANA\\@NFNFLBFBDDDBD@DAHEFCBABCB@D@F@DBBFDBBDBBD@F@FAJAD@BA@C@CCG@ABADE@ADC@C@A@CAAGC@AAC@A@C@CBEB@B@BBBBB@BC@EDEBCBABAB@B@FFFFBBB@FBJ@D@N@LEB@HEBAACKG@AAA@CAE@E@A@CAEACACCACCECCACAEAA@ADCHCJA@ABABC@ECEAEG@EBC@CDEFGHGFE@ABAAC@EAE@C@A@C@CBADABCDADEBADC@ABE@C@CFCBCDE@AACACEECCCCSGCCKECMFI@@DI@@@@DGDCDCFELOBAHGJIHIFGJKNGVGRCXEFEFCZOJSKORERCTCND@@Z@R@TCPMBCBEDGHKPQPGNOFIBIAICGCQCEMKMOQSKKCI@KHI"],encodeOffsets:[[115793,38393]]}},{type:"Feature",id:"140721",properties:{name:"榆社县",cp:[112.975209,37.070916],childNum:1},geometry:{type:"Polygon",coordinates:["@@EECIA@E@A@SAKDIHEDODC@O@U@MCU@SBUFCAKCSMGC@@MIYMQMCCIK_UKKEGIECGA@CAGASBCBSFA@@BB@JNEJADEHMJKFEJABBLBDHLBFBLAHGPIH@BARBBBLDHBBFV@FCJGHEFUBMBIFGFABEFADADILIBOF@@OASCQ@CBIDIJCP@FCHIHKHENCFGDKAOAMD@D@LBR@JIPGH@@@@KNA@MLQFSAODQB[RMNQJ@BBFHJJHDB@@BFBDBDDDFBJBFBFDFBBBDF@D@HADAFAFABADCBABAD@@AB@DBBBF@BBBBB@D@DAFEJAFAF@@ABC@A@CBAB@BAFABADADDBFBJP@NA`W^ADEJBB@B`@dFT@L@@D@D@BCB@B@BDB@BD@B@J@HAJAHAHCNCBAHBNDPJRFLBJ@VDXDdHXFRIDMHIJCJ@JJFNJJLTBBDT@LBLFJ@BBB@BB@@@@@LNNJRBB@LALCRCT@RBHFJJFFDFRJlFNDVBRDPFHDPLHHJLHHAJTB\\BDCJIFMDGAC@A@I@GACACGGGECCAC@C@CDABAD@F@DBJHDBB@BANEVAV@PGFELKHKDEFIDYOOOGKMLQHMLOLQDSAQC]OMQM@GFGBGEICESKMO@@EOHSBAPILEhMNKCC@@MKGIFMLI@WUIIKCMBGDIBADAHGFCHAF@J@F@D@DA@IBE@EDKBABC@@D@D@BABC@A@CBACG@CACEGAAAAAC@@AI@AB@BCB@DAL@JAJAF@D@D@B@@AACCECCCCAC@C@CBEAG@E@AHANBJ@B@BANWFIHUBS@A@ABG@GAKCI@GOC@@I@CBIBABA@ABGAEBA@AD@@@BGb@BCJ@@A@AC@GACECGBCDCDGDC@AC@M@MACAC@CBABC@ABCBC@A@AACC@A@EHGDGBCBYCcD"],encodeOffsets:[[115824,37814]]}},{type:"Feature",id:"140722",properties:{name:"左权县",cp:[113.379403,37.082943],childNum:1},geometry:{type:"Polygon",coordinates:["@@AACCA@C@CAABCAABA@@BAB@BA@@D@BCBA@C@EB@D@H@F@FBBAD@F@DADCDA@C@AAC@AAC@C@EDCBADABABOCKEGIAACMGIGGCCGEAGAMBCBIHMHMCIIGAECKJQDOOEQ@KAEAKEMGCAOKIGKGEAQKUISBOJM@@@M@KCOCKCACIKKDGDCBIBIHILEJGJCHAFEJGRETCNCDGHIHMHMBSHEHGJABKJKHIHABMJEDEBIDQDUDMHEBIJCDGHEAC@CAQBA@AB@@BD@BHL@DJLBLBF@D@BEFGDADB@DF@BB@SJCDEHGHABIHIH@BOLCAE@GBCFDDFDEHCFCFIPCHOHIAGAAAKKCMGICGA@IACBC@CEA@CCCCAC@C@CCA@@E@CDABAFA@A@GIECCACAGAEEAA@ECACBC@CD@LCLSDAH@B@BATGVEJMXABA@I@MAGB@B@FBHAF@D@DBDDDDDDFBD@BA@C@C@E@IBIBK@CBA@ADA@@BBJ@@BDBBBBFHBD@DDHAB@D@BADABC@C@@@ADABCL@FAF@JCBC@E@I@E@GBEDGHCBABCJAHDNJLVJ@XKJENHJNL@@DDMLgNKFOJABGTFP@@NPTLDFFJ\\EPERALAZAB@J@LBDBD@F@BBBBDDDFBBDDBFDDBBBBB@DBDAHADBBBDDDBDBB@D@DBNFJBPFN@FBHBDB@BBDBBHDDDHDDBD@D@B@HAH@F@B@BBDDDD@@DFBBBBB@DBD@DBDD@BDD@BBBBBJFFDHFBBDFLLLDNAJ@JONQPONKJEHGLGREXEPATBL@JBJ@D@H@HCFALI\\ABBRFNALI@@@@@@DCHCHGHEPCHAPGVETDPDLBDINQJQFGHIN]HUBOHODEFELGVGTAV@LDB@JDPFB@PGBAZOTKJEFEJIDAFGHOJIDAHGFDDKDIHDJJFDHHHMFEHEPED@D@P@DI@G@C@MGIGGMOAAEOFIHILENGHKEKAAQMCGAA@AAAAKMEOGCIJQFOBE@AHGFAFBFDDA@G@CIGAIDGJOFGDEDIBMBKEOCCEEA@CAG@EDIBACAGJICKE@@HGJA@MA@@AAAACK@A"],encodeOffsets:[[116485,37739]]}},{type:"Feature",id:"140723",properties:{name:"和顺县",cp:[113.570415,37.32957],childNum:1},geometry:{type:"Polygon",coordinates:["@@IGEMCGAEAIGGECIIGCCJCLECGHCBIJGPEHCBIJEFIFSLYPABOHA@OEICA@KCU@SBUHKHEFCFGPAPGVM^GJEHIRMRCJKAOCSCUFOHGBODGFGHGDCD@@@@@@KJMBQEAA[BKJEBGDG@C@I@IAK@SAOBWFQFKHGHIFMLOPMRIPI@MBKCKKCEAAGEECIEAAAA@ACC@ACCCAC@CAA@AAAACE@@CCCCAAA@E@G@GBA@C@C@CAGCCCGCAAAC@ACAGAEAM@OEIAMECAC@A@CACACCAACAGBCBCAA@AAAACCAECCAACECCAAAAE@C@CAKAI@A@YBKBQBOF[FAHEH@HRNPND^BRCTKRKPGNKRLNPHPPCZEJCFGLKLEFOHU@UBMFABA@CAIGCAE@C@ABCB@D@DBDDDHFHHBDBD@H@J@BBDCHENIJCDLHJDLHJJFNALIRQZ@LAJBDDPDJBLCNGLGJ@LDJLLRTNPNLDFPAN@LDFBJHNJLJJJLD\\BB@FCLEXBNBYP@BAD@F@BP@N@DBFC@AA@BCLENJT@LAFADAHANCRFJDHDLBNBNAH@VIRGJCLHDFJLNHNDN@LBBSASGKEKIUEMAQDQ@AJQJMHQBSDQ@CBEBGHM@ALGLCVB\\HXB`BTBXDTCR@DA^DVAXAVAJLERDPDNFHbTj@F@DAH@PADAPCPIHEBUCQAIDMDEDCNMND@@@@@@HBFBTFNBD@ZCVCB@FDDBFDB@B@@BD@B@L@BADALMBCBABE@@BABARYHAPDTFRAHECIAKFIFEHKCUDEPEFAPKHOFKHOBENIJCFATETGXARERENAHBPRHV@@JNNL@@FNBBBJNLNBNC@KDAB@@AL@AADCBGH@BA@A@@BAD@BAD@BCC@EBCAJCBANOJKLGICICGCCAKEIGCAECCAKMCKBE@I@K@GAIEOEKAEGGGMAM@C@G@QMKQOKEMCIIKSIKKS@M@M@AIOO@G@OCGEAAGEUEM@EAGAKCKMDMBED]BIBQGO@A"],encodeOffsets:[[116501,37989]]}},{type:"Feature",id:"140724",properties:{name:"昔阳县",cp:[113.706977,37.61253],childNum:1},geometry:{type:"Polygon",coordinates:["@@GGIMOEQ@C@MIIGIIIIIUCOAACGAMCO@CBMEMOMKAEAKHILMPABIDDBFAD@ADC@ABC@AB@@@BABG@AHCDBBK@@BA@CB@LMDMAMKAIAAEM@@MKIM@@GUOQGAMBQFQFWBSHSFEBIDMJAFGPELGPOLEBOFCFDVGLEFEJBLDJGFQBSEOCGBQZABAB@@AFABADKNCBABK@A@C@@AA@A@ECCAECA@UDYDC@MASEEAGA@@@@@@MCMNCDCFCNBJDRAVGFOJODCBOBG@CBE@i@aSEGCMCOFQIKUBWBUB]CCBQ@SDWCSA_AWA[GUAKDKH@BGNAHAF@DCRATGRINIR@BCRBRFNJVFLHLBTATBL@DCDCPCT@D@H@HAD@DILGHIFBJDNDNNPPPbTFHBDPVPRHLN@\\Bp@VCF@BN@RRKLEPQJUPAPALAPBN@HGHGDILKRCJ@LAP@VBXAL@RBNFTBPAJCPMHGHAHCJAJ@FA@@J@PB@@HFDDBBJ@HBH@H@@@DD@@BB@BAB@FBFFDBBHBLBJBL@H@HBL@LAHAD@DABAB@D@H@DAFCDG@C@A@@DIBIJCJDVJXFP@@@NBH@PBVBNHVLTJLLDDJFNJXBL@H@VENEVGLCTIFCZKPIPCPBHFBJDRBPBHTARRPDhJJBPBNBLAXIJEHIXERCLCL@PGPMFQBKCOEMEOAKGIKMGICK@KJUFOCIEKIMOQOQOOIOGKS[OSGIIIKIKKMKEQEMEIBOAIASAGAMCK"],encodeOffsets:[[116760,38334]]}},{type:"Feature",id:"140725",properties:{name:"寿阳县",cp:[113.176373,37.895191],childNum:1},geometry:{type:"Polygon",coordinates:["@@EDA@[AKCIIKIMIIGEAKCM@OBDRDHBJAJEJMPOHORGLCHAFADONSDQ@Y@@@MCSDQDQFLPITYPEDEFWFQDUHMHILEHGJIJGHABKPEFCDCDCH@@@@CJ@@EJDNLFDDTHDDDDFFBDBD@BCFADED@D@DAF@BCDABCFCBADCBAB@D@D@B@DBF@FBDAB@BEFGHEHCF@DAD@FFHFBFDD@BABAB@DIDGBCB@FBDBDBFDDDDBBDBDBF@D@B@FBF@DBB@BLHBDABGFA@KFM@C@I@EAA@AAEEEEA@A@ABABADCF@FADA@AAAAA@A@AF@D@D@BBD@BHDBB@D@B@DCD@BCFAB@BDH@D@DABC@IBEBE@C@AAACCAAECAE@C@A@ADABEDGFCBC@CACCEAKAMEME[@MBCBCDCDCBEBC@C@C@CAC@EBCBEDGBIBA@ADAD@B@B@B@DABADABADCDAB@D@D@D@F@BADABADABADBB@@@BBBBBDDBDHBB@DBBD@@AD@BADCBABABCBCDAB@B@DB@@BFFDBHJFFFJFDDDDFDFDDFDFFFDFBDBDBDBB@DBDDB@@BBB@B@B@BABADCD@DBDBDDBDBD@B@@@BD@BBBD@D@BABC@ABABAFAB@BAD@D@D@DAD@BA@ADCBABAB@D@D@B@F@D@DBB@DBD@FBDDB@DBDBD@D@B@D@FABABAFADAB@D@FBDBF@HBDBF@DBD@D@BAD@DADAFAFADAD@D@BBBBB@BB@BBBBBBBBDFB@@DDDBDDDF@DBF@BFDDDF@NENM@AB@@ABABA@@HCH@LBF@FF@@BF@RD@BBB@B@BBDABBB@BB@@BB@B@BBB@BB@D@B@BB@@AB@BABAB@BA@AD@BABA@ABAB@@C@@BA@A@ABA@@BBBB@BB@BB@BBBBB@BB@@BBBBB@BBD@BBB@B@D@B@JAJ@JAB@B@B@B@B@BAB@B@B@B@BBB@B@BBBB@@BBBBBB@@BBB@BBH@@BD@H@D@BBFBHCD@P@J@DD@F@@@B@B@BBB@@FFHFRJPJJBHBJIFIHILGJIHELANAJ@X@THFJJNBHJRPHJHJDH@LBRCLAJEFCDGDIDGACGAGEEE@KNUBCBKBKFCN@JCJADCDEDG@E@GBI@MDGDKFCDABCNALBN@F@PGHCFGDEBC@I@ICM@GAGEOCMEMAGAC@@AMAKBI@@DOFIJELGJCPGLEJEBCDCAOCIBKBEDIDEFCLGFCJEJIDIFMLMDEJKJOJIJGLGJEFEBABE@Q@KDMFGHKFI@A@QAME@UDo@[AM@GKOQOUACEGaSOOMOCMCMAIJEHGJK@CBC@G@G@CDSDODC@CAKKAM@MCMGIKCEKGIDQHUJG@MBMAKAGCICQEMDGBCBEBKBS@MIKFADB@@BEDCAM@O@@A@EBC@AZOMAWAKF"],encodeOffsets:[[115893,38464]]}},{type:"Feature",id:"140726",properties:{name:"太谷县",cp:[112.551305,37.421307],childNum:1},geometry:{type:"Polygon",coordinates:["@@X]B_@MIOEACAABCBEBE@E@CBGFIRERGNMNEBIDAJFN@LIJKFINA@IDGDCDEF@BADAD@DADABABCBA@ABE@E@CAE@CAECEIMCC@@BEHKHQBIFM@EBCB@FBFAJIPABABC@G@EBAB@NAP@@@D@BAD@DABABAAAA@AAAAACAA@A@ABCBC@C@A@ABAD@BA@QH@DDFFJHHDJ@B@BC@CFAD@B@B@@BDBF@@PFHL@JAHAT@D@FBFA@@JCJBB@DBB@DBDB@B@DBBBBB@B@D@F@B@D@D@@@@BDADBJBF@@@@J@B@BB@B@BCBABC@ABHB@@BBBBBD@H@B@D@D@L@F@D@B@D@F@FB@BBD@F@F@F@DBFBDBD@BBB@@@@@D@@@D@FABBBB@BBBB@D@B@F@DAD@FAD@DABABAB@BAD@F@FABAD@HAJAHADAJ@HAFAF@D@D@D@D@D@D@DBDBBBDBDDDDDBLBTLNJPDJGFKDGFCDA`EB@Z@RDBB@@BD@F@FALANADDF@B@B@@DF@@@@@BJJ@@FFBBDF@@@@HHRDDAZCB@D@TIB@TKDABADAJCJEFCDI@A@@B@@C@@@@BK@@@CBMBGB@FAJBBBF@F@@@B@@@H@PCHALEFCBADAPIB@JEVEB@PED@TADALAPGNKB@LGTBRFVEDAF@FEDMRMHERIXEBAJIBADADCB@HGVMPMZYRIBIGGIKGGOKGCOEQCUAMCkEQICEEEIIGEQAS@QDKDKBA@QAMIKM@@@@A@@AAA@AEIAK@KCSAAKSIIEMIII@IDGJCNQJWEcGWCUCI@KAQEOIMCGAABMDGDGBIBGBI@A@C@@ACA@A@ADA@A@C@CK@S@cE_@@AAAFIBC"],encodeOffsets:[[115368,38154]]}},{type:"Feature",id:"140727",properties:{name:"祁县",cp:[112.335542,37.357869],childNum:1},geometry:{type:"Polygon",coordinates:["@@KAGE@GBEAGIAQ@QEIMKMGECGCCCAG@EBGBKDIDG@EBGBCBGBG@E@E@C@C@ADAD@DAHAHABADCBCBC@E@IAA@CBCB@D@BBDBDBD@F@BABABC@G@C@GACBC@IDG@ECGEKCKAC@C@AAA@A@ABA@EAEAAAC@GBIBA@C@@@@B@DFJFJFH@PDRBNAB@DIJGHCHCJCFMDOHCLBF@D@BABABCBA@E@EFADAH@HAJKJG@GDIFILMT@DBF@@DHIDGBcHQHOLCHCNCR@BINOF@@@@A@@@EBIDOBEAOAKBE@IBKDICI@ADCH@HDNBFNH@HGHABKFMBI@IBA@E@]BK@E@BBBD@D@B@DDHBBFBFH@@BDHJBB@BDB@B@@ABB@B@DBBBBDBB@B@BHDJJ@@FFFN@HAF@DBJ@DBLHLNLJHVNXFD@BBTDH@J@HFBBB@DB@@@BBJBDBDDBDAB@@BB@BB@B@@BBDAD@L@D@DDDFDH@FADFPBD@@F@B@JAFBB@BBHAH@P@DA@ACOBEBEDEBADC@AJAFBHBFBH@DADCHCH@D@BAB@H@@BBBBBBNBFBBFJ@@BDF@D@PAFAFEBCBADCDAB@F@H@DDFFBDD@JAHBD@DBFBFBFABAD@BADA@A@AAAA@I@@@@@AEAIBCAC@@@@@C@C@A@E@C@AAAAACAA@A@AC@CAA@CAADI@IB@AE@E@CBSBG@IGKOE@@AEAC@@@A@ABCDED@@A@ACIGGEICE@CRGB@@ABCBAB@D@D@DABAB@B@DBBBBB@BBBBBBABA@CBC@A@C@@BO@MBAFAH@D@BABAJOBIAE@EDAFAN@JERALGFG@AD@NDFJFDDBF@DBF@F@BAB@DABABABC@CBCBC@AFEDCHCJCB@JMLEJI@KEMBIJCFANMHMFQJQHEDAF@F@FADABABCBCBABE@ABADAB@D@BA@@BEBEFIBE@C@CAAAA@AAEAA@CBA@@BCBADABCBABEBEBC@G@CCEAAEAECEAIAEACCACACAE@@CAIGGIAE@AA@IB"],encodeOffsets:[[115351,38013]]}},{type:"Feature",id:"140728",properties:{name:"平遥县",cp:[112.176136,37.189421],childNum:1},geometry:{type:"Polygon",coordinates:["@@IIKESCU@KBIHKHMHIDS@QBQFIBO@IAICEGACCCEGCK@AA@AAA@C@E@CDCB@HCBMROLKFCBMFA@OLEJKJOFS@OBIBKHKFGFCNCNCJIFCAK@A@EDIAM@OAK@E@KCSNIHADIHAH@L@FDFBDBLANF@B@BB@@@N@F@BM@@F@H@T@D@HFBDD@B@HCHABCBIBI@@@CHAFADIHAF@BELAB@B@@ADCHILCB@BKHOFGBABEDEFGDE@G@MAEIA@ECEAGAQAW@G@S@S@Q@OBKDIFIDKFGBA@GDE@K@C@AB@DBF@@@H@J@BA@C@UAABCPAD@F@B@JAJ@B@D@FBHBFBBRJBBBHABABADAF@NABCDCBGBK@AB@@@F@B@D@DBBDBJ@N@BA@CD@V@B@DBBB@B@BHB@BDRBFBBD@BC@EBEB@D@@BDLDBF@F@DABEBAF@RBB@BBAB@BEHCF@DDFF@B@@@@CFEDE@EB@BAFAHBHDBBBFBBHBLBBBB@BFAH@FDL@@@DFDHFDBHFFH@BDFDD@BP@RBJCJCBA@AAE@A@C@AB@BCDEHEF@DAJ@JADCBGBE@E@@AK@EBAFCPCJGDA@@FDFBFF@FFFFD@B@@BF@BBB@BDBB@FDB@DBBB@FBBBBDD@@FD@BB@@BBD@@BDDDDDDBJDB@FDDBFDDDDBDDDFBBB@@DAB@B@B@@FDBBDBNDBBBBDB@BF@L@^AF@B@JAJ@NALEBAHG@GMGAECM@GDGBCJ@JDLCJAF@LAPBFBPAJCFA@@B@@@@@PEJM@ADQDMDGPKRGdGHAJCCG@@AE@CNSJKJEHCH@LIBI@GBGBCFEF@B@DABABA@A@CAEDKPGNCDEDIDGHGJI@CBAAMCQ@OEGEIEI@C@AACDKJGPER@TENGBEBKDaCSACCGBIJENGHGBAFGDIFMDMAKCOHMTEN@R@LEFCDKBEBGAACKKMKEQGQCQAMDKFMJODGAGGECKCGGEE"],encodeOffsets:[[115159,37834]]}},{type:"Feature",id:"140729",properties:{name:"灵石县",cp:[111.77864,36.847927],childNum:1},geometry:{type:"Polygon",coordinates:["@@CIEAECCACAIBOJCBQRKLOJMJMNGBMFIDEDA@SNCBEFOJQJKNGHMPADILEFELCF@BCNALHLF@HBDHEF@@ADCDCB@@ADA@A@A@C@AA@@BEA@AAA@C@@BABAFEBCDA@A@E@AAAA@AAE@CAEAAAAA@A@@@ABAD@HBH@BA@CBADCDAFAFAFA@C@A@CA@A@E@A@AAC@AAACE@A@EEOCAKEMEIAC@@@MBKDOFEDCAA@EBCHABCDGHIJEHM@ADAHCTBLANBHBD@BB@H@B@@BAJ@B@@@BB@@FBBB@D@B@JD@@BABABAB@R@JBD@LFBDBJ@DBBBDFFDFPBLBLDD@DBDB@FBFDBDDDBFBLHPBZAF@LDFBLFBDDBJFLHJJBDDDFBJ@FBLHFD@BBBCBCDIDEDGDE@G@CCCCC@EBGFCBC@C@ABCBAD@JBN@HAJCBGDCDADKJEHCBCDE@C@C@AFINKLCFHBLDPBVHJADACG@C@IFINGHAHDBDFBH@DADADAJEDCLEJGJCDANAJANELEB@JCDC@C@CAABCBCJCLAJBBBBD@F@F@HBBBDFFBDBBBBD@D@N@F@BBBDFLD@B@JEDAB@D@DBDFBHADAH@FBDLLF@NBNBN@RDL@B@B@DIRAFIJGLGTEXCN@JBDADCAICIAGBIFABAD@RADADFBPDJFDBBFDJJBHDH@@HN@BF@NAHABG@ABK@C@@AAC@@A@@@@@A@A@@BA@@CEAC@ACEAC@@AA@@AA@AB@@@@CJKLAJCV@H@F@B@D@FCB@LCDABCBAB@BANAB@BAAGAC@G@AAAA@AA@ABEAAEAAAAA@ABABAFAJAFA@@FADAFB@@BBD@D@B@F@LEFE@EBOAK@AAG@ABAFAF@L@@@HAFA@CBADADAJCL@VEHADCFIB@@@PKNKLEJELEDFDG@GCMBC@A@CEGOGOEEAGO@Q@IBCFABABECG@CEKAGBCBAHBD@D@FKDIHGHGBECIKICCAA@E@YAUCODSAGKGOCQJMJKHIFKJGDKFGBODMBKCSDC@GDE@C@G@C@KDIDE@CAGCIEMDBGAEAAACIAICEAG@C@@B@B@BDFBBHD@BBBAD@DCBEBK@AAIEAACBEDEDC@MCEAQEEAA@GAGA@@SHKFGDCDIFA@GDGAKESCMAUAGCA@IEKCICMCA@CAA@QEC@GAAIACDCFMXODC@CGI@@@@@@@@@@@G"],encodeOffsets:[[114366,37540]]}},{type:"Feature",id:"140781",properties:{name:"介休市",cp:[111.916711,37.026944],childNum:1},geometry:{type:"Polygon",coordinates:["@@MGEGFKFKGUKSKICEKFIFKFMLOL@@A@EJCDGBUFK@IDCBCBAB@DEBGB@@K@E@EBAB@BBH@BBLAP@FEFKFE@A@C@C@AA@@EACBEB@@EBIBEBABAB@BBBBBFBBBAF@BBBB@BB@B@HBDBHABA@MBABA@ABADCBKDA@EDC@A@E@G@U@IDKBIL@D@@A@@BBB@@BB@@BDDF@BBDDF@@AB@@@B@B@@@@@BD@BB@@@DAL@BAHGBMBE@@AGM@@CGAGIIECAAECCIAOCECBQBC@ABEBAJBHDJBJCDCBIAM@WDSFKHIHEJCLDJFHFBDBHDTJJBFBRDFBD@DBF@LDJDLFVHJFFBHFDBCHDBJAB@BAHEDCNEN@FBLDAH@LHDHFNDJFDHFHJRFB@FB@@D@F@DBBFDBFDFBB@@@@BBBB@@@@FDFDHFD@PFHLDPBD@DBDBH@B@B@FBB@NBF@HFH@BDDBHBDBDHHB@BF@BFNBB@@VBD@B@@A@I@G@@AE@CBAD@L@F@HCB@HALEJCJELCPAR@T@T@H@X@RBHBFBFDB@FJNBH@F@HCFEFCBAHAPELG@ADAJKDGBC@@@ABAFK@ABEJGBCBEDG@@J@JADABADG@G@ACCEA@G@C@S@G@EN@@A@E@M@@AAA@E@BMAKACCE@E@KBGJGBCJGTMCCGCCGGKKEGAG@IEGCCGCGG@IDGDGEKEIASCK@YAQBM@KCAC@IFKHIJILKJIDADC@C@EAGAGEGMGGGCKHIFCHEJIACGGCEACQCKAU@OHMFO@"],encodeOffsets:[[114718,37773]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("临汾",{type:"FeatureCollection",features:[{type:"Feature",id:"141002",properties:{name:"尧都区",cp:[111.579554,36.07884],childNum:1},geometry:{type:"Polygon",coordinates:["@@YACCEMG_@@IIK@MAMHMTABCFMJMLKHG@I@UAKAIDKDOD@@EFCDCFCHAD@F@B@B@D@DEB@BADA@AFCLEDGLI@@@@@C@ABC@GA@@@@WA@@@@QA@@M@I@GFCHAJCPAFEJEJIFGBKDGBGBI@EDKE[@MDCFADEDEDEFEHA@CBA@A@A@AAACA@GECACAC@OFA@IBID@@@@A@SJIBQFA@OBKBEBGBUDQDQDODIBC@EDGDAFOFGJEJGFAD@@@@@@@@IL@FAHGNAJADINKJCDEDKBMCIGCCEGCCG@KEKDC@EBOFWL@HUCUBKAMHAPSBQ@QHA@MHIDKHEJJDHBLHPPIZBFDFHDJFJJLJDJDJ@JRIV@JGX@TFBABBJDTDJCHCRINGPIP@JTDDTJRFLJNHXDRDTHDN@JAJCJCFCFCPBRNFRJDJDJJJFHX`JRHLLDLENGNCJC\\A\\@D@\\@LAF@RE@@@@DAFA@@@@DCTKFCFALEPKFCRODC@@@@FGFGBCFEBEDEDKBC@@@CBIBCBI@@B@@A@CJKB@HINGHCFAD@FAFCFABDB@DDBBADRFPABA@@LERGHC@@@@FADALGFIAI@CCG@ACGAEQICAKCIAECAAA@AE@@HOJGFCFAFAHABAD@DADAB@JAD@J@N@D@TDH@NBPAF@F@@@@A@AF@@A@A@GBI@CBKDODMAAMACABCCCACHEH@DBNCRKFCJCHAJAD@F@F@J@FBH@ND\\BPBFFJFTH\\EFAFAF@F@RBLBLBNBLBHBH@FBD@@QBO@EAKBO@CBIAOEKIKKGIGKEECSGECA@KC@@@@CAICGCCAIECAWIUKKGA@AA@EAACCACCUAGCI@CGU@@EICK@@CM@GCMBC@AEEEAG@"],encodeOffsets:[[114375,36794]]}},{type:"Feature",id:"141021",properties:{name:"曲沃县",cp:[111.47586,35.641086],childNum:1},geometry:{type:"Polygon",coordinates:["@@IHEFEDMF@@MBOFA@[DCBM@E@G@SGIAQEC@A@GAIA@@UAMCGACBCBFLDFHNDTBXBF@HBF@H@JAFEFCDADGNAFCJDD@BAFABADAHAHBNBJFLDLAB@@IAKCIAIAEBABCBIBCH@LA@GDCBEB@@A@CBK@CBC@GBGBG@GDfRFHFNDFBDHRBBFLD@@DNPNPDD@HJJBDHL@H@DGP@LFFJBNBL@NAN@DBHDJDJHFDHDHDTDN@B@R@DBTFLJJFHLFHDDNRJHVHJFPITUBQD]CSCO@@ESESAEAEBAACAQ@I@A@CBAFBDAB@@EEE@A@AB@B@AAGGAA@@@C@@AAABE@E@AAAAA@AA@A@A@@AAA@@A@A@A@@C@C@@AA@@ABAB@@A@G@ABC@@AAEBA@A@@A@@B@B@BAD@B@B@BBBBD@L@BBBDFDHBF@D@BA@A@ABA@ABADAB@D@FBH@DAF@BBB@DADCD@D@D@B@B@BC@AAE@@ACAA@@CA@@AA@A@GE@AAA@IBA@AA@@@@AAB@@A@@C@A@AA@@B@B@@ABA@ABA@@@@A@AB@@A@@@DC@@AAA@A@CBA@AFA@@ABABC@@@A@@A@ADA@@@@@BC@A@@A@@@CB@@AACC@A@G@GBCHO@@@CBABABBBB@BABB@B@B@@@BBBBB@BA@AD@@@@@@BBB@@BA@C@@@AB@B@D@B@BA@AAA@AA@@AAAA@A@A@BA@AB@AAAACA@E@A@EGG@AAICAGICI@CACAIAU@C@MAOEE@AACAAGGIKCSBQ@EOE@@GCA@C@ABAB"],encodeOffsets:[[114247,36410]]}},{type:"Feature",id:"141022",properties:{name:"翼城县",cp:[111.718951,35.738576],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAGEGGCCEKFSLWFCHODGFMHOBU@KDQ@QKOQUSSOEQBuG]@YD_DGLQB@FBF@NAHEH@JEFGBAFCHE@EAKIC@CBK@E@CH@B@BBBH@FBBHDFDBJABBBBAFDDH@DDBJAFABAB@F@BCJ@H@DDHFBJAB@B@@B@@ADAD@LBBBDFFDB@B@BAF@@DDBF@D@@F@DBLDD@B@@BD@DBB@BAB@@ADBB@FBFALKD@BDDHFFLHBB@D@FADBBBBH@@BBD@D@BAB@B@@BD@@CBCBEAC@A@CDA@G@A@ABCDEBE@IAQBGBA@ADAB@@A@C@M@I@EBABADIJCF@JBHBJ@DFJLLRFJHBD@BO@KBKBIBICOCSDA@[DaFUDIFCBSTKHIHIJGFADEFKDEBM@EAC@_EA@CAM@E@CB@FBNBBEFIBGCGAC@@EAAWKMBABMBG@CBUDQBHH@F@B@FDBBBBBA@@BABB@B@B@BB@BB@@BBB@BABA@C@A@A@@B@@@DAB@@AA@A@@@@C@@BABA@AAAA@@A@A@A@BA@AAAAAABAB@D@@GPAD@H@H@BDDBB@@DA@@B@@@@BAD@@@@B@BCB@@@@B@@ADAB@BB@BEB@DAB@B@BB@@CD@@B@@@BAB@@@@@AB@BAB@BA@A@@@BBB@D@@@@BA@BB@@@@BBB@JAB@BBF@@H@BBB@@DB@@BBBD@@BF@BADA@A@C@C@C@CDCBA@AAE@CBG@EAC@A@CBAB@BAB@B@BABC@E@GAECACAAK@C@AAAAA@A@C@ABA@A@@@@BB@B@FABB@@AD@B@H@BA@AB@BB@@BD@D@@@@B@B@BB@BB@@@B@BBBB@BBBBF@F@BABB@@@D@@BBHHBBA@A@@B@BFF@FA@CBEAAB@D@B@JBRBDABBFBFFTFT@@DPDTC^ARSVOJJBXBB@R@LERIHCTIHCRCLAJAVBPFFF@HD@BBBF@HNAZGHATEH@DA^CHAJ@HAJ@HAHAB@FAlDPE@@@IOE@C@EVBPGLC\\CNGHAPCHBJDLJFJLHJHNHDAH@FAFABAD@B@BBBBBBBBD@B@D@DEDABAFAB@@BABCFQP@D@BB@HBFDBADADCDCBAH@BABAPA@@PDDDD@F@H@FBDDDBD@J@XBbARCLANCLIFIJGHCHCCIECAEAC@CDKJMRQHIHODSKMSI[Q@AEKFQRQFICI@AESGMQKQQAAKK[QQCEA[CIG@C@KAYIKKIKE@IBEFSBEB[BaBAHEN@LK@CCOCQ@C@GFaGG"],encodeOffsets:[[114649,36375]]}},{type:"Feature",id:"141023",properties:{name:"襄汾县",cp:[111.441725,35.876293],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAABADGAG@ACCCECAA@@BAB@@CBCBEDA@BDALGJEDEF@FBF@JALAHIFE@MBKAICEAE@KAOGOMQOGMGCCAMAGBC@GJCF@@AFGJILADMFEBKDOFC@I@GDCFGDGLELAJBD@H@RAJCFAJADIHGDA@GDGFCJCPCFCHKNOROJUHHJPLJJHHXAJ@N@^LFDFDBDNRLNLRHX@P@BAHHPlJHDBPLFFRPFLDH@BAD@J@@@DAL@B@D@L@DFRDHBBDNBJAH@HCJEHCPBFBHHJJHHFJAPCRCRCVCHAFALAPAB@REJATIB@@@@@JCJAB@PED@DBDBHFB@BDBBB@B@B@DAB@FGFEFCFCBCDENC\\@LFFCJ@HAHALCHAJEFIFIBEDOBIDGHEJ@N@@@RB@@@@XB@@@@HBD@BAD@@@@@J@HKFCDKBEB@BC@AFA@C@C@A@A@EBCDGDEDCFE@@PCLCJCLBVBJ@H@LGNKNIDEBANSNGGQ@G@@BI@MTANEPCFCJCBG@GAEAAC@@GEEOEUAIBKBQDGDSJGDQJKFQ@A@WAIAIEUGIGMQCCEGGKIEKISECAQ@A@M@SCGCGCECIGICGCCAM@MBK@MAIAEE@KHO@C@GGKACII@GCCMOMO@CC@EKAAGQACCEEMEGeQECK]AAAA@@A@C@A@AA"],encodeOffsets:[[114035,36540]]}},{type:"Feature",id:"141024",properties:{name:"洪洞县",cp:[111.674965,36.253747],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@E@C@IBGBIDEDQLMDCAG@GFBDDDADDBNBBBCNCPAL@DAJ@H@B@BE@@B@B@@E@E@OBMAG@SCC@M@I@C@IBA@CBCBC@ABGBEBEBEDIHGP@@BFB@BBFDJBLDDBRJBFDH@BDH@DBJEJKHCBEB@@@@GDQHKF@@ABOBQEBCAACCA@ACEBEDEBC@EBGDMHGJA@IL@D@BA@@@AJADAJ@D@@ADCLCFAFEFADEHEH@@@@CDQPEDOLKFEBEDSLCD@@@@EBCB@@@@QFE@KB[@C@[@[BIDMDMHKFALBV@RBFBHJNPFZNBB@@@@DFGLAHLNADCJADGP@F@LFJTRHFJJBFBDERARKZMLMJ@BIHMTDLPHDDHHHNJLHNBLHMJQDGAS@@^GNMDCHELGPIFAJCXID@DAJAPAVHPBVQD@HBB@X^D@FBXDTFN@@AHc^ABEFILIDGDCFYD@P@@BDFPDNPPJDBLFHBTJNDB@L@FBF@LD@@BH@H@L@B@LADLAJCLAF@H@B@JEF@DC@E@AAG@A@@A@II@CCI@CAEB@VINEJAB@\\INIHELE@@PBHBB@BBJDN@BBZD@@B@HBXDRCJIVS\\AVBJFRGLELGHEDGAKACCCIGGIIIIK@ACK@KBIBIBOHWHCDCFEJIFGDEDK@KAICM@IAGFGBIDI@EAEAA@C@CBE@GAGAA@@A@C@EDABEAIGGE@E@@DABCAI@CAGAAEAAAAAAMCKEMCKBABIJENIJIDAXEFMBCKK@G@@RMWKAC@CCEMSKGAA@AA@@@KIMMAAMGEEGGQKOAOA@@C@EAG@GAKAMAKAKAQAE@E@EBEB[FSGIEEEOA[AMCG@EAI@"],encodeOffsets:[[114372,36962]]}},{type:"Feature",id:"141025",properties:{name:"古县",cp:[111.920465,36.266914],childNum:1},geometry:{type:"Polygon",coordinates:["@@MCAAMOOMKKMQEGGCM@QBOEFIKAEBKFSJGBOFQHGFODOFOHGDG@KEIKIEIBEBIDMDKFEDIDMBI@O@KBIBQFG@CHBNDJCHKBQACBE@MDMDKACCDMEOGGOC[BE@APBL@FAP@R@@PBPBRLHHFFNHBBNNLJ@@B@@BBBLHNTDF@DBDXLQN@@@HLLADENWFCBIJMJIFAJABDLFNDLBNBBBBFBBBBH@DBJADCB@@@FHFJHFBBAFCD@B@@@BBBH@HAF@D@DBBBF@FCJAJEHBH@JDNBJ@LCLCFEHIJEFCDGDGXAPAJAJ@LDL@BJLJJHJJHDDBDBLCHGFKHKFQHBNDPHRHVJLBDJPDHJHDBPJJFNBRDTBRHNHDFHPHRJLFFFDNJDJ@NBNDFJFLDTBZBR@N@VBNBFBDBADJELCJCJ@PAFC@@@@DAFCFSBEDGJOFKDAFILILEHEFCHCJIDCLCZGPGFKFKBKFOBGBEBGDMFIDMFIBEAAQ@O@KDGFKHILKJEFGLMRIHKJKJODM@W@AC@KBCDCAG@KBK@MBMBSDMDKB_HOBOCKEKKGQGSGEIAEEICEGMMICGc@CACAGWCAG@GEAKBGLKT@ZBPDJNPNFEFGFMVKJKDEPUNIRIDGHGB[HSHSHQDKHOFSDG@CHQBSBQFWHQPCFEFSHKRKJIJ_EMQOCIEEMASAOCYGQD"],encodeOffsets:[[114818,36952]]}},{type:"Feature",id:"141026",properties:{name:"安泽县",cp:[112.250144,36.147787],childNum:1},geometry:{type:"Polygon",coordinates:["@@BK@KDKDOBM@IAO@C@MEEACAG@EBECM@CJAHO@ADK@G@CAGAGGGCAGEMEUIOGGCMEECGCMCGCMGGIAC@@@@CGAEFILMDK@IAI@GFIL@RAHAHKDI@E@G@KBO@EBABEDOCUAAEEAAQM_MgOeM_QKESK@IEIICMAKI_Q[AOBUDMBSBOAWII@UBYDUHUDWAKEWEO@QFQH@BCAE@KBGD@BGBGFCBCDCBCBCBC@A@AAECAAACACA@@@E@C@E@E@AAC@AAA@AAI@I@EBC@CBEDFB@BALAD@PEJOFGLEJMVMPOPQNMNKPILGFCJFDFBLH@DCJDN@FGJOLE@MCEJPFRAN@HDFHNRLLPNNPBBNDRCZHPDTBNBFFDJRPFNI`IJQLGLETEFODGREXARATGR@DCHETGPCLGRGTGTA\\GHCHQJMJOVCFILULENEHEFOMIMOCYAS@KLAHBLHFH@DBHXDBDBd@DHNJHNDFFJBFFJTHRHLHFLDLAPGPA`CLCNATAN@NAL@LBHCDAD@LBDX@N@PCLILIJGNQHKFELIJKLGHELCP@R@BBHOHOBCFIFKFIJEJI\\@T@XBL@H@RIHEFCLIXYRKPGNGNELAHANCJMFKDGPEF@TBV@J@F@RBVAVAT@P@TAFAFCDGDMAM@GDCDADAD@B@@A@AACAAAAC@A@AA@A@ADCJGDEDCBABAB@B@HFFDD@@A@AAAECEI@GACBEDK@AAAJELAHAPCPCD@DE@@@@B@J@DAJ@FAF@FAN@F@FAD@HBHDFFFFFFDDFDDDD@FB@@D@B@@CBE@KAA@EACCKCMKMAOAI"],encodeOffsets:[[115263,37095]]}},{type:"Feature",id:"141027",properties:{name:"浮山县",cp:[111.848883,35.968124],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@OFkCEBA@GBGBI@GBI@GB]DCBG@SFGBYHMBAHIDEDODMFSB@NAJ@@@HHRNBL@JJ@@H`FNDDZBH@FBFF@BADDN@HDN@@DLFJ@@HV@DDJBHDVBDDDBB@FBBB@LHVLXJDBJFDBHDJDDB@@@@LDB@FDTHFDLFJHLHJLFLBPAJ@DF@\\APDHHFPCNDDLBNCNCF@DARBLADGCIAMDGH@REJALAP@J@NAJCFCLENCJCFAJAJFJLLFH@HCPGPEPCHERGPEHATILEFALBNDF@PKHI@ECMDI@CKGEAECDIHEJKLONMRMPONONUFIHKPEFI@OBCBK@AEAAAAA@CAA@CBI@C@AD@J@BAFCBGDWFI@CBAFBNJHDD@F@DABADCDEBCBABAHCF@TQRGLMFOBOMKG@OCcIeASBG@I@UDSB[JOFOFOFYLCBA@GDMF_\\IJMEEICWBOBKGDGDIHEJKJMDKBQDaBWAI@C@CACCEAG@E@C@CCOC@@OBABABG@ABCDCDCBABECGAA@@A@CRODEBA@AA@EBABCBCFC@A@C@AAAAAAAAA@C@ABEBEBG@CBMGIGKGEIKIICGAODGBMH[DKDOHUA@F@DPF@J"],encodeOffsets:[[114481,36705]]}},{type:"Feature",id:"141029",properties:{name:"乡宁县",cp:[110.847021,35.970389],childNum:1},geometry:{type:"Polygon",coordinates:["@@BEDUDCAKIOOIMEQCKIMMMAMDIHMLGFQLeHULIBGBMAMBMFIAIBGAMD@@KD[BC@MBGDA@EDINOJC@C@A@SE[CSCA@GAGCGAUIEFCD@RJPB@NHHDDHCJKDEBCBQAC@@B@@@@EN@BMNKFGDUBSCICEAAAAMACAE@ACCCAAAAACCA@@AA@AA@AA@A@@A@C@@ACCC@A@AA@C@@AC@GA@@CA@AAAA@ECOIGAEEAJAJ@@@H@F@T@HABAD@@GNIJAF@FBHHHDF@D@F@J@DAFENABELEDIFEHCF@HAJ@D@H@B@DBP@J@DBF@DCDEBGBC@EAGCGAE@IBKBEBCB@D@@@@@DDFBF@JBBD@FAF@DADABCBAB@@@BBBB@@@@B@@ABA@@BAB@@BB@@BBB@@BB@@DBBAB@D@B@B@B@BABA@@B@B@@BBB@B@B@BA@A@CA@@@@A@@B@@@B@@@B@@@@A@A@A@@@@B@@@B@B@BB@BB@@B@B@@ABA@AB@@A@@BB@@@B@F@B@@@B@@B@@ABAB@B@H@B@@@BBB@@B@DBDABBBBBB@B@DABA@AB@@AB@@@BBBBB@@BBB@BBB@@B@FBFBJDBBHAB@F@D@D@BDD@BB@B@B@@@BBBD@B@B@BBBB@@BA@@@ABA@@B@B@D@B@BBB@DAB@B@BBBBBB@@B@B@RHBBJ@B@DDF@BBDFB@B@DCB@F@D@@B@HDDBDBDFBDHHFHALCNCB@ZB\\HRHNJDJBJJTRNXHNHHF\\JNJJFJFBRCPCNCVBLHHHDRHNDJNDRLRLHFFPDP@NMZMVHNHLFNCRAXAJBNBHFHHNLHBVEPBVTRHRBLAJGJGPGNALD@@@@B@JFHHDDPDBN@DBPJHLPNBH@D@FILGJCNGB@RGR@TABONGLBVAVD@GXKPEFAD@LCLFH@DDFHDDJHNDLAFCDCLIJMBCBIHMBG@EJK@@@@@@@@BCHEFIHIPEBEHCFCD@GEIGGIAGAEDOFGDI@GBGAICMAACGEQ@C@K@C@ABK@C@@@IBC@ACGEKQOEEOKCAIGkGOBG@A@OGWKQKMMQACECEC]KM@I@WBGGIIOKGIGAIBIA@@@@A@ECOIIMGIGIMUEIGQEEIKIMAOGME@GEM@IAO@IBQAOEM@EHCP@F@NCLORAJEN@L@NMZABOCKIIMKQD_CKC]@Q"],encodeOffsets:[[113613,36599]]}},{type:"Feature",id:"141030",properties:{name:"大宁县",cp:[110.75291,36.465102],childNum:1},geometry:{type:"Polygon",coordinates:["@@EJIDKFSB_DGBIDODED@@@FAN@H@DBDBBBBFDBDDDDFHNFHBF@F@D@BBJDL@BDN@D@B@D@HCFGDADGFGFELAF@JDFFHVPNJJLDJBHAL@DBVAJAF@B@LBTALCFCD@BKNB@bJBADA@C@CAGEMACBAB@D@FDDBD@BABAD@F@B@B@F@B@D@DDB@B@D@DAH@DARKJCDAD@HBNBFBBBFBD@D@FBDBB@DAFCJCFCBADADCDAD@H@D@DAD@JBJBH@LDRFLFDFBLBJBH@JRNFBDDHDTJPHVJJJPDLBFDPDFD@J@JBFBFDBFADIJENDPIHCJEBEDGTMJCJCTEJARERGDMJSDMDICI@I@EAMBMBG@@GOJIJCDALGFEFIFSDMDGACAE@MBCFGFOFMJGBAFGFGDIAGCEIGCIBKJK@@HONOP[JOLSDEDCLCNAJ@NDNINOLCLCT@X@FAFAHCLGJKFEHKBKDIDCI@G@KBIBC@MDOBIAODO@UAUAKBIFAL@HEBMDELGPKAIIGGEKUI_HMFQDMGIAWHMJLLJPALAHEDKFIKQE[@Q@IAK@SEEG@GDE@G@AK@MAQ@UAWCICMCICU@ODMLA@QLEBIFOHWJUHMDEBUDWDQBQBS@IAIAWEQCEB@@@@Q@GLAB"],encodeOffsets:[[113181,37205]]}},{type:"Feature",id:"141028",properties:{name:"吉县",cp:[110.681763,36.098188],childNum:1},geometry:{type:"Polygon",coordinates:["@@XFJBJBT@RARAXCVCFANCVGXIPGJEFARKB@NKPCV@JDNDJDXDVBR@NBL@@B@HCF@HFHTFL@JBR@\\@RFJLLEFCBGBKIOKKNIXGJBNHRCNE`GVJFLHHJJLBHOFKNCFA@GBKJELAVBVBP@PCJBPANCD@JALAH@J@LCLGNGHCJKFIDEPBRDB@N@NIHEJIHONGNGJANGB@JGDEBA@ICICIKIIIIEGCCEAEJYOOKGGAICC@G@MAKOIGAO@CAMOCCCGGIEA@@@@@KCMBOHIHIHKBQAQGUSOAUFGAMKGGGEMAIAWBQBMDKEMGUGYNMNO@OCEEKGKQCQIMMCQGGCGGAKDUDMDOAQIEIEMI[IGEMGWGQMISAICIMIQG[GYAA@MDKDGBGECGEAACACCC@G@AC@E@A@CDA@A@CEAAE@CCA@I@AAQGA@A@@@AAAAAAA@A@CBA@AAA@C@A@A@@@AB@B@@AB@@AAAAA@A@C@AA@A@@@A@AAAC@ACC@C@E@A@GBAAICEAEAA@@@AA@AAA@AA@AAAA@@A@@BA@@BABCBA@A@AAAABAAC@C@AA@AA@@A@G@A@A@AB@BA@@@@A@@@A@E@A@@AA@@@BA@@BAB@BA@A@@@AAA@@A@A@A@@@A@@B@B@B@@@@@@A@@@A@@@AB@@@@@DBB@B@@A@A@AAA@AA@A@@@ABABA@A@A@C@A@ABCA@@AA@@AA@AA@@AA@AB@@AB@BA@@@@@AAAA@@A@ABADCBCBE@EBC@@D@F@FCH@D@DBDBDBD@DBFAFCFEFGHADCJADAJBDBDBBFDD@DBBBBB@@BB@BBB@J@D@BBD@DABCFGDEBIDCBCBAFCFCHAB@@@DDFHLBD@DAFCDAF@BCLAFBFAF@FELCJEHCDADAFAFABADCBCDCDAFAB@@ADAD@B@B@DDF@BBD@FAD@D@D@B@@@BABGHEDCFCF@@@BADAFAFAHADA@@B@@BBAB@@@B@BAB@BAD@DAD@D@D@B@F@B@B@B@B@@@B@DAFAL@B@BAHBLDJFNJPDJ@H@B@HEJEFAH@HDJDFNN@@DFHJDDFJ@@BJDH@HCJ@F@FBJ@FADCFCFABCFAB@B@B@DBFHJDF@DABAFABKJEJCHAH@HBFBDDFB@FJDD@DFCPCJCHA`CTALEJCFIBAHKR@@@@@FARD"],encodeOffsets:[[113207,37199]]}},{type:"Feature",id:"141031",properties:{name:"隰县",cp:[110.940637,36.69333],childNum:1},geometry:{type:"Polygon",coordinates:["@@ECEA@@@@KEICI@GABDCHCNETEJEFKHCBIDIJHP@@AHANBN@F@JDJCJCNITCNQHQFIBSFIDIDSNCHAFIFGDOJBNELDRL@RDTH@F@DGLAPNBTHHFFNBRAT@PETETHNDJCLADCFADGAEACBABADBF@DHTPNHBDJDHDJCNGLIRETBRFRJHTLPFHHFDBBJLDLGBGD@HNBH@JDLDRDPHNFHBRDH@JBBFBH@F@BFDNBPDRHH@HLLLNCB@NANCRIHAPBLDJHB@BBDF@BEJEJ@F@JDFDBD@DAJCFE@C@KBCDEBA@AAACEC@CAAA@A@E@AB@B@BBH@B@FBB@B@D@B@BB@B@DAD@B@@B@@@BABB@@@BB@B@BB@@BB@BCD@H@B@@J@N@B@@@@B@B@@@BB@B@BBBF@@B@FA@@BBB@BB@@AFA@BB@@B@DAN@JBH@LB\\BBABE@@BAB@BC@A@A@A@AD@BAFA@@FG@A@A@AAA@@C@GAC@AC@@@@@A@@AA@@@A@AAC@AAEBCAE@A@ABAB@ACECEACA@AAA@CCE@CAI@G@A@@CA@A@C@CAA@A@C@A@@BAF@B@BADCJEHEDABEBAFADAFCD@D@BDBBF@F@DCJCB@FDDDBBB@DBHBJFDBH@DAJAD@F@D@F@DADCD@NBJDDDDDD@B@BAAC@ABAB@DABCACCA@A@C@ABE@ACA@A@@BC@CBADA@A@AFCNKLKFYFOBWPQGaNIDCR@DAd@LEFKFEFIAGECGAEC@CDIHQLIPEBGWQACCIIMAQAKAQ@EBE@CACBC@EBEAIAIAA@AECUEKCSGMKKKGKACEIECCCSE[@UBWBQASKe@GPW@C@MAKEEAOAO@E@EBGDCAWCQAE@KAE@O@E@KBKAQCMGEK@AGIACKGCM_ISGMEMIAEIQCAIEKG[E@YNSCEEEGG"],encodeOffsets:[[113554,37340]]}},{type:"Feature",id:"141032",properties:{name:"永和县",cp:[110.632006,36.759507],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@C@CBCDCBABEDIDEDCBA@CAEAC@C@EAAAEAMAGAC@CBIDQLCBG@CBC@A@A@CCC@A@E@A@A@E@C@ABABC@CAECC@A@ABBDFNBH@D@DCBABaIA@CBAF@FBJFPHFBHBDCJCBGDGBULEDCDABKLQRCDKPQ\\CJGNABCJGJGHKFSHOJIHCHAH@HDH@@FDHDF@F@F@FCFEFGFGFEHAFAFBFBBDBF@HCHAJAJBHDH@@FFLLHH@@DFBF@FADCDEBE@GAECEECECGCACCEA@@C@EBEBAD@D@DDFJFDDFDFFDDBHCJCFGFGFMDMBI@O@KBEBAB@@AFAFBB@@BBFBF@FALCD@D@FDDBFJDHHNFNDPDL@H@D@@@BCDCBG@GBEBABAD@B@DDBHBFBHFDFDHBT@HAHGFKFKFMBO@K@GBGDCBCDAD@DDFFDF@D@D@N@LBFBHFFFBJBF@@HANC@@JGHIDED@F@HDHFFBDAHCBAFBD@DAFEDADEDG@AD@F@HDRFJAJ@D@DADEFCD@P@D@HAFAPADADCBGDEDCDABBBB@FAF@DHDLFD@D@BABEFEBAF@FB@BBAB@DG@@B@\\HH@FBD@BD@DNHR@PCRAJFFDBDBF@FNRAN@J@J@LFPJJJHFDN@RCP@LBJBH@LFNALGDGBGBGFSNANCJABAPCNANEFEHUHSHGHGNGPAJBHANEHCLAH@@GHCHACKIKAAECGGOESKIGEQAQFSJQHKDMCICGCIGAOMGS@CAEBCBADAFBHBBCDEBCDKCIGMFSFS@OBSAQEMGESGMABOHK@C@ESGQCK@CQFKAMMCIFCJEBCAAEAE@I@IECOCECKAOCIIUIOGSIGCCCEAQM@IAGAIAKCEKEQEKCG@IAIAC@CBC@"],encodeOffsets:[[113272,37399]]}},{type:"Feature",id:"141033",properties:{name:"蒲县",cp:[111.096439,36.411826],childNum:1},geometry:{type:"Polygon",coordinates:["@@SCICAAABSEW@IHU@QJABCFIHA@MHIBMHMHGPIJGFMJM@A@QCOACFEJILGDMHKHKDCDCJALGLEFILKHGDEBEBW@S@KDKDMPMJMCI@MBKDCDCFKTIPO\\MPGP@@ILALDJJHDFBHCJEHEHABIHENEPEHAD@NBFHBJ@JDLF@@@@FBFDHHFFDFMT@Z\\FLHJFDBJRBFNJNFTH`JDNLHBDHJ@BFLNHRDLBLAF@P@F@LBF@RBXDDBHCFAF@P@PBFBLFNBD@X@HOf@TLRBXAVA\\@TFDDFDFJBDHLLLNLTHLDVFFD@BBBBJBJ`AHDHBDDDBJCTCPAPBRBPCNEHADAPCVITKBARODCJGHOBEB@DOBK@O@IAG@CAKGMIKGMGGCCOGCKNSJG@ANINKLYBQFQACAEIIGESQEI@K@EHOBCDIBCKMBGHKCE@@@@AAYMOEIMAGAE@QAUBKKCGKIQW_EGIICICIQIMEAQDODEDEDIBI@ICMSGQCWCMGKIQESICCISO@OJMHQJGDID"],encodeOffsets:[[113864,37080]]}},{type:"Feature",id:"141081",properties:{name:"侯马市",cp:[111.372002,35.619105],childNum:1},geometry:{type:"Polygon",coordinates:["@@CEEKG@IBOEKCGFA@GDCB@BAD@F@BA@@@A@CCEEEAIAGCAAK@K@ECCGCAC@KC@@GCCBEFKBEACACAIAEGEIKEMEIA@@CAC@IAIBKB@@SPEDGFABKJCHJLJDDF@@BDCHCFCBGJIPEL@NANBHBBBP@JAB@DBBFDDBB@@@B@B@BA@@B@FDB@B@BABC@@B@D@@B@BAD@B@DBBB@H@LBDDBB@BAB@B@H@BFB@B@B@@ADC@C@EBAB@D@B@DB@BB@BBBFDDB@@B@D@B@B@BBBBB@@BB@DDD@BBDBD@B@B@F@F@B@DADCBA@@FC@A@CBAFCD@B@BFDBBB@@B@FCDADA@@BA@AB@DBDFDD@BBHCHABBB@BBBB@D@B@@@BBBBL^FDHCH@HAHAD@DAL@DAB@@@FADAHCB@@KDGJADABAFAJBJBLDJB@@BACKEKAIAMBGBGBCBABE@ACCDIBEHMBCDCFEBE@I@GAE@GAEAWCSGM"],encodeOffsets:[[114126,36426]]}},{type:"Feature",id:"141034",properties:{name:"汾西县",cp:[111.56395,36.652854],childNum:1},geometry:{type:"Polygon",coordinates:["@@MOOCCE@AO@C@EZCDCHKJEJAF]BGd@BM@SEWCEAC@W]A@GAC@UROAUGOBIBCBC@WJIDEBOJKHGFCDMN]H@@BTCHIRGN@DBH@J@PALCPA@AFGPIHCDQPABSLUJODCBGBMFODQAOAOBSDIDCACCGAGC_BAF@FADBD@DAF@FBRBLBRJNDJBDXRAHOFKJGRCJ@DFDHBFDBHEJEFELKFc@CBLNRTJBH@PAJ@LBFBD@BA@A@C@E@ADC@AAACC@CAEACBAJ@PABBB@BBB@B@DAFAF@J@DBDBFDB@DEBAD@BAB@BAB@@B@DB@FB@B@HCL@FDDHBB@BCHEFALBHBNBDBBB@D@BCBEBA@@BDDDFBBB@B@LC@@BBBFABADADBFBBDBFBLDHFFB@JHFNDBBPFJBFDHDBGBCN@FGJIHGDCBADGFAB@DBFCPELCNA@@D@JBNFLFDBFP@F@BDFBB@BBD@B@B@F@BDBB@D@B@BEBEBEDCBCDAB@@AAG@GBCBA@@B@B@BBBBBF@DBF@BBBBBF@B@B@DCFABEBA@AD@B@BBB@AF@@BBD@B@B@B@BC@@DADCBC@@FECGGAE@GKBKDM@ADEFKFEJKBCNOHGLMRIPIFEDATMB@FCJCNEHANMNIPILKRQDAPIJADBEI@@@@@@ACCACAC@C@KCSECAIALQFEBCDEACGABEDELEBQAM@ECEEEEECEKIAAKIAAA@EECCACAIHIPED@FAFGHGDEFMDEBCHMDC@@HINAF@BC@K@A@K@GAG@@KCE@EAK@A@MCSIGAKECAOI"],encodeOffsets:[[114301,37341]]}},{type:"Feature",id:"141082",properties:{name:"霍州市",cp:[111.755398,36.56893],childNum:1},geometry:{type:"Polygon",coordinates:["@@[JA@IBMFUJA@BF@DDJ@DJJB@@@@BBH@B@FCDE@IFA@G@E@KBIDKBE@MBGJ@@CDGNADCFENCFGHEHEBC@OFGJBJBDDDFFB@BBLJBBLJDFFFFFDF@FBNARKFCFAFHBBDCFADEFKRJBDBTFLDD@D@DBDBBD@@@@@@FJDBFDFBDJ@H@@@@@@@@@@HJ@DCDWPENCDBDBJHBD@RFB@DBB@NDJDLDJFB@HDVBNBTDLFHBHCB@JEDCHCLETG@@HBHBB@FBRFFBNDD@FCFCDABBJFBBL@FADA@CBCAA@AGCAACE@A@A@AD@H@FBJDJBBDBBBFAHNCJFHDDBF@JCLCD@H@D@F@HCD@TCLDNAPCHALEHCLIJELGNIRIPDLHBHJ@PAHALMFO@QBA@K@AJILGFIFE@ED}@CNIFGBCCAEAMAUAM@Q@YASAKCIECEAM@MCIMIECEEIKGQGOCEMGQGSAQCMAIEOICAIGCGIOACIKGUGQCOAMIEUA[BUTIJQDWCGAA@@@YCAAM@ICAAA@GAOA@@KFGFMJ"],encodeOffsets:[[114421,37335]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("吕梁",{type:"FeatureCollection",features:[{type:"Feature",id:"141102",properties:{name:"离石区",cp:[111.150695,37.51786],childNum:1},geometry:{type:"Polygon",coordinates:["@@KIIGAAE@C@CBABABCBA@C@A@MEC@EBCAA@@A@C@C@AAAMAOCCBCBABE@KAAAAA@AAAEAAAAADE@AAACCCC@@OHMFOHQJUJ[LOFKLKLGPOFSAOA[GQAQJUDQCUCUAQHIJKPGVCLCHEXEVEVCHCHINMDQAGGGIQ@WDQFA@EQKIMBC@MFMFQFEBQDQFCBQDOFE@@@ABE@E@E@KBG@EDKBG@EACCGDE@@BAB@BBBDB@@BBA@@@CD@@EDIB@@I@C@CACBCBIJCDAF@F@BA@A@A@A@CFA@EDGFEHCBKNOHKFEDKFONMNINCD@BABABIHGTAN@DSJ@@ONKJINIHAFCHELAHBJDJFDP@F@HAB@LBHBB@FD@F@DBBB@J@BB@@ABGNAD@B@BD@H@F@F@BB@@ADCBEBGFIHGDEBKBGBABADBHFH@D@DABADBFDFD@H@HDDFADCB@DBBF@JCBBBBFL@FADAF@DBDBD@BB@@@@BB@B@JANAD@DAD@JCB@B@DDFF@@B@B@BABE@C@A@ABAHCDCDADABCBEDC@AJ@FAD@D@B@HFBB@@D@BBJQ@@@CB@B@DA@@@A@AAA@CB@BC@AACAACACCA@A@A@@@AA@A@E@E@C@A@C@E@E@A@C@EBE@G@CAGBETENCLE@@BA@@B@pGXEH@F@X@J@VELAPCVGXENBFMDAHBTDH@LAJ@JBFDFLBFDD@LDDDBRDLBHBBFDHBJ^RTLNJNHTHRDVBTCNATKVIXGNCLOPKLKNCJANFLDTR@L@hHNZLFCFAPAT@JCHC\\ANCDE@@BIAIEM@GBIHITITGNIDKBO@SBILMHODIHK@G@ECGIGKCC@E@MD@BEDINADGFAAEEAEAKAAECEAEMAA@SBKFIBCBCDCFCHEFCBCDCDGJQHMDEBEDO@C@@FMJMLOBCJCNCDAJEH@HEFGDCBGCG@ABAFEFEDGBE@CDE@A@CACAGAK@CAECAECCEAABABCBC@AAACACCEEA@E@A@A@OQ"],encodeOffsets:[[114253,38272]]}},{type:"Feature",id:"141121",properties:{name:"文水县",cp:[112.028866,37.438101],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CK@EBGAEA@AAKAGAAAAEAAGCGAEBABA@@FCFEF@D@@A@E@AJOPCFADCHABELBHBH@D@D@D@BAFAHEFEJCAG@@AEBAAADC@@HAH@DC@BBA@BB@BBB@@B@@B@@CBA@AAC@ABCBAB@BBBC@CB@@AB@@AB@BC@GAC@A@A@A@@A@CCDOTCBAHCDEHFBAHA@@B@B@B@BABEBICODEAA@E@I@MAGDALCNGNGBOBY@E@QEC@EBFTDRAFGFCJBXCLI@U@WAO@Y@U@Q@I@MBODCAGAEAA@ADA@A@A@ECA@A@@BA@@B@@C@A@ABADA@@BEAGBA@IFCDABABCBCAC@CA@@C@ABCFA@C@E@A@AACAABCBGBABC@MAMCIAG@ABADCJCBC@IBA@IDCBCAIAE@EBA@ECGCCAAAAAKCGAAAII@A@A@A@@G@GCA@@AAAAACAAAG@IFCBMDIDADKPINEN@@@DCPAFCFGNIRCHCDADEDGFEDCDADADEJAL@TBBFNFBFDBBBLBFFFBBHEBCJMFC@ANCF@D@LDJHDH@FL@TAL@LAJATEFAJCR@H@LDPDJDB@FBNBR@T@@@TATITIJKHGPMPCL@R@X@F@\\@JAF@JGFAVHZFfHFBLBTFXFNI@A@ALIHIDGBC@CCQEKCCGCECAC@EDGHEDCF@FARCNEHAPCF@DBABELCJ@DDDP@NCJ@H@DBBNFJPFNBP@V@FAPCJKAO@IHCJ@JFHLBD@LFLHFLBR@HCD@FAHCLEF@LBHBFBBDAJD@H@FAD@@E@C@CBADCBCFIHGLG@A@EBABCD@B@D@@BDFBBJCLEBAFCDC@C@ACCCGACEE@CFIB@JIPIRBB@JJAJADDDDFDBFDB@JBBBDBF@DANKHIDGDAFA@@FBHB@@BDAJADCFCF@DDDLJJBHCNALARDRFDF@HCHCHALDFH@FCDEH@HBDHDFDDFBHARE@@FDH@DGJBJ@F@D@@C@@DAH@L@F@@A@A@AAEACBCBEJAR@DCBC@MA@AA@@E@UAKEGA@C@@BCHCHE@@FCDAPAFAHABCAI@AFCBAHCB@FCBEACACAI@A@@CAA@AAGEI@G@SCAAC@WEUMIGMKGKAK@CAI@CBE@GEMEE@@IIGC@A@AAAACAACAA@A@BA@@@ACA@AAAGIAC@@EGEAAACG@C@A@CACAA@ACAAAAAMCCAAAEC@@@A@ABA@CA@AACECCCACCECCAECA@ICCACCCCAC@@AC@AA@@AEC@@CCAAAA@EAACAA@ECA@CA@AAA@AAE@@@AECEE@EEEEAEC@@CBIHODEDAB@FBL@@@FAFAHCDIBI@CBE@GFCFADA@@B@D@BBF@BABIDIDQAO@@ACCCE@AEGGECAGEEC@C"],encodeOffsets:[[114739,38192]]}},{type:"Feature",id:"141122",properties:{name:"交城县",cp:[112.156064,37.551963],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCBCBIIIA@QAOJIJA@EJ@DFFBDDHDD@B@DCDEDABKFIDAACE@AC@A@C@ADAB@F@BKHGHEJADCDAB@D@D@FC@EBG@C@BIACEAGAKAE@KFGDEBC@GDQ@KAGEEK@KACGKIEI@GD@JBPILODEBU@O@MAOEEIAMCAG@I@MDO@CC@CDIFKBACAE@ODGBMFQDEBE@CDGFCH@FBDFDHDDDFLDR@DADCHGJKJ@B@BMJWESEKAEAeGYEUGEBIHE@IB[@E@W@Q@K@ODONGHILSJSJSB@@S@Q@MAEAA@ICOCKCG@Q@IDEBSFIBKBK@SBK@@HGLCJGPKNAJ@TAPCLMJSHSJGJAJ@HFNBJAJ@@CFMD[BGDIDS@OBEBED@@EFAH@LCPEJCJAJHLDVBJ@F@L@D@JCJ@DABADA@A@CDEBGFMFGDCBEBAFCHAFCHKRENBDBBFBJDHF@@DF@DAJAJDJHPBDADCDAF@F@FDLDJFFFBJBlHPFLDLBNBF@T@bCDAFCF@JAFCFADAJG@AB@D@DCF@FAFADADA@ABABE@EAEBG@CBCBE@CBEDCDCB@B@B@B@BBDDBDDBDBFBHBD@H@JAFAFBFBDBDBFBD@H@FAD@BAD@BBDBDBBBB@D@B@FADCDCD@B@D@B@DBFDBBFDDBF@D@HAF@D@D@DBDDBBBDBDBFBBFBDBHBF@JDHAF@FCBADADBF@F@H@BADAF@B@HAH@BABA@AAC@A@CAA@C@CBC@CDCHGDAFCFEFAFADA@A@CACBE@ABADADABAFAD@F@D@D@F@DABABCFADADAHAFCDAFCDCDCBC@ABABA@C@E@CDC@ABADAF@DAD@F@D@DBF@B@DBD@DAF@F@H@L@D@F@F@HAHAD@D@B@FBBBDBDBDBDBDBD@D@FAD@DCFADABC@AAC@CAA@C@C@A@ADCDCDADA@C@ABCAC@EBABCBC@CDGDCDCFCDEBABCBCAE@CAE@ABABBFBJBLBD@D@DABCBCBABEDE@@BCBCBEDCDAF@D@F@DBDBFBHDJDFBFBF@H@H@F@F@FAFBBBDB@@B@BA@ABCBABABA@@DCFEDABAFAJ@J@LBLDNDF@HD@@D@D@F@H@HCFAD@FBNDB@FGLMLMBAB@D@HDB@B@BABCBEJGB@DAD@FALAFAD@D@BAFCBGHCDANAB@BAACCABAFAB@AECIEE@GBIBE@E@A@C@G@CBCLCNG@@DCBC@@BABCBEB@BA@AD@DADCB@BA@@DC@@@@BABGB@@ABGFADANAFCFEBCBI@ABGHS@AFGHCDAHAD@N@F@J@DBTBBBLBB@LBD@DABEBK@I@GKIIICCCCEAAAAEAE@A@CIACHG@EC@@QFGBEACCCECGGAG@CFEDG@CEBKDGDG@GCEQEQCKBMBGDIAKICC@CDEDEBCBIAC@@GAEA@@EBCBCHGJMLCBE@CAAAIAA@ECCACE"],encodeOffsets:[[114828,38381]]}},{type:"Feature",id:"141123",properties:{name:"兴县",cp:[111.127667,38.462389],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAECIAECCACBCCACEIAMCMACCII@GDEBC@ABCB@DCDAHABABCFCBCDCDEFABAFGLAB@BADAFADAFEFKDCBDJBHBDDDBFGJBBB@H@BB@@BBAP@J@BFFBB@BBB@B@DPDFB@DHDB@DB@FABABEDIDE@EBC@KBC@A@GECCMKKEE@CDEDC@ABI@UCIAGGACC@A@IF@@K@QBSGKDYNOF@B]@WFOHOLIPABKJIBGACCAQ@MBCCKEIGGQEQGQGAAOGOISGMDEAEEAEAIMMUAEAOCUGIEGEOGOCSAMAQGAAOEOEODQLSNA@SFOB[CG@KA]MYFGPCJGHMLMLWJ@@E@MBUAYAYEgGUCMBEBCBEBE@O@WHEBADABC@IAGEEACBA@CFADAHCFIDADBDDDBFDF@BE@C@GDGBCFEDA@AAEECCEAC@CB@FDL@D@@ADB@HDFDBBBDBHBNAV@NFTBFDRAP@JBTHPNN@@NF@@TBPAR@JAbAZBRFPJFH@@HLFJLPBBJJLL@@LJJHLDN@PDH@XFHDHH@@DH@BBDAN@@FV@@HN@@TVFT@@DJBJBJDJFJ@@LHLFHBPAT@NCHAJGNGHCJ@JBJF@@JF@@JDLFHDFF@FAHENAJBJ@B@HBN@D@BAB@BCFAD@L@D@@@BFBDDDBBBBBB@DBBAJAD@B@D@B@F@HBD@FD@BDDDH@B@D@D@D@@DF@NAJ@@CL@@@H@JBD@@DFHFFF@@BFBF@FADC@G@E@GBCBKDCD@@ADADAFBDAFEJKLGHAH@@@H@HFFFHDJBFBHHRDHHDJFHDJHDFBFAFEJAFAH@NDJFHHFDD@@@BFBDDBBB@B@B@D@DDHFHLFHJGFEDE@EBEAA@A@A@@@@@A@CIECECIAIBCHGHALCDBB@B@B@LBJ@B@B@D@B@B@JAFCFG@ADCJKDEBADCHEBEBIFCF@F@B@FBF@FF@@BDFHFBJFHFNHJFNJTNRFHDPHLDNFLFJDPDRNHFFDNHLDPBNBRDXD`AJCFCBADGFEFKDKDQBKBMAEBO@@@@@@FKFGNCLCJCDEBG@K@KAEDKFKJOFKFKFIHGFCVAR@NDLDTBTBXHP@DEAIAGDIJCRGHADEFCDCHANA^BpACAMCK@IAEAG@ICE@GCKCCCAC@A@CBAFAFAHAFCBEBCACACAA@@AOAG@I@KDGBGBKBA@CDODODIDIDMDQAK@GAEACCECG@A@I@ACCEIEIAC@CBG@CBEDAHED@@@F@D@FBDADAHAB@DAD@DCFABAD@F@F@F@FAFCDCFADCFCLKJGDGDOHQFQFMFK@EMCOEKGMKAIAM@EAKEMEIKQGEAAOGEAEGGECS@G@@CKACEEIEEAWKECEAKIACAECK@CAIBM@K@IAOCM@CAKBEBCBABC@C@CAAACAGAEAEEI@CAAACA@C@E@CAACAAAACAC@CAC@EBC@CBE@CBCBGFCDCDEDCBC@EBE@E@E@GAAAC@"],encodeOffsets:[[113966,39046]]}},{type:"Feature",id:"141124",properties:{name:"临县",cp:[110.992093,37.950758],childNum:1},geometry:{type:"Polygon",coordinates:["@@AEBCBA@C@CEGAGBCBAHALAFAHCJGHEFADABC@@AAE@E@G@C@@A@ABCHMBA@@AAI@A@AA@C@EECA@GAKAA@GBE@O@ECCIAIBGOB@@MBU@OCMAC@K@ODABKFM@G@GBMBC@AAAA@GDI@GAEBGACCAEAI@IACBCDEJGFC@EBAACCACEAEAGBEAEAIEKEEAA@GDEBCBCAG@KA@DAJ@FADAFEHADCHAFBJ@@DHDD@@FBJ@J@H@FBBBJF@BDFDH@D@@ADML@@KH@@EHKJKFMDC@MBIBSBEDEBOFIFOFKJADABAF@B@D@H@@HL@@RJZDHBFBNBFBFBD@HHHJ@@BF@FGJEFEDQNIFIDEDEB@@MDGBaLcNIFEFCDGDABCDEJAH@@@TIRGT@@ORGJABA@CBAB@B@DBDEFCHGFABGJCHEHEFEHCFCBAFAH@BCHCJ@@MT@@KHMFEDGFC@ABA@A@C@G@@@GBCBIBGDC@CDKFIF@@CBEBABIFABGLADAF@LBJFJHHDFDF@FCH@@GNCFEDKDA@EDEFADAJAFAF@F@F@F@BBNBBJT@L@LCNEJAFCF@D@B@JBJ@@PPRTDHBJAJ@BIRIPCN@DAN@F@TBTBN@@HPHJHHDB@@BBJBHBJARCL@JBBC@@@CCK@EDAD@FBDDFFBBB@FCDEHAHCD@F@@ACEAECCACBCJCDEBGBCDEB@DAFBHFJBD@BABCFAXGP@F@FADAFANAVDhHZFZBVBNAF@@@XINKNKHGDIHOZE^NLBH@\\DPATEB@TMRKPCPFPFBBRHNBTBPDPHHFJFVHPDFBVBNNBJBFFFFBNCTHPJPHBBRHRHRFHHFJDLAD@NBRDDHBJALIBAJOPKPGXE^@@APEZMLCTHRAL@@@JEB@D@BDHHJBVDJ@BAD@FCDCF@LFNLDDHFB@D@LAD@FAF@JCFCBABA@ECAA@GC@CEAOC@C@AAA@AAAEE@A@IBOAA@@AAG@A@AAHIAECCACAGCIDALCFEBEBCBEBC@ABAHKBEBAFEDCDCDADEBABABGDC@CDABAD@FAHCJ@ZCTCVEFCDADABC@ECCACEECCAECEEI@G@MAE@CAEACACCEECCCCCCC@ACAAAAEGCQOIICKCMAMAA@AA@@A@EAACACCIECCCACAA@@AACCE@CA@@AAA@A@C@CDEBG@A@AA@CCAA@CAA@@ECAAAEAGA@AAAA@A@A@C@@EEAA@AACAEAA@A@C@CBABCBC@A@@ECEAAAAA@C@C@AAA@ACE@A@AA@GGQKSIQIOCABQBEB@BCBCFABBJCBC@E@ECC@A@@BAD@@A@A@A@A@@C@AA@C@ACCCAAA@AA@@@@BABCJKDABA@@CIAMCOEK@SLAJA@K@GQQACAM@AACCU@E@CAC@MBAHGNAN@L@B@JIFECKBO@A@@FAD@D@B@F@D@BAD@DAD@D@FCBCB@B@DBB@BB@B@BADAD@B@@BBF@BABC@CBAB@DADAB@BA@@@@CC@@@AAC@@AAA@C@E@E@@@AABECGKAIASAKDIBOBMAECCCCEMGODKFEBAD@DA@@BC@C@A@CAE@E@E@E@EBEBC@C@E@EBA@@@AAAG@@EAC@AAC@@@AAGEA@C@C@EBI@@BCDAFADCBCBCDGDAB@B@B@DAFABA@A@@@EECCA@A@IDC@CBC@MBIBA@A@@A@@A@@AACAC@CBEBC@EEKAAAAIDE@AA@CDABCCEGCG@C@CE"],encodeOffsets:[[113627,38607]]}},{type:"Feature",id:"141125",properties:{name:"柳林县",cp:[110.889007,37.429772],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AEBG@AA@GCEA@A@AFE@ABACCKIKEEAGCEBABAPCHADCB@BABABCBCBABC@C@CBGHCHELINABABCAAB@@AB@FADCJADDN@@@FAH@BBDBBBBFBDD@D@BKHOPIJMFO@OCcOKIQQKOECAAE@C@C@AFCFEBEBCBIJEJEJCFCBG@O@GAEAEBABAD@FCDC@C@E@EAC@C@@BBHDDJDDD@H@FEFEBG@IEGEA@@NBHBDDFFDBBH@N@PA@@FBJDDF@H@JEFGHGJCJ@@@FBFHD@@LFLFJN@@DLBHAB@DA@@B@BA@GD@@YH@@ODM@E@YD@@UFML@@ADEJAV@@BL@F@DBJFJFLDH@@DLBVDJDBDDLDHBF@B@H@R@@@PBPDTBPBH@LBJDL@F@J@HBJBHDDD@BDHDJHN@@BF@HAH@@AH@@@LBJBN@@FJFJFFBDDDBHAH@B@@@HAHBDFBLHDBHFBDFHJJBD@DAHEH@@ILCDEFGH@BCDCFABAB@D@D@NB@@H@@LBH@DBDAFAHCB@FBLFJFFBFBHAFBFBBDDDBBFAD@HEFIDCDAJBJ@FBDBBDAHBF@HCJ@HBBBBD@NAHAH@N@LEBAPCL@D@NBPDV@NA@@PAFKDGBEJGJMLIPM@@TI@CBMHSJGBABA@ADCJMNMPMLEFCLEPGLMDAFGHEFCB@DEB@B@B@B@@A@EBEDCJIDADADBD@J@@@JAFC@@DC@@B@AA@@CAAA@ABA@AF@HC@@BCDEDM@EGEKGMGUIKAMCSCEA@C@C@AROHCDAH@DAFOLOFKFO@MCE@@A@@@QMKGEEAEBMFMBICGGEKEIEEECAKA@@CBA@C@CAAAAACECAI@GAGCEEEECOACDIBE@AACA@CAA@CHCFCDCBE@I@C@A@@D@FAFADA@C@M@KCAMQIBKDSFSDK@QGQIMNGD@NELENKFIEMQE]BQBQAGEAQ@I@CFIDEJEBAFEDC@KAECCCCC@ODSDMBM@E@@A@ADEDCBC@ACCGEC@C@ADCB@@KAE@C@E@ABGNGHC@E@AA"],encodeOffsets:[[113542,38069]]}},{type:"Feature",id:"141127",properties:{name:"岚县",cp:[111.671917,38.279299],childNum:1},geometry:{type:"Polygon",coordinates:["@@K@Q@I@QCGCIEOEGCAGCHADCHAFADAF@DEFCDED@FCD@LABCBEBCBGBMDC@I@C@ABGDEDCDGAECC@IDQTSFOJOBGBEAIBMDABEHCBGDABA@IBQAA@C@CBEFGBOFCBEBMDBP@J@LANBJ@DDLBFBDLJFBFDXLFBJFFFBDDL@@@HDTHFFHFBPHBBHFLRFJFNBL@FBNBJNLLHPFND@FELENERGRCPCHIHKLEDCDEBCDEDEBE@E@E@C@ABEBCDC@CBA@GBCBCBEAC@E@@@C@GFCBAF@DAH@DBDFJFJDD@B@J@BDHDFBDBF@HBLCRCNCJCJCPCP@DABALAHCH@L@JBHBP@@BBBDBDADAFEDGBEBEBAB@D@BBDDDLDHDF@JDH@FBJBL@NDDBB@TALAJEFCAIDI@K@A@IDMBIHMNMNMJEPILGJIFERMDLBJ@N@J@@@BCB@LDJHJDBHBLAPOJILCLEL@NBNHHFHDNLNJRLRHJJVFPDTJRFRBFBTFPFPHLJFFJNFJFNFJFFTBRGLEJALMJKHMFKFGDIFMFKBEBMQIMGMCMECCEAIGIE@AAEAGLKJEJGNINI@ANIB@JKLGNI@AHELELK@ECCBEBEFC@IEESMACFMDC@@@CDGFCBC@ECCCEAG@A@@@CFCFAF@DCHIJEPAHEHEAEAIDGFIBEAEEGCKBEDIHEJAPGBC@C@A@AAQBCGEGCGCOAEAEBKFG@UGIAEAWACCBKBEAKAM@IBGBMDIJGJGDCJCJADEBG@CBCBEHCRKAECAGAECCGCEBCDEA@GACAC@A@CBE@@ABC@ACAGBE@C@CAAAECGBAECGCACAIBEAC@C@A@EHGBC@ECECKAC@EAI@MEAEFM@CCIACCAIIMKGEMKAC@@CAECC@G@@@IAECA@GCMGMCCAGCGCKGIGECGGOCMAG@MAGA"],encodeOffsets:[[114275,39012]]}},{type:"Feature",id:"141126",properties:{name:"石楼县",cp:[110.834634,36.99857],childNum:1},geometry:{type:"Polygon",coordinates:["@@@K@I@IBMMQ@EAEACECIEQBODQ@MG@CACC@EAG@[GA@@@CHA@AB@AEAE@ABEFAFABC@C@KEGC@CBE@EAAAACBCDCFAHCDCBOBEBGBC@O@C@EDCFCBC@I@IBQEGCE@C@@BCHCFCBEFCBC@EAABGDCBEAGEGCE@C@CFGJIH@@MDGBALBJBLDTDPDJ@@JNDJBN@FAFCHEJ@BA@CHGNGJ@@EFGBMF@@MDKJGJ@BAD@BAHBF@@FDFDH@TBLALAHCFCNKHELCJ@H@HBFDDFBHADEFEBG@SBKBGDCBABAB@DBHDD@@DDJDHBLBD@J@NALBJDNLDFHL@@DH@@DDFHLHDDFJ@@JNFFHF@@JFFD@@RHPF@@FFHJDF@HAJAH@H@@BF@H@@DFHHHFBBLJJFPJFFTRPPDDHHRVFHDFJJLNJJDBLJHHFH@@DNFHFFLFDFDD@@BJBDB@HFJFH@FAFE@E@GCCICCCAG@AD@D@FBF@D@D@DC@EBCBAFAFBHBP@H@DADEFIFIJIDAFAFADEBED@D@F@BBFDLPRRLJdPPDP@NEJIPOLG@A@CCCEAAAAAAC@ABG@E@@CMBCDIBC@EBA@@BADBBABAJMFKDGHGDAD@D@BADADABABACACC@A@@HC@C@C@GAAEGCCCACACAAAAAAA@A@GBGDIAGBCBABABAD@BBBB@DBBB@DBD@FAD@DB@FBDBBBBHBF@FCDEDAH@B@@BBBCF@B@@BBF@BABCFOBC@@BAB@D@B@DBF@DAD@B@DBBBB@D@JGF@FA@ALEB@N@H@D@F@DAFCHAHCF@DBDBDBD@FAFAH@N@B@B@B@@BDBBBBBB@B@B@FA@@BBB@F@BAD@B@B@@@BBB@B@B@BABAB@BB@@@B@B@@BBH@@B@BAF@@@BHBH@B@FCFADCHE@@B@B@DBDDBBBB@D@D@BBBBBF@D@F@FCDEHELCPALALCFCDCBGACCEAADIBIFMJMNO@ABOAKAWAOBQAMIaCSAQ@EFIBKCMIKOKCIACBA@ABA@A@CAA[AKAG@IAM@CBA@@@AAB@BE@@AAA@AA@@EBA@@@AEAAA@A@@A@@@A@A@@A@M@I@@@@A@GDC@AAA@@AAA@A@@A@@AAAB@@A@@@@ABC@C@AAAA@C@A@A@EAA@G@AAA@A@@B@F@BBBDBD@DFBB@BABCFAD@L@DEFIDCBC@CACE@I@EFIFI@ACEAAA@IGKCOAGBQJMDMBA@MDKKGKG@QGOCMAEC@A@EAGAEIAG@QCGAMEOGQCKCICG@MAG@KBGDMFGBIAOBMHGHGHGTGVEFMFMBODABIBMDMBETAHAHCHKHMBKEG@IAKAO@QDM@ECIGIIEO"],encodeOffsets:[[113302,37802]]}},{type:"Feature",id:"141128",properties:{name:"方山县",cp:[111.244098,37.894631],childNum:1},geometry:{type:"Polygon",coordinates:["@@KBUFI@W@E@G@WFoHA@@@AB@@KFMDSFAFBH@D@HAF@F@D@B@F@F@D@B@D@F@F@BBB@@B@B@B@DDDBBBBD@BADA@@DBB@B@B@@CBA@A@@D@@IRD@FB@@BHBB@@B@FAF@D@D@FAFAF@F@F@F@DBB@D@D@@AB@@CBCFALEPCNHDFDDFDNBPAJALCTBJBLBDHAFBB@@F@F@D@B@BB@@BD@B@@DD@@@@ABA@CBCBA@AB@DADABE@AA@@@ABCBC@A@AAAA@CAA@A@ADEDC@C@CBC@ABC@E@A@C@C@EB@@@BAPDLEFIJA@K@M@MBGHAB@NBD@D@FDVBD@BBNBDRR@H@LIBKB@TFLDPBNDJ@@ABCBILADAB@@@@BBB@BBDDBDD@B@@B@DB@B@B@B@@@BC@AB@D@FDF@D@DAAIBADEDA@AFARABAPDRJTJRLHHB@@B@BDF@BBB@B@D@DBBBBFBFD@@@BADADAB@D@D@BBBBFBD@BBBFF@@@D@B@BBBBBB@BHBFBBFD@@BB@DBBDDB@@B@BAHCF@D@D@BBB@BB@@DDFBD@BB@DBDBDDJFDDDBBB@F@BB@@BBBBNDNDLJJRPHDBFBBDB@BDDDDDDFDDFBDBDBF@DBF@N@HFJDFBFDDFFBDDD@FADCBCBEDUFSDYDDJBDDNBNFJBDDDDADBFDJBFDDBD@BBHBF@F@F@FAD@DAFCDCDCHEDADAF@DAD@FAD@DBD@DBBBBBBDDBF@D@B@BDBB@DFJBFBFBHBDBB@D@DADABADAFBL@DDNNCFADAPEHAFEDAD@B@RBJAB@BAHCDAFGBANCJAFBHAPAPITERSJCD@FDHBDCFCHCBAD@J@D@NCHADAFADABA@KDC@EFCDCFE@CBEBCBEDGBCDG@G@ECCAAGCSCEA@@C@AAA@@AAABA@A@EACCACAAA@AAA@A@A@AB@@ABA@AB@BA@AB@BAB@@AB@BA@AB@BAB@@A@AAA@AA@AA@AA@AA@AAAAA@AAAAA@@AA@A@AAC@@@A@A@A@A@ABA@A@ABA@@@ABA@A@ABA@@BABA@ABA@ABAB@BAFA@@@@@@BABABADADABC@ABC@C@C@E@CAEBCBABADA@C@CBC@G@EDE@EBADABCBABA@ADC@CBCBADEFCFCJADAFAFGDI@AEG@A@C@CDEBABCBCBEACCAACCAC@CAC@CACCECCACAEAEAEACAIHCBEBEDIBE@EDCBaDS@E@MAKAKCOEkGIAEAEECICK@E@EBEDCBCACGOCIBIBI@CCE@@GEICEAAAACFMLQDGBEDGBEFADAHCNEHEFADCB@B@BCBA@CDI@I@C@K@EAICUGKBIDIFIDO@KBGFE@@YKGM@g@KSQKCMEIBMDKLOLKPMDWHUJSLMBSDUAQCSGMGMISK]QAICGAEGAKAQCCACC@KCCAEEKECIAI@KBG@SCGACBENMAWFUHOD"],encodeOffsets:[[113828,38537]]}},{type:"Feature",id:"141129",properties:{name:"中阳县",cp:[111.179657,37.357058],childNum:1},geometry:{type:"Polygon",coordinates:["@@PED@DBPGNGHOLWDM@K@ACGIISCMCQEMIMIKGIMGOIOKMQKGKQI[SUBGNMJWDQDQDiDW@WFC@CDEBGBG@E@GBE@MCIAA@GCEEGEEICC@BMPINENAJCJBBDFBDAHCDEDKDKBOBKDGFCFEDE@C@E@AAAA@A@C@CAAAACCCAA@A@@@GFCDEBEDA@G@GA@A@@BE@A@AG@AA@@@A@A@@AAA@ABABA@A@A@AA@@A@A@C@ABE@A@AA@@EBA@A@A@AAAACA@AA@A@A@M@G@EBEBC@CACACAE@GDGBEDCBE@C@G@M@A@KF@BEBE@IHC@A@AACAA@C@CBE@CAA@C@A@AB@@ADEPADABE@AA@@@ADEAA@AA@G@CBCFEDE@GAAAAAAC@ECAC@EBC@CAA@AA@CAAAAC@ABABABADBHCJAH@H@BBBBBBBDBDBDBDDFHBB@H@D@DGD@@@BDDDB@ADABCDGBOBAFAHDFBLFLJDDAB@BEF@B@BFBHDB@@BAHBFB@BBF@D@HGHMBAF@D@F@LB@@DABCD@D@HFDD@BADCDCF@B@BF@N@NATCPCD@DDDDBF@LCDEFABIFCFEJ@D@JBRHFRBRA^ARFFNEJMLKFMFC@MHJNHR@RCLETCTALRJBNLDN@D@B@BCBE@E@CB@D@J@F@DADCDEDGB@DBB@BD@BAFCJBDDPFFFFHDHBJ@DBDFBBBBDBD@B@DA@@LBDBFFJFLFHFDHAJENANBFFFLHRN@@B@@@DF@NEPELKPEPCBG@CBGDQP@B@D@DFBTDNDLBVJNHLHHF@FCNCFAD@@DDFBH@LAFCH@LAF@F@F@BA@@F@PERCDARERCFARENENED@NALJFRB@REXCR@HJHHRBNCJMDGDGFUFUFWDGDKHULOJIRGVBVDRDVCRIRB\\HPBTBPEHOLKLKPE\\KVIRIPGNEPGAC@C@C@CCAGEEAGBC@K@C@C@CACAG@C@C@E@@@A@A@C@ABA@A@ACACEA@A@A@A@AAAACGGCAA@A@CBCBKDG@IBCAE@KKAC@A@CAGBABALGFCDC@ABA@GBCBABC@A@@AAAA@@BA@AB@@@@@@AA@@A@A@ADABA@AA@A@A@A@BC@C@CAEAACCCMAAA@@@A@CBEDGBEGEEGEGAKBEABEBMBCN@JAHE@EEEEIKGMEOEAA@AAAGAA@A@@@ADAD@BA@A@C@AB@@ABA@CCA@CACBIBG@EACCAIAAIAEAACASCK@CBA"],encodeOffsets:[[113991,38071]]}},{type:"Feature",id:"141130",properties:{name:"交口县",cp:[111.181151,36.982186],childNum:1},geometry:{type:"Polygon",coordinates:["@@AEBCBCBAAEAA@@KDA@A@AACECC@AB@FADA@A@CAACAMAGAKAEBGFADA@GACC@EDK@G@AEAA@@C@AA@ABA@ABC@ABCFA@ECCACAI@E@EBCBA@A@AAA@AAOBI@ABBDBF@DDDBB@BCD@B@F@D@BABC@EAKAI@OBG@IAQSKMQ@CDMJHbORAXEPEZKLMLED@B@BCBAB@DAD@@@BDB@BAF@B@D@BDBBDADCBA@AB@BBDABA@C@CCCCICMAC@CDCBE@C@E@C@IBCBG@CAIEGACAA@AACCECA@IDCDE@E@AAACC@C@EDCBEBABAFCBGFIFCDABA@E@AB@@@B@D@BBB@D@D@BDB@@@B@HBJ@DDF@DBB@BDBFBFDBDA@AB@B@BBFADBF@BBD@B@B@@BB@@@B@@@@BDD@HBD@@@BB@B@B@BEH@@EBABC@@B@B@B@BADA@AB@@AFABBB@D@BAB@BABBDDJPLJLDNALEJ@FBRDTJbBNARBPBXBLAPDDFJHFFFHDB@JBNDF@HAF@H@HAFADCD@XEX@jCRCRCXCNIHMVA\\TRJHLRLLNJPHPJNLHNJNJRFNDTDJJDH@B@LCNKXGPMHOHCAC@OFAB@DDLBTBDFBJBBBBJDDFBH@JADADBB@DDB@BA@@BAD@B@B@@ABCBC@@B@B@HB@@F@D@B@D@@@B@HGDC@@@ACACACA@C@ABADED@BC@@@C@ACAEAA@@@@A@CLKBALIFGNIZ@TBTJHDDBFDB@@@RBN@FAB@BGDIAQAM@M@EAI@AAC@ECM@I@C@CFIFAF@JCD@@ABA@GBCDCBAAI@C@AB@HEDABABA@AAAAABGBEBCAAAAICCC@AMMCCIAIAI@OCGCDIPELAPCBAJEJMJMBEDCHKJKFEFEDELKJMBED@D@F@DCDAFGLIBCDCHCDABI@GAM@IBCDABAD@D@DAHEFAD@DDDDH@F@HCFCJCDCDAAA@AECKGEAI@EACCACIIKGIECAACKEEAKCE@YBOAKGEACACCCAAE@ECACAC@KCKAOACEEEACAA@CAIACKEC@IAQ@A@ABABAB@@ICA@C@A@AA@EA@@A@@@ABI@AA@G@A@@AACAGBMAKDSGCECIAOEAAMCGE@IEAGEKCEACAAA"],encodeOffsets:[[114038,37660]]}},{type:"Feature",id:"141181",properties:{name:"孝义市",cp:[111.778818,37.146294],childNum:1},geometry:{type:"Polygon",coordinates:["@@GC@KBGKCEAM@MFCDGFABA@IBCADGCAGEEAIEUGKEICKCE@CAC@EAQCEAIASIGCCAEAEGCIDKQBCJA@A@K@QCM@MAMAE@KKAC@EBGBCAGCECAC@A@CBIFA@C@EKACAAE@M@C@C@AAAAACEEACAA@G@E@EACAAIAKBIDADADBB@D@DCDIDA@KFMFIBMBCBIDIHKFCDIFCBCBCBG@EAACGCGBMHEJ@J@DDHCBIBUGOAKCGAEFEFILGLCDAFININIFABODKBOFCJHDPDJ@JBJBDDNN@BDDJDBBBBADAFAHBBBB@BABABCBGFA@@B@DBJABCDAD@HAB@BC@IDE@EBEJ@D@D@JDN@FBD@BBJ@F@NBNBRCJAHA@EBM@QA@@A@ECCAGCSISAY@MJEHKJABKL@D@B@@B@FBDB@B@D@@ADC@CFAB@B@DDBDBDB@B@@CDGHA@@@C@A@C@E@@@BB@BBBPFNFLHFJFF@FGFIBM@ADANAFFBLAHBHFFFFHHAFCDAB@@@B@BBDNDDBBBF@D@DADB@B@B@B@@BABCB@B@B@BB@@B@@@@A@@BAB@@BBBB@@@BADABAD@HAB@BCDEDKHABABBH@D@BBDLLF@DBJAH@LCDADAB@B@DBHHBDFM@CEM@GFSHOHEJALAPAFABA@AEICEBEBCH@LAL@J@FFJ@H@FGNQJGPOFCLGFCJGFCJCVGLCFAB@VCHCNCLGBABGDEDEFEHELMBAH@DALB@@FCFAPAHADABC@CBINAB@DAJAPBFAFABAD@F@JFJFDBFBDBDBDBB@B@FAHCFCP@D@BB@BDBJ@PBFBD@D@J@DAB@@@RCD@@@@@B@D@LAH@D@NCB@B@DBD@BBBF@D@FDBLDJAB@LCF@D@HBB@D@P@F@P@H@HATIHC@A@@DCAGBCDAB@F@D@@@F@FBFBFBNA@C@I@ETID@D@HAhD@GAE@MAA@E@A@AAGAC@CACCOGKOEC@GEECEC@@@@AAAA@@@@AACEAEECAA@C@E@CA@@EEAIQEGCGIEMCGE"],encodeOffsets:[[114550,37939]]}},{type:"Feature",id:"141182",properties:{name:"汾阳市",cp:[111.770477,37.261756],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@E@@BAL@HADADCBA@MBEBCBABAAGAAQIAAAEAG@E@C@ABI@I@A@EBCDOBA@@AAEM@AAEA@GGACACAGCC@AEGgCGBC@C@SJ@F@J@DMBEAEAEAE@@@C@E@A@CBADBHCD@@@BGDSJGBG@O@E@O@C@A@GAC@E@KDA@IBKCCA@E@CAEAAC@CAA@A@MDC@G@KBC@A@@@@@C@QD@@A@CBI@C@C@EAOAI@CA@AAAC@O@EDGDEBA@A@CACACAEACAIEIEE@C@ABEBEBOAIBCBA@MBAJ@DADCBGBOBEBED@@KACBG@ABKNGFEFCFCFAHABKHMDGDUDA@EBKDUHIDEDIHEDKHEDOPIHMREHG@I@EEI@K@KBG@ADAFDFFJ@BABEBOBKBIBGFGPET@HFN@DENBB@B@B@B@BFBBDBDB@B@BAD@B@B@@@F@D@D@H@DBDBD@D@L@D@HAFBHFDB@D@D@DBD@@DDDDBB@BCFBBBBFBBB@BBBBBLBF@BADADAPDNBBB@B@D@D@BB@DBFAD@NFB@D@B@DABABADAD@F@BBJHLJPRB@B@F@B@FFDDDBBB@BADADABBBDFFDDBBF@DBLBHBD@D@BCF@DAFCHEFEFAB@BDHAHCDEHGFBBDBBBBB@BB@HDH@@@@B@B@BJJBBHBLDBBBBDBHDFDB@FAF@JBDBDAJCB@JAD@DADIBCBAH@JBNDNBD@BAHADABADBBBB@F@D@B@DEBAD@@@DBD@DBDABABADCJEB@HAFB@AB@BCBAB@D@@@@AB@@AB@B@FDB@B@B@BCB@FBHBDBPCNAJ@R@V@Z@P@XBV@J@DKAWDIHEBECQESFAD@RFF@Z@PAHAHMDMBKHCNBJ@F@B@FBPCJDFABA@A@A@A@AB@BGEAFGDCBGDAPSDC@D@BB@B@B@D@HBD@@ABA@@BA@@DAD@AA@ABADABAD@BBB@DA@@@AA@@@AA@AAAB@AAD@@CBG@GD@BCBBFA@BH@DBFIFEBGBE@A@C@C@CAGAGFKBADGBCDEPOBICE@CDEFG@ABAAAA@QAE@ABAFCBE@E@CACK@AC@A@AF@FADC@AAAECQ@AGA@A@AAACAA@U@C@@DABM@I@CAAA@C@C"],encodeOffsets:[[114628,38145]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("朔州",{type:"FeatureCollection",features:[{type:"Feature",id:"140602",properties:{name:"朔城区",cp:[112.432312,39.319519],childNum:1},geometry:{type:"Polygon",coordinates:["@@CE@@@C@GDK@CAEQEIAKDEDEJAFBDBBHDJBHDBBBBBH@DCFGDM@MAI@MAG@GB@@CF@HDFDL@NCD@HHHFD@DC@IAAB@HAJADADEBEBGFCBCAGAG@EBE@EAGEICIAEDADBDFFBDDH@HCTCDEBEBEBEBGD@DBD@FBFADEFOH@FBPDRGREHIJQDA@QJEBSFOBQ@MAKAOCMIEKCGCIGEGCOFINEHENGJENGFMHIBGNB@JDFBNJBDADCBEBIDABCDAFAHAFFHDFCD@@EDGDODA@EBIDGJCHCJ@HDDDFB@XCDB@D@DD@DAJ@JBF@FAHCBARKHEFEF@FBFBFDBBBBBH@DB@BBB@D@H@HBF@DBBHDHBDBDDBDBF@FDHHHDBN@F@FBHB@BFDFHBD@FDBDFHBBD@F@DAJARLLLHHBDFDHAJCFCR@PBNAFAJIFCDEDGBCDCHAF@F@D@DBB@B@B@BBBBBBB@DBD@D@BA@@BABA@AB@@A@AB@@ABA@ABABAFABCBEDCDCLBFBHBD@F@B@HAH@JBHBDBFBDAJBDFADCBCBABBBBBD@F@HBP@J@FBH@HD@@FFDDABCDAB@DFDF@H@H@B@D@D@DDB@DBF@HAHA@@H@F@BBLDLADATMFBH@D@NAPELCPAJ@HBJBD@DBRDDHDDBBPHJBDBD@JCVFD@BAFIFQ@ABADALEDAFM@@B@DAVFLDB@JDJBDB@BCHBBB@@@BBJDDHDHPNBBD@LCJCHG@AP@LDNCREJDHHDDBBRTLDFBJBHBFDNMPKNQHIJODGDEJKLMLIDGBIFM@CDIDO@C@A@C@EBACOAK@A@@@GBC@EFGFIBCHOJKBEBCDMFSAQ@ABQ@MAE@O@@@AD@FCDCBA@KCEKGICAG@COGCCBCDGHKHAAC@ACEAACACAAA@A@@DCB@@ABA@AB@@C@A@C@A@AAACAA@EAA@ABGBC@ABMB@AA@@A@A@@BAB@DABADABAB@BA@ABA@@DC@@AK@I@IAAACCEA@CCA@@AGE@@CAA@KIAACAAAEAAAA@A@AAGCEACAAAC@CACAC@GAC@CAA@A@A@C@A@CBABCBA@A@EDABC@AACAA@CCCC@AEACAACA@AAAA@AAAAC@EBCBA@ABC@A@@@@CMCKEGEAQEI@MBOAMDGD@BCAYAG@KT@B@@AB@BBDBD@B@B@B@BAB@BABA@ABCBA@C@C@AAA@AB@@CAC@A@ABEBCBAB@BA@@B@BADABA@@B@BADAB@BAD@B@B@BKJQBGDKBS@YCMEMEKIMGOIGCECMIOGQCECKBQNQDSBMCA@KCUGOAA@KDA@KJGFCDEBGDODA@ABCBE@A@AEEAQBEA"],encodeOffsets:[[115011,40060]]}},{type:"Feature",id:"140603",properties:{name:"平鲁区",cp:[112.28833,39.512155],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAGAIAG@GBA@E@C@GAEAKACDCDAFADEBABAB@BAB@BA@@B@BA@@BABAB@@ABC@C@CAA@AAAAAAA@A@A@CAC@E@E@GBCDADCHCFEDIJEBMBOAQ@EDIDGBECACGGKKQKIBCBE@C@AAEGACECC@GACEAEA@AG@E@EAMGCGGECE@CACAACACCGAGCAE@GAG@C@A@AAA@@CAGAAAAECEAEAE@EFGFQLABGDEBE@IAI@CBC@@C@CCAWDA@AFCDABEFMLADCBABBF@@DFBFBHBF@FADAD@BCFEBEBEBAF@H@FEFEFEFAFAFAHBD@F@DEBCAC@CEEACBIDEBOJIJCDQFC@C@E@MGA@CAE@ABCD@BA@AF@@CLEJMHEPEFEHCFAF@DBF@BBJDDFDHDNDBBLBBBVBF@TFJ@L@\\ATBL@H@PFJNDDKJEFABMNILIJGFGDGDOHMJML@@CJAHALAHEHCDEDD@DFBFBB@BDD@D@B@DADCBEBCB@FDBBBDDBFBBBFCBADCFEDCDCDADAF@BABBDBDFD@@DB@BDDDBFDDBBDDDDBDBD@FBDBDBDD@@@BB@BDBDBDR`BDA@AD@BABADAB@D@@AD@DBF@HBDBF@@BD@B@D@DAD@DAD@DBB@@@D@B@BBBBBBBBNA@@B@DAF@DAD@DAD@FBF@D@D@DBB@DBB@BFBFBDD@@D@BBFDFD@DBBFDDDDBFD@@DDDDJDBDHHBBDDBDBDBDDFDBDDBDDDBBBB@B@@JHBBB@@BBFBDBD@B@DBB@D@BBDBBBDB\\B@BBBBB@@BBDDH@@@BBD@BDBBB@BD@BBFDB@DBFBDDDBDDDDBDBB@BDD@BBDBD@BBD@BBB@B@BBFBDDDBBBDBDDFBBBD@BBFDDBBBBBBDB@@DDHJDDDDBDDDBBB@BDDD@@FCLIFCJSNEHCRAB@VCJC@C@C@@@AAG@AACCGQU@A@C@A@CFIJAJANCLCHQBEFODGPQVMNCVCHABC@EAGISJKNBLDL@HCD@FCFEFGJOFEJ@L@HBJBJBXDLCHEDEBEAE@EEKIKAI@EDEFCHCPINMBCBCDAFGDGFCNKN@DAHAJAFCDGACAGCCJOHCFAJDJDF@@EBEDEDIFMBCDEHCPENBDB\\CPEF@HIBIDGDGBGFO@E@CCGIGGCYISGAGDELARCTAHQDOFGFCBCBA@ADMTMPFL@N@H@P@XDR@D@J@LAFAPCNE@KEIEICKFKDCDEHKDEFEFIBIDG@AFYB@NIVAdDZFTFLBNAHAD@DEDCFI@@DENQNMJMHQAI@CCICGBAECGAIAEAKCQSAACCGGICQFMDKCO@@BGHIDKDC@AAOMCGCGICAA@@A@AADG@ACAIAICA@KCUECBA@@@ENCBKFCBAB@BEREJABC@UEIDC@CAIAOGAACCCGQCCAC@IAGAI@OBKDOFMBC@G@EASNCBKBKCAAE@G@@@GBGBE@CAA@CCC@C@A@G@G@E@EC@CBADCBACCEE@@GCG@EAI@O@GAE@C@AAAABADADABCCEIACBEA"],encodeOffsets:[[115016,40350]]}},{type:"Feature",id:"140621",properties:{name:"山阴县",cp:[112.816413,39.527893],childNum:1},geometry:{type:"Polygon",coordinates:["@@EO@MGMMIWAQAOAGAMCEICKAG@IAECEIKECQ@MLEHGJEFKDG@A@@@CACAMCEAA@A@A@CAEA@B@B@D@B@DA@@BAB@BA@CD@@@BBBDBDBBBDF@BBDGBGLCHADDDPH@DBHJDLHDF@LABCDEDC@@B@@@PBF@NAR@BBRETCNADAFILGPADEJEH@FAD@H@@@BBLDPAB@F@D@B@DCPCJ@DENAJCHKJKNILCFCHIPGJMROLMNABDHDJ@DBJGRINMNMRCF@@EJCDCFC@GBMBKASEYEcCUBMJA@EZ@BCHAJEJEFCFGLCFCDELDLFJFJ@LMFODEBKBI@C@Q@WCO@G@M@K@OESNCN@BABADEDEHCPGRSBQDKBCFBHTHZJHDJHDH@D@FEPAHCHCHAJGJE@O\\FJVHRDJBTFVHNDFBDHFFBFHBRDXDJ@JCNI@ADCPA@@LCXDPAB@LANAHCPGRMHEH@FBBBDHBFDRPL@BB@FBHDRDZHNHHFFJBJBFLDFBVGREFCJCHAREVCJFDFBH@H@P\\DPAF@L@dARB@@NBJBFELIHGBGAKGKIKMKQIQMQIOEQGEKAGJILAJGLKDKJQHIDADEXKRELADALIRMFCLKFELIJGLGNGNCN@LATBD@R@R@L@J@JBL@HE@GDKHSDODKFMBEFS@C@EECKAEEBEJCJGJCJAJE@CAEAAGM@GHKFGFGDCDERQDC@A@@BA@CB@@A@A@A@A@ADCBADAHANBB@BABGBED@LLDB@A@M@AECGC@CDEBEAACABA@A@C@K@A@@@EBABA@@BABCB@BA@AAAYCAGACAEEACE@EFCJ@PCHE@ABIDS@EDCFA@@@ABCBA@A@ADCDAFED@HCB@DAB@DAHCBABABCDABEBC@C@AA@AAABC@AAA@O@A@GACA@AA@@A@AAC@CBA@C@@ACEEA@AAAACA@ACA@AACIACC@A@GSGSEGAMCICEAOEOGGAUEUAWASEQEIEGEGKAKGOIKKWKGKG"],encodeOffsets:[[115596,40181]]}},{type:"Feature",id:"140622",properties:{name:"应县",cp:[113.191098,39.554247],childNum:1},geometry:{type:"Polygon",coordinates:["@@ICKEEEEAGEIEMCKBIBIBODQDEBEBA@MDODGDCDGBCBKDMHKHGFBJDJDJBHEDE@BBABA@@BABCBA@CBCDABABA@CB@BABA@ABCBABA@A@E@I@EBA@A@A@A@A@A@AAEAAA@AAA@A@@AAAAA@ABAAA@AAA@A@A@@B@DADAFCBADABABGDCBA@CBA@GDC@EFCBCD@B@BABAD@B@@EBCD@FCTAJ@BGFODI@ED@FDFFBBFBDBHZDBB@BABA@ADAB@@ABAB@F@@@B@L@D@BABDBBBAFCF@DHDFD@B@N@BCAKKC@AFAHABA@MAGBCBABCD@B@B@B@B@BA@@DAB@@@BCDQRCFCDEHEHGL@HHNBBBF@DIFIBIDIHIDAFFFLBFD@F@DETAFENCLCPGTCL@HTFRBH@D@JDFDHFJHHJDFBDBLDJFFTFRBNHB@HTDBJDB@NAR@H@@@HBFBBBDFHHBDHJ@BJHLFPBJ@N@D@XDTFB@LDHHDFDDJNHHFFDDJJF@TDXEF@NCPCFCHCNILEJEJCLCRMDARIBAHIPAJ@XAFBL@\\BR@FBFBLHJF@@FD\\@DAF@J@VAH@FKGGAAEI@A@C@CFGBAFCBMGEEEGG@IJKFEPGPKJKBABAFGDCBADCBANMDIAK@@@A@AAG@A@CBCBCBAZ@PCJAB@JGFOBCBCDEDCDGVGXUFEPOHQ@AGQAMBIBIBCJIHKHCPILINENGD@PGVKJIFSUACAAEEK@K@EBO@GACEGIGOKOGGCKWAMEKIKGOSGG@KBQBI@G@OGG@KAOAGG@MBMEAG@QLWLICGOOGMEKGAIEIECKBKFGDCDKLK@MAEAOBMBQBWAGBI@E@I@UCMBKDK@OAGCGC"],encodeOffsets:[[115969,40255]]}},{type:"Feature",id:"140623",properties:{name:"右玉县",cp:[112.466989,39.989063],childNum:1},geometry:{type:"Polygon",coordinates:["@@EIP[OF[DCAMAOFGDCFADENCJCFAF@FE@ICICEBGDIPDDBHBDCHEDIBGBCBM@MLEDCHEHCBADADMNOJGDEDCF@FBJJLFL@FBFAFCFGFKDWCIAIAGAK@I@EFIPEHEFEDC@GDK@KCMAILJTBH@FADGBUDMDUNORCHEPAFGRKDMDIBIBEJ@D@B@D@BRVDHBD@BBH@B@@@D@DIDUDA@QBGDMFITEDKJED@@@@DH@FBBBDBBBBDDBBDBBB@@BBBBBDBB@BB@@B@@@B@@@B@@@@@B@B@B@@@B@@@@@BBB@@@B@B@@BB@B@@@@@BB@@@BBB@@B@@BBBB@@@B@@BB@@@@@B@B@@BB@@@B@@@B@B@@@B@@BB@@BB@@@BB@B@@@@BB@@@BBB@@B@@BB@@BBB@@BB@BB@@@@DB@@B@@BB@@@@@BB@@@BB@BBB@@BB@@BB@@B@@@BB@@B@@@BB@@B@@@B@@@@BB@@@B@@@@B@D@B@B@B@B@B@B@@@@BB@@B@@@@@BBB@@AB@@@@@B@@@@@B@@@@B@BB@@@@BB@@@@BB@@@@B@@@B@@B@@@@@@@B@@@B@@@@@B@B@@@B@@@B@@@B@B@B@@@BAB@@A@@B@@@@@BB@BB@@BB@@@B@B@B@B@@@@@B@B@B@@@@BBBB@@B@@BBBB@@@BB@@@@@BB@@B@@B@@BB@@@@@BB@@@@BBB@B@@B@@B@@@BB@@B@B@BB@@@@B@@B@@@@A@@B@@@@@BA@@BB@@BB@@@BB@@B@@B@@@@BBB@@@@BB@@@@BB@B@@B@@B@@BB@@B@@AB@@@B@BB@@@BB@@B@BB@@B@@BB@@B@@BB@@@B@@B@@@@BB@@B@@@B@@@B@@BB@@BBBB@@BD@@@B@@@BB@@@@BB@B@@@BB@@BB@@B@@BD@@@BBB@@@@@@D@BA@@@AB@@@@@@@B@B@@ABA@@@@BB@@@BB@@@@@B@@@@ABC@@@@D@@BB@BB@@B@@@BABAB@@@B@@@@A@@B@@@@@@@B@@@B@@@@@B@@@@D@B@B@@B@@@B@@BB@B@@@BB@@B@@BB@@@BBB@BA@@B@@@@@B@@@@@B@@@B@@B@B@@BB@@@BB@BB@B@@BB@B@@@@@BB@@@@@@BBB@@BB@B@B@DBB@@@BBB@@BB@@@BB@@@BB@@@BB@@B@@@BB@@B@@@BBBBB@@@B@B@D@@@@@BB@@B@@@BBB@BBB@B@@@BB@@BB@B@BBB@@BB@@BBFBD@@@DBD@BB@@@BB@@@@BBBBBBB@@@BBBBBB@@@BBBBBBB@@@BBB@@BB@@@AD@BADAB@BA@@BC@@BABCBAB@@@@BBBBBBBBDBBBBBBB@@BBBB@@B@@B@@@B@BBD@BB@@@@BBB@@BB@BBB@B@@@@@BB@@@@@BB@@BB@B@@BFBFCDB@B@B@H@FBHBB@B@F@DAFAD@J@J@HBNHFDDDDBDDHDFBPDHDFBLHDFFDFFJDHDBBFBLDJ@dFLBB@F@FBF@LADANGDCBA@ABCFCFCF@FALEFABADAFCFCDAFEDANCJAFAL@ZCBAHALADAHAF@LGBAH@FCJCHAJCHCD@D@B@JAPEFAPEB@@A@E@@BA@ABAAC@EBC@@@AA@CAA@A@K@@@KEKOAOJQPKNIFIBABMIM[AK@E@UAKGEE@A@EBA@@FAB@@A@@AAA@CAA@AAAC@A@ABABA@@B@@ABA@@BABA@A@@ACAAAC@ABCBC@@F@B@BAB@B@@A@@BA@@BABGBAB@@AB@A@@AC@EACAA@CA@@AABA@AFA@ABA@C@A@ADABADAD@BAH@B@@AB@DAB@@@BAB@BBFBB@@@DCBAB@BAB@D@BCB@@A@AC@A@CACACA@@C@@A@A@A@CA@@A@@@ABADA@A@A@@BAFCBA@CBC@E@ABCDEBABAFAD@FABA@GBEBCBG@AFCHE@IBEEQAGACAACIGIAIAGAICOEKCOAGAEACCQIOMOIKIMBI@EDODOBOBGBIAM@G@CCOAGEMCGEKAE@GHQJOJCLCNCFA@ADMAQ@ACQ@E@MBK@MPENEJEDEDIHEGCEAA@@AOKCQAECGAAEAG@GFQNOHGDMBKBA@OBWCKD@@OBCD@BMJIDI@WCQCGAAEEECGEAMCUGSEIAQCUG"],encodeOffsets:[[115144,40665]]}},{type:"Feature",id:"140624",properties:{name:"怀仁县",cp:[113.131717,39.821627],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAGSA@MGQASEEECIAKACCEGIIGGEECICC@G@QASEGFK@IAI@K@Q@Q@C@SAKBM@MDMHKHIHKJEFKLEDQNKJCBKBQFWLCFCBGJIRCLKLIHKBIJBHFLRHPFRJRNRJNLJLHLBLAHGHKJEFADEF@FBHDFHFPHN@J@F@PBP@PFJFDDDFANHLTFLDF@H@HBD@B@BBDBB@@BFFBB@BBBBBBBDBH@HBB@B@BBB@@B@B@BBB@B@BB@@B@B@D@B@D@B@B@DBB@BB@B@BCB@FCDAB@DAHCF@B@BB@D@B@BB@@BBBDB@BBDB@BDDF@@AB@BABAD@@ABCFCFA@ADAB@BCBA@CBA@A@A@CBCBA@ADA@@D@B@BCBFF@B@DGLBLDFFDH@PIJEJ@F@J@NFJ@TBX@J@HAH@F@JBVFJ@L@F@PATAPCrC`CJ@V@P@LDH@RFJDHBJHDDNHDBNHRJLJJJLFPFJHNFNANAHAZETCTCHENEHIFCLAXAJADCFEPOHEHGNMHAPBN@TADGHOFENBFCB@BCB@BADCBCB@BABA@@DADC@@HKVSNIJKMCQAAAUEOIOGA@CBI@O@OE[YACEEEGA@E@@AAAAEA@AA@AA@AAA@O@A@A@AAA@@AACAA@CBABCDGBA@ABABCBC@@BCBCBE@@BADGB@FGDA@@BAB@HCFAB@FAFCDADADCFCBADA@AB@BAB@BAFADA@AFABAD@@AB@DABAB@BCBAB@@ABAB@B@@ADCBAFE@AAAA@@ABAJI@A@CBCAA@CA@@AAAAACCA@@AAAAAAADE@AEAIGC@@@@ABABCCAELG@UBI@E@CB[@EC@@IEKGEAEAQ@[AK@EAWBI@OBGJABQJCBQNKDIDIFKFMJGDEDODMDE@WFSCE@IICCEEGGIMCCCEGGKCA@SEWCC@M@I@OAKEIG@AGIACGGCEAAEAGA@@G@Q@MBA@IC"],encodeOffsets:[[115759,40621]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
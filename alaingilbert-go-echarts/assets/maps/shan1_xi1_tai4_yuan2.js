@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("太原",{type:"FeatureCollection",features:[{type:"Feature",id:"140105",properties:{name:"小店区",cp:[112.565659,37.736525],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@A@AC@C@G@K@AAECCQGECC@G@KBOJEDEBMHCDADAFADIHCBA@@@DFLTDBBB@@BBLJFBFDL@HBD@D@BBDB@BFD@@@BDBBB@@BBBBDDBB@@FD@BFDB@BB@@@@BBBBFF@@BBLLNJJB@@D@B@B@@@JCBADAD@F@D@D@BBD@@BD@LHFLFFFHRLDDBBFJBBHJBDFJDH@@@F@J@B@@AD@H@D@B@@@@@BCJAFCFCHADCJADAHAH@BBF@DBBBF@BBFBD@J@B@B@DBD@@@B@F@@BF@D@D@B@D@@@@@@@@@@@@@DBF@@@H@@@@ABAH@BADAD@@@@AD@@@@@@H@@@B@D@B@@@@@B@@@B@@@@@@@@@B@D@B@@@@@B@B@F@@@@@B@@@B@D@@@@@@@D@@@B@@@@@B@B@B@DBF@@@@@@@D@D@B@B@D@B@B@J@@C@@@C@A@@F@D@B@@@B@BAB@@@@@B@B@@B@@@@B@@@D@D@@A@@B@@A@@@@@@@AC@@@BA@@@@B@@CA@A@@@C@@AA@@AC@@B@@@@C@@@@@A@@@C@AC@C@@@@@@A@@@@B@BA@@@A@@A@A@@@@@G@@@A@A@@@@@A@@@A@AB@@@@@@@@A@@BC@@BA@ABABC@B@BB@@@@@@@BA@@@@B@@@@@@@@@@B@@@B@B@@@@H@F@J@F@@@@BBB@B@@@B@@@@B@@@@@B@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@A@@BA@@@@B@@@@@B@@@B@@@B@@@F@D@D@BD@@FBF@BDFADAF@FFBBBBDFFDNB@@FD@FFH@@HNDBDDFHFDL@DABGJCDA@A@AA@CC@A@C@C@AACAA@@AAKEGG@AEK@GHIJQ@C@AAAACECCCKEAAKGCACCA@CAA@C@AAA@@AAA@A@A@ADABCBABA@A@ABABADABA@ABA@A@C@C@EACBCBABCD@BABAAAAACCACCAA@AAAAA@CAA@AA@A@A@A@C@EAG@EACAE@ACAA@E@I@E@EAC@C@A@EAAAEA@@AE@CAA@CDABADABCBA@C@C@GACAACAEAA@C@A@C@AAAA@G@C@AACC@EACA@AAA@EBABEBABABAFAFAD@DADA@C@@@@@@@GACCACACAGAE@CAC@C@E@E@EBEAC@EACACAAAAC@C@CBC@E@E@@@@@ABE@CAE@E@C@A@CB@E@C@@@AA@AACAA@ACAC@EDGDADA@A@C@A@A@E@CBCBC@A@A@A@A@A@@@ABA@A@@AA@A@A@@@ABA@@@@B@@AB@@A@@@ADAB@BB@@B@@@B@@A@AB@@@@@B@@B@@B@@@@@BAB@BAB@@CBABA@@@AAAB@@@@@B@@A@@@A@@@@@A@@@A@A@@@AB@@ABA@CA@B@@@@@@ADA@@@AA@@A@AA@AAAAAAA@@A@@@AAA@A@A@AAA@@CAC@@AAEAE@A@E@CB@B@DAB@BE@GBE@EBG@A@"],encodeOffsets:[[115165,38525]]}},{type:"Feature",id:"140108",properties:{name:"尖草坪区",cp:[112.486691,37.940387],childNum:1},geometry:{type:"Polygon",coordinates:["@@BAA@@A@@@E@@@@@A@@@AE@@@@@C@ABA@@@@@@@@B@@@@@@@@@@A@@@@@@@A@@@@B@B@@A@@@@@C@@@@@@@A@@@@@A@@@A@@@@B@@@@@BA@A@@@@@A@A@@@A@@@@@A@A@A@A@A@C@AA@B@@@@@D@BAD@B@@@@@@A@A@@@A@A@C@C@A@@@A@C@G@E@A@@@C@BFB@@@@DD@@B@DA@@BE@@KE@@D@D@B@@@@@@A@@@@ACC@@AA@@@@@@@B@@@F@@@@@@@@A@@@CA@@@@@@A@@@AD@@@B@BA@@B@B@@@@@BA@@@@B@@BB@B@B@@@@ABA@@@AAA@@@CBABG@ABABC@IDA@A@AAC@C@C@C@A@ABAD@DADGDEDEBGBIBCBABAJCDABA@A@ECCAA@AEIECEAEAAC@E@A@CDCHEBEDC@CBC@E@ABE@GDOJGFAFBDHFLDBB@FAP@BAB@BC@ABA@A@@@@B@B@BBBBBBBBBF@D@@ABABAB@BAD@B@AB@BA@CB@B@BB@BDFDB@BB@@@B@@B@@@B@B@BA@AB@@@BBD@@BBB@B@B@BAB@BADEBC@@B@B@@B@B@B@BBB@@BABA@CB@BAB@@BBB@B@B@BBD@B@B@B@B@DA@@B@B@B@B@B@BBBBBDB@B@@@B@BCBABAB@BAB@BB@BBD@BA@ABA@C@ABA@@@BBBBB@BBDBB@@D@B@B@BA@ABCBA@@B@B@@B@B@DADAF@B@B@BBBBBDB@B@B@BABA@AAA@AAAF@D@HBDD@DCFCDADB@BD@D@D@HBF@DBDDHBDEHABBDNHD@BC@CBADBBBBBDDDDFBBDDBBDBBDBBBFFFBB@RK@ALG@ADC@E@GBADAF@D@D@D@DBF@DAB@DAD@@@DCDCBCDEHAH@F@B@DB@FBDDBF@F@@AB@B@BAB@B@BBB@B@B@B@B@B@B@D@B@B@B@B@B@D@BBB@B@B@BBB@B@B@BBB@B@B@F@BCFADCDAFADAD@DADBFDDBFFHDBDB@B@@EBODCBC@G@ABABCBABEDEDEBCGCBCHFDGBABABEFC@@DC@AAE@@A@CCCC@A@ABABADCDCBADAJERGHI@@GBCBC@CAGDMAICCAEABEBE@CABKDGBM@ECCEAAA@A@E@AHGBC@IEEIC@@CBCB@@@HCB@@@@A@@@AA@@A@A@@AA@@@A@A@@A@@A@A@BA@G@CBG@@@@@@@CBC@@E@M@C@E@C@A@@@G@ABI@@D@@A@@@@@C@@@@@@@EC@A@@@@A@ABA@@@@BACEA@@@@@C@@A@A@@@@@A@@@A@@@@@A@@@@@A@@AA@CA@@B@BA@CAA@@@AAA@A@A@A@A@@@A@A@CAA@@@A@@@A@@A@AAA@@AA@AA@@A@@A@@AA@@@@AA@@@@@@A@@AC@@"],encodeOffsets:[[115239,38798]]}},{type:"Feature",id:"140107",properties:{name:"杏花岭区",cp:[112.570604,37.893955],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ADADC@EQI@CBIDGAEE@YCE@_GE@KAE@@@A@@@@@A@@@@@C@A@@@@@@@EB@@@B@BC@A@@@C@A@@@A@ACA@@@G@A@@@@@C@@BB@B@@DCA@@G@A@C@A@E@C@A@@@A@@@@@@@A@A@@@A@@@A@A@@@@B@D@F@B@BC@@@AAA@AB@C@@A@A@A@C@A@@@A@C@A@@@@@E@@@@@@@@@AB@@A@A@A@@@@@@@A@@@C@@@@@@B@@@A@A@@@A@@@@AA@A@@@@ABC@A@@@A@A@C@@@@@A@@@@@@BC@A@@@@@A@@@@@@@A@A@A@@@@@G@A@@@C@@F@B@@@@@F@@@D@@@@@D@B@B@@@B@@@@@F@@@BB@AB@@BD@@@B@@@@B@@B@@B@@BB@@@@BB@@BBB@@BB@B@BB@@@B@@@B@DBB@B@@@B@B@B@B@B@BB@@B@DBB@@A@AB@@DBB@@@B@@@@@B@@@@@B@@@B@@@@@B@BD@@@@@B@DFAB@@@@AB@B@B@@B@D@@F@@@@@@@D@@@@@BC@@@AJ@B@H@@@B@D@F@D@N@FD@DA@@@@@@H@DAH@B@@A@B@BB@@@@B@B@@BB@@@B@BB@@B@@@B@@A@GD@@A@AD@DD@FJJFD@HABGF@B@B@BBDFFDN@HALCBA@DAFAFFBDBJDNBHCDBD@DAHA@@@@DCB@@@BDD@BAHAHEDCBA@ABA@AD@DABADADC@@HGNGDALEHGFAJAXAB@FADAFABBD@FDDDDBBD@DAD@B@BBBBBDBDBDAFCHEHCNGLGA@@C@ABADCDABABADABC@ABC@@ACCAA@GAACCCAAAA@AADA@AB@BA@ADC@C@EAECKAA@ADCDE@IAC@MBIAEGQAEBABC@EEECQAEECIAAEICC@A@@"],encodeOffsets:[[115382,38804]]}},{type:"Feature",id:"140106",properties:{name:"迎泽区",cp:[112.5634,37.863451],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@AADAB@BAB@@AD@@@B@@@@@@A@@B@B@@@B@@@@@B@B@@@H@@@@@B@BB@@@B@@A@A@@B@@@@@@@@DBDD@@@B@@@@@D@@@@@@AD@@BB@@BD@@@B@B@@DA@@@@@AB@@D@@B@@@@@@@BA@@@@BC@C@@@A@@@@@@AA@A@@@@@A@ABA@@@A@C@E@@@@B@D@@@DI@A@A@C@A@A@C@C@@@@@@@E@CAA@A@A@@@@@A@@@C@@@@@@@C@A@@@A@@@@@E@A@A@@@@@A@C@A@@@@@@@@@A@@@A@@@@@A@C@A@@@G@@BAD@D@D@@@BAH@B@D@B@B@D@B@@@@@B@@@@@D@B@@@D@B@DBFD@@@B@H@@@@@B@B@B@@@@@@@B@@@@@B@D@@A@@@@B@@@@@D@B@B@@@B@D@BA@@@@@BBB@@@@@B@@@B@B@@@A@@@@D@@@B@@@@@@@B@B@B@@@BA@@@@@@@@F@@@@@B@D@B@@@B@D@B@B@B@@@@DBAB@BB@@D@@A@A@E@C@A@@B@B@@@B@@@B@B@@@@@@@B@@@B@D@F@B@D@B@H@@@DB@CA@A@@AD@@@@@B@H@@@B@BDB@@@B@D@@@B@D@@A@A@@FA@@@@@@B@D@@@@@B@@@@@B@@@F@LBF@`HF@ZDF@BFCHAJ@DRJ@FCDCB@B@@@BDDFJBBDJFFRBFDFFD@BAFARBFHJBNAD@JBF@DCBCB@LBFDFBD@D@BCB@@ABAB@BC@@AABCBABCBABC@A@E@C@C@CBADCBCBABCBA@C@A@A@ABCBCB@BCBCBCBC@C@CA@A@C@EFCFCBEDEDEBEDEBC@@@@@@@CBC@AAC@AACACAA@@@CAECA@@@ACAA@CAE@E@C@CAAAAAAAAC@@AA@CC@AACA@CAEAEBIBA@I@AABCBC@A@EA@I@IAC@@B@BCBIDAHCBK@ECEGCCCAGM@@EG@EEC@@MAECCEAAAAEEE@CBEBACE@EA@@ACC@C@E@@@A@@@A@@@A@@@@@A@@@@@AB@@@B@@@@@@@@@@@@@@@@A@@@@@@@A@@@A@@@@@A@@@@@@A@@@AAA@A@@E@I@E@G@@@@@@A@A@@@A@@@@@@@@A@@@@@AB@@@@@@A@"],encodeOffsets:[[115295,38717]]}},{type:"Feature",id:"140110",properties:{name:"晋源区",cp:[112.47794,37.715193],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@C@GAK@ECEAKIAA@@AACAKSCEC@AACIKQAAA@A@IBEBGHCHCF@BCH@@@@ABAH@B@BDJ@FCDAD@FDF@FADEDCDGJAFADAFCDC@GBEBCBCD@BADEDADEH@@CJIJAB@FDFB@BB@@@BB@BB@B@B@B@B@@ADAF@DEF@DAD@D@BBDDBDDBBNFHD@BFNBD@DABEBCDAFBH@H@FADEHEDABC@@BAD@BTDbHPDLD`LNBD@HBH@F@LDHDDB@@@L@DBDDDBBFBD@@@DBDBDBBBH@D@FCNIPID@H@H@N@B@@@BA@@FA@A@@@@@A@@AA@A@@@C@A@A@@BAB@BAB@B@B@B@BBBB@BBBBDBF@BBB@@@BAJ@B@@AB@@@@A@@@C@A@@@AB@@@@BBBB@BB@@@AB@@ABA@@B@@@@B@B@BB@@@@@@@BB@@@@@DA@DB@BAB@B@@ABAB@B@@@B@B@B@B@BAD@@ABA@@@A@A@@FA@@D@D@@@FAB@@@@@@C@@@@@@BA@@@@BAA@@CA@@AA@DAB@F@@A@@@A@@B@@A@@@@FAAAA@A@@@@A@A@@@A@@B@B@D@@@@@B@@@D@@@CHB@@@FA@BD@@HDJFA@FN@@C@CAE@@@E@A@@AC@C@A@A@IACAE@AAEAA@CAE@ABGBGBCDIBCDGDEBEDI@A@@@@@A@C@GBC@@@A@I@E@@CGEIACGIAAEIAACCQKEGEEEKKGC@@AC@AAC@C@E@C@CBABID@@A@A@C@@@IAMIKKAA@@EEAAAA@@@@AAA@EC@AEC@@AACCAAAA@@AACA@A@@EC@ACAAA"],encodeOffsets:[[115138,38551]]}},{type:"Feature",id:"140109",properties:{name:"万柏林区",cp:[112.515937,37.85958],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACAA@AAAAAA@A@A@A@ABA@AB@@@B@B@D@@@BBB@@@B@@@@@BEB@@AB@@A@M@G@G@C@OJMJEDC@G@AACACACA@@C@EAAACCAC@C@K@@CAGCKCE@G@GAC@MA_KKCOCaGSCDD@D@BAFABABCF@BLLFB@D@F@JDFHJBDCDEBEBC@C@E@CBKDKHCDC@C@CAA@ABARKJSVCPENBFHBP@LADBBFINADC@OEE@CFQhKTCH@DHDB@BBB@BBD@B@B@@@@B@@AB@@@BB@@@B@BAB@B@D@DBDBB@@B@@@BA@@BA@A@C@CBA@A@A@@@AB@@@@@B@@BB@@BBB@B@@@B@BBD@D@B@D@DAF@B@BAB@BABA@A@@AC@CA@BA@A@@A@@AAAAAA@AA@A@@@A@@B@@@D@@@B@B@@B@@@B@B@@@B@@BB@@FBF@@@B@B@BAB@B@@B@B@@@BCBABABABB@BBBB@@@@@BA@AB@B@B@@@BB@@B@B@BBBB@BBB@B@@A@A@@BA@@BCB@@A@A@A@A@AB@@ABAB@B@BABB@@@BABA@@@@B@B@@@BBDDF@BDBBBBB@@@B@B@B@@BB@BBBD@B@BBBDBDBF@DABCBMFGFCH@BDDD@D@F@B@FBBBB@D@HAFABCD@F@HDF@HAFCE@AAAAAAAA@A@A@A@@B@B@BAD@@ABA@ABO@EAAKCGEACBEHEPIHCF@BAF@D@DAD@FCFADGDCB@F@D@BBBFDFJFBFB@DBFDB@B@BADCBIBADAJAHAFAFCHCBC@CBCBAB@D@D@D@D@BBB@B@JCD@BABAH@BADA@@B@BB@@B@BA@@@@@A@AAA@@@A@@B@@A@@@@@A@AB@@A@A@@BC@@B@@@@@@@DB@@B@@@@@@@@@@E@@@A@@@@@@BB@@DD@B@@B@@@@@@@@A@C@CF@@LF@@AB@@C@AC@@C@@A@AED@@@B@F@H@D@B@@@B@D@D@B@B@@@B@B@@@@@@@@ABC@A@C@@@@@ABBD@B@B@B@B@B@@@@@B@@@B@B@@@@@B@B@@A@@@@@A@@B@@@B@@@@@B@@@@@@@D@@@@@B@@@@A@A@@B@@@@@@@B@@@@@@@@@@@@A@@@@@@B@BAD@@@@@F@@A@C@@@@@C@@@E@@@@@A@EAE@C@A@C@@@A@C@@@@@A@@@@@A@C@A@A@C@ABG@A@@@C@CBC@A@@@@@@BC@@@@BCBC@ABGBA@@@@@G@@AE@C@@@@@@@@@@@@@C@AM@@EEBCI@GC@@AEB@@A@DG@@C@@@A@@@@@C@A@A@@@@B@@@B@B@@B@B@BBEB@@@@@BA@@@@B@@@BE@A@CBB@@BB@@DB@AB@@@@AB@@@@@@@D@@@@A@EB@@C@C@@@EB@@@B@B@@AB@BC@ABA@A@A@A@@@A@A@AB@BA@A@ABA@@CCB@@@@A@@A@@@@@@AAA@A@@@@@@AB@BA@@BA@@A@@AAAAA@@@@BA@@B@D@@@B@@@@@BA@@@ABI@A@@AA@AAE"],encodeOffsets:[[115188,38711]]}},{type:"Feature",id:"140121",properties:{name:"清徐县",cp:[112.358667,37.607443],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@GAIBC@ACEECCG@E@A@CBCDABADEFEBOBC@E@AC@@EIAAAEAMAAAA@AG@A@ABC@G@GDCDCBG@EAGAEAIB@BCDABCFAFAFDP@BCBO@G@GBAAA@EAIBA@E@@@ACEOBC@ECGCECCC@K@C@CBAA@@@AAAA@@AA@CBCAAFEDA@GDABED@BBJADGBEBOBCBED@@GFGDAD@@@DHBLFVBF@@@BBB@@NADCDQ@IBAFADBDBF@B@B@BE@K@G@CB@@@DC@E@I@@D@BBFBFBBFBDDDDJJLJ@H@JALAFCBC@KAA@KAAASACAI@E@M@C@GBCBGDEH@BGTAH@BAJADEFEDMBCBEBAH@BA@AHAB@@@@CD@@ABA@CDCBC@@BABA@AFADAB@@ADCD@@MHKDAD@D@H@D@B@FAFAJ@HFFDJBFF@DDBB@BBD@BBBB@D@FBBBBB@BBBBBBB@@B@D@B@HB@@D@DAB@D@HDBDBB@DDBD@BADAF@HBB@BDBD@BBBF@DBD@FCB@FABDD@LLBD@BEFAD@DDDJL@@DFDFJNFHBBJJNPLHNHB@HBDDJLDDB@@@B@DBDDDDDDJFBBFB@ABC@AD@BAFCFGBC@E@GAGBEDCFABA@CACEM@AGCMEAACCCAAC@A@CBC@CFE@CBEBC@@@A@A@A@AAAA@@A@@AAA@CE@EBAJIDI@@FGBCFCBC@ADCDAFAHAD@DCBEBCBEHIDCFCBC@ECE@EBCDC@ECI@A@ABGBA@@@@DG@ADEDGHGFAJAB@B@BBLRDJBBD@@@B@DAJGBCBEBCDCNGFAFCPILAH@D@FDRHDDBF@B@L@H@DBDB@D@B@H@FAF@HAF@@ABA@C@ADAF@B@F@FBBB@@BD@DB@BBB@B@B@BB@@B@@@BBBBBB@BBBB@@@BB@@B@BC@@@@@@@ADBB@BA@@BA@@B@B@@@B@@@@@B@@@B@@@@A@@@@BABB@@B@BADA@@BA@ABA@A@@@@@AA@@@@A@@@@BAB@@@@A@@@AA@@ABABC@@B@@@BA@@@A@@B@BA@@B@B@B@@BB@B@BA@@B@B@B@B@B@D@DADAF@B@B@D@B@B@BCHCFCD@DB@BBBBD@BBB@@D@F@@@BAB@D@HAFAB@B@DBB@@A@A@@D@@ABC@C@ABCBEDEBABABABCBCBE@C@C@E@@@GAIAC@A@A@C@C@ABADCDABA@ABA@C@C@ABEBA@@BAF@F@B@DAB@B@B@@ACEACCCEAE@E@GACBCAE@AAC@CAC@AAA@AACCAA@A@C@A@CBC@AAAACCBGBIBGBC@ABEBE@C@ABA@ABABCBC@EBC@CBE@A@C@A@AA@AAAAAEBC@@@C@@@@@A@AAC@CAEACAE@E@E@C@AAA@@E@E@C@A@C@E@K@C@C@A@GACAAAA@@GAEBEAEACA"],encodeOffsets:[[115193,38375]]}},{type:"Feature",id:"140123",properties:{name:"娄烦县",cp:[111.797083,38.067932],childNum:1},geometry:{type:"Polygon",coordinates:["@@FCLCRCHADAFCFE@A@CCAGAACCGEACAMAO@GAKAQCOEYKMGKEECIEM@ICACBKCKCIAAG@@@G@CBCAA@AAEDEBCDEBC@C@AACAECC@E@E@GBEBG@QDC@CBE@EAKEI@G@IBCBEBCBABABCDCBABABAAAACAIBE@GBCAC@AAC@EBGBCBCBE@C@C@E@E@CBGBCBEBG@E@E@I@GAG@E@CAEBCACE@CCCCCCCCCECECCCAC@C@CBCBEBCA@E@CBABG@E@E@CACBABEDE@GBICE@GACAEAAAAEACACAACCCAC@C@E@GBC@E@CAECAAECCAA@C@A@C@CDCDEBA@C@A@AACACAAAC@ABC@EBG@C@EACACAEAEAEBIBG@C@GAEACACAACCCAAA@A@A@A@CDCDAF@DAFAD@DAHBF@FAFAB@BCBCBEBEBE@CDC@A@@BDBFBFBFBDBDBFDDDDBD@DBD@DBBDDBBDAFADADABCF@D@D@BFH@BCJEHEBCBIBEDEDCFABAD@DCD@BABABADCBAB@FCF@F@HAD@D@DCBABABADBF@D@F@D@DAD@BADCBCBABABAB@@@@@@EBABA@AB@BAB@BABAB@@AB@B@BAB@B@@AB@B@BAB@B@B@B@B@B@@BD@B@BBB@@BBBB@BBBBB@BBBB@@BBBB@@BBB@B@BA@ABA@@BABA@@BA@ABA@@BABA@@BAB@BA@@B@B@BBB@BBBDBDBBD@F@BABBB@BB@BBD@@@FBTDHDBBDD@F@HBHHDPFJFHDRDJ@R@L@HBNBH@NBPDHHFDJHLHHDHDDBNDNHHDB@FDJB@@H@D@FDDB@@BDNLHFNLJJDBBDDJ@DENBFNFJ@FBD@LBFDFDD@HAFGB@D@D@FBJADBDBDHBFHAFDBBDBD@F@HADB@BAD@BF@DAB@D@DBHBB@CFADDFDHFDHBDBBFFBH@LAF@LAN@HD@DCDKDABBHBFDBF@VGHAB@FADGB@AKCKAGAEDEBC@@@@@@JEN@FBFDDD@BD@JAHAHEFGHGNKP@PEJBHCJGHGFCH@JBBDDHPHJAPFHDTBLJHBF@DIHCJAJA@@RGJCDAJCAACCAECCAAAECACCECICCCCCACCCCCAAAACACAAACEAC@ABCBCBE@A@CAAAACACAAA@C@CBAFEDCFCDABC@C@@BGHIBC@IAMDABEBCBE@AAAACAAAAAAAAAC@EAA@C@E@C@G@C@A@CAAAEACAC@CAABABABCFCDAHCDADCBABCBCDCDCBABCDEAC@AAA@C@C@C@AB@DA@@BA@CAEA@@A@A@CBC@ABA@A@@BABA@A@@AAKAG@GDKD@@AA@A@E@A@@E@MBA@AA@ABADEHALE"],encodeOffsets:[[114712,38873]]}},{type:"Feature",id:"140122",properties:{name:"阳曲县",cp:[112.672952,38.058488],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACA@BCDCDE@CCCGAC@E@BB@BBB@BABABA@A@A@ACAAAAA@A@E@CBCBA@A@@@@A@AB@DABAB@@A@A@A@CA@CAAAA@AAAA@@B@BAD@B@BAB@@AAC@AAAA@ABA@ABABADA@@@A@A@ACAAAAA@A@A@A@A@@@CBA@A@A@A@C@AAA@A@A@AA@@BA@ADAB@BA@AA@AAA@A@A@@@@A@AD@FABC@ABA@A@A@AAA@AC@AA@@A@@BABA@A@@@A@@@@A@@AAA@ECACA@@A@ADAB@@ABAA@C@ABA@ABAB@BC@EDGBE@GCE@C@ADEBGBC@A@ADCFCD@@ABBBBB@@@BABA@GBA@@@ADAB@@A@@@A@A@@BA@@B@@BB@@DBB@@BCBABADADCBCBADAD@B@D@DAFEBCDEDCBCBAFCDEDADCFABADCFABCD@BABA@ELCBKESEC@IFENFDCDCDCBEFGDQ@C@@@ADFHBJDBL@D@@BBJGDKFIDADB@BB@D@BCFCDEBEDGFABCBC@EBCBABAB@DBB@DDBB@DDDDDBAD@D@BAFALBBJDH@JELAF@JFDDRDHFDHDJDJBHBDFHDL@LCLIDG@EF@LAJALAHEHGFGDKHEHILABCHEH@LB@XJXFfHL@BDAFGFI@MFGFCFAF@HFFLJLHNFFJDF@ABAB@B@BAB@D@BBD@B@BAB@B@B@BA@@BAB@BAB@BAB@B@B@D@B@B@@AD@B@BBB@BBB@BBB@B@BBB@B@B@BBB@D@B@B@@ABA@AB@BA@@JCJAH@FAHILGJIHMJINCH@N@H@JEPIRAFBHJJDJDHDLDJ@HADAFEDK@GFEFEDKDIFAJAD@DEJEJDF@H@FCHCJCB@J@DDBBHJDBHBLBDB@B@D@H@H@F@F@DBBBBBA@ABAB@BA@AB@BAB@BAB@BA@@B@DA@@BAB@BA@@BA@A@AAA@A@A@@BAB@B@B@B@B@BAB@BAB@BA@@BA@A@A@@@AAA@A@ABAB@BAB@@AF@FABC@EAECGCCCCDEFGDCFCDCJAHAH@HAH@HDBBFBF@FA@@FAHAFCJ@F@DBFDDBDDJDFDDFFHFFFD@D@DAHAB@DC@AFCDAD@BDBFBBAJALAPABAFAJCFCDAHCHBHAB@FB@B@B@FBBD@H@H@FBFBBAF@F@B@FAFADCFAB@DBBDDBBBF@BAHAJ@NDJBJBH@LBJDJ@NDJBFDBBDDBDFFBBDB@HBD@FB@@BDBBFDDDFFFBBBDFDHHHFFFHDDBBBJDFB@@F@H@HBFBB@HDF@HBF@HDDDHDH@HADBDBHBHBDBHFBBDDFDFDFBF@H@JBHBF@FDDBDBDFFHBDF@FFFHBDFBDDDABC@E@C@EDEBCHCJ@DBBBHAFADBFBDBHD@DFDDFDDDBFDDD@D@DDDFBDBFBBFBDBHBDDDFBHBFDFBFBDDLDTCFFRFDD@FETCDBDD@@@DBDDFBHBJBJ@JDJBJ@J@LAHAJAFAFADEDCAEC@AA@AAA@AAA@A@A@@@A@A@A@ABA@A@A@ABA@@BA@ABABA@AB@@AB@@ABABA@@BA@ABA@A@ABA@A@C@AAA@A@AAA@AAAAA@A@AA@AA@AAAA@AA@AA@DINKLI@KCEGKACAAIGCCC@CCAA@ACCGAGAC@G@CBCCEAAACCECE@EBGBABCACAEAC@GAEBGACBAACAAEBAACCAI@AAAC@AAC@A@CAEAECCGAEA@A@EGEE@ECE@G@ACAC@CAG@AAA@AC@AAA@AA@@AAGAE@EBGCGCGEEAGCGCGCACCCEEIAEAC@AAACAC@EAEECECC@A@A@AAA@AA@A@AAA@A@A@ABA@@BA@A@A@AA@AAAA@CA@AAAAAA@@AAAA@@AAA@AA@AAA@AB@BA@A@ABAA@A@A@A@A@A@@AAAAA@AAA@AA@AA@@AAACFG@A@E@MAAACCAKHG@GC@@CIBCDC@C@C@A@CDCB@BAB@B@@@D@@ABABABA@AB@BAB@B@B@B@B@B@D@B@B@BBB@BBB@B@DB@@B@DBB@B@BBB@B@BA@AB@@A@A@A@ABC@AAG@GBGDEB@DCDCDEDAFCBCDADADCB@DADCDAB@D@@@B@@CBCDCB@JETKBCB@DBFBHBJ@DADAJ@JCBA@C@CBEDCFEFEHADAF@DAF@BB@CBG@C@AG@AAA@AA@@AAAAAA@@AAAAA@A@AAA@A@A@A@ABA@A@A@A@A@IBI@IBA@C@A@A@AAC@AAA@AAAA@@AAA@AAAAA@@AAAA@AA@AB@BAB@B@@AD@@@BABAB@BA@ABCB@@ABABA@ABA@@AAA@C@A@@AAA@A@AAA@@AAA@AACBAAA@A@AAC@@QAE@@EEE@KAG@GD@@ABAB@BA@@BMNMFE@CCEC@AAE@CCECCCACC@@EAACAAAAAA@AAAA@AAAAC@C@CBEBEBCBCBC@ABC@C@CAE@CAGAE@CAEAC@A@CBEBABABEBC@A@C@C@CACAA@CCEAC@CAA@CAC@E@A@C@C@A@ABABCD@BABC@CBC@C@C@ABA@EBABAB@BADABC@C@AA@AAC@@A@C@CACAACAC@CDCBCBA@A@A@AAA@AA@CCCAA@CACACAEAECEEECCCCECECCECEIEEGICAEE@AKHMHGDGFEDCBCACAAAAA@A@ABC@CACCACCECC@AAEBCBEBA@WBIBEBGHKFCBMHGH@@CDCBABCBC@@BAB@BABCDGFGBABC@AC@@A@CD@@GJQHIFCBABCDCDABAB@B@BDDDDB@@@BF@BCD@@EDAFABABCHGEADHDADCFCFAFABADAB@B@HADCDAP@FA@A@ACGCEECAECCACBC@CBEBCBCDEBADE@A@A@A@AAA@A@A@AAA@A@A@AAC@A@A@A@A@A@C@A@A@A@A@A@A@A@AAA@A@ABA@A@@BE@E@CAAC@ECAA@E@G@GBCFADCDCD@@C@CBA@CBE@CAC@C@C@E@CBAB@H@FCD@BKH@BQLA@EAEEAACAAAACCAACEACCCCAAAACAAB@DADC@MGACBAFGACCGAC@CAE@G@C@C"],encodeOffsets:[[115128,38942]]}},{type:"Feature",id:"140181",properties:{name:"古交市",cp:[112.175853,37.907129],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACGCC@A@CBC@@@GAA@C@A@@@AAAAAA@AAAAAEAC@A@AA@AAC@AAACCE@A@EBABDBBDABA@MBCBGDAHEDABC@C@EBKBEBC@CBA@IHAFADABA@A@GCC@A@ABKNKNEHA@MCEAC@EBGDG@E@C@C@@@GCE@MCKCKAI@I@EBABCBEFCD@@ABABABAD@BABA@@@CAAAEAEBE@E@G@G@E@EAEAICGCEACACAE@C@E@CBCDAFADAD@@CFAFABADADCBC@C@KAIAEAAAAB@BBF@DBFADADABCFEDCDCDCH@DADADAB@FBDAD@B@DCBCBCDCD@B@B@D@DBB@DBD@BADCBEBCDC@EBC@C@CACACACACAAAEAA@C@C@GBGBE@E@C@K@G@E@E@CBC@CAA@E@CAC@E@C@CBE@CBAB@BCD@D@F@DABAB@BADCDCDEDCBEDGBCBCBEBADABCBE@C@C@E@C@EBABCBCBAB@BAFBD@D@BCBEBEBEFEDCBGHCD@DAD@D@DBB@D@BBD@BABABG@GBADAFAD@D@DBDDDFDFDDDDDDDDD@DDFDBFADBF@H@HBJ@F@F@H@FADAHADAF@F@D@D@F@DADAHAFAD@BBD@DBHAF@JADBBBBBBABADADCBABADAFADAJAH@J@LFFBF@DAD@RCH@FAHAF@F@D@FDDBBBD@D@FADCFAFCBBB@DBDAH@@@H@BBDJDLALBDJDN@JFFDLFNHZLPFRDLBHBP@NBDBFBDHBDHBDB@D@BEFEDCBGBQDKDEDKFGBCFAB@BBBB@NAF@@@@B@F@BBB@@LCHCH@LBBB@@@BABAB@@@BAB@BAD@D@B@BB@BF@DAB@@CBA@@B@D@D@DBB@BBDCFADABCDCDADADABCDCBGDCBEDADABABBB@DBDBDBFBB@D@B@D@H@D@F@DBB@FBDBBBBBBBBBDBB@BAFADAFCBBN@JADGJAH@@@DADCBEDCDEFAB@D@DBBDBDBBBBB@D@BAFADAD@BBDDFBBDBDBBBBBDDDDBDDDDDJDFDDDDBBFBBDDBFDDBBF@DBJHF@HERED@FCDCHBLDH@JAHAJEDEHEHAFCDILGFGLCHFDFFBLBF@PBNCPAFAHCNCNANBJGHGFCBCCACCCCA@CA@CAA@CBABADAFAD@DABAHEFCFADCDE@A@CAAA@BCJCLEHCAI@AC@K@CAAIEGBC@@D@R@HCFEDADCDCECFMJED@TFLFDAFKB@BA@ADCBADEBCBADEBCFCDCBEDADAFCDCFABE@C@C@ABCBCDADABCBCBADA@AA@CA@@AA@@@AB@@AB@B@@@B@@@BABC@@B@HAB@BA@A@@AAAABA@@DCDEBCAAEAA@E@C@C@CC@ADGHENEDABA@CAEACACAAA@C@AA@AAA@@@A@A@A@@AAAACA@ACEAC@A@@@A@A@@B@BA@A@@AAABA@A@AB@BA@@B@B@B@B@BA@AD@@AB@@@B@BA@A@AAA@AA@A@A@AA@@A@@@A@ABAB@@A@@@@AAAAA@BABABADA@A@@@A@AA@A@ABA@A@@@E@EA@@AA@@@A@@@A@A@@@AA@A@@@C@@@A@@@@B@@@BBBB@BBBB@BB@@@@BABB@@DBD@@@BABABA@ABA@E@CBC@A@C@C@AAA@@@A@A@AA@@AA@@@A@@@@BA@@B@B@B@DAD@B@B@@AB@@A@@@AA@CACAC@A@A@ABA@@@A@@A@@BA@@@A@@A@A@C@AAA@AAA@GC@CDGLSRgDEF@PFD@BCJMAECAKBO@GAAEFMDOTULIBQBAB@DBD@D@DCLGLCDAF@D@D@FAFADCACGICE@I@E@CEAKK@ADEBABABE@A@CCCEAAAIECCCCCCCAA@@@A@CCIKCCGAA@MGKGMOIIAAEGIMCECE@@IKCC@CBCFE@AACKKC@ACEBA@EDC@CAE@AA@AACACA@GAE@CBABC@CA@CAA"],encodeOffsets:[[114910,38593]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("忻州",{type:"FeatureCollection",features:[{type:"Feature",id:"140902",properties:{name:"忻府区",cp:[112.746046,38.404242],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAICAACAGCEEGEGGECACAAEECECCAECA@AA@@EAC@GCAAAEEACCCAAECIAMCI@ICKAG@IAIAMCI@GBABE@AACAACCAA@EBCDEBEBA@E@E@ABEAEAG@G@C@AA@E@A@AEAA@GBGAGDCBEDIDEBABOBKBIBABEACA@ABCDCBED@@CBABG@C@CECEEEGCEECICCCCAECCAE@I@EDGBEB@@EBE@EAAAGCG@GBG@GBIBCDEDCDEHCFDDDDDHBF@FADEBE@@BA@ABA@AB@B@BBB@B@@@B@BAB@@ABA@ABA@ABA@A@A@A@A@AB@@@B@BBB@B@BAB@@ABA@AB@@CBA@@@ABA@ABA@ABA@@BABA@AB@BABAAAA@C@E@E@G@G@C@ACAKAGACAGIAACCI@A@IDGDEDG@E@ICIFCFC@IBEBCJCLEFEF@HCLEFCBGBI@KCGCICICGIEAQBOJIFG@M@G@MDIJGNIJKHGJEBG@IBID@@ABA@@BAB@BA@A@C@A@AAA@A@A@AAA@A@AAA@AAA@AAA@C@@BA@A@C@A@A@A@ABA@ABA@AB@@ABA@A@A@ABA@C@AAC@A@ABA@A@AB@BEBABADABAD@B@BBB@@BBBB@BD@@BB@BBBBB@BDHD@@@@JHXHVLTH@LDTNPHJLHDHBD@PCJMP@D@FDPFHDPDLALEJABIFCBEBIBEBQFIHEPBNFPLJFFFLDTAB@V@N@BBL@VDHBHDLFFFFJHNFJFRJLNJTNNNJHFJH@@@BBB@FBDBBDBFDFBBBFDDBB@LJD@D@BBBDBBDFDBBBDD@D@DBBBDBBB@DBDBJBDBBBDB@DAHEH@D@B@F@DBDBBFBF@F@PCFAHAD@D@@@B@J@PALKHIROBALKHIDEPIDCPCLGFGNMJOB@B@@ABABA@@BA@AB@@@BA@AB@BCBA@@B@@@FAFAB@B@B@@@D@D@DAJCJ@FBHBLBFAFGBEDEDCD@B@D@B@JCD@D@B@B@D@B@B@@@F@@@@@BBDBBBHBDB@@DAF@FADAD@B@@@DBB@DDBBBBD@B@F@D@B@D@BBBDBB@D@B@BDF@BB@LDRANALBJFNHB@@@LHTHL@LB@AHEBCFCHCBAFALAFCHEDCB@@@BCFAHAD@FAFAH@HAFB@@HBDBDAFAFAH@FADCFEBAHAB@DAFEHGHEDEBABABC@EBABEDERWBCDCBCBADKBGBGFKJEDCKE@AACCQCCACECEAC@G@A@AAAAAC@A@CBCFCDCBAACCAAE@CDCFAF@B@H@JBD@DABCAE@G@CAGCACCCC@AAAAAACAGACCECACCCEB@DBBBB@FBHDHBD@F@H@\\@B@B@B@@AB@BBF@@ADAB@@ABA@@BMG@DC@EBIMM@A@C@C@@AE@ABG@CA@@A@A@C@@AA@ACAAA@EBCDGFEDAB@D@F@LBJBD@F@B@BCBABCBCFCF@HALAHED@JCHADAB@DABABAD@CCEAACEGEEE@ACEGCECACAECE@GAIAG@E@EAECECCCAAGECAGAGACACAGBG@GCCCGCE@GAE@GCA@EAGAG@E@@@"],encodeOffsets:[[115560,39226]]}},{type:"Feature",id:"140921",properties:{name:"定襄县",cp:[112.957237,38.473506],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CCCECCACCCEEC@CGCCAEACAEBGBAACAI@GDADCF@F@D@FADCBC@ABABCBA@CBGBIDC@GFKBGBE@EDADADABADA@E@C@IAKAE@C@A@CBEFCHAD@FBBDB@BBB@@@D@B@BB@@DAH@BBF@@@D@D@BNNAJ@FCDH@AN@@AB@BA@CB@BE@AAA@@BA@A@A@[@G@E@C@GAGCEAA@AACAA@DFDDDBDFBDBHBDBBBB@BDDDDDBBH@D@HBFADCBC@IAG@A@E@EBCD@DBFDBBDABCDEDAD@D@BBDBBBBB@H@D@FBFDBDDDDRBD@BLFCDIFELAHAHCLABADCDADQXCFAFAB@FADABABCFGFGHEFCBA@GBABEFCDEBG@EBEBCBCAGA@@EAGBG@EBEBC@GBEBAD@@A@CDGFEDKBEBABGDEDADGF@BVP`FNBJB^DDBHBDBD@RDL@J@LDJABEZGPCH@N@@@`HRDJBHBVCNG@@BADANMHGFEB@REJAT@DAD@RCTBZHTDB@HHDHBRFBB@FBFBD@DAB@B@@ABADABADDBABEBAF@DALB@BCHAHCHABDFFF@@NDDBLDJDPHFBFAFANG@G@CDKHSCKCEEGAIAELAJ@V@HAHAPGDCBEDCHIBABADGFIB@BAFKHEDANGJCDAPENEDATGDM@EBC@EDIDGTEFBDDFDFFLNBBJ@LKHO\\YNONMBKDS@AAMLMdOGG@A@@EGACCKAACCMGQCQC[KSIKECAcKCEGCO@M@KBIAK@CAEAC@EB@@ODIACCACCDCFEBEBIBGBKBI@I@IAICI@IAGAEACCCA@@C@ACDCFS@ECCQEEESDKCCCEAEAECGAEACCACAGACAEEACAEACC@C"],encodeOffsets:[[115734,39282]]}},{type:"Feature",id:"140922",properties:{name:"五台县",cp:[113.255309,38.728315],childNum:1},geometry:{type:"Polygon",coordinates:["@@MGQGcPKNBN@BCTALMNMP[ZGPKLI@AAKMEEECCCEASFCHCJ@FAD@FCNSHCBMFOFCBIDMHCBGFELABA@EJCHABABGJCDAFCDOHGBGBU@I@KBBFBJFHDFDLGTCL@D@H@FAJAHIHMFMHCFGJ@DCHANALGLA@GJGJCLBJ@H@@AJKPABGHMPOLOJIFSFOBMDM@O@IFEL@JDJFFLFNFRDTFPDL@FBHFBHBJANCH@H@HD@LBTHVBJFLHHHJHPFVBPGFEJEHCP@ZBJGHCFELANCRFFBPPJLFFDFDFJJRJHAPEPETCX@NLFFDFPFHDNHPBNDJHHHDF@J@H@F@LAJAHBB@B@F@DBF@@@BAB@B@B@B@B@B@DCBAFAB@@ADCBAB@@AB@B@B@B@BABCB@B@@B@DBBBB@B@B@B@D@B@FCB@F@B@DBB@BBBBFBB@B@J@B@B@@BB@BBBBHBBBB@BB@BDBBBPDLDFBJBNDF@B@BBBBBADAJ@HAJANCPAD@HCBEDCBAB@TEREPIRI\\CNBVFVJLFJJFHBFFHDFJRLNFDFF\\TFFHHHFFLBJBRJRLNPPLJNBRIREDATIHEXGFBV@RBZGJMFKDGDKDODMJM^IXFfFPDBBVFR@NCHATMLQFODIFAMEIQEOAKAIDQDSBMDSBIBM@E@M@W@KJI@@DEPKBEBEFILKNIRGVEPALGRKLGDUGOMEMIGKAQ@QDIDI@K@ISCWD]JMIGMGMIQEOCKAKCMGGQGC@_@CDIHSJQBMGCIAKDSBK@Q@K@ICICEOGUEOAQ@MAI@IBUBKDQDQAUKCIAECI@CACAOCKBQHKEQCGACMKMIIEEACACAGAMCEKCEAM@M@UEQEGGBO@QCG@O@K@GAEAUIQEKCY@G@KBGBQFIFSJI@E@GAEAGAKEGAAACAGAQIKGYGECCAWEA@MEEAWEaOMC"],encodeOffsets:[[116030,39400]]}},{type:"Feature",id:"140923",properties:{name:"代县",cp:[112.960282,39.066917],childNum:1},geometry:{type:"Polygon",coordinates:["@@IGGGKGIEUASGKAC@CBQDEBKFEFKJGNIPADKN@DEJCTEPAHABAJM\\QLOBMCO@QDEDH@@B@DEDABC@A@@BA@@@@@@A@A@@ENCHCFB@@B@BCBA@@@C@@@@@@@@AA@EDDBB@@@@BEFA@C@C@EACAIBGDMHcNEBOFKFKF[LCB@@@@@@KLENO`ELAFINCHININQNOHQJQDWNIHCJHJNF@BBBB@BDBB@@BBBBD@DBD@D@DBBBB@@BBDBB@B@BBB@B@B@J@F@H@BBD@J@B@D@FABCBCBADCBA@ABEDDFBDBB@J@JBL@@CD@@AB@BABA@ABCBABCBA@AB@@@B@BB@@BNABAD@HABAB@FBB@DBBBFBDBB@B@B@FBNDDBDB@@B@H@LCFEHIFGNKR@FDJLDFBF@JBHDLFJNDHBPBRBXBNJHN@NFPLHLHLXJLHPBLHLHFJFRFTFXBVBVFHBPHPFFBJDNDHBTFTH@H@BDDJBBD@BDB@BDBBBBBB@FFBD@@@DAB@DBD@B@BB@@BDBHBB@P@B@BBD@BABBB@B@B@B@BBB@BBBAB@BBBB@@@BBB@BBBFBBBB@B@B@B@B@B@FAJ@F@B@B@BADABAB@BA@ADAB@BABADCDAB@DABA@AB@BAAAF@FCAGCICIAIHELGNGLCDAHADCHCPCNCB@KWEQAGCGKICEEIFIRCNALCDAD@PGDEBCAE@E@AAI@ACOEO@E@EHIHI@@BABA@@@ABAB@BABABA@ADA@AB@BABCBABCB@DABCB@@ADABCBABADE@ABABC@ABABC@CB@BA@CBCBC@A@A@ABA@ABA@A@E@C@A@A@A@C@@@A@A@CAA@A@C@AA@@A@AAA@AA@CAA@A@C@C@ABE@G@CAA@AAAAAAACA@AA@AA@AA@ACCAE@G@A@A@CBA@ABC@C@AB@BEBADA@AB@@ADCBAJ@`NNDHAFIAQFGDAFDHFLBDAFGB@B@BADADAB@BAB@DAB@BAB@B@B@BAHAB@FE@@BABCB@FAB@B@BBDBH@B@BAB@B@@ADCBA@ABA@AB@HAB@DAB@DA@ABADCBA@@BA@E@I@@@@@ACE@AAA@@AC@A@A@A@A@A@AA@@A@A@A@A@AAA@E@A@CBAB@B@B@B@B@DDDBB@BBB@BBB@B@B@H@BAB@BADAB@@ADABABABAB@BADABAJCBADABC@@AAGACCAEA@AAAAA@E@MCIAEAKCOCAACA@AAAA@AAGAAAAAA@@AA@A@I@A@A@EAAAAAA@CAA@E@A@EDA@C@A@A@A@A@AACAA@@@@ADABA@A@A@A@ABA@@BADABC@@BABEDA@C@A@A@A@A@ABA@A@@AE@C@E@AAABGBI@K@E@G@ICEGGIGMCOAMGGCOECEEEMKW@SDOFOFGBQIIICECEEEIKOOEAQEMDKBEFGDIHYAO@GDIFEFOHUAOE"],encodeOffsets:[[115780,39801]]}},{type:"Feature",id:"140924",properties:{name:"繁峙县",cp:[113.265563,39.188811],childNum:1},geometry:{type:"Polygon",coordinates:["@@QJOJQFSFA@ABCDAFGDC@OBMDIBGBI@CBABB@BFDDHBBB@@ADCBABIDABCBABA@ABABABCB@BA@CBABA@ABG@A@A@A@AAA@AAA@CACCA@A@A@A@A@AB@D@B@FBB@B@B@B@B@BB@@B@B@B@B@B@BBD@@BB@BDF@B@@@@@J@FAB@@ABCDAB@BCBA@CBA@GBA@@BAB@BABCD@BA@A@ABA@G@CAAAA@A@EBA@ADAB@@EFA@GBABA@A@A@ABA@CBA@ABA@CBCBABA@A@EHCBKAGEECCBEHBREJGBMC_MI@ABCD@BA@@BCBABAFA@@B@DAD@BAB@D@B@B@HBFDD@BBBB@@BBBB@BDBBBBBBB@DBH@F@BAD@D@B@B@DBB@@BBB@B@BB@@B@D@BBB@D@B@B@@@D@B@B@B@D@F@BAB@BAB@B@B@BADAD@DABA@@DADAB@BADAB@BCFABABADCB@BA@ADCBA@ADABADABA@@BCB@BABABABA@AB@B@@ABAB@@GJGJ@F@FFPDP@BBJ@B@FBFADCFOHC@CBKDMBQDEJFJDFLJDHBHFRLXFAFARCPCJAJALANDJFHFFBFFLFJDHDHDPBL@LCNAVDJ@F@J@HAXBRANAPAFBNBL@LKDCHCLELAFDFJBJLHNFPHHPJDXKRKH@FBAN@NHHPBLBH@PHH@J@RALAH@THHPJLFLBNLXHDPHPLJHFHBD@HAP@F@LFLBFDBVBRFFAHIBCBCDGBABCLCPFNFH@RDLDRDFBNEHAB@FAHCJGRELAFAPAJBJBJBHBNBJARADAJAFCHAFAFEROFK@CB@FKFGHGHCHAHCHAFAH@HANAB@F@J@JAB@D@LCJAJBN@FBJ@D@B@RBF@LBN@J@D@LCDE@ECMCG@K@IJKNKJEDCNKPINIHGDGDMBEJGNIJCHEBIAEAK@C@IAOAIAGCMAOCMIKMGMMACCIFGBK@KFOBMBSFMBEDE@YBMFMFMBE@@@@BCBI@EYMKMEICIIMEOS@WDUFSEEMAMKMOGIEEEKGKIIGMIQIGGIIOOMKSAS@GBEBCJEPKRSNGBMDQ@UEAAOCeEWE]JINCNCPCLCHELINYHQAU@EAWHGFSJCBQFQJMAKIOOKMIQAQAIEKGEGGEE[SEEECKMIQCEEGAEEGIIKEUIUEMA[D"],encodeOffsets:[[116160,39900]]}},{type:"Feature",id:"140925",properties:{name:"宁武县",cp:[112.304722,39.001524],childNum:1},geometry:{type:"Polygon",coordinates:["@@BJCRBNBFBHDDDJLDTBPEB@D@FBHDJA@@PGHAFEDEB@HAH@DBFFBH@FBDBFBLAFCDADDFDF@H@HGDIDGBEFEHSHMFEDCDBHBDDNBDBBHAH@NBJ@NBN@HCDE@CAGAAAAGCIAGCAAACBEFIFCLCJBRFBF@DCL@H@D@@DFFBRAFBBFB@F@DABAB@PCHCFADCHELIB@LCB@PBVHLDB@NDTARCRMLAFDRDPHNJFDHDPJNHLJNFNFZDT@LAHCRALI@A@A@ABC@ABABC@A@AB@BABC@A@AB@@ABADAFABAB@D@DB@@BAB@BBD@D@B@DABAB@BA@ABA@A@A@A@AACAC@ABA@@@ALSH@ZBDB@AHCNCPBNACCE@EBCACC@AAE@AMMCAECGCOIGEGECCSGOBMFKFG@ICMGA@AAUIMIWGUMKGGGGGOQ@KQKKGIAEAQ@IBKHSFMEKAIAMBE@MMMGCCECKEGAKFMHOHGIAIAGGMAAECEC@C@@@ABA@CAA@AEA@ACA@AAAA@@AAA@AAA@AAA@A@A@E@A@@@AAA@AAA@AA@@AAA@AA@AAA@ACBAB@D@N@F@BBB@@BD@BBD@BBB@BAB@@A@G@A@C@ABA@ACAMAAAA@C@A@CAGACAAAIMMKGMGKCGGKOK@ODADCLEFCJK@G@C@O@I@KCQEMGK@GAI@CDODMDIHOBAHIFM@@BG@C@@FWFMBAFCVMPET@RDHDHDFFHJLPHLBDFHLHT@JUJEDCB@LEH@BNNLPAJCHCHCFEBAFGPGNIDC@OCECOCKAKBIDG@AI@A@@@C@C@GBEBODE@E@EAAAAC@C@E@A@CFGBG@CCAAACAIACACAA@AAACAA@C@CCCAACACEAAACAAC@C@KIA@CAECAAEAECCAAAAC@EAA@A@@IGGEMIMMISKMQIIEMEIGEEEEEDGFKLGJGPCDIHA@KFKDMFSHGFKDMLOLOPGNEPBRCJALCDG@M@CBGDCJAHCLGBMCMEMEGEGCGCMCMGOEGCEBA@ADCBCBEBE@CACAAACCAAAEBCBEBA@CAC@EDCBADAFAB@DC@A@E@A@CAAAAAAGECAGIECEKAAAAECC@CAI@C@IEIEC@E@GBEAQCIKIKCEAECEGSEGACMGSBQAKS@Q@C@K@@DCFE@ECEAAAAAAAACAC@EBCBCBCFABADEBK@I@CBKDEBEFCDAFAD@@CP@D@DAFCFCHCBEBAAEAACCI@ACAEBEDCFA@IDEBELOHIJKFSDIBMDGBGBOCAP@DCJAPCVGPGBMFID@@ENCNAFCF@F@F@DDFB@FFNRAPA`APBLBLC@HHHFLDZFTHNFPFTDRDTDDBJHBDDHBLFP@RBHFLNLPNDHCLAJGJKFINDNFNDFFHFVGN@H@L@LDBLNFJDDLRPNPLPJLDNFP@RDHH@BFFFFHFHDJCPELELATARBTCTDPLNJNLRJRPDBHHHP@ZANDJHFVHXDRBD@PBDBJ@TLHDHDDH"],encodeOffsets:[[114916,39961]]}},{type:"Feature",id:"140926",properties:{name:"静乐县",cp:[111.939498,38.359306],childNum:1},geometry:{type:"Polygon",coordinates:["@@O@MLGHEHGFGBIBC@@ACCECEAM@IF@@@@@@ADCFBFBHDLBLA@CHEBA@GBUHE@CAAEAGBALCDC@CGCM@KBE@KBG@EAQLGDAFAD@DAHCFIBIDCDIHIHCJANAH@JBNBLAFALDDXBFBJBVHH@LEFAFBPBHDHDHFADBR@B@B@DADOHIBGFCJAFDLFHBFAFEJCHBJBFGFGFOBIFGJCDE@EBED@D@@@BBHDFDD@FADEDCH@D@@CDENBDTNFF@JEDAFAFDD@FKLKFGF@BMJKHILA@MJ@BMJMJIHIFKLBHBF@BJFJHFBDDNFNDNHRJHALAPFHJFDDHDJDDHJXFPFXHRHR@RDJBFDPDHAHANCJATCLEJIPGFKFAJCB@DEFCFADB@BDJBDFBBBFADADGDEBE@C@CDO@@BCBEDCFEFALCDAJ@L@FABCBADEDADAFAD@DBBBBBBBBBDF@FEFCD@@@L@D@RLTRBTANHBDFHHTDFBFDFJLJLRDFBHAF@D@JFJFD@J@DBD@FDBBBBFLFDHJDBHFBBBBBB@D@B@F@BCDA@EBCBABCD@FBD@DABAFADBFBBDDBBDBDBF@FADADABCB@FAHDPFNHNDHDHDHFNFNFNDHADKBGDIHCDAN@H@DCBKDIAQFOHMPOPKNKLCHETGNELCLEB@JGDCHOHILKHEFCCKAGCG@UAK@A@M@UBACSEKEEKIEOAMFOJGREFAJAFADAJEBAFIBKCKCOEGCO@E@CNODI@OACCGKGGIMOCS@KSGUKWGIG@@@@GCACA@AAAAA@@AC@@AAAAA@@AA@A@ABCBABCBAFACEEIMEKGKIEE@GBEDEHENEJ@HEBEACK@eGWEWIA@@KFGDGBAJKFGLGHCHEFGBGBKBI@KFEH@JCDK@KCKEGACAGCICICGGEQCCCIEE@KBIFG@ICAABKBE@A@CEDGHIHMAMBMDGDEBOBMDOAE@KAEACEGEKDEHKHCJEDGBGFCFIFGBIBG@KCGACDEDC@QFGFE@IGCAE@IDCBIDQH@@IBIBGDCJE@GAKISAGCOEIBOGCGACIAG@EDGHIHGDIAOF"],encodeOffsets:[[114610,39098]]}},{type:"Feature",id:"140927",properties:{name:"神池县",cp:[112.211296,39.090552],childNum:1},geometry:{type:"Polygon",coordinates:["@@MKMIOKSCSDQASBKBKFOFIDGCGEEEEE@AKHKLOJGNAFKZGPGLCJIJODMDIHKRILABKXWbKNABWNUDQ@UBSHOHCBGDIFQJEBQDSHABU@UJEPAHgPEFADGFCDMVKJILAFEJCLAF@DFLLHJDLFFBBBDDDBFBNFLJDDBD@@BB@D@D@B@B@BBB@BBB@@@D@F@@ADADABAB@BA@ABABAB@@CD@@ABAB@@AB@BAD@@ADCDA@CBA@AAC@@ACAAA@@AAACE@EBGDIDEDCDK^RJDFDFBBBB@BADABABAFKLGLAD@BAJGJMFMJCNDHFJNL@@RHPPNLLJDDJDJBH@JANCHKLGJEDAFCFGNGNEXFLDNFNHNLNNDDFHHRFR@TDDJFLNRDh@P@LDHJD@HBLDFBLDPDPANATILEDB@BBDB@BBDDFBBBBB@DAFBBDE@ABCBC@EAEAGAECE@@AEBADABCNKFEBADCBECECC@GDIDGHIJCFAB@PCHCFC@@DCCEEGBEBGBEDCBAJCFADABCACMIEAICA@HMJANGHEFMHIFMFGJMPEHDHFDJDHFLNJPDLBNBR@PATEFARIB@RCJIFGHQCQAO@EPGFEBCAE@EAC@CHCFAFAFAFADCDS@GCGACEEACBCFCJBJDHFFBF@FAH@HBDBDAHEFAFABCBCBI@GBAJBD@@CECGG@GDC@MCKCE@GDE@@AAACCMACAGDCFCNETGFGFEHAJCHC@G@GCECEBCDCBEAKAEAC@EAGEECAG@GBA@CFEFGBOH@@IBGCEAC@A@OFSAKCCICCAGAEAMDQAICGGCGCSKI@CAOAC@QAWCUGGECIBM@YGOGGCAQOQI"],encodeOffsets:[[114814,39884]]}},{type:"Feature",id:"140928",properties:{name:"五寨县",cp:[111.846904,38.910726],childNum:1},geometry:{type:"Polygon",coordinates:["@@VAR@VCXMBALMXaLWBAJKLQJGNCPCJIDIHKHOLYBEHMPILKLGGGQCO@MEKCOIOKOMKQCCEIKMCA@K@K@GHMEUEGCEEMCMJMLEHIBIDKCGOMMKEKAG@QEOAKCGACIGCASCQCSCOEMESGYEKCGEGG@HGFCLFJPHPFPHJNNLHRDN@PEFGA_CaEa@[HMDIFEHAFENCHCHADGFCBMHMHMRCDMLOP@DCHAB@BA@ABIHCDSNQPCDEFABABABCBEDEDKJCBAFIHMFO@UCWJcTOFOFUJGBIDG@ACIGIGGEQGSCS@CBAB@F@FNVRNDT@FAD@D@B@BAB@BC@ADABAB@@EJGFOBSI[AGHGJEFIBC@IAQIYBEDKDELC@DDRDPJDFF@BBB@BBBBBBB@B@B@BA@A@AD@@@BBB@BB@D@D@B@B@BAB@@@B@BIBE@EBCF@BCT@DAN@L@D@LBPAFCFAHBD@HDLBDHJDFDD@H@N@BBDBHDFHDDBDDBD@BBH@F@B@FED@@EDIFCBADADCL@@@D@HDB@DJFLFHDDDJHDDDDBBFFAFABAD@D@BBBDDFDBBFBF@@@FAB@@BBADCJGB@JEB@FELANDFFDF@HVL^PNPAN@@BBB@FB@BB@BBDBDBB@B@BBFBBBDBD@F@B@FB@@@@F@FDDBTFJ@FD@@BBFD@@BBDBHAD@BBD@@BBH@Z@BBBJ@FBB@B@@@DGBEDAFCFCD@FBTHF@H@D@D@BBB@DDDDDBF@FBH@FAB@ACACBCL]DCFCJCHCFAF@BDBB@@BBDB@BD@BBB@DAB@DCBC@@BC@ABA@@BABA@@DC@@BABABAB@@ABABABCBC@@@E@C@@AA@AAA@A@A@A@C@CAA@@ACCCKIMEEACACCAAEAKEICKGEK@CBEDKFIBEJKLINUDCHEBCFEhOBGFOVIV@BATGRCFARIJEHCDAPGTG"],encodeOffsets:[[114568,39997]]}},{type:"Feature",id:"140929",properties:{name:"岢岚县",cp:[111.57285,38.70418],childNum:1},geometry:{type:"Polygon",coordinates:["@@DA@A@@@I@MAICKQNEFIJKHOJIFMNMNGNAJCN@J@B@LCJBJEDIFKBSBA@oB]AMBGBCDEDCFGBQHIDCJBHBJCFO@WGSASAKCMCQ@UBEDGHEJELELIPELCLBF@L@LAHCFIDKDMDEHEL@@@@@@APBFANALCRCLELEFCHDBLNHLFBFENOPFNBHFHFFHBFGHQJSBQFMJEJCJANCRHFFFHBPFLFBHCLAPFRBH@LDJDFBBDBNFJBHBDFBLB@@DB@BBB@DBJ@DAD@B@BABABA@ABCBA@ABGBC@AB@DAB@B@HDB@B@D@D@DBLJDBFJ@HFJDHFDDDAJ@FCHILGPBNCNXF^@T@ZCFAJAFATIPGLCJAHAAFEHCLCDAPAJBN@BDDRHXDCdLFJDHDLDJDNDL@JBDBD@FKLCFCZARJJBD@JAFEHIHG\\BTJPAHEFI@@BABABCD@@ABA@A@A@CBC@ECSQMMU@E@EBADAT@TDRHHFJHJHBDH@JCHAVIPEPEdSXIVDP@NEJGBEDALIFCFCDABABABAFEDCROTMDCJGBAB@@ABADG@CPONKDCNQNGNGDAHEBCDGDGFMBEFGJENC\\Gb@bF`DHBFE@OCMGQMKIMOGOEOGEIDKHE@GD@AKAKBOB_BOMQEEA@CE@C@E@EDEBEDMFM@@JCNEHAHODUBODI@CBOECIAQCQ@QGWGOEWEGICCCICGECGIOEKBGBANAFELENCJEHELGNILKNIBKFQHSAEEEIEMEIIMEEKIOGOESEEAQAQESIOCUEIIQGQKMIMKGCGEMGMAK@KFKDIJOPKBGACAGICI@K"],encodeOffsets:[[114181,39433]]}},{type:"Feature",id:"140930",properties:{name:"河曲县",cp:[111.138472,39.384482],childNum:1},geometry:{type:"Polygon",coordinates:["@@BODCDKFGBEGBIBKDOHSJEBIBEBYDS@]@WEAHGLBN@@@BHJFJDJDDFDLDFDDF@HCHKLCFAHAH@FCDEDCDCDAFGFABADCBC@CBGBEBGBEBKBGBEBC@ABCBBDFDDDDDBL@BBJADAF@BGJGHEBKHKFCFIDMBCDCFABABE@G@G@E@E@A@EDABEDCHCBC@AFAFCFAHGNABCDCFCFGDCBC@QDQHFJBFBH@@DFDJ@B@FCFABAB@@EDABCDAB@F@BFLHLBDBDDH@BHJJJ@BJJ@BDFBF@@DHBJBBBFDBFHDFFD@B@BABAD@B@@DBDBDBHFDFDHBD@BBF@DCDCFADAD@FBFDL@@BFDDDFFHBFBDBDDBDDFBBBD@DBDDDDBDBDBBDB@@BBABA@I@M@I@G@EAE@C@@@I@KDID@@IDEFED@@GDCFGJCFAD@DDFJHFDFBDDBD@FADEDABCBC@C@GCOECCAAAC@A@CAACAGAABC@ED@@CFBF@BDLBDBJ@JAFEFCBI@MAIACAA@O@KFAHBHJJDDDD@@JNBDHNFFDFDDHBJDB@HBFBBBHHFDFDDBF@FAHCFAF@H@L@LDFDHBFBDAFAFED@F@FAB@B@J@DAD@B@B@DBD@J@FBDBFBDBF@D@DAD@BAF@DAF@BAFCDAD@D@D@BBDBBBB@J@@@LDD@RAH@HAXBJDTFFFHFFNDPBLH@R@FAH@@@JBT@B@PAFCLGDADM@EAIAGAEBGBEDIHQDKLCD@LCHAPOAKCEAEIIGGAAACCGKGKGGKEKBKBCHKLCBALCfOF@JCDARMDEBADELSHKRGZCRAVKDABEDIJKNCN@^FZBVA@@FBBADAB@JEFCHAJCBC@E@GAO@IBCDADAAAEAAAA@A@CACAAAA@@AEAA@AA@@BMMO]OUK@GCEEEMCKBEFA@IFA@IHCDAB@AA@EB@@E@EAAAECCCAA@A@CBCBABEEEAACCCCIGCCGCKEIE@CCA@G@C@@DKBCBCDAJEFC@@FC@E@A@EAG@AACCCCAGCCEAGAC@A@M@GCCCEGIACCK@GACBGDEBEAO@K@C@KBM@CDS@ADEFAF@JA@A@A@@BA@A@A@A@C@CAAA@AA@@C@@B@BABA@A@A@AAAAAAA@AAE@CEOIQCCCCAIAK@MCICKCGCICKEDcWCQGCC@AAMBI"],encodeOffsets:[[114072,39859]]}},{type:"Feature",id:"140931",properties:{name:"保德县",cp:[111.086564,39.022487],childNum:1},geometry:{type:"Polygon",coordinates:["@@OGGCQESMMIIEMGGEIEEAEGAC@@EEE@EAA@E@E@EDAJAFGFCDABCFILCD@BEHEDIBA@A@C@A@A@I@KAA@A@A@CAKDGBGHADBJDJDFJF@D@B@@@@@B@BBBAF@FCFEFIHBBBBFFHLJLFLHH@FAD@BEFGHABAD@D@@@DBDBDDBHDDBD@D@D@DBFBFFFFDJBBBFFLBDAD@DDFBDDH@BDD@BBBBBFFFFDBFJB@BH@H@@AFGHADKLCDA@AF@DBBFDFDFBBBNJBDFHBLALELCN@@@RBF@DALAHINCHABADADCFAJAFCDEBCBIFCBCH@@AD@@BDBHBDRTLPBBDB@@JDJFHDDBDDDB@@PLJDLDJBTBFBH@B@D@HAFB@@B@B@RDFBD@@@DBD@FDDBHHHFFDHFNHDD@@JHVT@@JFBBDH@@FJBHBDBN@B@DCH@DBF@@BDFDFBJDB@DDDDDJBDRGRCD@DAHCDEDEDCBAHMBGDEBEBED@DADGFCBAFCB@F@F@H@H@F@BABADEDCNAJCDELELGFAHGHI@ABEBCAI@AAKCCCCECACDABAD@FAHALAFAHAFAHADAD@DABCBAHEBEDCDCFCDC@EBGBGDELKDG@GCEECKCECCCCIEIGI@A@@AMHKBGDMAMHOJKDG@EBICCECCGEI@GEICAKICAC@C@A@A@GCA@A@CBA@@BADAH@BABAD@BABABABA@C@CBI@CAA@AAA@@CA@AKCEGAIAMECAAACECI@KAGEQBODKAGKEOEGAEEGEDQBMDIFINIRETARIHGAEEGGEGEMAOEMPEFEAGKKMCAABEDID_BWCQCMAOAKCMGECGEQMOCICKEMEKC"],encodeOffsets:[[113759,39624]]}},{type:"Feature",id:"140932",properties:{name:"偏关县",cp:[111.508831,39.436306],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@DD@FAH@JBHDLBHDHDJDHDJFJDHBFBFBFBBBBAB@@@H@FBD@D@F@F@D@FAFCHAFCD@BAD@DADAFAH@B@D@D@D@F@DABCBADEBABABABAF@F@DBJ@D@DBF@FAD@DABAFAFCFCDAFADAD@DAD@B@F@F@DFFFBDFBZD@@BAD@BADAB@D@F@F@HCFABAHCFAFA@@DADAH@HAFCFADAFADCDADADEDCDA@ABCDCFCDA@ADAB@HAD@HALAJCDAFCDAF@DADADAD@FAD@D@D@BBD@D@BABABABADAD@D@D@BBFDBBFBBBDBDBD@B@DDBDDBBBDBHDB@FDB@@BBBBDDBB@DFHDDBBBD@D@DBF@FADADAFABA@@FCDBF@DAB@HAD@BABADABADABCD@@BBB@@@BDB@BFB@BDBD@DCB@BBBBDBFDB@D@D@FADADAB@B@D@BB@BD@D@BADCDABABAD@DDFCDCFGBGBKBGDI@@NKNIPGHCHCHEJIJKNMBAFELICCIMOEG@K@SA[BK@I@SEE@UAAAKAAAMCGCECCCAI@AAE@CBEDEFGFEFONGFIDK@@BEB@@ADCBAF@DBB@NHF@D@D@REDCJIPIFAJCDAFBDFD@DBFA@C@EACBGBEBEFEFEFE@E@GBEFAFAFAAABE@CAAAAEACCAAA@AC@ACAKFSJMBOBOCKCEAKCGAC@GIKCO@g@QCKMIECC@SEQGQEGCCMMMKMGMEKCWEMFMHEHEDCBIFKHGLMDIBG@IAICCCKIMKOOQG@@MKEICGDMNINEHIBI@ABCHKLKBEBABABC@AAAAACECEQIADBDBDA@EBG@EAE@CACCCCA@AAC@C@G@E@SGEAC@EDEDCBAFCH@@A@A@EAI@AA@A@YAG@AC@AAC@GBCAAA@@ECAA@@ECI@SECAECE@@@@@EAA@E@C@CACBCBAD@JBP@H@FADIDGBEDIFA@CBABEA@@UBYA]EM@MDILCJAFCBULQBYDQHGLKTCFABCFQNCBIDE@ePKDABKDGLADALFLHLLHLHDHBDBBHHJJBFDFBLOPGBKDC@KDCLGRCJAFAHBFBHBJ@FCNCBKHEDOBA@S@IA@@G@EBQ@G@@B@HAJ@F@DBDFFDFFHHHLHFFFDHDHDJBB@THF@H@JBFDJJ@FBHDJ@FCJA@AFMLAF@FHPHN@@FJBFBH@N@HBHDHFL@FAFADKLEHAD@B@FBDHFD@D@D@BAD@B@DCBADGDEBEDADAD@FBDD@D@D@D@JBF@DADEBGBO@CBEBADEFAD@F"],encodeOffsets:[[114113,40592]]}},{type:"Feature",id:"140981",properties:{name:"原平市",cp:[112.711058,38.731402],childNum:1},geometry:{type:"Polygon",coordinates:["@@S@IBQFA@EFGHMNCBAB@@MHUDGAIAQC_G@@M@G@ODYHAFIBKCI@K@QCC@CAGACA]CIAMA_EUOKAK@SGKG@@A@MGIEKAMBQBKCA@@ACE@A@A@CAAACAAC@A@C@E@A@C@AAAACCA@CA@@A@C@CBEBE@CB@@CAGAAACAAA@@@@E@@@A@A@C@A@A@C@C@IDA@C@A@C@CDCFAFEHEBKAGAEAI@IDCBC@C@@@A@A@A@EBEB@@A@@@ABADA@@BAB@@A@@BAB@@ABAB@BA@A@IPMNEHKHODCDOJCFGJKLABQPGJKLOB@BCHAJBLDLDPDF@PCDMJOHEHABEFGDGDIDOBMKAMG@KFA@CDIFIVS@KGEGACGKKOGIEEGCGCQCS@OFUNEDABENEX@@@DAH@@ENGJABGPCJCNCP@DBJ@HHLFNDR@L@J@P@D@HILEDKFCDCB@PPLHLDHHLHNNLJNBBDBHBDBB@D@B@BBNBDB@BAB@B@D@B@H@BA@ABA@AAC@AAC@@AA@AAE@M@C@A@ABBDB@BBB@@BBB@BB@@BBB@BBB@B@@@B@F@B@BBB@BBB@BBB@BB@BB@BDB@BFB@BBB@DAB@B@@@DFDFDBBHNBHBJHJPGNGLEHBLFFDDDNHNNF@NAJBLBNFTELGJAR@FBJBLHRL@LPRHHHHLHVNXHNJVJBBB@NHJDH@LENEPATHDDHFHFPJHDFDDBNN@BBF@BDDDBFAF@DDJ@RFFBFHDLDN@@@@@BAD@BABAD@FBDBB@BBBBBB@BDDBFB@BDDDDB@DBBBD@BAFCB@B@DABADAB@D@B@B@B@DBD@HBD@DBDBD@BBDBFBHDBBB@B@BBFBBBDBBBLJB@DB@@HF@BB@DDB@FCBAB@DABCDADABA@E@C@A@IAC@A@G@E@I@A@AAA@A@AAAAC@AA@AACAC@C@CAC@AAAA@@AAACA@AA@AMEGIDIJGXMRCRIPGRMJMJMDGJMBEFKP_FMLK@@@@@@DA\\KLELEPEFAdMNGHCJADBFBD@D@B@FE@A@@A@CAFCB@@B@@@@@@D@@@B@DA@A@AA@DEDGFM@@@B@B@@@@B@@AB@D@BAFC@C@AG@FCRCP@NDPARKN[BIBABGFODSFI@CLMBCJOHMLIFELEFARCDA@G@GDGBMAIAGGEEAK@OCSEQCMEKEEECI@IFKJEP@N@NCPATEJEPIPKNOHGBALOBI@@@GAIDKHIHIB@HKBKBMDG@CHIDENGNEJGBGBI@EMHEBEBEAOGICKCCAMC@@EECEBADGBGDG@AKACBE@ABAFABCCABCBAB@BA@A@CBC@EAEAA@EAAQCGGGA@SCYGSAQDC@CB"],encodeOffsets:[[115674,39544]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
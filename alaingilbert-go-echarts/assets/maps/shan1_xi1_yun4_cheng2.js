@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("运城",{type:"FeatureCollection",features:[{type:"Feature",id:"140802",properties:{name:"盐湖区",cp:[110.998272,35.015101],childNum:1},geometry:{type:"Polygon",coordinates:["@@CEC@IACCE@C@C@AACGCACAAEECAECAGCAABCACE@E@IEG@E@CAAEGAG@CAACACGGEECEAEBEC@I@GBCB@B@ACAA@AAA@C@AAMEGCCEAAAAC@G@C@E@A@C@AAA@AA@AAA@AAAAAAAACA@A@AAC@A@C@GAG@A@CA@@AAACGI@@@AEAIBCAA@EIACCAECCACACCCAACA@A@A@A@A@CACAA@CCAAAAAACE@@AA@ACACAA@C@C@C@C@EBA@EDGBEBE@@@ICEA@@C@A@ABA@A@C@CA@@A@ACA@@BABE@A@EAC@EAA@AAE@ECAAAAAAACECAAAAACCAA@C@A@EBEDA@A@A@A@EA@BABCBA@A@C@C@@@C@E@A@EAA@AACAECADCJ@D@DADABADA@@DGFCDADAFAH@B@B@B@FAFCD@BA@@FBB@BB@@BBD@B@BAFED@@@BGHCDEB@DBHBJFBHFBBBJ@BDH@BHBLBB@FADAD@BB@P@LABC@C@@@IAK@KDABAN@NCN@BAHAB@DAFAB@D@B@DDFDFDHDFHBJDHFFFBD@@AF@@ABCBCFAD@BAB@@@D@D@D@J@DBH@BBDB@BBBBLBBCFEFANC@@B@DBHTFDLFDBF@HCJAB@D@P@B@F@B@FDHDBHHJFDFBVAF@B@NFTRD@F@HBNHHDHCJMVEFDHD@J@FCFADSFON@@AF@D@DBDBH@D@B@DAD@DADCJAB@BAB@BABAB@@ABCLADABADAD@FCHCFA@GLCFCF@D@F@B@BBB@@BBB@FDBB@B@@@BABADEDAB@@ABABA@ABGFAB@@ABC@CBABABCD@BAD@DADAD@D@HAF@B@BBBBBDDBBBDBBDBFBBBF@DB@@D@BBDDBBBB@DDDJFBBBDBF@@BDBBBJDF@B@B@B@BAB@@AB@@GB@@@JBLNPBDBBFHHDBBFDHBD@JCH@D@@F@DCN@BTHJFB@BB@BAD@@@D@BDBFDB@J@@@B@DA@ABAB@BAB@BBBD@B@D@FAF@BAB@BAB@@FFPFBBDDDDHHLJDHBB@@BF@BBFHVDHLP@BBBBBDAB@B@BACAACCEBE@ADCFED@B@B@B@@@@@@ABAB@F@B@ABABD@BBDADCD@BBB@B@@AAAA@DABAB@@@@AC@A@@@BEBAB@@@@BBB@ADABCBEBADAF@D@D@@@D@DABADGBA@A@@AA@@BEBAB@BA@@@@A@C@@@A@@ABA@A@@BA@@@A@A@C@A@C@EBG@@@A@AB@@@BA@ABC@A@AB@FA@@@A@@BAD@D@BBB@B@@ABCBADCBA@AD@B@DAB@DC@@@ABA@@A@@ABCDAA@@@@@@A@ABAAA@@A@AC@@CA@@A@BABC@@@@@AC@AAAA@@A@ABABA@CAC@A@C@CA@ABA@@CAICCAAAEAC@@A@@@AA@A@@A@A@@@@CAAA@@BABC@A@@CA@A@AB@B@B@B@@@@@BA@ACA@@@A@@@@B@B@B@@@DEDEB@B@BABABA@@B@BA@@B@@A@@@A@@@ABAF@DC@A@AAAAABC@C@CBABAAE@EBEDGBACA@A@@D@BA@A@A@@@ADA@A@@AAA@@A@@BAB@@@@A@@@A@@@AB@@@@@@@AABA@@AA@A@AB@BA@A@@A@AA@ADGBADAFBHAFABBB@@@FD@BBBB@DBBAHIDE@CBADABA@AAA@K@@@@@CB@DADABABA@C@C@@@A@@AA@A@@DA@@CECA@ADA@A@AAABA@@@ACA@@BAB@@AACIKIECA@A@@BAFADC@@@ACA@@ECA@CAA@@@AA@@AABE@@BA@AB@@A@C@@DCBA@@B@@@CA@A@@@@BAB@@A@@@AA@@A@A@@@@@AA@@AA@BA@@B@B@@BBAB@D@B@D@B@B@B@@@D@B@DD@@@@@A@AB@B@DB@@@@@A@@BA@AB@@@BBB@DA@@B@BB@@DAHEB@BBB@@@AA@A@@@@BA@A@@@A@@@A@@@A@A@@@@D@@AA@@A@C@AEC@A@@@AAA@@BAB@BBB@HEDADAB@B@@@@A@@@@@ADAFCBA@C@@@@A@A@@A@@BA@A@A@@BA@ABAB@@@AA@@BA@@B@@@@BB@@@@A@@@@B@B@B@@@@@@A@A@@FAJCHENEBADC@A@ABCFCBA@A@@AAG@AA@A@ADCEACAAAAAA@CAA@@@@A@AA@A@C@@A@AAAC@IE@@AACCA@A@EBCBAAACA@EA@C@GAEBABADC@A@AAAC@CAECECGAI@A@CC@A@@@@DA@@@AAAECCEAA@AAACAA@ACA@@@C@@@A@@ADADABABA@AA@@A@@ABABCDC@C@ACK@E@EC"],encodeOffsets:[[113763,35793]]}},{type:"Feature",id:"140821",properties:{name:"临猗县",cp:[110.774547,35.144277],childNum:1},geometry:{type:"Polygon",coordinates:["@@CECAAA@@@A@@@EBABCBAB@DAB@BABC@A@@@EBE@@AAAAA@AACAIA@@C@C@GAA@KECAA@CCA@@E@A@AA@C@EA@@A@AAA@EA@@@A@AA@A@@A@AA@@@CBA@C@A@A@@CAAAAC@ACA@ABA@EBA@E@C@AB@B@B@@E@AB@@AB@BG@A@@AC@EAA@@CAA@@CACACBGBEBODIFEDE@ECGAAAAGACMCGAE@C@IAG@CBC@A@M@IDEDABABA@GBABA@@@C@@BCBAFE@C@EBC@CDC@E@CAAC@CBGAEAAGBEBEFCBCDC@EBCBAF@@ADEFAB@@CB@@CBA@C@GDE@ABA@CBEDA@A@ABCDCBEFA@ABABABABADCBGDCBOHGFQJIH[N@BC@EBABA@CBABA@CBE@C@A@CBQ@EBI@GBIBG@KB@@N`BFBL@@LdLb@BHVFTFVDPBPFfJt@@D`DTBJ@HHXBBBJJP@BJP@@HHHFLLFFFL^MLEHCFCDAHCFAB@B@FAVJ@@NBD@HDB@BB@@JHBBDDB@BBNADAF@FCHAB@B@H@H@B@B@DAB@B@@AB@A@@CA@BADAB@BA@@@C@@B@@AB@B@@AAEAABA@AFA@@@A@A@ABA@A@CHABAF@BAD@BAB@DAB@DADA@@D@BB@@BBAB@@@BB@BBFBBBBB@FBDBDB@B@B@B@B@BA@C@E@ABEA@BA@ABAFADADABA@@D@BAB@BBBAD@REJCFAF@BAB@B@BBB@BBBBBBDDBBBD@@FBBAHAJCF@HABABA@A@E@@@C@CACAEAC@A@A@ADAB@BA@A@A@AB@DAD@B@BB@DBBB@B@HADDBFBJ@BAD@@@BBH@D@DA@ABG@C@CB@BDFBDBFBDDH@BDFFHBDBH@BB@BBB@B@BAD@BAD@JC@BB@BD@BBBBBB@B@D@DADAD@DABAH@BABABBBDB@DAFADAJALAD@FAD@D@@BB@@B@HBHDF@B@@DBD@LBDAH@JAD@B@FBDDB@DBB@LBBBBBBDBBBBBAB@D@BAB@BAD@BADABC@AFGFOAMEKCEEC@A@AFCDAFADEBCAE@E@ADCFABB@FDBDJDDL@LEHANCFCHBPHDDFBH@B@DABADABA@ADCBE@CBABAFAF@D@L@HAHA@@BA@@BA@A@A@A@ACEAIAAAC@@AEACAAIECC@CAAAACCAAC@@@CAE@AAEACAAAACAACCAAAA@A@ABE@G@CBCBC@CBC@ADCBABADAD@BA@@BAHEBAB@BABA@@BAFCBCBA@A@@@AAAECA@AA@@AA@A@A@E@CDEDEHKB@DEDG@EBCBCBABCDKBA@@BABA@ABA@ABADIBC@CBC@C@A@CAGAC@C@CBE@@PMTEBCDE@E@IGCECUFINGDGCMGGAE@C@SQMEA@E@UBEAECGIAGGCECA@E@A@O@C@A@IBGDE@CAKEECGSCAA@@@MDEBEFADALCHC@GAEDA@ADADAF@DCB@@C@GCEA@@EAGBIBCAQCE@QCEACA"],encodeOffsets:[[113361,35876]]}},{type:"Feature",id:"140822",properties:{name:"万荣县",cp:[110.838024,35.415253],childNum:1},geometry:{type:"Polygon",coordinates:["@@K@CCCICA@EAAEBCD@B@FBFADCFEBCBED@B@BFDDFFLBNEPEH@BADCBABC@ABA@ABC@A@ABAAAAACAAAAKAA@CAA@CCEAA@C@IBG@CBKAC@CA@@@ACEAG@G@AA@@AC@C@EBC@KBIBCBEBCBA@ACAAABABG@ABCBC@CBCBC@A@A@AAAA@AACA@@AIDC@ABC@ABA@A@AAA@@AAGACEGCE@ACGACAEACCE@ADAD@H@BAB@@C@CAG@A@@BC@AAIAECCGBA@A@AA@CAAA@C@CBA@@B@B@BABA@CB@B@B@BBDBFBD@D@D@@@F@BABABGBE@IDGBABEA@@ACAACCAAAAAAA@AAA@A@ABE@EBIDQFC@ABAAA@ABC@@@ABCBCBEBAB@BABB@AF@B@F@DABA@A@A@A@A@ACAC@EAAAAEAAAA@@A@@BAAA@@AAC@@@CBCBA@CBA@ABC@ABE@ABGB@D@BAB@B@B@B@@EB@BABBBBF@BA@A@@BA@@@@D@@ABA@CBABB@@DB@A@@BA@A@CBA@A@G@G@A@A@GBEDE@CBMBAAA@CCAAIG@@AAA@GCC@MA@@UIEBA@A@EBGDCBEDGDKF]NB@DDJLDB@@JJDBHFNLHH@@HP@@BDDRDJDJBHH\\@BFTBDBHDJFVFPFLJHVHVJNFFBFDLHLN@@HNFPBHBF@DBJFRDNHZhCH@tDD@BBFPbLF@JKRSLA@ABADEDABAFAFF@AB@D@BBD@FBD@@@B@BCBCBAAIDABAD@B@DBBHDBD@B@BABABCDEDADCFAB@DCD@RF@BDHBD@BD@DAJGFIB@HBBA@A@CBI@ABAF@FBBJ@BBBBD@B@DAD@FBDBFB@BDDBBB@B@DB@@BB@@BD@B@F@BBBB@BBD@B@BDD@DBBB@B@D@B@D@DABEBIBAB@DB@B@JBFDBDBBBLDB@FBDFFDDBB@BABC@@B@B@@F@@D@B@DAD@FDFBF@B@HEFCJATFHB@I@@BIDQDWAE@KDOHQHOHAH@B@JDJAHEBMBA@AD@FBPFLHHJ@BDAHO@IDIPAJCHALAJCJCPMNAH@\\@JBRJHMDWCU@KDOBG@K@AB@DA@@@@AA@ADICE@AAA@AKOCGGUAE@AAE@@AACGKIGGCCCCAAOEEE@@BA@ABA@ABE@E@C@AACAAA@ABA@AB@BCBA@@@I@A@ECCA@A@C@@BC@AAAA@IESG@ADM@C@EC@G@IDC@GAECAAGCEGAAACMOAK@I@@GBK@C@E@EBABAB@DAFCD@BABCBABCBA@G@EACCOGGAEDMDGBKF"],encodeOffsets:[[113479,36107]]}},{type:"Feature",id:"140823",properties:{name:"闻喜县",cp:[111.22472,35.356644],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CBICACADKBEBEFE@AA@A@MJABC@ABABE@CCAE@ACA@@@@BC@@ACA@@AAA@AA@A@A@C@ABA@A@AAA@@@@BA@@@@B@@@B@B@@AD@@@@@B@D@B@DA@ABA@CBA@A@AB@B@B@BA@A@A@A@@@A@@BABC@ABABA@@@@B@DDFFF@BAD@BBDFDFFF@BBA@@@A@EAA@AB@@BDDFBBA@A@@@AAEEIEE@@@@A@C@C@AA@C@A@CDEDADADABIDEBCBADAB@B@HDB@B@B@BA@C@GACAA@ABCBAB@DCF@DABC@G@E@A@ABABEBABEDEDAB@B@BABGAA@CBA@A@CAAB@BABA@@AC@G@C@CBA@AAA@CAA@CBADC@A@C@EBABABA@C@CBA@E@@@A@@@@B@@@@@BEBAB@B@@A@@@AAA@A@@BA@@ABA@AA@AA@@B@@@@A@@QHE@CBA@@@@AAB@@@B@@CD@@@BB@BB@B@@@BA@@@@DC@ADCBAB@@@BBB@@@B@@A@AA@@A@AB@AACACCA@@CBAAA@A@@@@BB@BBDD@DDBBD@@CD@@@@@BDB@@@BA@ACAAAB@@@@ABA@@@AA@@@A@A@ACAAACC@AAA@@A@@@AAA@@@AB@B@@A@@@@B@DA@EDABA@@A@AA@@@A@CBAB@BBBBHJJBBABAB@B@@@B@@@@AAABCBAB@DAB@B@@DBBF@@@BABEDGDCBC@CACBC@A@ABAA@@@A@AAAA@@@A@@B@@@BA@BB@@@BCBA@ABE@A@AB@@@B@@AB@@@@@BABA@A@CAAAAA@@@@ABA@@@@@AA@AA@@B@@A@@@@AAA@@AAAA@@C@@@@@@A@@@@A@A@EDA@@@@@@ABC@A@C@@@A@@B@AAAB@@A@C@@@@AA@AACACEAAA@AAA@GGC@A@A@@@AB@@A@@@AB@@ABA@@@A@B@AA@@@@ABAB@DABCD@@@@BB@@@@@B@BB@ABA@A@CAAAAA@@A@ABA@@BA@@@@@CA@@A@A@CA@A@ACC@AD@@AA@@AAA@A@@@@@AABC@AA@@@CACAAA@AA@@AB@@C@C@A@@A@@@@DCB@CA@A@@BABAB@@@@@EKA@AAC@@@CCCCEEECA@@@@@@@@BB@@@CDA@@@CC@@A@A@@B@B@@A@A@AB@BAB@@A@@@AA@BA@CB@B@@BB@@BBA@CD@@A@AA@@@@ABA@@AA@@BAB@BEJA@CHADABA@AAG@AA@@@@@B@@@B@BA@@@AB@@@@@BAA@AA@ABABCB@@@@BB@@@BA@AAA@@BAF@BA@C@@@@@BC@A@@@A@@A@AA@@@AA@@@EAA@A@AAE@AA@@@AAA@@AA@@@ACBA@@@AB@BA@A@A@@@@@@AA@@A@A@AA@C@A@@@AB@@AB@@@@A@BAAA@@@@A@@@CDA@CBA@C@@BABCDABAD@BA@A@AAC@C@AB@@@B@@EBA@@B@BAD@BAB@@A@@B@B@@AH@F@D@B@D@B@B@@AB@@@BAB@BB@@@D@B@@@@@ABA@ABAF@@BB@@@BABCHABCBC@@@C@C@E@CBABAFADCB@BAA@A@@A@ABAF@@B@D@@B@@A@ABCBB@BB@BA@A@AAC@CDCBAAC@BABAA@E@A@AB@B@@@@A@A@A@C@EFCD@BAFDFBDDBABA@A@CBAA@BDFCJ@BBB@@BBBB@BDDDDB@BD@BBD@FBBBDFDB@BBBBD@B@FBB@DBB@BBBF@FAF@BHNHV@BBV@TVDLFPJ\\JLDB@DBLFJAXALBH@ZBDBRBJ@B@LBFAHARA\\@B@THTPRLNDFBdBTFNHFHBBJNNV@BNHJDNDTD@@LAJAJBD@DB@@JBNFLFFJFHJBDBDBFBLAFEDAHD@@LDD@DBDHFDL@L@BBHDJBFBFFDDB@@@B@@A@EBC@ADAHCB@HE@@DAHAAC@@EA@AIAC@C@A@AAGCCAAC@@CE@ABA@E@ABC@KEACAGACAGEA@CCAAAA@@CAAAAAQICC@C@ABA@A@A@AAA@ACC@A@A@@DENKDAJA@@@@BCLAFA@A@C@@AAEEACCICE@EAA@AACMMECCCAGAEFAHAPADAH@B@@AB@NGDALGDIDEHIDCJEJAVAHAJANDB@FDLNFDDBPCPGHED@FAFAfKB@DARCVKDCDCJIDE@ACIAQ@I@@BEDCBCDAHEF@F@HDJFBBD@H@JAF@LA@GFIRMNAHCEECE@A@@@ADADCBA@A@A@AB@D@BADABC@AB@B@DA@ABA@CA@@A@ABCBCAGCMEECC@ABCAAEAICACAAAA@@A@G@GCGACCAAA@@A@ACCCAEAA@AAAAA@@A@@BA@ABA@@DA@@I@E@E@E@CCEACA@@CAAA@A@EBE@AAECCGCGAA@CDGBGFA@A@AAC@A@A@ACACAAEACAAEA@@AAACE@CDCFCDCBA@AACCAEAGEEIAAAA@C@C@ABA@C@ABE"],encodeOffsets:[[114193,36041]]}},{type:"Feature",id:"140824",properties:{name:"稷山县",cp:[110.983333,35.604025],childNum:1},geometry:{type:"Polygon",coordinates:["@@GBIDOBCJ@JGPCB@AGIKGOEEAC@@BABANGFIBICA@G@GBGPGRCP@LBFCXCRAJ@@@J@F@FADCBEJCDCBCBCDCDCBCDADAH@D@BDBF@NAD@@FA\\AZ@DADSJGLFBHANBFFAB@B@DBB@FAHABCD@BCDCBADAB@BADBDBH@FCHBNBP@H@V@XCDABCD@DBB@BB@@BB@@@BD@B@BDB@DId@BNANBHAJAVKfGRKHENKJGNCNBNNLJRDNFPJJPBLCDCVAF@RD^DLC`LRJNLJPDBANY@M@KFMBIPQDK@M@EDOFGN@PFRBJAP@JBN@HFF@@G@C@C@O@A@GBaBOFSBGDM@@DGDELQ@@BK@UBEFUJUFGDCHQBI@EB@@GCUGQACCKAQAEAEEKGKGKKQEG@ACCEGIIGGCEEGGMEIAKAKAE@U@K@SAU@AGUGM@ABE@EAEAAA@CAA@EAA@C@AAAAA@ECACAA@EAC@AACA@CCCC@AAAAA@@CBA@@B@LAHCP@LDVCXGNQIIA[@G@MBONIDIDKB"],encodeOffsets:[[113591,36294]]}},{type:"Feature",id:"140825",properties:{name:"新绛县",cp:[111.224734,35.616251],childNum:1},geometry:{type:"Polygon",coordinates:["@@IBKECAA@KC[IOIKEUC@L@VBFBLBLFJHNFHDFHHJJFHDD@BFHLRHLHLFLBFBFBRDLBDHRDV@HA@@FAJGRCDEHIVEVAF@VAL@@KRCFCH@@CNAHETAPAb@H@B@P@D@D@HHNBPJNJLFFHRFJNVHJHJJNPJFDB@@@@@JBJAHBVGPIPQLMDGDEDODIHEHCB@HCJGBCBIDEBI@Q@GACBIFKHKHCDEHCJ@D@PELCFANEBCJKHIBE@@DEHID@HANBDBHDHNRPPNPHLBF@FBJDLBNAF@JEBGBK@IAE@EFEFCHIBKAC@@AACAAEA@C@EDAB@D@BED@@ABCDCBA@E@E@A@A@C@CAAAC@CCA@@AA@AAAAA@A@C@A@@@CAECAA@AAAA@@C@A@CBAFAD@D@BC@@@A@AEA@A@G@ABA@AAACCKAG@A@AA@C@ABC@A@AC@A@@@ADABA@A@ECA@@@ABA@A@@@A@CAECAA@CBA@IAOAAAGBM@MFKJOHIDADEDGAC@@CEICIKDGLIBAHEFCTOSCMCICMG@AMUIMAAEGMGSEcAEAMCQKSOSGA@[@QBGBEBKAA@I@QACAYAG@KAWB"],encodeOffsets:[[113760,36326]]}},{type:"Feature",id:"140827",properties:{name:"垣曲县",cp:[111.670108,35.297369],childNum:1},geometry:{type:"Polygon",coordinates:["@@ECCCAA@A@A@ABC@A@C@CBE@@@C@CAEAGAEACACECCCCAA@C@CAA@A@A@C@KDABA@A@C@CAAA@A@C@C@AA@@AAAACA@CACA@AC@A@EBC@KBKAC@ECEE@@@A@A@C@AAA@AA@C@C@@@A@G@CAGBMA@@A@CBA@A@CACC@@A@AACCA@C@C@G@C@CECEEEAAA@A@A@A@C@O@GAA@@D@DABIBA@@B@BAFNDJDF@BD@BEHAB@B@F@D@DEHAH@D@BDHBH@FEJEREHEJCBA@C@MEE@CBEBKBIBK@OEI@OBKDKJGPABDdDBBB@BAB@D@BBF@DDFFFDDBBBBBBDB@BFB@BBFBDF@FARDB@@@@B@BEFEDCBEDEBEBABC@A@@DA@ABAB@B@BC@@@IHOFQBMDMFAFCJENEHOLQFSFMDIF@DAF@B@DAB@B@D@DBBBBFJHFFBDBBD@BABCDEDCD@DDFBB@BB@BFDBFBBBBDBDB@B@D@BBB@B@HEHADCB@HBHDDDBF@BAF@F@BBBDB@@DBFBDDF@F@F@J@@@CB@@AB@BAB@@@BB@BBBBB@FBDBDD@B@BB@BBDDHBHDH@B@@@BBBBBDJDFBBBAD@BDDFFDNBHADAD@B@BB@@DAB@BCBA@A@@BADCBABC@A@@B@B@BABCDCB@B@@@BDFFFDDFBNFLNHBTDBBLJPPBDDJBBDFDBFBTHbFPBJBLADANGLKRMRCVDLFNLT`FLFJFFFDRBDA\\C\\GXEHAJARAHK`CZC^@vHRAPFTTRVLP@RDGPUVQPKLARARGJGDC@CCM@KAGGGEKAK@A@ABEBCDAHAH@JAVGHAFE@A@CCIBEBADAHCDCHEBEBE@I@CCKGMACEAEAA@@ADGBI@CACCCAA@A@AB@HCPGFEDMNYBGAI@E@EBCBC@ECAAAUIIGGGMAGAKGCE@GBGJMLKNWHKFKBCAC@CIIAA@ABCBAH@B@@A@@@AAIEUG]EOAIBGHMFG@EAG@G@G@ABEAGQWCA@@C@CBCDE@GABBAFADCFC@GDCBEDADABAD@B@B@B@@ABCBC@C@A@CAA@AAAA@@EAABGBKDC@C@C@A@A@IAC@AAC@CBABA@ABEHCDCDEDGBGBGBQ@E@EBG@C@A@CACEACCCCEEAAAA@ABEBQLABA@C@CA@@A@EC@@EEGECAA@A@EBEBEDEBA@CBGB@@A@@@CC@@A@E@CA@@SAEAG@A@SCG@KAEA"],encodeOffsets:[[114500,35910]]}},{type:"Feature",id:"140826",properties:{name:"绛县",cp:[111.568236,35.49119],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBKBIAOAaESGEACACEAACIACOOKIAASCGAKMMEEACCGDMBQNEJ@HKBE@IBG@C@AAIEGCE@E@GFCBADCDAF@@@JBRDJ@BCFIJCDCDULQDCBA@eLEBEBC@GFOHODCAECKMECA@MCIBGBUBIBIFCDGJCFCJKHCBMHA@@BA@G@CBOBGBEBBFBHDDFDNNBD@BBB@FDFDJBDFFBB@@@D@BEBKBAD@@@@IBCBMLCF@@@B@BDD@BBB@B@B@BAB@B@DDDRJBBBBDB@@BBBBDDB@HFDBHBDBFB@LAD@B@FAB@BDF@@BDDBHDBBB@D@D@JB@BFB@@BDGBCB@@LDPFJAH@DADAHBNDVB@@JBHBB@D@RFJBTHH@F@N@DA\\CB@PENA@@NEFCFEJGBABAD@B@HD@@PF@FARDTJLHHBBBD@BFFBP@N@DBVBJBD@DDJHJDBBJ@BRAVCDAH@NABANAXLBB@FD@HBHDJAFEAAAM@EDAF@N@DBB@`FD@FBN@FALCFEBCHEJIJGLGTSDAJEVCbE\\CB@TCPDJDJALALAP@@AACIGQEKKEI@CAIAG@IDEJIBCBAFAJ@N@D@B@@@BABCB@HARAJBF@FADCBAB@H@B@DCB@D@FBDADA@@AC@@@ABA@A@CAC@AG@AAAABC@E@CAAKGEECGACC@KLEBEAA@CA@BA@ABA@CAC@@AA@C@KCCAE@@@@CAECC@@BE@A@ACAEEACAA@KBCBC@@@AA@A@IBEACG@C@GDI@A@EBABABEAICCG@CCBEAAAAIBCACEAGEAG@AA@A@ADGF@L@DAD@LJFBF@DGBEHAFE@IFGBG@MAE@EIBGBWF[H[DCBQAECEEEIEKS_MKKEUCQDQNKLMH"],encodeOffsets:[[114399,36236]]}},{type:"Feature",id:"140828",properties:{name:"夏县",cp:[111.220456,35.141363],childNum:1},geometry:{type:"Polygon",coordinates:["@@EBE@@@@BAB@@@@ACA@@@@@BBABABCAAACCAAA@@@AD@@@@CCA@A@@@ABAJ@@BBB@B@AB@B@@C@AB@B@BA@OFYJKDCBIBKDIBM@CBG@EBGB@BABB@J@@BADAHCBA@A@@@AA@@BC@@A@@@CB@@A@@C@@AA@A@AAA@@A@ABA@EC@@@BA@B@BBBB@@@BA@A@CB@@A@A@@AA@@@ABEBCBDD@D@BCDCDADBDCDAHAB@FCFAF@BBBAF@@CBCBCDEDA@@@AG@CBC@@AAC@A@CBA@A@ABA@@BAAAA@AC@C@EA@@CDE@A@EE@ABCAACAEIWKMGACAA@C@A@@A@A@@@@BAB@@@BA@CAA@G@AAECECGOACC@A@A@CBAAAB@@EB@BAD@DDF@@ABIDE@G@A@@BHF@B@DADCFAFCFABCD@B@@DBBB@BABA@E@EECAA@@B@@AL@@C@ABGB@@AB@FA@AAC@CAC@A@A@@AAA@C@AACCAAA@@AAA@ABA@AAA@CBA@C@@BCB@HAB@B@BA@AAC@E@C@CBA@GAA@ABADADA@@@C@AAAEAAAAA@ABCBCBC@A@A@@EEGACEAABEDEBGBCDABCFC@EBA@C@CCAA@AAAA@ABCBEBKBEBA@@@@A@CBA@A@@@@ABAB@B@DA@@BCBEDCDIFG@CACAIAKBGBIBE@@AC@GAG@KDCBABCAQAA@@AACA@CFC@CBCB@BA@A@CD@B@BBBH@BB@@@BABEDAD@B@BCDABMFGFIDEB@@@B@B@@@@A@A@A@@@@@@B@@A@@A@@A@@@AB@@BB@@A@AB@BAB@@@B@BAB@@@BB@B@@@@@@DABEDCB@B@@@@@B@@A@A@CBCBGFA@AAA@AB@@BB@B@@@BFD@B@D@BB@@BC@@@@@@B@B@@@B@@@B@@@BAB@@@@@BBB@@A@AAA@GFCB@@AAA@@@CBA@AA@@A@@BAB@@@B@@@@CAA@A@@B@B@@@@CCA@C@@@A@A@A@C@A@C@A@AB@AA@A@@@ABB@@BB@@B@@@@@B@BB@@B@@@BA@AB@@@@@BDB@@A@@@ABCD@@@D@BA@@BAB@@AFBB@@BB@@B@DBB@FD@@DB@B@@CDEBAB@@@BDBJFJLBD@BA@AB@@DB@B@@ABBB@B@BCB@BDBDF@@CB@@@BBB@@@B@@@D@DABABCBCBA@@D@@@@@LBB@BABCBAB@DCFGJABCAA@AA@AEC@@A@AAEBGBEACBABCH@BBBB@@@@BABA@@B@BBB@@ABBB@@@@@@A@@B@@@B@@@B@@A@AB@@@BB@BB@@@BCB@B@@@B@BABC@@@@BDBABCHAF@FBFABAB@D@DADBBBB@B@BCDE@AB@B@@@B@@@BA@@@ABA@@@ABABABA@A@CFCF@@A@A@A@@@@@@B@@DB@BAB@@@@A@A@A@A@@B@BDB@@@BADAB@@BBDB@@@@@B@BB@B@@B@@@BD@FBBBDBJDDB@@AB@BDBD@B@D@DBB@BABAB@@@BBBBD@@B@@@@ADABB@@@DB@@BDB@@@BBAB@B@B@@@@B@CBAD@BB@@@AB@B@@@@B@@@@@BBABB@@@@@BA@@BA@@B@D@B@@B@B@BB@@B@@@@B@B@B@@ABA@@B@DA@B@@BB@@BB@B@@BBF@BBB@B@FB@@B@@B@@BBB@@@@B@@@BAD@@@@D@B@@ABE@AB@BBB@@A@@AA@@@@DABABAB@@BBB@A@@@@BA@@B@@A@A@@@A@@@@BBH@BBB@BABCDGB@FI@ABA@AB@@BB@BA@@@@BBB@@@DCB@AA@@AA@@@ADAB@@ABB@@B@@@BA@ABAB@B@@@@A@AB@B@@@DD@@B@DC@@A@@A@@@@@@B@FDFFDDDD@@D@BBB@FL@@@@A@ABAB@@@BDBA@CD@@@@@BB@D@D@@@BA@@BBB@BBBD@D@@BBD@BA@B@@@@@BBB@BB@@BC@@BDD@B@BDBB@B@@@DB@@@@B@@AB@BAB@@@BBBBDBB@B@BAA@@A@A@@@@AA@@@@DCBA@CBABA@@@@BBA@B@@@B@BA@@BA@@B@@@BA@@B@B@D@HHB@BBB@BBDFDBBBB@@B@@D@B@@@BABBA@@@@B@@@D@BAD@B@@@@B@FCB@B@@@@@@B@@@@D@@@BBBB@@BB@B@@B@@@@AB@@BBB@@@@B@BA@@@@BBBBDBB@B@BA@A@@@@BA@@@A@@BAB@F@BAB@DA@A@@AAB@@A@@@AB@@@B@BB@B@B@@BBBAB@D@DADBD@DAHCFCBA@A@@AECA@@@ABA@CBADABABB@@@@@A@@@ABABAAAIIAGAA@ABADAB@@@B@@B@BB@BAFCB@@C@A@@B@@@@ABA@@B@BB@@B@@@BB@BDDBBDB@B@B@B@@BB@@B@BA@@@@BABBBDB@@A@@CA@A@@@@DC@@ACCA@CCCAAA@@A@@B@B@BBDA@@DBBDBD@BBAB@@@BBB@@@@A@@AA@A@@BADABCD@@C@@B@@A@@@AAAA@@A@@DC@@@A@@BA@B@@B@DAF@RG@@@B@@A@@@BBB@@BAB@BB@@AB@B@BB@@B@@@@ABAFA@A@@@@@A@@B@@@F@B@DAD@B@BABAFAD@B@D@BCDAB@DBB@BBB@DAD@H@D@@BB@BA@ABADBB@B@DAB@HBBA@A@ABAFCFCBAFABABAB@F@H@D@BA@CDE@CBADABAB@DBHBD@B@@A@A@ACA@G@ABABCDAFAJCBABCBCFCDCB@D@B@@B@D@D@B@@F@JFFFBB@@B@B@AACEAC@@BAB@FBB@@@B@AAE@EEECAC@ABC@AEECE@C@A@@B@BABAD@BA@AB@@@B@B@B@B@@A@A@ABAB@B@DAB@BAB@@C@A@C@A@@@@BC@@@A@A@@@A@@B@@A@@B@BBB@B@BAD@B@B@B@@BBB@BB@BD@@AD@@@@DB@BBFDDF@BABAD@BANIB@B@@BEFAFAFCLDBDBAJJENCTEREPKFGFMDIBENENCRAPEJG@@D@@A@ABABAB@@CB@D@BAFAFAFCDAFCFE@A@A@@A@QCEBE@ACAE@AEA@ACAAAAAAACCEECE@CAE@A@CBA@AAACACcBAHOLILCPAJ@PFL@JALAFADAF@NFD@B@DAFIFGFQFI@EAGCG@A@CBGFG@C@C@E@ABAFG@AACE@ICMCBE@A@AB@JABA@C@C@@CAAAAA@C@ABADCBAB@DABCDCBCBCBC@K@ADG@ABABA@ABCAAAACAAAAAICKGCAC@C@I@ABA@KACACCAAACEECAAB"],encodeOffsets:[[114315,35769]]}},{type:"Feature",id:"140829",properties:{name:"平陆县",cp:[111.194133,34.82926],childNum:1},geometry:{type:"Polygon",coordinates:["@@UM@@ICI@CDABUZMLCDBFBFD@F@DBB@BAB@B@@@B@B@B@BB@@BAB@@@@@@@@B@@D@@@BBA@@BBBB@DB@B@@@BA@AAA@ABEBA@CBG@C@A@A@@A@AA@ABA@A@@AA@@BA@A@ABABE@@@ABADEBA@C@C@A@ED@DC@A@ABBD@B@BADAF@BBB@H@D@H@BAD@FAB@DABDL@D@BB@@@@AB@BBAB@BDFD@B@DN@BAFCDA@ABABCD@@@BEFAFABCDGDGFGJCD@FBDBD@B@@AAA@AAA@@AA@ABC@BBBBBBDDB@DBDBB@B@B@B@B@BDDBDDDBDBFDDBBDFJB@DBJAFB@B@@HJBDBB@@DBB@H@HBD@B@D@BBB@B@BDBBBBBB@BBB@BBBB@BBD@B@F@D@H@D@BBBBDFHDNFBBD@B@BBB@DB@B@ADAHAJ@D@AFBFDFFFHHBDBDDBH@HBBFDBF@H@JFF@F@BDADBBHDDBBFFDBFDBDBDHBBD@D@F@DDJBD@DFFDF@L@BDD@D@DCBABA@@@BB@@BABABCBCB@BB@@@D@@@B@BDB@DBBB@BBBDFFDBB@B@@CB@@@@@BDDB@J@HBFDFDDBD@BB@B@BCDABABBF@H@DFBB@BDBBDAFAB@B@DDBB@@JFD@BB@B@BD@B@B@@B@B@@B@DBB@BBBBDBFBB@B@@ADADAD@DEB@BD@BB@RBDBBADALCH@HBD@@BF@JAHALAJBDBDBH@JEDCFCDA@AB@@C@ABABA@@@@@BAB@D@B@@B@FALAFADABAB@BB@BBBDDD@B@FAD@DEBADCHAFAFCBAFBBDFH@FB@B@D@DADABAB@BBBBBFBBD@@@B@BCBCBAB@HBB@DAD@F@D@BBB@@A@ABA@GDA@AD@B@DAB@BBB@BAB@BB@@BBDBBD@B@DBB@BB@B@D@DBD@BBB@@EBA@@HABAD@@@BK@@@AB@DBFFF@B@BA@AAACA@@@ADCBADEBEDEBC@C@AGE@AB@H@F@JCBA@@CE@CBC@AFA@@BABBDAB@B@D@BDHPFDFDBBH@B@DBB@@A@@BA@A@@B@B@@@@B@DBBBDNHXLFJDBBBAD@BFFB@F@DC@@FBD@D@@BBBBB@AB@BAB@B@DAB@D@BB@@AD@DBH@@B@FCDCDADA@@BEAA@ABEDE@EBABGDCACBCDCDC@A@CCCDAFABA@@B@@BB@B@@@DAB@B@@A@@AAAAA@B@@A@@FDB@BAB@@@BB@B@BBB@@@DB@@@DA@@B@@@AD@@BB@@B@B@DABGBC@AI@A@BA@AHAFAH@DAN@JALCJADALCZIPEB@@A@ABAD@@@@ABAA@A@AA@@BIBA@@B@B@DD@@@@BC@@B@BBDDBBDBBABAAA@@@@B@BD@@@@BA@A@@F@FABACA@ACCAAACCIAAA@CBA@CA@A@@@C@GBA@@ACCMAAAGAAAAEAEBEAA@CAAAEAIEAACC@CAGBAAA@C@EAEAKCGCCC@@A@@@A@ACCAAKECCCCA@EAABCBA@CBCD@D@BCBEBKBG@E@KCC@EAC@A@CACAAAIGAAAAAAC@A@A@AAC@@AAA@CGGACC@EAC@A@@@E@E@C@ECGAI@@@KBA@A@C@A@EDEFCDGDCBEBE@C@A@CBA@AAEGAA@AAAAAA@A@CACAE@AAABA@A@A@C@A@@@CAAACAAAAACACEAACAA@CAA@CAA@A@C@AB@@A@@A@@A@ABA@CBG@CBC@CBE@ABA@G@C@CBC@EBEBCDCFADCBCBA@C@A@C@CAIGIGKEEAG@ICGAAAGCOIEA@@@@CAEBIDIHQJMFE@EAECAACECGEKACACAGCECAAAGCG@A@I@EBCDCF@@EFADEBKBIBG@GA@@K@C@E@CDEDIJCDGDG@ICMKKKMS@@EIGMCOACAKAECCGEC@I@KAK@C@UCE@eC@@_I]KICECSI]QAAOIOG"],encodeOffsets:[[113651,35547]]}},{type:"Feature",id:"140830",properties:{name:"芮城县",cp:[110.694369,34.693579],childNum:1},geometry:{type:"Polygon",coordinates:["@@ICA@MCODSLA@SNIHKFCDSJAB]NC@KBMACAGAEGAACEQQAAIGEECAECQAQDGDGDEBA@QFOFaJOBGBe@SBY@GB@@C@QDGDEDGDKHSRSZCFGPCJM^NDB@D@JAH@BBH@DBD@D@D@D@FBBBBDBBBD@F@D@BBD@DDBBF@@ADABABAB@BBBDDBDBBD@DDBBBBDD@FBBBBHH@D@@@DAB@B@BBDBB@DB@B@B@DBB@D@B@@@@B@BCB@BABB@B@B@BADAD@B@B@@BCBA@ABAB@BBD@BB@BB@BFFB@H@B@@CB@BCBABA@@B@@@DAB@@@@B@BB@B@BBHB@BBBFBB@DBDBD@@DBBB@FBFBDBBBF@F@F@D@HBD@B@B@DBBBDB@@BBD@D@B@H@DBB@D@F@B@DAFABADBHFLDFDDBHHDDBBDDB@D@B@@@B@B@D@@AB@BAB@BADAB@B@D@@@DFB@@@B@@AB@D@@BB@B@DBB@B@BAB@@ABBBBB@D@B@@B@@@BB@@@DABABAD@BBD@@BBDBHDHDBB@@BBDBDBBDDDDDBDBD@DB@BBB@BB@BADADADAD@FADAB@B@BBB@FBF@H@F@DBF@DBB@DABAJEB@FGDCB@B@F@D@B@B@@AD@BAFCB@B@B@B@F@F@F@D@D@DDBBDDB@F@BADAD@FABAD@F@J@N@BBDADANEDCDABAB@B@B@BAB@B@B@DCD@DABBD@B@FDB@DB@@BH@@FBB@DBBBBB@HBD@BHBNFFDHBBBD@B@DCBKDCFCBABABBDBBBBDHDD@DBDBFBBBBFBBDBD@D@H@JBJBD@HBB@D@DBB@HDB@F@BBB@B@@@BBBB@DFDDBBBB@FBB@F@D@@@D@D@B@B@DABA@AFBB@B@B@B@FCFAB@D@B@DBBDBBBBFDBDBBBBBBFDF@BBB@FBD@FBB@F@BA@AB@BDB@@@DBD@B@B@BAB@D@@@FBJD@@F@FAHAFCB@FAD@D@D@D@B@DBDB@BBB@@DFD@BAB@@BB@BBB@BB@@@AACAC@EDCHIHEHCDCBABEFE@A@@DCBABAB@DCBE@ACMA@C@CE@ABAAAA@@B@@A@@A@CCKBA@CBA@EBC@A@G@C@GAA@ABEBC@A@AACBAB@D@@CFCB@D@D@B@FABCBA@@F@BABAB@B@@AB@@BB@B@BAB@@B@BB@B@D@H@DAB@FABAB@BBB@@A@@@ACAA@AA@AB@AA@@C@@@@A@@@@@@A@AB@@AAA@A@A@@@A@A@ABA@CAE@C@AEAEDCUJK@I@IAEGAEDS@_IYCGMYIMCEIICGIICEQGGCE@O@MAK@SEIECAIEA@GAQ@MJONEDCBIHOLC@OFA@ODI@C@ICECAIIKOKAAACemEEEAaBG@FK@yAC@EBGAQCOCOGSG@@[KICIC"],encodeOffsets:[[113207,35416]]}},{type:"Feature",id:"140881",properties:{name:"永济市",cp:[110.447543,34.8671],childNum:1},geometry:{type:"Polygon",coordinates:["@@CRIZCDKTCBMVCFETBPBJBRBFbl@@BDJVDHBJ@B@FCFKF@D@H@@@HBF@@DHDHBD@FADCD@@ADBJ@@DL@@BFDDJBJF@@FDFJ@@JTHJDFBDZXHJPP@@T\\DHFL@@BJFT@@FTBL@@@BDNDNLAH@JAHAJ@FAR@DAB@D@F@DAB@BADAB@BAFAD@@A\\MJGRIHEPGDAHCDABCBABABABAB@FEDADCBAB@B@FCDAB@BAF@HCD@B@DA@@DA@@BAFEBC@@BEDAFAD@DCDAFEFAHABBBFAH@DBDDBF@D@DCD@FAD@F@BEDA@AD@@@B@BAHAB@BABAFCJCN@B@D@DAH@JBD@F@HBNDBDBHBBHBFDF@FCJEPCFAHADADBDB@@BB@DB@FBD@@BB@H@@ABA@@BAF@@@@A@ABAD@F@B@FAB@BAB@BDD@BBBB@DB@B@D@B@DA@@B@@B@BB@B@@B@B@@FBB@BBB@@@FBD@B@@B@B@FB@DDB@DBLFB@HBD@D@@@JBDBBBB@BBBB@@AF@F@@@BADABA@CBA@ABADAB@F@@@B@@BBDBDFDBFBRDF@RDDBJAHAFB@@FBHDD@@@DA@CBEBCBCB@FCHBD@DGBKKAAAAAA@AC@AAG@C@I@C@C@C@@BA@ABCDEDABA@@BE@@ACEEGEICGACECGCECE@C@A@CBABE@CBABG@ADM@MBMBALCL@JB@@D@D@BA@K@OAAC@CBEBA@KAGA@ACG@AAIAAGEEAAIAG@CFADCHG@A@@FCBE@A@AAC@AA@@AAA@EB@@ADCBE@E@A@A@ABGBEBCDCHE@CB@BCBABC@C@CDIBC@CAAAA@@A@A@AAE@A@GCA@CAC@A@GAC@IAIAG@C@C@CAAAAEAAEACACAC@GCACAACAAAABABEDCDALCDA@C@AAGAECMEGA@AAC@GAAAACAA@EA@@AG@@CAA@ECA@C@AACBC@CDA@A@A@ABA@A@A@ABCBCDMFCBCBAAM@I@E@C@ABEBC@CBABE@A@CCAACCC@C@E@E@E@A@A@A@A@EDABC@@BA@A@C@E@A@A@CDEHA@IFABCBA@CAE@CAE@G@E@EAA@AAA@A@CBEBC@CBCBCBABA@@AAA@ACAC@CACACCCCAAACAC@AA@CACGAGAC@AC@AAC@ABABCB@@A@@A@@@AA@C@A@AAAA@BA@ABA@A@CAA@A@@AC@A@@BA@@@A@CE@@C@A@A@CBABA@ABA@@BC@A@A@@@A@C@A@CCAACCGGCAECKCGECAABEBCBA@E@C@A@CAG@A@C@C@AA@@CAAACAA@A@C@GAC@E@E@E@AACAEAEAA@AA@CC@CACAA@EAAA@AGAAAA@A@@A@A@@A@CB@@A@@@ABABADA@@DA@G@A@EE@AAAA@@AAC@ABABAB@DA@AA@A@C@CBABA@A@A@BA@ADA@A@A@@A@C@A@CAA@A@A@@CAAAC@A@ABA@C@@@CGGAAAA@ECCAAAACCC@AAACCCAA@ABABABABC@@AECA@CAC@A@C@EACAAACAAEAC@C@C@C@CAG@AAG@IBC@A@MCETDZFVJRDHBFH\\BF@H@XAD"],encodeOffsets:[[112905,35590]]}},{type:"Feature",id:"140882",properties:{name:"河津市",cp:[110.712063,35.596383],childNum:1},geometry:{type:"Polygon",coordinates:["@@@EBC@C@AACAA@AAIEAE@AB@BAJ@D@BABGAA@EJIHCBC@@AACCG@AQEC@CDA@EBCDCBCFADABABA@C@CAAGCAA@C@ABCBBJABADADA@@@C@EAC@AAC@A@@BEEEBABCBCFAB@BKBQTILE@aKEOAAC@sCG@gD@@HT@@FL@@DHR^@@HNFNFHFLL\\HRHPBDDDJFDBBRBLBFD\\@@@HANCLCR@BAB@@GBE@CBCDAD@D@JBP@DAFCDEDCBA@AB@DAFADEH@@AF@DBFDF@F@F@D@DCP@NFFHBPJFDB@BB@BDB@@HBD@@BD@B@@B@BDDBD@@@D@BB@B@@BBBB@@BB@DDBBBBDBDD@BBFBDBNBBFBJDTDVAHCLENM@AFM@@@@@AD@RBDAFALCDICGGCMGA@IO@QDCFEVJHBHDHBB@TD\\DTFB@D@D@PIJMFCB@HCNAD@\\ALC@@NCHBJAJBNE@AJc@CCA@A@AAC@@A@@AA@@AAA@CDCBADC@W@U@GAOAMDG@EAGACBC@ABABCDADC@ADCBABG@EAA@C@ABAEEMAGBEAHKTIBC@CBYB[@EC@MBE@CA@A@CBGBCDCDADCDCDADADCFIDABC@E@EGASEIBEDGFA@E@EAECC@CBA@C@@@@EA@A@@@ADABA@CAECCEEAA@KCAACACAAE@I@ACAA@ABAJAFCBC@A@C@A@A@AA@CCC@A@AAC@AAAAAE@A@C@@AA@@AA@@C@AAACAACA@AEAC"],encodeOffsets:[[113397,36342]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
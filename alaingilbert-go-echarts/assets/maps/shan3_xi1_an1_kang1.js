@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("安康",{type:"FeatureCollection",features:[{type:"Feature",id:"610921",properties:{name:"汉阴县",cp:[108.508745,32.893026],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@@A@@@AAA@A@@AA@@AC@@AA@A@A@@@AA@@@@C@@@A@CAA@A@@@AA@AA@A@AA@AA@@A@@BA@ABA@A@A@GCCAC@A@A@ABC@A@ABA@@@AAA@@@AA@@@AA@@@A@A@C@@@A@CBA@@BC@A@@@@@A@ABA@A@@@@DA@@@A@@B@@@BAB@@AB@@@@ABA@@B@@AB@@CB@@A@@B@@A@@AA@CC@AA@@@C@C@CCBD@@BDADADAB@@@B@DABAD@DAB@@BBABAD@@@B@B@@@F@DAD@@@B@@@@@B@@BB@B@@BB@D@@B@@@@@B@B@@@ABCDCDA@AB@@ABCBA@CBA@@@EBA@CAA@CB@@C@ABAAABC@A@BB@@BB@B@BB@@B@@@@DBDB@@DBBBB@@BB@@B@@@B@BB@@B@@@@AB@@B@@B@@A@@@AA@@@@@BBB@@@@@D@DBDB@CB@D@@ABADAD@@CHABCB@@A@@@BB@@A@A@@@A@A@A@EB@@@@@@@B@B@D@@@B@@@B@BABABC@ABA@@@BB@@@B@@A@A@@@A@@@@@@B@@ABABA@@@A@A@@@@@ABAB@@ADABA@@B@B@@@B@@@B@B@@A@@@BD@B@@ABC@@@A@ABA@@@ABA@ABC@A@@BA@A@@@ABAB@@BB@@@BB@BBDDB@DBFFBB@@@B@B@B@B@B@B@@@BDBBB@@DBB@@B@D@B@@BBB@@BBDBD@@@B@B@@@BAB@BAJ@B@B@@CB@@@@@BB@@DB@@B@BBD@B@@@B@BB@@@BBF@@@D@@@BA@AB@BAB@@ABABA@CB@@CB@BCBABADC@@D@BAB@DCB@BCDA@@HCDA@@BA@@DA@@D@@@F@@@B@@@BBDDBBD@B@BBBB@@@BB@@@@BB@BB@@@B@@BBB@@B@@@BB@@BB@B@B@B@@AB@@AAA@@B@@@@@B@@B@BBB@@@@@@@@AB@@@BB@@B@@B@@@BA@A@@B@B@@AB@@@B@AD@B@F@@BFBFBD@BBD@B@BBF@@BBBABA@@B@@@BB@@@@@@BA@@B@B@@@AA@@@@@@B@@@@@B@B@@@B@@@@BB@BA@@@@B@B@@@@@@AB@@@B@@@BA@@B@@AB@BAB@B@@@B@B@@@@@@AB@@@B@DA@@B@DA@@B@BDBB@@BDA@@BBB@@AB@BB@@B@@@B@@BBBB@@BB@@AB@@@B@@@@BBBD@@BBB@BB@BBB@BBBAD@@@BB@@@B@B@@@@@B@@B@D@B@B@@@@B@@@B@@D@@BBDB@B@B@@@B@@BB@@@@@BB@DDDB@BB@@BB@B@@@BDB@@D@@BBBBB@B@BBBAB@@@DBBBB@@BBB@@AB@@ABABAD@B@BAF@D@BAB@BAD@@B@BF@FBBBBBB@@@BB@@B@@BB@@@@BB@@@BBD@B@D@BBBHJ@@B@@BBB@@B@BB@@@@BB@BBB@BBB@@DD@@@BAB@B@B@B@@@B@B@BA@@BA@BB@@BD@@BDB@@@DBBB@@BD@@BB@DBBB@@B@@BBBBDD@B@@BB@@@B@DB@@BBD@@A@A@@B@@C@@@@@A@@@@@@B@F@F@B@BAB@@B@@@HF@BDB@@B@DCB@@@BBBBB@BB@BB@B@@ABAB@BA@A@BB@FFB@@@B@@@F@@@B@DBBB@BBB@@@B@@BB@@B@BB@@B@@B@@@BBB@@@B@@ABEDAB@BC@@BABB@@@DB@B@BBBBB@DBD@B@B@D@B@B@B@B@B@B@@BB@BBD@@@B@BA@@BB@DD@@@B@D@@AD@BAB@BA@AB@@@@ABA@@@@@@@DDBBBBBBBB@B@B@D@D@@DBB@@B@D@@BB@@@@DB@@BB@BBB@BDB@@@B@@B@B@DBB@DBB@BB@BB@@B@B@@BBBBBDDD@DBB@BB@BBBBBB@@BD@BAD@DB@@B@BBBBB@B@@@BBB@B@@@B@@BBDDB@BBBB@@@BB@BB@@BB@@B@B@B@B@DB@BD@DBB@@@@B@B@@B@@B@@DBB@@@@BBBBD@B@D@D@B@F@@@B@BBAD@B@@AD@@@@@D@B@@@B@@@@@B@D@@@@@@@BBB@BBBBBBBB@@BBBBB@@@F@B@B@B@B@D@BAB@@@BB@B@B@@@B@B@B@@B@@BD@B@DBD@@@B@DDB@BD@@@BF@B@B@DAB@BD@@DDBB@@B@BB@@D@@@BB@@BBBB@@@B@BB@B@B@DBB@B@DBB@@@BBB@DBD@@@@@DA@@@@F@BBB@D@@@B@BBBB@@B@BBB@B@B@DAD@BA@@B@DDB@B@@@@@BABAB@BAB@B@DABAB@DA@@BAB@@@@@B@@BB@BAB@BB@@B@@@D@B@@@D@@@BBB@DA@BB@BAB@D@B@B@@B@@B@@@B@@@B@@@JB@@B@BB@@@@B@B@BABA@@@ABBBDBBB@D@B@@BB@BBBBBBB@@@B@B@BAD@B@BADAB@B@D@B@B@B@@@B@BBB@@@B@BAD@HAB@B@B@@@BAB@@AFAB@BAD@B@BBB@DB@@B@B@B@BADAB@@B@@D@@@J@B@BAB@B@@@D@B@B@@@DAB@BAF@D@B@B@FB@@B@@@BABEBAB@BABA@A@@@AA@CCAA@@CAA@AAAAAAAAC@AA@@GC@A@AAA@AAAAA@@AAC@CA@@AA@C@@@C@AA@@@@A@A@AACC@A@AAA@@BA@@@A@CAA@ABA@EC@@A@AA@@@A@@@A@@@A@A@A@A@C@@AC@ABA@A@A@CAC@E@@BC@@@AB@@A@AB@@A@@AC@@A@@@A@A@A@A@A@@AAA@@A@ABA@CAA@A@@BA@EC@@A@AAEC@AAA@@AA@E@@@A@ABA@C@A@@@@A@@AA@@@@@AABCA@AC@@AE@@@A@@@AA@@A@@A@BA@@@AA@@AAA@@@@BABABA@ABA@C@@@A@@@@BADA@@BAAA@@BA@A@@AAAA@@AAA@AA@@AAA@AA@A@@BA@AB@@@@CDC@@@CBA@@@@@A@@AAAA@ABC@AA@A@@@CBC@@@A@@@@@@@@C@@BCBA@@AA@@CAC@AAAA@@CCA@@AA@EA@@AAAA@A@@AAAA@@@AAACAA@@@A@@@A@AAA@C@A@@ACAA@AAAAAAA@@AAA@@B@BABABA@CBA@A@CBC@AB@@AA@@AAAA@@@@EAA@@@@AA@@A@@A@A@@@AA@@A@@@AA@@ACAA@@A@C@@AA@AAAA@A@A@@A@A@@AA@AAC@@BA@ADAB@BA@ABABA@@AA@ABC@A@ABA@@@@B@@ABB@B@B@@BB@@B@FCD@DAB@B@@@@A@AB@@ABCDC@@@ABABA@@BA@@B@DADBBAB@@@D@DAD@B@@ABA@ABA@@@ABBB@DB@@FAB@B@DDB@@@@AB@BAB@@@D@@AB@@A@@DC@@@@B@B@B@B@@@B@@A@ABA@@B@HA@@B@@@BCB@BA@@B@@@D@D@B@BAB@B@D@B@@A@ABABA@CDAB@@ABABA@@@C@@@ABAAA@@@CBABA@AAAA@AC@@@A@ABA@A@C@AAA@@CAAA@@@AA@@C@A@AAA@A@@BC@@@C@@@A@A@A@@AA@@AC@AA@@AAA@A@A@A@A@A@@@AAA@@@A@ABABCDA@ABA@@@AA@@AAA@CACAC@A@@AAA@@CA@AAA@@AC@@@@A@@AEBGBA@A@@@A@A@A@@@CAC@A@@AA@@@A@@@@@AA@@@@@AC@A@C@@@A@A@A@ABA@C@@@A@@@A@A@A@C@@BCB@@A@A@@@@@C@@@AA@A@CAE@AAA@@@AAAC@@@A@@A@ABC@@@CFABA@A@@@A@@AA@AAE@A@@@@CA@@@@@E@CBC@A@A@A@ECECCACAAAA@A@A@AACA@@CAAA@@@@CBA@@@CA@A@@A@A@CBE@IAA@A@@AAA@A@@AAA@CAA@AACAAAC@@@AAKBA@A@A@ABABA@@BC@C@CAAAC@E@C@A@C@@@A@A@@@A@@@A@ABABABC@@BABCBA@@B@@@B@B@@AD@@AD@@@@ABA@ABA@A@@BCBABCBCBAB@@@@@B@B@@AB@D@@C@A@ABC@A@@BA@CB@@ADA@ABAD@BAB@BAB@@@@AB@@@@A@@BEBC@C@ABC@@@C@@@A@AA@@A@EB@B@B@B@B@@ABC@ABA@@BAC@AAC@@@C@A@AAAA@@AC@A@@@A@@@A@AAAAA@@AAC@A@A@A@AAAACA@@ACAA@CCA@AAAE@@AA@@@@CAAAA@AAA@@AAECECAAA@@AA@@C@CCA@@@C@A@@@A@C@C@A@CA@@@AA@C@C@A@A@@@@@A@@@E@AAA@AACACA@@A@@C@@AAAACC@@@@A@C@@AA@@@C@AAABA@@@@@@B@@BD@B@@@B@B@@A@@BA@A@AB@@@@@B@D@@@@A@@@AA@@A@@A@@@@A@@A@@@@AA@@@@@@@CAA@@AA@A@A@A@A@A@A@AB@@@@A@@ACA@CC@@@@@A@@BA@@BABAB@@@@A@@@A@AE@A@A@CACBA@C@@@AAA@BADA@@@A@@@@AC@@BAAA@A@@@AB@BA@AA@CA@@CCCC@A@AAA@ADCBC@@BE@C@AAC@C@@@@A@A@@@CB@@@BAB@B@@A@GAA@A@@@@A@@@@@@D@B@B@@@@@@A@@@@GAAAAAAAA@@CA@@CAAAA@AA@@@A@@B@BA@ABABABA@A@@@A@A@@ACAAC@@AC@@@AAA@A@@AA@A@@@A@CAC@A@@@@@A@A@@@A@@@A@A@A@AB@BA@ABA@A@@A@CAAACCAAA@@@@@C@@@AB@@A@@BA@@@@BA@@@AAA@A@A@A@A@AAE@AAA@@AA@@BA@@@CB@@@@A@@BA@AA@@A@@BAB@B@@@@@B@@@BA@@@@@A@@@@@@@AB@@@@@BAB@B"],encodeOffsets:[[110930,33448]]}},{type:"Feature",id:"610922",properties:{name:"石泉县",cp:[108.247886,33.038408],childNum:1},geometry:{type:"Polygon",coordinates:["@@CA@@AACA@A@@@A@A@A@A@A@A@@AAEECAA@CCAAA@@A@@AA@@E@AAACAAA@A@@AA@A@C@CBA@ABABA@@@AAA@A@@B@@A@@BA@@B@@@B@@@B@@@@A@A@AA@@ABA@@@AAA@A@A@EA@@A@@BA@@@AA@@@BA@@@@BAB@@AB@@@@@BD@@@@D@@B@@BB@@@@B@B@B@@@@ABABA@@@@F@@@@G@CAA@AB@@@A@@@A@AA@@AAA@@CA@@CAAAA@@@@BAB@@@@@A@@CA@ACBAAE@A@@@@@BBB@BB@@BD@@@@BDBB@@BB@@B@BB@@@B@@@BDBBD@B@D@@@@@@A@@@@@A@@A@@@@A@@@@@@@@@A@@AA@A@@AABA@AA@@A@@@A@@BCBAD@B@B@@ABA@@B@@B@@@@@B@@BB@@A@@@A@@BA@@B@@B@@@BCF@@A@@B@@AB@@@B@@@B@@B@D@B@BBB@@B@B@@@@DD@B@@@@@DAB@BCBA@ABA@A@@@@BBB@@@@A@ABC@A@A@@@A@@B@@@B@@AAA@@@ABA@@@AB@@A@@B@BA@@@@@@@@@@D@@@B@D@BABAB@@@B@BA@@B@@ADAD@@@BAB@B@@GH@B@B@BAD@@@D@B@DAFADCH@BBFAB@DAB@@@B@@@B@BB@@BA@ABC@@BA@A@A@E@A@A@A@A@A@C@C@A@G@@@AB@B@@ABA@@BA@@B@@@BHD@B@@BB@BBB@@BB@D@B@@@B@B@@BF@B@@@B@B@B@BBBB@@DB@@DBBBD@BBB@F@BB@BBB@B@BAB@BABBB@@@@BBD@DBB@BB@@@BBBDB@B@@@DAB@DEB@BAB@@@B@@AB@@@@B@@@B@B@DBB@B@@@@DDBBBB@@@B@B@D@BBB@@BBD@D@B@DB@@@B@@@B@@@@BDBBBB@@AD@B@@@D@@BB@BB@@B@D@BB@DH@@@B@@A@ABABAB@BAB@@AB@@@DAD@F@B@B@D@BAB@@BB@@B@@D@@B@@B@BBB@B@BB@@B@@AB@B@@@B@@B@BBDB@@BB@BBB@BB@BBB@B@DBD@DBBB@B@@@B@@BBBBB@@BA@@B@DAB@BA@@BA@@@A@@BA@@D@@A@AB@@@B@D@@@@CB@BAB@BA@@B@BAB@@C@@@@B@@A@@@A@@@@BABAB@@A@@@C@C@A@@BAB@B@B@B@@@B@DBB@@B@@B@B@@@B@@@B@@@@EBE@AB@B@@AB@DAD@BCBAB@B@B@@A@@@@BA@A@CFABAB@@AB@BA@A@@@@B@BABA@@B@@@@CB@B@@@@@D@D@BABABAB@@@B@B@B@@@@@B@B@@@BAFAD@@BB@BBB@@@DABBB@@BB@B@BA@@B@D@BB@BFBBDD@@B@B@@@BB@@@BBD@B@@@B@@@@@BBBB@BBDB@@@@@B@BAD@DB@@FAB@BA@@B@BA@@BABABAF@@@B@BAFAD@BB@@F@B@@@B@B@@BBB@D@B@@@ABAB@BAB@@AD@B@BBFBDB@@B@B@@@B@BAB@F@@ABA@AB@@A@@B@B@B@B@@AB@BA@A@A@A@A@A@@@ABAB@@@@@@BBBBDBBBBBD@FB@@@BA@@@A@@B@@BBB@BBBBBBDB@@@@DBB@B@B@@@@@BBBD@B@B@BBB@@@@A@@B@@@B@@A@A@A@A@@@A@C@BBBBD@DBB@D@@@@@@@@BA@A@A@A@A@@@A@@@BB@F@@BB@@@BA@@B@@ABABCA@@EAA@@@A@AB@@ABAB@D@BA@@A@AAAAAA@A@CAC@A@A@A@A@AAAA@@CACA@A@@@C@A@A@@AA@C@@A@@@CA@@@@AB@B@@EF@B@@@@@BBBBB@BBB@@B@DBB@@B@@@@@DA@@BC@CBGDA@ABA@@@CBABA@EDAB@@A@A@ABABA@A@A@@@A@@@@@CB@@ABA@@@FDBB@B@@A@@B@B@@@B@B@@@B@BAB@B@@AB@@@BA@A@C@CD@BA@AB@@A@@@AAAA@@A@@@ABED@@A@@D@@@@AB@@@@@B@@@BFD@@@BA@@BA@A@A@@@A@@@@@AB@D@@AB@@A@A@A@CB@BB@@BD@B@BBBBBB@B@@B@BABAB@@@BA@@@@@@B@@@BDBB@BDDBB@@@@AD@@AB@@@B@@@B@@@B@@@B@@BB@B@DBB@@BBB@DBB@BB@@@BB@@@BBD@BB@@@BB@@B@BAD@B@B@@B@DB@BBDBBAB@@@BABAB@@@DBB@@@BB@B@FADAB@@@B@B@@@BB@@@B@@@BA@ABA@AB@DAB@D@D@D@B@@A@BD@@@BBBB@B@DBDBB@D@@@@@@A@@BA@@BCB@@EBA@A@A@@AC@@@@@@B@HB@@@@@@AA@AAAAA@@@C@@BC@A@A@@J@@@B@BBHDDD@@BBBBBDBBBBF@@@B@@@D@D@F@B@B@B@@@BB@@DB@@B@B@DBB@DBD@B@@@D@BA@@F@B@D@D@B@BA@@B@B@@@B@@@@A@A@@B@BBDAFABAB@@@@@B@DBB@B@B@DADAD@B@DA@@D@B@D@DBB@BBDB@B@@BD@BBBB@@BBB@B@BBB@@@BB@AB@BBBB@D@D@B@B@B@B@@BB@@@D@BBDAB@DB@@B@@@@DBBB@@BD@BB@BB@BFB@BB@@B@@A@AFA@@@@AAAA@@BA@AB@@CBABCFC@AFA@AB@BA@AD@@A@@@CAC@AA@@@@@@@CB@@A@@@A@@@@@@@BA@A@@@A@A@@BABA@@BAB@@BB@@D@B@@BBBB@@B@@A@@BAA@@AA@@@BABC@@@@@A@@@A@ABEBE@@BA@@@A@E@AB@@C@A@@BC@@AA@@AA@@A@A@@BCB@B@@@B@BA@@@AAC@A@ACCAAEACAAA@@CAA@@@A@A@A@@AA@A@AAA@AAA@@AAA@@@@@@A@@@A@@BABCBABA@@@@D@F@B@BA@@BAB@B@@BB@@@BA@@B@@BA@B@@BB@@@@A@@@A@C@@BA@@B@B@D@BABCBA@AD@B@@AB@BA@AB@BB@@DA@@BBB@B@B@FAB@B@B@BAB@D@B@BBB@BA@@F@BBB@BBD@BBF@@AB@B@B@@@BA@@B@@@B@BA@A@@D@B@BA@@B@BADADABABA@@@@D@B@BB@@@@@@@A@C@A@@BA@@@@BAB@B@B@@@DAB@D@@ABA@@B@@@BABA@AF@D@BBBBB@@@D@@@B@@A@@BB@@@@B@@@@@BAB@@@B@BB@@B@@AB@@@D@BAB@D@FAD@@@B@BABC@@BAB@FCB@@AB@B@@AB@BA@@B@@@BD@@DB@@B@@D@@B@@BB@B@DBBB@@@@BAB@BBB@BBB@B@@BBBB@B@@DDBBBBB@@D@BBBB@BB@@@BB@@@@B@B@@@B@D@BABAB@FC@@@@@ADA@@DBBABBBABBB@BA@@B@@@B@B@@ABAB@@@DAB@D@DCBA@A@@A@A@C@@@AAA@AA@@C@@@@A@AAA@A@@@ABC@ABA@ABABA@@@AB@@AB@B@B@H@DAB@B@BAF@@@@@BAB@DA@@B@@@@A@@@@@A@@FA@ADA@@@A@@BABADAF@FAD@DAB@@@@@B@BA@A@A@@@A@AAA@AACCEAC@@BAAC@A@@@A@@@@@AB@@AAA@@@A@ABA@ABA@@DA@@@A@@BABAB@B@@ABAB@@@@@CA@@AC@C@@@A@@@@B@BA@@BA@@@ABABABAB@BC@@BA@@A@@AA@@@@@AB@@AB@@@@C@@@ABA@@@@AA@@A@@@A@@CCA@CCA@CAI@A@@@CBCBAB@ABA@@DC@A@@DCB@BAB@J@D@B@B@D@B@@@BA@AB@D@B@DA@@@C@@BAB@BAB@@ABCB@@ADABAF@FAB@DADAB@B@B@BAB@BA@A@C@@B@B@BAB@BB@A@A@@@E@A@C@C@AACAA@A@@A@CA@@@AA@@@@A@A@@A@CAC@@ACAA@A@A@A@@@AA@@AAA@@A@@AAAAA@CCAA@@@A@@@AAA@A@@@AAAAA@A@AA@@CBC@AAC@@AAAAAAA@@AAA@CCCACAAAA@@@A@AA@@AAAA@CAA@CAA@A@@@@A@@CA@AAA@AAA@@CA@@@@AA@@@C@AA@CA@@@C@C@A@AAAAAAAAACC@@@@@@B@BA@@@@BAB@@ABA@ABC@@@C@A@@CCA@@AB@@A@A@@AC@AAA@@@A@A@A@A@A@A@C@A@AAC@CAAAA@A@ACA@@A@BA@AD@@ABAFCBA@@@A@@AA@A@@@AA@@@AAA@@@AA@@@A@@AA@AAACAA@@@E@@@A@@@A@EEA@@A@BABA@AB@BA@A@@AAAA@AAAA@@A@CDA@@@CA@AGE@@A@@@BA@A@A@E@E@A@@@@B@@@@@D@@@@AB@B@@@AC@AA@@C@A@@AA@@@ACCAAAA@@@AA@AA@CAA@@AC@@AACA@@A@AC@@AC@@AAB@@AB@@A@A@A@@@A@A@ABA@A@@CC@@AA@AAA@AAA@@@@AAA@@@AA@AA@@@GIAA@A@C@AAC@A@@AA@@@@AA@@@AA@@A@@AAAAAA@EAEA@@@BC@ABA@A@CBE@A@ABCBABA@@BA@@AA@AA@AACA@@A@ABAAA@A@AAAA@@@CA@AC@@A@A@@AA@@ACACCA@@A@@@@AA@@@A@@@A@ACAAA@@@CA@@@A@@@@@@A@A@C@AA@@@@@A@A@@@A@@A@@BCAA@AAA@AAAA@AA@@ACAA@@@@@A@@BA@@AA@@AAAA@@@A@@@AA@@ABA@@AA@AB@AC@@AAACA@@@CBA@@@CBA@@@A@@B@@@@A@A@@@A@A@ABA@@BA@@@AB@@A@@@A@@B@@@@A@A@@@@@ABA@@A@@A@@@A@A@@@@@A@@@@@@@BB@@A@A@@@AB@@@@@@AA@@A@@@ABABAA@@AE@A@AAC@AACAEAE@@@E@ABCA@@@A@@BA@A@@@@BAB@@A@@@@AA@@A@@BA@@@@@@@@AA@A@AA@@@@@A@@@BB@BA@@BA@A@A@A@@AA@@A@@@AA@AA@@@A@@AAA@@A@@A@@A@@AAAAA@C@AACCAA@@A@@@E@@@C@@@CB@@AB@@CBGD@@CBADA@CDA@ABC@@@CDABABADA@@DA@@DABAB@BA@ABA@@BAB@@C@@@E@AA@@A@@A@A@@@AAC@A@AA@@CA@@A@@@@DA@@@A@ABI@ABA@A@@@A@A@@ACAC@AA@AA@@@A@C@AA@"],encodeOffsets:[[110804,33573]]}},{type:"Feature",id:"610902",properties:{name:"汉滨区",cp:[109.026836,32.695172],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BA@@@@@@@@@A@@@@@@A@@AB@@@@AAA@A@@AA@@A@@@@@@AAA@@@@@@A@BA@@@@@@@@@A@A@@AA@A@@@A@@@AA@@@@A@@AAC@@@@@A@AB@@AAC@@@A@A@E@C@@@C@CBC@@@AB@@@BA@A@@@C@@@@@@@@AAA@@AA@@@@BC@@@A@A@@B@@@@ADB@A@A@@@@DA@A@@BAB@@@@@B@BADAHCB@@@@A@A@A@AAA@A@E@ABA@AAA@@AA@@@A@@@AB@@A@ABA@@A@@C@@ACAA@A@C@CAA@A@A@@BABADC@A@@@@AC@@@A@A@A@AAA@@@A@@@@AA@@AA@@@CAA@@@A@C@ACA@@@ACG@A@ABAB@@A@@@A@CB@@A@C@@@A@@@@@C@@A@A@AAAA@AAAA@CA@@GAAAC@AA@@@@C@C@A@@@C@@@ABABC@@BA@@BAB@BC@AB@@@@@BABCBABA@@@@B@BB@@B@B@B@BAB@B@B@@@BAD@D@@A@@@A@@@A@@@CBA@ABCBABABA@CDC@C@EFA@@@ABA@A@ABABABA@A@@@@@CDABA@@BB@@DAD@@@DADABBB@DA@@@CBABAB@BCBABCBCBABAB@@@@BBB@AD@B@@@@ABAB@@@B@B@@@BAB@@A@CB@@ABEBA@@B@BA@@@AB@@@B@@D@B@D@B@@B@@@@@B@BAB@B@@@BA@AB@@@BBBCBEB@B@@@@@D@DAB@@A@AB@@@@BB@@@BAB@D@@DHABA@AB@B@BA@A@C@A@E@CB@@AB@@ABFDBB@B@B@DBBDB@@@@AD@@@BDBBD@@@B@B@B@B@D@@BB@@B@D@BBB@BB@@@B@@@BA@@BC@A@E@CBA@AB@B@@BB@B@@A@@@ABA@A@AB@@A@@B@BB@@@BBB@@@B@B@B@@@B@@B@@AB@B@BA@A@@@A@@@CCAA@@C@A@C@ABABCDA@A@A@@@A@A@A@C@@ACCAAAAA@@A@@@C@A@@@@@A@@CBA@@BA@@@ABA@AAA@A@@@@@A@ABE@@@AB@@A@A@C@EAA@@@AA@@AA@@@A@@AAAA@A@@BAACAAAAAA@@C@A@A@A@@A@@@@A@ABA@@@I@A@C@AAC@C@CBEBA@A@@@C@A@CB@@ABA@@@@B@B@@@B@B@@@B@BCB@@A@AB@AA@ABA@A@C@@@@A@@CAAAA@@AA@BA@AA@@A@@@@@AA@A@A@AAA@AA@@A@CBA@AB@@A@@AA@@@@@AB@@C@@@AAEA@@AA@@@CAAAA@@C@A@@@AA@@AAA@@ABA@@@AB@@A@@@AAA@A@A@@@A@C@A@@@A@A@AB@BA@A@@@AA@@AAA@A@AAAAC@A@@@AAA@CAA@AA@ABC@@AABA@@@A@ABGBA@@@A@@B@@ABAB@@ABABAB@B@@ABABA@A@@BB@AD@D@B@B@B@@@B@BA@@B@B@@@BCB@B@D@B@@AB@@@@GA@@A@@B@@AB@@AB@@A@A@@BABABA@A@@@C@@@AB@BABAB@@C@A@ADADA@CF@@AACE@C@A@ADG@A@A@@@@AA@@CDABAB@DA@@DABBB@BAB@@@BBB@B@@AB@@@B@@@B@@@B@D@B@B@BBD@BAB@@AB@@@BB@@B@@@BA@@B@@ABA@@BAB@B@DA@@BA@@@EBC@A@@BB@@@@@BB@BBFBBBDBBBB@@@@@B@@@B@@@DB@A@@@CBA@@@AAAAA@A@@@A@@@@@AA@@A@AAACAAAAC@AA@AA@@@AB@BBBAD@DB@BB@BDD@@A@@@CB@BA@@@CBA@AB@@ABA@@@A@A@@@@@AAA@A@@@@AA@@@AAA@@A@ACC@@@@@AAA@@A@@AAA@AA@AA@@CBA@A@CAA@EB@AC@@@A@A@@BA@@@AB@D@B@B@B@B@@BB@@@B@BBB@B@BB@@D@@@B@@BBBB@B@@@B@@B@@B@@@@BB@@BBBB@BB@A@BB@BB@BBB@B@BBBBB@@B@@AB@B@@BB@@@BB@BB@@@@@@CBA@ABABAB@B@@CB@@@B@@B@@BB@B@B@@@B@@ABA@C@AB@BAB@@@@AB@@ABAB@@A@@@@B@@B@@BDBBDDBB@B@B@BA@@@A@@AA@@B@@@BA@@@AA@@A@@@@@@B@@BBBD@B@B@BA@@@CB@@@@AB@@@DA@@@@BA@@@@@AB@@@B@@@@@@BB@@B@@@@@@@B@@@@B@@BA@B@@BB@@@@@@BB@@@@@@B@D@BAD@BB@@@@DAB@B@B@B@@@B@@@BBBB@BB@@B@B@@@B@B@@DF@BDB@B@B@@A@C@CBE@C@A@A@C@@@@@ACAC@@@ABA@@A@@A@C@@AAAACA@@A@@@@@AB@B@@@@BD@@@@A@EB@@AB@B@@CAC@@AA@ABAB@@@@ABA@CBABCBCB@@@BAD@BAB@@@@AAA@@@@@AB@@B@@@@@A@@@@BA@CB@@AB@@@B@@A@ABA@A@A@C@@BA@A@A@@@A@A@@B@B@@BB@B@B@B@B@@BB@@@BA@@B@B@@A@@BA@@@@B@@@BBBA@@@@B@BB@BAB@D@@A@@@@BA@@FC@@@A@@@ADA@@BA@BB@@BBBB@BDB@@BBB@@B@B@B@BDDB@@B@@D@F@B@D@B@BAD@BAB@BAB@@CF@BAB@@C@ABAB@@A@A@@@A@@A@A@@@A@@AA@AA@A@EA@@@B@@AB@@AB@B@BA@ADA@@@CBA@C@@@A@@@C@@AA@A@@@ABCB@BA@ADA@A@A@G@@@@AAA@@AAA@C@@@@@CB@B@@C@@@C@@@AAA@@AAA@AAA@@A@A@@@CBA@AB@@BB@B@BB@@@B@BBFAB@@B@BAF@@AAABCBC@CDAA@AA@@@@A@AA@@@AAAAC@AA@@C@@BA@ABA@@@@AA@@@@C@@@@C@E@A@ABCBABCBABAB@BA@A@@BA@@BABABABA@C@ABA@C@@@CBABABCBAB@@ABG@CBC@@B@@@B@B@B@B@BAD@@@BA@@@A@@@@@@@@B@B@@AA@@AB@@@@A@A@@BADA@@BADABAB@D@B@@@@DBDB@BB@BDBB@B@@AB@B@@@B@@AB@@@@@B@@@BCBAB@@@B@@@@@BB@DBF@BB@@BBBB@@DD@BB@BD@BB@BFBBBB@B@B@BBDBB@@BBBBB@BBB@@@BBBB@BB@@@B@BB@@DB@@B@@BBB@BB@BB@@@@B@@@BD@@B@BB@@BD@@BBBADADABADA@AB@B@BAB@BA@@@@BC@@BC@@@A@A@@@AB@DABA@AD@BABABAB@@@B@@@B@B@@@D@B@D@F@D@BBDBD@D@@AB@BABAB@B@B@LABB@@D@BBDBBBB@DBB@BB@@@BBB@BB@B@JBF@DAB@B@@@@BDB@@B@DA@@@@BBDB@@DBBBB@B@B@BBDBDBFDFDB@B@B@D@DAF@@@@@B@@D@@B@F@BBB@@BB@@@B@B@BADE@@D@BAB@@@@B@@BDBB@@B@BBF@DBB@B@@B@@@D@@@@@B@BA@AD@@@D@B@B@B@@@B@@@DAB@B@B@B@B@@@D@BBD@@@@B@@B@@@@@B@@BB@@@BBD@D@@@B@B@B@@@BABAHBF@@@B@@D@@BB@BBB@@DB@BB@@@BBDBD@DBB@BB@@B@@AB@BCBADAB@B@B@@BB@B@@@B@B@B@B@BBB@BB@@BBD@@BB@@@B@B@B@@@D@@AD@@@BBB@B@B@DB@@B@@BBDB@@BB@B@D@BAB@B@B@@BDB@BB@BABAB@D@@BBAB@B@@@D@@ABAB@BA@CB@DABAB@B@BA@C@A@A@ABA@C@C@@@A@@@ABA@AD@@A@@@GBA@@@AB@B@BA@@@A@A@A@A@@@@@CD@@@BA@@BC@@@A@ABA@@B@@A@CCA@A@EB@@CAA@AA@B@@AB@BAB@BA@C@CBC@@@A@ABCACBA@@@AB@@ABAB@B@@CDAD@BA@@B@B@@A@A@CBC@EDA@@@AA@@@A@AAA@BA@@@@@AB@B@BAD@BBB@@ABAB@BABA@CB@BAB@@BD@BBB@@@B@BB@B@B@BB@BBB@@@D@BB@DB@BB@@B@@@BB@@B@@@B@BB@@@BB@@@@BB@F@@B@BB@BB@@BA@@BAD@D@BAB@DABABABA@@@BB@BB@BBBBBBB@DB@BB@D@B@BBB@@@B@@@B@DBBB@B@@BBBB@@@BBBBB@@FBB@@BB@DD@@BBBBD@DB@@BB@@ABAD@@@D@@@@@@B@@@D@DA@@B@B@@BAD@BBBBB@@@B@@@@AB@D@@CD@D@@A@@BAB@@@BBBB@BB@@BBB@BB@@BBBB@@@BAB@@BBAB@@CBAB@@@@@B@@@DAB@BABABAB@@@@BB@BB@@B@@ABB@@@@BB@@B@@@B@@BF@@BDB@ADBB@@@@B@@BB@@@@@@B@DAB@B@B@@@FBB@@BB@BFDBBB@@@FDB@@AB@B@DBB@BAB@@@BB@BB@B@B@B@B@@@B@@@BD@@@BA@@B@BA@@B@@AD@@@FBD@D@B@BAB@BBD@@@D@B@B@B@B@@@B@@@B@@BBB@@@FDB@BAB@DBB@@@B@@AB@BBB@D@BD@B@B@B@@B@@B@D@@@DBB@@DBD@BB@@BBBB@BBB@B@BHD@@BBD@BBBBBBBBB@DB@@BBDDB@@B@@@BABABA@ABAFAB@@A@@@EAA@A@C@E@ABA@CB@@A@A@C@@@A@A@ABA@I@@@C@@@@AA@CBABA@A@A@@@CAA@AAA@C@ABA@EB@BA@AB@@A@A@A@GBC@ABA@@@A@AAA@@@A@A@A@C@A@A@CBABA@C@ABA@A@@@A@G@ABC@ABA@@BA@A@ABABA@ABA@@BC@C@A@A@ABED@@@B@@@BAB@BAB@B@@@BA@@D@@@BA@@BABA@@BA@AB@@AD@@A@A@ABABA@AB@@ABA@ABABCB@BA@A@CBC@A@CB@@ABCF@@@@@B@B@@C@@@A@A@@@CB@@@@@D@B@BBDB@DBDBBB@@B@@@B@B@B@DBDBB@BBF@@@B@@@@BBBBD@BBB@BBD@B@@@@@D@BBDBB@@@DAB@B@@@@@D@@CBBB@B@@ABA@@@@BBBBB@BBBB@BBDB@@B@BAB@B@BD@@@@@BBAD@@@BBB@@AB@B@@BBBB@BFBB@@B@@@D@BAB@F@D@B@DAB@B@@@BD@BBB@BDB@BBBB@@@@B@@@B@B@@AB@@BDB@B@D@F@@@@B@B@@DB@@@D@@ABAB@BBBBB@B@@@@@BB@B@D@B@B@BBB@@@BB@B@@@B@BB@@@@B@@@B@@B@@@BAD@B@B@B@B@@@B@B@@ABBBAB@B@B@B@B@@@BAB@B@DB@@B@DAB@B@@@B@D@B@DA@@DADABABA@AB@DABA@ABBB@@@B@B@B@D@B@DB@@@@B@FD@@BB@B@D@BBBDBBB@@B@D@@@@BB@@B@@BB@BBB@@B@B@B@DAB@BAB@B@@@BA@ABAB@BABADABCBAB@B@@@B@BAFABADA@@B@@@B@@AB@B@BAB@B@B@@ABAB@@A@AB@B@DCB@B@BA@@@@BA@ADA@@@AHAB@DAB@D@@@@B@@B@@@F@DA@@B@BAB@@AD@BA@@@A@@@A@@AAB@BAB@@@BA@C@CBA@ABA@A@A@@B@BAB@B@B@@A@@B@B@DABA@@@A@@B@@AB@BA@@BA@@@A@@@AAA@C@AAAB@@ABA@ADA@@B@B@DA@@BABA@@B@DABAB@@A@AB@B@@AB@B@B@B@B@B@B@@@@C@@BABABA@C@ABA@A@@ACAAB@@@FBB@@@BA@@BA@@B@B@BAD@B@BAB@BC@@@@@ABADAB@@A@@AAAAB@@@BAB@BAB@B@B@B@@A@A@@@A@@@ABA@@DAB@BA@ADA@ABA@AB@@@BA@@BA@A@C@A@A@AA@@A@AA@@@@@B@DAFBD@@@BAB@@A@A@@BA@CB@@A@A@@@@DAB@@@B@B@B@BABCBABC@@BB@@BB@@B@B@DBFABBB@B@BB@@@@BABA@@F@@@B@@@@ABA@@DIBA@ABABC@A@CB@B@BAD@@@B@@@@AAC@ABA@@@A@@AAAC@A@@B@BA@@BB@BDBBBD@B@B@@@@BBB@@@@@@BA@@B@@@BAB@@@BA@@@AB@B@B@BA@BD@B@B@B@BBDBBB@@@BA@@BBB@@@BBB@@@@@@B@@@B@D@B@@@D@@@B@@@@BB@BBB@B@B@@@@@@BBD@@AB@BB@@BBB@BAB@B@@@B@@BD@@@BB@@@B@DABA@AFC@@@A@@BA@A@@D@@@@ABA@ABABABABA@@@@@A@A@@@AAA@@AC@A@A@@@@B@@A@@@A@A@@@@@C@@D@@A@@@@@@A@@AB@@A@@AC@A@@BABCBA@ABA@@AC@A@A@ABC@A@@@A@CB@@AB@BCB@@@CCACECCAA@@@@ABA@@BA@@BADABAB@@ABA@@AAAAAG@AB@B@BA@@@A@AB@@ABA@AAC@@@@BA@@@ACC@A@@@@BA@@BABB@@@@BA@A@@AAB@DA@@@A@@BAB@B@DA@AB@AA@@A@@A@@@A@@@C@@@AB@@ABA@ABA@@B@@@@@AA@AAAA@@@AA@AEC@@@@BABA@@DAFABABAB@@AHABAB@B@D@BAB@BADABCBAB@B@BAB@@@@@A@A@A@AAA@ABC@GAA@AAC@AAA@@@A@EAAAA@@AE@@@A@@AA@@AA@@A@A@@@@@@B@@@B@DAB@B@B@D@@@@@BA@@BA@@BAD@BABABA@ABAD@@A@@BA@A@@BAB@D@@@D@@@B@@@@A@@BE@A@A@@BCDABADCB@BAB@B@BA@AB@@ABC@A@@@@B@BA@@AAAC@ABA@A@AA@@A@@@@@@BABA@A@A@AAE@@@@D@@@B@B@@@BA@@BAF@BAB@@AB@@AA@@ADA@@B@B@B@B@BA@@@@@ABABA@AD@B@@A@AB@@@BA@@F@@@B@BABA@@@@DABBB@BA@@DABAB@@A@@@AB@BAB@BAB@BAD@BA@@B@B@@AB@@A@@@A@@@AA@AA@A@AACAA@A@A@@@AB@@@BAD@B@BABA@@@@@A@@AA@ABA@@BA@@@@B@@@B@BBB@BAB@@ABA@@@ABA@AAAAC@AAA@A@@@ABA@@ACAAA@@@AA@@@@@E@A@AB@DAB@BAB@@@BA@A@@@E@@B@BAB@@@@A@CBE@A@AB@@@B@@@D@D@B@BAB@@AD@B@@A@@@CBA@@D@B@BA@A@@B@@@@@BBHDB@DD@@B@B@B@@@BA@@@ABA@@BA@@@ADBFABBB@B@B@@B@@B@@@FAB@@@@AB@@ABA@A@@@AB@@@BAB@@@@A@@B@@AB@@AB@B@DADAB@@@BCB@B@@AB@@ABA@@DBBA@@DA@@@A@@BA@@B@BBDB@@B@BAB@DCDA@@B@@@B@@CDA@A@A@A@@@A@@B@B@@BBB@@B@B@B@D@B@@@@@@@AA@A@A@AA@@A@A@@@A@@@@B@B@BB@@BDDD@@B@@@B@BABABA@AA@@CB@BC@ABA@@@AB@D@@@B@@@@A@A@@@A@@FC@@B@@@@A@@AC@@@AA@@AA@@A@@CAA@C@@AA@AA@@C@@A@@@AAAAAAAAAA@GAE@CAC@A@A@A@@A@@@CA@@@@AF@BAD@BAB@@@BAB@@AB@@@@A@@@ABA@@@ABA@A@@@AAAA@AAGC@@A@A@@@@BA@G@A@ABA@@@AB@@ABA@@@A@@A@AA@@A@A@@@AB@BAB@B@@A@@@A@A@CB@@ABA@A@@AA@@@A@@BA@@@@B@B@@@@A@@BC@A@@B@@@@BBA@@@@CC@@@AAA@A@@A@@BAA@B@@A@@@A@@@@@@A@@BA@@B@A@@@@A@@@@FAFABAHCDAB@BAB@@@BA@@D@D@D@F@@@FAB@D@D@HADA@@BAB@DABAB@DABAB@@@@@@AAA@AA@@@@ABC@@@A@@@@@@BBB@@@B@B@@AA@@AA@@AAAA@AA@A@A@A@EAAA@@@AAIDAAAA@@@A@@@A@A@@AAAA@@@AB@@A@@@C@A@ABA@ABABA@AB@@AA@A@C@A@A@A@@@@@@AAA@A@A@A@@@C@AAA@A@A@A@@AA@@@@@AB@B@@AB@@@BABA@@DA@@D@@A@@@@@CBA@@BA@@BADAB@BB@@@@BA@@@@@@@A@@A@@A@@@@@@D@B@DAB@BAD@@@@A@AAA@CAABC@@@@B@B@@A@@@A@A@@B@@A@@@AD@@@@BB@@@@@@@@A@CA@@@@ADA@@@@@ABA@@@A@@@A@@@@@AA@@A@@B@@@@@DB@@DB@@BADBB@@BB@BB@B@BB@@@B@D@B@@BB@BBDB@@BA@A@CBC@A@A@@B@@A@@@A@@@@F@@@B@B@@@@A@@@AB@@@B@B@B@@A@@DEB@DA@@B@@@BBA@@@@@BD@@@@BABA@ABAB@@@@A@@B@@@@ABA@@BAB@D@BAB@@@@@B@B@B@@@B@@@B@@@B@B@B@@@@A@ABABAB@@@@A@@@A@@CACAC@@@@A@@FA@@@@@A@@@@A@AA@@@@@A@ABABA@@@AA@@AB@B@B@BBBB@@@@@BD@B@@@BA@@B@B@B@DAB@DADCBAB@BABAFAB@@@DCB@@@D@D@D@D@DBDBDB@@B@@AB@@A@ABAB@B@B@B@@AB@BAB@B@@@B@BA@ADAA@A@@AB@@A@AB@B@B@@A@ABAB@B@@@B@DAD@@@@A@@@A@@@A@A@CAA@ACA@@@A@@@@B@B@B@D@BA@@B@B@HB@@B@F@D@@@@A@@AAAA@@@@BC@@@C@AAA@C@@BA@A@A@A@C@C@A@A@ABCB@@@@@BA@AAA@@@A@AAA@@AA@AAA@AAA@@C@A@ABABA@@@A@A@ABA@A@CA@@C@A@@@AB@@@@AAA@AA@@A@A@AACAC@CAA@@@AA@@@@A@ABA@@@CA@A@@A@@B@B@BA@@BCFADA@@@A@A@@@@@AB@B@@AH@@AB@B@@ABAB@BA@@@@B@B@@ABA@@@@BAAABCBA@A@@BABA@@@@BABABABA@@AA@C@A@A@C@A@AB@@@B@@@BA@A@GAA@C@A@EAA@A@AAC@AAA@@@AA@A@@EAAAA@AAA@@@@CA@@@AA@@A@AA@@@@A@@@ABA@@@ABAA@@@BAB@@@B@D@@@@CAA@@AC@A@@@A@@@A@ABA@C@A@A@@@A@A@A@A@@@@B@@AF@@B@@@BB@@@@ABA@@@A@@AA@@@@AAA@@AAA@@AAAA@AA@A@@CA@@AAC@@@A@@B@@BB@B@@CD@@AB@@A@C@@AA@AAC@CAAAA@@@@@CCAAA@@A@@A@CAA@A@A@@@EB@@@@ABAB@B@ACA@@A@A@A@A@@@@BCHCFADAD@B@@@@@DADA@AB@B@B@@@D@BAD@BA@AB@@@B@D@D@BABA@ABA@A@ABE@C@A@EAA@@A@GAA@A@@BC@A@A@AA@A@@C@@AAAA@@@E@A@@@ABC@A@@@C@@CBA@A@GAEAA@@@@C@AA@@AA@A@A@A@A@@B@B@B@@C@A@@@AB@@A@CAAAAA@A@@@A@C@@@IAA@AA@@@AB@@A@A@A@ABAB@@C@@B@@AFABABABA@@BCB@@C@EBA@ABA@@@CAC@A@A@C@AB@@A@A@AAA@AAA@CCAA@A@AAA@@ACCA@A@@@A@A@A@A@ADA@@@C@A@A@AAC@A@@BA@@B@@AB@B@@@@@@A@A@@B@BABA@A@@A@AA@@AABC@@A@AAAAE@AA@@AA@@@A@A@@AGACAAAECA@AAAAC@C@A@@@C@A@@BAB@@A@A@@B@B@@@@A@A@A@@BAB@@A@A@@@AA@@@A@A@ABA@@@A@@@AA@A@AA@@@A@A@@@@A@A@@@@A@AA@@AA@@A@@@AAAA@@A@EA@@CAAA@@AA@E@CAA@@@AA@@B@BABCBA@AB@D@BB@@B@@@D@@@B@@@B@B@@CDA@@B@@@B@B@@A@ABABA@@BA@@@@@AAA@@@@@@EA@@AAA@@AB@@CBAB@@A@A@A@AAA@@@AA@@B@@@@AB@@@@@B@@@B@AAAA@@B@@@B@@@@@@AA@@@@@@A@@@@@@@@@AA@@AAAAA@AA@AAAAAA@@A@AA@@A@@@@B@@ABABA@@B@BAD@@A@@@@@@AAAAA@A@@G@AA@A@A@@@A@@@@A@A@@A@BA@@@@@@AAAAACAAA@@@@@AB@@@@@@@@@AA@@@@AA@@@@AB@@B@@B@@ABA@C@@A@@@@A@@@@@@AAA@@B@@@@@@@@A@@A@A@@@@@A@@A@@@@@@A@A@@AA@@A@@B@@A@@@AA@@A@@B@@@B@@@@A@@@@BAB@@@@@@A@@@@@@@A@@@@BA@@@AB@@@BBB@@@@A@@@C@AA@@@@@BAB@@@@@AA@@@@B@B@@@@A@@@@AA@@@@@@@@A@@@A@@@@@@@BA@@A@A@@A@@@@@@@A@@A@@AA@@A@@@@B@@@AA@@@@@@AA"],encodeOffsets:[[111636,33256]]}},{type:"Feature",id:"610923",properties:{name:"宁陕县",cp:[108.314283,33.310527],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CCA@@@C@CAA@C@@AA@@@@A@A@A@@@A@AAA@@A@ABC@A@A@A@A@E@@@A@AAAA@@AAAAAAAAA@AA@@@@@@C@A@@@@@A@@@A@C@@@@@C@@BA@C@ABAAA@ABA@A@@@@D@BABA@ABA@A@A@CBCBA@EBE@ABCB@BA@AD@BA@ABA@AB@@@D@@CBA@C@A@@BAB@@A@C@A@A@C@I@A@ABA@CD@@@BCD@@AB@BBADADA@@B@J@DBB@DDB@DD@@@B@@@BB@@B@@B@BA@@D@@@@@BA@@BA@@@@B@@BB@@@AB@@ADA@ABABAB@B@@AB@@ABA@@@@@@B@@@DBD@@DB@@@@A@AB@BA@A@ABAB@@@B@@CB@@AB@BAB@B@B@@BB@BA@@B@@@@@B@@@BBDAB@@BDDFBD@BBB@B@B@@@B@BABA@@@@@A@CBC@EBE@CBABAB@@@B@@CB@BEB@@@B@@@@@B@@A@@@CBA@AB@@@@E@ABA@A@CBG@A@A@A@@BA@@B@@ABAB@BAB@BAD@B@@@BBB@B@B@@D@@@BBB@BB@@D@B@B@@@@BABCDC@A@CB@@A@AB@BA@A@@@A@@@ABA@AAABAAABCA@@CB@B@@@@EDA@ABABC@A@@@A@A@@@@@AA@@A@@AAAAAC@@@AAAACA@CA@A@AA@AA@A@AAA@AAA@AB@@@@AACAA@A@@AA@@@@CA@@@CA@@AC@@A@@@ABA@@BA@A@@BA@EDA@AB@@ADABA@@@C@EBC@A@ABC@@@A@@BA@@@AAA@@@A@AB@@@@A@@@@@AA@@@BA@@@C@@@A@AAAAC@E@@BABAB@@A@@@AB@BC@A@CB@@A@A@A@AB@@@@AB@@@B@D@B@@@@@@AAA@C@@@@@ABABCBCBABA@@@ABA@C@@@@BABA@@@A@@@AB@@A@A@A@@BE@AAC@AAA@AAE@@@ABA@AAA@C@A@ABA@A@A@EBA@A@A@AA@@CB@@AAA@@BABA@@BA@C@@BABADABC@A@A@@@AB@@@D@B@@@B@@A@@AA@B@@AA@@@AB@@A@@AA@A@AB@@ABA@E@C@@@@@ABABADAB@@@B@@@B@@@@B@BB@@BB@BBB@B@BBB@@@B@B@B@@BB@DB@BBBDBFDBBDB@D@BB@@B@@A@A@@@ADA@AB@B@@@BB@@BB@@AD@@@B@DA@@B@F@B@@AB@@AFAF@B@B@@@B@@@@ADAB@@B@@BB@AB@@@BA@@@AAAA@@@A@CA@@AA@AB@@ABAB@@@B@B@@@BAB@@@@@@B@@@B@@@DA@@@@@@B@@BBD@D@@@BC@@BABA@@BEB@BEDADAB@DA@@BAB@@BBBB@@@@EB@B@BA@@@AAA@AEA@@AAAC@@AA@AA@C@@A@@@CAA@CBAAC@@@A@@AA@A@A@A@C@C@A@AA@ABAA@@A@@AA@A@AAA@AA@AA@AAC@@@ACAAAA@CAC@A@C@@@CBA@C@CBCBA@A@A@CAA@@@@@A@ABEBCBAAA@@@@B@B@@A@@@A@A@@@ABA@C@C@A@E@@@ABC@@@A@C@CAA@CAA@A@@@CA@@AA@@A@A@A@E@C@C@@@A@@@E@AAAAACAAAA@@CCGCAAA@@@I@@@@B@BAD@@@D@@BBBB@BBB@@@@@@GAA@@@@@@@BD@@@B@BAB@FA@AD@@AB@@@B@@@@C@A@CACAA@A@AA@A@@ACB@@@@A@C@C@CBA@CBAB@BAB@@A@@@A@@AA@@A@A@@@A@CBEBA@A@BB@BA@@BA@@@AA@@C@A@@@@@ABB@@BCL@BABAB@DAJBBBD@DBB@D@@B@@BB@@B@BB@@BHDBB@@BB@@B@@@@@DBB@@ABB@@B@@B@B@@@DA@@DCBAB@@@BDBB@B@@@@A@@B@@@BBBBBBB@BBB@@BB@B@BA@BB@B@@@BBB@B@@@B@@B@B@@@BDD@BBB@BHHBDB@@B@BB@B@BB@BB@@@B@@@D@BADBB@B@B@@@@@@D@BDB@BB@@@BB@@B@BBBB@@@BAD@B@DBB@B@F@@@BABCDA@@@A@@@A@A@A@CB@@A@@B@FA@@BC@A@@@@BB@B@F@@@DBBBB@BBFBB@BD@@DDB@B@F@@@B@DFDDDB@@BB@@JCB@BAD@@@@@BA@@@@@BB@@@@AB@@ABA@@@C@A@@@@BA@@BB@@B@DCDAB@BCBA@@@@@BBBFD@@@B@@@BAB@B@@BBDB@B@@@@@B@@CBAB@@@FBB@@@BB@@@B@@B@BB@BBBBDBBDDDB@@BB@BA@@BBBB@@BB@BAB@@@BBD@@B@@@B@@@B@@@BBDD@B@@@DABAD@@AB@@A@@BA@AB@B@B@DA@@D@@ABE@@BA@@@@F@F@D@BABC@@@AB@@@@A@A@C@A@@@CDC@@B@@@B@@@@@B@BA@@@A@A@A@@@A@A@@@@BA@@@ABA@AB@@C@AB@@A@@BC@@@A@@DA@@B@@ABA@@B@@@BAAA@@@@AA@AA@AA@AA@A@@@@@AAA@AABA@A@AB@@@@CBADABABCD@B@@ABBB@F@@@BA@@@ABA@@@@BAB@@@BA@CB@@@@@B@BA@CAEBA@A@A@@BA@@@A@AA@@@@AAAA@@@@CBABC@@BA@@BC@GD@@C@AB@B@@ABA@CBC@A@AB@@@B@D@B@B@BBB@BB@@@@B@B@BAB@D@@A@C@AB@@BB@B@BDBB@@B@@@@BB@@BB@@@B@BDDBB@@@BBD@@A@A@ABAB@DAB@B@B@@B@@B@@@DAD@B@@BB@DBB@@@BAB@B@@AB@@@B@BABABC@@@ABBB@B@@@BA@@B@B@B@B@@@BBBBBAB@@@BAB@D@BB@@B@@BBAB@B@@BDB@BB@@FFBB@B@BB@@@B@@@BB@B@@@B@@CB@BA@A@ABCDAB@B@@@B@@@BA@@@@B@@BB@@@B@BA@@B@B@B@@@BA@@@ABA@AB@BAB@@AB@@@@DB@B@B@@ABAB@@@B@@@D@@AB@BAD@B@@@B@@@@@@B@B@BBB@B@B@B@@@AD@DBB@@@B@B@BB@@B@BABAB@@@BB@BB@B@@BB@@@@AB@D@@@B@@@B@B@DA@@BABA@@BA@@B@B@@@@AB@@BB@DABAD@@@F@BAB@@@DADAD@BABA@@BA@@B@@@BAD@B@D@B@B@@BBBBBBBB@@@B@@BB@@BB@BBBBBBD@@DD@@@@ABADA@@BAB@BBBAB@BB@B@HB@@B@@BBD@B@@BB@@DDDBBBBBFD@@BBD@B@DBB@@@BB@@B@B@D@D@@@FAB@@@DAF@D@B@B@BABAFCDCBAD@B@BA@@BABABAB@BAB@B@DBBB@@@BBB@@BBBB@B@@BBB@B@@@B@B@BBB@BBBB@@@@BABADABAB@BAF@@@BAB@DA@ADA@@DC@@@@B@B@B@DBBB@BD@D@@@DABAB@@@DADBB@BBB@B@B@BBB@BAB@BA@@B@BAB@B@BBDB@@DBB@@@BB@@BB@@@@@B@D@@@BBBBB@BBB@B@BD@B@DCB@@@JCB@B@@@BBB@@@BAB@BA@@B@B@BD@@B@B@@BB@@B@@@BBB@D@@@@B@D@B@@@DAFAB@B@BBBAB@D@@@B@D@FBD@@B@B@@@BDDBDDDBBB@BB@@@BB@@B@@@BB@B@BB@@BDBB@BBBB@DB@@B@@B@@@D@BBD@@@@BBD@B@B@B@BBB@BBB@D@BBB@@BD@BDDBD@@@@B@BB@@BDBBB@@D@D@B@D@B@BAFAHAF@DAD@B@@@D@B@@@B@@B@@@@FFB@B@BBF@@BB@DBBDB@BBB@BBB@@B@DBB@@@B@@DDBBB@@@BBB@BBD@@BF@B@F@@@@@@B@@@FAD@@@BBBBB@@@@B@B@B@DAB@D@BAF@B@BBBADBD@B@D@BBF@BCBAB@@@@A@CBA@@@A@CBABA@ABAB@B@F@DAD@DA@@B@B@B@BA@AB@AABA@A@AB@BABCD@BADC@@BCBA@@@@D@J@B@@@@@BB@B@B@@BF@@@BBB@@B@BBBAB@B@@@BBB@BBB@DD@@D@@@FB@@B@BBBBB@BBB@BBB@BB@@D@B@@@B@BB@@BB@B@@@@BBBBBBBB@@DF@@D@B@@@BB@BB@DB@BD@B@BB@B@@DBBB@BB@B@BBB@DAF@BABBD@BB@@B@@@@B@@D@BB@BB@@@BBB@D@@@B@B@@@BABA@@BADAB@B@DA@@BAB@BAB@B@@@BABA@@BBB@D@B@BADA@@B@D@BAB@@@B@B@DBB@B@@@DCB@BAB@@A@@BBBAJB@@BADAB@DAD@B@D@@@@@AA@C@ABC@A@AB@BA@@@A@@BAB@D@@@DA@A@AB@@ABA@@@A@ADA@A@@@A@@@@BCDA@AB@@@B@BBB@@@B@DAD@D@D@@@@@BA@@@@B@BBBB@@HAD@B@B@B@@@@@@BB@@@@B@@B@B@BB@@@@@B@@@@@D@@BB@BA@@BADA@@BA@@B@B@BAB@@@@@@B@D@@BB@BD@@BBBBDBBB@DBB@@B@B@DBDBBB@@@BBB@@@BA@@BBB@@B@@BBB@@@BAB@B@BBB@BB@@A@@BB@@@@@D@@@B@B@@D@BBD@B@B@BBBB@B@@@D@@ABAF@D@B@@@@@DBBBB@B@@H@DBB@D@BBB@@@B@F@BB@@DBFBB@B@@@FBDDD@@BBDDFB@@BB@BABAB@@@BCB@@@D@B@B@BAB@@@B@B@BBB@@@@@BCB@B@B@B@BAB@B@@@B@B@B@B@B@@@D@@@B@DBFBB@B@DAB@BA@@B@DBB@@B@@BAA@@AA@@A@A@A@AB@@ABCB@DAB@B@D@@@D@D@DB@@@@BBDB@BB@B@B@D@BAB@B@@D@@BBBBB@BBF@BBD@DBB@B@@BDBDBBBB@@@B@BAB@BCB@BAB@@B@@@@FB@@DB@@B@F@BA@@BA@@@C@CBC@A@@AA@AAC@@B@@AAA@A@@AAAAAC@A@A@@BA@@@CDE@ABABA@@B@DA@@@@BA@@B@HCBABA@AB@@@BABBB@@ABABAB@BA@@B@B@@@@@AB@@@D@@BDBBDBBBDDB@D@B@HABA@@@@BB@@B@BB@BAB@@B@B@B@DB@@HD@@DB@@@@@@@@@B@@@B@@B@B@BAB@BABAB@BB@@B@@BB@FDBBJBDBB@D@D@@AB@BA@A@@@AB@B@F@@@@ABA@@@@@@@AAA@@@@@A@@BABA@A@@A@@AC@AA@@@A@@@@@A@@A@A@AA@@A@@@@A@A@@@ADAB@@@@ABE@@@AAA@@@AAA@A@C@@@@@AA@@@GAAA@@DABA@@@C@@BA@@BA@A@@@AB@BBBAD@@@D@@@B@B@@@B@BAB@@@DBDB@@DA@@DB@@BA@@BAD@@@@AD@DA@@BBB@DBD@@@BA@AB@DBB@BBBB@@BBB@BBD@D@@@B@@A@@@@BAD@BAB@B@D@@@B@B@BB@@D@@AB@D@B@@@DBB@B@DBD@BAB@BB@@BBBB@@@ADC@ABABA@@B@B@@A@A@A@ABA@@@AD@@@BAB@@ABAB@BABABA@@B@B@BB@@BAB@B@B@B@BAB@@@BAB@BA@A@AA@@A@@@CBA@@@A@AB@@ABAB@BAB@BA@A@@B@@@@ADABC@A@@BA@@B@@@@A@@AC@@@A@ACA@@CA@@AA@A@A@A@AB@AA@@BA@@@A@A@@BA@AA@@@A@@EA@@AAAAC@A@A@@BA@ABA@AA@@@CCEE@@B@AA@@AC@A@@AA@@CA@@A@@@@A@@B@@C@@@A@@@A@@AAA@@@@A@@@A@A@A@@@AA@BA@@@AA@@A@AB@@A@@AA@@@@AC@A@@EC@AA@@@@@A@A@@@A@C@A@@@A@A@@@@BABCBA@@BA@@BABA@A@ABA@A@A@@BA@@@E@@@A@@B@B@@@@BB@@AB@@A@A@AB@B@BA@@BAB@B@@@@ABA@A@CB@@ABA@@@A@A@C@C@A@@@@A@@AA@@A@@AC@@@@A@A@AA@@A@A@@@AA@AA@@@@AAAA@@AAC@C@@@CA@A@@@A@@@@A@A@C@@AA@@BA@AAA@@@BA@@@AAA@@AC@CAA@AAA@A@@AC@@@AAA@A@AA@AAAAA@@@A@@@A@@@CCAC@ACA@@ACAA@@@@CB@BA@AA@@ECAAAA@@A@A@AAAAAAAA@AA@@@EB@@@BA@A@A@A@@@@@A@@@ABA@@BA@C@A@A@C@A@A@A@A@@@ABA@@BCBCB@@ABA@@AA@A@CA@@AA@@AAA@@@ACAE@A@ADC@ABAB@@A@G@@B@@A@@B@B@B@BAB@BABA@AB@DB@@B@B@B@FBB@BBD@B@@@BA@@@A@A@@@AB@BADC@AB@@ABCDCBC@@B@B@BAB@@A@AAA@AA@ACA@@AA@A@@@A@@@A@A@AB@@A@C@A@CC@@AAAA@@AAA@@AA@AACAAAAC@@@@@A@A@@AA@@BC@@@AACAA@@@AAAAAAA@@@A@A@A@@@AA@@A@A@@@@CAA@AAC@AAC@@@AACA@@AA@A@@@@@CB@@CAEAA@A@C@A@AACA@AA@A@A@@GC@@AAA@AC@@C@@@@@CA@A@@AC@AAC@C@@CAA@CAA@@@A@@@AAABAB@@ABA@@@C@CAC@C@E@@@@A@@FC@@BA@@@ADCB@@@DBB@@@@@EEC@@AC@CCA@@@C@A@AC@@@A@@AAAA@A@@A@@@A@ABA@EF@DAB@@A@@@AACC@@A@A@@@ABA@E@@@A@A@AB@@A@@BA@A@CACAA@@@@@AA@A@@@A@@A@A@C@A@AAA@@@A@@@AB@@@BA@CBABA@AB@@@B@@@BA@@BA@@BA@@@@BCBA@A@@@C@A@@@@@AD@BAD@B@B@@A@AAA@A@GBA@@@A@GA@@CC@@A@A@@@A@A@A@@@A@@DA@ABA@ABAB@@A@AA@@C@@@A@A@A@@BA@A@AB@@A@EA@@CC@@@C@A@@@A@@AAC@A@AA@@@A@@B@@AB@A@CAA@@@@A@@@C@@@C@C@AAA@@A@@A@@@C@A@E@EAC@@@ABA@@DA@@BAB@@A@ADE@ABA@A@A@@AA@C@@AA@@A@A@EA@@@@AAAAAACAAA@@A@AA@ACCACAE@@@CAC@@AAAA@A@@A@A@A@ACAACA@A@CA@@AEAA@AAA@AB@@@@AAC@@@A@AAA@@@AA@AAA@AGA@@CAAA@CCA@@A@BCAA@A@A@@@@@@@AB@@@B@DCBAB@DA@@@@DAB@BA@@@A@@DG@@BC@@@A@@AAAC@@@@@ABAB@@@@A@A@@AA@A@A@A@@@@@A@@CA@ABA@A@AAA@A@A@C@ABC@@@ABA@A@@@@D@B@B@@@@C@@BAB@@@@A@@@AEC@@@@A@A@AAA@AAAA@AAA@AA@AAAAA@@AAA@AAC@@@ABA@@A@ACA@@AAA@A@@ACC@AACA@@@AAA@C@@@A@A@A@A@A@@@AA@@@BAB@@@B@AE@@BA@A@A@@AC@A@@B@@A@A@A@A@@@C@A@AAC@A@A@@A@CA@@A@AA@A@A@ABA@A@@@@@A@A@@@A@@B@BABC@A@A@A@@AA@AA@@@BA@A@@@CAA@CBA@AB@AA@A@@@A@A@A@@@CBA@@@AAC@A@@@A@@AA@A@@AA@@AA@A@@@A@@@C@A@@BC@ABAB@D@FC@@BBB@F@@@BB@@@@B@F@F@B@@@B@@@CD@@@BC@@BB@@@@@BAFAD@B@DBFBF@D@DBB@B@B@B@DBB@BDB@B@BBHDB@DBB@B@FBB@B@BD@@DDB@@B@@@BBBADB@@@@@F@B@DAB@FAB@B@@@B@B@B@DADBD@B@DAB@@@D@@@DAB@BABAB@BCB@BA@@A@@A@A@A@AAAA@@A@@@AB@DADABAB@DEB@B@DCDA@ABB@AB@@@B@@AB@BAFC@@BABAB@@@B@@@@@@A@@@A@@@AAA@AA@CB@@A@@C@@A@C@@AAAAAAA@C@@CAA@A@AB@@A@A@A@A@A@ABAA@BA@A@@@A@A@A@A@C@AB@@A@@@@A@@@A@@A@@A@A@@@AAA@@A@AAA@A@C@A@A@@A@@@@@AAAAA@ABABA@@@C@@CA@@@A@A@@E@C@A@A@AC@@BA@@@A@A@@@A@@A@AA@ACA@AAA@AAC@@A@A@CBA@C@E@A@ABC@@@A@@@AAAEA@AA@AA@A@@BA@AA@@C@AB@A@@@@ACA@A@ABA@@@CAAAA@AA@AAAAA@A@@B@BA@@@AAADA@@@C@@@@@ABA@C@@AAAC@A@C@@@@@AAC@AAA@AACAA@A@@A@@@E@AAA@CACAA@A@A@@@A@@@AACACAA@AC@A@A@C@@@@DA@@B@B@@@D@@@@A@A@@@@DEBA@@DAB@D@DAB@B@@ADABABAB@BA@@BAB@BABAB@B@@@BC@@BAB@@AB@BA@AB@@A@@@CB@@A@@@ABA@ABA@A@@@A@@FCBAB@B@D@D@@AB@BAB@BABAB@B@@AB@BAD@BAH@AAAAAAA@@AA@C@A@AAACAA@B@@ABABA@A@@@@@AAA@@@IA@@A@@@A@@@A@@@@AA@A@C@A@ABA@@ACBA@AA@@C@@@A@C@@@A@@@AAA@ABA@@AA@@@@@A@AB@@CBA@ABCBA@A@ABA@ABAB@@@@A@A@CCA@@@ABC@CBA@A@A@AAA@@@AAAAA@@@C@A@AAE@@@@@CB@@@@C@CAA@AA@@A@CAA@A@CAA@A@A@@A@A@@AAAA@@AA@@C@@@AAA@@@AACC@@ACA@CBA@A@E@@A@@AC"],encodeOffsets:[[111133,33929]]}},{type:"Feature",id:"610927",properties:{name:"镇坪县",cp:[109.526873,31.883672],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAA@CBA@ABAAA@CA@ACE@ABEAA@@AAAAC@GAA@K@I@C@CCC@A@IAEBG@CBOBMBA@G@EAUBO@W@IBQBM@GBE@CDEBEBABABA@A@C@@@CL@D@F@BDNBH@D@DABCBABE@EBCD@@BBBB@FABAB@BC@G@MBC@ABA@AB@D@@@DABBB@@BBF@FBB@@BBFBB@@BBJ@NDHBBBB@A@AB@@ABELAH@DBH@F@B@@ABABE@GB@@KBEBA@AAA@@@GFIDEDABE@A@AAC@CAC@EDSHGDCBC@A@M@O@@@CBAB@B@@@B@BAB@B@B@B@BHLFLBF@B@@BB@@FDBB@@B@D@B@@BB@@@DA@@@@@B@@@B@@@@@B@B@@AD@B@B@@BBBB@@BBBB@@@@B@DADA@@B@@@BBFBB@@@@BB@BABAB@@@B@@BBBBB@B@@BBA@ADA@ADED@@BDBD@@ABEFEFABAB@@A@@@A@ABA@A@C@@@A@B@@@DB@@B@BBBB@@DDB@BBBBDDDFBB@@B@D@B@DA@@B@D@B@B@BBBBBBB@D@B@B@B@BADAD@FAHABAB@@@DC@AB@@A@@B@B@@B@@BB@@AB@D@B@@@BB@BB@@BB@@@B@B@@@@@@B@BAB@@@HFB@@B@@@B@@B@@BBBB@@BA@@B@@@D@@ABBBA@@@@@A@B@BBBB@@@B@@BDDD@@D@@BDF@@BB@@@B@@BD@BAB@B@@AB@@@B@@@BBB@DBBBB@B@B@BBB@B@B@B@B@@@BBABAB@BAB@@@DBBBD@B@DAB@FDB@F@@@B@BB@@FBD@B@@@FAD@B@BAB@B@@@F@B@D@@@B@DAB@B@BADA@AB@@@FAD@B@@AB@DABABA@@@@@AB@DE@A@@B@BB@B@@@@@@ABADADAD@@@B@B@@ADAH@B@BB@AB@@AB@BC@A@A@@@BBF@@@@B@@@@AD@BAB@B@@AB@B@@@@BB@@BB@B@DA@ABA@CDABAB@B@@A@@@AB@@@DAB@@CBB@B@B@B@B@@BB@@B@DBBBDB@@BBB@BBD@BAF@B@B@B@@BBA@@BA@AB@@@D@D@@BBB@B@B@BBHB@BD@@@@@@@@BAB@B@@@@@@BBB@DD@@BBDDBBB@@B@@AD@B@BB@ABA@ABADA@@@AB@B@BCDABCD@@ADCBA@ABA@@BABA@@B@@AF@BADAB@@ADADCDAB@@@BBBAFADAB@BBB@@B@BB@@BB@@@B@@ABA@@BA@A@A@@B@B@B@BAB@@AB@@ABA@@BAB@@@@BBBH@B@B@B@DAF@B@B@@ABA@@B@@@B@FBB@BD@BB@B@@A@ABADA@ABABABCD@BAF@B@@BB@BB@@BB@BB@@@B@B@BABABBD@@A@@B@@@B@B@B@B@@BBD@@@BBB@B@B@B@BBB@DBDB@@DAB@D@B@B@@@BBDB@@F@@@B@B@B@B@BBB@D@@@B@BBBB@BD@@BB@D@BAB@B@@@B@B@B@@@B@B@BB@@D@BBDDBBBDB@@D@BB@B@@@D@B@@B@@@@@BBB@B@B@@BD@BA@@B@BAB@BBB@@AB@B@@@BD@@BB@@BB@DBBBB@BBBB@DB@@BBABAB@BC@@@AD@B@DAB@@@BABABABA@@B@B@@AD@@AB@BAB@@@B@@@DB@@B@BA@@@@D@B@@@@@@DBB@@B@@@DDB@BB@BB@BBB@BBFD@BB@@@BBB@@BBBB@@@DDB@BD@@@@BAB@BABAB@@@B@D@B@@AB@B@@BB@@DB@@@B@BA@@B@@@@BB@BBD@D@B@D@B@FBH@D@BADB@@B@@BBBBBDB@@B@@AD@BA@@B@BBB@@@B@B@@@BBB@@@D@B@B@@BB@@@D@B@@@@B@B@@BBBDB@BB@@BB@@BBDB@@BB@@B@D@@BB@@B@DBBBBBB@@@B@DB@@@BBBBBAD@B@@@DB@BBBBB@BBBB@BBBBBBB@B@DD@@BB@@B@B@@@B@@ADCB@B@@@BBFBBB@@FBBJBALBLDLALCNEJMNM@KEKKUIQCO@A@@AG@I@@DMFUFGFIJKNEPSHIBGEMCUEIACEEIKKMIOGOAECQAUFSBEJOJM@@FCDAFCHEJMDEDEHGFIDIBA@E@E@K@A@CAGCKIKEGIAIAIAE@GAIAACAGCMIS@G@ADGJCFAJAHEHGDIJODQFMBEDEFMFIDIFKFKDOCGCEKDQBIAEAGAE@EGIIIMACAI@GBIBGDKAIAG@CBAB@DCLADCBABA@A@AC@ECKCCAAACEACACAC@@AAA@@@C@CBABA@A@CAC@CAAAA@E@@@E@CBEBGDABA@A@EA@@A@KEKCGACAA@A@CBA@A@A@GCGACAG@AAE@A@AC@@AAA@A@ABKDEBCBGAE@I@C@A@@@AA@C@A@AA@AA@@OBGAABA@@BABAD@@@DA@@BA@ABE@K@CAC@@AA@AACBE@C@GA"],encodeOffsets:[[112056,32482]]}},{type:"Feature",id:"610924",properties:{name:"紫阳县",cp:[108.534228,32.520246],childNum:3},geometry:{type:"MultiPolygon",
+coordinates:[["@@B@DA@@B@A@@C@@@A@@@A@@@@AAAAACAAAE@AAA@@@@A@@A@@A@A@A@CBA@@BABCBCD@@B@@BBBD@BBBBBDBBB@@@BB@@@@B@@@B@B@BBBB@@"],["@@B@BA@@BAB@DA@@B@@ADA@@B@@@CC@AAAA@@CBCAA@AA@@@A@CAAAE@A@A@GDCB@@C@@@AB@@ABB@@BBB@BB@@@BB@B@@@@DD@B@BB@BB@@B@@B@@B@B@BB@@@@B@B@@@"],["@@ABA@@BA@@BAB@@AB@BA@@B@BAB@BA@@BA@@BABA@@BA@@BA@@@AB@@ABA@ABABA@C@C@C@A@CA@@AA@@AAAA@A@@A@@AA@A@@@A@@@@AA@@AA@@A@@@A@@@A@A@@@@@AA@@@A@@@A@@@A@AA@@@@AAAAA@AA@@A@@@A@@@A@@BA@@@A@A@@@A@A@@AA@@@@A@@@A@A@AB@@A@A@@@C@A@AAA@AAA@@AAAA@@AAAAA@AAA@CA@@AAA@@AA@AAA@AAA@AAA@AA@A@A@@@A@@@A@AB@@A@@@A@A@@A@@AA@@@AA@@A@@AA@@AA@A@@@AAA@@@C@A@@@A@@@A@@BA@@@AB@@@B@@@BA@@@@BA@@BAB@B@BA@@BA@@BA@@BA@A@@@A@A@ABA@@@A@@@ABC@ABABA@@BABCB@@@B@B@BBBBBBBB@B@D@B@B@B@@@B@B@BB@@BBB@@BB@@@DBD@B@B@B@@B@@BD@B@B@@ABAB@B@@@B@BA@AB@@@B@B@@@B@@@DBB@BD@BBB@BB@@@@AB@@A@@BEFCBEHAB@@CF@@@B@@B@@@@@@@@BA@CDCDA@ABAB@@AB@BABA@C@AB@@AB@BA@ABABEB@@@@ABADA@ABEFAFA@A@ADCBABA@@B@D@@ABA@@B@@@BABAB@@ADA@@BEBABCB@BAB@B@@@BB@BBDBB@B@B@@@@BB@BBB@B@@BBBBB@@@BA@GJADADABABA@GHA@ADADED@BFBABABABABCBABGBAB@B@@@B@B@D@BCB@@CFADB@@BB@@@B@DAB@D@B@DBBBB@@BBD@@B@@@B@B@B@BAB@BA@ABA@A@A@@DADEBA@@B@@@BBB@D@B@B@B@@B@F@BADBB@@@B@DAB@BA@AB@@OJCB@B@@@B@@@B@BBD@BA@AB@@@BB@@@B@D@BB@@@@@BCFEF@BCBA@@B@B@@@BADCB@B@B@B@@BB@B@@@BAB@B@B@B@B@@AB@D@@B@BDB@B@B@@@@BB@@BD@DBB@B@B@B@@@@@D@DBB@B@FA@BD@@@B@DAFA@@BAB@BAB@FABAB@B@B@@@DBBA@@B@FCB@B@@@BABAB@FBHAD@DADAB@B@BAD@@@BD@@BBB@@@@DB@ABABCBABA@AB@@@B@@@@@@BBB@D@FAD@B@HAB@BBB@@BDBBB@@@@DBB@BBDFBBBB@BBBB@@B@@@BBB@@@BB@@BD@@@BB@BB@@B@B@B@BB@B@BBB@BBBB@@BB@@BAD@@@D@BBB@@@B@@B@BDBBB@DBBB@@BB@BBB@DAB@B@BBB@D@@@B@@@@@@BJ@@@@@@B@B@@DD@@BB@B@B@@BBB@B@@@BBB@@B@B@D@BBD@@BD@@@@B@D@B@D@B@BABBD@@@BBB@BDB@D@B@B@B@@BA@BD@DBD@@AB@BAB@BABABCB@@CBC@@B@@@B@BB@@B@@@B@@@@DBB@BB@BBBB@@B@@@BBD@B@@@@A@ABC@EBC@@@CBA@ABA@C@ABCBA@@BA@@@AB@BAB@@@D@@BB@B@B@@A@@B@BC@@BA@A@A@@B@B@@@B@@ED@B@B@@@B@@@B@D@@@BAB@B@B@@AF@@@B@BBDBBBB@@@B@@ADA@@@A@AJ@@A@A@A@AB@@@BA@@@A@CAA@A@@BCBABCBCDABA@ABABAB@@@D@@AB@@AB@@@@AD@@A@@D@@@@A@C@@@AB@BCBA@AB@@@@@B@@CD@B@@ABA@CBA@ABC@IDCB@@C@A@@@GDA@A@CAA@@AAA@@@@A@ADABAB@B@BCB@BA@A@A@@@@BA@@@BBDDDBB@DBDD@@B@B@B@BB@B@@@@BA@@BAB@@@BAB@B@BA@@@@BBB@DBDAB@B@B@B@B@F@BA@@@A@@B@@BB@B@@@BA@@@@B@B@B@@@B@D@@@DA@@BAD@@@B@B@@@@ABA@@FAD@BAFAHAFA@ABA@@@AB@D@B@@@BB@@FBD@@@B@@BDBD@FB@@@BBBBB@@@D@B@@BDBDDBB@@B@@@@ABAB@@@B@BBB@@A@@@GD@@AB@@@@BB@B@@A@@BAB@@AB@BABAB@B@BA@A@CB@@A@@B@@A@AB@DA@@DB@@B@@B@B@DBB@DDD@D@@@B@@BDDB@@BB@@@@AB@@@DA@@BA@@@AB@BA@@@@BA@@BA@A@@@AB@@@B@@C@@B@B@BAB@@@@@B@D@@AB@DAB@@@D@B@B@@@B@@B@@BB@@B@BB@@B@BAB@D@BAB@B@D@DBHDB@B@B@BAB@@AB@@@BBB@@B@BBBB@@B@@@BBB@D@B@@@D@@B@@B@@@B@BBB@@BD@@BB@@@BBB@B@@@BB@@B@ABA@A@@@@BA@@@@@@B@@@@@B@@A@@@A@@@@@ABA@AB@@@BBB@@AB@@@@@DA@@B@@AB@@BB@BBF@BBB@B@B@B@B@BB@@B@@A@@B@@AB@@@BA@@D@@@@@B@BBDDBBDBB@@@@BAB@BABA@@B@B@B@B@@@B@@@B@B@@@@@BBD@D@B@@@BBB@@@BBB@B@@BD@@BDDB@BB@B@@@B@B@BABABAB@@A@AB@@@B@@BBBBB@DB@@DB@BBBBBBHB@@@@@B@@@@A@A@C@@@@@@@@B@@B@B@HBB@@@@ABA@A@@DA@@B@B@@@@@@DBD@B@DAF@@ADCD@BBB@B@BDDDD@@DBB@@BABA@@B@@@BBBAB@@BD@@@@@B@@CBABB@BB@@D@B@DADBB@B@F@@B@B@@@B@@A@ABAB@@AB@@@B@@@@DDB@BD@@@B@@A@@B@B@B@B@B@B@BBB@@BB@D@@@@@@BB@@@@@BB@@@@@@BB@@@BB@@B@@@@@@C@A@@@@BAB@B@@AB@@@@A@A@@@AAC@@@A@@@@B@BABBD@@@B@@BD@B@@@@@DDBBBB@@@DB@@@DBDBBBB@BBF@@@B@@@@@B@B@D@D@B@@B@@DBB@D@D@B@@@B@D@@@B@DDD@@@BB@@BBDBDFBF@BB@BBB@BBDB@@@@BB@@BFBBB@DDB@DB@BB@BDBB@B@B@B@BBD@BB@BBBBB@@@B@@@B@D@@BB@BB@B@B@D@@BD@BBD@AB@BAD@BA@@@A@A@A@AFAB@@@BBB@@@D@@@D@BAD@D@FA@AB@@@@@BA@@@@BA@ABA@ABCBAB@BC@@DAB@@AB@D@BAB@D@@@@CBA@@@A@A@@@@AA@@AC@@AAA@@@AC@@A@@@@@AAA@@AAA@AA@@@CA@@AAA@@@A@@AAAAA@@A@AAA@AAAA@@AAAC@A@A@AAAAAAEA@@AACA@@ACC@@AAAA@@AAE@CAA@@A@@@@@A@@BADA@A@@@A@@@@BA@@@A@@@ABA@@@AAAACA@@ACACA@@@@@A@CBABABC@AB@BC@AB@B@@@@@BA@@BB@@@A@A@@@@@@B@@@B@@A@@BC@A@A@A@A@A@@@AD@DAH@BA@@BADABABADA@@D@B@BAD@B@BABABA@AB@@AB@B@@ABABADABADABAB@F@D@@@@@@D@@B@@B@@B@BAB@@AD@@@BBD@BBBB@@B@@B@B@@B@@BBBDCD@DABABB@@BE@A@AA@EBAAA@@@A@@A@AAA@@BAB@DA@@B@B@@@BB@BBB@BB@BB@@D@@@D@@@@ADA@@@@D@B@BB@@BB@B@@H@B@B@B@BCB@@ADABA@@B@B@@BD@@@B@@@D@B@DA@@B@BCB@@A@ABA@@BA@@@A@@FBB@B@@BBB@@@B@@@B@BB@@@B@B@@@BABAD@@@BA@ADE@@BA@ABA@ABC@A@A@C@A@E@CA@@@CAACA@A@A@@@AA@AA@ACA@AA@AA@@AAB@@CB@B@@@B@@ED@@AB@@@@@BC@A@ABA@@A@A@@B@AA@A@@@A@@B@@AB@@@@A@AB@@A@@AA@@@A@A@A@AAA@@@A@AB@B@@@B@B@B@@AD@B@B@B@BAB@@@@A@@BA@@DAB@@A@@B@@@@@A@@@BA@@@@B@BB@@@@BA@ABC@A@@DADABADAB@BA@@@@BABAB@@BD@DB@@@ABA@@FAB@@@@@AC@@@@@ABA@@@@B@@@DBBBBB@@@D@BB@@@AB@B@@BDBD@@@@D@B@B@D@F@DAD@B@@@@A@ACA@ACE@@@A@A@@@A@AA@@AAAAA@@A@@@A@A@A@A@CB@@@@AAC@ABC@A@@@@@@@AA@@@@@@AA@@@AAB@@@A@@A@@@@@@@A@@@AA@@@@@@@A@@BA@@@@B@@A@@B@@C@@BA@@@@DA@@B@@A@A@AACAA@@@A@@@@B@@@BB@@B@@A@@@AB@@BB@@@B@@A@A@AAACCAAAC@@@AA@@@@@@BA@AB@BA@@B@@A@ABA@@B@DAB@BA@@@A@A@A@@AA@@@@A@@DA@@@ABABABAB@DA@@@@@@AAA@@A@@AA@@@ABA@@@AA@AAAAA@A@AAA@@AAAB@A@@AAAAA@@AA@@@@@AA@@@@A@@@AAAAA@@@A@@@CA@@A@AAA@A@A@@AA@@@A@A@A@A@CBA@@AC@A@@AA@@@@BC@ABAFABA@@@@AA@AA@AAAAAAAA@AAA@@@ABCACAI@@@A@A@@AAAA@@AC@@BA@A@ABA@A@@@@@A@@@AAA@AA@@A@@B@D@DABAB@BA@ABABAA@@AB@@@BABABA@AAA@@@A@@@A@EB@BABAFAFC@A@A@@CAACACAA@@EAAA@@A@@BA@@@C@@A@@AEAA@ABA@AB@B@B@BB@@@BB@B@B@@A@A@@@A@A@@BA@AD@D@B@@ABA@@@@CAAA@@@A@@BA@@@@A@@@CBAB@@A@@@AAB@@@@@BAB@BAB@@@@A@@@@AA@@ABA@@@AA@@@A@@BABA@@@C@@BA@@B@@@BABBB@@@@A@A@AEC@@BA@@D@@@@@@ABA@@BAB@BBBB@DB@B@B@B@DA@@@@@C@C@@@@AAC@@@A@@A@@@A@@AA@@@A@@B@B@D@B@@@BA@@@@@A@AA@@@@ABABA@@B@B@@@@C@AB@B@@A@@@@@A@@AA@@@@@@BA@@@@B@@A@A@@AAAAACACAA@@@A@@B@@A@@@AA@@AB@BCB@@@AC@A@CA@@AA@A@@A@@A@@A@@@@C@A@@A@@@A@@@@C@A@@A@@@@@AB@@AA@@AAAA@CAA@@@BABA@AA@@A@@@CAA@ACA@@A@@AA@@A@@@@D@DA@@@@@A@@@AA@@AB@@A@@A@@@@@@A@@@@BAB@@ABAB@BA@@D@B@BABA@@@ABA@@@CBC@@B@@@@@@B@@BB@@@@@ADA@@@A@@@A@A@@A@@@@A@@BA@C@@BA@@B@@B@@@BABB@@BFB@@B@@@B@@@@A@A@@@@@AA@C@@@@@@@@ADC@A@@@A@@CBAAA@A@@A@@DC@@@A@@A@@A@@@@@AB@@@BAD@@@@@@A@@EA@@AAACAA@AAC@C@@@A@ACCAC@A@@AAAAA@@A@A@@@AA@@@@A@A@@AAB@@@FC@A@@@A@@@@@AFAB@BCDCBAD@BAB@@@@A@AB@A@@ABA@A@A@@DCB@B@B@@@@@@A@A@@@AB@B@@@B@@AB@@A@ABA@@B@B@@@@C@@BABAB@@A@@@CB@@@@@B@B@@AB@@@@C@@BABABC@@B@BAB@BA@A@AB@@ABCBCB@@A@A@@BA@@@@D@BBFA@@B@BA@@B@@@@A@@A@AA@@AA@A@@@AB@@@B@HCB@B@BA@A@@@AA@@A@@AAEEAAAACAAA@AA@@AA@CB@@E@A@@@AAAAAC@AAAA@@A@@@A@@CEACA@@C@AACBA@A@AA@AC@@IEA@@AAA@A@A@A@A@@BA@ABA@A@@AAC@A@AAAAEAAAAAA@EAC@CBABA@@@@A@@AAAAAA@@@C@C@@@A@A@CAA@@AA@@A@E@A@AAA@@A@@@AA@@@@AA@BCA@@AGC@A@@@@@C@@@ABC@@@@@AA@AE@A@@BA@A@@BAB@F@@AD@BCB@@@B@BABA@@@@@CBC@A@@BADABC@@@@CC@@@A@@@A@AB@AA@ABA@@AAAAAAAAAC@AACAAAA@AA@@@CA@AA@AC@ACC@@@@ABA@A@A@@BC@A@ABA@A@AB@@@@A@AB@@ABAB@@@BAB@@@BA@AB@@ABA@ABAB@@ABA@ABA@A@@BABA@@B@@A@@BABA@@@A@ABA@ABA@@BABA@AB@@A@A@A@@@A@A@@BA@AB@@ABA@A@A@A@AB@@A@@BA@@B@@AB@B@@@BAB@BAB@@AB@BABAB@@ABA@@BA@C@A@ABC@@@C@@@C@@@C@CBC@@@A@A@A@@@ABA@@@ABA@@BA@A@@@C@A@A@@AA@A@A@A@C@@@ABA@@@ABA@C@A@ABA@@BAB@@AB@@AB@@AB@BA@@BA@@@ABA@@BA@CBABCBA@@BABA@ABA@ABABA@@BA@AB@@ABA@A@ABA@CB@@AB@@@BA@AB@BAB@@@B@B@B@B@BB@@@@B@BB@BB@@BBB@@@BBBB@@@BBB@@@@@B@@@B@BA@@BAB@@@B@B@B@B@B@@@B@B@D@@@BAB@BA@@B@BA@@B@B@@@B@B@@AB@B@BAB@@@BABA@CBA@A@A@@@A@A@AAA@A@AA@@A@ABA@A@@BA@ABA@@BA@A@AB@@ABAB@@@B@@@B@B@B@BBB@B@BAB@BA@@BA@ABAB@BABA@AB@@ABA@ABABA@A@@AA@@@@AA@@A@AA@@AAAAAA@AAA@A@A@C@AAA@A@@@AAAA@@A@CAA@A@@@ABA@A@A@ABE@A@A@@@A@A@@@A@@BA@@@A@@B@@A@AAA@A@A@A@CAA@A@@@CAA@A@@AA@ACAA@AA@@AAA@AA@@@A@@BA@@@ABA@@BA@ABA@@BA@AB@BA@ABA@ABA@A@ABA@ABA@A@A@C@C@@@A@@@AAA@@@AA@@AA@AA@@A@@AA@@AAA@@@A@@@A@A@@@C@A@@@CA@@A@@AA@AAA@A@A@@@A@@@A@ABA@@AA@ABA@A@A@A@A@A@A@A@A@A@A@A@@@A@A@@@AA@@@AA@@A@AAAAA@@AAA@@@AA@@AA@@AA@@@@@AA@@AAA@@@AA@@A@AA@@A@@@AAAAAAAAAA@A@C@C@E@@@ABA@@@AB@@A@A@@@A@AA@@A@AA@@A@@AA@A@@BA@CB@BA@@@ABA@A@@@A@AB@@A@@@AB@@@B@@CD@BCB@BA@ABA@AB@BC@@BA@ABABA@@BA@A@A@A@@AA@A@@BA@A@@BA@ABA@AB@@A@AB@@A@ABA@A@ABC@"]],encodeOffsets:[[[111295,33334]],[[111273,33340]],[[110865,33031]]]}},{type:"Feature",id:"610926",properties:{name:"平利县",cp:[109.361864,32.388854],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@A@CBA@@AA@@@AA@A@@@@BA@@BCD@@A@ABGBEBC@CBABA@A@A@C@A@AAAAAAA@A@C@A@@@CBA@C@A@@@AACECCAAAAA@CC@@AAAAA@@@CA@@A@B@@@D@B@B@BAB@@@B@@@BABAFEFEBA@@ACAC@@FCBCB@BCB@AA@@@AAAAA@AA@@@A@ABABA@@A@@A@EAAA@@A@@@CBCBA@@@@@AAAA@@AAAA@@@A@ABC@@@A@A@@@@@A@@@A@@@@CB@@A@@AA@C@A@@@AAEC@@AA@BADA@E@A@@@ADA@AFABBD@B@BEDOLMJCD@@GJGDIDQH@@EDKFEBIDA@E@C@EBABABCFADKNEFCDGBCDA@IHABCBEFABADCDCDED@@BD@@BB@@@B@@AB@@@@BB@@@@@BA@A@ABCBABA@@B@BABCDEDABCDAB@@B@BBD@BB@@@@B@D@B@B@B@@BB@BF@BFBBB@@@@AD@BBD@B@BB@BBD@B@@B@@BB@B@@ABAB@BAD@B@D@B@H@B@@ABAFA@AB@@DFBB@B@BBBBB@@@B@@AB@D@BBBB@BB@BBD@BBB@BA@@B@@DBDDB@BBB@@@@@ADA@ADA@ABA@@BABAF@BGFADA@@BA@ABABCBABA@ABA@@BA@ABA@ABABA@@BA@@@AB@@@BBBBDBB@BB@ADBB@@@BAF@BBB@BBB@BDDBB@B@@@B@B@B@BBD@@@BA@AB@B@@BBBBBD@B@DBBBDBB@BBB@B@BABABAD@@@B@BBB@BBB@@A@@B@@@B@BDF@@@DBFB@@BB@DFB@DD@B@@@D@B@BBBAD@B@@DD@@@@@B@@ABA@@BCD@B@B@B@@BDBBAB@@@@@BAB@B@@@B@B@D@@BBB@@BB@BBDBDBBB@@B@BD@@B@B@@BB@BA@@B@BB@@BB@@B@@@B@B@@ABC@AA@@C@@@A@@B@BBBB@@@@@B@B@@BDBF@B@D@B@@ABAB@@@B@B@BBD@D@B@B@@B@FBB@F@@@B@FAB@B@B@DAB@F@@@@@BFDD@BB@@BFDDBDDFB@@@B@B@@BB@@@BB@@@BFBBBBBB@@@BFD@@DDB@B@FB@@BBB@B@DB@@BBB@DB@@BB@DB@@@DBB@FDD@@@@BA@@BADABAD@@@D@B@B@@@BBB@@@@BD@D@D@@@BCBADA@CBA@A@A@CACA@@C@A@AAAAA@CAAAAAA@C@C@A@@@AA@@AAA@A@C@@@A@CDABGDCBA@CBA@A@AAA@A@A@A@CAI@I@C@A@A@A@CBA@EB@@A@C@@@AB@@ADABA@A@@@A@AD@D@B@DAB@@BBBBBBBBB@@BBB@@B@@@B@DB@BB@@BBB@B@@A@CBADAB@@ABCBABA@@BA@A@@B@@@BA@@@A@ABABABAB@@@B@F@D@@@BDD@B@@@B@@A@@B@B@B@@FDBDDDB@@@@B@B@BAD@DA@ABADCDAB@@A@@BA@@D@BA@@B@BAB@B@B@@@BAFAB@@BB@BDD@B@BDDBD@B@D@DB@@@B@@@@F@D@@AB@@AB@B@@@@@@CBA@@BABA@@BA@AB@@@BABAB@BE@A@C@@@@BADCBCD@B@@AB@BA@@B@BA@ABABEFA@ADC@ABABA@@@ADCB@BAD@@@@BB@BA@@BCD@BA@C@@BA@A@A@AAEAAAA@A@CBA@ABC@@AA@@@A@@@ABC@C@A@@@AA@AAAA@@@A@A@A@@@AAA@AA@@A@A@AACAAA@@ABCBGB@@AB@@AB@BA@C@A@ABB@BB@BBBBBB@B@@@@D@@@@@B@@@D@BA@@D@B@@@BA@AB@B@BDH@B@@DB@B@D@B@@BB@D@@BB@@BB@@@@@B@@BB@B@B@B@B@@BD@@@@@BCDABAB@@@B@BBB@D@D@BBBBD@@@DB@@@AB@B@BA@@B@@@B@@BB@@BB@BAB@B@F@BBB@B@B@B@B@@A@GDCBABA@@@@@A@AB@@@BCB@@@@@B@BCA@B@@A@@@@B@B@@AD@@@@BB@@BB@B@@@@@@D@@@B@B@@A@@BA@@D@DAD@@@D@F@B@B@@@D@BB@@BAB@@@@@D@BB@@@B@@B@@B@@@B@@@BBB@@@B@B@@@@@@@@ABB@@@@@@@BB@B@@@@B@@@BB@@@BBB@B@@A@@BB@@@@@@@@B@@@@@@@@AB@@BB@@@@@@BB@@A@@@@@@BB@@BB@@@@B@@@@@@@BB@B@@@AB@@@@@@B@@@B@@@@@@@@@BB@@@@@B@@A@A@@@@@BB@@@@A@AB@@@@B@@B@D@@@B@@A@AA@@A@@B@@AB@@@@@B@@@@@@@B@@@@A@AB@@@@@B@@A@@@A@@@@BB@@B@@@BA@@@@BB@@BB@B@@@@@@@@BB@@@@@B@B@@@@B@@@@@@A@@@BB@B@@@@B@@@@@@BD@B@BA@@@AA@@@BA@@@@BB@@@@BB@@@@@@@@A@@B@@@@BBDBBBBB@B@@@@B@@A@BB@B@@@@@@B@@@B@BBBH@@@@BBBBB@B@@@@B@@@BC@A@AB@BABA@@@A@@B@@@BBB@@@BBBBBBB@@BBBBB@BB@@B@@@@@@@@@B@@@@B@@B@@@@A@@@A@@@BBBBA@@@A@@@@@A@@B@@A@@@BB@@B@BBB@B@B@@@BADA@@BA@@BB@BB@@F@@@@B@BB@@@@B@@AB@BABAB@@@@A@A@@@AB@DC@@@A@A@@@A@@@C@@@AA@@A@CBAB@DABA@A@AB@@B@@BB@D@FBB@@BBDB@@FBB@@@BBBB@@B@@@BB@@BBB@@@@@@B@B@@B@B@@@B@@B@BBB@@B@@@B@BAB@B@@@B@@B@@@B@BA@AB@@@B@B@B@@A@A@@@@B@BA@AB@@@B@D@@@B@DBDBB@BDBBFBBBDBH@@@B@B@@BB@@BBF@BBBBB@@@ADBB@@BBB@@@@BABABA@@@@B@B@@@@A@A@@BA@@@AB@@@BBD@B@B@B@D@@CB@B@B@B@B@B@@@BDBBD@@BB@B@BBBDDB@BBB@BBB@B@@@BAD@B@B@D@DB@@B@BAB@FAD@@@DA@AB@BABABABE@@@AD@@@BABAB@B@B@@@BA@@B@@BBB@J@@@D@B@@@BBBBBDBB@@@BA@@B@D@@@@A@A@AB@B@B@B@B@@BB@@B@D@@B@FBHBB@B@DA@@@D@@@BAD@B@@@B@F@@BBBB@@@DB@B@@B@B@BAD@@@BBB@H@BB@FBB@D@F@BAB@B@BAB@BA@A@C@C@A@@BAB@@ABC@A@C@@@A@ABAB@BC@C@@@@@ABCBCDEDG@A@@B@B@B@B@@@DB@B@ABABA@@@@FA@@B@B@B@DBB@@@@BB@BBDD@@@@B@BBDBD@BBB@@BD@B@@@BA@@DC@@@AAA@@@AB@@@D@BB@@DB@@@BBBB@BB@BB@BB@@BB@B@@B@@BB@@@B@BA@@@@AA@@A@@@BE@@@A@@B@B@B@B@@@B@B@D@B@BAB@@@B@@@B@D@@BB@DB@@@@@C@A@@BA@A@@BBBA@@B@BA@@B@@@@@BBB@@@BB@@B@@D@@B@BBB@BBFB@@@BBB@@B@BBD@BBB@B@FBB@D@B@HBB@B@@A@@@A@@BAB@D@B@B@D@B@@BB@BABABA@A@@B@BA@AB@B@DABABB@A@@B@BA@@@A@A@@B@@ABABA@@@ABA@@BG@@@ABA@@@@B@B@@@B@BCDE@AB@@A@A@AB@@@@BDB@@B@BAB@@@@@BB@@B@DBD@DBBBB@B@@@BBB@BB@@@@BA@@B@D@@@DBB@B@BAB@B@@@B@BABAB@D@@@BA@A@A@A@@B@B@@@B@@CBA@AB@@@@@B@@@FAB@@@@A@@@AB@BDB@@@BABABADC@CBA@@B@@BBBBB@@B@B@B@@@BABCB@@ABA@@@C@A@AB@@A@@B@BAB@B@DAB@DCB@B@@AD@@@BBB@@A@AB@@AB@BA@@DAB@@AB@@A@AB@@ABA@A@@@@BAB@@@B@B@B@BA@@B@B@DABAB@D@@@B@BA@@@AB@BA@@DABEDABC@@@A@A@C@CBAA@BAAA@A@A@A@A@@AA@A@A@CBABC@@B@@@DAB@D@DCB@BA@@BAB@B@J@BADBB@BBBBBBB@DBB@B@BAB@@@BAB@@AB@BA@ABA@@B@B@BC@@@@D@B@DA@@B@@@BAB@@@BA@@@@B@B@B@@@D@H@@@B@BCBAB@B@FBDBB@DA@@DC@CB@B@@@B@JA@@B@@ABC@@B@HA@@B@@@B@BABEIOA]EIEKUKGIBQ@IBSBGGKMAO@IGEKA@CUBMHSFIFKDQ@SEOU_ACEM@ADGJKPGRKFEJIDCLQHODKDC@@FMFGBKBGNAHAAIEA@@AAEAAA@@A@A@CD@BA@@@A@A@@@AA@@CCA@A@AAAAAAA@AA@AAAAA@ACA@@A@C@ABAAAA@@A@@C@A@@AAAAAA@C@AA@@AC@A@@@AA@@CAAA@@AA@@AAA@ACAA@@@A@A@@A@C@@@A@@AA@A@C@@@A@AA@@A@A@@@A@AAA@@@ABC@@BA@@@CAAAAA@AA@@@CAABC@G@EAA@C@A@C@C@AAA@@A@@A@@@ABA@@@A@@CA@@AA@A@@BA@C@A@@@A@ABABA@AB@@@@ACA@CC@@A@AA@AA@AA@@A@@AECAAA@AAA@@AAAA@CC@@A@@@AA@C@@@@A@C@@@@@ABA@@@CA@@A@@@A@ABA@@BC@@BA@A@@@ABABABAB@@A@CBA@C@@B@@ADA@ABAB@AA@@CAAAAA@AACAA@@AA@@AC@@A@@@ABA@@AA@ABA@A@AB@@AAC@@@A@AAA@A@@@@@AA@C@@@A@A@@A@CA@ACAACCAAC@@@AAA@A@@@A@A@A@@@A@A@ABC@A@@AC@@AAAAAA@@@C@A@AAA@A@A@A@@@E@@@CAAA@@A@A@C@A@CB@@CACAA@AAA@A@A@A@AA@@C@AA@@@A@A@A@A@@@AB@@@ACBABA@A@A@A@@AAA@@AA@@AAA@@@ABE@ADCBABABAB@BCBAB@@@@AAAC@@AAA@E@A@@@AB@BA@@@A@ABE@C@A@A@AAGAA@@@@BA@AB@BA@@BA@@BA@A@A@A@AB@B@B@@AB@BA@@@A@@AA@@AAA@@@AA@ABABCBEAA@A@@BADCBCBC@@BABC@ABE@@@AB@BA@AB@BAB@DABC@@DCBADC@A@ABA@@B@BCBAB@BAA@@A@ABC@@@AA@AACCAA@@CCA@AA@@@@@@@ABA@A@@@@@@C@@AGAAAA@A@A@AA@@@C@C@@BAB@@AB@AA@@@A@A@ABE@AAC@AAA@AA@ACAA@C@AA@@AA@A@A@A@A@DA@@BA@C@@BA@@B@@@@ABABADCB@BAB@@C@AAA@@AA@@@@@ABA@@@ABA@ABC@@@@@A@@E@AA@@B@B@D@@ABA@@BAA@@A@ABGBC@@@A@A@@BCBCBCBA@@@@@@@AAAA@@@@BCFA@@B@@@@ABABCBA@@BA@C@EB@@A@@BCBABA@A@CBA@@@C@A@E@@@A@A@ABA@C@EB@@A@C@EA@@AAA@@@E@A@ECA@CBA@C@AACA@@A@ABA@ABAB@A@@@A@A@A@AAA@A@A@AAAAA@CAA@A@@@A@@BA@@@ABA@AAC@@@A@@AA@@CE@AC@@@CCAC@@@A@@AAAAA@B@@@@@B@AABA@@@C@@@AB@@AA@AA@AA@@@@A@@@AA@GE@@A@ABA@@@@@@@@A@A@@AA@@AAA@"],encodeOffsets:[[111888,32661]]}},{type:"Feature",id:"610925",properties:{name:"岚皋县",cp:[108.902049,32.307001],childNum:1},geometry:{type:"Polygon",coordinates:["@@BA@@B@@AB@BABAB@BAB@@AB@BAB@BADABABAB@@AB@BCHE@ABEBA@AB@BAB@BCB@BC@@@@A@AAA@CCCA@@@AB@@AAA@AAC@AAAA@AA@A@CBA@@@A@@AAAA@A@AAACE@@BAB@BEBA@@@A@G@A@C@ABC@ABABA@@@AAA@@@AA@C@AAA@@A@AAC@ABC@@@@AAEA@AAEA@@AA@A@A@C@A@@@@@AAC@AAA@@@BADCBAFCDCBA@A@AB@BADABAB@B@@A@@@@AA@@@@BA@@@A@@AA@@ACCDGDMHCBKBC@ABEFIJGHIJEDA@@@CCA@A@GBSHCBEHA@ABABC@E@C@MDEDCBGFCBCBA@E@A@CCA@A@GDKFA@E@CAECCAC@C@ABMHGFEDC@A@E@C@EAC@O@E@G@EAGCA@A@A@GDMFGDABCB@DADCB@@ABA@C@A@A@CBA@@BC@AB@@ABAB@@AB@B@BA@@BABCB@@AB@B@@AB@BA@ABA@@BABAB@@ABAB@@AB@BAB@@AB@B@B@@@B@B@@CDIHGFEDCBA@A@A@@@A@ABA@A@CBA@A@ABC@ABA@@@CBABA@ABA@C@AB@@A@C@A@A@@@A@A@C@A@A@A@C@A@A@A@A@ABA@C@@@A@@@AB@@AB@@ABA@@BA@A@AB@@ABA@@@A@A@CB@BABABA@@BABAB@BAB@BABABA@A@C@ABABA@@BA@ABABA@@BA@A@@BA@C@@BA@@B@B@B@B@B@B@BBD@@@D@B@B@@@BABAB@@@@@B@@@B@@@BBB@BAB@B@BABA@@BABA@@BA@ABA@@@ABA@@@ABA@AB@@A@AB@@A@A@ABA@AAABA@A@A@C@@@A@AA@@A@A@A@A@AAC@@@AAA@@@A@A@A@A@A@A@A@@@A@ABA@@@A@A@A@A@A@@@A@A@AAA@A@@@A@AA@@A@A@A@@@A@A@A@@@A@A@A@A@A@A@@@A@A@@@A@A@A@A@C@ABA@AB@@@@AB@BAB@@@BAB@BA@AB@@ABA@A@@@A@CBA@ABAB@@A@A@AB@@AB@@@@@B@D@@@B@B@@AB@@@@ABA@ABA@@B@@AB@@@BA@AB@@DD@BBDB@@BDB@@B@@BBBBBBD@BBDBBBBBBBB@@AB@BBBA@@B@B@@@B@@DD@@@@ADCBAB@@@BAD@D@@@@ABABA@@@A@ADC@@BE@A@AB@@@BAB@@@BBFB@@B@@@@AD@B@@@D@@@@@BHD@BB@ADB@@B@@B@@B@@@BB@BBB@F@B@@@BB@@BB@D@B@B@@@D@D@@BBBBBB@@@B@@B@BADAD@FBB@BBBBFBBBBBB@D@BB@@@BAB@BAB@@@B@B@B@BBB@BB@JF@@BDB@@B@BABBD@B@DB@BDDF@@@B@@@BB@BB@BBDBBBB@@B@F@@@DAB@@BB@@BBBDBBBBBFFBB@@@BB@@B@@@BABA@A@GDA@@@A@@B@@@BBB@@BBB@@@@B@@A@@@ABA@@@EBAAC@@@@@AB@@@B@BA@ADAD@BA@@B@BABA@ABA@@@ADABAB@@@D@@A@@BA@A@@@@@A@@D@@@BA@ABAB@@@D@@A@A@@@AB@B@BA@@BA@@@A@A@@B@@@B@B@@@@A@A@A@CD@@@B@BAB@BB@A@@B@B@@A@ABC@ABCDADA@EB@B@@@@@B@@@BED@@A@BB@@@B@B@@B@@B@@@B@BB@BBBB@@@BBDDD@B@B@@@DBD@BBBBDBB@@FB@@@B@@@@C@AB@@A@@B@@@@@BB@@@@B@@CD@@@BB@B@BBDA@@@B@@@BCD@B@@@@@@D@B@@B@@@@@B@B@@A@@@A@@@EA@AA@BA@A@@@AA@@@AB@@@DAB@@@B@@B@@@@B@B@@@B@@CB@B@@@@AA@@@A@@@@A@@@AD@D@@AB@B@@ABABA@C@@@ABA@AB@BA@AB@@@@@B@@@@B@@@@BA@@BB@@B@@@B@@@@CBC@@@@@@BB@@BB@@@DB@BBB@D@@@BB@@BABAB@@B@DBB@BB@BB@@BA@@B@@@@@BB@D@@@@@@B@@@BB@D@@@@@@BB@@@@BB@B@@BB@@D@BBD@@A@ADA@@BB@@B@@@BA@@@@B@@BBBDBDBBBB@@@B@BA@@@@@AB@@@@@@BB@@@B@@@@@BA@A@@B@D@@A@A@@@ABAB@B@@B@@B@B@@@@AB@@A@C@A@A@@@@B@@BB@@@B@@@BB@@@D@BB@@@@@D@D@@@@CBA@A@A@A@@CAAAAA@AB@@AB@B@@@@C@@@AB@@FD@B@B@B@@A@AAAB@@A@@@AB@@@D@@ABAB@@@B@@BB@@B@BA@@BB@@@@@B@@A@ABA@AB@@@@A@BB@@B@@@BADA@@B@@@@@AB@@@B@@BBDB@@@@AB@BA@C@C@@BAB@@@B@B@@@B@BA@A@A@@A@@AAA@A@A@@BAB@BBBBF@@@BD@@@B@@AB@@@BBFB@@BBBDBDDB@@@B@BEDEBABABA@@F@B@@@B@@BB@BABABAB@@A@@BB@ABAB@BABA@ABCBC@A@@@@BB@@BBB@B@@@B@@@@@BAB@B@BAB@@BD@@BBBB@@@B@B@@BJBDAD@B@@BB@BBBBBBBBBB@@BBB@@@@ABEBAB@BAD@@@@BB@@@BBDB@@AB@B@@@D@@BFAB@DBB@B@DA@@BBBA@@BA@@D@@@DAHCB@B@F@BBDBB@@@B@BADCDABA@AB@DAB@B@B@@@B@D@FA@@B@@AB@@C@ABA@AB@BA@@@AB@@A@@@AA@@A@@BA@@BA@AAC@A@A@A@C@A@@@A@@@A@@BA@@@AAA@A@@BA@AAABA@CB@@CBABADC@@BB@@@@@B@BCH@B@B@DDFBB@@DEB@BCBCB@D@@@BABA@ABA@@D@@@B@B@BABA@AB@B@@@BA@@BA@@@AB@@@HB@@@@BA@@@A@C@ADA@A@@@A@AB@@A@A@@@A@A@A@CBCA@@AB@B@BABA@@@ABABABA@@BABA@@@AB@@@B@HABAB@@@B@BA@BD@BAB@@BBB@DBB@B@@@BBDBB@B@BBB@BB@@B@@@BABA@@B@B@B@@@B@D@B@@@B@BBB@B@@@BA@@B@@AB@BB@BB@@BB@@B@D@@@BBBB@D@@BB@@FBBB@@D@@@BA@@@@B@@BB@@@BAB@DAB@@@BBB@BBB@B@B@@B@@@@@BB@@BABB@@BB@BBDB@@@B@@D@B@B@BAB@@BBAB@@@DA@A@A@@@A@A@@@A@A@@B@BA@@DAB@D@@@B@B@FADAD@D@BBD@B@J@@@B@BAB@@@@@@BB@B@B@D@@@BBBBBBBDAB@@@BBBBB@@@B@@BB@@BB@@B@FBD@B@B@@@BA@@F@BAB@@@@@B@B@BBB@BA@@B@@AB@DA@@@B@@@@@B@D@@@BB@BBBBDD@BD@B@B@B@@@B@B@B@DCBABAD@B@D@@@BBDD@@B@@@B@B@@A@ABA@@@AA@@@A@A@A@@@A@AA@@A@@A@AB@@@BAB@B@BA@@B@@@@AAA@@@ABAB@DAF@B@D@@AB@@A@@@A@@AAA@AAC@A@@@AA@@@C@A@A@A@A@@ACCA@A@@BC@@@@CAAA@C@A@AAAECBA@@BA@@DAF@B@D@B@B@@A@ABAB@BACG@@@CBA@A@@AA@@@@BAB@@@BA@C@C@@@@@AFADAAA@A@@BAB@@A@@@ABA@A@A@@@@@AA@C@A@C@@@@A@@BA@@B@@A@AB@FABA@@DAB@@@BA@A@@@A@A@@BABA@@@@@ABCA@AA@@@@BABADADABADA@ABABADA@@B@@CAABABC@C@@BC@CA@@AB@BADC@@@@B@B@BABABAB@B@BA@@B@FED@D@DCB@BABADABAB@DA@@B@@@B@@@B@@@@CBC@A@@@A@ABA@A@A@A@AA@@A@A@@B@BADABA@A@@@@BAD@@ABA@AB@@AD@BABA@@D@@@B@D@D@@@@@BBD@BBHB@@DBBAB@D@B@@ABA@@BA@@HADABA@@BBDBBBB@B@@@BBB@BB@@B@B@B@@@B@BB@BBB@@B@D@D@BA@@B@@@B@@BD@BAB@DAB@B@BBFBBBB@B@B@@AD@B@@ADC@AB@@AAA@@@@BC@ADABC@@B@BABAD@BCB@FEBABAB@@A@AB@@ABA@@@ADCDABC@A@@D@B@F@@ABABA@A@@BAB@@AB@BA@AB@DA@@@@@@@ABA@@BA@@@C@E@@A@@@A@@C@C@AACCC@A@ACC@AAA@@BABE@A@@@A@ABA@A@AB@@A@CB@@AB@@@BADCBCBAB@@CBC@A@A@A@@A@CCACEC@@@A@A@AB@@@@A@@@ACC@A@@@C@E@A@@BABABABAB@@@B@@A@@@AB@B@@AB@BADABA@@BABCDAB@@@@AAA@AA@@ACAA@@@A@@@AA@AA@AAAAAAAA@@BA@C@A@CBCB@@@B@B@BABC@@BA@@D@B@@@FAB@DAB@B@B@D@J@J@DBB@B@B@B@BBB@B@DAB@DAHCBADCB@@@D@B@B@BB@@BB@@B@D@D@B@BBBBDBB@BBBBB@D@@@DBDBB@B@B@DAB@BCDA@A@@@C@CAC@@@@AA@A@@@A@A@C@@BCBABC@AB@@A@@C@ECA@CA@@A@@CAA@@CAA@AA@@CAA@A@AA@@EAA@A@CC@@EC@A@@AAAAAAAE@@A@@A@@AA@@@A@A@@EACCCAEC@AA@@ACCAE@@@@E@A@CBA@A@A@EBA@@@E@A@EAA@@@@A@A@CAC@A@A@A@@BABA@@@A@C@AAEAC@@@A@A@@@@AAAAA@@@@B@@@DB@@BAD@BA@A@@@A@@@AA@@AAA@@@ABA@@AA@A@@@ACA@@@AACACAAAA@@AA@AA@@@C@A@A@@@ABA@A@@@@BAAAAC@@@A@A@ADC@AB@BA@@@A@@@@CC@@@ABCAA@A@A@C@@@ACCA@CEA@@AA@AE@C@@CE@A@A@@@AB@@@AA@AAA@A@A@@BCBABA@A@AAA@AAAACAA@C@AACAAAA@@@ABAB@@A@@AC@A@A@A@A@@@AAACC@AAA@AAA@ABE@A@@AABCA@@AAAACAA@A@@"],encodeOffsets:[[111686,32812]]}},{type:"Feature",id:"610929",properties:{name:"白河县",cp:[110.112629,32.809026],childNum:1},geometry:{type:"Polygon",coordinates:["@@KIMKUMQGOGMESAA@U@UFOLGJ@@QVGLA@QFMGS@KHKHQH[HYBG@YA_KQGOEWA@@UAS@]DYHSFWDSBWLA@CBMHGFABCDABCDCDAB@BCFABAB@@@B@BA@@@AB@B@B@@@B@@ABA@ABAB@BAB@BCBABABAD@D@B@@A@ABC@ABA@@@A@C@A@ABCBC@@BA@C@CBA@@@A@A@A@@@@B@BABA@@BA@EBC@G@EBA@@@AB@DAB@@ABABABADAB@@@B@@@B@@@B@@ABA@C@C@A@C@@B@@@@@@@B@@AB@@@B@BBDDFBB@@@BABAB@BBBA@@BABABCD@B@B@B@BAB@@ADAB@@@D@BAB@@@B@BBDAB@@@B@B@@@@BD@@@@@BAF@DA@@@@BA@AB@B@@@BBF@D@@AF@B@B@H@B@F@BBD@B@@@BCHABABABED@B@B@BBD@B@B@@AB@@AB@@@@BB@@B@HAB@BA@@D@@@D@BBD@BBBBBBBB@BDD@@DBD@BBB@@@B@@B@DBB@BBDAB@@A@A@@@A@C@A@A@CB@BC@@@@BDDBDBB@@AB@@E@ABA@A@ABAB@@@B@@@@BB@@@B@D@@@D@@@D@@@B@@BB@@H@F@@AD@@@@@@D@B@B@@A@@B@BA@@@ABABC@C@@B@@@BBBBBBB@BDB@@@B@@@B@@AB@@BB@BBF@@@@B@B@D@@BBBBB@@D@F@B@B@@@@ABC@@B@BBH@B@B@BB@@BB@D@@@B@@@@C@IACBA@@@@@@DFF@@BB@B@@@B@B@@@B@B@@@@AB@@CBABCBA@AAA@C@@A@@A@@@@@@B@@@BDB@B@B@B@@@@A@@@ABABAA@@A@ACCA@AA@@@A@A@@@ABAB@BAB@B@@@BD@@D@@@DBB@B@@B@D@@ABBB@@@B@D@B@@@F@B@BADA@@BAB@@@B@B@B@H@B@@@B@BADABAB@BA@@DBB@@@BBDBBBD@BBDBBBF@B@BBBBBBBB@@BB@@BB@@DBB@F@@@DAB@@@DAB@D@@@@@BB@@B@@BB@BBBB@@@@B@B@BB@BD@FA@BB@B@B@B@@AB@B@B@B@BBLA@@D@BABAB@@@FCD@B@B@DBBB@AB@@@BA@@DAB@B@D@@@FA@@@@@@BAAAB@@@B@@@BA@@BADAB@BABA@@B@B@B@@@BB@@D@@@@@@DBB@BDDDBB@B@BA@@B@B@BAB@B@@@B@B@@AB@B@B@@@BBD@B@@@BB@B@D@B@B@BB@B@@@@@@@BB@@@@ABAB@@@@@@@@BB@@A@@@DDBB@@@B@@ABA@C@AB@@ABAB@@ABA@AB@@@F@@@B@@ABA@AB@@ABD@FDDBHHFDFDFBJDH@FBFBH@H@F@@@@BDBBFJTBFDDD@FBFAF@BABAFCLIFCF@D@HBFBJ@P@DAHAB@BAFCFEBCBABCB@DGDADAD@DBD@HAH@DBD@D@JBJAB@PCH@@@F@JBHBH@D@DCBABAFIB@DCHCLCJAF@D@HDF@H@BCBCBEBABCBCFCDAHELEJCD@D@FAB@JAB@NCPEPIHA@AJCHCHEB@HGDEBABCDMBCDABAFAFAJBHBB@D@AEDEEIIMEEEKAE@CFKJGHEN@@@HFFBH@FCBIBAFU@O@M@ICQ@KEM@AGUAODMHEHELKFGLGFQLQJEVMFEACAGGEOGOEQSEKCGKGSAC@MJOPKLQJUDW@G@MAAAIGAOHIBOAMEEACSG"],encodeOffsets:[[112717,33359]]}},{type:"Feature",id:"610928",properties:{name:"旬阳县",cp:[109.361024,32.832012],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@B@@BB@HBBABADAB@B@B@B@BBLFJAHEPETEF@J@^@LBLAJ@N@PCPEFCFAHCLCPCNAJEFKFELGFBDHJFLDFCDMJEF@F@B@JBLFNHNDJ@P@V@TEB@TBH@NBDADAFAFGHGFGHAHAFAB@JEJEDAPEJEBAJCJ@FCNCBAB@@@JGfIJ@BADCBE@K@@AOAAEUA[A@@G@Y@AAMCW@A@@AA@AACGS@A@AIWAI@@AE@[@EAEIQE]BAHCHEDEDCDEDEDCBABA@ABAHILGHAD@D@BA@@BAB@BA@@@A@@@E@@BAB@BA@@BABA@@BAD@B@BA@@@A@@AACC@@B@@@AA@@@@@@@@BABA@@@@AA@@@@@@A@A@@A@A@A@C@AAA@@A@C@AA@@A@A@A@@BA@A@@@A@A@ABA@A@@@ABA@A@CACC@AAA@C@@@@C@@@AA@@A@A@A@@@ABABA@CBAB@@AB@@A@@@A@BBAB@@@@@@EB@@C@A@A@CB@@AB@@A@@BAACAA@A@C@ED@@A@ABABC@@@KBAAA@A@A@A@@BA@A@A@A@@AEBC@@AAAA@A@@@@@AAAAA@@AA@@@AA@@@@C@A@CB@@A@CB@@E@A@CA@@AA@@AA@@AAAAAAAAA@E@AACAAAC@AACAAA@@A@CA@@ABA@ABCBABA@@@A@G@A@A@A@@@A@AB@@CBABA@E@@@A@C@A@@@A@AA@BC@A@@@@AAA@C@@@CC@@A@@@ABA@ABABA@@B@B@@@B@@BDBBDB@@@BBBABA@@B@@@@@@A@A@ACA@A@@@A@@@@B@@@@BD@B@BBB@DABADA@@BA@@@@@A@A@@@A@A@@@AAA@@EE@C@@@@B@DAJBD@@@@@@A@@@CAA@@AAA@A@G@AAA@@@AD@B@@A@A@E@C@@@AAAA@AC@A@A@@@@@AE@AAA@@BA@@@A@@@A@@CA@AAAAAAA@A@@@AD@D@BABA@@B@@A@AB@@@@A@A@C@@@@C@@BE@G@@@AA@@@A@@@C@@@C@@@C@A@@AA@@@@@A@@BABAB@B@BAF@@@BA@@AAACCC@A@@D@@ADAB@B@D@B@@@B@B@@@BAAC@AAA@C@AA@@@A@AAC@CA@@CC@AAAAAAAAAC@AAC@@@C@@@ABA@GBA@@@AA@@@@BA@@BA@@@A@AAC@A@A@AFCBABABADG@A@@@AAC@A@E@A@G@A@ABE@@@CAE@A@@@ABAB@@A@@B@@CBE@A@@@@AC@@@@@A@A@@BAAC@A@A@@BA@A@C@@BABC@@BA@A@A@A@ADCBABA@AB@AA@ABABA@A@@AACEAC@A@A@@BA@@@A@@@@@@@AD@B@D@D@B@BA@@@A@@@A@@@A@@BABCBABABA@@BA@CBA@@B@FAH@D@FAB@@AB@BA@A@A@@B@B@B@@@B@DAD@B@@AD@DABAB@D@B@@@B@BAD@BAB@@@@A@CBCBABADA@ABA@ABABAB@BA@@@A@@@A@ABA@@B@@A@A@@BABADE@ABADCDCBAA@CDK@KBKAWG@@YAW@KAUAW@[AMECAEEGWDKDONQNMHUOSWMMESE[EQKEG@]KMGGKCMCAFABA@@@A@@@GBA@@@AD@BA@@@IBA@@@A@A@@DCD@@CBA@CAEAA@A@ABADA@@@G@C@@@A@A@A@@@@@AB@@A@AB@@A@@@CBA@C@@@@@ADA@A@@@AB@BABA@@BA@AB@@A@ABA@A@CAA@AAAAAAA@CAABI@A@A@AB@@ABA@CDC@A@CB@@A@@@ADAB@D@B@BBB@@@B@B@B@BBBABB@AB@D@D@B@B@@ADCBAFCB@@ABA@@B@@ABA@@@C@A@ABCBA@A@@@ABA@A@A@@@A@AB@@@@@BAB@BA@@B@BA@@BA@CB@@ABA@@BA@@B@BA@AA@@C@@BA@A@CDA@CBA@A@ABA@@@@BA@@B@B@D@@AB@BA@ADAB@@A@A@A@@@AAAA@AA@@@AB@DCDABABAB@@A@ACA@@B@@@B@@A@EB@@A@@@@@A@@BAB@DA@@@A@A@@@@B@B@BAB@@@@BB@BBB@BBB@@BB@B@B@@BB@BAB@@@@A@AD@B@B@B@D@D@B@B@BAB@@@DBB@B@D@@AD@@@@BBBB@@@B@@C@E@A@@@GAA@A@@@ABC@A@A@A@@@@@@B@@DB@BBB@D@B@B@@@B@@@B@@C@CBA@@@A@A@AB@B@BA@A@A@@B@BA@@BB@B@CB@BABA@@@A@A@ABA@@BA@A@A@A@AB@B@BA@@BA@@@CACACAC@C@C@C@@@A@CD@@A@EBABABA@ABCDCBA@CBA@A@A@@@AB@@A@C@@A@@@@AAAAA@A@A@@BB@@B@@ABAB@B@B@@@@BBB@@@@@@B@@@@EB@@@B@@D@DBDB@@@B@@@B@@A@ABAB@B@B@@A@A@A@@@A@@@A@@@A@A@A@@@@@A@ABC@A@AB@@AB@B@@A@@@@B@@A@AB@BABAB@@@@AC@@@@B@AA@@A@@@CBA@CF@@@BA@A@A@@@A@@B@@@B@@A@A@@@E@@@@@@B@@@BA@@@@B@BAD@D@BAB@@CAAAA@@AA@C@A@@@A@@A@AAAA@@AA@CAAB@@CA@@CA@@@@A@@@@BB@@B@@@@@B@@@B@@AB@B@@@@CB@B@@B@@D@B@@@@@@A@@A@@C@@B@@@BA@@@@B@B@@@BA@A@@@@@ADBB@DBB@B@B@@C@ABA@CBA@C@@@@@@@@BB@@@@B@@@@@@AB@@@@AAA@CBAB@@AB@@AB@D@@@@@BC@@@CB@@ABAB@@A@@BA@A@@B@@@@BB@@@B@B@BBB@B@D@@@B@B@BBB@B@@@@B@B@B@D@B@B@@BA@@BABAB@BAB@B@B@D@@@BA@@B@@BBBB@@@B@B@@@B@@BBBBJCBB@@B@BB@F@B@B@BBBB@BB@BB@@BB@@BA@A@@@A@AA@@@@@@@B@@AD@B@@B@@BBB@B@@@@A@ABCBA@ABCBA@AB@@CBGBC@C@A@EB@@E@C@C@C@@@AB@@A@ABA@CBGDABEBEB@@@@@B@@B@A@@@AB@@@B@@@@B@@@B@@@@ABB@AB@@@@BBB@B@@DD@@@@AB@A@@A@@@@BAD@@@B@@A@A@@@@@AB@@@B@@BB@@@BAB@BA@@D@B@B@@@BA@A@ABA@@B@@@B@BB@@B@BB@@@B@BA@@BA@@B@BAB@H@B@@A@@B@B@@@HDBBB@BB@B@@@BAB@B@@AB@B@@@B@@A@@BA@AB@@A@ABC@ABE@@B@@B@@D@@@BB@B@B@D@DBF@HBB@BBBBBBBB@B@@@BD@@@BBB@@BD@B@DB@@@BB@@BB@@B@@BD@@@B@@A@@@ED@@@B@@@B@B@@A@@@C@A@@B@@AB@BADA@@DB@@BABABABA@@@A@@@CCAC@@AAA@A@@@@@@B@@@B@BB@@B@B@BBB@@@@@@A@C@A@A@A@@@AA@AA@A@@@@B@@@B@B@BCB@DA@@@A@@@CBCDA@ABA@@@CAAAA@@@AB@@@B@@CB@@ABCA@@AB@BA@@BA@A@AD@@A@CBCBA@A@@BA@@BA@@@@B@@A@AB@@A@@B@@@BAB@BA@@B@@A@EB@@A@@@@AA@A@A@AAEBCA@B@@AB@@AB@B@@AB@@A@A@A@@@CCA@GCAA@@@@A@@@@BABA@C@@@AB@D@@@BA@C@@BA@ABA@C@C@@@A@@@A@@B@BAF@D@B@@A@ABA@@@@F@@@BAB@@A@ABA@CBA@@B@B@F@@@@BB@@B@BBBD@@AB@B@@@BBB@BBDBB@BAB@B@@AB@BA@ABA@AAA@@@A@@@@@AB@@AB@BBB@@@B@@@@ABABA@C@AB@@A@@B@@@B@BBBBD@B@BBBB@@B@@@B@@@BA@@BA@A@@@ABC@ABA@ABA@ABA@@B@@@BA@ABCB@@ABA@AACB@@@@ABABA@@@E@@@AB@@A@@B@BA@C@@BABAB@B@@@@ABA@A@A@A@@@CB@BB@@BA@@BA@ABE@AB@@AB@@A@A@@@C@@@@@BF@B@B@BABAB@@@@@@@BB@@B@BAB@BBDBB@@ABA@@@@@@BAD@BA@@BABA@A@ABA@CDABCBAD@@@B@BAF@@@B@@A@@@C@@@C@A@AB@@@BAB@@@BC@AB@BABABABC@AB@@AB@@AB@@@@C@A@A@A@CBA@@@A@@@@@@@@B@BB@@BB@@BB@@@F@@BB@BBFBB@@@B@BBD@BBB@HBD@BAB@BBB@B@B@@@@@A@ABA@A@ABADCBABA@ABC@A@A@ABGB@BA@ABABEBCB@@ABAB@@@@FD@BBB@@B@BB@BBB@@@@A@@@AB@BAB@BA@@B@@@D@@@B@@@BB@@@BBA@@BCBA@A@AB@@@B@@CBA@BB@@@BAB@@@@AAAB@@AB@@@@@BDD@B@@AB@@@@BD@BAB@BA@@B@B@@ABA@A@@BBHBBBB@@AB@BA@ABCBAB@@AB@@AB@B@@B@DBFDBDDD@@A@ADA@@BA@@D@B@@@BAD@B@B@BBD@@AB@BABADAB@@@BBD@@@BA@@BB@@@@@@@@BC@@@@D@@@@@B@B@@@BA@@@@@@B@BBD@@BB@B@@@B@B@@@@ABABABAB@BAB@B@@C@@@@BAB@@@B@@ED@BABCBA@@@@@@B@@@@AB@@@BAD@@AD@BAD@@AB@@B@@B@B@BB@@@@B@@@@FFBD@BBBBDB@DAB@@@B@B@BBB@B@BBBBBDBB@@@@@AFCB@BA@AB@@@DA@@BA@@@A@@@@D@B@BAB@B@@BB@@@BBB@B@B@@@@@B@BBD@BB@@B@DB@@@@@@@D@@@@BB@B@@BBBBBBB@HAB@@@DBF@D@BB@@B@B@@@BBBBB@BB@@@DAD@D@@@B@BBBBB@@BBBDBDAB@B@BB@@BBBB@B@@@B@@A@@B@BA@AB@B@@@BB@@BB@BBB@@BB@@BBDBB@BBB@D@B@@ABA@AB@BABAB@BA@AAA@@BA@@@A@@ACCA@@@AB@DA@@B@@@@AA@@AAA@A@A@@@@@@DBB@B@BBFBB@BABADA@@@@CC@@B@DABABAB@B@DCBADAFA@@B@B@@BDD@@@D@@@@DABC@AB@BC@AB@@A@A@ABA@AB@BAB@BA@@AC@A@A@@@@@@B@@A@@B@DC@@@@AA@A@@@@BA@@B@B@@@B@@AB@B@@@DAB@HAB@B@DAB@@@B@F@D@B@B@D@DAD@D@@@@@B@@BAB@B@BB@FBD@B@B@@BB@@@BAB@BA@@@AB@B@BAB@BBB@@@B@B@@@DAB@B@@@BB@@D@@@BBB@BB@BBB@BBB@BB@@BBD@@B@@BB@@BDD@@@@B@@ABAB@@@BB@@BB@B@BB@@@@@B@B@@A@@@AB@BA@@B@B@B@B@B@@B@@@B@BB@B@@@B@B@@BB@BBDBB@BB@@DDBBBBB@B@@@@@@B@B@B@B@B@@A@A@ABA@@B@@@@B@@@B@B@DCB@@@F@@@B@BD@@@@BB@@BAD@BAB@@@B@BA@@DA@@BADAD@B@B@B@B@@@B@@ABA@ABABC@@BA@@BAB@BABB@AB@@CB@@ADA@AB@@@B@@@B@@@@@B@BAB@@A@@@A@A@AAC@C@@@AB@BABBB@BBBBB@B@B@B@B@@@B@@@B@DAB@@@B@BAB@B@B@B@@@@B@@@B@B@@@BB@@B@B@@BDB@BB@AD@DAFBBA@@D@D@BAB@@ABA@A@@D@B@B@@@BBBBB@BBB@@BB@@B@@B@@AB@BA@AB@@AB@B@@@BA@AB@@ABAH@FAH@@@@A@@BA@@BAD@@AB@@AACAC@AAABA@@@@BA@@@ABABA@@@AAA@AAA@AB@BCB@@A@C@ABA@ADABABABA@@B@D@DBD@D@F@@@B@@BDBD@BBBBB@@@BBB@@@@@B@BBB@BBB@@BB@BD@@BBB@@BB@DB@@B@BB@B@B@@@DB@@@B@B@B@B@BBB@B@D@D@@@BBB@DBB@D@@@B@B@@@D@BAD@B@DAB@@@D@BBD@B@BAF@@@@ABAB@B@B@@@HB@@"],encodeOffsets:[[112047,33943]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
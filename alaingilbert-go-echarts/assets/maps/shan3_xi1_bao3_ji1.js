@@ -0,0 +1,4 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("宝鸡",{type:"FeatureCollection",features:[{type:"Feature",id:"610302",properties:{name:"渭滨区",cp:[107.155344,34.355068],childNum:1},geometry:{type:"Polygon",coordinates:["@@BB@FBB@BBBBB@@B@FDB@BB@@B@B@B@D@DAD@@@B@B@B@B@D@JAL@HAD@H@@AA@@AAA@AA@AA@AA@@@@@AA@AAE@@CEB@HGBCBAD@BA@@F@DBBAF@D@D@B@B@B@B@DBB@@BDAB@BA@@B@B@BBB@BBB@FBB@D@B@B@B@B@F@D@@@H@DA@@DB@@B@BBB@@BB@DA@@HD@BD@D@B@B@B@BAB@@@@AB@BAB@@AD@B@@@B@D@D@D@@@DAB@D@B@D@B@FAD@D@FBBBD@@@BBB@FAF@BAF@B@B@@@DAB@B@D@@@D@B@B@FA@@B@BADAD@B@D@BB@@BA@@AA@A@@@A@@@@@C@@B@@AAA@AB@B@@@@CACBA@A@A@A@@B@@@@@@@AA@@@A@@@@B@@@B@@@BAA@@@BA@@@@B@@@BBB@@BD@@@B@@@B@@@B@@@@AB@@AA@@A@A@ABA@@A@@@@A@@B@BA@@@A@@B@@@B@B@@AB@@AA@@A@@@AB@@@@AA@@AB@@A@@@A@@@@@@@@AA@@@@@@@AB@@@@A@@@A@@@A@@@@@AAA@@@AAA@ABA@@A@BADA@@@@AA@AA@AC@@BADAB@@@AA@@EGC@@A@AA@BA@A@@@@@A@@@@@@B@@@B@BA@@@@AA@@@@A@A@@@@@A@@@A@@@@@C@@AA@@@@@@A@A@@@A@AAA@@AA@@BA@@@A@@@A@@A@AAECC@@@AA@@@@@A@@B@B@@@@@@AA@@@@A@@BBB@@@B@B@BA@A@AAC@A@@@@AAA@A@A@@A@@@@@@B@@A@@B@B@B@@@BA@@@@@A@@AACCCAC@@ACC@A@@@@B@@AD@D@@@DBB@B@F@B@@@@A@@@@CAA@AAAA@@@@@A@@@A@@@@AA@CA@@@AC@@@@@@DAB@@@@AA@AAAAA@ABG@@@C@@BC@AB@@A@@AEA@A@@@A@@@@DA@@B@DAB@B@B@BAB@@@D@@@B@@@@@@CAA@A@@BA@@@A@@B@@@B@@@B@@@B@@AB@@AB@@A@@@@A@@@E@A@A@@@BABC@@BA@ABACA@AAA@@AAA@A@@@@A@@@CAA@@AAA@@@AABA@@BA@A@@@A@AB@BABA@A@A@A@@@AA@C@@AA@@A@A@AACACAA@@@CAA@CA@ACAC@A@@AAAA@AAA@AAAAA@A@@A@AA@A@A@A@A@@A@E@C@ABC@@BCBABA@C@K@AB@@AA@@CA@ACA@@AA@@@AA@A@@@@@@A@A@AAE@AGAAACACCCECA@A@@BA@C@A@AA@@@A@CBEBGB@@CAA@ABCBA@CBA@@@C@@@A@A@CAAAAA@@@@A@@B@BAB@BA@AB@@C@I@C@ABG@@@EBA@C@GA@AABA@A@CBGDGDC@A@C@AAECA@A@@@A@@B@@AB@@A@A@AAC@C@A@CBC@E@EFAB@@E@@@A@C@C@IDAB@@ABC@@@ABAAA@@@CC@@A@CA@@A@AAA@AAG@G@EA@@A@@AACAG@@EAAAAA@@DCBC@ABC@@AAAA@A@A@C@A@@BC@@@AC@A@AAAA@@@@A@A@@AACAA@@@A@A@A@@AAA@@@GAC@@DBB@B@B@@ADABABABAB@BCBEB@@ABA@@@A@@@A@A@@BA@C@@BA@@BABABA@C@C@A@C@@@A@@B@B@BBDBB@@BD@B@B@@@B@@@DB@@B@@AB@@AB@@@B@BA@@B@B@BEHABA@@@A@EAA@GCA@@@ABCB@@@B@@@@AB@@@BA@@@ABA@@@@BBB@DBBBB@B@@@B@@A@CBA@A@AB@@@B@B@@ABA@@B@@@BA@AB@B@DAD@BB@@B@BBB@B@B@B@BBB@@@D@@A@A@CBA@A@A@C@C@EA@@A@ABA@AB@@A@@B@DA@@B@@@BA@@@AB@B@@@B@BA@@DBDA@AB@B@@AB@@@B@B@BAB@@@@@@@B@@@BAB@B@@CBA@@@AB@@C@EBC@@@C@@BA@@@AAC@@@@@@@BB@@@B@@AB@@@BA@@@ABA@@BA@@@AB@BCBE@CBA@AB@@A@A@ADAB@@EAA@A@@@A@ABEDA@E@EBC@E@@AA@AAA@C@C@A@A@A@A@@BAB@@ABAD@@@BAB@@@B@B@BBB@@@B@B@BAB@@@@BB@B@BAB@BBD@B@D@B@@@BAD@@BD@BABA@ABABA@A@A@@@A@ADA@ABABCBAB@@@B@@BB@@A@@B@@CB@@CBA@GBA@A@C@@@ABAB@@@B@@@BAB@BA@@BCBC@@BC@@ABD@@@@AB@@A@@@@BAD@@A@@@@B@BAD@@BB@@@BD@@@@BB@@@B@@BBBBBBD@@AB@BA@A@A@ABABA@A@@@AB@@@@@BA@BBAB@@@B@@@BD@@B@@@@@@@@@@AB@B@@AD@@B@BBBB@@@BD@FB@@BBBB@@@B@B@B@@@F@@@@@D@@B@@@@@@@@BA@@B@@A@@BB@@@@@B@@ABA@@B@@@B@@B@B@@B@@@B@B@B@@@BAB@@B@@A@ABCB@@@B@@@@@@AB@AA@A@@@A@@BADAB@@@@@BB@BBB@B@@@BA@ADA@@B@@@B@@B@B@B@@@@A@C@C@AB@@A@@@@@BBDBB@B@B@@@BB@B@@@B@B@@BB@@BD@@@B@B@B@B@BABCD@BAB@@A@@@AB@@B@B@@@BB@@@BB@BBB@@@@@B@BA@@@@B@DD@BBBB@@B@F@B@F@BB@@@@@BA@@D@D@B@B@@@DB@B@@@B@BA@@B@@BBDBBBDBDBJB@BD@BB@@BB@@AB@DABABA@@@@@@B@BA@@@A@@@A@C@A@A@@@A@@AA@C@A@@@AB@@AB@B@@@D@@@@@B@B@B@@@DADAB@D@BA@@@AB@BABAB@B@@AD@BBB@DBB@@DF@@BB@BA@AD@@@DCFCB@@@B@BAD@B@@@@FHBB@B@@@@CB@@A@AB@@@@@B@BBB@@BB@@A@AB@@AB@D@B@B@@@@BB@BEBA@ABBB@B@@DBDB@@H@B@@B@B@B@BB@@@B@@AD@@AFAB@B@B@BBBBB@FAD@B@B@D@B@@A@@@C@@B@BB@@B@BA@@@ABC@@@@BAB@B@@@A@@A@@@A@A@@@A@AACAA@A@@@ABEBADC@CBA@ABAB@B@@@BF@@B@@@B@@@@A@@@E@A@@DEB@@@@@@BBB@B@@@@@@AB@@@B@@@BB@@@B@BA@AB@@C@@@@BA@BB@@@@@B@@A@AB@@AB@B@B@DAB@BABABABABAB@BCBABCDC@ABA@A@@B@BBB@B@@@BADA@@DBB@BAB@@BDD@@B@B@@@@B@@@B@@@@B@BBB@B@@@@@@A@A@CB@@A@@A@@A@A@AB@@@@A@@AA@A@@B@@AB@@A@@BA@@B@@@DB@@@@B@@@@@B@B@@@@AA@@@@@@A@@@@@@@@BA@@B@@A@@@A@A@@@@@@B@@@B@@AA@AA@@@@@A@A@@@C@AAA@A@@@@DABA@@@@DA@@BA@@@A@A@@@@@A@A@@BC@AFAB@BA@@BABE@A@AB@B@BAFAD@FAB@BADA@@@AB@@@BA@@@A@@BABC@AB@BC@@FCDADAB@FAB@F@D@JBFBB@B@B@DAB@HBB@HBB@@@D@F@BBD@D@DABABADA@AB@NEBABA"],encodeOffsets:[[109693,35190]]}},{type:"Feature",id:"610303",properties:{name:"金台区",cp:[107.146806,34.376069],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CBA@@AA@AAA@@@CA@@CBG@@@C@E@A@A@A@A@C@A@EAA@AAA@AAA@A@@@ABA@CB@AA@CAA@A@A@A@C@C@E@ABCAE@@@ABC@ABADGHA@DF@@BF@BBB@@@@B@@BBBB@@BBB@BB@@BG@C@GBK@IBC@A@A@A@A@@@C@CBC@A@A@A@@@AAA@ECA@@@AAAA@AAA@EAAABABMFA@@BCBABABCBC@C@AAE@C@@@A@GAA@GAA@CBA@A@A@EAIAC@E@A@EBA@CBCBED@@ADA@@BADAB@@@B@@AB@@A@@B@@CBABA@EBC@EBABA@A@@B@BAFAB@@ABA@EB@BAD@@@B@B@@@@@B@B@@AB@@CB@@@@ABCB@@@@@BBB@B@D@@@B@B@@@@BBB@@BA@@@A@@@@@@@@B@B@@@BA@@@AB@@@@@@@@@B@@@@B@@B@@A@A@@@@@A@@@@@CA@@A@@@AB@@@BA@@BA@@@@BBB@@@B@@A@@B@B@BB@@@@BA@@D@B@B@@@@A@A@AAA@@@@@@A@@@A@@A@A@@@CC@AA@ABA@CA@@CBAB@@A@A@AAA@@@@BAB@BCDADABADA@ABABABABABA@CBA@A@A@@BA@@B@BA@@@@@A@@AAB@@@@@DA@@BABA@@@A@@A@@@A@@BA@@@@@@@AAA@A@@@@A@CF@@@B@F@@@B@@A@@@A@@@AE@@A@A@AB@BAB@DCDABAF@B@@@BBBBD@B@B@@@B@B@@@BB@@@A@A@AB@@@@AD@B@@ABA@@@AAA@@@@D@@@BA@C@A@A@C@EBA@AAAAA@A@A@EB@BC@@BA@@@A@@A@A@A@AA@G@@@CACA@@@AAA@@CA@@A@CDC@A@C@A@@BA@A@ABA@@@ABAB@@@B@@ABA@C@AB@@BB@BBBB@@B@@@D@@@B@B@@BBAD@@BB@@@BABCB@@DDBB@B@@@@@D@@BB@@B@B@BBB@B@D@BBB@@BB@@@@@@BA@A@@@@B@@@@DB@@@B@@CDAB@B@B@@B@@@D@D@@@BBB@@@BAB@D@FBB@@@@ABAB@@AB@@@D@DBB@DBB@BB@BBBBBBBB@B@B@BB@@BBBB@@@@A@@BA@ABA@@@@BB@@@BBB@@B@@@@@BABA@B@@@@@D@D@B@B@B@@B@@B@@@@@@@@A@@@@@@BBB@@A@@BA@@B@@A@A@@B@B@@@B@@B@@@@A@AB@@@@@BB@@BB@B@@@@@B@@@B@@A@ABA@@B@BBB@BB@@B@@A@@@@BA@@B@BB@@BA@@B@B@B@@@BB@@@@@ABA@A@A@@B@@@@A@@D@BAB@@@B@DBB@@@B@@A@A@@@AB@@@DB@@@@BA@AB@@@@BB@@BB@B@@@B@BABABCBA@A@@BBDBB@@@@@@@BB@@@@BA@@@@B@@BDB@@@@B@@@B@@B@@@@BABBB@@@B@@A@A@A@C@@@AA@@@BAB@@@@@@@@BBB@@D@B@@B@BB@BA@@B@@B@@@B@@@@@@@B@@@@@B@@B@@@BA@C@@@@B@BA@@B@BBB@@@BB@@B@@@B@@AB@BA@@@@@AB@B@BBDBBB@@B@@@B@@B@@BB@@@BB@@@@AB@@A@AB@@A@@B@@@BCD@D@@@@A@@BAAEA@@A@@BABCBA@@B@BA@@@A@A@@@@B@@@BA@@@C@@BA@@BC@@B@@@B@B@@BB@@A@@AAB@@AB@B@B@D@@A@A@AB@B@BCBA@C@ABC@@@A@@BA@@B@@@B@@A@@B@@@@A@@@A@A@@@A@ABA@AB@BADAB@DA@@@@BA@C@A@A@@@A@@BA@@@A@A@AB@@@@@@@@@BBBB@@BBBBB@BB@B@@BB@BBBBBBB@BD@B@BB@@BBB@BBB@BBFD@@B@BBB@BBDFBB@@B@B@@BB@BBB@BBBB@@@@@B@@@@@BB@B@@@@AB@AABA@@@@BA@@@C@@@@@A@@B@@@@AA@@A@@@@@@B@@A@@B@@@@@@@B@@@B@@A@AB@@@BBB@@@@@@C@@B@BB@@BA@@B@@@B@@@@@B@@ABAFCBC@@BCBC@A@@B@B@@@@ABCB@BB@A@@@@BCB@BC@AAA@@BEBA@@B@BBB@@@@A@@@@BA@@@CBABABABADAFEBAB@@@BB@@B@B@B@HB@@B@B@@@D@H@B@BAB@@A@@B@@@D@D@B@@@B@@@@@@A@A@A@@@@R@@AA@@A@@AA@AB@B@ACB@@@@AA@@@A@@CD@@@@A@@B@@@B@BBB@B@@@D@@@@A@@@A@E@@@A@@B@D@@@BA@@@@@C@@D@@@BA@@B@@@F@B@B@B@B@@@BAH@D@H@@@BA@@@@HB@@B@@@@A@EB@@E@@@@B@B@@A@A@@@@B@D@@A@@DA@@@@@A@A@A@@D@@@@@@C@C@ABA@@B@@@@@@EB@F@@AD@BAB@B@B@@A@@@ABAB@BA@C@A@@BABA@ADADCFABABAA@@@A@C@@@A@@A@@@C@CBAF@D@B@B@@A@@B@@AD@@@@@@C@@@@FABAFAB@DAB@B@@@DA@A@@@AA@@C@AC@@EB@@EBC@AC@A@A@CB@@A@A@E@ABE@EBA@AA@@C@AAEAC@C@EBA@C@A@C@A@CB@@C@C@C@A@@@A@C@@BA@ABA@@B@@A@ABA@A@A@C@C@@AGC"],encodeOffsets:[[109799,35180]]}},{type:"Feature",id:"610322",properties:{name:"凤翔县",cp:[107.400737,34.521217],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@G@A@AAAA@@CB@@BFA@@AA@@BABAGA@@D@@@BC@@A@@I@AAE@@@A@A@AB@@@@BF@@A@A@BBCBGB@@E@@@AEA@BCAA@@EB@BC@@@@@@D@@C@@@@B@BA@@A@C@@A@A@@@@AA@@@@@@@@BA@EBA@A@@@A@@@A@@BA@@DAB@BBDC@@@AB@@A@@BABA@@@A@@D@@C@@@@B@@A@C@A@@BB@CB@@@B@@@DEB@@EB@BA@@B@B@@ABC@@@BFDF@@@@D@@BBD@D@@@B@DBFB@@@DPIB@AC@ACIB@AC@AAQHA@@@@B@BBBBFA@BF@FFABH@FC@IB@BAB@@@BGB@D@@C@@BC@@@@@ACIBAAC@E@@@@BI@A@@AA@AAA@ABCB@@@DGBB@ABC@AAC@A@@BC@@BC@CB@BAB@@@@@BC@AB@BA@GBA@KBAE@@C@A@A@@AC@ACG@ACI@@E@@A@ACA@@@GBA@@BA@CB@CA@QDA@@CAAABAAACACACCBAA@@A@AEA@@@CEE@CC@AA@EB@J@BG@ABABCD@FAB@BABBB@@BBBBE@C@CCA@CA@@C@AC@@CBCAKG@AB@A@GEBAEEEF@BDDA@BBCBEFCAABA@ABDB@BEHEAEFABAB@@ADCB@@FBILABA@BAECCBAA@CAAA@@CCAGHA@@BB@CD@BICGAEAECEAAFADCBGHABEHA@@BON@@AB@B@@@B@BABADABCDA@@B@@CBA@@BAB@@ABA@@@ABAB@B@BABAB@@@@AB@DADCD@@CBAB@B@@@BA@@@@BABA@AB@@@BA@@@AD@@@B@@@@@B@B@F@@BB@BBB@@@B@B@@@B@B@BBD@@@BBB@B@B@@@@@@CBCD@@A@A@A@ABA@@@AAA@@@AAA@@@A@A@A@A@ABAB@BA@@BBB@@@B@@@D@@ABCBAD@BA@@@@B@@B@@@B@BAB@BBDBBBBAB@F@BADB@@BB@BABBBDF@BLNBDBB@D@D@@BB@B@B@HADAHAB@D@DBB@D@BADAFAB@D@N@D@DB@DBNFHFBBB@@@B@B@BBB@@@BBB@BB@@BB@@B@B@BBB@B@J@B@@@BB@@@B@@@@@B@@@@BB@B@@@B@B@@@B@BB@@BBBB@@@DD@@AB@@@@@BB@@B@B@B@@@@@B@@BB@@BB@@@B@@@BB@@@@BB@@@@BB@@B@@@@@B@@ABB@@@@BB@@@@@BB@@@BB@@B@@B@@@B@@@@@@@BB@@@@B@@@@BBBBB@@@BB@@@@@B@@B@@B@@BB@@@BB@@@@B@@B@@BBB@@@@@@B@@B@@@@@@@BB@@@@@@@B@@@@@@@@@@@B@@BB@@@B@@@@D@@B@@@@B@@@BB@@@@BB@@@@@@@@@@@@B@@B@@BBA@BBA@@@@@B@@@@B@@@B@@@@@@@@@@@B@@@@BB@@@@@@BB@@@@B@@@BB@@BB@@B@@BD@B@@@@DBB@BBB@BBBBDBB@B@B@B@@A@@@@@@B@B@B@@@BBB@B@@@D@@AB@B@D@D@B@@BB@B@@@BA@@@@B@DBB@B@BD@@B@B@B@D@@BB@@@B@@BBB@B@B@AB@BB@@BBBBB@@@B@B@BB@@@B@@@BBB@B@BBD@@BB@BBB@@@B@@@BA@B@@B@BB@BD@BB@D@B@BA@BD@BBF@BA@ABCFA@@@C@A@@BA@@B@@A@@B@@AB@@@@BBD@B@@@D@B@D@BDDBB@@@B@@CBABA@@@A@@BABABABABBB@@AB@@@@AB@D@B@B@@@BA@@@@A@AAA@@@AA@A@@AAAAAA@A@AB@@ADA@@@AA@@A@AA@@AA@@ABC@@BABA@@BA@@BCB@@BB@@D@@@@BBB@@BBDB@BB@B@BAB@BA@@BB@@@@@B@BABA@@@@@B@@BBBBB@@BB@@B@BBB@BBB@@@B@B@@@B@BB@B@D@@@@@BA@A@@@@BAB@@@@@DABAB@@@BB@B@@@@@D@@@@DB@BBAD@@@@@B@@@BBBBBB@@DD@@@@B@B@BC@CB@@ABAB@BC@@BA@@@ABABA@A@@BA@@BA@A@@@AA@@AB@@@AA@@@@@E@AB@BA@@@ADA@@B@BBB@B@BBB@@@B@@@BC@@@@BBBB@@BB@BBBBBBBB@BBB@@@@BBBBBBDB@@@ABAD@BBB@B@@@B@B@BA@@@A@@BA@@@@BAB@@BB@@B@FDB@B@@@@ABA@@B@H@B@B@@@FBB@@@BB@BB@@@@BB@@BB@D@B@BAB@BAB@B@B@B@BB@@BBB@@@@A@AB@@ABABADA@@B@@@BBB@@B@BDD@BB@@BD@DB@AB@@ABC@@D@DAB@D@B@@A@@@AAA@@A@@A@@AA@A@A@@@AA@@@B@@@@@FDB@B@@A@@@@CA@@BA@@@AD@BBF@FA@@B@@@BBBB@BB@@@@@AA@@BA@AAA@A@A@@@@BABA@@BA@@B@B@@BBB@BBBB@D@@@D@@BB@@BBBB@@@BA@@LE@@BB@@B@BB@@ADABADA@@D@@AB@@AB@B@@@BABA@@BB@D@B@@BB@DD@@@@BABABC@@@A@@B@@@@@D@@@DBB@D@B@D@B@B@@@B@BBB@B@@@DAD@@@@@BBD@@@@BB@@B@@B@B@BBBBB@BBB@B@BAD@@@BB@@BA@@BBBBB@B@BAD@BBB@BAB@DAB@@@B@DB@@D@B@B@@@BABAB@B@BAB@@@BA@A@ABA@@@@D@B@@@BB@@B@@A@A@A@A@@@C@@@AB@B@D@B@B@B@@ABA@@@ABC@ABABA@@AC@ABA@ABABA@@@@@@@@@@B@@@DB@@@BB@BDDD@@B@@AB@@A@A@@@@BA@@@E@@@A@@BA@AB@@@B@BAB@@@@C@@@@BCDC@C@A@@@A@@@ABA@ABABB@@BABC@A@@BCBA@@DA@@@BB@B@BAB@BABABABA@AB@B@@@@@@BABCD@@@BB@D@B@B@B@@ABABC@ABCBC@@@AB@B@B@@AB@@A@@@A@AA@@ABC@CBCBA@ADA@@@@@A@@DEBCB@@@B@BABA@A@AB@@A@@@AAA@CBA@AB@FA@@@@@ABE@A@@BA@@BA@@BBB@BA@@BA@A@@@AAA@C@@AAA@AAA@@AAABC@A@@A@@B@@@A@@BC@@AA@@@A@@@@@A@@@AAAA@@@A@@BE@A@@@AA@@AAAA@A@A@@@@@C@@@AB@BA@A@@@AAA@@AAAA@@@A@ABA@CBIBE@G@A@A@@A@A@A@AAA@AAA@A@AC@A@@AAA@@@A@@@ACAA@AAA@@A@@@A@@@A@@AA@@@A@@B@@A@A@@AAAAA@@AB@@A@@@A@@@@B@B@BA@AB@@@A@@C@@AA@AA@@A@A@A@@A@BAB@B@D@@@BAA@AA@@A@@A@@B@@@BBB@B@@@@@@AAAA@AAC@CA@@@@@A@A@A@A@@@@@BBBB@@AB@@@@GCCAC@@A@AA@A@@@A@CCA@@AAC@A@@@A@@BA@@@@@A@A@@B@B@@@B@@@B@@@B@DA@@@@@@@A@@@@@@BA@@@AB@@@DC@@BAFBB@D@B@B@BBB@B@@@@@BA@ADA@ABABA@@@@DA@@@A@@@@BA@A@@D@@@@@B@@@AD@B@BB@@@BABA@A@@@A@@@ABA@@@@@A@@BA@@B@B@B@B@@@B@@@AA@@A@C@A@AA@@@@B@@@B@@@D@DABB@@@BB@@@@B@B@@@DAB@@@@B@@@DBB@@@@@@@@A@@@AB@AAB@@C@@@@A@@@A@@A@C@A@@@A@@@@@@@AAAA@@A@@A@@@@@@@ABAB@@A@@@@B@@CB@@CAA@@@@A@@B@BABA@@@ABA@AB@B@@@@@AA@@@A@@@@BABA@@@@A@CAA@@@@AB@@A@@@AA@@A@A@ABA@ABA@A@C@C@C@@A@@@@@A@@@@@@AE@@@AA@A@AA@A@A@EBA@GAA@@@BC@A@A@@@A@@@AA@@AA@@@B@@A@@@@CCCA@@A@@@A@@@@AA@@@A@IBA@A@@AA@CCAACA@@A@@@@AAA@AAE@C@CA@AA@A@@A@@AA@@EA@@@@@@@AC@AB@@ABA@@@A@@@AA@@@BAB@BA@@@A@A@@B@@A@A@A@@@E@@@ABCBA@@@@@AAA@@@A@@@AD@H@DA@@@@@@AA@@@ABA@A@@@C@@@A@@@@A@@A@A@A@@BA@@@ABC@@A@ABC@CBA@@AA@@A@@A@@@BA@@@@AC@@@AB@@@@A@@@A@@A@@@@BA@@@A@@@@AAA@@AAA@@@AA@@AAA@C@A@CB@@A@@@@@@B@@A@AA@@@A@@B@@AD@@@@@@A@AB@B@BA@@@@BA@AACB@@@@@@@@A@CAE@A@@@@@@D@@AB@@@@@@AB@B@@A@@BADBBA@@BA@C@A@C@@@A@@@@A@AA@@@@C@@@@@@@G@A@@AA@@@@A@@@AA@@AAA@@A@@A@@@@@AB@BB@BB@B@@@BA@@A@@A@@@@@@@A@@@@@AB@@@A@@@B@@@@AA@@@@@@B@A@@@@@A@@@@@@AAA@@@A@@@@@@A@@@A@A@@@@@A@@@@AA@A@A@@@@@@@@@@BAA@@AB@@AB@@BB@@A@@BAEUE@A@@AC@A@@A@A@CC@@B@@AA@AB@BBBAAAB@DABFBA@@AA@A@AB@@@@A@@B@BAB@B@@@AC@C@E@@C@@A@@F@@@@CFCAC@@B@@BD@@AD@@@FA@@A@@@@C@A@A@@C@@C@@@@A@@CA@@AB@A@@@@A@@A@C@@@@A@AAA@@C@"],encodeOffsets:[[110148,35171]]}},{type:"Feature",id:"610304",properties:{name:"陈仓区",cp:[107.369987,34.35147],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@@C@AA@@@A@A@@A@@AAAA@A@A@@@G@C@CBABAAA@CAABEAA@ABC@AAA@KCG@CCAAA@AAA@A@A@A@@ACAE@A@@@ACC@@AAA@@A@A@A@@@A@@@@@@E@@BA@AB@@AACAA@@@A@AA@A@@AAA@A@@@CC@@A@A@A@@@@AC@@AA@C@A@A@@@@@AACAA@@A@A@@@AA@CAACAAAAAAA@@@@@@BCD@BABABA@E@A@CAA@A@A@@@A@AB@@A@A@ABA@@@@AAA@AAAA@@AABA@@@A@@ACCA@GA@@AC@C@A@AA@@A@@@A@AA@@@A@@@A@CCA@AA@@GAC@A@AB@@AB@@@BA@A@A@ABA@ABCDCBA@CBCDC@@@@D@B@B@@@DA@@@@BC@ABEB@B@B@@ABA@CDE@@@AB@@C@ABA@@B@@@@@B@BB@@@ABC@@BA@C@A@CBA@A@A@A@@@A@@BCA@@A@@B@BA@A@@@AD@@AFAB@B@@@@A@A@@@CB@@A@AAAAA@CAA@CC@AAC@@@AA@AAA@AAA@A@CAAAAAE@A@A@A@A@AA@@A@@@ABA@@BA@A@@BA@@@AA@@@@A@@@AB@@@BBDAB@B@BA@@@ABAB@@A@C@@AA@@@A@G@@AA@A@CCA@A@@@C@@@A@@A@AA@@@A@@@AA@A@@C@@@CD@@AB@@A@A@C@@@@AA@ABAB@@A@A@A@A@IDAB@@@BA@@@A@A@A@@@A@A@CBC@A@E@@BA@@@@@@B@B@B@BBBB@@@@BBBBB@BBB@BBBBB@@@BBDBDB@@DBB@D@@BBBDBD@B@B@BB@@BD@B@@B@@@B@B@BABABA@@B@B@@@BAB@@ABBB@@B@BB@@BB@D@@@B@@B@B@BB@@BB@BDBAB@BAB@@ADAB@@B@B@F@@@B@@@@@@BA@@BA@@BA@@@A@@@A@@@A@@@@B@@AB@@@BBB@D@@@@A@@@C@@@A@ABA@A@A@CBA@@@CB@@@@@B@@@BFB@BB@@@BAD@@AD@@@H@BAB@BBBBB@@B@@A@CB@@@@@@BD@@B@@DBB@@@@@B@@@B@@@@BBBBB@DB@@@@@B@@A@E@A@A@CA@@C@C@@BA@@@@@@BDD@BD@DBDDBB@@@B@@@@AB@@A@A@A@@@@BA@@@@@@@@BB@B@B@BB@@@@@BBD@B@BABA@A@@@A@AA@@@B@@B@@B@@@@A@A@@@@B@@@@BB@@D@FDBBB@@@@B@@@B@@AB@@BB@@BB@B@B@@@B@B@@@@B@@BD@@@@@B@@@B@@@@@B@B@@@@@BB@@@@ABA@@@A@@@@@@@@B@@@@@BABB@@B@BD@FH@@BB@@A@CBAB@@BDB@@BBB@@@@CBABB@@@AB@BBB@B@@BB@B@@@@@B@@@B@@@B@@A@@B@@@@@@BB@@@@@@@@@B@@@BA@@BB@@B@@A@@B@@@BB@@BA@@BA@A@@@A@@@@B@@ABA@@@@B@@B@@@AB@B@B@BB@@BA@@B@@A@@@A@@@A@@@C@@AA@AA@@A@@@@@AB@@B@AB@@A@@@A@@@@@@B@@BB@@@@@@A@@@@B@B@BABBD@D@@A@A@@BBB@BA@@@@D@@@@@B@@@BBB@@AB@@AAC@A@C@CBABA@@@EBA@A@C@@@@BAD@FA@@FD@@B@DB@@B@@@BCB@@A@A@CBA@EBABEB@@@@@D@@@@C@@BA@@@@BA@A@C@E@AB@D@D@@@BB@@@D@B@@@B@ABABEBCDCB@BABAB@@@B@DABA@AB@B@@@BA@A@A@ABC@@BE@A@@F@@@@A@@@AB@B@D@D@@@@C@@@@B@B@B@@@@CB@@@BC@A@@@@@@B@BA@A@@@@@@FA@@F@B@@A@@@GA@@@@AB@@G@C@G@AB@@A@A@A@A@E@@@A@@@AB@@C@@@@D@@@@AB@@C@A@@@@B@@@F@B@@@B@@C@@@A@A@AAA@@@A@@@@B@@C@@DB@@@B@@B@@A@BDA@A@@BBB@@@BB@@BQ@@@@@@B@B@B@@@@A@@@A@C@C@@@A@@@@BA@ABA@G@C@@@A@A@@@GAA@A@A@@@AA@@A@ABEFCBABABABAB@D@@AB@@@@@B@@A@AAA@@@ABAF@@BB@BADA@AD@@@@@BAAA@AD@B@@A@A@@@@BADAD@@ADEDAB@BA@@@@@A@@@A@@@AB@@AAA@@@@D@@@@A@AA@@A@@B@BA@@@A@@@@@@@A@@@@BA@@@@@@@@BB@@B@@A@@@@B@@@@@D@@AB@@@@ABBBA@@B@@A@A@@A@@@@@A@@@@AAAAA@AAA@@AA@A@@@AACEAAA@AAA@@@ECAAA@AAA@AA@@AAA@C@@AAAAAAA@AAA@@@AAAA@AAAA@@AAAA@@@@@@@@A@@B@B@B@@AB@@@B@@@B@B@DAB@@@@CBA@CBABA@@BAB@B@B@@@B@B@@@B@@A@@@@BA@@@A@@@AB@@@B@@AD@B@DABADA@A@@B@B@BC@A@A@A@@BA@BB@@@BA@@AA@A@@@A@@@AD@@AB@@@D@@AB@@A@@@@@@B@B@@ABA@@@ABADAB@@@BB@BFAB@@@B@@C@C@AD@@A@@@@BA@@B@BA@@B@@A@@A@@AA@@@AA@@@A@@@AACAAAA@A@@B@@@@ABA@@BA@@@A@@@AA@@A@AAA@@@ABA@@@@@@DAB@@A@@@@A@@@@@A@@@@@@@A@@@AA@@@ABA@@A@AA@C@@@AA@A@@@@@@A@AB@@B@@B@@@D@B@B@BA@@@A@AAAB@@@@@AA@@@A@@@@@CA@AA@@@@@AB@@@@AA@@@@@@A@CAAA@@@BABADABABA@@@A@A@@AA@@A@@A@@BAB@@@@CA@@A@@B@@@B@BA@@@A@CAA@@@A@ABC@@@@B@@A@@@@B@BAB@B@@@@AA@@A@A@A@@@AB@@AAA@@@AB@@@@@BA@@@AAA@AAA@@@AB@B@BA@@@A@@@@@A@A@@AA@@A@@@@BAB@@@@@@AA@@@A@A@@@@B@BA@@@AB@@@BA@AA@@@@@@@B@@@@@@A@@@@AA@A@A@C@C@@@@@A@B@BA@A@@@@@AA@AA@@A@@A@@B@BAB@@AB@@@@@AAAA@@AAA@A@A@AAAAAA@AAAA@CAA@CAC@@@A@@BA@AB@B@@A@EAC@A@AB@@A@AA@@C@C@@@A@@@@A@ABADC@@@A@@CA@@@@@A@@B@B@@A@@@@A@@AA@AAC@A@A@AAA@A@@@AA@@@C@@@@@AAACC@@DABA@A@@AA@@BCAA@@@A@A@@@C@@@AA@AA@AAA@@BAD@B@BA@@@A@@BABA@@B@BAB@B@@AB@D@B@D@DCB@@@DB@@BAB@FA@AAA@@@@@A@A@CBA@@BAB@@@AA@@AA@A@A@@@@BAB@@@DA@@@@@AAAEG@@@@@ABC@A@A@@DADE@C@@BCB@@AAA@@CE@@AA@CAA@ABC@@@ABABA@ABA@@B@@A@CBABC@C@@@A@A@A@@@@@C@@@ABA@@BA@@B@D@B@@BB@@@B@B@D@B@@@B@@@B@@A@A@@@@B@BABA@CBA@@AA@@AAC@@AIACACAAACAAA@@@AB@@A@A@@@ACA@@A@A@C@C@@@AB@@@@A@@A@E@A@E@AA@AA@ACCA@@@@@ABA@@@@@A@AAA@@A@@AA@@A@A@@@BA@@B@@@BA@ADCBA@A@A@A@A@A@@AC@@AA@@@A@A@@@AAA@@A@A@A@CAAA@@@@B@@@BAD@D@B@@@@@@A@A@AA@@@A@@@CB@BAB@@A@A@AAA@@A@@@@BABC@AB@@@B@B@@BBA@@@@@@@A@@DABAB@@@@AA@AB@@A@A@A@@@A@@@@A@AA@@@A@@@AB@BA@@@@@A@@AB@@@@AB@@A@@@@@@A@@@@C@@@@@E@@@A@A@A@@AAAA@@EAC@@A@@AAAAA@@@BC@@@ABA@@@@@@@@@@@AC@@A@@@A@@BAAAB@@A@@@@BA@@B@B@BABAB@B@B@@ABA@@ACAAAA@AA@@@A@@A@@C@@A@@AA@@BC@A@A@@B@@@BC@A@@B@@@BA@@@@ACC@CAAAAB@@@@C@@@C@AACAA@A@@@A@ABCB@@C@AAA@A@A@ABCBAB@@C@A@AAC@@@@A@@@A@@@A@@@A@AAA@@BC@@A@@@@A@@@A@AB@@A@@AAAA@A@A@A@A@@@@A@@@ABA@A@@B@@@BA@AB@@A@@@@AAAA@CAAAAA@@ABA@ABAB@@@BAB@B@@A@EDED@@@BA@@@A@A@AAC@@@A@ABCD@BABAD@B@@@B@B@@@@A@@@A@G@A@@@A@@@A@AAA@C@C@C@@@A@@@A@A@AA@@C@@@A@A@@@AA@@@@@BA@ABABABA@AB@B@BA@A@@@A@C@A@C@@@AAA@@@ABABABAB@@@@CA@@@CAAA@@@A@@@A@ABA@AAA@CB@@AB@@CB@@A@AB@@A@A@@@AA@@@AAE@@@AAAB@@@CCAAAACA@@A@A@A@@AA@@A@@A@@@AA@C@@CA@A@A@A@A@EAC@@C@EC@@A@ECEAA@A@A@@BABABABCB@@C@A@A@@AA@C@A@CB@@@BA@@BA@A@CBEDA@@BA@A@E@A@@@KLBDDHBDDFAL@HCFCJCFCHCDEHCJBFDDH@LFJBHBFDBFDH@DAJ@B@DBD@F@DAAA@ABABAHABA@A@C@AAAACE@@CA@@GBCBAD@B@DABA@ABA@CA@@@@A@AAAA@@A@A@A@A@A@@BBD@BB@@B@BABCBABA@AAC@CAA@A@CBAAAAC@@@AAABA@CDCBADABCDCBABA@A@C@AAA@A@CBA@A@A@A@ABA@@BAB@BBB@BDBBB@B@@@BABABABA@A@A@AAA@AAA@@BAB@B@BABABA@ADAB@@@F@DABADAB@B@BBB@DBB@B@B@B@@ABA@C@A@@B@@ABAHAHAB@@@B@B@@@BB@@@DBBBBB@B@@@BABA@AA@@A@@A@AA@@@A@@@AD@D@BADABAB@@C@AAA@@AA@@A@AA@@AAA@@AAA@@BABAB@DA@ABA@ABCAA@@BA@@B@BBBDD@@BB@@@@B@@BB@B@@@@@B@@@BA@@B@B@@BB@@B@BABABCB@@A@CAA@GA@@C@A@A@AA@@@@@@CB@BA@@@AB@@@BDBB@@B@B@@ABCBA@ABA@ABA@AAC@CAA@ABCBAB@@@DBB@B@@B@BBB@B@@BB@@B@@@@@BABA@EAAACA@@A@A@@@A@@@A@A@@@A@@B@F@DAB@@ABA@CBA@CAC@CCAAAAAA@A@A@@@AAA@AA@AA@@A@CBA@@B@B@B@BBBDD@@@B@B@BCDABCBA@CBC@E@A@AAA@@@A@ABCD@BA@A@ABAAA@A@ABA@@@ABAD@DADBB@B@@@@@B@@@B@@DB@@@B@B@B@BA@C@A@AAAAA@C@C@C@ABA@@BA@@@C@A@@BABA@ABC@A@AB@B@B@BAD@B@BA@ABA@A@@AAAA@CAA@C@EBE@ABA@@D@B@BBB@@ABA@ABCACA@AA@@A@C@A@@AAA@A@A@@BA@@D@BDBBD@@@BC@CBA@C@AAACAEAC@@CAA@CBCD@@C@CBABC@C@EAECCAA@A@C@A@E@A@ABC@CAA@CAC@A@@AAA@ACCAA@A@A@CAAAAAAEAC@E@A@@@CBA@ABA@@@@B@DADBB@B@BABCBC@A@A@@@AAAAA@ACCCCACAAA@@A@C@@@A@ABCBEBCBCBC@ABA@ABC@A@@@AACAC@A@A@C@@@A@ABA@@@A@@@@@@B@@@@@B@@BB@BB@DD@DBD@BDDBDBDBDAB@L@BABCB@BABBDBB@D@B@@BBB@BB@B@D@BCFAH@BABEBEBAB@BABCBCBEBG@ABCBC@C@A@AB@FJFFFBBBBLDFDJBNFHDJD@@HDHDJDFD@@FHBBBDDDDDDFHDDB@@FAFA@@BBBBB@B@B@@@DJBBB@BBBD@BB@DB@@BB@AB@HAB@DBB@BBDDDBBD@DAB@BBDDLBBBAD@DCBADCBABADADCBAB@BAB@@A@A@ABA@@B@B@BBB@BA@A@A@AF@FBFBHB@@BAD@FAJCBAB@@@@@@B@@@@@B@@@B@@@@@B@@A@@@@@A@@@@@@@A@@@@@@B@@@BAB@@@@@@@B@BB@@B@@@@@@@@B@@@B@@@@@@@B@@@@@@@BB@@@@@@@@@@@@@B@@A@@@@B@@@@@@@@BB@@B@@@B@@@@B@AB@@@B@B@@@@B@@@@A@A@@BBB@@@@AB@@AA@@@BA@@@@B@@D@@BB@AB@@@@ABB@@BB@@@B@B@@@@@@@@@@@@A@@@@B@B@D@@@D@B@@@@B@@@@BB@@BA@@B@B@B@B@@@@AB@@@@@@@@B@@@@@@D@@@BB@@D@@@@@AA@@BA@@@@@@B@B@@@B@B@@@@@BA@@@@@@@@A@A@A@@@@A@@@@B@@@@A@@@@@AB@@B@@BA@@B@B@@@B@@@@@@A@@BA@@@@@B@@@B@@B@B@H@@@B@@@@A@@A@@AB@B@@@@@@@BBBB@@@@B@@@@@BA@@@A@@DB@AB@@@B@@A@@@@B@BA@@@A@@@AB@@@@@@B@@@@B@@@B@B@@ABAB@BA@AB@BAD@DA@@@@B@BAB@@@BCB@BAB@DA@@BA@@BAD@B@@ABADAB@BAB@BA@@CAAAAA@AACAA@@CA@@@@BAB@BADEB@BCB@D@@@BA@A@@@AD@BABA@@B@@B@@BBBB@BB@DB@BBB@@@BDBBBB@D@@@BDB@DB@@DBB@B@BA@@B@BB@@FFB@B@B@B@D@BA@@@@@@B@B@B@BAD@@AB@@@B@B@D@@@@@B@B@BBB@B@@@DBBBB@B@@A@@@@BA@@@AB@@@D@@@B@BC@@B@B@B@BABADA@@BCB@@AD@B@@A@@@AB@@AB@@@BA@AD@BAB@@@B@BDB@@@@@BABC@@@@DB@@BA@@BA@@B@@@BAB@@BB@@@FADB@@BB@BD@DBBBBBBBDF@BB@@B@@B@BABAB@DAD@BA@A@@@A@@@A@E@C@@BA@@B@@@@@BBDD@BB@BBD@BBBDB@@BB@B@@@B@@B@@@@B@B@@BDD@@@B@@ABAB@@A@@@A@@@ID@@@B@FAB@B@DABBD@D@@@BBB@BB@@B@@@H@B@BB@B@BADA@@B@B@B@B@@@B@BB@@@@B@B@BAB@@BBB@@BB@@BBBB@B@B@@B@BBB@@@B@B@@@B@@@@@@A@AB@@AB@@A@@@@@A@A@A@A@@@ABAB@@@BAB@BABA@@BABABC@@BA@@BAB@@@DB@BB@@@B@B@B@@@@ABABA@A@@@@BCBC@@BA@AB@BAB@@A@A@AAC@@AA@A@A@AB@BA@A@@@@@A@@@A@A@@@AB@@@B@@ABC@A@@B@BAB@@AB@@@@@BB@@B@D@BB@@@BBB@@BB@@B@B@@@D@B@BA@@B@@@D@@@@@@@@@@A@A@E@@@A@A@@@A@ABA@ADADA@@@@B@@@BB@BB@@@BD@BB@@@@BAB@BB@@BB@@B@@@B@@BB@@B@B@BA@@DBBBB@@@B@@A@@B@DBBB@@DBBD@@@@DD@@B@D@B@B@D@HA@@BA@@D@DA@@BCBCBA@@@A@@DA@@BA@CBA@A@@D@@@B@DA@@BCBABA@ABAB@@AD@BA@AB@DADABAB@BA@@@A@@B@D@@@BB@B@D@@@BBB@@@B@BA@@@DF@B@@CF@B@B@B@@B@@B@@@B@DABB@@B@@@B@@@@@BBD@@D@@@@BB@@B@@@BBB@@@B@@@BA@@B@B@@@BB@@B@B@@@B@B@@ABC@CB@@ABA@@@A@A@AB@@@@@BAB@@ADA@CBA@A@@BAB@@@B@@@BB@@B@@@@ABAB@B@@AB@@@@@BB@BB@@B@BA@@@@B@@@@B@@@@A@@DBBAB@@@B@@@B@B@B@B@@@@BB@BBB@B@@@B@@@B@@@@DDBBB@@BBD@D@@B@@BBB@B@BA@@BABAB@BBB@B@@@B@@@B@@@B@@ABB@@B@@B@BB@@@D@@BBBBABB@B@@B@B@@BB@@BB@B@BB@@B@BAB@@@@@B@B@@BB@BD@B@B@B@BBB@@BB@@BBB@B@@ABBB@B@@BBB@BBB@@BBBBBDBB@@@F@F@B@@BB@BB@B@@@@B@@BB@D@B@@@F@B@@B@@B@@B@B@@@B@BBB@@B@BB@@B@DCB@B@@@@B@B@@BB@@@B@@@@@@@B@B@@@B@B@B@@@@@B@B@@A@@@@BB@B@@@@B@@B@@B@@B@@@B@@@BB@BB@@B@@@@@BBB@B@@AB@@@@BBBD@@@@BAB@@@B@B@BAB@@B@@@B@B@B@@@@BB@@D@FAB@DA@@@@@AAA@@@@@B@@@@@BCA@@@@A@@A@@BA@@BAB@B@B@B@B@DBBB@@@@@AAA@@AAB@@@@@@A@@@@A@@A@@A@@@@ABA@@@@BAB@BAB@@AB@BC@@BA@@D@F@FA@@@@@A@A@A@@@@B@@EBA@@B@B@@@@AB@B@B@B@@@@@BBBBB@@@BBBADCB@B@B@B@@@B@@A@@@C@@BA@AF@FBB@BB@@@@@@@@B@@@@@AA@@BA@@BA@@@AB@@A@@B@B@BABAB@DADABAB@@A@@@A@@AA@AA@EA@AAAAAA@@AAAB@@@@ABCB@BA@ABABA@AD@DA@AB@D@B@B@B@BAB@BAB@@A@C@@@A@A@@DC@@BA@A@@@A@@@A@A@@BA@A@@@A@AB@@@@ABAAC@@B@@@B@D@B@D@BAB@BAB@BAB@B@@@B@DA@@BA@@B@B@@ABA@@@E@A@@BA@@AC@A@A@C@@@ABA@@@@BADCB@BA@@@A@A@A@A@ABA@AB@B@FC@@B@@@DBB@@@F@B@B@BA@@FB@@B@BAHA@AD@@AB@BA@@B@@@BABA@@B@@@DABA@@B@F@B@B@@@BA@A@@BABAB@@@@AAAAC@@@A@A@@DC@@@@BA@@@A@@@ABA@ADA@@BA@@B@@@BB@@B@DAB@@@@ABABA@AB@B@BABAB@B@@@B@@@BA@@BAD@B@B@@@BABABC@@B@B@B@B@BA@C@@B@B@@@B@B@BA@@B@B@BAB@B@B@B@B@@ABA@@B@@ABAB@@AB@@@DA@AB@@@@AA@@AA@AA@A@@@A@AB@DABAB@BABA@A@A@@BA@@@@FC@@@@@ABA@CB@@A@A@@B@@@BC@A@@B@DB@BB@DBB@B@FDB@BB@@B@B@BBB@@@BAB@@AB@@B@@@@B@@B@B@D@@@B@@@B@BABB@@B@@@@D@B@B@@@@@@D@B@B@@BBDBBB@AB@@@@A@A@AB@B@B@B@@A@@@@@@B@DD@@D@D@B@@AB@@@AAAA@A@@@@B@@@BBBB@@B@@AB@@@@A@@ACAC@@@@CB@@ACA@@A@@@A@A@A@A@ABA@@DABCBABAB@@A@@@@B@@@D@B@D@BBD@BAB@@@@@BBB@B@D@B@BBB@BAB@D@B@DBB@B@D@FAB@@@JB@@BC@G@A@AAA@@@C@CAAACKM@ACEAABA@AAA@@CAABE@A@ABAACAAAA@ABA@@@A@@@@A@@B@@ABCDABA@@@C@@@A@@AA@AB@@ABABAB@B@B@B@@@B@BB@@B@BB@@B@BAB@B@B@@@DCDA@@@@@@@A@AAA@A@@AC@A@A@A@@@A@A@@AA@AAA@@@E@A@A@@@@@A@@BC@@B@@A@@BAB@BA@A@@B@@A@@@ABADA@@DCBC@CBA@@@@BABA@A@ABABA@@B@BA@@BA@AB@DA@@@AB@DCBABCBA@A@A@@@ABA@@PM@AB@FGBAHGDABCBEFBFDFBHBJD@ADCA@@AB@HGDB@DB@BB@DBBDAFDABB@BAJKEA@@DABC@@BABAFEFBFG@ACABAB@BADBFEDAAAB@CC@AFEFFABHFB@A@@BLHDBDA@@BDD@@@DBB@DDD@F@AAAA@@AABA@ABA@EDCBABAH@@A@IFAB@@BDDF@DF@@B@BFB@@@BBDABDBDBDBBBABB@DB@RCB@@DDAB@@AB@HA@@B@BDB@@@@FJ@BDH@BDD@@BB@B@D@@@BFLAB@HAB@@ABAD@@A@@@@BA@ADAD@@AD@@AB@D@BBD@BAA@HA@C@@DABAB@BBB@@BB@J@@A@@F@D@BBJABD@@@@D@@AD@@@@CHA@A@@BA@AJAD@@EAGEB@EAEB@AEAA@A@A@@B@RGBBD@@BJABDD@@BJACO@@A@AE@C@A@@@CAC@AC@@@@@CEAE@@D@BA@@@A@AB@@AFA@@FA@C@@@A@@DAA@@AB@D@B@@@@A@@D@@@@CB@@@B@BA@AB@@@BA@@D@AC@ABA@CB@@AB@@@B@@@B@B@FAB@@A@@@@@@B@@B@@B@B@@@@D@BB@@A@A@@D@@@@C@@@@D@@AFA@@BBADB@BF@@F@@@HADAAAB@B@@@AE@@@@BAB@B@@@F@BBJ@@@@BD@@A@@@CB@BHBA@AB@@BB@AE@@DA@@BBBBB@H@@@D@BAD@@AJAAEB@BB@@F@B@BAAG@GA@@A@A@@B@@@@A@@@@AG@A@CB@@@@A@A@@B@DAD@B@@@@@@C@@@A@CB@@@CQCM@ABA@A@@BA@@FB@AAE@@@I@@A@CBA@@AACBC@@@@@A@@AA@AD@AEAACB@@A@A@@A@@@@@@FAAA@@DA@@AAA@@A@@CB@AAC@A@@A@@AA@@AB@@A@A@@@@D@@@@A@AB@D@@@@A@@GB@@AA@@@@BAB@@ABA@@@@@A@@@EAAA@@A@@B@@@@A@@AC@A@ABA@AE@B@@A@AA@@BAB@@A@@@@@AB@@BB@B@B@B@@@@A@@@@@AA@@@A@A@A@@@@@ABA@C@@A@@@A@@BAB@@@AA@A@A@@@@A@@AA@@A@AAB@@A@AA@@A@@A@@@A@@A@@C@AAAA@AACAC@A@A@@AAAA@@AA@ABA@A@@@@@@A@@@@A@@CA@@@@AA@AA@"],encodeOffsets:[[110131,35041]]}},{type:"Feature",id:"610323",properties:{name:"岐山县",cp:[107.621053,34.443459],childNum:1},geometry:{type:"Polygon",coordinates:["@@AE@A@@@@@@@C@@@A@@@EA@@AA@@AA@AAA@A@@@@C@AB@@AB@@@@C@@A@@@@C@@B@@@BA@A@@@A@@B@B@D@@@@@BA@@@ABE@@@@D@@@B@@@B@@C@@B@B@@@AAA@BA@@@@@@@A@@@@B@@A@A@@@@@E@@AA@CB@BB@@B@@@BA@A@@@@@E@@B@@@@@@@@@B@B@@@@C@@A@@AA@@@@E@C@G@@D@@A@@B@@@@C@A@@B@@@@@BA@@@@@@A@@A@A@@@@@@B@@C@@AAD@@B@@B@@@@@@AB@@C@@D@@A@@DAB@@@@@@A@AAABC@AA@@@@@A@@CA@@@A@AC@@@@@@@AACAA@@A@@A@AA@BAB@BA@@@@AC@@@A@A@@@@A@@@@@AC@@C@@AA@@C@@A@@@@@AA@A@@@@@ABA@@@BBA@@@@@CAKACAACI@AC@@A@@AA@@AA@A@@AACC@ACC@@@AAA@A@A@@@AA@AA@AA@AAAB@AAA@AAC@A@C@@AA@AAA@AA@AAA@AA@@@ACAACA@@CB@@ABADE@@@A@@@@AAAA@@A@AAA@@@@@@A@@A@AA@@@@@A@A@@A@@@@@AA@A@@A@@@AB@@A@@@@@A@@@A@@@AAA@A@@AA@AAA@@A@@A@A@AA@@AA@@@A@@@@AA@@@BA@CBABA@AB@@CBABKFA@A@A@@@AA@AAAAC@C@@@@A@@@C@A@ABA@CB@@AAA@@@@@ED@B@B@@@B@@@B@BAB@BADAB@@CBCDA@@@A@@D@@EB@@@@@@B@B@@BB@BD@BBBBBBF@D@@CB@BAD@@@@A@A@C@A@A@@ACA@AAAA@@AACAA@@A@@@A@CDEB@@C@C@A@AA@@A@@A@A@C@@@A@@@@@@AB@@@@C@A@@@A@A@GA@@CCECCCAA@@@@AB@@@BAB@D@@A@@BAB@@AB@@A@A@@@AB@@@B@@@@@B@@@B@B@BB@BB@@DD@B@@@BBFBD@@@B@B@BBB@BBBDB@DDH@LBB@BAD@BBBAFBB@DBBABAB@D@D@H@@@B@BBBBB@@@BB@B@@@B@@B@D@@@@@@B@@BBB@@@@DB@@@B@@B@@@@@@@@BAB@BBB@@BBBB@@@B@BBDBD@BBBBBD@@@@BB@@@B@@@@BB@@B@BA@BBB@@@BB@@@B@@B@B@B@@B@@BA@AB@@@B@@@@DAB@B@@@@@B@B@B@@BB@@@@B@@@@@@A@A@AAA@@BA@@@@B@@@BA@AB@@B@BA@F@@BAB@B@BBD@@@B@@A@@@@BB@BB@F@@@B@@@@AB@BA@AB@@@@BB@@HA@@@B@@C@A@@B@B@@C@@@@@@B@BA@@BB@@BB@@@@BBD@BDA@@@BB@BB@@CB@@BBEB@@@@@@@BB@B@@@DABBBFC@@BBB@@@B@@@@ADBD@BB@DAB@@@@J@@BF@BEA@@AB@@@BAB@BDNDR@@A@@D@B@@@D@@@@A@C@CBA@@@@B@B@@A@@D@BBH@@@@@B@@A@@@@B@BB@@HBHABA@E@@@AAA@BFIB@BC@AB@@BB@B@B@@D@B@@@@B@@B@A@@BB@@DB@@@@@@DD@@@@B@B@D@@B@@@EB@@C@@BC@@AA@@@BDED@D@@E@@@@BD@@@@F@DBD@@A@A@ABA@@@@B@@A@@B@BBB@@ABAECBA@BBABAAA@@BBB@@@AD@@D@B@BB@D@@BB@F@FVAB@@@BA@@AA@@BA@@BB@AB@@@@@@@@@@@B@BBB@@@@@B@@@@@B@B@@@B@@@@B@@@B@BB@@@@@@@B@@@@@B@A@@@@B@@B@@A@@@B@@@A@@B@@@@@B@@@@@@@BB@@@AB@@A@A@@AAAA@@B@@@@@BB@@@BB@BB@@B@@@B@@B@@BB@H@@@@@@@D@@@@@BBB@@@@@@B@@@D@B@DAB@@ABCAAB@@@BA@A@@B@@@@A@@BC@@@@@@@@BBF@D@B@@@@@@A@BD@BAB@@@@ABA@A@@B@B@@@@C@@BA@@@@B@@BBB@@@@A@@@@B@@@DAB@D@B@BB@@BB@@B@BB@@BB@B@@B@@@B@@A@@B@@@@B@@@B@@A@@B@@BD@@@@AB@@B@@@@BB@@BB@DAD@BAB@@@AD@B@@AB@@@B@B@BB@@@@@@B@@@D@@@BAB@B@@BB@@@@@@CBG@C@@B@@@B@@BB@B@@@@ABAD@B@@@F@@@B@B@BA@@@@B@B@@ABA@AB@@B@@B@@@B@@AB@BA@@BBD@@@@@@B@@FB@@BB@@@@BBBB@@D@DBF@BBB@B@@B@@@DBBBDDB@@BB@B@JAB@@@B@@B@@B@@@B@@@DBDD@@@@@BA@@@B@@BB@@B@@@B@@@B@BAD@@B@HBB@FAB@B@B@@B@BBB@@F@@B@@@@B@@@@@B@@@@D@D@D@BAB@BAB@B@B@BB@@B@@@BA@@B@@B@DBB@@@@@ABAB@@@@@B@@BB@@@@A@A@@BAB@B@@ABABA@@@@B@@B@DB@@DA@@@A@@B@@@BABA@@@@@@B@@@@BB@BB@B@@@@@@@B@@@B@D@BB@@@B@@@@@@DA@BBA@@B@@@B@@@@@@A@CA@@A@@@@@BA@C@@@A@A@@A@@A@@AACBC@@@A@@@A@@@@@BBB@D@B@@@BB@@A@@@A@A@A@A@@@AB@@@B@@@@AB@B@@@B@@@BABAB@@A@@A@ABC@@A@@@@@C@@@@BAB@@@@@B@@CB@@@@ABAB@BCB@BAB@@@@A@A@AAA@A@C@A@EAAB@@CD@@A@@B@@AB@@@@@@@B@@@@@@CBA@@@A@@@A@@@A@A@@@@B@B@@@@AB@@@B@@@BBD@BB@DDB@@@B@B@@B@BD@DBHD@@@@BA@@AAAA@@@@B@B@B@B@@@@@B@@DBD@BBBBB@@@@@@@AAA@A@@@AB@@@@BB@@BBB@A@@@C@ABA@A@BB@B@B@@@BBB@@BD@@@@B@@BAB@@A@A@A@@B@@@B@@@BA@@BBBB@BB@B@@@@AB@@@B@@BB@@@B@@@B@@@BB@BBBBD@@B@@@B@BB@@@BBDB@B@BBB@BBB@B@B@@@BB@B@@B@BBB@@@@D@B@@B@FD@@B@B@@B@B@@@@@@BBD@FBB@BB@B@@B@BC@@F@@AB@@ABAB@BAB@@ABCBA@AB@B@@A@@@ADABA@ABABA@AB@@@B@@@AA@AB@BA@@BAD@B@@@FBD@@@B@BA@@BA@A@A@A@@BA@A@ABA@AB@BCB@@@B@@@@BBDB@B@@@B@BAB@B@@@B@@BBD@BBBBBB@@BB@@BBB@D@DBB@B@F@@BB@@B@DBBBB@DBB@D@B@@@B@B@@@B@DDDBB@@@B@@@BC@A@C@ABA@AB@DABABABA@AAA@@@AAAAAA@BC@@@A@A@@@AB@B@@@B@@@B@BBB@@@@ABA@@B@@@B@@@B@DA@@DB@@BBBADAB@@@BBDBDB@@B@@@BB@@BBD@DD@@D@@@B@@AB@@CB@@A@A@@BBD@D@@@@@B@BA@A@A@AB@@AB@B@B@D@F@D@D@D@DBB@@@B@@@@@BA@AB@@AB@DCB@B@BA@BB@DB@BB@B@B@B@HAB@D@BA@@@A@@@AA@@ABAAA@@ACA@A@@AA@@@@@AA@A@@AAA@@@BA@CBA@@AE@@@ABA@A@A@@BAB@@C@@BA@A@@A@@@@A@@@A@@@ABA@A@@AA@ABA@ABA@@@A@@@@CBA@A@@@A@@@@AB@B@@@B@B@@A@A@ABABA@@B@@B@@B@@AA@AC@@@A@A@@DADADA@@BCBA@@BABA@A@@@A@C@A@E@A@A@@BABCBCB@BCBA@A@ABABA@ABABC@AFB@B@B@B@@@BA@@B@B@@@B@@@B@B@B@BABAD@BAB@@@B@@@@B@@@@@BA@CBA@@@@B@@@@@B@@A@A@C@ABA@@@A@A@@BA@@@@@B@B@@@@B@@@@@@A@@@AB@@B@@@B@@@@@@B@@A@A@@BA@@DB@@@@@A@@@@B@@@@A@@@AA@@@@@@A@A@@@AB@B@B@@A@C@A@@AC@@@ABA@C@@BABC@A@A@@BA@A@C@E@A@A@AAA@A@AA@CCGEAA@@@@BA@ABE@A@ADC@@@@@A@@@AB@@@B@@@B@@@B@@@AA@@B@BABA@ABAB@@@B@BAB@BABC@ABAB@BA@@@A@@@A@AB@B@B@BABAB@@A@A@A@@@AB@@@BA@@BAB@@A@@@@DA@A@@@A@@BCB@@AB@@CBA@ADA@@@A@A@@@A@A@A@A@@@A@@@@AA@A@C@A@AA@@A@@@@@@A@A@@A@@@ABABA@@A@A@@AAAA@EA@@ABAD@@ABA@@A@AACA@@B@@A@C@A@A@ABA@@@@@AA@@A@@AAAA@@@A@@@AAAA@A@@@@AA@@AAA@@@@A@@AA@@A@@@@AA@A@@@@@@A@@@@A@@@@BA@@@A@BB@B@@@@A@A@@B@@@@BB@@@@AB@@@@@BAACB@@A@@@@BA@@@@BA@@@A@@@AB@@@B@@A@@@A@ABC@@@@@@@@@BB@@@B@@ABA@@@@@@BA@@BA@A@A@@BA@@AA@@@A@@A@@AA@@EBA@A@@B@@@@A@@@@@AA@@A@A@@A@@@@C@AA@@@AA@@@A@A@@@@B@@@B@@A@@BB@@BA@@@@B@@@@A@@B@@A@@@@@AA@@ABA@@A@AA@A@ABA@@@A@@AA@@A@@@@C@@@AAC@@@@@@C@@B@@@@AACBA@@B@AE@@A@@@@@@A@A@CA@@@A@AA@@A@ABA@@@AA@BA@C@@@A@@@@BA@@@AA@@A@@A@@@A@@AA@@@@A@A@@@@AB@B@@@@@AA@@A@E@@@A@@@@A@@B@@@AA@@@@BAB@@@@AA@@AAAA@@A@@@A@@A@@@AC@A@@@@B@@C@@B@B@@@@A@AB@@@B@@A@@BA@@@A@@BA@@@@AAA@@@@AB@@@@@@A@@@@AAA@CCAAA@@AAA@@BA@@@@@@@AA@@@@@@@@A@@@@AA@@AC@A@@AA@@AA@A@@@@@AAA@@@@@AAAB@@@B@@@@@@ABA@A@@@@B@@@@AB@@@@A@@A@@@@E@AABA@@@A@@A@@@@@AA@@BA@@@A@@@@@@AA@@@AA@A@A@AB@@AAA@A@@B@B@B@@@BA@@B@@@@A@A@@B@B@@@@C@@A@@AA@@@A@@@@@A@@A@@A@@@BAAAB@@@@AB@@@@AAC@A@B@@AB@@A@CC@A@AA@@@A@A@@C@@C@A@@C@@CA@@@@AB@A@BABC@E@@@A@A@@@@@@BB@BB@@BFA@@@@@@@ABA@@@GDABAB@@AC@@AB@@@@CBAB@@A@A@@BA@AB@@@AAC@@A@@CA@A@A@@@A@@@@@@@A@@A@@@@A@@@@D@@@@AA@@A@BB@B@B@@@@A@@@@@AA@A@@A@@@C@A@@@@B@@A@@AA@@@@@@DA@@AA@@BA@AE@@A@@A@@EBA@@@@@A@AAA@A@@@@@AB@@CBA@ABA@@@@A@@@CA@@@@AB@DA@@@@ACEBAG@AA@@@AG@A@@AAA@E@Y@C@"],encodeOffsets:[[110248,35128]]}},{type:"Feature",id:"610324",properties:{name:"扶风县",cp:[107.900219,34.37541],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@AAC@C@C@EA@@@ACC@CBA@@@GBC@C@GB@@@@@@@B@@IBA@@@@A@@A@@@@A@AB@CC@AABBD@@@@@@@B@@@@BBA@@@@B@@BA@@BB@@ABAA@@@@@B@@@@@@C@AB@@@B@@@@@B@B@@B@@@BB@B@@A@A@@@@B@@@@C@@@@A@A@@IB@@@BD@@@@BBBBBA@@@@@A@A@@@@BB@@@B@@@B@A@A@C@@@A@@B@@B@@@@@@BA@@@@A@@A@@@@B@@B@@@@@@BCBA@A@A@G@C@AC@@A@ABA@@BA@@@A@@A@@@ABAA@@@@@A@@@BB@@A@A@@@A@BA@@A@@@@CA@ABA@@@@@@@BB@B@@A@ABC@EB@@AAA@CBCB@@@@BBBD@BB@@@DB@B@@@B@BA@@B@BBFB@B@@B@BBD@@B@BDA@@@@B@B@B@B@B@@@@@BB@BF@D@@A@@@@@AA@AA@CB@@AB@@A@@@A@@B@@@B@@A@@@A@@@@B@B@B@B@@A@@A@AA@@@@B@@@@A@@B@F@D@@@@AB@@@B@@@B@@BB@@@BAB@@@F@D@B@BBBAD@BBF@@@@C@EB@@@A@@A@@@@AA@AB@@@CE@@BA@@AGB@@@B@@A@@@@BAA@@@@@BA@@@A@@@@BE@@@@B@@A@A@C@@ACB@@@@@AA@@@@@@A@@G@A@@BA@EB@@A@@@@B@@@B@@@@C@@DC@@BA@@B@@@BGB@ACBA@@AC@@CEBA@@@@BIB@BA@@@AB@@@@@BAB@@@@@@@@@@C@@@@BC@@@@@@B@@A@@AG@@B@@@B@@@@C@@@C@ABAA@AG@@DA@A@@@@@ABA@A@@BA@@B@@A@@BAB@@@BBD@B@@A@@B@@A@BBAB@@B@@@@BB@@@@@B@@@B@@BB@@@@D@@A@A@@@@B@B@@A@@@AB@@A@A@A@@@@BBB@BA@@B@B@BBB@@B@@B@@@@B@@@B@@AB@@B@@@@@BB@@@B@BA@B@F@@B@@@@B@@A@@B@@A@@@@@@BAB@B@@@@A@@@A@BB@B@@@@BB@B@@@@@BBB@@BB@@@BBD@@BB@@@@@B@@@@@@B@@B@@@@@@AB@@BB@BB@BBDDB@BB@@@@@B@@@@A@@B@@B@BB@@@@AB@@@B@@AB@@@BA@@@A@@B@B@@A@A@@@@DA@@@@@@BBD@@B@@@@B@@@BB@BB@BB@@B@@A@AB@@@@BB@@A@@@@B@@B@@@F@B@@@BB@@@@A@A@@B@@B@B@@@@@BB@@@B@@@BB@@@BB@@B@@A@@B@@@D@B@@ABB@@B@BAB@@@BBB@@@B@@D@B@B@@@@B@@@BFA@@@ABBD@B@@A@@@@D@@@@D@BB@@D@@@@@@BB@@BB@@@B@BAB@B@@B@BB@BA@@BB@@@@B@@@@AB@@@@@@A@@B@@AA@@AB@@@@A@@@A@@B@B@@@B@@B@@BBD@@@@@@BB@B@@@BB@@@@B@@@@@@AB@B@FA@@BB@@@BB@@@B@@BB@@AB@B@B@@AB@@A@@@@B@BA@@@A@@AA@@@@@@@@D@BAB@@@B@@@@A@@BA@@B@@@B@@A@@B@@A@@B@@@DABB@A@@@@BA@@@@AA@@@@@AB@B@@@@@@AAAB@@@B@@A@@B@@@@@@B@@@@B@B@@B@@B@@@BB@@@B@@B@BB@@BB@@@@@BBBBB@@B@@@B@BB@BB@@@BB@@@@B@BAB@B@D@B@@@@AB@BD@B@BB@BA@@BCBA@@FBB@BB@BB@B@@@ABAB@B@@@BB@B@@@@@@@@BB@@B@B@D@BBB@@@@@B@@@B@B@B@B@@@B@B@@CB@BAB@DA@@BA@AD@@@B@@@BCB@@@@@BA@AB@@AB@@A@@B@@@B@B@BA@ABABA@A@A@@B@B@@@B@@ABA@AB@BADABA@ABA@@@A@AB@BABABA@@@BB@@A@@@A@@@A@@@A@@B@@@B@@@@CD@B@BAF@BAB@@@@BBHFDDB@@B@BBB@B@B@B@F@D@BAB@@@B@BADAB@@@DAB@B@@BD@@@B@D@BA@A@A@@B@@@B@B@@@@B@@B@@@B@@A@@@@@@B@@@@CA@@AB@@@B@BA@@@@@@@@A@@@AA@@B@@@B@@@@A@@@@@@A@A@@@@AB@@@B@B@@AB@B@D@B@BA@@@@@A@@@@@AB@DAB@@@@A@@@@@@A@@BA@ABCBA@A@A@A@A@@@A@@@A@AB@@A@@@A@A@AEA@BADAB@BABAB@B@BABADA@ADADAB@@@B@B@F@B@D@B@@@BABAB@@ABAD@@CBCBCB@@@B@B@@BDB@@BA@@@@AA@@@ABAB@B@B@BA@A@@@A@A@@B@@B@@@B@B@DA@@@@@B@@AB@BAB@BBB@@@BAB@B@@@B@@@B@@B@@@@BAB@@@D@@@@BBB@BA@@BB@BBBBB@@BBB@@@B@BABA@@B@@@B@@@@BB@FDB@@BBB@@B@B@B@B@@BB@B@@@DB@@B@@AB@B@B@@@B@@BD@@@B@B@B@@B@@BBDB@@B@@@DBD@BDB@DB@BDDBBBB@BB@@B@B@B@BB@@BBB@BDB@B@@@@BB@@@@BDB@AB@B@@BBDD@@@BA@CBA@@@CAA@@@CA@@ABA@@@BB@@BD@@BBDF@DBBBD@@B@@BB@BAF@DAB@B@DB@@@BB@AB@@B@@@B@@B@@@@ABB@@@B@A@@B@@@@D@D@B@B@@@@AB@@AB@B@@@@@B@B@BBB@DDBB@@@@DAB@DB@@D@H@BBB@B@B@B@BBB@DB@@@B@@@F@@BB@@@@B@B@@@@@@A@A@C@@@A@@@@B@@@DBDBB@BDB@@@DCB@BABA@CA@BA@@B@DBB@B@B@DBB@@@@@BAAAB@BABCB@@@@@@@@@@AB@B@@AB@@@@@@B@@@B@AB@@@@@B@@@BB@BB@B@@@@B@@@@B@@BA@@B@@@@B@@@@B@@@@@BB@@@BAB@@@BB@BA@AB@@@B@@BAB@@B@@@@ADA@BB@@@@BBBBB@BBBB@@BBF@@BB@BBB@@@@@@A@@DC@A@@@A@@AA@@AA@A@@BCAA@@@AB@@@@@@@@B@@@@B@@@B@B@@@BA@@@@@A@@AAAAAA@@@@@@@@DA@@BBD@@@@@B@@@@A@@BA@@@@BC@@B@BBB@B@B@FA@@@@B@@A@@BB@@DB@@F@B@@@AA@@@CBC@A@A@@AEAC@@A@C@AAA@AAAA@@AC@C@@@ACA@A@A@A@A@A@AAAAEAC@@@A@@@@@@@AA@@@@A@A@A@A@@AA@@@@@AB@BABA@ABCB@DAB@DB@@BB@@@AB@BC@CB@@ABA@@B@@ABA@@@A@@@A@@@A@@DC@@BCB@B@BA@@@@BEDI@@DC@A@@@CBA@A@A@@BCBA@@AAAACCAAAA@@@A@@@A@ABA@@@A@@@A@@A@@@A@BG@@@ABAA@A@@AAA@@BC@@@A@@AC@@AAAA@CHDBA@@@A@@@C@@AA@@AB@@@CA@CAG@@A@A@@@@@ACA@@@C@ABABCBGB@@@@AB@D@@AH@D@B@@@@@B@@@BA@@@@D@@@B@@A@@@@DB@D@B@BB@@@BABA@@@BDD@@B@@@@@@A@@AA@A@A@@BB@@@CB@@@@BB@@@BA@@D@@ABA@AB@@@@D@@@@BA@@@CA@@@@@EBA@@@A@A@@@BA@@AA@AA@@@@A@A@@@@@A@@@A@A@@@@EB@@C@@@@@@@A@@@@@@A@ABA@@AA@@A@@@BA@@@A@@A@@@@A@G@@@AA@@C@CBA@ABC@A@C@A@ABCBA@A@A@A@@AAEB@A@@@@EAA@@ABCA@@A@@B@B@@CBC@@D@@@BC@C@@@@B@B@@@BB@A@@@@F@@@BC@@B@@@BC@@@A@@@@BCB@@ABA@@B@B@@@@ABC@ABE@A@@BADEB@@ABAAA@@BA@AAB@@@@A@@@BC@@A@@@@AAA@A@@C@@@@AA@A@@A@@BC@@@@BC@@@A@@B@B@BA@A@@B@BB@@BA@@B@@@B@@@DA@AB@@@@@@AA@A@@A@@@@D@@AA@A@@@@@@@A@E@A@GAA@@@AAA@@ADB@A@@@A@@A@@@@@@@@A@A@A@@@A@A@A@@@@@A@AB@@E@@A@@ABA@@D@@A@@BAB@@ABA@C@A@@@A@C@@@A@@@A@A@A@C@@@@A@A@@@@@BA@@CA@@@@@A@@@@BB@@B@@AB@@A@AB@@@B@B@@@B@@@@@B@B@@@BABC@@B@B@B@B@@@@AB@B@@@@@BA@@@A@A@@B@@@@@B@B@B@@C@A@@C@@@@A@@@ABA@@A@@A@@B@@AACB@@A@@@A@@B@@@BAAA@AA@@ABA@A@@@C@@A@@AA@B@AA@@@@A@@BC@@B@@@@A@@@@@@AB@@@@A@@B@@AA@AA@@@@A@@@@B@@A@@B@@AA@@@@BA@A@@A@@@@@A@@B@BAA@@AB@A@@@@A@@AAAABA@@@A@@@@@@AB@AA@@@@@@AA@@A@@B@@@@@@@BA@AA@@A@@@@A@@@@@A@@@@@@A@A@@@A@@@@@A@@AA@@@@B@BA@BBA@@@@BA@A@@@@@@BB@@@@@AB@A@BA@@@@@BBA@@@@@@@A@@@C@@@@@A@A@@@A@A@@@@@@A@@@@@@@@C@A@@@ABA@@A@@@@@AB@@A@@B@@@AAA@@@A@A@@@AB@@AB@@@@@BC@@@@B@@@B@@@B@@AA@@A@@B@@@@BB@@@@@B@@A@@@@@AB@@@DA@@@A@@BA@@BA@@BBB@@@@@B@@@@@@@B@@A@@@@@A@@@@A@@@@@@A@A@@@AAAA@@@@B@@AA@@ABA@A@@@AB@@A@@@A@@B@@A@@@@BA@@@A@@CC@AA@@@C@@@CCA@@A@@AAA@@@@@@@@@A@@@@BA@@@C@@@A@@@@B@B@@@B@@ABAAA@C@CBA@ABGFA@@@A@@@CAA@A@EAC@EBA@A@A@@@@CAEA@AK@A@AAAC@@C@ACA@GHABA@C@CBA@AAE@C@@D@B@BD@@@@D@B@@@@A@@@@@@@A@@@@@@@@BB@@@B@@@@@ABG@@@@D@D@B@@B@@@B@B@@D@BAB@@CBC@@@@BA@@DA@@@CB@@@@@@@BB@@@BB@@DB@@C@@B@@B@@@AB@@B@@@A@C@@A@@A@A@AB@@AG@@CA@BAAA@CD@@AJ@D@@@B@@@LA@ABA@BH@D@@EA@@AB@@AF@@AEA@@C@@CE@@AA@@E@@@CA@@CB@@@@CB@@B@@@@B@@G@@@E@AA@@EA_@@A@@B@@A@@A@@A@@A@@C@C@M@AB@@A@A@@BA@@A@@A@@@A@A@A@@AA@@A@@E@@@AAB@@A@@A@@@A@@A@@@@@@@@@BA@@@@@A@@B@@A@@@AB@@@BA@@@A@@@A@@@A@@@A@AAA@A@@@AAA@E@A@A@@@@@@A@A@@EAA@@@A@@@@@@B@@@D@B@DAB@DABCDABCBC@C@A@AACAAC@A@C@@"],encodeOffsets:[[110514,35045]]}},{type:"Feature",id:"610326",properties:{name:"眉县",cp:[107.749766,34.274246],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@E@A@A@@@CA@@ABA@CAA@@@A@ABABA@E@A@CB@@CAA@AAA@@@@@ABCDEBCBA@@@@B@@@D@B@BCD@BAB@BABABA@A@E@A@@@A@C@@@AA@@@@@@A@@ACAC@C@@@C@@@EC@@C@@@C@A@EA@@A@@AA@AAA@AAAAAAA@AAAAA@AA@@CA@@A@@A@A@A@A@@A@AAA@@ACA@@AAA@C@EACAAAA@C@A@CD@@C@A@@@A@@B@@@BABABGFCBAB@B@DAB@@CBCBA@GDA@C@@BA@ABE@C@@@A@@@AAAACAA@@AA@CA@A@@AEA@@A@@E@E@A@A@A@@@ACCACCA@EAA@EDC@KBABA@A@@BEB@@CA@@C@I@C@@BA@@@ABA@EBA@AB@@A@C@A@@@ABABAB@BA@AH@@@B@B@B@@@@@B@D@B@@AD@B@@AB@@ABAD@BABA@CBCBAB@@AB@FA@@BA@@B@@@D@BBB@@@D@@@BBBBD@B@@AB@BA@CDA@A@AB@@AB@@@@BB@D@BBBAB@BA@ABA@@BA@AAA@ABCBABA@@BABA@AB@@@DAB@@AB@@ABA@@BC@@@AB@@@D@B@D@@@D@BBBAB@BA@@B@@@DCDA@@B@BAB@B@@FFBDBBBB@@BB@B@D@BA@@BAB@B@B@D@@@BAB@@B@@BBB@@@BB@@BAB@B@B@B@@@B@@BBB@DB@BBB@@@B@@@@AB@B@@@@@BB@@BA@@BBB@B@@BD@B@@@B@B@@CDABAB@@A@@@CD@B@@BB@B@DBBBB@@@@A@CAA@A@CB@@@@E@AAA@@@A@@FAB@@CDAB@@A@@@ABABABA@C@A@ABA@@@AB@B@BCBABA@@@ABA@A@CB@@E@GBA@A@@@CBCDIB@@@B@@BBB@BBB@@BBB@BBB@@@D@BBD@BBB@BBABBB@@BBBB@@B@@@B@BBB@B@@DD@BDDBB@@@BBB@@BB@@@BD@@BDJBBBDBL@D@@@@AB@A@@AB@B@@@@@BBB@@@@B@@@@DB@@BD@@@BD@@@@B@@@@@@B@B@@BD@@@@ABA@ABB@@B@BB@@@BBBD@B@@@@@@BDB@@@B@@DB@@@@@B@@BADBB@B@B@@@@A@CB@@@BC@@@@DA@@B@@@@A@@@@AC@BB@@@DA@@@@@@@@B@BB@@@@@@@AB@@@@A@@@@B@D@@A@@@@BC@@@@H@D@F@@B@@BB@@@@D@@A@A@@@@@@@@@A@@@@F@@@@@BAB@@A@@@AAA@@DBB@@@F@@@@@B@BA@@@@@@B@@@@@@ABB@BB@@A@A@@@@DA@@@A@@@C@@@@@AF@B@@AB@@@@C@A@A@@@@B@@@BAB@@A@@@@D@@B@@@@D@@A@@BA@@B@D@@B@B@BBB@@BB@@BB@@F@@@B@@@D@@@@@@@BBFD@Z@F@B@BB@@@BBH@@B@@BBHFABD@@@@CBA@@B@@B@@D@@@B@@B@BAB@DA@@BA@@@@B@B@BBB@@@@@B@FA@@@BB@@@BFB@@AB@@BB@@C@@@@B@@BB@@@@A@@B@D@@@B@@@@BBB@@@@B@@@@@@A@AAAB@@@BB@@@@@C@@B@@@@@@BB@@@@@@@B@@@B@B@B@@DB@@@BD@B@@BAB@@AB@B@@@BADA@@@@BA@@BD@@BABAHC@@B@BA@@@@@@B@AE@@AAA@@A@@@@B@B@@@F@D@BA@A@BBA@@@@DB@@@DB@D@@@@DB@B@@@B@@B@BDDB@@@BA@@@A@@BA@AB@@@@AB@@AB@B@BA@@@@B@B@@CH@@BBBBAD@@@D@@@@@@A@@@AH@@BB@@@@A@@@@D@@A@@D@@@@@@@@@@@BA@A@@@@BA@@B@@AJA@A@@B@FA@DD@@BB@DA@BHA@A@@@AB@@AD@@CD@@@@@@A@@@A@@B@@@FAB@@AB@H@@@@B@@@@B@@B@@@@DA@BD@B@B@@@@A@@F@@A@@B@@@B@@A@@@@BB@A@@B@@@@A@@HA@BB@@AF@@D@@BAB@@B@@B@@@@B@@FAD@@@@@AE@ABCAA@A@A@C@E@@BA@A@@AA@@@A@@@A@@BA@@@@@C@E@AB@@@@@@A@@B@@B@BB@@@@A@A@A@A@@B@@@B@@@@A@@@AB@@@B@@@BA@@DAB@@BBB@@@@B@@@@CAEA@@A@@@@@A@A@A@A@A@@B@ACA@@@AC@A@AA@A@AE@A@AB@@A@A@@@ACA@@A@@AACAA@@@@DADAB@BB@@FAD@BAB@@@@AAA@@@@@@B@BAB@@D@@B@@@ABB@@@B@B@@@AA@@B@@@@@B@AB@B@@@BB@@@B@@AB@BAB@@@BDD@H@B@B@B@DA@A@@@@A@@@@A@@B@@@@B@@B@@A@@@@A@@@@AB@@@D@B@B@A@@@A@@@A@@A@@B@B@@@@@B@AAAA@A@@C@@A@@JA@@@B@B@@D@@@@@@A@@B@B@@@@AAA@@A@@@@A@A@@@@@A@@BAD@@@@@@@@A@@@@BBBA@@AA@@AB@@@A@@B@AA@@@@@A@@@@@@ACBA@BDDA@@B@B@@B@@@@B@@B@JA@@@A@@@@@@HAD@D@HA@@B@DAD@BD@@B@@F@D@DBD@B@@@D@BBDDBBBB@D@D@DABADCBA@CBA@C@A@C@@@A@@@@B@@@B@FB@@@B@B@@@@B@B@F@B@BB@@B@B@BBB@@@B@@@B@@@B@@@B@@A@@BA@@B@@@@AB@@@@@B@@A@@@@@@@@@BB@@@B@@@@BA@BB@@F@@@@BB@@BB@B@B@@@B@@@@BB@@AB@B@@@BAN@D@D@@@@BB@@@@BB@@@@AB@@@BA@@B@B@@@B@B@@@B@BBBB@@D@DBD@BA@@B@BDBD@@@@B@HAD@D@H@H@FBF@@A@C@@@ACCA@F@@AD@AEHAAAHACMIB@@@@@AA@@@@AB@@@@@@CA@@@@@@AA@@E@@@AB@@@@@A@@A@AA@@CC@@AC@@C@ACCDABE@A@@B@@A@A@@@C@A@ACC@C@@A@@@ABA@@@@@@B@@@@AB@@CAA@A@@A@C@@@AAI@AA@@C@@AAAA@C@@AA@@C@@@AC@@AAA@@@A@@C@A@@AB@@C@@@@AAC@AB@@@@GB@@AEBAAC@@@BC@@@A@A@@BA@A@@B@BA@A@A@A@AAC@E@A@GAGBA@C@@BEA@A@@AAA@AAA@@@ABA@A@@@ABA@@B@@A@ABA@A@A@A@@AA@AA@@@@@CA@@A@@@@A@@@@@A@@@@EA@@AA@@@@@A@@@AA@@@AC@@A@ACA@AC@@@AAA@@@AAAA@AAA@@AA@@@AAA@AA@A@AA@@A@A@AA@A@C@A@A@@@@A@@@A@A@@BC@@AA@A@CBC@A@C@@@@AAACCA@AA@A@AAA@AAA@@AC@AC@@CAAA@@@A@@BC@@A@@@AAA@@@ICA@A@A@C@@@CA@@@@@ABA@E@C@A@AAA@@CCAA@E@E@@BCBABAB@@A@CAC@C@C@@@AAA@@@@BA@@@A@@CA@@@A@AB@@AD@@@BA@C@@@ADA@@@@BABA@A@@AC@@BA@ABE@C@@@A@AAA@A"],encodeOffsets:[[110540,34799]]}},{type:"Feature",id:"610328",properties:{name:"千阳县",cp:[107.132441,34.642381],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@C@A@ABA@AAA@C@A@A@AA@@@@A@ABC@AAC@A@C@@@A@@@@@@BA@ABABADCB@@AB@B@B@B@B@B@@@BB@BD@@DA@@@@BDBD@@@B@@A@@BA@@@AAAA@@A@@@@@@BBBBB@@A@@BA@C@C@@@CCA@@@@@@@@BA@A@A@A@@B@B@B@@A@@BAACAAA@@@A@A@C@@@@A@A@C@@@@@@AA@BA@A@A@@@A@@@C@A@AA@@@@@@AA@@BA@AB@@A@AAA@A@@@AAA@ECA@A@CAA@@ACAA@@@@BAD@@A@@@@B@BA@@DAB@B@@@@ED@@@@AB@@@B@BABABA@ABCBA@@B@B@@@BBBB@@BB@@B@@A@@BCB@@A@@BA@AB@BA@@@AB@BA@A@A@A@A@ABA@A@@@ABA@A@@@A@A@@@@DABA@A@A@A@@@ADABAB@@A@A@C@AB@@AB@@A@@@A@A@ABABA@A@@BABAB@B@@A@CBA@@@AA@@A@@@AB@@CB@BAB@B@@@B@@AB@@@@CD@@@B@B@@BDBB@B@@A@ABAB@@@BAB@@A@A@E@A@@@ABCB@@A@@@ABAB@@A@@@ABA@@BC@@BGBABA@@@EA@@ABA@A@E@@@A@CA@@A@@@EDA@A@@BAB@B@B@B@B@B@@ABA@CDAB@@@@AB@B@@@D@B@BBD@@AB@@@B@F@@AB@BA@A@@@AB@@CBA@@@A@A@ABA@ABA@ABC@A@C@A@@@A@@@BDAB@B@@A@@B@B@@@BAB@@@B@B@@@B@@@BAB@@CD@@@B@B@@@D@BA@ABA@ABA@A@A@C@A@@BCBC@@BABAB@BABA@AD@B@@A@BB@BB@BBBB@BFBB@@BBB@@@B@@@BA@ABCBCBA@ABABA@A@@@@BA@@B@@AB@@AB@@BB@@@@A@@@@@@@@@AAA@EAE@@BAB@@@D@@@B@BB@@BB@D@B@@@@@B@DBB@@@@@B@@B@@@@AB@@DB@B@@@@@B@@B@@B@B@@@BB@@B@@B@@@@@@B@@@@@B@AB@@B@BB@@@@B@@A@@BA@A@A@A@EBA@A@@@A@@@@B@@@BBBD@B@DBB@@@B@D@@@@@BBBB@@@@@@AB@@@@@B@@@B@B@@@B@@BD@@@BB@DBBDBB@BBB@@B@BDB@BB@@@BBB@B@B@@DFBBB@@@B@BAB@BAB@B@B@BAB@@@B@B@D@@@B@@@@BA@@DAF@@@DB@BBB@@BDDB@B@FBB@D@BBB@B@B@D@B@BB@@D@B@@@B@@@B@@BB@@B@@@BBBB@DBF@D@B@B@B@B@B@@BBA@@BB@@@@B@@B@@@@B@@B@@@BA@EDCD@B@@@@BBDBBBB@BB@@BBB@@B@D@B@B@B@B@B@@@BDBBBBDBD@BBD@BBBDABB@@@@BBA@@B@@@B@@@BBBBBD@@@DBDB@@BBBB@BBB@@AB@@@@AB@B@@BB@BAF@BABC@CBABAD@B@@@B@B@B@BABABADCB@BB@B@@@B@BAB@@@BBB@B@B@@@BB@@BDBD@B@@@D@@B@@D@H@D@DBDBBBBB@B@@BB@@BB@@@@BAB@@@BEBA@@B@@@@@BBD@BD@@B@BBB@@@B@B@@A@AB@B@B@B@B@B@BBBBBAB@@@BB@@@D@BAD@BBB@@DBB@BD@BBBBB@DBB@B@BBDAB@@@D@B@B@HA@AB@@@BA@@@C@@@@@AB@@A@A@A@AB@B@@@D@B@@@D@B@B@DAB@DBD@@@@@D@@@@@BBB@B@BAD@D@BBBB@@B@ABA@@B@@@@@@@@A@@@@@@@@BA@@@@@A@@@@@@@AB@@A@@@@@@@AB@A@@@@A@@B@@@@@@@B@@@BA@@B@B@D@@@@@B@@BD@BBB@@BDB@@B@@D@@@F@B@@@B@BBBBBBF@BBBBBBBD@@@BBB@BAB@@DB@@@BBB@@@B@B@@@B@@@BBB@B@B@@@B@@@@B@BB@BDAH@JBJAPCDEHCHCFCDGFEBGBEBIBGBIBIDIDIDCFEFGFEL@FB@@B@@@@CBABABA@@B@@@B@DBH@B@B@BDFD@BBFBBDJ@@@@B@@A@@BCD@D@D@D@B@BA@A@A@A@AB@DA@@FCB@D@B@@@@BDH@HFF@BFJ@B@@BFBBBBAD@BD@DBD@B@BB@@FAFC@@D@FDB@@BBD@H@H@FBHBDBBBFHFJBJBJ@J@LBH@F@F@H@HAH@JAB@FAHAFEBEBEDEDCDAFA@@JA@K@@@A@E@A@A@@B@B@D@@@@A@@@EACAE@@@A@A@@BCB@BADCBA@A@C@ABAAC@A@@CA@@@A@@@@DBB@DCBCBA@@@A@A@A@AA@ACA@@ACBC@CBA@A@@BABA@A@A@@@@A@@D@@ABA@@@ABA@CBA@@@C@@BA@A@A@A@@@@C@CA@A@A@AA@GCA@AA@@@@@C@@@@CC@@@CAA@@@@@@@@D@B@@ABA@A@@@C@AAAAAAA@ABA@@@@BA@@AA@@B@@@@@AA@@@A@@BA@AA@AA@@BA@@@A@@@@AA@@@AB@@@@AA@@A@A@@A@@@@AA@@AA@@@@@BA@@@A@@@@@@@@@@@A@@@A@@@@@A@AA@@@A@CAA@@@A@@@@AB@B@B@@@@AA@A@@AA@B@@@BA@@@@@A@@A@@@BABA@C@@A@@A@A@@@AB@B@@@@A@@B@@@BA@@@BBBB@@@B@@BBAB@@@AA@@@AD@@@@A@@@@A@C@@@@AAAEC@@@@AA@@@@A@@@A@A@@@CA@@A@AB@@@@AA@@@AA@@@@A@@@@@@A@@@A@AB@@A@@@@A@AA@@@@@@@@ABA@@@AC@CA@A@A@A@@@AB@@ABA@@BA@A@@B@B@B@D@B@@@@@@A@@BA@C@A@A@@A@A@ADAB@@CAA@@@AA@@@@@@AB@@@@AB@AA@ACAA@A@AA@BA@@@CB@@ABA@@@A@A@@@AAA@AA@@A@@A@C@@A@@@A@AAA@ABA@A@@@@@A@A@A@@@C@@@AA@@@A@@@CA@@@A@@AA@@ABA@@AAA@AABABABAB@@@B@@ABAB@DA@@@A@CAACC@A@C@@@A@C@AA@@@@BA@@@AB@@@@AB@@AB@D@@@B@DEBAB@@AAE@AACB@@A@A@CAAC@@AAAA@@@@AAB@@A@@@A@AAA@@AC@AAA@A@AA@@A@@@A@@A@A@A@@AAAA@AA@@ABAA@A@A@AA@@@A@@AA@@@C@A@A@AC@@A@AAA@C@A@@B@@A@@@AAA@@@A@C@C@ABA@@@C@@@AAA@A@@@A@A@A@@@@B@@@@A@A@AAAACAA@AAA@AAA@C@@A@C@@AA@@@AA@@AA@@A@@@@@AA@@@@@@AA@@@@@A@@@@@@@@@@@A@@@A@@A@@@@@B@AAB@AA@@@AA@@@@@@@@@@@@@AA@@@@AA@@A@@@@@@AC@@@@@@A@@AA@@@A@@@@@@@@@@@A@@@@@@AA@@@@@@A@@@@A@@@@A@AA@@@AA@@@@@AA@@A@@AA@@@@AA@@@@@A@@A@@AAAA@A@@A@@@@@AA@@@@@@A@@@A@@@@AA@@A@@AA@@@@A@@A@@A@BA@@@A@@@@@AA@@A@@A@@A@@A@@A@@@A@@AA@@AA@@@A@@@@@A@A@AA@@A@@@@BA@@CC@@A@AA@AA@@A@A@@@A@A@@@AAA@@@@@A@@@@@A@@AA@@A@I@A@A@AAA@A@@@AA@@AAA@AA@@A@AAA@A@@@A@AAGEMECAA@@C@C@M@CBABEBC@A@CAA@C@CBABG@@IA@@A@EBC@A@A@CA"],encodeOffsets:[[109766,35392]]}},{type:"Feature",id:"610327",properties:{name:"陇县",cp:[106.864397,34.89305],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@CC@@@@ACCA@@AACAA@@@@BA@@@A@AACA@@ABA@A@@@AA@@@A@@@AA@@AA@@ABA@A@@A@@AAC@@A@@AAA@@A@@@@@CBCB@BAB@B@B@@@B@B@@@F@B@B@@@@@@@@C@@@A@@@ABA@C@@@A@A@@@AA@@AA@A@@AAC@A@@@AA@@@@A@@BA@ABA@@@@BAD@BA@@@A@@B@@@B@B@@@B@@@@@BABA@@B@B@BBB@@BD@B@B@BA@ABA@@BAB@@ADAD@@@@@BABAB@B@@A@A@A@@@A@@ACA@@A@AB@@AB@@ADABAB@@ABABA@AB@@A@AB@B@@@B@B@B@B@@@@@BA@@BA@@B@B@@@@A@@@A@A@@@A@AAA@@@@A@AAAAA@@AA@@AA@AA@ABA@A@@@@@AAA@@@A@A@A@A@@@CBABA@A@@A@A@G@@@AA@@AAA@A@@@CACBA@C@ABA@E@A@@JC@@B@@@B@@@BABA@@@A@@CC@AA@A@@@@@@AA@@@A@A@@AA@ACAAC@AAA@@ACCAA@@@@A@@@AB@@@D@F@B@@@B@@@BABC@CBA@ABABA@@@@AA@@ACEAAAAAACAC@@AAA@@CAEB@@A@@AA@AB@@A@@@AB@@AB@@CA@@@@ADAB@@@@A@ACA@@@A@ABC@@BAB@@A@@BA@@B@@@BA@C@@BA@AD@@CBABABA@A@A@@@ADA@@@C@@@A@@B@@AB@@@@@BA@A@AACA@@A@A@AAA@A@@@@@C@A@A@@@A@@BC@ABA@A@A@@@@@@@ABC@A@A@A@A@EE@@AAA@@@ABA@A@CA@@CAA@AC@@C@A@AACA@A@@AA@ACAA@@AAAAA@@@AA@@@ABABC@@B@@@BAB@@C@A@ADA@CFABA@AB@@@@DB@@BBBD@BBBBBDB@@ABA@ABA@CBAB@BA@C@AB@@AB@@CBA@ABA@AD@@A@ABA@@@@@CBC@ABA@@BABA@AB@BA@A@@@A@@@@@@A@@@@A@@B@@@B@@ABA@@@@@@BA@@@A@@BCA@@@B@@AB@@@@A@@@@@AAAA@@@@@@A@A@@BB@@@@B@@A@@@G@A@A@@@@A@@@A@@@@AB@@@B@@@@A@@@A@A@@@AB@@@AA@@B@@@@@B@@A@@@@@@B@@B@B@B@@@@@@@@@AB@@@@A@A@@@A@A@@@@@@@AB@@BB@@@@C@@@AA@@C@@@@@@@@A@@@@@@A@@B@@A@A@A@A@@@AB@@AA@@@@@A@@A@C@@@C@A@A@@@@@@B@@@@@@@@@@A@A@@@A@@AA@BA@@@@BAA@@AC@@@@A@@B@@A@@BB@@BA@@@@AA@AB@B@@@@@@A@@A@A@@@A@@B@A@B@@@@@@@@@@A@@B@@@@@@@@@B@@A@@@@B@@@@AB@@@@@B@@A@@@@@@BA@@@@@A@@@@@@B@@A@@BA@@BA@@@AB@@ABA@@@ABA@A@ABA@A@@BCB@@A@@@@B@@@@A@@@@@@@@@@@A@@@@BA@@B@@A@CDCBCFCF@HAFBDDJBDBBDFFFFFBBDDFDFBHBHDFBH@HDDBHDFFFFDFBFBHBDBH@B@BBFBDFBDDH@F@JAJ@L@F@J@H@J@DDDBBBDBDB@JCDCFA@@BJD@@FDF@DABAD@DBFDB@DBDBBBBB@@@DBBBFBBBB@B@BAB@H@@@D@BCDCBCDABC@GDADBF@B@B@@@BAD@@@FGF@@C@A@CBCBABB@BB@B@D@BABADAD@D@BAB@B@BB@B@D@B@AB@JBLFJ@@BHCNCFCDEDIDGDEDGBGFGDEHADEFCFAHAJAJ@JAH@BAFAFADADAHMLGDEF@BGHBL@B@F@H@D@BAJ@@@@A@@B@@@BA@@@@B@@@@@@@BA@@@@B@B@@@@@B@@@@@@@B@@@@@B@@@@@@ABA@@B@@A@@B@@@BA@@B@@@@@@@@AB@@AB@@@B@@AB@@@@@@@BB@@@@B@@@@@@BB@B@@@@@@@@BBB@@BB@@@@@@B@@@@B@@@@@B@@@B@@@D@@@@@@BB@@@B@@@B@B@@@@BB@@@@@@BB@@@B@@B@@B@@@@B@@@@@@@@@BB@@B@BBB@@@@@@A@A@@@AB@@@@A@@@@@@@@@A@@B@@@@@@A@AB@@@@@B@@@@@@A@@B@B@@@@@@@@@@@@@BB@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@A@@B@@@@@@@@@@@@@B@@@@@@@@@@BFBFBD@BBBABA@A@A@A@A@CDABAB@@@@@@@BHBHB@@@DFJFFB@B@BAD@@@BADAHA@@@@BBB@B@BA@@BA@@@@B@@@@AB@@@@@@@B@BBBB@@@@B@B@@@B@@B@@@B@B@@@D@@@B@B@B@BBB@BB@@B@@BBBB@@@B@B@@@B@@BBBB@@BB@@@B@BB@ABB@@@@B@@@@BB@@@@B@ABB@@@@@@@@B@@@@@B@@@@@@BDBB@BBBDDBFD@BDBDAB@DAHAFA@@B@B@B@@BDD@DBF@LFFBB@BAFEDCBC@ABADABADBBBABAB@B@B@B@B@B@@DBBBB@B@FCH@@@JCHCHEB@FCJALCD@@CBA@@BA@@BABB@@BBB@BAD@BAD@@B@BBBDAF@FAF@H@B@@@DBB@@B@BAB@@@B@BFBD@@@@@B@@@@@@@@A@@@@B@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@B@B@BD@B@B@DDBB@D@B@DAB@DBD@BBBAD@BBDBD@D@D@B@D@F@FBDBD@D@DB@@@B@D@DBBBDFBBBD@@D@DAD@@@BABCBBFBDDBDCDC@@BABE@BFBL@JBH@HAB@@@J@LALCL@HAHAJAJCHAHAB@HCHAFANCHAF@FAHAF@DAB@L@HAHD@@JFD@BDDBFDDDFBH@H@FA@@H@HBDBF@@@B@BCBCDADBFBJFFBB@@@@A@@DCBA@@HABABE@C@C@ADAFAJAHCD@JA@B@FBD@DDBFCH@BAD@B@@CBABGBE@ADEFADAHAF@F@HBNDNFF@F@B@DACEGECC@AHCF@D@FBD@BADCFEBEDCBABABCBE@AB@BE@AACCE@ADAD@F@FBDDD@DADABADAD@DBFFBDBBBFDFB@B@FED@FDB@BACC@CAIAEF@HAH@J@@@H@DAFAHBHADAHCDADEDEDEDCBCBE@A@EAEAECEAECG@EAGACDCBEDCFCDCDABCBEFCBAFAFCDCJCJADAD@HABBBB@@BC@CBAB@@@@A@@@A@AAA@A@@@A@@@A@A@@AA@A@@CA@@BA@AAA@A@@ACAAAAAAE@AAAAAAA@@@A@E@@@C@@@@AA@AC@@AA@AAC@@@A@@@@@C@A@AB@@A@@@A@@@@@@@AB@@@@@@BBA@@@@@@B@@@BA@@@@@@B@@@@@B@@A@@@@@@B@@@@@@@@@@AB@BAA@@@AAAAC@C@ABA@A@AA@@@@C@@@@@C@CAA@CBA@A@C@@@A@C@@@A@A@@B@B@B@BA@@B@@@@@D@@AB@@A@@BGBA@A@C@@@A@CBAAA@A@CAA@AAAAC@@AAA@CA@AAC@ABC@@@A@@A@@BAAAAA@A@A@A@A@A@ABAB@@@@A@A@@AA@A@AC@@AAC@A@@@@@AB@FA@A@@BA@A@@A@@AA@@AA@A@AAAAAC@C@C@G@CA@@@@C@@@AACAC@@AA@@A@A@A@AA@@A@ABA@@@A@A@@ADABCBABA@A@A@A@A@@@ABCBADAD@BA@ABE@AAA@@@ABA@@@@BA@@AA@AAAAA@@CACA@@C@AAAA@A@@@A@@@AB@AA@@@@AACBAA@AAC@AACACAACA@A@@@A@A@A@A@A@C@AA@AA@@AAA@AACAAA@@@@@ADCFCB@@A@@@AA@@@@@@AA@@@@@AA@@AB@AA@A@A@A@A@C@E@CAA@AA@A@@@AA@@AA@@@A@@@A@C@@@AAA@C@A@A@A@AAC@A@EAA@A@CC@AA@AAA@@C@@BE@CB@@A@@A@@@C@A@A@@@A@ABA@A@A@ABA@ABA@@@A@AACE@@@A@AAA@A@@AAA@ACA@@@AA@AAAACCAA@@A@@AC@@@A@@@A@A@@@A@@@@BA@@@@@@AAAA@@@@C@A@@@A@CAA@C@AA@A@@@A@@B@@@B@B@FAB@B@B@B@@AB@@@@A@@A@@A@AA@@B@A@@@@@A@@@@A@@@@AA@@A@@@A@AA@@@@A@@@@@ACA@@BA@@@@@AA@@@@@A@CAA@@@@@A@C@A@@AA@@AA@@@A@A@A@A@CDABAA@@A@AAAA@@@@A@A@A@A@@B@@A@A@@@AB@FA@@@@@@B@B@B@@@@EBE@C@@@AB@@ADA@@BA@ABA@AB@@@@AB@B@@B@@@@BB@@@@@@B@@@@A@BB@@BB@B@@@@AACAA@A@A@A@A@AB@@AB@@@BB@@@@@DB@A@@@@@A@@@@BB@B@@@@CBA@EBC@@@AA@@@@@A@A@A@@@AA@ABA@A@@@A@AB@@@@ACAA@@@@BA@@@AAA@A@@@@@AA@@AAA@@A@@@A@@@@AA@@@@A@@A@A@@A@@B@@@@A@A@@@@@A@A@A@@@A@A@@@@@@@A@@AA@@@A@A@@A@A@CDA@@@AAA@@@AA@A@A@@@A@AA@@@@AA@E@@@A@C@A@@AA@@@@@@AAAA@@AA@E@E@@@A@CAAAAA@AA@AAA@AA@@@AAABA@@@AAA@AA@@AA@AAA@A@A@C@@AAA@@@A@A@@@@BA@A@AA@@A@AAA@@AA@@@A@AA@A@BAAAAA@@@C@@AAA@@@@AA@BA@@@A@@@A@@@A@@@AAA@ABABA@AB@@A@AAA@AA@@@@CAC@AA@AACC@@@@@A@@@A@@@AAA@AAA@@@@@A@A@A@A@@@A@@BAAA@CB@@@@@@A@@A@@@@@ABA@@@AAA@@A@@@@BA@@@ABABA@@@@@AA@@A@@@A@@BA@AB@B@DAB@BC@@BA@A@@@@BAB@B@@@B@BA@@DAD@BA@@@A@A@@@A@AA@@A@@@A@AB@@A@@@A@@AA@A@@@AA@@A@@C@@@AC@A@@@@@A@@@AA@BA@C@A@@@AA@@@@A@A@ADE@@@ACE@@B@@A@A@@AA@A@@@C@AAA@@C@A@@@@B@@ABA@ABCBCBA@@BABC@@BA@AB@BABABAD@@CBA@@@C@@@@BAB@DAB@@CB@@@B@@ABADAD@@CBC@@@AB@@GBC@A@A@C@"],encodeOffsets:[[109356,35445]]}},{type:"Feature",id:"610329",properties:{name:"麟游县",cp:[107.793524,34.677902],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@ACAA@CCEAA@@AC@@AA@@B@BA@@DB@@B@DB@@B@DAB@@A@@CCAA@@@ABAA@AC@@@@AA@@@@@ACA@AAA@AA@@A@A@A@AA@@AAAAACC@ACAA@ACC@CA@@A@@@CAAA@@@AA@A@A@@@C@@AA@@@A@A@A@@BA@@@CA@@A@A@@AA@A@A@A@@@AA@AA@ECA@@A@@A@@@A@@@ABABA@@@A@AA@@AAAA@AAA@@ABA@AA@@@@A@AB@@@B@BAB@B@@BF@@AB@DAB@@B@BB@@@BBB@@@@B@@BB@B@BD@@BBAB@BB@@B@@@B@@ABC@A@GBA@A@A@A@@ACAA@@AABA@A@CDA@@BA@@BAB@@@@A@@@A@CAC@C@C@E@C@A@A@A@@BA@@B@B@BABA@@@@@C@C@AA@@@B@BA@@DA@@BA@@@C@@@CCC@AA@@AA@@A@@@CACAAA@@A@CBABAA@@CA@@CBA@@@A@@@A@@@AB@B@@A@AAA@@@A@@@A@A@@B@@@B@B@@ADB@BBBB@B@@BB@BABABABCBA@@BAB@B@D@BAD@@A@@@A@CACCA@@@A@A@@@A@C@A@CAA@AACAA@@@AA@@@E@AAA@C@CAA@AA@@AA@AAAA@AAC@AA@@@A@A@ABA@@@A@A@AC@A@@A@@@A@ADA@@BAB@B@BAB@@@B@B@BAB@@ABA@@@C@EA@@A@C@AB@@ABA@@BBB@@A@@@A@@BABAB@BABCB@B@@@BA@A@@BABAD@BA@ABA@AB@BA@@BE@@@ADA@@@@AAAA@EAC@AA@@@@@@@A@AA@A@@@ECA@@@@A@C@@A@AAA@@@@AAA@@@@@B@B@HAFAJ@DAB@B@B@@BBBB@@BB@B@@@BABA@@B@@@D@@@@@B@BBBBB@@BB@@B@F@@AB@@@B@BB@B@@@B@@@@@B@@BB@@AD@@@B@@@AB@@@@BADBB@BB@BBB@BB@@@DBB@B@@@BAB@@ABA@AA@@AB@@AB@@@BAF@B@@@@EBA@@BAB@DBB@B@@@BA@@B@BABABA@@@A@ADCF@@@B@@@@CB@BABAD@DAD@BB@@B@B@@@BA@@BA@A@A@@B@@ADAD@BADAB@BA@A@A@C@A@@A@@DCBA@A@@@@A@A@@BABABABABA@ABA@A@@A@@CB@@ABAD@@@BADAB@@AAAB@BAB@B@@@B@@@B@DCDAD@@@@@D@@A@ABA@@@A@@BAB@@@B@@@F@@AB@@@@@B@BA@@BA@@@CCACA@@A@@CA@@A@@@@@@@@@@@ABAB@BAB@BBD@@ABAB@BAD@B@@AB@BA@A@A@C@A@A@@B@@@D@@@B@B@B@BA@@@AA@@A@C@@@@@AB@B@BAB@@A@ABA@A@ABAB@@A@A@C@@@CAA@@@A@CBA@ABA@AAC@ABA@A@AAAA@@AB@@AA@@C@ABA@A@AAA@AAAAA@A@@@@AA@@A@@C@AA@@@@C@CB@@A@A@AAA@@@A@A@C@A@C@A@CA@@C@@@@@A@@@@B@@ADABAB@@@@CCA@@AA@C@A@@AB@BA@A@@@ABA@@BA@@@CB@BCBABC@@AAA@@@AA@@KF@@AB@@A@AA@AA@@AC@@@C@A@AA@AAA@AA@A@@@AB@@ABAB@@@@@B@BBB@BAB@@BB@@@@A@@AAAAA@@A@@@EBE@AAC@@B@@AB@@DB@@@@@BA@A@EC@@@@A@@@B@@B@@@B@BBB@@@BB@@@BB@B@@@BA@C@A@CBC@@@AD@BA@@BCAC@@AA@@ACC@A@AA@AA@@A@@@CBABAB@BA@@B@B@@A@AA@@AAA@A@A@A@ABA@ABA@C@A@@AA@@A@@A@@AAA@@A@EA@@A@A@G@A@@@AB@B@@A@A@ECA@@@AA@@BA@A@@B@@AB@@@B@@A@A@A@@@AAA@ABCBA@@A@ACAAAA@A@@A@AAA@AAAAAA@AAA@@AAAA@@@@AD@@A@@@A@AAA@A@AAA@@@CB@B@@ABA@@B@F@@@@BB@@A@@BB@@B@@@BAB@@AB@@@BABAB@B@@AB@@ADA@AB@BA@@DADA@A@@@@@CC@@AAAAAA@@A@@@@@C@AB@ACA@@@@@C@@@@@AAA@@A@ABCB@@@@A@AB@@@@@BAB@@@@C@A@A@@A@A@@@A@A@@AA@AAA@A@AA@@AA@AAAA@@@A@@@@ABABA@@@@@A@@AB@@ABA@A@AAAA@AC@AA@AA@@@@@CA@@AA@AD@@AB@@ABAB@@AD@BB@@BB@@B@BB@@B@@CB@BA@@B@BBBBBBB@@@BBB@@B@BBB@@@@@AB@@A@A@C@A@@B@@A@@BA@AA@BBB@BB@BA@@BB@@@B@@DB@@B@@@B@@B@@@D@@@B@B@B@@@@@BAB@BBB@B@B@@@BD@B@@@@BB@@BBB@B@@@B@B@@AB@BA@@D@@ABB@@B@BBBBDB@@BBA@@@@BA@@@@@@BB@@B@DB@@BABCB@B@@@@B@B@DAB@@@B@@@@A@C@A@A@A@@@@BAB@@AB@BA@@B@@@B@B@BDBD@@B@@AB@B@@@@@@B@@B@B@@B@@@BAB@@@B@@@@@@@@B@@B@@B@@BB@@@@BAB@@@DB@@B@B@@@B@@@@@BB@@@@FDBB@B@@D@B@@@@@@B@@C@@B@@BB@@A@AB@AA@@@A@AA@A@@AB@@A@@@@B@@A@A@@B@@@B@BB@@@@DABAB@@B@@@@B@@@@AB@@A@B@@BB@B@@B@@A@A@A@@B@@B@@@B@DBB@@@B@@B@B@@@@@B@@@B@@@@@@@@@@@B@@AB@@@@B@@BB@@B@@B@@@@B@BB@@B@@A@@B@@BB@@@@@B@@AB@@BBB@@BAB@@@B@@BB@@@@A@@@BB@@AB@@@@AB@BBBBBBB@B@D@@@BAB@BA@C@@@@@@@@@BB@D@@DD@@@@@D@@@@BBB@HDB@@B@B@BDBD@@@@@@B@B@BAB@@@D@@AB@DAB@B@@AB@BC@@@@B@@B@B@B@BA@AB@B@DAD@DA@BB@BDB@@B@B@B@B@@ABADCDA@CA@@@@@B@@DB@@@BBDAB@B@D@BABCDABA@AD@@@B@B@@BFBD@F@@@B@@C@A@A@@@@B@B@F@B@@@LFBFBDFDDFDHFHDHBHBH@HAJAH@FAD@HAF@B@@AB@FCB@HCDCFAHCJADAJAH@J@FBFBJBH@FAF@B@FADAHAFCF@JBJALEHAH@JBHBDBDF@D@H@H@FBD@DHHLDHDDHBDBFBDBJBHDHBDBBFDHFF@@@HAHAHCFCDAHAFCHAHAFAHDDDJDF@B@F@DCHEDEDC@ABEAA@EAC@A@A@A@A@EAA@AAA@GACAEACAEAACA@@B@B@F@FBD@F@B@DCB@AD@B@B@B@B@DAD@B@@@D@@@B@B@@BDB@F@B@BADCB@@@BBD@B@B@D@@BBBBBB@@@@DBBBDDB@BBABBBB@B@@DAB@@B@DBB@BB@B@@@B@BBBBBBBA@@@ABA@A@@B@B@BBDD@@DBDABAB@BD@@F@B@DAB@B@B@BBB@DAF@B@BBBDBD@@B@BB@B@@@@BB@B@B@BB@@@@BBB@@B@BA@ABA@@@A@@@ABA@@@C@G@AD@LBFBFBBBH@B@HAD@@@HBHFRBJDFBLDLBB@JANALCLCDC@@@@B@ENADAD@@B@BBBBBDB@BBBBBBD@B@B@D@D@DBB@BA@@@A@@BA@@@@A@AA@@B@@@@A@@AAA@BA@@@A@A@A@@B@AA@AA@BA@@B@@@@C@@@@B@@@@A@@@@@@@AB@@A@@B@@A@@B@BEBABA@@BA@@BABABC@@@C@A@A@C@@@@@C@@A@AAAAA@@@AA@@@@BA@@B@AAAA@A@@@@BAB@@A@A@ADCBA@A@@@A@@BC@@BAB@@@BC@A@A@@@A@@@A@@@@B@B@@@B@@AB@@A@@@A@A@@@@BAB@B@@@@A@A@@@@@A@@BB@@B@BB@@@@@A@@@A@@AA@@@A@A@CB@@AFCDABA@A@A@A@A@A@AA@A@AA@@A@@@AA@@AB@B@@@@AB@@AAA@@@@@@AB@@AB@@A@A@@@@B@BA@@@ABA@A@@AAAAA@@@B@@AB@@@@@@@AAG@@A@@@@BAB@@@D@D@B@@BB@@B@@BB@CBA@A@A@@@A@@DCBA@ADE@AB@@@AA@@AA@@@AB@@@DAB@@@@A@A@A@AAE@AAGAA@ABC@ADCB@B@FBF@B@@A@@@AB@B@BADB@@@B@BBB@D@B@@A@C@@B@@@BBBA@@@@BA@@@@B@@@@FB@@BB@@B@@@AA@@@A@A@AB@B@@@BA@@BB@@B@@AB@B@@@@A@@@A@A@A@ADC@@@ABAB@BA@@FBB@@@DA@@D@@AB@@BB@D@DAD@D@@BB@B@@BD@@@B@@BB@@@D@@@@@DBB@FBB@DBB@D@DBB@@@B@F@D@@BBBDFBDBF@BBBBBB@DDB@@A@BB@B@BA@AB@B@@@DBB@@AB@@A@@AAGC@@@A@@@@@@@ABBD@@BBA@@B@@@@A@@@C@A@A@A@ABC@A@@B@@A@@@@@C@E@AAE@C@@AABAAA@A@@@C@A@@@ABABA@@@E@AA@@@@AAA@@@@DC@AB@@A@C@C@A@ADGBCB@BAD@BA@@@AB@@AB@B@DA@ABA@@BADBF@FA@@BAB@@@BB@@D@@@B@BBB@DBD@B@@@BAB@@ABAB@@A@ABC@@B@@AB@@@@AB@@@@A@@A@@A@@@A@@@A@@DC@AB@@A@@@AB@@@@A@A@@B@@AB@B@@@@A@@@@B@@@BA@@AA@A@A@@@A@@@@B@@A@@@A@@@A@@@A@A@@BA@A@@@@@@@@@ABA@@AA@@@@B@@A@@@@@A@@@@@@@A@A@A@A@A@ABA@A@@@@BA@A@@@AB@@@@ABAAA@AB@@AB@AA@@@AAA@A@A@@@A@A@@@A@@@A@@BA@@B@@@@@@A@@B@@@B@@A@AB@@A@@@AB@@@BA@@@A@@B@@@@@@AB@@@@ABC@@A@@A@@@@BA@A@AA@@@@A@@BA@@@@AA@@AC@@BA@A@A@@@A@@@AB@@A@@@A@@BA@@@@AA@ABA@CA@BCAA@A@@BADA@A@A@A@A@@BAAC@C@@@ABA@@@@A@@@A@AA@@@AAAA@@A@@B@@@DDB@@@B@@A@A@AB@@@BA@@@A@@B@BCB@@@@CB@BA@@@A@@@@CA@@@@@@@A@AB@@@B@AA@@@@AA@A@@A@@@@@AB@@@@A@@@@@@A@@@@@C@@@AB@@A@@BA@@B@@@BA@@AC@@A@@AA@@@@AB@@@@@B@@AB@B@@@B@@@AA@@@A@@@@@@BCB@@A@@@@@AA@@@@@@BA@@@@@@C@AA@@A@A@AB@@@B@@@@@BA@@@A@AB@A@A@@A@@BA@@@A@ABA@@@@@@@A@@@@@@@AA@@A@@B@@@AA@A@@A@@@@@BA@@@@A@@@@A@@BA@B@@@@@B@@B@B@@@BA@@@AA@@@@AB@@@@@AA@@@@B@B@@@@AB@@@@AA@A@CBA@@@@@@@BA@AC@A@@@AA@A@AAA@@@@A@AB@@A@C@@@EDA@A@@AA@@@ABA@@@@@@@BA@A@A@@@@@@A@A@@@A@@AB@BA@A@@@@@AA@AA@BCBA@A@AA@@AA@@@@@B@@A@@B@@EAC@A@C@@@@@@@AAB@@A@@BAA@@@@@A@@@A@E@@@CA@@AA@@@BA@@@@@EBA@A@A@AAA@@@AD@@@@AB@@@B@@A@@@@@C@AA@@CB@@@@@@@@BBBBBB@@@B@@@@AB@@A@A@@@A@@@@@@A@@@@@@A@@B@@BBAD@@@BBB@@BB@@@B@@@BCD@@@B@@@@A@AAA@@AE@AA@@AAAAA@AAAA@@@@AAB@BC@@@@@AA@AB@@@A@@BAB@@AAA@@A@AB@@A@@A@@@@@A@@A@@@@@@AB@@AA@@@@@@A@@A@A@@AAA@@A@@@@@A@@B@A@@@A@@@@A@@BA@A@@B@@@@@@@@A@ADABA@BBAB@@@@A@CAA@A@A@CAA@@@ABB@@DABABA@CD@@A@ACA@CACA@@A@@@@@@B@@@D@B@B@@@@A@A@@@@@AA@@@E@@@A@@CAA@AAA@A@A@A@AAG@C@@@CAA@CB@@@@AACCA@AAA@A@@@@@A@A@@BA@@B@@A@A@C@C@@@@@@AB@A@@@A@BA@@@@@AA@@@A@@@BAA@@A@@CAA@A@CBE@ABA@@AA@"],encodeOffsets:[[110460,35440]]}},{type:"Feature",id:"610330",properties:{name:"凤县",cp:[106.515803,33.91091],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@A@AB@B@@A@@@A@@@ABA@@@AB@@@B@@@B@@@B@@CBA@AFE@AB@@AB@@ABCAA@@ACAAB@AC@AA@C@A@E@AB@@AAA@C@A@AA@@A@@A@@AA@A@@@AA@C@CAA@@A@@@A@EAC@A@@AAAA@A@@ABA@@A@ACAA@@A@A@@@@AA@ABA@@BA@@B@@@@A@A@A@A@CAC@AAA@A@A@A@A@A@@AAA@AAA@@A@@AAA@@BA@AB@BA@@A@@A@@@CA@@@@AA@@AAC@AC@@C@C@@ACA@A@C@AA@@AAA@A@ACBE@E@ABA@A@CBABAB@@ADA@CBA@@@@BA@BDDB@DAFAFBF@HAD@DDB@BCNCJAJAHEH@@ELCDCHGHCJCHELEFCJGJGL@FEHIHGDKFE@G@C@EBI@G@K@IDMFGD@@CJAFBBBFDFBFBHBF@JAF@HBH@BBF@F@H@F@HAFAFCHAFCBADGDE@IDEDGF@BAFBHDJFJBJBH@@@HHFFFFDHDHFLDFHAF@JABAFCHEH@@CFIFCB@BABAB@B@DAB@B@D@BABA@A@A@A@C@A@AB@BAB@BAB@BAB@BA@A@CAC@@A@@@A@@B@FA@@B@@AAA@@AAA@AAEAAA@@ABA@@@A@@@@A@AB@@AB@B@B@BA@@@@@AC@CAQEI@I@K@EBCDADABCD@FAJBJ@B@LABADAFADABAB@@ABCD@B@DHD@BFBD@BB@@DBBBBB@BBBDBDDDBDDBBBFDDDB@@DBFDBBBDDJDFHFDHHDHFDHFDDFHJBBBDDHDL@H@F@HAFCHAHCHCJ@JAJDHFHFFJDBBDBJHHHHFHJDD@@BHBH@HAJ@JAHCH@JALAJ@HDBHBB@HDHBJAL@H@H@L@L@JD@@B@DA@@B@B@BBB@DAB@@B@DBDHLDH@B@@B@B@@@@@B@AB@BLN@BFJBBB@DB@B@BBB@FBDCFCDCFCFCFAHAFCJAL@HENAHALAJAJCLCJAJ@FCFEJCJEFIHGFGBGBGCCCECCCEECACCAAMCGAMDAFAF@@@FBHDHFFFHHJHJHLDHB@FFDHJFJ@HAD@HCFAJCJ@LADALAJAHAFEDGDMFSFGHANADALD@@HDFDFDHDJBJAD@DCFCFEBCDCDCHC@AFAJ@D@HAFDHBFDHFDDFHDHBFDFBFBH@@BDDHFHFHDDLK@@B@F@B@B@@AB@FCDAB@B@@AB@@A@@DAB@D@B@@BB@B@D@@@DABABABA@AB@B@B@FBFDB@@@FDD@@@BD@F@B@B@B@BDB@@@DBB@@B@@@@BB@@BB@B@B@@@DBBBBBDD@@A@BB@B@@BF@B@@BB@@B@B@@@BAB@@@DA@@BA@@DAB@BBB@BAB@@@B@@@B@BB@D@@DB@@@@BABABABA@@B@BB@@D@B@D@B@@@B@B@@A@ABAB@BABABAB@@A@@@@BB@@B@B@@@D@@@BBB@B@@@B@@@D@D@D@B@BBB@@@B@@@B@H@B@@@B@@@@@@A@A@@@ABCBA@ADCBAB@@@D@BBB@B@@@B@@A@@FCFCB@@@@ABA@A@@BABAB@BA@@BBBBDBB@BB@B@@B@@@BAB@@A@@@AB@B@BA@@B@@@@@@B@B@B@BBBBB@@@BA@@B@B@@@B@@B@@@AD@@BB@B@B@@@B@@@B@@@B@@D@BBB@D@@@BADABAB@B@B@BBD@@@DABAB@@@B@B@DBBBD@@@D@@@@@BABBDBD@@BD@@AD@DA@AB@@ABA@A@@@A@@BABA@@D@B@B@HAB@DA@@DA@@@AB@@@AA@@@A@@BADABABAB@BCB@@@B@B@B@BABAB@BA@AAC@@BC@A@@@A@C@AAC@ABA@A@AAA@@@@BA@A@A@A@@AA@A@A@A@@BA@A@@BCBA@@BA@AB@B@B@B@D@D@B@BBB@@BF@D@FAF@B@FCBAB@@@B@B@FB@@BABCB@B@@@BAB@DAF@DA@ABA@@B@@AB@BA@@B@@A@@BA@@@A@@AA@@@@@@D@BB@@B@@AD@@@D@FAD@@@BA@@B@DA@@@ABA@A@@@A@@@@@@BA@A@A@A@@BA@@@ABAB@AC@CB@@A@A@@@ABA@@B@@A@@@AB@@C@AB@@@BAB@BAB@@@FBD@D@B@B@B@DAB@B@@@@C@@AA@A@A@A@AAA@A@AA@@ABC@C@ABAB@@A@@@AB@BA@@@A@A@@BAB@B@DAB@@@@A@@@AAAAA@CAA@A@@B@BA@@B@@A@@BA@@@@@A@@DABA@@B@HDB@FBB@@@B@BAFG@A@A@AB@@A@A@@BA@@BA@@@AA@@C@@@A@@@A@AAC@@AAAC@A@A@AB@@@D@B@D@D@B@BABA@AB@@AD@B@@AB@B@@@B@@@B@BA@@FADA@ABABABABABC@@@A@AAA@CBA@A@ABA@ABA@@@A@ADE@@@C@ABABCDC@A@ABA@@BABABAB@@ABA@@@E@A@A@A@@@A@C@@@AAA@@@A@AAAAA@@@A@@@A@@AAAAAA@ABA@A@A@@BA@AB@@A@A@A@A@AAA@@@A@@@A@A@@AA@@BEAA@A@A@ABA@A@@AAAC@@@A@AA@A@AA@@@AA@@@CA@A@A@@@@@ABA@A@A@A@@@AAAAC@AAABAB@@@BC@A@@@CBABABABABC@ABA@A@@DEBABADA@@DADA@ABABA@ABA@@@ABAB@@@@AB@@AAA@@@AAA@ABABABA@@BAB@BA@AB@@@@@@@@AA@BA@@@A@@@@@A@@BA@@@A@@@@@@@AB@@@@A@A@@BA@@AA@A@@BAA@@@@A@@@@@@B@@A@@@@@A@A@@@@@@@A@@@A@AB@@A@@BA@ABAB@@ABAB@@ABB@AB@@A@@AAA@@AC@C@C@@@AAA@@@A@A@A@ABA@A@ABAA@@A@C@@BAAA@CBCAA@ABABC@AAAB@@A@@@A@CC@@C@AAA@@@@AAA@@AA@@A@AAC@C@AAA@CBAA@AAA@@CC@ACC@@CA@A@@@AB@@A@@AA@ABA@@@A@AB@@@B@BA@@BB@@D@D@@@@BD@BB@@@@BBB@@BDBB@B@B@@@BA@AB@BAHA@@B@BB@@B@A@@BB@@@BB@@B@@@AB@@B@B@@BB@@@B@BBBB@@D@@B@@BB@@@@@BB@@@B@@BB@@BB@FCB@BA@BB@@@@@BB@@BBB@@@B@B@@@BAB@@@BAB@@@AA@@B@A@AAE@AA@@AAA@@AC@@@CA@A@@AAEEA@AACACA@ACA@AACAA@@A@ACA@CCAA@A@@@A@AA@@AAA@AAA@@@@C@C@@@AA@AC@@@ACA@AB@@@@A@ABA@A@@@A@C@EA@@GA@A@@CAA@AA@@@AB@@A@@@ABA@A@@@AA@AAA@@A@@A@@@@A@@AC@@CA@@@CB@@AA@@CBADA@AB@@A@ABA@@B@@@BA@AB@B@@A@@@@CC@AAA@AAA@@AA@AC@CBA@A@CA@@ABA@A@A@AA@@AAAC@@A@@AA@C@AAA@ACAA@@AA@A@@@@@A@@@@FC@AB@@A@A@AAA@A@A@AAAB@@A@A@C@CAA@@AC@A@@A@@AEAAAAA@@@@@A@@@@BABCBA@@BA@A@@ACBA@ABAB@@ABA@CA@@AFCBA@@@@@AACAC@AA@@@BABCBA@AA@AA@@@AB@BC@ABE@A@@@AAA@@@@@CAAC@AA@@@@@BA@@BABC@@@A@@@@@CCCA@AAA@A@AAABAA@AC@CA@@@ED@@E@C@A@C@A@@@A@A@A@A@A@EBA@C@@@@BABA@C@A@AAA@A@AA@BA@CB@BADABCFCAC@@@AB@@A@@@C@A@ABABGDCBABCBA@@BABA@AB@@A@A@@BAB@@GDE@CAABC@@BAB@B@B@B@B@B@BABCBA@@@C@@@@@ABA@ABCBA@C@CAA@ABABA@@BAB@B@@@B@B@BABA@ABA@@@AAA@@@@@AB@@@BB@@B@@AB@@ABA@EB@BABA@@@AB@B@D@B@@AB@@AB@@A@@@AAA@@@@BA@A@C@@AC@@A@@@@AAA@@@@BAB@@A@A@AACC@@ABA@ABAB@@AB@@CB@@A@BDB@BD@@B@BBADABA@AB@BA@@B@@@B@@@B@@C@@@C@AAEBA@@@AB@@ABA@@BA@CB@@A@A@CFAB@B@B@@@@@B@@CA@@AB@@@D@@@@AB@@@B@@AB@@AB@@@@A@CA@@@B@@A@A@@ACAACA@BA@A@@@ABC@AAA@AAC@@BA@@DA@@@@@@AA@@AA@AA@@@@A@C@A@@AC@A@@BCAA@@@AA@@@ACAAAAAACAAAAAAAA@@CA@@@@@AC@A@A@@ACAA@E@@@AABC@CBAB@@A@A@A@A@C@E@ABCBA@@@@@ABA@AB@BA@@@@@@A@@@ABA@@@AA@@C@@AAACAAAA@AAEAA@AA@AA@AAA@AAA@C@ABA@CAC@@AABC@A@A@AAA@CCC@@B@@ABA@CBA@A@@BAB@@C@ABAA@@@@@CB@@AA@@@AB@@@@CA@AAA@@AA@BAAABA@A@A@@BB@@B@B@@@B@BA@A@A@A@A@@DA@@BC@C@@@A@A@A@A@ABC@A@@C@@A@@@C@@CE@A@@AA@A@AB@BA@A@@@A@A@@AAA@@A@@@A@@@A@A@A@@AC@@CCACA@@@@@AA@@ACAC@AAA@A@ABG@A@A@@BAB@B@D@@AAC@C@@AAAA@ABA@EAE@AAABA@ABCD@BABA@CAAAA@A@AAA@CAA@A@@C@A@@A@A@@BAB@@A@@ACAAA@E@AAA@C@C@A@C@A@EBA@@@EB@@CBAB@@A@ABA@AAA@A@AB@@AB@@ABA@CB@@ABA@@BEBEBCBA@A@CB@@C@@@CAA@EBE@CBC@CDC@@@A@C@I@C@ABA@A@CAA@CBGD@@C@IBABGBA@CB@@A@@@AAA@A@@@ABA@@BABABA@A@A@E@A@GD@@AAC@@@AAB@BC@@BC@@@@B@B@B@D@DADA@@@ABA@@@AB@@@B@D@BBB@DA@@@@BA@C@C@A@@BABCDADABABC@@BADC@@AAC@@AAAAACAA@CB@AA@@@C@@@CCA@CAAA@AAA@@@@A@C@A@C@AAA@AAA@A@IC@AC@A@@@AB@@AB@@@BCAA@A@@@@@A@@@AAABA@@@C@@@A@A@A@AAAB@@A@@@CCA@A@A@A@@@A@AA"],encodeOffsets:[[109203,34468]]}},{type:"Feature",id:"610331",properties:{name:"太白县",cp:[107.319116,34.058401],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@CACA@@A@@@A@@BA@C@E@EAA@AAA@AAAA@A@A@@AA@A@A@@AA@AAA@@@@ABAB@BA@A@ABAAC@A@A@A@A@CC@AC@@AA@A@A@A@@AA@@@A@@@C@@@C@A@A@@@A@AA@A@@ABA@C@@@A@@BA@CB@BA@@BCBA@A@AB@@@@BB@B@B@B@BBB@@BD@@AB@@CB@@AB@B@@@@A@CB@@@BCBA@ADEF@DA@AB@@@B@@BD@@@B@@@BABABA@ABA@A@@BA@ABAD@@@@CBCDCB@@A@C@@@A@A@@@AAACAAA@AA@@AA@@A@@@@@AD@@A@@@A@A@AAA@EBA@@@CDAB@@A@CBA@A@CBA@A@CBABADA@A@C@@@C@A@A@A@A@ABA@@BA@A@@@AD@B@DA@AB@B@@@@A@@@CBA@ABC@E@A@@B@BAB@B@B@@BDBB@DBB@B@F@B@D@@BBDD@@B@FBB@@B@@@@@@ADC@ABC@@@AB@@@@A@@@@B@@A@@@AB@@BBABA@ABA@@B@@@@@B@BAB@F@@@D@BAF@B@B@B@@@BAB@D@@B@@B@BBBB@@@@B@BA@@B@@@B@@BBB@@@BD@B@@BBBBBBDD@@B@B@BB@@@@B@D@BA@@@@D@BBB@@BB@@B@@@BB@@B@BA@@B@@BB@@BB@B@@@B@B@B@B@BB@BB@@BD@BAF@B@BB@BDB@@BBB@BB@B@DBD@BBB@@@BBBFB@@@AB@@BB@@@D@DBB@B@@BB@@@B@@A@AAE@C@@@C@@BA@@BAB@B@@CB@@ABABA@@@A@A@CEAC@A@AA@@A@@@A@AA@@C@@@@A@EA@@A@CA@@@AAAACCC@@AA@AAA@A@@@@AAA@AAA@CC@AA@A@@@AB@@@@ABEBA@AB@@A@@B@B@@A@AA@@A@@AA@ABA@A@@@A@@@ABA@@BC@@BC@@BA@@AA@@@AC@@AA@@@AAA@A@ABA@@AA@@AAA@A@@AA@CAAAA@A@A@AA@@AA@@AAEGAA@@A@EAC@A@A@@AAAACAAAA@@@@AAC@A@@AA@A@A@A@@@A@CCA@@AA@AA@@@ABC@@CC@@@A@A@AB@AA@@@A@@A@@@CCA@C@A@A@C@AAAAAAAAAAA@@AA@@A@@A@@B@@CB@@CA@@AB@@@BA@@@AAC@A@C@A@A@ABA@ABE@CBEAA@A@A@@B@@@B@DBB@@ADA@AB@@@@@BAD@BBDADADAB@B@@C@AB@ACBA@ABABADAB@BAB@@AB@BAB@@CB@@AD@@@@AB@@CB@BAB@@@BA@@@AE@@A@@@AAC@AA@@AAAB@@A@@B@B@B@BABA@ADA@ABAB@@AA@A@AAAA@AAAAA@@@AA@@CAEECA@A@@ACCC@@A@@@@B@D@B@B@@@B@B@BBB@D@BB@@BB@BBBBBB@@@@@B@B@@@@@B@BAB@B@B@@AB@@@B@@@BDD@D@@BBBB@B@@B@@BBBAB@BAB@DA@C@A@A@AA@@AAA@AAC@A@AC@A@@ACA@@@A@@@CBIBABC@@@@BABCF@@AB@@@B@D@BA@@@@@C@@@C@@@A@AAC@A@A@A@C@A@A@AAA@C@AAE@@@ABABCDCDAB@@ABCBABA@A@AD@@@B@@@@@B@@@B@@@B@BAD@BCBABA@@BA@@B@B@BBB@BB@@B@@ABEDAD@B@@@B@@@@@B@DA@@B@@BBB@@BBB@@@@ABAB@B@BAD@@@@BBBB@@DFBD@@@BA@@B@B@@@@@BBB@@BB@BBB@BDD@@@@@BA@A@@BAB@@A@@@AB@B@BA@@BCBAB@DB@@BA@@D@@DB@@BD@@@B@@B@@@@BB@BBB@@B@@@BAB@B@@@BA@@B@@BBB@DB@@@BHB@@FBD@B@@@B@B@BAB@@@@@BAB@BD@@D@@BBB@@D@D@@@@@BB@BBB@BB@@B@B@@@BBBDDB@BDB@@@BBBD@BDB@BDBDBBBB@FFBB@@@BDB@@D@@BB@BB@@BBF@BBB@A@@@BB@@A@AB@@A@AB@@A@A@@@A@AA@@AA@@@@A@@AABA@EDA@@AA@@AA@@@A@@A@@@@AA@@@AC@@@AAAAA@@@A@@AA@A@@@BA@@A@@@AA@@A@@AB@A@@@AAA@@@GBABA@@BAB@@A@A@A@CA@AA@AA@@@@AAC@@A@@C@C@@@AA@@ABA@@@A@@B@B@@AB@BBB@@@BA@@B@@@BDB@@DD@BDD@@BB@BBBDAB@BBD@D@BBB@@@BB@@BB@B@@B@BBD@@@DDB@@@B@@@BABBD@BABAB@DBDAB@BB@AD@B@@@BBBAB@B@BAB@B@B@@@B@BB@@D@D@D@@BB@BB@@@BA@@BAA@BA@AB@BA@AB@BAB@@@BA@@B@B@@@B@@@@@@@B@B@@@@@BA@@@@@@@@B@@B@AB@@@BBB@@AB@@@B@B@@A@@B@@@@@@@B@@AB@@@B@@@@@B@@ABB@@B@@@@@@A@@BABA@AB@@ABABAB@BBB@B@@BB@BA@@B@@A@AB@B@@AB@BABAB@BCBCB@@CBABABCF@@@BAB@BADABABABAB@D@@@BAD@@A@ABBB@BBDBB@B@@@B@B@BAB@B@@@@@B@BDB@@B@@B@@BBB@B@@B@B@@BDBB@@@BAB@B@B@BBBAF@@BB@@@B@B@@@B@@BB@B@B@B@B@BA@@BAB@@@B@BAB@BBBBBBB@@@B@@@B@@BBBB@B@B@@BB@B@@@D@B@@@B@B@B@F@@AB@BA@ABABAB@@AB@B@BCDADAB@B@D@@CF@B@B@@AB@BAB@B@BABD@HB@@B@BB@@@B@B@B@@BBBD@BB@B@@@@@BBBBB@D@@B@@AD@@@B@D@B@BBBBB@@AD@BADCD@@BBBBFB@@BHBD@BB@@@FBH@H@BBB@BBB@@@DBB@@@DD@@B@BBBA@@D@BA@@BAJCD@D@B@@@F@@@BAFEF@D@DAB@D@D@BBB@B@@@BA@@@AB@@@B@B@FDBBD@B@D@HCHCDAB@B@BA@BHBD@B@FA@@H@BAD@J@D@@@BAB@@ABA@A@AB@@@@@BBBBDBB@B@@@D@@@B@DAB@DABAB@DB@@HAFADAB@@@B@@B@B@DAB@@@BDBDFDDDBBBHB@BBF@B@B@B@@@@B@B@@B@@BB@@DB@BDB@@BB@@BAL@D@B@BADA@AD@BAD@F@B@@@B@@AF@B@D@DAB@B@@@B@B@B@@@B@@A@@BAJCB@B@B@B@@@BABAB@@B@@D@B@B@@@BA@@DC@@D@@@@BBB@@B@@@B@@B@BB@@@D@@@B@B@DDB@B@@BH@B@@@B@@BD@B@@@BABA@@B@@A@ABAAC@A@@BA@@B@@@@@BB@@B@@AB@B@@AB@BA@@B@@@BBB@B@B@B@F@BBBBDBB@B@BBB@BBB@@B@@BD@BDDB@DBB@BBBBB@@@DA@@B@B@@@@@@ABABE@@BC@@B@B@@A@AB@@@DB@AB@@@B@B@B@B@DAB@D@B@@AD@BA@@A@@A@A@@@@@AB@BAD@@@BA@@F@DCB@BA@@@A@AFABAD@@A@@B@@C@@@A@A@C@@D@DCDAB@DADCBAB@BAB@B@B@@A@@BA@@BAB@D@HB@@BBB@DDB@@@B@@@B@@B@B@@@BB@@B@B@DBD@@HBB@DD@BB@@@B@BA@BB@BB@BBB@B@@B@BAB@B@@@BAB@@@B@B@B@DBB@F@B@BABA@ADC@A@@@@B@BBBBBBBDDBB@@B@@@B@BB@DBBB@@@@B@B@D@B@@BD@@B@@B@B@B@@@DD@@B@B@BB@@@BBBB@@@B@DBBB@@BAB@@AF@@@@@@@@A@@BA@@B@B@@@BA@@BA@AB@@@@CBA@A@@BA@@@@BBDDFDDD@@HBB@B@@@B@D@@@@@BA@@@@@@@B@@@D@B@BB@@@BBB@D@D@@@FADCB@@@B@@@BBBD@BB@BB@BDB@BB@B@D@B@B@@@@@BC@ADA@@@CAEAAAA@AACA@@AA@A@@@@@@@FA@@@CB@@@B@DCDA@@BABC@ABA@A@A@@@A@@@A@AFC@@@@B@BB@@DAB@BAB@D@@@B@@@@@@DBDBB@BBB@@B@B@B@LEBADA@@BAB@BADAB@@A@@BB@@@@@B@@BB@@BBB@B@@@@BB@BBB@@BB@B@BB@@B@@@B@@@@@B@@@BA@@B@@@@BBB@@@@B@@@@B@B@@@@BBB@@@@B@@@@B@BBB@@@BBBB@@@@@B@@CFAB@BA@@DB@BDDB@@JADCDA@@B@B@HAF@@@DAB@B@BA@@B@BADA@A@ABA@@B@BAB@D@B@BABABA@@B@@@BADC@@BA@EB@@@B@BBF@@@@@DAB@B@DBB@@@@@AAAA@C@AAA@@@ADC@@B@@@BABADC@@@A@A@@@AAC@@@AAA@AB@@AA@@A@@@@@ABA@@@@@A@@AA@ACAA@AA@@@A@@@A@A@ABA@AA@@A@@AA@AA@@@BA@A@@@C@A@ABA@AB@@A@C@AAA@@AAAAACEE@@@ABA@A@AB@DC@C@@@AB@@ABAAA@A@C@@@C@A@C@@BA@@D@@AB@BA@@BA@@BA@C@@BAB@BA@AB@BADABAB@BBB@@AB@BAB@@ABAAA@A@CAA@@@@BA@@BAB@B@DCB@@ABA@@@AACAA@A@@@C@@AA@A@C@@@AB@@AB@@EBA@@BADADAB@BA@ABCBA@@BA@@@ABC@@@A@C@A@@@@@A@A@A@@BGB@@ABABABA@@B@D@B@@@BAB@FAB@BA@@B@@AD@J@D@@@DB@@FA@AB@B@BALAD@FCB@@A@AAAAACGAC@A@ACCA@AA@@AA@AA@@CCCCC@@AA@A@@@@D@B@B@@@@A@@@AA@EAA@AAAAAA@@EAG@AAA@AAAAEAAAA@E@A@C@AACACAAA@@AACAA@CCAACCCACACAC@AAC@A@AA@A@A@A@C@CBABCBA@A@@@A@A@ABC@@EEAC@A@C@A@CBC@E@@@A@A@CAC@AAA@AAACGAA@A@ABC@AAE@ABA@ECEAC@@@A@@@A@@@A@@C@@AA@AA@@@@B@@A@@BC@ABC@CBE@A@AAAA@@A@@@A@A@A@A@A@@@A@@@@@AAC@AAAAA@@A@A@@AA@@C@AAA@A@AAA@@AA@@@C@AA@@@@AAA@@@A@A@ACAAA@@A@AAA@AAE@AA@@C@C@@@AA@@@A@ABA@CBCDC@AB@DAB@B@B@@A@@AAAAA@@G@AAAAAAAAAA@AAAAA@AC@AA@@A@AAAAA@@@AAACCAACA@@AAC@@@A@@CAAAA@@BABC@@@A@@AA@@@@B@B@DA@ABA@@BCBA@ADA@@B@@@DBB@@@BA@ABABABADCBABAD@BAB@@ABE@@@A@AA@@A@A@ABA@@B@AA@CBA@ABA@@BAB@B@B@@@AC@AB@@ABA@@CAC@AAA@A@AAA@AA@A@A@AAAAC@@A@@A@A@CAA@CA@AAA@A@EA@@AACAAAA@C@C@@@AAC@C@C@@@CBCBGFA@AAC@@AA@@BA@@BCDABABAFA@@@AB@B@B@@A@@@CD@@E@C@EBA@C@AAA@A@A@A@@@CAC@A@AAA@AA@@ABA@E@A@CAAAAAAAAA@AAAAA@A@AA@A@CAEAAA@AECAAA@AAA@@@ADCBA@C@CBC@AB@@A@A@ABA@ABA@ABA@A@A@A@A@@@AAA@A@EA@@A@@A@@@AAAAAAAAC@@A@ABA@A@A@A@@AA@A@@AA@@@A@@AA@AA@@@A@C@@@A@A@AAAA@AAA@GCA@CAA@@@AAAAA@@@C@CBA@C@ABC@A@A@AA@@ABA@CBC@ABCB@@A@A@AAA@A@AAAA@@C@C@AB@@AB@@@@@AA@@A@@@A@@B@BA@@@@A@@AA@@@@ABA@@@AA@@@@@A@@@AAA@AC@A@AAAA@CAEA@@@@@BA@@@CAC@AAA@@A@@@A@A@@CA@@@@CCAACACA"],encodeOffsets:[[110043,34469]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
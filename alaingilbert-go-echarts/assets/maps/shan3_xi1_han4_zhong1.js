@@ -0,0 +1,4 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("汉中",{type:"FeatureCollection",features:[{type:"Feature",id:"610702",properties:{name:"汉台区",cp:[107.031856,33.067771],childNum:1},geometry:{type:"Polygon",coordinates:["@@IHCBC@C@E@CAEAA@AAACCACCEAAAC@C@CBEBABCBCB@BA@A@C@A@@@A@@@@B@BCD@@ABA@CJ@@@B@@@@AB@@A@A@@@@@@BB@@B@BA@@@@D@@@B@BEJADADEDCDABABA@ABCBA@ABC@CBC@GHCBA@C@CBCDCBADAB@BGFABA@EBA@EDE@@DB@@B@@@D@D@@ABABA@@BBB@BA@E@ABC@@@ABA@@@AAA@@@@@@@@BBDBBBDDDBDBBBB@BB@@B@@@DAHBD@B@@@@@JA@@B@BB@@@DBD@@@B@@@@BBB@FA@@B@@@B@@BBA@@@BB@@@@@BA@@@BBC@A@@B@@B@BBBB@BBBBBFJBBB@@@@BBB@@@BB@BB@@@@DBBBDBDFBDBBDF@B@BBB@BBD@D@BBD@@BFDH@DBD@@@N@JAD@DAD@BA@GFCDAB@@A@G@E@ABAACB@@C@AB@@@B@BA@@BA@@BA@@D@B@@@B@@@@ABCBCB@@AD@@A@EBA@CBA@A@A@A@@@C@A@C@ABE@@@A@A@C@CAA@AAAAEC@@@A@AA@A@A@@@C@AB@@@B@B@B@B@@ABABCF@@@@CBC@C@A@@@@@@@DB@BB@@B@@A@EAA@AB@@@@BBBBBBB@AB@@@@A@AACAA@A@@@AB@@ABA@@@CACA@@A@C@ABA@A@@BABABCDCD@@A@@B@@B@DBBB@BBB@BBBBB@B@@@@AB@@C@@@@@A@@@FBBBB@B@B@@BBBDDBBBBDB@BBB@DBB@@HF@@@@ABABAB@@@@@BB@@BF@B@@@B@@ABC@@BAB@D@DAB@B@@AB@B@BA@@@ABA@C@@@AB@FCD@DABCBA@@B@FCB@BAB@BBBBFDFDB@BB@@D@HDB@BB@@BBB@@BBFBDB@@BAB@@B@@BB@F@F@B@B@BAB@FAB@DB@@B@BAD@@@BCB@@@F@DBBBD@B@B@D@BAF@B@B@BBB@@AB@BAB@DBD@@@D@B@B@@@B@D@B@BABAB@@@B@@@BB@@@B@@@@@BCD@B@@@BA@@BBB@@@D@@ABAD@@CBA@@B@@@@B@@@B@B@BB@B@B@D@B@DAB@@@B@@BDBBBFBD@@BD@B@@BB@@@BB@B@@B@@DD@@FBB@@BBDDDB@@@@BAD@@@B@B@BBB@BBBDBBBDBB@@BBBBB@B@@@BB@@@BBD@DBB@B@@@B@B@B@BBBB@@D@D@D@@@FABBB@BBB@D@B@BB@BBB@@B@B@B@D@J@H@BA@A@@@CA@CA@@@AAA@@AACA@AAE@A@ABE@@@@AAAA@A@@@AA@@AAAA@CA@AA@EAA@@@CCCC@@@A@A@A@@@A@C@C@A@C@@BA@A@@@CB@@C@A@@@A@ABA@AB@@A@@AA@AA@AAAA@@@CAA@AAA@@C@AAA@@@@A@@BC@@BA@A@A@@@@B@@AD@B@BAB@BA@@@A@@@AAA@@@C@ABA@@BC@@DABAD@@@FBHDBBBBBBBBDDD@JDBBBHFD@BHBB@@@@@BA@A@@@CDCBABIBA@@FCBABAA@@AAAAA@A@@@A@@B@BBD@BABBBAB@B@BBBADA@@B@D@B@DBB@B@BBDBB@D@D@B@B@B@@@@A@@A@@@@A@@@A@@AAEAAAAAA@@A@@@@@@DBDBD@BB@A@@@CBA@@@ABCAAA@CAA@EAA@C@@AC@C@@@C@AA@@@@@AB@@AB@B@B@B@DBB@B@FAB@B@@AB@@A@AA@KCCA@@@@DA@ABC@@@AA@@@A@A@ABA@A@@@@@@AAC@A@@@AB@@@BAB@B@DBB@B@D@H@D@@@D@@A@A@AAABA@@@A@@@@@@@A@@@@B@@A@@@@B@@AB@@A@@@AA@@ABC@AB@B@B@B@@ABC@@@AB@BABA@@@C@A@@BCB@@ABCBAB@@A@AB@B@@AD@BAB@BABA@@@A@@A@AAA@A@@AA@@@@A@@@@BC@AB@@ABA@AB@@A@@@@BC@@@AA@@@A@@@C@A@C@A@A@A@A@@AA@@@A@A@@@@@BB@@A@@BAA@@BC@A@@@@C@AA@@AA@@@A@@@A@ABA@A@A@A@@A@A@@A@@BA@@B@BA@@BAB@BA@@B@@A@A@@@A@@BABA@@B@B@B@BB@@BA@@B@@@B@DAB@B@BBBA@@@@AAAAC@AA@@@A@@AC@@@@BA@@@@@@@AAA@@@AB@B@@@DCB@@A@@@@@A@@@AB@BBB@B@@@B@BABA@AB@B@B@B@@AB@@A@A@A@A@A@C@@BA@C@A@CBA@C@@@A@@AA@A@@BA@@@@BAA@@AC@@@@A@AAA@@AABA@@BADABA@@BBB@B@B@@@BA@@B@@@B@@@@@@@@@@A@@@@@@@A@@B@@@B@@AB@BADABA@@@AB@@A@@@A@ABAA@@A@@A@A@@@@A@AB@B@@A@@A@A@@@@A@@@@B@@A@@AAA@EA@@@@AA@AAAAA@@@@BC@@BA@@HAB@BABCBABA@A@@CAEIACCACCAGCI@@EACACAA@AAAAAAAA@ACAACAACAECCCAAA@AAC@E@A@A@E@@@C@IBC@@@EDCBC@CACAAAAAAA@AA@AGAK@CACACECIGAA@AEEAAAACACAECEAA@C@A@A@CBABCBCBGDCBCDEDA@ABA@@B@@AB@BAB@B@@BBABADGF"],encodeOffsets:[[109618,33843]]}},{type:"Feature",id:"610721",properties:{name:"南郑县",cp:[106.93623,32.999333],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AKAIIMEGGECGBGAKKGGBEBCBIDEDGDIDGBGDSJEFEDEFCFGNAHAFCFAHCFADEFGFCJIFKFIBGDIB@A@@AAA@AA@@A@@@A@C@@BA@A@@BA@@@A@C@AA@@A@@B@@@B@@@BA@CAG@@@A@A@@@ABA@EBCBODEBADCFAFGLCFA@CHAFGJCJEDGBCFGFGBEFCFAF@JCDFFHHJFFNFR@JFTFNDHDPAHAHIPALEJ@F@F@HBHBF@LCFCJEHCFEFEBODGAGBGDCFCDAJ@HBNDF@FBHBDBFBBAB@BEBG@CBEAA@EACBCBC@G@GCE@ABEBCJABABC@C@E@C@A@CDC@G@C@AACAE@IAAAEACEACE@E@SFEDKBGAE@C@C@EBCAAAAECCCAEAYAKAGEC@EBCFEFCDCD@D@@@H@BABGBEBCAKEC@EBCBG@A@CFCBMAGAM@MCCACEAECCEAAAE@E@AACACECAC@CBEBADIDIBG@G@AAA@EBA@C@@A@G@A@@AA@AAC@@@A@AAC@@CCA@@@ABCBADBDAB@DAH@DBF@F@DAD@FAFBBFJ@B@DCBCBMAG@I@KAU@KBGBEBCBAAAACEACACOAGCIEGGUOICMCCCCEIGCEEEMGEAIAEAOBA@GCEAKEAAAAA@CBA@E@E@C@I@CBMFA@CAA@E@KFEBCAA@CA@@AA@@@AA@@@CEA@AABAA@A@A@ACA@GAAAC@E@AA@@C@]CKAMAC@DN@@@BAB@DABAF@DAB@B@BABADADGR@@@BFF@B@@DBDB@B@@@BAB@BBF@B@@D@B@@@@B@@@B@@@BD@B@@BBBB@@DBB@F@BB@DBD@BBB@BBDB@@@B@BBBB@BBD@@@B@@B@B@BBDAB@B@BDB@B@@AF@@A@@@A@AAE@A@AB@@ABAFAD@B@@ABEDGDCBABA@AD@BA@@B@BBB@BBB@BAB@B@F@DCFGHADA@ABCB@@@BA@@BB@@B@@@BA@@@A@@@AD@B@D@@@B@@@BAB@BA@AD@BAB@@BB@@AB@B@BA@@BABAB@@@BB@@@@B@@AB@BAB@@@BAB@H@BAB@B@B@BBBH@BB@BB@B@BBDDDFBBAB@B@@C@CB@@A@@@@BFFBHBB@BAB@@@B@B@B@@@BA@@@@@A@ABC@EDA@@BCB@@AB@B@BBB@B@B@B@@@B@B@B@@AD@D@BAB@@@B@B@@B@HFDBFDHDFDBBB@DDBAD@H@B@D@BBB@BBB@FDB@HDDBB@B@B@N@F@DBDBFBD@BDDBBDDD@BBBHBDBB@FBBBB@B@FBD@BBB@B@DBD@B@@@JCBAHAB@F@F@BAFAB@B@@@D@DBP@B@BC@ABAB@B@B@@@BCBADCBA@ABC@CBA@CB@DCB@BAB@DAHBBAF@B@FAJBD@B@B@B@B@BBBBDBFBDB@@@@@B@B@@@B@@@@@@@@@B@@@@B@@BA@@@@B@B@B@B@@B@BBB@@@B@B@B@B@B@B@B@B@BBD@B@B@DBDB@@BABC@AB@DA@@B@D@B@@@@BBF@BBDBBBBFF@@BB@BDF@B@@@@CBC@EBA@A@C@@@@@@@@BFD@@BBDB@BB@@HBB@@BB@@@@BAB@@AB@@AB@@@D@B@B@F@@@@@@B@@@BA@A@A@AB@@@BB@@@B@@@@@@B@@@@D@@@@@@BCB@BA@@@BB@BDBBB@@BB@D@D@BAB@BABABC@@BA@@@CA@AE@AAAAA@@@A@CBEDCDCB@@AB@@@DAD@B@B@B@@DBBBBBBB@B@BB@BDDDHDBB@@BB@@B@@B@@@B@@@@AB@@A@@B@BCD@@A@@@A@@@A@C@@A@BA@@BB@@D@B@B@BAD@@ADA@ADA@AB@@@B@B@@B@@@@@@BBAB@@AB@BB@@B@B@BB@@BB@@BAB@@BB@BB@B@@B@@@@BB@B@@B@@@@@@@@BB@@ABAD@B@@DBBB@@BBBBBDDD@BBB@BB@BBDBDBDBBBD@@@BB@B@@@BBB@@AD@@A@@B@@@@@BFBD@@@B@F@@BB@@@@DA@@@@@AAA@BB@B@@@@A@A@@@@@BB@@AFCB@@A@C@C@OBA@I@A@AAA@@B@@@@@@@B@@BBJDDBBBL@DB@@B@B@@@DA@@@@B@B@@BB@@B@HBD@@DFBD@@@B@D@@FBB@B@B@@@@@@B@B@BB@BDDB@B@B@B@BB@@@B@@@BBDB@B@BB@@@FDBB@BBBBB@@BA@AB@BA@A@C@AFCBAB@B@B@@BBBD@BBD@DBB@B@BB@@@B@BAB@F@B@@FBB@D@B@F@BBD@BBB@D@@BB@@B@@@BB@BBBBB@@@BA@A@@@A@@@G@@B@BB@@@@BA@@DBB@B@B@@AB@@@B@@@AB@@A@@@AB@@B@@@@@AB@@@BBBBBBBHDBB@@B@B@@@F@B@@@@A@@AA@@A@C@ACD@D@D@H@F@DBFBDB@@BB@B@D@@BBBB@B@B@@BBD@BBB@@BF@B@D@@@BBBB@@@BB@B@DAB@B@J@D@B@B@@@BABAB@@@BB@@BBBBB@@AB@@AB@B@BB@@B@B@@@@B@@AB@@@B@@B@B@@B@@@B@@@@A@@@BB@@@@B@@@B@ABB@@B@@BBDAB@DBB@@BB@BBFDABAA@@A@@@@B@@@@ABB@@B@@B@@@B@AAFA@@B@@@@@B@@@@AB@@@B@@AAABA@@@@BC@ABA@@@BDC@@@A@@BAB@@@B@@A@@BABADABA@@BA@@BAB@F@FCB@FAB@BAHE@ABABCDADCDAD@B@DAHGD@DAD@BAB@DABAB@BABADCFCBCBCFI@A@A@@@C@@B@@A@AA@@A@@@@B@B@@@BA@@@@@A@@DIB@BA@@DC@A@A@@B@@@B@D@B@B@@ADADABAFADAD@D@BBFBDDDBBDBBB@FBDBF@D@D@DAJGHEBCBAAA@@@ABA@ABA@@@AB@BAB@FCDCDAHCDADABADAB@B@D@B@FBFDDBDBBBBBFF@BBBJHFDBDBD@DBLBHB@@BBBBBBBDBDBD@DAFC@@D@JAD@@@F@B@B@F@D@BBB@BBDDFDDBBBBDDB@BBBBBBBBBB@DBDBFB@@DJBHDDDBBDFJDB@@@BABABAD@BBBBBDBBDB@@@B@B@B@BBB@D@BBB@B@B@@@B@@@BABCBC@@@AAC@AA@@A@C@AFGBA@@@A@@@AEA@A@@GCCCCCAA@@@A@@@C@A@C@A@AAAACCI@AAG@A@A@A@C@ABE@@@AA@@AA@@@A@A@@@@A@AB@@BB@@A@@@@AA@A@@BCB@B@BAB@B@@CB@@A@@@@A@A@@ABA@@@@BAD@@@DCB@@A@A@@@@@ABA@@AA@A@A@@@AA@@AA@AAA@@@@AAA@@A@@@CACCA@@@@AA@@A@@DA@@EA@@AAA@@@A@@@A@@@@@A@AA@@AAA@AAC@@AAABC@AA@@AA@@AC@A@@A@A@@AAAAAC@@AAGAAAA@@A@@B@B@BA@@@@@A@A@@@C@@@A@@CA@@A@@A@@@@AA@@A@A@AAAE@@@@A@E@@AA@@@BAB@@A@@A@@A@A@A@A@@@@BA@@@AB@DB@@BAA@@@@ABA@@@AA@@@@A@AB@@A@AAC@A@@BA@@BABAB@@A@@BA@@AA@@@A@@B@@AB@@@BA@@B@D@B@BA@@B@@@B@B@B@@@@A@AB@@@B@@@B@B@B@DAB@@@BAD@@@@@DC@A@@@A@A@@CA@@A@@A@@@A@AA@AAA@AAC@A@A@AAA@@@A@@@AB@@CBA@A@A@A@A@@@AAAAAC@@AC@@@CAAAA@A@A@A@A@@A@@@A@@@CB@@A@@@A@@@BC@@AA@AACA@@@A@C@ABAAA@AA@@@A@@DADA@@BAB@D@@AB@@A@C@A@A@A@A@@AA@CBA@A@AA@AC@@AAA@A@A@A@AAA@@AA@@A@A@AA@@@A@A@AA@@@@C@@A@@@A@@AA@@@@BA@@BA@@@A@AB@@A@@AA@AAA@@@@BAB@BAB@@@B@B@BBB@@@B@B@B@B@@AB@@@BB@BB@DD@@B@B@BB@@@@@@@B@@@B@@@@@BDAB@B@@BBB@@BB@BB@@@BBBB@@B@@@@@@@F@D@B@BBB@@@@B@@BB@@B@@B@@@B@@@B@B@@@@BB@@@@@FBBAB@BAB@B@@B@@@BB@@B@@@B@B@@@B@@BABB@@B@@A@@D@@B@@@B@B@@@BBBAB@B@B@@@BA@@@@@A@ABA@@@@D@@@BABA@@@A@A@A@@AAB@@@BAB@@@@A@A@A@ACAA@@@AA@AACGGCAA@AAA@C@AAAA@@A@AA@@@@AA@AAAA@ACAAECC@@AA@AAA@@@BCBAB@BA@ABGB@@CBABAB@DAB@B@@@@A@@@@@@@AB@@AB@B@DADC@@BA@AA@@AEAA@@AAAA@A@AAA@ACAAA@@A@@@A@@DA@@BC@@DAB@D@BADA@ABA@A@AA@@AA@@@E@A@@AA@@A@ABA@@BA@@B@@AACBC@@BA@@@ABABA@AB@DAB@@A@@B@BAB@BABABAB@@ABA@@DABABABABABAB@DAB@@@F@DB@AB@B@BA@@@A@AB@@A@CBA@@AA@@@A@ABA@@BA@A@A@EBE@AFAB@@ADA@A@@B@B@@@@A@A@@B@@@B@@@B@D@@@F@@@HBF@HBH@D@B@F@D@F@DAF@B@B@F@DAB@DABA@AB@@A@A@@CA@AA@@AA@@@@A@AAA@@AAAAA@@@A@@A@@@AB@@A@@@C@@B@@ABA@@@A@ABC@AB@@A@ABA@ABA@@B@B@F@@@@@BBBB@@@B@B@@@@@BB@@BBBABB@@B@@B@B@@@BABAD@DAB@@AB@FBB@FBDB@B@@@B@@B@@AB@BBB@DBB@@@@ABABABA@@BA@@BADABC@@B@BAB@DAD@@@DA@ABA@ABA@@@A@A@@AC@@@@CAA@@AAA@@AA@AC@@@A@C@@C@A@A@@A@@AA@@A@@@A@@BC@A@@B@@ABA@@D@@AB@@@AAAAA@A@A@@@A@AAA@CC@@C@A@A@A@A@CAA@A@A@E@C@A@EAAACA@@@@AAIE@@@@@A@A@ADABA@A@ABC@AAA@CAG@@C@@A@CCC@@ACA@AAA@AA@CA@@@@AA@@BA@@@@A@@@A@ABA@@BA@AB@BA@@FAB@B@B@B@B@@@B@B@B@B@B@DA@AB@B@@@B@B@B@@A@E@A@@@ABABA@AB@@A@@AA@C@A@E@A@ABA@A@A@@@C@@@AB@B@B@F@@@DA@@BA@@@ADCBCDABA@ABAB@B@D@B@D@B@DAD@BA@@B@B@B@B@@@B@B@@@BAB@JEB@@@BA@@@@@@BB@@B@@A@@DB@@BBB@BB@@BCB@@@D@BC@@B@@B@@B@B@B@B@BB@@@AF@@A@@BADG@@@C@A@@BA@AACB@@ABAB@DAAE@A@AAA@@AA@@@AAAAA@@AA@A@@BGFE@@@A@@BA@AAC@@A@AA@@@@ACA@@@AAABAAA@@@@@@A@A@A@@@A@@@@@AA@@@@A@A@A@@@A@@@A@@BC@A@@@@BA@@BC@@BG@@@AAA@E@@@AA@@AA@B@@AH@D@DAD@D@BAAA@@@A@C@A@A@A@ABA@CBABEBA@@@@B@B@D@JBB@B@@@@C@ADMCA@CCC@CCEEIGG@CCEEGCEEEICME@@QGGAQEGCKCGCGCGGCE@C@E@C@KAEECGCCAEACE@K@G"],encodeOffsets:[[109861,33224]]}},{type:"Feature",id:"610722",properties:{name:"城固县",cp:[107.33393,33.157131],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@A@@@A@@@@@@AAA@A@@@CCA@@AAA@@A@@BA@A@A@A@@@A@AAA@A@@@A@ABA@AB@@@@BB@BBB@@@BA@@B@B@@AB@@AB@@@@BB@@@B@@@BD@@@@@BBB@B@@@B@@B@B@BB@@BB@BBB@B@B@B@BB@@BDB@@B@BAB@DBB@@@B@B@B@B@D@BA@@BC@A@AB@@CBCB@@@B@@BBB@BBBAD@B@@@B@BD@BBB@@AD@@B@@@B@@@DA@@B@@@B@@@@B@B@B@BBBBB@D@@BD@@BDBBBB@@B@B@B@B@B@DA@@BA@@B@@@B@BBB@B@D@BBB@BBB@@B@B@@@BB@@@DB@@@B@B@@@BCD@@@@C@AB@@A@CBA@A@A@@@A@@@A@@B@B@@A@A@A@@@A@@@ABA@C@A@@@AB@@A@@BA@@@@B@@BB@@AB@@@BA@ABAB@@AB@@@BBD@B@BA@@B@B@@B@@B@@AB@B@@B@AB@@CAA@@B@@AB@@@@@B@B@B@BB@@@@BA@AB@@B@@BF@B@@@@@BFBBB@B@@@BB@@@@@BB@@@DB@@@B@@@D@@@B@B@@@@ABA@A@@@@BB@BBHBBB@@BDBBBB@@@B@BB@D@@BB@@BB@@BADBB@BD@BBB@BB@@BBB@@@@@B@@@B@@@B@BB@@FB@@CB@@@BB@@B@@B@DDDB@@B@@@BB@B@@B@BBB@@BB@@B@@@B@BBB@@AB@B@@@@@B@BA@CD@@C@AB@@@@AB@BB@B@@@@@@BA@@DA@A@ABA@A@AD@@@BBB@@@@@BA@@AA@@B@B@@B@B@@@B@@BB@@B@@AF@B@D@B@B@BBH@BDJBDBB@B@B@D@B@D@@@B@@BBDDDDHD@@@BFB@B@@@B@@ABEH@B@D@BB@@BBD@B@@ADADAB@@A@@@A@A@A@AAC@A@AAA@A@A@@@A@ACCAAAAA@AABA@GB@@AB@@AD@@@@BBBB@BBB@@@@FBB@BB@@@BA@@@@@@B@@B@B@@@@BA@A@@B@B@@B@B@@@@BB@AB@B@B@@@BA@@B@@ABCBABA@@BA@@@A@@@@B@@@@@@@B@@@@@@@@A@@@A@@@AB@@A@A@A@AA@@ABCBAB@@ABBB@@BB@B@B@@D@@BB@AB@@@@AB@@@BBB@@@B@@@DAB@D@B@DAB@@@D@B@B@B@B@BA@@BA@A@A@A@@BABABA@@@A@A@AAA@@B@@@B@@@@@BA@CD@@A@A@@B@@BB@B@@@@@@AB@@@@BD@@@B@@BBD@BBBB@@@@ABAAA@A@CBA@@@A@@@AB@@AAA@A@A@@@ABAB@@@B@@@B@BA@@@ABA@AB@@ABA@@@AB@@@BB@B@@@@B@B@BAB@B@B@@@B@@BB@@BBD@@@@@@BAD@@BB@AB@@@AA@@@@B@B@@@B@@BB@B@B@B@D@B@D@@@B@@@B@@B@@AD@@@@@BA@@BAB@BA@@BAD@@@@@B@@B@@BB@B@BBB@@@@B@@ABABA@ABC@@BA@A@@B@BA@ABAD@BA@AD@@@B@D@@ABABA@@B@@AD@BA@A@A@A@@BAD@BB@@B@@@BA@@BA@@@@@@BA@@@@@@B@@@@@@@B@@ABBB@B@B@BC@@@C@G@C@A@A@CAA@A@AB@@A@@B@@@BBD@B@@@@B@B@BAB@B@@@B@@B@@AD@BCB@@@@DBLDB@@B@BA@@BA@A@EBA@A@CAA@A@A@A@@BA@@B@@@@BBD@@@D@D@@BD@B@FBB@DBB@BBAD@B@@AB@D@@@BAAC@CACA@@@@@@@BB@BBBBFBBB@@@B@@@B@@B@@@@B@@A@A@A@C@C@A@CAAAA@A@CAA@C@A@@@CBABAAA@A@ABAAABC@AAA@@@@B@@@BBBBB@BB@ABABED@@ABAJABCD@D@@@BAB@@@@A@GA@AECAGAAICC@CCAAAAAAAAGCEA@@C@ABCB@@AD@@AB@B@D@@BB@B@@@B@@ABA@ABA@C@@BA@@@@@@B@BAB@@AD@@@B@@B@BBD@@@BB@BBB@D@@BBBBB@@BBB@@@BA@@BAB@B@B@@@B@DA@@D@@@BAB@@@D@B@D@D@B@@@B@B@B@@DDDD@@B@FBB@@BDBB@BB@BB@@B@@@BBBBB@@@@AF@B@BBF@BDBBB@@BB@B@@DBB@@D@@@BABF@D@BBBBB@BBB@BB@BBB@@BB@@BBB@FBD@BBB@B@FD@@BH@B@BDD@@@B@@ABABA@@B@@@F@BBD@B@BAFAD@B@BBB@@@B@B@BA@ABE@ABCBC@ABA@@BAD@@AD@BAB@@@@FB@BBB@@DB@@B@@BBBA@@DAB@@@@@B@@@D@@BBBDDBB@@@@BAB@@A@CB@@@B@@@B@B@B@B@@A@AB@@@B@B@@BB@DDD@@BB@BBBB@@@BBBB@@B@D@@B@@@@@BC@ADA@C@@@A@A@@@@@AB@@BBBB@@@@A@@@AA@@@BA@@B@BADAD@BBB@B@@ADA@ABAB@BAB@@A@A@C@CB@@A@@B@@@BAB@BAF@@A@AB@@AB@D@@@B@D@@@BAB@B@@@BBB@@AB@BAB@B@@@@ADAD@DAB@BA@@B@B@BAB@@A@@BC@A@A@@B@BA@@BCD@BAB@@BD@@BDBBBB@BBBDBB@@BBD@@@@B@@@@@BAB@B@B@@@@@FDB@@@D@FAB@B@B@BB@@D@F@BAB@@@@@@@@D@D@BBB@@B@@BBBBB@@@B@D@@B@@AB@@@B@B@B@@@BBB@B@BBB@@@@BB@BB@@@BA@@@BB@B@D@@BB@@@B@@B@BB@@@D@@BBAB@BB@@@B@B@B@B@B@@@B@BDDBBBB@@B@@@@@B@B@BB@@BA@BB@@@BBBBBBB@BBD@@BBBBBB@B@B@@@B@B@@B@@BBB@BB@@B@B@@DAB@@BB@@B@BB@@FBB@B@@AB@B@BBB@D@DBB@B@B@@BB@@A@@B@B@@@BBBB@@B@BB@BB@B@B@@@B@@@B@@@B@B@B@@BBBBD@BBD@BBF@@@B@BDBBBB@F@B@@@@AD@DBB@B@BAB@B@@BBBBBB@@B@D@@ADBDBB@BD@@BBBBBB@D@D@@BBB@@@BD@B@@BD@B@BBBFFBD@@BB@@B@DBD@B@B@B@BB@@J@@@B@F@B@@A@@@CBGAC@A@@BADCB@@A@@@A@E@@@A@ABBBAB@BABABAB@BADAB@B@BA@@BCBABA@@@A@A@A@ABCB@@A@@BADCB@BA@@@A@A@A@A@A@A@A@@B@BAB@@@BAB@B@DAD@F@D@BBH@B@DBB@B@DBB@B@@@FAB@B@BAB@@@B@D@D@@BB@D@D@BBB@B@DAB@@@BBHB@@DA@@B@B@B@D@DBB@D@@@B@D@BABA@ABABA@AB@DABAFABAHAD@DAB@@@D@BAD@@AB@@@BB@@F@@@B@BAB@@@@B@BADA@@@@B@@@BA@@B@@@@D@BABADA@ABABC@@B@D@B@B@DBF@B@D@@BDB@ADABA@@B@@A@@@A@A@A@AAAAC@ABA@A@A@A@@@AB@BCB@@@BA@AB@DABABA@@BC@ABA@@BA@ABCBCBA@@DABABCB@BADAD@D@B@@AB@@ADA@@D@D@B@B@B@@@B@B@B@@@B@D@B@B@B@@@@A@@BA@@@ABAB@B@BAB@B@B@DBB@B@BDBBB@@@BADABA@@FADA@@@A@AA@@AB@BABC@A@@BAB@BAB@DCB@BCB@@@B@B@@@BC@A@ABCBAA@@A@ABEBABA@@@A@AA@AA@@A@A@@@EAC@IAAAAAAAAAA@@@CA@@CAAA@@AACCCCA@A@C@CBA@A@@BCBCBAB@@@AA@AAA@CAACA@CCA@AAC@C@A@C@ABA@AB@@CF@B@@C@CAE@A@A@@AC@E@AAA@ECA@@AAA@A@@@A@@@ADCB@BCB@@C@AB@AC@A@A@@BA@@@ADAB@@A@A@C@A@A@AA@@ABA@@BCBA@ABA@@@@@A@CA@@AAA@C@A@CB@@AA@ACA@@@AAAA@AA@@AA@CAAACAA@@ACAEAC@A@@ACAAA@CB@@A@@@A@CBA@ABA@@@@AAAAC@@@AA@AAA@@BCBA@A@@CA@@@C@AAAAAA@@A@@CA@@@C@A@AA@@A@ABA@@AC@A@@BA@ABC@ABABA@@D@B@B@BA@@@A@@AA@@@ABABABABABA@@BAB@BABABA@@@ABABC@@@A@A@AAC@@@A@AB@@ADABA@A@@@AAECCAAACAA@@B@B@@A@C@AAC@@@@BCAA@A@A@A@@BABABA@A@@@A@E@@AAAA@@AAA@@A@@@@DADA@@DC@ABABABABAB@@AAA@@@@@A@@B@B@BABAB@BCBA@@ACBA@@DABA@A@ABA@@B@@AB@@A@@@AAC@@A@AAAA@A@@@AAA@A@@@AAA@@EE@@@A@A@@BABAB@@@@ABA@@B@BAB@@A@@BC@@@@@@AACACAAC@A@A@A@@@@@A@AAA@@AAA@A@AAEA@AA@@AAAAAA@AA@@A@@C@A@@D@B@@ABAAA@@BABA@A@A@C@A@A@A@CBA@AAAAC@@AA@@A@@ABABG@G@@@@A@A@@@A@@@@AACBA@A@ACAB@@A@@BA@@@AAC@@@C@@@A@@D@B@@C@@@A@AAA@@@A@A@A@AB@@@@A@@B@B@B@@ABAB@B@@@B@B@B@@C@@B@@@BA@@@A@@A@@A@@CB@@@AA@@A@@AC@@AA@C@A@@@AB@B@B@B@@@DAB@BB@BDBBB@@B@B@@@BA@@B@BA@A@E@A@A@CBC@A@AA@@A@CBE@A@AA@AC@@@@BA@@D@B@@@BA@A@A@@@@B@@A@A@C@GCM@A@AB@BADA@ABCB@@EAE@A@@B@@CAAAAAA@@@AB@D@FABADAFAFCB@FCD@DAB@BAD@B@B@FAD@DBDBDBBBBBB@B@@A@@AAAECCAAA@AACACE@@BABAB@DA@@BA@@@C@A@@BA@@DA@@@A@A@A@@AA@@C@@@A@@A@AA@@A@@BABC@@B@B@B@@AB@@A@A@@@AA@CAAA@@AAB@@AB@B@B@@@BBB@BB@@BBDB@@BBBB@@B@B@@A@@@A@C@@BAB@@A@@D@B@D@DAB@@A@A@@B@BAB@BAB@@AAA@@AAAA@@A@AB@@A@@A@@@A@@@A@AAC@@AAAAECAA@A@@@A@@DCDA@A@A@A@ADCBAB@B@@AH@B@B@@@@AAA@@AA@@@A@A@AAA@@A@@A@@@@BC@@@@@AA@@A@AAC@@@@BA@ABA@ABABC@@BAFA@@@A@@A@A@@@@ABABA@@D@@@@A@@BA@@@@B@@@B@@@B@D@@@BA@@D@@@B@B@BABAB@B@B@F@@@BB@BBD@BBB@@B@D@B@FB@@@B@BBB@BB@@@B@@@B@@ABA@@DBB@D@B@BABAB@B@@A@@B@BAB@@@@C@A@A@@B@BA@@B@@A@A@AB@@@DA@@@@@A@@@@A@A@A@@@@AA@@C@@@@@ABABA@@B@B@@A@@DAB@BABA@CA@@A@@ACA@A@@@ACAAAC@@AAAAA@C@@@CAACAAAAAAABA@A@@@AB@BA@@@E@A@@@AAAAAA@A@@BE@A@@A@@A@C@C@A@CAA@AAEB@@A@A@@@A@@@@AA@A@@BAB@DA@@@@BC@A@@@AB@@@@A@A@@ACCC@@AAGACA@@AA@@@@CAA@A@ACA@@A@C@A@A@@@@DAB@DCB@@@BBB@@@BA@@@A@@@A@A@A@@@@@A@A@C@@@A@@@CAA@@@A@@ACA@AAAAA@AAA@A@A@ABC@ABA@A@C@A@A@CAA@@AAA@AA@CA@@C@@@CAACAA@@@A@A@A@AA@AA@@@C@@@@@@AC@@@ABA@@AA@AECA@A@AA@@AAA@C@CB@@A@E@A@AAA@@@@@ABA@@@@DABAB@@AB@B@BAB@B@B@@@BA@A@A@A@A@@@A@@@BF@BAB@@@B@@@B@@@B@@@@@BBB@@BB@@@B@@@@AB@@CFABA@@@@@AB@BAB@B@B@@@B@B@@AD@@AB@@@BA@@B@BA@@BBD@@ABA@A@CBA@A@A@A@A@A@ACA@@A@@@A@AAAAAEAAA@AA@@@AB@@A@A@A@A@AAA@CC@@A@@@@A@@AAEC@A@@BC@AAA@AAA@@BA@@AAA@@@A@A@A@A@C@@@AAA@@A@@@E@E@@AA@@A@GB@@A@@@AA@A@A@@A@@@C@A@@AAAA@@A@@AAA@C@@AA@@AA@AC@A@A@A@@@A@@A@AAA@CAAA@@CBAA@@@A@@BCB@@A@C@@@C@@A@@@A@ACAE@@AAAC@A@@@@@AB@B@B@DAB@BAB@B@D@@@DA@@DCDCBAB@BAB@D@B@DA@@@A@@@@@@@ABADC@ABE@C@A@A@ABA@EBE@@BCBABAB@@A@A@@B@@AB@@AB@@@@AA@BA@@B@@@@A@@@AB@BA@@BA@@@@BC@@@@A@@@AA@@BA@ABA@@BA@@@@@A@@@A@A@AA@@@A@@@@AAABA@@@AA@@@@@A@BAA@@AB@@A@@@@A@@@@@BA@@@@@AB@@@BA@@@AB@B@@AD@B@B@@@@AB@D@@@BA@A@@AA@A@@BA@@@A@A@AB@@A@@BAB@D@D@@@D@@@B@BAB@HCBA@@B@BBB@@AB@@@@A@@@CBC@@BADAAA@AAA@A@AA@AA@@@C@ABC@AB@BA@C@@A@@CAAAAAA@@@@A@@A@ABA@AAAAA@A@@BA@A@@AA@AAAA@@A@AAC@AAA@@DC@ABA@@@@BA@@BA@@@ABABA@ABADC@AB@@@@@FB@@B@B@BA@@@@@A@AAAAAAC@A@AA@AACACCAC@AAAAAAAA@A@G@@@EAE@C@E@C@A@A@A@A@G@C@A@C@C@A@A@A@EAAACBAAA@A@@B@BA@C@C@@@ABCBCB@@A@@BAAA@EBED@@AB@@C@@@A@@@A@A@@A@AAAAAA@CB@@C@CBA@ABA@@@ADCBAB@@AB@@ABABAB@B@@A@CAA@AAA@@BA@@@@A@@@ACAEAA@EAA@@BA@CBC@ABAB@@A@A@@@@AA@BAAA@AA@@A@@@@@A@A@@AAAA@@@@E@A@A@@@AB@BAB@B@BA@@BAD@B@B@@AB@BA@@@@D@@@BA@@B@@@BB@@@B@BBBB@@BB@B@B@@B@@BB@@BDB@@@B@BA@@BABCBA@CBE@A@A@E@CBE@C@E@A@C@G@GAE@GA@@E@@@C@A@@@A@@@A@@@@B@B@@A@A@@@@BCB@BA@EB@BAF@F@B@BAB@@AB@B@B@@BB@@AB@D@BA@@B@B@@ABA@A@@BCAE@@@A@CBA@ABABABABABCB@@AB@BA@ABABABA@ABA@@@@BA@CBA@@BABAB@B@@AB@@ADBD@BA@@@AB@@AB@B@BB@@BB@F@@@B@@BB@@B@BAB@BCBABC@A@CB@@AD@@CB@@@B@@@BB@BBBDB@BBB@B@BB@BB@FB@BB@@BAB@@CDCBA@A@@BA@@B@@@@@@@B@@A@A@CBA@ABAB@DA@AH@BABA@ABAD@@B@BBB@@BD@FDBBBDB@BB@BBB@@@@BBB@@@BBBBD@B@BBB@DBHHBD@BBB@@B@DB@B@B@B@B@@A@AB@@A@BB@@@B@B@B@@ABAB@@C@@@@@AB@B@B@@@@AB@@A@A@A@ABAA@@A@A@@@A@@@@CB@@@@AA@BA@AA@@@A@A@@@A@@@AA@@A@@@@ABA@ABAAA@E@@@@AA@@@@@A@A@@@A@@@AA@@@AA@@@A@@A@AAA@C@E@@@@@@@A@@@AAAA@@A@@AAA@@AA@AA@A@CB@A"],encodeOffsets:[[109720,33682]]}},{type:"Feature",id:"610723",properties:{name:"洋县",cp:[107.545836,33.222738],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@@@A@@AA@@@A@ABAAAAA@@@@A@AAA@A@A@C@AACAC@A@AA@@@AAA@CAAA@@AA@@C@@@A@AA@@AAA@A@ACC@@CAA@@AAA@AAE@A@A@C@@BABAF@@A@A@A@CBC@AA@@AA@AA@A@AB@@A@EBACE@@BABA@ABA@@@A@@A@@@A@A@AA@CAA@A@@BA@A@A@A@A@@AA@@@@@A@A@@@AAA@A@@@@AAAAA@@AAC@@@AA@AA@AB@@A@@BA@A@@AA@@AA@A@@B@@@@@@@BBB@BB@BB@@@B@@AB@@A@A@A@@@A@@@A@A@A@EAAACACAAA@@CA@@ABABA@CB@@@BA@A@@@ABAB@B@@@@@DB@@B@@B@B@B@@@@@@B@@@@CB@@A@@B@B@BA@@@ABA@@@@B@B@D@@A@ABA@@@@BA@A@ABABA@C@A@CA@@AB@BA@@@A@@@A@@AAA@A@A@@EAA@C@A@@@AA@AAC@AAA@@E@A@A@A@ABABA@A@@@C@@@AB@@C@A@@@A@@@A@@@@@AB@@@B@@C@@@ABAB@B@@B@B@@@@B@@EBAB@@ADAB@BAB@BAB@@@@BD@B@BB@@B@@@@AD@@@@@BB@@@BB@B@B@B@@BB@@BB@B@@A@A@G@@BA@A@ABCD@B@B@B@BCBCD@@@B@@@BBBFDBBBB@@BD@B@B@@@B@@@BB@@@BAB@@@BBBB@@BB@BA@ABA@ABA@@@@B@BA@CBC@A@C@@@@BA@AB@@@D@B@@@BA@A@@@AAAA@@CAAA@@AAA@AA@@A@A@A@@BA@BB@@BBDBB@@B@@@B@BA@@BA@A@A@@@ADAB@@@BB@@B@BB@@@D@@@BB@@@B@B@B@@CB@@AB@@@B@D@@AB@@CBA@ABAB@@DFDBBBB@BBDDBFBB@@@BA@A@AAAACACACAC@EBA@A@C@ABA@CBC@EDA@EDEBCBABEBC@A@@B@@BBBBBB@DA@@@@BBF@FA@AD@BCBABA@@B@BDN@H@D@B@BA@@@@@@B@BAB@@A@C@@@AB@@@@BDB@@B@BAF@D@BB@@B@BAD@D@B@B@F@BABA@@@AB@@A@A@@@AACA@AAAA@CB@@A@A@A@A@@B@@@B@DBB@@BD@@@BB@@B@@DA@@@BB@@@@B@@AB@@A@@@@DA@A@A@@@A@A@AB@BA@A@A@@@@B@@A@@B@B@B@B@@BB@B@B@@@DA@C@@@@B@@@D@@BD@B@@AB@@@BA@DB@B@BABBD@B@@B@@@B@B@@@@@@HAHAB@BB@@@BB@@BDBB@BAB@D@B@B@B@D@B@BABAB@@BBAB@BA@C@@@@B@DB@@@BBB@BBBB@BB@@BFBBBB@B@BB@@BB@B@B@@@@@B@B@BBDDBDBBB@@@@@@AD@@@BA@ABA@@@AB@B@@A@ABAB@@@B@B@@FF@@BB@B@@@BBB@B@@@BBBBBB@@@BD@B@@@BA@@BA@@@AB@B@BABCB@@ABBD@@ABADA@ABABA@A@@@@B@@@@BB@BA@ABABABAB@BCD@@CBCB@@@@@BB@BB@@BBBB@@@F@B@@@BABABAB@@@B@B@BBBAD@@@@BD@B@D@BA@A@@@BBBDBBDDBF@B@@@BABCB@BA@@B@B@@BD@B@B@B@@ADAB@B@@ABABABA@AB@@ABABABABAB@B@@BB@@@B@@ABA@A@C@@@ABAB@BAD@BAB@@@BBD@@AB@B@BB@@B@B@D@@DB@@@BB@BBBB@B@D@@DB@@@BABAD@@BB@BBB@@D@BBBB@@@@AB@BAB@D@B@@@BA@@DBBDB@BB@D@FBDB@BB@DBBBDBB@@BB@@BBBBB@@B@BDB@@BA@@D@B@DBB@BB@@D@B@@@@AB@BABAD@@AB@BB@@B@B@B@D@B@BA@CB@B@@AB@@@B@BBDA@@B@DA@ADA@CD@B@@@B@@@BBB@BB@FDB@BBF@D@@BB@B@F@DBD@@@@ADE@@BAB@BAD@B@D@D@BBB@DDB@BDDBB@BBB@@B@@BADADA@AB@B@DAD@B@B@DDDDBB@@BBDB@@DB@@B@BBBBBBBBJBD@FB@@B@B@@@BBB@@B@B@@ABABAF@B@BB@ABAD@B@BAD@@A@A@@@A@ADA@CDA@ABA@AB@@@BADABA@@BB@@B@B@@CBEB@@ABCBAB@@A@AAACA@A@CAA@A@A@ABA@A@AB@B@@AB@@@B@@A@A@A@C@A@@@A@A@A@@@A@A@A@C@C@@@CB@BA@@BA@C@C@CBABA@ADABCB@@ABADAD@BAB@@AB@BAD@@ABABCBA@@BAB@@A@ADA@@B@@@B@B@BAB@BBDBB@B@B@B@B@@@BA@@@ABCB@BCA@AC@A@E@CAA@A@C@A@@@ADAB@BCBABABC@@@@@@AB@@A@@@A@@B@BC@A@A@@A@ABA@@@E@@@AA@@A@@BC@ABC@@@A@CBC@GBABEBABCBA@@BABAB@BABABC@A@@@C@A@CAC@A@A@A@@@CB@@GAAA@@A@CBA@A@AAC@C@A@@AC@C@A@@@A@ABA@A@EB@@A@A@CAA@A@CAA@G@AAC@E@C@CBA@A@AB@@A@ABA@@@@B@B@B@B@B@B@B@@ABA@CDAB@@@BA@AD@B@B@B@B@@ABABAD@@ABA@A@CBABA@ABABABA@ABAA@B@B@@@F@B@@@BA@CDAB@@@BBDAH@DD@BBBA@@DADA@AB@F@D@D@D@B@B@B@@BB@D@BB@@DB@@D@B@@@B@B@@@B@@BB@@@B@@@B@B@BB@@B@B@B@D@B@BB@@@B@B@@B@B@BB@@BBB@@BB@B@BBD@BBB@BBBBBBBB@BB@@@D@H@@@@AB@@@B@D@D@@@D@B@BB@@B@BBB@BBBBBD@D@@BBBB@DBB@B@@ADAB@@BB@BB@@BBB@@BBBB@@B@B@@BB@@@B@B@@@B@@@B@D@@@BB@B@@@BBAB@B@DAB@D@@BB@DBDBB@@@@@@AFAB@BAD@F@D@BAB@DA@@B@@@@@@ABAB@@C@ABC@@B@B@@AB@BAB@B@B@B@D@@@D@B@B@BCBADAB@B@DAB@B@DAB@@@BADC@@B@FAB@BBB@B@@@B@@@BC@@@@B@@@BB@@BBB@BBBDBB@@B@B@@@D@B@@@DADCDA@@@@BCBAB@@AB@B@BAB@BABA@A@@@A@@AC@@@A@@BAB@@CFEBCB@DA@A@@DAB@@@@@@ABA@@DA@@BA@@AC@@AA@A@A@A@AAA@@@@BAB@B@DA@AB@@ADAB@@AB@@@D@B@BA@@@BBBB@@@B@B@D@@@D@@@B@@@B@@BB@B@B@B@@BD@@BDDB@B@B@B@D@BBBAB@B@@ABABA@@@@BB@BBB@@@B@BBB@@@B@BBBBBB@BBB@FBF@D@B@@AB@@@B@@@DBDBB@DBDBBBDD@@@@DB@@@B@B@@@BB@BBD@DB@@B@@A@@@@FBDBB@BB@B@BBDB@BB@@B@@@@@B@@B@@AB@B@@B@@BB@@@@@ABA@@@@B@@@BB@@B@@@@BA@@BAD@D@@@BBBBB@B@BBB@B@@@DABAD@DAB@BA@@BBB@B@D@BAD@B@DAD@@@B@BBBB@@B@DBB@HDB@BBB@BB@B@B@B@@@D@B@@BBB@@BB@@@B@@BB@B@@BB@B@B@B@BAB@@@BDBBBBBB@B@@@BB@@@FBB@B@BB@@B@B@B@B@B@BAB@BAB@BAB@B@@@BAD@DAD@B@DABC@@B@BBB@BBFD@BBBFBDBB@B@@B@BBBBB@BBBBBBBBBDBB@F@B@BA@@BBB@BBB@D@DB@@B@B@B@B@BBD@B@FAD@F@@@DC@@B@@@@A@ABA@@B@BEBABADC@AB@@AB@@BD@BBB@HEDADA@@D@D@D@BB@@D@D@@@BA@@@ABCB@BA@@@A@A@A@A@AAC@AAEAA@@@A@EBC@E@CBAB@@@B@@A@@@CAA@@@AAC@A@@@A@@@@BGB@@AB@BBBAB@@ABA@ABA@AA@BA@A@@A@@A@@@@BAB@B@@ABB@@D@B@B@B@F@@@BA@@@A@A@@HABAB@@@@@B@@B@@D@BBDADAB@D@FA@@DBF@B@DA@@FABA@@B@B@@@@A@A@ABA@ABC@A@A@ABABA@AB@@ABA@A@@BA@ADE@@BA@@@@@A@@@A@@@AACA@A@@A@A@A@A@A@@@@@A@A@ABA@AAA@@@CBABA@AA@@@@A@AB@@A@CBAA@@A@A@@@@BA@A@AAA@@BA@A@@AAAA@@BAAABACAA@AA@AA@AA@@A@@A@AAA@AA@@@@A@@@A@@@AB@B@BA@@BCAEBA@A@A@@A@@A@@@A@@AA@@@A@@AA@@AA@AAC@A@A@AAC@@@@@@B@BABB@AB@@ABA@A@A@@AC@EA@@@AA@@@ACC@AA@@AA@@@AA@@@A@@BA@@BA@@@@BA@AB@@A@@BA@AA@@A@@@A@A@@@CBA@@@@@ABA@@@A@@@A@@@@@AB@@AB@BC@A@A@@AAAA@AA@@A@@@CBE@EBG@A@A@AAC@CDABA@A@@@A@CBC@@BABABABABAFCHEBABABABA@@HCD@@A@@@CBAB@B@FCB@BB@@@@DCB@B@DABABA@@B@DA@@B@B@B@BA@@B@D@B@@@B@BA@@@@DAF@D@F@B@B@B@@AB@@BBABBB@B@@@@BB@@B@@B@@BB@@@D@@@BAB@@BD@D@BAB@@@BAB@B@B@@@D@@@B@B@B@D@F@BBB@B@B@@@@A@A@A@A@@@AB@BADABABA@A@A@A@@@AAAACA@BAD@@A@@DC@@@AD@B@B@@@B@BAB@@AB@BBB@FDDB@BDA@@D@@@@@@@@B@BB@B@@@B@@@@ABADCB@@AB@DBBB@@DD@@BBDDB@D@BBDABADADA@AB@@@DABAB@@AB@@A@@@@B@@@B@BBB@DAD@B@DAB@HABBB@B@B@D@D@B@DABA@ABA@ABABE@A@C@@@CB@@@FAB@@AB@@@@CA@BC@@@AB@BA@A@A@AA@ACAA@C@CACBA@A@AAA@@@A@A@A@AB@B@BA@ADA@@D@B@B@B@@ABABA@@@@DA@@F@@@BABABA@A@@@A@@BABCBAB@DCBA@A@@BA@@AC@@A@@A@@@AA@@@A@@A@@@C@@@AA@@A@A@C@@BA@C@AB@@ABA@@@AB@@@@AAG@AAEBADADABA@ABA@@DAD@@@B@@AHAD@B@DAFAB@@A@@@A@ABA@@@A@A@A@A@@AA@A@AA@BA@@@@B@BCBA@AB@@ABA@@@@@G@@@@@C@@@A@@BC@A@AAA@A@@ACBA@A@ABABA@A@@A@A@@A@@@CAAAAAAA@AAAC@C@@@@@AB@@ABABA@A@@BAB@@AB@@AA@AA@@@CAA@C@@@ABABADABA@@B@@@AA@@@A@@@A@@B@@AA@@@@A@A@@FDBBBBB@@BB@@@D@FAB@@A@@B@B@D@D@B@BBB@@@BBB@B@@@BBBB@@@BBD@D@@B@@@@@B@B@@@B@@@BB@@BB@@B@@@B@B@BCDCBAB@@AB@@AA@@A@@@@BE@@B@@ADAB@B@B@@@BA@@@CBA@@@@B@@@B@B@B@@AB@@A@@EC@A@@@A@@@@BA@@@@@CB@@@FCBA@@B@@@BBBB@@B@@@BAB@@ADCD@B@B@@A@@BA@@@ABA@A@A@@@A@A@@@A@AB@@@@AAAEC@@@A@A@AAA@@AA@@CAA@AA@@@@BCB@BAB@@@BA@@DC@@@C@ABA@@E@CAA@@@BC@A@A@A@@@@AAA@@@@AAABA@@B@BA@@@@@AAA@@@AA@@@C@A@C@A@@@@@BA@@A@C@A@@@@A@A@A@@@ACEAA@AA@@ABAB@@A@AA@CCAA@@AAA@@@CBADAB@B@F@B@@@@B@B@@BBBB@@@BB@@@D@@@@@@@B@@AAAAA@A@A@AB@@@@AAA@CDCBA@AB@BAB@@AAA@AAAA@A@@@ABABA@@@AACAA@A@@BC@A@C@@A@@A@@BABA@@@@@C@A@@@@A@ABA@@BB@@B@BA@@@@ACAAAA@A@ABGFABAB@@A@CAAAAAAAACAACC@AA@@@A@CB@@CB@BABAB@BA@CBAB@@A@@@AAAA@A@E@CBCDC@C@A@AAAACECA@AAA@ABA@@@CA@@AB@@@BCBADA@@@A@CB@@A@@BABA@@@@@@BBB@DGDEDCBC@KAGAEACACC@ACBCA@@A@AC@@@A@AA@CAA@@BA@ABA@@@AA@@CA@@ABA@AAA@@@ABA@A@@@AAA@A@A@I@@@AD@@AF@B@@@@A@ACACAA@@AAAA@@A@A@@@AB@@@BAB@B@BA@@B@@@@AB@B@@@BAB@B@B@@A@@@A@@@AAAA@@A@C@A@@@@@@AAA@@A@@@CBABA@A@AA@@EBEBA@@@@AA@@AA@A@AAA@@AAAA@A@C@A@A@A@C@A@@@A@CD@@@@CBAB@@ABA@A@@@@@@A@@@@@ABA@A@C@@@A@@@A@@@AA@ECAA@@@A@C@CAAA@A@AA@@AAAA@@A@@AA@@A@@AA@AA@A@C@A@@@@A@AA@@A@@A@A@@@AAA@ACAA@A@@AAAA@ACC@@AAB@@AB@@A@@@A@A@ABA@A@A@AC@AAA@A@A@C@A@A@@@ADA@@BA@@@A@@@C@C@@@AA@@C@C@A@@@@@AC@AA@ABC@C@@@A@A@@AA@AAC@A@@@@AAAA@@AA@@AA@E@@@A@@@@ABA@@BCB@@A@@@@AAACACA@AA@@@A@@@@A@AAA@C@AC@@ACAA@@ABCB@@@@A@@AA@A@A@@@AA@@@A@C@@@ACA@@C@CA@@@A@A@@AA@@AAA@@AA@ABA@@@A@@@@@AA@ACAA@@@A@A@@@A@@ACC@@@AC@AA@@@A@AAA@@A@A@AAA@AC@@@A@@@A@AA@@@AA@@@CAA@@AAA@AA@@CBA@A@@B@@ABA@A@C@A@@AA@@@@B@@C@A@ACAA@AA@AC@@@@A@A@AACBA@@@A@AB@@C@@B@@CDA@A@ABC@A@@BA@@BA@@@BB@DABAB@BA@A@ABAB@@A@@@A@AAA@CCAA@AA@@@A@@BABADA@ABA@BBAB@@@B@BA@@@A@AAA@A@C@ABCAA@ACAAA@A@A@AC@@A@@A@@@@@A@A@@ACA@CAAA@AA@A@ABC@@@GB@BABA@A@AB@@CBA@@CC@@ACC@A@@"],encodeOffsets:[[110192,33865]]}},{type:"Feature",id:"610724",properties:{name:"西乡县",cp:[107.766613,32.983101],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@C@@@A@A@ABABC@CBA@AA@@A@@BABC@@D@@A@@@C@@@A@ABE@@BAAA@@AC@@AACAA@CAA@@A@@@AB@DC@ABCFE@C@CDA@A@@@@@AAC@@A@EBA@A@CAAAA@EC@@A@C@A@ABCBADA@@@A@@@@AAC@@@CA@@@AAA@@CA@AA@AAA@AA@A@CAC@A@CBC@@@@@AAAAAA@AA@A@E@A@AA@@AAE@C@GBA@@@CA@AA@@A@AGMABEBGBKBIDGBCBK@GBSAGBEACN@B@D@@A@A@IAC@A@A@@@@@ABAFAB@DAB@B@B@B@B@D@B@@BBABC@C@CBC@G@@BA@B@@BB@@B@@@FBB@B@@AH@@AD@@AB@@@@@BAD@@@B@@@B@@@B@B@B@@B@@B@@@@@B@@@B@B@B@@@@B@BBBABB@@B@BD@@@@BBB@@@BD@BAB@@@B@@EFAH@@@BBB@@BBBB@B@@BB@@BB@B@BBFCBA@AB@BA@BD@BAB@@@B@D@@CHAB@@@BE@@B@@AAA@A@A@A@@@@AA@@@ADC@@@A@AD@@AAA@AA@@CA@@@BA@@@AA@@@@@@AB@@A@IFA@AB@@A@A@@@A@A@A@A@@@ABC@CBA@C@A@C@A@A@AB@BABCBADCD@B@@AB@@CB@@E@A@A@A@@B@@@D@@@B@BAB@B@B@F@B@DBB@@@BA@@BABAB@B@@@B@F@BA@A@A@@@A@A@@BCBA@A@A@A@A@@@A@A@A@A@A@EB@@ABA@@BAB@@AB@B@B@@@B@@B@@AB@@B@@B@@DBBB@BBB@BD@@DD@D@BD@@@BH@DBB@BAD@B@BABCB@B@B@B@@@@JFBB@@@@DBBBFBB@D@F@B@B@B@DBB@B@B@B@D@@@DDB@BBB@@@B@B@B@BBBB@@A@@BC@@@AB@BA@@@@BAD@@@B@@@BB@@BB@@@@B@B@DD@B@@@D@@BBB@@BB@BB@DB@@@@BD@@@B@B@@AB@BAB@BB@BBBB@B@BB@B@@@B@@@D@@@BA@@FCFAB@BB@AB@@@DADABA@@D@D@B@@A@AB@B@BBDABBFBB@B@B@D@D@B@D@H@B@B@B@B@D@F@D@F@FB@@H@B@B@BBBBBB@BBDDDDBBBB@@B@BBDBBBB@B@B@@@@ABA@A@@@EA@@@@A@@BCDAB@BABAB@B@@AB@@AB@@@@AB@BCD@@BB@BBD@B@BB@BB@BBB@@@BAB@@@BBBBB@BAB@B@BB@@@@@BBBBBB@DB@@@@DABA@@BAD@B@D@@BBB@@B@BBB@BBBCBAB@@AD@D@@@B@@A@@BA@AAA@@@ABGDA@ABA@@@C@@@C@C@A@AB@@@BA@@B@B@B@@AB@@@BBB@@@BAB@@C@A@@B@@A@A@C@@BA@A@@B@@AB@@A@@B@@@@AB@@@@B@@@@@@BA@@BB@ABB@@@@@B@@B@@ABBB@B@@B@@@B@@B@B@B@@@B@@@@AB@@AB@BAB@@BB@@B@@@@@AD@@@@AB@@ABA@@B@@@B@@A@@@ABB@@B@@A@@BA@@BA@@@@B@BA@ABABAD@@AF@FAB@B@B@B@DAF@BCDAB@B@@@@@@@B@@CBA@C@A@ABA@ABCDCD@@CB@@C@A@A@ABA@CBA@A@A@@B@@@@@BBDBB@@BFBDB@@@B@@@@D@@@D@BA@AD@@@B@@BBDA@@BBDBB@BBB@@@@B@@@B@B@BBDB@@BB@@BD@B@BB@@@BB@BB@BB@D@@@B@@@@B@BBB@@B@@@HAB@@@BB@@@F@F@@@BB@BB@@D@B@B@B@B@@@B@BB@@AB@@BB@BBB@BAD@@@BFDBB@@@B@@B@@@DDB@BBB@B@B@B@@@BA@@B@@BBBFBBBBB@B@B@@@BB@BDB@B@B@B@B@B@DAB@B@BA@@AC@AB@@A@AB@@A@@BA@@BC@@@A@A@@@A@ABA@ABA@@@@B@BADE@@BA@@@@@A@@AA@@AA@A@@@@@A@@@A@@@A@@BA@AAE@@B@@@B@B@B@B@B@@A@@@A@ABA@A@ABA@@BABA@C@@B@BA@@@@B@BBB@F@B@@@DAD@B@BB@@BBB@B@FD@BBB@@AB@B@@BD@@@@@@@D@@BBB@@B@B@B@B@@BBBDDB@@D@@@DBB@@BBB@BB@DBB@B@D@B@B@BAD@BAB@B@B@BBB@BBBBB@BD@@@B@@BB@D@@@B@@@D@B@B@@@@@B@B@B@@@B@@AB@@A@AA@@A@CDA@CB@@@@@B@B@D@BB@BDB@B@DB@@@@BB@@DBHBBB@@DDBD@@@B@B@@A@@B@@@BAD@@@@CBA@AB@@@BBB@@@@@B@@@B@BA@BF@BBB@D@B@D@D@BB@@@@BAF@@@BBBBBBB@@B@F@@@B@@ABA@@B@B@BABBBBBBBDDB@@D@B@BBBB@@BDBBBD@@B@B@BD@@@BB@@D@@DB@@BBDBDBBBFBB@B@B@@@B@@@B@B@B@@@BA@@@A@@AAA@@AAA@A@@@@@@@AB@B@@BB@@BB@B@@AB@@@BAB@@BBB@@D@BB@@BBBB@B@@B@B@BB@@B@B@@@@@B@@BB@B@B@B@B@@AB@B@DBB@@B@B@B@@@BB@@@B@BAB@BA@AF@BDFAB@@@BAB@B@@BBB@@BBD@DAB@B@B@@@BEBA@AD@B@B@F@BBB@BB@@BB@DD@BDB@B@BB@@BBB@@@D@@@BB@@BBDBB@BB@@B@@B@BBDBD@B@D@B@BBB@B@B@@B@BBBBBAB@@@B@@BB@@@B@@B@@@BDD@BD@@DB@DA@@BAB@B@BA@AHA@@D@BAB@B@@BBBDBB@BD@@@B@B@@@@@BB@@@BDB@B@B@BBBDB@DBBAD@B@B@BBB@@@B@@A@A@@BAAAB@BAB@BCBA@AB@@@B@@BBBDDB@BBB@@@B@@@BABAB@B@@ABABA@CAA@@B@@AB@@AB@D@BAB@B@DC@@@AD@@@BAB@@@B@DABBB@B@@@@@BDB@@BBBBDB@D@@@@A@@B@@BB@D@B@B@BA@@@AB@B@DA@@BBB@BB@@BB@D@@BB@@B@@B@B@@@B@@BDB@BBB@B@@@BB@B@B@@BBD@@B@@DD@BB@@@B@B@@@B@DB@BBB@@@@B@@@B@BAB@@BB@BB@@BB@@@B@B@@DBD@@@DB@B@@@D@B@@BB@@B@B@B@@BB@@@@@DABA@@BBBD@@BDD@B@BBB@@@@@@B@@BBB@BDBDBB@@@@@BA@AD@@AB@B@@B@@@F@B@@BB@@BB@BB@B@@B@D@BBB@@BB@B@@@D@D@BAB@@BBD@@@@B@D@D@@@BB@@D@D@@@B@@@B@@AB@BC@@B@B@D@B@B@B@BBD@@B@B@BAB@B@B@B@@@BA@@BA@BB@@DD@BBBBB@@@BBBBDB@BB@@B@B@@@@BB@@B@B@@B@D@B@B@@BBB@@@BB@@BB@@@BBBB@@BBB@B@BB@D@D@B@@BBFDB@@B@@@B@@@B@@@D@BAB@B@@@@@B@@@@B@B@BA@@BADA@@@@DCB@@@B@D@B@B@B@D@B@B@BB@BB@BBB@B@@BB@@B@@B@FAFA@@BBB@B@BADA@@B@@@BB@B@@@@B@D@B@@@BBBB@@B@@@B@@@@A@ABA@A@@@ABA@@@@@AB@@A@ABA@A@@BA@@B@B@@@BBBB@@BBBDBDB@@@@@@ABE@@BC@@J@B@B@B@BB@@B@B@BA@@B@BBB@BA@@DB@@BB@@B@BAB@@AB@DBB@@B@B@@BDB@@@DBDA@BDDDBFBHBLBD@DAFCHC@CAA@A@@@@B@BA@AB@@@DAB@@@B@BCDA@A@@BA@@DB@@B@BAB@BBB@FDBDBB@B@B@DCDAD@D@F@BBBBB@@B@@@BADAB@@ABABA@ADA@@DAB@@@B@@BDDBBBDBBBBBBDBB@@@BABAHEBAB@B@BBDB@B@@B@@A@AA@@AB@BAB@@@@@@B@D@@@@ABAB@@@BB@@@@D@BAD@@@BBBBD@B@@ABAB@B@@@BBBBBB@BB@@BA@ABAB@DADCB@BB@@@@BAB@B@B@BBBB@@@A@@@@@@@C@@AA@@A@AA@AA@A@@@@@@A@E@ABABCDA@@B@BB@@BBDDB@@B@BA@AB@BB@@BBBDF@B@@@B@B@B@@B@D@B@@@AB@@@@B@D@B@D@@@B@@B@@BB@B@@@@ABA@@@ABBB@B@@B@BB@@@@@B@B@BAD@@B@DBF@@@AB@B@D@@CD@@AB@@A@ABA@AD@@@@BBB@DB@@BB@@BB@B@B@BB@BA@@DA@@@@B@@@B@B@B@BABAB@B@@@BAFCB@BADA@@B@BAB@HCDAD@@AB@@C@@@@@AA@CAA@@@AA@AAAAA@A@@@@@AFE@@@ABA@@@@DB@@B@@@@DBB@@@B@B@D@@@BDBDB@@BBBBB@B@B@B@D@DBB@B@BBBB@B@BB@@A@CBABA@@BAB@@@B@FB@@DBBABA@@@AB@@A@@AA@@@EAA@@B@@@B@B@B@B@B@@A@@@@@@C@A@CAC@AAAAD@B@@@B@B@B@B@@@@A@@@AB@@@@@AA@A@A@AACAA@@@@A@A@A@CA@@@@CAAAAAAAA@AA@@@AB@@@B@@A@@EAC@AAAACAAAAA@@@@@@BABA@@B@B@B@B@B@B@@ABA@@@A@A@A@AB@@@BAB@BA@@@EBA@A@A@@@A@AA@ACAE@A@ABC@@BA@ABABA@@A@C@A@AA@@@A@A@@@A@EA@@ABCBE@A@A@@BEBABA@AB@@A@AB@@ABA@EA@@CBC@A@A@@@@CAAAA@AA@A@@@@@A@@@AAC@A@@AA@@A@A@@@CCAAAEA@@A@C@AB@@A@AAA@@AABA@C@@AA@AAA@@BCBE@A@@@A@A@@@@@A@A@A@@BABABA@A@C@C@@@@@ADA@@@@@AB@BA@A@A@@B@B@@ABA@@BABADEB@B@@A@@B@@@@A@ABADA@ABC@CBA@@@ABAF@FA@@@@@A@@@A@@@A@AA@@@AA@C@A@@@A@A@ABA@AB@D@D@@@B@@@BABA@A@@B@@@B@@@@A@@D@@@BA@A@AB@@ABA@ADA@@@@@C@A@@BAB@@@@CB@@AB@@@B@@AB@@ABA@C@AB@@AA@AAAA@@@A@@@AAACAC@CAA@A@AAA@@AAA@AAA@@CAAAA@@@@A@@@ABA@@@AA@@A@AAA@A@AA@@@@CA@@@AA@@BA@A@C@A@A@EBC@C@@BA@@BA@ABABABAB@@@@A@@CGA@@A@C@AA@@AAA@@@C@@@ABC@@AAAAAC@@@@@A@@@A@@CAA@C@C@AA@@AA@A@C@A@A@@AAAACC@@@@@AAA@C@A@A@@@A@@A@@BA@@@A@ABA@CFA@CB@@A@A@ACAA@@A@@AAA@CAC@A@@A@AAABA@ABA@A@AAA@@A@EAA@AACAA@CA@@CA@AA@A@A@A@A@@@AAE@@@A@A@@@A@CAA@@AA@AAA@@@AGC@A@@@AB@@AB@BA@@@ABA@@H@B@D@D@B@B@B@B@B@F@B@B@B@@AD@BAB@@AA@@A@A@@@A@@BA@CBAAE@ADGBCBE@C@A@C@@BC@A@A@AHG@@@ABA@A@@BCBC@@@AB@@A@A@@BABA@A@C@A@@@C@@@@@@@@B@@A@AB@@@BA@@B@BA@@B@BB@@@A@@@AB@@@B@B@D@BAB@@@@@AA@A@@B@B@BAB@DA@ABA@C@@@@@ACC@@@@@A@AA@AAA@C@A@@@@A@@@A@@BA@@@AB@@@DE@A@@@AA@@@AB@@@B@@@BA@@AA@@@@@A@@@@AB@BA@@@A@ABCDA@AB@@@B@@@BBB@BA@BB@B@@BB@@@@@@@@@B@@@@@@BB@@@@@B@@@@@@@@C@AACCA@A@@@A@@AAA@@@AA@@AAAC@@@@AC@@AAA@AA@@@@B@F@BBDA@BDB@@@B@@@@BA@A@@B@BBDB@@DB@@BB@BB@@B@B@@@B@@BAB@DBH@@@@@@E@@B@BABA@@@@@A@A@A@@A@@AA@@@@C@@C@@A@@@@BA@@BA@A@@B@@A@@BB@@B@@AB@@@FBB@B@B@BB@@B@BA@@BBB@B@@@@@@A@@@A@@@AB@@AB@@@@AB@B@BB@@B@BABAB@DAD@B@B@@BB@B@BBBDBBF@BABA@@B@B@@AB@D@BAB@BA@@B@BAB@@@D@BA@@@AAC@@B@@@@A@A@@@A@@@A@AB@BABC@@BABA@@@@B@B@@@B@BABA@@@A@@@@B@@@B@B@@@@A@@AA@@B@BAD@BABA@A@A@@@A@@@C@A@A@@@@@@FAB@B@B@@@B@B@@@AA@@B@@@DABADG@@BCBCBA@@@CDAA@AC@C@C@@@@AA@A@@@@BB@@B@@@@AA@@@BA@@@@@AA@@A@A@@@AA@@AA@AACA@@CACA@@@@@AA@@A@AAA@@AAABA@A@A@@@A@A@@@AD@@A@C@A@A@@@AA@A@@CAAAAAAAA@A@C@A@@BABCB@@A@A@@@A@A@@@AB@@A@AA@AAA@A@@AC@@A@@AA@G@AA@@A@AAA@@@@@@A@ABCB@@AB@B@@@BA@@BA@AB@@A@A@A@C@@BABA@@BA@A@A@AA@AA@@B@@A@@@@@AC@A@AA@AA@A@@@@A@@BA@@@A@@@AA@@@A@@@@@ABA@@@AB@B@BCB@@@B@@A@IBMAA@E@A@CBC@A@@@A@A@ABA@AF@@AB@B@B@@@ACAEA@A@@@CA@@@AA@A@C@A@AA@@A@A@@AA@@@@@@BC@@@AA@A@A@A@A@AB@@AAA@AAAAAA@@C@AACA@@A@A@CAAACAA@A@@@@@AH@@A@@@A@AA@@A@CAA@AA@@AA@@BC@A@AACEA@@@@ADCBAF@@@B@@A@A@@@CAC@@@AAAA@@@@AB@L@FAJ@BAD@BAB@B@BA@AD@@ABCBA@ABABA@@BA@@B@B@DA@CDA@@B@B@@EBA@A@@@A@A@ABABCBA@A@@BABCB@B@BBB@BB@@@@BB@@D@D@B@@ABA@@D@@@B@@AB@@@B@@@@@B@D@@AB@@A@CB@@ABABAB@D@@@BA@@B@B@@@@CBA@@@@B@@A@AB@@@B@B@@@B@@AB@@A@C@AD@@A@A@A@G@@@A@@@A@@@A@@B@@BB@@AB@B@B@D@D@@@B@DA@ABC@@BAB@@@@@D@@AD@B@@@BABAB@@@B@@@B@@@@A@@BABABAFAD@@@B@@BB@B@D@D@DADAB@@ABBFADBB@@@BBBAB@B@B@B@B@DADAB@B@DADAB@@AB@B@@AB@B@D@@BB@BBB@B@B@@BD@D@D@BB@@B@B@D@@@BAB@BAB@@@BAB@B@B@B@@@B@@@B@@A@A@AB@@CD@BABAB@B@B@D@@ADB@@@AB@@@BAB@@A@A@@@A@AAA@ABA@E@A@A@ABA@@B@BA@C@@BA@@@C@C@A@AA@CAA@AAA@ACAA@EC@@AAA@A@CB@@A@@@AAA@AAA@A@@@A@@A@A@AAA@A@A@@@A@@ACAAAA@A@@@AAAAA@AC@A@A@A@@A@@C@A@CAAA@A@@B@@@D@B@@ADA@@@A@@BABBB@@A@@A@CCA@A@A@ABAAI@EAC@AACB@@ABAFAB@DA@@@@@AAA@A@A@@@@@@A@A@@@ABCAA@ABA@@ACBCBCACAA@CAA@@@ABA@AB@@@D@B@@A@A@ABA@EAAC@EAA@A@A@@@A@@AAACAA@@@AA@@@@AA@ACAA@@@@A@@@AAA@@AAAA@@@AA@@A@@@CAA@@CA@@AA@@AAA@A@A@@@@A@@@@@AA@AA@AAAA@@AEC@@ABA@@BABA@@BAB@B@@A@ABA@CAABE@ABEBABAAA@A@@@AD@@A@@@@@CA@@A@@@CBA@A@C@A@ABAD@@@BB@B@BB@@@D@@@B@@@@A@@@A@CBC@A@@BA@A@CA@@C@@@CAA@AAA@@@CC@@@A@AAAA@AAA@EAA@AA@@A@AB@@ABA@CBCD@@A@EBC@ABC@C@@B@@A@A@A@@@AAA@C@A@AB@@A@AA@AA@@@A@A@A@@@@@C@A@@@EAC@@@CCA@AAA@AAA@@AA@@@A@A@A@AAA@A@AAAAC@@@AA@@A@@@CC@@@@A@@@A@E@A@E@A@CBCB@@@BAB@@ADA@@B@BA@@BAB@BA@@B@BBB@BABAB@@@HBF@@@BBDBD@BB@@BBB@D@B@D@@@B@B@@@B@D@@A@@BA@@B@@ABABAB@@@BAB@@AB@@AD@BABAB@B@B@B@@ABE@ABC@@B@@AB@B@@CBA@AB@@A@ABCBABA@CBA@AA@@A@A@@@AAAA@A@C@A@CA@@A@AAA@AB@CAA@A@@AA@AAA@E@G@CBA@A@A@EBA@C@C@AA@@@ABE@A@A@GBA@A@@@A@A@@AACACCAA@@@@C@A@@AA@EAAA@@@@A@C@A@@AAA@AA@BAAAAE@AAAACAA@@@A@@AA@A@@@A@AB@B@@@@A@A@@BEA@@@@C@@@A@CAABA@A@CBC@ABA@A@A@A@A@@C@CAIECCGACACAAAECAA@AA@CAAA@@C@@A@@AA@AAC@@@@C@A@C@@@E@A@A@@AA@@A@@CC@AAAAA@A@A@AAA@@@A@A@C@CBC@C@C@CAA@A@A@ACCAA@AA@AAECCCAAA@AAC@AA@@AAAAAA@@C@C@C@E@C@A@A@@@AAA@EAE@AAC@@@@A@A@@@@B@@@@A@@AA@A@@A@GAA@AACAA@AAA@@@A@CAC@C@ECA@EACA@AA@@A@A@A@AAA@AAA@A@AA@@AA@@C@@BA@C@@@C@AB@@@@AA@@C@@@AAA@EA@@E@@@AAA@@@A@A@@@@@@A@@@A@CDA@C@A@A@C@AB@ACA@@@AA@AA@@A@AA@@@@A@A@@@@@A@AEA@@AC@AA@A@CAA@E@A@AA@CA@@@@AA@@@C@A@A@C@@@A@C@@AA@AA@A@C@@@A@A@A@EBABCB@@@@AB@BABAB@B@@GE@A@@C@@@AAA@AAC@C@CA"],encodeOffsets:[[110071,33390]]}},{type:"Feature",id:"610725",properties:{name:"勉县",cp:[106.673221,33.153553],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@@@@D@B@B@FAD@DA@@@@@ACE@AAA@@EEAAAAAC@AAE@A@@A@C@A@@@CBA@@BADAB@@CACAA@A@C@AAA@A@A@A@A@A@A@A@@@A@AAA@@@@A@A@A@A@@B@@AA@@@@@@A@@@@@@@@@A@@@A@A@@@@CAEACAAAAAA@A@A@A@C@IAEBA@E@ABGACBA@ABA@CDA@@DAB@DAD@BABCDABAD@@A@A@A@AB@BADA@O@CAC@@@A@A@EBABE@E@A@GBABID@@A@C@CAA@A@AAC@EAA@A@AAEAA@CAGAAA@ACCACCAACC@EACACAE@M@A@A@A@CAGCA@ECA@AAA@AAC@A@G@C@ABC@@BA@ABEDCDA@ABABGF@B@@ADAB@BAB@@@BBB@D@BB@@B@@BB@@@@@BAB@@ADAD@B@@@B@@B@B@A@@B@@@@@@@B@@AB@@@@BB@@BB@@@B@B@B@B@BA@@B@B@@@B@D@B@@BB@BDB@@B@@@BABA@ABA@A@AB@@BB@@@@B@DB@@@@@@@@ABC@@@@D@@@B@@B@D@D@B@@BBB@@@B@@BBB@BBB@@@@BA@@@F@B@@@BAB@BBBB@B@BBB@@@DBD@D@B@B@B@@@DA@@BB@@B@@ABB@@BBB@D@@B@@@B@B@B@B@@A@@@@B@@A@@BAA@BAB@@@@FB@@BBBDB@@AD@@@B@@@@@@B@@A@ABA@@@B@BB@@@B@@@@@D@B@@@BA@@B@@BBBBB@@@@BB@DB@@D@B@BB@@BB@BB@B@B@@@BB@@D@B@BBBBDBB@@@@B@@BBJ@BB@@B@@B@B@@ABABADABAD@BABA@ABA@@BA@A@AAI@A@E@@@A@@@@@B@@A@@B@@@@@AAAAC@A@AACBAAA@@@@@CD@B@@AA@@@A@A@@A@@@@@AB@B@@A@C@A@@@A@A@A@@AC@@@C@C@A@@BAB@B@B@B@BAD@B@D@B@@A@AB@@@@BB@@B@@@B@@B@D@BBB@B@@@@A@EAA@EAA@AAECEC@@@@A@@BA@@@A@A@EAA@@@CAC@A@A@@@A@AB@BCBAF@BA@@@CAE@C@CBA@C@A@A@AAA@@BA@A@A@@@@@@@@A@@@@@@B@@A@@@@A@A@@@A@A@CCCAA@@B@@@B@@@D@BAD@@AB@@AAA@@@A@A@@@@@@D@D@B@@@@C@@@@@@BABAF@B@BA@A@@@A@CDEBABABADABCD@@@DABA@@B@F@BA@@BBB@D@@@@ABA@A@CBA@@B@@A@@D@@CB@@AB@DAB@B@@A@C@ABA@A@@@@@AA@A@@B@@@@A@@@@ABA@@AE@@@AA@@@A@A@C@@ACA@C@C@A@@@A@AA@@@A@C@@@AA@C@A@AB@@CAA@A@ABA@@@@@AA@@@@AAA@@@@@ED@BA@@@A@@@CA@@A@AACAA@AAAAEAAAA@@B@@@@@BADA@@BAB@@AB@@AB@@@BA@A@CA@@A@@CAA@@AC@@CAA@E@@@@@@BB@@BBBBBBBD@BB@@A@A@AD@@@@AAA@A@AB@@AB@DA@@@@AA@@ABE@A@@A@A@@@@@@F@@@@@@@B@@A@A@@@BB@@B@@BABABA@@BAB@B@B@BB@@BA@@@A@A@@BA@@@@@A@@@@CBCA@@@@@@@AD@@@@A@@A@@A@@@@@@BCDABADAB@B@@AB@@@@@@@B@@BB@@@@BBB@BBB@B@@@@@BB@@@BB@@B@CBA@A@@A@A@@AA@@C@A@ADAD@@AB@@B@@@@ABA@A@AB@@@BB@@AHABAB@@@@@@A@@A@C@@A@@DA@@B@@@@@F@BAD@@B@@A@@B@@@@B@@@@@@@@B@@A@@B@@@BA@@@@@A@@@@@BA@A@@A@AB@B@@A@@@EB@@@@@B@BAB@@@@BBB@BBB@@@@@@BBB@@B@@@@A@@@AD@B@@@B@@@@BB@@@@ABA@@B@@B@BAH@@@BABCBEBCBA@AB@B@@BD@@BB@AJADAB@B@B@BBBB@@BBBB@B@BAB@B@@B@B@A@@B@@ADABCFCD@BA@CA@@E@A@@@A@A@ABA@@BA@@BBB@B@B@@@B@@B@@BDDFDBB@BB@@BABBB@B@D@@BB@@BBB@@@BB@@BB@DB@@@@BBB@@@B@D@@BD@@BB@B@BBBBB@BB@BBBB@DB@@@B@B@B@@BB@BB@BBBFBDB@@BA@@@@B@@@BA@@BABA@AA@@@@AB@BBBBBDBBBBB@DBB@B@BBB@BBB@FCB@@@BB@@@B@B@B@@@@DBFB@@@@B@@B@@B@BC@@B@@@BBB@@@DBD@@@BA@@@AB@D@BAD@@@BA@A@@B@B@@@B@@@@@BB@@@@D@B@@@BBB@@BDB@@@BAB@@A@@@@B@@@B@BBD@@@@AB@@@B@@@@BB@@BB@BB@DDBBDDBBBB@@BB@@@D@D@@@BB@B@@BBB@D@B@BA@C@C@AB@B@@@B@B@BAB@B@@CF@BAB@@@B@B@BBB@@@@B@B@DEBAB@B@B@B@B@HAB@B@H@B@@B@@@BG@AB@BABAB@@@B@@@D@B@BB@BBBBBBB@@@AB@BBB@@@@@@B@@AB@@AB@@@BB@@@@BABC@A@@D@@@BBBBBD@@B@BB@@D@@BBAB@B@B@@BBB@@@@@@B@@@@A@@@A@@@@B@@B@@@B@BA@A@@BC@@@CBA@A@ABCD@@ABA@@B@@@B@@A@CDCDABA@@BABAB@@A@@@@@ABA@@@AB@BABA@ABA@@B@D@B@B@@@@@BBB@B@@@B@BBD@@BB@@@B@BB@@BBD@D@@@BCB@BCBABA@@BA@@DAB@BA@A@@B@@@B@@BFBB@@@DBD@@BBDBHDB@@DBD@BB@BB@@B@B@@@DB@@@B@@@F@B@@@BB@@BBB@DB@@BABCDA@@@BBBBF@B@B@H@B@@@@@B@B@@@@@BAB@@@B@@BBB@@@@@B@BAB@BAB@BAB@BBB@B@@@BABB@@@B@@BB@@B@@@BBB@@@B@BB@B@@@BB@BBDB@@@@D@B@@@B@F@BFB@@BB@@D@B@@@@@DC@@@@B@BDBBBB@@BB@BB@@@@B@B@BB@@@AD@B@B@B@BB@@BABBB@BB@@@B@B@@@@BB@B@@@@BBD@B@@@B@@@@@BB@@@@D@@@B@@AB@@@BA@@@CB@@A@@@A@ABA@ABC@@B@@@@@@BB@B@@@B@@BB@B@B@B@BBD@B@D@@@BA@AD@B@BA@BB@B@B@BB@@B@@AB@B@D@@@BCB@B@B@B@@@B@@@@@B@B@D@D@B@@CDA@@@@BAB@B@B@DBB@B@B@BAB@BAFABA@A@@@A@@B@BC@ABABA@AB@@@B@B@@@B@B@BAB@@BB@@@@AB@@A@@@ABA@CBABA@A@@@@@@B@@BB@@@DBB@BA@@DBB@BB@@B@B@BB@@BAB@@BB@BBB@@BDB@@BB@BB@BBBBB@@@@@BAB@@AD@BAB@@@B@@A@@@@@AB@B@B@@@B@B@B@@AB@@@@A@A@AB@@ABA@@@@B@B@@@BABA@@@@B@@@@@B@B@DA@@B@BBB@@@B@B@@CD@DA@ABCFAB@@@D@B@B@DAD@BABA@@@C@@@ABAB@@AB@BAB@B@@BD@@HGHALHHDDDDDBBFBFAJC@ABAB@BB@AB@BADC@C@CBC@ADAB@AA@@FAFB@BBBBDD@HCF@J@HADCD@DHHLB@DD@BF@D@BDDFDHBFDLAFCPCJ@H@HDHDBFBJBHDH@@@B@D@@D@DID@DBB@@@@BB@@BD@@B@@A@@B@DAB@BC@@BABADAB@B@BAF@F@DA@B@BBB@BB@@B@D@BDB@BD@D@@@BDD@BB@@BB@@@@DB@@B@@@@BB@@ABAB@@AB@BB@@@BB@BBB@BB@@@B@B@B@B@BBB@BBD@D@B@B@B@B@@A@@@AB@@AB@BBB@@@@@B@BB@DB@B@BB@BA@@@BBBBB@@@BBD@F@B@@@BB@DBD@B@@B@@@BBB@@@BB@@@BBB@D@B@BB@@BAF@B@D@B@@B@ABABABABA@@D@@@B@@@@ADC@@BABA@A@ABABABA@A@@BAB@@@@A@AB@@A@@D@@BB@@@B@FC@A@@@ABAB@@@@A@@@AA@@ABA@A@@BA@C@@DA@ABCB@BA@@@ABC@AAA@ACE@AACAECEAC@CAAACAA@A@ABA@AB@@C@A@AA@@AACAE@AAA@A@CA@@A@A@@AA@@AA@A@AAA@C@@@C@ABCAA@A@A@C@@AA@@@C@A@@@A@A@@AAAC@AB@@C@@AA@A@A@@@A@@AA@CAA@CA@@AEEA@AAC@@@A@AC@@@A@ABA@ABCAC@A@A@ABEBA@@BABA@ADCBA@A@@BCB@B@@CB@@@BAD@B@@@DCB@@@@D@@B@BB@@B@@@BBBBB@D@D@@BDBB@B@BBDB@@B@B@@@@BB@@@DBDBH@@BBB@@B@B@B@B@@BB@@BB@FBBB@@DBBB@@@@B@B@B@@@DBDBB@BBDDB@D@DDB@B@DB@@@@@B@@B@B@@@B@BBB@D@D@FBDBBD@@@BB@BBBD@BBB@BBBB@BBB@@@B@FBF@DBB@@@@DBB@@DBBBB@DA@BB@@@BBBBBB@BB@D@DBB@DDB@@@@@BA@@@ABA@@@@@ABA@ABA@@BAB@@@AA@@@@@AB@@@@AA@@@AA@@@C@A@A@@AA@A@@@@@C@C@@BA@@B@@A@@@@BAB@@A@@BA@@B@@@@A@@@@D@B@D@@@BA@@AA@@AA@@AA@AA@A@@AA@@A@@BCBAAA@@@@BCBCBC@ABCFGBABADADABADABA@@@A@AAAAC@@BA@AB@@A@@@@BA@A@@DADCB@BA@@@AB@D@D@B@B@BAB@@@B@@ABA@@BADA@@B@@AB@BAB@BABAHE@@BCBA@@BA@@@A@@AAA@AAA@@@GAC@@@C@AAC@@A@@A@AAA@@C@AA@@@A@A@GECACAA@@@AB@@@@A@@@@A@@BABCDA@A@ABA@@DCBA@A@@B@BAB@D@FBB@BB@@D@B@B@B@@A@A@AAAAABA@@@A@@@A@A@@DA@@@@@C@@BABAAA@A@A@A@@DBB@B@@A@A@@@AACAA@@@A@@BA@@BABADA@AB@DA@@B@@ABABA@@BA@A@AB@DA@@@@@AB@DBB@D@BADABAAABA@ADA@A@A@A@@@@B@B@B@@@@A@@D@@@BCAABA@@@C@@DC@AB@FA@AB@@AA@CACCCACAAA@AA@A@@@@AAA@A@A@A@AAAA@AC@AAC@C@A@@@ABA@A@C@@@ABC@C@@@CBA@AFABA@@BCBA@@@@@A@@AC@E@ABC@CBAB@BCBA@C@@BCB@@AA@@@AA@A@@@C@@BADAB@B@@AA@@A@@@@BABABA@@@@GE@@AA@CAA@ACAAAAACCAA@AA@A@A@AAEA@@B@@@@@D@@@BA@@@@@AAAAA@AAA@AAACAA@@@@AB@@@DCDCBABA@AB@B@BAD@B@@@DBDB@@B@BA@@BA@@B@B@DBBBB@@@@@BAA@AAAAAA@@@@BAB@FBB@@@@AA@@ACA@@@@@@B@D@D@DA@@@@DEBABA@@@A@A@A@A@@BAD@@@B@B@B@@B@B@@FDBBBBB@DBD@B@B@@@F@BAD@B@D@@@B@B@B@B@DAB@FAB@@@BC@@DADABA@@@@@A@@@A@CB@@AB@@AB@@A@A@@BAD@@@DABBBAF@H@B@@@BADCHEB@@ABC@CBC@I@M@@AC@CCGAE@@AC@A@CAC@AAA@A@ACEAAACCECAAACA@@@@AAA@@A@@AA@A@@A@AAEIAAAA@AAAAAA@@@@AB@D@AA@@B@@A@@@@AA@@B@AA@@@A@@@AB@@EAA@A@@A@@@C@CA@@A@@A@AB@@I@@@@@AACBG@C@@@AA@@AAAAAACCCACAAAC@A@@@@@@B@BB@@B@BA@@D@BAF@B@@AAA@AB@BABA@@@C@C@@@AA@@CA@AB@@AB@@ABCBABAB@@@BA@@@A@AB@@@B@@CD@A@@AB@BAD@@@@ABBB@BA@@@A@@B@@A@@@@@A@@@EBBBA@@@A@@@@AA@BA@@@@@A@@B@@@BBBAECAAA@@AA@CAA@CBAA@@@AA@BAA@@@A@@@@@AA@@B@@@@@@A@@@AA@A@@@@A@@BA@@@A@@A@A@@@AAA@A@@BA@@BA@AAAA@@AA@@A@ABAB@@A@A@C@I@A@A@CBA@A@@A@@AAAA@@C@A@E@@AA@AAC@AA@@@A@AAAAA@@@C@AAA@@CAEACAE@G@C@C@C@BDD@B@@@BB@@@B@@A@E@@@A@A@@@AAGCAAAAAA@A@@BA@@@@A@@@BA@@B@@@BA@@A@@@A@@BA@A@A@CA@@AB@@@@AAA@@@@H@@@B@@@BAB@@A@AAAAA@@A@@@AA@@AC@A@AAC@AAE@A@C@A@EA@@@A@EBA@A@A@@AAA@A@CAC@AAC@AA@AA@A@A@ABED@B@D@BABA@@BAB@@AAAA@AAAEC@@A@@A@ACAAA@@A@@@A@@A@A@A@ACAACA@@A@A@A@@@@A@A@A@EA@@@C@A@@ACCE@@AC@G@AA@@AA@A@@@@@CB@@A@A@@@CAK@AACAICAA@@@A@@@@@@@AB@BBB@J@B@PAD@D@B@@@DABE@@AA@@@@B@B@@@@@@AAAB@BB@@@@B@@C@@A@@AE@A@@@C@EA@A@@@@@AB@@@BC@@AA@A@@@AAA@@C@AACACACAAAA@@AAA@ACCACAAAA@@AACA@@@ABCBA@@AA@@@@@@@@@AA@A@@A@@A@@@@AAAA@@AA@AB@@AA@@AAA@A@@@AAA@@BA@AB@A@@@@A@@@@A@A@@BAB@BCB@BC@@BC@A@A@A@CA@@AB@@A@BD@B@@@B@@@B@@@DC@A@AB@@@BA@@@@@A@@@AA@@@AA@@AAGCCCACA@@A@AAAAAAACA@@@A@A@ABC@C@@BA@@DADCFCDAB@@@B@BBBBF@@BDB@@B@@AD@BABA@ABA@A@C@CAA@@AACA@AAA@@B@@ADA@A@@@@C@@@@@@A@@@@A@@@A@@A@@BAB@B@B@@A@@@A@@@@E@A@A@C@@@A@@BA@@BA@AB@@@@AA@@AA@GA@@ACAAA@@EC"],encodeOffsets:[[109280,33715]]}},{type:"Feature",id:"610726",properties:{name:"宁强县",cp:[106.257171,32.829694],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@IH@B@@BH@DH@@@@E@@@A@A@@@A@@@I"],["@@ACAAAAA@GACACAC@A@@AACAEAG@CA@@@@AIBE@E@EAABG@E@GBK@CBEBABCDCFCBABA@A@A@@AAE@C@C@EAC@CBG@C@@@AA@CAQO@@KIECCCACKEA@A@ABC@IBEBA@CCA@A@A@GBCDCBKJCH@DBDABA@CBABA@@DDLADQJILCDEDEDIFCBABADCBAB@@AAA@@@MBEBA@E@G@GACBOFKDKBEBA@AAAAAAEEAAC@G@EAE@GCE@A@GBEDCDCFAH@FABCBABCDAD@BABGAIAE@ABA@CFGAGCE@E@MBA@GDADA@ABC@AA@CAAC@C@GDK@G@GAEAA@GDGBADBB@DAHCFCBIAGCIACACDADCFAF@B@BADCBM@ABCBE@@@@@@@A@KAKCGBEBABABCRCDCDGBGBE@ABAB@DABADCDA@ABA@CA@@CFABABCBAB@J@ADBBBFB@BBBBBBBDBB@BD@D@B@BAB@BA@@@ACECACCAA@CA@@AAG@@F@BC@A@CAK@AB@BBF@HBFBB@D@BDBB@HBN@DBBBDJFDB@B@D@DAB@DD@DBD@DABADABBBBDBD@DEHAB@@BHBF@@@BC@C@CDA@@@CAC@KACBA@EAA@@B@BDB@B@BA@IFADGDCDELC@EFABA@AD@BCD@B@@DDBDCDABADAD@BA@A@A@A@@ABC@CBAC@EAAA@C@CAA@@A@QLCBA@A@@A@@BE@E@GAC@CAAEACAEE@@@GAC@CAAC@A@CB@@AAC@AAA@KAED@B@BEF@BGHCDA@C@A@EDIHABCBA@E@AACACCAAEGACAAEAAAKGGCA@E@KGA@A@C@ABEFCBEBC@C@A@GBCBCA@@A@CBC@CDABGDA@A@ECA@AACAC@GACBAAAACAEAG@AAA@EEEBA@AGACCCAACAC@ABCBAB@@AAIBEDCDCBA@C@@@G@AAAOACAC@A@CBCBCDC@A@CGEGKACFG@GBGBGBE@CA@ABABCBC@E@CAEEEGCGCCCAEAKEEAGCCCCBCFGRCHCBA@@@E@A@C@GEA@E@CDEDEAKIEGEIWJIBKECAMMCGECCAGBE@C@AECECACCCGAEA@C@K@A@C@GGIEGBC@GECCCICCCAIBE@CAGIAAAAM@IAU@EAE@A@GT@FAHCFABAD@F@DADA@CAECC@C@MCE@EBIFEHAH@J@F@FBHDFHDFBDDB@BFADADADE@EBEBADAFAD@FBFBR@D@BABABC@CAAAC@CBCCCAGCC@ABCDGVADBHBHDHBFIVCDAD@T@HA@CBEBADABAHAFABMBMLEDCHGXBLBF@XBBFDB@BF^PBAD@LDD@FCLQFCJAHBFDD@LCHBFAFADCFEDEDEDCBGDGFEJGDCBAJ@@@L@JALBJBJ@B@LDJ@J@HDFBDFFDFJHFHDF@F@FBF@HB@@DBH@HBHBTDJ@HBD@F@HAH@JBH@JBB@HDLBJFJDJFHFJBDBDBHBHFD@FBFBLHXLJDFFHDDDJDDDH@B@HAHAJCNGHADABCH@HDHHFDFHDFDFFFFFHHBB@@DDHFFH@@LNPNDDFDDHBJFH@H@T@@FHFDBBAFAH@J@FCBGBCDGHEH@F@BBJBHBDDDH@H@FBBB@D@BFB@BEDED@@ADCHCDEDAD@DBNAHBDHD@@HHH@FAFD@BBF@DGFSA@@AHABADAHBDFFDDFBDDADCBCDEBCAGCEAEGMEE@CBCDCFBB@FDFFFJHFDJBHF@BLHB@@@@@BA@@BA@@@A@@CCCCA@CCAA@A@@BAB@@@BA@@DABADC@@B@DDDBBBB@B@B@BAD@D@B@F@@@@@B@B@@@BAFAHCDEBADAFBJJHGHD@@@@BBFBBBB@DB@@DBBBB@B@@@BA@ABC@A@@BA@A@@@AACACACAA@A@CBA@CBABA@A@A@ABEDCDADABC@@BABC@A@EBA@@@ADA@C@@B@@@JDD@D@@@B@@@BAB@B@FBB@B@B@B@@@D@BBD@B@D@B@@@BABA@@@@@AB@@@BA@@BA@@@B@BBA@@B@@B@B@@B@@@@@B@B@@@B@@@B@B@@@@B@@B@B@@AB@@A@@B@@@@@BAD@DB@@@BD@@@@BABAD@@CB@BCBCBA@AAABA@@BA@@@@BBBBBF@DBF@D@D@BA@@DBB@DAB@@B@@@@BB@B@@DBBBBBB@B@BBB@BBFH@@@@D@B@B@B@D@@@B@@@BBBBBDBB@BB@D@@@B@BA@@D@B@B@@A@@B@B@@@B@@BB@BAB@BBBBDFB@@B@@B@@@BBB@B@@@BBDBB@BDB@@BA@@BDDBB@B@FADBDB@BDB@B@B@BBBBB@@B@B@@BBFB@@B@@@BBB@@@B@@BB@@@DBDB@BDBB@D@B@@@BBBBDDB@@BB@BDBD@@@@@B@@@B@@B@B@B@DBB@D@@B@B@@B@FBBB@@BBB@BB@@BAB@@@@@BBB@@@BAB@@@@@BB@@B@@@B@BAB@B@B@D@B@B@@@B@D@@@B@B@BBD@D@DBD@BBB@@@@@DBBB@@BBB@BBB@@@@@BD@@@@HD@@B@BA@@B@D@F@@@@@BD@BB@@@DBBBB@BBB@B@BABB@@BBDBBD@@@@B@BAB@H@D@B@B@B@B@DCD@@@@@DBB@BBB@DCD@@@B@B@BAB@F@B@B@B@BBF@BBBADADABA@@B@@AB@@@BA@@BAB@@@B@@A@AB@@AD@BAB@FAB@@@B@B@B@@@@AB@BABA@A@AB@DA@@D@D@B@B@@@DCBA@CB@BC@ABA@@@@D@BBD@@@DAB@DAB@B@B@B@@A@@@@D@B@B@@@BA@A@@@A@A@AB@DA@ABA@A@AA@@A@ABADE@@@@@@B@B@B@@@H@B@@@B@B@BAB@BCB@@A@ABCB@@@B@@@@BBBB@B@D@D@DA@@@A@A@AA@@@@AAA@@BA@AB@BABABA@AAA@ABA@@BAB@DC@AB@D@B@@@@A@AAA@@AC@@@C@A@@AA@A@@A@@CAA@@AA@@A@AA@@AA@@@C@AAABA@AA@@AAAECCC@AA@@@@A@@@A@AAA@AB@@AB@BAB@B@@@B@F@@@DBB@@ADCDEBABC@@@AB@A@A@@@@ABA@A@AAAAA@@AAAAA@A@A@CBIB@BAA@@AC@@@ABAB@DAFADABA@A@@BG@A@AA@@@AB@B@@A@@A@@A@@@A@C@@B@@@B@@A@@@AA@A@@@@A@AAA@AA@@@@BA@A@A@@@@FA@@B@@@@ABAB@@@@BAB@@@@B@@@@@B@@A@@@AB@@@@A@@@@@@A@@@@@@AB@@@@AC@ABE@@@@@A@@@CB@@@BD@B@@@@B@@@@A@ABGB@BA@@A@@BAB@B@BA@@@@@AA@@BC@CB@B@B@DB@@BB@B@@@@BAB@D@AA@@A@@AA@@@@A@A@AAA@AA@@@@AA@@@A@@@@@@BA@@@ABABCBADC@A@@@@B@@@@BB@@@@@BC@@@@@@B@AD@D@@B@@@@@B@@AB@B@@@B@@AA@@A@A@ABA@AB@BABA@AA@@@AA@@B@B@@@@A@@@@@@@E@@@@B@B@@@@BAF@BB@@B@@B@@CBA@@BAB@B@BB@@@@BCB@B@@@AAC@AAAAAA@AA@@A@@@@F@B@DB@@BD@@BB@DB@@@DBB@B@@A@@BA@@BA@@BA@AB@BC@A@@@@@AB@BBFBBBBBB@DBBBB@@@DB@@B@@@B@@AFC@@@@B@BB@@@@BB@@@@B@BAB@B@DB@@BAB@D@B@@B@@@D@B@@BBB@@@B@D@D@B@BD@@@D@B@B@@BB@@F@@BB@BA@@@@@B@@A@@@@BBB@@@@B@B@BAD@B@@@@ABA@CBA@@DA@@@CB@@@@AB@DAB@B@BA@@@@@CAA@AB@@A@E@AB@BA@C@@DCBABCBABAFADCB@@@B@B@@A@ABEBA@A@@@@D@@@@@@A@C@C@@@@B@B@@@B@BB@@BA@@BC@A@C@@@A@@@AB@DBDDB@B@@@B@B@@@@@@BA@@@@@@@@B@@@@@@B@B@B@@AB@BBB@B@D@B@DAD@F@DB@@B@@ABEDA@ABAB@@@B@B@D@DB@@B@FBB@B@@@B@@AB@@@@@FDFDBBB@FBB@FBB@@@@@@AAA@A@C@AA@@@A@@@AA@@@@BAB@@@@A@C@ABC@A@A@A@ABA@AB@D@D@@@D@@BB@B@B@@@B@D@B@@@@ABA@@@@B@@@@B@B@@BB@@@ADC@@@@B@BBDABBB@D@BBBB@@@@A@@@@BA@@@@@B@@@F@B@J@BBB@B@@AB@BAB@BA@ABCBABCBABA@@@A@AA@@@AAI@AA@@@A@@A@CAAAAAA@C@@@AA@@A@A@A@@AAA@@AAA@C@@@CAA@@A@@A@AAAA@@@AB@@A@@@A@C@@@@@A@@AAA@@@B@BAB@@@@A@@@@A@@@C@@BA@ACAA@@EA@@@@BA@ABB@AB@@@@A@@B@@@@A@A@A@A@@@AC@A@AA@@AA@BA@@@AA@@CB@@A@A@A@C@C@CA@@A@AAA@A@AA@ABA@A@@@A@E@@AB@@@@AA@AAA@AA@@@A@AA@@@A@C@C@AA@@@C@@@@@AD@B@@@@@@A@@C@A@@A@@AA@@B@BAB@BABAB@@A@@@CA@AAA@@@A@C@A@@@A@AB@@A@A@A@A@A@@AA@@AA@@@@BA@@@A@@@@@@@AB@A@A@@@@A@@@ABCBC@@BA@A@@@@AA@@@AA@@A@CAA@A@@BA@ABABC@@@AHEBABAB@DCFCBAB@@AD@CCA@AAECGCECCAGEA@@@@A@A@@BA@A@CBC@@@A@A@A@@@A@A@AAA@A@ABA@@DA@AB@FCD@BAB@@@@@B@@A@@@A@A@A@@BA@AAAAGEE@A@@B@@@DAD@@@@ABAAACECCAAA@A@@AAAG@AA@A@A@ABA@A@GBA@A@@BA@ABA@@@A@@A@@A@@BABA@AB@@A@ABA@@AA@@BA@ABCB@@ABA@A@@@A@@@C@ABC@@B@@@B@@A@@@AA@@AB@@A@@DABAB@BCHGDE@C@E@ABA@AAA@AAA@A@AB@@ABCB@BADAHCFCBA@@@ABCBEBA@@BAB@F@BBB@@@B@@@BE@@@ACA@A@ABAAC@A@A@AA@@@C@AAA@AA@A@A@@CAAAA@AAC@CAA@@A@EAA@CA@AA@AA@C@@A@@@A@@@A@@A@C@@@@AAE@ABA@A@@@ACACA@@@AEE@A@@HQBCBCBA@A@ABA@CBEBA@CBA@A@@CMMCCAE@A@A@A@"]],encodeOffsets:[[[108623,33540]],[[109074,33453]]]}},{type:"Feature",id:"610727",properties:{name:"略阳县",cp:[106.156718,33.327281],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@AAA@@@@@AA@CBA@CA@@ABC@C@E@CAE@AAAA@A@@B@@AB@BABBB@DADA@ADA@@BCBA@A@@C@@A@@CAC@AB@@@@A@@@@BA@@BA@A@@@@A@@A@A@@@A@@@A@A@@@@@A@@@@A@AA@@@AB@A@A@@AB@@AB@@A@@B@@@@ABAB@@A@C@A@C@AAC@@@A@A@A@A@EAA@A@AB@@A@@@C@C@IC@@A@@@@DCB@B@@AB@F@BADAB@@ADCBCBCDAF@B@B@BABAB@DAB@D@BBBBDBDBD@B@@@BAB@@@BAD@BAB@@A@A@AACA@@CAA@AAEAAA@@@@GCGHBF@D@FADBH@LAH@HBH@H@@AFBFAH@HCJEFMH@@GDKDCBGDKJKLEHABABCBABEBGBGBE@K@E@I@EAG@GCO@KAKC@@OBEBCDEBEFEFCHGFMFG@A@S@IBEBGBEBEDGF@BABAH@HDDAHCBIDGBM@M@KFABCBCDEFEFEHAH@BAD@JDDLFBF@DDHAHCDEDCDEFEDCBGHEDADABEHEHINCHEHGHCFCDBFDDDBLDHDJBB@H@DABADAFCFAD@F@@@HAJ@L@L@J@@@@@L@B@FAJCRAJC@@@@J@JBD@B@HAHBJBDFBDBD@DDNBFDF@JDH@LAJ@D@FAJ@BAF@HELANAJDFBFDFDFHJBFBH@H@FAFDHBBBBJHHBTNHDDDHHFD@BJPBJBJHDJDDDDPDFBDFDH@FAB@N@D@JDLJDDFDDFFDBDFFDHDFBDDBFDDDDDBDBFBFBBAFBBBFKL@@@@BBD@D@DCFBL@F@J@JABAD@FEBCD@BA@@D@DABBD@HDD@FBF@BD@@FBBAB@@E@G@GBCDAF@BAB@D@BBDFFB@@DBFFDHB@BBDBD@D@FAFAB@B@HEBAD@@@NFBDHFB@FBJFBBB@FABAHA@@DAD@B@BCBATABAFGFABAB@DB@@D@LBDI@CIGGEA@C@ABOBAC@ABABAGMCIAA@@@ADEDAB@D@F@FBF@B@D@B@HBF@D@@@AFAFABBBJDH@L@D@@BBBDB@@@AB@B@BA@A@@AA@@AACBCACAAAAAA@AAA@@ABADABA@EAC@C@ABAB@DAD@@C@ABABABAB@FBB@B@DAHADADEF@JAHCHAFAD@HBNBHDJLLFH@@@HBJBLDJBHFHFFFDDHFFFHHHHNJLHLFHBJBB@J@JDLAHAFCDEFAFEDCLCHCHAH@H@B@FBHDHDJDHHHDFDHHHJFDPFJ@F@LFL@H@L@JBH@RCB@HEDABA@A@CDGBEBIBIBI@KAKDIBO@Q@aDIFEHEJEAC@@@ABA@ABA@@BABA@@D@@@B@BA@ABC@C@A@A@C@@BADEBAB@@CDC@@@A@A@@AA@A@AB@@C@A@A@@@@@A@@B@BA@A@@@A@A@@B@BA@@BAB@B@@@@@BA@@@A@A@A@@@A@ABA@@@@B@@@@A@@BA@ABC@@BA@A@@@@AAAA@AAAA@@AA@AC@@AA@AAA@@BA@AA@@A@A@AA@@AAA@CB@@AAA@C@@AA@@@A@@@@B@B@BADAB@BA@@B@@@BA@@@@AA@@BA@A@A@A@@@A@A@@BAB@BABAD@@A@AB@@@B@B@BABE@ABA@A@A@AAA@C@A@ABA@A@@B@DC@@@A@C@C@A@A@@@@@A@@@A@A@ADA@A@@@C@ABA@@@AA@@A@A@AAAB@@A@ABCB@@A@@@C@AAC@A@A@A@AAA@@@A@@@AAA@@@@@@@AD@BAB@BAB@@@B@@@DA@@B@@A@@BA@@@A@@@C@@A@@A@@@@@A@@@AAC@A@@A@A@@A@@A@A@@@A@@AAABA@AA@@A@A@A@ABC@@A@@A@A@A@@A@@AAA@@AAAAACA@@@@@CD@@@@A@C@@@AA@@EA@A@E@A@@@A@C@@A@AC@AAA@@A@A@@A@A@@AA@A@@@AA@@AA@@@A@BA@A@@@AAA@ABA@ABA@ABA@A@A@@@@AA@AA@@@A@AB@@@@A@A@@@@@A@G@A@A@E@AAAA@@B@DCBA@AA@@CAA@AA@@A@@@A@E@@@A@@CA@@A@A@@@AAA@@AAC@CA@GCCAAA@@AC@C@@AAAE@@@A@@@AB@B@@ABA@CB@@AB@BADA@ADA@A@@@CAC@AA@@A@A@@AA@@AC@A@A@@@AAA@A@@@@@A@A@C@AB@BAB@BA@ABA@@B@BA@@@@B@@@BABA@AB@BADCDCB@@@@A@@@AB@BA@@DCBAB@B@DA@@D@@AB@B@@A@A@@@AA@@@@@@B@@@B@@A@@@@@@@AA@AA@A@A@AB@AC@@@AAA@@@ACAAAA@@C@@@@BADAB@@@@AA@@A@@BA@@BA@@@@@@@AA@ABA@@A@AAAAAAA@@A@A@C@@@A@@BABA@ABAH@@A@@@AA@G@A@A@GBA@A@A@A@A@ABCFA@A@@@@@AA@A@A@A@@BA@ADE@@@ABA@A@A@A@@@ABAD@D@B@@A@A@CAA@AA@A@@A@@@C@C@@AA@@AAAACCAACCA@@AAA@@AA@@@@@A@@BA@@@@AC@A@A@@@A@@B@@@BA@A@@CA@AA@AA@@A@C@@@@@AA@@@@A@@@A@A@@@@BAB@@C@ABC@A@@B@@AB@@C@CA@@A@AA@@A@@@ADA@@@@AA@@@@@EACA@@@@@A@A@A@@AA@@A@EDA@AAA@AAA@A@CAA@AAAAACAAAAA@@B@@B@@BABAB@@AB@@A@@@@@AB@@CAEAAA@AAAA@@AA@A@A@@@A@@CAAAAA@@AAAAA@@A@C@A@@BCDA@AB@@AB@BBB@BABABABA@@BAB@@BB@B@@B@@B@B@B@@CBC@C@A@A@AA@A@@A@@@A@AD@B@BA@ADA@ABA@A@@@A@G@@@A@A@A@@@@@@@CFAB@B@BB@@B@BAB@BCBA@@B@B@B@@@BAB@@A@A@C@@@@@@BA@A@A@A@CBA@CB@@C@AAC@@@@@AB@BADA@@DABCD@@A@A@C@C@@@CBA@@B@BABABA@@B@@A@A@A@@@A@EBA@ABC@@BA@@B@BA@@@A@AB@@AB@@A@@BA@@@ABCBCBABAAE@AAA@A@A@E@A@ABA@A@@@C@CDA@AAA@CA@@@@C@CDA@A@A@A@C@G@A@ABA@@@@@ACCAAA@@AAABA@A@AAA@AACA@@A@@AAC@@@@E@C@A@@@ABA@@@GC@@@@AC@@@@A@AAA@AA@@AACA@@@@A@AAC@CAC@C@AAA@A@@@C@A@@@A@A@C@A@A@A@ABA@@@A@@@AA@@@@A@AB@@A@AA@@@@A@AB@@AAA@AA@@AAEAA@@@@A@AC@A@CAA@A@A@@@@A@@@A@@@@ACACA@@AA@CCAAAA@@A@C@A@CA@ACACA@@A@@AA@@@A@AA@@A@@@EAAA@@@A@AA@AAAAA@A@A@ACA@ACBC@E@AAACC@AB@@AA@ACA@CAAA@@A@A@AA@@A@@@@AA@CEAAAAA@ABA@@AA@@@A@A@@@@BA@A@C@@@ABA@@@C@A@@AAAACAAAA@@A@@@C@A@A@A@C@@@@@EGAAA@AAA@A@AAAACA"],["@@A@@@@@E@A@C@C@ABA@A@A@AACACCA@@@CDABCB@@AB@@A@AB@@@BBBDDB@DDDD@@@B@@AB@@AB@@@@A@@@KNCH@@@@BBDDDDDBB@FDB@CBAD@B@F@B@@@BBD@BBBD@BBDAD@BABAB@@ABAACFEHADA@C@ACAEBC@@AIGCAAA@A@A@ADAB@D@D@FBFBB@BA@@@A@AAAACAAAAAABABAB@BABC@@@C@I@A@@BAA@"]],encodeOffsets:[[[108610,33920]],[[108489,33950]]]}},{type:"Feature",id:"610728",properties:{name:"镇巴县",cp:[107.895035,32.536704],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CAAAEIGCCC@C@A@C@SAA@ABA@KNCDABGHC@GDEBAB@HA@@BCBEFCDEDCDABEFMLAD@BCDEFAB@@C@@BCAAAABABC@A@A@CJ@@C@ABADCBABAAE@EDADABE@CDAAIMGGAAMBI@EC@@AB@DABEHIHCBIDE@AB@@@BBF@BABABO@EFCBGJCFYZAD@@ADAF@DADAFEJCDCD@BCDCDCBC@ABABABAB@BCFADCFCFAD@BADAFCFAFCFABABEFMNAB@BBBAD@@@BCDABABAB@B@F@D@@AB@BA@A@GAIAGCC@EAC@IEAAC@C@G@GAGDEBCBAB@@AD@F@BAD@BABEBAB@BENCH@BAD@D@B@BDF@D@F@DABADCFABKJABCJ@HADAB@@A@ABA@CAC@@@@@A@C@A@CDC@CDGDEFCDABCDABCBA@CAECEAGGIBI@I@MAGBEDCBEDI@A@@A@@CA@@A@AAA@A@@@A@@AC@AAA@AA@@A@A@@@A@@@BA@@@AA@AB@@@@AA@@@@A@@@A@@AA@@@@BA@@@A@A@@@A@@@@@@@@C@@A@A@@A@@@@@A@AAA@AB@B@B@@@@@CAKCEBKCG@GAGCGCCAGCECIAE@EBEBCBIBGHEDEHAFCDCJELCHETGB@A@@@@AA@@A@EBCBAA@@AAAAAC@@AA@@BA@@A@@@A@CA@@A@@@@@A@A@AA@@@@A@AB@@ABABA@@@A@@A@@@@BA@@@A@@AA@@ABA@ABA@@AA@@@@DAB@BAB@@B@BBBBA@EB@@E@AB@@@@@B@BB@@BAB@BBB@@DB@BB@B@B@@BDBBB@@@D@B@BB@B@@@B@@@@@B@@B@BAB@@AB@@A@@B@@BDBFA@@F@@@BA@@BAB@B@BA@ABEBABA@A@A@@B@B@@@B@B@@@@A@E@A@A@A@@@@ADA@@@A@A@@@@ABABABAB@@C@@@@B@@@B@@AB@B@@@@@F@@@B@BAB@@B@BB@@@@@@@BABA@AAA@A@@@AB@@@F@@AB@@BB@B@F@FB@@B@@AFAB@@@B@B@BAB@@CF@@@B@BDFFHBB@BBDBB@@@B@BADAF@DBF@B@DABE@CBHN@B@BB@@BDB@@B@HAD@F@BB@@BBB@F@B@B@@BBBBBBB@@@@D@DAB@D@DBB@B@@BBB@BBBB@@DB@BB@@B@@D@@BD@B@@B@@@B@BCDABAB@D@B@@@FDB@BBDBB@B@FAB@@@BD@B@@@@@BCB@D@DEFAD@BCDA@@B@@@BB@DBB@DBBB@@BD@@BBAB@@AF@B@B@@@D@@@BC@@@ADAB@@@BB@@BAB@DADAB@B@B@B@@@DDBD@D@BBB@BB@@D@@@@BHF@@@ABABA@ABA@@@@DABAFAB@B@B@@@D@B@B@@BBB@@@D@B@@@D@B@B@D@@BB@@@@DBB@@B@B@FBB@D@BBBD@@BB@BFB@@@@@B@B@@@@@BBB@@@BBB@@B@@DB@BBAD@B@B@D@B@DCB@@@B@@@@@@@@B@B@@BB@B@@@FB@@FBB@B@@@DB@@B@@A@@B@D@@@DAB@@@DB@@BB@@B@BBB@BBB@B@B@B@BB@@BDBFBB@FDD@D@DBB@@@B@BBB@DBBBB@HBB@@@@BBB@@@B@@A@@@@@@B@B@@D@BBF@FBB@BB@@B@B@D@F@D@D@D@@@BBBBBB@@BBD@BBB@BBDDFDBBB@@BBBDD@B@B@BBB@D@D@DAD@D@D@B@B@@BB@B@B@BBBBB@BDD@@@BB@@BB@B@F@@@D@B@D@@@@@BD@BBB@@@BD@@@BBDBB@@BBBFDBBDBDBHBDDJFDBD@@@@B@B@B@BAB@BAD@D@BABBB@D@B@@@D@@B@AF@@@B@B@@A@A@@B@B@@@BBB@@@B@@BBBDBB@BBFBBABB@@BBB@BB@D@B@@@@@BBFBB@@BB@D@@@@@BBDDDBBB@@@B@B@@@BAB@H@B@BAF@B@@BBD@D@B@FAB@B@B@DAH@F@B@BBB@@BB@B@DBA@@BBB@B@BB@@D@B@D@BBBBB@@B@B@@@BBB@DAB@BADABAB@@@BAB@DA@@@ABA@@@AD@BAF@BA@@@A@A@ABABA@ABC@@BA@@BA@A@@BABABA@@@AB@@AB@@@@C@A@@@A@A@@@C@A@CAA@AA@@AACAC@A@@AE@G@@BABA@AAA@A@AB@@ABA@AB@@A@AB@BC@@BA@A@@DADAB@F@B@F@B@@@B@@@@@DD@@B@@@BB@@D@BBBBB@B@BBB@B@B@@@B@@BB@BBB@BBB@DD@@D@FB@@B@D@@@@@B@B@B@@@B@@BBBB@@@BAB@D@B@BB@@B@B@B@@@@AD@D@BAD@FAB@@@DCDAB@BA@@BAB@@@BBB@FBB@BBB@BB@B@B@@DD@@B@BBB@DB@@D@@@DBB@B@@AB@D@DAB@@@B@@@@@@A@@@C@@AAA@A@@A@@BCBAB@D@B@B@DA@@B@@@DB@@@@B@@@BC@@B@B@BBBAFABAF@BADBB@BAB@@@@ABA@AB@BA@AB@BA@@FD@BB@BB@BBBB@@B@@@@@B@@B@B@B@BB@@BB@@DB@@BB@D@@@BB@@B@@BBBB@@BB@B@@@B@@B@DB@BBB@@@@BB@@B@DBBB@BB@@@B@B@B@FBD@BB@FAB@B@B@BA@C@@@A@@BAB@B@@BB@DBBBDADADBD@@AB@BBBAD@B@@@B@B@@@@B@B@B@BB@@@@B@@CBABEBA@@DABBD@FBJ@BBBAB@B@B@DDB@@@@BA@AAAB@@@B@@CB@BA@C@@@A@@@@BBBDBB@D@@@@BB@B@B@D@@BBBBB@B@@@BBBBBBD@@@B@@@B@BBB@B@B@BB@@@B@B@BBB@BB@@B@@@DAB@B@BB@@FDB@DB@BBB@BBB@DBBB@D@D@@@B@@AD@B@@A@AB@BAB@B@F@B@BAB@BBB@@@B@B@@@BA@A@@BA@@A@BC@@@C@A@ABABA@ADC@@BAB@B@@@@A@@@A@@@A@A@ABA@A@@BA@ABA@A@@@C@A@AA@@A@C@CAC@@@A@AAA@AAA@@@C@ABA@@@ABA@@BABC@C@ABABC@C@A@A@A@ABAAA@A@@AABCAEBA@@BABC@C@C@C@AAA@@@A@@BCBEBABA@AB@@@@@@A@@@A@@BABA@A@@@ABC@@@C@@@@BA@AD@BAB@@C@A@@@C@C@A@ABA@@AA@@@AB@@@B@@@B@@@H@B@B@B@@@BCD@B@@@BA@@@A@@@A@A@@BAB@@@@A@@B@DA@@@@@A@AB@@A@@@CBABABA@@DAB@@@BA@@@C@A@@@@@A@@BA@@@A@@@CB@BA@@@A@C@CA@@A@@A@@AAA@A@ADABA@AB@B@DABABAB@B@@@B@B@FA@@@A@AB@DCB@@C@A@AB@@AB@BABAB@DABA@@BCB@@A@ABA@ABC@ABI@E@KBA@@@@BBBB@@D@DB@@B@B@@@@A@@BEDABC@@@@FBBD@B@BAD@@BB@@BBB@DBB@@@BBB@@@B@@@BG@@@@B@B@DBBBDBB@B@@@DBBBD@@@BBBBBBB@BB@@BAB@B@B@B@B@@B@@AD@@@@@@BB@@@B@BB@@B@B@D@BBB@@B@@D@@@BFBDB@B@@@A@ABA@@BEB@BAB@B@@@B@D@DAB@F@B@NBJAB@@@@A@@DA@A@ABA@@B@BA@@@@B@@@B@@B@@@B@@AB@@@B@@B@B@@BBBB@D@@B@@@@@BA@@@BBB@@B@B@BAB@@ABAB@@@D@B@B@BA@@BAB@@AB@@A@A@@BA@AD@B@B@@@@B@BBB@@@BBH@B@@BB@@@BD@@@BBB@BBBB@@@BA@@B@B@@@B@B@@@DABA@AB@D@B@B@BBBBBBDB@@@BBB@@B@B@D@B@@@BC@@B@B@@@B@B@B@BAB@D@BABBBAD@@@DAB@DBB@FA@@B@DAB@DABA@@BAB@DCDCBA@@A@A@@@@@A@@@@CAA@@@AAA@@@A@@@@@A@@BC@C@E@@@A@A@@BCBAAA@@BA@CBCBA@C@A@@BABCBCAC@@ACA@CAA@A@@@@AA@@CB@@CBAB@@@@AB@@@DAB@B@@A@ABABA@ABA@@BA@AB@@@@AAA@@@@BA@@HC@@B@@@AA@A@A@@BABA@@@@@AA@CAACAC@@@A@C@@AAAA@A@@EAC@CA@AA@@@C@EA@@AA@@A@C@A@@B@@AB@BEBGBEBABC@EB@@AB@B@@A@A@@@C@AB@@CB@@C@A@@@A@A@A@@@@@AB@@A@A@@AA@@@@B@@ABE@A@A@A@A@A@CBCAA@AA@@@@ABA@A@AB@@A@AB@@AB@@@@@AAAA@A@A@@@CCCAA@CACCAA@@B@@A@@B@B@B@@ADA@A@ABABABCB@@@@@BB@BB@DBB@B@HC@@B@D@@@DAJCD@BAB@DAB@BA@@@ADC@@@A@@@@BAB@DA@ABA@@D@B@@@@@@CB@@@BC@@@@BA@@BA@@@C@@BABABAB@BADCDABADA@AB@B@DBB@@@B@@A@@BAB@B@B@@@BIB@@@B@BC@@@A@@AAAAAC@A@A@@BE@@@A@ABA@A@@@C@A@@@A@@@A@AFC@@@A@@@A@AB@B@B@@AD@@A@AB@@@@A@AAA@@@C@@BA@ABA@@B@@AB@DABAD@B@BAB@DA@@D@FAD@BAB@@@@@@AAC@A@@@AA@AA@AAAA@CA@@@@@A@@@AA@@A@A@@@AD@DA@@DABABA@ABA@ABA@@AC@CACB@@AA@A@A@C@A@ACA@AA@@C@AAABA@C@A@C@A@@@@@AC@@AC@A@C@A@AA@AA@@A@A@AA@@@A@AAA@@CC@@@A@A@@@@I@@A@@@@A@@@C@A@AAA@A@CBA@AAA@@AA@AA@CAACA@A@AA@@@A@AAC@@@C@AB@@AA@@AAAAA@AAA@A@@A@A@A@AA@@AAA@@C@@AA@@A@@AA@A@@@AA@AA@AAAAACEAAA@CA@@@@AACA@AA@AAA@GBA@C@EBC@A@AA@@@@@@@A@@BAB@BADABABAA@@C@@A@AA@@AC@@C@ABA@A@CBCBC@GBEAA@ABAB@@A@A@EDA@@@ABCA@@A@A@A@ABEBA@ABA@AB@@EBCBA@@@C@@AEBA@A@CAC@@@@@A@A@A@A@CAC@@AA@@A@@A@A@A@ACA@@@@CBA@@@A@A@A@ABA@A@@@AAA@@@A@A@ADABC@A@@@A@AB@DA@AFEDE@A@@@@AAC@A@@@A@@A@@BAB@@AAC@A@A@@@A@@@ADAPI@@BAB@@ABA@C@A@@AABC@A@E@AA@A@A@C@A@AA@@A@@@ABCFCB@@@B@BAB@BABA@ABA@A@A@@@A@@@AC@AA@AACAA@C@A@CBA@@@A@@AA@BCDE@@DA@A@C@A@A@@@ABAHABADABABABABAEA@AFCBCBCB@HGB@BABABCBCHIB@@A@@AAAA@AA@A@AAA@@A@@A@A@A@CAAAA@@A@@@ABA@ADABAFA@AB@BC@@BABA@A@@@AB@BA@@@C@AB@BADABCB@B@BEFEBAB@BCBA@@@@FABABAB@@ABA@@BAD@B@BA@ABA@@BABAB@DCDCB@@A@@@@@@A@@@@A@@DE@@BAFGDAFE@AB@@@BA@@@@AAA@AAC@@AAA@C@@@A@@@A@A@@BAB@@A@A@@@ABABA@@@A@AAC@@@AA@A@A@C@CA@@A@@AA@AA@@AAA@A@@@A@A@A@C@A@A@AAAAAA@A@A@A@@DAA@A@A@AACAACACA@@AA@@CAA@A@AACACAA@ACAAAAAC@CB@@C@@AA@A@A@CBA@A@@AA@AAA@ABA@@@ABCBADABA@A@ABCAC@EBGBABA@ADGFA@CBC@ABA@AB@@C@CACACACAA@C@@@CDA@CB@@ABAAA@CCCA@AAA@@@CCEACAAC@CAC@ABAD@@A@A@@@AA@A@@@AAA@ACAA@AAC@EBA@A@ADABABABC@A@AAA@AA@AAA@@@AA@ECCAA@AAAAA@@CBADADA@A@@DA@A@A@A@@@ACCCACECE@@@@@AB@BC@@BA@@@@@A@@A@AA@A@@@ABA@@BAB@@@B@B@@@BC@A@CAA@AA@AA@@ECAAAACAAAA@CA@AA@ACAAAAACAA@A"],encodeOffsets:[[110597,32931]]}},{type:"Feature",id:"610730",properties:{name:"佛坪县",cp:[107.990538,33.524359],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@AA@AAA@AAAAAA@@A@@@@B@@A@A@CA@A@@BADA@CB@@C@@@A@AA@@@AA@BA@CA@@@@A@@@AA@@AAGC@AA@@A@AA@@AA@@@@CAA@CACAABI@CBABA@ADK@AA@BA@@@@B@D@@@BB@@B@@AB@@AAA@A@@AA@C@@BABA@A@@BAAAAC@ACAA@@@@A@ABC@A@AA@@A@@AAC@AA@@A@@A@@AAA@CAA@AA@@AA@C@AAA@@@A@@@A@@@A@@@A@@BA@@BC@@@@AACC@AAAAC@@A@@@@@@@AB@@A@ABABA@@@@AAAAAAAA@C@@AA@A@@@AF@@@@@BB@@BA@@BA@ABCDADA@A@@@A@@@AA@@AA@@A@@@A@A@@@@@A@@@@CAC@A@@AAAA@@A@A@AA@@A@AAA@C@C@A@A@@@@BA@EBC@@@A@@AA@AAAAEC@@@B@B@@B@@BA@@@@B@@@B@@BB@@A@@@ABCBABAB@B@@@DBB@D@@BBB@@BA@@BA@AB@@@BABAB@BA@@B@@@@@DBDBBB@BBBBBB@D@@@BB@B@@@@BABAB@B@BABBD@@@BBB@B@BAD@@@B@@@D@@@@@H@@@@AB@BA@@BABADA@@@@@ABB@@B@BBB@@@B@B@B@B@@AB@B@B@@@BA@EBCBA@C@GB@BA@@@C@CB@@AB@BABCBCBABBF@BBH@B@@A@@B@@AB@BA@@B@DAB@@@D@B@BB@@B@@@D@@@BB@@@B@@B@@@BB@@@BD@@AB@@@BABCDA@ABADAB@@@B@@@BABABAB@@E@@@CB@@@@ABAB@BA@A@A@C@@@CB@BABA@A@@B@B@B@B@@BB@B@BABBD@D@DBBBDB@@B@B@BABA@@B@@ADB@@D@@A@@BA@EB@@A@@D@@@D@BAFAB@BAB@BABCBA@C@C@A@A@A@AAGBA@CBA@C@CBA@AAA@@@A@@@@@@BA@@BA@ABCB@@A@@BCBCBABCBAAC@A@CCAA@@CC@@AACAA@@BA@CDAB@B@@A@@@A@A@@A@A@@@@@@C@@@CB@ACAECA@AAA@@BA@ABA@@@A@A@C@@B@@CD@@@BC@ABB@BDBB@B@@@B@B@BABABCBABA@@B@@@B@B@B@B@@A@A@A@AAE@C@A@A@A@@@C@@@A@A@A@AB@@A@ABC@C@@AA@AB@@C@@@A@@AA@@@@AA@@A@@A@A@AAAB@AA@@BA@A@A@E@C@E@CB@@@@ABA@@@A@C@A@@@ABA@A@A@@@CBA@@@ABABCBA@A@CD@@@@AAA@EDA@A@AB@D@@@BC@GD@@ABABABABGFEDABABABABAB@@AD@D@B@@@BABCB@DBD@B@B@BAH@FAF@D@@@BB@@BBBBB@@@B@BADA@@BA@@B@@@@@B@@@B@@AB@B@@@@AB@D@@@B@B@@@BB@@BAB@@@BA@@BAB@@@@AB@@AB@@@B@@BB@@B@@BB@@BDD@B@@BB@@B@@FBD@@@B@BAB@BA@@BAAABA@@@@@@@BD@B@B@BBD@BBB@@BB@@@B@@BB@@@B@@@BB@@@@B@BABBFAD@@ABA@A@@B@@@B@@@B@@B@@BBB@B@BB@@@BBB@@BBBB@DBABBBAB@@BBBB@@@BAB@@BB@B@BAB@@@@@B@BB@AB@D@BA@@B@B@@B@@BABAB@D@@BB@BAB@B@B@B@@@@@B@B@B@B@BB@B@BD@B@@@B@@@B@@@@AB@@CF@BAB@@@BAB@BA@@BABAB@B@B@BAD@BAB@B@B@B@@A@A@@@ABEB@@CBA@E@CA@@EBC@A@CBCBAAC@@@@AA@@@@@A@ABGB@@@B@B@@AB@@E@A@A@A@C@@@AA@BA@A@AB@@@@@BB@@@@BABB@@BAB@BAB@BA@ABAAA@@BA@AH@@@@@B@@@BBD@B@@BB@D@@@BA@@@A@AB@D@FAD@F@B@@BBBF@BBD@B@B@B@B@B@@ABA@AD@B@@AB@@B@BBDBBB@@FBB@B@BBB@@DBB@D@B@BB@@@BDBB@B@B@BBBB@BBB@B@BBD@DB@@D@F@B@@@BBB@@@B@B@FBB@D@B@B@B@D@BAB@D@DADAB@DCDAD@B@@AD@@ADA@@D@B@D@B@D@F@BAHAB@B@BBD@D@@BB@DBBDB@B@B@B@@@@@BAB@BA@@B@DBB@@ADC@@D@H@B@B@B@B@B@DC@AB@FABAD@BABAB@BAH@@@B@BBF@DBDDB@@@B@@@BCBABA@@DABAB@BAB@DE@ABA@@B@D@BADE@@BAB@D@B@BBBAD@@@B@@@BB@BB@D@B@@@DAB@BCB@BAB@BBDBBBB@F@@@B@DFB@AB@B@B@@BFBBBBB@@DBBBBB@@D@DDBBBB@B@F@@@B@@BBDDDBD@@DD@@B@BDBBBDBBBD@@AH@BB@@BB@@B@@@BAB@BB@DB@@D@@@@@BD@BBB@@FBB@@@B@B@@ABEBA@A@A@AB@B@F@BA@@DCDA@@@A@A@@@@FABA@@BA@ABBF@B@D@B@@@BADAD@BBDFBB@D@@@BB@D@B@FDB@DDDB@@B@@@FD@@@@DABA@ADAB@BC@@DA@@B@DCB@BABAB@B@B@BBF@B@B@B@@@DC@@B@DBB@B@DAB@BCB@@@D@B@F@B@DADA@ABABAB@D@B@DBB@B@DADABA@@DAB@DAB@B@D@B@BCD@BA@@BA@@BABABAB@D@BAD@DADADAB@BA@@B@B@@@B@J@@@BABAB@@ABAAA@ABA@AB@BCBA@@@@CC@@ACAAAA@AAA@@AA@@@A@@AAAAAAAA@@@A@A@C@ABC@A@@@AB@@AB@BA@ABCBC@C@@BA@A@E@@BCBA@CAA@@BA@@@@@A@AB@@AB@BA@AB@@C@A@A@@@A@@@CBA@@@@AA@@@AAAA@@A@@BABA@A@AA@@A@A@A@@AA@CBC@@A@A@A@A@AAA@A@@@@@@@@A@@@ABC@ABA@@@C@@@A@@BABA@@@A@ACA@@@@BA@@BA@ABAB@BA@@B@@A@@@A@A@AB@@A@A@@AA@@@A@@B@@A@@@A@@@ABADCBAB@B@@ADA@@@A@@@AAA@@A@@@A@@A@AAAEE@@AAA@AC@@@ABAAA@@@AA@@A@CBA@A@@BAAAAA@A@@@A@A@A@AB@@A@@@AAABA@@D@BABA@A@A@@BA@@@ABA@A@@AA@CAA@@@ABC@C@@@AA@@@@A@ABA@CBABAB@B@@@AC@A@@AACC@A@A@@AA@@AA@@@@@AA@CA@A@AAA@@BAD@B@@@@CBA@A@A@A@@A@@AAA@A@A@A@C@A@@BAB@D@DAB@BA@@@ABAD@@@HCD@@AB@@AD@BADA@@@@BBBB@@@@BBB@@@B@@AB@B@B@FADBB@@A@A@@@@DAB@@A@@BA@A@@B@BA@@B@@A@@@EAABA@@@ADCBABABCDA@@@@BAB@B@BA@BBB@B@@@@@BBBB@@BBBB@@B@@B@BB@A@@@AB@BA@@@AB@@CB@@@D@@AB@@@BAD@@@BAB@BA@@B@@A@@B@B@@@B@B@B@@@B@@A@A@@@@@A@@@AD@DC@@B@D@B@B@@@@@BA@@D@BA@A@C@E@E@@B@@AF@BA@@@CB@@C@A@ABAB@@AB@@@BA@@BCBA@C@@@ACCAA@@A@@@A@@@A@@@AC@A@@BA@AAA@@AAAA@@ABA@@AA@CCACCAAAAAA@@A@AA@@@A@@A@@AA@E@@BADA@@@A@@@@@ACAAA@@@ABA@A@@@A@@ECAA@A@@@@ABADA@CBCDA@@@AA@@AB@@@@@B@D@@AB@BA@@B@@A@@A@@@@AB@@@@C@ABA@ID@@AA@@CACCCEA@@@E@A@A@CC@@ACA@EAAAA@AACA@@E@A@A@@A@@B@D@@AB@@E@AB@@@DAB@B@B@@@B@@@B@DCBA@A@@@E@AAA@C@ABC@A@@AAAAA@@@AA@@A@@ACA@A@C@@@@A@A@A@CAABC@@@A@@@A@@AAAA@A@@A@AA@ACGG@AAA@ACC@A@@@A@AA@@@A@A@AA@@A@A@@AABA@A@"],encodeOffsets:[[110661,34156]]}},{type:"Feature",id:"610729",properties:{name:"留坝县",cp:[106.920808,33.617571],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@ACAE@AA@AA@@AAA@GCC@@@AAA@ECECAAAAA@ABA@EDA@@@ABADCBC@EDA@@B@@@DAB@B@@ABA@A@@BA@A@CBC@A@AB@@AD@BA@@@A@E@A@A@CBAB@@@D@@@BBB@@B@@BA@AD@@@DABADA@AB@DAD@B@FBD@@@B@@@@ABAD@@ABEB@BAB@D@@@DAD@B@@@D@BAB@B@@@B@DBD@BBDB@BB@B@B@B@BBB@B@@B@B@@BBBDBDBDDDBB@@BA@@BEBA@@BCD@@@D@@ABBBAD@@C@@@@B@@A@A@A@@@@@@B@B@BCB@BABBBABCBABC@A@CAA@@B@@@@CBA@@B@BAB@@ABAB@BA@@@CBA@@BCBABAB@@AB@@@B@@BBBD@B@@@B@BA@A@CA@@@B@B@BBBABAB@@@D@@@@CB@@@B@B@@@B@@ABBBBB@B@B@BA@A@A@C@@@AAA@EAC@A@ABA@@@@BABCD@@AB@B@BCBADAB@@@B@@B@@@@@BA@@B@DBDBHFB@B@@@B@@B@DB@BBB@@@@BD@BBD@@@D@HB@@B@BBB@BB@@@B@@AB@@ABAD@@GFABABA@ABA@@BA@@@CBAB@@AB@BA@@@A@ABA@A@C@C@A@@B@@ABA@CDCB@@@BAB@@@@@BA@@BAB@@BDBB@B@B@@ABCBABCBCBABABEHAD@BADADAD@@@@BBABAD@@@BB@@BB@B@@BBB@@BB@@BB@@AB@@C@A@C@@@@@@B@@A@@@AB@@@BA@AB@@@@@BA@@@AB@@@D@D@@@@@BBB@@@B@B@D@@BB@@B@@B@@A@@B@@@@BB@@A@AB@@AB@BAB@B@@@@AB@B@@AB@@@@A@CCA@CAC@A@@AAAAAAA@@A@@ACBA@AACA@@AA@C@@A@CAE@EAA@@@A@AAA@AA@AAA@AACAAA@@A@@ACCAEAC@C@A@AAA@@@A@A@@@@A@@@@CAA@A@CCC@A@CCAAA@CACA@@A@A@A@@@@@AACA@@AAEAA@@AA@@AA@A@A@A@@@AA@AG@CACA@@A@@A@@A@A@@@CAAAA@A@CA@AC@C@A@AAAA@@A@@@AAA@@@@C@@A@CD@@A@C@AB@@A@@DA@A@AD@@@BABCD@BABAB@@ABAF@B@B@BBDAD@BAB@B@B@@BDB@@@D@BBB@FF@BB@@DBB@DBB@@@B@@@B@BBB@@@DA@@BBDBB@@@B@B@@@B@D@@BB@@@D@B@BBBAD@B@D@@@DBB@B@BBB@@BB@@@B@BB@@D@BBB@BBFBD@BB@@B@B@DA@@BAB@B@BBBBDBB@DBDDFBFBD@BDF@BBB@BAD@B@@ABA@AD@BCB@@@DAB@@@BAB@BB@@B@@@B@@A@AB@B@@@BEDA@@@A@@AC@@@@BA@@B@B@@A@AB@@@BABABAB@B@BABAB@@CD@B@@A@@@C@@@ABABABAB@BBDA@BBBD@@BBAD@BA@@BA@@BEF@BAB@DA@@@A@@@A@@@A@@B@@AB@B@@@B@@@BA@A@@B@BB@BBB@@@B@B@B@B@DD@@B@@@BABBB@B@B@@@D@@@B@BABB@@B@@@@@B@B@DB@A@@BA@@BA@@B@D@@BJDB@B@BBB@BBD@B@D@B@@@@@BB@BBBDBB@DD@@D@@@B@@BDAB@DBBBBB@BD@BB@@CDAB@@ADABCBCBADAB@@@B@D@DAB@@@@CBA@AAC@A@@@A@@B@@AB@B@@CBCBC@A@A@A@@@@@AD@@ADA@BB@@D@BB@@HCB@F@B@B@B@BABA@AB@BA@@B@B@BB@@B@@@DAB@HABAJAD@@@HCDAB@DBB@B@BAD@J@D@B@@@D@DCD@DAF@FAB@DB@@D@@@DAB@B@DAFAFA@AB@BA@@DAB@BA@@BA@@BAB@B@BBB@BAB@@@BADA@@FA@@B@FAB@D@B@D@D@B@BBF@B@BBBD@@@BA@AB@@@B@BB@D@@@@BBB@DBB@B@BBBBB@DABABC@AD@BABBB@BBF@FAB@BBBBB@@@DBD@BC@A@A@AB@@@B@BAH@B@BBB@BBDBD@@BB@@@@B@BDDD@@BD@@@B@B@B@@@B@@@BB@BB@@@B@B@@@BABA@@B@BBB@@@BDF@@@D@@@BD@@@@BAD@B@B@B@B@B@@@DAD@@CB@@@B@B@B@BABA@@@A@A@@@AA@@@B@BABBBABB@@BB@BBB@@D@@A@@B@@BB@@DA@@@@B@AB@B@DA@AB@@@BAB@DAB@BA@@@DD@DBB@B@B@BADBB@@BD@DAB@B@DBB@BBB@BBBB@@BBBBF@BBBBBBDBB@@@DB@@B@@AB@B@@@B@@@@B@@ABAB@BA@@@@B@DABAF@D@B@B@B@B@@@BADAD@BA@B@@@FBBBD@@@B@BBD@@@@B@@DB@BBBBBBDBBBBBBBBD@@B@@B@@BBAD@@@BBD@@@B@D@B@@B@@BBB@@BB@@@@@@CB@@AB@@BD@BBB@BAD@B@@@BABB@BDDB@BB@B@@@@A@@DBB@@@@@BA@@BA@@@A@@BA@@@@@C@@BA@@DB@@@A@@@@@A@ABADEB@B@@@DAB@@AB@BA@@BA@@B@FABBD@@@D@@@@A@@@A@@@AB@@ABAB@BABCAAA@@@ACA@ACB@@@DA@@BA@@BABAB@BA@@DDBBB@B@@@BA@A@@B@BB@@@@@BD@@BD@B@B@@A@@B@BB@@B@@@BA@@BA@@@A@C@ABA@@B@BA@AFAB@BA@@BA@@@AA@@A@@BA@@@@B@BB@@B@BAB@BA@A@A@A@@@ABA@AB@BABAB@DBD@B@DABAB@BA@@@@D@@@B@DABA@A@A@A@A@A@ABA@AD@BADBF@HC@@BA@AB@B@@@BAB@BA@AB@DABADAHCBABAB@D@@@B@@@BA@@D@DBDEBABC@ADAB@@ABBB@B@BBB@D@B@BA@A@@D@B@FAB@B@B@B@B@@@B@D@B@D@F@@@FC@@B@@DBDB@ABBB@B@BBB@BDBDD@@@@B@@@D@BA@AB@@A@@@@BBD@BB@D@@@@BB@B@@@BAF@BADA@@B@@BBB@@BABADAB@@B@@BBDBD@B@@@@ABED@BB@@DAB@BA@AB@BABBD@@@BAB@@ABADAB@@@@@B@@@@BBBBFB@BB@@@@BBD@@BB@D@D@B@BA@BB@B@B@BBB@B@B@BA@@BED@@@@@B@@@@@BBB@@BBBDB@BBD@B@@BB@@@BDBB@@BBB@B@B@BA@@DBB@B@DAD@@@@@@A@AB@@A@@ACCE@@AAAA@@@@BC@A@ABABA@@@@AA@AA@AA@@@AB@@C@A@@@@@A@@@ABCFCBA@@@AA@@AAA@A@A@AB@@AB@BADA@ABC@A@A@@@A@@@A@@@@@A@@BCB@B@BADABA@@BADCDCBABA@@F@BBD@B@BBB@B@D@B@B@B@D@BBB@@@D@@@D@@@@@B@@A@C@A@@BA@@DEBA@A@@D@BAJADA@@B@@@B@BD@@@BBDB@D@BBB@BB@@BBB@B@D@B@@CBA@ABAAA@AA@@@@AAAAA@@@CCC@A@@@A@@BA@@@A@ABA@A@A@@@@@A@A@@@@AAAAAAA@@AA@@A@CAA@A@A@A@@@A@A@C@A@@B@@@DDBD@@@BDBFFDB@@BB@@B@BBBBB@BB@B@BBB@@BABAB@BCB@BA@A@A@A@AB@@@BABB@@BBD@BB@@B@@@BF@@B@@A@@BA@ADA@@BA@@@@BC@@DA@@BA@ABA@@BA@ABABCBABAB@DA@BBAD@@@@ABABCBCAC@ABC@A@@@@BAB@BC@@AA@C@A@@@AB@B@B@FBDAF@BAB@BAB@B@D@B@D@BB@@B@@A@@BA@@DB@@DA@@@AB@@@@BB@@BB@BBBBBBBBBBD@B@B@D@B@DD@@B@@@@B@@BBA@@B@B@B@@DD@@AD@B@@BBB@@BB@DDB@@@B@B@B@B@@BB@D@BB@@@@BBBBBDBB@BB@B@D@FBB@@@BBFHBB@@BB@@BBB@B@B@BBDBB@@BB@B@BB@@BB@@AB@B@BBB@B@@BB@@BD@@B@@BB@@AD@@AD@@AB@BA@@B@@@B@B@BAB@@BB@@@BBB@@@@A@AB@@@BAB@FABA@@@@BA@@B@B@@BDDB@BBB@BB@@@@@BBB@BBB@@DDBDBB@B@@DBB@@@FBB@@@@@@DB@@B@B@@@BB@@B@BBDDFB@B@@@B@BABA@@DA@@@ABA@AB@@AD@@@D@F@BBB@@@@A@@AA@@@AAA@C@C@@AA@@BA@@A@AEAA@@A@AAC@CAA@A@@AAA@AA@ACA@@A@ABE@AAC@@AAA@@A@A@A@A@A@@@AAA@@AA@@@AB@@A@AA@@A@@@AA@@AA@AAC@@@@@ABC@A@@@@@AAA@A@@@CCAAAAAA@@@AAC@@A@AA@@@A@@@AB@@A@A@@A@AA@A@AA@@@@CBA@A@@@A@A@ABE@A@C@@@EBA@A@A@@@@@AB@BAB@BAAA@@BA@@B@@@@A@@B@@@@@BA@@D@BAD@BC@@@@@@@AA@EAA@@@CCAA@@@C@A@E@AAA@CAAAC@@@A@ABA@A@AB@C@ABA@EB@B@@@@A@CACAA@@AC@A@CBA@A@AB@A@@@AAA@@C@A@@@A@@@A@A@@@A@@AA@A@@@AAAA@@AA@AA@@AAA@@BABC@@@AAA@CAAAA@@@CACAAAAA@AAA@@@AAA@C@@@C@C@A@@@A@@B@@G@C@@@A@@AAAAAAAAAA@AAC@AAA@A@@AA@AA@@AAA@A@@@@A@A@@AAA@C@A@A@A@@@AAA@A@@@A@@@A@@AA@@@A@A@@@A@C@@@CA@@AAC@A@@AA@A@A@C@C@C@E@A@@BCBCB@@ABAAC@@@@BA@E@A@@@I@@@AAA@A@A@C@CAA@@@AA@@ACEEAA@A@AAC@@@AAC@@A@AA@@@C@CAAAAAA@@ACA@CACA@BC@A@@@AAAAAA@@@ABA@A@AAA@CBC@@@@@A@EAAAAACA@@@E@AAC@AAC@AAAA@@@A@A@A@@@A@@@A@@@A@AAAA@@A@AA@AA@A@@@A@AB@@@AA@@@A@AAA@C@CAA@A@ABA@@@AAA@EA@@A@AA@@AA@CB@@@A@AA@@AAA@AA@@@@A@A@@@A@AAAAAAA@@AC@AAAAAAA@A@@AAB@@AA@@A@A@A@@@@@AA@AACAACA@@@A@A@A@A@A@@@A@@ABAAA@@@C@@AAA@@@@A@@AA@@@C@AAA@@B@@A@@AAA@@A@@A@AAA@A@AA@@A@A@A@@@A@@BA@@@@C@A@@AAAA@AA@@@AA@A@C@C@@@@@@A@ABE@C@@@AAA@A@A@EBC@@@A@EC@@@@A@A@A@AB@@@@A@@@@@AC@AA@CAAA@AAAAAAC@@AC@@BA@ADC@AB@@A@AB@B@D@@AB@@@BA@A@A@AB@@ABA@CBCBC@@@@@ABA@ABA@@AA@A@@@ABA@A@@@C@A@@@CBA@@BAB@@@BE@ABA@A@@@AB@@@DAD@B@B@@@BA@ABABAB@BC@@@AAA@ABCBC@A@AB@@A@@BB@@B@@@@@AAAA@@BA@@@@B@B@@@D@B@BCD@@A@@@@@AC@A@@@AAAA@@A@AA@AAA@@CC@CAA@@@A@A@@BAB@@@@A@A@A@A@@@A@@DAB@@@BA@A@@A@CAACAA@@@C@@@A@@@@BA@CB@AA@AA@@@CA@@AA@AEA@@@@BA@ABC@@BC@AB@BAD@DABAF@BAB@@A@A@A@@AA@A@ABCBE@A@AAC@A@E@@@AB@BABA@@@A@@CC@A@AAG@@ECA@A@AAC@EAA@AA@@AA@@AA@AAAA@AAA@AAAAC@E@G@I@C@A@A@A@@@AA@AAAA@C@A@AAA@AAEB@@C@C@C@@@AAAAA@A@A@@@A@A@CAC@AA@@A@@A@@@AAAAA@AA@CAAACAAA@AAA@A@A@A@@BC@A@@A@CCAC@AA@EA@@CC@@@AA@A@@A@@AA@@@AAC@@ACAEAAAC@@@A@@BA@C@A@C@A@AAAA@A@@@A@@@@@@AB@DA@@BCBA@@@C@@AA@AB@@A@@@ADC@A@@@@@A@@AA@@A@@@A@ABABA@C@A@@@A@A@C@@@C@CAA@ABA@@BA@AAA@A@E@ABC@A@A@C@AACAE@@@A@AD@@C@ABA@@@CAA@EBA@ABA@A@E@E@A@@AA@@@BA@A"],encodeOffsets:[[109503,34095]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
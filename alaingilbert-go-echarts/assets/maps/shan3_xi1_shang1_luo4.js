@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("商洛",{type:"FeatureCollection",features:[{type:"Feature",id:"611002",properties:{name:"商州区",cp:[109.941839,33.862599],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@@A@@@A@@A@ACA@@@@A@C@A@A@A@@AAA@AE@A@@@ACA@AA@AAA@A@@A@@BABABCBA@A@A@A@C@@BA@@AA@@@@@E@A@AAAC@@AA@@AACA@AAA@CA@@A@CAAAA@@@@BA@ABAD@BC@ABAB@@@@A@A@C@@@@@AACCA@A@A@A@@@@@A@CBC@@@A@@B@@AB@@CBA@@B@@@@AB@BE@A@A@@BA@ADAB@BCB@@@@@B@B@@ABA@@BA@A@A@A@@B@@CA@@A@A@A@@@@@AA@A@AAC@@@A@ADA@AB@@A@E@@@@BA@@@@BA@ABA@A@@@@@A@@@@C@ABA@C@A@@@A@A@A@ABA@@BA@ABA@IBA@@@@@@@AA@A@A@AAAA@@AA@ABC@IA@@AB@@BD@B@@@BBF@DBD@BAD@@AB@B@@@B@BAB@@A@CB@@AB@B@BBDAD@D@BA@@BAB@B@@@@A@AAA@A@A@A@@@AACAAAA@AAA@@AC@C@AAA@@A@@AAA@@BABABAB@@CBA@CDA@A@A@@@A@@BCB@B@@ADABABABEHADABAD@B@B@B@@CD@BCD@BABA@@@BB@@AB@BAB@D@D@@A@ABA@AAA@C@@@AAA@A@C@A@A@A@A@AAE@@@CA@@A@A@ACA@@@A@E@A@A@A@@@@BABADCD@@ABA@AAC@AA@@A@C@A@A@AAC@AAA@@ACC@A@@@@CAA@AAAA@@G@@@@ABAAAA@GD@@ECECAA@@A@@@A@AB@BA@C@C@@@A@A@@@AAA@A@C@AAC@A@@BA@C@CA@@A@C@CBA@@@CAAAAA@ACCACACAAA@CA@@AAAA@CA@@@G@C@@@A@A@@BA@ABE@A@CAA@EBA@EB@@@BA@A@@BA@@@A@@AA@@@A@ABA@A@CBA@C@@@AB@@A@A@AAC@@@GE@@A@AAA@A@A@@AA@@@@@ACCC@BA@@F@BAF@BA@@@@BAB@@A@@@CB@@A@@B@B@@@BA@@DADA@@BA@@BAD@B@B@@ADBB@BBB@BBBBBBB@BDBBBB@AB@@@B@B@@@B@B@BBBBBBBBBBBB@D@@@@@BD@B@B@@CD@@C@@@@@AB@BA@@BABADAF@@@B@B@@@B@@@BADA@@BBB@BAD@@@B@@BB@BBB@@B@@@ABA@@B@@@B@BAB@B@B@@@BA@C@CBC@CBEBA@CB@@@@@BBBBD@@BB@B@@@@@B@F@BB@@@@DBB@@BBBBDBBB@BBBBB@BD@D@BB@@B@DBBBB@@@@BBB@B@@A@@BA@A@ABA@AB@B@@@B@DAD@B@@@@@B@B@@@BA@@B@@A@A@A@AA@@CB@BA@A@AB@@A@A@A@ABAA@A@@@AA@AAAA@AA@A@@@C@@@CA@@AA@@A@@AAB@B@@@B@@A@@BAB@@ABA@CA@@A@@@CBA@@@@AA@@BA@AA@@@@@BABABKFA@A@@B@@A@A@@BABA@A@ABA@AAABA@CBA@A@A@ABA@A@@BAD@@@B@BAB@B@D@D@B@@AB@B@B@B@B@B@@AB@B@B@@ABA@A@@@AB@@@D@@@@@B@@@BA@@BABA@@@AB@@@BAB@BA@@D@BA@@@A@@@ABAB@BBB@DB@BB@@B@@B@B@BBB@@@B@@BBB@@A@@B@@@B@B@BB@@B@@@B@DBBB@@BA@@B@B@@@BBB@BBDBD@@A@@B@BBB@B@B@@B@@@B@@@B@@@@@@@BB@@@@@DB@BA@@B@@AD@@AB@@@B@@B@BB@@@@@BA@@B@BB@@B@@@@BA@@B@B@BB@@BB@@B@B@D@B@BBBB@@@BB@BBB@B@@@BB@@@B@B@@DBBBB@B@BB@@@@@DA@@@AB@BB@@BB@@@B@B@@BB@BAB@@@B@B@B@@BB@BB@BB@B@@B@@BB@@BBB@B@BA@@B@DAB@@@D@@@@@B@BBB@F@B@BABA@@@@@@@F@BB@@B@@B@BB@@@@@@@B@@BB@B@DB@@BA@@B@@@B@@@BB@BB@B@@AB@B@@@BBB@BA@@BAB@@@BBDAB@BA@@BBB@@@BABAB@@B@BB@BB@B@B@@@@@BB@B@@BBB@BBB@D@B@@@BB@@@@EB@@ABA@@B@B@@@@B@B@@@@B@@@B@@@B@@@BA@@@C@C@@@@BABA@@@@@A@A@A@CAA@@@@@AB@BCBA@AB@@ED@@@@AB@BCDABA@A@A@AA@@C@A@@@@@@@A@@@@@A@@BA@@B@DA@@@EBAB@@EBA@@DABABC@CB@@C@@@@@AD@@@BA@A@AB@BA@@@@B@BAA@@C@@@AB@@AB@B@@E@@@A@AB@@ABABAB@@CB@@AB@@ABA@@@@BDB@B@B@@@@DBB@@@BBBB@@@BABAF@B@@@@BBBB@@BB@@BB@DB@@B@D@B@B@B@@@D@@@B@B@@@@@@BB@@@@B@@BBD@@B@@B@@@@BD@BA@AB@B@@@B@B@F@@BB@@DBBBF@D@@B@BB@B@@@BDB@B@BB@@BB@@AB@BAB@BABA@@@AB@BABABA@@B@DBB@BAF@@FBBBB@BBD@@BB@BB@@@B@@@@A@@AA@@B@@@@BBBBDBDDBB@B@@BBFBD@@@DA@@@BB@B@@BBDBDBB@@BBBB@@B@F@BAD@D@B@@@D@BB@@@@BAD@D@H@D@D@D@@@DBB@BBB@@@D@B@D@@@D@@@B@B@B@B@DBB@@@FABAB@@@DF@@@B@B@@@BBD@B@D@B@D@B@@@B@@B@FAB@BBB@D@@@BBB@D@B@DBBB@BBB@@BBBBBBBD@DBBAB@@AB@@@@BBB@@BBB@@@@B@@ABABADA@@D@B@B@@@DCB@@@D@B@BBHBBB@@BBD@B@DBB@B@DB@@B@BB@B@@@B@@B@B@B@B@@@BB@DBBBB@@BBB@@A@ABC@ABABA@A@@@AB@BA@AB@BB@@B@B@DBD@BBBAHA@AB@@@B@@A@@B@@ABA@@D@@@BA@@@AB@@@BAB@B@B@B@BAD@@@BAB@B@@BBBB@BBB@BB@@@@@BAD@@AB@@@B@@BBDB@BB@A@@B@@AB@@@BBBA@@BAB@@BB@@@B@@@B@@@D@@A@@B@BBBBBBB@B@@B@DBB@DBD@B@B@B@BBBB@B@D@B@BB@DBD@@@BBB@@B@@@@@@@B@B@@AB@@AB@@@@@@@@F@B@D@D@@B@D@DBB@BB@@@@BBB@BB@BB@@@BBBBB@BB@@BB@@@BBBBBDBB@@BB@@@@D@@@BA@@@@B@BBBAB@BAFA@@@@@@AA@@AAA@CE@@AAB@@A@@AC@A@A@A@@B@BA@@B@@@DBB@DBBBB@B@D@@@@@@@@D@@BD@@BB@@AB@@FABA@@@AD@D@BC@AB@BA@@B@B@@B@@B@BBB@B@@@@@D@B@BAB@@@BA@@@@B@BBB@@B@B@@@B@@A@A@@@A@A@AB@@@DAB@@@@@B@B@@A@A@A@AB@@@DAB@@@@AB@@A@A@@@A@@BCD@B@@@@@@@BA@BB@@ABBB@@@@@B@BBB@@BB@@B@@BAB@@@@DBB@@B@@BDBBB@@B@@DBB@BBB@ABB@@@D@BB@@DA@@B@B@B@BA@AB@B@BBD@DBDBD@D@@@@@@@@@@@B@@AFC@ABAB@@@B@B@BA@@BB@@BBBAF@B@@@@ABABA@@@AB@@ADAB@@@B@@@B@B@@@@@BAD@@@@AB@@A@@B@B@@@@AB@@@BAB@BAD@B@B@B@@@D@B@B@B@@ABBBA@@D@BA@@BADA@@BA@ABC@@D@B@DBD@FBBBB@@@BA@A@CBA@@@C@CBABB@AB@BAB@@AB@@@DB@@B@B@B@B@@@B@BAD@B@@@HCD@BA@@B@BBB@B@@A@A@@@ADA@AD@DABA@@@AB@@A@A@@BA@C@@BC@@@A@A@@@@@@@C@C@@@@BA@@B@@A@A@@B@@A@@@@@AAA@@@A@@@@BABA@@B@@@BAB@BC@@@A@A@@@A@A@@@@B@@@B@@@BABA@AB@@AAC@@B@@C@@@@B@@@BABA@@@A@@B@D@@AB@ACBCBE@@@@BBB@DD@@B@BAB@@@@@BB@@@@B@B@BA@@BC@@@@@A@@@C@ABA@@B@B@B@@@@BB@@@B@@@B@BC@@@A@ABAB@@@@@D@@@@@@@@@B@@BB@@@B@B@@A@@@AAAAA@@B@B@B@@@@@BC@@@ABA@A@A@A@@@AB@@@BABAD@@@@@B@DB@@B@@AA@@C@A@@@@BA@ABA@C@CB@BA@@BA@A@A@@BG@ABA@@B@BA@A@@@@BA@@@@A@@@@A@AB@@AB@@@@AAA@@BABA@@BC@@@A@A@@@@BABAB@@@@A@@AA@AAA@@@A@A@A@A@@@@C@@@A@BA@A@@@C@A@@BA@@B@@@@C@A@C@A@CAC@@@@A@@C@@@@A@AAA@@@@C@@@A@@BE@A@@B@@AB@@@B@@@B@@@BA@ADABAB@BA@@B@@@D@@@@@BA@@BAB@@@@EB@DAFA@@@@@A@AAA@AB@BABC@A@ABABC@A@@@ABABA@@AE@C@A@A@AB@@ABA@AB@F@D@B@BCBAB@@AB@@ABC@A@@@ABAB@BAB@B@B@B@B@DABAD@DC@@BABABADABA@@BBF@@BDDBBB@BB@@F@B@B@@@B@@@BBFB@B@BDB@@B@@B@B@B@DBB@F@B@BAB@BBB@@@B@@DABBB@@@BA@@B@BBBBBB@@B@@@D@D@BBB@@@@D@B@BBBB@@@B@B@F@HAB@DAB@DAB@B@@@BBBBDB@B@@DBBBBBD@D@@ABBD@DDBBDDB@D@BAFBD@B@B@DAB@BA@ABA@@@A@@@@@@BBBB@@B@@@B@@@DABAB@B@@@BA@A@ABC@AAABC@A@@B@B@@B@@D@@A@@DC@@BAB@B@DA@@B@B@D@BA@@D@@A@@DBBB@@B@BB@@BABADABB@@B@DBB@@@@@B@BA@A@A@A@@B@@ADD@BBBBBBBBB@@BBB@B@D@BB@@D@@@BB@@BDBBB@@@B@D@B@@AB@@ADCB@@AB@BA@@BA@A@A@@AC@AA@BA@@@A@@@AB@BABA@A@A@@AA@A@ABA@ABA@CB@AA@@@EAC@A@@@A@@A@A@A@AAA@@AA@@A@A@A@@AACGCCAA@A@@B@@A@@DBB@BAB@BAB@@C@C@C@@@@BAB@DA@@BA@A@@@@@A@C@A@@@A@@@@BAB@BAD@BC@@BA@AB@@A@AAC@AA@@C@A@A@A@@@@I@A@CA@@A@@A@A@AAC@@@@AA@C@C@@@AACAC@A@AAA@C@@@A@CACA@@@A@C@@@A@ACA@@A@@@AA@C@A@@AAABA@@@A@@AAA@AC@AA@@@@@AA@A@@AAA@@@@@AC@@B@@AF@B@B@BAF@@@BC@A@A@CAA@@CC@@AAA@A@AA@@@@@A@@BAAA@A@@AC@AAABA@I@@@ABABA@@@A@@A@AAAAAA@@A@AAC@CB@@A@@@A@ACA@AB@@AB@@A@A@A@A@AB@@AA@@@@CE@ACAA@A@A@@@A@CAAA@@A@AB@@ABC@AAA@A@@@AA@@AAAAACA@@A@AB@@@BA@@B@@ABA@AAA@A@C@ABABA@A@ABA@@BA@C@A@A@@@A@@@ABC@@BA@ABA@@D@B@@AB@BA@A@A@GBAAAAA@A@AAA@A@C@@@A@ABAB@@C@AAACEAAA@@A@C@ABA@@@AA@@AAEAA@A@A@C@C@A@@@AA@@AAAAAAC@AEECC@AAC@@BA@@DABAB@B@@@@A@A@AA@@C@@CAA@C@@@@@@CAA@@CCAAAAA@A@@A@A@C@@CEAAACAA@A@@AA@ABCBA@@@A@AA@@@ACA@@A@ABA@A@AB@@A@@@@@AA@A@@AA@@A@A@A@@@AA@@@A@A@AAC@A@A@A@A@@AAAAAA@A@A@@AAA@@A@@@A@@A@A@@A@AACA@C@@AAAA@AA@AAACA@EACAA@A@@@AB@@@@CD@@A@@@A@A@A@AB@@@@AB@@A@C@A@A@@@@BA@ABABA@ABA@A@A@AB@@A@ABAA@BA@A@AB@@@B@B@@@@@B@B@D@B@B@@@B@B@B@@A@@D@@A@A@A@A@A@ABAB@BA@A@C@A@@@A@EB@@ABEBCBA@@B@@@B@@A@A@A@@A@@AAA@@@@@ABABA@@@C@@@AB@@ABA@@BAB@@AB@@A@@A@@CBAAA@A@AA@AAA@@C@A@@@@BA@AB@@A@A@AB@@A@AA@@@BABA@AB@@@B@@A@ABA@CBA@@@@B@@CBABA@A@CDA@@@CAA@C@A@@@A@@@E@A@@@A@@A@A@CAAA@C@@@C@@@@BA@@A@@C@AA@@ACA@AAAAA@AAA@EB@@ABADAB@@@@@@AA@@A@A@@@@@ABAD@@A@@AA@@@AB@@CAA@@@AB@DA@@@AACCA@AA@@@@@@C@@@@@A@A@ADCAA@CB@@@@AAA@ABCAC@@@A@A@CA@@C@C@E@AA@@@@AA@A@AAA@@@ECAAA@@AE@@AA@AA@@AA@AAAA@@A@E@@@C@A@"],encodeOffsets:[[112569,34489]]}},{type:"Feature",id:"611021",properties:{name:"洛南县",cp:[110.148508,34.090837],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CA@A@AAAAECAACA@@BA@A@@@@BA@A@A@@@AA@@@@@A@C@A@A@A@AB@@CAA@CA@AAA@@@@@A@@@C@A@@AA@@@CAA@A@@BA@@@ABA@A@@@AAC@A@@A@@@@AA@@AAA@@@AA@AA@A@A@@A@AAA@C@CA@@@A@A@A@@@A@CAE@A@AA@@BA@CBCB@A@@AAA@A@AACAA@A@AAA@AA@@EBABABA@A@CBE@ABC@EBA@@@C@A@@A@@E@A@@B@B@B@DB@@BBD@B@BA@@BAB@@ADC@ABA@AB@@@@@B@@@B@D@B@@@@@BAB@@CBA@AB@@@@@D@D@DA@ABA@ABA@CA@@@BA@@@@BBBDDDHBB@@@B@B@BB@@BB@BBB@B@B@@@@B@@@BBD@F@@BBA@@DAB@BAB@B@BBB@@@B@BABABA@@B@@@B@@ABB@@BBD@@@B@BAB@@ABA@@BA@CD@BA@@BA@C@A@@@A@AAAC@@AA@@C@@@AAC@A@A@AA@@AAAAAAAA@ACC@BA@@@@B@B@BABA@@@@@A@CAA@@@AACBABAB@@AAA@@@AACA@@@BC@@@ABC@A@A@@@CBA@A@AB@@CD@@@BC@@@@AA@A@@@@BADBB@BAD@B@BAB@@A@A@ABCB@@A@@@A@@@AAAA@@@@@@@B@@AB@BABA@CBA@A@C@EAABC@A@CCAACCC@AA@BC@C@AAAACA@@@ACAAAAA@@A@A@CBA@CBA@GBE@A@A@@@A@AA@A@A@C@@A@AAC@C@@@A@@@AAAAAAA@@@AB@@A@AACB@@@A@@AA@ABA@A@A@EAA@C@A@A@AA@@@CA@A@AEAAA@@A@@@A@A@E@@@AAA@AACC@AE@AA@@ABCBABABAB@@CDC@ABCBA@A@A@A@A@ABA@AB@B@@@BAD@BA@@BA@ABADA@C@E@A@@BAB@BA@@B@B@B@DBF@@ABAB@B@@@BADAB@B@BADABA@@BBB@B@B@@@@EBCBA@@F@@A@AB@@AB@@@@C@@@A@@@ABA@ABCB@BAB@@A@@@A@@@A@@BA@@@@BAF@@@B@@@D@@B@BBB@@@@@@DB@@@@@BD@D@B@D@B@D@@A@@@AB@@@B@D@@@BABB@@@D@@@@@@B@B@B@B@@BB@BBB@@@B@@A@ABAB@@@@@B@B@@AD@@ABAB@@BB@B@@A@@BA@@B@B@@B@@@@@AB@@@@@BABA@@@AB@BAH@@@B@BAB@@ABA@@D@DAB@BAB@@@@@B@DB@@BA@@@CAA@@@@@C@ABAB@@A@@B@@@B@B@BAB@B@@AD@@@@A@A@A@@@BBBB@B@@@BA@A@@@A@@AA@@@@@@@@@C@@@@@A@AB@B@B@@ADA@@@A@@@A@@A@@A@A@A@@@AB@B@D@@@B@@@@AD@@ABA@A@@@@@AA@@@@A@ABA@@@CCA@AA@@@@AFADBDA@@BC@A@@@@B@@ABAB@@A@@@@@@DA@@@BD@BA@@BABAB@@A@@@A@@@@@@B@B@@@B@B@@ADA@AB@@A@@@ABAB@@@@@B@@BB@B@@@@@BA@@@@B@BA@@@AB@@@@@D@D@@@@@@@B@B@@AD@@@DAB@@@B@BA@@B@@ABCBC@@BCB@B@@@B@BA@A@AAA@@@ABC@GD@@A@C@ABA@@@A@A@A@A@@@CA@@A@@BA@ABA@@BAAAB@D@D@@AB@D@BAB@@A@AAEAC@CAA@C@@@AD@BAB@@CBAB@@ABC@@@ABAA@BA@A@A@C@@@A@A@A@C@ABA@AB@@A@@B@@A@A@@@@BA@@B@@C@AB@@@@A@A@@@A@@@A@CB@BA@@B@@ABAB@B@@A@E@ABAA@@AA@@ABA@A@@@A@AB@BED@BA@@@@@@@@@@@C@C@CACAC@AAA@A@@BABA@A@A@@@CB@@AAC@@@A@BAA@AAA@CA@@@AA@AAAC@@@AA@CA@@@@BA@AA@@@AA@@AA@A@A@@@@AABA@@AAB@@A@@A@AC@@AAA@@@AA@@AACAAA@@A@ABC@@@A@@@A@@BAB@@@B@@ABA@@@AB@@@B@@@@ABBB@@@BEB@@AB@@A@@BC@@@@B@@@@BB@B@@@@ADA@@BCBADA@@@AD@@A@A@ABC@A@A@C@ABABCB@@EAC@ACA@@@C@A@@@C@@@AB@@AD@@A@ABC@E@C@@@@@@@BB@@FBBB@@BB@BBDBB@@@B@@@BB@B@@B@@B@@@BBBB@DBB@@BB@B@@BBB@@B@@@@@B@BA@@B@@@B@@A@A@@@C@CB@B@@@D@B@@@B@@@@ABA@A@@BAB@B@@@B@@@BCB@@A@@B@B@@BB@B@@@BAB@B@B@B@@AB@@ABA@@@A@@DAB@@@BBB@@DBB@FD@BD@@BBFB@@BBB@BBB@BB@B@D@B@B@@@B@@@BBD@@@F@DABBDB@@@@BAB@BABA@@BC@@BA@A@@@@BA@A@@@AB@BA@A@@B@@ABAB@BA@@@C@@BA@@B@@A@@@A@@@@B@@A@@@@BA@@@@BA@@@@@A@@@@@@BA@@@@BA@@B@DA@A@@B@BA@@@@@AB@B@B@BABB@ABB@BC@CDAB@@@BA@@@@B@@@@BD@@B@@@@B@B@@@@@B@B@@@DAB@B@BADAD@B@@AB@@AD@B@B@B@B@D@BAF@BAB@@@DAFB@@@BBDBBBB@HBBBDB@@H@F@@B@@@@@BBBBF@BBBDFBBDB@@BB@BB@BBB@PF@@BADG@ABABAB@@@HAD@BA@@@@@A@@@A@ABA@@BADC@@BCB@DAB@BA@@@@@@@CBA@@BA@@@BBBB@D@@@B@D@B@B@@BB@B@BBBADBB@B@BBB@B@B@B@@A@@BA@@BA@@AA@A@@BAB@BA@@BA@A@A@@BABA@A@A@AB@@@B@@A@@@A@CAC@@@AA@@A@@BABAB@@ABABABABB@ABA@@DBB@BAB@B@@@@ABBB@B@@@@AB@D@@@BAD@@@@@BA@@DAB@@@B@@AD@@A@ABABC@@@@B@B@D@@@BA@ABA@@B@@@B@@B@B@@@B@BB@@@@@DB@@@@B@B@B@@@@@DB@@@@BAJB@@DA@@@@BDDB@BDBBB@BBBDB@@B@B@D@B@B@B@BBB@@A@A@A@@A@@AA@@ABA@A@@BA@@@@@A@CAA@A@A@A@A@@A@ECAA@@AA@@@@@A@AA@@@BA@EB@@@DAFCDC@@@A@@@@DA@@B@B@@AB@@AB@@BB@BBBAB@B@BBF@BABBB@@@BB@@FB@@BBBB@@DBB@B@@@@BDB@BB@@@D@@B@B@@@BB@@BB@@@BAB@D@B@B@B@BBBBB@BDBBBB@B@B@@@B@@BB@@B@BAB@B@@@BB@@BB@DB@@@D@@@B@B@@ABAB@B@B@BDB@@BB@@D@BBB@@B@@@@B@@@@@@AB@@A@ABABA@@@A@A@@@AB@B@@A@@@A@@B@B@@@@@BB@BB@@DB@@@@@B@B@@A@@B@B@@BB@@@B@B@@@@@B@@@B@@@B@@@B@D@BADABA@@@@@AD@@BB@@@@@B@@@BAD@BCB@@AB@BABAB@B@@B@@BA@@@AB@@@@BBB@@BAB@F@BB@@BB@@B@@AB@@AD@BBB@@AB@@@BA@@@@BA@BFA@BB@BA@@B@@@B@@BBB@@B@@@@B@BBB@BA@@B@@ABA@@DA@ABAB@@@H@B@B@@@B@B@B@@@FADBB@B@@@@B@B@B@@BB@@@@D@B@@@DD@@@@@D@B@@@B@@@B@BBD@@@B@B@BA@@@@B@@@B@@@B@@@B@@AB@@CBA@@BA@A@A@@BAB@B@BBB@@@BA@@BA@@@@B@B@@@B@@A@C@@@AB@BC@A@A@A@@@@@@B@B@@@BAB@@AB@BBBA@@@ABAB@@@B@@@B@@@@@@@B@B@@@@ABABAB@@ABC@@B@@@BADAB@B@@@B@@@B@@ABA@@B@@CB@@@D@B@@BBBBBBBBB@@BBB@@@@D@@@D@B@@B@@BB@@@B@BBB@@@@D@@AB@BBBB@@DAB@BA@@@AB@B@@@BAB@@@@@DBBBB@@BB@@BB@BBB@@@@@@@@BBBBBB@@@@@@@AB@@BB@@BB@B@BB@@BB@BB@@B@@BB@@B@@BBBBB@@@DBBBB@BBBA@@BD@B@@@@@B@@@B@B@@@@@B@@@B@@@@@B@BB@AF@B@BBB@B@@@@BBFBB@B@B@B@B@@@D@DAD@@@@B@@B@@@BBB@@@BBBBDBDBB@@@D@BAB@B@@@@BB@@BBBD@@@BBBB@@BBB@@@B@@B@@DBB@@@@@BB@@DDB@B@B@B@B@DBDB@@B@FCB@DBB@BAB@B@@AB@B@B@@@HAB@@@D@B@@@DB@@B@@BB@BB@BBBB@@@F@@@@@B@DAB@@@D@@@D@@@B@B@B@@@BAB@B@BBD@B@@@B@D@BC@@BAB@@AB@B@B@@@D@DAB@@@BABA@CBA@A@@B@B@@@B@@@BA@@B@B@BA@@B@B@BAB@DCB@DA@@@@@@@@B@BB@@B@D@B@@@BAB@BA@@B@@@@@BAB@@@B@B@B@BABBDBB@@@BA@@F@DB@@@AB@@A@@BABA@@@@B@B@B@B@BCB@@@@@B@@@BA@@B@@@B@@AB@@B@@B@@@BA@@B@B@@@@@B@@@B@BAB@B@B@B@BB@@B@D@@@@@BBB@DAHABAB@D@@@DA@@BABA@@B@B@BAB@@@D@@@BABADAB@@A@@B@@@@ADCD@B@B@@AB@BA@@B@B@BBB@B@@@B@@@B@D@B@@@BB@@B@B@@@D@BA@@B@@@@@B@BBB@B@B@B@B@@@B@@@B@B@B@@@BBDD@@@@DBFBBBBB@@B@@A@AB@@@@@BAB@@AD@@@B@B@B@@AD@@@D@DBB@@AB@D@@BBBBB@@B@BAB@B@@AB@@@BB@@DBB@BBB@B@@@D@@AB@@A@@B@B@DB@@BBB@B@B@B@B@@@B@@@@@D@@@B@@@D@B@B@B@B@BA@@B@B@B@BBBBB@BBB@@@@B@BB@@B@BBD@@@@D@B@DBFB@@B@DB@@D@@@B@DAF@@@BAD@B@DA@@BB@@DC@@D@@@B@B@B@BB@@B@@@B@B@B@DBB@@@B@D@BBB@@@@BB@LCFCHANC@@JA@@B@DBBBHFDDDBFBFAB@DABAAEAIAG@E@ABCBCBABG@@@EDCDCFOBEBG@GBADCDAPG@@DCBEBE@I@ECI@AAA@CBC@AACCAI@M@E@GDGB@@ABAAAC@C@AACEICC@CAACAC@@@AACEEGCEECCAE@EAEAC@EBE@C@AACC@CAKAC@CB@@@FADCDCBC@E@CFEHGBC@AACCACI@ABABABAJAFAL@LAPCDADCF@LAJAHAFCDCFCDADCBALIDAF@@BF@HDDBDAJAHAH@H@DADAFAD@DCBCBE@EBEAA@A@@@ADCBABC@@BAFBD@HCBAD@B@DBD@L@JAHAB@@A@CCEAC@E@AB@JBF@D@BAJCH@J@JBF@B@DEDC@@DANCDAHKDC@AAGCIIK@@KIGC@@@ABADCBA@AACAAEE@@AACAC@@@CGAICCCCMEOEEAEEAG@ABEDG@ADa@G@A@@@E@@AA@@@@@@@@@@@@@@@B@@@@@@A@@B@@@@@@A@@A@@@@@@@@AA@@@A@@AA@@@@A@A@@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@A@@@B@@A@@@@@@@@B@@@B@@@@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@AA@@@@@@@A@A@@AA@@@@@AA@@B@@@@AB@@@B@B@@@B@@@@A@@A@@@@@@A@@@@BA@@@@@@A@@@A@@@@@@@@AA@@@B@@A@A@@@@BAA@CAC@A@A@@@@@AAB@BAHCD@@@@A@C@CAA@E@CAAAC@A@AB@@AB@DBD@B@B@D@D@J@L@F@DBDB@@@D@BABAD@@@@BDBBB@@@DBB@B@B@DABA@AB@@C@@BAB@DC@@@ABAB@@A@A@@@@CA@@A@@@AA@C@@BABABAB@B@BAB@@@@@B@@ADE@A@@@A@A@A@ABA@@BAFEJMDCBA@GBI@G@EAE@A@A@ABABAD@DAF@TAFAB@@A@A@C@C@@@ABGBCBCDA@AAAACGKCCGAM@IAE@MEGCCAGGAACAE@@@A@AAEE@A@A@ADCDEBC@A@AAA@@CAG@I@C@A@C@A@GACAA@ACC@CAC@CAEAAA@AA@@AAG@AACAC@A@A@@@ADCHCHEBCBCBG@@@@AAAACAAAA@@@AA@@@@A@@B@@A@AACAAAAAAC@@A@EACAA@@BA@A@@@@@A@A@A@@@AA@@@@@A@@@@E@AAA@@@A@C@A@@@AB@@A@I@@AA@A@@@A@@@C@@B@@@D@B@@@D@B@B@FAB@B@@@B@@ADABAB@@@@@B@@@@@BAB@@@@@BBB@BAB@BA@@B@BA@@B@@@B@@@@A@@@A@@@@A@A@@A@@@A@AB@@@BA@@BA@@BAB@@@DB@BD@B@B@B@H@@AB@BAB@B@@@BBB@D@DAB@B@B@@@@@B@@@BB@B@BD@@DB@@@@@B@B@DABCDA@@BBBA@@B@BAB@@A@A@C@AAC@A@C@@@@B@BA@ABCBA@A@C@A@A@@B@@ABA@C@@@A@@AAA@@@@ABAB@@@@A@@@@@AA@@@@A@@@A@@@A@@@AA@B@@C@@AA@@@CBA@@@A@AB@@CB@BC@C@GB@@A@@@ABCBA@A@A@@@A@@@A@A@A@A@@@A@C@A@@@A@C@A@@@CAA@A@A@@BA@@@AB@B@@ABAB@@CB@@@@A@@@AA@@A@CBA@CBA@AB@@C@AA@@C@@BA@@@@B@@A@A@A@AACAA@A@@@AB@@@@C@@@A@A@@AAAAA@A@A@@@@@A@@@@AACBC@@@CAA@@@A@AB@@@@ABA@A@A@AAE@CBC@CBG@C@A@A@A@A@@@ABA@@@A@A@@@@@AB@@ABC@@@A@@@A@AB@@@@A@A@A@AA@@AAABA@@B@@A@A@@B@@@@AB@AA@A@A@@AAAA@A@AAAA@@A@CBA@A@A@@@A@C@AAA@@A@@A@GBABA@AB@@A@A@A@@@C@@@@BCD@@ABA@@@A@A@AAA@A@A@A@@@A@"],encodeOffsets:[[112978,34764]]}},{type:"Feature",id:"611022",properties:{name:"丹凤县",cp:[110.32733,33.695783],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAA@@AAC@@BA@AB@@@@A@@E@G@A@A@A@@@A@AD@@A@E@@@C@C@@@A@AAAAAC@AA@@BAB@BABA@@D@B@BBBBD@BBB@B@DA@AD@D@@@BB@@DBB@D@B@D@BA@@B@@A@A@A@CAC@AA@AA@ABGB@BA@AAAAAACAAA@@A@C@@ACA@AACAAACCE@@AA@AAA@C@A@@@A@CAA@@AAAC@AA@ABC@@@AB@BAB@@@B@BAB@@@BAB@@@@BBA@ABADAB@@@B@B@B@@@@BDBB@@@DAB@B@@A@CBA@@@@B@@DFBD@@@BBD@BBBBB@B@B@@@B@@BB@@AB@@@B@B@B@D@@@@@B@B@@CB@B@B@BBB@BBD@B@@@@B@BD@@BD@@@BB@@@@BA@@@@B@B@@@BAB@@@BAB@BA@@B@@@B@@ABAB@BBBA@@B@@@B@DBB@D@BBDB@@B@B@B@B@B@B@@AB@DCBAB@BA@@B@BEF@@AFADAB@@A@ABA@@B@@@B@B@B@BAB@@BB@@@B@BA@@B@@@B@@@BAF@@@B@@A@@@@AA@AC@A@AAEA@@CA@@AAA@AA@@@ACAA@@C@A@CAA@CAAA@E@A@E@C@A@A@@@A@A@A@@@@B@@ABA@@BA@@B@BA@@@@@A@A@ACA@A@@AA@@A@A@A@@@A@@@@B@D@BAB@BABAB@BBB@B@BA@@BAB@@AB@BADAB@@A@ABAAA@A@@@AB@@A@@@A@@@@F@DA@@@@@A@@BA@@@A@@AA@@AA@@@A@@BABAB@BABAF@@A@AB@BA@A@CB@@@@A@@DA@@@@BB@@B@B@B@B@D@B@B@B@B@@@BA@@@@BAB@BAFBDAFBF@DBB@@@B@@A@A@CD@@@BAB@@@@AA@@CAAAA@A@@@A@@@AAAACC@A@@C@CBC@@@A@@AA@@BA@CB@@AB@@@F@@A@AB@B@@@BAD@@A@A@A@@B@D@DABBB@B@@@@@F@B@@AB@@@@@B@B@B@BBB@BCBCD@@ABA@A@CBE@@@ABAB@@A@EB@@A@C@A@@@ABC@@@A@@@A@A@@@AA@@A@A@@@ADA@@B@@AB@FBD@B@@@BDB@@@B@DADA@C@@AA@A@@@A@AB@@AB@BA@@@A@@@A@@@AB@@@BBD@B@D@B@@@B@BA@@B@D@D@B@B@F@@@B@@@B@@@@A@CAA@A@A@A@A@@@AA@B@@@@BD@B@B@@@D@B@D@@@BBBBB@B@@@B@@BD@B@@@@@D@B@@AB@@@BBBBBB@@BAD@F@BAD@B@@@BBB@@BD@B@F@DBB@BBB@@DBBBB@@@@BAF@BA@@F@BA@AB@B@@@@AAA@A@A@@@A@C@A@A@AAAAC@A@A@CD@@A@C@@B@@@B@@A@@D@@ABABA@@BAB@@ABABABAB@@ABADABB@BDBBB@@BBBBB@@@DDBBBB@@@@B@BB@@@B@@@BB@BB@B@B@BBBB@BB@B@B@B@D@BBB@B@@@B@@B@@@B@B@BB@@BB@B@@B@@@@@BA@@B@BAB@B@BB@BD@@B@@B@B@@ABAD@BBB@@@BBBBDBBDF@@@D@B@BB@B@BBBBDD@@BB@D@@@@D@B@DB@@@DB@@B@B@B@@A@A@ABCB@@AB@@BD@BDDFF@BBDBBBB@BB@@B@@@B@D@D@B@BBBBF@BB@@B@@AB@B@D@BB@BBDFBB@B@DA@AB@B@B@@@D@BBB@B@BBBBBAB@H@B@BABA@@BA@C@@@AB@BAB@@AD@B@@@B@@@B@B@DAB@@AB@B@BABAB@B@D@BBB@BAB@BA@@@AB@@A@@B@BB@BDBBBB@@BB@@B@B@BBD@BA@@BAB@@@BBDBB@@@B@B@B@DB@BDF@@@@BB@@BAB@B@B@B@@@BA@@BAB@BDB@@@B@@@DAD@BBB@@@BBBBBBB@@@@B@@ABAB@B@@@JABBB@BBD@@@BBBAB@@@B@@@@BBB@B@BB@@DD@@BB@D@B@BAD@@E@ABA@A@E@@BA@@@BD@@@@B@BB@@@BBB@@@@B@@BBDB@BB@@@B@@ABBB@BB@D@B@@B@@@BB@BDB@@@D@B@@@B@BD@D@B@@@DBB@B@BBDBD@B@@@D@DBB@@@@BD@B@B@BB@@@DBB@J@@@@@B@F@@@@BB@D@@@B@FAD@BAF@DAB@B@BABAFA@@BBB@BBB@B@DBBBB@B@BB@@@BDADAB@@AB@@B@BBF@D@B@@@B@B@B@@DBD@B@BBB@@@@B@BBBB@@B@@BB@BB@@B@@B@@@@BBD@B@@@BAB@B@@AB@@@BBB@D@@BB@@@B@D@@@B@@@@BB@BDBB@DB@@BAB@B@B@D@B@@@@@B@@B@@@B@BAB@@@@@BAB@@DBBBFDBBBBB@B@@DB@@@B@B@B@B@BBB@B@@@B@BA@@DC@A@@D@@@B@B@B@@@BAB@BAHAB@@@@BB@BBD@B@@@B@B@B@DAB@@@BBBBB@B@BB@BB@B@B@@BBA@@@@@AB@B@@@@AB@BABB@@BBB@B@B@@@@@BAB@@@B@@@D@BA@@BA@@@@B@B@@@B@BA@@B@B@B@B@D@H@DAD@DAF@BBB@B@B@BA@@@@BAB@@@B@DB@@D@DABB@@@@@B@@@@@B@BBBBB@BB@B@@@D@@@@@BA@@B@B@DBBBB@B@B@@@@A@@B@@AD@@@BBD@@@BAB@DAB@DAB@@@BB@@B@@@@@DA@@BABA@@@ABA@@B@@AB@B@B@DB@@B@D@B@@@B@D@B@@@B@B@B@B@@@B@@@B@B@B@DABA@@B@@@HAD@D@@ADA@@BAB@@@B@DA@@B@@BD@@@@ABB@@B@@@B@@@B@@@@@BB@@@@B@@@@@BABA@@@@BB@BB@@@D@B@BA@@@AB@B@D@B@B@DABAB@@A@A@@D@B@D@BBD@B@B@@@BA@A@AB@AA@AB@DCBA@C@A@A@@@@CA@@ACA@A@@A@@@A@@@@@A@ABA@C@CAA@A@@@ABA@ABA@@@G@A@A@AACA@@C@@BA@AB@@AB@@A@@BAB@@@B@@@@B@B@@B@@@B@@@@@@A@@@AB@@A@AB@@ABA@AAA@A@@@@BA@A@@@@@A@@@@BABABC@@@A@@@ABA@E@A@A@C@@@A@C@@@AD@@@B@@@B@B@@BJ@B@@@BA@@B@D@B@@@B@BBF@@@@@@B@@@@BB@@B@B@B@@@@@B@B@@AB@DBFBB@@@BDBBBBDBBBB@@@@AB@@@@@BB@@B@BBDBBBBBB@PCF@BA@A@I@A@AAI@C@CBA@@BAJ@FAHA@@BABABA@@BBFBBBB@D@BAFADAF@D@FBDBHFFDHBD@B@D@@ADEDCB@FAFABABCJGB@D@@@HAD@FAB@@@J@JAD@DABAFIFKDCBADABCBE@GBCBCNEJEFCF@FAB@D@BBBB@@B@NEJAF@FDF@D@F@BAD@BA@CBEBABA@CAE@A@@@CHEDADAD@D@D@B@BEDGBEDEBEBEFCHADABC@C@A@I@E@C@@A@CAA@A@@@CBA@A@AB@@A@A@A@A@@@CDA@@@GD@A@@A@@A@@A@A@EB@@CAA@A@@A@@AA@@A@C@C@A@A@A@@AA@A@CBC@A@@@A@@@AAA@A@A@EBA@A@@@@@AA@A@@A@@@ABA@C@A@A@E@ABA@@B@@C@G@A@@@AAAAAAAA@@EA@@@@CBABCBA@A@A@A@ACA@@@CAABA@A@CBA@@@A@EC@@@@@@@ADC@A@@@@@AAA@@@A@@AA@@@C@@@@BA@@@@AEAEAAA@@@AAA@@A@@AABA@@AA@@A@A@A@@@C@A@@@@@A@ACAAA@A@@@@A@@@AA@AAAAAA@@@@BABA@A@@A@@@@A@@DCBA@@@AAAA@@AAAA@@ACA@AA@@@@A@AAC@@ACA@@A@@AA@AA@AEAA@A@E@AACCE@@@A@@@@B@@CB@@ABA@@BABADA@@@ABADA@A@@@AA@@@AAEA@@AA@@A@A@@@A@A@@BA@A@AB@@CAA@C@AAA@AAAAA@A@A@@@@ABAFCB@@A@@AA@A@@AC@C@A@C@@BAB@BA@@BA@A@@@AB@BB@@BC@@FADAB@@@DABABA@@@A@ABCBA@A@A@@@ABAB@@AB@BADA@AB@A@@A@A@AAA@@A@EA@@@AAA@@@AC@@@ABA@@@@@AA@@AC@AAE@@@@AAA@@@CA@A@AA@C@A@@@AAA@AAAAAA@A@@@ABA@A@@@AA@A@AB@@AA@@A@C@A@@AA@AAB@@@FCB@BAD@B@B@DALCB@DC@ABAAC@CAB@@AA@@@A@CAA@A@@@A@@F@B@@@BABA@@@@BABB@@B@B@BADAB@@@B@@@B@@@@AB@@@BAB@B@B@@@@A@@@AAAAAAA@@A@A@@@AAA@A@A@A@ABA@@@@AA@@C@@AA@@CAA@C@@@A@C@C@@@AAA@@A@@CAAAACA@@@@@AAABA@A@A@AAA@@@AA@AAC@@@A@A@A@@B@@@BB@@B@@@B@DB@@D@B@B@DA@@@@BBB@B@BA@@DB@@B@BA@@B@B@B@@@BA@@B@@@@@DBB@B@B@@@BABA@A@@B@BBB@@@B@@@B@D@B@@@@@B@BAB@@@B@B@@@BA@A@@BA@@BA@A@CAA@A@@A@A@CA@@AA@@@A@@B@@A@@@@G@C@C@GBA@@A@A@C@AAE@C@A@A@A@@AA@@C@@@A@@@C@A@@@@@A@@BA@@D@@ADCBABAB@BAB@@A@C@C@@@@@A@A@A@@@@@ABA@@@CB@BA@AAA@@@@BADAB@@A@A@AAAAA@CAAA@@B@B@BABA@@DC@A@@@A@AAAAC@@@ABE@A@A@AA@A@A@@@C@@BC@A@ABABA@@AA@@@@@@A@A@A@@@CBA@@@A@@@A@AB@@ABA@A@ABA@A@C@E@@@AA@A@AAA@A@A@A@A@@@@@@B@@AAA@@A@@A@@BA@@BA@A@A@C@A@@@ABA@@@C@@@CCCCA@A@A@CACAA@C@C@CAA@AA@@AA@@AB@@A@ABAB@@A@A@@@@@ABA@@@@@@@CBA@@@@BAD@B@B@@@@@B@B@@BB@@@BAB@BA@ABA@C@@B@@@@A@AB@BA@@B@@@B@BA@@@ABA@@@@@A@A@@@ABA@ABA@@@@@ABAAA@@@A@@AA@@@AB@@CB@@AAAAAAA@A@@A@A@@@A@@AA@@E@@@A@AAA@@@@A@@AAAAAAACAA@@AAA@@CA@@AA@A@A@A@CACAAA@@@@AB@BCD@@@B@@@BAB@@AB@@@@@B@B@B@@@B@@ABAB@@AB@@AB@@@B@@A@ABA@ADA@@@A@@@A@@@@C@A@A@A@@@A@@AA@@@C@AA@C@AAA@A@@A@@C@@@@@AA@AC@AA@AA@A@A@AAA@A@@AEEAAAAA@@A@AA@AAAAAA@@A@C@@@A@A@@AAAACA@AAA@CEACA@@AAC@A@A@A@ABC@CBA@CBA@AAC@@@A@A@A@A@A@ABA@G@@@A@ABA@@@AAA@AAA@A@@BA@A@A@@@E@AA@AA@AC@@AC@AA@A@@AA@AAA@@@AA@@A@C@A@A@@BABAB@BC@ABABA@@B@FAB@B@@A@@BABAD@@@BA@@@AB@B@BAD@@@@@@A@AAA@@AAA@G@@@A@@AAA@A@@AA@@AAC@@A@@@@@@BABA@@@A@@@AAABA@@@@BA@@@ABA@@@A@A@AAA@A@@@@@AB@@@@ABA@@@AAA@C@A@@A@@AACAA@EA@ABA@@@AAA@A@@@AAABA@CB@@A@AA@@CBA@A@@@A@@BAB@BA@CB@BA@E@CBA@@@@@AB@@A@@@A@A@A@ABA@E@ABC@A@@@A@@@CCAAAAA@AAC@A@A@A@AAA@@@CBA@@B@@@B@@A@AB@@@@AAAAAA@@EAABA@@@A@@@@D@B@DAF@D@@AB@B@B@B@BA@ABABAB@@E@AA@@ADA@@BA@@@A@A@@AA@@AA@@@C@C@A@@@CA@@A@AAA@@@A@@@AACC"],encodeOffsets:[[113031,34177]]}},{type:"Feature",id:"611023",properties:{name:"商南县",cp:[110.881807,33.530995],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@IG@@BHH"],["@@@@AE@A@AACACBA@A@ABCBCBC@CBABA@CDC@A@ACEAACCAAA@E@@BA@ABAD@B@BAF@BC@@@CCECAA@@AAACAAAACAA@AAGBQBOBQ@YEOEQMQKGKCEGQ@MDK@IGAICQBONQPUXGHCFGNEPIRMHKEOIIGQCMDQHMJGJCFALDN@P@BAR@@AP@NGNGNCH@NEXGNKF@@OBcBK@ME@@IKBKCUIEMGMCAAAAAACCAACA@ACA@CA@ACAAAA@@GD@B@@@@@B@@B@@@@@@@A@@@@@@@@B@@A@A@@B@@AB@@ABA@@@@@BB@@B@@@BB@@@@A@@@@@@@@B@@@@@@@B@@@B@@AD@@B@@@@@BB@@A@@@@@@@A@@BA@@@@B@@@@ABA@@@@@B@A@@B@@@@A@@@@@@B@@AB@@@@A@@@@A@@@@@@A@@@@@C@@@@B@@A@@A@@AB@@@@@@A@@@@@@@@@A@@@@@@B@@AAAB@@@@@@AB@@@@@@@@AB@@@@A@@@AB@B@@@@@@@B@@@@A@@BB@@@A@A@A@@B@@AB@BABAB@B@B@B@D@B@B@HBJ@B@B@B@B@@A@AAA@AA@@@AA@@@AA@@AA@@@@C@CBA@CBEDAB@@A@@@AAA@A@C@C@A@A@@AA@AAA@A@C@CAA@CC@@AAE@@@AA@@AAA@AAA@@A@@A@AB@@A@A@C@A@@BCB@B@B@@@B@B@@@BBFA@@BA@AB@@BB@B@@BB@@B@@@B@B@B@B@B@F@B@D@@@D@BBD@D@BDB@BBD@BBFFBB@BB@D@D@DA@@B@@@B@DBB@B@DBD@DDB@@B@@@BA@@B@BBD@@@BAB@B@@@B@@B@@@@BB@AD@@BB@@B@B@B@DBDDBB@B@B@@A@ABC@@@ABCD@BAB@@@BC@@@@@EB@B@@BB@@DBB@@@B@@@@BB@D@@@@B@@@BABAB@B@BAD@B@@@B@@B@BBD@@BB@@@@BBB@B@@@BDB@@@@@D@B@B@@@BBB@@@BA@C@CBA@A@ABA@@@@BBB@@BB@@@B@D@@BBBB@F@BA@AB@B@B@@@B@@BB@B@@@B@@@D@@@@BBB@DABA@A@ABA@@BABC@ABA@A@AB@DA@@BBBB@BDB@@B@@B@BBDBBBB@@@@@D@B@@BB@@@B@B@@@FBBBD@BB@@@@B@DBB@B@@AB@B@@A@@B@@@@@BBB@B@B@B@@AB@B@@AB@@@@ABBB@B@@@B@@ABAB@@@@@@@BD@BB@@BB@@@BBB@BB@@@H@B@BB@@BB@B@B@@@@C@ABA@A@@B@@AB@@C@ABAB@@@BA@A@EBA@@@ABAB@BADA@ABAB@@@B@B@D@BB@@B@@BB@BBB@@@BBBD@@BD@@BBBB@@B@F@@@B@BAB@@@BBB@BBB@B@@AB@B@B@@@HAB@B@B@B@B@B@B@@BD@BAB@DAB@DAD@B@B@B@BBD@BB@BDDFB@BBB@BDBB@BB@B@@@D@B@@@BBBBBBB@@B@BB@BBBBFF@BB@B@BBB@B@B@@BBBD@@BBB@@@@D@@@@BB@B@BBD@B@@B@D@@BB@@@B@@@B@B@B@D@@B@@@B@@@B@BCB@BAB@@@@A@@BA@@BA@@BABA@@@A@@@A@A@A@@@@BA@@BA@A@@@A@@DC@ABA@@@@BBDBDBB@B@B@B@@BB@@DB@BB@@BBBDBBBBBB@@@B@@B@BBB@@@F@@@BB@@@B@@@B@BB@B@BBBBBB@@DA@@BA@@B@@BB@@@B@BBBA@@@@B@BAB@BA@@B@B@@@@@B@BA@@B@@A@A@@@AB@@ABAB@@@@@@AD@B@BAB@@ABA@A@@AA@@@A@A@@@@@A@ABC@A@@B@DA@@@@@@B@BA@@@@B@B@@@BABAB@@@BA@@BB@@BBB@DBD@D@B@DBDBB@B@B@DDDD@@D@@@B@BA@@B@D@B@B@B@@AB@@AB@@@@BB@BB@@@A@@@@B@B@B@B@B@BBB@B@@B@@@F@D@BAB@B@BAB@BA@@B@B@@@B@@AB@D@@@B@B@B@@@@B@@BB@BABAB@D@@AD@@@B@B@B@@B@B@BAF@B@@BDBB@B@B@@@BCD@@ABABA@A@@@BBDBB@BBBBB@B@@@BABC@A@@B@BBB@@ADA@@B@BA@@@@B@B@B@@@@@D@D@B@@@BA@ABABADABC@@@CB@@AB@@@@@B@D@@@B@@@D@@@BB@@@B@B@B@DBF@B@D@B@BB@HAD@D@H@@@@@@BA@@@@B@@BB@@DBB@B@@@@BBB@D@BAB@@AB@@@BAB@@A@A@@@A@ABA@@@@@A@C@A@@@A@@@A@AAA@@@@BABAB@@A@A@A@CA@@@@A@@@AB@@A@A@A@@@ABA@@@CA@@ABA@A@AA@@@@CBA@A@C@@@CAA@@@A@@@AA@@A@@@@B@B@B@@BD@BBB@@B@BBB@B@B@BABB@@@@B@BDBBDB@@@BB@BB@@D@D@B@@@D@B@DB@@BB@@@DB@@B@@B@BAB@B@B@B@BB@@B@B@@@BBBBBB@B@@@B@@A@A@A@AB@@A@@B@@A@@@A@@@A@CBABA@A@@@AAAB@@@@ABAB@@A@E@@@@B@@@BBB@D@B@@BB@@BA@DBDAB@BCDA@KDCBA@A@C@ABA@ED@@A@BBB@@BB@D@B@@@BB@@BAB@B@@B@@@BAB@B@@@BBBBBBBB@BB@@B@D@B@@B@BDB@@B@BB@@@@BF@BBD@@BB@@@@B@BA@@D@@B@@BB@B@@FBB@@@BB@B@B@BB@A@@BCBABA@@BA@AB@B@@@B@BABAD@B@B@@ABABCB@@A@CBEB@@AD@@AAA@@B@@@BAB@@ABA@AB@@@D@B@DBD@@@BBB@@@BA@EDAB@B@@B@B@B@BBBBB@BBD@B@DB@@BAB@B@@AB@B@@@B@B@@@BB@@FBBB@@B@@B@@@BCBAB@B@@CBABAB@@AB@BA@@DA@@@@@@B@@DFBD@B@F@BBBBFB@@BBB@@@BB@BD@@BD@B@B@@B@@BDB@BB@BB@BB@BB@B@@ABCD@@@B@@B@@@@BABAB@@@@BBBBBBB@@B@@@B@@B@B@BBBDB@@@@@B@D@@@B@B@B@@@BB@@ABBB@@@BB@BB@@B@BBBFBF@@@@AB@@@@@D@@BB@@@B@@BB@B@@@@@BCD@B@@@@@@FDB@@@B@DAB@B@BADB@@B@BDB@B@B@B@DABADA@@@@FB@@BBBBBBBB@@B@H@D@@@@AB@BAF@B@B@D@B@BA@@B@@@@BBB@@@@B@B@FAB@B@B@BB@@B@@@B@D@DAB@B@@BB@B@B@D@D@B@@@BB@@@BB@B@DB@@FAB@B@@@@BB@@@@BHC@@B@DC@@B@B@B@B@@@BAB@B@DA@@B@B@DBB@@@@ABGDGDEJOFCDABC@CAACCAAAA@CBC@A@A@@@AAACCCEAC@C@ABGDCBAHCDCBA@E@@BEFGBC@@HEBAJCD@JCFABAFEHGHI@@LMDEBALAHBH@H@HABADADEDAH@VEVERCDAFGFEDEB@BADBJBF@@@HAFAJILMFCNIFCDC@ABALGDAJYBEBE@AAAACAEGI@E@EBAAA@EACBEPIJEFGDE@GDEFKBKBMCWBCGGABABABA@AA@@BAACBA@@@E@@BA@@BB@B@BBBBB@@H@@I@CCMEMAE[OIAECCEAAHMHSDEDEFK@QBIJQLIFIBG@E@ECIAA@AAG@KFIDEDC@EAAEIQGCAWAEAEIDKJEF@BMGCI@A@ACA@@A@A@EBE@A@A@A@AACACAACACA@AAABABC@@A@@ACA@@GAAA@@AA@@@ADC@AB@DC@CDABAFEDCBC@@ACA@@@@CCBADABC@CBC@@@CBC@G@CBAAO@KECACCMOOOIKMMMOSOUCKBOHOF_DA@AA@@AAA@@AAA@CAAAACAA@A@ABA@A@C@C@KEC@ABA@EAC@CAA@CA@CAAAACCAAAACC@CAC@C"]],encodeOffsets:[[[113692,34283]],[[113480,33983]]]}},{type:"Feature",id:"611024",properties:{name:"山阳县",cp:[109.882289,33.532172],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@@@A@@A@@A@@@@@@@@BA@@@@B@@A@@@@@A@@@@@@AA@@@@AA@@@@A@@@@@@@@A@@B@@A@@@AB@@@B@BB@@BA@@@@B@@@@@@B@@@@@B@@B@@KL@B@BIJOLCBA@MA@@@@@@@@@@[CICA@MEQBMBWBUAMAC@KAOCKAWIGAOCSBYPCJGH@@A@IFMBKCECOEQCKBOFABSJYLEBGHABKDMFKB[FUDWFULGBGBGBMAIAYG]IQCK@WAM@MBSBYCQAKAEAIBSD[LEDEHKRKBUBOEQIK@A@EF@TCNIFMJC@W@EAA@A@A@@BCBABC@E@A@A@BB@@@@BBB@@@@@ABA@A@@BABAB@BA@CBA@ABA@A@C@A@AA@AA@AAA@@@A@ABA@@@CAA@E@A@@@AD@@@DBFBBB@@BB@BB@BBB@D@@BD@@@BA@A@AB@BC@A@@BA@@B@B@@A@CB@@AB@@@B@B@B@B@@@BB@@@@@@BAB@BABA@A@@@A@A@@@A@@@A@@@BDDFBB@B@@@B@B@@@@@@D@@@DBB@B@BB@@@B@B@@AB@BBBABAH@DA@@@@@A@C@EBEAE@A@AAC@AA@@A@A@C@ABGD@@@@@B@@BB@B@B@BADBB@B@@@B@FA@@@A@A@ABA@EB@@@B@BAB@BBFADBB@@@BD@@@BABA@@B@BAD@BADCB@@AD@@@B@BBBBBBB@BBBB@@@B@B@@AD@B@@@@B@@BDBBB@@@@@B@@AB@B@B@B@@DDDBBBFB@@BB@@@B@F@B@B@@@BAD@B@B@@A@CAC@A@@@ABABABA@@@@@@@AA@A@A@@@ACAAAAC@ACCA@@@C@A@@@CDCBA@AB@@ABAB@@@B@BBBBBBD@@DB@BB@@D@B@B@BB@@B@@@@BB@@@BB@B@B@B@@A@@B@@D@B@B@B@@ABAD@@BBBB@B@D@@BB@BBB@B@BABBB@B@B@@B@@BBAFAF@B@@@BD@D@B@BBBB@BBBBBBB@B@BBB@BB@@B@@BB@B@@@B@@@@BBBBBB@@B@@@B@@@B@@@B@@@@A@@@AFABABBB@@@B@BAB@DAFBH@@AD@B@B@BB@BB@@B@B@B@D@BA@@BB@BBB@@D@FDF@@B@@BADAHC@@@@B@AD@@BBBB@B@@BBAB@@@B@B@@@B@@@B@B@@@D@B@@AD@BA@@@A@@@A@AAA@A@@@ADA@ABAD@@ED@@A@@@AB@AA@@@CBA@CB@@ABABAB@@@BCBAD@@A@AB@B@BAB@@@BA@@@@@AB@B@BC@ABABA@@BA@A@@BAB@BAB@@@B@DBB@B@BB@@B@@@@AB@B@@A@@BA@A@@B@@C@@@AB@@@BB@@B@BA@@B@@@DA@@B@@ABA@@@A@@DAB@@AB@@@BAD@@BB@BDD@@DBB@@@@BBBBB@DBB@B@@BDBB@BABBB@@BDBDBBBB@@BBB@BADAFADA@@@@BDBB@@BBBB@BBBB@BB@@AB@B@B@D@B@@@BAB@@C@@@AB@D@@AD@BABCB@@A@@DAB@@@@@BA@@@@BAB@B@@@@BBD@@BBB@@AB@DA@@BAD@@AB@B@BAB@BAD@@@DB@@B@BAB@@@@@B@B@@CBAB@B@B@B@B@B@@BB@@@@A@ABA@AB@@AB@@@B@@A@A@@BAB@@@B@B@B@BBD@@DBD@@@@DBB@B@@@@@B@D@B@B@B@B@@@@B@BB@BD@DB@@B@B@@@DB@@FD@@@B@BDF@@B@BBB@BBBBDB@BD@BBB@B@@B@@@BB@@BAB@@@B@@BBBBDDBD@@@@B@@BB@B@B@BBB@@@HF@@D@BBB@B@@@BA@@D@B@DAB@B@BAB@@@B@@BB@@@B@@AB@B@@A@@FAB@FAB@DBB@F@BAB@@AB@B@@@D@H@@@B@@DBBBB@@DBB@BBBDBDDD@BBBBBDB@@B@DAD@B@@@DBD@B@@AB@D@BBD@B@B@BB@@B@B@@@D@D@B@@ABAB@@@B@@@BBFDFD@@HCB@BBAB@B@@H@@@BBBBB@DB@@@@@BDD@BB@BBD@BBB@B@D@B@@@BBD@BBB@BA@@DCBCBA@A@@B@B@B@F@B@@@B@BDB@B@@@DB@@F@BBB@B@B@B@D@B@B@BB@@D@B@BBB@BAB@@@@C@CBA@ABA@@AA@@B@BA@ADC@ADC@@@A@A@ABCBABCFGBABABABC@@@ADA@AB@@@B@B@B@DCB@DA@@BABABA@AB@BB@@@BB@BBD@D@@BB@BBB@BBDBBB@@B@B@B@B@BBB@@@@@@ABA@AB@@A@CBCAC@A@ABA@@DAB@@@BA@A@A@@@ABA@@BC@AAC@CAE@A@@@AAC@@BA@@JBD@BAB@@BB@BB@B@B@BBB@@@@@@B@JAB@BAB@@AB@BAB@B@B@@@B@D@B@BAD@@@@@@B@@@@@BAB@BAB@@@@AB@@@@@F@BA@@BCB@B@B@@BD@B@BBB@@@@B@B@B@@@DB@@@AB@B@B@B@@AB@BA@@@A@A@@@@DA@ABABCB@@AB@B@F@@ABA@@@@@AB@DA@@BA@@@AB@@@D@DAB@@@@@B@B@B@B@DDBB@@@@D@B@B@@@@@BABAD@@ABCBAB@@A@@B@BBDBB@@@DBB@BBB@BD@BB@@BD@BB@B@B@F@@@@BB@@AB@@@D@B@B@BABADABAB@@@BB@B@BBB@@BDB@B@@@BBFB@BB@@@B@B@B@D@B@@B@BDB@@@@B@@@B@@B@B@D@@@F@B@@@BBBBB@@BB@@BBB@@BF@@BBDB@F@@BB@B@BBB@@@@BBF@D@D@@@DBB@B@@@D@DBBAB@BB@@@@DAB@DBBCB@B@@@@@D@@@@@@@BBB@DDBB@@B@@CBA@@B@DB@@BA@@B@@BB@@@BCBA@@@@B@B@@@BB@@@@@@BABCBA@@FAB@BBB@BBBBB@BD@@BBD@@@@BB@@A@@D@@@D@B@BB@D@B@BB@@@B@F@@@B@@@B@D@B@DB@@B@DCB@B@BADA@@@A@@B@DAB@BAB@@@@A@@BAB@BA@A@@BBB@@@BAB@B@@@BAB@@A@@B@D@@@BB@BBBB@B@BBDA@@@BB@@@BA@@BA@AB@BA@@BA@@D@@@B@BABA@@@@B@BB@@@BB@B@B@@@@A@@@AB@DAFABA@@FAB@@@B@D@B@B@@ABABAB@B@B@B@B@@@@CB@@@@A@A@A@@@A@A@C@A@A@@@@@A@A@@BAB@B@@ABBBAB@@@BAB@B@B@BAB@BABAB@@A@@B@B@D@B@@@BA@@@@BAB@B@B@@@B@@@DC@@@@BA@@B@B@DBFBBABCBA@@BABABABA@@BA@AB@BABA@@@CB@@@@A@@@AD@B@@@DCB@B@D@BBBBB@B@D@B@@@B@B@B@BB@@@@@ABAB@@A@EB@@ABE@A@@A@AACA@@AA@AAA@C@E@AAC@@AA@A@@@ABC@A@EBC@AA@AAAA@A@@BA@@@A@C@@@@@AAC@@@A@@@AAAAA@A@@@C@A@C@@@A@AAC@@@@@ABB@@B@B@B@B@B@DBB@@@@@@A@@@A@@@E@A@A@C@C@AB@@A@A@@@A@C@AAC@A@@BA@@B@@@B@@@B@@ABA@@BAB@@@B@B@@BD@B@BC@C@A@@CA@A@@@AAC@EBA@@@AB@BC@@B@B@@@BB@@B@B@@@B@@@D@BA@@B@D@B@@@FAB@@@BABA@@F@DAB@B@BA@@DCDA@AAA@A@A@A@A@@@@BA@@@A@E@@@@@AAABA@C@C@AB@B@B@@@BC@A@@@ABAB@@@@E@@BA@@DAB@@AB@@BB@@@D@DAD@@@@BDDBBBB@@B@@@B@B@BBDB@@BB@@@@BA@A@@DCB@B@@@@A@@AA@CAEBEACBE@ABA@A@@B@@A@@@A@A@A@A@C@A@A@A@AA@@A@@B@@CB@@@@@DAB@B@@ABAB@@@BEBA@ABABA@AB@@@B@@BB@@BB@@@B@@AB@@@@@B@@C@E@@B@@@B@@@BA@@B@B@BBBAB@@@BABC@ABA@@BA@AB@@A@AAA@ABABA@ABA@A@C@A@@B@@@B@B@B@@@BB@@@BDB@B@B@B@@@@ABA@@@AB@@AB@BA@@@@@@B@B@B@@@B@B@D@F@B@FBBDBB@DBB@D@@@BBBD@@B@@BBB@BB@@DB@BF@B@BBDB@@B@@B@@@@A@@BE@A@@@A@@@AB@@A@A@@AA@@BA@A@A@A@A@@@AB@BAB@@@BABCBE@@FE@A@AB@@ABADA@CBA@@@A@A@A@A@A@AA@AC@A@CAA@C@A@@@AB@AA@ABABA@@@A@@@AB@@ABA@A@@BA@A@@@A@A@@B@@A@@A@@A@@AC@@ACA@@@@@@AAC@AAA@A@A@ADA@@@A@A@@@@@C@A@A@A@@BA@@AA@@@A@@@A@AAAAA@AAC@A@@ACCE@@@A@@B@DAB@@@@ABA@C@@AAAC@@@@@A@A@A@@BABCBAB@AA@@@@BA@A@@BA@A@A@@BA@ABA@@D@BAB@@BBDBB@@BB@D@B@@@B@DBB@BBB@@DFBDBBBD@BDB@BD@B@@@BBDBBBBBBBB@@AHABAB@@BBBD@DBB@B@B@@@@AB@@A@C@A@CAA@CA@@A@@@CBCB@@C@AAA@AACAA@A@A@CB@BA@ABA@AB@@BBDBBBBB@@@D@D@@@F@B@@@BCB@@@B@B@B@H@F@@@@B@@A@@BAB@@BD@BB@BBDDBB@@B@@@B@BBB@@@DB@@B@D@D@@@B@@BB@@BB@B@@@B@@AB@BC@@BBF@@@BABABAB@@A@A@A@ABA@@@CBE@C@A@C@@B@@@B@BAFB@@BBBBBB@@@@BAB@@@@A@@@AB@DA@@B@BBB@B@B@D@BBB@BBBBDD@@B@@@B@D@BAF@B@BAB@B@B@@@B@@@BA@@@@B@DAF@B@@ADAB@@ABA@AB@@@B@B@DA@@BBB@@@DAB@BABB@@B@B@BB@@B@BAB@@@@A@A@@AA@@@A@C@@@@AAAAAC@A@AA@@@CA@AAAAA@@CBA@@B@BAB@BADAB@@AB@B@BABCBA@AA@@@@@C@@@A@@@AAA@@@A@@@A@ABAB@@A@EAAAA@@@C@A@@AA@@AA@A@@B@BAB@B@DAD@B@@A@@AA@A@@@A@A@C@@@@CA@A@@@AAA@A@@A@@AC@AAA@@@@A@@@ABC@A@ABABA@A@@@A@@C@A@@A@@A@@@A@CA@@AA@@@AFA@@@@D@@A@@BA@ADCBA@@D@BAB@@@@A@AAACCCAA@A@A@@@AA@@BCA@@A@@A@@@@A@@@ABA@A@@AC@A@AB@@A@@@AA@CCC@CAA@A@CAA@@@A@@@CBC@C@A@@AAAEEAAC@AAA@ACC@C@AAC@@@C@A@E@A@A@A@A@A@@@A@@@AA@@@AAA@@BAB@@AB@AE@A@@@A@A@@@A@ADA@AB@D@B@B@@@BAB@@@@BB@BBB@BB@@BB@@F@BB@@DDB@DBD@B@B@BBB@@BB@B@D@D@B@B@BB@@B@@@BAFCDAB@DAD@@@@@BB@@BB@@B@@B@@BBB@BBB@@@@A@A@A@AAI@G@A@A@C@A@A@ABABA@ABA@@@AB@B@B@@@A@@AB@@@@@@A@@@@@@@ABA@@B@@@@@BA@@@@@@@@BA@@@@@@BABB@@@A@@@@B@@@@@@@@@B@@@@@@@BA@@@BB@@@@A@@D@@@@@B@@@@@@@@B@@B@@@@@BA@@@A@@@@B@@@@@@AB@A@@@@@B@BA@@@@@A@@B@@AB@@@@@@@B@@@AA@@@@A@@@BC@@@A@@@A@@@@@@@A@@@@@@B@@@@@AA@@A@@@AA@@@@B@BA@@BA@@@AB@B@@@@A@@@@@@B@@@@@@@A@@@@A@@@@@AHCAAAA@AAA@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@AA@@A@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@AB@@A@@@@@@@@@@A@@@@@@@A@@@@BA@@@@@@@@@@@@@@A@@@@@@@@@A@@B@@@@@@@@@@@@@@A@@A@@@A@@@@@@@@@@@@B@@@@@@@@@@@@ABA@A@@@@@@@@@@@@@@@@A@@@@@@BA@@@A@@@@@A@@@@@A@@@A@@@@@@@@A@@A@@@@@@@A@@@@@@@@@@@AA@@@@B@@@@@@@@@@@B@@@@AA@@A@@@A@@@@@@@@@@@AA@@@A@A@@@@@@@A@@@@@@@@A@@@@BA@@@@@@@AA@@@@@@A@@A@@@@A@@@@@@@@@@@@@@@@AA@@@@@@@A@@@@@B@@A@@@@@@@A@@@@@A@@@@A@@@@@@AA@@@A@@@@@@@@@@A@@@@B@@@@@D@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@AA@@@@@ABA@@@@@@@@@A@@@@@@A@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@B@@@@@@@@@@@@@@A@@AAA@A@@BA@A@A@@BA@CBE@CDMFA@A@IAEA@@EAEAA@AAAAC@@@AAECI@QDSHG@@@@@@@@@A@@@@@@@@B@@A@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@B@@@@@B@@@@A@@@@B@@@@@@@@@@@@@A@@@@@@AAA@@@@A@@@@@@@B@@@@@@A@@@@@@B@@@AA@@@@@@@@@@@@@@A@@AB@@@@@@@@@@@@@@@@@B@@A@@A@@@@@@@@@@@@@@A@@@@@@@@@@BB@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@QBA@AAAAA@A@C@@@@@@@@@B@@@@@@@@@AB@@@@@@@@@@@@AB@@A@@B@@@@@B@@@@@@B@@@B@@@BA@@B@@@@@@@@@@B@@@@@@@@@BA@@@@@@@@@@@@BB@@@@@B@@@@@B@@B@@@@@@@@@@@B@@@@@@@@@BA@@@@@@@@@AA@@AA@@@@AA@@@B@@@@@@@@@@@@@B@@@@@@@@@@@BAB@@@@@@AB@@@@@@@@@@@AA@@@@@@@@@@@@A@@@@@A@@AC@@@@ABA@A@AAA@C@A@AA@@AAAA@AAA@AAAAAAAA@AAC@@@A@AAABC@A@C@C@@@@@@@@AA@@@@@@@@@B@@@@@@A@@@@A@@@@@@@@@A@@@@B@@A@@A@@@@@@@@B@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@A@@A@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@B@@@B@B@@@@A@@@@@@B@@@@@@@@AA@@@@@@@A@@@A@@@@@AA@@A@@AA@AA@@@@@@@@@@@@B@@@@@B@@@@@B@@@@@@A@@@@BA@@@@AAGAKF[FABC@A@ABABA@@@CBC@C@A@ABA@A@A@C@ABA@M@OCKACAKAQGICGAM@OAQDIFGHIJINKFGDAB"],encodeOffsets:[[112839,33982]]}},{type:"Feature",id:"611025",properties:{name:"镇安县",cp:[109.152892,33.423357],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@C@@@A@AAAA@@@CAA@@AA@AA@@ACA@@AA@AAA@AAA@@@@@A@AA@@A@AAAAC@CA@AA@@@E@C@C@CAC@A@@@ABABABCB@BAB@B@D@BA@ADA@@BBB@BBB@B@@ABAB@B@@AB@@@@ABBB@BBDBD@BA@@BC@AB@@AB@@@B@@G@EBG@AB@BA@@BAB@@A@A@@BA@@BABA@@BA@@@@AA@@AA@AAA@AAAA@@A@A@C@@@@BAB@BA@ABC@C@@@ABEACBC@@BAAA@AC@@@A@AA@@A@@@A@A@@@A@@A@A@A@A@ABA@@@A@CBA@@@A@@@A@A@A@A@A@AAAAA@AAABA@@B@@@DBD@B@B@B@@@BA@ABA@@@@@A@@@A@@@A@@BCB@BA@@DA@@BAAABA@AB@@AB@@ADAB@BAB@BA@@@A@A@A@A@C@CBAB@@CB@@ABA@@@A@ABC@AB@@AA@@@@ACA@@@E@@@A@CDA@A@@@A@@@@@@AB@BAB@B@@@@A@A@A@A@A@@@@A@A@AAAACC@@AAA@CAAAA@@AA@A@@@A@A@@A@A@@@AA@A@A@A@A@@@ABA@@B@@@BA@A@@@@@A@@A@AAA@@AA@@A@AB@BA@@@@@CC@AA@@AA@@@AC@AA@@AAA@AAA@AAAA@AA@@C@@@AA@@A@A@CB@@A@A@@@A@AAA@ABA@A@@B@@ABA@AB@@A@@AA@A@C@EAA@@A@ABA@AA@@@@@C@C@CBC@A@A@C@E@A@@@A@CBA@A@GBA@CB@@A@A@@BA@@@A@A@@@AB@@@@@BBB@@@@CDA@@@@BA@@@@@@@@B@BBD@@ABA@ABA@@BAB@B@B@BA@@BADA@@BADCB@@@@@C@@CC@AA@A@@@EBCBABCDA@A@ABABCBA@@@DD@@@@CBABABA@EAAAA@A@CA@@@@@@@B@BBB@BB@@B@@A@@@CBA@@B@@DBBD@@@B@@AB@@BB@BABA@ABABA@@BAB@BA@C@A@AAA@CAAA@@AA@@AA@AAA@@AA@@A@A@@BABA@@@@BA@@@A@A@AA@AA@@A@ABAACACAA@@AAAA@A@A@@@CBC@C@@AAA@AAAA@@A@A@@@AAC@E@CA@@A@GBA@AAAAAA@@@AAA@@@@@C@@@@@@CAA@@@AAC@AAA@@@@@A@A@A@AA@@A@@AA@A@ABA@C@@@@@@B@@AB@@CB@@A@@BABA@ED@B@@@@AAACAAAAA@A@AAA@A@@@A@CBA@ACAA@AACEE@@@@@A@@A@@A@A@AA@@@BA@@BC@ABC@@BC@A@@BA@@@@@A@@A@@A@@AC@@@A@@@ABA@AAA@AA@@ABA@@AC@A@@@@A@A@A@AAA@@A@@A@@@C@@@A@C@A@@@A@@@@@@@AA@A@@AA@AB@@A@@AACAAAA@A@A@C@@AABA@A@A@@B@@AB@@A@AB@@A@@@AB@@@@@@AA@A@@A@A@C@AACA@AAA@@ABA@@@@BBDBB@@@B@@AB@BBD@B@@A@@@C@ABA@A@@D@BADAB@BABCJ@@AB@B@@A@@@E@@@ABAB@@@@AAA@A@AAEBCAA@A@@@AA@@AA@@ADABADABA@A@A@@@A@CB@@@@@B@BA@@DAB@@@B@BA@AB@@C@EACBA@@@@@B@@B@BB@@B@B@B@D@BAB@@AB@@A@@BAB@BCB@BABA@CB@@AB@B@@@B@@@B@BA@A@A@A@ABA@AB@@A@BBBB@@@BA@CBAB@B@@@@ADA@ABA@C@ABA@A@@@AB@@AB@@A@EA@@A@BBBD@@@BAB@B@DABABAB@@@D@@A@A@A@A@A@A@A@@BA@A@@B@BA@ABCBA@@@ABAB@@CBA@A@@@CB@BAB@BA@BB@B@DBB@B@@@B@@AB@@ABA@@BA@@@@B@@ABCBA@A@@@@BA@A@A@ABA@@@@B@BAB@BAB@D@DAB@@A@ABA@BB@@@B@@@B@@ABC@@BA@ABA@@@CBE@@@A@@@@A@@C@A@CBA@GB@B@@CB@BAB@@@@ABA@A@CDA@A@@B@BA@AB@BA@A@A@ABA@A@@BA@@@A@@@CBABEBABA@@@A@A@ABADCBABABA@AB@BAB@@A@A@ABA@CBA@A@A@@@AA@AAA@@@AA@@A@@C@A@@@AACAAA@A@C@AAA@@ECA@@@@@CAA@C@A@A@A@@@A@AA@BABCBA@@BABABCBCB@@CBA@C@A@@@A@A@CBA@@DBBBBBB@BD@B@@@@DB@@@DAB@@BBB@B@@@B@@@B@@@@A@@@A@ABAB@@EDABA@@BA@@@A@@BAA@BCBCDA@A@CFA@ABCBCBA@@B@@@BB@BB@B@B@B@BB@@@ABA@ADA@ABABA@CB@@C@@@A@CBA@C@CACBA@A@A@@@A@A@EBA@CBA@E@@@@@A@BCAA@A@@@AA@CC@@ACA@A@EAA@A@CAA@GCAAA@A@ACA@CAA@A@A@A@CAC@E@EACAA@C@EBAB@@@@A@@AD@@A@@DC@@A@@@A@E@E@A@@@@@AA@@E@A@AA@@EDC@A@AB@BAD@@@B@D@@@B@@@BBB@@BB@@@BBB@@@B@@@BBD@B@@AB@D@@@B@B@B@@@BBBA@@BAB@DBB@D@@@BAB@@B@@BBB@@@B@B@BADABA@@@@B@@@B@B@@@@@BAB@B@B@BBBB@@@DBB@@@@B@BBD@B@B@D@@@B@B@B@BA@@@@BBD@@@B@BAB@@BFA@@@A@AB@@B@@B@@@B@B@B@B@B@@@DBB@B@@DBBBD@BD@@@BBB@BB@BDB@@@AB@B@@BD@BBB@BB@BBBBB@@BBB@BBBBBB@BBB@B@@@@@FD@B@@@B@@A@AB@@@D@@A@A@C@@@@@@BAB@B@@AD@B@D@B@BBB@B@BAB@BDB@@@B@@@@@B@B@BBB@@@B@B@@A@AB@B@@@@BDBB@@@B@@AD@@CH@@@B@@ABA@CB@@@@CBA@ABCDA@@@A@@B@@@@@@@B@BBBADB@@@DB@DBBDB@@HB@BBB@BBB@@B@BBB@@@D@BB@@@@BAB@BBB@FB@BB@@D@BDBBBBDB@B@B@@@@BBBBB@@BD@D@@BFBDDD@BBBB@@@BBDBBBBBBB@@@@FBB@B@@@BB@@@DBB@@@B@BAB@BCF@B@BA@AB@@CB@@AB@B@@BD@F@F@B@D@@@BB@@@BB@B@D@D@@@D@@@B@@B@DBB@A@@BA@@@@B@@BBB@D@BB@@@B@@@B@D@@DD@@FBB@@@BAB@B@@AB@B@B@@@D@@@BBB@@@BABAB@BAB@@CB@@@B@B@B@@@B@B@@@DD@@HBB@@@B@HAB@B@BBB@@@@A@ABC@ABC@@@@B@D@@@B@B@DA@A@@B@@AB@@AB@@A@@@A@@BAB@BADAB@@A@@BA@@B@@@B@BBB@D@B@B@@@@B@@@BBB@@@@B@DBDBB@B@@AB@@@BAB@B@@@F@B@BA@@B@B@@@DDBB@@B@@@BA@CFEB@BAB@@@B@@@@BBBBB@@@B@@BDB@D@@@B@DDD@@BD@FF@@@@A@CA@@A@CD@B@@AB@@ED@@@B@@F@D@D@DBD@@@B@BA@@BABABB@@B@@@B@DBB@DB@@@DBD@BBD@@@BDB@@@@D@@@BDB@BB@@HD@@@B@BBBB@BD@B@B@D@BBBBF@DA@@D@@@@@BBB@@DBBB@@D@BBD@BBB@DB@@@@@B@BB@@B@@@B@B@B@@BBBBBB@B@@BBBD@B@@AD@@BB@@@B@B@@@@BDBBDBBBB@@BB@BB@@BBBB@@DDB@D@B@@@BAB@B@@@B@@@B@B@@BB@BDB@@BBB@B@BA@ABA@A@@@ADCDAD@BA@@BCDABA@@B@@@B@B@@AB@@A@C@AAA@EAA@A@A@@@CAA@@BABABA@ABA@A@A@@@@BA@@@@H@BA@AB@BCD@B@BBFBD@@B@BB@@BB@@DBB@B@@BB@BA@@DADA@AB@BA@@B@B@B@B@D@B@B@D@B@@AB@BA@@B@@@@@B@B@B@B@@A@@FA@@B@@BBBBBBBBBB@B@@@BBBBFD@@BBB@@ADA@@@@BBBD@@DB@BBDDD@@B@@@B@@@B@BBBBB@@B@BBB@B@@BD@@@BBB@BBB@DBD@@BB@B@@AB@@B@BBB@@AB@@BD@B@B@@@@@@B@@@BDB@@D@D@BB@@BBBB@@@@BBB@@B@@@B@BB@@B@B@B@@D@@BB@@@BB@@@B@@B@D@D@B@B@@@B@BA@@DAB@B@BA@@@@@ABA@AB@@A@ABAB@B@@@BA@@AA@@@@@A@AB@AAAA@A@A@AAE@@BAB@@A@@@@BAA@AA@@@A@CA@@@AA@@@A@AAA@@B@@ABA@@D@DCB@BA@A@CBABA@AB@B@B@B@BADA@A@@CC@CA@@G@C@@@@@@FADBD@B@@@FABABAF@BAB@BAF@FAB@F@B@@@B@@@@AB@DAD@@@BAB@B@B@DBB@B@@@@@@B@@@@B@@@@ABA@@@@DCB@B@BABBFBF@@@B@B@D@@@B@@BDB@@B@@@FADA@AB@B@@AB@B@@@D@@@FBD@B@D@@@DA@@BADADABAB@@@@A@AAC@A@C@@@@@@B@@@BABADEBAJI@@BC@A@@BBB@B@@@B@@ABB@@B@@@B@@@@CB@@@D@B@DA@@B@@@BBB@DBB@LFB@B@B@D@@ADCB@@ABAB@BABAHAFEDAB@@@DCB@BAD@@AB@@AB@@@@ABAB@@@D@BABB@@D@B@BBB@DBB@BBBB@B@@@@B@D@B@@@@@@AB@AA@A@@@A@AFC@@BA@AA@AAAA@A@A@@AAAGACAC@@@ABA@@AA@C@A@@DA@@B@B@B@BABAB@@ADCDA@ABA@A@@B@@AB@B@B@BA@ABA@A@@@@B@D@@@BAB@BABA@@BA@@B@B@@@B@BBB@F@B@BBB@BBJ@@@B@@@D@F@B@F@B@FAB@@@DBB@BB@@B@@A@@BA@AB@B@DAB@D@B@F@D@BBBH@B@BDBB@@@BCB@@@FABABABABA@ABA@@BA@@B@DAB@@ABAB@D@BAB@FCDABAB@B@B@B@@@B@BAB@@BBBB@BBB@BBBB@BBB@DBB@@@BB@DB@@J@B@BBFBBB@BB@@BBB@@B@@BD@BBF@@@DDB@DBB@@@@A@A@@BABA@A@@@@@A@A@@@A@@B@B@B@B@B@BA@A@ABC@ABAB@DEB@@AB@D@B@BA@A@AB@@A@@BABA@@BABCAAB@BCB@BAB@F@@@DADB@AB@@AB@B@DB@@B@@@DC@AFCDAB@B@@@HBD@DAB@DA@A@@@A@A@CDE@@@@DAB@BA@@B@B@BA@A@@BAB@DCB@@@@C@A@ACC@@@A@@B@DCB@BAB@@@@ABADE@@BAB@B@@A@@@A@@AAAC@A@A@AA@A@@@@CAA@@@@@A@AB@@A@A@@AAB@@@BAB@BA@@@@BA@@B@@C@@@AAA@@BC@ABA@A@A@@@AB@@A@AB@BC@@@AB@F@B@@@B@@A@@BA@C@@@@DABAB@B@BABAD@B@@@DA@@@@DBDB@@D@@@BAB@@AB@@@B@D@BB@@D@DB@BF@B@@BB@@@@@BCB@@@DAB@@BB@@BBB@BBD@@@D@B@@@@DBDBFBB@B@@@D@@@@@B@@@D@DAB@D@FB@@@@DA@AB@@AB@B@DAB@@@@AAA@@@A@@DABA@@B@@A@A@@@ADAB@B@BA@@BC@AD@@AFA@AD@B@BABA@@B@BAB@@@DB@@DC@@B@@@FB@BB@@B@BB@@@B@@@BC@@D@@@BAB@@@D@B@B@@@BA@A@@@AB@B@@@B@B@B@@AB@B@B@FA@@@@@AA@@@@ABE@AB@@@D@BAB@B@BA@@@@BADBB@B@@@BBBB@@DBB@@@B@B@@@F@B@BA@@B@B@@A@@@A@@BA@A@@@A@A@@B@@@B@J@B@D@B@BAB@B@@@FBB@BB@BBF@@@BDB@@@B@B@BB@BBD@BBB@@@@A@@BAB@BA@@F@B@BB@@@B@@DB@@D@B@@@BA@@BABA@ABABAFA@AB@NA@@@@BDBB@BB@BB@B@@@BBD@@@B@@@@@B@@B@@DB@D@D@H@DAB@B@B@@@BB@@B@F@@BDD@BB@@C@A@C@A@A@@@AB@@@@A@@AA@@@@BA@@DB@@B@BA@A@@@@AAAAAA@AAA@A@@@AA@@@@A@AAABA@A@AAA@AAA@@@C@AAAAA@@BCBA@@@A@A@A@CA@@@@BA@A@A@A@@A@@AA@@@@@AA@@A@A@A@CA@@ACA@@ACAAAA@A@A@@BABA@@BAB@DADC@@B@D@@@B@DD@BBDBBDB@B@@@B@BBB@@@@@@B@BABABA@@B@D@DBB@@@@A@ABC@A@@@A@A@E@A@@AA@@EAAACACC@@@A@A@ABA@@@A@@@@AACA@AA@@@@@@ABC@@@A@A@@AAAAA@AAAAAAA@@@C@@BA@CDABC@ABA@@@ABAB@@C@@A@@AABCAE@ABA@A@A@@FAB@BAB@B@@@B@@E@A@@@AAABC@A@A@AAA@@@A@@@@HCBAD@B@B@@@BBD@BBB@F@FBFAD@B@@@@@B@@CBGBAAA@ABA@@@A@A@@AAA@A@CA@@C@@@@@@@@A@A@@@AAACEAC@@B@@@B@@@B@B@@@B@B@BA@ABA@A@@@@A@@A@@@A@A@A@A@@BA@@DAB@@@@A@AB@@AB@D@@ABAB@B@@A@@AC@@@CAA@AAAA@@AA@AAAE@C@@BC@@B@F@B@DB@@B@BAB@@@B@BBB@@BBBB@D@B@B@BAB@DAB@@ABABA@AB@B@BA@@@@A@AA@@@@AAB@B@F@D@BADA@AB@B@B@EAEGIECE@E@A@AAC@QBYECEA[EODWJIDKDGBGBWBWCWGGGEGCYMSUEKGCGAACIISOOUOEEKIKKFC@@KEAAA@A@A@A@CBABABGAA@@AA@A@@@GA@@A@A@A@AB@B@@E@ABA@C@AAC@@@A@CBA@C@ABC@@@A@A@@@C@A@CAA@AA@@C@C@A@A@AAA@A@A@A@@@"],encodeOffsets:[[112e3,33943]]}},{type:"Feature",id:"611026",properties:{name:"柞水县",cp:[109.114206,33.68611],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@A@@@@B@B@@@B@B@B@B@D@B@@@F@@@@@DBBABBBBB@@BB@B@B@@@BB@@B@@BBB@@@AB@DAF@BA@A@@BCBA@CB@@ABAF@@AB@@@B@B@B@@A@@BCBABAB@BCB@@AD@BAB@BA@CDABAB@B@@@@BB@B@@B@@BFABB@@B@@D@@BBB@@@B@D@B@F@B@BBB@@@@@@@BC@@B@BA@@@@BA@A@@B@@@DAB@@@@@B@B@DBB@BBFB@@@@B@DADAB@@@D@@@FD@@@@BBB@BAB@@@B@BA@@BCBA@AB@DAB@D@DAB@B@B@D@D@BAB@B@D@B@B@D@D@B@@@@@B@@@@BBB@BBB@BB@@@DB@@BBBBFFBBBBB@@@B@F@B@B@DABAD@D@B@@@D@@@BD@@DBDB@@D@B@B@DC@A@@BAB@FAD@@@BA@@B@D@B@B@BBB@B@@@BAD@B@B@B@@@DADAB@DA@@BABABA@ABABABA@@BA@A@@@@@A@@@ABABA@@BAB@B@B@@A@@@A@@@@BABBB@@@BBB@B@@@D@@@B@@ABCB@@@BBB@B@B@@@DAB@@AB@@BBBBBB@@@B@@@@BBBB@@@@B@@DA@@BA@@@BBDB@DDDBD@B@B@BA@AB@@@BBB@@@DB@@BB@@DBB@D@B@B@BAB@@@BBB@HB@BB@B@B@@ABABABA@AB@BA@@@@@A@@BAB@@@B@@A@@@@@@B@B@B@@ABA@@BABA@@@AB@@AB@D@EC@A@@BC@A@CAC@AA@@A@A@@@AFC@A@@@A@A@A@@@A@@CAA@AA@@A@@A@@BA@@@A@@@EBA@A@@AA@AB@@A@@@A@AAA@@@A@@@AAABA@@@A@@@C@C@CAAAE@@@ABABAD@@A@@BE@AAAA@@A@@@E@@@AAA@A@@BA@@@C@@@A@A@AA@@A@C@A@@BE@A@AAAAAA@@ACA@@AAA@@@E@@@AAC@AA@@CAGCAAA@CA@@AAA@AAA@@AA@@AA@CAAACA@@A@@AACA@AA@BB@@BAB@BABCBAD@BA@CBCB@@A@A@CD@BA@CB@@@F@DABABAB@BA@EBA@A@EACA@@GDA@@@@@CF@@@@C@AAA@C@AA@AA@@@A@@@@BAB@B@D@B@@AB@@ABA@ADAB@BA@A@A@A@ABA@@@AB@@@@CAC@A@@@@A@@CCA@GA@@@@C@EBCBABA@@BCD@@@@A@GAA@@@ABC@CBGBCBA@A@A@AA@@AAAA@AA@A@CAA@@BC@C@A@A@E@ABEBABA@ABA@ABAB@@C@C@@@@@AA@AA@@@A@C@CAC@@@A@CBA@@@C@@A@A@@@ACBA@C@ABA@C@EB@@CB@BC@CAA@@@AB@@@BA@E@ABE@@@@@DHBBBH@@@@DBBB@@@B@@@@BBB@@BBB@D@@@@A@A@E@C@ABE@AB@@E@A@@@@@"],["@@@@@AAA@@@@@ABABADCB@@ABA@ABC@@DA@ABABADA@AB@@@@A@A@A@@BA@@BEBA@@DAB@DA@AB@B@@ABE@CBA@@A@AA@@@AA@@A@@@A@AAA@@AAAABAAA@C@@@@@E@@@A@C@A@A@A@A@@@A@A@@B@ACA@A@CAAAAAAA@A@@@A@A@@@AAA@AA@@A@@@A@A@@@@FEBA@CBA@@B@LDB@FB@@@@F@BAF@B@@A@@BA@@B@DBD@@ADA@@FAD@B@BAD@B@DA@B@@@B@BD@@@B@DAB@@@D@DBD@B@@@B@@BBB@@@@D@D@@@BABAB@BAB@BAFABAF@B@B@D@D@@AB@DBB@B@@BBBBB@@BBB@B@B@DAHADAD@BA@@B@HBB@@@@@DC@AB@BADAFAD@@@@@HBB@DD@@@B@@B@D@DB@@@@BA@@B@BAB@B@B@B@@ABABCB@BA@@BA@@@A@C@ABA@A@@B@@@B@@BBBD@B@BBD@@@@@DE@@@@B@HC@@DBFBB@B@FAB@@ABABABA@C@E@@DAB@@ADCB@B@@@DADAB@@ABCDABA@ABA@AA@@ABBB@BD@BB@@@DBBBDBB@@BB@@BB@BBB@BB@@DBB@BBHDDB@@BBD@BB@@F@@@B@BB@@DB@BB@BBBB@B@BAF@@@B@D@BB@@B@B@B@@@D@@AB@@@BBB@B@@@F@@@BB@BB@BAF@@@BC@ABAB@B@@BFBB@D@D@D@@@B@@ABBB@B@@@B@@BB@B@B@@@BA@@BBB@@@BAB@F@@@B@@AB@@@BB@@@BBB@DB@@@B@@@B@B@B@@@BED@B@@@B@BB@@BBD@D@BAD@@@BFDD@@@BBB@@BB@B@BAB@BAB@@@DBD@DAB@@A@@@A@C@ABAB@@A@@BAD@D@BA@@B@@B@@BBD@@@B@DAB@@BB@@@BA@@BA@@BBB@B@@@@@B@@ABBB@@@D@D@B@@@BA@@B@BA@@BA@@@@BB@@BBB@D@B@DADBB@@@B@B@@@BA@@BAB@@@BA@@BABAB@B@B@BB@@@B@BBB@@BBBBB@@@B@DBD@@@@@BAB@BA@BB@@B@@@DBB@@@B@@D@BBB@@@B@@BB@BBB@@@B@D@B@@AB@@A@@B@B@B@@@B@B@BA@@B@BAB@B@@@D@BBDAB@@AB@B@BBD@B@B@@@B@DB@@B@BAB@B@BA@ABABA@@B@@@B@@A@CB@@ABA@A@@BA@@B@BA@AB@@A@@@A@@@@@C@@BA@@B@B@BA@@@A@ABA@@@A@A@A@A@ABA@@@A@C@C@ABA@A@A@@BC@AB@B@BAB@B@B@DAB@BABBB@BAB@B@BA@AB@B@@@@AB@B@LEBABA@A@@@@BBB@@AB@@B@@B@DA@@B@@@DBB@BA@@BA@AB@@@@A@@@ABA@BB@@@BB@@DB@@D@@@B@B@@BBBBBB@@B@@@BBBBAB@B@B@@@BAB@B@@ADA@@BBB@B@B@@@@AB@@A@@@A@A@@@@@ABC@C@A@@@ABAB@BAB@B@@AB@@@@AAA@A@@A@AACAA@@@AAC@C@@AAAAA@AAACAAAAA@@AA@C@@A@@A@E@A@@@@@AAA@@ACAA@A@@@@DAB@FADAD@DAD@B@@A@@@A@ABA@A@A@@@AB@BA@@A@@@AA@AAA@@@A@@BC@AAA@AB@BC@A@@@A@@@A@A@@BEBCBA@AB@@ABA@@@@D@@@DC@@@A@AAC@@@@C@A@AAAAAAAAAA@A@A@A@@@A@A@@BAA@AACA@AAAAAAA@AAA@AAABC@@@A@ABC@AB@@AB@BC@CB@@A@@@A@AB@@@DA@@B@@@BA@A@@B@@ABE@A@EB@@AAAAA@@@A@@BA@AA@@A@@@AA@A@AAC@@ACAAAAAA@AAA@@@CE@A@A@@EC@@CA@@A@A@@@CAC@@AAAA@@@@@@A@A@A@A@C@A@@@@@AAA@C@@C@CA@@AC@A@A@A@A@@BA@AB@B@@@@A@@BA@@BAB@BAB@@@@@AA@@@A@A@A@A@ABADA@@@A@A@@@@BA@A@AA@@C@@BC@ABA@A@ABA@@BC@AB@@CBA@@AA@AC@AA@@@@@ABA@A@@B@@A@@@@BA@CB@@@DABA@ABC@@@CBA@@D@@@BA@A@@@A@C@A@ABA@@AAA@AA@AAAAA@@AAAC@@@@CBEBCBABA@AA@@AAAAACAC@@AABA@AAAAC@@@AAA@CAAAA@A@@A@CA@@CC@AAA@@BC@A@@BA@@BA@CB@@@B@BA@@@AB@@C@@@AB@@A@AA@@A@@BA@@D@@@@AB@B@@AB@@@@ABA@@@@@AA@@A@AAA@C@A@@BA@ABA@AB@B@@AB@BABAD@@A@ABA@@@@B@@A@@BA@A@ABAB@@@BCDA@A@@BABABA@@DAB@DA@@B@@BBA@@B@@@FC@@BCBAB@BC@@B@B@BBB@@@B@@@B@@ABC@@@A@C@@@A@A@@@A@@@A@A@@BAAA@@@AAAAA@@BCA@@@@@GDCBAB@@@AE@ECC@@@AA@AAA@@ABC@A@A@A@@@AAA@@A@A@ABC@@AGBE@CBA@A@A@@AABABABE@@B@@@@@@A@@@A@@@A@@@AA@AAAA@A@@A@@@A@A@@AA@@@AAA@AAA@A@AAAAAAA@AA@A@A@CAC@@A@E@EBAB@@@BBB@BBBBBBB@@@@@BABA@@@CA@@AB@@@@BB@@@B@@A@@B@@@B@B@D@B@DA@@ACC@AE@A@@@AA@@A@A@A@CBG@C@C@A@@CA@@@@A@@@@@A@@AC@A@@@AAAA@@AAAAC@@@@MBA@@BEBABAB@BABAB@@AB@@A@C@@@CA@@@A@@AAA@E@@@ABA@AB@@@B@@A@AAC@AAA@@A@A@A@@CA@A@@AE@AAAA@EA@@A@A@ABA@C@A@I@A@@@A@@@@B@B@@@BAB@@@B@@@BA@A@@@ABA@E@@@A@A@@@A@CA@@AAAA@@A@A@CAAB@@@@ABA@A@ABC@@@A@@BAF@B@@B@@B@@@@EBA@A@A@@BA@A@A@@@A@A@@B@@@BAB@@A@A@C@@@A@AB@@C@@@AD@@A@@@A@@A@AA@@AEA@@A@@@CD@@CA@@A@ABA@@@ABABA@C@@BEB@BC@@BAD@@ABA@A@CB@B@@@B@BA@@@ABCB@@@B@@BB@B@@A@CBA@A@@BA@@BCB@@@@EAC@A@CBC@@@A@@@@@C@@@A@A@EACACA@@@@@A@C@@AC@AAA@AA@@AA@CB@@A@AD@@@@A@@AA@E@@ACAC@@@AAC@A@@@A@@BA@AB@@C@@@CACA@@@@CB@@A@C@ABABA@A@ABCB@@@@@DAB@@@BA@@@A@E@A@@B@@ADA@@B@BA@@B@@@B@BAB@BAD@@BB@B@@@DA@@@AB@@@@ABA@AB@@A@BB@@@B@BA@@B@B@@@@BB@D@@B@B@@B@B@BBDBB@@@B@@@BA@A@AB@@CFAB@B@@A@ABA@CDA@@@@B@@DD@B@B@D@@A@CDA@AB@@@BABA@A@@@ABA@CB@@@@CF@D@B@B@@@BCBA@CBC@GA@@A@A@CBED@BCD@@A@@@CAA@A@@BA@@BCACB@@E@A@ABA@ADA@BBADAB@@ABAB@@@BA@@B@BABA@C@A@@BA@CFA@AB@BAD@B@BABA@A@A@A@A@@@@B@@@B@B@@@@@BABAB@@@B@B@@A@CAA@CC@@E@AAC@@AA@@@AA@AA@@AAAEAAAA@I@@@CAA@@A@@AA@CAA@AAAAAA@AAA@AA@AA@ABA@@@A@A@A@A@ABCBEDA@ABC@A@AB@BA@CBA@@@AB@@AB@BABABABABEB@@A@AD@@A@CA@A@AAGAAC@E@A@C@A@CBA@A@@BAB@@@BA@@@AAA@CA@@A@EBA@E@A@E@C@@@A@@@I@AAA@AAA@E@A@AAA@@@A@A@@@AB@@ABABA@AB@@C@A@@@@@@BAB@BABA@A@A@@BA@@@@BAB@BCBCD@BA@ABABA@A@A@@@CB@@@B@DBB@@AB@B@@BDBDBHBB@@@B@BBBBBB@@BAB@@ED@B@B@@@BBBA@@B@@@@A@C@A@@@@@@AAAAAA@CAA@AAA@C@@@AAABC@@@A@AB@B@@A@@BA@@BC@ABA@CD@@A@CBEFGBABABA@AB@BA@CD@BC@A@A@A@KEA@CAA@AA@@A@@@CBA@C@@@A@@D@@A@@@A@@@AA@BA@@@A@A@AA@@@BAD@@IJABCFABAB@@A@@@@@@@@D@BBD@B@B@@A@ABCBCBAB@@CB@@C@A@C@EA@@C@@@A@A@@BA@A@@BCBEB@@A@@@CA@AA@@@C@A@A@@@E@EAAAABA@A@CD@@@@AB@B@@A@@@@@@A@@@@A@A@CAA@A@A@AB@@C@CBA@@B@@A@@@A@E@A@EBE@ABA@ABE@ABABEB@@A@C@CAEB@@@@@@@D@HB@@DDD@@@BCBABA@A@A@A@@BABAB@D@BABA@CDC@@@AB@BA@@@BB@B@B@@BB@@B@@D@B@@BBB@AB@@@@@BA@AB@@BF@B@B@BBBBB@@F@@@B@@AB@B@B@BAB@B@BA@AB@@AB@DABA@A@@B@B@@@B@D@B@@@B@B@@@@@B@@BFD@@@BBD@@@@BB@@@BA@@B@BB@@B@@ABB@@B@@@B@B@B@@@B@@B@BB@@@B@@@B@@@DA@@@@B@@B@@@DB@@BB@@@BBD@@BBA@@@FFDD@@B@@BAB@BAB@@@B@BBDBB@BB@@FB@@@B@@BAB@@@B@B@@AB@@BBA@@B@B@B@BBB@@DB@@DB@B@B@@BD@@@B@@A@@@AB@@@BADCB@B@@A@@@@BABA@ABA@AB@BA@@B@B@@AB@D@@@BB@@B@BABA@AB@@A@ABA@A@A@A@AB@@AAA@A@@@ABABABA@AB@BA@AB@@C@@B@@AB@B@B@B@BA@A@@@ABAB@BCD@B@@AAAA@@AAA@ABC@CAA@A@CA@@A@C@A@@BC@@@AAA@A@@@C@A@A@ABC@AB@@@@@BA@@@C@C@AAA@AA@@AAAAA@CAA@@BAB@@C@CAA@AA@@CBC@@B@@C@AB@@AB@@CA@@CB@@CACA@@A@ABA@@@A@A@@@C@@@C@ABAAA@@B@@@BAB@@AB@@@D@@ABCB@@BBHB@@B@@B@@@@@D@BBB@B@@BB@B@@AF@B@@A@CB@B@@@B@B@@B@@@BBB@B@@@@B@@@@@B@@BBD@@BB@@@@BABAB@@@B@@@@BB@B@@@@@@@@B@B@@@B@@@B@@@@B@BB@@DBB@BB@B@BBBBB@B@BBB@@@@B@@@BBB@@B@@AB@DA@AF@B@B@B@BC@@B@@@@@B@BBB@D@@@B@BBB@BB@@B@@@@@@B@@@@BB@@B@B@D@B@@DDFBB@@AB@@B@B@B@B@B@B@D@BB@@BB@@D@B@@@BB@BB@@@BB@D@@@@HD@@BABB@@@@BB@@DDB@BBB@B@@@BDBB@B@B@B@B@BB@@B@D@D@BABB@@D@B@@B@BB@@BF@@@@@@B@BBB@@@B@BB@A@@B@@@@ABA@@B@H@@@B@FCB@@@B@@@FB@@@BB@@@B@BA@@@@FBB@B@BBB@DBB@@BB@@BB@F@DBDBBB@@BDDBB@@BBAB@B@D@DABAB@BA@@@@FBF@@BB@DBBB@@B@D@D@@@DBBB@@@DB@@BB@B@DB@@B@@@B@@@F@BAB@BBB@B@B@FDF@@BBBBBAB@BBBBB@@D@BB@@B@@BB@B@B@BBB@DD@BDD@@D@BFB@B@BB@@BD@@BB@BBHBB@@BBDBBFB@AB@BBB@BB@BBBBBBB@@@@@DAB@@@@@DABA@AB@D@D@@@BAJEB@@@@A@G@@@A@@@@BAD@BABAB@B@BBD@FBBBB@D@B@BB@@BB@@BCD@DABA@@B@@AB@@AB@B@@@BB@@HBD@B@B@B@BAD@BA@@BA@ABA@AB@@@B@BADABAB@@@AE@A@AAAB@@ABC@A@A@AAA@@@A@@BA@ABA@A@A@A@A@AB@@AB@B@@@BA@@B@@@BBBB@@B@B@D@B@B@@ABAFA@@BADCB@@@"]],encodeOffsets:[[[111779,34625]],[[111759,34685]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
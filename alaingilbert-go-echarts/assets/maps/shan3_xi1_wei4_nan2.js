@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("渭南",{type:"FeatureCollection",features:[{type:"Feature",id:"610522",properties:{name:"潼关县",cp:[110.246349,34.544296],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@AA@@@@@@@AB@@@@@@A@@@@@@@@@@BA@@@@@@@@@AB@@@B@BA@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@B@@@@A@@@@@@@@B@@@@A@@@@@@@@@@@AB@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@B@@@@@@A@A@@@@@@AA@@@@@@@@@@@@@@@A@@@A@@B@BA@@@@@A@@@@@A@@BA@@@@@@@@B@BA@@@@@@BA@@@@@@@@@@@A@@@@@@@@@@B@@A@@@@@@@ABA@@@@@@@A@@B@@A@@@@@@@@@AB@@@@@B@@@@A@@@@B@@@@@@@BA@@@@@@@@B@@@@@@@@@@@@@BAB@@@@@@A@@@A@@@@@@@@@A@@@@@@@@@A@A@@@@@@@@@A@@@@@@@@@@@@@A@@@@B@@A@@B@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@B@@@@@B@@@@@@@@@@AB@@@@@@@@@@@@@@A@@@@@@@@@@@A@@B@@@@@@@AA@@@@@@@@B@@@@A@@@@B@@@BC@A@@@@@A@@@@@@@@@@@A@@@@A@@@@@@A@@@@@@@@@A@@@@@@@A@@@@A@@A@@B@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@AA@@@@@A@@@@@@@@@B@@@@@@A@@@@B@@@@A@@B@@@@@@@@A@@@@@@A@@A@@@@@@B@@AB@@@@AB@@@@@@@@@BA@@@@B@@@B@@A@@@@B@@@@@@@@A@AA@@A@A@@@CBCFCB@VKLG@A@@A@AAC@A@@@A@CAA@A@A@@@A@@@AAA@A@A@@@C@@@CD@@AA@@CBA@C@AB@@E@CBA@@@C@@@CAA@@@EACAA@C@@@@@AC@A@AA@@A@A@@A@AAA@AAAAA@A@A@@@ABA@A@A@A@C@@@A@@@C@@@@@A@@@A@A@A@A@A@AA@@CAA@A@@@@BA@@BC@@@A@A@AAA@CA@@AA@@A@@BA@A@ABA@@@AAAA@AC@A@@BA@CAC@@@C@@BA@A@A@@@C@@BA@AB@@@@A@@B@BA@@@AAAAEACA@@@@CCAA@@A@A@A@@@A@@@A@A@A@A@A@AAA@@@@@A@@@ABC@@@A@A@@@AA@@A@C@A@@@A@@@A@A@AAA@A@@@ABA@@BA@A@C@CD@B@@A@@@@BA@CBABAB@@C@@@A@ABA@A@@@ABAB@@CB@@C@A@ABGBCBA@AA@@@@C@A@@@AAA@A@A@A@ABA@@@A@@@@@A@A@@@AB@@A@@@@AA@@BA@@@A@@@AB@@A@@@@@A@ADA@A@A@A@@@@@ABAB@@@BA@@B@@CAE@@@AB@@A@CAAAABA@A@A@@@A@AB@@@@A@@@ABA@AB@@A@C@A@@@AAA@@@@@@@@@CBA@CDA@ABA@A@@@ABA@A@@@AB@@A@@@A@A@@@@BAB@DABAB@@A@CBC@@@A@A@A@@BA@AB@@AD@@@@DD@@@@@BB@B@BB@@BD@B@@@D@B@BB@BBB@B@B@B@BBBBDB@@BB@@@@D@@B@@@B@B@@BBBB@BDB@@@@BBBD@B@@@B@@@@BBB@B@BBB@B@BB@@@BBBBB@@AFAB@@@BA@ABA@ADC@ABAB@@@@@B@@@@AD@@ABB@@B@BBB@@@D@@@B@@BBA@@D@@@B@@B@ABBB@B@@@@BB@B@B@B@@@B@@DF@@BDB@@BD@BB@@BB@@@D@BD@BBBBBB@B@@@@A@AA@@@@@@BB@@@@A@A@@@@@BBB@@B@@@@A@AAGC@@A@@@BB@@@B@@@B@@B@B@@@@BB@@@@B@@@B@BB@@@@@BB@@B@B@@@B@BAB@@@B@@@B@@@BBB@@@@@@BB@DBDHBB@@@@@BA@@BB@@@A@@@AA@@@@@@@@@B@@@@@@A@@@@@@@@BB@B@@B@@A@@@E@E@@@AB@B@D@H@DBB@B@@@D@B@D@@AB@DB@@D@B@B@B@@@D@D@@BB@@BB@@@@@@A@@BB@@@B@@BA@@B@@ABA@@@@@@@ABAA@@AA@@A@@@@@@CAA@@@@@D@@@B@@@B@B@B@@@@A@AAAB@@@@@@@B@@@@@B@@@@@BAA@@A@@@@@AB@B@BAB@@AB@@@BA@@@AB@BAB@@@@A@@B@@B@@@@@D@@BBB@@AB@@@B@@BBB@@@@B@@@B@B@@C@A@@B@@A@@@@@@BA@@BA@@B@@@D@DDF@@BBB@B@@@@@@@@@AB@@@B@@@BBBBBB@DFBD@@@@@@@BA@A@A@@@A@@@B@@BA@@@@@BBB@@@@BAB@B@@A@A@A@@@AC@@A@@A@@@A@@@A@@@@@A@@@@A@A@A@@@@@A@@@A@A@ADA@CB@@@@@B@@B@@@@B@@@BA@@@@HD@@B@@@@AD@B@BB@AB@@A@@@@@AF@D@@@H@B@B@BB@@B@@BLAB@BEEAAA@AAA@AB@@ADAH@D@B@BAB@BL@j@AGCIN@T@F@`@d@TJDETYTQLGHCFCHCRCD@@@HAZ@TAf@HAPAbIGSAA@CGWGK@C@@@A@@HCLCF@PAP@HBFDDB@@@@BA@G@C@CEAGAMEEAOICAA@@@A@C@CA@C@@AA@C@ACACACCACCG@AAA@A@C@A@A@@AA@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@A@@B@@@@A@A@@@@B@@@@@@AB@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@A@@B@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@AB@@@@@@@@@@@A@@A@@@@B@@@@@@A@@@@@@@@@@@A@@@@BC@ABA"],encodeOffsets:[[113017,35326]]}},{type:"Feature",id:"610502",properties:{name:"临渭区",cp:[109.510175,34.499314],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AA@@AAAAAAEA@AA@AA@@@@A@A@A@A@EBA@ABA@CB@@@@@@@@A@@@A@@@@@A@@@@@@@@@BA@@A@@B@@@@CAA@@@A@AB@A@@@@@@@@@@AA@@@@@@@AA@A@@@@@AAE@AB@@@@AB@@AB@@@@@@B@@B@@@@@DA@@AA@@BAB@@@@@BB@@@@BABBB@@AB@@@@AC@@C@@@@BA@@@@@@@BA@@AA@@AB@@A@@@AB@BA@@@@B@@A@@@@@@A@@@@BA@AA@A@A@@@E@EBA@A@CDAB@ABA@@@@@@A@A@@@@@A@A@@B@B@@@@A@@AA@@BA@@@@@@@@@BB@B@@B@@@B@@@@B@@A@ABA@@B@B@@AB@BAB@B@@CHAB@@A@@@@@@@@B@B@BABA@DBABAAA@BB@BA@A@A@AAAB@@A@@B@@ABB@@DA@@@A@BFA@@A@CA@@BAABD@D@@AA@AA@@AA@AAA@BBC@A@AB@@ABA@G@C@@@CB@@A@@@@@A@@@BB@@@@@@B@ABA@@B@@@@@@BB@BB@@BA@@@@@@@@B@@@@@BB@@@@@@@@BA@B@@@@@@B@@@@B@@B@@A@B@@B@B@@A@@@@B@@A@@@ABA@@@A@@@BB@@@@@B@@@B@@A@@@@@@@ABB@@@@B@@@@A@@@@@@B@@@@@@B@@@AB@@@@A@@@@@@@@@@BB@A@@@@B@@@@@@@@ABB@B@@B@@@@@@@B@@@@AB@@B@A@@BA@B@@B@@@@@B@@@@BB@@A@A@@@@B@@@@@@B@@@B@B@A@@B@@A@@B@@B@B@@@@@@@@@AB@@@@@@B@@B@@@@@@@@A@@@@@@B@@@@BB@@B@@@@B@B@@@BA@@@@@DB@@@@A@@@@B@@@@@B@@@@@BB@@@@@A@@@@B@@B@@@B@@BB@@@AB@@@@BB@@@@@B@@@@@@@@BB@BBB@@A@@@@@@@@@A@BB@@@BA@@@@@@@A@@@@@@@@B@@A@@@B@@@@B@@A@@@@D@@B@@@@B@@@@@@A@BB@@@B@@B@@BB@@@B@ABB@AB@@@@@B@@B@@@B@BD@@@@@B@B@@@BA@A@@B@@BB@@B@B@@B@@@@ABBDA@A@@@@BA@@@B@B@B@@@@@@@@BB@@BA@A@@@@B@@BBBB@@B@@@@B@B@B@@B@@@@B@B@@A@@@@@A@A@@@@A@@@@A@@@@@@B@@@@AB@@@BB@@BA@@B@@@B@@@@@@D@B@@@B@@BA@A@AB@@AB@@B@@@@@@@A@@B@@A@C@C@CBC@C@GB@@BB@DEB@B@BBB@BB@@B@@@B@D@DAB@BCDA@@BA@A@A@CBC@A@@@@B@@@@@@A@@@A@@@@@A@A@A@@B@@ABA@@BABABABAB@F@DBB@BBB@B@@ADA@@@@BA@AB@@BB@@B@BBB@@@@BAB@BCB@BA@@B@@BH@BAB@@ABA@ADA@ABA@@@@BBD@BD@@BBFE@@CA@@A@@ADABAB@@@@AA@@@@@B@@@BA@@@CB@@ABA@E@E@CAAB@@@B@B@@@@BBAB@@@@A@A@@BD@AB@@@@A@@@@A@@A@A@A@@A@@@@@@@@AB@B@@AA@@BAA@A@@@@@A@@@@@@@@D@BBBAH@@@@AD@@D@@@@B@B@@A@@FFFBB@BBBBBCB@BBD@@D@F@@B@D@DB@@@B@B@H@B@@@HA@B@@BDAB@BBBC@@@@DCBACEB@C@@@@A@A@@B@BKBAE@@@F@@AE@@@E@B@DA@@C@@@DA@BJ@DBD@@BBA@@@@DG@@CA@@DB@BH@@A@BFA@@B@B@@AC@DA@ABB@@B@@@@@B@@A@@B@@@B@@@@B@B@@@B@@@@@A@@@@BABB@@@@@BA@@B@BBBBBAB@BB@@@@A@A@@@B@B@D@B@D@HBBBF@HBD@B@@@DBFBBBBBBBFF@BBA@GDB@BADFBDB@@BEJ@@FB@@CB@@HB@@GD@AH@BB@BI@@AJ@@F@BG@@B@AHHB@H@B@@AD@B@@@HCA@FAF@F@@@FCA@DCAAFA@@FD@AFC@AHAJ@FB@@DABB@@FC@@BAJC@ADI@AFA@@FF@@F@@B@@F@BB@@BD@@B@BA@B@@F@@@D@@BB@BADB@@@@@@DG@@FA@AF@@BB@DA@@BABA@@@@@@BE@@@@B@DB@@B@DF@ALD@@DAHA@AH@D@@A@AL@B@DE@@F@F@@D@@B@DFBF@F@@B@D@@KAAFC@@@@D@LB@AH@FC@@F@FH@AFF@BCB@B@F@ABB@AJE@@BADA@AD@@A@@@A@K@@FEA@F@D@BHB@@@B@B@BAFE@@DE@@BA@@D@@@@@B@DB@ALA@ALA@@FD@@FBBL@@@@@@D@B@@PB@@B@H@@@@CB@P@BB@@B@@@@DA@BBB@@@@C@@BB@B@@B@B@DDF@JB@@B@BB@BB@@@@DBBTFFB@C@A@@B@B@@@@B@D@@B@@C@@H@B@@D@@@B@@B@@@@D@BD@@@@@AF@BB@@@@BH@@@@A@@L@HB@@@@@A@@B@@@BC@A@@F@@@BC@@H@@@B@@B@@B@@AB@AB@BBB@ABC@@@@@@@@B@@@@@B@BBBED@@FB@@EPB@EJ@@@BCB@@AB@@@@@@ABC@B@DF@@CB@AB@BB@@@@CD@@@@FB@@I@AF@LBAD@@B@@CD@BEA@BEC@@C@E@@B@@AH@AFD@@DH@@CBB@ABG@EB@BBBEF@@FH@@@@@BC@ARB@AF@@CDB@E@@D@BENB@FF@@EH@@AD@B@PB@@@DB@ABHB@BAFI@@F@@AD@BA@@@@FF@D@B@B@@@HB@EJ@JB@FD@ALPB@DD@D@@DB@@D@BF@@FTBAFB@@@@CBA@@@CAA@@BA@@BCB@@@@@NBD@@F@@BE@I@ABAB@JBBCD@BEFB@D@@D@@CD@F@AFH@@D@BHBF@@ED@@EF@@A@AN@LB@@D@@@@@D@FB@FJ@@@JB@FF@@C@AD@@F@@DBH@AFF@@B@FB@@FD@@F@@F@@@@ENB@A@@B@@CNB@A@@AA@@AA@A@A@@BA@@B@@@@@@@BB@@@B@@@@B@@@B@@A@C@CBCA@G@EAA@@AA@@B@@A@@A@@@@@A@@B@@@@@AE@A@AAA@A@C@AA@@A@@@@F@BEDB@C@A@AA@BE@C@A@C@AEA@CGAA@A@@B@BA@@@@A@AA@AA@A@A@@@@@CAA@A@@@C@A@@BB@@@D@@@@@@@ABC@C@AE@A@BA@A@A@@AA@CACA@A@@ECIAEAECKEU@@AC@AACAC@A@CA@@AA@@A@@@AA@@A@@@A@@@@@@DA@@@@AA@@AC@A@CAA@A@@AA@@AA@@@@@@A@@@@B@D@@@@A@@@@@@A@@@A@AAA@@C@A@@@A@@AC@C@A@BA@AB@B@FBF@@@@AA@C@@@A@AA@@@A@AA@@ED@B@@@B@@@@A@A@@B@@@@B@BB@B@@@B@@AA@@@@A@@@A@@B@@A@@@@AAA@@@@AB@@@@@B@@@BA@@@A@@@A@A@@@@@@A@@@AD@@@@@A@@@A@A@@A@@A@@@A@@@@C@A@@@@AB@@@@@BA@@B@@@@A@A@@@@A@C@A@A@@AB@@@B@B@B@@@@A@@@A@@@AA@A@E@@A@@@@@@@@D@B@B@BA@@@@BEBB@A@@@AC@C@@@@AA@@@AAC@A@@@@@@A@@BA@AA@@@@@@A@@BA@@B@F@D@@@@@@C@G@@@@A@@B@B@@AAAA@@@A@A@@@A@@AAAA@@@@A@AA@@@@@E@@@ABEA@B@@@B@B@B@@B@@B@@C@C@@A@@A@C@A@@@@A@A@@@@A@@@A@E@@B@BA@@@AB@B@@@@A@A@C@@@A@@@A@@AA@AA@@@@@@@C@AAA@@ACAEEAC@@@A@AGAAAA@CBEBEBC@A@A@IEC@@BA@AB@B@BABABA@@@A@AACAEAAAE@A@A@EACACAA@@@A@CDC@A@GAE@GCGE@AAA@ABAB@BAFABABABA@E@ACECCEGAA@CAA@@EAEEAC@AAA@ABA@CBCBC@C@@@AB@BC@@BA@@@AA@AAAA@@@A@@DAB@@@BAAA@@A@AA@@@C@@@A@@B@@A@CB@@AB@@AB@@A@ABA@A@@@@@A@@@A@ABA@@@ABA@@@CAA@@@@@A@@@@B@@A@@@A@@B@@@@@@C@AAC@C@@@A@@@AA@@@A@A@A@A@A@CA@@A@@B@@@@A@A@B@@@A@@@AA@@A@@B@@@@@@AA@@A@@@@@@B@@@@@@A@@@@@A@@AA@@@AA@@@A@@@AB@@A@@@@@AA@A@@@@@@AB@@A@@@@@AA@@A@@@@@A@@A@A@ABAB@@A@@A@@@@AA@@@AAA@A@@@AAA@@BC@AA@@AAA@A@A@A@@BA@@@AAA@@@AB@BCB@@AA@AA@@BA@@@A@@AAAB@A@@ACA@AC@@BA@A@@@@A@AA@@@A@CB@@@@A@@@C@@A@AA@@@@@C@@@@BA@@@@BA@A@C@@AA@@AAA@@AA@@A@@BC@A@@A@AC@CA@@C@A@G@A@@@AAA@AAABE@A@@BC@@BA@A@@BA@@DA@@@@@A@CAA@A@@@A@@@@B@BA@@@A@@A@@ACA@A@@@@B@@@@@B@@AB@@@@A@C@A@AA@@@@A@A@CBABA@@BA@@BC@ADCDC@A@@B@BABA@@BABCDE@A@ABCBABA@@D@@@BB@@@@B@BA@@B@B@B@@A@AAA@A@@BA@A@@A@@@@AB@@@@@AA@@@@@@@@B@B@@@@A@@@@A@@@@@BABB@A@@@@AA@A@@BCBADCB@@ABA@@@A@C@A@A@@@@@CBA@A@@@@AA@@A@@@CB@@A@A@E@@@@ABA@@@@@AAC@A@A@@@A@A@@@A@@ECAACCAC@@BCDABABA@@BBB@BBDB@@@BBH@BAFBB@BDBHD@@@@@@BAA@AACE@A@@BAB@BA@@@A@@AA@A@@@AD@B@BA@@@A@@BCB@D@DAD@BBB@BA@@D@BA@@DABAB@BAB@DAFCDC@ABA@A@CB@@ABEBC@A@@AC@AA@@A@A@A@@BC@A@A@@@ACA@@@ABCBEBA@@@CBA@ABA@ABA@A@@BA@A@ABAB@BC@A@@FA@@BA@CB@@EA@@@CAAACA@@@@AACA@@A@A@A@C@A@@@CAA@C@A@ABA@@BA@CBCD@@@BA@ABABC@CBA@A@A@C@AAA@@@AAC@A@@@AAC@AAAA@@AA@@AA@@AAA@EBC@@@A@A@A@ABA@CBC@ABA@@BC@ABABABAB@@A@A@ABEBA@A@ABE@ABA@AB@@ABCB@@ABA@C@@@CBA@A@EDA@AB@@A@@@A@@@A@@@@BAB@BA@A@@@C@AB@@CAC@A@@AA@A@@@A@ABA@A@@BA@ABA@A@@@"],encodeOffsets:[[112190,35086]]}},{type:"Feature",id:"610503",properties:{name:"华州区",cp:[109.775247,34.495915],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@AAA@AAA@AA@AE@AC@@AECA@CA@@AA@A@@BA@CB@@@B@BA@@BA@@@A@A@ABA@A@@@AAA@@@A@AB@@@DA@A@@@A@@@ABA@AB@B@BA@@@@@A@@@A@C@@@AE@CBABA@ABA@AA@@ABA@A@@B@@@B@@@@@@CBAB@@@BA@@B@BAB@BAD@@CD@DAD@B@@@BCD@BA@AA@@@@A@C@AB@@EA@@EBABAB@@A@EBA@A@A@E@C@@@AB@@A@CBA@AAA@AB@AA@@BA@ABIB@@A@@FA@@DAB@@EB@@@BADA@AB@B@BAB@@@BAB@BAB@BAB@D@@ABAFAD@B@@DB@B@@@B@BAD@@@B@B@BB@@BBD@@@BADAF@BA@@D@BAB@BCDEDCBA@ABA@ABCB@@ABC@@@ABA@AAC@CBC@A@AD@@@B@@ABA@C@@B@@@BBB@@@B@@ABA@AB@@@BDFBBB@AB@@@@@@GCCA@AAABE@AAG@A@@CAAAA@AA@@ABABCBAD@@BDDDBBFD@@@B@@@B@B@@@B@BBD@B@@@@AB@B@@F@B@B@@@DA@@B@@@BB@@@@@BAB@D@@@@@B@B@D@B@@AB@BA@CDABAD@@@BBB@@@@@BAAAB@@@@B@@@@@@B@@A@A@@@@@@@@@BB@@@@A@@B@@B@@@@BAB@@@BBB@B@BA@A@A@@@ABA@@@@@AA@@C@@@ABABAD@B@BCFADAB@@ABABA@@@@BCDCD@BAD@@AB@@ABAB@D@B@B@@B@@B@B@D@B@@A@@BA@@@@@A@@@@@@BDB@BB@@@@B@@ABA@@@@@@B@@@BBB@D@B@@@@CB@@AB@@@BAB@@AD@@@BAFBB@BBB@B@@@B@H@B@DB@@DBDB@@@@BAD@@@BB@@BB@BB@@BB@@@D@BAB@@@@AB@@@@@D@@@@BBB@@@@D@@@B@@A@@D@B@@BBB@@@@@@BAB@@BDB@BD@@@BBABB@@@B@@AB@@BBB@@BA@ADA@@B@@BB@B@@AB@@@B@B@BBB@BB@@BAD@@BB@B@@@BBB@B@@BB@@@@@BB@@@BABAB@B@@@@B@@@@@BB@@B@@@@@BA@@B@@@@B@B@@B@@@@@BA@@B@@@B@@BB@@B@@BB@@@@@B@@@@@@@@A@@@@B@@@BB@@@@@@@AB@@@BB@@B@@@A@B@B@@@@@@AB@@@DBB@B@B@B@B@@@B@@B@@@B@@@DBD@B@D@@@@A@@@@B@@@BA@@@@@@B@@@@BB@D@@AB@B@@AB@B@B@@@B@@@@@BAB@B@BA@@BA@@BA@@D@BA@@@@B@@@D@@BBB@@@BBAB@@A@CB@@@B@@BBBBB@@B@@AB@@ADA@@B@@@DADAD@DAB@BBB@BBDFFFB@@BB@DBBFHDDDF@B@FABABABEBABA@AB@BBB@BHFHDF@HBB@D@DCB@@@B@DBDBFBB@B@F@BBFBDBBBB@@@B@BABA@A@ABAB@@AD@JFB@B@D@FAFADAB@BBHBLDDBB@B@DAB@@C@C@G@@@ABABAB@@@D@D@F@D@F@DBB@@BDDBD@D@D@BABCDAB@BAB@@@BBDBB@BB@D@B@BBBAB@FAHCBAB@B@FEBAB@DA@BB@@@BB@DBF@DBF@F@B@BAJ@DABBB@@B@BBB@B@DAD@D@B@FBB@BAB@B@FCLEDAD@B@B@B@BB@B@@BDBJB@BBB@B@BABABABABC@ABCB@D@F@HBD@@AB@B@@CBA@CBA@AB@B@D@B@DDBBFHDDFFDDBBB@@B@@ABCFA@@@@BB@B@DBD@DBF@B@B@B@BADCBCBC@ABI@ADEFEDCFGDCDC@@B@B@B@FDDBHDDBFDBBBD@B@B@BABAB@@A@A@C@C@EAAAABA@A@@B@@@B@BBBFFBBDDDFDB@@B@@@B@BABA@G@G@GDW@MBC@CAA@A@@@A@@@EBA@ADG@A@ABAAAAEAE@AAA@@@A@C@C@AC@BAC@@@@@@AB@BCB@BACA@@@@@C@A@@@A@A@@@A@A@@BABCB@@AA@@A@@@C@AB@@@@A@A@@CAA@@A@@@A@@@A@A@AB@@A@@@A@C@@@A@A@@@@@A@ABA@@@AB@@A@@AA@AB@@AAA@@AA@@BA@C@A@@AA@AA@@@@A@@BC@@@ABA@AB@@AAGAA@A@@@@B@@A@ABA@@@C@A@ABA@A@@@AB@BA@@@A@@BAAC@AB@@A@@@C@@@ADA@@@EBABA@@B@DA@@BA@CBC@@@ABA@ABAB@@@B@B@B@DAB@B@B@BB@@@@@B@@B@@BBB@@DBB@LDDABBB@BB@BDAH@F@@A@ABA@@@AB@BB@@B@@@B@@@B@@ABABA@AB@@AB@B@@@@@B@BADBBAB@@@BABC@AB@BCB@@A@A@ADA@A@@@@A@CA@@A@AA@@A@A@CBCAA@@@CBA@A@AACA@@@@CBA@A@A@GAAAA@@A@ABA@AB@@@@@BBB@@@@@@A@@AC@@@@BAB@@@@A@@BC@ABA@@@AA@@AE@@AA@CCAAAAAAAACCAA@A@AA@@CBABA@AA@@@A@A@EAE@AA@@@@AA@AAAAAAAAAA@AAA@A@@@AA@@AAA@@@A@@A@CA@@A@@BABA@AB@@A@A@@@AB@ACBA@AACAA@A@@DADAB@B@DBD@B@DB@@B@B@@@BAB@B@BADA@@B@BB@@DBB@@@BB@@B@BAB@B@FAD@@@DB@@@BB@@@B@BBDBD@BBB@B@B@DBB@@@BAB@@AB@@@@@BAD@@AB@@C@@B@@A@AD@B@D@B@BAB@BADA@@@@@@BCB@D@B@@@BA@@@AB@@AD@B@BA@@BBDDD@@@F@BA@@B@B@@@@A@C@@DA@@@AB@BA@@@A@@@A@@@ABABC@A@@@AD@BA@@BABABA@@@@@A@A@@@@@@@A@@@A@@BABA@@B@AA@ABA@@BA@A@@@A@A@@@@B@B@B@D@@ABA@@D@B@@@@A@@@A@A@@B@@AB@@A@@AA@A@ABA@AB@B@B@@AB@DA@@BA@@@A@@@A@@@A@@@A@@B@@A@A@A@@AC@A@A@@@A@@@A@C@@@@CC@@A@C@@@@@AA@@@A@A@A@@A@A@CAEB@@A@A@A@@@A@A@G@@@A@AB@BCB@@AB@BA@@@ABA@AAA@@@@@@AA@AA@@@A@@@AB@@AAAB@AEB@@A@@B@@A@@BA@@AA@ABC@@BA@@@AA@@AA@@A@EBA@AA@AA@@@@BA@@B@@AA@@@@ABABA@ABA@@DA@ABC@A@@@A@@@@AA@@BC@@@@B@BABC@A@C@A@@@A@@@A@@@A@@@@@A@A@@AA@@@A@AB@@@@A@A@@@@CA@@AAA@@A@@@@@A@AB@@@B@@@@ABA@@B@B@@@B@BABAB@@@BA@@@@@@@A@@A@@@AA@A@CA@@AA@ACA@A@A@AB@BA@A@@@C@@@A@@CAA@@AA@@A@A@ABA@@@AA@@@A@@@A@AAAAAACA@AAAAA@A@A@C@A@AB@@A@@AA@@A@@@A@AC@@@A@@ACA@A@@A@A@CA@@AAAA@@EA@@AA@@A@AAABE@AAA@A@ABAAA@@AA@@BA@@BA@A@@@CB@@@@@B@@CDEDCB@@A@@FAB@@B@@B@B@@@@BB@@BBFDB@@@@B@B@B@BBB@D@B@@@@AB@@@BAB@BB@@BB@@@@B@B@BA@AAA@A@A@C@A@A@@@CAAA@AAACA@ACAAC@@@@CB@@IAAB@@@@CA@@@@A@A@A@@@@@CA@@@@A@@A@A@@@A@AA@@@A@@@AB@BAB@@C@A@A@@@@@ADAB@B@BC@@BA@@@A@CB@@AB@@@@C@AB@@C@A@@B@@A@A@AA@B@@A@A@ABA@CA@@AB@BAAABABAB@BA@ABAB@@@BB@@B@@BD@D@B@@@BA@@@A@@B@B@BABAB@@@B@BAB@@ABA@AB@@@BBB@@AB@@AB@@@BA@A@A@A@AAA@A@CAABAAA@A@@AA@A@C@A@@@C@A@AA@A@@AB@@AB@D@@@@@@ABA@CBA@AD@@CDAB@@AB@B@B@@@B@@@@ABC@GB@@A@ABAB@BCHAB@@OEA@AAA@@AAA@@CAAACEAA@AAEAA@A@@@@@AE@G@@@CAAAGAA@AACAAA@@A@BE@C@@BA@ABE@A@C@A@A@A@ABC@@BA@@@ABCBC@A@ABA@C@@@A@A@@@@@A@A@@A@@@AC@@@@@A@@B@@A@@BADCD@@AAA@BAAABA@A@A@@B@@@@ABA@@@@BCBA@@@AB@@@@AB@@@@@@@B@@@@AB@@@@AB@@@@@BA@@@@@@B@@@BA@@@AB@@@D@@ABA@AB@BA@@@@BABA@@B@@@BAB@@@@@BAB@@AD@@ABABA@AB@@@@CAAACBE@@@C@AA@@A@@@A@A@C@A@"],encodeOffsets:[[112391,35074]]}},{type:"Feature",id:"610523",properties:{name:"大荔县",cp:[109.941734,34.797259],childNum:1},geometry:{type:"Polygon",coordinates:["@@CECCAAEEAA@A@A@@@AB@B@BABBFBD@D@B@B@@@BABA@A@A@AACAAECCAGCCAECA@A@A@@@CDCDEHCDEFCF@BAJ@BADADCDABA@A@A@E@CAC@CAA@A@@A@@B@DEBA@@@AA@AACCEECCEGAACCA@C@A@A@@BAB@DAB@DA@A@@BC@GAE@C@A@AD@BADABABABABA@A@AAA@AIAC@@@AAAA@A@A@C@CBKFEDA@A@ABA@EAA@C@C@CBA@A@AAA@@@AABA@CBI@A@A@EAE@CAE@CAA@@A@@ACBA@ABEFA@A@ABGDEBA@ABAAA@C@A@@AAAAC@A@@BA@ABADCBA@A@C@CACCC@AA@CAE@C@E@C@C@@@A@ABAB@B@@@H@D@DA@CBA@A@CAKC@B@B@@BDFFDB@BB@BBD@@@@@@@B@@BBB@@@B@@@B@@@D@B@B@@A@A@@B@@ABA@@@@F@B@@@B@@B@B@@@@@@B@D@BB@@@@D@DA@@@@AA@A@A@@@A@B@AF@B@@@F@@@@BBB@@@@@BBBB@@@B@@@B@B@@BBBB@@@A@AB@@@@@@H@D@@@@C@E@A@@@AB@@@B@@@@B@@BAB@@@B@@@@B@D@BB@@B@@B@@D@D@@B@@@BAAAF@@@@ABA@A@C@@@@@@@@@@BF@B@B@@B@@@B@@@B@@A@A@A@@@A@@BB@B@D@B@@@@@@B@B@@A@@@AB@@@@A@@B@@B@D@@@@@@B@@@BB@@@@B@B@@@B@@@@BC@@B@@@@@@@@B@B@@@B@@AB@@A@@@@@A@@B@@B@BB@@@@@BA@@@@B@@@B@@B@@BA@@@A@A@@A@A@@A@@@@B@B@@A@@@A@C@@FB@@B@B@@BBB@@@D@B@@B@@E@EAA@A@@BABB@D@D@@BB@@@B@D@@@BB@B@B@@@B@@@@B@@@@@@C@A@@B@@@@@@@BB@@BB@@@BBB@D@BBD@@BB@@@@CB@@@@@@@B@@@BB@@B@@@BB@@BB@@D@BBDBD@BBD@@FVDLBFBFDJ@FB@B@BD@DBB@@@B@BABB@F@@B@DAD@B@@@@@@@C@@AA@@@B@D@@@BBB@D@@@@@B@BBBB@@B@B@@B@@A@AB@B@HB@DFB@B@D@B@DAFB@@B@B@DCAAFE@@@@@@BB@@B@D@BBB@B@BBF@@@@A@@@@B@@@@@BB@@@@AB@@BB@FBH@B@AD@D@D@BA@@@A@@@@@@A@@AA@@@@@@A@@@AB@@@B@BBB@@BB@@@BA@@BB@BD@@@B@B@@@B@@@@DB@@@BBBBB@@B@@AB@@AB@@@@@@B@@AD@@@@B@@@@@@@@@BA@@B@@B@@@B@@BABC@@@@@@B@@BA@@B@@@BF@D@@F@@@@@@@D@B@@@@@@B@B@BAH@@@@BBD@@B@BB@B@@C@CB@@@@@A@@AA@@C@AA@@@@AB@@@BBDBD@@BB@@@B@BAF@@ADA@EB@BA@BBBB@B@@D@@BB@@B@B@@@D@B@D@@@B@@CBIDA@EBE@@@A@A@A@AAAA@@A@@BAB@BA@@B@@B@@D@@A@CB@@@B@@A@AA@@A@@B@@AA@@@@ABA@@DA@@@@@A@@@A@AB@@CAA@A@@B@@@@@@C@CAC@AB@BA@A@@@@@CB@BA@EAC@@@A@@@AB@BBB@BBD@F@@B@@@AB@@@BA@CBA@CDA@@@@BBD@@@BDBBBDD@@@@@B@@AB@B@@@B@@@@B@B@B@DBBB@@BBFDJBHD@@B@B@D@FCB@@B@@@H@B@BBDDD@B@@B@BA@@BBB@@@B@BBB@D@B@@@@@@BBDDFDB@BB@DB@@BBBB@@B@HAF@D@B@FA@@BAB@FAD@BA@@@@B@@@BB@@BD@B@B@B@@ABIFA@@B@@@B@@BB@BDD@@B@@@@@DCB@FAD@B@D@B@B@B@@@B@@B@@@B@B@D@B@B@B@BB@@@D@DAD@B@B@@@D@D@DAD@BA@@BC@@BAB@B@B@@B@@EHAB@DA@@D@B@BBBBD@@@@B@DB@@B@FAD@DAB@DAD@B@@A@A@@@@@@@@B@B@@@DB@@BBBB@@BCBAB@D@D@@@@CB@@@@E@@B@@@@C@C@@A@@@@@@@F@@@@A@@D@F@@@@@@A@@D@@D@@@@DAB@@@BB@BD@DA@@B@BA@BB@@@DBD@@AB@@@@@B@@ABBD@@B@@@@B@B@B@B@DCDCBABA@@@BB@@B@BDBB@@@@D@@@B@@B@@@DA@@B@J@B@@@@@@B@@AB@B@BA@@DAB@@BB@@@@@A@@BA@@@@B@@@@@AD@@@B@@B@@@B@@@D@@@@FBBBA@BBBBB@@D@H@@@BF@@@@@@B@B@@@@C@@B@B@@@@@B@@@@@BA@@D@BA@@B@B@@@@@BA@@@ABA@@@CB@@AB@D@BB@@@@DA@@@@@@@B@@D@@BB@B@BB@@@@@AB@@@@@B@@@@@@@@@BA@@@@@@@@BB@@@@@A@A@@@@B@@@@BB@@@@A@AB@@@@A@@F@H@@@@H@B@@@B@@@@B@@@@@B@@@@@DABB@@@@@B@@B@BBD@@BBBF@@@@@@F@@BB@BB@@@BBBB@BB@DB@@BA@A@A@@B@@@BA@AFEB@@@@AB@@@BBBBB@@BBB@@@@B@@B@B@@@@@@B@@@@BAB@@@@B@@@BAB@@@@AB@@@@DA@AB@B@@@@B@BA@@@@@@B@@@@@@B@@@D@B@@@@@@@@B@@@BABCBABA@@@BAB@@A@BB@@@@B@B@B@AB@B@B@@B@B@@BB@@@@D@@B@@@@D@BA@A@@BBV@@F@@@@F@BN@D@@FF@@@@FD@@@@D@BJ@@@BD@H@@@@H@D@F@@B@@@@@DBB@@DBB@D@@@BBB@F@@@@@@D@BF@@@@@@FD@AF@@@B@LL@@EFA@EF@BEB@@@@ED@@@@@@C@AD@F@@F@@F@@@J@F@@AF@B@@B@@@@HB@F@@AB@@AB@@@D@B@B@D@B@B@D@F@@@@@D@@@B@@@D@B@@@@@B@DB@@EH@@@@B@@D@B@@A@@@ADBBADBD@B@@@B@@B@@D@@@@@@A@@H@D@@@@F@@@@DB@BB@BB@@F@@@BB@@@@B@@@@B@B@F@@B@R@D@D@@@@C@A@@@@D@B@F@H@D@@@B@@@B@@@@@B@@@@@B@B@B@@@B@BAB@@B@@B@@B@@@BA@@@BB@@B@@B@@@@A@@@@B@@@@B@BAB@@A@@@@@@@AA@@A@CAA@AA@@@@A@ABABABE@@B@@ABA@@B@BAB@@@B@@@B@BA@@D@@AB@@A@A@ADCBCB@@A@C@C@@@ABA@A@AB@BAD@D@D@@@@@@@@A@@B@BA@@@@B@@@AB@@B@@@DA@A@A@@@ABA@@@@DD@@@ABAB@B@@@B@@@B@DC@@B@D@BA@@B@@@BABA@@B@@B@@DFBAD@HAF@DAD@B@BAD@B@JAHAB@B@B@FALA@@JANARCDADKBAJGCEGIIS@@EIEC@@IEIACCAE@@CK@@AIBC@@DCBC@EACCGCG@@AE@G@@@G@CLEDE@E@AAICGIUAC@@akAEAQAIAOFSDENUDALSDCJYDQBC@W@GAEG[AECGIQEUCYFSN]DIHOSIc@_@E@S@M@DJBHi@K@@ABA@A@A@CBGBC@@BAC@@@EBCBIDABA@A@A@A@CAAAAAC@AAE@CAC@AB@@@B@B@DBFBD@B@BAD@@CFCDGHED@@CB@BABAB@BCBA@CBA@A@GAC@AAA@OCA@AA@@@A@AB@DAFEJGDA@@@A@@@@AAC@A@C@AAC@ACCAAACCCAAACAA@EACAEAA@AAA@A@C@ABABCB@@ABABC@ABA@A@@@C@AAA@ACA@@CAA@A@AA@AAA@@@C@EBE@CAA@GAE@A@A@C@A@ABE@GBA@A@AAE@CA@@C@AAA@AAA@CAA@GAA@A@AAA@C@C@ABEBA@CB@@A@@BBBDJ@D@B@B@BABCBCBA@A@@@CAOMCAAAA@A@CAI@A@GAE@C@CAE@ABA@@@ABCB@B@@A@@@AB@@BD@B@B@BAB@@A@C@IAA@E@C@E@CBA@A@A@AACAACAEAA@A@ABAFO@G@A@A@CCECAAAA@AAA@EACAC@CAC@CBA@CBCBIHABABA@@@A@@@CA"],encodeOffsets:[[112529,35447]]}},{type:"Feature",id:"610524",properties:{name:"合阳县",cp:[110.149453,35.237988],childNum:1},geometry:{type:"Polygon",coordinates:["@@EUESGU@AKaKc@@AKAEM_@@CMCM@A@@AKES@@ESAI@@EKCGS[@@OOGIYWACIHABCLCBQDMBIB@@KBEBA@A@A@GBIBA@C@ABA@C@CBE@GBC@ABCE@@@AA@@@ABAB@@A@@@ABC@A@@@CDA@@@A@@@A@A@AB@B@@CC@@@@AB@B@@@B@BCB@@A@@@BA@@A@@@@@ABA@@@@B@@@@@@C@C@C@ABA@@B@BAB@B@@@D@D@BA@ADCD@B@B@BA@@BC@@@ABA@@@A@@@A@ABA@@@AB@BA@@@AFABAB@B@B@@B@@BBB@D@BB@@B@@@@@@@BA@ABA@@@@@@A@@B@@@@@@AA@@@AA@@B@@A@@@AA@@@@AA@ABA@@@A@A@A@@@@@A@@@@@A@@@A@@@C@G@E@A@C@@@@@@B@D@@C@C@Q@A@@@@E@A@A@@A@@@@@AA@@E@@@AAA@@ACA@@@@@E@@C@G@@@@B@@@@C@@@@AA@@@A@C@CAABCA@B@@@BA@C@@@@A@@G@@FA@G@M@K@@@G@@FA@@EE@@BBJA@ABB@@DABC@A@@@AB@B@@C@@FA@@@@FA@@EA@@F@@@@A@A@@D@BG@@@AC@AC@EBA@A@@@@D@B@@I@@@@FC@@@@@@B@BA@@BAB@BABAB@D@FAD@B@@@BA@AAE@@B@B@@A@@@AA@@@AA@@@@BABAAAB@@A@@BC@A@A@@AB@A@@A@@@@@@@A@@@@@@A@A@CB@@A@@@@BA@@@@A@@@@C@Q@A@C@A@@B@B@@@@A@ABA@@@@B@B@@A@C@@@ABA@@@@D@@@@@B@@@@A@G@ABCB@@@B@@CB@@A@@BA@@@ABBB@@B@@@@@@@@B@@B@@@B@B@@B@@@@A@AB@B@@B@@@BBA@@@@BA@@BAB@B@@@@@B@@@B@@@@DBB@BB@@BF@@B@DBB@BBD@@BB@BBB@@AD@B@B@@@BA@@@@@B@B@@@@@@@@BAB@@@@@B@B@@@@@@@BB@@D@@@@A@AB@@DB@@@@@B@@@@B@@@B@@@@@@A@@BB@@@B@B@D@@A@@B@BAB@BA@A@A@@B@@@@B@@@B@@@B@AB@@@B@@@@@AB@B@B@@B@BA@@@BA@@B@@@B@@D@@@@@@B@@AB@@@B@@@@A@@@@BDD@@@@A@B@@@@@@@B@@@@B@@@@BA@@@BB@@@@@BB@@B@@@@AB@@@@B@@ABA@CB@@@@@@@@B@@@BB@@@@@@@@A@@@BB@BA@@@@@A@BBA@BB@@B@@@BB@@@@A@A@@@BB@@BBBABB@@BB@B@@D@D@BBD@BB@@@@@B@@@B@@C@@@AB@BABABB@@@FBB@@@BA@@@@BB@@@@B@@@B@@B@@@BDFBD@H@@BBD@BB@@@@A@@@A@AACA@A@@A@@@@@@@@B@@@@@@AA@@A@@BA@@@AB@@ABA@@@@@B@@@@@@B@@A@@@@@BB@@@@B@@@@BB@@@@@@BCAABCAA@A@@@@BB@@BDBB@@@@BC@@@@@@@B@@BB@@@AB@@@@A@@@CA@@A@@@A@@BBB@@@@AB@@AA@@AA@@@@ABA@@@BBB@@BBB@BAD@@AB@@@@@@BB@@BB@B@@DB@B@@A@@B@B@@A@C@A@@@AA@@A@@@@@@@@BB@@@A@@B@BB@@@@@BB@B@@@@@B@@@@AA@@A@@@@B@@@@@BDBB@@@@B@@@@D@BB@@BA@@@@@B@BA@@BA@@@@B@@A@@@@@@AA@C@@@@@@B@@@@B@@B@@@BA@@@A@@@@@A@A@@B@@A@A@@BA@AAA@A@AA@@A@@@CB@A@@@@@@@BA@A@A@@B@DA@@@@D@B@@@@BBB@@@@@BB@@@B@@@@@@@BA@@@B@@@@B@@B@@@@BBB@@@B@@AB@@@@B@@@@BB@@@@@BBBA@B@@B@B@B@@@B@@@@@@@AB@@@@BB@@B@BA@@@@B@BBBB@@BD@BB@B@@@BBB@B@B@@@@B@@A@ABAB@@@BBBBD@BA@BB@@@@B@@@@BBB@@@B@@A@@@@@AA@@C@E@@@@@DB@@@@AB@@A@@BA@@@@@@@DB@@BA@@BBBB@@@@@@C@@@A@@B@@@@@@@BB@@@BA@B@@B@@@BB@@B@B@BB@@@@@B@@FD@@@@@B@BABAB@B@F@B@@C@@@@A@@C@AAC@A@AA@@@B@@@@@@BB@@@@CBC@GBA@@@@@@@@@@@C@E@AAAB@BABA@A@AB@@@B@@ABA@@@AB@@B@@BAB@BBB@@BB@B@@@@@@A@A@@@A@@B@@ABA@@@A@ABA@@BB@@BA@@DABCB@@ABA@@@A@@@@@A@A@@@@@@@BB@@@BD@@@@@BBA@@@CD@@@@BB@@@B@@AB@@BB@BBB@@BB@BA@@BA@A@A@@@A@BB@@ABB@@@B@@@B@@BAB@@@@@B@BA@CBABAB@B@@B@@B@@@@@@GD@@@@@@@B@BA@@@A@@B@@@@@BB@@AB@@@@@@@@BAB@@@BAB@@@@@BF@D@@@DB@@@@@B@@BB@BB@A@@@A@@@@@@B@@B@@B@@@B@BB@@@BB@B@@A@A@@BA@@B@@@B@@B@B@@@@@@B@B@@@BB@@B@@C@@B@@@D@@B@D@@@@B@@@@@@C@A@@@@B@@@B@@F@DDDFBBBBBD@BBBBB@B@@ABBB@@@BB@B@B@@@BB@@@B@@@@@@A@AB@@@@BB@@AB@@B@@B@@@@BBB@B@@@A@C@@@@B@@@@@A@@A@@A@@A@@B@B@@@BA@@@@@@@AB@B@B@@@B@@AB@BAA@@@B@@B@@B@@@@BB@@@@@@C@AAC@A@@B@@BBB@D@BB@@CB@@@@@@@@@@B@B@@@BB@@@B@@A@A@A@@B@BBBD@@@@B@@A@CAA@@@@B@@FD@@A@@@BBB@@@@@A@@B@@B@@BB@@BB@B@@@CB@@@@@@@@@BB@@@@@@BA@@@A@@@@B@@ABAA@@@@@B@@B@@@B@B@@@@B@@@@@BA@@@@B@@@@ABA@@@@B@B@BBBBBBD@@@B@B@@@B@@@@@BB@BB@B@B@@BB@B@@@B@B@@B@@BB@@B@BAB@@BB@@@@BB@B@B@@@BB@@B@@@@AB@@A@@@@B@@BB@BD@@B@@BB@B@DABBB@@@@@B@@DBBBD@BBB@@B@B@@D@B@B@B@FABAB@@@D@B@@@D@@BBBD@@BBA@@B@@C@@B@@AB@DBBBBBB@BBB@BB@@BB@@B@@@B@@A@A@A@@AA@@@AA@@AAA@A@A@A@A@AB@@@@BB@@B@@@@BB@@@@@@@B@@@@@BB@@B@@@@B@BB@@DB@BB@@@BB@@B@B@@AB@@A@@@@BA@@BAB@@@@ABBB@BA@@BA@@D@D@DB@@D@B@@@BA@@DAD@B@DA@@@@B@B@@@BBB@B@B@@@@@@B@@BD@@@@B@@@B@DB@@BBBB@@@@@BBD@@@@@@BB@@@@BB@@@B@B@B@B@B@@B@@@@BB@@@B@B@@@B@@@@@B@B@@@B@@@B@DBBBB@@AB@B@@@B@@ABB@@@@@BBBB@@@@BB@@B@@D@B@BABABA@@B@BA@ABA@AB@@A@@BC@@BA@AB@FCB@B@B@AAB@@C@@B@BAD@BAF@FAB@B@DA@AB@B@BADBD@DC@@D@BAB@D@B@@@@@DA@@B@B@DAB@@B@BB@BA@@@@@A@AB@@@B@@@@A@@BA@@@A@@@ABA@A@A@@B@@@@AB@@@@ADAB@@@@AB@B@@@@AB@B@@@@AB@BA@A@A@@@@B@@AB@@@@@@A@@B@@A@@@@BA@@@@@@@A@@@ABA@@A@@@@A@A@@@@@@@A@@@@CA@@@A@@A@@@@@AE@A@@@@@@BA@@@A@@D@B@@@BA@A@A@AB@@@B@@@A@C@A@@A@@@@BABAB@B@@@@@B@B@@@@ABADABA@ABA@@@A@@B@BA@A@@@AB@B@D@BAJA@ABB@@@BFCA@@CF@@@BAB@B@RCB@B@@AB@@BH@AAJ@@CFALA@A@@B@@@@@@@@@D@B@@@F@@@@C@@@@F@F@@@@B@@FBL@@@B@B@B@B@@@BB@@@@BAB@@ABA@@B@B@@@B@BA@@@AB@@CBABABA@@B@BA@@@@@@@AB@B@BA@@@@B@@AB@BAB@@BDDBBB@BDB@@AB@@DB@@@@AB@@@B@@@@@@D@@B@@@@B@B@@P@@@@ABA@@@@@A@@@@R@AG@@A@@@@C@AAC@A@@@@AE@@@@C@A@@AA@@A@@@@@AB@@@@@B@@AB@DA@AD@B@@@@B@@@@B@@A@BB@A@@@@B@B@BAB@@@B@D@@F@@@@A@@@AB@D@@FTA@@@BBD@@@@B@@@@@@@DA@@@A@@H@@@@@F@@CH@H@@AB@H@@@F@@AB@BA@D@@D@@@@@@@D@@B@BF@D@BF@@B@@FA@@D@@@B@@@BF@@B@DD@@C@@D@B@@@@@DAB@B@@@J@@C@@HA@@@@DA@@@ED@AC@A@@JALAB@RSCAIKCCA@EKEEKKGEGG@@IO@AIOAIAAGW@GAICSC_@@IsEeAOCO"],encodeOffsets:[[113017,35995]]}},{type:"Feature",id:"610525",properties:{name:"澄城县",cp:[109.93235,35.190245],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@GAC@@I@@A@C@@C@@E@@E@@EC@M@@A@E@@E@@@AU@AB@B@@A@C@@A@@@@C@@A@@AA@A@@@@A@ABAA@A@A@@@@@AAB@@@BA@A@@ABABADAB@@A@@@@@@@@@@A@C@@@A@@@@A@@@@@@@ABA@@@@@@ABAB@@C@@A@@B@@A@AB@@A@@@@@BA@A@@A@@@@@@@@A@AA@@@@@AA@AA@AAAA@@A@@B@@A@EF@BAB@@A@@@@B@BAB@@CAA@@AAAAA@@A@@AAA@@@E@@@@E@AA@AC@AAA@@@@A@@@@AACB@@@@A@@@@@A@@@@@@A@@@A@G@@G@E@@@@B@@A@@B@B@@A@@A@@A@@@@@@B@B@@@@AA@@@@@@@@AB@@@@@@@@A@@@@@A@@B@@@@AAA@A@@AC@@@@A@@@@@@CB@@@@AAC@A@@BA@@D@@AB@B@@AB@@@@A@A@@@ABC@@@AB@@@@A@@@@@A@A@@@@D@@A@A@@@@@@@AE@@G@C@@@AAAA@AAB@D@B@@OA@B@F@@A@@@@D@B@@@@A@I@@@AB@F@@A@@DABG@@@@@@@A@@@@B@@@@A@@BBB@BCDABA@@@@B@@@B@@@@CBA@@@@BAB@@@@@@B@@@BBB@B@@@@DA@A@A@@B@B@@@@B@@@A@@BB@B@@@BAAAB@@@@B@@B@@@@@@A@@B@@B@@@@@@B@@@AB@@AB@@A@@B@@@@FBD@@@@@A@@@@B@@@@FB@@B@@@@@@BA@@@@@@@A@A@@@A@AA@@@@AB@B@@B@B@@@@B@@@@A@@@BBBB@@@@B@@AB@B@@@BAB@@@@@@BB@@@@@C@AB@@@@@B@@@@A@@@A@@@A@@@@B@@@B@@@B@@A@@@@B@@AB@@@@@BB@B@BAB@@@B@B@B@@@@@BB@@@@A@A@AB@@A@@@@BB@BBB@@@AB@@A@@@@@@B@@B@BB@@D@BB@@A@A@@@@@@@B@@@@B@@A@A@@@@@@B@@@@B@@@BB@@@@@B@@B@@@A@@@@@@BB@BB@@BA@@@@@B@@B@@@B@@@@BA@G@A@@@@B@@@B@@@@@@@B@@A@@@@@B@@@@@A@ABAAA@@B@@B@@@@@B@@@@B@@@@@@@@B@@B@B@BBA@B@@@DB@@@ADB@@AB@@@F@@@@B@D@BF@@@D@B@@D@@@@@@B@@@@@@@@@B@@@BB@B@@@@@B@B@@@@BF@@@B@@A@@B@@@@@@@BA@@BA@@B@@@@A@@B@@A@@@A@@@@B@@@@@BABB@@B@@@@@@@B@@@@@@@B@@A@@B@@@B@@@@BB@@A@@BB@@B@B@@@DB@@D@@@@BABA@A@@@@@B@@@B@@BB@@@@@@A@@@@B@@@@@@A@@B@B@@@@B@@@@@B@@@@@@BA@@B@@A@@@AA@@A@@@BB@@@@@@@@@B@@@BA@@@A@@@@@A@@@A@@B@AA@@@A@A@@@@@@B@@BB@@A@A@@@@@@@@AAA@@@@A@@@@@A@@@@B@@@@A@@A@@@@AB@@@@@AA@B@@@@@B@AAA@@@A@A@@@B@B@@@AB@@@@@B@@@@AA@@@@A@@@@@@@@@A@AA@@BAB@@@B@@@@@FA@@AA@@CB@@A@@@C@@BA@@@@A@@A@@@A@@@CB@@EB@@@A@@@@CBAB@@@@@B@@A@@@A@A@AA@@AA@@A@@A@@A@C@CA@@@@BAB@A@BA@@@@@A@@@A@A@@@@@@@A@@AB@A@@ABA@A@@@@@@BCABC@@G@A@@AB@B@@@@AA@@@B@F@@@@@@@@@BA@@CACA@AA@@@A@EBAB@A@CAACB@@E@A@EB@A@@AC@A@@@AB@@@@@C@@@A@A@@@A@CAA@@@@@@A@@@A@@@@@@C@AA@AA@@BA@@A@@@@A@@@A@@B@@@@BD@BB@@BGFABAB@@@@BBBBB@BBB@B@BBBB@B@BAB@B@BAB@@@BAB@@B@@@@@B@B@@ABB@@@@@B@BAB@@AB@@@@A@@B@@@BDB@@B@BB@@BB@@@@ABA@A@@@A@@@@@AB@@@B@@@@CB@B@@@B@B@DBD@B@@AB@B@BB@DB@@@A@@@A@@@@B@@@@@@D@@B@@@B@B@@@@AA@A@@@@A@@@@B@B@B@@BB@B@@@@@@@AA@@@@@@FAB@@@BA@B@B@@@B@@@@BA@@B@@@BB@@@@@A@@AA@@@@B@B@B@B@B@@@@B@@@B@@@@B@@@@@AB@@BB@@@@B@BABA@@BA@@@@@@@D@B@@B@D@@@@@@B@@A@@@@@BB@@@@AB@BAB@@@@BBFABBBBDBB@@@@BA@@@C@@@A@A@@B@@CB@BA@CBA@A@@@A@@BB@B@D@@@B@D@BB@@B@@@B@B@BB@@B@B@B@@@@BA@@@A@@@@@@BABB@@@@@A@CB@@A@@BB@@@@@A@AA@@@B@@@B@@A@@@@@DB@@@B@@@@BBB@@@@@B@@@B@B@BB@@B@@@B@@@BBB@B@@@@@B@@@BB@@D@@BB@BB@@B@@B@@B@@@B@@@@@BB@@B@BBBBBB@@B@BB@@@B@@BBB@@@@@AB@@@@@B@@@B@BE@A@@@@B@BB@A@@BA@@@@BA@A@@BC@@@A@A@@@A@A@@@@BC@A@@@A@@B@@@@AB@@@BA@AB@@A@A@A@CBA@ABC@A@A@A@A@A@@@@@@A@@AA@@@A@@A@@@@B@@@BA@@@@@@E@A@@C@A@A@@@@@AA@@@A@@@A@@@@BAA@@AB@BAB@@@@BB@@BF@@@@@@ACA@@A@@@@@@A@@@@AACA@@@ACA@A@@CAA@B@@AB@@@@C@@@@BB@@@@BAB@B@@A@@@A@@BA@A@A@@@@A@@@A@AAA@@AC@@@@A@@AA@@@@BAB@@@A@A@@@@@@AA@@@@@B@@A@@A@AB@@@CA@@@@C@@@@@B@BA@A@@@A@@D@BBB@B@@@BAB@BAB@@A@A@A@AD@B@B@D@@BD@BBD@B@@@BA@@@CBA@CDCBA@CD@@@B@@ED@@A@@BB@DF@B@B@@ADADCB@BAB@@ABA@@@@@@@@B@B@B@B@@ABAFA@@@BB@BFD@B@@@B@B@@@@CBEDA@@B@@AD@@@B@BBB@B@@@B@@A@@@ABAACAA@ABA@A@@BA@ABA@CAA@@@@AAA@@A@A@A@ABA@C@A@@@CB@@@B@@ABIDGB@@ABA@ABCBCB@B@@@BBB@@@@ABA@AB@@AB@@AB@@C@A@A@C@CBC@C@C@A@A@CB@@A@CBA@@BA@@B@B@@BBDD@@B@B@@ADABAB@BA@BB@@@@BADAHABA@@@A@ECA@@@CA@@ABC@@@A@@B@@@B@B@BB@@F@@@B@BB@@BAB@B@@A@ABEBABGDCD@@@@@BBD@B@@BFBB@B@D@B@BAB@@ED@@CBABAB@B@@@B@F@D@@@BABADA@AB@@A@C@A@A@C@C@C@C@@@ABAB@@AD@@BD@BBBBBDBFF@@@B@@@@@BABA@@@ABAAA@C@A@A@CBA@CBEDC@@BAAABA@@@CB@BABAB@B@@@BBF@D@B@BB@@BB@@BDB@BBD@B@@@BA@@@C@@AC@A@CCCAAAA@@@A@A@AB@@@B@@@D@@@BBB@B@@AB@@@@@@@BB@B@@@BB@@@B@@B@@@BBBB@B@@BBBB@@@@@@A@@B@@BB@B@@@B@B@@@@@B@@B@B@@@B@@@B@@@B@@@@B@B@B@@@@@BBB@@@@@B@@@@@B@@B@@B@B@@ABA@@@@@@BD@@BA@@BA@@@@@@BB@BB@@B@@@@BBB@@AB@@CB@@@BB@@@B@BBB@@@B@@B@@@@@@AB@@BB@@@BB@@@AB@BB@ABB@@B@@@@@@B@@BB@@@A@@B@@BB@BB@@B@@@BBB@@AB@B@@@@BB@@@B@B@@@B@@BB@@@B@@BBBBB@A@BBBB@@@B@@@B@@BB@@B@@@B@@@B@@@B@B@@@@@BBB@B@@@@@B@@B@@@BB@@@B@@BDDB@@BD@@@BB@@BAB@@@@B@B@@@BAD@B@B@B@BBB@BB@@BB@@@@B@@A@AB@@@@B@BB@@@@@B@@BBB@@BB@@@@@@@@B@@@B@@B@B@@@@@@@A@@@@B@@@B@@BBBB@D@@A@@@@@@B@@B@B@@@@B@@AB@@B@@BB@A@@B@@@@@@B@@BB@A@@BABB@@@DB@DADBB@@@B@@@B@B@@@B@D@BB@@BBBBB@@@B@B@@BB@B@B@B@B@D@@@B@B@@@@@BAB@B@BA@A@@@A@@BA@@@@@@@@BA@@@A@@B@B@@@@@B@@@@A@@@@B@@@@@B@@@@@B@@@@@@@B@@@B@@@@@B@@ABCBA@AB@BAB@@BB@BBA@@D@BA@A@@@A@@@@BADA@@BAB@@@BA@@BA@@@A@@@@@@BA@@@@@@@@@A@@@@@AB@@@@@@@@BB@@@A@@BB@@@@@@@@B@@@@@@@B@@@B@@@@@B@@@B@@@B@@A@@B@B@@@@ABA@@B@@@BA@A@AB@@BB@@@BB@B@B@D@@@D@B@@@@A@@B@@A@@BAA@B@@AA@B@@@@@A@BA@@A@@@B@@A@@@AB@@@AAB@@@@@@@@A@@@A@@@A@AB@@A@C@@@@BB@@@@@BBB@@A@BBAB@@B@@B@@@@@B@@B@@B@@@@@BB@@B@@@B@@@@@@@BB@@B@BB@BBB@BDBBB@FDDBDBD@DBD@DBB@BBDBBBBB@@B@BA@@BABA@A@ADC@A@@BA@@@@B@@@@BB@@F@BBB@@BBB@@@BB@AB@B@@@B@B@D@@@@BB@B@@BBA@@BBB@@@@BB@B@@@B@B@@BD@@AB@B@@A@@@@ACC@A@@GDABCBA@@@@CA@@B@B@@@@A@@@@A@@AA@@@BC@@A@AA@@@@D@BAB@BA@CBA@@@@@@@@DBFDDBDB@@B@BAB@B@@@@B@@@@@@B@@@B@@A@@B@@@@@@@B@@@BA@@BAB@@@@BB@@BA@@@@@B@BB@@@@BA@@B@@@DCDA@A@@@A@@A@@A@@@A@A@AB@@AB@@@B@@ABA@AB@@@@BBB@BA@BB@B@@@@@BB@@@BBBBB@@B@@AB@@@B@@B@@BA@@@A@@@@AAA@@A@@@@@A@@B@B@B@@@@@@AAA@AA@@@BA@@B@BB@BB@B@@AD@@B@@@B@B@@@B@@@@BA@@@AD@BB@@@@A@@BB@@B@@@B@B@B@B@BB@@@B@@BA@ABA@@A@AA@@BABA@@BA@@B@AA@A@A@@@@@@B@B@DB@@@ADC@@@@B@@BAB@@BB@@BB@@B@@@BA@ABA@A@@B@B@@@@B@B@B@BB@@@B@@@@@@A@A@@BA@@B@DAD@@@@CA@@C@@@A@@@@B@@@@@@BB@@A@@@@B@@A@@BAB@B@D@F@@@@AAA@AA@@AAA@@AA@@@@@A@A@@@A@G@@@A@@@A@@@@@@@AAA@@@@@@@A@@B@@@@@DD@@BBB@@@B@@B@@@@@B@@B@BBB@@DB@@DB@@@@C@@B@@@@@@AA@@@@A@@@@@A@A@C@A@AB@@@@@@B@@@B@@B@@@BADAB@@@DBBB@@@@C@@@A@BBB@@B@@@@B@@@BB@@AF@B@B@@DA@@BB@@@@@BB@BBDAD@@@@@B@@@B@@A@@B@B@B@DAD@B@D@D@@@B@BA@AD@@@B@@B@@B@@A@@B@B@@@@@BB@B@@BB@@B@@B@B@@BB@@@BABB@@B@@B@@AB@B@@ADAB@B@@@B@@@BA@@B@BAD@B@@@@A@@@AB@@@D@B@@@@@BAB@D@F@B@B@BBB@@@B@BAB@F@@AB@B@B@@A@@@@BABA@BABAB@B@@@B@@@@B@@BBB@B@B@B@FB@BB@@@B@@@BA@@B@@@BBB@BB@@@B@@@B@@@@D@BB@@BD@BBDBB@B@BB@@B@@@@A@@@ABA@@B@B@@C@@@@@@@@B@@@@@B@@@@ABADCB@B@@ABABAAA@ABA@@@AB@@AB@@A@A@A@@BA@ABA@@@@AC@@@A@A@@@@BAB@@@@A@@BA@C@AAA@@@AC@@AAA@@@A@@B@@@BA@@@@@AA@@A@@@A@AAA@@@@AA@@BA@A@AA@@AA@@@@A@A@@@AAA@@@A@AAAA@@A@@@@@A@@@A@A@@ACAAAA@A@A@A@@B@BA@@@@@A@@B@@A@@@@@A@@A@A@@@A@@@@A@@@@BBBA@@@A@@B@@@B@@A@@@@A@@A@@@@@@@@DA@@A@A@@AA@@AA@@@@AB@@@@@A@AA@@B@@@EC@@@A@@B@DBB@@@@A@@C@AA@A@AB@B@B@@@@A@@AA@@A@A@@@@@@@@@@@DA@@AAC@A@AA@@@AB@D@BBD@@@@@@@AA@@@@@AA@@@@A@@BB@ABA@@@A@@@A@ABA@@@@@@B@@A@@@A@AB@@@@BB@@@@B@@@@@A@@D@B@@@A@A@AA@@@@@AA@@@BA@@AA@@@@BAB@@@@@@@@A@@AA@@A@A@A@@A@@AABA@@@AAAAA@AACAAAACECCE@@@@A@@@A@@B@D@@@@@@@@A@@C@A@@@@C@@@AD@@@@AA@@A@@@A@A@@@@A@A@@@@A@@@AB@@AB@B@@@@AAA@@A@@A@A@@@AA@@@@A@@@@B@@@B@A@@AAA@@@A@@@@CA@@C@E@@A@@@@BA@A@@BA@A@@@@@@A@@BA@@A@@@@@AB@@@B@@A@A@@@@@@HC@@@@@@@AA@@@@ABABADAB@@A@A@@@@BA@AA@@@A@@@A@BA@@AAB@@@B@B@B@@AB@@AAA@@AA@AAA@@BA@@@A@@AA@@@@DC@@B@AA@@@@C@@A@@AA@@@@@@B@B@@@@@B@@@B@BA@@DABA@CB@@AA@@AB@BAB@@@B@BA@@@AB@@@B@B@@@@@@@@AAA@@AA@ABA@AA@@@BA@@B@BA@@@A@@BAB@B@BA@ABABBF@D@@@@@@@@@@@B@HAD@DA@@@@AA@@@@@@@A@@BBB@D@BBD@@@@B@@D@@@@A@E@ABABA@A@A@@@@EC@@@A@@@@AAA@A@@@AA@@A@@@@AAB@@A@@A@@@@@@@AB@@@D@@@@@@@AAAA@@AB@@CA@@@@@@B@@AB@@@BA@@@@CA@@@@F@D@@@BB@@@@B@@@@A@@AA@A@@A@@@@@AAB@@AACAA@A@@BABAB@@@@A@@A@A@A@AA@@A@A@@AAC@@AAAAA@@@@@ABA@@@AA@@@@BA@@@@@@A@@@A@A@A@@@@AABAA@@@@A@@A@@A@@@@@BA@@@A@@AA@A@@A@@@@A@@A@@@B@@A@@@@@@@A@@AA@@@@A@AA@@@@@A@C@@B@@C@AB@B@B@@A@@@@@@@BDA@@B@@@BBB@B@BBB@@AB@B@@@@AB@B@@@@@B@@@B@@A@@@AA@@@@@@A@@@@D@B@@B@@@@B@@@@A@@B@@AB@@A@A@@@@AB@@AAC@@@@@@A@@A@CA@A@@@@@A@@B@@@BB@@@@@A@@@@@AAA@@@@A@@A@AB@@@A@@A@@@@@@B@@@BB@@B@D@B@@@@A@AB@@@@ACA@@@AAA@@AA@@@@@@BA@@BC@AAA@AA@AA@@B@BA@@@@BB@@BB@@BA@@@@AA@AB@@@B@@@DB@@B@@@@@BA@@A@@AA@@@@@@@D@@A@@A@CA@AA@@A@@B@B@DBBADB@A@@@@A@@A@@A@@@@@AA@@@@B@@@@A@@@@A@@@@@B@BA@@BA@@B@@AB@@@BB@@@@@@@A@@@@@@B@@@@BDBBBB@@@B@@@@@AAC@AA@@@GACCE@A@@@AA@@@A@@@@@AA@@@@AB@@A@EA@@A@BABA@ABA@@D@@@@A@@@A@@@@AAC@AAC@C@@@@AAA@@AAABAA@@AA@@B@B@@@@@AA@@A@@@AAB@AAB@@@@@B@@AAA@@B@@@@@@@@@AA@@A@@@@@@@@@DAB@BA@@@A@@A@@B@@A@@@AA@@@@A@@A@@AB@@@@@A@@A@@@@@@@A@B@@@@@CC@A@@B@@@@@@A@@BA@@@A@@@@C@@@@A@@@AB@@A@@ABA@@@@A@ABA@@@@A@@@A@@B@A@@@A@@@A@@A@@@@B@BABA@ABA@@@@BC@A@A@@@A@@AB@@@@@@@@A@@@A@@A@@@@@A@@CA@@B@B@@C@@@AA@@@@@@A@A@@@@@A@AB@@@@@@@@@A@A@@@@AB@@A@A@C@@BA@AAA@@AC@AAA@CAA@@@AE@@AAA@CA@@@@@A@@@A@@@@@ABA@AB@@A@@B@AA@@A@@@@ABAB@@@@@@AA@A@@@A@@@@A@@@@@@A@@@AABA@@B@@AB@@@DA@@@A@@DABAH@B@@@@@@A@@@@@C@@B@BA@@D@B@@@@A@A@@B@BAB@@@@@@A@AB@D@B@R@D@@@@@@B@@B@@A@@B@@@DAB@B@@@@@@@@B@@@@@@@BB@A@@BB@B@D@@AB@@@BABBBA@A@@B@@B@@BB@@B@@@@A@AF@BBB@@A@@@ABC@E@CBABA@ABA@AB@@A@A@@@@D@@E@@J@@@@A@C@@B@B@FAD@@BBD@@H@@A@CB@B@@@@@@EB@@FB@@E@@B@@ED@@@@ABA@@B@D@BA@CA@BAB@AI@AF@@FB@@EH@@@L@N@H@@C@A@@@@@A@C@@@A@@@C@@@@@E@C@A@A@C@A@A@C@@BA@@BA@@@EGA@@@@@AA@E@@BE@I@@@E@@@@EE@C@@B@D@@@@C@@F@@A@AFE@@FEB@FK@@K@A@@BEC@@E@@@@E@@A@C@@@@E@A@AA@@C@A@CA@@AA@C@@@@@AE@C@G@"],encodeOffsets:[[112678,35825]]}},{type:"Feature",id:"610526",properties:{name:"蒲城县",cp:[109.586403,34.955562],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@OA@@@A@C@@@@K@AA@@A@AHAD@F@@@@@D@@A@@@ABA@A@@@@BAB@@@@@@C@@B@BAF@@AD@@@DBBA@@BB@@@BBABB@@B@@@@AP@B@HAJ@F@FAF@F@F@BA@C@ADC@@D@BAB@AA@AFOA@@@@@E@@A@@@@@AF@@@@I@AAA@IA@BA@AD@BAFC@@@ADC@A@@BA@C@@@@DC@@B@FIAAFE@ABAJ@D@@@@FB@DAD@D@B@BAF@@BB@@@DFB@@@FJBAL@F@B@DE@AJ@@@@DB@DZDAFB@JB@DHB@FHB@FF@@EH@@B@B@B@D@BBBB@@@AFAF@@RB@@@@@J@BA@C@@@@DA@@@AD@@E@A@@H@@@@HB@@@@@D@@HB@@@F@@@B@@D@@@@@@D@@B@HBD@@@@AB@BA@@H@B@@H@@@@@DC@@@E@@@@D@@ABCD@@FB@DB@B@@B@DXBD@HBVDF@LNFHBDB@@B@B@@@DCF@F@@D@B@B@AB@@CJ@@GD@D@JAJFB@FFBAJC@AF@@@@@BAB@@@@A@@B@BAD@@CbC@@@CBAB@BAD@B@B@BABAB@@CB@@@@AB@@@@@BA@@@@BB@@BD@BB@B@@@B@BAB@@@B@B@BAB@@BBBB@@@B@B@@AB@BABB@B@@@@BBB@BBBB@@B@@@BBB@@B@AB@D@@B@BBBB@@@BB@@@BBB@B@B@BA@BB@BDBB@@ABB@@@BB@@@B@@@@AH@BAB@B@@@B@B@@AB@B@B@@@@@B@B@FABAB@BBB@DB@@B@B@@@B@@@D@@@B@@@@@B@@@@@B@@@@@B@@B@@B@B@BB@@B@B@B@@@B@B@B@@@BAB@@@@A@@@BBA@@B@B@B@@A@@@@@@B@@@@@B@@@B@@@B@@@@B@@B@@@BB@@BB@@@BB@@@@@@@BB@@@@B@@DAD@B@@@@@@@@@B@@@B@B@@@@BB@BAB@@@@@@B@@@BB@BB@@ADB@@B@@B@@@B@BA@@BBB@@@@@@@@@@B@@D@BB@@B@@BA@@@@@B@@@B@@@@@B@AD@@D@B@DAB@BC@@B@B@@@@@AB@@@BB@@@B@@@@B@@@BAB@@@@@BB@D@B@@@@DB@@@@@B@B@BB@@BA@@AA@@@@@@@@@@@AB@@@@B@@BB@@B@@@B@@A@AB@@A@@B@@@@@@B@@@B@@@B@@@@@BBA@@@@@A@@BB@@@@B@@@@@A@@B@@@BB@AB@BB@@BB@B@@A@@@A@AB@@@BB@@@@BA@ABA@@@@BB@@B@AC@AB@B@@CBB@B@BBB@@B@@BB@@@@@@DBB@@@@BB@@B@@AB@@@@@@B@B@@BB@A@@BA@@D@D@@ABA@@B@DBF@DB@@B@BEBB@@@BAB@@DBBE@@B@BBCD@@B@BB@@BEB@B@BADBFDB@HDBADCB@@BD@B@B@@@@@@AB@@@@@@@BABCB@@ADA@ABA@@B@B@@@@@B@B@@@B@@@@AB@@@B@@@BB@@BB@@BAB@@B@@@@BAB@HB@A@@AA@@@AA@@A@@AA@@AA@@@@@@@A@@@AA@@A@A@@B@@@BC@AB@@A@@BC@A@A@@@ABC@@B@DBB@@@@@BC@@@@BBB@D@BBB@B@@BB@B@@B@@B@BBDBBB@@B@@@@A@AAA@ADABA@@@A@@@ABA@AB@@@BA@ABB@BB@BE@@BA@@@@@CBCD@BAD@BCB@@@@D@@HB@BB@@@ADB@D@BBCJ@@B@BBD@@B@B@@AD@BB@@BB@@@BB@@BB@BB@@@@B@@BB@@B@@B@@BB@BBB@@@BAB@@@@@@@B@@@@@@B@@A@@D@@@@@@@@A@@@@B@B@BBD@@AB@@ABA@EBABA@@@AB@@A@A@@@AAA@@@AB@@@DABBD@B@B@@@@B@@BBAB@@B@@@DB@@@@ABBB@@@@B@B@B@@BBBBB@B@@BBB@@@@@BABABCBA@@B@BB@@@@@B@@B@@@@BB@@CB@@@BA@@BAB@@@BBB@@@B@@@B@@@BB@@D@@@B@@ABA@@@A@@J@B@@@B@@A@@@@A@@A@@A@@@@@BAB@@@@B@@B@B@@@@A@@DC@@@@@@@AB@@@B@@A@C@@A@A@@@BCBA@A@@AAA@@@A@ABA@@@@@@A@@A@@@A@@@@A@@A@@AA@@@@A@C@@@@@EB@@@@@BEE@A@@@@@@ABC@@A@@ABE@@A@@@BE@@BCF@B@BE@@DBBED@BE@@H@@@B@BED@@EHBBEHB@@@B@@BB@BD@@CB@B@JBB@BGA@BE@@E@@@@@@BA@E@@A@A@@OA@@BKBB@@BA@@@@GAA@@AB@@ADBB@@E@@BA@AB@@A@AB@B@F@BMBAH@B@@CBBF@@@D@@@B@B@@@@@ADDB@@@@@D@BPB@@AFD@@@@D@@D@BB@@@@@@BBB@@@@BF@AFD@@B@@A@@B@BB@@B@@D@AF@@FB@FD@@@AFD@D@@DAB@B@@B@@@@@@B@D@@BB@@AB@B@@@@AF@@B@BEHB@@@B@@BB@@BAB@@ABBB@AD@BAFF@AFB@AF@@BB@B@BB@@B@@@@AD@@BB@@@@AD@BAFCA@BAF@@GA@BAB@@A@@@@BA@@@@CA@@@AH@@FBAD@B@@F@@@BA@C@@DB@@@@@@BB@@@B@BBEBBAFDBA@@DC@@A@@A@@@@@AB@DAABC@@AAA@E@A@AF@B@@@@@B@BBB@@@@@B@@AB@@A@@BDBFB@B@@@B@@@B@@AA@@AB@@B@@BB@B@@@B@B@@B@@@BA@@@@@A@@@A@BB@B@BB@@@B@D@BA@@@@B@@@@@@@AB@@@@@@@@@@@B@@@@@BA@ABAB@AA@@@@@@BBBBB@B@@HFBBBBB@B@D@@@BA@@BA@@BAB@BA@@@@AA@A@@@ADADABAB@BA@@HAJCBA@@@A@@DA@@B@D@B@BAB@B@B@@@BB@B@@B@DBB@BAB@@AB@B@BAB@DBBBBA@@B@@@@A@@@AAA@A@A@@BC@@@AB@FCDA@@@@@A@A@@@AEC@AAA@@B@BEBA@@@A@A@A@A@@@@@@B@BA@@BA@ADABCBC@@@A@ACEA@@AB@@@FC@@@A@@DCB@DADCB@DA@@B@@A@@@AAC@AAC@@@C@A@ABCB@B@B@@@BA@ABA@A@@@AAA@A@CB@@@B@B@@A@A@@@@@D@@B@@D@@BAB@@@@BA@@@@@B@@B@@@@B@B@@@A@AB@@@@BB@@@B@@D@@BB@BBB@@@B@@@@@@B@BAB@@@B@@@BA@A@AB@@@@AA@@@@@D@@A@@BA@B@DB@@@BDB@B@@DBBB@@@@@B@@@@B@@@DB@B@@@@E@@AA@@A@@A@ABA@@BB@AB@@@@@B@@@B@@BB@@@@B@B@D@@@@B@F@@@@B@@A@@@A@@B@@@@B@@BB@@@B@@@@B@B@B@B@B@D@BAB@DAB@B@B@@@BAB@@A@@BA@@@@@AB@@@B@D@@A@@B@B@@@B@B@@@D@@AB@B@@A@@B@@AB@A@@A@A@@B@F@@A@A@@@A@@@@BA@@@@A@AA@@@A@@AAA@@@AAAAAAA@@@AA@@@@A@@@A@@@@AA@@@AAA@@AC@@@AA@@A@@@@@A@A@AA@@A@@@A@@@AAA@A@@@A@@@@@A@AA@@@@@A@@CA@@@@B@@@@A@@@A@@BBB@@@@@A@@AB@@@DAB@@@@@A@BA@A@@@@B@@@B@@A@@A@A@A@@@AAA@A@@@A@@@AAC@A@@@C@A@A@@AB@@@B@B@DAB@@ADA@@@AB@B@@@D@@@B@@A@@A@CAAAAAEBAA@@@@BA@ABA@@@@AA@@@@B@@@@A@@@@C@A@@@@A@C@@@@@@AB@@ABABA@@@@@AA@@BA@@@@A@@@@@@A@@@A@@A@A@A@A@A@@@@@BB@@@B@@@@AA@@A@@@AB@@@@@A@@@A@AAB@@A@EB@@@@@@BB@@@@@@A@A@@AA@A@A@@@@@@B@@B@B@@B@@A@A@@@A@@@@C@@@@A@@@@@@B@@@B@@CAA@@A@ABA@@@AAC@C@A@A@@@ADA@@@@@A@@BA@@@@B@@@B@B@BA@@@@AA@@AAA@@@CA@A@@@AB@@@@@BA@@BA@A@A@@@@AA@BA@A@@@@@A@@@BA@A@@BA@A@ABA@A@AAAAAA@A@AAA@AAAA@@@@BABAHE@AA@@AAC@@@@@AB@@@B@@@@@@BB@@AB@@BBBD@@@@@@@@B@@@B@@@@B@DBB@@@B@B@@@D@@@@@A@@B@@@BBD@@@BFAB@F@@@DABB@D@BBAFAB@@@B@@BDBDB@@AB@@@@@@@@E@A@@@B@@B@@A@A@@BB@H@@@ADDB@A@@@@B@B@BA@@@BBA@@@B@@@@@@@B@B@@@B@@@@ABB@A@AB@@@@DBD@B@@@@BB@@@BB@@BBB@B@@@B@@@@A@@@@BADA@@@@@B@@FA@@DA@@B@@@B@@@@B@@B@@AD@@@B@@@DA@@BB@@EB@@@@A@@@A@AB@@BBB@@@@@@@@@B@@@@@BB@@@@@A@@@@BA@@A@A@@@B@B@@@B@BBA@@@@@A@B@@B@@@@BA@@@@@BB@@@@@@A@@B@@@@@B@@@@@BB@B@@@@@@B@B@@@AA@@@A@@@@B@B@@@B@@B@AB@@@B@@@@@B@@@B@@A@@@A@@@@@@@@AA@@B@@@BB@@B@@@@AB@@A@@@@A@@@@@A@@@@@@A@AB@@@@@@@@A@@B@@@@@@@AA@@@A@@@A@@@@@BABAB@@@@@CA@@C@@@A@AA@@AB@@@AA@@@@@A@@@AB@@@@A@@@@@@@A@@@@@@@AA@BA@A@@@@@A@@B@@@B@@@@AB@@@@@@AB@@AB@@A@@@@@@@AB@@@@A@@AE@@@@@A@A@@@@@AAA@@A@@@@@@@@@A@@@@@@@@CA@C@@@E@@A@C@A@@E@@@A@@BA@BC@@A@@C@@@AAB@A@A@AA@@@@@@@@@@A@@A@@@@@A@@@@AB@BBBAB@@@@@A@@@@@B@@@@A@@@@@@@A@@@A@@B@H@B@@A@@A@@@A@@@@A@@@@AB@@AAA@@A@@@@B@@@A@@@@A@@@@AA@@A@@@@@@A@@@@B@B@@@@A@@A@@@@@@@B@B@@@AAC@@@AAA@@@@A@@@@B@@@BA@@A@AAA@@A@@B@@@BAB@B@@@@@AA@@@@A@A@A@@@A@ABA@A@@A@@@@BA@@@A@@B@@@@A@@@A@@@A@@B@@@B@@@B@@@@@@A@@@@BAD@@@@@A@@A@@@@A@AB@@A@A@@BA@@@@@AAAA@@B@@@@@@A@@A@A@@@@ABA@@@@BBB@@@B@B@@@@@@@B@@A@@@@A@@@EA@@@@@A@@B@@@@@C@EA@@@@@AB@@@BA@@BA@@A@@@@@@@@AA@@@@B@@@@A@@@@A@@A@BBAB@@A@A@@AB@@@A@@@@@@A@AB@B@B@@C@@A@A@AA@@A@@@@@@@BA@A@@B@DA@@@@@A@@@A@@B@BADC@AAA@AB@@@@@@A@@B@@@@@@@H@BA@CB@@@@EBA@@J@B@@@@@@A@C@@B@@@@E@APB@@@A@CAA@E@@C@@@A@@@A@@@@A@@BC@@@@A@@@@@AB@@@B@@@@AA@@BA@CB@@A@ABA@@@A@@@@A@I@A@@@CB@@A@@@@A@@@C@@A@CA@A@AA@@A@@ABABCDCDA@A@A@A@@@@@@AC@AA@BA@@@@@A@@BC@CA@@A@@AABA@@@CBC@@AAA@@A@CB@@@@@CC@@@@B@@@@E@C@@@@B@@E@@@@@@@B@@@@D@D@@A@@@@F@@A@@D@@C@C@A@ABAD@@AAAA@@CA@@A@A@@@@@@@@@@B@BA@C@CBA@CBC@EBA@@@CAA@@@@@ACAA@A@A@CB@@CBAFG@@@AA@A@A@AB@@AD@@ABC@CBC@C@@@A@A@C@CBC@@@A@@A@A@A@A@C@A@A@@@AA@@@A@A@A@C@A@C@EBA@CD@@@@A@@@CC@AAA@@@A@@@AB@JEBA@@@A@A@AAC@@AA@@A@@@@@ABC@EBA@AB@@EBA@C@E@GBA@@@AAAA@@CAA@@ACACEAC@A@@@@A@C@A@AAA@@@A@AA@@ABA@@@@ACCAC@A@A@G@@@AA@EDC@A@A@@@GCIAECAA@@AACAA@A@A@@@@@@A@@@ABA@@@A@@@@CCAACA@A@@AC@A@@B@DCB@DAB@@A@@BA@@A@@@@EAC@AAA@ABA@@B@@@D@FBB@@ADA@@@@B@B@@ABAD@DBD@@@@@@@@AB@B@DB@@BAB@@@B@@@@@B@@CB@BA@@@@BB@@@AB@@@BBB@@@@A@@DAB@@@@CA@@@@AB@@ABA@AB@@@BBBBB@B@B@@@F@FAB@JCDA@@@A@@@C@A@C@@@A@AA@@AC@@@@AAAAAB@@AFAB@BC@@BE@A@A@@AA@@ACAC@A@@BA@@@@BBD@@@BB@@@B@@@@DAD@@@@AAAA@@@AC@A@@G@ABA@A@@@@@@@@A@C@@@@@@@EC@E@@A@@@AB@@AA@@@@@@@ADAB@@@A@@@AA@@@AB@@@@@@@@A@@@@@BC@@@A@@@@A@@BA@@BA@@@AAAA@A@@CA@@@@@A@@@A@A@@ACA@@AB@MA@DA@@@@BMA@F@@E@@@@EC@@EA@@E@AE@BEG@CA@@@EC@@B@DE@@EIA@@I@@EEAC@@@@@C@@@KAM@@B@BE@@FC@@FE@GA@A@CG@BEE@C@@DC@@@@CEAAFC@ADIAA@AB@B@JAF@@@EC@MA@@@@A@AD@@AB@@BB@D@@AB@D@@A@BESA@EE@@A@CA@@CC@C@@COABKC@@EIAI@@FGA@@A@A@C@E@@E@@B@@ABC@@@EJ@BE@AGABAA@@C@@OAA@C@@BG@@FE@@EMAAFC@@@@FCA@DE@@BQA@BAD@@@@G@@EE@AFAAA@@FAH@BAA@DG@@CC@BEG@@BA@@@@F@DD@AFB@AFC@@DA@@@BCKAE@@B@JA@@E@@C@@D@@A@@ABAA@@DE@@C@AAD@B@@@@A@@BA@AD@@I@@FOA@FA@@EC@AFAAA@@@@@A@@@@@@@@@AD@BAA@ABAA@@BA@@@@AA@@@G@@@AD@@E@@@@BAD@@A@@@@B@@@@GAK@@@@B@@G@@A@@A@@ABE@@@@C@@A@C@@A@@@@A@@@CA@G@@@@DA@@@@C@A@@A@A@@@@B@DEASEAA@C@@A@@AAAA@@@IAE@CCA@A@@@@AAA@@@D@@A@AAB@@C@@A@@@AAO@A@@D@@G@A@"],encodeOffsets:[[112052,35603]]}},{type:"Feature",id:"610527",properties:{name:"白水县",cp:[109.590671,35.177451],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@C@@@@CABC@@@@A@A@@@C@@@E@AA@DA@G@ABANE@A@A@@B@BA@@BAB@@@FA@CA@BA@@BB@HB@@@@AB@@AAAL@@PB@@@B@BF@B@@A@@@@F@@@AFB@AHA@IAA@A@@DC@@AAA@@@A@@GAAFGA@FC@AFA@@@G@@@AFC@AFCA@@AFA@E@AD@@AF@@B@@@AF@BB@@@AD@B@@@@B@F@AF@@@@A@@F@@@@@D@B@@B@@BB@@@@B@@B@@@B@@@@B@@@@B@BAB@@@B@BB@@@BABAD@@B@B@@@@D@BA@@@A@@B@@@@@@CD@@@B@@A@A@@@@A@@A@AB@@@@B@@@@BB@@@@@@BA@@@A@I@@@@B@@AB@BA@@@C@@@AA@@A@@@A@@@A@AA@@A@AB@@AB@@A@@DA@@A@@A@@@@A@@@@AAA@@@ABADABAB@@@@A@AA@@@AAAAA@AA@A@A@@@@@AABA@@@@CA@@A@@@BAAA@@@A@@A@A@C@AACB@@A@@B@@BB@B@@@B@BA@@B@@ABAB@FAB@BA@@BC@AAA@A@@@@@@B@@@@@@C@@@@BA@@@@@@@@A@@@@@@BA@A@@AA@AAA@@@AA@@@AA@@@A@@A@@AAA@@AA@@A@@AA@@ABC@@@A@AC@AAA@@@DIAAC@A@BC@@A@@AGA@@@C@@A@ADC@ABC@AD@D@@@@AB@@AFA@@AAA@BAB@@A@@BAB@B@@@B@@ABCB@BBB@B@B@@A@@@AACAAAA@@@@AA@A@@AA@A@AAC@A@AA@@@@AD@@@@A@CAA@@@AD@B@@@B@BAD@@@BA@@BAD@@A@@@@B@BB@@B@@@B@@@@@@BB@@BB@@@BB@@B@@BB@@@BGAA@AB@@@@@AA@AB@@AA@@AA@@A@@@A@@B@@A@@@A@A@@@@@A@A@@@AB@BCB@BA@ADAB@@@@@@A@@B@@@@A@A@C@@AA@CDABGCA@ECCAABA@A@AF@@AAA@@@DCAAA@@@AFCA@@BA@A@@AAAFA@@@CAE@CAA@@@AB@BC@C@@@AB@@@BAA@@@A@A@@@@A@@BA@@@AA@@@@AA@C@@@@A@@AA@@@AA@A@AAA@DA@A@@BBDA@@@AA@@@@AB@BAB@@@@AA@@A@@B@B@@@BA@A@@AA@@ABAA@@A@@@AB@@@@@A@@@@@AA@@@B@@@@AB@A@@@@@A@@@A@@@A@@@@A@@@@BA@@B@BA@@@A@@@AA@@@A@@A@@B@@@@@@@@@@BB@@AB@@AAA@A@@@@@A@@C@@A@C@A@@A@@@@BA@A@@@A@@A@@@A@@A@@BA@@@@A@A@@@ADA@CBA@C@@@BCA@@@@@A@@@A@@@@@B@@AA@@@AAC@@@@A@@@@@@@@A@AA@@ABA@@@A@@@@AA@BC@@AAA@@A@@@A@@@@A@ABA@@A@@A@A@@@A@@@@@@@@@A@C@CBDB@@@B@@C@@@@BA@@A@AA@@@@@BDA@@@AA@@@@A@@@@@A@@A@B@@AB@B@BAA@@@A@@@@@@@@A@@@A@B@@B@BA@AB@@@B@@@@@@@@@BABB@@B@@B@BB@@@DADBBAH@@C@@B@@@BAHAA@@BA@AC@@@@BA@@@AD@@@@@C@@EA@B@@G@BC@@@AA@@B@@@@@DA@@A@A@@@A@@A@A@AA@@@@@B@@AB@@@AA@@AAAAA@@A@@@@BA@@@AEA@A@@@AA@@@A@@@C@A@@@@A@@@A@@@AB@@A@@AB@@CAA@@@@@B@@AB@BAB@D@@BB@@@BA@A@A@@@A@AAA@@@AA@A@A@A@@@@@@@BA@@B@@@@@@@B@@A@@@@@A@@A@ABA@@A@@@BE@A@@A@@@@@@@AD@D@@A@@@AA@A@@A@@@@A@@A@@@@@@D@@A@@@@@A@@@@@@A@A@A@@@@ABAB@@@@A@@A@A@BA@@BA@@AA@AC@A@@A@@B@@@B@@@@A@@A@@@@@F@@A@@@AD@BA@A@A@@BB@@@@B@@A@@@AA@@@@@B@BB@@B@@@@@@@BEB@B@B@@A@@@@A@@@@@@AB@@@@@B@B@BAB@@A@@@@AA@@@AB@@AB@@AA@@BA@@@@A@@@AA@@AAA@@@@@BABC@@AA@@@A@@@@@@@AA@@@@A@B@@@B@D@B@@AB@@@@A@A@@@B@@B@@@B@@AB@BAA@@A@@@@@AD@B@@A@AB@BA@B@@@@B@@AB@B@@FABB@@@@AB@@A@@@@@@B@@@BBB@@@@BA@@BB@@AB@@BB@@@B@@@@@B@@A@A@@AA@BB@@B@@@@BAB@@C@A@@@A@@@BA@A@@@@@@@AA@@B@@A@@B@@A@@B@@@@@@@BAA@@@@@@@@A@@B@BA@@BABCA@BEAABAAA@@@@@@@A@A@@@AD@@@@@BB@@@A@@@A@BBB@@@A@@@A@@@@@B@@@@@@@@@A@@B@@B@@@@B@@@@A@@@@@BB@@@@@B@@@@@BA@A@A@@@@@A@A@@@@BI@A@AD@@@@@B@@A@@@@@@@@B@@@B@@@@@@@@A@@@@B@@@@B@@BAB@@@FA@@B@B@@@@@@@@AACBA@@@@B@B@@@@AB@@ABABAAA@@BCB@BABABAB@B@@@B@@AH@@B@@B@@@@@B@@@B@@CDAD@B@B@B@@@@BB@@@@@BBB@BA@@B@@BB@@@B@B@@@B@@@D@@A@CACA@@C@A@A@A@@@@@@A@@A@ABA@@@ABC@A@@@AB@@A@CB@B@@A@AB@@AB@@A@BBB@BB@@@B@B@@AB@B@@B@@@@BB@D@B@B@B@@@B@@AB@@@B@B@@@@B@BB@@@BBB@@@BA@@BB@BB@@@D@B@D@@B@B@BABB@@BB@BBB@@@@B@@B@B@@@B@@BBBB@B@BBB@@@BB@B@@@BB@@@@B@@@@D@D@@@D@B@BBB@B@@@B@B@@@B@@@@BB@B@@AB@B@D@B@BBD@@B@@@BB@@@BB@@B@@@@@@@BA@@@@@@@B@@@BBB@BBAB@@@@@@BB@B@B@B@B@B@B@@@@BB@@B@BB@D@@@BBD@B@@@B@@@B@@@B@@@B@@@BAD@@BB@B@@@@BB@@D@B@@DB@@@B@@@B@@@B@BB@@@@BB@@BD@DBD@@@B@B@@@@@@B@@@BBB@@BB@BBBBB@@@@BBD@B@B@B@B@B@BBBD@@@BB@@B@@ABBDBBB@BBB@B@BB@@@@BB@@DBBB@B@@DB@@B@@@@@@B@D@@@@@BA@@BAB@BA@B@@BA@@BBD@B@@@@@B@@@@@BB@@@B@@A@@@@BAB@BABABAD@B@B@B@@@B@@BB@@@BBDBB@@@BBD@@@BBD@BBB@@BB@DB@BB@D@@BB@@@@BB@BABB@@BB@@@B@@B@@@D@@@@@@@BA@@@AB@@A@@BA@@@AB@@@B@BB@@BBBB@@B@@@BB@BB@@@B@@@@@@B@@@@@@@@@B@@B@@@BA@@@@@@B@B@@@@@@B@@@@BAB@@@BBB@@ABA@@@@@BB@B@B@@@BAB@@@@@B@BAB@@ABA@@B@B@@B@@B@@BB@@B@@BA@@@@B@@BA@@BB@BB@@A@@B@@B@BA@@@@BBB@@@B@B@@B@@@@B@@@B@@B@@BB@@@B@@B@@@@@@@AB@@@@@B@@@@B@@B@@A@@@BBD@@@B@BBBA@AD@@@@@@B@F@B@@@B@BA@@@@BB@@B@@@BB@@B@BBDBDBB@BB@@@CJ@@@B@@AD@@@B@@D@BBB@@BB@@B@@B@DBD@@@B@@@@B@@@BA@AB@@@@@BB@BB@@B@@B@BABAB@@@@B@@BB@DB@@@BA@CD@@@B@@BB@@BBBBB@@B@B@@ABADAB@B@@@BDB@BB@@@ABABAB@@@@BB@BBB@@BB@@B@D@@BBBAB@@BB@@@@@B@@BB@B@BB@@B@@B@@@B@@AB@@AD@@@@@@B@@@@AB@@@@@@D@@@B@@@@B@@ABA@@BDD@@DBB@BBBB@@BBDDDDBBB@@@D@@BB@@B@B@@BBB@@@@@B@B@B@DB@AD@@ABA@@D@@@B@@BB@B@BBB@@BB@B@@@@@@A@@BA@@B@@@B@BB@DDBBBB@BB@@BB@BBB@@B@@A@A@@AA@@@A@@@ABABABAB@BA@@B@@BB@@@@DD@BADA@@@A@A@@@@@@@@D@D@@BB@BB@@@@AA@@@@BBB@A@@@@@BB@@@@@@@@@ABB@@@B@@B@@@B@D@@@@@B@@B@@BB@@@@BABA@@@@B@@BB@@@@AAAAA@@@@BA@@BBBBDDBB@@BBBAF@H@F@FADA@@B@B@@A@A@@B@BAB@B@@@@AB@@A@@DB@@BBF@D@D@HBB@@@BA@@@A@@@A@@AAB@@@@@@@@A@@@@@@@@@A@@B@@AD@@@@CBC@C@C@AAAB@@AB@B@B@@@@@B@@A@A@@@A@ABABA@@@@@B@@@B@@@BB@@@JABB@@@@B@@A@@B@B@@@@BA@@@@@@BB@@@B@@@@@@F@@H@B@HA@@@A@@HA@ABA@C@@@@B@B@BB@@D@@@B@@@@A@@AA@A@@AA@@AA@@@@@A@@B@@@B@DBBBB@@@B@B@BB@@B@BA@@B@@A@@@A@A@@BA@A@@@@B@D@@@@@@@@B@@@B@@@B@@@@@B@@BAB@@@B@@@@@@@B@@@BA@@DAB@B@@A@@B@B@BA@@BA@@BB@BB@@@@@@AB@@@D@@@BB@@@@BA@@B@@B@@B@B@@@@@@@@@B@@@B@@B@F@B@BB@D@@BB@@BAB@@BBBBB@DBDDDBDDBB@@BA@ABAB@DABA@@@A@@@@@A@@@A@@@@@@@A@@@@@A@@@@@A@@B@@@@@@A@@@@@A@AB@@@B@@A@@@@@@B@@AB@@@B@B@@A@ABA@A@@@@@A@A@@@C@A@A@A@AAA@@@A@A@@AAAA@AA@@A@C@A@@@A@A@@@A@@AABC@CCA@@A@BA@AB@A@@AA@@@@@@@@AB@A@@AA@@@BA@@@A@@A@A@@@@A@@@@B@@@@CAAAA@@@A@@@A@@B@@@@@@@A@A@@@@A@@@A@@@@@@A@@AA@AA@@@A@@@@AAA@@@@@BAB@@@@A@@A@@AA@@AAA@A@A@A@ABC@A@@@A@A@@A@AB@@AA@@C@@AA@CC@AA@@@A@@A@@@AA@@@@@A@A@AA@@@@A@A@@@A@@@A@@@A@@@AA@@@A@@@A@@AAAAB@A@AAAA@@@A@@AA@@@A@@@A@A@@AA@@@@@ABA@@AA@A@@@AA@@AAA@@@AB@@@A@@AA@@@@@@@@AA@BAA@@ABA@@A@@A@@AA@@BA@@@@@@@AA@@@A@AAA@@@A@@A@@DA@@BA@@AA@A@@A@@@AAA@@A@@@@B@@AB@@AC@@A@@@@B@BA@@@A@AA@@@@A@@@@@A@@@@AA@A@@@@@A@A@A@@A@@@A@@@A@@@A@A@@@@A@@@@@A@A@@@AAA@@@AB@@@@@@@AAAA@@@AAAAA@@A@@@@A@@AA@@A@A@@A@@@@@@BA@@@AAA@A@@@C@@@A@@BAB@B@@@B@BBDBDDB@D@@BD@@@B@@A@@@AAC@ACA@AA@@AA@@A@A@CAE@A@@@ABABA@ADA@@B@BABB@AD@FCDAB@DAB@B@D@B@BBBA@@B@BA@A@@@@@A@@EECAAAAA@AAC@@BC@@BABA@@D@D@D@D@B@B@D@B@@@BAB@BCBA@A@@@C@E@A@@@ABABADA@@FC@@BA@A@A@C@AAAAE@@@AAC@A@@@@DCHCBAFABAB@@@@ABA@AA@@A@A@@@EA@@A@A@A@@@AB@@@D@BA@@DB@@B@FDB@@@B@BABGBC@A@@A@@AABA@ABCB@BA@A@@@CCAA@@@A@AB@@AB@DAB@@@DAB@B@D@D@D@DAD@AAAAGE@@@AAAAA@A@@@@B@@BBABAB@@A@@@@@A@@@@@@@@@@BA@@@@@@A@@@@@ABC@A@@@A@@A@AAAB@@@B@@@@@B@@A@@@AA@A@@@A@A@@AA@@@BA@@BB@@@A@@@A@@@AEACA@AB@@@BA@@@A@@@@AA@A@A@@@@@ABEB@F@B@BB@@ADBB@CBA@@@@B@@@@BD@@CB@CABEAAAF@A@A@@AA@@@@@@CA@@@DAB@@E@@@@ABCEA@@BG@@B@@D@@B@@A@@B@@@BA@AHB@@BE@ADBBE@ABC@@@@AA@@BC@@@@@AA@@A@AAA@@BEA@BEE@BE@ABCA@AA@BA@AB@@AA@@@A@@GAAFA@@@BE@@@@@ABA@@AA@@@C@A@@@@A@@@@ABA@CC@C@BE@@C@@EEA@@BEC@@@@AA@@A@AB@@@@AC@BEE@@A@@A@AA@@@@@@AAC@@@@C@@C@BE@@OA"],encodeOffsets:[[112366,35910]]}},{type:"Feature",id:"610528",properties:{name:"富平县",cp:[109.18032,34.751077],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@@A@@E@A@@@@@@@C@A@@@@DA@@@@@@C@AC@@@@BAAE@A@@D@@A@@A@@@A@@@@GAC@C@@@@A@A@AA@@D@@CA@@A@@ACAA@@@@@A@@AA@@@A@A@@@@@@BA@@A@@A@AA@@AB@@@@A@@@@AA@@@A@@@@@AB@BABAB@D@B@BA@A@ABAA@B@@@@@B@@@@@BA@A@A@A@AAA@A@ABA@AA@@A@A@A@@@@ABC@@AA@@A@A@@@@B@BAAA@A@G@@@C@@HC@@@C@CBA@BAAC@@@AA@C@BEA@BA@A@AA@@AC@@ADA@@@@@@@@C@A@A@A@CBCHCA@@A@A@@@ABA@@B@@@B@@BB@@@@A@A@@@@@@@@A@@@A@@C@@@BAA@@@@@@@AB@@AB@@A@@BA@@BAABEC@AFAA@A@@E@@@AB@@A@AB@DA@@CA@@CA@A@@@CB@D@@B@ABA@AD@BA@@DC@@AAA@A@@@@@@@B@B@B@B@@@@A@@A@E@@G@@FA@BEIAI@M@@B@@A@@@@BAA@A@@@@@B@@@@@B@@@@A@AAEC@B@@@BB@@@B@BB@@BB@@AB@@@@BBCBA@CBBCEB@FE@@@E@E@@AA@K@E@A@EAC@A@@@@A@A@@E@@BC@CA@BA@@CM@BAA@I@AFE@ADE@AGC@A@@DA@@BC@@AAA@@E@E@@@AC@@@DC@A@A@@CE@ABO@@BC@@DGVI@ADA@@@A@@@G@AAA@@CA@AAACCA@AAA@E@C@@CCCAAEA@AAAAGCACCCC@C@A@@BCF@D@@ADCD@B@BABABAB@B@FABEA@@@C@@A@ABAB@@BD@BB@@@@B@@BDBBA@@B@B@@@BA@A@@@@@@B@BB@@B@BAD@DD@AFRBAFDBBD@DAJ@AA@@BAD@BD@@@@F@B@@DBBBBBBB@@@@@@@B@BA@@@ABA@@BB@@B@B@BAB@@@B@@@BAB@B@@@B@@CB@BABA@E@@CIAAF@BB@@BABCD@B@B@@@@CB@@ABA@A@@@AB@BA@A@@@@B@BA@CBC@A@@@@@@B@B@@@@ABABAB@BAB@B@B@@A@CBA@AB@@@@BD@@A@@B@@A@@@AB@@@D@B@@@B@@A@@@A@A@A@@BBB@@B@BB@@@B@@@@A@A@AAA@@@@@@BBB@BABA@@BB@@@@BC@@@@AA@CB@@@AA@@@@BA@@@BB@B@@@BA@AD@@A@@@@@A@@B@@AB@@A@A@@@ABADC@@@@@ABA@@B@@@D@@C@BA@AA@@@@@A@@B@DA@A@AF@@@BB@@@@B@@@@A@@B@@@@@@A@@@@B@@A@@@@B@@@@@B@B@@BB@@BBB@BEB@@BABB@@B@B@@AF@@@@@B@@B@B@@B@@B@@B@@@@AD@@A@@@@@@B@@@B@BADAA@@@BAB@@CA@@@BA@@@@@@DC@@@@B@D@@C@@@@@@D@B@@A@@@@B@@@@@@@D@@A@@B@BC@@@@B@@B@@@@B@D@@D@@@BB@@A@@B@@@B@@@B@@@DA@@DB@@B@B@B@@DB@@B@B@DB@@@D@B@@D@@B@BB@@@@BB@@@@D@@FB@@A@@@A@@@@@BB@@@@@@B@BBB@DBBB@@BBD@B@B@DADABA@@B@@AD@BB@@@B@@B@DBB@D@B@@AD@@@B@@A@A@ABA@BDBBBD@B@@BB@BBBB@@B@@BBABB@@B@DAB@@BB@B@BBBBB@B@BB@@AD@BC@AB@BA@@B@@D@@BB@B@B@HA@@@@B@B@B@@@BAB@B@B@@@@AA@A@@@@@BAB@BB@B@ABABA@@B@@@DA@@B@@AB@@@BA@A@@@A@@AA@@B@@@BBDA@@@@@A@@B@@@@@@A@AB@@@@@@BDB@@BA@@B@BB@B@@@BBBB@BDBB@@BD@@@BA@@@@DABA@@@@@CA@@@@@D@BBB@@@BA@@B@@@@BBB@B@BBB@@B@@BADAB@@@@@BAB@@@B@@@@@BBB@BBBBF@BBB@D@@B@@@@@BB@BB@BB@@@B@B@B@B@B@D@B@@BB@B@@BB@@@@@CB@@CDABA@AB@@@@C@@BA@AB@B@@@@@@B@@@BA@@BBB@BBBB@@B@BB@@@@BB@@B@BB@BB@@B@@EB@BA@A@@@CB@@ADAD@@CBADAD@@AD@D@BABAD@F@B@@@@@B@@B@B@B@@@@@@D@@BA@@@@@@B@@A@@B@B@B@@@B@BABABEB@@B@@@B@@BBBB@BBB@@@BA@@DABA@@DA@@@ABB@@BDB@@B@AA@@@A@@@@B@B@B@D@@@@@@B@@AB@@@DA@@B@B@B@@B@B@@@DC@@@@@@@B@B@@B@@@@A@@B@@@D@@ABAB@@@@B@@BB@@B@B@D@B@BAB@BA@@B@HA@AFB@@BB@B@B@@@B@BAB@@@BDB@@@BB@@@DBB@@B@B@B@B@@BFBBB@@BB@@AD@D@B@BAB@@@B@@@AB@B@@@B@B@@B@@B@@BB@@@BB@B@BA@@BA@AB@@@B@@AB@BA@AFBB@B@B@@@B@@@B@D@@AB@BABAB@@@B@@B@@@@@B@@A@@BA@ABAB@@B@@BB@BBB@B@DAD@@@BB@B@BBHBB@BBB@@BDBBB@@@B@B@B@@@@@B@@@BA@@@@@B@@B@@@@A@@@@A@BA@@@@@@@AB@@@@@BAB@BB@@@@B@@@@@B@@@B@@@@B@@B@BDB@DBDBDBB@B@B@@@@A@@@AB@BA@@BAB@@@B@@@@@BBBB@@FB@@@BB@@@BBBB@B@BABA@AB@@@@@BA@@@@BA@@B@@@@@@AB@@@BA@@@BBA@@@ABBBBBB@@B@@B@BBB@@B@@@B@B@B@B@B@@@BA@@B@B@B@D@BB@BBB@DBB@B@D@@@B@DB@B@@@B@BB@@BC@A@@@@BA@BD@BBBBBB@DB@@BAFCB@B@@@@A@@@@B@@@B@BAB@@@B@B@BB@@BDB@@@@@@BB@@B@@@@BB@@@BB@@@@@@@B@B@BB@@@@B@@@@@@@BA@@B@@B@@@@@BB@@@BBB@@BA@A@C@AD@@@B@@@@@@AB@@@B@@@@BBB@BB@@@BB@BB@BBBBD@BB@@@BB@BBB@DCB@BAB@B@BB@B@B@F@B@@BBB@@B@@@F@BAD@BBB@@B@@D@B@B@@@BBB@@@D@F@@B@@@@AB@@@@B@BB@@@@BD@@@B@@A@@BAB@B@@@BADA@@B@@@@@D@DBB@@@@A@ABABAB@@@B@@@@HAD@DAB@DA@@B@BBBB@@FA@AAA@AA@@A@@BA@A@A@@@AB@@A@A@@BA@@@A@AB@@AD@@@@AB@@@@@@@BB@@@BB@B@@@BA@@B@@BB@BB@@B@@AB@@@@A@@BB@@B@F@@@@@CFA@ABAB@@@@B@B@@B@@A@AB@@@@BD@B@@@@@B@BBBD@F@@@B@B@@@AFB@@@B@B@@@BB@@@A@@B@@B@@@@@A@@@A@A@A@A@A@@@A@@@@BAFAB@@@BBB@@@@@@@@@@A@@@A@@@AAAB@@AAA@@BAB@BDDBB@@BB@BADAB@@@D@B@@@B@BB@@@B@BBB@@@@BABA@@@@@@BB@@@D@@B@BAB@BA@BB@@@B@ABBB@BBA@AB@@@@@BBBBBBB@B@D@B@B@@@@AAA@AB@B@@@@@B@@A@@BB@@@@@BB@@@@ABC@@@@BDBB@@B@@BB@@A@@@CBA@@@@B@@@@@@@B@@@@@B@@@@BB@B@@@@@B@@@@@@@BB@D@B@BB@B@D@B@BA@@B@@B@@BB@BBA@@@A@A@A@A@A@@BA@@B@@B@@D@@@BB@@@B@B@D@@@@@@B@@CBC@@BA@@@AB@@@@@@@@B@BB@B@B@BA@@@A@A@@B@@B@@@B@@@@@@BA@@@@@@@@@D@@@@@@B@@A@ABA@@@A@@@AB@@@@@@A@@@A@@B@B@B@@A@@B@@@@A@ADGBABA@@@A@A@@BABC@@@CB@@A@@A@@A@A@A@A@@BAD@BB@AF@B@B@B@D@@@BA@ABA@@@A@A@@AAAA@@BA@A@A@A@@BA@A@A@@@AAAC@@AA@@A@@B@@A@@@@BA@@@@DA@@BABA@A@A@ABC@ABADA@@D@Da@@BC@A@AB@@@@@BA@A@@@@BED@BIEA@EEABI@I@CHC@@DI@@BAA@A@C@@@@EDE@C@@@A@AA@ACEGKME@UCGAC@WA@C@AA@A@@CEA@@DCBA@@@C@@F@@@D@@C@@@@@GA@G@@@ABA@@B@@C@GAA@@@@C@@@@C@@@@A@@@E@@GA@@@C@@@@GA@@@@@GB@F@@@BC@@B@@C@@D@B@@A@I@@@@QA@@BEBE@@A@AA@A@C@A@A@AG@@FE@@EGA@EGA@CIAA@BEYC@CCA@@@@BIF@@C@A@EBKIA@E@@EA@C@@AA@@BE@A@A@CBC@CEA@@@@@CBIBAF@BEJB@E@AD@@C@@D@B@@AB@D@BC@@D@BE@ABCB@@AJBB@BBJ@@@@@BE@@@@B@@@@F@@@@PBBEB@@BBA@A@CD@BCD@B@@A@E@EBE@E@EBI@G@ABO@@@@@AA@BAAA@@A@@AB@AA@C@@BC@@BE@A@AD@@@@@@@BA@A@@B@B@BA@@B@@@@C@@@@@EBCBGB@@@@EC@@EB@BKB@BKA@@C@A@@E@GA@EA@MAE@AFC@@EGA@BABA@@@A@@BOABEI@@DAHYC@AB@BCC@@EA@C@@@@ABC@@@ABA@A@ED@@@@@@C@AC@@@@@@C@A@E@@G@BE@ECAA@A@@@AD@BA@@A@A@AC@@@A@@@@A@@BG@@@C@@@CA@@AA@@@I@A@A@@@@A@CE@@@@@@@AB@@C@@BA@@@@C@@@AA@"],encodeOffsets:[[111955,35529]]}},{type:"Feature",id:"610582",properties:{name:"华阴市",cp:[110.092078,34.566079],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAA@A@ABEA@@A@A@@@@@A@@@A@@@@@A@A@@@A@@@@@AAC@@ABAAA@AACA@@A@AAAA@@@AA@@AA@@@AAA@@AA@@A@AAA@@AA@@BA@@@@@@A@AAAA@A@@@@@@A@AAA@@AA@@AA@AACA@@@@A@AB@@A@A@@B@@ABA@CB@@AAAAA@@BC@@@@@AA@A@A@@AA@@@AA@C@@@C@@@@@AA@AA@AAAAAAAAA@A@@@ABE@@@C@CCAA@@ABA@C@@BA@@B@@AB@@A@C@A@AD@@@@@@CBABA@ABA@C@A@C@@B@BA@@@@DA@@BC@AB@@@@A@@BA@AB@@A@CAA@A@A@AAC@CAAAA@@@A@@A@@CA@@C@EBA@A@ABA@@@AA@@A@CA@@AAA@@@CBABA@A@AB@@A@A@@@CAA@C@CAA@A@CBCB@@@BBBBD@BABBDA@@B@@@B@BA@@BABAB@@@BB@@D@BB@@@B@BB@@BB@@B@B@BBB@BBBBBBBB@BBB@@@@BBF@FBB@B@@@B@@BABAB@DB@@B@BBBDDBBBBBBBBDDB@@BF@@BB@@B@@AB@BAD@@@B@@A@AB@@@@BD@@@B@@@@A@AA@@@@A@@BAB@B@BB@BBHBB@B@B@DA@@@@DBBBB@B@DA@@B@DBDAB@B@@@BBB@@@DBB@@@@@@BCB@B@B@BA@ADA@@BADAB@@A@ABCAABA@@@@@A@A@@BA@@BABAB@BA@@@A@@@A@@@AAA@@B@@AB@B@BE@G@CB@AAAA@AACBKCA@CA@@AA@AA@@@@A@@@@AAA@A@A@CBA@A@A@@@A@AB@BAB@B@@AD@DAB@@CBA@@@ABAB@F@@CB@B@@@D@@@BA@@BBDAB@@@B@@ABA@@B@@@BAB@B@B@D@@AB@B@BA@@@@@@BBBBH@BA@@BAB@B@@AD@@@B@@B@@BBB@@@B@DAB@@BB@@BB@BA@@BBB@@@BA@@B@@AB@B@B@@@@@B@B@@@D@B@@@BA@@B@B@B@@@B@@@BB@DB@@@B@B@@A@@B@D@@@BB@@BA@ADAB@@@B@B@@@B@B@@@B@D@@@@DBABA@ADA@@B@@@@D@ABD@@B@D@D@B@@BB@BBFBFBBAB@B@BCH@BAB@F@@@B@@@BBB@DAD@NCX@H@H@HJGDADAB@DAD@DBD@DBFBB@BBB@BBDBDF@D@B@B@HEPAB@B@BBBBFBDDBBBB@B@B@DAF@D@F@B@JBD@B@@@BA@A@A@AAC@@BA@@B@@@@ADABA@@B@BAF@DBD@F@HBB@J@DBB@B@BBDBPNDB@@B@B@DADABA@A@A@A@CCIAA@AB@@@DAB@FABAD@D@B@BBB@B@HBB@DBB@BBB@BBD@@@DBF@BBB@B@HAF@BAB@D@B@B@F@HBB@DBF@FAD@@@B@BBB@@B@BBB@DB@BDB@BBD@@@B@B@BAD@BABA@@DABABAD@B@B@BBB@FBDBFBB@DBBBDBDDBBDBBDD@BBD@B@D@BB@@@@@B@@CBIHEFCBA@@B@B@@BBB@PDB@BBD@HBB@B@DAB@DA@ABABA@ADA@@FCHGDCDE@@BC@A@AACAE@C@A@A@@BAD@DBF@BBD@BBBBDBB@B@B@B@BAJCDAFA@@D@B@BBB@BBFF@ABAAK@@@AA@@A@A@A@G@@@CBE@@@@B@@@BAA@@A@ABC@@@@@AC@@G@@B@@A@@@A@@A@@@@A@@@@DAB@BCB@B@@@B@@@@@B@B@B@@@@@@B@@@@@B@@@B@@@BB@@@BD@@B@B@B@@@@ABA@A@@A@AA@@@@B@@AA@@@B@@@B@B@B@@A@@@@@@ACCEA@AAAA@A@@@A@@BA@@@@@@@@A@A@AA@@CE@C@C@@@AB@@AB@@A@@@@B@@@@AB@D@@@@A@A@@@A@@A@AA@@@A@@BA@@AA@AC@@@@@A@@@@AB@@@@@BA@ABA@@B@@A@@BA@@BA@A@ABA@@@@B@@@BB@A@@@@@A@@@@@A@@@@@@BABBB@@@@@@A@A@A@@@A@@@C@@@@BB@D@@@@B@@@BB@@BBBA@@@@@@B@BA@@@AB@@AA@@@A@@AB@@@@@@@AA@@AA@@@C@C@@@A@A@A@CA@@CBA@@@C@A@C@@@AAA@C@G@C@ABA@@F@F@@@B@@@@AA@A@@A@@@@@@B@@@@@@@@A@@@@@@@@BB@@B@@@A@@AB@@A@@@@AACGCAA@@A@@@@A@AA@@A@@@A@@@A@ABA@@@A@A@@@AA@@@@A@@A@A@@@A@@A@@A@@A@A@@@@A@@@A@@AA@@B@@@HDBBB@@@@@@AA@AA@@@@B@B@@@@@AA@@@@@@BBB@@@@@@AAAAAAAC@@A@C@@AA@@AAC@@AA@AC@@CE@@@A@@@A@A@AAA@@@@@AAABAA@@@@A@@@CB@AA@@@A@@@C@@AA@A@AA@BA@@BC@@@@@A@@@@BABAD@BCB@BAB@@A@@BABE@@AAAA@A@@AAA@A@AAA@A@AA@@@@@A@@@AACAA@@@@CA@AAAAA@@@A@A@@@AC@@@@@AA@@CAAAAAA@A@A@A@AAA@@A@A@C@@@AAC@@AAA@A@@A@@@@CC@@@@C@A@@@A@C@AAA@A@AB@@A@A@A@@@C@@@C@@@A@CBA@@@@@E@@@A@AA@AAAA@@AA@@@CA@@A@C@@@A@GB@@A@A@A@@BA@A@ABA@CAA@EDA@@@CACAA@A@A@A@A@CC@@AA@@@@A@CA@@@AA@@@A@AA@@AAAA@@C@AA@AA@@A@@A@A@ABC@@@A@CACAAAAA@@A@AA@@A@@@@A@@C@CBC@@@A@A@A@A@A@EAAA@@@@"],encodeOffsets:[[112702,35239]]}},{type:"Feature",id:"610581",properties:{name:"韩城市",cp:[110.442846,35.476788],childNum:1},geometry:{type:"Polygon",coordinates:["@@EGEMGM@@Q]CG@@EK@@GS@@GYCMEQAI@CAEAGEOGM@@KMKGECEAMEUIUGIGEKEOEUCIAGACES@AG[AGCICICQAC@@GO@@GGMKGECAII@@QTA@KBIB@@@BBDC@@F@@CB@@@@GB@@@DI@@@A@A@CB@@@@A@C@@@@DC@@C@AE@@A@@@A@@@CB@@EA@@@AEC@E@@A@AC@@@@@@@C@@@@CABA@@BE@@@G@A@@BG@G@@DE@@@@@G@@@@B@@CB@@@@@@A@@@@@AC@A@@SB@EC@A@@B@@@B@@E@@@@C@A@@BA@A@A@A@@B@A@@A@BA@@@@@@A@@A@C@@BCBA@@BA@@@@@A@@B@@@@@BB@@BB@D@@@@@BF@@@@@BBD@B@D@@B@@@BHQ@@@@@@B@@@@AB@B@@O@@@@A@A@@A@@@@C@@@@A@@@A@@B@@A@@CA@@BA@ACA@AACC@AA@ABA@@BA@@@@@ABA@A@@B@@@@@@ABA@@@ABABAB@DA@@B@@ABA@@@A@A@@@AB@BA@AB@@@@AA@@A@A@A@A@@@K@EA@@@A@@E@E@@@@@@D@@E@@@A@C@@@@@@@@@A@@@@BKBEB@DI@BBG@@AA@@BA@A@QDA@A@AB@@E@@DB@ED@A@@AA@BIBABC@A@A@@B@@@BABA@@@@B@@AB@BABCBAB@B@@A@A@@@@@A@A@ABAB@@@@@BB@D@B@@@A@@@A@@B@B@BAB@@A@C@@@@B@@AB@@@@@@@BBF@@@@B@@@@B@@DB@@@@@B@@@@@@@B@B@@B@@@AB@B@@@B@@@@@@AB@@@@@BA@@@@B@@@@A@@BA@@@@@@B@BABA@@B@@A@A@@B@@A@A@@B@@A@CB@B@@A@@B@@A@@@@B@BAB@B@@@B@@AB@@@B@@A@@@A@@B@B@@@@ABA@@A@AA@CBA@A@@@CB@@@@A@C@A@ABC@@@CDC@CAABA@A@@BCBA@A@EBE@ABC@ABA@@@@DA@BBA@A@A@EDA@@BAB@@AD@@@BA@@BAB@BABA@@@ABABABA@C@@B@B@@B@@BBB@@@B@B@@B@@AB@BB@@BB@@BBBB@@BB@@@BB@@@B@@@@@@B@@BB@@@BA@@B@B@@BB@@B@@@BBFB@@B@@@@@@B@@AB@BA@B@@@DB@@@@@BB@B@@B@@@@B@@@B@@B@@@@@@BA@AB@@BB@@@@AB@@B@@B@@@@@@B@@@@A@@D@@B@@BB@D@B@@AB@DBD@@@B@@BD@@BB@B@B@B@BBBBDB@@D@@BD@DBB@@@@@@@BBDB@ABBB@BBB@B@BB@@@@@@@B@@@@BBB@@B@@@@BBBBBB@@@@@@@B@@BB@@B@BB@@@@DBB@B@@@BBB@B@@BB@D@@B@@@BB@B@@BBB@BB@@BBB@B@@@B@@@BAB@@BBB@@B@@BB@B@@DBBB@@B@@@@@@D@B@@@B@B@BBBDBB@@@B@B@B@B@B@BBF@B@@@B@B@@@BAB@@@DDB@@BB@@@@@@@A@AB@@B@@@@BA@@B@@BB@@@B@@A@AAA@@BA@@B@BAB@DADB@@B@B@@@B@@ADAF@F@BBD@BABAB@@@B@@AB@BAB@@AB@B@BAB@B@B@@ABA@@@@B@@AB@@B@B@@@B@B@@BB@BB@@BADBB@D@DADA@ADA@AB@B@BAD@@@B@@@B@@@B@DAB@BBD@BAB@BA@@BAB@@@B@B@BAB@B@B@B@B@BAB@BA@@B@@BBB@@@@@A@@B@@@BDB@@AB@B@B@B@B@BB@@@@@B@D@D@@@@E@A@@@@DAD@B@D@@@@AB@@@BADB@DBD@B@@@BBB@B@BB@@B@BB@B@@B@@@FB@BB@B@@B@@A@AB@@@BA@@@@@@B@B@@CBABA@AB@@ABEBC@MBAB@@CBC@@@A@@BA@A@@@CB@@@@AB@@@B@B@@@@A@ABC@@@@@@B@BB@@@CD@@@@@BB@@BBB@D@@BB@@BBA@BBABB@@@BBBBB@@@@B@B@BA@A@@BA@@BAB@@@B@@@B@@@@@BB@@B@@C@G@@@@@AD@B@B@@@BCBCBA@@@ABAA@@@BA@@@C@@@ABA@AB@@ABAB@@@@@BCBAB@@@B@BA@AB@B@B@BB@@@@B@@@@BB@@AB@B@@B@@BB@@@@BA@@B@@A@@BA@@BA@@@C@@@@@BBDBD@@@@@@BCAA@@@@@A@@DA@@B@@AA@@@@A@@@@@@ADA@A@@A@@@A@A@CA@@@@CDA@@@A@@@A@CBA@ABAB@BA@@B@@A@A@@@A@@@ABA@A@@B@@@B@B@@ADAB@@AB@@B@AB@B@@A@@BA@@@A@AB@@A@A@@B@@AB@@A@@@ABAAA@AB@AC@A@@BA@@@ABA@@@A@@@@@A@@@AAA@@AA@@@@@@@A@@@ABA@@@A@A@@@A@@@A@A@A@CB@BA@@BA@@@A@@@A@A@ABAB@BA@AB@@@@@@A@@BA@A@@@A@A@C@@@@@@BCAABA@C@@@C@@BAB@@@B@@@B@@@@ABA@@@A@C@A@CB@BA@CB@@ABABA@@@A@@@@@@B@@@B@@BB@B@@@BD@BBB@@@@@@B@@DBB@@@B@B@@@@B@@DA@BBA@@B@BB@@@BBB@B@@@B@@BB@BB@@BB@@B@@@BB@BBB@@@BB@@@BB@@@B@BB@@BB@@@@B@@@@@BB@BB@ABBB@B@@B@@@@BB@@@@BBB@@@BB@@@BB@@BB@@BBB@BBB@@AB@@BB@@BDA@@B@@@BAB@@BB@@BB@B@B@@@BB@@BBB@B@B@@@@@BA@@@@@@B@@@B@@@@@BB@B@@B@B@@@@@BBB@@@D@B@@AB@BAB@@@BD@@@@B@B@B@B@@BB@B@B@D@BDBA@A@@@BB@@@B@@BB@@A@@@A@@@A@@B@@BB@BBBB@@BA@@B@@@@@BA@@BBB@@BB@@BB@@@BB@ABADABBB@BC@AB@@A@@BA@@B@B@B@B@B@B@B@@@B@F@B@DAD@@@@BB@@@BAB@@@D@@AB@BBB@@@BA@@BC@@BB@@B@@@D@B@@@B@@AB@B@BD@@B@BBB@BABB@@@@BAB@BA@@B@BBB@BA@@B@B@@@BAB@D@@BBBB@@@B@@@@B@@BBBB@@BBB@@@@DDB@@@B@@B@B@@@BBB@@@@@@B@B@@DF@@ABB@AB@BB@@@@@@BBB@@B@B@BBB@B@BBB@@@F@@B@@@BB@@B@B@@B@BB@@BAB@@BD@B@@@BBDB@@B@B@@@BD@@B@@@BB@@AB@@BD@@B@@@@B@BB@B@@B@@B@@BBB@B@BB@@@B@BA@@DCB@B@@@DAB@B@B@B@BB@@@@B@BA@@B@@@BBB@B@@B@@B@B@DA@@@BB@F@B@@@B@@@@@B@@BB@BB@@B@@BBBB@@@BBB@B@B@@@@@@@B@BB@@B@BBA@B@@B@@@@A@@@@BB@@B@B@B@BBBB@@@@@BB@B@@B@@BA@@@@@@B@@@B@@@BB@@B@BB@@DDBB@@B@@BB@@B@@B@@@@BB@B@@BB@@BBB@@B@@@B@@@@@BD@@@DBBDB@@BB@D@@BB@@BB@BBB@@@BBB@B@B@BB@@@BAB@@BBBBAB@@BB@BB@@B@@@B@@@@AB@@BB@@B@@@B@@B@@B@BB@@@BB@BBBB@@@BB@@@B@D@B@DB@@B@@AB@D@FB@@B@B@@@B@BB@@D@@@B@@B@@BB@@B@@B@@B@@@@B@@B@B@@@B@@B@@BBBB@@B@B@@@B@@@DB@@B@BBD@@@@@AB@@@BD@D@B@@@B@B@@@@@B@B@B@B@B@@@BB@@B@B@B@@@D@@B@@@@@B@@@@B@@@@@@B@@@B@@@BB@@@@@B@@@@@D@@@B@@@BBB@@BBB@@@B@BB@@B@@B@@A@@BADE@@B@@AB@BA@@B@@BD@BB@ABB@ABD@B@@B@DB@@@@@BB@@B@@AB@@@B@@B@@@DB@@ABADAB@@@BD@DBB@B@BAB@@@@@@B@@@@@BB@@B@@@@@@BA@AB@@@B@@@B@BB@@B@B@BBB@@@BB@@@BBBB@@BB@@@B@B@B@@@@@@AA@B@B@B@@@@@B@@@FD@BBDBDBBBB@@@BA@@@A@@B@@BB@@B@@@BADCB@B@@@B@@BBB@@BBD@F@B@BBB@@B@B@B@B@@@B@@@BD@BBB@@@B@BA@@@CAE@A@E@A@@BA@@B@B@@@B@@@@C@A@G@CBI@GDEFGJEFCFKBAFMBE@C@I@E@CCEGGAG@EBEJIHM@@BCBA@G@S@E@G@@BIBI@MDO@C@C@E@ECEAE@CBE@@FGBCBE@CBAB@DAFCDCBE@CAO@I@CBCDCDAF@HA@@BA@ADQDKBM@G@@C[AEAKAQCAIECCACGOGQK[EK"],encodeOffsets:[[113232,36411]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
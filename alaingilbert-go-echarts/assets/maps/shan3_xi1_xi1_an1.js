@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("西安",{type:"FeatureCollection",features:[{type:"Feature",id:"610102",properties:{name:"新城区",cp:[108.960716,34.266447],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@C@G@MK@@BA@@DA@@BC@I@@H@@C@C@CB@@C@C@@B@D@@@BC@@DA@@AC@@@G@@D@@@BA@@@D@@DF@@@@D@@@@@B@@@@@B@BC@@BA@A@A@@@@@@BA@BBBAD@B@@@F@@BEDA@@BA@@@D@@FC@@BA@C@@@@@A@A@@@@@A@@@A@@@A@@@@@@@A@C@A@@A@@AC@@A@@BB@A@@B@@@@A@C@A@@@@@A@C@C@@@A@A@A@AB@A@@@A@@@A@A@A@@@A@@@@@@@@@A@A@@@@@@@A@@@@@BA@@@@@A@@@@@E@@@@@A@E@E@@@C@E@A@A@C@C@C@@@@A@@@@@BA@@D@BBB@@@B@B@B@B@D@@@B@B@@@D@@@B@@@@@B@@@B@@@@@@@@@BF@@@BB@@A@@@A@@@A@@B@@@B@B@@@@@@@DBB@@BBAB@@@@@FH@@D@BE@@@A@@BB@@BBD@B@@@@@BHA@@@G@AB@H@@@D@J@@D@@@@H@@AB@D@BB@BA@@BJ@@@@@B@@@B@B@@@L@D@B@FAB@B@@@@@@C@@@@D@@C@AD@@C@@D@B@D@BB@@B@HBDBD@B@D@B@B@@C@@F@@A@ABBBA@A@@BA@A@@@A@C@@@E@AQ@@AN@@AD@@C@@@C@@BE@A@@BEAA@A@@AAI@@@C@@@@ACB@AH@@@@@@@@A@@BABB@BB@@A@AD@B@@@B@BA@@@CAE@@@C@@D@@BB@@AB@@@@A@C"],encodeOffsets:[[111648,35071]]}},{type:"Feature",id:"610103",properties:{name:"碑林区",cp:[108.94059,34.256783],childNum:1},geometry:{type:"Polygon",coordinates:["@@I@@@C@@@@@E@@@E@C@@@A@K@@@A@A@@@ABA@A@@BAA@@A@@@A@@B@@@B@@CBCBGBGDEDB@@DAFB@@B@BB@D@@B@@@BD@J@@@@@F@@DB@F@F@D@B@F@@B@@B@@H@@@DADF@B@B@@@B@B@B@B@D@@@B@@@@@@B@@D@D@D@B@B@F@D@@@F@F@B@@@@@F@@@@@B@@@@@B@@A@@@@@B@@@@@@@B@B@@@@@@@@@B@@@B@B@B@@@B@@@BBAB@B@B@@@D@D@B@@@@@B@D@B@@@@@@AB@A@@AB@@@BD@@@BB@D@B@@@@@@@B@@@B@@@B@@@@@B@B@@@@@D@B@@AD@@EC@@@B@@AB@FC@AE@@@A@C@ABAAB@@A@@@@B@B@B@@AD@@A@A@@@@@A@@@@@C@@E@@CC@@@B@@A@@@CH@@@D@@BB@@CD@@A@@@C@AA@C@A@C@E@@AA@@@A@@@@A@@A@CA@BGCIC@A@@A@A@@@@A@@ABAAB@AA@BA@DGCA@@C@@AA@A@@BADA@@@@@BABA@@@@A@C@@BBB@@A@AA@@A@A@@@AB@A@@A@A@BACA@@@@A@@@E@A@A@@DB@@@@@@B@@@B@@C@@BC@@AA@@AA@@AA@@C@@@@"],encodeOffsets:[[111569,35052]]}},{type:"Feature",id:"610104",properties:{name:"莲湖区",cp:[108.943895,34.265239],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@AA@@@A@@@@@A@@@@@@@A@@@@AABA@A@@BA@A@@B@@@@@BB@@B@@@@BBCBA@CGC@AA@@E@AA@@A@@@C@@DE@@D@@@B@@@@B@DB@@BB@B@DB@@B@D@BA@@B@@A@@A@C@C@A@AE@@@E@A@@N@@@@E@A@A@@@G@G@A@I@A@M@A@@@@F@@@B@B@BBA@@BA@B@DC@@D@BF@@BC@@DA@@B@@@@B@BAH@@B@BA@@D@@@@B@@B@@@FE@@BA@@@@B@D@B@@@BA@@@@BB@@BAB@@@B@@AB@@@@@H@BB@@BD@CB@@@B@BD@B@B@F@D@B@F@@AD@@@B@B@@AH@b@BDR@T@H@D@B@D@D@@B@@@@@@@@@@B@B@@@@@@@@AB@@B@@B@@A@BBA@@B@@BB@@A@@@BB@@@@@@@B@@@@@@AD@@BB@@A@@@BB@@@@@@@@@@BB@@C@@F@F@@@F@F@B@@@B@B@@@F@@A@CG@@E@@@@BAAA@@AA@C@@@@@@@A@A@@@AB@@@B@@@B@@@AA@@E@@A@@@@@@@@@A@@@A@@@@@A@@@C@@@A@A@@@C@A@A@A@A@@AA@A@CB@@AA@@@C@A@A@A@A@@@A@A@E@BC@C@@@GA@@@@AE@A@C@E@E@A@@CE@@@@@I@C@@A@@@AC@A@@A@AA@BE@CA@"],encodeOffsets:[[111524,35062]]}},{type:"Feature",id:"610111",properties:{name:"灞桥区",cp:[109.064646,34.272793],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@AAABCBACABCA@@@CABAA@A@@AA@@A@@AB@A@@A@A@@@@@AB@@AA@@A@AB@B@@CDCBA@@BABA@@B@@@B@@@@@@ABB@@B@B@B@BAD@@@BAB@@ABCB@B@B@@@BAB@BCDAB@@@@@B@@@B@@@@A@@BA@@B@@@BAB@HAB@B@BBB@BBDAB@B@B@B@@@B@@@D@@BB@B@DAB@@BB@@@B@D@B@B@B@@@BBF@B@@BH@BA@@@AB@B@@BB@B@@AD@@@B@D@BAB@B@B@@@@A@A@G@A@O@@@C@A@@@A@@BA@@AC@@@@D@@BF@D@@ABA@@@A@C@@B@BA@@AAAAB@@@B@@@@@@G@@BDA@B@@D@@@J@BB@@@BBBAF@@@BAF@@@D@@@DC@@BM@@BR@@B@F@@@D@B@@@BAB@@@BABAA@B@BE@@@@DA@A@C@A@C@CAGAA@@@AAC@A@C@@@@DC@@B@DC@@@@@F@@BF@J@@D@B@@@BE@@BB@@@B@@BB@@@B@B@B@@B@@@@@@@B@@ABB@@@C@@BD@@D@@@@B@@A@@D@B@BCB@BBDBBBBBF@B@B@B@FB@@@BB@@AF@B@CDDBDBCDH@@@@AB@@D@@ABHH@@@@DDBBBB@@AB@@ABA@A@ABA@ABADA@A@AB@@A@@@A@@@@BA@@@A@@BIFAB@@A@GH@@A@CB@BABADADAB@B@B@@@HABBBAF@FBB@H@JAH@F@B@R@D@B@D@B@F@D@B@N@D@F@DBFBD@BBFBFBBFFBDDBDDBBBBDAB@DBDBJBD@BBFBD@DBHBBBB@BBBDDBBDBBDF@@DDFJ@@HIDC@@DCBCB@BCBAFG@@@A@@BE@A@@@@A@@AD@BC@@BA@@D@@@D@BABB@BF@F@@AH@B@@BF@@CD@@AB@BAF@@AD@BDB@@D@@@@@@F@@@B@@@@A@@@@B@@AB@B@@BB@B@@@BGA@@@B@BABCBB@@BABBDE@@BAA@AAA@A@@@@@@A@@A@@@AACAA@@AB@@AB@B@@A@A@@B@@C@@C@AAAC@AAC@C@@C@@A@A@A@AA@@@C@@C@AD@@CACC@A@@@DA@@A@@@A@C@@ACB@CEB@A@@AACB@@@@@AA@@@AAACA@@@@@C@@A@@@CC@@A@@@A@C@@@CFAJ@ACB@@AB@@@@@@A@AB@@@A@A@@@B@BAA@@AB@@@@A@@@AB@@@@AA@@A@@@@@A@@AA@@A@@@@@AAB@@@B@@A@A@@A@@AB@@AE@@@@AA@A@@@@AA@@@@CAA@@BAB@B@@C@@B@@@B@@@BA@@A@ACA@@@AI@@FA@AB@@@B@@A@@F@B@@@@@B@B@@@D@@@BDD@DA@A@@@A@@FB@@@@BB@@D@@@BA@@BBD@B@B@@A@@@AAAB@@@B@B@B@@@ACD@@A@@D@@AB@@@B@D@@@@@C@@A@@A@@AD@@@@@C@@@AA@@@@@A@@B@AA@A@@A@ABA@A@@AA@@@@AB@@@A@@A@@@@B@@AD@@AC@@A@@A@@EB@@@@AB@AA@C@@B@B@@A@@@A@@@A@@@@@AB@@A@@BAB@B@@AB@@@@@@@B@@@BA@@@@@@BAB@BA@@B@@@B@@@B@@@D@B@BBB@@@@@@@@@@@B@@@@@@@@@B@@@@@BABBBAB@B@@@@ABA@@@@DB@@BA@@@A@@B@@@@@B@@@@B@@@@B@B@@B@@A@@B@@F@BA@@B@@@@@B@@@@@B@@@B@@@@@@@@@BA@@@@B@@ADABA@@@@@@@ABA@@B@@@@@BB@B@@B@B@@@@@@BBAB@@B@@B@@A@@@@A@@@A@@@BA@A@@B@@@B@D@@@B@@@@@AAAAAA@@@@B@B@B@@@B@@@B@@@@@BA@@@@@@B@@ABB@@B@@@@@B@@@@@BB@@@@B@@@@@BB@@@@AB@@A@C@@@AB@B@@@B@@@B@@@@@BB@@@@@A@@B@@@@B@D@@@BA@@@A@@DAB@@@B@@@@@@@@@BA@@@BB@@@@@B@B@@@@@B@B@B@@@BB@@B@@@B@@@B@@@@@B@@BBBBD@BD@@B@@@@B@@@BBB@@@@@B@@B@B@@B@B@@DB@B@@@B@@@F@BBB@@@@@@@B@@@@@@@B@@D@B@B@B@@@@@@B@B@BA@@BA@B@@@BB@BB@AB@@A@@B@@@@D@@BB@@BA@B@@@@D@@@@@B@@@@@B@@A@@BBB@@BB@@AB@@@BA@@B@@@@AB@A@AAA@@@BAB@@@B@@AAB@AA@@@B@@A@@@@B@B@@@DDB@@B@BB@@@@@DA@ADB@@@@DBB@B@@@B@BB@@@B@B@@@@@BB@@@B@@@@@B@@@@B@@B@@@@A@AB@@A@@@AB@@ABA@@@@AA@@AA@@@@BA@AA@ABABA@@@@@@@@A@A@@A@ACA@A@A@ABA@@@@@BA@@AC@@@@A@@@@@@@EBABA@@C@@A@ABA@@BCA@@C@@@@AB@@A@@@@CA@BA@@@A@@A@@C@@AA@A@@@DABA@BB@B@BB@@B@AAAAAACAA@@ACBC@@@A@@@@AB@@AA@AAA@A@@@AAA@@@@@A@@@A@@A@@CCAA@@B@@@@@AAA@@@A@@@@@AA@@@@A@@@@AA@@@A@A@@@A@@@@@AA@@@@A@@@A@CA@A@@@@AAA@@@BA@@@@A@@@@A@@@@AA@@@A@@@@@@AAA@@AB@@@A@@@@@@@@@A@@@@AA@@A@A@@A@@B@A@@@@@@A@@A@@@@@@@@@AA@@@@@@@@A@@@@@@A@@A@@@@A@@@@BBF@B@@@@A@A@A@A@@@@DB@@B@@AD@@A@A@@@@D@@@@@@AA@@AA@@AB@@AA@@@@@@@BA@@@@A@@@@A@AAA@AA@@A@AAA@AA@@@@@A@@ABA@EA@@@AAA@A@@AAAAAAA@@@@@A@AAA@@@AA@@@@A@@@@AA@@@@AA@@A@@AAAA@@@@A@AA@@A@@AA@@@@AA@AA@@@@CA@@@AA@@@@@@AA@@@@A@@A@@@@@@A@@@@A@@@AA@@B@AAB@@@AA@@A@@A@AA@@@AA@@@@AA@@A@A@@@AAA@A@@AB@DA@@@@AA@A@@CAA@@B@@AAA@@AAA@@@ADABA@ABCDCB@@A@@@A@@B@B@@@B@@A@@@A@@@A@@@@@@@AB@@AAG@AA@BADA@@@A@@@@@@BADAF@BAB@DA@@B@DAJCD@B@@A@ABA@@BAB@@AB@B@@AD@@@@AB@@@@ABA@@BA@@@@A@C@@@@AAA@@A@@@@@@@A@@@AAA@@@A@ABAB@@A@@@AAA@@ABA@@AA@@@@A@@@ABA@A@@@@@@@ABAB@@A@@AA@A@@@@@@@@@A@@@@@ACB@@AAAB@AA@@@AB@CEB@AAB@@A@@@@B@@@@@B@@@@A@A@@HABD@@D@@BB@@@DA@DD@AADA@B@@B@B@@@AADA@AAAB@@AAA@A@AAA@@@@ED@AA@@@A@A@GB@A@@@AA@@AA@AEA@ACAAD@AA@AEE@@AB@BA@A@E@A@@BABA@AAA@A@A@CA@@A@@@@BAB@@A@A@@@ABA@@@A@AAAB@BA@@@A@@@@B@@AA@B@@@@@@@A@@AB@@AA@@A@@@A@@@A@@@A@@@AAA@@BA@@AA@@BA@@@AB@@@@@A@@@AB@@AA@@BAA@AAA@BC@A@A@@@A@AA@@A@EB@BA@@@@BA@@@@@A@@@A@@DA@ABA@@@@@@DA@@@@BA@@@@BA@@@ABA@@B@@@@@B@@@@A@ABB@A@@B@@@BA@AAA@A@AB@@A@A@@B@@A@@AEAA@@@ABAB@@A@@DC@@A@A@AA@@A@@@AJC@@A@@AA@BA@@A@@BA@DC@AA@@A@AB@@AA@@AB@@@@A@@A@AAA@@DA@C@@AA@"],encodeOffsets:[[111697,34999]]}},{type:"Feature",id:"610113",properties:{name:"雁塔区",cp:[108.944644,34.214113],childNum:1},geometry:{type:"Polygon",coordinates:["@@@E@@B@N@B@J@B@H@H@@@B@B@F@@@@@@MB@F@@@F@@B@B@D@D@BB@@@@AB@@A@C@AA@@C@AAA@@CAA@@@@@@A@@@CF@@CD@@@B@@@BBF@@@BBD@DHB@DAAA@@@@@AA@@A@@@@@AB@B@@AB@B@BA@B@@B@@@@@@@B@@@@@B@@@B@@B@BFCHCHADADA@@@A@@@AB@@@B@@@BB@AB@B@BA@@B@B@@@L@B@@@D@F@@@F@@@@@D@@@J@@@@@@DB@@BB@@BB@@BD@@AD@@@@A@@@A@@@@A@@CB@B@F@@@B@@@@@DBABB@B@@@@BBA@@B@B@@@BBB@@@AA@AD@B@@@@@ABAB@@@@B@BC@AB@B@@BD@@@DBCHB@@ABBA@BBBA@@@B@@B@B@@@@BJDHD@ADBB@@@@B@@B@@@B@@BF@D@B@D@B@D@D@@@DAD@D@@@@GJ@D@@AB@@CB@@AL@@N@H@D@B@D@BB@D@@@P@B@H@B@B@@@@@@A@ABA@A@C@A@@BC@@@AAA@@@ABA@@B@@AAG@@@AAE@A@@@A@A@A@C@A@@AA@@BA@C@AAA@@@C@@@A@@@A@A@ABAAC@AAA@A@ABA@GBA@A@@@AB@@AB@@@@@@A@@@A@@@@BADC@ABA@AA@@@A@@@@@@AA@ABA@A@@AAA@@@@EC@CIA@AA@@BA@@AE@BAC@@EA@@B@@A@@@A@@AA@@AC@@@@@ABA@@DC@@AB@@@@@G@@BG@AAA@A@@AA@@BA@@@K@@CE@@CA@ABAAA@@AE@@A@@AAA@A@@@AACBCAA@C@@@ABA@@D@@AD@@C@@B@BA@A@@AC@@BA@@BA@@DG@@AE@EA@@@@@BC@@@@D@@CB@DC@@G@@IA@AE@@A@A@ABAG@@A@@@@ADE@@D@@A@BBA@C@@B@@CAA@A@@AE@@D@@AA@@@B@@@BAA@AI@@BB@@@C@@A@A@@@A@AA@@A@A@@G@C@A@C@A@AAC@C@@CABBBA@@A@@A@@@@@A@@C@AG@@@E@@BA@@D@@@@E@@AA@@CA@@@E@@CE@A@AAC@G@@@A@@@B@@B@BA@@B@F@BJ@@DCB@@C@ABAD@@B@@B@D@@@DA@@C@@@DA@@CC@A@C@@@K@@EC@@AA@@DA@CB@@@@ABEBCA@CA@@DA@@@E@ABEB@DAA@D@@@DC@C@@HEA@FAAAFEAE@@@QA@L@BB@@BB@@A@@@@D@@BAA@FBBADBBDABB@BB@CD@@DBADD@@@CFA@BAC@E@@CA@@C@@G@BCA@@BK@@@@@@B@@@FA@@DB@BJB@D@ABB@@DB@@@@F@@A@@BG@@BA@@DA@@DA@@AC@@BA@@@AAAB@B@@@B@B@@BFCA@F@DD@@AB@@@DB@BA@@@BB@@@@C@@FB@@DB@H@@BH@@EJHBA@CB@BC@@@BF@@BB@ABF@BB@BBBBEHB@DB@@AB@AD@BH@@@DB@@B@BCJ@@@ABABB@@BB@"],encodeOffsets:[[111467,35078]]}},{type:"Feature",id:"610112",properties:{name:"未央区",cp:[108.946825,34.29292],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@@DAC@@AA@@A@G@@@@BA@@@A@@BA@AA@@A@@B@@A@@@A@C@A@@B@@AF@@E@@@AA@@@@@@CB@@A@AG@ABA@@@@@@AB@@CD@@AE@@A@CD@@C@AAB@@AB@A@A@A@@A@@AA@BABA@@I@ADA@@@CA@@G@@ABCA@@BA@@CGAAFAA@AAAE@BAA@@AE@@A@@ADA@@DABIG@FG@@AG@A@@@@D@@AA@FD@@@@@@H@@@B@@@D@@@D@@@BC@BDA@@BF@@@ADB@@@D@@D@@B@@FB@@B@@@B@@@BA@A@@D@@@@C@@@@@A@A@@A@@@AA@@BA@@@A@ABA@@A@@@@A@@BA@@@@BABBDB@AB@@@BDB@BCAABAFC@@@A@@AA@@BCA@B@@C@ADC@@D@DB@@DBA@DF@@D@B@@AJE@@DCA@H@@F@@@B@@FB@ABB@@BA@@FD@@BCB@@@LDBAB@B@BC@@D@@A@@FF@@BJ@@D@B@@@@BD@@@@@@@@B@@BA@@@@@A@@@@BC@@@@@A@@@@@@@@BBBB@@BB@@AB@@B@BBDC@EC@@@@DLBBB@@B@@@NBBPJFBFDNFD@VJFBDBF@NDBBD@BBD@JDD@JDDBDBD@PD@@DBDBDBFDFBFDB@BBB@B@@@PBNBDBD@D@@@DBH@DBDBJBJB@BD@D@BBB@DBHFRLBBBBADJDHBB@DBFBFBB@DBHDJD@@LBF@LBB@LBB@J@H@D@F@AAAACCCAACEEAAAEAE@AACAE@C@E@C@M@A@C@E@A@C@A@C@Q@A@EBG@I@GAA@EBEAABA@G@@@A@ABABCBCBA@ADAB@@@HGB@@@BAJE@AB@@@B@@A@@B@@@B@@@BAB@B@BCBAB@BAB@B@BA@@BA@@AAAACC@@@@GGBA@@@CA@@B@@G@DCCACADCA@E@@BA@@A@@EAA@A@A@E@AAAACAAAA@ADA@C@@@@BA@@@@@@CC@@AD@@@A@BA@@@A@@@@@@@AA@A@A@@@A@@AA@@@A@@AF@@A@@@A@CI@E@@AE@@D@@@@A@A@EBA@C@K@@@A@A@@@A@@@@@I@@AB@@AAAC@A@@BG@@@@@@CI@C@@@G@A@@B@H@@GB@A@@@@@AAC@AA@@AA@@@A@E@E@@@E@E@@@@DA@@A@@@@@@@@A@@A@@@BA@@AC@@B@@@@A@@@@@@@A@@A@@@BA@@AA@@@AB@A@BA@@@@AA@@B@@@@@@A@A@@@@@@@@@@@@AC@C@A@C@G@S@Q@ACa@G@@BA@A@@@C@@BE@A@C@E@A@A@C@"],encodeOffsets:[[111468,35116]]}},{type:"Feature",id:"610114",properties:{name:"阎良区",cp:[109.226124,34.662232],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@AAC@@@A@@@@@GAA@C@A@A@A@AAC@C@A@C@CAA@A@E@A@@AA@C@@@A@@ABAA@CAA@@@AB@BA@@B@@@@@BB@B@@@@B@@AB@B@@@@CBA@A@@@@@AA@@@@A@ABC@A@@@ABA@@@C@@@@@AB@B@@@B@B@@AB@@@B@@@@@CA@A@BBAB@@@BA@ABA@A@@@@D@@@B@@ABC@C@@@AB@@@B@F@@@B@D@B@@AD@@@B@B@@CD@BAB@B@@A@@@EBA@A@CBA@@BAD@B@B@D@@@@@B@@AB@@A@ABABA@@B@@@@AB@@@@A@ED@@A@@BA@@@@@@B@@@B@@@B@@@BA@@@A@@@A@@@ABAB@@A@@@A@C@AA@@A@A@A@@@@AE@C@A@B@@BAH@BDB@B@DBB@DHB@FBB@B@@@BH@BBB@@@@DD@@FB@@FA@@B@DA@AFB@@B@DB@@BAB@BB@B@@@@BB@@@D@@FBB@B@@A@ABFDAFBA@@FDBBB@@@@@@A@@@@@A@@@@@BBB@A@@B@@@@AN@J@JBAFB@@EH@@@@F@BB@@@@@@A@A@A@A@@@@@@@BBB@BD@@CB@@ABCB@BAA@@@@CDA@@B@B@@DB@@DB@@CBAB@@@BA@@F@@@@BBBBED@AFBB@AB@@AB@@@BA@@BA@@@@@@B@AB@@D@@@@B@@@B@@@@@@B@B@@@@@AA@@@A@@@AB@BA@@B@B@@@DBDGDAB@B@B@D@@@@@@@@@CB@BD@@BB@@B@BABB@AFD@B@@B@@BDABB@DAD@@@D@@GD@@@H@B@B@BB@A@A@@B@B@@@BB@@AD@B@@B@B@B@@@BBB@BAB@B@BBB@B@B@B@@A@@@@@A@@@@@ABBBAB@B@@A@A@CBABA@ABA@@@@B@@@B@@B@@B@@@@@BA@@BBB@@@@BB@@A@@@@B@B@@@B@@BB@@@@@B@DB@BB@@@DB@@@CB@@B@B@B@@D@D@HB@@@@@B@@@BB@@@@CB@F@BB@A@@D@@B@D@@@@B@@C@@B@D@@@@@@@B@F@@@@BD@B@@B@@@D@@B@@AD@@@BA@@@@@@F@@D@B@@B@B@J@@@B@@BB@@D@@@D@@AH@@@B@@B@@@D@@B@B@BB@@ABC@@B@B@DB@FAFH@@@@F@B@D@@@@D@@B@D@@@@C@@F@BAB@B@@AD@B@@D@B@@FD@ADA@@BZDBG@CJ@AFPB@AB@@@B@BA@AHB@FD@BEF@NBB@@FHBF@@@@@@@@CB@@AF@@CF@BE@A@A@A@@GA@A@C@EFB@EL@B@@@B@@@BCB@BC@AF@BIA@BAE@A@A@ADE@BEG@@E@ED@@EBGA@@K@C@@D@BELB@@@C@AE@E@EA@C@AC@@@@E@EF@@C@ABKB@@@@CBGB@BG@CC@C@@FA@@E@@AF@@@EAAAFA@@CC@@AE@@EA@@EA@@DA@G@E@@@@BA@@DKAE@BECAE@@B@@@AA@@FE@@EA@CA@@@EM@@A@CUAC@@DG@@AA@@AB@@AA@BA@A@A@EC@A@A@@@@BA@@@BAA@@A@@@A@@@@A@@CA@@AB@@A@A@@@A@@@@B@@@B@@@@@BBB@@A@@@@@A@@@AAA@A@AAA@A@@@@DABABAB@D@@@B@B@B@@@@A@@A@@A@@A@AA@@A@@@@A@@@A@@BA@A@@AAA@AA@@A@@BC@@@A@A@@A@@AAB@@AB@@A@@@@@@@AA@C@@@AA@@@AAA@@@@AB@@@B@@BB@@@B@B@@A@@@A@@@A@A@A@@A@@A@A@@BA@A@@@A@@@@@@C@A@@@@A@A@C@AAA@C@@@@@@AABA@A@@@AA@@A@@@C@C@C@EAAAC@CB@@A@@A@@A@B@@CBAA@@@EAE@A@A@@@@A@@@A@@C@@AC@C@@@C@A@@@A@@@@A@@@@A@@@AB@@C@@@A@AACBAAA@AA@@@BA@@@@@A@@@G@E@A@@@A@AA@@A@@BC@@@@AAA@@A@A@@@@BAD@BA@A@A@"],encodeOffsets:[[111889,35424]]}},{type:"Feature",id:"610117",properties:{name:"高陵区",cp:[109.088297,34.534829],childNum:1},geometry:{type:"Polygon",coordinates:["@@EE@@CCCCAAABCCB@AA@@AA@@CCA@AAB@AACABAA@@AABA@AB@@BBA@@AAB@@CFAAAB@@AAADABA@@@B@AH@@A@A@@AA@A@@BA@@@@@@B@@A@@@E@@@@@@@@CA@ACC@@BE@ABA@@BC@@DE@@AA@G@@BE@E@@AAAABC@@@C@@@AB@@ADC@@BB@@@@@@BAF@@@B@@EHABADA@ADCD@@CDGJ@@EICC@@CEAAACCAACAAA@AAGACAC@EAAAC@IACACAA@CBE@C@G@I@A@KAA@KAE@KA@@ICGCCAA@EAEACAA@GAIC@@CH@FAB@D@B@B@@@H@B@BC@@B@D@D@DA@F@@AD@@F@@H@B@F@@ED@AABA@A@@B@B@@@@BFBABD@@@AB@@@@@@@@@B@@@B@@AB@@@B@@AB@@@B@@@@BBAB@@@@AB@@@BB@@@@B@BC@@BB@@BBB@B@@@B@B@@AB@@BBADBBDB@AB@@@@@@B@B@B@@@D@DAD@BBB@@@@@BAB@@@@B@B@BJ@DA@ABBBA@C@@@@B@@@DA@@DD@B@@@@DB@@@AB@@@BA@@@@@@@C@@BA@@DE@@@@BD@@B@@BAB@@@@B@D@B@@BBAL@F@B@B@HD@@BD@@BA@@B@BIA@B@BD@AFBB@D@BABBF@B@@B@@B@BA@A@@DC@@DE@AAABCB@@@B@@A@AB@@@@B@@B@@@@BBAB@@A@@DEF@@ABAJ@@A@@B@D@@DBFFJB@EB@@@FB@DXB@FD@AFB@@@F@@FD@BEF@@@@@D@@AD@@BJBD@@@B@@@AD@@@@BBFB@DFCBBB@@FL@@CF@@BNB@B@BADLB@AF@@@@CHBAFB@@EHB@@@@@F@@BAD@@@BBNBAFD@D@BEXD@CD@DBH@DI@CB@@AD@@BD@@BL@@B@@B@@@@BA@@DD@@CF@BG@@BED@DIFB@CBCJBD@B@@@D@@CF@@FTBHB@FB@BKA@BEB@@EJB@E@@@@BA@E@ABIB@@CD@D@@EF@@CBA@AB@@DB@BA@A@AC@@AB@@CB@@ED@BC@AB@@AB@@CFBBC@CA@@AH@@AD@BEC@BQ@@A@I@@A@@A@A@BO@@A@BCB@@AA@@GBC@@@A@@DB@FB@BIB@@ABBB@@AD@BC@A@@@A@AB@@E@@@@@A@@CBA@BC@@@CA@@E@@BA@@DI@@@@DIBB@ABCB@BEIABG@@BEBC@A@@BC@@GABEC@@@@@@CD@AAD@@A@@@ED@B@@EB@@C@CC@AAAA@A@AA@AGCBCFCDA@ABCDCDIGAA"],encodeOffsets:[[111774,35265]]}},{type:"Feature",id:"610124",properties:{name:"周至县",cp:[108.222162,34.163669],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@E@CB@@A@EB@@C@C@A@A@@@AA@@A@CAA@C@AA@@ECAAAACACC@@AA@@@AAC@AA@@@GAA@A@A@ABAB@@I@A@@@A@A@@@ABA@CBCBCBC@ABC@A@ABABAB@@AB@@ABC@ADA@C@A@A@CBA@CB@@ABCBCBA@A@CAA@C@A@ABAB@BCBCBA@E@A@C@@@A@ADA@CBA@A@CAA@@@CD@@A@A@A@E@AAA@A@A@ABABA@CDA@@@CB@@ADA@CB@BABCB@@@@EC@@A@@@CACCA@ECA@C@A@@A@@@CAACEAAC@CBAB@@A@C@A@E@AA@BAB@@ABEB@@@@@B@B@@CBCD@@ABE@A@A@@B@B@BABAF@BA@A@@@A@EA@@AA@AAC@@@@C@@@CAA@@ABA@A@@@AA@@AA@@ABG@@ACAAACAAACA@@@CC@@ACCCAC@AA@@@E@A@A@AACA@C@CA@AAAA@CA@AAAAAE@@@A@ABAA@CEA@@@E@A@AACAAAA@ABA@ADA@CB@@A@C@A@@AAA@@A@@@C@ABAAA@C@A@AB@@CFABC@A@@@AB@BCFA@ABA@ABCB@@ABABAD@@A@@@A@CCCAE@AAA@@@G@ABA@ABABC@ABEBA@@BCDA@A@A@A@A@G@C@@@CD@BA@CAA@@@ABA@AB@@@@A@A@A@A@ACCAA@@AC@C@AAA@A@GBABE@C@A@C@A@C@@@CB@BC@@BA@C@CBCDA@CBCBC@A@ABC@A@A@A@C@A@EAA@A@@@A@AA@@A@E@C@AB@BA@@BBD@@A@A@A@AB@@AB@BAB@DBBA@@@AB@B@B@BB@@B@B@@AF@BA@ABC@ABABCDABABAB@BAB@@A@CA@@A@@@CB@BABAD@@AB@BCBA@A@@@@@BB@@@B@@ADAB@@BBBB@DB@@@D@BB@@DBBBDDBB@B@@BBBB@B@BB@@BBDB@BBBBB@BBBBBBBB@B@HB@BBBB@@@BA@A@A@CBA@@BCDAD@DAB@B@B@@BB@@D@D@@@BBF@BBB@BBB@@@BBDB@B@B@B@@BB@B@@B@@B@D@@BB@@BB@B@BBB@B@DB@@BB@B@@@BBBB@BBD@B@@@@@B@@@B@B@B@B@B@@@BB@BB@B@BAF@DAD@BAD@@@BA@@@@@BBB@@BD@@@@B@@@B@@@B@@BDDF@FAB@BBF@BAD@B@BBBDHBB@BBB@BBD@D@B@B@@@FAD@D@B@D@BBDFF@@AD@B@B@B@@@BABADAB@D@D@B@B@BBBB@D@BBD@DBDBDBDDBBDDB@DBBB@@BBDBDBBBD@B@F@B@BBFBBBBBB@BBH@FB@@BBBBBBB@FBB@@B@@@B@@A@A@C@@@@@@BBB@@DDDD@DB@@BBB@@BBB@DD@B@BBDDHBBBB@B@BFBB@DDDBBD@@B@B@B@F@F@@@@BB@BF@@@BDBB@@BB@DBBBBB@@B@@@D@F@BAB@@AD@B@HCB@DADA@@BA@C@ABADAHEBABA@A@@@AB@@@B@D@@@DCB@D@B@BBDBFBD@B@BB@@DB@BB@BBB@@@@B@B@B@BB@@@DB@@BBB@BBBBB@BBBBBBB@BBB@@BB@@@FBB@D@@@D@@@FD@@D@@@D@D@DB@BB@@@@@@@BB@@D@B@@@B@F@B@B@BABA@ABA@ADC@A@A@C@@@A@@B@DAFADCBA@@@@B@BBB@DB@@DAB@F@B@BABAB@@@B@DBB@BA@@DB@@B@B@F@B@@BBB@B@B@@@DAF@BAB@@BD@@@BABAB@@@@CB@B@@@DAB@@C@@BA@@B@B@@DB@@@B@@AB@@@@BB@B@@@D@DBD@D@BA@ABABAD@@@F@FBBDD@@BB@B@B@D@FAB@B@@@@DB@@D@B@B@B@JD@@B@BB@@B@@@AD@@@B@@BBDB@@BDD@@BB@BBB@BBB@B@@BDBBDBB@@@@@D@BAD@D@BBB@@AD@@@B@B@@@B@@B@B@D@B@B@@B@B@BB@@B@BBBB@BB@@B@@BB@BBB@BB@B@@BB@B@@BDB@BDB@@@BD@@B@@B@@@B@@@@BB@@FB@@@@@B@@@@@B@@B@@@DB@@@@B@@BBB@@@B@B@BAB@B@BA@@@AB@B@@@BAB@B@@BB@BBB@BB@B@AF@@@DABBH@H@B@FBD@B@B@B@BABA@@@@BAB@@@B@B@@AD@@D@BBFA@BA@@H@@A@@BBD@B@@D@@@BA@@@B@DB@@@B@BB@@BD@@D@@@BB@@@DBBBB@@@DB@@BBJ@B@@@D@BB@B@DB@@BA@@@@@A@@@@B@BA@@B@@@@DDD@B@B@D@@@B@BA@@@@BAFCBDD@B@DD@@BD@@DB@@B@BB@@@@@A@@B@@@FB@@B@@@@B@@D@@@@A@@B@@B@@B@@@@JADNGBBBGBBFC@@BE@B@DD@B@@@D@B@@FBLB`D@@J@D@JBF@LBB@D@J@D@HAJ@BAHALCD@FABAFAJCB@BAHAJAB@B@L@B@B@B@H@FBL@B@JBF@B@NBJBN@H@B@D@DAD@D@@AHABAB@DA@@DADAFEBADADABADAHA@@JCD@JCHABALAB@HAB@B@FBB@B@B@BAF@D@DBD@B@B@FB@BD@DBJBB@HBDBF@D@@@F@J@DAH@FABAFANCBAH@RCRAD@H@LB@BB@JBHBFBHBLDLBF@H@F@@@D@BAJ@LAD@H@JAD@F@J@B@F@J@F@D@F@H@NBLBFBDBFBD@DBJDJDHBLFJFHDDBJD@@B@@ABGFY@@D@@@B@BCDBBCA@@AA@@@B@D@@@@CB@@DD@@AA@@AA@@@B@@KB@@CB@@@@A@AA@@A@E@@C@@DI@@CC@@BC@@BA@@A@@A@@FC@@@@@@BEA@AA@@B@@@A@@A@@BC@@AA@@@ABB@@DG@@@A@AC@@C@ACC@@@C@@@@@@ACA@@BA@CB@@@BAAA@A@ABA@CBC@@@A@AD@@A@A@@AA@AA@@C@@@CF@@A@AA@@AB@@ACB@AC@AGA@@ABA@E@@C@AC@@@AAAAAA@@A@@A@@A@A@AAA@C@@A@AA@@C@@CA@@C@@AA@@@A@C@AAAA@@A@AA@@A@A@A@AAAAC@@AAB@@C@@B@@AD@@C@CE@@AC@@@@A@AF@@CA@A@@@AA@A@@@@@@A@@C@@AC@AAA@AA@@A@@B@@CACA@@AA@@AC@@AC@@A@@@@A@@C@C@C@@@@B@B@@A@@@@D@@CD@@@B@@ABA@I@CB@@AA@@ABA@BD@@A@@@@@A@@@C@@@@@AA@@A@@D@@A@@@AA@@@AC@@BA@@@@@AA@BA@@B@@@@@@A@@ABA@@A@@@@BA@@@@@@@AB@@@@@@@@@CA@@AA@A@A@@@@@AA@CB@@@AD@B@@@@A@@A@AA@@@@@A@@@@BA@@B@@ABBB@@A@AA@@BA@@@@@@A@@B@@AB@@AA@@AGAAA@@AA@@@A@A@@@AAA@@A@@@@B@@@@@@@@A@A@@@@@AB@@@@@A@@@@BE@@AAAAAC@A@C@AAA@A@A@@@AA@AA@@@C@@BCBABA@@BCBAA@@A@AAA@A@AA@AAA@AAA@AA@@@A@A@AA@AA@AAC@@@C@ACAA@CA@A@AAAAA@AB@A@AE@C@ACAACAAAA@A@@@C@@@ABE@A@@@A@AB@AAAA@A@A@C@ABA@C@@@A@C@AA@BAA@AA@A@@@A@E@ABA@ABC@EBABA@@BAD@B@DAB@BABA@@@A@@AA@@@A@@B@BA@@@A@A@A@@BABABC@A@A@A@AA@@AAA@A@A@@BC@A@A@C@A@@@AA@AACAAAAAAA@AAAAAA@@AAAAACAA@@AAA@@A@@AAAA@@A@@@A@C@@AC@A@A@@@ABABABA@@@AAC@AACA@AA@@AA@AEA@A@AA@@@@AAA@AA@ACA@@@@C@A@A@@BA@@AA@A@@@AA@A@@@CA@ACA@@A@C@AA@ACA@A@A@A@A@@AA@@AC@@A@CA@@A@ACCCA@@A@@@AA@@@@AAA@A@A@@CAAA@@A@@@A@AAAA@@@A@@@A@ABA@@@AAA@@AA@A@A@ABC@A@@@AA@CAAC@@ACAC@CCCAC@AAA@E@A@AA@AC@@E@AAC@A@C@CAABAAA@E@ABC@A@CBA@A@A@@@@@AAAA@A@@BC@E@@@A@@@@E@A@E@@AC@AAA@AA@@A@AACC@@@A@@AA@C@AA@AAA@AAA@ACCAA@@AE@AAA@A@EE@@@@@AA@@@A@C@@@A@C@CBE@GBEBABA@C@A@C@C@@@AACA@AA@@A@A@@C@CAACC@@AA@AAC@A@AAA@AAA@A@A@C@AA@@@@AC@A@C@@@AA@@@CAA@AA@AAAAC@@AAA@A@@A@@@AA@@A@@AAA@AACCACCC@A@@@A@AC@EAC@A@@@C@A@ABAAA@A@EBCB@@A@C@A@@@@@@CAA@A@@@AA@@AA@A@@@ACA@A@@@ABA@AB@@A@AA@@A@A@ID@@A@CDA@C@@A@AAA@AAAAA@A@@@C@A@@@@AA@@AA@@A@CA@@CAAAA@A@ABA@@@ABA@ABA@AAA@A@A@AAA@CACB@@A@ABCB@@C@C@@AAACAA@A@A@@@@@CD@@CB@BCBA@AB@@E@ABA@ABCBABAB@@@@AAAAA@AAA@A@@@A@A@AA@@@AAAAA@@AA@A@@AACAA@A@ABA@ABABAB@@ABA@C@ABCDEDABABA@A@"],encodeOffsets:[[110692,34533]]}},{type:"Feature",id:"610122",properties:{name:"蓝田县",cp:[109.32345,34.151298],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@A@@@A@AAA@@AA@@@A@AAC@@@@A@@AA@C@@@AA@@AABA@AB@@@@C@CAA@@@A@AAAA@@AA@A@A@@AAA@A@A@ABAB@@AB@@A@AB@@AB@@A@A@@@A@CACBA@C@A@AA@@AA@@@@AB@@ABA@@@AB@@A@C@C@@@A@AA@BA@@@@@A@A@AA@@AB@@AB@@A@@AA@CBA@@@C@AA@@@AA@@@ABC@C@AB@@@BA@AB@B@D@B@@@BA@CBC@CA@@A@ABA@ABA@A@@AA@AA@@C@C@A@@BA@@B@@ABAB@@AB@BA@A@A@@@@@@@@BA@@@A@AB@@@B@@@@ABA@@BABABAB@BA@A@A@@AGAA@AA@@A@ABA@A@C@A@CA@@AA@@CA@@A@AA@@A@@BABA@A@C@CACCA@AC@A@@AB@@CB@@@A@@A@AA@A@@A@@@A@AAAA@AA@@BA@CB@@A@A@A@AA@@A@AD@BA@@@C@@@A@A@AA@@A@AAAB@@@@@B@@@BA@A@A@AB@@ABAB@B@@@B@@@@@BAB@@ABABAB@BABABAB@@CBA@CBCB@@A@A@A@C@AB@@A@A@AAA@A@C@A@@@AB@@C@EBA@AB@@@BCDA@A@C@@@CACA@@AC@@C@@@A@C@C@ABCBA@A@E@A@@@AB@BC@AB@BAB@B@@@DAB@BAB@@@@BB@D@@BBA@@B@B@@B@@B@@@B@@A@ABEDGB@@C@@@A@ADA@CBABA@A@AB@@@B@D@B@@DB@B@@@B@@@B@BAB@D@BDFB@ABAB@DAB@B@@@F@D@@B@BB@BDBBB@B@B@@@B@@@BBB@@@B@F@@BL@@@B@B@@@B@B@@@BB@@BA@@B@@@B@@AB@@C@AD@@AB@B@@@@A@@BA@@B@D@@@B@@C@A@@@EBABABCBABA@@B@@AB@BAB@@@BA@@@AB@BAB@@@D@@@B@B@@C@@@ABAB@B@D@B@@A@@BA@AB@DA@ABA@A@C@@BAB@B@@@DAD@D@D@@@B@B@@@B@B@@A@@B@@A@@B@@@@@BB@@BAB@@@@@@@BA@A@@@@B@@@@@@B@@@B@@@B@AB@@@B@@@@@B@@@B@@@B@B@B@BAB@@@@A@@@@@@B@@@B@@@@A@@@ADAB@B@B@@A@@B@@@@B@@A@@@@@@@BB@BB@@@B@B@BA@@@AB@@AB@@@@A@BC@A@@A@@A@@@B@@@BA@@@@B@B@@AB@@@B@BBB@F@@BB@@BAB@@@@B@@A@A@AB@@AB@@AB@B@B@B@@A@A@AB@B@@A@@@@B@@@@AB@BABAD@@A@A@@@@@A@BB@@A@@BC@@@AB@@@@@@@B@@@@A@@@@BA@@@@BA@@B@@@@A@@B@@AB@@@BAB@@AD@B@@@@A@AB@@AB@@A@@@A@AB@@@B@@ABAB@@@BCBAB@@@B@B@@BBCDB@@@@@A@AB@@B@@B@@A@A@@BA@@@@BB@@@A@@@@B@@@@B@BB@@B@@@BBA@ADD@@BAD@BB@B@@ABB@@BBBC@@B@@@@@@@@BDBBAAA@@BBFEB@B@BAB@@@BBBAC@@A@@@A@@B@BB@A@@@ADBBA@@@@BBB@B@@AAAB@B@DA@@B@FCD@DEB@@@B@B@B@B@B@ADB@@BF@DB@@@B@@B@@@B@@@AB@B@@@@B@@AB@@@BBABDB@B@@@BB@BAB@B@BA@@BC@@@@B@BCB@@@B@B@BABB@@@@@BA@@B@B@@B@@DBADBB@BBBCA@BA@@BABBAB@@@@@B@@BB@BD@BDB@BD@BBB@BBBB@BA@DAB@@D@@@@BBAB@@@@BC@@@@@AB@@CBBDCBA@@@BD@BE@CA@@C@@@@BAB@B@BA@AB@@@@AA@@AB@@@@@AAA@BA@@@A@@@@@A@A@@@A@@@AAAB@BBBA@@BA@CBA@@BA@@AA@@@@@@B@B@B@@@B@@ABAB@@A@@@A@@@C@@A@@AA@@AAC@A@A@@@@@A@ABA@@@A@@@ABA@ABABADABBD@BBD@B@DBABD@@BABDBDD@@@DDCB@B@@B@ABBBBBB@BD@BB@@DAB@@AB@@@@ABA@@@@BA@@@@AABCB@BBBABABCD@@BBB@@BA@A@@@@@A@A@@@@@@BA@@@@B@@@BA@FF@BBBC@BBBDB@BFB@@BB@@B@@@BHAB@B@@@B@@BFC@@@@BB@B@BBB@BA@BB@BCBBB@@A@A@@@@ACBBBC@@CCB@@A@@AC@@@ACGB@@@B@B@@A@@@@@A@@@@@@BA@BBA@DFA@@B@@BBA@BB@BA@BD@@@@B@@@@@@@@@B@B@@BB@@@BABA@@@@@@B@B@BA@@B@@@@@BB@@AB@BB@BB@@B@@@BABAB@@@B@BB@@B@@@@@@@B@@@BB@B@@D@B@@@@@AB@@AB@B@@A@@B@@C@@BA@A@@BA@AB@@AB@B@BA@C@IDCBA@@@CBA@ABE@CBAB@@@@@@@B@@CBABB@@BBH@BA@@B@@@@@@@B@@@B@@@BA@@@A@A@@@@B@@@BA@CDAD@BABCB@B@@BB@BB@BB@@@AB@DB@@@BBB@@@@CBA@@BB@B@BB@@B@B@@@BB@@@@BB@@B@@B@BB@@@BB@@A@BBA@@@BB@@B@@@@@@B@@@@B@@@@B@@B@@B@@@@B@@B@@DB@@@@BBB@@B@@B@@BB@@@BBB@@@@@BBBB@@@BB@@B@@B@@B@@B@@@@@BB@@B@BBB@@@@@B@BBBBBB@@@BBB@B@@FBB@BA@@@B@@@@BBB@BBB@@@BBB@BBB@@@@@@B@@B@@A@@@@@@BB@@BA@@BB@@BB@@@@@@@C@@B@B@@@BC@@@AA@@C@@B@B@B@B@@@@@@AAE@A@@B@@@@@@BB@@@@@@@@B@@@@@@B@@B@@@@@@@@@BB@@@@@@@@B@AB@@@@B@BB@@B@@B@@@@@@@@@B@@@A@@BB@BB@@@@@@@B@@BB@@@@@B@@B@@@@@AB@@B@BB@@@@@BDBB@@@B@@@@@BB@@@@B@@@B@B@@@B@@B@@B@@@@@BB@@@@B@@@B@BB@@@@A@@@BBDD@@@BB@@@B@@@@@B@BB@@B@B@BBB@@BA@@B@@B@@@D@DA@BB@DBBBBBBBA@@@AAA@A@@AABCB@@B@B@@BD@@@@BB@@@B@@ADB@@@@@BA@@B@@D@@@DB@AB@BAB@@@@DB@BAFA@@@@@@B@@@@@BD@@AB@@@@B@BAB@B@B@BDB@@@@B@B@@@@@@B@BABA@A@CBC@@DABA@ABA@@@@@ABA@ABAD@B@@ABAB@B@BABA@@B@DA@@@@@A@@@A@A@A@AB@@A@AB@@@@CD@B@@@BC@@D@DD@@B@BABA@@B@@AB@DB@@@BB@B@B@B@@@@@B@@B@@DA@@B@@BBBBBDD@DDBD@FCACACAA@C@@AA@ABA@@D@B@@@AA@@B@DBBBDB@@@B@BB@B@B@@A@C@A@@B@@@D@B@@@B@@@B@@@AB@@@BD@B@B@@@@@ABA@@@@@BBB@@@@AB@@@@A@@B@@@BAB@@@BC@@@@@@@D@@B@@A@A@@B@@B@@B@@@@A@@B@@B@@BA@@@@A@B@@A@@@@AA@@A@@@@@@CB@AA@@@BA@A@@@@@BAB@B@B@DABADAB@BA@ABAB@B@@A@@A@@A@@B@@@BB@@@@@BABA@@@@@B@@@@@AB@@A@@@@A@@@@AB@@@B@B@DB@BBBDB@@BB@B@@BB@A@BB@@@@@@A@@B@@@@@@@@@B@@@BA@@@@B@@@@A@@@@B@@A@@@@B@@@B@@@@A@@B@@@BA@B@@B@@@@@B@BBB@@A@@BB@@A@@@B@@@@B@ABB@@B@@@@@BB@@@@@ABB@@@BB@@A@@BB@B@@@@@@BB@B@@@@@B@@@@@@B@@@@@@@@@@B@ABB@@@@@@@@@@B@@@BB@@@BBB@@B@@A@@@BB@@@@B@@@@@@@BB@@@@@@B@@@@@@@@B@@@@B@@@@@@BB@@@@@@@@D@@BBA@@B@@@@B@@@@@@@@B@@@@@@@BB@@@@@@@@B@@BB@@A@@@@B@@@B@@@@@B@@@@@@@B@@AB@@B@@@BB@@@@@@B@@B@@@B@@B@@B@@B@@@@@@@@@@BB@@@@@B@@B@@B@@@AB@@B@@@@B@@@@@BB@@@@@@@@@@@B@@@@AB@B@@@@AB@@A@@BB@@@AB@@@@@@B@@@@@@B@@@@@AA@@B@@@@@@BBA@@@@@@@ABB@A@@@@@@@AB@@@B@@@@@@@B@@@@@B@@@BA@@@@@@@A@@@A@@B@@@@@@@BAB@@@@@@@B@@@@@B@@@BB@ADA@AC@@A@@B@@A@@BC@@@AD@@@@AB@B@BA@@AC@@B@@@AC@@BA@@@@B@@AB@@A@@BA@@@@@@@@@A@@@@B@@A@@@AB@@@@@@A@@@@B@@@@@B@@@@BB@@@CBBBB@@@DA@@B@@@@@BA@@BB@B@@BA@@@B@@B@@BB@@@@@B@@@@BB@B@@@@B@@@@B@@@@@B@BBB@B@@B@@B@@B@@@BB@@@@A@@@@B@@@@@@@@@@@B@@@B@@@B@@@B@B@@@@B@B@@@@B@@@@@B@B@@@@B@A@@BB@@@@@@A@BB@@@@@@@@B@@@@@BA@@A@@@@@@@@A@@@@B@@@@@@A@@A@BA@@@@@@B@@@@A@@@A@@@@AA@@B@@@B@BA@@B@@@@A@@B@@@@A@AA@B@@@@AA@@@@@@A@@@@A@B@@A@@@@@@A@@A@@@@@A@@@@@@B@@AA@@AAA@@@@@@@AB@BAA@@@@@@@AA@@B@@@@@B@@@DA@@D@H@B@BA@@BAB@D@AAB@BBB@@BB@@BBB@@@CACBB@AB@@D@BB@AEB@@@B@@CA@BA@@@AB@@@BABBB@B@B@@AAAB@BBBACAB@BA@A@A@A@@@@@@B@@@BADG@@@ABA@ABA@@@A@AB@BAB@@@@A@@A@@@A@@@@AAA@@@@@@@@B@@AB@@BB@@@@@@A@AB@B@@@@@B@B@@@@@@@AB@BBADCB@B@FAF@@@B@B@B@@BAB@@@@@B@@@@B@@@@A@@B@@ABA@@B@@@BA@@BB@@AB@@@@@@B@@A@@D@@@BD@@@@BA@@AABA@A@@A@@A@@@@BA@AB@@BB@@C@@@@@AA@@@@@@@BA@@BA@@@@BAF@BB@@@@B@B@@B@@@@@@BB@@@@@@@@@@@BBAB@@@B@DB@@@@@AB@@@AB@@@@@@@@B@@@@@B@@@B@@@@@@@@@DAB@BAB@FAB@B@B@B@@@@@BBB@@BFBBBBBBB@@BBB@@@B@B@BAB@@AB@B@BAB@@@B@B@@BB@D@DB@@BAD@@@B@B@@ABA@A@@B@@@B@@@B@@@BAB@FCB@B@DA@@D@B@BA@@DABA@@BAB@BAF@BAB@B@FABAB@B@@@BABABABAD@@AB@BAD@DAB@BAB@B@B@@@D@FAB@BB@@BB@@BB@@BBBBD@BB@@B@D@BB@@B@BBD@B@B@B@DAD@BABAB@@A@@DCDAB@@AB@BAB@D@B@DB@@B@D@B@B@B@@@DBBB@@@@DBBBDB@@B@B@@@JABAB@@AB@@BBAB@BBB@DAB@@@BA@@D@F@B@B@B@FAB@@@BABAFA@@FB@@BAD@B@@@@@BBB@@ADC@A@@@ABC@CDC@@BC@ABA@A@AB@@A@@BADA@@@@@@@A@@@AB@B@BA@@BBB@BAB@BADAF@DAD@@@B@B@@@@A@@@AB@@A@A@@@@@AA@AA@@@AAA@@AA@CAAAA@@A@@@@AA@A@@A@@@A@@AAAC@AAA@@AAEA@@AA@@@@@@D@F@D@BAB@@@BC@@BA@@D@@@B@D@@@B@BDD@FB@@DABABAD@B@B@D@BAB@B@@@BC@@B@BCDA@AB@BC@@@@@AAA@@@@@A@@D@@AB@@@BA@@FA@A@@AABA@@@@@A@@BA@@B@BA@@@A@@BA@AB@@@B@@@D@BAB@@@BBDBBB@@BB@@B@BB@@BDB@@@@@@@@@@ADC@AB@@@B@B@@@BA@@@@BA@C@@BAB@B@B@@@@A@A@@@@BA@C@@BAB@B@@@B@B@@@@A@@@A@AA@AAA@@@@@AB@@A@ABA@C@@@@@A@A@AAA@@@@AA@A@@@ABA@@BADC@C@@B@@ABEB@@BA@@AA@@AC@@@C@@@@@@C@A@A@AACAA@CA@@A@@@ABA@@@@B@B@BBD@@@BA@BB@@DFB@BB@@BB@@@@@@EBABA@ABAAA@@@@@AB@@C@@@@@AA@@AAACAAAA@@A@@AA@@AAAAA@A@@AAA@@AAA@A@@A@@AAA@C@C@AC@C@A@E@@@@@@@@@BA@@BA@@@A@A@@@@@@@AA@AA@@C@CAA@@A@A@C@AAAAAA@A@A@C@CAA@CAA@@@@AAAAAAA@A@AB@@@@C@@@A@@@A@@AA@@BA@AB@AA@A@@BA@@@AB@A@@ACAAA@@@A@@BA@@BC@A@@@@AAA@AAA@AA@AA@A@AB@@C@ABA@A@A@A@AB@@A@@B@@AB@@C@@@AB@BA@@@@BA@@@A@@BGBABAAC@CAA@A@@@AAA@@BABA@@B@@@BABAB@BAD@B@BA@AA@@AAAA@CAA@@A@A@A@A@@@@A@@@AAAA@@@CAA@A@CAA@C@AA@@AAGAAAA@C@@@A@CD@@A@A@C@@@CBABAB@BA@@@@@AA@AA@AA@@@@BA@@BAAA@CACAAAAAA@@AA@AAACAA@C@A@AA@@C@A@AAA@EBA@@@@A@@@A@C@A@C@AAC@A@@@A@A@@CE@@A@ABEB@@A@CAA@A@A@A@@@C@@@C@A@C@@@A@AAA@CA@@C@C@C@G@C@C@AB@@@@AAC@@@A@C@C@ABE@A@@@AAAA@@AAACAC@AA@A@@A@@CB@@C@EAAA@@@AAACCCAAAAA@@@@@AB@@BB@@@@@@A@@AAA@@AC@AAA@AAEA@@BE@AAA@C@AB@BABA@ABA@@B@BA@ABA@ABA@@AA@@AAA@A@AC@@A@A@@A@AC@E@AACA@@AA@@@E@A@A@@@ABAB@@AAC@@@@@AA@@@AC@AA@@@@@AA@@@@@@@A@A@@@C@@@A@A@A@C@AA@@CAA@@AA@@AAAA@@@@@ABEBA@A@@AAAA@@A@CA@@@@@A@ACA@A@@B@BA@@BA@@DA@@BABABA@@BAB@@@F@@@@ABA@@BA@@D@@@BB@A@A@@B@@ABAB@B@@A@@BC@@@@D@@@DAD@BABA@CB@FA@@BAFA@@B@@C@AB@@AB@@@@@B@@@@@@@B@D@@@BBB@B@B@BADC@ABA@@@@FC@@BAB@DA@ABA@@@@B@DBB@B@B@@@@@B@BA@A@@D@D@@@B@@A@@@A@@@A@@@A@@A@A@@@@@@A@AB@BA@@FA@@@@AA@@A@C@A@AAA@AA@@@AAA@@@@A@A@A@@AAAA@@@BABA@A@@AA@AB@@ABAAC@A@@BA@AB@@AAA@A@@@ABA@@@AAAA@@A@@@A@@@AB@@AA@@C@AAA@@@A@@@@@@AAA@@@@AA@@A@E@@@@@@ABABA@E@A@AAA@@@@@C@@@A@CBA@@@ABA@A@AA@@AA@@@AA@A@@AAAA@@AA@A@A@@@A@ABA@@AA@A@@@A@@AA@@ABA@@B@@C@@@@AAA@A@AACA@@@A@A@@AA@@A@A@AAA@@A@@AAAAA@C@A@A@@@AA@@AAA@A@@@AB@@@@@AA@@A@AB@@A@@@@AAA@@@@A@@BA@@BC@@@AB@@ACA@@@@A@@A@@@@@@@A@@@A@@@AA@A@A@AAA@@@@BC@CAAAA@AA@@A@A@@@AB@@AACAA@@@A@@@AAA@A@@@A@@@@BA@AA@@@A@@AA@A@A@AA@@@AAA@@CAAABA@A@ABA@@@CAA@@@A@A@C@AAA@A@@BA@CBAAC@@@A@A@ABA@@@ABA@A@@@A@A@A@@@@BA@@BA@"],encodeOffsets:[[112103,34665]]}},{type:"Feature",id:"610116",properties:{name:"长安区",cp:[108.907173,34.158926],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@A@A@AAAAA@A@@AAA@CA@@A@A@@A@@@A@@@A@A@@@AB@B@@E@A@A@@B@@@BABA@@BC@C@A@CAIAAAECA@@AA@@@AAA@ABABA@ABA@A@@@@A@@@A@@@@@@@@CA@@GC@@CAA@A@A@@@BA@AAAA@@@AA@@@@ABGBA@C@A@CCAACAAAAC@@@C@@BA@@@@A@A@@@ABA@ABAB@BA@AAAB@@A@@BABABGDA@@@AB@@@@CBA@@@ABAB@BCF@D@@AB@@@B@BBDBBBB@@@BBB@BA@@@BD@BBB@@@BAD@D@D@@AB@@ABE@A@@@CA@@EA@@@@@AA@ABA@ADA@ABA@@@A@AACACA@AA@A@CAC@AAE@AAA@AAAA@@@CA@A@ABC@A@A@A@@ACAAA@@@@CAC@C@@@C@A@A@CBA@AD@BB@B@B@@BB@@B@@@@BB@B@@BB@@@BB@@B@@@@@B@@B@@BB@BB@@@@@D@@@@@@BB@@BB@@@@@@BB@@@@@BB@@B@B@@B@@@BBBB@B@@@@@B@@AB@@BDB@BDBBBB@BBB@@BBD@@B@BBB@@BB@@B@B@BB@@BD@@DBBBB@B@BBB@@@BB@B@B@@AD@@@BD@@BDAB@@AB@@@B@DDD@BBDBB@@BB@D@@B@@CD@@@@@B@@@B@@@B@@@@EBABCBBBBBB@@B@B@B@@B@D@B@B@B@B@B@BB@@@@A@@BCBC@@@ABAD@@E@@B@C@@CAAAA@AAA@A@@@C@@BA@AB@@@@AD@@A@I@A@AB@@AB@@@D@D@@@BABAB@@@B@@@B@@BBB@@@B@B@B@FG@@B@B@B@@@B@D@@AB@@@@AB@BCD@DABA@@B@@B@B@@@@BB@@B@BA@@B@@@BB@@@@B@B@@@@B@B@B@@BB@@B@BBD@FAB@@@BABA@AB@@@B@D@@@B@B@@AB@B@@@B@@@BD@@@B@B@BAB@BAB@@AB@@AB@@@@A@A@@BA@@B@@A@A@@@AB@B@@@@BBB@BB@@D@@@BB@@@B@@A@ABA@@@@@BB@@@B@BBB@@BBB@@@B@B@@B@@@@@BA@@@AB@B@@@@B@@@DA@@@@@B@B@B@@BB@B@@@B@@A@A@@@@@@B@@B@@BD@@@@BA@@@@BA@@AC@@@@@A@@@@B@@@@BBBBDB@BB@@B@@A@@B@AA@@AA@@@A@@@@@@B@@BB@@AB@@@@BB@@A@@BA@@B@@@BAD@@@@FB@@@@@DCB@@@B@B@@@@AB@@ABB@@@@BA@@D@@@BA@ABAB@@@BAD@@@@B@D@B@B@@@@@@DBB@@B@B@@@@B@@@@@B@@@B@BA@@B@@@B@@B@B@B@@@@@@@@B@@@BAB@@@@@B@D@@ABABCBABCB@@A@ABABAD@@@@@BBB@@@@@B@B@@BB@@AB@@@DAB@BBB@DA@@B@@@@@BA@A@BAA@@BD@AD@@AB@DB@B@@E@@@BD@@B@BB@@@DABHC@@@@@@@A@@@ADCA@BA@@BA@@@@@AF@DB@@DA@@D@@@DB@@DB@@B@D@@@BA@@B@@AB@BA@@DC@A@@AA@@B@B@@@@A@@@@@@@AB@@@@AAC@@FB@A@A@@E@AA@@BB@@DA@C@@AAA@@@@@D@BADBBD@@B@@@BAB@JA@@B@@AB@AA@@B@D@BA@@@@DA@@DB@@D@@AFH@@BF@@BB@@DC@@DB@@B@DAB@BA@@@A@@@@D@@I@@D@@@BA@AAA@@D@@D@B@DB@@B@@B@@BB@BH@@B@D@@@B@DJB@@@@D@A@F@BA@C@@@AF@@AD@@BB@@B@@B@@HB@ABAB@BCFB@AB@B@@AB@@@@A@@B@@@B@@@@@BD@BB@@@@A@@B@@A@CB@@@BABCD@B@D@BE@C@@A@AA@@C@@@@E@@CC@@@@B@@@@@@A@K@@BC@@B@@AF@@A@@DAAAFA@@CA@@F@@A@@DA@@A@@E@@BA@@@@@@@ADC@@CA@@BE@@BA@@DB@@D@@A@@H@@@B@@@HB@@B@@@DBA@BAB@BC@@@@BC@@@A@@@G@@D@@@B@B@@@FA@@BD@@B@@BBBBBBB@@BB@B@@AB@@A@@@J@@ABG@@BA@@BA@@AA@@AA@@AA@@@A@AB@@@BG@@BC@IBA@FF@@@D@@E@@B@@@@ACC@@DC@@BA@@A@@I@@BA@@DCA@@@BADBD@@D@DJD@@BB@@FC@@@A@BBC@@AE@@BBB@@@D@@@@C@A@@@@BD@@@C@@DAJ@@@DC@@F@@@BC@@@@@@DC@AB@HAHF@F@@BF@@AB@@B@@D@@@D@@DB@@@@B@B@B@@AFD@@DD@@BB@@FDB@DC@A@@BC@@@@@AB@@E@@EEA@AA@@@@AE@AAE@@BC@E@@@@B@@E@AFG@@FA@@B@@ABBBAB@B@@@@A@@BABBB@@@B@@@BAAAB@BA@@BAD@BA@C@@@BB@@CHCHAFHDLFD@B@JUAABCF@@ABE@@BBBC@@BAA@BCB@BED@@A@CFBF@@@@CF@@CD@BCH@D@B@@@BCBB@B@B@DAD@@@@ADBB@@ADDBAH@BB@@@@@B@B@B@BBB@B@B@@@@DB@@AB@@A@@H@@B@@@@@BABB@AD@@AB@@@BC@@BA@@DA@@DA@@D@B@@ABA@@BDBBA@@@@@B@@@BB@@@@@B@@B@B@@H@B@@@B@H@@@BB@@B@DB@@@@DB@CBBB@DAD@@@B@HBADDBADF@@DB@ABA@@@FBD@F@B@B@BA@@@AD@@@BEB@DC@@BAB@BA@@B@@@@B@@@B@BB@@BB@@BFB@BBB@B@@@B@@@B@BABABABCDABAB@B@@F@B@BB@@B@DA@FD@@@@A@@FB@EB@@@H@F@@@F@@CF@@BD@BAD@@ADBD@@@D@J@@@BB@@@AB@@C@CD@BCD@@@@ADB@AB@@BB@@@D@BEBADB@ACA@A@@BAA@ACBA@A@@B@@AB@@@@@@BB@BAB@@@B@@AB@@B@@@BB@B@@@@@D@@@@@@CB@B@@A@@@A@@@AA@@EA@@@@CC@@@A@BC@@E@@AB@ACD@@A@@@C@@@C@@@A@@@G@@@@C@@EBB@@@C@@@CA@@ED@@@@@AA@@B@@ACA@@A@@BC@@C@EDBAE@@@A@A@@@ABABB@@B@@AD@@BB@@CB@@CB@@AH@@AB@@@@E@@A@@CA@BAC@A@AIA@@CB@@E@@@A@@@@L@@AB@ADH@@@@DB@@DF@D@ABB@DE@@C@BCCA@@DCA@@AAACBAABCAA@EBB@AC@@@@@@BA@@AA@@A@KRB@@F@FBBEBB@EFB@GD@D@@C@@@CBB@CFABAF@@@B@@CB@@DDBFABA@@@@DAB@@CB@@BD@@FL@@@D@B@D@@DB@@C@@@DB@@C@@@C@AA@@@BCBAD@@@DA@CI@@A@E@AB@@A@AA@@@B@@@H@D@BBB@F@@DF@@@B@@DB@@BF@@@@@@CB@@AF@@@H@@B@DB@@@@@B@@@@BB@AABA@DD@D@BBB@D@B@D@H@@@@B@BB@@B@B@@@B@BD@@@A@@AJ@@BBB@A@@@A@@BB@@@CF@@BB@B@DB@@@AD@B@AAB@@@@CF@BC@@@@@BH@AB@B@B@BF@@BJB@@@HD@@CDA@@@C@@D@@A@@@@FBF@@BH@@CB@@AB@@AD@@BB@B@@A@AD@@@BC@@@CB@BA@@D@B@DBDABB@@B@B@BB@@@BF@@BB@BBBAB@@DF@@DL@@@B@@AB@@BB@B@BBH@@AH@@@@@A@@BD@@CB@BA@@@@D@@BB@@BB@@@B@@@@AB@@FD@ABF@@BB@@AB@@BJB@DFD@@@@BB@BB@B@BAB@@B@@@@B@@@B@@@@A@ADABA@@BA@A@@BC@A@A@A@AA@BA@@@@@@@A@@@AB@BA@AB@DADC@@@ABAB@@@BB@@BA@@@@B@B@@@@BBA@@@BB@@BB@B@ABDB@@B@ADDBABADBB@B@@B@@BD@B@@CB@BBB@@@@B@@A@@BB@@BA@@B@BB@@BCDB@@AB@@@ABB@@BB@@@ID@B@@@BB@@B@B@BD@@CB@@@BABA@@B@FB@BB@@@@AB@B@@@BAB@B@BBB@@A@@@AB@A@BAB@@@@@@A@@@@@AB@BA@@B@@A@@B@@A@@B@@C@@@@B@BAB@@CB@@@B@@@@@B@@A@@B@@AFAB@@@BBB@@@B@B@D@@ABB@BBB@AB@@BA@@B@@@B@@@@BA@@B@@AB@@BB@@AB@BB@@B@@@B@@@B@@@B@@@BB@@BA@@@B@@@@@@@ABB@@@A@@B@@@B@@ABABBB@@@B@BA@@B@B@@@BA@A@@B@@@DBB@B@B@BBB@BA@AB@F@B@B@@ABA@@B@@A@@@A@@B@@A@@@@B@B@@@@@B@B@@AA@AA@@DCBABAAA@ADABA@B@@B@@A@@B@BA@@@@BA@@BA@CA@@AACA@AAAABAA@@@@ADACC@@C@ACACAB@@ACAB@C@AAC@AACBABCBABAB@BA@@B@@@B@BAB@@@@@B@B@D@BB@@BB@@@BD@@@B@@@B@@@BABA@@@A@@@A@A@A@@@@B@@BB@@AB@DAB@@AB@AA@ABABB@@B@@@B@B@@@@@B@@@B@@ABB@B@@@@BA@@BB@@@@BAB@@A@ABA@A@@D@@@DBF@@AAC@@B@DAACDA@@BA@@@@D@@A@@A@AB@A@@C@@@BA@CABA@AA@AAA@AACA@ACC@@AAA@@@A@@@@BAAAAB@@ABB@ADAAA@CAAB@CA@@@@A@AB@@A@@@@AAABA@A@@@A@ADA@@@@@AD@@ABA@A@ABA@@A@@@ACABAAA@@A@@BA@@@@@@ABA@@A@@@A@@@@A@@CAE@@AA@BCA@A@A@A@A@@@A@CFC@EDA@@@CBA@A@BB@BA@A@AA@@@@ABCA@B@@@BAAA@@@@B@@@BD@ABAA@@A@ABA@A@EFAA@@BBABCA@A@@@@@@A@@@ADAA@@AA@BA@A@@ABC@AC@BCB@AA@@A@@@AAA@@@@@@A@@B@@@A@@A@@B@@AB@B@@@@AA@@@BAB@@@@@A@DCAA@@@A@A@@BADA@A@@BABA@@@A@@BAB@@@B@@@BA@@BAB@@@@@@ABC@@BA@A@@BA@@@AB@@@@@@AB@@A@@B@@A@@B@@@@@@A@@@@@@BA@@D@@AB@@@AAB@@@@@B@B@@@BCBA@ABA@@@@@A@@B@@@@ABAB@B@@@@A@A@ABA@@BA@@BAB@B@@@@A@@A@AB@@AA@@@EAA@A@A@@BA@@@A@A@@B@@A@@@A@@@BB@@@@BADB@@@@@BA@@BA@@B@@A@A@A@@AAA@@A@@@@@@@BA@@@@@@AB@@@@A@ABABC@@B@@@@@@A@@@A@@@@B@@@@@BA@A@A@A@A@@@A@@@A@@@@@A@@BAA@@@A@@@A@@@@@@@@A@@B@B@@A@@@@@@BA@AA@@A@@@@@AB@@@@AB@@@@A@A@@@A@A@@@C@CBC@C@@@ABA@AD@B@B@BAB@@CBAB@@AB@@@@A@C@ABABA@@D@@@@A@A@@@C@@BA@ABA@@B@@A@@BA@ABA@@@AB@BADABABAFA@@B@D@@@@A@@@C@AB@@AB@@@@@@ABA@@BCD@@@BA@@@A@@@AB@@AA@@A@@@A@A@@@A@A@@AK@@@E@A@@AA@A@@@A@@@A@AAACA@AAAA@@@@C@E@@@ABA@CBABAA@CE@A@CBA@A@A@@@A@@@ACA@@@A@C@A@@BAB@B@BADAB@BCB@@@D@@@HAFCBAB@@@@A@@@AA@@@@A@AB@AA@@@CAA@@@@BA@ABA@C@@@ABA@ABAD@@ABAA@AAAAEEAAAA@@CA@@A@@AAA@AAA@A@@A@@@@@A@C@C@A@A@C@A@A@ABC@C@A@A@A@CBC@A@CBA@@BABAD@@ABA@@@A@ABA@AA@@@@EC@@C@@@A@CBCBA@@@@@EAAAA@CAA@A@@@@@A@CB@@A@@@@BAB@@@@ABA@@@AD@@@@@@A@AAA@E@A@C@A@@@A@AA@@@CA@@@AAEB@AA@@@A@CDAB@@EBAB@BA@A@C@A@A@@@AAAA@@A@@@AB@@A@A@@BA@@B@B@B@B@BAB@BAB@@@B@@BB@B@B@BAD@BA@BB@B@BBF@@A@ABCBABA@@@A@@BAB@BAB@@ABC@ABA@A@A@C@GA@@AA@@A@A@@BA@@BA@@@ABCBC@AD@@AA@@AAA@C@A@AAEAC@AAA@A@ABABC@AB@@@@@B@@@H@B@@A@IFAB@@C@C@A@@BABCB@@@@A@CB@@@@A@AAAAAAA@@AAA@ABAA@AECAAA@@AAAG@AAA@@AC@@AAA@A@AEC@@@CC@ACCA@AAA@A@A@@AA@@@AAC@@@AAAA@ABAAAAA@AE@ECA@A@A@AAA@ABE@@@A@@@A@@@CAA@A@@AA@@C@@AACA@@C@C@A@@@AACAA@@AE@EA@@@@ABA@ABCBC@A@A@AB@AA@CAAC@@AACACAE@A@@AA@@AA@CAA@AAA@A@EA@@@@ABA@@@A@@A@@EA@@A@@@A@EDA@@@G@A@@@AB@B@@A@@@@BAAA@@@A@AAA@@@@@@@AE@@AAA@@@@A@CA@BA@A@C@C@AA@@A@A@A@A@AAAAC@@A@A@AAA@CC@@AA@@@@AAAB@@GC@@@@@CAA@@A@@AAA@@A@C@@@AA@@AAC@A@A@A@A@A@A@@@BA@@AACE@CA@C@A@A@@@AA@@@@@A@@@@A@@@AAA@AAA@@@C@A@AAA@@@@@A@@@ADA@A@A@E@@BCBA@@BA@@@AA@A@@@A@@A@AA"],["@@EAA@KCA@@@AB@DABEF@@@@@B@B@@@BB@@BBB@B@@@B@B@@@BBBBBBBDBB@B@BD@@@@B@F@@@BAF@BAD@F@B@B@@@@@@CAA@AA@AA@@@@@A@@AACA@@@@AGAACG"]],encodeOffsets:[[[111464,34634]],[[111785,34606]]]}},{type:"Feature",id:"610115",properties:{name:"临潼区",cp:[109.214237,34.367069],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@@@@@AA@B@@A@@@AB@@@@@@A@@@A@@B@@@@A@@B@@@@@@A@@B@@A@@@A@@@@@@@@@AB@@@@@@@AAB@A@@AA@A@@AA@ACAAA@@C@A@A@@BA@@@@@B@@B@@@BA@@@@A@@@@@B@BA@A@@@@AA@@A@@@@BB@@@@BA@A@AB@BABA@CBABCBA@A@A@AB@@@@B@B@@A@@BBA@@D@@@@B@@@BB@@@@@BA@B@@@@@AB@@@AA@@@@B@@A@@@@AA@@@@B@BA@@@@C@@@@@@AD@@A@AB@@A@@@@B@@A@@B@@A@AA@@@@B@BA@@@@A@A@C@@A@@BA@@A@@@A@@@A@C@@@A@@@@B@D@BA@A@A@@A@A@@CAAACAA@@@BB@@A@C@@@AB@BBB@@@DBBBDBDEDC@CA@CCCAAAA@AA@@@CB@@@AA@@@@@A@A@A@A@@A@@CAA@@BA@@@ABABA@@@CCC@@@AD@@A@C@@D@@A@@B@BA@@B@B@B@B@@@B@@@@CBA@@@ABABA@A@AB@BA@C@AB@BAB@B@@@@AB@BABCB@@AD@D@BB@@BAB@@@@BB@@BB@@@@AB@BA@@B@@@BA@@B@B@@A@@@@A@@A@@@@@A@@@A@@A@@@@@A@A@@AAA@@@A@A@CA@@@@CA@BCB@@@@A@@A@AA@CC@@A@A@@@@@@BA@@@B@@BBA@BA@@@A@AB@@B@BBB@A@@B@@A@@@AB@@A@@BA@@AA@AA@@@BA@@@@@A@@@@@C@@@@@@AAB@@AA@@@C@@A@@@@BA@@BAAA@@A@@@AAB@@ABA@A@@@@@@@@A@A@A@CA@@@@@@@A@@@@@@@A@AAE@@@A@@@A@A@@CA@A@@@@A@AA@@@@@A@AA@@A@@@@@@AC@@AACAA@AA@@@@@A@@@A@@@A@@@AA@@A@A@A@@@@@A@A@@@@@A@@A@@AB@@@@@@@@A@@@A@CB@@@B@@AB@@C@A@@@@@@AB@@@@@A@@A@@@@@A@@@A@@@ABA@@D@B@@@BA@@@@AA@@@@A@@@@@AA@@@@A@@@@@A@@@AA@BA@@@@@@@AB@@@@A@@@A@@@A@A@A@@@@@BBBBBB@@@@A@@@C@A@@@A@@@@BAB@@B@@@B@@@@@@BA@@@@AA@AB@A@@@@A@A@@@@AAA@@@@A@@@AB@B@@@@@@ABCB@BA@@@@@AB@@@@@@@@A@@@A@@@@@A@@@@@A@@@ABE@@@@AB@@@@AA@A@@@@@@A@@A@@@@@A@@@@B@@AB@@CA@@@@AB@B@@A@A@ABAAAB@@@@A@@@@@@@@@A@@@@@@@@@@@A@AAA@C@@@A@@@A@@@A@@@ABA@AB@@@@@@AB@@A@@@@@@@A@@BA@A@AB@@@BA@@B@@@@@B@@@B@@@BA@A@@@@DBBA@@B@@A@@FB@@@@BD@@BC@@BA@@@@@@BB@@@A@@B@@B@@BB@B@BAB@@@@BBBA@@@@B@@@@BB@@D@@@@@C@@BB@@@@BD@@@@@C@A@@@A@@BC@@@@BC@BD@@A@A@A@@@A@BB@B@@@BA@A@C@AA@@AB@@C@@@AA@@@@EA@@@B@@@BCBC@AC@@C@@@A@A@@@@@A@E@@@@BA@@@A@@BEB@@BJ@@B@BDB@@@AB@@A@@@A@@@@DA@A@AB@@BB@D@@B@@B@@B@B@@B@@F@@BA@@BB@@@@B@BA@@@A@BB@@@@B@@@BB@@@B@@@@@BB@@B@@A@@B@@@B@@A@@BB@ABA@@@B@B@@@A@@B@B@@@@A@@BA@BDI@EB@D@@@D@B@@@BD@@D@@@BD@@@@@B@BDBB@@B@@B@@@@DABB@@@BFA@DDA@BD@B@@@B@@@CB@@B@D@BD@DC@@B@DD@@@B@@B@B@B@BD@@@@DBD@BBDBBD@@@@DA@@@@B@BA@A@@BA@@BB@DBBB@@B@@@@B@@@@B@B@BBB@@BB@AA@@BAB@BA@BB@ABDBBBA@BBB@DD@@BB@@BBA@DDBABBDDDD@@FFBBJHDCDCBAB@DCDEDABHB@@B@BBBBBD@@D@DA@@FA@C@@F@@@BC@BBC@@D@@@@D@AFHB@@AD@@@BADAF@@AHJBAFA@AD@BAACJ@@@@CJ@@AB@@@FB@@D@@ADB@DA@@@B@@@@@FA@@B@B@@@BADC@@BA@AA@BA@AJA@@ECA@@@B@@AD@HB@@BA@ADB@@@APB@B@@@@BJ@B@@@ARD@AFC@@BG@@BB@@DADEA@DA@@BA@@BADC@@FA@@DA@@BD@@B@BABA@@CA@@BAB@DE@@FC@C@@DA@AJ@B@FAB@@@@@FIA@FA@AFB@ALNB@BBB@@B@@@@B@@@F@@@FA@D@AB@DCB@DA@@@AF@@AB@@ADD@AF@@AD@BB@@BBDB@ADAHBB@@@FA@AFC@ABBB@HT@@F@FAH@B@BB@@@@@@@B@DBB@B@B@B@@@B@@@D@B@BBD@D@F@@B@@B@B@B@@@BBD@B@@@B@@@BABA@@B@@@B@@@B@@A@@@A@@@A@@@A@@@@B@@AB@@@FCB@@@@@BA@@@@@AB@BABAB@@@BA@@@A@@@@@C@A@ABC@AB@DAB@B@FA@@B@@@@ABA@ADC@@@A@A@@BC@@@A@C@A@@@E@A@@BA@@D@D@BA@@@A@@@C@@B@B@BAB@@A@@BAAAB@B@@D@@@@@A@@BA@@@A@A@@@ABA@@@@D@@@B@BA@@B@D@BAB@@@@@BB@@@@B@B@DA@@@@@ABA@@@A@@A@A@@A@@@@@AB@@ABA@@B@DBB@AB@BB@@@D@B@@BB@F@B@B@DBD@B@D@D@BBB@B@B@D@B@HB@@@@B@@@D@BBF@B@B@B@@ABC@A@@B@B@@@BB@B@@D@@AB@@@BBB@@@B@F@H@@@B@@@@@B@@A@@BBB@BBDABBB@@@D@@@BA@@B@@@@@@B@@B@@@B@D@@@D@D@@BD@@@@B@@@B@@B@B@F@FB@@B@AB@DA@B@@@@BB@@@DAD@BBFBD@D@D@@@B@@@BB@@B@B@BA@B@@@@D@B@BBD@B@B@@@@@@B@D@@@@B@@@B@B@@AB@B@@@@BB@B@B@@@B@@@B@@@@A@A@@AA@@@A@@BA@@@@BB@B@@BB@@D@B@@B@@@@@@@BA@@BA@BB@@@BB@B@@@D@@AB@@@BBB@BB@@@BAB@@@B@@@B@@B@@@BBB@@@@BB@@@@B@@A@A@A@@@C@A@ABABCB@@@@@BBB@B@BBB@B@@@B@@@@@BA@AA@@@@A@@@A@@@@@@B@@@B@BA@@BB@@DB@@@@@@B@@@BB@AB@@B@@A@@B@B@D@@F@B@BABB@@BA@@BB@@BH@@CD@VB@D@BN@@F@@DBB@@FF@@EB@@B@@@AF@DBAFF@LB@CB@@A@@F@H@B@@CB@@FB@@FF@@BD@@DB@BEBB@F@@BE@@@FB@@ED@BKE@@C@AA@@C@A@@F@@A@@@@B@BA@AB@@CAA@@BEB@@EH@@C@@@@A@BC@AAA@@@C@@@EA@B@@A@AC@@AA@@A@EA@@@@EE@@EB@BEJ@BCD@BI@AD@@EA@BA@CA@@EBIBGD@BEC@@EB@BEDB@CDB@E@@@EBE@EDB@G@@@ABC@@@A@GGABGA@@@AHE@@@BI@@AJA@@ABGC@@HA@@GA@@DA@@EI@AF@@CAEABC@ACA@HAB@AEEAAAAAAEACA@@A@C@GAE@AAGAC@A@C@A@A@@@B@B@@@@@AAA@ABAAAAA@@@AB@@@@A@BA@A@@B@@@@@A@@@A@A@@@@@@A@@@AB@@@@A@@@@@AA@BAB@@CBD@@@A@AB@AEB@@@AGA@@CB@@DH@@C@@B@AA@@AC@CAIB@@C@@@DB@@C@A@F@@BF@@@E@@BFLA@A@AB@B@@@@@@DFABDDA@C@@D@AA@ABAAC@@@AGB@@A@G@A@A@@@A@@C@C@AE@C@@@AC@ADAAAAA@AAAEE@EB@@@@A@A@@C@@@BC@@@@BGAA@A@C@@@@@@B@@@@@B@B@AB@@BB@@@ABA@@@@@@@@@BB@B@B@@@@B@@B@@@@@BAC@@AB@B@@@@@BAAA@@@@@A@A@@BADBF@F@B@BA@@DA@@B@@A@@@A@@@@BB@@@@BABABC@@@BB@@DF@AE@AC@@AAC@A@@B@BAB@BCB@BA@@BA@AAG@@@AB@@ADA@ABA@A@@A@AAA@@@AA@@BAB@@A@@B@BC@@@AAA@AAA@C@EBABABABA@AB@BA@@@AB@B@B@@@@@B@@@B@@@@@@@@A@@B@D@DAB@B@B@@AB@DC@ABA@C@C@A@@@AA@@AAA@A@AFA@CAA@@HAD@D@DAD@D@B@@@@AB@@@@@@@A@@@BA@@BAB@B@@AA@@@A@C@@@@@@@@A@@@AB@@AA@@A@@BA@@@@@A@@@@B@@@@@@B@@B@B@@@@@B@@@@A@A@@A@@@@A@A@A@@A@@@AAAA@@@A@@B@B@@AA@@A@@@@@@A@A@A@@@B@@A@@B@B@ACBA@@@@@AA@A@@@AA@@@AB@B@@A@@@A@A@@@@ACA@@@A@@@@A@@@@BAA@BAA@@@A@@AA@@@@A@@AAB@@@@@@@@A@@A@@@@C@@B@@@@A@@A@@@B@@@@A@@@@@@B@@@@@@@B@@A@@AAB@@@@@@@@@B@@@AA@AAA@@@@@@@@@A@@@@AA@@@@BA@@A@@AA@@@A@@@@A@@B@@@@@A@@A@@@@@A@@@@@A@@B@@@@@CA@@@@B@@A@@@A@A@@A@@@AA@@@@@A@@@@B@@@@@@@@@@AA@@@@@@@BA@@@@@@@@A@A@@@@AB@@@@AB@A@A@@@A@@@@@@@@A@@B@B@@@AA@@@@@A@@@@@AA@B@@AB@A@@@BA@@@@@A@@@@@@@AA@A@BA@@@@@@@@@A@@B@A@@A@@@@@@@@AAB@@@@@@@AA@@@B@A@@A@A@@@@@A@@@@@@A@A@@A@A@@@A@@@A@@@A@@@@@@@@@@@A@@@@@@B@@A@@A@@@AA@@@@AA@A@AAA@@@@@A@@@@@@A@@A@A@@A@@A@@@@@A@@AA@@@@A@@AB@@@AAA@@AB@@@@A@@@CB@@A@AADA@@A@@A@@A@@@@@A@@@@@@B@@@@A@@B@@@BA@@@@@@B@@@@@@@@AB@@@BA@@BA@@@@@AB@@BD@@A@@@BD@@ABA@A@@B@@C@@B@@AD@@@BA@@@@BD@@BCB@BAA@@A@@@@@A@@@@@@@A@AB@@@@@@A@@@@B@@@B@@@@@@AB@@A@@@@@A@@@@@@@A@@@A@@B@@@@@@@BAA@B@@@@@@AB@A@@@@A@@@BB@@@@A@@@@@@@@A@@@@A@@B@@AA@@@BA@@B@@A@A@@B@@A@@@@@@@@@@@A@@A@@@@@A@@A@@@BA@@A@@@@AA@@@@@A@@A@@@@@@@@A@@@@AA@@@@A@@@AA@@@@@@@AA@@A@@@BA@@@A@@@@@@@A@@@@@A@@@A@@B@@@AA@@@A@@@@@@A@@A@@@@@@@A@@@@@@A@@@@@@AB@AA@@@C@@@@@@A@@A@@@@A@@@@@@A@@@@@@A@@@@@@@AA@@@@@@A@@@@@AA@@B@@@@AA@AA@@A@@A@@@A@@@@@@@@A@BAA@@@@@@@@@@@@A@@@@A@@@@@A@A@@A@@@@A@A@@AB@@@AA@@A@BA@@@@A@@A@@@@@AA@BAA@@@@@@A@@@BA@@AB@@@AA"],encodeOffsets:[[111989,35120]]}},{type:"Feature",id:"610125",properties:{name:"鄠邑区",cp:[108.604894,34.109244],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAA@C@@@@@A@CBEBA@@@C@@@AAAAAA@A@C@AAC@@@@A@A@@@C@@@@AA@@@BA@@AAA@A@ABA@A@@AA@AA@@@AA@AB@@A@@AA@A@@AACACAA@A@@@AA@CAACAAAAA@@ACA@@AC@A@@@@@@@BA@A@A@AB@@AB@BC@AB@@AAA@@@C@@@@@A@@@@AAA@A@@@@A@@A@@A@@@@A@A@A@C@GB@@AAAAA@@@@@AB@@@@C@C@C@CBA@@@A@AAA@@@A@@BCBAD@@@@@B@@@BCB@B@B@@AB@BA@@B@BCB@@C@A@AB@@@B@@ABA@@B@BAD@B@DBB@@@@C@A@C@CBA@CBAB@@IAABAA@@@BA@ABA@CD@@A@A@CAA@A@@@A@ABC@A@@@CBABA@C@A@AA@@ABAB@@A@A@ABA@AB@@CBA@A@CBAB@@ABAB@@A@A@@@C@A@AA@@A@@AAAC@@@@A@@A@@@AAC@AAABE@CBA@AAA@A@@AAACA@@@AAAA@C@@ACAA@@AAA@@A@C@@@CE@@AAAAAAAA@@@@@AAA@@AAA@@@A@C@@@AAA@AAA@AAA@AAAAA@@@EA@@C@@@CCA@AAA@AA@@A@A@ABAAA@@@AA@A@@AE@@@A@AAA@@@@A@I@C@@@@@ABAD@@CDABC@ADABA@@B@BABBBA@@BABA@A@A@@@CBC@CBE@A@A@AB@BABAB@D@B@@AB@D@B@@A@ABAD@@BDB@@B@B@FBB@BBDDD@DBDBD@@BDDBB@@B@@@BAD@B@B@BBB@@BB@B@@AB@B@B@@@B@@BBBBB@@@B@@@BBDB@@@B@BBB@B@@B@@B@@@BB@DDBDB@@@DBB@@@BD@@BB@@@B@B@B@BDB@BBBD@B@@@DB@BDB@@B@B@@B@@@BBB@@AB@@@B@B@D@@B@BDB@@BBB@B@@B@@B@BFB@BBB@@BBB@BD@BBD@B@@ABABAB@B@@@B@BBD@@@D@B@@@BB@BB@BB@@@BB@BB@DBBBBB@BB@BBBB@BBBBBBBDBBBB@@B@@@B@D@B@BAD@@@B@BBB@BB@@B@B@B@BADABAB@@@B@B@B@@ABA@@@@B@@BB@@@B@@ABABA@CBA@C@AB@@ABAB@FAD@BAB@B@F@B@@@BBBB@ABB@@B@D@B@@@DAB@B@D@B@BBBBBA@@B@B@@@BAF@B@@@D@@@BBBBBBDDB@B@DBFB@A@@BBBBB@B@BDBB@DB@B@D@@BD@BBBB@@B@B@B@@BBB@BBB@BBB@@B@BBB@B@BB@ABAD@@ABABAD@@@D@@BBB@@B@@@B@BBB@B@D@BBDBBBB@@AF@@@@@B@@@@BA@@@@B@B@@@@@@@@@@A@@B@@@BB@B@@@B@B@@BB@@BBHB@BB@@BA@@BA@@@@B@@@@B@@AB@@B@BA@AA@BA@@@AB@@@@@B@@@@BBB@@@@B@@A@C@@B@@DAB@@B@@@@@B@BBB@@DB@@@@@@@@A@@B@@@@@@AB@@@@@BB@BA@@@B@@@@A@@@ABB@@B@@@@AB@@BD@@B@@B@@@BC@@@@BB@@B@@@@@D@@@B@@@@@BC@@AAB@BB@@BA@@D@JAB@BA@@@C@@DC@@@@@@BA@A@@@@@@D@D@DB@@@@@@BD@@BD@@BB@@BB@BD@DA@@@@BB@@BBB@BBD@@@DB@@@@@@@@BBB@@B@B@@DE@@B@B@@D@@BF@@D@DC@@BA@@@@DA@BB@@BDBB@B@B@B@BB@@B@BB@BB@B@D@B@@BB@@@DB@@DD@@@BBB@@@@DBB@B@B@BB@@@@BB@BBBB@B@@BDD@@@@FAB@BB@BHD@@BDA@BA@@BB@@B@BE@@D@@@DB@@BBB@@@B@BC@@B@B@@AD@DAB@B@BBBAB@@A@@DAB@@DB@B@@@@D@@@D@BDD@@@BDB@@@H@@CA@BA@@B@@BD@@AB@@@@B@@@AB@@BFB@A@@@@D@@EB@@@@BB@@AD@@AD@@DJ@@CD@@@@F@BB@@B@B@@A@@DA@@LA@@@B@@BB@@BC@@CA@@D@@C@A@@@B@@BB@ADCAADA@@@C@@@EZAH@BFBJ@NBRBJ@@@TBD@F@HBH@B@DBD@D@HBHBDBD@@@NDDBDBB@HFDBPHDD@@D@BBFBBBBBJDF@FBDBD@FDJDLFDBFBBEDGDG@@AA@@D@B@@ABC@AB@@ABABB@A@@@A@@AABA@AB@@@@@@ABAAABA@@@AB@@EH@BEF@@@@A@@F@D@@AF@BBF@@B@@B@@BFB@FF@@@BA@@@@D@@AB@D@@CCA@EA@@AC@@CC@BE@@@A@A@A@@A@@CC@@@C@@@@AA@@BE@@AE@E@BG@GBAD@@C@@@@D@@A@@@ED@@C@@BI@CD@@@C@@A@@B@D@@@@@@C@@AA@AF@@BD@AAB@@@D@@EA@@AC@CIC@@@ACBC@A@@DB@CB@@AJ@@@@BB@@AD@@CD@BD@@@@@AF@@@@C@@EEB@JAD@@AH@@A@@BAB@@@B@@BB@@BB@@BB@@AB@@AH@BA@@@I@@@BA@@BA@A@@AA@AAAAAA@@@AC@@AB@@E@@@A@A@@@CH@@@B@@@D@@A@@D@@ABA@AAB@C@@@AA@@G@@@A@@@GB@@@@CA@@CB@@AF@@AB@@DD@BC@@@@@@B@@AF@@@@BB@@CB@@@@EB@@DB@BEBB@CB@@@BE@@@AD@@AL@B@@@@@@@@A@@D@@DF@@@@@@DB@@B@BD@F@@A@C@ADCBA@A@@DAB@@@@AB@@@@@AAC@@A@@@@@A@@@AB@@@@@BA@@@ABAA@DE@ABABAA@@GA@@@@AA@@AC@@BE@@B@@@DABE@B@C@@@@@IA@C@A@@@C@AG@@AAA@@@AA@@@CAA@C@@@@CB@BBB@@A@@@CJ@@@@C@@B@@@B@@ABA@C@AA@@CD@@CA@@AE@@AG@BE@@@CA@@CB@@C@@B@@A@C@AB@@BBA@@@AB@@IBA@A@@@AC@AABC@A@C@@@@BB@BD@B@@CA@@AB@@B@FB@B@A@@ED@BB@@@@BA@@@@@@B@@@@@@A@AB@@BB@D@@CB@@ABA@@@AB@@A@@@C@AA@@CA@@C@@@CB@@CA@@CBE@@@@B@@AB@@ADBBC@@B@@@@@@@D@AGCB@@A@@A@AC@@A@@@FA@A@@CBA@@BCC@@AB@ABB@B@@A@@@@@AB@@CAA@ABA@C@@BA@@AA@@@A@A@@@@AA@A@@@@BCBABAB@@@DABADABABA@@@C@A@@@@BA@A@@@A@@@@@@A@A@A@@@@A@@@AB@@A@A@@@A@@@@@A@@A@A@@@AA@C@@@@A@A@C@A@@@@@BC@A@@BABAB@@A@@@CB@@A@@A@BA@@BA@@@@@A@A@@DA@C@@@@EA@@@@BC@A@@@AB@@AB@@@AA@@@@BA@@AA@@@A@@@@B@@@B@@BB@@B@AB@@@@AA@@ACAAAAA@@@@@A@@B@@@@@D@@BB@@A@@B@@A@@C@@AA@@@@A@@@@B@B@@@@A@@@AAA@@@A@A@A@@@@CB@@A@@@@@@ABA@@B@@A@@@@@AA@A@@@A@AA@@AA@A@A@@AA@@@@B@BAB@@@@A@@AA@@C@@@AAA@AA@@@@@ABA@@B@B@@@@AB@@AB@B@@@@@BA@@BA@@BA@ABA@A@A@A@@AC@@A@@@A@A@@BA@A@@@C@A@@@A@@BABAB@@A@EBC@AAA@@@AA@@@A@A@A@@A@A@@@@@AA@@A@@@ABA@@@AA@@@@@A@AA@@@ABCBC@ADA@@B@@A@@BC@A@@@A@A@A@@@EHA@A@A@@@A@AA@@@A@@@A@@BABA@A@@@C@C@@BA@@BAB@J@B@@@BC@@@@BAB@@AD@@@B@B@BBB@BBDB@@@D@AF@@@BCBA@@D@DA@AB@@@@@AAA@A@A@A@A@C@A@@@@A@A@AA@AAAADABAFA@@@@@A@@@A@@@A@@@@DC@@@AC@A@@AA@CAAAC@CCA@@@A@@BA@CB@AC@@A@@BC@@@A@AAA@@A@AAA@A@AACA@@AC@@AAA@A@@@AA@@AA@A@AC@AA@@AA@AAAAAACA@AC@@BA@@@A@@@@@AAAAA@@A@@@@A@AA@@A@@@@AA@@@@@@AA@@AA@@@@@@@C@@@@AAA@@AA@@@@A@@@@@AA@@A@@AA@@@AAA@@@@@AA@@AA@A@A@A@@B@B@B@BB@@BB@AB@@@AA@CAA@@@ABA@CBA@A@EACAA@@@C@@@A@A@A@A@A@@@A@A@ABA@A@A@A@AD@@@@A@AAA@A@@@A@ABA@A@C@@@A@AD@@A@ABABA@@AA@CEAC@AC@CCEA@@A@A@EACA@@AAE@A@@@A@AAC@A@CAG@@@@A"],encodeOffsets:[[111224,34638]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
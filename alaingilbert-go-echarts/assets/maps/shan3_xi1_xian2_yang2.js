@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("咸阳",{type:"FeatureCollection",features:[{type:"Feature",id:"610402",properties:{name:"秦都区",cp:[108.706272,34.329567],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AABC@@@@BC@C@A@AAAAD@@A@C@G@ADC@@DE@@D@@E@EA@D@BC@AFA@ADB@AB@@ADAA@@AF@BE@ADBBIVA@C@KEGCEACAKEICEP@D@@A@A@@B@@@DAB@@AD@@@@A@ACABAB@BAA@BADB@@@@B@@@@@BB@@B@@@D@@AB@@@@A@A@@@AD@@A@@@@BADA@A@@B@D@@FBBBAB@@BBB@B@@@ABB@@D@@B@@@@@@BD@@@BB@@@@@D@@@@DAB@@D@BA@@AA@@B@@AB@@A@@@AB@@AB@DAB@@@@A@@@@F@@A@@@E@A@@AAB@@@@@@@@A@A@@@A@@@AB@@@@B@@B@BAB@D@@@B@B@BB@BBB@D@DB@@DBFBFB@BB@@D@@B@DB@@@B@B@@A@AF@B@BB@AFCBA@A@@B@@@@D@B@B@@@@@@AD@BBB@@@B@@BAB@@A@@B@@@B@@@@AB@@BB@@@@B@@A@@@@BB@@@B@@@BB@@@@@@B@@ABAAAB@BA@@@A@@@@@A@@D@@@@A@@@@DA@@@AB@B@@@@AA@@AB@BA@@@@@A@@D@@A@@@@@AAA@C@EA@@AD@@@@E@@AA@A@@@@B@BAD@@A@@BADBBAD@@A@@@@@A@@@@AA@@@BEKA@FI@@@AD@@A@AF@@@@A@@@@@ADB@BB@B@@@B@@@BB@@@@B@@@@@DFCF@@D@DAH@B@@A@A@@BAB@@C@@@@BA@@@@@@B@@@@AB@B@@@@A@A@@B@@@B@@A@@D@B@@@@@@AB@@@@A@A@A@@BAB@@@@A@@@A@@@CDA@@@AB@@A@@@@B@B@@@@@B@@@D@B@@B@@@@@@B@B@B@@B@@@B@@@@BABBB@@B@B@B@@D@@@@B@D@@@@B@B@@B@@@B@@BA@A@@@AH@B@@C@@@@B@DA@@B@B@@B@B@@BC@@B@B@@@@@@B@AB@B@BB@@B@@DB@@B@@@B@@AB@BCB@@DB@@AB@@AB@@CD@B@D@B@D@@ABB@@FBBE@@DG@CB@BED@@A@@@@@A@ABBBE@@FBBBABFB@DBBB@B@@A@@BAFDNDAD@@CA@BA@@@AHA@AFA@@BGAADCA@DA@@BA@@@C@@@AFF@@DDB@AF@ABDBJBH@@BADHDAD@@@FDB@FCAAF@@AFIA@B@DAB@AA@A@AD@@A@@FA@@@@BH@@DBB@DDB@@B@AFHBADFB@B@@B@@AB@D@@DNBFB@@FBBA@@@@@@@FB@@E@@D@BEDBBEB@@EA@BED@BEB@BCD@BGC@@@GE@AC@@CB@BEB@@@B@HBBC@B@BPD@@D@BAD@@ABBBA@@BED@B@@BB@@@@@@BB@@@B@ABB@@@@@@@B@@@@@A@BAB@@@@D@@B@@@@@BA@A@@BB@@@A@AB@BED@@@@CB@B@B@@@@A@@@@AA@@BGA@@ED@@@BE@@BBD@@E@@@@@@BB@@B@BA@@@@BCFBF@BEA@BGJBBCB@@AB@@CA@BECAA@A@A@@@AA@@@AKA@AC@@EF@@E@@@A@AD@@AB@@@BEBB@ECA@FA@@E@@AFCA@CCAC@I@@AC@GAGA@@A@C@@C@@@BA@BC@@@C@C@EB@@CAC@G@@@CC@@@@EJ@BEB@@@BC@AD@@CE@B@B@@A@@@@@AB@B@@A@@@A@@C@@@A@FCBAA@AAA@@@C@@A@@@@AB@@@A@AA@@A@AB@@@EA@AA@A@@@@C@@BAB@@AB@@A@BB@D@@ADB@CF@@AD@@BBB@CB@@D@@D@@@D@BB@@F@BA@A@@DBBA@A@@BCDBB@@@BCD@B@@@BC@C@@@CD@BB@@@A@@@E@A@ABE@@@A@@@@BC@A@A@@@CBAD@@@BBHB@A@CB@BBB@D@@@B@DBB@B@@BB@@BDB@@FB@@@@BB@@FFB@DB@BB@@@HFHDLDDB^LB@XJPDFBAA@M@@@AA@AACK@@@@FDD@AC@A@AA@@BA@@AA@AA@A@@@@@@B@@@@@D@@A@@B@@@@@B@@AA@@@@@@@@@AC@@@@@A@CI@@AE@@EB@@@@CD@@A@ABACA@K@@DA@AC@@EB@@AA@BAA@@EA@@@E@@@@GDB@CF@BI@@@A@CE@@CAB@CA@A@@B@@AA@@I@C@@@C@CA@BC@ABC@@AE@@DE@@@E@G@@@A@@FEA@@@B@@C@@ECBA@@@AAA@E@@@@ABABADCBABABA@A@A@@@A@@@AAA@AEA@AA@@AA@@A@A@@@A@@A@@@ABA@AB@@CDA@AF@@C@@B@@ABA@A@E@C@EA@@B@BAA@@CE@BCCABCGAA@@@C@CBA@AA@DCA@@@@CAA@@@AA@@G@A@@@A@G@@@@A@AA@@@@@A@@A@@@A@@@@ABCA@AB@BA@@@A@CB@@CB@@CB@@AD@@A@@BA@@BCA@BA@A@@@@@AG@@@@BA@@BA@@C@@A@A@A@AAA@A@A@@@@@A@@ABGCABC"],encodeOffsets:[[111299,35067]]}},{type:"Feature",id:"610403",properties:{name:"杨陵区",cp:[108.084731,34.272117],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAA@@B@@AD@@@@@@@@A@@AA@AA@AAAAEA@AAB@@ABA@@BA@A@@@@@AA@A@@CCAAAG@A@@A@AC@@@A@@@E@E@C@A@E@AA@EBABEBC@KDGBABI@GBC@I@C@A@KAE@IAC@I@@@_CKAEA@@E@EAG@G@C@C@GBA@@@@@ACACA@@@ABC@CAC@@@AAAAA@@@A@A@@@A@A@@@ABB`@FB@@B@F@@@HA@@@@@@AA@@D@@A@@DB@@D@@@FB@@BF@@DD@@@FB@BE@@BA@@BB@@FC@G@@AAB@BKB@@A@@@C@I@@BC@@DBBABB@@DH@@BA@@B@B@BB@@@@D@B@@@AA@@B@@@AA@@@@DA@@CA@@A@@AA@@@@@@A@@D@@CB@@AB@@@@AD@DA@ABC@@@@A@A@@@AA@C@C@@@@@AH@B@@@@@A@@AA@@@@@@@@@B@@@@@@@B@@A@C@@@@@ACA@C@@@@DBF@BAB@D@DABGB@HDB@B@DD@BB@B@BBLB@BF@D@@B@B@B@FAD@FBB@B@DB@@B@@@B@HEBAB@DAD@B@BBBA@@@A@@@A@A@@B@@@D@@@B@@A@@B@@@@@@@@@B@BB@@@BB@DD@@D@@@B@@BDD@@@B@@AB@@@@@BA@@@@B@@@BA@@B@@@BAB@BB@@BA@@@@@BBBB@@B@B@@@@@@@@B@@B@@@@@B@@@@A@@@@@@@A@@@@AA@AB@@AB@@AB@@@B@@C@@BA@@@@B@@@@A@@@@AA@@@@@AB@@@BB@@@A@@@A@@@A@@D@@A@@@@BA@@BA@@B@B@@@B@BB@@A@@@@BA@@B@@@@@BB@BA@@B@D@@@@@@@@@@B@@@@B@B@@@B@B@@@@@D@@@B@@@@@@@B@AA@@@@B@@A@BBA@@@@A@@A@@@@B@B@@A@@B@AAB@@A@A@@B@@BB@@@@@B@@@B@B@@@@@@@@B@@@@@B@@B@@@BBB@@A@@@@@@@AB@@@BB@@@@@@BBA@@B@@@@B@@@B@BABB@BB@@@@@@BBA@@BB@A@AB@@@@@B@@@@BAB@@@@BB@@@AB@@@@A@@B@@@@@BBB@@BA@@@@B@@A@@B@@@@B@@@@@@AD@@AB@@@@@BBA@B@@BB@@@@@B@@@B@@AB@@A@@@@B@@@B@@B@@B@@A@@B@@AAAB@@@B@@@@@@@A@@@BB@@@@@@@@BAB@@AB@@AA@@@CC@@BA@@@A@@@@@A@@B@B@@A@@@@@A@@@@@@@A@@@@B@@@B@@A@@@A@A@@@A@@@A@@BAB@@AB@@A@@@ABB@@@A@@@@B@D@@@DBB@@BB@@B@@@@@@B@@@B@@BB@@@@@BB@@B@@BBABB@@@@@@@@@@@B@@@@A@B@@BB@@@@A@@BAB@@BB@@@B@@AB@@@BB@@@@B@@@@AB@@@@@B@@B@@A@@B@@@@@BB@@@BABA@AB@@@@@@@B@@B@@@B@@@@BB@@BB@@@AB@@@@@B@@@B@BA@@@AB@@@@@@AB@@@@@@@B@BAB@BA@@B@@A@@@C@@@AB@@@@@@@BA@BBB@@@@BCDA@A@@@@H@@A@@BB@AB@BA@@@@BAB@@A@@BA@@B@@@@@@@@@@AA@@@A@@@BC@@@@@@@A@@@A@@BABA@AB@B@@AD@@@@A@A@@@AAA@@@@@AA@A@@A@@@@AA@@@@@@@A@@BB@A@@A@@@FA@@@@BC@A@@@@@@@A@@@@@@@@@A@@@@B@@A@@@A@@@A@@@@@@B@@@@@D@BA@@@C@@@A@AA@@@@@A@@@@AAA@@@@@@@A@@A@@@AABA@AA@@@"],encodeOffsets:[[110727,35080]]}},{type:"Feature",id:"610404",properties:{name:"渭城区",cp:[108.737204,34.36195],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@CA@@DAA@AC@@BE@@DCA@BC@A@@A@BA@@BA@AB@@@D@@B@B@@BFB@@A@@B@BB@@B@B@@BA@@@@@BD@@@B@BBB@ABEDB@@@D@@@@B@@@BA@A@@B@@@@@BA@A@F@@DC@@BAD@@A@AFI@@F@@D@@D@@@HBD@DA@@F@D@D@@ADB@@A@@@DD@B@@@HBHBD@@BJ@D@DB@DDBBE@@@FB@@EDB@FAAAF@@A@@BC@@B@B@@@FE@@FD@@BLB@B@@BB@@B@B@B@DBAFB@@DA@@BA@ADIAAHB@AFE@EAAD@@@@ABA@@@AA@@@@@@@FC@AA@@AF@@C@@FB@AH@@BB@@@@@B@@A@A@A@@D@@C@AFA@@B@B@@AA@@@BAB@@@@A@@@@C@@A@ABB@@@@@A@@@@@@@A@BAA@@@A@@DAD@F@@@DB@@D@@@DA@@FBBB@@A@@B@@A@@@@@@@AB@D@@@@AB@F@@AB@@EFB@A@@@HB@@DB@@A@@@BB@@BB@AFB@AFHBADF@@@D@@@@@@@D@@@@BB@@AJ@@@BAF@@AA@@ED@B@@EB@@@BAB@BA@A@@BBB@@CD@@DB@@C@@D@@@F@@@D@B@@B@FB@BE@@B@@@AFD@@CHB@@B@@AF@BC@@D@@@JB@C@@@@B@@B@B@@@@B@@AB@@@BC@@B@@@@B@FB@@@@IBA@@B@ND@AB@@A@@@@B@@B@BBF@@@@@@BGB@@A@A@ABBB@@@@FBB@@BB@@@D@@B@@@@B@BA@DB@@B@BB@@D@@@B@B@@@BB@BB@B@BBD@D@@BB@B@@BB@BB@@B@F@BB@@B@BBB@B@@@BC@C@@@@CA@CEABAHBBC@A@@FBD@@@@B@@@@BB@BB@@C@AB@BBFBBA@@@@BC@@BAFBBEB@B@JB@EF@D@BB@@@BABB@B@@A@@@@FB@AD@B@BADB@DDBBIAA@@@@D@@@@@BA@AH@@@@C@AD@@@B@@@@@BBDB@@D@BDBAD@B@BBD@@A@@BCHB@@@@AA@@@@AA@@AC@@A@@A@@BA@@@ABG@@B@DB@A@@B@D@@@@@F@@@@CFB@@@@@B@@@@D@BB@@@@@DB@@B@@@B@@BBB@@B@DD@D@@ED@@@@ABA@AB@@@D@@@D@B@@@@BBB@@@@D@@@@@@@B@B@@@@A@@@@BB@@BABA@@F@@@@@@A@@@A@@@A@@BA@AD@@@@@BE@@B@@@@B@@@@B@@A@@@AB@@B@@B@A@@BB@@@@A@A@E@@@A@@HBB@@AD@@@BE@@@@J@LBBAD@@BB@@BF@@CD@@BD@@CB@@BB@D@B@@@H@@C@C@AD@@A@A@G@@@A@A@CBA@EDG@@BCAAAAQKGECAA@AAC@C@@AIAIACACAG@CA@@C@C@CAMAOA@@A@A@AAA@ECEAECCACACA@@OCC@CACAICC@ICC@AAC@AAMCE@CAEAUIC@MEECEAOIEAOCWIA@]KCAKCGCGE@@A@@ACAA@EE@@AA@@@@EA@@CA@AA@@AA@A@CAA@@@C@A@AAA@@D@BGAAA@@C@AB@D@@@B@BAD@@@@@B@@AF@B@B@F@@@B@@AAC@@D@@@DAD@@A@C@AD@@A@CAAD@@@BABCA@@@BABE@@@AAC@@@C@"],encodeOffsets:[[111301,35180]]}},{type:"Feature",id:"610422",properties:{name:"三原县",cp:[108.940509,34.617381],childNum:1},geometry:{type:"Polygon",coordinates:["@@BA@A@ADCBC@@@CDE@AB@D@D@DDBDHDBBBBB@BFDBDD@@@D@FBB@BDBBDBBB@@DB@BBH@@@B@@@B@BCJ@HU@CD@@AP@BAF@@DB@B@D@@C@@BD@@F@F@@@BB@BD@@AB@@CB@D@BHF@BCF@BEJ@B@ABN@@DB@@ADBD@@AF@@@@B@B@@B@D@FBB@F@L@B@@BF@F@@@F@@EFAADDAB@DAAA@@@@BA@@AA@@AAA@@@A@@A@@@A@@ABBEECBAB@@@@AAA@EC@@@A@@A@@A@A@@ABA@AA@@C@AA@BEB@@C@AB@@EA@@EC@@C@@A@AAG@@A@@@AAA@EGA@CAA@C@ACA@ABG@AA@B@C@AAA@C@@@A@@@A@A@A@A@CAA@@@@@@@A@@A@ABG@E@ES@@GAABAD@BEB@@E@@AABGBCA@AC@AA@@ABC@@BEC@BC@@BA@@BE@@B@@CDA@CBAC@B@@E@@@E@@@A@@A@@@AA@AMAA@@EGASA@EE@@DC@@@A@C@IAAD@DEACJC@AF@@AHE@@DC@@CB@@A@@A@@@@AK@@AC@@AC@@BA@@DCJG@CAC@@DWCAFC@C@BEMAAA@@C@AB@@@E@@@@GA@FA@BEGA@D@@E@@BKABC@A@AMA@AE@@DK@@EA@AAED@CEAAA@@@@BC@@A@@@C@IA@AC@@BC@@@@@E@AFC@@EE@@@A@BEC@@EWA@CEA@@A@@FIAEECA@@@CC@@@@B@B@@A@A@AD@BA@@FC@EAC@@@@F@@A@AFB@@B@D@@@F@@ABBB@BE@ABECEC@@AJ@@OA@A@AE@@A@AA@A@A@@@@@@BAB@B@@A@@AA@@@@B@B@@ABCAA@@@BE@AA@@A@@@C@AA@G@C@@@@D@B@B@@@@A@@B@@ABBB@D@@BB@@F@@@@H@B@@C@@D@@A@AA@@@@AA@@ABCB@BA@@B@BEBA@@D@BA@@BI@@@K@@@AB@@@@A@@DEBBFF@@@BBB@BBB@@DBD@@CB@DI@ADA@@B@@@B@@A@@D@DD@@EH@@@@B@@@BB@@D@B@@A@@BB@@BD@@B@@ABE@A@@@B@BD@@BD@B@DBB@DDB@@@DABABA@BBA@@@AB@B@B@@@@@B@@BB@@@@ABAD@@BDBDB@@@@B@B@@AB@@@BBBBB@BC@A@@@A@@@@B@@@@@@BBB@@@B@@@B@@ABA@@BA@@B@DB@BBA@@@AA@@@BA@@@@BBB@@BBA@@@@@@BA@@@@@@BB@@@@AB@BB@@@@@@@BA@A@@@@B@@BB@@@B@@@@@@AD@@@B@@A@AB@@@B@@BA@@B@@B@B@@@@@B@B@@@B@@A@@@A@@B@@@D@B@@@B@@@@B@@B@@B@@@@BB@@B@@@@BB@@@@@@@BBAB@@@@@B@@@@@@B@@@@B@@@B@@@D@@@@BD@@@@@B@@@B@B@BA@@B@@CA@@A@@@@B@@@BA@@@ABA@@@ABBD@B@@@@FB@BB@@B@@BB@@@DB@@@ABA@ABAB@@AD@@BB@@BB@@@B@B@@@B@BBB@@A@A@ABABA@A@@BA@A@@@ABCDA@@@A@ABABAB@@@@A@@@@@@BAAAB@@@@AB@@@AAA@A@AA@@@A@@@@@A@@CB@@@B@@@@@@A@C@A@A@AB@@A@A@@A@@ACAA@C@A@A@@BAB@@@@@B@BA@@@@@A@AAA@@@@@A@@@@@AB@@AAAA@@C@@@A@@BAB@@C@A@@BADA@A@@@A@@@@@A@@B@@A@@A@@@@AB@B@@@@A@@A@C@E@C@C@AA@C@@@ABA@CF@@A@A@ABA@ALAD@D@@AF@BAA@@A@@AA@@BA@AB@@ABABABA@C@ABABA@A@ABA@CACA@@A@AAA@ABABA@@@A@AB@@C@A@A@A@ABCBABCA@@EDA@A@C@A@CBAB@BA@AAA@@@ABABADCBAB@BA@@B@@@@CBA@EBABAB@BAB@B@B@B@B@B@B@B@B@BB@@B@@BBB@@@@DCF@@@BBB@B@@@DBB@B@@@B@B@B@@A@@B@@@@@@ABBBA@@BB@@DBD@@@BB@@@@@AB@@BB@@AB@@B@@B@@@B@BBBA@A@A@A@@BA@@BAA@@@@A@@A@@@@A@@@@BAD@BAB@@@BAB@BB@D@B@BAB@B@@@BBB@BA@@@@BBB@B@@@@@B@@@B@@@B@@B@@B@@@BB@@BBB@@@B@@B@@@@B@@@@@BB@@BBB@@@BBFBB@@BD@BAD@B@B@BBB@BBH@@@BCDA@@@@B@B@BB@@@B@B@B@@@B@@@BA@@B@B@@@BDA@BBD@B@BB@@BF@DB@D@@@@B@B@D@@BF@@@@@B@@A@@H@@@@D@@@@B@B@@@@@@BB@@@@B@@@@@@B@BB@@@BB@B@@B@B@@@@BAB@@@@F@B@@@@@G@@@@BAB@BA@A@AB@@@@@@@@FB@@ED@F@B@@D@@F@BA@@B@AB@BB@B@@B@@BAB@BAB@B@FABAB@B@@@BA@@B@@@@@@A@A@A@@@AB@B@@@BADABA@@B@BA@@B@@B@@BABAB@@B@@@@@BBA@@BBD@@@B@B@BBB@@DBD@@CB@B@B@@@@BA@@@@@BBDAB@BABABCBAB@@@@BBB@@@@@@BABB@@@DBLB@B@@JBD@PB@B@@@B@BBAB@@@@@@BB@A@@@@BBA@DB@@CB@@D@@B@@B@@@FB@@EB@@FB@@AB@@BB@@EB@@@@FB@@ABA@@@AA@@@B@@AA@@@@@@AB@@@B@@@@AD@BC@@@A@@B@FB@A@@BA@ADB@A@@H@D@D@@A@@B@BAB@@@@ABA@@@A@@@AB@@A@@@AA@@A@@@@@@B@B@DB@@B@@@BAB@B@B@D@B@@@@@@@@@A@@BB@B@@@B@@@B@@AB@BBBDB@B@@C@AB@@@@@B@@BABD@@@@C@@@AB@BA@@@@D@BCBA@@B@B@@@BA@@@AB@@@@@B@@@BCB@@A@@@AAA@@B@@A@@B@@B@@DAB@@B@@D@@A@@A@@AB@BA@AAA@A@@@@B@BBB@B@@@@@@A@@AAA@@@AA@AB@B@B@@@B@@@@A@@@A@C@@BA@@B@@@@AB@@@AC@@@@BAB@DAB@@@@A@ABA@ABABABA@@@@@A@A@@@@B@D@DAB@@A@A@@B@B@@ABA@@B@B@BA@@DA@@@@@A@ADCBA@AA@@ABEJB@DF@B@BA@ADA@@@A@@@ABA@A@@@A@@BA@A@A@AA@@AB@BA@@B@@A@A@@@@@@AAAAAACA@@@A@E@@C@@ABC@AB@@BBI@CACCABEQABEC@@CBC@A@AA@@A@A@@@@B@B@@A@@@A@AB@AAAC@@@A@@A@@AAC@@BABAB@@@@D@@FBBA@E@ABABA"],encodeOffsets:[[111657,35535]]}},{type:"Feature",id:"610423",properties:{name:"泾阳县",cp:[108.842622,34.527114],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BA@@@@BAAA@@@@@A@@BA@@@A@@BA@@@A@@@A@@@@@@@@BA@@C@BAEA@A@@A@A@@@@BABBBC@@FE@A@G@@@@EC@@BE@B@@CG@@@A@C@A@@AA@@DC@@AC@@DE@@AA@@AC@ABKAI@@@@@AF@@C@@BA@GA@@@B@@@F@B@B@@A@@AB@A@@@@AA@@B@@@BA@@@@@@A@@A@@@AF@@@@C@@BAB@@@B@@@B@@@B@@@@E@@@ABAB@@AA@@@@@B@@A@A@@@@@@@C@@@@@AA@A@@A@C@@@C@@@A@@BAB@B@@C@@FC@C@@C@AA@AA@@@A@@@AA@@C@@@@AAC@@@@@@A@@@@EA@D@@E@@@@@C@A@@@@BCAA@@@AH@B@@AB@@@BB@@@BD@@BB@@@@BB@@@@GAAD@@@BC@AAA@C@ABACC@@@CAAA@@@@A@@@C@@B@D@@G@@BAB@@@@C@@@@@BBAJCA@CCAABA@C@@BEA@@@@@BA@A@BA@A@@AAC@E@@FIAA@A@AFEAAB@@AD@@@@ABEAAAA@@B@DA@@AAA@@@@@A@@C@EA@@@BADGAABFB@DDB@@@@@DAD@@A@A@AAA@@@AAE@A@@@AAA@@AA@A@@AC@C@AAA@A@@AAA@@A@A@@@C@@@AAA@@@CAB@@A@A@@A@@@@C@@AA@@AA@E@@A@AA@B@B@BA@AH@@@@@@AE@A@AA@@@@@@BA@@BMCA@@@AB@J@@A@@E@A@@A@@@AD@@A@@BA@@@@@@A@AA@@@@@@DIA@@C@@@ADE@@BA@@@GA@DC@BE@@A@@@AFA@@E@AA@C@@@E@@@C@@@@DA@@CC@@DA@AA@@@BABA@AB@@A@@FA@C@@FB@@BE@AB@@I@@BA@@A@@C@@@@BA@@B@B@B@B@B@@@BA@A@ADA@@BA@@FKAA@@B@B@B@@C@C@@A@@@AC@@F@@AB@@@B@@@BAB@@B@@B@DLB@@@BB@@@@B@B@@@@B@B@FB@@@B@BABB@B@@B@B@B@@DBB@@@@B@D@@D@@@@B@BAB@B@B@B@@A@@@@DABC@A@@B@B@D@D@BBBBBDFBBB@@@@ABAB@B@@@BB@@@BBB@B@B@@ABEBKHCBABAB@B@BBBBBDDBFBD@D@@@BCF@@@B@BABABABABCDC@CBA@E@EAEAA@CAGACAC@C@A@C@EB@BA@BB@@B@@BA@@@AB@B@B@@@B@@@@@BA@C@CBAAABAAABCBEHABADABAFEDCDCBABGF@@E@G@ABGBABAD@@ABCBA@@@G@ABA@@@AB@B@B@@DD@BBBBBBBBB@BBBAD@@@BABCBADABAB@BOFEBABCDA@CDADCD@D@B@BABCDAB@FBB@@@DABB@@BD@H@DA@@B@@B@@@B@@ABCBA@@@C@A@AB@@@BAB@B@B@BB@F@D@BBB@@@BD@B@B@@A@@BA@@@AAAAAA@@A@G@A@C@CBA@@@A@C@C@A@C@A@ABCB@BAB@@BBB@BBBBBB@BBFBD@@@@ABC@C@@@A@CCCAAAA@A@E@A@@@A@@A@EA@@@A@C@A@A@@@@A@A@@@@AAA@ABA@@@@B@B@F@B@@@BB@@@D@@@B@@BBB@@@B@B@@A@ABAB@BABA@A@CBCAA@@AA@@@A@A@@@AB@@@B@B@@@BA@AB@@C@A@C@@AA@@AAC@A@@BA@A@@@A@@AAA@A@ABA@ABA@AB@@AD@BAB@B@D@B@B@B@B@BBB@D@F@BADA@GFA@ADAFADBB@D@@DBD@DBBBB@D@@@B@@B@@BFBB@@F@@BD@BBB@DBB@BAB@FAB@BADEBA@A@ABAB@DAB@@@BBB@D@DB@@B@DB@@DA@@BBD@B@D@B@F@B@BA@@BBDBB@D@B@DAB@F@B@D@@@FAD@@@BA@AD@D@@@@@BB@@@@BB@@B@B@F@@@BAD@@@DBB@B@B@@@@AB@BA@@@AB@D@D@@AB@BA@AB@BC@@BA@@@@@@DBB@BBBB@B@BB@D@B@@@BBD@@@B@B@BA@@DCB@BA@@BA@@DABA@@DAB@BA@@BBD@B@JBB@DBBB@@B@BAB@B@B@BAB@@AB@BAD@D@H@DAH@DAH@D@FADAB@B@B@B@BBB@B@BB@@BAD@BAB@@B@BB@FAD@DB@@D@BBD@@@D@DBBBB@B@BBBBD@BDB@B@@BB@DB@@B@@@B@B@B@@BB@@@B@BAD@B@@A@@BA@@BA@AD@BAB@FBB@BAB@@@@ADA@AB@B@BB@@B@B@D@DB@@B@B@B@DBB@@@D@DB@B@@@@@B@@@B@@BBB@BBBBB@BBBB@@B@BBBBB@B@@BB@@BB@DBB@D@B@BBB@@@@@@AB@@ABADABCBABA@@B@BBB@@ABADAB@D@B@B@FC@@DBBADABAB@B@B@D@@@BAB@@@B@BABAB@BBB@@@DBDBB@BAB@B@BABAD@B@BABABA@@BAB@@AB@@BB@@@BB@ABE@@@CBCBKB@BAB@B@@@DEB@BA@@D@B@@B@D@D@F@D@BB@@@@@@ABA@@@@@BB@@@@AB@@@@@B@@@B@B@BC@AB@D@@@BA@AB@@@D@@@BBBB@@BA@@@@B@@@@@B@BBB@@@@@B@@A@A@@@@BA@AB@B@D@B@DB@BB@@@@B@BA@@B@B@B@D@B@@@@A@@@A@@DB@@@@@B@@@B@@B@BBB@B@@BA@@@@BABB@A@@@@B@@@@@BABABAB@@@B@DCBA@@B@B@@AB@B@BABAB@B@@@AA@A@A@@@A@A@@AA@@AA@@BC@@BABAB@BA@@A@@C@@AA@@@AA@@AEA@@@@@AACBA@@B@BA@@B@@A@@@A@@B@@@DB@@@AB@@A@A@A@@@A@@@@AC@@@@@C@@@A@@@A@@A@@@@@@@@A@@@@BAAA@@@@@@A@@A@@A@@@AA@@@@@AA@@@@A@@A@@@A@C@@@A@@@@B@@@BA@@@A@A@@@@@A@A@@@@AB@@AA@@@A@@B@BA@@@C@@B@@@@A@@@A@@AA@@@@@@BAB@@@@@@A@@ABA@@@@AA@@@@@@AB@@@@@@AB@AA@AA@@@@AB@@B@@B@@AB@ACAA@@@AB@@AB@BA@@@A@@@A@AA@@@@@@@A@@B@@@B@D@@AAAAA@A@@BA@@@A@A@@A@ACAC@@BCBA@@@@AA@@@A@@@@@A@ABA@@B@AAB@BABA@C@@CA@CAA@C@AAC@@ACA@@@B@F@BA@@@AC@@AA@@AB@@@@A@CA@@A@@@A@@G@@FC@@C@CB@@@@A@@@AB@BCJ@@CDA@@AC@CA@AAA@AA@@E@AEFA@CB@@@@@BA@@L@@@J@@AB@@A@CB@FA@A@AB@@ADABA@@BB@@@@BBB@@@@CD@@@@A@G@@E@@@AA@@@CAABA@@@AB@@@@@@A@A@C@@D@H@B@@B@D@@@BB@@BAF@@B@DBBA@@@A@A@@B@@BB@@@@ABA@A@@@@B@B@B@@B@BF@@B@BPB@@BI@@FDFDBAF@@AAABA@@@E@@@C@AA@BEB@@@@E@@D@FBD@@EB@@ABCB@B@@@@A@A@@D@@AB@@@BIBA@@FE@CB@@@BAAA@@@@@AA@@@@@BAB@@@@A@@DABABBF@@CD@@CB@B@@A@AA@@@@AAEBA@A@CAABEC@@A@AJB@A@AB@@AC@@AC@@G@A@A@EBKAA@@@A@C@A@@A@AB@@@AC@@A@@F@@CB@@AD@@@@@@@B@@A@@BA@@A@@C@@A@C@@CB@@C@@@A@@D@B@AABAB@@CAIA@A@@@@@BA@A@@@@AA@ABC@C@C@@@A@A@A@@@@A@@BCAAABCAA@@BA@@@A@A@@@AAA@AA@@AD@@A@A@@A@@A"],encodeOffsets:[[111588,35283]]}},{type:"Feature",id:"610424",properties:{name:"乾县",cp:[108.239473,34.527551],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@BCA@A@@@@A@AF@@@@GC@@A@@@@@@B@@EB@B@@AAE@@@A@AA@A@@@A@@@@AA@@@@A@@@@A@@A@@@AA@@@@@@@A@@A@@C@C@C@A@@@@@@DA@@@@BAB@@@B@@@B@@ABAA@B@@AB@@C@AF@@ABAB@BAB@DMBACS@A@@@@@A@@BA@@BB@@@A@@FA@AHC@@AEBA@@A@GG@BCK@AB@A@@C@@@@@@B@@E@@BCBA@@@@FA@@@EB@@B@BDA@@@@F@BB@B@@DD@@B@DF@@@@@@@BAFA@@@@B@@ABABC@@F@B@DA@@B@@@@@BA@@BA@@@@DB@@@B@@@B@BABABA@A@@BCB@@@B@@@@@@A@@AA@@B@@AAAAA@@@@@@B@B@@@@B@@@@B@B@@@@@@@@B@BBCBKBAB@@AB@@A@A@@@ABA@@@A@AAA@AB@B@@AA@A@AA@@CC@EAA@@@A@@C@@@BA@@AA@@CA@@CC@@@CBA@A@A@A@@B@@@BI@A@@@AAA@ABA@@A@@BA@A@@A@A@@@@@@AA@@@A@@@@A@@C@EB@@A@BBA@AB@@@B@@A@@@@B@@A@C@@@ABA@@B@@@BA@@@@@A@A@@A@@A@EB@B@@AB@@@BA@@@@@A@@@@@ABA@E@@B@@A@@@@AIDA@@BCB@@A@@@@@AAA@@AC@AA@@AAC@@@@@@@A@A@C@@@@@AA@AA@C@@@AABC@C@@@@@A@A@C@AAA@@@AA@@@A@BE@@@@@@@@@AA@@@A@@C@@A@@@AA@@E@@B@@E@AAC@C@ABA@@@@D@D@BABBD@B@@B@@D@@D@B@@@@DD@@@@B@B@B@@C@@B@B@@A@@@@@@@@@C@A@@@AB@B@@A@A@A@@@@@@@A@AAA@A@@AABAA@@@@@AC@A@@GA@@@@A@A@@A@@@@D@@@@@@@E@C@@B@@A@@F@@@@A@A@C@@@AA@EBC@A@A@@@@@BB@@@BA@G@@@A@A@A@E@K@A@ABABA@@@AB@B@@@D@D@@B@BB@@BB@D@@BB@@@@B@BB@B@D@BAB@B@@@BA@@@@@A@@@@@@B@@A@EC@@@@A@AAA@A@@B@B@BA@I@@@@AAA@@@@@A@@A@A@@A@@@@@@AB@@@@ABA@@@A@C@A@@@AH@B@BA@@@@BA@@@A@AAGA@AA@@AA@ACCA@A@@E@E@@@@B@B@B@@@B@@GA@@@B@D@B@B@@AD@B@B@@D@@@BBFBB@B@@@@BB@D@@BAB@@@BB@@@AB@@A@@@A@@B@@@@A@@@@B@@A@A@AD@@@A@@A@@B@@A@A@@B@@@@AB@BB@@B@BG@A@@BA@A@A@CB@@@@AB@BAB@@@B@B@@A@A@AB@D@B@@@@DB@@@@AD@H@B@B@H@@@@D@@@@@@D@@B@@@@A@@D@BB@@@@@B@@@@BB@@@@B@@B@D@@B@BBAF@DB@BBDB@@@B@B@@@BA@@@B@@B@@AB@@AB@@@@AA@@@BAAA@@@AA@@C@A@@AA@@@@A@A@@@AA@@@@CAA@@@A@A@@@A@@A@A@AB@@C@@BA@@B@@@B@B@BBB@BA@C@@@@@@B@@A@@@@@@A@AGA@@@@@E@A@A@@@@@@@A@A@@A@@@@A@A@@A@@@@ABA@A@@BA@@@@@@@A@@A@@@@AA@@BCA@B@BAB@@B@B@B@@@@B@@EAAD@@@@C@@@CD@B@@AA@@@BAB@BAB@@AA@@@@@BAB@B@@@BAA@@A@A@@A@@GCA@AB@BA@@@@@A@@@@BABA@@@@@C@AB@@@@@@A@@@@AA@@@@@@B@B@@A@@@AAE@A@@@@AB@B@@@@@CA@@E@E@@@@A@A@@@@@A@@@@DBB@@@BA@A@@AAEE@A@@@@@@BA@@@@A@@AAB@AA@B@A@@@A@@@AB@BAF@@@@@@E@A@@@A@@B@@@@A@BAA@@@@@AB@@A@A@C@@BA@@B@@@BA@E@@@A@C@@AA@A@E@@A@@@@@@@BAA@@A@A@@@@@@DAD@@C@@@AD@DA@A@@@@BB@AD@BB@FB@@@@@BFABB@@@B@B@BABAD@B@B@D@BAD@BAB@D@DB@@B@@@H@B@@B@@@@B@@AB@@B@@@BB@@AB@B@B@@@@B@@@@@@@D@@@FA@@@@@B@B@@@B@@@@@B@B@@B@@BBB@@AB@@B@B@@@B@FA@@@@B@@D@@AB@@@@@C@@A@@BAB@BC@@@AB@@A@@A@@A@@D@@AA@@@B@BBB@@@B@@@@A@@@CC@A@B@BBB@@BB@B@@B@@BB@B@B@D@@BB@@B@@@@AB@B@@@D@BABBBBDBB@B@@@@@B@@D@@@@B@@ABA@@@B@@BF@BB@@BB@@BB@BAB@BA@@B@DA@@BABCDA@CD@@@@@BB@@@B@B@B@@B@@@@@@DC@@B@@@B@B@BB@@BB@@@BB@@ABAB@@@B@@@B@BD@@B@B@BAD@@@B@@@@B@B@D@BDB@BB@AB@@A@A@A@A@@B@@@@@BDBB@@BBB@@BDB@@BB@@@DAB@@@@@@BAD@@@@@BA@BBABBB@@@@@@@@@@BB@@@@@@@@@@BB@B@@@@@B@ABB@@@@@B@B@B@@ABA@@@@@@BB@BA@@@@BBBF@BBHAB@@@@B@@B@@@B@@@B@@D@D@@@BBBBDDBB@@BAAAAC@A@@@@BABAB@B@@A@@@AAA@@@A@@@A@ABA@@B@@AAC@A@@@A@@AC@ACC@AB@B@@@DBDB@@BAB@@ABA@@BBB@@ABA@@@AB@@AB@BB@@@BAB@B@B@@@B@@@@@B@@@BD@@@B@DCBAD@BAD@DC@ABADEBAB@FADAJABABADABA@@B@D@B@HB@@BABC@@B@B@@B@@AB@@@B@@B@FBB@BB@@DAB@HC@B@@@BA@@@@BAD@@@B@@@B@BA@@B@@@BABB@BB@@@@@BA@@BAB@DABAB@BCF@D@@AB@BB@B@D@B@FAB@DAB@B@DBB@DB@@BDBBBBDDBF@@@B@B@B@@BDFF@B@B@@@@C@ABABA@ABA@@@@@AAA@@B@@ABC@ABABAB@@@@BB@@CDED@@@@@B@B@@ABC@@@ABABAB@@ABABA@A@A@A@@@@BBBA@ABABAB@@@@ABB@BB@@@B@BAB@B@@@@@B@@@@C@@B@@@@@@@@A@A@A@AB@DADAB@@@D@@ABCBCB@@@B@@AB@@AB@B@B@D@B@B@@@BA@@@CBC@A@A@@AAE@C@@AAAAC@C@CAGBE@G@C@C@@@A@AAA@AAA@CCA@C@AA@@@A@@@@@@AAA@@C@A@A@@@@AA@@@@@ACBABAB@@A@A@@C@@@A@@A@@AA@ABAA@@A@@@@A@@A@@@@@AB@@@@@BBD@@@@C@A@@@@@@B@@@B@@A@A@CAA@@@AB@BA@AB@@@@@DBBDBBB@@@@@@A@CAA@A@@@@B@@@B@@A@@BA@@A@@@C@@@@A@@@AB@@CBA@@@A@B@BBB@@B@@@BA@A@@@A@@B@@A@A@@@@@BB@@@@A@@B@@B@@B@@AB@@A@A@@@@A@@@@@AAB@@@B@@B@@@@@AB@@@@B@@@@BBB@@A@@@@BB@@B@@@@@@@B@@@@@@AB@B@B@@AB@@@BB@B@A@@B@B@@AB@@@@A@@@A@@B@B@BB@@B@D@@@@B@@A@@@@B@@B@@@@@BA@AD@@@@@@@B@@BB@@A@@@A@A@@BA@@@@@A@@B@@B@@BB@@@BD@@AB@@A@@@AB@@@BA@@B@@@D@@@@@B@@@@B@@@@@BA@@@@B@@@@BBB@@@@BBA@@@A@@B@B@@@@@@DB@@@@@B@@ABB@@@B@@B@@B@@BB@@BB@@@B@@B@@@D@B@@@@@BBB@BB@@B@@B@@@D@BAB@F@JBB@@@B@@A@AB@DBB@@BBB@@B@HA@@B@B@@@BABABA@ABA@ABA@@@A@@B@@@BAB@@@B@@A@@@AB@B@B@@@@A@@B@@@@@B@@@B@@@@@@@B@@@@AB@@@@AB@@@BAB@BA@@@@@@B@@AB@@@B@B@BD@@DB@BB@@B@@@D@@@DAB@B@@@@BBB@B@@@BA@@@BBB@B@B@@@@@@BC@A@@@@@B@B@D@@@@@@B@@A@@D@@@@@@A@@ACB@@CDC@C@C@@BA@@@@@AAA@AAA@A@@@@@A@A@@AAAA@A@@@AB@BAB@BA@@BADE@@B@BB@D@DBD@@BDBD@B@BB@@BBB@@@@B@FBD@B@B@B@BAB@BA@@DA@AB@@ABA@AA@AA@ABA@@@A@C@C@BAAA@AA@@AA@@@A@@BAB@DAB@DBBBB@BD@D@@B@B@@@BA@@BA@@DBB@@@@F@DBB@@B@@@B@@ABABE@@@@DDBD@DHBFBDB@@@@B@BA@@B@DBB@@BD@FAB@B@@@@AB@@@D@@@@@@A@@@@B@@@BBAB@@B@BAB@@ABB@@BB@B@@BA@@B@B@BAB@D@BBBB@BB@@B@@DBB@DBD@BBB@B@@@@AB@BAB@B@@@@@B@@@BA@@B@B@B@@@@@@@B@@@@@BB@AB@B@@@@@B@@@B@@@D@BAB@B@B@@@@@@B@@AB@@@@B@B@@@D@BB@@@B@@@@@B@@BBB@@@@@BA@@@@@@BB@@B@@@@ABA@@BA@BB@@BB@@@@@B@@B@@A@A@@BAB@B@@B@@@B@@@@@@BAD@@@BBB@@@@@@@B@BA@@B@B@@AB@@@B@@@@@@@B@B@@A@C@@@@@@@@B@@A@A@@@@@AB@B@@@BA@@B@@A@@B@@@BB@@@@@@@@@@@B@@@@B@BA@A@@@@B@DAD@@CB@@@@@B@B@@A@@B@@@BB@BD@B@D@BBBBBBB@@@@BADB@ABA@A@@B@@@@@@B@BBA@@@@@CBA@@@@BBBBBDBB@BAD@B@B@@FB@@@B@B@@B@@@B@B@@@@BAD@@B@D@@@@A@CB@@@FBB@DBB@B@@BAD@@@BLDB@@@B@@A@ABBB@BD@D@B@B@BB@@B@@B@B@ADB@@@B@BBB@B@DBB@B@@@B@DCB@@@B@B@B@B@@@B@@@B@@B@B@B@B@BABABABCB@B@@@@DA@BB@@B@BBB@@BBB@B@@AB@BCBAB@B@B@B@@A@@BA@CBCB@@A@@AA@C@A@ABA@@DABAB@BBB@@@BA@A@A@A@@B@@@B@@BB@@@B@@@BABABABADCF@B@@@@@BC@C@A@@BAAA@A@ABGBC@ABA@@@CBEBABC@A@A@A@@ACAA@@@A@A@C@@AA@@@A@@@@@A@AB@@@@@B@DHBB@@BBD@B@FD@@@ED@@AE@@AN@@@GA@@H@@AB@@ED@@AF@@CD@@AAA@AFBBC@@CCA@@B@@AA@@EA@@@A@@@BA@BEA@@@@CB@BE@@DB@AC@@@D@@@@@@@AA@@DB@AC@@@D@@A@A@AB@@A@@BA@AC@AB@@@BA@@ABA@ABAAA@@@@BCA@@@@A@@BA@@@C@@@@CCA@@@@@@@@DA@@@@GB@@@@@@A@A@@A@@@@@A@@@BA@@@@B@@ABCBA@@DA@@@@BA@@@@B@@@@AG@@@@@@@A@@@@A@A@@@BCABAB@BC@@@@BA@@@@@B@@@@@@@@@@B@@CAABCB@BCA@BEBCCA@@D@@AC@BG@@@@IA@@B@B@@@FB@AE@@A@@E@@ADBB@EA@@D@B@BA@@A@CA@@BE@@A@EA@@@@@AB@@ABB@C@@@@CA@@@@BA@@@@@@B@@A@@EAAA@@DE@@@@@@BE@@@@A@@ABE@@A@@@AA@@@AA@@@@BA@@@@@@@AB@@@@@ABC@@@ABCB@@@BE@@B@@@B@@@@A@@@@BC@@@@@B@B@@BA@@DB@@B@@@@@@AFB@@BA@@@@B@@B@@@@@@BA@A@AAA@@A@@@DG@@C@@@@@@AB@B@@@@@BC@@@@B@BCB@@@@@@@B@@@@@@@@@@@B@B@@@@AB@@@@CB@@@@CB@@D@@@@BC@@@A@@@@@@@A@@IA@@@A@A@@BA@A@@@CBA@A@ABA@AB@@C@A@A@@@ABC@A@A@C@A@AA@@A@@@A@@@AAAH@DB@@B@B@@@BA@@@A@A@AB@@ADAD@B@FADABAB@D@DAD@B@B@BBB@FBB@D@BBD@DB@@B@B@D@B@B@@@@A@@@A@@A@@G@A@@HB@A@A@EA@@EA@@@@GAC@C@@E@@C@@@@AA@@C@BEA@A@C@@@AA@AB@@@AABED@B@@@@C@@@@B@@@@B@BHA@@@A@C@@BC@A@AD@AAB@@A@@A@@@D@@@@CB@B@@@BAB@BA@A@@A@A@@@A@@@@ABAA@A@@@@E@@B@@@BC@@EAG@@@AC@E@@C@@@@A@@A@@C@@G@BEAA@@@CB@@@@E@@B@@AB@@@@A@@BA@C@AC@@@@@@@A@@A@@A@G@A@A@C@@@@A@@C@A@A@@@@A@@GB@@@@@A@@IACA@@@I@@A@@C@AB@@@@@@@F@@@@C@@F@@CB@@@F@@@@@@GH@@@B@@@F@B@B@B@@@B@@AB@@@@CB@@AB@@@@C@@A@@C@@C@@A@@@AG@@@@A@@@@G@@@@@@@@@C@@AA@@A@C@@@@AG@A@@A@@C@@@@BA@A@AB@B@@A@A@@I@@C@@A@@@@K@@F@@@@A@A@@@A@@@@@@BA@@@A@@A@@@@@BA@AB@@@@@@@A@@A@@@@F@BC@@@AA@@@@AB@@CB@A@@A@@D@@@@@@@@AC@A@@AB@D@@C@@B@@AA@@@@@GA@@@CE@@E@@G@@AA@@@@AA@AA@@@@A@E@@@@CA@C@@A@@AC@@B@@A@@@@@@DA@C@@@@B@@@@CB@@GF@@@@K"],encodeOffsets:[[110975,35172]]}},{type:"Feature",id:"610425",properties:{name:"礼泉县",cp:[108.425018,34.481764],childNum:1},geometry:{type:"Polygon",coordinates:["@@BIF@@G@@@SA@A@C@@A@A@A@@G@@E@A@A@@G@@AE@@@G@@AG@@@E@AAA@ADA@@L@@E@@HA@@D@@A@@@@@@DCB@@@@@@@BA@@@BD@@@BD@B@@D@@F@B@@@@@BBB@@B@@B@@BH@@@@FF@@D@@HB@@@@B@@BA@@@@DC@A@@BB@D@@B@@@@@@C@@@@BB@A@@DA@@B@@B@@B@@ADE@@@@@@BB@@@@@@@A@@BAB@@@@B@@@@B@@AB@@@@@@@B@@@B@B@@E@@@@L@@B@@@@DJ@@@@B@BA@A@@B@BAB@@@@@DB@@@@BBH@@@@@D@BB@@BD@@@@@@@@@H@@@@@@B@@H@@B@@@BD@@@@DB@@@@D@@A@@BA@@D@@A@@BA@@@A@A@A@E@@@A@@@G@@H@@@@E@@@A@@DE@@@@D@@E@@@@@@@A@@B@DB@@@@J@@DBJB@@@B@@@@HA@@@B@@B@B@D@@@@B@@D@B@B@H@B@@@@BB@@@@@@@D@@B@DAB@@@B@@A@@BA@@@@F@@A@@D@@BBAFH@@@@DB@@@@B@@D@@@@FBD@@H@FB@@AD@@A@@@@F@@B@B@AB@B@@B@@@B@B@@@@BABA@AB@@A@A@@D@@C@@@B@@@@BA@BBC@@B@BAD@@@D@B@@GB@A@A@@A@@@@@@D@@A@C@AFBB@@A@@BBB@@D@B@B@AFD@@@BB@@@@@DF@@@@DBD@H@@B@@FB@@F@B@BGA@@@B@HB@@@@B@@@B@@A@A@C@A@A@@@CAC@AAC@A@EAA@AAA@A@C@CBC@A@ABCBEBA@C@CB@BA@@B@B@B@@AB@@A@A@@@CAG@BB@B@@@B@@@BB@@B@B@D@B@BAD@B@@@B@B@DA@@BAB@B@BAB@D@@@BAB@@@B@B@@JB@@@B@@@@@@@B@@AD@@@@@CA@@D@@A@@D@@A@@B@@A@A@@@@@@@@@@@A@@@@@@@A@ADA@@@@@AD@@@@A@A@@B@@@@D@@@CH@@B@@@BB@B@BAB@@@@@@@AA@@@@@AB@@EA@B@@@@A@@@CA@@AB@@@A@A@@@@AD@@@@@B@@A@@@A@@@AF@@A@AD@B@@AD@B@@@@BA@@@@@@B@@A@@B@@B@@BB@@B@@@AF@BB@@@@@AF@@@@@@CF@@BBFB@@@BA@@@@@@@AB@@@@DB@@@@@DAA@BA@@B@@@@FBB@@@AF@@DBB@@@ABA@C@@@FBA@CA@BF@@@@BF@@BEA@@A@A@@@JB@@@@AHD@@BC@@@DBADAFB@ADA@ADBB@DA@@@@@@@@@@@@A@@@@AB@@@@ADA@ABDB@A@@@B@B@@B@@@@@@@H@@B@@A@@@@@AB@@@@CB@@ABAD@BA@@@@@AB@@B@@@@@B@@@@B@B@@@@A@@H@@B@@C@@@@@@B@DD@@@@@D@@AB@@@B@@B@AD@@@@BBAB@BAB@BB@@A@@BAD@@BAB@@@BA@@B@B@BC@@@D@@BCA@@BB@@@@@@C@@@D@@BCA@@AFA@@D@@B@AFB@@A@@@B@@FB@@BB@@@AB@DD@@ADEA@BBB@BC@@DE@@BC@@FA@@BG@@@HB@@M@@BF@@BC@@F@@ECA@C@AA@@AACGA@@@@@A@@B@B@@@@@B@@BB@@@D@B@B@@BBBD@@@B@B@BADABAF@D@@AB@BADAH@B@BBBAB@@@B@DADADAB@BA@@B@@B@@@BA@@BB@@@B@@@@@B@@AB@@@BB@@@@@B@@@BB@B@B@B@B@@@@@BC@@@A@A@@BA@@AA@AA@@CA@@@@AB@@@B@@@B@@A@@@@@@B@B@@@B@@@BBBAB@B@FAJ@B@@A@@BB@@B@B@DBDB@@BB@@@B@@A@@@A@@BA@@@B@B@BB@@@@@@D@D@@B@@@@BB@@BBB@@@BB@@B@@BB@BDB@BB@@BB@@@@@BB@@BBBBBBDBBB@BA@ABAB@BA@@@@A@AA@@D@@AA@BBB@@@FA@@EA@@DA@@@@@@BC@@@BD@DBB@BB@BB@@@B@@@B@@@BD@@DBBB@@BB@@@@A@@AA@CD@@@@@A@BND@@AB@@AB@@CB@@F@@BC@@@B@@B@@@@@@D@@BBB@@@@B@BF@@CB@B@@BBB@B@B@BB@@@BDBB@@DDBD@BB@@B@BB@@BB@@B@@B@BBB@DBBD@@@BB@B@F@BB@A@CBA@ABA@@BAB@B@B@F@DB@@DB@@F@B@B@@@BA@A@A@CAA@A@A@AB@@A@AB@BAB@B@B@BBFBF@B@@@BABA@@@A@ACEAABA@@@AB@B@B@B@BBBBBB@D@BAF@B@B@B@B@@BB@@B@D@BA@@BA@ABGBE@@@AAC@@@ABA@@B@BAB@B@HBBAB@@@BABA@A@A@A@A@A@@BABABA@@@A@@AA@@A@GA@@@@@A@A@@BAB@D@BBB@BBD@B@BBDAHAB@B@BAJCBAB@@@BBB@BBDBB@B@@@B@FC@@B@B@@@B@@BB@@B@B@@@BAH@B@B@@@@BBB@B@@@BA@@B@@@@ABA@AAE@@BA@ABA@AB@BABA@A@@AA@@AAA@E@C@AAAAAAEC@AAA@A@A@@@CB@@AB@B@B@@@B@DBDB@BBBDDB@BB@@B@BBBBB@BB@B@@@B@D@D@@@BBFBB@@DBBBB@@@DAB@BA@@BC@A@@@A@ACGCACE@@CAEEAAA@@AAA@A@A@ABC@AD@BAB@B@B@@@DBDB@BBDBBB@B@@@D@@@FABAB@B@@@BBB@BB@DBD@BB@@@BBBA@@B@@@@A@AAC@AA@@A@A@ABA@C@@@C@AAC@@BA@A@@B@B@B@B@DBB@@@B@@@BA@A@@CE@@@A@A@@BA@@F@FA@AB@BCB@@A@C@@@ABA@@BAB@BCBABC@A@EAE@@@AA@@@C@A@AACAC@CA@@@CAABCBEBCB@HEB@BC@A@E@CAA@A@A@A@A@A@C@ABA@ABC@@BAB@BAB@BAB@B@BB@@@B@@@BAB@@@BBD@BB@@BD@B@D@@@BAB@@A@@@A@A@@BA@@B@B@@@B@@BB@DBDAB@B@BA@ABABAB@@@@A@A@@AA@AA@@@C@@@A@@A@@@A@E@A@A@@B@BAB@BB@@@@@B@B@@B@B@D@B@@@B@@F@BB@@@B@F@B@B@BBDBDDB@@@D@D@BA@@@@ACAE@AAAAAAAA@AA@@BA@ADABAB@D@B@D@D@B@@@B@DAD@B@H@B@@@BBBBBB@@B@@AB@@@@A@AAC@@A@AAC@E@A@@A@A@ABA@A@@BAB@D@@@B@DABA@@@A@@@AA@@@CBG@C@@AA@BA@C@@AA@EBADCBA@A@A@CDCBCDCB@DCBAFAPE@ABABABCDABA@A@@BCAA@AAAAAAAAA@ACC@@@A@ABA@@B@BAH@@@B@DABA@@BCBAHABAH@F@@@HEBADADCFCBEBABCBAFGDABABBBABBDAD@B@@A@@@@@A@@@A@ABA@@B@@AA@@@AAB@@AFAD@B@D@D@DBHBDBB@FBFBF@B@DAD@DCBABABABA@A@A@@DE@A@@@CACAECCAAAA@A@ABABADALGFABA@@@A@AAA@A@@AA@@A@A@AB@B@@A@AACEAAAA@A@C@C@A@AB@D@BA@C@@B@@@@A@A@ABA@A@A@@C@@@@C@A@@A@CA@@@A@A@AA@A@BA@A@A@@EAA@A@@@@@@A@A@@A@@A@@KA@C@AA@@@BA@A@@@A@@BA@@@ED@@B@@@BD@D@@@@A@A@AB@LB@EB@@AB@BCB@B@@A@@@A@A@A@A@AB@@A@@@@C@@@E@BCGABEA@BEA@@AA@@A@@@BA@@CA@@G@@@BEA@FA@@BE@A@@B@@C@A@@B@@@@@@@BA@@@@BA@AA@EB@@C@@@CA@@C@@@EBC@C@A@@@@A@@AA@C@AF@@ABAA@BC@ABC@@@OC@A@AADGAA@@@A@AFA@@DD@@BHF@@D@AHC@ADA@AFC@AFB@@FA@AFCAAFC@@@@FA@@E@@@@@@ABEA@@EAMA@CC@A@@BA@@@@AEABCGABEA@@@CA@CAA@CG@@A@@B@@EB@@@BCB@B@@BBA@C@AJBBE@@BEDB@ECA@E@@BCGCBC@AG@IACABAE@@BCA@CE@BE@@D@@@B@@AB@@CDBBCHB@AB@BEB@BG@@B@@ADB@@BCMCECAB@@@BA@A@AA@CEABAAAEA@@AFAA@B@B@@@@@BC@AFA@@DCH@@AFEA@@AA@BC@A@C@A@C@@DA@@BA@@BA@@CA@ADA@@BA@@@A@@@CA@@@AA@@A@A@@A@@@ADC@@BA@AD@@ABE@@BAB@DG@ADC@C@C@@BC@@ACA@@IA@BA@@AI@ADKA@AGABCC@@A@@CBA@A@@A@@@CA@MA@@C@@DC@BEA@@E@@A@A@BC@@@A@@A@MABEB@BCB@@AD@@CBA@@BA@@B@@AF@@EDB@A@@AC@C@A@@A@@BA@@ABCA@@@@@A@@@@A@G@A@E@ACBAA@@@AA@G@@CC@@AG@@AE@@CA@@CA@@E@@@GK@@BE@@BC@@AC@@CAA@@AA@ACA@@@@@AC@@AG@@A@C@AC@@CC@@@E@A@E@@BE@@BA@@BG@BD@T@B@BG@@@IA@@A@@A@CB@@MC@AAM@@BE@@BG@@BC@@@@DA@@BM@@@G@@@E@@@B@AA@@@@@AAEA@@@C@AA@@@@@@@@AAC@"],encodeOffsets:[[111001,35200]]}},{type:"Feature",id:"610426",properties:{name:"永寿县",cp:[108.142311,34.691979],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BA@A@C@@@ABA@@@@@AA@@AC@A@A@A@@AA@@@@AAA@@AA@A@A@@ABAB@@A@@A@A@CCA@@@@@BA@@@C@@@@@AB@@A@@@@@A@C@G@@BC@A@@B@@A@AHADAB@B@D@@DB@B@@@@@B@BH@DBB@@D@@BA@@BB@@@D@@@B@@ABGC@DBBBB@@BD@@@B@@AD@@BB@BB@B@AB@B@@AHB@@@B@@@@B@@@B@@AB@B@B@@@B@@BBBBDDBBBB@@ABAD@@@B@BAB@D@@@BCD@@CJAF@@@@ABA@A@AD@@CD@@@B@@@B@@@B@@AB@BA@@@AB@BA@@DADA@@B@@AA@@CAA@CBA@AD@BABABA@@B@@@@BB@@@B@B@B@B@@B@@B@@@@@@@B@@BDBFBB@B@B@B@B@B@BDB@B@@@DBD@@BBBBB@BBD@B@@@BDBF@@@B@BAD@D@@BB@@@@B@@@@@B@AB@@@BA@BB@@@@@@D@B@D@FB@@@AB@@@@A@@@@BB@@BBB@B@DA@ABBB@@B@@@@@BABA@@BB@@@B@B@@@@@@@@B@BAB@@@@@@B@BA@@B@@BB@B@FC@@D@B@@@BAB@@@@@BB@B@BBB@@B@D@@BAB@@@@@@B@DAB@B@@B@@@@B@B@@A@@@AA@AAA@B@@@B@DA@@BA@@B@@@@@@D@@@BB@@AB@@@@A@@@A@@B@B@DBB@@@@@@@AACAAA@C@@@@BAB@@ABA@@B@DBB@B@@@@A@@@A@@@@B@D@@@@@AC@A@@@@BA@@@@B@@@@B@@B@@@BBBAB@@BB@@@@B@@@DB@B@@@BABADA@B@@@@BB@@@@@B@B@DB@BB@@@@@@@B@@BBD@B@DDB@BBB@BBB@@@D@D@H@F@HADBD@D@BBBB@@@DBF@BB@B@D@DA@@B@@A@@@A@A@C@A@ABA@@BA@@@A@@DADABA@@@C@@BABC@CBAB@B@B@@@@@@@@@@AD@@@@@@A@@@@@ABA@A@A@@AAA@BA@@@@BABABAB@AA@A@@B@B@B@B@BABA@@BABABA@@D@BA@@@A@A@@@@FCDC@@AA@@@@BABABAD@BA@@@AB@BB@@@@B@BAB@BABAD@@@@@@A@AEEAC@@@A@A@A@@AECCAAAAAC@@CAA@CAA@A@CBA@EBA@C@A@A@@ABA@@@CDE@ABABA@CBA@AB@@A@@@@AAA@BA@A@@@AB@@A@A@@@A@@BC@A@@B@@A@@@AGDA@CB@@AAA@EAA@@@@A@@BA@@@AA@A@@@ADAB@@GAA@C@A@@@ABCBABABIBCBEBA@ABCFAB@BCDC@ABC@ABCDA@@@C@@A@@@A@@@@@A@@@A@ABA@A@@AAA@@BA@@B@@AB@BA@AA@@AB@BA@AB@@CACA@@A@A@@BDD@BBD@@@B@@@BBD@BA@@@AB@B@B@@@B@@BB@B@@@BA@A@ABAB@@@@@BBDBBAB@@AACCAAAA@@C@C@@@@A@@@A@@@AA@@@@@BAAG@AAEAA@@@@ABA@@A@@@@B@BA@@@A@A@A@@@@AA@B@A@@@@@AAA@@@@@@@@@@AA@@@@@@@@@@AABAAAB@@A@@@@BC@A@@@@A@CB@@A@@AA@AC@@AA@AA@CA@A@@@@@AB@B@B@B@@@BAA@@ACA@A@C@A@A@@A@@@C@ABA@A@@@ACA@@@A@@@A@AB@BA@@A@@AA@@AAA@A@@@A@@@CD@@@@@@@AA@A@A@@@A@@A@@@@DCB@DCBA@AB@@C@AB@@ABA@AAA@@AA@@AAE@@AA@@@B@BA@@@A@@C@@@@A@@@@@AAAACAA"],["@@@A@@@@@A@@AAC@@@A@A@@@@@BA@@@A@@@@A@A@A@ABC@@@A@@@A@@@@@A@A@@BAA@@@@A@@@@@@@A@A@A@@@AB@@A@@@@@A@A@ABA@@B@@A@A@AAC@CAA@CA@@@AA@@AAAAAC@A@ABA@A@@@AB@@@AAA@@AA@BA@ABA@@@BAAA@@A@@@@@@B@@@@C@@@A@@B@@A@A@EBC@@AA@CAA@@@ABA@@@@@CAEAGA@CACCC@@@@AFAB@BA@@@A@@@AA@C@E@@A@CA@@AB@@AB@@A@A@@@@CACA@AACAA@CBA@AB@@@B@@BB@@BBB@BB@A@D@D@B@@AB@BBBB@@BAB@BA@@BCB@@ABA@ABA@A@A@C@EAA@@@@@AA@AA@@A@AACAC@@AC@C@CAAA@@@CFAB@@ABA@ABA@@B@@@BBBBB@@@B@B@@@@@BBB@BBB@B@@@@AB@@@D@DCD@DA@BD@@@B@@@@C@@@@BA@@@@@@@@C@A@A@@@@@BAD@@@@@@@A@AAA@A@@AB@@A@A@AA@@@@@ABA@C@@@C@@@AA@@ACA@@ACA@A@@@A@@BA@@@@@@@ABA@AB@@A@@B@@A@@B@@A@@@@@@@A@@@A@@@@@A@@@@B@@A@A@A@@B@@@BA@@@A@AB@@A@@@@B@@AB@BAB@BABABAB@@A@A@@@GBA@@@AA@AA@CAA@@B@BA@@@A@IAE@A@ABC@@@A@@@@AA@@AAA@A@@@@@A@C@@@AA@@@A@@AA@@AA@@@@AA@@@A@A@@D@@A@ADA@@@@B@@AB@@A@@B@B@BA@@@A@CC@@A@@@@BB@BB@B@@BBB@@@B@@@@@AB@B@@@DBDAB@@@B@B@B@BCBAB@@@BBBADB@@DAB@BBB@@@@AB@@@B@@@BA@@B@@@B@@@B@BAB@@BD@@BB@@@@AB@@@B@@B@@B@BAB@@@@@BB@@@AD@B@@A@@B@@@@A@@@@B@@AB@@A@@B@@@BA@@B@BA@@@A@@@@B@@@@A@@@AB@@@B@@@B@@@B@B@@@B@BBB@B@@BBA@@BA@@BBBAB@B@@A@@B@@@BAB@@@@@BAB@B@B@B@B@B@B@@@@@@@B@@@@@BA@@@@@BB@@AB@B@@@@@@@@@BAB@@@B@B@@@B@@@B@@@BA@@@@@@B@@@B@BBB@@AB@@A@@@@@@B@@A@A@@BA@@@@B@B@@A@@B@@@BA@@BCD@@@B@@@B@@@BB@@@@B@@A@@B@@A@@BA@@@AD@B@BA@AB@BA@AB@@A@C@CAA@AAA@@@C@@@AA@@A@AB@@EBE@CAAB@@AB@BCBA@A@@BA@@B@@ABC@ABA@ADCH@B@B@D@D@BA@@BCD@@@@BB@B@@B@@B@F@@ABAB@B@@@B@D@@@BBBABBB@@@DBF@B@F@D@@@@@BA@@@@BAD@B@B@B@B@D@@@BB@@B@@@B@@@BDB@BB@@DBB@B@B@@DBLDB@B@BBDBBDDDJFDBN@DBFDFBBBBBBBFDBBHDB@DD@@B@@@@BBBBBBBDF@D@DB@@B@BB@BAHCBADAFABAB@AA@CBA@A@@@@@@B@@BDDBBBBBD@@@BDDDDBDBFDFBF@BB@BA@A@A@@@@F@B@B@@@BBB@@@@@@A@ADBB@D@@@B@@BAB@BB@B@DBF@DBDAB@BAD@DABAHC@ABCB@B@D@B@D@B@FA@@B@@@@B@@@ABB@@@@@B@@B@@B@@BB@@B@@B@@DB@@B@@B@@@@@BB@@@BDB@B@@@@BB@B@B@BBDBBBBBBBD@@@B@@@B@@@@@BB@@B@@@@BB@D@@@BA@@B@@@@@B@@@BB@@@@B@@@B@@@B@B@B@@B@@B@B@BB@@B@@@@@@@@@@BB@@@@@@@BB@@BBB@BBBBBB@@BBD@BBB@B@@@@@BBB@B@@@@B@AB@B@@@@@A@@BB@BB@@B@B@@BBB@@D@@@@@@@@B@@@BB@@@BA@@BBBB@@DBBB@@@B@B@@DBBB@BB@@BB@@@@B@@@@DD@@@B@BDD@@B@B@@B@@B@@@@B@@@@BBB@BBB@@@F@B@@@B@A@AA@A@@@A@@B@@@HAD@@@B@BA@E@AB@BA@@B@B@BBFBBBB@B@BA@A@A@GBA@ABADA@@BA@@A@@AC@CA@A@@@ABABAB@HBD@B@B@BA@ABC@A@AAAAAAC@@BAB@@@D@@@BBFBBBBBDDBB@@@DABCD@@AB@BABA@@@@B@B@@@BB@@@DBD@B@BABA@@BA@C@C@@BA@@BA@A@A@A@@CCCA@@AA@CAC@A@A@A@@AE@@@A@@BA@AB@FAF@B@D@B@BAB@B@H@B@BA@@BA@@@@@A@@AAA@AAA@C@A@A@@@EC@@AA@C@A@@@ABAB@BA@@DBFDDD@@BBBBB@@B@@BBB@DBB@DABA@ABA@C@G@C@@BA@@B@@@B@@@BB@@BD@B@F@B@BA@ABABA@@B@@@BBBBBB@B@B@BADCBC@AAA@G@E@G@CBA@ABAB@B@B@D@@@DDBDBBB@B@B@DCD@B@B@@@FBDBBBB@BABA@A@CAAECAAE@C@EBA@MCAA@A@ABAD@FABABCBAB@@@B@@@@H@BBBB@B@DADEDEDC@C@AAC@A@A@@B@BAB@D@DBFDB@@@BA@@AC@ADAB@D@D@LAD@BB@@BB@DADBBD@B@B@B@@CB@AC@AIIAE@A@ABABAF@B@DBDDBBD@D@DABA@C@CAG@C@ABA@@BCDA@@B@B@B@@BBB@B@FBBB@@@DABC@A@AAGAA@CBABAD@D@DBD@DBD@BA@@BEAAE@A@A@@A@@ACCAA@AAA@@@@AA@@AA@@A@AA@@AACCC@@AAAC@@A@@A@A@AAA@AA@A@@DE@@A@A@@A@AA@@@C@@@@A@@@@A@@AD@@@EA@@DA@@BA@@BC@AMB@@@@@C@@DBB@@@B@@A@@AA@AA@CC@@A@@@A@@@A@@AAA@@AAA@CAC@@D@@A@@@@B@@CB@@FB@@E@@AA@ABB@@@CB@@B@B@@B@@ABABAB@@AAACAAAAAAA@@AA@@@@A@@AA@@ACA@AAA@@@AA@@A@@AA@AA@@A@@A@@@@C@C@@@@A@@A@A@A@@AB@@@B@@@BA@@@A@@AA@AC@C@A@AA@@AB@@@@ABI@E@ABAAA@A@@@A@@@A@A@@@@B@@@@A@@@A@@BA@@@@DB@@BBB@@BB@@AB@B@@@D@@A@@@@@A@A@A@AAA@@A@@@@@A@@A@@BA@@@A@@@@@A@@AA@@AB@@A@@@@AB@@ABABC@@@@A@E@CDABABABAB@@A@@@A@@AA@@@A@@@@B@B@BAB@@A@AAA@ABCB@@AB@B@B@DBB@@@BA@AD@DAB@@@BA@A@A@A@ABADA@@BA@A@AA@@AA@A@AA@@ACB@@@@@ADABABABA@A@A@A@A@AA@@@A@@@A@A@A@A@@@A@CDA@@@A@A@CAA@A@AAA@@@A@BCA@A@@@@AA@@A@A@A@CACA@AA@B@BA@@@A@KC@A@@BC@AA@A@CAA@EA@@A@@D@B@@C@A@@@BC@A@@A@A@@@A@@@@A@A@@EA@@@A@ABC@AAAACAAAA@@@@AB@D@@@@AB@A@A@@@@A@@@@BAB@BCAAB@@@@AAAAAA@A@C@AACA@@A@@@AB@@@@A@A@@@@DA@@BC@C@A@@B@B@@A@A@@A@@@@@@@@@@@A@@A@@@AB@@@@AB@@A@@@ABA@@@@B@B@@@@A@@@@@@D@B@@@@A@A@@@@@@@A@@BA@@@A@AB@@A@A@@@@@@AA@A@@BC@A@@@@A@@@A@@@@ABA@AB@B@@@@AA@@@@@A@@AA@@AAB@@AB@B@@A@@@AA@@@@@@AB@@@@A@AA@@"]],encodeOffsets:[[[110618,35328]],[[110818,35504]]]}},{type:"Feature",id:"610428",properties:{name:"长武县",cp:[107.798757,35.205886],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@AE@@@@@AB@@AB@@A@@AAA@A@@@@AA@@@@A@@BABA@@@@AA@@A@A@@@@@A@@A@@@AD@B@BAB@@A@A@@AAAA@@A@@BA@@@@@AA@@AB@@A@@A@@@@@AAACC@C@@@@HDB@@@B@AAA@@@@A@@B@AA@@@@A@A@@AAA@@A@A@@@A@@@BB@@@@@@C@@@AB@BA@@@@B@@@DKDEAC@CAE@CDEB@DBDAFABADAD@DDBFDDDBB@B@BABG@A@C@C@ABICICEBA@EDG@E@CBCDCDEBEDCBAB@@@@ABA@@BADA@@B@B@@A@@@@B@@A@AB@BA@@BA@@B@@AB@@ABA@@BA@@@@@@B@@@@@@A@B@@BA@@@@BA@@@@B@@@BA@ABA@@@AB@@@@@@A@@@A@A@@BAAAB@@@@@@@BA@@@@B@@@B@@@@AB@@@BA@@@@@@@A@@@@B@@@@@B@@@@@@A@@B@@@@A@@@@@@@@@@@A@@@@BCD@@@@A@@@A@@@A@@BA@@@@@@@A@@@@B@@@@@@CB@BA@@B@B@@AB@@@B@BA@@BB@@BBBBBBBBB@B@B@BGHCFAFABEBA@C@C@@BEHEJEJEJELELCFAB@@@FAH@JABABAB@DADADCBCD@@ADAF@@@B@@@BB@@@BB@@@B@@B@@@B@@@@@@@@@@B@@@@B@@@@@@B@@@@@@@B@@CBCB@BABEJCHAFCFCHADADCFCDCBCLA@@BCFCD@BADADABABABCBCAC@CACACA@@CAA@AB@@@@A@@@@@@B@@@B@BA@@@@@@@@@A@@@@@@B@@@@@@@@@B@@@@@@@@AB@@@@@@@B@@@@@D@@A@@@@@AB@@@@@@AB@@AB@@@@@@@B@@@@@@@@@@@@@@A@@B@@@@@DABBBBDBB@B@B@BABABA@C@EAA@C@@D@DBB@BFBBDBBDFBBDDDBFBNGHAJ@FAD@D@@@D@FBDFB@DBB@BADADEBGBCDADCFAFDBB@@@@@@@BBBAB@DCDEDADADDDBDB@DF@@BF@@DDFDBBF@FABAFED@D@@RBX@FAFAFCB@BEDCBGAA@A@E@CAAC@C@AEACAC@CB@@@DDDDDDBBDDBNBJDLDHFDBF@B@BA@ABA@@BA@A@AB@@@BAB@B@B@BBD@B@B@BABABABABAD@BB@@BAB@BB@B@@B@HAB@@@BADCBCBA@@B@B@DBB@B@@@@A@AB@@AB@BBBBB@BAB@BCBEBCBCBAB@D@B@B@BBBBF@B@BADAB@BCDC@ABABABADAB@B@DB@@D@B@@@B@@@BA@C@ABAD@B@D@@BB@D@B@@BB@@@BB@@DBBAB@B@BCBA@A@@@AAA@A@@BA@@B@D@D@D@D@BADBBAB@@A@@@C@A@A@@BA@@DCBABA@AB@D@FBBBBBDBD@B@D@D@D@D@F@F@DBBA@@F@B@BBB@DBDADA@@DA@ABA@C@@BADAF@D@B@@BD@BDBBBBB@B@BABE@ABCBAB@B@@@B@DBDB@D@B@DAHAB@DB@BBBDB@B@H@F@BBB@D@F@@AD@B@B@@A@@B@@@@@BCB@BA@A@AAA@CAC@A@@AAAAAACACCACAC@@ACAACAAAAA@CBCBAFCDABADADAB@D@BAD@DB@@B@DABA@@@A@@@@@ABC@@@AA@BANCBA@C@C@CAAGEAA@@A@A@E@AAA@IAA@C@A@EBCBA@A@ABA@E@C@C@A@@@AA@AAA@A@C@A@@BADEB@@ADA@@B@D@B@DEB@@ABA@AAC@CAEEE@CAA@@A@@@GCECAA@AEC@@@A@AB@BCDADAB@B@BAFAD@DC@ABA@@AE@ACEAG@@AA@ECC@A@EA@@@@AB@CA@AB@@@@@@@A@AC@@B@@@@CBA@@@ACCA@AAA@A@A@@@@IAGB@BBB@@@@CB@FCBAFABA@A@C@CB@BCRB@@@@@A@A@@DA@@A@BAB@BABA@C@@@CAACA@AAAAA@A@AB@BABAB@BAB@BBFB@@D@B@B@@@BA@ABC@AAC@@@A@@@@IAAAABB@@@AB@@@@A@@@ABA@@B@@@@@@@@AAAA@@ABA@@B@@@@@A@@@A@@ABABA@@@A@@A@C@A@CB@@A@A@@AAA@A@@@@@A@@@CA@AA@A@@@@AA@AA@@@@@AA@@@@@@@@A@@A@@@@@@AA@@@@@@A@@@@A@@@A@BAA@@@@@A@@@B@AA@@@A@@@@@@@AAA@AB@@@AA@@@@BAA@AA@@BAA@@@@@BA@@@@A@@@@@@A@@@@A@@AA@@@@@@@@@@@B@@@@AA@@A@@@AA@@B@@@A@@A@@@BA@@@@@@@AA@@@@C@@@@@@@@@AA@@@B@@@@A@@@@@@@A@@@A@@@@@@A@@@BAA@@@@A@@@@BA@@@A@@@A@@A@@C@@@@B@AA@@@@A@@AAA@A@C@@ACCAAAA@@@@@DH@@@@ABAA@@A@A@@@@@@@AE@@@AAA@@AABAA@@B@@@B@@AB@@BD@B@@BD@@@@AAA@C@AAA@@@AA@A@@A@@A@@@CAAA@AAA@A@EA@@@B@@@BDB@BBB@BA@@BC@A@@@CBA@@@@D@@@DBD@@AB@B@@@BK@I@@@@ABA@ABA@E@A@A@C@A@@@@@@@@AD@B@@A@@@@AA@@@A@AA@@@AB@@@@@@@E@E@@A@@@@@ABA@@@@@AA@BA@@@@B@@@@A@@@@@A@@@@BA@A@@@@@A@A@@AB@@CAAB@@ABAD@B@B@@AB@@@@"],encodeOffsets:[[110468,35862]]}},{type:"Feature",id:"610427",properties:{name:"彬县",cp:[108.077658,35.043911],childNum:1},geometry:{type:"Polygon",coordinates:["@@M@CAIECCACCAAAA@A@KCCA@@@A@AAA@CA@@ACA@A@@@A@@@AA@@@A@@@AB@AC@AA@B@@@@@@@B@@HDBB@@@BA@@BA@CA@@A@A@@BABA@A@@A@BA@CCA@AAAA@AAEACCEAA@AC@E@A@@@A@CAC@A@CAA@EAA@CA@@@@C@@@A@@AA@@@C@@AA@A@@AC@C@CBC@A@@AA@@BC@@@CB@@A@EA@@ABA@AB@B@@CD@B@B@B@B@@@B@@A@A@@BA@@@AA@@AB@@A@A@@B@B@B@@BB@@A@@@AA@@EA@@@@@A@@B@@A@@B@AA@A@@@AD@B@@@@A@CAA@A@A@@CAABA@A@@B@@@BA@E@EAA@A@CD@BAD@BBBBH@BBF@B@B@B@B@@A@CB@@A@@B@@BB@@BB@@A@@BCF@BABCD@@@B@@@B@BAB@DAA@@@AA@@AA@C@C@@@A@AB@@@@@BH@BB@@@@@@A@@BA@@@B@BBBB@@@BAB@B@@ABA@@@@@@B@BA@@BA@@B@@@@B@BB@@BA@@@@@ABA@@BB@@B@@@BBB@B@@B@B@B@B@B@BABCBED@BA@@D@B@B@@BB@@@B@@@B@@@@AAA@@@AA@@@B@@@@@B@B@@A@A@AB@@@@@B@B@@@BA@@BA@@@A@A@@@@@@B@@@B@@@B@BAD@@A@AB@@AD@@@B@@@BABCD@B@B@BA@AB@@@@@BBBBBA@@@AB@@@@BB@@B@BBBBB@@@@D@@@@@D@B@B@D@@ADABAB@@AB@@ABABAFA@@@@BA@@@@BA@@B@@@@@@@B@@A@@@@@@D@@A@@@ABB@@BBBA@@@@B@B@B@@ABB@BB@@@B@@A@@@BBB@@@@@AB@@@BD@BBDDFDD@@@JDFABCB@B@B@BB@BCD@F@@B@B@B@FGFBHDHDDBHP@@BB@@@B@@B@@ABA@@D@@@@@@@AA@@B@@@B@B@@@BB@BB@B@@@@@BBA@@@@B@@B@BBA@@@A@GC@@@@@DDDBB@B@@@@@BB@@@BA@@BB@@@@B@@AB@@@BBBB@@@B@BA@ABA@C@@B@@@BB@@@@@B@B@@@BB@@@@ABAB@@@B@@B@@B@@B@B@BB@@@BA@@BA@@B@@@@BF@@@@@@BA@@@A@ABCBA@@BADB@@BA@@@B@B@@@@@BAB@@@@@B@@@@@B@@A@@@@@ABB@@B@@@@AB@B@@@@@BF@F@@@@@@@A@@B@@BBB@@@B@@B@@B@@@@ABC@@@@@@@@@B@D@B@B@FAB@BAB@B@@J@L@@A@@@ABA@@AC@C@@@C@@B@DA@@B@D@@AB@@AAA@ACA@A@@@A@@FBB@B@BBB@BB@D@@@BB@@@@BBB@@B@BBD@B@BB@@@@AC@@@AAC@@BA@@@A@@@AB@ABBB@@BB@B@@BF@@@@@@B@B@@@BBBA@@@@CG@@@@B@BBDBBD@@@D@BBB@BB@@@@@BBA@@@@@@DB@@@@B@@@B@@AB@@@@@B@@B@AB@@B@@@@@@@@B@@@B@@@@@@@B@@A@@@B@@B@@@@@@@@@D@@B@@B@@@@@@AB@@B@@@@B@@@AB@@B@@@BB@@B@@A@@@@@@@@@@@B@@BB@@@@@@B@@@@B@@@@@AB@@@@B@AB@@BBB@AB@@@@BB@@A@@BBB@B@@@@@@@B@@BBA@@@B@@@@@B@ABB@@@B@@@@@@B@@@@B@@B@@@@B@@@@B@@@@@@B@@B@@@@BBB@@B@@B@B@@BDB@@B@@@@@B@B@BB@@@B@BA@@D@B@D@BB@@@B@BABA@@@B@@@B@@@@@AB@BA@@BBBB@@@@@@@@@AB@BA@@B@@@@@BA@@A@BABBJB@@@@@B@@BD@BAD@BAB@@A@A@C@@@EAAAA@ABA@ABABA@@B@BBBBB@BDBBB@D@@@DABABA@ABB@@@CB@@@B@B@@@@QAADA@@D@D@BABEBABEDA@@D@@A@AAA@BH@J@@B@B@B@BBB@DD@B@@AB@D@@A@@@BDB@@@@@@@A@@BDBA@@B@@B@@F@BDD@FBB@@BHDF@BBF@@AB@BCDC@EBABA@A@CBCBADA@@B@B@@FD@BBBFDHD@@B@@@BB@DFFBF@DBD@BAB@BA@CFA@C@A@@@CB@BA@CFAB@@@B@D@BBB@BBB@@B@D@D@F@B@BAB@B@DAFAB@D@B@JBB@BBF@B@B@@@BBHFBB@D@D@DABMDABB@@B@@AD@B@@@@@B@@ABCBA@@@CAC@ABC@A@CBCBABCBEDABAD@DBBBBDBBBBDNCJALBBC@B@@@@@@B@@A@@@@@@B@@@@@B@@@@@BB@@BB@@B@@@@@B@@@B@@@B@@BB@@@@@@@@@@BB@@@@@@@@@B@BA@@B@@@B@BAB@@@B@@@@@BBB@@@B@@@B@@@@BB@@@@@@@B@@@BA@@@@@@B@@@@B@@@B@@B@@@@@B@BA@@@@@@@@BB@@B@@@@@B@@@B@B@@@B@@@@BB@@@@@@BB@@@@@@@@A@@B@@@@@@@B@@@BBB@@@@@B@B@@@B@B@@@@@B@@B@@B@@@@@B@BA@@@@B@@@@@B@@B@@B@@@B@D@@@BBB@@@B@@@@@AB@@@@B@@@@@@@@@B@B@D@B@@@D@@@@@BBB@@@@@B@@@B@@AB@@BB@B@DBB@DBB@BAB@@@B@@@B@@@B@@B@@@@@@@BB@@@@@B@@B@@B@@BB@@BB@@AB@B@@@@@@@BB@@B@@@B@@@@@B@@@@B@@@@@BB@@@@@@@@BB@@@B@@B@@B@@@@@B@B@B@B@@@B@@@@BB@@@@@@@@B@@@@@@@@@@B@B@@@@@BBB@BBB@B@@BB@@B@@BB@@@@@@@B@B@@@@@@B@@@BBB@DDDBHBFBBFD@B@D@D@F@B@@@BBB@B@BBB@D@D@B@B@D@B@B@BAB@B@DB@@BBBB@BBB@@@@@B@@DBB@BC@ABC@A@ABA@@BCDA@ADE@@@A@ABABCBA@ABA@CBA@ABAB@D@B@@@BA@@FBHD@@@@B@DGBA@@@A@@GACA@A@@BA@AEABCBA@@A@@A@@@AAAAA@AB@BAB@BA@@@A@@@ABABC@A@AAA@@AA@A@@@@DAB@BA@A@@BBB@@CBC@A@@B@@A@@BC@A@BB@@BD@B@@@@A@@@ABABAA@@A@A@AB@@AB@@@@@@B@B@@B@@@BA@ABAB@B@@@DB@@@@@@DCB@@AB@@@B@@@BABA@AB@@@BAB@@@@A@@BA@A@AB@BBB@FBB@B@B@@@A@AA@@@@@A@@@@@@@@B@AAB@@@FBD@B@BB@@BB@BBB@@ADA@@@@B@@@@B@@ABA@AB@B@@@B@@B@@BB@A@@@@@AB@DA@@@@AA@A@C@@@A@A@@@@B@@BB@@@DC@@@@AAA@@ADC@@B@@@@AB@@@@@BDB@@@B@BAB@B@B@@@BA@@BA@AB@BA@@BA@A@@BA@C@@@@@A@A@@@C@@@@B@@A@@A@@@B@@@AA@@@@@A@@@@AA@@@@@@@@@A@@A@@@A@@B@AA@@A@@@@@AA@CCA@@AA@@@@AB@@@A@@AA@@@@@@AB@@@A@A@@@@AA@@AB@@@CC@AB@@@AA@@@AC@@CA@@@@@@@BA@@A@@@@A@@A@@@@A@A@@A@@@@@@A@@@@@A@@@@@A@@A@@@@@AA@A@ACA@AAB@@@A@A@A@@AC@A@@BA@@@A@@@@A@@A@A@@@C@A@@A@@A@@@AA@@A@@B@A@@A@@@@B@@A@@@@A@AA@AB@@@@@@@BAAAA@@@@@@A@A@@B@@@BA@@@AA@@@@@AA@@@@@@DA@@@@@@@@A@@AA@@@@A@@@@AA@@@A@@@@@@@@@@@A@@@A@@A@@AB@@@AA@A@@@@@A@AB@@@AA@@@@A@@A@@@@B@@@BA@@AAC@@@@@B@@@AA@@@@AB@@@AA@@@@A@A@@B@@@@@@AAABA@@@@@AA@@@@@@B@@@@A@@@AA@@@A@@BCA@@@AB@@@A@@@@@A@@A@@@A@@@@A@@BAB@@@@@CAAAAA@E@@@@B@DA@@B@AAA@@@AAA@B@@A@@@@@AB@AA@@@@A@BA@@@@@A@@@@@A@A@@@A@@@@A@@A@@BA@@A@@@@A@@@AA@@@@A@@@A@@A@@A@@@@@A@A@@A@@ABA@A@@@@@A@@AC@@@@@@A@@@@@@A@@CC@@@A@@AA@@@@@AB@@@@AA@@@AAA@A@@A@@B@@AB@@A@@CA@A@@@@B@B@B@@@@@D@BAB@@@D@DAB@B@@A@@B@B@@@B@@@@A@@B@BAB@@@B@BA@AA@@ABA@A@@AAAA@@AAA@@@AAACA@@@@AA@@C@@@A@@@A@@@A@@@@B@@@@B@@@@B@@A@@BBBABB@@BAB@@@B@B@@@@@B@@@B@@A@@@@@AB@BA@@@AA@AA@A@@@AB@@@B@DAD@D@B@@@@@@A@A@@@@@A@@BA@AHBB@@B@@@A@A@C@@@A@@@@BAAA@A@A@@B@D@DB@@@@@@HBBBB@@@@ABA@A@A@@B@DCB@@@@BBA@@BA@@B@DA@@AC@@@AB@DA@@AA@@@A@@BA@@B@@B@@B@@@BA@@@AB@AA@@@@C@@@@@AA@@@AA@@@@@@@@@BABADCDCDADCJEB@B@BA@@@@FCB@D@B@BBB@BB@@B@B@BBDAB@D@F@B@@A@@B@DBB@@@@A@A@@@@@A@@BA@@ACBA@@@@B@@B@@BA@@@A@@BAB@@B@@@@BAB@BA@@AAA@AA@AB@B@@@B@@@B@B@@@@@B@B@@@B@@@B@B@@@B@AAB@@@@@@@@@B@@@B@@@@A@@@@@BB@@@B@B@@@B@@@@@B@B@B@@BD@D@D@F@@@BA@ABABAB@B@@@B@@B@@@B@@B@@AB@@@BB@@BAB@@AD@B@B@B@@@B@DAB@@AB@BA@@@@AC@@@@@AB@@BB@@BBAB@BAAA@@HEFCB@BABA@A@ABA@A@@@@BD@@@@@A@A@@@@B@@B@B@@B@@B@BB@BA@@BB@@@A@ABA@@BA@@A@AA@@@@@A@@@A@@A@@A@@@AA@@A@AA@@@@A@@@A@@@AAA@@AA@AA@@A@CA@@@@A@@AA@@AA@@@AAAAA@@AAAA@AA@@A@@@AAA@A@A@C@@AA@A@@AA@A@A@@CA@@AE@@@AA@@A@@@@@@AAAAAAA@AAAAA@AA@A@A@A@@BA@@CC@A@A@@BABC@@@@C@@A@@@A@@@@@@@@A@@AA@@A@@A@@AAB@@AA@@@A@@@A@@@@AA@@A@A@@@@@@@@@BA@A@@@AA@@A@@B@@@@@AA@AB@A@A@@@@@BA@@@@@C@@@@@AA@AAA@C@AABC@CAA@@AAC@KBC@C@A@CB@BBD@@AB@@A@ECCAC@A@ABA@@@@B@BBD@B@DCDCFCFCBA@A@AA@A@G@@A@@@A@ABADABEBC@AB@B@BBBNDB@FAD@F@BBFDBB@D@BABABA@AACAEA@@A@A@C@CDA@A@A@AAACCC@@C@A@A@A@AB@BAB@D@H@F@HBB@BADCDABA@A@A@AAAA@A@@@AB@BABAB@@A@A@E@AAC@@AA@@A@@@A@@@AB@@@D@H@DAB@BABCBA@CAA@AA@@@AA@AAAA@@CCECCA@@ABA@AB@B@@@B@DBB@@FD@@B@B@D@B@BBB@BB@@@B@@@@AB@@ABA@G@A@A@ABA@C@A@E@EBA@@BAB@@@B@@BF@@@B@B@BBD@DBB@@DBDD@@@B@B@BAB@@AB@@@D@DAB@@ABABA@C@CA@@A@@A@@@A@A@@B@BA@ABA@@DCBA@C@@AACCAAAAEAAA@@C@@@A@AB@@BDBBBB@B@BAD@BABA@A@C@GAA@ABAB@B@@@BDBD@@BB@@@AB@@CBAB@BAB@H@B@BABA@A@AAEAAAA@A@@@ABA@@B@FABA@@@C@GB@@A@@@@B@@@BBBB@A@@@A@E@@@A@AAA@AA@@@@@A@@A@@@@AA@A@@@CC@A@A@@CC@@@@@A@@A@@AA@@AAACA@@@A@A@@AACA@@AAAA@@AB@@A@@A@@@A@@@@@@C@@@AA@AA@A@@@AAA@@AB@@@@@A@A@@B@A@@A@A@AA@@@@A@A@AAC@AA@@AAAAAAA@AA@@AA@@@@@@A@@A@@@@@@@@A@@@AAA@A@@@@AA@A@A@@@A@@@A@@@@@AA@@A@@@@@A@@@AB@@C@A@@A@@A@@@AA@@@@A@@@A@@@C@AAAAAACAAAA@A@A@@A@@A@A@AC@@A@@A@@@@@AA@@@CA@@@AA@@@AA@@@AA@@@@A@@@@AA@B@@@A@@A@@@EBA@C@A@C@A@A@AD@BGDABCBC@ABA@CBCAE@CAA@A@@ABA@AA@@@C@A@CA@B@B@@@@A@AA@@A@A@E@@@@@@B@BABA@@AAECEAEACCCCC@A@@ACAAAACC@AA@@@@@@@@BAB@DBBA@ABEBCBABGDABA@@A@AA@@C@CCEAAAAAA@A@@A@@@CCA@GCAAECAAAAAAEAECCA"],encodeOffsets:[[110576,35715]]}},{type:"Feature",id:"610429",properties:{name:"旬邑县",cp:[108.333986,35.111978],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@AA@@ABA@@A@AA@@@@A@AA@@@@@@B@B@@@@AC@@@@@BAB@B@BABABA@EDGF@@BBABA@AB@AA@@AA@@B@@@@BD@@@@ABA@@BA@CBA@@@A@A@A@C@@BA@AB@@AA@@A@@BA@@@@A@@@AA@@@A@A@ABAB@BAB@@E@C@C@C@@AA@A@A@@@@@A@@@A@A@@@A@@A@@@@@B@@A@@@A@@@@@@@@@A@BBA@@@A@A@@@A@@@A@A@@@@@A@A@@@A@@@A@A@@BBBB@BB@@ABA@AB@@@@@AA@AB@@@B@@AB@@@AA@@@@@ABBD@@AB@@@B@@@@@B@B@@A@CAA@@@@BA@E@C@A@CBAAA@A@@@AAA@AAA@C@A@ED@@@@ABA@A@IFCDCBCDCDABAB@@@@@@@@B@@B@@BB@@@@D@@@@@BBA@@B@@AB@@A@@@@AA@@@AB@@@B@@BB@@CBA@@B@@BD@@CBA@@@AB@@AB@A@@A@CDA@@@@B@BAB@B@@A@AAGA@@@@@@CAC@A@@@@B@BBBAB@@@@@B@@@D@B@B@@@AA@GA@BAB@@@B@@@@@B@B@@@@A@C@C@CBA@@@A@@B@@@BBBB@@B@@ABA@@B@@@@@BA@@@A@@@@@A@A@@@A@AB@@AAABAA@@@BA@@@@@@A@@A@@@@@@B@@@B@@@B@@@DB@@B@@B@BDBB@@B@BB@@BBBB@@@BAB@BB@@BABA@@@A@ABA@@@@B@@A@@@A@A@@@@BA@A@CBC@@@A@ABC@@@@@A@A@A@@@@@@BDB@@@BA@@BA@@@@BB@B@BB@@B@@B@@A@@B@@@@BB@@@B@@DD@@@B@@@@B@@@@@@@BD@@@B@@@@@BAB@BB@@@@B@B@@@@@BB@@@@B@@@B@@B@@B@@@B@@B@@@AB@@@BB@@@@@@B@@@B@B@@@@@B@@@@ABB@@@@@BBA@@B@@@@@BA@B@BB@@B@BBA@@@CBA@@@@@@FBBBBDB@@@@A@AB@@@B@@B@@@B@@@@B@@@@@B@@BA@@B@AD@@@B@@BB@@B@@@@@@A@@@@B@@B@@@@ABBB@B@@@@A@@@@B@B@@B@@B@@BA@@@@BB@@A@@@@@D@BB@@AB@@A@@@@@@BB@@@@@BB@@A@@B@B@@@@@BBB@@A@@BB@@@@B@@@B@@@@@@@@@@@B@@BB@@@@@B@@B@@BB@@@@@@@@@CB@@@@@@BB@@@@B@@B@@AB@@A@@@@B@B@@@@B@BBAB@@@@@@A@@BBBB@@@@@@BA@@@@@@BB@A@@@@BB@@B@@@BB@@@@B@D@@@B@BB@@@@@@B@@AB@@@BBD@@@B@B@B@@BA@BDB@B@BBB@@@@B@@@@B@@@@@B@@@@@B@@@@B@@@@B@B@@B@@@@B@@B@@@AB@@@@@@B@@DD@@B@@BB@@A@@BDD@@A@@BB@@B@@B@B@@@A@@B@@@@B@@BB@@@A@@B@@B@@BB@DDB@@B@@@@@BB@@B@AB@@@B@@@@B@@@@@@@@BB@@@@@B@@@@BB@@A@@@B@@@@BA@@@@@@D@@@B@B@@@@@DAB@@@BAB@@ABA@@BAB@@AB@@A@A@A@ABA@@@A@AC@@@@A@@B@@A@@@CD@BB@BB@@@@CD@@A@@AA@@@@@@B@B@@@D@BBB@@@@CBA@@B@@@@@BAA@@@AA@@@A@A@@BAB@BA@@@@@@A@@B@BC@@AA@AAA@@AAA@C@EA@@A@BBA@@@@@@@@@@B@@@@BBB@@@A@A@A@EAA@AAA@@B@BAB@@@B@@A@AB@@A@@BABAB@@A@@@A@@BA@CD@@@@@@CA@@A@A@AB@BAB@@A@@@@A@A@@@@A@@BA@@B@B@BB@ABAB@B@@@B@@A@C@@AA@@A@BAD@@@BA@@@@BAD@DA@AA@@@BABA@CB@@@@@BBB@@BB@B@BADAB@B@@@B@@ABA@ABA@@BBBBB@B@@@BB@@@ABADFB@BAB@@@BDBHB@@@B@@ABCHA@@@@@GCEA@@AB@@A@C@A@AB@BAB@DAB@BABADAB@B@B@@CF@BCBAD@@AB@B@BAD@BADFDDBJ@DABABABA@@@AB@@@B@@@B@@BBB@BBB@B@@@BB@@@ZPFDH@DDB@@BB@@@BBD@BABADAF@PBFFREHATGJCBCBC@ABADCBAB@B@F@B@@A@A@AAACACA@CAEDEBABADEDEBE@ABA@ABCDCB@D@@BFDB@D@DBD@@@DB@BBFBB@BB@BBB@JBD@B@B@B@BCDADCL@DBLBBDB@B@B@@@@@B@B@@@D@B@D@@@BD@@BDBBDBBBDBBBHDFBFBDBB@B@BDB@@BBBB@DBFBBBFDDBFFDBBDB@BB@BDBHDDA@C@CBA@@DAF@B@@AEICG@@B@@APIB@DBBDDBBBBAF@D@B@BBBBBBB@B@@AAA@ABA@@\\@H@D@DA@A@CBA@@HADBD@B@BA@@B@FA@@H@FBF@DAD@JAH@D@@@HAH@F@HBD@DBDAFBFBB@L@B@DA@@B@@A@B@AB@B@@@D@@@@@@AB@D@BBB@B@B@BBB@@BB@DB@BB@@BDBB@@@BBB@DBDBDB@@D@D@B@F@HBFBDBDBB@FFBBDBBBB@BBFBBBBFBBDBDB@BHBB@@A@AB@@AD@FBBAFEBEDADCDCFALBFADBB@BDDBBD@D@B@@BDBD@@BDBBD@HBB@F@DBBBBBDD@@LZDDHDBB@B@BCDABABA`@B@F@DFNLPBHDJC\\JNHJBLBNGVGL@@@BGNCX@V@LALIPCFEN@DALBLFLLLLL@DBBBFB@BD@D@B@BCDAB@BB@B@@@B@@B@@BB@@BBB@B@@ABABA@@BABAB@@@B@@@B@@@DA@@@BB@BB@@@BBBB@B@B@BB@@DA@@@@@AA@@A@CAA@@@A@@B@@@@A@A@@@@BA@@@ABC@@B@@@@@B@@BBBDB@BB@B@D@@@B@@B@B@@@@@BB@B@BB@@B@@@B@@ABA@@BA@@B@@AB@@@D@@@B@@@AA@AAAAA@A@C@@@AB@BA@@B@D@@@@@B@@@@C@A@A@@@@BA@@DDB@D@B@@@B@AA@AA@B@@AB@B@@A@@@A@@AA@AA@@A@@@AAAAC@@@@@A@A@A@@@A@@D@B@BBD@@@B@BB@@BA@@@AB@BA@A@AB@B@B@@@@@@@B@B@B@@@B@@@@B@@AB@BBB@D@B@@@@D@B@B@B@@@B@D@@@@@@AB@DABAB@@@BA@@B@@@BBDB@@BAB@DCB@BAB@@AB@@BB@BB@@@@H@DAB@B@@A@@BC@C@@BA@C@AB@@AB@@AAA@AB@BABC@@B@DBDD@@B@@ADC@AB@@@B@B@BA@@BC@@@@B@DB@BBBBBBBD@@BBB@@B@BAB@B@B@@@@ABA@A@@B@@@@ABCBABA@@@@B@@@B@BBB@B@B@@@B@@A@A@A@A@A@@@A@@@A@@@AB@B@@@B@@@BA@@BAD@BA@BB@@A@@@@@ACAAAAA@@AA@@A@@A@@@AA@@@@A@A@@@@BABAB@@A@AAA@@ACAE@A@A@A@@A@@A@A@@D@B@B@BAB@@CB@@AB@@ABABA@@@@BBBB@@B@B@BADAB@BA@A@@@A@@@A@@@AB@@@@C@ABA@@@ABBB@@@BABA@@AA@A@@@@@A@@@@@A@@AE@@@@C@C@@@@A@@@@@AAA@ABCA@@AA@C@@@@@@@@A@A@A@AAAAA@A@@DA@@B@@@FA@@B@BB@@B@@@@@BAB@B@BA@@@A@A@AA@@AA@@A@@@AB@BAB@@A@C@@@@BA@AD@@@@AB@BABA@@@@@A@@@A@A@@@C@A@@@AAA@AAA@@C@@@@@A@@@@AAA@A@@BA@A@A@@B@BAB@DA@@@@@@BB@BD@@BB@@@B@@@BABAB@@@BAB@BA@@@@@B@BB@@B@BB@@@@@B@BAB@B@@@BB@@@@B@B@B@@@@B@@B@@@D@@@BBB@B@B@B@@AB@AAAE@@BAB@@A@C@@@A@@@AAAAA@@@A@ABA@A@@@@B@BBD@@@B@@ABBB@B@@@B@@A@@AA@A@ABABA@A@AAA@A@A@@@A@@B@@A@A@C@@@@@@B@BA@@@@@A@AB@@@@@DBB@@@B@@AB@@BFB@@B@@@@ABABA@A@ABABA@@B@@@BBB@BBBB@@B@@@B@BA@AB@@C@@@@@A@A@@AA@A@@@A@@@CA@@A@A@@BAB@B@@B@@B@B@@@@A@@@A@@@@@C@@@@B@@@BA@A@@@@AA@AA@AA@A@@A@A@A@AA@@A@A@A@A@@@A@@@@@@ACA@@A@@A@@A@@@AB@@@@A@@BA@@@C@A@@@AAC@@@ABA@@B@AA@AA@@AA@@AA@@AB@@A@@@A@C@@A@@@C@@AAAAA@AA@BA@E@A@A@A@@B@@ABA@A@A@@@@AAA@@@A@AAAA@@@@A@A@@@AA@@AA@@@A@A@@@A@A@AACA@@AA@@@@@A@@AAA@@AAA@@@AAB@@AB@@A@A@@BAB@@A@A@@B@@ABAACB@@@@CA@@ABAA@AA@AA@@AAA@@@A@@@C@@BCAAA@@@@@AAAA@A@@@A@@@C@ABC@@@@@A@A@A@A@@BA@@AA@@A@@@AA@A@@A@A@AA@@@@AA@@@A@A@@BA@@@@@A@A@@@A@A@A@@@@@C@@@A@@@A@@B@@A@AC@@@@A@AAA@AEA@@A@@AC@@CAA@A@@A@@AA@AA@@ABA@A@@@@@A@@AAAA@@A@AA@@@EB@BAB@@@@@@A@CA@@A@@@@AC@A@@A@A@@@AB@@A@@CAAA@@A@A@AA@@AA@@@C@A@@AC@A@@@A@@@A@@@BA@@AA@@CA@@AA@@A@AA@@@C@A@A@@DAB@@@@AAC@@@@A@E@@A@@A@AAA@@@@A@@BAAA@@BA@@@@BA@@AA@@A@@AA@C@C@A@@A@@@@BA@@@A@C@A@@A@A@@@@@A@A@@@A@A@A@@@A@@@@@A@A@@@A@AA@@BA@@@E@@@A@@@A@AAA@@B@AAAA@@CB@AA@@A@@@A@@C@@AA@@@AB@@A@@@A@A@@@AD@BAA@BA@ABA@@AA@@@AA@A@@CC@@@@A@@@ACA@@A@A@@AA@@@BA@@@A@A@@AB@AA@@@@@AB@@A@AA@@@BAB@@@BC@@@AA@@AB@B@B@B@B@@AB@@@A@@A@A@A@A@@B@@AAC@AA@@@@BAB@@A@A@@BA@A@@@@@A@@AA@@@@@CBABA@A@@@A@@@A@@@@@@AA@@A@A@@@A@@@C@@A@@@A@ABA@@@A@@AA@@@A@@A@@@@@A@@@A@A@@@@BA@@AAA@@AAA@@@@B@BCB@@@AAA@AA@@@A@@@@DA@A@@@A@@@@AA@@A@A@@@@@A@@C@@@@@@A@A@@@AC@@A@@@A@@B@@A@@@A@A@@@@@AB@@@BA@A@@@A@@@AA@@@@@A@@@A@@@CAG@@@A@AA@@@C@@@A@@@@AAAB@@A@@@A@ADA@@@C@A@@DAB@BA@AB@@@B@B@@@@@BADA@BB@BBB@@@B@D@DBD@@@BA@@@@AA@@@A@A@A@AAAA@@@BAB@@ABA@@@ABA@@@A@A@@BA@C@@BC@A@@D@@A@@@ADA@A@@AA@AAAAAAA@AA@@@@@CD@@@AA@@@A@@@AB@@AAAAC@A@AC@A@A@@@AACAAA@@AA@@@AB@BED@@A@C@ABC@AB@@A@A@A@A@@@@AA@A@C@@A@@@A@AC@A@AA@@A@ABCBCAC@@@AA@@@AA@@AC@@A@A@@AA@@@@@A@@@A@@AC@AAA@@@@CCA@A@CA@@A@A@@@AB@@@@CACAEC@@@@@ABA@ABADA@@BA@@@@BAB@@@@@B@B@D@B@@@@@DF@@BB@@B@BB@@DDB@@BB@BAB@BBB@B@@@B@D@@ABA@@B@@@@@DBB@@@BA@@ACAC@@@@CC@CAAAAACA@@ABA@@BA@A@@AA@A@@A@CA@@A@AAAA@A@AAAAA@AAC@A@@@ABA@A@@A@CAAA@AA@@@AAAAAA@A@@AA@@AA@AA@@@A@@@C@@@@A@@@@AA@ACA@AAA@@AAA@AA@@@A@@AC@@AE@@@@A@CA@@AC@@@AA@A@AAE@@@AAA@C@@@AAAA@@A@A@@AA@C@@@CAAACACA@@AA@@@@AAA@A@A@C@@@CAA@@@A@EAC@C@CAEAEAA@AAA@GCA@@@A@CBA@ABABCBCBAB@B@@@@AB@@@B@@@BC@CBE@A@C@@@AAA@@@@@@@@BAA@@A@@@@@AB@A@@AB@@@@A@A@A@@@AAAB@@A@@@A@@@AA@@AA@@A@@A@A@@A@@A@@AA@@AAA@AA@@@@A@@@AA@@A@@@AA@@@@@@AA@@@A@@A@@@@@@AA@@@@AA@@@A@@@@ACA@@@@@AA@@A@@A@@A@@@@BAA@@@@A@@@@@A@@@@@@@A@@A@@@@A@@@@@@@A@@A@@@A@@@@A@@@@@@AA@@A@AA@A@@A@@A@@@@@@@AA@@@@@@@@A@@@@@@@A@@@@@AA@@@@AB@AA@@@AA@@A@@AC@CA@@@@@@A@@@A@@@C@@@A@@A@@AAA@A@ACCAAAA@@@AB@@A@@BA@C@@AAAAAA@@A@@@A@@@@B@FA@@@GBA@@@AC@AAA@AAA@@@@A@AB@@@BA@@@AA@@EBIB@@A@OF@BC@C@@@CDABA@AB@BA@ABCBGDA@A@A@AB@@A@A@@@AA@@@@@@@@AA@@@@@A@@@@A@@@@A@@AA@@@@@@@@@@@@A@@@AA@@@@@@A@@@@@@A@@A@@A@@@@AAA@@@@AA@@@@@@@@A@@@@@@A@@@A@@@@A@@@@B@@@@@C@@A@@B@@@@@@AA@@@A@@@@AB@@@AAA@@@A@@@@A@@A@A@@@@AA@AAA@AA@@@@@AA@@AAAA@AAC@@AAA@@AAA@@@@@@A@@A@@@A@@A@@A@@@AA@A@@@A@@@AAAAA@A@@@AA@@@AAAC@@@@@@A@A@AB@@AB@B"],encodeOffsets:[[110957,35791]]}},{type:"Feature",id:"610430",properties:{name:"淳化县",cp:[108.580681,34.79925],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@C@A@@B@@ABA@@B@@A@A@A@CA@@C@AB@@E@A@A@@@AA@@@@AA@@@@C@C@@BAB@@C@EB@@C@A@E@A@CBA@C@A@CAAA@@ABA@E@A@C@A@C@AA@@CB@@CAA@@@CAC@A@AA@@A@CBA@AB@B@BABCFABA@EBA@ABA@CAA@AAC@@AE@@@AA@F@BADABADA@AB@@AB@B@@@D@BA@ADA@@BEBE@@@AB@@@B@B@@DF@@@BAB@@A@@@A@CAA@A@A@A@@@@BAB@@BD@B@D@@@DAB@B@B@BB@@BBD@B@B@@A@@@ABAA@@A@@AAC@CAAA@AA@@A@A@ABEB@@C@@@A@A@AAAC@ACACA@@A@A@A@ABC@@BAD@B@B@BBB@BB@BBFFDB@@DFDBDH@B@B@@@BAD@@ABA@CB@@A@AACA@@AAAE@A@@@C@C@A@@@AAAA@AAAAA@@@AAA@CCAA@ACACAA@@@A@A@A@@BA@@D@@@B@BBB@BFDBBBBBBD@F@B@BB@@BB@@@BABABA@@BAB@BAB@@BF@BAB@B@@A@@@AB@@A@A@AA@@@@@A@ABG@A@@@A@AA@@AA@@@A@A@@@EDA@@@A@A@CAAAA@AA@@A@ABIDABA@A@GBCBAAA@C@AAA@AAC@A@AB@@@B@B@@@@HBB@@@BB@@@B@@ABABAB@@@B@B@B@B@BABAB@@A@ABGAA@A@ABA@@@AB@B@@BD@B@@AFAH@BAB@@ABC@A@@@AA@@@A@A@A@ABE@A@CAAAAAAA@A@A@A@@B@@ABBBDF@B@B@@ABAB@@A@E@EAAAA@A@A@ABA@@B@BA@@B@B@BBB@D@B@BAB@@A@A@E@@@CA@@CAE@A@A@A@AB@@AB@BAB@D@BAF@@ABC@CAC@CAC@C@ABAB@DBBBH@B@BADCB@@A@AA@E@AAA@AA@A@A@@@CBAD@@AB@B@DBH@D@DABCBC@C@AACCCAA@E@ABAB@B@BBFJJ@BBDA@@DA@A@BBB@@B@@@@@D@@@@AB@@@@B@B@A@@BBB@@@@A@@@@BB@@B@@@BAB@@@@@@@@B@B@@@BB@@@@@B@@@B@@BB@@BA@BB@@@@BB@@BB@@@@@@@@@@B@@@BD@@@@@ADAB@@@B@BDD@@AB@@@B@B@BBBB@BBBBB@BBBBBB@@@@@@@BB@@B@@BF@@DB@@@B@BBB@@@BBB@@@D@B@BBB@B@@@BB@@BBBBB@@BBBB@B@@BB@@BB@@@B@@B@@D@BB@@BBB@@BB@B@@@B@@@B@@B@@B@BB@@B@@@BB@@@@B@@@B@@@@BBB@@@AB@@@@BAB@B@@@@@@@BDBB@@B@@B@@@BBBBB@B@@@B@@@BBB@@B@@@@BB@@@B@@@@B@@@@B@BB@@BB@BD@BBB@BB@BB@@B@@@@BBB@BBB@@B@@B@B@@@@B@@B@@@B@BB@@A@@B@@B@@@B@@B@@@@A@@@@BD@@@@@A@@@@@@B@@B@@@B@@@@@@@@B@@@@@@B@@B@@B@BB@@@@@BB@@@@B@@@@B@@@@@@@BB@@B@@@@@@@@@@@@@BB@@@B@@B@@@@@@B@@@@BB@@@@@@@@BB@@B@B@@@BAB@B@B@HCDABAB@@ABAB@BADC@@D@D@@APEB@@@JAFA@@BB@@B@@A@@BAB@@@@@BB@BBB@BBD@@B@HA@@B@@E@A@@B@@@B@@@BBBBBB@@@DAB@@@BA@@B@@BBBBDD@B@BBB@BB@@@@B@@@D@@@B@@@B@@@@B@@DBD@@@BB@@B@@BBA@@B@@B@@B@@@@@B@@@@@@@B@@@@@@B@@B@@@@@@@BB@@@@BBBB@@@BB@@@@@@@B@@B@@@B@@@@B@@@@@@@B@@B@@@@B@@@@@@@B@@@@@B@@B@AB@@@@@BB@@@@BB@@B@@@@DB@B@@B@@@B@@B@@B@@B@@@@B@@@@B@@BB@@@@@@BB@@B@@@BB@@B@@@@@BBB@BB@@BB@@@BB@@@@B@BB@@@BB@@BB@@B@@@B@@@BABB@@B@B@B@@@@@BA@@@BBA@@@@B@@@BB@A@@@@@@B@BB@@D@B@F@DAD@@A@@@A@@BA@@@@@ABADADABABAB@DAB@@@B@HDB@BBB@FBFBDBD@D@FBB@@@B@DB@@D@B@B@B@BB@@@@BB@@DBDBBBDB@@D@B@@BB@B@@@BBBB@@D@B@BB@@F@BBB@B@@B@@BA@ABA@A@@@@@A@A@AB@@AB@@@@A@@@AB@@ABA@@@A@@@AB@@@DA@@DA@AB@@@BCBA@@@AB@@@BA@AB@B@@@@@@BDB@B@@FBB@B@@@D@B@@@B@B@BABABABA@@@A@@@CB@@@BABABE@C@A@A@ABAA@@@@A@A@@AA@ABA@AB@BA@@@ABA@AB@@A@A@@@CBA@@@AB@@@@@@A@@BCBA@@BA@A@@@C@ABCBABA@A@AB@@AB@DA@B@@B@@@BAB@@@B@DBBA@@BA@A@@@@@A@@BA@A@@@AB@@C@@BC@@DA@@@ABAAC@A@AB@@A@A@@BA@@B@@@@AB@@@AA@A@@BA@AB@B@B@@A@ADCDA@AAABADA@AB@@E@C@@@A@@@@@AB@D@B@@@BA@@@@ACA@@A@@@E@A@@@A@@@A@@A@@ABA@@@A@@BA@@@A@@B@@A@@@ABABA@@@@@A@AB@@A@@BBBA@@BA@@B@@@BBBABB@@B@@@@@BCDCDABABA@AAC@@ACA@AACACAA@@ABABAB@BABA@A@@@AB@BC@AB@B@@@B@BA@A@@@A@@@A@@@@@ABAB@B@BC@A@@B@BBBBB@@@@@@A@@@A@@@A@@DB@@BA@@@@@B@@@BBB@AB@@@@@@C@@BA@@DBBBD@@@@@@@@AB@@ABA@@BA@AB@BB@@BABA@A@@B@@B@@@BA@BBBBB@FA@AB@@@B@@A@@B@BA@@B@@ABA@@@AB@@AB@BA@@@@@@B@@@B@@@B@B@@BB@B@@@BB@@B@@@@@BBB@@@B@@@@@B@B@@@@@B@@@BB@@@@D@FAB@@BB@@@B@@@@@B@B@DA@@@B@@B@@@B@B@BAB@@@B@BA@@@@B@@@@A@@BBBB@@@@B@@A@@B@@@@@DD@@@@BA@@@@@@B@@@@@@B@@B@@@B@@AAAAE@@@@DBD@@@BABA@@AA@A@A@AB@DA@@BA@@B@B@@@@ABA@@@@AA@@BA@@@A@@@A@A@@@@B@B@@AB@B@@@D@B@B@@AA@@@AAAA@@@@@A@@@@D@DAB@B@B@@@BAB@@ABABA@@@A@@B@BAB@@@@D@@B@@@DA@@@@@AAA@@@A@@AC@@@@@@D@B@@@@@@BDB@B@@B@@@@ADA@ADABCBABCB@DDB@@@B@@A@@@@A@@AA@@A@@BA@A@@@A@@BA@@@A@@@A@@AA@@@AB@@A@@@@@AB@@@DAB@B@@@@A@@@AA@@@@@C@@@@@@@@A@@BC@@@A@@@@ABA@@@@AA@@@@AB@@@@@@A@@B@@AB@@@@A@@@@B@@C@AB@DA@A@@@A@AB@@A@@@A@@@A@A@A@@AAA@A@@@@@CBAD@@G@AAA@AAA@A@C@ABC@@AA@EAAA@@A@AA@@AA@@@@A@@@@@@AA@@@A@AA@@AA@@A@@@@AA@@@A@@@A@@@@@A@A@AA@@@@ABA@AA@@A@A@ABA@C@A@@ABA@A@@BA@ABC@A@@B@@@@@@BB@@@@@BB@AB@@AB@B@B@B@AA@A@A@@@AA@@@BA@@AA@@BA@@@@A@@A@@AC@CA@@AB@AABA@@@@@@@AB@@@@A@A@A@@@AAA@C@@@AAA@A@@DE@C@@A@AA@@@AA@@A@A@A@A@A@A@A@A@ABA@ABABAFAB@DAA@AAA@C@A@CAA@@AA@@AA@A@AAAAA@@@AAAAA@AAAAA@AA@@@A@@@A@@@@@ACAC@@@A@CAA@A@A@@@CAC@A@A@@@AAA@A@@BCB@B@@A@ABA@EAA@ABC@@BAB@@AB@@@BA@C@ABA@@@A@@AA@A@A@@@A@@@CAA@@AA@A@ACC@AAAAA@A@AACAC@@@C@AAC@@@CAC@EBA@@A@AA@ABC@AB@@AAA@A@AAA@A@A@A@CBEBC@G@CBG@CBG@C@C@ABA@@BA@ABA@A@A@ABA@@@AACAA@IAA@C@AA@@ABA@CB@@ABCB@@AB@@ABA@CD@@ABA@A@@@C@AA@@A@C@A@@A@AAAAAA@CA@@@@@@AB@@ADA@@BABA@@B"],encodeOffsets:[[111214,35561]]}},{type:"Feature",id:"610431",properties:{name:"武功县",cp:[108.200398,34.260203],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@C@CAC@E@ABA@A@A@EAA@A@GBA@KBABGBIDC@ID@@GBCBABCBCBABEFCBCB@@CBA@ABGB@BC@C@CBC@A@G@M@IAMAA@E@IAA@K@EAG@A@A@A@K@A@A@IBGBABA@IDB@@B@F@B@D@F@F@@@B@@BDB@@@@BBHBBDD@@@BBB@@@@B@B@@AB@BA@@BA@BFBBBBBB@@BBB@@@B@@@@@@C@@BA@@@BBBB@@B@@BABBB@@B@@@@B@@@@@@BB@B@@B@@@@@B@@B@B@@@D@@ABC@@@@@A@@@@@@@@B@@@B@@@BA@@@@@@B@@@@@@@@@B@@@@@@@BAD@@@@EB@@B@@@@BAA@@@B@@@@@@BB@@@@@BB@B@@B@@@@BB@B@@@B@B@@C@@BA@A@@BABAB@@@B@@@B@@@@@@AD@@B@@@B@@B@@@@@@@@A@@@AB@@@BA@AB@@@@ABA@@BAA@@@BG@@@@@@BCBAD@@@@AA@AAB@@@@@@A@@B@@@D@@@BA@@@ABA@ABA@@@@@@@A@@B@@@@A@@B@@ABA@@@A@@@@@A@@B@@AA@@AA@@@@@A@@@AA@@@@@@@A@@BABAB@@A@@A@@@@@AB@@@@AA@@@@@A@@B@@A@@@@@AA@@A@@BA@@@A@@AA@AB@@@B@@A@@AA@B@@@@@@A@@@@@@@@@@AAAB@AA@@@AA@@@@A@@AA@@@A@@@@@@@@AA@@AA@CA@@C@A@@@@@@B@@AA@B@@@BA@@BA@AB@@@B@@@B@@@B@B@@@BA@@@A@@@@@@B@@@@@@@B@@@@@BA@A@@@@B@@@@@B@@AB@@DD@@B@@BA@@BA@AB@@@@@@@@AA@@B@@@@@@@A@@@A@BB@BA@@@@BA@@@@AA@@@A@@@@@@BA@@BA@@@A@@@@D@@@BAB@BB@@BBBAB@@A@@@@B@@@BA@BD@BA@@@@BB@@@AB@B@@@B@@D@@@@B@DA@A@A@@@@@A@@@@B@B@@AB@@@@A@A@@B@@A@A@A@A@@@ADAB@@A@A@@@@@A@@@A@A@@@A@@B@BA@@BA@@@AA@@@@@B@@@@DB@@AB@@@@B@B@@@@@@D@B@B@B@@@B@@@D@B@@@B@DAB@BA@AB@@@BC@@@AB@BB@@@@FA@@B@B@@@@@B@B@B@@@B@B@B@@@@@@B@@@@B@@@BCA@BB@BB@@B@HBB@F@B@@@@@@@B@B@@BC@@@@@@BB@B@@B@@@@A@@BCB@@A@@@A@@@AB@@AAA@@@@BABA@A@@@@B@@AD@@@@AD@@@BB@B@@B@@D@@@@BBB@B@@B@@@AD@@B@@@@@BA@BAB@@BBAB@BA@CFAB@@@BAF@BAD@B@@A@A@@@AB@BA@AD@@@@@B@@AD@@A@@@AD@@E@@@@@@@@BF@B@B@@BD@B@@@F@B@@A@@@AB@@AD@B@B@@@BA@@@@B@ABB@@@@@@AB@@@B@F@@@@@@@BE@ABA@@B@@@B@A@B@@BBA@BB@@@@@AB@@@@@@@BFFBB@@@BAB@@A@CA@@@@@B@@@@@B@B@@F@F@@@DB@@@@A@A@@B@@B@F@BB@@B@@@@A@A@@@@B@@B@@B@@@@@@@BAD@@@@@B@BA@A@@B@@@@@B@@ABAB@HD@@@BB@B@@@BB@A@@@ABA@A@@@@BB@@BA@ABA@A@@BB@@@ADC@@D@@@@@BCFB@@@A@@A@A@A@@@BA@A@ADB@AB@@B@@B@@@@B@@@@@@AB@@@BAB@B@@B@@@@B@B@@B@@@@B@B@@@@@@@B@B@F@@@@HB@B@B@@@@B@@@@A@@@@D@B@@AAA@A@A@A@@@AB@@AD@@@BAB@B@@@@B@@@B@B@@BB@D@@B@@B@@@B@B@@B@@BB@D@@@BB@@B@BB@A@@BB@@@@BA@@BA@@@AA@@@B@@A@@@A@A@@CAAAA@@CBEAAA@@@@C@AA@@@@@AA@@@@@A@@@@AAC@@@@B@@A@@@@C@@@@C@@@@@@G@A@A@GBC@@@@CA@@@@@A@CBAB@B@@@@A@A@@BA@ABA@@@@DAB@B@B@@AB@H@@A@AA@@ABA@@@@@AB@B@@@@AB@@@@B@@BCB@B@@@@A@@B@@@@@@AB@@@B@@@BA@@A@@A@@BA@AC@A@@A@@A@A@EAAA@@C@@@@A@ABC@@@A@A@C@A@@HB@@@A@@@A@A@A@@F@F@@@@BDBBDB@@BB@@BHBBBB@@@B@@A@@B@@A@ABG@@B@D@B@@@B@BA@@@@BA@@@@@@@BB@B@@@@B@@@@BB@B@@J@B@@A@A@AB@B@BBB@@@@@FDB@@@@A@@@@B@@@@@B@@A@@@ABA@A@C@AAAA@@@@@AA@@@CAA@@AAA@@@@C@C@@@ABA@@B@BABAB@L@F@B@B@B@@@H@B@@A@@AA@@@@B@B@D@FAB@@B@@@D@B@B@@E@@@@BA@@@@D@F@@@@@@@C@@B@@@@B@B@@B@@HB@D@@B@@@@BBBA@BB@B@BBB@@@@@@@B@B@B@@@@ABA@@B@D@@@@@@@@@B@@@@A@AD@@@@A@A@A@@C@@C@@A@C@@@@CA@@@@AACBA@A@C@C@@B@BAD@D@BBF@@@@AF@@@BB@@B@@@@DB@@@B@@B@@@@@@@@AFB@@@B@@B@@BB@B@D@B@B@@@@@DADBB@@D@B@@BBB@@@@D@B@B@@@@@@@D@BB@@BBD@@BB@BB@@@@B@@@DA@AB@JC@B@@B@@@@AF@B@BA@@@@B@@@@@B@@A@@BA@@@AFAB@@@@BB@B@@@@@B@@A@@@AB@BA@@D@B@@@@A@@B@@@@A@@BAB@AAB@@@FAD@@@@B@@B@@@B@@B@@@@B@B@@@@BAB@@@BB@BAB@BB@@B@J@@A@@@AB@B@B@B@DA@@D@@DB@@DB@@BB@@A@@@DB@@@B@FBD@@DB@@B@BBB@@@ABAB@BBB@@@B@BA@@B@B@@@BA@@BALADAAAA@@@@@@@@@@A@A@@A@@@@@@A@A@@@@B@BBBB@@@AB@@BB@@@@@@@@A@@DA@AB@B@BABA@A@A@@@A@@CA@@@@AB@@AB@@@@A@@@CBA@E@@@ADAB@BA@@@@@EBAB@@@@@@E@@C@AC@@CA@A@@A@E@@B@ACA@@@FA@@B@@E@@B@DA@AF@@@@A@@@@D@@@@BBAL@ADH@@H@BB@FA@BD@BGB@@EB@@@A@@AB@@AB@ACA@@EA@@@A@@CC@C@@C@@C@A@@@@AABCC@@AAA@@@AA@@@@A@A@@@@G@@@@@A@@@@B@@@ACBC@@@@@@@A@C@C@@@@B@@@@@@AA@@@@@B@@@@A@AD@@@@@BA@@B@@@@A@@@@B@@@@@BC@@B@@C@@A@@@@AA@A@A@@CE@@@E@@ALB@@AAC@@@EAA@@A@@A@CA@@@AA@@@@A@A@A@@@@@@@A@@@A@@D@@A@A@@B@@@@A@C@CAA@AB@B@@A@C@@D@@CE@@@@@@A@@CAA@AB@@@AA@@@A@@AB@@A@A@AAI@@B@@@@C@AA@A@@ABA@@@@D@J@@A@A@@@CAA@@B@@A@@A@@C@C@@@CA@@@@A@@@@@@A@@@@A@@A@@@@@A@@A@@C@@@@@@@AAA@@@@@@@@@@@@@@@AA@@@@@@@CA@@@A@@AB@@@@@@A@AB@DA@A@@D@D@@C@@B@@@@A@AD@@E@@D@@A@C@@@A@@@AA@AA@@@@B@B@@@@A@@@@@CA@@@@AB@@@A@@@@A@A@A@@A@@@@@A@BC@@B@@CC@@@@@@@BAB@@AA@@@@C@@B@@@@AA@@@@@A@B@@A@@A@@@@@@@@CBC@AAA@@AAEBABEBG@CBI@E@@@C@E@CAGAA@IACAC@@AEAA@"],encodeOffsets:[[110864,35023]]}},{type:"Feature",id:"610481",properties:{name:"兴平市",cp:[108.490475,34.29922],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@C@QBQDG@ABMDBB@@BB@BAD@D@@@@@@B@@@@BA@B@@@@@B@@B@@A@@@@D@@B@@BA@AB@@@@@@D@@DA@@@ADB@@@@@B@@@@B@B@B@@B@@@A@@B@@B@@D@@@@@B@@A@A@@@@@BBB@@B@@@B@@@D@BC@@@@FC@@B@B@@A@@@@DC@C@@@@BCBA@@B@B@@@@A@@BB@@@B@@D@@@@@@BB@@@@@@@@@@@@@@B@BB@@@@@@D@@@@BB@@@@@B@@@@B@@B@@@@@@@@B@@B@@D@@@D@DB@@@@BA@@@BB@D@@@B@BI@C@@@@@AB@BB@B@@B@D@@A@@@BJ@B@B@BA@@BB@@@B@@B@@BAB@DB@@@B@@@@F@@DC@@@@D@BA@A@@BBB@D@D@B@@A@@@@B@BC@@@@B@@@B@@@@@@@B@B@B@@B@@B@@DBB@@@@BB@FB@@D@BB@@KA@BF@@@F@@DB@B@B@@B@@B@@@@DA@@@AD@@@@A@@@@@@B@@A@@@AB@@@@C@@B@B@@A@@@@@B@@B@@@@A@@@@@@D@D@B@@@@@@ADBD@@A@@@@@@B@@@@@H@@B@B@@@@@BB@@B@BB@@DDBA@B@@B@D@@@@DD@D@@DB@@@B@@FB@BD@@@@B@T@BDNA@CBA@ABABA@@BED@@@BA@@@ABBBA@@@A@@@A@@BA@A@@B@@C@@@@B@D@D@D@@@@BB@@@@@@@B@@B@@@BB@@@@@@B@@B@@B@@B@@@B@B@@B@B@@BF@BA@A@@FA@@@@@@@@BD@@H@@E@@B@B@@B@B@B@BBF@@@H@@BH@@@F@@BH@@@@B@B@FH@@@@B@B@BD@B@B@@T@@@HE@AJD@BB@@@@@@@@BBD@@@B@BF@B@@@@BBA@@@F@@@H@@@N@@AB@@C@@D@@AH@@AF@@AN@BBD@@NA@@D@BB@@@JB@@H@@A@A@SACH@@AB@@AF@@AF@B@F@@@D@@DD@@B@D@BH@@BD@@B@@@@DB@BBB@@BB@DD@@BD@@AF@@AL@@H@@@FB@@DB@@DF@@BH@@BD@@DH@B@@B@@BBDA@B@F@B@H@B@@B@@@@@B@AD@BB@@AB@@@@B@DBD@@@BCA@FE@@BA@@@AB@@AB@DC@@BA@ADA@AFNBB@@@@B@@ADB@B@@@@FB@AFD@@CD@@@NBB@@D@@@BB@B@DA@@@BD@ADHB@BLBBCJ@@BB@@AJB@@DB@BD@@AD@D@D@BCH@@CBA@AF@BA@@BCB@@AD@BC@@B@@@BAA@@@@@@@@A@AD@@AA@A@@@@A@AB@@C@A@@D@@@@ABG@@B@B@@AA@@@A@@@@A@A@@C@A@@@@@@CA@A@A@@@AABA@A@@A@@@A@@@@A@A@A@@@@A@@@@A@C@@@A@@@@@A@A@@B@@@BA@@B@DC@@B@@@B@@@@@BA@AB@B@B@@@@@BA@@@@@@@A@CB@@@@A@@@AB@B@@@@@@ABA@@@@@A@@@@B@@A@@D@@@BA@AB@B@@@@ABG@C@CE@ED@C@@@@@A@@A@@A@@@A@@@AAAA@BC@@@@B@@@@@BEB@@@BC@@J@@ELBAF@@B@@B@@B@@@@@B@@@BCAABC@AB@@@BC@A@A@@B@B@@BF@@@@@BC@@FBD@B@BB@@@@B@@@@CB@@@@@B@@ABA@@BB@@@@@ABA@@B@@C@@B@@@@@@CB@@@@@B@@@B@@ABABBBA@@@A@@@@A@@A@@@A@@AA@@@@@BA@@@@@AA@@BA@@@@@A@@@AB@@@BA@AA@@@A@AAC@@B@@@@A@A@C@@@@@@AB@B@DABEA@@A@ABEB@@@@A@A@@CAA@@@@CA@@AEAEACA@@CAC@A@AAA@@A@A@A@@@CBA@A@AA@@@@@BA@@B@@@B@B@@@@@@@@@BA@BB@F@@@B@@@@E@@B@@@@@BA@CBA@@BA@@B@@@BA@@@AB@@BB@@A@CA@CB@@@@@C@@@@AA@@C@@A@@@@A@@@@CA@BA@@A@A@AA@@BAAAEA@@@C@AB@B@BC@A@@B@@@BC@@B@B@@@@@BA@@@C@@@AA@@A@@@@@A@@A@BC@ABB@ABABABDB@@@@@BC@@BA@C@@@AB@B@@@@CFOECC@CAEAE@ICAAAAEAAAC@@@CCOGCAGEA@CACAMC@@C@CAGAGAC@C@CAA@G@GAE@C@SA@@I@QAMAI@EAA@@@ICCAGCIEKEGAICICCAC@EACAEAKAMAG@E@C@E@I@E@A@I@E@C@IBG@C@KBI@ABC@@@E@G@E@KAKCGAEAGAIAA@@AKA"],encodeOffsets:[[110946,35019]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
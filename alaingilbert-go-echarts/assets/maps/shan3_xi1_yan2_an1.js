@@ -0,0 +1,6 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("延安",{type:"FeatureCollection",features:[{type:"Feature",id:"610602",properties:{name:"宝塔区",cp:[109.48976,36.585472],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@BA@AB@B@@@BA@@@@@A@@@AD@@AA@@@AAA@@A@@@@@A@@BA@@A@@@A@@@@AA@@@@AA@@@@A@@@A@@@@AACA@@@@@A@CBA@BB@@ABA@@BA@@B@BAB@@@BABABABAB@@AB@@A@AA@@@@A@@BAB@@AB@B@BA@A@A@A@A@@@@@A@EH@BA@@D@D@B@@CB@@@@A@@AA@A@@@AAA@A@A@AB@@A@AB@B@B@@AB@@AD@@@BA@@@@@A@@AA@@@@@A@CDCB@BA@A@AAACA@A@@BA@@@A@@@@@AB@@@@@@@BA@@B@@@A@@A@AA@@A@@AAA@@AC@@A@@@AB@@@@@B@@C@@@@@AB@@@B@@@B@@@BABABABAB@@@@A@A@@AA@@BA@@@A@A@@B@BA@@@AB@@@@@@@BA@A@@@@D@@AB@@A@A@C@@@G@ABAB@@@@@B@@A@@@CAA@@A@@CBAD@D@B@B@BA@@BA@@@AA@@A@@@CB@@@@AA@@@AA@@B@@AB@BAB@@@B@@A@@AA@AAC@AAA@A@@A@@@B@@@@@BA@@AA@@@CD@BABAB@@@@@@AB@@AB@@AB@@A@@A@@@@@@@@AA@AAAAA@@@ABE@A@A@@BA@@AA@A@@@AC@@AA@@E@AA@A@@@@BA@@B@@@BA@@D@B@BAB@@A@A@C@@@A@@@ABAB@@@@AB@@@B@@@@@B@@@@@B@@@@A@@@AB@B@@A@@@AA@@@AA@@@ABCB@@A@CBADA@@A@@AB@@@BA@@BA@A@@@CBAB@@@@A@A@A@@@A@AB@@A@@@@@@AC@@@A@@B@BAB@@AACB@A@AA@A@@@A@@@@@ADABA@@BA@A@@@AA@@A@ABA@@@@D@@@FA@A@@@@B@D@@A@@@@@A@A@ABABC@@@C@@@A@AC@@@A@@@@@AEBA@AA@@A@@@@BABA@@@AA@@@@A@AB@@@@A@@@AA@AA@A@@@A@@@@@@B@@@BAB@B@B@@@D@@ABA@AB@@@BA@ABAB@@A@@@A@A@@@@@AAAA@@AAABAB@@AB@B@BABA@@@A@@@ABA@A@@@@B@@@DB@@B@@BB@@@B@BA@ABA@@@AB@@@@A@@B@@AB@@AB@B@@@@A@@@@@@BA@A@@@@DBB@B@@@D@B@B@BBD@@A@ABA@A@@BABAB@@@@ABCB@B@@@B@@@BAB@@ABA@CB@@CD@@@@A@@@A@@@A@AB@BA@AD@BA@A@AHABABA@A@@D@B@BDB@B@B@@BD@D@@B@B@B@B@B@BBDD@@@D@BBBBD@B@@@B@@@B@@@BB@B@@BB@@@BB@@B@@@@@BABA@@@@@@BBB@@@BBB@@BB@@@D@@@B@B@B@@@BB@@BBBBB@BBDA@BB@BBDF@@BBBBDBF@B@F@B@@@B@@B@@AD@B@@@@@@B@DABA@@B@@@B@@@B@@ABB@@@@@B@B@@@@@@BAB@@A@@B@@@@BBBBF@@B@@@BB@@B@@@BB@A@@B@@@B@@B@B@B@B@@@BB@@@@@B@BA@AB@D@B@@@@B@@BBB@BAB@@BBB@@@BBBB@BBBBBBB@@@BA@A@ABA@@B@B@@@BBBB@@B@B@@@@AB@@@B@@@BCDA@CDABAD@@@B@D@B@@B@@@@@@A@@@@BBBDB@@DB@@@D@@@@@BC@@@@@AD@@@BA@@@@@AAABA@@@AB@@@@@BAB@BA@@DAB@BA@A@@@A@@@ED@@@BAA@@AA@@@C@@@@AD@DA@@B@@@B@@BB@B@BABA@A@@@A@BB@B@B@@BBBB@@@@@BA@@BA@A@@@@B@@@@A@@@@@A@@@A@@B@@A@@@A@@@@B@@@@@DB@@B@@@B@@@@AB@@@@@B@@@BBB@@A@@@@B@@ABABA@@B@@BD@B@@BB@BBBBBB@@BB@@B@@@BA@AD@@A@@@@B@@@@C@@@A@@@@@@@CAA@A@E@@@C@C@C@A@A@@@A@@@A@C@@@ABA@@@AAAA@@AB@BCB@@AB@BA@A@@BA@@BC@@@@@AD@B@@@@B@BB@@@@@BADAB@@A@AAA@ABAAA@A@@@@B@@@B@@@B@@@B@@B@@BAA@B@@@B@@@B@@BB@@B@@@B@@@@BB@@@ABC@@@@B@@DB@@BB@BB@@B@@BA@@@@B@@@@A@@@@@@B@@@@@@A@@@@B@@@@@@A@BBAB@@A@@BB@@@@@B@BBB@BBB@B@B@BB@@BAD@@C@@BA@@B@BA@ABA@A@@@@B@@AD@@@B@DAD@DA@@B@@@BA@@BAB@BAB@B@@@BA@@B@BAB@@@BAB@@AB@@@BBBDB@@@BA@AB@BA@@B@B@@@BB@@@BA@@BBB@B@@@B@@@BABA@@B@@BB@BH@@@BB@B@@B@B@@BB@@@AB@BE@@B@BADBBBB@DC@AB@@AD@B@BA@@@@B@@@BAB@B@@@B@@@BBDD@BBF@@@BB@DB@@B@@@@@@BBB@@@@BB@B@@@@@BA@@B@@B@FBBB@@B@@G@A@AB@@@B@@BBD@@@@@@@A@@@C@A@ABAB@@@B@@@@@@A@A@A@A@@BABAB@B@BA@B@@B@BBB@BB@BBBB@@BD@BB@@B@BB@B@@@BB@@B@@BBB@@@@BB@D@@AB@@AB@@@BA@@@A@@@@B@BB@@FDB@@ADAB@@@BB@@@B@@@@@@BB@@B@@BBB@@B@@AB@BE@A@A@@B@BA@@@@B@@@@@@B@@AB@B@B@B@@@B@@D@D@@BBBB@@@BA@@B@B@@BBBB@B@B@@@B@@BB@@@B@D@@@B@@@@B@B@@@B@@@@B@@@D@B@@@@BBBB@@@B@BCBC@@B@D@D@B@B@B@B@B@B@@@BBB@BBBBB@BABADC@@@@B@BBBB@@@A@@@AB@@A@BB@B@@BB@BBBB@BAF@D@D@B@@@@BBB@DA@B@BB@B@B@DAB@@B@@@BBB@@@BCF@@@@@@@@B@BA@@B@@@B@BB@@@@BBA@@B@@ABBBAB@BB@@B@@CB@@AB@@A@A@@BAB@@ABA@A@A@@B@@BBBD@@@B@@A@CBA@@@@BA@ABA@@BAF@@@BB@@B@BB@B@@@B@@@BBB@@BBB@@@BB@@AB@@AB@@BB@@DAB@@BB@@@DA@BB@@@B@@@@@BA@AB@@AB@BABA@A@A@AB@@@BBB@BB@@BBBB@@B@@@B@@@@A@E@A@A@@@@@@BBB@@@BA@CB@@AB@B@@@@A@@CAA@@CC@AAA@@@@A@@B@BBB@@B@BB@@BD@@@@ABAACAE@@@@AA@@@@@AB@@@@@@A@@A@@A@@@AB@BAB@B@@@D@@@@AB@@AB@BAB@@@B@@@D@@@B@@@B@BA@BBBB@@@F@F@@@B@B@B@@A@A@AB@@@@@B@@@@DBBBD@@@B@BA@AD@@B@@@DA@@D@BAB@@ABCB@B@@@@@BBB@@@B@@@@A@@B@BABAD@@A@A@@@@@@B@@@@@@BBB@@@@B@BAB@BAB@BAD@@@@DBB@@BB@@@@B@BB@BBB@@@D@@@@@B@@BBBBDBBB@AD@@AB@B@@AB@BA@BB@@B@B@B@@@@B@@@@@@@B@@@@@BAD@B@B@@@BAB@@AB@@@BB@B@@@@@ABA@BB@B@@A@A@A@A@@@A@@BBBAB@B@BBB@BBDDDBD@@BBB@@@B@@@@B@BA@@@@@@B@@@@@B@@A@ABA@AB@@AD@B@@@@@BDBBD@BBD@@ABABA@@BABAB@D@@C@G@A@A@@BBB@B@@@@@B@@@@B@@ABBB@DDFB@BBB@@@D@B@F@B@B@B@@@BAB@@@BC@@@@BA@A@ABC@A@@BA@@FA@@BA@@@@B@B@DBBBFBB@@A@A@AA@@AAA@A@@B@@@@A@@B@@@@BBB@BB@B@@A@A@EAA@@@CB@@AB@BADABABABA@A@BBB@BB@@BADB@@B@B@@BAB@@@BBB@B@@@B@@A@A@@BAB@@@@@B@@@@ABA@@B@B@BBB@@ABC@@BABAB@BABA@@B@@@@JBB@@@B@BBFF@BB@B@B@@@@B@B@@@@@B@@@@BB@BB@@BBB@F@@BBB@DBD@B@BB@BB@@@@B@@A@@BAB@@@BA@@@@BB@BB@B@@BB@@@@B@B@@@B@@@@@@B@D@@B@BB@@@@@@AD@BBB@B@@BBDB@@@B@B@B@BBB@@@DA@@@BBBB@B@B@B@@BB@@@D@BAD@B@BBD@BBBBB@@BCB@@A@ABA@A@@@AAABA@@B@B@@@BD@@B@@@BA@@B@B@BBB@B@DBB@@ABAB@BAB@B@BADA@@BADC@@B@B@BAD@@A@@B@BB@@BABA@@B@@@@@BBBDDDB@BD@@@B@@FCDE@@@@BB@B@B@@@B@@@@D@D@@@@B@@@@BB@@@@B@@BABBBD@DBFB@@DB@@BB@@@@B@B@@@@@BBB@B@B@@@BAB@@@B@@@@@B@@A@@@@@A@@@@@@@@AA@@ACAA@@@ADCBCDAD@@AB@@ABA@@@@B@@@B@@@@@B@B@@B@@@BB@BB@@B@@@@BB@@BB@@@B@B@@B@@B@@@BA@@@AB@@@@@BBB@@B@BB@DBB@@A@@@@B@@B@@BB@@DDBB@B@@@@@B@AB@DA@ABAB@@A@A@@DC@@@@BBB@B@@BBB@B@@@@BB@DBBBB@@@A@@BA@A@ABA@EBA@AB@@@B@@@@BBB@@BBBAB@B@@A@A@@@@BAB@BA@@@A@@BC@A@AB@B@@C@A@ABA@A@A@A@@@@BAB@BAB@@@@AB@@@@@B@@@BA@ABCB@DBD@@AB@BAF@@@BAB@@A@A@@@A@@BC@@@A@@B@@AB@@@BCB@@@BABBB@@AB@@AB@FBF@BBBBB@@B@@@BB@BB@@@BBB@@BBBBB@B@@BBDBBBD@B@B@@B@DB@@BBB@@@A@@A@@@A@@@A@A@@@A@ADABA@@B@@@B@@AB@D@@@B@@A@@@@@C@C@@@@@A@@@@@@B@BA@@@CB@@@@@B@@@@@B@@BB@D@@@@@@@B@B@@@BAB@BABA@@@@@A@@AAA@AC@@AA@A@A@A@A@@AA@A@@BAB@@@@AA@@A@@@A@@@@@ABABCBA@A@A@@BA@@BAF@@BB@@BB@@@B@@ABA@@CA@@A@BCDA@A@@BA@A@A@@AA@A@@BABAA@@ABA@@DEBA@A@AA@@AB@@A@@@A@@B@@AB@@A@A@@BAAA@A@@B@@A@BBD@D@@BB@@BA@@@@B@@@B@@@B@@A@CBAD@@A@@@@@A@AB@@ABABE@A@@AAAA@@A@@BA@@@A@@A@@@@D@@AB@B@@BB@@A@@@AB@@@AAA@@@@A@A@@@@@@C@@@@A@@B@@BBA@@@@@A@@@A@@@@@AB@@@B@DB@@B@@AB@@AA@@@C@@A@@@AA@@@A@@BA@ABA@@BCA@@AA@@@@@AB@BAB@@@@AB@@@B@@A@@BCDA@@@C@@A@A@ACA@@@AAA@AA@@@A@@@A@ABA@A@@@@CA@@@@AA@@@A@@@A@@@AAA@@@A@@@@B@B@@@@@@@@A@@@@@@@A@@A@AA@A@@@@B@@@@@DDB@@@@A@@@@AA@@@@@AB@DB@@@@@@@A@@@A@A@A@CBA@A@A@A@@BA@@B@@@BBA@@B@@@@@@B@B@@@@AB@@A@@@@B@B@@B@@B@AD@@@@B@@@B@@ABC@AB@B@B@B@@AB@BADCBAB@B@@@@@B@@B@@DA@@@@BBB@BB@B@@@B@B@BA@BB@@@@DBB@B@B@B@B@@@B@@BBBB@B@@@@@B@BADA@@B@@@BB@D@@B@@@B@DA@@DA@@B@DAB@B@@@B@B@B@@@B@B@B@B@@AB@@@B@DB@@@@@@B@@BB@@@B@DB@@BBB@B@@BDBBBB@BBB@@@B@@@B@@A@@@AB@@A@AB@B@B@@@@@@A@@AAB@@A@@@@B@@A@A@@AA@@BCBAB@BABA@@B@D@@A@@@A@@@A@@@@BAB@@AB@BAB@@A@@BC@@BA@@B@B@@AD@B@@@B@B@@@BABAB@B@@ABA@@@AB@B@@@B@BB@@B@@@@A@C@@@CAA@@@@B@@@D@B@@@BA@ADC@C@@@@B@@@B@@@BABABG@@BAB@BA@@@@B@@B@@@BB@@@@@@@@A@@B@@@@@@@B@@BBAD@BA@@@AB@B@BCBA@@B@BAD@@@B@@A@C@A@@@@DE@@AAB@@AB@B@@@@@AB@@B@BB@@B@@@@@BA@AB@@@B@@@@B@@@B@@BB@@BB@@@@@@@AB@BB@A@@@@AA@A@A@@@AB@@@BB@@B@@@BA@@@A@A@@B@@@B@@A@@B@BB@BB@@BB@@@@@BABADAFAHABAD@@@BAB@@@@@B@@@DD@B@@BDB@@@@AB@@A@A@AAC@@@A@@@AB@@BDB@@B@B@@@@BB@@D@@A@@BB@@@@@@B@@@@B@ABB@@@BBB@@@BA@@@@@BB@@@@@BABADA@@@AB@BA@@B@DB@ABA@@B@@CBA@AB@@@BBB@@ABA@@@@B@B@BB@@BA@@@A@AB@BC@A@@B@@@@@@B@@B@B@@ABA@@@@BB@@@@BA@@B@@A@@@@BA@@BA@@@A@@B@B@B@@@B@@@@A@@B@BABABA@AB@@@@@B@B@D@@@DB@@B@@A@@AA@A@AB@@@B@@@BB@AB@BCDAB@@A@A@@BA@@B@@@@@B@@@@B@BBB@@BB@@@A@@@A@AAA@@B@@@@@BD@@@@B@@CBAAAAAB@AC@@B@@@B@@B@@@@BA@@@@@A@@@@@AB@@A@@A@@A@@B@@A@A@@A@@@@@BA@@AA@@AA@@@@B@@@B@B@@@@@@@AA@@A@BA@A@@@@@@B@@B@@BA@@@@@@@A@@@@CA@@DA@@AC@C@@AA@@AB@@A@@@AA@@A@A@C@@@A@@@BC@@@A@A@@B@@@B@@@B@@@@AD@@@@@@@@A@@@A@@@@@A@@A@@A@@@@A@AB@@@@@A@@@@A@@@A@@@A@@A@A@@@AB@@A@@@A@A@AA@@@@@A@CBCB@@A@@@@AB@@A@@@AA@A@@A@@@@A@@A@@@A@@@@A@@AA@CA@@@C@A@A@@@AA@@@@@A@@C@@@@A@CD@@@@AA@AB@@AA@A@@@@@@@@@@A@@@@AB@@@@@AA@B@@@@AB@@A@@@@@ACA@@A@@A@A@@A@A@A@AB@@@@AA@@A@@@@@@@A@@@@@AA@@A@A@@@@AA@@@@@@@@BA@@@A@ABAAC@@@@@A@@AA@@@@@@B@@AB@@@AAA@AA@@BA@@B@@@@ABA@A@C@@@@@@@@A@@@@A@CDAB@@@@AA@@@@A@@A@@@ACA@A@ACA@@@A@@@A@@@A@BA@@@@@@@@@A@@@@@@@A@@@@@@A@@@@@AB@@@AA@@@@@@CC@AAA@@A@AA@@@@BA@AA@@CAAAAAAA@@A@@CBC@E@@A@@@C@@@ACA@A@@@@@A@@@A@@@AAA@@AAA@@AA@@@@A@AAAA@AA@@@AB@@@@AAA@A@C@C@@A@AAA@A@E@@@A@@@A@@@E@@B@@@@AB@@@@@@@BA@@@A@@@@@AB@@@@@@@@AA@@@AAAAC@@A@@@A@@@A@@AAAA@@@A@@@A@@@@@AAA@@@@@A@@A@ABA@@AA@@AA@@CAAAC@@A@A@@AA@A@AC@@ABC@@@@@AAA@A@@@C@A@@A@AA@@@@@A@@AAAAAC@@@A@@A@@A@ACC@@A@@@A@AB@@@@A@A@@@@@CDAB@@AA@AABA@@@@A@@@A@@A@@@AA@@A@AA@B@AA@@@AAA@A@@@@@@A@A@@A@@BA@@BA@A@@C@A@@@A@@@A@@A@AB@@AD@@@B@@@@AA@A@ABA@@@@CA@@@B@@A@A@A@@@AAA@C@@@@AA@A@@@@@@A@@A@C@@AC@A@AAA@@@A@@@@@@A@A@AAAA@@AA@@AA@@A@A@@A@@AC@@@A@@A@A@@A@AA@@@@@A@@@@@A@@A@AB@@@@BA@AA@@A@A@@@A@A@@@AA@@@@@@@A@@@@@AB@@@@@@@A@@AA@A@@A@@AA@A@AE@C@@@@A@@@@@@A@@B@@A@@@A@A@@@@@A@A@@@A@@@AB@DCFC@@BAB@@A@@A@@@A@A@@@A@CAA@@AA@C@A@@@@@@A@@@@@@A@CE@@@A@@@@AA@@@@ABABAA@BC@@@@@@@@A@A@@@A@A@A@AAA@AA@@ACA@@A@AAAAA@AAAAAA@A@@AAAA@AAA@@@AA@@A@A@@BA@@DA@@B@@@@@@AAB@@AAA@@A@C@@@A@@@@BAB@@CB@@@BABBBB@@B@@@BA@@@@A@@@A@@@BA@A@A@A@@@A@@AA@ABA@AB@@ABA@A@A@A@@AAB@@AA@@@@ABABA@AA@@AAA@A@@BABAD@B@BBD@@@D@F@BA@@BB@@B@BA@@@ABA@A@@@A@@@AA@AA@AAA@@AAAAA@A@ABA@@@AAA@@@@@AB@@AA@@AA@@@AAAAE@@AA@@AAA@G@A@B@@@BAB@@@B@@AB@@AB@@@B@@@@@@@@@@A@A@@BAB@DC@A@@BA@A@AB@@ABA@A@@B@@ABBB@@@B@@@@ABA@A@AB@@ADC@A@@@A@@@@BA@@@AB@@@@A@A@@@@BA@@BB@@B@BAB@@@B@B@@BB@@@B@@@@A@@BAB@BABAB@@A@@@A@A@@@AB@@@BA@@ACB@@@BBB@@BBB@@@B@@@BB@B@@@BB@@@@@BB@@B@@@@B@DAD@B@@@B@@@@@@A@@B@BABA@B@@B@@@B@@@BA@A@@@CB@@@B@@B@B@@B@@AB@B@B@@ABADC@@B@B@D@@ADABA@ADAD@B@@@B@B@@@@AB@@AB@@A@ABADAB@B@BBBB@@BB@@@B@@@DB@@B@@BBBABB@@BBB@@D@B@B@B@@A@@B@@BB@B@@@B@@B@@@@BB@B@@@BC@@HEB@@@B@BBBFBBB@@@B@BA@@BA@@BA@A@ABA@AB@BA@@B@@AB@BBD@BD@BBB@@@@BAB@DC@@@@BBB@@DB@B@DDF@@@B@BAB@BA@@B@B@B@@AB@@ADA@ADABA@ABA@AB@@AB@BA@AB@@BBB@@BBB@@@B@@@@AB@BCB@@ABC@@AC@CBC@A@A@A@@@@@A@A@@B@B@B@B@F@B@H@@@BB@@B@@AB@@@@@DB@@BABB@ABC@@@@BAB@B@@@B@@@B@@@@@BA@@@A@CBA@@D@B@B@@DB@@BB@@@@@@@BA@AB@DABA@@D@BBBBBB@@@@B@@ADC@@BA@@@@BBBBB@@AB@@AB@@@@C@E@@BA@@AABA@@@@BBB@BAB@@AB@@CB@AA@AB@@@DA@AB@BADAB@B@B@B@B@D@@@@@BB@@BBBB@@@BB@BBBBB@@@CD@BAB@@BBB@@BBBB@@BABAD@@@B@@AB@BB@BD@BBBB@B@@@D@BA@ABA@A@A@AB@B@HA@@F@B@DB@ABB@@BADC@@BA@@@@BA@A@ABAB@@AB@@AB@@@@AAA@@@@BABA@A@@A@@AE@@A@@@@@@@ABADA@@B@@@@A@@@A@A@A@@@@BAB@@@BB@@BB@B@BBBB@@@BADCB@B@B@@@B@@@D@B@BA@@BA@@@@@A@@@AB@B@@@@@@B@B@@@BB@@@B@BA@AB@@AAA@@@@@A@@@@@@A@@BAA@@@@B@@ABAB@@A@@@@B@@@DBB@B@@B@@@@@BA@@@@BB@@@@B@@@B@@FBBBB@BB@@@@@@BB@@B@BBB@B@@@B@@@BCB@@ABAB@@@@@@AB@@@@A@@@A@A@@AA@@@C@A@@B@B@B@@B@@B@B@BBB@@AB@@@B@@@@AB@@A@A@@@A@@@ABA@@BC@@@ABBB@@@@@BBBB@@@BBB@B@BB@@BD@@@@@B@B@DB@@B@@@@ABABCB@BA@@BB@B@BA@@BBA@@BB@BBB@@@@B@BAB@DA@@@@@@@@@BC@AB@@B@@BBAD@@@D@@@BA@@@@BABA@@B@@@B@B@B@B@@BB@@@BBBBBD@B@@B@@A@@DA@@B@BA@@D@@ABAB@BA@@BABC@BB@B@@@B@BB@@B@B@BBB@@@@@@BA@@@A@@@@@BB@@@@@B@@@BBABBBABAD@@@BAB@BA@@B@BA@@BA@@@@@A@@BA@@@@BA@@@A@@BAB@@@@A@@@@GAAAAAB@B@@A@@@A@@@A@@@@@@@A@@@A@@@@@@BA@@@AB@@@@AB@@@@@B@@AB@@@@@@@@A@@@A@@@@A@@A@AB@@A@A@@@@@A@@A@A@BA@@B@@A@@@@@A@@@A@@@A@A@A@@@@@CB@@C@@B@@A@@@A@A@AAC@A@@AAB@BAB@@@@A@@DA@@@A@A@A@C@@@A@@@AA@A@@@A@AAA@AA@AAA@A@A@AAABAA@BA@@@C@@@A@@BA@A@@@@@A@A@@@AB@@@@A@A@@@@BA@@@A@@@@@A@A@@@A@@@@@A@A@A@@@@@AAA@A@@@AB@@A@@B@@AA@@@BAB@@A@AB@@ABA@A@@AA@@CAAA@@A@@@@BAB@@@B@@@@AB@B@B@D@B@@CB@@CB@A@@@@@C@@@A@@@A@A@A@A@@@A@A@@A@@A@A@@@@@A@@BA@A@@AA@@BA@@@@B@BABA@@@AAA@ABA@A@A@C@@BADA@ABA@@@AA@@@A@@A@@AA@@@@C@@@AA@@@A@A@@A@@@C@@@A@@@AA@@@AA@A@@@@A@@@AA@AA@@@@@A@A@@BA@@@AC@@A@AB@@@@A@@@ABA@A@A@C@A@@AAAAC@AAA@@B@@AD@DAB@B@BAAA@AA@@@@AA@@A@@A@@@@@AAA@@AA@@@@AA@@CAAAAA@@A@@@@AA@BAA@A@AA@@A@@AA@@@@@@A@@@@@A@A@@@@@A@@@A@CAA@@AAA@AAA@A@A@ABABCFAB@@CA@@A@@A@@@AE@AAA@@@A@@@@@@A@A@@A@@@A@AACC@ACAAAA@AA@@@A@@BC@A@A@C@AA@A@A@@BA@@@A@A@@A@ABAB@@A@A@@AAAA@AAA@AA@@@E@A@E@@@@@@@AB@@@B@@@@@@@@AAAAA@A@AB@BAB@@CBA@AA@ACCAA@EAA@AAA@A@@A@A@A@@@@@A@AB@B@B@@@BA@@BAB@@@@AA@@@@A@A@@@ABABA@A@A@A@AA@@AA@@A@@BA@@B@BAD@BA@@BCBABA@A@@@@BA@@BA@@@@D@@CB@BA@@@@@A@A@@@AAC@@@@A@@@AAA@A@@A@A@CBC@A@@BAB@BABCBCBA@C@@@A@A@AAA@A@A@ABA@@@A@AA@@C@@BABAB@@@@@@AA@@AA@A@A@A@@CE@@@@A@A@@@@A@C@@AA@@@@A@@@CBA@A@@A@@@@AAA@@AA@@CCA@A@C@@A@C@C@@AA@A@@@AAAA@AAACAAAA@@@AAA@CA@AAA@@A@@@A@CB@@AAAAC@@@A@CBA@CA@@ABA@@BA@@@@AA@@@A@A@@@A@AAC@@A@@@@AC@@@@AAE@A@CAA@AAAA@@@@ABAA@@A@AA@AAA@@@@ABA@CDAB@BAD@@@@AAAACC@@@@A@@@@A@@ACA@@AA@@@@@A@A@@AA@@@ABAB@@E@A@A@A@@@@A@A@A@ABA@@@C@@AAA@A@@@A@A@AAAAA@@@A@@@A@@AA@@@A@A@A@CB@@A@A@A@@@AAAC@@AA@@ABAB@BA@@AA@A@@@@A@CAE@A@A@A@@AA@A@AAA@EA@CBABA@AA@@ABAB@@@B@@CAA@@@AAA@@A@CA@@AA@C@E@C@A@@BA@AB@@AA@@A@@@C@ABAB@@A@@@ABA@CB@@@@AA@@AB@BC@ABA@@B@@@B@@@B@@AB@@A@@B@B@@CB@@AAC@@@A@@BAB@@A@@BC@A@C@AA@@AAAA@@@@A@AB@@A@A@@@ABADABA@@@A@@AA@AB@@AB@@A@AA@@@@CB@@ACAAAAAAAAAA@@A@@A@@@AAAAC@A@@A@@AA@ADCBA@A@A@@@@A@AAA@CA@AA@@@@AA@@AB@@AF@B@BA@CB@BAB@@A@ABAB@@@@C@A@A@@@A@A@ABABAB@BABABA@@@A@@B@@A@@@A@ADABCB@@@@@@AC@AA@@@A@@@ABA@@B@@@BA@A@@@@@AAA@@A@AA@@AA@AA@@AA@AAA@@A@A@ACCCA@A@A@A@A@@@A@AB@@A@@@@@@AA@A@@@A@A@@@ABAA@BABA@@DA@@@@AA@A@@@CAAAC@@AA@A@@@AB@@ACA@CA@@A@A@C@A@@@AB@@AB@@BB@@AB@@A@@@ABA@@@CB@@@@@D@@AB@@AB@@@BC@CD@BA@@@CB@@@A@@A@@@A@@@ABA@CB@@ADCBABA@@@AB@@@BADA@@@CC@@CA@@AB@@C@@@AB@@ABA@A@C@@@@@@BA@@@A@C@@@@@@BA@@B@@AD@BAB@BADAH@@@BAD@B@@@BA@A@@@ABAB@@@@@B@@AB@@AB@@A@@@A@@@A@@BAB@@A@@B@@BDBB@@@D@BA@@BA@AB@@@B@BAD@@@@DD@@@FAB@@@BBB@@@@B@B@@@@@@@BB@BBB@@@FAD@@@@@@@DAD@BAB@@@@BB@@ADA@@B@BBB@D@B@BA@BB@B@@@B@B@B@BB@BD@@@B@@ABAB@@@B@@@B@@ABC@@@AB@@@DAB@B@@A@A@@B@B@@@B@B@B@D@BBB@B@D@B@@AD@@@B@BAFA@A@A@@@CB@D@@@B@B@@@B@@BF@BCDA@@@A@ABA@@@AA@@A@@@AD@@@B@B@B@@A@@B@@@@AB@@A@@@@@@BABAD@BA@ADABA@AB@@@BA@@BA@@@@BAB@B@B@BBDBB@@@@AF@@AB@B@DA@@B@B@D@D@BB@BB@@@BBD@@@BAB@B@B@BB@@B@@A@@B@@AB@@AB@@"],encodeOffsets:[[112159,37253]]}},{type:"Feature",id:"610621",properties:{name:"延长县",cp:[110.012334,36.579313],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@AA@@@A@@@A@@@@@A@@A@@@@A@@@A@@@@B@@@@B@@@AB@@@B@@@@@@A@AB@@AB@BAA@@@@@@@A@@@@@@@@AB@BB@@@B@@@@@@B@@@BC@@@@A@@@@@A@@@@@A@@@BC@@@@A@AA@@@AAAA@@A@@A@@A@@B@B@B@B@@A@@@@@@@A@@AAB@AA@AA@@@@A@@@AAA@CAA@@AAA@@@@@@@A@@@C@@@@@AB@@AAA@@@@A@@@A@@@AA@A@A@@A@B@@@AA@@B@@@D@B@B@B@@CB@@@B@@A@ABA@BB@@@BBD@B@B@B@@@BA@@@@DA@@D@@@@@B@B@B@@@B@@@B@@@@@BA@@@ABB@B@@@@B@@@@@B@BA@@B@BB@@@@@@B@@@B@@@@@@A@@BA@@@@@A@@B@@A@@B@@AB@@@@@@@B@@@B@@@@@@@B@@@B@@@BA@A@BBBBHB@@@@@B@@A@AB@@@B@@AB@@@@AB@@@B@@@@AB@@ABA@@@ABA@AB@@C@ABABAAAB@A@@@A@@@@AA@@@@B@@@B@@A@@@@A@AAA@A@@@AAA@@@A@A@@AADAB@@ABA@AB@BC@@@ABA@@@CB@@@BA@@@@AACAAAA@@A@@AA@A@A@A@@@A@@@ABAB@@@@AB@@C@@@C@AB@AA@@@BAD@@A@@@@@@@@CBA@ABA@@@@@AA@AAA@@AB@AB@@A@AAA@@ABA@ADAB@B@@A@@@CAA@A@@@@@C@@AA@@A@AAA@A@@AAAA@@@@A@AA@B@@AD@@AB@B@@@B@@@B@BA@@B@@A@@@A@@BA@AAA@A@@@@AA@A@A@@@@B@D@@BB@@@B@B@@@B@@A@@B@@@@A@AB@BA@AD@@A@@@A@A@AAA@@@AA@@@@@@AAA@AAEA@@@A@@@A@@A@@A@@B@@A@@@@@AA@A@CA@@A@@@@@@BA@AB@BA@@@@@BB@AB@@@@@@@@B@@@@BB@BA@@BABA@@@A@@A@@@A@A@@@@A@A@@B@@@B@@@@AB@@ABA@C@@@A@@@A@A@A@CDAB@@A@AA@A@AAA@@AA@@A@AB@@@@@B@B@B@@@B@@A@@@CBAB@B@@@@@@@BF@@BB@@@@BABAB@@@@BB@B@@A@@BA@@BA@AB@B@BAB@@@@AB@@CDAB@@AA@BCAA@E@@@GBA@A@@B@B@BAB@BABC@@@A@A@AA@AACA@@ABA@@@A@@BCBA@AA@AA@AA@AA@@BA@ADC@@A@AAAAA@@A@@AAAA@@AA@@@@C@A@A@A@A@A@CBABA@@BCB@@A@@BBBA@@DA@@BA@ABA@AA@@@@ABBB@@AB@@@F@D@@A@@BA@@BA@AAAA@@@@AB@@CD@BA@@@@@AAAAAAC@@@ABCBA@@BAB@@@@@@A@@AA@@CA@A@C@@@AB@D@B@@AB@@@@A@@@A@@@A@A@AB@@@@AD@BAAAB@@CA@@@@A@@BA@@@AA@@G@A@E@A@A@A@A@@@@B@B@@@@@B@B@BAD@DBD@@AD@BA@ADA@@B@@A@@@A@AA@@AA@AA@@BABA@@BA@@BAB@BABCB@BCB@BA@@BA@A@A@@@ABA@ABA@@@E@CCA@A@@CA@AA@@@@CDA@AB@@@@AA@AACC@AAA@@BA@@@ABA@@BAB@B@BAB@@AB@@ABA@@@A@AAAEAAA@@@A@GF@@AD@@A@A@@A@@A@@@@A@@@AAA@@@AB@@@@A@A@A@CA@AA@@AAABAA@@@AA@@C@@@A@@AA@@AAAAA@A@CBAB@B@BA@@BA@@B@@A@A@@@A@C@CB@BABCB@BC@A@A@@@CDAB@BA@A@A@@BA@@@@A@AA@@@A@@D@@@BAB@@A@@@A@@@@AABABA@@@@B@@@@A@@@A@C@CBA@@@@@@AA@@A@@@@AA@@A@A@@A@@@A@@AA@AA@AA@@A@BD@@AB@@A@@B@@@B@B@@@BA@ABABA@AB@@@B@@A@@@A@@AA@A@@@A@ABA@@@AA@@AB@@@@@B@B@@A@@B@@AB@@@@@B@@@BCD@BA@@B@BAB@B@@A@@@A@AA@BA@@@@BAB@BA@@B@BAB@@@BCDA@AB@@@B@B@@@@@@@@A@@@A@@BA@@BA@@@A@AB@@A@B@H@B@BB@@BB@@BFBB@B@@BB@@BB@@BA@@@@B@BB@@B@BAB@B@BBBB@@BB@BBBB@@B@@@B@@@BAB@B@@ABA@@@AA@@ABE@C@@@C@AAA@C@ABAB@@@BBB@BB@@BABAB@B@@B@@BA@BB@@@B@B@BAB@BA@@BAB@BBB@@@B@@@B@B@BAB@@B@@@B@@@@@AB@@A@@@AAAAAB@@A@@DA@AB@@@@@B@@@D@BB@BB@@BA@B@@@@A@@@CB@@AB@@@B@BB@@B@@BB@BBBBB@@@BBBBBBBB@BBBBB@@@DB@BB@@BBB@B@B@B@B@@@B@B@@@@@@D@@ABBBABA@@@@BB@@@@@B@@DFB@@@@@@@@B@@@@B@D@B@@BB@DBB@@@B@B@@@B@@@@BA@AB@@EDCDA@@B@@@B@@@B@B@@@@@B@B@@@BA@@@@B@@@@B@@@@@@DBFB@B@@BB@@@@BBB@@@B@@@@@@BA@@@@B@@@@@@@B@@B@@@B@B@@@B@BB@@BAB@@@@BAB@@@@B@@@@@B@@@@BBB@@@@B@BB@@@D@@BB@@@@B@BB@@BB@@BB@BB@B@B@B@@@@B@@@B@BBB@D@@BD@B@@@@B@@@@B@B@@B@@D@B@BB@@B@B@B@@@@A@@DB@@@@AB@B@BBB@@@@@A@@BC@@BAB@@@@B@@@B@@@B@DB@B@@AB@@AB@@@@B@B@@@@B@B@BB@@B@@B@ABBB@@@BB@@B@@@@B@@@B@@B@BA@BBB@@BADC@@@@B@B@@@@@BAB@@@B@@@DD@B@BB@@@@B@@BDBBBB@@@B@@@@BBB@@@@B@D@@@BBB@B@@@@AD@BD@@B@BBB@@@B@BD@BBDB@@BB@@BB@@AB@B@BB@@@@@B@BB@@@@B@@@B@@@B@BB@BB@@@B@@@B@@@BDBB@B@@BB@@@@@@@@BA@@@@B@@@B@@A@@@@@@BA@@@@@AF@@@B@@@B@@@F@B@B@BBB@@@@D@D@BBB@B@@A@@B@@BBB@BB@B@B@@B@@BB@BB@@BB@B@@@B@@@B@@@@@BDB@B@@@D@@@BF@D@DA@@@BB@BBBBBB@DB@@BAB@@@@BBB@@@BB@BDD@@@@@@BB@@A@@B@@@@@B@@@@B@@@@@@@B@@@@@@@@@B@@A@B@@@B@@@B@@DB@B@BDB@B@@@BB@@@@@BB@@@@BADCB@@@@@@B@@@@@@D@B@B@BA@@@@@AB@@AB@@BBB@B@@BA@@@A@@@@B@@BB@@@@@D@BBBAB@@@B@@A@@@@@@B@@B@@B@B@@@BB@@@@B@@@@@@@B@@@BB@@@@BAB@B@B@@@@B@BB@@@DB@B@@@@@BA@@B@@A@B@@B@@@@BA@@@@@B@@@@@@@@B@B@@BA@@BBB@@@@DCB@@@@@@DB@@@@@B@@B@@@B@B@D@@DBB@@BB@@@@@@B@@@BB@@@@@@BB@B@@B@@@BA@@B@@B@@@DADAB@@@@@B@@B@B@B@@@BA@@B@@@B@BB@@@B@@@B@@@@@@B@@@@BAB@@@@@@BB@@@@B@@@@@B@@@B@@@@@@C@@B@@A@@@A@@@A@@@@B@B@@AD@@B@@@D@B@B@@@BB@@B@@@BA@@BB@@@DBD@@@@BAB@@@DAB@@BB@@@@BBA@@@@@@BB@@@@B@@A@@B@B@D@B@@AB@BA@A@@B@B@@@B@BAD@@@@@@CAAB@@@B@@A@@@AA@@@@A@A@@@AD@@@@AC@@@@AB@@@@@B@BB@@@@B@AA@@@A@A@@BAA@@@@@@@@A@@@@A@@A@@@@@@B@@A@@@AB@B@@A@@B@@@@AAA@@@AB@@@@AB@@@@@@@BA@@@ABA@@AAB@@A@@AC@A@A@@@@B@B@BBB@@@B@DAB@@BBBB@@@B@B@B@@@B@@BB@@@@B@@B@B@BB@@B@@B@AD@@@B@BB@@@@DCB@@ABAB@@@BBAD@B@@B@@@BABA@@BA@@B@@BBBB@B@@@@@@A@@B@@@@@@A@@B@@@@@@B@@@BAB@B@@@@B@@AB@@@DB@@@B@AB@@@DA@@BAB@@@@@DDBD@@BA@@B@@B@@@@AB@@A@@B@@@B@@@@B@@AB@B@B@D@BB@A@@BA@@BB@@@@CD@@@@@@B@B@@@@@@@ABABA@AB@B@B@@@B@@BA@@@@@BB@@ABA@@B@@B@@@DAB@B@@B@@BD@@@B@@@@BB@BB@@@@BB@@BBD@BB@@@B@@@@@B@BBB@@@@B@@@@@B@BB@@B@@@B@B@@A@ABABA@AB@D@DAB@BCFCBABBB@B@B@B@@AB@BBB@BD@@B@BC@A@AB@@AB@@A@@@AB@@A@@@@@A@@@@B@@@BA@@BAB@@ABABAB@DAB@@A@@@@A@EA@@@@BAB@BBD@B@@@@@@@AAA@@A@@@A@@B@B@BA@@B@@@@@D@B@B@@@@AB@@@B@B@B@@B@@@@D@B@@@@B@B@@@B@@@@A@@@BBBB@@BB@@@@B@B@B@BBBBB@@@BA@@@@@ABA@A@@@B@@@@@B@@B@@AB@BCBA@AB@BAF@@@@@B@@A@@@AAA@@@@B@@@@@@A@@@A@@B@BBB@@@BA@@C@@A@@BAB@@B@@@BB@@@@BAB@@@BB@B@@AB@@AA@@A@@@A@@B@@BB@@A@@@A@@@AH@B@@@@@@BCB@@@B@BBB@@B@B@@@@@@C@A@A@@@A@@BB@@B@@@@@B@@@AA@A@@@A@@A@ABC@@@A@AA@A@CAA@@@A@@D@@BB@BD@@BABA@A@AAABAA@@@@A@@@ABABA@@BB@B@@@@B@@@B@@@B@DABAB@D@D@B@@@@@B@@@BB@B@@DAFBB@BADA@@BA@AA@B@@@@A@A@A@ABA@@@@DBB@BBB@@DBB@B@@BA@ABE@@@@B@BB@@@@DADAB@@AB@@@AA@@B@B@@@@@@@@AA@@@@@@A@@@AB@@BB@@@BBBA@@@@@A@@@AB@D@@@@@BD@@@@B@@@B@@B@@BB@@BA@@B@@A@@@A@AB@@@B@@BBB@@AB@@@B@@D@@@@BA@@@DBB@B@@@@@BA@AB@@AB@D@BADAB@DA@@@@@B@@@@@B@BAB@@B@@@B@B@@@B@BABBBA@@@@BA@AB@@@@@@@BB@@@@B@@@@@BA@@@@B@B@AB@@BBB@B@@@B@BB@@@B@BB@@B@@@@CBAB@B@@@@@BDA@@BB@@B@@A@@A@@AB@B@B@@BB@@B@@B@@@B@@@@B@@@BBB@@@BB@DBD@@@@@B@@A@@B@FBB@@@B@@@BA@B@@AB@@@@@@AD@@@BD@B@B@@@@A@ABA@@B@B@@@@B@@BBDADAB@BA@@@@AA@@@@BC@A@A@@@A@A@@@@@AB@A@@@@A@@@@@A@A@@@@B@@@@A@@BA@@A@BAA@@@@A@@AC@@@A@@@A@@@@BA@A@@@A@A@A@A@A@@@A@@@AB@A@@A@@B@@AA@@@B@@AA@@@B@@@@@BA@@@ABA@ABA@@@@BA@@B@@@@@@AB@B@B@@@DA@@B@@AB@@@@@B@@@BA@@@@@@A@@@DA@AB@@ABAB@AAB@@A@@B@@@@A@A@@BA@A@@@@@@@A@AB@@A@@B@@A@@B@B@@@B@B@@ABAB@BA@@BA@@B@@@B@B@BCB@@A@A@@BA@AB@@ABAB@@A@@@@@AB@@ABAB@@AB@@@B@@A@@@@@A@A@@@A@@@@@A@@@AAA@@@@B@@A@@@@@AB@@@@AB@@@@@@@B@@AB@@@@AB@@B@DDD@@B@@@BA@A@@B@@@@ABA@@BA@@AA@AAC@@@@AA@A@@@@BA@@@BB@@BB@@BBBB@@B@@BD@@@@@@@@BA@A@AB@@@B@@@@BBBBBBB@@@@B@DAB@BAB@@A@@B@@A@@@A@@@@@A@@@A@@@A@@@A@@@A@@@A@@BA@A@@@A@@@@@A@A@@@A@@@A@@B@@@@@@@B@@@BA@@@ABB@A@@B@@AB@@@B@B@@@@B@@B@@@B@@@@BB@@B@@@@@B@BBD@BBB@@BBBD@@FBB@@@B@B@@BB@BADCBAB@@@B@@@@@@@BB@@@BB@@@@@B@BB@@B@@@B@@BB@@@B@@@B@@@@B@@@@B@@B@@@@@@@@B@@B@@B@BB@@BB@@BB@@BBBB@@BB@BB@BB@@@BBB@@@@B@@@@@@BB@B@@@BBBAB@B@@@BBD@B@B@D@B@B@B@B@B@B@@@DB@@B@@@B@@@@@B@B@@@@A@@B@B@@@B@@@@ABA@@@AB@@BB@@AB@B@@@D@B@@@B@@B@@@@@@@BA@BB@@BB@@@@BAB@@@@BB@D@@@B@@@BC@@BAB@B@B@B@@@D@DB@A@@A@@@@@@A@@@@@@@@BAA@@@@@@A@@@AA@@@@@@AA@@@@@@@DC@@@A@ABA@ADA@A@C@AB@@ADA@@DDBB@BB@@B@B@B@@BBFDBDBDB@@@@@@@BA@@@B@@@@B@B@@@@@B@@BB@@A@@@@@BBA@BB@B@BB@@@@BBD@HMDIR[LODCRQLKBADCFCVKHAHCDADIACAGGEEOAI@EBEDALM@ADCDEBKAS@K@ABEBIAU@CBKAGCIIKMIUOEGCE@IBEFKHEHEBCHCDE@G@C@A@CCM@ACKAI@A@C@EAEEGGMCECCACECAAAAAC@C@GBM@E@@@CCCEIA@CEACAE@GBGDGFILIBABEBA@CCEGIAE@C@A@A@@@@@@A@A@@@@@@B@@@BA@@@A@@@AC@@@@@@A@@B@@@BAA@@A@ABA@@@@@A@@C@@B@@@AA@@AAA@A@@@@@@B@@BB@@@@A@@@@B@@@@C@@BA@A@@BA@@@AA@@@@AB@BA@@@@@@AA@AA@@@@B@BA@@@A@@@@A@@B@@A@ABA@@ACA@@A@@@@@ABA@@B@@A@@@@A@@@@@@@AA@A@@@C@A@@BA@@@A@@@A@@@A@A@@@A@@@@@@A@@@@@@AA@@AA@A@@A@@@A@@B@@CAA@A@A@@@ABA@A@@@A@AA@@A@@@AB@BA@@@@@@@@C@A@@A@AA@@@@@A@@@@A@AB@@@@@@AA@A@A@@@@@A@@A@@@A@@B@@A@@B@@A@@@A@AA@A@@@AA@@@@@CB@@@@AA@@AA@@ABA@@BA@@AA@A@@@A@A@@A@@AB@@@A@@@@A@A@@@ABAAA@A@A@A@@@A@A@A@@@@@A@@AA@@A@A@@@@AA@@AAA@AA@A@@@AA@@@@AAB@@@B@@A@@@@@A@@BA@AB@@@@A@@@A@@BB@@B@@AB@BA@ABA@@B@@@@@@A@A@@@AB@@A@AB@@@DAB@@@@AD@@AB@@A@AAA@@@@@@AA@ABA@ABA@AB@@@B@B@@ABA@ABA@@@A@C@@@A@@B@@A@A@A@@@@A@@A@@@@BA@@BCBCB@@@B@@ABAB@@@BAB@@A@@B@@@@@DAB@BA@@B@@ABA@@A@@AA@@A@@@ABA@@@CB@@@@ABA@A@@@ABABA@ABCB@@A@@@@@AB@BB@AB@BAB@@@BA@A@@@A@@@C@@BA@@@AB@@@@ABC@AB@@AAA@@@CBABABCBA@CBA@A@CB@@ABCBCB@@A@A@A@@@A@@B@@@BA@@BABABABC@@@@@A@@B@@ABA@@@ABC@A@CAA@@A@B@@A@@@@AAAB@@@AA@@E@A@A@AA@BA@AB@@A@C@AB@@AAA@ABAAA@@@@@A@@@ABABABA@@@A@A@@@@@@BA@@@@@@A@@@A@@@@@A@AB@AA@@BAB@@@@@A@@@@AA@C@@@EBABAB@@A@@AC@@AA@A@@B@@A@@@A@@@@@@@@@A@@@A@@B@@AB@@A@A@@@@@AAAAA@AAA@@@@BA@ABAB@BA@A@@@@B@@A@@@AB@@@B@@@@AB@@A@A@@BAB@@@@@@AB@@A@A@@@@@A@AB@@A@@@C@@A@@A@@A@@@@A@A@A@A@@@A@@@A@@@@@ABABA@C@@@ADAB@BAD@@AB@@@BAB@BCB@@ABA@A@ABABAB@@ABAB@@B@@BA@ABAB@@@@@BA@AD@BAD@@AB@B@@@B@@BB@B@@@@@@A@@@@BBHAB@BAB@BAD@@@@@BCBB@A@@BA@@BAD@@@BAB@@@B@@@@AB@B@@@@B@AB@@CBA@AA@@AAA@@@AA@@AAAA@AA@AACA@@@@AD@BAB@@AA@@A@@@A@@@C@A@EBA@@@A@@@A@@@A@@@@AA@@@AA@@@A@@@@AA@@@@AA@@"],["@@ABCF@DAF@@@BB@DCBAFE@@ACACA@@@"],["@@B@@AAGAI@AA@E@A@@@@FBHBBFFB@@@"]],encodeOffsets:[[[112753,37268]],[[112768,37251]],[[112767,37248]]]}},{type:"Feature",id:"610623",properties:{name:"子长县",cp:[109.675264,37.142535],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@B@@@BA@@BAB@B@@A@@@ED@B@B@@@B@B@B@@@BABAD@B@B@@@BDBBBB@@BBBBD@@@@DBBBD@@BD@@B@@BB@@BD@B@F@@@B@@@@@B@B@@@B@B@@@BB@@B@@@B@@B@B@@A@@BA@@FG@ABA@A@A@A@C@A@A@A@A@A@A@@BAB@@A@A@@@E@@@@DCDCBAB@FABAB@B@F@DB@AB@BABCB@BAB@BA@BB@D@B@DCDABABA@ABA@@AA@A@@@A@A@@@A@A@@AABA@@AA@A@A@@@@B@@@B@BB@BBB@BBBBB@B@BBB@@BAB@D@B@HAB@F@@@B@@BDDBB@@BBDBB@@BB@@@B@@@@C@@B@@A@A@ABA@ABA@@@A@@ACAA@@B@B@BAD@D@DBB@@@DAB@@@BABAB@@A@@B@@@@B@@@@@B@@BB@@@BDBDBBBBBB@@@B@FBBB@@B@@@AACAA@AAC@@A@@@A@@D@B@@ABA@@D@B@DBBBB@B@@A@@BC@AB@@@D@B@BABA@@BADA@AAA@A@@B@BA@@@@@@@AAA@@@@AA@@@A@@@@@AAB@A@@BAA@@@@@BA@@@@A@@A@@@@@@@AB@B@BAB@BB@@BBB@DA@@@@D@BA@@@@AAA@AA@@@@B@BAB@@@BBB@B@@A@A@@AA@@A@@@AB@@CBC@ABA@AB@@@@AA@C@A@@@A@@D@B@B@@E@@AAA@A@A@@BA@@@@AA@AA@AA@@@AA@A@@A@@A@@A@@B@@@@@A@A@AA@@CA@@CAAC@AAA@@@AAAA@@@@@A@@@AB@@@@@AA@@@AF@BAB@@@@A@@C@A@@@@@@A@AAAA@C@@@@A@@BA@A@C@AAC@A@A@@BAA@AA@@@@A@@@@B@@@@AB@AC@A@@@@A@C@AA@AA@ACAA@A@ABA@@@AAAAA@C@AAABA@CDA@A@AB@@@B@@@@@B@BB@@@@@@BA@AA@@@@@A@A@@A@@B@@A@A@A@@BADAB@BA@@@A@@BA@@BAB@BABBB@@CD@B@@@@@B@@@@A@CAA@@@A@@BA@@@AAA@AAAA@@A@@BAB@@@@A@AAACAA@@@@@AB@@@BAAA@@A@A@CA@@@@B@B@@@B@@A@@A@A@B@BA@A@CBA@AD@@@BA@@BG@A@A@AAA@A@AB@BABA@@B@@@@B@@B@@@BA@AB@BB@@@BB@DA@@BC@A@A@@BA@@DB@@B@@A@A@@@@BBB@BABAB@BA@@@AA@@A@@BA@A@@BA@BB@@ABA@@@@D@@AB@@A@@@A@@@A@A@@@@A@A@@@@AB@@@BA@@B@BAA@@@ABEAA@A@A@@@A@@@@A@@@A@@B@BA@@@CA@@@@@A@A@@@A@@@@@@B@B@@A@@@A@@@@@@A@A@@@A@@A@A@ABAB@@A@CA@@A@BA@A@@AAAAA@@@A@@AA@@A@AA@A@@@BAB@@@B@@ABA@BBA@@BADEDCDA@@BA@@BAA@@AA@A@A@@@AA@@@@@A@AB@@@@A@@B@B@@@BA@@@A@@B@@A@@@@@AB@@@@A@@BA@@@@@@BA@@BA@@BABBB@BADABA@AB@@@BB@@@@BBBBBB@BBB@B@D@B@@B@BA@@BAB@B@@BB@@@@D@@@@@@@@B@@AB@@@@B@@BB@@@@@@@@AB@@B@@@@@@@A@AB@@A@@B@@B@BA@@B@@@@@AB@@@B@@@@B@@@B@@A@@@@B@@@@B@B@@@BB@@@B@@@B@@@@@B@BABA@@B@@@@@@BAB@@BBB@@@@B@B@BB@D@@AB@B@@@@@CA@@@@B@DB@@B@BABCDCBABABA@@@A@AAA@@A@@ABA@A@A@A@A@AAAAAAAC@AAA@@AB@@A@A@AAA@A@@AC@@@AB@@CAC@@@A@@@A@A@@@A@@@AAA@A@@AA@A@A@AA@@AA@@@A@@A@@A@@AA@AA@C@@@AA@@@ABA@@@@A@A@@A@C@A@AB@B@@A@@BA@A@@@A@A@A@@@CBA@@@@@@A@AAA@@@@AA@AA@@@@@@@BC@A@A@A@C@@@A@A@@BC@@DABA@A@A@@AACC@A@A@AAAAAAAAA@@@AAA@@A@A@A@@@@@@AAA@A@@@@A@A@@@@@@@A@ABAB@@AA@AA@@AA@A@@@@A@@@AAA@@A@@@CDCB@@AA@@@A@A@AAAA@A@@@CB@@A@@@A@@@@A@AAA@A@A@AA@@AA@EB@BA@@@A@A@A@ABA@AA@@A@A@@AC@@@A@AB@@A@C@AB@@AB@@C@C@AAA@@@@AA@AAA@@B@@@@ABA@@@C@@@A@AAA@A@@@BAAA@AAA@@@A@EAA@@AC@@@@@@A@@AA@@@A@@BC@@@ABA@CAA@@A@@BA@@@CBA@@@@@AA@@@@AAA@A@ECEA@@AA@A@@@A@AB@BA@@@@@AA@ACBA@@@@@@A@@@A@@AACBA@ABAD@@@BBB@B@@CB@@ABAB@@AD@@A@@@@B@B@B@@@D@@@BA@@@@@@B@@BD@BAB@B@@A@@@AAA@@AA@AB@@CAA@@@A@A@@B@@@BBDBB@B@BA@ABA@A@CAA@@@AAA@@@A@AA@@A@@@ABA@ABAB@@AB@@AA@@AA@@A@@@A@@BA@@@@@@@A@A@AA@@A@A@AB@@ABA@AB@@@B@@@BA@@BABC@A@@@@@@@@A@A@@@AA@@@A@A@@@@@A@CA@@A@@AA@A@A@@@@@@A@@BAB@B@@AB@B@BA@@@AB@A@@@@A@@@A@@@@@A@A@A@A@C@A@CA@@A@A@A@@AA@A@A@@@C@@@@BABC@@BC@@A@@AAA@@@A@@@@BC@@@AAA@@ECA@A@A@AAA@CAA@A@A@EDA@AD@@@@A@CD@@ABCA@@AB@@A@@B@B@@CB@BKF@@ABCAAB@@ADA@@BA@@@ADA@@BA@@@A@@@A@CA@@A@A@AB@AA@@@AACC@@A@@A@@A@@@A@@@A@ABCACB@@@@AB@BA@AA@@A@@B@@@B@@A@@@A@@BA@@@A@AB@@@@AD@@A@A@A@@@ABA@@BAB@B@@A@@@AA@@A@A@@B@@AB@@A@@@ABAB@@AB@@@BA@CAA@@@A@@BAB@@@@ABA@@@C@@@@@AA@@@A@ABC@@@A@AAC@@@ACA@@CA@CAA@AA@@AAA@@A@A@A@AAA@@@@@A@A@A@@@A@@@AB@@C@@@@@A@@A@AAA@@CAA@@@CBA@A@@@AAAACB@@C@A@A@ABA@@BA@@@@@C@AA@AAA@@@@A@@AA@@A@@A@A@CDA@@@AAA@A@@@A@CAA@A@CB@@A@A@A@A@AA@@AAA@@BA@ABAB@@A@A@@AAA@AA@@@CBE@CBA@AB@@@B@B@@A@C@A@@@A@@@A@@@A@AC@@A@@AA@A@ABA@A@@@@@@@AA@AAA@A@@A@AAAB@@AA@@A@@A@A@AA@A@@@CC@@@@A@A@E@AAA@@@@@A@C@A@CAAA@BA@AB@@@@A@A@@@C@AAA@AA@A@@AA@@AA@@A@AA@@CAA@@@@A@AACAAAC@AA@A@AAAA@@AA@A@@AAA@@A@@@AA@AAAAE@EAA@@BA@@BA@AAAB@@A@AD@@A@@BA@@@@B@@AD@@@B@@@B@BA@AB@@E@ABA@@BC@CAA@AD@BAB@@A@@@@@A@@B@@A@ABA@AB@@@@@B@B@BAB@B@B@DA@A@@B@BAD@@@B@@ABA@AB@@@@@B@BA@A@ABAA@@AA@A@@A@@@A@@BAB@FAB@B@BAB@@@B@@AAAA@CAA@@@@@AAA@AA@A@AA@@@@CD@@@B@BA@AB@BCBA@@B@A@@@@@AAACC@@AA@@@AA@@@@@@BA@CAA@@A@AA@AA@@@@A@@B@@AB@@A@@@@AA@A@@@A@@AA@@A@@A@@@AAA@@A@@@AA@A@@@@@A@@@A@@@@@AB@BA@@BC@CBADCD@B@@BBBD@@BB@@@@@@@@@B@@@@@BA@@@@@A@@@A@AB@@A@A@A@AA@@@@A@A@@@@@AA@@CA@@EACAC@AABA@AA@@@@@AA@@@@@A@@C@C@@@@@@A@@@A@AAA@@@@CFED@@@A@@ACA@CCACAA@@@@A@@@ABAB@@AAA@@@@BC@ABA@A@@@CDAB@@CBABA@A@ABA@AB@BA@CAA@A@AAA@A@@@AB@@A@@@AC@@A@A@@@ABBB@B@@@BAB@B@BA@AD@@AAAA@AAC@A@ABC@A@C@@A@C@@BCD@B@@AB@@AB@@AB@@ABA@@@@AAA@@@@@@@B@@@@@@@@@BB@@B@@@@ABA@@@@@AA@A@A@@@A@AA@@@AA@@A@ABABAB@@A@@@A@CAA@@@AB@@A@@B@@@B@@@B@@@@AB@@@BB@@B@@@@AD@@AB@@@BBB@@B@@D@@@@@B@@A@CAAB@@@@@B@B@@ABBBA@@@@BC@A@A@AD@@AA@@A@ABABABA@@B@B@@@B@DBB@B@@@@@@@B@@BB@@@@@@BBBB@B@@@@BB@@@@BB@BB@@B@@B@@@@BA@BB@@ABB@ABB@@@AB@BA@@BB@ABB@@BA@@B@@A@@BAB@@B@AB@@@@@@@B@@A@A@AA@@@A@@@@A@@@@@@B@@ABA@A@A@AB@@CBADC@@B@@@BBB@@@@@@@B@B@D@DBD@BBB@BB@@B@@@@@B@B@DAD@@B@@@@B@@A@A@EB@@A@A@A@@@CBA@@@@@BB@@@B@B@B@BB@BAB@@@BABBB@@@AB@BBDA@@@A@A@@@@@@B@D@@A@A@@@AB@D@@@@CB@@@BABCB@B@@@B@@@B@BA@@@ABAB@@@BA@AA@A@AA@@@@A@@@A@@@@@@@@AAABA@A@A@A@@@@@@@@B@B@@BB@@AB@@A@A@A@@@@@A@@BAB@BABA@A@@@ABA@@B@D@B@@@B@@@@@B@@AB@@AB@BCBEB@B@@@@@B@@DB@BBBB@@D@@A@@@@@@@AD@BAB@B@@A@ABA@ABA@@B@@@BEB@@AB@BABAB@B@F@B@D@B@BBBBD@@@BA@C@ABCBA@AD@@A@EBABCBAB@B@@@BA@@BA@@@CFABA@A@@BA@@BAB@@CBA@@@A@@B@@@@@@@@B@@BA@ABC@A@AD@@A@@@@@@@CBCBABA@A@A@@@AB@B@@@@@@ABA@A@A@A@@@@@@@@B@@@B@@@@A@A@@@@B@@A@@@@BC@@B@B@@@@@@@@@BCB@@AB@@B@@@@@@B@@A@@@@BAA@B@@B@@@@B@@A@@@A@@@AB@@B@@B@@@@@BA@AB@B@@@@A@@@@@AB@@@@ABA@@@@@@B@@A@@BA@@@@BA@AB@@@@A@@@@@@@A@@@@B@@@@@@CB@@A@@B@@@@A@A@@B@@A@BB@BBD@@DFBB@BB@@B@B@F@BB@@B@BAB@@@@AB@B@@@B@B@@@@@B@@@@@BA@@@@@@@A@@A@@A@AAA@AA@@AA@@@@ABC@A@A@ABC@A@C@@@A@A@C@A@@@AA@@A@C@@@@@@B@B@@@B@@@@BB@@AB@@B@@@AB@BB@@B@@A@@B@B@@A@@B@@@B@@AB@@B@ABB@@@BBAB@B@@@@@@@B@@A@@B@@B@@B@@A@@B@@@@BB@@@BA@@@A@@A@@A@A@AACAA@A@A@@@A@@AAB@AA@@@@@A@A@C@AB@@@DAB@@AB@BAB@@@B@B@@AB@@@D@B@BB@B@@@@@B@@@B@@@@@BBD@D@@@B@DD@@@@B@F@@BB@A@@B@@@BABAB@@AB@@AB@@@BA@@B@BA@B@@@AD@BAB@@CB@@AB@B@B@BBB@B@BA@@BC@@BAD@BBBB@DDFDB@@BB@BC@@BABB@@B@@@B@@@DA@@BADCBABAB@@@@@BB@BB@@@BAB@@@B@B@B@B@@@BEBABAB@B@@B@@@B@B@B@B@BDF@@AB@@A@@BB@@@ADB@B@BBB@@B@@@@AB@@ABA@@@DBF@BBABBB@@B@B@@@ABB@@BDAD@@ABB@BB@B@BB@@@@DCB@@AB@DC@@DA@@D@D@BBBB@BBB@B@BB@@@@BB@@@FAB@B@DBBB@@B@B@@ABAB@@@BAB@BAB@BAB@DADC@@B@@@@BBB@@B@@ABA@@@@B@@@B@@D@D@@@BAB@@@BAB@B@@@BBB@@@@@B@@@@@@@B@@@@@B@@@B@@@BB@AB@@@@@B@@@B@@@@@@@BB@@B@@B@B@D@B@@ABAB@B@DAB@D@D@DBB@BBDB@@BAB@BA@@@A@AAA@@@@@@@@BAB@BADABADAF@D@B@B@B@@@AB@@CBA@A@@B@@AB@@ADCBAB@@@B@@A@@@@BAB@@@B@@B@B@D@D@B@@BBB@@ABA@A@@@@B@@BBB@B@D@@DABABAB@B@@@BB@@@@B@B@@@BA@AB@@@@B@B@BA@@@@B@B@B@@@B@@@@BBBB@@@BBF@B@BBB@BB@@@B@@G@EB@@@@@DAB@@@@BBB@@@@@B@@@B@@@B@@@@@B@@@B@@B@@BB@BBB@@BBBB@@AB@@AB@B@B@@@@B@@@B@B@B@D@BBD@@@BB@BA@@@AB@BA@@@@B@@@@B@@@B@@@B@@@@BAB@@@B@@BB@@AB@@BDAB@B@@C@@@AB@B@BA@BBBB@@B@B@@@BA@@DBDBBDDB@@@@AB@@CB@@BB@@B@@@@B@@@@@@BB@@BBA@@B@@@@B@B@@@DBB@BB@@@@AB@@@B@@B@@@@B@@@@AB@@@BA@@B@@@@AB@@@@@@BBB@DBBBD@HB@@BB@@B@B@DA@@B@BAB@B@@@B@FBBBDB@@B@@BA@A@@B@B@B@D@BAB@B@B@B@@@@BA@@D@@@B@B@B@HAD@FAF@B@FAFAB@BB@@@@BBA@@@@BB@@B@@A@@B@@@B@@@@@@@B@B@@A@@B@@BB@B@B@B@@@@B@@@@B@@B@@@@@@B@@B@@@AB@@B@@B@@BB@@@@@@@B@@BBBB@@@BB@@ABA@@BADC@@@@BA@@BCB@BAB@B@DBDBB@B@H@@@@@B@BBD@B@B@DAD@B@@@@B@@@B@@@B@@@B@@@@@BA@B@@B@@@@@@BB@@@@@@@@@B@@@@@@B@@B@@B@BB@@@B@@@AB@@@@BA@@@B@@BA@@@@@@@AB@@@B@@@@@B@@@@@@@B@@B@@@@B@@@@@@@BB@@@@B@@@@@@@B@@@@BB@D@@B@@B@BB@@B@B@@@BA@A@@@@@A@@B@@@BDBDDBBBBBB@BB@@@BB@@BBB@D@B@@@DDB@BBB@B@BBD@@@B@B@B@@@@AB@@@@A@@@@B@@@@@AAAA@@@@BABAB@B@B@B@@@@@BA@@@@BA@@@@@AB@@A@@@@@@B@@@@@@@@A@@@@@A@@@A@@B@@@@A@A@@B@@@BA@@@@BAB@BA@@@@@A@@@@@@B@@@BA@@@@BA@@H@D@@@B@@BB@BB@@@@B@BA@@B@BB@@DAB@F@B@D@B@B@@@@@@@@AB@@A@@@@BABABABAB@@A@AB@B@BC@@@A@@D@D@D@@@@A@@@A@@@@DCD@@AB@@@BAB@BAB@@@B@@@B@BA@@B@BA@@@@B@BA@@BB@@BA@@AA@A@AAABA@CBABC@A@@@A@@BA@ABC@@BA@AB@@A@@A@A@AA@AA@@A@A@AAC@@BA@@B@BAD@B@BBD@D@F@D@D@@@BBB@@@@A@@@@BA@@B@@ADA@@@@B@D@@@D@BABADAB@@@B@@ABABADABA@@B@B@B@@AB@B@B@DABA@@D@D@B@@@@B@B@@BDB@B@@@@ADAB@B@B@D@B@DCBABA@@B@B@@@BBBB@@BB@B@@BB@@D@BB@@B@@@@@B@BADAD@BAD@DA@@@@@B@BB@@BB@@@B@D@B@@@DC@@BA@@B@B@B@DAB@@@BBB@@BB@BBBBBBBBBB@B@@AB@BB@AB@B@@@@@@B@B@@BD@B@@@BAD@@BB@@BA@B@@@B@DC@AB@@@DBBAB@@@B@B@DBBBB@B@B@BADB@@B@B@@@B@BB@@B@@@@B@@B@@@@@B@B@@@@@B@B@B@BA@@@@@@B@@A@@@@@@B@@@BAA@@AB@@@BA@AB@B@@@@@B@D@B@BA@@B@@@BAB@@B@@B@B@@@B@B@DAD@@AFA@@B@@AB@BA@@BAB@@@@@A@@@A@A@AAAA@@@AA@@A@AA@AECC@A@@@EBC@A@@AC@A@@BA@A@@BABAB@B@@@B@@@@@BA@@@A@@B@@@@@@@B@@@B@BAB@@@B@@@B@B@@ABB@A@@D@@A@@@@B@@BB@@@B@@AB@@@B@@@@@BB@@@@DB@@DDD@@BB@@BB@B@BA@@BA@@B@@ABA@@B@@AB@@AB@@AAAA@@A@@@@@@@AA@@A@@@@A@BA@@A@@@@@@@BAAA@@@@AA@A@@@A@AA@@@@@@A@@@@AABAD@B@F@D@@@BA@@@AAA@ABGBA@A@@CA@CAAAA@@B@@A@@@A@A@A@@DA@@@@BC@CBCBCBEDA@@BA@ADE@@B@B@D@@@BBBBB@D@F@B@@B@@@@AFAF@D@@@BD@BABA@@@AAA@@BAD@B@B@@A@A@@BC@@@@AA@@@@BA@@AA@@BAA@BA@@A@@@BA@ABA@@@CAA@@@ABABABA@@@A@A@A@@@@@@@ADA@@@ABABC@@BA@AB@@A@@@A@@A@A@@@A@@@@A@A@@@@AA@@@@@@@@B@@A@@AA@@@@BAB@@@@@@A@@A@A@@@@@@AB@B@@AB@@@@@@@A@@@@@AAB@BA@@@A@A@@@@B@AA@@@@A@A@A@@@@@A@@@@@C@A@C@A@@@A@ABA@A@A@AAA@A@A@@BAB@DAD@B@D@@@BA@@@AA@@A@@@@@@A@@@@A@A@A@@@@A@@A@CA@AB@@@@@@A@@A@@@AAAA@@@@@A@A@@@@B@@A@E@@@AB@AAAB@@@A@@A@@@@@@A@@@@AA@AAAA@A@@@@@A@@AAA@AA@@A@@AA@A@@@A@@@@@AA@@@@@@AAAA@@AA@@@@AA@@A@@@AAA@AAC@@@A@A@@@A@@@A@@B@@AB@BA@AB@B@@BB@@B@BB@@BBBB@@@B@@BBA@@@@BBD@B@@A@A@@@A@A@A@A@A@AAA@@@AB@@A@@D@@BB@DD@@B@B@FABAB@@AB@BA@@BA@@BAB@B@@@B@BD@@BBD@@@B@BABA@AB@BABAHEBAB@@@BBB@@B@D@B@@AB@B@B@@DBBB@@BD@@B@BAD@B@B@B@BB@B@D@BB@B@DBB@BD@@BBB@BFBBBBBDDB@@B@@A@AB@DCBADABCBA@@@@B@B@DDD@BBBB@B@@BB@@@@@@B@B@BAD@B@B@@AB@AABA@@@A@AB@@AB@B@B@FAB@B@DCB@B@@@B@FD@@DDB@@BBB@@BB@@BB@B@@@DBB@@@@B@BAB@BA@@BA@@@A@C@A@@BI@A@A@C@@@@B@@@B@@BB@@@@ABA@@B@B@BB@B@B@B@@@BBB@BBB@B@BB@BB@@"],encodeOffsets:[[112544,38172]]}},{type:"Feature",id:"610622",properties:{name:"延川县",cp:[110.193514,36.878117],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@C@AA@@@@AAA@A@@AAB@A@@@@@BA@@AA@@@@@A@A@@@@@@A@@AB@@@@@@A@ACACECAA@@@A@A@AA@@AAACC@@CB@BA@@B@D@BCB@BAB@B@B@@CD@@@@@@B@@B@@@@B@@B@@@B@@@@B@AB@@@@@@@@@B@@@@B@@@@BCAC@@@A@A@A@A@AB@@AD@@A@@@C@A@@A@@A@AB@@@@AA@@AAB@@A@@@@@@@AA@@@A@C@@@A@A@@BA@@AA@@B@@AB@B@@A@@@A@A@@@@B@@A@A@@@@@A@@@A@@@CA@@A@A@A@A@A@A@C@A@A@C@AA@@A@A@ABAA@@A@A@@A@@@@A@@@@@AA@A@@AAA@@AAA@@AAAA@@AA@@AA@@AAA@@@@AA@@@@@@@@@@AA@@@@@@A@@A@@@A@@@A@@AA@@@A@@@AAA@@@@@A@@A@@AA@@@@@@A@@@A@ABCDABA@@AA@A@@@A@EA@@ACAA@@AA@AAC@A@A@@@@@AA@@A@@A@@@A@@@@A@@A@A@@@A@@BA@@@@BAA@B@@AB@@A@@@@@@@A@@@@B@@@B@@@B@B@@@@@B@@@BAB@@@B@@@B@@@B@@@B@@@B@@@B@@@@@B@@@BA@@@@BA@ABA@CBA@@@@@AAAAAA@A@@A@@@A@@B@BAB@@@@@@@@AC@@@AA@AA@AA@@AA@@A@@AB@@@@@BBB@@@@BD@BBB@@AB@@AB@B@@A@@@@BAB@@A@@@CC@C@AA@@B@@A@@BA@@@@@@@A@@B@@A@@B@@@@@BA@@@@@BB@B@@@B@@@@@B@@@B@B@@@@@BA@@@A@@BA@AB@BA@@B@@@@@BA@AB@BA@@BAB@@@B@BA@ADA@A@@@A@@@AB@@ABA@AB@BA@A@@@A@A@@@@BA@@@@BA@@B@B@@@@@@@BAB@@@B@B@@A@@@@BA@BBA@AB@BA@@BCB@@B@@@@@@@AB@@A@@@@@A@@BA@@@CB@@A@A@A@@B@@@@A@@@AB@@@@AB@BAB@B@@AB@@@@A@@@B@@BA@@@B@@BA@@@@BB@A@@B@@@B@@@B@B@B@B@B@@@BAB@@@@@B@@@B@@BD@@@B@@B@ABB@@@AB@@@B@@A@@@@@@B@B@@@@@B@@B@A@@B@@@@@B@B@@@B@BAD@@@@BB@@@@ABA@CBCBAA@@@A@@A@A@@@AB@B@B@@A@A@C@@A@@BC@@@@@@BA@@@AAB@@A@@@A@EAA@@@@BA@@@@@C@CAA@@A@@AA@A@@@A@@A@@@A@@@@AA@@AA@A@A@@BB@@@@BA@@@AA@@CB@A@@@@@ABADA@@@@@AA@@A@A@@AAA@@@A@A@AA@@BAA@A@@@@@AB@@@@A@@@@@AA@@@@@@A@@BAB@@@@ABAAABA@@@A@A@@@A@@@BA@A@A@@@@@A@@@@CBA@CBABC@A@@BA@@BAB@@@@A@A@CA@@B@@A@@C@@@@A@@BA@@AA@AA@@@A@@B@B@@@BA@@@AB@@AA@@@AA@@@A@@@@@AC@@@@C@A@@B@@@B@@@@ABAA@@A@@AA@@B@@@B@@@@B@@B@@@@@@A@A@@@BB@@A@@BA@CBCB@@@@AAA@@@@@AF@BAB@@@AAA@CA@AAA@CA@@@@AB@B@B@B@B@@A@B@@BAB@@CBABA@EACB@@@AAA@@A@@@@@A@C@C@A@ABCBA@@@A@@@A@@@@@@AAA@@ABAB@B@@@B@@B@ABBB@B@BABAB@@ACA@@AC@@@@B@@BB@D@BBBB@@@D@BAB@@@@B@@@BBB@@A@@@@@A@@@AA@@@B@@@B@B@D@@@@A@A@@@AA@A@A@@DA@A@@@@A@G@@B@@@B@@@BA@@AA@@@@B@@@BB@@BA@@BA@A@@A@@BA@A@@A@@A@@@AA@AB@@@BD@@@AB@@A@AAA@@@@B@@@B@@@@A@@@@@BB@B@@@BA@@@@@E@ABA@@BABADA@@BA@@@@A@@@@@A@@@BAB@B@@@@AB@@A@AAAAA@A@A@@@@@AA@@AAAA@@B@@@@@@A@@@A@A@@A@C@@@@@@AA@A@A@@@A@@B@@A@A@C@@@@@A@@@ABA@A@@@@B@@@BB@BB@@@@@@A@C@AAA@AB@@@@FBB@@@@@@BA@CBA@ABAB@BA@AB@@AB@@A@@@@@@B@@@@@BA@@B@@@BA@@BA@@B@BADA@@@ACA@AAA@@BA@A@A@A@AAABEDADA@CBC@A@@BABAB@B@BA@A@@@A@@@AAA@@@@@A@@@@@AA@A@A@@@@@A@@AAC@AA@@AA@@@@AAA@@A@@A@@@C@@AA@@@@ABA@C@@@AA@@@AB@BA@@A@@@@AB@@@A@@@A@ABAB@BABA@@@@@@A@A@@@@@@@DC@@@@AA@@AB@@@BAAC@A@A@A@@BA@@@@@@A@@@AB@@@BA@@@@@AA@@@AB@@ACCC@@@@A@AB@@CB@@A@@B@A@@CA@@A@@BA@@@@@@ABA@A@@@A@@@@A@@@@B@@@@A@@@@B@@@@A@A@AA@AA@@@AB@@ABAB@@A@@@@ABCAA@@A@AB@BA@CD@@@@AAA@@@C@@B@AA@@@AAA@A@@@@A@@A@@AA@@@A@A@A@@@A@AA@AA@CBA@@@A@AAA@A@@@@@@B@BBD@@@BA@BB@@AB@B@@AB@@@@@@A@@B@@A@@B@@BB@B@@A@@@@BA@A@@B@@@BA@@@@@@@@BB@@@@@@B@@@@@@B@AB@@@B@B@@BBA@@@@@AAA@@@@@A@@B@@D@@B@@C@@B@@@B@B@@B@@B@@@BA@@@A@BB@D@@@@C@ABA@@@A@A@@@@BABA@@BA@C@A@A@@@@BA@@@@@AA@@@@@@AB@A@@A@@AA@CB@@A@AB@@CB@@DB@@@@@B@@@@@@AB@@@AA@@@@@@@@BABB@@@BB@@@@@@A@A@@@A@@@@@BB@@BB@@AB@@@@B@@@@B@BA@@@@BB@@@@BA@@B@@@@@B@@@@AB@@@@@AA@@@A@@@BDA@BBBBAB@DA@@@@@AC@@@@A@@@BB@B@B@@@B@@AA@@AA@A@A@@A@@@@@A@@@AB@@@B@BA@CBADA@@BAA@@A@@@A@@B@BBB@@@BA@@@CA@@C@A@A@@@@@A@@BABABABA@@@@B@@A@@@A@A@A@@@@B@@AB@@AB@@@@@BA@@@AB@@@@AA@@@@AB@BA@A@@@@A@@@@A@@@@BADA@@B@B@@AB@@AAA@A@@@@@AB@BA@AA@@A@@BAB@DA@@@AB@BCAA@@@ABA@@B@@CBABAB@@@@A@@A@@@@AB@@A@AA@@A@BAA@@@@@@A@@@@A@@AB@@@@CA@@A@@A@A@@@CA@AA@@B@@@B@@BD@B@B@BA@@B@@A@AC@@@ACC@@A@@@@@A@AB@@C@ABGBEBCBABAB@@@@A@@AA@@AAAA@@@@BA@@@A@@@@B@B@@AB@@A@@@AA@@A@@B@@@B@BBB@@@@@BAAA@@B@@@@@@AA@@AA@@@A@@@A@@A@@@A@@BAB@@@@A@@@AAA@@@BA@@@@A@A@@BA@BB@@CF@@@@@B@D@BA@@@C@ABA@@@ABADA@A@@B@@ABC@AB@AA@@@@@@@A@@@@B@@@@@@A@@A@@@AA@@@@@ABA@AB@@AHABAB@@A@@@A@@@@@@DCD@BAB@@A@C@@@A@@@@@BB@D@@@D@B@@A@@@AAA@@@A@A@@B@@AB@BA@A@ABAB@@A@A@@@A@C@@BA@A@@@AB@@AD@@@BA@ABA@@BA@AB@@@@@B@@@B@@@BC@A@@@ABABA@ABAD@@BB@@@B@BA@@@@@@BA@BB@@@B@@@@A@A@A@@B@BA@@B@@@BA@@@A@@@A@AAA@AACA@AA@A@AA@@CAA@@@A@@AA@@@@@@@CAA@@@A@@BA@A@A@A@@@A@A@A@@@A@A@CBA@@@CB@@CBA@@@A@@@@CAA@@A@@@CBABA@@@@@A@A@AA@AA@@@A@A@A@A@A@CA@@@@AAB@@A@A@A@@@AAAA@AA@@@@CB@@@AA@@@@@A@A@ABCDABA@@BA@A@A@A@@BAD@BA@@@A@@@@@BCA@@@@AA@A@@@@@@BA@@B@@A@A@@@@@@@@AB@AA@@A@@@AB@@@B@B@BAB@D@B@B@B@@@B@@@@@@CAA@@B@@@@BB@@@@@B@@A@CC@@@@A@@@@@@BBBB@@@@B@@@@@@@B@@@@@@A@A@@@@@@B@@BB@B@@@B@@@B@@BB@@@@DB@@@@@BAB@B@B@@@B@@BBB@BB@@B@BDB@B@@@@D@@CBAD@@@BA@@@A@@B@@A@ABA@@B@@@@BB@@DB@AB@BAB@@AB@@@B@@B@@@BD@@@B@@BA@@BA@@@CAA@@@A@@B@@@@@B@@@B@@@@AB@AA@@@@B@@D@@@@@@@@B@B@@B@BB@@A@@B@@@BA@@AA@A@@BC@@@@@@BB@@@B@@AB@@@BBBB@@@BAFAB@BA@@B@B@@@@@BC@AB@D@BA@@@A@@@A@@@@@AB@@AA@@@CAC@A@BA@@@@BBBAB@@@B@BA@@BA@@@@B@@@BA@@BB@@B@BABCF@@AB@BB@ABAB@@@BBB@@@B@BAB@@@BCBADB@@@DB@@AB@BA@@@A@@AA@@AE@AB@@AB@@@B@BABADAB@B@@@@@B@@@BB@@B@@A@AB@@@BBB@@@B@B@B@BBB@@BDB@BB@@@B@@@@ABABA@AB@@A@A@@@@@@@C@A@@AA@@@@@A@@@@@A@@D@@ABA@@@@@@@@B@@@@@D@D@@@@@BA@@@C@A@@BA@@@A@@@ABCB@B@B@@@B@B@@@B@@@BB@BB@@BB@@@BBBB@BBD@@@B@B@@@@@BAB@@ABBDBB@D@B@@@@@B@BBF@B@B@@@@@DD@@B@B@@B@B@BB@@@BB@@BABBB@@@@BBB@BBB@@@@@@B@B@BAB@B@@BB@@@BDB@@@B@@@B@@@B@D@B@@@@A@A@@BAB@DAF@DA@@B@@BBB@BB@B@@@BABAB@@AB@BB@@BBB@B@B@B@@@DAB@B@DBB@@@B@B@BB@@B@DCB@B@@@@BB@@BB@@@@@BB@BBBD@@@@@B@@AB@BAB@B@D@@@DABBBB@@B@B@DA@@B@DB@@BB@B@BB@@@@@D@@@BA@@B@@@B@B@B@@@@@B@BBB@B@B@@@BB@BB@@BBB@DDB@@DB@B@@BD@B@B@@AD@B@B@@BB@@@@D@@@B@BA@@@@BA@AB@@@B@DBB@@A@@BA@@BABA@@B@@@BA@@@AB@B@@@BB@@B@@@@ABA@AB@BA@@B@B@B@@@BC@@@@BAB@@@B@@AB@@@B@@@@A@@@AB@@@BBB@@ABA@@@@DADBBAB@@@B@@@B@@@@BB@@@DDBB@@B@@BBAB@B@@@DBB@@@B@@@B@@AB@BC@@B@@AB@BC@@BADBBA@@LE@ADA@@@A@AB@@@BA@@DBBA@@DCB@@@@@BCB@FCB@B@B@DBB@BBB@B@B@FD@@BB@B@@AD@@@@@B@@BB@BB@@@AD@@ADAB@@@@@D@@@B@BBB@@@B@B@BB@@D@B@D@B@B@B@B@@@@@B@@@B@@B@A@@B@@ABA@A@@BA@A@AB@@@B@@@@B@B@B@@BB@@@DBB@@@@@B@B@@@B@@B@@@B@B@@@@@@B@D@BA@AB@@A@@@A@@BAB@BA@@BAB@B@@@BBB@B@@@@@@@B@@AB@@@B@@@BB@@BB@@BA@@BABAB@BA@@B@@@BBB@@@B@BB@@B@DBB@B@BAB@@A@AAAAC@A@@@AB@B@@@B@DB@@BAB@@BB@BB@@B@@@@ABA@AAC@@@A@@@@B@@A@@@C@@@A@A@A@@B@@@BC@@BABA@@DA@@@AAA@A@@BCBAB@DABB@@@B@@@B@@@@B@DA@BBB@@@@B@@ABAB@@@B@B@@BB@DF@F@BBB@B@@B@@B@@@@AB@D@@AB@@@BB@DBB@BA@@D@@AB@@@B@@BB@@@@@@@BD@@BB@F@B@@BB@BBBAB@@B@B@BBB@@@D@@@B@BA@@@@@AB@BBB@@B@@B@BBD@D@@@BA@@BAD@B@@@BAB@@@D@@BB@B@@@BBB@BAB@B@B@@@B@@AFAB@@BB@@B@B@BBB@B@B@@B@@@B@@@DA@@B@B@BB@B@B@B@@BB@@DADC@@B@@@BB@B@@@B@@B@B@@BB@@BBB@@BABAB@@@@@@@B@B@@@@@@BBB@B@@@@B@B@B@@@BB@B@@BBBBBBBB@B@B@BDDBB@@@B@BABCB@@AD@@@B@B@@@D@B@B@BAD@@@@@@B@@BBB@@@@BB@B@B@@@@B@DA@@B@B@B@@@B@B@@AB@@@@ABAB@D@B@@@@B@B@@B@BA@@B@@B@A@C@@@AB@@ABA@@@AAA@A@A@@@A@@B@D@@@@AB@@C@@@A@CDA@@@AAA@@@@@@DA@@@@@@@A@A@@BAB@@AB@@@B@@@B@@A@@@AAC@@BA@ABABA@@B@B@@AB@FABB@@B@@A@@AC@@C@@@@A@A@@BCBA@@@ABC@ABC@ABA@AAA@@@@A@@@@B@@@@A@@@A@A@@@AA@AAAAAAA@A@A@A@A@@@@@C@@@E@@@@BAB@@ABA@ABA@A@@B@DBB@BA@@@@AA@@@@@ABC@@@A@@@@@AD@@@BB@@B@B@B@@@B@@DB@@B@B@@B@B@@@@AAA@@@@@@@@BAD@BB@@@@@B@@B@@@@A@ABEB@@@@@@BB@@@B@B@B@@@@@@A@@@@@A@@@AB@FC@@@A@@CA@A@A@@BC@ABA@@BAD@D@B@@@@A@@@ABA@@@@D@@@@@@@@@@B@@@B@@D@B@@@B@@@@A@@BAB@B@@@B@@@@@@A@AB@B@@@BABAB@@@BA@A@A@@@@BA@@@@@@@A@ABA@AB@BA@ABA@A@@@A@@AA@@AAA@A@A@@AA@@@BA@@@@B@D@@@DBD@@@@@@A@@@@@A@@AB@@@@A@@@@@BA@@BAB@B@B@BB@@D@@@@@@CB@@@@@BB@@@@@@B@@A@@@@@@B@@@BB@@@@@@B@@@@@@@B@@BB@@A@@B@B@B@BA@@@AAA@@@@BAB@@@@@A@@A@AB@@@@@B@@@B@@@@@@@AC@@B@@@BB@@@@B@@@AA@@BAB@BABC@@B@B@@AB@BADA@ABAB@@@B@@@DDB@B@BB@@BB@@@@B@@@B@@BAB@B@@@@AB@@B@@@DA@@@@B@@BA@@@@B@@@@@B@@@@B@@@@@B@@A@ABAB@@@@@BDB@@@B@@A@@BA@@@B@@@@@BB@@B@@@B@@@@BB@@B@@@@@BA@A@A@@CAAA@AA@@@@A@@B@@@@BB@@@B@@A@@B@B@@@B@@@@@@B@@@@@BB@@@@@@@AB@@@@@B@@@@B@B@@@@@B@D@BB@@@@ABAAAB@@@@@@DB@@@@@B@B@@BAB@@@@BB@AB@@@@AB@@B@@@B@BA@@@@B@@@@B@@BBB@@@AA@@B@@@BB@@@BBB@B@@B@@@@@BA@@@B@@@@@B@@@@B@@@@@AB@@@@BB@A@A@@B@@@BBB@B@DBDB@@D@DBB@B@@@BBA@BB@@@BBA@@@@BB@@B@@@@@@B@@A@@@@B@@@@@B@@@B@@B@@@@@B@@@B@B@BB@@@@A@@@@B@B@@@@B@B@@@@@BB@BA@@@AB@@@BB@@@B@B@@@@@BABAB@@@@@@B@@@B@B@@B@@@F@BAB@@@B@@A@@@@@@BBBD@B@BBB@@FDB@@BB@@@@@@B@@AA@@@B@@@@@@@B@@A@@@@@DBB@@A@@BABA@@B@B@@@@@@@AD@@@@@BB@@@@@B@@@B@@@B@@@@A@@@A@@@A@@B@@@B@B@BB@@@@@AB@@@D@B@B@@@BBB@B@DAB@@@@A@@@AB@@BB@@@B@@A@@@@@AB@@@@D@@@@B@@@@@@@@B@@B@B@DAB@@@@A@@@@@A@@@@BABA@A@@@AB@@A@@@@@AA@@@DAB@@@@AB@@@B@@@F@@@@@BA@A@A@@BABAA@@A@@@@AA@@@@@A@@F@B@FB@@B@@@A@@@A@A@@@@@@@@B@@B@D@BB@@@@@@B@BAB@BA@@@@@C@@@@@@@@BB@@@B@@@@B@@@@@BA@A@@BBBA@@B@@@@@@@@BAB@@@@@@F@@@BA@@B@B@B@DBB@B@@@@@BA@@@@B@@@B@@@@@B@@A@@@@AAA@@@@A@@B@B@B@DA@@BA@@@AB@@A@@B@@@@@A@A@@A@@@A@@@A@@@@BB@B@@@@BA@A@@AEA@@@@@@@ABA@@A@@@A@A@A@@ABABC@@@@@@AB@DA@@@A@@@@@@A@@@@@BAA@@@@@@@AB@@@BA@E@C@@@C@@@@A@@@ABA@@A@@@A@@@@@@@@CB@D@@@BAA@B@BC@@@@B@@B@@B@@A@@A@@@A@@AD@@@@A@@A@A@C@@@A@@AAA@C@@@@B@@@@@BAB@B@B@DB@A@@BABAB@BDB@B@D@DBBBBBD@@BD@B@@@@A@@@AAA@A@@DB@@@A@@A@AAAA@A@A@AB@@@FA@@BA@@@B@D@B@@@DBB@@B@D@B@BB@@B@B@@@AB@B@@BB@@BB@@B@BB@@@@@@@A@@@AB@@@B@@@@@@B@BAB@B@@@B@@BB@@@BBB@@D@@B@@A@@@ABA@@@A@@B@@@@BBBBB@FDBBA@@@@BAB@@@@B@@@@@BA@AB@@@@@H@B@D@@@BABAB@B@@B@@AB@@D@D@@BB@@@DBB@FBBBB@B@B@@@B@@@@A@@AAAA@AAACC@@@A@@BABADADAB@@@ACAA@@@@@ADB@@@@@B@@B@BB@@@A@ABA@@B@BBB@B@B@B@@@DADA@@FA@A@AB@@AAA@AAAAA@A@A@CA@@C@@@A@@BBB@@@@@D@@A@ABA@A@@B@@@@@B@@@BBB@@@@@@A@@AAA@AAA@@AAA@A@A@AB@@A@@@A@AB@@BB@@BB@@@@@BA@@B@@@@@BBBF@B@@AB@@@AA@@A@B@@@B@@@BA@BB@B@@@@BA@@@@BB@@@@BA@@@B@B@@@B@@C@AB@@@@@@@@@BBA@@B@D@@BBB@BBBDBBD@@B@@A@@D@@@@@@A@@A@@@@@@@BA@@@A@@A@@A@@@@@@B@@A@@@@BBB@BD@@@@D@BAB@D@@@@A@@BBDBBBBB@@BA@@@AB@@@@@B@@B@@AB@BB@@@@@BA@C@@@@B@@@@B@B@@@@BBBBBBB@@BBB@BA@@BB@@@BABBB@@@@@@@@A@@@@B@@@B@@@@@@@@@@AB@@B@@B@B@@A@@B@@B@@@@@B@@@B@@@@BA@@@@B@@@@@@@@B@@B@B@A@@@@@A@@@BB@@@@@@B@@@@@@B@@@@@@BA@@@@B@@@AB@@@@@@@BB@@A@@@B@@B@@@@@@@@@@@@@DB@AFIDGBE@EAMCIIM@@CICOCSAKAIBK@@AEAIEEGEEAKAM@C@C@E@ECCE@CBCDCDAHCHAL@P@NALELEHEBG@GASCGCEGEEAGACA@C@ABCBAFAHAH@DADC@A@@@C@GCKCOEMGMCGEICAECC@C@KDEBE@EAAA@@AABEBE@@BAFALAP@J@NANCHEHEDEDIAGCCEEECCCIECE@C@CBCFAFAD@@@FBDDDBDHDFFFFDHBF@FADCBC@EAECE@@GGKKEE@@CGAGBIBIDG@GAEACEAEAEBGBEFEHEHEFEDE@E@E@GCEC@@CG@GBGDGJGPITGLEHGHIDIBA"],encodeOffsets:[[113084,37527]]}},{type:"Feature",id:"610624",properties:{name:"安塞区",cp:[109.328842,36.863853],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@AA@@A@AA@@@@@@ABAB@@@@A@@@AA@@A@@AA@A@@A@@@A@@@A@@@AACAA@A@C@@CCAAA@A@A@A@A@@@@CAC@@@A@ACA@A@A@CA@A@E@@@A@AD@B@B@@@@@@@@A@C@AAA@@AA@@A@@A@AB@BAD@@A@A@C@@@@@@DA@@BABA@EA@@A@@BA@A@@A@@AA@@AA@@AA@CAC@ACAA@AAA@A@@@@@AAA@A@AAAAA@A@A@@@ABAB@@EAA@@A@AAA@@AAA@@@ABC@C@@@A@@@@@@B@@C@A@@@CBA@AB@@C@@BAB@BAB@DAD@B@@BB@@@@@BAAC@A@@@E@ABA@C@A@@AA@@AAA@AEA@@AA@AA@A@A@AFA@@@A@A@@@AAAAAAA@@BA@ABC@@BA@A@A@A@CBA@@@A@@@C@@AA@@@A@A@@BAB@@A@AAA@@@A@@BAD@BBD@BAB@@C@@@A@@@CA@@@@ABC@@B@BAB@@A@ABAB@@@H@@@@B@@BB@@B@BAD@B@BA@@DAB@BA@ABA@@@A@A@@@A@@@AB@B@@@BA@AB@@AAA@AA@@A@@@A@ABAB@@@BABADA@@B@@@BA@ABAB@@@@@AA@@@A@@BAB@@AAA@@A@@A@@D@BAD@B@BB@@BA@@D@BA@ABA@AB@@@BAB@@BB@B@B@@AB@@@@AB@@A@A@@@@AAA@@A@@@AB@@ABA@ABA@A@@@@@@AA@CA@@AB@@ABAB@@@@A@A@AB@@@@CAAA@@AA@@@@ABCB@@@@CB@BAA@@@A@AA@AA@@@@@AAAAA@@ABABA@ABCB@BABB@@B@@@BAD@BBB@BA@@@A@@@EA@@A@@BCDABCBAB@@ABBBA@@@A@@@ABA@@ACA@@@@AA@@A@CBA@AB@@ABA@AB@@@B@@@B@@@B@@ABAB@@@@@@AA@@@@A@@@A@CAA@@@@@AB@BCBC@@@@@AB@BCB@@A@@@ABA@@@@@A@@AC@A@A@@B@@A@CA@@@A@@AA@@A@C@@@E@@@ABAD@@A@A@C@A@@B@B@@A@EB@@CA@@@@ABCBA@@@AC@@@AA@@B@@ABA@@BABADABCBCDAB@@A@AA@@@C@@@@CAA@@@@AA@@@@@CBA@@@A@C@A@A@ADAB@@@@A@@@AAA@A@A@AB@@A@ADA@@BC@AAA@@@A@ABA@GD@@A@AAA@@@AB@@A@@@@AA@@@A@CCAA@@AAABAAABA@A@AA@@A@@A@CAAAA@AA@@@@B@B@BAD@@@B@FAB@B@@@@@B@@@@@@BB@B@@@@@B@@BB@@@BA@A@C@@BA@@@AB@B@B@B@@AB@@A@@@@@@D@F@FBB@@BBB@@@@@@B@@@@BB@@@@@B@@@@CBC@A@ABABAB@@B@@BAB@D@D@@@B@@@@@D@@AB@@@B@B@B@B@@@BB@B@D@B@@@BBD@@B@@BB@@F@D@B@B@B@B@BBB@@A@@BBD@@@B@D@@B@@@@B@@@BB@@@B@@A@@BA@B@A@@BA@@B@@BB@@BBB@@B@@B@@B@@BB@B@B@@@BBB@B@BB@@@BBB@B@B@D@@@B@@AB@D@@@@@B@B@@@B@DB@@B@BBB@@@ABAB@@A@AB@@@BC@A@AB@@@B@D@BBBABBB@@@BAB@B@D@@AH@@AB@@@@@BA@@B@@AB@@@@BB@@D@DDBB@@B@B@DAD@B@BADBB@@@D@B@@A@@B@B@@@B@BA@@D@B@@@B@B@B@D@@@B@B@BBB@@@B@BB@@D@@BB@@@B@BBABA@ABCBA@@@BD@B@B@B@@@B@@@BBB@D@@BB@@@@@D@@@H@F@BAD@DC@CAEAA@@@@B@@AB@@AB@@BBDBBD@@@BAHB@@B@@B@@@DA@@@B@@DA@@@B@BAB@B@D@D@@@B@BBB@@@@B@@AB@B@@@B@@@B@DAD@@B@B@B@B@B@@BBD@@@@@B@@@@@@B@@B@@@DAB@@@B@B@B@B@@@@B@@BD@@@B@B@B@@BB@@B@D@DA@@@@@@@B@BA@@BBB@BB@@@D@@@@B@BB@BD@@B@@@B@B@BB@@B@B@B@BA@@BB@BBDAB@@@@@B@D@DA@AB@BAB@@@BB@@B@@B@@@ABBBADAB@@FB@@@@BB@@@B@B@@AB@@@BA@A@@@ABA@AB@@A@AA@B@@ABB@@@@B@@@BABBB@@@B@B@BB@@B@@@BBB@BBB@@@@@BC@A@A@@@@B@@@@AB@B@B@@ABA@@@@B@@@@@BBB@B@@BB@B@@@D@@@BBBB@B@@B@@B@B@B@@@@B@@A@@@@B@B@@@B@D@@@@@@@D@B@@AB@@@@@BAB@B@@@BB@@B@@@BB@@@@B@@@B@@BD@@DB@@BB@B@B@B@BB@B@@@B@BBB@B@B@@@@B@BB@@B@@@B@BBBB@@BB@@@B@B@@@@@B@B@B@BB@@D@@@B@B@@@@B@B@B@@@@AB@BA@@@ABAB@@C@@@@@@BAB@@@@@B@@@@@B@@@@@B@@@@@B@@AD@@@B@B@@@@B@BAB@@@B@@@BBBB@@B@@AB@@@@@@@@B@@BBB@B@@A@AB@@AB@@C@@BABA@A@@B@BBD@B@@@@A@@@A@@B@BA@AB@B@@A@@B@B@B@B@@A@ABC@@@@B@B@B@B@@A@@@A@A@@@A@@B@DBB@@@@@BA@A@@@A@AB@@A@@BBB@@@@@@B@@@BB@B@@B@@@@@B@@@B@@A@@@@B@@@BAB@@B@@@DBB@@@@BAB@@@F@@@B@BB@B@@@@BB@@B@B@@AB@@@@@D@@@B@BBB@@@B@BBBC@A@EB@@@@DFBBBB@@@DAB@B@B@@BB@BAB@@@B@@@B@B@@@@ABC@@@A@@B@B@@A@A@AB@@@D@BB@@BB@@BB@@@B@@@@B@@@@@B@@A@AB@@@B@@@@BBBB@BB@@B@B@B@@@@@BB@@@D@@@BAB@@ABABA@@B@@@@@@BB@@@@B@@B@@B@@@@BB@@@B@@@B@@@@@B@@@B@@@B@@@@@@BB@@BBB@@@@@@BF@@B@AB@B@@B@@AFAB@B@@@BAB@B@@AFBB@@BBAB@@AB@@A@A@@@A@AAA@@@@A@@AAAA@@@@@@AA@@C@@A@@A@AA@@CB@AB@@@A@@@A@@@AB@BAB@@@@@B@BA@@@@AA@@BA@@@@@A@@A@A@@AA@@@@A@@@@@AB@@@@@@AB@B@B@B@B@B@@@@@@@B@B@B@@@B@@@BA@@B@@@B@B@BAB@B@@A@@@AA@@AB@BA@@@@@AAAA@@@BA@AB@@@BA@@B@B@B@B@@@@@@@@A@A@@@@@A@ABA@A@A@AAA@@AA@@BA@@@@@AB@@@@@@@@A@@AA@C@@@A@AAA@A@A@C@@@A@@@AB@@@B@@@@@D@@@B@@@B@D@@@B@@@@@@@B@B@@A@@@@@A@A@AA@@@@A@A@ABA@@@@@A@@@@@@@A@A@@@AB@@AB@@A@@@@@@B@@@@BD@@@@@B@@@B@@@@CBA@AB@@@@AB@B@@@B@@@B@@AB@@@BBB@B@@@@@@B@B@B@@@@@BB@@B@B@@@B@@@@@B@BB@@@@A@A@A@@@@@A@@@BB@@@@@B@@@@@BA@A@A@@@@@@@@BB@@B@@@@C@@B@@@B@@@@@@@@B@@@@B@@A@ABAB@B@B@@C@@B@@AACB@@AAA@@@C@@AA@A@@@@AA@AB@@AAA@@@A@@@AA@@@@AA@@@A@@C@@AA@@@A@A@A@@AAB@A@@A@@@@@A@CAA@A@A@@@A@A@@@@B@@@B@B@@@B@BA@@B@@AB@@@B@@@@ABAB@@A@BD@B@B@@BD@@@B@@A@A@@BA@A@@A@@A@ABA@@@@B@AA@@A@@@@@@@@A@BBA@@@@@A@@@@@A@@@@@@@@@@@AB@@A@@@@A@@A@@@A@@B@B@@AB@BAD@@@@@BAD@@@B@@A@@BA@@@@@A@ABAB@B@B@B@@@@@@CAAA@@A@@@A@@@@BA@@B@BAB@B@B@B@@@@@B@@B@BBA@@BA@BBA@BBA@@@@BBBB@@@@B@@BA@@F@B@B@B@DABA@@@A@@B@@AB@@AB@@@B@@BB@B@B@@@@@B@B@@@B@B@BA@@BAB@@@@B@@@B@B@B@B@@BBAB@B@B@@@BB@@@BB@@B@@@@B@@BB@@BD@@@@C@AB@@@@BBA@@BB@@B@B@@@BBB@@@B@@@B@B@@@B@@@B@B@@@BBB@B@@BBA@BBA@@@@B@@@B@@@B@@@BAB@DBBA@@B@@@BAD@@BBAB@@B@@@@BB@@@BADA@B@@B@@@B@@@@@B@@BB@@@ABA@@B@B@BAB@B@B@@A@A@@@A@@@@BAB@B@B@@@@@@BBB@BB@@@@@B@@@B@@@@BB@@@@@B@@@B@B@@AB@@C@A@AB@@A@@@@@@DA@@@BB@B@@@B@B@B@B@@@@B@@@A@@@A@@@AB@@@@A@A@@@A@A@A@A@AB@@@@A@@@BA@@A@A@ABC@@@@@@A@@A@@@@@ABA@@@@B@@B@@B@@@B@BB@@@BB@D@@@@@BB@@BB@DAB@BAB@@AB@B@@@B@@@@AB@BAD@@BBB@BBFABB@@B@B@@BB@@@BDBB@B@B@@@B@@@BABA@@BAB@@@@@BA@A@@D@@@@ABAB@D@@@B@B@@@BB@@DBB@@@@BB@@B@@@B@B@B@@B@BB@@BA@AB@@A@@@@B@@@@BBB@B@@@FA@@@@BB@@@@B@@@@@B@@@B@B@@@@@B@B@@@@@@BB@@@@@BAAA@A@A@@@AB@@A@A@@BA@ABA@@@@@A@@@@@A@CAAB@@A@@BB@B@AB@@@@@@BB@@@@AB@@@@A@@@A@@@A@A@@@C@A@A@A@A@AA@@@@AB@@A@@B@@@@@BA@A@@@AB@@A@A@A@@BA@AB@@AAA@@BA@@@A@@@ABAB@@AD@@ABAB@@AB@@B@@BB@BB@@@@@@BB@@@BB@@BA@@@@BC@AB@@A@@@@@@@AB@@@BAB@@@@ABAB@B@@@AA@@B@B@B@@BD@@@B@@A@C@@@A@@B@B@@@@@BBBA@A@ABA@@@@@AA@@C@E@@@C@@A@@@@CAAAA@@@@@@A@@CA@@@A@AAAAA@@CA@@@@A@A@A@A@@@@@A@A@@@A@AA@@@AA@@A@@CAAA@@BA@@A@@AC@AAC@@@AA@@A@@@A@A@@@A@CA@@A@@@AA@@A@@@CBA@@@A@A@@@@B@@A@@@A@@@A@@@A@A@@@A@ABA@@@A@@@A@A@@@CBA@ABC@@BA@@B@B@B@@@@BBA@BB@@@B@B@@ABBB@BBB@@BBBB@BB@@@DB@A@BB@@@AB@@A@A@@BA@@@A@A@C@@@A@@A@@ABA@A@@@A@@@@B@@@BAB@BA@ABAB@@B@@BB@BB@B@@@@BAB@@AB@@@@@DD@BB@@BA@@BA@A@@@@B@@@B@BBBB@@B@B@@@@@BA@@AA@@@@B@@@D@BAB@@@BB@@@@D@@ABBB@BBBD@@B@@@B@@@B@@CD@@BH@BB@B@BAB@@@BAB@@@@BBDBBBB@@DB@@@BABABABCB@@@@@B@BA@@B@@A@@B@@ABA@@BA@@B@@@@@BBB@B@B@B@@DFDB@@@AB@@A@@BA@AAABA@@@AB@@A@@BB@@BB@@B@B@BAB@BAB@B@BA@AB@@A@AB@@A@@@@@C@@@ABA@A@@BA@@@A@AAAB@@A@@BAA@@A@AACCA@@BA@AB@BABABA@@@A@@B@@A@@@A@@@A@@@@@A@@@@@A@@@AB@@@@AB@@@@A@@@@B@@@BA@@B@@BA@@@BB@@@@A@@B@@@B@@@B@@@@@@BB@@@@@B@@@@@@AB@BB@@@@@@@@B@@@@@@@B@@@B@@BB@@BDB@@B@@BB@BB@@@@D@@B@@A@@@A@ABA@@B@@@B@DABA@@BA@@@@@BB@BBB@B@BB@B@@BB@@BBB@@@BB@@@@B@@@BB@@@AB@@@B@@@@@B@BBB@BB@@DA@@@B@@B@@@@AB@@A@@@@@@BAB@BCBAB@DA@@BA@@@BB@B@@AB@B@B@@@@@@B@@@@@@@@BBBB@BB@@@BD@@B@@A@@B@@AB@@@@@BA@ABA@@B@@@@@BABB@@@@BB@@B@@B@BB@@@@@@A@@BA@@B@B@B@BB@@B@@@@A@@B@@@B@@@@@AB@@@@ABAB@@@BAF@@@@A@@B@@DB@@@@@BB@B@BA@@BB@@@@@BA@ABC@AB@@CD@@A@A@@@@BAB@@@@A@AAA@CA@@@BAB@@B@@B@@@B@@@@@@@@@@BB@@@B@@@B@@@B@@B@BAB@B@@B@@B@B@B@B@@ABA@@@@B@@@BD@@B@B@BAB@@@@@BBA@@@@B@@@B@@B@@B@@@B@@@B@@@@B@@@@@@@ABB@@@B@@B@@D@D@B@@@D@@@BB@@@AB@@AB@@@D@@@@@B@AB@@@@@@B@@@B@@A@A@A@@@A@@DBBABBBBD@BBB@@@@@A@ABA@A@@BA@@BB@B@@DAB@BA@AB@B@@@@BBDABCBA@@B@BBB@@@@@@B@@@@ABABAB@BAFB@@@B@B@B@@@B@B@@B@@@@@BA@@B@@@@F@B@@BB@@@B@@BB@BAB@@@B@BBB@@BB@@@@B@@@@@@@@@BB@@@B@@B@@@@BAB@@AB@@@@@@@BA@@@A@@B@@@B@@@BA@@@@@@BA@@@B@@@@@@BB@@B@A@@BA@@@B@B@@@@@@B@B@@@@@@BB@@A@@@@@@BB@@B@@A@@@@@BBB@@B@@B@@B@@@B@@AB@@@@@B@BA@@@AB@@BB@BA@@@@B@@BB@@AB@@BB@@@BBB@@@B@@B@@@B@@@BA@A@@BA@@B@B@BA@@B@B@DB@@B@B@@@@@B@B@@@@@B@D@B@@@B@@@B@@@@@B@@A@@@A@AD@FAB@D@BB@@FAF@@@B@BA@@A@@@@A@@B@AA@@@@@@@@@AA@@@@@@@@@@A@@AA@@@A@@A@@AAA@@@A@@B@B@B@@AB@@AB@@A@@@@BABAB@@@B@B@@@B@@B@@@@B@@@A@@BB@@@@BB@@@A@@@BBB@@@@@@@AB@@B@@@@B@@@B@@@@B@@B@BBB@@B@BB@@@@@@BB@@@@ABB@@@@@B@B@BB@@BB@B@@@@@@BBA@BBB@@BB@@B@@@BB@BBBAB@@@@@B@B@@@B@DABA@@@@@B@D@@@@@@@@@BBBA@@@@B@@@@AB@@A@@@A@@@BB@@B@B@B@@@@B@@A@BB@@B@@@@B@@@B@@@B@@B@BAB@@@B@B@B@@A@AA@@A@@@AB@@@B@D@BBB@B@@@DC@@D@BA@@@A@A@@CACA@@AA@@@@D@DBB@F@BBB@@@B@B@B@B@@@@@@B@B@@@@BB@@@@@B@@@@@B@@@@B@@B@@BB@@BB@@B@@A@B@@@B@@@@BB@@@@BBB@ABB@@@@B@@@@BB@@@@@B@@BB@@@@@D@@@@@B@BBA@@DC@@B@B@B@DBD@B@B@@@D@@AB@@@@@B@@A@ABA@@D@B@B@@@@@@@@C@@B@@A@@@@B@B@B@@@BAD@B@HEBCB@@@@@@@C@@@@AD@B@@@@BA@@B@@@B@@@B@@BA@@B@BC@@B@BB@@B@BA@@B@@@BA@@BA@@DBB@@@BAB@@@@@@A@@BA@@B@@@@A@A@@@@B@@@@@AAAAACB@BA@@B@B@@AB@BA@BB@@@@B@BBB@@BBB@B@@B@B@@@@ABA@@@AB@@@BA@@B@@A@AB@@@@@BB@@@B@BAB@@@B@B@@B@@B@AB@@B@@@B@@@@A@@@@D@@AD@B@@@@@@@@@@BA@ABA@A@@BADABABABBD@@DD@@@@@BA@@BA@@@@@@@@B@@@@AB@@BBA@@@@@@@ABB@B@B@@@B@@@B@D@@AD@@@@@B@@@D@@AD@@ABA@@@@BADCB@B@@@DAB@B@BBBB@@@@BAB@BBBBFBBC@AD@@AB@@A@AAA@A@A@ABA@@DA@@BA@ABC@@A@B@@A@AB@@A@@BA@@BA@@BABA@A@@@AB@A@@AE@A@@@@@CCA@@@C@C@AA@@@@A@@@A@@@@@A@A@@A@A@C@@BA@@@A@A@@BA@ABA@@BA@C@@BAD@B@B@@@@@B@@BBA@BB@@@B@B@B@DBBBB@B@@@@BB@@@B@@A@@AA@@@@@AB@@@@AA@@@@AB@@@@A@@@@@@@ABAAA@@A@BAA@@@BA@@@A@@@AB@@@@A@AB@@@@AA@@ABA@@A@@@BA@@AA@@@@@A@@@A@A@@@@D@B@@@BB@@B@D@B@B@@@D@B@D@BAB@B@D@BA@@@@BB@@BBB@BBB@@@@BB@@@@@@@B@@A@@@@@A@@@@@A@A@@@ABA@@@@BA@A@AA@@A@E@A@AA@@AAACE@@AC@AAAB@@@@AB@B@@@@@@AB@@@DA@@@@@@@A@@B@@@@@@@B@@@@@BAB@@A@@B@@AB@@@@A@@@@B@BA@@@@BA@@@@B@@@@@@ABAB@@A@@@@@AA@@@BA@@B@@@B@@@@A@@A@@@@ABB@A@@B@@@@A@@@@A@@@BA@@DA@A@@@@@@@@@A@AD@@A@@B@@@@A@@B@B@@@@@@A@@@A@@@@@@B@B@B@B@BA@@@@@@@ABA@@B@B@B@BADADA@@@@@@B@@@BCB@D@BAB@@AA@@@@@@@@@@AB@@@B@DA@@BA@AB@@AB@B@BADE@@B@@AB@@A@@@ABADABAFAB@@@BCB@DABAD@B@@A@@ACAA@A@A@C@A@E@ABABA@ABA@@FA@A@@@AB@BAB@BAB@@@@ABA@ABC@@@@@@B@@@@CA@AA@ACA@@@A@@@@@AFADA@ABA@@BA@@@A@@@@@A@@@A@C@AB@BA@@B@B@BA@ABA@AB@@@@@B@B@B@@@BA@@AA@@@A@A@@@@@@B@B@B@B@BABB@@@@@@@@@B@@@B@@B@@B@BBBB@@A@@BABA@@B@@A@A@@@A@@@ADABA@A@@DA@@@@@CBA@@B@B@@@@C@A@@@@B@B@@@B@AC@ABA@@A@AAAB@@A@ABA@@A@A@A@A@@AA@@@@B@DA@@B@B@B@@@FAB@B@@@@A@@A@@@BC@C@A@A@@@@@AA@@AAA@AAC@C@C@A@A@@@@@@AA@A@@@AD@BCDA@@BAB@B@B@BA@@@A@@@@B@@@@@@B@@BBB@B@@@@A@@@@@@BAA@@@BA@AB@@@@AB@@AA@BAA@@AB@@ABA@@A@BAA@BA@@AAB@@A@@A@@@@AA@@AAA@@@@AA@@@@@AAAAA@@@@@@AA@@@A@@@@@@@AAA@C@A@@@A@AB@BABABAB@@@BB@@BCB@B@D@@A@@B@AABA@@@A@A@@@@BADBB@@@@A@@@@@CA@@@AA@A@@BA@@BC@@@@@AA@@A@@BA@@@@@A@@@A@@@AB@@@BA@@B@DBB@@@B@@@BABABAB@@@BB@@B@@B@B@@@B@BBB@@@@B@BA@@@@@AA@@A@@@@@@@@@A@@@@@@BB@B@@B@BA@@BA@@BA@@BA@@@ADC@AD@B@AA@@@A@A@AAAAA@@B@@C@@AA@A@A@A@A@@CAAA@@@AAA@ABC@@@@@@AAA@@@@C@A@@@@A@@@A@A@@@@@AA@@@AAAA@@A@@B@@A@@BA@AB@@@@A@@A@@AAAA@C@CAA@AA@@@EAA@AA@@AAA@@@@@A@@@@@A@A@@A@A@A@@AEEAAA@@@A@IA@@@@@AB@BA@ABABA@AD@BA@@AA@A@A@AB@BA@@@@@A@@@@BA@AB@B@@@@A@@@AAA@A@@BA@AA@A@@@CAAB@@AAA@AAB@B@BABABABC@ABA@@DA@@B@FBB@B@@@@AAAA@AA@@@@@AB@@@@@@AB@B@BB@@BBB@B@@@AAAEAA@C@A@A@@B@@AB@@EB@@AB@D@BAB@B@@A@@D@@A@@BA@A@@@A@A@A@E@A@C@@AA@AEACCA@AA@BA@@@@@@A@@@@@AAA@AB@B@H@D@@@@CBABA@AB@BABA@@AC@AACCA@A@@@@@ABC@@BAB@BAB@@@@A@@@@@A@@@@B@@A@A@@A@@@A@AA@@ACCCAC@AAA@A@ABAAA@AB@@@B@B@B@B@@@@AAAB@BA@@@@A@A@@A@@BA@@BA@A@@@A@ABC@A@@@@@A@@@@@@@A@@A@A@A@@@AAB@@ABA@@@ABA@@BCA@AAACAA@AA@@@@@C@@@A@AAA@@A@A@@A@@AA@CA@@@@BC@ABA@ABA@A@A@@A@AA@@@@@@@A@@@@B@B@@@BCBA@A@AB@@@@@@A@@AA@A@@@@@ADABA@@BA@A@CB@@C@@@AC@@BABA@@@C@AACA@@@@@A@@@@BAB@B@@@@A@A@A@@@E@E@@AAAAB@@A@A@@@A@@@C@@@A@@BA@ABA@@BA@@@@@C@@@ABA@ABA@@B@@@@BB@@@@@@@BA@@@@B@@B@@F@DBBBBA@@@@AC@@AAA@@@AA@A@AB@@@@@BB@BDD@@BB@DB@@@@@@ABA@@DAB@@A@@AA@A@@@@B@B@F@B@@@@@@A@@@AA@AA@AA@@AAA@A@@BAB@B@B@BA@ABA@@BAB@@A@@@@@A@@AAB@@C@@AA@@BA@CA@@AA@@BA@@BA@@A@@AA@AA@AA@@A@@@A@A@@A@AA@@A@@BE@AB@BAB@@A@@B@DAB@@@@A@@ACAA@@@AB@B@B@BA@@BA@AB@B@@@BA@@DA@@@AA@@ABAAABA@@@AB@AA@@@@AAA@@@A@@@ABA@@@@@@@@@DE@A@@AA@A@@@AA@CBA@A@A@@A@ACBA@AA@@@@@A@C@CBE@AAAAAA@@AA@A@@A@BA@@B@@@B@@AAAAA@@@@@CDABABA@AAAAA@AA@@A@A@A@A@A@A@C@C@A@@@ADADA@@@A@AA@A@@A@C@@@A@@@@@@A@@@A@A@@A@@@C@A@@@A@@AA@@@A@A@A@AA@AA@A@@@AB@@A@AA@AC@C@@@@A@@@A@A@ABA@@@A@@@@A@@@@@ABA@@@@B@BAFA@@BA@@@AA@AA@@@AA@@@@@@@A@@AA@@A@CB@BA@EC@@AAA@@@@@@B@@AB@@A@@BA@@BC@A@@A@@A@AA@@@AA@@A@@@AAAA@@@AAC@@AA@AA@AAAA@AAA@@@@AABA@A@ABAB@@@B@B@B@B@@@@A@@@A@AB@B@B@D@@@B@@@@@@AC@AA@@@A@@B@B@HA@@@AAEAA@@@@AB@@A@@@@@AAA@@@@AA@A@@@@A@@@CAA@@A@@AE@ACCAA@@A@@@A@A@AB@@A@@@@@ABA@C@@BA@@BCDA@AACAABA@@@AFA@@B@@AA@@@A@AA@A@@A@@AGA@@AA@@@ABAB@@A@@@A@A@AA@@AB@@A@@A@@@A@AB@@ABAB@@A@@CAAA@A@@BA@@BA@A@@BA@A@AB@@A@@@ABA@ABA@AB@@A@@@AB@@CBC@C@A@@BC@@@A@@B@B@BAB@@A@AB@@AD@@@BC@AA@@A@A@AAA@AAA@A@A@@@@AA@@@BA@AB@A@B@@@@A@@@@@@B@@@@A@@@@@@@@B@@A@@@@@AB@@@AA@@AAA@@CA@@@A@@D@BA@@A@@A@@A@@@A@@@AA@@@A@@@A@@@ABB@AA@@@@A@@@A@@@A@@@A@@B@B@BBBAB@BBB@@@BABC@A@@@@AAA@@@@@@ABC@@@@D@@AB@@AB@B@@ABA@@DA@ABA@@BBBB@@B@BA@@D@B@@@B@@@B@B@D@D@D@@@F@B@B@DB@@@@@@B@@@D@@@@@@A@@B@@@BCB@@A@@@AA@@AA@AAAA@AAA@@@AAC@@@AB@BABA@@@A@@B@@@AA@A@@@A@@@@BA@@@@@A@@@AA@@C@@@@@A@@B@@@B@@@@AB@@@B@@@@@B@@@@@@A@@B@B@@AB@@A@@@@AAAA@@@A@AAAB@@@B@B@BA@A@AAA@@@A@@@AB@@CBC@@@@@D@@BB@@BB@A@@FC@@B@@@B@B@@ABA@CB@@ABA@A@@@@BA@@B@BABB@@@@B@@A@@BC@@@@D@@A@@@@@C@@CA@@CAAA@A@@B@@@@@@@@AA@C@A@@@C@ABCB@DCBAD@@A@@@A@@B@@A@A@@@AAAA@@A@A@@@AB@B@BAB@@A@AAAAAAA@AA@A@@AA@AA@ABA@AA@@@A@@A@C@A@@BABA@@@@@A@@A@@@A@A@A@AA@@@A@@@@BAA@@A@@@AA@@A@@@AEAA@A@@A@@@@BA@AB@@@@@@@A@A@@@@AA@BA@@@A@@@A@@@ABCBA@@@@@@@@ABC@@@AA@@@A@E@A@E@CAAAAA@@CEAAA@@ACBAAA@AAAA@@AA@@A@A@A@@@C@@@A@"],encodeOffsets:[[111888,37425]]}},{type:"Feature",id:"610627",properties:{name:"甘泉县",cp:[109.351019,36.276526],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@AB@@@A@@AAC@A@@@A@@@@BAB@BA@@BA@@BC@CBABAB@@AB@@@BB@@B@@ABCD@B@@AB@@A@@@A@A@I@@@AA@@A@@@@BAF@@@@@BC@@@ABCD@@CAA@A@@@@D@B@@@BA@EBA@EAC@@@AAAAAA@@@@@@CBA@AB@BAB@B@@A@A@C@A@AAAA@AAAA@@@A@@@A@C@@B@@@BADA@A@A@ABAB@B@BA@A@@@@@AA@@A@A@@@@A@A@AAA@@@@A@@@AB@@A@@@AA@@@@@@A@A@@AAAA@A@A@AAAA@@C@@@AAAA@A@@AA@@E@@AA@A@@AAB@@AAA@CA@@AAAC@A@A@CA@@@@AC@CBCBA@A@A@@AAAA@@@A@A@@@A@@@@@@E@CA@@@AA@@A@@A@@A@AB@@AB@BCBAB@B@BA@@B@@A@A@@@ABA@@BABAB@@A@@@A@@@A@@AA@@@@BAB@B@@AB@BA@@@A@@A@@@@A@@BA@CBA@A@AB@@A@AA@A@@AB@@@B@@ABAAA@ABC@CA@@ABAB@B@AA@@AA@A@AB@@@@@@ABA@@BA@@@AB@BA@AAAA@B@@AB@@AB@@AB@@@@A@A@@@AB@@AB@@ABA@CB@@AD@@C@@@@B@@@B@@@B@@AD@B@B@@@BAB@@AACAA@@@@B@@@B@@C@@@A@A@@BAA@@C@@@@AACA@@@A@@B@@A@@AA@@@@BA@@@A@@@@@AAA@A@@@CAACA@@@@@A@@DA@@BAAA@@@CA@BA@ABAB@@A@@AA@@@ABABABABAB@@BB@B@BA@@BA@CB@@A@@B@BAB@@AB@@BD@@AD@@@B@B@@@BBB@@@BCBABA@ABA@A@A@AAA@@@@BAD@BBB@@AB@@@BBB@@@B@@ABA@AAA@@BA@@B@FAB@B@@A@AB@@C@EC@@A@@@A@@@A@@@@@@@@B@BABCB@@@@@B@B@B@@AB@BA@ABA@@@@BA@ABA@A@AA@@AA@@@@A@A@@@ADAB@@AAA@@@@@A@AB@BABA@@BA@@@AD@@AB@@A@@B@B@@AB@BB@BBBD@B@@@BB@@@B@@@@@BBBB@@BBBBA@@D@BA@AB@B@B@B@B@@@@ABABC@ABEBA@@B@@B@@@BBA@@BABABAB@@AB@@BB@B@BB@@@@@AD@@@@@D@@@BABA@@@GA@@A@C@@BA@@@@BB@AB@@@@EBA@@@@BA@@B@B@B@@@B@B@B@@@@@BB@BBBB@B@@@@BB@BB@@@DBB@BBB@@B@B@@@BB@BB@@@@BB@BE@A@ABA@@@@BA@AD@@C@A@@BA@@@AA@@@BA@AB@@A@A@A@@@A@@@@B@B@B@B@@AB@@@B@@CB@@AB@@ADA@ABA@@@A@@@A@@@@D@@@@AB@@EB@@ABAB@@@BC@@@AB@@@B@@CBAD@BA@A@@@A@@@A@ABA@@@AB@@@BA@@@@@AAA@@BA@@@@B@ACAA@A@C@A@CA@@AA@@@@A@AB@@ADA@A@A@CA@@CDA@A@AA@@CA@@AB@B@BA@@@ABEBA@@AA@@A@@@@A@@B@BA@@@A@@@ABC@AB@@@B@@A@@@A@@@A@@@@@AA@@A@A@AB@@@@AB@DA@@@AB@@A@@BA@@BA@@B@DAB@@@D@@A@A@@@A@@@A@A@AAAB@BAB@@CB@@A@C@C@@@CAACAAA@@@A@@@AB@B@@AAA@@AAC@A@@A@@@AB@BA@CBA@@@A@@@A@@@A@A@AB@@@@@B@@@BBB@B@@@BAB@@A@A@AA@@A@AA@AA@AA@@@@@BAB@BAB@@A@ABA@AAABAB@@@BAB@@AB@@ABA@@DCB@@@@A@@@@@AA@AA@@@A@C@A@@@@A@A@A@@A@A@A@@@AAA@AA@B@BAB@@A@A@@BA@C@AB@@@B@DAB@@@@A@C@@@A@AB@AAAAA@@@A@AA@GA@@@B@@@@@BA@@DA@ADABABABA@ABA@ABA@@@@@ADCD@@@@A@@@A@A@CB@@A@AC@@@@A@@@AB@@@B@BA@@@ADCB@BADA@@BAB@@A@AB@@@BAB@@A@A@@@CAAC@B@DADAB@D@@B@@BB@@B@BAB@@BB@@ABA@@BAB@D@@@BA@@BBDBD@B@B@B@BBBB@@@@@@B@@@B@@@@@@DFBB@B@@BBBBBB@@B@@@BB@@D@@@B@BAB@@B@@@@@B@@BB@@BBBB@@@@BBDBB@BBBBB@@@BB@@D@@BB@@@@@BB@@@@BB@BB@ABB@BDDDBB@@@BBBB@@B@@@B@@@B@@@B@@BD@B@B@B@BB@BB@B@@@@BB@@@B@@@@DFBB@@@@BB@BBB@BB@BB@@@BBD@B@@@@BB@@BB@@BBA@BB@@@@@B@@B@@B@@BBB@@BB@B@@B@@B@BB@@B@@BB@@BBB@@@B@@BB@@B@@@B@@BBB@@B@@@BB@@@@F@@BB@@@@@BBB@DBBA@@FD@BB@@B@F@B@BBB@@@BB@AB@@@@@B@BBB@@AB@@BBBB@B@@@BA@@BB@@B@@@@@@BB@BB@@B@@@@@@BB@@@BB@@BB@@@B@@B@@@B@@B@@B@@BB@B@@ABA@@@@@@BBB@@@@AB@@@BAB@F@@@@FBDDB@@@BB@D@B@BBBADBBBDDF@BB@BBB@B@D@BBB@B@BBD@B@BBF@DB@@DB@@B@D@@@BBB@@@@B@B@@@@@BB@B@B@BAB@DABABA@@B@B@B@BAB@DGB@BCBA@@@@BADC@@BABAB@D@B@D@FCDAB@DAD@@AB@BADAB@@@@@@B@@@B@B@@@B@@@B@D@D@DAB@@@@@B@@@D@B@@@B@@BB@B@BB@@@@B@@@B@@@BBB@@@D@BBB@BBB@D@@@D@BCB@B@BAB@@@B@@B@@B@BAB@@@B@@@B@BAB@D@@@B@D@@@@@BBB@B@@@D@@ABA@ABA@@@ABCBABAB@@@B@B@@BBBD@@@BA@@@A@A@AA@AA@A@@@AB@@@D@BAB@BAB@BBB@BB@@B@@AB@DAB@B@BCDA@AD@@@B@DA@@@ABA@@@@B@D@B@B@BADB@@BBB@@@B@@A@@BA@@@@AA@A@A@CBCBA@@BC@A@C@@@@@ABAB@@AB@@@BB@B@BABBBABB@@BBDDB@@@B@@B@@B@@@BA@@B@BBB@@@HCB@BAB@@@B@BBD@@AB@BCB@@@BAB@B@B@BB@@B@@@@@BABCB@B@D@B@@@B@DA@@@@B@@B@@B@DB@@@@@D@@BBB@@@BADCDABABCBA@AB@BA@@@AB@@B@@BD@@B@DABA@@@@DB@@FAB@@@@A@AB@D@B@B@@@BCBA@@F@@@D@B@@@BB@@@B@@DBB@@@@AB@B@D@@BB@@@@@B@BA@@B@@@DA@ABA@@@@D@DA@ABA@@@@B@DBB@@@B@@@@@BB@@@@@@BABA@@@A@@@A@@@A@@BAB@BA@@BAB@DAB@@@BB@@@@DB@BB@BA@@B@@@B@AABA@@BADABADC@AB@@@FB@@B@@@B@@AAA@ABC@A@@@AA@BA@ADABAB@BABA@@BBBB@B@@@@BBB@@B@B@@BB@ADA@@@@DABA@@@@BB@@BBDB@@@@BAB@B@@@@@BABA@@BA@@DBB@@B@@@@B@B@BAB@BA@@BA@@B@@@BB@B@@B@B@@@BA@@@@BA@@@A@AAA@@BA@A@@BAB@BAB@@A@CB@@AA@@A@ABC@A@CB@@@@BB@BB@@BA@AB@@@B@@B@@@@BABAB@@A@@@AB@BCBA@A@@BABAB@@@B@@@BBB@BB@@BAB@@A@@@ABA@@B@@@B@B@@@B@BAB@@ABA@CB@@A@ABC@A@AA@@AA@@@@@@G@@BABAB@@@BA@A@AD@BA@@@@DB@@B@@@D@@@BA@AAC@ABC@AB@@@B@BBB@@@BA@AB@B@@@B@@BD@@@B@@@B@DAB@B@B@B@@AD@BAB@@AB@BBBBBB@@B@B@@@B@BEB@B@B@@BBB@@FB@BBB@BB@@BB@D@B@BAF@@@B@D@BB@A@@@@AA@@@ABC@CBA@ABA@AD@@@BAB@DA@@B@D@@@@A@@@@B@@@D@D@BA@@B@BB@@BB@B@BB@FB@@BABA@@B@B@B@BBBBB@B@BB@@@@B@B@BBB@DB@BBD@DBB@@BB@@BB@@@BB@B@@AB@@@FBB@BA@AB@@C@@@@D@B@B@@@BC@ABAB@@@@BB@@BB@BBD@B@@@@@@@@@@A@ABCB@@@F@B@B@B@B@BABABGB@B@@ABCB@@ABAB@@@B@@@B@@@@@DC@@DAB@BA@@BA@A@@@A@@@ADABA@@@@BABA@AB@B@BAB@@@AC@A@A@A@C@@@AAA@C@@B@B@@A@@@@B@@@@@@ABA@@BA@@@AB@@@@@BA@@B@BAB@@A@A@@AA@@@AA@@C@@@A@@B@B@BA@@B@@@B@BA@A@ABA@@BABABB@@BBBB@@@@B@B@@@B@@@BABAB@@A@@BAB@BA@@@C@@@A@ABA@A@@@A@@@@B@@@B@B@@BBB@@B@@@@@BAB@@@@@BB@@B@BA@A@@B@@@BBB@FA@B@@@@@B@@BDB@@@D@@@D@BABAB@B@@@@@B@@@@C@A@@B@B@@E@@@C@@B@BAB@@@BB@@B@B@@AB@BABC@@@@B@@@B@B@@B@BDABB@@BA@A@AB@@@D@@B@@@@B@@@BAB@@@B@B@B@@@@@BADA@@B@B@@AB@@A@@BA@@@BB@BCDAB@@@DABA@@B@@B@@BB@@B@@@@ABA@@B@@@@@@A@@@@@A@@@@@A@@BA@@@@BABA@@B@@@D@B@B@@@BA@A@A@CB@@A@@@AB@@A@@B@B@@B@FB@@BD@@B@@@BBB@@AB@@@B@BAF@B@@BBBB@BBB@@@@@@@@@BB@@@BA@@BA@@BA@@@@@@BABA@ADC@@B@@BB@@A@@@@@A@@@BB@B@BBD@BBB@@BB@@@@A@@BA@ABA@@@AB@@B@@BB@@@@DA@@B@@@BB@@B@@AB@@A@A@A@CBCDA@@@BB@DB@@B@@@@A@@@@BABAH@@@D@B@B@@@BA@@@C@@B@B@@A@@@@@@BA@@B@@A@AB@B@@@B@@AB@@BB@B@@@@@BABABABA@A@@@A@@@A@@BA@@@@D@@@@A@@@@BA@@B@@@BD@@BB@BB@@@BBB@@@@B@@@AB@@A@@@@@@BA@@@@B@@@B@@AB@B@BDBBB@B@@ADADCB@@@@@B@@BB@@@@@B@@A@@BC@@BA@@@A@ABAB@@@BAB@B@B@BB@@B@B@@BB@@@@@DA@@@A@C@CB@@AFGB@@@@@B@B@B@B@B@@A@ABA@@BA@AB@@@@@BBB@@@BA@@BABABABA@A@@BA@A@AB@@AB@BA@@AAB@DAB@@@@@B@BD@B@@B@@@B@@@@@BB@@@@BB@@@@@B@@@BB@@AB@@@@@B@@@BB@B@@BB@@BC@@B@@@@@B@@A@@@ABAB@@ABA@@BA@@BA@@@AB@@@@AA@@A@A@ABA@A@@AC@A@@AAA@@A@C@C@A@AB@@C@ABA@@BE@@@@AAAC@A@A@ABA@A@@B@@AB@@A@@BAB@BABCB@@ABCBA@A@@@@B@@@BA@@@@@AB@@@@A@A@A@@BC@@B@@@BB@@B@BAB@@@B@DC@AAE@@@A@@@A@A@@@CDA@@B@B@B@BE@A@A@@BC@@@A@C@AAA@A@C@A@A@A@@@A@AB@B@@@@ABA@C@@BA@@D@BA@@@A@@@A@@BABA@@@A@@ACA@@A@A@A@A@@@AAAB@@A@A@CAA@A@AB@BC@@AA@@@@BA@ABC@C@@@@@@BC@E@@AA@AAA@@@@@@A@A@@@@@AA@A@@BA@E@@CC@@@@BC@A@A@@BAB@@AB@@A@C@@AAAC@@@AB@@@BAC@@A@@AA@AAA@@@@EAA@@@A@@B@B@@A@@BA@CBAB@@@@AACEAC@@@@AB@@EB@@@@A@@A@@AC@@@E@@@@@@E@C@A@@@@@@C@A@C@AAA@A@@A@A@@@A@@C@CCE@AA@@@C@EBC@C@@@@AACACACA@@@A@CB@@A@AAA@@AA@@A@AAA@A@@AAA@AA@@@@@@@@A@@C@A@AA@CACA@@AACAAAACAAAACA@@C@@B@@A@A@A@@@@B@BA@AB@@A@A@A@@@@AA@@@@A@@@@AA@A@@AAA@C@C@@@AAAEAAAC@@A@A@@@A@AAA@C@A@CAA@A@AAGCEAAA@@@@@AB@BABCBA@C@A@ABAAC@CA@AAAAC@@@CCAA@A@@A@@AA@@@AB@@A@@@@@@C@AA@AAA@@@A@@@CD@@A@ABA@AAAAA@@@AAA@@@@@A@AD@@@@A@@A@A@@A@@@@@A@A@@@@@AA@@@@A@A@@@@BAACA@@A@@@@@ACA@A@@@A@A@@@ABAB@@A@@@A@@@CABA@AB@@AAA@AAA@AA@AAA@AAA@AAAAA@@A@A@@@AC@EAAA@ACCAAA@AAA@@@A@CA@@A@I@@@A@A@@@ABA@@@A@A@A@@AA@@BA@A@AA@@A@@A@@C@@@A@@@AAA@A@A@@@ABA@A@@BA@A@A@A@A@@@@@@@A@@B@A@@A@@@A@AB@AA@@@@@@A@@@@A@@@@@A@@@A@@@A@A@@@@@AB@@@@C@A@A@@B@@A@@@@@AB@@A@@@A@A@AA@@A@A@@@@@A@A@@@C@@BA@A@@@AB@@C@AB@@A@@@A@@@E@C@A@ACA@A@CAA@C@C@@AA@A@@@A@C@@B@@@B@@@@"],encodeOffsets:[[111918,36988]]}},{type:"Feature",id:"610625",properties:{name:"志丹县",cp:[108.768432,36.822194],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@MDSHCHABABAB@@IH@BA@A@@@AB@BA@GD@BA@ABEDA@@A@@@AAA@AAA@E@GDKJMBGEECGCEEGGICCCCAAA@A@GFGHA@A@A@E@ICEECACAE@E@EBAB@@@@A@@B@@@@AB@@@BA@@@@B@@A@@B@@@B@@@@@@@@@B@@A@ABC@CBI@C@IBGDC@GHGFILA@K@A@@@ECA@EAC@AB@@AD@DDFJJ@B@D@BALAL@HABGBC@AB@@BH@B@@INCZCBIAC@MTE@GDEBADADBFBR@BCLINWROFO@@@kD@@I@IAGCACEEGACBCHCFCDEBEBKHEAGEA]AACBSTCD@DBFEJABAA@@A@@B@@G@]AAAMDKLEV@FEJBFADABB@@@@B@@BDB@@BA@@@@@A@@@@B@@B@@@@B@@B@@@@BB@@@DB@BBBBB@BB@@B@@BB@@@BA@@B@@B@@@@@B@@@@B@@@B@@@BB@@BB@B@@@BBBD@B@B@@B@@@@@B@@B@@@@AB@@@@BB@@BB@@BB@@@@@@@@@@@B@@@@@@B@B@@@BCBA@AB@B@B@@@BBB@@@@@B@@@@A@CB@BC@@A@AA@@@AB@@@@A@ABABAB@@@@@BB@@D@@B@@@DAD@D@D@BB@@BB@@@DA@@BBBBB@@B@@@B@@@BA@@BA@A@@B@B@D@BABADCDADCB@@A@@A@@@C@CAC@@@@AA@@@@AB@@AB@BABA@@B@@@BBB@B@@@B@@@B@@@@AB@B@B@BBB@@@B@@@B@@BBAB@@@@@BB@@@D@@@DCFGB@BA@AB@AC@AB@B@@@@@B@@@BD@BDD@@B@DAB@@@BB@BB@BB@@@@BA@AB@@@BB@@BBBBB@D@@@@B@B@@@B@BB@@@@@@@B@@@@AB@@@@ABA@ABC@@BA@C@AB@DAB@BB@@BB@BBB@B@@@@C@ABA@@B@@@B@@@B@@A@@@AB@BAB@D@B@@ADAB@B@B@@@D@B@@@BA@@@@@@B@@@@@BAB@B@H@DA@B@B@B@@BAB@@BB@B@@B@@A@@@@B@@@B@@BB@AL@B@B@@@@B@BAB@@@@AB@B@@@@@B@@@B@@@BA@@@@@AB@@AB@@A@@@A@A@@BA@@@B@@BD@@D@@D@@@B@@@B@@AB@B@B@B@B@B@B@@BB@AB@B@B@@B@@@@AD@B@D@@@BAB@@@D@@@BA@@D@BAB@@@B@B@BAB@@AB@B@@B@@@DBBB@@B@@@@@@A@@BA@@@@B@@ABA@@B@BBF@B@@@@B@B@@@D@D@@A@A@AB@@@B@D@@BBB@@B@BBD@B@BB@@@@B@D@B@B@@AD@@@B@@D@@@BB@@@@AB@@BB@@@ABAB@B@@@B@@@BBB@@@D@@@BB@@@@B@@@@@@B@DB@@@@@J@@B@BB@@B@@@B@@@@@B@@@B@@@@@@@B@B@B@@@@@@A@BB@@@@A@@@BBAB@@@@@B@@AB@@B@@@A@@B@B@B@D@@@B@@@B@@@@BB@@CB@@AD@B@BDB@@@BB@AB@D@B@@@@B@@@B@BBBB@@@@@B@@@BB@@@B@B@@@@@@BBB@BB@BB@@CB@B@@@BA@@@A@@B@@@@@@@BA@@@@@ABABAB@@@@A@A@@@A@@@A@@@@@@B@@B@@B@@@B@@@@AB@AA@@@@BA@AAA@AAA@BB@@@BAB@B@BB@@@AB@BA@BB@B@B@@@B@B@@AB@@@@A@@@ABA@@BA@A@@@@BA@@BAB@@@@@@@BA@@@AB@@@@@B@@@B@B@@A@B@@B@@@BAB@@@@@B@@@@AB@@B@@B@@AB@B@BAB@@@@@B@B@B@@B@BBDB@@BB@@BB@@B@BBA@@@@BA@@B@@@@A@A@@B@@C@@B@B@B@B@B@B@B@BABAB@@AB@DABAD@@BD@B@@@B@BAB@@@BBBA@BB@D@@@B@@@@@B@@ABA@@@@B@B@@@BA@BBB@@AB@@@B@@@BA@@@A@@@@D@@@@@@F@B@B@@B@@@@@@B@D@@@@B@@@B@BC@A@A@C@AB@@@B@@@BA@@@@B@@B@BAD@BAF@B@BB@@@@BA@@D@@AB@@@@@D@@@@@@B@@AB@@@@BBB@@@B@BB@@@B@BADABA@A@@@@F@@@B@@@B@B@@@@@B@@@B@@@@@B@BB@@@A@@B@@@B@@@@@@BBA@@@A@@@A@@A@@@@A@C@AA@AC@@B@BAB@B@B@B@@@B@@@BAB@B@B@@@BA@@@@BBD@@@B@BBB@@@@@@B@@B@@@@@@@@@BB@@@@B@@@BA@@@BBA@@@B@@ABADAB@@@B@@B@@AB@@@@@@B@@@@B@@@@B@@@@FD@B@D@@B@B@@@@@@B@B@BA@@D@@B@B@BB@@BB@@@@B@BABA@A@@B@B@@@B@B@@@B@@B@@BDABBB@@@@@@@B@@@@BB@@@@BABA@@@@@AB@B@@A@@B@@A@@@A@AB@@@@@@ABA@C@AB@@@@@EA@@@A@@@C@AB@@A@@@B@@B@@@BBB@@@BB@@B@@@@@B@@@B@@@@@@@@@B@@@@@BBB@BDBB@@@@B@@A@A@@BA@@B@@A@@BA@@B@@B@@@B@B@B@@BBB@@@D@@A@@B@B@@@BBB@B@@@@@@D@BAB@B@@@@@@DB@@@B@@BB@@@BA@@B@@ABB@A@BB@@B@B@BBB@BB@@@B@@@BA@@@@B@@@B@@@B@@@B@@@B@@A@B@BB@BB@@ABB@@@A@@@@@AAEBA@A@@B@@@@@@@@A@@@ABA@@@@@A@@@@@A@@@@AA@AAA@@AC@@@ABA@@@A@@@@DA@@BA@@@@B@@@@BBB@@AB@@BBBA@@B@B@@BBB@@@@B@@@BA@@B@@B@@@D@B@F@B@@B@@@@@@DB@B@BBB@@B@B@@AF@B@@@@@B@@B@BAB@B@B@@B@B@@@B@@@B@@@BB@BD@@BB@B@@BABABA@@BBB@B@@@@@BB@@@BBDBBB@@@BBB@@@BB@@@DB@@@B@@@@@BA@A@@@@BB@@DB@@@BB@@@B@@@@B@BAD@B@B@@@BAD@@AFBBB@BBBD@@DB@@B@@@@@@B@@@@@B@B@B@@B@B@@B@@@@B@BA@@@A@@@@B@@@DB@@@@BA@@BA@@@AB@B@@@@@@BBB@B@B@B@BBH@@BBBB@@A@@@@@@B@@@B@@A@@@@B@@@@@@@@@B@@@@AB@@@@@@@@@BAB@B@@D@@@BAB@@@@@D@BA@@B@BA@@D@@@@@@A@@@@@@BB@@B@BBB@B@@A@@B@@BB@BAB@@B@@B@@A@@D@B@B@@AB@BA@@@@B@@@@@@A@@@A@@@A@@BA@@@A@@@@@@@AB@B@@A@@@@BA@@@A@A@@@ABA@A@ABA@A@A@@B@B@@@BB@@@@@B@@@@BA@AB@@@BCBA@A@@@A@@@AB@@A@@@@@A@@B@@@@@BBB@@B@@B@@@BA@@@A@@BA@@@@@AB@@BBB@@@@BA@@B@@BB@B@@B@D@B@@@@@B@@@@BDB@BAB@B@@@@AB@@ABBB@@@D@DBB@BA@B@@@@@B@B@D@@@B@@@BADAB@BA@@B@@@B@B@D@B@@@B@@@@B@B@@@@B@@@B@BAB@@AD@@B@@B@@@BBB@BB@@BB@ABA@A@A@A@@B@@@D@@@@C@A@A@@@@BAD@B@@@@A@AA@AA@@A@A@A@A@A@@@@@@A@@ACAA@@@@A@@AA@A@AA@A@@@AA@A@@A@A@@A@@@@@ABA@@@@A@@@A@@@A@@@@@@A@@A@@@A@@@AA@@@@AAA@@BABA@ABB@@BB@@BB@@@BB@B@@@BAB@BA@@B@@@BBBD@@@@BBBBBBD@BDB@@@F@B@@B@@@@ABB@@BA@@@@@@B@BDBBB@@DBB@B@@@@BBA@@B@@A@@@@B@@AA@BA@@@@B@@@@@BB@@@@B@@@@@B@@@@@@@B@@@@AB@B@@@@@B@@BDB@@@D@@@AB@@A@CBD@DABD@BB@@B@@B@@B@@@@B@@B@@B@@@@@B@@@B@B@@@B@@A@A@A@A@@@AB@D@BAD@@@B@BA@@B@BAB@@@D@@@@@AA@A@@@@B@@@@@BA@@BBB@@@@@@AD@B@@ADA@@@AB@@AB@@A@@B@@A@@B@@@@@DA@B@@@BB@@BB@ABB@@B@@BBB@B@@@@@@ABAB@BAB@B@@@@@B@B@@A@ABBDAB@@@@@@B@BAB@B@@D@D@BB@@B@B@@@@@B@@@B@@@@BB@@@@@B@@B@@B@@@@@@B@@@@B@@@BB@@@@@@@@@A@@@A@@@A@@@@@A@A@@@@@A@@@@BAB@@ABA@AB@@@@@@DB@@BFDDBBBBBD@@@BBB@B@@@B@@@BBDBBB@@@@@A@@D@@@@A@@D@@@@ABA@@B@@CBA@@@@B@@@@@B@@@B@@@@B@@@B@@BB@@@B@B@@@BB@@BB@CF@@ABAB@@@BDBBB@BBB@@@@A@A@A@@@A@@@@B@@B@BBBBB@@@B@D@@@@@@B@@A@@BB@@@@B@@B@@B@@BBA@BB@B@@@@@B@@B@@@@BB@@@@@@@@@@B@B@@@@BD@@BB@D@BBDBB@BBB@@@@@AB@B@B@B@@AAA@@@@B@@@BD@@@@@@B@@@@A@@@@B@@@BB@A@@@@@@@A@@B@@@BB@@B@B@B@@@B@@@@A@@A@@AB@@@D@@@D@@@B@@@B@@@D@@BB@B@@@@BD@BBD@@@@B@@B@@@BBB@@B@@@@BB@@BB@@BB@@BA@BB@AA@@B@@BB@@@BABB@AB@@@@BBA@@BBB@@@@@@@BB@@@@@@@@B@@@@CBA@@BC@A@A@@@@DA@@BA@A@@B@@ABA@@@@@A@@BA@@@BB@@BBABA@ABC@ABCB@@@DA@@@A@@AC@@@A@@BA@@@AAA@@BA@@AA@@A@A@@A@@@@@@@@@AB@@@@@@A@@@AB@@@BA@B@AB@@@@@@@@@@@BA@@@@B@@A@@@@B@B@@A@@@@@@B@@@@A@@B@@@@@@AB@@@@@@@B@@BBA@@B@B@@@@@BA@@BA@@B@@@B@@@@@@B@B@D@@@BB@B@@ADA@@B@BCB@@@@A@@B@B@BBAD@B@BB@@B@@BA@@B@@A@ABA@ABC@@@E@A@@BAB@B@BA@@B@BA@@B@@@@@B@@@B@B@@BB@@@@@@@B@@@@@B@@@B@@@@A@@@@B@@@@@B@AABA@@B@@@@@B@@@@@@@B@@@BAB@@A@@B@@@BAB@@@@@@@B@@@BA@@@@@@@AB@B@B@B@@@@@DA@@B@@@B@@@B@B@@@B@@@B@B@@@B@@@@@B@B@@@B@B@@BB@B@@@@B@@B@@@B@@@B@@BBB@@B@@@@BB@@BBB@@B@B@@BB@@@@@BA@@F@@@BA@AAA@@@A@A@@AA@@@A@@@AA@@A@CB@B@@A@@@@@A@@B@@A@@B@B@@@@AD@BAB@@@@@B@@@@B@B@@@@BB@@@B@@B@@BB@@B@@@@@BB@@@B@@@@@@@B@@B@@D@@A@B@B@@@B@@@@B@@B@@B@@@B@@@BB@@B@B@@@BA@@BABA@@BD@@@@@@@@@BB@@@@B@@@@@@BBA@B@@B@@@@@@ABB@@@@B@B@@AB@@@@@@@B@@@@@@@B@@@B@@B@BB@@B@B@B@BBB@D@B@@B@@@@B@B@B@@@B@@@BB@@B@@B@@@@@@@BBBB@@@@B@@@@A@@B@@@@@@A@@@@B@@A@@@@@@@A@@@ABA@@AA@@@A@A@@BA@@BBBBB@@@B@B@@BB@@@@BB@@@@@B@@@@@B@@B@@B@@@B@@@@B@ABB@A@@@@B@@@@@@@B@@@@@@@B@B@@BB@BB@B@@@@@@@B@@@@@@@B@@@B@@@B@@B@@B@B@@@BB@@DAB@B@@@@@@@B@B@@B@@B@@@AB@@@BA@@B@@@@@BA@@B@@A@@BA@@H@@@B@@@BAB@@@@A@@@@@AB@BA@@B@@@BAB@@A@@@@BA@A@@@@@AA@AAAA@@AA@A@@@A@A@@@@@A@A@@BA@@@ABA@@@@@CBA@@@@@@BB@@@AB@@@B@@@D@@@@@@@BAB@B@@AAA@@BA@@B@@AB@@@@A@@A@@@AA@@B@@ABA@@@ABB@@@@BBB@@AAA@@@C@@@A@@A@@@A@@C@@@@B@B@@@BA@@B@@@B@@@DAB@@@BA@@@@@@@A@@@@@AB@@@BAB@@@B@D@D@@A@@@AAA@@@AA@AA@CA@@A@ABA@@BC@A@@B@@AD@D@BA@@BA@@AA@AACAEAAA@BA@ABABAB@@A@CF@@B@@BB@@B@@AB@@@BBD@@B@BBB@BABAB@B@@@@B@B@B@H@B@B@@ABB@@B@@B@@@@@@HBB@BDBDBB@@@B@AB@B@BAD@@@D@@ABA@BB@@A@@BAB@BA@@B@@@@B@B@BBB@BB@@B@@@@@@@B@@BB@@@@@B@BB@BB@@@B@@@@@B@@AA@@@BABA@@@@@@B@BA@AB@@@B@BA@@@@@A@@BAB@@BB@B@@BBB@@@B@@@@@B@@@@BB@@@@@@@BB@@@@B@@BB@@@BB@@@@BB@@@@@B@@@@BBA@BBA@BB@@A@@B@@A@@@@B@@@@@@A@@B@@BB@@A@@B@@AA@@AB@BA@@B@@AA@@@@ABA@@@@@@BB@@@@BA@A@@B@B@@@@@@AA@@@B@B@@@B@@A@@@@@A@@BA@@@BB@@B@@B@@@@BB@@@BB@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@B@@@@@@@@@@@BB@@B@@@B@@B@@@B@BBB@@BBB@@B@@@@B@BDB@B@@BB@@@@DB@B@@@B@@@B@@B@@@@B@@@@BB@B@B@@@BB@A@B@@BB@@@@B@@@AB@@@@@B@B@BA@@@@B@BAB@B@@@@B@@@@BA@@BB@@BAB@@@B@@@B@BB@@@@DAB@@BB@BA@@@@@BA@@BB@@@@DAB@B@@@B@@B@@BD@@@BB@@B@B@@@@BB@@@BB@@@@@@@B@@@DB@@@BBB@B@@@BB@@B@BCBADA@@B@BAB@@BB@@A@@@@@B@BAB@@@BA@B@@BD@@@B@@@B@B@D@@AB@B@@@@@BAB@B@@@BA@@D@@B@@@@@B@@@BBB@@@BB@@@B@DAB@@AB@@@BABA@@BAB@@B@@B@@@BB@B@BB@@B@@@B@BA@@@@B@BB@@B@B@B@@AB@@@B@BAD@B@B@@@B@B@D@@@@@B@@@BB@@D@@BB@@B@@@DBB@@@B@B@@@B@@@BB@@D@BBD@@BB@@@AB@@BBDB@@@BB@@B@@BBB@@@B@B@@@@@B@B@B@B@@@@@DB@@BBBB@B@B@@DB@@@B@@@@B@BBDB@@@@@BD@@@F@D@@@BB@@@@B@BAB@B@AA@A@@@@@A@AB@@@D@B@@@@A@@AC@@@A@A@AB@@B@@@ABABA@@@@BA@A@@BA@@@@@@B@@@BAD@@A@@B@@AA@@A@@AA@@@@@@AAA@@AA@@@BA@@BABA@@BC@@BABA@@B@@@B@@AB@BB@@BAB@@AB@B@B@@@BA@@B@B@@A@@@@@AB@@@BA@@@@BBB@B@B@B@D@@@B@B@@@B@@@B@@@@@BA@@@@AA@@@@@@BAA@A@@AB@@@BADBB@@@@@B@@@@@B@BAB@@AB@B@@@BA@@B@B@B@BB@A@@@@AA@@@@@@@A@A@@@@@A@A@@@A@@@@@A@@A@@A@@B@@E@@@AAA@A@@A@@@@@@BA@@BAB@@AAA@@@@A@A@A@@@AA@@A@@A@CA@@AA@@A@A@@@C@A@AB@B@@C@@@@BAB@@@@A@AB@@ABAB@@A@@@A@A@A@CA@A@@AA@@@A@AA@BAAE@AAA@AC@ABA@@B@@A@@@A@A@@BA@ABA@CBA@@AA@@A@@@@@CAA@@A@@A@A@@@AA@@@@A@@B@BA@@@@B@@@@B@@@@D@BAB@B@@@AB@@B@@@@@BAB@B@B@B@@@B@B@@@@@BA@@B@@@B@@@A@@@@@@A@A@A@A@@@AAA@@B@@C@@@@B@@@BAB@D@@@BA@@@A@A@@@A@@@@AA@@@@@A@@@A@@@@AAA@AA@@@@@@@A@ABA@A@@B@@@B@B@@@@A@ABA@A@A@AB@BA@@A@@AA@@@@@A@@@A@@@@ACBAB@@A@@A@@A@@@BAAA@@BC@A@@@AB@AA@CBA@A@@@A@@@A@@@A@@B@AAB@AA@@@AAA@A@@@A@A@@@A@@@A@A@@@A@@AA@A@@@A@AA@@AB@AA@@@@BAD@@@@@AC@@AA@@@A@@A@@@AA@@A@@A@@@A@ABAAA@@@A@A@A@A@@@A@@A@AB@@ABA@A@@@A@A@@@@@A@A@A@@AA@@@A@@BA@@BA@@@@B@@ABCBA@A@A@E@@@AB@@@A@@A@AA@A@@B@AAB@AAB@@AB@AAA@@@@A@@@@@A@A@ABA@A@AB@@A@@B@@@B@@@BBDB@@@@@@@A@A@ABABAB@@@@@B@@AB@@@@A@@BC@A@@@@BC@ABA@@@A@AB@@@B@@@@B@@B@@@BA@@@@@@@@@@B@@@@@B@@@@@B@AAB@@@@@@@@@@BB@@B@A@@B@BAB@@@@BB@B@@AB@B@@@@A@@AC@@@A@AACB@@@BABA@@@@@A@@BA@@@AB@@A@A@@@A@A@@@A@@B@B@@@B@B@B@DBB@@@@@B@@@@BBA@BB@B@B@@@B@@BD@@@@B@@BB@@@@BB@@B@@@B@BB@@BAB@@B@@B@B@@BD@@@B@BB@@DABB@@@AD@@@@A@ABABAB@@@@A@@A@@@@@@@@@@A@@@AD@@@@@@AA@@A@@@@@@B@B@B@@A@@@@@A@@@@AA@@B@@@@@B@B@B@@@@@AAA@@@@@A@@@A@A@@@AA@@@@A@A@A@@@@@@@@AAA@A@@BA@@@A@@@A@@@ABA@@@@BAB@DA@@@@@A@@@A@@@@AC@@@@@A@@@@B@@@BA@@BA@@B@B@@@@@@@B@@@@@B@BAB@B@@@@@BBB@B@@@@@B@@@@A@A@@@@@@@A@@@C@A@@@A@@@C@@@@@A@@BA@@B@@@D@B@B@B@BBB@@@D@B@@BB@@@@@@@@@BA@@@@B@@AB@@BB@BBB@B@B@BAB@@@@@B@B@@@@@@@@@@A@A@A@AB@@A@@BAB@@A@@BBBB@@@@B@@ABA@@BB@@B@@@@ABA@A@A@A@@@AB@@A@@@A@@@A@A@A@@@@@@@A@A@A@A@ABA@@@@@@BA@@@@B@@@@@BB@@@B@BB@@@@@B@@AB@@B@@B@@A@A@@@@BA@ABA@@B@@@B@@@A@@BDA@@BBB@@@@BD@@@BB@@@@@@BBBB@@@B@@B@BBB@@@B@B@@@BA@@BAAA@@AABE@@@ABA@A@@@ABABE@@@AA@A@@B@AE@@A@@@@A@AA@@AA@@@@@@@A@@@A@@@A@@@@@A@@@A@@AA@@@@@AA@@@@A@@A@@A@@@@A@@@ABAB@BA@AB@@C@@@A@@A@@@@@A@A@AA@@AAAAA@@@@@A@@BAB@@@@A@@@@@A@@A@@@A@@AA@@AA@@A@C@@BAB@B@@@@A@AB@@@D@BA@@@@@A@A@@@A@@BA@AAA@@@A@ABA@C@@AAAACE@@@@FAB@D@AA@A@A@@AA@A@A@@@C@@@@BA@@@A@AA@@A@@A@A@@A@A@@@E@@BA@A@@A@CA@@A@@@BA@A@@@A@@B@@@@A@@@A@@@@@AA@A@@A@@@A@@@@A@AA@@@BA@@B@B@@@BAB@@@@A@CAA@@@@B@@@B@B@@@BA@A@A@A@@@@@AD@B@BA@A@A@A@@@@BA@A@@BABA@@@@B@@@B@@A@C@AAA@@@@BABAB@@@DA@@BA@@B@BA@A@AA@@@A@@@@@@A@@BA@@@AAAA@@A@@@A@ABA@@@@@@A@A@@BC@@@A@@@@@A@@@@@A@@@@@A@@@@BA@A@@@@D@@@BABA@@B@@ABA@@@@@A@A@A@@A@A@@@C@@@AAA@A@A@@@@@A@A@@@A@@AA@AA@A@A@@@AA@@A@A@@A@A@A@AAA@@@A@A@@A@A@A@AAA@@CA@@AC@@@A@@@A@@A@@A@@@AA@@A@@@ABA@A@@@@BA@@@A@C@@@@@@@C@A@@@A@A@@B@@@@A@@A@A@A@@@@AA@A@AA@A@@@C@@@AAA@@@AAA@A@@@@@A@@B@BA@@@A@ABA@@@@@A@@B@B@D@@A@@@@AA@AAA@A@@@AA@@A@A@A@@AABA@A@@@A@@A@BA@@@ABBB@@@BAB@BA@@B@B@@A@@BA@@@A@A@@AA@@@@EA@@BABCAABA@@A@@@@AA@@A@@BA@ABAB@@C@C@A@@@@BAAC@AAA@@ABA@A@A@@@AAA@A@@@A@@@ACA@@A@A@@C@@@A@@AAA@AB@@A@A@@@@@@CBC@A@@@AA@@@A@A@A@@AC@@@A@@A@A@A@A@@@A@CB@@A@@@@A@@@@A@@@@@C@AA@@@A@A@A@A@AC@CBA@@@A@@@A@A@@BA@@@@@AA@A@A@@@C@C@ABA@A@A@@CB@@@A@@CB@@A@@@@AA@BG@A@@ACCAAA@@BA@@BA@@@A@@B@FBDBD@@CBC@A@E@G@@@C@@@@AA@@@CAA@A@@@A@@@A@A@AAC@@B@DABAB@BAAAA@@@A@@AC@@@AAA@@@A@AAA@A@@@C@A@A@A@@@A@C@@@ABA@@@A@A@@@@BA@C@@@A@CAABA@C@CBA@A@@@AACCC@@@AA@@@@BA@@@AB@@A@@@@BA@@BG@@@C@ABA@A@@AABAAA@A@C@A@@BAB@D@@A@@BAB@@@BABA@@A@AAA@@@CAA@@@A@A@@@@@C@A@@BA@@@C@A@A@A@AA@@A@@A@AAA@A@@@A@AAA@@@AA@@@@AA@AA@@AA@@@AB@@AB@A@B@@AB@@@@A@@AA@@A@@@@@@AC@A@@@C@AA@@@BA@AAA@A@A@A@C@E@@@AA@@@AC@AA@@A@C@A@A@@A@@@A@A@A@A@@BA@@@C@@@@@A@@@C@CBA@AA@@@BABABAB@D@DA@@@@@A@@@@AA@@@@@A@@@@A@AA@@AA@E@E@C@@@@B@@@BA@@@A@A@ABA@@B@@AD@B@B@@A@@AA@@@A@@@@@AAA@@@@@@@A@@@@@ABA@E@A@@BC@A@A@A@@B@@BBBBB@D@BB@@@BB@A@@BA@@BABA@@@@D@B@D@@AB@DADAB@B@B@@B@@B@@AB@@@@A@@AA@AA@BC@A@A@A@C@A@@B@BA@@B@@C@A@@BCB@DC@A@ABA@CBA@@@AA@@AAA@ABA@ABA@A@C@@BA@@B@B@@BBBB@B@@@B@@A@@ACAA@@@A@A@@BABADABA@@B@BAB@BA@@@C@@@AAA@A@@@@@C@A@@@CBA@A@A@@@A@@BA@A@AA@@@@A@@BA@A@ADA@A@C@@@CAA@AAA@A@C@@AA@A@@@A@@@A@@A@@A@AAA@@@A@@@A@C@@@A@@@@BA@C@C@C@A@@@A@@@A@A@@@A@@@@A@CBABA@@BC@CBA@CBEDC@A@C@A@ABAB@@CDAB@@@@ABADA@CHA@ABA@A@A@@@ABABCBA@ABA@A@A@@A@@@@@A@A@@A@AA@@C@A@@@CA@@CAE@AAA@C@AAA@A@AAC@A@A@AAA@@ACEACAABCAA@A@A@CAA@@A@CCEA@@@@@EBA@A@@BA@@@@AA@A@@@@B@BA@@@AAA@@@AA@@@@A@@@AA@@@A@@AA@@A@@AA@@@@@@@AA@@AAA@@@@@@@AA@@AB@@A@@@AAAAA@@BA@@AA@A@A@@@@BAA@@A@@AA@A@A@E@AA@@AEC@@ABCAA@AA@@@@A@@AE@@@@@AA@@A@@@AA@AA@@@A@@@AA@@@A@@AA@AA@@AA@@@AAA@@@@AA@A@@AA@AA@@@AA@@@@A@@@@AAB@AA@@AA@@AA@@@@@AAC@A@@AAA@@AAA@AAA@@@@AACE@@@@@A@@AA@@@@@AAAA@@A@A@A@AAC@@@A@@@A@@@A@@@AA@AA@A@@AACCACA@BAA@@AAA@@@@AA@@@@A@@AC@@@AA@@A@AAAAA@CAAA@@@@AAAA@@AA@@@A@@@@@AA@ABA@@@C@@@AA@@A@@@AAAAAA@@@AAACE@@@@@@@A@@@A@@@@A@AA@A@A@A@AACAC@AB@@A@@@CBA@AB@BA@@AA@@BA@A@AA@@AA@@@@CBABC@C@AA@ABA@@@AA@@A@@BA@@@@@A@@A@AAAA@AE@A@@A@C@A@A@ADCB@@A@A@@@A@@@AB@B@@CB@@@BAB@D@@CB@@A@@BA@@@@@AA@AAC@A@AAA@AA@@@A@A@AAA@@@CB@@A@A@A@A@AC@@@AA@A@@@AAA@@@@@A@A@A@@@A@AA@AA@@@CFABAD@D@@AAAB@@ADAD@B@B@B@@@B@B@BCB@@ABA@AA@A@@A@@@AAA@@@ABA@C@@@@BA@@B@@A@A@@@A@CD@@A@@@A@@AA@A@@A@AA@@A@BCDCB@@BBAB@@CB@BAB@DA@A@A@A@@@AC@@AAAC@@@@CDA@CDAB@B@@@@A@E@AAA@E@@@CB@@A@@@@AAAAAA@@@@@ADABABCBCDABCD@FABCDABA@AAAAA@A@A@AB@D@BBB@BDD@B@@AB@BA@@B@B@DAHAFABABAB@@CBABABCJ@@@B@@CDMAOCU@SNOBS@E@IFGLIB"],encodeOffsets:[[111155,37324]]}},{type:"Feature",id:"610626",properties:{name:"吴起县",cp:[108.175933,36.927215],childNum:1},geometry:{type:"Polygon",coordinates:["@@SJSDYAAVBHDFAB@@CDE@EB@@ABAJCBA@AA@AAABG@A@@AAAA@C@AAAA@A@A@ADCF@@C@A@AACI@EEEAEA@ABCFCBE@C@GAA@@@AHADCB@BBB@@@BAB@@C@CCA@@@ABABA@AAA@ABAB@F@HBD@BABA@GBA@CBABA@A@CA@@@AAAACCA@@ED@@@@A@@@CC@@AB@D@B@F@BCB@@@B@@B@BBB@B@D@B@BB@@BB@DAB@BADA@GDA@CA@@CB@BAD@D@DBDDDBB@@BADC@@B@@@BAB@@BB@@@@@B@@A@@D@@@B@@BBBJB@@BB@D@D@FABAD@@@BBBF@BB@HAFADA@ABA@CBEBABABBD@B@@@B@@@B@@ABCBA@ABA@@@ABA@E@@@AACA@@A@@A@A@A@@@@A@A@AB@@A@@A@@GCA@A@AB@@A@@A@A@@A@A@A@@@@A@C@ABC@@@A@@@@AAA@@@@ABCDE@@AC@CAA@A@E@A@@A@A@ABC@@BABAD@BA@AB@B@D@D@BAB@@ABA@AAAAACE@AA@@AA@@@@A@@@ADCD@@@@A@A@@C@@CAAA@@A@@DAB@@A@@@A@@AAC@@C@@@AA@@@CAACEA@@B@BA@@B@@@DAB@@A@@@ACAAAAA@@@AD@@AB@@BB@@BD@@BB@BAB@@@B@B@@DD@B@@@@CD@B@B@BBD@D@BDD@B@DAF@DBFAFCHAHCFCF@@AB@B@BA@@@@@@AA@AA@@ABA@AB@@@ACBAB@@AD@@@D@BABC@@BCB@@EBA@@@@@@@@D@D@D@DADABAFEFABA@AD@@@H@DBDBD@@AB@B@@@@A@@A@A@@C@A@@ACAA@AA@@A@@@A@A@@BADA@CAC@CAE@@@A@@A@@A@A@@@A@ABEN@@@BAAA@@BA@A@AB@@@@@@AA@@@@A@@@@@A@@@AA@@A@A@@B@FAB@@AB@@@@@@B@BB@@BB@@AD@@@D@@A@@@@@A@@@CB@B@@@@A@@@@@@@A@@@AA@B@@A@@@@@A@@@@B@@AB@@A@A@@A@@A@@BA@@@@AA@@@@@@@@@@@@AA@AB@B@@@BA@@@@A@@AB@AA@AB@@A@@@@B@@@BAB@DCFAB@@C@@@@@@@@A@@@@AB@@@AA@@@AB@@AB@@AB@@AB@BA@@@@@A@@@@BA@@BA@AAA@A@AA@@AEA@@AAA@CA@AAA@AB@@A@@@A@@@@@ABA@@@@@@@ABA@@@C@A@AA@@@@A@C@A@@@A@@@ABAB@@AA@BABC@@BA@@BA@@B@@@B@@@@A@@@@@CD@@@@@@AA@A@@@@@@AB@@AA@@A@@@A@ABAA@@AB@@ABC@A@ABA@A@AB@@AFAH@BABE@A@AAACAE@@@@AB@@AB@B@@CBABCBCBCF@A@@@BA@@@ABA@@BAB@@@B@@A@@@AA@@A@@@AB@B@@@@A@@@@A@@AA@@A@@BA@@F@B@BA@ABA@E@@@A@ABC@CAA@ABA@A@@@@@@C@@@A@C@A@AA@@@C@A@A@A@AEA@@AA@@@ABABCDA@C@A@A@AAA@A@IPEDEBKL_N@@ABCB@B@@ABA@@@@@BBBBBBB@BB@@B@BB@@B@@BBB@@BB@@BB@@@B@@@@@@BB@@@BB@BB@B@@B@B@@BB@@BB@@@DB@B@@@@B@@BBADBB@B@@@B@BBB@B@BDB@B@D@B@B@D@BBBBB@@BB@BBB@B@@@B@@@B@BABBB@BB@@@BBB@@@@@B@@BB@B@BBBB@@@B@@BBB@@BB@@@@AB@@A@@@@BA@@@A@AB@@A@A@@BA@@BAB@@@@@B@B@@@@DDBBBB@BBB@B@@@B@B@BB@@BAB@B@B@@BB@BAD@B@B@@@@@B@@ABA@@B@@@@@BA@@B@@@@ABBBA@@BAB@@@B@@B@B@@BB@D@@@@D@@ABBB@B@@ABA@@B@B@@@B@D@B@@BBB@@@@BA@ABA@ABA@@@ABA@@@@@A@A@@@A@AB@@A@A@@B@@@@A@@B@@A@@B@@B@BB@B@B@B@B@@A@@B@D@@@B@B@@@B@B@@BB@@@BA@AB@@@BAB@@@BA@@@@@A@@B@@@@@BA@@BA@A@@@A@@@ABA@AB@@ABA@@@@@@@@BBB@@ABBBABBB@@BB@@BB@DBBBB@@B@BBB@@@A@ABA@@BA@A@@@@@A@AB@@@@ABA@@@A@A@@@A@AB@@@@A@@BA@@@AB@@@@@@@B@@BB@B@@@@@BAB@@@@@DBBBB@@@BB@BB@@@B@@@BB@ABBBBB@@@@@@B@@B@@@@@B@@B@@B@@@@B@@@BA@@@ABA@A@AB@@A@@@A@@@A@@@AB@@@@A@@@A@ABA@AB@@@@B@BBB@A@@BA@AB@B@@@@D@B@F@B@@@B@@BABBDA@BBB@@B@B@@@B@B@@@B@@@@B@@AB@@@B@@A@ABB@@BDB@@@B@@@B@@B@@BBB@@B@BB@A@@@A@A@@@A@@AC@@@@@A@@@AB@@A@@B@D@B@@@@@@ABA@@@CB@@@B@BDB@@@B@BA@AD@@ABB@@@BAB@@@BB@@@B@@B@@@@B@B@B@@@B@@@@BBB@@B@@@B@BA@@B@B@@ABB@@@@@BA@@B@B@D@B@BB@@B@@@@@BA@@B@B@@B@DD@@@@B@BB@@@@@@@@AB@BA@@@ABAB@B@@A@@@@@CBA@AA@@A@A@@B@@@BC@A@ABA@@@@B@@@B@@@@@B@@@@C@@@@@@BA@@@C@A@@@@@@@@@@B@@@@@B@@@BA@BB@@@@@B@@@@@@AB@@A@@AAAA@@@@@AB@AAB@A@@@@A@@@@@@A@@@@@A@@@@@@@A@@@@@@AC@@@A@A@@@@@A@A@@BA@@A@@@@A@@@@BAA@@@@@AAA@@@AAAAE@A@A@ADAB@B@BA@@BA@DBB@@B@@AB@B@BBD@BB@@BAB@@A@AA@@C@A@ABC@@BA@AA@@A@@BA@A@@@AA@@@@@A@@@AAA@@@A@A@A@A@@@@AA@@B@BA@A@AB@@@AC@@B@AA@A@A@@BA@C@A@AI@A@A@ABCF@B@@@@@B@@DD@@ABAB@@@B@BAB@@@DA@@DABAB@B@B@@A@@@@B@@@@@@AD@@@B@@@@@B@B@BA@@@A@@@A@CB@@@BAB@@C@@@ABAB@@A@AB@BBB@@B@BBA@@B@@@B@B@BA@@B@@@@@B@@@B@@@B@@@@@B@@@@@@EB@@A@A@A@A@@BB@BBBBA@AB@@@B@B@@ABA@A@CBA@C@@B@@ABABAB@@A@@BA@AB@B@@A@AB@@BBBB@@@D@@@BB@@@D@B@B@B@BB@@B@@@B@@@BB@@B@@B@B@@@@B@BBB@B@D@B@@ABBBA@AB@@AD@B@@@@A@A@@@A@@@ABA@@@A@@BA@@@@BAB@@@BA@@@@@AA@@AA@@A@@@@AA@@@A@@A@@@AA@A@@A@@AA@@A@@@@@A@@B@@@BB@BBD@@B@@@@AB@@@BB@A@@@@@A@@@@B@@@@A@@B@DB@@BA@@@@B@@@@B@@BBB@@@B@B@B@B@BAD@@@@@@B@BB@@@@B@@@B@@@B@@@@@B@@B@@BB@@DB@@BBDBD@BB@@BB@B@DB@A@BB@@BB@B@@B@@@B@@@BB@@B@@@@BB@@@@@B@@BB@@@@@@@B@@A@@@AB@@A@@@A@AB@@@B@@@@@@BBBBB@@BB@B@B@BB@B@D@B@B@BABEBABE@CBA@C@A@ABE@A@@B@@BB@BB@BB@B@@@@@BAACB@@@B@@@BA@AB@@@BAB@@AD@B@B@@BDBD@@B@@B@@BB@@BB@@@@@@B@@B@@B@@BBAB@@@@B@@@@A@@@@BAB@@@@@@A@@@@B@@A@@BA@@BA@@@AD@@@@@@AB@@@BA@@@@@@@A@A@@@@@AA@@A@@A@@AA@A@C@@@@@@G@@@C@@BAB@@A@@BA@CAA@@@AB@BA@@B@@@BB@@B@B@@@@B@@B@@@BB@@@@B@@BB@@BB@@@@DD@@@@@@DBB@B@@@@@@AD@@@@AB@B@@@@A@@BB@@@@@@BB@@@BB@@@@B@@@@BB@@B@@@@BB@@@@@BB@@@@B@@@@BB@DA@@@@B@@@@@AB@@@BBB@@B@@B@@A@@@@B@@BB@B@BA@@BAB@@@BB@@ADCBA@A@@@@B@BB@@B@@AB@@@B@@A@AB@B@B@@@BB@BD@H@@AB@@ADC@A@AB@@@B@@@@BB@@@B@@@@@D@B@B@B@DA@@D@@@@@@B@B@@@BB@B@@@@BB@@BB@BB@@BB@@@B@@BB@@B@@@@@A@@B@@@@@B@BB@@@@B@@@BB@@@@B@@@BBBA@ABA@A@@@A@@@A@@@@BA@A@@AA@A@@@@@A@A@@@A@@@@@@A@@AB@A@B@@CBCD@BCBAB@DA@@B@DB@BB@B@@B@@@@@BB@@B@@@@BBBB@@@D@@B@@B@D@@@@@@@DB@@@@B@@@DA@@@B@@B@@@B@B@B@@@BBB@@@B@@BB@B@@@@B@@@@@@DB@BA@@BA@C@@@AB@@ABADAB@B@@BB@B@@@BA@BDAD@B@B@BBB@@BB@BBBA@ABAB@@AB@B@@C@A@@@@@@BAB@@@@@BBBB@@BB@B@B@@@D@@@@@FABAB@B@@@@@B@B@@AB@@AB@@B@@@B@@@@@@@@@B@@@B@B@@@BA@@BBB@@BB@B@@BBA@BB@B@@@BBBFH@@BB@@@@@@@D@@B@@BB@BBBB@@@B@@@@@BB@@B@@B@@BD@B@D@B@@@D@@@B@FABB@B@B@@B@@C@AB@B@@B@@@@@BB@@@AB@@A@@B@D@@@BA@@@BBBB@BB@B@@A@@B@@@B@B@@BBB@@B@@@B@@@B@B@BAB@@@DB@@@B@B@B@@@BB@@B@@@BB@@A@@@@B@BA@@BA@@B@@AB@@@B@B@@@B@BA@@B@@@B@@@B@BAD@B@BBB@D@B@B@B@@@B@B@@ABABAB@@A@@@A@@@AAA@AAC@@@@AA@@AA@@AAA@AA@@@A@@@@AA@@@@@A@@AA@@@@B@@A@@@@@AA@BA@A@@@@@@AA@@@@DA@BB@@@B@DD@@B@D@@@@@BBB@DC@@BABC@@@@A@@@AAB@@@DA@@BAB@@BB@@@@BB@@@B@@AB@@BBA@@B@@B@@B@B@@@@@B@B@@@B@@@D@B@B@B@BBDA@@B@@@B@B@B@@@@AB@@@@AB@@A@@@@@@@ABA@@@A@@@A@@@A@@@A@@@@@A@@@@BA@AB@@@@@@A@@B@@@@AB@@@@@@A@@@@@A@@BA@@A@@@@@@@@@@AA@B@@A@@@A@@BA@A@@@A@@@@@@@@AAA@ABA@@@AA@@@A@AA@@AAA@A@@AA@@BABA@@CAA@@A@@B@@A@C@@BA@@DAB@@@DA@@B@B@B@@@@AAABCA@@@@A@@@A@@@@@A@@@A@A@@@@@A@A@A@A@@BAB@@@D@@@@@BA@C@@@@BA@@@A@A@ABA@A@A@A@A@A@A@@@AB@BB@@D@@@@AB@B@@@@ABE@AB@@@BBB@@AB@DCBA@A@A@AAC@A@AA@@@A@@@A@AA@@@@A@@AA@@@A@@AA@@@@@@@A@@AAB@@@@BA@A@AB@@ABA@@BAB@@ADAB@B@B@D@B@AACE@@BA@A@@A@@AAABA@@FA@@@A@@@@@@@@@A@@@@@A@A@@@@@AA@@@@AAA@@@A@@A@@AA@@@BA@@@@A@@ABC@@@AB@@ABA@@B@@A@@@AB@@@@A@@@A@@@@@AAA@@CC@AB@@@@@BA@A@@@AB@@A@@BA@A@@@@B@DAB@@AB@@AB@@@BA@@BA@@B@@@@BAD@B@@@B@@@D@@A@@DB@@@B@BAB@@@@@B@BB@@B@B@B@BBBB@@BA@A@@BABBB@BB@@@D@@@D@@@@@B@B@@@B@@@D@@A@@@@@@@D@@@B@B@FABA@@BAB@B@B@@@B@@@@@B@DBB@B@B@BAB@@@@A@A@C@@B@D@@ABADCB@BA@A@A@@D@@AB@BA@@BBA@@B@B@@B@@@BA@A@A@A@@B@B@@BBB@@@@@A@CBAB@@@DBBB@BBBB@@@B@BABA@@B@@B@@@B@B@@BB@@@B@@B@@@@B@@@B@@@@@B@@@B@@@@BBBB@@@BB@@BB@BBBB@@@@@@@@BB@B@@D@B@B@@@B@B@B@BAB@B@@@@@BAB@@@BA@@B@@@@B@@@DB@@B@@@B@@@B@BA@@@@B@@@B@@BB@BA@@B@@@@@BB@BD@BBB@DB@BBB@@@B@@AB@@@B@@@BA@@BBB@@@B@BBB@@@B@B@DB@@B@@@B@FA@@BABA@@BAD@@@DB@@@D@B@B@B@BBBDBBBDBFD@BBB@@@ABA@@@A@@B@@B@@BB@BBA@@B@@@B@BB@@B@B@DBB@@BB@@@@@B@@AB@@A@@BA@A@CB@DCB@B@@@@@@B@@@B@@@@@@BA@@B@@@B@BBBBB@@@@BB@@@B@@@B@@@BB@DBB@BD@B@@@@BB@@AB@B@DC@AB@@@@@B@@@@@@@@@B@@@B@BBB@@AD@B@@@B@@@@@@@@B@@@BB@@@@@@B@@@B@@B@@@BAD@@ABA@AB@@ABAB@@@@A@@B@B@@@@@B@B@@@@@@@DBB@B@@@B@B@B@BBB@B@B@DBB@FDBBB@BBB@@@@@B@@B@@@BBBB@@@@B@BBB@@@@B@@B@@BB@@BBB@@@AB@@B@@B@@B@@BB@BD@@@B@@BB@@@@@B@BBB@@A@@@BB@@AB@@BB@@@D@@@B@B@@@B@B@B@@@B@@@@BB@BA@@B@@@B@@@@@B@@@@BB@@A@B@AB@@B@@DABB@@@@D@B@BDF@B@B@D@BBB@D@@@B@D@B@D@B@@@@BBB@BAB@BAB@BA@B@@BB@@@BB@B@@B@BAB@D@DA@@@C@@@AA@@A@A@A@A@AA@@C@AB@@@@BB@B@BB@BB@BDB@@B@BBB@B@BB@@B@B@@@B@BB@@@@@B@B@@BA@@@A@@@ABC@@@A@@@A@ABA@@@A@CBE@A@A@@B@@A@@BA@@@@@AB@BA@A@@@@@@@A@B@@BBB@B@B@D@B@@@@BDBB@B@@A@@AC@AB@@@BA@@B@@AAA@@B@B@DBD@BA@@B@@@@B@@BBB@@B@@B@@BD@@@A@@@@B@B@B@@@@AB@@@BA@@B@B@@@@@B@@@@AB@@@D@@@BB@@BDBBB@@D@@@FABABA@@B@B@BAB@@BD@@@DC@@@AAAAC@@@AA@@A@A@@AA@CAAAAA@@AA@@@@@@A@@AA@@BA@A@@@A@AA@BA@A@@@@@@@A@@@A@@@A@AB@A@@A@@@A@@@@@A@A@@@@@CBA@@B@B@B@@BB@B@B@@@B@B@@@@@BA@@BBB@@@B@DA@@B@@@BB@@BABAB@@@B@BBB@@@B@D@BBBBBBFHBBBBD@B@B@BABA@@@ABADAB@B@@@D@B@@@@@@B@D@D@BB@BBBBBB@B@BBB@BBB@DBD@BBBD@B@BB@@@@B@B@B@B@@@@@B@@@BB@@AB@BAB@B@B@F@BB@A@ABCBABA@@@@BB@@@BBA@@@B@@@@@B@@@BBB@@@D@DBB@@@BA@ABA@@@@BA@@@A@@@@BBB@B@DBDBD@@B@BBF@@BBB@B@B@DBHF@BBDBFAB@B@@A@A@A@@@@B@BBB@@B@@@D@@BBBBD@@CBC@CAA@A@@@AA@@AB@@@DAB@@AB@@@BABB@DBB@@@BB@@DBBA@@BD@@B@@@BA@@@@@BBAB@@B@@@B@@B@BA@ABAB@@A@@BC@A@@B@@AB@B@@@@BB@BBB@BB@@BA@@B@@@@@B@@@@@@B@@AB@BAD@B@B@@@BBBB@B@@@BA@ADC@@B@@BDF@B@B@B@B@B@@@B@BB@@@@B@B@@@B@DB@@B@B@@BBA@@@ABA@@D@@@B@B@B@@A@@@A@@AA@@@ABAB@@C@ABA@@@A@@@A@A@C@@BA@A@ABA@A@A@@@ABC@A@@BAD@@@D@F@@A@A@@@A@A@A@C@A@EBAB@@AB@DAB@@@F@D@BBB@B@@@@@@@B@@@B@B@B@@@BB@A@BBAB@@@@B@@@@B@@B@BB@BB@A@ABA@@@CBA@AACBAAA@AAA@@@AB@@@B@@@@B@BB@@A@ABCBABCBAFCB@@A@C@A@@BAB@B@B@@ABCBAB@@A@@B@@AB@@@B@B@@@BA@@BA@@@A@@BDDFBBB@BB@DB@@BBBBDBB@BBB@BB@@@@B@@@@B@@@@@@BBBB@@BBB@BB@@@@DB@B@@B@@@@@BB@@BAB@B@B@@A@@B@B@@@B@B@B@B@B@@@@A@@B@@@B@B@B@@BB@@@B@@@@@B@@@@@B@DBD@@@@BBB@@@BB@B@B@@BB@@@B@@@@@BBB@@@B@FBBAD@@BB@B@@BB@BA@@@B@@@@B@@@@@B@@@B@@@B@B@@BBABBB@@@BB@@BB@@B@B@B@@@BB@@@@B@B@DB@@@@@BDB@@BBBB@@F@B@@@BBD@@@B@B@@@BA@B@@B@@BDA@B@@B@B@B@@@B@@B@@BB@@BB@@DBBB@@B@@@BB@@B@@@B@B@BB@@BB@@B@@@@B@BDBBBBD@@B@@@B@BAB@@@@@@BB@B@@B@@A@@@BB@B@@@@@@A@@BB@@B@@BBB@@@@@B@@@@BBB@B@@@@@BB@@@BB@B@@@B@B@BB@BB@@@@BBBD@B@@@BA@@@@BD@@@@B@@@@B@B@@@BBB@@BBB@B@@BBBB@@@@BB@B@@ABA@@B@@@@B@D@@@BB@@B@@BB@BBBA@@@@B@@@B@AA@AA@@A@A@@@A@@AA@@AA@@@@@@AA@@@@AA@@@@@A@@A@@A@@A@AA@@A@@A@@A@AA@A@A@@@@@@@A@@@A@@@ABCB@B@@@B@B@@@B@@@BBBBB@@A@@B@@@@@@B@@BBB@@@@@B@BBB@B@@@B@BAD@B@@AB@@@B@@@@@@ABC@@@@B@@@B@@B@@@B@BB@@@@@B@FABAD@BAB@@AB@BA@@B@F@DAD@@@B@DAB@B@@@@@@@@BAB@@AB@@@@@B@@@BBB@BCD@@B@@@FB@@@@@BB@AB@FA@@B@@BB@BA@BBAB@BA@@@@@@@AB@@@@BB@@BAB@@@@@@B@@@@@BA@@B@@@@B@B@@B@@B@AB@@@B@BB@@@B@B@B@B@D@@@BABAB@@A@@@A@@BC@@@@@A@A@A@@B@@A@@@@BAB@BA@@BA@@@@@A@@@AB@BAB@@A@ABA@@BAB@@ABC@A@@@A@@A@@AB@@@AA@A@@@A@@@A@@@@BAB@@A@A@A@@@A@@AA@@AA@@@@@@BA@@BA@@@AA@@CAA@A@C@AAAAA@A@A@@@@AA@@@@@C@@@A@@@A@@@A@@BAB@@@BA@@@AB@@A@@@@BA@@@@@@@AA@@@@AB@BC@@@@@E@AA@@A@AAA@@@@@@@@@@@A@@@AA@@@BA@@B@B@@@B@BAB@@@@A@@AA@A@@@A@@@A@@@@A@@A@@@A@@BA@@@@BABA@@@ABA@@BA@@@A@@@AA@@A@@@@AAB@@@@AA@BA@@@@@@B@@@@@B@@BB@B@@@BA@@@@BA@@B@@@BA@@BA@@B@@ABAD@@AB@@@BAB@@@B@@A@@@@AC@ACC@@BA@@@A@@@@@A@A@AB@@@@@BA@@BA@@@@D@@@B@@@@A@@AA@@@@B@@@@@B@@@BA@@BADA@A@@B@@@@ABBF@B@BBBA@@@@BA@AB@@@BBB@BA@@BABA@A@@B@BABA@@B@@A@@@A@@@C@A@@DB@@@@@C@C@A@@@A@@@AB@@@@AA@@@ABA@C@A@A@EBA@A@@@@A@@@A@@@@BA@@BA@@@A@C@A@@@@AAAA@A@AAA@@ABABABA@@@A@ABA@C@A@@@A@@@AA@@@@AA@@A@@@AAA@CA@@AA@@A@A@CC@@A@@@@AB@B@@@BA@A@@B@B@@A@@@AD@@ABABABA@A@@@@BA@@BA@@AABA@AB@@@@@@A@@B@DBB@D@B@@@B@BC@A@@B@B@@@B@BCB@@@B@@BDB@A@@@@B@@A@@@@@@@AB@@@@@@@@AB@@A@@BA@@B@@A@@B@@AA@@@B@B@@AB@@@@@B@@@BBB@B@@@B@@BB@B@@@BB@BF@BB@@BA@@B@@@B@B@@@BABAB@DAB@@@D@B@@@D@BABABCB@BA@A@@EAAA@@A@@AAAA@@A@AA@@AAA@CAA@@@@@A@A@AB@BADC@@@A@@@A@@A@@A@C@C@@BA@@BABABADABE@C@@B@@AAA@A@@@@BA@ABA@CBA@AAC@AB@BAB@B@@@@BBB@B@B@D@B@@@B@B@@@@BB@@@@BAB@BB@@B@D@B@B@BA@AB@@ABC@A@ABA@@BAF@@@@A@A@A@AB@@@BB@@BB@@@@B@@@@@@C@@@A@@BCBA@CDE@@DE@@@A@AA@@@A@AAAAA@AA@A@C@C@@@AAA@@A@AACC@A@@BABCB@AAAAAE@@AAA@A@AAA@@AAA@@ACAC@ABABA@AB@@@@A@ABA@@BA@A@A@CCC@AA@@A@@@C@AB@@ABA@@BA@@@A@ADA@@@A@A@A@@DEDADC@A@AB@@A@@@AB@@@@@@@@A@@BABA@A@ADABA@@@A@C@A@AAAEA@@A@@@ABAA@@@@@AA@@@@A@@BC@@@A@BAA@@FIBA@A@A@A@@@C@AA@@AABE@AAA@A@@AA@@@AA@A@@A@AAAA@@@A@A@A@ABA@@F@@@@@BA@A@ABAB@@@BC@@A@@@B@@@A@BAA@@A@@@A@AB@AAB@@@@A@@@@@A@@AA@@C@AB@@@@AE@@A@A@A@@A@A@@AA@@@@A@@B@@A@@@@@A@@@@@@@AAA@A@@@C@@BA@A@C@@B@@@B@BA@A@@@A@@A@AAACA@@A@BCBA@A@CBA@A@@@A@@@A@AB@@AA@A@AA@A@@A@ABAA@@@@A@AAA@A@ABA@C@@@@@@B@BBB@B@@@@@B@BA@BB@@@B@@@@@@@B@@@@A@@@C@@AA@@ACAABC@A@@@A@@A@@@@A@@AC@@@AA@A@AA@A@EA@@@A@AAC@A@ABA@@@ABA@AB@@A@@BA@ABC@A@AB@@A@AB@BA@@B@B@DABABADAB@@@BA@@C@@@A@AC@@@A@@@@@ABA@@@@@A@@@@@@@A@@@@@A@@A@@A@@@@@A@@B@@ABB@@@A@@B@@@@AB@AA@@@@@AAA@CA@AAACA@@@@@@@AB@@AA@B@@A@@@@A@E@@CA@AACAAAA@A@@C@AA@A@@@AB@@ABA@A@@@AAA@@A@@AA@@AA@BAB@BA@AB@BB@@@@BB@@B@@@B@@@@B@@@@B@@@B@@@B@@@@@AB@B@@@@@BB@B@@@@BBB@@B@B@@B@BBB@@@B@@B@DB@BB@@@@@@@@B@B@B@B@BB@@BBBB@@@@@@ABC@A@@B@B@D@@@@@@C@@@AB@B@B@B@BAA@@AA@@AAA@A@@@AA@@@BC@@BA@A@A@@@A@@A@A@@@@@@A@@@A@C@A@A@@@AB@@ABABC@A@@@A@@@C@A@A@@@@@AABA@CAC@@@A@AA@BA@@B@@A@A@ABA@AC@@@@@A@@@@@A@C@AA@A@@AA@@@AB@@@@AA@AA@@B@@@@AB@@@B@@AB@@A@@@@AA@AA@@@@A@@@@B@@@@@BA@@B@@@B@@@BABAD@@A@@BAB@@@@@A@@@@AA@@A@A@@@@B@BAB@B@BAB@B@@@B@B@@AB@@@@@BA@A@@B@@@@@@@B@@AB@@@B@@@B@@@B@@@@A@@@@@ABA@@BA@A@C@@@@BA@@@@AA@ABA@@AA@@@@BA@A@AAA@@@AA@@@@@@@B@@@@C@@@ABA@@@ABC@@@@@A@AB@@C@@@@ABA@A@@@@@@@@BA@@@@@A@@@@@@@@@A@@B@@@@A@@@A@@@@B@@@AAAA@@AG@A@A@A@AAA@A@@@@A@A@@B@@AB@@AB@@@@CA@@A@@@@@@B@@ABA@@@@@@AA@A@@@@A@A@A@@@@@A@@@@A@@@CA@@ACAAA@AABE@@BC@A@@@A@ABC@A@A@@A@@@A@@A@@CA@@AA@@@@@BAB@@@@A@@@A@@C@@AA@@A@AA@@A@AAAC@A@@AA@@@@A@A@AA@@ABABAB@@@AAA@@ACA@@A@@@A@@@A@@@A@AA@A@A@ABA@@@@A@@@@@ABE@@@A@AA@AAA@A@@C@@@@A@@@@A@E@A@C@@@AA@@@AB@@A@@@@@AA@AA@A@@@ABAA@@BA@@AA@A@@A@@@@@AB@@CB@@@@@B@@AB@B@@BD@@BB@BBB@@@@@B@@@@@B@@@@AB@B@@@B@@@@@@A@@@@BABBF@B@@@@@B@@AA@BA@@AAAA@B@@@@A@@@A@@@A@@@A@@@A@@B@@A@@@A@@AAA@AAA@A@@@AAB@A@BA@@@AB@@A@@AA@@@A@@CA@@@@@@@ABA@A@C@@@@A@A@AA@@A@A@@@@BC@@@A@AA@@@A@A@A@@@AA@@@AB@@@BA@@@AB@@@B@BA@@@@@AAACA@AA@@@@A@@@@@@@@@A@@@A@@@@@A@@@AA@@A@AA@@A@@@@A@@@BA@@B@D@@@B@@FB@@@@A@@B@DAB@B@@@@A@@B@B@@@BA@@@@BA@A@@B@@@@ABAB@@@@AA@@@@@A@@@@@@AABAAC@@@AA@@@A@A@@@A@A@@@@BABABA@@@@@AA@@AAA@A@@@@CB@@A@A@A@@@@A@A@@@@C@AEC@@@@@A@@A@@@@@@A@@@@A@@BA@@@@A@@BABCBA@@@A@@AB@A@@AB@@A@@@@@AA@@@@@@@@A@@@@A@@@@A@AAA@@@C@AA@@@@AB@@A@A@A@AB@@A@@@A@A@A@A@ABA@@@BDB@@B@D@B@@B@@@@B@@@B@@AB@A@@@@A@@@A@@@@B@@AAA@@@@@A@@@A@@@@@A@A@@@A@@@E@@@@@@BABCBABA@@@A@@A@A@@AA@A@@A@@BA@@@@@@@@C@@@@BA@@@CB@@A@@A@@A@ABE@ABC@A@AA@@@@@AB@@A@@@A@@B@D@B@BADA@@@A@@@@@@C@A@@@@A@@@@A@A@E@@@@C@@@@@@B@@AB@@A@@@A@@BA@AAB@@A@@@A@A@@B@BA@@@A@@@@@A@@@CAAB@AA@A@@BA@A@A@@@AAC@@BCBA@CBA@@BABA@A@A@A@A@A@A@A@AD@@@@AB@B@@@@@@AB@@A@@B@AAA@@@AA@@AA@@CAAAA@@@@A@A@A@@@@BA@A@ABA@@@AA@@@BA@@@@@A@@@@BA@A@@@AA@B@@@@A@A@@@A@@@@BA@@B@@A@@@@@@BA@AB@@A@@B@B@@AB@BA@@B@@@@@BA@@@A@A@@@A@AAAB@@ABA@@A@@A@ABA@A@@AAB@BBB@BBB@@A@@B@@BBA@@@@@A@@@AA@@@@A@@@@B@@@B@@@B@B@@@@@BABABA@@@@B@@A@@@@@@@AB@@@B@@A@@@ADA@@AAA@@AAA@A@@@@A@A@@@A@@A@@@A@@@@AAAAA@@@A@@@@@@A@CBAA@@A@@CA@A@ABC@@DA@@AA@@@@@A@@@A@@@C@A@A@AB@@@A@@@BA@@@A@@@@BAAA@@B@@@@@AAB@@@@@@@@A@A@A@@@@@@@A@@@A@@@@@A@@@AA@@A@AI@@@@@A@@C@A@@@@A@@@@@AA@@C@@@A@AA@@A@@@A@A@AB@B@@AA@@BA@@@@AA@@C@@@@A@@BC@@@A@A@C@A@@A@@A@AAC@A@AA@AA@@@C@A@@BAB@B@@@@C@C@@@A@A@@A@E@AAA@@@AB@BA@@@@@AB@@@B@@@@A@@@AACA@@A@@@@ABA@@BA@A@A@A@@BA@A@CB@@A@@@C@@BA@A@@@C@ABC@@@@@AA@A@A@@BAA@@@A@A@A@A@A@ABA@@@A@@@A@@@CC@@@AC@@@A@@AB@@@B@B@@@BA@@BA@@B@@@@AB@@A@@@A@@@@@A@A@@B@@A@ABA@@@@@@A@ABKA@@AA@@@A@@@@@@BA@@@@AAA@@BA@AA@A@A@B@@C@G@ABA@A@@@@@A@@@@B@@A@@@A@C@@@A@ABABC@@@A@CBA@ABA@@B@@@@A@@@A@@@AB@BAD@@@@@@AAA@AAA@@AAA@CBA@@B@DAB@@AD@BAB@B@@A@@B@@A@@@@@@@A@@A@A@@@A@A@@C@A@AAAA@@AA@@A@@BAB@@@@AAA@@AAA@@A@CBA@@@CC@AAC@@A@@@@@A@A@@BBDA@@BABA@EHCD@@C@@@A@@A@@@@BAAA@@@A@@@A@@AA@A@A@ABA@@@@@A@@@A@@@AAA@A@@@AB@BA@ABA@@BA@@@@BB@@@@BD@D@D@@@BB@@@DABCDCBCBA@A@C@A@AB@B@@AB@@A@@@A@@@AA@AAAA@@CB@@A@@AA@@A@C@CBC@C@@@AC@@@AA@@@@A@ABAB@B@B@@A@@B@@BBB@@@ADA@@D@B@@A@@@@@A@AA@@A@A@A@@BABAD@@A@A@@@@@@@@A@@@@@@@@IDMDMAMGEIGSGS@AEQCKMYGIGK@@CCCAWEI@KD@DCXADQDEBA@"],encodeOffsets:[[110721,37460]]}},{type:"Feature",id:"610629",properties:{name:"洛川县",cp:[109.432369,35.761974],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@A@@BC@@@A@@DI@@A@@AAAACAC@A@AA@@A@@@AA@@A@@B@@A@A@@@A@E@A@@@@C@@BABCDCBADA@AB@@ABA@ABABCBAB@B@BB@@BBBB@JBBBB@@@B@@B@B@@@B@@@BCBA@@@A@A@A@ABA@@@@B@@BB@@FBB@DB@@@@B@@B@@@BCH@@BBBDB@BBBB@BBB@@@@ABABCBEDA@A@A@GDEBA@ABAB@@@B@BB@BDBBFBBB@@@BBBA@@BABABC@CBA@E@ABA@A@A@A@@B@@AB@@AD@DBB@@BBB@@BB@B@@AB@@@B@@@@B@@ABAB@@@B@B@@BBDBBB@BB@BB@@@@BB@B@@@B@@A@@@A@A@@B@@ABBB@BBD@@AD@@@@ABA@@@AAC@AAAA@@A@AB@@@@@BBBDBBBFDB@BB@B@@@D@B@@@BA@A@A@EAC@C@A@A@CAA@@@C@AB@@AB@B@@@B@@BBD@BBD@BBDBBBDD@@BBB@DB@@DBB@B@DB@BBB@B@D@@@BA@CBA@EBA@A@@@A@C@AAA@C@A@@@A@A@@@C@ABAB@@@BBB@BB@D@FBB@DBBBB@@@BB@BBB@BAB@BA@A@@@A@GCC@A@C@@@ABABA@@B@BBB@BB@BBFBB@JBBBBBB@@B@BB@ABAD@BCBAD@@AB@@ABA@A@@@@@@C@A@C@C@@AC@@A@@@AACB@@CBEDABAD@@@@BD@B@B@B@B@@CBA@@@A@MDA@A@@BA@@B@@BB@@@B@@B@@@HAHAD@B@B@B@BB@@@@@BB@@@@BA@@@@B@@C@EBABA@CD@@@B@@@B@@@B@BB@BB@@D@BAD@D@BBBAB@@@BBDB@@@B@B@@@B@BA@ABA@A@A@@AA@@AA@CAA@AAE@@@EBABAB@@@B@BB@@BB@@@B@D@FAB@B@B@@@B@@@@BB@@BABA@CB@@C@ABABCD@B@D@D@BAB@B@BAB@B@BA@AB@@A@A@AAA@A@@AA@A@AACAAA@@A@@@A@AB@@@B@@@B@@BB@BBB@B@BAB@@CB@@A@@@EAC@CAA@@@@@@C@A@@@@@A@@@@CEA@@AA@A@C@ABA@AB@@@B@B@BB@@B@BBB@D@B@B@F@D@BAD@B@B@BA@@BA@@BA@AAAAA@AAA@C@A@A@A@@BABA@@D@B@@@B@B@@@BABADABA@@B@@A@@@A@@AA@CCAAA@@@A@A@@BA@@B@DA@@B@D@@AB@@@@CBCB@@ADAB@B@B@@@BB@@B@@@@BBDBBBBDB@BBD@@@B@@A@@BA@@B@B@B@BBBBBB@@@B@@A@ABA@A@ABA@@@@@E@CB@@@DAB@BA@CDABA@ABA@A@C@@@A@@@@A@@@C@A@A@AAACA@AA@C@C@A@A@A@A@ABABAB@B@@BBBB@@BBB@@BDBBBB@BBBF@B@@@BA@@B@@@@B@@@B@@A@@D@D@@B@BBBB@@BBB@@B@@B@BB@B@B@@@BCB@@@@B@BABADAB@BA@@@A@A@ABA@@BAF@BA@A@A@@@@BA@@@A@@@AA@@A@A@A@A@EBA@A@@BA@@B@@@@@@@@ABED@BAB@BB@BBBBD@D@@BDBB@@@@B@@@DAD@@@B@B@BBBBB@@AB@B@B@BAB@@ABABA@@B@BABAH@@AB@B@B@@A@@B@@ADAB@BABAB@@@B@@B@@B@@@B@B@@BB@@BBABBB@B@BB@@BAB@B@@@@@B@@B@BBD@@@@BAB@BB@@@@@@B@@ABA@A@@B@B@B@@B@B@@@@@@B@B@B@B@BB@AB@BBD@@BB@@B@@@BBA@ADA@AB@B@BB@@@BB@@@B@@@FAD@@CBABC@AB@@AB@@@B@B@B@@BDA@@BA@@@CB@@AAABA@A@@@@B@@@B@@@BCD@BA@@B@@@B@@@DB@ABABC@E@@BA@@B@B@@@BAB@@A@@@A@A@@@CBABBD@B@B@BAB@@@BA@@@ABA@A@ABA@@B@@A@@@@A@@C@@@ABAF@@@@DBB@@@B@@@DB@@@@@@BBDBB@BBB@@@B@BBBB@@B@@BDBB@FB@@@@@B@@@B@B@@@B@@BBB@@BDBB@B@@@@B@BAB@BBB@B@@@D@@BF@B@@AB@B@B@B@@@B@@ABA@ABAB@BA@@@BB@@BA@@BCB@BA@@@B@BA@@B@@BB@B@@@B@BB@AB@@ABCBAB@@@B@@D@@BB@BD@BB@@BB@@@@@@EB@@@@@@DBB@@@B@@DB@@@@BC@@B@DB@BA@@BC@@@@@@@@BB@@@@@@@@@B@@@@@B@@@@@@BB@AB@@@BB@@@@@BBBA@B@@@B@@@@@@A@@@@B@@B@@@BAB@B@B@BBB@B@DB@AB@@ABA@@B@@@@@@B@@@BB@@@B@@@@@B@@@D@@@@@B@@@@@@B@@@BA@@@B@@@@@@BB@@B@AD@@@B@@@BB@@B@@@BA@@@E@@AA@@AAAA@A@A@A@@D@B@@@B@@@@@@@B@B@B@B@B@B@B@@B@B@@B@@@@@B@@@@@@BBAB@@@BB@@@@@BA@EB@@BB@BA@@@A@A@@BA@@@@@E@@@@B@@F@@@@BEA@B@@@@B@@B@@@B@@ABA@@BA@A@A@@B@@@@@BB@@@DA@@B@@@@@@BAB@BA@@@@BB@@@B@@ABAB@@@@BA@@BADAD@@@B@B@@B@D@BB@@@BAAC@A@@@@@@B@B@@@DADAD@@@B@@B@@@@A@@BA@AB@@@@B@@@BA@@@@@BBBA@@@A@A@EB@@@DB@@B@D@@@B@AA@A@AB@@@B@@BBB@B@@A@A@@BB@@@B@BA@B@B@@B@BA@BBB@DB@@@@B@@@@@B@B@D@@@@B@B@B@B@@@@B@B@@@@B@D@B@FAB@BA@@@E@E@@@A@A@@FA@@@@@A@@@C@CB@@@B@D@@@@B@B@B@@@B@@@B@@DABAB@@BAB@BB@@B@@@@B@BA@@@@BB@@@@B@B@@@D@@B@@A@@B@@B@@@BB@@B@@@BA@A@AB@BA@AB@@B@BAD@@@@B@B@@@BA@CBA@AB@@@BB@@B@@B@@B@@@@BABBB@@@@B@@ADABABA@A@@B@@AB@@@@B@BB@@B@@ABAB@@@BA@@B@@B@B@B@@@@@@@AB@DAB@B@@@B@B@B@BBDABAAABC@EBA@AA@@A@@@AB@@@@@@A@@@A@@@A@@BB@BB@@BB@@@@ABA@@B@@@@@@B@B@B@B@B@BB@B@B@BA@@B@@@BDB@@@B@AB@A@@C@@@@BA@@BB@@@B@@@@B@BA@B@@@D@B@@@@CB@@@B@@DA@B@B@B@@@@B@@@@KBA@@@AD@@@@DAD@B@B@DB@@@@@@C@ABA@CBA@@B@@@@@@B@@B@B@B@@@@BAB@B@D@B@@@@@@DA@C@@@AD@@AD@@AB@BD@@@@B@@@B@BFBB@BB@@A@BB@@@ABA@@@@@@B@BB@BA@@B@@B@@EB@@@AAA@@@@ABADCD@@@BA@ABA@A@@BA@@B@@BD@B@@AB@@BABB@@@@BABAB@B@@@B@DA@AB@@@@B@@@@@BA@@@@B@@@BA@@@BB@@@@@BB@@@BCD@@A@@B@@@@B@@A@AF@B@@B@@@@@DC@AB@@@B@B@B@@@@@@B@B@@BAB@@A@B@@@@@B@B@B@@@@@@BA@@B@@@@@@DB@BB@B@@@@@@AB@@@@AB@B@@@@DAB@B@B@@@@@@@@@@B@@@BB@@B@@B@@@@@@@@B@BB@@BFA@@@@BF@DBBB@@@BB@@B@@@@A@@B@@B@@AD@@@@BB@@@B@B@@@@@@@@BA@@BA@@@A@AB@@@@@BBBBBB@@@@@BAB@@B@B@@BB@BB@@BBAB@B@@@@B@@@AD@B@BCB@@@B@F@B@D@@A@@@@@@B@D@@BB@B@B@@BBBBBBDB@@B@@@@B@@BBBD@DBF@DBB@B@D@@BBB@@B@D@@@BB@@@@@B@@@BB@@@@@B@@@@@BD@BD@B@@B@@@B@@@@@@BB@@DBB@@@@B@@B@B@BB@@BBB@B@BB@A@@BB@@B@BB@@@@@BB@@A@@@BB@@BB@B@@@B@@B@BB@@@BBB@B@@@B@@@BB@@B@@@B@@BB@@@@BBBBB@BBBBDBB@@@BA@@@B@BB@@B@B@BB@AB@DA@@@ABA@ABA@G@ABC@ABC@@BCBCDA@@B@@@B@@BBB@@BD@F@B@B@@@BBB@BBBBBB@D@D@DCD@B@B@@@@B@@@DA@@B@@@BBD@@B@@@B@B@BB@BBB@@B@BBB@AB@@@B@@@B@@A@@@@B@@@@A@@@@B@@@@A@@B@@A@@B@@ABA@AB@@@BABA@@BA@@@AB@@@@A@@@@@@BAB@@AB@DADA@@B@@@BAB@@@BA@@@@@@B@D@@AB@B@@A@@B@D@BBB@B@BB@@B@BBBBB@BB@@@@B@@B@B@@B@DDB@B@DDB@@AB@@A@@BAB@BC@@B@BB@BBB@B@BB@@B@@@B@@AB@@B@@@@@DA@@@ABA@@@@@@B@@@@@B@@@BA@@B@B@B@B@B@@@BB@@@@BBD@@BBBB@@@H@@@@@@AA@AAA@@@@A@AAC@A@@B@@@B@B@B@@@B@HADAB@@@@@B@FH@@@B@D@@@BB@@@DA@@@@BBB@@@B@@AB@@@BBBB@@B@BA@@B@BB@@BB@BBB@@@@BA@@BA@@BBB@B@BB@@BB@@@AB@@@@AB@BB@@B@@@B@@BBB@@@BDB@@D@B@@@FABA@@@A@AB@@A@@BABAB@@AB@@ABAB@@@B@BB@@B@@@DABABA@@@BB@@@B@BA@@BAB@@B@@BD@B@BB@B@@@BB@@@B@@B@B@B@B@@@@@@B@@@B@B@@BB@B@B@B@@F@@B@@@B@B@@@BB@BB@@BB@B@@@@BBB@BA@@BA@@DAAA@@@@BA@@B@@@@@BAAC@@BC@A@AB@DA@A@@BAD@@@BAB@@@@CBA@@@@B@@@BBB@@@@@BA@AAA@@@@BA@@B@B@@@BABB@A@@B@@@@AB@@@BAB@B@@@@@@@@@AA@@@ABCB@@@BA@@BA@BB@BB@@BAB@BA@@B@BA@@D@B@@@@A@@@CBA@A@@@A@AB@BA@@B@B@D@@@@@BA@A@A@@BA@AB@@@BBB@B@BB@@B@@A@@@CBA@@@@BB@@B@@@@A@@@ABABCBA@CBA@@@@@@@AB@@AB@@@@A@AA@@@@A@@@A@A@ABABC@A@@@@@C@@@A@AAC@A@A@@AA@@@@@AAA@AAAAA@@@A@ABA@@@CA@@@A@@AA@@@AA@@@@ABA@A@CAA@@AA@@@@@BA@AB@@@@@@AAAAA@AAAA@@ADA@ABC@@@AB@B@@@BABC@@@AAA@A@@AA@@@@@AA@@@@@@A@ABA@@@A@A@C@@@A@A@C@GA@@A@@@C@@BA@@@@@A@@DC@C@@@@D@@@@@@@B@B@D@@@BAB@BABC@@BAAA@@BA@A@E@@DC@A@@@AAAA@EAAA@@AA@@AC@CAA@AA@@AA@@@A@@BAB@@@A@@A@A@@@A@A@A@@AA@A@@@AB@@A@@@A@@@C@AA@@AAABC@A@@@A@@AA@AC@@@@@@@BABA@@@A@A@@AACA@@@A@@B@BA@CC@AA@@@@AAA@@A@@A@@@@A@@@A@@@A@A@@@@A@A@@@AA@@@A@@BAB@@@@A@A@@@A@@@@A@@@@A@@A@@AA@@@@@CA@@@AA@@AC@@C@@AAA@@A@AABAAC@@ABA@@AAB@@A@@AA@@@C@A@AA@CA@@AA@AAA@@@AA@@AA@ACC@@@@@A@@AA@@@AA@BAA@@@@A@A@@@C@A@A@A@AA@@AA@AA@@@@@A@@@@@ABA@@BA@AB@@A@@@A@CA@@A@@C@A@AAAAAA@AAA@A@AAA@@AA@AA@AC@A@@A@A@@A@A@A@A@A@@AC@ACG@A@@DC@A@CAABAA@@CCA@@AA@@AC@AACAAAA@A@C@C@A@A@@@@AAAAAAAACAAA@CAAACAA@C@@@@@AB@B@BAB@@A@@@CBA@A@C@AB@@@B@BA@@AC@AB@@ABA@@@CBA@A@@@@B@@@BBB@@AB@@@B@B@@BB@@@@@@@BA@ABA@@B@B@B@@BBB@@@@BA@AB@@@@AB@B@D@BAB@@@B@@AAA@ACGAA@A@AB@@AB@BBB@F@F@B@DBB@B@D@FBB@B@@@B@B@DB@@B@DAB@BB@B@@@@D@D@BB@B@@B@BBD@B@BBBBB@BB@BB@B@@A@@@A@A@CBA@CBAFDB@BA@@@@BAA@AAC@A@EC@@CAG@E@@@EAA@AAA@C@A@A@CBA@A@E@@@EBA@ABA@AB@@@@AA@@AAAAA@@AA@@@DCDADADADCBABABCBA@AAABABA@@@AB@@@BADAB@@ABADA@ABA@@@A@@BA@@@@@@@A@@DA@A@@BAB@BA@@B@B@D@B@BABA@@B@BC@A@@B@@AB@@@B@FABA@C@@AA@AAA@@AA@@@A@EBEBCBA@@AA@@@AAA@@@AA@@@@AA@@AAB@@AB@BAD@B@BABAD@@@B@B@B@@@B@@@BCBAB@@C@@@A@CAA@EC@AA@ECAAAA@@@@@@A@@@AA@@A@AA@@@A@@@@@A@@AA@@@@@@@@@A@@A@@A@A@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@A@A@@@A@@@@@C@@@AA@@A@@A@@@A@@@A@AAA@AAA@@@A@A@A@@A@@A@@AA@@@AA@@@AA@@@@@AAAA@@@AA@@@@@BAB@@@@@DA@@@@B@@@BA@@D@B@B@B@@AB@@AB@@@BABA@@B@@C@@@A@A@@@A@ABA@@@@@@A@AAC@A@@@@@@AEBEAGA@@@@@@@ABA@@@A@@B@@AB@@AB@@AB@@A@@BA@ABA@AA@@@@@C@C@I@E@A@CAA@@@@@BC@@B@@A@@@@@@@@@ABA@@B@BA@@@CBA@A@AA@@A@CA@@AA@@@@A@@BA@@AAAAA@AA@@@ABA@AA@@A@@@AAA@@E@A@CACA@A@@@AB@D@DAD@@AB@FA@@B@@AAC@@BCDABABABA@@BAB@B@@@@CB@@@@A@@@A@AA@@C@@@A@AAAAA@@AAB@BC@@@@AA@A@A@A@E@@BA@@DA@A@@BA@@@@BA@@BA@@BA@A@C@A@@@@@A@A@A@A@A@A@@@CBABC@@@A@@@K@C@A@@@AAABAAA@@AA@@BC@@@@BA@@B@B@@@D@D@B@@@@AB@@@BABA@@@A@@@A@A@@AA@@@A@@@A@@@A@@@AB@@ABA@@@ADC@@@AA@@@@@@A@@@@@@B@@@@AB@B@@@@@@A@@@A@@@@@AA@C@ABA@A@AA@@@@@AA@ACA@@A@@@@A@BA@@@@@A@@A@@A@@A@@AA@@@@@AAAA@AA@@A@@@A@A@@@A@@@A@AB@@C@@B@@A@A@@@@@AA@@@@@CECG@@@ADA@@BABC@@B@@AA@CAAA@@AA@@@A@@@AACACACAGAECGACA@@@GGAAACCA@C@@AA@@@AA@@@AA@@AACBA@EDA@@@A@@A@@B@@@@A@@@@@A@@@@A@@@BABA@@A@@A@@@AB@@@@@@@@A@@@@@@@AB@@@@AAA@@B@B@@B@@BA@@@A@@B@DB@B@@@@B@B@@@B@B@B@@A@@BA@ADC@AB@@A@A@@@@B@@A@@@AB@B@@A@@@@@A@@B@@@B@@@BA@AB@@@@@B@@@DAD@B@@A@@@@B@B@BA@@B@D@BAB@B@@@HADADADCBC@@@@BB@@@@B@B@DCBABABA@ABA@@@A@@@@BA@@@AB@@A@A@E@AA@@@A@@@@@@@@@A@A@@@@AA@@@AB@@@@AA@@C@@@A@@B@@@@A@@AAA@@AB@@ABA@A@@@@BA@A@CB@@AB@@A@@@@@@@A@@@A@AB@@@A@@@@@A@@@A@@@A@@@@@@C@A@@@@@@BAB@@@B@B@@@BA@@@ABA@@@AAA@A@@@A@AACAA@@@A@@@@B@@@@BB@@BB@@@BBB@@@B@@A@@@C@@@AAA@A@@@@@@DAB@BGB@@@B@@GBA@G@@@@E@@@@A@@@A@@A@@@@B@@A@@A@A@@@@BA@@@@@AAIB@@A@@A@@@A@@@A@@@@ABAB@B@B@@@B@BA@@@@@A@A@A@@BA@BB@B@D@DAD@D@@C@@BA@@@@B@@@@@@@@@B@@@@@@A@BB@@@B@@@B@@AB@@A@GAC@C@E@AA@@CA@@@BA@@B@@A@A@ABA@@@@B@BA@A@@@CBEBE@G@E@ABAA@@AACCAAAA@@AB@@@@BBBB@B@@A@@AA@@@@@ABAB@@@@AA@@@AA@@@@@C@A@@@A@@@@A@@AA@B@@@@@@@@AA@@@@B@A@AA@@@@BB@@@@AAA@@AC@C@@@@@@@@@@B@B@@CBABC@@C@@A@@AA@@@ABA@ABABAB@B@@@B@@BB@@@B@BA@@@AA@AAA@@AAA@AACA@CAAA@@@A@@@AB@@@B@@@@A@A@@AA@AAA@A@@AA@@@C@@@AB@BC@@BCAA@A@A@@@@@A@AA@@@A@AA@@AC@@@A@AACCCCAA@@AAAAA@CA@@CC@AB@BA@@@A@@A@@@C@@@@@@@BA@@@@@A@@@@C@@BA@@BA@@@A@@@@AA@@A@AAA@@@A@@@@AA@@BAAA@AC@A@@@AA@@AA@AAA@@@@BABABA@@A@@ACA@A@@@ABABCBA@@@A@AA@AAAA@@AA@@@A@@DCB@@A@@CAA@@AA@@@@@BABA@A@AA@@@AAA@@A@@@@BAB@@A@@@A@@A@@@C@CAA@@@@AA@@AA@AAC@@@"],encodeOffsets:[[112129,36228]]}},{type:"Feature",id:"610630",properties:{name:"宜川县",cp:[110.168963,36.050178],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@BB@B@@@B@@B@@BBBLEAGOD"],["@@BBB@@@@@PD@CSA"],["@@@AA@AB@@AAA@@@@A@AA@@A@@@AE@@@A@AAA@A@AAA@A@@@AA@A@@@@A@@ABAA@BA@@CE@@@A@A@@@@A@AA@@A@A@@@@A@@CA@C@@A@AA@@AAAA@@@A@@A@@@A@AA@AC@A@AB@@A@A@@@ABA@AAA@@@ABA@AB@@@@AAABA@AAA@@@ACA@A@@BA@@@A@C@@@A@@@AA@@AD@@AB@@A@AAA@@BC@@@A@AB@@A@@A@@C@CBA@E@A@@@A@A@A@A@A@A@A@@@AB@@@BA@@BADA@AACBAB@BAA@@A@@AA@@AA@AA@@AB@@@@A@@@AB@@AAAAA@@AA@@@@B@@@B@@@BA@@AA@@@A@@A@@@BABB@B@DBBBD@@BB@@@BB@@D@@B@@@B@@@B@B@@@BAB@@@B@@@BAB@@@@@B@@@BABEBAB@@A@A@CB@@A@@BCB@B@@A@A@@B@BA@@@A@A@C@C@A@@BA@@@@@A@@@@BB@@B@@A@@B@BAB@@@BAB@@@@@B@@AB@@@@AB@@A@@@@BA@AB@@@@@BAB@@@@@@@B@@@B@@@BA@@BA@AB@@@B@@@BABC@@BA@@@AB@BA@@B@@@BABABA@@@A@@@ABA@AB@D@BABAB@BA@ABABC@@BA@AB@@@B@@@B@B@@@B@@@B@BAB@@@BA@@@EAA@ABA@AAA@A@A@@BA@@BA@@@A@A@@BA@@B@F@@E@A@AA@BCB@@@BAB@BBB@B@@@B@B@@ABAB@@@B@BBBAB@B@@@BDBB@BBB@@@@BAB@@@B@@BB@BBB@@@B@@@B@B@@@B@B@@ABCBAB@@AB@@@B@D@@@DB@@B@BBB@@@B@BBB@@@BAD@B@@@BBB@@@B@@BBBBB@BBBBB@BBB@@@BBBB@@@@D@BA@BB@BB@BB@BBBBB@@@B@@B@@BB@@@B@@@@@BB@B@BBAB@@AB@@@B@@BBBB@@@BA@@B@B@@@@@BAB@@BB@@@BD@@@@B@@@B@@A@AB@B@BA@@@ABA@E@ABABA@@BA@@AA@ABCDA@ADCB@BA@@@C@A@@BAB@@A@@@ABA@AA@@A@@@CAA@@BA@@@AA@@A@A@@@A@A@EA@@ABA@@@AA@@A@A@A@A@@@A@ABA@@@A@A@A@@@@B@B@@@@@BA@@BA@@@@@BBB@DBD@DB@B@@@B@BABA@EBA@AB@@@BAB@B@@@BB@@@B@@BB@@B@@@@@@C@CBA@A@A@@@EB@@A@A@A@A@ED@@ADCD@@CD@@@B@B@@@@@B@BBB@@BB@BBBBD@@BD@@@@@@ABC@@@A@AA@AA@@@AAAB@AABCA@@AB@@@AA@@@A@A@@AA@@@A@@@@@A@@A@BA@@BA@A@@B@@@BAB@@@BAB@@@@@B@D@B@@CB@BA@@DBB@BDF@@@F@BBF@@@@ABA@@BA@@B@D@B@@BD@D@DBBBBBB@BB@@@@@@BAB@DAB@BAB@F@D@F@@AB@@CB@@@B@BAB@@C@EBABA@AB@BC@@@A@EACBA@EBCBA@A@ABA@@@A@CBAD@@A@@BA@ABA@@B@@ABAB@@AB@@@B@B@@AB@BA@AB@@@B@B@@AD@@CB@@A@A@AA@@A@@@GB@@@B@@@B@@@B@B@D@B@@@@@BA@A@A@A@AACBC@A@BB@D@@BB@@@B@BA@@B@@A@A@A@@@ABA@ABAA@@@AA@A@AA@@@@ABABC@CBABCB@AA@@A@AA@@@@@AB@@A@A@A@AB@BA@A@AAAB@B@@@@A@@@A@@@A@AB@@@@@@AAAA@@AA@A@@@@@A@EA@@A@@@@@AA@@@@A@@CC@@A@C@@@A@@A@AA@@AB@@@AA@BC@@@AB@AA@A@@@AB@B@@A@A@@@AB@B@@@@A@AB@BABA@@@@@@@AA@@A@@@A@@AA@A@A@A@@@@AAAA@@@C@@@AB@B@@A@@@A@@A@@ACA@@AABC@CBABAB@@C@@@A@A@AD@B@BA@AA@@@@@@A@@AABC@@@@BA@@BA@@A@@@AAA@A@C@CCC@CA@A@@@A@@@E@@A@@@A@C@ACA@@A@@@ABA@A@A@@@@@ABA@@@AAA@A@@BAB@@@@A@@@@A@AA@AC@AA@@@AB@BABA@A@C@AAA@@BCB@B@@A@@@A@@@A@@B@AA@@AAA@A@@AA@@@AA@BAAA@A@@A@C@@@A@ABCBAB@@@@ACAA@@A@A@A@@@AB@BAB@@ABC@A@@BA@AB@@@AA@@C@@A@@@AA@@AA@@A@A@@@@AAC@ABA@@A@A@@AA@@@A@CBEBCBA@@@BAAA@AA@@C@AA@C@A@CB@B@BA@@BAD@@A@@@AAAA@@@BABAB@@ABA@@BA@@@@AAA@@A@CD@@@@@@AB@@AB@B@@@B@@AB@@AAAAA@A@AB@BAB@@@AA@@@AB@@AB@@CBA@ABA@@@C@AB@@A@BA@AAC@@@A@@@@@AA@AAAA@@AA@AAAA@@@A@@A@@@AAC@@BAAA@AA@@@CB@@ABA@@BA@@BA@AA@@AB@@@B@@C@A@@@@@A@@@A@CB@BC@@BAB@@AB@@@@A@AAA@AB@B@B@B@@@B@@B@@B@B@@A@@BA@@B@@@@@@AB@DABADAB@B@@@B@@A@@@AA@@@@AB@D@@@BA@@@AAA@A@AA@@A@@BAB@@@B@BAB@@@@C@A@A@@@ABA@@B@B@@@BAB@D@@@B@@A@C@@@ABA@@@ABA@AB@@AAA@@AAB@@AB@@A@AD@B@@BB@@@@@@@@A@A@AB@@A@@B@@A@@@@BAAAB@@A@A@@@AB@@@@BB@BAB@@@@A@AA@@A@@@@@AB@D@@A@AB@@C@AB@@@BCBA@@B@BAD@@BDAB@@@@A@@@AB@@@@BB@BBB@@@BABAB@@AB@@AB@@@DAB@@A@AB@@@B@@@D@@@DB@@@ADBB@BBB@D@B@B@D@B@B@BA@AB@B@@@BBD@BB@@BBB@@@BB@A@B@BBBB@BAB@B@@@B@B@@@B@B@B@B@D@@@B@B@@@B@@@D@B@@@B@@@B@B@DA@@B@@A@B@@@@B@B@@B@@B@@BB@B@@BB@@BDB@BBBB@@@@BAB@BBB@@@@BBD@@@B@BBDAB@@B@@B@@@@B@@D@@@BBBBB@@@@@B@@BBBB@@@F@@A@ABA@@@@B@BABAB@B@D@BA@AB@BCBAD@BCBA@@B@B@BAB@@@@BBB@@DBB@B@B@@B@B@B@DABCB@@BB@@@@BBDBB@B@@@BB@@@BBBBBBBBBBBD@@DA@@@@BBB@@@BA@@BAB@@BB@@@B@BABCBA@@B@B@@@BAB@@@@@BBBB@@BBD@B@D@@AB@@@BA@AB@@@D@BB@@DA@@@A@AB@@@BA@@@A@@@A@@@AB@BAD@@ABA@@BB@@@@DAB@BA@@B@@@BABAD@@@B@@@BB@@BAB@@AB@D@F@D@B@@BB@@D@BB@BB@@B@DB@@@A@@BABA@@BBB@BADAB@@FBB@B@BBB@@@B@B@BBF@D@B@@B@B@@BB@@ABABA@@BB@@BDBB@@B@B@B@@@DAB@B@B@@@B@@BB@@@B@@@B@BBBBB@B@@@B@B@BB@@@D@@AB@B@B@B@B@@B@B@B@F@@@BABA@@B@@BB@B@@@@@B@@BB@BD@@@B@@B@@@@@DDBBBB@@@@BC@ABADCB@BA@@@@BB@BBBB@@@BBBA@@@@BBBBB@DBB@F@BB@@@@BD@@@@@BD@BBB@@@B@B@@@B@@B@@B@@AB@BA@@DBB@DAB@@@D@BBBB@@DAB@@@B@@@BB@BDBB@BB@@B@BBDBBB@BBBBB@@B@B@@BD@D@B@@@@D@BDB@DB@@BB@BB@@@@@BB@B@DA@@B@@@@@BB@@@D@B@@B@B@@@@@DF@@@B@B@BBB@@BB@@@@@@BABA@AD@@@BBB@@@B@BAB@B@B@BBB@B@@@D@B@DADABA@ABA@AB@D@DAB@B@@@@BBB@B@@@B@@D@BB@@B@B@@@@@B@@ADA@@@C@@B@@AB@@A@@B@B@BADA@AB@@ABC@A@AB@@AB@@@BB@@BBB@B@B@B@BABA@@B@B@@@@@BB@@@@BA@AB@@A@@@A@ABAB@@@@@B@B@B@@@@BBB@BBB@FBBDD@BBBB@DA@@BA@ABAB@B@BBBB@@@@@@@@@A@@BA@@@@@@F@B@F@@@B@@BBB@BBBBB@@@B@BA@AB@B@BB@B@@@B@@AB@B@B@@B@D@B@BAD@@@B@@BBB@BBDB@BDDBBB@@@B@@@@B@B@@@@B@@@B@BBF@@B@@@BB@@@DB@@BADEBABAB@B@B@BBB@BB@@BB@D@B@@@B@@@@@B@B@@@@@B@@@@B@@BB@@@BBB@B@ABB@@B@@B@@@BBBBDB@@BB@@@@BB@@BB@B@@@@@BB@@@BB@@@@BBB@BB@A@ABA@CBC@@@AB@BBD@BBBB@@@B@D@B@BAB@B@@@B@@A@@B@BD@@B@@AB@@@B@B@@@@BBB@@B@@@B@@B@B@@B@@BB@@B@@@D@@@B@@@@B@B@@BB@@D@@@@@BB@@@BB@@@B@@B@@AB@BCBAB@@@D@B@BAB@BBB@B@@ABABA@@@@@AB@@BB@@@BAB@@@B@@@@@B@BB@@@@B@B@@@B@B@B@B@@@B@@@D@@@@@BDA@@DA@@@A@C@A@ABA@@@@@A@@BA@A@@B@@@BBDB@@BB@@@BAB@BA@@B@BA@AB@@B@@BA@@@@BA@@B@@@BBB@B@@@@@B@B@B@@@@@B@@@B@B@@@@@B@@AB@@@@@B@B@@A@@B@@@B@B@@@@@BAB@@@B@@@D@@ABB@ABBB@B@B@BBB@BBBB@BBB@@@B@B@@B@@B@@B@@@A@BB@B@B@@B@@@B@@@B@@B@BB@@BA@@@@D@@@B@@@@@@@B@BB@@BB@DPC@E@@B@F@B@@BBJBH@BA@@@A@EEAAKFA@BB@@@B@@@@@@@BA@A@A@A@@@@BB@@@@BB@BB@B@@TBDEBA@@B@BDBD@@EFABCDA@@A@@BEOCAD@@B@@@@@B@@@@@B@@@@@AD@@A@@@@@@@@A@@AAA@@B@@@@@@B@@@@@@@B@ABA@@BA@@B@B@@@@A@@@A@@B@@@A@@A@@@@@@B@@@B@@B@@@@B@@@@@B@@@B@@BB@@BB@@@@BB@@@@@B@@BB@@B@@B@@B@@@B@@@B@@@B@FAB@D@@@B@@@B@@@BB@@BA@ABC@@@@DBBBB@@BBBBB@@BB@@B@BB@@BBB@DA@@BAA@@@@@@ABA@@@@@A@@BA@A@@BC@AB@@AB@A@DA@A@@@@BC@ABA@ABAAG@A@@B@@@@@@@@AAA@@@A@@@ABA@@BC@ABCB@@A@@@@BABAB@@AA@@@BABA@@BABABAB@B@BA@@DA@ABA@A@@BA@@BC@ABABC@@D@B@BABA@@@@B@@@B@@@B@B@B@B@@@@@@BB@@@@BD@@@B@@@BAB@@@@@B@B@@@BA@@@@@@BA@AB@B@@@BA@@@@@A@@BA@@B@@@@A@@B@B@@ABABAB@@A@@B@BBB@BBBB@@@@B@B@@@BA@@@AB@@@B@@@@@@@@@B@@@B@@@@AB@B@@BD@@BB@@@BABAFA@@D@B@@B@@B@@@@@A@AB@@BBA@@B@B@@@@@B@@@B@@@@B@@A@@@@B@B@@@B@BABABA@@B@@@@@B@BBBAB@BB@@BAD@B@@@BAB@@ABBB@B@F@@@BB@@A@BB@B@@B@@@@A@BB@DBB@D@BA@@B@BA@@@AB@@@@@D@BABABA@AB@@A@@@AB@@@B@B@B@@@DADABA@@DAB@B@DAB@DABABADA@@B@BB@@BAD@BA@@@@BA@@B@@AD@@@B@@@B@B@@A@@BA@ABAA@@ABA@@@@B@@@DABAB@BABA@@B@B@BA@@@@DA@@B@BA@@B@@@BB@@@BB@BA@@@AB@@ABA@C@@@@@AB@@@BA@A@@BABA@@@A@@DADA@AB@@A@@B@@@@B@@B@B@B@@@@AB@@@D@B@@@B@BAB@BA@@@A@A@@BAB@BAB@BAB@@B@@@@B@BBB@@@BA@@BC@@@@BA@C@@BAB@@@BA@@B@B@@@@@@@@AB@BAB@@ABA@@@AA@@AB@@@B@@@@@BAB@@AB@@@@@B@@@@A@@BA@B@@B@@B@@@BBBB@BB@@BB@@@@@B@BB@@BB@@@@@B@B@B@@@B@B@B@B@BBBA@@B@B@@@@@@B@@BA@@@BB@B@@@B@B@@BB@@AB@BA@@BB@@BB@@@@DA@@@@B@@B@@@BBBB@@@B@@@@AB@@@@AB@@@B@@@@B@@@@@B@BBB@@@@@@BAB@@@@@@B@@@@BBB@@@@B@D@@@@@@B@@ABA@@B@@@BBB@@@B@B@BA@@B@B@B@DB@@@AB@@@B@@@@BBB@@BB@@@@@@@B@@@@B@@@B@B@@@B@@@B@@@B@@AB@D@@@B@B@@B@@@@@@@B@@B@@@@AB@BA@@@@B@@@DB@BB@BAB@@@@AB@@@@@@B@@ABA@@@@@BBB@@B@@@@B@@ABA@@@@BB@@B@@AB@B@@AD@@@@@@A@@B@@@@@AA@@@A@@@@B@B@BB@@BB@@A@@@@DB@@@@@B@BAB@@@BB@A@@@AB@@@@@@@BD@@B@@@B@@A@@@A@@@@B@B@@@@@@@BADEBADEDEBC@EAI@E@EDI@GCGAI@@EICCGICE@@MMCECI@GBGFEFI@G@A@GCIIOEMCIAKBG@A@ABKBE@C@A@@@A@A@A@A@E@A@C@CBC@CBC@ABA@A@A@@BAAA@@@AB@BCBGBEBEBC@A@@DEDEFCHGBA@A@@@A@C@CBC@EAC@ACE@C@A@ABCBC@@BABEDCDCDABCBABEBEBCDCFGDIFK@EBEAEBEDK@ABEDCBE@CACGKCE@C@@BADGDEBEDADAJCFAHCDEBA@CAC@A@C@IAA@AAA@@AAAACAC@ECAAACACBIBCDIBCHGFEDEBEAE@CACACAC@C@CDG@E@E@CAA@IAECE@C@@@@@CDAFALAJAF@HBHDFBD@HAFADC@CAE@C@IAO@@A@@@A@A@@@AB@@@B@F@BBF@D@@ABA@@@A@AAC@@A@@@A@@@A@A@A@AA@AAA@E@C@AA@@AA@AA@@@A@A@CDAB@@A@@@AA@@@AB@@@B@@A@@AAAAACAC@AEC@@A@@@@@A@A@A@B@@B@@@@A@A@A@@@A@@AA@AA@A@@AA@@A@AAA@A@@@AAA@@@A@@@A@@BAB@@@@@@@AA@@A@@@@@A@@@@A@ABA@A@CAC@@A@@BABCBA@@CA@@A@@@@A@@BA@@@AA@@A@@@@CAA@@@@AAC@BAA@BAAC@@AA@@@ABA@@BA@@@CFAB@@@BA@@@@AA@@A@A@@AA@AA@AA@@A@@@C@@@@@A@@@@@A@@A@@@A@@@A@@@@A@@@@@@A@@@@@AC@@@A@A@A@@@AA@@A@A@A@A@A@@@@@A@A@@@A@C@C@@A@@BA@@@@C@AAA@@@CA@@A@@@A@A@@@AAAA@@@AA@@@A@A@@@@A@@A@@@@AA@@@AA@@@AA@@@C@@@AAA@@@A@A@@@EAC@A@@BA@@@CAA@C@A@@@A@@A@@AAAAA@@A@@AAA@@@@AA@@@A@@@AA@@BA@@@@@A@@@AA@@AAA@@BAAAAA@@BA@A@@AAA@A@A@AA@@A@AAA@@AA@@AC@A@@AA@ACCA@@C@@A@@@@@A@@@AA@AA@@AA@@@AAA@@@@@AA@@@AA@@@AA@CA@CA@@A@AA@@A@@@A@@@A@@@@B@@AA@@@@AAA@@@@A@AA@A@A@A@AA@@A@@B@@@@@@AA@B@AAA@@@AAA@@@@@@@A@A@A@AA@@A@AA@AA@@@AAA@@AA@@@@@A@@@A@E@A@@A@@CBA@A@@@@AA@A@AA@@A@@@ABA@@@@@AAA@A@A@A@CB@@A@A@CD@@ABA@@@A@@A@AAA@AA@@@@AA@A@@A@A@@A@@@AC@@BA@@AA@@A@@@AC@@A@A@@@CAAA@@A@C@"]],encodeOffsets:[[[112753,37242]],[[112755,37254]],[[112961,36596]]]}},{type:"Feature",id:"610628",properties:{name:"富县",cp:[109.379776,35.987953],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ADA@ABADCBABABC@@BA@A@@AABA@A@ABAB@@AAA@@A@@A@@BA@A@@@AA@ACA@@AAA@AA@@@@A@AAA@@@@A@A@C@@@C@ABC@@@A@@@@@C@A@EBCA@@@B@@A@C@AAA@@A@BA@@@A@@B@@@@A@@@@A@@C@A@@B@@C@@B@@A@@BA@@@A@@@ABAB@@A@ABCB@@@@B@@@AB@@A@@B@@@@A@A@A@A@A@@BA@@@A@@@A@@@A@@@@@AAAB@@@A@@@@@@@AA@@@@@AB@@@A@@@@@@@@A@@A@@AA@AA@@@@@AB@BA@@@A@@@ABA@ABA@@@A@@BA@@@A@A@@BA@AAABA@A@@BA@A@A@A@@@AAA@A@C@A@A@A@A@ABABAB@@A@AB@@AB@@@@E@E@A@A@@@@@@BAF@F@B@@@@A@C@@@@B@D@@BB@DBB@@@BF@@@@B@B@@AB@B@@AB@B@@@@A@@@A@CAA@@@A@AA@@A@@AA@AC@@AA@AAA@C@CAEAA@A@@ABA@A@ABA@@@CCA@AB@@GAA@@@A@A@A@@@AB@@@B@@BBBDBBDDB@@@@BBB@@BB@@BB@@BB@@BB@@@@BB@@BB@@@@BB@@@BA@AA@BA@AA@@@@@BA@A@A@@@C@A@@@A@ABA@CBC@@@ABA@@AA@@BA@AAA@CAA@AA@@AA@@CA@A@A@A@A@C@A@@BA@@@AAC@A@@@A@@@A@AA@A@AAA@@@AAA@@@A@@@@@@@@ABA@@@A@@@A@@@A@@@AB@@A@@AA@A@@B@@A@@@@@@@A@@BA@@@@@AA@@@@@ABA@ABA@A@@@ABA@A@@AA@@AA@@@@CAA@AAAC@@A@CA@@A@@@@@@A@@B@B@@A@A@AB@@@B@@ABA@@BA@ABBB@@A@@B@@A@A@AB@BA@C@ABA@@BA@@BA@A@A@@BA@AB@@A@A@@@@@@BA@AB@AAB@@ABA@@@@B@@A@@B@@C@@BA@@B@@@@@@@B@@E@@@@@AB@BA@@@A@@@AB@@@@CBA@A@AB@@@@ABA@@@A@AAC@A@A@@A@@A@@BAAA@A@AAA@AAEAA@C@E@@AA@@@AB@B@B@B@B@B@@@B@B@B@BBF@@@DAB@B@@CBAB@@CA@@@@ABAB@B@@@B@@@BABAB@@@B@BAB@@CBA@AA@@@@AAAAA@@@A@@BA@@@A@AAA@A@AA@@A@AAA@@@CB@@@AC@A@C@@B@B@@AD@@@B@B@BA@@BA@@@ABCBC@@B@@@@C@AB@@A@@AA@C@@@@@@B@B@@@B@@AB@@A@@B@@A@@B@@AB@BAB@@A@AA@@A@CAA@ABAB@@@B@@ABA@@@@BA@AB@@A@@@AA@@A@@BAB@@@@A@A@A@@@@B@@AB@@A@ABABAB@A@@@@AA@@ABA@C@A@ABABAB@@A@@@CA@@@@AAAA@@A@@@ADCB@DAB@@@BA@@@ABAB@@@@BB@BABA@@@A@@BC@A@A@A@CB@@A@@CA@@@@AA@A@A@@@@@AB@@@@A@@AA@CA@@AAABC@@@ABA@AA@@A@@A@A@@A@@@A@@@AB@B@BAB@@AAA@@@A@@@AB@B@AA@AA@@@AA@A@CB@@AAEA@@ED@@A@@@A@AA@@AC@A@ACA@@AC@A@@C@AA@@A@A@@@@@AA@@A@CBABA@@@@@AA@A@AA@@A@A@@@@AAE@@@A@@@@@C@@@A@@AC@AA@@@@CBC@@BA@A@@@C@A@AA@@@C@@@@A@@@A@AAC@@@C@A@@A@A@AA@@A@@A@A@A@C@A@@AA@A@ABC@@@ECA@@BA@A@@@@@CA@AAA@CB@@A@@@ABA@CAA@C@@@A@@@AA@@@A@A@A@AA@AA@AA@@A@A@@@A@@A@A@@AA@@@@@AA@AA@CA@AB@A@@AA@@AAABA@@AA@A@@@@@A@@AAAA@AA@@AB@@@@A@@BABA@A@A@AA@@@@CA@@@A@@@ACAA@@@@@ABABA@ABC@A@A@AAA@A@@@@@AD@@AB@@CAA@@AE@A@A@AA@@@@AA@@@AAA@@A@@AAC@@A@@@A@@@CCAA@@@@AA@A@A@@@@A@A@ABCBAB@BA@C@@@A@@@A@AC@@A@A@@@ABA@ABA@AB@@AD@@A@A@E@@@A@@B@@C@@@A@A@@@@AA@B@AA@AA@@A@@CDA@CD@@AAA@@@C@@B@D@B@@@@A@C@AB@@@B@@A@A@CAAA@@AC@@AAAAAAAC@A@AA@A@@@AB@@ABA@@AA@@A@@@@A@AAAA@@A@CB@@@@@AAA@A@AA@A@@@@@AB@@A@@@A@A@A@A@@@@B@B@BCB@@@BC@AB@@AB@B@@A@C@ABCACAAAC@A@@@@@@A@ABC@@AA@A@@@A@A@@@AEC@@A@@AABA@A@A@ABA@@@AB@BAB@BA@A@AB@@AAAA@@AA@@A@@A@AEA@@A@ABAB@@A@A@AA@@AA@ACACCAA@@BA@ABA@A@@@A@@AAA@AAA@@B@@AA@A@@AAA@@@A@@A@@A@@@ABABABADADA@EEC@@@@@A@A@@B@BCF@@A@A@@BAB@@@BA@@@ABCBAB@@@D@@A@@@A@A@A@C@@BAB@@ABADA@@BA@@@C@@AAA@@@A@@AAAC@@AA@AA@AACAA@EA@@ABAB@B@@A@@@A@@@A@A@ABA@A@A@@D@@AB@@A@A@A@@DAB@B@@ABA@@@@@@BA@A@@@A@A@A@AAAA@@CACA@AA@@AAB@@@@AA@@@AA@@@A@A@AA@@A@AAA@@@A@CC@@A@G@AB@@A@A@EA@@CAADADEFCDCDCDAFADAF@D@D@BBBDBB@BB@DBTBPAB@BBB@@@B@B@B@PBP@PCRANKTOVKL@@GHCFAHBNDDZZDFFJHBTD~ZFXFNLJLFNDBBL@L@\\ANEZCJ@NBNFJH@@BJBTBTANBNDF``NJJNATCTALERBNBNDPDLFLFJNPNR@@@@@@@BAB@@@@A@@B@@A@@@@@@@ABABA@ABC@@@@B@@A@@B@@@@@BDFDLBBHJDFFDBBAHENELEJYhADILKRINILGJGRADINGTADGV@FBFJTDDBD@DGJCL@@@@@BGV@@ABCX@D@@@BBJBZET@J@B@@@B@@BVDNA@B@@@DNFRDFB@BBBB@B@@B@@@DA@@F@B@BBF@B@@@@@@ABADCB@DC@@@@BDBB@@BD@@B@B@B@B@@CBA@ADA@@BAAA@@DADC@A@BB@@B@BB@B@@BB@@@B@@@DCB@@@B@B@@@@AB@@A@@D@B@BA@@B@BB@@B@@@@BBBB@BA@@DA@A@A@A@@@A@A@ABCBC@@BABB@@@CBCBADE@@B@@BBBB@@@B@B@B@@@@@B@BB@@B@B@@B@@BDB@B@B@B@@@DA@@B@BBB@B@@@B@@BBB@B@BBD@BBB@@@@B@@AB@@@DA@@@CBA@A@@DA@@@ABA@@B@@@B@B@@@DABCB@B@D@B@@@@BBFB@BB@B@BB@@@@@B@@AB@@@BB@@B@BAB@BDDB@@B@B@@@BA@A@@BAB@@@BA@AB@BC@ADABC@@B@@A@A@@BA@@B@@@@@BDB@@@DAB@B@@@B@@@@@DCBC@@@@B@BAB@BAB@BABABABCB@@CB@@A@@@@@A@@HBB@@B@B@@BBBB@BBAB@@@D@B@@@@@BA@C@A@@BAD@B@@AB@B@@@BA@A@ABBB@BB@@B@B@B@@@@B@B@B@@B@D@B@@@B@@BBB@@@@B@@@@@DA@CB@BA@@BA@@BA@A@@BABABBB@BAB@@@BA@ABA@A@@@@BBB@@BBBB@@@BBB@B@@@BA@A@@@AAA@A@@@A@@@@BAB@B@@@B@@@B@@@B@DAB@@ABA@@B@@@@BBD@BB@BB@@@ABA@@B@@@B@BBBDDB@@D@D@B@@@DA@@BA@ABABBB@B@@@B@@@B@B@@@@C@@BA@C@AB@@AB@@AB@@@BA@@B@@CBA@@@@BAB@B@@@BB@@@@B@@@B@@@B@@@@A@@BAD@BA@@B@@@B@@A@AB@@@@@@BB@@BB@FABA@@B@@A@ABA@@DB@@BBB@B@DC@@DBB@B@B@BC@@BAB@@@@@BB@@DBB@D@B@B@DB@B@A@@B@@AB@BB@@@@B@@A@@BA@@B@BAB@@@B@@@B@B@@ABCDA@@@A@@BA@@D@@A@@BABA@@FA@@BA@@@@@C@@B@@@B@@@B@BAB@BC@@BA@@DA@@@A@@BA@@@A@A@A@A@@B@@@B@B@B@@@BAB@@A@@BB@@B@@AB@D@@@BCB@@A@@B@BAB@F@@AAA@@@@AAA@@A@@@A@AA@AAA@CA@@A@@AAA@@@@@AAAAAA@@A@@@@@A@A@A@@@A@A@AB@@A@@B@FA@@@@BAA@@A@@B@@AD@B@@@HB@@B@BA@A@@@C@@@@BC@@@@A@@A@AAA@@BA@@BABABA@AB@AA@@A@@@@AB@FABAD@BABA@@@@@A@A@A@ABAB@@A@CB@AAAA@@AAAA@@@@A@@@A@@A@@@AACAAA@@ABA@@@A@AB@@@BA@@BC@@B@@AB@BA@ABAB@@@@@B@BB@@BABC@@B@B@@@@@BB@@BBB@B@BAB@@A@@B@BAB@@ABA@@@A@A@A@@@@DABA@A@A@@@@@@B@@@B@@@B@@@FDD@@@BAB@@@@ABA@E@AB@@AB@BBB@BA@@@A@@AA@A@@BA@@AA@ABC@A@@B@BBB@B@B@BAB@BADA@A@@AA@A@@@A@A@@BC@@AC@@BA@@BA@A@AB@@@DAB@@AB@@A@AAA@@BABABABABA@@B@@BB@@@BABAB@@ADB@@B@BB@AB@@CB@@@@@B@BDDB@@B@B@BB@@@@B@@@B@@A@@B@@BB@@@@AB@@@B@BD@B@@D@@@BB@AB@B@@@D@@@@A@@@A@@B@DBBB@@BA@A@@@A@ABC@@@A@@@A@@@A@@D@@@BC@@DAB@BA@@BA@@BA@@B@B@@@@@BA@@BA@@BA@@@ABBBBB@@ABA@@B@@AB@BA@@@@@@BAB@B@@BB@@B@ABABA@@DBD@BAB@BBBA@@@A@@BA@@@BBBB@@@BAB@B@DAB@@AB@@@@@@BB@@@B@@ABA@@@ABA@A@@B@@BB@@@B@@@B@@@BABA@AB@BA@@B@B@@@@AB@@A@ABADA@ABA@@BAB@@@@BB@@@BB@@B@@D@F@@@@B@@@B@B@@@B@BB@BB@B@B@DADAD@@B@@B@@D@B@BBDBB@@DBB@BB@@BA@BB@B@@BF@@@BB@@@BBBBB@@D@@@BBBBB@B@B@BB@BB@B@@@@@@@BB@@B@@@BA@@B@@@@@BB@B@B@B@@B@B@@@BB@@@@B@B@@A@ABABAB@B@B@BC@A@@@AD@B@@@B@@@B@BB@BBBBBB@D@B@B@@@@ABA@ABAB@DA@@@@@@BBBBBB@@D@FBB@FAB@@A@@@A@C@@B@B@DB@@DCBA@@D@@A@@@@BE@A@@B@@@BB@@J@B@B@@@B@@@BA@@@ADCBA@@@AA@@A@@BA@@BABADAD@@AB@@AB@@ABA@A@@B@@@B@D@BB@@@B@@BAB@@@@@@@@A@@@AD@B@@@B@B@@BD@D@B@DBB@B@BDB@D@F@@@B@@@B@@@BAD@@@BA@@B@B@@AD@@@B@B@@@@@B@B@@@BBB@B@@@B@@@BA@@@@B@@@@AB@B@D@@@@@BA@@@@B@B@@@B@@@B@@@@@B@@@@@@B@@@@B@@BBAB@@@B@@@@B@AB@@@@@@@B@B@B@B@B@@AB@B@BA@@B@B@B@BB@@B@@@D@@@@BB@@@BBB@B@@AB@@BB@B@B@@@B@BA@@B@B@@@J@B@@@DBB@@@B@BBB@BBDD@BBBFBD@@B@@@B@BB@BBBBB@BBB@BBB@@BBB@BBB@BA@@BABDB@@B@@@B@@@BABA@@B@B@@@B@B@BD@@@@B@@@DBBB@A@@B@B@@@@@BB@@@@B@B@@@@@B@@@@B@BB@@@@@BCB@@@@@B@BB@@B@BBBBB@BAB@@@DC@@B@@@B@BBB@@B@D@@@@B@@@BA@@B@@BB@@@@BBBDD@@D@BBBBB@@DBDAB@B@B@DABADABA@@B@@@@BBFBHDBBB@B@DBB@D@B@BBB@@@B@B@@@BDBBBFBB@@D@D@B@BB@@@BBB@@@@@B@@B@@B@@B@B@B@@@BAB@@A@A@@B@B@B@@@@AD@@@DBBBBBBDBBDBBB@@DBDBB@@B@B@DB@@@@@@@@@BBB@BB@@@BBB@B@BB@@BB@BBB@@@DAB@@@B@BDBDBD@B@@D@D@FAD@@@B@@BDF@D@DB@@@B@B@@@@BBB@B@D@B@D@@@@B@D@F@@@@@@@@F@@BD@@@BB@@@@@FA@@BA@@@@BDDFBB@@@@BADAB@@AB@@@@A@AB@@@B@FB@@@@BB@BBB@@@BD@@AB@@@B@@@B@@@BA@@BA@@@A@@@@BABA@@B@B@@A@@@ABC@A@@BGBC@ABA@ABC@@@AB@@A@@@@D@B@@@B@@A@@@@D@B@B@BA@@BA@@D@@@BA@@DB@@DD@@B@BC@A@@BA@@B@BADABC@@DAB@BA@@B@@@B@@@@B@@DA@@B@@ADCD@@A@@BA@@BA@@@C@@@@DA@@B@BA@@B@@@BA@@AA@@BA@@BA@@B@D@B@B@@@DBB@BD@@BA@@B@B@@BD@BBDB@@B@B@@B@@B@@CB@BA@ABBBA@@B@B@@@B@B@@B@@@@B@@@BAB@@@B@B@B@B@B@DDBDB@B@@@BB@BBB@@BBB@@BB@@B@BB@BB@@@@B@B@@A@@@AB@BA@@B@@@B@@BBD@@@@@@DABABCB@@@B@@@@AB@@@B@BABA@ABABABAB@B@@@B@B@D@@@@@BABAB@@@BA@ADAB@@A@ABE@@BA@@BBBA@A@A@AB@DA@ABCDA@ABAB@@A@C@ABABA@A@A@@B@BAB@@@@E@@AAAA@@@A@@@@AAAA@A@@@CA@@@@@@AA@@@BAAC@A@A@@AC@A@@A@AAA@AB@@@@AAAAA@AC@@AA@@@AAA@@@AA@@@@A@A@@A@B@@@@AB@@C@A@A@@@A@@@A@C@@@A@@@A@A@@@C@A@A@A@A@@@A@A@@@ABA@AAAAAA@B@A@@A@@AA@AA@@AAC@A@@@ABAB@@A@A@A@C@A@A@CAA@AAABC@@A@@C@@@C@@@A@@BAB@@@BA@C@@BA@@BA@@BABA@A@@AA@ACB@@AB@@ABA@AA@@@@@AAA@@AAA@@A@@@A@A@@@AE@@@@A@A@AAA@@@A@A@@@A@@@@A@A@A@A@@@@A@@AA@@A@A@@A@AAC@@@AA@AB@@ABA@@@A@@A@@ABABCB@@A@@@AAA@@@A@AB@BA@@BA@ABAB@@@BA@@B@B@@ABEB@@A@C@@@CA@A@@AA@AA@@@A@@@AAA@@B@@A@@B@@AA@@AAA@A@AA@@AB@@AB@@@@AA@AAA@@AAA@@@ABA@@@AAAA@@A@@BA@@@A@AA@@@@CB@@A@@A@@@C@A@@EGA@@@@@A@CBGBA@@@A@A@A@@@A@@@@BBD@B@B@@B@BBB@@B@@@@G@@@A@AA@AC@AA@@@@AA@@A@A@A@A@A@@@AB@@A@@@@@A@@@@@@@AB@B@@CB@@@@A@@@BA@@@A@@@AA@@A@AAA@AAAA@@@ADA@AB@@@BA@@BA@CCA@A@CCA@@@@A@AA@@@@@AAA@AAAAA@@@AAA@A@AAC@A@@@@BA@A@@BC@A@@@@@@@AB@@A@AB@@A@@@CBCBA@@BA@AB@@@@@@@B@@A@@B@@AB@@ABAB@@A@@BAB@BA@@@@BA@@@@B@@A@@@@@@B@@A@@@@@@BA@@@A@@@A@@BAA@A@AA@AAA@@A@A@A@@@AC@AA@@A@@@CB@@A@@@@@@A@ADC@C@C@CAAAAAAA@AA@@A@A@E@C@@AA@AA@@@A@@@AB@DCDA@AD@BAD@BAH@B@BAB@BA@@B@@CBAA@@A@A@AA@@A@A@@AB@@A@CAAAAAA@AAAA@@@@AA@@@A@@@AA@@A@@@A@@@AAA@A@@AAA@@@@A@@@AAA@@AA@@B@@@AA@@@@A@@A@AA@@AB@A@@A@AAA@AA@@A@A@AA@@@@@AA@CA@@A@@@@A@@@A@@@@AACC@@A@@@@@A@@@@AA@@A@@@@@A@@A@@@C@AA@AA@@@C@AAA@CAE@CACAA@@@A@@A@@@CAAAAAAA@@@A@AAA@@@C@A@@@@B@@@@C@A@E@A@@DA@A@ABC@@A@@@@@@ABAAA@@AAA@@AA@A@@@BA@A@@@@AAAAAA@@@@A@@B@B@@AB@@AB@@@@@@@@@A@A@@AA@@@@BC@@@AA@@@@B@@A@@@AA@@A@AA@CAE@@@@EB@AA@@A@A@@@@@@A@@@@AA@@A@@@A@@@@@@@@A@A@A@CB@@@@@ABA@@@@BA@@@@@@@AAAA@@C@@@@A@@@AB@@@@@@@A@A@A@@@@@A@BA@AB@@@A@A@@@@A@A@A@@@A@@BCD@@@@A@@@@ABEB@@@@A@@A@@@@BC@AD@@A@@A@@@@AA@@B@@A@@@A@@B@@A@@@@@A@@A@@BCBA@@@A@A@ABAB@@@@AAAB@@BA@@@AAC@@@AB@@AB@B@BAB@@A@@DCBCBA@@@@BB@B@@FA@@@AA@@@ABA@@A@A@@@@B@BA@@A@@A@BA@@AAAAEA@@@A@@@@@ACA@@BC@@BC@@B@@@DCB@@@@@@@A@C@ABA@A@@A@A@A@@@@A@@@@A@@@AB@DAB@B@D@@@@A@@C@A@ABC@C@@C@@B@@AB@L@@A@@@@@@A@A@ACB@@@A@@DA@@@@@A@C@@@AABA@@@@@@A@@AA@@AB@@@@@DB@A@@B@A@@CA@A@@@AB@@A@A@AAAA@A@A@A@A@@@@@@@@AB@BA@@@@AA@@AAA@@AB@@@B@@@B@@@@@@@BA@@B@@@BBB@FAD@BABBBAAC@A@A@A@A@@@ABA@CBA@@@@@@A@A@A@@@@AB@@A@@BABA@@@AA@@A@A@@A@@BA@@@@BABABCB@BA@@@@@AABA@A@@A@@@@AA@@@AA@@A@@BAB@DAB@@A@A@@@@@BC@A@AA@@BABA@@B@BAB@@A@@@AA@@A@@@@AB@@@@AC@@@A@A@@@@@AA@@@@ABA@@@@@@AA@@ABA@AA@ABCB@@@A@@@A@@@A@A@A@@C@A@@@A@@D@D@@@B@@@@EB@@@B@B@@@F@F@@ABA@EBA@C@A@@@@@@A@A@@A@A@A@A@@@@@@C@A@A@@@@@A@@A@@CAA@AABA@@@@A@AABA@@@A@@AB@B@@@@AAA@AA@@@A@@B@BBBA@@@C@A@@@CA@@A@@F@B@B@@ABAA@@@@B@@A@@@A@@A@@BAB@@@B@@A@@@@A@@BCBC@C@@@A@A@@@@B@D@BB@A@@AAC@A@@@@A@A@@BCBC@AB@@A@@A@AB@BA@@@A@@A@@B@@ABA@A@@@@A@@@CB@@A@@A@@@@@AB@B@B@@AB@BA@@@A@@@AA@@@@@@AFB@A@@E@@@@A@@F@@@@@B@@AB@B@@@B@@AAA@@FAB@@A@@@@AA@@A@AB@A@@@@A@@@@@A@@@@A@AA@A@A@A@A@A@A@@@@@@@A@@@A@C@@@@B@B@BBBBB@@BB@@@F@@AB@@A@@@AA@@A@@@C@@B@AA@@A@@@@A@@@B@@A@@@A@@@@A@@@@@C@@@A@@@@@A@@@A@@A@@@A@@@@A@@@AB@BA@@BCAA@A@AAA@A@A@AB@@A@@@@A@@B@@@@@@@@A@@@AABAA@@@@A@@A@@BAA@@A@@@@A@@@@@A@@@@@@@@@A@@A@@@@@@D@@AB@@ACAA@@@AD@@@@CA@@@A@@AA@C@@@@A@@F@@@@A@@AA@@AACA@@AC@@@@A@@BADABA@@BAA@@A@A@@@AAA@@@AB@@A@A@@ABA@AD@@AB@@AA@@B@@ABABAB@BA@@@A@@@A@A@ABA@@@AAE@@@C@@@AAA@ABA@A@A@@A@A@CA@AA@AA@@@A@@@A@A@@@A@@@@EAA@CA@AA@@@AAAAA@@@A@AAA@CAAAABCAA@C@A@@@@@@B@B@BB@@B@@AB@@A@@@A@CC@@A@@@A@@@@@@BA@@B@@A@@@@A@AACAC@A@@A@@@@@AB@B@@@@@BCBABCB@@A@A@AB@BAA@@@@AA@AAAB@@AAC@@AABA@ABC@A@ACE@AC@AA@BCBAB@BC@@BA@@BA@@AA@@A@@@A@A@A"],encodeOffsets:[[111898,36623]]}},{type:"Feature",id:"610631",properties:{name:"黄龙县",cp:[109.840314,35.584743],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@A@@AA@@AAAA@@AA@@AAA@@BA@@@@A@A@@AA@AA@@@@A@A@@@AA@@A@@A@AA@A@@@@AA@BA@@@A@A@@BA@AACAA@@@A@@@A@A@@@@@A@@@A@A@@@A@@A@@A@@@@A@A@A@A@A@@AA@@@@AA@@@@@@AC@A@@@@AAAA@@CAA@@@A@@@@@AC@@@A@@@@A@A@A@AA@@A@A@@@@@CBA@C@CB@@AB@@A@C@@@CAC@C@@@AB@@ABA@AA@B@@A@AB@@AB@@@@@BA@@BA@A@@@AA@@A@@ACA@@AAA@@@@@@AA@@A@@@@@A@@@@@@AA@@@@@AA@@A@@A@@B@B@B@B@BBB@BB@@B@@BB@@@B@B@BA@@@A@@@AA@@AAA@AAA@AAAACAA@@BA@@@@DA@@@AB@AC@AA@AC@@@A@C@@@A@ABEBA@A@A@C@@@@A@AA@AAC@AACA@@@A@@@@AA@@@B@@A@AB@@@@@B@B@@BD@@@@AB@BAB@@@B@B@BA@@BA@@B@@AB@BBBABAB@BA@A@CDAB@B@@A@@@@@A@@@@@@@@@@DA@A@@@AB@B@@@B@@A@@@AAA@A@CAAAC@@AA@@A@C@@A@@@A@@@A@@AAA@A@@@AB@@A@@@A@@AAA@@E@A@A@AAA@AA@@@@@@A@@@ABABA@AABAB@@@@@BA@A@A@@BE@A@ABA@@@A@AAA@A@E@C@A@AB@@@@A@C@@@A@@B@@@B@@A@C@ABA@@@AB@@A@@@A@A@CB@BA@A@@BA@@@@AA@BA@A@@AA@@@A@AA@@@AA@@@AAA@@@@A@A@@@@BA@@@@AA@@@C@@BABA@@@C@C@A@C@CBA@A@A@@@@BA@@@A@@@@@C@CBAAA@@A@@@@AA@@CB@@@A@ABE@@AA@@A@@@@@@AA@AAB@@@D@@@@@AACA@@A@CBAB@@A@@@@A@@@A@@@@A@@B@B@D@B@B@@@@@B@@B@@B@@@@A@@@@D@@A@@CA@@CA@AAA@@@@A@@@@@AA@@@A@AA@@CC@@@@A@@@@B@@@@@@BB@B@@@@@@@B@@@B@@@H@B@@@B@B@@@@BB@@BB@BB@@BBB@B@@E@C@A@A@AB@@@BA@@@@@@BA@@A@@@@A@@@@@@B@@@DB@@D@@C@CBA@@@AB@@@B@B@@@@A@@@A@@A@A@A@A@@A@A@@@@BAB@BAB@@A@@@AA@@AA@@BA@AA@@@@@CD@B@@CAA@A@@@@@@@@B@BBBA@@@AB@@ABAB@@BBB@@@AB@BAB@@@A@@AAA@A@A@A@@@A@@@AA@@@B@@A@@ABC@@B@@A@@A@@@A@A@@@A@@@BC@@@AAAA@@A@AB@@A@@BBB@BB@@@@@@@A@A@AB@@@@@B@@@BB@B@@B@@@B@@AA@@@@A@@BA@@@AA@AAAA@@A@@A@@@@@AAAB@@AAA@A@@A@@BAB@BA@@@A@@BA@@B@B@B@@@BB@@@@B@@@BCBCD@@A@@@AB@@@@AAA@@@@@B@@AA@@A@@A@AB@@AB@@A@@@@@@@A@@@@BA@@@A@@@@@@@@A@@A@A@ABA@@@CACAECCA@@@@@@@@AB@DABA@ABC@@@@@BBB@@@AD@@B@@BB@@@@@@B@@A@A@@@DB@@@@ABADCB@HB@D@BD@@@@@BA@A@@BC@@AA@A@@@A@A@@A@@A@AA@@AB@AA@A@@A@@C@A@A@@@A@A@@BAA@@A@AA@@AA@A@EA@@A@@A@@@@@AB@@@BCD@B@BABAB@@ABA@@@AAAACAAAA@CAC@CAC@CACAECA@AAACA@AAA@@A@AA@@A@@@@@@@A@@@AA@@A@@@@@AA@@@@A@@@@@AA@@@BAAAB@@@AA@A@@@@AA@@@@@D@BA@@B@B@@@B@@@B@@@@@@A@BB@@A@@B@@@BA@@@B@@@ABB@@@@@A@B@@BA@B@AB@@@BA@@@@B@@A@C@@@C@A@A@A@@A@@AA@@BAB@B@@A@@@AB@BA@@@@@A@AB@@@@A@@@A@@@A@@@@@A@@@A@@@@@@@A@@@@@@A@@AB@@@A@@A@@@@@@A@@B@@@@@B@@@@@@@@AB@@@@@@@B@@AB@@AB@@A@AB@@CBAB@@@@@B@@@BABC@@@AB@AAAAACCCACCCAA@AAAA@@BA@AA@@AC@A@A@@B@@AB@@@@@B@@AB@BABABABCDA@A@@@@@AA@@@@ADCDCBCBGB@@A@A@ABC@A@@@ABA@A@@@@@@BA@C@CB@@A@@@@@A@@BAB@@A@@@A@@@@B@@@@@BA@A@@B@@@BA@@@@@@B@BA@@BCD@BAB@@@BA@A@A@@@A@A@@@@@@ACAA@@@@B@@AB@@@AA@A@@@BB@B@@A@@B@@@@@@@B@@@@@@A@@B@@@BB@@@ABAB@@B@@@@@@B@@@@@B@@A@@@@BB@@@B@FCB@DABB@@BB@@B@@B@@BB@@@DDBBDBBHH@@B@BDDHBFBHBDBDBD@B@@@B@@BB@@BBDBB@@BA@@@ADAB@@CB@B@@DHDF@@@@B@@B@@@@@B@BA@@@@DA@@B@B@@@B@@@B@B@@@BB@@BBBBB@@@@B@@BB@@@@BB@@@@B@@@@ABB@@@@@@BB@BDB@@B@@@@BBB@B@BAD@B@@B@@@@@B@@@B@@@@A@A@@B@@A@@@@@@@@B@@@@B@@B@@CD@B@@AB@BA@@B@@@B@@@B@@@B@@BB@@@B@B@@@B@@ABAB@@A@@B@@A@C@C@@@A@A@@@AB@@@@AD@@BB@@BBABBB@B@@@B@D@L@@@B@@ADAB@D@@@B@B@B@B@B@B@@@@@B@D@BAB@@AB@@AB@@@@AB@@@BCB@@AB@@@F@B@B@BBB@@@@ADA@BB@@BBBB@B@B@@@DB@@B@B@@@B@@A@@D@@A@A@AB@@ABABABCBAD@@BD@BA@@@EBA@@BC@CBC@A@@B@@@BDBDBB@F@@@BB@B@@@BB@@BAB@B@@BBB@BBBB@@AB@@@B@@B@@BB@@D@BB@@B@BAB@D@@ABA@@@AB@B@@@@@@@@@BA@@@AD@@@@B@DBB@F@J@D@D@@@@@B@@BAB@BAB@@@BA@@BA@@BA@@BA@@@@B@@AB@B@@@@@@HBFBFA@B@@@@B@D@BBB@@@@@@@AB@B@B@@@B@B@@@DA@@@ABAB@@A@@BA@@BA@A@A@C@@@AB@@A@@@@@CB@@@@A@AB@@@@B@@B@@BBBB@@@@B@@B@@BB@@B@@BB@@@@BB@B@B@@@B@BBB@BBB@@@B@@@B@@@@BB@@B@@@D@@@@@B@@@B@B@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@B@BB@@@@B@@@@@@@@BB@@@B@@@@@B@@BBB@@@BB@@B@@@@@@@BBBBFDB@@BFDB@DBB@@@D@@@BADA@A@@@A@@@A@A@A@@BCBA@A@ABC@ABA@@BA@BB@@B@@B@@B@@BB@B@@BB@@ABADAF@F@B@@BB@@BB@BBB@@@DABEBA@@@A@@BA@@@@BADA@@@ABABA@C@A@A@@@ABA@AB@@@BCB@@@B@@@@@@AB@@@B@@AB@BCBAB@BA@CBAB@@A@@B@@ABABBB@BABADABABCDCBCBCBCD@@B@@BB@BBBB@@BB@@@@BAB@BAB@FA@@F@B@B@DAB@B@D@B@BBB@FB@@F@H@DB@@FDB@D@BBB@AB@@@@ABA@ECAB@DAB@D@B@B@@@BA@A@@AAAA@AAAAA@C@AAA@@@@AAAC@C@@@@@@AAAA@CBA@@@CAA@A@@@A@A@EAC@A@A@CAA@E@E@A@AAA@@BA@@B@BBBDH@BBB@BA@@@A@ABC@A@A@@B@@A@@BAB@@@@AA@AA@A@A@@@AB@BAB@@@@@@A@@AA@A@@@A@@BA@AA@@A@@@@@@BAB@D@@AB@BA@@BBD@@ABA@@@A@@B@D@BAB@D@@@BA@ABA@A@@B@@@@@DBBBDBB@DBBDBBBBBBBBB@@@@@B@B@D@D@BBBBBBD@BBD@@BB@@DB@DB@ABBB@D@BCD@@@BDH@BBD@@@B@B@B@B@BB@B@@@@BBDB@@BBB@@BB@B@BBB@BBBBBBBB@D@@@@BB@@D@B@@@BA@@BAB@@AB@B@@@@@B@@@@BBB@@BB@@B@B@B@B@D@@@B@B@@B@ABB@@B@@BB@@@B@@@@DD@BBB@@BB@@B@BBB@@BB@@DBBB@D@@@B@@BB@@@BA@BB@BA@@BDABBBB@@@BB@BD@@@BD@@BB@@B@@D@@@@BB@@@BB@@@@@@B@@B@@@B@B@@@@@BA@AB@@@B@@B@@@B@B@@B@B@@@B@@@B@@@@@@BB@@@BB@B@@B@@BDDB@@A@AB@@@B@BD@BB@B@@@B@BA@A@@@@@@BDB@@BB@@@B@D@BABB@@BBD@@@B@@@B@@@BA@@B@B@@BB@B@B@@@B@B@@@@B@@BA@AB@@@B@@BB@@BBB@DBD@@BB@@BBFBB@BB@B@@@BCD@@@F@BAB@@BBAB@@ADABA@AB@@C@A@A@@@@@@@C@@@@@@DCD@@@B@@@@AB@@@D@@@BB@@H@D@B@B@@@D@B@B@@AB@B@B@@@@B@@B@@@@BB@@@BBB@B@@ADAB@@A@A@@B@@AD@BCB@BB@BB@BBBBB@B@@@@A@@BAB@@@@B@@BB@DBB@B@BA@@@@BB@@B@@BB@@@B@@D@@AB@B@B@@BBBB@BBB@B@@@@BB@@@B@BBD@B@B@@@D@@@@@BADB@BBB@@@@@BA@@BA@AD@@ADAB@@@B@@@@@B@D@@@@A@@BA@@BBB@@AB@@AB@BA@@DA@@B@@BBBAB@@BD@B@@@BB@@@B@BB@BBB@@BBBBB@@B@@@@@B@@BD@BABB@@@BAD@@@B@BAB@DA@@BA@@BA@@B@@B@@BA@ABAB@B@BBBB@@BA@@@A@@@ABA@@BA@@@@@@DCB@@@BB@B@@B@@AB@BA@@BABA@A@@BBBB@@B@@@BC@AB@@A@ADAB@D@B@@B@DB@@BBBAB@@B@DAFADAB@@@B@@BB@B@@@AB@BBD@B@@B@B@@@BB@@BB@@B@@@@DB@@B@@BAB@@AB@D@BA@@BA@ABA@@B@B@B@@@BBBD@@@@BADABAB@@@D@B@@@@BBBABB@@B@@BB@@@BBB@BB@@B@AB@@@B@@@B@@@@ADA@AB@BBD@B@B@BA@ABA@@B@@BBDB@@B@B@@B@@@@@BA@AB@B@BB@@B@BA@@@@B@B@B@BA@@B@@@DB@B@D@B@@@BF@@@B@@@B@B@@DDD@D@D@BBB@B@@@BB@@AB@@A@@D@BA@BB@@@@@@@BBB@@A@ABCB@B@@@D@@@BABADAD@BA@BB@BD@@@BB@@@B@@@@ABA@@D@@@B@BB@B@@B@B@B@B@@BB@@@B@@@BB@@@@@@B@BA@ABAB@@@@@@ABA@@B@B@@@@ABA@@B@B@@BBA@@D@@ABB@@A@@BB@@B@BB@@@D@B@@@DD@@@B@@B@@B@@@@@BB@@F@B@@@@@BBB@@BBBB@@@@@@BAB@@@B@@@B@@@@@@ABABBB@B@@ABAB@B@B@@@BA@@@@B@@B@BB@@BDABADAD@BABA@@@@BBB@B@@B@@BBBAB@BA@@B@B@B@@@@AB@@A@A@@AA@@@CAAB@D@DABBB@B@B@B@@A@@@@@A@C@A@A@@@A@@@A@@HA@@B@@@BBB@B@@@DA@@BC@@@A@A@@BAB@@ABA@@@A@A@@BA@@BABA@@@AB@BAB@@AB@@@BCDAB@@@B@BAB@B@DAFAB@DAFBB@@@D@@ABAB@BAFAD@@@BA@A@A@@DA@@BA@@@E@C@EBA@ABA@CBA@A@@@@A@@AAAAAAA@C@CAC@@@A@C@AB@@AB@BA@@@@AE@A@E@@CE@AAA@CB@@ADA@@@A@C@A@@@@BA@A@@BA@A@@@AB@B@@AB@@A@BB@@@@@B@@@B@@BB@B@@@B@@B@@BA@@DBBA@BBABB@@B@@BBBB@@@D@BA@@@@@@AC@@ACAA@AAA@@AA@A@A@@@@@A@A@@DC@@DCBC@@FCB@B@B@B@@@FA@@B@B@B@DAD@@@@@@@@AA@@AA@@@A@@A@@@ABA@A@@BAB@FAB@BA@A@A@@@ACAC@CAA@AA@@@@B@@AB@@A@@@@@A@A@@B@B@B@@@B@BAB@@@B@B@B@B@@@BB@@B@BA@@FBB@B@@@B@B@@@BB@@B@@AB@DB@@B@@@BBB@BA@@B@@@BA@AB@D@@@B@@ADABCB@DCBAB@@BB@@AB@BABAF@B@BA@@B@@A@ABAB@@@@A@@@A@@C@@A@@AA@@BA@A@@@@@A@AB@@A@@AAAA@@@A@@BA@@BAAAA@A@@A@@@@@A@@AA@@@AA@@@A@AAAAA@@AAAA@@AABC@@@@@AAAA@@A@AAA@AAAAA@AAAA@@@A@@AA@A@@@ABC@A@@AA@A@A@@AA@A@AA@@C@@@C@A@@BA@@BADABA@@@A@A@@@A@A@@@A@@AA@AAA@@@A@@BA@A@@A@AAA@CA@A@@@ABAAA@A@A@@BABA@@@A@A@@@AAA@ABA@A@@DA@ABBB@F@@@@E@AB@@AB@B@@@B@@AB@@AB@B@B@BBB@BAB@FB@@B@@A@@BA@A@A@@@A@@@A@A@@@A@@BAB@@AD@BABAB@@ABABA@A@CBAB@BA@@B@@@B@BABA@A@@@AB@@ABA@@B@@AD@BA@A@@@A@@BAB@@AB@@A@@@A@@@A@@@@@@BA@A@@@@BAB@@A@@B@@@BA@@@@BA@@@A@@@@BA@A@@BA@A@AB@@@@AA@@A@@B@@@@@B@@AB@D@D@B@B@@@B@@A@AB@B@@@@ADA@AB@@@DAB@B@@@BAFABA@A@@@A@@@@BA@A@@@A@@BA@A@@@A@A@@@A@@@AC@@@AA@@A@@AC@AACAA@A@ACC@A@A@A@@AA@A@A@A@@@@@AC@@A@ABA@@BA@C@@@A@AA@@@@A@A@@@@AAA@@@@A@@@A@@@@@@@AB@@@@A@A@A@AA@@AA@@A@A@A@@AA@@AA@AB@@A@@@CB@AA@@AA@@BA@AAA@AA@@AA@@AA@@A@@A@@AA@A@@A@@A@@A@@@@AAABAA@@AAA@@@@A@@@@@AA@@AAA@@@A@@A@@AA@@A@AAA@@A@@@AA@@AA@@AAA@@@A@@@AAA@A@@AAA@@@AB@ACB@@@A@@A@A@@@A@CA@@@A@@@@A@AAC@@A@@@AAA@@@A@@@A@@@@B@@@B@BABA@@DAB@@ADAB@D@B@@@B@BA@@@@@A@@@A@@BA@AD@@@D@B@BADB@A@@@@D@B@B@@@B@B@@AB@@@@@@@BAB@@ABABAB@B@@@B@@@B@@AB@@ADAB@B@B@@@B@@@B@B@@@B@BA@@B@@@@@@@B@@BB@BB@@B@@@@@B@@@B@BA@@B@@AB@D@@BBAB@BBBA@@B@@@BA@@@AB@B@@@BAB@@@B@@AB@@@@ABAA@@@BA@@BABC@@@A@A@@@AB@B@BA@@B@@@B@B@@@@AB@@ABABAB@DAB@@@B@@@B@DC@@@@DBB@B@@@B@@@@BCB@B@@@@B@@@@@BB@@@AB@@CB@@@@@B@DB@A@@@@C@CAAA@@@@D@@@B@@AB@@AB@@@@AB@@A@@A@@AA@@@@ABA@@AA@@@@@A@@A@@A@A@ABAB@@A@A@@BADA@A@@@@BABA@@BAB@BA@@D@@@B@@A@@BBBA@@B@DADA@A@@@A@ABC@@@@H@D@@@@AA@@A@@@@@A@@@A@@BA@AB@@AB@B@@A@A@A@@A@AAAA@@A@BAAAB@AA@@AA@@@CAA@AA@@A@@@@DC@@A@@A@A@@@@D@BAB@@@@@@A@A@@BA@@@@DA@@B@B@@AB@@@D@DA@@BANAD@FABA@@BAB@BADA@@@A@A@@@@B@@A@@BAB@@@@AA@A@@AEA@@A@@@@AAAA@@@AAA@A@AA@@A@C@CAA@BC@A@@BA@@@@@C@ABC@C@@B@F@@@@@@C@C@A@@@@AAA@A@A@A@A@@BA@AC@@A@@@@B@@@@AA@AA@@@ABA@ABA@A@A@A@A@ABA@A@@@A@AB@@ABA@ABC@AAA@CBA@@@A@@@A@@@C@ABA@A@@BCB@BCBCBC@A@CAAB@@AAA@@AA@A@@@A@A@@@BA@@@A@@B@BA@@@A@ABA@A@ABA@@BA@ABA@@@A@@BABA@AAC@A@EBEBC@@@A@@@A@AA@BC@CBA@A@AB@@AB@BBB@@@@A@@AA@@@AB@@A@@A@@@BAB@@@@@@@A@@AA@CC@@A@AB@@A@A@@@A@E@AAA@A@A@A@A@@@A@CAAA@A@A@A@@@A@C@@@@A@@@AACA@@@AAA@@@AA@AA@@BA@A@@@A@@@AAA@AA@@AAA@AA@A@@A@@@AC@A@@AA@A@AA@@A@A@CA@@@@AAA@@@AA@@@A@@@@@@AAAAAA@@@@@AA@AA@@@@@A@@@@@@AAA@A@AAA@AA@BCAAA@@@@@@A@CAC@@AC@@@CAAAAAA@A@A@A@@AC@@AA@@@C@CAA@@BA@C@A@@AA@@@@CB@@@@@@A@@@@A@@@@AA@@B@@A@@AA@@BAB@@@@@@@AA@@@A@@@@@@AA@A@@A@@@@CA@@A@B@@ABA@@@A@@@@A@@@EAAA@@A@@@AA@@@A@AB@@A@@AA@@@A@@@@A@@@"],encodeOffsets:[[112775,36291]]}},{type:"Feature",id:"610632",properties:{name:"黄陵县",cp:[109.262961,35.579427],childNum:1},geometry:{type:"Polygon",coordinates:["@@CD@B@HBFABAFADCBC@A@ABCB@BAD@@QBIFKNGH@BEBA@E@CFCBCBA@@@A@@@@@E@AB@B@DAB@@@F@@CFAFA@ABCBGAC@GBC@CBCBCDEDALAL@FCB@DGH@D@BCLGT@LETDNFJ@@@B@BBD@B@@@@B@BD@BAF@@ABADGB@BCB@@CDAFAB@D@B@BFFBBBBBD@DBD@BABCBCBABCBAD@D@B@BBB@@B@D@BBHJDFDFBB@F@F@B@@BBDDBBDB@@FBB@B@@@BAH@B@@@DDB@@@B@BBB@@@BBB@B@@@B@@B@@BB@@@@BA@BB@@BDBDB@@BBBBB@B@B@@@B@B@@A@@@@B@BA@@@ABA@CB@B@B@@@BA@@@CB@B@B@BAB@B@@@B@@@B@@@@ABABA@@FBB@DBBBB@@BBB@@BDBB@@@B@@BB@BD@@@B@@AB@BCBA@@BA@AD@B@B@B@@@B@@@@C@@BADABA@@B@@A@@BA@AB@B@@@DE@A@AB@B@@@@@D@FFB@BCBCBABABA@@B@@@@BB@@@B@BB@@@BBB@@@AB@BBB@BB@@@B@@@BAB@BAB@@BBDDDB@BBB@@BBB@B@@@BABAB@@@FB@B@BB@@@BB@@BBBB@@BAB@B@@ABA@ABA@@B@BAB@B@B@BA@BB@@@FD@B@@@B@B@@@BBB@@AD@B@B@@@@B@D@BBDBDBBAD@B@@@@ABA@@BAD@@A@@DA@A@A@A@@B@B@B@B@@@B@@@BA@@@@B@B@@B@BBB@B@@@@DAB@@@BBBBB@@@@@@BB@@BB@BA@@BA@@B@B@@B@BBDBBBBBB@@BD@@BBDBB@B@@@@A@@BAD@B@@@@@@A@C@AD@@@B@BB@@DCB@DC@@@BB@@BBBA@B@@B@@B@B@@@D@@@@AB@@@F@B@B@@@BC@@BAB@BAB@BA@@B@B@@@BDB@@@B@@@D@B@@ABADABAB@B@@@@@@B@BBB@@@@BBDD@@B@@@B@@@BD@BB@@@BB@B@@BB@@@@BBB@B@F@@BB@DB@@BA@@BC@@@@B@B@BBB@B@D@BAB@BABA@@@@B@DB@B@@@B@@DB@@@@BBB@B@B@BA@AB@@@@@BA@@BBB@BB@BB@@@@@B@B@@BB@BABB@@BB@@@BBAB@@DBBB@@B@@@@BB@@@B@BB@@@B@B@@@BBB@@BBBB@B@B@@@B@@B@@@B@@@DBB@DAB@B@@@BA@@DBB@BDB@@@@B@B@@AB@FD@@D@BAB@B@@BB@D@B@B@B@@@@BB@@B@B@BB@D@@@D@BBB@@@B@@@@@@D@@BBB@D@@@B@B@@AD@DA@@@@BBD@@BB@@@D@@@@@B@@@F@BB@@@@@B@BB@@B@BBB@@@@B@BADAB@@@BB@@@@B@B@@@BBD@@@@BBD@@DB@B@BBD@@BBB@@@B@@@FC@@FBBB@@DAB@B@@B@@BBB@@B@ABA@@B@@@B@BB@@BA@A@ABA@@B@@@B@@@@B@BB@@@BBB@BA@@D@BABB@@DBB@@BB@@@@@BA@@@@B@B@B@@B@@B@@DB@@@DAB@B@B@D@@AB@@@B@BA@AAA@@@@BABA@@B@@A@@BA@CDABC@@B@@@BBBB@@@@DB@@B@@@BABABAB@D@B@BA@@BB@@@@@BBABABAB@@@BA@@@A@@B@B@B@@@@@BA@AB@@@BB@@B@@@BAB@@A@@B@BA@@@A@@BABAB@DBB@@@BBB@@@BA@ABA@@@AB@@@@AB@@@BA@@@A@@@A@A@@@@D@B@@BB@@@BAD@@@@@@AD@DABA@@B@@AB@@A@A@A@@BC@@@A@AD@B@D@@B@@DA@@B@BBB@@@BBB@B@BBB@@@B@@AB@@@B@BBBB@@@@BBB@DA@@BA@A@A@@BABA@A@@@A@@@ABABA@@@@DB@@BADA@@@ABA@C@@AE@A@A@A@A@@@A@A@A@A@ABA@@B@@BF@D@B@FBBBB@BBB@B@BB@AB@@@@BB@B@D@BBB@@@B@BA@@@@BAB@B@DA@@@@BA@@B@@@B@@ABA@@@@F@@@@A@@@@@@@AB@@AD@@@@AB@@@@A@@B@BA@@BA@BBAB@@A@@@@B@B@@@BAB@@AB@B@B@@AB@@AB@BAD@B@@ABAB@B@@@@AB@@@AABAB@@AB@BA@@@A@@BAB@B@@@@A@AB@@@@@@@@BB@@D@BD@BB@BBB@D@@B@@BB@@BB@B@BA@@B@B@BAB@BA@@@@B@@B@@@@AB@@@B@@@@@@@BA@@@@BBB@@@BA@@B@@@B@@@B@@@B@@AB@B@@@@@@B@@@B@BB@@B@BBB@B@@B@B@@@B@@@BBD@B@@AB@@@B@D@B@B@B@BDB@@BB@@BBB@DBB@BBB@@AB@@BB@BA@@D@DAB@BAB@@@B@D@@@B@B@B@@A@@@@BBB@@ABBB@@A@@AA@@@@AA@@AA@@@@AA@@AA@@AA@@AA@@AA@A@@A@CCAAACAA@@@A@@BA@@B@B@B@@@B@HB@@BAB@DD@@B@BAB@B@BA@@@BBBBF@D@DBB@BBB@@BDB@@BB@@@BBB@@@B@DBB@@@B@@@@@@ABA@@@ABA@@@A@A@@E@@A@@AA@CAA@@@C@A@@D@B@@@@@@A@EBE@A@@@@B@B@F@F@@@@@BA@@BAB@@@BABABAB@B@B@B@D@B@B@BB@@B@B@B@B@@AB@B@BABBB@@AB@B@@@B@@AB@@@B@BAB@BA@@B@@@B@@ABA@@@@B@@BBB@@@BB@@@@@@@@@@B@@BA@@@@B@@B@@@@@@@B@@BABB@@@@B@@@B@@@B@@@B@@AB@B@B@B@B@@@@@@AB@@@BA@@A@@@@@DABAB@@@BABA@@B@@@B@@AB@@@@AD@@@@AB@D@@@@B@@B@@@@@@AB@@@B@@A@BB@BBD@B@@@@A@@DBFAB@D@@@@@B@@@D@BAD@@@D@B@B@@@@@BB@B@B@@B@@BBB@BB@BDB@@B@@@BAB@@@BB@@@BB@BA@AB@B@BABBB@@@BAB@@ADABABCDAB@BCB@B@B@B@B@@@BB@@BB@@AB@@AD@@ABADA@ABBD@@BDF@B@BAD@BABBB@@BD@BA@BB@BBB@@@@BB@ABAB@B@@@DABADA@A@@@@@ABA@@@@B@@@@BBDBD@B@B@@B@@@@AB@@A@@@@B@@@B@@@DDB@@@B@@@BA@@@AA@@A@A@A@@@@B@D@B@DBBA@@@@@@CA@@A@@@A@CA@@@@BEBA@@D@@@@B@@B@@@@AB@BAB@B@BA@@B@@A@@BA@A@A@AACBADA@@B@B@@@B@@@BA@A@@@A@AB@@AF@D@BABAA@@C@@@A@@@AB@@ADC@A@@@A@@@A@@B@B@BABB@@DA@@B@@AB@AC@@@A@A@A@@BA@@BAD@BADA@@BC@E@@@A@@AA@@A@@A@ABAB@BCB@AA@@A@@@AA@@AC@ABAA@@A@A@A@A@A@@@@A@A@@@@A@A@AB@B@BA@@@A@@@@A@@ABA@A@@C@AAA@@@@A@@@@@ABA@AA@@A@AAABAAA@@AA@@@A@A@@@AA@@@@A@@BABA@ABABC@@@AB@@@@A@ABA@@BGBA@A@AB@BABA@@BA@A@A@ABA@@AAAA@A@A@A@@BC@C@@@A@@A@CA@AC@C@AAAAA@@ABA@AFCBA@@@@@@@@@AB@@AB@B@FAB@B@B@B@@@BB@@B@@@B@@A@@B@B@B@@ABE@AB@BAB@B@@@B@@ABABCBA@A@A@@A@AD@@A@A@A@@A@AA@@@AA@AA@AA@A@AC@C@@@@BA@@@A@@@@@@AB@@A@@@AAEAAA@AACA@AA@AA@@AAAA@@@ABABABAB@B@B@B@D@D@B@@BDBBB@B@B@B@D@@@B@@B@@@D@B@B@BAB@BADCB@@ABA@C@@DAF@@@@@B@BAB@B@BAB@@@@A@@AAAAAAA@A@A@@@AB@@@BA@@@C@AAA@ACAACAAA@@@@@AA@@A@@@A@ABABC@@DADA@@@@BA@@@C@AB@@C@AB@@AB@B@@@B@BBDDB@@BB@@@B@@@@AB@BABCBA@A@@@A@A@@@A@CB@BA@AB@B@B@D@B@BBB@BBBBB@@AB@@AB@@A@A@ABC@A@C@E@A@A@CAA@A@AA@@A@A@A@@BAB@BAD@B@B@@BB@DF@@@@@B@@@@@B@D@@@@B@DBD@FB@@B@@@DA@@BA@A@AAA@AAA@@@A@@@A@@BAB@@@B@@@BBDBBBB@B@@BB@B@BBB@B@@@BAB@@A@ABA@A@ABA@A@C@C@ADCBABAD@@@DAB@BA@AA@@A@@A@@@A@A@A@EBC@A@@@A@@AA@@A@A@@BABAFA@@F@BBB@DBB@@BB@@BB@B@B@BAB@@A@A@@@A@A@@CAAA@@A@ABAAC@C@ABC@@@AAA@@A@A@@@A@@@A@@DCB@BAFAD@@@@A@@B@@A@@A@@A@@@@AAA@A@A@C@GBGB@@A@@@@A@@AA@@@AB@@AB@B@NC@@CAAC@@@@ABAB@BA@@@A@@@AA@@@@AA@@@AB@A@@@@@A@@@ABA@@@A@@@@@@@BABA@A@@@@A@A@A@ABA@@@A@@C@@@@@@AA@AA@@C@@@A@@@@@@@AA@A@@@ABA@@@@@@@AB@@E@@@@@ABAB@@@BA@@B@@A@AB@@@@AA@@ABA@CB@@A@@@@BA@AB@BA@@@C@@@ABB@@BB@@@@@@B@@AD@@BBA@@BCBCBA@A@A@A@A@A@@@C@@@A@A@A@@@A@@@A@@@ABA@A@ABA@A@@@@A@@B@B@@@@A@A@@@AA@@@C@C@AB@@@@A@@@ACA@CA@BA@@AC@C@A@C@A@ABA@@@C@CBA@A@@B@B@@@@A@@@A@@@A@@@A@C@@@A@@A@A@@C@AA@@GB@@@A@A@@@AA@A@A@@@AAA@@@AD@@A@@A@@@@@@@@@@@A@@A@@@@@A@@@@@@A@@@A@@@AA@@@AB@@AA@@BA@@AA@A@A@@ABCB@@A@@@A@AA@@A@A@AA@@@@A@@@@@@B@DBB@@A@@@@@@@@@A@@@@@@C@@A@@@A@@@A@@@@D@@@@A@@@A@@@@@@@BA@A@A@@@A@@@@@@ABA@@@@A@@AA@@@BAB@@@B@B@@@@@@CA@@@@BA@@@@@@@AAA@A@@@@AB@@A@@@AB@B@B@B@@@@CD@@A@A@@@A@@@BD@@AB@@A@@A@BA@@@@A@@@@AB@BA@@@@@A@@A@A@@C@A@A@@A@@AA@@@@@@AB@@A@@@@B@@A@AB@@A@@A@@A@@B@@B@@@A@C@AACA@A@@@@@@AA@A@AA@@@@B@@BB@@@BA@B@@@ABA@@@@BB@CB@@@BA@@@@BCBA@AA@@A@@@A@@@@@@@@B@@AAA@@@@@@@A@A@@@@@AB@@@@A@@@@@A@@@ABA@@A@@ABC@ABA@@@@@A@@@@@A@@@E@@@@B@@@@@AA@@B@@@@A@A@@@@B@@A@@AA@@B@@A@AA@@@@@BAAA@@B@BA@A@A@@B@@AB@DAB@B@B@@A@@@@@@B@@A@@B@@AB@@@BA@@B@@A@@BAD@@CDB@@@@BA@@BCB@BAB@@ADA@A@@@BB@@@@A@BBA@@@@@B@@B@@@@@BB@@@BB@@@D@@A@@@@DB@@@@@@BA@@@A@@@A@A@AAA@@@A@@AA@@@@@A@@A@@@@A@@A@@A@A@AB@B@BA@@@ABBB@@@B@@@@CB@@@@@@B@@@@BA@@B@@@B@@@@@@AB@BB@A@ABAB@@DF@BBB@@ABAA@@CDCF@@@@B@@@BB@BEBABCBA@@@C@A@A@@AA@A@@@A@ABAAGBE@AB@@@@@D@@A@@@@AAAA@AAA@@@A@A@AAAB@@@@A@@@@@A@A@@A@@A@A@@A@@CA@@AAA@@@A@AAA@ABA@@@ABABA@@@@@@@A@@A@A@AAAA@A@ACA@AAA@A@@B@B@@A@@B@AAA@AA@@@AA@@@BA@@B@@@@A@@@@AA@A@A@@@A@A@@@A@A@@A@@ABA@A@@BA@@@@@A@@AA@@BA@@@@@@@@A@@CA@@A@@A@@A@@AA@@A@@@@AA@@A@@@@AA@@@A@@@CB@@@D@@@@AA@@A@@A@@C@@@A@A@@@AA@AA@@A@C@@@A@@B@@A@@@@@AA@@@@@@@@A@@@@@A@@BA@@@@@@@@@A@@@@@@A@ABA@@@@@@B@@@@ABA@@@A@AAAA@@AAA@A@C@A@CCA@@@A@@@@@CB@@@A@@BCAAAE@A@@@@AA@@ABA@@@@A@@@@@C@@@@A@A@ABA@@@@A@@@@A@@B@@A@@@A@ABA@@@A@@@C@A@A@A@A@A@C@A@AAA@@@@@ABGDABA@A@@@@@A@@D@B@@A@@@@AAAA@A@A@C@A@A@ABA@@A@@AA@@@@BC@@BA@@@@AA@A@ACAAA@AA@CAAA@@A@@@CAA@@@@@A@AABA@@@AA@CAAA@@@@AAC@AA@@A@@A@@@@@A@AAA@@@@B@BABA@ABABABC@@@AEC@@ABA@@@ABA@@@@A@C@A@@@AC@AAAA@AA@C@CAA@@@A@A@A@A@@@@AC@@A@@DCACA@@AC@A@A@A@@ABA@AB@BABCBA@@AA@A@A@@A@A@@@C@AAA@AA@@A@ACC@@A@@@E@A@@@A@@@@@ACACAAAACAACE@A@ABABA@A@AAA@@IGAA@@BAB@@A@C@A@AA@A@@A@@ACA@AAA@@AC@AA@@@A@AA@@A@@AAA@@@A@@@ABABAA@@@@FA@A@@@@@@@@A@BA@@@AB@@A@@@@B@@@B@@@@@@@@A@@B@B@@@@@@@BA@BB@@@@@BA@@@@B@@@@ABB@A@@@@@@@@B@@AB@@@B@@@@A@A@@@AA@A@AC@@A@@BAA@@A@AC@@A@@A@@@A@@B@@AA@@@@@A@AA@@@@B@@@A@A@AA@@A@A@@@@@@ABADC@@@ABA@@@@AA@A@@ABC@A@@@AC@@@@@@A@BAAA@@A@@@AB@@A@@AA@@@A@A@@@A@@@@A@@@@@ACB@@A@A@@@AA@A@@@@@C@@@@@@B@B@@@@@AC@@@A@@A@@@@AAA@A@@@@A@AB@@A@@@A@@A@@@A@@@A@@@@@AC@@@CA@@AA@@A@A@@@CA@@@CA@@@AAABA@@@@@@A@@@AAEA@@@C@A@@@@AAAAA@@AA@@@AAA@A@@AABA@@@@@AA@@A@@@A@A@@AC@@AA@C@@@AA@@AA@@@A@@A@@@AAAAAA@@@@AAA@A@A@A@@@@@A@@AAC@A@@@A@@A@@AAA@@A@A@@@@A@A@@@A@ABA@@@A@@AAA@@BAAA@@@AA@@A@@@@@@CBA@A@E@@@@AA@@A@@A@@@C@AAAAA@AAA@A@@@ABA@C@@@AAA@@@ABA@@BAB@@A@A@AB@@A@AC@@C@AAA@CAA@@AA@AA@@A@@AACA@@BABA@@@A@@@AAAAA@AA@@A@@@ABAB@B@BABAB@B@@A@@@EA@AA@@BA@@@A@CA@@@@A@@B@B@@@@ABA@@@@@@@@D@B@BA@@@@B@@@B@BBB@B@BABAB@B@BBB@@@BA@@@A@A@AA@BA@@@C@AAA@@@@@@BAB@B@B@@BBBB@B@@@B@@@D@BA@AB@@BFBBA@@BA@A@A@A@AA@@C@@@A@@@@A@@A@A@A@@@@@AA@@A@A@ABA@@@@@A@@A@AA@@A@A@@@@ABA@AB@@A@ABAB@@A@@@A@@AC@@AAA@@@@@@CBA@ABA@@@@B@BAB@@@BBB@B@@B@@@@@D@@@BB@BBB@B@@@B@D@@@B@B@@@B@@@@ABABA@@B@@C@@BAB@@@@@D@BA@ABA@@B@@@BB@@BB@@B@B@B@@ABA@A@AB@@@@A@@@AAA@@@EB@@A@@@CB@@@BBBBB@B@B@B@B@@@@@@D@B@@BB@AD@BBB@B@@@@@B@@D@D@@@@@BF@@@B@@@@@B@@@@@BBB@@ABAB@@A@AA@B@@AB@B@D@@A@@B@@@B@@@B@@@BABA@CBABA@A@@@AAAA@@@@ABAB@BA@@BA@@DA@ABA@A@C@@@@B@BB@@@@B@B@BBFBD@@BB@B@BA@ABAB@@@@@B@B@@B@@B@@@BB@@@BB@@BBBBDB@B@@@@@BA@@AABC@AB@@AB@@A@@@A@A@@B@@@B@@@B@@@B@B@B@B@BA@@@A@A@A@AAA@@@A@@@@@ABABAD@B@@A@@@@BAB@B@@A@A@A@ABA@@@AA@AC@AAAAAA@ACAA@@@@@AD@@ABA@A@@@A@@BCD@BA@@@CCCAA@@@ADABA@@BBB@BA@@BA@@B@DAB@@@DAD@@@BA@A@CBG@@@@@AAA@@AA@@BA@ABA@CDA@AB@@CAAA@@A@@@AB@@A@ABCBA@@B@@@@C@A@@@A@A@A@C@@@@@@A@CAA@ABA@@@A@@A@@@A@A@A@@@@@@@A@A@A@@B@BABA@@B@@AB@@AAA@@@C@AAA@C@@@@B@@@B@B@B@@@@BDBB@B@@@BB@@BBB@@@B@@@BA@A@@BA@B@@BBBA@@@A@C@A@CC@@AB@@@@@B@B@D@@A@@@@@C@A@@@ABA@@B@@@D@BBBBB@BBB@@A@@@C@@@A@@BA@@@AB@@AB@BA@@@A@@@AAA@A@@A@@@@@A@AA@@@C@A@A@@ACAAA@AA@@@@@A@@@AD@B@@AB@@@@@B@B@@A@@@@B@@BB@D@BB@@B@@@@CB@@AAA@A@A@AA@A@@AAA@@A@@CB@@A@@@A@@@A@ABAB@@ABAB@BA@A@AA@@AA@@@AA@@@A@A@"],encodeOffsets:[[111230,36395]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
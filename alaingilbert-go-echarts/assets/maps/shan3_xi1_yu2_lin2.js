@@ -0,0 +1,5 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("榆林",{type:"FeatureCollection",features:[{type:"Feature",id:"610821",properties:{name:"神木县",cp:[110.498939,38.842578],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@B@BA@@@@@C@A@AA@AC@@@A@@BABAB@BAB@D@@@B@@BBB@B@@@BB@BA@ABABA@@@A@A@A@A@AAA@EAA@AAC@AAC@A@@AA@AAA@AA@CAAASGA@A@A@A@ADADABAB@@AB@BA@@BCFCDADAD@BA@@B@BADAF@@@BBD@@B@DBHBDBDBFDB@HBFDB@B@@@B@"],["@@J^BJIH]T@DNZD@@@ZEHAHFFLFLHTjBBBBDDBBDBDDBDDDBBDFFDDDDDDBDBBDFDDBBBBDDBBFDDDHDBBFBD@D@DB@@JFBDDBBBB@FD@BB@BBBB@@BBBA@@XXTVfPB@BBBDBBBBBB@@BBBF@BBBRNRDD@XGRGDGDGJIz@FF[hWXEFALD@B@DBB@B@D@BDDBdV@B@B@BB@@B@@DBFDF@DBDBFDBBB@BBB@B@BBB@BBDBDBDDDDDBDBBBBBBBBB@B@@@@@B@B@@ABABABCBCBABEBABC@ABA@ABCBEDCDCBCBCBCBA@ABA@ABCDCBAB@@CBABABADABABABAB@BA@ADABCBA@CBA@ABA@@BABA@A@@BA@@@A@@@A@AAA@AA@@A@C@A@C@C@A@ABA@ABA@ABABABCDA@ADAB@BAB@BAD@BABABBB@B@@BBB@@@B@BABAB@@AB@BA@@B@BB@B@D@D@B@BBBBDBBBDBBB@DBB@BBF@DBB@DB@@BB@BBBBBBBB@B@HCB@BA@@@@@AB@@@@@@A@@B@@AB@@A@@@AB@@A@@BA@@B@BAB@@@BAB@@A@@B@@A@@B@@@B@@@@@@@B@@A@@@@@A@@B@@@BA@@B@@@BA@@B@@AB@@@@AB@@@@ABA@@@@@AB@@@@@B@@@@A@@@@@A@@@@@@@A@@@@B@BAB@B@B@@A@@@@@A@@@@BA@@@A@@B@@AB@@@BA@@@@B@@@@A@@@AB@@@@A@@@ABA@@@@BA@@@@@@BA@@@A@@@@@@B@@@@A@@@A@@@A@@@@B@@@@@@A@@@@@@@@BA@@@@B@@@BA@ABA@A@@B@B@@@@@@AB@@@BA@@@AB@@A@@BABABA@A@@B@@AB@@A@@B@@A@@@@B@B@@@B@@@@@@A@@@@@@@@B@B@B@B@@@BAB@B@@@B@@BB@B@B@D@@@D@B@B@D@BAB@D@@@D@D@B@B@B@@AB@@@BA@@@@B@@@B@BBB@BB@@D@@@@@B@B@B@@@@@BABAB@B@@@B@BB@@BBD@BD@@B@BBB@@@D@@@B@D@BBB@@@B@F@@@DBF@@@FB@A@@B@BBB@B@@@BBB@@@DBB@BB@@B@@@B@@B@@@@BBB@@BB@B@B@@@BBF@@@B@DBD@BBFB@@BBB@@BB@BBB@DB@@B@BBD@@@@@B@BA@@BAB@@@B@B@D@B@@@B@@@@B@@BH@BBB@@BB@@BBB@DBBBBB@@BB@@BB@@@@@BB@D@BBB@@@DDB@BB@@@BB@@@B@BB@@B@F@B@B@B@B@B@B@FBB@B@BBB@BBDDB@FDB@DBB@DBBBBBBBB@B@B@D@B@DB@@B@@AB@D@DBB@@@B@BBB@BBB@BD@@B@B@@BB@BBBB@@BBF@FD@@B@B@BBF@DBB@D@BBB@BDB@BBBBBBBB@@F@J@F@@@D@B@FBB@DADAB@@@BA@B@@D@@BA@@BCBB@A@ABABCDCF@BADAH@B@B@DA@@@@B@@@B@BAD@BABAFAD@B@B@@@B@B@BABADADAFAFEFCHADABAB@BAD@BAF@B@DABCHADABABADABABGN@BABEBCB@DAB@B@DEJCFCDABCBCBC@GAG@I@CBCBABCHCFAJENELAL@F@D@J@BB@B@@@BBDBBBBB@B@D@B@D@BABBD@BBBB@BBDBDBB@DBDBB@BB@@@BBBABNGlKvOLEJLL@FAVKPQJGrDD@LABGYQIGCADABAFAD@@AB@@@B@@AB@@@@@@@B@@@@@@AB@@@B@@AB@@@@@@AB@BA@@BA@@BAB@@@B@@A@@B@B@BA@@DA@@BA@A@@@@@@@@@A@@@@B@@ABA@AB@@A@@B@@@B@@@BA@@@@B@@AB@B@@AB@@@@A@@A@@@@@@@AA@@B@@@@@@A@@B@@@@@B@@@@@@@@@B@@A@@@@@@@A@A@AB@@AB@BAB@@ADADABA@@@@@A@A@@@@B@B@B@@@@@BAB@@@BA@@@@DA@@BA@@BA@@@@@A@A@@@@@@BA@@@@BAB@BAD@BA@@BAB@@A@@B@@ABABA@@B@BA@@B@B@BABA@A@AB@BA@AB@B@@@D@BA@@BA@AB@@A@AB@@@B@B@BA@@B@B@BAB@DAB@B@@ADABA@AB@DABA@@DA@@D@BAB@B@B@BAB@B@BAB@BAB@@AB@BA@A@@@A@@A@@@@A@@@@@@B@@@B@@AB@@@@@B@@@@A@@@@@A@@@@B@B@@AB@@EBABCDEBB@A@@@@@ABC@@@C@A@CAEAA@C@AACAAA@@C@A@ABC@ABCFCFEBA@ABGBI@@@ABABADADCHEDCDCBABA@A@AACCCAAECAAC@@@AA@A@A@IBGAA@@BA@ADAB@BADCHG@A@@@ABE@EBCBK@@@@@@@@B@@@@BB@BBB@DBD@B@D@BBB@DAB@@@B@@@@@@A@@BAB@@ABA@@@@@A@@@@@@B@@A@BB@@AB@@AD@B@DCFCB@BBN@B@D@B@B@B@@@@@@@DCFCBA@@A@@@@ADABABAB@@@DBD@DBD@BAB@DABA@@@ABA@A@A@@BAB@@@@@BA@@BA@@@@BA@AB@@@@C@@@A@@@@@@@A@@@A@@A@@A@@@@@A@@@A@@@@@@@AB@@@@@@A@@@ABA@@@AB@BABCBADA@AB@@BB@@BD@FBB@@BBB@@BBBB@@B@B@@ABAB@BABA@ABAB@@@@@B@BA@BD@DBBBB@D@B@@@BA@@BAB@BB@@@ADA@A@@BA@@BA@AB@B@@AB@@@B@BAB@B@@A@@@A@@A@A@AAAA@AAA@A@@B@D@B@FAB@BABAB@F@B@@AB@@@@ABA@@BA@@B@@A@@@A@@CAAAAAAA@AGEAA@@@A@@BAB@BAAA@ABA@@@AA@A@CAA@@@A@@A@A@ADA@A@@@C@ACAAA@@@@BABAB@@@@AAAA@@A@@@@BAB@@@@A@@BAB@DAB@B@DAB@BBB@B@@@@@@DBB@@@BB@B@DAB@B@@BDDBB@@BDB@D@B@@@BBB@@@BAB@B@@AB@@AB@@@B@@A@@@A@@BB@A@ABABA@A@A@A@@@AA@@A@@A@@AB@@AA@@@@AAA@CAA@@CCAAAAA@A@A@@AB@BA@ABAB@DCB@@@BAB@@@BAA@@@C@A@@AA@@A@CAE@@BA@A@ABA@@@A@AB@@A@BBBB@@BB@B@BABBDAB@DCB@@AD@A@B@BA@@B@BBB@D@@@B@B@BBB@ABBBBB@@@@D@B@@@B@F@BCB@B@BA@CB@BA@@B@@C@@BABA@A@AB@@@B@@BB@D@@@B@@@@@B@@@B@BAB@B@@@BA@B@@BAB@@AB@@A@@@@B@@ABAA@A@CAAAA@@A@@AA@@@A@@@@@@@AB@B@@@B@B@F@D@BABBBA@@@@BCB@BA@ABAB@@A@@@@@@@AB@@@@@@@@AB@@@@A@@@A@@BAAA@A@@B@@A@@@@@@A@@@BABA@@BA@@@@@@@@@AB@@@@A@@@ABBB@D@FDB@B@F@@@@@BC@A@@B@BAB@B@B@BA@@D@BABAB@@ABA@@@A@@@A@A@A@@@A@ABA@@@A@A@@AA@@AAAAC@@AA@A@@@AA@@A@@@A@AAA@A@@@AA@@@@A@@@A@A@@@AA@@A@AAA@A@@A@@@@@A@@@@@AA@@@B@@A@@A@B@D@@AB@@AB@@@@A@@@A@A@@B@@A@@@@B@@ABAB@BC@@ACCC@@AAAA@@@@@A@@@@@A@@@@A@@@@@A@@@@@@@@@@@A@@B@A@@@@@@AB@@@A@@@@@@@A@@HE@@B@BAB@BAB@@AB@@@BA@@B@@@BAB@B@@@DA@@@A@@AAAA@@CA@@B@BBB@BABAB@BAB@@@BA@@B@@@@@@A@@DE@@@@B@BCBCBADA@@BAB@FA@@BAD@@AA@A@A@C@@BA@A@AB@@EAA@@@AAB@@@DA@ABA@A@@B@BAB@@AFABAB@@A@@@@A@@@@AA@@@@@@AA@@@@@@A@@A@@@@A@@@@@AA@@AAA@@AC@@BA@@@AAC@@BA@@DADABADAB@@@B@@@@@B@@@@@B@@@@@@@B@@@B@@@BB@@B@@@@BB@B@@BB@@@B@@@B@@@BCBA@A@A@C@A@A@@BC@@B@B@@@BDBB@@BAB@BBB@@@BABCB@@@B@DA@@@@BBBB@@BABC@A@@B@B@B@D@@@@@@A@@@A@@@@@@ACA@BAB@BABA@@@A@ABA@@BAB@BABABABC@@D@B@BAB@@AB@B@BAB@@@FEB@@@@@B@@@B@@@B@@@B@@BB@@@@@@@B@@@@B@@B@B@@@BBB@@@B@@@BDBD@B@@@BB@B@B@@@BCB@B@B@F@B@B@@A@@B@@@B@BBBB@@D@FBB@@@B@BC@@B@D@BA@@@A@A@ABABADABADADABAB@D@@@D@B@@@BCB@@@DAB@@@@A@@BAB@DABE@@BAB@DC@@D@D@D@DADABADAB@@@B@@@B@@@BAB@B@@@B@@@@@@@B@@@@@B@@@@@@@@@B@@@B@@@@@B@@BB@@BB@@@B@@B@@BAB@AA@A@@AA@@AC@@BE@@@C@A@ABC@C@A@A@ABC@@@AB@B@FEBABABABC@@@@@A@A@@@@AA@@@@@A@AB@@@B@@A@@BA@@@@@@BA@@@@@@@@@AA@@@A@@A@@A@@@AA@@A@@@@@@@@@A@@@AA@@@A@@A@AAA@@@CBA@A@@@AAA@@AAA@@@@C@@@A@CB@@@@A@B@AA@@B@@AA@BAA@@@@@BA@@@@@@AA@@@@@@@@@A@@B@@@@@AA@@@@@@@@@A@AA@BC@A@@@ABADABA@@@A@@@ABCBCB@@@@AA@B@@A@@@@A@@A@@B@DC@ADA@@B@@@@@B@@@B@BB@@@@@@@@B@B@@@B@@AB@@@B@@@@@@@@A@@BA@@@A@A@@@AB@@AB@@@AA@@AA@AA@ACBA@@A@@@@@AAAA@@@@@@BAA@@@@A@@@@@A@@BB@@@@BA@@B@@@@@@A@@@A@@@@@AD@DA@@BA@@@AB@@AB@BBBA@@@@A@@@@A@@@@B@@A@@@@@@@@A@AA@@@BC@@@@@@@@A@@A@@@@A@@@@CBA@@@AA@@A@@@@@A@@@CA@@@@@@A@A@A@A@A@@@@AB@@@@@@AA@@@A@AB@@A@@@@@@A@@@@A@@A@@@ABAAA@@@AAAAA@@AA@@A@@@@@@A@@B@@@BA@@BCA@A@C@A@@@A@A@@AA@@@AC@@AA@@BA@@DADABA@@@AB@B@B@BB@@B@@@BA@@@@@A@A@@@@@@BCB@@A@C@@@AB@@@B@B@@@B@@@B@BB@@BA@A@@@@F@@@@@BB@@B@@@D@B@@@BBD@B@@@B@@@@@BB@AB@@@@@B@@BB@@A@@B@@@@@@@B@@@@@@@@@BBB@@ABA@@BC@A@@@@A@CBA@@@@@@@A@@A@@@AB@@ABA@@FA@@B@A@AA@A@@@@@A@A@A@@A@@@@@BA@ABABA@@@C@@B@D@@@DB@@@@@@@D@BBBAB@@@BB@B@@BB@@@B@@@B@B@B@@AB@BADADABAB@@@BAB@B@B@BAB@B@B@@@B@B@F@D@BAD@DCB@@ABABA@ABABAB@BA@@@@@@@@D@@A@@@@@@@A@A@ABC@ACE@A@@CCGEEGCI@MBGBEFIBEAECEIGGCIEGCCGGQAGAECIEGEE@G@G@@BGHGLKFIBEACBEBCBC@@DCLCDAHAF@H@D@BC@EAEAE@@EEGECE@@AC@I@G@@DK@@BI@MCE@@@C@C@C@ACGCC@AECC@GAE@A@C@A@C@IBABCAA@AAAACACCEA@A@@@C@KBCDE@ABA@A@CAM@G@AAIBIFMBG@EEEGCKEIC@@IE@@IEIAI@GDMHIHGBMDS@OBGAKEKG@@EICIAIAICI@@ESSU@@GM@@EU@@BMAC@ACG@@GGGCWEG@OCM@KCIGKI@@KKIIAAKOEIGK@@EGOIQEYAaBIBQ@OBSA@@ME@@MMGOAS@IBOCQAEES@MBUAMAGACAAECGCA@IAK@QDIBGAIAAA@BABABA@GDC@@B@@@BB@@@D@F@B@B@BBBB@B@BB@AB@@@BADCDCDABA@ABA@A@IEAAA@A@EAA@A@A@@@AB@@@BBD@@@@DBFB@@@B@@AB@@AB@@AD@@AB@@AAA@@A@@@@@@A@@@A@AB@@A@C@C@A@A@ABA@@B@B@@EBC@CBA@@B@@@@@B@@BBB@@B@@@@@BAB@DA@@BA@EAA@@@A@@@A@@B@B@@@BBD@@@B@@A@ABA@ABABCB@@AB@@@B@@@@@BB@@@B@@@DAB@B@D@D@DBBB@@@B@B@@@BADABA@A@CAA@CAC@C@AAA@@@ABABA@CBA@AB@@@BA@@BBB@B@@DB@B@@B@@@B@BBB@@@@B@@@B@@B@B@@@AB@BABA@@@@B@@B@BBBBB@@BD@FD@@DBB@BBB@D@B@@@B@@B@B@@A@A@@B@@A@@B@@@BBB@@AB@@@BGDABA@CAG@A@AAAAC@A@A@A@@@A@ABABABAB@@AD@B@@@@BBB@BBBBF@DBBB@@BBAD@@A@@@CHCF@B@@@BAB@@@B@BA@ABA@A@CAABC@CBEBCBABA@@B@B@@BB@@@BB@B@DBDDDBDDBD@B@B@@@BABCDCB@BA@C@@@A@EAA@@@@ACC@CCA@AA@@@A@@@A@@BAB@BA@@DAB@BAF@DABBB@B@@@@BBH@DBDBB@BB@B@B@F@BAB@@CDA@@@ABAA@@CAAAGAEAA@CAC@AB@@@@AB@B@@@@DDBBBBBB@B@BBBA@@BA@@@@@A@ICAAC@A@C@CBA@AB@BAB@@@B@@@BB@BBBBB@DDDD@BBB@B@B@@@B@B@BAB@B@BABA@A@C@C@A@CBGBC@CBAB@BCDCBEHABABADAD@D@H@B@D@HB@B@@@B@AB@@B@@@B@@B@@B@@@@BB@@@BB@BBB@B@@AB@B@BAB@BABA@A@EAEAA@AAEDCBABABQLCDEFABABEBABA@AB@@ABA@A@ABA@ADAB@BCDABAFAB@BAB@D@@@B@@@BB@@BB@@BB@@B@BAB@B@B@DBB@@@BAD@@BB@BB@AB@@@@ABAB@@@@@@@@BBB@F@@@BBDF@B@DAD@BAF@BAB@@ABC@MD@@A@@@@AA@@@A@A@@@A@A@A@AAA@AAAAA@EAE@A@AAA@CBEA@@AA@A@@@@@@@@@ACBA@C@@@@D@@A@C@A@@AAA@@BA@A@A@@A@@@A@A@ABAB@@AA@@A@A@@@@B@D@B@@@@A@@@C@A@A@@@@A@@@@B@B@@A@AB@AA@@@@A@@@@@@@@@AA@@CAA@@@@A@@@@A@@@ABABA@@BA@@@@A@@@@BA@@@@A@@AA@@@AB@@CBABC@A@C@GCAA@@@CA@ABA@ADAB@B@@@@C@A@@@ADA@@@A@E@C@A@C@CBE@KBG@E@E@EBC@A@EAG@AAAB@@@@A@AB@@C@A@A@@@@BE@@BABC@@@AB@@AB@@@@AB@@@@@B@@ABA@@@@B@@AB@@@@AB@B@D@@AHCJ@D@F@BA@@BCAA@ABIDA@@BAB@@A@CBE@IDA@EBA@@AA@@AA@A@ABAA@BC@C@E@S@CB@@@BCBEBABEBCBEDCBA@A@GAA@A@A@EF@BAB@@B@@BDBB@BB@B@B@DBB@DDDBBBBBB@@D@@@B@@@B@B@@@B@@B@B@FB@@BB@B@B@@B@@@@@D@B@BBDBD@BBD@FA@@B@@CAA@A@AAA@A@A@C@@AA@@@AB@@EFABA@CBC@I@GBC@A@ABG@CAQ@K@C@A@I@EBE@CBEBABABA@KFCBABABA@@@A@C@C@A@EAG@E@C@C@A@AAC@EBCBA@C@@@A@AAA@A@A@A@A@A@C@G@CBA@EDCBC@CBEBGFA@A@A@A@ABEDCBABABC@@@A@A@AAA@@@AA@@AA@@@A@ABABA@A@@AA@@AA@A@@C@@@FCD@FABAB@@AB@@@AA@@AAECECA@@@A@AB@@@@AB@BABABCBABA@CB@@A@A@C@@@AAAC@@@CA@@A@AAA@@A@C@EBCBC@IBIDCBGDGD@BEDCBEDCBCBCD@@ABAD@FADGHCF@@@@CB@@A@@@BBDBB@@@@BB@@B@@A@AB@BCBC@C@@BCBABCBCDCBEDCDABEDADCBADCBA@A@A@A@ABE@CBKBE@A@A@@@@@A@AB@@@DBBA@@BCBA@@B@@CBADABCB@BCBABAB@BCDCDAD@@@@E@@@@@AF@BA@EBGBCBC@C@@@EBA@EBCBC@CBGBE@A@@@A@AAAA@@A@@@A@ADAB@BA@ADGHABABEFA@ADAB@@CFA@A@A@A@ABA@@BA@@BABA@ADAB@B@B@BAB@@ABCD@B@@@@@BBB@BB@BBB@@@@BBBBBBB@BFB@@B@BB@@@@@@AD@BABAD@DAD@B@@@BB@B@D@BBB@BBD@BBBBBD@BBDFD@@BBB@D@HBJ@D@HBF@DBHBBBFDDBBBF@D@LANCD@F@DA@@DCBADAHEFABA@@HBD@PABAB@@@@@D@B@D@D@D@JBD@F@D@@BB@@@@DAB@@@FAFADCFEJ@DAB@DADELAB@@AB@@EDCBEDGDCBEBEDCBCBCBEDABEBCDC@EDWLCBABA@ABA@GFA@EDAB@@IHGDA@CBA@EFCBADA@CBABABABQLGDADCDCBCDOJEFA@CBED@@ABEDABCDGDADABABCBABABABABGDCBCBEBEDA@@BABCDABCD@BGHA@ADAD@@ABAB@BADAB@@ED@@ABEDABA@A@I@CDFFBDHHPFAHADEP"]],encodeOffsets:[[[112461,39594]],[[112312,39884]]]}},{type:"Feature",id:"610822",properties:{name:"府谷县",cp:[111.067276,39.028116],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAAEGGKGECCC@A@A@A@AACCEADF@BAD@B@B@B@@@@@@@BC@@@@@@@@@ABA@ABAB@BABAB@BA@CDC@ABC@E@A@A@@@A@A@A@ABA@A@A@AB@@A@ABCBCBABA@@BA@A@A@@@A@@@A@@AA@A@@A@@BAAA@A@C@@@@@@CA@@C@A@@@@D@@ABAB@BAB@@@@B@@@@B@B@B@@@@@BBBB@A@@@EB@@AB@BA@@B@@@BB@@@@@@@B@DAB@@@@@@BAD@@AB@BA@AA@@@@@@@@A@@@@@@@A@@@@BA@@AA@@@@@A@@BAA@@@@A@@@A@C@AA@@A@C@@@A@@@AA@@@@E@@@@@@BAB@@AAA@@@A@@@A@A@@@A@@B@@@D@BA@AD@@@@@@@B@B@@@@AB@@A@@@AAA@A@A@@B@@ABCBCB@@AB@@BB@@BD@@B@@BB@B@@@B@D@B@B@AD@@AB@@A@@@@B@@@@B@@@BB@@BBBB@B@@BBAB@B@@@BB@@@@@@B@@@@B@@@BAB@@@B@@B@@@@A@@B@@B@B@B@B@B@@@@@@@DB@@B@@@@@B@@@BB@@B@DA@@@@@B@@B@@@@B@@@@@@D@@A@@BBB@@@@@@@@@@BA@@@@@@B@@B@@@@@ABAAA@@BA@@B@@AB@@CBC@@B@@@@@B@@@B@@@@A@@@AB@@@@AA@@@B@@@@@B@@B@AB@@@@@@BBBB@@@@B@@@ABBDB@@BBB@@BB@@A@@BA@@B@@@B@B@@AB@@@B@@@@@@A@@@A@@BA@@@A@A@@@@@@@@@AAA@@@A@@@@@A@@@CB@BCDA@@@@BB@@@@@@BA@B@@B@@A@ADAD@B@@@B@@ABCBAB@B@@@BADB@@B@B@@@@@@@@BB@@@@A@@@@B@@@@@@@@BB@@@@@@AB@@@@B@ABB@@BA@@@BBA@B@@@@@DAB@@@D@@@@@BB@BB@BB@@B@B@DA@@B@BBB@@@@B@@BB@@B@@@@@@@@@B@@@BB@@B@@@@BB@@@B@@B@@@@@@@@AB@@@@@@AB@@@BA@@@A@@B@B@@@@BB@@@@@B@B@@@@ADABABABEFA@A@@B@@AD@B@B@B@DAD@B@B@D@@AF@@BD@@BB@@@BBBA@AB@@@AA@@@A@@AA@@AA@@@@@A@@@A@@@@@@@@@A@@@@@A@@@@@@@A@@@A@A@AB@@A@@@A@@@A@CBABCBCBC@C@C@@@CDA@AB@@AFCBA@AB@@@B@@A@CB@@A@AD@@A@C@@@C@A@ABCBCBABCBABAB@B@B@B@@ABC@A@@@ADA@@@A@EAC@@@AAAAA@@@A@@@@BA@A@E@A@A@A@AD@@A@A@A@@A@@@AACAC@@A@@@A@AA@@A@A@@@@A@@A@@@@@@@A@@AA@@@A@@@A@@@A@@@@@A@EF@@A@ABA@A@@BA@ABA@C@@@ADABABABA@AB@@AB@B@B@@ABABA@ABB@BD@@@@@@@B@@@B@@@@C@A@A@A@@@@BADAB@@AAAA@@@@CBA@@@A@ADAB@@A@AAA@AB@@AAAC@@A@A@@@AD@@@B@B@D@B@BABAD@@A@@@A@@@A@@AA@A@@A@@A@@@AA@@A@@@A@@@@@@@A@@@@@A@@@@@A@@@A@CBABCBCB@@AB@@BD@B@@AB@@BD@@BB@BB@@B@@@@@B@@B@@@@B@@@@B@@B@@@@B@@B@@B@@@@@@BA@ABEB@BA@ABA@@@@BAB@BCB@@A@BB@@B@FB@@BAB@B@@AD@B@B@B@@BC@AB@@EBA@AB@@CBABADADA@@@@@CF@@@B@@@@A@@@AB@@A@ABA@ABABA@AAA@@@DB@@BBBB@@@B@@CB@@A@A@AB@@A@@@AB@@A@@BA@ABA@ABA@@@GF@@@B@@@@@@@B@@BA@@@@@@@B@AB@@@@@@@@@@@B@@@@@B@@@@@@B@@@@@B@@@@BBBB@@DDBD@@ADA@AB@BA@@@@@@BA@@@@B@B@@@B@@A@@BA@@BC@A@B@@@@BA@@@B@@B@@@@@B@@@@@BB@B@BBB@@@BB@@B@B@@@B@@@@@BB@@B@B@BBB@@@B@@@BB@@B@B@@BD@BBBB@@BB@@@B@B@@AB@B@B@@@B@B@B@@@B@@AB@BA@ABABC@@@ABA@A@A@ABA@@@@BAD@@@@E@A@A@ECC@A@AA@B@@@B@@A@@B@@@@@@@@AB@@ABAB@@B@@@@@@@@BA@@@@BBBAB@@@B@@@B@@A@@B@@@@@@A@@B@@@@@@@BA@AB@BABA@AD@@@@ABAAABC@E@A@A@@@A@A@@B@@@@@@@B@@BB@@@BB@BBDBB@B@AB@BA@@@@@@BA@@BA@AB@@@AAB@@A@A@ABA@@@A@@@@@A@@@C@A@@AA@@@A@@B@BABAB@@@DA@@@ABA@@DABA@A@ADE@A@@@A@C@@@@@AAAABAA@AAA@A@@@C@A@AAA@@@ABA@B@C@@BA@CDA@CBAAABA@A@@AA@AA@A@BA@@B@B@@AB@B@BAB@@BF@D@BB@@BB@D@@@B@AB@@A@AB@@A@CDA@AB@BABA@@BB@B@B@BBBBDD@@BB@DBB@B@@B@@BA@@BB@@@@BB@@B@@@B@B@BABAB@B@BAA@@@B@@@BA@@@A@@BA@@BA@A@AB@@A@AA@@A@C@A@AC@@AACC@AA@A@CBA@A@@A@@AA@C@@@@A@A@AAA@CBA@A@CBA@AB@@@B@@A@AB@@@@@BB@BB@B@@A@ABAB@@@@BBDB@B@D@@@BCB@B@B@BB@@@B@DBB@B@@B@@AB@BBBABA@AB@@@B@@BBHF@BBBBBBBDB@@@B@@@BA@@@AB@@AB@B@@A@@BA@E@A@ABABA@EBA@C@A@@@@BBB@BBBBBB@B@@@@B@@@BA@A@ABA@@@A@@BA@A@@BAB@@AB@@@BCB@B@@AAA@AB@@AB@@A@C@A@AACAC@@AABA@@@@@A@AB@BABABA@AB@BA@A@@@AAAA@@AA@AA@EAC@@AA@@AA@@BCBABADABA@@B@@AB@B@@@B@@@@A@@B@@@@@@@B@@@B@@@@@BB@@@@B@@@B@@@@@@@B@@@D@@A@@BAB@@@@AB@@AB@@@@A@AB@@@B@BAB@B@@ABCBA@ABC@CAC@CA@@A@ABABCB@B@@B@@@ABEDCD@@@@@@A@A@A@C@A@M@AAA@EDCDA@C@@BA@@BA@@A@BA@@@@@@@@B@@@@AB@BA@AB@@@B@@@@A@@@A@CBA@AAC@A@C@CAA@AAA@@A@@A@@@@@@@@@ALAD@FAF@B@@@BGHCDABA@CB@BAB@@BBAH@J@B@BBB@@D@BBFDBBDDBD@B@BABABCDCDGFCDCBABAB@B@@AJAH@BABEFEDAD@BAD@B@B@DB@BBBD@B@DBBBF@D@B@D@@AD@B@@@@@B@@HBB@BBFDFDDDBBDBBBDBD@BBBBDBDBBBBB@DBBBB@BBB@BBBB@@BB@BBB@B@B@B@B@BBB@@@@B@@@B@BA@@BCBABABC@CBCBA@@@AB@@BB@DBB@B@DBB@F@BBB@BBB@DBBB@BDBDBBBBBBBB@B@B@BBB@BB@DBDBB@B@N@L@LCPCLCDCDC@ABA@AHIJEDAF@F@F@F@FBJF@@@@BBB@BBBB@@DBBBB@@BB@@B@B@BABA@@D@BABBB@B@BABB@@@BBB@B@B@F@@@BA@@B@@@B@@B@@BB@@B@BAHCHIHABAB@@@@A@@@ABA@@BAB@B@BA@@BABABABAFCDEDABCBADC@@BABABA@A@@BC@A@A@ABC@CAA@@@A@ABA@@@A@ABABABABABAB@DABA@ABAB@@AB@@AA@AACAAACC@@@@@A@@BCDA@C@CBC@@DABABABAB@BABA@ABCBA@A@@BABA@@DAF@FABC@E@@FG@@BA@ABCDADABADABABAB@B@@@D@B@BB@@@AB@@@B@@@@@@@B@@A@@B@@@@AB@@@@A@@@@@@@@@A@@B@@@@A@@B@@@BA@@@@B@BA@@B@@A@@B@@@B@@@B@BAB@@@@@@@@A@@@@@@@@@@B@@@@@@@@AB@BB@@@@B@@@B@@@B@@@B@@@@B@@@@B@@@@@B@@@D@B@BB@@B@@AB@@@@@@@@B@@B@B@B@@@@@B@@@B@@@@B@@@B@@@@@@BA@@@@B@@@B@@@@@@B@@@@B@BA@@@BB@@@@@B@@@@@B@@A@@B@@@BB@@B@@BB@B@@@B@@@@@D@@@BB@@@@B@@@@B@@@@@@@A@@B@@@@@@@BB@@BB@@@@B@BB@@@@B@FALCFABA@ABAB@@AB@@@BAB@B@BBBBB@@@DBB@DDF@B@BBDB@@@B@@@@@BBBBBD@@BBBDDBDBBBBDBBDDDDF@@BBBBBBB@@BBB@B@B@@@B@@@B@@BB@BB@DBDBBBBBB@@B@@@B@@@@@B@@@BA@@BAB@@@B@@@BBDBDAB@BEDDDD@FD@@@@FD@@@B@@AB@B@@@@@B@@@B@@@@B@@B@@@BAB@@@@@B@@@@B@@B@@A@@BA@@@@B@@BB@BBB@@@@@B@BAB@@@@@B@@B@@BB@@@B@B@@B@@A@@B@BBDBDDBBB@BBBB@BB@BB@@B@BB@@BBBBBBBB@@B@@B@BBB@@BBBB@BBB@BB@@DB@BDFBBBDBD@@BB@@DBBBB@BBB@B@B@B@FBB@BBDDFFDDBDBBB@BBBB@BBD@B@BA@@BBBBB@BBBB@B@D@D@BBB@B@B@@BB@@B@B@B@B@B@BBB@BBBBDBBBBB@DBDBDDBBDBB@@@DBB@B@BB@B@@ABAB@@AB@@@B@@BBBBFDDDBDBBBBBDBDBFBBDDBBBB@@BB@@BD@B@@@@AB@@@B@@@B@@DBDBBBB@BB@@B@@BBB@@@B@B@BBBDBBBBBDDBBBBBD@BBB@B@BBB@BBBBBBBB@DBBBB@B@@@B@B@@@B@B@BBD@BBDDBBBDBDDDBBBDB@DBFBD@D@DBB@D@BBD@D@BAB@B@B@@AB@B@D@BBB@@BB@DBB@B@B@B@B@B@BBFBBBDBB@BBD@HBD@B@B@BBB@BBB@B@B@BAB@BA@@B@B@BABB@@BBFBDBDBBBB@DDBB@B@BD@H@DDRJJL@FDDDBJB@@DFB@B@NAFBHF@@@BFBBB@F@DBBB@D@F@BED@@B@DCBGBCB@@@B@@@BDBP@HCBGAC@GBC@ADALBTFF@@@B@@@DADAF@D@DBHBF@D@LA@@HBF@@@LBHDLDB@HDDDBF@LBDFDJDHF@@FDFBDABABABG@CDCDAFCB@DCDCD@A@@A@@@@@ABA@ABA@A@@@AA@AAA@A@@A@@BA@A@A@AA@@@@@AAA@@@@@@A@A@@B@@@B@BAB@@@@A@@AAA@@AAA@@@A@@@A@@@AAA@@AA@A@@@A@@A@A@@@C@A@@@@@@@@A@A@@BA@AB@@@@@B@@@@BB@@@@@@A@@@E@A@A@A@A@@A@@@A@@@C@@@@@@A@@@@@A@A@@@@AAAAA@@A@@@@BAB@B@@A@@@AA@AAABCBA@CA@ACACAQK@@AAGA@AAA@A@CBCBC@A@AA@A@C@A@CBAA@@A@A@CAAAA@AA@AAA@A@@@EAA@A@A@@AA@@@ACCAC@ADABAHADCBCACCAC@EDE@GAACA@CACAAC@@AEBE@A@C@CCAEAIAEBIAEEAAC@AA@@KC@@BE@E@CACCGCG@@@ALEBADC@GFGACEAACAABG@ADCBCD@D@FBB@B@@D@BBDB@HDBDDDB@B@D@B@FCDAFCDCDA@A@CCCACCCAGEEACAC@A@AAA@CBC@CBCDC@@DEDCDCFEBCDCB@DADAB@J@RFFF@BBD@BP@B@DBJBNBJ@DAFEBE@IAIACCK@AAEDE@@FCD@BAHBDBBB@D@BBDBBDDPFHDD@D@DABAFCBC@EACCCEAECIGCE@CBCDEHIDEHC@@FCFEJC@@JCLCJ@@@D@F@FBH@J@N@J@B@BAAA@@CAAAACACCCCCCAC@AAEACCCAACACAEEGCECCAE@@CKAE@EBCBCDEDC@CAE@AACCGCEGECACACA@@@ABCBA@A@AECCEEGCAAEAAAICG@@AECE@AII@AIIGI@ACGACACGKEK@A@EBADCBAFC@@BABADE@E@ACICE@@AGAEEIACCICCCCA@ICEAECAC@@AE@CDG@C@AAMACAGEI@@CGAAIE@@USIG@@CCMGGEECGEGGCAECC@CA@@C@EAQCA@A@@@EAGBC@A@G@EASAIAKCICOK@@CACCCAGCIEIC@@CAAAKOQSACAGAC@@BC@@DGDAJEDAFADCBEBIDEBCBCBADGJMBGBK@CAE@Q@@DMFKBKAKEGACMIAAEAECECAA@CBEB@DCLKBCHGBE@@@GAGA@EICAEEEEAAAA@ACC@ACGACCE@CBCACEKAEAACIEEEEEACAC@C@C@CAGCCAACAC@C@@@CBCBAHGFE@ABC@EGGEKIKGKEE"],encodeOffsets:[[113610,39661]]}},{type:"Feature",id:"610802",properties:{name:"榆阳区",cp:[109.721069,38.277046],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@DASSA@ABAB@@@B@@BDB@@B@B@D@@@@ABA@A@@@A@A@ECGAA@ECCACAGACAA@@@AC@A@@BEBC@A@AB@@ABCBCDCDEYEOB]PMJKRXLJJFRLRBJFHDAD@DAFAB@FA@@D@D@DAHAFAB@@ABE@@@@F@@@@@BCDCDC@ABABADA@ADABABCDA@@@AB@"],["@@@AB@AA@C@@BAB@@@@@B@B@F@LADAF@BAB@B@B@B@DABCDABCFCBADCFCDADCDABADA@AD@D@DA@ABAB@@@@AA@@A@@A@CAAA@@B@@@DA@@@@DEHGBC@EBCBA@@DCDADAFCDAFC@AHCHCDAJCJAD@DAFAD@B@@@BB@B@BB@@D@@BDBB@@D@B@B@@@DAB@BADABABA@ABA@@@@BAB@@@B@FDFDBB@@BB@@A@@BA@ABEBC@ED@@D@@@@BBB@@BB@@@BABAB@B@B@@BB@@BB@@B@BBB@B@@@D@BABADAFCBAB@B@B@B@HEFADAD@DAFCB@DAH@D@B@B@B@B@B@B@BBB@@@D@B@DAFAD@BBB@D@D@F@H@FBB@D@D@B@@@B@BABADALEB@BABAFADAF@FAJ@B@D@L@R@DBH@BAB@D@HAJ@D@DAB@BAFE@@BA@@B@@BD@B@B@B@BBB@B@DB@@@AB@@EAC@AACAC@A@A@C@@@@@AA@A@A@@AA@@E@A@AA@@@A@A@@@A@@@C@@@AAAAAACC@CAA@C@A@AAAA@CA@AA@@@BA@AFEB@B@B@HBB@B@DAFCDAFABAFADA@A@@DAT@F@D@D@@ABBBAB@B@@BB@@BB@FAB@JCF@DAB@@@BA@AB@JCBAB@DB@AB@@A@E@CDIBG@@@C@ABA@@@@BA@@@A@@B@BA@@@A@@@@BA@@@@BA@@BA@@D@BA@AF@@A@@B@B@D@@@BAB@@@@@BABBH@FBB@D@FAF@F@H@LAF@DAD@B@D@F@B@@@B@BC@@B@D@@@@@@ABABCB@BAB@@D@@BBHDD@B@D@BADA@@BA@@B@@BB@@@@@AB@@@@@B@@B@@AB@BABA@@B@@@@@@B@@B@DB@@BB@@@@@@@@B@@@@@BBA@@B@BA@A@@@@@@B@@B@B@D@@@B@@@@@@A@C@A@@B@B@@@BB@@BABAB@B@@@B@@@@B@BAB@@BB@BB@D@B@@@@C@@D@B@DA@B@@@@@@@@@BBB@@FBDAB@BBB@F@FBB@BBBBB@BBB@B@B@@@B@B@@@B@@B@@B@@@NCD@BA@@BA@ABE@ABC@C@ACEAA@@E@A@AA@@@@@@@@BABA@@@@BAA@@AAA@@BC@A@@AA@C@A@ABA@A@AA@@AA@@AA@@A@@@A@@@CBA@ABABEBADC@ABABCB@BAB@B@BA@@BAB@BAFABABAFEDCRKBABADAFCBBB@FBFBB@B@BA@ABA@A@ABA@@@AAA@AAA@@A@@A@@A@@@@AA@@@A@@@BAA@@@A@A@@G@C@A@G@CBCBCBABAFGDADC@ABADAD@HADAB@D@D@B@B@BA@A@ABA@A@A@A@@@A@AA@ABE@A@AB@BA@CB@@A@A@AACAEAA@A@@A@@BA@@BA@@@A@@@AA@A@C@@@@@@A@@ACAEA@@A@@@A@@@AA@@AA@@@AB@@A@CB@@A@@@@A@@BC@A@@@@A@A@A@@AA@@AA@A@A@@@@ABC@@@@A@@@A@@BA@AB@B@@@BAAA@AA@@@@ABEHCB@@@@A@@@@ABC@ADEB@@AD@@@@@AA@@A@@@AA@@AAB@@A@AA@@@@AAAA@AA@@A@@A@@AA@@@@A@A@A@@AA@A@A@@@AAC@@AA@@@A@A@@@AA@AA@A@@@@AA@A@AA@@A@@@AA@@A@@@@@@AA@@@A@@AA@A@AACAA@E@A@A@GB@BCBAB@@A@@@AB@@@@@@A@A@@B@BA@@@AB@B@@@B@@@@C@@@A@@@@@BA@@@A@@AAA@A@A@@@A@CAA@A@@B@@A@@B@@A@@B@@@@A@@@A@B@A@AB@@A@@@A@@@@@@@@@ABA@@@A@AB@@A@@AA@@AECAA@@@AB@B@@@BBB@@@BA@@B@@@@@@AAA@AA@A@CBA@CB@AA@A@@A@@B@B@@@@@@@@A@@CAA@@@@@@@A@AB@@@BA@@@A@@@@B@@@@A@@B@A@B@@@@@@A@@@@@@B@@@@@B@@@@@@@B@@@B@B@@@B@@B@@@D@@@B@@@A@@BA@ADA@C@@@@@@BA@@BA@@B@@A@@@AB@@@@@@AB@@@@AB@@@@@BA@A@@@BD@B@@@B@@@BB@@@AB@@@B@@@B@B@BAB@BAACAA@E@C@@@@A@@@AA@@@A@C@AAAAAA@@@@@A@C@A@@@@BAD@@@D@@AB@AA@@AAACA@@AA@@@@C@@@@DC@@@A@@A@@A@@BAB@@ABABA@A@@@@@@C@@A@@@A@@@@A@CBA@@@@@@@@AD@@@BA@@@AA@GA@AA@@AA@@A@@@@CAA@@A@@A@A@A@CBCB@@ABA@@@A@A@A@ABA@@@CA@@@@@A@A@@@AA@@@EAA@@AAA@A@@BAB@@@DBD@@@B@BA@@H@B@BB@BB@@@BABBB@@A@@AA@@AAC@CAA@AA@@B@@@B@BABAB@@A@A@@@AA@@@ABA@C@A@@@AA@@AAA@@@CA@@@@@@@@A@@BC@A@A@@BCB@@A@ABAB@BA@AB@BA@@@@@@@A@@@AAABA@A@@@A@A@ABA@ABC@@@AB@@BB@@@B@@BB@D@@@@AB@D@@@@@B@BA@A@C@@AC@A@A@@@AB@@A@EBC@A@AAA@@@A@@@A@@@ABA@@BA@AB@@@@A@@AA@@CA@AC@@A@@AA@@@A@@BA@@B@@@@AA@A@@@@AAC@AA@@AAA@@BA@@BA@A@A@AAA@@@AA@@@A@@@@@@H@B@B@B@BADABA@AB@@A@A@C@@@A@@@A@@A@@@@@@AA@@A@@@A@@AA@@A@@A@@A@@B@@@@@@AA@@@A@C@AAA@@A@BAA@@A@@AA@A@@A@@C@@A@@@A@@@AA@@@@@AABEB@@A@CBA@@@AB@@AB@@@@@@C@ABA@ADA@A@C@A@@@@@@A@@@AA@@@AB@@@@AA@BA@@@@@BB@@@BA@BB@@BAB@@@B@@B@@A@A@@@@BAB@B@@ABA@A@ABA@A@ABAB@@AAA@A@@AA@A@@@@@@@@B@BB@@@AB@@A@AA@AA@@@A@@@@B@B@B@B@@C@A@A@A@@BA@@BA@@BAB@@A@A@@@@@@A@A@@A@C@ABA@@@@B@@BB@B@@@B@@@BA@A@@@@@@@@A@@@AA@@@C@AB@@CB@@@@@AAC@@AA@A@@@A@@@CA@@A@@@A@A@@@A@@A@@AA@@@A@A@AAA@@AA@B@@A@AB@@@B@@@B@@BB@@@B@@ADABABA@@@A@@A@@@AB@@A@@@AA@@@@@@BA@@@A@@AA@@@@@@A@@A@@@@AA@@@@@A@@BA@@@C@@@A@@AA@@@@@@AD@@@@A@@A@@A@AB@@@@AAB@@@BA@@@AA@C@@@A@@@A@@A@AB@@AB@@@BBB@@@BAB@@A@AAAAAAC@A@@A@@AAA@ABA@@@AA@@@ABA@@@AAAA@@BABAB@@ABABABA@@@A@@A@@@A@@BA@ABA@@@@AA@@@@@EBABC@A@A@@@A@@@@BA@@@@B@@@@@B@@@@@@@BA@@BA@@@@B@@@@AB@@AB@BA@ABAA@@AAC@A@@@C@@@A@CB@@@@A@@@CE@ABA@@AABA@@@AA@A@@@@@@@B@@ABA@A@@@@A@@@AB@@A@@@@ABAB@BAB@BA@@@@AC@AAA@@@@A@E@EBC@C@A@@@@@@A@A@@@@A@@@@A@@@@BAB@@@B@@AB@@@B@B@@@@ABB@ADABABA@@@AA@@@A@@@@@@AB@BA@@@A@@@@@A@@@@@@AABA@@@A@@@@@A@@@@@@@@AA@ADA@A@A@@BABA@@A@B@@A@@AA@CA@@@A@EB@@@@AA@@@ABA@@AA@A@@AA@@A@@@@@@@@A@@@@B@BBB@B@@@@ABAB@@ABA@@@A@@@A@@A@@AC@A@@@A@@@@@@A@@B@@@@A@@@AB@@@@@@A@@@@@@B@@@@@@A@@@@DA@@A@@@BAB@@@@A@@@@BA@A@@BA@@@A@AA@@A@@BA@@@AB@@@BADA@@@@@@AA@B@@A@@@A@@AA@@@A@@AAA@@@C@@A@@@@A@AACA@ABA@A@A@A@@AB@@AA@@@G@AA@@@AB@@AB@@CBA@A@CB@@@B@@BBB@@B@@BD@@@BB@@@@BE@AB@@ABA@ADA@AB@@AD@@@@@B@@B@@@BB@BBB@B@@@B@B@@ABA@@BCB@DAB@@@@A@@@@AB@@A@AAAA@@@@B@AACB@@A@C@AAAA@AA@@AAC@A@@@A@@@ABA@A@@@A@A@A@@@AAC@@@A@AB@@A@@@@@AA@@@@@BA@@@AA@@A@@@A@A@@B@DA@A@@BA@A@A@C@@@ABA@@B@D@B@B@@@@@@@@AB@BCB@@@B@@@B@BDD@@B@@@B@@@BB@@@B@BAD@D@B@BAB@BA@@@@@@C@C@@@@A@A@AB@B@FAD@BBB@B@BA@@@A@A@@@@@@A@@A@@@@@@@@@A@BA@@C@@@@@@B@B@DAB@B@BAF@BAB@@A@AA@A@AA@@GDA@@@A@@AA@@@@@A@@@@A@A@@@AA@@AAAAC@@@A@@BAA@@@B@@@@@BB@@BBB@BB@@BB@@B@@@B@BA@A@A@ABAB@BA@AB@BAB@B@BADA@@@A@@BA@@BAB@@@@@B@BBBBBBB@BB@@BA@@@A@@@A@@BA@@B@B@@A@@@@BC@@@@BABA@@B@B@@A@@@@CA@A@@B@B@B@@AB@DE@A@@BA@AA@A@ABA@@@AAA@@A@@@A@@AA@A@@@AA@AAA@@@AB@B@@AAB@@CBA@@@ABAB@@ADABA@@BADAB@BABABC@@@A@AA@@AA@@@A@CBAAA@@@@A@A@AB@@A@A@@A@A@A@@AACAA@AA@@@@@ABA@@@AC@A@C@A@AB@BAB@@@D@B@@@B@B@DABAB@@@@A@A@@AA@@AA@@@AA@@AA@@@A@@A@@AA@@AA@@AA@A@A@A@ABADC@@@@@A@@A@@A@@@@@AD@@AB@@A@@@AA@AAA@A@AAC@A@A@@@ABA@@@C@@@@@ABCDADCD@D@@ABA@@@E@C@CAEA@@AAA@A@@BCF@@@@A@@@A@A@@AB@@AA@A@@@AA@A@@B@@@DB@AB@B@@A@AA@@AB@BE@ABABC@ABA@@B@BAB@B@DBB@BB@@BBB@@@D@BA@AB@@@BB@@B@@@BA@@@AAA@@@A@@B@@@@@B@@B@B@@@BB@@@B@@@@@@@BB@BBB@@B@@@B@@@B@BADADE@@DAB@@@BABAB@DA@@D@DB@@@ABA@@B@@@BBB@F@@B@@@@ADA@B@@BA@@@@@BB@@B@B@@@@@BA@@B@B@@A@@@A@@@AA@@@BA@@BA@@@A@AB@B@@A@@@@@A@@@A@AA@@@@@A@AAABA@A@A@C@A@A@@@A@A@A@BC@A@@BA@@AA@A@A@@@@AAA@A@A@AA@@AAAAAA@@B@@A@@A@@@A@@@@BA@@BA@@A@@@@BC@A@A@@@@A@@@@@@@@@BA@ABAB@@@D@BAB@B@BADCB@BABAB@@@@B@@@BA@@BA@@BAB@B@@@@@@BA@@@@@D@@B@BB@BB@BA@@B@@B@@B@B@BBB@BBB@B@BBB@@@@@BC@A@ABA@ABA@AB@DBB@H@@@BA@A@@@AA@A@@@AA@@A@@@@A@ABAAC@AA@BA@@@@@@@AE@AA@@@@@ABA@A@@A@@@A@@@A@@@A@@ABA@@A@@@AAA@ABA@C@A@A@@BABABCD@@@BC@A@@@C@AAC@@B@@A@AB@@A@A@AB@B@@AD@@@@AB@@AACB@@@@C@@@C@@@A@AAA@BA@@B@@ADC@@BA@@@@AA@@@@@AB@B@@@@@@A@@A@A@ABA@@@A@BA@@BA@@@@AA@@ABA@@@@@@@AA@@@@A@@@A@A@A@@@A@ABA@@@ABCACB@@A@@@BB@@D@@BB@DB@@B@@B@@BB@@@B@@ABAB@@C@A@AB@@A@@@@B@@@@B@B@B@B@@@D@@@@@DBB@@@B@BA@@B@@B@@@B@@BB@@@AB@B@@@B@@@@BB@@@@@@@B@@@@@AB@BAD@@@BAB@BA@@BA@@@AB@ACBA@@BA@@@@@@@@AA@A@@@@@A@A@@@@@A@A@CBA@@@@BBBBB@B@@@B@@A@@@A@A@A@@@A@@@@BA@ABA@@@@AA@A@@@C@AB@BA@@B@@AA@BA@@@@@A@@A@@BAAA@A@A@@BCB@@@@A@@@@BB@BB@@@@@B@@@@A@@@AB@@@BA@@@@@C@AAAAC@@A@@A@@BA@A@@D@BA@@@@@@AA@@AABA@@BA@@AA@@AA@A@A@A@A@AB@@@B@@@B@@@B@@@B@BAB@@BBB@@@A@A@ABAB@FA@@BAB@BAFCBA@AB@@@@ABABA@@BC@@@A@A@@BAB@@ABADAB@@@A@@AC@@@A@@A@@@A@AA@CA@@A@@@A@@@@B@BE@@BA@@@A@@@BA@A@@@@@ACA@@@AB@@A@@AAA@IEA@@AA@@@@A@A@AA@@AA@@@AA@@@@A@BB@B@@@@ABA@@BA@CB@@EAA@@@@BABABA@@@@A@A@@@C@@BA@A@@BADA@@@@BCBCB@B@BA@ACC@A@@@A@@BA@@BA@AA@@A@AA@BA@@BA@@BA@A@AA@@AA@@A@A@C@A@C@A@@AA@AA@@A@@@@@AB@@A@@AA@@A@A@@@ABABABA@@@A@@A@@@@A@@A@A@A@C@A@C@A@@B@@@@B@@BB@@B@@@B@@@@@BAB@BCB@B@@@DBB@D@DBB@B@BBDBBBB@B@@@D@B@@@@@@AA@@@BA@@DAB@@ADC@A@@BA@@AA@A@@@AA@@@@A@C@A@A@@BA@@@@B@B@BB@@B@B@@@B@@A@@@A@@@AAAA@AAAA@@@A@@BC@AAE@C@ABA@@B@BB@@BBB@BA@@B@@BBBB@B@B@D@BADA@ABA@AAA@A@@@AB@DB@@BA@@@@@A@@B@@A@@B@@@@A@@@ABCBA@@BA@@B@@@BB@DBB@BDD@@@B@D@D@D@BB@@B@@B@B@@@BAB@B@@@DAD@B@BA@@@A@@@A@AAC@C@A@A@ACA@@@A@@@A@@@ABC@E@AA@@AA@AA@@@@BAB@@A@C@ABA@@BA@@BA@A@AA@@AA@AAAA@@@A@ABA@@@ACC@@@@A@@B@@@B@BBBA@@BABA@@BCBABA@@@@@A@@C@@@@A@A@@AB@@A@A@A@A@A@@@@BB@@B@BA@ABC@A@@BA@@B@@B@BB@B@@@D@B@BB@@BB@B@@@@BA@E@ABA@@D@D@@@@@B@@A@@@ACA@A@AA@@AA@A@@@A@@A@@@@A@@BA@A@@@@@@A@A@A@A@A@AB@@@BAB@@@B@@A@@@@@@@A@@A@@@ABA@@@A@@A@@@A@@@@D@@@BA@@@@AA@AB@@@@@DA@A@@@A@A@@B@@CA@ABAA@@A@@BA@@@@@A@@AB@BA@A@@@@AA@@A@A@A@A@A@A@@@A@@@A@A@A@AAA@@B@@@@@BB@@@@@ABAB@@@B@@A@@@@A@A@@@AA@A@@@ABCB@@ABABABABA@@BA@ABEFGJCBADA@ABAB@@@B@@CB@@@BA@@@@BA@ABABA@ABABCBC@A@@BA@CBCDIDCBA@@BABA@CB@@@B@@@@@B@@A@@BA@AA@AAAACAAA@CCA@CC@@@@A@ABABAJ@@AB@@A@AAA@@@CA@@CAA@@FA@@BABABABC@CBCBAB@@A@@@@@@ADC@AB@A@@@@@A@@@EFAB@BAD@@@D@DABABABC@CB@@@@AB@@AB@@A@@BBB@@@B@@A@@BC@@@AB@@B@@B@@B@BAB@B@BB@@B@@@B@@B@@@BB@@@B@BAD@B@B@BB@@@@@B@B@@@@@@BB@@B@@@ABA@B@@BB@BA@@@A@@@AB@@@B@DB@BB@BB@@AB@@A@AB@B@BA@@BAB@B@@AB@BAB@B@DAD@@ABC@@BAB@@CFABABCBABCBAB@BABAB@@@BABABAB@@@B@@AB@BADAB@BA@@@@B@@AB@BCBABA@AB@B@@@B@D@@@BAB@@A@@BA@E@ABA@A@ABA@@@A@@@A@AB@@ADA@C@@@ABABA@AB@@@BAB@@A@ABABA@ABA@A@A@C@ABCBA@ABAB@BA@@@A@EBA@@A@@@B@@@@A@@@A@A@@@ABAB@@C@AAC@A@AA@@C@A@@@C@@@AAAAAC@@A@AA@@@@AAA@AA@@A@@@A@CDA@CBA@A@A@AB@@CD@BA@CBA@ABC@A@GBC@A@@@A@AAA@AAA@A@AAC@CAA@C@E@CAA@A@A@A@A@@BA@A@@@@AC@AA@@E@CBA@AB@@C@AAC@C@ABA@ADA@CDA@@BCBA@@BAB@@@@@BCBCB@@C@@@AB@BCB@@ABA@C@A@CBA@@@A@CBA@EBA@A@A@@@E@A@A@ABC@ABE@@@ABCBCBCB@B@B@BA@GDABCBA@C@E@ABA@ABA@AB@@CF@B@@ABA@@B@BABABABA@AFAB@B@@AD@BA@CD@@ABC@ABCBCBA@@@ABABA@A@@@CBA@A@@B@@@@@BB@BBD@BB@@DFDFBDDBDBFDB@@BDBB@@@AB@BBB@@@B@@@D@@CD@B@@@@BB@@B@BAB@D@B@DBHA@@DAB@B@B@B@DAB@F@@@BA@@B@B@@BB@@@@B@BA@@BA@@@BB@@ABA@A@@@A@@B@@@@@B@@@B@BABA@@@A@@BBB@BAB@BBB@B@@ABA@@BAFABEFAB@B@F@B@BAD@BA@BBABADEDCFADEDGDIDEBC@EDCBEBABCFAB@B@B@BBBDBBBDBBB@BB@BB@@@B@BA@ABC@AB@@@BB@BBB@@@@B@@A@@@C@A@A@@BA@@B@@@@DB@BBB@@@BB@@@@B@@@B@@A@A@@@A@A@CEAA@AA@E@@AC@CAEAA@A@A@A@@@A@C@A@A@A@GHAFCDABAB@BADAB@BAB@B@B@D@@CFADADAD@@@B@B@B@@A@@BAB@BABCD@@@B@D@@@BBD@B@BA@@B@@OBIBABA@ABABAB@@@BBD@BBDBDBDHJBB@BB@DHDB\\PHD@@AD@FABAD@DADAD@D@BAD@BAF@BAD@BABAF@DCL@BAB@HAF@B@@CAGC@@C@C@CACAADICA@GCGAA@@A@AKEA@A@A@@@ABABA@A@AACBGBEBC@KDA@@@@ABCBAFGDEDG@@A@BAA@@@GA@@A@@B@@@BB@@BA@CHCFABADABABA@A@A@G@GAIAEA@@A@A@@A@@@ABADABADC@A@@BAB@@@B@@@BA@@BA@@F@BA@@B@DC@@HEB@@A@@@AAACC@A@ABABA@ABA@@AACAEACCA@@@@A@ADAFCBCDA@@@A@@AAAECEAAAAACAACCCCAACCCEAAAAAAA@C@A@A@E@@@IBG@A@C@A@E@A@C@A@GA@@CAAAA@@@A@@A@A@@AAAAECA@@@ACAC@@@C@C@@ACA@ECCEA@AA@@@@C@@A@@A@CB@@ABCB@BAB@@AD@BAD@D@BB@DBBB@@@B@@@@AB@@@BCBADCBEFEDADA@@BA@G@EBE@C@CBKDGBEBEBABCBEFEBEFCBEDEDCBA@@@A@A@AAAC@@A@@@@@EDABCBA@C@K@C@@@AEAGAGAAACACECEAA@A@A@@@A@@@AB@FABA@ABABA@CAK@A@G@C@E@I@@@CBABC@ABEBCBABA@C@CBA@A@ABCBABA@A@@A@ACCCGGGACAAAECGACCAAAGAE@CAEAGAA@CAAAAA@I@CAC@A@E@E@C@C@EBGBEBAAA@AAAAIAE@A@C@AAAAAA@A@AAA@@@@A@A@ABABA@C@E@CBCHE@ADEBABCDM@@@A@AA@EICCAA@@@@@A@@BA@ADAHCD@DCBAB@BADG@@AA@@CCAACCACEGACAAEEAA@A@A@@@A@@BA@C@@@@@A@AEAC@CAE@C@A@C@A@CBA@@@AAA@CDABCD@BA@@B@DAD@D@B@BA@AB@B@@CB@@E@A@@@ABABA@ABC@ABEBC@EDA@A@A@A@@@C@A@CBC@EAA@AACA@@A@A@A@A@A@AACAC@A@@@AB@BC@C@@@@@A@A@ABA@CAC@CAA@A@A@@B@B@F@B@BABABAB@@AF@BA@C@ABA@A@@AAA@@@AAAA@A@AB@BA@A@@@@BA@@@I@@@A@A@AB@DAD@@@@AAA@@A@ABA@A@@@AA@CCAACAEAAAA@A@A@C@@BC@A@EBC@A@ABCBA@CBC@GBSF@BABABA@@@C@A@@BAA@@A@@A@GAIBE@CBE@GBA@A@A@CAA@CCC@A@ACCAACACAGAA@EAA@@@A@C@A@@@CA@@A@@@E@A@EFCDCFABEDA@GFGDABGBAB@BIHCDCFADAFAHADADIHGHCDABABAB@BABCB@@A@A@@@C@@@IDIDC@C@A@@@E@ABA@@@I@C@CBCBIBCBEBKDIDC@@@EBA@ABA@ABCBEBIBA@E@@@ECAAGE@@ABwX_TKHhED@@KJEFEFEF@BCBEHCFAD@D@F@DDFBB@FAHCHCHAF@DDD@@DBFBHBFDFDFLFFFFFHDHHHFHBD@DIJGJGFEFBFBJFJFHFHBFBDBHBFFFFDBD@BNLRlBHJ`HNJDHDLFJHPHJJLFJHDHDDDFBJBH@HBD@H@JCFADCFEF@F@BDDLDJDJFFHDBFDFDHBJBFDFFFFFFDFBBFDJFB@NDRPftB@HFBBB@JBNFLDJFDDJJFJDHFHFFDHBF@D@DDFHHHFBBJFJJFDBBHHJFHHHHHHJJBDDBHHHFHHHF@B@@@@@@@@@@FDLHBBDBDBHAHADAROZUFAHATEF@DBDDBBDDHFFJDDJFNFLHFFDD@DADGBEDGHIHIHKJGFABIHCDAFAHAFBB@BDHDH@H@HDHDHDFDFJBLDLDNDLBHBFBHBH@J@H@JBFB@@FDDHBJBHBH@HBFBHFFBBFDHFLJHHHFFDJFDD@FAD@@@DBFBDFFHFJHJDJFFDFHHF@@FFFDFBH@BBHHHHHHHFDBBDBBB@BBBBDBD@DADADCBAFEBABABA@@BABAh_H@NNZPFBBBBBBBBBDBFD@@FDDBDBFDBDDBBDDB@BDFDDBBDDBBDBDBDBF@DADCDCB@DABD@BADAB@BDDBDDDDBBBBBBDBFBBBBDDBBBBBBDFDB@DBBBBBDBDBD@BBB@D@BTdNZDCJ@B@B@BAFCBA@@FC@@BABC@ABABA@@BCBCB@HG@ADCBADCBA@AB@FCFADADAHCBABABABADABABABCHCDCBAFCBA@@FCDAB@FEPIDCDADCBCHCRKBABABADAB@BCDAFEB@DAB@HCJG@@BAFCB@HEB@BAB@BADAXKFCD@DCFABAFCDADADAFCFADAHCFCDAFC@@BA@@BAFKBC@CBA@CFIDEBCBE@E@@BA@C@@A@@AC@E@C@IAC@C@C@A@C@@@@@A@ABOBC@GA@@ABEBGFCBABCD@@CBE@C@MDKBC@E@AACAECAAGACAE@GAC@I@GAC@A@AA@@ECAC@AACAAAAC@AAA@AAC@A@A@@A@@@ABC@CBCBA@ABC@@@@@@AAA@@@EA@AAAAAAA@A@@A@AAA@@AAA@A@@@@@ADCBA@@BA@A@A@ABABCB@BA@AB@@AB@BAB@B@B@B@DE@@BABCB@FEBABAHGBCB@@ABABCB@@@B@@@BBBBB@@@B@F@HAEGAIKQEQIIWKLQNI^OPAZF@AB@@ABA@@BABABCBCB@B@B@B@THBBDBB@@BBB@BBB@@@BBD@BBD@BBB@FBB@B@B@B@B@B@@ABAB@BABA@@A@@@AAA@AA@@@C@A@ABTT"]],encodeOffsets:[[[112472,39595]],[[112474,39594]]]}},{type:"Feature",id:"610803",properties:{name:"横山区",cp:[109.294346,37.962208],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@@@@AA@@@@ABA@@@@@AA@@A@@@A@@AA@A@@AAAC@@@A@C@@@A@@A@@AAAB@C@CAEACCCAAAACEC@AAACEAC@AAEAC@@AA@@A@A@@@CD@BCD@B@@CBABC@A@C@A@@@A@@@@D@BAD@BBH@F@D@D@B@FBDAD@B@BADA@@B@@A@C@A@AAC@A@GB@@A@CBABABEDADCDGJABEFABCBCBA@A@A@EAA@G@A@@@AB@@AD@BA@@B@B@DBBBB@BB@@DB@@BABBD@B@@@B@@@@@B@@@@@DDD@BDDDDDDFF@BBBBBDBBBBBFH@BB@B@BBD@B@B@@@BBBBBBB@@BB@BAB@B@@@@@BB@BB@FBBB@@BBDBFDB@BDFDBBB@BBBB@B@BB@@@B@@@B@@BBB@B@@BB@@B@D@@@B@@B@@@B@@B@@B@@B@B@@@BB@@@BFBB@@@DD@BBB@BBB@@BBB@@B@B@@@@@BB@@@D@D@B@B@B@DAB@@@FEFCHCBAD@B@@@BBB@BDDAB@@@BA@@BC@AB@@ABA@@@AB@DADAF@@@B@@@B@@AB@BC@CBABABCBABA@ABA@A@AB@@AFAB@DAB@B@B@B@D@B@DAB@D@D@H@B@F@FAD@DAB@FAB@B@B@@ABAB@DAB@B@B@HBBBD@F@B@BBBBD@BBBBBBBDBFBDBB@BDDBB@@FBB@HDBB@@B@@B@BBH@B@D@F@BAB@@CBABCD@@@B@@BB@BDDBBBB@B@B@@@@@BB@B@@DB@@@BB@@@@@@BB@@BCHAF@D@HBB@D@FDDBFDB@DFBDABC@A@@@ABE@DBA@B@B@BB@@B@@BB@@@B@@B@@@B@@B@@@B@@@BBB@@@BBBABBB@@@@B@@DB@@@@@A@@@A@A@@D@@AD@BAD@HCDABAB@@ABEDCDCB@DCDADAB@D@F@@@@@B@@BBBBBBB@BBDB@@@B@B@FAFCBABCBAB@@@B@@BB@BD@@BDBBB@@BDFB@BF@BDDBBB@BBD@D@B@D@@@DB@@B@BBB@F@B@@@BAB@FEBABCDAHEDABA@@B@B@@@D@B@D@BAB@B@B@@@B@D@B@@@B@@@@A@A@@@A@C@A@C@CBCDC@@B@HCB@BAFCA@AAC@ABA@A@A@ABCBA@@@@@A@@ABA@A@A@AB@BADE@@@@@AA@@C@A@@A@@@CBA@@@@@AA@C@A@A@A@A@@AA@@C@A@@@A@@@A@@BABA@EBC@@@A@@B@@@B@BBD@@A@@BA@CAA@@B@B@@A@@@@@CA@@@A@CA@@@@@ABA@@@A@A@@@AAAA@E@A@@@@A@CBA@A@@@A@AAAA@AABA@@@AAAA@@BA@ABA@@@A@@A@AAA@AA@@@AE@@A@@AA@A@A@C@@@A@A@@C@@@AA@@@A@@DA@AB@B@@@BA@@B@AA@A@A@A@A@@@AA@@@AA@@@AB@AAA@@A@@@@@C@A@@AA@A@@A@@@AC@@C@@BCD@@ABAAA@@BA@ABABCDAB@B@B@@BB@BB@@BB@BB@BB@@@@B@@BB@B@BB@@B@@B@@B@@@B@B@B@@B@@@@BAB@@@BB@B@@@B@@@BBD@@@@@@BCBC@I@A@EB@@AAA@A@ABA@AB@@A@@@A@@@@@A@@@@A@@@A@@@@DA@@@@@@AAC@C@CAABA@@D@@A@@@@A@@@B@@@BAB@@@@A@@@ABB@@@A@ABC@A@@@A@A@@@@A@@@@DCFC@A@@@@CAA@A@@@A@@BCDAB@@@@A@@@AAA@@@@@AAB@AA@@@@@AB@BA@@BA@@@A@@AA@@C@ABEFCBCDA@A@A@@@A@AAAAA@@@AA@@@C@@@AA@A@C@C@C@A@AA@@AA@@B@@A@A@@AACCAA@@@A@A@@B@@BB@@BB@@@B@BCDC@A@@@AA@B@@A@@@A@A@@@AB@BA@@@ABAA@@@@AC@A@@A@A@C@@@A@AA@AAC@AAAA@@@A@@BA@A@@@A@A@@@@@AA@@@@@@AC@@@A@@@AAAA@@A@A@ABA@A@C@@BAB@@@@AAC@AAA@A@AAAAAAA@@@A@@AAAA@C@C@A@@@A@@B@@@B@@A@@B@@AB@@A@BBABABA@@AA@@A@@@@AAA@@@BA@@@@A@@@@AA@@A@@A@@@@@@@AA@@A@@AAA@A@@@@@@A@A@A@@B@@@@@@@B@BA@@@@@A@AA@@ADA@ABA@@@@A@@@AB@@A@@DCB@@@BA@@B@B@BAB@@@AAB@@@@A@@@@@A@@@@@A@@@A@@@A@@@A@@@@@@@@A@@A@@@@AA@A@@FE@@B@B@BBB@@BD@B@DAB@BABA@AB@@AB@BA@@@@@@@AB@@A@@AE@A@C@A@C@@@A@A@@@A@@BA@A@@@AA@A@AAAAA@A@@@AB@@ABABA@@@@A@A@@@AA@@@C@A@@@A@@@@@AA@@AA@@A@AB@@A@ABAB@@A@@@A@A@@@@@@AA@@@A@@AA@A@@@@@A@@BAB@@@@AC@@A@AA@@@@A@A@ABA@@BA@@BA@@@A@A@@B@@C@@@CAA@@@A@@B@BADB@@@@B@B@@A@A@@@@@@@ABABEBA@@@AAAA@@@A@@C@A@A@ADABABABEFADAB@D@@@@A@AA@@@A@A@@@@@A@@AA@@@A@A@@@A@@AC@@A@@@EDA@@@@@@AAAAACCAA@AC@A@@@A@@@@A@@@@@C@A@@@@AA@AA@@A@@@@@A@AA@@A@@@@A@A@A@AB@@A@A@A@@@AB@@A@AB@@A@AB@@@@@@@@A@AA@@A@AB@AAB@@AAC@CBABA@@@@@@B@@@BA@@BA@AB@BADABA@@B@@A@@BAD@@ABAD"],["@@DA@@BA@@@@CAACCACA@@AB@@A@A@@@AAAAB@@A@ABA@@D@@@@ABAAC@@BA@@AA@@@A@@BA@A@@A@@@A@@@A@@@@@@A@@B@@ABA@@B@@AAA@@C@AAC@A@A@A@@@A@@@@@@A@ABA@@BA@@AAAA@@AA@AAA@@@AA@@@A@@@@@A@@@A@@@A@@@@@A@AA@@@@BA@C@@@@FAH@@@@A@@AAA@AAA@E@AA@@A@AA@A@@A@@@A@A@A@@@@@ABA@A@@@@@BAB@@A@@@A@A@@A@@A@@@ABABABA@CEBCB@@A@CDABABA@@BABBBADABA@E@AB@@ABEH@@ABADB@@B@@@B@@ABABA@@@@@BB@@@BC@AB@BA@A@@@ABA@@BABAB@@E@A@@@A@AC@AC@AA@@A@A@@@A@ABC@ABAB@B@@@BBB@@@@A@A@A@@BAB@BC@@B@@A@@B@@B@@@@BAB@BAB@BA@ADED@BAB@DAB@@BBBBB@@@B@@@BA@@B@@@@@BBBB@B@BBBB@B@B@B@@@AD@B@DADADB@@D@BBB@BBB@@HB@@@B@@@BAB@B@B@@BB@B@B@B@B@B@@@BCB@@AB@DBB@BB@BBDBB@B@@A@@@@BAB@@@@BAB@D@D@@BB@@BB@@A@ADA@@@A@A@@@@@A@A@CDAB@@A@CD@@@B@B@BAB@BABABA@@B@@CBAB@@@B@@@B@B@BB@@@@B@@AB@@CBEBABCBA@AB@B@B@@BD@@@B@B@@ABABCFA@@B@@@@@B@B@@AB@B@@@B@BB@BBF@@B@@@@@@ABA@AB@B@@@B@@@@ABA@EH@@ABAB@B@@@B@@@@BD@@BBADB@@@BB@@B@DABA@@D@@@@@@B@B@BA@@B@@BB@B@@AB@BA@AB@B@@@@B@AB@@@@BB@@@D@@BD@D@B@@A@@@CA@@A@A@@@AB@B@BAD@@@BEBAD@B@B@DBDBB@@@B@B@@AB@@@@@D@@AB@BABA@ADADCB@B@@@B@D@@@B@@@BA@AD@@@B@BB@@BADAB@B@@@B@@A@@@A@A@A@@@C@AA@@@@@@@C@AAA@@@@AA@@ABABABCFA@ABC@C@CBCBA@EB@BA@@@ABA@AB@@@B@@BBBBDB@@@@ABA@@@A@AAA@A@@@A@CB@BEBKDE@CBIDIBIDCBABEBAB@@A@ABCBA@ABABA@ABAB@@CBA@A@CDABABC@@BCBCBKFABCBCDA@EDGDCB@@AB@DAD@F@B@BA@@@ABC@A@A@A@@A@@@@AAAAAA@A@AA@AAAAAAA@@@CG@@A@@@A@BB@@@B@@ABA@AA@@A@AB@@BBB@@BB@ABBB@@D@BBD@B@@BAB@@B@@BB@BB@@@F@@B@B@@@@BB@AB@@BB@@@AB@BBDBB@@B@@A@@BCBABGBEBED@B@@A@@BA@EFCBA@A@A@A@GCCACAA@AACCCA@@C@A@A@EAA@AAC@@@ABA@A@AAC@A@A@A@C@A@A@AB@@C@E@A@G@C@@B@@ABCBCFAB@@AB@B@B@D@BBD@BBDBDBBBBAD@BB@H@BBB@BBADAB@@BF@B@BBD@BAB@BBDD@B@DB@@BHB@@@DB@B@BB@BDBB@@BA@BB@@@@@@BAD@B@@@@@@B@B@BABB@@@@@BAB@@ABADCDCD@B@@B@B@B@B@DB@@@@@B@@A@@B@@@BBB@BAB@B@@@BB@@B@@A@@BA@@B@B@@BBBDDBHDJDDDFBDBFBDBBBFDD@@BBHBHBB@@@@BF@B@B@D@H@DBH@D@F@B@B@BBB@DBB@B@BCBGFEFABA@CD@B@@@@F@B@B@B@BBBDB@DDB@DFDB@@B@DA@@@@@B@@AD@BA@C@@@AB@BA@ADADABABCBADAB@B@B@B@@@@BBB@@@DAB@@@@@@B@B@@BBDB@BB@AB@B@B@@BBB@D@B@D@BBFDBBD@B@BAB@BAD@B@@@DB@@B@F@DADA@@B@D@@@FADA@@B@@B@@@@@BAACBA@ABABABA@@BD@@@B@@B@@@B@@AF@@@@@B@ADAB@@A@@B@@@@@@B@BABABA@CB@@@B@@@B@@@B@@CD@@@BBBDB@@@BAB@@BB@@B@DD@BB@@@B@B@B@@@@BA@@BAB@@ABAB@B@BA@@B@@A@@@@A@A@@CA@@A@@BCBA@ABEFA@ABAAC@ABA@CBG@GBA@@AA@@CBC@AB@FCBABA@CBA@@@A@@@A@@A@@@CD@BCD@@CDAFABABCBC@A@C@E@EAA@AAAAIGCAAAA@A@AB@@@@@BB@BB@@ABA@@@A@A@@@AD@BCBA@CBA@A@@@AB@B@B@@AB@@CBABAB@@@@@D@F@H@H@@@@ABBFBF@B@@@@C@@@@B@BBHB@@@@@G@C@A@A@A@@@EA@@A@A@A@A@EB@@A@A@@@@A@A@A@@@A@@A@@AAA@A@C@A@AAAAAC@C@A@@@E@I@A@ICA@ECE@G@ABC@CBGDA@A@CB@@ABA@AACA@@A@CBA@A@A@C@@@A@C@@@A@AAAC@AAAA@@@EDA@@A@@@C@AAA@ACA@AAAAACECC@@AAA@A@CBA@CBA@@@CDABCD@BCDEDABA@@B@@@B@BBBBDBB@@@@BD@@DD@@DD@B@@BB@B@@@@@BC@CBEBGBABAB@@AB@@@DAH@@LFLHF@DBJ@JBBBDHDLDFBHAN@HBFDBHDHDDBH@HAFAFANFHDDBAHCJAFBBDDLJJNDF@HDHB@H\\HABBJ@D@D@FBB@D@D@J@F@F@BH@@@BixTXRrIRSHKF@@HFBBFD@@F@B@JAFADABAB@BAB@FA@@D@JCLCFADAJADADAD@J@@@B@BAF@@@B@D@D@JCJC@@D@@@B@B@@@DABA@ABABABADCHGJGBCBCBGBEBCDEDCJG@ABAHABAHCHEB@FCBADEDCFEB@F@@@B@@@DB@@B@D@B@@@B@FBB@HBDBDBBBDD@B@BDD@DBB@D@B@BAB@HAF@DAFBJ@H@BB@@@BB@AB@D@@@B@BABA@ATEHAD@DAB@DABAB@D@FAB@D@@AD@B@B@B@BBFBDBBBDDB@@B@@@BAB@B@BB@BB@@@@BC@CBAB@B@@@J@@@B@@A@@B@B@@ABAB@B@BB@B@@BB@BB@B@BAD@B@@ABE@@BABABA@A@A@E@A@AB@B@B@DBD@DBB@BAB@B@@@@@D@D@@ABA@@B@D@DBBBB@B@B@B@B@@@DBBBB@FBD@DAB@D@@@B@B@B@B@FCD@FABAD@BAB@BABA@@B@F@@@DA@@@ABAB@@A@A@CBC@C@AB@@ADCBADCB@BB@@B@DAB@D@B@D@F@DBD@FB@B@B@@@@@DAB@@@B@@@B@BBBFFBBBDFHBDDDBBDD@@BB@@CHABA@ABCDC@GDCB@BAB@@@B@@@@BBDDFJB@@B@B@@CNADABCF@BGFAD@D@F@DABAB@B@B@B@@B@BBB@B@BBBB@B@D@BBFBJBB@BBBABAFAH@F@D@D@F@F@BBD@D@JBBBBDBB@HBFBDBF@HBBBDBBDDHBFBBBDHHDHDD@B@BB@B@BADABAB@B@DAD@B@BADAFABAD@BADA@@J@F@D@H@B@L@DBB@BABAB@BA@EBA@@B@@@B@B@B@FBFDBDBDBBBHBHBF@@D@L@D@B@DABAFC@@@@B@@@BDBBB@B@@@B@DAFCFCDAFEFAFEDABAFAFAHALCDAD@F@FAH@B@@AB@BCFCFEDABCDA@A@@BA@@@@@A@@AACAA@@A@CBC@ABC@@BA@ADABA@@DAB@@@@BD@@@@@BBB@DFFDB@BD@@@D@D@@BDBD@@B@FDBBBB@@@B@BB@@@B@BBDB@@HBB@D@B@F@B@D@B@H@JA@@F@B@B@D@B@BBBBBBDFDDBBDDDDBBBDBBBBDFBFBB@@@B@@CBADEDCB@B@B@@B@DDFBDBBB@@AB@BABAB@B@BDDBB@B@@@BA@GF@@CDA@@@ABE@@@AB@@AB@@A@@@A@AB@@@BCDABCBAB@B@@@BB@B@@@FBJBHBH@B@B@B@BABABCBADEDGB@@AA@@A@@@AB@@@HB@@B@ABB@@@CHCFEHABAD@B@@B@LCD@FAHADABBB@B@BABA@@B@B@B@LF@B@BB@HBHDB@JDBCDBDBD@D@@@HDDB@@@ABE@GBA@ADK@CBEBA@ABC@ABE@ABC@A@CBCBC@CBCBA@EBC@@GC[OCACGA@@AAAGIACACAC@AAC@A@@BABABAB@BAJAPA@@@AB@@A@AAC@A@@@C@A@@DCBA@ABA@AB@@@@A@A@A@@BCBCBCDE@@@C@A@ABA@ABABC@ABABADCBEHGB@B@B@D@B@@@B@B@B@B@FBDBD@@BF@B@@BBBDFB@B@@@B@B@@@@A@@@A@@A@@A@@AA@ACA@@@@@AB@@AB@B@D@@@B@@@@A@@A@AAA@@A@@BAD@BAB@@A@A@@AAA@@AAACAAACAAA@A@A@ABADEBAFADAFCD@FAJCHCFCBCDEFCBCBAAAB@@ABC@A@A@E@ABAFEBABE@AB@BA@@@AAA@ABA@AAA@AB@@@B@BA@A@A@@@A@@@@@AB@@@B@B@BA@@AA@@B@@AB@@A@A@@A@@AA@A@@@AB@@E@A@CBA@A@A@A@CB@@GBCAA@C@A@ABA@@@AA@@@@@ADC@@@C@@@A@@AA@ABA@@A@CA@AA@ECCACAACCECE@@AAC@AAA@@A@@@@@AB@B@DA@@B@B@BABA@@B@DADABAD@BA@@DCB@@ABC@@@ABABEB@BABABA@A@AB@BA@@@ADE@@BAB@BAB@BAF@D@B@DABAHCB@@A@A@ADADADABA@@F@BAD@BAB@B@F@@@B@B@B@FAB@DAB@@@B@DAB@D@B@BA@@DA@ABA@@D@@@DADA@A@@@@BA@AB@DA@AB@DCB@BCB@BAD@D@BBD@@@BAB@DAF@@@BBD@@B@@B@B@@AB@B@B@B@B@DBF@D@B@DBD@BBB@B@BBB@BBB@@@B@D@HAB@D@BAB@DAB@@ADC@@BAB@B@B@DAB@DCB@@@B@@@BBB@BB@@@@BBB@@@BDBBBB@@D@@@B@D@@@BBB@D@BBD@@@BABA@@B@B@@@B@@@@@@A@@@BB@FAB@@@B@@ABABAB@DABAD@B@B@B@BAB@BABAB@@@BA@A@@BAB@BABA@@D@B@BC@@BAB@@@B@@@B@BAB@B@BAF@B@@AB@@@BA@A@@@C@A@@@ABAB@BADA@ABA@@@A@@B@@ABABC@ABA@@@A@@BABABA@A@@BABA@ABADABADABABADE@@BACAGAA@ICKC@BA@A@@BABA@AB@@C@@A@@@A@@@@AAEB@AA@@@C@@A@@@@@@@A@@A@@A@@@@A@A@@BEDABA@GDA@@@CDAD@D@D@B@D@B@@@B@B@@A@@@A@C@A@@@A@A@A@ABC@A@C@@@A@A@@@ABCBGFCBADABEFA@AB@@A@E@A@AAA@@@CA@@C@A@C@C@AAA@AACC@AAEA@CE@AA@AAAC@@ACA@@AA@@@A@ABADABEDEBA@A@@@A@AC@AAAAAAA@AA@@@@@E@C@A@CBCBCDA@CDCDAF@BA@ABCBGDC@ABC@@BC@@@@B@B@@@B@@@@CA@@@A@@A@AAABAA@@A@AA@@A@@@A@@@@A@@@AA@@@A@@AA@@@AAA@A@B@CAAA@@@B@AB@A@B@@@@AA@@@@@CAAAC@@AA@@AA@@A@@AA@@A@@@@@A@A@C@A@EAA@A@AA@@AA@@@@@@AA@@A@@CA@A@@A@@@@@A@AAAAACC@AAA@@@A@@DCBADA@@BA@A@E@C@AAG@A@AA@@@AAGCA@EA@@AACC@AAAACAEACAAAAAAC@AAAAA@E@C@AAGAA@A@A@CBA@AB@BA@A@A@EBA@CBC@EBE@A@G@C@C@A@CBA@C@A@A@A@A@CBA@EB@BA@@B@BAB@BABABADABAB@DADA@@BA@@@A@@@E@CBCBA@@B@@AB@BA@@BAD@@AB@@A@CBACA@AA@@A@C@ABGDEDEF@@A@CBA@A@A@C@C@@@A@@A@@@@@A@AA@AA@@AA@AAA@ACC@@A@EA@A@@AA@@A@A@@@@AA@@@@A@@@AA@@@C@A@@@AA@@@AAA@AA@@@A@@@A@@A@AAAAAA@AAECACA@ECCAAA@@AAEAA@@AAA@@@@A@A@ABA@@AA@AAAAAA@@A@A@C@AAA@A@@AEGAAAACAAAAA@AEECCCCCC@ACC@C@@@@@A@@@@@A@@@AACBA@AA@@CA@@AAAAA@C@A@AB@@ABC@@BA@@B@H@B@FBB@B@B@DADABAFEBAHIDCBCFCBABADAB@@@HAB@D@BBB@D@B@@@@AB@BC@A@ABCAC@E@A@C@C@EAG@ABC@A@C@@B@@@B@D@B@D@BADA@@@ADC@ADC@@B@B@@@BB@@BDBF@BBDDFBB@BFDBDBBDBDDFBDBD@@AA@B@@ABA@@@@BA@@ACAA@A@A@@@A@@@@@A@@@@@@@A@ABA@A@@BAAA@@BA@@@A@@@A@A@A@@@@@A@@BA@@B@@@BBB@@BDBDBB@@@BBBAB@DADAD@BADC@C@ABA@ABAB@@A@A@@@A@@B@@A@@@@@@@@@@@AB@@A@@@@@AA@A@AA@@@@CA@A@A@@@A@@@A@AB@BA@@BADABA@@@@@@AAC@A@@@AA@@BC@@@AA@@@AAA@AAAA@A@CAA@@AA@@B@BA@E@@@@A@A@CBA@A@@@EAAAA@@@AAECAACAABA@@BCAA@@A@A@@@ACA@@AAC@@@A@@@@A@AB@@EB@AAB@BA@@A@@@A@AA@@@@@@A@@A@@A@@@@CBA@@A@@CAA@@@C@A@@@@A@A@@@@BCBAB@@A@@@@@@@AA@@AAB@A@@@@@@AAA@A@A@AACAAAAACCA@@@C@@@A@@BA@ABC@@BA@@@A@@A@@@AB@@@B@@AA@@AAEA@@A@C@@@@A@A@A@@@@@@A@@@@@@BA@AB@@@BA@@@AB@@ABA@@BA@AB@@@DA@@B@@AB@B@@@BA@@@@B@@A@@B@@@@@B@B@@B@@BAB@B@BA@ABA@A@AB@BA@A@ABA@@@@BAB@BA@C@A@@@C@C@@AA@@@AC@AA@@AA@CAC@@AC@@AAA@AAA@@@AA@@A@@BA@@@@B@@ADA@@BA@A@@@A@@BA@@@A@A@@AA@@@A@AA@@@CBC@A@AA@A@A@A@@AA@BC@ABA@CAA@A@C@AA@@@@@@@A@A@AA@@A@@BCB@@@B@@@B@@@@A@@@@@AB@@ABAD@@@BA@@@A@ADABAB@BAB@@@B@@A@@AA@A@A@A@AAA@A@AB@BABABA@@@@BAB@@A@@@@BABA@@@A@@@@@@@BB@@@B@@A@@@A@C@A@@AAAAA@@A@@@ABC@@BAB@B@BAB@B@B@AA@@AAA@EEA@AAA@A@A@@AA@@A@ABA@@AA@@@@@@ABA@C@A@AB@@A@@AA@@@A@A@A@A@A@@A@@@A@@@@@A@@@@@@@A@@A@A@A@CAA@@A@@AAA@AA@@ABA@@BA@AB@D@BA@@@AACA@@A@@@CB@@AB@@@@A@@@@A@@A@A@@AA@@@A@AD@BABA@ABC@A@A@@@A@@AA@BA@AB@@A@AB@@@@A@@@@A@A@A@A@@BABAB@@@D@B@B@B@@@@@B@@@BA@@@@@AAAA@@@AA@AA@@A@@AB@@C@A@@AAAAAA@@@CA@B@@A@@@A@CAA@A@@@AB@@@@A@@BA@@B@@@@B@B@BB@@@B@BB@@B@@B@@B@B@@@BC@@BAB@@AA@@A@@BCACB@@A@@@A@AA@@@@A@@A@AA@@@A@A@ABC@A@@BA@@@@B@@B@BBBBB@@BB@@B@@@BB@A@@BA@@@@@AB@BAB@@@B@@A@A@A@A@CAA@@@A@A@@AACK@@A@@@@C@AA@@@A@@@AB@@GBC@A@A@E@E@C@@B@B@@CDAB@@@@@BB@@B@B@@@@CB@@AB@@@@A@@@@AAA@@A@@@A@@C@@@A@@B@D@@@B@B@@A@@@C@@@AA@@@@A@@DA@@AAB@@@B@@@DA@A@@@ACA@@@@@@BAB@BADA@AB@BC@A@@@@GC@@@A@@@@@AB@BAB@D@B@@@@@AB@@BBD@@@B@@@B@@A@AB@B@BABAB@@ABA@@B@B@B@@A@@A@A@@AA@@@BA@@AA@@B@AA@@@A@@@@B@@A@A@AA@A@@BAB@@A@@@A@@@A@A@E@A@ABA@@@A@A@A@@A@@@@@AB@FA@@A@@@CC@@@@@AA@AACACA@@AAAC@@CE@AA@AAAAA@@A@@@AAA@A@@A@@@C@ABA@@@A@@A@A@E@@@AC@A@@@A@CA@@AAA@A@@@A@@@C@A@A@A@AA@@C@@B@@A@@B@@A@@@AAAAA@C@AAA@@A@@@A@@A@AAEAA@AAA@AAA@@@A@C@A@A@A@A@@@A@A@@@A@@A@A@@AAA@@@A@CBA@A@C@ABA@E@A@C@AAA@ABAAEBAAA@AA@@@ADA@A@@@@@AA@A@A@ABAB@@@@BBBB@@@@A@@@@@@B@@A@@B@@A@A@A@@@C@AAA@A@AAA@CC@@A@C@A@AA@@AA@@A@@AAAAAAACCCA@A@@@AB@@@@@B@B@@A@@@A@AA@@A@AA@@@@CAA@@@@@A@@@@@@@A@@A@@A@@@@@@@A@@A@@@@A@@@@@@@A@@@@@A@@BA@@@@@@B@@AA@@@B@@A@@A@@B@@@A@@AAA@@@@AA@@@@@@@@A@@@@@@@@AA@@@@@@@AA@B@@A@@@@@A@@@A@@@A@@@A@@A@C@CBA@A@C@AAA@@@@@G@A@A@CACAA@A@ABA@AD@@AB@@@@CDAB@@AB@BA@@A@@AAAA@@@A@@@@@@AA@@@AA@@@BA@@A@@@@A@@@@A@@@@A@@A@@@@@@A@A@AAA@@@AB@@@@A@A@@@@@@@A@@@AB@@@@AA@@A@@B@AA@@@@AAA@EBEBA@E@EBC@GBA@A@A@@@C@@@AB@@@@@A@A@ABA@A@C@A@A@AB@B@@AA@@@CAAAEAA@@@A@A@ABA@@@CBA@A@@@AA@@GAC@AACAA@AA@@@@@@BA@@@@@AB@@A@@BA@@@@@A@@A@@@@A@@BA@@@@AAA@CA@@A@A@@@@@@AB@AA@@AA@@@@@@@A@@A@@@AA@@"]],encodeOffsets:[[[112359,38706]],[[111993,38317]]]}},{type:"Feature",id:"610826",properties:{name:"绥德县",cp:[110.263362,37.50294],childNum:1},geometry:{type:"Polygon",coordinates:["@@AB@@C@A@AAA@@@@@@@@A@A@@AAA@A@@A@@@@CA@@AA@AAC@@@@A@CD@B@@AB@BCB@BAB@@@B@B@@A@@@@ACAA@C@@@AA@@@AA@A@A@@@@A@@@@A@@A@@@@@@A@@@@A@@@@A@@@@A@@@@@A@@A@@@C@@@A@AB@@@BA@AB@@@@@@AA@@@@@@A@AB@@EBA@ABA@ABAB@@C@CBAB@@A@@@@@@@@BA@@B@DA@@@CD@@AB@@@@@@@@A@@@@@A@@@@@A@A@A@@@C@@AAAA@@A@@A@ABA@@@@A@@AA@A@@@@A@@@@@@AA@@@A@@@EAA@@@A@EBGB@@AAAA@BA@@BA@A@@ACCA@@AAC@A@A@A@A@@@@A@EB@@ABCB@@@@AB@B@@C@@@A@@BA@@B@@@B@DAB@@CB@@@@A@@@A@@@@BA@ABA@@@@B@BA@ABCBA@C@@@A@A@@@AAAAAAA@@@@@@@@F@DB@@@A@@BA@@@A@A@A@@@@B@@B@@B@@@@A@A@A@@@ABB@@B@BB@A@@@@BA@@@AAEAACA@@B@B@B@BB@@B@@@@@@DD@@@@A@@@A@A@A@E@A@@@CBA@@@A@@@A@A@A@A@@@@@@BB@@B@@@BB@ADA@@@@BA@@@BB@@@@@B@B@@@B@@@B@@AB@@A@@@@@AAA@@@@BA@A@AB@@@@AAEC@@A@@@@BAD@D@BA@@D@@A@C@@@A@A@@BA@@BAB@BA@AB@@@B@B@@@BA@A@@@A@@AAA@@@A@@@A@@@ABA@@A@E@ABA@AAA@@@@C@A@@BC@@BA@@@@BABE@A@AA@A@A@A@CA@@A@@BA@E@CAC@@A@AAC@@@A@@@C@@AA@@A@CBA@ABA@@A@@@@A@@@AB@@A@@@A@A@@@@@@@@@@AB@@@@@@@C@@@@AA@@A@@@A@@@ABA@@A@A@@AAAAA@@@@A@@@A@ABAB@@A@@@EA@@@@ABA@A@C@A@@@@A@@@AAAA@A@AAA@A@@A@@B@@@@@@@@@AA@@B@B@@@B@@@BA@@@@@A@@AAA@A@@@A@@@@@DDB@@@A@C@A@ADAD@@A@@@@@@B@@@@@BAB@@@@AB@@@B@@BB@@B@@DAB@BBBA@@B@@A@@@@@@@BBB@@B@BBB@@BBB@@@B@@AB@@@B@@B@@@B@@A@@BAD@@AB@B@@@@@@@B@@@@@BB@B@B@BBBB@@B@AB@@@BBB@@BB@BBBB@@D@BB@BBJDB@B@B@BBB@@B@B@@@@ABA@AA@AA@@@A@A@ABA@A@AD@B@B@@C@@B@@@B@BBB@@B@@@D@@@@@ABA@ABABABCBA@@@@B@BB@@@B@@@BADB@@B@B@@@B@B@@@BB@@BBB@BBD@B@@@BD@@BB@@BD@@B@@BD@B@@@B@@B@@@@B@@@@@@B@@@B@@@@B@@@B@BB@BAB@B@B@@@@B@@B@BAD@@@B@B@B@B@@AB@@A@@@@A@@AAA@@@@@@BABCF@B@@@@DB@@@@AB@BABA@@A@BA@A@A@ABABABAB@@@A@@AB@@@@@B@@A@A@CAA@AAA@A@AB@@AB@@C@A@GAA@@@@@@@@B@@ABCBA@@@@BA@@BABA@@@@@A@C@@A@@@A@@A@@@AB@@A@@@@A@@@A@@@@C@A@C@@@CBA@@@@A@A@A@@@@C@CACA@@A@A@CAAA@@BABA@AA@@AA@AA@@@ABA@AA@@@@@AB@@CB@@A@C@A@A@A@AB@@A@@A@@AAA@AAABAAA@@AA@A@@@ABA@@@A@@AAAAAA@@@A@A@ABA@@@@AAA@@@@A@@@A@@@CC@@A@A@C@@A@@@AA@CACCC@AAA@A@@@AAAAA@@B@@AB@@@D@B@@@@@B@B@@EBCDA@@AA@@@@@ABAB@@@D@B@@@B@@@BCD@@A@A@@@AB@@A@@BABA@ABAD@BAB@@@@@@A@AAA@AB@@A@@@A@@@A@@BAA@@A@CAB@@@@A@A@A@A@@A@@@@@A@AAACA@@@CA@@AA@@A@@C@A@ABAAA@@BCBA@@@AA@A@@A@A@@AAA@@@@@@@AB@@ABA@@@A@@@@@AA@A@@@@@A@A@@@@CA@@AA@@A@A@@@A@ABABA@A@@B@@ABADCD@@@D@@A@B@AD@@@@@@@B@@ABB@@@AB@@@B@@A@@B@@AB@B@@@B@@A@@@@B@@@B@B@BBB@@BDBBB@@B@D@@@@A@@@AA@@A@@@A@AB@B@D@@ADAB@BCDAB@B@@AB@BA@@B@B@B@@AD@@@@@@@B@@@B@@@@@B@@@B@@@B@B@@@@@B@@AB@@C@@BAB@F@BBDBBHBFDB@B@B@@@B@@B@B@DAF@D@@@BA@GFABCDADAB@@A@CAA@KA@@ACA@CB@@EA@@A@@@@@ABC@ABC@CBA@@@EF@@@@@DAB@BA@@@CBE@A@A@@B@BB@@BDD@@ADB@@@BB@@@BADAB@B@B@@B@@@BAB@B@B@B@D@D@@ABABCB@BAB@BB@@@B@BAB@@@@BBBB@@AB@@@@B@@@B@B@FADAB@FCB@D@@@@@@BCBABCFAB@@B@@@BB@@B@@@BA@@@CBCDA@A@@@@BB@B@@BBBF@@@BDB@@BBB@HAB@@@@@@BAB@B@B@@@B@BB@@BBBDBBB@B@BAF@B@@@B@B@B@@@B@@@B@@A@@@@BB@@BB@@B@@@B@@@@BB@BB@@B@BBB@@@B@B@BAB@@@@B@BB@@B@ABAD@BA@@B@@@B@B@@@@@B@@AB@@ABABAB@@A@AB@@@B@B@BAB@BA@A@A@A@A@A@@BA@@@@@ABAB@BAB@DAB@@@BC@@B@@@BA@@B@@BB@B@BAB@@B@B@@@@@@B@@AD@BA@@@@BD@@@@@@@@BAB@@A@@@@BA@@@DB@@@@A@AB@@AB@@B@AB@B@@@@@B@@@D@BBD@@BB@@@@BAB@@@BB@@@@@BBB@B@@@B@DBB@@@B@@@@@@A@@D@B@@BB@B@@@B@@A@AB@@@@@B@@@B@B@B@@@BBB@B@B@B@@AB@@@BB@@BAB@@ABCB@@@B@B@BBD@@ABCD@@BB@@@@@BBB@@CB@BA@CB@@A@BH@@@B@@@@ABA@AB@B@@@B@@@@@@@B@BA@@DA@@B@@@@DBBB@@@@@B@@A@@@@@@A@@A@@@@@AB@@@A@@@@A@@@@@@B@B@@@@BB@@B@B@B@B@B@@@@@AB@@@B@@@@BAD@@@@B@B@BAD@B@BA@@@@BA@@@BD@@@BB@D@@@B@@@@B@DA@AD@B@B@@@@@BC@A@@@@B@@BD@@BB@B@@BB@BAB@B@@BBDFBB@@BBB@BBB@B@D@@BB@B@@@DBB@@BB@@BA@@BCDADA@@@@B@D@B@D@@@@CB@@DBB@D@B@B@@@B@B@@@@@@@@@B@@@B@DBF@B@B@@@@B@B@@B@@@D@B@@@BA@@B@B@B@BAB@B@@@DAB@B@B@@ABA@@D@@@B@B@B@BBBB@BF@@@B@BBBB@@@B@@@B@B@BB@BD@BB@@@B@@AB@BC@@B@@@D@DB@@@@B@@ABA@A@@@@DA@@B@BC@@FABADAD@B@@ABC@@BABABA@ABAB@@@@@@C@@B@@@BA@@@AA@@AB@@A@@B@BA@@@A@@@AB@D@B@@@BA@A@@@AA@BA@ADADABA@ABC@@BABAD@@ABABAB@B@@@BA@@BA@@B@DA@@@@B@BAB@BAD@@@B@@@B@DC@@@@DB@BB@B@B@BB@@@B@@@B@BAB@@@B@B@@BB@@B@DBB@BBB@@@BBBBBB@@@B@BBDBD@B@@@@@B@@DA@@B@BA@@F@@BB@B@BBDB@@BBB@@@@@@BAB@@AB@B@B@@@BAB@DA@@BA@@B@@DDB@@BB@BBB@BA@@DBBB@@@B@@@B@BB@@BBBB@BBD@D@B@DBBBBBFBDBB@DA@@DAB@@ABAFE@ABAB@@AFB@@@BB@D@@@BC@@BAB@BAJ@BA@@B@BBB@BB@B@DB@@B@@B@B@FBHBHD@BB@DD@@BB@BBBBBBB@BB@FAB@BBJBB@D@F@BABABA@@@A@@@A@@@A@A@ABA@A@ABE@@BA@AB@BAB@DC@AB@@@FB@@@ADCBADCBAB@@A@@BAB@B@B@B@DBBBB@@BBBB@BBBBDBBBD@DBFBFBHBDBDDFFFDBBBB@D@B@B@BAD@@@B@@B@@@AB@@@@BB@@@@B@@B@@AB@B@@A@@@@BB@@@@B@@@BABBBBB@BBBBD@BB@@@FABA@@B@BB@@BDB@BBBBBB@BD@B@B@BB@@DDD@DBBAB@DBBBBB@@B@BB@@@BBA@@B@@@@AB@D@B@@ABB@@@@B@@AB@BB@@B@@@@@B@B@BD@@B@@@@@BB@@@@@B@@@@HDB@B@@@B@FB@@@DBB@@@BBA@@@@B@BBB@@@B@@A@A@ABAB@F@@@@@@B@@B@@@@@B@B@@@@@B@@@@B@B@@@@@@B@@@@B@@B@@@H@B@B@@B@@@A@C@AB@@A@@@@@A@@@@D@@@BABA@ABC@A@A@ABAB@@ADBB@B@BAB@@@@A@@AAAA@@@@@AB@DA@@@@@EAAAA@@@AAABACA@A@@B@@@@A@BBB@@B@@@@@@BA@@@BB@@BB@BBD@@@@@@@E@E@@D@DB@BA@@B@@@B@B@B@@HA@@B@@@B@B@@@B@@@DB@D@BB@@@B@B@B@DD@@@@@ABA@@@AB@@AB@B@B@DD@@B@@ABAD@BA@@BA@A@ADEB@BC@@BA@@BAB@@@@A@@@@@AB@@AB@AAC@@@@CAE@A@@@ABA@@B@@AB@DE@@@@@@@CAA@@@CAA@A@ABC@@@@@CBA@@BADADABABA@@@CBA@ABAB@B@BAB@@AB@B@B@BBB@DBB@B@@@B@@@B@B@@@@@@A@@CACC@A@C@C@A@A@@@C@@@AB@@AB@@AD@@@@@@@A@@@@A@@@A@@B@@@B@B@B@@A@@@@@@@EB@@C@@@A@A@@@AA@@@AA@EAAAA@A@AAA@A@ABA@@@ABABA@A@@@A@@B@D@@@BBBBB@@@DBBDBBBBB@@BB@D@D@B@B@B@@@BABA@@B@B@FB@@BABABA@ABAB@@@AAAA@@A@@A@@BAB@@AB@@A@@@A@@@A@@@@@ABABA@@BAD@@@B@@B@@@D@@@B@@B@ABBB@@@B@@BAB@B@@BB@B@B@@@@B@@@@@@DB@@@B@@B@@@@A@_@AA@A@A@A@E@A@E@EAE@A@A@C@@AA@@AAA@CAE@@BA@@FCD@@A@A@C@@@C@A@A@@@@@A@A@@B@@A@@B@BAB@@ABA@@B@@@@@B@@@@@@@B@@AB@@AB@@@@A@@@AB@@A@A@@@A@A@@@@@@@@A@A@A@A@C@@@A@A@A@C@C@AAABC@@@A@@A@A@A@C@@@A@C@A@@FAB@B@B@B@@@@AB@@@BA@@B@@A@@BAB@@@@E@@BCDI@@@AB@BA@@BABA@@BA@AFCBC@@@A@@A@CAA@BA@@@A@ABA@ABA@ABA@@DABAFAB@B@BA@@BA@A@CBCDA@@@@A@@@@@@@@AB@@A@@@AAA@AAC@A@AA@@@@@CDCBAD@@@@@@A@@AAE@@DABCBA@@@A@ABC@AAA@A@@@A@@@AB@DA@A@A@A@@BAJCHE@AFCBC@@@A@@@A@@@AB@D@@@BAB@BA@C@@@AA@AA@A@@@CAC@@A@@@@@@@@@A@@BA@@@@@@@AA@@@@@@A@@@@B@@@@ABEBA@@@@@A@A@@@A@@@A@A@@@@@CAC@@AAB@@CAAAC@E@AAA@ABA@@@@ADE@CBC@@@@@AAC@ABCBAB@D@BA@@B@@@@A@@@A@@@@@@@A@@@@BA@@B@AA@@@A@@@A@@@@@@@@@@@@AA@@B@@@AA@@B@AA@@@@@@@@@A@@A@@@BAAA@@B@@@@@@AA@B@@@@@@AB@@@@@@@@BB@@AB@@B@AB@@BBA@B@AB@@@BB@@BAB@BAD@D@F@D@B@B@B@@@B@B@@@B@B@BABB@A@@@@DCBAB@B@B@@@@A@A@@BBBAA@@@@AB@@@B@BA@AA@@A@@@A@AB@@@B@@@@@@A@CA@E@GAKCCCCACIAUCK@@CGEKEIAI@C@EAK@@BUFIBC@@NKVE@@ZCF@N@PC@@ZG@@HCB@@A@AB@@CBAAGCK@@IMKEKE@@GCAE@E@@DIHIHGFE@I@GCEICEA@@OBM@G@AAECCEAC@@CBA@A@AA@@CBCBC@@@@B@B@D@BB@@BB@@B@@D@@BBB@@@B@@@@BBBB@@@@@B@BAB@@@BAD@B@@@@@@@BABAB@BABABCDAB@BAB@@@@A@@B@B@BBHB@@B@B@@A@ABA@@@@A@A@@A@@@CA@AA@@@A@CDC@@BA@C@C@C@@@AAAA@@@@A@@B@@@@@@A@@@@@@@@@A@@@AA@@@A@@@BA@A@@@A@@@AAA@@@@A@@@A@@@@AAA@CA@@@C@A@@@@A@CB@@@B@B@@A@@@C@@@@B@@BBB@@@@B@@@@A@C@A@@@A@@B@@BB@BA@@@@B@B@@@@A@@AA@@@@B@@@DBB@@@@C@@B@@@B@@@B@@BBBBB@@@BBBB@DBB@@DB@@BD@@@BA@@@@AAA@@A@@@@@A@@@@BA@@@ACC@@@@@@BB@BB@@A@@@A@@@BB@BA@@@ABA@@B@@A@@@AB@B@BA@ABABABAB@@AAAA@@@@AB@@@@AA@AA@C@C@@@AA@@@A@@CDA@A@@@A@@B@@@@A@@@A@@@A@@BA@@@@@@A@@@AA@@@A@C@@@B@BCA@@@ABABA@@@A@@@CA@@C@@AAAAA@AA@@@@@@A@A@@BA@@@@@A@A@@@ABC@A@A@@B@B@@@@@BB@@@@B@@@@ABC@A@@@C@@@@B@DB@@BB@BBAB@BAD@D@@@D@@@B@B@@@@@CB@@@B@BBB@BDB@BBB@B@@B@@@B@BCB@@A@@@AB@@ABC@@@@C@@@@A@A@C@@@A@@@@A@@BA@@@A@@@@@BA@@@@AA@@@A@@@@B@@@@@@@@A@@AAA@AA@@AA@@@C@A@ABA@@@A@@@@A@CBA@@@@AAA@@A@@@A@@@@B@@A@@A@A@A@C@@@@C@A@A@CBA@A@A@A@@@AAA@A@AB@@AA@@B@@AA@@@C@AA@@@@@C@@@@A@@@AB@AA@@@A@@@@@A@CAA@A@CA@A@@@C@A@A@@@ACCA@AC@A@@AAAA@@@@AA@@@AAB@@@@ADABCD@@A@@@A@@AAAACAAA@AA@A@AA@B@@A@@@@A@@@A@A@A@ABCBA@@AA@A@@@@@@B@@BB@@@B@@@@A@@@AB@@AB@@@@@BB@A@AB@B@B@@@@B@B@@@@@@B@D@B@B@D@B@@B@@B@@@DAB@BEBABA@AB@@"],encodeOffsets:[[113161,38198]]}},{type:"Feature",id:"610824",properties:{name:"靖边县",cp:[108.793988,37.599438],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@D@@@@B@@@BB@@@D@@@B@BB@@AA@A@@A@BA@@B@BA@@@AB@@B@@@BB@@@@@BA@@@AB@@AB@BB@@@ABA@A@@@@@@@C@@@A@@BA@@A@@A@@@@B@DA@@@@B@BA@@B@@AB@B@@@@@B@B@@@B@B@@BB@BB@BBB@@@@B@B@@A@@B@@@BA@A@@@AB@@ABA@@@@B@@@@@BA@A@@@A@@@GB@@AB@@@@AB@@A@@@@@AB@@A@@BA@@A@@@@AA@A@@@@@@@A@A@CB@@AA@@A@A@@@@AA@@@A@@@A@@@@@@@A@@@@@@@A@A@@AAA@@@A@A@@@@@@@A@@@@@@@A@@B@A@BAA@@@@@@A@@@AA@@@@A@@@@@A@@@@AA@@@@AA@@@A@A@@AAAA@AB@@AB@B@@@B@@BB@BA@@B@@@@@@@B@@@@A@@B@@@@@@@@AB@@@@@@A@@A@AA@A@@@@@@@AA@@@AA@@A@@@A@A@A@@@@@@AA@C@A@AAA@A@A@@@AAA@@@@A@@@A@@@@@@@A@@@@@@BA@@@A@A@@A@BA@@@@@@@AA@B@AA@@@@@@@A@@A@@A@@@@@@@@AC@@ABAB@@AB@@A@A@@@AA@@A@@@A@@@@AA@@@@@@A@@@A@A@BC@@@@AA@@@@@@@A@@@A@@A@@@@@AA@@AA@@@@A@@AA@@@@@A@A@@A@@@@@A@ABC@@B@@A@A@@@@BA@@@@B@@@@@BA@A@@D@BB@@B@@@B@@BB@@@B@B@@BB@BAB@@E@@@AB@@@@A@@AA@A@@@AA@AA@@A@@A@@@AA@AA@@@A@@@A@@@@A@@A@A@@AA@A@@@A@A@@@@@A@@@A@A@@@A@@@A@A@@@A@@@A@@@CB@@@@A@A@A@A@@B@@@@@@AB@@A@@@@@@@A@AB@@A@@@@BA@AB@@A@@@@@@@A@@@@@A@@@ABBBA@@@@@A@@@@@@B@@A@@@A@@@@@A@@@@@@@A@@AA@A@@@A@@@@@A@@@ABA@@@ABA@A@AB@@@B@F@@AD@BAB@B@BA@@@AB@@@AA@@A@ABCAAA@A@@@@B@@A@ADA@@@CB@BA@A@@A@@@C@A@A@@@@A@@@A@@@AB@@AB@@@@A@A@@@AB@AA@@@@@@@A@@B@@@@A@@@@B@@A@@@@@@@@BA@A@@@@@@BA@@@@@AB@@@@@@@@@@A@@B@AAB@@A@@B@@@B@@@@A@@B@@@@@@@@@BB@B@@@BB@@AB@@BB@B@@AB@@@B@@BD@@@B@@CB@@A@AD@BAD@BABAB@AA@@A@@AB@@@B@@@@AB@BA@@@@BAB@@CB@@@@@B@BAD@@AB@D@@A@@@@@@@@@AA@@@@@@A@AA@@AB@A@@A@@BAAAB@@A@@AA@@@BBA@@AAB@@AA@@AA@@AA@@@@@AA@AA@@A@@@@A@@C@AAC@@A@@A@A@@AC@@@A@@@A@@@C@@@C@@@A@@BB@@@@B@@A@@@A@A@A@@@AA@@A@@@@B@@@@@@@BAA@@A@@@@@@B@@A@@@@@@@AC@@A@@@@@BB@BA@A@A@A@@B@@@@AA@AAAAC@A@CAA@@AC@@@@@A@A@@@@@@@@A@@A@@A@@@@A@@@@@AAAB@AA@@@AA@@@@A@@A@@AB@@@@A@@@@C@A@@@A@AAAAA@@@@A@@B@@@B@B@B@@@@@AA@AAACA@A@@BABA@@DEA@@AA@@A@@@A@A@@AA@@@A@@@A@@A@@@A@@@@@A@@@@@AB@DA@@@AB@B@@C@@@@B@@C@@@@B@@@@AACAAA@@A@@@A@A@AA@@C@AAAACAEC@AA@@C@@@@A@@BAB@BA@AB@@@@@B@@@@@B@B@@@@@B@@@B@@@B@@@@@@@@AA@@A@@@@@@A@@@@A@@@@AA@@@@@A@@A@@A@@@A@@@@@A@A@@@AAC@C@@@@ABA@A@A@@@@A@CBAA@B@BA@A@@@@@A@A@ABA@AB@B@@@@A@A@AA@@@AA@BAA@@AA@@A@@@ACB@@@@A@@@@BA@@@@BA@@BA@@B@@CB@BA@C@@B@@@@A@AA@@AB@@@@A@@@@@@BBB@@@@C@@@A@ABA@@@ABA@@@C@ABC@A@@B@@@B@B@B@BA@@@A@A@@@A@@@@@A@@@@AA@@@@@@AA@@@@AA@@AACCBC@ABABCBA@A@@@ABA@@B@BA@@B@BAD@BAB@@@BA@@BAB@B@@AB@B@BBD@B@B@@FBB@B@@B@BBB@@D@@BB@@@@@@BB@@@B@D@BADB@BB@@BD@@@B@@@@@@A@@@@@@@A@@AAB@@A@A@@@@@AAA@A@A@@@@D@B@BAB@B@BBB@@@@@BBBAB@@@@BBBB@B@@BA@@B@B@@@B@@@BAB@D@BABADB@@@DBBB@B@BB@@@B@B@@A@A@@@AD@B@B@@AD@@@B@B@BB@@@@@@B@@@@@B@@@@AB@@@@@BB@@@B@BB@B@B@@@BF@@@@BAD@@@BB@@@B@@@@@B@@A@BBA@@B@B@@@BB@ABB@@@A@@@B@@@AD@@A@AB@B@BAB@@@@E@@@AB@B@B@B@B@@BBBBB@@@@BBB@@B@@BB@B@BBF@BA@BB@@B@D@@@B@B@BABEJ@@BB@A@B@@AD@@@B@@B@@B@@@@BBBA@@B@@@FBBB@B@B@D@B@@ABCB@B@BABAB@@@B@@@@@@A@@B@@@BA@@B@BCDCBCF@@@B@B@B@@CB@B@B@@AB@@AB@BA@@B@D@@@BB@@BDD@D@B@BAB@@AB@B@B@@A@@BABAB@BBDBD@@BB@BB@BBB@B@BB@@BFBBBBA@ADAB@@@BDDBBB@@@BB@B@@@D@D@BBBB@BBBBB@@@B@@B@B@@CF@@CF@DABAD@@@B@@@D@@@@A@@@@@AA@@AA@@A@@B@B@B@B@@E@AB@@AB@B@BAD@BA@@BABA@A@C@A@@@AAA@AB@@@@AA@@@@@A@A@@@A@C@A@AAA@A@@A@A@ABA@@BBD@BAB@DAB@BAB@@@@@BBB@BA@@@@DAFCBABABAB@@AB@@@D@D@BB@@@@B@@@B@@CDABA@@B@B@B@@@@BB@DBB@BB@@B@BB@BB@BB@@@BBFB@@@BABA@ADABABC@@@A@C@@@A@CBA@ABAB@@A@A@@@A@@@AB@@AAE@@AAA@@A@A@@AA@@@A@A@AA@@A@@@@@A@@BA@A@@@B@@BA@@@@BA@@@AB@@@BA@@B@@@@@@A@@B@@@@@@@BA@@@@@@BCA@AA@@@A@ADA@@@A@A@@@@BADA@@@A@C@A@CAA@@@@B@@@@A@@BABBB@@AB@@AB@@@@@BABABAB@BC@@B@@@BA@A@@@@BAB@@A@A@@B@@B@@@DDB@B@@@BB@@DBB@BB@@B@@@BB@@@@BB@@B@@@B@D@B@BAB@@@B@BABABA@@BB@B@BBBBB@@@@@B@D@B@@AB@@AB@@@@@B@@@B@@B@B@FAB@B@D@B@BA@@B@@B@@A@@B@@@B@@@B@D@D@@@@CA@@@B@D@@@B@@@BA@@@ABABA@@@@BABAB@@ABA@AA@@A@@BAB@@@@ABAAA@E@AA@B@@A@@@@BCBAB@@AB@@A@@@@@A@@@@@BB@@@B@@A@@@C@@@@@AB@@AB@@@@A@@B@B@B@@@@@B@@AB@@DD@BBD@@@@@BA@@@A@AB@@A@@BC@AB@BA@@@AB@@AB@@A@@@AB@@@@AB@@A@A@@AA@@@@@A@@@@@@@ABB@@B@@A@BB@@@@@BB@@B@@@B@@AB@@AB@B@@ABAB@@@@AB@@@B@@@BB@@@@@@B@@@B@@@BBB@@@B@@A@ABA@@@A@A@@@AB@@B@@B@@@B@@@@@@@@@@BB@B@BB@@B@F@@@@ADA@@B@@B@@B@@@@@@AB@@@@@BA@@B@@AB@@A@AB@@@B@@@B@@@B@@@D@@@@BB@@@@@B@BBBBB@B@D@BBB@DB@@B@@AB@@AB@@@@@@BB@@BB@@@B@@@B@B@BA@AB@@@@@B@@@B@@@BBB@@A@@BB@@@@B@@@BAD@BA@AB@@AB@B@BA@ABA@@B@@@B@@@@AB@@ABA@AB@@@@@BA@@@@B@B@B@@@@AD@@@B@@@BA@ABAB@@C@A@A@A@A@@@A@@A@A@@BAA@@@@AA@A@@@@@@AB@@A@@@@@A@@@@A@AB@@AA@@@@BA@@@@@@B@@ABAAAB@@AAA@@@AB@@EBAA@@A@@@@EA@@A@@@DC@AAA@A@@@A@@@@BA@@BA@A@@@@@@A@A@CBA@@@C@CBE@A@@@ABA@@BA@ABC@ABEBA@@@@@A@@A@A@@@AA@@@A@@@@@AD@B@@@@A@@@A@@BA@C@ABA@@@A@A@AAA@@@@@A@AA@@@A@@@@A@@@@BA@AAAA@@A@@@A@A@@@A@A@AD@B@@@B@@@B@@@@@@@B@BBBB@@@@BB@@@BBB@@@@BB@@@@B@@@@BB@@@@BB@@@@@@BB@@BB@@@B@@@B@BB@@BBBA@@@A@@@@@ABCB@FAF@BAD@@@BBBBB@B@B@B@D@BADABCDAD@@@BBBB@BBB@D@F@@@BBBDBB@@B@D@@@B@@BAD@DABBB@@@@FBB@AB@@EBABCDABGBA@IFCBA@AB@@CBGDA@CDABA@@BE@@@@BABB@BBFBBB@@@B@B@@@D@@@DAD@B@F@B@B@@@B@BBB@BCB@@EB@@@B@BBH@BBH@BAB@D@BBB@@@B@@@BBB@D@B@B@@@B@BA@@B@B@B@B@@BB@@@B@B@B@D@DADAH@@@@C@@@@BAJ@B@@EDA@AB@@@B@B@@@B@B@BDDBB@B@@ABCDADABEDEDABABEBABCD@@BB@@BB@@BB@@AB@B@@A@@BA@A@A@C@@@A@CDKHCDEFCFA@ADCB@BABAB@@@BB@BB@@@B@D@BAJ@B@@B@FBB@@@@@@B@B@B@@BB@BBF@@BD@BBB@B@@@@C@A@@BA@@B@BBF@D@@BB@@FDBBBB@B@B@@AB@B@@@@@@B@D@B@B@@@B@J@PAL@JBNFNHJDJBJAF@dB^D`DLBdLB@VLPH`RB@BBRHVDfJdLF@JA@@JC@@\\KfSPGt]RCR@bHVFXHnJTDNLNJJDDDTBN@@@J@RARER@@@TDDDLHNX@PCRGVADADAJA\\@PDLHLLPLL@JBHAJ@BADCLGTAX@~@B@\\H~@XBF@PBRFPLXZnJNBHBD@NEJ@BAFAFCF@@GHABcRBl@F@@@bODAHAFADC@@HETMXYBUBEDEFQ@@HUJKNIXOBGBODIDMJMNM@@BADAD@H@FD@@@@BG@C@@BA@@BABAHAFADAD@@A@@@@@AAA@@@ACC@@CC@@AC@@@@AAACAA@A@A@@@AB@BAFCDC@ADCBADC@@B@DAB@DAB@B@BB@@DDDFBBBB@BDB@BBB@B@D@@@BB@FC@@B@BB@BBDBBB@@@D@B@@@D@B@B@B@DAB@@@DBBBB@BA@@DAB@B@HCDAD@BAH@F@FDB@JDB@J@F@@@B@D@D@BBBB@B@B@D@BBB@BB@@@@B@@@B@B@B@@B@B@@@FAB@B@B@B@@@FB@@B@B@B@D@H@@@@@A@AG@A@A@@D@@@@@@AAEAEBA@@@@@G@G@E@C@@@@BABADA@@BA@@@A@ABA@@B@B@DAB@DA@ABC@@B@B@@@B@BA@@AAA@@A@@@@BAB@B@BBDBJHBBBBB@FBF@D@B@D@DABABABEDC@@DC@ADC@@B@@@@B@@@B@@AB@DABABEDA@@BAD@DB@@BB@HAH@DAB@BAD@BBBAB@FEBAB@DA@AB@@@DB@@@B@B@@B@@@@AB@@A@ABABA@@BA@AB@@A@@A@A@A@@@A@@ACCA@@@AA@@BA@A@@CAAA@A@@DC@@@A@@@A@@@A@@DAB@BABA@A@A@@@@A@@@@BA@CB@B@A@@@@BE@@@A@@@AA@@@C@@AB@BABABAB@DABB@A@@@@@AA@@@CBEB@@C@A@@@CBCBE@A@@@CA@@A@C@ABA@ABA@C@AAECAAC@A@C@A@AA@@@A@ABAA@@ACAAA@@@A@A@@@@A@CB@@A@AA@@@@@A@A@ABABCDABABABCBCB@@ABA@@D@B@@ABC@@@A@@@@CBA@@@CACEA@CCA@ACAAA@A@A@E@@@@@@ADCB@BAFEHEDA@A@AAA@CAA@A@A@A@E@CAG@C@G@C@A@AAE@@@@AAAGAG@AC@ECAACAEACAEACCICGCCAACAA@@@A@AB@@AB@@@@AA@@A@@@ABA@AAA@A@@@AB@@@@A@@@@CAA@A@A@A@@@@ADCDCBCBA@@BA@A@@@@AAABA@A@@@@@@@@ABC@A@@@@A@@AAB@@AAACA@@A@ACA@@A@AG@@CAA@C@AC@ABA@AAC@A@AAE@@BABCAAA@AAG@A@@ABCAAAAACAC@AAC@A@C@A@ABA@@BADEDABA@@@AD@H@B@F@D@@@BAB@B@D@B@B@B@D@BBB@B@BA@@D@BBB@FBB@B@D@@@DBDDBBB@DBDBHDB@B@B@B@DAFEB@@AB@@@@AFCFAHABADA@AB@@@@AA@CAAAA@@B@@AA@@BAA@@A@@A@A@@@@E@@AAA@@AA@@@BA@AA@C@AAC@@@AABAA@@AA@AA@@BAB@@@BBB@BA@@@A@@AAB@@@B@@@DH@@B@BBBBBBB@@B@BBBBBBB@@@@@BB@B@B@D@BA@@B@@A@A@EBC@CBA@@DAHCFCB@DCDABALEDADA@AD@BABADCB@B@DA@@BABAB@BABAB@DABAB@@@BAFABADAJCJAJCDAF@LCFA@ADAB@@@B@B@BBB@@@B@BA@@@@CAAAAA@@@A@@BAB@BA@@B@@AFAB@DADAD@D@BAB@DEBABABA@@BB@@@@BB@B@D@@@@@@BBD@@@B@B@B@@@B@@@@A@@@ABABC@AA@@A@A@@BCB@@A@@@A@@@C@A@@@ADABCBCB@BA@ABA@@@C@@@@BA@@@A@A@@AAAC@C@A@ABCFA@A@@BC@A@ABA@@B@B@@@DB@@B@@@@A@CAC@@@C@@AA@@@@BAA@@@@@@ABAB@@ABA@@@AAA@@@AB@@A@A@A@@@@C@@@ABCBA@@@AA@@A@BCAA@@AC@@@@@A@@@ABABA@@FGB@BA@@@@@A@@@ABAB@BA@@@@@@@AE@AAA@@A@A@@@ABA@@@A@A@@@@@AB@DEBABA@@@A@A@@AC@@@A@ABAB@DABAFADA@@BA@@@A@@A@@A@A@A@@@A@@BADA@@@AB@BABA@ABA@A@A@A@@DCB@@@BADCB@B@@@@@B@B@@@B@BCB@@@AA@@AA@@@C@CBA@A@@A@AB@@@@@BA@A@CAAAA@@AAA@CBA@@DA@A@@@A@A@A@A@AAA@@@A@ABA@A@@@A@@GA@@AA@AAA@A@CA@BCBC@C@ABC@@A@A@A@A@AA@A@AAAAA@@@@A@@@AB@@A@@@A@AAAA@@BA@CBA@AFCBCB@@ABA@ABA@A@@A@@@@AB@@@@AD@@ABA@AB@B@B@@@@@AA@A@@@ABABAD@BAB@@@B@B@@@BBD@@BBDB@@@B@F@@@BABA@AB@BA@@B@B@@ABAD@@A@@AA@@@@B@BABA@@@A@@@AA@BCBA@@FGBA@@BAF@B@BABCAABA@AB@BABADCB@@@DAFAC@A@A@AA@@@A@@B@B@BA@@AA@AA@C@C@A@A@@@@A@@BA@A@@B@@@@A@@BADABC@@BA@@@AB@B@DA@@BA@@A@A@A@C@E@CBABCBABA@AB@@@@@@@@BB@B@B@@ABA@AB@@CAAAA@CAC@C@A@CBA@A@AB@BA@C@A@A@@@@AA@@A@@@@@@@A@@@A@@@@BAA@@A@@@A@@@A@@@@@A@@@@@@@A@@@@AA@A@@@ABA@A@@BA@A@@@C@CA@@@A@@@@@AB@BA@@@AA@A@@A@@@CDCBA@ABA@ABA@AB@@A@AB@BA@A@@@AACAA@A@EB@@A@@A@@A@@A@AAA@AAAAAC@C@@@CB@@CDA@@BA@CD@@@@AAA@A@@AAA@BC@CB@AA@BA@@A@A@@@AABAAAE@CA@@B@BA@@BA@@@@@AA@AAA@A@BC@@A@@AB@@@BA@@CE@A@A@A@A@A@@@AA@A@ABABAF@@A@A@A@A@@@A@AB@@A@@AAA@@@@A@ABABCDAB@@CB@@A@@@A@@@AAAB@@ADA@@AA@ECCCA@AA@AEAAAAAA@AB@@@@AAAAA@A@CB@@A@A@CDAB@@@@AB@BC@@BC@@@A@@@@@C@@BC@A@@@A@@@A@A@A@BA@@@@@@B@AA@@BA@@@@@A@@@@@@B@@AB@@A@@@@CC@@ACBABABABC@AB@B@BAB@@A@@@@@@@@A@C@@BC@@@@@@B@@A@@@A@@@BAA@@@@AA@A@@@A@ABA@@@A@@A@@@@BAB@@@@AB@@A@@BA@@B@BA@@@@@A@AA@A@AA@@AA@A@A@@A@@AABA@@BA@A@@@ABA@BDBBBB@@@@A@@@@@@B@B@@A@@@AB@@@B@@@@A@AB@@A@CA@@AB@@AB@@A@@@ABA@@@AAA@@@ADA@@@AB@@@A@@@A@@@AB@@A@@A@C@@B@@D@@@@@@@A@ADGFA@C@AB@@A@A@A@@@@@@BA@@@@D@@@@@@A@A@C@@@AB@B@BA@@@@@A@@BC@@@A@A@C@CAA@A@A@@@CD@@AB@A@A@@@@@C@@@@AA@@@A@@@@AA@@@@@A@@A@BAA@AA@@@@AA@@@@@A@@@A@BA@@@AA@@AA@@@AA@@@@@@A@@@@@A@@@@AA@@@@@A@A@@@@A@A@A@A@@@A@AAE@A@CAC@@@@@BB@@DBDB@@@B@B@@ABC@@@CD@@A@A@AAC@A@@@A@@B@@@BB@@B@BA@A@A@@@A@ABA@@@@A@@@A@@@A@@A@@@AAB@@@@A@@A@A@A@@@AA@@B@@@B@@@BA@@@@@A@@B@AA@A@@@@@@@@@C@A@@@@ABCBA@@@A@A@@@@@A@ABAAA@@A@@@AA@@AA@AAB@AA@@@@@@@AAA@@AAA@A@@@@@A@BA@@@@AA@@@@@@AAA@@@AA@A@AA@@@@@@A@@@A@@A@@@BA@@@@@@A@AA@@B@@@A@@A@@A@@AB@@@A@@@@@@AA@@@A@A@@@A@ABAB@@@@@BA@@BA@@BA@A@A@@@@B@@BB@BB@@@@B@@BB@@@B@@@@@@@@B@@B@@@@@@@@BBA@@@@B@@B@@@ABA@@@E@EB@@AAC@A@EBC@@B@B@@@BA@@@@@A@@@A@@@A@C@A@@@@@A@A@@@@@A@A@@@CAA@A@@@ABA@A@@@AB@@@BAB@@A@@@A@@@@A@@AA@A@@AA@@BA@@AA@@@A@@B@@AAA@@BA@@B@@A@A@@@@BA@@@A@@@AA@@@@AA@AA@@@@B@@@@AA@@A@@@@B@@@AA@@@@@@@A@A@@@@A@A@@@B@@AB@A@@@AA@@@@@@@A@@AB@@@@@@AB@@A@@@A@@@@B@@AB@@@@@@A@@BA@AB@@@@@AA@@@A@@A@@@@@@@@@A@@A@@AA@AAA@@@A@ABA@@AA@@@A@@AA@E@@@@@@AB@@A@@@@@AA@A@@@A@A@A@@@A@BE@ABABABA@@@@@A@@@@A@AAA@@@ABADCBAA@@@@@ABAB@@ABA@CA@A@@AB@@AB@B@BAB@@@@@A@AAC@AAAAABCA@@@B@@@B@B@BA@@@A@@@@@@@BAA@@@@@C@@@A@@BA@@B@@AA@@C@@@A@C@C@@@@AA@@@A@BA@@@@@@A@@@@@@A@@@A@@@AA@@@@A@@@A@@B@AA@@@@A@ABA@A@@@AC@@A@@@@@AB@BA@A@A@A@@@@AA@A@ABA@@@@A@@@A@@@A@@AA@@@@@@@@@@@A@@@AA@@@BA@A@@DBB@BBB@@@@@BA@A@@B@B@@@DC@@BAD@BAB@@A@@@@AA@@ABA@A@@A@@@@CA@@@AB@@@@@BE@A@@BABA@@@@BA@@@@A@@@A@@@@B@@A@@@AAA@A@A@@@AB@@@B@@@@A@@A@AA@@@AA@@@@AAAB@@@@A@@@AB@BAB@@@@A@@BA@@@@BA@@@AC@@A@@AAAAA@@@@@@@@@A@@@@A@A@A@@BA@A@@A@@AB@@CBA@ABADA@AB@@@@@@@BA@@B@@A@@@@A@@CB@@AAA@AAA@@@@@A@@@A@@B@@AA@@A@@@@@AA@@A@AA@@AA@@@AAAA@A@AAA@@A@@@@AB@@ABCBA@@@A@@@AB@B@B@@@BA@@@@C@@A@@AAA@@@AA@AC@@AA@@@A@@@A@@@@@@@A@@@@@@A@@ABA@@@@@@@A@@@@AA@@@@@@@A@@@A@@@AB@@@@@AA@@B@@AA@@@AB@@A@@@@@@B@@A@@B@@A@@B@@@B@@@@@B@@@@@B@@@B@@@BA@@@@B@@ABABABA@@BAB@@DBBD@B@BB@AB@@@BA@BB@B@B@@AB@@@BAB@B@@@D@@@@@BA@@B@BA@@BABA@A@ABA@ABA@A@@@AA@@AA@@@BA@@B@@ABBB@BAB@@@BA@@B@@CACE@@@A@A@AAA@A@@@@@AB@@AB@BA@@@AB@@@@AB@@A@A@@@@DABABABA@A@@CA@@AAAAAC@A@@A@AB@@A@ABA@A@@AAG@@DC@@@A@@@A@@@AC@AA@AAABA@@@C@@A@@A@@BA@A@C@@@A@@B@@BB@@A@@@@@A@AA@AA@A@A@@@A@@B@B@@AB@@AA@@ACC@@@@A@@BA@AB@@@@@AAAA@@AA@@@BABAB@@ABA@A@@@A@@B@@@B@B@BA@@@BB@@@D@B@B@@@B@@AB@B@@@BA@@A@@A@BCA@@A@@AAAAA@@AA@AAABA@@@A@A@@AAB@AA@@@@@A@A@AB@@AD@BAB@DA@@B@B@@@B@@@B@BAB@@@B@B@@@B@@@B@@@B@@@@A@@B@B@@@B@DA@@B@@CA@@A@@@A@@@@@C@A@A@@@A@ABC@A@A@@BA@@@A@A@AA@@A@A@@B@@A@A@@@AA@@A@AAA@@A@@@@AA@AB@@ABAB@@A@@BA@CBA@@@A@@A@@AA@A@@@A@@@@@AC@@@AB@@A@A@AB@@@@A@A@@BC@A@A@@@A@@@C@@AA@B@@A@@BA@A@A@@@@@BA@@AA@ABA@@@CBABADA@@@AA@@A@A@AA@@A@@C@@@A@@@@@@AA@@A@@A@@A@A@@@AA@@C@@AA@@@@A@@@ABA@C@@A@@AB@@A@@@@ABA@@AA@CB@@@@AAA@@@A@@@A@AB@@AA@@AB@@@@@A@@A@A@ABA@@@@@ABA@A@@@@@A@@B@@@A@@A@@AA@B@A@@A@@@A@AAA@@@@@A@@A@@@@A@@@A@@@ACA@@@@AA@@@ACA@A@A@@A@@@AA@AA@AAA@@@A@@@@A@@@AA@@A@@@@@@@@@@@A@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@A@@@@@A@@AA@@A@@A@@A@@@@AA@@A@@AB@@@B@@@@@BA@@@A@A@@@B@@B@@@@A@A@@@@BAB@@@@AA@@@@@@AB@B@@B@@BA@@@ABA@@BB@@BA@@@@BA@@AA@@@@B@@@@A@@@@@@BA@@@@BA@@AAB@AAB@A@@A@@@@@A@@A@@A@@A@@AA@@@A@@A@@A@@@@@@AA@@@@@A@@@@@A@@AA@AA@A@@AA@AB@@@B@@@@ABA@@@A@@BABA@@@@@@@ABAB@@B@@BA@@@@@A@@@A@@AAAA@@@@@A@@AA@@@@@@@A@@@AAA@AAA@A@@@@@@AB@@ABA@AB@@@AAB@BA@@@C@@BC@A@ABAA@@@A@CACA@AAA@G@@@@A@@@@AA@BA@@@A@A@G@A@A@A@@A@A@ABABA@AAA@@@AC@A@@BA@@@AA@@AA@@@DEB@@@BABABAB@@ABBFBDBBBB@@BB@@AB@@A@CBC@@@AB@D@@AB@BAB@@@DBB@@BBB@@B@BB@@B@@@@C@C@A@@BA@A@@BA@@@@B@@@@@@@B@@A@@BA@C@@@A@@@AB@@A@@@A@A"],encodeOffsets:[[111388,37944]]}},{type:"Feature",id:"610825",properties:{name:"定边县",cp:[107.601267,37.594612],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@K@IAE@YSC@E@@@@@A@G@A@@@CBCBObAFCBEDCFH@DDFHBHAHMHKJCBYT@@A@@BMJCFORUHYCKCCAICECE@IAIAGDEDKDG@I@GAI@IBB@@IBGBEDCFYTEDCBEDGAEACC]QAAC@C@ABATAFCBGBC@CBA@@@B@@BB@@@B@@@@BB@@@@BB@@B@@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@BB@@@@@B@@@@@@@@B@@@@@@@B@@@@B@@@@@BB@@@@B@@@@@B@@@@BB@@@@@@@BB@@@@@@@B@@@B@@@@@@@@@@@@@@@@@@B@@@@@BA@@@@@@@@@AB@@@@@B@@@@B@@@@@@BB@@@@B@@@@@@AB@@@@@@@@AB@@@@A@@@@@BB@@@@B@@@@@@BB@@@@@@@@@@@@B@@A@@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@@@A@@@@@A@@@@@@@AB@@@@@@@@@B@@@@@@B@@@@@@@@B@@@@A@@@A@@@@@@B@@@@B@@@@@@@B@@@@B@@@@@@@@A@@@@@@B@@@@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@B@@@B@@@B@@@@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@B@@@B@@@@@@@@@@@BBA@@@@@@@@@@B@@@@@@B@@@@@B@@@B@@@B@@@@@@@B@@A@@@A@@@@@@@@@@@@@@@@B@@B@@B@@A@@@@@@@@@AA@@@@@@@B@@@@A@@@@@@@@@@@@@BB@@@@@@@@@@@@A@@B@@@@@@@@@@@B@@@@@@@BA@@@@@@B@@A@@BA@@@@@@@@B@@@@@@@@@B@@@@@@B@@B@@@@A@AB@@@@@@@B@@@@@B@@A@@B@@@@@B@@@@@@@@@@@@B@@B@@@@@B@@@@@@@@@BA@@@AB@@@@@BA@@B@@@@@@@B@@@@B@@@@@B@@B@@@@@@@@A@@@@@@@@@@B@@@@@@@@AB@@@@@B@@@B@@@@@@@@B@@@@@B@@@B@@@@@@@@@ABA@@@@B@@@B@@@@@@@B@B@@B@@@@@B@@B@@@B@@@@A@@@AB@@AB@@@BB@@@@@@B@@@@@@@@AB@@AB@@@B@@@B@@BB@@@B@@@@@@ABA@@@@B@@@@@B@BB@@B@@AB@@@BA@@@A@@@@@@BB@@B@@@B@@@B@@A@@@AA@B@@AB@@A@@B@@@B@@B@@BB@@@@@A@@BA@@@@BB@@@B@@B@@BB@@@@@@ABA@AB@@@@@@@B@B@@@B@BA@ABA@A@ABA@@BA@B@@B@@B@@@B@@@@@B@@AB@B@@@@@BB@B@@@BBD@@@@BB@B@@BB@@@@@BB@@B@@@B@@@B@@@B@@@@@@BB@@B@@@@@AB@@AB@@@BA@@B@@@@@B@@AB@@@B@@@@@B@BA@@@@B@@ABABABA@ABABABBB@BB@BBDB@@@B@DABAB@BAB@D@B@@@@@BA@@@@B@@@@@B@@@@@BA@@BA@BB@B@B@B@BA@AB@@A@@BA@@BA@@BA@@@@B@@@@D@B@DABAB@DBB@FABABAB@B@@@@@@BABABC@ABABCDAB@DAF@BB@@@BBD@@B@@@BA@A@A@AA@@A@@AA@CBC@@@@B@@B@@BB@@@@B@@BBB@@@D@@@B@B@@@@@@B@@@DAF@B@@ABABA@@BA@@BBB@@BBB@D@@B@@B@@@BAB@@@@AB@@A@@@@B@@B@@@@@@BA@@@@@@@AA@@@@@@@B@B@@@@A@@@@@@@@@@@@@@@A@@B@@@@@@@@A@@@@B@@@BA@@@@@@@AB@@A@@@@B@@@@A@@@@@@@A@@@@@@@A@@B@@A@@B@BA@@@@BA@@@@B@@@@BB@@@@@@AB@@@@A@@@@@@B@@@BA@@@@@@@@@@BA@@@@@@@@B@@AB@@@@AB@@A@@@@B@@ABA@@@ABA@@@@@AB@@A@@BA@@@BBB@@@@BB@@B@@B@@@@BB@@@BBB@@@B@@@B@@AB@BB@@@@@@@@@@@@A@@@@BBB@@@B@@@@@@B@@@@@@@B@@@@@@@B@@B@@B@@@@BB@@@@BA@@@@@@@@@BB@@@@@BBB@@@@BBB@BB@@B@@BB@@@@@B@@@@@@BB@@BB@@BBA@@BB@@@@BB@BBB@@BB@@@@@BB@@AB@@@@@B@@@BB@@B@@@B@@@@@B@@A@@@@@@B@@B@@@@@B@@@BB@@B@@B@@@@@B@@A@AD@@@@D@@@B@@@@BA@A@@@@BB@BB@@@@AB@@A@@B@@B@B@@BBB@@@@@@A@CB@@@@@@BB@BBBB@BB@B@@A@@@@BAB@B@@@@@@BBB@B@@@BBA@@B@@A@A@@@@@ABB@@@@B@@B@@@AB@@@@@B@@@@@@@B@@@B@@AB@@@@@@@@@@@@@@@B@@A@@@@@@B@@B@@B@@@@A@@@A@@@@@@B@@@@@@@@@BB@@B@@@@A@@B@@@@@@A@@@A@@@@@@@@BA@@@BB@@@@@@@B@@@@@@@@@BA@@@@@A@@@@B@@@@@@BB@@@@@@AB@A@@A@@@A@@B@@@@@@@@@B@@@B@@@@@@@B@@@@@B@@@@@@@@@@AB@@@@A@@@@B@@@@@B@@@@@B@@@@A@@B@@@@@@@@@B@@A@@@@@@B@@@@A@@@@@A@@@@@@B@@@@A@@@A@@@@@AB@@B@@@@@B@@@@@@@B@@@A@@@@@@@@B@@@@@@@B@@@@@@A@ABA@@@@B@@@@@B@@@B@BA@AA@@A@C@AB@@CDABCBCBCBCBCDGFCBCBCDABAB@@ABGDOHIH@F@DFDLBJBJDFDHATEF@BB@@@@@B@B@@@B@@@B@@@@@BA@@@@@@B@@@@@BB@@@@@@B@@AB@@@@@B@@@@@B@BB@@@@@AB@@@@@@A@@@@@@@@@@@@B@@@B@@@@@@AB@@@@@B@B@BA@@@@@A@@B@@@@@B@@@@@B@B@@@@@@@@@@@@@@@@AA@@@@@B@@A@@B@@@@@@@B@@A@@@@BBB@@@@@@@@@BA@@@@@A@@BA@AB@@@@@@@@@B@@B@@@@@@@@B@@@@A@@B@@A@@@ABA@@@@@@@@B@@@@@@@BA@@@@@AB@@@@@@@B@@@@@@@B@@@@@B@@@@A@@A@@@@A@@B@@@@@@@B@@@@AB@@@@@@IDADKFKJELK\\GX@HCHAB@HBDDFFJBJAPAHAD@H@FFJBFLXFTNzBJBHBH@J@JAJ@F@T@L@HDHFFHHLDJBHDHD@@lZHFLH@@HHHHBDDNBFDDFDBDDHDH@DBHDL@B@FDFBHBHBDBDBBAHEHABEAmKIBIBCB[fADEFCD@@B@B@B@DADADAJAF@BBBBBBfADDLDHDHF@BCF@DBBFBDDFDDDHFHHBD@@BBB@ZZFB`EHADDBV@FCBE@yJ@DCJAHCFBFDFDBN@NBH@JALGJEFAJ@HBJBLBHB@@HBJ@JBFBHFRLNNHPDNDXAH@@@B@BANH^JLpNJBLBN@HBJ@FB@@J@@@J@J@J@LF@@NHF@H@@@@D@B@BA@C@C@A@AAC@CBEDAD@BB@BBFFDDD@D@BA@A@EAC@@DADAFBD@BBD@DEBCFCDEDGLCL@@@hhLTH^HXLZC^@@GFCDCBFBLDdPDFFLDHD@L@D@FE@@CCGG@CBCBAF@TEJCPKPOJIFCJCFCD@LALC@@`CHAFAJCLCD@TA@@bIB@BBBBBBDBB@B@H@LADAD@JCJGDCBAD@LEZI^K@@B@@BBBHFBBB@D@DAFA@@FAFAFAHAD@B@DA@@@@@AA@@@BADAH@NINGLAKJA@A@@@AC[@@@K@@@A@@FITYBA@@@I@@AI@A@@BAD@F@F@D@D@@@HCDGFEDK@Q@GBCJGDEDE@ECIAIKQGK@A@CJKHODA@@BAH@dBBBPBJAFARKJCRAXCDARAPEFCFANMLGH@R@B@LDLHJHLF@@P@LAJBJHJNHPDDBBHDTH`FXBdAF@NA@@ZELGJQHMFETG@@A@@@A@A@C@A@@@@@@@@ABA@@@A@AAAAAEC@@AA@@@CAE@A@AB@@AB@D@@@@@@AAA@AAC@@AE@AAA@@@A@A@A@@@@A@EAA@@@@ABI@A@C@A@@AAA@@A@@BABA@ADABCB@DEFEDCLGDCB@@@D@B@B@B@@AB@@@@ABA@@AA@@AA@@AA@@DCBAFABABAFCFCBABCDCBA@@@AAACC@A@A@A@@@A@A@@BAB@FC@@@ABI@A@@D@@@@@BGBC@C@C@A@A@A@@AA@@@A@A@A@AB@@A@A@@@A@A@CAA@A@@@A@@AA@A@CBA@AAG@AAG@A@A@@FA@@DA@AAA@A@A@@@A@A@E@ABC@C@@@C@@@A@A@@AAEAAAA@BA@A@@F@@AB@BADCB@HCDA@@BAB@DAJEB@HABADCBAFA@@BAA@EA@@@@AABA@CBC@AA@@@C@A@@@AAACAA@@E@C@A@AAA@AA@A@@BCDCBABC@A@C@A@A@AAAAA@A@@BC@ABE@EDAAAA@@AA@@@AA@@C@A@@@@@@AB@BA@@@AAA@@@@AAAA@@@AAA@AA@AA@@A@A@@@@@@A@@C@@A@@B@@A@@@AACAA@@@@AAA@@A@A@A@@@AAA@@A@@A@@@@@AAA@A@@A@@@@@A@AA@@@AA@@AB@@@@@@@@AAA@@B@@@@AA@A@@A@@@@A@ABA@@@A@@@ACAACA@A@A@@A@@@AA@@AAA@A@@@A@@@AA@@A@@@AACA@@AA@@AA@@@AA@@@A@A@A@@@@ACB@AA@@@@AAB@@A@A@@@C@AA@@A@E@@@AAAA@@CA@A@@@@CAA@A@@@@@AA@@A@A@A@@@AA@@AA@@A@AAAB@AA@A@@@A@@@A@@@@@A@@@@@@A@@ABA@@AA@A@@AC@ABEAA@@@A@AA@@@@A@@@A@@AA@A@A@@A@@AA@A@@C@CAA@@@@@A@@@@@A@@@A@@AA@A@A@@@A@@@@B@@A@A@A@A@A@@@A@A@@@@BA@A@A@AB@@AA@@@@A@@@@ACA@@@@AAA@AA@@AAAA@@@@@@@A@@A@@@@@AAA@AAA@CAAAAA@@CAA@@AAAEACC@AB@@@B@@AB@@A@@@A@A@@BA@@@AB@@@BADABA@@@A@ABA@AB@D@B@@@DABEDABADABAB@@@AAA@@@@@@A@@BA@@B@BBB@BBDABBB@DA@@B@BAB@A@@AAAA@@@@A@@A@@@@@BAAAB@A@@A@@@A@A@A@@@A@@@@@@@AAA@A@C@E@@BA@CBA@@BAFAB@D@B@B@B@@@B@B@@@@E@C@@BC@AB@D@BA@@B@B@B@BAB@B@@AD@B@B@@@B@@@B@BAD@@@BABA@@B@@BB@@@B@@@@A@A@A@@@CB@BA@@B@AA@@@A@AA@@C@A@@@A@A@@A@@A@A@@@A@A@A@A@ACE@AA@@@CD@BAB@@A@A@AA@A@@@A@ABC@ABA@@@A@@@@A@@@@@A@@@AB@@AAA@AAA@@A@@A@A@@BA@@@@BAD@@@BA@AB@BABA@@@@A@@@AA@AB@A@@@@AB@@A@@@AC@@ABCA@@AA@@A@CAA@BA@A@@BA@@BA@C@@BA@@BB@@B@B@DBD@DA@@ACAA@AC@@@A@@@AA@A@A@@B@B@B@@@@ABAAEAC@AGECAA@A@A@AA@@AE@A@AC@CACAA@A@AA@@@@@B@@AB@@@@AB@BAB@@A@CAC@@@A@AA@@A@@@@@A@@@B@AA@@A@@A@@B@BADABAB@A@@A@E@A@ABA@ABA@@AA@@A@@@@@A@A@A@A@@@@@AAA@C@AA@AAC@CAA@AAA@A@AAAAAAAA@@A@C@C@A@@@@A@C@@@A@A@CBAB@B@@ABABA@A@C@AAAAEGAAAAAAC@A@@@A@AAA@@@A@ABAB@@AA@@A@@@CBA@@@A@AA@@AB@@@@A@A@@@A@A@A@@AA@A@A@@@AB@D@@@@@B@B@@@@@B@@@BB@A@@B@B@@@B@@@B@@@@@@@BABB@@B@B@@@BAB@@BB@@@B@@@@B@@BB@BBBB@DBB@@@B@BB@@B@@BDBB@B@@CD@@C@@AA@ABA@A@@@ABABEB@@C@@@AACA@AA@@A@@@C@@BA@@@@@A@@@@@A@AB@@A@@BA@@@@@A@A@A@@B@@@C@@AA@@@@AA@AA@@@A@@A@@@ABC@CAA@A@@@BB@BA@@@AB@@A@@BBD@@@BA@A@CA@A@@A@C@A@A@A@AA@@@A@B@@@@@@@BABA@@B@@@@AB@@@BA@@@@B@BAF@D@B@@AB@B@B@@@B@@AD@B@@@B@@AB@@@A@A@@@@AAA@@@A@A@@@AAA@A@AAA@@@CA@AAAA@@A@AAA@@@@BAD@@@BBB@B@B@B@@@BB@@D@@@B@@C@CBA@A@AA@A@@A@@AA@@@AABA@ABA@ABA@AA@@@@@A@C@A@C@A@@@CAA@A@C@A@ACE@A@A@C@@A@BA@CA@@@BAA@B@@@AA@@@@@A@@@@@A@@@AB@@AAA@@@@@A@@@A@A@A@@@A@A@@@C@@AA@@BA@@AA@@B@@@AA@A@A@@@@AA@@@A@@ACA@@AA@@@@AA@@@BA@@A@AA@@AA@@@AA@@@@@AA@A@A@@A@AA@A@@@AA@@@@@A@AAA@AAECA@CAA@A@A@AAA@A@A@@@A@A@CA@@@@@@A@A@@@@@A@A@@@@B@@A@AB@BA@@BAB@BC@AB@@A@@@@A@@@A@@@@A@@A@@@A@@@@@@A@@@A@C@@BA@AAA@@@A@@@@@@@@@A@@@@@A@@BCDA@A@@BA@@A@@A@C@@AAA@CAA@@A@@@A@@@A@@A@@A@AAAAA@@@A@@@AB@@@@@@@A@@@A@@@@A@A@CDA@@D@BAB@@@BA@@BA@@@@@A@@AA@CAA@A@@@AAA@@@A@@@AB@AAA@@@AA@@@@B@@AB@B@@AA@AECCAAACAAA@A@A@A@A@C@@CA@@C@AB@@ABAB@@EBA@@@A@@@CAA@A@@@A@AAA@@@A@AA@@AB@@A@@@A@@BA@@@A@AAA@@CAA@AACA@@A@@@@@AB@@AAA@@@A@@@A@@B@@A@A@@@A@@@AA@@C@@@A@@A@@@AB@@A@AB@@@@A@A@ABA@A@A@@@A@A@C@@@@AAA@@@@@@@@AAAAA@@AA@@A@@AAAA@@@@@A@@@A@@@@@A@@@A@@A@@@@A@@AA@@@A@A@@@AA@@@ABABA@@@A@AA@AAACA@@A@AB@D@B@@@@AA@AA@A@@@@B@B@BAB@@A@@@@A@AB@AA@@ABA@@BC@@@@B@BABA@CDAB@BC@A@@@@D@B@B@@A@ABA@A@A@CAA@@@@@A@@@A@A@A@AB@@ABEBA@A@@@C@@@@@@@B@@@@C@@@A@@@A@A@@@@@C@@@C@@AAA@AAAB@@@BAB@@AAAAA@A@A@@@AAA@@@@@A@ABA@@@A@@CB@@@@C@@@A@@@ABC@A@@A@@@AB@@AB@@A@@BA@@BA@CBA@@@@@@BAB@@@BA@@B@@@BAB@@@@A@@BDD@@BB@B@@@@@B@@@B@@A@@B@@@BA@@@AB@BA@@B@@AD@BB@@@@@AB@@B@@BB@@@@B@@BB@B@@B@@B@@@@@B@B@@@@@B@@@@@@@@@B@@EB@@ABBB@BB@@@@BAB@@DFBBA@C@A@A@A@CB@BA@AB@@AB@BA@@B@BAB@@@@BA@BB@@@@@@@B@@BB@@@B@@BB@@@@@BBB@@@B@@@B@@B@BBD@B@B@BABCDA@@BA@AA@@A@@BAF@B@@A@A@@B@@C@@@AAA@@B@@@B@B@B@B@B@BAB@B@B@B@@AB@@@@@DAB@@@@C@@@A@AB@@@B@B@B@B@@@@@B@B@@@B@@@@@B@@@B@@B@ADBB@B@@A@A@A@@@CB@@A@CB@@AB@@@D@BA@@@@BB@DB@@ABAB@@BB@@@BBB@BB@@B@B@@BB@@B@BAB@BB@@@@@@@@@B@@@BAB@@@B@@@BA@B@@B@@@@@@@@B@@@AB@@@B@@@@@B@@@@A@@B@@A@@@@B@@@@A@@BAB@@@@@B@@@@@B@@@B@@@B@@@B@@AB@B@@@@@@@BA@@B@@A@@B@@A@A@A@@@A@@@CBAAA@A@A@C@@@A@@@A@A@@@@@A@A@@@@AA@@@AB@AA@@BA@@@A@@A@@A@@AA@AB@@CB@@A@BB@@B@@@@@ADAB@@CDA@AA@@@@C@A@@@CCA@@@A@@ACB@@@@BB@@@@@@@BABB@@B@@@@@BA@@@@@BB@@@B@@@@BB@@@@@B@@BBB@BB@@BB@@BB@@@@BD@BBB@B@@@B@@@BA@ABAB@BA@A@@@A@A@A@C@A@AAA@C@ABA@@@A@@@A@@@ABA@@@A@A@@@A@@BA@@@AB@@ABA@@@@@@BA@@A@@@AA@@A@@@A@A@A@@CA@@A@ABA@A@@@A@@@A@@@AA@AA@A@@@A@@@@BA@A@@AAAAA@@B@@A@@@C@AB@@@BA@@A@@A@@@@@AA@A@@B@DA@@@@A@AAAEBA@@@C@@@A@C@A@C@@AA@@@@AA@@A@@@@@A@@AAAAA@@AA@@@@C@@@@@@AA@@EGAA@A@@@AAAB@AA@@@AAA@@AA@AB@@A@@@A@A@@@A@@@@@@@@@A@@@AA@@BA@@BA@A@@@@@A@A@ABEB@@@@C@@@A@A@A@@AA@AA@A@@@@BA@A@@@@B@D@@@@ABA@@BABAB@AA@AAA@@AA@A@A@ABCACB@@A@@@AAA@@@ABABCBA@@BA@@D@B@@AB@@ACA@@@@@@@A@@A@A@@AA@@@A@AA@@A@A@A@@@A@@@@A@@CB@@A@@@@@CA@@@@@@C@A@@@@AC@@@A@AA@A@@A@@@AA@@@@A@@@@AAAA@@C@AB@@CBADA@ADCDA@@@A@BBA@@@B@@@@B@@@B@B@@@@@B@B@@BB@B@@A@@B@@@B@@@B@B@BAB@AA@A@@@A@@A@@A@@@A@@A@@A@A@@@@@AB@@@@@A@@@AA@@@A@@AA@@AAA@@AA@@A@@A@A@@A@@@A@A@@@@C@@@CBA@A@A@C@@@@@A@@@A@@A@@A@@@A@@B@BCD@BA@@BG@C@@AAA@@A@A@A@@B@BA@@@A@@BA@@@AAA@@@@@@BABCD@BA@@A@@BA@AB@@A@AAA@@@A@@B@@@@AA@@@AA@A@@BA@@@@A@@@@@CBA@@A@@A@@@@@AA@@@@A@@A@@A@@@AA@@@@@A@@A@@A@@AA@@@@@@AA@@@B@@A@A@@B@@C@@B@@@@A@A@CA@@@@@@CC@@@@AA@@AA@@@A@@A@@A@@@AA@@@@@@A@AA@@A@@@AB@@ABA@@B@DBB@@AB@@@BA@AD@@@H@@@@@@@@D@BBB@@@BB@@@BB@@@@B@B@@@@@@@B@@A@@BA@@@@@@BC@@B@@AB@@AB@@@@A@@B@@@@@@@BA@A@@B@@@@@@A@@A@AB@AA@@@@AA@@@@@@@AA@@AA@@@AA@@@ACAC@@@A@ABC@@BA@A@@BAB@@A@@@A@@DABB@A@@@@@AAAA@@AAA@@@AB@F@BAB@D@B@DAF@BAFABA@A@A@A@C@AAAA@A@A@@AA@AAAA@@@@@@@A@@BAB@@@B@@@BA@@B@@@@A@@@@@@AA@@@A@@@@AA@@@@@AA@@A@@@A@@@AA@A@@AA@@A@BCAA@A@@AA@@AACAC@AA@@CA@@AA@@@@A@@@@@A@@@A@@@A@@A@@A@A@@@@C@ABA@A@A@A@@@A@AA@@@A@@A@@@@@AB@@CAA@@@@B@@A@@@@@@B@@@@@BAA@@A@@B@@A@@@AC@AAA@@A@@@@B@@@@@BB@@BB@@@@BBB@@B@@@@B@@BB@@@@@BB@@BB@@B@@@@AB@@A@AB@@@@AB@@@B@@AB@B@@@B@@@B@B@@A@C@@BA@@BABAA@BA@C@A@A@AAA@@@@@@A@AA@@@AA@@A@@@A@@@AAA@A@A@C@@@A@@A@@@C@@AAAA@@BAB@@@@ABAB@@AB@@@BABABA@@@AD@B@DAB@B@BA@@@A@A@@BAB@AAAAA@@AB@B@B@B@@@FA@@@@@@@A@@@@@A@@@A@@@A@@@@@AB@@A@A@A@@@AB@AAA@@@AA@ABAB@@@BABA@@D@@@BA@A@@DAB@@@B@@@B@@A@A@A@@@@@A@@BC@@@@@@@A@@B@@@@A@ABABA@CB@@C@@BA@A@A@@BABA@@CC@@@A@@@@@ADEBAB@B@J@@B@B@DAB@@@B@BBBA@@@BD@@A@@B@BABA@@@BB@@@@@B@B@B@B@@BB@B@@@B@@@@BB@@B@B@@AB@@@BBB@@AD@BAB@D@@@BBB@@@BA@AA@@AAC@A@ABA@@@AA@CAB@@AB@@A@ABABCB@B@F@BBBB@@B@BB@@@@B@AB@@@@@B@@B@@@AB@@@B@B@@@@@B@B@@BD@@@@@@@B@@@@@@@B@@@@@B@@@@B@@@@@@BBA@BBA@@@@B@BB@BB@@@BA@@@@@@@A@@@@AAB@@A@@@A@@@@@A@@@@@@@@B@D@@@B@@A@@@@D@@@@@@A@@@@@A@@@A@@B@BAB@D@@A@@@AB@B@@@BBB@DA@@@@B@@@@ABABA@@B@@ABA@@@@@@@@AAA@@@@@CCA@@@@A@AB@@A@@@@@AA@@A@A@C@A@AB@@A@@@@AA@BA@A@@@ABA@@@A@@@AA@A@@A@@@A@A@A@@@@@@AA@@@A@@A@@BA@A@@AA@BC@@BABA@@@A@ACA@@@A@@D@@AB@B@@@@A@C@A@@@@BA@@B@@@B@@@@BD@@@B@@@B@B@@@BAAA@@@AA@AA@@@@A@@@A@@CA@AA@BAB@@@@A@@BA@@@A@@A@@@A@A@@@A@A@@@AA@ACBAAAB@@@A@@@A@E@A@C@@A@A@@BAB@@@BAA@A@A@@A@@BAB@B@B@@@B@@A@@B@@@B@@@B@@@BA@@B@BAB@B@@AB@@A@@@@@@AA@@@@A@@@@@AA@@@@@@@AAAABAA@@A@@@A@@AAA@@A@@AAAA@C@@@@BA@A@@@@@AAA@@@A@@@@@@BA@@B@@AB@@@@@BAB@@@B@B@@@B@BA@@@@BAB@@@@@B@B@@AB@BAB@@@A@AAA@@@AAAA@CAA@@AA@@AABAAABA@@AA@A@@@@@@B@BA@@BAB@BA@@B@@@B@B@@AB@@A@@@@@AB@@@@@B@@A@@BA@A@@BAB@@A@@AA@@@A@A@@@A@A@@@C@AB@@@@A@A@A@AAAA@@@@AB@@@@AB@@@@@@AB@B@@@BAB@@@B@B@@@@@B@BA@@B@BAB@BAB@@A@@A@AA@@@A@C@A@@@A@AB@BA@@@AAABA@@@C@@C@A@@AA@A@@@@A@@BA@AB@AABA@@@@@AB@@A@@@@@AB@BA@@@A@@@@@A@ABC@AAA@@@A@ABA@AA@@A@A@A@@@AAA@AAAAACC@@@@@A@A@@@@BA@AB@@AB@B@@@BAB@@@B@@A@@B@@@BA@@@@AA@@AA@AA@@@A@AA@A@AAA@@@A@@@@AA@A@@AAA@AAABA@@@A@@@A@A@AAA@@AA@AAAAC@A@A@C@A@A@ACA@A@AAA@@@A@A@CAAB@AA@@@@@@ACA@@A@@AA@@AA@A@@@@AAAA@@A@@AA@@@@@@@A@@AA@@AA@@AA@AA@@@AAA@@@AAA@AAAAAA@@@@A@@@@@A@@@A@A@AA@@AA@@A@AA@@A@@@AAA@@@@@AA@@@@@@A@@@AA@@@@A@@@@@@@A@AAA@@@@@AAA@AA@@CAC@@@@@AA@AA@A@@@CAA@AAA@A@@@A@@@A@A@AA@@A@A@A@@@@AA@@@@A@@@A@@@@@A@@A@@B@B@@@@AB@B@@@BA@@@A@A@A@@BA@A@@@CBABA@A@@@A@AB@@A@A@@@EBA@A@ABA@C@AB@@A@A@@BAD@BABA@@BA@@BA@AB@@@@@@AB@@A@EB@@ABA@A@OB"],encodeOffsets:[[110164,37722]]}},{type:"Feature",id:"610827",properties:{name:"米脂县",cp:[110.183754,37.755416],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@AAAAABA@A@@@A@@A@@A@@B@@@@ABA@@@AA@@@@@AA@@@@BA@@A@@@@A@@BC@A@A@A@CAAAAECEECCCAGAEAEACAC@AACAAAAAA@AA@AA@AACAA@A@A@A@AB@@@BA@ABCDABCD@B@@EA@@A@@BCDA@ABA@@BAB@@AF@B@BAB@B@B@B@@@B@@@B@@ABABABE@C@A@IAAAA@EBA@@AAAAAAA@AAA@@CCA@@AGCGAEAA@A@@@@AA@@C@AAAA@AAA@@@ABI@ABA@AB@@AD@@C@A@@A@@EA@BA@AB@BEFAB@BA@CB@@CBA@CAEAAAAACAA@C@C@AAA@AA@AA@@A@A@@@A@@AACA@@ABA@AAA@@AA@CC@@@AB@@AB@@CBA@A@@@A@ABA@@BA@A@@@@A@AA@@CAAAA@A@@AE@@@ABA@@@CB@@@A@@@@@AACAC@A@A@@AAAAAA@@A@AAA@CAA@@@AA@@@A@A@@BA@A@A@@@A@@AAA@A@A@@ACA@@@@CDA@@@A@@@C@ABA@ABA@@@@@CBA@@@AB@@AB@@A@A@ABAB@BC@ABAB@@AD@BABCBCB@BABB@@B@@@BAB@@A@C@A@@B@@@B@@ABA@@@@BA@@BB@@B@@AB@@A@@@@D@@@@A@AB@BABABAB@@AD@BA@C@CBABEB@@ADA@@@CB@@@@@BAB@BA@@@@@CAC@@@A@@@ADA@@BA@@@A@@AACA@@A@A@A@@@A@@AAAAA@@@E@@AAAAAA@A@A@@@C@@@AB@BA@A@A@CB@@A@A@ABA@A@A@@@AB@@A@C@@@A@@@@A@A@@A@A@E@CAA@@@A@@@@@@@@@A@A@@@A@A@C@A@CA@@A@A@@@A@@@@@BB@@@@A@@@A@B@@BB@@B@@@@@@ABAA@@AA@@@@AAA@@@@B@BA@@BA@@@@@@BA@@B@@BBABB@@BAB@@A@@@AAA@@@@@ABA@CDABA@A@A@AA@@@@@@@@A@@@@BE@@@A@@@@@A@@AC@CAEAA@@A@A@@A@@A@@A@@@@@AB@@A@A@A@CB@@@@GC@AAACA@@@@BA@A@@@A@@A@@BA@EB@@ABABAB@B@@@@@@AB@@@B@B@BA@@BA@@@@BA@@@A@C@A@@A@AAAE@A@A@A@@@@@A@AA@@CCA@@@A@@@A@@BABAB@B@@CBA@C@EBA@CAAAA@A@@@A@ABEB@@A@AA@@A@@@A@AB@@@@A@A@@@@BA@AACAA@@@EC@@AAAAC@@@ABG@ABAB@@AD@@B@@B@B@B@B@BCBABAD@BB@@@@@B@@B@B@D@BA@@@A@@@AACAAAA@@BA@@B@B@B@D@@BBBBBBA@@@@@EAE@A@ABE@A@CB@@C@C@@@@BB@B@@B@BB@AB@BEF@@AB@@C@CAC@AA@@A@AA@AA@@@ABA@AA@BA@ABA@@B@@@B@@@@@B@@@@@B@@B@DADBB@DBB@@@AB@BADAFBD@B@@@BB@@@DFB@@@AD@BAD@@ABAD@@B@@@@BA@BBB@B@B@B@@@B@B@A@@B@@ADABA@@@A@@BA@A@A@@@C@@AA@A@A@C@@@@A@AA@@A@AA@ACAA@@@@@A@A@@@AA@A@@@@AA@@A@@@A@@@@@@@@@A@A@@@@@A@@@@@AA@@@AA@B@D@BA@@@@BBB@@@BA@@B@@AB@BA@@B@@AB@@@@AB@BC@ABA@C@@AAAA@@@A@@@A@A@@@AAAAA@@A@@@C@@AA@@@A@@A@@A@@@@AB@@A@@BAB@@@B@@@BAB@BABA@@B@B@B@D@D@B@@@BA@A@A@AAC@@@A@ACC@@@@@AB@B@@AD@B@BAB@@AH@@@@@B@BA@@@@@@D@@@@@DAD@@ABA@@@CAC@A@AA@A@@A@A@@C@@@A@AA@CAAAA@@@@ACAAAA@A@@@@@A@@B@@@BA@A@A@A@@@CA@A@@@@@A@@A@@@@@AB@@@BA@@@A@@@A@@AAA@@A@A@ABABBB@@@BA@AAA@A@@AA@AA@@@A@@@A@@@@A@AB@@@@@@ADAB@@@B@@A@@AA@@@A@@@AD@B@BB@BBBBB@B@@B@@@BAB@@@B@@@B@B@@@D@B@D@BBF@@@BA@@B@@@@@@ABA@@BA@@BABABA@CBA@C@@AA@AAA@A@@@EF@@@BBB@@@@@BB@@@@@@@@@@B@@@B@@@B@@@B@@@@@B@@@@@B@@A@BB@@A@ABA@A@@@AB@@A@CD@@@BA@@B@@@B@@B@BAB@BC@@BBB@@@@@B@@A@A@@@@@@@AB@B@B@@@@@@@@BBB@BB@@@BB@@@@@@B@@@@BB@@B@@B@@@@@AB@@B@BB@@@@@BB@@BB@BABAAAB@@@BA@@@AB@@@@A@@@AB@@@B@D@D@B@BB@BB@@@B@BBBBBBB@B@BBD@BB@@@@BA@AD@B@B@BAB@B@@@BBBB@@B@@@D@@B@@@@B@@B@@@@@B@B@@@BAB@@@B@@BBBBD@BBB@@B@B@@@D@B@BB@D@@B@@B@AB@B@@ABA@@B@@@B@B@@@BA@B@@B@@@BCDADA@@@A@@AA@@AA@@@@B@B@@BBDDBB@@@B@BA@@@BB@@BBB@D@D@D@B@B@@B@@@D@@BB@@B@BBBBB@@@B@B@B@DCDAFEBAD@@@BB@@@B@@AB@@ABA@@B@@@@BBA@BB@@@@B@BB@@B@@@@@BADC@AB@@@B@B@DB@@@@@BEDCD@@@@@B@@B@B@@@B@D@BAB@@@A@BA@@B@@@@@BA@A@@@A@@@B@@B@@@@CB@BADBD@D@BB@@@@@@CB@@@@@B@@@B@@B@@@@@B@@@B@@@BAB@BAB@B@BB@@FAB@J@D@DA@A@@@@C@AA@@A@@@A@A@@A@@BA@A@@A@@@@A@A@A@@@AA@@@@AA@@A@AAA@@@A@@A@@AAAA@@AA@@AAA@@@A@ABADCBABAB@@AB@BBBA@@DC@AD@@@BD@@B@@@@BBB@@@B@D@@@@@BB@BBA@@B@@BB@@B@@B@@@B@B@B@BBBA@@@AB@@A@A@@BCB@@@B@@BB@@D@@@@B@B@@@D@B@BBB@@@BF@@B@@BBB@BBB@@@@B@@AB@BAB@@BBBB@@B@BA@BBBBBB@@@B@B@DAB@@@@@@B@FBBBB@@B@B@@@B@BA@@@@B@@D@B@@DB@@@@B@@@@A@AB@DBB@@AB@@@AC@A@A@@@AB@@@D@FAB@BA@AB@@@B@@@B@D@@@BB@@@B@B@B@B@DBB@@@@B@DA@@B@@@@B@DB@@B@@@@CFABA@@B@B@BAB@BB@@@@@B@DABAB@B@B@BA@@D@BBB@@AB@B@@@@@@BB@@@@B@@@@@@@BD@@@B@@BFABB@@@@@B@@@BD@@@BAB@BA@AB@B@@ALDJDB@HBDB@AD@BA@@BC@C@ABA@ABA@@@ABA@AB@@A@ABAB@@@BA@@AAA@@ACAA@@@A@@B@@@B@@ABA@@AA@B@BA@@A@@@AA@@@@@@@A@A@@@@AAA@A@C@ABA@@@A@@A@@@AA@@@A@@@AAA@A@ABA@@@@AA@@@BA@@D@@AB@@@@A@@AA@AB@@@BA@@BA@@@@DAD@BABABA@C@C@@BC@ABAFE@@B@@@@@B@A@@BCD@B@@@@B@@@BADADAD@BABABA@AB@@EB@DB@@DB@@B@BBB@@@BA@@BIBABAB@@@@@DDB@DDB@BBBDBB@BBBB@@AB@@@@A@@@@@A@@DAB@BA@AB@DAJCDCDAB@@AB@D@DABABAB@BABAB@@A@@B@@A@@DA@@@A@@BABAB@BCDAHIFEBAB@@AB@BABABABA@@DABA@@B@B@@B@@@B@B@@B@@@@A@@BABA@@@@A@@A@@@@@AB@BBB@B@B@@@B@@@B@B@B@B@B@B@@@BB@@@@@BABA@@BB@@@@@B@@AB@@@BBBAB@@DA@@@@B@B@@@BCB@@@@A@@BBB@@@@AB@@C@@@@@@B@@@BB@@@B@BA@@B@@@@B@@@@@@@BA@@@A@AB@@A@@B@B@B@B@B@B@@@@B@B@@AB@@@@@@BB@@@B@B@@BB@@B@BDB@B@@@BA@@@@@C@C@@@AB@B@FAB@@@@@AAA@@AAA@C@@@A@A@@A@AA@@@AB@@@BAD@BABA@@@AA@@@@@B@B@B@B@BA@@BB@B@@@@@@DB@@@@@B@BADA@AB@BA@AB@AA@A@A@@@AB@@@@@DD@B@@AB@B@B@@BBBBB@@BB@@B@BAB@@AB@@AB@B@D@BA@AB@@@@BBB@@BB@@B@FAD@B@@@B@@@B@@DB@B@B@B@DBD@B@B@@@B@@ABA@C@CB@@A@A@AB@@A@A@@@@AA@@A@C@C@C@A@@CC@AAA@CAA@@A@@@AB@@ABAD@B@@@B@@A@@@@BA@@@@B@@@@AB@@CAA@@B@@@BBB@BAB@BCBABC@A@A@A@AA@AA@@@ABA@AA@@AAA@@@AB@B@DBF@BAD@@@B@@BBBBB@BB@B@@@B@@@BA@@@A@A@@@AAA@A@@@@@AB@@@B@B@D@B@@B@@B@@@BBB@@AB@@@BCD@BA@CB@@AB@@B@@B@@@@A@C@@@A@A@AACAAAA@A@CAC@A@CA@@A@A@ADA@AB@@@@A@@@A@@@AA@@@A@@A@@@@B@D@B@D@B@B@BB@@@@@@BB@@@B@BABABA@@B@B@@@BB@@@BA@@B@@@@@BB@@BBB@@@B@D@B@D@B@BB@@BB@@B@BAB@@AB@@ABB@@B@BB@@BAB@@AB@@@B@@@BDD@BABA@A@ADAD@@@@CBAB@@@BAB@@@D@@@B@B@@B@BABA@A@@B@FB@@DAB@@AB@BA@@@@@AAAB@@@@@BB@@B@@BB@@B@B@B@@B@@BB@JFB@BB@@@BA@@B@@DB@B@@@@@BAB@@B@@@B@@AF@@A@A@@B@@@B@@@DBB@@B@B@@@BB@@@D@@BB@@@A@CBAB@BA@AB@@@B@B@@AD@@ABAB@B@@A@@BABEDABA@AB@@EBA@AB@B@B@B@@AA@AA@ABA@@@A@@@A@@@A@@@A@@B@B@B@B@BBB@@BB@@AB@@ABBB@@BB@@@@@@ABC@@@@BAB@@@BB@@@BDBB@B@D@@@@AB@@A@@B@@@B@@A@@@@@A@@AAA@@@@@B@@A@AD@@@B@BBBAB@@@BB@@@@@B@@ABB@@@AB@@ABAD@@@B@B@@B@@B@BAB@@A@@B@@@B@B@B@@@B@@@@A@@@AAAAA@A@@B@DAB@B@@@@@B@B@@@@@B@B@@B@@@@@@B@@AB@DA@BBA@@B@@AB@@ABA@A@@BC@ABA@@@@A@@@@@@@A@@A@@A@@@A@A@@B@@AA@@@A@@@AA@@@ABA@@@A@CA@@@@C@@@A@A@A@A@@@@@@A@@B@@@BAB@D@@@BABA@@@A@@AA@@@AA@@@CAA@@AC@@@AA@@B@@@DADBBA@@B@BAB@@@B@B@B@@@B@@@@@BB@@@@@@B@BA@@BB@@@@AB@@ABB@@@B@BAB@B@@@@B@@@@A@A@@B@@@@BB@@@@AB@@CD@BA@@@ABB@BBB@@@D@@@D@@@@@DABB@@BA@@@@BC@@@ABAB@B@@@BAB@@@@AD@BBD@@@B@D@@A@@DCBABA@AB@B@D@B@BAB@BB@@B@@@AB@BB@@@B@@@B@@@B@@@@BAB@B@@@@BBF@@B@@@@@@ABB@@BBDAB@B@B@@B@@@BB@@B@B@@B@@@BAB@@G@A@CAA@@BAB@BAB@B@BAD@@@@A@AAA@A@AAA@AAA@A@@@@AA@@@ABA@@AAAA@@@@C@@@@AB@@@@@@@ABA@AB@@AB@@A@@@A@@A@ABABA@CDABA@A@ABC@@@A@AB@BAB@@@@@@@@B@@@@@@B@BAD@@@@@BB@@AB@@A@@B@@@B@@@@BA@@@BBBBBB@@BBB@B@B@BB@@@@@B@BBB@@AB@@@BADB@B@B@@@B@B@D@B@B@B@BABBB@@@@@B@@B@B@@@B@@@@@BA@A@@B@B@@AB@@AB@@B@@B@@@B@@@BA@A@@@AB@@@@A@A@@@AA@@@@B@@AA@B@BC@@@@@AE@A@AA@@A@@@AB@B@@CAC@@@CBA@ABAB@@A@CB@@CFCBABA@@@A@@@A@@@AA@AAA@@@@@@A@@@A@@A@@@A@AA@@@@@A@@@@B@@BB@B@@AB@@A@@@AA@@A@@BABC@@@A@AA@@AAA@CAA@A@ABA@@@AB@BADAB@BAFA@@BB@@B@BA@A@@BCA@@A@@@@BBB@@B@B@@BA@@BB@B@@@B@@@@@DE@AB@B@BB@@FBDBD@F@@@B@BA@@@CDCBCDCBA@@@@D@@@B@BA@@B@B@D@BBB@B@BBB@@B@@@BA@@BC@@B@@@@@BB@@@@B@@@@CDAB@B@B@B@BBB@@BB@@BB@@@BB@@@B@@BB@@B@@BB@@BB@@@B@B@@A@ABCBA@A@@@A@C@@@A@ABA@@B@B@D@BBD@@B@BA@@@@B@@BBBBD@BB@B@B@@@@B@BA@@B@B@B@@B@BBDAB@@@B@@BB@@B@B@@B@B@FADAB@BA@@@AB@AAB@@@@@@A@@@C@@AAB@@A@A@AA@@@CB@@@@A@@@@AAA@A@EAA@@@@@AB@@@BA@A@@BCB@B@DAFA@@B@@A@A@A@@@A@A@@@@AA@A@A@@@A@A@A@@B@@@@@@AA@@AB@@@@@@ABA@@@@@@B@BBB@@@AAAAA@@@A@@@A@DA@@@@BA@@DAB@B@@@B@D@@@B@@@B@@@B@@@ACA@@AC@@@@A@@@AAAAABABABA@@BA@@@A@@@A@@DB@@BA@@@ABA@AAA@A@@@@@A@@A@@@B@@@@AB@B@D@B@BB@@B@B@B@D@DBB@B@BA@AB@BBB@B@@@B@@@BAB@@A@@@@@A@A@A@AB@@@B@B@@@@BB@@@B@B@BAB@@AB@BCB@AC@A@@@A@CA@@@@A@@AE@A@@B@@@D@@@DB@BDAH@D@BAB@BABADCBADC@A@AA@@@@C@@C@@@@@BA@ABA@A@@B@B@D@B@B@BA@@@@@A@AAE@A@A@@@A@@@A@@AA@A@@@AA@@@CA@@A@AA@@@@@AA@A@@A@@AA@@BA@A@@@AB@@@@@@A@@C@CA@@A@@A@@@A@@B@@A@A@A@A@@BAAA@@@@@A@@@@BAB@D@FBB@@B@@@B@@@@@BB@@AD@@@B@@@B@@B@BAB@@@@@B@@BBB@@@DBDB@@@B@@BB@@D@BAB@@A@AAA@@@ABA@@@@@CAACCAA@A@@@A@@B@B@DBD@@BD@BABA@@F@@@F@BAB@@B@@@DBBBDBB@B@@B@B@BB@@B@B@@@@@B@@@B@B@@@B@BB@@B@@@B@@@B@@@@AB@@@@@DA@@B@@@BB@AD@@@D@B@@@@AB@BE@@BABAB@B@@@@ABBB@@@@@@ABA@@A@@@A@@@A@@@@@@A@@BCBE@AB@@@B@@@B@@B@BB@@@B@B@@@@@BBBAB@B@@@B@@AB@@A@A@A@@@AB@@@@@@B@@B@@@BA@@@AA@@@@@BA@@D@BAB@B@B@@A@@@@BAB@@@@B@@@B@@@@B@B@BAB@@@@A@@@@@AA@@@@@@@BA@@@@@A@@A@A@AA@@A@@A@@@A@@C@@A@@@@@A@@B@B@B@@@BABA@@B@@BB@@AB@@AB@@C@ABABAB@@@B@@B@@@B@@@@CDA@@@@B@@B@@@B@BADE@@B@@@@BAB@@@BAB@B@@B@@B@@B@B@B@@BB@DAB@B@BA@AB@@@B@@@FBB@DB@B@B@BBD@@B@@BB@@@AB@B@@@B@BBBA@@@@B@@B@@@B@DBB@@@@AB@BA@@@@@A@AA@@@BA@@BA@@BA@@BAAEBABA@A@@B@@@B@@@@@@A@C@A@A@AA@@@@ABC@@@A@A@A@A@AAA@A@A@ABAB@A@AA@@@AB@@AB@@CA@@CBA@A@@BA@@@@@B@B@B@B@@B@@@@@@A@@@@BB@@@@B@B@BAB@@A@@@@@@A@@@@AB@@ABA@@B@@@B@B@@A@AA@@A@AA@A@AAA@CA@@AA@A@@BA@ABCBC@@@ADBF@DBB@BB@@BA@ABA@@B@B@@@@@B@@CB@@@@@B@@@@ABA@@@A@A@ABAB@BA@@@ABA@@@ABA@AB@@@BA@CB@BAB@@@BA@@DBB@@@@@@@@AAAAA@A@@@@@@@@B@B@B@@AB@@@@B@@BB@@@B@B@@A@@B@@@B@@B@@@@@BA@@BAB@BA@EB@@@B@@@@@@ABA@A@@@AA@@@A@@@@@AF@@@@A@@AAA@@@A@BC@@@@A@@@@@@B@BAB@@@@A@A@@@@@A@@B@D@@@B@@@B@B@@@@A@@@@B@AAA@A@@@BAB@@@B@@@@AB@@AB@B@BA@@@@@A@C@A@@A@@A@@@@DA@@@@BA@@B@@BBB@@@B@@A@A@@@@@@B@@B@B@B@@@@AB@@AB@@B@@AB@B@B@@@B@@B@@@@@@@B@@@@AA@@AB@@@@@BB@@BB@@B@D@@B@@@@@B@@@@C@A@@A@@@BA@@@BB@@@@B@BB@@@@@BB@@BB@@@B@B@@A@@@A@AB@@AB@B@@@@A@A@@B@@@@D@@@@BA@@@A@@BB@@BA@@@AB@DCFABAB@B@B@B@@@B@AA@@@@B@@CA@@@AA@@@@BA@BB@@@B@@B@@@@BA@@BA@@B@@BD@@@@BA@@@@B@@@@@@B@@B@@@@@B@@B@B@@A@@@@@A@@@A@@@@@AA@B@@A@@BAA@@A@@@A@@@@@A@A@@@A@@@BBB@@@@B@@@AA@AA@AAA@@A@@B@B@@@@BB@BB@B@@B@@@@A@@BA@@@@AA@AAA@@AA@@BABA@@BAB@@@@AAA@@@A@@@@B@DB@A@@@A@A@@@@B@B@@@@@B@@@BADA@A@@@@BB@@CFA@@B@B@@@@@@@@B@BA@ADC@A@@B@@@BB@BBA@BB@@A@@B@@@DBB@@A@@BADAB@@AB@@@BA@@BA@A@@BA@@@@@AB@@A@@B@@ABA@A@@@@BA@@@ABA@A@A@@BG@C@@@A@AB@BB@@B@@AB@@@@A@@B@@@A@AAA@@@@A@@B@@@AA@@@@@A@@AA@@A@A@@@A@@@@B@@AB@@@@A@@@@@AAC@A@A@CC@@@@@ABA@ABABA@@@A@@@@AA@@@AD@BA@A@A@@@@AA@@BA@@@AB@@AB@@AB@@@@@B@@@@@@B@@@@@@B@@@BB@@@@BBA@@@@@@@AB@@@@B@D@B@BB@@ABB@B@@B@@@B@@DB@@@@AB@@ADB@@BB@@@@BB@BABA@ABA@@AC@@B@@@B@@@B@@A@@BGBABA@@B@@@@@@@BA@@AA@A@@BAB@B@BAB@@@@A@@@@CA@A@@B@B@@@B@BB@BB@FCBABAAA@@@@@@AB@@@@@@AA@@@@@A@@B@@A@@@@@@AA@@@@@@B@BA@@@@@A@@@@@A@@A@@@@A@@BAAA@AAA@A@AAA@@@@@BA@@@ABA@@@@@A@@A@@@AB@AA@AA@A@G@@@A@@@@A@@A@@@@@@@@A@A@@A@@@@@A@A@@@@@A@@@@A@@@@E@A@AB@B@B@BA@@@A@AAA@@@@@AB@A@@AA@C@@EAA@@@A@A@GC@@@@@A@@@@AA@@@@A@@@ACA@A@@@@@A@@@AAA@@BA@@@@@AA@BA@C@A@@B@@A@@@AB@A@@AAA@@@AAAACAA@ABCAC@CC@@AAA@A@C@@AAAAAAAA@AC@@AAA@@@ABEB@@A@@AACAA"],["@@A@ECCAECC@A@GAC@E@GBADBBB@B@FBB@D@B@B@@@@@B@@@BB@@@BB@@BB@@BD@BBDB@@@@B@@B@@A@B@A@@B@A@@BBF@BA@@B@D@BAACCE"]],encodeOffsets:[[[113061,38634]],[[112514,38826]]]}},{type:"Feature",id:"610829",properties:{name:"吴堡县",cp:[110.739673,37.452067],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@K@ICKAG@OASAOCOA@@Q@G@@D@B@@@@A@@@A@@B@B@@@BB@@BABA@@@A@@B@@B@ABAA@@@B@B@@A@A@A@ABCD@@@@@BAAABA@A@@@A@A@@@A@A@A@C@E@C@C@ABA@AB@@AA@@A@@B@AAB@AA@@B@AA@@BA@@A@@@@@@A@@B@@@@A@B@@B@@@@A@@@BBAB@@B@@@@B@@@@@@@@BBA@@@BB@@A@@@BB@@@@@@@@@@@@@B@@@B@@BBA@@@AB@@@@@B@@@@@@@B@@@B@@A@@@ABC@A@ABAD@BBD@B@@@@AD@DCF@B@@B@BAB@BBF@D@BBDB@@BA@BD@DB@@@@B@B@@@B@@@B@B@@@@@B@FABA@@@@@A@@B@@@@@@@BB@@@@@@B@@AB@@@@@@@@@B@@@BD@D@@@BBBB@@B@@@DABA@AB@@C@A@@B@@@B@@@B@@ADED@BGFIDAB@@@B@B@BCBA@@B@@@B@@@BBB@BAD@B@B@@ABADCB@@BF@BB@@@@@@@BCDADC@@@@B@@B@BBD@BBB@B@@@BA@@B@@@@@@B@@@@@CBAD@D@BAB@@ABA@A@EBABCB@@AB@BAB@BAB@B@B@@ABB@DBB@@@@B@@ADED@BAB@@ABAB@@ABA@@B@@CJAD@@@F@@A@AB@@@BA@@@AB@@A@@B@@A@A@A@A@EB@@@B@D@B@@@D@B@B@BB@@@D@BABBD@D@B@B@B@@@D@B@B@B@B@@@@@@@@@@B@B@@@B@BA@@B@@@B@@A@@BA@@BA@@@@@@@A@@@@@A@@@AB@BA@ABA@@@@BA@@@@B@B@@@@@B@B@D@@@D@B@BC@ED@@AB@@BF@DBB@BB@@BD@B@B@F@FBF@B@F@B@B@B@B@@B@`@B@@A@@@@A@@CA@@@@@@@A@@A@A@A@@AA@A@AB@@@A@@AABAA@@@@A@@@C@@@AA@@@C@AB@@ABAB@B@@@@@B@@@B@@@BA@@BA@AB@@@BB@@@BBBB@@A@AB@BABABAB@@EAA@A@@@ABAB@@A@A@A@C@C@A@@AA@AAAAACCA@@A@AAAA@@C@A@@@@B@@@BABAB@B@@AB@B@BBB@B@BBBBB@FBB@@B@@B@@@B@B@@@DA@@F@@@@@@@BA@A@A@@@A@@@@B@@@B@@B@@@@@@@C@@BA@@BA@@B@@@D@@@B@B@D@D@BDDDB@@@B@@@@A@A@@@A@@@A@A@CAA@AAA@A@A@@BA@ABA@A@AB@BAB@D@@ABABCBCBAB@@AB@D@@@@AD@B@BBB@D@@BB@D@@@@@@CFA@@BA@@@AB@B@@@BBF@D@@D@BBABB@@BB@@@BB@@@DB@DBB@BB@@@@A@@B@@ABB@B@@B@@@B@@@@BB@@@A@@BA@@@AB@DCB@B@@AB@@@@ABA@ABA@A@@BA@@@@BB@@BF@BB@@@@@@ABA@A@C@C@@B@@A@@@A@A@@B@D@B@BA@@B@B@@@B@@@@@@AB@BC@@@AB@@@BAB@B@BADA@@B@BAB@AA@@@A@@@@@A@@BBB@@@BAB@@@B@B@BB@@@BBB@@B@B@D@@@@@@BBBADBB@B@@B@BA@@BAB@BAB@@@BBBBB@@@AB@B@@@@B@@@B@@@BAB@BBB@B@@@B@@@B@BA@@@@B@BB@@AB@@B@@@B@BB@@@@@B@@@@@@B@BAB@@@B@B@B@B@@BBB@B@@B@@BB@@BB@@@B@@@BAA@@@@@@@@AA@@@AA@@@@@@@A@@@@@A@@@@A@@@B@@@@A@@@@@A@@@@@A@@AA@@B@AA@@@AB@@@@A@@@@@@BA@@@A@@B@@AB@@AB@@@B@FCD@BAB@BAB@@B@@@B@D@B@@@BB@@@@B@@B@BB@B@@@@A@@@BB@@@B@@@@@B@@@@AB@B@B@@@BA@@B@@@@@B@@@B@@@@B@ABB@@@@@@@@@A@@B@@B@@@B@B@B@D@D@@@BA@@BA@@@@B@B@@@@BD@@@B@B@@@B@DBB@@@B@F@B@@@@A@@@CA@@A@@@@@@@A@A@@@ABCB@@@@AB@@@@@@A@AB@B@@AB@@ABA@A@@B@@@B@@@BDBB@@BB@@BB@BB@@@BAB@@@AA@@@A@AA@@@B@@AB@@@B@BAB@B@B@@@@@B@@@@@@A@@@@@@@AD@BAD@B@@@BBB@@B@@BB@@BB@@BBBB@@FBBCLIPEJEPEFAFCTAJANAD@NCLELIFG@@LG@@NKBC@@@CCGCE@AIEAAEAG@I@I@EA@@CCCG@@AIBEDGBCFGBEBC@EBI@C@@@GA@@M@C@CBABADEDC@AHGFEDCJK@@FGBG@CACIIEGACGECAKGEAACBG@G@@@ABGAGCCACEEEIEI@@AMAI@K@@BG@@BG@GAE@@GMCICG@ACCGCIAGAI@"],encodeOffsets:[[113389,38348]]}},{type:"Feature",id:"610828",properties:{name:"佳县",cp:[110.491345,38.01951],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@A@BA@@@AB@@@@@AAA@CAA@@C@@AA@@A@@AA@BAA@@BA@@B@@@@@B@@A@AB@@AB@@ADA@CF@B@BAB@@ABC@AB@BA@@@CCA@A@A@@BA@@B@@AB@B@@@@CCA@A@A@@@A@@A@CCA@@A@@@A@A@@@A@@@GB@@@A@A@A@@@AB@@ACAC@@@@F@F@@@@@@AC@AAA@@AA@@B@@A@@@@A@@@AA@A@B@@A@@@@BDBABBB@B@@BBBB@F@@@@CBA@@B@@@@BBBB@@@B@@A@ABA@A@CA@BA@AB@B@B@BAD@BABAB@@C@@@@@@B@@@@@BA@@B@D@B@@BBA@@B@@@BB@@@@@B@BA@@B@@A@@@@BB@B@BBB@BBBAB@@@B@@B@@@@B@@@@@B@@@@ABA@@@@@@@BB@@@@@@@BA@@@@B@@@@BB@@@@@@BA@@@@@@BBABABEDA@@AAAA@@@A@A@@@@BDB@@@@@B@@A@ABA@A@AB@@@BBB@@AB@@@@@@A@@@ABABAH@@@BA@@@A@@@A@@@BD@@AB@BABABA@@A@@A@@AA@BC@@BA@@@@CA@@@A@@@AA@A@BA@@AAA@C@A@@@@@BA@@@@@@B@AA@@@@AA@@A@@@@@@@@A@@@@A@@@@@A@@BA@@BA@@B@@AB@@BB@@@@@B@BABC@@B@@BB@@@@@B@@ABAB@BAB@B@@@@DDB@B@D@BB@@@@B@@@@@BA@@@A@@B@@@B@B@@@BB@@@B@@@@BB@@A@@@@B@@B@BBB@@@A@@@@B@@A@@BA@@@AAA@@B@B@@@DAH@@@B@BAB@B@@AB@@@@@BAB@BA@@@@BA@@B@@@@AB@@@BAB@@AB@@A@@BA@CBAB@@@BA@CA@@A@@@@BA@@AAB@AAA@@A@@@@BCD@BABA@@@@@@@@@@A@AB@DE@@AA@@@@@BCBAB@@A@@@@@A@A@@@@@@B@B@@@BCAA@@@@@@B@@BB@B@@A@AB@@ABAB@@BB@@BB@BBB@@@@AB@@@B@@A@@@@AAAA@@A@@A@A@@@@BB@BBB@@BBB@@A@@@@@AA@A@@@B@@@B@B@@@@@B@@@BB@AB@@@BA@B@@B@@@@@B@@@B@@@@@BA@A@@@@A@@@@@AA@@@@@@@@A@@B@@A@@C@@AA@@@AB@@AB@@@@@AA@@@A@@AAB@@@@BB@@B@@DA@@@@@BBA@@@A@A@A@A@ABEBCDA@@B@@AB@@AA@@@B@@AB@@@@@C@@A@@@@B@B@@A@A@@BA@@B@B@@@BA@A@@@A@@AA@@A@@@@AAA@@@@@AA@@B@@A@@@BB@D@@@@@@A@@@@@AC@A@@@AA@@AA@@@@A@@BB@@B@@A@@@@@@@A@@@@A@@@A@ABA@@@AA@@BA@@B@@A@A@A@@@@A@@@@B@B@@@@A@@AA@AA@@@AB@@@@CB@@@@@BB@@@@B@D@B@@@@ABA@A@@BA@@B@@A@@@A@AB@@B@B@BBA@@@@@@B@@A@A@@@A@@@C@A@@@@B@@@@@B@B@@A@ABA@@@@@@@@B@@D@@A@B@@BB@BB@@@@@BE@@@@B@@@B@@B@@@BAB@B@@@@A@@@A@@FABA@AB@@AB@@@@A@@@@A@@@AB@@@@A@A@@AA@@@A@@A@@BA@A@A@@@@@@@@@@BBBBB@B@@@@@@A@CA@@AB@@A@ABA@@DAB@@A@@BAB@B@@AB@B@@ABA@AB@B@B@B@@AB@B@@A@@@@@A@@DA@@@@@A@A@@@AB@BAB@@AAA@CAE@CA@B@@ADAD@BAB@@@BBB@@DBB@BBB@B@@B@BB@@B@BA@A@@@A@@@AB@BA@@B@@B@@@@@@@@BA@ABA@A@@@@@AA@@@@@B@@@@A@@@@A@A@A@A@@@@AB@@@BAB@DB@@DA@@BA@@B@@BBB@A@AB@B@B@BBB@B@B@B@B@B@@AD@B@@B@@B@B@B@D@B@@@@A@@@A@@@@BABABBFAB@@AB@@AB@@AB@@B@@B@B@@@@ABA@@B@@A@CAA@@@A@@@@A@@B@AA@A@A@@@ABA@@A@@AA@@@AC@A@A@ACAA@EA@@A@@@A@@BABA@A@CBA@@AA@A@A@@@@AA@@@@ABA@A@@BA@A@@A@@@CFABA@@@A@@@@A@@B@DC@@@@@A@@@AA@@@A@ABAB@B@DA@@BA@@BA@@AA@@@ABAB@@A@A@A@@@@B@@@@@BD@@@@B@@@BB@@@BBB@B@@@@B@@@@AB@@@@@@B@@B@@@@@B@@A@ABA@A@@@@@@A@@@A@@A@AB@@@@@BA@A@A@ABC@@@AB@@@@B@@B@@AB@@A@@@@A@@@@A@@B@@@B@B@BA@@BA@@@A@A@ABAA@@@@A@A@@@A@@A@AA@@@A@@@A@@BAFAD@@@B@@@@B@@@B@@@B@@@AB@B@@@@A@AA@B@@A@A@ABAB@@AFA@@B@@A@C@@@C@@BAA@@A@@@CB@@@@A@@B@@A@@@A@@@A@@@AAA@@@A@A@@@A@@@@@A@A@@@AAA@A@@@@AAAACAA@C@@@AA@ABE@@@E@@@ABABC@@AC@CAA@A@@@@B@@@BBBDDBB@D@@@@AB@B@@BB@B@BA@ABC@@@AA@@@A@@CACA@@A@AA@@@A@@@@BA@A@AA@@@A@@@C@@BA@@A@@@@@A@@@AA@EAC@A@AB@@@@@B@@@@BBAB@@@B@B@B@BA@@@@B@@@BB@@@DBD@@@@B@@@@A@@B@@@BAB@@BB@@@BB@B@@B@@@@BBB@@@DB@@B@@B@@@BBB@@@B@@@B@@@B@BBF@B@B@@@@ABA@A@C@A@A@@@@BAB@BAB@@@@D@@@@D@@B@@B@BCDABCDABABA@ABC@G@CB@ACA@@C@@@A@@@@BBF@@@B@@B@@D@B@@@BBDA@ADA@@BA@ABA@A@@@A@@A@@A@A@@@A@@B@B@B@B@@@@@BA@AB@@A@@@A@A@AAA@@BABA@A@CAC@A@A@A@@@AAA@C@A@A@@B@@A@@@B@@@@B@@@@@BBB@BAB@B@@AB@@CA@@@B@@@B@@AB@@ABABABBBBB@B@@@B@@D@@BB@BD@@A@@@A@@@A@@@C@A@@@A@A@CB@@AB@@@@CBB@@@B@@@B@BBBB@@A@AAA@@@@@@@AB@B@@@@A@@BB@@B@@@@A@@@@B@B@B@@@B@BBB@@@@@B@B@@@B@B@BA@@@EBCBA@A@AD@@@BAB@@A@@B@@@@BB@F@BBB@B@@B@@@@@DA@@B@@B@B@BA@BB@@@D@@@B@@@@A@BBA@@B@@ABA@CBEBA@A@ADABABA@CBAB@@ABCB@BA@AB@B@@AB@DA@BB@@@ABA@@B@BBB@@B@@@BBB@@@B@@@BB@BB@@B@BAB@B@@BAB@@@BCFA@@BA@A@A@@@@BDB@@@@@BA@A@@@ABAB@@@@AD@@@@@BA@A@@@AB@@@B@@@B@@AB@@AAA@AAAAAAA@@@@@A@AB@@AB@@@B@@CBABA@A@ABA@@BABA@AB@B@B@BABA@@@A@@@AA@@AAA@AA@@AA@@@@A@@@B@AB@@@B@@BDBB@BB@@B@@@B@B@@B@@@@@B@@BB@@@B@HC@@BBB@B@@B@BA@ABE@ABA@A@CBA@A@@@@@@@@DB@@A@B@@@@@@@@@BB@@@@@@@@B@B@@ABA@A@AAC@EBA@A@@B@B@B@@D@D@@@@@@@ABA@ABA@C@C@ABA@@@A@@A@@@A@@@AC@ACA@@@A@@@A@ADA@@B@@@@@@A@A@C@A@@@AB@B@@@D@B@BAB@@@BCBA@@@@B@B@@@BB@@B@@AB@@@@B@@B@@@@@BA@@B@B@@BD@B@@@B@B@B@@@BAB@B@@@B@@@BBD@BB@@BBBBBD@B@@@DABBA@@@@@BBBBB@@@BA@@@@@B@@A@CBA@AD@@AB@BA@A@@@A@A@AAA@@A@@@AA@@@@@C@@BA@@BCB@BAB@BA@@BAF@@@@AA@@C@@AA@@@AA@AA@@@A@@D@BAB@DA@@BA@@B@@BBH@@@B@@BA@@BB@B@B@B@BAB@BD@B@B@@B@@@@D@@BB@BB@@@B@@BB@@@B@@@@ABB@@@@@@CBAB@@A@@B@@AB@@@BB@@B@B@@AB@@@BAB@@@@@B@@A@AB@@B@@@CB@@@@@B@@@@A@@@@@@@@B@@@@A@@B@@@B@@A@@@@B@@@@B@@@B@D@@BB@@@@B@@@B@@AB@BA@AB@B@@A@A@AAA@@@@@@B@@@@@@B@@@BB@@@BBB@@AB@B@@BB@@@@FAB@@@B@@DBB@@@BA@B@@@ABAB@@@B@BCB@BBB@@@@@@@@@B@@@@@B@@ABBB@@@@@@@B@@@@@B@@ABA@@B@@@@B@@@B@@B@@ABABCB@BAA@B@@A@A@@@A@@BA@@@A@AB@@@@@B@@B@@@@@@B@B@@@@B@D@D@FAF@B@@@@@BB@BBD@@@@ABA@ABA@AB@B@@B@@@BA@@B@@@@@@BAB@BA@@@@@@@B@B@@B@@ABBB@@AB@BDF@@B@@@@@DAB@@@D@@@B@D@BB@@BBBAB@@ABA@@BA@@@@@A@@B@@AB@@A@@@@@@@A@@@@@A@@B@@A@@B@@@B@B@D@BAFA@@@@B@@B@@B@BAB@@AB@@@B@@@@B@@ABABAB@BA@ABAB@@BBBB@@B@BA@@B@@B@@AB@BBB@BB@@@@BBDBBBB@B@BA@AB@@A@AA@@A@@BA@@B@BB@@@B@@@D@B@@B@@AB@@A@BB@@@@BAB@@@@BB@@@@@BC@@@@@@BB@@@B@@@D@@AB@@@B@@@@BB@@@@@BB@@@@@@@BB@@@B@@AB@@@@@@BB@@B@@@BA@@B@@@@B@@ABABCB@BA@@@A@@AA@@@A@@@A@@B@BA@B@@BB@BBB@B@@@B@@BB@@@@B@@@B@B@@@BB@@D@@@B@@@BBB@@BD@B@@@@DA@@BAD@@@B@@B@@@B@@@@@@B@B@@A@@@A@@@AAA@@@A@@B@BAD@B@@@@B@B@@@@B@B@@@BA@AB@@AB@@AB@B@B@D@@@@A@A@A@A@@B@@@B@@BBBB@@@BA@@A@@A@A@@@@@@B@B@@BB@B@BB@@BABAB@B@BAB@B@BA@@@ABA@A@@B@B@@@@AA@@@A@AB@@AAB@@A@@AA@@@@B@@ABB@@@@BA@@B@@B@@@B@@@@B@D@B@B@BCB@BAD@@@@@@@BA@@BA@@B@DAB@@@FABA@B@@@@BB@@B@@@B@@@@DB@@@@BBB@@@BB@ABB@@@BB@B@D@B@@BB@@@@@@@AB@@@@BB@@@BB@@@B@@@BB@@B@@@@B@@@@B@@@B@@@D@B@BA@@BABCBABA@A@A@G@@@@@@@@B@@BB@@B@BBB@B@B@@AB@@AB@BB@@BBD@BB@@@@@BBB@@@@@AB@@AB@@@B@@BB@@@BDB@@DB@@BB@@@@@BAB@@AB@BA@@B@@@B@@@B@BBB@D@FAB@@@BA@@B@B@D@@BD@B@B@@A@A@@@@@CBA@@@@@CAA@@@A@@AA@@BA@@D@BAB@BAB@B@@@B@B@BABB@@B@@@@@@@B@@ABAB@@ABABAB@@@DA@AB@B@D@@AB@@@@@@@@@DB@@B@BB@@BB@@B@D@B@BA@@B@@B@@@B@BA@ABABA@@@A@@@BBB@DBD@BB@@BB@@@BA@AAAB@@A@@AAAA@G@@@ABA@@@C@CA@@A@AB@@@BBB@BB@FB@@B@@B@@@B@B@@@@DB@@B@BAB@B@B@@@B@BA@@DADAB@B@B@@@@BB@DB@@@@@BB@@BB@@BHBB@@B@@AB@@C@@B@@@@@@B@DAB@@@@@@B@@@BD@@@@@@@@BABAB@BA@AB@@@BB@@@@B@@CD@@@@@D@@B@@BB@BDBB@@BBA@@BC@@@C@AB@@@@@B@D@B@@@@BBBBBBD@B@@@B@@B@@@B@@D@F@B@DBBB@ABA@A@A@A@@@A@@BA@@A@@A@@@A@@@AAC@@B@B@@A@@@@BA@@@@BA@@@@@@BA@@B@@@@AB@@AB@@A@@@@D@B@BCB@@AB@@@A@@@C@@@A@@@@A@@@A@A@@@A@@@@@@@A@@@@@A@@@@B@@@@@@@@A@B@AB@@@@@@A@@B@@@B@@A@@BAB@@@@@@@B@DB@@@B@@@@@@A@A@@@@BB@B@@BDAB@DAB@B@@BBB@B@@@@A@@@AB@@A@AA@@A@A@@B@@BBFD@BB@@BB@@@BAB@@@B@BA@@@@@@@@B@@@B@@@BAB@A@B@@@B@@@@@@AB@@@@AB@@@@AB@B@DBB@@@B@B@B@BB@@@B@@AB@@@@B@@@D@@@@@@A@@@ABA@@B@@A@AB@B@@@@@@@BA@@B@@@BADA@AHAB@B@F@B@DBBBB@B@@BB@@@B@@B@@@@B@@@BB@@B@@@BBB@B@@B@@B@B@@BBB@@B@B@@@B@@BD@BB@@B@B@B@@BB@B@B@@@@@BB@@@BB@@@BBB@BB@B@@B@@B@BA@BB@@BB@@B@@@BB@@@@C@@BA@CF@BAD@B@@B@@@@@DAFGBA@@@@BBB@BB@A@@@ABAB@@AB@@@B@@@@@AD@B@@B@B@B@@BB@@BB@B@B@@@@@@BAD@@@B@@B@@@DAB@@@BA@@B@@BB@@B@@@B@@@BB@BFBD@@@B@@@@D@B@B@@B@@@B@@AB@@AB@@@BB@B@FBDBBBB@B@@@DAB@@ABAB@F@BAB@AA@ACCCCA@AAAAA@@A@@@A@@BA@ABAB@DAD@B@D@BBJDB@@@@@B@@AB@AA@A@AAAAAAACC@@@@@ABA@@@@BAD@DBB@FBHBBBDB@@BBBA@@B@DC@@BA@A@E@A@AAAA@CACAG@AA@@@@@AAABA@CBE@ABA@CB@@ABA@AB@@@B@@@B@@BDB@DDD@B@@B@FBB@@@D@B@@ADADCBA@A@@@A@AACCCCACCCAA@A@@A@@AA@@@A@AB@BADAFADAD@BADBB@B@BAB@@A@A@@BA@A@@@ADEDG@@B@@@BCAA@@AACAE@AAAAA@AA@@@@@ABC@@BABABABAB@@@B@B@B@D@BBBBB@H@DBB@BAHC@A@@BA@@AA@A@@@AB@@@@AB@B@@@@A@AA@@@A@C@A@AAA@CA@@ECC@@AA@AAAAA@@@@A@@B@BA@ABA@@A@A@@@@A@@@A@@A@AAA@@@A@@@@ACA@@@AAA@AB@@A@@BAB@DAB@BABA@@B@BBD@D@DBB@DBB@B@BABC@A@@@A@A@@AACAC@C@A@A@CB@@A@@@A@@A@@@@@A@@BA@@DABABAB@BAB@@@@A@@AC@A@@@A@AB@@@B@@@B@FBB@@AB@@CBA@A@@@@@AA@AA@@@A@@@@@AB@DAD@FA@@@A@AB@BAB@B@D@D@B@@@BAB@@@B@@@@@@@@BB@BB@@BA@@BC@@BA@@BA@@@A@@EACA@@@@AC@A@@BA@@B@B@B@FBB@B@BBJFB@B@BAB@BADCDCBC@A@@BAA@@A@AAAAAA@A@E@C@@@A@@A@@@AD@HCB@BABA@A@@CAGGGIGO@@AMAS@S@EBM@CDMJOJQ@ABIAICGQSOO@@AI@I@A@CDEBEFIDM@K@KISAAAM@A@E@E@EBEBEBIBCFEFCB@LCFCDEHM@@DG@ECECEGGEIAI@KBEBCHKBAJEBAFADA@@JELEDCD@HCJADAHA@@H@D@B@B@BAD@HEFCNELG@@NS@@DIDG@ABGBEDADEFGFEFGDGHIBAHEDGFEAC@C@ABADAB@BAHIPQ@@HSJQ@S@@BGFIDCBAHCDCFEJEdMbKHANC@@FAFCJCJERMFCFEHI@EAE@@GIGGC@EAEAMAEAGAYCQI@@GK@@@G@C@ABEBAEA@@AAAA@@AA@@AA@@@AA@AA@@A@C@ABC@@B@@@@@@@B@@@@A@@@@@A@A@A@ABA@@@A@@BA@@@B@@B@B@@BB@@A@AB@@A@@AAA@@AA@@AAAC@@A@@@A@@@@BAB@BA@@BA@A@@B@B@@@@A@@B@@A@AD@B@@@B@B@@@@@@@BB@@D@@@B@@A@E@A@@@A@CAA@@@A@A@@@C@@A@@A@A@@@@@AB@@AB@@C@C@A@A@A@@@A@@@@AB@@@@@@@@@A@BAA@@@@@@A@@@A@@@@@AB@@A@@@A@ABA@@@@@A@@@@@A@@AA@@B@@@@@@AAAA@@@@A@@A@@A@@@A@C@A@@@AA@ABA@ABC@EDA@@@A@@BA@@BA@@@@B@@AB@@@@@@@B@@A@@B@@BBA@@@BB@@@B@@@@@B@@@@@B@@A@@@B@@@@@@B@@@@@B@@@@@@BB@@B@@B@@@@@@B@AB@@A@@@A@@AA@@AA@@@@AAA@AA@A@A@A@@@A@ABA@@@@@@@@A@@@@AAA@@@A@@@BA@@AAA@@@@@ABA@@@A@@@A@A@AAA@AB@@A@@@A@@@@@@ABA@@A@AAAA@@A@ABA@AB@@ABA@@@@AAABCAA@A@@@@C@A@A@@@AA@A@@AAA@A@@@A@AB@@A@AA@@@B@@@@@B@@BBA@ABA@@@CBABA@A@AB@@A@@B@@ADA@@B@@@@A@@@A@A@@@ABA@C@A@@@@B@B@@@BA@@@@D@D@BAB@B@@@@A@@AAE@@AA@@@@AB@@@BAB@BAB@B@@A@@BA@A@CDA@@B@@AB@@@B@@AA@@@@@A@@@A"],encodeOffsets:[[113207,38633]]}},{type:"Feature",id:"610830",properties:{name:"清涧县",cp:[110.121209,37.088878],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@ACCAAA@AAA@AC@A@@@AB@A@@@@@@@@BAD@@@@A@@@A@A@@AB@@@@AA@@@@AB@@@@@AAAB@@A@@@A@@@A@BB@@B@@BA@@@AAEAA@@@@A@@@AB@@@@A@@AA@@AA@@B@B@@@BA@@B@B@BBB@BB@BBB@BB@@@B@@@@A@AA@@A@@@@@A@@@@BAB@B@BC@@@@@A@AA@@@B@@@DB@@D@B@BBBBB@BBB@BA@@B@BEB@@CBCB@@A@A@A@A@AAA@@@AB@B@B@@AAA@@@@A@@@@CA@B@@@@BBBD@@A@CBCBABAB@@@B@@DDBB@BBBBB@@@B@@A@@@A@A@A@AAEAA@CA@@A@@AC@C@@@BA@@@AA@A@ABAB@@C@A@G@@@@@A@@BAB@@@@A@@@@@BA@A@@B@AAECA@AAAA@@@@@AB@@@B@BA@@B@@@@AC@@@AA@A@@AA@@@A@@@ABA@A@A@@@@A@@@A@@B@@@B@@@@@@AAA@@@AA@@AA@@@ABA@@A@A@@@AAA@C@A@@@AA@C@@@A@C@A@@AB@@EB@@A@@B@B@BBBBBB@@@@B@@CA@@@BBB@B@@@B@@A@C@@AC@AAAACAC@A@A@ACA@ABAB@@@BCAA@A@A@AB@@@@A@@@@@@DBB@BB@@@D@B@B@@@@B@@C@@BB@@@B@@@@BA@@@@AA@@@@@ADA@B@AB@@C@A@@D@@@@@@B@@@B@@@AB@B@@@B@@D@@@D@F@B@@A@@BA@@@@@@B@AB@@@@B@@@@@@@@B@@CBA@@B@@@@D@BABA@@@B@B@B@@@BB@BA@@@@@@B@BF@@@BAB@@@@@AAA@@@@@B@@@B@@@BB@B@@@@@A@@@@BA@@B@@AB@@CBA@A@A@@@@B@@B@BB@@@@@BA@@@@@A@@@A@@@@@AB@@@@A@A@CAA@A@A@@@AB@@E@@@@@@@BA@A@@@@@@A@@@ABAA@@@BAB@@@@A@@@@@@A@@AA@@@@@@@@@D@@@@ABA@ABA@@@@@@@AAC@A@@@@A@@@@@@B@B@@@B@@@A@@@EAA@E@@@@B@@@@BB@@@@@BB@ABAB@@@B@BAB@@@@E@@@A@@@A@@B@@A@CB@@B@@B@@@@@BA@@B@@@BABAB@@@@@B@@@@@B@@A@CBA@A@@@@A@@@@@@A@@@@@@C@@A@@B@@@@@BA@@@A@@AA@@B@@@B@@A@CBA@A@AA@@A@A@C@@@A@@B@@@@AAA@A@@@A@@@@B@@@B@@@B@@A@@@A@@@A@@@@@A@@A@@@@BC@@@@@@A@A@@@ABAB@@@BA@CA@@@@B@@@@A@@@@@@@A@@BB@@@A@@@@A@@AA@EC@@AA@A@AACAA@@@@@@@BA@@@A@ABE@@@A@@@@A@A@@@A@@@@A@ABAB@@@@A@A@@@A@@A@@BA@@B@@AAA@@@@A@A@@@@@@A@A@@B@@@@@AAA@A@@@A@@@@@A@@@@A@@@A@@@@@A@@B@@@@A@@@@A@@@AA@@@@AB@A@@AAB@AA@@A@A@CAC@@@CACAA@A@AA@@A@@@@B@BAA@@@@BA@@@@A@@@@@@A@@@@@A@@AB@@@@A@@@@AAA@A@@AA@@A@@@BB@@A@AA@@@A@@A@@@@@ABA@@@A@@@BA@@@@BAA@@A@@A@AB@@@A@A@@@@CA@@@@@@BABBBA@@@@AAC@A@@@@@A@A@@@@@@A@@@@BA@@@@@@A@@A@@@@@A@@@@A@@@A@A@@@@BA@@@A@@A@@A@@@@B@@B@@BBBDB@@@B@BAB@@@@A@@@AA@@@@@A@@@AA@@A@@@@@A@@AB@@@BA@@@A@AC@@@@A@AB@B@BA@@@@@A@@@@@@A@@@@@A@@B@@AA@@@@@CB@@A@@@BA@@@@@ABA@AA@@@A@@@@@AA@@AAA@A@CC@@A@@@A@AB@BCBABA@@BA@A@@@ADABA@AB@@BB@@A@@@@@AA@@A@@@BD@@@@@@A@@@A@@@@@A@@B@BB@@@@@A@AB@@@@BB@B@@ABA@A@A@@@@BA@@AA@@@@@@@A@@@@@@@AA@@A@@@@@@@@BA@@@@@@@AA@@@@A@@D@@@@C@@@AAA@A@A@AB@@AB@@@@B@@@@@BA@@@B@@@@@B@@@@C@CA@@C@A@@@@@AB@@B@@BB@B@B@BB@@BB@@@B@@@BAB@BABA@@BAB@B@B@@@@@@AB@@@@@B@BAB@@A@ABAB@@A@A@@B@B@@@@A@@@A@A@AB@@@B@@A@@@A@C@@@@A@@@A@@@@@@@@C@@@@@AB@B@@@B@@A@C@C@AB@@AB@BAD@@@B@BDB@@@B@@EDA@@B@@@B@@@@@B@@@@A@A@A@@@A@@A@@@@A@AF@B@B@@A@@@@A@@@@AAC@AB@@@@@@@@BB@B@@A@A@@@@A@AA@@CA@@@A@A@A@@@AA@@C@@B@@@@@B@@AD@B@@@@BB@@@@ABA@CAA@@@@BAB@BAB@BA@AB@@@@@F@@@D@@@@@B@B@B@BBBBBBB@BBB@@B@B@@@B@@@@@@A@@B@@@@@BB@BAB@BAD@BAD@B@@ABAD@@@B@B@@D@@@BD@@@BA@@@AAEBA@@BA@A@@@ABAB@BAB@@BD@B@@@BA@@@A@@@A@@BA@AB@@@B@B@@@@@@CB@@@@@@BB@B@@CB@D@B@@@DA@@B@@C@A@@@@B@@@B@BBB@B@@AB@BA@@B@@@D@B@@@DBBB@@BB@@@@BB@@@B@@BB@@B@B@BBB@@@BBB@B@@@B@@@B@B@@@B@@BD@DA@@B@@BD@@@BBB@B@B@BA@@BB@BBD@BBBBBB@B@B@B@B@BAB@BB@@@BB@B@B@@ABABABCDADABA@@@CAA@@@@@DB@@@@A@A@@BC@A@@A@A@A@@A@AA@@BA@A@@@@A@@@ABABA@@@@@A@@@A@@@A@@A@@@A@A@@A@@@@@@BA@@@A@@@@@@A@@BA@@@@A@@@ABA@@@@AB@@@BAB@@@@@@@A@@@BA@@@@@@@@AA@@@A@@A@@BA@@@@@@@@@@C@@A@@AA@A@AB@@ABA@@@@A@C@AAA@AAAAAAA@@@@AA@@A@@BABCBABA@AAAB@@AB@@AB@@@@@@AB@@@B@@A@@B@@@@@BA@@@@B@@AB@@A@A@@@@B@@A@@B@B@@@@BB@@B@B@B@@BB@AB@@AB@@CBCDCFAB@@AB@AAB@BA@@@A@AB@@B@B@@B@BB@@BB@@@B@BBBB@@@BABB@@@DBB@@@BABAB@B@@@@B@@@B@B@@@@B@@@B@@@@A@A@@@@B@@@B@B@@@@@B@@D@@ABA@@@@B@@@B@@B@@@B@B@B@FBBA@@B@ABA@@@AB@@A@@B@@B@B@@@@@@B@B@@@B@@@BA@@BC@@@@@AB@BA@@AAB@@@BAB@@@BB@@B@@ABA@ABABA@AA@@@@@B@BA@@@CA@@AB@@@B@BAD@@CBA@@A@@AAA@@BAB@@A@@@@A@@A@@@ABABA@@B@BBB@B@B@BAH@@AB@@C@@BAB@D@BABA@B@B@@@@BA@@@A@A@@@@@DBB@B@@@BBAB@@A@@B@@@@BBBDBBB@@@@@BA@AB@@@BBBBB@BB@@B@@AB@@@B@DBB@@@@@@A@@@@@ADC@@AABA@ABA@AB@@AB@@@B@@ABABC@AB@B@B@@@@AB@@@@B@B@@@@BBB@@A@@@@A@@A@A@@@@@A@@BAB@B@DCB@BABBD@B@BBBB@@B@BAB@B@DB@BBBB@@B@D@B@@B@D@@BBA@@@@@A@@B@@@@@BBB@AB@@@B@BBD@B@D@BAB@@@B@@D@B@BB@B@B@@@@B@D@@@@B@@A@ABE@@B@@BB@@@@A@@B@@@B@@@@BBBB@@B@BBD@BB@DB@@DB@@B@B@B@@@@@AB@@@@BB@@@@BBB@@B@@BBBB@@B@@B@@AB@B@B@BB@@@FA@A@C@@@@B@@@B@DBB@@@@BAB@BAD@DA@@BA@@B@@@BB@@@B@BA@A@AA@@A@ABA@@@@@BBB@BB@@@@ABC@@@@@CBA@AA@@AAA@ABA@A@@B@@@@@@@BB@@@@@AB@@@@B@AB@@@BBA@B@@@@@B@@BB@@@@BB@B@@@@@@ABA@@@@BBB@BCBAB@@ABABA@C@@@A@@BAD@@@BA@A@AACAA@C@@@AB@BA@C@@@@B@@@BD@BBB@DBBB@@C@A@A@AB@B@B@@B@B@@@B@@BAB@@A@AB@@@BB@BBBB@@@@@AB@@@@@B@B@BA@@@@@@A@@A@@@A@@@AB@@@B@B@BA@AB@B@@BB@D@@AAA@AAABA@A@@FCBAB@@@@B@@@B@@B@@@@@B@@C@@@@@@D@@BB@@@BA@A@@@A@@BBBB@@DB@@BB@B@B@@@@@@B@@@B@B@B@@A@@@A@A@@@@B@@@B@BB@@@B@@@B@@@@@@@@BC@@B@@@BB@@@@BA@@B@BB@@@@B@BABBB@BB@BB@@B@B@@B@@@@B@@A@@@@@@BB@B@@@@BA@@@@@@@BBD@B@B@@@@BA@@B@@B@@B@B@@@BAB@@@@@@AAAA@@A@@BDD@B@@@@A@@AAA@@@@@B@@BBB@@B@@@BB@@BBBAB@B@@B@@@D@B@B@@BB@@@@BBBD@@B@B@B@@B@@B@@AB@@@@@B@@A@ABA@@B@@@@BBBBB@@@ABAB@@@@B@@@B@BADC@@BAB@@@@@@B@BB@BBBBB@FBB@B@D@@B@@ABABA@AB@B@@@BA@@B@B@@A@@B@@@@@@BB@@@BB@B@BB@@@B@B@B@B@@@@AB@@@@@B@@@B@@A@A@@@@@@B@@@@@BB@B@@@AD@@@@BBB@@BBB@@@B@B@@@@D@B@B@@@B@@BA@AB@@@@@BA@@B@@@@AF@B@B@@BB@@AB@@AAE@@B@@@@BBDBB@@BDAB@@@@@@@@BA@A@@BA@@@@BB@@@@@@B@B@@@BABAB@B@@@B@@@@B@D@BB@@@@BA@@B@NB@@BB@@B@@@BAB@B@B@@B@@@BABA@AB@B@@@BABAD@@@B@@@@B@@@B@BA@@B@@@BD@@@BBB@B@B@B@B@BA@A@AB@@@@BB@@@@@B@B@BFH@@B@B@B@B@B@BBB@BBB@@@B@@@B@@@BBB@@@B@@A@@B@@@D@@@B@B@@@@@B@B@@@AD@@@B@@B@B@@@DCBCBA@@@AB@B@BABAB@@@B@B@@@BB@@DB@@@@@B@B@@@@@BBB@@@@B@@@B@BA@@BA@@@@@@B@BB@@@B@BB@B@@B@@ABAD@@BBAB@B@B@DB@@@BB@@DB@@B@BDBBB@@@@@B@@@@B@B@B@B@@A@DBB@@@BB@AB@@@B@@@B@@@BAB@BBB@@@@@@@BA@ABCBAB@BA@AB@@@BA@@B@B@@@DC@A@@@A@@@A@C@@BABA@@@@B@@BB@DCFA@@@A@A@@@@@A@C@@BA@@@AB@BBBB@@B@B@BBD@DDDBB@@B@@@BD@B@B@@@DD@@B@@@B@@@@@BB@B@@B@BAB@B@@@B@BBBB@BB@@@B@BA@@B@B@@BB@BBBABBB@BB@@@BB@@@BAB@B@B@D@B@@@DA@@BA@@@@B@@BAB@B@@BBB@@BB@@BABAB@@BBDBB@B@@@DBDBD@@@@@@B@B@B@@B@DA@@D@B@D@@@@@@B@@@B@@B@@@BA@@B@@@@B@@@BD@B@@@@@B@BA@AB@@A@@B@DABA@@@A@@@@@@B@HBB@D@@@BA@@BAB@B@BBB@DBB@B@@@@A@@@@BA@@@B@@BABABABAB@B@B@@A@BB@BA@ABA@@@@CA@@@@@ADEBA@A@@@@B@BB@@@B@@B@@@BA@@@A@A@A@A@@BC@A@AA@@@@@@A@ABA@AAAA@@@A@@@@@@A@@@A@@@@A@@@@@@AA@@@A@C@@AA@@@AC@@AA@@AC@@A@C@AAA@AA@@AA@@A@A@@@A@A@@@CAAB@@A@@@A@@A@A@@B@DABABABAB@BA@@@@C@@@A@@@AA@A@A@@@AD@@@@A@ABCB@B@BAB@B@@@B@@BBBB@BA@@@@@A@AA@AAA@A@A@ICAAA@@A@CA@AA@AAA@@AA@A@@BAA@@@AAAAA@A@A@@A@@@@@A@@@@@@@ABA@@BC@AB@@@@A@@@AA@@@A@@BA@@@A@AA@@AA@A@AA@AA@@@@@@B@@@@AB@AA@ABA@CA@@@AA@@@A@@BA@@@@BA@A@@@@@A@@@@B@@@BCBCB@D@B@@@A@CC@@@@B@@@B@B@BB@@@B@@@@AB@@A@@@A@A@@@BB@@@@@@@@A@@@@BB@B@BBB@B@BB@B@@@B@@B@D@B@B@BA@@@@FB@@B@@@BABAB@@@B@@@@@BBBB@BB@B@@@AB@B@@@B@@@BB@@B@@D@@@@@@@A@@B@@@@@@@@B@B@@@B@@@BA@@B@@@@@@BB@BAB@DAB@@@BB@@@D@@@B@@BD@B@BD@DBF@B@@AB@@@DBB@B@B@B@@B@BAFAB@@@@AB@@AD@@@B@D@@B@BBB@BAF@B@@@AB@B@@@B@@@B@@BB@BB@@@B@B@@A@@@A@A@@BAB@@ABA@AB@@AB@B@@@D@B@@@@CB@@A@CBC@A@@B@@@FDBB@@@@BAB@B@@A@@B@BB@@@@B@@@BA@@@A@@@A@@@A@A@@@@AA@@B@@A@@B@BCA@@A@@@AA@@A@@@@B@B@B@B@@@B@@@B@DA@@B@F@B@B@B@@@B@@@@@CC@@@@@@@AA@@A@A@A@AB@BDFBBB@@B@@A@@B@A@@A@AA@BA@@B@B@B@@@@@@AA@@@@A@@B@B@B@@@B@@AB@@@A@@C@E@@@@@@B@BBBBBB@@B@B@@@D@B@DABAB@@A@A@@B@BAB@@A@@B@@@B@@@@@DA@@BA@C@A@@@AB@@AB@@@D@@@@ABA@@@@DABA@@FAB@@@@@@B@B@B@BBD@BB@DD@BB@B@@AB@@ABBBB@@HAFAB@@@B@FB@@B@@@B@@B@@@@B@@@@@@BBB@@@B@@B@BAB@@@@BB@BB@BD@@@B@B@B@@@@@B@@@@@B@@@@@@@@@BA@@DC@@B@@C@AB@@A@@@@@@B@@@BADAD@@@BABAB@BAB@FA@@BAB@@@@@@@BB@@@@@@BAB@@A@@BAB@@@D@@@B@@@@B@@@@@B@@B@@@@@@B@@B@@@@@@@@BB@@@@@@B@@B@B@B@@B@@BB@@D@B@DB@B@@B@@@@A@A@@BA@ADA@ABA@@@ADCB@@@@@BD@BBB@@DB@@@@@BB@B@BB@@@B@B@@@@@@B@BBB@D@@@BA@@BAB@BAFA@ABA@C@@@AA@@@@A@C@A@A@C@A@@@@A@A@@@@@@A@ABAB@A@@A@@@@BA@@BA@@B@@@@@@A@@AA@@@A@@@@B@B@@BB@DABAB@B@B@@@B@@@@@@BA@B@@B@BBBB@BBBDBB@BB@@@B@@@DCBABC@@@@BA@B@@BB@@@@BBBB@@@BBDB@DD@B@@@B@B@D@@@BDBB@B@DBB@@@@@B@@@B@@BBA@@B@@@@@@D@@@@BBD@@@B@@BA@@@BB@@BAB@B@BB@@B@B@B@B@DAB@B@D@@@@@@D@B@B@BB@@@@A@@B@@@B@@@BB@B@@B@DAB@@@@@@B@@AB@B@B@D@@BB@@BBB@BB@@@B@@@@@@A@@@@@@B@@BB@@@@AB@@@@B@@@B@@AB@@@@@@B@@@D@B@B@@D@@@@@AD@BA@@B@@@BA@ADA@@@A@@@@AAA@ACA@AAA@A@A@@DA@@@@A@A@@@C@@@C@C@ABA@AB@AAA@@CAA@@@@@@D@@@BAD@B@@A@@@@@AA@@@@A@A@@@@B@BAD@B@@@B@B@@@@AB@@@B@B@@@@B@@BBBBB@BD@@@DB@@B@@@B@BABA@@B@ADA@@@D@B@@@B@@B@@@B@@@@B@@AB@@@B@@@B@@@@@@AB@@@B@B@DC@@@B@@BB@@D@D@B@@BBB@@@@BA@@@@BBBB@@BABABABAB@@A@ABA@@B@@@@AB@BA@@B@@AAA@@B@@@B@@@AAA@@A@@@@D@BD@@B@@A@@B@@@@@B@@@BB@B@@B@@A@@AC@@CA@@AA@CAAAA@@A@AAAA@@@A@@@A@@@AD@@@@@AA@C@@@A@@B@@BB@@@@@@A@A@@B@@AAA@@@AB@@@B@D@B@@@@@@A@@A@AA@@@A@@D@@@B@@@@A@A@@DAB@@@@@@B@D@@DBB@BB@@@@@B@@@B@@B@BB@@B@@@B@B@@A@@@B@@BB@@B@@@@@@@@@B@@@@@@@@AB@@@@@BBBB@@D@D@D@B@@AD@DCB@@@B@@BDB@@B@@@@B@B@@B@BAB@@@@A@AA@AG@A@A@AB@@@@@BA@ABADCBABA@ABABA@A@@@@@@@ABC@A@@BA@A@A@@@@AAAA@@@@@A@@AA@AC@@@@AA@@AA@@A@C@A@A@@D@DADA@@BBB@B@DA@@AG@MACAI@@CCCEKEEEEGCM@@EGGGKICAIIKMIICEEGQUGGCCOOSQEEOIIEKIAAGEGGCE@@@GAE@@@GBGBI@GCEGIEE@@OEQG@@ECIE@@GEEEIM@@EICCKGEGCC@@CG@@GKCEMKICKAMBI@C@KAGAICCC@@CCAG@CBABADAHCLATAH@FAFEBCAGCEECGAG@I@KDGFMLEDGDKBKBSAG@ECEC@@AEBG@ABC@AHILINC@@NEHAFE@@HIHMDGB@CA@@@@@@@@@@@@A@@@@A@@@BA@@A@@@@@@BA@@A@@@@@AB@@@@@@@A@@@@A@@@@@@@AA@@B@@@@@B@A@A@@@@A@@@@@@A@@@@@AB@@@@@A@@@A@@@@@AA@@@@BA@A@@@@AA@@B@@@@@@@@A@@@A@@@@@@B@@@@@@A@AAAB@@A@@AB@@AAA@AA@AAAAAA@@@@@A@A@@A@@@@@@DAB@@@@A@@ABA@@@AA@@@@@A@@B@@AB@@AAAACAAA@@@B@@C@A@ABC@@@@@ACA@AA@@@@@BA@@@@@@@@BB@@@@B@@AB@@@@@@B@@@@B@@@@C@@@@BA@"],encodeOffsets:[[113025,37850]]}},{type:"Feature",id:"610831",properties:{name:"子洲县",cp:[110.03525,37.610683],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@ABABA@A@@@A@A@@@@A@@A@@B@@AB@B@@A@@@@@@A@CB@AA@CAAA@@@@@AF@BB@@BA@@AA@@@A@ABE@@@@@AB@@A@@@@BAB@@AA@@@A@A@C@@@@@@A@A@@AA@AA@AA@@@@BC@@A@A@@A@@@@@A@@@@B@B@@@@A@@@A@@@@BA@@@@@A@A@A@A@@AAA@A@@A@@AA@@@@@@@AB@@@@A@AB@@A@@@ABAB@BABA@@@AC@A@A@EAA@AAAAA@@A@A@@@@A@AB@@CDABA@@@A@@@@@BABA@@A@AAAA@@@@@AB@BAB@@@@A@@@@BA@@@AA@@@@A@A@AC@AA@A@@A@@AA@A@C@@@A@@@@ABAAA@AA@@A@@@AA@AA@@@A@@@@BB@BB@@@@@@ACC@AB@@@BBBB@@@@@@BA@A@@@A@AA@@@@AB@@A@@A@A@C@AA@@@@@@B@@A@@A@A@@A@@@@B@@@@A@@A@@@@A@AA@@AAAA@AAABA@@@@@AAA@@@AB@@@@AA@@A@@@AD@@@@@@@@@A@@@A@@AAA@@@A@@@@@@B@B@@@BA@A@A@@@A@@@@@@@@A@AAA@@CA@@AAAA@@@B@@@BAB@@A@@AC@@@@@@@@DA@@@@@A@@@@A@@@A@@A@ABED@@@BABBB@BBB@BC@A@@AA@A@@BABA@A@@@A@@B@@@B@@@BB@@@@@@@ABA@A@@@@@A@@B@@@@AAAAA@@A@@BAB@@@BA@AA@@@A@A@@@@A@ABAB@B@D@A@@@AAEAA@@@A@AAAACACA@A@@AA@@@A@@@@@A@@A@@@@BA@ABAB@@A@CB@@A@CAC@C@ABA@A@@@BBBD@@@B@@AB@BAB@B@B@BA@@@@D@@A@@@A@@AA@CAAA@@AACC@AA@@@E@A@GBA@C@A@AB@@AA@A@AAAAA@AAA@AAAA@@@A@@@@@@B@BBB@@ABBB@@@B@B@@@B@B@@@BBB@@AB@BABABCBCDA@C@A@@AABA@ABA@ADABA@@BCAE@A@A@ABEBA@ABCDCD@@@@@F@@@B@BA@AB@@@B@B@B@B@B@B@D@B@B@BAB@BEH@@AB@@@BA@A@@@@A@@@AA@@A@@@A@A@@@A@A@@@@@A@@@E@AAC@@AA@@@AC@@AC@AACA@@@@ACAA@AA@AACA@A@@@A@ABCBA@A@@@A@A@A@@@A@AFC@@B@@@@ABA@AB@@A@@@A@A@@AAA@@A@AAA@AAA@A@@@A@A@AAAA@A@@@AB@B@@A@@AA@@@A@@@@@@D@B@BAJ@@@B@D@B@@AB@@ABA@ABA@@@@@AA@C@@@AAA@@AA@@AA@AA@CC@@ECA@@@A@A@CDA@A@EBA@A@A@@BA@@B@B@@ABBBA@@BA@A@C@ABA@A@@@@@@@AA@@@AAAAAC@CCA@A@@@@@ABADCBABCDA@@B@BA@@@CAACAAAAAEA@AA@@ACA@CAA@A@@A@C@AAAA@A@A@C@ABA@@@AC@@AACA@@@A@ABA@@@A@C@AA@AA@@A@ABGFABABA@@BABABA@@@C@AA@@ACA@@@A@A@AB@@AB@@ABA@@BA@ABEBA@A@@@CCA@@AC@@@@BA@@B@@BB@B@B@B@B@B@B@@@B@BA@C@AA@@@@AB@AA@@@A@AA@AA@@A@AA@@AA@A@@BABA@@BA@@@@B@@@B@@@B@B@@BD@BBB@B@@@BB@@B@@B@@BB@BB@B@@@@B@@B@@@@@B@@@BBB@@@BB@@BBB@BB@@@@@B@B@BB@BBB@@@@@B@@@@B@@@@B@@BABBA@@B@@@F@BA@@@@@@B@B@@@@BBBB@@B@@@@B@@@@A@@BDBB@@@@B@@B@B@B@@@@@@B@@@@B@@@BB@@B@@A@@@C@ABC@CBA@AB@B@B@BBB@B@B@BAB@@@B@D@B@D@@@@@B@@@@@B@B@B@@@@@BBA@@@@@@B@B@@ABA@BB@@@@B@@@@@@@A@@BA@A@@B@@@@B@B@@@@B@@@@A@AB@@@@BB@@@BA@@@@@@@@@BB@@@@@B@B@@B@@@B@B@@@@B@@@BA@@BAB@@ADAB@B@@CB@B@@@@@@@B@B@B@@ABABAB@B@@BB@D@@AB@BAB@@B@@@ABB@AB@@BB@@AB@@@@BB@@@@AD@@@B@BA@A@C@AB@@BB@B@@ABABC@@A@@@CBEBE@@@@@AA@E@C@A@AAAA@@C@A@A@@@CF@BAB@@CBAFADAD@DAD@@@@CB@@@B@B@B@@@BA@@@BBBB@DDB@@@BABAH@BBB@B@@AB@@C@E@A@C@ABBB@@@@@B@@@@B@@B@B@@@BBB@@@@BBAB@@@@@@B@@@ABB@@@@@@BB@@B@@@@@@@BB@BB@BA@@BA@@BA@@@AB@BA@@@AB@@ABA@A@@AA@@AC@CC@@CA@@@@AA@@@@A@@@A@@BA@@@A@@AA@@@@@@BC@@@@BAA@BA@A@@@A@@@A@ABA@@@A@@@@@@@A@@@@B@@AB@@@@A@@@A@A@ABAB@@@BAB@@@BBD@@@BAD@F@@@BDDBFB@@B@BB@@B@@BBBBB@B@@@B@@@@@A@AB@@ABA@@BA@@@EB@BC@CBA@A@@@A@A@@@@AA@AB@@A@@@ABA@C@A@@@@@A@A@@BAB@@A@@BB@AB@@A@@@@@@@@BA@@@@@@@ABA@A@A@@@@@A@A@@@@@A@@@@A@@A@@@AAA@@@A@A@@@CAABA@A@A@AACAA@A@@@A@ABCA@@A@@BCDA@@@A@B@@AA@@AC@AB@@A@C@@AA@A@@@@@@@@ABAA@@ABA@@@AAAAAAAAAAAA@@AA@AA@@A@CBA@A@A@@@AB@@CD@@A@C@A@@@A@@AA@@A@A@@@@CBC@ABC@CBABA@@@@@A@@@AAC@@@AA@@@AAA@@AAAA@@A@A@@@ABABCDA@C@A@A@A@CB@B@@A@A@AC@@@A@A@@A@C@C@@@ABCBA@A@A@@BA@A@A@@@ABCBABAB@BA@@@A@CBABABC@@@C@A@@@@@CB@BA@@@AB@@@@@B@@A@AA@@C@C@E@C@C@AAA@C@ABA@@@AB@@BD@B@B@BB@@BBBB@B@@@@BA@@BAB@@AD@BAB@@@B@@@BADAB@DABBB@B@BBB@@AB@@AA@@ABA@@@@@ABA@@@ABA@@@A@@@A@ABA@AB@@A@@BC@CD@@@@@B@@@B@@C@C@C@@@@B@@ADA@A@@B@BA@ABABABAB@@@@@BA@@B@@@@@@A@A@C@A@E@A@CB@@AAA@@@ABA@@@@@AAA@@AA@@@C@G@@@AB@@@@AB@@A@@@@@@@@B@@@@ABA@AB@@@@AB@@A@@@@B@B@@A@@@@B@@@B@@@@@B@@@@@@A@@@@@@@@BA@@B@@@@AB@@@@AB@@@@A@@B@@@@@BCB@B@@BBB@BBFABBBAB@BBD@B@F@B@BAD@B@B@DAB@@@B@BB@@@B@BB@@@B@B@@@B@B@B@B@D@B@@@B@BBB@BBB@FBBBB@@@@B@@@BB@BBD@B@BBBB@@B@@@@AB@@@@AD@@@BBB@B@B@D@@@B@@@B@B@BB@@DBB@@@B@D@@B@@@F@B@BB@@@B@BAD@@@B@@@@BBB@B@@@BB@BBBBB@@BDF@@BDBB@@DBDBBBB@@B@@@@DD@@B@@@EBA@@B@@@@@BB@B@B@@@B@BAB@F@B@B@@@B@@@B@@@BA@AB@B@@B@B@BA@@@@@@B@@BBA@@@BB@@AB@@B@@BB@B@@@@BA@A@A@@@AB@BA@ABABA@A@@B@BA@@@A@@@C@AA@@BA@@@@A@C@A@ABA@@B@@@@@B@@HD@@@@@BADA@@BCBABA@AB@@@@@@DB@B@@@BCB@@A@@@A@BB@@CB@@@B@@B@@B@@@D@@@BA@A@@@C@A@@@@B@@@DB@@@B@@@BB@B@@B@@@@@BA@@DA@@@@@A@AA@@A@@@@BADC@@@A@AD@F@F@B@B@D@HA@@BA@@B@@@B@@B@D@@B@@@DLBB@@@B@B@@BB@D@B@B@B@BA@@@A@ABA@@B@@@@AB@@@BAA@@A@@@AA@@AAAA@A@AA@@@@@AB@@@BAD@B@B@B@@BBB@@@@B@@B@@B@B@@@BA@BDAD@@@BB@@BA@AB@@AD@@A@A@@@@AA@@@AAA@@@A@@A@A@A@@A@@@AB@@@B@@A@@B@@@BBB@D@B@@@BA@B@@D@@BBBBBB@@@B@DA@@BB@@@BBB@@B@@BBBB@@@@B@@A@@@A@@@@@A@A@A@C@@BABA@AB@B@B@B@@@@@@B@@A@@B@BA@@BABB@@BB@@@B@B@D@BAB@BA@ABCB@@@B@@BB@B@@@@B@@B@@@@@BA@@DA@@B@@@DBBB@@B@@A@CBAB@@AB@BA@@BBB@BB@@@BB@DBB@B@B@@@@B@@@@@@@B@@@@@B@@@BB@B@B@B@B@@@B@@BB@@@BAB@D@B@BA@@@@@@BB@@AB@B@BB@@BB@B@B@BBB@FFB@BB@@BBA@A@A@ABA@A@AB@@AD@B@@@BB@BBBB@@@B@D@B@@@BA@@@A@@A@@@@@@@B@@ABAB@@@@@BA@AB@@@@ABABABA@@B@BBB@B@B@B@BBB@@@BA@@@A@ABA@ABCB@B@B@@AB@@C@AB@BA@@B@@@@@B@@A@@@A@@@A@AD@@@BB@@B@B@B@@@@@@BBD@B@B@DBB@BAD@@ABB@@@B@B@BBBB@D@DA@@B@@B@B@@BB@@@B@B@@AB@@@B@@@BAB@@CB@BA@@@@@AB@@@BB@@B@@BB@BBB@BD@@BD@DBB@@BB@@BBD@@B@@BD@D@@@B@D@B@@ABA@A@@B@BAB@B@@ABAB@B@BAB@@A@ABA@AA@@@@A@A@@@@@AB@@@@A@@B@@A@@@ABA@@@AB@@C@@BAB@@AB@BA@@BA@@B@@A@@BAB@@A@@@@B@@@@@@@@B@B@B@@D@B@@@FBBB@@BB@@@A@@BA@@B@@@@B@@AB@@AD@BAB@@@B@@@D@@DBBDBBBBBD@B@B@BBB@B@@@@B@A@BB@@BB@@@@@@B@@@BADA@A@@B@B@@@@@@B@D@@BB@DB@@@AB@D@@B@@@@BB@@@@@@@BBB@@@B@@@ABA@BBA@@FA@@B@B@@B@@@D@BB@@DB@B@@@B@BB@DB@AB@BADBBBFDBB@@B@BBFB@@B@B@DAB@B@@@@@@FABA@@@BB@@BB@D@BBBBBB@BB@@B@@B@@AD@@BB@@B@D@BB@@@@@@ABCBAB@@ABA@@B@B@@@B@@@B@BDB@@@@BBB@B@@B@@@@@BA@@B@@@@@@@@@@@BA@@@@B@@@B@BA@AB@BAB@B@DCDABC@CBCBA@ABAA@@A@CACA@AA@AA@@A@@@AB@@@B@@@@@B@B@B@@@B@@AB@@BBAB@@@BAB@B@B@@@@@@@B@@@@@B@@@B@BBBBD@@AB@@@@AB@BA@B@@BA@BB@BB@@@@B@@@D@B@@BDBB@@@BBB@@@B@@@BB@@B@@@@AB@B@@B@@B@@BCBA@@BC@AB@@@@AB@BABC@ABAB@@AB@@A@@@A@@@@B@BADAD@BB@@BA@BBAB@@@BBB@@@@@@@@@BAB@@@BAB@@@BA@@B@B@B@@@BAB@B@B@@@@@@BB@@B@B@@@@@BB@@BBB@@@@@B@D@@@@@B@@B@@@B@D@@BBBDDBBBB@B@@@@B@FC@@B@@@BD@@@B@@@B@B@@BB@@@B@@@@@B@B@@BBB@@@@@@CBABCFEBABABABCB@B@D@@@@B@@BBBB@@B@FABABA@@@@@@B@B@@@@A@A@@A@BC@A@AB@@@B@DB@@D@@@@AB@B@@@B@@AB@@AB@BAB@B@@@@@BBB@@@BD@@@@BA@AB@@@@@B@B@@BB@@@B@@B@@@@B@B@@@B@@@BABAB@@@BAB@@@BB@@BB@@@@B@@@B@D@@@B@@B@@@B@B@@B@BABA@@BA@@B@@A@ABC@@B@@@B@@BB@@@@A@@BABC@@@@@@BAB@@@@@@B@@@B@@BBB@@BB@B@BBB@@A@@AABABAB@B@@@BB@BB@@@B@@@B@@A@@BA@@@@B@@@@B@@@@@BDB@@B@B@B@B@@A@@@AB@@@@@B@B@BBDB@B@@B@BBDBB@@B@B@@@DB@B@@@@BBBB@D@DB@@B@BA@@BC@C@@@@@C@@@@B@@A@A@@@@BG@@BA@A@ABC@@@ABA@@@@D@BDB@@@D@BBB@B@B@@A@@@A@C@C@A@A@AB@BA@ABA@A@@@A@@BA@AB@@@BA@@@@@BB@@@@B@@BB@@@D@@@BB@BBBB@@B@B@@@B@@@B@BB@BD@B@BAD@@ABA@@@@BA@@@AB@@ABA@@@AB@@A@@AA@A@@B@@A@C@ABB@@B@@B@@@@@B@@@@@B@B@@@@@@@@@B@@@BB@@B@@B@B@@B@@@B@B@@@@BBBDB@@B@BB@@B@B@@D@B@B@B@@BD@@@B@B@B@@AB@@@B@BABC@@@AB@A@A@@@A@A@A@A@AAB@@A@@A@@@BCBA@@BC@ABC@@A@CE@@A@@A@@@AACBEBC@ABA@@A@CAA@CACBA@@@@A@@@@@A@@@@@A@@@AB@BAB@@ABBB@BA@@B@@BBBB@@@BBD@DBD@@@BA@@FE@ABAA@@A@AA@A@@A@@D@D@@@DAB@F@BAB@F@FB@@@@B@AAAAAA@@@C@A@A@AB@@AB@BBDBBB@@B@@@B@@A@C@A@AA@@@@@A@@ABCBADA@A@A@A@A@AA@@@BC@@BABAH@BA@@D@BBBB@@FD@@B@DBBBB@@A@@B@B@@@@@BAB@@@B@@@BBB@@@FABAB@@@B@B@BBDBB@FAD@B@DA@@@ABABA@AB@@@B@@@B@DD@@BBB@@@@@B@B@B@F@BB@B@BB@D@B@@@B@@A@@B@@AB@@A@A@A@@BA@@@@@@@ABABABA@@FAB@@AB@@@@B@@@BAB@@@@DBBB@BHD@@@@DAB@B@B@@@BA@@@@B@@@@BB@@@@B@BB@FBDBD@@BB@@@@@B@@@F@@A@@B@@@@@@@@@BBB@B@B@BADCB@BA@@@@B@BB@@B@@@BA@AA@BAAA@@@AB@@A@@@@B@@AB@@A@A@@B@BB@@@@BB@@BBBA@@@@@@@AA@@AA@B@@@B@@@@@AA@@@@B@@@B@B@@@@@DA@@@@@C@A@C@A@@B@BCDC@AB@@AA@@AA@CA@@A@A@@AC@A@A@AAA@AA@@AACEAA@@@ABA@AAA@@@AAA@@AC@@@A@@B@D@@A@@@@@A@ABCB@@C@A@@A@@@C@A@@A@@AC@@B@@A@@B@@A@ABC@A@A@A@@C@AB@@@@@A@@BA@@@@A@A@A@A@A@@@AA@@@@@A@A@@@@B@@@@@@B@@BA@@@@B@@@@B@@@@B@@@@A@@@@AACA@@@@@AB@@CB@@A@A@@@@@@@A@@@ABAB@BA@@@@@A@@AGB@@@DAB@@ADA@@AA@A@@@@AA@@DCBA@@AC@A@A@A@@DABA@@BA@AA@@A@@BA@@@A@A@AAA@A@@@A@A@A@@@A@@@@BAB@@@@A@@@AAA@@@A@CB@@@@@@@@A@@AA@C@A@@@AAA@A@@@@AA@@A@AB@@@@AA@@AC@A@C@@@A@@@@@ABAA@@@BA@@BAB@@@@@CA@@B@@A@@B@@@BA@A@@@@@@C@@A@@B@@ABC@@@A@@@@A@A@@@BA@A@AAA@@@AB@@A@@@AD@@A@@BA@CBA@ABABA@@@@B@@AB@B@B@B@B@B@@ABA@A@A@A@@BAB@@@BABABA@@BA@@@A@@@@@A@A@@@AB@@ABCBAA@@@AAA@@@@@BA@A@A@A@@AA@A@AA@@AAA@@@@@A@@@AA@@AA@@A@@B@@@@A@@@A@@@A@A@A@@@ABE@A@AAACAAA@AA@@A@A@@@A@ABA@A@@@@A@GBA@AA@@CA@A@@AEAA@@@AAA@@@@@BCBAD@D@@AB@@A@@@AA@@A@@@BADEBADA@A@@@@C@A@EDA@CBEBA@A@@@A@@@@@BA@@AAAA@@@@BA@A@A@@AAA@ABA@ADAB@BC@C@A@A@A@A@AB@@A@@@@A@ABABC@A@@AA@@A@BC@@CC@AA@@A@AB@B@F@DA@@B@@ABA@C@@@@FE@@B@DAD@BAD@BA@@@@B@@@FB@@DAB@BD@@LBB@DBB@@@BABCDCBAHEB@@A@@@CBE@C@A@AA@@@A@A@A@ECGAAAAC@A@EBA@AD@@@BA@@@A@@@@@A@A@@@A@@@A@@@@@A@@@A@@@@@@BC@@@A@A@AB@@ABA@@@ABADC@ABABC@@@C@ABAB@@@B@@@BB@@B@@@@@@C@AA@AAAC@@AA@A@A@A@@@A@@B@@@@A@@@ABA@@@AB@@@@A@@BA@@A@BA@@@A@@@@@@BCA@B@@@@CA@A@@@@A@@BC@@A@A@@@@@A@A@@@C@@@A@@@@BA@@@A@AA@@A@@@A@@@A@AAA@AAA@A@A@A@A@@@EG@A@A@A@@@@AA@@@@BAB@B@@A@A@A@A@AAA@A@@AC@@A@@@ABA@@@A@@@@@@A@@BCBA@A@@@ABAB@BA@A@@@AA@A@A@AB@@A@@@AA@@MAA@@@AB@@@@AAC@A@@@@@"],encodeOffsets:[[112729,38257]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)};A?A.registerMap?A.registerMap("山东",{type:"FeatureCollection",features:[{id:"370100",type:"Feature",geometry:{type:"Polygon",coordinates:["@@FMV_jEJCLK@MIK[COCKGCO\\ENCHGQGGIHGTCd@`FV@JEXWBICWQ[RSlSVM^_CkCKEM@OF[LWZUD[J@@CF@@CC@AI@GBALCAED@@@AEAAGB@BIBCECADFAFE@BGGACGBCDAFA@CSBCACCGBABC@IG@ABAAEAKCAHMTUFMf_HKFO@SH_J]BEBKFG@EDABGcaGAyEUCOGeWMEM@KBaHECACDCHADCAA@OESGIMIKEIE@ONMJGjMLCPGJIZHGTAXFJJNJNCVOHDPDJ@RADDDBDC\\F@FCHA@BCA@CHBBF@BCJB@ELDLB@DL@@AFANABGBAB@BGHA@CBABD@HLDBB@DBBD@B@BD@FB@@DD@DB@DL@F@BDF@LIBC@GA@@DE@EA@IVGHCD@DC@ICKACWGCMC@@DCBIBAFCBEECOPC@E^KNCJEPSHMFIDMM]ASFWz{NGYn_PKPWDOFIJIf@DDP@\\ETIACECM_FK@CKGOOOIAQCIECKB@GMB@ADAEMC@@EDEAEEEBQDIJ@LA@MHEGMHA@CEAF@DG@KAE@IHACCGBI@AEA@ABBG[AJIDAXDLA@GAASED@R[AAC@ADIDIIKCEIBAHAHCDAFBPINA@EFEAGBENCJGHC@C@AFQ@CMBI@WMQCcDEBAFEDK@ECULCB@BABSDE@GDSRKFADBDCDCAIGBAEBEA@AGGOBCCGBCCI@AACE@CKKACG@AAGKCAMACIAAGBUA@AOEIGY@AEE@ED]LGK@GDEACSOI@AGCGBCD@@AIEOKMAM@aBcCKG_eIOSCYDIHopIDKBYAMBEBIAAB@JA@C@CBC@GBGDG@BEAIKMCAIQWCEGCEGAKIAEAICKGK@CACEEMDKCGEECS@@GEECAEOQWUKCI@CBCB@DEGOSAO@WKO@MMDGEAIBGF[FICABEDC@KFKDAABICIEAACEECMACCO@EDGAG@CEIEBG@CCE@EEEAIEEIICEBOEECAOAAOAGACAUADE@CAA@AB@AA@ABABCEAADA@ECKCA@G@ICEGIAEFOHINEFKEUHCBEACB@DGL@BCBC@CA@CUIMIQCIAAAMD@FEDODEDBFED@NIDHBEFIDGH@FCHDJMBA@ACEEQAIC[FAAOFkX[BUM{UIEQOQISBMEOAUBUPMLQJaBUCK@IW]WQCIODECEGDE@@AA@@FBFABWAGAAGCCA@ADCGBGACSAEAE@AFGFC@KEADBBDBBDABGAK@CBCCAI@GIGBCIDECGAICK@@G@CFCAACCAEOE@CGE@ADEDED@@CC@LQB@@BB@BAJEZAJMFQJKVEGWEBACBAEIE@@CE@@GCBAGNADBH@@FLBF@@GAA@CE@@ELALBBDFBFB`IDKBMCIKQOMIaKIEIWIKAUG@OCICAEK@CUE@ACE@ACGC@EG@BFBBBDADC@@HIDBNF@@BCLEDe@QLEVIJKBU@OCQGM[MKOI[K]CIK@QGMMBgXE@GTAP@\\LfBPCRGLMNGNUjILOPOTRPRXXVNLRJRPHJ@NFJLNPNNNHXHJPRRLhNpN`PbNT@\\ANBHFDLFLFHHB`@XED@FDBBFRDFJHHVHJb^HBNHDHT\\HDHBrFbH^HNFNJDJBHCJQdABDZEH]FADAD@DFJLJNBX@HBFDBF@HAFcNCD@DNJNLLXDDd@DBTPDFDNGXBDDDLB^BJDJHFJCbDDH@^A\\L^L^NFJBJ@TBFDDVLFFHVAVBRDDHBLAJ@DDDDAJBFDDNLjXNFvHTRPHLDAFB@ANCA@H[BCHE^DbDLC@BDFPBDRCB@FDFABDA@BFAFG@ABADBDA@DF@BFLJGdGACFBPC@CB@ZFBLD@@FAF@JCB@DDDR@BCDABCH@B@NBHL@NC\\DZ@\\E\\ERMHaL@DKF@DKBHPB@DHF@B^M@KBGAM@CB@DSA@TBJEBKJEdJDX\\HFdNdJLLILOFOLEV@PELEL@XXNlNjHVN@ZC^DDC@EB@BHDB@TFB@DHDHB@AJDCFEBCLBB@HCLCD@DDBR@BB@ZDLIB@FA@AJWJE@AAGA@ZIA@HM@ADBBGDBFF@LJHBDNE@DNDBH@@HDBABC@@FE@ALG@BBCA@N@BC@AFDBLABDQRKHkNAPKlCNGLEP@NJJRHLHFJCPORR`PNHNC\\HPPP²FXDJLFTK\\VTPFNDNCHMdBVFZNpBT\\XZHJJVPHPA`CXF"],encodeOffsets:[[120088,38434]]},properties:{cp:[117.000923,36.675807],name:"济南市",childNum:1}},{id:"370200",type:"Feature",geometry:{type:"MultiPolygon",coordinates:[["@@ACMBMECBABBB@FBDJCJBJABC"],["@@DABEC@CDBD"],["@@D@BGBGCAG@@DADBJD@"],["@@SHKCM@GCOBI@EF@DBDFBDFD@V@BBAJD@D@PEROL@BE@CCCC@"],["@@HC@CAAE@ADGFDBFA"],["@@BBD@BEEGA@CDBBBF"],["@@H@BCCAID@BD@"],["@@FAFEBCCCC@CBC@CB@D@DFD"],["@@DDD@BABIAEEACB@N"],["@@D@BACEA@ADBD"],["@@BKFK@CACEBEDEDAD@FFNDBB@BC"],["@@BFBBD@BAAGBCCECAAB@L"],["@@LBBAECCBA@C@@BB@"],["@@AB@BBC"],["@@DA@AA@AB@B"],["@@BA@AAB@B"],["@@BACA@B@BB@"],["@@DBF@AEDCE@EBABBD"],["@@@@@B@A"],["@@F@BCI@@DB@"],["@@FCACCBAFB@"],["@@BFD@F@BCCCDCF@BEMCCDMB@DHBBD"],["@@@BB@BCCA@D"],["@@D@@AA@AB@@"],["@@FBDABA@C@AKBADBB"],["@@BFD@JG@CGEE@AB@J"],["@@ABD@AA"],["@@DA@EC@CBADFB"],["@@DDBA@CACEDBB"],["@@NHF@DAACA@C@EEQG@BHJ"],["@@A@@DDAAA"],["@@AB@BBA@A"],["@@BBBAACAD"],["@@TXDFDLJDJBBGAOFSBCJIDE@CAEGGGCUIMAIDEDCHAFBTDH"],["@@DHHDBFDABEGIEABGCCC@CF@DDD"],["@@DABC@EACE@EBBHFF"],["@@ALBBDADCHMCAE@EH"],["@@BACAADD@"],["@@D@@ECF"],["@@DAC@@B"],["@@DAAAAB@B"],["@@BCC@@BBB"],["@@D@BCCBCAABDB"],["@@B@DCCEAACDBHB@"],["@@DBAECECHBFDC"],["@@BDFDHKBEAEBEACGH@DGL@B"],["@@BODADABDJADB@BBBLDDEH@TCFGDAAADCLBHEDBHABDHADDHALABEBAD@DBDFNFF@JID@BB@HFLAHMLGDS\\IFODABBJ@JDH@FBHHDN@JBBB@FJRADEDILBLFPJA@DDDH@@FDDBDFHHD@@EBAD@FLBDFH@RNL@RGL@LBBFCFLHBDHFBJJ@DDJBBADBD@BCDELCDGFANDBD@F@@IDDBADPEFFBABCD@BBCHDDBJEN@JCL@BB@@BCHCBCJG@GJCJ@FDDCHCBBHCBEC@DABGEBC@AEDCCC@@BDXEDEHBBFD@BGPOVG^AJDPANFFAFC@AFCACNLLJBHDBJTDAFHBAFDHFDFBBFIHEBDHABKBIAACCCADDDABACODGDQNCBAE@GM@@BD@@BE@@BDB@BEAGBABBDG@O@ABABY@AH@BBBCNFDCRBDTD@BJBHDJDAHFBAFGDAH@D@BIHCJABA@DHB@BCFB@BA@@LBBDJDB@BFF@NFAAPLBBHFDANFFTD@DBDFINEHBFDLEHAJ@BBDBDCJ@FCFBHC@KDGHCJBJEL@LFDFTFH@ACP@PFDB@DJABA@CHDJADAF@B@ADDDAHHABCHAADDJHDFADB@JIFBFEHCHDJDDDJCDEAG@IPETABC@AFDHHHF@DD@BDBCXDBL@FDBB@LFF@TPPRPFFBJFBLADDBJBBtRALFLFH@LDFADCFHZCLNFALFBCLJDVBBARBDCFABCTHAHCBALIFC@GJIBCRBF@HRB@DDD@FJF@LLL@BDDBDDED@JHN@DBBBnBHA@CV@BBAJNBAPHBADA@AFCAEDEPHJJDDDGBACG@ABAHDRD@@DBHDBAHG@AAI@@GAAqZAHC@@DY@ADIA@EC@MEQKAEDW[AEtEJmFSFLgHyVYDOHE@CDKDBB@BKA@BBBA@EA@DE@OLG@@AGA@EKAAABAKAANDJBLBBD@IHCACDGFE@CJI@EDE@@FGHEBADEACBG@EBE@CFE@ADA@CFABCBACC@GFCFEBADEAAACA@BADQNKB@BADSJCBEFBDEHENAD@DCDBFCDG@AB@FEF@HGDAHCDAJQL@HGFAJCBAFMFIAOPQDGDYfGlBVFNJJCDBFADFFCDPBFF@BCBGAE@AB@BFDPBLIPABBHF|¦Dl@CL[nAHHPV|NL@DJbHFFBD@BIL@HFN@@CH@BERBDCH@@AFA@AHBTGH@@CHBDA@GJAD@HFRFDEHBADVHAAFG@ED@@ABIRINB@BDBFBP@ACB@ZDHHF@AH@BF@@DCA@DD@@FH@@DHCRD@BPB@FR@@CFBBEDBBGD@ACF@@CH@@A@@@EB@HB@DF@@DD@@CPA@AN@BBL@@BVA@AP@RANBDCZADElAFFRFDBCBABAABBCBFABB@@BCFFH@LD@BABBBABBBDBBE@@FDLDPBNEFFDHB@@AFBDCFADCB@BABBH@BBBAD@BA@ADAFAB@ACJALAFABABGB@FBD@DB@C@CB@HDDF@DDBJDAFDL@BDBBDJ@\\AFCF@FDH@BALB@AH@D@BCJCF@JBJ@LDLAHDFDPDH@@KBABB@CHBJAFBBA@ABADBBDD@JFBBCB@LLD@HBFFAD@HAB@BFD@DDDBBABCDADABDJBJF@DDBBDCBDDBABDADFFCDJDBLAHCD@BDH@DDBAHD@AFBDB@@DTD@FABABBDF@BFAFBDABABBD@BBB@DBD@FCBJPJCHID@FDVKD@JDBFDBBFFDBDDBFFDA@GDABD@FJBRC@B@BFBBC@GEKBCDCAABACCDIB@DHAB@DNCF@BEF@DEFBHD@FB@FAHKC@@AC@BCJB@AA@@GAE@EBAFABCLCAC@EBCB@BGJF@BAB@DD@BDB@BAB@@HEBBBFBBBDPPJFAJBJABDB@ACBAHBBAD@HIFA@AVAFFBJCDAF@BEF@BD@DCJ@FHDCD@BCBDJDF@@DF@J@ABA@ADJ@@DCBB@D@ABD@FC@EHAHBJEB@DABBBDD@HFNFR@FBHD@FBJXRBFB@BBCDF@BAH@FBFAAABENABKLGBCBDFA@ADDFAAGHSBC@GCK@EM@BEC@FGC@DCA@AEA@@KAA@GD@@ED@B@@I@AE@@AF@E@@AB@@ABCA@BEAIGCC@ACBCBABGDCBBBAF@AEPEVNVCBDB@FDD@BD@FDHF@BABEAE@C@GDDPA@AFB@AJADDD@HOI@AEDAF@DC@EFMCGKCAAAEC@@AHC@EBIAEE@BEAA@AE@BGI@B@E@ABA@@BC@AJG@@BGFC@ECAA@KC@@KEBBCA@@CA@@CA@@AA@BIA@@EC@@IBGGA@CI@@CD@FQH@AEL@DEBCCC@EAABAB@@@AADCAA@AA@@C@CD@@AHABCAAF@BCD@DED@@B@ABBBGBBDGDA@@JB@CL@V@B@@DDBBK@DB@D@@CA@BKCA@OBCGA@CCAOA@EG@ABC@@BIEG@M@MEEBBGACBEBA@@@C@AA@BCAE@CB@@AJCFBBADBDC@CJCECBCE@@ACGCA@CAA@AD@@DB@@EL@PGLAFGAGCACGCADAE@@AF@@@CABEACA@BABAIIEABEID@AGACB@DA@@C@@ABCACBS@@BA@AACAADGAACB@BEAECAAGCE@E@AA@ACCA@CMEBAEABC@CBAE@IAHMBIHB@CG@@CA@@IDGJDBEFCJBBCC@@AD@FGF@D@@AD@F@@BFBHBHE@GBEFCFACADM@@F@AAACFABICEAA@AEA@AAA@@EC@ABBDAEABADAF@@AD@BAD@BBBCF@FA@BJ@HEFBDEAABCBED@BAAABAFBBA@CHA@AB@ACIADWF@BFL@HFHABBBCB@BAH@CIC@@CBCFEB@BDB@@AD@@AFECKA@DSAGACG@@DI@CA@AC@AOBCA@@AACC@@ACA@ABAAMB@@CGECQ@CB@@GBE@G@ADCB@BBD@BDDBJDHAJDJFDHHAFFDAHDDANJJD@DN@J@CJA@CHDBBDH@FFBJAHRBJDH@LCFEG@@CDCB@BBFBCB@@JCHBDCJALQRDBED@FAJGBEFEAE@@J@DCÂnDBDAXKRCN@HENWAMDEVKLIF@PFF@TKhENBTI\\[DEACSSWWOW@EHK@AGI@C\\]@CCKEGCCQEAACMECGCCAEBGNCB[AEEMAAEBGNIDGDUPIJDF@BEAGMIG@CBDF@DGFCBMMOMGGIQEE@CHEBE@GACIEMAECSG@B@BHD@D_LGDEJ@PGTDFTPBLEJYRMBAD@BFFBDB`CJCDMDG@ÍGCCACDQFMBC@CCIECKA]DCAECGKAE@IAEQCKECCAGGGKGEEAC@KDOBEFCVIJKF@DDLEBEDMRSDKHG@CCAG@AIC@IDgHG@SEK@G@GFY@CBEFGAMSH@BMBCFCFI@ICGSQCIBGFGDAH@LDNJNAFCBAC[BGHK@CQS@EBCNKPyAGEODM@SDIDERCBC@CACQACC@ABANCBCCQBCB@D@NDHABCBIACEEGEWCIASDSJE@mYOEQCCB[NEACAEEAKCEOIKAQ@IDCFDF@FADCBGAAIEE]DEHC@ECACLQCICCE@GBCCEAOFE@OEUASEIBOHM@WICCCEC@A@IIIGGGEED@ACCAIFCEC@CIDCBECAE@CBDF@BGCG@KDE@IGECO@Q@AB@BDBBFADCBCACCKAMECEFA@EACGEG@AB@HIFOCGGGAE@@DFFDFCHEBKGQ@AB@HB@BCBDAFCBC@BCAAA@AFGAEGBGEA]D@CDI@CE@ADC@CCCAADADHLBRHFF@AFJHAHBDHDJBBB@BGAABJL@BE@ADHBBD@LRJEFFJDBFADBDJD\\`rTVAD@FDFHDN@BDAJKZGJODOLKLCFQREHCDC@]EOGECAGBODIDEJ@RKBABOCECAGB]@ECGIC@EBCJABIIC@G@EBGJ@DHJBDADCBWBI@ECMQEAE@EBCDE^AHEDIBIAuOMISSgUIID}KQOIgCN[BUHClETEHELSJAPG@CACBCNI\\EALEBC@ECOECM@ECBELE@ACG@EACOKACBCACiaCAGBCAHEP@FBdVPLTNZJPQ@CIGGIO[@CBCCIBCBAD@HBBD@B@JHDBBCL@BJFB@HMB@BAPFGP@DFDPHHJH@PCPADC@EJKBAW}CEGASCi@MAUKEGEQO@IIUaEEOEIICGCKFKACEIAAcG[BCB@DHLBPHHPNBHFJND@DADBHBBFBNAJFFHADAFCBcI[MCCCKGGAEC@CDCAJGAACBCAAABC@AE@KKI@GBEHANCBC@AA@CLM@AQIC@GFEACWIMKEUEKAKBGFKBMEECOSMWIO]wGO@I@IFGNI@CNS@GCGGCAG@IAEGA@A@SGBCCMcGKAMIGCGCAIJIDKCKEIAGBBHHVOTC@GCGD@BDJ@HSTCBMBcDOEACGMAQBKFADGHERAVDBAFE@G@IAGEIIEiQIKCQIGCGASBCDCVIJI@IEQCCGAaAGCGEccECK@ED[XGBE@GAKIC@CBAD@FBPCP@JEDC@KOSGQOAEHC@AI@KI@CDA@KDCHKACEAGCRYAAIEICG@GHEJE@MMIAEDINEBCDA|WBS@KAKCsIC]BKAI@SLGBGHAFS@IJA@EAAA@IEKCCKCC@ERGDC@KGGGG@EADKAAKBEHIAGFWAMCC@ADAB@BDD@FCPKBOHIJ@TBDLJABGDABBBF@BLFDABAB@DFJATBV@FGHIHANEBBFADFFFRCDDF@FENFD@DABFFB@DA"]],encodeOffsets:[[[123909,37365]],[[123856,37328]],[[123919,37315]],[[123894,37288]],[[123842,37286]],[[123835,37281]],[[123800,37266]],[[123759,37249]],[[123619,37158]],[[123616,37147]],[[123674,37108]],[[123719,37104]],[[123632,37099]],[[123630,37095]],[[123643,37084]],[[123645,37082]],[[123207,37056]],[[123853,37050]],[[123847,37042]],[[123852,37043]],[[123516,36968]],[[123479,36963]],[[123483,36861]],[[123376,36828]],[[123387,36826]],[[123200,36808]],[[123214,36806]],[[123213,36806]],[[123044,36772]],[[123779,36756]],[[123091,36755]],[[123092,36751]],[[123066,36651]],[[123043,36624]],[[122805,36485]],[[123969,37424]],[[124303,37137]],[[123919,37299]],[[123735,37253]],[[123483,36946]],[[123474,36964]],[[123359,36934]],[[123219,36807]],[[123066,36798]],[[122719,36470]],[[122609,36451]],[[122395,36589]]]},properties:{cp:[120.355173,36.082982],name:"青岛市",childNum:47}},{id:"370300",type:"Feature",geometry:{type:"Polygon",coordinates:["@@BCH@FAAG@AB@NDAJDBJ@HELBBJDBB@@CDETAFB@CF@HBDAD@FF@HBBE@@BLDH@@DJ@DDBF@JAFDFFB@BB@DD@FDJJ@@BDDADBBBCDFDBBCB@@HCFC@DDBHDBBDCDCAA@BDHB@DDBH@BCACBAD@BEHGAAGGHCFBFBL@BDH@@DD@BHEBCD@FBBDHIH@FKLMD@EE@BCBA@AGJECAXQB@BB@@FC@@JF@@HF@@F@BC@@FCB@DFBDZD@D@DLFD@FBBBNIJ@PFFD@@Br@@DFADDHA@JD@B@BALA@GP@@FPBGDJD@B@DL@RCV@BVJLPAAJLDJ@@NAB@BL@H@BB@FLDBB@FF@BXIB@AGACA@CC@@HCB@GAAAAAHFD@DC@@HABECCE@GCEAEA@BNBD@BAD@PAHEFIBADEBBDEB@DOF@RE@BZE@@H@DCBeBCD_B@DE@BJCBBHAD@PDBD@@BABCA@FDBB@@FCFEB@HM@@CCAAB@FG@@AC@A@BBCB@AAAADGBAD@FG@FHFLBJALBLDDBLODc@kFyCSEqAIBQFo@uH{V[FYHS@OESCABC@@DA@GA@CGDCDE@AB@RADC@@FE@@LLB@BDBBHABC@BFSJCAK@@BDBE@@HCAADAJBFEA@B@DDBAJpJFBRPFHFJLHH\\LRTFLFhLRPTHDXLJ@NAJEdYFAMIN@PHxRHVFZ@jMLEJGFIJCXBRDfRXNFADMB@BCAAB@@ABA@AAAAIJDFHNB@DBADFJAJE@GFB@DJCDBBBDEBCF@BO@AC@BIFDFGGICAEBMGF@AIGBAC@KA@AGEA@IJA@FF@DIA@@EF@FDJBDA@ERBDAH@DCRF@HD@ANBBVAH@HGFBFC@G@@@ADKVBBFHB@EJ@@EJ@BGC@BE@CDBDODBFGGEMK@EFGYO@EECBGF@@@@CEA@EJD@CCABKBCHCDIPSAADAIABAMEAGKCIGCJUGBA@ODBBAFBAAHIF@DCCCBCBABCFB@ERF@F@BF@@ELDLHPAbFBJFDB@@@AGJ@DCDCNC@IBCJMEG@CD@ACDC@ENA@BR@@BB@HADA@CAGEA@DGG@GB@@GAAWC@WC@EM@CDB@ABODAAGBILACEF@@CN@@KCABIT@AMhAB@@CXABG@AA@ACHAAEF@LC@CDAHBHJFBD@@CH@DJHDFB@NHB@FRAHB@JADBLF@@JXADFH@@BF@@AHANCBB@DHCFKDCFudDBBJB@BJ@@MD@@ETCTCBFLJ@HB@@D|BFA@EF@@GBAAED@COBABAEEHEF@JDH@@GBEHA^@B@BFV@JErJAKH@BJ`CJAD@BBD@DCCKJ@@AEAECBAFB@EB@@IMSI@E@AGE@EE@CHC@CBAF@@kC@ACEAGMEBCE@EEA@C^C@IBA@AOA@EGAMCAAA@BRG@@HE@@GE@@IA@AWI@SB@CHC@KDAAICE@GD@AKOIE@ECAA@CIGWACCGAC@ABE@@EOBIKIAUKEAEGME@DEA@CCCG@KDOAUDADED@JE@OGSDCOB@BCL@GCAWEEGB@DWAIKAACEHWNA@IDEEEI@GDQDEFCBADGDCAC@AAMCEEKBKOHAAENC@ESWT@JEDGCAIDSDIMEC@CGC@CACBA@OHABDBBBCDBDA@CHABCCEEAEDAFA@A@AOE@@EAA@GCCIAG@@EGACE@AFCDBD@DEDACGLOAEECSIGIMOSKGOBA@ADABBD@HEIECODQF@DCJOBIVCXIL@BA@MCCQGAC@GDIDCGEBMDA@CJCBAGECIHUJOHEJ@FGECDCDGCEE@CC@CDCFSBIIC@OCECKBCBGFAHDL@FB@HJ@HECE@ADCBGIAGGCIAAGCBIHCBECEHAHEDKFCD@DCACBINGAKDGHAACFBBFDBDEACDDB@JAA@CABAX@JBHADESOACFAF@NEBC@EFG@EBENEEKEEDCLIBKIIDCAEBIACMMAMPEF@HDLHRDNJPBVMBA@CLILAJCDCJE@CGKFGCKBIEGBMOIA@AEHADEACHKHSDGL@DCAIJEFCDO@K@CD@@I@@ICACDMJBLIF@FBHA@AAADAD@BDFLBAFBJ@F@BBCD@DRHD@HE@GFCBB@DHJLC@APCBCACD@DBH@D@DAACFBDABCIGEAIGAADIACHEBGACIA@EDEBE@SACAMTKHBDAB@HDFCJACDC@ADFDDDH@BEACHA@EECDCHCBCDIEEAEDAA@HE@ACAAA@GCQACKFGBGKGDU@GDCFMBEGBCGEBCJGDEAABCFALIAMNCKK@CFIRMFIFEBGCCCAi@SCIIOEURCBK@MJEDENIJMTcCM@ICEODA@AECK@KEIBICCECOCEA@UCaKIACCEBCB@DA@G@GAEAGGOQC@O@GAIEEESEACCB@L[DGFEFBL@DABSBGBMHMDAFAJ@BQFGHAFFFPBDD@DIN@DBHGLAJECCB@HCDE@MJEDK@EBDHGDC@@AHCCAIBEASBE@KBKDENG@EAC@IFACQAMIEAABCABECA@ECEEF@GKAMLEE@EHBCGOACBMQ@MAA[GECOQCG@SSKACAOCAUBgAEDANBHNVCBMEWBCAWSICMFAF@PGBIRBHDHCPNALBBHKVCFAFNBBFCFCNIACDHHF@@NC@GBGFGLADHNZDCH@BCBEFFJBJP@@RMHDHCDMKM@C@CFGDAF@JEFBBDNEFGD@LA@C@EHBPDBADC@AJC@ABAHHHFABBBFCBBBF@@DIPIBI@@BFFDLPVAB@NHFF@LGBEF@D@BLTJPBHAHEDBDLJFEFCPDF@FAFBLDRHHDPDDCH@DWFM@EDOBGEI@CBIFAFBFFFAPGBGP@PD@BJGHEB@FBHFFJBBB@JBBBB@EFBBFCBCDHBABGBAA@DFA@BGHC@CDF@DBBAACDCDAD@@DE@@JEFGB@ABEAAG@IDACG@EBCDECBIIAKMBEEBAB@HDHDDABADDDBLCNE@ISC@AB@FADBF@LE@GN@DEBILQFEHADFF@FAFBF@FCFA@cHIFCCA@ANCFFJBBAB@DHDBD@BADIBKA@DCDCACA@AFACOE@GDOGGA@JABCDKBA@EI@CJ@EIED@FKNI@EFI@ECIAGHPPE@ENGBHFHAFBDFAHGAELGABDGBBBGBRLBLELEACB@DO@ILCBLDF@NFL@F@CCG@HANDBHKJAAQPOB@DER@B@DGDIHMDAFBHEF@FMBOJEACBGDGBABFJLDJJJCBCD@BBQ\\C@TFBB@HKBWCCBIJ\\BAHBAB@BFJ@HADDGB@JBF@LCHE@FB@DGBHNGF@NKBI@CJAP@BFFBFCF@FD@FNCB@BNA@HLAFDFN"],encodeOffsets:[[120544,37579]]},properties:{cp:[118.047648,36.814939],name:"淄博市",childNum:1}},{id:"370400",type:"Feature",geometry:{type:"Polygon",coordinates:["@@AEBENI@AJCL@FADKPMBA@GGK@EZIR@ZINMHOFEZMRAVFBaBAJEBC@EHGTSPAJHTHJCL@DCDQBENADMDEDINIPUXSTQVKJIL]hWVELERIH@DE@EGYBKDAL@TF\\ALEBCDGAIMKQGAM@WAIBIKMCIDGFI@ASU@CDKCECCOAKMAEBGFGD@BC@OTKBI\\eVEHCFEFMHIJGJILEvUNABGGeCCeOCC@GBALADA@ECE@CBIPKLIbK`GDECKBIACAGGMCI@GFG@ACC@GJEDGKoMCYMBBHMB@EDGBMEYJGIm@OCKDO@MBM@EFI@AGI@CFGCIJIAEBCAIDEB@BEOBGAOLcPVKB[BgDCcKQEIWSEIWQEBEHE@AEECIGEAE@EFU@CCIWQUCGCA]@KDQRGDMBE@CAEGGESBCDUBEBg@BAEIG@AR@NEFCBI@WA@BKTOVMNMFDFABC@IBIHADCTSJKDIHAF@hUJBBBH@PJBAV@JHFBFJB@HDDBJ@DM@AJ_@E@AIMAE\\EHCNCFGDK@ED@DFF@FUTWDIF@BDDHP@LSFADDLKLAFKHEBGCE@EFC@EEc@BFFBBDABEBCEG@@BDD@DIBMAUGCHSJ@LADC@CBBHAHCBEBGAGCABAJGFGFAHEBAFEFG@@FWTKFIBCCCGIFQ@TdJ@FBNJLNDHEFEBBFQDGHQDWHcZ@NWJCJGADIHCDIlIPIDOBoUUAQF[NODQBqGQBIBYTQ\\EFQFCDCHFLDDNTDT@NIVMHOF]V]REP@VCJCHOREL@JSFSRGBOPUHQBEDGDCDG\\AROFIRFVAFGF@BPBNHNDHAXQLKLBHBHJJXDFXLJARMJKHCJAXFPKDEFAJ@DBNHH@N@DBHJ@D@DZHfRFFLNLJ^NND\\@fJXC\\KT@DBHJF@FFXD`@VBFBjVX@NDHDDDBHFJRLTBvC`CH@@JM^ETBFFBVCN@HDLJD@TEHAJSFFBXADGFCL@DNFFHP@jIF@LFJ@TGRAJAFG\\CDCDEBKAKDKJGFILA"],encodeOffsets:[[120238,36121]]},properties:{cp:[117.557964,34.856424],name:"枣庄市",childNum:1}},{id:"370500",type:"Feature",geometry:{type:"Polygon",coordinates:["@@AIG@BLqIIFU@AEA@]@GBAF@HG@ICE@GFFFABABDPC@BFAB@HE@@FEB{A@CA@@GKIAESDSD@FC@@NI@@AIAAAcCEvCDADBTJ@BFBBB@BFBDN@DD@JDFLBF@JHDFBHEFBHZP@FA@AFFXCDCHC@@HDFDDBFJ@BFADG@BFEB@HE@@DH@HEBFHCF@BDAHEDA@@DBB@DGHCCCNP@@HGAAD@JFBD@BLRABHJLJCBFH@@HL@@XB@@JD@@JF@BFF@@HE@@HCBBHEBAJE@BHABAJG@@DODGNGFKRJFMAABABHVR@BBJTDBDFN@@RE@@NS@@DJB@FQ@ABBJE@ABY@AFBDJ@BBABIA@FYAEBABBLAHK@C@ADBLEB@wAHNC@EHWAACGD@AC@AB@DIBA@AXCFM@@EK@DKCES@A@ADUC@FIB@PALO@OEgCDE@AFD@@HB@BBCBCHCBG@MCML@FDDFHHDVANC@FABBFAF@BFFDHCH@JDBHBADOACLDFDB@BEBGACJCDC@@BE@KHI@EAE@@CCBAAK@@BE@@AGBFJEB@BFRBBHCFJYH@H@FDBDADD@JDHH@BDFB@DG@H@ALJBBHB@CJF@ALJDAH@BC@@DBBD@BDD@HFDF@FBBDDHADBFF@LJB@FFB@CB@BHH@ADCB@HJ@AFDBENGA@AI@AEA@CB@HG@AAACACM@CEG@CFGAA@APD@@FH@@FO@CB@JC@@BFJABFHFDN@LDBDCLDDBAFHDBDFBXCB@FF@BA@ED@AHIBAFJ@HDDND@@DG@@BF@AJPADHDTFABFFDFARBJLBA@ALGD@BBADBDF@B@BEBELB@BHB@CHEHDHGDANFJGZ@FDHFJARFBJDB@DB@@EB@@BHFBFCBBFBBF@DHLBAPFADDBDCLBFBDFABFDPHAATAB@HDBBAF@D@@BXB@VH@BLHAPJAPMBKJBNF@DFK@RdDBL@BEB@@DFBANABADC@@FK@@HJ@@FEBAFBBD@BHCBGDIB@F@@BJG@@DGA@AI@BJJ@BBO`BBKVQCCBGEIFC@CBEJKCBCCABCGABCOADEaGA@BCICACIEA@AFBPAFEJG@@JHABFGFGAB[@AC@ERADGBAZAFJB@DCFFBCFDDK\\@@BECACPEFFBAJMBBbCVSACBAFBDPBAFF@CpJDHDABGFBLEDFJvC^ENBBLDFBH^LJCLCHIDMBCBAB@DRNTfzK´Y|IjAVHZENGTSVARNLRHFXDlElSRQHaRaRORIRE\\HfNnHzLJXHĎ`ªFBEpI~UpWxc`_VX¡JcFGsÆLSH]@aD[LU@G@GCCIGCEDWFQHI^KXCZBZDbLPBPCJGFM@SEWCMAIBMFGNKXI`C^GHCFIBI@KGUIOKGYGmAyB·NOAOB_CuMcwmmqQWauiM_I[@SFgNkRkPqNsNFmHUNWPQRKjIRGƵUIO@AQ@ÁIGaMYKK@BCFE@CACECCG@CAODCEBEEAAD@@AACC@ACA@ACC@@IKD@EGFCAGUA@AEG@@GK@DKACDA@ATCBFB@@BH@AIDAEWD@DHZKBDLCAGEcJAEI@@ACEABYIB@SJACED@CCAISBCKK@BEOABESB@GaEAAGA@BEBMABDE@CFKBUC@GOBC@@AE@@DMCAKC@AE@ED@@CDAAEB@AG@ECB@BKB@EM@@CB@ACDAEQD@AAV@J@@CFBPA@CJ@@CN@JA@AE@AAD@@EA@@CCCF@AEJC@CUB@BQB@DE@@GC@@EPEBDJ@@ADAACGBAGAB@BMBAAEBGD@DEDE@EYDAAML@DEEWACDC@IDADEAG@CI@@CCAACD@@GKBACE@AASBGDGBCLG@@CYAIB@PK@ADMBEDSBECEMEAAC"],encodeOffsets:[[121311,37833]]},properties:{cp:[118.66471,37.434564],name:"东营市",childNum:1}},{id:"370600",type:"Feature",geometry:{type:"MultiPolygon",coordinates:[["@@EBCB@F@FHFLDDBBFHHF@D@HEPOBGBCCESFIEA@GDC@ME"],["@@E@IDGFQTEF@FBFDDDBTBDDFABECE@ELADA@CAAIAAC@CJIBCCE"],["@@AB@DFJ@BED@DFF@JDBHGDIJCBCIKEAOC"],["@@GDAF@DBDJLJ\\HHJDZDL@NCJEDEBCCGIKOCW@EACCEMIEIAGA"],["@@EHOJCF@ZJrBFBBF@DCHKNeDATALCNMFI@CEAYAWFG@EA@C@EACCC"],["@@KBADAH@HFJDDF@B@JGDICKGEC@"],["@@BDBACI@H"],["@@E@GFGHCRBDB@JGJ@LCDI@GEEG@"],["@@CFBFB@@CFBBCE@AE"],["@@DABA@CACBCA@A@@DCDBH"],["@@C@CFEEA@CB@FBDJF@FAF@DRH@HHBDMAOCICEIE"],["@@A@AFBFDBDC@EEC"],["@@@AA@BB"],["@@BBBAFBJEAAGBBBA@CAADC@"],["@@B@@@A@@@"],["@@@BD@@AC@"],["@@B@AA@B"],["@@A@BB@A"],["@@@A@B"],["@@@B@A"],["@@BAAB"],["@@DBFABD@AAABAFBD@ACDAKAA@@DADG@"],["@@BAAA@D"],["@@@DB@@AAA"],["@@HBACE@@B"],["@@GLBJFHDBFBDEBSCGK@"],["@@BI@CECEDAD@LLA"],["@@BA@AC@BD"],["@@GB@HDFH@DCACCA@CAA"],["@@D@ACA@@D"],["@@A@@DABOAENGHAJJDJ@DCPDJAHC@CCA@CEGEA@EEA@E"],["@@MBUNGFINCH@FBJFFNJPDHALAJCBIAQBK@GAKEICA"],["@@OD@FDBFCDBD@BE@ACA"],["@@D@ACDA@CDBBA@CEG@CAEICEFEA@HD@DDBPHD"],["@@EBCFED@DPAJJLED@FCBCBAJABBDDFAJEHIBMLECCBECCAEGAC@@DGBEBQBIDKHEDCDDFADEDAD"],["@@@CDGHWIKAB@FKBKF@D@DOPGBOAEFG@@BAFBBNBFBBD@DABG@AH@BB@DEB@BBCHE@AAABE@B^J@LDBJHFNEDABG@EHE@EFA@CDCDIFCHGAEEKCA"],["@@EDCBHABC"],["@@B@A@"],["@@DACB"],["@@DBBAD@AGC@ABEAEFD@@BF@"],["@@@BC@DBAFJBFFABGBBDFALBH@FCCCBCEDEACCE@CAAEGC"],["@@ADEB@BHCHGEAAF"],["@@HCDE@ICIAK@IBGFIbcRC^O^I`DDCJSNCDEDAbKBDL@F`BFFDPJZFXPPH^XJBT@LCFAHIBQHIbAÆItAÈJzAh@FJY@QQkCSGQKUOSeSMKIMCgEYKMcMOIOKGOOG]DKDACEEDO@EIYBCGK@IKEEGAOcAMECECI@MBEAA@CDAJ@DE@IC@BED@DHDA@GBNFABIII@E@AJFFEBI@CEAEABCBBDCGAGBAGDE@KIBEEALE@BMDCFCB@BB@DD@FFFBDECCHEIC@ADABCEGCCGF@GEACCB@FAAEC@@GAAEGFGIES@@GBCII]AgBALGLAHIHE@IIBCBHDGDADGIACADQG@CAKQIGCDFFALABE@EAGBCBBBABGDECBFE@ABDHEBAC@BC@AB@B@DBDC@@FUCDCIGIABA@CC@ABA@KGA@ABBBIJCJGHFF@DCCGAE@@DGA@GAECCCEDCFCBCAACKBEKECKAAKFOAUEOAuAm@oVULcFKESUEOCAQBGEA@CLC@GISEIBCFELGJKHGGEACBMLMJ@DCBADHAGFHAFELHAJGH@DECIAEACKGCAAEDGAGDBAAAGABDAD@@CACBADG@ABBFGHOBYEKEue]]GUKGSAmQ[MDgIUBI@MD@HEBEAIUAECGYBIDEDANDB@VKRMF@H@FFD@FABCBYDGJIBKXCFABADWV[HCLDPObAN@B@BOFKJAbPDLAL_@CEGBEHAHEBIDCHALO@ACCMDYIGGEGHGGOBEDKDCXADABQBCLINYJGDEAK@GDEJAV@HDRAP@`AZKTULEcQAKEEIOEUOEGAC@EFENBJADAFEBG@GEMCEIEMEOAG@ODILGLIDeGqOgBYEICKIokIEO@eHK@IAY[O_Ue[m_SYQIOCYD§BS@u\\IP@NDP@DCDED_LM@GAKBIFCBQFMÁmCDI@@@BFEFAFIHEBC@AFQCKRIBCDGAID@@DAEAAAED@DH@EFIDI@ICQABGAIEEG@ACCADGB@DII@M@@CICMICBGCCBEEGBCGIEICGBICCAACC@AAA@CD@B@HAF@HA@@DDRHF@DA@BNAB@BDB@BD@BD@BB@ADBPD@@BDBJ@@CH@BDBHCTB@DLEF@BC@@BA@ACA@EFAD@DD@DJG@ABA@ADAAGBGEK@AEE@CXJBBDA@@BGB@DABEAABBBABC@AFADBBCFEAGFI@@AEBE@ADAAC@ABC@@BE@CBABFBCBAA@BFD@@BB@BFB@BBBDFAJEBBDBBE@@@CNDBEBEDAF@HGFGAEA@AE@C@@BC@E@EHC@@BD@ADIAEDAFICCH@JB@@DH@@DGAAJGNJBF@AB@DADFBABNF@DDBBDB@@B@FDFBHDBBFAFA@BDHBBCDBBBB@@AT@DADBBA@@@DB@@CDAHB@BJCAFFBJJABABB@BDAFDB@@E@@BF@CBDBDHDBBF@DEFKBOHK@@FA@@CC@@BBB@DDBDH@BF@ADFDID@DCDCAABEAID@BA@@DBFADB@@B@D@@ABAFBDAHFANFN@H@JF@AD@BAH@@FPBDB@DHBAD@PDBALB@@DE@@CALCA@CA@U@K@@DIA@@CBCHAAAHAA@B@AC@CFC@ADE@BBADGB@BC@@D@DB@@BBBCDBB@@A@ABBB@FDDADCFK@BFG@CRA@C@@DJ@@DHBAH@JD@@FB@AJB@@BB@@DB@@DB@ADFA@LD@@LBBFDD@HE@AH@BID@@AB@BAF@A@J@AHF@@BBBAFF@BFAJ@FGD@BD@BFBBLDDHEN@FCDE@CBBFJ@GPC@CCIB@BEA@BOBCC@H@DBFAFABE@CG@EACC@ECA@ACUDUMOFBFE@ABAACDAHABADBDD@HDBJAFB@AD@BA@@BF@E@@BF@@B@JA@C@@FC@@HBB@LB@BFB@CDD@EHD@AFN@@FDL@HADGTBHEBCC@BEBACADKHALMBAFBBEBEAG@ABE@DCAAA@AEWQAIBACEKCQ@MEGEC@AEGBIFGAGB@FEDC@BAC@A@DA@CI@BCB@BAI@E@@CE@ICACADC@CDEGI@CDC@@AFE@ABEDCAIEEUB@BEBGJC@ABGAABBDA@ACIBIAEBOICOAAEAAAFA@GA@ABA@ACC@@CBA@AIEAHA@AD@FBDKDADEBAB@FBF@HB@@BIAADD@@BD@GLEBA@@EGCEACFE@AFE@MD@CBACGA@CJDDABBBCDADFL@HADEA@A@AQDIA@EACCB@HCBEECAACECAECAAEICC@ULECC@GJIDIOD@@GAC@CAA@AACBABAACBEAEE@ACBABA@ESC@CA@ACBEC@BGCA@CCG@ADCBGAKICDCEEBCACABCCDAACCA@CAAGCGAAAAACBCBADABCACCC@AEA@GBC@EBAE@GKC@KDAAAIEC@ACCAAB@BABEAIBGA@DAAAB@LG@OCECGCKBKCI@IAE@IDADC@G@@BKAABG@ECE@ED[BI@ACCA@ACKBEICCA@CCEGCA@@D@DCAC@CAA@EJEBKBIBBDA@EBCB@BABC@ABAAG@AAABA@CDEBCDEAABA@AGEEMFOAKCEC@@AFCAAABAAABA@AKCG@EEAD@@AAEBDAAABBBADACAQEEEkBCFYBCDMAQBO@@BUB@AK@AAM@@BOB@DC@@CE@@CGAA@@F@@@BG@@DE@BDC@AHCAAFEA@DQ@@EOA@AQCGD@CG@@EC@@CDB@CE@@ABGE@GGYCA@BDO@EACA@AMAQJAJ@BC@@FEHBBUGBCGACFQEGEC@IB@HCBGA@DG@SHGA@BEB@BG@CDQAAFG@@DM@GEK@AJC@EAGEFhCFIHcL[PWHIRDJXTHH@DMZjZPD\\PFAFINEJTFÖXHDFFNRb\\T^HJLJZPJHTdHLJFHFTDrLZNRXwBAHBFDDxBHBDFDRIFGJD^@HEFELSTG@aLEJCLSLAFHP@HBHETJJ®LZFbDLBHJJ\\DHEFFFLHX@tJLFRPRL°NRJNPLHnJXNFNL¦~^X\\`VPBLPTILCNJJDdJLRD\\IPQB_CWJYTCFCHAHBHDFFDLDl@nAÐ@lHLBdTldZDFJHFLFDHBd@TCIHJFB°JLBJDJFvpZLNAPEPBÚF@R@DDDDHBFAPOH@ZDPAVE^KTAVBpRRDTBhABAHMDA\\@DANEPDJBJELMDG@K@GKECEBKHINKt_LCX@FEPIDEPMDMDGVQNGZE^DHPD@DC@GFAVFTDX@bB`AHAJEHELMBEBKCIYaCGAG@SRCJCHKBK@CCGSUAKBGHK`YJG[CtBl@N@¦\\FBHHBLCHIRWPAJDDHBZAZK\\AXOXSXE\\KFIIGOCaB_HCDE@EEAEDAP@BE@KDIBKD[BCD@POLEJCTAJA`Y"],["@@@AAA@BBB"],["@@ED@BF@@E"]],encodeOffsets:[[[123806,39303]],[[123821,39266]],[[123742,39256]],[[123702,39203]],[[123649,39066]],[[123542,39048]],[[123759,38985]],[[123847,38933]],[[123567,38898]],[[123575,38896]],[[123580,38839]],[[123570,38853]],[[124326,38515]],[[124390,38483]],[[124401,38481]],[[124402,38480]],[[124404,38481]],[[124406,38480]],[[124403,38480]],[[124406,38479]],[[124439,38479]],[[124433,38475]],[[124446,38462]],[[124452,38459]],[[124379,37646]],[[123541,38969]],[[123780,38940]],[[123588,38873]],[[122698,38205]],[[123320,38698]],[[123344,38676]],[[123522,38857]],[[123527,38848]],[[123549,38892]],[[123590,38896]],[[123658,38824]],[[124390,38476]],[[124384,38479]],[[124411,38469]],[[124417,38467]],[[124425,38454]],[[124397,38474]],[[124369,38429]],[[124276,38540]],[[124473,38486]]]},properties:{cp:[121.391382,37.539297],name:"烟台市",childNum:45}},{id:"370700",type:"Feature",geometry:{type:"Polygon",coordinates:["@@HCB@D@HHF@LCnAZCLGHEBEBaFANY@CGGWSCIFKDEXG\\OdKNMEgIa@CMKU{GOBG\\mDK@Ck{¥GEAAOBKJOAEC@ABAF@HBDA@AEEOADCEEBCAEDCIIEMAUHkZeHCRCPOJBNEBEDABIHE@GRKBIDCBGHC@GFE@EBAH@DCAEDC@CBCFMFGACFEDATIBC@ALARMBC@ADBBBFBBCFADEHED@BDDABADEB@BCF@DEF@FAH@DAFBBCFAHG@EF@FCJ@DIF@HEDCDBJGC@AAAKCIBMLBABBBLB@FHB@BH@PKF@@CFBB@AA@ALB@AAALCDCF@PGZCzUhGKTEnEFIFs\\BCXBFRLNFD@@FJBBCZ@@CD@BGrYBB@HJ@BBH@BGCAAG@CC@CQBGBAH@BDHACCICGIFOFCDBBEB@BCGABOMABIQCEBAFsAAACAM@IGC@CFACCC@AKK@KIE@ECC@CQA@GAEDQJAHID@JEBKDABGSGADEBCDQAABUAICDKEABKMEDKGYDEBCCE@KEGEKBKsQAAAICCKBEAAC@EEEQOOO@SEE@KAAECK@CADWCA@ACCE@GGCGBED@BAFSJOH@FBDCCICCCIDGFGAEJE@ICAEBGCCIBCGBADGBBGCCBCA@E@CBIBGC@DABIB@CCAOEO@BDG@SECEKEK@IFIAGDCH@LEDC@CAEDI@CDCAAAI@GBKFECGAMFEJAC@CSCEEBMECAGKABOEB@MEE@ACACIAA@KB@@AEAADA@CGB@BADIJG@A@CBGHCBEEABGICGCIA@ASCACDQECDMAA@ABGZ@BABAP@H@ACBAHAFB@ACA@AF@@AC@@AN@@HBFDARMHCPCBDBACCBCDDBDJBLABACGFAJGAEEAECCGBEGABESCAIGCIAKKDMDBBED@BEEEBMCOBIH]PUHO@AECAAFGFCCW@AD@DDFC@BADHFBA@CFDDAAGDADGCC@EDIHIH@DIDADG@AA@@ADK@IFM@CEIDGAAC@AFC@CEOFBCCAJC@@@EACE@ECGBCHKDCFADC@CA@BE@EACCI@AABEIGACKGDEAEEAQ@QHK@QMG@CEKA@EBCFA@@GCEGACCC@EG@CC@CIBEOAKJKFCBCIQ@EAAIAM@GCAG@ECG@IAIBAPCJET[HCNKBGEK@GAAC@IJE@MECECAC@ABAFKBGBCCGBACGBCAGFKACDBBCBEHSDG@CFKCAA@ACAIBACCBCBAPBCFBBBMFQLGJAHEFEBOAIBGDEV@FDHBDRJBFCHGNMLiVQ@WIIAE@QAKCaQMIKICEIESIM@AD@HH`BV@NDLAXGLQNEJDDLHLTAHSh_TG@K@YKMAQBIDGFAHBPAHEFKBYIk_SGGGoIkCEB[PKLGFOAAA@MACACEBMJ[JADDDRPNBdXH@J@J@@FGLBH@HDPAHBb@LCBCDI@OCUGCNWEMAQIGAm@SBMGG@WJCBALAHEFCBKBqEM@EVCPRPAPCBK@CBAFJPJFZGBDNAJBBJCBB@BFDBBFMBIHADFH@HADBBFDHJ@BABBFNHLHD@BADB@DABBHEBAJGAEBOJE@CAAAG@EJMBCHADB@MDGEC@@CGCM@EDUEBGC@MFGFOBALC@GAI@CDAHADEBGFAFGBMKCAIAEFC@IAEC@CDAFA@AAAG@ABA@G@AABGHBD@OWWAGCM@EECAGDCJIHIBIJBFD@CFDJHA@BCDEBAACBBHCL@FAFH@@LD@BDABGDCDBHAJDD@RIPGAIFALANJHCD@REDQDKEW@GAIAGDEDE@CASGC@MDGHG@IFEAGEGIOGCCAGDADBFIEMBA@@@AC@EEBGE@ACADCBGAAABQCG@CHIQECEKBBPADKFKAGCEOGAGBAJEBCA@BGDCACB@DFD@FCBCLEFADEAM@CDGBMHQBCIAAIAMFC@KHKMGBKMCCE@ICKKQEKFBDI@OSAC@CDEDGCKAAADCAGBICGECKA@@FCBCBICDCCEIAGCCEMCGAIJEE@CE@EDC@AAFGFAXBVC@AECDCHKCGIA@I_QAEECBCD@DCIBEDGCA@CBGASLBNBD@TAFCF@FJBBDAHGFBD@DCFBBJHFBJHADCBEABDCBC@G@CAC@BDADOD@BKDGI@CAAED@HGFC@QG@CDCAAE@I@EAABEKACC@CBBB@BGBEAE@KJIACNBDJD@@@JC@@D@LCPEDIFBJCDK@CHGTGLBDCFGBBFB@PJANFHAJDLEHHL@DIFCDIDKBKJ@DABUNOAMIQCKGGCE@OFBNNNBDAJBFCDJJALKJCDFFFLMFAF@FEH@FADMFE@EBBDTPCFGBIAW@ABDBB@IBA@CCBDCFCAAEEABDGBCHBLMHAJBDCDC@EDCLGFGBDFAFGDAJHDBBDJHHJBAHCD@BDFGFI@@GEAK@GCEBAHADDLDF@PJDAJETCD@DDDF@DFCHCDFDEHI@GFIPGVDJHFABID@DCBANHFCDCJ@HBDRHDD@NABK@WJUDAJIPCDE@CRDPJFGFC@AACB@BADHNTLNPHJTJFDBFKPDHCBCFC@CAED@BDFHB@FH@JBDD@HBB@FF@BPB@B@BEFCFBDFADGB@DCBCAADAAACGB@PABBD@DHD@DFDJNTCJCDBCHIFS@TX@FMDBFGBLPLAFFNDBBD@DBHCBCDAFERCHCJ@FFCF@JMBGXDFBBJLXB@CHAFFBXHDK@ADA@DPTCPHF@@IFCBCVCPBLCH@DD@DFB@CNFFHFBVLJBJLPA@FF@BAD@HBDDXBJH@DBBFDF@PJBLC@@HDFBJCB@LGD@DTAJ@BXB@@JF@@HF@@GH@AQB@BBNDHB@FPB@BAB@J]D@DFB@FDFFAHNFBBDD@@lE@AB@DGD@DFFF@BHF@J@NT@JA@@FEAABFDFB@BI@DLCDC@AAC@IB_DBDFBFNFDTAFCNABCL@@OJAZB@DH@DKHAHCTABBF@BDLA@HC@BDDB@DJ@@DBHCFCB@JCDBDFXCFK@BNCBFZF@FC@CHCFABBNA@ABABHHABDCB@BI@ACOF@FD@@HF@@CRA@AVA@DIDBFE@DD@DB@@FC@BBF@@BIBM@@DI@@DOBEA@DI@U@BBC@FRCBBDA@@DN@@FLA@ADA@FBHA@BFCB@DC@@FBFD@BLND@CF@@BD@PA@HVDLADEF@ACNBFA@AHBBBbF@HTAAFPBAFL@DLTABJDDC@DFIB@LBBAFJAAZFBBD@@FJIBFdBHKDACYLCGC@FXCBBJG@@AA@AESD@BCBBDCLL@@HH@BFB@HVDBHE@FLC@JD@BDB@BDD@BD@BC@BBFFFACDBP@DDHFDBD@DEFADL@ZLbNJHÂR@@BPJƶVLIFKJoBO@MLONKXELBHBV\\^C^DLRBfBJFDf@JCNOBCACAAC@QNCBKBGAGC@[AGIQK[CGBEJCF@DHDDH@jSHAV@HDDFPnDDFBHEDIAIM[EOCqJGLAFBBJCX@PDJLDT@VEXUPERAZHRBPELIDMCMI[AKBMLKBGBWDGH@FCZaJGHAZDRJ^HºAjJRDD@PADST@B@BDBVUDC@ULE"],encodeOffsets:[[122368,38022]]},properties:{cp:[119.107078,36.70925],name:"潍坊市",childNum:1}},{id:"370800",type:"Feature",geometry:{type:"Polygon",coordinates:["@@LELKFK@ECGEGWQ@CpkNM\\YnOXKVHHBFAB@FMFED@DDNZDFFDJ@HETAFODAT@DADEDAF@FHDBBA@ACI@CDEFCBB@VDDBBJAH@DADE@CCeAMFO@CLMDIdkPMNCfALE@EJABCME@KDSDEBANELHDDXNPTRHV@DAJEX@LBJJF@JCFEJEF@NBNFHBFAHCJBFFBNNFJDLDD@DB@BB@@ZRPPEVPTAVFPALVHKX@\\VFN@^FHBPDFNJbHN@NDFLBLBDNPLHJJTHVAJ@JFF@\\AFAFKFCFKIGCQEOAMe[cGKCMKACCIBKBIHEZOPOLGTGZETIPMDI@ICKI]IIQ_GGCAIBWHSBYIGEGGAEAWBKCGBE@UDKLEL@lP`FNFdLL@FCBCDODEFCzIR@LB^HFEHGPUNIVGLDHDFJBJBPBDLHH@HCl]RAVGHAHBFDPZHDbFFAhLTHPLJFFHDJCHMNBHLPHDbDHDBDHHBRDFR^CJ@NHBHBT@dQjDZEH@VDRHRBD@JIDMDERELBXCJAHC@IACACOIDCDAF@PJR@XFHFPDjGJALFTBHANGjAVCJ@DDFFLBTJLJPDLPFDFADCHCdFPCFSNCDAFCHKLG^LHAHMHAZHTHD@DALGPQNUDKVCXEbMHGLQ@IFG@GD@FDN@PFF@TAJCDEMGCEACBKBCNM^WbUTOJElgCIIGACG]@Q@CPIES@G@CFG@C@EGMMG]EGKBOFYBEAEMBKF@DADKTEDCHI@CACIGYACEAMAAE@YLOLC@ICCCCIBGCGKGMMAEAKEGCGECMEGEAG^SNEHEBIEGFOBANEJGFGHWBCCAOICE@EFMEMUOOCIKAC@QBCFGHYDCJGjMJGAEEGMSGKAGMBaKWMGCO@EBEJIHCLBLALCFCD[DEHIBQBSHI@KEE@iJO@EGME@CDKHEBCAWEEITGBSFC@KIGCM@UDEAAEFSN]@IG@_DuDSAQKEIAGCCGCMCW@iUEAUA_@WCEEE@GICAS@[LWDeI[@MC]MKIKMEEeQYG@C@CGICAM@G@MGCAI@EBCFOLWEIBGDUVEDIBWKCEIWGIGAKAKLWRGBMCMGOA@AHEBEEUJQPEBQH[DCHCFCRAVGPOHATQTE@IFKPQDGDI@UFO^Q^UPENGJU@MCSMSCCEKDGDCREFER[ZSJARArHRAPC\\MREVBpVPAJCJOJkDCJGBCHDIXI@MdYXGRCHGRCAEFAFECGKMMIEAI@ScR@JEDHDDJALEXS@EH@FEBEFABGHEHEBIBAHDHBFADABGAGDAD@BC@KTIDGVHNBJA@CCC@AH@DFFABAACEAAEd@FFD@FEF@HDFALGBELKCKBCTE@KGOCC@AJEXCVS@EEE@CFCL@HCDEDMFGF[NBBJF@`@BIN@@CAICC@GIAAEGE@IBUIA@OAGAAcTC@KDGFAADCGEEAKDCCI@EKOGC@@BABAA@CADGA@B@BCA@BAAA@@@EAAEAA@AD@@CD@B@@BDADDBFJEFKECCEA@AAQLCACACA@E@AC@@EAAADBFA@ABC@EBA@@AA@@AIEAB@CBCPCCCDE@CGCC@ACSGI@GACCEFC@EAAGFAF@CGJGAAEAACBGK@RAAE@IAGGAEFE@GGBC@CDKJM@IACKECE@CDAAMAACGEEWICD@DGHABM@ECACC@BC@CAAgICBRdXRHHABGIOKGJFIECWJAAOBHXIBEMCACAABG@EXAFTJKbEBIAMAIAGT@XC^HNN@B@@PKlGVQbCNGnEFIRCBICEJkAC@WQKCE@EDMRGTDXCLDHJVFHbP@HCBINABDFABSFGDCDAJBZGNCDQBCDAHFTAFOPiTQPGBC@IECAOTIRWzOCADANDdDDLB@FO@AJGBe@WJMLENqVmPUNKPDKGDEDFO@A[J@_ODH[XG@I]DGIG_GG]BGEMB@LUFODM@IDGHIDAFQGK@AB@BA@@BKBADG@ACA@AGW@@@A@@@G@AA@EC@I@MDABE@@DC@@DMJCEMDACC@CGF@AEGC@EA@AGEDAA@@CCI@AA@A]E@BGA@@EA@DE@BGiIOBC@@DC@@CIC@AeE@FE@KB@AHAAASDAAG@AGAAeA@AA@@CI@@BG@BFA@@CEB@CKB@@CBB@MB@II@@AMB@EB@@CB@AED@E@@DEIABGEMQDAEBCC@@DACCC@@BCEGAE@CKF@GMEA[LmNUAoDGDEFMFM@QF_BOFMHKLGHGFSHeJUJO@KBIFOLADGXEHMDoFIBEDFLFFVTDFBDCDEH{hKLQZOPCHAN@NBRHJfLHFHJTf@FCFGBKJJNDNFFJBR@HCVADDLVRXDFHNLHFHFTDHLLBHOVGRDBVED@HBDDBD@RLELONIFAZALVTpDPATGRWfGDKCOE_I[CBHIbGVCFEDKDINC^GX@LHLHDVHFDLPFVE`IPGX@HHV@VAJMjCTCDcHKCWIGBEDQNGDK@MDKFKLEJALBNDJBNAPA\\CZKbCPBFDFRN@NQZAXBDJNRNHHQ`G^@LeZQRGHQTCFQN{^]`SNt[JUJZYDI@GDKZC^BJANBV@dB@@CB@@JDA@FFA@`LFGN@HYB@JC@FJDBZFXBJBFDFH@FGXDA@RD@@HDBADPxLN@R@MP@FBDDDX"],encodeOffsets:[[118836,36846]]},properties:{cp:[116.587245,35.415393],name:"济宁市",childNum:1}},{id:"370900",type:"Feature",geometry:{type:"Polygon",coordinates:["@@PSPOJKViHMNMHKDQAOKe@[BOHSF@hWNAHN@RJL^D\\LPJNLN\\RHPDV@LAJIFURKf@FCDK@AE@AMJC@GD@BCACAAAEH@@FHDBDF@BDF@DVL@BFJDPD@VHLBXJFJLJJbPNLRDJANCLSHKBEAEAACKAKB@FF@@DBB@HE@KA@EG@CAMBBHDA@HF@@DF@FJABBDFAHXUFILERINYBIFABA@@AA@KRD@@DC@GL@BHF@DPFBFDDBBED@D@HL@JDHBFDJCADJH@HBJDDDAL@HBBAACCAAABCLFD@HEBEF@FBTBBDAHDHBCB@DDBHHBXBBAAE@EB@@BF@HCDFCFJPRD^XJXL@VDbARINKVOVAPBNFTARJRPJF|VVNL@PAlWPEBB\\EJDRBFFBDPACIDG@EHGJCFEGAJC@MFCAEFCPCFC@ENCBBJBRDNJVJ@DDBD@DA@AHK@CDAFBDAVGLFFEJMPGFEJBFHJDH@B@LDFDB@BCFBADAB@BBBA@@BBB@DCFVBDBHBPBBBBPFDPFFAJDFJJFFBFFF@DDH@FADDBF@DBHCH@FDPBDDNFFBDFBDJAJBBLCLED@FCBAJD\\EHEJAFBCHNNP@XLP@TBHPCFA@AD@DDJVLRXFPDBFF@HT@FDHFLDNCFFBD@DHLDLBJBFLJHBDFFHXDJRDBLNBJAFH@HCHAD@DAF@@IBAJBFANAZBLAJCBOIMEU@WLmJUDQ@KVetkDM@KA@MIEKDOJIDI@ACCAGH@BACAAEJ@LC@KIIBEB@@GH@^D@AB]BKDEGAI@MGQABSAAO@@AFCJA@EDAD@@ED@JERCLDFHJDDDJBHED@@AR@BMAEC@DIDBFACEOKEGN@@GFIDCFB@AAA@ENGBCNDDC@DLBDEFAHBD@BAAAEABGFG@GCAQIOB@BDBADGAEEGAEGACCOCCAOA@ACCG@AHEZ@DCMEGA@GDE@GKEABCAFEGCDEFCDBBAHDPAHFHCDBHFB@AFJJJ@DCAEFBFDJBJCnXLDP@JKLcHIJG\\I\\BZ@ZK`SXK^Id@ZDFBHEHCHCRGF@DCHCBBHGCCHEPBFDFFB@@@B@HIPKHKEEGGBEFADK@ULAXJPB@DHFAHCDDFHBLHJADAPBFA@AAA@AD@BDJDBCH@DBBADGD@FDBBHADCFABBCHB@LCBCHADDH@D@DCF@FD@BHBHJPFLAHDNB@ALAJBD@JGHAHMAERAJ_CGAGJQHA@OBENEFDXRFDZALFF@MWAGBMX_BGBIEMKMEEKEAACKBGAKSGQ_E@WHOBaP]DO@OBOEQOOIEGGMIQSGEACCY_AGJITMHMBG@IEGGEOECAAEAKBEJ[H[@ICEEASDEAIIGAO@UDUAEAGCKGQYCEGCYDQBSJIHQHIBC@ICQMSGMCQCYDaCQKCC[gIMKuCekhIFSPaV]XMNADALBDDFNHCFIDSBE@OEM@ECC@@HEH@JKRGHaNWFUDCLMVORKHCBC@SGYGGBGNGB]KKHGLEDCBMDETODcEGDCDEBECKOOCKISIKAEECCI@UDiBMHGBSAKEIBiHOCGEWEQ@OIE@CBCDPJBDBD@JGDIBWDKAQFCFCNIJC@QAQGUCG@YFiCcRS@GAGA@MDIQ]CEAQGGACGCaCGCKOAGNMDGCIEGIEOKSGgKEBaEGCOYECGAGBUHQBk^GDG@KGACAOAIEIGCKCUHMJOVGHEF]GKAQ@yJEDCFCPADEDK@cKME_EkOK@KFCL@VAFDHALBXBFHHHFZJTAXGJADBHHR`JJJ^DL@JCJONSJYFSHKHOPYPGFAJALDJBDNLLDdHf\\BNFPDRJHELEDCHCF_BUEYBSGIIKGMOACAKEKMCM@aGMICEAOEG@]EM[UW@GLKUOBUESBUOOFQO@YA@@ACAC@KCICMEAMEEIAGDEBGAMEMAE@IFEFIDE@IIKAW@IFCBU@QGOSWMCCKGMFABCFCT@LNFADIB@FKFeBMDONclCJKN@DEPBNDf@DCFCBG@IBAACC@UAAEDCF@DDJ@BABCAEGE@CBCFCBS@CBEPSBEDEBE@ECCEMYCCC@CDGPCB]GCAWLmPKJ]^khCD@DXRFHDH@FELKLKFDdFTPhVEDJDRB@BF@NED@HA@@DFA@ANALHU@@BB@BFD@BHJ@CLA@AJD@AHJBAPB@@BD@BDD@BPABBBCB@BHBCJDBABXNFFFTDDJFVHTDTBVFDLCbJFCJBPF"],encodeOffsets:[[119016,37042]]},properties:{cp:[117.129063,36.194968],name:"泰安市",childNum:1}},{id:"371000",type:"Feature",geometry:{type:"MultiPolygon",coordinates:[["@@ABEFBBDAH@DAB@ACIA"],["@@EDFA@A"],["@@ADBFBDDBDAAGCEC@"],["@@C@EDAD@DJ@DE@CAA"],["@@IB@@DBF@DACA"],["@@CBABDBFABAAAC@"],["@@EBABBDHA@AAC"],["@@CB@DHJBPHFBABGDA@CECEKIE"],["@@AJDBBGAAAA"],["@@AB@HFFB@DAJCACGEC@E@"],["@@FA@AG@ADB@B@"],["@@F@@ACCHCEAGF@BCDH@"],["@@@@@BBAA@"],["@@A@@DB@@C"],["@@@ACB@BGBACE@@BD@@BE@CFCDDBHCBBFB@DDBBHD@@BBBBCDA@CBAHBFCFDDAAABAAEDCFCDBDAD@FEBBFCAAKBE@WBO@GB"],["@@ABBA"],["@@A@DBAA"],["@@CDGA@BDDD@FEAA"],["@@D@CC@D"],["@@B@BACACBBBB@"],["@@A@CD@DFABAAC"],["@@FBDCJ@BCCADCB@@ACA@BGBCCEBD@@DDBCBC@ADE@@DCBB@HC"],["@@@DD@CC"],["@@ADBA@A"],["@@ABB@@A"],["@@BA@AA@@BA@BB"],["@@@BB@BD@FADDB@CB@@CDA@ACAAAEA"],["@@C@BDBA@A"],["@@EDF@BAAA"],["@@JH@DB@BFBCH@FEAAEBACE@ACAC@EKBCFFB"],["@@bF@FAT@^JBBAHFF@JD@DAJ@DDFFDHBJEHDLDDN@DAP@RBLFBDAHLHD@DCDDB@DCFEHCHDDDAXEHILAHBDFBf]hGDBHDB@FALB@DGVBBDBJCLSPBT@@EEAAAEC@AJCDEFBDCH@FCHBDBFC@CACBC@AMQDGEEQ@@GCAC@GCMBGAKEAAH@@ICADIDAJ@D@PGHE@EII@CNcBERSDWLIJALADBBFANLJDFAJDDJCZBHLHDHAJED@DB@NDDFAFEXFHAHMFCF@R@DCJGJI@EAAIAAC@MDKHEFATCXBFCAIF@nCPBbB`HN@F@LIHALA\\BjL~J\\RHBF@BABCEOBGJKFAdEGxH`VLDrBJ@BBRFF@HIAE@AF@DNBDH@DABCLABAAAG@CABEHEPCH@BBGH@HCBCF@DDBP@FBFCHKZGFGDUPCBC@GCGGEWIEOEEC@IAGDINADCBS@EBIFI@QCGEW[CAQAGASOGGEMCUDAbFFA@EEEWOKIIIMMCEKGAEBGBAHCBCBCMIEG@EBCF@BA@CIEAAAEEEBCFAHBTAHFH@BA@CEQ@ANKF@BCAQDODEXEJCFGJQ@QACECE@MBEBK@CAGQCCaFEAGEAC@CDEHEPMBC@GCGIKKA_@kLIBoEQCKGMQGOGUEuFg@MAGEG[MCCCE@EDELCVATCV@^FVFrVVDVBL@JEHGBGAGACOI[KKGMOIU@MBOHMPGDKCIKEKQBAGEKKMUUSKIC[CI@E@GFAD@JBPNL@FGBK@k@gBO@GCAC@C`ULKH[D@HHFABCOUC@QHWIMGMAECAAAIAAA@@JCBQBUJO@ECBCH@BAAAGCAADKCGEEMEMCOAGCCA@CCABECAM@GHABBFGBG@CC@EACEAIFQDSACBAFQCO@[JABQCMBAACGC@MHMFCDMHIBK@aKQAKAMBGLDH^NBH@NADIJADCJANAFCDK@IEIMGCRCHTn\\hAJCBUDWKE@GDC@GEGK@MDGGMCAk@]IMIAGDICCIEC[CKCACFAD@jIFKJCJCADINEXCBGBGKSEOJG@IWIGQIgGcC{UWAKASGOKW[KsOmSKQCG@GFEBECKCCEEWEOECIICCCE@IFE@WM[EaAKAyWSKII@CDE@MFK@IDKDEFCDE@GXsBECEK@MLKBYLIDSACBGNBdADCBCCEKGCIB[RK@QC@CNEBEJE@EDEHC@K@EEGIGE@G@GD[HCAGEE@C@@B@HDDPDJDBF@DABEBQJC@MII@AF@PABS@CBED@DDF@FU@WDED@D@HDDPHDFHFZHfRPDVADDDDBHFF`VBB@DK@sMKEGCMUECYCUQeYAC@EDKAAKFSVYL_BO@QBGCI@K@IBCF@DBPCFIHMZKJADARCBWBCDCLAFHPGHFHHHZJNCDD@BKPGBCDAJGFGBAFFH@DK`KBOCaIBELAPA@M@aBOPKCGDU\\CXABEBWDALIJCHAZADEBC@EEG@E@QNQJEBMCCBCFAJHZFDVBBJAFGFC@@NAJJVCh\\NnRTBLHHV^^vfLFZFPAHGAEBAH@BCDADB@@BCACHBBBABHCHBFCBBHDDLFBJBFD@CHGBIKGEFGBHEGBBCDA@CNINKDAFBHHLGHIFKDEJATFHJD@DKB@HFRADBFPTVLFdEVKpUn@vBPBVFPBLEBBDLLFAFDLBBADEDCDDFDDBF@HHB@CF@HBDD@CEEHGDIJIAABAB@LHB@BAD@@DABJBJHCDVD@ED@AC@C@ABAD@@ABDFACGBAF@AEFDHCBAAADAHAFBF@BABKEEDCJHLRDBH@CRDBJBCHCBCHAGADJJF@JGBGHKBKhA^BJJAD@HT@JFEHFHBB@HD@BFEBA@DDFB@HHEDDFHADCB@BJDGFDDCFEAEEC@@CAAA@EDCDANF@BKFFJA@LCFBHHAHBCDAAADFBFB@DAJEFIE@B@FJJAJEBAM@HCBCGC@AFD@@JCFI@CB@DBBAF@NDJDFNFdBBPFHLF@JHLADJZ@FCPFFBDLC^CPHHPPLPJdNLNFZDhJNNLfTPTLVHRDTRl@RIZ"]],encodeOffsets:[[[124927,38429]],[[125154,38395]],[[125526,37851]],[[124965,37745]],[[125228,37715]],[[124510,37628]],[[124501,37625]],[[124540,37595]],[[124584,37560]],[[125422,38346]],[[124940,38430]],[[125015,38477]],[[125100,38426]],[[125105,38427]],[[125116,38400]],[[125134,38412]],[[125140,38379]],[[125619,38344]],[[125423,37940]],[[125324,37737]],[[125331,37735]],[[125192,37638]],[[125177,37655]],[[125179,37669]],[[124965,37762]],[[124979,37772]],[[124626,37688]],[[124563,37676]],[[124521,37612]],[[124401,37615]],[[124851,38373]]]},properties:{cp:[122.116394,37.509691],name:"威海市",childNum:31}},{id:"371100",type:"Feature",geometry:{type:"MultiPolygon",coordinates:[["@@DCHB@ACCCBK@A@JF"],["@@@AACK@@FJBDA"],["@@A@A@@DD@FCE@"],["@@CBA@EEBA@CECFM@ECEDCEQEEBCAEFABMJGHG@EAUBSEI@CBABAECAKE@AABAHCBAKIAC@SJIPGLADO@ECC@ABABCD@NDXBHEJBFGLABBCLFBH@HHLHD@HCFQD@LDDDFL@JBBHBJIT@BEHGHATKJ@LBFOBKAKEMYsUyGIMGSCIGIYKEEIOYuCU@IBKVQ@GCQEEIEaIgIISAM@A^AFAACACYAAAAuAAEAABAJCDCDIAGBCHCTMHM@QEOGSMsmu}MWKWY±KDGFEhQBE@ECEeQAGDYCEUCEGQM]EKHGHM@MGbCDADGFCPMNYBMBEFKBEDE@EDAAOACAGIOEAECCECO@SHKTATEJGFIBU@IBIFCJBLBFBBPHCBOLK\\EDCFGDCBMEIJ@HBJBBrFFBLNHV@NHFHR@V@FJFDDILIlCDY@OFABHJADUVIFCF@HBBLJBLCD[BwISGEBAPIJATAHMHABBHADCBKCGBaNILsVIB]@]MCC@CLWBGAICIKGOQIGMC]FICMGEACBKJAF@LAFWLKDaD_NCHHR@VEJABGAMWUKGGCAIBIBMASDUCICG@[DQJAAAIAAKCMAKEI@KGE@_RGEOC@FCFOFMPEAMKEICAE@KFOBYDKA[GE@GD@XAJCBaFC`CFAJ\\LHFJ@PCNBFF@DCBMF@DATEF@HJDN@LBDBFJJDHBHALGL@F@HDLBL@JGJ@LDBF\\RFJNXIJGLKDIHQJMLBH@FERFFTNHJNHJH@HCHBHVNFJDFBHIX@LDHDlFLRT`\\FJ``FJHXLZHJHT@PCFIBK@aEgA[FO@[DODCFE@GJCJFZ@NGNCNCRDH``PV@JCXBJFBDHJHHBZ@FDFLDTA\\R`@DXABDEP@N@HDDhBNDFD@HCN@JDJHFPDLA\\@LVFTLDFDH@LKPIDIHCFNNTN¼DVF^LLJT`B@@BLGPBVKBC@CGACAIOBEDAL@DABOQODOFUN@rFLADAFEBGBKDAXIH@NHTAn@HBRJNBXFDMVHPDJ@HE@EAkBCCO@GAGHK@EI@I@G@cWMAQOCCBC\\INIFABDBD@NBBPBHELK\\OFAlDpJHHTHl`ZJLAFEBGAOBGHEJCRANBZLL@H@`STgBGKSKGCCFIRMHKBWCK@MAUG_@GBCN@TJJFDFLJNJbRLDRBF@JBXJR@jUNKHMDGAEQIACCG@EFUHCJAPBFAFEBGHIRKNEAAEAAD"]],encodeOffsets:[[[122793,35985]],[[122778,35850]],[[122704,35834]],[[122395,36589]]]},properties:{cp:[119.461208,35.428588],name:"日照市",childNum:4}},{id:"371200",type:"Feature",geometry:{type:"Polygon",coordinates:["@@PAROBBLIAGMCGBH@DDE@K@MEE@KCDAJKP@@CDAFBFKAKQKHAAAHAACHBFKHBBGCEEAGBGEHAFMF@OOHGJBFDJ@FEJ@LM@EFCFJI@@DFJB@LADCBA@IHBPHHCF@DPEB@BDBDBDC@CLBJABC@AACGC@CBAAAEIDEBMB@DDJEdGB@DE@EAEBE@EEEBCFGREJKFA@CHMF@@KAEBC@EBAD@JTF@DMAKCCBCBACCCG@GBAFAAFLNJBAJFDDCFAH@BDJCH@BBAF@BHAFE@IF@@CC@CBCDBDABCAE@DCD@HG@AEB@CBBHABAGADCDAAEEA@FAAAA@IAAIAEEAG@EFAHGAIC@@OHOHABOEEAEBEJEDAJ@HFPAFCN@XE@CDGCCCOGGCQAKBE@ECEDOFEIECKCAGFGBOASIAKC@E@AFKHE@GE@MBAOUCKEE@AJ@JAJO@CE@AADAAEAAEBGGBGBAD@BID@BCCAAOFGD@B@@KHCFECMAAFE@IBEHCDED@N@NLDCCGNG@QO@AIEIFEDA@ADGYCGMBCHKHEHAD@@ME@GGDCJBDMDEAEMABEDELUAGKAMBAHCHQBBFGNGBIHC@IAKB@BMAGCKBOEGIGA@AECE@CDC@G@CCA@EBADKDA@DGAAEBCDGBAAECC@CHABCAG@ADICACC@@BBB@BEBOACBIBKGGACEDCBGGE@COAWIKB@VCLEBAFHHFFGLOLGJA@@@A@EEECOAGFDDGHAAGDCDE@QHGDGDGFEAYCc@]JWL_TYLY@[A[JIHGJKdILO@KCmWIDIAECEABFCDI@IIBEEEGAGDGEOBGCABCAGFADHDEFDBBALF@HCF@HHBNFCDY@GF@BDHBDB@BPDDDPBDFHHBFFHBBCCA@APARJDB@HEHAHFBBBABC@GAEBCFKA@CCDMCADMH@FBB@BEACDEJ@HM@FHPLDFEBCACJD@BFANQ@@BC@GFIACCICEGKCQDIFC@@FC@CB@FIBED@BP@BBATRBNHJ@HBCFALA^@B]CG@@HA@AFJJ@LKDI@BFDBABG@BHDD@BCJIJCPFLNJB@@LCNslUf@LCRIVKn@XFVJNAPpoJGZCTDJP`fLHdDbAN@NBPLJF@BC@ADDHBHJ@TPBDCF@HHL^KFCF@BFZ@JHPF@BVBHABBDJNBDBHLBBH@BDLL@DDFBBJ@DDHADDPAHH@BFBFAABJHDBDCACBCLETQHCF@TCBA@ADAVKFDL@FCBEFAdCRDXNJ@NA"],encodeOffsets:[[120569,37403]]},properties:{cp:[117.677736,36.214397],name:"莱芜市",childNum:1}},{id:"371300",type:"Feature",geometry:{type:"Polygon",coordinates:["@@AHQJCBOAKH@AA@S_KI]KUE»CSMMMDEJGJCLO@KCGCESKUEK[@KBOCGECI@IDM@GECMCa@EACC@G@MFOACWB@CQ_B[CSEKECY@GAIGCGEAAIDW@IOU__CGH_HM@MEYDIHIF@DEPC\\CP@\\EhBbFL@JADEBCAQGMGIKYGWEI__EI_[QSEKCkCG@KJWAGCEEIUMAGDG@GIGMGGISMEEFQ@EAGNKRIJGLCHKJISa[QAEKCI@IHK@KAGCE@K@KHGBGAICEICAKAM@IC@GFCBYNEDAAGCAMAODI@GE[KBIDED_bEDABI@WLC^HLBZCPALEF@DBFJNLFBNOPEDE@EPDHF`QF@LHJ@LFNBLDBBBJBBRI\\CH@JDVDTCNBJAJADBHHVLLVDDD@DAFI@UGQDG`MbCLCXKBE@KBELIHAPJJD^ENDJHPRLHDJBJAHKXDH^N^@JAJAPIZIJKbMHALDDABCAGBANGBABYJIBOFATHxJ\\ADC@ICCIGAABKLGTSDEGIBAPEZ@DCJkJKCCIE@E@UGQGEAUEMEGEEEAqEAAAI@GJIJDH@HADGFCL[PKDAOGAAAEAKDIJEJAV@F@HEJKBSLS[AGCU@GAYKOCSAECQgEGGACC@WAAECqBeBQIg@KAIB{DKAOIMBKCM@wMIBEDILGDCA]EGGGCcM]GKAEG@QBGBgAMCIAG@MCQDeCEKGouWaEOOQEMAUBOCMCCE@QDOAODG@CCACDG\\UBCKMDQCOEMCAK@KGGAOBODE@OGCCXKDCAMCIIDI@KCMEBCCIFECE@A@EDANAHBLMNEH@NBNFJ@HEVQJM[SCCAIQCMGMIIAg@G@YI[UM@KFYZIDQAOIGCKAKDID_HCDCZCJABOCQBYCWKUACBEFCDaBCADIC]MAOGIKEKGEOCI@_HEIECAWDEDQNGFEBKAOI@EDG@EKEAIAGFIDIHGEEG@MOg@CHs@IJ]NeBQAUK]EISKCEEKE[@ICE@KCGGKFCGMGCKBYMEIKAiFALC@ECSBaAAB@LA@GCAEmDAAAE@QS@AAAC@IBCGI@IEUNGoBEYCCHYAAB@NADI@CQCCQDAJADFFJVBNCD@FHX@bAXIFULMFEDKHDDQDBJGDGB@BPZFDTHVFJNEFBJ\\dHLYHBDSDI@ECC@ODIF@FBFADGBIEKISJaJkJBJFJFFDBHBDDFDBFFHDABAXl@JCDWJBLOHRXEHIDUDMI@FiBCAAEQHQDQABHOBACCWSBAPSBGJE@BRAB[D[JQBEAGGGKGG]DQFGBE@Mso@CB@HEBCCK@EGEBO@GBCLA@KGGCC@ED@JCBEAAGKGOAKBZNDDNHbCHIF@HDD@BEH@HDJHNBHBDAJDLCF_HaLKJOLAJ@DDF@FCBKBAB@HDDfPDDHfAHMBZKLIHGJENEFGDUF[fAJSL@PADC@EFAJBFJLFBLBDDDFCL@DTV@BEJCHDJLNAJBJ@XBNRHNLBJCHADKF[BSEK@CBALHZ@FCFG@QJKFUFgXK^IJULSRWTOVMJCJCFCNMBAFCRCDK@IDSGIGOBSTGH@FADIFABAbUEQBYNEFGPMNYJQ@UHCD@DHL@HABONCLEBK@ID@BMJAFBFJ@HDXNbLNABHHLNTFHBFIHiNIHCDGZEHADBVJLPDVPFNEN@FDFPJDBCNENEHIHMFABEPFHAJGFMF]TBHHFNFFDDHFHBLBFNNLHDHAHDJDDJDD@PKZKF@BBBNDFZBJHBD@DGJCDSFCLCBE@ALFNFBZAPELAH^FNHHN@JEH@D@HFTOJ@D@RH^BDJHBDFlLvJN`lRLbDZC`HTHHFPJHBJARGJGTIRAZCHDDFRZLHHDFBVBVCP@HBJJFBTCFBDF@JG\\I\\AFBLBFDBPFHFFH@JAHGNSNIJBHZ`DDFBTHJRHNFHPJRPPFPAP@^CbOPAXGF@R`THBLAHDLBBLFFFLNFNAJAHQ\\hBVADBBPBDTL@TDHPRFD\\HBB@NNRDAPBDHGA@FFFNKLB@HFEDF@FDBAFDBBAFBNJRBBDJED@FBH@FMLCLAF@TAFBJADBGD@BD@HCCGFAL@LEHGF@DC@GDAFDBIHKAG@CJM@CCCOAEEBEHGRE@ABIBENCNGHATABA@CAKFEHE\\C@KDABDTFFFJFHBP@D@PRHHFBHBH@B@@CDAFADDJBbLVDB@DFDPDFJDJALFL@FD@BCBFPJDN@dDNSJIFMFCNIL@DAVQPFJJTDl@FFAHEFEJQNEJ@DLLMDBNKJEBADBBCFIHADHFADFHNADEHCV@HCHLHALEBDDR@HBBDB@BGFB@CBBFFFCJADGDCDFD@FGBBDAFG@AB`R@JJBDHGLCDFD@BUDWAEBCFABBBD@FCF@@DFFJIHBNDDFHDJBDFCDJDDADA@EB@DLHFJDHADBBCBBDLCHCF@DBDPTJ@ACLERFLLJDF@DDLNHALNLGD@NEJBBBDJRANGHADCN@FBBCFEDKDA@EEC@CDADBHC@ADBFABIHAHBFPHDLBLEBCAOLADFRFGJ@DDHARBBHBDABCBDF@AHFFD@@B@@ABFNEJCACBBHDDPHHJHFFBJEH@HGNCD@THDBF@FCHCJBHBX@LFRCFC@QDCIGBMBKJEHBJO@QCCBIAGDCHCBAACC@@KG@BE@EDKAGDABBFADC@AGBCIDEC@AEJIJAJGDIHCDBFFN@HDXBPXC@GAAHBBH@B@BAH@BB@BEBCB@DFDJBD@FEJBDBNLHABEHEFABCBGDCJ@HBD@BKPAHENED@AHVFFCN@HD@DD@HFNCA@BCDGNAFIH@BBDBF@PIFAHBBIFAAGBA@CCAABC@KGMGAEBA@AGIECAABC@GEGBCJGNAAECAAEA@DAAIIAMBACWF"],encodeOffsets:[[121881,36891]]},properties:{cp:[118.326443,35.065282],name:"临沂市",childNum:1}},{id:"371400",type:"Feature",geometry:{type:"Polygon",coordinates:["@@PALGROF_@KDYDMAMCGmOMCSIDKRYDU@MBKJMlALCHGBI@HB@@IBCCODCLGTCHEBGJAAICCQGECCMCESKM@KGDIBEGMAB@DGB@CKQEMAEBAIIBAACCBAAJEAEOBGGGAI@ACGC@GBADBHAAAF@BIF@JAJ@AEHCCGDACIEC@G@AH@BAAAD@B@BCCA@CPEAABCECCFEFECKA@EDCGC@ABCJAGI@CCABAMAAIc@ECG@@EEGI@CBKMIOCYACECI@QDIFITILODMGOE[HQTKKGQGIKEOIKESBCJAFEBDNE@BPFDFHD@@DC@DN@VCBGBMNG@CMDAIMDADEAKGE[MKIIMEKE[AODOHW@KEQMSg[IIEK@IE_IYWE_DOBOGIUGIWYS[oAYMUEcAGNMDMCOEUSL[ESIKWC±EOOGOD[GMOMQ_PQDOEIKGQGII@MFOHKDMLkBOlMLGRQACKBCABED@@A@MDBAAH@BKF@@ED@BACA@GG@CACMF@CMGAKIE@AEHCAABCN@@GJB@YHBBBF@XIBIB@@EJACK@YAAQ@CA@CDCDK@GAADKFADEIC@BGAGC@CEA@SCAAGA@@FCD]CYDM@UiGkMWM@WFKFK@OFUPKPEJKKKcIcMGEW[ICFcLIFAAI@STB@CDAN@HBLAN@A]E@CGA@GOLA@CLE@CbKNGFQF[@[CYD[@MGKMAA@G@ADCBADQ@CC@CDA@IBE@EC@AKYEA@@DODEABDcHIHEK@ACEB@ACBCBAH@BEAEB@ACEBECA@QDACEOACD@CKCaF]DG\\A@GDBBMA@BEKCOGSQuGMEiWMKCCAEBICCCCI@KBGACCAQBUGUEEUKCCAE@SAIEI]M]K[K]BG@CCDaEIIGIC]AKACCACHWCMCESOCAc@CCKWMKMI@CDCdMBE@GAEECGAW@MAKIEIBGBC^EFGCSBGRcDIAGCIMIME]GaGyGABDBABFLADC@MGAB@DE@HTEBSIEA@BKH@@AAIFCHFNKB@FC@DFODKBCDADA@OKiBILANHR@RENGNWVMNMHMHMBYJCR@NJrHbFXB^@NIVANDHLNBLCRAfELCNBPAPCLMJGTANHPJNDLDRGXEPBHGDBFAFS@AB@FG@ACA@BFGA@BJFCDDBHCND@DLJDAJBAEJ@BFC@@HBDM@BDGDFDCDBFBAHDHTEH@LF@DJDADLGDBDRHN@@BBADPD@BHABCB@CWBCDAJ@BH@@DC@BDB@@AD@AFA@AFC@HRA@BDHB@DJBBAFBNCCIHCDBFABFJ@@BDB@FEACD@BB@ELCDMAADFDFF@PCBHLD@BEFABDJBBDDDRABNEBEFE@@BC@ADADOEODBFG@CBCHCD@HIBo@uEACI@BCGB@FKBDJK@@FG@EDE@AGA@BHE@BFCBAAG@CIBA@CCCI@@AGBKDMTQNQHKDULOLIBCFWBAEA@AHGFBDCBADBFBDB@@BEBI@KBBDIDICCICKB@@CEI@CH@@DB@D@@CN@AEAKEACGECE@EAQGAAACFGNEEIA@II@EB@BDBACIW@BDABIIEDEGCBACABBDC@@CUWAIBU@CEQKI[GICMO_WEDDB@BEDAAIDEA@AECUEAGD@@IBAC@@D]B@BSLCA@CGBIAEDI@CBCFEBWGCA@CO@iGwFi@sHWDQFaTSB@FC@CD@LCB@AE@AAEWGECA@DE@ABQBCBAFHBHAAHGHG@CCBCC@MDCD@DK@AOOFIII@AJW@CBO@@CI@AAACBC@EK@BPABM@@NIBGA@DCDABJJIJBHHBD@FED@FLNFHHTfAHGFGIEBAPJBCFQHAJNLFHHJPAXLBFCHBBF@BDCNBDB@N@HBDHDNFFrbDB@HEF@DDBPAHL@PEBAFNFNVB@ACJA@DA@@DB@@BGDDJHB@BFAADGDDR@HKT@FBJAJBBNNBBHCFBCFJDLEBDABAAGDZXBFK@AB@BJBGFCFFV@DGBDFAFJD@CDAFHLH@RNG\\RJCDCHADBHNAB@FBBDAA@BAB@HFJFDFAHEFC@ABFHFBFD@HGFADA@@BBDN@BNELIJ@DJLCBBHF@DHHHBJFJAFOCEDAHN@LFCFMJ@FDBL@FFT@ZDDDULZCLFDBABBBDB@DDDDAHEHDJ@@F@DBBDCB@@ABEP@BB@DDFCB@BCB@BDBLBJCP@PJJBbGLAFDFDFD^AJFP@fLJFDAb]LEJAPBPEZ@VEBB`^BHJDBDBBDCD@@FCHVABD@PTDFD@BCDMBELG@@BJJ@BIBICEFBBJDFD@DEDC@IGCDCCCB@CIBEC@CGAC@@DSB@DE@CC@D@D@LD@BH@@@CBBF@@HFB@DB@@DFA@DF@@BA@@JBBA@BB@BOD@DK@@HCBDJC@BBCB@FEB@JV@DDCB@BB@HLB@@CBHDAAEB@@CFFB@VKCGVM@ADABANC@BD@AHABKLTHTDAFIBA@DDLDBF@DABIACFBDJHNFB@CDMCQBBHDHABIBABABFFB@L@LCADINBBFDDVGJDDLBEH@FNABB@DCHBFD@FGDANFPJLCF@HHBB@BCBUBCBABVDL@DA@GHCBCB@ACF@CITACEDAFJHAHLLCCEXE@CA@BAGB@EC@BA@@D@@UD@BCIEGG@@EGFCCCBAACFA@CJ@CAHAFBAAFDLOZKHIR]JKPMNIHFDFNlLTHHRJ`DHDPRLJTNR\\FFLHZJFDBFBLBDZPNNEDACGBDDBADFPHBAJLLFdPNJDCHDGFprV\\EBHJG@@FCBDHD@BDD@@CH@AGLFDCLJFHRlH\\BBABDBBDBCBBAFH@ND@CTFCFHDABC@@DD@@BBADF@@@CF@@CAABAD@BA^TCDADBDA@@BJ@@BK@AFFFG@BHD@BDE@@BFABFNCAEHACCL@@ADAD@B@DBBAFDFJDNB@DbEBBJG@GDBFC@@BB@BJB@@GB@FBB@BBDCDDD@PRh`ABBBAB@DB@FLC@@DE@FBA@DFD@BDDABBBBABN@DFD@@ANABINB@EN@TKNALB~PFMvDOdARB@DBBBB@BFBDEDBCFNDBARHJDVD^@N@¢QTAXBHBNLRFbINC²INDbPZFdTpDNAVKLCdBjUHAPDRD`Nz\\pXZfZDHNjHlDN`f"],encodeOffsets:[[120363,38924]]},properties:{cp:[116.307428,37.453968],name:"德州市",childNum:1}},{id:"371500",type:"Feature",geometry:{type:"MultiPolygon",coordinates:[["@@DAD@LHPQSA_QP`"],["@@ECQEsYWQY[eyCCQKUK_SMQCBu`ADB`J`HPAFCFF@BJ@@D@DZMBBBA@@BGBBLH@@DXE@BD@BPDBDVFHFvAJEHSRADH^LdHDHB`ADBDFBRDDFFpZpJJF@TDTA`C@@FAA@CC@AJC@BHHA@FHBBFD@AAB@BBDA@FHA@LE@@DA@HTFA@AH@@FAB@BF@BP@BJLBHDHBJBBDH@HBFDFB^GG`ALFT@NET@ZBTAF@DC@BDI@ID@BA@BHABDFBABB@FD@@@CBBDAA@HEBADA@@BAB@FMBKEEDACSDBDKB@FA@BFE@@DQDBBCB@DA@BFB@BFB@DHCBBHCBDHEAHVADOFgG_QYIKDAPFJHFHLCTONMPILAlCLKPOHOFUDODQJ@HFDJFDF@HDDFDJA@HC@@BD@@BABB@BHA@BFA@BHEBBBB@@DCB@DEB@BCBAAEBDFIFG@IKA@A@AB@FENDDHD@DUBADBH@HO@@DE@@A@FCBDLRCFJB@@CHAJLDLGJAHHJMBBDCDBBJHHBDABA@G@@@CNDJLVNPRB^FT@RBDDJLCDHJABF@DK@I@@HBHFDNDMJRRDNVKDDXTHRJH@JCH@DLF`HDH@HBHFDLHBLVBJHJBBEF@AFFDEHBDBBLBBBABCBBPLBFF@DGBDFDFH@FDIF@FDDHAPTFN@NBBLDN@DHDDRFF@HEJ@PED@BDCJBDDBLEFDBTFDL@DABABIDCR@APBBNIHIP@HJDD`HfNJDPJNCBBDHRJNDNFF@JEPF@EFAF@@F@BCBFFBF@JHJALCJ@FBFLJBJHJBFCHBBNPFLLJTPTFJTFBAEJCJFDAAABAF@FLJ@@J@BF@BB@LHA@MN@BAAOL@@FADBDBBJ@@DP@DAX@BIJ@JJPEBPL@@CDCNCD@ADDDH@HGBGGBGABEDARABAF@@CDBHFFXBBF@@BDA@KDCD@@ETAbSREXCtGj@xEjHP@@DDBXHFADEDAJ@FCJBHA@DDBTK@A^A@CD@AB@JC@BHVFFD@BFBJCBBFC@ACAFC`XNPJD\\HLJFR@DAVBJVX@DD@ACBABDDAFHFCJJBAACX@DJABACA@@FJJB@FJMFEHBDBBRHFBF@FDDHFBBLBFM@@DC@A@@CG@@DFJ@DA@DLDJJDJCACLAJ@FA@AA@ACAEBCDAACHEBGB@BFXADEJAPKVKLCRGRMNSLCHA@BJ@DD@DABDJH@BBDAAEF@AGB@BHF@FCH@@EL@CILA@EHAADJ@BDvFp@JA@GDCDGDAH@AEPCPFBCBCD@@AF@FEFAAMQBCCACIAACEBAFC@GKDA@OEEECBCNBDCFKA@@ADCFB@ECA@AI@AEEBCAGDDJMDEAABIA@CGAAGEMD@BEB@BEC@@BA@ACD@@CG@@ABIDCXA@DDABAAGC@COAB@AM@QGACHCCKCBCIE@@KFGGSGCABAEDCECHCACN@AC@GD@AEI@BFIACBKI@CMCGDCADCIE@AHBAEB@BDH@@EBAT@BEAEHCAGFOHWCQCKIMGOBMHSNIDKBOAODMFKBeDQAKKMCGBMJU@MA]EWGaIq@MDQZINANGNGNMXUHMFM@QGQBMJKjAPLB@BCDCLAPCCED@@ELAEMDGJEBB@@LG@AFBTJD@BCGQF@@CBANHD@BCEKBACABAGCS[CGMGGAa]GIGUIGCEGSICWF_@GAEGEKCKGEMA[BS@aM_OoMgMQKOQGIGWMMOMKMEI@MGIQOQIMKWUQWOMQGIADIEDaCKUESASCUGIECCESEEWMIKCyYWu_sG_IYIYSGCK@]LE@uUGMCEGCK@G@GDQLC@S@QDaIaUEEAADCJ@WS@OMEG@SAkE]HeIOIC@BAGICUKGGEUCQGGEEKEEQ@IF[BWDQCQAKCo_GgGORFJDD@FCFADCBGAMGGACC@EDGJGO_"]],encodeOffsets:[[[118268,36758]],[[118260,36742]]]},properties:{cp:[115.980367,36.456013],name:"聊城市",childNum:2}},{id:"371600",type:"Feature",geometry:{type:"Polygon",coordinates:["@@CHGD@CAAMDGB@BE@@AG@CEWB@IE@AKBC@IGAQB@EGA@MEAGCCIG@@DC@EAGIGACB@DKDE@BFGBBDB@@BAHWB@DA@gBBNS@AJDB@LM@@DE@DFKBAJBHCBAP@BCA@DFND@@XXDBB@HA@@HHH@CFBBH@DCBGBA@@AQ@@AMB@FCDBDC@@DFHINAD@JMDCDCDI@BH@@A@ECAIaEOBKGKC@FE@@A@EQE@FEAADABADDDCDE@GJBBEAABCA@PABVHDIJHLDBHNFABJBCBBBOTCJGDADALDB@DIC@FD@BF@@E@AHFD@FZPEH@FNLHFEHCACPCA@DAFD@AHI@@FI@@FGAAEUACL@B@@@HEDEAGH]BAABMC@@GQECDG@CBQA@FCBIAECE@ABBDB@CJE@@EIB@JFBBHB@@LBDHA@BBHE@NHFADBHJEHECAJD@@BAPE@ADCFAACAID@CEA@HIFIBCEAB@CMAEGICBJBB@BAB@BA@BBADA@CNEBWMeQQCWAIDEJIHKFiNY@UEQGwOGM@JNEBcZIFMBI@WKGCOSKQEgEKQS[KGGKEIEGQOEAoIBICA@C@AFBAEBIBCDB@GF@CA@AL@DBTIAED@BAAGCA@AKA@KF@@ED@BC@QBAF@DCHC@DHBB@@CD@BATDPFT@ZG\\E|UvGp@REJArBTFzDlEd@PCAKCCAKBKAIEKEGH@@EBCHABCBB@BDAAAB@D@@BH@@EBADB@DN@@GFADE@EA@CA@EDBBA@AC@CA@OBCAGDAAIF@@C`ADCfADA@C@GF@AYF@@QPE@CFAACFABCJAFEBG@OBC@AACAMB@BFDF@HDFFDBA@GD@@CECBGBBBB@HDA@GD@@DDBHB@BJAAWE@@EAAKC@EAAG@K@@ABA@MI@KCBIOBIKAUU@QDK@@C@AICHCOA@EO@@HKBABA@C@@IGBCCEB@Cq@@AGAAC@OJIAMAA@EECCKC@C@CYEA@CDA@ED@@A@EE@@GE@@ID@@EA@@ARABWFDHI@BABADF@@FNCHGDC@EJGCGAA@EDCFAAGC@@CG@ACK@EAEAGDHHBBGHAFC@ABBDADG@CA@CGAACB@DBDCACCAAGCCD@DE@GA@ADCACEADAABCCC@AI@CI@ECCA@@AEACEBE@IAECCI@@CG@KC@AF@AA@GAAGCCBGAE@@DEASBCF@DA@CAAIKAGFI@CABIMCA@@BBHEBG@AD@NPJPPLH@DELN`FDBDSJ[FO@CCe@IJEJCPOXOLm`ZMHy|EXBTN^CNEJGNOTIFMD]L@FODDPFFDABEJADA@CD@DNXHBDDL@JCDC@GDUH@JFBF@@CB@@HIJCDE@ACE@K@@CCAC@@CA@@EACA@C@AA@CAAKC@GACAB@DGBAHA@ABAHMBEB@BK@@CKAKC@FIAADE@AADGB@ADB@DG@E[ECDCACCQBI@OCGCUPMDMIIIWESBGHYIJOHKDiNIHMN@PJFLFNJHJFT@PBBCDGBCDBDFDbGLAN@NFfXPHVDzFHBdbAHCB@FEHALAFI^G`@TEPGLe`ENSVGNDBBLBFAB@BJHD@BAHADDDBTA@DEBCBADDHHBAHF@BECEDBDFJA@AHABBBF@@C@BFKDAB@HBJD@@DE@@DI@C\\YVKXE\\@PFNDLDl]`UNkTQTR\\DXAJWXIFU@_Ec@SDGHHJRHGHMD[FDPLHPD\\DJL@NKLIDiFU`ENJZF`@JFLJJh\\NTFR@LGXCPBPLhJNLJ\\NHFBLCFCBJNCBDNH@NMHADA@UCMD@@CC@EGECAOF@CMFABEDITALFPJLFHJHRLLRS\\GPFNHPCJKJSJERCJ@FDBDDZJPLNDAJ@FH@FH@FDd@BJNBABDB@DHJIBAD@BHDCD@FLBFDFEDEFDADBBOF@DDBADA@C@BBABG@@B@HFDDJCBDHGDBFI@IBE@AJE@BBGBCAAB@HHDBDJ@HBHHPABFIFBBDABDABJJABBFFNLR@DHA@CBAHNAFCJLHN@TLDFDNFDRHDDBJIBAHGFSDKHCDDPAD@JA@@GAJGHKDkBINAL@NCVQZCLTJNDnPDHBNCNCZ@LE`QPKHOBDJBLDJRPJLAB@DC@@FB@@BEB@BB@@DDBCJHAFBBBAHDDXBNLHBdBXGF@tHfAFBHHFDLBdGP@HDRPTLDFD\\Z`FD^JPDDDBJ@JCPENBHHHDCF@BBBFDBHAJBJCDHJD@DKBKJHHFR@DCJBFRZDB@NBFbRpfPRDLHLvE[HORM|cÀlS\\@ZJàČV@PEDMÏĽAE@IDEHCJCLAl@`AbG`E¸EzESeOKAEBADANAJCDGDKKIG]EAKCAAFMD]IuEFCAKHEBAGCICDoE@BEOAACBEDATBDUAaNABIEAFEDODBAF@@L[CCDEEADE@CIABEBYHABCFQD@@BA\\HBHEAEGB@IH@FIBEAOBEB@JFBDJDADB@bHCFPBADHBADDBADLDFIDAD@JEHFDARDLUAAP_AAI@AIJ@@BHB@CH@AI@@@EJAHCDAAGC@AABEFA@EI@@GL@@ED@BCBABMEA@CA@AFK@CAQcL@CEE@AMLINABOOIGBAKG@@UWA@AC@E@ABCA@GBABSGBCOAEEBACAEDKACCCEBBOKACGE@AAAEDAAEGE@AA@@FA@@CCAAIQEIBGEECY@IHMECBGHGCGF@DGA@AKAAFAFA@E@ACBCAAC@KH@BABIKQAEBECAEEBCSCGOBBIE@@AH@@CC@CMGCI@BEJABGC@@FABE@@EDAAWCECAEGABCCDKACKCM@ECEGBAEI@AD@@IDAP@@EG@@EC@BOB@HBDEH@DFN@BDBDBBH@@GDAB@BFJ@@BHBFMCABEI@@GDABCG@AGA@@DEA@EIA@KEECAGBCCAA@ECEGEC@ACC@AA@CD@@ABGICBKE@DIA@AGIABKG@H@@CEAACG@CG@ICCCBCA@E@GZGEIGDAAEQ@AFAEIHA@BF@@AL@BBDA@DF@FBJ@LGF@@AD@DCDIHBFA@ACACEDKPBBCGACA@IDGCGEE@ABEAEBA@EMDUBGCEGCC@ENKNDH@DADGDAAAA@@GC@BEF@DChPFP@BK@OJA@EVDBCB@T@DFCLL@@FN@DEBWB@JA@CBAD@@BHCBDXBFGD@GMxB@FAAKBCD@L@BGAKBAFAZB@EJBBAAAI@ACBEZ@BAF@AIBAR@@EIA@CT@@MF@@QM@CECAISAAQ@GUBABANBIELQHEHMPC@CH@BIBAAGF@BIFAAGDA@GF@@GE@AEE@@IC@@IA@@WK@@GG@AEIDIKAGQBAKC@EA@IBCHB@GO@DMDDHG@CAA@CB@FCBGACE@GDAEGFG@@CF@@GFAAEH@BCAEI@AECCCE@GD@DGDCEWBEB@@EYOAGFEAGCEIGE@KACE@ICCM@ACAEA@AAAEI@AS"],encodeOffsets:[[121128,37888]]},properties:{cp:[118.016974,37.383542],name:"滨州市",childNum:1}},{id:"371700",type:"Feature",geometry:{type:"Polygon",coordinates:["@@@QAUBMAID]LYHCJ@ZCYVI\\I sTM^_|]RMDERSHGRQfY@KH]R_GGQMIMACBWRY@MQMCEAEDOLaDYB[BOAMCIAMBKFILKLENCL@HCRMFCHAXJLDdGDCDSNiBI@UGU@GHWJOF_EUKOECUGGCGK@KHWD]JMLCFCDEHUJaAG\\D`JPFLDHCXeHQBSCOSoKUYBEBMJKPKF@QACCCGAC@UFCAHQPUAGKKCGESEGKGGMCEQWKUCCUBGDQ@IAEECMIMLIHADE@ESeGIGEeKGIAQ@MBMDGPORYLK|gFGDCACCEUSEEEKFCJApENCFGHWBCPKJELAP@VIfITGHEHGLKNGPE`AREN@NEFEHCpCVBnM\\KCCBg@KEOC@iAIIEa@WGEGAE@OGUlOHCJGBGAICGWYBUAKKQOO@EFGNIECAASIGE]EWCGCCCC]ACGEM@EFMH_BICCI@OCOCCM@WGMQCMCKIMMFGFEFENIJCDUFIBEAQM_McSSAGDEFKDSZMbabeDwYcC[Ril_CKWAaOGiASAIKSwTEF@BEDUFQB]JYBQBK@U@KDMPOBIAAI@g@KMOGCAECAGDW@QFEFMDKFIH@ABAC@CB]HG@MES@OBKAMAG@QDK@MGM@AB@D_FSHMHKP]^MRCFAPIPMHGBKACB@PEVQRITAH@TFV@LCLEJIJEJJ\\JNDNGR@FCFETAPALGJCNCFUBED@FFDCFEDCDBHCD]LO@MEGBGHUDGDMNiNYPEBO@aOG@UFGAMCIIECMDKFELAtBFRTFZCDUFYBAAO@COUDBJWB@DKBHVB@BDD@BBJERdHCDFDAB@DF@LALCFG@MCECGKaLSLWIMBBREDELIJYDEDFRANIJW@KEQA_@W@WKUQ]GKOECG@EBKHEFIRu@WDgAMEQKOUGCEDIJCFEREF]FUN@DAB@BA@DPDABF@HAB@HMB@DO@DGGB@AD@@IA@@BC@GQIBAEHAAEL@DA@CSBABDDGBAAE@qNCDRH\\NLLDLAHCFcBUHGHADVfDFHFtL`RNP@H@NENOTWVg\\AJBHRZLLLHPHNF^FfFf@FDBDCx@nFDND^FFJDNJP\\`FJljHDP@HnB^DXFLTFADBJPPT@JAJCHAFDDD@DFBDFADC@CDEHEHCR@@\\DBB@@eJ@BPFB@TVFbFfDdAPIXFL@TCN@LDhZ`Z@DCJ@BHDNLJ@TRVN¢`FF@PENIbHVJCDJ]HALNAFFF@BVPE@EF@BVZC@RN@HRRTNJDFFVDRDH\\XNH\\dRjZ`X\\XR\\PZADBBJDDJ@hGHDFLBBB@nQFDDNlBX@@BD@BBTBPB\\LRDzF@TD@@HF@@\\EB@LR@@PDTVEFPC@DLPAHXDABLf@ALX@BJPVzKN@@ERA@DF@DNVER@@NfB@GFDDZJ@@HF@A]HBBP"],encodeOffsets:[[118658,36728]]},properties:{cp:[115.469381,35.246531],name:"菏泽市",childNum:1}}],UTF8Encoding:!0}):C("ECharts Map is not loaded"):C("ECharts is not Loaded")});
\ No newline at end of file
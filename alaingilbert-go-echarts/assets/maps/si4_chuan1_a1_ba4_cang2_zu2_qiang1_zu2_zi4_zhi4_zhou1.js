@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("阿坝藏族羌族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"513201",properties:{name:"马尔康市",cp:[102.20652,31.905693],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAECCIDIDIACFE@CA@AAACA@A@K@C@AACAEECCAAA@CBGJCDCAIAMAKAMBG@KCICMCSGMEMEMAKDKBODKBM@MAEBC@A@_PCBMRB`@P@B@@@@@LEXUfKNAFEPKRCF@@AFCDIHWRQFWBMDA@GFUXIHYJUJC@YJMB_HMHABKRQ\\EHKDQBIB[@YAeIK@IFCBABA@SDMC]Q@@GBA@IDM@CAGAOIIAAD@@@@CDAHBTEX@BKNYNEJCFGTEFIFUFSHiHGDQJ]dQJM@GBE@E@IFMBUJSHWHUJWJSLE@A@E@AECKAIAEACCECCA@A@EDEBA@AAECGGGEGECCAAAB@@ABADAJADGFA@C@ECGECAA@GJEDEBA@@@KGECC@C@ABCBEDIJGJCF@D@BBNAZINUBQKOEQDMFMNAF@DBLAFCDABG@EBG@CCC@MCMCC@G@G@CDEDAD@FAFBJBFBDDFBFBDBDDHBFDHDNDHFNDHDDFFDBHDHBLFHFFFBD@FDFFPDHDHFHDFJLJHDFFDFFFFFDHHDDHHJHDFBBBBBBDPDJFLDH@FDDDFDDFDD@DBHBDDBDDHBHFNDDBFDFBDDDDFDHBDDFFFHJJFHDFDFDB@BBFFBFDBDHDFBDBDBDBDBFBDBD@FBFBLBB@H@HAFCRELAFAFAFADADCDADIHCDEBCBGBGBC@EBI@CBG@IBC@EBAFCF@F@F@DAHCDFBFFFDDBFDFDDDDDFDDBDBDDF@D@DE@CBEFCFCF@DADAH@D@L@FBDBDBFFDFDFDBBBDBFDDDDBFBDBFDHBDDD@FDDBF@F@D@FEDCBCBCBCFAFCDAFAHAF@HADADAHCFEBCBADEDCDI@CFCFARAFCDCBEDCDADCB@DCDABC@A@A@ABB@@DBDBDBNBFBB@B@DAFCFADBF@B@FBFBB@B@FABAFEH@JBDBL@JBHDDAFEHIFIFKJMHKAECIBADBD@JDJD@DBD@@@B@@BB@BBD@@@B@@BFBDBDDDBDBFB@@BBB@BBF@B@@@H@B@B@B@@AH@H@DBD@DBB@BDDDDF@F@B@FDD@DBFAB@HEDABCPGDCB@@A@AB@BAJBLDJHFDFBFAFEHKFGHCFCFGJMFCF@DBDFDDBAJKFIF@JBDBF@FAFGBICKEI@CAGBCHEFEDAD@D@F@HFHFFHBBB@D@F@DCB@FEBABABA@A@AACB@BB@@BBB@BABABAB@BADCBABABC@@@@B@FBD@HBF@B@@CDA@ABA@@DDB@DBDBB@DBBAD@DC@@BA@CAA@A@@BBB@DB@@DAB@D@BBD@DBBBBB@BBD@BBBBB@BAD@B@BBDDFBDBFBDBDBDBBDBJHHFDDDF@@DB@@@BA@EBCBAB@@A@ABA@@@AB@@A@CBABC@A@A@AB@BCB@@ABAFAB@BAH@B@@@BA@ADAB@B@BB@BBDABBD@BBDBBBB@B@B@@@B@B@DBD@DAD@B@BAB@@A@@AA@ABA@@FAD@@@D@B@HABADADBNCJAH@FAHAFEFCFEFCF@PFHDDDPHLDFBF@F@JCHCFCFEDEFKFKDIDCHGJEFCNGDCDAFABAB@HADAH@LAD@B@B@FBD@HBJBHFHBNFTJJ@HBB@D@HAH@LEDCFCBCFAFDHDHDLJFBD@FCHEFGFOBIBGBGBIBI@G@C@I@GAE@E@CBEBCBCD@F@LBFBH@D@B@LCDCBCDI@EBCDCFEFBNFNDHBJ@LAJAJAFCPIHCDCDEFCFK@EAKAE@ECGCGGUAEBEBCDEFCFEDAHAHAD@D@DADAFCFCHEFEDENKHEHAHCH@LCFAHCHAHADEHEDCFIBEFEDCFAHCLCFGDGDEFIFGDIDIFEFOBC@EAEACCCM@ECE@CCEAEICCCI@CAIBEDCDADCFCDAFAJ@F@F@F@D@D@DAHAFANCFCFCFAFAHALILOJIHIJMHGFKNQDIBMBGFGF@D@JFJFDFJJNJJDJDL@L@JCJDDBD@HBBBBAB@@AHKDCBC@@@AAC@@CG@ABC@@BABAF@H@@@B@@A@@@AA@@AA@AAA@@A@AAABA@A@A@@@EBAB@DA@@B@AA@@@A@@@AB@HA@@B@@@@A@@AAAA@A@A@@@A@@C@AA@@@A@@BADC@@@@AA@CA@BA@ABA@@DA@A@@@AA@@AAABA@@DAB@@@B@@A@@@AAAAA@A@@@AB@BABADAB@BAAAAC@A@@BA@ADABABAAAAA@@@A@@BA@AB@@A@AA@@AAAA@@A@ABCBABA@CAC@A@ABABEB@@A@@CCAAECAAC@A@CAA@AA@GCIAE@A@C@A@EAA@CAAAA@A@CAACA@AAABG@AGEGIAIAKAKBKBMFKBKBOAMEOAMEQ@OASCU@OE[EKGEGGSKIEAGAE@GDO@KAKGIEKCAICECGCGEIGICGAOAIBEBG@GBIHAD@J@H@LAFGFE@I@IAGCICEEMGOCMDEDGHATAN@J@LALIVIBIBM@A@GAIAICG@GFKPEFGFEBC@GDKDKDMFOJIFMFI@M@OBKDO@CFGJELIFE@G@CIEEEAGBGACACEACEGCEKCEAGAODKFEBGAICIKKAGAUAQ@SAIA"],encodeOffsets:[[104664,32441]]}},{type:"Feature",id:"513221",properties:{name:"汶川县",cp:[103.590179,31.476854],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@ECGCG@CBA@AAKACAC@A@A@C@CBEBA@A@EAA@C@CBGBSNIBIAIAIDG@GCKECEISCEEEGCIGIACECICMKMIEEB@B@@A@ABKDOHIFGDEBMHEDCBA@E@M@OIOKCAO@O@MEKEAAAAKCCACBCAC@CAAA@C@A@AACAAA@AAAAA@AAC@A@GAOG[GQAQAQ@MAM@K@MAMCG@C@E@CAEBGDEDKFCDEHEFGFCHEHINAH@JDHHNP\\JPALFNHNDNDb@LANALCHNFHFFDHDJHAJAFAFEHCJAFBRBLFFJFLFFF@F@FE^@H@HDHHHDFLDLBJDHFRRDHDJHXDR@H@BBJDJBFFHFFBHBFAJCHEHEHKFKBIAOAICOEQCKBE@KNCLBJBFFJHJBNBJCJGPAF@NQdGPCFFJHHLVFHBHBJ@J@DDHDDBBDBD@DBB@DBBBBBDBD@HBBBHFDBFDPAJAFB@@@DDLBJ@BB@B@DAHAJCBAB@BBFHHJFJ@DCHBFFHHLFPFJBL@JFJDFD@D@JCLKDKDGJMDI@CBAB@BAB@HAHAJIRQNIRCNAPBJHFFJJJJJHLHJ@JALABAPEFADAHDHFDB@D@NBBBBBBLHHHFH@FAF@DB@FBLBRFLDFHDL@HAH@FFHBL@JDHJHPDFBNBD@HAHCPED@DBNHHFLHJJHHFHNTFDPFHBhFNBZBP@NCFBFCHED@D@DBDDFFLPFLDDDBD@N@NBN@DBB@@DBDADCD@B@@DD@DEFEF@B@BDBXNVRFBJ@NBJDHHB@BDBBDBJBDBBD@B@BAD@DNFLHPVHJJPHJDJFHFJFHFLBHGBAFGDIFCB@BBDBH@DBD@D@H@D@HAD@H@D@D@DBFBB@D@J@D@DAD@BADAF@B@D@BBDBHDRHLFJBJAHAHOHIDSLIJANAJBNBFJHNHTF\\LDBJBJ@BAB@FCDEBAD@B@D@FBJDD@B@HABAFEB@B@D@BBFADAHADCHCDCPINIHCBC@C@CBABCBAFKBGB@B@D@F@PDH@H@HAF@J@LGBCDMBICMAICGMSGGEKIQEKEKEMAEAEAC@C@GBK@GBEBCBKFOFKLKDAFCHIFCFCJEHKDCDCF@B@HBDBFBB@DDDH@BBFDDDBB@DABAFaBABA@CBE@CDEBCBGBABA@CBCACAOFKHKHKHKHIJIFGJINILIHEFEFACC@A@C@CACAE@CAECACAC@CACACA@@EAEBC@AAA@EACAE@M@A@A@CAA@@AAC@CCCCCCCCCECIECCCCAC@AGGAACGCCAAAAGAGAAACAC@CBABGBC@E@MCEAA@AAAACCAAAAEBEBGBIAADABCBCBC@A@CECECCEAIAC@I@EAC@A@GBCACCAE@G@ABEBEBC@C@AAGAABE@ABC@CBE@CDGBCDCDC@AAEACGKCECC@A@C@G@EAGJGJMDCBC@C@E@GAIAG@CEU@ADEDCBABC@A@G@CBC@A@I@CAEGGGIEC@@ECEAA@AE@EAKAECE@ACAACBEACCCECCEACCCAA@AAC@C@A@ACGAEGIGKEA@AACAC@ECACC@C@A@CDEBEACCCCCCEAAAA@C@CBC@C@A@E@CACAECEAAECGKEICE@A@A@@BA@AB@DCFAFADABC@A@ABE@E@C@CBEBCBI@A@E@E@AAGAAAAACCK@C@CBC@GDCBA@@@AACC@CA@@C@I@CAEKGEMGMEKGKGGEGC@AEECEGGCM@K@I@A@EBKCKCKGECECCA@EAMI@@C@A@CBA@A@A@YWEEKAIAO@G@G@@@IEKEGCKEKAC@EEMQGM@@GOE@IBGEIGKEMGECE@KDCBADAJEJIJC@C@A@EAC@CBC@CBGDABEHCHAB"],encodeOffsets:[[105737,31583]]}},{type:"Feature",id:"513222",properties:{name:"理县",cp:[103.164661,31.435174],childNum:1},geometry:{type:"Polygon",coordinates:["@@GFKDODEBIB@JBLFJFFLHLNFLDPBL@RCLQRCFEL@HBJ@NANEJEJGLGJGLCDI@GEGGKIGEICE@GDEFEJELCNAJAH@R@HBFFLHJBL@LCP@HBFBHJFTLHHHFFLF\\@PDVBT@PFRBNFPBNAPALELANALBLBLBJHJHF@BAHBB@BDBBB@D@BBBBB@DBB@F@B@D@BBFDJ@HBBB@DBB@D@BBFDBBDD@@DDBFBDBFBF@DCD@B@DBBBD@B@BBD@BAF@B@BBB@@BAB@F@BABA@ABADCB@BABCDEBABAD@B@DBFBDBFBBBDF@BBDDFDH@BDDB@B@BAD@HCB@B@D@BBBBB@BBB@D@D@B@D@D@BBFBDBDBHFDBHDJJBDJBNDJ@^ETCJCHANAbDLBP@P@JCHGHIHIFGBCHGNCHCJ@J@JCHBF@B@DADAD@D@FBBBDBDBD@B@B@@@BABABE@ABAFCBCDALIBAD@D@B@BBB@BDBBBF@BD@J@D@FBFDDBD@D@FBD@DADA@A@EBCDCDCBABCBABADCBABAD@DBD@DBJBBBF@B@D@D@BADCBA@E@ABE@A@A@AAGACAA@AACAA@A@CB@BAF@BAB@BABABCBCBABAFA@@JEFALCDAJAJAHALALBLBLBPALAH@VBL@JBHAJCFGDELWBGBELM@@@A@CBA@@BADAF@B@D@DAD@HCDCD@BBD@BBDBDBFAD@DCFE@ADG@ADADAHAJADBJAP@H@B@DBDBFDLFB@BBBD@BJDJDJBP@R@PGLIJEHIJCJCF@JDPHJHNH\\HJBJCJ@RGPCNELAJ@LAL@PCPGHEHMBIBGBGFEHAHCNGHEJKFIDCAEAMBIBMJITKJCPGBGBGAIEIGKCQAGAC@A@C@ABEBC@ABC@C@C@I@CAAAE@C@C@C@GBC@G@C@G@CAC@CAGAC@ADAJEHCBEHAAGEKEGEIEGCIGIIOGIOUKGME@CBC@A@AACCAIACAAAACA@GGICMAI@EAUQWMCA@A@AFEFE@CCC@@@ADCBCAC@CA@CAM@MAM@C@CACCEKKOEECCCAC@C@GFEDEAMDO@YAMAgEGAOEECMSEGGGIIKGGEMGCAC@OFGDGBC@MAEAOCIGCG@IAKEG@EBG@GCKEGKCQEKAEAA@@CBE@EEGGGKGAAAAAA@M@CCAGEGCCBEBOFABKBIBI@KGIGIIIIEEIGOAMBQDMJQRIJGBGBA@ABA@AB@DCJINCHCLKLIDC@C@CEEI@IAKEIEOGKEGAEDG@CEIGIEGAAA@ABIDGBCBA@A@@AAICK@C@@EAIBOBECCAGEAAGAC@CAAAAACAA@CAC@CAAACCCG@C@IAIAGEGKUGGEIIJQFEBC@CAC@CACBCBA@GAC@CBABEHC@E@GAC@G@E@C@EBIFABC@GBEDIF@BABBL@FDFJHHJFJJDDD@@ABIBEBCBCDKTA@ABEBMBIBCBCBCBOBA@IFC@C@OAKAIDEFA@AH@NDNFNHV@LCJKJMNKHGBCBKLQNMNMNGLGPCRGPCPCP@`@NCN"],encodeOffsets:[[105148,32193]]}},{type:"Feature",id:"513223",properties:{name:"茂县",cp:[103.853363,31.681547],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@IABC@EAEACCECAEEA@CAAAAAAAAAA@CAC@CBIDEBGDCBCBC@C@A@G@KBCBABE@CAE@CAEAAAEEEGIGEECAECC@ABIDCBC@EBE@A@K@CAEAEEEEACEAGCGGAAAAEIACAAEACCGCCAICECCAQGIEAADCBA@CCAEEECECAE@C@EBA@EAAAAFEDCBC@CCEACCG@C@CAC@EAA@ACA@CAAA@@A@@AAA@@A@A@@BA@@AAAC@A@@AAAE@C@C@AB@B@@A@C@ABA@A@GBC@ABA@A@C@@@@BADABA@ACC@AB@@AD@B@B@@A@@@@BB@@B@AA@@AAEAECEGCQAA@@E@CACAAGC@C@CBGHCDGDEHCBCBC@C@AAC@ABCFIDGHADA@A@AAGEACCGACCE@A@CCI@GAAAAE@E@I@EACCACAG@CEEAAKGCAEECCIGACGIACAA@C@C@CBCBABCBC@@@CAAAC@C@C@AAA@AB@BABADC@A@@@A@A@A@A@CAA@A@ABCBA@A@@@@A@A@AB@@CBC@AAC@CB@BCB@@A@C@ECIAECC@EEGAKCKCGCGEEBEFGFKJMJIJEHIJGJGLGLGLELBPBDAD@DABABAHADCF@DAF@DABABEbABCBA@CACCAE@ACGCCA@EACAGAA@E@CDCDGLIFEDEDGJEDCBKLELEPALADAF@HAL@H@DBDBFBFFNFLFLJRFLHHNTDHBJDNAJCNADKHI@E@GBG@G@OCE@C@A@A@AHELABADAB@D@DADGDMJOJCDGDCDGBCBEBAAC@A@A@EFABGBA@C@ICEAC@A@C@ABCFEDA@ABI@IACA[KSEMGIGCDEJILGFMHGDGBEFAHAHAJGNGFOHODK@KBI@KBMFODQHI@IDIA[GMGIGOGICE@IDIDGJIFKJOHQ@O@IAICIC@AACAAA@KEECCACAA@G@O@IBCAIBGBCBCB@BCH@BEFCDC@EBCACAAAC@AAC@CDGDC@CBC@A@E@CBAB@@AB@D@B@@KNAFAHKXCFEHIDGBIAK@UAG@KBOBKAKAKAKBGBIBIBCBKDEBIF@@EBABABADADABABA@ABE@ABA@@D@BBBBD@BBBBDBH@B@B@BAF@B@FABCDABC@C@A@E@AAIACAC@CAC@ABABCDABABADABCDCDAD@F@BCBCBC@EAC@C@CAECEAC@I@C@@AAEAAACA@AAA@C@C@ABKJCBADEDAB@BAFABABDDDDDFJFDDDRLPFLFLHHHJTDRB^BNFNJJJNFNFPHPHTDN@HBH@NAF@B@DBHJ@@B@@@B@DABAJADAD@D@JBJDHFFBHDFDB@@@HKBADCPGDANCDABEBIHEDERKHEDAB@N@JARDJBDBDBDADADCDAHADEFGBAHCJGFCD@HABABBFBLHB@BADADAFCDADCFCB@JEHGHGJIDAD@DANBF@FBFBFBHDBBLHDBDB@@FAF@DADAFCBCBADEBED@HBDBB@HAD@BAB@FAH@DBD@@@@BBDBBDBDBF@DAD@FBHFDBF@FBD@@ABABABA@ABA@ABADAB@BAD@B@BBD@JDB@FDDBB@BBJ@FDJBD@BAF@FAD@F@B@HAF@L@B@D@B@@@BBDDFH@@@H@F@FDF@LAHGNERAFABANBHJTHLJLLJFHBJ@PARCFAF@FAV@HCJCHEF@BIHGHEFGHGJKPGJGHABEHEFCLEFCF@HADEVCHADGHCBMH@B@BABADCDA@A@A@CACBA@CDABC@CBIBEBEBIDCDAD@FBDBDBFBB@B@@@B@@A@ABEACBA@CDCFCDGDAB@B@DBDFHHBLBFBF@FFFBFBJHDBHDDDDHDDBBFDDDLLDDFFFJHJHNDDHHFFHFHHNJFBFBHBLBDAF@FCJGFCFCDCDCDEDEFEBILGFCJBBDBD@FBHBD@HFDFDD@DAH@FAFCFCFCBABAFCJADAHAFAHANCF@F@F@FAHBTDF@HDFDJDJBF@FAJCHGFCDEHEHGDEBEBEDGBGBQDM@E@IDS@E@AFGBABAFCFEHCFCHANEF@J@R@JAHBNAFADAJ@FAHBH@FBF@D@F@JAFAFCJCDADEHEBEBAFCJEFCFCDCD@DCJEDA@ABAA@AE@GAE@CBCBADGBC@CBEDGHKBE@A@@GIEIAA@A@A@EAC@@AAABA@CAC@CAEAICCACA@C@A@A@C@AACA@EAA@C@AACA@AAA@AAC@AAC@AAAA@CAA@AA@AAABEBE@CA@AACA@@EAECIGACA@AEACBI@C@C@E@CAAACCE@A@A@A@G@A@CAG@CAAAAEEAABCBAB@FCJGHATBJAJ@HAH@FAFAFCHAFAFBH@D@FALEDARKFGFCBABAHAF@J@DAFA@AFAP@D@H@B@DHBFBDDBHDFBD@J@HBFBHDHDD@DFDDBBBB@@B@B@@AB@BCFIDC@AB@B@B@B@DCFGHGB@@AF@B@BA@@@A@AAA@A@C@ABA@C@A@ABA@AFALCB@B@@@BC@CAAAEAE@C@G@C@C@ABABAFBF@DABABABCBC@CBI@CBGBE@@BA@@BABAD@BBDBJBHDFBF@FAH@H@F@HBDBB@B@BABA@I@CBCRGFAH@JBDBD@DBBAHEDABCBE@EBEDCDEDAHBDBFDJFDDD@DB@@F@@@BADCD@D@H@J@D@D@DAB@BABABAB@D@D@D@H@LCB@BA@C@AAEB@FEBABCBAD@DBBAJCD@B@@BBBCJ@BB@BBBAB@FI@A@@B@B@JF@@@@BA@@CI@AAAA@E@AA@C@@@CBC@ABCB@@@D@B@@@@ABC@@A@E@AAAA@C@AD@H@DAB@BAB@@A@AA@AA@A@@DAB@@@FBBA@@@@@AA@A@@AAA@AB@@AB@DA@ABA@GAC@A@A@@DAHA@@@AB@@AAAAACCAAAA@A@ADE@AAEBC@C@C@GBEAGAA@EG@CAEAE@EACAC@E@A@AAAA@AAC@C@AEIECA@CAA@M@A@AACCGCCAABA@CDCBABA@E@"],encodeOffsets:[[106608,32473]]}},{type:"Feature",id:"513225",properties:{name:"九寨沟县",cp:[104.243841,33.252056],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAA@C@C@A@AAAA@CAE@GAI@G@ICEAEAC@EAA@A@ACC@AAAAE@CBC@A@CA@CACAG@AA@A@E@A@@IAA@@AA@GBC@EECACAADCBEBABABAB@BAD@BABABABGBCDEBA@A@CBC@ABAB@BAB@D@@BD@B@B@B@@ADEDCDEDABAB@BABA@C@A@A@EAEACBA@ABABA@@BAD@DABADGH@BEHKHABA@CF@BAB@F@@BD@B@@ABCBCBC@CBCBA@ADADAB@BA@EBA@AB@@AB@FABABA@KF@BC@A@CBCB@BAD@BCFABA@E@CBCBA@ABCDCDAB@FAB@B@D@BIDMFA@AAACAC@@EAMBEBGDGBEBKAA@CDABCFEBIDEDGDCDABAFCFABCBE@E@AB@DCDE@CDEDA@CBABCBCBABAB@@AAC@A@C@CBEBAAC@AA@AAA@EAAAAC@CBABC@A@AAGIACEE@ABCDABAACAC@C@CAC@ABAA@@AC@A@AAC@@A@A@EBA@CBABA@AAEAC@A@ABADA@AB@AA@AACCAAAECCCAA@C@A@C@CBABA@A@CAAAACCACA@AA@A@@BABA@@BC@ABABABABA@@@@EACAEAAAAA@@AC@ABCBEDGBCBE@C@A@@AACAA@CAC@C@AACA@A@ABADABA@A@@@A@CAAAC@@C@GDGBEBCB@@ADCBABA@EBA@ABA@ADCBCBA@AA@AACACAECACAG@C@AA@@CEAAAAG@E@CBG@EBAB@DABBF@B@DBBBBDDDDCBA@CDA@C@CBA@CBCF@BED@B@@BB@B@B@@FB@B@@ADBD@B@@A@A@CACDCBEFA@C@CBC@GCA@A@AD@DADBDAD@@ADA@ABEBABABAB@D@DAB@@ABE@ABA@AB@D@BCDCB@BAB@B@B@BABABA@ABED@B@B@D@B@B@@@BAB@BABBB@D@DGBE@AB@BBDDDBDCBEFEDCDADCBK@@D@JAFAF@DFBCD@DBFCFEDEBAAICECG@G@A@Q@M@IAKBE@G@CAEACCEEAAC@C@IAE@CB@DBDIDCDIDIDOBGBGDC@EAABABBF@DCDEBE@EAAB@D@DBFAFCD@BBHHJAFADAF@DBFDHADCBEBADCHAFADEBEBIFGDC@ABCLEDCFEHGFEBIBECGCGAECIEICGCEAG@G@EBEFCFCJCFEFCBEBGFGAKBKAGAIEEGEEAGCEAGKOECEBCFCFEPCFCBEBC@IAAJAFEFGNEFGJGHIHEFCFCFEHERCLAH@H@LDLBJBHDRHFHBJDHBHAH@H@HBFDHDHFDFDH@J@JAH@HDJDHBHBJFHDLBFDR@HAFAFCFCHEHCFAHAJ@FBH@FBFBHDHFFBFDHBHFLDHHHHJFHBFHHFJHHJHHHHDNHFNHHFJFFHDXNHDFFFDNTFHBHBNFDBDBBABAD@B@FBB@BADADAD@@@BNAB@BDDD@BLDBFFH@B@B@D@DABEHADER@@ABA@ABADABABAAMIG@KAG@G@A@ECCCECMIGAGCGAEAKCEASAIBG@EDEDAHCFEHCFGLCFGFEHGHEHEDGFIDMFEDGDEFCF@HBFDJBHFHDJFNLXFNFLHJHLNVLLFHFH@FHADEDAJ@J@D@D@D@BDBDBD@DBFDDBJ@@@BBF@DHJBB@@DBD@BBJ@F@B@DB@B@HBBBD@@DBDBFDD@D@@BAJAFAF@@BBDBFBB@DAB@@A@GAG@C@AB@D@LA@@HEB@LBDB@BCL@DDDF@DB@@B@DCD@@AB@D@@A@@@AEIACAC@ABA@AB@D@FC@@B@BD@B@D@BBBB@B@BCDE@A@AB@D@DDBD@FAD@B@BB@DBBB@@@@B@BGDC@@B@B@B@B@JFBBB@@@BA@AACFEAA@AAAOCAAEEAAGCA@@A@E@A@@B@FFBBB@B@DCB@B@BBBBD@D@@@BA@IA@@ACA@@@ADEBCB@D@HF@B@DBBD@BAJIBAB@D@DD@D@H@@B@B@RGBANCFADCBAFCBAB@B@H@B@D@B@JCBABABABAB@DAD@BABAFAB@JAD@B@BABE@CBABAHADAJAR@HBJDJDJFHHPFJ@LBH@FBFBPBNDNBF@JBHBF@N@FAPALAL@JAJ@J@J@JBF@L@F@TEF@JCRCHAF@FAJ@J@H@JFH@JDFBFBFBFBDBFDFBJBHBJ@F@H@RBH@F@FAFAFCRAJCPEJCJEJEJEJCFENMJEFGHEFCFGFCHENGFEHCLIFCHEDABABABA@ABCBABC@AFEBABC@AAE@C@AAE@C@CDAB@DABA@ABCAA@@AA@AKCC@AAA@AAAAAABAAIBCAGAACAECGGACAA@E@A@A@ABABCDEBC@E@CBGFIHIBCDIDMBGFUBGBGDKHIDGDGFCHGNCFAJCFEFGDGBG@CDEBAAECCAA@C@EBCBCBE@E@GDCH@DADAB@DBDBJFDBHBFAHCDAHKFEFAH@DABA@A@@BKAADGBGFGBIFIDGDIDGDIBGDIBCDCJAF@FBFBH@J@JAJBJBJDVHPDNDJBL@HAHAFALCJCHCHCHCHAFCB@HCDANBDAHAHC@A@@@@@ASDGAKAKEICGCKA@@A@@ACAAAB@DC@A@A@ADA@@@A@A@A@C@A@@@A@AFCFCHEBABBBAB@BABCBC@AAAAAC@@@CBIFGHCDEFABIDEBIBC@IBQ@E@I@C@I@C@CDCDEFGJCDEBCBC@CAA@ECGCAACACCEAEAC@C@@@AAAA@A@@FEDABC@C@C@AACDCDAHEDABCBEBCBEBCBC@CAG@CBADCDCBEBEAC@EBI@C@C@AAAA@BK@IDKBI@EBCDKFGFKBCDEBCBEDKDEBI@IBKBCDCDGDKFIBEJMFGFGDEBEDG@C@C@CA@AAC@CCGCCAGAAA@CAEAC@CBEBA@@DBB@D@DABA@@B@B@@A@@B@AC@@@A@@C@C@C@CCAA@@EGAKGGEGGG@CECGAEECE@IDEHEHEJCJAJAH@LBJDJDB@LBJBLAJCJALEHCJEFG@@DCBGAEIEIAKCGAGEGCGCGAGAGACGCG@I@I@GBI@G@GAEAGEE@@IIGCCAMAC@CAE@C@CAC@ABGFEDGDC@EBC@EACCA@A@A@E@GDCBABCBABA@C@A@AAGC@AA@AC@A@A"],encodeOffsets:[[106799,33735]]}},{type:"Feature",id:"513224",properties:{name:"松潘县",cp:[103.604698,32.655325],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AAB@BCBC@CDIDGBCBCBIAIAC@CCAAAA@CBGDC@C@G@EBCBG@C@C@AA@AAEBGAEBC@A@C@AC@GACAC@CA@AAB@BCBIFCDC@CDEDEDIFEDABAFGFCFCBIDEDEBIBE@C@E@EAG@GAEBI@CBEBMBGAIBQ@I@E@MFGBEDGDEFEDABABEH@B@FCT@J@FCNARAHCHAFAFCFGHGFCFEDGHIDEBE@IAICECGCE@SCGAEBE@E@E@MDGBEBGBCBIBEDABABEDEDEDEBG@CBC@ECEC@GACAG@EACACIAEDKHAJEFCFCFCDCDEDEDIHEDE@CBKAGAEAEAMIGGGEEEGGCCGMGIEIEECCKKCCECAACCCGCCGCCAIGEAEAEEE@EAKAGACLCHAFCHMREDEBKNCDEH@HJPNPFFHFFHDBDFHJFFBJBFDHBHDHBLAH@HCNGfAH@TBJDLBJBH@JCHCHAJEFGFEJEHGHCFCJAHEJEHAH@HAFBH@FBF@H@FCFEBEDEBIDGDGJAFAF@F@FCNAFAHAHCHCDCF@FAF@HBDBHBFBHBJDLAHCJCFCDGDCDIBEBKHCD@FQxGTO\\GHEDIACECIAGAKAG@IAIAGBEBG@GBC@G@E@EAE@GACAEAGEGEEGGCGCAGECECEAEAI@G@GAG@GCMAICMEICCMKCEIGGGGCSSAEAGAICIAGIEICK@S@GDGAG@E@ECCCOEMCE@EBCBEDC@EBE@E@EBM@EAGCECGBIBEDGBIHGJGJGHORGFEBG@GACC@ECECEGAGAE@EDEBO@GCCACCCCCCECQIEAGCGCGEKAIEEECCACCCCCECECEAI@I@ODEDGBEDGDEJGFIDE@IAGAIAG@G@IBGFEDCFCFAHEHCFIAG@GAECGICCECEGEEIEGEIEGCICGCGCSGICMEIAGEKCGEEECCGECEGMECAECEICCCGCGEGGIEECECCEQIOGIEGJEFEDEDAHAFCFBH@HBJBHDHDJFHDDBHHLBDBFBHBFBPDF@FFJFHHHFFJFJDH@FDLFHDHDFHHJDJ@HBL@@BBBBDDBB@@VJHBV@FBHBBBBBJDLFRBLBLJ@F@D@HAFAFCFCFCDCHEDBHADBFBFDHDFJDJDFFFFDFBNBJDFFLDDDBHDFBDBFFFFDHBF@LAHETAJAFAFELAFAHAFCFBFDNDDBFFD@DHJBFDHJJJHJDFBFDNDHBJDDDFNDFFDDBJDFAF@JAFBJBHAH@FBF@J@FDJDNNDDFDFHFFFDDFDFDJ@HBHAFBH@FDHDN@DFHBHBFBF@H@FAF@FAHADCFCFEBCDCDAFAFBDBHBFBFFHDHBFDHBDBJ@FBH@J@H@HDFDHHDJFHDJAJAFADCFCHCDADAJ@LAF@JBFDFBDDFDBDFFBFBFDFBFDDBDBDFDFDDB@@D@D@D@FBBBDDDBDBD@D@BBBBBBBBB@B@BAD@D@B@BDBD@BBBBBD@BBDADADCBEBEBEHGJCLGFCBCBCAEAI@CDCDCFAHAN@F@HCJCLAJ@BABAAE@E@G@EDCBADADCDABCDCFCDADCFCFCJCFAFAH@J@HBHBJBHHFHFFHJHDLFJDFBFBFDFBHDHBFFFHFFFDBDHFFFHHHFDFHFFFBFBF@FBHBJ@H@J@HAH@H@HAJ@HBLBHBHBDDJBFFHBFBHDJBJBFDDBBD@HHDDBB@HHLDDDFDD@B@BBA@@B@BBBBJAB@F@FBHDFBBBBB@@B@FAFCB@B@BBBBDFBD@BAB@DBDBBAD@BED@BDF@BA@CD@B@BBBBD@@DD@BFNBDBD@BDHDHJRHDDBFDD@B@D@F@BANBD@BBBBFDB@H@D@J@FBJ@D@HAD@@BDDBFFHBFFFFHFHHHHHDFFHHDFDHDFBJBD@FADADEFODEDEFAFDLPBHDFBHFFFHJFHBLBLAHBHEFADAFEDEDIDEFEFAH@H@FBHDJDJFFDHBHDFDJAFAHEFGDEFCDKBAD@HCJEFAFABCBEDGBCFADABCCGAE@CBEBCBEGIAG@ADCBEAE@C@CBAFBF@FADC@CAEBABAFBD@HCHAPAJCJCDCJCAC@CDAF@JBD@D@BBFFDDFBDBH@F@LAJBN@R@B@H@H@FDJDBBFAFCDEAE@CDCEA@CBEBE@I@CL@DABCDCFCFEDAACCCAC@ABAF@HA@C@CAABA@ABA@A@@@A@A@C@A@AFCBAB@BABA@A@A@ABA@ADADC@A@CBAB@BAF@BA@@BA@C@CBABABAFABAB@BC@@BCACBC@CBCB@B@HDD@DAD@B@FEDADCDBB@B@@@@AACBC@@@AEA@@@A@AAA@@@AFC@ADEDAB@DAD@B@DCB@DACCCCAAAA@C@AAEBA@CBAFAH@DAF@H@BBBBDF@@BBD@H@DBDBBFBDBD@BBBB@DADABCB@BAB@FAB@BADABC@@DAFAHAHCD@AA@G@ABAB@DBB@B@BA@A@A@CACCC@A@@@ABAB@DAFAB@@ADAB@D@BBD@BBB@B@BAB@DAHALEDAD@BA@AAA@A@ABK@G@E@AAAACAACAECC@AAIAA@AA@@AA@ECCCGAA@AB@@CDABCDADCFCFCB@DCDADEDEBAB@DAFCDAB@B@DBBBHBJBBBB@DBJBDBBAD@D@B@@@BB@DAB@BBD@FBDBBBBFDB@DBB@FBDBD@B@B@DADBHBFDD@FDBBDDBFDFBDBBBBB@B@B@B@BADAFCD@F@DAJCFCBABCBC@ABE@E@K@C@C@EAAAA@A@AAABA@ADIBA@A@C@A@E@CBABAD@B@DAF@FBB@B@B@BCBE@ADEDIBA@CBADIBAB@D@B@B@DBB@B@B@@A@@AG@EB@@ABAFE@@BC@A@C@E@AECAAACCCAACCCCCA@ABCAABE@AAC@AEEDAB@B@DABABA@AFCHE@@DCBCBABADADAB@BABA@A@C@E@A@AFIDG@ABABABCD@FAD@BABADCBA@A@EBA@ABCDEBCBA@ABE@A@AAEAA@A@CAE@C@E@A@C@AAI@C@ABG@AAA@AAAAA@ABE@C@A@E@EAA@CBC@A@A@AAAACAAACCI@A@G@EAAAECG@A@A@CBC@Q@E@E@A@CBE@E@CBA@G@A@EBC@CBE@ABC@A@AC@C@CAAAACECAA@C@E@@BEDEAA@@C@AAA@@AAA@ACECECCIMAAAC@A@E@E@C@C@A@ACEA@AAAAA@IAC@SCK@C@GAEAABSA@@E@A@@@PHLFFDLDBD@H@B@@HDBB@@D@FDFB@@BB@BAD@D@@@BBBDBDDBB@B@@@@AAA@@BCB@B@BBD@BDD@BAD@D@B@DADAFAFCDADEDCBOLCDABCBIHEDADCBIHCBABCDCFABCDADABADCHADADCHADADADAD@B@DAB@D@D@BABCBAB@HAFBD@DDJ@D@B@BAFADADABADEDABCDA@EDGDKFCFABADCBABA@CBA@AACAGCAACACACAAAGAEAE@C@A@EDABABCBKBCBE@C@C@CAEAE@GAG@C@EAAAECA@CACAC@E@CBC@A@C@EAI@GAGAA@EACACBC@GFGDC@ABA@C@CAC@C@EAC@C@GA@@AC@C@CAA@ACCAAIGACCACACACACACAEAGAC@C@C@AAA@@ACEAGAE@EAECGAE@C@A@C@ABGBCJOBADEBABABCFEBADABADADADAB@BADKBAFK@ADCDEDCBAFGBAFGDCDCDABABADADCBAB@BCBA@@@CAAACAEACAE@A@A@ABE@ADC@CBA@C@C@CACAG@C@CBABABCBA@AAE@A@ABG@A@CBC@CBABABGDE@CBC@AA@@AEGACAAACAACAAABC@A@A@CCE@CAEAE@E@C@C@A@AAAGGAAAEAAAEAE@A@E@A@C@CAEAA@AAA@ABC@ABAB@D@DCFGBABEDABCBAAAACAAC@AAAA@@BAHAB@BABAB@DEBADCDCDC@ADGBEBC@ABAB@D@D@DBB@D@D@FAPKBALALADCB@DAP@JBF@FBD@BAB@BCBC@ADCBABADADAFABADCFCBCFE@C@EBA@ABEB@BAHCDAHADA@ADCBE@CBA@C@ABA@ADEBAFADAL@J@F@DBD@D@H@D@P@DAB@BABA@CDG@ABABAF@B@NIB@PBFAJ@NCRGR@DADE@@BBBB@FBFDHBBBDBBDBFBJDNDDBDDBBBBBBADAFAD@D@BB@BB@@DBBDBH@BBD@F@BBBBFDDDFBB@D@D@BCF@BAD@BBFDDDDBBFFBBDBB@DA@@BCFCDAFADAZCFABABABA@AACAAAAAC@AAA@A@ADCBABABGBABABADABCBA@ABADCBAFADABAFEFED@JEBADABCBC@CBGBI@@BG@ABCDIBA@GBA@ABC@A@A@@A@CBO@CBE@K@E@G@C@E@CAQGMEKEICCCEAEAEAECAAEEAABACC@AAAAAC@EACACAAACAAACCAA@AAI@C@A@C@C@AAC@EIKEAEACAC@@AAAFGDGDCDEAA@ADE@C@@D@@A@C@@A@ABC@A@ABADEJIDABC@ABEBABA@C@C@C@A@IAC@EAA@CAAAA@ECEAAAAMA@KDABCAOCEBG@GBCBC@I@G@EBA@CBA@CBA@G@C@IAEAMAC@EACAAAAAA@CAA@G@GAAAC@ACC@EAC@A@C@EFCBABABADADABAFABEDGJABC@EAOAA@GDA@ABCBEDA@CBE@C@ABC@A@CACAQCA@E@EBGBMHCBCBCBEFABC@A@CACAC@CBGBIBE@CBC@EBC@MDMBC@"],encodeOffsets:[[106345,32934]]}},{type:"Feature",id:"513226",properties:{name:"金川县",cp:[102.063829,31.476277],childNum:1},geometry:{type:"Polygon",coordinates:["@@GGGECAEEECIOCCWKGCCAEAE@GACBE@CB@@A@@@CECAA@C@CDCDADAJAD@BCDA@AD@D@D@BCF@B@FCHAJ@FABADAJ@DCD@B@D@DCJ@N@D@DABCBCBC@CDADIDSBEBCBABCDGPGTABA@O@A@A@A@CACCCAE@C@[GE@CACAAAAA@@A@A@CG@@@@CCAE@GOKCCA@EDCBGFGFKFKDCB@LAZERADMLGDEBCFGPBJBFFLBF@DABEDEDABADBBDDDD@DABINAB@F@DFJ@F@DGLCDGDG@A@AB@BCDGDGBKBMBI@KAMCOGIAU@KBGBGDADA@BD@B@FCH@B@BBBDBDBBFBH@FADA@C@IAIGOEIAGAICMEECGCEAC@A@ADAD@ZAHAHADC@GBEDA@AD@BADIDABAVEPEPADSHIFSLGDCDEPABEBG@GBCAC@CAA@ABCFAB@@KEA@CAABE@CBAAE@GEOIACAA@A@E@A@AAAAAIEEEEICAECA@@CAAAECCAAA@A@ABKPAB@BDHBHAF@DADCDCBWPABA@@@C@C@CAE@KAGCC@C@EBA@A@CECCA@GBGBOAEAA@C@EAC@G@AAA@AEACAACKAAACCAECAAAAAAEF@@C@EAGAE@I@@A@AJGBAD@HBDADAD@B@BA@C@CDG@A@AAAAA@@E@A@AAAEAAAACAE@@AIK@A@C@ABA@@B@PFDBBA@@@GACAAA@ACAA@@AAAEEIAAA@A@EBCBABAJAB@BEDAD@F@P@F@DABEF@D@D@D@BCDAHCDCD@B@BBDFJ@D@BADCHCD@D@DDHDDRHDFNXBBDAHCB@BBBHFP@DDFDH@D@FCFAFGHCB@@@DADAFABEBQDGBGDCBA@ACACA@A@C@C@E@C@C@AACACCAC@C@CDCBEAE@CACAC@CAI@ACGAGAAE@E@QDC@AACAEEGEECCCEGA@C@GCCAACAECCCCAAC@C@G@MCG@AB@D@F@J@FADCBA@E@GEKEEIGCECCE@C@EACAACAC@CBEFIPGHCBC@G@G@E@CDGDC@G@CAC@@@@BBH@DABSLAFAHBN@BDXNVFJFJDJB`@JEPEVCPCJSbCHADEFCBEDGJEDGFCDHNLPBDHDPBFDFBJNDF@@FFDDFBRLRLB@B@DBHDBBBFB@HDFFNF@BBDBHDFBBBBBBH@DBDBBBBBFDHF@FDFBBB@B@B@FCFADABA@@DBB@FFFFBBFDDD@BDDDBHFDDBBBDBDDHFD@DBBB@FDHBDBB@DDBDFJFHBBH@B@BBJHJHBB@B@@@BBBBBDBDDBBBB@B@BBBRLVZPPVXZXLXENKHKBEBOFCBI\\DRDRGZQTMDG@CBEAIAK@GBADCFAHCLCVFLADCLAJ@DJNDFDBL@NBHAJANGLCH@FBRFB@BB@DCVEVCFCFAD@DBDAF@D@F@BABCD@BBBDDHFHFHHFDBBB@FAFCB@B@DDDFBDBFBJDLBFF@B@F@TKXIVIXGTGVINAJEF@F@HAN@RI^cRIHCjGTGVEJEFEHSDEFIZMLM@AFWASBGDC@@@@BCJBPJHBDBN@JCB@HA@@^RNDTCB@BADAJEL@fJZB\\@JARALCFGR[LQBANG`GNAZID@VIZIJGVWHEB@NCXAREXQJGDCBE@@DELQFOBELMVeFW@K@@@@@A@OA_NQDA`OB@D@FANBN@LAPCLALCNBNFNFTHNDJDLDH@NALBNBJBDBDCHIDAB@BBDDFFDBBBD@L@B@B@BDBBB@DAB@B@F@HBB@HDB@LCJAHCFEFEBEDIDKDMJQFCLENCJEDCFEBGAGEIIQEICGDGJGFGDCJIFMBM@GFGJIHE@ALCHEFIFQDODM@MAEAKEKIIMIMGI@GAI@CAAAIGEEGCEAEACCCGGOCCECCAAA@GCGACAAECGCECCEAC@CBE@A@C@E@I@A@ABCBAFABABCBE@C@AACAGBABAFAD@BABC@ADC@CAE@C@CB@DCBA@ABC@C@E@C@EACAACAEAAAAC@C@A@ABAFABCBABCBA@C@ABABA@@LCBABCBS@GDG@CBA@C@@@G@A@C@AEEGCCEAE@I@KBIDYBK@IAS@I@K@YBI@ICWAKCI@CACEEGCC@ABI@YEAAACAEAAA@CAIBEAOCE@A@A@@BBJ@DCJCFABC@AAAAAEAG@ECCCACAC@C@A@CAOOEEGFCH@FCFABCBCBEAEEGCGAMCECOQIKECEAKCKG"],encodeOffsets:[[104552,31854]]}},{type:"Feature",id:"513227",properties:{name:"小金县",cp:[102.362984,30.995823],childNum:1},geometry:{type:"Polygon",coordinates:["@@@[ECASCKCCCBIHQRGDGBK@GDWXMLUPCHO`_R_BOBE@ECWICHAH@DBD@@@@@@JJHFBF@D@L@FCHCFYJ@F^vF@`GBB@FEJu`GFAHBJBHHHDFJHFFLFJHDL@N@\\BRExCTBLFFHHJJLJJFLFVFRDVB\\@F@F@NDTBH@D@JKDAFBJBJDRNJFDBBFAJSZCHAFBJJP@FAHKLIPE\\AFCBUNCB@LDRAD@BCD@BADBL@DDDDBBBBB@B@BADEHADALABGFAB@@BBBBJ@B@DBBB@D@BCDAD@@BBDD@B@BAFAFAFA^@B@FBBHFFBBDBD@FABADGJAD@BBD@F@D@DFFPPDBB@D@D@DBDBDD@FBHBFBBBBD@BADEDI@CAI@AB@B@F@PDFBJADBB@BBBFBDBBZFJ@BAD@HDFFBD@DDJBLDX@JAJ@Z@L@JBT@JALCZAJ@L@JBFDFHDFF@B@D@B@H@@@DAB@DCH@HATADABKD@@ABAB@B@DABADABADEBAB@B@B@DBDBBFBDBBBBD@F@D@F@DAD@BABCDA@@D@DBF@DCD@BADABC@EBABABBHBD@B@DAFADABEBABAD@B@B@J@F@D@BAF@DBDDFFDHDFDBBBDDH@HBBDBFDDDHPDHDDFBFBHDFFJHBBDBJ@HBJ@NHNJJJFLBLBF@NCNCPEREJGFKD@BGFIJEH@HANENIJCDEHIHCHDHFJJRFJBHAHEFCDIFMDKFEDIRCNCLCJAFEFEFGDIBKDA@GCA@GAE@A@A@CB@DEFBDCJCJDJFDDBJBTBR@VBHBLBJLJDHBFALEPCHBFBLDDFFHBDDFDBHBHAFBFFDJH@F@JEFKHIDEP@LCPAN@J@NEJEPINELCLCHCD@FAHEFELOHEH@JDJBHBB@N@JAJAJUBK@K@IBMBSHGFCNCPDNHFFJDHDJBJ@F@HEBE@K@G@IBCJGHAH@FAJAPBHBJDJHHFHDFDJDDBAE@G@QBGBIDMFKFIFEHCF@JDHFLJHHHFJ@DCHKHIHKFIFIBM@MAI@GFKDERQDK@QAKCOEKKMKGEEEIAK@IJAFAPCLCHEDM@M@_DODOHODQHOHKNMNMRMLKDAHALGNMLIDI@KGUEMCM@MBGB@FEJCLBPBD@D@JEB@PADADADAJANAFABAB@LSDCDAFAJABA@@CCICEIGIIGCE@EAKBA@AJEFCHAD@BAJEFAD@F@H@D@HBF@D@FGBADAD@HBB@DADADBD@DBD@FAREJIDEHORc@MBEHODIAIAMGIEIAEAIDKLMF@LARDPFJDPBJBLALEFGFGDGBIAEAGEEEGAECIAI@A@GCQGWCICGQQGEICKAKCCEGGCG@G@GF]@E@EEEKEIEEEAKAQBEDIFGBEBEBIIGGCECGEMECFADQ\\GJKHGFSDGAKCECKCICKDGDKFEBG@G@KCKEMGKGIAIEOCKAO@M@MBSCS@I@UMGGEGGYEYAKAICI@CGEECKCK@O@OAM@MAM@K@M@KAEDMAKAGCCEKGIIEICEIGGCGAK@OCKEKECECOEGGEGAI@KAMAKAGGEIKMGCOGEECEDMTcAaF[@UAICECAG@O@AAGGECEAEBGDiVODWRWNG@C@]GKAOBMAECIISQKACAIQCEC@E@EDE@EGAAA@GBC@A@@CAEAEcYECEKBMBKCAIAC@QSQCIFSFGF@NEDGBGDG@EGACaMGAGA"],encodeOffsets:[[104638,31363]]}},{type:"Feature",id:"513230",properties:{name:"壤塘县",cp:[100.978526,32.265796],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@AACE@EGEECAAAACACAG@AAAAAACEAGAC@AMEEEGCA@AEAAGCCAA@A@QKQKEACCEE@@CEIMEAECOAGCACKOGMIMCEAKCKEIEAECG@G@KFC@A@GCEAGAICuQCACCECKCSMEGCGCCGAEAC@CDILAHBJ@BABCBG@KDKDCBGVIRCNAFBBBDDFBFAFCJILCBABABAF@D@JBNBFDDFDNFDB@B@BQTGFCBE@CBABAFALADADBD@BHHNTDH@DABIFKDKDIAE@E@GBIFCDAFCHKLfOJMJCFEZ@PDHBFAFCFEDKBEBADCPGNYZA@E@GAOMEAUEKCQBiAC@CBGHMXAH@D@HDLJTDLJHJBB@LEB@BBD@HHFHDBPDFBFBHFFBB@DAB@BBRP\\ZJR@DDDDDFH@L@D@D@HBDBBJBVFJBLALAJBF@FDDHDJ@JANBJ@F@FADCDIHEFAF@NBHDLBDFDZPDDBFDF@BERADCDAD@BMJGFCD@BAB@BABABC@CBC@AAA@AACBEBCBA@C@AAA@C@A@A@@@A@AAA@@BAB@BAHCBEBC@G@aGgM[MKCI@K@EAMQC@C@EBMFGBEB@BCFABABGDCBAD@BCBGACB@@CNCHCDC@G@ABCDGFEBC@AACCCAAAA@_\\EF@D@DBFHJFVBNJT@F@BCBC@CAKCA@A@CDAJGLEFMFEBADAFAZABCBEAC@ONCDEBEBIBGBEBCFCLGNEHEBKFSRIVCHAH@DDDHHHDJBFARCJBJDHLADKNGLABC@EAGBGBMDI@G@GAC@A@CDQ`M^ADCBEBGAEBIFKJGFCBC@CCEIA@Q@@@C@G@A@ADCFEDA@C@GCC@CBQTKTCFAFBFDFHFDDBB@DABAB@BCDABAB@@A@@@AAC@A@A@A@CB@BAB@@AAAACAECE@AA@@AA@@A@@@A@@BABEDA@ABADAB@@@BB@BB@D@B@BAB@@ABAB@BAB@BD@BBBBBD@B@@@DAB@B@B@BA@ABA@@@CBCFABABABCB@B@@@B@B@@@BABEDA@A@A@AB@@@D@DAB@B@@C@@B@B@@BDABAFBD@@BFABAD@@CDCD@B@BBDLPHFBDADCBEFAFAD@JBLDHHLHJTXVRJDZHH@H@F@FBBDDLBDJHHFH@F@DBFFFPRbFDBBBD@FADCBEBMVAFBJBDTLTDdHHDHH@FB@AB@@B@@DEJELCHEJ@JDJBFAFAL@HDN@H@JANAL@JDJBLDJFHFFHBFAFAFCFCDAFAHCJGJGHAF@DBDDFHBJ@F@HCLGVEJQPKLKH@@GHAD@DFDHBBA@@FAFCHCJ@F@FCFCFCHEFCJCPERCFCFCBADBFFDF@DDDHFDBBC@I@IBIBGBCDAFCF@HBLJFFDHFFB@B@DADGDCDAFAD@FBDBDADADAD@JDJD@@D@HCFADABCBCFAJ@FCDABCD@D@FBF@B@BADABAFADBFAF@B@DADA@CBGBEFGDCHADAFDHBB@D@B@FCFGJQFCD@DBJFZXNJJFDBF@DAFAFBJBFDD@B@H@H@FBD@HHFHVPVZJJNHJFJFPJFDHDHBDBB@FBBBF@@@B@B@@C@@BA@@@C@@@ADCDCDA@AB@B@BBDBB@@@BA@@@A@A@@@A@@BAB@BBB@BBBB@B@@BD@@@@B@B@BA@@@A@C@C@@@ABA@@B@DBB@DBB@@B@@@BAB@@A@BB@@@@DBB@B@BA@ABEBC@A@@A@@AA@@A@CAA@A@AAAAC@A@E@EBE@ABA@ABAAC@C@A@ADADAFAB@BA@DB@BB@BABDHL@DAHABAFAFCHEBAFCDCFAFEBCBAHIDCDE@EBEBCBG@GBEBEBEBE@EBCBGBGBC@CLQBCDCBADCFEDCHCF@DAFCJGBAHGDCBCBGACEGACCCIK@CAM@OAC@CBG@I@E@GBCDEBEFMFCFCDCDANEHAH@D@F@FBH@FBLDJ@FBPBFBLDHDFBHBFAJ@DAJIDEDGBKBC@C@CBE@EAI@KBCBE@CBEBIFKBADGBADEDADCDADAJCHAFCF@DANAHAF@JCRENCDAPCDAL@HBFBFDF@DCBG@C@E@EDEBEFAD@JAH@DAJ@FAD@HAHADAFADCJGBCDCBCBCBEBEBEFKDQBE@G@GAAAKAE@EACACAEACACACACCECGCAAEEEAAA@ECECGCIEGIEECEACCGCECCACCEAECCEMAGCGACCCGACAC@ECCCCECC@ECGEKCICOAAAAAACEIGGGCCGGECEEEEECCEIGIKCEEGCGCGEOCE@EACEEGEKEGAGCCAEECCCGEMCGCMCGAECGACACAECEACAEAIBE@EBCFCDCH@H@D@NDNDD@DDH@FAH@BADCBEAK@CBENMNERCPFRLVAJMBYAM@A@CDEHIJIFCDABAD@D@FDLH@@B@FAFCHIB@DBHFFDD@B@HEBCBIBCBA@@BA@ADCBA@A@E@CBEAC@CBCDEDEFUDU@CAAA@QEEAG@KDMHIBGBMAK@CACEIM@CBIDKBCEKDUDKBGDEBCHAL@JBFBDAH@NCRSHYCQCQJ[DAPEFALALGFMKWYWUWOOUYQKAA@A@AAAAACCCAAAAA@A@@@AAAIGIGAAA@G@AAEGEIACCCA@CAGAECA@AA@CECCGACACAACCGECACC@ACCECAAEEEEA@CA@@ABCBEBEDA@"],encodeOffsets:[[103767,32388]]}},{type:"Feature",id:"513228",properties:{name:"黑水县",cp:[102.990108,32.061895],childNum:1},geometry:{type:"Polygon",coordinates:["@@KGEAAAABGBC@EDIHGDABEHCFGBCBCDCBCBCACAIAQCIBM@A@CBGFQLCFGFAJAFCBMDCBOHCDABGL@@A@ECGCEAGEICIAC@C@CBIBABCBA@@@A@@@GICAA@E@MBG@GAM@SCOGOGMEMEIIMIME]AQASCGIGGEKEKKOCQCCIECECCCC@@A@A@C@CACAAAEAC@C@CBCBA@E@GAIDI@I@GDMDGHADEHGJGJGHIDO@O@KAaCMBGBIDSD]FI@MCIAACIIGCCAGECACAEAAAC@C@A@C@C@A@AAA@AAAAC@A@A@GDC@ABA@A@CC@ACGCEAC@ACEAAEACAEACAA@C@ABABCFADABA@CDAB@BABABE@A@AB@@ADABADAB@D@B@B@BDD@BBBCHCPANDdFNFPF\\@LCJALGLAL@FAFCL@DBFAF@FAPBHAFAH@HGJEHABCHINCJGPEHCHCNAFENCJAFEFEJCHCJEJGPEFCFCDCFAFAHBJBF@DAF@FENCHAFEFCDEPAFALAHAJAH@J@HDHDFFHHJFHBFJNDHDHJDFBHAFAFCFCFAHAJAFAFDFDDDFFBFBDBHBF@HBHBJBPEJAHCHAHJFPHRJDFFDFDJFHHHFHDDDJDDFBFFDHNDFHFDDFFHFLDHFJBNFJDTHHDHDJDHDJFHFJFFFFHFDDDHJFDHBH@JBDEFGBGDEDEFCHEJAH@H@JBHBJBF@JCHEFIHCFCHAFCPCJ@J@FBFDFDDDDDBDDDFFJFLBHFHDHDFBRJFDDDDDDDDBHDP@FAFCF@HBHBDFDF@FDDHBH@FAHEPQHGHIHIJGHAFCJAHAFDHDFBN@FAF@F@FAD@FCDAFAF@NDPFDDFDF@H@HBHCT@L@JDJFBHDJBJBHBFTTHDHHJHDFNLDDFJDNBJDN@HBH@H@HBJBFDFDFHFDBDHHHFFFHBHBFBD@HBF@F@F@HAD@HAHAFBHBJ@JBHBLBHDJDFJBFCHGP[HSRw@EDCLGFAJADCHCDCDEDIBGCKAIAGAEAGAC@GBE@EDEDCDGBGBGBEDM@E@EBEBEHIHCJCFAFCFADE@E@GAE@EAGBE@GBGFGFIBGDIDEHGFGFIHEFEBIDGDG@IAGAICKAI@SBGHeDM@GBGAKCGAGCGAEAIEEGICECAEGGEEEMOIO@GFGDCLMFAFCNQDGBEDGDKEGAC@C@ABAHCDCDEDCB@DAFBBAB@@@@A@@@AAAAEACAC@EBCDCJCFAFAJADAD@BADCB@DADBB@B@B@DCBCBA@A@ANGDAHGBCDGFUBC@GDEFEDKFEFGBAHGHILOHIHGFEHGJG@AFEDGDI@GBU@EBEDEBQ@OAIEGKIIKGKISAGBMBABEFQHMBG@KCE@E@E@G@@EGCCAA@@A@C@A@K@E@GBA@E@C@EBE@ABC@IAECI@AAA@CAECA@ICC@AAA@C@ABA@CBAB@BAB@BABABAB@BC@EAE@CAGEEAC@CBE@CACAAAAC@A@@C@CAG@EBA@ABC@GBA@CAGAC@AFCFABADEDCBCBE@EB@@CACAKGAAGCEAEAEAE@MACBC@CBIJGHGHIFA@EDCDCBEDCBCBABA@"],encodeOffsets:[[105741,32682]]}},{type:"Feature",id:"513231",properties:{name:"阿坝县",cp:[101.706655,32.902459],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@CAC@ECA@E@E@CCCC@AAA@CAC@C@GBG@@@A@A@A@G@@@AAE@AAA@AA@AEACCCACACAE@@@A@@AC@AAA@@@A@@AC@CICICC@CAABDJBFGLINELEJGJEFCBGCIAK@CAIAG@EFABEBA@A@EAEAA@E@CAEBEDCBA@A@EAMACACACA@@AA@B@B@BADCBCDA@CDCBCDAFCDEDQBEBED@DCJCDCFABADEFGDCBCBGBE@GBEBCBEDEBADADADCDEFC@E@E@CAECC@CCGAECCAEACACCECCAAACACECEEECACAEAK@C@G@CBCBE@EDEDAF@DCFC@E@CCCACAECCCCCECECCAECEEEAE@ECEAGAK@CBODCBMDQFIDE@GBMBCBE@EDGBIDCBCBCDCBCFABCHABELAJAF@DAFAD@LBJ@FAF@D@DADALCHCFIJCBI@EBGAEAGCKCEAOAEAI@KCEAG@EAE@C@G@GBMFCBCDEDEDENAFCFAD@H@F@JAH@DBD@PBN@DJLDDBDFHBDAHADCDGHABIHEDCBE@GDCDEFCDABCDADKR@DADAHAHAD@FAFAFAFAF@HAHADAF@FCFCDGJABADEFEBCDEDABGFEDEBABGBCBK@CGCDAD@L@JFFHHJDH@LBLAN@JBFDFDFHDHJHFFHDH@FDFFHHNTDFDFFFJHJJHHDHBHBLBN@JBHBHAH@JCHAHBDBFDFJFBD@DEFCFAFBDFRDJBJBJDH@FCDGDIFEDCD@H@HBHBLCFADEDEDIBKDCDCFEHCDIFGDCDGH@@EHCBEBIBGDCDEBGDMB@@WBG@CBGFGB@DBFHNFJ@HADCF@BBFHRBHAFAFED@B@BDHBFAFAJCH@J@BBB@F@@B@B@@AB@B@BB@@B@DAHADCB@D@FBB@B@DAD@DAB@F@BAD@B@F@B@B@B@D@F@@@B@D@F@B@B@B@BB@@@BB@@@@BABEDADEHAB@BBD@BABGJAB@BAD@B@D@D@BBDBDDHBBB@JD@B@B@B@FAF@BBDBBBD@@@@@B@@CB@@AB@@@D@@A@A@OAA@AB@@@B@BBD@B@BA@@@EDABEBCBA@@@GAA@@@CB@@ABA@@@A@CAA@@@@@CBA@@B@@BBBBBF@DBLADELAD@B@DBDDDDDBDDDD@BBDAB@DAD@BBBBBBD@DBB@BD@B@D@DAFAD@D@DADCFADCBGBCBABCDCJ@B@BBBFDBD@FBBBDBB@DADABA@AB@BE@E@G@A@@@@@@BBDB@@B@@AB@@CDA@@@@B@BBBBB@@AB@@@@MBA@@@ABABCDAB@B@F@B@BAB@B@@@BJDBBBD@BBB@F@@@@ABA@ABA@A@@@C@CAC@IAA@@@@@@@BD@@AB@BCDABCB@B@@@@BDHHDB@B@@@@AD@@@BDJFF@@BB@@ABABAB@B@B@B@@BBBBBBD@FBHBDBDDBBB@FB@@BB@DB@@BB@D@DAD@B@@BB@BD@@@B@B@@@DABAB@BA@CB@@@@BBDD@@DBB@L@D@DAB@B@BBBBBB@BAD@@@BBB@BAB@@ABBB@DBB@@BBD@BBB@BBDBBB@@BBBB@BD@BBBAD@F@FABAB@BBHBB@B@@A@@@ABA@A@E@C@ABABAAA@A@A@@@@B@B@DBB@B@F@BABBB@B@@@@F@BDJDHBB@BB@HB@@@@BC@@BA@@BAB@D@BAD@FCFADCB@B@DB@@BAB@BBB@BBB@BAB@D@BBB@BBDB@BB@@ABA@@@@B@B@D@BBBBBB@@B@B@@@@@BA@ABA@@B@BAD@H@D@B@B@BADAB@BAB@DBB@B@@@BABCBADA@@BCFGBCBCBA@ABA@@FAF@FA@@D@D@B@B@BADEBA@@@A@A@AAA@AAAA@@ADA@@@A@@@E@A@ABAD@D@DCBADCBABA@@BE@EB@BA@@@AB@@ABAAABABABCF@BABADGFABA@A@A@A@E@C@EBABAFC@ADEBABAD@F@DADABC@ABC@CBC@ABCBCBCBABA@AB@B@DBBBFDD@DB@B@DAD@J@B@FAH@D@D@DBB@BDDBB@BCJ@DADAD@DBD@BADABCBCB@BBBDBDDB@BDBHDDDDFFD@DBB@HABBL@F@D@DBDDDBDBD@D@DAD@HCDADAPAF@F@FBD@DAJCD@B@D@LFD@DBB@B@FCBAHGFGBCDGBA@ABADADADAD@BABCBCBC@G@ABABABAD@D@B@D@BABABA@CBI@@BAH@D@DAB@DADADABA@ABCBADALADADCBC@ABCDCDABCBADABADAB@DABADAD@BCFEBEFEDEHEDCFED@BABGBABADAF@B@BABABC@ABCBADADCDAFABC@A@CBADAD@FBB@BBBD@FBBFHDFDBDDBDDBBJBBBFBBDBDDDBD@BB@@BBADAF@F@JAFAFEL@BABFBD@JBFDBBHDHFFDFFDDDD@BBDBBADADGRAB@D@B@@JDDDHBDBHBD@FFBBBBB@FAD@BBB@@DBDHFDBBB@BBJBDDDDH@DDD@BBF@D@D@DADAB@DBBBBDBDBB@BDDDDDDD@BBDADADAFCHEFCBEBCBCBABE@A@EBABAHABCDCBEBI@E@I@E@E@C@C@AAC@C@A@CBCFCDEDABC@CBIFCDEFELCB@BADBDBH@D@DBDBDBDBF@F@DBHBFBFBDDHBH@F@DBF@D@HBFDBB@DBBBBDDBBBBD@FBDBDBBDDBBDDBDDFBD@@BDBDDBBDBDBBBD@D@DBDBHBBDBDBJBHBD@BBFBB@HAD@DBD@@BBBBB@@@@B@BBB@@@BA@@BB@@D@@@@@DAB@B@B@@@@@BA@@B@B@@@@@B@@A@@@@B@@B@@B@B@B@@B@@BBB@@BDA@@B@@@B@@BD@B@BAB@B@B@@DB@@@DBB@@ABBB@B@@B@BB@B@B@@@@AAA@@B@BB@@@@@B@@@@D@@@@B@BB@@BB@@@B@@@@@@A@@@@@B@@@B@@C@A@ABA@ABAB@F@B@JBPBF@BDHBFBHBHAL@H@F@H@J@F@DFH@BBD@DAF@B@FADABABABC@A@C@BFADBDBBFBDDDDFFBB@BB@BBBADAB@D@F@J@DBDA@CDCBCBAB@FCB@B@@@BDBB@@FBLFB@PBBB@@@F@BBBBD@DBB@DA@CFAB@@@BDD@B@BCF@BBDBDBDBB@B@F@BBB@@HDBBDDBBBD@B@A@DHEDCB@DGDC@@DCFADADAFCHCFAFAD@HADCBA@AAE@ACE@A@ABCDCDAF@PEBABABCBABABC@CBCBCBEBAAC@@@EACAEACCCCGAAAC@C@ABE@AFEDCB@@A@AGM@A@C@GBABG@ADGDCBCBADAHALCF@DADABCBC@CAIAECIAE@CBADCFCLAVAAC@AACC@G@AAA@AAAAAA@ACBCBA@C@A@AAAACAAACCAAAACC@@@ADEBA@A@CAAAC@CAEAGAACAE@EC@CAC@AAAAA@@AAC@EAAAAAAAAACAEEAAACACCIAE@M@C@C@C@ACAAEEGAC@C@C@ABC@E@CACAGAC@A@A@ABCBADEBA@C@CAAAAMAAAE@GBC@CA@@AACGCGACAACAAAEAAACAECCAE@CAAAAA@CAI@AACAAACAC@CAAAA@@A@@BAB@BABA@ADABABGJA@@BABAFA@@@@E@@@ABAAA@@@@GC@AA@GBCA@@A@@@C@A@E@A@@AAAAAACACAAA@A@EAEAGAA@A@A@A@@@AC@AAE@@DCB@@@BC@A@A@CAEAE@@BA@AFGB@FCBCDCBADADCBA@A@ABABA@A@C@@@@@@BB@BBBBBDBFDB@B@@@BADCBCDAFCDCHEDAD@DADAJCF@BABC@A@A@C@ADC@CBC@CBCFGFEBC@ABECKAGAIBC@EBG@ACE@C@G@EACCKACAAECEACAE@AA@ADK@EBA@CBABADANCB@DADEDAFAF@FAJ@DADABABCHADB@BB@DDFDBFDBDH@F@B@BBB@DDBDH@DBBBDDBFBBBFDFD@BBB@BBBDBD@D@D@F@DBDBFDHF@@BBDDBDBDBDFLDHBFBJ@HBB@BDDB@HAL@BBB@FBBBBBBABA@AB@B@@@B@B@FDLDRHHHNDLAFAHATKXCB@V@l@\\FDDDBBB@@B@DB@@B@B@@A@@@@@A@@@A@@BAB@@@B@B@BB@@BB@@@B@@@D@DAD@B@BDBFC@BB@@DAB@B@@@BB@B@B@B@@B@BCD@BBBJBBB@BABG@A@AB@D@BDB@BA@EB@BBBJBHFD@BB@B@BCD@BB@DB@@@BCB@HED@B@BB@DBBDDB@@@@@BAD@@BB@@DBD@B@B@BB@B@BA@@BA@CAA@AB@@@B@@@@B@B@B@B@B@@B@@@B@@ABA@@BA@@BB@@B@BB@AB@@@@A@A@A@@@AB@BB@@BB@@BA@@@A@A@A@@@A@AB@@@@@BBBB@B@B@B@BB@@@B@BA@@B@B@@BBB@B@BB@BB@B@DB@BB@@B@@ABCBA@@B@B@@BB@B@@A@@BA@AB@@@B@B@@BBDEB@D@DBB@@@@C@AA@C@@@@CA@@@@A@@DA@@DAB@BA@A@ACA@@@@BABAF@B@BAB@@ABA@@B@DCBAHAD@DAD@B@@AB@B@B@B@BBBA@@@A@CBA@@DCHMBC@AAE@ABALODEDCJCDA@ALEB@J@D@BCBADAD@DBD@DAFGBCDAF@DDD@B@B@BDHHDF@BAH@DADDFBF@@AB@FCNAFAFABDF@BAFAJCF@BDNCFED@FA@@BCDADADBTBDBD@BBDD@D@D@D@@ABADCB@@AB@BABA@CBCBADADEBC@C@C@C@CEQ@C@A@EDBDB`LHBBA@ECECCBG@@D@BBD@B@D@JDJDB@B@BAAE@A@ADC@EBEBABBDA@@@CHABA@A@@D@B@@@HCDAFE@@BBDF@@B@DAB@B@FFJD@D@@CBAD@D@@FBNB@@JKH@FAFCDAB@BA@C@A@C@E@C@CBCBCBCFEBAB@DAD@DBFBB@BAH@BAH@D@DAFCHEFABAD@D@DABBB@DBAF@F@FBDBBH@B@BBDFDBBBHBBCLKFAXGLCXCPGLGDADAJAPCNCB@JELAJAB@FABBD@DBDBB@BFAB@@@BCD@DBBBD@@DAB@BB@DA@C@AD@D@HBBB@FCB@ABADCF@BB@DAB@B@@BABAB@BB@BBFAB@BCBAD@@@BF@@D@LBH@HCBE@EAACAC@E@C@CCCCAE@ABCD@B@FBHJJDB@JCBCBC@GAEAAGAMAAAAA@A@ABAFCHAH@B@DBBDBFDDD@D@@@BA@@AEAECAECAA@CBEBAFCB@J@B@H@DA@A@CAAC@IBC@AA@ABCB@JCDABC@C@CBCFAD@@BBD@B@BBF@DFFDBFAFC@CCCEECCAA@AFAFBFBHBDAB@CAAACCAA@AGCGGGKAEIIMGECIAIAGAGCGCECGCGECGEEGECCEECEEGGEGEGGEGEGAGAEAOCKCOAIAEAE@C@GBGBG@AAE@AAGAGAGCE@ECGAEAI@EAEACAE@UBEAE@GAG@E@EAE@OCEEEOMECGECCAE@C@AAEGMIOEEEEIGQQEEEECIAE@CBCBG@EBG@EAMAEACEKEGKMECGEGECGECGCGCQGMCQAMGECECAECEKSIMGCGAEAGBEBSDCBGBGBIBGBKAQCI@KAKASEKCICGCE@MDK@KCCEAADIDOFCFCBEACAIEI@CBCJOPYFEBCCGCCCAECEAAA@ACAIEC@@CBEBGDMBAFCBADABC@CBABABAB@B@DBBBBBBBB@BB@@DGB@@@B@DDB@FCPODCBAB@D@B@D@B@B@DAD@F@D@D@D@DADABC@ABCBA@EBC@CDCDGBA@C@EAECK@EBG@IDGAGKIEEBCDKBCAC@CDE@EAA@EHAJEDABEDCBAFA@@@AAG@ABCFANG@EBEBEBC@E@EBEBAAA@AC@@@A@C@@@A@@@A@AA@A@A@@A@A@ABA@A@CAA@A@A@AECACAA@@AAAAAAAAC@@AEA@A@C@ABABAB@BA@CBAEAO@GAC@CCCAACACBABGNCD@DBF@BAH@D@DAD@DADCFAHAF@F@DAJADABC@A@CACAACCCAC@CAAA@A@C@IBC@G@@ACIAE@CFCBE@E@CAC@E@@AE@C@ECE@AAE@AA@E@GAAA@BA@AB@BAHADGFADEDADIDEHQNGFGHABADCFADBHAJ@DADABGJ@@A@EBC@EFCBGBC@A@ECA@C@C@CBIDEDGBCBE@K@E@EAEAC@C@CDCB@B@@BFBBHDBB@BAD@D@BBD@B@BABABCBCDBB@FADAHADCBGNAB@DDDCD@HADCFA@ACAABAAAEACAABABA@A@KBE@C@C@GCCAACEE@E@K@AAAECAA@CC@CAAACCAE@AC@CAAACECAEOCEAECEAAA@@AA@A@@BABABCBABEBABA@@@@@@EBIBCAA@CBE@CGCCBCBCBEDGFCDCDCDA@E@@BA@A@CFA@CDCD@@AB@AA@AA@AAA@ACGEG@CAA@CEECCAAAAECAA@AA@AAEEAACAAA@AAACKAEA@@CAA@@@AAC@AAC@A@ABA@ABA@ABA@C@@@A@A@@AC@A@C@A@AAAAC@A@A@AAAAA@A@C@AA@AC@A@C@A@AAA@CAAAGAC@A@C@C@CAAAA@AAA@G@A@C@AAG@E@E@C@A@EBG@AAC@E@C@ABADEB@@AFCDCBGACC@EEGGGAEAEBE@CEEEGGECCCCGWMEACC@I@IBQ@GCGMQAEAEAEBA@@CAIAE@EJILABCCCECAE@EDINEHEDGDEHGLEFEBEAECIGKCIAABA@@B@BA@CDOHADCBGFA@EB"],encodeOffsets:[[104193,33149]]}},{type:"Feature",id:"513232",properties:{name:"若尔盖县",cp:[102.967826,33.578159],childNum:1},geometry:{type:"Polygon",coordinates:["@@HCFCNEJCHEFCFGHGFGHEDEHKDEFGDEBGFCFCH@JATBFBLDFBHBHDHBNJFDDDFDB@H@H@LBH@NJBBBABABCBAB@BA@@FQBCFGBA@C@C@A@AEGAEKC@ACCACA@MB@A@@BCBCBC@AAA@E@ABCBAAAACECAMAGEGMSECEEGCWMGCEEEIGGEMMGGCGGIGGGEIGGAEEGGIGGCGEKAGCGAEEECGAGAE@EAG@EBIBGDEFGDGDEBEBE@GCQAECKEGAIAGCGCI@GBG@I@ICGCEGEGCECGAG@G@GBGAICGAGECQAGAICK@K@GBGDKFQFGDEDEFEJGHGHIFEHMFEBEBIEAGCECGCEGCEGGGGEGEGEEAEEGAECC@AC@GBC@I@EAI@C@G@A@ECAAAAC@MAABE@C@A@C@ECCAGCIQCGCG@AACACEM@ACC@@ACAA@A@ADCB@@ACE@AFC@ABCAAAC@CBA@AACCEAAAAA@A@EDEBA@@@AAAAEAGCEAE@A@IBAAAAA@@@AB@A@BCBE@ABABA@@D@BBD@DDDBD@D@B@H@F@HBD@FDHBFBFBFBF@H@D@FAJ@F@D@HIDABCBCBEBCBA@CDCBCB@BAD@B@DABC@CBC@ABADCBEBCBABBD@BC@CBA@ABABCBA@AFCBA@A@A@A@CBABCBCAC@CACB@BCB@ACCC@G@CBABAB@B@B@B@DCBABABED@BAB@DABG@CBABCD@AA@@@@@A@ABCACBE@MFgNCDIEC@CBGL@BG@OH@HEPAJBD@BCL@LGN@FEjADCBEFA@@D@L@PCJEFCAC@MDCAI@@BYAKDC@A@IAEBC@YIA@ABCBADA@KBAB@B@BJA@BED@BBBDBBB@@AB@DBB@@E@AFEHCDSDU^eFIA_ACDABC@CBGBG@CACACAAACACACAABA@ABA@ABABC@A@C@EAAACACAW@Y@Q@ECA@C@C@C@O@E@E@S@EBGDGDCBIFCBA@CAC@A@CBABAD@FADADADABABEBC@ABC@C@A@ACA@C@A@CAA@ACEA@@CCC@A@ABA@@@A@AACACAA@A@EAA@ABCD@@AAAAECA@A@AB@BB@@BOBABCFA@E@S@MBE@E@GAICAAACAACAAAA@ACA@CA@@AAGCGACA@@CBA@A@CC@@AC@A@@@A@AAAA@CA@A@AAA@ACAEAAA@@@@@AA@@ACAAA@AC@C@G@@@CCGC@@C@@@CCEIAACE@CCAECCACCEA@AA@A@EJA@AAA@CBCBA@@@A@CCCCEGIEGAA@A@AF@DCFE@IAIEEAAAACKEKGIC@AAABI@@CC@CAA@CAGAECKACCE@ABA@ABA@CBEDC@E@EACEC@@CIAASAIAE@KDE@SAA@A@CBGAEAEAEB@BBBDDFFDD@DEDEBCAEE@CAE@A@AAC@AC@EBAD@D@DADCBIDA@AD@BBBD@JAD@BB@D@BCBG@A@I@A@EDABAF@DBBFDDBBFBF@@AB@@C@C@CCAEACCAA@G@GBEDAB@B@BBBBBNBHBBBBF@HADADIDA@ICGIEAA@C@AD@BBFDDDDD@F@D@DBBB@FAFGDG@KAC@@@AE@@C@ABADA@EBAAA@@ABABA@AA@A@CBA@@ADEBCBAA@EDA@AA@G@CBCD@B@@CAAA@CB@@ACAA@CDC@A@@BAAEA@CACAC@AAEBA@IBKBIFA@MDODIBCBCBKHOHWDKDWHEBKLADGAAACACEAAA@G@AAAC@E@EBECAA@AACBC@C@ABEBGFEDCBC@G@ABG@ABA@EACAC@CBA@ABEFADADAD@D@D@F@D@B@DABA@CBEDEBG@IL@@MAEA@@@CBCDA@@@CICEEA@A@CBA@@@CEAA@@EFCBGD@@A@C@@@@BABGB@D@@CBAAABAF@FCD@B@BBFABA@A@ICICC@A@C@AAC@@@AHDDDF@FABGA_KCACA@F@B@DFR@D@D@D@DADCFCBABAD@DABABA@@BA@CDAB@BC@C@C@C@A@A@@BA@A@ABA@A@ABA@@@@@@@@BA@@@@@@@@@@@A@@BB@@@A@@@@@@B@@A@@@@@@@@B@@@@@@@B@@@@A@@@A@@BA@@@A@@@@@@@@@@A@@@@A@@@@@A@@@@A@@@@@@A@@B@B@D@B@@@B@@@@BBB@@@BBBDBBB@FDB@FDDDBBFBDBDBHBF@DDDBBFBDBB@DBB@BHHBBFPBLDJDFBBHBFBDBFBHDBBD@D@B@B@BB@DADAFAB@H@DBFBDBDD@@BD@BAFCFCDEBADAD@B@D@DBFDFDFBDBDADAFCHIDIDCDADAN@D@D@HBJDHHBFFDDBD@F@B@DCFKJIDCJAFAB@DBBBBF@FCDADCFABBB@DDBB@DBB@D@D@BABAFE@CB@@ABAD@NADBBB@BBD@HBDDBDB@D@D@DBBBBBBFBB@D@BBB@@@BBBDBBFFB@DDHBLBFBDDBFFLDHFBF@H@JCLED@FBBB@B@D@DCBIDMDEDAD@DBDDBHDB@B@DAB@DCHEBEBCDCDANCDBBB@B@DCDEFMHMHEFCBIFCFADBFDJFHFHBFADAFCFMJCFCH@DABAF@BBBDDFBD@F@LGHCH@B@FBDBBDBJ@J@BCDEDKDGBGDEBCDADCHAFCJADEDIDEBI@M@IBIBIDGDGFKLEDEBABGJGBKFC@IAG@I@EBC@ABC@EB@@EBEBEFCDAD@D@H@D@D@DAJAFAJCHCFCDGHEFAFADCBCFCF@BAD@DBDBBBD@F@FDHHJBF@H@BDHFJJNNLBB@BBD@DAFKNKBA@EACA@@ECEIAEIKACEE@@EECAC@CDCBSHCBAD@H@FBHDFJHFDFBJBF@N@NFFDBF@DBHABADEFEDCDEHGHABAD@B@@@BBB@@@@EDGFGBIBCBC@I@A@E@C@GBC@UAE@A@IBG@C@C@CBCBA@ABCFAB@B@@@DDD@@NNFBDDHHFDDDDFDFBF@F@BAF@F@B@J@D@FAFCFCHCHAFCBCDEBEFC@CBG@CBA@ABAFABABADEDABGHEHCFCHADCBEFCFABA@EBABA@ADADABABCBCBADADCDABCBABA@AD@B@F@B@@A@AB@@CBABCBEHCBCBA@ABE@A@A@CD@@@DA@CB@@GLABCBABC@ABC@ABEDABABAD@B@BFH@DBBDBBBBBBBB@FBFBJBHBHDBBB@B@B@FCBABA@@B@DBB@B@B@@ABCACCCECAA@ABAB@@@B@DBFDBD@D@D@B@BB@@@FAD@N@H@B@@A@@@AE@A@AABAFEDEDAF@D@HBB@B@@A@A@ACAAAA@AA@A@AB@HADABBBB@@BB@B@BEHABBBB@B@JGBABAD@X@DB@@BB@BA@A@M@CBA@@B@BBBB@D@DADADBB@FFBD@BCN@BBBHDDBBAB@DCDEBC@AAA@AAAA@C@AAA@@@@A@@DCBABAFEF@F@BDEFAD@FFHFLDFJDB@FBFCFIHEFGBAFBDB@HB@DADBFDFFBJFDPABCDCBEDAF@@CACEBGBCBCAAEAKAC@A@EDCBABBBDCDAB@BBBJ@H@B@BDBHBDBB@@BBD@FEBAACEAAAAABAB@B@JBD@DCDEDAF@DFBFBDHDF@BADKDAB@FAD@BBDDBB@B@B@BB@@BB@BAB@@ABC@@H@BCB@B@DABBB@B@D@BA@@B@@BBB@B@B@D@B@H@HBD@D@F@BBDBDBB@BBB@B@F@DBN@FBDBDDDDFBFBF@D@BBBBBDBBBFBBBDB@DBHBDBDBBBDBB@DBDBFBJBHBF@BBBBBDDBBBBBBBF@BBDBB@@B@FABAB@D@DAD@FAB@DA@A@E@AB@BABBFB@AFCFCHAHBLFZDLDNDJHLBH@JAL@JBLBN@LFD@H@FADAHRJBVDn@PAPMJEB@@@B@B@B@DABAB@BA@@BAB@F@DABADCDCBAHBHBFHAFCXAJAH@JEDGFCFGFCFIFE@MFIBEBQJCBIDGDAF@@DDDFFHFHJDHDHBJDJBFAH@F@DD@@DAFAFCB@@@@F@DADADAD@BAB@BBBB@BBLBD@BB@BBD@B@B@BBBBDB@BBD@D@BAF@BAB@@@BB@B@BD@D@B@B@DBDBBBB@H@DBB@DBB@FA@@B@BAB@@BABAD@D@B@B@BAH@@@@@BBBB@BBD@B@LBB@BBBBBBDHHFFDBBBBBB@B@DBDBDBBBBDBRA`@N@N@JAF@DBBFBLB@BDBDAJ@HDBLDDDBDBFDFBD@@B@@@B@D@FDB@B@FAFAB@FAD@H@DBB@BBH@D@BAB@FCD@HAF@J@D@HB@@@BBF@B@BBBBBDDBBBBBB@D@B@BAB@BBDBB@B@@@BABABA@CDAB@B@BB@@BDDDBB@JBB@BBBBDBBDDBB@B@D@B@F@JBF@H@FAH@D@@@DBB@B@B@DADADA@@DABAFAB@BAFCF@FALEHELKHIBG@EBG@EBGDCHGFCFCLGFEDE@GBGAICKAIAGAG@I@AAMDE@EDAFBDGJGJELCNCB@D@J@HBLDJ@JCHA@@F@BBJFFFFFD@JADADCVK@@D@N@LAJALAF@FCLEHEHEBCFCFGBCAWDI@KBGDCBCHA@AA@KGCECCAOBCFCJGHEBCDBDDJBLA\\YNGPKBA@ACCAECIGEIAQAOAOBODCBA@AAAI@C@CACAC@CAACCAAA@@@AIAEAEBCHAJFJDH@JAJABABC@MBCBGBGDEBADABCBA@ABCAA@AAA@CAEACCCIECACACCAA@AAI@AACACAA@@CAAAEAC@C@CACAACCOAI@E@C@CBEBCBCDEDEDCDCFCH@F@FALBHBJAH@DAHCFEFODEBEDGDKAIEOIMAEAEAGEQAG@ABC@ECECG@C@AAGACCIACAEAC@CACAEAGBCBAFEHCBAF@FAFALAF@F@F@FCLCPGD@FAF@J@F@L@F@B@BHDDDDNJHFFDFDB@HCD@N@DAFAHANELGBCBIBKAA@AAACACAACAEAC@E@AB@FAD@BAB@BADCHCTGHELGHAFEHAJEJAJALCLAJ@dFJ@H@JBP@LAL@JALBNDHBJBDDHPFHBJBFBFFDDDHHBFBFFLFHHHHDJDHBRBVBJBJ@HAJAFAB@FGFGDGDGBIBG@CFEDEBADGDEAAA@E@@A@C@EBI@C@AC@E@A@@ABCDCJGF@LED@@@@AAC@IAC@A@AAA@AAA@@@E@C@A@A@@AA@AAACA@ACC@A@AAACAKMACACCIBGCCCCCEAAAEB@HAHA@EEGEGKKMUGKGIEKEMKWEMCIEGAGCIAE@GDEFE"],encodeOffsets:[[105968,34383]]}},{type:"Feature",id:"513233",properties:{name:"红原县",cp:[102.544405,32.790891],childNum:1},geometry:{type:"Polygon",coordinates:["@@HI@GBGBEAGBO@EBEAE@CDKBE@EBKHKBKDI@KE[EOEMCcBMDODGAA@ACC@A@A@A@CBABCBABCAA@A@ABE@AAC@A@AACAA@C@ADC@CAEAEACAECC@BA@AFAB@B@BBD@DABABAD@B@BB@BB@BB@@B@BA@@BAB@@@B@@BBBBABABCB@BAB@@@BBDBBABA@CBABABA@@B@@@BBBBB@B@@@BA@@@A@CB@@ABBB@BB@@B@@@BCB@@AB@BABB@@DBB@@@@CDAB@@@B@@BBD@@@@B@@@B@BBBBB@@@B@@A@@@GBA@@B@@@B@@BBA@@@CBA@AB@F@@@B@BABBB@B@BB@BBB@@BB@@B@@@BA@@@G@E@ABAB@@AD@BDH@@BD@B@@ADCDGL@BA@ABAAGAC@CAICIDK@K@ICICMIIICEIEIEC@E@EHAHANCJMRELGHINGJIJKPKJGBEBEBEDEDMDEBGBCBC@C@E@E@E@I@EBCBEDCDCBCDAFBJ@DDJDDFJFBDDF@FDN@DDBDBF@FADEPEFCJCJEHEJCFCHEHKDGDEBCDEFAFEJCDGFCFGBGBGDEBKDG@GDGBGFMLCFEFGFEDEDCBCBC@C@GBGBCBEFEDCFADAFBFHVDHDH@FBFBL@FELEDCFCDGDOJEDIBIBKBI@GAMCMEEAEFCDAD@FCJADCDKDA@C@G@EAKAE@C@ADADAF@D@FBF@H@J@D@HAJAJAHAHAJEPEHGFEDC@EAKIGCGCECEBADEDCDKFG@GBC@A@GAI@SIMEGAGEIAGAC@EAA@A@C@KBG@CBGBA@ABEBCBCDMHEDIFGHCDCJELELCFEFEDGDIDE@E@EAKCOGCCGCOEE@EDEFEDEFGBEBG@IBMDCACBABGBA@C@@@C@EB@@AB@BBB@@@BA@ABA@C@CBC@CAA@A@@@A@A@A@AACAAAC@AACBAAA@@A@ABABCB@@A@@@ABG@ABABEBA@@DA@ABAB@B@D@BADAB@@@BA@@B@BAB@@@BADAFAB@@A@@CA@@CECCGEIGCAAAACACACAEACCEAC@A@ABC@AAAAA@AAC@AAAAACAC@AAC@A@CB@@CAA@AA@@@BBB@DAB@@CDC@ABCAA@CACAA@CC@@AB@BCB@DA@E@GAC@EAA@@@@@ADABABCDABA@ABABABA@AA@@AAA@BD@B@BABABABEFA@CDE@C@A@AAEGGEGEE@C@C@CBEFGFADBH@DFJDLAJEHEBE@@@ABBFBFBFNRDH@HAR@J@JDDFBXNDHDDFDHHFFDFF@FAFBHBHHFFD@BDAHCDED@BA@CFAB@B@D@FBD@BAH@F@B@D@F@FBH@B@D@B@HBB@BBBBB@D@D@D@BBDBHBB@DBB@B@B@D@BBDB@@B@D@BBBBB@B@B@BBDBB@B@B@D@BBD@@@B@B@@@DAB@BAB@BAB@B@BBD@BBD@B@@BB@DB@BFDLBB@BBBDBBBFFBBB@@BBBFDBBBBDDFF@DBB@DFHDH@BBB@BBBB@@BBA@@DCDCB@DEB@B@@AF@B@DCDCDCHEFCDADADAHD@DAF@DBBADAJ@F@@@@B@BAFABADABABA@AB@B@@BB@BBDFBFDFFPDBDFBBDBD@@BBFDDBBDBD@@DBBFDBB@B@L@FFFBDDBHDD@D@F@LAB@B@BABADBFBBBABBBBDB@DEBC@GDCCC@CBAHMDABCBGBC@EAADCDABABA@A@AAC@A@CBC@AAAGCAAAE@@@ADADCD@D@FBFBF@L@F@DAHAFCJCDAD@D@B@FDB@D@HADAFED@FAB@@@HIBABC@CBIAGBCDEBCBAHGHERMFGJCBCFCBCHEBCBG@ABAB@@ABBHBF@B@@BBF@BDF@F@DBF@@@FBD@D@FAFED@DBFDJ@BH@D@JAD@B@B@BB@DBDDDBDDBDBB@D@BABCBI@C@EBEBGDEBC@CBC@C@CBG@AAE@CDCHMBADADBBBDD@DBD@HBPBFDAB@@ABABABAD@B@B@BF@@BDBBBBBB@BB@DBDBBFB@B@B@DBB@B@BAB@B@@@@B@BBBB@@@B@@@D@B@@@D@@BBBABAF@F@FADAFAF@FMHEBAD@BBH@B@@EBABCDAFCBIFGB@FBB@FCF@DBDADCLADFFLJBHCH@JAH@FDLBF@F@DABCHCD@DAD@FABAD@BADCBCBC@C@C@E@C@CBA@A@C@A@C@A@ABCDOPEDA@CCA@@@A@CH@@AAA@AAAAAACAA@A@ABABAB@DADCBABEDABCNAHAF@DD@JFDB@BBBFBFDDBDDDHADEFOZIPAD@DFJBJBDAFEDEDCPCJBBDFLDL@NCF@HDJDLDTFLBLBJ@RDLBHAJAHAHADATCFAHAFBHBHDJNLTDFBFFDFDNHRBNDRHHDHDFDDHHFHFFDLNFHFLBDBFBN@FAH@FAHAD@DBFDJFFFFRRJHFFFFJPHNBF@B@DBFDDHFFDPNFFDF@PBF@F@FBH@HBFAF@VBFBDBF@FBJBFDH@FDFBHBHBH@BBF@BAHAH@H@DBFBFBJDPDLBPBFBHFHFHHHHFHFFHDFFFDDHFFFDHHFHDFDHDHDHBJBJBFDNHJJBFHLHHHD@BBBDDBBDBB@TBF@LCF@JBTBBBDJ@@FDBD@F@FCDAF@DAB@BAB@BDFBDDLBFBH@DBB@DDD@@AJBB@BJDLHLFBDBBFBJFJBF@DE@CBEB@B@HBJFFHDDDDB@@@B@DADAB@BBB@FIB@B@@BFBDDDBFDDB@DDFBBFJDD@@D@@@HDDD@@H@D@D@@BBBDB@BB@@B@@@@BBFBDB@BBB@B@BDBB@BB@B@B@@@BBD@@DDB@B@DA@@DBHBHDBB@@DBB@BDB@BBDBBBBDBBJDHBF@F@NAT@F@B@DEBAPA@AA@@ABAB@B@FDBBBB@@DCBAB@FBB@B@DBDBBBB@@@B@BAB@D@DD@@FBBDB@DBB@D@B@BDB@D@D@BAD@FABABABCBCBC@EBCBADAB@D@DBB@DAJEDAHCHCFAT@F@F@P@D@D@D@B@FDR@Z@X@DBDBBBFBD@B@D@BABAB@BAB@BADBDBDBBBDBDBDBH@HADAD@BADC`BJBfEV]TCDCFGBEF@@@AA@CBA@@AACAAA@AFC@AIB@A@ABALAB@BCDABAB@ZJD@FAJBB@D@LCZB@AJ@DBNCD@DBFEDI@O@K@CB@FEDABCFi@EHM@KDK@AACBIFO@GPGH@@AHKDAD@JFDChMNEF@DADBBAB@@@@@B@@BDCBADAH@BA@CBA@AFCBABADA@C@A@A@ABABADAH@D@DD@BDA@ADADBD@DBDABADAB@B@B@B@DABEB@DABABAB@DAD@@AACBADAFADABCBAD@DAD@BA@C@ABC@ADADADCB@DAFADADABAJC@G@C@EBI@E@C@GAEAEAEAECG@EAC@G@E@G@A@CACCC@CAC@A@CB@BABAF@DA@A@ACCCECCGK@GAACCGGC@AACCAEAICIAGAEEGAECIACAGAGAK@GBI@G@GBG@G@I@GAIAG@EAEAEEEGECEGEGGEEGEACECEEEGEEGAGCEAECEAEAICKEGCGIEEEGGGIAGAGAI@G@EBEBIDEDEDCDCBEDCDADCBCDCBABCD@F@H@FBFABABI@KBIDGDE@M@GBEBCDCD@DBJBFADADEDKHIDGHAFAFAFCDCBCBAAC@AAAA@AACACA@C@C@ABA@A@AAAAAAAAC@C@CACACCAAEAC@C@C@@@CAECECACACCCAECEAEAEEEACECCCEAECIAE@KBI@CBCBGDEDCDEBIBIBGCIEGCCGCE@G@G@IAG@EAIACCGAECGEGAEAEAGACBEBEDCDCFADEDEBCBG@EBE@E@GAEAEAGEG@CCMCG@EAGBEAG@GCICECEECEEEGECCCMMICECI@E@EAG@GBIAEAIBE@EBICCAECCEEMCCICGAMCECEAICIGIICGAEGI@CECAECCCMAEDEBEBGBEFKBEBEBIFSBG@KAECGEEEECAEAGCCACCEKCEAIAMCEEEEEICICCECGAEAEBCAGFCDGDCDEDEBEBE@G@C@EKIKAQAKEICAAAAGAEAU@GAUI@@AACCAAAA@@AK@GCIGIEGGCGCKEECG@ICIEEEGGEGEI@ECEAOAEAGAEACGKAGCCEGCICGAGAI@GAGDEBEBGFCFCFEHIBGDGBGFIAOAIAG@GAEAGACAEEECCECECEBIBGBEBEDEDEBGBEAICCGCGIMAEEGGIEGCECG@G@IBGBIBGBKBEFODCFEBEDGFM@EBE@CAEAIBGBEDEDCDEFEHOFIDIDGFIFEBEDIFMBEDMDGFGHODIJMDGBAFG"],encodeOffsets:[[105134,32811]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("巴中",{type:"FeatureCollection",features:[{type:"Feature",id:"511902",properties:{name:"巴州区",cp:[106.768878,31.851478],childNum:10},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@A@B@@@@@@@@@@"],["@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@@A@@@@@@@@@@@@ABB@"],["@@@B@@@@@A@A@@@@@@@@@B@@@@"],["@@@@A@@@@@@B@@@@B@@@@@@A@@@@"],["@@@@B@BA@A@@A@@@A@ABA@@@BB@@B@@@"],["@@@@@ABA@@@@@@A@@@@@@AB@@@B@@@@B@A@@B@AA@@@@CBAB@@@@@@@B@@@@BB@@@@"],["@@@@HAA@BA@@@@GD"],["@@A@AA@@AC@A@EA@@@A@A@C@ABC@A@C@ECCA@@@AA@C@ABADA@ABEDKDABBB@BDB@D@@ABC@A@AACCC@A@A@C@A@@@AB@BBD@BCDAB@D@@BB@BBB@@@B@B@@@DBD@@BBB@BAB@BBBB@@@B@@BB@@@@AB@@@BB@@B@@D@@BB@@@@@AB@B@@@BB@AB@@A@AB@@@B@@@BA@@@AA@A@@CB@@A@@@KGAAG@MEECAA@AAAA@EAAAAAA@C@A@ABAD@BADABE@CAICC@C@AD@BBDFDBB@BAJCFABADBBBBB@F@@@FFDB@D@D@DCFCFABCBA@@A@ABGAA@ACBABA@AAAAAAAAABABEBC@GAKEA@EBCBEAEAC@EBC@ACAAABA@AFAHADCBC@CLAFADAB@BB@BDBD@F@D@BAB@DBBBBBDDBBBBD@BABA@ABCBAB@B@D@BABAB@B@BBBB@B@BAB@@ABAD@@ADADCB@D@D@@@BB@B@B@BB@BABADAD@DAB@BADABBBB@@B@DA@AD@B@BBB@@@BA@AAAA@AAA@AA@ABA@EBCDCBABA@CBE@A@@DABAF@D@B@DBB@B@BADA@B@BBBBB@BB@BADADABAD@B@D@D@BB@@@BC@ABA@A@@B@D@BDH@L@DBBLHBF@D@B@B@@@H@D@F@@ADA@ABAB@B@B@B@@@@BB@@BB@BDD@BBBB@BAB@B@B@@@BBB@@@B@BAB@@@B@B@@@B@BA@@B@BABB@@B@@B@@@B@@BB@@DD@B@@ABC@A@A@@BDDB@BB@@@BA@@@C@@@CAAEAAAAA@A@EH@B@@@BBB@@@BAB@@AB@B@@DDFBHHFHCDIFABA@@B@@@BABC@A@A@A@AAC@A@E@CDABABA@C@C@CDA@ABABBB@BB@BAHC@B@@ABABAB@B@@@D@BABA@GDGF@@IHBBB@B@BBB@@@BBB@DBB@@BBB@@B@BBBBBBB@@B@BA@AD@@AB@@@DBBB@BDBB@BBB@@AB@B@J@@ABAAA@@AAAAA@AA@@AA@@@A@A@ABA@AB@@C@A@A@AAA@CCACA@A@A@A@@B@@@B@B@B@B@BABA@A@A@AA@AAAA@@@A@A@ABA@A@A@AC@AA@A@KBA@AB@B@BAB@@ABA@@B@BBB@BB@@BBBBB@DBD@@ABABA@CBAB@B@B@BBBD@BBBBBBDB@BBBAFBBABADEPAB@BA@EBC@C@A@A@@AAACCCAA@A@@BA@ABA@@B@B@D@BEFABCDC@C@A@A@C@CA@@AC@AAA@AA@A@A@AB@B@D@D@B@DBBABA@ABABCBAB@@CFAB@BB@B@BABCB@B@B@B@@BAB@@AB@@BBAB@@AB@@BB@@B@B@D@B@@@BB@B@B@BBBBBBDFFBB@@@BAJ@H@F@B@@@B@B@BA@A@@@A@CA@AA@C@C@ABABCBAD@D@BBB@B@BA@@BA@@B@BABCBA@A@CAC@E@C@CACAAAEACBE@E@AB@@AB@DADCD@B@B@@AD@@ABABA@A@AACA@AACCCAAAAA@A@@B@BCBABAB@B@D@B@DAB@DABABCBC@E@AB@@ABAB@B@D@D@B@D@BBDHDFBBB@D@DABEJ@D@B@BJB@@@@@@B@@BB@@BBDBF@BB@@@B@DABAD@B@DBBD@HBFDFBFDFHCBABA@@@@@@ADB@AB@@@@A@@@@@GB@NCR@H@J@DDLBDBFDJDFHLDHDDFFJFJFVLJDNHHDJDFBL@HEJIJGBAHCLBJ@HDJDFHHBHBFBD@D@BAJAJCJCJ@NFJDFDRNFBNFLBLBDBHBD@HBN@H@VAFAB@D@JAJAHANG@@B@@@B@BABA@@B@B@BD@@B@B@DBBBB@B@D@B@@@BA@@B@@BB@BB@@BBBD@B@B@DB@@B@BDDFDBBB@B@@@DDB@DDFDB@DAFAB@BB@@BDBFDBB@B@@@BD@B@BBBBBB@BD@@B@D@B@@ABBBBDB@@B@FD@@DBD@@@BAD@@@@AB@BAB@@@BAB@BA@@BA@@D@@A@@FF@B@B@BAB@BA@@@ABAAABA@@@A@AB@@@BA@A@@@A@EF@@@BA@@BAB@@ABD@B@@@AA@@@@BBF@B@F@@BB@BBBBBBB@@@@ABAB@B@B@@@@@B@FA@@@D@A@@BB@@BB@D@BDABA@@B@BB@ADBB@@B@BDB@@B@@A@A@A@@B@BB@@B@BBB@@BB@BBB@D@B@B@B@DBB@D@BAA@@A@AA@@A@A@@@A@@@ABA@@BAB@@A@@DCB@@AD@BA@@@AB@BA@ABA@@BBB@B@@@BCBABEB@BADCBABCBAB@BA@ABABEBADGFI@A@ABC@@@A@@@A@A@EB@@AAA@A@@@AAA@A@A@C@C@C@C@@@CAACACCIE@A@KBABADAD@DA@ACIAC@A@A@C@ABA@@BABACC@A@ADADCDADABABCBK@CAE@G@CCEEEKGACBAB@@AB@@A@A@@@AB@B@BC@@A@AA@EAIBGFCDABBDADBB@@B@B@D@D@BBDFAB@DBD@B@@CAK@AA@EBA@A@AAAC@ABGBABAB@BBDDB@BBD@@A@@CS@A@C@E@AAEBA@A@ABA@@BC@A@A@@BEBGBCBABABC@@FANI@ABA@E@ABAFA@A@ACA@A@@BABAB@D@B@BB@B@BBB@BB@BBDBB@@@BABABEBAD@B@F@HADA@AB@CAG@AA@@@CBA@CBC@A@AEECCC@C@G@MAAA@@DCFA@A@@@CA@AAC@CBA@E@CBC@CAE@@A@ALKFEBC@CE@EDE@C@IAC@AAGCC@A@C@E@CBA@ABC@B@@BBD@B@B@BAF@D@B@BAB@BA@MDA@@@@@@A@@FE@@@AA@AAA@@@AA@MAAEG@@AECAE@EBC@CAAAAAACAAE@GDCBQHGDC@ECEAG@IDGBI@E@A@GAECCAI@ABEBA@EBA@AB@FAFABABEA@A@A@A@CAACCCE@A@ABCBEBE@A@C@A@ABCD@D@F@DBDB@B@H@BB@BABABCA@@EEKAAA@@AAB@@@B@B@@A@EAAAA@@A@@BAD@B@@@AA@AA@A@CBC@AB@@ABCD@BB@FB@BA@AB@@C@@AAAAA@@AEA@@AB@D@BAB@@AAAC@E@C@AE@ACC@ACAA@AAA@@A@A@CAA@AA@EC@@BABABC@IBABCBAD@D@B@DBFDB@BABEHA@A@AAA@CBA@ABCBC@AAACAG@SBECCAGEA@A@AABABA@A@A@AA@C@A@CAAAA@A@A@@@A@@AB@FE@@AAC@IBA@@@AB@@@DAH@@A@@@@A@C@A@C@A@A@ACI@@BADCB@BABBBBD@L@D@@@DABAB@B@D@D@FA@@@C@@AA@@C@ECAA@@@CBABA@CAA@A@E@C@CB@B@FADAB@FBD@DDDADABCBA@CAG@@AEAG@C@EBEBA@CA@A@EBABCA@@@AACAAA@A@@ABCBAAC@AACAA@ABC@A@AAACG@A@ABA@A@C@CACBGAA@AECEI@ABEFEFCBEBEBEDIBA@EAA@ABADGBCAAC@ECCACACACAC@A@ADADADABC@IAAAECAA@AAG@AAACCIGAAA@CBABC@CAE@IFC@OEACAC@CAQ@CBAB@BBD@@B@H@BB@BADG@AGEGCCAE@"],["@@@A@@@@AAAAAA@A@BABC@ABEHABAB@FBBBBB@A@BBB@@A@@@@@@@B@@@@@B@@DB@BBDAB@@BB@@B@BBB@B@@A@@@@DA@AB@@@B@B@@ABA@@@A@A@A@@@@@A@@@@BABA@@B@A@A@E@C@@@@A@A@AAE@A@@"]],encodeOffsets:[[[109086,32721]],[[109142,32658]],[[109146,32659]],[[109089,32714]],[[109086,32719]],[[109083,32723]],[[109086,32718]],[[109209,32677]],[[109426,32270]],[[108974,32683]]]}},{type:"Feature",id:"511903",properties:{name:"恩阳区",cp:[106.654386,31.787186],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@ABAA@@@A@@@@BBB"],["@@@ADC@E@A@CBCLODA@AACAAACEA@CCKAAC@EAACAABABCBA@AA@E@A@ACAEBGCAG@GAEAEAEAEBGBIDA@CBOJCBE@GAC@A@GBEB@@CDADAD@@BDDBBFBDADEF@B@BBBBB@@@B@@BD@B@@@BABA@E@@BAHADADABCBCBC@ABAD@BABIFABGD@BABABC@C@A@AAAACBABADC@CBE@ABA@CABAAAAE@CA@AAA@CCA@E@CAABCDCBA@A@AAA@A@C@A@AB@BAB@B@DAD@BED@BAD@D@BABA@@@A@ECC@CAABE@ABA@ABABA@EBAACBABABADBD@BABA@CBA@AB@BBBFFBB@F@H@FABADABBD@DDBBB@DAPADABABC@CBAB@B@@BBBBBB@BAFAB@BABCBCBA@ADCFAB@@A@A@A@CCA@AA@@A@E@CBEB@@@B@BA@A@ABA@@BA@@BBD@@@@AB@@AAA@@CAA@@@AA@ABAAAAA@@B@B@@@BA@CAAB@@AB@BA@@B@@@@@B@@@BBB@@@BB@AB@BABA@A@ABA@A@A@AB@@BD@B@@@B@@@B@@BB@@ABAB@@BB@@ABAB@@CBEDABED@@A@A@C@KDCBA@ABCDABA@GBCBABA@AF@@@B@BBDBBDBHBB@BB@B@B@B@BD@B@BB@DBBBBDDBBBDAB@BCDAD@BAF@BDBD@B@@A@CBABAB@FBB@DABAH@BABADCB@B@BBBBBBBD@FADBBBBBBBBBD@B@HABA@EDAD@DFHD@DBFAF@DAH@B@@B@BABCBADABABCHABGFEHABADAD@F@BADAD@@A@ABA@@B@@BBDBB@@BEDABADABAF@B@DCDD@D@HDB@DBBDAFBFAD@BBB@BDDBFFHFDBD@BABCBCD@@ABABCJAB@D@D@@@D@B@BA@@BBFBFBB@DAD@FCH@B@B@BBDDFHDJDDBFFBB@B@BADABC@A@C@A@E@C@A@ABABAD@B@B@BBBDBD@J@DBBBDBBBDB@BBBBD@F@@@@BB@J@D@@BBDBFJBBBBBB@@DBFFFJBDDDDBFBF@BABCDCF@B@DBBF@DCND@DADGBCBCDE@@DCFCDAH@DBBADDFDB@F@F@DAHBFDB@FBNB@@FDD@DA@@LC@@B@@B@B@@AD@D@B@@BBLKLOBCDCBAFEDADADCB@BAPEFCB@JKBGBIBABADCB@BAB@AA@@@@@@AA@@@@B@@@B@B@@A@@CGCC@A@AB@@@B@B@@@BB@@B@@@@@BA@@@@@ACGAC@A@@BAB@HCB@BA@AAAACA@@ABAB@B@B@DBB@F@@@BAB@@A@@BC@ABCB@@AB@FAB@D@B@D@BBB@HBAC@A@C@A@C@C@ABABA@@BAF@D@DABABA@CBA@C@A@C@ABABADA@A@AB@B@BBBBDDBD@BDBBBB@B@BABA@@BC@@@A@ADCBC@CBA@@BAF@F@DAFBBBDBDBD@F@D@DBB@B@DABA@A@AB@@AB@@A@AAA@A@CBCDABABAD@D@B@@BDBB@@@B@B@@A@A@A@@@A@E@GBI@A@@AAEEACAAAA@A@A@AAA@@A@C@A@A@@@AA@@BA@@BAAA@@BA@@BA@AA@A@A@A@ADABA@A@@ABADE@@BADABABAB@BAAA@C@A@C@C@ABAB@B@B@@BBB@BBD@@DBD@B@B@D@D@DCBAFE@A@C@A@AB@BAB@@AB@B@DBDDBB@BB@B@D@D@FAB@@ABAFOBCBAAABEAA@ACAAAAAAAC@AA@A@A@ABADAB@BABA@@AC@CAAAA@AA@@AAA@A@AB@BA@@BA@A@ABAB@LAB@B@@BBDB@B@B@BAB@B@@@B@BB@BBBB@B@B@BA@A@A@A@A@A@@@AB@B@B@B@BDDDB@BBB@B@D@@@BAB@BAB@B@@@B@@BB@@BBBBB@BB@BBBA@@@I@ABA@@AA@AAAACA@AA@C@@BA@@BCB@@A@AA@AAAAAAA@@@AA@AA@CAA@AA@@A@AAA@A@AAJG@@HEHCB@BA@A@C@@@ABABABA@@@AGDABA@@AAABABAB@DCD@D@B@BABADCF@B@D@BBB@B@B@D@BA@A@@@AB@BAJEDCEGGGEACC@@@ABA@@BA@A@@AA@A@@@AFGB@B@BBBBBFDB@@D@@@B@@A@@AAA@CC@AB@B@D@BA@@@ACC@@AA@@@A@@@AA@@@AAABA@@@ABA@@@A@A@@@A@ABA@@@A@AA@@A@A@A@ABA@AA@ACC@AAA@@AA@@@@@A@A@ABABAB@BC@@@E@C@G@@@A@A@CAEKGAA@C@KCG@A@C@AB@B@BAD@@A@@AAC@C@A@C@ABCBCBABA@@AAAAA@A@ACBABA@A@CAA@C@E@ABCB@@@BAF@DABABCDAD@FAB@BBBB@BBB@BB@BAB@@A@AAA@C@@BCBA@@@AAAACBABA@CBC@CBABABA@@A@A@AAA@@C@C@A@CDCB@BC@AB@BA@ABA@A@AA@A@ABABA@A@C@ABADABAB@BA@AACAACAACAAAA@CBA@A@C@EACACA@@ABABCBEDKIAEAC@EACAEAAAAAAC@AAA@AA@G@CBCBCF@B@D@BA@A@A@E@CAA@A@AD@B@DADADEBAD@D@BB@D@LCD@D@BB@B@BABABCDGBGAEAAABAAA@AC@C@@@AAA@A@AB@B@@@B@@@@ABA@@@@BC@A@ABCDAB@@CACAAA@C@A@CAAAAAA@AAC@AGECCAC@C@A@@BCBA"]],encodeOffsets:[[[109143,32668]],[[109194,32322]]]}},{type:"Feature",id:"511921",properties:{name:"通江县",cp:[107.245033,31.911705],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@BA@@@@@@@@@@B@@@@@B@@A@@CAAAC@@@@@@B@BA@@@@@@@@A@@A@@@@B@B@BABBB@@@B@@BB@@B@@@B@@@B@@@@A@@@A@AB@@@@AA@@@"],["@@@@@A@@A@@ABA@@CA@AA@C@A@@B@DAB@B@@@B@@@B@@B@@BBABBBB@@@B@BAB@@B@@BB@B@@@@@BA@@BB@@@@@A@AAA@A@@@A@@@@@A@@@A@A"],["@@@@F@@CED"],["@@A@CA@A@BAD@@BB@@BAB@@@@BBBA@@@C@AB@A@@@@BHBF@HEF@N@BADEEE@GJIBKD@DBBFBDDBDBD@H@BADAHCHADEDAD@D@BDF\\@LBHD@@DBB@BAF@F@F@BBF@FA@UJCBAFCB@BEPKHGDCB@FCDEFCFADADGBADANAFAB@BC@AACEEC@A@E@@@E@EBC@C@A@@@C@E@EBCAA@ACACCG@@A@@@@@A@AB@@AA@@A@AAA@ABA@A@A@CCACAAAEACAAC@C@IBIBA@I@"],["@@@@BAB@D@B@B@B@@A@A@A@A@CE@@BAB@@A@@A@@@@@A@@@AB@BB@@FCAE@CBAAAA@IBGAC@CBAAGBCAECA@A@@@A@@BAF@@@@@B@BA@ABDBBB@@@@B@B@B@B@@@B@H@B@B@B@@@B@BA@@@AB@@@B@@@@BBBB@DH@@@B@BBB@BABB@"],["@@AHABADC@E@G@IGICEE@CAC@A@AA@@@C@CDADA@ABCAAA@ABK@AAAC@OFCBCH@DCJC@E@@ACGAACAG@CCEKACEGECACBC@CFCHI@CCCDCPCHA@C@AACAE@E@CBGF@BADCDAB@BBBBBBB@B@B@BA@ABC@ANI@A@CAAEAABIDEBABCAA@@CAAA@ACCCBABCBAB@B@B@B@F@D@BA@@A@K@CA@A@CBA@@AACACB@B@B@B@F@BCBGDEBE@GAI@ECCACC@CBEFAHAD@@A@A@@AACAA@BABC@CB@@AB@NCDA@ABCBA@A@C@AA@CAECCCCC@E@CBCA@EDABID@VEBE@AAE@E@E@ABA@CA@@GCKA[@CE@A@CBCFCBCDGBGBC@A@GACACCCEAAA@CLCJAHIF@FFBC@A@MFE@GAEAGAC@@@@B@@@BC@AAEKMOMCA@@A@A@@@A@@AE@EDCFAHAFAFABEBC@C@EBAAG@AAGAA@ABGBEFEBCDAFAFBFADAFCAA@E@CBE@C@AAA@@A@GAG@@AA@@AACAA@AAC@@@A@A@@AAAA@@@A@EFABAB@BAD@D@HABABAH@B@BBB@B@D@@@B@BABCDABC@CBEBA@AB@B@B@BDBBBD@BBB@@@BBB@@BBDBB@B@B@BAB@BADAD@@@@ABABC@ABA@A@C@CB@BA@@BAB@B@B@BA@C@ABCAE@A@A@A@@@A@C@A@G@C@CBABA@@@A@CAA@ABCBA@AB@FCDABC@ADCBCFCDADCFCJCHCHCFABCF@B@B@BBDB@@B@B@B@BAD@BAFCBABAB@DAB@@@DBBBDBN@B@BA@A@CACAEAA@ABABABAD@BD@DAD@FDBBHBD@PEF@B@HDDDB@DF@F@BABAFCFILCBEBABA@CAA@A@I@A@@@AB@B@BCBCDCBA@ABA@E@ABCBABABABCACACACC@ECM@CACA@C@E@IDA@KDA@A@C@CAC@AAAAAAAACAAAA@@AAA@A@AAAA@C@A@ABAB@@@BDB@B@BEBAB@B@FAB@BMJEB@@ADABABADAHAF@@@B@BAD@@AB@B@BABBF@B@F@D@BDT@@@BC@AAA@CCAAA@ABABAH@BBDBBB@B@FAB@@BBL@DA@C@CAA@EBAC@A@C@C@A@AA@CACBAACBEDAHBJ@FBBB@@@ADA@A@@B@@@B@BA@@BA@ABBDLHFFDF@D@HBF@DALADABCBCBCDCB@B@BDDABAB@@AB@B@D@B@BBDDJ@BCBC@CBABAB@L@BJFDDDBBB@D@@@D@D@D@D@B@BBB@B@@@BBB@BA@@F@B@B@@@B@@AD@B@BEJCHABAFAB@BABA@ABADABCDABA@AFABAD@@A@A@AA@@AB@BABA@@B@@ABC@@BA@CD@@@BA@AB@@AB@B@@@B@@@B@BB@@B@BB@ABC@A@CAA@A@A@C@A@AAA@@AA@AAA@@@AAA@@@@B@B@BA@BB@@A@A@A@CA@BBB@B@@CDEJCJ@DABABBF@LADBJ@DDD@BABCBCB@BB@B@BA@@@@@DBD@HB@B@HCJBDB@BAFBBHFB@B@B@DAD@D@DBDBFBD@B@B@H@HBBA@AD@@@@A@B@@@@@B@@@B@@A@@B@@@BBB@BBB@@B@@@@B@B@B@@FHBD@B@BC@CFBFCBBFDDBBBDBBDBB@@@DA@@BDFJAF@D@DDBFDHBFDAB@BEBABAB@B@B@BDFBD@DFFDB@BBB@BB@@@B@BBB@BB@@B@@@B@BAB@@AB@B@@@@BB@B@BB@@@B@@AB@BA@AAC@EB@B@@@BB@@@AD@BB@DBFBBD@BB@@BAB@@@BAB@B@B@DAH@BCF@FAB@FCDABABA@C@IDA@@@@CACA@CBCFAFB@B@@D@@B@BB@ABBB@@B@@A@A@@@C@CBA@@A@A@@D@@A@@AAA@EBEDABCBABFBB@@B@@AD@@B@FB@BC@AB@BFFBBBBBBD@@BDBDDHADABBDDDF@@BDBB@BD@D@B@DB@BB@B@BC@A@AB@@BD@@DAB@@BBF@@@@BB@CBAB@B@@EAA@@B@BBD@@@@@ABA@@B@BA@@B@DBBBDF@@B@DF@DBBBB@@AB@BBBB@@B@B@@GAAFFBBD@FDDBD@@C@AB@BA@CDABACA@A@ABCB@@AACC@BAFABCACAA@A@ABC@EDABCB@FADAHBFBBBBB@@ABA@@BB@D@BBBEDEFEDAB@@@BFD@BCBBBF@BPAF@B@@D@DBFD@@AB@FC@ADHDBBCFCDABABCBG@A@@BBBBBDB@@@JBDEBE@G@EDEAEBC@AD@FADBBD@@BBBAB@BA@CBABB@BBD@BBB@BBB@BB@@@D@@AB@BDBABBP@BBDBBBDBD@F@F@B@B@BBBDHDFBB@BAD@@@BB@D@D@BAD@B@B@D@HABAB@BBFBD@D@B@B@D@BABAF@FBB@DAB@BBBB@BBBDFBDBHBHA@B@FBDDHBAD@DBDAF@@BBD@@B@@@B@@D@BBBHPCDAFAB@BA@@B@B@@@H@DBB@@A@@@A@@@AB@@@BBD@B@@@B@@AB@B@@AD@B@@@B@@@BBB@BB@@@BJAHCJALEJEDIHEFEBCDEBGDEBEBGHMDEFEFCFETIHCHAJCHCFCJCDAFAHALHBLAHDHHFFHJNBJBL@@@H@LDFFBDBHDFDBF@L@D@F@DDFHHHDHDLDHDRFHBRH@@NFJDFFDFFHDF@DHHFJDF@DDD@DDBFBHATBHAL@DAHAJCLAHAFABADAF@BA@C@AAE@CBEBC@A@A@@AAAC@AAAEGCE@A@A@@DE@@BA@A@A@A@@BABE@@@AA@@E@E@AAA@@BA@@@E@@BA@@B@B@BBB@BA@A@@@@@@AAA@@@BA@A@A@@@E@@@@@ABA@@@A@@@A@@D@@@BABABABA@@@@@B@B@@CB@B@@B@B@B@F@B@@@@@@A@A@@@A@AB@B@B@BAFABAB@@A@ABA@AB@@A@@@EB@AEAC@@@AB@@@BA@@BA@A@AA@@@@@A@@@A@A@@A@A@C@@AACA@AA@A@A@@ACA@@AA@ABA@AA@@A@A@@@@BAF@@@FAB@AAAAA@@@BA@ABA@C@@B@@BB@BAB@BA@@BB@@@B@@AB@@@@@BB@@@B@BABA@@BAB@@@@@BBB@B@@@@@B@@@DBB@@@B@@@AB@@BB@@BDBBBB@@BBDAFAB@@@BB@@@@@BHAFSDGFKDIDCBEFGFCHGJADAFAFAF@JBFDHDDBHDHDHBH@LDFALDDB@@@@A@A@A@@BBB@B@B@@@@@BB@B@@@@D@@@@@@B@@@B@B@@@B@@A@@B@@BB@@@B@@@@@BB@@@@BAB@@B@@AB@@B@@@B@B@@@BBB@BBD@@BB@@@B@B@BBB@@@DB@@@BB@J@FCDAFCHANBJ@J@JAHHFBFDDBB@DABADCBADCFEHCDCD@DCB@D@B@@@@@D@DBB@BAB@@@BABC@GDIBALIBADEBCBA@C@E@CCE@A@A@CBC@ADGFM@ABAFABA@ABC@A@EBC@@BADAFAHCAA@AAACCAAGCAAECAE@ABE@KBC@C@M@AEA@ABKDEBAEEECA@@@@CDC@C@AAAA@GCAACCAC@@ACGGGEEC@ABA@AE@@@FC@E@A@CDCBA@@@AB@BA@C@CBEDC@@@CB@BADC@CAAA@A@AB@BEBA@AAA@A@CAEC@ACAC@@A@ADCBCBCBABCBE@EBA@AAAA@AB@@ABEBCAA@@B@DAD@B@B@@@B@B@@ADCD@@CAGAG@IAC@MGEAECEAEAA@ACAC@EDE@K@EAACAAI@CFA@AAEACCK@EBABE@ADAJEDAF@B@@@@ACCCCCCA@@@@ADB@@FBDBD@B@@@CC@AB@B@BBHDB@@CHDFBB@DAFCB@D@H@NDB@D@DAFCDCDCDAFE@CB@DABCBAJEFEFE@CB@@BBBBBD@DBB@@@HCPMLGDC@AB@H@B@DADC@AAAAA@@@@BABA@@B@@BD@BABC@ABADADCFEBABGDCBCBC@C@ABA@ACCEC@AGGCCEKAACACAM@@CA@@AACCCAEAA@@ABA@@@AAE@AAEACACCAAAAC@A@CAC@CAEA@@ABCA@@CGEEICCCA@CACAA@CC@C@@@@C@A@AACB@@ADADCBA@@@@ABEAEL@@AAAAA@A@ACC@B@BCDEBE@A@A@@AAC@@A@@@ABCFCBCBA@@AA@ACC@CAA@K@A@A@GDCBC@A@C@ABCB@@C@CBABC@AAECEBCCGEA@A@A@CAA@A@ACCAEBABA@@@AACACCEC@A@@DC@@@ADA@ACEAAA@A@C@MAECA@@AAABC@ACCB@@ADCTKDCBA@E@EAAGECCAC@CC@AAACCAAAC@@@A@A@EBA@C@A@ACAAAA@@AA@ACAGDA@A@@@E@A@@AC@CAAAAA@A@C@EBEDGFCDAK@A@CDCBABABEAC@C@A@ABC@AAA@CACAAAB@BC@ABCBABC@CB@BABADCFAB@DADABCBCDCBA@ALAHA@AFA@ABCCG]YAAC@ABA@A@ABA@@B@F@FCBIBKA@@CC@C@@DCFG@AAAC@CBC@A@CA@@@C@O@AA@A@@BCJA@A@C@AACA@A@AA@BABAJEBA@@@AAAA@G@A@ABABE@KFA@A@C@A@A@C@@@@A@AA@@B@@@BADAD@@@@AB@@C@ABA@A@C@A@ABCAA@A@CA@@@ACCA@B@BCBCAC@@AC@A@ABABAB@DAA@@@A@A@A@A@@@@@AACAA@CB@B"]],encodeOffsets:[[[110032,32605]],[[109964,32434]],[[110062,32620]],[[109928,32434]],[[110058,32627]],[[110031,32618]]]}},{type:"Feature",id:"511922",properties:{name:"南江县",cp:[106.828697,32.346589],childNum:10},geometry:{type:"MultiPolygon",coordinates:[["@@@A@@B@@@A@@@A@@@@@BB@@@@@@"],["@@@@@@ABB@@@@@B@@@@@BAA@BC@@@@@@ABAB"],["@@@@@A@AA@@@@B@@@@@BB@"],["@@@B@A@@@@"],["@@KDDDBADA@@B@@A@@@@@A"],["@@@@CBC@NL@@@A@@A@@@@@@A@@@@B@@@@@@@@B@@@BHMA@@B@@@@@A@@@@A@@@BBA@@@@B@A@@A@@@A@@B@@@@B@@@@@@@AB@B@@@@@@AA@@@@@A@@@@@@CC"],["@@@@B@@@A@"],["@@A@@@A@@B@BDA@@@@@@@A"],["@@MKHLB@D@"],["@@@@A@@AAEAC@AA@@AA@@@@@@@IA@A@A@CFIBA@C@CAAEAGCGAA@AAC@A@C@A@EBA@@BA@AD@BAD@@@BA@AB@@E@A@CAA@A@A@AB@BB@BDBB@BABA@GDA@AB@@@BBDDH@B@@@@ABB@@@@@@@@@@B@@@@@@A@BA@@A@@@A@@@AA@@A@@B@@@@@@@@@@@@@@@@CBDDDH@@@BAB@BA@ABA@CDABABAJAHILA@EDOFABA@CDCBCBEFABCDADKPKLAA@@@A@CBCGN@@@@@@@@@@@@C@@@B@@@@BABA@@@@@A@@@AA@@B@BAGKEC@@MAEAA@ECGACBE@E@A@ECCCABCAG@CBEDCD@@CFADADCHCBC@CH@@EHGHEFEFGJAD@BDBB@D@BADABAD@FABBB@D@DFB@@BDAD@DADAFCJABBBFDFAHBDDDDDDFBLB@@B@HBB@@BBDBFAD@DAD@@D@BBBBHBF@J@D@B@BCBA@C@@BAB@D@F@@@BAB@BABBFBFAB@F@B@BB@B@HAP@D@D@BB@BCFED@D@B@D@BBD@DBB@BBBFBFDHJDFDDB@B@B@DALEFABAD@HBDBFDDD@B@BAD@BABE@ABG@ABA@CBCDABCDEBABAB@B@B@D@DBB@BBFBBF@BBD@J@D@DB@B@JBDDDJHNF@D@DAPAFALCN@F@DBD@DBFADKJABAD@DFLBF@F@F@BAFBD@JFd@@BHBDFBHDHBJDNBJBDDF@D@LBLBNBRDLBJDB@F@HAHBLBPDD@DBBDDFDFBD@B@DBB@BBJ@D@BBBB@FCB@B@BBBDBBD@@@@@BE@@BA@CAAAB@A@AB@B@@AD@DBFCBAD@BBHBDBFADANDBBBBBL@J@DEDC@CD@BDDDFBFHABBD@B@D@D@DCFAL@DALCFCBAB@@@BAB@BB@BAFBBB@FBB@DDBDJ@L@BBBB@@ADAFABCDABC@CBA@A@EBAD@B@B@B@FBB@@DA@DB@BB@BBBA@C@A@@AA@CAA@AC@EAAA@@@BB@@ABAB@@CBA@@DCBA@CDCBAACCAAC@@@DCCAAAEB@B@DAB@@A@@@BB@BC@@@@@BDBD@@@BABAB@BEHEHOJGDEDCBA@C@AAIAGACAA@CBABCF@B@DDHJJBB@DAB@DABCDAB@JBF@B@B@F@D@DBB@B@B@FAB@B@B@BBBAB@B@B@BDD@B@@C@A@@@@@@D@@BB@@D@@@BB@FBB@BA@AFB@BB@@A@ABA@B@ABEAE@E@@BADB@@@AD@B@BDD@DBFC@AB@@@@@DAH@FBHBDBFBBDBBB@BADAFEHCDEDCBADAB@BDLBF@BCDADABUJEFGFGBABCDAD@B@B@BBDHHBDFF@D@B@B@BCDA@@BAB@BBN@D@J@D@B@DBBBDBD@B@BCBCD@F@B@DFHBD@FAF@HERCF@DABBJALBBDBDFDFBD@FCRADCBADEBCDAFEHCJ@F@F@F@F@FADAD@F@B@F@N@H@JAHHDB@PAFBJBFBNHFFDFJHDFDDNDJDVPHHJFHDPBBDBDDFBBBBDAFAHALAV@LBJ@H@NBDADA@C@AEIAABE@EBC@C@EAE@CBG@CBAACBCDABA@@B@DD@@BD@B@B@@BD@BBB@@@B@H@BD@B@FAB@BBH@H@JAJCBCFADAD@DBDFDBBBF@F@BBFBDDBFDFDBNDN@HBNBDADEB@H@DAFAD@LFDBFAHABA@A@G@@@CDCDCFEDEFAD@HFLBZBFBDBDDBFBBDBFAD@D@F@HBLAFCTEF@F@BDDFFBBBJBF@DBBBD@H@D@DCB@D@F@D@D@BABADIFABAF@HDH@D@DADAFBB@FBDAH@FA@ABAAAAEACAG@ECEAM@GBIDCDEHCHAHBPCFAFEDEFGDIDE@KAEAG@G@E@EFIBKJOBGBGCOCGEMES@IEQEMIEGGEEDC@IBEDEFEHAHEDEHAFCDIHIBEDGB@DEHKBEDEBCFAAGAAC@@@@A@@@AC@AA@@BEAC@CBCGACCEAA@B@AGAGACCEAA@AAAAAA@CBA@EAE@ABABC@A@A@C@C@EAAAA@ABGBC@A@A@C@ABC@C@A@@A@@BC@AAACECGAA@A@A@A@E@EACACAAAC@AAOBACA@ABA@@@C@@AAA@AAA@AAC@AAA@BADAB@@ABAAA@AC@AABC@EBCD@FAFBFCH@F@FAAC@I@@CAAAAA@AB@H@DABABADCDEAAGCBCD@@EBA@@ECCAC@@@@ABEAOE@AADA@AEC@A@@BAFCFEFCAA@A@CAA@@AB@BA@AAAAAEBGBC@EDABAFCD@BAB@B@DBDBBABE@ADDBB@@DABAB@B@BDBADCB@@ABAD@@@ACCC@EACEABEHB@@@A@AA@AA@ABA@@AAAA@CCEA@@@CEAACAA@@@ABA@@@AB@B@@@@AC@A@AB@FB@@@ABADAA@@A@@E@AA@@BA@CC@@AA@@B@BADA@A@@ACAA@C@C@@AAAAC@@CECCAACBGBCCCA@AC@AAAAAAEE@ABAD@@AEAA@@@BC@@@AA@EABADABAFCFAB@BB@@@BC@@@@B@BB@DAD@@@B@B@@@@AA@AA@BAAA@@@@CA@A@BEDEDAB@BD@D@@B@JCD@B@BABADC@EBA@EDE@ABG@C@A@ABA@A@@BA@AA@@AACEACAA@@ABC@@A@@A@@@AFAD@BBB@@ABA@@@A@@AAA@A@@A@@A@A@@BA@ABA@@@A@@@AAA@AAA@@@A@@AAA@ACAEE@CACCE@A@A@ABABAFA@ABAECGAECCA@C@CBEEIAC@@CB@@A@CAAAACAACCAEDAAEDED@@A@AACEG@@@A@A@A@@A@@@AA@AAA@A@@@AB@@@@A@@@A@@@@@A@B@@C@@BABGAG@A@A@C@EACACAC@C@CBA@A@A@GEAABE@ACAIAGDA@A@@GAC@C@@@@ABA@A@@ADADABA@ACC@CAIBC@KAEBABA@CDIFIDC@@@AAA@ADBB@B@B@@@AA@@CA@A@AA@CA@BAAA@@@ABCB@A@CAA@@AA@@@B@C@@EBA@@@@@A@A@A@AB@B@@A@AAAAAAA@@AE@A@E@AA@@@@BB@@A@C@BA@@BA@AB@@A@@FEB@@@B@B@@A@@BAB@@@B@BABBBA@@B@@ABA@A@A@AEE@@@BC@@@AB@@ABA@AB@@A@ABA@@B@@C@AB@@C@CA@@ECA@@@CAAAAA@BA@C@A@@@ACA@AAAA@A@AAC@@A@A@CAAEAC@@AAA@EBCBA@ECCCA@CC@@A@A@AAECCC@A@AA@@C@A@AACAA@@AAA@@AA@@@AB@@A@C@A@A@AACAA@A@@@ACA@A@@@ABABA@@@A@@@MHGBIBIBC@A@EBUBG@M@GAC@GACAKAKAMEEAQMECICMEI@IDIDIBABC@C@EAGAGAEGICGCI@KAGDABIHIJGFK@EAICGCMGICUKIEIEEECCCGGKCECIAEACCK@C@I@GDQ@M@@CEAECEAE@GACCAA@C@ABCBA@"]],encodeOffsets:[[[109142,32667]],[[109213,32675]],[[109090,32714]],[[109086,32720]],[[109089,32712]],[[109083,32714]],[[109145,32658]],[[109142,32657]],[[109086,32721]],[[109193,32660]]]}},{type:"Feature",id:"511923",properties:{name:"平昌县",cp:[107.104008,31.560874],childNum:7},geometry:{type:"MultiPolygon",coordinates:[["@@BADGAACL"],["@@@B@@ABA@@@A@A@A@G@CBA@ABAB@B@BBD@@BDADADA@B@DD@B@@DBB@B@DBBAB@D@B@B@BAD@@@BA@@@@BCBC@A@@@ABA@AAA@A@A@@CGA@AA@A@@A@@@A@"],["@@@@AAA@@B@@@B@@@@@BB@@@BA@A@@"],["@@@A@@A@AB@@AAA@@@@@BD@@@@@BBAD@@@B@AA"],["@@@A@A@@CBBB@@B@"],["@@A@@@A@@@AA@@@A@@AABA@A@A@A@@B@@@@B@@@@@@B@@A@A@@@@D@BBDB@@BABABAAA@@CCACAA@A@AACHDDBB@AC@CB@B@B@FBFBD@DAD@@CAA@AEBAACACAE@CAAC@E@CDC@A@AAAACAA@A@CAAAC@AAE@A@AAC@A@A@ECCCACAADADABCD@D@BADBBCDA@CBEBCBCBE@AAECEACAEACAGAG@A@A@A@AAAAAAA@CBCDABE@AH@D@FBFBD@B@DGBODCDDD@DGJED@DADBDFDFHBDFLDDH@DBBBDH@BF@D@DI@CDGDAPED@BB@BAL@BBBDBBAB@BCDCD@@@B@@B@BBD@DFFJDJHH@F@D@BCBABG@ADAB@BAB@@AII@@"],["@@@BA@@@@@A@@@@@@@@@@@AB@@B@@B@@A@@B@B@@@B@@A@@@@@JJ@A@@@@BE@AB@@@B@B@FDDBHABBDAD@HBJAB@BBAB@DBF@D@D@B@B@B@BA@A@A@C@A@AB@@@B@B@@D@B@B@D@B@B@LEF@BABAB@H@B@BB@B@@AB@@B@BAF@FCDALCDALCLCJGFCFAHBF@HGFEHAPAB@DC@@B@@A@GBABABEBC@ACGCEECECCA@ABCAA@GACCECGAC@C@E@AAG@ECOACCAAAGCEAA@GAAAAAC@AAA@@AAC@C@ABA@@DIBGDGBCCECCIAAACA@ABADCDA@C@C@AC@ABABABABABCBE@@@@BAJABCBA@A@A@CACAC@A@GAC@CAACBC@AA@A@A@ABADABA@AACC@@AAEEBAB@DBDF@@B@BABC@AAAAAAABA@ADCNE@A@I@C@MDC@AB@FCJCBCFIBAB@B@DDD@DBDBD@DABABACCBE@GCKAIBABEBCCAACA@AAAAAACCCI@EAAAE@AAAA@GACAA@AAACACAACAGA@AA@@AAAACAAAE@A@A@EDM@CACCEECAAA@KBA@A@A@@@A@A@AAA@E@CACAEGAAEA@AAA@AAAC@CAA@@BAAA@@AA@@@ABABEFEFC@GACCAC@@AC@C@GCAAC@C@CBA@@A@A@CDA@A@E@@@AA@AAAA@A@A@A@@A@@@AA@EBAA@A@AA@C@AB@BAB@@C@@A@CDE@AAAA@@@@A@@AAAAE@C@A@AB@D@B@B@DCBC@E@CAAAIKACAAA@AAAAAA@@AD@BA@@@AABECECACACBG@A@AA@AAC@CCBCBAB@DADABC@AACCACAABCFE@C@C@AA@A@ABEDA@ABA@A@@AAA@@A@C@AAA@@ABABA@@AA@@A@A@CBA@A@@@AA@AAA@C@ABEBGBEAC@AA@AEAGAE@A@C@AACAAAAACAAEAA@C@EACAA@C@EBE@G@A@E@C@A@C@EBAA@@CAACAC@C@C@GAEAECCAACGAAAAAC@G@ACECECCCCCAA@A@C@AA@BCBCFABADABAHABABA@CDA@ABEFABC@EAAC@A@CFG@A@AAAAAAAC@EAGABCBE@C@CACAE@ACCAACAAAGAIEGDGBE@CBA@E@C@A@G@GAG@A@ABCAAACG@A@ECCCECACCAAAA@GAKCACA@A@ACEEIEGIK@EBABADCDAAI@G@E@EAGCEECGCE@A@EAAAAC@ABAAACAMGC@CACBIBEDA@A@ICC@AB@BDDAB@BABA@@@CDAB@BBBAB@BABI@ABA@ADAB@B@BBB@DABC@AB@B@BBBBBABADABCB@@A@ABADCBA@AA@CCQ@CAAC@C@ABADABABAAEACA@AACEGAACCCCAA@A@A@CBA@AFAFCB@@C@@@C@Q@ACAA@@@CDCBAA@@GECAIAACAC@CNQ@A@C@@A@@@@AAA@@BC@G@CC@@@C@KDABCB@@ABABABAB@@@@CB@@@@CBA@CAA@@AAA@ACE@@@ABA@@@A@A@@B@@A@AAA@AAC@AA@AA@@CAAA@@KCAAAA@A@ADEBCBGGCAACECC@@@@@BGB@@A@AAA@@CIWAAA@A@ABCDADA@CBA@AAECA@@@CBAA@DIFGHEHA@C@C@@BBB@@BB@@@B@@@@@@@B@@@@@AA@@@A@@A@@@@A@@AAA@BABABADBBHJJHXZBBABABCBA@AAA@AAAACAAA@@@@ECCAC@ABC@CHARCBEFCD@DBDDBBFDPBDBBDDB@B@BB@B@B@BBD@B@D@D@@BBC@CBE@AB@ACB@@ABA@ADA@A@A@ADAD@@@B@D@FABCDGJCHAFABA@EBCBAB@BBBFD@BEB@BMBEBCDADE@C@AAC@A@@BABADABADABA@AAACCCA@AACBEBAD@D@D@DABADCBC@G@ABDH@BBBBBPLDBFJDB@BABABODCBAB@BAB@B@BBJ@@@BA@ABEDA@@@GCAA@DENADADCDABA@CCICC@A@@@CDAD@@@BBDB@DBF@B@@BADAB@B@BBB@BAB@B@@DBB@B@B@@@@@AB@D@@ABCDCB@@A@@@@P@B@DDB@BBBAB@BAB@B@BAF@DBBA@@F@B@B@B@@DBFDD@B@D@BAD@B@B@@@B@@F@BBD@@BBB@F@DBHDHF@BCHABA@@A@G@AC@AAA@AB@DBR@DBDBDPFD@JEF@DBD@BADAB@BBJHDDBB@BBH@BBBFDBBJBD@BABCBCBCB@D@DBDBDBDBFDD@BBADCHAB@BBB@FABCJAFAFAFEDEFAF@BFJFD@BBBAHBD@D@D@BAB@B@BDHBB@B@BAD@BBBBD@BBDABAD@BB@B@BBBD@B@@DBBAFAB@B@@DABAF@F@DBHBF@@BH@DABADCBCBCCC@EAA@CBEBA@A@@D@D@F@BBB@DABAB@D@@BBFDD@@@BB@@@D@@EBC@C@A@A@ABCB@@C@K@C@AAAAABA@CDAB@@DJ@B@B@B@D@B@D@B@@B@@@BG@C@@BA@@B@JAD@BB@@EFA@@BB@@@B@B@B@BBDBB@D@B@@B@B@BABABBBB@B@HFDBFDTAH@DBBB@BADAD@BAB@DBB@B@BGBAFABA@ECCAA@C@C@ABADAB@JADABAB@@FDB@@BBB@D@B@BB@BBB@DB@BDD@BBFD@F@D@BB@BA@ABC@A@@BB@BF@@BBBB@BD@@@BAB@@AEAA@@ADCBA@@BAD@DAB@B@@BBB@@A@C@AB@@@BB@BBFBB@@@@A@A@@BA@BB@BBFL@FB@ADABABA@@A@G@ACACAE@C@C@AD@B@B@D@BAFAFAD@B@BDFDDBB@D@B@B@BFBBABABE@EBAB@FAB@FABAJ@DBFDHBB@F@J@HAJCH@FBFDD@HCRGDAHCF@BBBDBBBBDBD@FAF@DBBF@@FHBB@NBB@@B@BBB@@B@@EF@@@B@@@@B@NCB@@ABA@A@A@CBE@A@A@AAC@AA@D@BAB@DAF@D@B@D@HDBBD@JBD@F@FCF@@DADEFKL@B@BF@DBD@DAF@B@DAD@BBB@@D@@@BEBCD@@BBNBH@D@D@DDFF@B@BAD@DAB@D@@BBH@DBA@@BCBGBE@A@C@ABAFABAB@@A@BBBBBBBBD@DBD@B@B@LCB@JCF@D@B@BD@DDN@FDDDBDBDBBABABADABAF@B@BAB@DADCDA@A@ABA@@B@J@B@B@DBB@BAFADAJKDEBEBA@A@ECEA@CCGCA@E@OFC@GAAAECC@CBC@@ABCBABABAB@FBDBDBB@B@@A@AAMACAA@C@@BA@CBABADABE@ABC@A@A@A@AA@AC@A@A@ADEBADEDGDGDIDEBCDCDEDABCD@BADC@EBAB@DABAB@DBB@@@B@BADAD@H@B@D@B@@@B@B@B@F@DBBAD@B@@A@A@ABA@AB@@ADAD@B@B@BAD@BABA@@@@BCBC@ABA@A@A@AAAAC@AA@AA@@A@AAC@AACA@A@A@ABAB@FADAD@BADCBA@A@A@@@C@AAA@A@ABGBABA@G@CBC@ABABAFEB@@@B@BB@BB@B@@@D@BBB@DBBB@@BB@@BH@H@BB@BBD@F@DAF@B@DBBEBCAEBEBEDCFAFEHABAB@HBBBH@BBFAD@D@FABABEBEBGDEFCF@@BB@@@B@B@@@DBPNLNBF@BDBB@J@B@JAJAD@D@BBBDBFBBBDDDB@B@B@DKAAAB@AA@@@@A@@@A@@@ABA@C@AB@D@B@@BDB@@AB@BB@@@@B@@@B@B@@@B@@@@@B@@@BBB@B@B@@@@AA@@AB@@@@A@A@@AA@@@BA@A@A@@CHB@BBB@@@BB@@BAB@@@@@B@@@DHBDBDB@DBFAF@D@@@B@D@D@FAF@@@F@B@D@FFBD@BADA@EBMBCBABCHCBEBEDCFEDA@CDGHOLAFAD@D@FDDDDFDDBB@@B@D@BABAD@BCBMDA@@BA@@DADABB@DBBB@@@B@BC@GBEBAF@DDDDBFDJ@HBF@FAHCDA@A@E@A@A@ADADBBB@@AB@D@BDBL@B@@@ABC@E@A@A@A@A@ABADABDDBDB@BB@DB@DBBAFAJCBAFBBB@D@BMJ@BAD@BABH@HBDBFBDBFBFDBBF@DADAFADAB@DCAABC@A@CDCBABCBCDBDBDD@F@B@BBD@B@BBF@BBDBB@D@BBBBDBB@B@BCD@D@FBDDBF@DBDBBBFA@BBB@DC@CBC@EAEAA@A@A@@DBDA@CAGCBD@B@BBBBDDD@@BBABABAB"]],encodeOffsets:[[[109958,32443]],[[110051,32616]],[[110062,32620]],[[109927,32436]],[[109515,32016]],[[110031,32609]],[[110035,32603]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("达州",{type:"FeatureCollection",features:[{type:"Feature",id:"511702",properties:{name:"通川区",cp:[107.504928,31.214715],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@C@ABA@CDCDABABA@K@C@@AAA@A@@@ADC@@@ABC@A@A@CACCCGGEECCCCA@GACAIBG@C@AC@ABCBEAACECAACCAAEACACCCGAED@B@@BDDFFFHH@BBF@BCDCFAB@B@BA@CBCB@@CBAB@@A@A@ABAA@@C@EC@@KSGCC@CDGVCFEBC@EAGAG@IDCDCBA@AFBDBFDFBJBHFLFJFHDJBHFJDJBFBB@BBFBBBB@@BHBH@HBHAJEHMJABEBMFGFCDI@CCICIEEEEEEGAGCGCCGCE@EBCBC@DJBFABEHCBGBADAFCHA@CF@B@@AH@@BJ@@BDBH@FFHBHAFAFHDBDB@@DCB@DADBF@BDFJLBBLJDFBFDHDFABABADCDABAD@F@DADAFEBIDEHCHCFAN@D@D@DBD@BABCAECSQCCE@G@EAG@EAAC@C@CAAAAC@CDCBA@CAACAC@AC@CBADAFCBAAAAE@E@@C@EBELCDAFCB@NALBDBB@@ABAAGOCECE@@@C@EBA@E@G@G@A@A@EAEAAAEAECEAC@EAE@OAAB@DBR@FC@AAIACDIDKDKDQFIDCBWNQLEDBBDDDBDFDD@F@BDHBBDBBAB@H@HBH@B@D@F@B@DAF@HAHCJFHBBBDBBBDD@BBFBD@D@DAFADHBFBD@BBBBBB@B@BEH@D@BBDFBD@BAFEBAB@DCB@BABABGBABCBADEDA@ABBD@B@B@DBDDDDDFDF@B@HBDBBBBDHBBDDBFBF@H@D@DBDBDDB@@BBFAD@B@D@F@B@H@F@FAD@B@DBFBD@B@FBBBBDBBBBBD@B@D@BBFBHBFB@@BBDAFAHAF@B@DBB@BBB@@B@B@DAB@B@@@BB@@ABAB@BB@BBD@B@@@BB@BB@B@BAB@FCBAB@B@@B@D@DEFADBBBDDDBBD@BABC@CBADADA@DBD@BBBB@H@DADBDBDFAFBB@@B@@ABC@@BBBBBBBA@@B@@A@@BC@@BCBABC@A@@@@B@@AA@AACCEEAA@AAABA@ABC@C@CBA@A@A@CB@BAB@D@D@DDBDDFBBB@DA@@LEB@@A@AC@A@@A@ABADAB@DABAD@BBFFDBD@F@D@B@BBF@B@BBB@@B@BAD@B@BBBBDB@DADADCFCFCD@H@H@DBDBD@DBD@B@B@B@@@B@@AB@@AB@BABCDGRADADCFEBADCHGFAF@HB^TDBDBDEDGFKCE@C@@A@ACCCCEAAAA@@@AB@BAJAHCBAB@@A@@@ACCAC@@@AA@@@A@@A@@@@@ACCCA@CBABCDC@ABE@AAAC@I@AAEACCAA@E@I@E@AECCAECECCACCCACE@C@EDEDG@@FCDEFCHEFCD@H@B@LABADABAB@DBD@BB@DBDD@D@DADBBB@@@BBFDBFBD@FADA@@@A@A@AAACBEBA@AABC@ADABADABBB@@BBBB@@@BA@A@AB@@@F@BAB@B@D@B@@@AA@AAAC@C@AAC@A@AA@AAABA@AB@B@JBB@BAA@AAAA@ABABABA@@@@@A@@@@A@CAA@AA@CAEAACA@A@A@C@A@@CAEACAA@AA@A@GBADC@A@CACCEA@ACAABA@ADCFE@A@CCCCE@CAABABADAF@D@DBBBD@J@FAB@B@DFB@D@FAD@FE@A@CCACEAC@CAG@AAC@IBE@@BAF@H@D@FCB@BC@@@CBCFCBA@@@CCCAAECEC@A@C@CBADCFCDCB@@GBADABAB@DAD@B@BBD@B@BAD@BBD@BBBAFAFEDA@A@EAG@A@AAAAEACAC@C@CBCDAHCBABEBE@EBGAC@ACAAA@CBCBE@AAEAACA@@AA@ABCBCBEAA@CAAA@EEGCCE@C@CABC@EDCBA@A@A@CCGEAE@A@A@A@@A@A@CFCBEBIBA@A@CA@CEIAAECECMAKAA@QDIDGHAP@HDDDDBBBD@DCHAJFR@HBB@HBD@FAHAFABA@A@CBA@@@ABC@A@AA@AACAAABABCBA@C@A@CACC@CAAA@AA@C@AAC@AACAAAAA@EECA@ACA@@@@CAAAA@@@ECC@@@"],encodeOffsets:[[110083,31956]]}},{type:"Feature",id:"511723",properties:{name:"开江县",cp:[107.868736,31.082986],childNum:1},geometry:{type:"Polygon",coordinates:["@@BAD@F@B@B@BADEBA@A@ACEAIAE@C@ABCBABAB@B@B@DBD@B@D@FDB@@@BBD@FBDBBBF@DBJDDBDBD@DBFBFB@@BAB@@A@A@CCCAAC@GAA@@A@C@@BA@AB@D@H@HBBABA@ABA@@BA@@@@@A@AB@@A@A@@@@BA@@@@@A@@B@@@BA@@@@@A@@B@@A@@@A@@@A@@BAA@@@BAAA@CCGCM@ACA@AABEBA@@@EGKIAEAA@A@AB@D@B@BA@@ECQMGGCC@AAABADE@ABC@AAAA@@@A@AAA@@@A@CBA@AAABC@A@EC@@AAAAA@CC@@A@@CA@@AAA@ABA@ABC@A@A@@@@AAC@A@CCACCC@A@ABCAAAAGCAAEAA@ABADA@@@CCCEAA@A@ABADA@ABEBE@CBADCB@BAF@BA@A@@@ADA@A@@@C@ABC@AB@@A@AAABC@G@C@C@@B@@@@AA@@@@AA@@@@A@@A@AA@@@@@ABA@@@ABAB@D@L@F@J@F@B@DADADABEB@DAFABA@A@AACCCCECA@A@C@@CAC@CAA@AC@@CAAAE@A@CBA@@@AB@@AAA@CA@@EAA@A@A@@AA@A@@EA@@@AAAAA@CAA@@@@A@@AACAA@A@KBCB@A@@AA@AAC@A@@@A@@ACACCC@C@AAA@@@A@@@CACAAAEECAA@A@AA@@C@AA@@A@A@AAAAA@AAAAAAAA@AAA@A@C@A@A@AACBA@A@ABC@AB@@CBA@A@C@A@C@A@A@A@ABC@A@CBA@ABA@A@A@@BAA@@AA@A@A@A@A@C@C@AAA@A@A@AAA@AAAAC@AAA@A@A@E@C@A@A@A@A@A@@@A@AAA@A@AB@@ABA@A@C@ABC@A@A@A@AAA@C@@AABA@@D@B@@@B@@@B@@@@A@A@@@A@A@@BA@@B@@AAA@A@@@CBA@ADCBCB@@A@@B@B@B@B@D@BBB@B@@@B@B@DABCBADABABAB@DAB@B@BB@@B@B@B@D@B@BBB@D@DADAB@BAB@B@B@B@BABA@@@@@A@A@A@AAA@@AA@@A@AAC@A@A@A@AAA@AAA@@AAAAAA@AAA@AAAAA@CAAACAECA@AAA@AAAAA@CAAAAAA@@A@A@C@ABCAA@A@AAAAACAAAA@CACACCCACACAA@A@CACAECCACCA@AAAAAAAACCA@AAAAAAAAAAA@AAAAA@CA@AA@AACACAAAAACA@AA@CAACAAA@AC@CAAACAAAAACCAAACAAAAACA@AA@AAA@AACACAACCACCAAAAA@CAACA@@AAAAAAAAAAAA@@AA@A@A@CBC@C@@@CBA@ABA@@@ABABA@@@ABA@A@C@AAC@C@AACBA@A@A@ABABCBADCBADCBCFEDADC@AB@BA@A@CBA@A@A@ABABCBA@ABA@A@A@AAA@A@AA@@AA@@@@@@@@@@@@A@@@@@@AA@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@A@@B@@@@A@@@@@AAA@@@A@A@@@@@A@@@@BA@@@AB@@A@@@A@@@@@@@@@@@@B@@AB@@@@@@@@@B@@@@@B@@@B@@@@@@@B@@@@@@AB@BABABA@@BC@@@A@AB@@@B@@@@BBB@@@@B@@B@@@@BA@A@@B@B@BB@B@@@@B@@ABA@AAA@@@@B@BB@BBB@@B@@@BCD@@A@@@@@A@@@@BAB@B@D@FBBBDB@@@BBB@@@@B@B@B@@@B@@@BBB@@BBB@@B@@@BA@AB@@AA@@A@AB@@ABABA@@@AAA@@AAD@B@B@B@D@@@B@B@BA@@BA@@@A@A@ABA@@@AB@@ABAB@B@B@B@BABA@ABA@C@CBC@C@A@C@ABC@C@AB@@C@@@A@A@@@AABD@@BB@B@BBB@BBB@BBB@BBB@BBBBDBBB@@BBBDDBBBBBBBB@BDDBBBDBBBB@@@BBB@@BB@BBBBBBB@@@BBBBB@@BBBBBBBB@@BBBBB@BB@@BBBBBB@@BBBB@BBB@@BB@BB@BBBBB@BBBB@@BBBBB@@BBDBB@@BD@BBB@BB@BBBBBBD@BBB@@BB@DBB@BBB@B@B@BBB@B@D@B@BBD@B@BBDBBB@@BBBB@@@BA@ABA@A@A@AB@@@B@@BBB@@@BBB@@B@@BBA@@BA@ABABABC@ABC@ABA@@@@B@@@@D@D@BBD@B@@BB@@@BB@@@B@BB@@DBB@BBB@@@D@FBDDDFBFBHBBBBBBBBBFBHDLDD@BB@DADCDCDCDIDIFEFEBEBMBI@EDAHCDAJADA@CBCAE@GBABADCJ@H@FDHDFBFDDBBAFAH@HBDBFBD@@@BA@AB@BCBGBG@@@@@CBCBAB@BCBABADAB@DBB@@BFBD@BADEBE@C@C@EAG@I@K@EBA@AAAEAGAEEEABCBADCDAD@DABADEDGBQJDFFHDBDFFFDFBBBHBDBBDDB@@BBD@BBBBDFDBBBBBB@BDDDFBBHJFHDFDFBD@@DDHDDBBDBFDDDFFDDDFDFFDFDFDF@@DFDDFBD@BBBBBD@F@F@F@D@BBDDDFDFDD@D@DADAFCFCFEDABEBC@@@A@@@A@ADABADCDCDCFAFCBAHCFCHC@@D@DBFADADABCBCB@B@FCD@D@F@DADADAHCFAHCB@B@BD@F@HBB@DDDBBJDFDD@\\IL@DBBFDFDDD@FCDCBEBCAEAI@A@C@CAC@CD@DBDBFBDDDBDDB@HHHBJBHDRLFBDBD@DBD@FDH@FBDBFBB@@DBBB@BB@@B@B@DBDBFBDBBBBBBDB@"],encodeOffsets:[[110613,31993]]}},{type:"Feature",id:"511703",properties:{name:"达川区",cp:[107.511749,31.196157],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAGAGAEAE@GFCFA@CFEFEFGHGFEFC@A@EGECEAG@G@CBA@ADABE@EBEBCB@BEHCJAFCZCJCHGHAD@B@@DDDDADCFCDCBC@@AA@A@C@ABCBEFA@CBA@DDDFBBHDAHADCF@B@BBBBBLD@@BBDB@@BBB@@BBD@BBB@B@BA@@@@B@B@@AB@B@@DF@BBB@BB@DBB@DA@@@@DA@@@@BABABABA@@DABALCD@@@D@@D@HAD@@BB@B@@B@@@@D@BMR@DBDBDJBDBHF@@BBDADC@@B@DB@B@R@D@@@DA@EDEB@BAB@D@B@BBBDDDDBBFHBD@BDBFBBBBABABCBAD@D@BB@DDR@DBBB@DABCBAB@@@DABABCBAAAAA@A@ABAD@BA@CAA@A@ABABCB@BAJ@BA@ABAAA@ABADC@@B@BA@ABACC@ABAD@JDB@B@FCJADADBD@NHDBBBAB@BBDBBFBB@F@HDFDDFBH@F@F@HBJCBCDABAB@FJLFHFJDF@B@BDBDBBL@HBBFCRKXMDAJCRELCLCJCDCJBBBD@@EAQ@CBAPBF@FBD@FBFDFBBBFBFBB@B@H@H@F@B@FAD@@@F@FDPDBHAB@BA@CAKAMBA@EDCBKDAF@F@DF@F@BBBBDABEBCDAD@@BBDBDDBB@DADCD@BBBB@D@DBDFBH@FBH@F@DDTRFDDBBA@AAC@C@C@CBMDEDGFGJCFABEBC@C@EBCBADCBCBABACECGAECEKIAAIKCE@AAEBC@CDA@CA@ACGCBEBEAGEG@EAGAC@@AI@@BG@@@ADEB@DGBEBCHADAFGBAAECID@DAFAF@HDDDDHBHFHFFFFJFJDDDJ@DCHENEFABANIFGBIAG@GAGAG@@AAAAAE@AAAAECIEIAGCIEGEIEKAGAICEAEACBEB@DADCJCH@HBFBD@FADEHUDCD@HDLT@@FDD@@@BBBAB@B@@@BADA@@DADAB@@A@ABADEDC@AAE@AGGEECEAC@@@AFCHBDDBDBDBFDBBDDBDFBBAFAD@BBDD@H@JADBHBB@DDDDFFHHDDBD@D@B@BAD@B@@CD@B@@@BBB@BD@L@B@BABADCDCB@BAD@B@B@@@D@FD@@B@BBDB@@@@DB@BDBFFB@BBBBBD@BBD@B@DBBB@BB@DDDDBB@D@B@DABABABBBD@BBBB@D@BA@@B@DAB@B@BABEBG@EAC@GAA@GEQBIDG@CACAACCCC@GBOHGJCRCB@LBNBFDFDBBFJ@DDBB@B@JAFADADEB@B@B@@AB@@A@@@AC@CCAA@ABABAF@F@DABA@A@KBCFADABABCBAFCFCFCBCBAFEDE@C@ADEB@B@B@D@FCDABAB@B@DDBDBB@@DA@ABCHKDCFCJCJCHADAFCBABDFFBHDFBH@@DHBDFDDD@@RIHAFCBCBA@CBCDCBCDABAFFBFBHBFBBB@FAL@J@H@FBD@D@F@FABC@AACAE@@AA@CBABCBADA@ABADADA@@@@H@HADA@ABAB@@A@@ACAEAC@GBGBEAACCAECECG@E@GDIBCBAHAF@DBDAB@BCBIDCBGFCJ@NAFAFAFEJEJCDCDCDCBC@CAAC@KCGCEAAAAAAAAAGAEAEACCAC@E@C@@AA@AAA@CA@@A@A@@AA@@A@@AA@C@AAC@C@@@@@@A@@B@BAD@BAD@BABABAB@@AB@AA@@@AA@AA@@A@AA@@@A@@BAB@B@B@BAB@@A@@AAAA@@AACAAAA@C@AAA@C@A@A@AAA@A@A@AAA@CAA@@AA@AAC@AAAAAAA@@AAA@AAC@@AAAC@AA@AAAA@@AAAAA@AAAAA@@AAA@@AA@AAAAA@@AAAAAA@@AAA@AAAA@@AAAAAAAA@@AAAA@A@@AAAAAA@AAA@@AA@A@@AAAAACAACC@AAAAAAAAACCAA@AA@AAACAA@AAA@AAA@AAA@AAA@A@AAA@@AC@@AAAAACAAAA@AAAACAA@AAA@AA@AA@@AAABA@ABA@AB@@AB@@A@A@A@A@A@@@AACCACAACCCCCCAAAACCACAAA@AAAACCAAACAAAAACCA@CA@CEAAAAAAA@@AAAA@@AAAA@@AAAAECC@AAA@@CCA@@AA@AACAA@A@A@AAA@CAC@A@A@A@CBABCBCBA@ABABADCBA@@BAB@D@DBB@B@B@B@BABABC@ABC@CBC@ABC@ABA@ABCBA@C@C@A@C@C@AAA@AA@@@@AA@@@A@@@@@@@A@@A@A@@@ABABADAB@DABA@A@@@A@@@AA@@@@A@A@@@A@@@@@AB@@@@AAAA@@A@@@@@@B@@@BA@@BAAA@@@ABCB@@A@AAAA@A@@CAA@A@@@AB@@@BA@AA@@A@CB@@A@@AAAA@ABC@A@A@A@ABA@A@@@AAAA@@@C@@A@A@A@@@@A@C@AAAA@A@A@A@AB@@AB@BBB@@AB@@CHABABABABABA@@BA@@@AB@@A@@@A@@@FBBBDDB@@BDBBDBBB@BBBB@BBBBB@BBBBB@@BBBBBB@BBBB@@BBBBBBD@@B@BB@BBB@BBD@BBB@DBD@B@BAD@@ABABABABADABABABABABABAB@@CBABA@ABA@@BABC@CD@@AB@BABAB@@CB@AAAAA@@AAAAAAAAAAAAA@AA@@A@A@A@AB@@AA@AABAB@B@@ABC@@@ABA@EBEBCDA@EDGDABABA@A@@ACA@ACA@CA@AA@AACACAAAC@@AC@A@AAA@A@@@AA@@AA@@AA@@@A@AA@@ABA@@@A@A@A@A@@@AAA@@AA@AA@@AA@AAA@AA@@@A@A@@@A@AB@@A@ABABABABA@@BAB@@BB@B@B@BA@@BA@A@@@AB@@AB@B@@@BAB@BA@ABA@A@A@A@A@C@ABAAA@AA@@A@A@@@A@A@C@@BA@@BABA@ABA@A@A@AAC@ABA@ABA@A@C@ABA@@BA@@B@B@BBB@B@B@BADAB@B@BABABE@A@A@AAA@A@@@AB@BABA@@BA@@DABA@AACAA@A@ABCJADA@AA@AEG@AA@CAECC@@@A@AB@BA@A@AIA@A@@@AD@B@B@@@@A@C@CAAACA@@ADADA@@A@C@A@@CB@BAB@BCAAAAAAAA@A@@D@B@BABA@AAAACA@B@B@B@B@@ABA@@B@BA@AA@AAAC@@@A@@@@BB@@D@B@BBBBB@@ABA@ADC@EBEBABCB@@BBDD@B@@A@C@CAC@@@A@CBA@CBA@AAA@AA@@A@ADC@@@C@AAA@CBABA@A@A@AAACA@@AAAA@BB@B@DABA@A@CAA@CBABAB@FBD@BDDBB@DAFADABAD@BFDDBDBDDDF@D@DCBA@C@ECA@AACB@B@@@@@@@@BBB@@@@B@@B@BD@B@@@BBB@BB@BDAB@@AB@@A@A@ABBFDFDDBDADCBIDCDCBCBEBCBCDGHGDEDADAHCBCDAD@FBDHJBFBDADCHEDCB@@ADABBD@B@BAJCBEFEFC@OAKCIAIAE@EBABAB@BBDDHDF@@BDFJBFBFBD@D@H@B@FAFCBC@E@GBAB@@@BDDBB@DAFCHDDBBD@DB@B@B@BBBB@BFD@FB@D@FDF@H@D@D@H@B@DBB@B@B@DCBGB@B@BA@AAE@ABAB@@@BA@BBB@B@B@@@F@DDBBBA@@BAB@BBB@B@D@B@FBF@B@@@DA@BB@BBBDDBDFFBDBB@@FBBAD@BCF@@ABC@C@E@EA@@CBC@A@AAAAAA@C@AA@A@ABADABA@@@CAAA@@@CAA@@CBA@C@C@AB@BAB@@C@A@A@A@A@ADA@@BA@CAA@AB@BCBABA@@AA@CAAACAA@CAACAAAB@N@FADAB@@CBEAEAE@C@C@CCACAC@E@@@@CCCACACCACACCAAAA@CB@@@BADABC@CAAAAAAC@MAC@AAACAG@A@A@"],encodeOffsets:[[109677,31932]]}},{type:"Feature",id:"511722",properties:{name:"宣汉县",cp:[107.72719,31.353835],childNum:1},geometry:{type:"Polygon",coordinates:["@@DCDI@A@@@AA@CAABA@GDEDABA@AAAA@AAAA@A@GBC@EAAAA@@C@CAC@AAACAA@ABGBA@@AC@AACC@ABA@@A@CAA@C@@@CAAAAE@AAC@@AAAAAAE@IAC@EBA@CAA@CAACACAA@EBG@E@AACCCAAABABCDABAFABADADA@CBCBGBE@A@A@@@AAA@CA@@C@CCAACACACCAA@@EC@@AC@@AAAC@@@@BA@A@@@@ACAAAAAA@@A@A@@AA@@AGCE@EACAECCCEGEECCAEAC@ABEBADCBABC@@@@EAIC@@A@A@AAA@CACA@@@A@@BA@@DADA@@@@@@B@@A@@DADAB@BAB@@CBA@CAECKAEAAAAEACAC@G@SDKBC@EAA@A@CBABAAA@A@@AAC@AC@CAA@CC@@@A@ABABA@CAEBA@ADABAD@@ABC@A@CAGAAAECAAACCA@A@CBA@GCCAEECAC@A@EBCBCDA@C@C@KCEAG@E@CBEDA@GBA@A@@ACC@@@@AC@AA@A@K@KBC@G@@@CAACCAC@A@C@@@AB@BABKFIDA@C@IAABAAC@@A@ABA@AECAACAAAA@@AA@@@EACAGAI@QAA@CBAA@@CC@A@G@C@CB@BCDABA@A@@@KACBA@@B@H@B@B@@ABABADAB@BBFD@BBBB@B@B@FADAB@D@BAB@BA@CBC@A@@@G@E@A@AAAGGMICCAA@A@ABCDEBADC@A@Q@C@IAAAA@@ACCEACCGCCCAAAC@CAE@O@GAC@EACACE@A@AAE@AAACCCAA@CAA@@@CD@@E@AACAAACCACAA@ABADABABC@A@@@@@@@@@A@A@@BAA@@@@@@CB@HAPCFADADCBABE@@@CCC@A@@@@DADCBADCBA@AAEGKAC@AA@CA@@MCC@E@AAA@AA@AAE@A@AAAAAA@@@GCCAA@CCCEAAECKCMGOGIA@@@AAC@@AAA@E@A@ECCAECEE@@AC@@@@CAC@A@AA@@@@@A@C@ABC@@@AAACACAICICC@C@EBE@A@A@C@CAACAAACAAA@AAC@E@E@G@C@IAC@ECGCECA@CAIACA@@@@@@@ABAA@ACAAAACAEACACAA@A@@@AAA@AA@CA@EACAEAG@ECC@CAC@CAEAQKGCIAGAGGA@CCCACCEACACAC@@DBD@D@D@BBJBFADAFCDEDC@CCCEAECAK@[JC@ECICAACC@CAA@G@EACA@A@GDEBGDCBCBCBE@C@C@EDA@A@ADADCBCBEBCAC@@@GDEDGDABEDEBCDCDCDABCB@B@B@@@B@@ADAFCBEFEDEDCBCBC@C@ECECCCAC@A@C@E@E@EACAAAAC@EACCCE@@CECECEEEECCCECCECCAEACCAGCCC@@ACCECEEGGIAACECC@AAAAAAAECACAA@AAC@AA@CCAAACAGAACEEECECAEGCE@@CCECACCG@@AGCEAGEEACABEDCBGBIDIDEDCDGLAD@BCB@@AAACCCA@A@ABCBEDC@A@A@A@CF@B@DCFEFABADEDEDEDABADABCBEBAD@L@BABCBE@E@ABAB@BBBDDD@@B@@@BA@@BA@@@@B@B@BBFHFDDB@B@B@DAFCD@BA@D@DDFHDFFB@BB@DBBAFADAD@BBB@@DBBBBF@BAFAD@DBBDB@BBDAH@FAFAFABGDCBAD@D@DBDBDBFBB@B@BBH@F@BCBEFEBABAAC@AAC@ABA@C@AAA@C@CBA@ABCBAB@HA@CDEDCDAB@D@D@BFDFDBBDD@D@@ABEDAD@D@@ADA@EDC@G@E@AB@@AF@JBD@BBH@DBDDFDB@D@BEFC@EBC@A@CEA@A@EBI@C@AACAC@E@CBABABBB@DDFDD@D@BEFCD@BABBBBDB@DFBD@D@BCDAB@H@BBBB@DBFBDB@@@B@D@B@BDBBBBF@DBBB@DBB@@@@@@B@@@@ABABAB@BBBBBB@ABA@IAA@A@@BABBB@BBBB@D@BBD@D@BB@BBB@@A@C@A@A@ABE@@@A@@B@BAB@@A@AA@AA@AACBABCB@BADBBB@FADABB@B@B@B@@CBEBC@EACAAE@A@@AACACBC@C@AC@CAAC@CAA@ABCBABKBA@G@C@EDGFEDCFED@@CHCF@F@DDFDBDDDBFDFDDBFD@B@F@J@FBBDDFBBBJ@D@BB@BAF@BCDADAB@DDBDD@B@@@@@BB@@@B@@B@@BDDD@B@@@BA@ABGDIBABA@@B@@BBBBDFDDBDB@@@@DDFELCHCFCACA]SGAE@EBGHCDABEFCDCBQBCHADABA@@BA@@BA@@@A@A@A@C@CAC@CACAG@G@C@EDEDCDCBCBA@ACAA@A@ABC@A@AA@AAA@E@AAA@C@E@C@CAEEAAC@ABCBA@CBAB@B@BB@D@@B@BA@KF@@CBA@AACEACCCC@C@A@ABA@@D@B@BAB@D@DAD@BABBB@BBBFFDDBBB@@BA@@@@@@BADABAD@@AD@@@BA@@@ABB@BBBDJLBBDBF@D@DA@C@A@A@CBAB@D@F@BBBB@@@B@@B@BB@BCF@D@BD@@@BA@ABAD@B@@B@BBBFAB@@B@@@BB@B@B@B@BB@BBB@@F@B@B@DCB@B@@@AB@D@DBDDB@H@DBD@@BDDDHBD@FEFEBABA@@B@@BB@BB@AB@DBD@BB@BBB@BFBBBFHDBDBF@B@BBB@B@@@B@B@B@LAB@BBFDDFBD@DCN@F@B@BBFBBBDBB@BB@@BHBDBBBBDBDBBB@DBHBB@BB@BBFBB@FDJDDBBBBBBB@BDDBADAFABBJDL@HAFDDBBFFDFDBDDL@FBHAFCD@DADAJ@FBD@DBBDBBAD@BBBB@F@@BD@DBBBBBFBDDBB@DABCFABGBC@C@ABAB@BBBB@B@B@B@@B@@BBB@BDD@B@B@B@@@D@BBBBBDDHBBFDFBF@DAH@DBBBBBB@DDB@FBHDHFJFB@PJDDB@@B@BBDAB@B@BBDFBD@@@DCDGDAB@DC@@HCDCHAFCD@F@D@HCHCFCFABCDEBEBC@@@@B@@A@@@@@@DAJ@H@F@DA@ABADEDCBCF@FADA@CAG@E@EBGBIBGBG@GBGBG@@DCHAF@DCTYJIHEHAHADCFGDCBA@@D@D@BB@@BB@BBB@BAB@BABABADBB@@BBB@B@DBBBB@BDBBF@BBBB@BBDBAB@FAFADAD@@@B@F@F@DBBAB@B@BADABABA@C@CAA@EDCHAH@H@ZJB@DBTFFBBB@@@BABABA@@@ABB@DD@BBBHFDDB@BBB@F@BB@@@BABEBCB@BIVCDEBCBCAEAGAGBGDEH@F@HBJ@B@DBFDFHFH@DAVOJCNAPBDBBBADCD[VADADCHBF@FBHDHDFFDDDBFBHDDDHDHDHBD@B@@@B@B@B@@@DBBDBBB@@@@B@H@@@BBB@BBBDBBBDBHBHBFDD@BDDFFHDB@H@B@@@@BH@HAD@DAHADCLIHAHAHCFADA@@B@F@F@B@DBFLBDBB@@B@BA@@BA@@B@HFD@BBB@@@B@B@F@@A@@B@@A@@@@@C@@@ABG@A@AB@@@BAB@BBBBBBBDDDB@BBDBBDDBDBD@BAD@DAFAB@F@FBD@F@F@D@DBBBBBBDBBB@B@BAB@BAD@D@DABADAD@BBBBDBBDDDBDB@B@DABA@ABCBCBCBCBCDABABADADCBA@A@AAC@AAA@A@CBA@ABC@A@A@AAC@ABCBABADAD@BADAB@DCD@B@D@B@BABA@ABC@CBAAAACA@@@A@A@@ACC@AA@C@A@A@C@CA@@@A@CBA@@BADCFADCFCDAFADABAFCD@BAD@BADAD@D@D@D@DBD@D@DAD@FAD@D@B@DBDBD@D@DAD@B@D@DADAD@DAB@@ABCBADCDABADCDADADADADADABADADADADADADABADABABAD@B@DBBBBBBDBDBDDBBDBDBFBDBDBDDDBBBBF@DBF@DBD@D@DADABADADADA@ADABABABABABCBABCBABAD@D@DAD@D@DBBBDBDBDBF@DBDBF@DBB@BBB@FDFFHDLHJHJLFFDDFJBBFJHLFFB@BBBDDBBBB@D@BAF@BAFADCDAD@D@DBDBBBFDDBDBDBD@DBDADAD@DADABAD@DABAD@@B@B@BBD@B@D@BDDBBBBDDFDB@B@BBFAB@F@D@D@D@FAD@D@F@D@D@DBDBDBBCBAFADAD@D@DBB@BAD@FCBAD@FBD@DBF@BB@AAA@CBA@ABAA@@A@@@AB@BD@@@@B@BABAFADAB@DADABAB@@@@AB@B@BAD@DABABABAB@@@B@DBB@@@BAB@D@D@@@BA@ABA@@BAB@B@H@B@AA@ACAEAAAAACCAAIEMKMIAA@@@ABAFCJABAB@FCBA@A@A@C@AFMBEBKBC@AAC@@@@AAE@A@GCAC@@@A@@BCDAFCBA@@@C@@DAB@D@@@BA@@@E@AFCDAFAHADABA"],encodeOffsets:[[111148,32434]]}},{type:"Feature",id:"511724",properties:{name:"大竹县",cp:[107.204795,30.73641],childNum:13},geometry:{type:"MultiPolygon",coordinates:[["@@@@BB@@@@@@@A@@@@@@A@@@@@A@B@@@@@"],["@@@A@@A@@@@@@@A@@@@B@@@@B@@@@@B@@@@@@@@@@@@@"],["@@A@@@@@@B@@@@@@BB@@@@@@B@@A@@B@@@@@@@@A@@@@A@@@A@@@@@"],["@@B@BC@@@A@@BAA@@@@@@@@@@@@@A@@@@@A@BB@@@@ABAB@@A@@@@@@@@@A@@B@@@B@@@@BA@@@@@@@@@B@@@@@@AB@@@B@@@@@@@@BA@@B@@@@@@@BA@@@A"],["@@ECA@A@CA@AAA@ACAC@CBE@EB@BADBDHJBBDAB@B@BBNE@@@A@@@@A@@@BA@@@@@@B@@B@@@@@@B@BC"],["@@BGQD@B@@@@@@A@A@@@ABV@"],["@@@A@@@@@@A@@@@@@@A@@@@@AB@@@@@@@@@BBA@@@@@@@@B@@B@@B@@@@@@A@@@@A@@@@@@@B@@@@@@@@@@@@@"],["@@@@@@@A@@@@A@@@@@@@@@AB@@A@@@@@@@B@@@@B@@B@@A@@@@@@B@@@"],["@@@@@@@@@B@@@@B@@@@@@@@@@A@@@@B@@A@@@@A@@@@@@@AB"],["@@B@@@@@B@B@@@@@B@@@@@B@@@B@@A@@@@@@@@A@CA@@@AAB@@A@@@A@A@@@A@@BA@@@A@@@@BB@@@B@@@BB@@BA@@"],["@@GAGCAA@ABG@ADIU@CDAB@BA@@@A@AC@AFCBCB@@@B@D@BBRCDGBCBCCEQQAAE@EBCBCBAD@@AD@DADCHKPCFCBC@E@A@AD@@@B@@@@@@@B@@@B@@A@@@@@A@@A@@@AMFBD@B@@@BA@A@C@A@@@ABBD@@@@BJ@D@D@@@BD@FB@B@BABADA@BB@@DADB@@B@BH@B@BB@BBDA@@BA@C@A@A@@B@HBB@@B@@AB@@@@@BHAD@DB@@@@B@@@@@@@@A@@B@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@BB@@@@@@BB@@@BB@@@B@@B@@@@AB@@@B@@@B@@A@@BA@A@@CC@A@@@@@AB@@AB@@@@AB@BAH@@@@@@A@@B@@@B@@@B@@AB@@@B@@@RS"],["@@C@FHDHBF@@@@@@B@@A@@B@@@BB@@@@@B@@@@@@B@@A@@@@AA@A@@B@@@@AB@@@B@@A@@B@@@@A@@@@B@@@B@FE@GBCFKCCAEDMIACC@C@@BABC@@@AA@A@C@AB@B@@ABCBA@I@ABA@@BBBAB@DA@GBABAB@BBDBBBBDBD@B@BBBD@D@D@DAB@B"],["@@@@@B@@@@@@@@A@@@@@AB@@@@A@@B@@@B@@@@@@@@@@@@@@A@AA@GBC@A@A@AACAA@AAAA@AA@@AA@@AA@A@C@A@@@AB@@AB@B@B@@@BABA@@AAA@A@AAA@@@AAAA@A@A@AA@A@A@E@A@C@@@A@A@ABA@@@AA@A@@@AAC@A@ABABC@@BAB@@@BAB@@A@A@A@ABC@ADABABA@@@A@ABA@ABABABAFCBABABABADABCDABCBALC@@@@@A@@@A@A@@@C@@@@@@B@@A@@@AA@@@@A@@@AA@@ABA@@@@@@B@BBB@B@BB@A@@@@@AA@AA@@A@@@@@BC@@@@@A@EA@ACAAA@AA@@@AB@@@@@@@B@@@@@BA@@@@@A@@@@@@@@@@@@@@BAB@B@F@B@B@BABADA@@BA@A@C@A@ABABABA@@@ABAB@@AD@B@BAD@D@B@B@B@B@D@@ABAD@FAB@DABADAB@BABABABABA@AB@BAB@B@BAB@DA@@BAAA@@B@@@@A@A@AAAAAAAAACCAAAA@@AACCAAACECAAACAAAAAAACAAAAAC@A@@AA@A@CAAAA@AAA@@AA@C@@@CAA@AAA@AAAAAAACAAA@@AAAAA@A@A@AA@@AA@@@AA@AAA@A@@AA@AA@A@A@A@A@A@AAAA@@AC@AAA@@AAA@@@ABA@AAA@@CAA@C@EA@AAAACCAECEEEEEEACAACAE@CAAA@ADCDCDCFCDCBADAD@BAAACEA@ACAAAAAAAAAAAAC@AACACACAAACAAAC@ABEBABABCBCBCC@CCC@@AC@AACAACCACA@@@@A@@ACAACCACAA@AAA@A@AAAACA@AA@@CAACAAA@A@@A@@@@@@@AAA@@@A@AA@@AA@A@@@AA@@@@@@AAAA@@@A@@@@@@@A@AAA@ACAA@ACAAAAAA@CAAACAACCAAAA@AAC@@@AAAAA@A@AAA@@@AAAAA@A@AA@@AAA@A@A@A@@@C@@AA@A@AAAACAACCAC@C@C@C@A@A@AAAAAA@AC@ABC@A@A@A@@BA@A@A@A@AAC@AAC@ACC@E@A@A@A@AAA@@AAAA@AAAAA@AA@@@@AAAA@@AAAAAACAACAACAAAAAACAAACCAACA@@A@AACAAACAAAAAIFIHEDE@A@AAECQTB@@@@@B@@@@B@@@B@@@@ABAB@@@@@@AB@@AB@@@@CBA@ABAB@@@@@B@@B@@@@B@@@@AB@@@BA@@B@AA@@@@@@@AB@@@@@@@B@@C@A@@@@@@@A@@@A@@B@@@@A@@@AAAAA@@A@@A@AA@@@@@@CAC@GBBBBBD@BB@B@B@@ABA@CBABA@ABAH@B@@A@AA@AA@C@CBA@@A@@@A@@FC@ADADC@@@A@@C@IAC@CAAAEA@DDDJBCNBFDDELAD@HEF@@BA@B@@@@ABB@@@B@@@@@@BA@@B@@@B@@@@@@@B@@@@A@@B@@@@B@@@@@@@@@AB@BA@@@@@AAA@@@@@A@@@A@@@@@A@@@@@@BA@@@@@AA@@@@@AA@@@AAB@AECGEGQDAD@BBB@BP@BBBBAFBD@@DBJBDBB@@BBB@L@BABABEBEBE@GAIGEAG@E@E@C@ABABEFGH@@@D@B@BBBBB@D@B@B@BA@@@CB@@IACBKFGDCDCF@DBBHBF@DBHFBBB@@BBDBBDBDDBBHLFHDDDDHDB@D@HBHDDDDF@BAD@DABABABCBEBA@@D@@DD@B@D@FABADCD@@ABEB@@CBCDAH@B@@A@A@CA@@A@ABA@A@A@A@AA@@BAFE@ABABA@AAE@AB@@CBC@A@A@@A@@AAA@@AAC@@@AACACAAE@CACCACAEDCDEBI@EBEBCBGHIJABA@ABA@A@C@@@E@GBEB@@A@A@AB@BA@A@@@A@@@AA@@@A@A@A@@@A@@B@@@B@B@B@@@BA@@@@@A@A@A@@@AA@AAC@A@@@A@@@@@CBCBCBADCL@B@@A@A@A@@@@AAA@AC@A@AB@AA@AAA@AA@ABEACAAAACAAA@AAA@@@@@ABA@ABAB@B@@A@@DADA@AB@BA@@DAPADAB@@AFEDCDCDADAD@BAB@NOFM@@BA@@@A@AA@A@A@A@A@A@A@C@@@E@E@CBED@@@BBB@BA@CBAB@BABB@BB@D@BABABAB@@A@CCAAEAA@ABA@@B@@BJAB@@A@A@@@IEA@CAA@C@A@C@CB@@CAG@C@AB@BBBBBBBBBDDB@@BA@@@CAEBA@@@@@@BADAD@@@@CB@B@@@B@@DFBB@BABCHADE@CBC@ABA@A@A@CAA@A@AA@@A@AAA@A@C@A@C@AAC@A@A@A@A@ABA@C@ABABABABABCBKFEDE@A@AACACAG@C@AAAAAA@AA@AA@AAAAA@A@@@AAA@@@AAA@A@A@@@@AA@@AAA@A@A@A@A@@BA@ABA@AA@@A@@A@AA@@AA@AAA@AACA@@CB@BBB@B@D@B@@ADGFADAD@D@@@FBFEPBFBDDBJDDB@BBBAH@BDBD@DAJCNALBJBDDFBBF@BBD@DBDBB@H@F@N@D@B@BBBDBB@BD@DBNBFHX@H@FAB@BDFBBBLBFBBDDBDBJDN@B@D@@BDAB@@@@@FBB@BBBBBBD@BBB@B@BBB@B@D@BBD@DBBBDBD@BBBBDBF@BBB@B@D@B@B@BBD@BBB@B@DBB@B@BBB@BBAB@@@B@B@B@BBB@BBBBDBBBB@BBDBBBBBBBBBBBBD@BBB@BBBBBBDBBBB@BBBBB@BBBBB@BPbBJ@F@FCDEDG@KB@@ABABABABA@@DAB@BBD@BB@BBBB@@BBB@BB@B@@@B@BAB@@@B@BBB@BBBBBB@B@DBB@@@BBB@@BBB@BCB@BAB@@A@BBBB@@BBBBBBB@@BBD@BBBB@BB@@B@BBDD@DBBBBBDBB@@DB@BBB@@FHBBBB@D@BBBBDBD@DBBBBBB@BDBB@BBDBD@BBDBB@BBBB@@@BBB@DBBBD@B@B@BAB@BAB@BAB@B@B@B@B@B@BAB@BABBB@BBB@BB@@B@B@BBB@BB@BD@@@B@BAB@B@B@BBBBBB@BBBB@B@@@BAD@BBB@BBBB@BBB@@BB@BBB@BBBBB@BBBBBB@B@B@D@B@DBB@BAB@D@@@BB@@BB@B@BB@@BB@B@BBB@B@B@DBB@BB@@B@BBBBB@@D@B@BBB@@@BBBB@@BB@BB@B@@BBBBB@BB@@B@B@@AB@B@B@@@B@BBB@DBBBBBDBBBBBDBB@DBDDBBDDDDF@BDB@BBBBBDBBDDDDBBBBBBBDDDBBBFDBBDBBBBB@BBB@BB@B@B@@BB@B@B@BAB@D@F@B@FBF@F@B@DBHDD@FDB@BBDDBAB@B@@@BA@@BAACA@@AAA@A@@@AACA@@@@A@@A@AA@@@@@@@@@ADABBB@FDD@B@DA@C@CCECCCACAEC@ABCBABCBE@CAACC@AAC@EBABADAB@DBB@B@BA@C@AAAB@BB@BB@BDBBB@B@B@BADAB@BBD@@@D@BCB@@@BBB@BBB@DAB@DAB@@@D@DBD@B@@@@ACCAA@@DABAFAFAD@BCB@BA@@AAAA@A@A@CA@@A@@B@@@D@BB@BBBB@@A@AB@BA@@@A@A@A@ADBBBBBB@BA@A@A@CB@B@BBBBBBDB@ABA@ADA@@@B@D@BB@BCBC@@DBBBDBD@B@@@@@@A@ABC@@B@B@BJB@B@@ABAB@@@D@FDDBB@@BFH@BBBB@BCDIBAB@B@DBBBB@BA@CB@@AB@BA@ABA@@B@B@BBB@B@F@BABA@A@ABABC@A@A@AAA@A@A@AB@@AB@BAD@B@B@BAB@BAD@BBB@B@B@BAB@BA@AB@@AD@B@B@@@B@B@@@BBB@BBBAD@B@B@B@B@B@BAB@@ABA@A@@@ABA@@BA@@B@B@@AB@@A@A@AAA@@BA@AB@BABABABAB@@@BAB@@@B@B@@@B@@BBB@BBB@@BBB@@BB@BB@@B@B@B@B@@@B@BA@@BBB@@@B@@BB@@BB@@B@@@BBB@B@BBD@@BDBBBDBD@BBBB@@DDB@BDB@BB@B@BABAHCFCB@DCFAFAB@BA@@D@BA@@@ABABA@BBB@@BAB@B@B@@@BBB@BBBBBBBBBBBB@@BBBB@BDA@@BABA@ABA@@DCD@BA@AB@BAB@BADA@@BABABABABABABABCBABABABA@@BC@A@AAC@CAA@AAC@AAA@AAAA@@@ACAAAA@AA@AA@AAAAAAA@@AAAA@AAAAA@AAAAAA@AAACCA@AA@CCAAEAA@A@@AA@AA@@AA@A@AAAA@C@A@CAC@A@@AA@A@CAEEMGCA@@A@@@A@A@@@BA@ADADABAB@@@B@@BB@B@B@BA@@BC@A@A@@@AA@@AA@AC@AAABC@@@AB@F@B@B@@@AACE@AA@BABAHABA@A@A@@AAA@CBE@CBA@AAA@AA@@BAB@BBBAD@B@@ABC@CA@AA@BA@ABA@CAA@AAAACAC@CAA@AABE@C@A@AA@C@AA@A@A@C@A@@IAC@C@CBA@A@@@AABADA@ABCBABABE@AAAA@A@AE@@@@@@A@@@@@AB@B@@AB@@@@@@A@@@@@A@@@A@@@@@A@@AA@@@@@@@@@A@@@@@@@@@@@AB@@@@A@@@@@@BA@@B@@"]],encodeOffsets:[[[109814,31068]],[[109817,31067]],[[109606,31247]],[[109799,31105]],[[109816,31077]],[[109854,31075]],[[109812,31072]],[[109815,31070]],[[109827,31055]],[[109826,31053]],[[109860,31090]],[[109791,31138]],[[110030,31514]]]}},{type:"Feature",id:"511725",properties:{name:"渠县",cp:[106.97303,30.836618],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@@@@@A@@@A@@@@@@BA@@@@@@@@@A@@B@@@@A@@@@@A@@@@@@AA@@@@@AA@@@@@@A@@@@A@@A@@@@@A@A@@@@@@@A@@@A@@@@@A@@@@@@@A@@@@@A@A@@@@@@@AB@@@@@@@@@@@B@@BB@@@@@@@@@@@@A@@@@@@@A@AAA@@@@@@@AA@@@@@@@@AA@@@A@@A@@@@@@A@@A@@@@@A@@@A@@@@@@AA@@B@@@@@@@@@@@B@@@@@@@@@B@@A@@@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@@AB@@A@@@@@@@@@@@@A@@@@@@@@@BA@@B@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@AB@@@@A@@B@@A@@@@@@@A@A@@@@@@@@@@@@@@@@@@B@@@@B@@B@@@@@@@@@@@@A@@@@@A@@@@@@@@BA@@@@@ABABCD@@AB@@@@@@@@A@@B@@@@AA@@@@@@@@@@AA@@@@@A@@@AA@@@@AC@A@@@@@B@@B@BA@@BA@A@@@@@BA@@@@AA@@@@@@A@@B@@A@A@A@@AA@@B@@AB@@AB@BAB@BB@BB@@BB@@@B@@ABA@AB@@@B@BB@B@@@BB@B@@@B@@@BCB@BA@A@AB@@@AA@@@@@@@A@A@@BA@@@@@A@@@@@AA@@@@@@@@ABA@@@@@@B@B@B@A@@A@@AA@@@@B@@A@@@@@@A@@@@A@@@A@@@A@@@A@@A@AA@AA@@@@@@@@@@A@@@@@@@A@@A@@@@@@A@@BA@@@CBAA@BA@ABABAB@B@@A@@@@@A@@@A@@@AA@@@@A@A@@@A@@@@@A@@BA@ABA@@@CDA@@BAB@@ABA@A@ABAB@BADAB@BA@AB@@@B@B@BB@@@@@@@B@@@@BB@@@@@@@B@@@@B@@@@@@@@@@@B@@A@@@@@@@A@@@A@GBA@@@A@@@A@@@@@@@AB@@@@@@ABA@@@@@A@@@@BA@@@@@A@@@@@A@@@@@@@@BA@@@@B@@A@@@@@E@CAC@AAA@ABBBBD@B@B@BBD@B@BABADABCDC@CB@@A@@@@@A@@@@@@@@@A@@@@AA@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@B@@A@@@@@@@A@@AA@@@@BA@@@@@@@@@@@AB@@@@AD@@@@@B@@A@@@@@@B@@A@@@@@A@@@A@AB@@@@A@@@@@A@@@@BA@@@ABCDA@@B@@@@A@@@@@@@A@@@@@A@@@@@A@A@E@@AA@@@@@A@@@@@@@AB@@@@@@@@@B@@A@@B@@@@@@A@@@@@@@A@@@A@@@@@A@@B@@A@@@@@@B@@@@@@@@@BCD@B@@@@@B@@@@@@@B@@@@@@@@@B@@BBDD@@@BB@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@BA@@@@@@@A@@@@@A@AB@@A@@@@@A@@@@@A@A@@@@@A@@@@@@@A@@@@B@@@@A@@@@@@AA@@@@@@@A@@@A@@A@@@@@@A@@@@@@A@@A@@A@@@@@@A@@@@@@@A@@@@@@BA@@@@@@@@BA@@BADAD@@ABCFCF@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@BB@@@@@@@@BB@@@@@@@@B@@@@@@@B@@@@@BAB@@@B@@@@A@@B@@@@@@A@@@@@@@A@@@A@A@A@@@@@A@@@@@A@@@@@@@A@@@AB@@@@@@A@@@@@AB@@A@I@ABC@A@A@@@@@A@@@@@@@A@@@@@A@@AA@GEA@@@@AA@@@@@@@A@@@@@A@@@A@@@@@@@@@EBA@@@@@A@@@@@A@@@@@@@A@@A@@CA@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@BA@@B@@A@@@@@@B@@@@AB@@AFA@@@@B@@@@A@@B@@@@A@@@@@A@@@A@@@@F@@@B@@A@A@@@@B@@@D@B@BDBDDBB@BBJ@FABCFA@@D@B@@BDBFDDFBFBBBDD@F@@BD@D@BAFADADCDABCFCBEDCFEBCDCBAB@D@B@BBB@BBBBBBDBBBBBBF@BBD@BAD@DABAD@D@D@@@D@BAB@DA@ABABAB@B@B@B@B@B@B@D@@BBB@BB@@BBDBD@BBDB@BBDBBB@@@B@B@@@D@BBB@BBB@DBB@@BB@BBFBDD@DBDAB@B@B@BBB@BBB@DBBBB@B@@B@B@B@@@@@BDB@@@@@B@F@BABAB@@ABABABADCDABABA@@D@BB@B@D@BBF@F@DBD@F@F@HCD@D@D@B@BADCDAHCB@F@FBFBDBBBBF@B@D@B@B@@@BA@@B@@AB@@@@@B@@@@BBB@D@@@BB@@@@AB@BA@@@A@A@@B@@@B@B@@BB@@@@@@B@@B@@@D@@@@@B@@B@@@B@@@@@@AB@H@@@@@BB@@B@@B@B@@B@@@BA@@B@@@@@@@B@@@@A@@@@AA@@@BA@@@A@@A@AB@@A@@B@B@F@BB@@@B@B@B@@@BB@@BDBH@DDBD@DBFDBDBDB@B@BAB@D@D@FBDBDDH@DBDBBDDBDBDBDDBDB@B@B@BAD@@@BA@@DAB@B@@BBB@B@DBDDDJDDDDHDFBJDF@DBBBFDDFHHDDF@DBFA@@F@DBLFF@D@DDHF@@BDBF@DBFDFBBDDFBH@FBHBDBD@BAB@D@B@BBFJ@B@B@DCDADAB@@@@@B@J@DB@B@HAB@BB@@AFA@@@@F@BDB@BDBFDDD@BAF@DBFDBBBBDBD@B@BADEFGFEFCD@BADBJBF@FAF@@AFFF@F@F@FDBDBFBDFDF@@@DBF@@B@B@@@@ABEBAD@B@@D@T@BBB@@BDBD@DDF@B@BAB@B@@AD@D@@BBBDBBBBDA@@B@FDBBB@DAB@BCDCBAB@B@BBJX@DB@BBB@@@HA@A@@@@B@DAB@FEDABAD@B@B@@BD@DADCDEBCCCCC@@@ABCHGDGDIDYBEDIFG@ADAFAFAF@BABCB@DAH@H@FBFDFHB@D@FEHEHGFEFEDEB@DEHEF@FBHBHBDBB@B@H@DBBB@BBD@NBDBBBBDBD@BABC@A@@DAB@BBDBBDBDDDDBDBDD@@@@@FBDBDDDD@D@F@FBFBDA@@BABC@E@MBABBBDDBB@DBBBDBB@@BB@BADA@ABAB@DBB@@AB@BCB@B@B@B@D@@@BA@ABAD@D@B@DA@@BB@D@@BBDB@@B@BABCBAB@B@@B@DBBBBBBB@D@DA@@FBF@D@D@BA@@DE@ABCAA@EA@CAEACECAACAAA@@ACB@@A@E@EAA@C@A@A@AAA@AB@@ABAACCE@@@A@A@A@AAB@@A@@BABAF@BBB@@A@AHADA@C@A@AAA@C@A@G@C@C@GCE@E@CEAC@AEA@AA@A@A@ACAC@AACCDGBE@CAACC@A@@BAHAF@D@DABE@E@A@G@CACAEAEEIAC@@CECGAC@ABABAFAF@JBJBLDPBD@FEFEDABI@A@AACBABC@@DAFCDGBCACAEGIAC@EBCDCDABGBCFCHCHGDCDAFADADADCJCDABCACCCCEAECCAAA@ECC@GCCAA@E@E@EAA@E@C@A@ABA@A@A@@AA@A@A@@AAA@AAAAACAAAECAACACCAAAAAACACCACCAAAAA@ACA@ACECCACCAAC@CAAACAAAAACAAAA@CAA@A@A@@@A@ABA@@@A@AA@@AAAAA@AA@A@@AAA@@AAAA@@A@AAA@C@@@AAAA@A@AA@@AAA@C@A@AAA@A@AAA@@AAA@A@@AA@@A@@@CBA@AAA@C@A@C@A@AAAAAAAA@AAAAA@AAA@@AA@AAA@AA@AAA@ABC@A@@@AAAAAA@AAAA@A@A@ABA@A@A@@ACA@@AAA@A@A@AA@@AAA@AAABA@ABA@A@A@A@A@A@ABA@ABA@ABA@A@A@AACAA@CAA@A@@AAAAA@CAAAC@CAAAA@CA@AAAAAAA@CACACAA@A@CAAAAEG@@AA@ACA@@AAACAAAA@CCCAAA@@@AAA@AA@AAC@AA@AAAAAA@@AAAAB@@@BA@ADA@AAA@AA@AA@@A@CAA@A@AAAA@AAA@A@A@@BA@A@A@@@AAAA@AA@@AAAAA@@AAC@ABA@CB@BABABABA@@LAH@FCDC@E@EAIOa@AAAAA@AAAAA@AAAAAACAAAA@AAA@AACAAAAAAAAAACAAAA@AACAAAAAA@AAA@A@A@@@A@AB@AAA@A@AAA@C@AAA@AAC@A@A@A@C@AAA@AAEACAA@AACACAA@CAC@A@C@AAA@A@AAA@AACAAAA@AAA@E@@@@BAAC@@@C@@EAA@A@@A@@@@A@@@@@A@@@@BA@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@A@@@@@AB@@@@@@A@@@@@AB@@@B@@A@@@@@@@A@@@@@@@A@@@A@CA@@A@@@@@A@@@@@@AA@A@@ACAA@@A@@A@@@@@A@@@@@@@A@@@@@@@G@A@@@A@@AA@@@@@A@@@A@@AAA@@A@AA@@@@@@@@AA@@@@A@@@@@A@@@CBA@@@A@@@@@@@A@@@@B@@A@A@C@A@CAA@A@ABABADAB@BA@CAA@C@ABCDABCBAAAAA@A@@CA@A@A@@@@@@A@AA@AA@A@@@A@@A@ABA@@AA@@A@@AA@@ABCAA@CB@BBB@B@B@@EBCBABAF@@@@@B@@@@BB@@A@@@@@A@@@A@@@@BA@@@A@A@A@@BA@@@AA@@@@@A@@A@A@A@A@@@@@A@A@@AA@@B@@@BA@AB@@A@@BA@@@@@@B@@@@AB@@@@@B@@@@@@@@A@@B@@@@A@@@EBE@EBA@@@A@@@@B@@A@@@@@@@AB@@A@@BA@@@@@@@@@AB@@@BA@AFAPAB@DAHEFGH@@@@AB@@@@@BA@@@@@@B@@CH@B@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@B@@@@B@@@@B@DDFB@@@BB@@@@@B@@@@@@@B@@@B@B@@@B@@@@@B@@@@@@@B@@@@A@@B@BABA@@B@@A@@@@@@B@@A@@@@BADGB@@A@@@@@AB@@@@@@@@@BA@@FCFCB@@A@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@BB@HDB@@@@BB@@@@@@@BB@@DDFD@@BB@@@@@@@@BB@@@@@BDFDFBBBD@B@@B@@@@B@@@@@@"],encodeOffsets:[[109517,31445]]}},{type:"Feature",id:"511781",properties:{name:"万源市",cp:[108.034657,32.081631],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAABABCBC@CACAC@CCA@A@ABEJADIDEDA@@BCD@N@D@J@BMFCD@BABBBBBBB@BADABA@@@CECAA@ABFFBB@@DDBBB@BABCBAB@B@B@@BADBDDBD@HBB@D@DBDBB@B@B@DABABI@A@@F@DABABABABABAD@@B@D@DCBCDAB@BDBBBJBDDDFADCHAHCJ@@AB@B@DBD@BB@BBD@BBBBHBB@FBHDBBDBBDDP@FBH@B@F@DBDDHDFBD@HBBAD@BDBFDFDDFDH@BADAFABAB@H@BA@@@CDA@OBGBEFGHE@GAEBEDIHKDKDCBKDCBEDE@ABA@@@IFABABB@@B@BDBBBD@B@B@DI@AB@B@@B@P@D@@DBB@D@DAD@BB@BEHCD@@@DDD@@LBJADA@E@E@AB@BAB@B@BAD@BB^ZDHAD@BEB@BGBKB@BABCDADADCBCBA@EBCDABABA@@DADABAD@BADA@BBDBDBB@BBD@BAB@D@D@FBBABADADCB@L@CBEDCHAF@F@D@BBBBBDBD@@BB@F@@@B@B@HCDB@BBB@@BBBBBDB@D@B@FAB@B@@@D@BBDBBDBBD@@DBDDDHFBB@F@FABCDSLCD@BA@DD@BADBB@BB@FDNBD@B@B@BBDF@BCB@B@@CD@@@BFDDDDBBB@@B@BAFADBBDB@B@DBB@B@B@HFDDFAFDBBD@BADAD@@@DABAD@B@D@DAHCB@B@L@B@DBD@BDB@@BB@DADADEBA@@B@@@BD@BB@B@F@FADC@A@ADD@B@BBBBB@BK@BFAF@B@@B@DABCBC@@DABBB@D@@@@@@DDDB@DBDBB@DDJDFFDH@@DBBA@@FBDBD@DBB@D@BBBBDDDBFBBBF@BB@@B@BA@@BBBFDDBD@BB@@DN@DBDBBBFLDDHH@BFDDD@BAB@B@DADADCDAHABEFCDCBAB@BADABC@@AA@@@ABAB@@@@BBBB@BCDCBA@G@A@@BCDKHONGD@@A@CAC@AAAA@AA@@DEFEFIFABADCBA@@DEFCBCDCDEDCBC@A@MCG@C@A@EDCBA@EAGC@DA@GCAAA@A@@BDD@@A@C@CAEA@@CA@B@@B@DDDDDD@B@@A@E@CBIFCB@BAFAB@FDLBDBF@BEB@DBJDBBB@F@LCF@FBDBDB@FBFBFDFBNHD@JBH@HBDB@@DCBC@@@A@A@@@A@ABC@C@AB@DBFABA@@BAB@BB@BAB@FAFADABADADCD@B@BD@DB@BFDDBB@B@BBB@FA@ABAB@B@BB@DCDABA@@D@@CDAF@D@DABA@@B@@ABCD@D@B@FED@@F@@BAB@BFDHFHHBD@@BDDDBBHDB@BB@B@DCD@D@@B@FDFFABCFAL@BFB@B@N@DAD@LAF@BBFFDBBHDBBDDBB@BBBHBH@D@D@BBJFD@FBD@HDJBHBB@B@@ABA@@@C@E@ABABABADC@A@@BCAA@ABANMFEBABADEBEDEBEBC@ABCDEDEBCDE@ABABABABAD@DADCDC@ADCDCFIBEBC@CBEBC@@BCZYDEHIDAFEP@BABA@AAE@A@@BAF@JCDAJGFGBA@CBA@@FDJ@NABBHHJNBBDCF@BABCFCF@BBBADABCBAD@@@DIB@B@D@BABABBDB@AD@@@BAFEDC@ABCNKFEBADCFCDCFEDA@AB@@GBAFAHCD@HGBADCLMB@BAB@TBD@B@D@D@DDJHBFBB@D@BBBBDBBBBBDB@@BDBB@BBDBBBBBFD@@BBB@@BBB@D@BAD@@A@A@@@A@AB@@AB@B@@@BBBB@@@@B@@@@AB@@ADA@@B@@@@DFDFDBDD@B@@@B@B@BCB@@@BCBCBAB@DB@BBBBB@DBFDB@@B@@BB@BBBB@BBB@D@BABABABCB@B@FAD@BBB@DB@BBB@B@@@BBB@@B@B@@@BCBAD@DBD@BBBDDF@D@@BB@BDBDDB@BBBA@@DAB@DC@@D@B@DBDBDBDBD@@@BAB@BAD@DAB@HEBCB@BAHAFAD@DBBAB@B@BABCDABA@@B@BAB@BBB@@BB@B@DAB@B@B@@BD@@@DAD@BBBBDB@BBBBDBD@B@BBB@DB@@BB@BDBDDBBBB@B@B@BA@AB@BABAD@BA@@B@@@B@BAB@B@@@B@B@@AB@@AB@@AB@@A@ABA@AB@@A@@B@@A@@@A@@BA@@B@@AB@@@B@@@B@D@@@B@BB@@B@B@@BB@@BB@@@BB@@B@@BB@@@@B@B@@@BA@@B@B@@@B@@@B@BBBB@BBB@BBB@BBB@@BB@BB@@DBB@BBB@BBBB@@BBBB@@BB@BBB@B@B@D@@@B@BA@@B@B@B@@@B@@B@@BB@B@@@B@B@@@B@@AB@@@B@@@B@@@BBB@BBBB@@@@BBB@@@B@@@B@@@B@@B@@@@@B@B@@@B@@@BB@@BB@@B@@B@@@B@B@@BB@@@@BBBBB@@BB@@DBB@D@D@D@B@BABAB@BA@@BA@@B@@AB@@AB@BA@AB@@AB@@ABA@A@AB@@ABA@@BA@AB@@AB@BAD@BAB@B@BAB@@@BAB@@@BAB@BAB@@AB@B@@AB@B@@BB@B@B@B@@AB@BABAB@@AD@@ABAB@BAB@@ADA@ADC@@@A@@BA@@B@@@BAB@@@B@B@BA@@B@@ADAB@@AB@B@@BB@@@BBB@@@BBB@@@B@B@@@BA@@B@BA@@F@D@D@B@B@BBBBBBBB@B@@@BB@@B@BB@@B@@BB@BB@@B@@@@BB@@BB@@BB@@B@BB@@BBBB@B@BB@@B@@BB@@B@B@@@B@B@B@B@B@B@B@B@B@B@B@B@BAB@@BB@BAB@@@B@@@B@B@B@BBB@@BB@@@DB@@B@D@@@B@B@@@B@@@B@BB@@BB@@@BB@@BBB@@BB@@B@BB@@B@@@D@D@B@B@B@BAB@BAB@B@BAB@BAB@@ABAB@@AB@BAB@@AB@BA@@B@@AB@@@B@@BBB@BB@@BBBBDB@@BB@B@DB@@B@B@DBB@B@B@B@BBB@@@@AB@@@B@@AB@@@B@B@@@B@B@F@BAB@B@B@BA@@B@B@DBB@@@BBBB@@B@B@BBD@B@B@B@BBB@BBBB@BB@@B@BB@@B@@B@@BB@B@BABAB@BA@@BAB@BA@ABABAB@@AB@@ABA@A@AAA@A@A@A@A@@@A@@BABA@@BAB@B@@AB@BAB@@AB@B@BAB@@@BBB@B@BBB@B@@@B@B@B@DAB@BA@A@@BA@A@ABA@@@A@A@@@A@AB@@A@AB@@ABA@A@@@C@A@A@@@A@A@A@A@A@@BA@AB@@A@A@@@A@@@@AA@A@@AAAA@@A@AA@@AAA@@A@A@@A@@A@A@A@A@A@@BA@ABAB@@A@@AAAAA@AAA@AAAA@@A@AAA@A@A@A@AAA@C@A@@AA@AAAAAAAA@AAAAA@AAAAAAAA@AAA@A@A@AAC@ABA@A@C@A@C@A@C@A@A@A@@@AAA@A@@@ABA@A@ABA@ABA@ABA@ABA@ABABAB@@A@ABA@@@A@A@A@AB@@A@AB@@A@C@A@@@A@AAA@@AAA@@AA@@AAA@ABA@@BA@ABA@A@ABA@@@ABA@A@AB@@A@ABA@@BA@ABA@@@CB@AA@A@A@AAA@AAA@AA@AA@AA@@AAAACAA@CA@AAAAAA@AAAAAAA@@AAA@AA@@AA@A@@AA@A@@@A@A@@@A@A@A@@@A@@A@@@A@@@ABA@@BABA@AB@BABAB@BAB@BABABA@@DABABA@@B@BAB@@A@@@A@@@AA@@AA@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@HCF@F@DA@AA@BA@ADA@B@@B@F@B@DA@@BABAB@BCDABABABABABCB@@ABA@A@ABA@AAADEHGDA@@FG@ABA@@@@@@A@@A@@@@@@@@@@@A@A@@@@BABAB@@AB@@A@@@AB@@C@@DA@CBA@@DABABABCDABADABAD@B@BAB@D@D@@AB@@@BA@A@A@@@A@@AA@A@A@A@@@A@AB@@A@@AA@A@AAA@A@A@@AA@@@AA@@AA@@@A@A@A@@A@@@A@@@@CAECEAEEC@CCC@AB@@@@@@A@@@A@@@A@@@AB@@A@AB@@A@@@A@@@AB@@@@@B@@A@@B@@A@@BA@AB@@@@AB@@A@@@@@A@A@@@A@@@@A@@@@A@@@A@A@@@A@AAA@A@@@A@A@A@A@A@@@A@AA@@AA@@A@ABA@A@AB@@AB@@@BABABABA@ABA@@@CBABA@@@ABA@C@ABA@AAA@AAA@@AAAAAA@AAA@@AA@AAA@A@AAA@A@C@C@@@A@AB@@A@@@@BA@A@@@C@@AA@AAAA@@@A@A@AAA@ABA@@@ABA@@BAB@@AB@BAB@B@B@B@BAB@B@BABAB@B@BA@ABA@ABAB@@A@AB@AC@@@A@C@@@A@A@C@AA@@A@@AAAAAAAAA@AAAAA@AAA@AAC@A@A@A@AAA@A@@AAAA@@AAAA@@AA@AAA@A@A@A@A@CA@@A@@AA@@@@A@@@@BA@@@@B@@@B@BAB@@CB@@ABA@ABA@@@@@AB@@AB@DABABAB@BADAB@@AB@B@BAB@BAB@B@DA@@BAB@FA@CDAB@BA@ADCBABA@A@A@AECAACAGCCCGGCEA@@AABABABIFCBCFCBA@CBG@CBEBCDGDEDADABABIFA@A@AACCA@IIAAAACBA@CBA@C@AACAA@AC@@@CBC@AAA@@CAA@A@A@CB@@@BA@CDEDA@C@@@A@A@@AAC@ABE@ABE@A@AAAAA@AC@C@A@AA@A@@DADADAFEHE@@B@DAB@DCBABCBA@C@AFIDIBGAA@@@AAM@BAABAAE@A@@@@AGDEFCDCD@BD@@@@@@BA@@@@@@F@B@@@B@B@@@B@@A@@HAHGLOJIFGFGBEBA@@DCB@DADAB@BA@A@A@GDABCBCDCBABCFCJCFALAPAB@DAB@B@DBBBHBHA@@BA@@B@@@B@@@@A@@@@@A@@@@@@@@@AA@@AAA@@@A@@@AFCBCDE@G@C@@@EBCBADABABAD@FCTIXIB@DEBAFALAN@@@B@@DBBFBJBHBD@D@DAB@DCBC@A@CAC@AGIKMAA@ABCBCCACACAC@C@E@C@C@EBC@C@C@E@A@EBAAA@A@ECCCAAAACC@A@C@AAC@A@A@AC@ABCBC@ABCBCBC@CBCBCAC@CACACAECAACACAC@C@CBCDEBABE@ABC@A@AACAACAAA@EEGKEIAAEICCEEIKIGKGGCEEECA@AAA@CAE@CACAE@CACACAAACAC@C@CBC@C@ABABADABADABABABABCB@BCBCBCBABCBCBC@C@CAE@CAE@AAAACCACACACAEACACCAACACACAAAACAA@C@ABABCBABCBCBCBCBCBCBABCBCBCBCBCBCBCDABCBCDABAD@BA@CBC@CBCBC@A@C@CBC@C@CACAA@C@C@EBC@CBC@C@CAC@C@C@C@CBABC@ABC@EDABCBEBCBEDCDEBCDAB@@AB@D@B@@DBD@B@B@D@B@@BDD@BB@B@@@B@BDBBAB@DAD@BABABA@C@A@C@CDA@CBABC@CBABABAD@BBD@B@B@BAD@BAB@D@BBB@BBD@B@BABCDCBABABCBADADADADAD@BABCBA@A@ACCCACCAAAAAC@CBABCBC@C@ABA@ABA@A@AAACAAAACAC@E@E@C@EAE@A@EBCBC@ABC@CACAACCAAAA@CCACAAAAAAA@AB@@A@@B@BAH@B@@@D@@@@@BA@@@@BE@A@A@@@A@AAC@GEA@@@AB@@ABA@@@AAACEKCAA@E@E@A@@@CBEBGDGBGBKJCDGBCBC@GBG@@A@@A@G@A@GCEECC@ACCAEAGAGACAAACAAA@AA@@G@A@@@@@AACAAA@C@@@A@A@A@@@AACCGCGCGCCAGAECCECCECGAG@EAEDGBCBC\\UDCBCAACAOAMBIDUPCBG@GECEAE@C@AAI@G@EFGHCHAHBFBDBDAFADCJU@ADAFABA@A@@AAE@A@AAA@CCGEAA@ACCA@BA@@B@BABA@A@@AAEASECAA@YIG@G@GBCD@FBB@D@DABABCBABA@A@ABCAE@E@A@@@C@CBEBEBA@ABAC@AAAAAE@AAACA@AACAA@A@AA@@AABCBABA@ABA@AAA@AAA@@AAC@C@@@ABCDEHCDGBGBGFIJSZCDE@GBCD@@AHAH@HAHAHAJAH@F@FBH@DCBEBE@ADCDCFAB@BCBE@G@I@CB@@@@@@@BA@@@@@ADAFCFADEBEDGDGDC@E@C@EDGBCDGD@@CDA@CBCHCD@@C@EAAC@A@ABAAC@A@AA@CCOIA@IEGEGCEAA@CCA@AAAACAG@CBE@EAECAACGACAAAAC@@@A@A@A@C@ACA@AA@@@AA@A@A@A@AA@ABABAD@D@HABADEBA@CAACCEAAAAACAC@@AE@A@AA@ABCAAACCAC@EAI@CBCBC@EDGBEAK@CCCACEEE"],encodeOffsets:[[110115,32446]]}}],
+UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("德阳",{type:"FeatureCollection",features:[{type:"Feature",id:"510603",properties:{name:"旌阳区",cp:[104.416966,31.142633],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@ABCBEFCDABA@C@C@@@GA@@A@A@A@A@A@@@A@A@A@CAAAA@ACA@@@CAAAE@ABABCBC@CBA@A@@@@AA@@@@@AAA@@@A@@@CAEAA@AAAA@@A@@A@@AA@AA@A@ABABEAC@A@CCCAAAAE@CAACB@@@@@@AB@B@B@BA@@D@BAD@@@B@BAD@@@@@@@@@@AFAB@@@BCDAFABA@C@E@A@@BA@@BABC@C@A@@E@@AA@@C@A@ABABA@@BA@@AA@@BA@A@@@A@@@A@@BB@BBBB@@@BA@ABA@A@EBA@@B@BAB@BABA@@@AA@@AAA@A@C@A@A@ADAFCJAD@B@@@BAD@DABA@CDCBEBABAB@B@BAD@DA@EDCBA@ABABA@@@@B@@A@@B@@@@@BABABCDEDADABBDAB@BCD@@CBIFEFABADABCBCDADAD@DABA@@@ABAB@@CBC@ADCBABA@ADAB@DAB@BA@ABC@C@ABA@AB@B@BAD@FA@@BABAD@BCDAF@FENEL@DAB@BA@AHAFAD@F@BAF@FAF@D@@BB@@BBB@BBB@@BABA@ABAB@@A@@B@@@B@@@DAF@B@D@@ABA@CBC@@@AB@BB@BB@@DBFBBBDB@BB@B@@@BB@@DBDBFFFF@BBB@BBD@@@BBB@B@B@DBF@D@BBB@B@@B@@BB@AD@@@BCB@@@B@B@@@B@BBB@BB@B@B@BAB@BB@@@@@BBF@@DBD@B@@BBBFBB@DBB@DBDBBDBBDDBDB@BBBDDDBB@@B@BBB@@BAD@B@BB@FFFBBB@B@@@BAF@@@BBBD@BB@@BD@B@B@B@DAB@F@@@DAH@H@B@DDFHFFDFDD@DBBAB@BB@BBB@D@F@BBD@@BB@BD@B@B@D@B@B@BCBA@CBC@ABABAB@BABC@@BC@C@EBABABABA@@@AB@@ABABAB@B@B@@@B@@AD@@@B@BA@@D@B@B@@@BA@AB@@@BABB@AD@B@B@@@B@D@@@BB@@B@D@@@B@B@J@D@B@BAB@BADA@AB@@ABABA@@BA@A@@@@@@@@@@@@@A@@@@@@@A@A@@@A@A@@@A@@@A@@@@@@@A@@@@@@@@@@@@BA@@B@@@B@@BB@BB@@@@B@@@@@@AB@@@@AB@@@@@@@BA@@@@@@BA@@@@BABAB@BABAB@@A@@@@@@@@@A@@A@@A@@@@@A@@@@@@@A@@@@BA@@BAB@@@@@B@@BB@@@@@B@@A@@BA@@@@BCBABA@@DABA@A@@@A@@@@@A@@@A@@@A@@@CBA@CB@AC@A@A@ABC@ABCB@@A@AB@@@@@@@@@B@@B@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@B@@B@@@@@@@@B@@@@@B@@@@@@@@A@@@@@@@A@@A@@@@@@@@@@@@A@@A@@@@@@@@A@A@ABABAB@BABC@CBA@@@A@@BA@@B@B@B@@@B@@@@@B@B@@A@@B@@@@@@@@@@A@@B@@@@@@@@@@@B@BA@@@@B@@A@@@@@@@@@@@@B@@A@@@@B@@@@@@@@@@B@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@BB@@@@B@@@@@B@@A@@B@@A@ABEBCBEBEBABABA@@DA@@DC@@B@B@BBB@BDB@@@B@@@B@@BB@@@@@@@B@B@@@B@@@@@@@@@BA@@@@BA@@@@B@@@@ABB@@B@@@@@B@@@@@@@@@B@@@@AB@BA@@@AB@@@@@@@@@BABAD@BAB@@@@ACC@@@@BAB@B@@@B@@@@BB@@@@@B@@B@@@@B@@@@@BA@@DG@@BA@@A@@A@@@@@A@@@A@@A@@@@@@@@@@@@@@A@@@@@A@@@@@A@AAAB@@A@@BA@AB@@A@@B@@@BA@BB@@BDD@@@@B@@@@@B@@BB@@@B@@@@@@@@@@@B@BA@@@@B@@@@@@@@@B@@@@@@@B@@@B@@@@A@@B@BA@@@@@@B@@@@@@@@@B@@@@@@@B@BBBB@B@@@BABAB@B@@BDDBB@BB@@B@@@BA@@@@B@DBB@B@@@@@@A@@@@BA@@@A@@@A@@@@@@@@@A@@@@@@B@@@@A@@D@BA@@@@@@@@@@@A@A@@@@@@D@@@@@B@@@B@@@@@BABADEDA@ABAB@@B@BAD@@B@@@@@BCD@BAB@@AB@B@DD@@@@@@B@BB@@B@@@@AB@DABA@C@ECCACECECEEGCICA@G@C@A@CAAC@@@CBEBA@@A@A@ABA@@@@A@@@AB@BAB@B@D@BA@@@A@@@@BA@@@A@AC@A@A@A@CA@AA@@@A@CAC@A@AACA@AAC@@BABADAB@B@D@DBD@F@B@@ABCBABE@CAAAAAACCECAA@AB@@@BA@@H@B@D@BADCB@FADABAB@@CBABCAA@A@A@@@CBA@@@A@@A@A@@@A@@A@AA@@@@@A@@@@@@B@@@@A@@@CAA@@A@@@C@@AEAE@A@AAACCAAEAC@CBAB@@C@CBAB@BC@AAA@@AA@@AA@AA@@EBA@@@A@C@EBIFA@@@A@@@@AA@AC@A@@CCAABC@CBC@E@CAA@G@EBE@C@@BABA@@@A@AAACC@ACEKM@A@@@AA@AE@CIUAEAE@A@EA@AAAA@ACAA@A@C@ABC@@B"],encodeOffsets:[[107e3,31774]]}},{type:"Feature",id:"510626",properties:{name:"罗江县",cp:[104.510249,31.317045],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CBA@ABCB@@ABAA@@AA@@A@@A@AACA@A@@AC@AAAAAAC@@AA@@AA@@@AAAC@@BA@@BA@@@A@@@A@@A@@@@BCDE@@BAAA@@@@@A@@@A@A@C@@AA@AA@@@A@ABA@AA@ADCBAAAA@@AA@ABC@AD@@@@A@@@BC@A@AA@AB@BCBCFABAB@@@@A@@@A@@@@@C@@@@@@@@B@B@@@@@@@@@@ABC@@@@B@@A@@@@@@@@B@@@@@@@@@B@@@B@@AB@@@@@B@@@@A@A@CAA@@@@@AB@@A@@@AAA@CAAC@@@ABABA@A@@@AAAAAA@@@@@@@A@@@@@@@@@A@@@@@@@ABA@@@@B@@A@@@A@@@@@@@A@@@@@@@@@A@@@@@ABA@@@@@@@@@@@A@@@A@@AA@@@@@A@@@@@CC@AA@@AAB@@A@@@@BA@@BAB@@@BA@BB@B@B@@@@@B@@@@@B@@@@@@@@@@@@B@@@@B@@@B@@@@@BB@@@AB@@CH@@AB@@@@A@@@@@@AA@@@@@A@@A@@A@@@A@A@AB@@@@DD@B@@A@ABC@ABAB@@@@@@@@A@@B@@ABA@@B@@A@@@@@@@@@A@@@@@A@@@AA@B@@A@@@@@AB@@@@AB@@@@@@@@A@@@A@A@@@@@@@A@@AA@@@A@@@A@ACA@AAA@A@@@CD@@CB@@ABABABAFAFADAF@B@BA@@@@BA@@@@@A@@@@@AA@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@A@@@@@@@@A@@@@@@BA@@@@@@@@@@@@@@BA@@@@@ABA@@@@@@@@@@@A@@@@B@@@@@@@@A@@@@BA@A@@@@@A@@@A@A@A@@@AB@@@B@@AB@DADABA@ABAB@B@B@B@@@@@@B@@@@B@@@@@@@@@@B@@@@B@@@@@@@B@@@@@@A@@@@@A@@@@@@@@@@AA@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@AA@@@@@@@@@A@@B@BA@AD@BAD@B@B@BBDA@@DAB@D@@@B@@@B@@@B@@@@@B@@@BABCB@@ABABAD@@@@AB@@@BA@@@@@A@@AA@@@@@A@AB@@AB@@@@@B@@@@@@@B@@@@@BB@@@@B@@@@@@@@@BA@ABABA@ABAB@@@@AB@@@@@@AB@@@@@@A@@B@@A@@B@@@@A@@@@@AAA@@AA@@@A@@@AB@@@@@@@@@@@@@B@@@@@@@B@@@B@@@B@B@@@B@B@@@@@@@B@@@@@@@@@@@@@BAB@@ABAB@BA@@BCBABA@ABA@C@I@A@A@@@C@A@@@AA@@C@A@@@A@A@C@@BAAAB@@A@@BAB@@A@A@C@@@ABA@@@C@@BA@@@A@A@A@ABAB@BA@@B@@ABABABAB@F@DAD@@ADABA@ABAB@BAD@DABADA@A@C@A@A@C@BBCBEDCJ@@@@@BA@@BA@@B@@AB@@@BAB@B@DABABA@ABA@@@AAA@@@@@AA@@A@@@ABAB@BBBDDDBF@F@BBB@@@B@BB@B@D@BBDBAFADCBABBB@DBBBFDF@DABADGDE@A@@BAPADAD@HBHD@F@FDBFABAFCF@J@F@FCJA@A@@D@HAFA@ADADC@BDHBBA@@BAB@BBDB@DDB@B@@A@AAA@A@@BADAD@LFF@BB@@DBB@B@D@DCB@@@BAB@@B@@BB@@@@@@@@BA@@@@B@@@D@@B@@@B@@@@BBB@F@@@B@@@HDHBB@@@B@D@@@BBBD@BBBB@D@B@@@B@BBB@@B@B@@@BA@@BABCBCBCBABCB@DAB@DBBBBB@B@B@@@B@BB@@@B@BB@B@B@@@B@B@B@BBB@BABADC@@B@FDDBBBBF@D@F@DBDBBBBDBB@@@BBB@@@@B@@C@AAA@@@BBBB@@@BA@A@A@@@@B@@A@@BBB@AB@BAD@BA@@BA@@B@@@@AB@B@@@BBABABA@@@ABABBB@B@B@B@@B@@C@@@A@ABAB@BABAB@@BAB@BAB@@@DBB@@BBB@BA@@B@@@B@@@BFBFBAFA@@D@DADCDA@@DCFIHGDAD@BADAD@@@B@B@DABADA@@@@DCBC@C@@BA@CBCBAF@F@D@D@@@D@D@BAB@@ABABABABA@A@CAA@@AA@A@EBC@@@GBABEBABA@ABA@A@AB@@C@A@AAAGMAA@A@A@A@CBCBA@@BAFCFAFAHAB@D@FAB@DC@@BAFCBAB@BABA@@B@@@HABAD@B@B@BAB@B@@@FAB@@@BAB@D@DABAJA@@DAB@DAF@B@B@BABABE@@BC@EBC@AA@@CAE@A@C@ABA@AD@BABABABABC@@BABABADABABC@ABCCCCCAEBCDABCBA@EBABEBC@CAEBAB@F@LBJ@F@BDBFBB@@D@B@@@FADAFAF@BAAA@GAGAGACCAACICGCICGAC@C@E@E@I@EBA@A@G@C@C@AA@AA@ACEECECEAC@C@CAA@AAAA@E@AAAA@AA@@C@@AAA@A@A@CBAB@BADAF@DADCDED@@A@ABA@C@CBCB@BC@A@@BA@@BAB@BA@A@@@C@@AA@@BA@A@@@A@AAA@CDGBABEA@@@@@A@@@A@A"],encodeOffsets:[[107103,31992]]}},{type:"Feature",id:"510681",properties:{name:"广汉市",cp:[104.282429,30.977119],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@C@ABE@ABA@C@ECA@AAA@ABC@A@EBC@C@CACAAAC@C@@BAB@D@@@B@BCBC@AAA@@CCCCACACACAA@@@A@C@A@CBA@E@E@AAAA@AA@A@@@ABABGH@@CBCA@@CE@@A@A@@BCBAD@B@BA@B@@B@@A@@@@BA@@@A@@@@@AA@@A@@@A@@@AB@@BB@@@D@B@@@BABCBABM@AAEAEA@AA@A@A@CBADABC@@@A@AAAAC@A@ABA@@BABAD@@ADA@@@A@CAA@@@A@A@C@@@AA@AACAA@@A@E@A@EB@@AB@BAB@B@B@BAD@BA@CBA@@BABBB@@BBB@B@DBBADB@@B@@@BB@D@@@B@B@B@B@B@D@B@B@BBB@BDADBB@BD@B@@BF@DD@B@FB@@@D@BCDAD@B@BB@BB@@B@BB@B@B@B@BBB@BBBBB@@AD@B@D@DA@@DCDAD@BBBB@BBB@@D@DADCDED@BABA@@@@B@BCB@BA@A@@@A@A@@BAB@DA@@@@@A@A@@A@A@AA@@@C@@@A@@B@@@@@BB@BB@@@@A@@@ABC@A@@@@@@@@@@B@@@@@BA@@D@B@@A@CB@@A@C@@@CBC@@@@@EBA@CB@@CBA@AB@BC@A@A@@@A@@A@@A@A@@@AB@BAB@B@@@BBBABB@@BB@@BB@D@BB@@@@B@@@AD@B@@@@D@FBD@B@FCD@JABBB@BB@@@B@@BB@@@@B@BABC@CBABALBF@F@DBDBDBFAD@BB@B@@BB@@ABABABABG@AB@B@D@BABCDCDED@@A@CBCBABABA@@@CBCBADAB@B@@BDDBFDD@H@D@B@@@DD@@@@DBB@B@@B@BBFBB@@B@BABCB@B@BABBB@B@B@B@BABAB@B@@B@@@@@B@@@BB@D@B@BBBB@@B@B@BAB@@@DF@@@@ABCD@BB@BBD@@@@@@BB@@@@BB@@@@@B@@AB@DB@@@@ABCH@@@B@@BBD@DA@@@BBB@BB@@@BA@@B@B@@BBBBB@@@@AB@@@@@B@BBBBB@@@B@@@BDFB@B@B@BB@AB@@@D@B@B@B@B@B@@B@@BB@@@@B@@@@@@BBB@@DDDDBB@B@@ABCF@B@@D@B@B@BBA@@B@D@D@@D@B@B@@B@B@BCH@DA@@@A@@B@@BFBB@B@@@@BB@@B@B@D@F@@@BBB@B@BB@@@@B@@ABA@AB@@ALA@@B@@B@B@BB@D@DBF@B@@B@@B@B@B@BBD@BBB@@B@@@BB@@@BA@AB@B@BBB@@BAB@@A@AB@@@BDFBBB@@EBC@A@ABAB@BAD@D@BAB@@ABA@CBABCB@BADABCD@DA@@BABA@@B@BA@CBCBCDCDABABCBAFEJEDA@@DC@ABAACBABCFCDCBABA@A@@@@@AB@@@@A@@B@BABAB@DAFCB@@CBC@A@ABABAFADADCB@BA@CBC@A@@@ABCDIBEBCB@B@D@B@B@BB@@BB@@B@BA@ABA@A@AB@FAB@B@BAB@@A@@AAAAA@@AB@@@B@@@B@B@@AB@@BB@@AB@BABAB@D@@@BB@@@FB@D@D@BA@AB@@AB@F@D@B@BABEDC@A@@BABE@@@@@@@@@@BC@A@A@@BC@A@CB@@A@A@ABA@@@@@@DABB@DBFBBDBDDB@D@FBBABAB@B@@BBB@@@BB@@@BBBBB@FBDB@@B@@@B@BB@@@@B@@B@@B@B@DAD@DABABAAAC@CAAACA@@@A@ABAAA@G@@@CBCBA@@BABADCBA@AAEAAAA@A@@AA@A@@@A@@B@@AB@@@@AB@@@@@B@@B@@@@@B@@@BB@@@B@B@@@B@DHBB@@B@D@B@B@DDD@D@@ABABAD@FA@@@@BAB@@A@@@@BA@A@A@AACAEAC@C@CBCDCDCFC@ABAB@BCBA@@@A@CAA@CAC@CBCBCDCBABCBC@C@C@ABCBABA@@D@BA@@BA@AAGAAA@CA@@AB@@AB@B@@A@CBA@@BGD@BA@A@@B@B@@A@AAC@CBC@AB@BA@A@@BA@A@E@A@@BABAB@@@@A@@AC@E@A@A@@AA@@A@@@@C@@@@@A@@A@A@@@AA@A@@@A@AB@@@@AA@C@AA@@AA@@@A@@@A@A@C@CBA@ABA@@@@@A@A@@AAA@BE@CBEBC@CA@@EA@@GAEAECGA@@C@@@AD@@AB@@CD@@A@@BC@AAA@@A@A@CAC@CAAACACAA@C@ACAC@ABAD@@@B@@AB@DABA@EBCBAD@@CB@@CBCAACCA@C@AAA@AAAAACGCGA@AG@A@ABA@@@AA@@AGCAGAC@@AA@@@BA@@BABABC@@@@A@@AC@@@AA@AAA@@@AA@@AA@@A@@@A@@@@D@@@BA@A@A@AAA@C@AA@AAAAACCEEA@@@C@A@GBABABCDA@ABE@EBC@ABC@AB"],encodeOffsets:[[106802,31643]]}},{type:"Feature",id:"510623",properties:{name:"中江县",cp:[104.678751,31.03307],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@A@@@@B@@@@@@@@@@@@@BB@@A@A@@"],["@@@@A@@@@@@@@@ADABB@@BBCBA@@@@@@@@@A@@@@@@@@@@"],["@@@@@@@@@@@@@A@@AC@B@@@@@@@B@@@@@@@@@@@@@@@@@DB@@A"],["@@AA@@@@@@@@@@@@@@@@@A@@A@@@CDB@@BB@B@@A@@B@"],["@@ACBAHE@C@A@GAA@AACC@AACBEBABA@C@@A@C@A@AAAC@GAC@ABA@@BAACB@@ABC@A@C@E@E@C@CBAFCHGJCHCJAFADEJEHABAD@B@B@@BBBBBB@B@D@@AFAD@B@@BB@D@@B@DDB@@BB@@B@B@BEDCHCBAD@@@@AD@D@BAD@@@B@B@@@B@@ABEAEAAAA@A@@B@@FF@@@HAB@@CACA@ACAAA@@A@E@CBAAA@@A@GBE@AAAA@A@EBCBA@@B@D@FDH@BA@@BAAA@@AA@ABB@@@BBDFBB@BABA@AB@@@@DB@@@BA@@BC@CBEH@@A@AAAACA@@@@@@AB@@A@CD@@A@AAA@AAAGACAAA@A@AAAA@AA@GBA@E@CAAAAGBIBAAA@@A@AAA@ABADAFAXCPAB@BA@C@AAAC@@@CDKAC@@AAC@GBA@A@@ABA@A@AACAAAAA@AACBA@CBC@@@AA@C@I@@FKBC@EBK@AAC@@EAA@@B@@@@@B@@@@AB@@@@A@BB@@@@@@@BC@@@A@AA@@@@AA@@@@@A@@A@AA@@@@@@@A@@AA@@@@@@AB@B@B@@@B@@@BA@@@@@@@A@@@@@@@@A@@A@@@A@A@@BCD@@@@@@A@@@@@@A@@@@@A@A@@B@@@@@@AAA@@@@@A@@@A@@@@@@@@A@AB@@@@A@@A@@@A@@@@A@@@@@A@@@A@@@@BA@@BA@@@@@A@@@@@@@A@@AAA@@@@A@@@AA@@@@@A@@@@@@CA@@A@@BA@@@@@A@@@@@AA@AA@A@@@ABA@@@@@@@@@A@@@@@@@@@B@@BB@@@@@@B@@@@@B@@A@@BA@@@@BB@@@@B@@@B@@@@AB@@A@@@@@@@@@BB@@@@@@@@@@@@A@@B@@@@@@@@@@B@@@@@@B@@@@A@@B@@@@@@@@B@@B@@@@B@@AB@@@@@@BB@@B@@@B@@@B@@@@@@@@@@@@AB@@@@@@@@@@@BB@@@@@BB@@@B@@A@@B@@@@@@@@@BB@@@@B@@@@@@@@B@@@@@@@@@@@BB@B@@BB@@@@@@@@@@B@@@@@@BB@@@@BB@@@B@@@@@@B@@@@@@@B@@@B@@@@@@BB@@@@@@@@@@D@@@@@B@@@@@@@B@@@@@@B@@@@@@B@@@@B@@@@A@@@@B@@@@@@@B@@B@@@@B@@@@BBBDB@@@@B@D@@@B@@BB@B@@BBD@DDDDFHBJ@@@B@D@DABCBEH@B@B@BAAA@A@CAA@CBA@C@AAA@@AAAACAA@AA@C@@@ABA@@BA@@@@DBH@BABAD@B@B@D@@AD@D@@AF@B@@CD@@@B@@CD@@AD@D@@AD@@@D@@ADAD@ACD@@CB@@@B@@AF@@ABAB@@CB@@C@E@E@E@AB@@ADBD@@@@@D@@@D@D@@AD@D@D@@@DBD@@BBDDDD@@@D@@AD@@C@EBC@@@A@@@EAEBA@E@@@C@CACA@@CA@@AB@D@@@D@@@DBBAB@@C@A@CAA@ABABA@C@C@CAC@CBABB@ABAB@BBDBB@@@BABABAB@@CB@@A@@@AB@@@B@@BDBD@@BB@BBD@D@@AB@@@@CB@@CDABADAD@@AD@@AD@FCJ@FADAD@ACB@@CBCACAAC@@AA@@AAECEA@@C@@@CB@@@@ABADBDA@BB@B@@B@@ABAB@@@B@@@@@BB@DAB@B@FAD@@@@AFERAF@@@@CDAD@@CD@@@BABCB@@@F@@AD@@@B@@BDBBBBBB@DABCB@BC@C@C@@@KA@@CB@@A@E@C@CD@@ADCD@@C@C@@@G@CBE@A@AB@@CBC@CBCBA@@@C@@@C@E@@@EBEB@@C@AB@BA@@BABA@@DADADAB@@@DCBC@@@C@@@C@@@CAEC@@CAE@C@C@A@CB@B@B@DAD@BAB@BEB@BC@AB@@ABABAB@BA@@@A@C@A@CACAC@EBCBA@C@@@CBAB@@CD@ACBAB@@EB@@E@CB@@GBGB@@GBCB@@ABEB@BEB@@C@GB@@E@@@CBC@@@CBC@A@A@A@A@AD@B@@@B@B@B@@BD@@BBB@BB@BD@DBBB@D@D@D@JHX@D@BABIDA@CBCBCD@@@@ABCD@@ADADA@ABABEAAAA@A@@@ABC@ABC@AB@@BH@BAB@@ABC@@@ABABAD@B@D@DADADABCDADAD@DBD@DBB@D@B@@ABADA@AB@BEDCDCDAD@D@DBDBFBD@B@B@BAB@@@@@BA@AB@@@@EBC@ABAB@BC@C@CCA@A@C@A@@@AACGA@@@A@A@@@A@@A@@@A@@@@@AA@@@@@A@@B@@A@@BA@@@@B@@@BBB@@@BBBBBBF@BABCDABAB@@ABAD@D@@@HBBAB@B@B@@DBBBDBD@BBF@BBDB@@B@BDB@BBDBB@B@B@@@B@B@B@B@B@@@HB@@D@D@B@BADCFEDABAB@@AD@BAD@B@B@DB@BBBBBB@@F@BBFBFJV@DBFB@@B@@@BLNDF@BDDBB@B@B@@ABAB@@@DAF@F@HBB@D@FAD@DADBBDD@@@BBDB@@B@@B@@@B@JEFAD@B@@@B@FA@@BBB@@BB@@BB@BBD@@ABADAD@@@BADAD@FBBBDDBB@B@BBFBF@@@D@@@BB@DB@@B@@@@@@A@@@@B@@@@@B@@B@BB@@@B@B@@@@B@@AB@D@@@B@BBBADAB@DA@ABCBEBA@CDABC@A@G@@@AB@@A@@BBBFDDDBBBBBB@DAFABAD@BA@E@C@CAC@A@A@CBABAB@@BD@BDBBBB@D@DBB@@@B@@BDBB@B@B@D@@B@B@@AB@@@@@B@@ABC@A@A@ABA@@B@@@B@@B@BAB@B@@@ABAF@D@@BDDBB@D@H@B@JDHDFFFDFDBDDD@F@DABCBA@@B@@A@@@AAA@@@@@@@CCA@A@@B@BB@BBABCD@BBBB@BAB@@@B@@BBB@@@D@B@B@@@B@@@@BBAB@@CFAD@@@@@BB@@@B@@@B@@AB@@AD@BB@B@@BB@@BB@@BDBBBB@BBD@@@BDBBBB@@@@BB@@BB@AB@BA@AD@BAB@D@B@B@@@B@@@@FBBAHADCB@BBB@@@B@B@@AB@@BD@@@B@B@@ABA@AB@@AB@D@@ADADAD@B@BAB@@@FCDCBC@CBEBC@ABADAB@B@B@BB@@@DB@@BBBBBF@B@BB@BBB@D@DBDDFDFFFBDB@@BBBD@D@H@B@B@FAJ@F@F@D@D@HBJDHDJDBDDBBDBHBH@HBBBBB@@BB@BDBBB@B@BABA@AB@B@BAB@B@BB@DBDBDBB@BBBD@@BD@B@BAD@B@B@BAD@DABABABABABCBA@C@CAC@C@AAACA@AAAAACE@AAA@AAC@AAA@E@GBEBG@C@@AC@@C@A@@@CAC@@@CAAG@A@AAAE@E@A@GAEAAAA@@A@A@@@A@@@ABC@ABC@CBABCBEBADGBABCA@CAA@@AA@@AAC@EBCBC@E@CAC@E@E@C@C@C@CB@@ABAB@FCD@B@@B@B@B@BBBDDFBD@BADABAB@BBBDBB@@B@@ABABCBCBCB@DAB@BABABA@@@C@ABC@C@A@A@A@A@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@A@@B@BAB@@A@@@@A@AA@@AA@@@A@@@@@A@ABA@@@@@ABA@@@@BAB@B@D@D@FBB@@BB@B@B@B@D@D@BAB@DABAHAD@@@B@@A@ABA@ABA@@@I@CAGCC@@@AAAAAACECCCECAACCAA@ABA@A@ADCDCDABCBABAB@DADADADEBABABADADADAB@BABCDEBAB@B@DAD@D@B@D@B@@ABABCDCBA@ABCBEBC@@@A@EBE@CAC@C@C@@AGAA@A@AAABA@A@A@ABABABA@@@@BABABAB@DADABABABA@@@AAACAAAAC@@CCAAA@ACAA@CA@@CAABC@@@@@A@CBA@@BC@@@C@AFAD@@@@AB@@@D@FAD@@@B@@AHA@ABAFEBCDCBCA@BC@@B@@C@@BE@@@A@@@CAC@@CC@@CC@@AAAAACKGAA@A@CBABAD@BBBBDDD@DBBBBDB@B@BABABADA@@D@BBBB@@BAD@B@B@@A@A@@@E@A@@BAD@B@@ABA@C@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@@@BD@@@@@@B@@@AB@@@@BBBBBBB@@B@@B@B@BAB@B@BAB@@@BA@CBA@@B@BB@BDC@@@@@@B@@@@@@@@@@B@@@@@@@@@@BB@@BA@@B@BBBB@BABAD@BB@BBBBBAB@BAB@BABC@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@ABAD@@BBB@B@B@B@B@@@@@B@@@B@JADBB@H@HBD@D@@@BC@ADE@@@ABAFMB@DI@@DE@@@A@@BA@A@@@@BC@ABA@ABC@AAE@@@AAA@@@@@A@AAA@@@@@A@@@@@A@A@A@@@@@A@C@@B@BA@BBAD@@@D@@@@@@@B@NAD@H@B@BA@C@E@CAA@A@@@AAA@@BEAABC@@@@@AAA@@AGACCEAEAC@@BC@C@C@CBC@CBCDCB@@@BBBBB@BDBD@D@@DF@B@B@BDBF@DDBBDD@@BD@@DB@@BB@BD@D@B@BC@@@A@I@@@E@C@A@@@@@CAC@@@A@@@@@A@@ACAC@@AC@@@A@@@CA@@@BA@A@ABE@CBGBKBEBE@CBEBCDEBCBC@@BABABC@@DAB@BAB@BAB@@C@A@C@@B@B@@@B@BB@@B@B@@@@AB@@C@@BA@@B@@@BAD@BA@@@A@@@AAC@@AAB@@@FCB@BA@@B@@@BBB@@@BBD@B@B@B@B@@A@@@A@@@A@AA@@A@@@A@@B@BB@@DDB@@DB@@@@BB@@@BA@@@AAC@A@A@@@@BA@@B@@@B@B@BBBB@@@@B@@@@A@@BAA@@A@AA@@A@@@A@@@@BA@@B@@@@@B@@@BBD@@BB@B@B@B@@@B@@@BA@@BA@@@@@@B@@@@BAB@BB@@@@@B@@@@@DA@@@@BC@@BAA@@A@@@@AAA@AAA@@AA@@@@A@@BA@@@@B@B@@@DBBBFD@BB@@@BB@BB@@@B@@@@A@@@A@@@A@@BA@AB@@AB@@A@@B@@@BA@@@AA@@AAA@@@@AA@@B@@A@@B@BA@@B@BA@@BAB@B@@A@BB@B@@B@@BB@B@BB@@@B@BBB@@@B@B@B@@@B@B@@@@@@AB@@A@@@@B@@ABB@@B@@@BB@@@@B@@@BA@@@@@AB@@@@AA@@AA@A@@@AA@@@A@@@A@ABA@@B@@@BBB@DBBB@BB@@B@@B@@BBAB@@@BA@@@AB@@@B@@@B@@@B@@@AB@@@B@BA@@BBB@@@@BB@@B@@@B@BA@@BA@AB@@A@@@A@@@A@@@A@A@A@@BA@@@@BA@@BB@@B@@BB@@@B@BAB@B@@A@@@A@@@A@A@@B@@@B@B@B@B@@@@@DE@@B@B@DBDB@@B@@A@@@AACAC@@@@@A@@B@@@DBB@DD@@@@@@@C@AB@@CBABABCDCB@B@@BB@BBB@B@@@B@@A@@@AACAC@@@@@ABB@@D@B@@A@@@AAC@A@A@C@A@A@@BAF@B@@A@C@A@CAA@@A@@@A@@@CD@@@@A@AC@@@CB@@AB@@@B@D@D@B@F@D@BADAB@@AB@@AAA@A@@@@@AD@B@@A@@@AA@EA@A@A@A@AA@@@C@A@@@AA@A@A@CBA@ACAAC@C@C@EACECCAMAA@CAC@CACA@CBAB@BCACACCCACDCDAFABADCBCBCBC@C@@A@A@A@A@A@@@A@@@@@@AA@@@@A@@@AA@@@@@A@@A@@@@@A@@@@@AA@AB@@A@@BA@@@A@@A@@@@@E@C@A@A@@@@A@@@@@AB@@A@@A@@A@CCCAC@@A@@@@@A@@@@BA@@@AB@@A@A@@@@@AA@A@@@AAA@AB@@@@AB@@AB@@A@AB@@A@ABA@@@@AA@@@@@@@@A@@@AB@@ADC@A@@BAA@@A@@@@AA@@A@@AA@@AA@@@@A@@@A@@BA@A@@@A@@@A@@AA@@AA@@@@A@@@@@AB@@A@@@A@@@@AA@@@@A@@@A@C@@@A@A@@@@A@@@@@@@ABABA@@@@@A@@@@A@@@A@AA@@@@AA@AAC@AA@@CB@@A@A@A@@@@@@@AAB@@@@@@AD@@AB@@@@A@@A@@ACECC@@@A@@@@@@@ADAB@DC@AB@@@@A@@@A@@@A@@AA@@A@@@A@@A@@@@@AA@AA@AA@@@AAC@A@@@@@A@@@@A@@@A@@@A@@@A@@@@@AA@@@@@A@@@A@@@@@A@@@@A@@@@B@BC@A@@@AA@A@AAA@@@AA@@@@@AA@@ABA@A@@BC@A@@@A@@@@@@AA@@@@@@@@AA@A@A@AAACAA@A@A@CHAB@@A@@A@A@@@@@A@@A@@@@A@@@@@@A@@A@@@@@@@A@@@@@A@@@@@AA@@@@@@A@@@@@A@@@A@@@@@@A@@AA@@@A@@@@A@@A@AAA@ACA@EACBE@ABAD@BADCHAFABCBGBEBA@@@A@AAAC@@A@A@@@E@@B@@@BA@@@A@A@CCC@"]],encodeOffsets:[[[107272,31892]],[[107425,31683]],[[107394,31680]],[[107413,31680]],[[107544,31280]]]}},{type:"Feature",id:"510682",properties:{name:"什邡市",cp:[104.167501,31.12678],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@A@@@@@@@@A@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@"],["@@@A@@DGBA@@@@CAA@@BA@@@@@A@@A@@A@@A@@@@C@AAA@@ADCBA@@@@CE@@A@ABA@A@@@AAAAA@C@A@@A@@@A@@@@@AA@A@ABABA@A@A@A@AAABA@A@ADABA@@@AAAE@A@AA@A@CA@@@@CC@@A@C@G@C@ECCAAC@@@ABABCDADA@@B@BABADADAB@@@FCDCDCBA@A@C@ABAH@BABABABA@@AA@@@AAAC@EBCACACAE@E@KAABAB@DADABA@@@@@AA@@@A@@AAA@AAIBC@EDA@C@EAC@A@A@A@A@A@C@A@E@@@AB@@@@@@@@B@@@@@@@@BA@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@C@@@@@@@@A@@@@@@@@@A@@@@A@@@@@@@@@@@A@@@@@B@@AB@@@A@@@@@A@A@@@GBIBCBA@@@@BAB@@@BA@C@CB@@@B@@BBBB@@@BA@@@AB@@@@@@@@@@@@B@@@@B@@@@A@@@@@@@@@@@@@@@@@AB@@@@A@@@@@@@@B@@@@@BA@ABA@@@AB@@ABA@@BA@@@@@@B@@@@@@B@@@@B@@@BBDAD@@@@@BA@ABCBA@AB@@ABCBA@C@ABE@C@A@@@@B@B@@@@@@@@@@A@@@@@@B@@B@@@@@@B@@@B@B@@@B@F@B@@@@@@@@AB@@@@@A@@@@A@@@@@@@@@@@@B@B@@@B@@@@AB@@A@A@@@A@@@A@CB@@@@A@IFC@@D@D@@A@@B@@A@@@BBBBB@@BA@@@@B@@EBA@A@@@AB@@A@@@A@@@A@@@AB@@@@@B@@A@AB@@@B@@@@AB@@@@@@@@@@A@A@@@@@@@A@@@@@DD@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@A@@@@@@B@@@B@@@@B@B@@@@@@B@@@@@@@@@BA@@@@@@@A@@B@@A@@@@@@@IAA@@@@@@@@B@BB@@BDBB@@BB@@@B@DBB@@@BB@B@@@@@@@@A@@@A@@@AB@@A@AB@@A@A@@@AA@@@@@@AB@@@@@@A@A@A@@@A@@B@@B@@BB@B@@@@BB@@@@@@DBBAB@@BB@@FB@B@@CD@B@@@@@@@BB@@@@@@BAB@@@@@@@@@A@@A@@@@B@@@@@@@@@BB@@@AB@B@@BB@BCB@B@@ABBD@BDFCDEDA@CBA@@@A@@@AB@@@@@BB@@@@BA@@@@@A@@@@@@@@@@@ADA@@B@@@@@B@@@BBD@@@B@@@B@@B@@B@BCBBB@@B@BBD@B@@B@D@@A@@B@@@@A@@B@@A@@BCB@@@BA@@B@@B@@@@@@@@B@@@@@BAB@BAB@@@@A@@@@@@@@@@AA@@@@@A@A@@@@@@@A@@@A@@@@@@@A@@BAB@BA@@@@B@@@B@B@@@@AB@BABAB@@A@@@@BCB@BA@@BCB@@A@@@AA@@A@AB@@@@@BBB@B@@GF@B@@@BAB@@A@@@@@C@@@A@@BA@@@A@@@@@A@@@@@@BB@@@@@@@@@@@@@@B@@AA@@@@@@A@@@@@@B@@@@A@@A@@@@A@@BBB@@@B@@@@AB@@@@ABA@@@A@@@@@@@A@CAACEH@@@@A@@AA@@@@@@A@@@@@ACC@@A@@@A@@AA@@@@BA@A@@B@@A@CAA@A@A@@@A@@BAD@B@@@B@B@@@BB@B@@@@B@@@B@B@@@@BBBB@@@@AB@@@@A@@@@@AA@@@@A@@@@@A@@@@B@@@@B@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@A@@B@@@@@@@BF@B@@@B@@@@@B@AB@@@@A@@B@@@BA@A@@@ADA@@@A@A@AAA@@@A@AB@@EJA@ADAD@FBBBDDDBBBFAB@BCDCDAB@BCDAD@FBDDBBBDBBB@B@BADCDADABIDEBGDCBADAD@BCBCBA@CFAB@BADABADABGBABCDABAF@B@F@D@D@H@HBFBFFHFDFFFFFDFFFDFBDBD@B@B@DBBDDF@F@DADCDCBA@A@AB@@AB@BBD@B@D@BCDEFCDAB@@ABA@ABABAB@B@D@F@DEFCFCDCDAB@FADABAB@B@BBD@BBB@DBBABCFC@CBA@@@AAAAAAAAAACAC@CAC@A@ABCBCBADCDA@A@CACACCCAECEAC@C@AAG@ABC@ABE@ECA@AAA@A@A@ABEFEDEFABAB@DAD@D@BAFCBA@@BA@ABC@C@@@A@@@@AAA@@@AAACA@@@@@BABAD@B@BBB@D@B@B@B@B@@@BCDABABA@@BBB@B@D@DBDBB@D@@ADADABAD@D@D@DBBBDHJBDJHDDFFDBLHBBFF@DBHBDDDFBJ@F@F@BBBB@HDJ@D@BDFBDDHBDHFBBB@B@BCHGJCDEBAD@BBD@D@DADAFGHCDCHGDAD@D@BHDBDBF@@@BBDRFHFDFBBB@@BB@@B@@A@A@@AAA@@@BEBAB@@AAA@@B@FCBA@@BABA@@@C@@AA@A@@BABA@ADABA@@@@AC@@BABAB@@A@@AA@ADA@AAABAD@BAB@@@@@@AAA@AC@AA@@@AB@@A@@D@BA@@@CBC@@@AB@@@DB@@BBBBB@@@B@@@@A@@AA@A@@B@B@B@BBB@@@BABA@@B@B@@@B@@ADAB@@A@@@A@A@@BAD@B@HBB@B@@@@B@@AB@@AB@@BDBD@B@@@B@@@BB@B@@@B@@A@@@@BAB@@A@A@A@@D@@A@@@A@A@C@@BAB@@@F@B@DDBBB@B@@B@BB@@@BBBA@@B@@AB@@@@AB@@A@A@@@A@AB@@@BAD@@A@C@A@AAAAA@@BAB@DAB@B@DAB@B@@A@ABA@@@A@@CA@A@@@@BC@@@AAABAB@BAB@@A@@@@AAA@ACA@AA@A@AA@AEACACACAAAA@@@C@@BCBABABCBABA@@D@D@D@B@@ABA@@DABADA@@BAD@@@BABA@@D@B@BBBAB@@A@ADABADEBA@AAC@CAA@CAA@AAAA@CA@@AACAAC@@@A@A@A@AAAAA@@@A@@BA@@DCDA@ABCBC@A@C@ABABA@CBA@ABAB@@A@C@CBC@AB@@A@@AAC@A@A@@A@CBABAB@BABA@@@ACACCAA@@BE@A@A@AD@FABAB@@A@A@@B@B@BDB@B@DAB@B@@ABCBC@A@C@AA@AAAAC@CAEAAAAAAC@ACCCAAAAAAA@AACAC@AEE@A@ABEBC@A@A@E@C@ABABEBC@C@@AABABCDADABABBBA@@@A@AF@BAD@DAB@DA@ABC@A@A@AAA@ACEAC@A@ABCB@B@BAFEBC@@DABAB@BADAD@BCDCFAFAFEB@DABABA@ADAD@BAFAB@BCBABBB@D@DAD@BADAF@@@DCB@D@B@DB@@D@BAF@BAB@DABCDABADABCB@DAD@B@DAB@B@B@DABADABABAD@HEDCB@FAFADAH@BABABCBABAD@BA@@DCD@D@@@B@JEB@F@DAB@BA@AB@BA@ADADABB@@B@B@BAB@F@FCD@DAB@D@B@D@BADABADABAD@FCDAFAB@BAD@DAFCBAD@DADA@@DEBABAB@BAD@DADCFCFGBA@A@@FC@@FABAB@B@BA@AAACE@A@@BAB@@@BA@AA@AAA@A@@BAB@@A@@A@@@AA@AAC@AAA@A@A@@@@AA@E@CAC@A@@A@A@AA@@@KB@BA@@BAB@BA@@@@@AAA@A@AA@@E@C@A@A@@@AA@@@@@AAAAE@@@AB@@@B@@CDG@A@A@AA@A@C@@@@C@C@AB@AAA@A@C@@@@ADEBA@@@AAACCCC@@AA@A@@@@A@@@@@AA@@@AA@A@A@A@A@C@@@A@@BAAA@A@A@CE@A@@@A@@AAAA@A@A@@@@BA@@@@AAAA@AA@A@@@AB@@A@@AAA@A@@CBC@AA@@"]],encodeOffsets:[[[106606,31776]],[[106696,31831]]]}},{type:"Feature",id:"510683",properties:{name:"绵竹市",cp:[104.22075,31.338077],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@BABADCDEDCBCBCBCBABC@CBA@C@C@@@ABABABABAD@FAD@DAF@DCD@@@BADBD@BBDB@@FBD@DADABAB@BA@@@AB@BCBCBABEBCB@BCBCBCBCBABCBAB@BA@@BADAB@B@D@FAF@FADADADADAB@@ABABABEDCBABA@@BA@@@ABABA@A@@D@B@B@BAB@@@@A@@@AAA@AA@@A@@AA@A@ABABC@A@ABADC@@BABADCDABA@AHEAC@A@C@AAAA@@@A@AAE@E@CACCAA@ABABA@@B@@@BB@@@@B@BB@@B@BAB@BABA@C@ABA@A@@BA@@@AB@@AB@@A@@@@DIFCDAAA@AAA@@AC@A@E@CAA@AAAA@ABCAC@ECECGECE@C@A@GBG@C@@@EBA@C@A@A@AAC@@AAC@AA@A@@BE@A@@@AAAEAEEA@@A@ABC@AA@AAA@@@AACCACAAA@ACCCAAACCACAA@CAA@EAAA@AA@C@CA@@AE@A@@@@AAA@ABA@A@A@@AAA@A@A@@@A@A@@DA@A@@BCA@@AA@@@@AAA@A@CAE@C@A@AAA@A@@AC@AAA@AEEEECACA@@AA@@A@A@@ACAAAEACA@@AAA@@ABA@@D@DAB@BA@@@C@ABE@C@@@A@@@AB@@@BABAB@BA@AA@AAA@AA@@AA@@@CBE@EBE@A@EBCBEBGB@@ABA@CFKFM@EBEDC@ABCA@A@ABEB@@ED@@@BABEHEDCDCBC@ABA@ABABCF@@CBCBC@ABEDCBC@ABA@EBCBEDC@ABCBABCBABC@A@C@A@CBC@EDE@A@ABA@A@@@AACBCB@BABA@@BABA@CBE@A@IFA@@@C@C@CD@@ABC@ABABADABABG@CBEBEBA@CDGFC@ABABCBABCBA@A@A@CBA@C@CBA@ADCBABCBADCBA@ABE@ABC@@@CAA@C@A@CD@@E@CBABC@CBC@A@AAABADA@EBABC@CB@BABABCBA@EFEBEBCDADC@CBABA@ABCB@@ADEFABA@A@AD@B@BBDDF@BBB@B@B@BAD@BCBA@CBC@ABE@@B@B@@ABAAABCBCBADABBB@@@DADAFAB@B@D@F@B@BADAF@B@BFF@BBDBD@BBBBBBBDBDD@BBDBBBBFBDBD@BBBBB@@B@D@BADAD@BA@A@CBA@A@ACA@A@@@@B@BA@ABEBC@@B@B@BAF@@BBDDDB@B@@ABABA@ABAB@D@BB@B@D@BB@@@BA@@BAD@D@D@BA@AB@BAB@DABAB@B@D@BADAD@BCBCD@@AB@@@B@@BBBB@B@B@B@B@@BDDBBB@@DBB@BB@BBB@DBB@DBD@BABCFABCB@B@BA@ABAAA@C@@@ABAB@@C@AB@@CBABCB@@AB@BA@C@C@C@@@ABABADABABAD@@@D@@BBBBBDBDBDBFB@@B@BBBB@BDB@BB@@@@@BA@ABA@ABBB@B@@AD@@@@@BDB@@@B@@AB@B@BA@A@CBA@A@CBA@AB@@BBBB@B@B@D@BC@AB@@A@@B@B@@@B@BA@@B@@A@@BA@@@ABAA@@A@@A@AA@A@AACCA@E@@@A@AB@@@D@B@B@@@BC@@@@B@B@BA@AB@@@@@BA@@@A@A@@A@@@A@@@AACAC@@BA@@BA@@@A@@A@A@GAA@C@AB@@@B@B@@@BA@CB@BA@@@A@A@@@ABAB@@A@AAA@A@A@@@@BBB@@@B@@A@@@A@AAAA@@CA@@A@@B@@AD@D@@ABC@@@@BA@@B@@BBD@@BBB@B@@@@A@ABC@ABBB@BCB@BBB@@@BA@ABAB@@BD@@@@ABCB@BABAB@@@BBB@@@D@@ABAB@@ABEDA@@@BB@BA@ABAF@@B@BB@@@B@BA@@@A@@@AA@@@@@BA@A@C@@BA@@BDD@BABCBAB@@@@@D@BAB@BAD@H@BAB@B@D@BA@A@@B@D@DBFBB@@@BBDBB@@AB@@@B@BB@BB@@@BB@BB@DDB@BBB@FBD@D@DDHBDDF@DADCDEFBBBB@FAB@F@DBFFDFDFFDB@DABCDBBJFRHDBFDJDDBHDDDFBBBBDFJBBBBHHHDFBBDFFFFFBDBL@B@F@FAD@DAJCBAD@FDDBFFJHFHFFBBFBDBF@DBF@BADALAH@B@D@D@DADAHCFAJCDAD@DBB@BBBBBBBBDBB@B@BAFAJELCFCB@BABAAA@C@ABA@A@AAC@@AAECAAEECCAEEGAECE@EAABEBCBGBCAA@EBA@ABCBA@ABABADAFCFADCFCBCDABC@C@A@@@ADA@@FAD@DABA@A@AACACAA@ABABABABADADADABABADC@AB@@A@CBCBC@C@C@C@E@G@A@ABA@@DADAD@FADABABAB@@A@A@A@A@AACCCACCCACAAAABA@CBCDC@EBCBC@A@C@AACAC@A@A@@B@BAD@D@B@BAD@BABADABAB@@BB@@BB@@BBB@BB@@BD@FBBBB@D@B@D@DAD@BAD@FADAB@BAB@BAB@BABC@ABABCBA@A@A@AAC@A@@BABAB@B@JAB@@A@AAA@CD@"],encodeOffsets:[[106720,32249]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("甘孜藏族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"513301",properties:{name:"康定市",cp:[101.957146,29.998435],childNum:1},geometry:{type:"Polygon",coordinates:["@@@T@NBDBDBBHDHBJD@@@@FDDBHJDB@BDBDBBBFBPDFDDDDFHLFDBDFBZJFDFFFDHDFBBBFAD@FBDDNFJDDDDHDFFFFLDBDBJ@J@DBFBHJFFLF\\JDBLF\\FF@HB@DSlAJ@NAFYDaJGDAFBFLNTXNNEXGFCD@D@XJXFB``JHBDAhDdA\\GPINOLCL@ZARF\\DLTfBBJHRB|CCXCXFBXIRGNKPGPAXBP@@DP@JD@FDNEFAL@HL@LF\\DFnPVDJD\\LPFRRABCLOBIAI@KDEFEJMZCLCLERCRBZBL@NBXBHPPHHBNCHEHMJOBMAaECASRBLHLJFDDFLAB@@@@@FI\\@J@LJPFBhBJJCRAHERO^UPEBSDOFAXDFFTDPEXEPKNIHM@KGsO]G[@SDEDABADCDCFCFADIDABCDCJ@B@BHDJDDBDBDBBBB@FFBB@@HHDDBDDDBBBBBD@@DDDDBBBBBDJHBB@BBBDBB@DBD@FBD@BBBBB@@BBDBH@HADABCBGBwBA@ABAD@D@BBH@BABCFABAZ@F@FDDJFFJBD@DBB@BJHJJLJLL@D@F@F@PAf\\Rz^DBBB@@BBB@B@D@J@DADAFABAHABADA@A@@@A@CAA@@@C@E@A@ABA@CB@@@BAB@@@BAAABAB@B@F@B@DBB@BBFBB@BBB@DDB@BBFFDBFBB@@@F@@@L@DB@@HBDBBBBBBBB@BBBB@B@@DB@BBBBBBDBDBDBD@B@BB@DDBBBBBBBBBDBBB@BB@@B@BBD@@@DBB@B@FD@@B@@BBAB@B@B@B@B@B@BAD@BBB@D@@@FBDAB@BABADABAB@HAB@B@B@D@D@DBB@B@BBD@B@D@BAFCBABABC@@DG@ADCBCDCB@D@BABA@A@@BCBABABCBAB@@ADCBCD@FBFBF@B@DBBBBDBDB@DAFMBAB@BAD@LDB@DA@ABABCBEDAFEBAFCFAD@@@D@B@BBBBBFBB@BBDBFBHBDBBDBHDB@DDB@BBBBFHBHBBVJD@JAF@FBFBDDBD@B@@BDB@BB@B@B@@BBBDBBBFBDBBDBBBBBB@@BB@BB@BBBB@@@BD@@B@BBBBFDFBB@D@DBBBBCBC@ABCBA@ABC@ABA@ABC@C@A@ABC@@BAAABABA@AB@@ABAB@BCBABABA@A@@BA@@BAB@BBB@B@DAD@FAFCBAFA@@DABA@@FAD@HBBBB@FBDBB@B@B@@@DAHED@RCDDPONGD@LDXFDDX^NTHPFPJVHXD\\DHHR@BHVBJJTLJTRJFPNAr@HBDx\\FDFFDJ@TANGPBLFTLPTHVCRGJGPARJTNPBLCPINMFBFB`jJXJFDF@PA`A`QP_DGVONKXWHCL@HAHCRQJGDADDDLBTFD@\\HBHBbNBDFHH@HCHAFC@MHETEJERDRTD@JBDBALANFLFDdZBFBF@DB@D@HAB@BBFHF@FCF@D@DFJRDBLBTRJJFDNBPALB^HD@H@XMXQPCjUHCFAFBFDHHBBP@H@DBDFBJ@VE\\BbSdCNDFFFHIBIHIRSBGCGDSBC@CAACC@AB@BAJCBABCAC@CECEECGAEBOBGAIGGMGCCAGHM@EACCQAMGGOGOMCCMIKKEOGOASCSAQAOCQAGEQAOCOBI@M@K@]DWBM@MAKAOHMJKJMDKAKIkCKAAAQFK@AJIBIAK@MEM@ACGCKCKGOGYCMAI@C@KCS@MCMAOGiEKGCIEMIKAOGIEGGIEIEGCCCKCQMIKGMEOGIEGCEEGS_CIEGCIAI@IAE@KDEDKAEECI@GAGGEEOUiPG@I@IACAMOIICIEKGGGCGEKAOAKDMFSJC@OAIAGAKGQIYSA@GMEEEEKIMGMCMGOEMAOCICECGIAE@Q@IAI@KAI@KBMBK@CDM@MAIGEGECASKKCKCICMAUICAKEKEIICQEYKWMMIUBm@EIKKGM@I@Q@E@MEEEMOMUOIAAEAs]CAACU[MWCECYAYDOJM@SIOEQBEBEDMDWHSTUJEPKHIDC^oFMBGLSPIPEFELODUDQT]RkFMBGBEBGCQ@MHQHELCRCNALG@GAEO_CYAQGIMIIIEOCQCMBI@EFWDEJMG@EAIGe[kQICUISEkcyECEAWAU@SIUEUOKCeMQAKAU@KJABEFEPCTBFJjADCHGLSRKLOLKDIDQ@MGE@E@SFEBGFA@]DOBOBOCGGMEMCI@EBCLERIHOAOIMKSOQCKBEPBZDRJRBJEPE@QB@@A@IBG@GAeQCCOMSGQKIKEQKKKQSCMCMC@AMEGCGGAEEKQkCEGQKOIKGMKKKGUAQCQDEFWVIL@N@LCJMJODUBaGIEEEEKEKCGAGEMEMQ_EEÃgICcINGNIHKFaJEBCDCJAFCZCHAHGHCF@D@FBFNXBF@DADCBEBIBK@CBAB@B@DDFHJFJDPBPCVEZANCPAJ@DDJFFDDBF@H@J@TADCDEBE@CAGEGGCAC@EBQFAB@B@BDH@B@BABCDKHADAB@BBBBDDBLFLDHBL@JALANCHCNKHGDAD@BBB@BD"],encodeOffsets:[[103498,30056]]}},{type:"Feature",id:"513322",properties:{name:"泸定县",cp:[102.234617,29.91416],childNum:1},geometry:{type:"Polygon",coordinates:["@@EAMACHCJBFFJFD@FCN@JDJ@B@B@HAF@@A@ABAAG@A@C@ABABAB@@@BBDBFBBAD@HAFADA@C@OBC@EAGAA@AE@CBM@G@IBK@C@@A@A@ABC@E@ABABADABA@C@Q@KCGACACAAEAAAAEAYEYAC@MJAAG@OGEAEBSHGBE@]MME[@QBG@E@KCE@IDIFKLIFG@G@KAGAS^CRCVKPEFOFOJKTAHEN]pCDGJOLIFSVGTCXCNAFAFFRJP@TINCPBZDZDFNXV\\BDDBt^FBBBPJNVNPFFNFF@R@J@N@LHJL@FAnJVNNLXFZDRJJLFLFDBVJNBJDLDLDTLDBHFHFBJ@NCN@DALAN@LBJ@LBJ@J@RBFHJFDJDPDNBPFNHNDNHLJFFFFHNB@ZTRJLHHBJBPBD@TINELCPBLBHFHDHHFLDJJJNPDBJBJ@H@jOF[DQBMFQAIAQ@QHOJKRSFKFMBM@OHWCGCCEE@CACDEDE@CAEACGCQSEUBI@IEIIIGK@GFOPSTI@U@Kd[FAXB@IBE@I@KBIBCLILCLEJMDGDKBIAIIOKIIKEAGACA@E@CBEHIBEBEBEUKIGKGIGGEGIEIEMCMKyCKCMEOCMCI@KAKBGDIJIHEFCfSJIDEDU@ICSEQ_W·]O_WKKGAGHGHBFBDG@G@"],encodeOffsets:[[104639,30176]]}},{type:"Feature",id:"513323",properties:{name:"丹巴县",cp:[101.890358,30.878577],childNum:1},geometry:{type:"Polygon",coordinates:["@@EEAKDSFwAQ@[@MCKIGKEEEIGCEGGAGAIBGHEv_FI@EAA_HE@]u@EZIDEDG@E@K@CAEGEII@@@@@@AC@CBGDGiI_EAEAMNOJKDOASMQIOBIHQHUDSGKOESAKHOBM@SCIEEECw[AC@GBqOMIESQKIISAIGU@AGQCGC[GWIUEOGOMSW]CCWEKCC@MHOP@BADADCDCFCFAFCFAFCDCDCDCDCBCDCBADADAFADADA@EDEBGDCDEDCFIHADABBDBBDDFBDFDF@B@DBDADCLAD@BABEBIAUEE@GDGFONCDKDMDQLED@FCDADE@MB]BGDKD@BBBNHTXHF@FCNBL@@ADCBIBABEBIBGDCBCDCDCDGFCDAD@D@FAFAFAFAD@@DHHJDDDNEnBB@D@B@DAB@DABADCFABCBCDABABBB@HBDBD@B@B@BBB@B@BBD@DBJ@@BFBB@BBBBD@@DJDL@B@B@BBF@BAF@@@D@B@DAF@FAB@D@@@D@B@F@BB@@DBD@B@D@D@B@D@BBF@BD@B@@BBB@DBHDF@BFDFBBBB@FBF@D@JBBBJ@FADAB@@BAB@HAF@FCLBBDDBFBDDFDD@DDR@l@FAFA^Af@DORCFSBSDMRGJQVABQBYCaBMDQDSRUXUXADINOLYLIBMBKEGKCG@ODOAQAOAGAUEGA@U@O@CBEHKFOBQDCDEDETAT@\\ERAL@DYLABCAWGQBGDEDGDEBCDQpCHCLABIDKDAD@DDJBFFDDFHBFBFDDBPDF@D@PEBABBDBBBDFFFFFBBBFDFBFBBDDD@VBLDPHFBFBD@@DKHA@CF@D@BJF`NB@JBHBHDFDBDBBABAD@F@D@FBFBD@LBFCLAL@FAF@DBBDBFDHBB@B@@DBD@J@BABCFAD@LADABCD@B@BB@HDLDH@HDJFFBBB@F@BDD@BBL@DAB@F@BDF@B@FBD@@DDDDBD@DBF@DBD@BDBDBB@JAJ@FAF@B@@BBDCREJGJIHUJIFE@ABAD@DDFJL@B@BCBCBAB@@@BJDD@@B@BADCBAB@@AAAAA@AAA@BBBDB@BBBD@H@@ABCAOEA@@@AB@B@D@BJL@BF@DBBBBBBFBBB@F@@@BBBB@B@BCH@D@DABA@C@CBCBGAC@ABIH@B@BJ@F@HBFBD@@@FEBBBBBBFDDBBDBBDLBBBDBFB@BBH@D@FBD@B@FBPBHAHAB@DDDFB@B@FAD@D@HDLBF@DBD@D@@@B@BAXODADCBC@CBEAGCG@ABALOBAB@B@BBDDBFBB@DB@FDDBFJFFJFBBBB@B@B@F@BBBBDPJHFF@BBDAF@BADBB@LF@@BADEBAB@DBD@DBHAH@FABAFODCHCTKJETGBCFOFOBUBAJCBC@ABCB@FCHAD@BCBGBG@YBCBCB@D@FBHDFDNFJDHBJBPFJHJBD@B@BC@EAGAECACAAA@A@ADG@E@AACB@BCHCHALAV@JBPHNDLBJ@NALAHAHCDC@ABAB@H@HCDCHK@C@EEI@C@EBAJMBA@CCCCCAABCBAFCFCBA@CAEEKAEAIHODEFAHCNKBCFQBY@KDALCLEHEHEDAFCB@DDPL@HBFDD@@@@DHB@B@@@BBBBDBDBF@\\HD@F@DBDDDBB@B@B@P@B@BAHSHODCBADAFATAJCBCDCD@DADABA@C@C@MDI@C@C@ADC@CBIBCBA@EBIDG@E@ADE@A@C@CBCB@DC@ABCBIBCDCDCD@B@DBDF@@B@@@DAF@DAHBF@FBDBHDXLDDJPFDFFDBHFHHLHLDFBFDJLPRFDNDHBHDFFFBDADABADE@EDGHE@C@C@EAC@ABCHIBCBA@EACACEAGEAA@E@AB]BEBEBE@A@ACCAA@@BCDC@A@CAACAA@I@AAAA@@BAHEBABKBCFGBC@A@AAAAACACC@CAKBC@ADC@ABCCQ@KDAVMDABEF[JOLKBG@EIOAIBEDGTYBIAECAIEQMICIAEACBILC@G@SAMCE@E@[@UAQCUEKEIEKIIIGG"],encodeOffsets:[[104518,31615]]}},{type:"Feature",id:"513324",properties:{name:"九龙县",cp:[101.507294,29.000347],childNum:1},geometry:{type:"Polygon",coordinates:["@@kDO@IBCLKZEBIE[MUMMO[SKIECIEaMQEOCWGE@mFIBEFGnBjCVGP@F@JAFCD@HBJAFCBCDE@E@E@GTBHEHGRCTBNCJEFADBDBD@JMFAB@DBF@DONA@GGYGOKSGaUCBEHCHBDbVbrLLHJDFFBBADGHKFE@CDAF@FDF@LANAJBH@XAFCBCBCK^ENGHGP@JCbMN@RARDJDJHPFJFFBJBHBD@@B@BCBGJ@BBFAFEBEFAB@F@DBDDBDB@B@BADCDAD@D@BFNADEBODUNMPIROVGPO@MDINEHAFABEBICEAEEMESCG@GDCBALAFADWXGNEJQHOBYCMCOCIFAHALGJOFWASIUMUEaAABFNBH@HAFKLOZCBEDI@G@ICKCQBMBGDGDEDG@G@YKOCO@YD_@G@E@GA_OWKG@ABBDHJBN@Z@JLJ@D@H@JEJENEJAFBJBNCP@DLT@D@DKDKFGDGLS\\CHAFBJDP@FGJ@D@DDNBLCPCFBJDLFXJLDH@LBHHLHN@D@D@BEBI@CBABAD@D@FJt@JAFADCDCBCBOAG@C@CBCDAFCHIdGVEVANBDFHJJDJ@FAHAFGDIFEDAB@F@P@JAFADGFYPGHCFAFAH@PDZBPBLCRCFIHGLdJDÄhFFR`FNFNBHDHFLFLFFJFbHVAPCNIDI@K@MJKXUFERCRDVBLHLLHNJLLPHRDFRlFLBFHHHDNF@BNDNDTDLRLLFRJLRLTHPNDDfRHBH@JAB@@@RAF@FOAIIQCQAYFOLARDTPNLPJPBJGFQDKFAJ@NDNFHHPDPAPA^CB@HEFATEF@F@NHR@JCLCPKLKTQHKDGBCIiAEDSFOFEBALIV@LBRBfNLDVPVFTJV@XBFBFDzldTFVJJDlRf\\JHFBH@JIJCFCVMNC`@J@N@BCDCFANAJBJFFDF@LAXGLELGNELGPMFEFGHIHKRUHCBE@GAEESCCGEGCICKEICMIKIIGKICAIGKICC@EFEHAN@J@N@NDJLHFJFP@JAHCH@NKBE@EAADSJGLELKLGJODI@IESGQCG@GHKBGBGVS@EGKIQCIAI@EBGDELCUEIEMOOMICSWQUIIMKOIICMEGCOKIIKICGIa@G@K@EECeOICGAEDEBE@KEMMCIMICEAGAIBQBKEMKMGGEESWCKBEFCLGLKNILE@IASBK@GHGFI@MBU@ENSHEFCFCJIHK@EEECEGEEAGA_GMEOICCGMUSGEEEMCO_CQGCIGCIGIAGCIAO@A@AMACAA@AACCAAEAKBGBOHGDKAICIEKGICgMCAYUaQEEEEECEECAEAMIECEIOISMCCAE@GBGBI@K@GAEAGBGFMACCIGUCYAI@A@@@@CGACEEACKQEGAEAC@EBQBCAIACGMGGCMEMCEACECGCKCEAEACECCCE@@@@A@@AA@@@GE@@@@@@@@@@A@@@AA@AA@AA@@@@@AC@EAIAAA@@@@@@CAC@"],encodeOffsets:[[104106,29033]]}},{type:"Feature",id:"513326",properties:{name:"道孚县",cp:[101.125237,30.979545],childNum:1},geometry:{type:"Polygon",coordinates:["@@UIAAAGEGAAAAA@CCA@GCCAAAACAGAEAC@AAAAEAAAAA@C@@@C@EBEDABEFCBAFADAB@BCBA@KCC@ABA@ABENCBA@ACACAACAA@E@EAEAC@ADCD@BA@ABADABABAD@@@BABABC@A@CDADCD@BCH@@ADABABEDABC@A@C@AAA@A@CAC@C@A@A@A@GBA@ABCBABABA@CBEA@@C@A@AAC@ABA@A@A@A@A@A@AB@AA@@@ECA@A@CA@@C@AAA@@@AAA@AAACAAAAAAAACCA@@A@AACACACACAAAA@ACA@@@AAAAAA@AAAAAACAGA@@CAK@@@E@@@A@EACAEEAAA@CCA@AAA@EAAAA@CAA@E@A@A@ABBBAB@@A@AB@@A@@DAB@B@B@F@D@@BB@D@B@@@BCBABGBABEBCBCBI@C@A@A@AA@@AACAy][QGPAFMTOTILEF@FBFXd@DADG@o@GDCBAD@NABABIAA@CAC@AAC@ECCACCICC@A@YSYK_KGEMFMHOFUHGB[VGFIZCDCNIBGEA]KMO@MHIFM@OIGYASIOCGKIWMOCQEGCGAMBEDCBmhUPWNstMFOJQTalSRE@C@MXBfCP@VBDtpBBJXE^EHKTin]bABAPBHBDFBP@DBDR@VJNbVHFDLEXAXFJFTHTEXMN]FWFOHKNWNILUPIB[HWDKHIXQJMDELE^OJUAGHKLMAYCUFWPGHMR@FFrCVMPCBCBAFCJAJBBFHHNDDBD@F@F@BBBBBBDFJPNHLVX@BBBBB@DCFUJONA@EDA@IAE@CBGFCBEBE@ECIAG@C@CBAD@B@DDF@BJPDHbTZLFJD@FBHBD@DA@A@EAC@EDABChYLGRIP[V]fSJERQVMf@ZJ\\N\\LdXJX@B@FERCLCFAVARETE\\FZPFFBVRPZFP@@@@BJMN@ZHJXJbLHB\\H\\NXXPrDNBDBHB`FJTDRGX@DBH^AJKXMp@LXlF^NL`B\\ERHHBHZCDOPSTELKZEZCdF^P@RURABTEVJXBFLLJQHUDALCLCH@DABA@AAIBGJKDCD@FBHBDDDHFHTNLDFDDDDBvRJDHBFBHDB@D@LEH@H@FDFBFJDLBLDFJNDCHEFCHIFCDAFEBCDGTaDIDOFUFO@IA_CIEIEIMUCW@AAMBGBETKBA@CAG@A@@D@DBH@D@HCDCF@H@H@D@DAHGJOFEDAD@DBBBBD@F@DDFFDHDFJLFHFF@B@DABC@E@I@E@CBAH@NDH@D@D@BBDDDDBFBDDBHDD@B@FHDDFDHFFFDBBBD@RCF@F@BBBHDH@BBJ@DBDBD@DBFAFCD@D@DBDDDDBBBD@D@F@D@D@B@B@BDBDB@DAHCHARCFABABEBC@C@@DAHGBEDE@E@CCGCE@CEOAGAAA@GDCBAAMWCEQGCCCG@C@CDCDGBC@A@CEIAC@A@ADCDCBGDC@A@C@C@CFEBA@C@E@O@EBCFC@ABABIBADAFAB@B@BBFJBFBB@@B@BBB@BBBB@@BADABC@A@AC@IC@A@@BADADA@A@AIKCE@CBCBAF@JEVIJGHIFIDQAC@AA@E@EBI@IBA@CACA@AAC@CAE@CACCCCC@@AC@E@ACE@A@EBA@CAK@ACC@A@EAAEAIEGCG@KCGCA@@A@ADCBABC@KBCDEBA@A@IAC@CA@A@GAECCAAA@CBE@EBKDKAE@KACAE@E@C@EBCBAAAACECGCGAIAA@_MIE@A@CDEB@LG@CC@EAEAOGKCUAC@CCAAAECEAEAAEEEECEAACAAAABOFC@E@OCCAECEAGACEECAECI@CBCLCJCBADKDGRoDCFAHCFCHCRAXHDBBAZK@CBKFQ@[BSFSFCDCRCPALEFGDAP@V@B@FHBVBHBPBRCP@PDHHLLFNAJAZKPKJMBCVWVWTQRCNCbAZDRABARUHINQTCTADEPQ@CBeB]BE@E@kCQ@CCCCEACAECCAADK@EBE@GBA@AA@CBEBI@AAIAC@E@EAA@AAEAEC@ACEAG@CAA@AA@C@@AAE@A@C@A@C@C@AAC@CA@@A@E@A@C@@@CBA@EBE@C@A@C@@BE@AAE@A@A@ACKCI@@ACAA@AAAAE@@AI@CAC@A@AAA@A@A@AACAC@GAABABADCDABADEBCBA@CBA@C@A@CAAFmCMCCGICG@@BCBEBEBE@E@CBCDCHEDCDCDCDAHCJAFABAJADABC@@AKDM@EGESWMGAA@ALCHC^ANAF@BCDC@EFCRKNCLCDCPMHEHCF@VFJBFABA@ABCDKBCAC@C@ACECEEACCAAACBABCJGDEFCDCHCFAFCB@BCBCBEBCBCDADCDADCDCDCDCBEDEBEDEDEDCBCBC@ACCQDC@GFCB@@A@A@A@CAEAA@AAGAC@EB@@ABCB@@EBABEDEBC@CBA@A@AAA@AB@@AB@@@BABABABADA@AB@BA@@BABABBBAB@@AD@B@B@DAD@BAB@BAD@BABAD@BADADAACAC@A@EAECAAAAA@@@AC@@A@AA@AAAA@@AA@AAAACAAAACAEAAACAA@@@A@AAAA@AC@@@AACCCEAEAE@IBC@"],encodeOffsets:[[104054,31150]]}},{type:"Feature",id:"513325",properties:{name:"雅江县",cp:[101.014425,30.031533],childNum:1},geometry:{type:"Polygon",coordinates:["@@BH@DABEHCBA@ABAA@@AA@GACCCCCC@@@@@@@C@A@A@AB@B@D@B@@@@AF@B@@@@@H@BCDKDOFA@A@AA@@@A@AB@BE@@@@BEBAAA@AAACAC@@@@@A@E@A@@@CBC@@BADAHA@ABA@AAEEGECCCAC@E@QB@@AB@BAB@@BH@HBP@@@@@@@DABADCBC@OBG@CBGBMJIDCDC@@@@@@@A@E@G@EAEBABA@AB@B@B@@@@@BBBBFDJ@B@D@BADEFEFAFERABAD@@ABAB@@@@A@EBEAE@CBA@CB@@@@@@@@ABAD@B@J@BABABADABAB@BALAHABCBABC@EBA@@BAB@B@FAPCFAFEFMPGJEF@DAFALCFABCDABABC@AAC@GACAC@AB@@@@@@@@ADAD@@@@ADCBABCBCB@@@@@@KDIBCBC@@BAB@DAF@HA@ABABE@IBC@AB@B@BAHALABAB@@ABC@EACAC@A@ABCBADCFAD@D@B@DBHFVXMB@H@NJBFDJBNDFABABGBODGDCVELE@ECG@CBCFAF@B@D@HBDBD@H@F@DBB@FBDDFBD@B@RI@@PGF@FFJFHBNBZAHAF@BB@DBFBBD@JAPIHAF@FBJFLH`JFDDF@DJLFFNJDHDPFZADCHW`CNCLGJILCL@FDHFDNLD@F@TQbkRSPINEtsXMVOngDAFCNAHBHDRFPDXNLJDHJPBTHZPJN@JENGP@LNB^HFJADMDCJYHE\\UHAVGPENGNEHF`LZLZTB@D@JDDDDBFDD@BBD@DBB@JBBABA@MBCDAHCp@H@BC@CWcAE@EFEJKPSNSBEHOBe@O@E@E@CKKKIIIIG@AAA@CACEIIECC@E@EBYBADEBA@AAG@A@CBCBAB@xAHADABABC@GAGAC@AA@AAAAC@EAC@CAA@CAAA@AAAIGACAAAACCCC@@ACAAAACCACCCGG@@AAEEA@AACACACAICGC@A@ADIDCBAJCBCDEDEDCBCBAFCTC\\@^HtPLHN@JGLMFOFWCOESCEBWPETCFAVOP]FQBGDQIIgAEAIO@K@IJ[@E@@@@BAEKCCIEGKAKTQDBbFNBPANIFGDGAMGGOOAGAW@MAKAYDQFQDKDKNYFIFELCJ@JBPADKBAQQOE[KICUCmOCEE[@KGKK@EBMFECC@@ICO@@AOBWHOLOHMJQAWWEWDD{DQAIGAASeCKE[BQ@YDKPKJMHOB[CcBgACIG__EAIW@W@CDCHEFWMMSWKMAEBEHCbIZCBE@MBITk@CGAE@[EKECA[IKEEEGIEACAI@I@CACAEKEECECGCCICMECCEAC@EBAAEAGCECEEECYIEAACECGKCECCECOCEAAACACA@ACAGICAEC@@@@ICGAGCAAACAC@M@SACA@AAC@CBGHMLGDMDKBIBK@GAKCKECAACAA@ABABCLGDCBA@A@ACG@A@ABAREFAD@DBHHHFDBF@FADCBC@S@I@GAECCEECI@CBIDOBMFYDUAOCOEIGICE@C@ABADAL@JAFADABC@CAEMWAE@E@CDEHGBGDGDYEAAAAAAM@MGGCEGCEAI@CACA@A@CBCACGAM@OEGCCCa_YMQIIAG@AJHLHbAHMJKDBLBPBFAHCVCHGL@B@FGTEXA@@B@B@BABABABABABABC@C@ABAB@BA@AB@B@B@BBD@BAB@BCDADB@@D@BABCFADEHADADCDCB@DAFBBBBJHBB@BAB@D@B@HD@B@@@@@@D@BBFVTFH@B@D@DBH@BDD@BA@ABK@CAIECAA@ABA@ABADBF@D@DBHBD@B@BADEFADAD@FDFLTJN@DADADA@AHB@DHBDDHDJBDDBFBFBBDBDBDBDFDFDDB@BEDIFABCB@@EBIHINJTLRHNNRV\\BD@TC\\@TKVUdOTUVOFsGE@EBMPIXKPINKNSAeIMHBVFRLPBB@BTjBL@XINIHGFKDQCOQSISAUHOPQPQLCLDZBBTnADCDOLifA@YRWF[VMRQjCFedSRYXKRMLQHGD@@IBCBMHEBEDCDIDEDE@ABAB@D@BABAHATAHGFMNOHEF@J@DA@BLDHDH@BAFCH@LABCBEBE@I@A@ABAB@B@B@@@DBFAD@BAD@DCFCDCBEBC@CFEDCBA@A@AB@B@DAF@B@B@BADABABADAJ@D@BADA@ABABADADABE@ABDBHBF@HBF@TABBBB@B@BBBBBFDD@BD@BBD@D@FBDTZBD@\\@HahG@]ISCKDILENONSBC@ABA@C@@@A@AA@AA@A@A@EB@@C@I@A@AACAGAECCCIIEGAAGAC@C@CBAD@HBTBNBD@HBJBFDBFDBBHBDDDHBJ@FAPANCDCD@FFHBDFFRNRL`HJAHBDBBBDFADAHEJCDCDGBO@SEYKKEI@I@EBGFAD@F@NBF@HJNFVFHDDFBD@J@PCZGFAH@F@HDBJJRJJJFJBB@BAD@F@DBBBBBBDBBBB@H@BBB@@DDFFFFDDDBBBDBD@D@DABABADADEBCHID@FBFDJ@JALCH@FCDC@G@EACDOBQFOHMFETUXMFAF@B@DAB@@@@A@CAA@A@@@A@@BB@@BBD@BBB@B@BABABAFAHEFABABABC@@JCB@BBB@HABADAB@FADAB@B@B@B@BBD@D@B@BB@@AB@B@@BBB@B@B@B@B@B@DADAD@DBB@DFBB@@B@@ABA@ABAB@B@@@DDB@BBB@DA@A@@@A@A@@A@@@@A@@BA@@@@B@@@B@@BB@BBBAD@DA@@B@@@ABABADA@@D@@@D@@@@@B@@@@@B@@@B@@B@@@BBD@@BB@@@@B@@@@BB@@@@@@BB@B@@@BA@@@@BB@@@B@@B@B@@B@@@@ABA@AB@@@@@@@@A@@@@@@@@@A@@A@@@@A@@@A@A@@@@@@@AA@@@@A@@@@BABA@@DADABA@@@@BB@@@@@@BAB@@AB@BA@@@@B@@@@@@@@@@@B@@AB@@@@@B@@@@@@@B@@@@@@@@@BA@@@@@AL@D@B@@AB@BA@@BA@@B@@A@@@@@@@A@@@@A@@A@@BA@@@@@@@@@A@@A@A@@@@@@A@@@@@C@@@AB@@@@ABA@@B@@AA@@@@A@@@A@@@@@AB@@A@@@A@@A@@@BADCBA@@@@@A@@@A@A@A@@AA@@@@BA@@@A@ABA@@B@BA@@@A@@@AB@@A@@D@@A@@@@@@AA@@@@@A@@@AB@@A@A@@@@BA@@@@@C@AAE@A@@@AFE@A@A@AFEB@B@B@B@B@B@@@NCBAB@@BB@@@B@@@@AB@@A@A@AA@@AB@@ABA@@@@@A@@@@@@BAB@BB@@B@@A@A@@B@BAB@B@DA@@B@B@@@D@BABA@@B@B@@@BA@ABA@@@A@@BA@@BA@AB@@ABA@A@@@@@A@@@@@A@@@@B@@@@@@B@B@@BB@@AD@B@@AD@B@@@F@@@@@@B@@AB@@AB@@@@@@@@AB@@AB@BCDA@AFE@@@ABADA@A@@@@@ABA@@B@@A@@@A@A@@@AB@@@@@@@@A@@BA@@B@B@@@@@@CB@@A@@B@@@@@B@@A@@B@DA@@@@@A@@@@AAA@@@@@@@BA@@@@@A@@@A@@B@BAB@@@B@@ABA@@AC@@@@A@AA@@@@@A@@@A@@BA@@@C@A@@B@@AB@BE@A@@@@B@@@BDBB@@BB@@B@@B@@@BBB@@@BB@@@@@BABA@@B@@@@@B@BC@@@A@@@A@A@@@@B@@AB@@@BABA@@B@@@@@@@@@@D@@@B@B@@@@BB@@@@BB@@@@@@@B@@@@@@B@@@@@@B@@@BAB@B@@@B@D@@BB@@@@B@@A@@BAB@B@@AB@B@@@@C@ABABBBAB@@@@C@CBA@@@@FD@@B@BABAB@@A@A@A@A@A@@BB@BB@BADABA@CB@@@B@@BB@BAB@@A@@@G@C@E@GBC@@@@B@BFBBBLBDBBDB@DBXDXFTFNPTdTFDFFFVCPMLKJ@VBFFDNDVAHBBHIREJI^DV@LDJ"],encodeOffsets:[[103321,30901]]}},{type:"Feature",id:"513327",properties:{name:"炉霍县",cp:[100.676372,31.39179],childNum:1},geometry:{type:"Polygon",coordinates:["@@UQEAOEEYF[FSBQBUDEDKFQ@E@AIWcW[K[MYIe@UNQRIFeTU^O\\QJKHgZADCB@FBD@F@BCBC@GAEAC@AB@D@DBBDDBDBBBD@B@BC@EBA@KDC@C@@AC@Q@CBABCDAF@BCBQPCBkAMJ[zABuAGBYLEHCNB^GTCBABABCHADABAB[HKFIFG@EB@B@@@B@BAH@BBJ@B@@@DAF@BCHA@ADAB@BGF@B@@@@DBBBB@@BBD@DABAB@BED@D@H@NADGHEJAH@@E@E@EAE@EACAEAECGABF@@@@@B@D@B@B@BAJ@D@BAF@P@B@B@BAHAF@BAJ@D@D@DBF@DRbGTYDsHMDGDDZALKbCB@IDKLETIPSPWVKLULWNGBIA[EAHARB^DRDJCLIPAPDLERGLELBd@BGRKPSRUPINCNAHADAFKJEAKAOAGCIGCAA@@BEBCBCFMRO^CDEDA@E@MAWAMAG@G@IBKBKDGDC@EFADBDDFJFXJ@L@H@HDLHTPDBB@B@BBD@F@B@F@T@F@BBBABEBA@ADAJ@PJDVH`BHfhDDRTBX@TP\\HNDDNHJBJIDAHBDBDBF^DDLFFDHDLHFFFBDBF@JAD@F@J@N@HBB@FBB@@A@AHKBADCBA@@DC@A@@@AACD@D@JAD@BBFBDDBBBBDBD@@@DBDBB@DBDBDD@ABA@A@EBIBAH]A]JYLQPOPOLOVmRiB_R]VWN@DADAHBDDDB@B@BDDADAFAFADABBB@DAHDBB@HAD@BAR@JAHBF@D@J@TAD@D@JADF@DBD@BANBDDHDHBF@JBDBD@D@DAJ@B@@AB@BABADADABCBCDCBABGBABC@C@A@@BFF@BBFDPBJBD@BBBRBD@BBBBHDJFHFDBFDFBDBCBCBAB@D@DCLAFEHEFABCDCBCD@BAFCJ@BAB@B@@@D@DAB@B@@EDCB@BB@D@ZEVMbaTeDEZMN@ZDTCRURGXGPEBAFAV[FAtADATM@@@@VMDCHADBPNHHTTRP^R^NfJJ@H@CKISCK@G@CBGNWHGDAD@jBRALDVFFBPNHBF@B@ZYHMDOBCFALAFCDEBEAECG@OFYDENIPIeLKDGBEDCJEHAF@F@JBLCLCJEBA@CCGMSGG@AACBCBCBKBEBADAF@DAHERS@A@ACAMEECCCAEAM@I@CBEBABADAJKDIBEAECEACAABEDMKKAEIWFUASQBQVO@E]DcFYLYFKTSPODCGYGAQG[F_AMKE]Wk@KNoLWBIG]CAW@QHSCEIA_AGACCMOqWW[M[GGAaKWIGI@YNMAI@@@@EOOY"],encodeOffsets:[[103301,31890]]}},{type:"Feature",id:"513328",properties:{name:"甘孜县",cp:[99.99267,31.622933],childNum:1},geometry:{type:"Polygon",coordinates:["@@JAH@H@NBXBNBF@B@FCDCP]NQDEDAFA@AB@DBJHHDPBLBFBLIBEBCBGDMJMVOTQLOHQ@AAcFKHKFQCKBOJODKCICQA]BQBGYMi_UGICWBC@WJ]NkTOFKDeNA@GD[jUtCDCBSAICUYSGUI]OY_I]@UAUKGI@]HEACCCmQiECUEYLCDUpCHCFSNUTIPO^GD]BKC[GG@aPGBGCUQOYSKQAKHOTADEDEDGFGDEFGDEDIFEFIFIHIFGDGDIDIFIFIDGDKDIFIDIBKBCAGBGBC@GDEDCBCFGHEHABEJABABA@ABC@@@ABADEN@@ADABABAJAB@BADIHAD@DAB@BBRBH@DABABAB@B@B@B@@BBFFB@@B@BABA@A@A@C@A@CBCBADAB@@A@A@EAA@ABA@EJADGJ@B@B@DBBFH@D@BABA@CBA@EBC@A@@BA@@B@BAD@@A@ODA@A@IEA@AB@@CDADABAB@@ABAAA@AAGCEAI@E@C@A@AACA@BADGPABAD@D@F@DHL@D@@@BABA@@B@BDBRJBBBB@@B@BD@B@B@@@BBD@@B@@BLJBDBD@HBHBJ@DCFELGF@B@B@D@BBDDBDBDBB@B@B@@@JBHBBB@D@BADADEHEFiXYREFIJAD@B@T@BAB@@@F@@@B@B@BAD@BA@@DAFA@@@@B@BBBDBJFBB@@@B@BABABA@A@C@CBCBADABCBC@@BA@@@@BDF@BABABAB@D@BAHADABADCDCBABABA@AB@@@B@JABABCBA@A@A@ABADABABEFIJAB@BBDDFFHDBDBBBD@@@@B@@CBEHGFAB@@@BBDHHFFJN@D@BA@@BC@C@@@A@A@A@@@AB@DA@ABABEB@@ABAB@BABABABCBABCBAHADA@ED@@@B@@DBBBDBBBBBFLBDDBBBLFFDHFLFNJHFLF@@BDBBB@@@B@D@DCFCDABC@A@@@AB@@A@@@A@A@C@A@@@ABA@ABADABAHCFCBCBAB@B@HCD@J@L@B@B@B@D@F@DBDBB@BDBF@@B@B@DAD@BAD@BBDBB@B@BAD@BA@A@@BI@@@A@@@@B@@@B@@@B@B@@@B@@@B@@@BB@@@@B@@@@C@@BAB@@@B@B@B@B@B@@BB@@B@B@BBBDB@BBBB@BB@@DBB@PBF@D@DBB@B@@@B@B@B@BAB@BB@@DBBBDBFDBBBB@D@B@BAF@B@DAD@D@BCL@@AD@@@D@@CFCB@BAB@DAFAF@HAFA@C@C@C@EAC@CAE@C@G@GBGBE@EAEBCBABCBCB@BAB@BABABC@A@@BABABCFAD@BCD@@C@C@@@A@ABABA@A@@@@@@@A@GBCBAAABA@@@@@A@A@@B@@AB@@A@@@A@A@ABA@A@A@@@C@@@A@A@@@@@A@@@@@A@@@A@@@A@A@A@A@A@A@@AA@@@AB@@A@@@CAA@A@A@@@A@@A@@AA@A@@@A@@@@@@@A@@@@@@@@@A@@@@@@@AB@@@@AA@@@@@@@B@@A@@@@@@@@@@@@@A@@B@@@@@@@@@A@@A@@AAA@@@A@C@A@CA@@A@A@@@AAAAEAA@CAA@A@@AA@@BEF@@CFADADADCFCDCFCFAD@F@BBD@DDFDDD@DBDADAD@B@DB@@@@DA@@B@@AB@@@BA@@BA@@BA@@@@BA@@B@@A@@B@@@@@@@@AB@@@BA@@B@@@@@@A@@BA@@@@@@@@@B@@@@@@@@@BB@@@@B@@@B@@@@@@@B@@@@@@BB@@@@@@@@A@@@@@BBA@@B@BA@@B@B@B@B@@@B@B@@AB@B@@BB@@@B@B@@@BBB@@@@@BB@@@B@@@BB@@BBFFDDBBBDBDBDDD@BBB@D@DAB@D@B@@D@BBBDBBDBDBBB@DAD@H@DBHAHBFAJ@DBDBFDDBBDDBD@BADABABCBEBGBCAE@G@EAEBABCDEBCDC@C@EBCBADEDCDABADBDDBHHBDFFBDBFBFA@B@@B@@B@@@B@@@B@@@@@B@@@@@B@@@@@@@@@@@@@B@@@B@@@@@B@@@B@B@@@@@B@B@@@@@B@@@@@BAB@@@B@@@B@@@B@@@@BB@@@@@@@B@@@B@@@@@B@@@@B@@B@@@@@@BB@@@BB@@B@@@@@@@BB@@@@B@@@B@@BADAD@D@FAD@FAD@BABABAFADADCD@@AB@@CB@@@B@BAB@D@B@@@D@@A@@B@B@@@B@@@B@@@BAB@DADADA@@BAB@D@B@B@@CDCB@@A@A@@BA@@BBB@DBBAD@B@@@B@D@@ABCBAB@BADBBBB@D@B@@@B@B@@@D@B@D@DFHFF@B@FCFAJ@L@HCJ@J@DFH@D@DCJCLONKJe`[`WTCLEHCFEDCDanU^CDABC@C@IAE@EBUJKHCB@LCHOTORCHAFEfEPCFABEBEAEAOKECC@EBQDGFAH@F@H@FADKRIXGNGLELKRGHMJUP[\\ENCLMdIJGFGBKBIFGDUVAFALEFCDCDERCF@FBDBF@H@HADADCBEBCDAHABA@CAEGIDEBIFEBCD@F@JE`CD@FDBFDBF@TCFIHKJCLAFAX@DCBCD@D@HBFBbEJBDDVAFCFALBNHHLFHBF@JJDDH@`OVC\\AFBFDFFDHF@L@H@HBJDHDTKTI@@@@BABAB@BBDBDBDBDBB@DBB@BBD@B@DAD@B@D@D@D@DBB@D@BABCBABADAB@DADBF@DBD@DBD@D@B@D@BBBBBD@BBD@DBB@DBBB@BBD@DBB@B@DADABC@ADCBABAFCB@FGBCJGHEHODEBGPQBA@AAACCIQAM@CBCAK@ABAHGBABC@E@ABAFENGDCDCHIDCFCHAJCFARIHCFAJ@FAHEPKBEBAFEDALEFABAAK@@BCDALGHCFCB@B@DBB@D@BCNKPYDYFEBGFGBCBG@G@GHKHYFEFKHGJGJEFGDMHKDAJCFCFIFENCJCFGHMDM@ECSAE@CBAH@D@FAF@DAHBTLDBFADAFIDIJEDBFBHPJHDFFBBAB@BCFGBEDAB@DBDBBADCFGDIDGJKBE@EBCDCDCBGDEFADA@CAC@CBCDAD@RFPFNJHNDFD@D@XKNEDCBCAE@CDCJGH@FADADCFILMNMHCRGLIFEFK@G@GACBC@C@ACE@GBKDCDCH@NAD@BCDKBCDCBCBGDGBA@A@AAAECCG@CDMDGDO@CACACBEACACBELKFCDABBBDFFFBD@DAFCLOHODE^CJEDGDCHAJBHADCD]@EEAGCIAMBI@aOCACCAIAKAMEO@UBIDE@CAGJYLSXuDIAEBGHKDK@AACEAGESUCIAAC@EBOHCBCA]KECC@EBEDEBA@IAA@AABCBE@CAECC@CBCLIJIDC@AACCCEACC@C@ABEFEHEBEBE@C@CBADCBAFKDM\\WFELIFCFADCFILK@A@W@S@IBCDAFADCBABE@SBIHIDAFCHAFCBA@CBO@OBEDIJGNSPIHCD@F@HAHCFIHM`eFGBIAGCGEK@G@SBMBGRIHIJGHAJ@HALEBAD@B@D@BDBFAHBFBFBDDD@JBBBBHFFDDD@BAHEJI^ORITADBHBHC^AHBZBHLTRTHHFBH@DAF@D@HFFBH@HAFAD@@BHBD@J@DCDCDE@E@KDMFWBCB@D@DDNPNLHBDBFABE@GDGFKDCHAFBDBLhFFDDJ@RBJBXNFDDDAFCHEHGNIFEBCBCJ@BAH@LDJB`@ZDJFJDBD@DDBBD@D@F@DBD@DBB@DABBB@@D@@BBBFBDBD@D@BDHBH@DB@DA@@B@B@@@@BBBB@BB@@DBDAFA@@D@@@DBBDBDDDFDFBDBDBD@HGBABABADAB@@ABABA@@FCB@LCBA@CB@DEBA@CB]@MAK@IDMBCLGRGJCFCFIBIBEAI@E@C@E@CBC@EHWDEBCRQJARCTB^JRETS^MLS@GAOBE@AAA@ADG@E@C@CBAFEBAHCHGDAF@BA@AACAE@CAC@C@ABADGDEBAFE@AB@BEDEBC@A@C@G@CAEACACACEC@CDA`GDADADCBACCCACAA@CACA@@C@CAAAAACCEAAAC@IBC@C@BD@B@@@BCD@@ABCDABGL@B@BA@EAA@GAM@I@E@C@IBE@CAEAEEKGGCECKECCE]CACAGACBIJIAMGCCGMO[@SAWQSCCegAGG_CUOII@CB@BABAFABAAE@S@E@A@E@C@AAA@A@CASOKGGCG@K@I@WIECEACBCFED@HCLCLA"],encodeOffsets:[[102527,32429]]}},{type:"Feature",id:"513329",properties:{name:"新龙县",cp:[100.311368,30.939169],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@CBC@CBKHA@KHCBEBUD@@@@@@I@C@CAKGCAAAC@C@ABCBEFADADETABAD@@CBEBKDCBCBCDABAFENAHGVCNGRADABABC@IBK@_B@@@@E@wBG@MDIBKFGFA@E@E@G@M@I@E@G@E@GBCBCDIDC@E@KDMDIDEBA@CAKEQCEACCIIEICACAC@C@CCOEAAA@ADIDCBG@C@CACCECECA@A@ABCBEFEDCBC@ADADAB@BBB@BDD@BABAFCBCDC@CBC@ABABAFIdAFBF@H@FBDFDLVGLKDmBWCGDEHAFCRDNDHQXO\\MVKFXCBIAICMGKMAEC@EBGDC@CBA@AAA@EEGEAA@CAGB@BEHGBA@ABA@C@EAMAKAACAA@C@C@IBE@A@C@@@ABCBABC@C@CBEBGDGJCBEBABA@EAA@CAA@EBGBA@A@@AA@@AAA@BAFADADEBQBCFCDA@CBG@CBC@CAC@CCCAAAA@AAAAA@AAAAIGCAEECC@ACA@ACCAAAA@AQMCAA@E@G@ABA@@BEFCBE@@@GBE@CAE@A@IHEHEFABIDK@GAEBAD@FBFFDBH@FGFEAICEAE@ADGFMJI^GXGNUTGBYEIdAXBXGfB\\H\\BJBJ@J[t@VBFDRRfZRRTDH@JAHU@fPhZP^D^DPFDPVFLT\\HBpDHDTJZHX@RBH@JFDFAJEHCBWFUFMPGHGJE^CLIHGVE^DRBZAHAHM~ADQX@JBHRb@HARAHCFABC@uEOEMAC@EDEBE@E@CDABGDGByLG@GASIGAQ@E@CBCLAd@BABE@ICAAE@AB@DBhCPAD@D@DDJBDDBDB@DBD@DBP@DAFIPAFAD@D@BDDPPFFDH@HAFEFADCBE@E@ABCBEDCF@DAD@DAF@@EBAG@CBADGdCFCBCBCBEBE@IBGBGBCBI@YAMCEAGAEAE@IBG@KDG@SVFbPRJTEN_HEDURu\\IREJUJGDa@GFU`@RI`QFSAEF[`EFeRIBgAEBINAT@NDNH@NBFBDDBJAF@HBHBF@F@FBDDDBHBDABCDBFLHHBDAFC@EDAFADADCBAFAHAHAFAHEHAFAJ@JBHBFFFDB@D@BA@AAEEEAK@CDCBADCB@D@HDDBD@BADAFCBCBC@C@C@C@@BCB@HBL@L@F@LAH@RFLFJDFFBH@@DBBBB@D@F@J@FBHDBBB@BBBA@@BABABCDC@@BAB@JFB@B@PCB@@@BC@A@AB@@AB@D@FAB@DAB@BA@A@CEGAA@C@A@AHIBCFIB@BAB@FBB@B@@@BABCDADAB@D@B@B@B@BA@A@AA@EEAA@@@A@A@ABABABA@CAGAQ@ABA@CBCJGBC@ABABIBABABC@@FMBCBA@@D@BAB@BABAFIBAFGHGDEDAFCHCD@HAHADBLAJAJCJELCHCJCJEJEJCHCHCJEJGJEFEJEFCHCFEHCHEFCFCBCPSLGRBTLPZVRHDHAbOH@\\HLD^AHCP]JOVSTMDEDGVoDCZKVFFDRjDnDDFB^GJ@LHBV@VJ^Z`^PVJTHVZJDTBDADCVs\\iHCB@fMLCPElS^MXID@XAJDVHj`ZN\\FJBHAXMVKLKXUTOJOFSLKJC@DALaBKCYHCNCtGZCHSQa@CAE@C@C@CBI@ABEBG@A@A@A@OBE@A@CBI@A@A@A@C@A@@@@AEHBFDFBDBFBF@FBF@F@@@BGFIHGBC@M@G@CFC@ABABA@CAC@AA@AACA@@@@@AHE@ABABCB@DG@ABE@C@@@AAI@ABG@A@A@@@AFAH@JELE\\GBABABCDGBABADAHSA]DMFGZKHAvBBA\\yNIlBDARODA@ABEDCBADAR@D@@BD@D@LCB@FAD@@A@AACAAACCCAA@C@CBAEIYKaSCGIO@ACE@C@ABCDAD@H@JBFDF@FADAHEDAF@JBB@FCB@PMVIDE@CAAAA@AUWGKOMEIACAAAA@A@E@EACCCGMEGAABIDIBEDADANODUEq@ENQHGXOVEZDNBLKHGVBPIF]FKNCRIJWLGXC\\GJAVOJKXMLMPGXE^ENMFWGSESEIBWFWCKGEaUIM@UCQCAO@EAACAGBOBA^ajmLSFGF]IWAAsoAC@UDOAeNWMKECCG@EDKJKHIDKDMX_DGBCEYCOCGMIEEIK@CCEEC_IKGIEEAE@GBOJIBC@AAAE@CAAE@GBYBMAGAIEEEE@OH@@QJA@C@EACCEAA@CAE@G@C@CAGAC@A@E@EBAD@DDH@FKFUFCDCHAPAHABEBMCIAECIAMG@A@WN@D@DCFCFCFA@A@A@@A@EAG@CC@A@A@EBA@A@@@AABEAC@@A@C@CBEDCDGLADCBCDC@EBYFMBKBEBEAE@EAQECA"],encodeOffsets:[[102939,31164]]}},{type:"Feature",id:"513331",properties:{name:"白玉县",cp:[98.824182,31.209913],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@CCAAA@E@AAACEAC@A@EBCA@@AAAA@E@A@ECoQECCEEUDOHOH[@SAEWYSOSEYAKBY@aBUB}E[KWICAAH@B@BB@BBBBD@BBBB@D@B@D@BBD@D@B@F@BAB@B@@@B@BABABABADABA@@BA@@BAB@@@BCL@J@@AD@B@F@@ADBB@@BDB@@B@B@F@B@F@H@D@D@F@DCFCFCBAFAB@@CFAB@DA@CFABAFABABCD@BAB@D@@AD@B@DADBB@BBDBB@H@@@B@@AFABCDCFCB@@ADAHCFAB@BAD@@@FAFBFBBBD@D@BBDBBBD@B@DBF@DABCDEDEB@F@HAD@@CLBB@@DDHFFFDFBDDDBFBD@DAD@D@@EFABC@CBE@ABABABCDCBCDAF@DAF@@DDBDDD@D@FAF@F@D@DADAD@BCDADCDCF@@AF@DAF@FAH@D@DCF@@AFCFCJ@B@F@@AH@FBDDFF@@@FBB@LFH@D@JBHDBDDF@DAD@DBD@@DBHBF@J@HBBBHDDBBFBHADAJADAFAD@FAF@F@FAP@@EFCDKBIBGDMDKDGDCBG@G@C@IDEDAFCDCDIH@@CFGDEACBAF@D@FCD@D@FBF@DAFABEDABABA@A@E@E@A@C@EB@@ABA@@BAB@F@FAB@DCHCHABABABEBIDCDCBGDCBA@CBABCBABAD@BAB@D@BA@A@CBCBEBE@ABABA@CBC@CBCBCBCBADCBCD@@KHCDCDADAB@B@D@D@DABADABA@@B@@@B@@@DBBBDBB@@@B@DBFBDBHDFFDDFDFDBBFDF@BBBBFBJ@D@@BF@JBJDFDFFJ@BBL@F@F@DADEFADC@ADAH@DA@AD@@@BA@@DGBIFOHCDAJAF@@EDGBI@A@@@A@C@C@EFABABABCBEDCBABABABCBCBABABADABAF@B@B@BB@BBBD@B@BADAB@BC@IDA@ABABAB@@CBA@ADAB@@ABA@ABCFAD@@@D@BBB@@@B@DAH@DAD@B@D@BAD@DAB@@CB@B@D@DBBBDBB@BABABCBCDADABCBCBA@ABA@AB@BABABC@ABAB@@AD@BABA@ABA@@BCB@BABABADCHAFABCDGBE@ABCDABCDADABA@A@IBEBIDAFBDIJG@M@C@@@E@C@E@E@EBCB@@CBCBCBCDABAF@BBBB@D@JDHDDBDDFF@DBF@BBF@F@D@DBF@BAFCDABABE@E@C@C@A@ABADCJ@B@B@D@D@HADBD@@@F@D@BABBB@BBF@DBD@BBDBB@BBD@D@B@B@BBBBDBDBFBD@DBDBD@D@F@F@B@D@BB@DF@@DDDFDFBF@@@@B@@F@DDFDF@@@BFFDDDDFBHBDBHAF@HABADCB@D@BBBDBBB@B@DAFADAD@BAB@FAD@F@D@B@B@D@BABADCBABCLIBEDCBADGFGDA@C@AHEHCB@BCHEHGDABGBE@GFIDCBE@@DGHCFAD@D@DADCHCHBH@FCBECEBAFADBDDFLBDB@DDBDFDHFJHFHDBDDDHBH@F@@@J@HBF@FBFDFFHDBBDBDD@JFJDDDDHB@@BBBBDJHD@BBF@DBD@B@BB@BB@FBB@BBBB@BBHBDBBBDDDFDDBBDFH@@@@LFDDBHDBADIFEFCF@BA@GLGJEHA@DLHHfNJ@NDNFLFLJLNFDv\\n^D@BA@E@_GQ@GBEFGJGJGHAFALBFBDDJJNJNBNBJAFAFCH@F@RHFBD@HCF@RFRDF@DBDDBF@FGFKNWJCLCVELILIJGRBFRHHDJNNJdTDFKdKPSTGNGNEDOBYHKHGNCH@FDJPXVVDBHCLKXIFADBFDBHFBFBJCHCH@R@PA\\KFEDSFGFGJGJEHCF@HBHDHAF@JBFAFCH@NBHDFFDFJJDHDTHHDH@BDFF@F@H@DBFDBBFBBBDAJCF@D@FBFBBBB@DBB@DBFDBBB@BBB@DBH@B@D@FAD@FA@@DAFAF@@@HBDAD@D@BADCDAFEFABADEBABA@ARA\\INID@F@F@HBFDLFFFDFBBDBCM@MBSJMFAhBJAfQFE\\_FETBREJ_@QV_HEb@HCVIFIJQv[VQFC`GFMISOQEaTUH@LCH@JAF@FBHBFBNDZBJ@DAHAHAJAF@FADADADADEHcBCDAH@BFA@@BE@CBC@CDEFCDABAF@F@DABCFEBE@GCGEEOOCC@A@CBCBEJOBE@CAO@CAC@CCACAACCI@C@CBCDOAg@CBAF@BBJDF@BA@ABcDKDAF@R@HBTJHBH@zKHAHCBADCF@F@FAFCD@NBPFvFD@BADEBGBQ@GQaAG@IRWBCN}BGBGAYCQF]HUJGDKF]HIHGNOVEXEDAFGBICEIEG@QAW@YGSIGCoCGAS[EKOUECO]C]CYOOg@eVBG@ICGQSYQQeCQAE@U\\s@IAIAIG[A[HeAWBWJcAI@E@W@EEeFUGcSkSMWAWFOBgA_KYMUGAABUPUFMBIGIOGaFGB_@UCWCUGYAWHElGNEBUBmHABADbZBDATCBO@U@MLKVONU@A@aC[EEEIICOIMgUCCScAGAaAEQaCQEWACACBCHSBAPIBA@KA@OAA@MDMDKAIAG@EBGHCDC@UCQ@EDCFCBABA@A@AACACCAAA@A@@@A@AB@D@B@DAD@B@D@@@D@BAF@D@DCBCJABCB@BAB@B@@@@O@E@CAAAAA@C@ABG@C@ACAA@C@IGCCACAA@AA@A@GBA@G@EAG@ABMPAB@D@BC@IAEAC@@@ALCJEHCDEDCBC@C@@BA@ABA@AAA@CEAA@@A@GBA@C@A@A@AAAC@@BA@CFC@A@@@C@C@AAABA@ABADABAD@@A@AAAE@C@AACACCCAAACEA@ACA@AA@@CA@@CBA@EFABABA@EDA@@@AA"],encodeOffsets:[[101535,31230]]}},{type:"Feature",id:"513330",properties:{name:"德格县",cp:[98.580914,31.806118],childNum:1},geometry:{type:"Polygon",coordinates:["@@KMKIKEMEMCI@eMGGCKABA@ABCDABCBCBA@A@ABCBABCD@@CB@BAB@B@DBBDD@B@@@B@FADAD@@ABABABA@@BABCDA@@@ABA@CBA@A@EB@@A@EBCBABA@A@ABCBA@CB@@@@AB@BABA@ABABABCBCBABABABA@AB@@A@A@A@@B@B@@@D@@AD@B@BAD@B@BABA@@@ABABABABCBEBC@CBA@C@A@A@CAA@A@@@ABAB@@@BABABCDCDCBABA@A@A@A@ABA@@BABA@ABE@GB@@EDABABA@A@CDADEFEBABCDABABABE@C@A@C@ABCF@@@B@DCF@@GFCFABCDA@EDAB@F@DBD@BADABCDEDCBCDCD@B@B@@ABCB@@C@C@ABCDABCBAFEDCDA@ABC@C@ABC@ABADGJABBB@BABEBCDA@@@CBGDED@DADEBCAAAA@E@C@C@CBADAD@BADBDAB@DABCBE@CBABEDABCBCDAFEFCDCDABCDAB@B@BBFABABCDCBEBGDIFGFCDGFCBCFCBADADBDDLBFBD@D@@BBEFGBGF@DBDF@FBFB@DABOJEFCF@HDFDF@FAFCB@BC@A@CAGEAAE@EDG@EBC@E@AB@@@B@@@BB@BB@BBDBF@DADADC@ABABCBCJ@DCBBHAH@BEHADABEDCDABCDADA@ABI@EBC@CBABAB@DBF@DCDABABGAKAE@EBA@CDAFAD@D@@@BB@BD@@B@@BB@B@BBBB@BCFGDGDKBEDCFEHAD@DBFDHDD@BEDE@C@CAC@ABCB@@C@E@A@A@A@EAA@CBAD@BB@DDBFABCD@FFJ@@LHDHEBABGBABAFCDCH@DBDAD@HCLMNIH@FDJDDBJPFPDJHBBHDF@DH@JBD@F@BIBIBCBABAB@D@@BBB@DBB@@@@D@B@@C@@DBD@B@BB@B@BBBBHDBBAHCDBPCFCB@FBHFDBDCHADCB@DBBHBDDBFAJEDEFIBIDADAFSFE@CFAL@FDF@BBB@BBB@B@BABCDMJ@@MJOLGLA@@@EFABC@CDGDCDADCHABCDA@E@E@A@C@C@A@AB@B@B@BBBBB@BADABEDEDABC@CAA@A@A@ABAD@BBB@BB@ABABCBE@E@G@C@CB@BAD@DBFBF@D@B@@ABA@CACCCAA@A@AB@BAB@F@DAB@BAB@D@D@BEHAD@B@B@D@B@DADABABABABCDAF@BABABAD@B@@AFCBCDCPABCDC@ABAF@DAFAHEFAFEFAFABCDCBC@CBABA@@BA@@B@B@@ADABAD@@ABABCB@BA@@BABA@C@ABA@CACBC@C@ABABCBABEBAB@@@B@@@BBFBB@@@BB@@BBJ@D@@@BABADA@@BAB@DB@@BBBB@@BB@B@B@DBBBDB@@BBAB@B@B@B@BCBADABADABAD@BABADADAB@BAD@DAB@B@DAB@B@DADADAJAFAFAB@FAFAD@@@DBF@BB@DDDD@BB@@@BB@BB@AFDLBBCDC@LJHBFBFBBBFTDFFDJBVCNEF@DBDFBPBPAJ@DFFFBFBHFNLDF@F@F@@CB@BAB@@AB@@@@C@C@C@A@EBA@CD@B@ACA@AA@@AA@CDCBIBA@AB@DA@CD@BA@A@A@@@BBDBBB@BB@BBF@BB@@ADABAB@@BBBDB@@@DBB@BBDH@@B@@@D@B@DBBBBD@DDBBBBBBBB@@@@B@BBB@D@@BB@@JEDAF@FCBADAB@@@B@B@B@B@B@B@@DBF@@BBDBDBD@B@@BDB@AB@B@@@@AB@FCBABAB@DA@@BBB@B@DAF@DB@@DAB@DA@@@EAA@A@@BABA@A@CB@@@@BB@@BBBB@B@B@B@B@@@FC@AH@BB@@BBB@@BB@@DBB@@B@B@BAB@H@D@B@@AB@@@AABAAA@@BA@@B@L@D@BAB@D@D@B@B@@@DB@BB@B@B@DCB@BADAD@D@DCB@B@B@B@DB@B@@DFB@DBBB@@D@B@BBD@B@BABAB@B@BAFA@@DAFABABAB@@A@CBC@AD@FAD@@@F@B@@BB@@@D@BCB@B@BAB@D@@B@BBBDABBD@B@@@BABC@@DAD@B@D@BABAD@@@B@B@BA@@@A@@@A@ABAFCB@DB@@B@BCB@DABCD@DAD@BADAFCJBB@DABA@CAE@A@ACG@EBGfUBCDEBCDCBCBCDCFGBAHEDCHEDAHCJCHCFALCJ@HAFAF@F@FALADAj@L@FBD@FAFADCBCNMDAH@H@HCFCFAF@F@FBFBHBDDJLDDFDDDFHBHDFDFDDNJFDBFAH@HAJBF@FDL@FJ`@FCNBBBBN@DBDFTbDLLJDFHHDDDDFDDBDBDBJFFBHBHBD@HDFDRJBBNLb`RNHHBDHBDABCFCF@J@TDDDFBDBFBJBFBB@F@DA@@@BB@@H@@@BAB@D@BEJ@DBBB@D@BBBB@DBBB@@BDAL@D@D@B@B@B@B@DBB@H@B@BA@@@@B@@@DDBB@D@BBF@BBD@@AB@B@B@B@@@BB@@AB@B@B@@@BA@@D@DBB@@@@BB@BB@@B@@@@D@@@@B@B@B@@@@@AD@BB@@BBB@@D@B@@BCBBB@BB@@BB@JEDCDAF@F@H@DBBDADAH@D@D@FDJ@DBDBBDHJPFJBH@D@FDFBDBDF@DB@@B@BBB@@@@BBB@B@@AB@@@BAB@BA@@BBB@B@@A@A@@BCBCBCBCBAB@BABBB@@B@BBBBBDDBDDBB@BB@@@BB@@BB@@B@@BB@@@B@@@@B@AB@B@B@B@@AB@BB@@@@@BBBBBBD@@@B@BAB@B@B@@@FBBBFBD@DDD@B@BABBB@BBB@D@DAB@DAD@B@B@B@F@BADADAD@@AD@DAB@@@B@B@@@B@DBDDFBBAB@D@@BB@BA@@BF@LBFBD@HAD@DAB@DABADADCBADADCDABCBCBA@ABA@AAA@ABABC@A@C@@BC@A@@@A@A@C@E@CBC@A@A@C@A@@@@BA@@F@DADADCB@D@DBBBBBB@@DBBB@BBB@BA@@B@@@BBBBD@B@@@DB@@B@@B@@@@B@B@B@@@@@B@BB@@BAB@@@B@B@LADBBB@F@HBD@DFHBBBDBFFFBBDBDBL@FAHCDCFCBALKFCB@HBF@@D@DBF@F@DBBDBHBDABCBADADAB@D@FFBF@F@DFHDBB@BABGDCFADABCBC@G@GAEAC@EDEFQDCDCFEBKBEVUHCJELAHAHEJINcDKFM\\[VONIHGLQFKHKHMJWLQBC@E@G@EBGHERCFAD@FDPLFBFBFABADEFOFeBEDGPQPSDG@KDALGVIFAF@JBD@D@BADCV]bmDCFCDEFGDKXS\\_f_LIPMDKDI@C@CEG@C@IDI@G@KBIDE@E@AEEEG@C@C@A@C@@@A@A@@@A@CAAAABC@ABADABA@@@C@A@@@ABCAA@CAA@AB@@AB@B@@@DADC@@@A@A@CBA@AB@BCBC@CBA@A@@@A@@@A@@@A@AB@@@@C@@@A@CBA@A@A@@DA@@BA@@DCBCBCBEBABA@ABC@EBC@E@CBCBC@AA@@@A@@@@@AA@@@@@@A@@@AA@@A@@A@@@@A@@@@A@@A@@@@@A@@@A@@@@@@@A@@A@@A@@@A@@@A@@@A@AB@@@@A@@@@@A@A@@@@@A@A@@@A@@@@@A@@@A@@@@@@@@@@@@@A@@@@@A@@@@@A@@@A@@@A@@@@AA@B@AEAEACEEACGGCAACBCBADCFCBCDAFAD@D@DCFADCBAFAFBH@F@DBHAFADABABABC@AACCCAACCAEAC@CBIAEBGAG@C@GBC@CAACACAAAACAAC@@@@A@CBA@C@CAA@ACCACACACAACCEEAA@@AA@@A@@@A@@A@@@@AA@A@@@A@A@@AA@@@ABA@@@A@A@@@A@A@A@AB@@A@AB@AA@@@@B@@@@@@@@@AA@@@@@@@A@@@@@@@A@@@A@@A@@A@@@@@@@@@A@@@@@@@@AB@@@B@@@@A@@@AB@@A@@B@@@@@@A@@@@BA@@@AB@@@@AB@@AB@@AB@@A@@BA@@@CB@@@@CAA@C@CBCBCAC@CCCE@CAC@A@EBCDEDEDCDEBCBCBCDE@@FE@AB@@BB@B@DBB@FBBBBB@@B@B@@@DBB@D@B@@@B@BB@@@BB@@@@@@@@@A@@@@B@@@@@@@@@@@@@BA@@@@@@@B@@B@@A@@B@@@@@@@B@@@@@@@@@B@@@@@@@B@@@BBB@@@BB@@@B@B@B@DB@@B@@@BA@@B@@BB@B@B@B@B@B@@@B@@@B@@@@@B@@@@@B@B@@@D@@@B@B@B@BAB@B@@@B@@@BA@@@AB@B@@@@@B@BABBDAHAB@@@@@@@B@B@BABAB@@@D@D@@@DC@ABCDEBABA@AB@D@BABA@ABA@ADADABADAFAFBF@HAHAH@D@F@DBD@FBD@D@D@B@BE@GBEBE@CBA@ADADE@@@C@@BC@@DK@A@CBC@C@ABE@A@A@CAAAAECCAAACA@@AAA@ABA@A@A@@@A@A@CAC@E@OAA@CA@@AAA@AA@ACAAA@A@A@AA@@AA@A@A@A@A@@@A@AB@@@D@@A@@@@@AA@@A@@@A@@@A@A@@@A@@@A@@@@@@B@@AJ@@@BABC@ABA@A@CAAAC@ABC@CBA@A@@@AEACA@CACAE@C@A@A@A@K@I@C@GDA@A@ABADEDGDABCBAB@BAB@B@@@B@D@B@B@@@BA@@B@@@BADCBEDCDC@A@@@A@AAAC@@KEGEMIKEGEECKEAACAACEKAAAACAAACA@@@A@@FCB@BCBGDABADABABABA@ABABA@@FABABAB@@CBA@@B@B@B@@@D@D@@AB@@A@CIMEEGGAC@A@@BAHEFGDA@@@A@@C@AACACAEGCEAC@ABAJIFEBABABCBAB@B@B@DABABA@I@A@@BAB@BABADADCBCBABCBG@A@CBABABA@ACE@A@@B@@AD@DABABCDADAD@B@B@BABA@A@A@@AAIECAAA@A@A@@B@BE@CB@@ABC@A@A@A@@@E@@BA@A@S@ABCJIFEZQjWFEFGBCBC@A@CAAGAIA@@A@A@A@CACACAAC@A@C@A@AHEFKDE@CAIAG@GACACKI@AA@@@AC@A@@@A@AACA@@@AAAAQICA@A@AB@BA@A@@@CGK@C@E@CBCBAHOBC@A@@AGEEICKEQEG@KBE@K@K@GAA@AD@@@D@D@DADADEDCBABC@CAGCC@A@CDABCD@DBLFFBF@BABC@A@ECEEGAIAI@EBGBGFEBGBGBEBABCDCBEBCB@FEDCBGAKGAEDCBAACAGCCAC@E@EAEAG@GBEAICCEAMAG@CAAACEEEKEECGAE@E@C@MJ[JQB@BABABCFABEBEFCBCDABC@C@CBGA@@E@EBCB@@EBC@EBC@A@G@CAA@AAA@AAECCAA@CAA@AAEAEAC@E@IDCBAAEAAAECCAG@E@E@CE@ACGGGCSCGIICEEEGCMAG@EDEBIAE@GBGCGAE@GDIFIHEHEHCTEF[LOBQ@G@GDIDEAEAAGECCAEBWJKLGDCAUUOWCI@EDGHMLGZGPAFCHMHMTSLOLcCEcSMIIMGCQGAEHQJIJKFKDUDKXILMHE@EAECCCAE@QCQEE@GDC@EAQGE@G@EDEBIBMAMAMIIICCEAKAEBGBIHIHEHAF@HHR@`@FABC@m]u[EC"],encodeOffsets:[[101299,32192]]}},{type:"Feature",id:"513333",properties:{name:"色达县",cp:[100.332743,32.268129],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@KEGCCCC@CIQ[YQOAAA@CBA@EAGEEAEAOCCAEGGGC@AAA@KFA@IAIGG@I@eI]M]QQOSSGGOMCAGBCDUN@@@@SNCBsBEBU\\EBABOFWHQHQVSDYCM@YNCFSfabUNYFC@A@@ADAFC@@@ABA@C@C@@@ABA@ADIBE@ADCDADCBAFEFGBEDK@C@CBADADACAEAECCAGEIEGCAAAAC@QAAA@AACAICOAE@AEE@AB@D@D@BAHABADADCDABABCBCBA@ABA@@@ABI@C@CACAC@IAECGCGACBM@AAC@CCEIBC@C@SBI@C@E@GAIBQ@ABC@GBA@CABG@CAABABCBEBEBCCC@A@ACACCGACBCBM@UXQ^A`QjUnKPOPOPKRIZB^G^ABAJ@F@BAB@BABCDCBCB_HCB@DFDBDBDBDBF@D@H@D@BADCFAFA@@BEFABCFCHAB@B@DBD@DBFBD@BABE@CBGHGDABEFAB@D@D@FCH@BBB@BAFBP@HKT]NSTQF]ISAQDIBQRADCFGX@FAD@D@F@D@FBJAFAJEJEDIDQHKHADCN@JBL@NA^@DABCFA@@DABKDA@ED@@ABAB@BA@CBABABABGHC@CACAEAECCCACACCA@@C@@@EBCBCA@@AAA@AA@A@@A@A@@@CBA@@CAGCG@A@CACACAEAA@@@CA@AACBA@CAC@CAE@C@C@AACCC@CAEICI@YA_CI@KBG@ADIDAFAJEHMFGDGBECCECWMIAQAI@CCEEKgCAEAGBCDELCH@HAFEBCAGAMKMOCCC@A@ADEXCN@L@FCFCDCDI@C@GA@AC@EBGBG@EAGEC@E@CBG@EAGGQSKSAGAYBGD]AGAGBCJSPQJ]FIBG@ACCECGEAAAA@ICCACAEAEBGAEACC@A@C@ABKFGBI@GBIHGJQJAHAN@T@HFLDHBHAJEH_fGNEJGDGBE@C@GDOJMTIHCJAF@PAP@DABEDGBEDCBGJAJ@TAFABCDEBCBAD@J@T@X@BKLEJCDEBEDKJEF[XCNELABCDAB@D@DAFAFGFEFAF@B@DDDFBDDBD@BCDIJKJAD@DDDBF@DAFADBBB@JBB@FAFCFAD@FD^LDBDAPGFAD@BBDJTVHFFBBD@BCLGLAHBFCJWvKTIZBH@DCFAJ@VFPBNBLBJDDDBbPJ@NAJBHDFB@FC^CDGBIAGBCDCHIF]DCFGPKPEDCBC@EAEEACAACBEDKLAFBDBDAFBDBD@DCPCHCN@DDHFDBB@B@BABCHAHADCDADCLADC@MBG@CDCDAL@HDF@B@DADBD@H@HELEFKJQHGDMNKNEJCDCBEBG@IHCD@DBFADCDMFWLC@C@CEGMMIOEQEC@CBAD@DBD@DCBEBCFAHCDCDAD@FAFILCHCJEHCDABCACAA@CBAFEHADA@ABEACEIGGOEACAIFCJEJCBEBCASKGACBE@EBC@G@AB@DBFDT@FCNGNEHIDMDEFEJEDIDCBGLCNEHIFIHGHELEFGZGL@H@HAHADEHAHEFCZOZMLADC@A@CAA@A@EDGDKHCBAD@@BLABEBKFCBEFABAFOLGFEBI@EBGDQJEBIDGBEDCDGJCDCDMHEFAB@B@FADABGHAB@BBLAD@DBNJRDDBB@BABORAHCFGPGFIHADEHB@@@BBDBDDFBFDFDFDDDBBDBD@B@B@DBB@DAFCDABAFCDABAD@BAB@@CB@BABBB@BA@@B@DABBB@D@@@BAHC@@DA@ABABCB@BAB@BCB@DBD@B@BAD@B@BABA@@@C@@DA@@BCBA@AD@BA@@B@B@B@BAB@BA@@BC@@BAB@B@@@B@D@BB@@DAD@BCB@B@B@B@B@D@FABAB@B@@@@@B@@@D@BAB@B@B@@AB@@@@@@@BABA@@B@B@BCDC@@D@B@B@D@BB@BB@B@B@B@@AB@B@@@@@B@@@@ABBB@BABABAJAD@F@D@D@D@FBF@BAD@BABA@ABABCB@DBD@DBD@D@DAD@DADABAB@BABCBC@CBCDAFADADADEBCFCDEDCDCBEBCDCBCBA@C@CAC@C@C@CBADCDAD@DB@BB@DABCDABCDABCFAD@DBBBDBB@D@F@D@DBBDBBBBDBBDDBB@D@D@BADABCBCBABC@CBE@ABCBABCB@BAB@DBBBDB@BBBBBD@B@D@DAB@BCBABCBCDA@@DCF@B@F@DBD@BBB@B@FAFAH@F@BA@@@@BA@@B@@@B@B@DA@A@ABABA@@B@@A@@BA@@@AAA@@@ABC@@@A@@@@@@@C@@B@BA@@@@BA@A@@@@@A@C@ABEDEBC@E@AD@D@DCBABCDA@AF@DBDDHFDD@DAJAFAFBBBDDDFBFDDDDDBD@DFFBDDBDBBD@B@F@B@BBBBBBB@B@D@FAH@F@BBDBDDDBB@@BDDB@DADCBAHBBBB@F@BBBAFAB@D@FB@DDBDDBBD@BBD@DAJ@D@FBDBBDFBDFBDDDBBDBF@B@DAF@DBB@DBDBDBBDF@BADCBADCB@BAD@D@FBF@B@DCBCDADADAD@B@DAD@DCDA@ABIBC@GBABBBDDB@DDBB@B@DABCB@B@DBDBDBFDBDHBDDBDBDBDBFBDAB@FEBADCBADCDABCBADCBAB@DBD@F@DAB@DBBDDBBBBDDBJDDBD@DAB@DCBAFGDAD@BAF@D@DBBDBDDFBHBFAJADBDBD@BFFBBBBBD@B@D@BABBB@BDDFFBBBBFFFB@B@@BD@BAB@DAB@B@B@@BBBBDB@B@D@@@DBD@B@FADBDBD@D@B@DAB@DCF@D@B@D@B@@AB@@@B@@BB@B@BA@@BAB@@@@@@DB@@B@HD@@DAB@B@@A@@BA@@@A@A@@@@B@@@BB@@BB@@B@@BB@B@B@@@B@B@B@@@D@@@@@BBB@@@BA@ABAB@BA@AB@@A@A@@@A@@@AA@@A@A@@@ABA@@@@@@AA@A@A@A@A@A@A@A@ABC@CBABCDAB@B@DAB@BABA@CBC@ABCBABCDAB@DADADAB@@AAE@@DABADABABABABAF@B@BABADC@A@EBE@C@E@C@C@CBCBCBABA@ABC@C@CBA@E@CBABCDADADAFAB@BCBCB@DAB@DBF@B@F@D@F@B@D@DAD@DADBDBFBBB@@BAB@@A@@B@@@@AB@B@@@BAB@BA@@BA@ABA@@BAB@B@@@B@@@BA@@B@B@BAB@B@B@@@B@B@FBDBBAD@DABAD@BA@CAC@A@CBAAECC@C@CACCE@CCAAACC@CAADCBAB@D@DDD@BBD@D@D@DABAFAFCBABCBAD@BDBBDBBBD@BBB@DADADABABA@A@ABC@ABAB@D@D@B@B@@AAC@C@AAC@ABC@ADCBABABA@A@CBABADBDDBBD@B@B@DAFADADADABADA@A@A@@AAAAACBC@C@EBCDCDAFCBAFADABA@A@@AAACACACAC@CBG@C@C@CACAC@E@E@CACBCBCBC@@@A@C@C@C@C@C@EBCBE@ABEDCBCBADC@CBIBCDEBCBE@GBEBE@E@@BE@ABG@CBC@C@CAAAE@A@AACE@A@EBA@C@A@CEBACCCACAAC@CACACCCCAECCCAC@CAECCC@C@A@C@A@AAAAAC@C@C@A@AAAAAAAAC@CBAH@FADCFCDCDEDCDEBE@C@C@C@C@A@AF@D@DBDBF@BAF@@A@CDCBCB@FAD@FBFBF@DBD@DADALCFCH@JAH@H@HBF@FBD@D@B@BA@C@CBADCDCBA@CAA@C@A@CFADADAFCD@DAF@DBBBBB@BADABABADBBD@DDBDDD@@BF@BAFADDDBDBDDHBFFN@FBDAFAFBDBD@DAFAD@DBDADBDBBDB@DAD@DAH@D@DBDDFDDBFBF@DBFBB@DADADBBDDF@FBF@H@DBFBDBB@BDAHAD@BAFAB@D@BD@D@D@FADAHADALEFAF@FAFAFADCFAFAHAF@JAHBD@FADADCDCDABADAD@FBFFBDBBDDD@HBFBDDDDDBFDFBFBBDBDDDBBDBD@F@HBDBDBDDDBBBBBB@@@B@BBB@@B@BB@@@B@BA@@@@BA@@@A@AACA@@A@A@@@CB@@ABAB@BA@@BA@@AACA@AA@AA@@@@A@@@A@@@@@@@AC@AAA@A@@AAAAAA@AAC@A@@@A@A@@@AA@@C@AAA@A@@AAAAAAAAAA@AAAAA@AAA@AAA@@@A@AA@@@AA@@AAA@@AA@@@@A@@BC@A@@@@BA@@D@BAD@BAB@B@D@B@B@B@@@@BB@@BBDBD@D@BBB@B@@@BB@@BB@BDBDBBDBBBBBB@BBBB@BBBB@@BBBBB@BBBBBBBBBBBBBDBBBBABCFAFABA@AACDADAFA@ADC@CBEBADABC@A@E@CDCD@BCD@BAA@G@ACBAF@DABBDDFFBD@@D@@@BCB@BABCDADABBDAB@DCBGBC@CACBC@A@ABA@ADA@CACDADAD@BABA@@F@BAB@BC@CDCBCBCBAD@B@@EBAB@BC@@B@@A@@@A@AB@@AHEDCBEB@@A@A@C@A@AB@B@@@FAB@B@@A@@@A@@A@EEA@A@A@AA@BA@A@AB@@A@@A@@A@@A@@BA@AB@@AD@BAD@@AB@B@BBB@@@B@@AB@@A@@@C@@@A@@AAA@@AA@E@AAA@AA@@@A@A@@@CB@@@@AB@B@B@@BB@B@BBB@B@B@B@B@@AB@@@B@B@@@BB@@B@@@@@B@@A@A@ABAAA@C@@@A@@B@@@@@B@@@B@D@B@B@BA@@@@@@@@ACCA@@A@@AC@AAA@@AAAA@@A@A@A@ABAB@@AB@@@B@@@B@BB@@BBBB@@B@@BB@B@@BB@BBBB@@B@@@@AB@@AB@@A@AA@@AA@@@AAAAA@@@@A@@AA@AB@@ADEBA@@B@B@F@B@B@@A@A@@@A@@CC@@@A@@@A@A@@@A@@BA@A@AB@@@B@@@B@FBB@B@@AB@BA@@BEBA@@@@B@BBB@BBBBBB@BB@B@@@B@BA@@BE@A@AB@@AB@B@D@B@@@B@BA@@D@@@@AB@@AA@AAAA@@CA@@AA@A@A@@B@@AB@@AB@@@B@@@B@@@BB@@@B@B@@BB@@@@B@B@DBB@@@DBB@BB@@BAB@@A@@@A@A@A@A@AA@@@@A@@B@B@BAB@B@BBD@BBBB@BB@@@B@@@D@B@@A@@@A@@@AACAA@ABA@@@AB@@@BBB@B@F@BAD@@@B@D@B@@AB@@A@@@A@@AA@A@A@@@AB@BABA@@BB@@B@@BBB@BB@@@B@@@@@BA@@DADABABCDAD@DB@@FAHCBABE@ADADADBB@DAJ@HCBABB@B@@@@B@@@B@B@@EGGGCcGSCSKACAIBENUFADABC@EACAAECQaEOEECAE@G@GEIGACCKACEAE@G@G@YGICUQSWGIGKCGAK@IBCBEFEDABCACGEKOAC@A@ADCDC@@BCBAAE@@ACBEBAAC@@@A@AD@@@@ABA@C@C@@BAB@B@B@FCBA@A@@@A@A@@@ADABABABADEDA@@B@BAB@@A@A@ABA@C@@@AACAAAAC@@ABA@ABABA@@BA@A@A@CAAA@@A@@BABCBAB@FCBA@AB@@@B@@@BB@@BBF@FDDBBBBB@@BA@ADAB@B@B@D@BB@@B@@@BABADC@ABABA@CAACCGECEAEBEDELSRSDAD@HDD@B@FCDEBCB@H@D@@@R@B@FJDDD@DAHELIJEFAHBFADABCN]R_DCB@D@HBH@J@NCHAHAFBD@BAHKLMBCGKICIAQDEBIAGCGGCC@CBGDGJUTQLEFAFGHMDKDEFAHAJAFAFADCPMD@FBDABABYBEBCFANEFEHKBIDCB@B@LDDBD@DA@A@EISAMEUGIAE@C@CFE`[B@BBDBDDBBD@FAHEDCBAH@D@DCDGDM@@DAHBDA@ABCDAHCBABADE@AFAHANEFAD@D@NRFBL@J@LD\\NhNbHH@D@FADABG@ABA@AB@BBB@@@B@B@D@B@BBD@B@DAFADABBB@BBD@DAD@BABA@ABA@ADCHENI@ABCDCBCFQ@ACEAECCYOECACCKAG@MBEFEJGDCBC@E@EAIBM@ICICGECE@IAKBKBIAUEIAAAAC@G@C@C"],encodeOffsets:[[103374,32615]]}},{type:"Feature",id:"513335",properties:{name:"巴塘县",cp:[99.110712,30.004677],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BBBB@@B@B@DBDAB@B@B@B@B@B@B@B@B@DADADADABCHA@ABA@@@ABCDABAD@B@@@@AD@BA@ABABABABCB@B@B@B@D@@BFBB@B@BABILADABADADCBA@CBCBC@A@CBIDABABAB@@@B@D@B@BBBB@DDBBBB@DDDBB@B@@@BADADAD@BCD@BAB@DAB@B@BAB@D@D@DBF@D@DCFAB@D@B@F@BBB@DAB@DADAN@DAD@@@B@D@DBBDB@B@D@D@BABAB@FANCN@D@F@BABCD@@@B@@BDLDDB@@@JCD@D@BCBEL@DFFBFAHADGF@B@@@BA@@B@@@B@@BB@B@F@DHLBH@DCFEFGFABCAC@BBAJBHBF@BBJ@FCFALEFCD@@DJ@LDLBFDLBFAHEF@DFHBFAB@@ADABABCBCBA@@BABCHEFCNAFEFGFEHSP@@AHCF@FBH@BAJADCDABAFCFCDADA@@@ED@@AB@BAB@B@BBB@BDDBB@BABA@ABE@A@@BADAD@FBDDBBF@DCDAD@D@DHLBF@D@DADBFBH@BCFABBBBDBBBLBDAB@BAB@D@@BBBB@B@D@J@F@D@BBB@@B@@BDFFJ@B@BBJBJ@@BH@HBTBB@BBB@BBBDBBDDNAPIZ@LHHBBBDFHBJBF@F@D@D@FBDBDJFFDBF@FDF@D@FFPDLDDFF@@@@HFFF@N@H@DFFBB@H@FBJBBJ@DBFHAHGBCBABA@ABA@EBEH@@AFBJBJBDBHCJ@DAFAHAH@DAB@F@FDBDFEFEDEH@F@NBFAHABOFAD@FDLBFFFBFAFAFADAD@@AF@HBFDFBFDHBF@HBF@FCFEFGFEFCHAFCFCHGDEHEFCFEFADAFBFDFFJAFCFCFEF@@CDC@C@CBA@C@C@CBC@CBABAFAD@DBBBDDDBB@B@D@B@BADA@CBA@AB@@ABAFAB@D@D@B@BBDBD@BBFBDBFBDDDBBBBBB@BBB@D@F@D@@ADABCD@@CBCDEDABCDA@ABCBADAB@DAD@D@B@B@B@B@@@B@DBB@BB@@BAB@B@BGF@BAB@BBD@D@BBB@@DB@@B@DBBBBBBDDF@BBBB@BB@BB@@B@BBDBD@BA@@DABEFAB@BAB@B@D@D@D@@@@BBAB@BAD@B@@@B@BBD@B@B@D@BBHBJ@B@BABABCDABCBABABCDCBABAB@D@D@BBB@DBB@DDBBDBB@B@B@B@DABA@ADABAB@BAB@B@B@B@BBD@F@B@@DBXJ\\L~FVAbAZ@LAZBTFTPXZBF@TG\\GPCPFVDFFDpRFDB@F@B@BB@BB@AD@F@BBDDFBB@B@FBBDB@DBBBB@@B@FCB@BABAFEB@DA@@DB@@BBB@BDB@DFBBDBDDDBBBD@F@BB@B@BC@ABCBAB@BABBB@B@D@D@@@BED@DAB@@BDBBB@B@D@B@HAB@@@BBDFB@BBB@BAB@@AD@D@DAFCDCFGDIBK@@D@FBJBD@@A@CBANOBAH@FBH@B@HAB@B@@BBBBDDDJHD@B@DB@B@DAH@B@DBBBBDBF@P@@@@@@ABA@ADABADIDA@C@CBE@A@C@@@C@ABC@C@A@CBAB@@@B@B@BBDDDBBBB@B@BADADEFCR@VDD@DCHGFAH@JBLBNCNCB@PBB@@LABOJABGTADBDBDFXDRRbBFBbBHTdDDhVJNDPJJFF\\FbDB@V@PMLUNKV@P@DABSACaYBCBAnGVAFAHMFkXGZBVHXDVD`@HAbEKGAC@CIQ@A@@@A@C@CBCDC@ABC@G@ADE@C@CBABKBA@A@ABCBEDI@E@CCEIGUKMIGICM@KBIT_V_BC@ABCFGB@BA@ABC@AA@@AAA@AACAAAEAEB@BC@A@@AACAA@@ACECAACCAA@AA@AFE@@FCBCB@DABAD@FAB@FADAFAFAFCD@BABAJAH@FAB@DADCDCDAB@BAF@F@B@DAB@HGBAD@FCD@B@D@D@HAF@BAB@DAB@@ABA@@AEAC@ABC@@@A@CCKMWOWMU£[YCEDKDOACACBCDCB@HEHCNQJKFEFCDCBC@CCCA@A@EA@AAAACCE@ABC@A@@A@A@ABA@@AAAGCCC@A@@@CDE@A@A@AAAAEAA@AAC@A@@A@A@E@A@CBE@EAI@C@CA@ABAD@@A@CAAACICCC@A@ADEHGBC@AACAC@EAG@CBCDCFI@C@AECGCA@@G@@DAD@DE@@@CCEAC@@BABC@E@@@AA@C@@@AA@C@I@IACAACE@CEAA@G@A@CEACACDCBAHKDCBKCG@IBIFGFEJ@F@DABA@C@CBYFIBCFAN@BADCBE@CACEC_IOKIMEIAEBaBUEK@O@ADCH@D@HBFBFAHGfcHIFKAIMGcQgkMWAK@OCEECIIBEHaDIFMDEBIBEDADA@A@AECEG@C@ABCJADCDEBGFQDEFGJOFGDEBC@CACBABEBCDEBC@CCAE@K@ECCC@AACIKKGUGQAOBSDIAEGGKycGKDK@MAABE@EBCJCDCBE@KTmBgAEI]GUGOCCSQSCQDWJYBGGAEACCCEKEIACBADALIDCDEDIFGHEBA@CAECEACBABAFADE@ABEJG@E@EAC@ABCDABEAECOEMBMFKDI@KIOOSOKEACC[U@AQaCG[mAEK]AK@]KUCGAKIACAEACBGFINCBK@OEUCKCIKEECEAECUCCEA]JI@CGAS@IAMBKFKBIAGCEKCKCG@WCCCCEAUA[C[EmAGCCGAGFGLEHM@OEG@K@OFIHIDQAGBMD_NUFUFO@C@K@@B@DABAF@D@BBD@F@B@DBD@FBD@D@BAB@DBBBH@B@B@@ADCFCD@D@BBJAF@DB@BD@DBDAF@BCBCBADAF@@@BBDBD@@BBD@BBBD@DAF@B@BABADGBCDABABBHBBBBB@DA@@B@@BBB@B@BBF@B@BAH@B@BBD@B@BAD@B@@BDDFDDBDBB@DAD@BABADEDABAB@@@FAFBDBDBB"],encodeOffsets:[[101493,29923]]}},{type:"Feature",id:"513334",properties:{name:"理塘县",cp:[100.269817,29.996049],childNum:1},geometry:{type:"Polygon",coordinates:["@@NKDOEUEEECcSOSEMESCWAW@CCAAAACAKAAAEA@@@@@AD@H@F@D@H@@@BA@ABA@@AA@@@A@@DABCBABA@@AAA@@@B@B@B@B@BA@ABABA@@@EC@@@@AB@D@D@@A@ABAAAB@B@D@@A@A@@BA@A@AB@@@BA@@@@@AA@@@C@A@@@ABA@A@@@A@@@@A@@@@@@@@A@@@@@@AA@@@@AA@@@@@A@A@@@C@@@@@@@@A@@@ABAB@@A@@BA@@@@@@B@B@@@B@@ADA@@@@@A@@@ABAB@@@@A@@A@@AA@A@@@AA@@@AA@@AAAC@@A@@@@@@BAFA@@BA@@@@B@D@@AB@@@B@@@B@@@@BBB@@@@@BD@@AB@BA@@@A@ABA@@@@B@@@B@@@@AB@@@@@@B@BB@@@@@B@@@@CBA@@@@BA@@@@@A@@@@BA@@D@@@@A@A@@@AB@@@B@@@@@@A@@B@@@B@B@@@BA@@@AB@B@@@@@BCBAB@B@@EF@BCBADA@@BA@@B@@@@@@A@@BA@@BA@@@@@@@@E@@@ABC@@@ABC@@AA@@@A@A@@@@A@@@@@@B@@@@@B@@@@@BAB@BA@@BAB@@AB@@@B@@AB@BAB@@A@A@@@ABABC@@@A@A@@@CBA@A@ABA@@@@B@BA@@@AAA@AB@@@@@@@B@@@@AB@BA@@BB@@B@B@BA@@B@@A@@@A@@AA@ABMD@@A@A@A@A@A@A@EF@B@B@BEF@B@@@BBF@B@D@@@@AB@@@@@B@BA@@B@@@B@@@@BB@@@@@@@BC@@@@BA@@B@@@B@@ABA@@@AB@B@B@@AB@@@@BB@@@B@B@B@@@B@@@@ABCDAB@@B@@@@B@@@BA@@B@@@@@B@@@B@@B@@BA@@@AB@B@@A@@B@@@D@@@@@B@@@@B@B@@@@B@@@@@@@@AB@@@BB@@@@@@B@@@@@@@BA@@@AB@@ABA@@BA@C@K@@B@@@@AB@@@@@@@@A@@@@@@@A@@@@@A@@BA@@@@@@@@@@@A@@@@@ABA@@BA@AB@@@@@@AA@@@@ABCBCB@@ABAB@@@@@B@@B@@B@@@@@@@B@B@@@B@@B@@@@B@@@@@@@@@B@@@@@@A@@BAB@B@@A@@@@A@AA@@@A@@A@@B@@A@@@AAA@@@@@@AA@@@@@A@@A@@AC@AA@@A@@@@A@@@A@@@@@A@@@@@C@@@CB@BCBABA@@A@@@CBC@ABAAA@@AA@@@A@@@@@AB@@@B@@B@@@@B@B@@@BCBA@AAA@CC@@A@A@AB@BAB@BA@@@AACEA@CAC@CBCBA@A@A@A@A@A@AA@@@ABA@@AAA@C@C@AAA@A@A@A@CBEBA@CBABGBA@AAA@ID@@ADABABEBGFEBABABABA@A@AAC@AA@@AA@@@B@@@BBB@D@B@@A@CBA@E@EBWNSVEFGNEPARCPBD@F@HCDEDG@KDIBI@ECEAC@GJADCFCBABABCBC@C@CAAACACCEEEECC@@AA@A@GAAAAACAAAACAE@C@ABA@IAIEIIIQAIGCE@G@EBYHODI@C@EACCEGEUIM@GAE@M@EBCHEFAJ@J@LFZLTFP@HADCDCFIBGBCCEAACAGAIB_GQKQMEEACEG@EDCDCBMBO@EAICGCCGAAAECCAAEAI@GACAMAS@GBCDAD@D@HBBBFHJJDDFDHBDBBBB@J@D@@@FAB@B@B@@BBBB@@@D@B@BAD@TAPMFMJKLCTD^JH@bg@G@[ACSYAC@E@CAC@AACC@ECAAAA@A@AAAAASBE@GAE@GACABAF@BABCBCBABAB@BC@A@CBIBCBABABC@A@A@ABE@C@ABAB@B@DAFCDED@FADADCDE@CBC@ABCAE@C@@@A@ABABAB@J@F@FADABA@KDGBE@ACGCGAKB@@C@IFEPGNMHEBGBSBGBA@A@CBABAF@FCJCDCFCFANGDAJA@@HCRGNKLQZWTQfcDERiNQ\\UXEZQB@jePKDCBCSmAACYDKRKROPOVGTBTJPRRDLCHEJGJM@WAKSi@AAAKOEQAUNGfJTBLMJMLOJWNOFAF@tHPEVUPSVcLU@SD[@SACU[MQGMKQISJMJGFA@@DABAJEFC@ACAECECACACACACEAEACAACCICGACCGA@BGB@BCBC@CIMKSCE@EBCBCFEBC@A@AACAG@C@CAEBCBAB@BAB@DBJFDBL@BAB@@ACC@AAG@C@C@AEGUSAE@A@C@@@@A@C@@G@A@CBA@AAAIGAAAABE@CDADCBCBCFGBCDEBA@A@CA@BCDC@ABA@AAC@A@A@ABAB@@ABABAD@D@BABABABABABA@A@A@AB@FWHS@E@AYLSFOCEA_SQQUMUO[GY@QAOEOIUOEE@CCcDKJGRCT@JBT@\\EL@DAZsBM@COMKMW]EACCICQ@IDCFQZMJ[BGBYA@AAAAEAACAA@C@CBCBCBEDE@A@G@C@EAKEIGIGA@K@MDABADABABGNEFEDEBEBGDIBQ@E@AA@ANQBG@AIEMGECAEGCA@EC_yCCAAAAKEKAYE[IQ@ODGHWHEAQGWACBA@@B@FCDCBAD@DBDFDDDDTADCD@D@D@BDDJ@NDH@F@F@DBBB@DF^FJHFTLXHPJXALCNEF@DBB@BDBB@@ABCDAB@H@JCJADCDCBEBCDCDADKNCFINA@ADA@E@EAC@CBCBABABABBFBD@D@DCBA@CBC@@@GBA@CBCBCDADBNADABIFEDMTAFAPAFABABG@QBC@EDCDADCBGDC@E@G@[CMCKEGCKEC@IFKHCBGBEFAB@BBDAHADCHEHAFCFGDABAJCBADAB@FBDADABIBCBAD@BADITSZORKJaPWNKJIHKRCDCBkXSLMNEN@ZCDCDKDMLIJ­ìKTKJKFKHGJewSvYOfOdQ^ADKN[ZWZIHYHMFEFGHK\\@HCHIFODQBC@GCCC@AAA@@AC@@@@@ACCAAACAC@@ACAACCIGACEACCC@ACICUAkMsM_IYKGEACBALEFCBIDC@C@CECKEGECE@IBGDEBE@EAI@GFMBMBO@MAO@GDEBG@CGUIICKCMEMIQKYQFWJ_B]B]@YAG@EBwnCDGXGRIXEFAFAF@DBBEJGbILGHGDMBKDKDID]XEJ@XAF@@CDEBGDGBCBE@C@EAE@EBA@AD@@E@A@A@ACCCACCAABE@G@ECEICCICCAGFKFCBBD@DADCFEHIPEHCFERAHCFCDIBAD@B@DFHFD@B@BCBCBAFAJCFENCJGbAFJJFDDF@PBLNXhldRNHBJELGJedGHEBEAGAC@G@CD@B@PFLAVAbBFFJJNPL`JFDBD@DAFCDABM@EBADEJAZ@D@DABCBE@I@EFEHAJ@JDHALCDGLABCDBDBDDFB@H@B@FB@DDFBBBD@J@J@DBB@@D@B@@B@@@FADAB@@BDDF@D@@CFC@CB@@@HB@HDFD@B@DEJCDAD@DBH@FBDBD@BADGHCF@B@BDDJDBDBB@D@BC@AB@BDBD@J@FBF@DAB@F@B@B@@@@BBD@BBBBFBB@B@B@BCF@D@@@BDDHDBB@BB@BAB@B@@@@BAD@BDFBDBB@BFBB@B@DD@DADCDEDEFILMRGDGFA@CDADBDBDCPCLDF\\Z¤NVPXNXDL@D@B@@AD@BBDBF@@AB@BA@CBA@ABE@GBC@C@A@C@EDC@ABGHA@CBA@E@E@ABA@CBCDCDCBA@EBG@IBABABC@EDEBEBCBEBA@EBC@ABCBA@ADED@@EF@BBBB@DBBDDBDF@BB@DBBB@@@BADA@BFBFBBBD@BBB@BB@@BAD@BABA@EHAD@BADU`S`AJ@LDNHJNJVLJHDF@D@FCJAFAD@B@BABALAB@D@DCF@B@HAD@BCDAD@D@D@B@@@BJR@DBDLHPHHJAJENOVAVBBVHZN`LhBPAXEXBTNTlHdEVFf@F@X@FBJZFHAVSHMHWJ]NIHEBCF@FBJDFBHE@EAGECAE@EBCFAHBL@JCBAFEFGJGB@F@DBF@HA@@F@DAFE@AB@BAH@F@B@DBRN@BBBBBDD@BDB@BDDFFDBJHBBBBB@BBBBB@BBDBDDD@DBD@DAH@DAB@DCDERAFABCBCBE@ABB@BB@@BB@B@HAFAB@DBB@FBB@BAFADAHIHCFADAD@D@BADABA@@D@B@F@JAD@D@B@DBBBBLBN@F@DAB@BABGHAFA@BH@DBBHFFFB@BBB@DAD@HCFAD@BFLNNHJDJBDAWLENUP[RWCGCMDQBEFGHCXDnALCHKKUECAC@E@GAEBEJcBEBABAD@DAD@DCDABEBA@ACC@AAA@ABABCBCD@DAFCFEDABAB@B@FDFDDDDBD@H@DAJCBCB@BBPFDDD@D@DBDBFJJJDDFBRDLFDBB@FAJCNCLCF@D@JCDCDAHAF@H@F@J@N@H@F@F@B@HELEJANCH@xAF@@@@@`AL@JAD@BABABCHQDMHUBGFMBEBADCDADALCFADA@@BCBAFSBCBCFEDABAD@D@BBDBLHDBD@J@@@@@@@VCFADALGB@LGDAD@DAF@DBRFFBF@FBFALANAZEFAD@DCDABCHKDCFCDAD@B@@@BDAFBB@@B@B@FAB@B@D@@DBH@F@BB@B@B@DEDEDE@C@CEUAG@C@A@CBCDEBCDABAB@D@DBFBD@BA@@BABABKBG@A@ABAD@JAF@BABAB@@GBE@CBA@AD@DAJALC@@@@@@DADABADABC@@@@BCBC@@@@@@@@BAD@DBHBD@BBD@BABADCBADEBKBE@CFEHINOFEBEDEBO@E@ABA@AB@FAD@BADABABGBK@ABABABCBABA@A@I@ABCBA@@@@@@@@DAB@DAF@FBFAB@@@@@BABA@@BCBAFQBEFEFEBC@A@C@ACIAEAA@A@@@@@A@ABAB@BAFAFBH@F@B@@@@@@@D@DCJCNIHADAH@PAD@DABCBA@C@@@@@@AO@GAG@@BA@ABA@@RAF@D@DBDDHFFFBBB@BAB@BGBC@AD@DA@@B@F@B@@@@@D@DBBB@BBBABAF@@@@AFA@@B@B@@BBB@B@PELCDC@A@G@@@@@ABE@@@@@A@C@ABAB@B@D@@@@@@@D@DDDDBD@HBB@@BBBAB@DAFGBA@CAGCI@KCUJ]FIJQAGGAUBMCECAE@ULI"],encodeOffsets:[[103309,30823]]}},{type:"Feature",id:"513332",properties:{name:"石渠县",cp:[98.102914,32.97896],childNum:1},geometry:{type:"Polygon",coordinates:["@@EFG@KAE@CDADALKFGJBFAHABEAC@GAG@E@GFCDABE@ABA@ADCDADABC@G@C@A@C@ABCFCBGBCBAAA@CAA@AAEBAAA@@@A@A@EBKBA@AB@@@B@BABABA@ADADCFADCBC@KAC@ABADA@A@A@CBA@IBA@@@A@A@C@ABC@@@CAAAAAA@@@A@A@A@A@ED@@@@KAEAA@A@AAA@CBIDEBCBA@@@ABGD@B@D@DA@@BA@E@C@A@CBA@KAA@A@CBABMFGCECIACBM@A@C@CAC@CBA@ABA@ADADAD@B@D@BBDAB@DCDABABCBC@C@AAA@KBSGIBCFCJGHGBI@A@A@C@CAC@CCEAA@E@E@C@ABA@CBA@CBGACBC@EBEBEBC@EDC@ABABAB@B@B@DAB@BABC@CBE@E@EBA@GBC@A@ABKFCDAB@@@B@@@B@B@BABCBABC@C@CBABCBCBABEBCDCDAB@BCDABABCBC@AAA@@@@AA@A@A@CD@@AB@B@@CB@B@@CBCBCDCBADADABADABADAB@D@D@DAB@D@D@B@DAD@BCDC@CBE@EACCC@ACACAAC@ABAB@DAB@DC@ABC@GBE@C@C@EBGDABGAEAG@E@GDADABAD@F@FCFCBCDCBE@EC@ABCAAC@KHAB@D@HCFEDEAEAE@E@A@@@A@A@A@@BA@ABCBABA@ABC@C@ABA@ABA@AB@BAFAD@B@BB@@@@@@B@@@BA@CBA@A@CAA@A@AA@BA@@@@@ADCFCHGDEDCFCDABCBED@DIDAD@JCBGAEBIBCD@@GH@BBBFDDD@D@DCD@D@BADAJADCDCDCBABEDAD@F@BCDCBCBAFADGHAFCFAHCHCDADCDABEHAB@@@FANABCD@DAH@DDFCDADBD@DAD@@@D@D@HAD@DBDHBH@F@DB@DAH@D@D@H@DADCDADEDEF@FBDJDBB@DCDGBGBEB@@A@@BCDAB@B@@@@BBDDBBBBDFADCD@@A@@@C@A@A@C@G@I@G@ABC@ADCBABCF@B@BBFBD@D@B@@BBB@B@B@BBB@D@@@B@B@BBB@@@@DADAD@HBBBDDFFBDDBB@@@DABCFAFCHAD@@ABBDDHDFDBDDFDDBD@D@H@D@D@D@FDDBDBF@DAD@FBD@DDDDDFDBDDD@DBF@FBD@HDDBFBD@B@H@F@D@FAFCDAF@F@D@DBHHDBDDDBD@DABCB@D@H@FBF@HBD@@@FBB@D@BBDBD@DBFBDBDBBBFB@DADCBCBABABABADA@CDA@EDEBEHCD@@@B@@AD@DADAF@DFA@@D@D@DBBBDBDADAFADBDBBDBB@@DBBDDBDBD@F@DBDBDBDBBDAB@DCD@BADAD@DAD@DBD@D@B@BABADAD@BCBCD@B@DADAB@D@DABAFADADCFABCDABABAD@B@DC@C@CAC@C@C@C@CBA@IFADCBCDED@@AFBBBBBDDDBDADAD@B@BBBBDDDBDBFBBBDADABCBCDGDEB@B@DBDBFBHBH@DADCD@BBBB@FAD@B@BBDD@BFD@@B@D@D@DBFDDDBBD@BBDD@FBB@DDDD@DD@B@D@BDFBFBBBBDDBBF@B@D@DADADAD@D@DBDDBBBDBDDDBBD@BBD@DBDB@@DDDBD@DBDB@BAD@D@B@DBD@BBDBBDBDBFFDBDDDBDB@F@D@BBBBDBBDBBBBDFBBBFBBDBD@D@D@DAD@DAFAF@D@BBF@BDFBBBFBBBDDDBD@BBF@F@B@FBDBD@DBDBL@HBH@DBBDDD@DBFBDBDDD@DBDAD@DADADAD@D@D@D@F@F@H@FBF@DAD@DB@D@D@@AD@DABAD@B@DBDFBDBBBBB@@B@B@@BB@@@F@B@@@@A@@@A@A@@@@B@B@BDB@B@B@D@DABAB@B@@@BBAB@BABAB@BB@BB@@B@BAD@B@B@DB@BBBABABAD@@@BBB@@@B@@C@@B@@@BB@BBB@BABA@@BC@@B@B@@@@@@B@@B@@BABAB@BBB@@B@B@@@BBBD@D@@BBBBB@D@D@B@B@BAB@BBB@B@B@B@DBBB@@BB@DBB@@BBBBB@@@DBD@FB@@BB@B@@ABAB@B@B@@@BBBB@B@B@B@@@B@BAB@@@BAB@@@@@@B@B@BAB@@ABABABABA@A@@@E@C@GBA@CBC@CBADCBCBC@CBABCBABE@@@C@E@ABADABABCBEBEBE@E@E@E@CBEBABABEBA@CAC@C@EDABAB@DDB@FABCDABC@CBC@C@C@EBCBEAEAABC@ABCBAFCBCBC@CBABABCDCBC@CACAEAC@CBCBCAACCCEGE@A@CD@BADABCBC@EBCBABADADEHCBEAA@AACACC@@A@CAC@CBE@AAC@CACBEB@D@DBBDD@DCBABCBCDCDABAD@BAD@DAD@DCBABCBEBCBCBCDABCBADCDCBAD@BAFABCDCBABAB@D@DBDDFDDDD@F@DABAH@B@FDD@DBB@FBDBF@BAD@DAB@D@BDDDDBD@FCBCBABEBABCBC@CBA@G@CBC@A@KBCBCDC@E@CCEBCBC@G@CACAGCGCE@E@IBCBADAFCFABEBCBAD@BAFCBEAC@CBGBGDEBCB@DABAFADADEDCD@DBFCD@DBBDDFBHDBD@D@D@BDFBFFHBFBH@DBFDBDB@DBD@DADADBDBDDBFB@DAFAFCD@DADADCDED@FADAJBDDBDD@D@FBDCDCBCBEDCDBD@@@D@J@FAFAF@DBBDDFBBFCDABADABADCBCDGD@DDDBDABADCB@DAF@HCF@DBD@DCDABADCD@DCBCBG@C@E@EB@F@DCBABGFCACBABEDADADBDDDBBBH@DAD@BAD@FDBDBJBNBLBJBDD@BADCFADCDCD@BDFBDBD@FADEDABCF@DADBDBDDBF@FAFAJCFCDADBHDHDD@FAF@D@FBJBL@H@J@HAD@D@BD@F@DBDBDDDBBDBD@DA@@FADADAHABBBBBBBBDBF@F@BBD@BBBBBD@B@@BD@BDB@@BBBAD@BABAD@B@D@D@D@BA@ABC@ABABAD@B@D@D@F@BBD@BBDBFFFDBDDDD@DBDAF@D@D@DBD@FBDBD@HBJBFBBBB@DDDBBBD@FBDBFBDBDBBAD@FAD@D@DADADAFADADABADADAB@D@D@B@DBBBBBFFBD@@@D@DBB@D@D@D@D@D@BBDDBBBB@D@D@BBB@BB@BBB@BBDBBBDBF@B@D@DAD@B@DBD@DBB@B@DABADAB@DABAD@D@DADAD@BABAB@D@BBD@D@DBDBDBDDBFDD@H@DAFAFAFBDDDBB@FBBBDDDDBFDFBBDDFFDDBD@H@F@F@FBF@F@FAFCBCFCDEFADDF@DCBCBGBGBABAD@BADAD@DCFCDCDADCFCHCFAFCFAB@D@@DD@@@@BD@D@D@@@B@@BBB@@@DBDBB@B@DBB@@@BB@@DBB@D@B@B@B@@@B@@@B@@@B@@@@@BABB@@B@B@@@DB@@B@@@B@@@B@@@D@@@@@B@@@@B@@@@@@@B@@@@@@@@B@@B@@B@BB@@@@@B@@@@@@@@B@@B@@B@@@@@B@@B@@@@B@@@@@@B@@B@@@@A@@B@@BB@@@@@@@B@@@@@@@B@@@@@@B@@@@@@B@@BB@@AB@B@BB@B@@B@BA@@@@BB@@@@@B@@@@@@B@@@B@@@@BB@B@@@@@B@@@@BB@B@BBB@@@BABB@@B@BB@@@@B@@BB@@@@@BB@B@@B@@@@A@@BA@@@BB@@B@@@B@@@@B@@@B@@@BA@@B@@@@@@B@@B@@@@@@@@BA@@@@@@B@@@@@@@B@@B@@@@@@AB@@@BB@@B@@@@@B@@@@@B@B@@@B@@@@BB@@B@@BA@@B@B@@@D@@@B@@@@BB@B@D@@@@B@@@@@B@@B@@@BAB@@@B@BA@@@@@BB@@@@@B@@@@@A@@B@@B@@@@@BB@@@@A@@@@BA@@B@@BB@@@@@@@@@AB@@@B@@@@@B@@@@@@@B@@@B@@@B@@@@ABB@@@@BBBB@@@@@@@@@B@@B@@@B@B@@@BB@@@B@@B@@@@@@@@@B@@@@@@B@@B@@@B@@@@@@@@B@B@@B@@@@@@@@@@A@@@@BA@@@B@@B@@@@@AB@@@@@@@@B@@@B@@@@@@@@B@@CD@BA@BB@@@@D@@@@@@B@@@B@@@@BB@@@AB@@@@@B@@@@B@@@@@@@B@@@@@B@@@B@@@@AB@@@@@B@@@@@@B@@@@@@@@B@B@@B@@B@BB@@@@@BBB@@B@@B@@@@@@@@B@@@@@B@@@@A@@@A@@@@B@@@@B@B@@@@@@B@B@B@@@@@BBB@@@@@@@B@@BA@@B@@@@@B@@@@@@B@@@@AB@@@@A@A@@@@B@@B@@@DB@@@@B@@@@@B@A@@@@A@@@A@@B@@B@@@@B@@@B@@@@A@@@AB@@@@@@B@BB@@@BB@@@@B@@@BB@@@B@@B@@@@@@B@@A@@@@@@B@@@B@@@@@@B@@B@@B@@@@@@@BB@@@@B@@@@A@@B@@@B@@@@@BA@@@@@@@@D@@@BBB@BB@@@@B@@@DA@@@@B@@@B@@@@B@@B@@@B@@@BABA@@B@@@BA@@B@@B@@B@@BB@B@@@@@B@@@@@@B@@@@A@@@@B@@B@B@@AB@@@@@D@@BB@@@@AB@@@@@B@B@@@B@@@B@BA@@B@@@B@B@@@B@BA@@D@@@F@D@B@@@BB@@BBB@@@BAB@BA@@BA@@B@@@B@DBD@@@@@B@@@@@@@BBB@@@B@@@BA@@B@BB@@@@@@BAB@@@@@B@@BB@@@BAB@B@@A@@B@@@BB@@B@@@@A@@B@@@B@@@@B@BB@@@B@@@B@@@@@@AB@@B@@@@@D@@@@B@@@B@@@@@@A@@B@@@@@@@BB@B@@B@@@@@BA@@@@B@@@BB@@@@B@@A@@@@@@@A@@@@@@@@D@@@@@B@@@@A@@F@B@@@BA@@B@@A@@B@@@B@@@@@@A@A@@@@@@@@BABBB@B@@@@AB@@@@@@@B@@@@@B@@A@A@@B@@@BA@@@BB@@B@@@@B@@A@@@@B@@@B@@@@@@A@AB@@@@@@BB@@@@BBABA@@B@@@BABB@@@AB@@@B@@@@BB@@@@AB@@@@@B@@@BB@@B@@@B@@@BA@@BB@@BBB@@@@@@A@A@@B@@AB@B@@AB@@@@A@@@@AA@@@@@A@@@@B@D@@@@@@@@A@@@@B@@AFB@@BA@@@A@@@AA@@A@@A@BA@@B@@A@@BA@@@@B@@AB@@@B@@@B@@@@@BAB@@A@@@@@@@A@@B@@@AA@@@@A@@@@AB@@@B@B@B@B@@@B@@@BA@@BA@A@@@@B@@A@@@ADB@@@@BA@@@@@@BAB@@@@A@@@@BA@@BA@@BA@@B@@@B@@@B@BAB@B@@@@A@@@@AA@@@@@A@@B@@@@@@@BA@@@A@@BA@@BA@@@@B@@B@@B@@@B@@@B@@@@@BAB@@AB@@A@@@ABA@@@@B@@A@@@@AA@@BA@@@@B@@@@@@BB@@ADAB@@@@@BA@@@@B@@@B@B@B@@@BA@@@@@@DABA@@@@@A@@@AB@@@@@B@BA@@@@@@@A@@@@@@B@@AB@@@@@@A@@@@@A@@B@@@B@@@@AA@@@@@BC@A@@B@@A@@B@@A@@@@BA@@BAB@@A@@@A@@@@BA@@B@@@AA@@@@@AB@B@@@BA@@@A@@@@@@@ABAB@@@@A@AB@BAB@@@B@@@@@B@DD@@B@@@D@@A@@B@@AB@@A@A@A@@@A@@B@@@DB@@B@@A@@BA@AB@@@BA@@B@B@@@B@B@@AB@@@B@@@BB@@@B@BBB@@B@B@D@BBB@B@@@BBB@@@B@BAB@B@D@B@BAB@@@F@B@B@B@B@B@D@BB@@B@@B@@AB@B@@@@@B@@B@B@BBB@@@BAB@D@@@B@DCB@@@@@B@B@FBB@@@B@BA@@B@DAB@@@B@BAB@@ABA@@@@@@BAB@BB@@B@@@FABAB@BABABA@@B@B@@@B@B@BB@@BA@@B@@A@A@A@@@@@A@@B@@@BAD@@AD@B@B@B@DBB@@@BABA@A@@BA@@B@B@@BB@@BBBB@@BD@DBBAB@B@B@B@DA@@B@@A@@@A@A@@BA@@@@B@@@B@BB@@B@@@BA@@@@BCB@@AB@B@B@BAB@@AB@@AB@B@BBB@BAB@@@B@@@D@B@@AB@@ABA@@@@DAB@B@BA@@B@B@BAB@D@@@BBB@B@@@@@@A@@B@@AA@@A@@@A@@BA@@B@@@BAB@BAB@BAB@B@@A@@@@@A@A@A@@@A@@BA@@D@B@B@BBB@@BB@B@D@BB@@B@@@BBB@B@BBDBB@@BB@B@@BB@@@B@BAB@@@B@@@@@@AB@B@BAB@BAB@@@B@B@@BB@@@@BB@B@B@@@B@B@BAB@B@@@B@B@B@B@B@B@@@B@B@B@B@B@@BB@DBB@@BB@BA@@B@B@@BB@@B@@BB@@@@B@B@@@B@D@@@B@DAB@BABA@@B@@@B@@BB@BBB@@@BAB@@@B@@@B@@BDB@@@@B@@@BAB@@@B@@@B@BBB@BBB@B@@@B@BA@@B@@@B@B@@@B@B@@@BA@@@@B@DB@@DB@@B@B@BB@@BB@@B@B@BB@@B@BB@@B@@@B@B@@@B@@@B@@@B@B@@@B@@@B@B@B@B@@@B@@@B@B@BB@@@@@BBAD@B@B@B@@@F@D@F@BAD@B@B@D@DAD@B@DAD@B@D@F@D@B@B@@@B@@@BAD@B@B@DAB@D@D@B@B@DAB@B@BAB@B@@@B@B@@BB@@A@@B@DC@@@@@A@@@@@@A@@BA@A@A@A@@@AA@@@A@@@@@AB@@@BB@A@@B@@@@@@@@A@A@@A@@@@AA@@@A@@@AA@@@A@@@@BA@A@@@@A@@AA@@@A@@@@@A@@@A@A@@@AA@A@@BA@@B@B@@@BBB@@@BA@@B@@@@@@A@@@A@@A@AAA@AA@@A@@A@A@@@ABA@@@A@@@A@C@@A@@@AAA@@@@A@C@@B@@@@@@BB@@@@BB@@@@A@@@A@A@@BABA@@B@B@@@B@@@B@B@BA@@@@B@@AA@@AA@@AB@@@BA@BD@BA@@@@@AA@@A@@@AB@@@B@B@B@@@@@@@@A@C@@B@@AB@@@@@@ABA@@AA@AB@@@@AB@B@@BB@@@@@B@B@BA@@@@B@B@@@@AB@BA@@@A@@A@@@A@@@A@@AAA@@@AB@@@@@B@@@B@BBB@@A@@@@@A@AB@@@@@B@BB@@B@BAAC@ADC@CBABC@CBC@ABCBA@CBCBEDE@ABC@ABEBC@IAABC@GAE@AACACACEE@@@@AC@AAA@AACA@CACAC@EACAA@A@CAA@@CA@@CBA@@@AAA@A@@AA@CAACE@@AC@A@A@A@A@C@ABC@C@ABCBCDADADCDCDCBADABCBCBE@CACAE@AAEACACAEAACCAACAAACC@AACACAEAC@CACAA@A@@DABADABCD@FCFADCDCFADEDCBABCBCBADAFAD@FBJ@DBBBDDDBBBB@DBD@BABCDCBCDCDCBADA@A@@@@@A@@BA@AB@@A@@BA@ABA@ABABCBCBC@ABA@ABA@A@@@A@C@@BA@A@ABA@A@A@A@ABA@ABABABABA@ADC@@@A@@@A@A@@@@@A@@AA@AAAAA@CA@@AAA@AAC@A@@@A@A@AB@@CBABABA@ABABA@@BA@@B@BAB@@@B@@@@@BB@@BBB@@@BB@@@@@@B@@@@@@@B@@@@@B@BB@@@@B@@@B@@B@@B@@@@@B@@@@@@@B@@@B@@@@@B@@B@@B@B@@@@@B@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@A@@B@@@BA@@B@BA@@BABA@ABA@@@@B@@@BA@@@@BA@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@A@@@@@A@@@A@@@A@@@A@@BA@@@A@@@@@A@@@@@A@@@@@A@@B@@A@@@@@@@@BA@@@@@@@@@@BA@@@AB@@@@@BABA@@@@B@@AB@@@@AB@@@@@@@B@@@@@@@@@BA@@@@B@@@@@@@B@B@@@B@@@@AB@D@BAB@B@@@@@BA@@B@@@@@B@@A@@B@@@B@@@@@@@@@BA@@@@B@@@BABA@@B@@@@@@@@@B@@A@@@@@@@@B@@@B@B@@@B@@@B@@@@@@@@@B@@@@@@@B@@ADADABAF@HABAB@DCBADCDCDADC@CBABABA@@@A@@@@@@@A@A@A@EA@@A@@AC@A@C@@@A@@@A@@@@@ABA@ABA@@@A@@B@@A@@@@BA@@@@BA@@DCDCDABABA@@@@BA@@B@@A@@@@B@@@@A@ABADAFAFADADCDCDAFCDCFCDAFCBABC@C@ABA@ADAD@DAD@F@DCBABABC@A@AAAEACACACAC@EAGAEAEAG@C@E@CAC@C@AAAC@EBE@E@CBGBCBA@A@CBC@ABCD@HCD@FADADADCFABAFCFAHEBABEBCBE@G@ABC@A@C@A@AACCA@A@AAA@C@A@CCAAACC@A@C@AAE@C@EBCBCBABADAD@F@DBBBBBBBD@B@B@BABADCBC@CBABC@C@ABABABABA@A@AAA@ABADABADBD@B@B@B@DABCBCDADAD@D@B@B@DCBABABABADADAD@DADBD@D@B@DABA@A@AAC@CAC@A@CAABC@C@C@A@AB@BAB@D@D@BADABABABA@A@AB@D@D@D@@C@C@C@CBC@A@C@AACACBA@ABA@CBAAA@CACAC@A@CAE@EAC@CACAAAC@A@C@C@AAC@A@C@CAC@C@AAA@A@ABCBAD@FAF@FAB@DCBABC@ABE@A@ABE@CBCDCBAB@DCBCDADAFAB@DAD@FCBABABABA@C@CAC@E@A@A@E@A@C@ABABEBABABA@A@ACAA@CAEAA@CAAA@A@C@CDGBABAAACAC@CAC@CA@AAA@CBA@ABEDC@CBABA@CBCFE@A@CAAAA@AAACCEACAAACACA@AACACBCAABC@ABA@AF@F@DAF@DAB@B@DBDBD@BBD@B@BAB@BA@ADABADAB@D@DAD@D@D@DCBABABCDC@EBC@ABC@@@CB@BABA@A@A@@@ACAAAAAA@AA@ABABA@A@A@A@AAAAAAABA@A@AAA@CAA@CAA@CAA@A@A@ABABADABC@ABCBAAA@A@A@@BAD@DAHADAB@D@DADABCBABA@A@A@A@A@C@@@C@A@E@A@A@@@A@AA@@ABABADCFCBCBABADABADCDCBADABA@CBCBABCDCBABADADAF@B@D@D@B@DABEBCBCDADAF@FAF@FADADADABCDABCBABA@CBADAFAFAD@F@DADABADAD@B@D@FCD@HAF@H@FBF@FBD@D@BBFADAD@D@BAB@@CBA@CAC@A@A@A@ABABA@CBCBAFAFABABAFAFADAD@B@D@DADAFABCBCBABAB@B@D@B@D@DAD@B@D@DBBB@D@B@B@@BBDBBDBDBBBBD@DADABADEDADCBAD@BAD@DAF@BBDBDBB@B@D@DBBA@@@A@ABCBCBABABAFADAB@F@JBJDF@DBB@BABAB@@A@EBE@ABCBCBADCDAB@B@B@DBDBD@BBB@DABADG@@@EBC@ABABCDA@@BABABABAB@DBB@BBDDB@BBD@D@F@D@D@F@D@HAHAFAFAHAH@DAJBBAB@BA@ABABADAFCBADADBB@BAB@BA@A@CDADABABABCBABCDA@CBA@EACCA@C@@BADADADCBA@A@CBC@C@C@A@A@CBC@ABAB@B@DBB@BBD@DADABADADABCBA@AAAAAAC@AAC@C@C@CBCBC@C@C@C@C@C@ADADBDAF@D@DAD@BABABCBAAAAAAAAAAAAABA@CBC@C@CACAA@C@ABC@ADCBADCBCDCDCBCBCBCBCBCBE@CBEBEBC@ABCBC@A@C@AACSJSLGCICGAG@K@E@CGEEECEA[BUD_PG@CCIIE@GAKEGGAMBKDEBECUACFIAaAE@G@CDCDA@CBWBEDKLIJGDE@SAEECCA@EDCF_@I@EDCFAJEFAJC@C@EAEEEC@A@CBCBABADCBGACAAA@C@EAE@C@CE@GAA@EDKLABEDCDGDEBK@CACAAAEEAEACAAEG@CAC@G@EAACAKBA@A@@@A@AB@@AAA@@@@@A@A@A@@@@@@AA@@@CA@@A@C@AAAA@@A@@@ABA@AAA@AA@CA@AAAACAC@A@CDCBCBE@@@AB@@@@@B@D@B@BAD@D@F@D@B@B@@@BAD@@@D@BADAB@BBB@BAB@BABADADCBCDCBABCDCBABCBA@CBC@GBC@EAKAE@@AB@@AAA@@@CBAAACEAC@C@A@@@A@A@@BA@CBC@@BCBCBC@A@E@A@A@ABC@CBA@C@CAA@AAABA@A@ACC@CACAEAA@E@@@A@ABA@A@A@@ACAAAA@A@@@@AAA@@BA@A@A@A@@B@A@@A@@@A@@AA@@@AA@@AA@@A@@AAACCCAACAAAAA@@@AABA@ABADADADADA@AB@B@@@@AAA@AB@@ABA@A@@BA@@@AAA@A@@A@AAA@@@CAE@ACACCE@E@CAGEIIOCGAAAC@CCI@E@C@CBGBCACCAG@E@E@CBCDIFA@@AA@@AAADA@AA@C@@@AA@AA@@ABC@@@@A@A@A@@@@@@C@@A@@@AAA@@A@@A@CAC@@@AB@@A@A@A@@BA@@A@@@A@A@ABA@@AC@AAE@A@CAACC@@A@@@@@ABA@G@A@CAA@A@A@A@C@C@K@CB@AA@AA@CAAAAC@A@AA@CFI@A@CBA@A@@@GA@@A@@CBE@A@EAIAEACAEACCSCI@E@EDADCBGAACGGQMa_MKAAQIECGCC@GAGAEAIECACACAECCCCCGGCEKICKSaCECAM@AAAADM@EI_@ECK@EAEBI@GBGAEECMICCCECEAGEGCCECCCIKCCGAEAEAE@E@EBEDGDG@G@CBMNADCDEBEBC@EAK@i@CBKBEBE@E@EBGBI@KDEBGDIDGDCBGFCDGFABEHCDADADCDADCFADeVAH@FDH@B@BBF@DABCBA@IAEDCBABC@CBC@ADCBA@ADA@@@CAA@EDAB@B@B@@@B@@ABA@A@@@C@ABABC@A@C@CB@@ADAB@@A@C@AACBAA@A@AC@A@ABA@A@ADC@@@A@@AA@E@@@C@EBC@@BAD@D@BA@ABABEBCB@@EBABA@A@ABABA@C@AAA@C@@@AACAA@CE@@@ACAA@A@A@A@CDC@C@CBABA@CDA@A@A@@ACA@@A@A@C@C@A@ABC@K@A@@@AB@@BBABBB@@A@@BA@C@G@A@ABA@A@@@AA@CA@@AA@AA@@AAG@@BED@@A@A@A@A@A@AA@AA@@A@@A@@D@BABAB@@@BBB@F@@CBA@CB@@CAE@CBA@A@AA@@CBA@ABABEDA@@B@@A@A@@BCA@AA@C@CACAAA@@AE@CA@A@A@A@A@A@@@A@CBABEDE@CBIF@@AA@@@CAA@A@A@@A@AAAAAACA@CACAACAA@C@@@A@@@CGAAA@CA@@A@ACAA@@BABABC@@AAE@AAA@@AAACAAA@@B@B@B@@ADCB@@CBAB@JADADCB@@BB@@BDB@B@ADCB@FAB@D@D@D@@@@@BA@@BA@ADA@@@E@ECEMKGEEAEAEE@CBIAOAOCECAE@MFUDIAECCEESAAEAEAGAKIG@IDEBEHAHCJEBECCAGBCHADAB@BC@CDEDCBMBCBADG@ICGBIBEDGD@F@DEHIBC@EF@DDH@FA@A@A@A@CA@AA@@A@@AA@@AA@@@@CAGEC@CAA@CBABABABCBABAFAFA@@@A@@@AAACA@AAE@C@A@A@EBABABC@A@ABCDAB@B@FAB@BBBB@BBB@DD@@ABCBKAOBGBAFGH"],encodeOffsets:[[100408,33204]]}},{type:"Feature",id:"513336",properties:{name:"乡城县",cp:[99.798435,28.931172],childNum:1},geometry:{type:"Polygon",coordinates:["@@NIhCNEHIAO@KI_AeCSGU[[QQCCEOCSAEA@AAAAAAA@CAA@AAAC@C@AAAAACACA@CAC@CAAACAA@AAB@@CC@@A@C@A@A@ADAB@B@B@B@@@D@@@BBD@DCBCFADA@ABA@I@ABA@ABAD@BCB@@AB@B@@ABEBMHA@AB@@AB@B@D@@ABA@E@GAA@A@ABAB@BAB@@A@A@A@A@@@A@ABA@I@ABA@ABCB@@A@A@A@A@C@A@@@ABCBEDABAB@@@@BD@D@D@BCDABA@@B@@BF@B@D@FABABCBE@E@AB@@@@@@@@A@@@C@@@@@@@A@@@A@@@CA@@@@@@A@@AA@@@@@@@AA@@@@A@@@AA@@@AA@@@@@A@@AA@@@A@@@@AA@@@A@@@A@E@I@C@@@@DHLHFBBAB@@IBC@AD@BHJFD@B@D@D@BABEBA@ABABADGHCD@@A@ABEBEHADCDEDEFEFGHCDA@EBA@@@A@ABAB@D@BABC@CAIACBE@ABAACBW@A@E@C@CBABAHGJCDEDEBKDGD@D@B@BBB@@@DBDBD@DBFAFADCBABCDCFCDAFADAFAB@D@D@D@DBD@F@DBF@F@F@D@D@HAF@FADAD@B@D@DBFBFBDAB@FAFAD@DAD@F@D@D@DAF@B@FBDAFADCAAAACAC@CA@A@IAKCEGEMACCE@AAA@CCCC@CAAAEAEAA@CBCDCBC@E@C@E@CAEBC@CBEBE@C@A@CACACAC@C@C@C@CBEBAC@AAC@CBG@GAC@AACAAEECACCCACCACACAC@C@CCC@C@CBADCBEBA@CCCAC@EBCDCBCDCBCBCACCCCAC@EAEACABADC@CCCACCAEAC@@@CACAECICICICGAA@CBCDBB@BBDDD@FBBBDBF@FBF@DBDBFBDATABSLiZM@EBCACBCBIDCBGBAAC@C@E@EBEBEBC@CAC@@AGIUKSOA@CBIFIhELGJCFKDMF@DAD@B@D@DCJBHDFXNRLHHBJAXIXCTAV@PDRDHDBdEFBPF@TDLDPFJFFRNJHNJJJFFBBZ^FHJLBBBZONULWJE@QISDQLMRKJMZMTEHSPKDABKV@DHTHHNT@J@FGRKHUHODgF[TGH@FAHBL@FAFQDADABJBBLDHLV@^BLL^BF\\nDHRb@B\\VDDFBPLPTJP@LCJELANFNDPBFAFCBAD@BBD@F@FIHAF@BCFEBABABBDDFBF@DABGFEHCJCFCDKJCBABBDFJFLDDBDBFHHZAXIRCTDTRDDHPHVJ^BFAhSn@LAFCDIDAD@FAFBB@NCLHLzdHLFHJBTCPARBVHLHJLBD@BDDFDL@F@DB@DADCFADAFABDALEHEDBJDDDFJFDH@F@BADBBDDDBDB@B@F@@@BCB@FAF@FBD@F@DAHAHCFADC@@BE@WFI^WJCLCLCNAHCHGJKHaFIAA@CBEBEFEJWHQHWDCxmFAH@ZB^@^A`AXIRECI@AAA@A@A@A@ABABABCBCDERWDE@ABA@CAA@CCECEACAC@AAA@@@C@@@C@A@A@ABGBGFWBM@EDKBIBQH]BODOBM@CBO@I@E@@@AFAFCDABAD@F@HBrTHBFBdADCLWPANGDUDSFITINKFSCQr¡JQLQJMFSCU@QHKPMHI@@@@FGDQFiHWA[CIEQMWGODODMFITUBE@KBK@ILKFOGMOIIQDKHID_KKOCWEICSU@AAM@SDMLOHS@KGS@CACCCEK@@@CFSDAN@rPFBJ@FCFcAIC_OIMCKC_KGGGSBY@AeEEKQBEBGBE"],encodeOffsets:[[102388,29403]]}},{type:"Feature",id:"513337",properties:{name:"稻城县",cp:[100.298403,29.037007],childNum:1},geometry:{type:"Polygon",coordinates:["@@B_@K@IFYHQFMHOFOBKFoDMHMFGHUFMDGFMDOCKCGIGM@]@MFEFMHCBI@EEGAI@GDIDGBIBEAAA@CAECAQBKBI@OAMAIEGGCECQCICAEEGKEIKKOCECGAULEBG@GFQ`IBUEQAGBMDIFIDU\\IHSNSJYJmbULEH@BEFCFCDCFEFADCDCDABEBCDABABAH@BBB@BA@A@GAKC@@@BAB@B@@A@@B@@@B@@@B@@AB@@@B@@AB@@@B@@A@@B@B@@@@@@@D@@@B@@@B@@AB@@@BAB@B@BAB@BA@@@@B@@@@AB@@A@@@@@@@A@@B@@@B@@@BA@@@AB@B@DA@AB@D@@@@AB@@@@@@@@AB@@B@@B@@A@@@@B@@@@@B@@@@@@@B@@@B@@@B@BA@@DABCD@@@@AB@@A@@BA@A@@@A@@@@@A@@@A@@@@@AB@@@B@@A@A@@@@@@@@B@@@@A@@@@@BB@@@@@@A@@@@@@@@BA@@@A@@BA@@@@B@@@@@B@@AB@@A@AB@@@@AB@@B@@@@B@@@@@BA@@BAB@@@B@@B@@@@@B@@B@@B@@B@@@@@@@B@FAD@B@B@@B@@@@@@B@@@@@B@@@BB@A@BB@@BDB@BDBDDDDDBD@@@BABAF@BADBB@BBBBB@@D@DAD@D@DAFAD@D@B@BBDFBBB@DFDBBDBBD@DADABCDADAD@DABCBABC@@F@D@D@DCDBB@BB@B@BBB@DBDBBDBBBDB@@@DAB@DBDBBDBFBHBF@FBH@HAHBDBD@DBH@H@J@HBHBDBBBDBDDFFDDFFBB@D@BABADCDADADBB@BBBB@HBD@BDDBBDBBBBDDBBDBDBBBDB@B@B@BABCDCBCDEBCDCB@BCBAFADADC@ABC@C@E@CBA@CDABADCDCDAF@D@DDDDBFBFBD@FAFBD@BBBB@D@D@BBF@FBF@D@FBF@DBDDBBB@@@BCBABA@CBC@ABC@CAA@CACBAFABABABCBADCBABC@E@E@CBA@AB@D@D@D@BADABABA@A@C@A@CBABCBC@ABCACAA@CBCBCDC@CBA@E@A@CBA@CDABABCBA@EBE@E@EBC@EBE@EBCBCBC@A@CACAC@ABAB@D@BAD@BBDDFBFBDBDBD@BBDDFBFBB@B@DABC@CB@@@BBDBBBDDDDDBDDDBDDDBD@BABABCBCDCDCDADAFAFAF@D@BA@A@A@C@CAC@A@G@CACBADAD@BA@CBC@ECC@A@CBCFCBADCBC@CBCBCDEBE@E@EBCBABCDCBADABCDAFABBB@D@BAB@DABAD@B@D@@BDAB@D@DCBADCB@BABDBBBDFBB@B@D@BBDAD@F@DAFADADAFABCBADC@A@A@C@AAC@A@C@ADABABAB@D@B@D@B@F@B@D@B@DAB@D@BAD@B@BBBBDBB@DBD@DDBDBBBBB@B@DBDBBB@DBB@BBBBBBB@BFDTFPDDRR\\\\HVDTBfJ`@LBPGJMFgDMJAFAHAFLRFFf@BAZHTHH`LLDNDPJD`BJEdEDI@EAqOM@CBET@D@@FLDDBD@DHT@LGTKPCN@TBN@BTVJDXFPDLLC`GJCLJRPJHNEPKL@JAL@LAFSVEJCNCPHPNXFRDJB\\GXEjCREH@@@@GJONGL@RDVETINKRIRq¢DRETMLSJEJCTCVMHOBKXCDcBEAGAqSGAE@C@ABCBEDEB@B@@@F@JAP@DANCPAPG^ARAJCL@FANEXAHAH@B@B@B@D@@@D@@BB@BBDBDDFDF@DBB@DAB@BCFQXCFADADABAB@B@B@B@BBB@BDJLZJRFNDNDLJJHV@DAHCF@HBP@NAPANEN@HBJ@FAFCFAH@JDFHFLFFD@D@DCDAJEDKFABBDHFZL`JtNlNVBJDBDD@DDFBBDJHDDBBBD@@BDBDBBDD@B@@@@BD@@BB@BDDHDD@RAPCJEDG@GL[HGFENEZGJGXY\\YLMBCR]PcPeZTuxfHILGLELILS®ëJINKLCDCDC@YFMNMTKlWDADCLQJGLIXMbOLIPQTYJSBC@ABCDAJABABCAC@EBABCDABIBAHCDEBEFGDGBCBGAC@ABAFEHADALGJED@LFHDLFND\\DH@F@D@HCDABCDCFCD@RAH@BABABEBOBENSFCJEBABCAMBCDCDADAB@HA@@D@DAB@DA@C@CACAEBABABADADAD@FBF@B@BCB@JMDELMBCDCDCFADADCBCDI@I@GBADCBA@@AAACA@CAE@MFKDWBOIWGSKGEEIE]@CAACAE@E@G@MCI@CC@A@C@CDCBCCSCCECAC@CBCDADC@E@AB@DAAIAELMHCBIBGD@HADAHEBCGQ@CBMBSLUNQHQDSBGDQ@GBCPKJAHELCHEBCBC@I@@A@A@EFCAA@AAAICMACBEBEDGFKBCFADADA@@BAAAAACA@A@E@IBEDMBEAOWBQBMFCBC@CAAAGE@ECAC@E@A@QGMKMIIGKQGGCAGBOJC@CAKCM@QECAAE@G@CFCLALBJ@DC`YRELGFG@]DG@SEO@OEG@MFiFKJM@SGGKIKIIUCI@AAO@MAIO]EIEECAcMCACCIKOKMGGOCSGSO@MCGEGEAMJGDIBGC]A]AIGSKMKGKKEMM]AGDSBCHAPALGHGHMBIGUGKKKIIIEKEGEGEOWA[EYCSBOJKPYBGDW"],encodeOffsets:[[102793,28849]]}},{type:"Feature",id:"513338",properties:{name:"得荣县",cp:[99.286335,28.713036],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@A@AAE@A@@@@@AB@@C@A@@@ABE@C@CB@@A@@AC@@@@BA@@@@@AAA@@@CBA@ADAB@BE@@@A@A@ABAB@@ABADE@@@CA@BA@A@@B@DEB@BA@AB@@A@A@@BA@A@C@AA@ACA@ABCBA@CDCBADAB@D@D@D@BABCDABCDCBCBABADABABABABABA@ABA@A@A@A@A@EACAA@CB@BABAB@D@DA@ABABA@CDED@@@F@B@DABABEFEBCDA@C@ABCB@BAFAD@B@BA@AB@@A@A@@@A@AAACAAC@C@@BAB@DBD@BABA@A@CBEBCBCBCDAB@B@DBD@F@DAF@FADABCDCFCB@@@B@BB@@BBDAB@BCBA@CAAACACAC@CBC@CDAB@D@DBDBBBBBBB@B@BB@BBF@FBF@H@BAFABADADABEFABC@GBGBEBEBIBA@ABAB@@ABCDCBCDCDA@EDC@CBCBAB@BABAD@F@JABABCFABAB@D@H@FBF@BABABA@E@A@CBG@ABABCBAFAFCBCBCBG@CB@B@B@FBFBDADABABCDGDA@ADABABADCDAFCDADCBCBCBABABAD@BBDBHAD@DAB@BCD@BCBCBCDAB@B@BBBBBDBBBBBDBD@DBD@DBB@DBBD@BB@BD@B@DABAFAD@D@D@D@D@F@D@F@HAD@B@DDBBD@@BD@DBDBD@LBBDD@D@DADAF@D@DADABCDCDCBADA@@BABBDBD@B@BCF@D@F@BADCD@DCH@BADAB@B@BHF@D@BADADE@@BAB@D@D@D@BBBDBBBBBBDBB@D@DBDBBBDBBBDBD@BBDABABCBABGBE@CBC@A@ABABA@@D@B@BB@BBFDDDBBBD@D@BADADABCBCBEB@@CBC@@BAB@B@BBB@BDBBBBDBB@DABADC@GBGBGDCBAD@DADBDBFBH@B@BADABEFCBAD@B@D@B@BBBBF@B@D@B@BADCDCFEDCDABEDAD@BAB@B@BBBDBBDBDBBDF@D@BABAD@DAB@D@BBB@BBBBDBB@F@DAD@BCBAFADCFEFADABABABCBAFCH@BABAB@B@B@D@BBDABBBBFBF@D@B@BABADCHCHAFAB@DAD@BAD@DAD@FADADABDHBB@@@B@D@FAD@F@DBB@JABABAB@DBBBFBFBHBD@F@DDF@BBBBDBDBBBD@D@D@D@DADAD@B@BB@FBDBDBBBBBBB@BBF@BDD@F@F@BBDBD@D@DBF@B@BAD@D@BABAB@DADAD@B@BBD@DBD@D@@BB@B@BBBBDBB@BBBABADCDABA@ABAB@D@BBD@D@DBBBD@BBB@B@B@B@DCD@BABAB@@@B@D@D@DAF@B@B@BAD@B@@@B@DDFDLBF@F@H@DABAFAFADABEDAD@B@B@D@D@B@D@F@D@D@LALADAHL@D@P@VEVE`MNCHARBJCJGPEL@H@PFN@FGHKHEHBDDBHFnD\\B\\BVDFDDXDH@LDLDDFBHAJELALBN@JBTDHJ@^IFBDDDVBFDFFFJLLDVDPFL@DAJMHEDAFBDBBABCRCBE@EAKBG@EHG\\ShEPCVGLGHQ@E@IMSGGGS@CLUBALCTOFGNSNYLINQRKTCRJF@XIVKPMAYAAIKEGY]AAEEIIMIIGQMEEEICOCK@SOEEAcFCACGCQ@OBUDSJWBWAIGGQKWMCEAGDI@C@C@ABC@CNELCDEHIFKJgJEDAB@TPVLHJ@BD@DBD@FAFAFAF@D@D@BBHADAJCDADADBFAN@jYTKBABSACAEAC@CAE@EAEACAA@ECCAC@AAA@@EBAEAACAA@CCAC@EACAEAEACACCACACCCEC@ACCAEEAA@CBADABAFADADEBABC@C@C@C@AACACAC@C@ABE@E@C@CAAAG@CAC@A@CBADE@ACCCACACA@AAAIGIAE@E@CBGFCBEBE@OEAC@EBQAEEEWIOMMKCC@CBCBCAABC@CBAACCEAA@EBE@A@CACC@ABEBA@CAEBCACAC@CBADCDABCAC@EBE@E@EACAAAACCACACBC@ABE@CCA@CCAA@CBCBI@C@CCCMKAA@AJGDGBA@A@AAAA@AAC@AA@A@C@A@CAO@E@@BCDAPGHGBA@ABABC@@BA@A@A@ABC@@@A@@@A@@@@@AB@@@B@@@@A@@@@@A@CBE@@BA@@@A@@@AB@@AB@@@BA@@@@B@BA@@B@@@@AB@@@@@B@@AAAAAA@EAA@CAC@CAA@CCCAGAAA@EBOPYBCDCB@H@J@J@@AJKBO@A@CGKKIGCACAEAYCGQIEC@AAEBCBA@@@K@G@@BSCKC@CCGAECCAAA@ABCFEHOFIBAFAD@DCBABEBA@C@ABCBC@C@C@EBC@A@C@C@E@A@AAAA@@@A@A@ABA@AAA@CC@@A@AA@@@@AABA@A@@@@AC@@CIAA@A@@AAAA@A@@AA@A@@AA@A@ACEAA@C@C@CAA@CAC@@@AAC@@AACK@AACAE@C@C"],encodeOffsets:[[101773,28886]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("凉山彝族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"513426",properties:{name:"会东县",cp:[102.57796,26.634669],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@C@AAKAGAEAC@CCCACACAGEEEEMCCACCAACC@GAOAC@CACACAAA@CBAACACOKEGIM@CAA@C@AAABA@C@I@C@IACAGCECCEAAAEAGAEB@@ABAD@BA@C@C@MICAEAC@CAC@CAC@GBIDCBAB@BAF@DADCFADEDCBKDIDIDEDEDIFABCBAB@FABA@O@E@E@GFCB@B@B@@BDBDBFDBBD@D@D@@BD@LBFBHBFDDDDNJPPHFDBBBDD@D@DCPAFADCLAFAFADCDCD@@A@CDCBA@CBK@C@EBEDMBGBKHMFGFEDEDEBC@KAG@IAECCCAAAC@OAIACACCCCAGJGBU@]KWAKDEH@NLL@H@BQPCJ@D@DDB@D@B@DADEJABEBIDEDG@E@CBKBC@K@YGE@CB@DBFLBPDFDJD\\HDBBH@ZBLADMNEJAJDFLDBBFBHJDDFFBBF@DBL@D@@D@@ABABAD@DCFIHAJ@FC@OBC@IBIAE@CBC@KBAE@A@@CGKAK@IBCDBDJLBBABOFUJOHGFCJ@`A@GBEBKDOFEDA@C@A@CBEAA@A@@@A@CDA@AB@@AB@F@F@H@L@J@H@H@B@FEHEFCD@@AJ@B@FFBJFBDBB@DABADCBGFAB@DDDBBBDHBBBD@F@HBD@B@JF@DB@FBNBTBHDDFDFL@X@FH@J@JENAJBJHFDBD@HBF@D@DCF@FADAD@B@RHF@RBJCBEBAJCJMHAJBJDDHDL@DABGBWKABAD@DDFBFB@FJH@DBF@FAF@BDBBDF@DBFFDFB@@HJDBBAJ[DEDE@ADBBB@DBB@FBBB@DBDAD@FBBBBD@FBFBF@D@BCBA@CAA@CBCBCDCB@@AB@B@BDLBFBD@@BFD@@AVBJ@H@JG@QDAFAJBDDDL@@@DBB@BDB@DB@@DBB@DBBDDBFB@BD@B@DBBBBBBBB@BBB@B@BB@AB@BD@H@HB@HAHEDE@E@ABBDBBHJDH@J@DADCBAB@DBBFHB@D@D@B@FDBDBFBBHBFBFFDBBDBDBBBDBB@D@B@@@B@F@BAB@D@BCFAD@B@B@B@@BDBFFHDH@DBDBDFAB@FCBADIFSDCH@HAFBDDBJFHDDFFJDJBJBJFLFLPDZ@DBDDFPBLNBDHTHTBBPHPDLAD@DCBGBIBMBM@E@CHIBKBIHIJGHEJENCFAFALGLGLGLCJCLBHDJHJHHFHDrLJDB@FD@BDDDDFD@@DBDBD@FBB@B@DBFB@@DBD@PDBA@CBCBCB@@ABA@@HEBA@A@A@@BABABABABABADCBABABCBCDE@ABCDCBCBABA@@DAHADABBD@@@B@B@BADADCDABCBC@A@A@CAA@AAAAA@C@@BABCBCBC@ABABADADCBADC@ABC@A@A@A@A@A@A@@@A@A@A@@@AA@@A@C@@@C@CDCBCBCDCDAFCBA@A@CCCAACAAA@@A@AA@@@A@@@ABA@A@@BA@AB@BCBA@CBC@CBABABC@A@A@@BA@A@A@A@CBABCD@DAB@BABA@A@A@ABA@@AA@A@@@AA@AAAAA@A@A@@A@ABC@ADGBAB@FCBADA@A@@BABA@A@ABC@C@A@A@CA@AAAAA@@A@@@A@ABA@A@A@@@AAA@A@AAA@@@ABA@C@@@AAC@A@@@ABA@@@AB@@@BAB@B@DA@@B@@AB@BC@@FABADADCFCDC@C@A@C@AAC@C@A@CBA@C@AACCCAA@ABCBC@E@A@ADCBA@C@A@CAC@CAA@ABC@CBA@C@GBC@C@AACAA@@GECAC@AA@@@A@C@ABCDCBABCBABABABABC@CBABA@C@CBE@C@CBABCBC@@BABCB@BAF@DBDBD@D@D@BABABCBA@CBC@C@AAAAC@CBC@CBE@ABG@CACAEACAA@CACA@CAE@CAAEAE@@@E@C@ACCEECGCECCCAA@A@A@CACACAC@C@C@E@CBG@CBC@G@EACAA@@@@@A@A@@@A@@@@@@A@A@A@A@ABC@EDEFKDI@ABG@GAE@@AGCECGAIAC@C@G@GAGAG@G@GBCBCBC@CFW@C@ABEBCBCBC@CCAEEACACAG@E@A@A@CEIEC@@CCCAECE@AAE@M@M@C@E@EBE@EAE@CACAEAC@A@@@AACAAAA@ABABGHABA@C@C@C@A@C@A@C@AAEAC@E@CAA@C@CDCFCF@B@DFL@D@BABCBCBADCFA@A@A@@CCECICACGACACAGAE@ACCAACCKGCCAA@AAA@CACAACAGEGCC@AAAAAAACAA@CA@CACAE@C@K@@@EBC@CAEACAC@C@"],encodeOffsets:[[105271,26935]]}},{type:"Feature",id:"513401",properties:{name:"西昌市",cp:[102.264449,27.894504],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@B@@BA@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@B@@AA@@@@@@@@@E@@@@BA@@@"],["@@OGAGCMEMEMGEKGQCQ@UBMDQDQLMHOHWJODQAG@SGGCHTHPGLOLCPTNJV@jAJ@JBHNAJFF@@ADCH@B@@BBB@BBDBHDBBFBBBD@BBD@JBB@D@D@B@J@F@F@BB@BBH@PABABABCBABCFCFCDE@WFC@CBIDAACCKIEECCGAKFGDGDKFKBOFMBOBEAGCKAG@IH@BANM\\AL@J@FENININEN@TELW@QFGB@PLPNZIRKJ[FkHINDVLJPJHHJFNXDNADIRMFWF]NGLCNADDTHNNNTJPNBP@FMJG@_Ia@kH_JABAFDLARALEFADADELAD@FHNTZNVDPFXArDDBD\\NFEHGFIHMLQNQLIPKLETIP@R@NFLHNLLLHPJNH^FTHTHNRLN@LGPO\\GXAPADGJUHIDCLCHCHAHBF@JBJDL@LBJBLBH@HBLBJ@FAHAFCDADGFGFEJCD@HBHBDFBBDHDDFHBJFLDLFLDJHLDBDDHFHDH@JALEJCF@JBJBNBRJJFDIHOFGDM@KDKAGAE@EAMOOKMEOAY@I@K@M@IFIBAFEBEBEBO@QDIBEBIEKEECCACBC@AFCJCDA@EDGFM@KBE@CFMJOJGL@VjNRHHFFHDHFNHHHHJDDPRJLREBCBACQI[EKBMBODSBQCQCM@@@ACM@IBMAMAGAEAI@A@AFKFCBABAB@RM@@B@JEPAL@@@NDNDHDJ@H@J@HADAjQAEAE@GACECQCAAAEFKPGPAHELIDC@E@C@CBEDGBE@AAAC@GBEDG@G@CEAE@EDCDE@A@CA@GAE@@AAEBEFCFG@@BIBOBWDEJCLAH@TA@EASAWAIBIBIBKDMCCAGACGEOCMAMCGCEICKCKGKEOCGAGCK@ACQCQGKEIEIEMAKAS@MBK@GDKE@GDK@IDOBIBG@GAGC@AECCECGCIEIGESGSBG@QASCU@QAQ@C@OAKDGDEDCBCBIH@TCLCLGAG@OCMGKCIAMJIFID"]],encodeOffsets:[[[104525,27459]],[[104589,28241]]]}},{type:"Feature",id:"513424",properties:{name:"德昌县",cp:[102.17567,27.402839],childNum:1},geometry:{type:"Polygon",coordinates:["@@EDABG@E@CACAECAA@@CCA@A@A@KGGCEAACEK@@G@C@A@CB@B@D@BAH@B@B@DADAB@BCFAB@B@BADBDBH@DABCDAH@B@HAJAFAFGBMBG@A@C@@@AAA@A@GBE@E@C@AACAA@C@A@OBCB@A@ACKAGACCCAAA@@@C@CACBABEFCDEBA@EBG@EBEFELELADEBIBGAI@G@ABC@CDCDCJALADGPCF@^AFCDABCBCBC@KAGAAAACAECCA@C@GDE@SEAC@ACEEKCAECIAAAGEEEEAOCC@ACCGAMAIEKEEEIACAECEEGEGAAGEGCCAAEAKACOKGCC@CBMXCLADGDKFC@A@ECEAI@@@ALAFADAF@BCDABCDEBKDMFC@A@A@CCMKGCAAACCACAC@AAECEAEAA@C@@BAHCJ@H@DAHIPAFCF@B@@BBBBDBF@BABBDBDBBD@BADADGRCDKLCDAB@BADBD@DAFAD@BCBCDC@CDCBCDCH@D@F@FAD@D@DDBFD@BDD@BABABQFCD@@BBBBFBBB@FBN@@FDDB@D@DAFMJGFABAFBFAFAFADABDHFNBNNNNJTLFDPLFNHTJRJJHPFFHRFXHZCVAPBPDFFN@FALEN@N@FALDPDNDRDNDVDRJVFVDTBVJ^BDHDTHH@RBPCXIPGNGRKRCNCVAR@RDLHHFFNFNDNBHPHJCJENIJBLDNHPDH@HBDKDK@SJGDADAFCHCLCPBD@R@RBV@TDRBH@TATHHFFJDJDHDFFD@BHDHBH@JAPAJCL@HCF@HQHOJQFQDEDIDM@MAOAMCOESBKFKLSNQDEDIHOLKLKFKDIDI@@DIDEJCHBJBB@NALIJIPOFQDKDK@OAQ@G@ECEIESAKBGCCKLKJGJINGFEDEAIEIGIEMCM@Q@EBGDWF]JSLIFCHCBO@OBI@GBG@IGCKBWHOFOBKDIAGCCG@OAKDYFUCGSMSOGEIKIMIOGIAAEGAKEAIAKCC@"],encodeOffsets:[[104813,27735]]}},{type:"Feature",id:"513425",properties:{name:"会理县",cp:[102.244683,26.655026],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@AAC@E@C@E@C@C@AACAAE@EAA@CAACCAC@A@CoEI@GBGDIBGDKDIFcXEHIHGBA@A@A@@@A@@@AA@@C@@AA@A@@@@@@@A@@AA@C@@AA@A@A@A@@A@@A@@@@@@@@@@@A@@@@B@@@@@@A@@@@@@@@@@@A@@@@@@A@@@@B@@@AA@@@@@@@@@@@@@@A@@@@@@@A@@@A@@@A@A@@@AAA@@@A@A@@AA@C@@@A@@@@@AA@@A@@@A@@@@@AB@@@@A@AB@@@AA@@@@@@@ABA@@@A@UIMCODILABQNGFADKFKDK@OEKGGCGIGIEIMUAAY_EECCKEIAcE@@ADAD@DADBFDBDFDDBDBDAFAF@BADBDBD@F@BCL@DBDBHBD@D@DADABCDGHADADBFBDDDHJDFBD@FBBBD@D@F@DADGLAB@F@DBBBBJFDDHDDD\\RB@HHB@BBD@HDDBRFFBLBHBF@BBDBBD@L@D@BBD@B@BADABKNABADAB@F@DBH@BBDBB@D@DAB@B@B@B@DHJDDDBFDBDBDBDBD@BBFBBBDDB@BBF@B@F@DAD@B@D@BBBBD@B@D@F@D@BBJBHBBHL@BB@BB@BBDBF@DBB@D@B@DAP@DAD@H@J@DADABCBABAD@F@FDJBDDDBB@BBP@VBLFPBJCJEDEBCBVpFLDDDBLBFBDDFBDBD@F@DADBHBF@DABABCDEHKBCFCDGBI@CD@DAPAHAJCFABABCD@BBFDFLBFAHALBHBJDL@H@JDHDBFDFJBN@BHFHJFJ@FADADEDEBCBAD@F@F@FBFAD@BCDCDEFAFAJBJFTBT@LAH@RADCJGJ@BG@[@CBAF@PAPCJCBABEB@D@B@D@D@D@BA@A@A@A@A@A@I@C@A@CB@@CD@@@B@@@BB@@@BBBB@B@BB@DD@BB@@B@BB@@@B@B@@@B@@@BD@F@BAB@@A@A@ABED@@@B@@DBB@DF@@@B@@A@@@@B@@DD@D@F@D@B@FBB@B@@C@@@@B@@@@BB@@BB@B@B@@@@ABABAD@DDD@BDD@D@DB@B@@@@A@AB@@@HBBDDHBF@DADAD@DBB@BB@D@F@DAFBJ@DALCFA@@D@BBB@@BAD@B@BDFFNBF@FBB@BDBBBD@DADAFCBABGBADCBADC@CBE@EACCCA@@CAO@E@CCCACAABCBADADGDGDC@C@AD@D@B@DADCBA@A@C@EAGACEC@A@ABADAD@D@D@@AFBB@BBB@B@BABA@AB@FADADA@ADGFEBAD@F@D@FAD@D@B@@A@AAC@@@AB@D@PBPFB@BBBFBFAXGZBDBD@@DBRDNBFBFFBD@FAF@B@BBB@BB@H@HDFBFBHJDFBD@DADEHCF@DBB@D@FB@@BBBHABBB@@HBBBBDBFDDFFHDB@BIHAB@DBBDBBBABAD@B@BB@BD@B@BA@CBIBA@AB@B@B@BABEBCDEDAB@B@BBFBFBBD@D@F@DBDBDD@B@D@@ADEBGFOJCF@B@BBFDFB@B@B@D@D@B@D@B@BABCB@B@@@DBBB@D@BAH@BBBB@B@NEPEHCB@BBBB@@@DCD@FAF@DGNCDGHABAD@B@DDJBD@@BBDDFB@BBD@LAJ@H@FBDBFBDBH@DADCHCNAHEF@B@@@B@DBDDFBBBBBBB@F@BAD@D@B@@BBBB@B@BB@BBB@@ABAB@B@D@BBBBBDDBDBF@FAFCFGHKHEDAB@BBBDDFFDF@B@B@BEBCDA@@B@BDBBBDBBBBJHPHP@DAHAL@FCFENEVEL@D@FDDFDTNHDBDBBBD@HAL@B@D@NBFHFBBFHBDAFEH@@EHDDBDBHDL@B@BDAPAB@D@B@DBBBD@F@F@HAB@B@BB@@D@B@H@NAHABEBEBI@G@ABGDCBA@CAGACBC@A@ABADE@ABABC@C@A@ABG@A@C@ADAB@D@H@@@FLBDFBHDLHB@B@B@DD@@BBFDDBDBF@H@BAFCD@LDJBFBBLFHBBHJJPJNJLHFTPTNDHEVCZBL@PDHHDJBLCPAPEXGLAHDLAPINODSFQDERGLCPAFKBKAIKKMKGMCIAIBQJODCJCREFANCFKDGFSHQDUBS@KCKGICSBKBGNKNENADABE@C@EAK@M@OBEL[H[DKHQLQHIJCHCDADCBK@KDGHCNGHAZAACAC@CCGEGAEAC@@@A@A@ABCDE@A@CBA@A@E@A@@@A@CAAACAAACACCAEEEAGAAAAEACECA@C@C@A@EGAA@CBADABC@C@ICGGIAAACBAF@F@FCBG@GGAG@C@@ABAA@@A@AAA@AAAAAAAAA@C@AACA@AECCAA@CAA@CA@@CCA@AAA@C@@CKCCIAEBCB@RIHG@I@UA@BC@AE@@ACAECK@A@ABA@@DADCDADAB@DBB@DA@A@CAEAE@EACAAEAC@CBCAA@AA@EAA@CAACA@BCFCFI\\ABCAGI@@EAECAE@CCEAAACE@EBE@CAG@EIA@AECE@CBCBAXLHABA@CCKCGICIAGBINIDABAFIDQAE@QGA@C@CBEBE@CDC@E@GAC@CAGEAIBIFM@I@IEGW@K@CECEGCSAMAEAA@@CIEA@C@GAE@C@AAGAACAACC@CBAHEDABCBA@CAAACIEEA@E@ABI@@DCFEFG@E@A@G@G@I@K@G@E@EBA@@BAB@DCB@@@B@B@FBDAB@D@B@FCPELCFAHAB@@_DIHEPGVIPEBAAAIKACDCJAL@LBDH@@@BBFLAD@DAF@JBJAD@PAD@@EBIJGDE@CBCBABA@@@CC@K@CAE@AAEECCGIEAAAKCCEBIFINMBCAK@YAGCA[GICECOCKAAE@CDAF@ZHL@D@LADAF@H@FCJCFABAFIBC@C@A@CCA@C@CDIRO@A@GKK@MFGLCXB^LV@HAHI@@EAGCKCEAIAKCA@A@AAC@CACAE@E@ABC@AACACACCCAOEEAECA@C@AAEACAAAEAEAGCEACAEAA@AACAC@EAE@E@@@I@E@KBC@CAG@EACAC@I@C@AAGCIIAA@CAECEACCEAA@ACAAAEECCACEGCECAACGIAACCCCCACAC@A@CACAECECA@A@A@CACAGACAGCA@AAAC@AAAA@C@G@G@CAEAGEIAEAA@E@I@G@G@E@EBE@CAC@CAAACACAEAAAACAC@I@I@GAEAEACCCCA@@AA@@@@CCACAAKGCCGACAOIQMECEAE@A@CBCAEAAECCEAECCCCECCCEAEACCEIMCECCAA@AAC@CAAACAAAAA@EBC@CBCAC@CCAAAEAC@EAAAA@@AA@@ACBC@E@A@A"],["@@EM@CASAW@@@@@@@M@@@@@@Ce@E@AAQCEGEK@CBA@GBEHCLC@DABBRF^J@DAFADAD@FDLFFBBBB@F@"],["@@CCCA@@CACBADCD@D@B@DBBDBB@D@DADC@ABA@C@A"]],encodeOffsets:[[[104583,26714]],[[104394,27349]],[[104613,27496]]]}},{type:"Feature",id:"513422",properties:{name:"木里藏族自治县",cp:[101.280205,27.928835],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAWeMOUCKGSMICGDGHKL@LANKR_bBBFBDFBJ@H@DADEBGBW@KBUbKHWCGBEDGFKHIJM@MIEAEDCFGHIJGHEHOPUHONCBOPSLYN[@M@CBKLGFOJCBMP_PG@IDKDEDMHCDAFCLKFKFILKFEAECABCDA@A@A@CA@@AACAEC@@E@ABABEJEJCBABBBDDBDHBBDBD@BABCDADCFCHABA@C@E@CAIEEAC@ABABCHEFABE@C@C@CCAA@CCI@CAAAAGC@AAA@C@A@K@@@AAACG@@@A@E@CACCE@AA@AC@@K@IDMCKEGAE@M@GFAFBNCFIDEBOHCA@EBKAEAEBKAQCKGEIG@@A@AA@@AAA@A@AAAA@@A@AAA@@AA@@@CA@@AA@BA@ABA@AB@@ABA@A@ABA@@@ABA@@@ABA@@@ABA@A@ABA@@BA@@BA@@BAB@B@BBB@@BD@BBBBBBB@BBB@D@B@B@DA@@B@BA@AB@@ABA@ABA@@BA@@BA@AB@@AB@B@BAB@B@B@B@B@BA@@B@B@B@@@BAB@@AB@@@@ABA@AB@@A@ABA@@@A@ABA@@@A@@B@@@BBB@B@@@B@B@B@B@@@B@B@@ABAAA@@@@AA@@AA@AAA@A@AAA@@@ABA@CA@@A@A@A@C@AAA@AA@@AAAA@AAA@AAAAA@@C@A@A@A@AB@BCBA@ABC@A@A@A@A@A@A@@BC@A@AA@@AA@A@A@A@A@@@A@AAAACAACAAAA@AA@@AA@AAA@AAAAA@AAA@A@A@AACAA@AAAAAAAAAACAA@AAAAAA@@CAC@A@ABA@@@ABC@@@C@A@C@A@AAA@AAA@@AAAAA@CAAAAAA@CAAACAA@AAAA@AA@@CAA@@AA@AAAA@@AC@A@CAA@A@A@AACAAAAA@AAA@@AA@AAAC@AAA@AAC@AAAAA@AAAAA@AAAAAA@AAA@A@@@AAA@@@AAA@@AAAAA@AAAAAA@@A@@@A@ABAB@BAB@BAB@BAD@BABABAB@BADCBE@C@A@CAACA@AAA@A@C@A@ABABCBABCBA@@B@BABAJ@D@DABAD@BAF@D@B@DA@@B@BA@A@AAA@@@ECCEACEDEDCBEFCFEBCDGDIBE@E@IBGDGBGBGBGAC@IAAAAABABAB@@A@A@A@AAA@C@@@A@@AA@A@AAACA@@A@A@AA@CA@AAA@A@@@ACA@A@A@AAA@AA@A@AACACAA@A@A@A@@@CAABC@E@ADA@@ACAA@C@@AAAA@CA@AA@AAE@A@AAAAA@ACCCCCCCCCAAA@A@A@AACAAEEA@CAAA@@CAACA@A@AAAAA@@A@A@AB@@A@ABA@A@CAA@AACAE@A@A@GAAA@@@@@EBIBEDCBE@ECE@CAACAABE@AAACCCA@C@CAEBEACACCCCECCCACAC@@CC@CAEBE@E@E@EDABADCDAD@DADCDCBGA@@BDBFDDDFDF@F@FAHAHADCFAFBD@FBFBHBF@HADADGBEBGDCDEFADAFBF@FDFBDFDHFDBDFDDFBFDBD@DABCFAD@DBFEDABBDDBDFDJ@DCDC@EBEFCDEBCAEAECEAC@GDEDEBE@EAECEACAEDGDEBG@K@E@C@EDGFEFC@CBG@CAG@EBEFEFEBCB@@CF@BCBE@CBCFEFE@I@CAEACB@H@FBHFFFDFDBFDFHJFFFFDDDBB@@BBBABABADABAB@B@D@B@BA@A@@B@B@BABADAB@@ABA@A@A@@BC@CBABADAB@@AB@BAB@@@@A@@@A@@@AB@BA@A@ADCDCB@@AB@@@BABABCBAB@@AB@@ABAB@B@@A@@BA@@@AD@@AB@@CD@@@D@@@BABAB@@ABA@@@A@@@A@A@@@A@ABAB@BA@@@@@AD@@A@AB@@@B@BABAB@BA@AB@@@BAD@@ADA@@BABAB@BAB@@A@@BA@AD@BA@ABAB@@A@@@A@A@@@AB@@@BA@@@CBA@@@A@A@@@@@A@@B@@A@@@A@@@A@@BA@AB@@A@A@A@@@@@AB@@A@@@@@A@@@A@CB@@A@ABA@@@AB@@@@@@A@@@@BA@@@@@A@@A@@@BC@AB@@@@@B@@@@ABAB@@@BA@@B@@A@@@@@@B@@@BA@@BABA@ADA@@B@B@B@@AB@@@BA@@@BB@B@@AB@BA@AB@@@B@BABAB@B@@@@@@A@AB@@@@@B@B@@@@@BB@@@@BA@@@AB@@@@@@@B@@AB@B@@BB@@@@@@AB@@@@@@A@@@BB@@AB@@@B@B@@AB@@@@A@@@@@@B@@@@@@A@@@@BB@A@@B@@BB@@A@@B@@B@@B@@@@@@ABAB@@@B@@@@AB@BA@LDHBB@B@@AAA@ABGBABADCFABADCDCBCFEDEDCDEFE@AFGVKnaZITITMJGV[JCJENCHARBVFJAR_HEH@FAVKHBFDPDLLFJHLFFDBDJDRDFHHJFNBPBJ@LARADBBF@DBBFBJAHAJCHCJ@HBFFJ@DANGFENE^@N@JHDHDLCPENCHENGVEHGNCNEpALEPGPENGREZ@J@LA`CXAHOZILAPDTFZB\\PXHFHFLFJFJJLLHLHVAJGNGHKHOBGBADCTBHN^FNLLLHLNHTBJB^D^AHCJIHBNHFHFNDP@HTDTHPNHPLJLDDDBdNDBFFFJP^BJ@NBP@BDJJVLJLJHH@TINELEj@NFH@PFP@TCH@^EHKHQF_ZCDI@KAKBED@D@HBFDBRFN@LDDBD@PIHADBHHLRJHNJNLRHB@F@D@DB@FHFBBDBD@DANERAXABPAFCNAF@J@F@BDBBBBBAB@@CBCBEBADELCHAFAFBDDNBJBBB@DBFEB@B@@@@JADADGFKDGFIBOLAD@HCRAHCTGRMRKVATAN@DHRADGFCBGBC@AHAJGDKNBFBJXBRHFBXGHGPCR@\\JZFLBLFBBBBDD`zFDB@HDBFFDNHJF@BAHMR@BBBF@R@JAHCFAFAFCFEHMBABABCBANCL@B@JHJHLFFBD@H@B@F@FCDADADAD@B@DBBBBFBB@BZBHA\\ANIRYDEJCR@JDDDFBX^LNPN@DANYtCBK@[FS@IAS@QDIHCLDd@DFFVPPJPFRBZ@\\HVPVNRR`TFBPDTEZKHKDGDUBGAEAOAKLCNIBGGaGKBIH@JBRJZNb`DDHDPFN@HBBDAD@D@BDBDBJ@FBHDDFHH@NBNBBBBFBBEDIDCFAbILEJGHMJMHKJGDEDQAKAOCY@OBGBEDEHGZOHEBCBE@I@O@EBAFCJEHCBEBG@ECIIIEGACBMFUHUJcDGBEDCDAD@H@PBDADADCBCBE@IIs@E@CBCBADAJ@FA@A@C@CGMGKAG@KCGIKEWCKAIDEDOAKCM@C@CHI@ECOAIBEDGT[HKHCLELC@C@CKS@CDOAMAIBEFIFMFI@I@G@CKI@I@YAMGIACBAH@XL`PHBF@H@`@ZCP@PDZLH@H@FCHCHCNARALDJDH@J@FCDAPYLKBE@GAGEMBAbBVFVNTJXBPEHIBKBGJEPDNDZDPARGFIHMXWBCBEBKDAHCH@TDNFFFFBJDFABABEFGJMNCP@HOPUJQNOVMPCFABCEM@A@CBCDCBC@A@ACACAAC@C@EBAFEFABEAE@AHIDA@A@AC@GAIAEAIEOEIGICQCQBM@aNIDO@GHMH]FLADADEDWBG@IAMBKBE@ECE@CB@DEFGLCHABEACEGIKKaqaUACDGFGDAbVTHPLZHHHB@PM@CAE@CBANE@IACACBCFEDIAMDSHQFGAGHSF@F@F@DCDABEAI@GDCBE@I@EHODUAiHmFEJAnEF@XHPDRFbNJFFDLJ\\TNPVN\\NJFFALYDKJAP@lCD@SMMIAACK@CBI@AAEACACAC@CBCDG@@BEBCACCCCGAABEBG@C@GAKAC@E@ABGFSBE@A@A@AACACCICC@A@MAg@OCQEMEMCCCCKSCECMCGCCCACAOGIAEEUOAA@CAM@CA@CAA@WBC@IAICEEIK@AEQGOUK@@@@QKECACBGDE@EAKIMCACCAACBkKC]DKDSHIAIEGCCDC\\QDGBGACA@WMIEKEGEEKCGGA_CcGGCACHUBIBMCGKAEEQ@IBIBEAMOGEICGBEDC@AABEBGDEBKBGDCB@DDDBHBFAJCRGHCHEFKFGBEDEBOBO@KAIA]HQNSJEBAACAA@IAGCEEEKCEAAAEE@@OQCKCAG@GACBIDC@ECCECAEACBEF@FCJQREBENCFEBC@EAOMIIGIEQCOAUIGUIUSOIEEGEMCQDKDQDIB"],encodeOffsets:[[103688,28381]]}},{type:"Feature",id:"513429",properties:{name:"布拖县",cp:[102.812061,27.706061],childNum:1},geometry:{type:"Polygon",coordinates:["@@AACAAAAAAAAC@A@C@A@CBC@CBA@CBC@CAA@EAC@CAA@C@E@A@@AA@@A@CAKAE@E@I@I@UBKDIBGBG@E@MBA@IDKDMBMDG@MBK@K@MAMAKAIBMFKHIHFJFNDN@H@DCNGLMJMRCRDLDPB\\ERAVDPBDDFJRHFNDR@JCPCJDDFDN@NBLAL@LEJCLGVAFAJ@L@BAREPOJKHAJAD@ZDX@L@JANCNKJIHEHBPFPBFBBBLDJCJCJGLIJEP@LDFDF@BDDBBLJPPFN@D@TAH@JAJAL@N@BCHAFCLGRAHCLBT@F@NE`ALAR@THVJVJALAhKLAN@RBJDLBNAJAPELCJENGLCJBLAFDHDLDDBPLLF^NFDBD@DCBCBEFCBADBDBB@D@DAB@B@BDBB@BB@BAD@D@BCBAB@B@BFDBB@BBBA@ABGB@BABAB@@BDBBAD@DBDDF@D@DCFADBF@@@FAB@HFHJFTNNHPFNBRCD@HCD@FCNELELEDADCFGHE@@FCFKDEBC@C@A@C@CAGAA@C@A@ABCDKFK@@D@BAB@BABA@C@@AC@C@AB@FCFABCBA@ABE@C@C@C@ABADC@CBA@C@ECKACAEAIAC@ABA@A@CBA@@BAB@B@FAD@B@BAFAPOJGDCLGFEDCBCDGBCBEBCBAB@BAD@FAD@BBB@@BBHDLBDB@BBDBD@FABAB@@CBA@CBKDSBM@IBG@CBM@C@AAAACKOAAEGEICCEE@AAC@@@A@ABCBGBC@C@A@AEUEQ@EAE@A@CBCDI@AFOBGBC@C@AAC@CCC@@IIIIGGGGCAACAA@A@C@A@ABAFEJKFGBADCLMBC@@@C@@@AAAAAECGEECAC@CAC@I@KAI@AAAA@KGCECCGOAEACKIIGAGEUAECEGGGEKICAAECO@GAAAECCCAC@C@EBC@G@KBE@EACAAAAAAE@EBG@Q@AB@@ABC@ADEBE@A@A@@AACC@@A@A@C@A@AACA@@ACCEAACGCMCKEIGGGIAEAGAGEEACAECEAACEEMAGAGGOEQACAECCACAACC@AAACA"],encodeOffsets:[[105359,27959]]}},{type:"Feature",id:"513435",properties:{name:"甘洛县",cp:[102.771504,28.959157],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@SFIJIFkNOJUBI@KBGDGDKJIJIHEDGDIBSBIEIEQEQ@QASDGDKJGHCH@LBLBJ@LCJCNABCL@RBNDLHJDJFHBD@FBJ@LAF@DEHELCNM\\ABKTGVIT@F@DBFBJHTBBCDA@uUECAAGQCECCIAIAIAE@AAAACEMBCBCDCD@DAB[L[XKBQ@SEMAGAKGIEIAQAWAMBIFGDIDEDCBCBGDCDCBBHBDBFDFFHHJFLP\\DNALINGLCJEPCLAJBHDJDLJHLHNBRVTnFHFDF@JADDFHAH@HIRCJALBHDFB@DFDHKRGNNfD@HCDBHFDDF@RBHDDFBF@HDFDBDBBFAFDJAFEBEBCDABJHHFDBJBJ@R@J@NAB@JRBD@BGDEFEL@LBHDFHHFFLFHBJDHDH@NDHBFCJIJMDKDAF@BC@CEICKBEDCDAFCHAF@FCFAHGJAHBNHFBDBJ@DA@@hKHENEJCB@HBJDDDPDH@JCFAFBDDHL@BFNCHGJKJCJ@D@JDJHTHLDBFDBDDBBF@FAFAF@DJFL@HDPLFBL@B@BARIF@FADADA@ABC@C@CAG@E@A@CD[BEBADAL@BAZ@J@L@TBLDLD@@HHDFFDFBFDDF@H@FAVJlRZ@@LJDP@BFLFF@@@@DBJDD@D@DBB@RAB@B@B@F@@AB@F@DAD@DC@@B@BAHC@@BAB@@AFABAB@B@@@B@@AB@@@B@@@B@B@B@DAB@B@@AB@@@B@D@DA@AFBF@FBNBDABABAJCHADA@EAECMCGAACEAE@GDECAEA{AUDQJI@ECAGLKBIBSHG@GAE@@GGCAekGEEMCMBOAOGKEM@CCICMAMBMDOHKJKLIPQFIHINOFICEIIKIKGGCKEKAOEKCMIEECGCGBSBIFOHMHMFMFKJKHSFMHMBKFMBKCIIIKGGICIAKDKFOFIBABEDMDKAIIYEMGSEMCS@ABMFMBGBEBEFIDGLGDMCKCOCO@IFKBGDEBEFKJMFIBKDIDGFMAC@A@AD@BAB@B@DABA@I@A@A@EACECEAE@ABA@C@@@QACAAACACEEAGAICKAAAI@G@KJI@KASCGCGEIMAEKHGDCF"],["@@HBHDH@HDJ@HAHAAECCEKOOSLEFBHEF"]],encodeOffsets:[[[105331,29336]],[[105091,29925]]]}},{type:"Feature",id:"513431",properties:{name:"昭觉县",cp:[102.840264,28.015333],childNum:1},geometry:{type:"Polygon",coordinates:["@@ECKBIAKDMHIFKDOFIBMBKAICQAM@KBgLKBIBWBI@OBCB_LEDCDE@CAAAEAGAGOGMCEQCAAOC@C@ELQBiGEGGEAI@WCGBGLGDMAMACCM@KBEBGBMLIPEJCDEDIDG@OAG@ODGFGFI@CCEEGGEEEAIAMDIBSBKDE@G@AAEAGCEKEIAKCIGIEAS@I@KCSBG@KBIDCFAXAPAJ@@EHEDAFBF@BF@HBB@@D@BCFCD@FBFDFH@H@FCHAD@BB@BAFCHAF@D@D@FCDKJGFOBOHELBFBBRDFDBD@HBFBFFFJHJ@H@FBHLDBDABAFCF@BBDJDHBLBFJJLHLHLHNFFDFHLHJHJHDFFNDJLJFBB@VIB@BBDDLLDDFFHBJBB@L@LCRCJ@HBJFBB@FBFBDBBDBD@D@DADAD@B@@B@HDLHPHJDPBHDJFNDJDHDLCR@PDHBJBBRJBBBLDDBBD@NAHDFBFPBBDDF@PFHFFHFHBDHPFHJFLFLFHJHNBHNn@DDVABAHGNEHGFAH@HBJDNFNFTDH@BBDHTDLAHADCN@FCNCLABAJBFDFFDHDDDB@FHBFBHDVHBNDLFHDHBLDPBRAFAJCBEAE@CFQDEBADI@EDAP@L@L@DBHDFBHFPHNBNAJCLANAJ@HBPFB@HDTJTHLa@IBI@EBC@@BKBMAMGWAECEGKEK@CAI@E@EAI@E@CBIDKDGDIHODKLKDCDCDADAJALETELBP@BBNDJDJDBBHDNFNDDBB@@AB@BA@@BCBEFMBEDCFGBABC@ABA@A@I@G@A@C@C@ABEBKBEBC@@@ABADCBC@@@AB@@AAA@AA@AAAA@AA@@A@A@@@ADIBCBEBEDCBCB@BAD@B@DAD@FADC@@BA@A@CAEGKAGAAAC@C@IBCBABCBADCBCD@DCFCFADAFAF@H@H@DBD@D@@@B@BABADEBCBCDADCBCBA@@@CACACBA@AD@B@D@DBDAB@BCDADIBCDCB@@BBB@BBDDDDDDBJDFDDBDFBDB@DDB@LDH@LBDBDDDDFDHJLHHH@@HLJHDBFBJ@J@J@D@@@BBABA@GDG@G@AB@B@@@BBB@@BBBB@@@BAB@BA@BB@BDDBB@B@BABABCDGDAB@@AB@@@BB@@@@@B@D@DBDAF@BAB@B@@@DBDBB@D@B@D@BBDBHHBB@@@BBBDBDBB@B@DAB@@@B@BBDDDBBBB@DBFBB@B@BA@ABCDEBC@ABA@A@AAAAA@C@@BA@AD@B@DADABAB@BA@A@ABADABAB@BA@@DCFE@@FEHCBABA@AB@@C@ABA@CAI@ABA@A@ABABABAHAHCFAFABCDADCFEBCB@@AB@F@D@L@L@J@HAF@DABABCBC@C@E@E@@@CDEFIDGBCBAB@B@BA@@A@CEKCEAA@C@CBE@A@AACAGIS[CEAAGKCEISEMCMAMAICGAAEIIECACEEECCCEKSQFIBM@I@MDEBIFKNKPIJEHCDABMFO@QBMBOBKAIE[CG@MAMCEACCG@OAGEKMAEBI@GCKCMBCP_@ADEDGJMBOAGAEEMEKACAMGGKKSOAC@CJIBC@CEIACAAMCGCCA@AAA@K@MAIBI@QBC@QEBC@ABA@ABADAFADCHADCDEFKHCDIHOPEBABA@C@EBA@A@AB@@AB@D@BAB@BBDBJBFBDDL@F@DAB@DCDAB@B@D@D@DAF@BABADEBEDA@@B@DBD@@@DABABA@ABC@@@ELCLAD@B@B@DBBBH@D@D@B@DADCFELED@@GFEHCDCBKFKFMFEDC@GDC@QDMAOEMGSMIEEG@GBA@E@@AEBCDE@C@CCEAC@CBCAAAC@@BABA@AHABAB@AA@AAAEC@A@ABADA@A@CBC@AAAA@CA@A@ABA@C@CAAACBCDAFEDADA@CACEC]MKEOKCAKCGC"],encodeOffsets:[[105316,28474]]}},{type:"Feature",id:"513433",properties:{name:"冕宁县",cp:[102.17701,28.549656],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@A@A@AB@B@B@B@B@@@B@@B@B@@@B@B@D@@@B@BB@BDBBBDBB@B@B@@@@@@ABC@E@AACA@@AA@A@AAC@C@AAC@CB@@"],["@@GOKKMKKGMEQ@O@SJKFOLKJMRKRGNEJGHEF[MACmBQB_DUDQJKPBPBZGVIHGVFPF^C^ENIDI@O@UFQJONKLIHGHUDBDBL@H@DAHAFBBDHDDBDADAF@@CHAD@DBDBDBDBF@BAJ@DDLBBNJTNDB@@@@@@BBJBFBD@@B@@@@BBB@@BBB@@B@@@@@@@@@@@HF@@B@@BB@@@@@DFDDDFFBFBLDHDFDBDDFFNDNHHHNBDBJADAR@FBDBFFHLRBDFFBDDH@@@@@BBJDZHVDJBDENAHBHBF@H@LAJAH@HBFDDTNPJFJFDNJFBDBFFFDFFFFbRZVDBhNJDLHJFJDLBHCPGHALAFBBBDDBBB@DBNB@B@BBPDJBHHJDJJHHDDRP`NDFFHFVTHNDDPJNF`HHBFBHFDFFF@FGLIJEDEDGFMT@FAV@NEJGH@HALBT@JKFMJKLKHEDAFDLTXFFHHLNFNALARBJBHDFNJDJNNLFF@FAFCHBJDfPFD@F@L@HJbDHLJJJPLHDNFJDPJNLJJRVTXJDPNNPJFVFF@HAHCHCFCHCDA@COS@CJGHKP]LOJMNKLKFGJMFOLSDODIJWFKHIHCDAP@HFX@PBPCJCJILGLGLQJIJIJGLILANANBbBRCT@NCNALCJCD@FCAII]@M@YBQ@O@OAKAOAIGIMKGEIIKGIIIEKKGIAGAEAKDKDK@MEOKYAGAGAMCO@K@ACKCKGMGMGOEOCSAQBQDOBGBEBSESAKEGAEDG@EAEEAGCKEMAKEKCACEEIIGEECEEAGAGACAM@GAEAOCI@MAUEK@AIEGIGIEICKCKCGAICGAGAEAEBCDCHEFCFCLGDCFCLK@@BABADCFCHEJGFEDGFGBIBE@A@CDIBGBEDIHKFIHKBABC@@DG@I@GAGAICUA@@KAG@EAICSBO@GACAAEGGCICIEGAEBGBGFEJGDGHEFIFGDKDQBEAM@IBKBMBKDMBGCCIACAG@CAGAE@I@IBI@EBEAKIEQIMAIAIAE@IDKFIBG@GCGECCCAGKCIEKCKEKAIEGCCCGAACEGAGAC@IDEFEHCHCBEDGBEBI@KAGAG@KAIAKAK@ICIAE@GAGBGDKDCDGJIVCHOBWB[HOPKHM@QKGMGSESG]IM"]],encodeOffsets:[[[104741,29036]],[[104359,28790]]]}},{type:"Feature",id:"513427",properties:{name:"宁南县",cp:[102.751745,27.061189],childNum:1},geometry:{type:"Polygon",coordinates:["@@GEIGIGGCKAIDKDKHKHKHEBEBMDIFGFIHGJAJALGJ@D@FANANAJAHCDC@KBOCOGAAGSGSACKMOACEAC@CCYKOKEIEIAIAICEECCEGAICCEAGBG@CDETCJABEDA@EBYBGBMHGDCH@LALCDCBGDIDGJKRGRCLG\\K\\AF@P@NBL@F@DAFCBMBMFMLAHALDTHJDL@LATCVGRETCHELMDEBQFIDCDIPARBJDJHNNLLLBJALELOBKDQHCFERCTMPOJKB@JAH@HAJ@PAP@XHJ@FJJNHNFNDLHJJHJJLJHHFFFF@FBL@L@D@JBNDNHLDPDTBH@H@NBNCJGFEBCFCFHBJBFBFHHLFLBVLVEJBLIJEN@LBL@JCNEJGLCFAFAJGLGNEJALBNBNBL@L@NAH@NCNALCJCB@NAF@H@HAJALCVAJ@J@F@F@LBDBB@@@BB@@BE@E@ABCBC@CBA@ABA@C@ADABADADCDABADCD@BC@CBCBC@C@CBC@E@C@C@CAEBE@C@A@C@C@C@AAAACAA@ABA@CBC@CBCBC@@BCBABC@AAA@C@AACAAAC@CBCBCBC@CBC@ABA@CBABC@ABAAA@ABEBC@C@E@C@A@@AC@CAC@EAE@@AI@C@CACACAC@CACACACAAAC@@AEAAAC@E@C@CAC@CBE@A@CAAACAAACCAAACAAA@AAAA@@AAAAC@AAC@CAC@A@CACAC@EACACAACECA@CAAAAAC@A@A@ABCDADCDEFCFCFCDCFEBCDE@C@C@C@A@CAA@C@C@EBC@C@ABCAC@C@EAA@AAC@C@CAC@AAA@ACA@A@C@E@C@@BEAA@CBA@ABCBADC@ADC@A@@@A@CAE@A@ABA@CDC@A@@@AAC@A@A@C@ABCBABCFAB@BAB@BADABAB@@AB@@A@A@CB@@A@CBABCBABABCBCBCOCC@CA@@EACAA@A@EAC@CACA@@ECCCCC@AECA@ICqKGC"],encodeOffsets:[[105317,27502]]}},{type:"Feature",id:"513423",properties:{name:"盐源县",cp:[101.509188,27.422645],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABABCBABABA@ABABA@A@@@ABA@A@A@ABAAABC@A@A@A@A@A@CB@@AAA@C@A@A@A@A@A@A@A@AAA@A@A@A@A@A@A@@@A@A@A@@@A@A@A@@AABA@@@A@ABA@A@@B@BABA@@BABA@ABAB@BAD@B@BAB@B@B@D@BAF@B@B@B@DAB@B@B@BAD@B@B@D@D@D@BAF@@@D@D@B@D@B@B@B@BAB@@@B@D@BAB@BABAB@BADABAB@@ABCBABA@ABA@AB@@AB@B@@AB@@@BAB@B@BAB@B@@AD@@@B@B@BABA@@@AACBABA@AB@@A@A@ABC@C@A@CBA@ABAB@@ABA@@@@D@@AB@@@B@@AB@@A@@@A@@BABAB@@@@A@@@@@@@@@@B@@@BA@@@A@@@A@@@A@@@A@@B@B@@@@@@@BA@@@@B@@@BA@@A@@A@@@@@@@ABA@@@AB@@@B@B@@@@@@AB@@A@@@@@@BA@@@@@@@@@@@A@@@@@@@A@@@A@@B@@@@A@@@@@A@@@@BA@@BAB@@A@@B@@@B@@@@@@@B@@@BA@@@@@@B@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@B@@A@@B@@@@@B@@@@AB@@A@@B@@@B@@BF@@@B@BBB@B@B@B@B@B@B@@BB@B@B@BBB@@@D@B@D@B@B@BBB@@BB@BB@BBB@B@@BBBB@BB@BBB@BBB@BDB@B@B@B@B@B@@BB@@DB@@B@@BB@BBB@@@BBBBB@B@BB@@BBB@@@JHHFDLBRALBFBFAL@FDBPGFAJCDEAMBEHEN@F@HBLFNDJCL@@@BDB@@BDFBD@D@F@B@@DHBB@B@@@L@B@DBB@BHDBBBB@DDJ@DBBDDD@D@F@BAFEDGBABAD@FBJFDBF@D@B@BADGDEBCDCBA@AACACGAACCCAABADAFIFIBABAF@@@FDDBBB@@DBB@B@B@DCBAFDFBLEJKLELEDKBEDCNGFCLCJCH@`ONODAPIHELKDAN@\\@ZMTKPODAPMVGPOFGHGJIHGDEFCFBNJN@JILGHEFCHAXDLGVaLAX@HAFABC@C@GAICEEAAA`aLQBM@KLKHGHCJDTNLHVDNPXfDBJARCLCRCNDHFFFPJVTVJJHBVDPFRHJJJPNFBD@FADEFMFARQDI@EFEDAFBDBDFFDD@JCDAHBH@DBDLPR@@FFBBFBLDFFDFBH@JBBBDABIFMTGRB^BJ@LAPAPCFAFEHELGFGDQHIDEBGACACCA@CDAHALCFAHAFBBD@FCHAJDHFNPFBJAJAR@FFLBDHANAJGVBDHDdH`DHBDHFLHFLFJFXNB@BDAHCH[RCDDDFHBJGJCTCLD^lLDABBDDDBJNBL@FCFAHBDFDRL@@@@VLHPFR@BJLFFJDJBD@XAB@DBB@@DBN@DBBVPFFJBPHDBDBDDDHDNDFLTDDDDFNFNDR@PBh@N@BDDDJBDBD@B@B@BAFETAH@B@FVCHGJGLKPMRIVEP@J@JCFMD]E]EOHUJGHUAYAOLORIVC`CRAnACCBqEWCOMUSYGM@EBCFKBCBCFEBKBQCKBEBA`IlGb@`JH@NI@EAOOMSIMMGMCSBCDMHK^MXENEJQBCCMMWIEGGOIKICUJMlG\\ELIJQMYKO@OHAREX@FK@SFMJMJMFM@E@IBKN[BM@AJGH@LBHDFBPANAPELALEHCHCLEHBDDFFLJDDBBJCDAD@XEF@DCDEDEBADABABABA@OAG@AAAE@E@I@A@C@C@A@IAC@AAC@AAEAAAGCCAAAA@AA@@@ADGBC@@EEBIMAG@IBI@iIUSMDOPKHKGOGSACI]AUCSEUIUCQCUCMCQCMCOBK@E@MFMBK@EEMCEAOBODUGYEWGQEEGOIIIQGSEMOKECSKMIMMAMEMCGMKEEAE@IBSAECIGGCCACBCFODICQAECQICGAE@EEAA@CDEDEBEDADAN@BA@AEYCK@G@KAOCCAAK@gEQCECMOKOEI[ZMHSJCB_NODG@CACAKGACEAEBE@EDEFA@C@CAA@CBEBE@A@ECEAC@CBKDKHABAFCDCDCBCDEFCDAB@FAFAFILEHINGHIXCJEN@DCDEDEB@B@BBBBDDFBBBF@B@DAB@B@F@DADADCHADCB@B@BFDFFFFBDBB@F@DBDBFFFBJDNDJBF@FAFCJAF@FBDABALABCBABA@A@GCECA@@@AB@B@B@@BB@BBF@DABA@ABIBGBA@@BA@BLBJ@B@BABC@CBMBAAA@CCCAACC@C@G@EBCBEBC@A@CAICICCACAAAAA@ABG@A@AAAA@ECCAAAACAGCCOQAECGAAAAICCA@ACCACAAAECCCACAG@EAA@A@GGCEAA@AAC@C@ABA@A@ACA@AAGAGEQCE@E@A@I@A@@CAMAO@E@EBCBEDCDCDCBC@C@A@A@C@EDC@A@EA[OsUKCAA@@@C@EDGDEDEBE@@BC@C@GKQACAACACA@AACBE@IAE@ACCACEEEAIEAACACCCE@BC@ABABCBADAD@@@@AD@B@B@BEDADCBIHGFEDCBCFCFEHCDA@AB@@AACACAAAKAC@G@EAAB@@A@EDEDABCB@BAFAD@BABABEDABA@C@@@CACCAAA@A@A@A@C@AAGCCAC@@@A@CBCBCDADCDA@A@A@A@AACAEECEA@A@@@EBE@I@AAA@AAAACCIKA@CAA@GACAI@C@C@IBABCDCDA@A@A@@A@AAAA@CC@@CAC@@@EDCFCBCBA@CACAAACEEECEMGCACAAAA@C@A@A@E@@@@@@B@@@@@B@@@B@@@@@B@@@@@@@B@@@@@@BB@@@@B@@B@@@@@BA@@BB@@@@@@B@@@@@@A@@B@@@@@@AB@@@B@@A@@@@@@B@@@@@@@B@B@@@@@BB@BB@@@@@B@@@@@@@BA@@B@@@@@B@B@@BBA@@BB@@@@BB@@B@@@B@B@B@B@@@@ABA@@@@BA@@@@B@@@@B@@@@@@B@@@@A@@@@AAB@@@@A@@@@@AA@@@@@@AAA@@@@@AB@@@@@@AA@@@@A@@BC@@@A@@@AA@@C@A@@@A@A@A@A@@@A@@B@@A@@@@@@B@@@B@@A@@@@@A@A@@@@@@@A@@BAB@@@@A@@@@@ABA@@@A@A@@@A@@@@@C@@@A@@B@@A@@@@@@@A@@A@@@@A@@@@@@@A@@@A@AB@@@@A@@@@@AB@@A@@@A@@B@@A@@@@BA@@@@@@BA@@@@@A@@@@@A@@@@@@@AA@@@@@@@@A@@@@@@@A@@@@A@@A@@@@@A@@@A@@@@@@AA@@@@@A@@@@@A@@@@@A@@@A@@@@@A@@@@@@@A@@@@@A@@@@@ABA@A@@@@@@@@AAAAA@@@@AA@@A@@@AA@@A@@@A@@@@@A@@@A@@@@@@@@@@@@@@AA@@@@@@@@A@@@@A@@@@@@@@BA@@@@AA@@@@@A@@A@@@@A@@@A@@@@@A@@A@@@@@@@AA@@@A@@A@@A@@A@@A@@@A@@@AAA@@@@@@@ABA@AAA@@BA@A@A@@@AB@@A@@@AB@@A@A@AB@@A@A@A@A@A@AB@@A@@BA@@@AB@@@@AB@B@@@BB@@B@@@B@B@@@B@B@@@B@@@B@@AB@@@BAB@@A@A@ABA@@@A@CBCBADCDABAHABCP@BBBDBFB@BAF@F@@ADKRGNEDCDAF@D@DDLDF@JAPAFEH@@A@ABABABA@@BABA@AB@@ABA@@BA@AB@@A@ABABA@@@AB@@@B@@@BB@@B@BBB@B@@BB@B@@BB@BB@@B@@BB@@BB@@BB@BBB@@BB@@B@BB@B@BB@AB@B@@ABA@@BA@@B@B@B@@@BBB@@BB@B@B@@@B@@AB@@A@@BA@A@A@A@@BA@A@AAA@C@A@A@A@ABEBABABABADAB@B@B@B@B@B@B@B@D@B@BAB@B@BA@AD@BAB@BAB@@ABA@ABA@ABA@ABABABAB@B@BABABAB@BAB@BAB@B@DAB@BAB@BA@AB@@ABAA@BC@ABA@CB@BA@AB@BAD@B@B@B@B@B@B@BA@AD@BABABAB@BADABABABAB@@AB@B@D@B@B@BBBBB@BB@BB@BBBB@@B@B@BADAB@DABABABABAB@DCD@@AB@B@@@@@B@@AB@@@@@B@@A@A@@BA@A@A@@BAB@@AD@@@BA@@BCB@@@@@B@@@B@@@@A@@@A@@@@@A@AB@@A@@@A@@@C@A@@@A@AB@@A@@@A@C@A@CAA@AAA@@AAA@AAA@AAAAA@@AA@@@@BA@@AA@@@@CAAAAA@@C@@@@@AA@AAAA@@@A@A@@@BBA@B@BPDTANADABAFBBFFHL@FFLBXANAD@BAB@BAB@BA@ABCBABAB@BABABCFAFAB@BCDAD@BAB@BABCBCBA@BF@JA@@B@@CHEJKPINGNGDG@KDAHBHD`@DAD@@GBGA@AAGAAAACACA@AQAEBAB@@BBBFBDFJBBB@@@@BB@@@B@B@@@@B@@@@@@BB@@@B@@@B@@@B@@@@@B@@@B@@@@B@@B@@@@@BB@@@BB@@B@B@@@BB@@B@@BB@@@BBB@B@@@@BB@@@@BB@@B@@@@@@AB@@@@AB@@@@A@@B@@@@A@@BA@A@@@A@@@@@A@@AA@@@@@A@A@A@@B@@@@@@A@@@A@A@@@AB@@A@@@@B@@@@A@@@AB@@@@@@A@@@@B@@@@AB@B@B@@@B@B@@@B@@@BB@@DB@@@BB@@B@@BB@BB@@@@B@@@@BB@@B@@B@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@B@@B@@@@@@@BB@@A@@@@BB@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@B@@@@@@BB@@@@@A@B@@@@@@@BB@@@@@B@@@@@B@@A@@@@@@@@B@@ABAB"],encodeOffsets:[[103266,28340]]}},{type:"Feature",id:"513432",properties:{name:"喜德县",cp:[102.412518,28.306726],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@KLEDCDKHEDEDGFCDADBFBFBHDHBJDHDLDLFJHJHJJF@BHCBAFABABADACACEAC@ABC@A@ABABCBC@A@ABA@ADA@CAA@A@CB@CAC@@A@CA@@ABC@@@C@@@A@AAA@C@@@AAA@AA@@AAA@A@A@AAA@@BA@A@A@CAA@A@@@A@@@AAC@@@@A@A@@@@A@@@A@A@A@ABAB@@A@A@@@A@A@A@@@C@@ABAB@@"],["@@BV@NDJBPBF@HBNBDBHBHFFFDHFJJFFBDLDLFNBLFHDFBFAFEFCFAHAHCJANFLBLBJCJEHIBEBMBIFKJEFGLGLIHIHMHIBCDEDGBKDGDCHIDCDEDCDCJCJAPAJALCJEJIJGHCNAD@F@NDPFPBP@R@J@PCNCHEFCBIBKDIFGBAFCH@NDB@JBLDNDN@HBFBPBJCJEDCDIDIJGDEDEHCNAHAHCJELEN@PCNACUAGAEEGA@CCGCECCEAEBIBADKDM@EDMBCBGCKGSAC@ACGESEMCMAI@GBGHEFGHMBGBACU@CMmAGGMGIKEKEIEEGGOACEGEGGEOEE@CCAAEOEAGCMBC@AACCAKAAQIAAAICG@ODQCKCGCIEMCIAGCOGIGOCK@G@AA@C@CBCBC@C@CAAAACAE@EAAIEGAI@QDKDK@A@IAGAEECCKKCCAAA@UJA@EAKICIEMCEIGIGKGEGECMEKGKGKGIIAEAKCGCIAAE@EDABCBCAGKEAG@I@IGEEiRCBGBI@G@I@GCMCMC@@K@OBIFA@@@QNA@ABABEDEL@B@BBJBFBHBNAN@JDN@B@@DNDRARCTAPANFLJ\\DRABADQFIKOQCCGIGGMGGEGCEEGGMQUiK@IHIPEN@DAF@LENCH@FCBIDED@BADBDDDFFFLAJAFCJ@RAPAFAFEFABEJ@J@N@L@JBZFPLNPPBN@FBFBHCL@LCNEHGPCJBLAF@FAJ@J@JBFBH@DBHBDDJHDNALCNALAJAN@FBRALCHCJEFEHGHCFIHEHAFAHBJFJDHDFHBBBD@HAPDTBJ@FBH@LB@DVBJBH@H@JCH@@ADABGLEJGLCJAFAHCJ@D@BAFAJEHCHEFIHGFEDCD@@@D@@@B@B@B@@@B@BB@@@DAD@BBD@D@BBB@B@@BB@BD@B@FAD@B@@@@A@A@A@CAAACA@AAAA@@@C@A@A@@@BD@B@@@B@@@BBB@D@B@BAB@@BB@B@B@BBB@BB@@BBB@B@@@DBB@B@B@@@D@@AD@BB@@D@BD@DBA@@D@BBB@DCB@BAB@B@BADADAB@B@BAD@BBDDFDBCBABABEBABGDFL"]],encodeOffsets:[[[104738,29031]],[[104739,29116]]]}},{type:"Feature",id:"513434",properties:{name:"越西县",cp:[102.50768,28.639801],childNum:1},geometry:{type:"Polygon",coordinates:["@@I@MBKBIDMBMAOGGEEAGCCAK@K@O@CB@FCJABCFER@DBFAFIDEBQBOAKCGAGCKEMCGAMBODM@KFIFGDGBMBGDCFCFIHCJCJCDIFIDOAEAGAM@MCKCIAA@MCG@EDABEHCJALAJEDGFMDODI@Q@O@OAOEMCE@C@MBGDIHIJIFKDIBOBIBIDCDCDCFCDGJCDCHALCHCFADGJGNGJKJKHEHIFELAJANAFGJIFIDKAKAMEIBGDGBEBEDEFEBBF@FCHBFFHBLFTATAFAHCPARBRDTFPHPHNHNDLDL@B@LDPBNBHBHLZFP@NCLCLBLBFBHHJLLJFJJLHJJHFNLHJBJBPBL@P@PAR@Z@NJ^BJHDDFBHDHBFFHFJJDHDDFBBDFDADCHCDADAFCJCHCJENAXBRBJBJFLHHBNBTFR@LA\\W\\KBA@CDCDCDANADFBBBBF@JBJBJBDDDFHRBBFDvVB@DCAAGSAIAE@C@EJSHULSBAN[DMFKFG@CBE@KAI@EACEGCIGICKAM@QDKBADMDI@KAIAK@KDGHGLIHCTCRBR@RFJFJFTAJAHCFCJGJILIHCHCLAJ@VAPIlMJEJITEDEHCLGPWBMDE@CGI@GBG@EDCHEJKJGFGAI@GCKCMAMGUAKAUBc@]BG@AAECI@AACCCGAMMAA@EBEBALKHIBEBI@@@CDQAWBkBSDSBQCS@IAKDGBIFISGSIGCA@OEGA"],encodeOffsets:[[105328,29001]]}},{type:"Feature",id:"513437",properties:{name:"雷波县",cp:[103.571696,28.262682],childNum:1},geometry:{type:"Polygon",coordinates:["@@BEDGBCBC@CAWAI@@BGFGFIDGDEDEDCHIHGDGBCFKBIDM@C@A@IACCGAI@CCCAG@EDEBEJKNOFEDEFM@ABEBC@C@CAC@ACECECCCACACAKCGECA@@KIA@AAEAKAC@GBA@AAACGKEGKKAAAAEAKAA@ECCAAAICGCEACGACCIGKECAAAAA@A@C@E@A@C@G@GBIBA@EBC@GACACAIGACCMCQCEAEACAEAAECCAA@A@CAC@EBC@CBA@EHEFCHAFBHBH@H@FCFADED@@IDEBEBGDGFEDGDMHMNKFGBC@A@A@GAOKGGAA@E@AAAAAECMESEA@GA@@IAUCIAECCCAAACAC@E@KACCEGKGKCKAACCGCGEACAGAEEEEEKGKGMGAAGEACA@CAAE@CAAAAA@CCA@A@E@ECC@KEAAECEGEECCAAAACAE@CBE@EBE@A@AAA@AAAAAE@C@E@CBABADEHCDA@A@A@C@AAACGACAG@@AACAIKIAIECCACAA@KCG@C@ABE@C@CAGBE@@BEFGHGFCDEFEFAD@FEFAD@DBBBBBDBB@BHBBFDB@F@FDB@F@D@D@DADA@ABADEDADE@G@CAMCE@A@CBE@EBC@ABCBGBC@@@C@CDCBADC@CBCBCDABABADABABABABAD@B@D@D@D@B@B@D@B@B@B@DAD@BBD@B@D@BBBBBB@BBBBB@@BBB@BDDBBBB@@BDB@@B@DBB@B@B@B@@AB@B@@@BA@@@A@A@A@A@A@A@C@@@A@A@A@@@A@A@AAA@AAAACAAAAA@AA@@AAA@A@A@A@@@A@A@A@@@C@A@A@AA@@A@A@@@A@A@@AA@AAA@@AAAAAAA@AA@AAAA@@AAAAA@AA@@AA@@A@@A@@AA@@@@@AAA@@@AAA@A@@AA@AAA@AAAA@@@AAAAAA@@CA@@AAA@AAAA@@AA@AAA@@ACAAA@@AAC@AA@@AAA@CBI@CAC@A@O@E@EAICEDG@I@CBCBEBC@AAA@A@A@CBACAA@AACC@@EKCGGAE@I@@@E@GAQWGAECICCACBEBCDEFANAL@F@FEFGHIDGHADCHEHGHCDMNONKLCDGDIACBG@G@IBIAIAY@KCG@I@ODGBE@OBC@OIEAGAAA@AAGACGAEAICKEG@GHEHEJABCBKACAEACBADCBAH@JBHJVLTDFDDFFDFDBJFFJBBDHBJBNDNFNJTDFHLBBDFT\\HJDBBBB@F@DAD@B@FBLDDFB@@@ABA@A@ABADCHEJCF@D@@@F@F@DADADABCBE@GBI@K@K@BHBJBHCBIJAPANALAH@FAHBHBBFFJFHF@BDJDJBHAH@BAJGJKHKHIBMHgTSLMHKHOJEBCBA@IDA@ABCBAB@BADCD@BABADEHABADEFEJAB@@EDEDEBAB@@AB@BEDABA@@B@@@BFBXRFBTFB@HBH@HBTBDBDBHFRJJHJHDHBF@J@JCHENAHENERAFAHCN@BA@@@@D@@@@@@@@@@@FCNAL@FDNBBDDBDBBDD@B@FAP@FBB@DBBBB@B@BABCBCBAF@@@D@J@@@FBBBDBBBBDBF@DBD@BBDBBBD@BBDBBB@BBDAH@BCFAD@DABBDCFAB@D@BA@ABEBEDCFCF@BCH@B@D@BDFDFBDDFBBBD@D@FA@@B@XDJ@JCH@FAJ@D@D@BBDBBBDBB@B@F@DBD@F@BBD@DBDBD@BB@@BB@BAF@J@D@LCHCNEHAB@B@BAJKFEDAFGBC@A@C@CBE@@@@BC@CDEBCBCDABCBG@A@A@CBADC@@DCBC@@BA@AB@LSFIBGFKBGHIBAB@BAH@DABADADBF@D@BABCBGFCFADC@EDCBAFALCHBJAFDDFRJLJJNFDHFHBD@HDHFDLDJFNDNAD@FAJDFBFGPOFIHIH@FBHDHDJFJPJLDJDLFPBNDJ@LBLDHBFHFDTHJLFDDDJJT`FNALCLDFL@X@JAHCHCDBD@DB@@HBTHLBJ@LCJGBA@CBABCBAHEDADA@@@@BB@@D@BBB@@@@@@@B@@B@@@@B@@@@@B@@@@BB@@@B@B@D@B@B@BBBB@@@@B@@@@@B@@@@@BB@@@@B@@@@@B@@@@@B@@BB@B@B@B@@@FADADADAD@B@@@@@B@@@B@@@HCPGJGHKDK@EFGHGLKDCB@B@B@FBB@FBDCFABAFCDCBCBA@A@C@C@C@C@CEECACAACACAECCAECCCAEACACAAC@C@A@CAEBEACACACAEBCB@FAFCFAFCFADCDADAFCFA@EBCBCBCBCDADBF@BBBBBBB@@BBD@B@@@D@B@D@B@BBD@@BD@@@BB@@BBBBDDDBDDD@DBDADBF@D@DLHFDFBHBDBFBB@@@D@D@D@F@DBB@D@B@BBB@B@B@B@BB@ABCBABCHGBCBCDCPKFEHCFEFGJUFKDEFE"],encodeOffsets:[[106318,29153]]}},{type:"Feature",id:"513436",properties:{name:"美姑县",cp:[103.132179,28.32864],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABADCDCBADADCFABABA@@@C@C@CAG@G@E@EBCBEBEDCDC@ADCDABADABAD@J@DBDBBBHHLBF@D@BAB@@CDEBC@CBA@C@ABA@ADCDAFAFADCJ@B@@@B@BB@@BBBBBB@@BBB@BA@@B@@ADCDAB@B@@ADAFALAF@B@D@D@B@H@J@BAB@BADABEHCDAFENAFAD@@ABA@@BA@CAMCMEGCAAICICMCAAO@KASFKFIBCBCBCDCDKLCLGPCJCHCLAJ@D@FBJ@F@FBJ@DFLHLDFBFHXBNANAL@@AD@FAJ@JKbEJAJCHBL@JDTARCTATAlBXCR@D@@AJAFGJKLABAF@FBBNNHBDDBD@BDJBF@BAH@^AdBVBLHVBNDNDL@HBJEHIHILGFCD@FAH@HHJ@DCFANOXBFJNHFHDTDLBJ@LIH@J@BBLBJDHBFBDFDBBBDBRB@@D@B@BAF@FBFDBD@F@B@B@JABCBA@J@BABABABABAD@F@B@B@BAFCBAB@B@@@BBBFBDBD@@DBB@BAB@DADCB@BADAJ@DAB@D@B@BAB@DAB@D@D@BAFEFCF@LBJBJDJJDHHH@@@@HNDJDJBNBJBLAHAFAJ@HBJFHHDLNDFJFLHFFJFDHDFAHBb@LDL@FBHDBFD@@FDHBBBHFBBBBBBB@F@D@NFB@HFJBBADATCFAH@B@DAH@@@@@B@DBDB@@@@B@@@BBBBFALGRQBCFCDABBB@D@B@DADADADCDCB@B@BBDBBBB@FFHH@C@ADAD@F@VBJALEHAFCHGJKFGFIJOHGHIFCFGBAJENINGPODG@GAMCKGIQKIGIKAECUCKCGKKCGGQIGCIAMAIAGAKACEMCEEIOIIGYMEACE@EBED]@EBQ@M@C@CBADABABABCDC@A@AA@@AACAC@CAC@A@EAC@C@E@AAAACAAAC@A@C@CBI@EDG@ICI@W@AB@@E@CACAACEACCECE@A@C@ADG@ADEDEFCFABAB@@A@CBADEACBA@CBCDE@ABGAC@AAACAAAC@AACAAAC@CAE@CAAAAAACAA@E@@@I@C@@BEDADABA@A@AAAAA@CAA@EBO@E@ACCAAACCCAACM@EBKDM@E@@@@@@@@@@@C@@B@@ADMBGBEFQFMBGFMDG@I@IAECGIGIGQIGECACASAGAG@GAA@SEEAWQEA@A@@@AB@BAFC@ABA@@BAFAFCFC@@BAFIFEBCBAFGBCBA@ADCBC@ABADABAB@JCB@DAFAPILGNGTKhSNGJALGLGHIBI@ABGAGCICI@AGEIEEEAAAGBG@EBGBKBMBOJIDAAGAIAGC@E@A@@BA@ADEFCDCBADEBEBGDGBABABAB@B@BAB@BBJ@DAB@B@DA@@BABABGDEF@@EFCD@@ABA@ABCBAB@B@BABA@ABCBCBA@C@@BAB@@@DBBBB@B@BAB@BADCFAD@BABA@A@EACAA@AACACCAAA@@@A@CBA@A@CACAAA@A@@AAGGCAAAC@A@C@A@CACA@@A@A@ABE@CBCAC@A@@@@@A@@A@@BA@@BAHCDCBABA@A@AAACC@AAAB@@ABA@A@@AAAA@@AA@A@@@ABAH@H@HCB@BAAA@@C@I@I@I@EACAIGGK@@GGKGGIECCCCCCAKAG@KCA@CCA@ACCECAECICCACCCCAC@AAA@AA@CDADCJCBADA@CBCAC@A@C@@BABBDBD@D@@"],encodeOffsets:[[105547,28721]]}},{type:"Feature",id:"513430",properties:{name:"金阳县",cp:[103.248772,27.69686],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@EAGCECACAG@GCI@GAA@AACEEAC@EAAACECGAGACAEACACECCACACAECEAEAC@E@E@CAACAAAC@A@AAA@@ACAAAAE@CAACCCECAAGAACGACAAAACAACAEAEACACCC@C@C@E@CBC@G@E@E@C@EACAACCAAEAECEAGCCACEGEECCAEEEAEA@@CAGAGBGBE@EAC@GAA@@@ABCFABABAFCDCBEBC@ABC@C@CAE@AACCCCCEECGIECCACEAAGCACECEAKEE@GAIEE@EACACCGACA@@CAE@A@@@GDGHABEDABABAFABEHCDADCLAD@DAH@DCFAB@DABA@A@DDBB@@@B@BAFCF@BAD@BA@@B@RAH@FBFBBBBDBFBF@LAH@D@FAD@D@DBDDBFBB@HDPBFDBLJHFHHDFBFFVBHJHLJBDBFHPDDDFLHB@BB@BBJ@L@JBD@DBDFDHFFDBBBB@B@@@D@@ADKNCDABEHILEFAB@B@B@D@BBBBDDBHHHHJJJJ@@DD@DBD@B@DADAHEP@BCJAD@D@BBF@FFRFV@B@B@DADAHAD@B@B@@BD@BFFDDFJFHBBLPBDBB@B@DAN@DAH@JANCTAL@DAB@DA@ABEBC@CAAAA@ACCKAG@AA@AAC@@RAD@RAJBJ@N@LBB@BDBHDNDBBBDFJ@DADIJ@DBDTPLLHHBNBDFLFNBFBHAPINCHCF@BO`ADDNDL@HAJBFLNHFPBH@DDFBNDNBH@\\DJFLBPANARAP@NEBADCFGJILOLMJEFANCJ@N@JAREIUAG@IBGDABCDAFBDBLBDABAFIFGHGH@LFJDFBHBBDBH@BBBHBFBPJD@PAF@HAPCJ@H@LDZ@JBJBJAH@H@DAJBHCDCLKPMNMDCHGFGDGBCHGJCHGFE@E@EBKBMFEDCFADADBJDFDHBRXHBF@@@J@F@HBDHFL@@DDBBB@DB@@@EBABABABABA@A@C@A@AAA@CAAAC@CAA@CAA@AAC@AAAAGACAGACACCECCCCCC@C@A@ACA@AECCCCAAAICA@AAGCICCCAACCAGAC@C@A@CACCCCEACCCCAC@CAC@ACACCEE@C@E@EAA@CACACCAACEEEECCECCACECACACCCACAEACACEAC@@@GDCBC@C@C@EACAEACA@@AAACACACCC@AAAECACCC@EAEACACCCCCEAECEAE@CC@@@AACGECAAAECGCCCAACACACCEECCCEEECACACCCCAECAC@C@EDC@CACCA@AE@IDIBCBALIDAB@BADCDGDABCBC@CAEAAAA@A@CACAA@CACBC@C@EBG@E@CAAAACAAACAE@C@C@E@C@CBC@ABA@EBC@CFC@@@A@CBE@C@C@E@I@EBEB@@E@EAGA"],encodeOffsets:[[105707,28231]]}},{type:"Feature",id:"513428",properties:{name:"普格县",cp:[102.540901,27.376413],childNum:1},geometry:{type:"Polygon",coordinates:["@@AEAIEGEDADEFIHMDMAG@G@SAOCKCMGMCIAC@K@K@EAE@EEGEIGIKGIIIKGMCMEMGII@EGI@WGDEDKJITE^CXAH@F@RDNFNHJFJBJCFEFMHIJIHKLDLHDLATBJFDF@F@HBR@PCLCLEROPIJKJMBA@IAGAIDCFCJ@@CJCJELKLKLGPCJCFMRKTELALFTDPBNBP@NCNCJCFERIRGPGRCL@HAL@NBTBLFNFJFJHLDRDR@BDLBHDHFPHLDLDLFJHDNDNBPDHFBDBHDDCNALAJAJBJBXBT@FLDJ@T@FBHJDJBLFJFLHDFBBBH@F@LCTAJANCJBFBFFHHFFDDJ@HEHEPCH@PBH@JCFCDCFIJONKHAFALAN@DDNBNBHCHKHAXDJ@FBHHHFAjKR@F@DPDBBRDDFHNHPHBFBBBDBF@DCFC`KDAPAJ@XAIUGU@SBQBKF_@M@EASDKBGHQDKBEDG@A@MBKBI@IBG@S@CEMOOKIAACC@ACECE@KFOJIHKDIDICIAKAAAEEOAOFGJGLIDMBM@I@KCW@YBCBILGPIFOBQ@A@KBIBEHUDKFI@KBKAK@MCMCEICODIDQ@MCGEIQCEACCOBUFQA[COCKDQNQNIHKDM@C@GCMEMEIEBEBKDIHMFIDK@KAM@IFKJIAUFUKKAKEGGAE"],encodeOffsets:[[105091,27945]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
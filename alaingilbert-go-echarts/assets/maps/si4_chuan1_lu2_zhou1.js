@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("泸州",{type:"FeatureCollection",features:[{type:"Feature",id:"510502",properties:{name:"江阳区",cp:[105.434982,28.87881],childNum:1},geometry:{type:"Polygon",coordinates:["@@EDMFA@@@ABA@@BEDCBCBGD@BA@ABA@UJIDBBDF@BBB@@@BBBBB@@BBBBB@DAB@D@DABBBBDBBBBBDDBBBBBD@B@@A@EBC@CBA@AB@@AB@BBB@BBB@BAB@BABCBAB@BABBB@@BBBBB@@B@@@DABABADAB@D@D@D@BCBA@CBADB@@BA@CDAB@@@@@B@@A@@@@@@BA@@B@@@@@@@B@@@@@D@BFBDBBBB@BB@B@BA@@BABA@A@ABA@CAA@A@A@@B@@@B@B@D@D@B@D@FBD@BBBB@@B@@ABIBABAB@DBD@DDDD@DB@B@DADABAB@@@@A@AB@@A@A@A@A@A@@@CB@B@D@@BB@D@B@@BBB@BB@B@B@B@D@@CBABAB@FBD@DBDADA@EAC@E@CBC@EBCDCB@BCBCDCDCD@@@@@B@BA@@D@B@B@D@B@BA@ABC@A@ABABA@AD@B@D@BA@ABC@C@A@C@C@ABABAB@B@BAB@BADA@@BABCDCB@@BB@BB@@@B@D@@@B@@BA@@BA@@BBBB@BDB@BA@@BB@@JADADCDC@EDCBC@AAA@C@C@A@@BAB@B@B@DBDBD@B@D@B@BABA@ABCBA@AD@B@BBB@BDB@BB@@@@@@@@B@B@D@D@B@@@B@@@@BB@BB@@@B@B@B@@ABA@@BB@@@BB@@B@@BBBBB@BAB@D@B@B@B@B@BAD@BB@@BBBB@@@BBB@B@B@B@@@B@BB@BB@F@B@@@BABAB@DC@A@@@AA@@A@A@A@AB@BABABAB@@AAAAA@C@CBABAFAHADBFBFHBBB@BABABABEBCDABCBAB@B@@@DBBBD@DBBBB@@BBBBB@DBBBB@@@B@D@@ABAB@@BBDD@BBB@DABCDCBABABCJBDBFDDDB@@B@B@B@DBHBDBB@DA@@BABABA@A@AAC@C@A@ABABA@ADCDEBABABABABC@CB@@A@E@CCECAGAG@AAAC@E@EDCBCBAD@B@DBFDFFBBFBF@DCDEDCDAD@JAP@B@H@D@DBBD@DBH@HBBDDBBLDBDBB@BAD@D@D@BD@H@P@@@BAD@@CBEB@@A@@@CCIAEBA@ABAB@NAF@F@D@BABABA@AACAEECCC@ABCDCHCHCBAB@D@B@D@FAF@DAB@B@DA@@FAD@HE@@B@BA@@@ABA@@@@@AAACACACAAA@ABA@ADADAB@DCFAD@F@F@HB@BF@DDHH@@HHB@@BDD@@BBBBBBBB@@B@BBBBBBBBDBBBDBDDD@D@N@HEFA@@DCDGB@@CDC@CBA@ABA@@DGDKHGDADCDCFCB@HAB@B@B@D@B@HBB@DBFBB@HDB@HF@@DBDBJNFF@@TLPH@BFF@JGLADAHAJCDEFAHADADABABAD@BBB@BNPRJ@@DBDBB@LBHBD@DADAHAFAHCDCDAFGB@BIBA@CNOTKFAZCLGFE@ABKCSCCGKCAQQ@@AACACEAE@CBCFAD@B@@@BAB@@CBCBA@@BC@AB@BC@ABA@A@@@A@A@CBADEFCBADA@@D@FBDBFBL@B@BA@ACC@A@AB@@AF@HBB@DAB@@A@@@AC@CAA@AADIB@@A@AAAECAA@ACAE@ABABBDDDAD@DABCBEBA@AA@C@CAAAC@ADEFG@A@C@A@AC@C@IFA@A@CAA@AA@@@C@@@ABA@A@CAACAAA@AAA@@@AAAAAAAC@@@CB@BC@@@@@@C@C@A@A@AACA@AAA@AAAA@@A@CBC@@@A@C@AAA@AAABA@CBA@@@A@C@A@@AAAAA@@BADCBAD@DAB@B@D@D@RC@@FADCBABCAACEEC@@@A@A@@@ABA@@@A@AAA@C@E@@@@AA@CC@C@ABAA@A@CBAFC@E@AA@A@C@CA@@A@A@@@AB@@ABAAC@A@A@@B@BBB@B@B@DDFBD@B@BABEB@@@BBHAB@@@@A@E@C@CBA@IBAB@@@BBBAB@BABABCH@BBBDBDB@@@BA@@@GBA@CB@@ABABA@AAA@CEECA@CACAAAECAAAAABAB@B@BAB@@AB@@ABB@@BAB@@@@A@@A@CA@A@@@AB@BBDBD@BABABCBA@A@A@CACAA@EBCBCBCB@BC@A@ABA@AB@DBB@@BDADAB@BADA@ABC@@@A@AAAAA@@@AB@BBBDH@@B@B@@B@@@@ABA@@BE@A@@@A@@A@C@@@@@@AB@@@@A@@@@A@@@A@@@@@AA@AB@@@B@@@B@@A@ABA@AACEAAA@@BAN@BABC@EAGA@@CAAAEAG@AAAABABC@CBAAC@CACACCACC@C@CDCDADC@C@@@A@A@@@@A@AAA@A@@@AA@@@@@AA@A@ABADC@A@AA@@A@@@AB@B@BA@EBCDA@ABA@@@AB@@AB@@EFED@@EDA@CDC@@@AB@@@B@@A@GFAB@@CB@@A@@B@@@B@@@BABA@A@AAAACAA@A@@B@BBBDBBBBBA@@B@@A@ABA@@@A@@B@@@@A@A@ABA@@BAB@BBD@@ABA@A@CBA@ABA@AAAC@@C@C@EHCD@F@@@BDFDD@BBBADABC@A@CAEAECEAE@E@ACAABI@C@CAC@CACCAAAC@@@E@CAAAAA@A@A@OAGCAG@CACCDAFI@EAIEK@@CEA@KEIE@@SI@@ICMEC@G@IAUCOISGGCAAUOMGEAI@E@IB"],encodeOffsets:[[107783,29423]]}},{type:"Feature",id:"510503",properties:{name:"纳溪区",cp:[105.371505,28.773134],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BBBDF@F@FBFDFBDBB@D@BABCAA@ACCCE@A@@@EDCFGD@D@@@BDBBB@BAB@DAB@B@BA@@AC@ABA@AB@BAB@B@@@@@@AB@@@B@BAB@@@@AB@AAAACAAA@A@AB@B@DBBBBBB@B@BA@A@@@A@@@AB@@@DA@@BAHEB@@@@A@@BA@@D@DCB@FC@@FCFE@@BA@@BA@@B@BAB@DCFAB@@A@ABA@@B@@@BBB@D@BCBAB@B@@B@@@@BB@@B@B@BBB@@@@@@B@B@@@DCDCBCD@D@DDDDBBDBD@DBDAB@DADABBBBBH@FBBBDB@@HBFBD@BA@ABM@AB@BBDFBBB@BAB@@@@A@@@A@@BAB@@B@@@@@B@@@B@@B@@@@@BA@@@@@@@D@BB@@@B@F@@AB@BA@@@@@AA@A@@@CGAA@ABA@@B@BBBBB@@@D@BAB@BC@ABABCAC@@AA@CBAB@BAB@D@@ADADADAFAB@DBDBB@B@B@DABABA@AACAC@ABA@@B@B@@D@BB@@@@@BA@AA@BA@@BA@@BA@A@ABABABBBBFDBBDBDBB@FDDFB@BBB@BABA@@DAB@HA@@B@@A@@CACAAA@ADGBABA@ABAAA@A@@BAJAB@DAD@F@B@@@@@BAAG@A@@FABA@A@AACCE@C@A@AAA@A@AB@B@D@BBBA@@BA@@B@B@@@DBD@B@B@@B@F@ADA@@BA@A@@@A@@AA@AAA@CAA@@A@AAAAA@A@C@E@C@A@IBE@EAAAAC@OAEACAAE@C@CBCFAB@BA@@@C@C@AAC@@@AAA@@A@ABAD@DABAB@@A@@@A@AC@CCA@CAAAC@CACAAA@AAA@@@ABABAB@@AB@B@B@@AB@@AAACAAA@AA@@ABC@A@A@AAA@AAA@@A@A@A@AA@AAAAAA@AAA@AA@A@C@A@@AAAA@AAAB@@A@ABABAB@BAB@B@@BB@BBD@BBBA@@BCBABA@@B@B@B@B@B@@@BBDCBEBADG@A@A@AA@@ACAC@@@@@A@EDIFCBE@EACCACACBA@C@C@@CCCACA@CDGFGDEB@BE@E@CBEAAEAEACAE@C@@@@A@@@@@ABC@CBABAB@DADABAB@BAB@@A@A@ABC@A@A@C@A@AAC@@ACAA@CBA@@D@D@D@DABA@A@@@CBA@C@EACAC@A@AA@BA@A@A@@BCAA@A@CAC@CAA@AAAACAAA@@AB@DCA@@@E@CBCBEBA@C@AAA@AAAAAA@C@AA@@A@AAAAA@A@A@@@A@A@@BA@A@AAAAAAC@AA@A@A@A@A@AA@@AAAACAAA@A@@@ABCBC@EAOC@C@A@AAABE@A@@@A@C@A@GAG@G@E@C@A@G@A@C@G@I@I@E@CACCAAAA@A@C@CBG@C@CBE@C@C@C@CAE@ECCACACAACAAAA@C@C@GDC@ABA@EB@@ADABCFA@CBCBAD@B@D@JBFAF@FAF@@AD@BABA@A@ABA@C@A@ABA@@BAB@BA@@@ABABABC@A@ABA@C@C@E@A@CAA@AAAA@ACC@AAAAE@AACACAA@AAAA@AAC@A@C@A@A@A@ABAB@BA@A@AAA@@@AAA@A@ABA@CBABCBA@A@ABC@E@CBC@AB@@AB@B@@@BAD@DABABABA@CBC@CBCBE@CBABAB@B@BA@@@@BC@A@A@C@A@AA@AAA@C@C@CAC@A@A@AACACCCACCCCCA@AACAEAC@EAAAAAA@@AACAA@AA@AAAAA@AAA@C@AAA@A@ECA@A@A@A@A@ABAB@BA@A@ABA@AAC@C@A@A@A@AAC@A@EAA@CAA@AAA@AAC@AACA@AA@@C@CAA@A@@@AACBA@A@C@ABA@CBA@A@A@AB@@@E@E@K@G@G@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@AC@@C@GBC@C@C@CAE@EACAEAEAE@E@I@@@ABAD@D@@@F@B@BBBB@FBDAHGB@@DBB@@D@DBB@@@@DBDBB@DBDB@@B@BB@@BB@B@DB@@@@BB@BBBB@B@@@BB@BBDBDBFDDBB@BDFBDDBDB@@BB@B@BAB@BAFADBF@BBBBB@DBD@FDLBD@BADABCDAB@BADAF@BABCBADABABE@CAC@CCE@CB@BADBB@D@BA@AB@AEEAAA@AD@D@DBBBBFDBB@DABC@C@E@@B@BAB@DBFDFFDB@BD@B@BABCBCBI@CBABAB@F@DABADABABCDCDAB@ACC@@AAC@GDAB@B@BBBD@BBB@@B@BABADCDCBCD@@AB@BAD@BBD@D@B@BBHBJ@BADADAF@B@@AB@D@BAB@DAD@B@BAB@B@D@BB@@DB@DDBBDBBBBBDBBBDBBBDB@@BBB@@BDBBBB@BB@B@BAB@B@B@@@@B@@@B@D@B@B@B@D@DBB@BBDD@@DDBB@BBBBB@@@B@D@B@D@FBD@DBDAHCFEFCF@DDDFBDADADAD@DB@BBD@B@@@D@F@DAH@D@BBDAD@B@@AB@D@BABABCBGBCBEB@D@B@DB@DA@@B@B@BBBBBBBBBDJCVIB@BAB@@AHCDADAFC@AB@BA@@B@NEFCJAF@J@FBNHVPBBHDTHPJVDJBH@D@NFJD@@TJ@@JFLFB@DF@@FLBJ@FEJCBDDDBH@DBBH@P@B@BBBBBDBF@@@D@BBDBBD@DBD@D@DAJ"],["@@BD@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@A@@C@"]],encodeOffsets:[[[107921,29527]],[[107755,29133]]]}},{type:"Feature",id:"510504",properties:{name:"龙马潭区",cp:[105.437751,28.913257],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@CCCAAACAAAAAAAAAA@@@AAAAAAAA@@CC@AA@GG@@GGCCE@@AGAE@E@C@EBCDA@CBCB@BAB@BBBDBDBDBBB@B@@@@AB@B@@ABA@@@GFC@EB@@CBA@A@CBE@EBC@A@C@A@ABGDGDCDAD@BDDFDBFBD@BABABABC@E@E@MBA@AB@BABBFDJ@D@@@BA@AF@DC@AB@@O@G@C@@A@C@CBC@AAAACKCAACDABABADAB@BC@@@@B@B@DB@DB@D@DCDCBAD@D@H@JBBDHBD@DFHBF@D@B@DBDDDBB@@@@@@@B@B@BAB@DBD@@@B@BFJBBBFDFDFDF@@FF@@AB@@A@C@A@ABABCF@@@@ADAFAB@D@B@@DBF@BBD@DADABAB@B@DBD@@@@@B@@@B@@@B@BA@B@@@@@@BB@B@BB@@BB@B@BB@@@@@BA@@@@@AB@@@@@B@@B@@BB@B@BA@BB@@@B@@@AB@@@@@@AB@@@@@@BB@@BB@@B@@@B@B@B@@@@A@@@@@A@@@@@@B@@@BBBBB@@BB@B@@@B@@B@@@@@@@B@@A@@@A@@@A@A@@@@@@@@BB@@@@@BB@@@@A@@@C@A@@B@@@@@@@B@@@B@B@@@B@@@@@@@BB@@@@B@@@B@@@B@DAB@DBBB@D@FEBAD@BAB@H@@@B@@ADAFC@@BB@@@@DA@@@AB@@@@A@@@@@AB@@@@BB@B@B@@@B@@@@AB@@@@@@A@@@A@@B@@@BB@@B@@@B@BABA@@B@AA@@@@FCD@D@FBFBJHDDH@HAD@DBDDBFFHFABADSDABADA@A@C@CBCBAB@@C@AAA@AAABC@C@ABAB@B@@@@A@A@ACG@A@CFCF@F@B@H@DBHFHHBFDDDD@H@FHHHFD@D@@CACAABABCD@DAFA@@DKDABCD@FDDBDDD@BA@CACCCCCAC@AAAA@ABABA@A@AA@@@@@A@@AOBEDAD@F@DBBBB@B@BABABABADAB@DABC@@B@@@B@@ADA@A@@@A@AB@@@B@@@B@@@B@B@BB@@BA@@DAB@BA@@B@B@B@BA@A@@@A@@@AA@@@A@@AB@BAFKBE@AAAECEAAC@ECGKEAE@M@G@GBEBCDAFC@AAA@ABCBABABCBCBGFEDCBIBGBCHK@IEE@AOGSK@@EEIMCACA@@GEA@GCA@EACAA@GAA@C@A@A@A@GBA@EDCDCDCBGHCLCH@@AB@BAB@DCD@DA@CHCD@@EBGFM@C@"],encodeOffsets:[[107995,29603]]}},{type:"Feature",id:"510521",properties:{name:"泸县",cp:[105.381893,29.151534],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@"],["@@@@@A@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@B@@@@B@@@@@@@@@@@@A@@@@A@@@@@@AA@@@@@"],["@@@@@@@@B@@A@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@A@@@@@@@@"],["@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@B@@@@@@@@@@@BB@@@@@@@@@@@@A@@@@@@@@@@"],["@@@A@ABA@A@@@A@@@C@AB@BA@@@A@AA@@@AAAACAEAA@AA@AB@@@BAD@F@D@DAFCDAB@@ABA@@@A@@@A@@@@A@BAD@J@FAF@FBZLDBB@D@DADA@ABAAAGK@C@CFGHCDAF@DBLJD@FBB@FDD@FBF@DAF@BAB@B@DBBB@@@BA@BDBB@BB@DCHAFAD@B@B@B@FCBADAB@@@B@@@@AD@D@HBJDHDB@B@BA@@B@LBDADA@EAIAM@EB@B@FBBDDJDFBHBDRLFFHAFADCHE@EISDEFEHCHAH@@AD@H@JBHJBF@BADEFEFAD@F@BDD@AB@FADAB@H@D@B@FB@B@@AB@@BD@@ABBFBDFHHFLJJDHBLCJCDEACQAOAIACGAIBKBIDKFIHQLUAM@AAGAC@ABCB@BAB@B@B@BA@@EOCGCEAC@A@ADC@C@E@G@K@AA@AEA@@C@ABCBAFAF@D@D@@BDAB@BABABCBEFIDGBABA@@@AA@@@@@@ABA@@@@@A@A@@AC@A@@@AB@B@@@BA@@CK@C@C@CBABADAH@LLDBFAFADE@GICOEICEIAKCG@C@C@A@A@ABCFEHEFEBCBEBGBEBEFCFAD@FAB@BE@E@@@@@@@@@@A@@@@A@@@@@AA@@@@@@@@@@@@@A@@A@@@@@A@@@@@@@@@AAA@A@A@@AA@@@AA@@@@A@@AA@A@@@@@@B@@@@@@AB@@@@@A@@A@@@@A@@@@@@@AA@@@@AA@A@@A@@@@@A@@@@A@@A@@@A@@A@@@@@@@@@@@@@A@@@@B@@@@@@@@@BA@@@@@A@@@@@@@@@@A@A@@@@@A@@AAA@@@@@A@@@A@@A@@@@@@@@@@A@@B@@@@@@@@BB@@@@@@@@@@@@AB@@@@@@@@@B@@@@@B@@@@@@@B@@@@A@@@@@@A@@A@@@@@@@@@@@@@@@@B@@@@@@A@@@@AA@@A@@@@@@@@@@@@AB@@@@@@@B@@@B@B@@@B@@@@@@@@A@@@@@A@@@@@@AA@A@@@@@@@@B@@@B@@@@@B@@A@@@@@A@@@@BA@@BA@@@A@A@@@@B@@@BA@@@AB@B@@AB@BAB@@@BA@@A@@A@A@@@@@@B@@A@@A@@@@AA@@@A@A@@@A@@@@A@@AA@A@@AA@@@@A@@AA@@A@A@AA@AA@@A@@A@@@@@A@@CACA@AAA@A@ABCA@@A@@@A@@@@@@@AAE@AA@A@A@@AAC@A@@AA@@@AA@@C@ABAAACA@AAAAAAA@@@@@A@@BA@A@@@A@ACCCAAA@C@A@A@A@AAAAACC@A@@@A@A@@@A@@@AB@BA@@AA@@AAA@G@A@AA@@A@@ABA@@AA@AEC@@AA@ABC@A@@BA@@@AAAA@C@C@ABIHAB@@AB@@A@@A@AAA@A@@DG@AAA@@AC@A@AA@@ABABC@A@ACAACAAAAAGA@@AA@AB@D@BA@@@A@CAA@ACA@@@A@AB@@AD@@AB@@A@CAGEAAC@@@AB@B@B@BEBA@A@A@A@@BC@CBA@E@A@A@A@A@@@AAA@EAEFKHYDEBSLMP@DABAJA@EHCBCDGDEBGBCBCBC@GAKAA@CACA@@QIMOEDCBADAF@H@H@NBFLFDH@FBDFBFDBB@BAFELABA@@BB@@@B@@B@@@B@@@BABA@A@A@@@ABA@CB@@AB@@AAA@A@@@A@@@A@@@A@@B@B@@@BCB@BA@@@A@@@ADCBA@CBABABABABA@A@AACAE@C@CBAFBP@@@B@@@@BBB@B@BABAB@BB@BBDDDDDBD@DABC@CCCAECC@ADCBCL@@EBCBC@ADABBBBD@DC@C@GEGG@E@GCCCCAEGGGECAG@A@E@E@ED@D@BDH@B@B@B@@A@A@AB@B@DADBB@BBB@B@DA@ABAD@D@D@BCBABCBCTABEBEGAECCCAC@GBG@CCIGEAEAC@C@ED@@@@BBA@@@ABABA@@@A@@@AA@@A@@@@B@@@B@@@@A@@B@@A@@@A@A@A@@A@@A@@B@@@@@B@@A@@B@@CB@@@@AA@@EDCB@BA@@@G@A@ABC@ABEFC@A@AA@CBA@C@A@@@A@@@A@@A@@A@@@@@@@A@@@A@A@@@A@@@@@@@AB@D@@@B@@@@@AA@@@@A@@A@@@@@@B@B@@@B@@@B@@@@A@@@@@@@AA@@@A@A@@AA@AAAA@@A@@@@@@@@B@@@@@B@@A@A@A@@@A@@@AA@@AA@@@@@@BA@@@@@@BA@@A@@@A@@AABA@A@@AA@@@@A@@@@BA@@@@B@@A@@@@AAA@A@@AA@@A@AAA@@@@@@@AABA@@@A@@@A@@@@@C@CAA@A@ABCBCBC@AAE@CA@@@A@CBABEBC@@@@DEBABAB@D@B@@@BA@@EE@@CECECEAEAAEI@A@A@@AC@CBA@A@A@A@@@@@@AACCAC@C@A@CAEEG@CACCGAA@I@G@CBCDADC@C@CCAA@@C@A@A@@D@@ABABCBABADCCCAA@GAG@CACCAC@G@A@O@IBC@CBCDCFCDE@EAAAEEECCAA@C@ABADCD@F@FBDBBH@HBDBDF@D@F@BA@@DADABABABABCFCD@BABAB@B@B@DBD@B@BABABAB@@CBA@CAGACAA@A@A@@@CACCAEACDIBABADADCBA@CAA@ACCAA@@@@A@EBC@ABAB@@ABAB@BA@A@C@@@C@EBCBEDADAD@D@DDFPJ@@BBBDB@@B@@B@D@B@@BDBHDDBDDB@@BBB@@BD@BBB@B@BAD@BAB@B@@BDBD@B@@@D@BE@ABA@@DBDFJ@FAD@BA@@@A@ACAAC@CBC@G@I@CD@DBBFDBB@BA@ABC@GBE@GDGBAACCAAACCAABADEBGA@@@ACC@A@AACAAA@ABA@ABA@A@C@C@EBABADADADEBE@E@AB@@@DAH@D@@AB@DBBBDDBBB@D@DADAD@F@D@DBBBBB@BBBBDFBFB@B@DDHBF@DD@@BDBBBDBDBDBDBBBBDBDDDDDDFDDBDDDFDHBDBBDDBDBBDBFDBDDFBB@BABAD@BABADAD@DAD@DBBDBBDDDDDBBFDDDDBDFDDDBBB@BDBBBDB@D@BAD@@@@A@A@@@A@@@A@@B@B@BBBD@@B@BABABA@QDCB@DAD@DBBB@D@F@@@D@BBBDBB@FBBD@D@B@B@DAF@F@D@DDBDBD@F@F@BAFBJ@B@BAD@DBD@BBBBDHFDB@@@B@@BBDFBDDDBFBDBD@BBBBB@BFH@DBDBDBBFHDFBBFDDDFDBD@@BBDBBBHH@@BB@@B@@@@BDBBBB@B@DAD@D@BBDBBBF@FBDDHH@@BB@@BB@@@@B@@@B@BC@@@@A@A@@A@@@ABABABAFCNGB@D@BBB@@B@@BDB@@@@@@@@@@@BBB@@@DDB@BBB@D@BB@B@B@BABEBCDABABBBDBD@L@DBBB@B@B@B@BGDCB@B@B@@@DFBB@B@BAB@@AB@BC@AB@@@BBBDB@B@@@BB@B@AB@DB@@B@B@B@@@B@B@B@@@@BB@B@@A@ABC@@DBBDDDDD@@@@@@FBLDF@F@D@BADAD@B@BBBB@BBD@F@DBBF@FCDCFCBABC@A@A@CAC@A@@@A@AB@B@D@D@B@B@@A@A@AAAAAC@C@A@AAA@"]],encodeOffsets:[[[107899,29991]],[[107916,29989]],[[107917,29992]],[[107894,29997]],[[107997,30009]]]}},{type:"Feature",id:"510522",properties:{name:"合江县",cp:[105.830986,28.811164],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@A@A@A@@BAB@@A@AAC@@@AAA@CBA@A@@BADABAB@BA@AA@EC@A@A@@DC@AA@A@A@ABAA@AAA@ABADABABC@C@A@A@A@CBA@ABA@A@AA@AAAAC@AAA@A@A@ABAB@@A@CBA@A@A@CAC@CAAB@@CBA@AAAAAC@A@AA@C@ABABADAB@@ABABA@AACBC@C@A@CB@BAB@BA@CBAB@BBDAB@B@BABABABC@A@C@A@AB@@CDCBEDCDCB@B@B@B@DA@CBAB@BBB@D@BA@ABADAB@BAD@B@DA@CBAB@B@B@BAD@B@D@D@@A@C@C@C@@BC@A@C@A@C@@@A@@ABG@@AA@@A@A@ABABA@ABABCBA@A@A@EBAB@B@BADA@@@AACA@ACAABABADABA@@B@B@B@BC@AB@DABA@AB@@AAA@@@A@A@AB@@ABAB@D@BAD@@CBCBA@@@CDABA@CBA@ABAD@B@DA@ABA@C@A@C@@@AA@AC@A@CB@@ACAAAA@@A@ABAD@BABC@ABC@CD@@CDA@@BABAB@BADABCDAB@D@@@D@BBF@D@BAD@BABCBADABABEDADABABADCD@D@DAB@BBF@BBB@BAB@BABABABADAB@@CAEBCBADABA@AAAA@ACA@BABA@AB@BAF@@ABA@ABA@AAAA@@AA@A@@C@AAA@A@A@CBC@AAA@A@@DA@ABABA@ABA@A@AA@@A@@@A@ABA@@@A@@A@@@A@A@@BAD@BA@ADAB@@A@A@A@@@A@AA@AAA@C@C@G@A@A@AB@BA@ABC@CB@@AFADAHAHAH@DCBE@CBEBC@A@EDBDBBFB@DBBDFAB@B@@A@@B@@A@@@@@A@@@@B@B@@@B@@AB@BA@@@@@@B@@@B@@B@@@@@AB@@@@@@@@BB@@@@@@@@@BA@@B@@A@@BA@@BA@@@AB@@@@@@AB@@A@A@AB@@A@@@@@A@@@C@@BA@@@A@AB@@@@A@@BA@@@AB@@@BA@@@@@A@@A@ABA@@BA@A@A@@@AA@A@A@C@C@CBA@@B@BAB@BA@EBEBC@ABA@C@AAAAAAAAA@@@@@A@ABC@ABABABAB@BBDBB@BBB@BBBBD@D@@@BC@A@C@C@C@A@A@CBABA@@B@BDDD@D@D@@BCBCB@BBBDBB@@@ABA@ABA@EBG@AB@BBB@B@@ABA@CBA@@DABADABAB@D@BABA@ABABAB@AABABABA@AAA@@@A@C@A@C@C@C@A@@B@BAB@@CBG@C@AB@@@@@BAB@BAB@BAB@@ADAB@BABABABABA@A@A@@C@@@C@A@C@AAA@A@A@C@AAC@C@@AC@@A@@A@ABABABC@ACAA@CCACAAAC@@A@ACA@@CBA@@@CAA@ABABA@A@A@@AAA@ABE@CAA@CAAAC@AAAC@CAAA@@@A@A@AA@@A@@@@AA@AA@A@C@A@A@A@ABA@ABABABC@ABA@A@@AC@AAA@ACA@AAAA@CAAACBC@CBA@GCA@CAAAECCACACAAAA@CAA@A@ABC@ABCBA@CB@@AAAADC@CBE@CAA@C@GBG@@DAF@DAD@@AAACAAAAA@AAA@A@CBA@AAAA@AA@C@C@C@CBA@EA@@@EC@@DA@@B@@@@@@A@@B@@A@@@@@@BA@@@@@@@@@@@@@A@@B@@A@@B@@@F@B@BADCB@BA@A@@@C@A@AAAA@C@CA@@A@ABA@@@C@C@AAAACC@@@AAAA@@CAAAA@AA@A@@@A@A@ABA@AB@@@@AHADC@EAIAC@AACACAA@ADAD@@A@@@@@@@AB@BA@AB@@AB@@A@A@@@@@@@A@A@A@AA@@C@A@AACAA@CC@@ACA@@@ABC@ABA@A@@@A@@@C@@@@@A@@@A@@@A@A@A@@@A@@BA@A@A@@@AB@@@@@AA@@@A@AA@@A@@AA@A@A@A@A@A@ABA@CB@@@B@B@@AB@BA@@@A@@AA@@AAA@@@@A@A@@B@BAB@@@BA@A@ABA@A@ABA@@BABC@@@@@A@@@A@@A@@A@@AA@@A@AA@@A@@@A@@@A@@AA@@AA@A@A@ACCC@@@AAA@AA@@CAEACAGAA@CAA@@@@@@B@B@D@D@D@@@D@B@BABABADEB@B@@@@@@ABAB@D@B@B@@@@@@@BA@@BA@ABC@A@AAC@CAAAA@@@@@@A@A@@@ABAAAAACAEACCIQ@@GIECEAAAEBGBEDABA@CBA@C@A@@@@@EG@A@EAE@M@A@C@C@@BA@A@CAAKICAAA@AAEAKBIDOBAA@A@GBA@C@C@ABC@AAA@AA@@@CAA@@BA@A@A@A@ACAAAAA@@A@A@@@@A@@A@@@@@CB@@@@@@@A@@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@A@A@@@A@@@@AA@@@@@A@@B@@A@@@@@@A@@@@@AA@@@@AAB@@@@A@@@@A@@A@@@AA@@A@@@@@@@A@@@A@@@@A@A@@@@A@@@@@@@@B@@@@@@@B@@A@@A@@@@A@@@@@A@@@@@AB@@@@AA@@@@A@A@@B@@@BA@@@@@@BA@@A@@A@@@@@AB@@@A@@A@@@@@A@@@A@@B@A@@@@A@@A@@@@A@@@A@AA@@BA@ABA@@BA@A@A@ABABA@ACC@AAA@@@A@G@C@A@CACAEAAAA@A@A@AA@AAA@A@ABA@AAAAAA@AAEAC@AAA@A@@AAB@@A@@@@B@B@B@@@@@B@@@BA@@BA@ABA@@@A@@@@B@@AB@@@@AB@@A@@@A@@AA@@@@A@CA@@A@@@@@@@AA@@@A@@@@ABCBEBGBGBC@EBABI@C@EACAMEGAC@AB@D@DDJDFDBAB@BCDEFA@I@CBABABADELCHABABA@A@A@EAA@ABADA@A@C@C@@BAD@B@B@DADC@ABA@BP@FADAD@B@@@BBBDBBBBB@@BBB@B@B@B@B@@BBDBBBB@B@BAB@@@B@B@@@B@BBBBB@B@BB@@B@DBBBBBBB@BBD@B@FADADAF@@@B@CDA@@BB@BBBDBB@BBB@DBD@D@BBBAD@@@B@BABB@@B@BBDBD@F@DAB@D@@@BABCBC@C@C@@@AB@DBBBD@@BD@B@B@D@B@BAD@B@B@BA@ABA@ABCBCBA@ABAB@DAD@B@@@@@B@@D@F@DBFBFBBBAF@D@FAFA@CFEHCH@DDBDBDD@@@D@DABBDBDDDFBF@DAJEFCB@@@@@D@DB@BB@@B@B@BCHABAFCDAA@@A@A@A@A@A@@@ABABAD@@ABAAC@AAA@@AA@A@ABA@ABAB@B@BA@BB@BBBBB@@@B@D@BBBB@BBB@BBBB@BBBB@B@B@@@BB@BBB@B@B@BAD@BB@@BBBDBBB@BA@@BA@A@A@@BA@ABAB@B@@BB@BBBDBDBD@BBDBB@DDD@@B@B@@@BA@ABCBC@AB@B@BB@BB@@D@BBD@D@@@B@@ABADEDAD@F@BBBDBF@PBDBBFBF@JAB@D@F@D@B@B@BBBBB@@@BB@DBB@BBB@@@B@@@BAB@@CB@BEDAB@D@BBBBAD@D@@DBB@@@@@F@DBB@B@B@@AB@B@@@B@B@@FDDFBBADABCDEB@@QDC@C@A@A@CBC@ABCDAB@@BBBB@BB@D@B@@@B@DAB@BABBB@BBD@B@@@D@DAB@@@BBBBB@BBB@BD@B@B@B@D@D@@@@D@@ADA@@D@BBBBBB@B@@BB@BBBDBBB@D@BAB@B@@@D@@BBB@DBB@B@JED@D@@B@B@D@BEHCF@BBDBB@D@DBBB@FADABA@CBCCCACBABAF@DB@BBBFDBB@B@BA@CJBBB@DBD@@B@@@BA@CBA@GAE@@BA@@B@BDD@BABA@K@EACAEAC@@@CBABEDCFAB@D@B@B@@@BAB@BADA@@BAD@@ABAD@DA@AB@@A@C@EBAD@DBFDFDBBB@@RRDBHLDDDTAL@BFBB@BB@@B@B@B@B@F@B@DAD@@AB@B@B@B@FA@A@A@ABA@@D@BBHFDBB@@@BA@@BC@@BAB@@@B@BDB@DBB@@@B@@A@CBAB@@BB@BHBBBBBDDB@B@BADAB@BB@@B@BBD@@BB@BCH@@@BBB@B@BB@@@BA@@BAJGBAD@D@B@BB@B@@AB@@@BAD@BBB@@FD@BBB@@AB@BB@@@BBB@H@B@BB@@BB@@ABA@@B@@@B@@@B@B@@@BDDBBBB@B@B@B@B@DBBDBDD@B@B@@@BAB@@@B@@@@BBBBBB@BDBBBAB@B@DB@@B@@BB@@@BBD@BB@B@B@@BBF@B@@@@@@@B@@@BB@AD@B@BBB@BDBDB@@@B@@@@@BB@@@BBB@@B@B@BB@@BB@@@@@BB@@@BBB@@@B@@B@@@B@B@@@B@@B@@B@@@@BB@@@BA@@BA@AB@@A@@@A@A@@@A@@@@@A@A@CA@AAA@@C@ABABA@AAEA@@CAC@@@ADAD@BA@ABADABCB@B@DAB@@ADA@@BA@AB@DAF@B@B@B@BA@@@C@@B@BABA@ABCBA@ADCDCBA@CBABCBABAB@DAB@B@D@@@ACAA@AAAAA@AAC@@A@A@A@CBEBCAAAAC@CBEDCDEFA@@BB@@@@@@B@@@@@B@@@@@@@@@BBDBFDDBF@BBD@DAD@D@B@DBB@BBB@DBFCBALGHKFAFC@@@@FAD@DBFBDBPDLDD@D@BAJCFG@KCM@ABC@@BABALALCJIHCHB@@BDBB@HKPAFBDHBFBDD@@@B@FBBBBBB@B@@B@BBB@B@@@D@D@D@B@BBB@B@@BB@BD@BB@B@DBB@B@@@BB@@DBBDBBBBBBBBDBBBDBBB@BB@BB@BBDBD@BBDDDBBB@DBBBB@BBB@B@@AB@BA@ABA@CBA@@BAB@@@B@@BBBBBB@BBB@BBB@B@B@BB@B@@@B@B@BAB@@CBA@C@AB@@AAC@A@ABA@AB@B@@@BBBBBB@BBDBB@BBB@D@B@BAFADAD@D@DADABAB@BCBABABA@ABCBCDABCDCBABCBABCDADADAB@B@DBBBDBBBBBB@DBB@H@@@B@BAB@B@BABABC@A@A@AB@@A@A@A@AAAAAC@C@AAA@@C@AAA@A@A@@BAB@BADAB@BA@ABAB@BA@@@A@AAAA@@@A@AB@B@BABA@C@AAA@@A@A@@B@D@@@BA@A@AAAAAAA@C@A@@BA@A@ABC@ABA@@BADCDAB@B@B@D@BBDBBBBBB@@@@BB@@D@BBBBDDBB@B@BBD@D@B@B@BABABABA@AD@DABAD@BABAB@B@B@@B@B@B@BB@@@BA@A@C@AAA@A@AAC@@@ADADADADAD@B@BAD@D@B@D@DBD@@@B@FBB@DB@BD@DBB@D@BAD@DBB@B@B@@B@@B@D@B@D@DCDAD@D@B@D@@@B@BB@@BBBDBDDBB@@BD@D@BAD@BBDBBBD@BDDBBB@@BB@@@D@B@B@@@@B@DCDAFADADABAB@BADA@@BA@ABABAB@B@BBDAB@BABCDBD@B@BABA@ABC@A@CBABADCBABAB@B@B@B@BAB@D@D@DA@@BA@A@AAABC@ABCBADABCBABADABA@ABA@A@C@A@@B@B@B@DBBADA@@D@B@B@D@BAB@BA@A@A@A@C@@BA@A@@@ABABA@ADCBABABAB@BABA@@@AAAAA@A@AAA@C@A@AAC@AAAAACAAAAAA@@@E@@@C@AAA@@AAA@A@ABA@AB@DABADABAB@@AAAA@CAA@@BC@CB@@A@@CAA@@@C@ADABC@@@ABC@C@C@A@C@ABA@ABA@A@ABA@C@A@A@ABC@@F@BADBBAD@BADABADA@AB@AC@A@@AABA@A@A@CBAB@B@@ABC@C@E@C@C@C@A@C@EBC@C@CBC@C@ABAB@B@B@B@B@BCBADADAB@B@BC@@@C@A@@BABADAB@BAB@@ABABABC@A@A@C@ABABAB@BABABA@ABCBA@CAA@C@A@C@C@A@ABABA@@@CBA@C@CAC@C@C@A@C@C@ABABAB@DADADABAB@DABABABA@AAAA@AAA@A@CAA@C@AAA@@AA@@CAAAAACAAAA@ABABC@A@A@ABABABADABAB@DADAD@D@@@D@B@DBBAB@BABA@A@CAAAAAAAA@A@CAA@ABAAA@C@C@A@ABA@ABCDEBA@CBABABABAD@DCB@DA@ABA@A@C@A@AAAB@DCDA@ABA@A@C@A@A@C@A@E@AAA@AAAAAAAAAAA@AA@@AB@@@@@B@@@D@@@@A@@BBB@@A@@@@@A@AA@BA@@@@YQA@BABADAB@BA@@BC@E@C@AAC@ABABABC@ABAB@@@BBB@@BDD@BDBBB@@BB@@B@BAD@BABA@AFCBC@ABABABABCB@B@B@@ABA@@BA@BB@BBBB@@B@B@@AB@"],encodeOffsets:[[108928,29211]]}},{type:"Feature",id:"510524",properties:{name:"叙永县",cp:[105.444765,28.155801],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@BBA@AAB"],["@@BDDG@A@@EF"],["@@@@@@AA@@@@@AA@@A@@@A@@@A@A@@@A@@@@@A@@@@@A@@AA@@@@@@@A@@@AA@@A@@@A@@@A@@@ADCBCDAFAFAB@DAF@BADADA@@B@@@BBBBBBB@@@B@@@D@@AB@@AB@BA@@@@BAB@BC@@@ABAAA@@BABA@@@A@A@@@@BA@A@AA@@ABA@A@A@@@AB@@A@A@@@AAA@@@E@@CCACACAC@C@ABCDADCBABCBEBC@E@E@EBE@ADABC@@B@@ABAFA@AC@AACAA@@I@@@C@AAEAIAGEICECGAECAEGAC@@@GBIBCBC@@D@DBBD@@@BBB@DBBBBBB@@@@B@@ABA@@BAB@BA@AB@DC@A@@BA@@@A@ABCBA@A@CBABA@A@ABC@CBC@C@ABA@@A@@AAA@A@@@@BA@@@A@A@@B@@A@A@ABC@ABABA@AB@BA@ABA@@@@@ABCBEBE@EBCBE@C@@@AFIDGBCAC@@A@AA@@CB@@CBCD@@CDGBE@GCIC@@GA@@CAIAECCA@A@A@@@AC@EAUIA@EG@CBEDCTKJO@GACCCCCAE@C@CCK@CBA@EBCDEBAAA@C@AEEACACAA@AAC@AAAAAAAEACAACAA@AACAEAC@ABABADADADABA@@@A@A@A@CA@AAGCECCEACAE@E@CA@C@CBAD@@@@ADAB@B@@A@@@A@@@@@A@AA@@A@A@QGAAC@UKAA@CBC@CBC@CBE@C@CAEAGAE@CAC@C@C@E@C@GBC@CBG@@BMDU@G@CCEICAA@A@CBAB@D@BANALG@@BA@ABCBABCBC@ABC@C@A@@AA@@AA@A@ABABA@C@C@A@ABA@ABCAABC@CDCDCDEDCBA@C@GBA@ABABA@@@@B@DCFABADAFAHCFADC@AAAC@A@@AB@BAD@H@J@NFB@BABC@C@AFEF@B@BFFFDBFADAHCFCF@JFVDD@@A@AGGCC@EBGBCBAD@DCBCBEACKIAC@CB@B@D@B@DADADE@@@A@A@CBA@ABA@ABA@A@A@@@A@A@@@ABEDCDAB@FHHFF@F@F@DCBCBC@A@A@E@A@A@@AACA@@A@AB@@AB@BAB@BABA@C@I@E@C@E@CCAC@A@C@@BCDCBCAC@A@AB@AA@A@@@@AAA@@AA@@A@A@A@A@AB@@CBABA@@BADAB@@@BADC@CAG@C@G@ECEI@E@EAG@CBGFCDA@A@CEAE@EBA@ABCFEDCBABC@ABABE@ABCBA@A@ADA@@DABA@ABA@CDCBA@A@C@CAC@@AEAAB@B@B@BBB@BA@A@AC@AABEBC@ABAEEC@AAC@CAC@A@CACACACECOECCAKACAACBAFIBCAACC@ADCBC@ECEII@E@A@CFABA@C@GBA@@BA@A@ACCACCCCC@ABGFK@@A@C@ECCAAAECAA@CACACCAACAAAAAC@AAAAAAAAAA@AAA@C@A@CBA@@@A@ABC@A@ABAB@BCBABAB@B@B@DCH@B@B@BBB@B@B@B@BCF@BABABCBGDEBAB@BAB@B@BAB@@@BA@AB@@@@AB@B@BAD@DBBBJ@D@BABA@ABC@A@A@CBABA@A@A@@@ABABA@A@AAA@C@A@ABC@A@CBCACAC@A@C@I@CACBCB@@@B@@@BAB@@CBE@CBAB@BAB@BABAB@@@B@DAB@@A@@@ABBDBB@BCBADABCDABAB@D@D@BKDGBEBCBCBAFCFGBABEBCBGA@@E@A@EBCDEDE@G@@@A@@BA@@@@@C@CBE@GAEAE@@AEEIAA@EBABAD@@GFA@G@EBCAACAC@AACC@C@ACCGA@@@AB@B@DCBCBE@GCC@AAG@C@C@E@AACCABC@AAEA@ABCBAACAE@AAA@@CAC@AB@@AB@FADABC@C@ECE@C@EECE@@AAAB@BA@GAAAAAC@ABA@C@C@@A@@AAEE@@AGCCAEACAA@@ACAAEACAA@AAA@A@CB@@A@A@A@@@AA@@CEAA@@@A@A@@@AAACA@A@@AC@@EACA@ACCCE@AC@@@AB@@ABABA@C@CAC@A@@BCD@@CBA@ABA@@@@H@@@B@B@B@B@B@D@BBD@B@D@B@D@BADABAD@B@FABBB@B@@BB@BBDBBBBB@@BBBAB@@@BABA@AD@BAB@B@BAD@BBB@BB@BBBB@@B@@D@@ABABABABABC@A@ABA@ABA@ABABABABAB@DAB@BAB@B@D@B@B@BAFB@B@BBB@BB@@@@BABA@@B@@@@@B@B@BBB@@@B@@@@BB@@BBB@@@B@@@B@@@B@@@BAB@@@@@B@@@@@B@@@@@B@@@@B@BB@@B@@BB@B@D@B@BBD@B@D@@@BBB@@@B@B@@BB@@@@@@@B@@@@@@AB@@A@@@@D@DBB@BBBB@@BB@B@B@B@B@@ABABA@A@C@A@A@A@ABA@CAA@A@CAA@CACAA@CAA@A@A@ABABC@C@C@A@CAC@A@EBA@CB@@ABABA@ABAB@@ABA@@B@BABAB@@A@A@A@AAC@AAC@A@AA@@A@@ACA@@A@A@@@ABBB@B@BA@@BABA@@B@@ABBB@@BBBBBBBD@BBB@@BB@BBBBB@@@BBDABABABAD@@AB@BABBB@B@@BB@B@B@B@DAB@B@BCB@BA@ABA@@BA@@DB@@B@B@BBD@BABAD@BA@@B@B@B@B@B@BADABABC@AB@D@B@FBBBDBBBBBBBBBDBBDBBB@BB@DDBBBB@@BBDBB@B@B@B@B@B@BADABAB@B@B@B@BB@@BBBBB@BB@DBFBD@@@BBBB@BBBBB@B@DDBBBDBB@@BBBB@D@B@B@B@B@B@BAF@BAD@BAB@BBD@B@B@B@DBB@BAD@B@BADAD@BABABABABABCBABABABAB@B@BAB@B@B@D@B@B@BBD@B@B@DBDBBA@ABCBEBEBE@E@CBE@CBABAB@@BF@BABA@EAA@AAAAACAAAAA@CBC@AACACCCCAA@@@AAA@@AA@AAAEAA@C@A@CBA@A@C@CBE@C@@@CBA@C@CBC@A@C@@BA@C@C@A@AB@@ILKP@BCDAFADCF@FBD@BDBBDDBBDDBDDBFDBFB@BAD@B@BBDBDBB@DBDCFEDEFADAFBBFBDBF@DBD@DBF@DBH@D@DBNNHDDFBDDJ@@FJFE@@@@@@@@@@@@@@@@@A@@@@@@@@B@@B@@@@B@@@@@@B@@@@@@@@A@@@@@@@@@@@A@CHBA@@@@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@A@@@@@AB@@@@B@BBBDD@D@@@B@@BAB@B@BB@@@D@B@BABBDBBBBD@@F@@BBB@B@D@BAD@@@@BBBBB@D@D@F@DBDDBDDFDDB@D@D@D@DBB@BDB@BBD@B@F@F@F@F@B@D@D@DBB@D@BBD@DBF@B@D@F@H@B@D@F@D@B@BBDBBDBDBDDBB@D@DABAD@BABCBABAB@D@DDBBBDBDBB@BBBBBDHBDAD@DADCFMTCHAJ@F@BBF@HBF@FBF@FBD@DAFADABCFA@CBAB@FFJDHADCDIBA@CLA\\@DHZDRCFEB@@CDAH@FDJ@D@HBFBDDJCHEHEFGDEDA@CBC@@BA@AB@BAB@@@@ABCDAB@FHP@DADGBCBAF@FCFEBGDEDMDAB@@B@DBD@B@B@B@B@D@@@D@D@F@D@BB@B@BAB@@@B@@@BB@B@BBBA@@BABAB@B@@B@D@B@B@B@BB@BB@BBBBBB@@@D@@@BB@DADCFABABCDABA@AB@@@D@BBBFFB@FBBBB@BB@B@B@@@BFF@@@BA@CAC@@@AB@B@BBF@D@BAF@BAB@@A@@AAEA@C@ABABCDC@E@CB@@AB@J@DABC@A@C@AAA@@A@AA@@@AB@@@BBBDRAB@BABA@GAG@G@G@G@EDE@EBC@EAE@EAE@A@@@A@@B@B@BAB@DAB@B@D@BABBD@B@@@BBB@D@DB@@BDBBBD@BBB@BBB@DBB@FBB@D@BBB@B@B@D@D@BBB@BAB@B@@ABABAB@B@B@B@B@FDB@B@BBD@B@BBB@BBBBB@@BBBBD@BB@BBBBFBD@FBDBBBB@DDDDBDDDBDBD@B@B@BBD@D@D@DBB@BBBB@D@B@B@D@@A@@B@@A@ABABADAF@DADAD@DAB@BABABA@CBC@A@@@ABA@@BAD@DAF@D@BAB@B@DABADAB@BAB@B@BB@@B@BBB@B@@ABABAB@B@B@D@B@D@BBB@BB@BBBBDBD@BBFBB@BDD@BBBBBB@DBB@F@D@D@B@BAB@D@BABABA@@B@@ABA@AB@BAB@D@B@BAB@B@BA@ABC@@BE@EBEAE@I@C@ABCDADAB@DEBABC@@FAB@BAD@HCD@D@B@BBDBBBBDBDDD@FBF@D@D@D@DAF@D@DAH@D@D@BBBBBDDDBF@J@J@H@D@B@H@B@D@F@H@H@HBB@D@B@@@B@F@BABBB@D@D@B@BAD@BC@C@A@ABC@AD@D@B@B@BCBAB@FBB@B@B@BABADGFKBCBABADAJ@B@FEDC@ABACACECI@C@CBAD@HBNFDBFBD@J@BAFAD@HAHAFADABA@@@@"]],encodeOffsets:[[[107750,28774]],[[107749,28772]],[[108149,29142]]]}},{type:"Feature",id:"510525",properties:{name:"古蔺县",cp:[105.812601,28.038801],childNum:1},geometry:{type:"Polygon",coordinates:["@@HAF@D@H@@ADA@ADGDGBGDIBGFGDEBCDGBE@ABCBCBCBA@@BCBEDADADCDAB@DADAB@B@@B@@@@B@@@@B@@@B@@B@@@@@@@B@@@B@@@B@@@@@B@@@@B@@@@@@@BB@@@@@@@B@@@@B@@@@@@B@B@@@B@B@@@@A@A@A@CBA@GB@B@DABAB@FCDADAB@BA@A@A@@AAACAC@A@CAA@A@A@A@AAAAGAECGEC@ACAEGECAI@CACACAIAEAG@AACAC@ACCAA@C@A@@@A@ABAD@@AB@@A@A@@CA@@AA@A@AB@BEBEFEDCFC@@BALI@A@AACAE@C@C@CBCBAB@H@B@D@DADADADAB@DD@@DBBBDDBDBD@@HDFDDBD@D@H@DBDDBBBBDBB@@A@@AA@AAC@@BA@@DAB@B@BAB@@@B@@@D@DBBBABBBBD@@ADADB@BBB@BABB@@B@B@BAD@DAD@D@FCB@D@DAB@DCHEDC@C@EBCBE@CBA@C@ABA@A@@BG@C@@B@BADAB@BB@@D@BBB@@H@D@DDDBBB@@@BABAD@BAD@D@B@BBB@BBF@DBD@DBB@B@DABAB@B@BABCBA@AAC@ABABAB@F@D@D@FAB@B@BBBB@D@BABADADADADADA@ABA@@B@@AD@D@B@B@@@@@BB@B@D@@@B@B@BBBBBDBDBBF@@@F@DBDBFBD@HBJB@@FDFDDDFDHDFDHDBBFD@@B@@@BDD@D@BB@@D@@@H@J@H@FBD@DBFBB@DBDBF@BAB@DCD@DAD@@@DAB@@@B@D@D@D@D@BADAB@HC@@FABABABAB@BAD@DAD@@@BADCF@FABADCDEFAFCDED@DAD@FAJAF@B@BCBC@C@CAC@@BCBCBCB@FAF@FAF@DABA@A@E@C@C@A@ABEBCBADADAD@HA@@FAD@FADCDCBC@EBCB@@@@C@A@ABADCB@FCB@BA@ABABA@A@@@A@@BCB@@@AC@C@A@EAA@CBC@C@AAAACAA@@A@AAABABA@A@A@@AA@@CACACACAAAACAAAACAACC@CAAAC@C@C@ABA@CBABE@@BABABADADADAB@DAD@DAB@B@BA@@DADAD@D@D@D@BBDB@@DBB@DBD@B@FA@@BAD@BADABABC@ABCA@@A@@BA@ABADCDCBADCDADABABAFC@@BA@@B@BA@A@AAA@@AA@A@A@ABABC@CB@BADCDADADABCBC@A@CAC@AAAAAAAACAAA@AAAA@@AAAAA@AA@AAAA@CAC@A@A@@A@EBC@C@EBC@A@C@AA@@C@A@A@@@AB@@A@@AA@@@AAAA@A@CAA@A@AA@@AC@ABABC@ABA@CBABAB@@@F@DBJ@DAD@D@D@B@BABA@CBA@A@ABABABAD@BA@A@AACAA@@@@AAEAA@@@AA@A@@@@@@B@@A@@@@B@@@@@BAB@@@@@@AB@@@@@@@@@B@@@@@@@@@@@B@@B@@B@@@@@@@@@BA@@B@@@B@@@@@B@@AB@@@@@@A@@@AB@@@@A@C@A@ABC@A@@AEACACAA@@AAAA@AAACAAAAAAAAAAAAAAACAAAACAAAAAAAA@A@ABABCBCB@FCB@BADABABAB@B@B@B@BBBBD@BBB@B@B@@@B@B@@A@@B@@A@AAA@@@@@CAA@CCACACAAACAEAGEIA@AIBABIAGAGEGCEAAAEEECEEEACCCAIEAAA@AAAAEE@@ECC@AA@@ECEAEAAA@AAA@@CCGAECGBG@GAEA@@AAAEAA@@E@CBIBCBE@CAC@AAAACEEAGCCAC@ADEBEBCBCDCBEBGAEBAAEBABEBABED@@GBEDGDEBG@EBCBGDC@CBIBC@EAEAC@GBCBA@A@A@I@GAC@C@CAEAEBC@@ACACBEBE@CAE@E@CACEACBCBED@BACCAAECGCGAIAGAGAGCIEKEC@A@EAC@EBG@G@@@@@A@GBCDA@I@C@CBCACAA@CACAGEGACAAAC@CAGCEAICGAECGAIAA@EAAA@A@@CBCDAD@DAD@DAD@DADADABA@E@AAA@C@G@E@A@C@@AAACCECC@@@AACAECC@GBCBABC@CAEACACAEC@C@E@AEC@@CCCACCBC@@@CEACCGEAAAACCCAA@C@C@GBC@CB@BA@ABEBIBCACBAAEBC@ABC@A@EBEBE@E@ED@@ABC@C@GBCBCACBE@CAECC@C@AB@BC@MCICGAC@CAAAEBC@EA@AC@IAECA@CACAACAC@C@EACAE@C@ABCBA@AAACCCAA@CAA@GAC@ECC@C@A@A@ADABC@AAAAA@E@E@CBCDEDGB@@A@CBCAC@@@@@ELAH@BDDDDBDDD@B@BAB@@AB@H@DABEB@D@B@FJJDF@FADCD@BDDBBADEJABBDDBLBDBFDDPDFDBDBDBB@D@DBD@BBD@FFAB@BADAFBBD@@B@BABA@AAA@A@A@BBBF@@BD@D@D@BABCD@DAB@BABCB@@CB@B@BABAD@BAFAB@BADABCDEFAD@BAB@FBFDFB@B@DCHEDAH@FBF@J@DF@F@H@DBH@DCDAB@@A@CBAB@@ABAB@DA@@B@B@B@B@BB@@BB@BB@@@@@BBBA@@B@BBDADCDAD@@@D@BBDDDF@D@F@J@D@B@BA@ABA@ABA@@BAB@@@DBBB@@@B@B@F@B@BADADCDE@E@E@GEEGA@CBCDAF@B@@@B@B@@@B@BAB@BAB@BAB@D@B@B@@CFCBCBA@C@A@A@@DBDLJBDAFADCDC@ABADAH@FDDHH@B@BC@UCIEE@EDGDCBEBCAEEAEA@E@EF@B@DADABA@MEI@G@C@ABA@@BB@D@BB@BCDEBGDEBCBABEBCDA@@@@@ABAB@BAB@H@DABCDCFCDCD@DADBBAD@BAB@B@B@D@DABAB@B@BBB@@BB@@@B@DAD@BADADABAD@BAB@@KHMBABC@A@AB@D@BBBJDDF@D@HCVAN@@AH@DAD@H@D@F@D@DBD@DBFBHBF@D@DAF@DAD@DAD@DBBVLD@BBRHB@B@@@BBB@@@@@B@@@B@@@@ABABC@@@@BCDAD@B@@D@FBFBDDFFDHDBBB@@D@B@B@B@@ABCBCBCBABAB@BBDBFBD@BBBBDDBFBBBBBBB@BBD@BBBBDBDFF@B@DBBABCFAD@FAB@DDL@D@DBFDDDDBD@HIPSLCDAF@DFHB@VJFBD@@B@@@B@BDBFDJBDB@@HB@@JDHDF@HADC@@DCDA@@DA@@BBB@@@BDADCHEJ@B@@@DAFAD@FAFAFAD@B@@@@AB@BABA@@BABAB@BAD@B@B@BA@@@@B@B@@AB@@@@@BBB@BB@@@AB@B@DAD@DAD@B@BABAB@D@BABAD@B@B@@ABB@B@B@@@DBD@B@B@BAD@BA@@BA@A@ABA@@@ABAB@@@DCDCFEBABABCBCBEDEBAHCFAD@JCB@B@B@BBBBD@B@B@BABA@ADADABAF@DAFADADAD@DBB@@B@@@@BBBBDB@@@@AD@BAB@@@B@B@B@@@BBB@@@B@@AB@@@BCD@@@B@@@@B@BB@@BBB@@B@@@@@B@@@B@BA@@@CB@@@B@@@BBBBD@@@@BBDBB@F@FBHB@@HBD@HBBBBABA@@B@BBB@B@@@B@B@B@B@B@@@F@F@J@F@@@B@@BC@@BCBC@CBEBABAB@D@@ABA@CBB@@D@D@@AD@D@BDFBBDBBBB@FDF@D@DABABAA@@A@AB@BABA@@B@B@@A@@@C@E@@@A@@B@BA@@@@@@@A@A@@@A@@@@DA@@B@@@AA@@@A@@@@@@@A@@BA@@A@@A@@@A@@B@BBB@@@BA@@B@@AA@@A@@@A@@@@B@@AB@B@B@@@B@@@@@@AD@FB"],encodeOffsets:[[108351,28988]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("眉山",{type:"FeatureCollection",features:[{type:"Feature",id:"511402",properties:{name:"东坡区",cp:[103.831863,30.042308],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@AAIC@AAEBCBAB@BBD@B@BCBE@A@@A@ABIAAC@CBEDEFGFC@E@@A@@CBCDABA@CAAAAC@CBC@@BABADACAA@A@A@CBA@ADABA@A@E@CA@AECCCCAAA@@ABAB@@CDCFCDIFG@EBG@EBCBCDCFCF@BAB@@ABGLGDIAGIAMAIAI@E@IBGFAJANIDGBCB@@A@IBKGAOBQFGBGBG@A@IBG@G@GBK@SCMAOCMEA@EDI@AB@BC@@@AB@@CBA@@@@BBB@B@@BBB@@D@@ABEDAAA@KBCBA@@BAFAB@BB@@BB@@BABABE@A@AB@B@BBBDB@B@BA@C@ECC@EB@@AB@BBB@BAB@@@@EBC@@@@B@BDD@BBD@@AB@BBJAB@BCBC@EBEBA@CDCBEBCBKBC@C@A@@ABCBE@@AAEAAAAAC@AAC@A@I@GAABA@ABA@A@@@C@A@CAIGCA@A@C@@A@AAEBA@CB@@@@CBCB@BB@EDABGBCBCBB@@B@B@BCBAB@B@F@B@DCBCF@B@BBB@B@B@@CD@@AB@@A@@@EAGAA@C@A@@@CF@BABA@A@C@A@A@C@CCA@C@IDCBEBABABAD@B@@DB@@@BBB@B@B@@@BADA@ABC@@BABBB@@BBB@DBHD@BA@@BABEHAD@B@D@BBB@@BBFDB@@B@BLP@@FJHPBJ@@@@DBB@BB@@AFGNEFABC@C@AACGA@A@ABGFABADBDA@C@C@C@CCAC@@CACACAC@A@CDAD@DADAD@BC@A@A@@A@EACA@A@A@A@AB@BCN@BADADAD@DBL@FBF@D@BADABABG@CBGHEFCDABAB@B@B@LDH@BD@D@FAD@B@@BBDABBBBDFLBBHJDBDBDBF@B@@B@B@@ABCBADCFAH@D@DBBBDBF@FBDBBDBDBPFDBHDBBFFBDBBBBBBBB@BB@@B@@@BB@@B@@@@@B@@@@@@A@@@@B@@@@@@@@@@A@@@@@@@@@AB@@@@@@@B@@A@@B@@@BA@@BA@@B@@A@@B@@AB@BA@@B@@A@@B@@@B@@@BAB@B@@@@@@@B@@@@HBLDJDPDFBB@BBB@BFBD@@BBFBFBNF@@B@FAB@B@B@FHBBJ@BBFBD@F@JAHAB@@A@ACCACAABABAB@D@DBDBBBDFLRBFDJ@@B@BABADCBC@CDABAHARID@B@BD@J@BEL@B@FB@@BABEFAB@B@DBFDHBDFBB@B@LBLBTBHBFBJDHF@@@@BAB@BBB@B@@@@B@@B@@B@AB@@B@@B@B@BBBBB@@BB@@@BBA@@B@@@B@D@@B@BBDB@@@B@@@@ABAB@@C@@@@B@@@@@B@@CB@@BBBB@@ABB@B@B@@AB@@@BBD@BB@@B@@BBB@@AB@@@BB@@@@@B@@@B@@B@B@@CBABA@@B@@BBBDBB@@F@DADCHMFMBCAAEI@A@AFAH@@A@AA@G@E@C@C@A@AA@A@EBEDC@C@A@AGE@A@@@ABC@@CE@A@A@C@EBMBGDGDEFIDCFAJCJED@J@HA@@DADAJEFCD@DBBBBD@@BBD@H@D@@@D@BABCBADADAFAJAD@HAFAD@FBF@BBDAD@D@DADGDAFCDABAHAFAB@B@J@DAD@DADA@ADE@@BAFAHAH@B@B@B@@B@J@@DBD@JAHEBABEAGAGAIBGBIBKFEDAF@FDDFBDAB@D@JFNDFBFJLFBJ@LAFAFAPGD@FAHCFCDAHELGPGHG@O@GDEDCHIFIDIFOBEDK@K@M@GAEEQAC@A@AAACCCCAAAAAAACBCBCBA@A@A@CBCBABC@@@C@@AAGECC@A@ABABA@A@CAA@@AAAAACAA@A@ABCFG@@@A@@A@ABABC@@@AAA@BA@A@AAAEACAA@AA@A@ADCDC@@@A@A@ACEAACAC@EBCHABC@C@GCEA@@CBC@E@A@ABA@@B@B@@@@@BABAAC@AC@A@CA@A@A@@@@BCB@A@A@C@A@A@@@ADCBAD@D@D@BAD@BADADABAHAD@B@H@B@B@@A@ACGBCBCBCDGBA@C@C@C@GBE@ABC@ABCBA@EBABAB@B@BC@@@CAAEECECA@A@@FECB@GCECCECECEASFEDEBCBA@IBIBCCC@A@AC"],encodeOffsets:[[106404,30622]]}},{type:"Feature",id:"511403",properties:{name:"彭山区",cp:[103.872949,30.193056],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@B@HADABBD@B@BBDDDH@AB@BADA@@@A@AA@@@A@@@@@A@@A@@BA@@AA@AA@@@AAC@AA@@A@@BA@A@A@BA@@AAAA@@DA@@@A@@@@@A@@D@@@BABA@@@@@A@@CAAAA@@@@C@A@@@AB@AA@@A@@AA@AAAAA@A@@@@AA@@B@AA@@@@A@@A@A@AAA@AB@@@BCB@BAB@BBBA@@@@@B@BB@@A@@@@@@B@@BB@@A@ABA@A@AB@@@@@@BBAF@B@BA@ABC@A@@BAB@FBD@@@BB@@@B@FCDAF@@@BB@DBBFBB@F@@A@@EAA@@A"],["@@BCBABCMCED@DIBEAGEFIAAEAICIAEIEGGOCG@GJIHC@GAACC@SAKBCB@FNBBD@BCBEFMHAADAB@BLGNGVMAGAACGGIHGBA@A@ABABADABCDAD@DA@@JAH@FCDABA@CAA@CEECAC@KAA@@@AEAGAE@@DCJEBABA@CCEAE@ABCDA@ABAEKGIEGCIEIGHOHKHGFCBEDGDEBC@OHEBEBKBI@EAIKAECEEM@I@CBAACCEECE@CBEFALAJAHBJBHBHAFABGFIBC@CA@@@I@AA@A@A@G@GBEBAB@@CF@BCBCBC@CBI@A@A@EBGBABCBEDCBCHCBC@C@CBAAE@EAC@EBGBC@IBEBCBCBABADABC@@@C@G@C@AA@@ACAACAC@EDIFCBCB@@GBI@C@IFIDEBCDEJCFCHAHAN@F@D@B@BDF@@AD@B@@@BHF@B@B@DCDAF@F@BBBB@D@D@F@H@B@@B@BG@EB@B@BFJBBADENGNCDCBE@DFBBD@BAB@@BBBDDBBB@D@JCB@B@B@@BBD@D@DILIL@D@B@BBBDBF@H@B@BAFEB@BBDBB@FA@@BB@N@DDDB@DBH@D@BADAB@B@BB@@@D@DADAD@BB@FJDBDDLDFDJFNHFBD@FAJCF@B@J@V@DBF@FAN@FAB@B@@@B@DBDBDBFBB@FB@@@@@@N@DBDBDJ@BD@@A@C@I@ABADADGBABA@ABEBABA@A@A@CDAB@HBFBHBH@D@HADABB@DBHBDB@A@BBD@DBBD@B@DABADCFAB@DBBBDDBDBB@JDDAF@D@FADAFBDA@@@A@@@AECAACAAA@C@ABCBE@@DCBADG@ABEFCDCBABADCDCDADAD@D@DABCDA@C"],["@@@EBEDIDGAIEKEGECUNMHKH@ABABCGBENAFADC@AAEMA@ADBL@TDDBB@HGDIJ@HDHHPFHFJJBJDFBBBLDLDNDDABADAB@BA@A@C@@AMAA@@@EBCBCBCDA@@DABA@@DABCBA@C@ABAA@@E@AACCAICCECCAE"]],encodeOffsets:[[[106186,30997]],[[106462,31043]],[[106469,30986]]]}},{type:"Feature",id:"511423",properties:{name:"洪雅县",cp:[103.372863,29.90489],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@A@@D@@@BB@@B@@AB@@@B@BB@@@B@B@@@@B@@ABB@@@B@@@@@BB@B@@@BA@ABA@@BA@@B@B@@@@@BAB@@@@@@@B@@B@@@@B@@BB@@B@@BB@@BB@@@@BB@@@@B@@B@@BBAF@HABABABE@A@C@CBK@CAC@A@A@C@@@CAACAC@A@IDEBABA@A@"],["@@A@@AAEACAEBAAAAACCAACE@AA@A@ABE@GACCA@CECEAAAAABKFGDABG@IBK@EAI@E@I@EAA@C@EBA@@AAA@KBABC@@AA@ABC@C@@AG@A@A@@BEEKACBCBEDGHEBC@C@A@CACCGACAAC@ABABAH@B@F@DCB@@CAA@A@A@@BABA@A@C@AB@B@@@B@D@BA@@@A@A@CCAAA@A@ABBB@B@D@@ABADABCDADADBBFFHB@B@@ADCB@@G@C@CBA@AHA@ABAA@@A@@CGIIGGCCBCBCBG@@@A@@@A@@BA@A@@@@C@@@@A@A@ABADA@ABABA@E@@@E@@@C@CAA@A@@@A@A@@@A@@@C@@A@@ACACA@C@EBA@@AAA@IACAEAAECCAEACCCECA@EAE@C@ADD@AAC@AEEAEACAALGBABEACAACAKECAGIAAAA@AAC@C@M@EBEHKAAEEEEAA@AAA@ABADELGBA@A@IACAABA@@JA@BD@@@B@@@@@@@B@@@@@BBDB@@@@EF@B@DB@F@DBHBFBB@B@BABAA@@AA@@AA@A@@@A@@@A@@@AA@@@@A@@@@@@AFEBAD@D@BABCBA@ABAB@F@FDFBDDDBBDBJBF@DADGFAD@BBBDBD@D@DABADA@ABAAAAAAC@@BADEBC@EDABC@CBCD@D@HDD@DBDABABEBGGDC@CACAAA@CGECCKCGCICECICC@ACAC@EBADO@CAAIAAAACCIACAAACA@E@EAECEGACEAG@EACA@CBEAA@AAAIAGBODEBEAEACCGE@ADCBCDKACACBKACA@AAA@A@A@@@@@@AA@A@@@AA@@CAAC@@@@ACAEAE@CAA@A@@BAJO@@BA@@DGDEBC@@@A@@BCDE@@@A@@EE@@A@AA@AAC@@AA@A@A@AACCEAAAA@CB@BADADAB@B@D@F@@@@@B@@@BBB@@@DB@@B@@@@@D@DAJ@F@FADAACCCCCGCECACEI@AAE@AACC@C@AAGCEAKCM@IBMFOAM@MBMBOBKAKAEAAEEGAQ@IAMAMCG@CAK@IKEQCO@MDEBEBEDCDEFCBABGDG@E@E@EC@C@CAAEAE@CBCAGAEAC@EACCECE@EDE@GDEBEDCFGFGBEFCDCDEBE@CCG@GAMFIDGBG@C@@AEAGGGGCGCEGIIGIEGCCACCAECCCEECAC@@BCBC@ECBCBC@GBI@MBQ@QAG@E@CBMDKDSFUFQFKBMLMJKLKLEHCDKJGHGDIHGFGH@FBHFH@@JNNNRNBDBHBFBDFFFHFFLRHHLHHFJDJDPFLDJFHFFDFFDFBFDJAP@N@NFRFPFJDN@NCJAL@FBDAJBNAN@PBFFFFFFD@D@DCDEBADAF@FBFDD@D@DAFADADCDAFDBD@@BL@fRF@D@@@@CB@FENGHEFAJAL@J@FBBBBBBB@@@@BFDDBD@D@BBDBB@@BBB@@@@@@@B@@@@@@@F@FBB@BADABCBA@C@ABA@@@@B@BAB@B@BAB@BCBCBA@CACAAAA@A@CDADAD@F@F@D@DADABCB@@@@A@C@@BA@@B@@@@AB@@CAC@A@@BECC@EBCDC@@B@D@D@BBDDBBB@@@LFHDDBB@BABABAB@B@D@B@DBD@B@D@B@D@@@@@DDBBBBBB@BBFBDD@BABABCDCBCDAD@D@FADEFEDCLIFCHALAJ@DBBBBD@BEH@BAD@DA@@BCDCB@B@B@@@B@B@BBBBB@D@DABAB@D@B@B@@@DNLFFFB@@@B@D@B@BCDEFAB@DABABADABJBFBHBDDFBDB@DAD@DBD@BABCBIDGBCBGBA@AB@BAFBFFBFDFBHDFBHBFBBF@D@FAHAFABAHAFABCHGJCDELABABCHCJITAJAFCJENGNAHADABKJKFKHKFGHGJCF@JDJHHBBBBFFFJHDNFLDLBHBJBPFNBFBFBDBPNFDDFFDDDH@H@D@D@DA@ABCB@B@B@BBBD@BBDAD@BCD@B@B@DABCDAFAD@@BB@BB@B@FAJ@H@F@D@FDBBFDB@BBB@DAD@F@DDBD@D@DAD@BBBBBBD@BBDDBDBBB@BA@ABEDID@@@B@B@@@BD@BBJ@DBD@BB@@BAB@B@B@BFBB@@B@B@BA@C@ABADANA@A@A@ADADIFIBG@I@@BIFKDEFAF@HBDFDHFLHLHJJDLBJCDAJCPGFAFCBAHELGLGFCFCPGBAPEHADAD@FBDBD@RFDBHBDBHEHIB@JKHCLBP@JAPGLDFFJLLFHAHGJKLMHKHKDAFIHIFKBE@@HKJIBC@AEE@@AA@A@A@A@ABAFAAA@A@C@ABAB@DANBBA@@@@@A@@@AECA@@A@AB@@AA@@@@A@@A@@A@@A@@AA@@AA@@@AA@@@@A@@AA@A@@@@A@@AAAAA@@@@@@BCAC@AA@A@@BA@ABAB@B@@@AAB@@@@@@@@BB@@@D@@@@A@@B@@@B@@@@A@A@A@@B@@A@A@@BA@@@AA@@A@@@CC@AA"]],encodeOffsets:[[[106002,30560]],[[105998,30559]]]}},{type:"Feature",id:"511421",properties:{name:"仁寿县",cp:[104.133995,29.995635],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@AC@B@@@@BB"],["@@B@@@@A@@A@A@@B@@B@@@@@@@"],["@@CACAAAA@EACAAAAAG@A@A@AACEACA@CDA@ABA@AAA@@AAAA@ABABABA@A@AAAA@A@A@ACAC@A@A@@A@A@AAAAACAA@A@AAA@C@AACACA@A@@BC@CA@BADAB@DCBAB@JEBEBA@EAAAACAAACAG@A@A@CBEB@BEDAB@DABGFA@CBCA@AA@@AAEAAA@CAAA@@@E@AA@@ACAA@AAA@@A@C@AAA@ACAACAA@A@C@AAA@@A@@AC@@AAA@A@A@A@AAAAACAAAAA@CAAA@A@ABCBA@@ABC@ABCAA@AAACCAC@A@GAA@@AAC@AA@A@@AA@ABC@AAAAE@AAACAAACA@@C@A@ABABCBA@A@C@CAEAA@A@A@@BA@@B@BDBBBABA@@@C@C@A@CBCBAB@@@B@B@BB@HB@@@DA@ABG@A@E@AD@B@@DBDBBB@B@B@BC@C@@@@@@@@B@@@BA@A@A@A@@@AD@D@B@B@BBBBDB@@B@BADABA@CBABA@C@CACCA@A@A@ABAA@ABC@E@A@@AAEBA@E@A@A@AAA@A@A@AB@BBDBBAD@BABA@A@AB@@ABBD@B@BA@AB@B@D@BBBB@BDDDBB@BABABA@A@AAA@AAAGCAEAG@AB@DBNDH@@@DBB@BBB@@@BB@AB@BA@@BA@@@ABA@C@CA@@A@ABAD@@CDA@A@A@A@ABA@CBC@G@CBC@E@EAA@AA@@@MCAA@A@CDCDA@C@EAC@AB@@@BADABMDABCFA@ABAF@FADCDCFC@A@A@A@@BA@@@A@CBABBDBB@DABE@@@IDA@A@GEA@CBA@ABAD@@@DADADABABC@A@ADADCDA@ADA@@BAD@B@FAFGDABAB@@EDABAB@B@BBBBBBBH@B@D@BBBBBD@BAB@DBB@BDFBBBDDDBDB@JBBB@F@B@D@B@B@B@@ABA@E@A@CAAAA@CBAD@D@@@D@D@D@BABABCBEBA@A@EBEAC@A@A@C@A@ABCBABA@AAAA@@C@A@AB@DBF@B@BD@DBB@DFDFBBBB@@BBBF@D@B@@@D@B@B@B@BAD@@CBCBAB@B@D@B@BA@A@AAA@AA@A@A@@CAA@A@EDCB@B@BA@A@A@@B@BA@ABCBCB@@EAACCAC@A@EAA@CBABC@EBA@CAA@AAAAACAAAC@AAAAAAA@@AAAA@AAACAC@A@AAEA@AAAAAA@A@C@ABA@ABADA@CBCBA@CB@CCC@@A@ABABBJ@JJFDHJFNDBBJDLHFHBHANGBECGEGEI@GBCDGJOXABEF@@@BDBDFFFBB@D@@ADA@A@ABAB@FABAD@BAD@BAF@H@D@D@DABCHADADADDH@B@BA@A@G@A@C@GBABCBCBABC@ABC@C@C@ABCD@B@@@B@B@D@B@BDA@A@@B@B@B@@D@BBDD@BBBA@A@@@@@A@AB@BAB@F@D@DA@@FBHDD@D@BADGFAD@DBBBDF@B@B@B@@CDCD@B@BBBB@DBFBBB@B@BABB@BB@@D@BABAB@@@@B@@EHAD@B@BBBBDBBBB@@BB@D@BABAB@B@BDDHFBB@@@D@@ADABAD@D@B@BABADADBDBBBBBBDDDDBB@B@BBDFRBF@H@N@LCLAFEPCJEJGJCDCF@H@PFJDJFHHJFLAB@BCBAD@BBFDF@DABABIFCD@@BFBHBF@@B@LBD@DBFF@DBB@DABCBEDG@IB@@CBC@CBADCBABAB@B@BABGHHJDHBBBHFDFHFLBJCHCJAF@FBFDDDFJDDBBD@B@FB@@@@@@@B@DCDCDCBCBA@@DCBA@@B@B@FA@@HB@@DB@@HD@@BB@@DB@@D@@@DAB@DB@@FDBBD@@ABC@E@@@@BA@AD@@@FDLD@@LD@@D@B@@@BC@C@@@ABA@@BABA@@B@@@D@BB@@DD@@BBB@@@B@BC@@BCBC@@BAA@DC@ABA@@DA@@D@DBDBB@B@BA@@BA@A@@AA@A@@@A@A@@B@@@BAB@DBFBA@D@DABABCBABEBABA@@DAPCDAB@B@BABC@@@A@@@CBCDA@@DA@@D@D@@@JBBB@@DD@@B@BBBBHBFBBBDB@@BBFDDD@@BBDB@B@@BB@D@H@@@B@D@D@A@F@B@DBB@D@B@@DDBBB@@BDLBBBDDBDBBBBBBD@@BBBH@B@D@B@@@BBDDB@@DB@@BB@DDDA@BDBDBHBFAF@B@BDBBB@@BBFFBDBBBD@@@@BBB@D@B@B@@ABEBADABADG@CB@BAD@BBFDDBBB@@D@FAFCJAHCF@BADABC@C@@ACAAA@AA@ABG@C@G@C@AKI@A@A@ABC@C@@AC@@@A@@@ABADADCB@@@BBFFB@B@B@@@@A@C@@BABADCBA@AA@ACCC@@A@CA@@@@CCECAA@A@A@ABCBADAFA@@B@FGDA@ADBD@B@B@LE@@D@B@JB@AB@BADGHK@E@CAA@A@A@A@ABABCB@@AB@FDDBB@DBB@@ADCHEBCFCJEDAF@F@B@BADE@CB@BAB@B@D@NDFBFBB@@@BABCBADAB@@ABABAD@HAF@JAB@BA@@LGHEFABABA@AAAAAA@A@MBC@@@ACBABCFGHAJCHEXCB@@@FGBABG@A@CDQ@C@ABC@A@AAACGHIDCDAB@DAJ@DBD@D@@@BA@@@ABC@ABCDADCBG@A@CBADE@AGCA@@A@AHIFCLGD@D@DBB@B@@B@BAB@B@BB@@BBB@D@BBB@BNEBBHBB@DA@A@A@@@A@AHEDCBA@@AA@AB@@ADAF@F@DBFDD@B@JABABABC@AAAAAICAA@AB@B@B@D@DAB@@A@AEEACEAA@CAG@A@AA@A@ABE@CDCNKDCBA@C@@A@@@E@KDGBC@EAAAAAACACAC@A@A@CBAB@BAD@B@FBD@B@@@@A@@AAOMAAAE@@@CBABAB@BA@@@A@AAACAAIAA@A@A@A@AAI@@CAAA@@@A@@BAB@DC@@B@D@B@@BB@BBBBB@DAB@DA@@DAB@BA@@FCBADC@CA@EA@AAA@CAA@AAA@@AAAEAAAAA@CC@A@AAC@AAAAE@E@@ACA@@A@GBC@@D@@BBBDBB@@@B@@A@AAA@A@AB@BA@@B@HAH@BABABC@@B@@C@A@AAA@@AAEBA@A@AAA@@A@ABADCBAB@BABABABEBCBA@@BAD@D@@@BA@A@A@CBCAC@AACAA@ABA@ABAD@B@FAFABADC@@BCB@@A@A@ACECCCAA@A@@A@C@@@A@AAE@ABC@ABA@ABCB@D@B@BA@A@@@AAAAAAAA@EAC@C@@ACCCEACCEAA@AAA@B@@@@A@@@@@@@@@@@@A@A@@B@@B@@@A@@@A@AAE@A@CAA@@ACAEACBG@CBEDA@C@A@A@A@AACAA@EAAAAACB@BADADADABABBDBB@B@BDD@DBB@DAB@BADABABAAAEEAAAAAA@A@A@AAA@AAAE@@AAAAA@AAAECA@A@CBA@A@AC@AEAA@ABIBAA@@CGCECEAACGCEAGAA@CAA@EBA@E@EBAACAAAACACAEAEC@AAC@A@A@AC@A@E@A@ABCBC@EACACBC@CACAAAA@C@ABABABC@A@A@@BABABAB@DADCHABAB@@C@A@CA"]],encodeOffsets:[[[106992,30571]],[[106735,31009]],[[106902,30462]]]}},{type:"Feature",id:"511424",properties:{name:"丹棱县",cp:[103.512783,30.01521],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@D@HBB@@B@@BAB@@A@@BAB@@@@@BABA@@@A@@CAGAA@E@A@@@@@A@@@AB@BAD@B@@BB@@"],["@@BAB@BA@ABCB@B@B@D@@@@AACBCBAB@DA@CCECAA@CAA@AAAA@AAAA@AAA@A@ABA@EAC@CBAAAAAA@AA@CB@@C@CBA@ABA@@@A@A@@@A@@@A@A@A@A@@@@B@BA@ADA@A@C@A@AAA@AB@B@@BB@BA@@B@BA@ABE@@@ABAD@BC@E@CAAAC@@AEBAB@B@B@B@BBB@@FF@BADIJGL@@AFELGJEJCBGLGLKNILGHGBKEIKEEKCOHIBO@KAGDILA@GJGFCAGACAQEC@CAEAC@CBGBOFABOHEDEDKHKHGFABEDEBOHIDCBIDKAICGIGKEKCGCEGAE@EBCFELAJ@@@JAHEJCJCB@B@B@BMBCBAB@B@DABA@A@@@AAAEA@A@A@ABBD@@BFBBBFBBA@@BEDA@@B@BDB@B@DBBHB@BBJDJDJBDDD@@BFB@@BFFHJPNFDLHBBB@LBB@DBBBBDBJFJDDHFB@B@DABABA@AAACG@AFEJEDABBLDFBD@JAH@D@FBBBBD@B@H@BBBDDBBBFFP@@F@B@@CFO@A@A@@BAB@DBHDB@BAB@DGBABA@@BB@B@H@DFJBBBBB@B@BADCDAD@F@DBFBBBDAH@FDBBBBBBDDBDBBBBDDDD@BDBFBBBBDB@DBBAHMB@B@D@FDBB@B@D@B@D@BBB@B@@B@@@B@DABA@@BA@A@AEC@A@ABCBABEFGBEDCB@HAB@F@FBDBDDDFBFBD@DAD@@@BAB@DCDGFEBEBABCDEL@DDDF@HBFADCDCD@B@@@FBBBB@@ABA@GACAE@ABADADCDA@ADG@CBC@C@CB@@@BBB@FLDFNRNNDBB@HC@C@CBGDEBCDABA@@@A@AA@E@CACACAGIAAEKACAABAAC@AA@C@EBC@C@@ACG@K@A@ABABADCFEHGDAH@BABABC@A@CAE@EAK@CBCBCBC@ADM@ABAB@B@B@B@BD@F@BB@B@D@@ABCBC@CBCDCB@D@DBDBDB@@BDDDD@D@D@B@ACBCBAHEBAB@B@DHBBD@D@BAFEHMBE@@AAA@CA@@@@AIGOEI@@KO@A@AA@ECAA@@AA@A@C@ABCFGBA@AB@@AGCCAA@AA@@AABA@AD@BAB@BC@A@@@A@AAA@A@@CA@@@ABCBABAFADAJCD@B@DDD@B@B@D@B@B@BA@ADE@@B@D@B@HBFB@@B@@@BA@@DC@@@A@AAA@A@ADEDA@C@A@E@ABADA@A@A@AA@@AE@AABCB@BABABADC@E@E@@AACCECAAIEA@AAA@ABCB@BAAEA@A@@@A"]],encodeOffsets:[[[105920,30849]],[[106058,30612]]]}},{type:"Feature",id:"511425",properties:{name:"青神县",cp:[103.846688,29.831357],childNum:1},geometry:{type:"Polygon",coordinates:["@@AC@C@CAAABA@@AB@@AA@@BA@ABC@EBCBABAB@BGNA@ADABABE@E@C@A@@@ABABAB@BCDADA@ABA@AB@@CBC@A@A@@A@ADABC@A@AAA@AA@AAC@C@A@A@A@AACCAAA@@AC@C@CAAAC@AAC@C@AB@BAD@D@F@DB@BBB@BB@B@BABAF@D@D@BAF@@CHABABABA@CBABAB@DABABEFABAB@BBB@BBD@DCD@D@B@BBD@@BBB@BB@@D@FCB@BAB@DADBB@DBBBBBDA@@D@DBDBFDFB@ABAHADCJED@@B@BAHAD@B@D@@DDBDDBB@B@DBD@B@B@BB@BAHDD@D@BABCBC@CBA@AB@DBBBF@B@DA@A@E@C@A@CBA@ABAB@D@D@BBB@D@B@BBBBBBBBDBB@DAB@BABA@ABCBAB@@@B@BAB@BBBFD@B@D@BABA@A@GACACBA@IDA@@BB@BFBBBBB@FBB@B@@BBBEHAD@@ABGF@@J@FCB@NFPDNBTDL@HAH@H@JAB@H@HAHAREPAHBAL@J@BA@ADCHMJIBEBAH@J@FBJBJBNHJJBHCHKBA@@BA@ADEDEDCDAFAH@FAH@JEDCDEDC@@BABA@@BBDBDDFD@BDBF@B@B@BABCB@DAB@B@B@DBCBABAB@@AD@DBDBBDBB@BADCDA@@@BF@D@HEFEFCDAD@BBAJ@B@BB@F@DA@A@AAC@ABADAFABBD@BJ@BBBBDB@D@DDJAJAB@DAFAFCTEFBFDFDDDDF@HDABAPWHIDCHAJ@HFHFFDHABMAGEGKGICAAMCIECGIE@IAI@@A@@AAGCCAAA@AAGAAA@A@CFA@ABABEBABAFAB@BC@A@@@C@AABKCA@GAABA@ABA@AB@BABCBA@A@@@CAAACAC@A@GAA@AA@A@EAI@ADGBABAHGBA@AC@A@AAA@AAAAEE@CAG@ACAGACAGC@@A@ECA@@AEBAAAE@ABCBALBBABABIAEAAC@GFA@CAAAEO@C@G@E@A@AACAAAAACCAC@C@C@I@E@CBA@I@G@EBABEBEBCAAA@CAG@CC@GCCACCAAAAA@A@C@A@C@A@A@ABC@E@EAC@CAA@AAKIKIEEC@ECA@C@CBA@C@CCIAG@CAAA"],encodeOffsets:[[106269,30424]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("绵阳",{type:"FeatureCollection",features:[{type:"Feature",id:"510703",properties:{name:"涪城区",cp:[104.756944,31.455101],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@@BAKQBBNG@@ECAAGAGGAI@GBEBECGGGGACAGAABABAB@BA@ABC@C@@@C@C@E@E@ABAD@DAB@@@DADCD@@NBJBBHARANKFANBJFBD@@HFBJBDP@@DBBBBBDDLILIFAH@EMBA@E@@BABABADA@@DAA@"],["@@ABCBA@A@@@C@CBABC@CBGHEJCD@@CBCDCBC@@@EBABCBC@CD@BABADABA@EDC@CDABAB@BA@CB@@AH@DABBF@@@@@@B@B@BB@@@BCF@@CBEBCBABCF@BABADAB@@@BB@@@B@B@@B@@@@AB@@@@A@A@AAA@AAAA@@C@A@A@@@ABCB@@ABCBCB@@@BB@@@BB@@@DA@@BBBBD@BBBB@@BBB@B@BBDB@BABA@@BADAB@DBDDDDD@D@@@D@DAHGBAJI@@@CAAACDCBCB@DADAB@D@B@B@DADCBCDCFCD@D@H@H@DDDBFF@@B@DBJAHABADADAFCFCFADADACOIAEA@GC@EAAIBMLEBMBQAGIAMA@@CB"],["@@BDDHBDFDBDBBDFBBFDHFJDDDDBD@BBH@HBB@B@BB@BBD@DBBBBBBD@BBB@@BADADAB@@@B@BBDBD@B@F@B@H@AB@B@@@BAB@@@BB@@B@@@@@B@@@@@@@B@@@BBD@BAB@BB@@@@@D@B@@BFBBBBB@F@F@DB@@@H@BAB@@A@A@@BB@@BB@B@@@D@B@B@BH@BBB@BA@BB@@DF@B@B@BC@GDQHCD@B@@@BD@DBFBDBB@@B@B@DB@BBJJFBBBB@B@DADADAB@B@@@BBBB@BBD@BBB@B@B@B@@@B@@B@B@B@B@BBDDBB@@B@BBFBB@@@@AB@BB@@@@@@@B@@D@B@@@@A@@B@@AB@D@B@F@@@D@AC@@AAACACAAAAC@AAEAAAAA@CBGBCBC@CBEBCBADMDCBEDEBC@E@A@EAEAE@AAE@C@CDIBA@AAA@AAAACAAECCAAA@A@A@AB@FAFAD@FAFADAHAB@FAHABAHEFCBABA@A@@@C@ABEBA@CBEBC@ABC@C@@BCBA@ABA@C@A@A@C@A@A@ABA@CBA@ABA@@BABADC@A@@B@BADA@@FCB@B@@@HBDBB@JAB@DAF@DAB@@ABA@EBG@GBGAC@@@A@@@@EGAACEA@@A@C@A@CBABA@@DAD@FAB@D@DCDABC@ABAB@BCFCDCBADABAD@DABCBA@AAE@A@ACEAGAC@CACBI@C@G@EBCBC@ABADADCDADADAB@DAFAF@D@F@D@FE@ADA@@@A@CGKCECCCCECCA@@CBAB@@A@A@C@A@AA@CBA@CBA@AA@AACCEAC@A@A@G@C@AAAAACAG@@@@@@A@AAA@AAA@@ABADEBCBEC@E@ABG@A@C@CACAEC@@EACCCAECE@GAC@E@E@EAEAEAGAEAEACAECACABA@C@@AC@AA@AAAACAC@CAAA@A@ABA@A@@BABABA@A@AAACA@@AA@AAABE@EBCBEB@@A@C@@@AAAEACE@I@KAE@A@ABBF@DADAFAB@FABADCBADBFDDDDAD@BADABCBABABAB@@ADABABABABC@@BAB@B@D@BBF@DB@@BAD@FAD@@AFABABA@A@E@CBA@CB@@IBABCBC@A@AB@@A@EB@@A@A@ABA@A@C@ABGB@@A@@@ABABA@ABEDAB@@CDA@EBC@A@GBEBEBEDAB@@ABAD@D@B@B@BBBHNBB@B@B@DA@@B@BAB@BABABAFAB@H@@AD@F@BBB@@BB@D@BABHBDBHBHHDHAFAF@HBJHHHBBBFD@@MHAALR@B@@CB@@CBABABAB@@@FABFNG@EBKJKJBDBDDD"]],encodeOffsets:[[[107119,32205]],[[107068,32176]],[[107100,32238]]]}},{type:"Feature",id:"510704",properties:{name:"游仙区",cp:[104.766392,31.473779],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@DA@@BAB@@@H@BDB@BBB@B@D@B@B@BAD@B@BABABCDABCBAB@BA@ABABABEBC@CBAAA@AAC@AAABA@A@ABAAC@A@C@C@C@C@A@C@ABC@A@@@@@@@C@ABEBABA@CBCAA@AAACCA@CAA@ABAB@BADADCBCBE@EBABC@E@AA@@AFEDCDCFCFCHGLCAA@@@A@AB@@ABA@@B@B@@B@BD@DBBAD@D@D@D@@BF@D@B@@@@@@B@@@@@B@@@@@@@B@D@@@B@B@BA@@@@B@@@@@B@@@@@@@@@@@@B@@@@B@@@@B@@B@@@@@@@@B@@@@@@@BB@@@@@@@@@@B@@@@@B@@AB@@@@@@@@@@@@A@@@@@@@@@@B@@@@A@@@@@@B@@@@@@A@@B@@A@@@@@A@@@@@A@@@@BA@@@A@@@@@A@@@@@A@@@@@@@AA@@@@A@@@@@@@A@@@@@@@@@A@AB@@@B@D@@@D@@B@@BB@@@B@@@@@DB@@@@@@BB@@B@@ABABA@@B@B@@@@@@B@@BB@@D@BA@@AEAAB@@A"],["@@@@AEAC@A@ABA@ABCD@BABC@AAA@CBA@C@A@A@C@A@AB@BABBD@D@DBDBD@B@B@B@@AB@AA@ABA@ADCB@BC@A@@AE@C@A@ABAB@B@B@BA@A@A@@AAA@AA@A@A@A@AAAA@AA@ABC@C@A@AAAAAAAAABA@AB@@CAAACAAB@B@DAB@@@BBB@@BDDBBD@PBB@BCBA@CAC@C@C@CAEAAAA@A@AB@@ABC@CBA@ADAFBF@BAB@BC@A@A@AAAACAABCBCBAD@D@DAB@BAB@@C@@AAAA@A@@DAB@BABABEBAAAA@AAAAAA@C@A@AAA@CAA@@@CAEAAAC@A@ABCAC@A@A@CCAACAC@A@ABABCBC@A@A@A@A@C@CBC@A@A@A@G@C@A@A@AAA@AAA@A@C@C@A@ABAFCDCFAFADCBC@ABCB@B@BBBB@B@B@B@BBBDDBBD@@@B@@@@A@C@A@ABADEB@@@B@B@DDB@B@@@DA@@B@BBB@BBB@@@B@@ABB@BBB@D@B@D@DABAB@BB@@@B@B@B@BBBBDBB@D@B@DABAD@D@DAD@DADADABADABABABABG@ABADAB@D@D@DAD@D@@A@A@@DC@C@AAKEEA@E@C@CA@C@ABCF@F@HAD@BA@CA@AAIAOMCAABE@C@CAA@CA@@AABC@@B@@AA@@A@AB@@AAAAA@AA@ABABCBABABA@AAA@@@CAAA@ABABCBC@A@A@CAAAAA@CAA@CAA@AA@C@ABA@CB@@A@C@A@A@A@@BADAD@@ABAAA@AA@@@AA@@@@@A@C@@@@A@A@CBAB@@AB@@@B@DBBBDAB@@A@C@AACAC@CAAB@A@@AC@A@@A@A@A@AA@@@A@A@@@@A@C@CBA@A@A@@A@A@@AAA@@@AA@A@A@E@AAA@@A@@BC@A@@@AAAAAAA@ABCDA@@@AAAA@A@A@@@ABAA@@@C@ABAAA@@AAAA@A@@BBBAB@B@@ADABA@A@A@@A@@BCAAA@AAAB@@ADABC@AB@@ABB@@BABA@A@A@ABA@ABAAA@A@A@ABA@AA@B@@EDEFAF@BBBBBDDDDA@A@C@EEEAABC@@B@@ABABADAB@@@BBBB@B@@BBBABAD@BC@@BA@AAC@@AAA@@@@A@@@A@ABABA@A@@BC@CBCDABADCDAB@@A@C@A@CA@@A@CB@@A@@@@@@@C@EBCD@@CD@@AB@@AF@B@@@@@BBB@DBB@B@BCBABABEBE@E@C@A@A@CBA@ADCDEDCAC@AACACCACCAAAE@A@A@CBABABABCBABA@@AA@AAA@A@CEGGGCE@EACECACAC@A@ADERABC@A@CCEGEEAA@ADAF@DABC@AAAACAABCA@AAC@A@ADA@A@AACAAC@A@CDE@C@AA@CACBC@CB@AGA@A@ABAAAAAECOQCC@@AAAC@@AE@CEFC@E@C@E@EBCBA@CBCBCBCDCBAB@BADAD@F@H@DAJBD@DBDBHDF@B@BBF@BABADCBC@ABCBABCDEDADA@AB@BADCBCDC@A@EBC@CB@@ABAB@D@B@D@BB@DFBBFH@@@@@B@@BDAH@HAH@FAB@BA@CBE@CBA@IBA@CAGA@@A@A@ED@@CBABA@@@@BCDABAB@@AB@BAB@DAB@B@B@B@D@B@B@DAB@BABAD@@@DAD@BADAF@DABAF@B@D@@@BABABEDGFABGBEBA@GBCBEBEBC@EBEBA@@B@B@BBBDBFDBBBDBB@BBB@BABCJ@D@DBF@BBFBF@F@B@FADCFAFCDCNABADAF@DADADAH@DBBBBFBBBD@BBBBBDBDBB@@BD@@@AD@BA@@@@B@BABA@ABA@A@@@A@@@@BAB@BBD@B@B@B@BBDF@@@@BB@B@BB@@BB@@@@@BB@@@@@D@D@B@B@@A@EDAB@@@@@BBB@@DBD@@@@BB@@B@BAB@@B@@B@@B@B@@A@@@@B@@@B@@@@@ABA@@BC@A@A@@BA@@@@B@@B@F@B@@@B@B@@@@@B@@AA@@@@A@@B@@AB@B@@AB@B@B@@@@@@@BA@AB@@@D@B@FA@@@@B@@@@BB@@@B@B@@@BA@AB@@@B@@@B@B@BB@@@AB@@@B@@@B@@@BB@@BB@@@@FA@@B@@@DDB@@B@@@@AB@@@@@@B@B@B@D@BAD@B@B@@B@@@B@@ABABA@@B@@@B@@BB@@@D@@@BA@@B@@@B@@@@@B@@@@G@CA@B@@@BAB@@@BAD@BADAB@B@@@@@@@@@B@@@@@B@@@@B@B@@@B@@@@@B@@BA@@B@B@@@@@@@@@@@@B@@@@@@B@@@@@@B@@@@@B@@@@@@@@@@@B@@@@@@A@@@@@@B@@@@BAB@@@@@@B@@@BA@@@@DADA@@B@BB@@B@B@@ABAB@B@@@@B@@A@BB@@@@DCB@@A@@@@AC@@@@B@B@B@@@B@@A@@BA@@@@@@B@DBB@B@@@@@@A@@@A@@B@@@@@BBB@@BBAD@B@@@@@B@BA@A@@@AB@@@B@@@@D@@BB@@B@B@BBDBBBD@D@@@BD@@@BCBABB@@BB@BA@@@@@@@@@@B@@BA@@B@@@BA@@B@@@B@@@BA@@B@@AB@@@@@@AB@@@@@@@@A@@B@@CBAB@@AB@B@@@@@@@D@@@B@BAD@D@B@@@B@B@@@@@D@@@B@@BB@@B@B@@@F@@@@@BB@@@@ABA@ABEHCDCDAB@B@BB@@BB@FB@@FBB@FBJBB@@@D@BDBD@DB@@BB@BBB@B@@@@F@@BBB@B@@ADAB@BABAB@BAB@BAB@D@D@B@DEB@BA@A@@@A@ABCBAB@@@B@B@@@B@@@DABAB@BA@@@@@AB@@AB@B@B@BA@@@@BA@@BAB@D@D@@@B@DBBB@@@B@B@@AB@BAB@B@BBDBB@B@@BBB@BBB@BA@@BBBB@D@B@@A@@@E@G@AB@@@@@B@DBD@DB@B@@@@BBBAB@B@@B@@@BA@@B@B@@@A@@AA@BADB@@B@@AB@@@@@@@B@@@BB@@@AFA@A@A@@@C@@@ABCDABAB@@@@@B@@@@@@BA@@@@@@@@@BBB@@@B@BABAB@@GBABABA@@HB@@@B@@AH@B@BBHB@BFBFB@@BD@B@@AB@@@B@@@B@@BB@@@DABA@@AAB@BABA@@@@@ABECECE@GB@D@B@D@@ABBB@B@B@B@DDBBBD@@@B@B@H@@@@@BB@B@@@BBB@BB@@B@@BD@D@BB@@BBBD@B@@B@@@B@B@@@DBB@@@FCBA@@B@FDDD@@@@B@B@@@BAB@@@BABA@@@@@@@AB@BA@@@C@@@C@A@@BAB@@@@A@@@A@@@@@@@@@@@AB@@@@@@@@@@A@@@A@@@@@@@@@A@@@A@@@@@A@@@A@@@A@CAE@@@C@C@CBCAA@CACA@@@@A@AB@BA@@BAB@@@B@DBHKDGDEDEDCFCBE@@@A@ABCBC@AAA@C"]],encodeOffsets:[[[107516,32473]],[[107526,32407]]]}},{type:"Feature",id:"510705",properties:{name:"安州区",cp:[104.567187,31.534886],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCACACCCAAAACA@@CBCBEBEDEDCBCBABGBIBCAA@@@EECACCG@G@C@C@EDCDADCDCBA@A@C@A@CBCBA@ADCDBDBB@D@@IJABGHCBC@@@C@C@CCCCCAA@CBAB@@ABABA@AC@A@AAA@AA@AA@AACAA@AB@@C@@AA@@A@@A@@DADABA@@DABA@@B@B@D@@@BBBBB@BBB@B@@@@@BA@@@@@AA@A@@@A@@A@@BABCBA@ADEBADAFADA@@DE@A@@AAA@A@@@@@@@AEBA@CBG@@DAB@@ABABADCD@FCB@BABCBA@ADCD@DAAEAE@@A@@@A@@@ABA@AA@@AA@C@@BA@ABA@AA@ABABA@AB@B@B@@@DA@@@@A@A@AAABABA@@B@BABAAA@@A@A@@B@@A@@@AB@@ABC@ABA@@BAA@AB@@@@A@@B@B@B@@A@@AAAA@@B@BBD@@@@A@@A@AA@@A@CAAAAAAC@C@E@CAEAACAECA@@@CDABABA@AAA@A@A@@@A@A@A@@A@A@@AAA@@@A@A@A@AAAA@CBA@CDABADADADABA@AB@@A@@@A@AA@AAA@@@A@C@A@AA@AACAA@@C@A@@@A@GAGC@@A@@@E@A@AA@@@@@A@@@AC@@@A@@@@@AB@@@@@@@@AA@@@AA@AB@@A@CDC@A@A@CA@@AAE@KEC@CBAB@@@BBB@B@BA@A@CCA@AC@ABA@AB@AACG@ACDCB@BEBGBC@@@@BIBEDE@I@E@EDABEBCA@E@EGCGAC@CBOBAB@@@BCFCHABCBE@ECAACAA@AAABCDEBABGF@BABCBCDABAB@@CDAB@B@BADAB@B@BBB@@@BB@@BBB@B@@@B@@A@ABA@A@C@@@@BABAB@B@@AB@@ABABCDAFABAB@BA@CBCBCBCBEBE@EBC@A@A@CBAB@@ABA@ABADABADADADADA@ADAFABADADA@@B@@ABA@ABCBCBC@EA@@CAAAC@CAAB@@C@CDE@CBC@EBC@ABABABAB@B@@@D@DAB@DADABADADADCDCFCDABABA@C@@DBB@B@BA@IBA@A@ABAB@@@BBD@B@B@BABADAB@BADABA@ABA@ABA@CBEBC@ABC@CBC@A@C@A@AAEAC@@AA@@AAA@AA@@AA@@AA@ABCBABABC@ABA@C@C@ABA@@@@B@BBDBD@B@D@BADAD@FCDAD@DABBBBBBDDDBDDDBD@B@B@B@B@BA@ABABCBEBC@CBCB@@AB@B@B@H@F@D@D@DADAD@D@BA@@BCDABABCBCBCBABABABAB@BBBBDBD@B@BABCBC@EB@@CB@B@@@B@DADCBADEDCDEBEDCBABAB@BABAD@BAB@FBBADAHADAFBB@FDFBFFHBFDDFFBBFDBB@@BD@B@BAB@B@DBBABABA@EDKDIFEBABA@A@B@FFDBDFBDBF@FADJBB@F@B@BADADCB@BADBHDDDBBB@N@B@DBB@FDFJ@B@DBD@BBBBBB@F@D@DBFBF@FBDBH@@@D@B@DAHADAF@FBD@@@FDFDBBBBH@FBDBD@B@FDD@@B@BABAF@BB@F@PBBBB@BBBDBBBB@B@B@BB@B@BABAB@B@DDBDHF@BJ@BBBB@B@@AD@B@@B@BBD@B@BB@@@BBDBDDBDBDBBBFDD@B@D@D@B@B@DBD@B@D@BBBBFDBBDBBBD@DBB@D@B@DABC@ABA@A@C@ABA@@@C@@BABC@C@A@CBABA@CAI@C@A@A@@DADADCBADCBCB@BAB@B@B@BBB@@@B@@@@@BA@ABA@AB@DCDEDC@CB@DAB@B@DBDBFBB@BBBAB@BA@@@A@A@A@AAGAE@EAA@ACEAACC@A@ABAHAFADAB@FCDABAB@B@D@BBF@D@DBB@B@@AB@DC@C@CBCBA@@B@@C@A@@@AAA@@@AAA@A@A@@@@AA@@B@@ABABA@@@@B@@@@@B@BBB@BBB@@@@@@@BA@A@@@@@@BB@@@@@@B@@A@@@A@@@AAC@@@@A@@AA@@@ABABA@@AA@@@B@BABA@@@@A@C@A@@@@@@A@A@@@@B@B@BA@@B@@A@@A@@@CB@A@@@@B@DABAB@@@@AA@@A@@ABAB@@@@@@AABA@@AACA@@@@@A@@@@CAEBA@AA@A@AD@@AB@A@@@@@@@@@@@A@@@@@A@@A@B@@A@@A@@@@A@@@A@@@A@@@@@AB@@A@@@@@A@@@@@@A@@A@@@@@@AA@@@@@@@@@@A@@A@A@@A@@A@@@@AA@@@@AA@@@AA@@A@@A@@@@A@@@@@@@@A@@@@B@@@@@@A@@@@@@B@@@@@@@@@BB@@@A@@@@AA@AA@@@@@@@AA@@A@@@@A@@@@@@BAB@@@@@@@@@BB@@@@B@@B@@B@@@@@B@@@@@@@@@@@BA@@@@A@@A@@B@@@@@@@B@@A@@@@@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@BA@@@@B@@@@@A@@A@@@@@AA@@@@@@@@@@A@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@AB@@@@@@@BA@@@@@B@@@@B@@@@@@@@@B@@B@@BAB@@A@@@@@A@@@@B@@@@@@@@@@AB@@@@@@B@@@@@A@@@@@A@@@@A@@@@@@@@@@@@A@@@@@@@@A@@@@AA@@B@@@@@B@@@@@@@@A@@@@@@@@A@@A@@@@@@@@A@@@@@@@@@A@@@@@@@@@@B@@AB@@@@@@@AA@@@@@@@@@@AA@@@@@@@@@@@@@A@@@@@@A@@A@@@@@@@@B@@@@@@@BA@@@@@A@@@@B@@@@@@@@@A@@@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@AB@@A@@@@B@@@@@@@@A@@@@@@@@@@BA@@@@@@@@B@@@@AB@@@@@@@AA@@@@@@B@@@@A@@@AAAA@@@@@@AB@@@B@BB@@BBDB@@BB@@B@@BBBBA@@@AB@@AB@@A@@@@@@B@@@@@B@@@@@@@@A@@@B@@B@@@@A@@@@@@B@@@@@@@@@@@@@@@B@@@@A@@@@@@@A@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@BB@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@A@@A@@@@A@@@@@@B@@@@A@@@@@@A@@@B@@@@@@A@@@@@@@@@AB@@B@@@A@@BB@@@@BB@@B@@@@B@@@@@@B@@@@@@@@@@BBA@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@B@@@@@B@@A@@@@@@A@@@A@@@AA@@@@AA@@A@@@@@@@@A@@@@@@@@@A@@B@B@@A@@A@@@@AAAB@@A@@A@@A@@@@@@A@@@@@BA@@@@A@@@@AA@@@@@@@@@@@B@@@@@BA@@@@@@@@@@@@B@@@@@@A@@B@@@@@@@A@@@@A@@@A@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@AB@@@@@B@@@@@@@B@@@@@@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@@@@B@@B@@@AB@@@@@@@@@@B@@@@@@@@A@@BB@@@A@@@@@@@@@@@@B@@@@@A@@@@@@A@@B@@@BA@@@@@BB@@A@@@@@@@@B@@@@@@@@@@@A@@@@A@@@@@@AB@@@A@@A@@@@@AA@@@@AB@@@A@@B@@@@@@@B@@@B@@@@@@@@@@A@@@@A@@A@@A@@@@A@@@A@@@@@@@A@@@@@@AA@@@A@@@@@B@@A@@@@@@@@@B@@B@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@AB@@@@@BA@@@@@@@A@@B@@A@@@A@@B@@@AA@@@A@@@@@@A@@@@@@A@@@@@@B@@@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@AB@@@@A@@@A@@@A@@@@@A@@B@@@@@@@@A@@@@@@@@@@@@@@AA@@@@@AA@@@@@@@@@B@@@B@@@@A@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@A@@@B@@@@@@@@@@A@@B@@A@@@@@@@@@@@A@@A@@@@@B@@@@@@@@@A@@A@@@@B@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@@B@@@@B@@@@@@@@A@@@BB@@@@@B@B@@@@@B@@BB@@@@@BB@@@BB@@@@B@@@@B@@@@BB@@B@@B@@@@@@B@@@B@@B@@B@@@B@@@@B@@@@@BBB@@B@@B@@@@@B@@@B@@BB@@@@A@@B@@@@@@@@A@@BB@@@@@@@@@BB@@@@@BA@@@@@@@B@@B@@@@@@AAA@A@@@@@A@@@@B@@@@@BA@@@@B@@AB@A@@A@@@@B@@BB@@@@@BA@@@@@@B@@@@B@@BB@@@@@@@@B@@@@@@@@@@@@@@@B@@A@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@B@@@@@A@@@@@@@A@@@@@@@@@@@@BB@@@@@@@@@@@@@@@B@@@@@B@@B@@@@@B@@@@@@@@@B@@B@@@@@@B@@@@@@@@B@@A@@@@@@@@@B@@@@@@@B@@@@@@@@@BB@@@@@@@@@B@@B@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@AA@@@B@@@@@@@@@A@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@A@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@A@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@B@@A@@@@@A@@@@@@B@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@B@B@@@@@@A@@@@@@@A@@@@B@@@@@@@@AB@@@@@B@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@A@@@@@@@@@A@@@AB@@@@@@@@@@@@A@@B@@@@@@FDH@HEH@F@FDFB@@@@@BB@@@@@B@FF@@@@@@BBPJDBHHBBDB@@@@DDHHDF@@@@@@@B@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@BA@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@B@BB@@B@B@B@BA@B@@BB@@@B@@@B@@BBB@B@@@@B@@A@@@BBA@B@@@@@@@@@BB@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@BB@@@@@@@@@@B@@BA@@@@@@@BB@@@@@@@@B@@@@@@@@@@@@@BA@@@B@@@@@@@@@@@@AB@@@@BB@@AA@BAAA@@@@@@B@@BB@@A@A@A@AB@@@@B@@@B@@@B@B@@@A@@@A@@B@@@@A@@@A@@@@@A@CB@@@@AB@@@@BBA@@@@B@BA@AAAB@B@B@B@B@@@B@@AB@@BBBA@@BA@@B@@C@BB@@@AB@BA@@@@@@@A@@@@B@@B@B@@B@@ABBB@@BB@@@BBB@B@B@@AD@@@B@B@@@BB@A@@BAB@@@@AB@@@@@B@BBB@@@@@BA@@@BBA@AB@@BB@@DC@@B@@@@@B@B@BB@BBDBF@ADEDCDCFEDA@AJEDCB@JCD@BAFE@A@@BA@@JC@AB@@@FI@A@@@AAAAAAC@A@@C@A@@@AA@AA@A@A@@ACEAC@@@@@@@AB@DBBAD@DA@@@@CG@@@A@@DA@AB@@@BB@@B@B@DA@@B@DA@A@AB@B@B@@BFBHDB@BD@@FBF@F@BA@@@C@A@A@@DCB@BAD@@@DAB@BA@@BAB@FAD@HAB@DAB@LEB@BCDC@CBA@A@A@@A@AACACACAAACC@A@@@ABEBCBADABA@A@@@@A@@A@@A@IBA@@@@ABABA@A@@A@KD@@A@@@@@@@BA@@@AAA@@AA@@@@@A@@B@B@B@B@B@@@@A@@@A@@A@A@A@AA@@@@@@BA@@D@BCB@@A@@@A@@AA@@@@A@EAA@CB@@@@AA@@@C@@@@@@@@@A@A@A@@B@BAD@DBB@BB@@B@@B@@@@ABA@@B@@B@@BB@BABA@@B@B@B@B@@@@BB@D@D@D@D@DA@@@A@@BC@A@A@@BA@A@@@A@A@@BADCB@BC@@@@@C@@@@@@B@B@@@@@BA@A@@@@AA@A@@@A@ABAD@D@@@B@D@HB@@@G@A@E@AACAC@A@A@@BABCBC@AA@AAC@AAAAAA@CAC@AAAA@A@GAG@AAC@CACCICGEECAACEAAACECACCGAC"],encodeOffsets:[[107100,32238]]}},{type:"Feature",id:"510722",properties:{name:"三台县",cp:[105.094586,31.095979],childNum:9},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@"],["@@BADAD@BAB@@A@C@C@CBABA@@DCBCBC@A@A@CAC@CBA@CBA@CBA@C@CBADABABABC@@@A@@@AACBA@C@C@C@C@AAC@@@@@@@@@A@@@@@@@@@A@@@A@@@@@A@@@@@C@ABA@C@AAAAAC@A@C@ABC@C@CAA@AAAAAA@C@C@@BC@CBABCBCBADCBABCBCBABA@@BABCBABC@@BC@A@A@@AA@@A@@A@@@A@@@ABAB@@C@CBCDAFAFAFABADCBA@CACAAAAA@@@@A@@@@CCEAAAC@CAA@AA@ABCBCBCB@BCBCDCBABAB@D@@BB@@BB@B@D@BCBABA@@@AB@B@BB@DBDDB@@B@@A@@BA@@@@@C@A@ABAB@B@B@@@DAB@@CBC@A@C@AB@@A@@@AA@@A@@@@@@@A@@@@B@@A@@B@@A@@@@@A@AA@@A@A@AAAB@@A@@@@@AAA@A@A@CBCBEDA@ADCDCDCBABCDC@CDAF@FBDBDADCBEDG@@BCAEBE@CBC@C@EBCBC@C@ABCBCBABABC@EAC@CACAE@C@C@ABCDEBA@C@CCCAC@C@A@@DABABABABAAA@AAAAACAAAAA@@AAAAAC@AC@A@@@CBABA@CACA@@GEAA@AA@AA@CAC@C@A@CAA@@A@C@GFCBC@@@@@A@@@CBIBA@C@@@GBABABA@AABABAFEBAAAA@C@EAA@ABABC@ABCBABABAA@@C@CACCIEC@CAAAAAAAAAA@@@A@C@CBEBCBAAAA@@AA@A@E@CACAC@GC@E@A@C@C@A@@@A@@BA@@D@@BDB@@@@@DC@@B@@@B@@@BB@D@B@D@BA@E@AB@@@B@B@D@B@BBD@B@@@BA@C@@@AA@B@@@@BDBD@B@@@BA@@@A@A@AAA@@AA@A@CDADABAB@DA@@B@D@@@@@@CCA@CA@@A@@@@B@@@@BDBD@B@@@BA@@@CACAA@A@@@CF@@@@A@A@A@A@@@A@@@@B@B@@@B@@@BA@A@ABA@@@A@@AA@@@AA@@AB@@@@AB@@@B@B@B@@@B@@@B@@@BA@@BAB@@ABA@@@A@@@AA@@@@AA@AB@@A@A@@BA@@A@@@A@@@A@@@A@@B@@AB@@A@AB@AA@@@@AA@@AAACAA@AA@@A@@@AB@B@B@@@B@@BB@@B@B@@BB@@B@@A@@B@@@@AB@@A@@@@@AA@@A@@@AA@BA@@@@@@BA@@B@@@@A@A@@@A@A@A@@@A@AAA@@@A@@A@AAA@@@AA@A@@A@BA@A@AB@@ABA@@@ABA@@@@BA@@@BB@@@@BB@BB@@B@@AB@@A@@@@BA@@BA@@BAB@@@B@@@B@@@B@@A@@@A@@AAA@@A@@AECAACA@@A@A@@@@@AB@@@B@@B@@BB@BBB@BB@@@@@BB@AB@@AD@@@@CB@@@@A@@@@@A@@ABA@A@@A@@@@@@@AB@@AB@@A@@@A@A@A@A@@AC@AA@@A@@@@@A@@@AB@@@@@B@@@BB@@B@BB@AB@@@B@@A@@@@@AAAAA@A@@@A@@@AB@@@@@B@BBD@B@@AB@@A@@A@@A@@CA@CC@@AAA@@@@B@@@BB@@B@B@@@B@@@BA@A@A@A@C@AA@@A@AA@@A@@@ABA@ED@@A@BB@@BD@B@@@B@@ABC@AB@@A@@@AB@@@DA@@B@@A@A@@@AAA@@@A@A@@@@D@B@DA@ABA@ABA@CB@@ADABAB@@ADADCFADAF@DAFAFALAH@DAF@B@BAB@@B@@D@@@B@@BD@@BDBD@@@B@@@@@B@@BD@D@@@@@B@D@F@@@J@B@@ADA@C@C@@AAA@@CA@@AC@@CCAACCE@CA@A@A@ACE@@@CACACA@AAAA@@A@CDAD@DAD@D@D@DAD@@BDBFDFBDBH@@BB@B@@@@ADBBAF@@BB@B@@@BBB@D@F@DABA@G@C@MBA@@@@@@@C@@@C@AB@AABA@@@@D@B@@@@@B@B@B@@@@@B@@@@BB@B@B@@@@BB@B@@BF@BAD@BAB@BAD@@@@@BAB@@@B@@CF@@CJA@ENAB@B@@CF@BAD@@C@C@GAG@A@CAIBA@@@A@@@@@A@A@A@A@A@AA@@@AA@A@ABA@ABAAAAA@@ABCBA@AAAAAA@@@AB@@A@@@@BA@A@@AA@@AAAA@@@AB@DAB@@A@ABA@A@ABA@A@@@@AA@AAAAAA@BA@AB@BA@C@AB@@@B@F@@@B@BA@A@C@AB@@AAAAC@@@CBABABABA@A@ACAACAC@CCAAAAC@ABAB@D@BBBLHBDBBBB@@DD@@DD@@BD@D@@@B@@AF@@@DA@@@ADB@ADCDADEFAB@BGB@BA@@@C@EBC@@@A@@B@@C@EB@B@D@@AD@@AB@D@B@@@@ADBB@DB@@DBBBDB@BBDD@@BDBBDBBB@B@@ABABABCBCBA@ABABAB@@@@ABABAB@B@B@BABBB@B@BBBBH@@@D@DBD@DAF@F@B@@ADAFAD@BABCDADAB@BA@C@A@C@C@CBA@A@ABCFADABA@CBCBCBABABABCFCBCBCBA@ABABADCBCDCD@B@BAB@BBBDDBBFDDDFDBDBBBB@B@@DDBH@D@J@@AB@BAB@B@BA@@@C@GBABCBA@ABC@C@A@A@A@A@@AA@EAC@C@A@A@AB@@@@AB@B@@@@AB@B@B@@@@@B@@BB@@BBB@@@@@@BA@ABA@@@@B@@@@@B@BA@@B@B@B@DAD@B@D@@ABABABA@CBA@ADADADAB@BA@@@AAACAAA@ABCBABC@EACCAA@A@A@A@AA@C@EDA@AB@BA@@D@D@D@D@F@FBD@D@FADAD@FBD@BB@@BB@DBB@ADABCHABAFADAB@DAD@BAD@B@@@B@@@B@BB@BBFBHBB@F@F@BB@B@BBHDB@@D@DB@@B@D@@@BD@@@DAHAF@H@FBB@BBD@BBB@BDFBBBB@BDBBB@B@DBD@D@DABADABABABABCBC@ABA@A@C@BDFDDBFBFBHBFBFBFBF@F@D@HBF@FDDBDDFB@@FDDBDBD@B@H@BAF@D@AFADCFAB@BB@BBB@BBB@@@@@@@BHBDBBBBD@H@B@B@D@FBDDBBB@@BAB@DAB@DBBB@D@B@B@@@BADA@@DBFDDDDDDFHL@D@B@@CB@B@DBF@@BDBB@@DDPRFDBBBBAB@B@BHB@BDAD@DADBB@@B@DCF@D@BBDDBBBB@B@BCB@D@BBB@ADBBBDBB@BADCBE@CB@BBBFFFHDDB@D@BAFQBCB@D@DBDBDFFBF@HDHHDFB@B@BBB@@BB@BADABABABADAB@B@F@BBDBBDDDDBBBD@DBFCDCBCB@DAB@B@D@F@F@FABABADA@A@AAA@CAA@A@@@@@ABE@@BA@@DC@@DCFAD@@@@@@@B@@@DAB@@@DBB@D@B@@@BADCBCBADCDAD@@AB@B@BABAB@@@B@@@@@BB@BD@BBB@@AD@@ABCBAAA@AA@A@AA@A@@BABCBABA@@@AD@BAFBFFD@B@B@CCCCAAAA@ABEFEFC@@@ABBB@BAB@B@B@BBBAB@BAB@B@B@BA@AA@BA@@BAD@BABC@@BABBB@BBAD@@@BB@B@B@BABC@@@ABAAA@AB@B@BB@BB@BBBAD@@@B@AB@B@@@B@BBBBB@@B@DCBAB@BBBBBB@@B@D@@AB@@@BB@B@F@B@BBB@@B@BB@@@B@BB@B@B@DAD@B@@@@@@B@B@@BBB@B@B@@@@BBD@@@BBADBD@DBBBD@B@@@BAACAA@C@A@@BA@@BADAB@B@@@@@@D@B@@@@BB@@B@@BBBAB@BC@CBAB@@@B@B@B@D@BA@@DAB@B@DBBB@DBB@DBB@BBBB@D@B@BADADAB@BBBDB@@B@BBB@BABADABABAB@@BBBBB@BA@@B@BB@@BA@@@ADBB@@DBB@DBD@F@BADBPNJBBBB@@DABC@GBE@E@AD@B@DDBD@F@B@FFBL@B@DCD@@@B@B@@BBBBB@F@B@FAB@D@@@B@D@DBB@B@D@D@@@DA@@BCDAB@DAB@B@@@AC@A@A@@@A@@BAB@BAD@D@B@F@B@D@DBD@@BBD@@@BBBBBBBD@DBHB@@BA@A@C@EAACAE@AAAA@ABA@ADE@CBA@CA@@AAA@A@CBADABABABADABCBC@ADABADABAB@@ABAA@@AAC@C@@@A@A@E@AAAA@AAAA@CAAAACCAA@@@A@CBCDCBAD@BBB@BA@@BA@ABAD@D@BA@ABC@ABAFEBAB@DBD@D@HAHAHAD@FABAD@DADAD@BABA@ABA@A@ACAA@CAE@ABA@ABCAC@C@C@CAA@A@@A@ABADADALCFAF@@@DCBC@ABAACEAG@I@CAA@CAAA@AAA@C@CBCBCDA@CBA@ABCBA@A@AACAC@C@ABABCDABA@@DABABABA@C@AACAA@A@A@CAGAA@C@ABC@CACA@AAAACAAAAAA@A@A@A@A@AA@AAA@CB@@ABAB@BBD@B@@@D@BAB@BC@@BCB@BA@@DBDHFFDBFCHCHIHGDE@@B@B@DABA@EBABCAAAAAAEACCCAAACAC@A@CAEAA@AAACC@AAAAC@A@@CAAAAA@A@A@@A@A@C@AACAA@A@@@ABAFAB@DBD@B@B@D@BABA@CAC@ABABCBABABABC@A@CAAAA@ABABADABBDBD@F@DBD@DBF@B@B@FABA@A@CAAAACAAACACAAACACCAAAAAA@@BCBADADABABA@@BC@ABC@C@ABA@CBCBADA@ABCBCBA@A@A@AAACAC@C@C@CAA@AAA@@A@A@@DAB@B@D@D@BABA@CDCBC@E@@@ABC@A@A@@AA@AAA@AAAACC@CACAC@C@A@C@ABCBADA@C@CACAAAAAECE@AAC@C@C@CBC@@@C@@BGBA@A@A@EACAA@@@A@@BCB@BE@A@E@A@C@A@ABABABA@CACAAACCACACCCAACAC@C@C@ABEBA@@AAA@AACAACAAC@A@CB@FCBADC@A@C@CAAAC@AACAC@AAEAAACAA@@@AAC@AAAAAAAAAAAC@@AC@C@CACA@@AA@AA@BA@A@A@ABE@A@@AAAC@@@A@@@A"],["@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@"],["@@@@BA@@A@@@@@@@@@@B@@@@@@@@"],["@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@A"],["@@@@@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@@@A@@@@@@@@@B@@@"]],encodeOffsets:[[[107271,31892]],[[107699,31779]],[[107424,31683]],[[107425,31683]],[[107394,31680]],[[107393,31679]],[[107393,31677]],[[107412,31679]],[[107412,31678]]]}},{type:"Feature",id:"510723",properties:{name:"盐亭县",cp:[105.389453,31.208362],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBABA@@DADABCBA@C@C@C@EACBC@A@A@CAAAA@A@ABA@CBC@EAA@AB@B@BBHBDAB@BA@C@CBA@CBC@A@AACAA@AAABCBCFADADAB@@AD@FAD@DAB@B@@@@@BABADCB@B@B@@@BA@G@G@EAEAECA@@@A@@@C@ABCBCDAB@@ABABA@A@A@CAABC@A@A@AAA@ABABAD@DADABABCBA@C@AACACAECC@A@A@ABAB@D@BBD@D@BADAD@B@B@BABABEBAD@B@B@B@BCBC@ABAB@B@@@BAH@BCDA@@BCBCBAD@D@B@D@@@DBD@BBBABAB@@ADABCBAB@@CD@@@B@BAB@D@D@B@BBDBB@B@D@D@BADAB@BA@CA@@A@A@C@@BADABC@EBE@CBE@CBA@A@A@AA@AAA@CBCAC@CCAAAAAAA@CACCAACAAAC@CBCBADA@ABAACAAA@C@CBA@CBC@CBABC@@BAD@DAD@B@BABA@AAAAACAEACAA@C@ABADABCB@BC@AAC@@AACACAA@CAC@C@ABC@A@AAAA@AA@C@ABA@@@A@@B@BAD@B@@@FADCD@DABABC@C@A@A@CB@@@B@BB@BBB@DBD@D@D@DBBB@B@B@BABADAD@BCBABAD@DAB@B@DAD@BAD@@ABABCBCBABAD@@BBBBBBDDDBBBDBDBBBDBBBBB@D@BABEBA@A@E@CAC@CAE@C@CAAACBABAB@BBBBB@D@BADABABABADAB@BBD@DABABC@A@A@C@CAA@EBAB@B@@@BBBBD@B@D@B@BB@B@B@BBBB@DB@D@BBBBD@BDBBB@FBDBB@D@DBBBDBDDFBBBBBBBADAB@FABCBA@A@@@CFGHGJGDEDCAEECGCA@@ABA@AD@@ADA@ABC@@@A@C@AAA@AB@BA@@DBB@BBBB@B@B@B@B@BBBBDBBBBBB@BD@DAD@B@DBBBH@D@B@BBBBD@B@DABABABCB@@ABCBADAB@B@DBDBD@B@BABAD@BAB@DCBADAD@D@DBB@BBBDBB@DBJ@H@FBBDAB@BADCD@@E@EBKDCBCBAB@B@BB@B@DBD@D@D@DBBAB@BAF@DBB@DB@B@BAB@BABABC@CBCBC@ABEBC@GBGBGBC@C@CAA@ABEFAB@BAD@BABC@C@AB@BAB@@ABA@AAC@ABCDAD@D@B@@BBDDBBBB@DBBBBB@BB@B@F@B@B@@@DBD@BB@AB@BA@ABCBABCB@BADADCBABABABCBAB@D@BBB@BB@@DAB@DCF@BAB@BBBBBF@DBBB@F@D@BAB@@FBDB@B@D@DBBBBBFBD@B@DABCDADABABA@@BAB@@@B@@@@@@BBBBBBB@BBBBB@@B@B@B@DBBBBBDD@BBDAD@B@@A@@@CAA@ABCBA@AB@@A@A@A@ABABABABCBCBAB@BAF@DBDBBBB@D@BBB@DB@BB@@DENBDBBD@FAFADAHALBD@DDBBD@FADA@@BBB@BB@@D@BA@A@ACCAACA@@BA@@@@AE@CBADAHCB@DABABABCDABAB@@@BBBB@DBB@DBDJDJBDADADEHGDADABB@@BBBBB@@BBB@@B@@DBDBBDDBDBBDBBBDBB@FBJ@D@B@@ABA@AAA@AAA@A@A@AB@B@DAB@D@D@D@BABABA@ABABADAD@B@D@D@D@B@BABC@A@E@@@A@AAABAB@B@@@BB@BB@BBDADAFEJG@@B@D@@@DBBBDBBB@BDB@B@B@B@@AB@@AB@@BBB@BBD@D@@@D@B@B@B@BABCBAB@B@DDDB@DBBB@@@D@@BBB@D@F@BBDBDBBBB@B@D@@BB@BBBAB@@AB@B@B@@BBBF@CDADA@@B@@@B@B@@A@@B@@@B@@@BAB@B@B@B@@@B@@B@BDBFDB@@B@B@BB@F@BB@@@BBB@@@@B@@AB@BA@C@A@@@@@AB@B@B@BBBB@@B@B@@AB@@ABCA@BA@CBABCDGDEFCBABAAAB@B@B@B@B@BAFE@C@AAECCAACAAAA@A@AA@CBC@C@AAAA@A@@@AFAD@BABA@A@AA@A@C@A@ABAA@@AA@A@AA@ABABA@AAA@AA@@CAABA@ADABA@@BABABAB@B@B@BA@@B@@@@AA@AAA@@AB@BCDABCBABABC@ABA@ABADABAB@B@B@B@DABABAFCB@HCHABADAB@BBBBAD@B@DBBDBDB@@BBB@BABABCBABABAB@F@D@DAB@BA@ABABBBB@BBH@B@B@BDBBBBADADAB@B@DBDDBBB@@@BA@A@ABC@ABAAA@CCC@A@ABC@ABABA@ABABABADBD@D@B@BB@FBDFDDDDDBD@@@BB@B@DADADBFBD@D@D@BBBABAD@DBDBBDBBBBBB@DBB@BD@B@@@DADCBA@ABCDGBABA@@F@BBBB@@B@BA@@D@@A@C@C@E@C@ABC@@BABC@CACAA@C@@@AA@C@@BAA@C@AAA@C@@@AAAA@CAAAAA@AAAAACEAA@AB@B@B@B@@B@@BB@BB@@AD@D@@@@A@@A@CAA@A@AAAA@AAABA@A@AA@@AA@A@ACAAA@C@CAA@IEA@AAA@ABABC@A@C@@AAA@I@A@AB@DAD@BA@CAA@AAACCEC@AAC@@AC@A@A@CAAEGAAAC@AAC@@BEB@@A@A@@AC@A@CB@BADAB@BBDFBDBBB@FB@@BAB@B@DBB@BAB@DABABCBAHAD@B@DABA@ABADAD@BABABABCBA@CBABABA@C@AAA@CBA@CBA@C@@@A@ABABABAB@F@FBD@F@BADABABABAB@F@DBDBB@B@B@BAB@BAB@@A@A@@AAA@@@CAA@@@A@AA@CACAC@AAAAACA@AACBCBCDABABABCBE@CBEAABABCBAAC@C@C@CBCBA@C@AAAA@@A@AB@BAB@DBB@DBBAB@@ABAB@@@BAB@AA@AA@@@AA@@A@@A@AA@A@A@A@@BA@A@@@A@@@C@A@A@A@A@@AAABC@ADA@ABA@A@AA@AAA@A@@A@@@C@CBCBCDAB@D@BB@BBBDAD@@A@@D@BAB@@@B@@@BB@@B@B@B@BAB@BB@@BB@@B@B@@A@ABA@A@@DCB@@@B@BBBB@@BB@@B@BA@@@A@@@E@ABCBABAB@BA@C@ABABAB@BABADCBAAACA@A@ABCBAB@BABC@A@A@CAA@CCAA@CAA@A@@A@ADCBC@A@C@A@A@C@CBA@AB@D@DA@@BA@A@@@ACI@CAAACCCA@C@@@AB@DBJ@BABA@AAACACAC@CBCBCDCDA@ADABCBEBAAE@AACCAACACAAAAA@CAA@ABA@AABA@AD@DAFCDA@ABAACAA@@CAA@A@@@@CBC@E@E@E@A@A@CBC@AAC@ACAAAC@AAAC@C@ACAAAECA@A@C@CAA@AA@C@AAAACAAA@C@CBC@EBCBABABA@C@E@EAC@CA@A@A@ADABA@C@CAAAA@@BABAB@B@BAACAACACAC@CAA@GBA@EBEBC@E@C@IBA@C@AAAAAAC@A@AA@A@AA@ACAAECE@CAC@@C@A@ADADABABCAC@C@A@@CAC@C@C@CAC@CAE@@@AA@@CAC@A@@AABA@AB@B@@ABABABCDABAB@BABC@EBEDA@@@CBABCBADADAFABAF@BAFAD@BBD@B@@@BA@A@CAE@A@A@CBC@EDC@C@AB@B@B@BABAB"],encodeOffsets:[[108077,31764]]}},{type:"Feature",id:"510725",properties:{name:"梓潼县",cp:[105.170845,31.642718],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@BA@@@A@@@@@A@@@@@@@@A@@A@@@@@@@A@@@@@@A@@@@A@@A@@@@@@A@@@@@@@@@@A@@@@@A@@@@@ABA@A@@@C@A@@@@@@@A@@@@@A@@@@@@@@@@B@@@B@@@@@B@@@B@@@@@@@@@B@@@B@@@@@@@@A@@B@@@@@@@@@@@B@@@B@@@@@@@@@@B@@@@@@@B@@@@@BB@@@@@@B@@@@@B@@@@@B@@@B@@A@@B@@@@@B@@@@@B@@@@AB@@@@@@@@A@@@@B@"],["@@B@DADC@@@@AAAAAAEA@A@@@@B@DABABE@@@ABA@A@A@@@@AAACAAAAIC@AECAACC@@@@A@@@@@@@@@A@@CAA@@@@A@ABA@A@@A@@@@@C@@B@@@F@@@AEAA@@@A@ABA@@BAAA@AAA@@@C@AAAAAACAC@A@E@CAA@AC@@@A@AA@CCACCA@A@ABADABA@A@A@C@@@C@C@AAA@AA@@BA@@BA@@@A@A@ACA@AAACAAACA@@C@A@@@IHEFCBCBAAA@@AAA@@A@A@ABBB@B@B@@@F@BADABA@C@C@C@A@C@CBABAB@BABABABC@C@C@A@CBA@A@@B@B@BBB@BBB@BAB@BA@C@I@EAA@CAAACAAAACCCAAAC@CA@@@AA@AA@AAAA@@AACBCBGHCFCBCBIAICAC@CAA@CAAAA@@A@ABCBADABABCBA@GDCBAB@DBF@@@@AB@@DBBBDD@B@BABC@@@AAA@AA@@CBEBC@AACCC@KAGBCBEBEBC@AAACFM@CA@@ACAA@AAC@A@AACACAE@ABA@ABADADABABAB@B@B@B@BA@@BABAD@BBB@D@@@BA@C@CBAAC@ACAAAA@C@A@A@AA@AAAAA@AAAAAA@@@@@@@A@@BA@AB@BABABCDCBA@C@AACAEAAAA@C@C@ACAEAGACAC@AAAAAA@A@@AC@AC@CAC@A@E@A@C@C@ABA@AB@@@B@@@B@BBD@@A@A@CBA@CBAD@@CB@@C@C@A@A@CAC@A@@@C@A@EBA@E@A@AAAA@@C@C@CBC@C@A@CBAB@BAHABABABCBABCBCBCBC@CBC@C@ABCBA@C@A@CAAAAAA@A@A@@@A@@ABABA@C@C@A@CAA@AAA@BA@@@A@AAA@AAA@@@CB@@A@A@CCA@A@@@A@CFAB@B@B@D@B@@A@@@C@AACCAA@A@A@A@AAAAAA@A@AD@BADCDEBEBCDEDAB@B@B@D@D@BBB@BBB@B@B@B@D@H@B@B@BAD@D@D@B@B@B@BADADAB@B@BBDBDDB@D@B@BBDAD@B@BBDBBBF@D@@BB@DBB@B@B@DBBBBBBB@BBABAFABABA@CB@@@BBBBB@@@DA@ABA@CBC@C@ABADADBBBDBB@B@B@BADA@ABE@EACB@BAB@DAD@BA@@B@BBBBBBF@D@D@DBD@DABADA@OAC@AACC@AA@AA@@A@CBA@A@BBBDBB@DA@@BABBBBBBBBB@B@B@DAD@BBBB@BB@B@B@B@BBBB@BB@@@B@BABA@A@A@AB@B@B@DBF@@@BADA@CD@BAB@BBBA@@BA@A@A@C@CACAC@C@AAABA@@B@B@D@B@B@DAB@DBB@BADABC@AD@BAB@B@BBDBF@@@DBB@BADAD@B@BBBF@D@BAFAF@DADABCBC@ABABAB@DBB@DDBB@BBBAD@DABABAF@B@D@@@@@@@BAD@B@D@B@D@D@D@D@BBDAB@B@BABBB@BBD@BBBAB@DADAFABAB@BABA@ABADCBADABABA@C@ABA@A@C@A@A@AAA@ACG@@@A@AB@@CB@BA@BBBF@@ABC@@@AA@@@A@@@@A@A@@@ABAB@BA@@@AA@@@@@@CA@@@@A@@@A@@AA@ABA@@B@@@@@@ABAB@@A@AB@@A@A@@@@B@@@@AB@BA@@BA@A@C@@@A@@B@@@B@BBD@@@@@B@@CHA@@BA@@B@B@B@@@BABA@@BA@@B@@@@@@@B@@A@AB@B@@@B@B@B@@B@@B@@BB@B@B@@@@@B@@A@@@A@ABABAB@@B@BB@@@BB@@@@D@BB@@@@BBBDBBBB@DADAB@F@DAB@B@B@BBDBBB@BB@@@B@@@B@@A@A@A@A@@@A@@@@BA@@B@@@@@@@@@@B@B@D@B@B@@B@@@B@B@D@B@@AB@@@@@@@@B@D@@@BDBB@@B@@@@@BA@A@@B@B@@AB@@A@EA@@@BAD@@@B@@A@A@A@@BA@ABA@@@AB@@@@B@@BBAB@@@@@@B@@@@AB@@@@BDB@@@@@B@BA@@B@B@@B@@BB@B@@@@@BA@AB@@@@@BABABABEBABA@A@@@@@@@AB@@A@A@@@@@@@@AA@A@@BA@@BA@@@A@CC@@@@AB@@@B@B@BABAB@@AB@@AD@D@BB@@@HA@@B@B@@@@@@B@D@B@BB@@@@AB@@@BB@@@@@B@@AD@@@@@@@@@B@@@@@B@@@@BBB@BCD@@@@@HF@@@@@BEFAB@@BBB@JD@@BB@@AD@DCH@HAF@B@@@@@@@D@DBDB@B@DBB@BADCB@@ABCBABA@ABE@A@C@@BABA@CBABAD@BADBB@B@D@BB@@BB@BB@B@DBB@B@B@@@BBBBBBB@DBB@BBDBBBDBBB@@BBB@B@B@@@BAB@@@B@B@B@@@@@@B@BA@@@@@AB@@@@AB@@A@@B@@A@@B@@@@@BB@B@@@BDDB@@B@B@@@B@AA@@@A@@D@B@D@BBB@BBABAD@@@BFBD@D@DBD@BBBAB@B@D@BBB@AD@B@BAD@BBB@BDFD@DBHBD@DB@@BB@BB@@ABABABA@ABABAD@B@BB@@BB@BBBAB@BCH@BB@@@B@DAB@DAB@@@B@BBB@BA@@DCBAB@@@BB@BBB@BBDA@@BABABA@@BBBBB@B@B@BABAB@B@BBB@D@BBDBDDBD@@@FB@@BB@F@D@@B@B@B@BADAB@BABBD@BAB@@@@@@@B@@@DAB@B@@BABBB@@BBFBBBB@DBB@DBDBD@B@BAB@B@F@F@D@BA@A@@@@@@@@@A@@@@B@@A@@@@@A@@BI@AB@@A@@@@BA@@@@@@B@DABBFB@@@BB@@BD@D@B@B@BBDH@@B@BABA@CBA@ABAB@BA@B@@@BABAD@BD@@DDBH@B@DBB@BABAB@B@B@B@@@B@@@B@@@@AA@@A@AB@BABABA@A@IB@BABAD@B@BABAD@D@BCBA@@DABCAAAACACAA@CC@AAC@E@CBC@CAGAEAAACAAAAAAAA@C@A@EAEAE@C@ABCDCD@F@FBDDB@BBBAB@FAHAF@B@DABADAD@FCB@BCBADCFIBC@A@I@E@E@A@AAAE@CACA@@ACBGLKLGDABA@C@CBAB@BAB@@B@BDLBDAD@B@@BBFHDBB@B@@@BEBA@@B@DAFBD@B@BBBBD@DAB@BGBABAD@DA@A@@ACAAAC@A@ADEFCFADAD@B@DA@@@CBEBEBC@ABE@CBE@@@EAC@A@AAC@AA@A@A@ABABCBGAEAGAE@EAEACAA@@A@A@CDC@CBC@AAAA@AAAA@AAA@@AAA@A@CAAAACAAAAA@CBC@C@CAA@@A@CAAAAACCAA@AAAABA@CB@B@F@BADABCBABAD@DBDBBBBBD@H@FAD@BAD@B@DBBBBBDBFDDBDD@@DBDDBBDBB@JFB@@B@@B@D@@ABC@CBCBC@CAAAC@C@@DCHCDCBABABC@CBCBAFAD@BABCBCDABADABABA@C@CACBC@CBCBE@C@A@A@ACAEACAA@@CAC@GACAECCCAAC@C@C@CBA@@F@FADAFCBAB@BAB@HAFCHABCBABABA@AD@B@DBBHBDDBB@B@FA@@F@D@D@D@FAB@HCB@DCBCBE@EGOACACBCBGBCBC@A@E@@@C@EAC@C@C@ABC@CBA@A@AAC@AB@DABBBB@@@BA@A@@@@F@@B@@ABADAJCBAB@@@@@DCB@B@BBB@BABA@EBC@E@@CCAA@ABCBABAD@BBDBD@H@D@B@BA@A@@AA@A@ABA@@D@D@BABAF@D@B@D@BA@CDAB@F@@A@ABABADAB@DA@@@A@AA@@@@@@A@CBE@ECA"]],encodeOffsets:[[[107516,32469]],[[107989,32272]]]}},{type:"Feature",id:"510726",properties:{name:"北川羌族自治县",cp:[104.46797,31.617203],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@CA@@CAACEE@@EAECIEEAGCGEECGCEAEACAAAEAE@@@AACAA@CAAAGAC@E@EAC@AA@@AA@C@A@AAEAC@AAAA@A@@@@@A@@@CD@@AA@@BAB@AA@@B@@A@@@@AA@A@A@@@@BA@@@@BA@AB@A@@A@@@A@A@@BC@@@A@AAA@A@@AA@@AABA@@@AA@A@@@@A@@B@@@@@@@B@@ABA@@A@@A@DA@@@AB@@ABAA@@BA@@@A@@@A@A@A@ABABBB@@A@A@@B@AA@@@@BA@@@@DAB@@@@@B@@@B@@@@@@AB@@@B@@@A@A@@@A@@@A@@@@@BAB@B@B@@@AA@@@A@@@@B@BB@ABB@@AA@@@@BA@@@@@@@@@@@@@A@@AB@@@@@@@@@@@@A@@@@@@@@@AA@@@@@@B@@AA@@@@@@@@@@@AA@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@AA@@@@@@@@A@B@AA@@B@@@@A@@A@A@AA@@@A@@@A@@AA@@@AABA@A@A@@@AAA@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@AB@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@A@@@@@@CEGGCC@@@@CAAAGGCAOIAA@@@@@@EEA@@@@@A@@A@@@@EAECE@G@GFG@EC@@@@@@@AB@@@@@@@@@@@@@BA@@B@@@@@@@@@B@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@A@@@@BA@@@@@@@@@A@@B@@@@@@@B@@@@@@@@A@A@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@A@@@@B@@@@@B@@@@A@@@@@@@@@@@A@@A@@@@@@@@@@@@@B@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@B@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@B@@@@@@@@@A@@@B@@B@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@A@@@@A@@@@@@@@AA@@@@@@@@A@@@@@@@A@@@@@@@@@B@@@@A@@@@@@A@@@@@@@@AA@@@@@@@@@A@@@@@A@@@@A@@@@@A@@@@@@@@@@@@@@AA@@@@@@@@@@@@@B@@@@@@@B@@@@@AA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@BA@@@@@@@@@@@@@@@A@@@@@@@@@AA@@@A@@A@@@@@@@AB@@@@A@@AA@@@@@@BB@A@@BA@@@@@AB@@@@A@@@@@@B@@@@@BBB@B@@@@A@@@@A@@@@@@AB@@@@A@@A@@@@@@@@AA@@@B@@@@@@A@@@@B@@A@@AA@@@A@@@@@A@@@@AA@AA@@@@A@@@@@@A@@@AA@@@@A@@@A@@@@A@@@@AA@@A@@A@@@@@@A@@A@@A@@AA@@@@A@@AA@@@@@A@A@@@@@A@@A@@@B@@@@@@A@@@@@@A@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@A@@@@@@BB@@@@@@@@@A@@@@@B@@@@B@@@@@@@@@@@BA@@@@B@@@@@@@@A@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@B@@A@@@A@@@@@@@@@B@@B@@@@BB@@@@@@@@@@@@@@@B@@@@@@A@@@@B@@@@@B@@@B@@@B@@A@@B@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@@@A@@@@@@@@B@@@@B@@@@@@@@B@@BB@@A@@@@B@@@BA@@@@B@@@@@@AB@@@@A@@B@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@@AA@@@@@@@@@B@@@@A@@@@@B@@BB@@@@@@@B@@@@@@@B@@@B@@B@@@@BB@@@@@@B@@@@@@@@A@@@A@@@@@@@A@@@@B@@BA@@@@BB@@@@B@@@@B@@BA@@@@@@@B@@B@@@@@@@@@@@A@@@@@@@@@@BA@@A@@@@AB@@A@@@@B@@@@B@@@@@A@@@@@@@@@@@@@@B@@AA@@@B@@@@@@A@@@@@@@@@@@BA@@A@@@@A@@@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@A@@@@@@@A@@@@@A@@BA@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@B@@@B@@B@@@@@@@A@@@@B@@@@A@@@@@@@@@@@@@AB@@@@A@@@@@@@@@@@B@@B@@B@@@@@AB@@@@B@@@@@@@@BB@@@@BA@BB@B@@B@@@@BA@A@@@@B@@@@@@@@@B@@@@@@B@@@BB@@@@BB@@B@@@B@@@@@@@@BA@@@@@A@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@AB@A@@@@@@@@A@@@@@@@@A@@A@@@AA@@@@AA@@@B@@@AA@@B@@@@@@@@@B@@@@A@@@B@@@@@@@@B@@A@@@@@@@@B@@B@@@@B@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@B@@@@@@@B@@A@@@@@@@@@@@@@@@A@@@@@@@@B@@A@@@@A@@@B@@@@@@A@@@@@A@@@@@@@@BA@@BA@@B@@ABAA@AA@@@AA@@CAAA@@AAA@@@A@@B@@@@B@BB@B@@@B@@A@@@@@@@BB@@@@@@A@@B@@A@@@@@@@@@AB@@@@@@@@@@@B@@@@@@A@@@@@@BA@@B@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@AB@@@@@@B@@@@@@@@@A@@@@@@B@@@@AB@@@@@@A@@@@@@@@@@BB@@@@@@@@B@@@@@@@@@@@@BB@@@@@@@@@@BB@@@@@@A@@BA@@@@@@@@@@@@B@@@@@@@@@B@@@@@@B@@@@B@@@@@@B@@@@@@@@@@A@@@@@AB@@B@@B@@@@@@@@@@B@@@@@@@@@@B@@@@@@B@@@@@B@@@@@A@@@@A@@B@@@@@@@@A@@@@@@B@@@@@BA@AB@@@AA@@@@@@@@@A@@@@@@A@@@@AB@@@@@@A@@B@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@B@@@@@@@@B@@B@@@@@BB@@@@@A@@@@@AB@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@A@@@@@@@@@@BA@@@@@@@A@@@@BB@@@@@AB@@@@@@@@@@A@@@@@A@@@@AA@@@@@AA@@@@@@@@A@AB@@@@@@@B@@B@@@BB@@@@@@B@@BBB@@@@@B@@AA@@@@@@@@A@@@@@@@@B@@@@A@@@@@@B@@@@@@B@@@@@@BB@@@BB@@B@@B@@B@@B@@B@@@@BB@B@@@@B@@@@@@@@B@@B@@@@B@@@@B@@@@B@@@@@B@@@BA@@@@B@@@B@@@B@@@@@@BB@@@@A@BB@@@@@B@@@@@@@@@@@B@A@@BC@@B@BBBB@FADB@@@@@B@@@@DBBB@@ABBB@@@@@@BABA@@@BB@@B@@A@ABCBA@@@@@@BDA@@B@@@@BA@@@ABA@A@@@@@@B@B@@@@B@D@B@@@@@ABABA@@@B@@BB@BABA@@B@@BB@@@@@BD@B@@@B@@@BA@@@@@@@AA@@@@@@@BAB@@@@@@A@AAA@AAA@@@@@A@@@@@ABAB@BA@@@BB@@@@@B@BBB@B@@BB@B@@@B@DA@@@ABAD@D@DCDA@@BA@A@CAC@E@AAC@A@A@ABCBEDA@CBEBGBAB@B@BDDBBDF@BBB@FBFBH@B@B@B@B@@ABA@ABAAA@EACACAA@A@CBA@@DCDCFCDA@@BAB@BAB@@@@A@@@A@AAA@A@A@ABA@ADCDABCDCBCB@@@B@B@DBJ@DABAB@D@B@DADAB@@@D@@AB@B@D@BAB@BADCBA@C@A@CAC@AACAAAECAAAAC@A@C@CAA@A@C@C@A@C@ECAACACACAACAC@A@@AAA@C@AAA@@@@ABC@@@AAAAAI@@AGEACCCA@A@ABABA@A@@A@A@AAAAAACAAA@AAOAE@A@@ABEBA@A@AC@ECA@C@CAEAG@AAAAECEC@@C@EAE@CBGBCBA@C@@@@FBBBHAF@H@D@DADBF@BCF@B@BBBBBDDBBBB@BA@@B@@GBCB@@@B@BBD@HAB@BCBA@@BA@@BBB@BB@B@@B@@@@ABEA@@A@CB@@@BBBB@@B@BA@ABA@CBG@C@@B@DBBBBF@B@@@AD@B@@A@C@@@A@AD@BAD@D@@@DBBF@B@BB@BDJ@@AB@@@@IEA@A@@@@BEJA@ABAAA@@ADIAA@AA@C@IDABCAC@ABADABEFA@BF@B@DABA@KDG@C@C@C@A@ABABABA@CBC@C@I@G@C@C@CDAB@@E@@@CAC@CCIEECCAGACBCFCDAF@FAFADCBGFABCAC@CAIAG@EBQHAD@D@JABABA@A@CAGAE@G@G@EBE@EAGCIACAAAC@ABAB@@AB@@AFAH@DAJ@DADADABABCBE@EAABAB@B@D@D@H@DBFBFBB@DAD@@A@A@KDEB@BAB@B@B@DAB@B@D@BBB@B@B@@ABA@E@@BA@GHEHCDA@A@A@A@@BCDEJADA@@BA@A@@@AAAACCCEC@GCGCEAGAI@C@EAGCCAACAECGA@G@C@O@EB@BEBCBI@E@GBABABEDEHQLCBKFEBC@G@EAEBGBEDEBEBG@GBI@IBSAGBIHEDA@ABADBBFFBBBB@DBH@D@B@H@B@B@BDFBDBB@D@F@D@DAJBDBFB@BDJHFDFB@@DBBBB@@DAFAFBB@BBBB@DBB@BB@BBD@BBD@BBB@BDBBBD@B@FBB@BD@B@D@B@B@DDBDBJDFBDBD@DBB@BABB@@BD@F@B@BBBFJHJ@@@BAFGLCHAF@DADCHABAD@DBF@HBFB@@BDBD@DBHBD@@B@D@BADBFAHBF@BBBD@D@H@DAFAH@D@D@HCDAB@BBDB@DBDBJAJADADCHCJ@DADADA@BBB@D@NANCD@FAD@DAF@JAHADAD@DBDBB@D@BAFEDADADANGHAFAF@B@RDDBDBB@D@BAD@F@DAB@FCDABAB@HCB@PBFBD@BAHIFCBABEBABCBCBABADAFED@B@D@FBD@BDD@BBHBH@B@DBB@BBBBDBFBD@NBFBJBD@H@B@DAB@DAB@FAH@J@D@DAHAH@FAPDDBBALCB@BNBBFBFDB@BBDBB@FBD@JBB@D@D@D@B@BAFABAD@BAJCFIBCBAB@D@BAB@@@@D@BC@@@@DCF@BBBCFCDCHEHBB@BD@DBFBFBJL@FBD@B@D@D@B@DBJ@BBBDDBBDBBBDBDBFBD@BBBB@BDDABBBFFBBFDFBFBFBDDJDLFNFRHDBF@D@H@F@L@F@DAP@DAB@@@@ABA@C@AAC@AAA@CAA@AAAAAAAAAAAAAAAAC@C@AAC@A@CAA@A@AAA@C@A@C@A@A@A@C@A@@AC@AAA@AACBA@CDABADADAFADADADADABABA@ABA@A@A@AB@DADAFAD@B@DBD@DBD@D@D@D@DAD@BBD@B@B@B@B@BABC@ABABCBABADABCBABABC@ABABA@A@A@ABCBABADABABABABAB@BCBADABAB@BAD@DAB@DAD@DAD@DAFADADAD@FADADADADAD@FAB@DAFABABADABAB@@A@A@C@AAA@ABC@ABA@ABADABADADAD@DAD@DAFABAD@DABABA@C@ABCBABCB@BCDABABAF@D@DBB@DBF@BBB@DB@BBBBFBDBDDB@DBBDDBBBDBBBDBBDDBBBDDBB@DBDAD@F@DBB@BB@@@DAB@D@BAB@BBDAB@DAD@B@DABBDBB@B@@DB@@D@D@B@D@BCDCBABADABAD@DADABABABABA@ABABABABABADABABABADAB@BABABBD@BBB@BBBAB@BCBABABADABADABABAB@DAB@DBB@B@D@B@D@BABABADABABABADABCB@BABAB@DAB@@AB@BA@ABA@AB@B@B@D@D@D@D@DAF@B@DADABABADAB@B@@@B@DBB@D@BBD@BAD@BABAD@FCD@HAFAF@D@F@DBD@D@BAD@DADAB@F@B@D@D@D@D@B@B@@@B@AC@AAA@CAA@CAA@A@A@C@C@C@CBA@ABA@CDABAFAD@DAD@B@DADA@@DABABADCBA@ABABABADAB@DAB@DBFBDBDBFBDBD@DBD@DBD@BBDBBBDBB@B@DADCBADA@ABA@A@A@AA@@A@A@ABABABABA@ADAFGBAB@AGACCCCC@@AACAGAEACACAGKCAAA@A@A@AB@BABA@AGQCK@CAC@CAC@CAC@A@A@ABCJGDA@@BCBA@@BAD@B@HBFBB@@AB@@AAC@AAAAAAA@AAC@ABABCFIBAHEB@@ACACCCAAA@@CCCCCAEEACAAIGGGCACAEAIAICC@A@@@AB@B@BABA@ABA@ABEDGH@@ADAFADDB@B@DCBCBC@E@IFMJA@A@@@EGACCA@@EEGCGAA@CAEEECGAEAIAKEAAAAAAAAEACCCACACAE@CACAAAC@IBE@E@A@AC@A@A@A@@GCACCC@A@AB@BA@@@AAAAAAA@A@ABAD@FAB@BBB@@@B@BAD@DADAFCBABAB@@A@@AA@A@A@@BCBA@AB@@A@@@A@@@A@@BAD@@@B@D@@@B@@@@@B@@@BA@AB@@@DAB@FAB@@@@AB@@A@AA@@AAA@CAA@A@A@@@@@AB@@@BABA@@BA@@@A@@@AAA@@@AA@@A@@A@@AA@@A@@@@AA@@A@@A@@A@@A@@@A@@@A@A@A@A@EA@@A@@@AAC@A@A@@@A@@BA@@@A@@@A@AA@@A@@@AB@@ABABADABADAB@BA@@@@B@B@BAB@DABABABCBC@@B@@A@@A@CCC@CAA@A@AAUIEAACBCeEEDG@@CDCH@JG@@D@@@@BDBBAB@BAB@F@DAF@@AB@@A@@@@B@@@@A@@B@@@@@@A@@@@AAA@@BA@C@AC@@@@@CFCDBDBCDFB@A@@@@@@@@@@@@B@A@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@A@@@@AB@@@@@@AB@@@@@AA@@A@@@@@@@BAA@@@@@A@@@A@@@@@@@A@@@@@@@@A@@@@@@@@@@@@AAB@@AB@@A@A@@B@BCDBB@BB@A@@BAAA@AACA@@AAA"],["@@BDfF@@FG@AB@DCDABCBA@@AA@AA@AABA@AB@D@BBF@B@B@BEBA@AKGAA@AAACAAAA@CAADA@@@@B@BA@@BA@BB@@@@@@@@@@@@@B@@@@@@B@@@@@@@B@@@B@@@@@B@AB@@@@@@B@@@BB@@@@A@@B@@@@A@@B@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@B@A@@@@@@@@@@@@@@BEA@@@BBB@@@@@B@@@@A@@@@B@@A@@@@@@BA@@BE@CBE@A@ABA@ABCA@A@@C@@@IH"]],encodeOffsets:[[[107098,32477]],[[107072,32508]]]}},{type:"Feature",id:"510727",properties:{name:"平武县",cp:[104.555583,32.409675],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@C@C@CAE@C@E@EBGBC@EDC@ABABC@ABC@AAC@A@CAA@@@A@A@CBABABCBCBA@E@CBC@C@C@C@A@A@A@@BAB@BABA@@BA@CBA@ABABA@ADCBABABABCBABABABC@A@C@A@A@CAA@CBA@ABABCBABCBABABABADA@ABAAA@AAC@AAABABA@CBABABABCBABABABABAB@BABABABABCBCBC@ABCBABABCDADC@A@C@C@@@CA@@@AAAACBA@C@ABC@CBAAC@ABA@A@CBA@C@@AAA@CAE@C@CBCAA@CAACAACCAAACAAACAACCAA@CCAACACAEAA@ACAA@AAE@CAA@CAC@E@ABABCBADA@ADABAD@B@DABABCBC@ABEBCBC@CBC@CBCBABCBAB@BAB@BAD@BBB@B@D@B@BA@ABCBABABEBCBA@EBC@CBCBCBCBEBC@CBCBEBCBC@CBC@CBA@CBC@ABA@ABCBABADA@ABABABABCBABABAD@B@B@BABAB@BADABABADCBABABADAB@BADABA@A@A@A@C@AAC@CBC@C@C@C@CAC@CAA@C@EBCBCBA@@B@B@BAB@BABABCBCBCBCBEBCBCBABCB@DABBD@BBB@BBD@@@B@D@B@B@B@D@B@DBB@B@BBB@D@BBD@B@DBDBBBBBBBBBBBBBB@BBB@DBB@BBD@B@DAB@B@B@BAD@BAB@HABCJAD@BAH@@AJAH@DADADCBABIFC@EFEFABCBEBABCDAB@BABADCBABABABAHABABCD@B@BBB@BBDBBBBBD@BABABABEBYDCBEBCBEDAD@@CBA@CAAAEEAACCCCAE@ABC@ADE@A@C@CAACECCAEAA@A@EAC@AAGACCA@@AAA@@A@CBCBEBCAAAAAACCCAMCICEACAAAACAACGAE@EAAAA@@CFCBQ@QHMDI@EBOAA@MJA@E@ABAB@BCH@DABABA@CBO@C@G@C@C@CAE@I@K@CBEBABCF@BAB@B@DAB@DAFCD@BCBGBCBGDABA@AF@BAB@F@DEFADEDCDABEBCBCBABABCD@BADADA@ABC@EAE@IAO@CBA@CDKBKBABOLEBC@C@A@CAC@C@A@AB@BADAFCH@BCDCDCDABCFA@ABABA@GBAB@@BBBBD@BBBDBBABADCBAFABEHCDC@A@AB@BAD@BBB@BBBBF@D@D@B@F@BBFBFBBBFBBHHBB@B@B@D@D@FBFBF@DDF@D@B@BADBBDBBBBDBBBDFH@BB@@BAD@DCFAHABAB@DAD@D@BAH@B@BBF@BABADABAB@D@DBHBD@D@D@DAB@DCD@BAF@B@B@BBFBDBFBDBB@D@@ABADA@ABCDCBABABCBCDCDEHABEHABCDCFCD@BELABCLABA@CBCBCBABCBABEFADABABCFABIPADAH@B@D@B@DBFDHBF@FBFBHDF@BB@BBD@D@D@HBFBDBDBDBDBDBDBBDJHBBDD@BBB@D@DBD@@HBD@D@FBD@D@DBD@B@BAD@HCHED@DADBFBB@HBHBJ@FBD@B@D@DAF@D@DBDBB@FDBBFBD@H@HBF@FBDBD@D@F@DALADABABAFCB@D@F@FBHBBBDBDBDBBBHDDBBBB@DAB@BADABCBADELEHCFCB@DCBAFCBCBABCBCBE@A@A@CCI@CACBE@GBADABA@A@C@CBA@C@ABCBCBCBCDGBCBCDGBCBABCDCBADEDCBADAJGDABCFCJGDABADCPKDAFCBCDCBEBEBC@C@A@CBC@ACC@AAC@A@ADA@AB@BB@@@@@AAACCCAAA@A@@@CBC@AAA@@EAECC@@@AAGC@@@A@GACKCECKEOG@@B@F@@@TBBAFBHBD@L@TDD@JBB@BBBBB@DF@B@B@D@D@F@F@BBDBBJNDDDFDF@BBB@BB@BBD@@@BBCFAF@@@F@DBBFDBDBBDBD@D@@B@BAD@BAF@DAD@F@B@HAB@D@FAF@D@B@F@F@RAD@D@B@BDHBFBB@F@H@BDJBDBBBDBB@B@B@BAD@DBB@F@F@B@DAF@BBBBB@BBB@BAH@B@DBJ@B@D@B@F@DBF@D@BBBBF@B@BAF@BABADCFAD@BAB@F@BABCDABABC@EBC@ADABAB@BCHEJ@B@B@F@D@BABABA@CBCBABABADCD@@GFED@BABABCBA@A@CBFF@BBD@BAFBBAD@BDBDDDDBBDDBDBBFD@B@F@D@BAD@@EFAB@BA@@FBH@@@BA@A@A@CAA@A@C@A@ABCJAB@DABCJCF@BAFADA@A@A@EAE@CBA@C@ABAB@D@F@B@D@BABCJ@BABBB@B@BBBBB@F@D@D@L@FAF@BADADABEDIDCBE@C@EDCBABA@A@A@A@AAAAACCEAECCAAECC@ECGACACBA@A@C@CAEAA@CAA@ECAAAAAC@EAC@ABA@CAA@@A@C@C@ABCAIACAA@AAIAGAAACAA@A@CBEDCBA@ABCFCFCBCDA@EDEDCDCBADCB@DA@@BBBDHDD@FBB@@BBB@JBBBD@FDDBBBBDBB@B@F@HAL@B@BBB@BABC@CBKFGBCBA@ABA@A@AAC@AAC@A@CB@BA@EBCBA@AB@B@@@BDDBD@D@B@BABA@A@CAA@AB@B@HBB@@BDBB@D@B@@@BABCBAB@B@BDBBBD@D@DBB@DBBB@@@B@DAFADCHAFAD@BBD@@BBBBFBDBFB@@@@ABABABAB@BAD@@ABAB@@@BBBB@BDDDBBBB@D@BABAB@D@D@B@DBBDDFDBBDBBD@BBBA@@BCBAB@B@BBDBF@BABAB@DAB@F@B@BD@BBB@D@@BB@AB@BBD@D@DBDBDABCBAD@BFFBDHJBBB@D@BADAD@BBBB@FBB@BBBD@BBFADAD@B@D@BB@@BABADADABADAB@FCDCF@DC@CBAF@F@DABADEBEBADCHCFCJCFADEBADCB@LBFAHAHCFANAFB@@BDBDBBB@NEJC@A@C@ABA@EBADCDCBAB@DADAF@B@BADE@ABC@ADADAB@D@@ALEB@BABA@EBA@@BAB@FAB@@ABABCBCB@DADAD@DADABA@@@AAC@@@EBA@ADEB@BALGFG@AHGBCBA@CBC@AB@BABAB@DAFBFBB@B@D@B@BA@ABABAFCDCFCBC@@@A@A@AAC@@@CBA@ABABAD@DAB@B@FADCHABABABA@ABC@ABABABAFADABCDBDBFFD@HAB@@BB@JB@@@B@F@BBBH@DBDBB@@@@A@A@ABA@ABABABADA@@BAAABA@A@CBABA@ABADA@ABC@A@C@CBA@C@C@ABC@A@ABC@A@C@C@A@C@@BCB@@A@@@AAEBA@AD@B@B@@ABCBC@@D@DBD@BBB@BAB@B@BABABAD@BADBDAD@B@B@DABABAD@B@B@D@DBD@D@D@BAD@F@DAD@D@F@B@D@D@BABAD@@A@ABC@ABAB@BABAB@@A@C@@@A@A@AAA@AB@D@B@D@B@DABADADABA@ABAB@@AB@BAFABAD@DABAB@@@BA@A@@@A@A@ADABABADAD@DAD@DAD@DAB@DAB@BADABA@ABA@AAC@AA@AA@AAA@A@@BAD@BBD@BAD@BABAD@BAB@DAD@D@D@BAD@BCBABADABA@ABABABA@A@A@ABAD@B@D@D@D@D@F@D@DBBBD@DBF@DBBBDBDBB@DBD@B@D@F@HAD@FAB@B@@ABA@CAABABC@ABA@ADAB@BBB@D@B@@@@A@A@C@A@ABABA@CBABA@CBCBAB@BABAD@D@FBDBFBDBDBDBDBDBBBD@B@DABAD@BAD@DAF@BAD@BADA@@BA@ADAHCB@BABA@A@A@@@A@ABA@C@AAC@@@ABC@ABABABC@ABABA@ABCBABABADAD@DAFAD@DAD@FCBADADADABAB@@ABCBAFCFCB@DCBABABABC@@@AAA@CA@@AAC@AAAAAAA@A@CAA@A@C@A@ABA@A@A@A@A@AAA@@AAA@AAA@AAAA@AAAAA@CA@@AAA@C@AAA@A@C@A@@@C@ABCBABA@ABA@A@A@A@AA@@AAAAAA@AA@A@@@A@ABCBADADADAD@D@D@DAD@BADA@ABABCBABABABCBADABCBAB@BABABABC@ABC@ABA@ABC@C@C@A@A@A@A@AB@BCD@BADAD@D@D@D@BBB@BBDBB@DBB@B@DAD@D@D@BAD@B@D@BAD@BAB@DAD@B@D@D@DADADADCDAFABADAFADADCDADADABAD@DAD@DAB@DABABABCBABA@@DABAAAACAA@ABA@ABAAA@A@A@@CE@A@AAEAGFGB@B@BABC@@BCBABC@@AACG@@AAAA@@A@AAAC@@BA@@DC@@@AACA@@@@@BA@@@A@A@A@C@AAA@A@AAA@A@@A@AA@ADCDA@ABAB@B@@AA@BA@A@@@A@@@@BABA@ABABA@A@CBAB@AA@@@@AACAAE@@@@@AD@BA@A@@EAAAA@@A@@@@@@DBB@@A@ABC@@@ABBD@@@B@@A@ABC@A@ABADA@AB@DABADABA@ABA@ABC@AAA@C@A@AAA@A@C@A@A@C@A@ABA@ABA@ABAB@DC@@B@B@B@@@B@@@@A@A@ABABC@@B@B@BA@ABADAB@DABADABABADABAB@BADABAD@DADBF@B@D@D@BABA@A@ABA@C@ABA@@BABADCBADADAD@DADEBC@@B@FBDDHB@@DBD@BBB@DBD@D@D@D@F@DAD@D@FAD@D@DAB@D@BADCBA@ABA@ABCBADABCBCBADABCBABADABCB@BABCBA@@BABAB@FBD@DBB@D@DBD@D@B@D@BBBBB@@BBBB@DBD@F@F@D@DAD@DAD@B@B@J@D@@BLBB@B@BBB@B@@@BA@ABADAB@@@@CB@BA@@@A@A@A@@@@A@@@@AAA@@A@EBA@ABE@AAA@CA@@@C@@AC@AACAA@AAAAA@C@A@A@A@C@ABA@C@CBA@ABADADABAD@F@B@DABA@@BABA@A@@BC@ABAD@DA@A@ABC@A@@JGFCBA@A@@BBDA@@@CBC@AA@ACAGEI@@@ABI@CKAC@C@AA@@A@A@@@AAABA@A@@@A@@@E@A@C@CACBA@A@CAAAA@CAA@AACA@@AA@A@A@C@A@A@A@AAAA@CAA@A@C@@@EC@@CAAAC@@AA@@AAA@AA@@A@@A@A@@@C@A@@@@AB@@A@A@@BCBE@AAABA@@ACAC@AACAA@@@CAA@A@@@A@C@A@A@CA@@AAA@AAA@A@A@A@ABADCBABA@@BEBAB@BAFB@@@ABAACAAAA@ABC@CAA@CACAAAA@@C@C@C@CAA@AAA@AA@@AAAAAAAA@CAAAAAAACAAAAAACAAACAAAA@CAAA@A@@@CAA@A@AAA@AAA@CA@AA@AAA@CBA@C@A@AAAACCAA@AAC@AAAAAAAA@AAC@@CAAA@E@@BAD@BA@@BADC@ABC@A@C@A@CBA@ABCBCBCBC@ABC@A@C@AAEAAAC@A@@@A@@@A@A@A@@A@@@CAA@@@A@AAA@@@@ABA@@@@AA@@A@A@A@@AB@@AB@@AAA@@EACAAAA@A@C@CAA@A@A@@@C@EBC@@@AAA@@@@@AD@@A@ABA@AAA@CA@AA@A@AA@@@AA@@A@@BA@@@@@@@A@A@A@A@@@A@@A@@@A@@@@@AA@A@@@@CAAAAA@A@@BA@AA@@E@C@@A@AAC@@AAA@@@@@@@A@AA@@A@AAAA@A@@@@@@@@@@A@@@AA@@A@AAA@@@A@@@@A@@@A@@@A@A@@@A@AA@@AA@C@A@A@AAA@@CAA@AA@@ABA@@BABCFABABA@A@@@A@AC@@EEAACCA@@@AAA@@@@ACAAACAAACBA@A@AB@B@BBBAB@@@@AFAH@DBF@@DHDB@BA@AFCBA@C@@AECA@CACBC@E@A@EGCCAAICA@AAIE@@A@A@AAA@AAA@@AAAAA@AAA@@AA@@@@A@A@AAA@@@CAECCA@@ABA@@@A@@@AA@@A@AAC@A@A@A@ABEHCB@BABABABAB@B@B@BB@@B@B@BAB@BCBABCDCBA@A@CAAACAAAC@CAC@CAC@CAEACACAEACAA@CBA@CBABABAB@BABCDABABCB@@CBCBA@C@CBC@EBABCB@DAB@BAB@D@D@D@D@B@BBB@DBB@DBB@BBDA@@@A@A@C@C@C@C@A@E@A@CBCBC@AB"],["@@AB@@B@@@@A"]],encodeOffsets:[[[107134,32818]],[[107120,32204]]]}},{type:"Feature",id:"510781",properties:{name:"江油市",cp:[104.745915,31.778026],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@H@FCACG@CD@D"],["@@B@@A@@DCCACAED@D@@@@BDD@B@@A"],["@@B@@@BA@A@G@@CAE@E@A@AAAAAE@@@A@C@@@@AAA@ABC@AA@@A@@@@@@@A@@@@@A@@@AA@@A@AB@@A@A@@B@@GAC@A@@@C@C@AB@B@B@@@BBB@@@@@BAB@@@@A@A@@@@@@@@D@@@@ADA@CDAB@@@B@B@@@BAB@@@B@BAD@@@B@@CBC@C@C@C@A@@A@@A@A@A@A@@@ABABA@@AA@@@@AB@BA@@@@@AA@@@AAA@CAC@ABA@@@@B@B@B@@@@@@@@@D@@BB@@@@DAB@FBB@@@@@BB@@@B@@@BA@ADC@@@AB@@@@@@BBB@B@B@@@@B@@@B@@A@A@A@A@A@@@@B@@@@BB@@BB@B@@AB@@@@@@B@@@LCB@@@@BABAB@B@@B@JAB@@@@BB@@@@@@BABCBABADAF@B@@@BDDBBDBDBDBBBB@@@@B@BAB@DCDADA@KFA@CBA@GBC@EBA@AB@@ABA@CB@@C@ABA@CD@@@B@B@D@@ABE@E@EA@@ACA@GCEA@AA@A@A@@B@BCBA@@@CBA@A@@@AA@@A@@BCB@@@B@@DH@@@@CBC@ABCAA@@B@@@@@@BDDF@BB@B@B@@BBB@@B@D@@@@BBDBBBB@B@@@BEJ@@A@@BID@@AB@@@BEFABC@IDA@CDIF@BCBEFCDCDCF@B@B@B@DBB@@BBD@FBF@D@HBBBDBB@DBBB@@F@FBBBDBFBFBHDFDHFHDFBJFFDFB@@FFBDDB@@DBBB@BB@BD@BBBAB@@@BDBBB@BBBLH@BABAFA@A@E@AAC@A@@BABBBB@@BBB@@ABADCBCDA@@BEH@@ADBDFBVJBBB@B@DBD@DDB@@@@BA@@@ADADABABCBA@ABA@A@@@@@ABA@CBABCBABAB@BA@@B@@@BB@@B@B@@@B@@AB@@@B@@@B@BBD@B@@@BB@@F@B@B@B@B@@@B@@@BB@@@@BB@@@BB@@@@@BB@@BB@@@@BB@@B@@BB@B@@@B@@AB@@ABAB@@A@@B@@@@@B@BBB@DBB@BB@@B@BA@@B@@A@EBA@CB@@A@@BAB@@A@@@@@A@@@C@A@@@C@AB@@@B@@@B@@@BA@@BABAD@@@B@BBB@@@BA@ABABEDCBCBC@ABA@@@A@AAA@EBC@AB@B@BBBBBBB@B@@ABA@@B@BDDBDHD@@@B@B@BBDB@F@F@JAD@BBDBDBF@DBDBDBDDFBBBBBBBBBLFJBFBHBFDFFDBB@HBHDFF@@DBBDFH@@B@B@NIJEF@D@DADA@C@ACABCBEBC@@HGFCBAB@BAB@BA@A@ABA@@B@D@JDJBFBDBDBHHJHBBBDFFDBDDDD@@BBDBDDDB@BA@GFABEJADAB@BBD@BBBBBBB@BBD@BA@@BA@EAGAA@C@AB@@ABAD@@CBIHAD@B@B@BBD@DBD@DBD@DDLHR@BABABA@@B@B@BBBDBHLDBDBFBHBDBBB@@DDDDBDBHB@B@D@BBB@@@BB@@B@@@B@BA@@DBFDDB@@B@BBB@B@@@@@BB@@BB@BBBBB@BB@BBB@BBB@B@@@JFBBB@JDBBDDFHB@F@D@DADBB@FD@BD@B@DABEB@@ACACG@@AE@CBGBE@@@@BAAA@A@ABAB@B@DABBDBBBDB@B@@B@BB@@B@DDBBFF@@BDB@@@B@B@BABADEBA@AB@BA@@BBB@DB@@BB@B@B@B@DBB@@BBB@@@B@B@@@B@@@B@@@@@@B@@BB@B@BB@@B@@@B@@@@@@@@B@B@BB@B@BB@@B@B@@@@@@BB@BD@BBB@@@@D@FB@@BAB@@@BBBBBDB@@@@@BBB@@@@B@@@B@@@@B@@@B@B@B@B@@@@@@AB@@@BB@@B@@BBB@B@@BDBB@BBB@BAB@@@BC@@@@B@BB@@D@FAD@@@B@B@B@DBD@B@B@BBDBFB@@BB@BA@@BA@@BB@B@B@@@BB@@@@AB@B@@B@BBB@@@B@DB@@B@@@@B@B@B@@@B@@@BBDBBBF@B@D@BAD@BADADADAD@BAB@D@B@D@BAD@BCDAB@@ABC@AB@@@FBBDB@@BD@BBBBBBBBBD@BBB@DBBDBB@B@B@DAB@DBB@BBBB@@DBB@BBB@B@BBB@D@@@BBBDBB@BBDBBBDBBBBBBBBDBBBBBB@DBBBBBBBB@@BBB@BBB@DBD@D@D@@@BBBBBD@DBB@DAD@BBBBBBDAB@B@@EAABA@ABAF@@ABABCDAB@B@B@B@BBB@BBB@BB@@D@B@B@D@B@@@BBB@D@@BBBD@BBDBD@@ABBB@BAFAD@@@B@BA@@B@@B@D@@@B@B@@@@BB@@BBB@BB@@BD@BBDB@@FD@@D@B@B@DBB@BB@B@B@B@B@D@B@BBB@@DBBBB@DBB@BBDBB@B@DADBD@B@F@@@B@@@B@B@BABB@@B@B@@@BBD@D@LBB@D@BBDBB@BB@BB@BB@@PLB@BBDBB@JBBBHBFBDBBBBB@B@D@DAFBJDDBDBBBBBBFBB@J@H@D@D@B@F@D@B@D@F@F@B@BAB@@A@C@AB@@@F@B@FBBDBBBBDD@BABABBB@H@FBD@B@BBBBBB@DBHAD@B@HBFDB@F@@@B@BBBBB@B@D@FAB@B@BBBAH@D@LAHAD@BAB@FAB@B@BBBBD@B@FABAB@B@B@B@@@DADAB@DBD@@AF@HA@AB@DBJBBBB@DBF@HBB@B@B@H@B@B@B@H@DDBBBBBBDDBBB@BBB@FBHBBBD@D@D@B@D@JBFBB@D@B@BADEBAFIDE@A@A@AB@AA@CA@@CCAEAMKAACCCCA@@@DCBCDEBAFCFCBA@@BCB@DAB@@@BBFBDBHDDBB@@@B@DAAECKBADA@AAABAAAAACE@CAECC@@ACCCCCEGEGACB@DCBA@AFC@@BAB@@ABA@A@AAAAAA@A@EACAEAAACAAACEEGB@DA@@@CAC@A@@C@A@@A@@BAD@DA@@@ABA@@@@@@B@@@BBBBFBB@@@B@DA@@BAB@BAB@B@@@B@DABA@@@AAAAA@@@@@A@@D@B@DAB@@@B@@B@DB@@@@@DE@AAEACCCCEECCECC@@CACA@@AAAACAAC@@@AHEBADCDCFEBCB@B@B@D@LB@@FC@ACE@A@A@AB@FE@@D@DABA@A@@BA@@@AECCC@@@A@CBAAAAAA@G@E@CAGAC@CA@@AA@A@@@ABAB@BAB@F@D@@@B@B@BADEDAHAD@DAB@BAD@@A@CCCKIMIAAGEGEIEAAGECA@AAA@A@CBA@C@EBC@CBC@G@A@AAAA@@@C@CA@GCEAAHG@A@CBG@A@A@AAA@@@@@E@C@@@@BD@@BA@AB@@@DBFBB@D@DABC@A@C@CAEBI@@@@@A@@@AA@@A@A@@A@@@ABA@A@CCA@@A@EBA@@@AB@B@@ABC@@CAA@BCAA@@@ACCAABAAABABADC@AAAACAAAAAAAAAA@@AB@@CB@@AB@@BB@B@BAB@@@@@B@@@B@B@BAB@@@BA@@DA@AB@BABAB@B@BAB@BAB@@@B@BAB@BA@AB@BAB@B@@@B@@@B@@@@@@@@A@@A@@A@@BEAA@A@C@@@@A@A@@A@@A@@@@@@@A@@AA@@@@@@@@AB@B@B@@A@@@A@@@AD@B@B@B@B@@AB@@A@@@A@BA@A@A@@@C@@@A@AA@@A@AAAA@@AB@AAB@@@@A@@@@@@@@A@@@@A@@@@@@@A@@A@@@@@@A@@@@A@@@@@@@A@@B@@AAA@@@AAC@@D@B@@@B@B@@@@BB@BAB@@@BC@C@@@@@@A@@@@@@A@@@@@A@@@@@A@A@@@@@@@@A@@@BA@@@A@@@@A@@@@@@@A@@@@@AB@B@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@A@@@@B@@BBA@@@@@@@@@A@@@@B@@@@@@@A@@@@A@@@@@C@@B@B@@@@@@@A@@@A@@@@@AA@@AA@A@@A@@BA@A@A@AB@@AAA@@@A@@A@@@@@@B@@@@@@A@AA@@@@AB@@@B@@@@@@A@@@@@@AA@A@@@@@@A@AB@BA@@BA@A@A@@@A@AB@@A@A@@@A@@@C@@B@@@@@@@@@BA@AA@@A@A@@@ABA@A@@@@@@A@@@@@@B@B@@AA@@CFCFEFEJI@AAA@A@@@A@AB@@ABA@A@EAACC@A@AACBABCDABA@ABA@C@ABCDCDABAB@B@B@BABAB@BADBB@BA@@CA@@@A@AB@@A@A@AA@A@C@C@@@AA@G@A@A@@ACCAC@AA@C@CAACCCCACCGCCACAC@A@C@@BCBCB@@ABCBA@A@@@AABA@AB@BAB@DABABA@@@CGAAA@A@C@C@@AA@@A@@EAAACBA@@@@@@@AB@@@@@BA@@BAJ@@@B@@@@@BA@@@@@@B@@@@@@@@@BABC@E@E@A@A@ABA@C@CACAA@CAA@AAEAAA@@AABA@AA@A@CB@@A@@@@@@@A@ABC@AAABA@CBABA@A@A@@@@C@EAA@@EA@@C@CAACAC@A@CAA@A@ABABA@A@A@AAAAA@AB@BABA@AB@AC@AAA@AAA@@A@ABCD@@ABA@AAA@@@A@CBA@CBA@@@A@@ADG@ABAAA@AAA@@AAA@C@ABAB@BABABAB@BA@@AAA@@CAC@GACAC@CE@AAA@ABC@A@ABCA@AAC@A@A@ABAAC@CAC@C@EA@A@@BCBAAAA@AAC@A@C@@@@B@@BBA@@@A@A@@@CAAC@@A@A@@A@@@@@AB@@@@AB@@@BA@@@@BA@@@@B@@A@A@@@@A@A@A@@@A@AB@@A@A@A@AA@@AACAAACAAAA@CAA@AAAAAA@@A@A@A@CAA@A@@AAA@@AAC@A@A@CAABC@ABAB@DABAB@@@D@BAF@BABABAD@BA@CDABA@CAA@A@AC@C@C@@@@@@@ABE@GDG@CBC@@AA@@ICA@AA@@BAFE@A@@@@GE@@@@C@ADA@AA@@@@@A@@@@@A@@@@@@@@BC@@@A@@@@AA@@A@@B@@A@@A@A@C@A@@@@A@A@@@GB@@A@@A@CBC@@BA@@BABA@A@A@A@@BA@@@@DDB@@@B@@AB@@AB@B@@B@@@@@@B@B@@@BA@@@@@@B@B@BAFABABABA@A@@@@BAB@@A@@@@@AAA@@@AA@A@@@ABA@@@@@A@AC@@@@BA@@@@@A@@@@A@AB@AA@@@@@BA@@B@BAB@@AB@B@B@@@@A@@BC@A@@FBB@@@BA@@@A@AB@B@@A@@@@@AA@CA@A@@@C@A@@@@@@A@@BA@C@A@A@@@A@@@@A@A@C@A@A@@@@@@@@A@@@AB@@@@@B@@@B@B@B@BA@@@A@@@A@@AAACAAAA@A@A@CBE@A@CBCBA@AACAAA@A@@A@@A@C@@A@@A@@AAA@@@BABABAB@@@B@@@@A@@@@@A@AAA@@@AA@@@@A@A@A@@@ABAB@@@@A@@@@@@@AB@@AB@BA@A@@@A@A@AB@@AB@DG@@@A@@@@AC@A@A@@@AB@@@D@B@B@@AB@@ABA@@@@@A@@CCECA@@@ABED@@A@CA@@A@A@@@A@@@@AACAA@@AAC@C@@AA@@@AAA@AA@@A@A@@A@@@@@G@A@A@@ACAACCA@A@A@A@AA@BC@A@C@A@@HDFDFAF@B@@@@ABABA@BB@@ABCB@@A@@AA@@@A@@@A@@BA@C@@AA@AEAEA@AG@A@ABG@@@A@@GA@@ABABAB@HA@ABABA@@@A@AA@@@@@@@@B@@A@@@@A@@@@@A@ABCBAD@B@@@D@@@B@BEB@B@@AA@@A@@@@@@@A@@BA@@@CAABB@@BB@@@A@A@@@AB@@A@@@@ABAAA@A@@A@A@@CAC@C@A@@@@BAH@F@@@B@@@@A@CAAAA@@ABA@AAA@AA@@@AAAAC@A@ABA@ABA@@@A@A@@AACAA@@@C@C@A@AB@@AB@@@@ABA@A@A@@BA@@B@@@@ABA@ABCB@@A@@@A@A@@@A@ABAD@B@B@@@BABA@CFA@C@C@A@ABA@ABA@ABABA@CB@BA@A@AA@@@E@@A@A@AAA@@AA@@CACACC@@@A@IAEAA@EA@@EAA@@AA@@A@ABADCDCFGBAB@BA@@@@AA@@@@E@@@A@A@@@AA@@@A@@@C@@@@@A@A@@@A@CBC@A@A@@@C@@@@@@@ABA@@BADA@@@AB@@@@@@@@@BA@@@@@@BA@@@AB@@A@@@A@@@AB@@A@@@AB@@AA@@@@@@@@@@@ABA@@AA@BADA@A@@AC@@C@C@AACAAAA@A@@@AA@@@C@@A@@@A@@B@@@BABA@@@@@A@C@AB@AA@AA@@@@A@@@@B@@@B@@@@A@A@CAA@@@@@@@AB@@@BA@@@A@A@A@@@@@BD@@@@@BA@CD@@@@AAB@@@@A@@A@A@AB@BA@A@@@AAA@@@CBCB@@@@AB@@A@@@@@@@BA@A@@A@@@@@@@@B@@@@A@@@@@@@@@@@A@@@@@A@@@@@@@@A@@@@A@@@@@@@@@@@@@@A@AB@@AA@@@@@A@@@A@A@@@@@@A@@@@@A@@@@@@@@@ABABC@ABC@A@@BA@A@@@ADBH@@@@@@A@@@@@A@@@AB@@A@@@C@@AA@@@A@@@AB@BABA@@@A@@@AA@A@C@ABC@A@A@A@@@@@@@BA@@@@@AA@CC@@A@@@EB@@@@AA@@AA@@A@@@A@@@A@@B@@AAA@A@@@A@@@A@@BAB@@A@A@@@A@@A@@A@@@@@EBA@C@@@A@@BAB@@@@@@A@A@A@@BA@A@@BA@@@@B@@B@@BA@@@@@A@A@@@A@E@A@@@@A@@B@@AB@B@D@@AB@BA@@@@A@@@A@@@@@@BA@A@@@@AA@@@BA@A@AA@@A@@C@CA@@AA@A@@@@BAFCB@@@@A@A@C@C@@@@AA@@@@A@@AA@@A@AAA@@@@CEAAA@A@A@C@AAA@AB@@@@@B@@@BAB@BABABA@@@@@ABC@@B@@C@@@E@A@C@A@@BA@@@@B@@A@C@@@@A@@@@@@AAA@@B@@A@EAAAA@@@AACCAAA@A@A@A@@@@A@@@A@A@AAA@AAC@AAAAA@@A@A@CBCBCBA@A@AAEAIIAAA@@C@A@AA@CAEACAC@@A@@@ADCRGHCD@@A@A@ACE@@AAB@@AAA@AAGA@A@C@@@A@A@@AA@@AB@"]],encodeOffsets:[[[107066,32512]],[[107094,32498]],[[107173,32313]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("南充",{type:"FeatureCollection",features:[{type:"Feature",id:"511302",properties:{name:"顺庆区",cp:[106.09245,30.796803],childNum:1},geometry:{type:"Polygon",coordinates:["@@JBB@B@@DBAB@@@HBBB@AB@HK@@BABA@ABA@@B@DB@@BBB@B@B@@ABA@@BA@@BABADA@A@ABAB@B@B@DBDBD@FCDAD@D@BBBB@DBF@PB@@DBB@BBBADAB@B@B@@DBF@DADCBCBCB@BAD@FBFBB@@ABC@E@I@CDEDAB@@@BAB@BBDBBBBBD@B@BABA@C@C@CBC@@@CAECA@C@A@ADABAB@DBBBD@B@@A@A@CBA@A@C@CACAAAC@AA@@C@ABAB@F@FADBB@BABCDCDCDCBCBC@@@C@A@A@A@@B@B@D@B@B@BA@CBA@ABADAB@D@D@BBD@@BB@D@B@DAB@DADCBA@@@A@AAA@AAAAAA@ABABA@A@E@CAA@AAAAA@AB@BABABABA@@AAE@@AA@@CA@A@AB@@AHABA@@@CBA@@B@@@B@@AB@@A@A@@@AA@EACAAAA@BA@A@@@@@@@@@AA@AA@@AE@ABC@ACAEAA@A@AABADAHCLANCH@FBFDFBB@BABCBABAB@@@BADABBBD@@A@@BB@@@@BB@@@@BD@BBBBCFBBB@FAFGBABBB@B@BA@A@A@AB@BBBB@BBNBBBBDBB@BADCDCTMFEBEBA@C@C@A@CAACAE@A@CECCCECEGEKGGGC@CAA@G@C@CBABA@ABCDABADABAFAHAFADCDCBC@C@MGGCEAAACAECEAEACAAACAAAAAAA@AAEAEAC@GAC@CAC@EBCAC@C@C@CBE@CAC@A@CAC@CAGACAEAEAEAACK@@AC@CBCBEBA@@@ABAA@@AA@CBI@EBKAGACAIA@C@G@EA@CACACCECEC@AECGCCE@E@C@A@A@E@E@A@A@E@A@C@A@A@C@C@C@AAC@A@@AC@AAAAAAAACCACAC@GDA@CBE@CACEEC@@@@@@CE@@@@@AA@@A@@@AAA@@@A@@AA@@@@@A@@@A@@AC@@@@@@@E@AAA@@@AHCBABABCBCBA@A@EAAAAAACACAGBGD@@A@@@A@@@A@A@A@A@@@@@A@AB@BA@@BAB@@ABABAB@DAB@@BBA@@B@@@@@@@@@B@@@@A@@@@@A@@@@@AAA@@@A@@@@@A@@@@@@@AB@@@@A@@@@@@@@@AB@@@@@@@B@@@@@D@B@@@BA@@@@@@B@@@@@@A@@B@@A@@@@@@B@@@@@@AB@B@@@@@@@@@B@@A@@@@@@@A@@@@@A@@A@@A@@@@AA@C@@@C@A@ABCBABA@A@@@@@A@ABC@@BA@@@@@@@A@@@@@@B@@@@@@@@@B@@@@B@@@@@B@@@@@@@BB@@@@@@@@BB@@@@@@@@@@@BA@@@@@@@A@@BCB@@@@@@AB@B@@@@@B@@A@@@@B@@@@A@@@@@@@AB@@@@@@@B@@@@@@AB@@@@@@A@@@@@A@A@@@@@A@@@@@@B@@@@@@@@@B@@B@@@@B@@@@@@B@@@@@@@BB@@@@@@@@@B@@@@AB@B@B@@@@@B@@@@@@@B@@@@@@BB@@@@B@@@@B@@@@@@@B@B@@@@@B@@@@@@BB@@@@@@@@B@@@BBB@BB@@@@B@@@@@@@@B@@@@@@@@@B@@@B@@@@@B@@@@@@@@@B@@@@@@@@@@A@@@@@EBA@@@@@A@@@@@A@@@@@@@A@@@A@AAA@@@@@A@@@@@@@A@@@@@@@@B@@A@@@@BAB@@@@AFAF@B@DAB@@@@@B@@@@@@AB@@@@@@A@@@@B@@A@@@@@@@A@@@@A@@@@@@AA@@AA@@@A@@@@AA@@@@@A@AAE@@AA@@@@@@@A@@@@@@A@@A@@@@@@A@@@@@@AA@@@@@AD@B@DBBBD@@A@@@@@@@@@ABA@@@@@@@A@@@@@@BA@@@@@@@@B@@@@@@@@@B@@@@@@BD@@B@@B@@@@@@@@@B@@A@@@@@@@A@@@@B@@A@@@@@@@A@@@@A@@@@@@@A@@@@@@@@@@@A@@@@A@@B@@@@@@A@@@@B@@ABAB@@@@@@@B@@@@@@@B@@A@@B@J@D@B@@@@@@@B@@@@@@A@@B@@@@A@@@@@A@EBC@@@@@@B@B@@@B@@@@@@@B@@@@A@@@@@@@A@@BE@A@A@@@@BA@@@@@@@A@@@@@@@AB@@@@A@@B@@@@A@@BAB@@AB@@@@@@@BA@@@@@@@@BB@@@@@@B@@@@@@@B@@@@ABABAB@B@@@@A@@@@B@@A@C@@BA@@@@@@@A@@@@@@BA@@@@@ABAB@@A@@@@B@@A@@@@@@@A@A@@@A@@@@@@B@@A@@@@@@B@@@@@B@@AB@@@@@@@B@@@@A@@@@@A@@@AB@@A@@@C@AB@@C@A@BBDBFBFBDD@B@BCD@@@B@@@BB@@@@BABBBB@D@B@DDBDDFBD@B@B@DAB@BCBADCFAD@DBBBDD@B@D@D@BB@@BD@B@B@D@D@@BB@DDBD@B@DADA@CBABAD@BBFBDBDBFDFDDDDBDAB@D@B@F@DBBDBBBDBBBDDH@B@B@@@BA@ADEDCH@H@F@JAJADADCD@BBBBBB@@@BBB@BAB@DA@A@@@ABABAD@BB@BBB@@BDFFBBBD@@FPAD@BDBB@D@BB@@@@@BAB@B@BBBBBBB@@@B@BABAD@B@BBJ@@BB@@D@B@D@D@B@BBDB@BA@ABA@CAAAA@AB@BBBFBBBB@@@BB@B@BD@@@@@BBBBBB@@@@@BBB@BB@@B@B@@@BCDAB@BABBBBDB@B@B@BAB@B@@@BBBLDD@BB@B@BBB@@JBBA@CBC@C@A@AB@D@BBD@D@BB@B@BBB@B@@@B@@AB@B@D@BAB@BBBB@B@B@BAB@DABBB@@B@"],encodeOffsets:[[108623,31845]]}},{type:"Feature",id:"511303",properties:{name:"高坪区",cp:[106.118808,30.781623],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAAECCAC@@AACAA@A@@@AB@B@@AB@@A@A@A@@@A@A@@BAB@B@@@BA@@@@@AB@@BB@@FD@B@@@BAB@@A@GCCCA@@@E@A@@@A@AB@BCDGHAFA@A@A@@@ABCJ@B@DBBBDDHDF@B@@BB@@DDB@DDDBBFBF@BAFAB@BABB@@DBBDHBDAD@B@FADADADADBB@DADAD@@ADCDAFAB@B@DAB@DADA@ABABA@@@C@@@C@@AA@@AACAAGEEECCGEECEEIA@@E@A@EBA@CBABCBADADADAFCDCFAFBH@DBBBDDBDDBBDDFJDDFFDDBBBBB@DDFBHBJBD@BBBBAB@BA@@BBBBBDBDBB@BABA@@DCB@@AB@B@@AB@@ADAB@@ADEBC@@BEBA@ADMA@@A@@BA@@B@BBDDDN@HAJ@FAD@@@DAFADAD@BABAB@@CFADCB@@CDCBC@@@C@E@E@E@C@EBA@E@EBC@A@ABAB@F@FBFDDFFHLBDBHBH@@HCHADBDBBBBBBB@F@BABADADABABGD@B@@BB@B@F@@@@@@BD@@@B@@@B@@@@BB@@@B@@BB@B@@@BB@@B@@@@DF@@@@@@FDDFDBF@DAB@HCD@DBDBBDBBBBBB@BBD@@@BBD@B@D@D@D@B@B@D@B@F@B@B@F@F@B@B@D@FDFHDFD@BFDFDDDDBDBB@@F@H@DJBDBHBLBFAJ@DAB@@BB@AB@B@@ABAFAD@DBD@@DLBBBFBFBFBDBH@DBD@D@BBD@DAF@D@D@DBDAD@FBD@DBD@HBDBFBF@BBBBBBBDBBBDBFBFBFDDBBBFBHDNHD@D@DADCBCBEBGBEBABCBADCBAB@BADAD@H@B@DBD@HHLHHFDFDFDDDFB@F@DBBB@D@B@DB@DAB@HCFELEDABA@ADABCDCBCAC@CAA@AAC@CBCBCDAD@DADA@C@CAC@A@ABABA@CAA@A@AD@B@FADABA@AAADABADABADAD@BABABA@A@C@AAAA@C@A@A@A@A@EAGACAECCACAAAAAAAAA@AAACBGBMJILA@AAACBC@ABC@ABABAB@FADAD@BABE@AB@B@JAFA@A@A@A@ABCB@B@BBB@B@B@AB@D@B@@AD@BBBB@B@@A@A@ABAB@B@D@B@BAB@@AD@@@DBD@DAHEFAD@JFB@F@BC@CBCDADCJGBCDCFEAAB@DCB@BAD@F@DABABADCAABC@CDCBADABABA@C@A@@@ABAAA@@@@@C@CBA@ABABADADADAFAD@@@BBB@DBB@DADABA@CDIBEAE@AB@DAF@D@DBB@BDBDB@FBB@JADADAFA@@DADCBCDCBCFAD@D@DBBBDAB@@ABC@AB@D@DAD@DADAD@D@D@@@@AHEDG@@A@@A@AAAACCECAECCA@@CGCIKKCAAA@@GAE@AA@@C@ECCAAA@A@C@A@CACCE@@AA@AAG@@@CCK@CAAA@CAGAEAACAA@@CCACAAAAAA@AACACBC@@BCBABC@A@CAA@C@A@CA@CAC@A@ADABM@EBC@AA@@CACCAAAAEO@@ACA@CAABMLAD@BBD@BABCBAAA@CAGB@BC@CDCBABCFABABAB@D@BFD@BBBBBB@BB@BABMHABAB@BA@ABE@E@@@A@AA@@@C@AA@AAIEC@@AAABC@AA@@AA@C@C@A@EAA@ABCBCBA@E@CAECCC@A@AB@JHB@B@@@@AEC@@@AB@@@BBB@B@@AD@BA@@@@BA@C@AA@@AAB@@@BA@@BA@A@@AA@AAC@A@A@@AFG@A@A@AA@ACA@C@A@CBAA@@AC@AA@AACBE@CB@BA@CBCDCHCHCJAB@BA@EBE@EBADABAB@BAA@@CAAACAE@CBAB@BBF@BBB@BABABCFADABA@@@@A@A@@@ABAA@@AAAACA@@@@I@AA@A@ABANAB@@AAEA@@A@@@@B@B@B@B@@@B@B@@AHABC@A@@A@M@AC@C@@BABABE@A@CCAAABCBCAAAAA@@ABIHEBC@C@@A@AHCBCDG@C@C@CDEBCB@B@BBBDB@B@BEDC@@B@B@@BAJBBBBB@BABAJAB@@AA@AA@EACBC@@BAB@@@@AA@@@BA@A@@@AAA@C@A@ABA@AGAA@@@ALAD@BCBA@C@@A@ABEACAAC@CBC@G@A@@A@C@CDCDE@CC@CAI@GCCAAEAAACAACCCAMEECCCAAAAAACA@@ACA@C@A@ABABAB@@ABA@CAAA@AA@@AB@@@@BB@@@B@@@@@B@AAAA@@AA@A@@@AB@@@B@@@B@@A@@@A@@@AB@@AB@@@B@@@B@@@@A@@@@@AB@@@@ABA@A@@@@B@BCBA@CBA@E@C@C@C@C@C@ABAF@BAD@DAD@DA@C@CAA@E@A@A@AAAACAAAACCAAC@@@AAA@A@ABCBA@ADCBCDABC@C@C@CACCCCAC@CAC@@@EAEAC@CAAAA@@A@@@@@@@C@ACCA"],encodeOffsets:[[108694,31312]]}},{type:"Feature",id:"511304",properties:{name:"嘉陵区",cp:[106.071876,30.758823],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@A@C@ABA@C@AA@@BC@@B@@C@A@A@@C@AAA@A@C@@A@AAAA@@A@@AA@A@EBA@@A@@C@A@@@CA@AAA@A@C@A@ADABADCBABABCBAB@B@@@BB@BBDBFBDBDBBBBB@B@BAB@B@DBBBB@D@DAB@DAD@@CDABA@AAA@@AA@A@CAC@ABA@ABABCBC@CAAACE@CACAA@CAAA@ABC@EBCBEDADCD@DADBF@DDF@@BBBBDBDBDBF@DBD@BB@DBB@@@D@B@B@DBB@DBBBDBBBFDF@DBFDD@DBBBBBBBD@DBD@DADADABADCBABCBCBE@CBC@CAE@ABAB@B@D@B@DAB@BADABEDC@C@C@A@ABAB@DABAD@BEFAFAFCDADEDEDAD@DAB@DBFBH@F@B@BCBCBA@E@EBC@CBABABBD@BBB@DBB@DABABABBB@BB@BDBDDF@DBBADAB@BADAB@DA@ADAD@BADABA@ADAB@DB@BBBABB@B@B@B@@ABCAAB@B@DAD@BADA@ADBBBBBD@BBB@D@BBB@B@@ABAB@BA@B@@B@D@D@DE@CBCBABAB@D@D@F@H@F@F@BBDBBBB@B@BA@ABAD@D@B@DABC@C@A@ABCBAF@B@D@DBDBDDBBBDAD@B@@B@BAB@BCDAFCDAFABAD@B@FADAFBB@DB@DBB@B@BB@B@B@D@@DBBBDDB@BDBFBD@FCB@@A@CBE@E@CBAD@D@DBB@@@BBD@D@BADEDABA@CBCBCBABABCDEB@BEBADEBC@A@ABAB@DBBBBD@@D@B@DADABA@C@A@CAC@C@A@@B@BFBFBDB@@@BABABAB@@A@AAEEAAC@CBADBB@BBD@BABC@C@C@A@@BCF@BABAHABBB@BB@@@@@@@B@@B@@D@B@B@D@D@B@B@BBB@BBB@@DCBAB@D@B@FB@B@@@BBBBB@BAB@B@D@B@BBHDDDD@@@B@B@BAB@D@BB@@@@BA@AAA@AB@B@FAFAF@H@DD@DABCBQDCDAB@DBDBBDBFBDBBJ@FBDBBLDD@@@DCFAFBFBFBF@DAHAFBD@D@FCF@DBDFFD@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@BABC@I@CBCD@B@N@DAF@FC@@@A@A@CBA@AB@@AB@BCBCBC@EBM@@DC@@B@B@B@@B@@@BABAB@B@B@B@DBBB@BDJAF@F@HBBBB@BDBBDFBBBD@@@@BBB@@@D@B@BABCBCBCBADCBADCBABADCBADCDADAFADAB@DCDADCDCFCDCDADBBBBDBD@B@DABAB@DBDB@@@DDD@BBB@DD@@B@B@D@@@BAD@@@B@@@BA@@B@@@@@B@@@@@@A@@@@@@BA@@@A@@@@@A@@@@B@@@@A@@@@B@@@B@B@@@@@@@B@@@@A@@B@@@BABA@@@@B@@A@@@@B@@@@@@@B@@AD@B@@@@A@@B@@@@@@ABABABA@@@@@A@@@@@@@A@@@@A@@A@@@@@@B@@A@@@@@@BA@@BA@AB@@@@@@AB@@@@@BA@@@@@@B@@@@@@@B@@A@@B@B@F@@AB@@@@@@@B@@@@@@A@@@@@@@A@@@A@A@@@@D@FAB@@@@@B@@@@@@AB@@@@@@@@A@@@@@@@A@C@I@AB@@@@A@@@@@@@A@@@@@@BABA@@@A@@B@@@@@@@@AB@@@@@@B@@@@@@@@@@@B@@@@@@@B@@B@@@@@@@B@@@@A@@B@@@@@@@B@@@@A@@@@@@@@@AA@@@AC@@@@@@@A@@@@@@@@@A@@@@@@B@@A@@@@B@@@@@@@B@BA@@@@@@@@B@@@ACAA@C@ABC@@@@B@@B@@@@B@@@@@@@@BB@@@@@@@@B@@@@@@BB@@BF@B@B@@@@BB@@@@@B@@BB@@BB@@@@@@@B@@B@@@@@@@B@@@@A@@B@@@@@@@BA@@@@@@@A@@@@BA@C@ABEBE@@@@BA@A@@B@@@@A@@@@@@B@@@@@@@B@@@@@B@BBB@@@B@@@@@@@B@@@@@B@@@@@B@FA@@@@B@@@@@@@@@@@@A@@@@@@@@@A@@@@@A@@@A@@@@@@@@@A@@@@@@A@@@@@AAA@AA@@A@@@@@@@@@AA@@@@@@@A@@@@@A@A@@@@@@@A@@A@@@@@AA@@@@@@@A@@@@@@@A@@@@@A@ABA@@@@@A@@@@@@@@AA@@@@@@A@@@@@@@@A@@A@@@@A@@@@@@@@@A@@@@B@@@@@B@B@@@@@B@@@@@@@BA@@@@@@@A@@@@@@BA@@@@@@B@@@@@@A@@B@@@@A@@@@@ABA@@@@@@DA@AB@@@@@@@B@@A@@@@@@@@@@AA@@@@@@@@AA@@@@@@A@@@@@A@@@@@@A@@@@@@@@@A@@@@B@@@@@@@B@@AD@BAB@@@@@B@B@BADABAB@D@@@D@B@@B@@B@@@@BB@@@@@B@@@@@@@B@@@@A@@@@@@@@@ABA@@@@@@@A@@@@B@@@@AB@@@@@@@@A@@@@B@@A@@@A@C@@@@@A@@@@@@BA@@@@@@@@B@@@@@BA@@@@@@B@@@@@B@@@B@BB@@@@B@@@@@B@@@@@@A@@@@@@@@@AB@AA@@BA@CBABABA@@BA@AB@@ABAB@@@@@B@B@B@B@@@B@@@B@AGAGACGKEECCAE@E@EBABAB@D@FAF@B@FAD@F@F@F@D@@@D@DADC@@DABCDE@@BABA@ABCBCBE@C@@BC@EBI@GCMCCAAA@@@AB@@@BB@CN@BABAF@@ADCF@BA@CB@BA@@BA@A@@BA@CD@@ABABA@CACAAAAA@AB@@ABAAAAAC@IAGAEACCA@AAAACCEECCEICCAACCCAACAA@CAGBEDEDCBEBCBCBCDABADAB@FAB@F@@@JBFFFDHFDDFFHFBBBD@BB@@BD@@@D@@@B@BABAB@BC@CBA@C@ABABEDCBC@@BCBC@CAABCBCBCBC@E@ABCACCGAA@CA@BA@ABABE@AAEAECACCA@CC@@AA@@@ACECGACAA@C@ADIBA@@B@B@B@BEHGDC@ABAB@@@B@F@@@B@DDHDB@@@BA@A@@@AEC@@AA@@BA@@@@B@@A@@@ABA@AB@B@@@B@B@B@@@BA@@@ABA@@B@B@DBBB@ABA@A@AAAA@@@A@@AA@AA@@@@AA@@AA@@A@@@AA@@@AB@@A@@@@@AA@@@@@@C@C@AAA@AA@@AA@@@@AAA@@A@AA@@@@A@@@@@A@@@AA@@@A@@@@@AB@@A@@@A@AA@@A@A@@@A@@@A@@@A@@@@@CCACA@@AAA@@AA@@CAEB@@CBC@@@E@AAC@C@CACAC@CAA@@AACACAA@@@AAA@C@A@ABCBCBC@ABA@CAAAAA@E@AACAC@@AAA@ABAB@BABA@A@@@A@@@AAAA@@AAA@ABA@A@@@@@@A@@@AB@AA@AA@A@C@C@AB@@ABAB@B@BBB@B@B@BA@@@A@@A@@A@A@ABA@C@C@C@A@@BA@@B@@@BBB@@BB@D@B@B@B@@A@A@@@A@A@@@A@CBA@@@AAA@AAAAA@A@A@@B@B@B@@BB@@B@BA@@B@B@BB@BB@@BA@@B@@@@CBABABABA@@@A@A@@AAAA@A@@A@@A@@A@@AA@@@@BA@A@A@@A@@@A@A@AA@@BA@@@@@A@@AAA@@@AA@@@ABA@@DA@@B@@A@@AC@@BC@@@AB@@A@@@@@@@@A@@@A@AA@@AA@@@A@AB@@AA@AA@@@@@ABA@@@A@@@A@@AAAA@@AAA@@@@AB@A@ABA@ABCBABC@A@C@A@CAA@A@A@@B@B@B@BABABABC@@@C@@AAAAAAACCA@A@@@A@CBA@CBEBC@C@A@AAAAACACCCACCAAA@@A@CD@D@FBF@DAFCDCD@DADADADCBAFCF@DA@@B@@ABC@A@AAA@CBA@AA@@@ABCBCBABADCBA@A@A@A@@CAC@AB@@A@@@ABCDCBCDADCFADADADCBAAAAAA@ABCBCA@AAA@CBCBABADCDA@A@AAA@@C@A@CAABEBCBCBAD@BABABABCBA@ABAB@BA@@A@C@A@A@@@@@AB@BA@A@@@A@A@A@@@A@@@CAA@@@@CDA@@A@A@@AAA@@@A@@@AA@CA@@@A@A@@@A@ACAA@"],["@@BC@ADABAFADAF@@@B@D@B@@B@B@B@B@DCBADABABA@A@@B@@C@A@B@DB@B@B@@@B@B@@DBB@@B@@@B@@BB@BB@B@@@B@@AD@B@D@BB@@BB@B@@B@B@D@B@@@@@@@BBBB@D@B@B@BB@D@@ABABC@@B@BAF@DBD@B@DAB@BA@C@@@A@A@C@A@A@C@C@A@C@A@@CB@@ABGDABC@A@@@ABAA@@AACACAA@CAABABAD@BBBB@BBD@@@@BABCBCB@ACA@@AAAA@A@C@A@CDEBC@A@A@AAC@AACA@@@A@C@A@@@A@AAAAACA@AAA@CBAA@AAA@AA@A@CBA@C@EBCB@BABA@A@@@@@AB@F@BBB@@@BB@BB@B@BD@B@B@BBD@@@@B"],["@@B@@@BA@@@@@@@@B@@@@A@@@@@@A@@A@@@@@@@@A@@A@@@@A@@@@@A@A@A@A@@@@@A@@B@@A@@@@@@@AB@@@@@@@@@B@@@@@@@B@@@@@@B@@B@@@@B@@@@@B@@@@@@@B@@@@@B@DA"]],encodeOffsets:[[[108450,31213]],[[108444,31208]],[[108308,31643]]]}},{type:"Feature",id:"511322",properties:{name:"营山县",cp:[106.565519,31.076579],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@HDFBDDFDD@D@DBDFBBDBB@FBD@BBBBBBBD@D@F@B@B@FADAD@FDBFAFEFCFA@@DA@@@@BA@@B@@@B@BA@@B@@@D@B@@@D@@@B@BBABCB@ACB@@A@@@AB@@@B@B@@BB@B@@@@DD@@@@@@BB@@@@B@@BD@@B@@B@AB@@AB@B@@@B@@@@B@@@@@BB@@B@@B@@@@BD@@BB@B@@@B@@@@@B@DA@@B@@A@ABAA@@A@A@@@@@ABAB@D@@A@@@A@AB@@@@A@@B@@@@CBCHCDCFCBAD@D@DDBPBFD@@@@BB@@@BB@@BB@B@@@@@@B@@@@@@@BDBH@DB@@DDFTBDD@B@DABABCAC@CDC@AB@HCFCDABCBA@A@ABAD@B@BBBDDHDDBBB@DAFAD@BBDDDBB@DBJLFHBBD@DB@BBBBBDBB@B@@@@BB@BAB@DAB@@@B@DDB@BBB@B@BAB@BABAB@B@BAH@D@DABCBA@C@C@CBCFADABBB@DDBDBBB@BABCBABCBA@AB@D@BBD@F@BCDCFANA@AFA@AECAA@ABADAFAB@BABEDGHIDCBA@E@C@A@@BCBCB@B@B@BCB@BA@@DA@BBAF@DAD@AA@@@C@C@AAC@A@A@AAAA@A@CCAAACCOAECAAC@CDCFEDABQDGD@BAD@DBFD@@@@BBDBBBBBB@BBB@DABABAAAWYIGGIAABCBABA@ADA@@D@D@B@FGHGJE@CAAACAA@@@CBC@@@ABA@A@ACE@CACAC@@AA@A@S@CA@C@ABAF@B@@A@A@@@AE@C@@CECEEACACA@E@E@EEEBE@@BE@EAEAIBC@ADCFEHEFEBC@A@AACACAACAAE@CBE@ACCECCA@ACA@A@E@@B@BE@@AAA@GBA@A@@C@I@A@@@@BABCDC@C@A@AEIAAA@C@A@ABC@CAGAEAG@EACCAACEAE@CAEAC@@GECCC@E@KECAE@@@EBCAE@CCGGCEECAACAE@ICEAGCCCCCCICCCAA@A@AA@@@ABA@CB@@A@@BC@A@A@ACACAACACACCCAAAC@CCGACAC@E@C@CBA@A@ACACACAAE@CACCCG@CA@AA@@A@@@A@A@A@@AAE@A@A@@@@BA@@B@BB@@@B@@A@@BB@@@@@B@@A@@@@@@@A@@@AB@@A@@@@A@AA@@@AA@@@@G@A@@B@@@@A@@@A@@@@A@@@@@C@@@AA@@@@@@@AA@@@A@A@@@AB@B@@@B@@ABA@@@@AA@@C@A@AA@@@@@A@@@@BA@@@AB@@A@@@A@A@C@AAEAACAEAEAE@A@GDCBCDABA@C@C@C@GDE@E@C@CAE@E@AAC@A@A@@A@CB@BABADCBCBABABA@@BABA@A@E@A@@@@CA@A@@@@@A@A@AA@A@AACAA@AAA@AAA@A@A@CBCAC@ACAE@AAA@@AA@CAA@AAA@A@C@@@A@A@@AACAAAA@AC@AACAC@AA@@AAA@AC@A@A@A@A@A@A@A@ABAB@BCBA@ABC@@@C@C@C@ABCBC@ABC@AAE@AAAAAAACAAAACBA@A@C@G@C@ABCBA@A@AA@AAAAACE@AA@A@@@KBC@A@A@CIA@AAABC@CBC@@BCBA@@BCBB@@B@B@B@@A@@@ABABA@ABA@@B@@@B@BBBAB@BA@ADAB@BABAAEACAAIAACAE@EBCBGTAFCBAB@D@B@BBBBBBDBBBB@@ABADA@EBK@E@AB@B@FADADAD@B@BDBBBF@B@@@@BB@@H@B@@BD@@B@BAB@BAD@BBB@D@BAB@@BBBBB@BB@BA@@BABB@@@BBB@BB@@BAB@BAB@BABABC@CBA@A@C@AB@@@BAD@B@DAB@BAB@@A@A@C@@@@BABABABABBDADIDA@ABE@ECC@@D@BDFDFBDAD@BCBCAGAE@EBI@ICOAEACBCBAFCBC@C@A@CAECC@C@E@CCAAA@E@C@A@A@CBADCBEBG@A@A@@BCD@BEDAB@B@B@B@BBDAB@BABAB@@@D@L@BABADA@EBA@CB@B@DABC@ADAD@B@H@D@BBB@B@BABA@CAC@AAGBCBABCB@BDDBB@@@D@F@BBBB@BB@@@B@DBHBB@BB@B@BAB@@@B@BBB@@B@@B@D@DBB@HBB@BAB@BBB@@@BBBAB@B@@@@@@H@B@B@DABA@C@ABA@AA@AB@@A@A@@A@C@CBCDCBCDA@ABBB@BAB@@ABC@A@@BAD@BA@C@CAA@AB@B@BD@ADBB@DDDBBABADCFCDADABABA@C@EAABA@AB@B@D@DADABA@GAAB@@@BB@BBDBDB@B@F@D@D@B@BB@B@BBB@@B@D@D@D@HBD@H@DBBBB@@B@B@DAB@B@BBBF@DAB@D@B@BBBD@FBF@B@NCB@D@D@DDDDBBB@RDFDBBBD@B@DAFCFADABBBBBDDDDBD@BB@DBB@FB@@DBBBD@BBD@FD@B@D@D@BBDDHBH@DBB@BDBHBBBBBBD@F@BDB@BAB@@@BABCB@A@A@@@C@@@A@@AA@@AA@@A@@@@@A@C@C@@@A@@@@@C@@@A@@@@BA@@B@B@@BD@@@BB@@DD@HDDBFBDFBDDD@D@D@H@DBBD@B@B@F@B@DA@A@A@@BABA@ADC@@B@B@B@@@BA@@BA@ABC@@BAB@@B@BB@@BBBB@@@BA@@@AAAAAAACACAAAAA@@BA@@B@B@D@B@BB@@@B@@A@@@BB@@BA@@@@BABA@ABABCB@BAB@B@D@B@BBB@DBB@@BB@B@@@B@@@@A@@@ABAAA@@@A@@A@A@@@@ABAD@DA@@BA@@@AA@A@AA@@@@@A@@D@B@D@D@BBBA@A@@@A@A@AF@@B@B@F@B@BBFBD@F@F@BBBB@@BD@F@F@BB@DADGFAB@BBDBBD@HCF@BBFDFBFBHDB@FBFDBFDDDDB@B@BCBCDAFAFBFBFBDADABABBB@@DDDFBHFB@D@JAHCFCB@@@D@BADCFAFADAJ@DBDBBDDBFBD@FAD@HD"],["@@A@@@@BB@@@@@@BB@@@B@@B@@@@@A@@@@@@@A@@AA@@AA@B"]],encodeOffsets:[[[109274,32046]],[[109515,32016]]]}},{type:"Feature",id:"511323",properties:{name:"蓬安县",cp:[106.412136,31.029091],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AACJQBGA@@@A@EBC@EBC@ABCBE@A@A@EBAAAAC@AAAA@AAA@AA@CBAAA@ABA@CBC@@@ABA@AB@BA@A@@@@AA@@A@A@EAACACAC@ABAACAA@A@@@ABAJCDAD@@CBEBABEBAB@BAF@B@@@B@@@B@B@B@B@B@BC@CBA@A@@@@BAB@B@D@B@D@D@B@D@BCHFHB@@B@B@@AB@@EBA@C@E@A@CBC@CBCBAAA@CHGF@B@@C@C@C@CBCBC@CBC@A@@BAD@BA@CBAACAC@C@EBADCDADCDCB@@EBCBCBIBA@EAA@ACACA@CAC@E@CBA@@BBFAFCJ@DABCBCBA@CAA@AA@@C@EBCBCBCBABAB@BAB@D@D@@@@BBAB@B@@@B@DABABCBABCD@DADBBCDABABCBE@C@ABA@CDA@BBEFCDADIHCDCBAD@DADE@A@IEC@EBGFCBC@CA@@C@@BA@ABA@C@A@A@AB@B@B@BA@A@AA@ABC@@@A@CBAA@A@A@AAA@A@AD@B@B@B@BEBIBA@A@@BAFABC@CBEBA@ABAB@BAD@BADBDBBB@JKNIHADABB@BBBBBBBBBDBDBFDDBHBFBB@B@B@B@D@B@BB@B@D@BABABABC@CBABCBABCBBB@BABCBEBA@C@@B@BBB@DABAB@B@BBD@D@DCBCBC@CBADAD@DBD@BBB@DBDADCDADCB@BABCBKFEFGDA@CBA@@DABAFEFSNCDCDABA@CAAAAAAM@AAAAAA@@B@B@BABA@A@AAABEHEBA@AADEAAAAC@@A@@A@@A@@A@@AB@@@ACAACBAB@@A@ABABADABA@EAECEAG@MDKBGDCBABBBB@B@FBDB@BAD@BBF@@BBB@@B@@@@@@@@@BABB@BBDBFBB@@B@@@B@BA@@BA@@@A@@@AB@D@@ABGB@BA@@B@BDB@@BB@@BF@BB@BABABA@ABAB@BBBBB@DBF@B@B@BABAB@BBBB@BBB@B@B@@ABCDCBA@CBA@C@A@@AC@AAC@C@A@CBAB@BAB@DABA@A@C@A@A@@@@B@B@B@D@@ADADCDCDCDADABA@CAEBE@A@AB@B@DB@@BBDBBBD@D@D@BAB@D@B@B@B@D@B@BBBDBB@D@DB@B@BCBEBADAB@BBBBHBBAB@@A@ECC@A@C@ABABBBBB@BB@@BA@@BBBDBFB@B@@@BABCBCBAB@BBBDADBB@BBBBBBBBBBDAFAB@B@FBDBB@B@@A@C@ABCBCB@D@BBBB@BDBDAD@FBDB@BDDDF@@@F@JBFDDFFFBD@F@B@DBB@B@B@@BA@ABA@@B@BD@BB@@@BA@@BAB@BAAA@AB@D@B@BDBD@B@B@FBDBB@DBB@BBD@DBB@FBBBJFADAD@H@DBFFH@B@DBBBD@D@DAF@D@B@@BBB@FDHDDD@D@DABCBABAB@@@B@BAB@@AB@B@BABA@AA@@AB@B@BAB@@@D@@B@D@B@B@@BBB@B@BB@DBD@D@BBBBB@B@BABA@AAA@AB@B@BBB@D@BABA@BB@@B@B@BBBB@BA@A@CBAD@D@DBDBD@B@B@@ABAAA@A@@@AACAC@@@A@CACACAC@AA@A@C@AA@@AAACAA@C@CDABBB@BDDB@@B@@@BABADAB@D@D@DDBBBB@D@F@D@FBDBDBBDDDBBBBF@BBB@BBDDDDBDBBBBBDBD@JDD@DBD@B@DB@B@DBHBFBFDDB@HBF@H@LAJ@FBDFBFBDBB@@BBBDDBDBDBB@DBFBDBDDBF@BBD@@BBD@D@D@FBDBB@DABEDA@CDEDABADADABCB@BADAD@D@DDBBBDBBBBF@D@F@BDBBDBB@HCDCDCFAD@B@FBB@BDDBF@B@B@H@D@B@@A@A@A@ABA@AB@B@B@BDB@BBD@H@D@DBBA@@@@@CA@@A@@AC@@@A@AB@@A@@B@@@D@@@@@B@@@D@D@B@@@@@B@@@BB@@BB@@@B@@@D@@@B@BDABA@A@@BA@ACA@A@EACAAAAGACA@AAA@CAGCGAC@A@C@C@AECC@AAC@AACA@@EAA@CAA@@AACCCCCAAAABABCDEBE@C@AACAAECQCA@AACCCCC@C@A@MDA@E@EAC@AA@A@A@CBA@CAEAAA@A@CBA@A@@@AAAA@C@GAC@G@C@C@C@AA@AAA@A@@A@A@C@C@E@ACACAAAA@@A@@BAHBB@BABC@C@C@ABAB@BAFBD@B@BABABCDCDEBCBAAACC@CAABCC@@A@ABAB@DBD@B@@ABC@AB@D@BA@@BA@AAABAB@DCDADCDAD@B@@@@B@BA@@BBBB@BAD@B@BA@C@A@A@G@@@@A@A@ABAA@@A@AAA@ABA@GAA@CAC@A@@@@AA@AAA@@@A@ABA@A@@AAAAG@C@A@@AAA@AA@A@E@C@@AACC@ADABADAHABBD@DBB@BA@A@AAA@A@C@G@ABCBCD@BA@C@ADAB@FAB@BCBA@A@K@C@@BABA@ABAAC@A@A@A@ABAFC@ADC@AB@B@H@FADABCDAB@B@D@F@B@BBDDF@D@D@FDDBB@D@D@DABEDADAFBPBJDJ@FAF@HBDBDA@ABCACCECE@A@CD@FDF@BAB@JCBCACBABABABA@A@@D@B@B@@@BA@ABA@C@ABC@A@@BAD@B@B@DAD@BABA@ABA@ABA@AA@@AAA@A@@AAAB@@ABA@@AAAAA@AA@ABC@A@AAC@ABA@ABA@@@AC@@@A@GA@@A@@A@E@AACA@A@ABCBCBC@E@ABAF@L@FAB@BCBA@@AAAAACAAAA@A@A@CBADABEHSDAFAF@DBBBBJDBFBBBBA@ABABCB@@ABAAA@A@A@@@AB@BAB@BABA@@B@@@@A@A@AA@DA@AB@DA@AD@DAD@BABBB@DJB@B@D@LA@@B@B@@BDFBBBB@BBBB@B@DABAD@H@D@B@B@DA@AAA@A@A@CBADADCFADEFCDADEBADCBCBCBE@A@CAC@@@ECCAAEAEACCAEAC@@@A@CB@DEBA@EAI@AAACCCA@A@A@C@@@A@@B@B@@@@A@@@EABCBC@A@A@@@@AAAB@@ABA@A@@@A@@AAA@CCA@AAA@ACA@A@EBE@IDCAC@CCECCC@AA@GCGA@@CDCFCBCBE@A@A@AA@ABCDA@A@A@A@CAC@AAEAA@A@A@C@A@@@A@CBCBABABABCBABABADABC@A@AACACAAACAE@CACAC@C@A@C@AAAKCOECAC@G@A@AAC@AAC@@@A@@@IAAAAA"],encodeOffsets:[[109141,31494]]}},{type:"Feature",id:"511321",properties:{name:"南部县",cp:[106.036584,31.347467],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@B@@@A@@@@"],["@@B@D@FDB@@@BAAAAGAA@ABABCFADA@A@ACAC@A@CAAA@A@A@C@AA@C@AACAA@ABCB@B@B@DDBBF@D@@AD@D@D@DABABA@C@AAAACAAAA@AB@@A@CBCF@D@J@FAD@BA@EAEAC@ABA@ADADCDCBE@CA@@@A@ABABCAA@AAA@CA@@OAE@CAAAAC@C@CBEDC@CACAA@A@A@AB@B@BCBABAB@@AB@@AB@BA@A@A@AA@@CAA@@@AB@BABAB@@GLA@@BAAGA@@A@ABA@@@A@@B@B@@AB@@C@CAC@C@CBAB@BAF@B@@A@C@AAA@@@CAC@CBGDCBCBCBA@AAACC@A@ABABABAB@DAB@B@B@BB@BBBB@DDD@BBBA@ABE@ODCAAAAAA@CBC@GAGAC@A@@B@@ADBDB@DBDB@B@B@@AB@@@@@@A@@@@BA@@@@@@B@@@@A@@@@@@@@@A@@B@@@@A@ABA@ABAB@B@B@@@BBBJBB@DBDB@B@DAB@D@B@BDJB@FBDBDAB@BBDD@B@B@FAB@@CBCAABA@ABCBABABAB@D@@@@BBBDBBAB@@@BA@@BAB@BADA@CBCBADCBA@C@G@C@A@CB@@AB@B@@@D@B@BAB@BABA@@@@A@@@C@@A@@AA@CAC@CDAB@@ABA@ADABCBABA@ABA@A@A@AAA@AAAC@A@A@AAA@@A@A@ABABC@A@AB@BBH@B@@@BA@AAA@A@A@ABAAA@@A@A@CBE@@@AC@A@A@@BABAD@@A@@@AACA@@@A@@BA@A@AA@@AC@A@ABAB@BAJBB@D@@@BA@@@@@@@CBA@C@A@A@AAAAAA@C@CBABABCDABC@@B@@A@ABA@AAC@ABE@A@A@@A@AACBC@AA@A@@@AA@A@A@CB@@ABC@@BAB@@AB@@E@CBA@EAAACAA@CAA@A@@@@@@@C@@BA@A@A@@AA@@G@A@@AAA@A@A@G@@@@@@@@B@@A@@BA@A@@@C@AAC@C@CACAA@E@A@ABCBCB@@GDC@EBC@A@CAC@CACGAA@@AA@A@@A@A@A@A@A@A@AB@BA@@BAAA@ACA@@B@B@BB@@B@B@@AACAACC@ABAB@B@B@D@BA@CACBAD@BBBDDD@B@@@@@BB@@@@@@@BA@ABAB@B@D@B@BBB@D@@BB@DBBABCBAB@B@B@BBD@B@BC@A@C@CB@@@BABA@A@@B@BA@@@A@A@AAC@@AAB@@A@@@@@CAAA@AB@@@AAAAA@A@CBABAB@@ABADAD@@@BFD@@@BA@AB@@@BABCBA@E@@@@@C@C@A@EAAAC@ABABBDAB@BC@A@C@ABA@ABABA@A@CCACC@CAA@AA@ABA@@AAC@CBCDCJCFAFADB@@B@B@BABAB@B@H@DABC@A@AAACAA@AA@A@A@A@C@@@@A@@AAA@A@ABA@AACA@@A@@@AB@@@BA@A@CEAA@@AB@@@DABABABA@@@@@@@C@A@C@CBABA@CBA@A@@A@ABAHEBA@A@AA@@AA@CBCAAA@@@A@@@AA@E@@AA@ABE@A@C@CBAB@B@@AD@BA@@BA@A@@@B@BB@@@B@BA@ABA@ABA@A@A@CACAE@A@ABABABCBABE@C@EAE@A@ABABAB@B@B@@@DAB@DAB@DBB@B@DABABAB@DABADABABABC@CBAB@BABCBA@C@GBABADABCBA@ABA@CAA@A@AB@@EAA@AAACCEAAA@CBABA@@D@BBD@@@B@BA@AF@@BD@BBDBBFHBB@D@B@BBD@@BD@BFDDDBB@BBB@DABC@CBA@@B@B@JBB@BD@B@D@BABAB@BBB@JFB@DBD@B@BBBDB@B@@BB@@B@BABBB@BBBBBB@B@DBB@@@@B@@C@C@@BA@@AAA@@@AA@A@A@A@@BBBDFBBBB@BBBBBDBB@BB@B@@@DBB@B@DBB@AD@B@@B@@@DBBBD@DADAB@@AD@B@D@F@D@D@BC@@@ABA@@@AAAAE@@@ABABCHAD@BABCDCB@@A@C@@AAA@CAAAAAAACCACAC@ABABAAC@C@C@EACACBCBA@A@@A@@ACCCCCECAC@EAAA@C@C@CAABABAB@BABAB@BAD@B@BDD@DBBAB@BAD@B@BAB@@A@AACCCAA@A@CBCBABAACA@A@A@AAG@AAAAAAB@BABA@CBC@E@A@ABABABADABABA@AA@@CACAAA@C@ABCAAAAA@CBABGBGDA@EDABABCBA@A@A@A@ABCBAB@BAB@BADABABADCBADA@@BB@BBB@@B@@A@@@ABA@A@A@ABABAB@@ABCB@BABBB@DB@@BBB@BABAB@BBBB@B@@BB@AB@B@B@D@BBBB@B@BA@ABCBE@@B@B@BB@B@DAD@DBBB@B@BBDBBBDDBF@B@DEFABA@A@A@A@A@BBABABEDCFCHADAB@DABB@AD@BA@@BA@A@@@AAAAA@A@A@@B@@@@@B@DABA@@BA@@@@@AA@A@@AAE@A@@A@A@AA@ECCA@A@AA@@@A@A@A@A@AB@@A@@@A@@@@BA@A@@@A@@@CBCB@D@@E@@@A@@@@D@@@@@BB@B@BAB@@@@@BB@DB@@@@@@@@@B@@@@@DDBBFD@BJDBBBBBDBB@@@@@B@BAB@B@@AFABCBA@@@@@@BFBBBBBBB@@@@CDCBA@DB@FAF@D@B@@@@B@@B@B@@@BB@DBB@BBF@DBD@BD@@@@BDBB@BCD@B@BB@B@D@B@BB@B@B@@ABBBB@BBB@B@BBBBBBBBB@FADADAB@BBAD@@AB@@B@@@@@@@@A@@B@@@@@@B@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@AB@B@BAB@B@@ADA@@BB@B@B@BABAB@B@@BB@@B@B@BB@DBB@BB@B@@@B@B@B@B@D@@ABABCBAB@B@BB@BAB@DAB@F@B@@BDFBB@@B@@@@A@A@AB@BAD@B@@@@B@FB@@BDAB@@@B@@ADC@ABA@AB@B@BB@@@@D@B@B@@AB@@A@ABA@C@CBADADAD@B@BD@@B@D@DA@@BAB@@AB@DAF@BBHFDBDBF@HADAHAF@BB@BBB@D@F@DBBBDDBHBBBB@D@FBD@DAB@DA@C@AAA@A@@@C@ABA@@DKBC@E@AAA@ABABCDA@CB@B@@@BBDD@@B@B@DAB@B@@B@BBB@D@DBBBD@DBBBBAJABABAB@@@BBBBB@B@D@B@DBBB@DBD@BBB@DBD@F@DABB@@BB@DABABEDCBA@AD@BBBBDB@BBB@DDDBD@FBD@BB@BBB@@BB@B@B@B@BBBB@DABABABCBA@C@E@CBAB@@AD@B@B@DBB@D@B@D@BBBB@BAB@@@BBBB@B@BABBHBDBB@B@@@@A@A@E@CBADE@@B@@A@@CAAABCF@F@BCACAC@A@CDCDBHAF@DA@CAE@A@AHAD@D@D@BC@A@CAC@CBAHDDBD@DADCBC@CBA@@BCF@J@L@NAHBD@F@FBFADCFCDCBCDCFAB@DBD@DBDBDDBB@DABADABBDDBD@D@DAFCBABADCF@DD@DDDBFBBDBDCBCDC@CD@B@BBDBFBBADC@C@CAA@CAA@CBEDC@CBA@A@CBE@C@E@@GKACA@C@GBA@A@A@@A@@BADA@@@AAAA@C@CBABCBA@A@A@@@AAAAAE@ACCACAGAAAA@CDCFCBCLWBCBADCJABAB@@C@AAAMAECA@AAAA@AEECCAC@ABCBC@AAC@AACBCDAB@BABAB@DBDBD@DBF@HBH@B@BB@BA@CBCDAB@DB@BBB@DAB@DADCBCDCBEDC@CBADCBE@@ACACC@CACBE@C@C@CAA@@E@A@C@ABADAD@BADABADA@AB@@@@@BBBBDBB@B@B@D@B@@@BAB@B@B@DB@@B@@AB@@@BA@@B@B@@@DABA@CBCBA@C@A@A@A@A@CAA@@@@@AAA@A@ABABCFC@ADCD@B@@@DDDBBB@BA@@BC@CDCBBDBBB@BB@B@DBDF@D@D@DCFEBABA@ABA@E@A@A@A@ABA@AD@@@B@D@DBF@D@DA@A@A@CA@C@GAA@AAC@AAA@GKBAACAACAAA@C@AB@BAB@BADABADBBBHHDDBBD@DBB@D@BAAC@A@C@@@ABA@ABADADAB@BABADAB@DB@@DDB@B@B@BAB@DBBBB@@@B@BAB@@C@@BAB@B@B@@@BB@@@@@@@BAA@@AB@@AB@BB@@@BBB@B@@B@@BB@BB@B@BB@BBBBBB@D@DBBBBB@@AB@@A@A@@B@BBB@@AB@B@B@B@BBBDF@@@B@B@@AB@D@B@B@B@B@BB@B@FBDBBDBD@B@D@B@BBBB@F@B@BA@A@@D@BBBBDBB@@ABABAD@B@D@BDBBBD@B@@BB@B@BBB@BABAB@BB@B@B@D@DBDBBB@@B@FAD@FAF@DADABA@ABABCD@B@D@B@B@DADBB@PDF@B@D@D@BA@A@GBEBEDKBABAB@@AB@B@HAB@ZDB@DBB@BBDBD@D@F@B@DB@@DBDBBB@@BBD@BA@@D@DA@@@@@@@@B@@@@B@@B@@@@@@@@B@@B@FDHDDBB@B@DADDBBHH@@B@B@BAB@B@D@BD@@BDBD@DBBB@DHBBF@D@DAD@H@D@DD@BBB@BB@@@FBB@B@BA@@@C@CAC@EBEBA@AB@B@F@D@DBBFHDF@FAB@BBDFFFFADCCG@EFCDAFHDBBBB@D@DAHAB@DA@CBC@AB@B@D@HBF@FCDBB@DDFDFDDDBBBBDDDBDBB@D@B@BADADADADADABAB@D@D@@@BF@B@B@@@BABEDA@@B@BB@DABADAD@D@@@D@FB@@B@BB@@@B@F@B@FBD@BB@B@@ABA@@B@BAB@B@BB@@@@@BAB@BA@BBBBBBBB@B@L@B@BBBB@B@DBBBB@B@BA@AB@B@B@B@D@@A@@@A@A@@@E@A@C@C@A@ABA@ABA@@DCBAB@DAFBFAFADABCB@BC@A@C@C@C@ABABA@ADCFAD@DADAB@BABCDCB@BADCDADCBCBAB@BADADABADC@C@C@AAE@ACC@CCCAAGAGBIBCACC@CAA@CF@D@B@JBDBHADEBABABCJ@F@D@DADAD@@@FCBADCBABCBAB@BC@ECIGM@CAC@AAA@@C@A@C@G@E@CAACACBC@@AACAI@C@AAA@E@C@C@CCCAACG@C@ABEDA@A@CBC@CBE@C@E@C@AAABA@ABCBA@C@CA@@@@@@@@@@A@@@@@@@@@@@@@@@@@C@@@@@A@@BA@@@@@@@@@ABAB@@BB@@AB@B@BDBB@@B@@@D@@@@@@BB@@@@@BB@AB@BBBBB@@B@DB@D@BBB@@BB@BBBA@@BAB@@@B@@@B@B@@@BBB@@@@@@B@@@DBDB@B@BBB@@@B@B@@ADCB@B@@BB@BB@BBBB@B@B@@AB@B@B@@@B@@@B@@ABA@@@C@C@A@AB@A@@ABA@AB@@AA@@AA@AA@@B@@C@@@AACBA@A@@@@@BA@@@@A@@A@@B@@@@@A@@B@@@@AB@@@@@@@A@@@BC@A@A@@@@@@@@BC@@BA@@B@@@@A@BA@@@A@BAA@@AABAA@@@AA@@@ACAA@A@A@@@ABBB@A@BA@@BC@@@A@AB@@B@@@A@@@@@A@@@AA@@@@@AA@A@@@@A@@@@@@A@@A@@D@AA@@@@@@@@C@@@@@@@B@B@B@@@@AB@@@A@@@A@BAA@@@@@@@@@B@@@@@@@@@@@@A@C@@E@@@AB@@AAA@@@@@@BAAA@@AABB@A@BBA@@@@A@A@@@@@B@@BA@A@@@A@@@A@@ABA@@AB@@AAAA@@@BA@@@AA@@@@AA@@@AB@@@BAB@@@@@@@@@@@@@@AA@@@@@A@@@A@@A@@A@@A@@@@@@@@@@@A@@B@@A@@@@@A@@@@@@@@@@@@@@@A@B@@@@A@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@A@B@@A@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@@@BB@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@AA@@@@@@B@@@@@@A@@A@@B@@@B@@@@@@@@B@@@@B@@@BA@@@@@@@AA@@@@@@@A@@@@@@@@A@@@@A@@@@@@@@@@@@@B@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@AA@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@B@@A@@A@@@@@@@@@@A@@A@@@@B@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@B@@@@@@@@@@@AA@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@A@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@ABA@@@@@A@@@@@@@A@@A@@@@@AB@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@AA@B@@A@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@B@@@@@@@@@B@@A@@B@@A@@@@B@@@@A@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@A@@@AB@@@@@A@@@@@@A@BBA@@@@@@AA@@B@@@@@@@@@A@@A@@@@B@@@A@@@@A@B@A@@@@@@A@@A@@@CAA@@AA@@A@@@A@@@@@@@A@@@@@@@AB@@AB@@@AA@@@@A@@@A@@@AA@@@@A@@B@@@@@@A@@@@@@A@C@@A@@@@D@@@A@@@@AA@B@@A@@@@@@@@@@B@@@@C@@@@@A@@BB@@@@A@@@@@B@@@@@B@@@@@AB@@BB@@@BB@@@@@@@@@@@AC@@BA@@@A@@A@@@@@@@@A@@B@@@BGMAC@C@A@EBC@A@A@CBCBCBEBEFCDAD@BC@@A@@@@@A@@@@BA@@A@@@@@A@A@@@AA@@@@@@BA@@B@A@AA@@@@@@A@@A@@@@@@A@BA@A@@@@A@@B@@@A@@@@@@@AA@@@@@@@@@@@A@@@@@@@@@@@@@@A@@A@@@@B@@@@@A@@@@@BAA@@@AB@@B@@@A@BB@@@@A@@@@A@@@@@@@A@@@@@@@A@@@@AB@@@@@@@@@@B@@@@@A@@@A@@@A@@A@@@BAA@AB@AB@A@@@@@A@@@A@@@@A@@B@AAB@@@B@@ABA@@@@B@@@@@@@@AB@@@@@@@@@B@A@BA@@A@@@@@@@@@@@@@@A@BB@@@@@@@B@@@@@BA@@A@@@C@@@A@BAB@@A@@@@@BB@@@@@B@@@@@@@@@B@@@@@@A@@@@@@@@@A@@BAA@@@B@@@@@@@B@@A@BBA@@A@AB@A@@@@B@@@@@@@@A@@A@B@@@@@@AB@@@@B@@B@@A@@B@AA@ABA@@@@@@@B@@@@@A@@@@@@@@@@@A@@@@A@@@A@@@BA@@BA@AA@@ACA@@@@AA@@A@@@A@AA@AB@AAB@@@@@@A@@@@BAB@B@@ABA@B@A@A@A@@AA@@@@BABA@@@@@@@AA@@@BA@@B@@@@@@B@@@@@B@A@@@@B@@@@@@B@@DB@@B@@@@AA@@@@@@A@@B@BB@BB@B@@A@@@A@@@@B@B@@A@@@@@@@@BB@@@@@@BA@@B@@@B@@@@B@@@B@@@A@@DA@@BAD@BCB@@C@@DAB@@@@AB@@A@@A@AA@AB@@A@@A@@@@@A@@A@@@@B@@@@@AABA@@@BBA@@@A@GDB@GAAC@@EI@AGDAEACEFGCMBABABCCA@CDAD@HCFAHCB@LCB@F@JDFBBDFDBBDD@C@@BA@A@ABA@@BBB@BA@A@ABA@@BA@A@A@@BABADABA@C@CCCGCECA@AA@@@A@CBE@C@CACAA@C@AEGAE@C@GBCBCIEAAEAA@CAC@AAA@CAA@CAEAA@A@C@CA@A@A@CBAB@BB@ABA@AB@@A@@AAC@@A@AB@BAB@@AA@A@A@CAA@E@C@EAEECCAE@I@E@@CECC@ACAEAC@CBCA@AAAAAC@A@ADAD@B@D@BA@A@CAEAA@A@EBCBAAAAAAAAAAA@CACBAA@ABADADABA@A@@@AEACAAA@AB@@AA@@AAAAABABAD@"]],encodeOffsets:[[[108026,32309]],[[108725,31837]]]}},{type:"Feature",id:"511325",properties:{name:"西充县",cp:[105.90087,30.995683],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@C@CCGCAAA@C@A@A@ABA@AAAA@@A@A@@@AB@BABCBCDABABCFAD@BADABABCBA@C@C@CAC@C@C@@@E@EBCBC@ADAB@BBD@FCBCBCB@@@@EDAB@@CB@FAB@F@DADA@A@CAAAAAA@A@@BABABC@@A@ABA@C@A@AAAAACAC@A@A@C@@@A@@AAA@ABA@CBABA@@AAA@EAE@CBCBCDAD@B@B@@BB@DB@B@@BBBAH@D@BBFAFABCBG@G@CBCDCB@BCH@@CFAB@BA@@BAB@BAB@BAB@@ABA@ABAB@@ABAD@H@@C@A@A@C@A@C@AD@B@D@@@B@DBDADABCBCB@B@B@DD@DBF@FDBBBDB@@B@BBBB@D@BBBBBBD@B@JAD@F@D@FAFAB@HAB@DBD@DBDBBBBDABA@A@ABAB@@BBBB@D@DABCB@B@B@BDBD@FBF@D@B@BABADAFAD@DAD@B@BBBDBB@B@DBBB@DBD@B@B@FDBBDB@B@DBDBBD@BBDB@BBD@BAD@D@B@B@F@F@FAD@D@@B@B@DB@@BBBDAB@BCBEDCBC@@BABBBB@BAB@DBB@BBBBBDBDDBBD@BBFABAFADCB@BCBCDADAD@DBDBDBDBBB@BA@AAI@CBA@@D@B@DDBDBB@DDJ@B@@@BAB@@CBC@A@@BAB@D@D@B@B@D@BADCD@B@BB@B@DBB@DB@DBB@D@B@BADABA@ABAD@B@BDBBBABCDABABA@ABAB@B@DABA@ABABAD@B@F@@@B@@ABA@@@AA@@AAAAA@@@A@CD@@@BAB@B@BA@A@@@AA@@AAA@ABA@A@A@@@AA@@A@@@A@ABC@@@@BC@CBAA@AAAC@A@CBADAD@D@D@@@BB@B@BBB@@B@BAB@BCB@BADBB@BB@B@B@B@D@@@B@@@B@B@@AB@B@B@B@@B@BB@@@BB@@B@@BBBA@AB@@A@AB@BA@ABCAA@CAA@ABA@@B@BB@BB@B@DABAD@D@D@DBDABADABBBAF@DAFADABABCBADADBD@BDBBBBB@BBDBD@DBBB@@@B@DBB@B@@AB@@ABC@@@ABADAD@B@F@BAB@@BF@B@@B@@@B@@BBDBDAB@@BB@@B@BABGFAB@B@BB@B@DAB@BADAD@B@D@@@@@@@B@BABABA@C@@BA@@BBDFB@B@@A@@BA@@B@@@DBBBB@BAB@B@BB@@@B@@D@B@B@B@B@@BBBBDBBB@D@BA@C@G@ABABA@A@A@AA@BCBEDEDIDCDAD@BB@@AB@BBBB@DBD@BDDDB@B@BABAB@BAD@B@D@@ABAACBABAD@BBFBB@D@D@@@@@F@B@DABA@A@@BAB@@A@@EC@A@@BCBCBA@@BABADAB@B@BBBB@@A@@BBBDB@@@@B@@@BA@BD@BBB@B@@@B@@A@AB@B@BA@A@@DAD@B@D@@A@AAC@A@A@ABADABAAA@CAA@@@CAA@A@A@C@ABABAB@@A@@@@@@AA@@@@A@C@CCAA@ABCDADBB@@A@C@A@ABABAD@BDDBBB@@@A@AA@@A@A@AB@BDB@BB@AB@@ABAB@B@B@B@B@B@@@@BBB@@BBDHDBD@DBB@D@FAD@HC@@DADABAB@F@B@DBDBD@D@BBD@@@B@B@@AB@@@@A@@@@@@H@B@B@B@BB@@@B@HB@@BB@B@B@@AD@@@@@@@B@B@DBB@DBBBFBB@DAF@@@BA@@BA@AD@BA@@DAB@B@B@@B@@@BBBD@DABBB@@@@B@BAF@BBD@BAB@B@BA@@@ADCBADABAB@D@DBBBBBBB@B@D@B@DA@@@@@@B@@A@@@CAABI@ABABAB@D@@BB@@B@BAB@@@B@@DBBB@@B@@@BCBA@AB@B@D@@B@@AF@D@B@BB@BBBAB@B@B@BBB@@A@@@AAG@ABAB@D@BABAB@B@@@BB@B@B@BBDBBB@BBB@B@B@BAB@BADABABCB@BA@@BADCD@DBB@@BB@@@@D@@@B@@B@BA@ABA@A@A@C@@@ABA@@DAB@D@H@D@B@DABCDADAB@BC@ABA@AB@@A@@BAAAACAA@@@@@CBABABADABAB@BADBDA@@BA@E@A@ACCAAA@CBCAEAA@CI@A@A@CBA@C@ACACAA@IAAA@A@@@A@ABABAB@BAB@@@@@@AB@@@@@@@@@B@@@@@@A@@@@B@@A@@B@@@@@@@BA@@@A@ACACAA@ACBC@@@AB@D@HBHBD@DAB@BBBBDBPCF@BAB@AA@ACC@CAAAAA@@A@A@ABA@CBABABABAB@D@BDBBB@DADADAHCDAD@DB@@B@BBD@B@@@@ABE@ABADAD@D@DBD@@@BA@@@A@AB@@@B@@CA@A@IAA@@@AABA@CBA@A@A@AAAAAA@ABC@A@A@@BA@@@A@A@AAA@A@@A@CAC@A@CBAB@D@D@DAB@AA@IA@AAA@A@@ACCAKAA@@A@A@ABA@A@A@ACAABA@ABADC@A@@@A@AA@@AAA@A@@@@AAAAAA@@@@C@@A@AAA@@A@AAEAAA@ABAB@BBDBB@BAB@@ACAAAA@C@C@A@C@@@AA@@AI@A@ABCBA@A@A@@AAAAAA@A@ABA@A@@@@AAC@A@CA@ABCEO@@ACAAEEAC@@AA@AAAC@ABAB@B@@@BCBA@ABA@AA@@A@AAAA@ADCBCBCBI@I@E@GDGFCBCB@@A@@@A@ACGACAAACAAACCAE@A@C@A@CBCACCECECCACAEAAAC@ABAB@DCBCBA@C@CA@CAA@@@C@C@A@AAC@@AAC@C@A@C@ACAA@CBCDEBCDA@ABA@C@A@AACCEACCCA@C@A@AABA@A@@A@@A@@@A@@DC@A@ACCEAEACAAAA@@@CCA@AAC@CC@@A@AC@CBABA@C@AACACAACACBCDEDCDCDCBCDA@CBEBCBCBCDABCDABABCDABCDABADADADABA@C@@@A@AA@@@@ACAACEAAACA@AAGAE@E@IBACA@AA@C@A@A@ABABA@A@@@AA@A@A@@@CD@@AN@FADADADA@@BA@@BAB@D@B@B@@EDE@CBM@A@C@AD@D@JADAB@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@ECCECAE@EDC@C@EAGBCBE@EAEAEAEBCD@@C@KCAAAC@EAICAEACAAAAC@CAA@@@@@@AB@@A@CBA@@@@@A@@@@@@@A@@@@@A@@@@@@AA@@@@@@@@A@@@@@@@A@@@@@@BE"],["@@A@A@@@AF@@BA@@@@@@B@@@@AB@@@@@B@B@B@B@@@@@B@@@@@@BB@@@@@@@@@@BB@@@@@@@@B@@A@@@BBBADCRCDABA@CCCG@E@EBEBA@A@@BBB@BAB@@@@AAC@A@AB"]],encodeOffsets:[[[108302,31638]],[[108304,31638]]]}},{type:"Feature",id:"511324",properties:{name:"仪陇县",cp:[106.303042,31.271561],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@GDEBGDC@CB@DDBADABABDNEHDFFBCBBHJ@@FD@BB@HCA@H@B@@AB@A@@ABBB@@@@A@@@@@@BB@@@@@B@@@@BA@@BBBB@@@@BA@@B@@A@CB@@@DA@ADC@AB@@CB@@@B@@@A@@@A@@A@@@A@@@AB@@@@@@AA@@@@@@@@@BA@A@@@@@@B@@@BA@A@@AAAA@@@@B@@@@B@@B@@A@@@CA@@@A@@@@A@@@@@@B@A@@@@@A@@@@A@@@AB@@B@@B@@@@@@ABAB@@@@BB@@@B@B@B@AAB@BA@A@AB@@@@@B@@@@A@BBA@@BBBB@@@B@@@BB@@@@DB@BB@@BAB@@AB@@B@@@B@@@@@@B@@@@@@@@@@@B@@@@@A@@@@@@AB@BBBA@@@@BA@@@@A@@A@@B@@@@A@B@@@@B@@@@@@A@@@@@@BBAB@@@AB@A@BA@@@@@@@A@@@B@AB@@@B@@@@@@@@@B@@@@A@@@@@@@@@A@@@@@A@@A@@@@@BA@ABB@@@D@@@B@@@AB@@@@A@@@@@@@A@@A@B@@@@@@@@@@@@B@@@ABB@A@@@@@@@@@A@@B@@@@@@A@@@@@AB@BA@@@A@BBA@@@@B@@B@@@B@@@@@B@A@@BBAB@AB@@B@@@@B@@@B@@@B@@@@@A@@@@@@@@A@@B@@B@@@@@@@B@@@@@@@B@@@@@@B@@A@@A@B@@@AA@@B@@BB@A@@@@@B@@@@@A@@B@@@@B@@@@@@@@@@@@B@@@@@@@@@@@B@@B@@@@@@@B@@@AB@@@@@@BABB@@@@@@@@BB@@@@@@@BBB@A@@@AB@@@@@@BB@@B@B@@@@@B@@@AB@@@@@B@@@@@BD@@ABCDCFEFADADADAB@B@D@FAB@D@D@NBAH@@A@@@@B@@@@@@B@@@@B@@AB@@BD@@@@@@@@@@A@@A@@AA@@BA@@@@A@@@@@A@@@@@B@@@@@AA@@@B@@@@@D@@A@@@@@@@@@@@@BA@B@@B@@B@@@C@@@@@@BD@B@@@@@@@@B@@@@A@@@@B@@B@@B@@@B@@@B@@B@@B@@BA@@BA@@@@@@B@@@@@@@B@@@B@@@BB@@BB@D@@@BB@@@@@@@@B@A@B@@B@@@A@@@@@@BB@@@@@@@@@A@@@BB@@@@@@AB@A@B@@@@B@@@@@A@@B@@@B@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@B@@A@@@@@@BA@@@@BA@@@@@@@@@A@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@BA@B@@B@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@BA@@@@B@@@@B@@@@@@@B@@@@AB@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@B@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@B@@B@@@@@@@@@@@A@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@A@@B@@@@B@@@@@@@@B@@@@BA@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@B@@B@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@A@@@@@@@@@@@@@B@@@@@@B@@@@@@B@@@@@@@B@@B@@@@@@AB@@A@@@@@@A@@@@@@A@@@A@@@@BB@@@@@@@@A@@@@B@@B@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@AA@@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@BA@B@A@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@B@@A@B@@@@@@@@@@@@@@@B@@@@@B@@@@AB@@@@@@@@@@@B@@@@BB@@@@B@@@B@@@@BB@@@@@@@@@@@@@@BA@A@@BA@@B@@B@@B@@B@@AB@@B@BB@BA@@BB@BA@@@B@@@B@@@BAB@@@A@@@@@B@B@@B@AAB@A@BA@BB@BB@A@@@@B@BB@@BA@@F@@@@D@B@@@@@@@@@@A@@@@@@@@@B@ABB@@@B@@@A@@B@@A@A@A@@@@@@@D@@@@@@@@@BBC@@@@BB@@@@@@@@B@@B@B@@B@@@@BB@@B@@@@@B@@@A@@@BAB@@@D@@AB@@A@BAABA@@B@B@B@DB@B@@BB@@B@ABBB@@BB@A@B@@ABB@@@@@@AB@@AD@@A@@@@@@B@B@D@@A@@@B@@@@@@BA@@@@@AB@@@@@A@@@@BB@@@@@AB@@@@B@B@DABB@@D@@@@AB@@BBB@@BB@@BAB@BA@@@BBAB@D@D@@@B@BA@@@A@@@A@@@A@ABA@@@A@AAAAAA@@AAA@@@ADABC@@@A@A@@AA@A@ACACA@@A@@@@@@@AA@A@@@A@A@@@A@@BA@AB@AA@AAA@@AA@A@CCAA@@@AAAA@ABAA@@A@@@@AA@@@@@@@C@@@AA@CA@A@ABA@@AA@@BABA@@@@@@@@B@@AB@@@@@D@@@@@@@@@@@@@@@@@B@@@@@@@@@@@DBD@B@DABAB@BABBD@F@D@F@DAD@DAB@B@FCBAD@H@BDDBDDD@D@F@B@BBD@J@DBBB@@ADBDBDDBF@H@D@B@D@@@BB@BBD@DHNDJ@FADA@ABADABCDABED@@C@CBCBC@E@I@ADABABCFGBCAIAA@C@E@@DBB@DDDDBJAHAHBBBDD@DDD@BBF@B@D@DCDABCBCBABA@ABADCDCBCDABA@CDADABA@CBCBC@EBCD@BABAB@B@D@D@D@BADA@ADCBEBEBEACBA@ABCD@@AB@BAB@B@B@D@D@B@F@@@B@B@@@BCD@DABABABABABAF@DBB@B@B@J@B@D@B@@@DAHENABABC@CBCBABC@A@EAA@A@A@ABA@@B@B@B@DABABCHAFAD@BBBBBF@D@BBB@BBA@@BA@A@AB@B@@@B@B@@BBBBBBFDBDFDD@DB@@BB@@@@A@@BA@@@BB@@@BA@GFEDABCBA@C@A@A@AB@@AD@B@BBFBBB@B@@@DAFCB@D@BB@@@B@B@@@BABAF@D@BB@@BAB@BA@ABABABA@ABA@C@AAA@CA@@A@@BA@@B@B@BA@@@AAC@A@A@@B@@@BA@A@C@E@AB@BADA@C@@@@B@B@@@B@@@B@@BB@@@@BB@@AB@@A@A@@@AB@BBBB@@@@B@@@@A@@@A@@B@@A@@B@@@B@@A@@@ABC@@BABBDBDDB@@@B@@@@@B@BBB@BB@@@B@BA@@B@B@BFB@B@@@BB@B@B@@A@A@@@AB@BBDBBDDJHD@D@B@DAHCBAD@BB@BB@DF@BA@@BAB@BBBFHFFDDFBD@DADCH@F@DAFA@A@@BBB@B@@BBBB@D@D@D@B@B@BB@BBB@DB@BBD@B@D@@@HANA^CF@D@DBBDBJBHDFDDH@B@DBD@B@DADAFCDCD@HABA@B@@BB@@B@HAD@B@@@@@ABCB@B@B@B@@B@B@@@@BB@A@@BB@@BB@B@B@BB@@@@ABA@@B@@@@B@BB@@B@BB@@B@@@@@@@@@@B@@@BB@@@B@@ABA@@BADBB@@A@@@A@AB@BBBBBAB@@B@@BB@DB@BB@@BA@@@@AC@AB@@AB@BAB@B@@A@A@@FADAF@B@@@BBB@DDB@B@B@@@BADEBCB@DADABA@ABABE@AAAAAAA@@@ABADAD@BABABCBO@CAACA@CACBABCBA@E@G@EAAEEAA@ABAB@DAB@BA@AACBCBABADABBFAB@BABAB@BAF@BADBD@FDB@@@B@BA@A@CBC@AFC@ABC@C@ABA@ABAB@D@B@B@BBB@B@DADCBADBF@B@DDB@BBB@@DBFBBABDBB@BAF@DAD@BCBADABBBBB@D@D@BABA@AHCBAJEBA@ABCBAD@DADABABCBCBG@AF@B@BA@A@@@AAC@@@A@@AAAA@A@AFEBCACAECAAC@@BCBCDC@@FAHAB@D@HBF@DAPIDAB@JCHAFAFBFBFBHBH@DBAHBFBDB@F@B@@BABADABBBBDFBD@BBDL@DFBBDBBBD@BCBKPAD@D@B@FCD@BABAD@@@B@DBDDDHF@BBD@BBBBBBB@D@B@DBBDBDB@@BADCBAB@D@@A@@B@BA@@@@@A@@@ABAB@B@BB@@D@D@@BBBABBBFBHBHADCBABA@A@AAAC@C@KDC@A@@A@CBCFABCBC@C@ABCB@B@DBF@B@B@B@@A@C@ADEDADAH@B@@BBB@BBDBBBBFBDBFBD@FBJBD@DABCBGBEB@BABBBDD@FAD@FBFBDAFAB@LFHBD@FABABABBBBBBBBB@BADA@BBBAH@B@BB@DABADEDE@C@C@CCAEE@@E@A@AAAABCBADEBI@AAAECAC@ABCD@D@JDDBF@BABC@ABCBAB@D@B@BBBBFBB@BB@BBBFDNFH@BBLH@@B@@@DA@@@BBB@@B@@A@@@A@@BAB@@@BAA@@A@@@ABA@@@@A@@AC@@@@AA@@A@@BA@@@@AA@@@A@@AAAAA@ABA@AA@@AC@C@@@A@A@@AA@AAA@@@CBADC@AAC@ABA@@B@D@B@B@D@DDBBB@D@BA@@@CCA@AAABALCFCBAB@BCBAD@B@@A@A@EB@AA@CBE@A@ABA@ABAAA@ACA@C@A@O@@B@@@DADCBA@@@CBA@@@@A@A@A@CA@@@ABA@AAA@A@ABABC@AA@E@CAA@AC@A@@BCDC@@B@D@JDDDB@BADCBCBCFM@CBBHD@@B@FCBAB@@A@@AI@A@ABA@ABADAPCBABA@ACAEICAOKAAAA@ACGA@A@ABABA@ABA@A@AAA@CCA@@@A@CBA@ABA@@A@@A@A@CAAAAA@ACAC@AAEGIKCAA@CACCAAC@EBCBA@AACCCGACAAA@C@AB@B@BABADCBEDGDA@@BCD@DBDADABCBA@C@ACESCC@@CAG@CA@A@@@@@@@A@@@@A@A@@AA@@A@@AA@@@@ECOACA@C@CBCDADEDCDGDA@@@@@AB@@@@@BAB@@@B@@@@CBABA@@@@B@B@@@BBBAB@@@@AB@@C@A@@@@@A@@@AAA@@AC@@@@@AA@@@AA@@@@A@@@@@@A@@@ABA@@BAA@@@@AC@@AA@@@@@AA@@@@@@CC@@@@@AAA@@@A@A@@BA@@B@@@DA@BDABAAAA@@@C@@@A@C@@@A@@@ABA@@@A@@@AB@@@@CB@@EBEDEFEBCA@EBCBC@E@A@A@E@CACAAAAAAC@EAA@CAAACECAC@C@ECCCEAGCGCC@EBC@EACAACCACAI@CBEBEBCDABC@@@A@EDGDIBC@A@GEEACC@CA@AAABCBCBEAEAEAEBCBADADA@A@CCCCAEECEAA@GCEAEAECAAE@GDC@AAAC@ABAHEBC@CAAE@E@C@@AA@AA@A@E@EACAE@A@A@E@A@AE@@B@B@B@@@BABAAC@C@A@C@@@@B@@@@BBB@B@@B@@AB@@CBC@AB@B@@B@B@@@@B@@BBAB@B@@@B@@A@@@A@A@@AA@CAA@AAA@C@A@A@ABA@ADAB@BABAB@@@@AB@@AA@@B@@@@A@@AAA@C@A@A@@@AB@@BBBBDBDBBBBBBB@B@@AB@@A@AA@AA@@A@AA@AB@@AD@BAB@@AB@@A@A@A@@@CD@BABAB@@@B@BCBA@E@A@A@C@AA@C@G@C@CCCACCEEACAGCC@@@@@ABCAC@G@C@AAA@ACA@A@A@@BAB@B@B@B@BA@C@G@A@A@E@CAACA@EAA@C@EBCDCDGDA@CAAAACE@C@E@AAAAACAACCC@C@CBABA@ADCBCBABCBCF@DCBAFCBA@CAEAC@C@C@AA@@AC@AAECCCAEACAA@CACACAACAA@@AAACAECEEAI@KBG@E@GAA@CCAEAEAG@C@ACAA@C@CAC@ICC@CAAAAACACACCAC@AAA@AAEAACACCAAACAC@E@C@E@CAAAACCC@C@A@CBABAB@@A@@@CAACA@AACB@D@DBBBDBB@@BBD@B@B@@BBDBDBD@D@B@@BDBD@B@@@BBBAB@BA@A@C@CACAC@C@AB@D@BABA@AA@A@A@AA@@AABABC@A@AAA@A@@BBB@BABABA@A@AAAAC@C@CAA@@A@AAA@AA@A@C@A@@@CCAAECACEAICE@A@KDA@"],encodeOffsets:[[108791,31957]]}},{type:"Feature",id:"511381",properties:{name:"阆中市",cp:[106.005046,31.558356],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AACACA@@CAA@E@C@C@CAAAA@CAA@YCA@GBA@A@@BA@ABABCLAFAF@H@BABC@C@A@E@OCA@CACBA@A@C@A@C@ADAB@BABCBCBE@EBC@EBA@@@AACACAC@A@A@A@@ABABA@AAA@A@AAA@@@AACAAACC@A@C@ABAB@BA@CAAAAAC@@@@BABA@E@A@AA@A@A@C@AACACCAEAA@A@@A@A@A@A@A@CBA@@@A@A@@CEAA@A@A@A@ABA@@AA@A@AB@B@@@BA@@AAAACAC@A@AAAA@AAAA@A@@AAA@@@AA@A@AA@@A@@ABA@@BA@@BB@A@@@@@@AA@@A@A@A@AB@@@DA@ABA@@@A@AACAA@ABA@A@A@CC@@CAA@CBABABA@CBCBAB@BAB@B@@@D@BBDABC@A@CAC@AACCGGAACAABCBABA@ABA@@B@DBBDBBBBDABHLB@BBD@BBB@HBD@B@@D@B@BCBC@E@CAC@A@@@C@@BAB@B@B@B@B@FAB@BABABEFCDC@C@E@AC@C@AAAA@AAACDADCD@@AB@@AAACACC@@A@C@CD@BEDADAB@B@BBB@B@@@@BB@D@B@B@B@B@DABAD@DABCB@@A@A@@@AB@@A@@BA@@@CAA@A@A@AB@@A@C@A@A@A@CAAAAA@@@@A@@BCBABCBABC@CBAB@B@D@B@FB@DBD@D@F@DADBD@BDBD@@AFCDAB@DCDAFCDADCDCBA@CBA@AAA@@CBADCDAB@@AAAA@G@GAE@CAC@CACAA@ABABA@CBADBD@BBD@BADAD@BBDDDFF@BBBBBB@FDNBBB@B@DA@ABIBCDABADKXADEDCD@DBBBBBHBDDD@BBFBBBB@@B@B@B@DABADAD@B@BB@B@@CBAB@@@BB@B@B@HAD@B@BDHL@@@F@DAF@D@BAB@DCDAF@DBB@DBB@D@DCDABEACAAAA@C@@DCDADCDCAAAAECC@CCCE@CDABABEDCBC@C@CAACBABCBA@CAACCCACAC@CAA@EBCDADCDEDCDEBEAE@C@GAMBK@I@E@AD@@AB@DADCDCBC@CAGCAB@DBD@D@BADC@C@C@GB@B@BBF@DCBE@GBCACD@D@BBDBDADE@E@ADBBDB@@BBB@@BBBB@B@B@@ABABABC@ABC@A@C@A@@B@@@BBBB@D@B@B@D@B@DAD@DADAFCBCBABE@CBCBA@@B@B@@BB@D@BBB@D@D@B@BBBBBBB@BBB@B@BBBBDBB@B@B@B@D@BBDBDB@@@BB@B@B@BB@F@B@@@BDB@F@B@B@@@BAB@@ADD@@@B@B@@BBBBBB@B@BABCBAB@B@B@BB@BBF@D@BABAB@B@@BBBBDBD@D@B@BCB@BCBABAB@BBB@DBBBBBD@BABAD@BAD@BBD@@@@BBDBDBB@BD@B@DAD@BABCFADAB@@AD@B@B@F@DBDBDBB@@D@D@D@B@DAB@B@DCB@BABADADADAD@D@B@BBDJDDBDBBBBDBB@DBDDDBBAB@@A@@@A@G@G@ABCHCFIBABC@A@A@ACAAC@@DAD@J@B@HA@@B@BCBC@ABGBA@AB@BCBCFCDABADA@AB@@A@@BC@A@CDABABBB@B@B@B@@A@@AA@A@A@C@ABABA@AB@@AD@B@B@DBDBD@B@D@BA@ABABAB@BAD@BBB@BBBBBB@@D@B@B@@ABA@A@@A@CACAAAAA@C@ADCBAB@BAB@@AAA@AAAA@A@A@@BCBA@AAA@@A@AB@@ADAD@BABAAAAAGA@A@A@@B@B@F@B@B@@A@A@A@C@ABCB@D@DAD@@A@A@AACA@@ABA@@B@B@B@DBB@BAB@@A@E@A@ABAB@BABADAB@BB@BBB@BBB@DAD@BBBBAD@B@D@BBDBDBDBB@D@BB@B@@CBA@CB@@AB@DABCB@BAD@BBBBBB@D@@B@D@DDBB@D@FADEBEDGDEDCHEFCLEJ@FBBB@B@F@D@DDBJJDDBDBB@D@BCFEDEBABAB@DBDBFBBD@F@HAH@F@HBHDFBDBDDJDDBD@BAB@BAB@@ADIBABABAB@D@D@D@B@BBBB@DDBB@BBBAB@B@BADABA@BBBAFADABBB@BD@DBF@DBB@BB@FDFFBBBBAD@B@^FBBD@B@BADBDBDBDBD@D@D@BDDFBDBBD@D@B@H@FAFAF@B@D@D@B@D@D@B@DBB@BBBB@B@BABC@ABCBEBGDCB@B@@BDNDFBFDBBJHVPDBDFBB@B@BADAB@D@@@@@BB@B@B@B@D@B@B@BB@DCF@B@BFF@B@B@@@BBD@BBBBBDBDBDBFBD@BB@@BBBBB@@@BBAD@BADAD@BBD@@AB@BCD@@A@ABAB@@@BBB@BB@ABC@@@@BDBBB@B@@@B@@AACAAAC@AAAB@@ABA@C@A@BDBD@@BDBFBD@BADDBD@BBBB@BCHBF@@BD@DDBBBDBBD@L@@@B@BBB@@BB@@BBBB@@B@BA@A@ACA@CBCBADEBK@A@ABA@ABA@AB@@AD@DBB@HDB@FAB@@ABAAAAA@@@AB@B@BA@A@@DB@BBBBBBBB@BBFDFBBBD@FAB@D@BBB@DBDBDBBBDBBBD@B@B@D@D@B@BAB@BA@A@GAG@ACCACCAAA@AAC@@BC@C@A@CBGDIBADCDAB@DADA@@FABABIBABABAB@D@BBBBDDDBBBBBFBD@D@D@D@DADCBAD@D@BBBB@B@B@DDBDDD@B@@@@@B@@@@AB@@@B@@@@A@@BA@@@AB@@@B@B@@A@@@AB@@@B@@@@ABABA@@@@B@@@@@B@BBB@@@@@B@@@@@BB@@@BAB@BB@@BBDB@BBB@@@@B@B@B@BBB@B@B@BBB@B@B@BB@@B@DAD@BABBBAB@B@D@DADCBBBB@BBF@DBFBBBD@D@@ABABABCBCDABABB@@BB@B@BB@@BB@@AB@@AB@B@B@BB@B@@AB@B@@@@BBBF@@B@@BB@@BB@@B@@@@BBB@@BBB@@B@@@BB@BB@B@@@B@BAB@B@B@B@@@B@@@B@@@B@@BB@BB@@B@@@@@BB@@@@BB@@BBB@@@@B@B@@@@@B@@BB@@@BB@BBBB@@BB@B@@@@@@AB@@@@@B@@@@@@@B@BB@@@@B@@@B@@@B@@@B@@B@@@@@@BB@@BB@@@@@D@BA@@D@BAB@B@B@DBBBD@BBD@FAB@DAD@BABA@A@AAC@@AC@AAABC@E@ADAFAD@BBBBDBBAB@BABABABABA@AAABCBCBA@A@CBA@@@C@A@CAAAA@A@A@@BCDADCBADBFBDBDB@@BBB@@ABB@ADC@C@ABEBABCBAFC@AA@CAAA@@@AB@BAB@@@BCBC@A@EBCBCBAFGHEBADGBABABCDABA@A@AA@G@CBE@EBCAC@EG@CBCFCB@BA@G@AACAAAAAAAABC@EACAAAAAAA@A@CDABABG@ABCBA@EAA@ABAB@D@BA@C@CA@ABE@ABCDC@ABAACAACCAAAA@CAAA@C@@A@A@A@AAAA@GACAAAAC@A@A@@BEB@BADAHAB@BADCBAB@DALCD@B@B@@@FCBAFCDA@@BABA@@AA@@BABA@@AA@@@A@@@A@@@AAC@@BAB@B@B@BAB@B@BA@ABAA@@A@@AA@A@@@A@@@@@A@@A@@A@@@A@@@@@@@@A@@@AAA@@@AAA@@@@@@AB@BA@@@@AAA@A@A@@AA@@AB@A@@A@@A@A@@@@A@A@ADABA@@@@A@C@GBA@@@AA@@@AABGBC@CDEDCBCBA@C@CAA@G@CCCEAGAIACCAC@E@]DMBGB@@C@A@C@AAA@@CAA@AAAA@A@C@C@C@A@AA@AA@A@AA@@@BEBCBE@G@CDCBC@EACCEEEGAA@ABA@AB@@ACEA@@AAAC@ABGDCBA@C@C@IGCCAAAC@ABA@@B@B@@@@A@AAA@@A@A@AEA@A@@@ABA@@@A@@AAA@A@A@@@@@A@@CAACACBA@AD@BA@@B@@@@A@@@AB@@@@AB@@@B@@@@@@A@@A@AA@ABA@@B@B@@@BA@@AA@@@@AA@@@A@@@A@@@A@A@@D@B@BC@ABAF@D@B@B@@A@@@AB@B@D@BB@@B@@A@A@AB@@AB@@@DBB@BBD@B@BAB@BABABAB@@ABA@AA@@A@CBEBA@A@@@A@A@@AAC@A@EDCB@@A@A@AAAE@A@ABC@@BAB@B@D@B@DABAFCHEB@@A@@AA@@B@@AB@@@@@AA@@CAC@ECACECAAAAAA@@@A@A@@@ABAB@B@@AB@AAA@AAC@E@AAAA@ABCBEDGBABA@C@A@A@AB@BAB@B@B@FBB@D@BADADAD@BABAFMBG@C@@@A@C@A@I@A@AAA@CBEBABABABABA@CDCC@A@A@A@A@@BABA@A@AACAA@A@AA@A@A@K@AAAAAAAAAB@@ABA@A@@@@AAA@A@ABA@@@AB@BA@A@@AAC@E@A@E@A@@AAA@@@EAC@@@C@C@CBABCBA@@A@AB@FCBA@A@@@A@AAE@@C@C@A@ABCBCBCBCBCBABA@C@A@CACACCAAAACCECECCCA@CAEDE@GAC@A@A@@BAD@DCBA@GBCBC@A@AACAEGCBED@FDHCDEBEECEAAA@EBE@GCAECAC@E@A@A@@BABAF@FBD@D@D@@ABA@A@EA@@A@@AAA@ACCC@G@C@CBC@E@AACGA@AA@CACAC@@ACC@A@A@ABA@A@@@GGAACCCBA@A@CAGCECA@@@@A@@@@@@A@@@@A@@A@@@@@@@@@CBC@@@ABC@AA"],encodeOffsets:[[108634,32161]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
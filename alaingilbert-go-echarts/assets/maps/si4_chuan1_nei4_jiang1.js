@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("内江",{type:"FeatureCollection",features:[{type:"Feature",id:"511011",properties:{name:"东兴区",cp:[105.075489,29.592756],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@ABCFADAHABAFCFABC@A@C@CECC@@@@C@C@C@CB@@A@CBABCD@@AD@D@DBDFL@B@@BB@D@B@@@@@D@F@B@B@@@HADCD@@AB@@CBA@A@@@@BA@A@@@C@CAAA@@A@@ACC@@@@ACECAACAC@@B@@A@AB@BAD@BAH@DA@AD@BCBCBA@CBA@AD@@AD@@@B@D@F@B@D@J@B@D@DADAFCFCD@@A@@BA@A@A@A@C@KAA@@AAACE@AEGCCAAAAAAA@ABA@@BAB@B@D@BBFBB@DBB@BBBDH@BBB@BBF@DBB@B@BAB@D@B@@@B@@@D@B@B@BBBBB@BB@AB@@ADA@ABA@KF@@@@CDA@ABA@A@CBEBABABABAD@DBD@DBBBB@@@@D@@@BB@@@@D@@@B@@BB@BBBBD@BB@@@@@B@@BD@@B@B@@@@@@@BBB@@@A@@BA@@B@B@@DB@@@@AB@@ABBBA@A@@BA@@@A@@BAB@@A@@BABA@@@A@A@@@@@@BA@@@A@@@A@AB@@@@BBB@@B@BB@@DB@D@B@@B@@DDDFB@BDB@@@@BD@@BB@JAFB@B@@BBF@F@DBB@B@B@B@B@@@@A@AB@@@BB@@@B@@B@@@B@@AB@BB@@B@@AB@B@@A@@B@BB@@@A@BBB@@@@B@@@@A@@BB@B@B@@@@@@B@@@@@BC@@@@BBB@@@@A@@BB@@B@@@BDB@@@DAB@@@@@@A@@B@@@B@BBBB@@A@ABABABB@@@B@B@B@@AB@B@@@B@@@@@B@B@@@@@@B@@BB@@B@@B@@B@BA@@B@BBB@BBBDBB@@B@D@B@@@@BB@@@B@BBB@@@@BBBFH@BFFBDHFHF@@FFFJDFFJBF@B@@BD@D@BABABCDAD@D@@@DBF@D@H@D@FAFAF@HDDBBFAH@F@BBDBLJDDBB@DAFBBB@B@D@D@D@FBBBD@D@BAFBDBDDDDFDDBDBB@FBD@D@HBHDDBDBDADC^CN@D@HBHBHBB@D@DCFAHEDCBCFC@AAAAC@A@ABA@AHAB@@ABCDG@EACAACAAA@A@CACBAD@D@H@D@FABCDCDCBAB@D@DBBBFAH@DAB@FAFBBBFBJBD@FAB@BB@BBD@JAFBB@@@B@BBBDBBBBD@BBBBBD@F@B@D@D@DAB@B@JBFBF@@AHCDCFEBCBAB@DAF@DABAFALDFDBB@B@D@BAB@BABBH@BFFBDBFBBBDB@DABCFCDAFCDCBC@ADC@A@ABKBEDADCBA@C@C@AAABEBBDADBJ@B@JAB@DABCDC@E@CAC@EAEAGBCAC@CAG@AACAGACCGACCEBCBCDEBEDCDABADC@AB@@@BABAB@@@DC@@BABA@A@@@A@@BAB@B@B@@@@A@@@AAA@@@ABABA@A@@CC@AAC@A@C@A@@F@BBB@B@@A@C@A@AEGAABAHIDABA@A@A@@C@A@A@A@IGGE@AAAAA@AECIGOHEBA@@@@@@A@@CA@@@ABC@@@A@@A@C@A@@AA@@ADA@@@@@A@@AABA@@BAFA@ABCBA@A@EBA@@@C@@B@@A@@AAECA@AA@@@@A@AACAA@CAC@AAAA@@BC@E@EBCBA@ABC@A@@AE@AAAAAC@A@CAA@C@ABABAB@FABAB@@CBC@AAC@CACAAAAA@@AAA@AAAAAACGAA@AAG@C@@@@AAEBA@AA@@@@@AB@DCBA@@@A@AAACA@@CEAA@A@A@@BC@@@@AAA@ABA@@BBB@BAB@BA@A@EAA@A@A@@@@B@BDBDDB@@B@B@B@BEDEDAB@@A@A@@A@A@A@C@C@AAA@AC@@ACAGC@AACBEDCFGBI@AAAAAA@A@EBC@@@UIAAAA@@@G@@@AA@@A@AAAAA@A@@@A@ABABA@A@@AA@@A@@@A@@C@@@@@@@@C@@B@AA@@ABA@A@CBAFY@ACACAC@EAGAAA@@AGBEBCDCB@@A@@@C@CAEAAE@CAABA@@BBD@B@BABEHABC@GCAAC@ABADCFAFCLCBABCBCBAFCBA@CBAA@@ACAEBADCBCBA@EBCDC@A@C@A@A@EBEBAAG@C@A@A@CBCB@FCJGJEBADCBABCBABE@UCEA@AAIGGEA@E@ABCD@JAB@BGFE@A@AACCACC@A@A@@@C@ABA@ABCBA@AAA@AD@@CAC@A@EBA@A@@@@@AA@@@A@@@@A@EAA@A@A@@@AC@AA@AAABG@A@@@A@A@ACA@@@A@@@BDAB@@A@A@C@@AAAA@A@@@A@A@@C@CAACCAAAABCDI@CAACAGBC@C@ABABCBCBI@Q@A@E@E@E@E@G@EAC@A@AACC@@A@A@AB@B@BAB@@A@C@A@A@@@A@@BCDCFA@A@A@CCAACAAGCCCCCACBA@@D@B@BBDBHBD@BAFADADA@EBEDABC@ABC@CAE@C@ECCAAAAAA@A@CBCAAAC@CBABABAB@BABA@IAADBBBBBB@F@BADA@GJCBAA@@@GAA@@GBA@E@@ACGCFABGZADCBC@C@A@A@AAA@ABA@@BA@AB@@@B@DAJ@B@DABADCBAB@@CDGF@@CFCDAD@B@@AD@DBBBDDBBBD@B@B@B@DCBCBC@ABCBC@@@@BADADADABA@@DCDADCBADEBAHGDEBABEDC@@BAD@@@B@BBDB@@@BBBBDBFBB@D@B@D@@@B@DAB@B@BAB@BABCBCBCBE@EDABAB@B@D@DBBLDNBBBBBBBBB@BAF@J@BDHDDDBBBB@B@D@B@BAFABCHGBABABA@@BA@A@C@@@EAAAEACA@@CBABA@@BA@@D@B@D@D@D@D@BABA@@BA@C@GBCDA@ABAB@B@B@BBBBBBBD@@DN@@BF@BBDBBDFBDBD@B@D"],["@@@@@@BBBABB@A@@@A@A@@@A@@A@@ACA@@@@ABBD@D"]],encodeOffsets:[[[107625,30248]],[[107779,30213]]]}},{type:"Feature",id:"511002",properties:{name:"市中区",cp:[105.067597,29.587053],childNum:1},geometry:{type:"Polygon",coordinates:["@@DADA@ABCB@@CBG@ABC@ABAB@@@@AD@DBBBFDBD@@@@DD@BB@@@BBDBD@@@B@B@@A@@B@B@DA@@BA@@DCBC@G@@@A@A@E@C@@@@@A@CAA@@@AEKAC@C@CBC@@DCBADAB@@@DAD@D@D@@@@@DDDFD@B@D@BADEBEBABGBCDEBA@C@AACACCEAAAC@AAE@@CM@@ACAAAAAAA@A@A@AB@BCBAD@H@DAB@@ABABC@C@C@C@A@C@@@AB@@ABAB@DB@BDBFBB@F@@@D@BAB@@ABABABGHADEBABA@C@A@A@AACACCCG@A@IBE@AAAAAAAAAMAKCAA@C@C@ABABAFCF@DADADABA@ABA@A@ABA@C@A@@@C@A@CAAAEACAA@A@@CAAAA@@@C@AB@@CDAFABCFGHABCFABCDCBCD@@ABCBCBCBAB@@@@ADAD@BADADCDA@A@A@C@AACAACAA@CBC@@@ABCDCDE@@HEDC@@BADABCBA@C@ABI@C@A@@BAB@@AB@BAB@BBB@B@D@D@DABCHYBADEFAHC@BB@@BA@@B@@DBB@@@B@@@@AA@@A@@@A@@A@A@@A@AB@DC@AB@@A@A@@CAICCAMBC@CAA@AE@C@A@ABCDABA@@@ABCBABE@AAAAAA@CBEAE@C@C@C@AAC@ACCA@AAA@@@@A@@B@BA@@@AB@BIBA@CB@@CAA@A@A@AACAA@AACCAAAAAA@AA@AAA@@@C@A@A@A@A@A@@AA@@AAA@@AC@CACAAA@CC@@A@@BA@ABA@ABAB@@EFABAB@@@D@BCDAD@D@BABABABABCDABABABCDA@@@A@A@A@AAA@@AA@@E@C@AAAA@@@AAC@CAC@CAC@AB@@C@E@EBA@@@EDCDABBD@F@@@BABA@EBA@A@A@C@IBE@A@AB@B@@@DBB@BAB@@@@GAC@EDABCB@@@B@B@FBB@DBF@B@@AB@@CBC@A@A@@AAAAA@@CCA@CBC@C@@AC@AAABE@AAABCFAFAB@BCDEBCDADBDDD@D@BA@ABA@@BB@@BA@@BA@CBED@FDFDBDBBBB@D@HBH@DCDAF@D@BADBF@BBDBDD@BHHFJ@B@B@@BB@BB@@D@B@@AD@BA@ABA@AB@@AB@B@@A@A@A@A@CBABA@@B@@B@@@B@B@BB@@@@AB@B@@@FBB@BA@@BA@A@AA@@AA@A@C@AA@@@@@AB@BABABCD@BC@@@ABB@BD@@AD@@BB@@@B@@A@CBA@ADA@A@A@AB@@@B@BB@B@@@BB@BAB@BAB@B@B@B@@CDA@@BBBBDJHPF@@FBFBJBJBFBHBFD@@@BBB@@@BDB@@@B@@DBBB@@@B@BB@B@BA@@B@@BB@@@@B@BA@A@@@@B@B@@@@B@@B@@B@@@B@@@B@@@@@BD@B@B@@@@A@A@@DADEB@@AA@@AB@@@BA@A@ADCB@B@B@@BB@@@@B@DADB@@@B@B@@A@@@CBAB@@@B@@@B@@B@HB@@BB@@@BFD@BBDB@DD@@B@B@DAB@B@DADA@@BBB@D@B@@@BA@@@A@A@AAAA@AACCAA@A@@@A@@D@B@BA@@@CBA@@B@FBHAF@DBB@@DFFBDBFBBFJBDBDFHFFBBFF@BJJFDDBBBLFHHBBFDDBRLFDBAFADAB@B@BAB@DC@@@@LEB@BAB@BC@@BAA@@AAAAA@A@A@A@C@@@A@@@A@CBA@A@AAA@CAE@AAA@ACGAA@AAA@CAAAE@A@C@ABA@AB@BAB@BBBBBBDDFH@BDFBB@BB@LBD@B@B@B@B@@AB@@@DCDEBEBC@C@C@A@I@C@A@E@C@A@@BC@@BCB@DAB@"],encodeOffsets:[[107559,30309]]}},{type:"Feature",id:"511025",properties:{name:"资中县",cp:[104.851944,29.764059],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@@B@@@@@@@@@@B@@@@@@A@@@@@@@AA@@@@B"],["@@@BB@@@AA"],["@@@B@@@@@A@@"],["@@BB@BABC@@BAB@BBB@B@BFBHDDBHBHB@ABA@@BAAAAAAC@C@C@@DAD@D@HAB@D@A@@@AA@A@@@AA@@@AA@@CA@@AACBA@A@C@@BC@@@ACAAAAC@A@BDBB@@A@@BAB@@@@C@@@@@AA@A@A@A@AA@A@AAABAAAB@@@B@BBBBBBD"],["@@@B@A@@@@@@@MCADR@@@@@A"],["@@B@FDBD@HBBFFBBBB@DCBKDC@A@A@@@A@AA@ABCAEAC@@@EAAAAA@A@E@A@A@C@@B@BBF@BAB@BB@B@DB@@B@BB@@@BA@ABA@ABAB@B@BB@BBD@BBB@@F@@B@B@@@DAB@BAB@DBB@BBD@DBB@B@@B@@AB@B@BAD@BAFABE@AB@@@BBBD@DBDAJCB@B@BBBB@B@BCFAB@D@B@BB@B@B@BAB@@@B@@B@B@BCDAF@@@BB@B@B@BADAB@@@BD@@JFB@@B@@ABA@C@A@ABB@@BBBDB@AB@B@F@B@@@BA@@B@@@BB@B@B@@A@A@AB@@@B@DBBB@BBB@`DLCJIFGDEJEBADAJAHBLBD@F@H@HAFCHCFGHGFEBANE@CBEBA@ADGDCB@BCTCRFFA@A@A@CAEICIM@KPEDATGNEFEDCDCDE@CBGAGAADGBC@CAC@CACCAC@C@C@ADCFCBADABC@AAAABE@ABABABA@EBGCAA@BABA@A@ABA@ABABABEBCDEBAFEBCDADADAHELELAH@BABCDGA@A@AABE@CAACCKICAAAE@G@EBAACC@GBEBE@E@C@G@CAE@C@@@CBCDCBABA@A@CAC@@@AAEEICEEIEE@@GEGEACEE@AEGAA@A@@A@AAA@@@A@@A@@A@C@A@@@AAACAAA@AAA@@@ABA@@@@AA@@@AA@@@A@@@@A@A@@@@@A@@@A@A@@BA@A@A@@@A@BABABAB@@@AAAAA@@@A@@@@B@@@@A@CB@@A@AC@@A@@@AA@@@B@@A@AA@@@@AD@@@@A@@@@@@@@A@AAA@@@B@@A@@@@@AA@A@B@@AAA@@@@BA@A@@BA@@@AAA@@BA@@@A@@@@A@@AA@@A@@B@B@@A@A@A@A@A@CAE@E@AA@@@AEAIBA@@AC@@A@@A@ACA@CECC@@@AA@C@A@@CA@@A@AA@AA@@@@BAB@@@B@@@B@@A@@@@B@B@@@B@BA@AB@@@BA@AB@@@B@@AB@B@AABA@@BA@@@@CA@@@A@AB@@AB@@@A@AA@@@@@@A@A@@@AC@@@A@@@@AAC@AAAAA@@AA@@@C@@@@@AA@@C@@@@@AAAA@CAC@CBCBABAECQKCAECAAGGKEAACAECII@AEEAAEEEGACACEIAAAEACEE@CA@CAE@GBEAA@@@AB@D@@ABA@C@@@@B@@@BBBDDBBB@BB@B@B@B@@AB@@A@C@A@AA@@CBCBA@A@CBA@A@@@CCA@AC@AEC@A@@AA@@GAA@@@@A@@@A@@BADA@@B@@@@A@A@@CACBA@@@@@AA@@@A@ADABCB@B@@A@@BA@@BB@@FABC@CB@B@@@@@@A@AAC@@@@A@@@A@@@A@@@@AA@@@@@@A@A@@B@B@@A@A@@A@@AA@@@ABA@A@@A@A@@AACA@@@A@@CA@A@@AA@A@@ECGAEAIAIAEAEA@@OEIGACGFKDG@IBG@C@K@K@E@CDGJCFAFGFE@CACA@NB@@@@@@@A@@B@@@@@@@@@@@@@@@@CQA@C@CBADEHAHCBEDIBKBKAK@I@G@MDK@EDCFEHEHABGPENAHAN@BAJ@JAB@JAJABM@C@AD@BBFBB@BA@BB@@B@@@@@@@@@@@@@A@@@A@B@A@@@@@@@BBB@@@@@A@@@@B@@@@@@A@@A@@@@@@@@@BA@EBAD@DB@@BHAB@BB@@BB@@BBDB@@EREHCJGNA@AD@DAP@HCDE@GAA@CCECEAGJCBCBABADCDCBCBADAB@B@F@@AB@BC@A@CDCBAB@@AFAB@D@@@B@@BF@@@B@@@BABA@@D@DBBFL@D@BAB@@A@C@EBA@A@A@A@@AAAAA@@CGAAAA@CAAAC@AC@EAA@AB@@@B@BABA@AB@B@@ADCD@B@F@@A@A@ACAAAAA@AAA@AA@@EEACAADEBEBCBCACAAAA@@A@CAA@A@A@AB@@AB@BAD@D@B@@ADAF@BBB@BBB@BAB@B@BABADABA@A@@@A@ADA@A@@@AC@CA@@AABA@@@@B@B@@ABA@CAA@A@A@@F@F@F@FCB@@EAA@@AA@A@C@E@A@A@CAGGA@A@EACBCAK@A@ABA@A@AAA@@@A@A@A@A@@@C@@@A@ADCFAFCDABAB@@C@CD@@A@ABGFEDCB@B@B@BBBBBBBFFBBABABCBABA@CBA@CAC@ACA@A@CAAAABCBCBCBABA@BDBBBB@FBBBD@B@B@B@B@DCBAF@DAHBDBFBD@@BB@D@BBF@B@B@@BD@BBBDFBDDFDD@BD@D@FBB@BBBBBB@B@@@BABA@C@A@AD@BAB@BAD@BBF@B@B@@@D@BB@B@DBDDDF@B@B@BA@AD@@CDABEBEBA@C@AB@BAB@BBBBD@BBDAD@D@B@BAB@@C@C@AB@@ABADAFABABABA@ABCDAB@B@BB@BBB@B@FABB@@BB@B@B@DA@@@ADABABG@GBA@@@ABA@@B@BBB@B@BA@@@A@CAAA@AC@@@AD@H@BB@BD@@@FBFBB@BBD@B@BDDB@BBBBBFBB@@BB@BBB@DBB@BFBB@@DCDABED@@ABA@CB@@CBA@CBA@AAAAA@@AA@C@A@@@CDA@AB@@@B@@BBDB@@BJ@B@B@B@BBBBJDBBB@B@B@@ABA@ABAB@D@@BFBBPNBABA@@@EAC@A@@BAD@H@BALATAL@D@B@@BBBABCFAD@B@DBDDDHDD@DAJCBEBCBE@ABAD@B@B@DBBADCBC@A@AAECC@ABCFCBCBCBGBCBCDAB@DB@@BB@@@@@B@@BB@B@@DD@@BBB@@BB@B@@BB@@@B@B@@C@CBCBAB@LEHABAF@@@DAB@B@@A@@@A@@@A@A@A@@@A@A@@B@@@B@@@AB@B@@@@B@B@B@@A@@@@AA@E@@B@B@@A@A@@@A@A@@DC@@@ACA@@B@@ADA@AAA@A@E@@AA@BABA@@@@AAAB@@@BA@@BAB@BA@A@@AAA@@@ACB@BABA@@@@CE@@@@A@AA@@@A@@@@A@@@A@@A@AA@@AA@@B@@@B@B@@AB@@A@@@AAAA@C@@@@@@AAABA@@A@@@CBC@A@C@CBA@AACAABA@@B@FAB@F@@@B@@BADCD@@@B@BDBD@BBD@BB@B@D@@BD@@BBB@@@D@BABCBB@BDABB@@B@DADB@AD@@A@@BA@ABADA@@@A@ADCFIBCBA@@D@DBDDBDBHB@B@BBBAB@B@D@B@B@DAB@BA@BD@F@@BBBBDDBBBDDDJHHD@D@HAB@@@BA@@@AB@@AB@@ABA@@BA@AB@@ABA@@BABABA@ABAB@@@@AB@@@@A@@@AA@@@A@@AA@@AAACCA@@ABCBA@@F@BBDD@@B@DEFCBAAAICCCEA@@AABA@AD@HADCBEDCBC@CAC@@BCBCF@@@BDBDBABABAFAB@@@BFBHBHABAFDHDBH@HDDBBBFFFDBFCD@@AFABA@G@A@C@A@EAIEC@IFE@C@CBCH@BDJFHDDB@@B@BA@ALBBB@BBB@B@B@@@HG@CAA@AB@DCB@B@BBDBB@D@@BD@BADABABA@AB@@AB@B@B@B@@BD@BA@@DABA@@D@B@HBJAFAHEDEBCACACGAG@C@E@GACACAAC@CDC@@BABCFAFAB@B@@B@@AD@B@@BBD@D@BAD@BB@@BB@BBBBBF@BBBBB@B@B@@A@A@E@C@CBABCDEBA@C@AAC@A@A@A@ABAB@DBD@@@BBB@F@JABA@A@A@@ACCC@ABAB@B@DCBADA@A@AACB@FAB@B@F@B@BA@A@AA@A@AACBAAA@@AAC@A@AB@B@B@DBD@B@BBBBDB@AF@DAB@@A@A@@B@B@BBFAFC@A@@@AEACAA@ACACAAA@CB@A@A@@@C@AAAA@CCA@AA@A@A@ABEBAB@B@BBBD@BBBBBB@BC@A@A@CAA@E@ABA@ABAB@@@DAB@DBF@DAB@FBDDBD@B@@CBA@AB@B@@ABABABCBCDEFABA@AD@B@BB@DBB@D@BBBB@BBB@BDBB@BA@@@A@A@AAAAA@ABAB@B@DBB@@@BBD@BBB@B@D@B@BDB@BBB@BADABA@A@ABCB@BABBB@D@FAF@BAB@BB@@CDABC@AB@DCDADCB@@CFAD@B@BFBFDHA@BBFB@@BB@BBB@FBB@BABABADAB@B@D@BAB@D@BBB@FBB@B@@CA@@ACA@AB@HC@@B@@@@F@B@FAB@BCBCDAB@BBDB@BBDDHDDFBBFF@@DBBB@@D@BA@@@A@A@AAA@ABAB@B@D@D@F@B@BA@@@AECEAGAAAA@@C@@BABAB@BAD@BB@BBDBDBBB@B@BAB@BABBB@@A@@BABBBABBB@B@B@B@B@@@B@D@BBBBBD@@D@@AD@B@B@DABB@@DB@@BB@@B@@B@@@BBB@@B@C@A@GBC@C@CB@@@D@DBDBBBBAB@@AB@B"]],encodeOffsets:[[[106990,30570]],[[107205,30385]],[[107205,30386]],[[107420,30624]],[[107301,30305]],[[107435,30639]]]}},{type:"Feature",id:"511024",properties:{name:"威远县",cp:[104.668879,29.52744],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@@@A@@@@@A@B@@@B@@@@@@@@@@@"],["@@@@@@@A@@B@@@@@A@AA@@@B@@@@@@@BB@@@"],["@@AAE@CAAAA@AB@BAAA@CB@AAA@@C@@AABAB@@AB@@BDA@@@C@AA@@A@ABA@@B@@@B@@@@@@DAB@B@BB@@@@ABABAB@B@B@BB@@@B@@@BCB@@@B@@@@@@B@BAF@B@@@@EAAA@@A@@@@@AB@@ABAD@@A@@BAA@@AC@A@@@ABA@@@@A@A@@@A@@@@B@@@D@B@@AB@@A@@@C@@@A@@@B@BD@@@B@B@@A@AA@@A@@@@@AD@B@B@BA@@@A@@@AA@@@AB@B@@@@A@@A@CA@@@AB@BA@@@@AAAAA@@AA@@A@@AA@@@@AA@@A@@@@@@B@@BBBB@@@@ABA@@@AA@@AAAB@@A@@BBB@B@@@@A@A@AB@@@B@B@@BB@@B@B@@@B@@B@@@B@@A@A@CBA@CAA@A@AB@@@@@D@BB@B@D@B@@@@BAB@@ABA@@B@@BB@@@BAB@@B@@@DB@@@BA@A@E@@@ABA@@B@F@B@F@@@B@@A@AAAA@A@ABABC@A@@@@A@@@ABABC@@B@@@@BDA@@B@@@@AAAAABABA@@@@A@@@AA@@@A@@D@@@B@@BB@BA@@BA@AACAA@A@AB@@@@BBBB@B@B@@@BA@E@A@@BC@@B@@@BBB@BABA@ABA@AA@AAA@@A@A@ABAB@B@@@BB@BBD@@@AB@B@B@@@BD@@@@BA@@@A@CAA@@@@B@D@@@B@@@@A@@@@A@AA@@@AB@BA@A@@@@B@@@BA@CAA@A@@B@@@BBBCBBB@@@B@BAB@@@B@@@@ABA@@@@DA@@@AC@@A@A@AB@AA@AAA@@@@A@AA@@@A@@@@@@@@@BA@@@AA@A@@A@A@A@A@@A@@@@A@@DCB@@@@A@@AA@@@@ED@@A@A@@B@@@BA@@@A@@C@@A@A@@A@@@AA@@@ABA@C@A@@@@@AB@@A@A@@@@@@@BA@A@@A@AA@@@A@@B@B@B@@@@AAA@AA@AB@@@BAAA@AA@@@A@@A@@@@@@@@BA@AB@B@@ABA@@B@@A@AA@@A@@BA@@B@@@B@@@BA@A@@@@@@B@@@@B@@@@B@@@@ABABC@@BA@@@@D@@@BCB@@@@@BAB@@@@@A@AAA@@AB@@@B@@AAA@ACC@CAC@AA@@AE@AAAGBABA@A@AB@BADB@@BBD@BABA@ABAAE@@AAA@@@IAA@AA@@AAC@A@@DANE@A@@@CEAIAC@AAEAC@E@A@ABBFAF@DADCD@@AHADABABA@EBCBAB@@ABADAFAD@D@DA@ADB@@B@BF@B@D@F@DAD@JCD@BABAB@B@@@DABAHEBAB@B@B@BB@@@B@BAB@@CBABABE@ABA@@BA@@B@D@BBF@B@BBBDDBD@B@BA@@BA@BB@DBF@@@BAB@@A@G@A@AB@B@@BDDD@@@B@B@B@@CDAB@@@B@B@DBBALAF@HADAB@BAB@@BDBB@DABABCDEDA@EFCFCHGNIHIJ@@A@C@E@A@C@ABA@@@AB@B@F@BA@@BCBCDCD@B@@@BB@HBBBB@@BCH@HBB@D@BBBB@DB@@@@@@BB@B@B@DAB@BBF@DAB@BADABAB@B@BA@ABEBC@ABA@E@AB@@ABAFA@A@A@ABA@AAG@E@EAA@IECAAAC@A@@CA@AB@A@@AAA@A@ABABA@@@AAA@A@C@@BAD@B@@@@@BADAB@BA@ABCB@@E@A@A@@B@D@@@BABA@@@AAA@AAGCA@A@A@ABCBABCBADA@@BA@C@A@EBA@A@A@A@ACAACAACAA@@CCCEA@@AAA@A@CAG@ABAB@@AB@BA@A@@AC@AB@BABA@A@@@A@ACC@AA@@ACAA@GAA@A@AA@@AAAAAAACCCCA@@CAA@C@A@A@EECA@@C@AA@@AA@A@C@AA@A@A@A@A@@BA@CBCD@@ADAFAD@DAD@BAFAB@DA@@BCBA@@@ABAB@BADABA@@BA@E@GBA@A@A@AB@BAFAD@BAD@BBBAB@BA@A@ABA@@@EAEA@@C@C@ABCBA@AB@@QHA@A@CAECCACB@@ABAB@B@BB@D@D@B@F@@@B@@@@@AB@BCD@B@BB@AJ@DAB@BA@CB@@ABEDA@DBBBDBBB@BBFBB@BAD@BBB@@@BBBD@BB@@BB@H@BBDDDBB@BBBAD@BAD@BB@DABAB@B@BB@DBBBBDBBBBB@B@B@B@BBB@BD@@BB@@@BB@B@D@BBBBDDB@BBB@B@D@BB@BBB@DB@BB@@B@F@@BBDBB@BBBF@BB@@BDBDAB@HEBA@CBAFC@AFADAB@B@H@DBBBDBBBBB@FABAFIFA@ABCDA@CBABB@@DAD@@@BDBDBBBD@B@BBB@B@DBBBBB@B@B@BB@B@D@DB@B@B@BBBBBB@B@BABABAB@BB@BB@BBB@BAB@DCB@BDDFBBB@B@H@BBBBDBFBB@BBDBDBDBB@D@@@BABADGBC@CBABABA@AB@B@D@BABABAD@B@BBDBDBD@DADBFBD@DABAB@F@B@D@@B@B@BBD@BFDFBDBDBBBBBBDAB@F@FAB@FBB@DBBBHDFDHBBDFDFDH@@BBJABAB@FB@BBDB@B@DAB@B@FDBB@BBBBB@BF@BB@BBBDAFCHEBAB@@@DCD@@@BABADCBEDEBCB@@@D@@@B@B@B@B@@@B@BBB@B@BAB@L@DBDAFBB@B@HHDBB@B@F@D@B@B@@BB@FB@@DA@E@E@E@EB@B@B@DBB@BA@@@A@A@@B@BA@BB@@DBD@@B@B@BCB@@@B@B@BABCBA@A@ABA@AAA@AAA@ABEBC@@@A@CBC@ABA@@BAB@B@B@DBB@@@BBBBBDADADAFCFBBBDFF@@BBB@BBB@BBBBBDB@B@@@@E@ADCBC@@@ABAB@BA@A@A@@BAB@FBD@@BBDBB@DBBBBDH@@BBBB@BB@B@B@B@FAD@B@@@BA@A@CEKAA@C@CB@BA@A@@@A@@AE@@@A@@@CBABE@@BADADCB@D@@ABA@@@E@ABABCDADADCBCBADADAHIFBFDDDB@HBF@DC@GBO@CBCB@HMDIFGFQ@@CAAA@@AA@@AAA@GB@AA@@CBCFAB@@A@@@A@@@AB@@AAAAE@ABCD@N@BABI@IBA@IBI@ABMBGFMHOBAFGFGDEFCL@NCH@J@L@LBLAJAFCDABGFGBCDAD@B@DBDBDBF@HEBEDEHIDCF@L@L@D@H@JAH@LCHEAA@AB@DC@@@A@A@ABA@ABA@AAA@@A@A@@A@A@@BAB@B@B@BCB@DAB@@@@A@@AA@@BC@@ACA@BA@@D@@ADCBABA@ABA@@@@B@@B@D@BBB@@BBB@B@@AB@@AAA@E@@@ABA@@@@AAA@A@@@A@@@@AB@BADAB@B@B@B@@@@ABA@@BAB@BAB@@ABC@@@A@CA@@AAA@@@A@AEIGG@ACCCAAAE@CAABC@E@CBCDG@GAC@A@AACACACE@EFCDAB@@AB@@AA@@AB@BAB@@A@CCCACBCDCFADC@ABABEDEBAA@@A@AAAAAA@@AAA@C@A@ABA@A@AAAAA@A@C@AACAC@A@@ACA@ECA@@A@CBA@@@A@A@A@AAA@@AAABA@GFC@@BA@@F@D@B@B@D@@AB@BA@C@A@EAC@AAAD@BBB@BA@GFAB@BBF@@ABA@C@EDA@A@A@@AAA@@AACCAAA@@@A@CB@BABA@ABA@@@AA@@A@AACAACCEAA@@@@@@@AC@AB@BA@AAA@@@@@A@AA@@A@@ACBCA@B@D@@@B@D@BABCBABCDA@@@CAAA@AAA@@@AA@@@A@A@A@@@AAAA@ABA@@AA@A@@@AA@@A@A@@@A@A@@@@@@@A@A@@AA@@@@@@@@@A@@AAEACACA@A@ADAB@BBBBDBBBB@@@BA@A@AA@@AC@AA@@AA@@@ABAFEBC@@@AAAAA@@@A@@BAB@BBBBBB@@BA@@CE@A@G@AA@A@A@C@CB@@@A@A@@DADEB@B@BB@B@DBB@@DBB@B@DEAA@ACEEC@A@AB@BAB@B@DBB@BB@BAB@B@B@@BBB@HBB@BA@@@AAA@EAA@@AAEC@A@A@@BA@A@A@C@ACAACA@@A@@BAF@BC@AA@AA@AAA@CBE@@AA@@A@@@@@CAABA@ABABABEL@BABA@IAC@A@@AA@BADGBCBA@A@AA@@CBA@AFG@A@AA@AAAAC@AAABA@EDA@ABC@A@CBA@@@"],["@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@A@@@@@@@@B@AB@"]],encodeOffsets:[[[107207,30386]],[[107206,30388]],[[107241,30070]],[[107301,30306]],[[107302,30305]]]}},{type:"Feature",id:"511028",properties:{name:"隆昌县",cp:[105.287612,29.339476],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@ED@B@@@@@@@@@@@@B@@BDG"],["@@A@AB@@@@@@DA"],["@@A@@@@@@@@@@@@B@@BA"],["@@A@@@@@AA@D@A@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BA"],["@@HAF@B@@AD@@@B@@@@@CC@@@ADA@AB@@AB@@BB@@ABCFCJMDEBEBCDUBAGU@G@A@CBCDAFCLA@ADCFEBCBEAE@AACGCGACAAAAA@A@@DEHEHAD@D@B@FBBBBB@BBFDBD@B@DABCBCBADAHBBB@D@PBDFBHBH@FA@@B@@@BB@@@@@@@A@@@@BA@@B@@@@@@@@@@A@@@@B@@B@@@@B@@A@@BC@@@@@@B@AB@@BBB@B@@@B@@@@@B@@@@@BB@@@@@@@A@@@@BB@@@@@A@@@@@@B@@@AAB@AA@@A@@ABA@@@@@AD@@@@A@@@AB@@@B@B@@AAA@@B@B@B@@AA@C@A@@AB@@@B@BAB@B@@A@@AA@@BA@@BA@A@@@@@AA@C@E@EDA@A@AA@ADG@A@AEAOEEA@AGC@AB@@@@@A@@@BA@@B@@@AA@AB@B@B@@@HBJ@NBJBBA@@F@FAFAB@@@B@B@BA@@@ADAB@BB@FBBBBD@DADADAFABABABCDOBABADAJEDABC@A@AAAAA@CFI@CCAA@@A@@A@@@AB@@AA@@@A@@A@AAA@@@@AAA@@A@AA@A@ABCLI@C@CCCCC]QAAAAAE@GFKBKBEBE@ACEAC@C@E@@@A@CA@A@@A@@A@A@A@@@A@A@A@@@CAA@@B@AAA@@A@A@ACAA@@A@@BADA@@BA@ABA@A@EA@C@@@A@ADAHC@A@A@A@AAACAK@C@CAAABABADCFABA@A@A@AAAC@A@AACB@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@B@@@@@BACC@@AB@@@@B@@B@@@@B@@@@@@B@@@@@@@@@@A@@@@@@A@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@AA@@@@@@@@@@A@AC@@@AA@AAC@A@MHCH@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@AABABAB@B@@@BB@B@@@@@ADA@@@AB@@@@@@@@@@@B@@@@@@@@@@A@@A@@@@@@@@@@@C@@AA@@GGCCEAE@AACAAAC@C@CBA@A@AACA@A@@A@@@AA@@GGAACAAA@@ACECCCECAACEEGAAACAC@CEG@AAAAA@AACACAECCACCEAA@@@A@@CAGEACAA@AAC@CBC@A@AAIBE@A@E@EACACCCC@E@E@CBA@A@C@C@AAA@@B@BAB@@@@A@A@A@@@A@@BBB@DADA@ABCBC@CAAAA@A@ABIH@@AFGN@BABA@CBC@C@ACC@C@CA@@@A@ABADBFA@@BA@ACCAAAAE@EBGBA@EDCDADCFCBCDCBC@AA@EEECAABAD@DBFBHFHDFHHBFAD@@ADGFADA@O@CBCBCH@BA@A@A@C@C@CBC@CBC@CBCDABCBAD@BADA@@AA@@ACCA@ABA@A@OHCBE@CA@@BEAAACAAA@CBCBAD@BBBDBBB@B@BAB@BA@CBCD@@CD@B@@FBBBDDBB@BABABA@GBC@M@ABE@AB@D@D@D@@FDBBDDBF@FAF@BCBA@C@I@E@CB@D@BDBFB@B@B@BE@C@@BE@CBCDCDADCDA@ABE@E@ABAD@BHJ@@ABABEBE@C@EAAAC@CBABAFABBDBBFFBBBB@BADADKFADAB@DBDABE@CBC@EBA@@@A@EB@@@@B@DBB@A@AD@@@BBF@BAF@@@@A@@@AAC@@@@@A@C@CDABGBABB@@@@@HH@B@B@H@FDN@BBBBBBBBDB@D@B@DDFBF@B@B@D@DDB@B@B@DEBCBAHCF@DBBDBJBF@DDD@DABABEBEB@BADBB@DADCBAD@@A@AA@ABCACC@ABADAF@BCBAB@B@DDH@DBBBDBD@B@@@BABABAD@ACDA@BBDBDAB@B@BBBBDBFDD@F@DBD@BAD@BAFCFAB@BCBCBE@AACAGAC@A@A@CB@DADBDDDDBHDBBBDDB@B@B@DEDC@AB@@@B@B@D@B@@@BA@A@ABAB@B@@@DDBBB@D@FBH@F@F@F@F@B@R@J@DADABABAD@D@HADBBB@DCJADBBBBDDBB@D@DB@B@@@B@B@BB@BD@B@B@@@BAAC@@B@@@B@BDB@B@@@B@H@BABBB@@BBD@@B@B@B@FBB@@@@@@B@@BB@@@@B@B@FAB@D@DB@@BCB@BBB@DABAB@BAD@@@B@B@D@BDDDBBB@F@HE@ABA@IDCBAF@B@HFJHBBB@DF@VAFABADABCDABIFIHEDA@ADD@BA@@@@DB@BB@@@@B@@@B@B@@@BAAABAA@@@@@CA@@AA@A@@B@B@B@DBHABAF@F@B@B@D@BCDAD@FABADCDABBFBD@@BBDAB@DABEDADABADADKBEDEBCBAD@BBHDD@BAFGBA@A@AAC@AB@BADBF@BBBF@D@D@@@BA@CDADAFBH@@BBHBFBD@DBDB"],["@@ACC@@D@AB@B@@BB@"]],encodeOffsets:[[[107901,29987]],[[107920,29990]],[[107917,29988]],[[107895,29996]],[[107822,30243]],[[107779,30211]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("攀枝花",{type:"FeatureCollection",features:[{type:"Feature",id:"510402",properties:{name:"东区",cp:[101.704109,26.546491],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@A@@B@@@@B@@@@@@@@@@@@@B@AA@@@@@@@@"],["@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@A@@@@@@@@@A@@B@@@@@A@@A@@B@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@A@@@@B@A@B@@@@@@@@A@@@@@@A@@@"],["@@@@@@@A@A@@@A@@@A@@@@B@@A@@@ABA@@@A@@@@BAC@AB@@@@CF@D@F@B@D@D@@BA@@B@@A@A@@@@@@@A@@@A"],["@@@@@AB@@A@@@@BB@@B@B@B@BAB@BA@C@AAA@AAAA@C@A@E@ABAB@CCAC@A@CBDDBBBB@BBBBBBBBBD@"],["@@@A@@@@@@@@AAA@AA@@@@A@@@@@@@AB@@A@@@@@@BA@B@@@AB@@EB@DAB@@E@A@MFA@ABCDGDABADEFA@@B@@AB@F@BBD@BBDBBBB@B@B@BA@ABE@ABBT@@@@@B@H@JL@D@BDBVBHDFHDH@FADAD@DBFHHJ@BFDHHDBBBFFDDHHPLPHFBD@D@B@B@hCFCDCFGDCDAJBLFP@HADCBAD@F@BBFRDFFBRB@@BCBIDKDI@A@CACCE@AIKGIEGEECCGC@AAA@C@A@A@ABC@ABA@A@@CAA@C@A@CADA@AD@@AEOEaAA@GAGBEBK@A@EBi@KAEAAA@GCIAGAK@G@IDEFMNEHCDCFABIHABKJCBCBC@ACKWCC@@@@@@@@@A@@@@@@@A@@@@@@@@A@@A@@@@@B@@@@@@A@@@@@@@A@@B@@A@A@A@@A@@@@@@@@AA@@A@@@A@@@@BA@@@@@@BA@@@@@@@A@@@A@@@@B@BA@B@@B@BA@@@@BA@@@A@@@A@@B@@@@@@@B@@@@@B@@@B@@@@A@@@@@@@@B@@@@A@@@@B@@@@@@@B@@@@@@AB@@@@@@A@@@@B@@@@@@@B@@@@A@@@@@@B@@@@@B@@A@@@@@@AB@@A@@@A@@@@@@@@"],["@@@B@@B@@@@B@@@@B@@B@A@@@@BAA@@@@AA@@@A@"]],encodeOffsets:[[[104166,27320]],[[104164,27319]],[[104284,27395]],[[104096,27220]],[[104140,27185]],[[104141,27178]]]}},{type:"Feature",id:"510403",properties:{name:"西区",cp:[101.630619,26.597781],childNum:9},geometry:{type:"MultiPolygon",coordinates:[["@@PBGGAAEH"],["@@@@@@BA@@B@F@ABABCBB@A@@B@@@@@@@BB@@@@@@B@@@@@@@B@@@@@@@@@B@AB@@@@@@@@@@@B@@@@AB@@@@B@@@@@@@@@B@@@@AB@@@@BB@@@@B@@@@@@B@@A@@B@@@@A@@@C@A@BD@F@D@B@B@BB@AB@B@@@@@BAB@B@@DBB@@BB@@A@@B@@@@BB@@AB@@@@B@@AB@@BBD@CBC@EAC@@C@@@C@@@@A@@@@@@@BAAACAABC@@BDBBB@FKAG@C@GAC@A@A@E@A@CAAAE@C@A@AAA@A@A@A@ABAB@BAB@D@BAB@@EFA@A@GCGACAC@EEA@A@A@A@EACA@A@@AA@CAC@A@CCICCIEACEEEECE@AAE@A@EAAAEAC@@AABGAGA@@@CAAAAAA@AAA@E@C@CBABCBCFBFDHHNNFBF@F@DAD@DBDLL@HAHAD@BABA@AD@BARADEDIHB@BD@DBBA@BBBJ@BAB@B@BBDBB@D@DADADEF@B@D@FBBDDBDDDDBDDBD@H@D@DBBBBB@BBBDBJBB@@@@BBBBB@@A@@B@@BB@@@@AB@@@B@@@@@B@LAJCVOFANAFAHAHGJKHSDAL@fAD@PAHEFEDAJALBFG@A@@@@ACA@AAB@BABBB@@@@B@@@B@D@BHHJ@T@F@F@D@JBDBPRFBB@B@@@@AEWACBABABA^EF@D@BA@CA_@I@G@A@@@@AS@@A@C@CAC@AAAAAAAA@AAAAACCAA@CACAAAAC@AAC@ABA@ABABGBABABCBADAF@@@J@BAFABABA@ECEAA@C@E@AAAAACB@@CDEBABADA@@BABC@@B@B@BA@AB@@C@AC@A@@@@@@A@@@@@@@@@A@@@@@A@@@@E@@B@B@B@DA@@@@B@BAB@B@@BB@BA@@@AB@@A@AAA@BA@C@A@@C@CDA@AB@BBBCDCBC@AB@AA@@B@@@D@@@@@@@DA@A@A@AA@@A@ABA@@BA@@AA@@BA@@@A@@@@@AA@@A@@B@@AB@@@@@@@A@@@@@AA@@A@A@ACCB@B@DAAAAACAFAB@@@BG@A@AE@BAAA@AAA@@C@CA@ABABAD@@A@@AA@C@AC@AA@AAA@AAA@@@AAA@@@A@@@@A@@@A@@@@@@BA@@@@BA@@BB@B@AB@@@@@B@@@@DB@ABB@A@@@B@@@@@@A@@B@@@@@B@@@@BB@@@@@@AB@@@@A@@@@@AAAB@@@B@@@BB@@BBBB@ABBB@@A@@B@B@@B@"],["@@@BB@@@B@@@B@@@B@@@@AB@A@@@@@@@@@@@@@A@@A@@@@@@@@A@@@@@BA@@@@@@@@@A@@A@@@@@@B@@@@@@@@@BA@@@AB@@"],["@@A@@@@@@B@@B@@@BA@@A@@@@@"],["@@A@@B@@@@@@@@@BA@@@@@A@A@A@@BCBB@D@@@F@@@B@B@B@@AB@B@@@B@@A@@B@@A@@@@A@@AA@@@AA@@@@A@@A@@@A@@AAB@@AA@@@@@@@AB@A@@@@A@@B@@@@@@A@@@@A@B@@@@@@@@A@@@@B@@@@@@@@@B@@B@@@@@@@@@B@@@@B@@@@@@@@BB@@"],["@@DA@@@CAA@@A@@@@D@D"],["@@@@@@@@A@@@B@@B@@@@@@@@@@A@@@@B@@B@B@@@@A@@B@@@@A@@@@@@A@@@@@@@@@A@@@@@@@@@@@"],["@@@@@BDB@@BABA@A@@AA@@@@A@@@@@@@AB@@AB"],["@@AA@A@@A@ABBB@@@B@@B@BA"]],encodeOffsets:[[[104032,27268]],[[104009,27201]],[[104089,27194]],[[104089,27191]],[[104071,27185]],[[104043,27173]],[[104013,27210]],[[104006,27207]],[[104011,27224]]]}},{type:"Feature",id:"510421",properties:{name:"米易县",cp:[102.112895,26.897694],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@@ACACHYBWAEAEAAA@OEOAC@A@@B@@BD@B@BA@C@C@EBC@E@C@ABEFCH@BCBCBEBA@@BABABA@A@AAA@EA@BC@C@C@CBAB@B@BFDBDBH@F@D@BABCDCBA@C@C@@B@DCDCHCHCBABADBBBDDD@D@FBP@DB@DDBD@FAF@DCDABCDABAHAB@J@HOLKLIH@L@V^HLH@JKAKHJJBLARLJCJCBHDF@HKDCL@CA@A@CDC@AB@@@@@BB@@@@D@@@@B@BA@@@@B@@@BA@@@@@DB@BB@@@@@@@B@@A@@F@@@@@@@@@B@@BA@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@AB@@@@@@@B@@@@A@@B@@A@@@@B@D@HBDDHFHHJDBBADCBADC@ECCFADAEEAGAEJEJEJIDBHDFBJ@HBLCFADA@EDCFAFG@EBCF@HBFCJGHEFCBK@ADAACCIIFQBE@KQHKDIFIDELCHGFGFCDCFEDEFAA@@E@CAA@CDEFGBC@CACCEGIEAEAGCG@A@@AAA@A@ABE@EACEEEAMAQCCA"],["@@@ABAFCDCFABA@A@A@ABAB@JADAB@@A@AACA@@A@ABCBAAACAAA@CBAJG@ACAEGCEECCAAAAA@GA@AAGBAA@AEBCFEFCDEDEHGHKDCFEJCJGLLRF@RAJEDJBDCB@BALEDGFIHEDGAE@AD@FEHEBCD@FCBEBKDGAI@EAGCCAIJIFIFBFBHFFCBEBDDDBFBN@HAH@HAD@HFBBBHJBHFLHDDBDJFFBFA@CDCBCDADB@@H@HEPBJDLDFAFEJADADAH@@ABCBAHGDCHM@CBE@EDC@C@@AAAAA@GDOFMFA@A@AA@ABG@A@CAACA@@A@A@ADABA@C@A@C@C@A@A@A@CEAE@A@ADEPIHEFABC@@@C@ACCCACAE@C@C@AAAEAE@A"],["@@ABCDABCAGIEGCGAC@G@C@A@@B@@@@AB@@@@@@A@@@@@AA@@ACA@@@@B@@A@@@A@@B@@A@A@@C@@@@@AA@@@@A@@BCD@D@BDBK@CDGLE@GCDADIKIBQAKIILGLB@IKG]G@U@KJGLKPK@G@IEDCBCBC@AACA@AAA@EAEEMCE@A@ABC@AA@AAC@@@EBKDCBI@EACBE@C@A@@AAA@CBCBC@CAECGACGA@@A@@B@B@@A@C@ABCBG@C@CBEFEDCF@D@BBDHJBH@D@HAH@D@BABC@C@GAA@AAAC@CAIAIACEIGGKAA@CAAAE@A@E@A@AAEAKEECC@CBEBCBI@C@ABAD@H@ZA@GAOCE@C@EBEBEBC@GBOBK@EAC@CDADCDIFMJCDEF@BD@AB@@@@@B@@AB@B@@@BA@@@@@@B@@@B@@@B@B@@@@@B@@@B@@@@@@@B@BA@@@AB@@@@@BCFADCBCBC@A@AAAAAAA@A@ABABABAFAF@F@F@B@H@D@DADCHADONKJGDCDCDEFIFEBCBCDABGDKFIDIDEBcFKDABCDCNCNCFMPMPADEVCXAL@LHb@LAFAFGDCD@FBDDBlXnZPL\\VNJBBANAHBFBHDFNJDDBDBNDJDFLLJJFHDJFbDTAD@DAD@DDHNPFJLPNPFDRDhFL@BBDDBP@L@HDLFZ@BABM@CBCBAFCFCF@DBBFFF@HBJDDRBFDRCJEPADBDDDHHDJBFAT@JBFFFNLBABCBEBEAEBEBAHENIBE@C@CCAEC@@AM@EAAEAAAAA@@DCREBABA@ACC@AECCA@C@CBC@E@E@CDGDCDADCD@DCDA@ABCBE@CACBC@ABADCLKDCHQBCBC@AACCACAAAABE@CAAAAA@@@ADEBEJOBG@C@GDIBG@AD@B@FBFBFDBBD@DBDBBDBBHDNLDDB@B@D@NELCFADCBADC@ABEBCBEBK@@J@FBFDB@D@LEHCBCDKNWDAD@HDPLBDBLBFDBHDHFBBFHFHDFBFBDFJFFFLBJBNDHBDD@PDFBFFHFBBJBFDDBFLDF@BBDTFF@HCD@B@DDBFBDBBHBLBD@DADABADCBE@]DEHOBCBKDIDCDCD@BAH@J@HBJAFABCFKFKFEFAH@FAB@FADCFEBADADBD@@@B@BBFG@@FGBEACEGAAGEAE@M@C@ABK@GACAAACGCSMECCC@E@CFKFUFMDE@EBKBG@CGOGOAIAACAAACA@A@AB@DCFA@A@A@ACEEECCAA@ABAFCLGHGDEBE@EAEACCCAAAA@A@C@ABABA@@AA@AAAA@A@AA@AA@C@C@ABE@A@AAAAAACEAC@C@A@@@AFEBGDMDGBC@CAGACAEAC@E@GBI@KAC@AEACCAA@@ACCI@CG@CBCBIBEFEBKCICOAGFG@DBDD@B@DAB@BCDCBC@A@CAAA@C@AEBEAIEACCCKGGEIAAGAAGEC@GBG@GBM@EACA@FCD"]],encodeOffsets:[[[104585,27314]],[[104627,27420]],[[104539,27481]]]}},{type:"Feature",id:"510411",properties:{name:"仁和区",cp:[101.738528,26.497765],childNum:9},geometry:{type:"MultiPolygon",coordinates:[["@@B@BD@@@@@BBB@A@C@A@@@A@@A@AAABA@BB"],["@@EAOj@@Vg"],["@@OGAp@@Rg"],["@@@A@@A@@@@@@BB@@@@@"],["@@BB@@@@@ABAA@AB"],["@@WECLAHAN@DC@@@@B@@BB@B@@BB@BBB@BBBD@@B@DBB@@@BC@ABAB@BDBD@@@BB@BBBABF@@B@BAH@@A@EBDBBBBBCBA@A@DD@B@B@BB@@B@@@@@B@@@@@@BA@@@AB@@@BB@@@@B@@@B@@AB@@BB@@AB@BAB@@@BBB@B@B@@C@@@@@@@C@@@AB@@BBAD@DADCAA@ABAB@DCD@@@@B@DABB@BBB@@@BA@@B@@AAA@@@ABA@A@A@@B@@C@A@A@AF@@@@@@B@@@@@B@@@@@@@@@B@@@@B@D@@B@DA@@BABA@A@@@ADAB@@CBABABCF@DA@BDBBBBF@D@B@FBFDB@BABABE@A@I@@BEBCDABABAHABABAB@BAD@BBD@NQ@A@@BA@@CA@@@A@@@@SYEBC@C@@B@@@@@BBA@@@@@@B@@BA@BB@@@B@@@BB@@@@@BB@@B@@BB@@@@@@BA@@@@BA@@@A@A@@BA@A@A@@@E@@@C@A@GCGAKECIBC@EBC@A@CIH@@CBCEYE"],["@@HbDHhG@@BA@@BA@@@@@@B@@@@@BB@@@BABABJD@@@@@@@@@@@AA@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@B@@A@@@@B@@@@@@@AB@@@@A@@@@A@@@@@AA@@@@BA@@@@@A@@@@@@@@@A@@A@@B@@A@@@@@@@@@@@A@@B@A@@@@@@@@@A@@@@@@@A@@@@A@@A@@@@@@@AB@A@DABABAE@A@@@AB@@@@A@@@@A@AB@@@AABAA@AA@AA@@A@@@A@@BABB@@@@B@@@@@BA@@@@@@AA@@@@@A@@@@@AB@@@@@@@@A@@@BAA@BCA@@@@@A@@@@BAA@A@@AB@@AGCmP"],["@@HBJNPXDNDHFDDBBBHVFLBL@FBDB@FAFAFAFBBHBNFZHDLDFAFGFMFM@E@C@ABADAFCHCDCDE@CDCDALAHCHEFEBE@G@CDCJEDEDE@CAEAEACMKECCECaKEIACBCDEHCDEDgDA@A@C@C@Uh@@BBA@@@@@@@@@@@@@A@@@@@@AB@@@@@@@@@@@@@PiQh@B@@@@@@A@B@A@@@@@@B@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@AB@@@@B@@@@@AB@@@@@@@@@B@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@BoOKGGCCEEAACAGGEC@AGIEGCAC@CBEBG@GCCEAGAUACC@K@B`@DABC@E@]FABABABBDFX@B@@A@A@EAOQCAIAC@E@E@S@I@OAKAIBCBEFGFOBC@eBK@CBGTILGHGBEBMBEBUPIDKBA@@@@@A@@@A@@B@@A@@AA@@@@BA@@BABABA@A@CDABCBABAD@BAB@B@BA@A@AAC@CA@@CBAB@@CBABED@DAD@BBD@B@FBDBBBBB@@BFBD@BDDBB@D@BBBDDNBBDB@@JDHDNDPHDD@B@B@@DHDDBBBBD@B@BABADAB@TJB@@D@D@HB@D@D@F@DAB@BBDBBD@@B@HBPFDDJTHPDBF@DAFGDEDODGJEDAFE@ADEbQFCDMBCHEPCTEPEJCJOFEHCJGHEBG@UFOLKDA"],["@@C@K@ODIFQREFCDMPEL@L@X@@BTDJHJHJBD@BA@C@EAA@gRM@CB@@@B@BDDDHBF@BABC@CAEGCAA@@B@DENMR@BY`KPCBABC@C@C@E@CDGDE@IAEBK@ABCDGXCDCBA@E@IEKEOGG@G@MDEBGAIEKGGCEA@@CBSVEHAD@PAFCDIFGDE@E@E@CD@DBDDHBHADCDEDKB@@AF@BFDHD@B@BABAB@BB@BBBBFJBHNJLDFDFBJ@dIREF@F@LBDB@D@DCB]JGD@BAFBDBBFBJ@DBLRFPBF@DCBEDGF]DCBGDAB@F@HFRHZBFAFCBCB]@@@@BBFDHAF@B_RIH@@EDKBEDCDKRCFEFIHEJCFCBC@CAC@C@EDGFAFGHCBC@M@G@@@CBADCJGLEFGDCDAD@HELA@AG@GBC@CA@@AAIC@@ABCDABABABABCDABCDABEDCBAB@@AD@D@D@BBDBB@BBBBB@BBBBB@@@B@@@BA@@B@@@BAB@BA@@BCF@@AB@@C@CCKGEAC@A@@@CBABAF@DABA@AAA@A@@BDFDJ@BAB@BA@@BA@ECOMCAAAA@@BCB@BAB@B@@B@DBB@B@DDBL@BBBA@A@A@AAC@ABABABABAB@@A@@F@@HFDF@F@FCHANABAL@D@@BDABDL@@BBBBF@\\ED@HAH@B@B@FBHDD@H@HCDAF@D@B@DBDD@@JF@@JGFCBCBQ@ABCB@BA@ABCBG@GKKAC@CBC@C@EAEMEGMCGAEDEDABADAD@F@B@BBB@BBBBDB@@B@BHAHBB@@BDBFBB@F@BBF@BDFFFFFBDJFDDDJ@D@BBD@DBB@@@BDBFBB@B@B@B@FFD@DBHBHDB@B@FE@@BA@A@CBA@ABABAB@B@B@B@BBB@D@F@BBDBB@F@B@B@D@HBD@H@LB@EAACA@AD@BADBBBAB@@@@@@B@@@@@@D@@@DD@FBD@DAC@AA@@BA@@@A@@A@@BA@@A@@A@@@@BA@@AA@CA@@@ABA@A@@@@ABA@@@@A@@AABAB@@@@BBA@A@A@A@C@EACB@D@@@B@@@@@A@A@@@@A@@B@IC@@CA@AgHCGGanOHD@@B@@A@@@@B@@@B@@@D@@CBMBGDKXFZFDF@C@C@@B@@@BB@DJG@D@BAD@FADDJLFHBHDDA@AB@B@B@@@@@B@@A@@@@@@@@@AB@@@AA@@@@@@@@@A@@A@@@@@@@@@A@@@@A@@@@@@@@@A@@B@@@@@@@@@@A@B@@B@@@@@@@@AB@@AD@D@FATZB@@@@@B@@@AB@@A@DBB@@A@@@@@@@@@A@@@@B@@@@B@@@@@@@@AB@@@@B@@@@@@@@@@BB@@@@@@@@@@@@@B@A@@B@@A@@@A@@@A@@@A@MRBBBBBD@DBBDAB@D@DB@DBABAF@B@D@B@BB@BBB@B@DABA@ABA@A@A@@@AA@@@@@BA@@B@@DBD@B@@@BAF@BAB@@A@A@AAAAAAC@AAC@A@EBA@@@AB@FEBCBAHCDCBAB@NEB@F@@@BA@CFA@@BA@@A@B@@A@@@@B@@@BA@@@@@@B@@@@@BBB@BB@@@@@@@@@B@@@@@@@@@B@@@BA@@B@@@@B@@@@A@@@@@A@@@@B@@@@@@A@@@@@@@A@@B@@@@@@@BA@@@@@@@A@@@@@@@A@@@A@B@AA@@@@@@A@@A@@@@AB@@@B@@B@@B@ABB@@@@@B@@@@@@A@@@A@@@@@A@@@@@@@AB@@@B@@@B@@A@@B@@A@AA@B@@A@A@@B@@@B@@@@@@@B@@A@@@@B@@A@@B@@@B@@@BB@@@@@@@@@BB@B@B@@@@AB@@@@@@@B@@@@@@@@A@@@@@BB@@@@@@@@@@B@@@@@@@B@@@@@@@@DDLXBDD@DADALIBAJGBADEDCFGNMFEJCH@L@HBJBHDB@BBBF@LAj@F@BALAFBH@HBBFbFP@BC@@BCBDBB@D@B@DB@@J@HAF@FCPIJIDEDEFIDCNE@@HA@@@@DCBA@A@@BEAECIAE@@BE@@@A@@BCBADEBEDK@CBA@E@K@ABE@ADEBGBI@GAU@ADE@ADGBGBIAM@GBCDMDMDEDAF@H@N@F@D@DA@@BA@CBA@CBC@C@KBCBCDEDAH@LBD@F@REDAFAFCDIAIEOAK@UAO@AAACCACCI@E@EBCBADABABC@C@I@GBC@CBO@C@A@CAA@CAEAC@AAAA@@AGKAAAGAI@A@C@E@C@AACAA@A@C@ABC@C@E@AAE@ACAACAAAE@AACACACACECCACCGI@C@A@A@ABA@C@CAAAC@AAG@C@EBABCBALMBABC@A@AAC@A@C@KACCAAAE@GAKAEAQECAGCC@AAA@GGA@[QCCGCCCIEAAAA@C@EBAHKBC@C@E@CACAA@EACCEGICCACAEBCBCHGDCBABC@C@CACAGAC@CDK"]],encodeOffsets:[[[104033,27261]],[[104177,27300]],[[104174,27299]],[[104144,27181]],[[104010,27223]],[[104028,27167]],[[103980,27190]],[[104120,27310]],[[104285,26709]]]}},{type:"Feature",id:"510422",properties:{name:"盐边县",cp:[101.855071,26.683213],childNum:1},geometry:{type:"Polygon",coordinates:["@@CKAIAGBKBGAEEKECAAC@ADABEBIDGBOBCBC@@DAJCHEDADGLCFADABCBE@GACACBE@C@CAEACCEAKACACCEKUoQFE@C@KAG@CBCFADAD@L@DAD@DAB@DAB@@CBC@E@M@G@E@CBCFCNCNAD@HBNAJAHCH@BCF@BBV@HAJAHCF@BAF@B@L@FAB@DCLAFCFABAD@@@B@@AF@@BFDJBFAF@@@BABCD@@@@GB@@MFCDEJCFCFIJOJEDE@GBI@@@@@@BAB@BAD@B@B@B@DBB@BHDDDFFFHHJJL@BDFBD@D@BCJCLAJAD@@QAEACEEQAAE@C@ABCDGBO@DbDFFDNLBDBFBF@DCFCFIFCD@D@HAFEFGFGDKBCBCD@DCFCDGDEDCBAB@B@D@FENENEHEBKCGCEYAMAGEAEBEBEBA@AC@EAKEKGUAACAECCGCMOWIMGACBKLEP@VAHGFIHGDEFIPIDOFSFODGFADCNEDaRCF@BEFCBIFCHCPCFEHCBE@CAGOISCCOEGAA@@@ACCAAAA@CBE@C@C@A@@G@C@CA@SIA@CBABABA@C@AAAACCCGABGFEFADBDHPDDDB@@FAHCD@B@DD@BBF@@@H@D@FABCBGBA@AACAC@E@EDCBIDEFIFGDG@GDCDA@A@G@AACAGCEAEEACAEBCBEDEFCBC@CBGDCBEBEBA@EAU@EAAE@KDIFC@ABCDABCDC@EAEAAACCCACC@E@G@ACCCAA@CB@@CBC@@@G@CAGAIAE@CBABABBBBDBTEJ@D@@@BBD@D@BAD@BCB@@ECGCC@@BAB@D@BADABABCBABCFADABCBA@CAE@CDAB@@BDBBFDDBFBHHLFDDBDBBADBB@D@D@B@@CDC@@@ABABA@@@ABA@BDFBDB@BDBBBBDFHDFDB@DBD@DBBDFBDDDFHBDBD@DAD@BCBEDEDADADADCFABADADEBKFABCD@DCDCF@BAB@D@DADEBC@EBC@CBEBE@C@AAEAC@CBABCBC@KAC@CBCD@@AFCDCDEDADADC@C@AAAACCE@CAICG@EBEBCDCBEBC@@@C@A@@@EBC@@@CDCDAD@JADABABCDEJ@B@DBDBFBHDJFFDFBF@DCHA@E@CACAG@G@K@G@G@C@CDCDCB@DAD@FBPBHBD@FCFABMFEFEFCAC@CAC@E@EDEBAB@@CBCBCBABCBCBCBCBABCBC@ABCB@@MBIBQFOJOHAD@DBR@DFFB@@BBBBBBD@DBB@DBD@DCHBDBB@D@DABAD@HDD@BDBBBD@BBBB@DBFBBBD@@BB@J@D@D@BABGBML@B@B@BB@@BBBBDBB@DBB@B@@@DB@@B@B@D@@@@@@@@B@@B@@B@BBB@@@BB@@@@@@BB@@@B@@@B@@@@@@A@@B@@@@@B@@@B@@@@@B@@@BA@@@B@@@@B@@@@@@@@@B@@BBBB@@@@@B@BB@@B@@@@BB@@@@BB@@@@@B@@@BA@BB@B@@@B@@A@@B@@A@@@@BB@@@@B@@@@@B@@@@@@@BA@@@@@@@@@@BB@@@@@B@@@@@@BB@@@@@BBBB@@@@@B@@BB@@@@@@@B@@BB@@@@@B@@@@@B@@B@@B@@BB@@@@@@@B@@@@@B@@BB@@F@B@B@D@B@BBDBDBNHDFFFDFBBDBDBB@DADADEFC@@D@DB@@DDB@BB@B@BB@B@B@DCDCBAJAD@D@J@DBHBB@DBB@JLDDBBBBB@BBJ@F@FA@@B@B@DFFFDBBBB@B@B@B@DCBCDCDADAB@@@D@DBHDBBD@B@B@B@B@BBDDDB@@D@B@BAFCBABA@ABCBE@ADABAFCFCB@@@BAFBH@D@LBBBDBDBBB@@BAB@DCFGDEDEDAFCHEJGDABCFC@A@A@ABC@@@@BCBCDABABAD@@ADFDDDBBBJFFBFFBDDD@BBF@JAFBD@BDBDBBBBDLR@H@DAD@@AFCFCFCH@F@D@@BBLDtV\\PFBB@D@FCD@B@B@D@D@DADCDCFCDAFAF@P@NBDB@@@B@J@B@FDFFRBHBH@BDB@B@BAB@B@DBD@BBBDFHHB@B@FBH@DBDBDDBFBBBDDD@BDBJDBBBBDHBFPRDDBHBDBBDBFDB@BB@B@BAH@BBBBBDBDBJDJDDBB@D@FADAFAH@D@D@BDDBDDB@BBNADAD@BA@A@AAIAKB@@AB@HAJABAB@BA@CAE@AAA@@@A@ABA@@B@FDHDB@B@BADABABKBAAC@EBEDIBE@EAECICMAIEEAEAC@C@EAAACEEEEEC@A@ADABCDGBCBC@C@E@ABA@C@AAEAACEACAA@A@AFAFCDC@CFMDIJWHGJMFGJKBEBE@EBADCFEDCDADCDCBEBALGLCDAD@FBFDB@F@FADAB@DBD@B@FEFCF@FAFBBDLHDBDBH@PC`MDATING\\YMOCG@CBC@CBCCSEaCIEGIIKKCECIAMACCCMICEAGAEBGBMAAMI[UOKmYkWCAAC@EDCHCBEBE@KGa@KBKDWFUBCNONODEDMDMDCBALCdEFAJCJCLEHCBADCDAFAJEFEDCDCHCLIPMBCDGBC@C@C@G@A@E@EBEBEBABABAB@B@BBBBBBB@D@DADABCDE@A@@@C@C@A@E@CDE@@@@BA@AFEDCNIJEDCBCDCD@FBL@PAHAD@FAFAFAD@F@PDHBB@@Y@GBCBAD@E]AQBA@CDDKFGHAB@DAL@HFDFBR@B@FDf@@@@@@@N@@@@@@BXBT@DFNB@F@BBDBB@LBHHFJBDBJBJ@DBDBBB@HBD@D@BA@A@CBG@G@CAGGIAC@A@CDEFCFEDAD@H@DABAD@A@@C@CCC@ACC@CBCBABA@@@@@A@AAA@@AA@@@@@A@@D@@@@AAA@E@A@C@E@CCC@@@A@@B@@@@A@@CEA@CA@@@A@@FCBAB@B@@@BA@A@EAC@@A@@@A@A@@@A@@A@AA@@ACCA@@A@AAAAA@@A@@A@@@A@@DC@@DAB@D@J@B@B@B@B@B@B@@A@C@C@C@A@CFABADADIBO@OBEDA\\@H@@AHIDIBC@QBG@KASESAIBIBEFEDCDC@ABCAE@E@E@EBCDAFAFCBCBC@EEIGIGE@AAMEIECCACG@I@G"],encodeOffsets:[[104481,27094]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
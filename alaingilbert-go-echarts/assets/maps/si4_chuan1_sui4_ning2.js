@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("遂宁",{type:"FeatureCollection",features:[{type:"Feature",id:"510903",properties:{name:"船山区",cp:[105.568297,30.525475],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@B@@@@@@A@@@@@@@@A@@A@@AA@@@@@@@@@@AA@@@@A@@@@@@AA@@@@B@@@@@@A@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@BB@@@@@@B@@@@@B@@@B@B@@@"],["@@@AA@OT@@AB@@@@@B@@@@A@@B@@@B@@@@@@@@@B@@@@@@@@@@BB@@@@JBBB@@B@@@@@B@@B@@@@@@B@@@@B@@@@@@@B@@@@@@@B@@@@@@@BA@@@@@ABABABQPCD@@ABAB@@@@@@A@@B@@@@@@@B@@@@@@@B@@@@@@@@BB@@@@@@B@@@@@@@BB@@@@B@@@@@F@B@@@@@B@@@@BB@@@@@@@@@@BB@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@BA@@@@@@@@BA@@@CDCBCBCB@@A@@B@@@@A@@@@@@@A@@@@@A@@@@A@@A@@@@@@@@A@@A@@@@A@@EI@@@@@AA@@@@@@@@@AA@@@@A@@@@@@@A@@@@@AB@@@@@@@@ABCBCDABAB@@@BA@@@@@@@@@A@@@@@@@@@A@@@@AA@@@@AA@@@@@@A@@@@A@@A@AAA@A@@@@@@AA@@@@@@@@A@@@@AA@A@@@A@A@A@@@@@A@@@@@A@@@@@A@@@A@@@@BA@@@@@@@A@@@@B@@@@A@@B@@@B@@A@@@@B@@CHELAD@DA@@B@@@@@@@B@@A@@@@@@@A@@@A@@BC@I@@@AB@@A@@@@@A@@@@@@@@BA@@@@BABA@@B@@A@@@@@@BA@@@@@@@A@@@@@@AA@@@@@@@@@@A@@@@@@BABE@@@A@@@@@A@@@@@@@A@@@@@@@@@@@AA@@@@@@@@@A@@B@@@@A@@@@BEDCDA@AB@@@@A@@BA@@@AB@@@@A@@@@@@@A@@@A@@@ABA@@@A@@@A@@@G@E@A@@@A@@@@@@AA@@@@@A@@@@@@@A@@@@AA@@@@@@@@@AA@@@AEECC@A@@@@A@@@@A@@A@@@@@@@A@@@@@A@CBA@A@A@C@A@A@@@@@A@AB@@@@A@@@A@OAG@A@@@A@@@@@@BA@@@@@A@@@@@ABCBCB@BA@@@@BA@@@@@@@AB@BABA@@B@@@@AB@@@@@BIFCBEBGHEFGD@HEHCHGBED@FFFHJ@B@@@@@@@B@@@@@BA@@@@B@LAF@B@@@@@B@@@@@@@B@@@@A@@B@@@@AB@@@@@@ABAB@@A@@B@@@@A@@@@@ABEBAB@@@@A@@@@BA@@@@@A@@@A@A@EBOBGB@@A@AB@@@@A@@@A@ABA@@@A@@B@@A@@@@@@@@B@@A@@@@B@@@@@BAF@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@BB@@DFDFBBBDB@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@D@BAB@D@B@@@@@BA@@@@@@@@B@@A@@@@@@@A@@@@@AB@@@@A@A@E@O@G@A@@@A@@@@@A@@@@@@@A@@B@@@@CBADA@@@@@@B@@A@@@@B@@@@@@@@@B@@@@B@@@@B@@@@B@@@@@@B@@B@@@@@B@@@B@FAL@F@B@@@B@@@@@B@@@@@@@B@@@@B@@@@B@@@@@@B@@@@@@@@@B@@@@AB@@@DABCJ@BAFAB@@@@@@@B@@@@@@@B@@@@BB@B@BBFBF@@@B@@@@@@@B@@@@@@@B@@@@@BA@AF@@@@@B@@A@@@@B@@A@@BABA@@@@@AB@@@@@@A@@@@@A@@@@BG@EBA@@@A@@@@@A@@@@@A@@@@@A@EACAE@GA@@AA@@@@A@@@@@@@A@@AA@@@AAA@@AA@@@@@@@@AA@@@@@@A@@@@@A@CAA@@@A@@@@@@@A@@A@@@@@@A@@A@@@@@@@AA@@A@@@AA@@@@@@A@@@@@@@A@@@@@@@AB@@@@@@@@AB@@@@@@@BADADAHAFEJ@DAD@@@BA@@@@B@@@@@@A@@B@@@@@@A@@@@@A@@@@@@@A@@@A@@@@@@AA@@@@@A@@A@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@AB@@@@@@@@@B@@@BAD@B@BAFC\\AF@D@FA@@B@@@@@B@@@@@@A@@@@@@@AB@@@@@@A@@A@@@@A@@@@@@@@@AA@@@@@@@A@@@@CE@A@@@A@@@A@@CI@CAE@A@@@A@@@@@@@AA@@@@@@AAACCGIABA@A@CBABCDABABAD@B@DBBBDBBB@DBDBBD@@@D@BBB@BBDBDDBFBB@DBB@BD@BCDABABADBB@BDBB@BBDDBBDBD@BB@BD@BBBBBB@@@BBD@D@BBF@D@B@BBB@BBBBBBBBBBA@@BA@@BA@@@A@A@@@ABA@ABA@@@@BAB@BA@AB@@A@@H@B@DBBBBBBB@D@D@DABBD@BDDBBBBBBDBB@D@D@D@D@D@DBB@D@DBD@D@FBDBDBB@BB@@B@@BB@@BBB@BBD@B@B@@@BBBBDBBD@B@DAB@DADADCBABAFABADB@DBDBBDBBB@@B@DABEBCBCBCBCDADAD@D@F@F@B@D@F@D@FABADCBAB@@A@ABABCB@BABC@C@@BABAD@D@BBDAD@@BBB@D@B@DBBBBBABADA@@B@D@D@BBB@DBDDBBDBBB@BFBDBFBD@F@B@B@BA@CBCBABAFCFCFCFCDADCBADABADBBBDDFBDAD@D@@@BAD@D@BBDBBBBB@BBBDBBDBBDDB@D@D@@@D@@C@C@ABCBAB@DBD@D@DBB@BDDBBDBDDDBDBBD@D@F@D@DBFBBD@D@BBBBDBF@DBDBBDDB@B@@A@A@ABCBADAD@BADADADCDABABAB@B@DABC@A@C@EACBC@CACBE@E@CBCB@BABA@CBC@C@CAC@CAAAAAAC@ABC@A@AA@AAABC@ABC@AACACCACAC@AAA@AC@ABCBC@CBCAC@AAAAA@CA@AACAAACACAAAAE@CACBC@A@CBCBCBABC@AAA@C@AAE@EAC@A@C@EAA@E@AAC@C@ABCBC@CBA@AAACAAAA@C@ABCBCBCDEDCDCDCBEDCBCBA@C@AAAA@ABE@@BC@C@A@CCCAACEAE@C@EBCBC@C@AAACAEAC@CAEACCAC@A@C@C@CACACAA@C@ABABC@A@A@C@@@ACAC@C@C@EECCCAACAABC@ABADABABAB@BAB@D@BADBD@D@B@B@D@DBDBDBBBBBDBDDDBBBB@D@D@B@DABAD@DA@ADABCBCDC@@BCBCBCBEBC@C@E@EBE@C@EBGBEBABCBABAB@DAHAB@B@B@D@DBDB@@DBBBD@BBD@BDD@BDBBFDB@BDB@BDBBBBDDBBBBBBBBD@D@BABABA@A@ABA@@@C@AAE@A@A@AAA@EACAEAA@C@CBCDCB@FA@AB@BAHCDABABA@A@A@AAAAACAACA@ACAACAACECEAACAEBCBCBEBCBA@CACAA@@CAECC@C@CAE@EAEAAAAABC@ABAFIDCBCBA@@@@@A@AA@C@ABCBA@A@A@"]],encodeOffsets:[[[108260,31131]],[[108271,31049]]]}},{type:"Feature",id:"510921",properties:{name:"蓬溪县",cp:[105.70757,30.757575],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@CAC@A@A@A@GBCBA@ABABADABAFAH@F@DAF@FD@B@B@@AB@DABAB@ADB@BAB@@B@BB@BBBBD@D@@AB@@@B@@A@@@@B@@A@@B@@A@@@A@@@A@@@A@@@@@A@@@@@A@@@@BA@@@@@@AA@@@@@@@A@@A@@AB@@@@A@@@@@A@@@@@@BA@@@@@@@A@@@@@@@A@@@@@@@A@@@@A@@AA@@@@@B@@A@@@@A@A@@@A@@@@@A@@@@@@@AA@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@B@@@@@@@@A@@B@@B@@@@B@@@@@BB@@@@@@@@DACA"],["@@B@B@B@@@B@B@@ABA@@@AAA@@AAC@A@C@@BA@CB@D@@B@DB@@B@@@"],["@@@B@DA@@@AD@@BBD@B@BAD@B@B@D@@@@AB@B@BABABCDA@C@A@A@A@AA@C@A@@@E@CBEBABCB@BAD"],["@@C@A@CAC@C@A@@D@B@BBDA@ABA@A@AAA@CAC@ECCAE@E@CACAA@C@CAAB@@ABAD@BBBDB@BA@@@ABCBA@CBC@EBABCBC@@BAB@B@D@BABCBA@ABAB@BA@CAAAACEAC@CA@@A@ACAACA@@ABADABAD@B@BBB@BBBF@B@B@B@BB@DBDAD@BADA@AA@C@C@AA@A@@B@BAB@BABC@CAE@A@CB@@A@AA@A@@@C@A@AAC@@A@A@ABAB@DABA@C@CA@AAA@CB@@ADBBAJABABA@AAAA@@@AAA@AAACAAA@CA@@AB@@CBABAB@BAD@D@DBBBBBB@B@BA@A@C@C@@@BB@BDBBBBDBBA@A@CAC@CAABABADA@@@AABA@C@@CAAAGCCCCA@C@CBA@ACAAAC@C@ABCBABABCB@F@BBD@DDDBDDBB@@B@BC@CDC@ED@@AB@BA@AB@@@@@BA@@@@@@B@@@BAB@@@@@B@@@B@@BBABA@@B@DCDABADCDEBEBCAEAECAA@@CAECCACAC@CBCBAACAAAACBABABABC@CAECEACEGECECC@CBE@C@A@A@A@@@@@A@@@@@@@@AA@@@@AA@@@@A@@@AA@@@@@@A@@@@AAA@@@@@@@@A@@B@@A@@@@@@@@@@@A@@@@@@@A@@@@@@A@@@@A@@@A@@A@@A@@A@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@B@@@B@@@@@@@@@B@@@@@@@A@@@AAA@AAA@A@@A@@@@@@A@@@B@@@@A@@EBA@CDAD@D@DBBBFBD@FBB@B@B@BBF@B@D@@AB@B@BABABABC@C@AAAAAAAACCAAAAACA@ACA@ECAAACC@ACC@AAC@AACA@@CB@@BB@@@BB@@@@@@@@@@B@@@@A@@@@@@@@BA@@@@@B@@BB@@@@@@B@@@@@@@B@@@@@@@B@@@@@@AB@@@@@@@B@@@@@B@@A@@BB@@@@B@@@@@@BB@@@@@@AB@@@@@B@@@@@B@@@@@B@@@B@@@B@@@BA@@@@BA@@@@@@BA@@@A@@BC@C@AAAAA@@A@AA@ABA@BCA@ABCBA@@BA@A@C@@F@DADAFADADAD@@CDADADCB@BCBC@ABCBA@C@C@A@AACACCCAAAAACACACAC@A@A@C@C@CAABC@A@ABA@ABABCBAB@BADBBBDDBDDFFD@D@D@DB@B@@@D@B@BADAB@B@DBBBDBD@D@D@D@BBDDDFBDBD@FBDBBB@B@DADAD@F@DBFDFBBDD@D@B@DAD@@AF@BBBBBD@B@DADAFCDADCDCFCDCDADABAD@B@BBDBBB@BAB@DADAD@B@DBD@B@FBB@F@D@BBD@FBF@B@DBB@BADABADAD@D@BADBD@DBFBBDBDBBBDBBBB@@DBBBB@BBDAD@DADAD@BBDB@BBD@DBDBBDBD@BAD@BADBB@BBBB@D@BAD@BBBBBB@DBD@D@DAD@DABABA@AD@D@FAFBD@DADBD@F@D@BADCBA@A@ABABCBCDCBCBABC@CBABAD@B@B@BA@A@CCAAAC@CAEACAA@A@CACEACAC@E@C@C@AAACCCACACCAACA@CAC@C@CAA@ABAD@B@D@DC@@@C@C@A@CCAAACCAAA@AAAAACAAAC@C@AB@@C@C@CBEACCAACAABCBABCDCBEDEDEDEDABABAD@DABA@A@E@C@EACAEA@AAACAAACCCAA@AAC@C@A@@@CBABABAAAA@C@A@CAA@AC@CBAAC@C@ABAB@@@DADABA@ADAB@B@BA@ABCDABEBC@E@C@A@E@E@C@C@CBCBADADADADAFCBA@@@AACAAAAC@CCAABEBABABCDCBCBA@CBA@C@AAACAA@AABADAF@BAH@B@HABAJEDCDCBE@CBG@I@GACACAACAG@AECGCEACAG@GDCBEDABBDFBFDJDNDFDDBB@BBBBBB@D@DADGJA@ABEBIBM@@@AB@BBBL^@D@DAF@B@BAH@DBBDBJ@DBF@D@DBDBBBBBDDDBB@B@FCDAD@@BB@@@@@B@BB@@BBB@BDBDBB@D@DD@B@DAD@BB@BBDBDBBD@J@DBDBB@@@@B@B@@BBB@@BB@@B@B@@@BA@@BAF@DDBDBF@D@B@BA@CAAAAACBEBAD@D@@@D@BAB@@BBBBD@B@DBB@BB@BB@DABADGD@BABADAB@@AB@B@@@B@BBB@B@B@@@@AB@@@@ABHBHBH@HBB@DBFBFDBFBF@BBDBD@D@@@@B@@BB@@@B@@@BBB@HABAB@B@@@BB@@B@BAB@@@BB@@BAB@DBBBBB@@@DADABADABABCDADE@CB@B@DDBDBD@D@DADCFEBCD@B@DBDBBB@BCBADCDAD@BAB@@@BBF@B@@@FB@FDDBBD@@@D@D@D@BBDFBDBBB@@@@@B@D@H@D@DBDBBBFBFBDBBBDBBBDBD@D@BBF@D@B@B@D@H@@@D@D@DD@BABADAB@BBBBBDABBB@DDBBBBB@B@D@BBBD@DAFAF@BAD@B@@@DBBB@@DDH@FBFBHBFBDDDDD@DADA@A@ABCDCDADAF@FBB@BB@@ABAB@DAB@BBB@BB@@@D@B@B@D@DBBBBB@B@B@DAB@B@BD@BABA@AB@B@BBBBDBB@B@BC@C@EBA@EDA@@BAFC@@@@DADADA@EAC@ABABCD@DAFAF@@@D@D@D@DBD@D@B@DABABABC@ABCDEBABADCDABA@ABA@@@E@A@CBADA@CA@AAA@AAA@A@C@C@A@A@C@@@@AA@@@@@@@A@@AAABABGBA@ADE@AB@D@D@D@BA@AAC@AAABCDAD@BBFFBBB@@@BABABA@A@@CAEAEA@A@AB@D@D@DBB@D@B@BABC@C@A@CC@AAAA@CBABAB@D@FABCFA@AFADCBABADADADAB@BAFCBC@A@CAC@A@@AA@C@CBCDAF@F@DAB@@@DA@EACAEACA@CCAACA@@@C@A@AAAA@A@CAA@@CAABEBC@E@ABCBABEDCBEDC@ABA@A@AA@C@CBAACAACAC@C@C@ABEDABAB@D@D@BA@C@A@CBCBAB@@A@AAAAAAC@A@E@E@G@E@C@CBABADADAF@@C@C@C@AA@B@@ABABA@@@AAA@A@CAA@AACAAAABCB@BC@ABC@C@ABADBBA@@@A@A@AAAABAAA@@CBABCB@BABC@ABCBCB@@CBABC@ABABCAA@CCEACACA@@AAABABABA@CAA@CAA@AACBABADAD@FAF@B@DADA@A@A@EAGAE@CBA@CBCFCFCBCDCBEBEFE@ABCBA@CBABAB@D@D@D@FCBABC@ABA@C@A@C@ABABAF@DBD@DAF@DADABADABCBABCBC@CAC@CACAAAAAA@CCCAE@CCEAEAAACAA@CAA@C@A@A@C@@AA@CAAC@CAE@CACACAAAAA@@CE@CAEBC@CDCBCFCDAFAD@BAB@BB@DBBBD@DDFBBDBD@DABABAB@BAD@DBB@B@@BB@BBB@BADC@@BC@CBA@C@CAAAA@C@ABA@A@AAAAACACAEACAAAA@@A@@@ABADABABADABCBABCB@D@B@B@BBB@@D@@@B@DB@@@B@B@BA@ADAFAD@B@DAB@B@@BBB@BBBDAB@BBB@BDBBBBB@@@B@D@B@@@B@BD@BBD@B@B@BADCF@D@B@D@BBBBB@@DB@BDADABA@A@@C@AAA@AA@ABCBABADBB@DBDBBB@@BBBA@@B@D@BAHCBA@@DA@@@B@D@B@D@D@B@B@D@B@B@@@DABA@CBA@C@CAE@ABA@@@ADAB@BC@A@@A@A@A@CAAAA@@@@ABA@AB@BABADABABABC@ABADADAFBB@D@B@DB@BBB@B@DCBCBADADAB@BBB@ADAD@BBBBBBBDABCBCBCDEBCDCDADCBA@@B@@@BAD@DB@@@B@B@BABCDABABADAD@B@@BBB@DAB@BBB@D@BA@@@AB@@CDEBEDABCBCBC@CDCDCBE@CAE@E@CDCB@BCBABCBEAGAGAEACCCCAA@@@C@E@EBEBC@CAACCEAC@EBE@CBADCDCDA@@DABCDCDEBCD@B@B@B@@B@BAD@BDDD@DADAFABA@@@C@C@@@A@A@@@E@@@@BC@@@ABA@@BADCDBBBD@BA@C@CAA@ABCBABA@AAAC@C@A@CAA@@@@CBABA@@BCB@FBHBJB@@B@BA@A@AAAEAEACA@@@A@ADAB@D@F@B@@AB@@AAA@AAABC@A@C@A@@AI@@AAAAC@CACBA@ABCDABCBABC@CC"]],encodeOffsets:[[[108259,31126]],[[108461,31227]],[[108444,31208]],[[108524,31103]]]}},{type:"Feature",id:"510922",properties:{name:"射洪县",cp:[105.388412,30.871131],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@A@@@@@@@B@"],["@@@@@@B@@A@@@@BA@@@@@@@A@@@@@AB@@A@@@@@@@A@@@@A@@A@@@@@@@@AA@@@@@@@@A@@@@@A@@@A@@@@A@@A@@@@@@@AA@@@@@@A@@@AAA@A@@AA@@@A@@@@@A@@@@@A@@@A@@@@@A@@@@A@@A@@@@@@@AB@@@@@@AB@@@@@@@B@@@@@@@B@B@@@@@B@@@@@@@B@@AD@@@@@B@@@@@@@B@@@@@@@B@@B@@BB@@B@@@@@@@@@B@@@@@@@B@@@@@BA@@B@@@@@B@@A@@@@@@B@@A@@@@@@@A@@@@@@@AA@@@@@A@@A@@@@@@@@@A@@@@@A@@@@@A@@@@@@@A@@A@@A@AA@@@@@@A@@@@@@@A@@B@@@@@@@@AB@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@AB@@A@@@@@A@@@@@@BA@@@@@AB@@@@@@A@@@@@A@@@@@@@A@@A@@@@@@@A@@@@@@@AA@@@@@@@A@@A@@A@@@A@@@@A@@A@@@@B@@@@A@@B@@A@@@@@@@A@@@@@A@@@@@A@A@@@A@@@@@A@@@@@@@@B@@A@@@@B@@ADCH@@@B@@@@@@AB@@@@@@@@AB@@@@@@A@@@@@A@@@@@@@AAA@A@@@@AA@@@@@@@@@A@@@@B@@@@@B@@@@A@@@@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@@@ABCBA@@B@@A@@@@@A@@@@@@@@@AA@@@@@@EGA@@@@AA@@@@BA@@BA@ABA@@B@@@BA@@B@@B@@BB@B@@B@@@B@B@@BB@@@@BB@@BB@@@B@@A@AB@@@@@BA@BB@@@BB@@@@B@@@B@B@@@BB@B@@B@@@@@B@@@BABABBB@@@@@@@@BB@@@@@@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@B@BB@@@@@@B@@@@@@@@B@@@@@@@B@@@B@@@@@@@B@@@@@@@@BB@@@B@@@@@@@B@@@@@@BB@@@B@@A@@@@B@@@@@@@@A@@@@@A@@@@@AB@@@@A@@@@@AA@@@@@@A@A@@@@@@@A@@@@@@@@@A@@B@@@@@@A@@@@@A@@@@@A@@@@B@@@@@@@@@B@@@@@@A@@@@@@@A@A@@@@@@@A@@@@@@@A@@@@BA@@BCBAB@@A@@B@@@@A@@@@@@@AB@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@@@@A@@@@@@@@@A@A@@@@@A@@A@@@@A@@@@AA@A@@@@@A@@@@@@@A@A@C@A@@@@@@@AA@@@@A@@@A@AA@@A@@@@@@@@AA@@@@@@A@@@@@AAA@@@@@@@A@@@@A@@@@@@@A@@@A@@@@@A@@@@@@@A@@A@@A@@@@@AA@@@@@@@AA@@@@A@@@A@@@CCACAAAA@AAC@@AAA@@@@@@BAB@@@C@A@A@@B@AAA@@@C@C@@@@@CDAB@@CAA@@BABA@E@@@ABABA@ABA@CDAB@@@B@B@@@B@@CD@@A@@@A@@@@AAA@EAA@@@AA@A@@@@BA@@B@T@B@B@@A@A@A@E@A@A@A@@@@@@@A@@@AAA@@BA@B@@BB@@BAB@@BD@B@BA@CDAB@@@BBF@B@@BBJF@@BB@B@D@BBDDF@B@B@D@D@BDFBAB@FBD@B@BBABEFABABBBB@BABAHA@@D@B@JADA@@B@@@@@D@DAHED@B@@@BB@D@B@DBD@DBBB@@BBBHF@@DBDBB@BADA@@B@D@@BBDBBBB@@BBBBDBBBBB@BBBABABABABCB@@@B@DBDDD@D@DABCFAD@B@D@DBFBD@DBD@FADABABADAD@B@DADAD@F@DAD@DAFBFAD@@CHAFCDCBCAEAE@CB@DCDADABCDCDCD@BCBAFAD@D@B@BBB@B@@@@@BA@BB@B@B@BB@@B@B@@@@@BA@@@@BA@@@@@@B@@@@@@@BB@@B@@@BA@@B@D@BAD@DA@CB@@A@A@A@AB@B@B@D@@@@AB@@@BA@@@CAAC@CAAA@A@@B@@ABABADC@A@A@@AA@@AC@A@ABABCDADADA@ADADAD@BBBB@DBD@BBFBDD@@@@@B@@B@BBBBBD@DABCDABEBEBEBCBAD@D@DA@AB@B@@@B@@@BB@@@BB@@@B@BAD@@ADABADAB@@ABABADADABCDABADADAB@DAD@@@D@DBBBBBBB@DBD@D@BAD@B@D@BBBB@B@DAB@B@D@@@@@B@@@@@B@@@B@@@@@@@@@B@@@@@@@@BD@B@D@D@D@DABBD@B@@@B@@ADABABCBAB@D@DAB@DAB@DAB@DBD@D@B@BADADCD@@ABAB@D@D@D@BA@ABC@CBAB@B@@@B@@BDBB@@@BAF@B@B@BABB@@BBB@@DBDBD@D@@BD@BBBBBBBBBB@BBD@B@@BBBDBBBF@BBDBD@BBDBB@D@D@BCDABEDA@@D@BBDDBBBBD@BBB@BB@FABAD@D@D@DBBBDDBDBDDDBBDBDBB@BABABAB@D@B@F@B@F@@ADA@AB@@@B@DBFBB@B@B@HA@AD@@@D@DAD@D@D@BBF@FDBBBBDBDBD@B@BCDABAD@B@D@D@DBDBD@BDBB@BBB@BBB@@B@@@B@BAD@B@@BBBBBB@D@BAD@D@DBB@DBDBBB@@BD@BADA@ADCBAB@B@DBBBDBFBDBBBBB@BA@A@ABC@CBC@AD@BADAD@DAB@DAD@B@BBBDAB@BCBABAD@DBDBBBDDBBD@DBBBBBBDB@DBDAD@DBB@BBBB@B@B@DAF@DAF@FAD@BABC@AD@B@B@@@DBB@@ABABC@A@C@C@AAAAC@A@A@C@CBA@A@A@@DC@@BADABABC@@BABAAA@AAC@C@@@C@A@CBCDADA@AB@DC@ABG@A@@@ABABAD@DA@A@A@A@ABCFABABA@A@A@ABCBC@A@CAC@A@CBABAB@B@D@FDDBDBBBD@B@DABABABC@CBCBABAB@BBB@B@D@BADBB@B@B@BABA@@BADCDABAD@@@B@@@B@FDFBFBH@H@B@@A@@@A@ADABCBA@A@@@@@ABA@CBC@EBC@@BABCBCDEDABABBB@DBBBB@D@DAB@DAD@B@@ABAACAG@A@ABAB@FBD@DAB@BAB@B@BBDBB@B@D@DAFBD@D@D@B@DABABC@CB@BADABABA@A@A@ABAD@D@FCD@DAB@B@F@DBB@B@@A@@@AAC@ABCBE@ABEBABEBCBCDABADA@@B@FCFAD@BA@ABABADCBABABA@@@ABAB@BA@BB@D@DB@@BB@@F@DBD@DBD@D@D@DB@C@ABCD@B@D@B@B@D@@@@GBCBA@@BABAB@BA@@BA@ABA@ABA@AB@@ABADE@@DG@ADADCDAH@H@DABABEAE@A@CBGAA@AA@A@@CAA@@CBCBC@CCCCEAGAEAEAG@CC@@AACA@@A@C@ABE@EBCBC@AA@A@C@AAAAACA@CAABAACAAAAA@CBABABC@@C@C@C@@@G@C@A@A@CAE@A@CACACAAACAAACAEAEAAAC@C@C@G@C@A@@@@AACAEAAC@A@C@C@C@@ACCAECA@@E@@@AAE@A@@BA@ABCDCBCDA@AAACACAA@C@ADEFCDCBC@C@CACA@C@ADAF@BCDCBABABCBABC@C@@AAAACAA@AB@@AA@@A@ABA@@@AA@@A@A@ABGBA@AA@@A@@@A@@AA@@@@@@CACAC@AAEAEECEACAA@GAG@GAGABA@@@@BA@@@@@A@AAA@A@A@@@ABA@@BABCBA@AHCBCBA@CAAA@@AAA@C@AACAA@AA@ABC@@@C@C@ABAFBDBBBB@DABA@C@E@CACA@CBE@AB@@A@@@A@AA@@AA@AA@@@A@A@@A@CACAI@C@AAACAC@AAAC@CBA@C@@C@CAAACACA@AA@@AAA@@@@@A@@AC@CBEDA@A@CACCAAAACACAC@E@CAI@CAAA@CBG@A@ABE@C@CK]AA@ABA@@AAB@@@@A@@@@@A@@@@A@@@@@@@AA@@@@@@A@@@@@A@@@@@A@A@C@A@@@@@A@@@@@@@A@@@@@@@@A@@@@@@BC@@@A@@@@@@@A@@A@@@@@@@A@@@@@A@@@@@A@@AA@@@@@@@A@@@@@A@@@@@@@A@@@@@@@AA@@@@@@AACCAA@@@@A@@@@A@@A@@@@@@@A@@BA@A@@@A@@BA@@@@@@@@@A@@B@@@@@@@BAB@@@B@@A@@@@@@B@@A@@@@@@@A@@@@@A@@@@@A@@@@A@@A@@@@@@@@AA@@@@@@@@A@@A@@@@@@A@ABAAA@A@@@A@@A@AAC@@@AA@@@A@@@A@@@ABA@A@A@@@A@@AAA@@@A@@A@@AA@A@@AA@@@A@@A@@@@@A@@@@@@@A@A@@@@AA@@@@@@@A@@@A@A@@@A@@@@@@@AB@@@@A@A@A@@B@@A@@@@@@@A@@@@A@@A@@@A@@@@A@@A@@@@@A@@@A@@@@@@@A@@@@@@@A@@@@@@A@@@@@@@A@@@@@@@A@@@A@A@@@A@@@@@@@A@@@@@@@A@AAA@A@@@@@A@@@@@@@@@A@@"]],encodeOffsets:[[[107774,31406]],[[107896,31382]]]}},{type:"Feature",id:"510904",properties:{name:"安居区",cp:[105.456342,30.355379],childNum:8},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@A@@@B@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@"],["@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A"],["@@ABBB@@@C"],["@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@AA@@@@@"],["@@AA@@@@A@A@@@@A@@AA@@A@@@A@A@A@AAA@A@AAA@@AAAA@AAA@A@AB@@ABCBE@CBA@CDDD@@BBB@@@BBB@@BBB@BB@B@@BB@@@@BB@BBB@@BB@B@B@B@@B@BB@BAB@@@@@@A@@@A@@@AA@BA@AB@B@@@B@@@B@D@@A@@BA@ABA@@BA@@B@"],["@@AAACA@@BC@A@@BBB@@CBAB@BA@C@@@A@A@ABA@C@AA@CCEAC@CDCDCDABA@@@GAA@@@@A@C@C@CBCAAA@ADADAD@BA@A@ACAA@@A@CBE@ABA@A@@@@BA@@@@@AA@@@A@@AA@@A@@BA@@@AB@B@B@@@B@@BBB@@B@@A@@@A@A@AB@@ABA@@@@BAB@@@B@B@DBB@BB@@@@B@B@BAD@BBBAB@@A@AACGKAABABCDABA@A@AE@CBEFCDC@AAAA@A@ABABEBA@CAAAACA@B@@@@@@@@@B@A@@A@@@A@@@@@A@A@@@@@A@@@@@A@@@@@@B@@@@BB@@@B@@@@@@@@@@@BA@A@@@@@AB@@@@@@@@AA@@@@@@AA@@@@@@@@@@AB@@@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@BA@@@@@@@@@@@A@@@@@@@@A@@A@@@@@A@@BA@@BA@@@@AA@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@@@@@@@@@A@@@@@@AA@@@@@@@A@@A@@@@@@@@A@@@@@A@@@@@@@@@@@A@@@@@CACAA@CAABC@C@C@A@AACBAB@BAB@B@DAB@D@@@B@BAB@BA@AAA@AAA@C@@BABABABA@A@A@C@@@A@ABEFABA@C@C@C@E@C@E@@@ABABABE@A@@@CAAAAC@CACBA@GAA@@AAGCGCAA@C@ADAH@D@BA@ABC@A@CAC@@@@BA@@@@@@@@BA@@@@@@@@@@AA@@AA@@A@@A@@@@@A@@@@B@AA@@@@@A@@@@@AB@@@@AB@DAD@DCDCBENKDAB@FALB@@DBFBB@D@@CDA@ADCBCBA@ABACCACC@ABEBC@A@E@AAAAAAAA@C@C@EBE@@@CAE@CAAAAQCE@GBEBG@C@@AAAAAAAAAAACAA@CA@@CBE@EDA@ADEBICIKECEAIBCBAD@FA@B@B@BBB@BB@BB@BBB@B@BBB@B@B@@@B@@@BB@@@B@@B@B@@@@@BBA@@@AB@@AB@BAB@@@BC@A@@@A@@@A@A@@BABB@@B@@@B@@@B@@@@A@ABA@@A@AA@A@A@A@@AA@AAA@@A@@A@@AA@A@@AAA@AA@AA@@A@AA@B@BGL@@B@@BDAB@BB@@@B@@AFAB@@ABA@CAA@A@@B@@ADABCD@@ABAF@B@@A@I@A@@BCBGJA@@BC@A@A@AACAAEAA@A@@@A@E@@CE@AAAAAAA@@A@ABGFCBABGBABA@CFG@EH@@@BA@A@ECCA@@CBADCDCDCB@B@B@B@F@B@BBB@@@B@@@BADAB@B@@@B@BA@@@ABGDAB@@A@@B@F@B@@ABCDA@A@C@ABA@GFABA@A@E@@ACAA@@@C@A@CA@@@@ABAB@BAB@@@F@D@B@DBBA@ABABA@C@A@@@@AA@@G@A@@AC@AA@A@A@A@A@CA@@A@CDA@@@@DABCD@B@BAD@D@B@@ABA@BBDBB@BBBB@B@B@@AB@@A@A@GAA@@@AB@@@@BD@B@B@@DD@B@D@B@@@@ABE@A@AB@@CH@@A@@@A@AA@@AA@CAA@@A@CA@@AA@@GC@@ACA@@@C@@AEAA@A@E@@@A@AAEG@AA@@@A@GBCB@AEAA@@@AB@@AB@@@B@BABAB@@@@@@AB@BA@A@A@@@A@B@@BF@@@@@@B@@@@A@@BB@@@@@B@@@BB@@ADKLCD@BB@@BAB@BAD@@@B@BBB@B@@I@AA@@@@@@@C@@AA@ACAMGCB@@@B@BDJ@@@BA@@@E@A@@BA@CF@BA@@@@AAAAAA@@AABC@A@@@@@@ABC@A@A@@@A@@A@EB@@CAA@EBAAA@AAAA@AAA@AA@CAA@A@CBA@A@AAA@@@EEAA@@A@GB@@A@@A@@@A@@@ABE@@@A@@AA@@A@@@GB@BA@@ACA@AE@@@A@C@CBABCD@@A@A@A@AAA@A@G@@@A@@A@@AC@CA@@@E@@A@@@A@A@@@@@@A@CBA@@@CAC@E@C@A@@@BB@BA@A@C@A@@@ABCDADA@ABA@@@AA@@@AA@@ABCBABA@AGAABCBEDEDADABAFBNCPABA@ABCAIAIAGBABABAB@B@@@D@@@@@@@@@@@@@@@@@@@@@@@@@@AAA@G@GBGDA@CBABCDCBA@A@@@@@CECBA@CBEDC@ABABEB@BGLAB@B@@@B@BA@@BABEB@B@BBBB@H@B@@B@@ABA@CBC@GAE@E@A@CBABABC@A@@@CAAAA@AA@@@@@@EDABA@AB@D@D@@BJAB@BC@CBABADEFCJAD@D@B@J@FABC@@@C@C@CD@@BB@B@@@@A@@B@BA@@BAB@@@BDD@@@B@@CB@BAB@@@BA@@BCA@@@AA@@@A@@@B@@B@@A@@A@@AAABA@@B@@D@@@@B@@BB@@BABB@@A@A@@@ABAD@@A@@@EC@@A@@@CDA@C@A@CBE@A@@B@BB@FFBBBB@DBB@B@@@@B@@@FAD@DB@@@BBD@@DBAN@BADE@A@@BBBDBD@D@D@FBDBF@DBD@DADBB@BB@BBB@@BB@@BD@BDD@BDBBBB@D@BBF@D@D@DB@B@@@B@@@BA@@@@BB@@@@BDB@BB@@BDD@@@@@BB@@B@@B@@BB@BB@@BB@@@BB@@@@@B@@BD@B@BBB@@@B@BB@@B@@@BB@@@@@@@BB@B@DDBBDBBBB@D@BAB@DA@A@CAC@A@A@ABA@@B@@@BDBBD@B@D@B@F@D@BBB@@BBB@@@B@DB@BBB@BAB@@AB@DABAD@D@@@@@@B@@@BBB@@@BA@@B@B@@@B@B@B@BB@@B@@@B@@B@BBBB@BD@BABABAB@BA@@BA@@@A@@B@BAB@D@B@BBBBBBBBFBB@B@DBB@F@DADADADCDADCBC@C@C@C@CBA@A@ABABA@ABADAB@D@B@BC@CBAB@BABABA@@BAB@B@D@D@BB@@BBB@D@B@D@BB@BBB@B@B@B@B@@DBB@B@B@@@B@B@@B@B@B@B@D@DAB@FAB@D@B@@BBDBD@D@D@FABADABADAFAB@BCDCBCDCBABADAD@BBDBB@BB@BB@@@BA@@BA@@@A@@B@BB@B@D@D@B@B@BBDBDBB@@@BDDDBBB@@@BBBA@@BABC@C@A@C@AB@BB@@BBDDBBDDBBBB@B@DBF@D@D@D@D@BAD@BBB@DBD@BBF@BBDBDDDF@@@BB@BFBD@BBFBDBDBD@BBBA@@BABC@C@CB@@@BBBB@BBD@BB@FBBB@DBDBDBBBBB@@BB@@AD@BAB@D@D@DBDBBBBDBB@DADABABABCDAD@DAD@D@DAB@BBBBBBBD@D@DADABCD@@HJDDBB@B@@@@B@@B@@@@@@@B@@@BBF@DDJ@@@B@@@B@@@BDF@@@@@B@@@@@@BB@@@@@@@@B@@@@@@BB@@@@@@@BA@@@@@@B@@@@@@@@A@@@@@AB@@E@CBED[BE@A@ABC@A@@@A@@@@@@@@BA@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@BB@@@@@B@@B@@@@B@@@B@@@@@@@B@@@@@B@@@@@@@@AB@@@@@@@@A@@B@@A@@BC@CFIBEBGBCBC@A@@@@@@BA@@@@@@@@BA@@@@@@B@@@@@@@B@@@@@@@BB@@B@@@BB@@@@@@B@@@@B@@@@B@@@@B@@@@@@@B@@BB@D@B@@@@@B@@@@B@@B@@@@@@B@@BB@BB@@B@@BB@@@@@@@B@@@@@BB@@HBF@DBFBB@@@@@B@@@@@B@@@@@B@@@B@FAH@@A@@B@@@@@B@@@@@@@BA@@@@B@BA@AB@@@@A@@B@@@@A@@@@BEB@@A@@@@@A@@@@@@@A@@@@@@@A@@AEAE@A@AAA@@@@@A@@@@@@@A@@@@@@BABE@ADIBA@C@@BA@@@@@A@@@@@@@@@A@@@@A@@@@@@A@@A@@@@@@@A@@@@@A@@@A@E@K@EBA@@@A@@@@@A@@@@A@@@@A@@@@@@A@@A@@@@@@A@@@@@@@@@A@@B@@@@A@@@@B@BCDA@@@@@AB@@@@@@@B@@@@@B@@@B@H@P@F@B@B@@@@@BA@@@@B@@@@@@@B@@@@A@@@@@@B@@A@@@@@A@CBA@A@C@@@A@@@@@A@@@@@@@A@@@@@@@A@@A@ACAACECE@@AA@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@BE@A@@@@@A@@B@@@@A@@@@@@B@@@@AB@@@B@BAB@@@B@@@@@BAB@@@HAPAFAB@B@@@B@@@@@B@@A@@B@@@@@BAFABA@@@@B@@@@@@AB@@@BABA@@@@@@BA@@@@@AB@@@@@@A@@@@@@@A@@@@@ABE@K@A@@B@@A@@@@@A@@@@@@@AGIEE@EFCHADGFG@GHCFEHGFADAJE@A@@@@BA@@@@@AB@BA@ABA@@@@@@B@@A@@B@@ADADABA@@@@B@@@@@B@@A@@@@B@@@B@H@PBB@@@B@@@@@BAB@@@@@B@B@D@B@B@B@DAB@@@@@B@@@@@@@B@@@@B@@B@@@@@@BDDFF@B@@BB@@@@@@@@B@@B@@B@@@@@@@B@@@@@B@@B@@@@B@@@B@F@H@@@B@@@B@@@B@BA@@B@@@B@@@@@@@B@@@@@BA@@B@@AB@@@@@BAB@DCFC@A@@B@@@@@@AB@@@@@@@@@B@@B@@@@@@@@@@@B@@@@@@@B@@@@@B@@AFAB@@@@@@@B@@@@@@@@B@@B@@@@B@@@@@@@B@@A@@@@B@@@@AB@BA@A@@B@@A@@@@@@B@@@@@B@@@BA@@J@D@@AB@@@B@@@@@@@B@@@@A@@@@@@@AB@@CBCFKDG@@@A@@B@@@@A@@@AB@@@@@@A@@B@@@@@@@B@@A@@B@@@B@@@@@B@@@@@B@@@@@B@B@B@@@B@B@@B@@B@@@@@@@@@BB@@@@@@@BBB@B@BB@@@@@@B@@@@B@@B@@B@@B@@B@@@@@@@@@B@@@@@@@@@B@@A@@BABADCDABA@@@@@@@@BA@@@@B@@@@@@@B@@@@@BB@@@@@@@@B@@B@@@@FJ@@@B@@B@@@@B@@@@@@B@@@@B@@B@@@@@B@@@@@@@B@@@@@@AB@@@DADADADC@@B@@A@@@@@@B@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@A@@A@@@@@@@@A@@A@@A@@@@@A@E@@@@@A@@@@@AA@@@@@@A@@@@@@@AA@@@@@@@@@A@@@@@@@A@@@@@@@AB@@@@@@@BABA@@DCROBABABA@@@@B@@A@@@@@@@A@@@@@@@A@@@@@@@A@@A@@@@@@@@AA@@@@@A@@@AAIA@@@@AA@@@@@@@@@@@A@@@@@@@@@A@@@AB@@@@@@A@@@@BA@@PS"]],encodeOffsets:[[[107624,31115]],[[107624,31115]],[[107602,31142]],[[107618,31124]],[[107723,31038]],[[107616,31125]],[[108123,30913]],[[108270,31048]]]}},{type:"Feature",id:"510923",properties:{name:"大英县",cp:[105.236923,30.594409],childNum:4},geometry:{type:"MultiPolygon",coordinates:[["@@BB@A@@A@"],["@@@@@@@@@@"],["@@B@BB@@BD@BBBBBBDDD@@B@@@B@@@@@BB@@@@@@B@@B@@@@@BB@@@@B@@@@@@@B@@@@@B@@@B@@@@@@@B@@B@@@@@@@B@BB@@@@B@@@@@@@BB@@@@@@@@@BB@@B@B@@@B@@B@@B@@@@@@@B@D@B@B@@@@@@@B@@@@@BBB@@@@@B@@B@@@@B@@@@@B@B@@@@@@@@@B@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@BA@@@@@@B@@@@@@AB@@@BADA@AB@@A@@B@@@@@@@B@@@@@@@B@B@@@@@@@B@@@@@@@@A@@@@@@@@@A@@B@@@@@B@@@@@B@@@@@@@@AB@@@@@@@@@B@@@@@@@B@B@@@@@@@BB@@@@B@@@@@BA@@@@B@@@@@B@@@@@@@@@@A@@B@@@@A@@AA@@@@@@@A@@@@@@@A@@AA@@@@@@@@@A@@@@@@@A@@@A@@@@@@@A@@@@@@A@@@@@@@AAA@@@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@@@@@@@AA@@@@@@@@AABABA@A@@@A@@@@@AA@A@@A@@@A@A@@@A@@A@@A@@AAK@@A@@B"],["@@AA@AEACBABCBC@A@EDCB@BAB@BBDDD@@DF@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@AB@DA@AD@B@B@BBDDBBB@B@BAD@BBBD@@@B@BABABAD@D@D@BBF@@ADABEDELAFCDABADAF@BBBBBHHBFBD@DADCDAAAA@@A@A@A@@BAB@D@@AB@BABCBAB@@@B@@@BA@ABC@AB@@@@@B@D@@@BABA@@B@BBB@@@B@@ABADA@CDAB@B@@B@@B@@D@BAB@@@@BB@@B@@BBB@BD@@BD@D@@AB@@AB@@@@@B@@B@@@B@@BB@@@@@@@@B@@@B@@@@@B@@@@B@@B@@@@@B@@@@@B@@@@@@@BB@@@@@@@@B@@B@@@@B@@@@@B@BB@@@BADGB@B@B@DBBB@B@B@BBB@@@@@@@@BB@@@@@@@B@@@BAD@@@BAB@BB@@B@@@@BB@@B@BBB@B@@B@@@BADA@@@@@@B@@B@@@@@B@@@B@@@@@B@@B@@@@@B@@@B@@@B@@@B@@B@@@@@B@D@BB@@B@@BBBB@@B@@@@@BB@@@B@@@BB@@@B@@@B@@@B@@A@@BCDA@CB@B@@@@@@@B@@DDDF@BB@@@@B@@A@@BC@@B@@@@A@BB@@@@@@B@B@B@@@DA@@BBD@BBB@@B@@B@@B@B@@@B@@B@@@@@B@BABA@@@@@@B@@@@@@B@B@@@D@B@@@B@@B@@B@@B@@@B@@@BA@@@@B@@@@@BB@@BB@@@B@@@B@@@BAB@@@B@@@B@@B@@BB@@BB@@@BB@@@@@BB@AB@@@BCD@BA@@B@@@B@@@@@@B@@B@@B@BAB@@@BAB@@@BA@@BA@@@@BAB@BB@@B@B@@B@@@@@B@BA@@B@@AB@@@@@B@@@@@BD@DBDDB@@@@BB@@@BA@@@@B@@@@@@B@B@D@F@@@@@BB@@@B@@AB@@@BAB@@B@@@@@B@@@@@BB@@@@@B@@B@@@B@@B@@B@@@@B@@@B@B@B@B@B@@@@DADADADCDABEBCBCDBDDDBDBDADA@AB@DDBDBD@DBB@NBDBFDBD@F@D@DBDDB@BAB@D@B@BBB@@B@D@@@B@@B@B@B@BFBB@@B@@@BA@C@@B@@@@@BBB@BA@@BA@CBAB@HBDBD@D@F@BBB@@BBBBDAFADAD@B@@@B@BBBBBBBBDBD@JFDDDBD@@@BBBABADABAD@BACE@A@C@C@A@ACEAC@A@C@AAA@@IEAA@@@AAE@A@@BADCB@@A@AAC@@BA@AA@@AA@B@@AB@BB@@B@@@@@@@B@B@B@F@B@B@B@@@@A@A@S@AB@@A@@B@B@@B@@BB@FBB@B@@B@@@B@@@DC@@@A@@@A@A@@BADCB@BAB@BABA@@F@B@BA@AB@DB@@BADC@@@@D@D@@@B@BBA@@@@B@B@D@@BA@A@@@@@A@@@@@@B@@@@@LB@@A@@@@BAB@@@@A@@AA@@AA@@@@AA@@@A@A@@@AA@A@@AA@@@@AB@@A@@@AB@BAB@@AB@@A@@B@@B@@B@FH@@@@@@BB@@@@@@@@B@@@@@B@@@@AB@DABA@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@B@@@@@@A@@@@@A@@B@@@@@@@@@B@@B@@B@B@BB@@@@@@B@@@@@B@@@@@@@BA@@@@@@@@BA@@@@@@@A@@DGBC@@@A@@B@@@@A@@@@@@B@@@@@B@@@B@B@@@@@B@@@@@B@@@@@@@B@@@@AB@@@@@@A@@B@@@@B@@B@@@B@@@@BB@@@@@@@B@@B@@@@@@@B@@@@@@@BB@@@@@@@B@@@@@B@@@@@@@BA@@@@B@@A@@@@B@@@@@B@@@BA@@@@@@@@@@@A@@@@@A@@@@@A@@@@@@BA@@@@@@@@@AB@@@@@@@B@@@@@@@BBB@@@@BB@@@@@@@B@@@@@B@@@@@B@@@@@@@@@B@@@@B@@@@BB@@@@@@B@@@@@@@B@@@@A@@@@B@@@@A@@@@@AB@@A@@@@@A@@@@@@@A@@@@@@@@@AA@@AA@@@@A@@@@@@@A@@@@@@@A@@@@BC@@@A@@@@@@@A@@@@@A@A@@@@@@@A@@@@@@BA@@@@@@BA@@@@@@B@@@@B@@B@@@@@B@@@B@@@@@B@@@@@B@@@B@@BB@B@BB@@B@@@@@@@BB@@@@@@B@@@@B@@B@@@B@@@@@B@@@@@@@@@BB@@@@@@@@@BB@@@@@@B@@@@@@@BA@@B@@@@@B@@@@@@AB@@@@@BA@@@@@@@@B@@@@@@@@@B@@@@@BBB@B@B@@@@@@@B@@@@@@@B@@@B@B@@@B@@@@@@@B@@@@@@@B@@@@B@@@@@@@B@@@@@@@B@@@B@@@@@B@@@@B@@B@@@B@@@@B@@B@@@@@@@B@@@@AB@B@B@@@@@BA@@@@@@B@@@B@B@@@B@@@@@@@B@@B@@B@B@@@@@@@B@@@@@B@@@@B@@BB@@@BBB@@@BB@@@B@BB@@@B@@@B@BAB@B@@@B@@@B@@BB@@D@BBB@@@@B@@@BBBAB@B@B@@@@B@@@@B@@@@@@B@@B@@@@@@B@@@@B@@B@@@@@B@@@@@B@@@@@@@B@@@@A@@@@B@@@@A@@BA@A@@@@@@@AB@@@@@@@@@B@@AB@@@B@B@@AB@@@@@@@B@@@@B@@B@@@@@BBDDBB@@@@@@BB@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@BB@@@@@B@@@@@B@@@@@@@B@@@@B@@@@@@@B@@AD@@@@@@@B@@@@@@B@@@@@@@B@@@@@B@D@B@B@@@@@B@@@@@B@@@@@@@BB@@@@@@B@@@@@@B@@@@@B@@A@BB@@@@N@JAFABAB@HIBC@C@CAAAAAAA@CAECMCICECEAACBAFCDAHCH@DBFBHDFD@BBHBDDBDBHBJ@H@DAF@DADCFCBIBA@G@ABG@ABEBCBA@@@A@AAC@AAA@AA@@AA@@@AAA@CACAEAC@C@CAC@A@CAC@C@C@C@C@A@CAAAAAAACC@AACBA@C@C@CAAAAAACAA@G@@@@BA@@BABA@AB@@@@AB@BAB@B@@@B@B@@AB@@AB@@ABAAAAAAAA@AAA@A@A@CAE@A@CAC@A@@AAAAAAC@@AAAC@CAAACCAAA@CA@AAABCBABADC@AACA@CAA@EACAACAC@AAA@A@C@@ACCACAA@AAACAA@C@ABCBABADCBADAB@B@BA@@DCBABC@C@CACAAAAAAA@CBC@C@CBC@CBADABABCBCBA@CAAAAAAC@C@C@CBA@ABC@@AA@@AAAACACACAA@AA@EAAC@AAA@AA@A@@DAD@D@BA@AB@AA@AACACACAE@AACAEA@@A@@CECCCAAAE@AAC@CAA@AAC@ABC@C@C@C@E@CAA@A@AACAACCAAC@AA@@ABAD@B@D@D@BA@AB@AA@A@@AACACC@A@@AAACAC@A@A@A@C@C@AAAA@@@@B@@AB@@AB@@A@@AAAA@CAAAC@CBABABCDADCDADA@EBCBABCBABEBC@C@C@CAAA@@@A@CBA@EBA@C@C@A@A@A@AA@A@@@A@A@A@CA@@@A@A@A@AAA@AAAC@A@C@A@AA@@AAC@C@A@A@AB@@ABABABA@AB@DADA@C@A@CBAB@BABAB@B@BAB@D@D@D@DADCDCBCDCBCBCBE@A@CAA@A@EAAAAAAAAAA@C@A@ABA@@@@B@@AB@@ABA@ABABABC@@AAAAAA@@@@A@@@AA@@A@A@A@A@@@A@AB@@A@@AA@A@@@A@@@@C@C@ABCBA@@BA@ABA@AAA@@C@A@@AA@AA@AAC@E@A@C@A@C@AAAC@@A@@@AB@B@B@BBD@D@BCBA@ABC@A@AACAAACCA@A@@A@@@@@@AA@@A@@@AAA@@@A@AAA@C@@AA@@@@@A@@A@@AA@@AAA@@AA@@@@AA@@A@@@@CC@AA@@ACA@A@@A@@A@@B@@A@@@A@@@ACAC@C@E@AAC@A@AACA@ACC@AAC@@AA@@AA@AAAA@CACBC@CAE@CAEAC@C@C@CA"]],encodeOffsets:[[[107596,31173]],[[107596,31173]],[[107773,31407]],[[107609,31160]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("雅安",{type:"FeatureCollection",features:[{type:"Feature",id:"511802",properties:{name:"雨城区",cp:[103.033026,30.005461],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@A@C@C@A@A@IDEDGDEBEBC@A@@@@D@DBDBBBBBBD@BAB@B@D@D@BAH@B@@BFAD@D@BBJCFCBAB@@AAACACAAC@AACAAAA"],["@@CBC@E@E@C@CBCB@D@BBBBBBD@DABADADA@ABA@A@ABA@@@@@AB@B@DABADCBABA@EAE@@@@@@@A@@@@@@@A@AA@@AAAC@A@CACCCAE@@@@AAAAAAEAI@K@IBEBGFMHEFA@@D@@C@E@eQK@BBADADAHAH@FADCFAF@@CFCJ@H@JBLBRJRHLFLFJDHBJAJALCF@BAFCFCLCDEHGJGHMJEBCDK@I@A@O@IBG@SBKDGFABEF@FDHLFHDJDLFLH@@DDDHBHBD@D@NAHCF@BCBEFCDBD@D@DCDEDCDAD@DAJBJB@BBBBD@FBF@DBFDJFFFFDDFDHBD@DBHBNDFBF@FAPBF@B@BDD@F@JALCBCD@DDBFBHFBD@DAL@BBDBBBB@@FFDDFDH@H@BDDBF@J@DDDHHVFHFNFJHJFFDFBFBDFFFFDJBJDL@@@D@H@BCDAJ@HBJDHDDHJDFBFBHDJDHFFFLFLFNHLBBHJDDFFDDLJRLHFHFJFFDFBHDHDNFHEBCBG@ECE@C@CPCTCXAFAPAB@HAHCB@FEBABAAAAECKGIKGGIEEGCGGAAACEEKMCE@@CG@AAAACCSAK@CBE@QAEAAAAAAGAGAKAIAE@ACAAACBCDCFCBCBC@CACACCCKGKEGCAC@CAKBEDCNOBCDAB@@AHAFBFDDBB@FADCBAHE@@@A@EBCDAF@FDDBF@F@DDB@B@D@@A@A@A@A@CCCAAA@A@CDA@E@CAAA@A@ABGB@BAB@DAF@@@B@BDDFDDBBB@B@D@JAD@HBHBDBDAB@HCFABA@A@A@A@AHCFEBC@AA@@@AAAAA@CBCBA@A@@ABCBAB@F@BAB@@A@ACCIAA@ACBC@@FAJCBA@@@@HADGBEBA@@BCBADABCFCDEDADADA@@DADAFAFAD@BAB@BA@@PBF@H@DADCBADCHGJGC@E@G@I@EBA@A@@AAA@@BCBEDCBA@C@A@ADC@ABCAC@AACAAA@A@A@AD@BCBC@C@G@G@CCECCEECOMCAEAEAMAOEIAGAKAKCMEGCEIEEAAAAGGCI@IDEHIHGLELGLELIBABCBGHMFMDIBEBIJSDIDGBABAFKDCHIDGBABEBGBABEBG@E@CAEEAGAEAGCEAECEAAEBE@ABAB@HADAHAJCDABA@AAC@CBC@CCAEACCGAEAIABABCBABA@CBAFEDC@A@A@C@A@@EAEEMK@C@@@A@A@CBABA@C@CAAAA@A@A@A@@@A@ADADC@AB@@CBC@AFG@AACAACAI@KBGBEDKJCDEFCFEBC@C@CBADCDADABABC@ACAE@AAAAAAACC@@@@C@A@C@A@C@CAA@C@A@A@ABABABA@CAGCKE@@A@AACCAAC@C@A@@@CDAD@FDDAF@@@BBD@DA@@B@@A@@@AB@@@D@B@@A@ADCB"]],encodeOffsets:[[[105706,30701]],[[105518,30413]]]}},{type:"Feature",id:"511803",properties:{name:"名山区",cp:[103.109184,30.069954],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@D@FAFAHCFCJCB@B@D@D@B@@@@ABC@C@CACCCE@C@CBA@AAA@ECAAECIHGHCDABCDCBG@E@OA@@ABA@ABC@EBEBCBCB@@CBCBCBCFEDADCBABAD@@ABAFCHGB@@@@ABIDEB@@ADBDB@JBDD@B@BA@ABE@A@ABAD@BB@B@DADAB@BBBB@@B@@BADEFGD@B@B@B@BABEBGDA@CBCAGAGAC@IBC@A@A@AACCCEACA@@@E@CBA@ABA@AH@B@BBBDBF@B@DCB@B@BBDD@D@B@B@B@BC@A@A@CCE@E@CAECE@CBAD@F@B@@GFABCDEBA@CAECEAGB@BA@CBADMPCDAFBL@DBDHDLFLHDDBDBD@DADADEDCDADBDBBBDF@JBLBHBHBBBBBBBBF@RAF@DBLDTBDBB@BDH@@DFLNFFBDBBHHHDFFHJLHHJDLBFBB@@HDLAL@DBJDFFHLBFLRHHHJFLBDDD@@D@B@BAFENMBABAFDDDD@B@BABADEFEBA@EBEBC@@BA@C@A@EAEBCBCFCFAB@HDB@DBBDDDBFAF@BBDDDBLDH@@DHDJFFFDJD@BfRDBBBJDBBDAB@DAD@HBH@N@NCJADCHMDM@EAM@A@ABAF@LDLDDBB@D@BADAFBBBDADAFCFAB@@@H@BADCJAB@@E@EDG@E@CAE@ACAACAA@@BEBCAAACEEEACA@A@A@A@CDCDE@A@AACAACCAAAA@A@EDCDCB@H@DADE@@@@@@@@@A@@@A@@@@@@@ACC@CAABADGBCA@AEACACICEC@@@C@A@ADAF@DABADEBC@ADCBA@AAA@A@@@ABADADEBCBCFAHCBAB@@GBEDADCB@AAEACAE@C@CBCDABA@A@AAAAEI@C@G@AAA@@ABABCHA@ABA@GCCAA@AB@@@B@BEP@DA@E@@@EOAEAACCAA@A@G@AACAAEAC@G@IBC@EAKCAACBIFEF@BDHBB@BABABCBA@A@GECCEIAIACAACAA@KAA@AAKGECOMGIEE@AA@AE@@CCACCICIAI@AGAAA@C@ACA@A@AB@FC@AB@AAAEAAAE@@AC@@AAC@CAI@AAC@@A@@@A@A@@AAC@C@EB@AA@G@ABC@C@A@A@ABC@AAAAAAAC@C@C@@"],encodeOffsets:[[105678,30709]]}},{type:"Feature",id:"511822",properties:{name:"荥经县",cp:[102.846737,29.792931],childNum:1},geometry:{type:"Polygon",coordinates:["@@KQEEEGEEACAEAGACQMMMIM@@CAKIADCHCFCFCDG@OAIAE@EAECCACAEBC@GDED@@QDIBGDIBIFIHGFILKL@@EFADEJCJCJANELCLCJEJEJGNCJEHGJILEHMPIL@BGJIJINELALCHCJCDGFCBK@IAMCICIAICKAICKAMAKBI@KDIFGBK@MBG@IDIFGDGHIHCBGJKPCFCDKNIHIJCFGJEHGFGHEBEBG@E@GAEEKCKCKAIAK@ODSDU@QBWDa@MFQHEDAFAFAFGJAF@D@FDBHBFBJLLJJPBJAJCLCHINKFKDKJADAJ@L@JAF@JPHF@L@JCFCHGLAF@JBHFDBLJHNJHJHBHBFBBDBFBHAFBDBNAdCD@HDZNPFLBLBD@BBDBHHJBHDH@HDDDBDDB@DAD@F@D@D@DBDD@F@LFF@D@HBLBNDJFLJLJLLLHFJDJJFHDHBHAHCJGJGHEH@HAHBNBLDLFJFJBJ@HCDEHGJIFGHILGHIFCJBNBDHDHBDH@JEFGHQJOLGRIRGNGLGHIFELEHCJBLDLFFHBBFHJJHDHDHDDBDBLFHDDBD@LDLFLFFDJFDBB@D@HBHBBBHHFDFBFBD@B@DCFEDA@ADEBG@M@CACAGCGCC@@KGKEICGCKECG@EFEBAHELCTAH@JAP@B@J@L@DCFANIHGHIFGDCDKDEBE@ADEBKBIAICGEIEKGKIQAQAK@I@GDIDE@@BEDEBC@EBGBGBCBCAA@AC@CABEDCBCBCBE@C@CCCAE@EBEBCFADC@C@CECEEEEAE@OBMAMBIAC@EBKDI@MCMEIEOEQ@M@MBOCIAECEEEECGEIEKCOEICICGEKGGG"],encodeOffsets:[[105335,30235]]}},{type:"Feature",id:"511823",properties:{name:"汉源县",cp:[102.645467,29.347192],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@GAMCG@GCICGAKEEEGGCEAG@KFKFEHC@AACIQA@MBI@Q@I@IACAGEIGBADCFAFABECIBEAECACACE@GAECEGCQAE@CCGECAGDC@MeM@CAE@CB@B@FADEDCDE@C@CDAF@J@FDHBFLJDFBFAFCDEFCFEH@DDHFPDHDFFHBFDR@F@HDFHJFB@@@DBBBDBFDBBDBJ@J@FADURCDABJBFBFBHBDBFDDDHDBFDFLDF@F@D@H@H@HBDDFDDBBDFDFBDBFBHDFBD@J@D@DB@CBAD@B@LCHAHAFCDA@CBADABCBG@G@EBE@AAEBCDC@AACDA@E@CBCBAFEAGFETKCBCBABABA@CB"],["@@@@CBI@CAEAMGGAIBGHEBEDE@GBEDCBCDAFDLFJ@DADE@CBCLINIJEDDAB@BABADADAPPFLDDBFGBGBI@GCG@GCGAABAD@D@FCBBD@BCDADBF@BAF@F@HAHADCBAB@DCBEDGBGBKDA@C@AB@DCAC@I@C@EAGCEACAEAECACCAECCCGAG@G@C@E@E@KCCEAEGCCCECCAGAEAEAIAABADADAF@F@B@B@BAB@BABADADA@CB@@A@@@A@@@DDBF@DT^\\H`FVJRTD\\JLDDB@BJBD@DAHAJAJ@LAF@HALGLGLELCBINCDGHKNADKLIJMNMJIFEJCDCDABABGDIBIBMBKBMAS@UAa@O@SDQDUBWJSFUHOJIDMJKLILEPEXGH@JCNHR@LFRDT@JCVCFIJeTEDGFIJCJAHBL@LDJDNFPDNDLLzDNFNFJHJHFJHLHJHVLFCRGNEb@XCRAV@TCPCL@JBLBLDLDFFHBF@H@FAFAHGHEFGHIDEJIJGLMDCDELOHIDAJGHGHCJEJCH@NAL@HAJELCJ@LANBLBJDLBJDJBJDNDJBL@DAHEDCDIDGBKFKJMJIHI@AJKNOFGJKHIFGDIHMFIFIDIDKFKBMDIDIFIBCFE@@LKJKHEJGJEJAHCJARC@@FCHCD@FADBDBFDFBF@JBPBH@DCDEDEDGBCLJDBEGAG@EHGHEJGHCHGLIDCFGLKLKNINKLAREVETELCNCDAF@H@RBR@NAJ@HAD@DADA@@DEDEDCFEFADAFCFCBCBGBGBE@ECCACECCCEKACCCCECCAEBGDOBG@AECAA@CDELIFGDEBCBCFIJKDCEA@BCBC@A@@@A@@BA@A@CBA@A@A@@@A@@@A@@BA@@@A@A@ABEB@BA@AB@@GDABA@@@CDC@CBE@A@@BE@A@A@A@QBA@CAC@C@ICCA@@@@EEEK@ACOKI@@QYIkBU@E@GCEECEAECCEGG@@KCKCSAK@I@Y@ABK@CBABAFC\\@D@B@FBH@D@DAD@BCBCBEBE@QJABA@K@EAOKGCK@IE@CBEBE@EAECAACECCAGKGSCI@I@CDILIHIDGEM@AGKCCEAEBIDG@OCCCICGAA@IDMFGFgL"]],encodeOffsets:[[[105097,29914]],[[105166,29867]]]}},{type:"Feature",id:"511824",properties:{name:"石棉县",cp:[102.359462,29.227874],childNum:1},geometry:{type:"Polygon",coordinates:["@@EKGIEGCEAEACAGCEAACEGCICEIEGAECGAGCEGCEDC@IDKDMBMDS@QDaAMAMBKBKJIHIJIJKRKHKHIJIDODOAW@GEO@CBGDGJELIXCJCPKTEPINEHKLMLINKPO^GLIH@DPT@DCBGDEDGDGDGBE@KDCFAH@FBJDJJRHL@FUTAHAHGL@HDHHRFT@JCJIPKHKLKFIHCTBB@FAFMLG@GDIBO@IEGEIKMCM@I@M@GBEF@FDDLJJHDBLJJHLJNJJDLFJDHDHFDDFTBF@HAFGDQVGLGJEHEFONKHMFKHKFWHKBE@ECIEIAMBEBCDADM@I@_@MDUNEDIDIJINCFEX@FAJDNDRFPJJNJHJBRDZP`BF@HKHMBQDKDGFGR@NDRAHAFAHENQlHBLBH@H@JELKJEJCF@LDF@H@RA\\@NF^NF@HATGFAFBPHH@BBNID@ZBZFFBBBBBBFDBDBHBLDR@D@B@BABCBABAF@D@BAB@B@@@@DAL@J@HAN@DBFB@HBFBD@PAD@B@BCBE@GBCAAAEAC@A@@BABABAD@B@H@BBBAB@@@BE@G@A@ACI@IDM@EECEIAEDIDGNBFBH@H@ACAEHGHGHBLL`X^P¸`X@KGQDM@IHGFWFOJKLKNIJCPIVGTEXIVARCTCP@b@VBT@NBLANAJAJAHCBABADCDCFIJENINMJILKBCLMHGDCJMDAFKHKHKBK@GBE@KBIBIBG@CACAIA@CCIKC[QSUI_E[GS]@CAECC@@B@@@B@@@DAB@BCBCBA@ABA@A@A@A@EBEBCBCBABADCVQBC@E@IAIACCAAEACAA@C@@EAGICE@G@ECQAEEGCECGEOCG@CFGDEFEDCBEAECEKIAECG@E@IBEDCD@F@DCFCBC@E@ADAF@DBN@HMLQCGCEA@CEAGBKDIJQ@GBGEGCCIBE@ECEGSmQUMAKGIGCKCIAGBIDKFODIHKJMBKCMO["],encodeOffsets:[[104934,29601]]}},{type:"Feature",id:"511826",properties:{name:"芦山县",cp:[102.932385,30.142307],childNum:1},geometry:{type:"Polygon",coordinates:["@@EE@@AAAAAC@ABK@CACGEEACA@CABIFKDE@A@@@ABABCHIJ@NF\\NZBXNPLPBL@LCFGLCFEFGFIDKFMFMFGHEFBNDR@R@LAN@JAH@HCHAFE@C@EAFJ@DDFBHDFFHHDFDHDHDFDJDNF@D@B@BEJOBMBGDCBCFAH@D@D@LBHBJFPDLFJDLDHHHJHJFLFNFLBJFHHFFHBDDDFHJBFAF@FCFEJEHELEHGHGDEFEFGJELCHBFBHHLFJHLHFLNNHHBJBP@LBFDHD@DADADEHCHEHAFAH@HFHBLBJFJDJBHDNBJDLDNDLFJBJBFBHHJDHHJJJHJFFJJJLLHJJHFJJLJFJFHBF@H@FCDCBGJMDKJGFKFMDOFOFOHGJGHIHGFIBKBK@MBKBIBAD@F@HBH@J@HDHBJBLBHBJ@HGFILGJEJAHCL@JAJCNH@NDNBL@N@NBR@RBRB\\HPHDGAIBIFKPYDEJGHCHCTSDEDE@ACU@C@EBAF@JBzZJ@DAFE@GCIBEFEHCHEFCHUFGJELCJ@J@DAFEBGBEFEFEDEBIAI@KDK@KCIEECGAE@UAC@EDGDGHCFCHBJDD@FA@C@GBGFGLIBAFEDKCSGQCIAEBELCLAPBF@AC@A@ADABEFI@CEEEGCAACAICCCECCAE@AA@@@@AA@AAAC@CEG@A@CC@C@AFADA@A@EAC@@@ECIEEIKICGIEACBCJGRGBC@A@AA@@CAAA@C@A@IBGFCBC@CACK@AHM@AACAC@ECCCCAAFEAQDCBEBCACAGAGCIBCDAHAFCDC@C@GSWECGIIIIK@@MKGIECEAEAABABA@A@EKCECCGACACCCG@GDCFEDECKCKCKCMBEBEDCJAD@@IBI@G@GAEBCMEGCGCEAECIEGEGEQKKICCEECCGIAAGKEMEKEKEECGCIAGAECEGICCCGAI@GBIDC@A@G@C@@CKAICIEEEEACAECEEEGIEIEMEGGUCGCCI@E@CAACG@G@ECCC"],encodeOffsets:[[105393,30763]]}},{type:"Feature",id:"511825",properties:{name:"天全县",cp:[102.758317,30.066712],childNum:1},geometry:{type:"Polygon",coordinates:["@@AC@C@C@C@EBC@CCAACCCGCG@GCIAGGCAAAC@KAKAOEYMGCC@cDMBCAEAGBEACAAAAEAGIGIGGMKICAGEIAE@KBGHEDIDK@E@OGWAEBc\\@L@VSJOTEP@HHLJJFJ@JAJFVRTHDBDBF@DCFCFBD@DFFDDDHGX@PANENELQTILGP@RBRBJERANCRE\\PVFFHHHBJ@FDBFCLCF@LBF@JBJDJFHDJT`FHDFFHHJFPHNJLRNLDDDHDJFJFHHJFPHLBNJJFHDFLHjBPDN@NDT@L@DBJDNLAL@NBJCD@JLFDJDNDDAFAHBXHZHPDPDTHNHRHNHL@PBVCJCHCLIJELIJKJMHGLINANAJ@PA^ENEHEFGFMBMDKHKHIJGHCNCRERGJIFGDIBKBKAKCOCOAMDGJENAR@PARANELCJCHC@WDIFIP@LDHFDVBNHPJPHJJDJ@L@JALAJAFBFBD@F@BEDG@GBG@IBM@K@QCQAMFEHGNENELEJCHEFEDEHKDE@KAKKOMOAWMYE[@MJIDGBABA@@B@F@LCJEBADCDABK@I@ECC@A@AAEBO@EAECEAMAG@CACCGCEECEEIEECCAE@EAC@AAAAA@AIBI@CBCDCFCDC@C@CACA@C@EAEAECGGAAGAGAC@A@CAIEECKEKEKCC@CAGCKECACAGCGCGCIIEGAAEGKEKCIAGDKFEFGJKHMHQHQJKHIPGREHIFG@ACCGCGMAIAEDGJKHGJEHIJGHCFGDI@IAIEKEKCMAGAGBG@GFIHIHGDGBGAGCIECIEIKGKKKIKIIEMCKAGAC@E@KEE@C@"],encodeOffsets:[[104961,30614]]}},{type:"Feature",id:"511827",properties:{name:"宝兴县",cp:[102.815403,30.37641],childNum:1},geometry:{type:"Polygon",coordinates:["@@IOGOAMCUGEKCO@EJCJ@XGDIDKDMFQBOBQ@MBIFCHBNDPDPBLALALCJEHIJQHQFMDGDIHGJGLCLANENEHGFMF]FOBI@MBMBKJGHINILKJIFKJGDIDUDOAK@MGQGMGSGOCOCYGWGGAEBCBMCICECIKC@IDMAK@KBHZHPDLDLDH@BFN@NBLAJIJ@BELBRBBDLJlBLCLINILGNBPBL@NANCX@^@L@NAJDPBPFRBHDRBPBRDTBTHPFPLLNJDDPNPHHHBNDRBD@FGNBHDDNHHHBJAHAPBFDHFFFD@DBDADABIDABA@@BDDBB@DADCTDHAHQTGJAJGJPHHDLNFJHHLBNBLBJ@HBHFFHDPDFLFLFPDL@HBHDJHDFFJJJLHDFHDLBNBFCLBN@L@N@NBN@PBP@L@LDFDHF@DDJBJBLFZHZFHHHVNJ@T@TDNAN@P@LBPDJFJBLHNHLFLDH@H@FALEHCLCJDLDFDLDHBTCHELGHIR[BCDEDGBKBM@KCaCMGMEMBKIOO[GMCG@IBGJMFGDGHEFEFGDCLEFCHCFADBF@D@DMBI@IDKBGFIHIJKHE@GAIAGAKAICG@G@IAG@G@EBCJALANAL@LAJAHEJGHGHIPGPEPENCLEHELINCHIDADC@E@GAEEGEIKIIIGEIIKGIKIIEEGIIIGICGGIAGAEAIEICKCMCKAICMAGCIEIAIAKEG@GBGBEFGDGFGBCBC@CGCECKAO@IAGAMGKMGEGKEIGKAGAEDGFKHIFEFEHCHGFGFKFGFIDE@EBEAEGICECCGAEEGGIEKAMEKEIEIGGGCGCKEICKEOAIAG@K@C@CBGDEDAHCNAPAFI@A@A@CMEICECGCGCECGCEGCEAGCE@CEIEAIBKBIBK@I@ICGI"],encodeOffsets:[[105282,30917]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("资阳",{type:"FeatureCollection",features:[{type:"Feature",id:"512002",properties:{name:"雁江区",cp:[104.677091,30.108216],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@A@@@@@B@"],["@@DB@CA@AB"],["@@@@A@A@A@@B@B@B@BBB@@@@D@@@@@BA@AB@@@AAAC"],["@@B@@@@@@@@@@@BA@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@ILBBBBFD@@FBB@B@BADEBAD@B@B@HH@B@B@BEFA@AD@D@BBDBD@@@D@BAD@BABBB@BBBBBDBHDB@HDFD@@BAFIBCBABAB@D@JBB@JBDBBBBBBDFJBBB@B@DADCBABIDCB@B@HDDBLFNDBBLDDB@BBBBHBHB@@BFBHBDBFBHFDDB@BBJBDDBBBD@D@HBF@B@F@BEBC@ADABBD@BDBDBLBB@D@BADAJGF@T@NAJ@H@FBH@B@BD@D@DAF@FCFILEFCDEF@BAD@BBBBFDHFFDDDBB@B@B@B@HGDEFABAF@DAD@F@LBFFB@@@BAFEDAD@D@BBFLFL@@BDDHDJBFBBFDHDD@B@B@BAB@B@B@DDBB@@B@B@@@BABABC@C@I@CBA@AB@F@BADAHAB@D@B@F@DBBBBBBH@DB@@BB@BB@@FBF@@@B@FADAB@HBDBB@B@BADABCDABAD@B@B@D@BCDCAIAKAKAGACAEBGFCNGDAFCDAJCJELCB@LEDCDCDC@@LKJKLMDCDCJKFG@AHEPEHCJG@@LEFIBI@@BIHEB@@CAAAC@CDAB@@BBD@DB@@@B@B@B@B@@@BBB@DABAB@DBB@BBBAB@BAB@BBB@BBBD@@B@@@DABAB@B@B@@B@DB@@@B@BE@CB@BAB@B@J@F@FBD@FBDBDDBB@@BBD@BBB@BAB@DADAD@LBJ@BA@@@@@@B@@@@@@@@@@DB@D@@AB@@ADC@CDGBEFCFDFHB@DDBBB@@@BA@@DC@ADADEBADCBABGBABCDALEHAB@BAHCDCBABA@A@C@A@ABCFCFADABABC@A@ABE@G@@A@@@@@@@@@@@A@@@@@B@@@@@@@@@@@@C@C@CBCBABCD@B@FAD@BA@CAEAC@@@A@AAA@AAEA@@ABA@ABA@ABA@AAAAA@@@AB@BAB@BA@@@@@A@@A@A@A@@A@@@@@A@@F@@@@@BA@AD@B@@@@A@@@@@AA@@@BA@@@@@A@@@@EBA@A@@A@@@A@AA@@@C@@@@A@@BABA@@@A@@@@AAC@C@CAA@@@@AAA@AAAAA@A@@@@@AD@@@@@A@CAAAA@@@AAA@A@@@A@ABA@@@CACAGEKEQEEGEIGOIIEGEEMECAICGAAACBMEKG@ACAAAAA@ACAA@AAA@AA@C@A@@BAB@B@@@@A@AAA@@A@@@AB@@A@E@A@A@@BCAAA@AA@BAB@D@B@BA@@@AA@IE@@AC@@A@CBABA@A@A@@A@@BEDC@A@A@AA@@@A@ABA@A@A@@A@A@CBADE@A@AAAAAA@A@IDCBCAC@AA@A@@BAF@BABE@ABC@A@ABA@@@AA@A@CAC@AAA@CAA@ABA@CB@@A@A@@@@EA@AAC@AAA@@A@ABABAB@BAB@@A@@AAA@@@CAA@A@@ABA@AAE@A@AD@B@B@F@B@B@BBBB@F@@BDBFAD@BBBB@@@B@B@D@LCDA@CAAAAEEAA@GACECA@GAGACAGCEA@A@AAA@ABA@AD@BA@AAAACAAAA@AA@AAABA@ABA@A@AAACAC@AAAC@ABA@ABAB@@@DB@BBHBFBFD@B@@ABA@E@C@C@A@A@AB@BBB@B@B@B@@ABC@@@AACA@@EEAACEGCCCAAA@AC@ABADCDA@ABA@E@A@E@@A@@@GDA@@BDB@BB@@DA@A@EAA@AAC@A@ABC@A@A@CBABABABA@EAA@AAA@@AA@AE@AGBECEA@A@ABCDE@@DABCDC@CBAD@BADC@@AAA@ABE@EBC@A@AAABA@AD@B@BABCBABA@AAA@ACA@C@A@A@AAC@AA@@A@CAA@A@AB@BBBBB@B@B@B@@ABA@CA@AAA@AAAAAC@A@CAA@@A@ABCB@BAFEDCDABABABA@@@ABAB@DA@@@AACCCEAA@CBE@CAA@CB@@A@AB@BAB@B@FBB@D@B@BADA@AAAA@AACAAA@A@ABAF@B@B@BBBB@DDB@BB@B@D@@@B@BDAB@BBBDBDB@DBFB@B@@@BEDEBAAA@A@@@@B@BA@CBE@@BCAAAAAA@C@CAA@A@A@@B@BBD@BB@BBDABBB@B@@B@BABA@E@A@A@EBA@BD@B@BCBABCDA@A@AB@BDDBD@@@B@BABIBE@A@AA@@C@CAA@AB@B@B@B@BBD@B@DABCFADAB@D@D@F@B@BA@A@A@AAAAE@AAAA@AAA@@AAC@ABC@C@AA@@@ABC@@@AA@A@EBEBADAB@@CD@DBDDBDBHBF@D@H@HBBDBDADCFGFEBIBGAA@C@@@ABCB@@ABC@@AA@A@A@A@@BA@@BABABCBABC@@AC@A@CAAAA@A@CDA@@BBB@DGH@@A@A@A@AAA@AABKB@@A@AA@CCEGCI@ADGDAD@F@JED@JFFBB@D@B@H@B@BABE@@DCAEECEEAACAGCG@CACGBEBAAGAGAE@@A@EBABABABACAC@@E@ADAD@@BD@DADCDAFCDGBC@@BABBB@@FBDDJDBBABEDCFA@@@CCAAE@@@ABAD@BB@DDBB@BB@@BB@@@B@@B@@@B@@A@@B@@A@AB@BABABAB@@AB@BA@@BAB@@AB@BA@@BA@@B@@AB@@A@GBC@C@GGCICCAACAACAA@AE@C@@AABA@CBA@A@C@A@A@ABAAA@A@AGACCCCAC@@@ABADEJCD@B@B@@CBAB@BAB@@@BC@@BCACBA@@@AACB@AAAADABC@@@A@AA@@AC@@@C@AAAC@AAC@CA@A@A@@DCBC@AA@@@E@A@EBA@@@ABBBBD@BAB@D@D@BAD@D@@@BB@BABB@@@@@@@DBBBB@@B@@@BA@@@A@A@@@AB@@BB@@B@BB@@@B@@@@@@B@@BBB@@@@@DF@@@@ABABA@BD@@B@BB@@@BABA@AB@@AB@@A@BB@B@@B@BA@ABB@@@F@BBB@BCB@BA@@@DB@B@@CD@@@B@B@@@B@BA@A@@@@FBB@@@@@BA@A@A@@@@@@ABA@@A@@@A@@@@B@B@@@B@B@B@@@B@@@BA@A@CB@@E@ABGBKFA@ABAD@D@DA@A@@@A@@AA@A@@AA@AA@@CC@@@AAA@@@A@@@@AA@@CAA@CBADADAHADADEDAD@BDDBF@B@BADCDABCAA@A@C@AB@BAFADAFIDCBC@GCCCAC@C@ABCDEBAAA@AA@C@K@SBKBABG@C@AB@@@BBD@F@@ABABBB@@@B@@A@C@EAA@C@ABA@AB@D@B@BBDBDBDBBBBFBD@HALCF@@@B@@@@DABCDMLCD@DAF@B@BBBB@H@DBB@FBBDFF@B@BA@CBC@A@A@A@@BBBJDBBBB@BADABABIBA@C@ECCAE@E@CB@BA@@BBB@@ABCDGF@B@B@@@B@BCBA@BFABCBUDA@AB@BB@@@F@D@BB@BBBBBBBHBFBB@JHCHADCDADCFAB@B@BBBBBB@H@@@BBAD@B@D@BBB@BB@D@F@F@BDAB@B@BHC"],["@@@AGD@B@BDDJK@@A@@@@B@@@@A@@@@@@@@@@@@@A@@@"]],encodeOffsets:[[[107569,30839]],[[107496,30898]],[[107426,30618]],[[106977,30874]],[[106977,30875]]]}},{type:"Feature",id:"512021",properties:{name:"安岳县",cp:[105.35534,30.103107],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@B@@@@@AA@@@@@B@@@@"],["@@BB@@@B@@@@B@@@BA@AB@@A@@A@@@@@A@@B@@@AA@@@@@A@@@@@@@A@BB@@@@"],["@@ECEAAACA@@A@A@C@@B@BA@ACACACACACECACCA@C@A@A@ABC@CAAA@BA@@@AAA@A@@B@@@DAB@BAB@@BBBB@@@B@BA@@@@B@BB@BBD@@BA@C@AAACC@ABAF@HCB@B@@@BDBD@D@B@@@@BB@@B@BBB@@@B@BD@@BB@@DB@@B@@BDDBB@@B@H@@@@@BCB@@@B@@@@@DFB@@@B@B@B@@@B@@@BA@A@@B@DBB@B@@ADA@AB@D@@@B@@@@CAC@AAICGEAIACAAAACAACAC@G@CAAA@ABADAB@BAAA@CAEDAD@D@D@BABBBABA@@DADEFGHG@@DABAH@F@DB@AD@B@D@FABADCDAB@B@B@DBBBB@B@B@@ABAB@AA@@DADAF@DBB@DAB@BAAA@ACECAAA@ABC@CBC@I@IBC@AD@BADBHBD@BAB@@ABA@CBABADADAB@FBDBHFDBD@BABCBA@CBA@E@CAA@@@ABAAADCB@FAB@@ABCBEBCBC@C@C@ABBBBDBDADAB@DBBDBBB@@C@@BC@ADCDAB@FBF@@BDBBBDDB@BBB@B@BA@@BCDCBC@CBC@A@CBCB@F@B@DBFHDBDB@@F@DBBC@A@AAAAACA@@@ABADADCD@BAAA@@@AB@BAB@@A@@CA@A@@@A@@@A@A@A@@A@@A@@@@@@E@A@A@@@@ADA@AB@@C@@@@AC@A@@@A@@A@A@@@@@A@@@BB@@A@AAAB@@A@@@@@AA@CA@@@@@ABA@@@@A@@A@@A@AA@ABA@AA@@A@@@@AAC@@@@@A@@BB@@@@@@@C@@@@A@A@@@@@A@@@@C@@@A@A@@AC@AAAAAC@@A@A@A@AAI@ABA@@BAB@BC@EBABA@@DQ@AA@AA@@CCCC@AA@E@A@@A@@@ABA@@ACCCA@@ABA@@AA@A@@D@@A@A@A@AAAC@C@@A@@BEAAAAC@CAA@AB@@ECAAAA@A@@@AB@FEFEDABCBA@@AA@AB@@@B@B@@A@C@CBAAAAAC@A@@AAA@ABADABA@@BA@ABABAB@B@@@B@@A@@H@J@D@DBB@FCDA@@@AA@AA@@@A@@A@@A@@@BA@A@@@AAA@@@B@DC@ABA@@A@@A@@AA@@@AB@@AAA@C@CBA@@@A@A@@@@AA@AAA@ABC@@@@DABAB@@A@@AAAAA@C@@@A@A@A@CAA@AAC@A@A@@AA@AAA@@@@A@A@CBA@A@@@A@@@AAAA@CAA@AAAC@@A@A@@A@@@A@A@@C@AAA@@BEB@B@B@@A@A@A@AAA@@@AA@BA@A@A@@@@@ABABA@AB@@@B@@@B@BAB@@@B@@@B@@A@E@A@A@A@@@@@ABA@A@AB@@A@@@@@A@A@AACACAC@@AAABC@CA@@AA@A@A@A@AAEE@@ABA@ADABC@ECAAC@A@CAE@AAACAAABC@IBEBEBC@EBAB@B@B@BCBA@@@A@AEAAAAAAAA@A@AA@A@@AA@@E@@DE@A@AA@A@A@AB@@CA@A@@BADCB@@C@AAA@@CCCCA@@@ABA@C@ABA@A@IDCBADA@@A@A@AA@@@AAC@A@@A@@@@@CD@@A@AA@@A@A@IAG@CAEAEAG@A@A@@BCDAB@D@BA@CCCUUCC@ACE@C@ABCBAHECK@C@CBC@AA@AAE@GAAAA@@@@AA@@@B@@@BA@@@@@@AAAA@A@@@ABC@@BAB@@AAAB@@A@@BA@AAC@AAA@@A@@@CBCBA@C@@BCDCDCDCJA@@@CCECAAAA@C@E@AB@B@HCDA@@@A@@AACAKECIA@C@IGCA@@@ABABA@ABCLG@C@AA@KAA@AACA@BCBCDADABAF@BABABIBA@QFGBA@ABA@ADAFCFABAB@@A@C@GAA@CACCCCAACCCGAC@A@C@ABEBCBAB@DAB@B@@@BA@A@C@EAA@ABABGBCCAAAAAAAA@AAEBCBA@AACAC@@@C@EBCBC@A@CAEAA@CBGBE@E@CBABCBGFA@ABAFABAB@@@B@BABCBGJABBBFH@B@B@D@BA@A@AAE@@@@B@D@BBD@BDD@@@BABAB@B@@BB@B@@@B@@A@A@A@AB@@@B@@@BABAB@@CD@@A@ABAB@@A@@BCDABCBCDAFCFADADDFBDDHBDBHBD@BBH@DBDADBHBF@FBD@D@FCDADCBA@IBA@I@CACBAAAFBB@B@D@DABCDCBAFAL@B@BCD@BADCDEDCBEDADCBA@ACAAAEACEE@AAGBA@ABA@A@C@AAAECKCEBABCBE@CBA@ABADEFCDGD@BE@EAIAA@A@CBC@C@A@E@C@AAAA@AACAACAAA@A@A@@AABE@IAC@AAAA@EBC@IAEAAAEAEBA@CBG@EBAACAC@A@ABCDCDADEBC@G@C@C@ABBD@D@BBBDBBBBD@FCHAD@BA@GB@BAB@B@BBDBB@BEDADCDGFEBCDC@A@GAGAGAC@M@]DCDCBCACAGCGAC@C@EAA@CACAECCCCCCAEAABC@C@AAEAC@C@C@CHADABG@KBKFGFCBCBCBADEFABCFADAFABAB@BAB@B@BABABB@DBAH@FABABAB@BAFBBBBD@BABCDADEBCD@D@D@DBBD@DBD@DADCHBBBHAH@DCFCDCDEFMFSHCBOF@LJNJDBF@D@B@BEBQESDADA@CDCH@BABAF@DMFABEFGHEHGDEDGBG@E@C@KAGAIBCBABIFCFEHIJKD_CDB@BBBBBDB@BLHNFDABBHBJDDBNFFFFHJJHPFJFHRFLFHFDBDB@@B@BAB@@@DABAJADAB@B@BBFDB@B@B@B@DABBDFDB@AB@HCBA@E@C@A@AD@DBDBFBB@D@B@B@B@DBBBBBHDFDBDBBB@BABA@ABGBABA@AAAIC@ABAFAD@D@DDBD@FBDADAFADCDAD@B@B@DBH@BBDBBD@@@DABADCDCDAFAFBH@D@DAB@BA@@AA@@@AB@DABAD@@D@DBDB@BABADAD@FFDDBD@DDDBFBD@AB@@B@@@@B@@@@@@@@@B@@@B@@@B@BC@C@C@ACA@C@C@A@@@ABABABA@BBBB@B@B@DBBDAH@BBBB@BABE@AB@B@BB@BAB@@ABA@@@@BBDBD@@BBD@BAFCDADCB@D@@@BFADBD@BBBBA@EB@@@BDBBB@B@D@DADAD@DCDAJEB@B@BBBBDD@D@BB@@BB@DBDBBBB@@BDDBBHFFFNNFJBHBB@BBDBL@JAHCF@BA@@F@B@DBD@B@@ABA@CAC@CAABIN@BAH@J@B@DAH@BABCDA@C@C@CECAA@A@AD@@AFABC@E@ICG@EBA@ABABBDDHFFDB@BB@JDB@BB@BBL@HAF@D@D@DADA@ABA@EAEACAA@A@AD@DAH@B@BDB@@D@@@D@JPFJBDBBABEF@D@B@BDDDDB@DBD@F@@BB@@B@J@BBBB@DBFBFJDD@@DB@@BBBDBB@BBBBB@DB@B@@@B@B@DC@ABA@@@AAC@I@C@ADCDAB@B@@BB@@BCHAD@F@B@DDDB@D@DBHBB@@@@@@@FBBBFDDH@D@DCDAFCF@BB@@@H@B@B@BBB@B@B@@@DCBADAD@B@@@F@@BDB@BB@@AHA@@B@@@BB@@@B@@AF@B@@@B@@@BB@@@HAB@@@BBFF@@B@BBB@B@DAB@B@DBB@@BBB@BBBBBB@BBFAB@DB@@FAB@@@@B@@@B@BAD@B@@@@B@D@BA@BB@BBBB@B@@B@@ADEB@@AB@F@@@B@@A@@CI@A@A@@DANHDB@BBB@@@D@@@@@@BBJ@@@@AAA@A@A@@BC@ABA@AA@@ADCLKBC@@AA@@A@@@@@A@@AB@@@@@@A@@@@E@@AA@B@@@B@B@B@@ABA@@@@@@BABA@A@A@@BA@@BA@@B@FB@BDAHAB@@@B@@BFHBBB@@@F@B@B@FB@BD@@@B@BD@@HD@@BB@@DBB@@@BB@DBB@@BBB@@@B@@@DG@@BAB@F@BA@@@@@A@C@ACC@@@A@AAC@@@@BA@@B@HBB@B@@@BA@@@A@AAAAAA@CAAAB@BA@@@A@CBC@A@ADCBA@C@@B@DCB@@@DBB@B@B@B@B@@BBD@@@B@HB@@B@@B@D@B@BABAB@AA@C@A@C@E@@BA@ABABA@@@@DBB@D@@@B@DB@BF@B@B@BAHEB@BAD@B@B@DCBA@@@A@E@AB@@@BAHCBA@@B@@A@A@@@ABABC@A@@@A@@AA@A@A@E@A@A@ADADCDCBCDA@@DBFDB@B@@A@@FGH@DEB@BAHABADAHEBAB@@@BBBBBB@BDF@@@F@B@@@BBBBFDBBBB@B@D@@AB@HIDA@AB@J@B@@@@ABEBA@@DCBABC@@@AB@B@DBB@BA@@BABE@@@A@@AAA@CB@AA@@@CE@A@@@ABGB@@AB@B@DC"]],encodeOffsets:[[[107770,30861]],[[108114,30852]],[[108095,30909]]]}},{type:"Feature",id:"512022",properties:{name:"乐至县",cp:[105.02019,30.276121],childNum:12},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@@A@@@@@B@@@@@@@@@@@@@@@@@"],["@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@"],["@@B@@@@@@@@@A@@@@@@@"],["@@@@@@@@@@"],["@@@@@@@@@@@@@@"],["@@BC@ABMCA@@AC@A@@CAC@EB@@A@@@@@@AAA@@@B@@@@@@@@@@@@@@T^"],["@@DBAC@@@@@@@@@@@AAD"],["@@B@BA@@@AE@@@@@BD"],["@@@ABCDCBCBEBCAC@EACCCC@C@EBAB@BJDBB@BABABAH@BABABA@AAACECGCAAAACAA@A@A@C@A@EACACAC@@B@B@D@FABGDA@@BCACEAACBA@A@A@A@ECAAA@A@CBIBABCBB@B@BB@@B@BBDBB@@@@@C@@B@@@@@BBBBB@BBB@B@@B@DBD@D@BB@@@@@B@@ABAB@@@B@@D@@@B@@B@B@@@BB@B@FA@@@@@B@@@@AB@@B@@B@@@@@B@@A@C@@BAB@@@@E@@@@B@@@@@BB@B@B@@@@B@@@@ABA@ABA@@B@@BBBB@BAB@BAB@BAB@BB@BF@BBB@B@B@@BDBF@DABC@EBA@C@ADABAD@D@D@DB@@@@HAF@B@BADABCBEBEDAD@B@B@D@BABABCDGDABA@GBKFCBADABAHABCDABCFCB@BCD@@AB@@A@AACCA@EGECEDAFCH@DCD@BA@@BC@A@CAI@KAC@CBCBA@ABA@AAC@AA@@AACCCAEAC@EAE@I@A@A@ABA@@DAFA@@@A@@C@AA@A@A@ABCB@@A@@@ACAAA@AAA@ABA@ABAAA@CAA@ABCBA@AA@@A@A@A@A@@@A@@CAC@AA@CB@DBDBB@DA@GFAJ@@AJEJKF@@IHGDOFGF@BEHILCDCDKNILKL@@CDCDCDKFA@KDIFIDCBEDCBMHEDAHBFBDBHBLBLBJDOBEFCBABBB@BFDFBDBB@J@BB@@@DBBBBBBA@A@CBAB@BA@@BBBDB@@@DA@@FB@@DAB@@@B@@B@@@BABAB@D@@BB@BB@FD@BB@DADABA@@B@BBDDDBB@D@D@@@F@BBB@BBDJ@L@B@B@D@BAD@B@D@B@F@N@BADABABC@CBAB@BAFCDCDCDCH@B@BBB@@B@D@B@BADCLIBC@A@GBABA@@DA@BD@BB@BBH@D@BBDDDBJBDB@BBH@B@FAHAD@DCBAB@B@@BBB@BBH@DBDBBBBB@B@B@@@BD@DBBB@@@B@BCD@@@B@@BB@@BABAFMLOLA@@@A@@C@A@G@A@@AACBA@@BA@@DAD@DBBDHFF@D@L@B@F@L@@A@@@@@G@ABABCF@D@D@B@BDFBBBD@BBBAJ@R@DBH@D@B@HAH@DAB@D@D@LBB@DB@@DABCF@D@B@D@DDFBDBB@F@BAHBB@@@B@D@@@BDBF@B@@B@@@B@BAD@@@D@BFFDFDFBDBD@D@@BDBB@@BB@N@BAD@@@B@BCBAB@BA@C@AAEACEAAA@CBAD@@@BBB@B@D@BAB@BA@CBA@@@ADEDCF@B@B@D@DAB@BA@AD@@AB@@ABCB@BFB@@BD@BAL@FADEL@@@J@DBB@@D@DAB@DABBB@BBBBBD@B@BAB@BB@B@HAD@BB@@BDCL@D@@BDBBD@B@@ABADOBWBEBCBAB@BBB@@@BBABAJBHBBDBF@B@HAB@@BBBBBB@B@BBBDBHBBB@BBB@@@DCB@@@BA@@@@@@DBBBBBB@@@FGDAD@@AB@@A@@CA@@@@BAB@BA@AAACEAA@@A@BAB@@BB@BB@AB@@ACG@E@C@AB@DAFAB@B@BB@BAF@H@BB@BBDAF@B@@@BBDB@BDBDB@@BA@G@@EE@@@AB@B@BBFBFBBA@@@A@@@A@A@@BC@A@CBC@@@@BCDADGFC@A@A@AA@@AA@CCA@@@@CAA@@@ABCBE@@@C@AAAAAAA@@@A@ABCBAFGFIBCBEDIDGHIDGBEDAD@F@F@D@B@D@BA@@DABB@AB@BAD@HBD@BB@B@B@D@BD@B@BAFADABBD@BD@BBB@H@B@DGFABBDD@DDB@B@@@B@@A@@@AF@@@B@B@@@BDBBB@@@B@FAHADABABEDGBC@ABCBAF@DAFBB@BDB@BBB@@@BA@@BA@@@CAC@@ACA@AA@@@AA@@A@@A@ABC@@@@AA@@@@ABADCB@BCBA@@@A@@AA@A@AB@BA@A@@@C@A@@@@BAD@BAB@@A@@@A@@BADABA@ABA@@@CBA@AB@B@B@@@BBBBDCBC@CACAEGGAAAA@ABEBCBADCBEFKFCBABC@@AE@A@C@CBCBABA@A@A@@ACAAC@ABA@A@AACCAAA@A@C@@BCBA@@BAA@@@@CE@@CCAC@ABA@ADAFCB@D@DABADAFBB@F@S]@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@CAAAAEEA@@A@AB@F@DAB@D@B@DC@@B@@@FD@@B@@@BCBA@@B@B@@@AAAB@@AA@@@A@@C@@@@AB@BABB@@@BB@@@@AA@@@B@@A@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@A@@@@@@@@@B@@BC@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@BD@AB@@A@@BA@ADA@@@A@@CC@A@@BA@AB@@A@AB@@@@@@@A@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AAA@@DCD@D@@@D@BA@E@I@A@CBCDIFEBCBADAD@@ABAAI@@@C@CBAB@BAFC@@@@@@BBB@BBDB@@B@D@BABADAB@F@F@HBD@DAB@BA@@@AA@G@A@AA@A@AFABA@AB@@A@A@@@ABAHK@AFABABAD@FCDAB@DADF@@@@B@B@DADCBADAB@HCHAH@B@BA@@@ABABABAHAJBJBDBBAB@BADOAMBEBABCFCFCDABAHB@BABABAD@BB@@B@@BB@@B@BAB@BCDCBA@@B@D@B@B@@AAA@@B@D@F@D@DB@@B@DAB@@@@@@@@B@B@@@BF@@@B@@DBD@@DEBEDC@C@CCGECAAEA@@@@@@A@GACAC@A@CC@C@A@EBCDG@AA@@AA@A@CBCD@B@D@JBD@B@@AB@BCDA@A@@@A@A@@CAAAA@AAAACAA@@CA@@CCEIEACAA@AA@A@I@AA@@AE@C@CAA@CCCC@A@A@CFEBAAAACEIIOC@@@C@@@CA@A@ABG@CBCB@B@DBFBFBB@BAB@BC@C@C@CBE@GAK@AAAA@ICA@@ACAEECGACBABAB@FAH@JDF@D@BABE@@BCB@B@DBDFD@D@B@DCBA@ABG@C@A@IBG@AJMBADBD@DBACA@@@@@@A@@@@BBF@AC@C@A@EB@@ADEBG@IAKAC@AAAAGEIMMEEGEAACC@AA@AACACAA@@AA@@A@CCCAAAAA@A@IFCBCDC@CBCBC@A@A@AAAC@@A@@FABAA@AACBCAE@@C@A@CDCBEDABC@AA@@ACAC@A@@B@BA@@BA@AAAA@A@@BAFABA@AA@ABGACCAA@A@A@AA@AABABAB@B@@@B@D@DDB@B@D@DADA@@@A@@@A@@@@@@@@@A@@@@@@AA@@BACAECC@CACCCEEC@CBABABA@AC@C@CC@ABCBA@@B@@BB@@ABA@CBC@G@EAEBCBCDCDABCB@@C@AAAC@AAG@C@A"],["@@@@B@@@@@@@@@@@@@A@@@@@@A@B"]],encodeOffsets:[[[107723,31040]],[[107597,31173]],[[107568,30839]],[[107596,31173]],[[107497,30897]],[[107624,31115]],[[107624,31115]],[[107612,31158]],[[107617,31127]],[[107772,30863]],[[107628,30783]],[[107616,31126]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("阿里地区",{type:"FeatureCollection",features:[{type:"Feature",id:"542521",properties:{name:"普兰县",cp:[81.176237,30.294402],childNum:1},geometry:{type:"Polygon",coordinates:["@@]Ba@_FQFIHIJILONKNMJAHJTTn@JGTa@G@B\\ARAjERMHMBGNITSPAHITCDCBADALNJITSPHheXEBUHKFQFWBeCYCU@EBAHDHDFCHSBGREPIFSASCI@G@GDGFKJOHaBQAOHUHUTSRMTIPIHWRSN_hYXULKBQCMBKDIP@@UTUPYLgV[F[HK@CF@D@D@FBJBF@NCDADADCBCFAD@DAFAD@BCBABC@ABA@ADAB@FABADEHABCBEBABCDABABADABA@CBEDABABADBDAF@FAB@B@H@B@D@BCP@BAB@D@FALAFCZADADADBBBB@@BBDDBB@D@D@BAFBD@F@DBDBBDBBD@DBBBBDDBDBHBFBBBDBDBB@B@BBDFFDDBBBDBDBBBD@F@@@DBD@D@BAD@BADAB@F@BF@ĸO@@ABE@C@@@CDABABA@CBE@GDGBCBBBBBBD@B@DAB@DBL@FBB@DDDDHDFBHD@F@PCLCH@F@FJFBBD@B@F@BDDBDBBB@DAB@BCBC@ABADAD@B@@BBB@BBBB@D@B@B@BAB@FAFAB@D@B@D@BBBB@DBBB@B@HCB@BB@@ADCDBBBBBADADABCD@B@BBB@BBB@B@D@B@BBBB@DDDJBJ@HBDBJDLBFAHAJ@DBDDTDHBBDBBBBNJDDFDFFB@BBJBB@DDBDBDBDLPDFBBBBHDHDFBD@DDBB@BDPBD@F@D@BBBD@DDHHRPJPJN@BBFBFCLAN@@DJDDFBBDABCDEBCBCDCBA@C@E@CBKB@JEDAJABAD@DABADBBBD@B@BADCB@B@BBBB@@B@@EHAD@BBBDBBBD@FADAD@BBBBB@FBBBB@BDDBDBB@B@D@BALFTJJFNHHBPDNBPAbCH@B@BBBDBB@BBH@BBBFZAD@FBBVtBHDJNTBJ@FBBFF@D@DGFAHLDDBFBBDBDBDDDFFBBBDBJBDBDDDHFDBDBDDBBFFBJBHHL@BDDDFDFFDFDJFHFHDDBLDRJPJLLHDHRFDFB@HBLDDJBHADB@@@FAHADADBDBBDBB@BBBB@B@BA@@BCD@BDABAJAF@D@JAD@D@HFBD@BBBAD@@DDFRVHRANMHSFKLGPIEMCKAGPAVCRERJHRNLHJCRAFPDLDTORENNHP^FLDVBRJH@JCLALHPHBDLDNAHOFQHCTMJILOFKEIOCKADEFKNMPMHONERIR@VFNA\\QTETINGJL\\RJJH@XBRRJJBHL@JGNG@OBKAK@K@Q@GLIR@HPPBJCJQGQCGFCLGJCPKJKDGJKHILGRCRFJDDHAJADAP@JJFFJJFJFTHTDNCLGFGPOJKHODMAMBMDMFIJIPNZNTLdBNBFKFWBS@OHINMTITCN@V@RABGAGMMAGFCHIHK@MAKDKFMDIDITDVFR@V@LENOHOTMLEPBLCRJRHPCRKLBLFPAPAJCH@N@JCBGGOKOKICGDGHGJGBOBGGGFO@GDEFCLARBJEPCPEPCLDJNJJJHXJVFJDTAHDLDL@LKHIHGNEPELQDI@GEGEOHGLEXHL@DGHQZOJEHKJKHO@KAGBKLEZCTAXCNBFFFfDFDCD@D@B@B@DBDB@BB@BB@AB@BI@@DAD@DAH@DAFABAB@B@BBBFB@DBDBBB@DBD@DDB@DB@D@DAJDH@JADEDAFCJAH@HFHFBD@HANDFFDNLJNBDB@B@B@B@DBBBB@D@D@HAB@BA@ABA@@@I@ECA@ALEPADC@A@EBG@WA@CGACACAEAI@CBC@CBCBCBADABCNAHCFALED@FAD@BABABCBA@CAAAAECAA@C@EBI@CBADABCB@FABAPIBA@A@ABGBEBEBABABAB@B@B@DAFCBAFCHEBADADCDEDADC@@BCBEBCBC@A@C@CAA@E@CBEBCAE@C@ACC@AACACBBB@D@B@F@RCF@LBHBHBFBFB\\@H@J@D@H@DARABAFAJK^QECEAGEECEK@CDCBC@I@GACEE@@@@@AEACECCCECIAAIKCC@ABEBEDCBCBE@@@CDCBEBABEBEBABCBEBADEBCBABC@A@@@EAAB@@A@A@@@AAE@E@AFEBCBCBCDEBAFCFCBCBCBCDGDGDCFIBCDCDCFIFEAECGAE@I@IA@A@CAM@CBC@C@GECCAAE@C@@@@ABG@CB@BADABCHEBGC@IA@@AAA@AA@C@C@ABA@CB@AC@AA@@@GAE@CAC@C@EBEBEAECACACACA@AAA@EB@@A@A@C@A@CAAAA@A@IBA@@@A@@@A@AB@BCBC@A@CDABADAHAFADABA@CBA@CBA@EDABABAFABAD@@CB@BE@EBC@@BCBADCFCBCDCBEBEBGBE@KBKDEBEBABEBA@C@CBGBC@A@A@AAEACACBA@@@@B@DBH@D@BA@ABE@AB@@CBADABABEBABA@@@CA@C@E@AFABADEAE@CBC@Q@C@CHA@A@ACCCKCCGE@@EAGKA@C@C@CDEBEBCDEFKDG@A@@ECEAACOAE@CDG@E@AAA@@A@@B@D@BEBMDA@@D@J@FADCFABA@AB@HBBDDDBBFBBABCBCD@BBBDT@DBBA@A@C@A@ICC@MICAC@C@CAE@C@W@ABA@C@C@A@CBC@CB@J@BABABAFCDEHADCBABC@EBG@E@E@A@AB@D@BADADABEBC@@@@ABC@AAA@@ACkyA@AAC@A@C@CBCBCBA@C@ABCBA@A@A@A@A@C@@ABA@C@CFEAABABCB@BAACAC@CBADC@@@ABAHC@@@AAE@ACACEAA@CAC@AAEAACAA@C@AAACAA@EBE@ABEDK@ACEAGAAAAA@AA@C@C@E@ABA@@FCBABA@A@ACAEC@CCCACACECAAAAACAAAAACAAAAAEACACCAA@CCCACCGGCAGCE@GAACAACIEAEGKGMEIIQKOMQKEKOMEEKSA@@AC@A@EBC@ABCHARALGFS@SJGFENBLPPFLAZ@TFTJRLNKNK\\KVSVYFQHGAIIOQMKAAC@C@EDMHA@CBAAC@CCGAA@ADKHGAEGKQSIKAMDodCRMNI@[WIBmPUDWDQTKHEDOBS@UAMBcPODQBYAUBS@UBQFMFIHCHENEDO@YL_PYFuT[LWNKCCEJ]AEAIKGMEKFKFMHSHQHQAEGAIDYIKAKAEBQBSGIUBcDcN_DWP[V]ZSDIBQDQFG@MKIUFMLKTKPKEIIIW@M@CECKBKBIHQ@IQY@GLCdIJE@IIESMMGCCBERIJI@MKOOOIO@@GK@UDgAGGMUMUKKGSIQUOMCCCC@C@GAA@@E@C@AAA@A@CBA@@@A@AAAAA@@@A@ABABABC@AB@BA@AB@@@@@B@B@B@B@@ABABAB@@@@A@A@@@A@@@BEACU@AAsAKGAEBIf[NMFODU@OG]GSESIOIMKIGEICSAAEFGLGFKEMMCQE]CMHIFIJKBIEUK"],encodeOffsets:[[83137,30730]]}},{type:"Feature",id:"542522",properties:{name:"札达县",cp:[79.802706,31.479216],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@B@FDB@@@@AACAA@A@ABABAD@F@FBHHFHHBHNBDBBDFAB@FBBBDBDBF@BBDBBDDFDDLFFFDBF@D@DAB@DAD@DHJAHAFDFBBJJDBDDD@JALCFCFCDADC@CFGPIBCDCBIBABAF@T@JALDLRZNN@X@N@FB@@BADKBCHEFALAFAD@FB@@BA@AAC@AHCTG^QLU@IAEACCCGEAA@A@@H@B@FBJHTLNHJFJFPFLDLBH@LFHCHBXIJMDMFQBIHUDMHI\\MNGL@NHJDJCJGHUBQIMQMQMIIKIG@GKCKOMCMDMRCR@LCP@LDHLFRBFHTBJDFPBPCVDLJFHFFPBPDN@L@NCNAHAFEJGF@LNDPHPNFR@LCRVLBJCJCDCBEBA@C@ABEDC@EBA@CBABCDEBA@EBCHGFC@@@ABABCDAB@DA@CBABAD@HBB@F@B@@AB@BA@A@A@GAEAAA@E@GAGCC@IGGEKICGEKDGFE@KCIKUCEBCPARCA@CACAACA@AAEAA@AAAAC@CBEBC@AACAAA@ABCFG@@@AA@AA@A@ADABC@A@AACAABCBA@CBCBABIFC@ILADAF@D@B@DADCDAFADCBAACEACCCI@@BMDKAEAE@AIMIOQOGGCCC@AA@A@C@EACCO@AAACCC@EAGCGCAAAACEKOACACACCCA@IAAAA@EEECCCMIAAAAACGASCCCCAI@GBEBKAICCAGAI@IACC@CAAAAA@C@A@A@AAA@AAA@C@ADCBCBABAAAADCBC@@AAA@GDA@A@AA@CAAAAC@A@C@A@EBEBA@ABA@A@C@A@AA@AAA@AA@C@CBAB@BADADA@CBA@AAACCC@A@E@AACEAEIE@G@KDODE@C@AGCECGCC@CAA@EAK@CBA@C@AACAAAADAHAHCF@DAB@BABADC@@D@F@BA@@ķPE@@A@EBABC@ABC@A@CAC@C@@@EACAAACACAACCEEAC@A@AAAACACAAAEAGACCCAAAA@CACCAAAAC@C@EACBE@A@C@CAACCAA@@AAAABCBCBCDYBEBK@E@CBA@ADO@A@C@A@G@ABA@EBEACBCBABAFCDAB@BABCBABADCBAFADABAFGBCBA@EBABCB@BAD@BADA@ABCBE@CBCDEDABCBCDC@MAEAI@E@C@CDEC@OAKAUESIIGICIAGBKJGHKLKNgXaHiFQDQDEDajQDMDOA]B_LUAOGMFSRS`S`YJeB@@SCeAQFSLiLQHQEWG_EQAUK]UOCWDkVYRCNEJAF]DWFOFMFGXCVITMNINIRCHMNSNMFSHUHGHCJCHAB@DH@H@D@D@AL@VKVURDPDJHLC^GHMNQNmPUBODIJALLLRDJF@@BLELKNa`MHOAMCYGiAQDKJYhSZW^[^CJYZSJMAYBSHQNKLOPKHULQJEACM@MKSOEYCWEWIQM@GCMIGEHAHAJEJULcJQHIFQjSRONIP[tCDSAYDMAqCWKQEE@AFBVE|ELOFC@MDMGIISYSMIMGCMEQE[E[IIEEMFOLKFMAUNMBGEMMEGAMBMFSB[GQK_GgAU@MLGLAN@P@FELEHCNCLILIFIFQFWEMK[EcBCACAACMIMAKAM@GCCD@BELGDgZIPIJ[LKFKDMBIBKDKDGHGVGLGR@VGVKHGFGLEPDLN^X`FNEXUNkTKPENCPIJQFMFEVI`MLUHQNMNKNC\\CRMPILG@AEIW@QKEUDYFOFGJUHWCQNMRKZCJERMLMNCPF`H\\LRT^X\\HLBHKHUPMXIRQLs@GHCJ@XDR^tXHTLTBPDRRdXbVXP@@JPLXBLGZCT@RCFGAcIMAM@ILEHAHS~MVkS^KFzORAFBHBJDHFTHPNJNJpZJDNFZD\\HBFBPFNLL^\\HHDRELKL[FKDCBAB@@@@@BA@@B@BA@@B@@@B@@@B@@@@A@@B@@@@@@@B@@@B@@BB@@@B@B@@@@@BA@@@A@A@ABA@@@A@ABABA@@BA@@BA@@@@@A@@BA@@@A@AB@@A@@@CBA@@@AB@@@@@BA@@@@@AB@@@BA@@B@@AB@@@B@B@@A@@@@BA@@@A@@BA@AB@@AB@@A@@BA@@BA@@BA@@@A@@@A@@@ABA@@@A@@@@@A@@B@@A@A@AB@@A@A@@@A@A@AB@@A@@@A@@@A@@@A@@@A@A@@@A@@@@BA@@@A@@@A@@@A@@BA@@BA@@@A@@@A@@@A@@BA@KBABA@@BA@AAA@A@ABA@A@ABABCBAB@@ABAB@@@@@@AB@@@@A@@@A@@@ABA@ABA@@@ABABA@A@ABA@A@A@ABA@@@A@ABA@@@@BA@@@@@@@A@ABA@@@AB@@@@A@A@C@ABA@@@AAA@A@@@A@@@@@@@@@@B@@@@@B@@@B@@@@@@A@A@A@@@A@@@A@@@A@@@@@A@@@AB@BABABABA@A@@@ABA@A@A@A@@@A@@@A@@A@@A@@AA@A@@@@@@B@@B@@B@@@@@B@@@B@@BB@@@@@@@@BB@@@@AB@@@@@B@@@@@BA@@@@D@BAB@@@B@B@@@B@@@B@@B@@@@@B@@@@@B@@@@@@BBB@DB@@B@@BB@@@@B@@B@@@B@@@@@BB@@@@@B@B@@@B@@@@BB@@B@@@B@@BB@B@@@@@B@@@BA@@BA@@B@@@B@@AB@@@@AB@B@@A@@B@@A@@@CBA@AB@@A@@BA@@@C@@@A@@@@@A@@@AAA@@AA@@@ABSLCBEFGHCHANCJEDOJCDIBA@AB@@A@A@ABA@ABA@ABC@@B@@A@@BA@@@A@@@@@AB@@AB@@AB@@A@ABABAB@@A@@B@B@BA@@BABABA@@BA@ABAB@@@@@B@@@@@B@@AD@BABAB@@@BAD@@@BBB@@AB@@BB@BB@@@A@@@A@A@@BAB@@AB@B@B@@@BAB@@AB@@AB@@@@C@CA@@EAE@A@A@@@@@@@AA@@@A@AA@@AA@@AA@@AAAA@@AA@A@C@A@A@@@AAAA@@AAA@A@AA@@A@A@A@C@A@A@@AAAAA@AA@@@AA@@A@@A@@AB@@A@ABA@CBA@@BA@CB@BA@A@BB@@@BAB@@@BAB@B@@BB@B@BBB@B@B@@CBA@C@A@A@A@A@@B@@@BA@AF@BA@@BB@BBBB@@BB@BB@@DGP@B@HCDEB@DBBDFBD@FCLWDEDBdBBRDJHHLHFNBPHPHFHBDFFFJBLFR@NBFHLHLJJJLFVMR@@QLWDEH@BHJDP@NGPONSRKPFNHHHN@FBRBHAFDP@VGNEFKLABKPATUVIPABS\\ONQJGFGDEFER@JPNJLDH@DJHHHJH^FTBDBJFD@LDD@P@DAPAbNRTT`TDTBNCHJRJRCX@HF@`HHF@~ORBHFJLVRTLVFPDBFTfZ@DBB@TJLTLJTHD@NAFUBGDG@OAKCKAIAKFGFMTOZ[JOLM@WGMGIKMIKEOJgJSXAMD]NMLMLWFGDMHQLKLEPENIHILIVAN@JAHCLMJELGTCHGBM@IJKPG\\MDA`QZSBARIH@BNDJPZNR^TXBJ@L@VD^FB@Z@DAB@VIHENCVAFJAV@PJV^Z@REbBPP\\LRRJRNNHNDF@@@AABIBCBABAF@B@FCJCDALENIBANKFAD@D@FEBCD@BAFAPA@@XCNM@@FMBAPOA@TMBA@@BOBEFIDAFCBICEBABA@ABCDADADADABABABH@J@DBD@BBDLLBFBDBF@D@@DDFDFD@@DALMDAHCFAN@DBNDBBJBBAD@BEBA@@PEDA\\C@@JE@@FK@AJETGJEDCBA@@@A@C@ABC@CBEFGDABAF@LBB@HDDBB@JHRLJDF@@@DCDIBABEBEBCFCDC@CDCDCBCBABE@C@GBABK@ADIBKAO@CACACA@@AACAEACBG@C@@@@@BFBDDBDDBFDFBBBDBBBBBBD@@@F@B@D@DADBH@D@D@DBD@DBDBDDDDDBBBBBDDBFDDBH@DBDDBBFHFHDHBBDBDBF@@@@A@A@@D@J@FBHAFBDBF@DGdOXGJFLChI\\ETOR@JLTEDIKWKIQSMMFI^IFM@MJUPIXIVEXAPBREFQHOHKJKTGHMNEFKLGJCJCRMTONOFM@QCQ@KFOBKJQJMBMDK@OAK@KAOCKCO@IDMJKJIFODMBKBKRK^BP@PAJSFOCIDILIREFKPOTWHGPCJGDGGIIKGIKISGBMFK@M@MFOHGNGJAFEJMBK@QFMBMECCGKAGBQ@KGSKEGCMAIHGLEFM@KBEJEJDJ@JIFECMCI@KBODGPOJELILEVIRIJKHSPSPKHIJCFEDILOJKB[LOJIDG@GDIJONGRJPBVANCFCFAHIJIF@JCJI@O@KDQFGJIFKLKDM@KFCJAJGNM@OXONKPQRGPCHJZHNEJGPC^AN@JBNARAZCNEPAPKAM@A@@EAG@MECA@CBCBEBEAGAEIIEG@C@CFI@I@@@AAEACCE@@EAMEICUAKAOCQCQCKAGAEIEQBILBHEJALAFGDKHGDOFEEKCEEKEIGAGGGCEIEGCEAE@GLANBNCHENC@MMMQKEKCEAMJIHGRCbEXBJANBJALANALANMNS@SFOBSASBKBKDILQJMHKDGAKCG@GCKAI@GAA@ACCAE@A@C@CAC@EAA@AAAAA@@B@BABBDBBBB@@@B@HE@@B@@BBDB@@BJBD@BA@C@CB@@ADBD@DAHCD@"],encodeOffsets:[[82294,31833]]}},{type:"Feature",id:"542523",properties:{name:"噶尔县",cp:[80.096419,32.491488],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@HDD@D@F@FADCB@FBNLFBFBF@N@HCTGLGJE\\G`A\\MhCdD`]XMLINI@CCCCGCMAIAEA@ECIGECKAKDMDS@YCGACAÍoQ_HHW@OBIBi\\KBwOA@K@GAACCIAGBEDADA@ECIGGM@I@EBGFOBQ@GEKGÁSI@A@C@I@CACCGCOAAAEEACA@E@GAE@E@ABABCN@B@HAH@D@D@HDHBHFHDFDF@F@DADEFDD@H@D@DDJFH@BFH@DDD@BHDB@L@FBFDTRHHHBB@DBD@J@BBB@BB@DABADBBDFBB@DBBH@B@DBDDBBDBB@D@D@JABBDDDBB@FAFCF@BAD@B@FCBAB@BADBDBFBB@FAD@DBDAJBRCLPDPLHPJLCNA\\BFATGDANB`AB@DDDDDDD@DBLABBPADBHJFDHBDBB@F@LCHBD@"],["@@DDHFHJ@RGRHJHLLLXfVBBDARO^A^UVGFGPGNGZFLRHHHGZFRTFHHBD@FAHKPWjGNETAJA@GFIFCDE@I@AB[EEAA@AAAA@@A@A@CDCBA@A@A@C@@@@@@@@@BB@B@@@B@@@@@BB@@@@B@B@@@B@BABAB@@@@@B@BBBBD@@@D@B@B@@@BA@AB@B@@@B@@B@@@B@BB@@@@@BBB@@BBBB@@BB@B@@@B@@AB@B@B@@A@@@@BB@@@@@B@B@@BBB@@@@@B@@@@A@AB@@ABABA@ABAB@@@B@B@B@@@BBBBBB@@B@BBB@@@DBB@B@@@B@@B@B@@BB@@@BB@BBBBB@@@B@@@@C@AB@@@BABA@@BAB@@A@@B@BAB@B@B@B@B@B@B@@BD@BBBBBBB@B@B@@@B@BAB@@@@@B@@DBB@@D@B@@@BBB@@@B@@ABAB@BABAB@@@BA@@@@@ABA@@@A@@B@B@@@BAB@@AB@@B@@BBBBBB@@BBBBB@@@B@@B@@B@@BB@B@B@B@@ABB@@B@@@@@B@@@B@@A@@@@@@B@@AB@@@B@B@@BBBDBBBBBB@BDDBBBFDDDHDFDFBDDFBBB@@BB@@@@B@@@B@@ABA@@BEBAD@@A@ABA@AB@@@@@@@BA@@@@BA@A@@B@@A@@@@@A@@@@B@@@@AB@@A@C@A@@@CB@@ABA@@@AB@@AB@BAB@BAD@B@B@@@@@@@BB@@@@BB@@BBB@@DDB@BBD@B@B@B@BABA@ABA@@BCDC@@BA@A@AACB@@@@@B@@@BBBBBBB@@@B@@@B@B@B@B@BBFB@@@@BAB@BB@@BAB@B@B@@A@@@@@A@@BA@@@@B@B@@@B@@@BA@@BCBADABCB@BAD@B@DADA@@BA@ABA@A@@@A@C@A@@BA@@AA@@@A@@@AAA@A@A@@@ABABA@AB@B@DA@@B@@AB@BABAD@BAD@BAB@BA@@B@@@@@B@@@B@@@B@B@@AB@@A@@@A@@AA@A@@A@@@@@@@A@@@@AA@@@@@A@@@AA@@@A@A@@@@@A@@@A@@@@@A@A@@BA@AA@@@@A@@@ABADAB@BAB@BABABABABA@ABA@@@A@A@@A@@@@AA@@AA@A@@AA@A@A@@A@@A@AA@AAA@@@@A@@@@A@@A@@A@@@A@@@A@@@A@@@AB@@ABAB@B@D@B@DBF@@BBAB@B@DAB@BA@ABA@@@A@C@C@A@C@C@A@@BCB@BADCBABAB@BA@ABAFCDABABABA@AB@@AB@@AB@@@B@D@D@BAB@BA@ABABC@A@@@AB@@AB@@AB@@@BAB@BBBA@@DA@@BA@C@@@@BA@@BA@@@A@@B@@@@@@@BA@@@@@@PEDCJAFAJCJAB@BAB@BA@@B@BABA@@DA@@DADC@@DA@AD@BA@@BA@@DA@@FA@@DADAB@DANAD@@@DAD@DBF@HA@@PBTAJANALCJGHCJEBAB@DCDCFC@@B@@A@@B@@@@AB@D@@@BA@@B@@AB@@@B@BA@@BBB@B@BAB@@@BA@@BA@A@@BAB@@@BBB@@@D@B@B@D@BAB@BA@@B@@@D@@@BAB@B@BAB@@@B@B@B@BAB@@A@@@A@@@@@A@@B@@A@@@A@@@AAA@@@A@@@@BA@@@A@@B@BB@@B@@@BB@@@@BAB@@ABA@AB@@A@@B@B@B@B@B@@AB@B@BA@@B@B@B@@@B@BA@@@@BABA@@BABAB@B@@@@@B@@@@@B@B@@AB@@@DAB@B@BAB@DAD@B@BAB@D@B@@@DAB@B@BA@@B@@A@AB@@@@@BAB@@@B@@@BB@@B@@AB@B@B@@@D@@@B@@AB@BA@@@@BA@A@AB@@A@A@@@A@@BA@@D@BA@@BA@@BA@@@A@@B@@ABAB@@@B@B@@AB@@@B@@@B@@@@@B@@@BA@@@@B@@@B@B@@@@@B@B@B@@@@@B@@A@ABA@ABA@@B@@AB@BAB@B@B@B@@@B@B@B@B@@@B@BBB@B@@@@B@@@B@@B@@@B@B@BAB@B@DAB@D@BAD@B@BAB@@ABAB@BABAB@BAB@@A@@@@@@A@@A@@@@@@@AB@@AB@@AB@@@BABBB@@@B@B@@@B@@ABA@@BA@@BAB@BABA@@@@@A@@@@AA@@@A@@B@@ABABAB@B@BABADA@@B@@A@@@@B@@A@@B@BAB@BAB@@@@@B@@@@@B@@@B@BA@@B@@@B@@AB@B@B@B@B@B@BA@@B@@@B@@@BA@@@@@@B@B@@@B@B@@AB@@@BA@@@@DAB@B@BAB@B@BAB@B@@@B@@AB@@@B@@BB@BB@@DB@BBBDB@@@@@BBD@B@BB@@BB@BB@B@B@@@B@BAB@BA@@B@@@B@@@B@DBD@D@B@B@@@B@@B@@B@@VDR@ZERBLC\\@ZSVKVBLHFP@RBV@JDNRLNA\\CPAN@H@P@JCNEFCJEXGPGVGDCLIJETEZCbGHALATID@DDPPJJHDH@PAREbITG`GTELGNGDEACGICEEYDCNEHCNGBC@C@AIKEGDGJIDIBED@LAH@T@HEDABCAGIO@CDCB@RBP@ZAH@JBL@LAR@NDLAPCF@JBF@BABC@GAEBA@AD@BB@@B@D@B@HCFGBAD@DAD@FBD@B@B@@AFCB@D@@@BA@@@AAA@A@ABA@C@@DABABEBCBA@M@EBABCDD@@D@@@B@D@@@F@VGVCTMLCPHLBTDT@LATFHFPANEPEL@B@LBB@D@D@H@BBD@@BDBB@D@D@D@DABAB@D@D@BAJBB@BBBB@BBD@@LADBH@D@N@F@D@BAHIFE@@D@B@D@B@BBBBFDBBD@FBDBD@BADCBCDCDAD@LA@AB@BABCBAFGB@N@H@LBB@DB@AD@BAB@BAFA@CB@@@@@DDB@BADEBABAJ@FAFCDCDCB@B@D@D@D@DCJAHAB@DBD@B@HEJEFCB@BGBABAB@D@B@BA@ADABEBCBADAB@H@D@B@B@FCDCDA@ABAD@D@BADCDG@ECAA@A@AE@A@@B@DA@@DAJAHCJCFCDADAHAF@@@BA@A@E@C@EBAD@BABADCBE@AFEDA@ABA@AB@B@F@DAD@DBD@HAF@B@@B@BDBB@F@FBF@B@DAJ@BAFAFCDCFGDA@@B@@@BB@@DFBBB@D@B@D@H@DBDBBBB@BAD@FAD@H@D@BABAJAD@H@D@D@F@HDB@@@HCHCJIFEDADAD@H@@@DALAB@B@@BBB@BCB@B@D@D@BBBBBBBB@@BBLAB@DDFBB@DB@@@DBB@BBHDJBJDD@B@FCDAJ@D@DBF@BBHBFBD@D@D@R@D@B@B@@A@@DABC@GBG@@AE@C@CBABA@C@CDIDC@AHOBKDMBGAGEAOEACAIBICCOEGC[UACACBCJGHGDIHSBGBEFEFGJEJ@NDFDHDF@^GPGJEJCVINCRCLKBIBQ@EEIAKACHK\\QFKHIHKCKQWGG]YC@UAgEa@GC@ABUHWJO@ODKBO@KBKBSHSFGPSJKHMHQ@MBIDOJIDEDGHAJIDA@GBECE@S@C@CACGOAAAACACCUO@AAAEGGM@ARY@CBC@CAA@CBAFE@AAC@ABA@CBKJODCHEFEB@B@B@BBJLFBHBF@DABAL@DB@@BB@HBBAD@BBBD@F@DDBFBBFB@BBB@@LFHAFAAIAG@EHAJALCHIEKKOIUEMCGICKAMBKDQBQAQCICMGWOKEO@K@Q@OAWUOWCIMIBMAKOEKAQBEECGEEKBQFOBKDIAEI@EBKAMKFGDIDOFM@I@KAKDKJGASICKCMDQCIMGI@G@KAIAIAKCMCEAGGMCIICCAGCIKGHKLMHGJEDIAMAKBIAIDILGJEJKCIGGMOKKAMJGNILGPCN@L@PGHADEBIDGLGDBPKH@PALAFGAG@QGGGIEGGIGGDILEFCJAFEFEAI@IGC@GBIDGHGLGFGBMBOHKCEIEKEAGMEEGFKLSHGRKNBLBAMGOAKBEJOJIRINKPCPETGHKPIH@NFRJ^FJ@FAFCDGJAFDJNNbPETCPB@JDHDJFJFBH@FAHALCPGBIAMFENBFAJEDICMMEGC@GDGKSAKDITMRAJARENKLIPKRGLEJCJ@R@NIJCFODEPCH@DGJGDIJKIOAG@IDO@GCEAAAEACACCGAAACAAKEBG@CDABCB@@ADC@AB@@A@AAAAAA@CAAAACBCBCBG@E@@CAGBIACCAK@GEAECGQGCKKOIQIKCCAGCGEIEECECCECECC@AGKAGAIEEAACCCACAGECCACACAIACAAEECCACACACEACAKCBGHE@C@CEEAA@EAIMSCIAGUsAA@EBCEYAA@AAG@AAAACAAA@G@aDOBMAOCGAMGIESIKEABC@A@QDOBADDFLVDJ@LEFCHFLDHLJHFJHD@HDHBF@B@BBBF@H@B@BABA@@BA@E@A@GAC@ABAB@DCBA@CBADAB@B@@EDGHAD@FABCFADAB@DAB@FCDAF@B@DABAFCDIDIDKAQUKDQ@MEGOCOKME@IHEFGBMBMDK@M@OCOAEEEGKIUCODOACEAIGSAEEQGKKCO@KDQ@QDCNDNPNDLHLH@LJJJRNRNJNARGVIHIDICMGK@MH[NGJCNGVAJERCNINWJGAGDKEG@KAKCOEIEIEMGSKIGEAA@G@@@@BBBHFDDBDBF@JKV]RSHGD@BBD@BAB@@EAC@EBKBEBGFADCLAB@@EAM@W@M@YMKQKCIBS@E@ABABAJCDADOJEH@DCDCBEDEDKDIBC@CCCAIIAACEBEBGGI@CBC@CBA@C@CAEECEECKECCCAAAC@AAEACACAA@EBACEAAACGMGAEGGGEAE@C@ABAB@B@BBBBD@B@@A@ECA@A@C@GDCBC@CA@BA@@D@DABC@IA@AA@AC@AA@@@GFA@@@A@AACAAAABA@@@BBBB@BBB@FBD@D@D@BBFDD@BBB@HBJDL@HDHBLCHGLINKRCJALALBTATEP@TMTMNKBMBKBIBMAIBWAaFQDGHIJBNDFFLRLNN@NMDGFMDMAKB@HBFDFFHFJHDHHHBFJFLDFFLEFCPGHCLEHKBIBGFKAAJFRFJHBLBRDRDPDLBVBJDNFFB@@DFBDBF@B@@@JEJ@D@DFHJJBFBHAFAFAD@DDBNFH@FB@@@BBNOLOBMFYDQBMBIAM@]BODIHMFYGGIODQHORMLWP@PMNIHIBED@LCNKLELIJEHCR@L@PIJIDE@IJGJEBEDMDUBOAQIMHIPCJ@HCHIJKPA\\ILKPCJEFIDGJOLOTGTILQJUJKFKJIFOPCHAP@LDJDNEFIJI@ICIFAF@LENKFGHBJDNFHTLLHR@HALBDHFDANEN@RALINEFIBMHGHEP@N@NELANTHLJHJJLHJCHIHODGHSXOPELQFKJCJDJEPITOBO@]AQLALALCNEPIJILCN@JDPDLBP@LBL@PCLANINIRALEP@LDR@RENMPSPQNIDIDKHELMFGNSHILGLGPERQFOAWBUFWJOJIV@NEN]JEJNNRTLJLXCJSFIKQ@SP[FgJKDIEWHcPCHE@CAEAGBEAI@C@@@@B@B@@E@CACAAACGEGEGAACCCAG@CAECCAACAAAACCCCACAC@CAC@C@C@CAGBC@C@C@A@E@@ACAAAACAAAEAECCAACCCEA@A@@@@@DAHBDBFBD@BB@BDBD@DBPALCJ@BALAB@H@DAFABADCDCD@DCDEDADAFAFABCJCD@@E@ICQKIGA@CAGCA@KAE@ABCBEHAF@DAD@B@D@B@@ABCDIFSHIF@BEL@@IF@@[DCBOF@@ABAFC@ABIAAAMCCAM@EBGDCBKNCB@@ECECCC@@@CAEACAEKKAC@AAC@C@IAGABABCBCBCBCBAD@BABABDFAJEDCBEJAFAP@@ABSNB@OPABEN@@MNWD@@OBEBABC@ADEFC@C@EBMLABMJKFCBIDEDA@E@ABABADAJBBTFLLFJDPLBLAJAFAJADAD@HJBHFFJ@NGNCLHHHJLBHJF"]],encodeOffsets:[[[83185,33405]],[[81169,33315]]]}},{type:"Feature",id:"542524",properties:{name:"日土县",cp:[79.732427,33.381359],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@HAHAJAHAHAJCJAHAH@HAD@FAHAHAHAJAHE\\ERC`@NDRHVRZHHFLALENKJEDAB]TYAWJYTMLSHMP@NDHRNAFQBKHWHCBKFMNC\\CjBFAHGJMLIHG@A@KDkCWFSPKREPE\\MXSHWNOPi\\GHI@_DWDC@WHEBKHEJARINKLKNGT@L@NOJOL{xUZafEFEP@LBX\\FNBDBHEBmEQ@UDUTOPGJKT@ZNRt¤BFENKVGLGPCPERANFZJhB`EhIPEXDLJJFJ@F@JALEFyRAB@DBFHfBNBJJv@HEPCHEF@@MHSJUJGHGDODI@GAUDIFMFKFGJ@P@NRRDDDJCHIJBLDLLHPALIHEHBJJRFZAZ@R@\\FNJBBBBHF@BLLXTPRJJRJLAJELGTGJ@DBFFFTJDJ@LBJTBHHLJDNAJMHGLEZKH@NFLJ@@HJBLBXBNDHHPHVDTDFHFNNJLDRBLHZLV@JDJFNBLBJCH@D@L@JDDHHD@TCvGRBNDHHNH@@@B@BABADCBABCHJTJHABABCDABABC@AB@BABCDCBCD@BADAD@DA@CBCBCDA@BBBBBB@BADCD@BAD@FADCBAFCDA@ABADEFADB@RHHDDDFBF@F@B@BDAD@D@B@DDBBBHBHBDBDBDDB@D@D@DAF@B@F@@@BABA@AACBCDCBE@ADAB@HAB@BDVRXJVDVLTFVDTFPCJMNINMRCLBNI`CZAXDRFPHNLHHJHRDPDV@TBRBNBPCJ@XKXMT@bHZJXJXFTFbBVBlFVDT@TMPFPLJXDHRHLEJGFMXKV@XFXFTMXMhCfDVD^\\LRHJTFRIdIVFV@\\BbB\\FXTNHLJTXLHNJJJPJBPMLKLGHCLPXLTDVFPJVJRHJN@JL@RANUPQNGPHLbFNLJBXFB@ABALGXBLATAN@DCF@D@DHJBDBHDDFDBBD@@@@D@D@DFFHJ@HBFDDJB@F@D@BAB@BB@BBBD@H@@HJFJJFDD@DABAB@JDJFDDFCDEDGBCFO\\@FAFCB@DDDHDF@@@BB@DCDN@DAL@DBHDF@HBB@HADAF@JAHAB@B@D@DBDAD@DADCB@B@BBB@BBBD@B@BCT@H@DBJBFBDBDBB@@@BBHXLFB\\@F@DB@@@BABAD@BDBBFAFER@DHBDH@FDFBD@FAFABG@EBGDCDAF@D@NAJAFADBBFDBDBD@BB@FBL@F@BDCLEBGBCBCFCH@B@BBB@B@BBBDBBBDAB@B@@@BDFTDL@B@BCDOLCFAD@B@BTJ@@@BB@BBHLJP@@DDDBJDFDVTDBF@ZKPCHCF@J@D@DAB@F@t|VDDDHRHJHBDDDBBDBFBBF@DADB@DBD@BCFCDGDGBCB@FBHEL@DBBZHRFHBHJDH@HBFBDDBJAN@HAP@DBBBBFADD@XAZGPEL@FDPBN@JBNAFADC@@BBHL@BA@KFGDGDKFEFCBC@EBA@@BAN@NADKJGFADEJEFCBGDEBEDGFCDAD@@ABCDABABCBGFEHEDADABCDABABCBCBAB@B@BD@BBB@BBDBBD@J@B@DBDBDB@BD@B@B@B@DAD@D@B@FBBBDBBBBBB@B@D@D@F@DBBBDBJBD@BFFFFBBBBFDDFBBBB@DDFBBAF@B@BBBFBBBB@FDDBD@JDD@B@PAB@D@F@D@H@B@H@B@DBD@H@F@D@H@F@B@B@BBD@FBDDBBBBDD@BBBBD@@BBFLJHJFNTHBHBDBB@@DGJ@DBBHBBB@FDDFDNHFDHAHADABACKCQ@GBGDEDEDAD@@@HAPAD@JAD@DADAB@@@FABADANCB@FAD@D@D@FBF@BA@A@CAA@E@EDA@@FADAHAD@D@DBHDHFDFBBBBDDD@HAB@B@FABAFAFAHCF@@ADC@@BADAFADAB@JFNBNLJJRHVATMLIHG`@VBHKBUFKPEVEPAHKGQCUVCPAXAV@V@T@TAZDVD^D^D\\BdD^GXSZIVEZ@ZJJDXGJILMLEVAjBfAZBJC@@B@FBB@B@@B@@@@@@A@ADABABABGDEFEHAB@@ABA@E@A@@B@@@B@@CJ@@@@B@F@H@N@B@D@FBFBFBD@@@BBBB@D@F@B@B@B@@BBDDBB@D@BAD@@CB@B@@D@BBDB@@@BBBBD@@@B@BA@C@ABEDEBA@AB@@BBB@@B@D@B@B@DBB@@@BRRRRJJbGVFdLBEHeNAPAVIFMTO^EfHRDPCHKJQDQJWPUHA\\PPDRCLCVCD`NHVF@@@@B@HAB@BABB@ALBFAHALEDCD@B@DDD@LADBB@@B@LBBDDDBB@D@BADAB@BABABCFAF@DBDDBBBB@BHHBBDBB@@@BA@AB@@ABAB@@ABABAFCBAD@F@FAB@BABE@ABC@A@AD@B@BBD@HDHBJDD@DAFENCJ@JDH@FADCDAF@DADEBCDE@G@EFGLGPGLCFEDEFGLCJ@L@@ABADCJKDG@EAK@EEMAE@GDITUDEDAF@DBFDF@P@DBJFJ@J@FADCDC@@D@J@FBD@DAB@J@B@HA`B^@dC^@bA^BVDZJbRPH\\RXBv@\\LPBPB^E`GPEXETBRHDDNBPHVFf@HALAFBHFFHFLFPFHFD@@BFFHDDHBJBJDDBF@D@FGBAJAEC@ACC@C@C@ADCBADABAHAFAHCD@DCBADCAC@ACCCEAC@C@ABC@CAAACAA@I@IJQJGNKPETERITGVMRKTBNFTF^FXDTDVBX@RBZCPAVANAPCTBTBT@TAZAZ@RARGZDVD^A\\@TGRELCRGHGJMBOGWKMSGEK@UCMBO@S@KHIPEHG@SHIBOJUDOJOHMLOLWJMFMHIVOJKLMHW@OAM@KISES@MAUBQAM@K@KDM@ODK@OBKBMEKGMISGM@KBKMQ[GQCQAOCMGGMGIEKCOQMOCQEMEH[HMHQBOFS@KDQHSFKBKCKOOOICA@AAE@@AA@ABA@AFADADC@A@ABAPIPGLCFCD@J@N@NBHAFALAD@LAF@DADCBA@C@C@CBE@CAAEE@A@@DADAHABADCDADBBAHAF@DBFBF@LBF@D@D@B@AAAAAC@ABCBCDABA@A@AAAACBAAACGCEAA@AB@DAH@BADABAACACC@CAEAEAEEGCA@CE@AA@EBA@C@CAAC@E@AACA@AA@A@ABADA@A@AIACAA@AA@A@EBG@CAAA@CAAAAGAC@@AB@BAB@@A@C@CACAE@GCCCAAAC@ACA@ABA@E@CBC@AA@@AAA@E@AAECC@A@@BC@CD@J@D@DABABADCDCDABA@C@K@ABCBAFAB@BBB@DBF@D@DAHCBABC@CBAD@DABEAA@ABAB@DCBA@CDCHGBGBC@CCC@AB@BABA@ADE@AA@G@]BQAEA@A@AFEAC@@FELADCBABC@C@A@AA@AAAC@ACEAAAAC@AAICGAAA@CAAAAAA@CBA@E@EDMDIBCACBC@CBEAG@IB@BABCHGDEFEDABA@CAAI[EMCCIIECACB@FGJGBA@@A@AACACAAGBGBADCBA@A@AAAACAA@ABCFE@AB@B@JBDABCBEBCDEBA@A@ACAOCCEC@@ACAACKICGCECECE@IACACCCCEEAGEGEEAECAECGGOEGAGESKOAAC@E@E@AC@@EAAAAA@AAAE@@@CBABA@AAA@CCA@@@A@@BABABA@@B@@B@BBB@AB@@GBA@@B@BB@BBBBB@@B@BA@A@C@A@CBA@AACCA@CBA@A@AA@@@A@@DA@AAAAAAA@@AA@AAAC@A@@A@AB@DA@@BAA@AA@@A@@A@ABAD@BA@AAAAAAAAAAAAEAACCC@AA@@AABABABOBGDGDGFIDEHIFMJMHIDELQb]FGHULUBEHOFKAGBIDGNONSZQbULI@CCGGCGAMEIAM@ICC@GBC@CAOUEICAGE@ACC@KAO@EFIDG@EBE@GBCDABCBE@CBCDIDCDIBI@I@AAEEEMEIAECCEBA@CBGCECEEM@CAEAACCGCAAAA@I@C@@@@@ABA@ABCDE@EBIBCL@B@@CAABCBC@CAAGACACCACCACAEBIBC@@ADEBC@CAE@EBEBCBCDAFAF@DADAD@F@F@B@HED@D@D@DBBCDEDC@ABAAACAC@@A@ABC@A@A@ACCE@E@CAC@CCCCAACAKCE@GAKBC@E@EAEACCCCCAC@CAAAGGAAICC@AABAJGBABA@CAACECCACEI@A@@@AHAHCFAD@TMFCBA@C@@AAEACAC@CGCACAEACCA@@A@AB@HEDA@CDCDAFADABABABEBCDCDAFCHIFIBC@A@GDCDCBCDIDE@C@C@CC@OCA@AA@E@G@AAAEBA@AAAEAEEIACAA@CBABA@C@C@CCE@A@ABABABC@AAEAAQGEAE@KAE@KAE@CAAC@ACCCCCC@CAE@ACAICAA@@C@CFEBE@MCICCCEAC@C@E@GBECGAEAE@EBC@CB@@E@E@AAKAMBEBE@EBIJABGFE@IAI@KDI@EDIJMNIFCBGBC@IAGCECE@IAGEEFADCBC@IIECEAG@EDI@M@SBYBC@IEOGQDIBKDSHIFE@CAEAECGEUE_EOESOWOOGC@AEEI@@ACGGIOMMGCKIEIK[ISMMECG@UJEDGDKFMBC@IAMEKGOOEE@C@K@A@C@CCEC@G@KDK@Q@I@ICGCOUCCGECEBGm@MAm@K@c@G@E@AAC@CCEECEEEKG@@CCCI@G@ECQ@EAI@G@E@EDCDEBABIBGFE@EAGEGWWWOMKKIMGECAGAGCEEAAC@CDGBE@GI]GW@MEeMOACEGGEOEUAO@QDYDWFOBKAA@MACA@@@@MGCCIACECCAEAGAEAEEI@A@C@ALGBEFKDCFCDCFADCFEDAD@D@BA@A@EBEDEDCFAHAFAD@FBLBFBFBD@F@F@DADCFEDEBEBEDEDCFEBA@CBC@E@CBA@@DADABCB@H@B@J@DBFJLJFFDDB@HELG^GRGNGHEHIBCB@F@FAFAFAF@F@HDBDBDBBBDJFDDBBBBBBBAFADEDAFCD@F@L@FADCB@@AD@D@DBD@DCBCFCDCFAHAFAJAFAJCDADBF@D@B@BABCBC@I@G@C@AACCAC@G@CACACAAACCACBEDE@ALILOHKBINcFQ@KBCB@\\GBCJaD[FELSNST]VcJOJKDGP_X[lwHIDCFEDEFGBC@ABC@CBC@ABABCLKBABCFIAAAGACACACECGECAMCCAE@C@C@I@C@CAC@ACCAAC@ABG@ADG@@FIBKCOG]@EBK@CAAA@AAGEIBIEOICGAKEIOC@AI@E@KFOBGDMHIBMAEBEDEFIBK@CBCBA@GKGBIDIBMAG@[DQBCAEAC@C@A@OFKDA@GDA@A@A@EN@BATAFABABCDABEDOFOFADABGBC@C@GFA@EFCHCBEHCDCFABEJ@DCH@DCJMPGDOBS@U@I@E@IFGLCBCBCBEBCBADAD@DADABEBEBADADBF@B@@GDCFCBIBCBEDCBE@G@GFA@EFWLCDE@KGSO_KEGkgIMMIWSYMSIWAYBG@OKEAE@KFIF[HKD[BGJQLCLCBCBEHAHGLGL@@AR@FADA@A@G@C@@BAB@BKFOHQFOFQASAKCMCOMOIMIQIUIUCWAOC[GYDOF[DSFUJSFMBOJENEL@NAPANENKFMXOJUAKMOEQKWI[OKECEAGE_AMFEDIAEAEEGACBIDA@E@@@@AAAACACACACAAACACCA@AAA@CA@@@A@A@A@A@CA@CEAACAACAA@CAAA@BA@AAA@A@ECGCIAC@C@EAC@CCCAAAAG@CAGEIGAAKGQMECEEGAC@CCGAI@EAEAEBK@EB[AMBSHE@CAAAC@EBKASDI@[JGDABA@CAC@C@GBCBAD@L@DA@C@A@GAEBGBODG@QGG@C@EBKBCBCBE@IBGBIBIBG@IBG@A@@AEEKECACAAEAC@AA@C@E@IDG@C@CAA@E@AA@A@ABABCBEFCDABGBABAD@B@FABABCBCBCDABABWACAC@ABCB@BABC@CEGAA@EBCBCD@@ABA@@AACA@CCCACAABC@A@CACCAICGAEBCBCAEACAA@CBEDA@I@EBEACBC@CAA@C@EBA@CACAG@ABCDGBEBA@G@MACACCCAEBGBCBA@E@C@AA@CGCCCEAAACCAAA@EAGAC@CBA@@A@AACEECCECCGEICACAA@ABCBC@C@C@A@CA@AC@AAG@C@C@A@KAA@K@OFMFOBGESEKBS@SCKAOGKDSNUDUHE@@@C@A@@@C@@@CCADAB@F@NABADAFABCB@@@DAB@B@BBB@B@@AB@@C@A@ED@BA@A@C@EAC@CBC@ABEHGDA@C@A@@@AAC@@BABBF@HADABE@IAE@ODKBMCQ@KBK@IAG@YBO@QAA@CD@DJPBHADCBGFS@G@KBC@AFCJIJCHFHJL@B@DADMHGDMFCDFZDFHJBDCFMHKHSF_HSHaJQFOBG@GCIIOOCCC@SJKBGBaHYDSFIFKJCDUHOHWHIFEDMFIDO@G@M@OB[DMBQKCM@IAU@QEOKGUAULYT[@KDQAYFQ@UC@@@AA@@@@A@@@A@A@CAC@C@A@@@A@@@AB@@ABA@A@A@@@A@AAAA@@AA@@A@AAC@A@@@@CAAA@ACA@@AAA@@AA@@@A@@BA@@@A@A@ABA@A@ABA@A@CB@@@@AB@@A@@BA@A@@@A@A@@@@@@@AB@@A@@@A@@@ABA@A@A@A@A@A@@BA@@@A@@@ABA@@@A@@@@@A@@@@@A@ABA@ABA@@@@BA@@@@@@BA@@@CBABABA@A@ABAB@BA@@@@B@@BB@@@@@B@@@@ABABA@AB@@AB@@AB@BA@@@A@A@@@A@AAAB@@A@@BA@@BA@@B@@@@@@@BB@@@@@@@@BA@ABA@ABABA@AB@BA@ABA@C@ABC@A@CBA@A@ABA@A@@@A@@@@A@@@A@@A@A@AAA@@@A@A@A@A@@@A@A@A@A@ABA@@BA@@@AB@BAB@B@BA@@@@@A@A@A@@@@@A@A@@@A@@@@@AB@@A@@@@@A@@@A@@@A@@BA@A@@@A@AB@BA@@@@B@@AB@@AB@@ABC@@@AB@@@B@@@B@BA@@B@BAB@@@@ABA@@BA@@@C@@@A@A@A@@BA@@@AA@@A@@@A@AB@@@@A@@B@BA@@@ABA@A@CB@@A@C@A@ABA@C@CBA@ABA@A@CB@@A@@BA@A@@@@@A@@@@@A@A@ABAB@@ABAB@@@@ABA@@@A@A@A@@@ABA@A@@BA@A@A@A@A@@@@BA@@BAB@BA@AB@@@@AA@@A@@@AAA@@@@B@@AB@@@@@B@@BB@B@@@B@@@BA@@@@B@@@@@B@@@BA@ABA@A@A@@@A@ABA@A@AB@@C@@@A@@@ABA@ABC@A@A@C@@@A@AA@@A@AB@@@BAB@@AB@@A@ABA@A@AA@@ABA@@@A@@BA@@@AB@@C@A@@B@@A@@@@BA@@@EDCDCDA@ABIFGDIHKDMBIBSBOA@@GBE@CAC@CB@@C@MBCBA@CBCB@@EB@@CB@@AB@@ABC@@BCB@@CDCB@@CB@@ABABA@@@ABA@ABA@IBIDEBIBCDOF@@@@@@AB@@@@@@A@@@@B@@AB@@AB@@@@@DAB@@CB@@ABAAA@AB@@A@@BA@@BA@@B@@@BADAB@BABA@ABC@C@A@@@A@@BA@@BA@@BABABABCBEDAB@BABA@ABABCDABA@AD@@@B@D@D@B@D@D@B@@AB@BABA@CBA@A@AB@AE@CAA@C@A@A@AB@BA@@B@@@B@@@B@@@B@@@BB@@@@B@@B@@@@@BB@BBBB@@@@BB@B@B@@BB@B@@BB@@B@@B@@@@B@B@@AB@BABABABABABA@ABA@CBAB@B@@@B@@B@@BAB@@@B@B@@@@@B@@@B@@@@@B@B@@BB@@B@@@@@B@@B@@B@@@@@@@B@@@@BBB@@@B@@@BA@@BA@A@@@A@@@A@@@@@A@@@ABA@ABC@ABC@ABABA@@BA@@@CBA@A@@BABAB@B@@@B@BBB@B@@@B@@BB@@AB@@@B@D@B@@@BAB@BAB@@CBCBA@C@ABA@ADCBABAD@@AB@@A@@@A@A@@@@@AB@@@B@@@@@BA@A@A@AB@@AAA@AB@@@@EAAAA@A@A@A@@@A@@@A@AAAAAA@@A@@@@@A@BD@B@BAB@@CDAD@@AB@BABABA@A@A@C@AAA@CC@@AA@AA@@A@@A@@A@@@@@@C@@A@AACCAAAA@E@A@A@CFABA@ABC@A@C@C@@B@@@B@B@B@B@D@@@D@B@DABABCBA@EBABABCBADA@A@A@CACCAAAAC@CBADAD@DBBBB@BAD@BA@EBABADADAB@DBHADADEBED"],encodeOffsets:[[81064,33805]]}},{type:"Feature",id:"542525",properties:{name:"革吉县",cp:[81.145433,32.387233],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@PDXBVDVJdőA@_Bļ"],["@@HGFCPIDCHEHGDE@CBEB@BGDCDAJCFCBAFC@AFCBAFE@@DCBCHU@@@ABCBCDEDCHEDEDABCFCDADA@CBABCBADCDCBCB@F@HHJJ`PPHVBLKHMDGBM@IHIFMHANALCHELKAUEKLINGV@TIJOC[LMJMPINGTA^BTHJBPBNGHCNGZETB`@JIHKFIBQDSDUCY@Q@_XUHCDI@IAKGMIOCMACGSCW@ACKIQGKEKCO@KQCME@CIECK@IAIFIOEICAOGMQIGMGWKQSYIOMCMDE@KHKJQ@KHKLEdKNMBUA[ISGKAOAOBGHGLGPEPEPGPCTF^ERDDJNTNNHJH@NIHSB]DWDSFMDKHCFGHMNERMJQDO@WASDG@KFEH@LAH@NBLALENGJ@FEHFJDD@HKRQJIHGDKPINEFYIIGI@OKEAA@AAE@CBEDGBCD@@@DBF@DABIBKDGDCBCCEIAIBCFCDA@CCCCCC@ACECCCIEEBIECAE@MAABAC@EFEHCBAEAOEIACBG@CAMCKCACAC@ECECKECBEBAJAH@@GDGFA@A@@DABC@C@AGK@G@CDGBABABCBCBEACAEAGBEB@BIAGCCECIA@CC@ISCS@@DOBALEB@JK@ALMBEACOUEO@@]RILEBABQBCBG@C@I@G@[@EAEAGAGAKAE@QDE@A@C@A@AABDBD@BDD@B@DBFADAF@D@FBB@D@D@BADADAFAD@@CDCBCFCDCBABGFEDABEDCBA@A@A@ABABABAFAFAH@B@BABOJABEBA@ADCBAB@DAJ@F@DBBFDBBBB@DABADABABC@EBC@KFEBGDMBADCBABADAD@DAD@DBJBFBDBDDHB@@XAH@F@BCDOBKF@BDB@F@J@@AB@BABA@GBC@C@A@AACAA@A@A@A@ACIMMKECCEBM@GACGEGEG@IBEDCBCFIBG@ICCBC@A@@CCA@CAC@CAACACAA@AEAAA@A@ABEBCBG@CBC@CB@@AJA@@BAAA@@ACACAA@A@C@C@CDCEEeEEMAWDSBYDKFALBH@LGPILGLIFYPGRCHK@WGKFGHFPFH@HCJKROFMFGHGJKLK@KCGCSBICUEWIIGIIIMKCODOFODIFQAKBEDCF@HEPHHAHAPIHGHCHDHLJLPHPAHIDM@G@IDOBOBKEKAQLODQGQIKDOAKFSNGPMPKFU@Q@UESCCJCJENCLBL@NGLGJEDBHNNBHAHQBU@M@SDSJMNGJ@PATEXELMAcASKYMOMIJEJCNANBNCNGPILOPEHKHMDSCSGIEIEEIIE@IBOBCBICGICQEQDKHGJILCHILOLIDKHEDDHHRIRIDOAGOQ@KJ@H@R@LBLAL@PMHIHK@AGIIQQWAG@II[QIKMHSJSF[RMBUEQ@QJMFGPONMNELCFLBPDFJELKPIJSNGDERGPMBKCACOGKGKBIDG@QIUAKC]EGOMMQFSPKCOCBEDQGIMKGQQIQFUDOBBHDLFNOJKHELGTMNQBUGEQCC@@BCAA@AACGEC@C@IBC@E@IBABCBA@ADCB@DAHLFBBBDBBDHBDBDBFBBDF@HCP@JBHJPILCJIHCHG@ODCFEPIDMJQ@I@IDKFQHOLKJMLQFIBQBSNCJBLLTCH@HHDNFDNCJIFEBMAEFBNAJOHKDGBEBG@EAEICICG@IOASDOFMaIMECIBCHEDEBI@]EQIMEG@OJGLSHOFODMLQJIJIPAFBLHPBNKAMAQLGHKTELFHNFBHLFJFDFGLAPANEHKHGHCHAJ@HHD@JBJEFEFIBEDKFCJHHHJFHHJHH@RBHEHKBOBG@OLCAKHCHAJCFGBOHK@M@ODKHMJIHBNLLNPHHDJILIFKHCJBJAJBLBNCJIFGHKNGLLHDJBHDDJJNDHHFBNDLDJBJBLBH@J@NHDJCRDNDLTJHBLILCLBJ@N@PEJCHCLEBNAL@FFJJBLCPARELAFFDHFFRALBPFBLANNJDJPXXVPBR@L@P@LFXPNHJDRDRBRALCNALBJDDHFNJVLPFLGJKDIBGB@FBHBJEBGBKE@@AA@AEAAAAECCE@C@AA@ABCAA@GAA@@CAK@ABCBE@GAEAIKAAA@A@A@EFGFCDIPAL@DAB@BBD@BEFAB@DBB@DAD@DQZ@BHNFHBB@BVPDDDBBBBBHPBD@D@D@TDFAF@HCBIJGBCHCFIJCPAJ@NGRGNILOTEHGTATAL@LAPCL@PIPGXAV@BHDb@hFVBD@^ZHHRXDLGLGJEL[RGLBDBLFJ@FARAJKLQDMDUJIDIFOH]HE@GCECMCI@IFEHEFAFAHGTCJGHIHADBDBD\\VHDPFDDAJBJBDPFFBBHAHCNALGP@BCDCJ@D@DABAB@D@DBF@@AH@HADCB@@@BA@A@C@Q@C@C@C@EAGAAAE@CAC@I@CBEDA@C@ICIAGCAAA@CA@@A@@CAACE@CBAAK@AA@AAAAAA@A@C@C@ADA@AAA@AA@A@KBCB@@G@C@CBCBEFIJGDGD@@A@GCE@C@C@G@C@IBABABC@G@C@EBC@ABA@AACACAG@C@A@C@A@AACE@@AA@@A@@@CBEHCDEDEBABI@CBA@E@EAE@A@CA@A@AA@E@GBC@CAC@CBE@A@A@@BAB@BCBEF@BAFCDABABC@AB@F@D@F@BAB@@E@GBCBCBEDIDGDIBCB@@CBA@@@@BBFB@B@DB@FCHCDABC@C@AB@BCBCDEDA@A@C@G@A@CBABADAFCB@BABA@C@A@ABABAHA@EDIFGFA@C@CAA@GBIBCDC@C@C@A@A@CDCDEDEBI@ABABCFABA@CC@@@@A@@DEBABA@ABC@@BCAA@KAG@M@A@EHABADABA@@BKBC@CBCDADCDABC@CAEAC@AAECAAAAA@C@A@C@@@EFGJABC@E@M@C@G@CAKB@@AC@AAAAAA@IAABC@C@DBDBFJDHFDDDFFBD@B@BB@DAD@HBFBB@BBDDBBFBDDHD@DBBD@F@B@DAHAFADBDDDBNBH@B@FAHADCBAH@DBDBB@FAD@B@DBD@DAFBFAJ@B@FCDAB@DBFBDBDAFAHBJDDBBD@D@BADBBBDDD@DDBBB@@AB@BC@ADAD@FBBFH@DADABA@AD@BBDBDAXABCBADADADABEBA@C@ABABAHCBEDAFADAB@B@BBBF@B@DBD@H@JCF@D@B@@BBDBFDBDBLFFF@BB@H@JAH@JAJAHAJAF@DADALAFAD@H@RHH@PCHAFAHBB@D@B@@C@KBCDAHAD@D@DBB@BAHC\\IJ@TCLBFAD@BBDBF@TGNA\\BFAL@FAFBFBJ@HBDDD@HBFFFDRNLHBBJHHFDBH@BBBBDD@DBD@F@DBDDJDH@F@BBB@BABB@BB@DBBBDDBBBDFB@@D@B@B@B@B@@DBB@BBB@DDDBBBDBDBDBDBBBBB@@@@@FCBAJBDFHBFBFCJEFBNF`BHDFLF\\PXJRLPFLNVBPINWLEFMBMBO@MFKFMPINATEVITE\\CPEZC\\HĻMACBSHEB[AMBKDOIKGCOKOQDIACBCAC@EBA@EACACAABA@ABEDA@C@ABE@EDEBA@CACCAAIBC@C@A@CAAACCCAA@G@AA@CAACEAABCBA@CAAA@AAI@C@CAA@GAGGSQECEAK@A@GC@ACC@CEG@AEGCI@C@C@GCCFEBC@C@ECECEEGAGCG@G@C@CBG@G@ADMBABAF@F@HBF@B@BDFFBBPBHDDDDBJ@D@B@J@ÂTLHHFR@PAHEFAJ@N@HHDJ@FCBCBAFBHDJBDHBL@B@xPLAj[JAPAX@G`GRÎpDBHBZDT@NCLCLBFDJHFDB@BFBJDNDHDD@DMJKJWN_^cCgD[N_B[HIFKHSHGDM@E@EAEAMKEAA@CDEBE@C@C@GC@@C@GAKDE@A@CAGAECGICAOBAAKBCAC@CCCCCCcŒRJNJPJPNNDLDTBRBPEREPGLE@ABA@AD@H@B@B@BC@EBQ@@HKHKBGFGDADADKRKHI\\ALC\\GJELEF@FBPLH@ZAXBTJZNXTNJJNlhFH`LTPLHF@DCXKFEB@HEH@F@DAFCDAJADADEHC@@@AAEBCBCFAFABABC@CBCBCDAFADADADAHKJEF@J@V@T@PAHCNODI@CDG@CFIBADEDCFGDADGFEB@HED@D@HABABCPEPEFCBADCBABABEBS@AFMB@B@B@HCB@LCPEB@D@D@FBDBRA\\CH@NBJAJCHAHLB@DADAL@JAFEFCFANBJANGHCPALEF@J@@BPDFJBLDHPJJFJAHFBBB@BB@DAL@FH^DPALEJ@@CH@BAH@BBDDBBDD@DBD@J@D@D@F@DBNDDBHFFDBDBDBDBHBBEJADABKLADAB@BAD@DAD@BADEHCFEFCDGJkxW\\O`CHILIPUdS^MTKTEFC\\IbAD[HA@AD@LERMdAJGLKPKJ@BCFAFBDDDBBDBDBDBH@D@DBBD@B@D@H@JADADABA@C@E@CACBIDEBIBEBGBEBCDEDADCDC@CAC@C@@BA@CDEBK@E@C@EDCBCFEBABAAAAAACCIEACAAACACGCE@E@EBEBEBE@A@ADGJGFMHQH]HKHGFA@CCEEKIEICAI@A@G@A@ADCBCB@@AB@D@FAD@DABEFCDCFAFAFCFEFCDCBE@E@C@EAEAKAEAC@EBGBEBCDCFAF@F@BABC@C@CBEFCDEBCDEDCDELAFKH@B@D@BFJBFBFBHBFDDDFJBDDNH@@@@DBNBB@LBPAXEZCRCP@VBPFHFFHBDNPFf@NHXJ^@HAFCH@DBDFBDFBHBHFDNHLJNLXPXXFHBH@FEFAHAJABCFCD@F@F@HBJ@FDR@F@HDJDD@@LHFFDFFFDDD@BBF@H@d@L@n@NBn@AHDFHFDDPVHDJDJ@R@L@LCH@D@DF@D@D@B@L@DFFPPLHNFJBD@NALEHCFCVIH@FDNNJTL\\FJLJHDNNJPHHBD@@FJBFD@PHXPTPPF`FVFHFFDFBDBF@JETGLCJARCPHJFD@ZATAN@J@FCH@FBFDJJD@DABCFEHFJBF@FDHDJBD@HADAJENMJIFCJ@LCJ@JBF@HEBAJIFAF@FANALBBBF@F@BE@C@A@G@GBCHED@J@DADABA@ACIAC@A@AJAJBDBBDFFDDF@DDFBZDB@F@HCDCDAD@BAB@DBBBD@DAB@DBDBB@BAB@DAB@DABA@EBCBABCBCDCB@BCHEHC@CDEJKFGDGDGDIDCDGDCDGFUDEDAF@D@F@L@F@H@FAXGDA@@D@DABCFGFCJQDKDGHCBCDIBAHIBAFIBEBCBEDCDAF@LDPAF@FCJ@N@FADCHILIHE@@@GHSDADCFCXCNGFCPJFFDABCFGDCHDRFD@F@DCHKDCLIJEFBD@FCFBFBF@F@F@FBBBBBADBDDBJDDBB@TITKLCVITITINEjQLEDCDEBAXOhWTGNI^Q^QVIBCDIBEAC@ADCBCBABABC@GBCBABCBABADAFC@ABA@@@@BCFEHKFGFENODCDEDKBEHIBEBGBI@GBIDOBK@C@E@CACACACCMAQEKCEAECGACCCCCCABADEFAHEDADCBABABEBC@AB@DA@AB@@A@@BA@@D@DABAB@BA@@BA@@@A@A@AAACAC@@A@@@A@ABAD@FCBABCBCBE@ABC@C@@BIAI@CBA@G@A@A@AAC@A@A@G@CBCBM@C@A@CBA@AFKBEBGBC@CBC@CBEBGBIBGDIDIDGBGDGPeTgLWDKDCBCBKBGAE@ADAJEBA@A@AECAA@CBAAA@AC@A@AA@ABCAE@ABC@ABABCPIJEDCFEFEFKDCBCBGFGFEHIDEFCFCH@DADCDABCDEHKBC@E@C@CEMCCBCDAFCFEHGDGFE@IDMBCDCD@FCPGJEBC@A@CHEDEHM@C@CDAFCFCBABS@CACCC@ABCCGGI@CCCAAA@NCDCHEBCBC@AACAAGCCE@@@ABBDBB@DAB@BAL@D@JKBA@I@MBEFG@C@K@ECGAC@CFQBK@CBABOACACAEEKIEEAACAAAG@sCEAEEMI]ISEIEE@C@CAAEE@A@@BAPEFCBE@E@C@G@C@CAACIACBCDIBEDGBALCDAFCFADC@A@AIEDMFIBC@AACA@EEAA@AFABC@C@AACE@KAECEAQCEGEIGKDC@CAG@EBEDCFCFABC@CDOFEDE@C@O@CBAD@D@D@D@@@BA@A@C@CDC@GBADADCBC@G@CDCDA@ACAECCAC@C@G@GACAA@ACECG@CA@A@CDEDGBAFABCDIDCJCFGFO@CCECEEEOOGGGIAA@ADAJCHAFAJAJCDABCDG@EBEBE@C@EACAM@CFIHEBCACCAEAMAECQKGAEAI@EAAABAPIFEJGBCBA@C@AD@H@FAHCDADEBCAEBEAACEGGACMECE@C@CFAHCDCDCBCAA@AAA@@BAFC@EEGAAY]QWKOMMAA@AEIMKACECCGEAMC@@ECOAAAAAKAAAAACAE@C@EBEAG@C@E@UBEBEBCBEBE@CBCBCDCD@HABCBABBB@DADADA@E@ECC@G@CBI@EBGBIBA@G@GCECGCICEAEACACEAAAAGAABEA@CCCCACCCAECAG@G@G@EBE@CBCLIJIAACBEBABGFCBC@A@IAEAQEG@EAAACCCAGCC@BBABA@@@AAAAGAGAIAOCMAG@EBEBOBGBG@GAKAG@EBE@GBE@E@C@E@E@GAG@C@C@ICE@MBC@GBCAC@K@G@EAC@KAE@M@C@C@C@CBC@SAO@C@C@MCICC@CAC@A@A@C@A@ICAAC@AAAAAACAAAA@C@A@@BCDABAB@J@DFN@FEHYRKFMPGBKCM@ACFOFIHIDENOLGHIAMAECICCCMHEHIJW@CCCCG@AHADAJEHE@CBGBE@ICGA@@C@C@ACC@CDC@GBCAECIACCAEAECEC@A@A@@CGCCCCGGOGMAGIJGHI@CISGMCG@ACCCCEIAC@A@@CKDGFILKLMJQJGBGNDP@TDNDPDV@JBP@VBPCLEVCJDHAFENGPALBJHPFLKHUDQDQDQCMASKMJSRMNG@KCECE@C@C@AB@AE@IAECICCBKBARBL@NEJ@JBLDBDBPFHJN@DABCDCB@BLFNBVDF@J@FDFDD@BM@GTGDGDK@C@AF@NITGXGTCJBZGBSVBPEJC\\ENEJENCTCPCNARCRAPDJGDE@KMMAAEKACCIEWACAYC[BKRmLKFKH]DMBADEDEDEDWAMAOE[@EDSFYDIDCNICAGCWIECAE@GGMAEBCBAF@FAFABCBC@CAAACEACCEEEGGGCI@E@CBABADCFEBABC@CDI@ECEE@GDGDCFIDG@EGKACBKDI@CAIMWSSGE@GBC@GBEBC@MBECC@CBA@EB[AUEEAWE@IAGAICEAGBAGKCCCIAMAAACAACA@AAA@A@C@AAA@AACAI@G@EAGCEAEEGAQAGAIBIDKBEJKBE@G@G@GAEDCDEBA"]],encodeOffsets:[[[83069,33517]],[[84794,31758]]]}},{type:"Feature",id:"542527",properties:{name:"措勤县",cp:[85.151455,31.017312],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@OUOEIUKOIQUWAKCSDMCK@MDKTQFOFOAOES_QOCWASEMBS[KMUIIEMEQDMLQTKHSLUFUFSAOCKGU@KCOIOEYESAQJIRBTCLQLOFSJUFSRIRKRKJM@ME[JULKHERIJSJONOJMJMLQPSHQJQRORODORETQNKPIJKPEVMLIJ]BSHMPIJOF@THRFLERKHUC[EcAM@MBMNCLIHQ@YOMDKHQHMFFRARMLHRNJFTGRAPGRDX@TCVEJDPINELTPFNDPBR@VGJADENEHONMHMJQN@TFPDP@@@BAD@DBFBD@FABABABGBEBMDCBI@EBA@C@C@ECA@C@ABA@ABA@EAA@EAA@C@C@CBA@EHABADELADIJADADKJIHCDCFCF@NCHGNADBDFDBD@DCDEDIHCFGHCDABABAB@D@DBBBDFD@BBB@B@DABABC@IBABA@@DADABABADABADBH@DAD@FAFAD@B@DAH@BABA@CFAFCBIDQBE@G@EAGEGAODSJIFIDEBA@CAIAEAYBCCEEICGAI@IBCDCFBFDDJDFFADEDYZABCBEDADAD@BBD@BBD@BABAB@DCHAB@F@D@DBDFLLFFBDBDDBDDFDFJFHHNL@@BB@BA@CDWLABAB@B@D@D@B@BCDAB@B@BBDPJDBDBBDBBB@D@F@B@FDHBD@B@@BBB@BBJ@F@@A@GDA@@B@B@BLDF@DBFDBBIFCD@FCHEDEBGDIBE@EDKHGF@@A@G@AB@B@BBJ@L@D@BDBDBP@P@B@BB@@@BCDADABCBSF@@@B@D@BBBHBDBJBF@BBD@BBBBD@B@B@LDHDLBJJ@BCF@B@@@B@@LDAFDVBZATBRJRJLXLJNNPHN@@@@KP@DRDDDDBBJBDBBFDHBHB^RBHCTALFDFFB@BDBFBB@@@BTLjTRFLL@PBHDFNNJHPLNRJDBBHFFDHFJH`Zh`FBL@B@DAB@BA@CBA@ADABAD@DAJ@DBD@DBBBBDDJBB@DABABABA@BBBBJHDBBB@BBD@DAD@BGHCDABCBABABCDABABM@CBA@ABEBMFUPSPCB@BAFABABCBCBCBABABCBADC@C@C@AAAAACCAAAA@A@C@CBEDCDAD@H@D@D@DB@BBD@FBDBBBBBBD@@@@BHBD@DBB@DDF@D@BAFAD@FBF@BBB@BAJADABABEBCDGFGJEBCDAFCDA@CBE@AB@B@H@DAB@B@@cK[IEFCFIHGDGDA@@DAFDRFJFHVXEDOLMD@FALBFNdJn@Bi^GR@jDDDJFJBB@BABABCB@BAB@F@DBF@BA@@@GBABAB@B@@BBNDDBBD@B@BBBAJEFEBCFERGDNA@IDaPA@A@ABC@KHA@ABA@ABBBAB@@CBAAAAA@A@AB@@DD@@ABCBABC@G@A@@@ABADAFAF@BAB@@@BDDDF@DBFABCBABA@±QABABA@ABAAG@AACAECECCACAC@C@G@I@IBA@GDC@CBG@E@EBE@GBE@E@EAEAE@E@C@CBC@CACBCDCBA@CBA@@D@DABABEDABADABABEBC@ABCDABCBCBABCDE@ABAB@@CBCBEBA@E@E@E@CAA@A@CDE@CBABCBAD@DCBC@A@@ACACAA@A@CBCBABADA@@BEAC@A@@BA@GD@@A@EA@@GC@@C@G@@BABABA@M@CBC@EBCBCDA@ABGBAB@BBDGBBBLLGLCD@DAD@HDB@F@D@F@FBB@DDB@JBF@DBLFFDDBB@FBL@FBBBR^BHBFDB¤LBBAFBDBBD@JBLBXLDLLNAJBJBBNHHB\\@HEFED@TNDHBDB@F@JC\\BFCLAXBBANKHEvOlPHHBLVVFFXLBB@B@B@F@JDFBFCFGF@DFNBHHJBDT\\JFNN\\RVPANCLCF@BBBBBDBJ@DBB@BD@B@H@BD@BBDBBD@B@DABED@B@B@DB@DDB@BBDAF@FAH@D@FBFBBDBBDBD@D@J@FADADAHAB@BBBB@FBBHBB@B@B@FA@@BBAH@@@BFABABAF@F@B@DCNORQRGLCRKBOAOHKRKXBX@R@TGNER@LATERIVKVHRJPDLCVM\\@VAXCPKJMZBPNBNKJSPCVCXBJDJHFNHTJVJNBL@VM\\KbCT@P@NDPDLFPDHD@@JBFBHBBBDDBBDF@DBBB@DBXLJFDNDBBBRAJA\\CHA@@HAFAJBDBFFFDDBF@B@D@BAB@DD@@NJVFTBdATMNKLKXGXCXGNEHUEW@IHEVCNQFUDUJILGLCR@POPGL@JBRNLPLVLJTJRCLGLGXG^EBICMCMDKCKBKFEHGLENCRELK@UBENBXFREXEjMNCPEPGBEQ[GU@KPKROFKNMHMBOBUDQLMLO@KJOHIPGLE\\BZAJINILCR@TIZELMTGPGXCPE^@VFPKLSNMNKRAf@jAxEPMGYAMHMRAVGPEVELHVA^APEPQPM^IPAVFVE\\EXBRATGTGTIT@ZD`DXH@BJBN@LKDKFQZGHGRGFOBUASAWCMDUCUF[RSNOLOHK@UAQIOCK@SBODMDIBAECYI±}M~WAR@H@B@@AB@BAB@BAB@B@D@B@BA@@B@B@@ABABA@@@A@ABA@C@A@@@A@ABA@ABABA@ABC@A@ABA@CBC@A@A@@@A@AA@AAAAAAAAAAAAA@@AAAAA@A@@@AB@@A@A@A@A@ACA@A@@@ABA@A@ABA@@@ABCDCB@BCBAB@@A@A@A@C@A@E@A@A@A@C@A@A@A@AAA@ABC@A@CBC@C@C@E@GBEBEBC@C@C@C@A@A@C@A@A@A@CBCBABABA@A@E@C@E@CBABCBABADA@A@AAAAAAA@A@A@ABCBA@A@A@C@AACCCAA@A@A@ABABABABC@A@A@AB@BE@G@M@C@CAAAAACCAAAAAAA@C@CBEBCBA@C@C@EACAEAA@CAAAA@C@A@CAAA@AA@C@C@ABC@CBCBC@CBC@@AAA@A@AAA@AAAAAAAAC@AAA@C@A@C@A@ABA@A@ABA@A@AAAAEAA@@CAC@C@EBA@A@CBC@CBA@AA@@A@@A@A@A@AAC@AAA@@AAA@A@@BA@CBC@@@A@EACAC@CAAAA@ECAAC@CAA@@A@AAAA@@AC@CAC@AAA@A@AAAACCCACCAAAAAACAAA@A@AAC@C@ECI@CAGCIAEAEAIAIAI@EAG@G@C@CBG@G@EBKBGDG@CFKDI@AFIDCFGFGHGJGHGDEFEFEJKDGDEFGFIDGDGFMBABEDGBEBGBE@IBCAG@GAIACAG@ACGAICCAGOaEMGKU[CEDGNUNQTQJKPQXEHIIMIO[CoGMHMDMLCFGFEBC@K@gAKAGCEC@ACC@ABE@AA@@CGGCGEEEICACCCEAC@A@CDCBABEBC@C@C@A@A@CB@DAF@F@D@FBDBB@DABAFANIBA@A@A@E@AB@DADED@D@HAFAB@D@BBBBBD@BBBDBD@@CBA@CBABADAB@F@H@B@DABCFGFCDAF@F@D@BBDBDBD@H@J@FBD@FAD@DCBAD@@@FBHDFBDBDARGHCD@BBDDFDBA@AJU@AIKBCBADADCBCBWLoNcBAL]@ABEAAAA@CBC@C@CACAEAC@A@AAA@EBA@CAA@ACCAA@CAM@ABI@A@AAC@AAA@@AMCI@EAE@KDM@A@C@A@M@EAC@EBA@EDGBCBCDCBAHEFCBABABEBCBCDAD@FBFB@@NGDCBC@CCM@A@ABANCFCBA@QCICA@@E@YJIDC@IFEBE@EBC@ABABAD@BCBCBABCBCBABAB@FABCB@@CBAB@BAD@BC@A@AA@ADG@ACAC@AAECAC@A@QDU@OQOGKYAKEEQCOIOSKYMc@UBQB@UB@@AAA@AFABCD@FAF@DAJCDADCDCFCFCFCFADCFEDCDAD@BAB@J@FBDCpÇBEBKDGFGDEDCBAAACECCAC@IBCAC@ECEACEGAAKAE@EFABEBG@CBEFCBC@E@E@G@EBABAB@HCPABEDKHABAD@B@H@@ABCB@@A@EAE@AACACEBC@I@ICM@OBCDEBG@GAGAG@G@GCI@E@CDEBCBKBCB@DAF@VD@@@@BIBABCJC@@DGBAGIACA@E@GC@A@A@ABADC@@@CCCAAAAG@DOAIGOQGSCU@Q@YDUEWESKUGOKAKSKECAC@ADAXQBADEFEFMDEDGDGDE@EBC@E@C@G@G@M@K@AAAKCEAC@CAKA@@A@MAC@E@IAA@C@CBC@KBE@C@C@C@M@G@E@CBC@C@ICCAA@EAECCAIAC@CAEAC@CAGAAAIAA@C@C@M@CAG@@ABAAA@ABAAAEAC@GCIACADG@CACCCGGBI@EBEBI@ABEBAHAHADAFADAHCHGDCDA@@FAHABAJCDALEFAF@HMDEBCGGEGCEAEAGBEACEAG@G@EBEBCDC@EDC@Q@GAEAECECAEA@@FCFCFEHEDAF@DBDBBADEDCB@D@D@FAD@F@BEBABGBCBEFIL@@A@A@A@CCACCC@@C@@@IDEBGAEAAAAAC@C@EDKAM@QVIPIRINQH"],encodeOffsets:[[87485,30577]]}},{type:"Feature",id:"542526",properties:{name:"改则县",cp:[84.06259,32.302713],childNum:1},geometry:{type:"Polygon",coordinates:["@@QGCINBH@HCGIEI@IHCJDJJJFLHH@FEAO@K@QJIJIPCNELCJCBGEEGCGAKBQHQFM@KEKAIAI@OAIAIAKAIFQHKDK@EAGAE@MFGFEHEFEDEFAGBIAECEKEKAGFGBAGDIDQDKDIHIBGFMFANCJ@JBLDDDLGNKJMHKPKHEREVCTCRCPEPCZUOCQBQDMBODSDMDIFMF[FIDOFUAATYHIASDWHSHMJE@@B@DCLCHSH@HANC@ECECI@E@UCMAKE@ADADCBA@CIMEGAOACKCIAI@MFK@QAABALDDDJBF@JBFA@@B@D@DDFDF@LMHQNITLNBTDNCRCRCRGVKLOEIGKAOBMHEFGBICUDKFODUAO@IAU@OCMCSCO@MCAHIHIRKNKLEJCHDL@@@BBDFJDDDD@BDHHNJT@DGJIHHJNBPHHHDDDDDH@@@B@BFDFDFBDBBDDJBFAD@HCD@DDD@B@D@DB@DH@JAFAH@DGFIFCBGB@BDHDD@DIXGJGFDNDDDJBFBNGJKHMPCFGJEJEPBDN@LDHANOLEZQFG@EEM@C@IBABADC@AB@D@B@BBDBBBBBBBD@BBJDB@D@B@B@D@DBD@JDNDD@D@P@TBD@DAD@D@D@N@F@LBD@FBH@L@D@DBHAD@NAF@JDD@D@H@HBF@F@D@F@F@HAF@FAH@LBHBH@HAPAFAFAH@NBPDJBHBHBBBBB@@B@BAAAD@HDDBDDBBFBH@RFFBJBB@D@DAHEBAFADABBIJKJAD@DAF@F@H@HBHFDDBDDDBDD@DFBBAHBBBBBDFDBFBFBJDHDFDHDH@B@JAHAFAJ@DAH@D@FDF@B@BCBC@CAABADABA@GDCDCDADAF@FADAFAFAVAF@D@H@FBFAD@F@DBBBBBLBBBBBPBFD@@NDFBDHFDBDNLFJ@BBBNNLPRXZ^BBFH@FEDAB@@BB@BBBADCDCDGDEB@D@DDFNFBDHHDFBBAFBFADCFCBGDEBG@C@@B@DABADIHEFOJABBBFBJ@FBHBRLFDNBFBDBBDADGFEJ@DBNBD@F@DAFAF@FCHADCBIDIBEBGBIDCB@BBBHJHHPPFFDFDF@DEPEHIDCDCJADEBABCHCF@D@BDBH@FDBDB@DBHBH@D@D@DBFDDB@BCBCD@D@HADCDCBAB@HCD@D@D@BAB@@C@C@C@C@AB@D@P@DCFEFCP@DADEBEDCDAF@FBH@DCDHLFJFHRDFBFDLBF@BD@B@DADEB@BBBFFB@BD@BADEJCNJF@B@BCDEBEDCBKDABCHAFCJADBDDJBB@D@D@H@D@FAFEDOFAB@@@BFFBB@D@DFFFJJTJ^FNBFDF@tBHBBBDFBJFFLBFBDBDAPAB@DALER@DBDDH@F@L@DEHAF@N@JABILC@K@ABA@CBA@CAAA@B@@DFHDBBBD@BADADGFCDMDB@BBDD@DHJDHAD@BDDBD@DATABEDEDCB@D@DGNCFGF@D@BADIFOHEDC@CDADCN@JEFCHGHEFEDCBADDDFN@D@D@FADGLCFADCBCDCBG@EDEDCFGJEFEHAHADCDELEFEFCDIFOJADAB@BAD@BBFAD@BBBB@D@@BBBAB@DBBFD@B@BABIFCB@BBFAHALADCDCLKXShOfCHAHCHCJCJAHAJAHAF@DAD@DADAHAFEL@BAB@D@B@DANAD@D@H@B@BBD@B@B@B@HAB@DBJAJ@@@DAD@BAFADADABEDC@AB@B@B@@@BD@DBBB@B@B@B@@AB@@ABA@ABCBC@@@AB@@@BA@@BCBA@@BADAFABABCDCBGFEBCFABDBDDDDBDDHBFDFFLBRDNBDBDBD@D@F@DALCPAJ@HAJAHAFGJAFCLCFCDMPEFEHGLEFAD@@@@AB@BEDCBABABADABAD@HADABABADCD@BBDAFCJADUJ]R]RMJSHgXWPABCFCDKFiRMFSJSJUJKDSLSJA@CAICCAACBCAAAAEAE@E@E@EAEAEDC@EAIFKJCDGLCDE@C@QEGCCDEHADCBEEOIEDMHWDEDCDCBGT@H@@GFKJGJCDEBM@I@EDE@OBKCE@CBCDAFADAFEJABGJABCJADGDCHCLIREDEHADCBC@@@CBWHEBG@E@K@E@C@E@CBCFEVCHCDCHCDCJCHCHEHILCF@DGDGFADA@CDADADABAD@FABCBA@CBA@ABA@CACAA@CBC@AACAA@ABC@CBCDGDE@A@YCEACCE@CCEEACCAIAIB@B@BBDDJ@BABCBCBI@C@GFAD@H@H@B@DAF@@DAD@FAF@FBHBFDHAF@D@D@FBDDJDNDF@FADED@@@BBJDDB@BBF@DDDDDDD@BBDDBF@LBF@LBF@FBRHBBBF@BADABAB@B@BDF@D@D@DABAB@DBBBDFJBFBFBBB@FABB@B@H@FBBB@PDD@@D@D@DCFCJADCDCD@H@BADEJGJEDCBCDADAFABABCBEBCBCD@DCBGFA@@B@BB@DDFBDBDBDHD@DBFBBB@@@DABEDSNC@EBGDGB@B@@@BFJBDDDDFBB@DABABIHABBBD@JDBBHHBBDBD@DBDDDDFBFBF@D@LAHBF@LDDBBBDDDDD@DBF@F@DD@B@B@BAD@B@BD@DBBBAB@BCDCFADCAC@C@C@GFA@E@E@C@CBCBE@EBCBADADAF@FBF@DADCF@BD@JAFADBDBBDDDDBHBBB@DADADBB@DA@K@ADAJ@FCFAD@BAB@B@@@@@D@JBBBBHDDDBBBF@DFNDFDFAH@DABDFFDJBNFFFBF@B@JAJCJCDCJAD@DAFADCBAD@HAF@FCHEJ@FBP@LDD@BHFDBFJPVDBD@HAD@JDN@JBNFHBHDDH@DKJaVYRMTMPCHAJBHELGPAFKVGVEHa^KRCFGJINENGJCFEJCHCHAHAPABABBB@@BBD@DDBBBFBBBBBBBBBB@BABC@AB@B@BB@@@BBB@AB@@CBA@@B@BB@D@BB@BBB@@BBBBBB@BCB@@@B@@BBB@B@DAB@DDBBB@DAB@D@B@B@@A@AA@AAAAA@@A@AB@HA@@BAA@AAA@@@@AB@BABA@AB@@@B@DDB@BBB@BADA@@F@BB@BBBBBFB@@BDF@F@D@BBLPFTBHFHHPDHBFFDFBHFHFFBDFDDBDBD@JDFDFDFDHLJBDDB@BD@DFPDDB@B@BABCFADAFADCBIAA@A@@BEFAD@BBBBDBB@B@BABCDABAHBHDBDBBBB@@@ABIHEHA@BDFDJJDDFNJ\\BB@DABCBEFCFGHADABA@@JBHAF@DADBDADCJCN@F@FAB@DBBBBBB@DBBHBJDBBD@BBBBDF@BBDBBB@@B@B@DADABCDKBEF@@BDEF@B@BFBRB^AH@B@@BCF@BABABA@@BDD@DADAHGHCD@DABCDA@AB@BBBAFCBC@AB@DADABGDCBC@E@CAA@AAA@EBABAD@B@L@DABCBCDCDABABCBC@I@C@@DAD@@@BDDBF@B@FBB@BB@@BAD@D@FAB@BDB@BBDBBDDHDF@DBDBD@B@@@BA@ABA@@BDBHBBDBB@BB@DAH@F@BBBB@DBJB@B@BCBAB@B@BBBB@BD@B@FBDDBD@B@FAB@@BDFB@HDFFFBFBDBD@BDBDABCBABG@CBA@@BBBDFDHBBABBDBB@B@BABCBADAD@BBDBBBBA@C@C@E@KAE@EACAE@GBABCACBCDABGBCBCB@@@BFFBB@DAF@D@D@DABCDCBE@KBC@KBEBGBMAM@I@C@EDKDOHOJAB@B@BCDCBEB@BAB@BBB@@BF@BDBPJPPDLALELGTCR@LETAPGRGNG\\NFRFPDRNDPFLHJHNNHPDRBRD\\HNRAL@LHNJTHNFLANAL@PCL@PCN@L@LBNARBV@NFTJT@LBN@PGXKNILUPGJENINKXKPGNIPCPIVAPGJ@TGHOFGJ@L@TAPDN@VFLTHLNHXAPINGHQHKDQFSH[@]BUCYCQHQBY@YBSBS@SASAODMBUBOBYDQAW@UASCWC]ESEMESAQLUNSHQJSFOFMLIHIR@J@JBBBDBB@DAD@B@DBDDFDD@BBDCDABCDC@GDEBGBABCBABCD@B@D@DDD@BFDB@HAB@BBDBFDF@FAPBFBBDFLDBFBJBFBBBFFLDHFBB@BA@CBAB@BBBFBDBDD@B@DAB@B@DB@DBB@DB@BBH@B@D@FAB@@ABA@AFAF@B@DBF@DABABABCDAD@D@F@BBBFB@DCBAD@B@FABA@G@ABAD@BA@AB@DB@@@@D@@ABED@B@BBB@B@@ADAD@BAB@FA@@D@FBD@DDFFHDF@@@@ADADEF@B@@BBPBD@B@B@DAFAB@BBF@JBF@B@@ABAL@PLRVPFRFZBLDTJXHTRLDL@bOHDFXBXJJXFZCNAPDXPRRJPHHRDRARETCVFTLPLNNBLENCNJTPPNPAVGHGPGTARZHX@\\CP@VDLPLXBXBLDNNPTJTF`FN@VA`OPEVIXGXBZNPFXEVGXI`AGTGPGLUTGdKVEXIVMNOXETHVRNHLHNFTJFLDLFNB@FDDBB@BBB@BAJCB@RCR@X@L@JBHAJ@RDXFNALGBMPEVANBZ@T@bARCNGCQIKEQAONSNMJOHKFK@SBM@OAM@KAMGIIEBQJKJMFOFMFQDIDOHKRKRE^@VCVK^KPQJMFSLWNKNITOPGLGZEZ@bGTE^F\\FVLTNPRPFRCPKRGZMZCfClBXETCXKNCTBRCJEP@XAVBX@TBDAHCFKFAPAFBFBBBFAHKDEBCFCL@R@D@FDHDBBF@H@DDBBBBFAB@BABCBAD@F@D@HABA@ABC@@@AACAAA@CWHEZFbD`HZBRCLUREXFRFTJTLLHRJPDR@^@hBpAhB^ANCJKP@dHVBZB\\@BBBBD@LBB@B@H@FBFBHDB@@B@F@DBB@@BB@BBB@J@DDDHJNJDD@FBBDBJFHDDBB@FDF@B@DADABCD@D@F@D@DBD@D@BBDBBB@B@B@@ABBBB@F@DAD@D@DAHAF@J@JDLDDBDBB@D@HBHBFBBBB@DBD@DADCDEB@ACBABAB@B@F@DBDBB@D@DABAB@BEB@HBD@DBFBLHD@DADAHEF@DAB@DGBADAFAFCDA@ADGBA@CBADCBCFCDGDCBE@EBEDKBCBABAD@DBD@HFDDHFHDDDD@DBD@L@B@D@DBDDBBB@D@DCDCJGFEHCDCBGBABAD@D@D@FAD@D@DBDBFBFDDDBBDDFFDFDDHJBBBBB@FBFBHBBBD@D@D@FBB@JFFHDBB@BBD@DCD@D@DBBBDB@DDBBBDDJDPAJ@D@F@DBB@FBF@L@BBNDLBDBF@FBH@H@FBLBJ@FBDBBBDDDDBD@BDBLFFDDBFDLFHDD@FDHBHDDBF@FDDBJFBBDDFDDFFBTJPHRFB@`FFBBB@DAJ@BBBDBBFDDBDFFBDBD@BARBDBBDBBBH@DBBBBD@DAFCJCBMB[FEFAD@DBHAD@DABBFB@BBLBLDBBJBD@H@FBHBFDFDFBD@DBRDN@D@D@F@TBHFBFD@FBRFLHlRZBH@F@F@HBLAD@D@DDD@DBNBNBDBFBFBHBFDD@FDDBDBH@HDF@BBF@JBDBD@BBFDB@H@D@DDHBFBH@FBH@D@DAFAFAJAL@LBPDTLHBJBH@FBDBFFNLNDPFRPB@DBPCH@DBNHRBHDDBLVDBHBXBDDBBBFBBlNRDFFNFJDJBBBFBFDRBH@DBBBFBNBF@PDLBRFDDTXHHPXFDHDLDhHHBnHDBFANClEJAJ@F@F@FAFAJADAF@D@JBFBF@D@F@FADALBF@FDH@FBJDDBDBJHF@LDD@FBF@JBF@BBHBDBDDD@FBD@DBD@JDFDBBBBBDADABCDADA@BBB@B@H@D@D@D@H@F@F@F@JB\\NFBF@ZABABA@CGG@C@CD@JCDAFAFAFEJAH@D@FAHADCHAHCJEHCLCFCLIBARUDCDCB@@@BCB@@ABA@E@I@ABA@EBE@C@C@CBABEB@BA@@@@B@BAB@BBD@BB@@@@B@FBB@D@J@B@B@D@DABAB@@@B@DAHCBAB@B@D@D@B@BAD@BABAB@D@B@B@DAB@B@B@DABAHAB@DAD@FAF@B@D@L@F@B@@@B@B@@@DADAB@DAH@B@FCB@D@@@B@B@@@B@B@B@DAD@D@@@B@B@D@D@B@BBD@B@BBB@D@D@B@B@FADAHABAB@BA@EB@@E@C@EFEBAHGDAHCD@B@PCFCBAHID@D@BADABABAFEBAB@DAB@D@DBB@BB@@BBDDDBHBD@D@DADAF@DBDBBBBDDDB@FBB@D@F@B@@B@D@B@D@BDBFB@B@@BB@F@F@HDDB@DDB@DBB@BD@DAHBD@D@D@D@D@D@@BBB@HDBBBBFHBD@BDDBD@BBD@D@DBBBBD@BBBB@D@FBDBBDBFBF@DBD@DBF@FBFDFDDHBDHDLHD@DBF@HAF@F@DBDBF@N@DBF@DBLFFFDDDBBBF@F@FBDBDBHBD@HBFAFBFAH@F@DAHBHDHBHFDBBBFBHBH@LAFCFAFAHAF@FAFBFBDBLBFDHBLAFBFBP@F@F@D@RDNBFBDBL@FARADAJ@F@LBD@DABCBAD@H@JJBBDBD@DBH@DBDDDF@DDDDFDBHDD@DDBDBDBDBDBDJDBBDBF@BBDBFJDBHH@DDDFBPDHDDBBDBFDBDBFBD@H@HABCDAD@D@FBDDDHBDBDBBHHBDB@BC@A@C@CACAA@A@A@ABA@A@A@A@A@C@@@AFEBAB@BADAFCBAB@BABC@A@@BA@ABADAB@DAD@F@F@B@DAB@DABADC@@DA@@BA@AEE@@AAECCCAA@@@CDC@A@E@C@ABAHCBAFABAFABAB@BAB@FCB@BABABABAFADADE@ABA@ABAB@DA@@D@@AFABAFCB@DA@@F@BAD@BAD@B@LCD@BBD@DBDBB@B@DCBADABCB@@A@E@CDABAB@D@BAB@B@@@BAB@BABABAB@BABABAB@@ABA@@@CBA@@@C@I@A@C@C@ABABABAB@FAD@F@D@D@BBH@D@H@JAD@DADAB@BABABEBCBEDABE@A@ABA@ABADCFAB@FCBABABCDE@ABABAFEDCFCBAB@B@DCBA@A@A@E@@@CBA@ADCBA@A@A@AAA@AAC@A@ABEBEDCBCDAF@HCDABAF@D@F@L@D@BABEKWAI@EAGCAEAA@CAC@ACCGAAACAAA@AAA@AA@AAACAAAA@AAAEEC@A@ADABABABCB@@E@EBI@C@ABCBC@C@A@A@ABABCBAAA@AAACACAIACAAAC@@@A@AA@@@ABA@@@@BAB@FEB@DAJABAD@D@B@H@F@BAB@BA@A@@BAACBC@@AAAAEAE@A@CAA@GBCAABAAE@EAA@AACAGCCECCACECKK@CAA@AAA@AAE@C@@@C@@@AAC@CAAAA@@AC@A@A@@@ABG@A@ABA@A@C@A@AAA@@CE@GAE@E@ABC@A@A@ABA@@BC@EBA@ABA@C@ABA@C@ABC@C@E@ABADGBABABADGBABADE@CFKBC@A@ABC@ABC@ABEBCBCDABADABAFADADCDAB@BCB@@@AA@AA@AA@@EAG@E@GBIDA@CBA@E@IAGAA@A@AACBG@A@EACAC@CC@A@A@E@ABABA@ADIDC@A@A@ABA@AAABAAAAA@AA@AA@AA@AAA@AAA@AAC@A@CAK@EAG@A@GAE@C@CAEAIAAACAGCAAGCCACAECEA@A@@DCBA@ADAFCB@FED@BCBABABABCB@BABABCBABC@A@A@A@CBCBEBAFI@A@CBC@AAC@A@A@A@AAAACAC@@@EAA@CAEAC@CAA@AAC@C@EAA@A@CBCBA@@@C@AAEAA@E@CBC@CB@@ABA@A@E@GBA@A@ABADCBADAFEBADGBABABABEBEDEFK@ABA@ABA@ABCDC@ABA@ABABADE@A@CBAAA@A@A@A@AAA@@AC@@AAACECCCAA@@C@AAA@A@CCCCA@AC@AAE@G@A@CBI@ABC@ABC@E@A@A@CAC@AAAAC@ACCA@@AAGAC@AACAACCAACCAACECA@AAAACAAAC@AAAAACACCCC@AAAAACCEGAAAAAAAAAACEACEI@CAAAEACCI@A@CACAA@ACCAAAACA@AA@AAAC@@AA@CACBGBE@A@A@AAA@AACACEEAA@AACBA@C@G@AACCGIGCCACCCEICECECEIOAACAEEACAABA@ADAB@DAD@F@F@HBL@J@HABABA@AAGAA@ABABC@A@CGG@AAA@C@C@G@C@EAE@GAK@EAECGACCICCACEIGQAC@CACACACAEACCCCIAEACAC@EBCCCACGICCIEEECECCGIEICCCAKGGEGGIICAAACAAACEAKAAAAECCAAA@CAABCDCBC@A@CACACIMACCCACCCAC@E@GBE@C@E@C@CBEFEBABCBGBAFEBA@A@AAAAC@A@CBC@CFEDCBABADC@ABAECACCCKGCCOIAEAECOAA@CAEBAACACCAA@MAC@AAACACGGEGEACAEAEAE@CAECAAACCCEECCCAICCAIEGEIGCCEEACACAC@C@E@C@CAKAEAGAE@C@I@EBGBEBCDCDGDAFCF@F@D@TCF@FAFALEJEDCLCFAD@D@DAJ@TGTGD@FAF@D@LBD@DAFAF@FAFADAD@BCDCDCDAFCFAHEFCLGDAFCFAFCDCHCDCBAHEBCFGFIDGBC@@BEDEBABCHEFCJCLADAD@JCDCDABADEBCAE@ABABCBCBCBC@EBEAE@C@E@EBEAI@C@EAAACCCAAAAACCGGGCAEEEECCCCCAACAC@C@E@C@CACCAACGEEGE]@E@C@I@EDS@E@E@E@E@CDIBAAM@C@CBA@E@CACEGBE@E@GDIBCBCJGFCBADCDEBEBCHSBCDEDCDADAHCDADAFCDAHEDEDCNUHKBCDEBCDK@EBCBE@E@EBIAE@EACAICCAEAECG@A@EBE@IBC@C@CBC@CAC@EACAK@GAC@CBKAC@C@GBABAFCFADCFCFAFCLGHEFCBADABC@@RKOb[jDGHQDGBC@CAaCe@Q_i{w]aAa@cAEGGOSEECKCIACMEEEAgFaLkTWXMHEHAl@XBfBqFiHu@GCIOMAM@GBGBAxJUR[DKdOSEUCSAUMA@OGUIIEKKGG@C@EDS@E@CCAAAGCEG@Q@w_AIOaSEEwImWYO]OMCsQiQeA[IQA@C@CBG@C@C@CBE@G@AAC@AAAAAA@CAC@A@EAC@A@AA@A@A@ABABADE@A@AAA@AGI@A@A@ABA@C@AACAA@A@C@A@C@A@G@G@EAABA@AJIB@BAFABA@ABA@@AAvIpFdRRFD@FBFAJANEDAF@H@dDJ@FAHAHAFAB@DAFMGWYU]KIIIQIeSUQYAIFCXINEDEDGAKCOCG@EUIoSC@CBC@A@@AAAAAEEAGCCECCCEC@AAA@CAAACCCBAAA@ABA@@FGNOBADM@A@AAEAAAAMIGKEICQKUGQ@A@CBCJMBADCFCDCDAFEDAHELCBADAHGBCDCFEDADAFADABCBABC@EA@CEQ[qWSc@WDqMSE[IWMG[EM[K_[[am[iOkA_CÿE]QmQ{O]iM_CkKooUyUgCmMeDgRUF]O[]OgOES[O[UOoIcKsKWSCAIWWiBUDSQKUIKUFaD_BI@GAIKWMYK_IYIQGQKMGK@SCKB[IScgKG_aQ[ScKQIK[UWQEMGSUYQS@SDIBWHYR[\\QhKVQR_LMVICQFSDIBSNO@S@OAMKIEICMFSNKNERSFODOKKUEGO[[IIKYVO@A@C@C@@AA@A@A@A@@BCBA@ABA@A@A@A@AAC@ABA@A@CB@A@A@EA@A@A@CHOFSJQBA@CACACACGGAAACOcI]IgCKBE@CBEFG`SBAFKGB[DIBQBAACACMIEWKCAA@AA@CCEAACCAAGAEAIA@@GCOCKEOCMCO@S@aD[LUNK@MAUISIMGGECIAIDWDUTOLIAMOMYAINOLWDUB[@UNKDOCQIUGULQJSFKBQ@MFSHQ@W@WAQLGLBPAPQLKDQHQRMPCDA@E@E@ABABEB@A@@BGAA@@EBA@A@A@GA@BGDMDEH@@ADABYNIDCBADA@EFA@AB@BA@A@EAEAE@A@]LEBEDC@KDEBCAE@C@EAC@E@C@E@C@E@K@MBELSHU@U@iDW@YFOJGH@ZNHBPIPYZEPAHDFBD@DAHA@ABEDCBABE@CBA@AB@@EDABGF@@AD@BAD@@ABE@A@@@ABEFEBABEBA@EFC@CB@@C@ABEAC@A@A@A@CBCBA@C@AAAACAAAAAAAEGCACAA@AAMOGGCC@EBC@C@CAC@A@CACBC@GBCBEFIDC@A@A@AAA@C@GBAFBBBBBBA@ABCBCBABEBA@A@E@ABC@ABCAE@ABABADE@E@C@ADADCFCBADCFEDCBCDIDG@CFGBA@ABCCIAEAC@AAC@CBK@C@AAAACCCAC@C@A@ABCBCDCDCFCFADCBA@A@AACOECAA@C@A@CDOTCFAFADAFABIBODC@A@CAC@A@AB@@ABC@C@ICIKC@CCAAACAC@CAC@CAKACACC@CAC@C@C@CBCBC@ECGACBA@@BAFAR@H@NAD@BA@AD@B@H@BAD@BCLCFADCFABC@CBCAAAAAAAC@G@EAC@A@CBCDCBC@CAIACACCC@AACBE@ABAD@DADA@CBC@EBI@C@ABCFABABC@A@A@CAIGCAICCAAAA@IAC@CAABOFCBE@C@ABCBA@CAGC@@GK@EAAGCCA@A@ABABC@CBEBE@G@CBA@A@@@ABA@A@AAAAAA@A@A@ACEECCCAE@AAC@CBAAC@C@EAC@A@EBA@@@@AACAA@A@@@AA@C@KEAAAAABABABCFABABA@A@EAA@GBGBGBEDCD@B@D@BA@@@A@C@KBG@ABA@@B@BDBDDB@KJMJO@S@OQIKMKMESCCCIGIQKGKKUAKBSDM@MCSIIEMAS@O@QD[FYDM@KAGCQMIKMGMDMLGJGBEAWMQKUEAAAACAE@E@IBGBGBEBCDCFEFIFGFEFGDEBEDEBEBC@Q@EBG@AAMGGEQCUGSKGAE@A@[VUH]BIBI@[AG@Q@aCYAMAQFUDYDIDIFKBKCGIGAYBiHOHMJOBW@OBCDGLGNGFGDODCDCLGRCHIBDJFLDF@FBLKFKFMJ@@[VSHGFEHCNCPITAFA@EA_Q_Q@@ACA@@AA@@AC@A@@@AC@AA@AAAA@@BA@A@AAAC@CBA@@CA@HIFELeCeC@C@KCO@QCWCSDMTEPEHCNGLMJK@GFM@QCSCOCMCKKMGIGGOCIOGA@IEICEAGAKHGDKCIGIAQIEAKIEIAGHGHIFEEKQ@CFMHM@OAI@CDFHLHFLKFKHGFEDGBGCKAGAKACDIHKFIFG@GAICMAECGAG@KAEAME"],encodeOffsets:[[84914,32396]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
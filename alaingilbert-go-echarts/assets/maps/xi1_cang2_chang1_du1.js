@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("昌都",{type:"FeatureCollection",features:[{type:"Feature",id:"540302",properties:{name:"卡若区",cp:[97.196021,31.112087],childNum:1},geometry:{type:"Polygon",coordinates:["@@CA@@AAAAC@C@SAABCFCFEBGBIBG@MAC@AA@@@CFCJCHEFGDE@C@CDEBAAEUGAE@CFIBCFIBCBCDEBCBEAC@CAEBCBKA@I@YCEACBCDEBGFEHORQLGF@DDLABEFMBIBEDABQJWDMAIGKLGTEFWFUCKBQLKRGLELGHIDKHUJOLSNERCJQNOEMCIHMAGMKEQCOLENGTKROLWHO@GDQPMXGTOXEJ[NOLMLULOHQTMRU\\KHDFDDFTB\\LNJLJTJ`DTNJNHVLXFV@PG`KdKbKRKPIZQNONGJCNAJFDBBNCTEPKVETARBD@@@@@@@FDPAPGJKDUJSLYTOLWZ]^SLKB@B@LDF@B@FBB@BBBFB@BBB@B@FBB@@HAB@NJB@DBB@@B@@A@ABEFMHiP]NE@@BCHCLAFCFCBGBCFCDEDCBKDMFC@C@CACAIECCMAC@C@IDE@G@CBKFGHABEBCBAB@BBDLHB@B@FAFCDAFA@@JFPVjvBPBBF@HALAJ@NDHDR@D@FDLLBFLZ@LDFBJ@BBBBBB@BBBBB@DJNXHN@BCDGBEBCDEBCBMCAADI@AC@WFABABDF@BFD@FADCDQNCDCBEAECKECACE@C@C@CA@G@AACCCAK@EBA@ABADBBBBBBFDDLBDFDXLDDNJFDDBF@D@@B@@@BABKDE@[FE@C@ABABAB@B@DBDB@J@D@DBFBJLDBDBN@D@DDB@@B@D@BBBBDBBBB@BABABCBCBCBCDCBCBADABA@EBABAD@H@DAB@BCFAB@F@BCBA@A@CBABAB@D@BB@DF@D@B@DGFAD@B@@BBB@F@DBBB@BABADADAD@B@DBDBB@DCBABE@ABCBKN@B@BHHBDADABIFIHCD@@CD@D@B@@A@ABA@E@C@ABAB@@@B@B@BABABCBG@E@AAA@CD@B@DDDDBBFAH@D@HJTNJFDD@DADCJCJ@N@@B@BCDGBIBIF@DILCDC@G@C@CB@DABAB@BAHADABABABBFCD@BABBF@F@B@FABC@EB_IGCC@C@@@ABADA@ABEAA@CAAAAAMBGRKRGVAZBPFT@LDHBFBABE@ADCBCLEHGFIHIJGNGLCJCJA@@F@B@J@J@H@J@D@HBBDBDDP@@FJJNJF@@DBJBHAHCH@B@J@@@D@BB@BBB@@BD@@DDLHFF@@DBDFBD@D@D@FAFCHCFCDEDMBGDABAD@D@FABADEDCH@FBF@@ABEBEBABCDAHAJAFBBAJ@FABADCDEDKFGBAB@BABADEDGDEB@BA@EJ@HAL@HCHAF@HAPAL@HBDBD@@@@BBDBBBD@F@DBDAJBP@NB@@BBDHFNFFFF@BB@DFBDBDAD@BCDEDEDEDGDGBGDEDEHADAF@@AFAFAD@FAFADCBEDEBIBMDC@@D@JAHAFAJKPEHA@@@AD@D@D@HDLDH@BBF@BABAB@BABCBCBCDABABABA@ABC@EBCBABABAD@D@B@BBBDDDBDBDBBBB@B@BABABCBCDABAFEBABAB@DAB@FBJ@@BF@B@DAB@DABADABAB@D@D@B@BBBBB@B@DAB@@@B@B@BBBBBBBDDBDBB@B@D@D@DAFEFCDAB@D@DAF@FAD@FA@@BADAFC@@DEBADAB@D@FCB@BCDCBABAB@@A@AB@BCBA@@@A@@AA@AAA@A@A@CBA@ADCDE@@B@B@B@@@B@@@B@@AB@BAB@@@B@BADABCBAB@@A@@B@BBDD@FAB@BCDAJCHAJAB@B@B@J@BB@@@DBB@D@FAD@F@@@B@B@BBBBFBBBBBB@B@B@BB@D@HBB@DDDB@BBDADCFADCDCDEDADAHAD@B@DADCB@BABAB@B@B@HAB@DADABA@AB@@ABC@@B@BAB@@@@@B@@@@BDD@BDBB@F@DBB@@@DB@BBD@@B@@BABB@@B@B@@@@BBB@@BBB@BB@@@B@@BB@@@@BBB@B@@B@@@B@@B@H@@@BABADABCBCBE@E@EBCBC@CDCFCFCFADCDAFAD@B@D@BBDBBBBDBDBBBDDBBFFDDFBFBDBB@D@F@D@B@D@FBD@H@FBF@FAF@H@D@B@B@DB@@B@BBDBHBDABADABABA@ABCBCBCDCD@DC@@DADABADAB@D@DBD@HAD@D@DBD@D@B@B@DCFABAD@D@D@H@D@B@DBBBBBBBB@DAHCBAFAFAFCDCDADAD@DAB@BBF@BBD@DFD@BBB@B@D@DBD@D@H@D@B@D@DADADAF@D@D@DADAFABADCBADEDCDADCDAB@BAB@B@@@@BBFAD@D@BBBBBDDDDDD@@FHBBDDBDDFBDBBD@DBDAFAHCFCHCFEBADCD@B@F@@@H@HBD@FBBBBB@BBBBHBFADAF@DAB@BABAB@@GFCDCBCDEBCBCDGBADCDADCDEFAB@B@B@BBD@DB@BBBAF@DADBB@BBBBDDBH@FBBB@B@DCF@DAD@BAB@BA@C@C@C@CBA@ADCBABCBC@ABABAB@D@F@B@B@BABA@C@@BCBEDCDAB@BA@@BB@@B@@@DA@@FB@@JAB@B@@@B@@AB@B@BBB@@@B@BB@@F@F@DB@@B@B@@BB@B@F@D@B@B@BABAB@B@D@@@@@BB@@B@B@B@B@@@@B@B@B@BABCDCDABCHAFCDCDCD@B@@@BBDDFFJ@@DHBFDHBD@@FFDDDDBBBB@@@B@@ABCBA@EBC@C@C@A@A@A@A@@AA@@@A@@@A@@@A@A@A@@@A@A@A@@@ABCBABA@@@A@@BA@A@A@ABA@EBA@ABAB@@AB@@@@BBBBBB@B@B@BA@@B@@@B@@@BBB@B@BB@@BBB@BED@@ADCB@BAB@DAFAD@F@HCF@DAB@DBBBD@@BDBDBHBFBDDDDDBFBD@BAF@D@D@DDJBHDHBDBH@FAB@D@@@BBDBB@DBB@D@BBFBD@@BD@HBDBF@@BF@F@D@BAB@D@BBBDHDDBFBDBBBBBBJ@HBD@DBBBBBDBBBBBBB@@F@H@LCHADAHADAB@BA@A@EACCCAC@A@C@A@A@ABAB@B@BBB@FDBBB@D@BABABEDABAB@FAFABADAB@B@DBDBDFDDBBB@BBF@D@B@@@FCBC@@C@@@A@C@@AA@A@ACEC@AECECIE@@@A@E@A@@DADCBAHE@AD@@@@AAC@AAABC@@BA@@BAD@B@BAB@FCH@B@@ADE@@DC@@@ABC@ADA@A@AECKEGMEMDIHANEFQAQBEDANENEHEDGFQFMBGBQDQFOFKFOHQDM@IKQ@@@A@@@A@A@@@AD@BABA@A@@BABA@@@AAC@@@A@A@ABA@C@A@@EEAAA@CAAACCC@CAEAAAA@AC@AACCCEE@ACC@@CC@@BABA@ABABA@APIZOZOHCLGLIDCBCBADABADCBC@CDCDEJCHCDADADCBA@AACEKCI@O@EGGAA@GBCBAB@DABAB@FE@AAAA@G@GAQGEAC@C@EAC@E@@E@CAA@@ABC@CBEHGDEHCBCBA@G@ABOFA@E@CBABA@AAA@AA@E@A@@BC@@AGAGAGBE@A@CACEEACAC@C@CAACE@AAA@CAABIBABC@AHEBAB@@A@@AAA@GCC@ABCA@@DABCDCDC@GDGLITQJMHKBE@C@CEIACEACAMCWAEACAACBCHCJCJ@V@DA@G@IBATONQFIJKPUDQ@UDQNQNG^U\\S^GXCNAfCTCLQFMD[PMRDX@j@XARCJGHGDC@YBGDGBIAMEMBAFE\\UBADKDEDAB@PFDAFMJIBEBMAK@EDIDMBM@EBCJCJAFBDBH@LCPIBABEDGDEVI@Q@IB@HBL@NHFDN@VDVJJ@PELET@FAFADEBCBOBADAR@JCRKFEHKXUDEBGBCBA@AAC@A@A@AFGDABC@A@A@ACCKKCAKAAACAAAIKAAA@A@@@ADABCBAD@BADKJ@@IBE@@DADABEDEDADA@GDC@CAA@CA@@E@CBIAG@O@EBCBCBADAB@@A@GCGAE@GBABA@AAC@A@A@CBC@A@@@AA@A@A@AGBG@ABC@O@A@GAC@Q@G@AB@@A@ABABEBIBABA@CAAAAA@@@CBA@A@A@CAAACIIGGCGC@I@KCICEAAA@@@E@@@GAEBCHOJIFEBE@AAAEA@AAABCDEBCJOL]RKLQN@ZMF@LBJ@XETAJENCPANEJAB@@WDMFO@EBAPDJANAFEFCB@D@PJJDHDJ@TEhUJELCNALFLALCDCBEBE@@@A@A@@BCCAAA@@@@@@@AB@@A@AB@@A@A@@@@AAAA@A@@A@@AC@@CEGAG@A@CBAB@DCDADCB@BCDAB@AA@@A@CCC@@AA@DEBA@ABCBABADCBADABCDC@ABABA@ABADEB@B@@AA@KISGQOWEgKYSKQKUK_KeM_II@CBCIFC@CAqSOGUGECEGU]GEECC@ABCDKH@HAFEDYLIDGDGBI@G@I@G@QLMDGBSAGBKBODGBQHOFG@MAIBKHGFIJCBE@EAIAGBIBEDEFGJa|C@EBC@ODU@GAE@C@ENADCDGDEDIFCDKHCBCA@CEI@CHM@GIIAAE@GBC@GDIBE@A@ACCS@O@E@IACGKIIIOCAI@DECIOEOGGEICI[NU\\YPSLQDY@U@@CMGM@@@@A@A@C@A@G@A@AAA@AA@AAACC@@CC@ACAECAAACA@@CAA@AAE@@@AACACCCBB@@BAB@FABADAD@B@@ABBF@B@H@DBD@B@FEDCBABCDADABC@AIIWCYCQHUHQNWDIBIBOE_GGEIW@KFMAAQsEGCGB[CCCAICGBKAA@MCEAAA@@DMFK@A@CBADAHEDGFCDEBCBK@@DC@A@ABA@EFOHKDU@E@CAC]QCCEAKAGCEAC@GDG@Q@SGECCEKKOGC@EDEBADCB@DBF@BCBCB@DAFDFFFFF@B@LADCDM@QCSGA@M@C@"],encodeOffsets:[[99557,31509]]}},{type:"Feature",id:"540322",properties:{name:"贡觉县",cp:[98.27097,30.860099],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@IDK@A@@BA@AB@@AB@BABCBABABA@A@A@@@ABA@A@E@A@CAC@A@C@A@CAAAAC@AAAAA@@A@ABG@@@A@EAC@A@A@A@ABA@ABABABCB@BA@C@A@A@AAAACCA@CAA@CAA@A@C@CBABADADCBABADABADCBABA@A@AAIAG@A@C@A@AAC@A@A@@@ABC@ABAAA@@IBGBEAC@EACCGEGCICICGEEIGEAACAE@E@KDMFEDE@GBGBGFONGDYDUA[MeI[C]BQB[J]NQHIFKTEVKP@@SHSEOKGIGUAQPSDMAKYKUESKY@UJQHQF[NOPSPYBUFUCMMWMSGMGIaBcCYGKGQE]IGmJADMPS`OfO`KXCHE^IXOXMTUXY\\QZ@@CDCFEDABCAC@A@ABAJCBABCBGHADCJADABCAGAIAC@ABCDEFEDGDADCD@BBJ@DGHEPABCN@DAB@BAB@B@DBDBBDF@BBDBBFBDBPJTLCT@ZDLDLTZPFLFQNKHOBGHMHMLKVkpMNEXNRNRKVKZORSAUASPOJIHYXSPYVA@WJQJURK\\EXG^WXYJWNS^OLYNg@QRQTWT]RCFADLLLFJNERG^QV[JQDEBQFBRHPFHPRPZANAXWTOLCDCDGFABCBABALABCDA@@B@FBD@@@BA@A@ABC@ABED@B@HBD@BA@GBC@A@@BABAFCLABADAD@B@J@D@@ADABBFAJ@BAB@@IDC@ABGH@B@D@BABA@CHAD@DA@AB@@CBC@E@EAE@G@@@e@k@aEWCKDEHAD@DJJN`LfL`LVLRZThLXFRPTHLJB@@BA@A@CFAB@BABAB@BCDADCBABCDABABAD@BABCFB@@BD@DDB@@@BBA@CBADA@CDCBCDA@AB@D@BBHFH@DD@@BB@@@@BBBBB@@@@@B@BA@@B@BA@@B@@@@@@BBDBBAB@BABABADABABADABA@@D@B@B@BBB@B@D@DAH@LCD@R@N@LAJCBAB@BABCBIBCBC@@BAB@@BBBBDDDFD@A@@B@DCHIB@B@J@D@D@BADABABABCBCBAFEBABEBABAFCFADCBC@E@AD@D@HBDBBBNHBBD@BD@BBFBBBBDDBBJBBD@BAB@BCDABGDABAB@DCBQ`QVG^@XBFDFXPHFLFDBB@D@FCDCBAD@FFB@D@HEDCFAFDLD@ABA@U@CBEDCBEBGLQDCDAFAPIHMBGBCB@P[HILUDARGNFLLX@NILMRQTUHOFIDSLQLIJGJILGJDRCTQFMHOLY@@NKZOXONKNITCP@NAVOLSHSJIREFAFCRALBPHX^JDPF\\DHFDFDPDD`SF@VFrLX@VERIdKVMJC\\AbBTAFAFAF@F@HBFBHAHAH@FDDBJBLAL@FELIHCHCFCNGNEHEFEBCBAD@D@ZHPJDDBB@FCFCH@DJ@HAFC@@BEBIDCPGJEHA@CB@@A@@BCB@@CBGBCD@BCFEBC@C@E@EAK@AEICECEAI@IAE@@@CAIAEAA@ACEAECACECEECCEAGACAE@C@A@@AAACAA@C@@@A@@@AB@BABCBA@C@C@C@ABABCDCDCLG@@DCDABCDADADADAD@DAB@BABAF@FADADAB@B@@A@CBA@ABCBADABADAB@DAHCDADCJCFABABABADGDG@CBA@E@EBA@AB@BA@@FAD@B@F@F@B@B@BABAFCBABE@CAE@E@CDC@E@CBEDAFBHCDE@@JGDCDCBEFCJCD@H@H@DAHCLCNCHCJALADCFE@@BO@E@EBE@EBCBEBCBIBCAGAECAGCAAGAI@E@GACA@@AC@CBC@CCEACGCIAC@G@KEA@EA@@E@CEAC@EBG@@@E@ADIDEBE@@DE@C@CBG@EBE@CBE@@DEDCBCDC@ABCBC@C@C@EBE@E@CCCACCC@@BE@CBEDCDADCBABABAF@DAD@BAFE@@@CBC@CACAECCACCEEEGECC@@AADK@@BC@G@EFAFCDCBA@CAE@C@AACAAAC@A@CACAAAEBE@E@@BC@ABADEBGBC@@DADEDCBABE@@@A@@@GAAAC@AAABC@C@ABC@@@CBA@ADCBABABEBADEB@@CBADE@@BABEDADEDE@C@E@C@C@G@E@A@E@A@AA@AC@@AABC@@@E@ABC"],encodeOffsets:[[101340,31150]]}},{type:"Feature",id:"540321",properties:{name:"江达县",cp:[98.21843,31.499202],childNum:1},geometry:{type:"Polygon",coordinates:["@@AACCOIYGC@C@ABADEFGFMFMHEDGDGDKJEFK@KBIACAECG@GBGBEAGAE@E@EBEBSBaA[BIDUNcLQJUFW@qKUEE@_TCCCOCEGE[COEICW]OGKAQBEDEBQFIJGTKTUPMBO@SDMJMLWPYPML@@KZGPENSRQDICKHIJIHKJKRCTEJGPSVQRKNMJW@KKMEQHCBKVGJO\\A@ADAHGNOJEBCBCDKRAHAFCDAF@D@VAB@BKCECEBCDGFC@A@EEC@ABCDEDC@A@CAKEGEWOCEAE@WH]RUR_DA@CBABAHCBADC@ABA@AACIAAACCAAAAAE@AACC@AAMGAACAGAC@C@@B@FADCDEBEDABABAFABEFABADADABABCBABC@C@I@A@A@GJCDA@@@@BECCCACAA@AA@AB@@ADADAJADABA@ABIDKBM@Q@C@KDG@CBC@A@A@AAA@A@C@@@ABCBABABCBABABABA@ABAD@@@B@B@@AFAFCDKDKBKEMBKDIFgVSFI@GCICOIC@A@EDEFMBIBOCAB@FEPCN@XA@IBMFOBMDIFSBWFI@KAE@YNM@KRQLK^IPADCFADBB@BFBBB@BAFEFIJGPADBF@H@@@F@@BBFBJDLDJ@D@DHHHJJBDBB@D@B@BAB@D@@BBBBDBB@BAJAFABABAB@@@BAH@R@D@HBB@P@D@BAH@HA@B@B@BBB@@B@D@DAB@B@D@BBB@BAHAF@HBHDB@@@BABCDADAFAP@H@JBDAF@@@DBB@DBD@HCB@BCFCFCBABC@CF@JA@@LIBC@ABCDABABC@@B@B@BBJLBBDBBBLBDBLLDD@B@B@BADCBEH@B@B@BBD@BABADAHCFWVGLEFQLIDQ@CBABAPADCFEBEBS@KFOFI@UIUCM@ECMGK@GAA@@J@RUJCFCHAFABOJKDG@CAEAIBIDAD@FANCNCJ@FBLANAFIJENCBOEA@CBCFCLAB[VEFABFNBNAJCHAH@ZCDGHIHQDWBi@W@QCONC\\ENKRSDeDMBWD]H[T]VMHMRCR@VCROVILEJMRSPAB@J@HCBU@I@IDGDADBDDBFBXBNDDBFBBDFJ@D@DAFGLINSRKJCH@HCDCDADCB@@DBBAD@HDB@BB@@@BA@ABGF@BADABAJBB@DBB@BDFBB@D@DBDBDFFBD@D@BAFBHBHBH@@AD@@@B@FBBB@BBB@BADAF@B@PEBAH@B@DADAFGHCFGDAD@BA@@BB@D@FF@D@FBD@D@FBRHHBH@B@BB@BEFA@ABCBA@ABAD@HBBHH@F@PDJFLBD@BABCDCBCBGDIDCFCD@DADCDABCBABADCDKJKHGDYPYPOJ@BABAB@BABAB@@DD@@DD@BFFDDBD@BBDB@BBFBDBD@DDBBDBB@BBFF@@@B@DAB@B@B@B@@BD@B@@ABAB@@@BABABC@@B@@@B@B@@@B@@LR@JCNGREPELEPCRARAHENERCHGFMFMFCBAFBRERMFGBCJFNHNLFFD@B@BCB@BAD@B@@CD@@CF@BA@G@EDA@ABA@C@AB@@AB@@ADBB@BBD@B@@C@@BGFABCDCB@@@B@F@B@@JFFDFD@BFDBDB@B@@BD@B@@@D@@@ADEDFBBDDDFHBDBBD@DBF@FBHAB@DBBBBDBF@F@FAD@DADAB@B@BBDBBDDHDD@@@@@@BA@@@A@@BA@A@A@@BA@@@A@A@@BC@@BA@@@A@AB@@@@A@ABAB@B@@@B@@AB@@@@BBB@BBBBBBBDBFAF@F@F@BDDBB@B@B@D@BADADADABAD@B@DBD@D@B@@ABABC@CB@@@B@B@DBB@BA@CDABADAFAB@D@B@D@B@DABABCFADABABC@A@ABA@@B@B@BBDBBBD@BAD@@@BABABABABABC@C@C@A@EBA@CBCFABCBC@A@C@CAGACACBC@C@A@A@ABCBADCBAD@B@DFFDDBB@H@DBD@D@B@DBDBBDBHDDDHDFBDBBB@B@HDBDBDBD@DB@BBD@F@@DFBBBDDDDDDBBB@@@BA@A@A@EBE@E@EBABCB@BA@A@@@@@@B@@@@@@@B@@B@@B@@@@AB@@A@@@A@@@A@@@@@A@@@ABC@A@ABA@@@A@@@A@ABABCB@BA@@@AB@@A@@@@@A@@A@@@@A@@@@@AAA@@@A@@@C@@@@@A@@@A@@@AAC@@@A@A@@@A@@@A@@@A@ABCBCBAB@@@@@@@B@@@BBB@@@BD@@@@BB@@@@BBB@@@@@B@@@@@B@@@B@B@B@@@B@@AB@@@@@BAB@@@B@B@B@@@B@@@B@BB@@B@B@@@@@BBBBB@@@B@@@BB@@BBDBBBDBBBB@@@BBD@@@@BBB@@@@@@@B@@@@@BB@@@B@@B@@B@@@B@@@@B@@@@@B@@@@@B@@@@@D@@@@BB@B@B@@@B@@@BBB@@@@@B@@AB@@@B@@A@@B@@A@@@@AA@@@@@AB@@@@A@@@A@@@AB@@@@@@AA@@A@@B@@@@AB@BABA@@B@BA@@B@@@@@BAB@@@@@@@@@BA@@@@BADAB@@@B@@@B@@@@BB@B@BBB@@@B@B@B@B@DB@@B@@@BBB@@BBB@@B@@BBB@@@BB@BB@@B@@B@@BBB@@@@BB@D@@@B@@@@@B@@B@@@B@@@@B@@BB@B@BB@@B@D@@@B@D@@@@@@B@B@B@@@B@@A@@B@B@B@B@@BB@@@B@@@B@@@@@B@BB@@@@@@@@@B@@@@@@@B@@@@BB@@@B@@@BBB@@@BB@@B@@@B@BB@@B@@@B@BBB@@BB@@@BB@@@@@@@@B@@@@@B@@AB@@@@@B@@BFBDB@@B@B@@@B@@@B@BBBBB@@@B@@@BBB@@@B@@@@@@@B@@@@A@@@@BA@A@@@AB@@A@@B@@A@@B@BA@@@@BA@@BC@@BA@@B@@AB@@@@@@A@@@A@@@@AA@A@@@@@@@@@AD@@@B@@@@@B@B@@@@@B@@@B@@@@@@@@@@A@@@@@@@@A@@@@A@@@@@A@@@A@@@AB@@AA@@@@A@@@@@A@@A@@A@@A@@AB@@@@A@@B@@@@@@@B@@A@@@@@A@@@@@@@A@@AA@@@A@@@@B@@ABAB@@AB@@@B@BA@@B@@AB@@@BA@@@AB@@@@A@@AA@@@A@@BA@@@@B@@@D@BA@@D@@@B@@@B@B@B@B@@@BA@@@@B@@A@@@A@@@@@ABA@@@@@A@@@@D@B@B@B@@@B@B@@AB@@@@AB@@@@A@@@@B@@@BA@@BAB@BA@@B@@@@@BA@@B@@@B@@@@@BB@@@@@@B@@@@B@@@@@@@BB@@@B@B@@@B@@@@AB@@@@@BA@@@@B@@@@@@@@@@A@@@A@@@@@@@AB@@@@@@A@@B@@@@@BA@@B@B@DA@@B@@@@@B@@@B@B@@@B@@@@@D@@@B@B@@@B@@@B@@@B@@@@@B@@@@B@@@@BBB@@@@B@@@@@@AB@@@@@@AB@@@@@@@@@BB@@@@@B@D@B@@@@@BB@@@B@@@@@@BB@@@@@B@@@@@BB@@@@@B@@@@@B@@@@@B@@@BADA@@B@@@@@B@@@@@B@BABABA@@B@@@@@B@@@@@@@BAD@@@B@@@B@@@B@@@B@@@B@@@@B@@B@@@BB@@@@B@@@B@@@@@@@@@BA@@@@@A@@BA@A@@@@@A@@@@BA@@B@BAB@@AB@BA@@@@@@B@@B@@@@B@@@@@B@@@@@B@@@@@@@BA@A@@@@@@@@@@@@B@@@@@@BB@@@@@B@@@@@BA@@B@@@B@@A@ABABA@@@@@@@BB@@@@B@B@@@B@@@@AB@@@@@@@@BB@@@@BBDD@B@@@@@B@@BB@@@@B@@@@@@@BA@@@A@@@@@@@@@A@@B@@@@@BA@@B@B@@@BAB@@@@@B@@@@@@A@@B@@@B@@@@@B@@AB@@@@@@@B@@@B@B@@B@@BA@@B@B@@@B@B@@@@@@@B@B@@@@@@@@@B@@A@@B@@@B@@A@@B@@@@@@A@@B@@AB@@@BA@@@@B@@@@@B@@@@@@@@@@BB@@@@@B@@@@@B@@@@BB@@@@@@BB@BBBB@@@@B@@BBAD@D@@@@@B@@@BAB@@@@@B@@@B@B@@@B@B@@AB@@@B@@@B@B@@@BA@@D@@@@@B@@@B@@@@@B@@AB@@@D@@@B@@@B@@@@@B@@@BAD@DAB@B@@@@@B@@@B@@@@@B@@@BA@@@@B@@@@@@@@@BBDDHF@@@BB@@@@BDB@BB@@@@B@@B@BDBB@@@@B@@@BAB@DA@@@@BAB@BADABAB@@@B@BA@@B@B@B@B@B@BAB@B@D@@@B@@@B@D@DBB@B@B@B@B@@@B@@@B@@@@@B@@@B@@@@@D@B@@@B@@@@BB@B@BB@@BBB@@@@@D@F@F@B@FBDDD@DBD@B@B@J@HAHGDIDEJATHLAB@BBD@D@DABABADC@CBAAC@A@C@ABCBCBCB@BAB@DAD@DBD@B@N@DAJBFDHDNEBADAB@B@LBB@DAB@D@F@B@@AB@@C@C@AHCBA@@B@DAFAJCDAB@BBB@B@FBLB@@@@FCB@B@B@B@@@B@BBBBDB@@D@BAD@B@B@@@B@JAB@DAB@B@B@BCBAD@LBD@DABCDEBCBCB@BABA@A@A@@BAB@LAFAB@B@@@B@BBFABBB@DBB@BBDAHADADEBAD@B@D@H@D@BABCDCBCB@BAF@BADCHEF@H@HBD@FBBABGAEHILEBKBCDCF@LBH@FEHGBEHAPALBDABA@@CCA@AAA@AA@ABA@E@ABADCBAB@D@BABAFAB@B@D@F@BBB@BDBB@@B@@@B@BEBEBADABABABADAB@DBD@HFDB@@@@BB@@BB@@@BB@@BDBB@B@B@B@@ECG@CFED@JAFG@C@EHCFCJAHAJDH@BCDANADAFCDCD@@ABABCDGHADBFDFADIBGFGFAJCH@D@DCAACKBEA@@AAA@@A@@ACCCCA@@AAE@C@@BCBE@EBABEBEBIBCBC@C@ABA@C@ABA@CBC@ABABCBCBA@ABCBABCBABCDA@A@A@A@ABAAA@@CAAACAA@A@A@@AA@AA@AA@@CBA@AB@BCBA@A@@@CAI@AA@@A@@AAAE@A@@@A@@BAFABADABABAD@D@DADBB@BAD@B@BA@AB@@ADABABA@@BCBABC@@@A@AB@@AB@BADAD@DADCBABEFEBEFEBGBE@CBEBAD@DCBADODCDABE@@@ABCBABA@ABEDCBABABABABC@C@A@C@A@ABCFG@A@C@CBA@ABA@C@EBA@ABAB@B@DBDDDBB@BA@@@A@CAEAE@CBC@ADAD@H@F@F@DABABAA@@AAA@ABCBAB@B@B@DBD@BAFCFCBABC@AAAAA@A@A@ABAB@D@D@B@F@F@B@DCBADGBCDCHCDCD@BAFE@@B@HKPKNI@@NIDCBA@A@AAA@AAA@ACE@EBKDEF@TEBEBCJCJAFEFCBIAECCGAAA@CDABCDGACECAG@EDADEAODCBGAAGCAAAAA@A@@A@AAC@CD@@@@A@C@@A@CAA@AA@@@CBABADAJAJA@A@EAC@ICGE@GCAAIGOCOEAICCCI@EJGNMDK@GBCAC@CDGDCBEBAHABAFACGKG@@EI@EDCBAAECCA@@ABCDAB@FBB@B@B@F@D@@@DABAD@DBD@F@FC@ACCCGAE@CBCFGDEFCLAHCHCDE@AAAAAA@A@@AA@@@ACA@@A@@@CBCBEDCB@FAF@LBHBBABADC@CAE@CBABADAD@FAJ@BAB@BCDCBADCFCBABCFG@ABGAGDA@CDIDABABAD@BCBC@CAEAC@AAAA@@A@@@A@@BAF@D@FAH@FCF@BBHFDBB@D@@ADABE@ECECE@GDEFEPIBA@CEAEAE@AC@CHEHAFEAA@@@CACAECKACBCBCDADEDAHEDCHEJEHCFADADCBABAAE@A@ABADCBADCDCFEBEDCDABAFCBADAF@DABA@CBAACBC@ABCBCDAD@D@F@B@BBDBFABC@CFCHCDA@@B@DCFABA@AAABAHIBCBAD@BAD@D@BAB@DCFCBEDABADCBAD@D@@@DABA@@@A@ADCDCDAFCDCBABC@AAC@C@EBAFCB@DCBADEHE@@DE@C@A@@DEBAD@B@D@F@BABABADCBAFAFEBCDCB@B@BABAFC@@HAF@BAB@BA@AB@BAB@B@B@B@BADADCDCBABA@A@@BABA@@B@B@DBB@B@D@B@DAD@FADABABABABA@@B@BA@A@ABC@A@ABC@@@C@@@A@AB@B@B@@@BAB@BABABADADABABABAB@BA@ABA@@@@DAB@DABAB@B@BADAFAB@@@FAB@B@DAB@BA@@B@DCBA@AB@BABABA@@BCBC@E@A@@@ACCAA@C@ABA@ADA@@DCBADABAB@B@DADABADCBAB@BAB@FGHIHKB@@ADEFEJEBCCAAGCCKE@@@@EGACCAECCCACAAACAG@AAAAAA@EAA@@AAAA@C@CAE@AAC@IGACAA@AA@CGCCICIEC@ACACCAEGCEAE@EAE@G@I@@@EAGCGCCCAEGIGGEECACCCA@ACEKCCCAEBABDFAFEDG@GAGDCDCBC@C@EBGDCH@@AFCDEJ@HAFAHCBGHGFADA@GDGF@B@DCBEHCHABCDAFKJADABCDABABC@A@A@C@E@C@EBA@ABC@CBEBCBA@A@AAACAAC@A@CDABGBE@GBCAGAEACCCCEE@A@@CECE@C@EA@@@@@AECECECC@@CEA@@A@C@A@E@E@CACAC@CACAEACACAA@A@A@A@CAC@AAAAC@AAC@CAE@AAABA@A@C@E@@ACBC@G@C@C@A@ADIBCBAB@D@D@F@F@BABADCBE@AAE@C@C@EAE@AAE@CEECCCAGCICC@A@AA@ABEBADCDADADA@@DAFAF@F@D@F@@@D@N@H@JIACBEJCFAJAB@B@BABCDCBADCBAF@HADCBABEDGBCBABA@ADA@AB@BAB@BA@ABC@@BABAD@BABA@ABAB@BAB@DADABABCDCDABABA@AAAACAA@C@C@ADA@@BA@CBC@A@C@ABC@CBG@C@A@@AA@A@C@@BCDEBAB@BA@@BABCB@DA@@BABABAB@JCD@@ABABC@A@AACAAA@@A@A@ABEBABCBABADADABABABADAFCDABABABAFED@D@B@@@B@@CDGDE@E"],encodeOffsets:[[101138,31767]]}},{type:"Feature",id:"540323",properties:{name:"类乌齐县",cp:[96.600246,31.211601],childNum:1},geometry:{type:"Polygon",coordinates:["@@NGFEBAB@@@@AA@CAA@MIA@GB@@AA@E@AAA@AEAAA@AAA@E@ACE@K@ALATK^]XYPKZSTKVILCHIBOCO@E@@@@@@ACBQFSLUFODSAMCAIEMBIDMHMPYROJQLaLcL_LOHU@WEUKMGMICSI_ISIKKMA[ESCCCEGEECGEMGM@]PILMNOJOXGHQJQ@]EOE]UMIMQCUKQQUWISBQJOLEFEHAJMXKD[@K@SIMGE@GBCFAPAFGDQDKBSBQEIEMIOBKCKBIDYLIFIFEFKCQQI@QPA@AAC@A@@ACE@CBCDGBG@CBABCBC@A@EACEECEAC@C@CBC@@A@OFIDCBIDCBG@A@CDCBEBCBCDCDADCLABCBCDKDKFCDEDABADA@@BCDKHEFEJEFADC@C@C@EAKAEAEAEAMEA@A@A@EJABEBCBIDEBG@CBCBEDCBKHEBEDOFC@EDEDSJCBA@@B@B@F@BCL@BABAB@@BBDFBD@B@@ABGDMHC@ID@B@BBBDDJFLDBBBBDFJXDFDDB@FA^IVERAJAFADADC@A@CBC@@BA@@HCRGPCD@DBJJFHHFFFDDDDBB@DAL@DBB@BFFB@@BB@FD\\TLJNHDBBBH@TCNBJAH@FHHFBBB@HAHAD@D@@BDBBBCFCBAD@@@BHJ@D@@@BCBEBC@AB@BCLAB@@C@I@A@AB@@AD@DABA@EB@@CDC@AAC@C@EBCBEBCB@@FFBDFHBFBDBDBH@B@D@FABE@EBMFSBYD]HCAKAEBIRGHG@GAOIGAEDEJEFQDSFQJGFQDGBABADEJGDABQDQHMHWFI@IAKBWFCBGFAJCNIBEAMGAEEA@HBLFLBFBDBHDFBD@DBF@D@D@DABADEFEHGJCDAD@B@B@D@BBDDD@BBD@@BBBAFBFBH@J@J@D@FBBBIFCBCLEJEDCBG@EAICC@C@ABCBCBCDCDCDCJCB@@A@IEKEAAA@A@CFADADAB@@@@CF@@@DBDDF@D@DCF@D@D@D@F@DABABA@G@CAEASUGECCC@E@@AEAA@AAAAAA@AACEIACCCAAAAC@@@CBA@EDGD@DGDEBEDAF@DBDDFDB@D@BABC@ADBDBD@D@F@BQLQHYJUVUTUPMVSVWXWPSHQ@YAWDMZM`EhA\\B`BZWLM`JZNPPZF^BVCNAFB@@BB@@@@BBB@BBBB@@B@BB@@BAB@@@BAB@B@B@@@B@@@@@@BBB@B@@@BB@@BB@@B@BA@@B@@@@@BB@@@@B@@@BB@@BB@@B@@B@@@B@@@BBBBB@B@@BB@FCVGPAHBDNBHFBFBPJXBFDB@@@@BB@B@B@@@BBBB@BB@B@B@@BBB@BB@@@@@BB@@BB@@B@@BB@@BBBBB@@BB@BB@BB@@BBB@@@B@@B@@@@B@@@@BBB@@BB@@B@@BB@@BB@@BB@B@@@@@@@@@B@@BB@@@@@B@@B@@B@@BB@B@@BB@@@@@@@BBB@@@B@@@@@@@B@@@@@@BB@@@B@@@@@BB@@@@@@B@@B@@@@@@B@@@@@B@@@@@@@DBB@@B@B@@@B@@A@@@@B@@@@B@@B@@@BA@@B@B@@@@@B@@A@@B@@@DB@@B@@B@@B@@@B@@@@@B@@@B@AB@@B@@@D@@BB@B@@@B@@@D@@@@@B@@@@AB@B@@@@@B@@BB@@@B@B@@@B@@@B@@@B@@@B@@@B@@@@@@AB@B@B@@B@@B@D@B@@@B@B@@A@@A@@A@@@AB@BAB@@@B@@@B@DBB@@@B@B@D@BA@@BB@@D@@@B@BAB@@@B@@@BBB@@@@ABBB@@@@@B@@@BBB@@@@B@@@@B@BAB@@AB@@@B@B@@@B@B@@@B@D@@@B@B@@@B@@@@@BA@ABA@@B@@@B@@@@@BA@@@@@ADA@@@@B@DMAEE[HUDE@@D@H@FGFCDC@@JIPMDCFCHEHGFIJIFADAD@H@LD@@BABABCDIBC@A@@BCB@B@FBB@BADAB@B@DBBAB@FCDC@@FAHCF@B@@@F@FBBAF@BABA@AAE@C@A@C@AB@DANAJAH@D@D@BB@@D@DDBBBB@A@@@CBAFCB@DEDC@@B@DCBGBM@I@E@CFKAG@EBEBC@C@AAE@G@C@ACIACAA@@BCBC@ABA@ABA@C@C@C@E@EBEBCDCBC@@DADAH@N@XHLB@@D@B@D@DAB@BA@ABAFCFAD@B@HDB@@BBD@D@BBBD@BB@@@@@B@@CBCFAB@BB@BBD@@@BB@D@B@B@@B@FBDBB@BB@@@D@DAB@DAB@B@BBD@F@D@FADAD@D@B@@BD@BBBBBB@F@HBF@F@HBF@DDBB@BBJ@B@DAJ@DAD@@@@@B@B@BDD@@BD@B@D@B@B@BBBDDAB@@@BADCD@BEDADCDABAFABCBEDADADAD@BADABABCDABA@ABADABAB@@@D@BBFBD@BFFFFBB@BABGFQJKHIHIBGDEBEBEBIDKDA@AD@FBFBDBHDH@@@B@B@DBD@BAJ@HELCJAB@H@@DFLPJDLDJ@D@DB@D@@FJ@@@DBB@BDB@@BBBB@@ED@D@BB@BB@B@@FBBDB@@B@BAB@@EBAB@@BBBDFD@D@BABC@GBC@CBCDCD@B@D@@ADCDCDAHCDCHAB@B@B@@@@@@@@HBB@@@B@B@DB@@DABBB@B@@@@AB@DABA@@@@@@PBB@FBD@BBHHFDFBFBDBBBBBBBDH@@@BDFFBHDD@BBB@@AD@FEHAD@HCLANAPCL@EJ@D@D@B@D@F@B@@@RLHDN@R@FCDCFQLYDI@IAQ@EDG@ADCHIDC@C@AAI@K@E@A@@BCFIBEDEFALEFE@@@@@@FEDGBEAGBE@ABI@A@GB@DELGJGDANGHC@@HGDABCBA@@BE@@@AB@@@BAB@B@B@D@BAF@DAFAB@DAFCFCDAB@LEFEB@FCHAHAFAFCDCDG@CBABADCDCFAFAFCDAFADAHEHGLGDADCFADAF@FDDD@@FJ@@BBD@DBD@D@DBB@DDBBB@DBBBBBBBDBDBDBB@DBDDDBB@B@@@BAB@DCDCFIDEDCDC@@DCFE@@FCFCFADAB@HCLCF@D@BBDDHDDB@@@B@BAFAJ@B@B@B@B@BB@BBB@B@BBD@B@BAD@FANEFADADCB@@@@ABADCDE@@AECG@KESAOBYHULQHQNABBBBDBB@FBBAB@BCBA@@D@D@HD`JFAD@BA@E@A@EAEBA@ADCAEBABABABCBG@ABABA@CDAD@H@D@DCJK@CJEJAHADC@A@AM@I@IDCDCBC@ECMIIS@G@CBGAECACC@C@ADCB@BBF@H@DABABA@A@A@A@@BABAD@F@B@BAB@@@@A@CDC@@DCJGJEBABCACGG@A@ALMDABAF@BADA@CAAAC@C@ABCBCBCBA@AAACAE@A@AA@@@ABCHE@C@A@CCEA@@A@CBABADAB@B@DA@A@EBADE@ABA@C@GBCBAFAB@BABCDADADCDADADABABA@AAAAAACAA@A@C@AA@CCC@M@CACAIKEACAC@I@A@AC@C@ABABABAD@F@\\EF@LCBA@A@@@AC@E@CAECMICCWKECACCKECAAAAAABCBAB@FAL@DBDDBBH@B@@D@D@DDFDBLFFDFBDADCRMDCBC@EEC@ACEBABAXED@@BCJBBNDDAFADCFAHADC@AGMMWCIA@AAAAA@AAAA@AAICE@KKYAEKKECC@Q@GCMCI@KBGBE@AAAOiuOUIE@@EBCBEDEBA@A@KGAC@ABADAFABAHGLEDAH@F@JCD@D@NBDDJFDBDBD@D@NELCDAFCDCDEHADADEBEDKDG@AF@^MjO"],encodeOffsets:[[99194,31950]]}},{type:"Feature",id:"540325",properties:{name:"察雅县",cp:[97.568752,30.653943],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ABA@A@C@@CGACACBKAEMOGKAA@A@ABECCCACCCAAA@AAE@CAAAAAACAACCGA@A@EBC@C@CA@@@B@DABABADAHCDABCBIHAF@DGFAFCJADCDKDEFCDCBC@C@CDIHSG_DYPOHORGNQ@M@UEYG_AWJMJQBQIOE]FDPOZSJMNIXUVMPOXKFMFS@Q@SGSIMOIKQESD_L[P]BcBWB[BcHaJeT_NYRUPUGUCOHEJGFCDCBCBEBG@A@IAAAC@C@CAEAC@MGE@E@EBM@C@EAC@E@A@ADINCDIDCDEJAD@D@DDD@F@@@BADAB@B@B@B@B@DBDB@D@DAF@FBTNBD@BADAFGFUNID@DCBA@CBC@C@C@A@CBCBABCDADCB@BADABCBA@@AC@CAA@CBCBCBEDOPOXOZE\\BB@NBJBL@POV[JWJYRMJCFGNA`IVE\\OTIJGHQR]VKTCHIZMXYVOHSM]AUCM]KWIOMOWFEXADOV[XUXULWJYHUHKNAFAR@RBTFVPXVDNBB@FFDF@DCHEDGDOBADCJEHAFCFEJ@@@BABABABALADBF@DBDAFADCFADADEJADEJ@DBFVHBFABCF@D@DCFEHGFIDED@D@@BBD@NBH@JAHAFADEDEBATBD@D@BBBB@@DBD@N@B@THRDN@DCBC@K@AEEEECEBE@CDADA@AAE@CDABCFAFCD@PHLLDFFDTHR@H@HCD@FBHDLBFBDD^RBD@D@FCVGLEP@FAB@B@BCD@@ALADCFEDCHGFCBAB@D@BELCN@@BBFBNDB@LBHAJDDBDDA\\DHFHRtBBEN@LJXHF`HPFJAJAXCRMVGRGZDXDJJ@BADCBCBADABCDEFA@C@CAG@A@E@AA@BA@C@CBABEBA@AB@@AADDBDBD@B@@BF@BBB@DB@BDBBFDDB@BDD@@DDBB@BBBB@BBB@H@B@D@B@B@@@@@HNDN@@@VCZKROT[ZMVJ\\JDHFPHPFDJCFJ@DBJPJJHLBD@J@F@PDTBDB@F@JAHCD@HAF@BBJJ@HGN@DFJ@DDBDALGDCJEFCHCDCBCFMD@F@HBV@PCD@FAD@b{HIFEFCJAHAJBFBF@DAJIHELGJANBH@PERGHAPCLAHATBHANCRKH@J@H@J@HAHCJCZKFCBE@GLGDCBAD@FDHFV^FHFDVHPHrTDBD@JEFGLCXDbFl@f@@@H@F@FBF@D@DA@@BAB@@CBCDGB@BA@A@C@AHGBAD@JC@@BA@ABIAEBABC@@@C@I@ABCBCBADKBEBA@AB@D@HAB@@AAC@G@AFCBAD@BAB@B@@A@@AC@E@AB@DCBABKBADABAHEDCDCPKXSBWBMOYOQEGGOAQREFARC\\IRUH]FQIMKEKKBCDE^QXSRSRQh@ZMPKT]XMZIXWH]FWL[VQRIXIB@ZUTOZWJGPITOVBTBPQLYLUMQMQFWNMloLUNKNGHGPALGRMKEOESYCKCK@YDSSKOICAEAAAAC@ACEAAAC@C@ABA@ABA@CDMBAFOHG@CAI@ADCBCHCFCFEDCBAD@JBHBDBBABCDIBCHGDABADABIBAB@D@DBBAFCDEDC@@RYZ[VWNSPWJWF]DGLWP_PeT_NOBCCEGCGODQDOB[EMKUWGQFIPMT_NKYD_GU[YYOcKIGGYDQMMW@ONYP[FaH[RWRAD@@CBG@C@@@ABCDCDA@@@A@CAAA"],encodeOffsets:[[100612,30836]]}},{type:"Feature",id:"540324",properties:{name:"丁青县",cp:[95.619868,31.409024],childNum:1},geometry:{type:"Polygon",coordinates:["@@@UEQWQ_KGIEEKM]QSMCUSQWSUOIAAAIAEAEAABAB@DB@DBD@@B@BABCBCBCBC@ABAB@B@B@@HDBB@B@B@HADCBEBABAB@D@B@D@D@FABCF@BADAFAHCFADABAHABABCBCBCB@BAD@D@D@DADKHCDADAFADADABGHCBCBCBEBC@CBCBEDABCBCBA@AAAACCCCEEECEACACBA@CBABAD@D@B@BBBB@H@F@DBDBBB@B@DABABCBCBC@C@CACACACAC@CB@B@BDDDDBB@B@DABAD@B@H@DADABA@A@]AWKO@[N_BKHCLOLQAOGECKKECCVGLKNOJUL]H]FaAi@WBCBCBEBEBE@EBC@GDE@GBKBE@E@E@E@GFYN@L_dENBNCJEJEFFPCHOEEBEJ@BADCDELCBC@CBCBCBCBK@GBE@CBEBGDGBEBIBMAE@CACCAAC@QB@BKJHNFJFTEXILOJFPEPGLMLOLIJKHUFKEIQULSPUNQJKRST@PHTJRTRPRPLHLFVBTMVGRARETKROJQFaNWHaFYD[AWAS@OBGDEBCDCDAFAHCJCHCJCJAJ@H@H@HB@FDBBDDBBB@BB@BGHCNCNGNSDYH[BQKOI[FCR@PCNDNHRJFXFVATDHPFLPRR@PAVHJJFPC`GTCRCNBPCNCNGNGLQHUJWJUF[@[N_HMFOJINFLAXAREVBLFJTFLDNJFVAR@VA\\CXONGTHN@B@@CD@B@BC@C@CBA@CBABCDABIDD@D@D@B@D@BBB@NLDB@@@DBBBBBBDB@BFFBH@HDHBF@@HFBBBDBBDBBBBBBB@DBF@DDF@@@B@DABABMDCBA@A@C@AA@BCBEDCBE@E@A@AB@@@BBJDH@FB^AFCDGFSNABAD@JBDBNBJ@JAL@BDH@DAL@DBD@B@JBBFDFDDD@FAD@FAFAJ@JBBVHBBFDDDBBGACBCDEF@D@DDDDDBD@F@JFDDDHJHBLHNLFBDDBDB@BB@B@BDBB@FDF@B@@AD@@BDBJDD@@BBBB@B@BB@BB@BBD@B@DBBDFDDDHFBDBD@F@D@DBDBD@B@BAFAF@F@F@BDF@DBDBFBF@B@@CB@@@@@BBF@BBBBBDDBDDFFLBJBBAF@D@DBDBB@B@DCBABAAABCBE@G@A@A@EJGJQLQJABADEJMJABADADABCBEHEHCDCFCDEDGL@F@DDFDFBBBD@DAFAFEFABADBLBD@DABADEBIDUHKDCBEBID@@@BL@B@FBJ@LDP@DBDBB@B@D@B@D@B@D@BBB@@@B@@@B@B@BB@DDNBFDHFTJLHDFBF@FAFALEBADABCDCB@@BBB@P@FDF@FAJ@BBDBBBBBBD@BBF@DBBDBBFBB@BF@AB@@A@@@AB@B@@@JAHCF@F@JBH@DE@CACFEF@DAAA@CAA@C@CDCD@BAACAA@CFCLIBCDEFBF@F@JEDADCBED@D@FBTGBCFGJKBAD@LDDBDDFBFBD@DCD@DBLBND@@DABC@CBEBAFABA@EDEFC@C@CAC@C@EBCDCFG@C@C@G@CFC@C@A@CBEFCFCHCH@B@FA@@B@B@B@F@B@@@B@@@B@@A@@@@B@@@BA@@@@@@@A@A@@@AB@@@@@@@B@@@BBB@@@BBBDB@@@BD@@BB@@BBB@@@BBB@B@BAB@B@DCBABA@@B@@@B@B@@@B@D@@@BA@@BBDB@@B@B@B@B@D@D@B@@BB@@@@B@@@@@@@@@B@@@@@@DB@@BB@@@@@B@@B@@@DD@@BB@@@@@BA@ADAB@@@B@@@@@@B@B@B@@@B@@AB@@@B@@@B@B@@@B@@A@@B@@@@@@@@A@@DA@@@@@@@@B@@A@@@@B@@@D@@A@@B@@B@@@@@AB@@@@@B@@@@@BA@@BAB@@AB@BA@@BAB@@AB@@@B@BAB@@@BAB@B@B@@@BA@@B@@B@@@@@@B@@@@@@@B@B@@BB@@@B@@@@BB@@@@BB@@@@@@@@@@C@@@@@@B@@A@@@AB@@@BABA@@@@BA@@BA@@@@@AB@@@@@@@B@@@@@BA@@B@@@@@BA@@@@B@@@@@B@B@B@@@@@D@B@@@@@@@BA@AB@@@B@@AB@@@@@B@@@@AB@B@B@@@B@@@@@B@@AB@DA@@@@@A@@@@@A@A@A@@@@BA@@BA@AB@BA@@B@@@B@@AB@@@@@BA@@@@B@@@B@@@@@@A@@B@@@@A@@BA@A@@@@BA@@@@@AB@@@DC@@BA@@B@@@@@@@B@@@@@@@@@@@BB@@DHB@@BBB@B@@B@@@@@BB@@B@B@BB@@B@@@B@@BDB@@@@B@@@D@D@D@D@@@B@B@@@B@@@@@@AB@@@BABA@@@@B@@A@@B@@@@@@@B@B@@@@@B@@@@BB@@@BB@@@@BB@@@@@@@@B@@BB@B@@@B@B@B@@@@@@@@@@@BA@@@A@@@@BA@@@@@@@@@@B@@@B@B@B@B@B@B@BBB@@@B@B@@@B@@@@@@B@@@@@@@@@B@@@@AD@B@B@B@B@BA@BB@@@B@@@B@BB@@@@@@@B@@@@@B@BAB@B@D@BAB@B@@@B@@@B@@@BBB@@@B@@@@@BBBB@@@@B@B@@BD@@@B@@@BBB@@@@@@@@@@AA@@A@@@@@AA@@@@AA@@A@@@@AA@@@A@A@A@@@@@A@@@AB@@A@@BA@@@@@A@@@A@@@@@@BAB@@@B@D@@@BA@@B@B@@@B@@@B@@@B@BA@@B@@@@@@@B@BB@@@@@@B@@@@@@B@@@BBB@@@B@@@B@@@@@B@@B@@@BB@@BB@@@@B@@BB@B@D@@@@@B@D@@@@@H@D@@ADG@@@@@@@@BA@@@@BAB@@@@@@@B@@@B@B@B@B@B@B@BB@@@@B@B@@@B@B@@@@@B@B@@@B@B@@@@@B@@@@@@@@@B@BB@@BB@@@@B@@BBB@B@@B@@@@B@@A@@@@D@@@@AB@B@@@@@@@@@@B@BAB@@A@@B@@@B@@@@@@@B@@@BB@@@@@@@@@@@@@@@@BA@A@@@@@A@@@@@@@@AA@@@@@A@@@@@@@@@AB@@@@@B@@@BA@@B@@AB@@@@@@@@A@@@A@@@@@@AA@@@A@@@A@@@A@ABA@A@@@@@@@A@@B@BA@@B@BA@@@@B@@@@@@ABA@@@@@A@AB@@A@@AC@@@@@@@A@@@@AA@@@@@A@A@A@@@@BA@A@@@@@A@@@@@@@@@@BBBBB@@@@@B@@BB@@@@@BAB@B@@@B@B@B@B@@@BA@@B@@@B@@AB@@@DA@@B@@A@@@@BA@@BA@@@@BA@@@@@AB@@AB@@@@AB@BA@@B@DCBABA@@@@BABA@@A@A@ABA@@AA@AAC@AC@@AAABAA@@A@AAAA@BA@AB@@A@A@A@@@AAA@A@ABABA@AAA@AAABA@A@@@A@A@AB@@AACC@@A@A@A@@@CCAAA@A@ABA@EAA@A@AAA@A@AAA@CCAAA@AAA@@@A@A@C@AA@@A@A@A@A@C@KBE@A@E@C@EAG@A@@@AA@@A@CBA@@@A@@@K@AAE@A@@AA@@AC@A@AAA@@A@@A@CA@@@@CC@@A@AA@@A@@@@BA@A@A@A@A@A@A@@@A@A@A@AAA@A@A@A@CA@@A@AB@@@@AA@@A@@B@@A@@@A@@@AAA@@BA@A@@@AA@@A@@@A@@@A@A@@AA@@@AB@@@@A@A@A@A@@@A@@A@@@A@@@AB@@@@@@@@A@@AA@A@@A@@A@@@@@@@A@@@@@A@@@@@@@@@@@@AA@@AA@@A@@AA@@@@AA@@AA@@@A@AAA@A@@@@@@@@@@@@A@@@@@@@@@@@@B@BA@@D@@@@A@@@@@@@@@@@@@@@@@A@@A@AA@@@@A@@A@@@@AA@AA@@A@@AA@@@@@@A@@@@@A@@@A@@@@@@@A@@A@@@@AA@A@A@@@A@A@@@A@@@A@@@@@@@@@@BA@A@@B@@@@@@A@@@A@@@AA@@A@@@A@@@@@A@@AA@@@A@@@@@A@@@@@@BA@@@@BAB@BA@@@A@@B@@AB@@@@AB@@@BAB@@@B@BA@@B@BA@@B@@A@@BAB@@A@@B@@@B@@@@A@@@@B@@AB@@@BA@@BA@@BA@@BAB@@A@@BA@@@@BA@@@@@@@@@@B@@@@@@@@@B@B@@@B@@@@@@@@@B@@@@BB@B@B@B@@@@BB@@@BB@@@@BBBB@@B@@BB@@@@@@@B@B@@@@@B@@@B@D@@@B@B@@@B@B@@@@@@@B@@@@@@@@AB@@@@@@@@@B@@@@B@@@B@@@@@@@B@@@@B@@@@B@@@B@@B@@B@@@@@@@BBB@@B@@B@@B@@B@@B@@@@@B@@BB@@@B@@@@AB@@@BBB@@@B@@@@BB@@@B@@@@BB@@@@@@@@@B@@@@@D@@@@@B@@@@@@@@@B@@@@@D@@@BBB@@@B@@@BB@@B@@BBB@@B@@@@@@@B@B@@@D@@@B@@@B@@@@@B@@@@@B@@@B@@@B@@@B@@@@B@@B@@@@@@@B@@@@@B@@@B@@@B@@@@BB@@@BBB@@BB@@@@BB@@@@BB@@B@@@@@@BB@@@@@@B@B@@@@@B@@BB@@@@@@BB@@BBB@@@@@@B@@B@@@@B@B@@@B@@@@@B@@@@@BBB@@@B@@@B@B@@@@@@@BB@@B@@@BBB@@@B@@@@@@@B@@@@@@BB@@@@@@@@B@B@B@B@@@B@B@B@@@@BB@@AB@@@B@@@B@@@@@@B@@B@@@@@@@@B@@@@@@@@@B@@@@@BB@@@@@@B@@@@@@@B@@@@@B@@@@@@B@@@@@@@BB@@B@B@B@B@@@B@@AB@@@@@B@B@B@@@B@@@@BB@@@@@@B@@@B@@@B@B@@@B@@A@@B@@@BAB@BA@@BA@@@@@@B@@@BA@@B@B@@@BBB@D@@@@@@@B@@@B@B@B@@@@@BBB@B@@B@@B@@@@@@@B@@A@@AABA@A@A@A@A@A@A@A@A@@@A@A@@@A@@@A@@@@@A@@@@AA@@@A@@@A@@@@@@@A@@@@B@@@@@@A@@B@B@@@@@B@@@@A@@B@@@@@@@@A@@@@@A@@@@@A@@@@@ABA@@@@@@@@@A@@@@@@@AA@@@@@@@@AA@@@A@AA@@@@A@@A@@@@@@AA@@@@@A@@@@A@@A@@@@@@@@@A@A@A@@@@@@@A@@B@@A@@@@BA@@@@@@B@BA@@@@BAB@@@BA@@@ABA@@@A@@@AB@@A@A@@@@BA@A@@BA@@@A@@@AB@@A@@@@@A@A@@@A@@@@@A@@B@@@@AB@@A@ABA@@@@@A@@BA@@BA@A@@@@@@@A@@@@@@@A@@A@@@@@AA@@@@A@@@@A@@@A@@B@@ABA@AB@@A@@@A@@@@@A@@@@@A@@B@@A@@@@@@@@BA@@@@@@@AA@@@@@@@@@@AA@@@@A@@@A@@@A@@@@@@@A@@@@BA@@@@@@@@@@@AB@@@@@@AA@@@@A@@@@@A@@@@B@@@@A@@B@@@@@BA@@@A@@@@B@@@@A@@@@@AA@@@@@@A@@@@@@@@@A@@@@@A@@@@B@@A@@@@@@B@@@@A@@@A@@B@@@@@@A@A@@@A@@@A@@@@@A@@@@@A@@@AB@@@@A@@@@@@B@B@@AB@@@B@@@B@@@@AB@B@@@DA@@B@D@@@@AB@@@B@@@@@BC@@@@@AB@BE@@@A@@A@A@@@C@A@A@@AC@AAAA@@@@@@@A@@@A@@@@@@@@AA@@@@@@@@@@@AB@BA@@BA@@BA@A@@B@@@@A@@@@BA@@BA@@B@@ABABA@@BA@@@@@A@@@@BA@@@@@A@@@@@A@@@@@A@@@AB@@@@AB@@@@@@AB@@A@@@@BA@@@A@@@@BA@@@@@@@@B@@@B@@@@@@@B@@@BA@@@@BA@@@@B@@@B@@@B@B@@AB@@@@@B@@@@A@@@@@@@AB@@@@@@@@AB@@@@@BA@@B@@@@@BA@@@@@@@@D@@@@@B@@@B@@@B@@@BB@@@@@A@@@@BA@A@@@@B@@A@@@@@A@A@@BA@@@@@A@@AA@@@@BA@A@@@AB@@A@@@AB@@@@@@@@A@@@@@A@@@@@@@@@@@@B@@@B@@@@@B@@@@@BB@@BB@@B@@BB@@@@@DB@@@@B@B@@@@AB@@@@@B@@@BA@@B@@AB@DA@@@@B@@@@@B@@@@@BBB@@BB@@@BBBB@@@@@@B@BBB@@@B@@@B@@B@@B@@@B@@@@@B@@@@@B@@@@AB@B@@@B@BA@@B@@@@A@@@@B@@@@A@A@@@@@@@A@@@AB@@A@@@@@A@@B@@@@@@@@@D@@@@@B@@@B@@@B@@@@BB@@@BB@@@@@@B@@@@@@AB@@@@B@@B@@@BB@BDB@@@@@B@@@@@@@@@@@B@@@@A@@B@@@@A@@@@@@B@@@@AB@@@B@@AB@B@@AB@B@BAB@@@@@B@@@B@@AB@@@@@@BB@@@@@@@@@@BB@@@@@@@B@@@@@B@@BDBDDFDFDBFBHBH@F@BBDBFDFCHEDCFAFBBDDBBCJGHEBAFEDC@A@EBCBCD@F@HBF@DABABAACAE@G@EBEBEBA@C@ACCCEAECG@CBCFAF@DAFABABEFI@CBE@C@ECCBEBCBAAAAE@EAG@GBCBCBEBC@EBC@AC@GAIEC@MFA@BA@AAAAC@CACCAC@E@C@@@AGCAC@CBA@@ABADADABA@CAC@CBEBCDCHEDELGHCLEDAJGDCACCCAAG@GAC@ACG@EAC@@B@BAFAB@@EDEBC@A@@ECEAAA@G@IBIBGAEAAAAC@AA@CA@AAA@AAAC@E@E@C@CAAA@ABKDACI@@IOGGEGGCA@@AFEFCDADCBE@GEAMCAC@CFCFGDEDABABAD@DBD@BADAJBFBFB@@@EACBCBAFAFAD@D@FBB@D@@A@AACAC@C@CAC@E@E@EBCBCDCFADAFCDCBEDC@C@CACACAA@ADE@CFCBCBAAC@A@@FABA@AAC@CDADABA@C@EBCDABA@EBCBABC@CBABAF@DAFCFEDCFCDCDC@CBCACCACACC@CDEDADCHCD@FCF@D@BBDBB@BABABEFCDC@ABCBCBCBCBABADAD@FADA@CBEBCDCDADCBE@CBE@C@CDABA@@D@F@F@FAHCFAHCFCFAF@D@DDFDBBBBD@B@DCDADCBAD@BBFDFFFBDBDBBD@FAF@D@DBD@BBBADBBBBFBD@DAFAF@H@FBF@DBDDDFDFADBDBDDBD@D@DADAF@FBF@F@FAF@HCF@HAF@DADADAF@H@FADAFAD@B@DDDBDBBADADCBCFADAF@H@HAFAFAFAFAFAF@D@FBDBHAF@FAFAFCF@FAFAFADAFA@@DAF@HAHCFADAB@BEBCDCFEDEDCBCBC@C@CBE@ABEBCDCBCDA@CBE@CBEBEDCDCDADCBABABCBCDAFCDCDAB@B@@@BB@@@@BBBB@@B@@@B@BBB@B@@@BEDMAUE]OYMOIYN_XKAYA_B[FgN_NYXCZBR@TGXOXWTUNUVOVSVUZIRGRK@A@E@CACACBCD@BA@A@CCACEAC@CBEFCFAHC@CHCFCB@DA@@D@BBBBDDBDFJBD@BBBBBBBB@FB@BF@D@DDHFTVFBDBH@B@BABA@C@E@C@C@CDE@C@CCEAC@C@@DE@@@@BABCBCDEB@B@BBLFJFB@@@DADIDCDCDCDADABAD@D@JDFBH@DAFCFIDKDAJEAAEAC@I@I@G@EAEAABAA@@AC@ACCAC@A@C@A@ABCDCHIFGFEBCBA@C@C@CAE@CACCEAGACAEEKAK@GFBBFNHFBJADMBIHEDAXELAJBJ@XENGRGRCBAHCFIBCBAHARCHERITERCFEFIFCHBPJHBH@HGJQFALBDB^GZCTANEFAF@BA@E@C@AAGACACAEEGACEE@@DAFADAFAD@D@BBD@DC@@FAB@BA@CBC@@BAB@J@D@@@BADK@ABAD@FADA@A@@@CGI@A@@BCDADEAACA@AC@C@GBGBA@AAGEEGG@IBMASDG@AACAMGKI[SECA@@AA@EE@AAA@CBK@CAACCCCEEGEEGIICAC@ODQHGD@@AB@@AD@D@BCDCBEBIBQBUF]JEBA@CCCEIWCEAAAAKCIECCAA@A@AJCD@NGHCBA@@@AACCEAA@@BABA@ADK@A@E@A@AB@DATIFCFCD@PEFC@@ECA@AAA@CBA@CBA@A@ECEAA@C@A@A@A@AAAAAAAK@AAGCC@ABAFEDABA@CACACCGEI@CECIIGOAG@CBCBA@G@AGCEAC@C@GDIJGNAH@DDJHLDJ@F@HGFCDODQHIDKBC@C@QBSHQNKNQDSCS@SESEQMIMCKMCYBE@M@KFKTITKTKR]FSLUTQ`OXIT]FUJUB_BaFKPQTUXYL_F_FeB]CMAWCMFC@K@CA@ABA@CDCDCHKDK@ICICGCAEAIDA@EDEFEBEBC@iDQBKCIGAACB@FEF@HCLAF@FAHC@E@AAACAAACIGAAAAACCCCEAACAA@C@A@GIAS"],encodeOffsets:[[97898,31860]]}},{type:"Feature",id:"540326",properties:{name:"八宿县",cp:[96.917836,30.053209],childNum:1},geometry:{type:"Polygon",coordinates:["@@COAKB]A]HeCQOGO@UDMHIJ@NBNLR@FCBKAQAODOLYVSDQ@SFSLQ@MMQES@MFE@OCWEICIAIDIFIHCJEHINKTADKPGLINADERCTANENIXEJMHCBODMFCFMJONMJQBA@AD@H@PBHAFIDSCI@C@OLOLIBUNMHEBGL@@@B@BB@JFDDBBBB@BJJRFLDJHFHDJBBDBF@R@RCJ@NRPLF@HAFBFDBBD@DCJG\\IXILAH@LBJFTLHR@VIPIJCD@B@DFJ@BAB@BA@CACAIAA@CBGDKFGDCFMNCDGJEFIFABABAD@BABCBMHC@KDE@GBC@CBABCDEDCB@BGNEFBBDBR@J@RBJHBFBJFRLJHLBTBDNNATELGPINGRAJCPCLAJCJAJAJCFCFEFGDGFIFIHKBI@[FADAFGPAXG^OVULUBMAWBWN]XMLERBV@PILIR@ZL\\VVTFRDLPBZTZNXA^NVRXXHJFVRHPAZKXINATLTRHbNVNTTLPLNLTDLFT@PKPBRVjNPV^D^UX_HaE]CCBCBA@C@ABA@AD@@@@BDDBBBL@F@FBHDDBBDDBFBBBDB@BBB@DHH@B@DA@@D@B@D@BDB@B@DADETGZAF@JBFFNFF@D@F@DCDADCBA@A@CCC@E@OBG@@@@D@BFFBD@@@BE@QHEDADEFCBKDC@@B@@BH@BD@B@BBB@@B@D@BC@GDEDGFCDCDEBGDINEF@BBF@BABA@EBE@K@KDEBAB@BABCB@@A@CA@AEGGICA@CAUCEA@AA@@E@ABADABA@MBEBCAAAEGCACAM@A@AIAACAE@E@IFCDCBBD@B@FCLAJ@@@B@B@BBBF@F@BB@BABAFCFABGBA@AB@F@J@JFVFJBNBTMNUH]D]P[PYNOL[RYNEL@JTHfH`TFHFNHFLDTBF@CLMJQNKLOVITGPMXEJKHYLMLOTGPGLMJSFUFMNGPFXJVLNVPTJ`RZLhRPNJPJNAVIVGRCLCPFVEJOFEDINDLJJVNfRTLPB\\@RGPMPINEN@LBPJANGHI^JPLVHLFLLLNVFPPJLFVBRAVDJLDN@JCFK@QBDRRLVPIPCPLTFTEPKPAHH^FGFEPKRITAXJRVLRDVNRNJ^VPF^FR@RIHGPWPINMJK^ON@NHHFFDHFLGV[NQRSPGVKNKPK\\MFIPWHSNWROHCP@XGPKLQHSFMPKRDLFHNNBJGNDPFRMDIFQTMPKVILGJCHGFKHKLQRKLAVDXEFEHSLKJHNBXCRIBAFCJANAFEBACK@CHERKPQFGHEFADCDAFBZDJ@B@BABABA@A@@FIDEBEFGDIBCPAHCFCDG@CCEEEA@MAUCOWEUAS@QBQBELMVGZGXIVKVW\\WPUBCFWXENPJPLXN^VD^BTNPGZUNWJYDGLS^URQHGJIPSF[JUB_HMKEQGIO@QLQAIUYGWKUU_QOgISEc@YGGOFUZ]HWMMWKEQ@MHYFUASFMLYBODONQNQRIRQZW@IBQIOQIIMGWFSEQOKOAKAUBKCKEEACGGGAEEM@GAKCI@ODEN]L[ZmFQLFOFIDCf[PMHUHWDGPCNBN@@C@EAICGKQCAIAUAGASKMAUOCAC@OAQBC@CA@@AEMYUOUEMBQLUPWJ[D]KKUQSUMOSC[BYO_SUESDOROAUSMOOGQ[M[C_EeBGAGB@@@MAWC[@IGcCWEYGU@@C@AAACAA@CAABE@CBAHA@A@@@AAC@AACACC@C@@@IGGOGMGQCOFMNGFE@GIMCCG@]FMFSLIBUAUCICMICEEUESBSFQHOH_FaDSGUIGYAGAIMSO@E@ODS@GHGHCPODCJAVAFEBS@GE]EOIMCEACDKHIDCBU@EAO@OE_BKCGOI@@KCE@KDMHMLKHE@QKEEMKCG"],encodeOffsets:[[99244,29894]]}},{type:"Feature",id:"540327",properties:{name:"左贡县",cp:[97.841022,29.671069],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@CCGA@ABABCDC@C@CBCDCDADEBCAAACCCAA@A@ADA@CBA@CBCDEDADCBCBEACACACCAAA@ADCJCHABA@@CACCACAE@EBEBCBCAAACCEECACACAC@C@CCCCCCC@C@C@GDGDGBEDEBCDAH@F@H@F@FADAFALCJEFAH@F@FBAE@EBCBGDGDGFIHIFIBCACCCCC@CGDEDC@YPiTOJIDMAQCOCEBEBWR{pURQTCRDRLPNJBDBFADWTEPGDADAF@BEJEFEDGDOFGDIHCFDLAHIHEJCLADAFKJAFGPGPCR@JFXETIRKLHLLLDPDNDJ@DMVAFKFENKVGRCFEFEHEFC@E@GEQKUIYGMAIAK@UCE@ALGJGHILODODKAEAC@A@EJEJKNAT@N@JCHELEBEH@HAHFLCXAFQLMPKFCBYJKDEHIJIJGJGJCDGAEEBE@CACGEGGGGIGEECGAKAKCIIOKBOBQB[FKAIEKCGCCL@@@BDHBhAFAHCBIBIBEDKL@DGPENGLELABGHMRGJGJKR@J@@@BB@B@B@BCFEBA@@D@BDJP@DEDAD@FBBBBHFJBHDBBDBBD@DCBADEBGHABCDIFSNBB@DADGDKFA@CCC@EAUGU@GDADGBCFBXCXAFEHDJBNBHAHMJOFMJSLQRSdEN@@QDaJK@OKGQ@]QO_Be@gAmGaJadITFZYRIBMLMLGdYZSH[X@BGTCPYTY@ILOP[CUTMNGF[GW@[LURSNUKGGOEUDIHG@CBG@E@AAICC@E@A@CA@A@A@E@CBEDABCBC@E@CCG@A@@FEBADAHCFAD@NAFCDCBIDEJIFAFEFIJGJGHCNCDC@AAAECCEIEOKUQAIA@E@ABABCDCDCDGDSJC@EAGCKCEACACAACCC@C@AAECCAEACCCBCDGBCAE@@EAEAAAAAAE@KCEAI@C@@@CBC@ABAHAFCFAF@B@B@BABAB@HI@@BGBCFEBE@ECGAEE@IDEBE@MACBCBC@AAGIECCAA@A@@@A@C@I@E@A@CAACEIAAC@A@C@C@IAK@OCKCwIWCICE@A@AB@FAL@DAJ@DCF@BAB@D@B@F@BAHCL@BAD@F@D@FDH@F@@AJBD@@BDBF@FBFBDBD@BBBBFDHBHDJ@D@DBDBD@B@F@B@BBD@DB@@DADADEHGJCDCHAD@F@DAD@DAHADAD@DAFADCJ@B@J@F@D@BBH@F@@@HADAP@FBJAD@HBNA@CBI@kGE@CBEBABAD@HBTADCBCBEBGDCDQNAD@BDFJHBDBD@@@BDFAB@DA@GBCBABCBAF@DFNFBD@DBDBFDBBDBFBB@D@D@CDCDIFCDEFGJABINEHGHCFEHCHABCDCF@BA@s\\KHGFAB@DDFDDHDHBD@BDBBFF@B@@AB@@C@C@IBE@EBCBA@A@C@EAEBEBEBWAC@AACEEACCA@CAG@CBCB@@AB@@CFCF@@CBABAD@BCBA@IAG@CBC@@@CE@@CAAAAI@AA@A@C@G@A@AIAA@@C@Y@qBGFifojYVEHCDCFEZ@D@D@FJX@B@BABCBABEDCB@D@B@BABAB@BGBAB@DAFBB@DBBBDBBD@@@HVFZDXHd@JD\\BX@N@@HAHBfA`F\\D\\NHRPPTNBVQPCPFTTVP`AZD\\PTVNRTLV^L\\CXIVORKNAVFVPNZBF@@DBD@RAPBD@DBVPNBTLHBVBJBDBLRDHBJ@F@DM@MAODCHGXGVONe\\CDEJEPKERYnK\\M^CF@PDJBL@HFNBFHHDHFBLFLDVALBPBPLFRETHXJNRJJPAR@JYXQRQJMRMRCPAPKZENBTEVGZ@NFRXLNNGXY^EVHPZHd@TFhJRPV`LVHXVZBJKR@RJPRHLFDENIZQXI\\IPU@OAKAI@MAAF[PYPWPOFCDADADAB@DBD@@BB@DABABC@ADABCDCBADADAB@D@D@D@DAB@DA@CJCVMHEBEBC@AACSMEAE@CBC@A@AC@C@A@A@A@ABABC@A@@@ECC@C@CBCFIDCJCDCJMBCB@F@D@FBD@N@FAF@F@NHD@FBDBD@D@BBJBB@H@FADADADCHEFIPGVDVHVOZQ`MfSbIdG\\AXAdA^A\\O`KTCRFJLNPTJTHR@T@NELEPWNOVUJWNMTIPYCOIICMFKDCFKAY@KDKDKPOBAVGRKP]FERKNMEOCWBSHOFEb]BIBOBERONUFG@CBGBKBODQDCHO\\UJSRSDMGUBCjCGM@GDCPIHCAJAFC\\_`[DID[LOFGHCHIBMDYJSFAhKFCHIFONSAAB@FEHEB@DAFCHEBCFG@CCCCBG@IBE@E@EAICGAAAK@CAAACAC@CAC@CACACAAA@@GGIIQIUKWBWLIDMBUSKAOHG^OROLQPMXY^]VCDUTWT_JWA]E_G_GMGQU@]@aCeAQBUFKNUV]PQR]JGNQASCQ@QVYNYPg@_@wPcLKRaFUJSJODQDGFIPIXGPKFMDIDSP[PMTC^GdCRKNWHUREVNDJPNR\\TNZ@\\A\\IJUGODQRILEHAJAB@BCNQNMNOJWBUNMJ@TELYDWLULcPORIT@NJFFXTPLRGLSTaL_JQD[EWKYB[HKDSEIIIUUESFOBGFc@_BWFWNCFDTJTGBYFOA_JYNCN@RKHUHgI[EYD_FIRaDIHYC_DWNWBKDMAaCU@QNMNCBA@C@C@AB@BA@CBEBC@CACAE@CBCFCDGHCFEB@@CAC@CAABEBEDGBEDADCBCBCBCDCBC@E@AAE"],encodeOffsets:[[100987,29303]]}},{type:"Feature",id:"540328",properties:{name:"芒康县",cp:[98.593113,29.679907],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@C@C@C@ABA@ABAFEBA@CB@@AACAC@A@AA@@AAAA@AA@ACEACAAAACAA@@@CA@@AA@A@CAC@ABA@AHE@A@ABA@AA@@AAA@C@A@@@A@A@A@A@CBC@CBABCDABAB@DCBAFCDCDA@@DCBABC@@@C@E@CAA@AAAAAAACCACAEACAE@AACAC@A@A@C@CBABEBA@@BAB@DAB@BC@A@A@C@AAACCACAA@CBCBEBADAD@DAD@D@B@DAD@D@DC@@FEDEDEBEEICEAEBEBCFEDEFEFGHCDGDEBEDGFEHEFEDE@EAE@GAECGAECEAE@GBE@@BCBCBEBEAEEEAECK@EBCPEBABGAE@M@EFGFCFECECA@E@EBA@CBGBGBE@CDIAGACAIAIBE@@FGFAB@BAB@BADAHABGEGCAI@AAAI@E@GAAEE@C@G@MEEGE@@@@EECCCKEO@E@CCE@EAEECIEACAC@E@C@C@EAEAIEGACAAGG@KJYBOCMACCAAA@AAA@AAAAS@GAG@@AIAI@A@AEICE@AA@@@AA@A@C@E@I@C@AAAAA@@@CBA@ABAACAKAAACAABADE@AAGAEBC@C@CAEGK@C@CBCDC@CAECAAC@EBCBC@AB@F@BAB@BA@AAACC@AAA@A@ABA@ABA@@FC@@B@BCDCDEBEBADCBCBI@AAG@EDEBG@@TOFGHEFEBEDMFEDGBA@AB@DADABABABC@@BAAEEG@CFEBGAECKAECK@KCI@@DCFEBKDE@EAI@AAEAGBIAAD@DBBAHEFEDE@CAGGK@C@E@AAA@@@A@@@AB@@A@@@AHEBCBGAEEE@CFKDA@A@CDC@I@@CAKCAC@@@A@@DCBA@A@E@CDMBM@EBABA@A@C@C@ACAAA@C@C@A@@BC@CBMBC@CBA@CAA@A@E@A@CBADE@C@CAE@C@C@CBA@A@ABA@CBA@ADC@ABCBCBC@A@@@AAACC@CAAAACCA@AA@A@A@C@A@@BABABAJCDAB@D@DADAB@DABCBCBABCJKBA@A@AAAAE@@@C@A@A@ADABABABABAB@@ABC@@@@@ABCBADCBA@@B@BAB@DGBABCBCBC@C@A@A@A@A@A@A@A@ABAAC@C@A@AA@AA@AYDO@A@AA@AACECIAC@CCCCCCE@A@G@E@EAI@QAOAC@EACAECCEACAEAIACCAA@CBCBEHCDCBE@IBCBCBCDCBE@KBGBE@E@AEAC@CBG@@AE@E@G@EAACAGCIC@AAC@E@G@G@GBG@IBG@EDEBEBC@C@A@EHCF@DCDGBGBGBCDCFA@@B@FADA@AB@@A@AB@DEBEDEBEBABABA@CBEBCBABA@A@A@A@A@A@A@CBCBCBCDEBEBC@CBCBC@@@C@E@E@CAGACAAEICACACACBA@EAEAEACCCAACCCAAC@GAC@@@CCCAACA@CCECAACAAAACA@AE@A@A@@ACAC@@EGAGCCE@CAOBI@CAACCACEAE@E@EBGAEAEAECCAEBEBC@CBC@ACG@C@CAEAE@I@GACACECEE@A@ADABEBEBEBI@G@CBADCJAHCFAHCHCJCHCDCBAHBHBHBFBDCDCDEDE@EACACACCCECAA@@BCBCBC@CDGBEBC@C@C@ACAC@EACAABABADCBA@E@CAAACCAAC@C@G@GBCBCDCBAAAACCAACCAAAA@ABA@EBCAGAIAAA@CAC@AACCAA@AC@A@CBA@C@CACACBCA@@ABA@ADADA@C@CBE@EBEBGBE@E@CAEACCACAAAAEAEAA@A@ABABEBEBC@AB@@AB@BBD@F@DABCBC@CBCDCBCFAB@F@DBF@BAB@DBDBF@LAD@BCBEBE@ABA@@@BBDFDDDBDB@BADCFCD@D@F@F@HBDD@DBBBABADAFA@CBABABADCH@H@F@H@LBFBDBBDB@BABGBEDEDAD@BBHDJDL@F@DEFCDEFADAD@FAHADCDA@CBGH@FCDEDGDAD@BB@DBBB@B@DADABAB@@BB@@@@@@AB@@A@A@A@@@QBC@ABEJC@@BA@EAAAC@E@@AEGCCA@@A@AAACCECE@CAE@A@CACGAAC@@@@@A@AA@@A@A@@AC@@@A@A@@A@@A@@A@@A@@AA@@A@@A@@AA@@@@A@@@@@A@@@A@@AA@@@A@A@@@@@ACAA@@@A@@@A@@@@A@@@@@AA@@AC@AA@@@@A@@@A@@@A@@@AA@@@@A@@@A@@@@BA@A@@@A@@@A@@@ABA@@@@@AA@BA@@@@@A@@@A@@@@@A@@@@@A@@@@@@B@@AB@@@@A@@B@@A@@@AAA@@@ABA@@@C@@@CB@@A@A@A@C@@@A@A@ABA@@@A@AA@@@BA@A@@BAB@@@@A@@@@BA@@B@@@@A@A@A@ABC@A@A@@B@@AA@@A@@AA@@@A@A@A@A@@@A@@@A@A@@@@@A@@AA@@@A@@AA@A@@@A@@@@@C@@BA@@@A@@A@@@@A@A@A@C@A@@A@@@ABCAA@@@A@@A@@@A@A@EAAAAC@ABC@ABAB@B@F@FAD@BA@ABA@C@A@A@AA@CCAAAA@A@ABCBA@@D@FBDAB@@ADC@ABCBCDCBAB@B@DBD@B@B@B@@@BA@@@A@AAACCAACCACAACE@C@ABAB@B@B@F@BAB@@AAEACAECCAACACAA@AAAC@A@CBA@CBA@@@C@ABA@A@A@ABC@ABABCBC@A@A@C@C@C@@BE@ABCBCBCBCBCBADCDAB@BABAFCBA@@AAA@AAA@CAACAA@@AA@A@A@C@C@C@AA@AACAAAAA@CAC@A@C@@AA@@@E@ABA@C@ADEB@B@@@BBDBB@B@@@BA@C@C@@AAA@A@@C@ABCBA@ABA@C@E@ABCD@D@BAB@BA@C@A@ABAB@@@B@B@BADCDC@A@AAA@CACAA@C@CAC@A@C@ABABAD@D@BABA@A@A@C@AACBA@ABE@@AA@@AAA@@A@A@C@E@@BACCACCECECEEECACCEAGC@@MGAACAWE@@COCGA@@A@@ECICGAGAEAIBEBC@E@EAGBA@ABA@@B@D@DABMDMN@RDVBbCNALMXCXD`GZCJQbEJC`FZJ\\GhGVQLM@MDIZB`EPAZSHSIECMDEXAX@`EdAHEPFTVVJJFJCTGLA\\LZFXC\\IRK`SbKTQHOKWSEEMIS@QJOPKdKVCXKZSFI@MNAVIXMPMNMRADA@IBGBKFQJCRHPIV[J[BY@SMQ[OMCIUMQFGVMXQLcD]HSDONO\\CTCJENOLWHOJEJCHCRIPITEVQbKLOd@x@`OhMZUZ@RDRBTMRIHQ^ORU^MVELAVBRDf@b@^RVNH`H`H^FXB`IXSVSDC^UZ]NWROPKPQH]PGLBVTNAJCXKXAVLRJJJHH@@BBDBDBDBD@DBD@DBBBDBL@BBHBJDFBF@F@JAH@DADD@DEHADGFEDCBA@GFEFA@BBMTEPGJEDgLEBITCZANGJGDEHKPC\\CJ_\\[`EDIBBGDOJCD@HHNiDADHVCNQTIT[VGPCDCRAPALAH@DEHMVQPAFAPAJa^EFGPATDXFPMNQLEFO^QLUHABOPCLCL@LBZELCDELDNJJ^EPFRJRANIXI`BZHVFN@R@HMPQPGZO`CTHJGDCD@D@DADCFELCDCBCDIBEHE@CBEJGDABADCBGBCBABA@C@A@@DBD@D@FAB@B@DHBDDBBBBBBB@DBF@BBBDBDDDBDDAF@B@BBBHLNPBFALBDBDDH@@@D@BAB@BBBDBB@@@B@DCDCBA@@D@H@DA@@BCXQ\\QbG\\EZOPMX@NNCRHZJHdLZP\\ZHVC`LZ`MNSJOREXHLVFNA\\CPCRHPHDDFnIJHF^HRHLDZAdJbNHTHXNNNVDVEZATOPO\\MRERGVIZ@TLVFZLBLCNOTBRHVHJPLTFTG@@LOFULSJERG^M\\IRA^A\\DfJ\\NVBZCHCPMHEHAHAF@FCNELCF@F@DBBBHFFJHFJDJDHDHFDDFBD@FBHAJA"],encodeOffsets:[[101356,31024]]}},{type:"Feature",id:"540329",properties:{name:"洛隆县",cp:[95.825197,30.741845],childNum:1},geometry:{type:"Polygon",coordinates:["@@@IFKZM\\QPKZM\\O^O^CVGNMASAMEIEU@I@I@EBAB@HABADEBEBA@AAAE@E@AA@A@A@A@@BIDK@E@AACIJCDAB@FAB@FCHADCFADGHCDCDC@DDDBLBDDBB@BABCDABEBCBABAB@@K@EBIDCBA@A@ACAAA@E@EA@@EECACAEAEAA@CBEBEACBA@C@CCKEC@A@GD@@SDSFSDSBUEYGKGOEQ@I@QEKIQIMEO@OBQEKOCYE_GS@QAUWSUGYD[FQCOCEQKMU@YHQTGRAZ@PINMBGGMOOAIHGTOPQDUCUKOOOGSAKEMFULQHO@UC[@SJOTANBPMLUHWHUJOLONGNBVRL\\JHHCLSLQJWDUBMDUBOLKHS^WHORCPGRMFMP@RERCNGLSBWNGNMFODUBKJBRDTRRJXHVGVINCDQJcDgB[JUFeHSASBGLQHaFKJQRKHM@WIQKQEOFOPQLOHWDWDSBcCAOBSBQCMSCQFQFQHWCAKFMEQMMMGQJINELOHSJQNENBPPNJJLJFJJJHHBNERINBVDRIJKFGV@NJRELQVCRMNULKN]JYFQHAD@RHPJV@ZPJPCNALDPT@NCNAVATATFPLP@PFZE`@FABGDADFPBDBD@B@B@DKDEHMLOHCBIDMRKLCHKHCBIBC@E@AB@DDDNJBBADBFHFLJFHBH@HCFCHGRAJCHAD@JAF@L@J@D@D@BCJAFEJADAFAHAJ@F@J@DBDBB@BAXFJDNf`FDLLFDPHRBPKDKLG`A\\MP@XL^BB@B@BABC@C@G@ABCBA@C@AAACCCC@A@ADAD@DBDBDBDBD@D@DADABABA@C@AAACACAE@G@A@AA@A@A@CBCBADAB@DADBFBFDFFDDDDBBBBB@DADABAFCDADAD@FADADADAHGBABCBCBEBCDCLGBC@C@C@CBC@ADADADABABABGBABCDEBGBEBC@ADEBA@E@C@C@A@CBABAFADABC@G@A@AAAGC@@@A@ABABAD@DADADABA@A@AC@CAA@@CBABAFBFBJBBBJBVPXTTRDVTN^RLNFFHJ`LXRFR@VBTHJB@D@B@DBBBDFDDBDBBBBJHBDBBBDBBF@D@BG@EBEDK@GFE@EDABBJHLDRAjCD@FAFAFEFCB@JCFBDBDHDJ@JCLGLCDCD@DAB@BDBL@D@NEXDNB^DfA`E`EZKVWRSLObE`AVAVI^EJSPWR_VSTK^ELQLSJSLSLEN@F@ZANDDLJNRNTFTFT@TDRCLMRMTGRAD@D@LAJCRGPCDCHE@G@ECIGKCI@CBGHMJIHCD@D@FBHD@B@HABAD@DBHHPJJFD@DFJDHBDBD@DABCBEFAB@BDDBH@BBLBBBBBBB@B@B@D@B@FBFDB@B@DAB@DAB@BBB@FD@@FALGDAFCDADAH@FAJCDAFABAFIB@B@B@NFFBFBFBLBFBD@D@D@BCFEFIFELGDC@AB@BCBAFCDCLELCDCDABADKBCDCDCDAFADADCB@H@DAJCDAJCPEB@@@AD@D@DBDDFFFBD@F@BADADAB@DAHCHAD@DDF@BB@D@BBB@ROJ@RRLDFEJEJEZKJCLALDPANJJFRFTALARCHCBEBODEHAF@NHTJL@\\@LCNWBIG]BGLOFOESKSDOJOUOQKCQRAL@DE@ICMIKUCQBUAKEOIEOMUKKEKGKKUIOJ]HGBMOIKAM@MFOJONQH[@OASKeQUMIICKJMFCPEFIEUDODKHQJUBUIMIOOMgQYK_QSIUOKMIUEWHONMVETENIHKHOPSNKZKLGFINWHOJSPULKRMNIDKE@SAKCGEEMEG_SeGSG"],encodeOffsets:[[98670,31148]]}},{type:"Feature",id:"540330",properties:{name:"边坝县",cp:[94.7078,30.933652],childNum:1},geometry:{type:"Polygon",coordinates:["@@UMGIQQMKWMY@OOGMGOMMMEYCUIIMEIGMKKcDUAMOOKUFSFUJQHYFCFC@@DBB@D@B@D@BBBB@DF@@GDMDW@MDGDKFsj_Z@BANGJSGQ@MNKRADQVOHMDSGWEa\\OHQPMIUAQASBQ@QFFLENMNKLOPKLMJYDWGK@MLBN@P@NGJQNOLWJQHMLIRKRMJIFOAOBQDSJGLCTHJLPLLXLBRILIRIRERBLIRWF[FSGA[PCTFNJHPDNHJHPFLJFRHRFJJDX@P@P@RJRFLGLIVITCLFPQLGLCTAZERCR@TAVLPDNGTC\\JLG@KFKNCNAN@RIJKJMLMJMNSRCXCVEXBFJHHTDNN@\\LTNIPAPCT@XDTCTBPATETCXAZCRIVETCVIZFTBRJPLLJPBPER@TFPDV@NKVSZCXGTE\\@VHFNBVDRLXBRJPHXKNQHQHKPKZEZAZMNQNOVONMTETEXCV@ZEVKNWLOBUDKRFXHXJVJ`NVHTFXHVZN\\HRHNHHJCTMVCZBXBRHTLXLH@DAF@F@DADADADBD@BB@D@B@FAB@BABAFC@@DABAB@DAF@DAD@BADAB@DAB@FAB@DABAB@DAD@F@BARFVDHZJN`@RPGNIHDTRBVAP@PEXGVCVBVHXLJR@BHTJZLRRDTRTPLCXLPTNLVJTDRARSHG@QIKOMQOEGEMFIPSTM@WFUDKHMHMPGVAPAXBRATFTFTJLFNRTNXJNHLFNFNPNCNEJIDIJI@IEIMKIISGKQDQAUISAKIKKUM]BUEQAU@WAUDYAWDSDMDKFQHMDQDMLKPGJMJOXOHGRDDBVBTKNIFDFHENFTHDRADDAd@BBDlIPPNHH@H@VDD@LAX@jV@HIRFLTB^ELHVCCQ@IHATB`PDD@DAHABBDBDBBBBF@DBFFNDBDBBDBRABIHA@CBCBCDADSXMNADEHAJ@F@BBB@B@BCFBBBDBB@BCJCFEJCB@DCFABC@IFCDCHABCBCB@B@DHFCNRAD@BBDDDBF@NBJAFAHAHCFADAF@HAL@DADADADAD@DAFKDCBC@AFIFAPFDGEOFEFIDIAMFM`c@KZMHEF@F@F@F@LAHAF@HCD@FAF@FAFADADAXAj@bB^E^GVKPILMHKDUe_CMEIBW@AAAAC@C@I@EBIBGBEBCFIBEDI@A@C@C@I@KBE@IBCDGBIHQDGDE@GAGEGKIGEAEBCAAMICC@CBAF@D@JADALGDGLKNQJCDAPGNKFGLC@C@A@AACACEOBCHCBA@EF_EY@OKOEOBSBSBUDM@MOSKCMBODOI@YIUGO@QBCRGZE^ILMVKNMDQRUFKIQ@MHULEJICQAUJMFQAMGGIIQBSFQDQRSHWDKJYPOCUIWEQBIK@Q@QKIQAeRINYNQRWRULWL[CSGOMGKMUESDQGIAKLQNQNOEQUE_DWFYDMC[KIOUEQNKDENKLMDMJM@KKWIA@WEYBOBKNGROHQDWBODQDSPOF[CMCOGKKIESGUC[JSJWDUBUFQRIJMFQ@UCOISKKIUE[DOBUCQEEMEOKAIA@EDCFOAKFIFOBEAAUCUAOESF[D]@CJMBQGUEW@WLGLW^UAUECWDOGOQCY@[B_@YBOLCPBN@LBTAPWLKEMHYF[B"],encodeOffsets:[[96587,31331]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
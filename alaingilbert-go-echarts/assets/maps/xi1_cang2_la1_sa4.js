@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("拉萨",{type:"FeatureCollection",features:[{type:"Feature",id:"540102",properties:{name:"城关区",cp:[91.140552,29.654838],childNum:1},geometry:{type:"Polygon",coordinates:["@@WGEPGNCX@@@DBBAD@B@BAFCHAFADGNAB@DCB@BGDADCDADCFABABABA@C@A@ABABCBCFABCBCFEF@@ADEDEFADAD@BAD@JAB@D@DAFADABA@@H@H@HCJ@H@BED@BE@C@EAAAICE@E@A@@@@@@@CBAACAA@A@AAC@AB@@G@A@A@AAAAACAA@C@AAAA@CBAAA@AAAA@AACCAC@A@A@A@A@A@@@@@CBC@AB@@A@@@AAA@A@@AA@C@C@@@C@A@ABE@KC@@@@BFBDB@BBBB@B@B@BABAB@@AB@BBB@DBBBF@@B@B@@@BDDBB@BBDBDFD@DAB@D@@@BB@B@@DB@B@@@BAFGDIF@@ABCFABCDABAB@DBBBB@F@D@B@D@DFLJL@VOJQHOLAVJPLRFNALQRSFX@LAP@J@NBNANARAZEB@RCPFFDZL\\HXHbNZ@VGPELEJE@INSFKFKBM@AHQNMLGLGPMHOFUJOPETGLIBOBCAAEOGO@UDUFYJYHUHOTQFKAMGMIKIM@OFUwEMA}EKNQIOGMGME"],encodeOffsets:[[93376,30220]]}},{type:"Feature",id:"540103",properties:{name:"堆龙德庆区",cp:[91.003339,29.646063],childNum:1},geometry:{type:"Polygon",coordinates:["@@GQEOOOQIKGSAOCOBQKIKKOGMMGSNQ@MLQLSPS\\QNUVQVCXDPDRJJFHDD@RKTKH@@@@IHQJWLWLU@WDcRQBICKMKEA@ICO@_DcBSCS@[GIAICMBMDUDSCSAGDMFWDKDGEM@]CQDKHSJGNODSAGCMGGGMGO@M@ODQC]@C@MAWFIAMQSCUCQA[GKAMASC@V@V@F@@BBBB@BBBAD@B@@@D@BAB@BABABA@CBA@C@CBA@CBC@CBA@C@ABABAB@D@BBB@D@D@B@B@@ABBB@BBBBB@B@B@@A@CBA@ABAB@BAD@B@BBDBBBD@BBD@BAD@B@B@B@BABBB@B@DBD@BBBBBDBBBB@BBBBBBBBDBBBBBBBBBBB@@AB@@A@A@A@C@A@@BABAB@D@B@D@B@DABAB@BCBAB@BABBB@BBD@BBD@D@B@D@FAB@FBB@D@BBD@D@BABAB@@AB@DBB@BDDBD@DBB@DAB@BABC@@@A@A@A@@@@B@B@BADBD@DB@BB@@BBB@FJHNHNVFFMN@JDHLGVAPLRBNJRDRCVLDNFLHINAZCVDRNLLJHJLLJJI\\ABCHABAJ@B@@DBFB@@@B@@@BAFABABA@ABEBC@ABC@ADABA@CFC@ABH@PDRBJ@LBHBBDH@DHDBF@FFDD@LD@D@DCB@FBHPADAFBJDDBD@JHXBDFFDDFFDDDH@FBBBBDHCDCDCFAH@F@FBDBDBD@DAFAF@DBF@D@DBDFDBDBBBHAFBBB@H@TFB@HBD@LBP@LBCPGRAPNJVJN@RHZGPK\\@PDZCPDRFLDZENERHZFRHHNLLR@^AX@TCRERARCR@VDR@VCREVI\\CR@RC`@TFHJZ@\\AVKF@D@HDFFJBNBH@F@FCFAJ@N@HAJIFCBGBGAEECIEGGCGCOEAOEIASCECGCEGCCAC@ABADCBA@C@CA@UM[I@@[E_F[AUKMCSFQDOCSIMOKMOAMEQSOIQEW@U@UBWEOG@S@OBK@QJWNUBWKWK]B[DW@_J[PMDYGQKSA[DWTORGPFV@P@FKNGRCLFNDFJTBTCTCPBXETA\\HTERQBKEMKQIOBUPKRGPI@UIKEK@C@C@A@C@EAAAA@CBABADCBADEBA@@JEHCBE@A@@@ACA@@@AAA@@C@A@CBC@CECAAAA@CAAC@@A@A@@@AEAA@CAA@ABA@@BABA@A@A@AAAAAA@ACAE@@@@LDF@BAB@D@@@D@D@B@@BB@B@BB@@B@@@BAD@DA@@@@B@B@B@B@B@D@DBBD@BBBBBB@BBDAB@BB@B@DBBBDBBBBB@B@H@@@BAD@BBB@B@DBBBDA@@@@@@B@F@F@JDBBFBD@F@@AFC@A@GDI@G@G@GB@BABCBE@C@CBA@IBC@ABCBCFEFCBC@@FEDEDABADEDABABAB@D@B@BABABADEBCDCBCHC@ADA@CBAHMBCBEDGBE@A@ABCAA@C@@DWHMFOICOM"],encodeOffsets:[[93351,30207]]}},{type:"Feature",id:"540124",properties:{name:"曲水县",cp:[90.743853,29.353058],childNum:1},geometry:{type:"Polygon",coordinates:["@@MJA@C@G@GAIEiOOE@@A@G@IBIFCBC@CDCJADGFADEHWTED]`EFGDYF@BBH@DBDBDDBBDBDBBFDJDJHB@D@BABGDIHEBAB@B@F@DBB@@@DAB@B@B@LL@TZKLELCTFHXCNCPJPBTATQPQRKX@RNJPPVPP\\BDFPBD@@BXEZG^AVKXPDTBHTETBNBRHLPJLJTDNBLB\\HRBVDTDNRJBXENBD@^@RDPCN@P@NHHHNHHDTBPCHMTILGRC^DN@HFLCXCNEHCTBTDVCNCNAJDJB\\HT@TDdA`CP@JDB@LFLNJDRAdQXCV@XKXKRIJG@@@@LGLS@QCCEGIICQCODWRUVURMT[TORKNKEGDMDMCMSAUCMIWOOKaSYIYE[C@@_AKOGMSI_EUIYAYAQCGAcKcCaC]E]EK@KAGHEBADOFCDA@SDWDOAMAKICAIAICECCGAAAAAAA@GBEBCAIEGAGCCACE@A@G@AEG@AEG@@A@ICOACAK[WEEIIGUAELKDACSK]OSAA@@@@@WAiSI@CFC@EBC@KGG@A@C@"],encodeOffsets:[[92668,29950]]}},{type:"Feature",id:"540121",properties:{name:"林周县",cp:[91.265287,29.893545],childNum:1},geometry:{type:"Polygon",coordinates:["@@KBUEWEUGOIUOELELMT@JIFKFOFUHY@aMWG[GYKECOEQDA@YFQBMBMBMAI@O@KBW@[GSBWFOASDSDSAEIMCKEQDMHELO@U@OEQHSPCXB\\LTHRCZONI\\@`CXA\\L^LXAXMVIX@RAL@P@TPHXFVAV@X@RFPJRTNFPBLNNPTJPDRCTENDVL\\B`E\\F@@\\JVNB@@D@DABCDAB@BBDDDFHHDFDTDJBPFFBDPDHHHJFFDBFAHAHEDIJGBM@I@EBEDE@G@MAIAEEGCC@E@UL[BY@GISE_@ARB\\BXCTCPGTFLBLEPDTDNHNNDP@PKPGLCLGTPN@PCTCLHHLHRDPPRLDLNNDPBTFJHDRDRCT@NEPFNHLANHJPDRERCRAN@LFPJXJZHdBZFNBXATAXJETELDLJHHBFBPCVIT@XNNAZKTBHPLPFNNHXEFJMPDLJFHRNL`F\\DLEVDLHFDVJPJXFX@ZAXAXCPIJILMT@VDV@FD@@B@DB@@BCBCDEFE@CCEBCJCTG@ABCBEDAHADCJAF@JDLD@DAFBBFBBLJHDDBBLHBDBDBDDDDDBBF@FAF@FAJCLGLGHLNLNDFBJ@BAB@BGHEHCFABA@ADAD@D@F@H@D@H@P@D@HCLCBAH@D@JABAFADAFGBABB@@B@@@DDBBDFFDB@FBD@D@F@DBD@@@D@DA@CBCBCB@HAD@B@FAB@BAD@B@DIAGCAIGGC@E@A@CBC@C@CBEBALODUKSCQ@SDQHMASASDUDUHUNERBJF\\JNENCNENBPGCMDKEOCKUIUQGMMAOHYBQFUDQJWCUMOMKKQMWIOCSBO@SCYMSISMOKKMCOMEODMEEE@A@E@A@A@A@@A@AAA@@A@A@CACGIAA@@A@A@ABCBCBA@AAAAA@ABA@A@@@@AB@BAAABGAABCDEBE@@@ABABABC@@BABABGFEBCBABCBI@EACACAC@@@E@ABABAHCHCDCBABADI@GBC@C@I@GACAAAC@@@ABAECCAA@@@A@ADCBA@A@A@C@C@C@EBCBEBE@G@E@EBSBC@A@CDCBCBABEDABA@A@AAAA@@E@A@A@CA@@@@AA@EBA@C@AEU@W@[KKSGWGUAMCKIKQOJQF]@_@cF]AUFWHcBAQFOFOEWOMGK@SB[BYA[D_AECOEOHWNYVWJKJKNQJKDKAYOOKKMKUKYE[E[@]A_Ca@UIUAQCOEOCUDMPKTMLGB"],encodeOffsets:[[93457,30491]]}},{type:"Feature",id:"540122",properties:{name:"当雄县",cp:[91.101162,30.473118],childNum:1},geometry:{type:"Polygon",coordinates:["@@GMEIA@AA@@AAA@@CACBC@A@A@A@@B@B@B@@@D@BA@ABA@CAA@CACCC@AAA@CBA@@BABA@A@CAC@A@CAA@EBA@E@C@A@CAC@AAC@AAABA@ABADA@ABABA@C@A@C@A@CBABA@AB@D@B@B@B@@@BA@@AAAAAAAAAACAAAAAAAAAA@AACAAAAA@AAC@C@AAABA@A@A@A@ABC@AAC@AACAAAC@A@ABC@ABABAB@DAB@@@@A@AAAAA@AAABA@@@A@A@C@CAA@A@CBABABAD@B@DAD@DAB@DAD@B@DAB@BABA@ABA@A@C@@@ABCAA@AAAAA@@@E@U@UKIOIGKAQAMFSGSSAOCCD]LMFIRATARMHQLQJYPSL_RYFaFMRMRMLQLCRDNFXG\\OHKLNVDXER[LWJUFMLGJBZMHULYT[D_DQHMVAXLRVHTFNELJ@NBNJPFNETMHMDUJIFCNOXKNQBWKUAWJCXKVOFYBUFIRKXARMLGHLJHNDTFTEVARDVPDNCTCVDBVBVFLLTPVLTLNLLJRAPDVHTNNHLLJRHPBHP@NEJEJLPPHVLFTAPBRALBPDNBRFPNNLHBRHNLLTFR@TDXDRJRFXFT@V@RALGPQNKVB\\DJNFPDPANEPANAPFRRJXDVBRHLLAPBPFPP@LEHKZCTHJFNLHLLLLLPFT@LIPEPDLJHLJJZNPHNLPJRNJHBBJPJFTHRBJFGLKJGHGLGPENKRGLEJAJABADCDCFAD@B@DAFADCDCFADEDEDABCDADBF@FBDDFºƘlªTnL`THdPhFH@b@DDt@BBBHFDFDH@BBBBB@@FDBBD@DBDBFJDDFF@BAF@JBBDFFH@D@@F^XDAD@DAHAFAFAHAJAFAP@JCHGXIRELI@ADEDCFAHCBA@@BB@DDHBBDDDFNJFDNHHFBHBJD@F@B@@AF@B@DAHEDCDAF@PCHCBAB@DCDCBEFEBC@ABCBAFCDABADAFABAFEFEDCDCBCDCDK@ABCAAAE@@GG@A@CFWBKDAFCDARCBB@@BF@BBBBBD@JBTND@@@BA@@AEKUMM@A@AVKJEDALGNGLGFCLGPGJEPEFAFCD@H@D@DBDBDDFFDBDBL@BBDDBDBDDHAHAFJFHHFHFD@D@DKLODI@AB@BHBHDJFLDJ@bHHBDBDDDDBFBBFDDD@B@DGDC@SFEBEFCFAF@BC@UFAFAH@DBF@BCFABCDEHGJCJ@HCFBD@BBD@@BDBDBBBD@D@F@BB@@BBBRJVJBQJCFCJCHAJDD@HCHALAB@DGBEDEDCLCDABAB@@@@BBDFBHDTHfN\\JfDDABAHAFABCB@D@DDBBBBBFENJF@JÊ]EGCIDIDGAM@EDCDKBEBK@@BAD@DADADCFCDABADADA@@@A@A@AAA@CAAAQ@KHQ@^aIXwDU@AFCJIPWTGPKBQDWDSHUCSAQ@OKOBS@KPGVDZAZERCNKHQB[HMPKVCRCVA\\AZFXD`LhL^NXNPLZPNJRFTAZBZFPJJLJLJJVBDKHODUESCSDUFURQ\\OXIRIZKXGTILMNQROBABABABADC\\a@@CIAC@CFCJCFALEBCFEHK@IDCDADADA@@BEBCFOBCBABCBA@AB@BC@@@@@A@AAAA@A@CAC@EE]IUBQEMOMEOC_BKIBM@OACAAAAAACE@A@@EBIDC@IAMC@C@MBGBG@A@ADAHEDCDABC@ADABA@@@@BC@A@AABEHCBEBABIBC@G@ABKDGDC@O@G@C@G@E@C@C@CB@BABEBGDGFAHA@ABI@EAMCMKGKKHKHIDEBE@EBE@AACCCCACACACKGAACCIGAKEAAABE@CKCICE@IBCDGBCBAFAD@BSHIDADDF@DEFCFADAD@@CAA@@@ECU@UCS@KNIJOJWDWBYBW@WEOIUIECKGUCKF[C_EMKGQIECKNOEIWFMGEMKOGOSAYLMBWMS@UJODEAGAIGCKFKFSWISBWBMAYEcAYGWIOIKEM@QBQDQFOCGIBMGKEMFO@MDSCQCQIGSEOAMCKMKCOQCOGQGKKGSDODM@SOKHKDOHOLO@MCGMCMCSFOAKEKHSDODSAWA[BQQDQ@[DUJQFUDQ@UCQ@QDQBQFSDW@]BQ@KKGMQGYEQGMFYFKCQEOCYDOC[@OLYHQGM@UIMIBOHQDOKAO@KAC@GAA@SEG@A@AABEAGAAACECAC@C@CAE@CBEBE@CACACAC@E@EBGDEDCDCCGAAAA@ECGCCEECCEEACGW@IACCCAIBEBCGOEAA@CDC@C@@KCCEEE@CACGG@ACGAKAI@QAOCG@BAD@DEB@BABCD@BAD@FABAB@BABABE@A@@@A@@EACA@@@ABIBADGBAJ[IIKKGIKIMKCQDUBYJMKGMEKCDUCQIQAMKQBOHUGKICM@ENUEGM"],encodeOffsets:[[92657,30473]]}},{type:"Feature",id:"540123",properties:{name:"尼木县",cp:[90.164524,29.431831],childNum:1},geometry:{type:"Polygon",coordinates:["@@@QLWRQROBSASIODODMGWSEKDKFYL@SKKA@A@A@CB@@A@CAE@A@A@ABGFCJAHABC@A@IGICECAAACACCAACAC@CAG@ACDEBGFEFEDABABABC@GBM@[FC@IF@@A@aN_DMHUFiCK@EAE@E@QNcRQ@C@aCmPSECABIBgBQ@MKSEi@W@YIE@IDIDQCQAq@GAKBYFUDIJ]JCNMNMJWNQJWBOLMTSH[HWDOJKLAHDTBL@PATCZQNQNATJNXAZ@JDDF@N@HBBJ@HCDBDL@DADILA@ULGDIFMJKLKPGREHEDGHMFAHPPHBJ@DFNNFHA@BB@@DDTRJRBLBDBBDD@DFDB@@D@D@@BBF@H@B@B@@DDBBD@@@BAJABEDABAD@BDLAFCHABG@E@CBCBA@@BBBB@D@FDHDFDLFLDB@B@B@@CDAB@F@F@@@RFRBHDZLAFELBLPLJPDNLLJDDFENGFG@KFKJEHCJELGHIFGJEFEFCH@HJJBF@FAJDFBDHFDF@BABGFCFBFJF@DGTEJEJCNAFGFGFAB@@HJBJBBABKJADALHPBFAPAX@VBJFNFLLJNHVBTDJ@JAHCFAF@FDRAVCTIRGRQXCVRLAHGJIHGJCTDPEPIN@TARHFBLFNDXJHGNKBQLWJQVEZAPELUDWXIVBXLRALMPWDMJEVINCNGFSEMIOAM@MKIMFSEUGKQBWNURG`C\\CZSVKNGAYHINKVEXI\\KFQCWMULKPGH[EWCMDQRKNKNQNQbEZE`QTKZORIRKNGBQBSJQNE^KDCLWBUH]FYAW@@ACEOACO[UOOOMI"],encodeOffsets:[[92602,30111]]}},{type:"Feature",id:"540126",properties:{name:"达孜县",cp:[91.349867,29.66941],childNum:1},geometry:{type:"Polygon",coordinates:["@@BZCLILMRILILUXMZGXFPDPBFC`B\\AZA\\@THLPNFXEPEPBRdAXGVE^BdE`@^@REPIHUXGXMdKbEXBTHTFVQTUJSJSJU@UEUKKQUI[@WA[CWMQMSCIEKKWIOGW@WHSHSBKMMYIWGMOEYEOIKKMCOFKNGC]KOEOEOLEPBNGBQDWHQAM@IQMOCM@QLOJQAMGOMOSOGWMWIYGYAIMGISEUESEOIKOKKIKSGSBQFOJOJMFYCQFQJMPGJUFODMBEV@PJNJLHNBNELSRGPGVIZEZCV@VHPFPBBADAPKJSHOFIPEVGPONKHKHMNGR@BANVPPJVHXFVFLAHANKLSNOVCPDPFRDVBVJb@`D^B\\@\\FZFVLNLLLPP"],encodeOffsets:[[93660,30512]]}},{type:"Feature",id:"540127",properties:{name:"墨竹工卡县",cp:[91.730732,29.834111],childNum:1},geometry:{type:"Polygon",coordinates:["@@UDOGS@QB@JBNGRCXARMHOAKFFPFPLPD^MHELDPLNJLFPFZNPXHZJNNALGTGT@XHXJPLXFLDJNTNRDXB\\@XJ\\RVLLFV@VIVITITSVURSESGWAaFcLWNWHGVLRLJNDVBXHTHLL@\\@XFV@B@DAB@FBB@@@@DBB@B@F@@@BBBBB@B@BAFCBADADADCB@D@TAFAF@H@F@FADAFAD@D@D@B@B@B@DABCB@@@B@DBFDAB@B@@BDBBBD@H@J@DAD@HCJABABCDGDGDABAB@B@F@@BDBDBD@FAJADABADEFAHABAB@@ADABAB@B@@AFCFADBBAHBBABA@@B@@B@B@BAB@BBBBB@DADABAB@B@@@BBHJBD@D@B@BB@BBB@@@@B@B@B@F@BFFNFPCNFDPLNPLTNTJZNTDP@TAPDXJRNLLPNVNXDRIVCREZAPGNBHNVRVJZQLETMJWBUCQBWBEDEZGTFFL\\TVDZERCFALCNJLLTJPFPL\\CXITGRCRGRCDLTPP@JITHFLFT@LBRHPFLLTDLDVRLLHDL@NFLLH`@VK\\IXMLQNWRSZG^CXEPI\\EHLBLJHPCXGRFTD\\G`GLGZIXGNELFPGPFNARINCNFXCPHVD\\@PGTCTCLGLMZKJIDMDKMQKCCK@KSIUMKGEKAODIGUKDQCGKCMAO@UEKOES@OCOIWAMNMFQFSIUKSKUKMGAI@KMGOCKMBOJIRAVIEQKMU@YCMGKOUAWIMMAMEKQ@ORYJUCWOMGQMIKOISMSGQOQEWAY@YJOFUNQBKGDQEWMICOLSAOMMBQVMJANA@@ZGVEV@ZGRCVQPONKFMLKFKJQBSJSLGP@LHTLPPDNDNBNRFZ@REZ@ZCH@EIESCKCGEMEMEECAEAGACEAE@ECWBIHIFGRWLGDKDS@CGOAC@OFM@AGIOKEMBMHOLOJKBAAMCKOGSEMCUAOECAGBEJCFGFC@CGEKAEGGIGICACGIECIEEAAK@A@UGIOBQHKFIIGKIQKEQCQD_FQ@MDMHEBGCECMEKKIAEASIAAGMECMGEGCCCAGBIBM@EBIJIFKFIBI@EAEAICQGQDKLGPCLM@UDUCMDMFILKPIFQFKISBWHW@KDSCIMIIQBCPMJQDWF_@_BMGOGQFIGCMKWEKQJOBKEKIKIQEUDOBSBEKAKAMQCQAcCGZKHWHSBKQKOSBOFENGRJTFZBR"],encodeOffsets:[[93833,30286]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
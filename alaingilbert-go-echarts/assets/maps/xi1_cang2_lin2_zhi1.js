@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("林芝",{type:"FeatureCollection",features:[{type:"Feature",id:"540402",properties:{name:"巴宜区",cp:[94.361094,29.636576],childNum:1},geometry:{type:"Polygon",coordinates:["@@SEQIYLeLWHQFC@I@I@QBQFCLAPARINKTQROLKRKNEHCFOPMRSHMJUNUCYCWGUCSASSIMIIMQUIOCS@UJOBSBUDQFORIBOAQOO@KLAPAVIP[HU@]@]FUEAQFKBQAOGISIWC[@UOOMSMKGYQUOaGcGQBYACB[D[FMBBLLRHPDPCVIPETERETDTERCPGH@BIJMNKJGPGPENKLO@QCMBMJKRGROLUJDNNPHNGTKRONSJWL[JWLOLUNCZFRJRJ\\LPRTPPTLRJRH\\HTFXLZHTLPTVHRDXBVDTBREZA@@`B^GXD^JZLbLVPf\\P\\@XLXPV\\DXAFMHQ@KJCTDNLPLBB`LVHNKHKNETJRJRFVCbFTJTTVLL@DIB]@OHENANJPLLDNANOR@\\ARBXBZBXER@NFVEJKPERBZIHKFARI^DLBFV@TTRLLPRL@@@LELEBAHCB@F@HFFDBDDBAD@BADEB@B@BBDFDBFFFDBB@D@NEFIFALIBCDCD@D@JBFFDBD@B@BALG@ALBHHDDLADEDAFFHBJAFIB@N@JFF@FCDE@KHCJAHEDAF@DJHHLJHHDJ@^EJ@LD`AL@DAHIFOFQ@Q@SAQ@EBGFQLI@ABBBHHDDFDAVQTGJQRM^OTOP@TBNFJEVEVMTQVUHS@UJERRJNLRHPF\\DTJTJPNJTDPHXBPHRRNPJR@RCJKPKLMNMVMXATCTE`MPDJJPCLMTEd@PFRHPLLIDMVKZCV@NFLFNG\\KRKZCRG\\K^CZDZDfHZCDAHEJENOAKEKMKGSHKTCV@PIFQRKVDVFNLJLHJNB^ORMFEHILIXMTBVHRHZHRB\\@XHZFXAB@Z@dGXMBABCBCHCDCFEBC@AA]AODKDG@E@ALIFI@C@CA@A@CBEBABCBAB@AFIDGFEDAF@LDNBJDNFHHJFJBJDFDHBD@D@DABGBEBEDEBCFAHAFCD@B@LAZE^Q\\OVIXOTQVULQXIXGVDZB\\K\\SZQqwQqBibgVAJGGIE[QWMOMMQKMUUIgWcJUDSBQJ]BUBcCWB@@ACAACAA@I@A@C@CBABEFABEFA@@@A@AACAA@C@EBSFEDGHGFC@CBC@E@ABCAE@E@C@C@E@AAGCAACBEHIDABIDCBEDABABAFCDABEDMHGBG@EAC@C@C@CACAABC@A@ABEDABCDCBCDABCBEJCDADEJEBC@C@CACAC@EAC@MAECG@IEEAKAGAAAEBEBEAE@CAKCCCAA@CCECEACCCGEA@ECCAECAAC@CAC@CBE@A@C@I@CACAAEECACAC@CAE@AAAAAEECEACAG@I@CBADEDC@AAAAG@C@@BADC@C@A@C@@BABAHEBA@C@ABCAC@CA@ECAACCAAAA@ADI@CAE@A@A@A@CAACEAA@CAI@ACAGACAGAEAACOOGICYDUDWJOEYGIAGFKFUC_BWGMMDCAGCICG@@C@GDEBEEOOOSGGIO[CIEGGAKCC@EBAAE@CDCF@@CB@@CBCBA@C@AAEEEAG@AAC@CAKDEAECCC@KAMAI@CCGAAQBA@SBGAKAE@IEC@CAEBCBCDADCBQ@KESIC@MDG@EAIG@AOKAGAAAAAAA@EACAC@CA@@ICC@OBA@WHIBC@C@C@GAA@AACA@AECECGIGECEEGMGICKGGGGKAACCCCGAEAE@GAGEGEGGEMEEEEAC@GAECCEAGAE@G@GBUHCBG@@@WAWHYJaP[DWHYPW@"],encodeOffsets:[[96541,30101]]}},{type:"Feature",id:"540421",properties:{name:"工布江达县",cp:[93.246077,29.88528],childNum:1},geometry:{type:"Polygon",coordinates:["@@QCYGQIGOKMWMcUYQQGOGQBMASMMSYI[@_FKDMPGPMbQDSCMKGIAYKMUAQ@MSESAQQGQJEHELIVMNILS@OCMNEVAVCTIRUJKHAPINUBA@SHULMNMPUTKTKPFXFRHNBLIPIRMNMJOHSHQJONGPMHSBODUBQLQJMCKQIKCKUG[JMIIKAMCOYBYDMDSCQEMMIKMKMEUAWBUBQJBPCNMLERMHQFWBUEUIQKOQKQKGMHMFSBO@SEOKMIISOWMQIOMQAODSCQSIMI@W@MIEUFQHSDMFEPAR@@IPATLNLL@FBLARARDTHPCPFDPLJLJNNNLRJPLNDNKHILMLIXBXB\\DZC\\KJSAMESEKG@AE@EBCDCDCDC@E@A@AAABABA@@D@DBF@B@BEAMBGAG@AAA@@@EBGBG@GACBABCXKNE@G@G@YDY@QFY@QEAMCMCMOOSKKGO@KHITATIRELKLENMLOPURQDYHU@UFYH@@MBIBUNARNNBPKTDPNJFXCRLHRAVMPEZIZ@XBRFRPTHTNPJJLRNNHXPVDZIPQR@FLBNNNXJVBLPNHZDV@LNFRUJQBIJAPLNPDNH@LBJNHVLTLVLTJRENENMXBPJPDT@PFFL@VBPDNHLRDLCHVCJBPFLLHVNTJ@LDLLDNRCLCNIJNN@dCZBRFLBXIRCTPL\\ARIZOXCTNPANGTBJTEVOLMNHNTBVITC\\INHAREXCVJLHZNPFPNPTBP\\CLHPJVERIPONQNKFALBPLHJVJTHPTRNDVB\\JPHRDRCLAR@HMNBNRBPFTDT@VAR@PFJNLNDP@NAPIRKTGXERMTIRRHLN@NETAZILSCKIMAMDMAOAS@UFQXQbIbC\\IVSFMAQCIPMTIHWJOLSJMPKTCRLVTLPTRVHJJRFPHFRNLPFRJFLBP@LZN^IXOXS\\S\\@TDZ@ZANKHERSTQ\\UXMRKLWAUGQKGWECM@YH[RQTGVQbMVEdC^IdORDX@REVUXETBVG\\BV@PDLHBJJJTHR@JOFOXINDVD\\D\\Hd@RMVK`ERHLLDP\\NVFXE`IXBHLJN@TFRZDNBZITEPOXG^KVIXGTBX@ZER@LFPLNBTEREVEVATAHOHSBOPETELIHQLQNGR@HMHMLQLSPOPEZALFVDNGNOPMPONMLKLIPCFQVIT@VGRUNSFUFUEIAM@SPOPSN]RQHIRSBUECCCGGAABAJ@RKHEFAR@TBR@R@PEJEBG@CBKC_@KFI@]CIGGKIGGCIE@CBGFIBGD@LCFEDE@IEM@A@EJIBGAEECBCFKBCCGGKA@BKHABA@C@CAEEIAC@C@CDADKJEBEJMFC@A@CAEEAEECAC@A@AFABC@ABCCAACECGEE@A@GDABKFKF@@K@OQKKSQ@SEUKA]CQJEBGLYJQAOFILUFMEQ@WFYAWAQA[BQ@MPMBKCOKMIMBGF@PA^CJK@UKSSSIaEUDQEQISIMFGLMLUG_KAAOKMKSCID@LGRENWB[COUKW@WO[e[UOaKYK]IWC]H_A@@YBQFSAUCWAQCUGOSSKYGWKSE[GQGQISKOOQSKOI[IQEQDYVMPKXK\\IXKTIPMLQHSGMMOCMVIPKHQLQNINARDP@LKFMHOHOLINMJI@AHGDOFQCSFSFQFSJODUCOGOKQAKUJMBKLJ\\BLALGPIPSPIPIPKRMLSJI@QB[EcBWAWDKNERATQJeDWGeAOD"],encodeOffsets:[[95659,30268]]}},{type:"Feature",id:"540422",properties:{name:"米林县",cp:[94.213679,29.213811],childNum:1},geometry:{type:"Polygon",coordinates:["@@COIGeGOMIOIOBi@[CEMGKAIBSTKZGDOESGQKMQQSSKOIKMFSFMAMMBIHMJIBWCYIOEOOYB[DU@Q@OGQEGOAOKKMMSKOGGEYSWO[M_DIaQUDGfULYBWEOEUGQBUDCAQAEAEACAC@GBEBIAGAGAIEE@E@@@EFEHCD@D@FBDDHDJDDFDHBJDHDHBHDJFDFDFBDBFBBFDFBFDFDH@DDDDBDBF@HBL@J@FBDDDDDBD@BAD@BBDENKRMPIbP`FRCVKNG\\PRRRZRBRI\\ERCDAH@F@JBJBF@DEDCB@D@DBDFDFDLFJFHDFFFFDJDFBD@FEHEFEFQBYFY@oEWCQAQASAYEQB@@wRQHGDCBSNK@IDKFG@ICMAQAGBIBIFAD@DBH@HADIHIJEF@BAJBDBBDBHBPBF@DB@@ADAFENABEHCFCHEHCHEDGBGDCDAD@F@FDFBDCDCACAECC@GDGDADAFBJ@FAJ@HAH@F@N@B@FAFEDCBGBGDGBIFGDCFCFAH@FAJAH@DALEHCFGFKBIDMDEBEDIDABBF@DHFBB@F@DCF@F@H@HAFCFCDGHCBGBIBAFBDAHCDEBC@CB@BDBBD@L@BBDFJABA@iCI@Q@iAT\\Eb@@@INCFADGHFPFZABZEDJDDFBFHT@F@DADABGBGD@@gbSPOTIRFTJNHLRHBRFTNTR@VBLNBZHJNLTDRCNaHONOLC`E\\@ZJNTTNNBRAPHRHZRdVXNLNHPRJZHRDPCfBXHfCRIBSFQLMXCXBdA\\FRAJ@TINKLQJOJOTOJOHOBKAKI[LKNAVINA\\E\\CDAZBRAdHbHVPZRLHTNPNVP\\@XDTJHJBPARELBRVF^E^@V@\\GJOBUBOLKP@RPPBJAPQREVCTAPAVIT@PDVJNRJJJNTTTBVDXHZDVDVMNITGNQPODEFGLMLQPKRQLSJMBQBODKRERAJ@J@D@REXGfKZKRJTFX@ZOXG\\CbOZIXGXB@@H@DAVGHAH@F@HBFBDDBF@HBDFFFFFNHHHFHFHBF@FBHBDDDDBBHLHHLHJDNHFHDFHFHJFDFD@BDBBBB@HBD@D@D@JAXGB@PAD@JD@@DBD@DBFBB@BBBBBBBHPL@BJHFBH@NCD@TJLFR@DABCDCDAFADBD@JFF@LBHBTAB@RABBDH@DBJBN@LDDFDFBLCDBD@BBH@FBFFBBD@B@DADA@@DA@@DEDCF@BBFAD@LDHBFHDJP\\HJTHPPFPAFCF@H@DH@JDHDDBNCHNAXD`EVELBHHJFZIPCXCVDZHJPPBDFBHBDBHBDB@BBJ@DBBDFBB@D@B@B@BBF@DCJ@BBBBBDDBBFDB@@DBDAD@B@DABGFABAB@@@D@B@DCDAB@@@DBHBB@BCDCFAB@D@JBHBDDFFFBBBB@BBF@DBDBDFDBFDBDBJ@D@B@F@DAD@DBD@BBFDDBFDB@HFDDBDDFDF@DBBDDLDDBF@FBFAFABBHBLBFBJFH@FDNBD@FBD@DBDBD@D@FAFIBCDCFIDABADCDADCBAFCBAB@D@BADBDBD@D@D@FBH@HANGFCBADCBEBABAFCDAJCBAJCFGDABBHDBBF@D@D@F@F@DBBAF@D@DAD@HEHGFCTEFAD@B@DBBBB@@@B@FEBAFEBADAD@B@J@B@DBBBBD@@XAdDVA^ARITAVCdIKkOOOOOOOYK[A_ISUK_GgIkKiKWSKI_YYaJ[DQC_iMSWCGCIOEMCaQKUSYC]DWBYASKOyA_OGaJcP[G[UKaJaRQISOQKQOMISAOSOMYK[AOEGWEOQMKCMAODGLSVYXS@QMUAWKS@OKIAOGKKUI[QOU_cUUCCcQ]B[@_SYQCBSF]CGMDSGM]AUI{HUIBWMWSE]FC_Q@AGaYaK[TU\\ERGJSRSP]vQPK@OIGII]O_M[KWU_[OameWEgDeDA@A@QDKBI@C@EACCECEEACAE@EBCDC@@EA_IegeGUEK@K@MCK@MEUQ"],encodeOffsets:[[96320,29561]]}},{type:"Feature",id:"540424",properties:{name:"波密县",cp:[95.767913,29.859028],childNum:1},geometry:{type:"Polygon",coordinates:["@@HOBEBC\\EJ@LAJGJEHEHCFEDEDEBIBIDIBIDKDOBIHQJMHOFKBSMMACASGKKIEQAIAEIGQAI@Q@CAAAFEHM@ADAFCDCBADAD@HAF@LCD@NGDABA@ABCBABAJEFEHIDCNMDEHCLEHCDAB@JBDBDBB@@ABA@AEI@C@ADCJIJO@UGQSKIEKAG@KBWJ[JIHCDC@AAECEAGBE@OKMQI@QDQ@E@CAAACIEGIGKCQEII@AAAAACCIEA@@A@A@@GBEDGDIFGFIFCFCFADAFAFADMJYNEBSHSDU@cKQMOGQ@EDWTQTUZKTYL[@c@UFGNATIJOAYGSDUHGDWPORITKXMF[J_LeA]BSFWLYVmLYHSCITQPSHSBYIMIEEKCUHGBGJIJ]DUEWBKNMRMT[H]LSRQLuK]EcHYDUP@^KP]RSDmSA@MBKBQnGOLZJVMVKXMj@PAV_N@@A@GDkPZC@UE_VYDODW@O@IBECQQQDWA]FYEUFGz[FiHWKMO]GKROPQVSPUP_TYHWlW^_P[FsYR[T[LYAUCWHWJKRUVSRWPUJ[P]RYFKBA@C@EDGBEBADCFAFAFAHCBC@C@GAECICIAIEGGMEICMAKCE@CBEFCHEJ@BBADABAFADAB@B@@D@DEJKJ@B@FCHCLBPB^@BADEFCDGDADADABWNcHY@A@WBYEWG[@QAYGQGUGSAWNKJGJEFQN]PMAGIIKMKUEUCQLEROJU@SDGLHTNLFLBLMPIFGFCBYDeGYCYC]D[LQHYDQL[LMHKEMEU@YDULCNKJOKQGOEc@SFKNODIIOC_NSFSDWBUNMNKNOLILQDQ@OIQMGQAOGWCOISOMSISI[COEQGMKQIODKJKLMNOPONMPMHUCKEYBOFOPKTKRGNGNQ@MHKRGRKJSFOFAPGTGPSBUBUFQFSFMAAVCTDTHRNTBN@LNBRERBLLPLP@NBV@B@RFRNFV@VBD@D@REZITETNNRFNDPFRJXJHNPLNPVBdCLLHNFJJNVJZDNFNNHPHNPPZ@XNNLRRHJVN\\AZENGLFXKBOAS@KAMDOPKZA`@\\AZ@RDHPCPDXVFVBX]HKXKX@VFRHNADI^@\\CTEPFVBVDBBAFEPEJBLEPCD@FJBLBFPFNRFVDPA\\CVFLJTLPJVDR@NEJIRQVEVAXCTI\\IVDTHJFLLPHND\\DPETORCPCXARCPGHQLMPAZAXFB@XJLLN@NINCLKFMLCRMVFJP\\LNDZCXE`CVFFRMPMRKRBLHJCRFTNVHLPNTH\\DXKVKXQRQZMJMfQRBLJ@R@RJLRAXFVJPDZOLIXCTGRQRCTERAEIKIIIOMAOFMRMTIPGFKJMRINHNNFRENBLXDRGRERETDDNARATBPdDTAXCXCPGRKPOPERFRLXJN@LGRQLIbERGHKTATBfGVE\\IhAdCRIDCJMHUGUIWQQCSAQLIVAPCNEHMXMTAHKDMFQ@QNONEHQDOPQXGT]LGPKVANCVAXCRITKDKGG[IQKAUHMPMPKVIXGVGNKAOBMPSTI\\@VDP@RGVKNELFTBPHPPVLVDRCPOHSJGPBNPHHNAJM@OBYHQRSZGV@LNFRPDRD\\EZCVHXTBV@RHTF`DZLPRFPAP@NFRJLJRFJ@R@PFLHZHVFTATCTETC@@HCB@D@LFDDD@B@DAFBFADAB@FBFBDBDBFF@@FBF@B@BBBDB@B@DAJCFAL@@@BABADAFABADCBA@AAACCKACACCD@DCDCHGBCDEBCDG@EBA@EBADCJIDADCJEF@F@DBBBBJB@N@DBDBFHBBDBFANAB@BABCBAF@@@BBB@DFBV@DDBHJFH@BDBB@@@DABA@ABAFALCL@F@FAB@BA@AAE@AFEJMHCFADCDCHEFCHCD@@A@C@AA@AAA@C@@AAG@@@AD@LCDAFEBCFCRGF@@A@@ACEE@A@C@@H@PAF@D@DDB@B@DABCDC@C@E@CEEEMAE@IBEHYFSBC@C@ACA@A@C@A@CB@@C@AGG@CAA@ACAAAEACAACCAGCEAE@K@AACAAC@@@@BCB@BAD@B@DADA^DbF`GVWC]U]MOUiAQLO@OESCKKSKMKOSSUMaMQGKSBSJMLWBYGOUQIEWGQWMUB]MWSYAYKOQCSEUUK[@YJQJK@OAUFQNK^WXMXANBVAVKPUH]BW"],encodeOffsets:[[98892,30383]]}},{type:"Feature",id:"540423",properties:{name:"墨脱县",cp:[95.333197,29.325298],childNum:1},geometry:{type:"Polygon",coordinates:["@@ZG`SVOTORUPOLQ^HNPXLjG\\EHyVEZF^EXBRCRRFDJAP@X@PCZC`UVFD@YlOHCB@@@`MBU@ONiLWNUIUKYHPRmLANAB@nTTC^QLO@]VOZCdG^FvLRKTQ^K\\GNSNQLMXAVF^CJIHIHAVGLDFFNJZJTATGROJSEGGQASHUJMNMDMEKGMCACCGAMCWEICOCWEOGCEHMDWDUBMDGHOFEDGJIPWLQNOLENEBABACCEEUYAAUMaYEMCEAMACACCCOCEAA@AE@ICEIEKACAAAA@A@E@EBC@EACE@CGCEECIBCDEFADCDCAAAAACMMIEIKGGKKQWMQGS@SAUGIKQKOOMOKKMMOOSGS@UBUFIAMBALIH[@@AABC@C@EBE@CBEBCBCBCDGBCB@ACKEEE@CHI@@@CGEGEAC@CBG@AB@BAHED@BA@@BC@A@ABEBADCLQDADADCBA@AHC@@@A@ACAAAEGECECAA@CACAAA@@A@ABADIBADA@A@ACAACAAA@@GAA@A@A@ABA@C@AAACCCGAAA@BAB@BC@@BAAA@AA@ACACACAA@AAA@ADE@CDIBK@A@CA@ICAAAA@A@@@AHGBEBCAE@A@@@AB@FCFGBALEBAB@B@BAB@@AACCECGEKOW@A@C@AA@@AACGGCCAA@@@ADABAAM@A@@GAOGM@M@GAA@E]KQH]IkJWBC@A@@@@@AA@@@@@@A@@@@@@@A@@@@@@@A@@B@@@@A@AB@@A@@@@@@@A@@@@@@@A@@@@@@AA@@@@@@@@@@AA@@@@@@@@A@@@@AA@@@A@@@@@@@A@AA@@A@@@@@A@@@@@@@@@AA@@@@@@@@@AA@@@@A@@A@@@@@@A@@A@@@@@@@A@@@@@AAA@@@@@A@@@@@@@AA@@@@@@@@A@@A@@@@@@@@@AA@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@AB@@@@@@@@A@@@@@@@A@@A@@@@@@A@@@@@@A@@@@A@@A@@@@@AA@@A@@@@@@@AA@@@@@@@@@A@@A@@@@AB@@@@@@@@A@@@@@@B@@@@@@AB@@@B@@@@@@A@@@@B@@@@A@@@@A@@A@@@@@@@@AAA@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@B@@@@B@@B@@@@@@@B@@@@A@@@@@@@@@@@A@@@@@@@A@A@@@@@@@A@@@@@@BA@@@@@@@@@@@AB@B@@A@@@@@@B@@@@A@@@@@@@@@A@@@@B@@A@@@@A@@A@@@A@@@A@@@@@A@@@@@@AAAC@@@A@@@@@@A@@A@@@@@@A@@AA@@A@@@@A@@@@@@A@@@@@@@@AA@@@A@@@@@@@@A@@AA@@A@@@@@@@@@@@AA@@@@@B@@A@@@@@@@@@@@@B@@A@@@@B@@@B@@@@@B@@@@@@@B@@@@A@@@@@@@@@A@@@@@@@@AA@@@@@@@@A@@A@@@@A@@@@@@@A@@AA@A@@@@@A@@A@@@@A@@@@@@@@A@@@@@@@@@A@@@@@@@@B@@@BA@@BA@@@@B@@@@@@@@@B@@@@@@@@@B@@BB@@B@@@@B@@@@B@@@@B@@@@@@@B@@@@@B@@@@@@@@@B@@@@@@@@A@@B@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@A@@A@@@@@@@@A@@AA@@@@@@A@@A@@@@@@A@@@@@@@@B@@A@@@@@@@@B@@A@@BA@@B@@@@@@@@@BA@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@A@@@A@@BA@@@A@@@A@@@@@@@AB@@@@@@@@A@@@@B@@@BA@@@@@A@@@@@@@@@AB@@@@A@A@A@@@@@@@@@A@@@@@@@A@@A@@@@@@A@@A@AA@@@@@@@@@A@@@@@A@@@@A@@A@@@@@@@@@@A@@A@@@@A@@@@@A@A@@@@@A@@@@A@@A@@@@@@@@@AA@@@@AA@@AA@AAA@@AA@@@AA@@@@@@AA@@@AAA@@AA@@@@@@A@@@@A@@A@@@@@@@@@AB@@@@A@@@@BA@@@@@@@A@@@@@@@@@A@@@@A@@@@@@A@@@@A@@@@@@@A@@@A@@@A@@@@@A@@A@@A@@@A@@@@@@@A@@A@@@@A@@@@@@A@@@@@@@@@A@@@@@@@A@A@@BA@A@@@A@@@@@@@A@@@@@@B@@A@@@@@@B@BA@AB@@AB@@@@@@@@A@@B@@@EEAoNSPO\\mJ[AOGEeI_[E@MK@Cc_KMCMAaPaC[KKOBKCEKIGOEMEIICKIGOMKIIEGICCCGGI_Is[WMy]kUcO]SEOCaGgIOUEOEMGuYa]QcGcMoESIeQS_[MSEUGOI[Qa]ecWQIcMaIsUaMWEaOSKOEuUOIaQUISIcIcGaEYKWO]UeOSGqi[UoeeK[EcIgOmW[SeKS[K_QOIOIcSaK_MWI{UQEoOOEYOSG[AUDmJQESK_OYAaD]FYIaGcC]D[JM@}ASESQOSMOEPEBMDKFCFEH@NAROPIH@FDLPVPT\\RRJRJVRTRXNTRNFLLNNNHPJVHVDDF@JGFIB@@@@@@@@@@@BA@@@@@@@@@@B@@A@@@@@A@@B@@@@A@@@A@A@@@@@@@A@@BA@@@@@@@A@@@@@AA@@@@@@A@@@@A@@@@A@@A@@AA@@AA@@@@@@AA@@AA@@@@A@@@@A@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@A@@B@@@@@@A@CD@@AB@@ABAB@@@@@@A@@B@@@@A@@@@BA@A@@B@@A@@@@BA@@@@@@BA@@@@@A@@@AB@@CB@@A@@@@@A@@B@@@@A@@@@@@@@BA@@@@@@@@B@@A@@@@B@@@@@B@@@@A@@B@@@B@@@@@B@@@F@@@@@B@@@B@@@@@B@@BD@@@B@D@B@@@@BB@@@B@@@@@BB@@@@B@@@@@@@B@@@@@B@B@@@B@@@B@@@@@@@B@@BB@B@B@@@@@@@B@@B@@@@B@@@@@@@@@@BB@BB@@@@B@@BB@@BB@@@B@@B@@@@@@B@@@@@@@B@@@@@@@B@@@B@@@B@@@@@@@B@@@@@@@@@BB@@B@@B@@B@B@@B@@B@@@@@@@BB@@@@@@@@@BB@@@@@@B@@BB@@@@@B@@B@@@@B@@@@@@B@@@@B@@@@B@@@@@@@@@B@@@@@@@BB@@B@B@@@B@@@@@@@B@@@@@@A@@B@@@@@B@@@@@@@@@@@@@B@@@@B@@B@@@@@@BB@@@@@@B@@B@@@@B@@@@@@@@@B@@@@@@@B@@@@@B@@@B@@@@@B@@@@@B@@@@@@@@@B@@@@B@@@@B@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@BA@@@@@@@@@@BA@@@@@AB@@@@@@AB@@@@@@@@@B@@A@@@@@@B@@@@@@B@@B@@@@@@B@@@@BB@@@@@@@B@@@@B@@B@@@@@@@BB@BB@@@BB@@@@@BB@@BB@@@@BBB@BB@@B@@@@@BB@@@@@@@@@@@@@@@@@@@@B@@@BB@@@@B@@@@@@@@@B@@A@@@@@@B@@@@@@@@A@@B@@ABA@@@AB@@@@@@@@A@@B@@C@@@ABA@AB@@@@A@@@@@@@C@@@@@A@@B@@@@A@@@@@@@A@@@@B@@A@@@@@@B@@A@@BAB@@AB@@@@AB@@@@@@@@@@@@AB@@@@@@A@@@@@C@@@@@A@@B@@A@@@A@@@ABADCBADAD@D@B@@BBDDBDAH@F@D@@@BBF@HBDBFBFBHAF@@@BAFCDCBCBAB@F@D@DAD@@ABAFAHCDCD@F@@@@@D@D@@@BAH@DAB@BAHCF@@@B@@@@@BA@@@@B@@@@AB@@@@@@@@@BA@@@@@@@A@@BA@ABA@@@@@@@A@@B@@@@@@@@AB@@@@@@@B@@@@@@@B@BA@@D@@@B@@@B@BAB@@@@@@@B@@@@@@@BA@@@@@@@@B@@A@@BA@@@AB@@ABA@EDABCBA@@BA@@@@@@BA@@@@@AB@@@@@@A@@@@B@@C@@B@@A@@@@@@@AB@@@@@@EBEHGDC@IAKAK@G@G@EBGFADA@ABAB@@CBEDKBG@CBABCBCFAD@DAH@D@BAF@@CHCP@F@B@DAHCDEFADEBEDABABCDCD@D@B@D@D@DCDIJCBC@A@A@CB@@AF@D@B@B@@@B@BC@EBCBABADADA@A@C@A@CD@B@B@BAD@BBBBBBD@@BB@DBD@DBBFBDBBBDBBDBBBD@F@D@BADABABAB@DAD@B@B@D@DADBBBB@B@BABAB@BAF@B@DB@@D@B@B@@BDBBBBD@B@@BB@@B@B@@ABCDABAD@@BB@@BD@B@BABCDEFAB@D@B@BA@AB@DB@@@D@B@B@BBDBBBBJ@B@FBF@D@BEF@BBBBD@BBHAPA@@BAB@B@D@B@DBBADCDA@CBCBCBE@IBGDA@A@ECA@C@EBEBAB@D@FABADABGDKTORCBABADAF@BAB@@ABABADADCFCFADA@ABABABABABABAFABAD@@@BBD@B@BAF@B@DBD@@AB@@C@E@A@ABAD@BBD@B@@@@ABA@G@A@C@EBE@G@G@G@CAE@@AAA@@CAE@A@ABABEBEBG@G@@BC@A@@@CA@@A@A@M@C@A@CCA@A@EBADABC@A@GAA@AA@@A@C@ADA@A@E@C@@BCB@BA@A@C@G@A@EBABC@@BAD@@CBA@E@C@CHGFIJEDEHCHAJCJAFATBD@D@FBFBF@DAFIJGNA@AD@BBFBFEL@FLNBDNFDFADCFGHCDBL@FCFADAD@@BDFFBB@HABBFBHJNBB@@CD@HABADABBBFHB@@DADCBEFEFAB@D@DBDDB@BA@MDIBGFCFIFI@CBADCFKJCDEJCF@HAHCHBL@HAFEDGBM@Q@EBEDCF@FAFADCFAB@DDDDH@FADC@C@EAQGGAMNI`QVMLHFPHTLNNLLBPHPRFPHR@V@\\CZAPPPFZJXDJANIJGNABNENETLNPJTLRTNRRLTHPFHCLYTSJALBNHDF@\\AjJPJPPNfHJHDPVRNFL@NDL@L@VFfHfh`JFB@@CDAD@FBFBDFFFDDDFBD@J@LARCB@B@fChCXFnfPb`\\XV\\L`N^PJJJH@POLuRO^QTITQH[FSVL\\ZbHb@B`RD^ETFNXAXVJ|GVJ^BHNCTHN^DTEDAZR`T\\@^AdRDDVV`dPV\\RVJLLPHJBPLT@XLVBRNT@ZWTUHKPCNBLDRNFPHXPF\\BZLPNPTTBNJRPRLTPRJbQbIVLH\\O\\IdHb`PzBLPBTAZCXD^TZLVbRNDPFDJDHTXjND`CRI\\Zb`ZLJXTjLlLhJ`HVLJTB`L\\PZPPPPPPLlhXVJNVRLNNNPRXF\\HJIHUBahAjRrrxt\\E`OX]Xk"],encodeOffsets:[[97655,30573]]}},{type:"Feature",id:"540426",properties:{name:"朗县",cp:[93.074702,29.046337],childNum:1},geometry:{type:"Polygon",coordinates:["@@KIKCI@CAIIGCG@GIAE@G@AAAAAEEIKBEAE@KEG@IAEAEAG@@@@A@E@C@A@CBC@CAAACCAAA@A@CBC@CBE@CAE@CAA@ABCBABCBCACACAA@A@CBEBC@CBC@C@A@C@AB@BAB@D@D@D@D@B@BAF@DAB@@CBCDEBGHIPOTYD]DYASJSLSCKBUFQDWCQH@@ACCAGKCACDIF@BABABAB@@@B@BA@@@AA@AA@@@@BA@@D@BA@@@A@CBC@ABCBCBCDABC@ABC@C@ABC@@BC@ABABEBCBCBGBEBCBA@CBE@A@CBABAB@B@@BDA@ABEBCBCBABABCBABCBABC@@BA@EBIBA@@@EAEAGGAACEAACACC@ACECECECA@A@C@CACBEAC@EACAC@A@C@ABCAACCCCCC@ADA@E@ACAEACAAA@C@C@ACACAAA@C@CAEAEAACAGCCACAEACAA@AB@BAD@D@FABA@A@AAA@ABC@CDABEBCBABA@ABA@ADABADCDCDCBCBCDCBCDEBCDCBABABABABAFCDABADCD@B@DADAB@DAFAFAB@D@BBF@B@D@D@BADADCHCDAD@B@@DDBBDFDDDFBDBBBDDDDDBDDFBB@B@D@B@DBBDDDDDBFBD@F@DBFDDBBDBD@B@F@DB@B@BBDBDBBBDDDDBBFAD@B@D@@BB@DDDDB@D@D@D@DAD@BA@ABA@AAE@C@ABCDABCBABCBADABAD@B@DBDBDB@@DC@A@A@EDABAD@D@B@DDFFDFBDDDDDDB@AB@@CBC@C@ABADAD@DAD@@CAA@C@A@ABCBCFAB@D@D@F@DAB@BAD@DAD@D@@AB@BAB@D@BBDAB@DABAD@B@FBBBBBFBF@FBDBB@DBDBB@D@B@B@D@DABADCBABCD@FABABADAD@F@B@DAB@FCBATHLRJNDZ@TDNFPJPDHRX\\VZNTCbBZZFFJLJ^GNKVFbRXDZHZLZBRFT@RPPZLXJLJDDDDRLNPJPVN\\FX@R@bFLPDNNNNFBDFJFTBPJXNLRNVPRRVLLDDBHHLRF`HTDHJRBRADGLCRBJBBPBHFJJBNBJFDZE\\DVBXFLHHNDJNJDPMJQLKHAZ@XDZERQHIFITDTDRAPBNLLTLFLPDT@JKNMJUFKFGRIGKIMESJQPSTOha@@HCHABABC@C@EGSAECEICFCYBAEYEOHGBCDEJM@@@FaS[jBR@J@jDB@BAEIAC@A@KACCA@ADAD@FADCBGACBEJAHADAHGDCDEBE@G@G@EDE@C@EAAGE@CAEBAJCFCFANCJCLAHEDEFGBK@CBGBI@EBGDEDEHCJEHAHCHADAFCBE@E@A@M@EBG@GBI@EAIBEBCHCHCD@FDDBDBDCACCE@E@EBCDCHCHAFCDGFGDGDEFGBAFMBEBC@@CAE@OAGACAAAACBI@AFEJIJGBC@GAG@CBCJEJAHARBNBJDH@LEJCL@TMDAHCRGxQ@@RAZFTBRBRBXDpFZ@ZERAFEFEFG@EACCECIEEEEGCIEKEECECAC@C@CDAFC@CAEAI@I@EBGDCFQJ[AQYQQQOQH[LMDUEQO_JaNOLQFMAC@ABC@AACCCCCAC@E@IAK@GAEACCCCCG@ECECEAECAAAEACCECEIEGCGAGCICGAECCCCICGAC@E@CDCFGFEIBIDMBMDK@C@ECEACBA@AB@FBFDBDDDDBB@BA@IhoR_BQDUB]IcAA@YNMRKXK\\oJ_IAmAUGE_SaFYDcAwBILaDSBKDE@G@E@CDCBEBABCBC@A@EAEACAEAECEACAEAAA@BAFADADAB@BCBADA@ADADCDCDABABABABAD@@BDDF@DABGFG@A@GMC@ULMDA@GB"],encodeOffsets:[[95151,29461]]}},{type:"Feature",id:"540425",properties:{name:"察隅县",cp:[97.466919,28.66128],childNum:1},geometry:{type:"Polygon",coordinates:["@@UKGAE@EAQECCACAE@ECCECGCG@IAIAEAGAEAAACCCE@E@OBI@EBEAE@GAEAEACEAG@EDEBIDE@IDC@C@CAAAA@AB@B@BAFAFADARADABCDADAD@BABAD@BAD@D@D@D@@@DBD@DBDBDBDBD@BBD@B@BABADABADADAB@B@B@B@@@@CACA@@A@A@@BA@@BA@AACBA@A@@@C@@@A@A@A@@@@BA@@B@@A@@ACAA@A@A@@@@@BA@@@@AA@@A@@A@A@@@A@@A@@@A@@@AAA@AAA@@@@@A@A@AA@@A@@@A@AB@@A@@@C@@@AB@@@@@@@B@B@B@@CB@@@@@B@@@B@B@@@@ABA@@B@@@@@B@@@@@@BB@@@B@@@B@B@@A@AB@@C@@@@@AD@BAD@@@B@BAB@@@@C@@@AB@@AB@@@B@@@@ED@@@@@B@@@B@BAB@@@BABAB@@@B@@@@@B@@@@@BA@AB@@AB@@@B@@@BADA@@@A@@@AB@@@B@B@@@@@@EDAB@BA@ABA@AB@@@BC@A@AB@@A@@BA@C@C@A@@@A@AB@BA@A@A@ABA@A@@@A@@@AB@AA@@@@BA@@BA@A@@@AB@@C@CB@@AB@B@@A@A@@BA@AB@@@BADA@ABA@@@AA@@CAA@CBA@ABADABADCEA@A@@BABABAB@@A@A@AAA@ABA@ABA@C@A@@@AA@A@@@A@@A@A@A@A@A@A@CBA@C@CAA@AAAA@AAAAACCCAA@C@A@@BCBA@C@C@AAA@C@A@EAIAC@@@CBADA@@@AA@@A@E@A@A@AB@BAB@@CBCBCD@BA@@D@@ABCBABABA@CAA@A@ABABABCBC@C@A@E@A@CAC@A@@@A@A@AB@BADA@CBADAB@D@DABABADABCBADABCDAFAD@D@D@F@DABADA@A@ABABC@CBE@A@C@GAC@C@@BABCBABABC@ABC@C@A@ABCDCBCFCBC@C@C@EBE@GBGBCBCDEDCBEDGDC@AAECECICICC@KBC@CBCBGHCDCDIDEDCDEFEFEHAFCHCDADEBGBADEFADAFAHCJCFCHABAFAFAF@DABCBEFEFEDGDEDCBC@E@CAEBC@CBEBCDEFABCDABABADABAFABABABABC@C@A@C@E@CAC@A@ABABABA@A@C@C@CAA@A@AB@@ABAB@B@B@@BD@@AB@@AB@B@@@B@B@B@@@@ABAAC@AAABC@A@@@A@CAAAA@AAC@ABA@A@AB@BAB@BABA@C@E@AAA@CA@AAEAAAAA@A@@AA@@AAA@B@BABADABADADAD@DADAB@DABADAB@DABAB@B@B@B@B@FBF@JAB@DAB@BABADABABA@ABABABABABA@AB@DAB@B@BAB@DADABADABABADCBADCBADCBADCBABA@ABABA@A@ABCBC@CBEBC@CBCBABA@AB@BA@A@A@C@A@AAA@@ACAAAAAAAAACAA@AACACAA@CAA@A@C@A@C@A@C@@AAA@@@AAA@A@@BA@ABA@ABA@@BA@ABC@ABA@ABA@A@A@A@CAA@A@A@CAA@A@AAAA@A@ABA@A@A@C@A@C@C@C@C@CAA@C@C@C@A@C@C@A@CBC@ABC@ABC@ABABA@ABABCBEBA@A@ABA@A@A@C@A@C@C@A@E@A@CBC@ABC@CBCBCBABCBCBA@CBC@ABC@A@CBC@ABA@AB@@ABA@@DAB@@AB@BAB@B@BAB@B@DAFAHAB@B@B@B@B@D@BBB@B@@@B@@ABABAB@BABAD@BAB@B@BAB@D@B@DAB@BBB@B@B@BBD@B@BBD@D@BBD@B@B@DBB@BAD@B@B@DABAB@BABAB@BABA@AD@BED@@A@ABA@A@C@A@A@A@C@A@C@C@A@C@A@A@ABC@A@A@C@C@C@IAMAA@A@C@CAC@CAC@AAEAAAC@ACCACACAAACACAC@AAC@AAA@A@A@A@A@@@A@A@A@C@A@A@C@A@C@C@A@C@A@A@AAA@AA@AA@AAAA@@A@AAA@A@ABC@A@ABA@A@CBA@A@C@A@C@C@C@A@C@A@C@A@C@A@C@C@A@A@C@GBA@ABA@A@ABC@A@A@C@A@C@A@C@A@A@C@C@AAA@AAA@AACAA@AAA@AAAAC@AAAAA@AAAAAA@@AA@@@AAA@A@AAAA@AAAAAAA@CAAAC@C@A@A@C@ABA@C@C@CBC@EAC@C@C@CAC@C@A@C@ABC@A@C@C@C@C@AAC@A@A@AAC@A@A@@@ABA@ABABABAB@@ABA@AB@@A@EBK@KB_TOP]\\]V]EKAOJGVCbCRMLe\\YKQAiJSEWMo_I@[J{@OC]OgIOAHJDHDDHJJFLJPNJHDLJJNFPFJHFLLDPALLD\\ObBbDNLNd`@DNLF@`\\fJHFBPI\\[nOPMTpBFF@@A@@@@B@@@@@@A@@BA@@BABA@@@@@@@@BA@@@@@@@@B@@@@@@@B@@@BAB@@@B@B@@@@@@@B@@@@@@@@@B@@@@B@@@@@@BB@@@@@@@B@@@B@@@@BB@@@@@B@@@B@@@B@@@@@@@B@@@@@@B@@@@B@@@@@@B@@@@@@@@@B@@@@@@AB@@@@@B@@A@@B@@@@@@@@@BB@@@@@@B@@@@B@@BB@BB@@B@@B@@@@B@@B@@BB@@BB@BBB@@BB@@@@BB@@@@@@@@B@@@@B@@B@@@@@B@B@@@@@B@@@@@@BB@@@@@@@@@@@@BB@@@@@@B@@@@@B@@@@@@@@BBB@@@@B@@@@B@@@@B@@@@@@@B@@@@@@@@@B@B@B@@A@@B@@@@@@@@@B@@@@AB@@A@@@@@@B@@@@@@A@@B@@@@@@@B@@@B@@AB@@@B@@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@AB@@@@@@@@A@@@AB@@@BA@@@@@@@@@@BA@@@@@@@@@@B@@@@B@@@@B@@@@B@@BB@@@@@@@@@@BB@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@A@@@@B@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@A@@A@@@@@@AA@@AA@@@@@@@@@A@@@@@@@@@A@@@@@AB@@AB@@A@@@@@@@@@@B@@@@@@@@@B@@@@@@B@@@@@@BB@@@@@B@B@@BB@@@@@@@B@@@@@@BB@@@@@@@@@BB@@@@@@@@@B@@@@@@@@@B@@A@@@@@@@A@@@@@A@@@A@@@@@@BA@@@@@@@@@@@@@@BA@@@@@B@@B@@@@@@@@@@@BB@@BB@@@@@@@@@@B@@BB@@@@@@@@@B@@@@B@@@@@@BB@@BB@@@@@@@@BB@@@@@@@@B@@BDBB@@@@@@@B@@@@@B@@@B@@@BB@@@@@@BA@@@@@@B@@@@@@@@@B@@A@@@@@@@@BA@A@@B@@@@@@@@@@AB@@@@@@@B@@@@@@@B@B@@@@@@@B@@@@@@@@@@@B@@A@@@@@@@A@@@@A@@A@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@B@BB@@@@@@@@@BB@@@@@@B@@A@@@@@@B@@@@A@@@A@@B@@@@A@@@@@@@@B@@@@@@A@@B@@B@@@@B@@@@@@@@BB@@@@@@B@@@BB@@@@B@@@@B@@B@@@@@@@@B@@@@B@@@@B@@@@@@@B@@@@@@A@@B@@@B@@@@@@@B@@@@@@@@@B@@@@@@B@@B@@@@@@@@@BB@@@@@@@@@BB@@@@@@B@@@@@B@BB@@@@B@@@@@@@B@@@@B@@@@B@@@@B@@B@@B@@@@@@@@BB@@@@@@@@B@@@@@B@@@BBB@@@@@@@B@@@B@@B@@B@@@@@@@@@BB@@@@@@@@@@BB@@@@@@@B@@@@@@@B@@@@@@@BA@@B@B@@A@@@@B@@@@@@@B@@@@@@@B@@@@B@@B@@@@@BADIXJlG^LRF^B@HBN@N@PHHB@@@BBNABCB@B@@BBDDHHBD@BB@@B@D@BPXFLDHDFBD@BA@ABA@A@ABKFABEHEDA@@B@@@BBFADAFGH@B@@@BBBBBJDB@@D@BALCJ@DCF@BBB@BBBBDBDBDB@@BBBAB@@ADA@ABB@BBDHDDBB@B@DAB@B@B@BBB@HB@BBBDDB@B@BCBABCJAB@B@BB@BBBD@DBBFDFDFHBBDB@B@B@@GD@BABCDCBCBKRCDABAF@B@BAD@@ABC@GFABA@@BAH@DBDHFHF@D@@GJ@DFFLFBDA@ADCHADADADAF@DAF@F@DADBB@@G\\KJABBNEJAV@VHTPTNPLNPLPNLPLRHJBV@THTNRRXLLHHJLJFNNBDBBBBCDCDEBCFADDJFFHD@DDFFBD@FAF@B@B@BBDBLBJFDF@JBFB@FBPDDDBDBDBNDFFNbZVNBBVZFFDDABABMFKFMPKROXIJCHEFGPCHANCVCXGNDFPHXFPDJDXFNDHBDDDBHNFLCNMNINGVBTHRFHTDZGnKZUXKTE^AfB`K\\INELWJSPQXOHCVGTCZHPBJIBSHMVEd@\\@ZKLSVYRSXSFCR@PHRNdLV@TCTGFAZMNIBCBEBEBCDEDEJEHEJEHCFCHAHKFANGVMJAPKPKD@J@TDJCBEAG@O@GBCB@RANIPMNIDENEPCDANGFIJWFMBMDSFQBCJMHKLOBCLSJMFGDIJGJEJCJBJDXFPDF@NET@RFNNR@TKTER@TCZUPKPCRBLBDA@EKQAM@MJINGVCP@PHDRGfB^A^BLDPDHNLFFRLF@LGNKNGLCF@LD@@PJDHALF`@PBP@FAVCDGJCLBDDFJNFPF^@HATEFUBIBCDOPGDGH@HCT@P@FTPJNHBZBJHHVCTEbG`GPERATFTFVDFNJJDVDVBJATKNE^EH@DDJN@HEFMHENDPHRHNHPJH@@D@D@BDBD@BBD@B@@BABA@A@A@CDAFCBADABA@A@AIW@E@C@CFYDEDCFGZUpijeHErAZ@D@@@BBBJB@H@D@B@B@@BBJBBDB@@DF@@D@DAH@JBB@DA@ABCBADA@@DEDE@@BA@@DADAH@DBB@DDFBDFBBD@XBFAFAFAFBD@B@B@DAFAF@JAD@D@@@BA@@@AEEAAACC@GAGCCCCE@CBAHELGt[B@@ADEDCBADGFGDEHGFGJMBAHIFEDCJEDCDCC@C@A@EACAAAECCACAC@EAEM@CBEDABADAHAB@@CBACE@A@@ACACIGCE@ABCRMDCHCFADADABCAS@GBCBAFADAF@lHJ@DAB@AM@GBCAI@EBOBC@G@@@EAG@A@C@E@I@ADIBCBE@CBCBCBG@CBC@C@EBCDGDCHIFGBCBC@CA@@CAC@A@A@E@AACAC@C@CCIAGCGAEAA@AACACAE@EAEAC@@ACBI@@@ECG@E@C@EBC@ADKBG@A@E@A@CBA@ADE@CBI@CBK@EBAB@F@JDXDxJLDPDL@JBD@D@B@D@BBFJBDDBB@F@J@D@B@@@B@B@DBFDHJBBD@DADANBF@FAJCF@BFDH@FAFEFADAH@@GJA@ABABA@A@E@EBEDGBAB@BAD@D@@@DBJDF@LBFBBBBFBFB@@BFADCHADDDBDBFDDBF@B@DDDBDDBDBFBLDHDFBD@TIHCDCDCDCBABAF@B@BJVRPLJFDFFDBB@BCDMDGDIHIHEJEFEBIJCFAJCDEDMBC@EBGDCBABEF@@@BDH@D@FADADCBAF@D@F@B@BDBB@F@D@JDBBF@H@DAH@JGVCPFHHVLTMVQ\\KX@\\HHENMVS\\DPOJKZ@ZSDOHS@A\\WTGZYHcNKNKJAZQEYJSbcbInHhBf@`ARP@^HRPLL@bIRC@@FMTcRQTKNIPENIBGAGAMCIFGBEDWAWDEHABCHCV@VHFBD@DDB@LEHCBC@CAATMJEDCBAHGFABCDA@CACCAAAGCIAGEAAAA@EBCFC@CIOACC@@@ABEFADA@A@A@@A@@@ILQHIHINQHGBAFKHKFMHO@CLKFCJAJADABGBEAgCG@A@@DKHDLDJFLB\\ERAPALAJPDJBLBLDHFFJHHHHHHFBD@DAFFFHBDCHIHIJIJIFGLCZIDALENORKBEDWEKBG@GFGFAFKDG@I@MBSLMFIFIB@D@FBLBPCPCJKHGHIBKF@VDL@JBNBZHVJRLHFF@D@FEFGFEDEHQLUFMLEBENU@CCICMCOKKGKLKJQFSEW@IDQHOHOBELIBEBCDKFIJGBGCKDEJGHCPEHCFCFEFI@ABEBCHCFOXSBCAEACMIKOCQDQRSVQ|oXQFAFAPDRDNBJCPIjSZOD@FCHC@@DAFAFAFEBCDC@ABCDEDEFGDEBE@E@ECGACAC@AB@F@F@DAFADA@E@C@CBEDAFCDCFCDADEBCBC@CAEBCBCBCBEDEFCHEFEDCBA@CAC@@AECGCEAC@AA@CAECKCKAICIEECAEACAGAICK@A@CBABAFGBE@AACACACAEAECG@CBCDC@E@CACBE@EBEAAAE@C@ABA@CA@EAEAMCAAAA@CBC@@@A@AAAAA@@A@CCICECCACAE@G@KBA@C@@@A@A@@@@@AA@@@A@@AAAAAAAAA@AAA@AAA@A@A@C@@ACA@ACC@@AAA@@AAAAACC@@AA@@@@@@AA@@@A@@@AA@@@@AA@@AA@@@AA@AAA@A@@@AA@@@@@@@AA@@@A@@@@@A@@AA@@@@B@@@@A@@A@@@@A@A@A@A@@@AA@AACE@@@@CBC@@@A@A@EB@@A@@@A@@@@B@@A@@@A@@@A@@@IDEDA@A@@@ABA@@B@@AAA@@@ABA@@@@@A@@BA@AD@@A@ABA@@@@@A@ABCBABA@AB@@@@@@@@A@E@GCC@EAC@EBEBGBABEAICICEAC@C@EAEAGEGCCA@C@EAACCCEECCCAGCGCACCEAECE@CACBEDEDADABEDABA@@@@@A@@@AA@BA@@@ABA@@@A@@@A@@@@@A@A@A@A@A@AA@@A@@@A@@@A@@@AB@AA@@@@@@AA@@@AA@A@@AA@@@@CAAAA@A@@ACCBCBABCDC@A@EAAGEGCGGICEAEACCCGACCC@A@CI@I@GBCBE@E@GACAC@CBA@ABCBEHADAB@BDDDFBBDF@D@DADEDADCDAD@DBFBH@HANADABDDBD@DADGHAFCFABG@EBABCBAD@DBLDLDJDJBJ@F@HAFBF@H@DADCDIBABAB@DFBDDDDDB@D@D@BCBCBCBAFADAF@FADCDCBCFCFCJADCF@D@DBD@BEBGDE@EBC@A@ABEFCFCHCFAFCDC@EBC@ADADAD@D@DDBBDBDBFBF@FAD@D@DAFABGBABAB@BABCBABABCBCBCB@@AD@DADAFAD@DADBDDF@DAD@DADGDEDAB@BABA@A@AAA@ABCBC@C@ACACAECGEECAGCI@EAE@G@EBAAEAACECAACACBCAA@COAKAE@C@A@C@AA@@@CBEDIHADCBC@ABC@CBAAAACGAIAG@IBGDEBCFEHEJCJEHCHEFEDEBGBEBIBE@G@C@CBABA@@DA@@BAAC@A@A@CDCDCDABADABAAABC@ABABABA@A@AAC@A@AD@DADABCBCBABABC@C@CACAAEAKAIAICGACCECAAECE@EACECCEECECEGAEBEBBBADADCBE@GAGCCAE@E@A@E@A@AAGCKKAA@A@M@C@CBG@G@EAI@@FCDG@EBC@CACACEAC@CBCBC@CAAEACAACAC@CBC@@CACAEECCAGGCDEDCD@BAB@BADIFMJMFIBMBEBELA`EPIFUHU@M@MICQUAGAWPQPGNAHDVFNFJBJFN@LENGLEDIHQPIPSFUFUDMJ@FTTOPQFKJILOLKNINGNKFSAuAKCYQGECGAGAK@IEEIBQ@WJSGKCIBEJENALGPKRKPGJMFW@GDEFCNINBNHNKPAP@LDLBJEJALBHBF@NMVOXKPOF[DEPCNGHMBQBGJEHEBKBIAMBOAMMUSKAOBIDCH@F@JMREJUBMCY[[OGQWQKMOAK@@@AMAaDMR[HK@GAGEAGGYEAIHKHK@MEIAEACACAA@@A@A@A@@@AAA@@AAAAA@@AAA@AA@@C@AAA@A@@@A@AA@AA@@A@A@A@@@A@A@A@ABA@CBA@A@ABA@C@ABA@A@A@AB@@AB@BAB@B@BAB@@AB@@A@@@AB@@A@@AA@@@AA@@AAA@A@@@A@@AA@AA@AA@@AA@@@A@AB@BAB@B@BAB@DA@@BAB@DA@@RGHEJI@A@@CKGK@@MEE@CA@BA@A@A@@@AB@@@@@BA@@@@@A@A@A@AAA@A@A@A@A@A@A@A@@AA@A@@@AA@@AA@AA@@AAAA@@AAAA@AAA@A@AA@@A@@@AA@@@A@ABA@CBA@A@ABCBA@ABA@ABABA@@@A@@BA@@BAB@BA@@B@BA@@BA@@@@@A@@@A@@A@@AC@A@A@A@AAA@AAA@AAAA@AAAA@AC@@AAA@AAA@AAA@A@A@ABA@ABABA@ABCBABCBABABABA@ABABAB@@AB@@ABABABA@@BA@ABABA@ABABA@ABABABABAB@@AB@BA@@BA@@@A@AAA@AAAAAAAAA@@AAA@AAA@@AA@@AA@AA@CAA@AAA@AAAAA@@AAA@@@A@A@@@C@A@A@A@ABA@A@ABABABABCBABABA@@BCBABABA@AB@@ABA@@@AAA@@@A@A@A@AA@AAABA@A@@@AAA@AAAAAAAAAAAAAAC@AAA@AAA@A@A@A@A@A@@@A@A@AAA@AACAA@A@CAA@A@A@AB@@@@A@@B@@AB@B@BABA@A@C@CAA@C@AAA@C@@BA@A@A@A@AAA@C@AA@@AA@@AA@A@@@A@A@A@@@A@@BA@A@@@AA@@@AA@@AA@A@ABAAC@A@A@C@AAA@A@@@A@AB@@ABA@AAA@A@@@ABA@@B@B@B@DBB@B@BAB@BAB@BAB@@ABA@@BA@CA@@C@A@A@A@AAA@CAAAC@AAA@A@A@A@AAA@CAA@AAA@AA@@ABA@ABABABA@ABABA@A@A@@@AAAAAA@ACCA@AA@@A@A@A@A@A@A@@AAC@A@AAC@A@C@A@A@AAA@AA@AAA@AA@@A@A@A@@A@A@@@A@ABA@A@A@AA@@AA@A@@@AAA@C@@@A@AB@@ABAB@BCDABADADADABCBAB@BA@ABABA@@@A@A@A@AACAAAAAC@AAAAAAAA@CACACACAMGICECA@AA@@AAAAAA@AAA@A@A@C@AAC@AAA@AAA@C@A@@@A@CBA@ABABA@ADCBA@ABABADABABAB@DAB@B@D@DAF@BBB@D@DBD@BBD@D@D@B@B@BBD@F@B@F@DBB@D@DBD@DBB@DBD@DBB@BBB@B@B@@@@A@A@@@A@CA@ACAA@AAAAA@CAA@A@A@C@A@@@A@ABABA@A@ABCBA@ABA@ABA@AB@BA@@B@DAD@B@B@D@B@B@BBB@B@D@@@@A@@@ABA@A@@BABAD@BAB@BAD@BABABABABA@@BCBA@A@A@A@A@AAAAAAC@AAAAACAA@AACAAACAA@@AEACACAACAGCEAGCEACCECECE@GAE@E@G@G@CBCAEACAE@AAG@C@EDCDAFCLCJE@CMGEACAEACACA@CBCDEDEJKDEBABC@C@ACCECCCICEAGAEAC@E@EBEBEDEDCBG@E@G@E@EAC@CBCB@D@F@FBD@F@DADCFABEFCDCDAFCBCBCBG@GAGCICGCICKCKGICICGCEACCECECCCGGEEAAIICCEEIGIEEEGEECCAACEEACCC@@@@AAEGCCCECCCACACAGAEACECCAGACAE"],encodeOffsets:[[99501,28480]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
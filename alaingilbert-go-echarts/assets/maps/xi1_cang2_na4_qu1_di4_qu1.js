@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("那曲地区",{type:"FeatureCollection",features:[{type:"Feature",id:"542421",properties:{name:"那曲县",cp:[92.0535,31.469643],childNum:1},geometry:{type:"Polygon",coordinates:["@@WE[I_E]CQCOBILcBcI[BWEGGCGC@E@A@A@@@ADA@@BABADABADEPADAF@@CBCBCBCD@JGLEFADKFEBIDED@DBDDJ@@[bCDABABABABQPMRKNSJWHYLQJWJ[PQREVCVDTFTCVGPCLUAIIIKIKOIYEYASBQEMIYOOKWM]MgK_KWCYE[BUBQDUDOLGNA\\GRMLQDYFYBUCOH@LATLP@PBRDTGVCTCXAROLSHOXIJED@BCVWxJ]b@GR@LBRBB@DBB@B@B@B@@CBCBABCBEDCDCBCBC@AB@@ALAFCLCD@FBNCHCJDJFHÉ^@IIEC@@@CFCFGP@B@@AJBH@BAFABCFEHCBIJQ\\IRCJCJGXAL@B@DBH@DAB]bsvqtejNTBJAbAJGJEPKDQAIFMBFBDJDRHJ@ROPAJQFCBMJwvHAHCF@DAH@H@F@RCNAHADAF@B@HBDAHAD@TLD@XJTLFDBBHDFBFBJBD@NCXBH@L@LBPBFDLJFJVZHL@B@@@BA@KBAB@@BBBBFDDFDB@B@F@BLBXJBN@N@NNNZNTPLLNNREVAHLHNJJVJRJLNJNJLLNTBLIVANJLNHPHNVHZA`AXGB@NDPF@BD@DBFAZED@N@Z@TBB@L@R@D@L@D@D@FBHAbBF@HHPRHNFPFPJJVBNNGPGT@LLJHJJJ\\JLKnWRCNGVSdSRANKJUNIVEdBvULMDA@@BA@@@C@AAA@C@E@A@AF@@@DB@@HBBAD@D@D@D@@AB@BC@A@@@A@A@CD@HANBDBD@DBDBDBB@DBD@BA@ABA@@DABE@EBGDE@CB@@A@A@@^IDALAH@HAFAHCBCB@@AAE@CBEDCDABAFABAaFAF@PAHAF@BA@BHDBB@@BFBBB@HH`XFFFADABADBDFBFDJBD@FCD@HDFBDDB@DHNFJBHAFAJ@D@D@D@B@D@BAFAB@B@B@BBF@BAB@@ABE@ABCB@D@B@BDDFDFBHBHBJBR@J@ZÐp^JhNBC@CBCBABEBADCDEBEDEDGBCBC@CDCBADAB@DAD@DBLBJ@ZDPARBNJTJTDJEFQRMVBLJAN@LFJTFD@F@TD\\BXH^F\\@RJRBRL\\FRC^CXEVEVE\\BZAPJDN`vAB@H@DB@@B@B@BBDBH@BBBBBNJFDDDBBBFFDDDDBDDDBFDDBDDBBHFDBBBDDDD@BBBDD@BBF@DBB@BDJ@B@H@B@DDDBDBBBF@B@D@FAFABABCD@B@B@DB@DBDAF@JAFCBABADCDCDAFADAF@TEFAHCDALAHADADANEFCDABAHAHCFAFADCHADABCDEBC@ABADADEJANAR@FADBBBDDDADAFABB@BBDBDBBBBBBBBDBD@D@H@F@HBB@BBBD@H@BBB@BBBJDBBDFBBBBDBJDBBB@FDDDFFBDDBDDB@BBD@F@JDHBFBJDFBDBD@B@HCDCBCDCBC@ABEB@B@FBD@BADAFADB@@B@B@B@F@H@@@ACAAC@GCA@CCAAAAACCGAAACAGCEAE@ABAFEDADADBDBFBLAD@HAB@DADGBABABAD@BAD@BAB@DEECAAGGAA@A@AAA@G@C@AACAED@DABADABEBAB@DAB@D@BAFAFABCDABCBCBGBEBE@ABCBABKJIXOTMZIXMZKLGJEVKRCRCRGDUIUOUKMOCWDYDQEOMACCMNOVGRKEMMUGKKMAMEQGOOCKASHMJMHU@EAIEQOM[EUCSBMLKVCZ@`ARHLELO@[PMBA\\G`MbGZObK`E^AVANKBADUESAWIMQKWMGQHINQNQLYF]DWAWKUIKIKOFMIIUKOUMSKWKUG[@QL]AQCUHSJONIGIOAKAMKQMIIKJKLEMWQUIQGUEGIODMHKGSMIYBa@AA[C_BUEWGUESGKKIMYCMOAMGOGOCO[IUEOKGEOFKHMFMKO@GNKFMGGIMEQBQEMAQMSEIIEK[DQEMKOCOGMIBONO@QAOKMQCUDKADOQKMOYIMEOGKWGIGOQBG@K@EQOMQEQDWBMBQBSBODODKAIGGKCKRWAWFUDKBGNGNKVINGNKLMFWHKGSSEUEE@W@YNSBOLQ@QIGML_LMBOWc@KC]DOH]LSHWV]NKXKBKXET]IOWIOKQKIYIQUAOT[DEMCSWCcBeFgF]J_DcDY@YKIW@QES[K"],encodeOffsets:[[94265,31272]]}},{type:"Feature",id:"542422",properties:{name:"嘉黎县",cp:[93.232528,30.640814],childNum:1},geometry:{type:"Polygon",coordinates:["@@[DOKOESIKKMIKDEBQDYFUC[SEKSEYHCFAFAXDRAVIXSNKFYRDLFPCLDNOHMAMFMDMF[IIEQAMFGVCVCVBTBTGNCR@TDRLTCVKPABAF@D@DAD@D@B@FHDJHDBBHCJA@C@ABA@EBA@C@GBA@ADAD@DCBC@@@C@CAE@C@C@EAA@ECCEAACC@@A@@@AA@B@BAD@@@@ABCB@BADCBCDGFCB@B@BAHAH@N@DNDJBD@JCFA@@@BDFBBBBBBBD@PANLJ`APDNFNPRFVA^JFFD@DBB@B@BB@B@B@@B@B@F@D@DHHHXF\\AdJdAJKPARD^D`F\\JXF\\LFT@RJXZLZ@dC`C^IhEfEdAXDDTFN\\CPSVBJRJZRLPLXJJPS^WFALWLMLU^GXKTG^CPD^@LXdAPKNK`HNRJR@PKTAZMX@F@VFTFHTGLEXKNMLMHUJMLMHAHCLEVBXQXDLHLJHLBPCPCTARANAXARCRFPNFRL@H@RAHPHJLXPHNFZJNPRLCPLBVCRDLNBP@RMPAPNJPHPDNLRF\\CFLJJTFRNNBRFRANFHJNHLEHMP@NLNELGPEHFPLVF\\JDPHPHPBNNPZDJNLLTHVFXHVF`A\\DBBb@ZANJHTGLCNJPFHHVJRRVNXKFILJLNJLRBNBLJPJHPMTIVGRD^BRK\\@VHXLTLVNLPJVNJPEFIB@@A@A@AAE@A@CACBCBCBEBCDMHKFEBGBCBC@CAE@C@CBABCBCDGBC@CAC@CBABA@CBAAABEDGBCBE@C@C@C@ABE@ABEFBBDBBBDBBDBD@B@BAACACBCBABA@EAA@ABC@AAACC@C@CBABCACKQ@CFBXABAA@AACCGCCEKIKGECCCCEECEC@AB@P@D@@BDBB@F@FCH@JDF@D@BAB@FDH@FBDAD@DBB@BAB@B@BDD@BAD@D@B@F@J@NBHDFBD@FBD@DAFBFDBBB@BAB@BABBD@DADBD@DAVDDBD@H@L@HBHBD@@@DA@ABBF@B@H@F@DABAB@DAB@DBB@D@F@DAHCBA@ADA@@CC@ADAFC@A@AECE@A@AAA@@CL@D@D@B@B@F@NCBADCBAD@F@DAB@BABAFEDAD@DAB@BCBAB@DAB@BABAB@DABADAFCBAB@B@HCB@HAD@DAB@FBJBTAJCNAL@D@DAD@JANCLCJCHAFAB@FAH@FBB@BKA@AAE@AAAAACAAACAAA@CAEEMC_AWMMSOKFOB_FM@U@MZQTJXHVJFZDLXDXBLJNJ^AV@LJHRJHT@T@ZIVKTGPIPMVMTKZEV@`KTANKNMLQV@\\@ZGBUBQTGPITBFKBSEIGQEQKIOEIGMGOCIGEMDS\\OBTH\\EXEJQAKFQJQJQJKAQWKKKKOGIDSHKTIRCPAPBJENILQJQNKRGXIPKRMHI@M@OAMNKL@XHZCNILKPOLKNMFMEKRER@TARBVBNJROPGb[XFTHNCPGRUBCLQNMR@THHIBM@A`YtiLEHCNCX@NCHC@@CEA@AA@A@C@A@CAA@CD@DEZERGVITEVEGMWIQIOEMCQEMMFSJSFY@Q@CAC@UEUQMQEA@U@MAO@OKKKQAQFMA@KAMMSGQCSDSBUOKKEQ@YFW@SAWHUJ]LWHOPSFYJMAYCEQ@SIMGKWA_JWFUE[MCOKKQG_FULQNc@[G[CUCMCWJEPIPQ@SGIIAIKGOCU@[AUHSAWFUVQFW@QCcP]JcDUFaNURSHQRG\\@ZDNXFLHHRBVKXQLWN[VSRQTGFMLYBY@SC[@[TWTWP]JYM@KAOEKQIOEMKEQOGQEIIUGSQKOUSQKSDOLINKTIPGXSJONDJBRENUT[JaDaJWRER@VBTBPCNBNJNDLKTYJSBMFM@GKQQSJQNWFSHQLOJMBO@MCMKEI@OBQ@UCSESAOMQMAGNQ@KBQDQCOG[IUAMCSQGOISIUKGAOBKLERMPMJOFQIUGODKO[SAMOEOMOGYIKDUFWBQMG[JSDUJSAGMNMPKFUISSAMHOBSMWDYPQJ[BOKDSJQAWEKAQDY@cMMYLKNKHSDSDOH[@UCOGWDMEMDQJMBOEOHKEMFWHYJKH_H[HSCQEWHODIGAKGK[FOJWF]DYHQTMXKRWN[JUL_@KGEK@MCKKGQKCUCKKSEKGOAQ@KESEKSGIJO@SOCKQDQHQDSHWJ"],encodeOffsets:[[94302,30943]]}},{type:"Feature",id:"542423",properties:{name:"比如县",cp:[93.679639,31.480249],childNum:1},geometry:{type:"Polygon",coordinates:["@@NSPMPURMNMBYFYLYLORGRGLMGWIOAQKWCQAUEMUG[@SFWHYDUTMLU@OCSEQ@OFOAKIOKQISAYEUJSDUFQJYDWBSDSFOBSASDWCS@ODOBMJKS@[MMSCGGEIWAUFWDQDMTINKNINILQJM@MBMDEL@LKH[ISDMHOCUKSBQ@QDYFSBKDKHORKESDUJKJKHQEQIO@O@W@ICATELSAOJSHARAVYH[@U@KRMNMLSB_LU@YFSLUNONOJSHULYJS@S@IGGQKIU@]BMIKIWAWCCKEYUIWGSIYR@N@VENA`EPPLNTXN`BNDFFDBB@BBBDBBBDBBBBF@BBB@ALA@EAG@EBA@EBGBIDKDMDIBC@CBC@K@MBIDSBIAEAA@CBC@GBA@GDA@A@ABEDCBABCBA@ABABA@CBA@ABADA@CBC@CBEFABABA@CBE@C@ABCDABMDE@A@A@C@C@K@@DB@BBB@F@FD@B@BEDCB@BDD@@CB@BABGDCBE@C@A@CAA@CBA@ABCBE@G@A@E@AA@BCB@@C@GAGAK@G@C@CAUCCBC@CACBC@AAABA@ABA@AAECEACBC@EAC@EAGCMAI@E@A@C@C@ABC@ACA@A@ABA@CAC@CBEAG@ECA@ABC@E@ICG@EDE@A@CA@AC@O@A@@BFDFDDFDDFDLHLJDFHDDDBBB@ABWBEA@DLRBDADAB@D@DDDBB@BAD@BBB@FABABADBDBDABA@C@CAAAACAAACEAAF@BAF@B@D@D@DAFADCHAFBBAB@DABAB@DBD@DADCHADADAB@D@DBF@DADADAHEFGLCNADAFADADBD@D@BBF@B@B@BA@EJJLJLLVBXCXE^KZMRMRGJHRXNRLJNBXFTCVABMLUB]B_FaLYPaH_N[HABON@\\KPKFQG_BY@UDKLANDTFVN\\RPJFFBV@NGNITGLBPDHPFRBNLNHLNVFNQLUHMPDNBDPNRFZCXCPDLNPVJVCVQHQDQDULIFKHYLWNYJSNWPIJALABAD@BAFAFAHADADCBADEBEBABC@A@CBA@ABAFCBABCBC@BFBD@B@D@HBB@B@BBBHHBBFDCFA@ABC@ABC@ABABABCHCBA@GBC@KBEACACACBCBEFAB@BBFDFBHBDBBDHBDBBBBDDB@HDD@BBBD@@G@E@A@A@A@@@CAEBCBABC@EAA@A@AF@BADCDADCDGDA@C@CAEAICEAGAICE@C@AAA@CCCAACEECCECA@AAICCAAAAACEAAICAA@AAA@A@GACAAA@GAE@G@C@C@CAAAAAAAAAACAC@AAAEBCBCBCCAACAEBQ@MBIBCFCBAB@BADCFADCBGBCDEBEBGDGBABCBEDMFCBCBGBKBCBGDEBSFE@CBEBCBCDCDABABEDIBE@CBCAA@@DAL@F@HAF@D@D@@BDBBDDDFB@DBB@D@DATEFABBJDLDJDBB@B@D@DBDDHFBN@DBBBBB@B@F@BAD@BAB@DBF@DBH@FCFAD@DDFDDFVHBJBD@DBDBBBDDDBDBDFBBBABAFADABBDBB@BAB@BADEBCJCBADCHEBAB@B@HBB@@@BA@A@AAC@A@@@E@AAA@A@A@@B@DDBDFFDDD@D@B@D@FADBDBDBBBBBDBB@DDBBBBBDBFBDDFBDDDDBB@JBJ@F@F@LBD@DBBBBB@D@BBBBDBBBD@BEBA@@@AB@BABAB@FEDABCBEDCDADEDCD@BEFCDA@@BBD@DBBDDB@B@BBBDBB@F@H@BBBDFBDBD@BAJ@DBF@BB@@FBFBD@DABADADAD@BBBBDRNTHXNLHH@JCFEJAJ@JAHA@AACCCAGFIPGPCLABCBGBIFEJIDABEBCBAB@B@J@D@FABCBAB@H@PBNBH@BB@D@DAD@FDH@F@BADAB@@E@ABCPBBDDLJLJFDBDBB@J@DBBHDF@JAD@BB@BABCBCD@@@BHAFAHBFFD@H@RDD@HAHGDAJANAX@JEH@NCJADAFBDATCFAH@FCH@B@DBH@JADABC@EB@FFDBD@DAFAF@BBBAHC@AAC@A@@HCN@FGBCBC@ABGHGDEHAFCFCFCDCDCFGDEBAFEDAFAFAFCD@F@FAF@H@D@HADADAFCHAFAF@NCHAF@D@D@HAF@H@F@FAFALEBAFGDEFEDG@CEG@I@ALQVOXINITQNKdCRBRAVBXAZA\\AXAT@NHFPJHTB^A\\D\\@\\GHMTIZ@XE\\CTINOLMPGLCRGLGHMBQFONWPKREVIPEXCVBVCLMGGGKEK@OPOLGJKLMPQNCPBN@@KEKGMGMEMEMISGSIMIQCODEFGLGPDPBPKNOPQNOVCZAZFNVDR@RBNLJP@VKNQNORSTQLULUHUFMDW@UNWJKNMLQNGPAN@REPKHQHOHMJOPIR@T@XBRBTERKRMLONKBABBDBB@FEFEFGDEBCBCJI@@BA@A@CBCBEBCBC@CBC@@B@DAD@DBD@DBB@@VHHDABADAF@NAH@DAB@BCBGBIDEFEDABABABCD@HCH@FDHDDGFGHGBAFCDAH@FB@@N@HCFEDGJGDEBGBCAE@CAC@AAAAACCCCAECCEAAE@A@E@CBA@CBCAE@@@CNAVKZEZ@Z@ZJXDXBZAPEHCXDRFVH^@Z@\\IHA@ABCBE@ABADAF@DAFCHGFEBCBCAGCEAE@GAG@EAC@C@ABABABC@C@CCE@@@A@AACBCBCBC@C@EBE@CKGKWGSAQAWDYNUDSGIMGQG[GYMGUEWGSMUI_IUGWEWLQVCPAXKLMFU@YDUFWFS"],encodeOffsets:[[96530,31764]]}},{type:"Feature",id:"542424",properties:{name:"聂荣县",cp:[92.303377,32.10775],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@C@SEEI@KBMKIUAQNERIFSCSIMIQAOBYCI@KACAC@CBA@CBABCD@DADADCHCFAFCFCDABAFABAD@DADgM]IÏoYI@Q@IAGAGAEAECMvBJBJAJCF@DDXEBABADCB@BCHAFCD@DAFFHBBJJHFJFDDDBBDDFBBAD@BCDGJAB@D@B@BBDBBBBJFDBDB¨K^VRND@B@H@B@F@HBDBDBBBBBDF@DAHEH@FAD@D@D@BAD@F@D@B@D@BDB@BBD@B@F@BBD@DCFABADABCBABCBA@CBEBEDGFGDABABCHGJGJCFCFABCBA@C@A@EDMBYJYN[LSHMFMHMJMNMPIL@J@H@H@HBDAHEFAF@DGFEBEFEHGHEFEHGHGBEFEHEHAF@F@F@DBFBD@F@BBFBBBBFFFDFDBFBH@D@D@FEJ@H@JDJBHDHDHFHBDDFBF@L@J@DBHBFHHH@HBH@F@DAHAF@JAF@H@HDDBHDHBH@JAHAF@L@H@HDLBJBHBHBFAH@H@F@JBHBFBJDHFDDDDBHBF@FBFFFHDF@FBF@D@DCNMLIPGNELEHADCBAJ@J@H@HALENELCH@HBJBD@HCB@HAJ@DBHFFFDBF@H@FBFAB@B@BBFCRANCL@RBH@FBDHFHB@FAFCFEFED@DABAD@FDF@F@HCDEFCBAFAFAJANDHAHEDAHBHALGBAFBFDFBF@JBFDDHBDDDDFDFDDFDHFHFDFDFDFFDFBJFDDBDDHFVBJA@CDCJ@LBPAHGLIPGHAL@NALALGJGJEFCJ@JBF@BCAADADCDAFCHAL@J@PBDHF@@BBBBBBBF@D@BBFABAD@B@B@B@D@B@BFBTDBDFFBD@B@BAB@BDBFBB@@@DAJAT@L@HB@BMX@H@BAD@B@H@DCFEH@BAP@L@BDJBDBBDFDDHJLFLJNDRBJBHFNPDHDJDLLRJLDBJAD@D@DBJFDBBD@D@FDDJFPFFBDBAB@BFJDHJHH@NHBDBFFHBBCH@FAH@HAFBDBBF@HAD@D@LDBBDD@DDHBBDBL@FBHBNHFBJFB@@BADADFJBB@BADEFORCDENBF@FBBDFDDBBD@F@FAJEJ@HHHJBDHDDBBBHPJNFHEJBDDDJNBFADDBBBJHEDMFGFCFCBCBGDADAH@B@FB@F@D@DBD@F@D@DBB@BD@B@D@BBBBBB@D@B@@@B@D@DAFABADAD@B@DFDDBBBDD@@@BA@CBC@C@ABCBADCBADCDA@@B@D@FBFBBBFBDBD@B@DABC@A@C@C@ABADABADAF@FBF@D@F@DBDBDBDDDBDDDBDBDBDDBDDDBBBDBBBFBD@DDDBDDDBBBDBD@D@DBB@BDBD@D@FAD@FADCBADCDAFCBCBAF@D@B@D@BABABABADBF@D@F@D@B@B@DABC@EDADABA@@B@DBDB@@BAB@B@BBB@B@BABA@ADEJEBADCDADADCBABCDAB@FBB@DAFCB@BABABBDDDDBBFBFDF@FBFBF@F@HAF@FADCDCFCDCDCFCFCFADAD@DADADADADCBEDADCBCDCD@BADBF@BAD@@BAD@D@FBFBFB@B@B@DADADAB@FDDBBBFDB@DAFADCDAFADCDAD@B@BB@F@DBB@@D@D@@@FAF@D@BD@D@DBDDBD@DBF@DADCFCDAB@FBDDF@B@B@B@BADCDBDBDBBBDBDBB@D@FBFBBBBBAD@B@DBBDDDBDBDBBDBBDBBBDBB@B@BAD@DDB@B@@@BAB@BCF@FAB@BA@@BC@CBCBE@C@C@AB@@CB@@A@C@A@ABC@CBA@A@AAC@AAAAC@ADAFAD@BADABAD@HAD@B@D@BAB@@A@A@AAAAC@AACACAA@CAA@CAA@A@A@@@ABAB@BAD@BAB@BA@A@A@CAA@CA@BA@CBE@E@CBAB@BAF@D@BA@EACBA@@BAB@@A@C@C@EBC@CBABABABADABAF@D@D@D@BBDBD@DDBBBB@BBDBBDBDAJBF@H@@@B@B@D@B@DBD@D@BB@BBDBD@BDDD@DAHAFCFAB@B@B@DBBAD@F@B@D@FBDBB@DBD@F@D@BF@D@BBB@@BB@BBB@D@F@DBD@BBBB@@@BBDBB@DBBBDB@@B@B@DADABAFAD@DABAB@D@D@@BBADABABCB@BAB@BADADABAD@F@BBB@B@D@DADAF@D@B@D@BBBBFDBBBBDBBBBAD@DADABCF@BABABA@@@AAC@ACC@CA@@A@ABABAB@BCBABC@ABA@CBGDCBCB@DABAHAD@B@F@D@D@D@FBD@B@BA@A@ABA@AB@F@BADB@@D@D@D@BADA@ABAAAACAA@A@A@ABADAFAD@BADAB@@ABC@C@E@C@A@A@CACAEACAAAAAA@ABEFAF@D@DBBAF@DAFAD@BAD@B@FADABC@ABABAFABAFADADABABCBADCFCFCDCDCBABC@@BABAB@BCDABABC@C@A@C@ADCBABADADAB@@@BB@@@DBDBDBBDBBB@@@D@B@DBB@@BBB@BBD@B@FBF@F@BBB@@D@@BB@F@@BD@B@@@BAB@@@B@BB@@BB@B@DAH@DAD@DBDBB@HAD@D@DDDBD@BBBBD@B@DABAD@BAD@D@B@FADCB@@A@C@E@EBE@CBA@ABABCDCBABABCD@BA@A@C@A@EBEAC@CBA@C@C@EBC@A@C@@@@CCGCECACCEAE@EACCEECI@I@CAICEAECGAGCCCAE@EAC@EDEBEBCBCBE@GBE@EAEAE@EDEHEDCFCDCDE@A@@@A@AAC@AAA@AAAAC@@@A@A@C@A@A@A@ABAB@@A@@@C@CCEEGAE@ICEECCAE@G@MGIE@@AACAAAC@EDCBA@CBA@GDA@@@CCECACCECAEEEEAAAAACAC@C@@@CA@A@EAA@C@A@CBC@CACACA@@CAC@AAAAEAEAA@@A@ABAD@DCBCBEBC@@BCBABCB@@BD@@DBDABABCB@BDBFADABGBCBABAB@BAB@B@D@DBDBD@DABABCBCB@@ABADAFEB@B@DABAAA@AAAA@C@IBKBC@E@ECAA@ADA@C@C@E@ABEFKBGDI@ABAB@B@B@D@JFB@DADEHCFA@ABGBG@C@A@C@ACAACECCAA@CBE@C@CBCBC@@C@A@A@C@C@CBE@G@AABA@A@A@AA@C@C@C@CAEACCECECEEAEAEAG@C@GBEBGACCCEEEEAGGCCEECCCCECGCEAEAEAGAI@GDGDADCDAFEHICE@EEEECEAC@EEEE@EAG@ECE@E@G@G@K@IBGBGBIDEDCBIBGDGDGBCDEFCFCDCDIFADAB@D@H@DCJEFCDGBCDEBEBAD@F@D@FFDHF@DIHGDGDCDEDCDCFAFEDEBGFCBEBGBEBEBEBIBGAKEEACCGCEAEECCCAE@E@EBCBCBGDCBCDCBEAEE@C@CDE@EBGAGCGECAEBEFCBCDEBABEBC@IAEEEEEIEG@GCEAEAACBGBCAEBE@C@CAEACEGCEECKAGCGCGC@CAICGCQAOBMFETGJIJMFILEP@NEFEBCBC@CBQM@GD@@@BBD@BGDABAAE@EBCBC@CAEEA@@FADCBIBG@CAA@G@EDG@EBSDCBEACBIBMDG@IFW@MBIBCBGHGBC@QCG@C@EEGAEBGB@A@@DCDABA@AAAC@IBE@GCAA@C@IAAACECKIKICCAADOBAF@@@BABC@A@ECG@EBC@C@CAAG@MAOAG@A@ABADEBC@I@A@A@ABADAFCBIJEFAJAHADKBODOHEJBHDDBD@BGBIBI@IBEFIDG@KGWMSGQMACAA@ABCBCBCBA@CACAE@EA@@AAE@CBI@AACACCEAA@A@G@EAAACAAA@A@CCAA@CAC@AB@DCFE@ADCFCBCDCFCDABAFC@EBABA@ABA@@B@FA@AACAAACAA@A@CAAAACAC@KAE@E@I@IAA@CACCACCEACAEACAAAACCA@CAAAAACACACAEBC@A@C@C@CCEEACCCA@@@@B@BBB@B@F@@@BBD@B@BAB@@A@GAA@A@ABGFCDABIDADCFABA@ABA@CAAACBEBABABAACECACACCAACACAC@IAGAEUCCCE@CBCDE@EAG@CAE@CBA@ABC@A@E@AAAAACAM@EACGAC@C@C@AAAICKCICAAEBSFCBC@A@CAA@CECCAAAC@@@C@CBE@G@EBK@C@C@A@ADCBABABE@E@C@AAEAAACCC@C@A@G@ACI@AAA@CAE@ACCAA@ACCCCAACAGEAACCCAECCACCCACCECAEAACCECMIAAAA@AAGAC@A@A@AA@@C@GBA_uCMOIYB[AUFUFWF]DQD[EQKQAQI[@]EWG[ASC"],encodeOffsets:[[94490,32479]]}},{type:"Feature",id:"542427",properties:{name:"索县",cp:[93.785516,31.886671],childNum:1},geometry:{type:"Polygon",coordinates:["@@BcCCQBGCESFMEGECMJSLUACAQCGHWPIPINOHKLCNCRGNERCLCNCTBXCZBV@XBVFRAVN^LVJLBLJTBVCRLRTHJJNLFJ@JIJCJIJMFMDMOMEKEMGWISMMQKESISESEQBWAOBUBOHGNGNCLEV@XSNOTEJFNFHRPPNJL@RGHQTQBSCUIMKOSWKKDSOSQQCKQIYGS@AIQWKUGUAUDWHOFO@UBQACSJGHMQO_@IMGYUCQEABE@C@CBA@ABCBA@EBA@CBA@CBABC@CBE@CBA@ABCB@@EDABABA@EBA@C@A@@B@@DF@D@DADABAB@B@DBD@FBH@HBFDFBHADADEFGHEDCBE@CBAB@BAFAD@BGB[JY@]@UGQEWCGDOFYBWAWCYIY@Y@YFULMB@D@@BFAD@DAB@D@F@BBFFBDDBFDDDDBBBB@BBD@DBFADAHCFIHCHEFGDM@@@EAG@CBEDABGHEHCHGCECG@GDC@ADABABCBEFCFAJAHADA@CBG@MBE@CBABCBGG@UA@CAC@CAC@CBA@@@AD@DADADAFAD@D@BAB@@IJADADCFEHEFEFA@CAAAABMLKPQNQLSFQAWAS@Q@OJIPGNGPGROLQFM@OBMHKRMNILMX@VCXENGVKVKVSRQTMPMRULO@KIAM@QCQMUYEYBUDMPORMPOLOAOCKHEHCFDPJRJNHTJTFNFNHNHNFL@LM@OAMDORKNILKHOP@PFLHLHHKNUDUAWDOFUJQFOLMXEPARGNKHQHVAPHJ@TDNBLCN@HBH@VMD@L@H@FAFADAHCFD@B@@BD@NF@DADABCD@F@DADAB@B@BBFBD@@BB@@BFHJF@FBBB@DAFAHCLIBDHFBBBBBD@@ABGBABIBEBUHCBCBCBEDSJGBCB@FBFDDDDDFDJDDBDFBH@D@D@H@DDDBBDBBBBF@DABAFAD@B@DADAFAH@DABC@AB@PED@B@@B@BBH@DDNBBBBB@DABA@@BB@BAHAF@F[JBBB@BBB@BBBBDADAB@BCDA@@B@B@B@BB@@B@B@FCDAB@BAB@D@D@B@B@@ADA@AD@@@BAB@D@F@DABCBGDCB@B@HBB@B@BABA@A@AAE@AAABA@ABAB@JAHCBADABA@ABC@@BAFAJCF@DAB@F@B@B@BA@A@A@C@CAC@A@E@@@C@ABABCB@@ABCBA@C@@DBBBFBF@HBDBD@HAB@BBBDBHDDHHFFLJLJBBBADAH@FCHCDABA@A@ABA@CB@D@BABCBADAFCFCBAB@D@@DBDDBBDBBDHHJBBDFBFJBD@DDJFBBD@BBBB@B@FDBFDD@L@F@DABCBC@EBADABA@ABAD@DAFCDAB@@@@ACEEA@@CCCCAACC@@@AAACEGMAC@C@@@AAC@C@A@AB@BAB@D@DBD@B@@ABAB@BBBBB@B@FAB@BAB@@CBC@ABAB@D@@@B@BBBDBB@@B@B@@ABCBABAD@B@B@BAJCLANCFCH@JCLAP@J@F@DCDE@E@ACEACCG@E@EBAFADABC@ABEDC@CDAFAH@D@BA@AAEAA@C@E@GACBCBADAD@ACDE@ABA@CBALAFEBAD@DBDDDBF@DBBB@BBD@@BFFBNBF@DAFDFDFFBFBDDDBBBD@@BAB@@ABCAAAAAA@CAC@CBEBCD@D@B@@BB@DBD@DAFAFCDAFBFBH@FAD@BABCBAB@DDBBBF@DBDBBDDDFFFDFDBBBB@D@D@B@DBDBBAFCFEDCJCDABA@AACWg@@@@D@D@F@DABAD@@A@A@AB@@BBBB@BAFBB@D@DADABAB@DB@BB@D@DAJ@@@BDFN`LXKDE@GBI@CAAAACA@CBCBADABCDDBBBBB@BD@HHHBHBHBD@B@BHFBDBB@B@B@@DBDC@@@@FCF@D@D@HBDBD@JA@@@AFCBAB@J@JCFA@@FB@BFBF@HAJGBA@@DAB@@@DD@@B@@C@A@AB@@AAC@EAAACBE@CFADCBE@K@GBABBBBBHFBFDD@DAFABCJCDAD@HCBADCDAD@@BFDFFDBB@BBBAF@H@D@DADADAB@@@BBB@DDBD@@DBB@B@HCHCLAJ@FBB@DDDB@@B@BB@@HAJ@F@HAFADEBEBCBCB@DCDAB@DADCDADAD@F@D@DC@@@CBC@CBC@CFAFAJGFCXD@@BA@CACMOCGC@CAC@@@@A@ADCDEDK@C@C@CB@H@D@BCBABAB@B@D@DADA@@D@@@@@BBB@FHDFDBDD@B@FBB@@BB@B@B@F@@B@BB@@@B@DB@B@D@HAD@BBB@BDBBD@BAD@D@B@FB@BBAB@BADAD@DA@ABA@A@@D@D@DA@BB@BBB@D@D@DAB@BAB@B@BBB@B@B@DCDE@ABGB@D@F@HAFD@@DBD@BBBB@DAFADBDDDB@LEB@FBB@DAB@D@FBHDLLHJFJDH@D@BB@L@D@FAFAD@T@D@DABCDEFCFEF@L@FB@DBABAFAHADCDA@A@AAACA@A@CACACGKCCACAC@CD@DBD@BADEBADC@AAE@C@AAA@ABCBCCACAGC@A@ABABCDADADCF@LCF@D@DADADA@@BBVNthDFDFBBD@BBBBBNEDMPA@G@ADAD@DBPALAH@DBD@BADCDCDC@G@@C@AECGIGCCAIACC@@A@EDEDCBCDGH@BHN@FELBD@BEFABGHKJIN\\M\\@VEXIVIRGHKHMDMDMAODMDQHSD_EOIIUGOBQ@OQEKGOSCUBWEIEGQCMDM@ODQ\\EPJRL\\AZGTCHMDMDMHG@AAAA@AACCAAECA@@G@G@GBIDIDIDGDIBGBEDCDCFAHCPAT@XB\\BZCbEXGbMREPILQFSBQHQNUASEUGKOKOQSQIQGS@OTSLQRIVMTOVKJRLFVELGJIPKNKHKFOEOPIJKFWESEIGMLI@ADMGE@C@ADADABADGDCJED@BADE@CDAFIDEDI@AAAACAADE@A@AAA@A@EBIFGBCNMTWBCDCDADAB@JGBAAQACCACAEMAE@CAEAACACAAAGBCBC@OC_SAGB@JDRUDKG]FSAEKJQ@GiUW@KBC@UCG@G@MGOOkJAC@A"],encodeOffsets:[[97107,31862]]}},{type:"Feature",id:"542426",properties:{name:"申扎县",cp:[88.709852,30.930505],childNum:1},geometry:{type:"Polygon",coordinates:["@@DPKJMJ]@SC]YOIO@OL[FCLENQBMBUBIBQ@USUIYCWDxRLDLPVBNFE\\CZAR@FCDGHMHMJ_DEAGCG@G@GDIBIBGDIPO@ABABATDD@DBFHTAV@PANAJBJBFJ@LBR\\N~O@[E[OGMMiEIIG_GYEC@AFB`BTBRCDMVJ\\FP@@BPabAFOHOLSPAF@LHVFRPTPFJJt\\NHDHBFMPOTCF@H@NBbANDPB^BFHBN@JHZ@HAtHVDtHXHFHEJAF@BDXHf@LEdXDD\\BN@FBBBCJELAHDLPNBJFHDBhJJ@\\IDBVPNXJ\\dNPATDPBJHDF@LADMHEH@FENABE@K@KFDNCV@@AFDZ@BC@K@CBEDEBQBK@OHUFKDOBIBAB@@@DBR@BMLC@A@GACC@@ABBBFLFN@B@B@@AAC@IACAI@ICEAC@CBG@C@CAAAUE@@ljjjD@^AF@PAHCTAB@FNHHHJBJ@@BLAHCJCJBDHN@LBJ@NBDABCJDFFHLBDHBJCHBHDDSZMP@D@FBBPVT\\EFEDCDABA@@@@BBF@FAFAFAFAF@BAJBT_ISD]LSJCTOFKDGNQJQLMLONMJEJAFERSP@PDNARNLJJCZHVDLGPGHMHAL@LINKLMJMDITAPANILEBCBCBCBCBCDCDCBABABAB@B@B@D@@AD@H@D@B@B@D@F@F@F@D@BEHEHCDADABABCFADADAB@BAB@B@B@D@D@@@B@@ABWpBF@D@B@B@BADADA@@D@DAD@B@B@BDDFHBDBB@D@FAF@BCH@B@BT¤BBžÈLF¸lPJD@FALGHBDLFDDDDDBH@D@BBH@B@B@B@BCFGHBDR@BBB@BB@TBJBN@ZHJBHAJBPBJD@FDBLCJCHGBGBE\\HJBHDPALBLAHCF@RCF@RBRDN@JDFDB@rJlJnblvF@BABAD@F@H@HAV@FAD@DABADCB@FADAFCHCFCDADAD@PAHARARCNAFAD@~§BĆúƸ¦PŘDBhBdDJJ\\PdT~NX@B@F@HAFCFCFAB@BBD@B@B@BK`OhQt@F@DHlAB@FnTJDJDDDAF@@@BBB@BBDBB@D@BCF@BADBD@D@D@D@D@BBDA@@B@F@NBHDHFJDDDBJDFHJJjHPKDEBA@A@GGc@AFERQ@C@ABCBANABAB@@B@FBBDBHBD@B@FAB@DAHCVCNBF@REH@@EEUACGGKICE@EBEFC|[TGLGHGhmFCDANBFBBDFBDBJFD@NCF@HEFCFKNIDCDAAE@CBADAF@D@DBDBB@NAJ@HBFBBB¾ÅBGC_@AA@ECAACCCC@ACCEE@A@C@C@E@C@CAA@@@AA@@AA@A@AAAACAA@AAC@E@VJeA@AAE@E@CAA@ABABABA@A@A@IAE@C@EDC@E@IBIBQ@M@CAA@ABI@EAG@G@K@E@I@CBADA@CBA@WCC@ABCDAF@DAD@FBD@PAFBJ@F@BARƣUAAEGB@@A@A@CAC@CCE@CAC@AACCO@C@CAC@CBK@GBGBE@CBE@CDQ@C@IBCBGAEBEBEBK@C@CBCBK@KDQ@K@EBGBK@E@E@KBCBE@G@E@G@CBC@I@CBE@E@C@GBE@C@A@ADK@CBEBG@C@EBC@IBI@C@CBCBE@C@ABG@C@EBE@EBE@EBG@E@CBQ@EBE@I@CBCBCBEDGBAHG@@FCFCDAD@àz]LILCĨDDA@@BA@@BA@AB@B@BAB@@A@A@A@CBADCBADABABADAB@BABADABCDABCDCB@BADAAECG@A@ABAAE@EBC@ABCBAB@DILQFKDEDKDC@A@CACAA@CAGAC@EAE@CACAA@CACCC@EAC@CAC@CACACAC@C@CAAAA@AAC@AAA@A@AAAAA@A@A@@@AB@DCBABAB@BA@A@C@AAAAACACAAAA@AACA@ACAACAAACAAACAACACCC@CAA@C@@AA@ACAA@@ACC@AAA@AAAA@ABCDAD@BAD@DCB@FAHCFAFAFAD@BADADAD@DAD@BAD@FAH@FARAD@MiCGIS@@@A@CNKPKTGBSCSMQIOFYCKGKKKGMOGOCOB]DKKIEOIE]HUDO@UOM[EGGQA@@EDADCD@@CCCCAA@C@C@GBCBCHEDCJEDAFABAB@@C@A@C@CACAAAACAGEAAA@AB@@CD@BE@AAAA@CAKDK@EAGBCDCBA@I@ABADAFAHADA@@@EBC@@RIJENEJCFADABCLUFQ@A@CAGBCBCDCHCNGNIDC@A@A@E@I@ABCDALEPELEHEDABCDC@CBCCQ@AB@DABAB@@A@AAEACCICG@IIUDMjCQGMEIMNWHEJG^µD@BDDBB@LB@@FBD@DBDAHDFBF@D@DBB@FDBBBBPLFBFDn`]jCBCBAD@BADCHABILCFCBCHCDAF@D@@AFfbADCJADCDADA@BBAFEHGHKJIFA@ADB@@@FAD@F@F@D@FBBBBDBB@DBDAJ@@@BBBBBD@H@DAFAD@D@BADCDE@CBA@CBCBAD@DBD@J@B@BBDBBBDB@B@B@BAFO^CHA@BBBBD@PBP@J@JBPHHHFJBH@T@BDHDFHDJFJBzDXBTBH@HAHADABAFEBA@@@BAF@BBFDDDBD@B@DQBAFCHCF@JCTEHCDCDEHIBCBGFK@E@AMOKK@C@CJIFM@AB@@@B@F@D@D@B@BABA@A@ADEEWTFdXNF@@BADABABABA@@B@@@LDD@D@BAB@@A@ACIAC@@HIBC@CBA@EAE@C@EBABE@@BADCDADC@ABC@E@A@C@AAACC@A@ADCFEBABCBACAAEAEAGCEEKGOAE@CFGVGLGNSFM@ABEBCFCBAFKBEDCBEDABCJKDEDCHKDG@IGSKOKOAWAK@QGQAKSUKKIIMAWE@@C@ABCBCHABAFABA@CBC@EACAKAC@A@AAACCCAAACCAEBCBCBEDABABEBCBC@C@CCAACGA@EDOD[JODOHSFUHUCC@AAAACECAABEBC@GBA@GAECA@A@C@EBIDEBECAIASBCBCD@F@FBTHD@B@BADG@@NKJALMJINEFGHIFEBE@@DCDEBGBEBKBAFAHBH@HCHEDCDG@GBGBIDGHIDCBEAOGMCCSDEAECOK@CCAAACBCBCBMASMCE@EJOBGCCAAA@A@CCCECG@OAEEEC@EEC@QAIAEEGKAQ@AAACACAE@IDO@E@EA@[EOCGDCF@JBfBLGHCNAZAFC^IVBF@@EWsBKTGFCJOBMICKDECBQAKKD_JSDQFQDK@MBQAU]CAEAYAK@@]BIEEKIOAE@QZCFEDEBG@GCOOEAKACBmLHÿDcCW@O@QQEGD@A@E@E@G@ABCBAIUMMKQQA[@QDQHYPINILWROZ_FaWYBcEMEMBOHMPSHQPBPCRKRQASIcUOYSNODUOK@KMSQCQAKO@KBOAQB[DSAU@SLS@UBOUEQMQOCQASEIO@SGUQEKJSROLMHOJUEUC_VO@KO@OBWGGOCINODYDQCQDQNIJSDK@K@WD[AUDMDSB[BUBcAQAWASKI_IUIKMOMMIUGG[A[KYKAMBMCYMKSCKOEIC[@QAUUUSI[HSFONWJOPERFXFXDRCTAPDJTPFLALDNCRIRCLMRSFODQRKDO@SDANITAVOH[JU@SHYDUBIBW@U@_AYWKUGOQQMDONOFQGICYKQA[LELGTGRGPOLKDSHOBSHSDUAOBGNAFAVAV@PANGVGNKTET"],encodeOffsets:[[90208,31088]]}},{type:"Feature",id:"542425",properties:{name:"安多县",cp:[91.68233,32.265176],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@IEAAAAAC@A@A@CBAHIDC@ABCAACEACCACCIEGEIIAAEGBE@CDCBEDG@ADABCBAFACW@CDEBIAIAIuNCC@A@A@CDABAF@BA@@BA@AAE@A@A@ABABE@A@C@A@C@C@CBIBEAGEIGM@CCAACCE@GDC@EACCIAECECAABCBEBEE_WGGA@AAAE@@AAGC@AABE@GBOBE@EBbABEBABCBCDAF@DBF@BA@ADGDEBGBG@KBCB]J@@@B@BA@@DCFAH@FAFCB@@AB@BABC@CAA@CACACAC@CAMAGBC@@D@B@B@@@BADA@@BC@C@C@C@ABGA@@CA@@E@@B@B@F@DBB@B@D@@AB@@CBKNuVcAUFMJIVMLQBcTUTMHQDmXKL[IIIGIKI@KHSHOMMUAIIEOEOGMOQGGE@aAGBEAC@C@K@C@Q@K@A@SAY@M@C@YFEBCAC@@AOEMCA@WH_BYBUGGMGOKMMIUBKJSAKMIKIMKMQIUIIIGMGKUBQFMMKKSOYMMM@M@MAMWIKA@A@E@ACACEECAAAA@@BALAB@@A@@@AGKUYEIKIECOAKAK@G@WAMDC@IAEAEAGCAAECSKWIC@SKC@GBCBGAA@E@CBGBMBQDE@G@G@CBE@GDGBEBGDCB@BAD@JAD@J@FAJAF@DAFAFAH@D@F@B@DBFBDBDBDDDBBFDBDDBBDBDBFBF@F@F@N@DAR@HADADALAHAH@DAF@H@F@FAN@HAFANAFCFKPINA@ABADABIH@BABOA@A@EFGFGDIFQRKTCRBNPNBFCNAPENDVFTLLHNBL@VGPONSDWHOFOPEP@BAB@B@BDHBBBBABABABAB@BAB@DCBKFEBCBA@EBCBG@I@aXCJEHEH@J@H@HEFGFCJPLCDCBADEFCDCDCBCBC@GAC@C@C@CAGACCEAE@A@KCG@UF@@A@AA@@CGCCCA@@AAEAEAGCKAEBODC@IBE@A@ICGBAB@B@BBP@XAJADADEFAF@BBHBD@BaOCE@C@A@C@EAAAA@GDKBGBA@EBCBA@C@@@ABA@@BBF@@AB[bBB@DDHBFBF@DBDBFDDFD@D@BJFXLXRBBBLBDBB@J@BBDFDDLFBBBH@F@DDDB@@@DBXC^@XJXFJANIP@D@FADWLCBy¼mjGZGXCAAAAAC@A@CA@@ACECCAI@CAECEAE@GAG@I@IBE@@@EBEBK@EAE@CBIFCDEBC@GCC@CBEBGBCFEFKFSFI@G@GEGCACC@E@G@EAICIAEAGBG@IAGBMCGAE@GBEBI@GBEAKEGAICCAP¸öTFTOnq´WraAF@FAN@DAD@@A@C@@B@FAN@DA@C@E@E@ABAB@D@LADCDABDJBJ@BB@@@BBB@DBHF@@µº¦gjY\\cbU\\UXSTADDBFFHDFHBDADEJCDABA@A@A@E@IBC@EDABABADB@DDBFAFAFADEJ@D@BNLBFLJFBLBJ@DBDDD@FADADAHBBBHBBFFBF@DDHFFBAHADGDCD@DCBCDEDGDKDOHCDEBC@C@GBGDEDKJEDA@G@QAG@GAEAGAE@EAEAEAGAEASCOCgKIBO@I@IBM@I@O@G@KFEBIB@@BBDBBDBB@B@DBB@BFFBB@B@B@BABEDCBABCDCBI@A@@B@B`®nìp®\\JX`NpCXCNFH@F@BAFKFGHARAHGJ@BAJ@DCL@BDHBBHNBB@F@DAB@D@FABADEF@BAB@JCFEBCFGDCDCFADABABBDABABAH@BFJFDHBF@FBFD@B@@CDOLIFCBG@G@C@K@E@C@GBGBGHMBEFCFBDBHAJAD@DBF@D@BJJHDHF@D@F@J@DDFBBDJFJJFFHBRAHCJ@@ABABKP@DEFCDADEFOR@BABSJGFGBIDG@CBA@AB@B@DAF@BBDBBBBB@@BGNEPI^O^TăXRC@GBG@E@E@E@G@@A@@BA@CEIEEISIGGGEAI@IAG@CAAAC@MBQHCFCDAD@BBB@@BDFF@B@@AB@@AACA@AACAAC@AAAAECCAC@ABC@EFCD@B@DBBBB@B@BBD@B@@B@BA@@@A@E@@B@B@@AB@@@@@@B@@@B@@@B@B@@B@B@@AB@B@B@DBBB@B@B@DGH@B@F@DABEFABABG@AB@@AD@B@@EFCDCBA@kVm\\]VIFABAFBD@FDHFHJLFDFBFDFDFDHFFH@B@FAJEHAH@@@@@FFHAB@BCBCBA@IBKDK@CBC@GDABADAB@BAP@JDH@BADABCDA@ABC@AAA@IAGCCACAEAQIECGAGAEAGCEAA@OFSD]HOFGFEFGTABAH@R@FCJCLADCDABAD@@@D@D@@D@JCB@D@FDBBFBBD@DBD@@B@DBBBBB@B@@BFBFBDJL@B@BB@ABBH@J@HBDB@@D@B@BAB@B@@@BB@F@BBBBDD@B@DEF@@@B@BFF@B@D@BADCHABEDABABCDADGNABCD@BB@@BDBLD@@@B@HB@DFBBBBB@B@FDBDBJDF@BBFBD@B@@CBGBABA@AB@B@HABEDA@CDAD@BAD@@AB@@C@CBIAA@@@CDA@A@GAA@A@A@@@C@ABEHCHABCBAB@@E@A@A@CB@B@B@@G@I@CBABSHOFEB@BADAH@DBDAB@B@@AB@BABAB@BB@B@BB@BADAD@D@D@DAF@B@D@B@BAB@DAD@DBDBBBDDDBBBB@DBD@FBH@D@BAF@B@D@D@D@DAHCLADABABCL@BADCB@B@B@D@@@D@D@DABABGHCD@@CBE@A@GBEBA@ABEDADABA@EDA@CDAD@BCH@F@D@BABCFCBC@IBC@C@ABEBABABCB@@CBABABA@ABABEBEDKBCBABIBGDCBA@E@CBABEBA@EBABE@ADAB@BAFADCDADABABADCDADCDEJABAB@BABABAB@@AABA@CFKBCBEDEDADABAB@@A@C@C@A@AAA@AEACCA@AC@@@A@E@E@A@C@K@A@AAACAAC@AAC@E@C@CAC@AAAACCEAEAACCAAACAEAAACACAC@C@ACGAECC@AA@ECAACA@AACEAAAACAAAC@AACCAAAAAAC@EAKAE@ABEGCEAICSBMAC@E@E@A@ABC@CDABABABCBA@ABABABADABABADGHCBCBCBCB@D@DCNABABAFCD@DABABADABEDEFAB@B@BBFCDABCD@B@B@BAFADCHCJEFCFABAB@FBDBHBDBD@D@B@BCHABADAFEJCH@D@B@HBJ@FAJ@BCFCDCDADCBCDE@CBEBABCBCDEFCDABCBCBCBCBABCDEBE@CBEAABC@ABEDEDCBCBC@CBCBCBCDABCBC@GDCD@DABAL@D@BBBDBDDDFBDBB@D@BAFBH@B@H@D@BADABCDC@IJCBCBABABCBA@CBCFCB@BABARAFCBABADABCH@BADABADEF@DEDEFCDCDMJEDKJGBGHCBCDADAD@D@D@@ADABCB@B@BCDABA@AD@B@D@DADAD@DBB@@BBB@BB@BAD@BBBDF@B@B@F@DADBHAB@HABBB@BBDBF@F@L@D@DBBBBDDA@@BADADAD@@BD@BBBAFADBDBBAD@DAF@D@BAB@@CBCDAB@D@BAHCDAB@B@D@@@FED@FGF@B@H@FCFCBEBA@@BBF@D@BDJDVBD@DEF@HGF@@CB@BADCB@BCF@@@@AFCFABABCBIBC@ADCDIACBOFA@KDABCBA@CBEDADEDABAB@BAFAB@D@B@DAF@D@F@D@DBFBB@B@B@BA@ADCDABA@AB@B@BCBA@A@@D@DAB@B@@EBE@CBA@CB@BA@EBE@CDCD@FGHA@EDABCBIDA@ABEBEBABABA@EBA@@BA@A@ABCBC@A@A@CB@BADABADADCDCBC@iAG@EBC@EBI@CDEFA@AFAFBLFJDFBBDFBJQDMH@B@@DDDDHDHH@BABEDI@QBSD@B@B@F@D@DALBBBDBHBH@@DFDB@BBBHFDDFFVDDDJT`Zn@DBFFHDZATAV@P^HLJJHF@BB@PHPNB@DFFFDFBFDBTHDDDBBDBBBBD@DBDFHHDDHFDBBDHHHFHFDDDD@F@HCFCJCFGLEFCHCJCFAFADBDBBBDGJCBABADBD@DABAF@DDJAFDF@BDFDDDB@D@F@H@F@D@FCNADAD@BADMFCBEBK@GBGDCFIHEDAF@HBD@FAF@JBHDDBFFHBD@BBFJHFDJBBBBDDD@D@BBFBBBFBBBH@FBDBBBDoĆHPDHFJFHLPHNHL@BB@FDFBD@DARCJA^AD@@ADAD@D@R@P@D@HANBJ@D@DAB@FBNDD@B@D@HCD@D@HBFBFBB@HBF@B@FBDBFBTJBBDBLDF@D@NCFADBDDHBHBFDDDBBBB@D@H@BBBD@HBD@B@FAB@DADADAB@F@DBFBHFHDDBBBHFJDFDHBB@DBB@HBD@@BDBDBDBB@HFFH@D@D@DBDDF@FBDAFADABCDAF@B@L@HAF@FAFADAD@DBBBFDDB@FBD@HDHDDBDBDBBBDDDBDBDBDBBDDDD@FBB@H@F@FDDBF@HDF@L@FBJDJBFBHDDBH@D@FD@@BB@D@F@HAB@@CFAHAL@DHJHJDDFFFDFFDF@BBFDFFHFDFHDFJP@DBH@J@DADCDCFEHCH@DBDBDADABCDAFAD@D@BCFABAB@D@B@FBD@DDB@@AF@BBDDDB@D@D@JAHAPCHBBF@B@BADKPAD@@@@BBB@@BBBBD@D@H@DBDBD@BGFEH@DABAJBFBDBB@DDLDH@D@BB@FBPJDDBBDHDJBB@BAH@D@LAH@DIN@B@FADABADABABAJAFBBBB@BB@LAF@H@FBB@DBDD@B@@ADADAJCFADABCBCDKBCBKDA@CBA@GHA@CBCBCBCBABAB@B@BB@BBBBFBBBD@B@DBBBDBDBFBB@HDB@D@D@JAB@J@D@F@D@DBDBDBB@D@@@D@FABAB@D@BBBDBB@DFFBBJJBBBD@D@FBH@BBBBB@B@B@B@DADBD@D@BABA@A@C@A@CBABCBABE@ABAB@BAD@BBDDBDBB@@B@BADCBAD@B@BDFBBBBFB@B@B@BA@ABCB@B@B@BB@DBF@B@DDBBBF@BBDBBBBDDHDDDBBDDBB@BCJBF@BAB@BCFAFAB@DAB@DABIDC@EBABCBCDEDC@OBG@G@ABA@AB@B@F@@C@K@ABB@@BPFBDBD@D@B@FAJCHAD@FBH@D@H@DCFAFADCDCFCJ@B@BABABAB@@@BABCDEDCDCBABA@A@CAA@AB@@CFABA@C@A@CBC@EAC@GA@@A@A@C@ABGDCBAB@@@B@B@BBBBB@@DBDBDBF@@@@BA@@BB@@BAHAD@BAB@@@BABEBA@EFEBC@KBGBCBA@@BAB@@ABAB@BBB@BAFAB@B@FAB@B@B@J@D@@CDADA@ADCBIFGBEB@@CBCBCDABEF@@ABE@CBA@CDCDCBGBSHA@CBABEDCBABAB@BADA@GFOJMFGDC@IBA@CDEBABEBEDUJA@A@@BADA@@B@@BBDBDBBBBDDDDBBBB@DBJ@B@F@JADADAD@B@@BD@JHDBBBB@D@D@NAJBN@F@B@F@DBB@D@D@D@@@BA@@BA@C@ABC@ABABABAHADABADABABABAD@B@JEB@DEB@BADAB@JADAD@F@D@HCF@HCBAL@B@DBB@J@B@DCFCBAJAFABAFEDAB@B@B@FBBBLHHHBBBB@BBDB@@D@B@J@B@BB@BDDBBBB@HDBBDBDFBB@B@DADADADCD@@@B@@@D@B@B@BADGH@BABAD@B@F@D@F@B@B@@BH@BAFB@@@B@DCFABAD@@@F@D@D@HBB@HDFB@@DBFFB@B@H@F@D@D@B@BAJCJCNCP@PANAFEHGXMZKTKRILGLILILKNGPGPGTSRKPCNEPERAXE\\CTCVARETENA@@@@B@@@@@@AB@@@@@@@@A@@@@@AB@@@@A@@BAB@@AB@@AB@@ABAB@@AB@@AB@@@BAB@B@@@BAB@@@B@@@B@@@BA@@@@BA@@BAB@@@@@BA@@@@@A@@@@@@B@@@BA@@B@B@B@B@B@B@@@BADB@@B@@@B@@@@@BA@@@@B@@@B@@@B@@@@@B@BBB@@@B@@@@@@@B@@@@@@@B@@@@AB@@@@A@@@A@@@@@@@@B@B@@@@@B@@@B@@AB@@@@@BA@@@@@A@@@@BA@@@@@@BA@@@@B@@AB@@@@@B@@A@@@@BA@@@@@@@@B@@@B@@@B@@@@@B@@@BA@@B@@@B@@@@@BBB@BBB@@B@@BBB@BB@BB@@@@@@@@@BA@@@@B@@A@@B@@@@A@@@@@@@AB@@@@@@@@A@@@@@A@@@@@@@@@@@A@@B@B@@@BA@@B@@@@@BBB@@@@@B@@@@@B@@@B@@@@A@@@A@@B@@@@A@@@@@A@@@@BA@@B@@@B@@@B@B@B@BB@@B@@BB@@BB@@BB@@BB@@BB@B@@BB@@@@@B@B@@@B@@@@@B@B@@@B@B@B@@@BAB@@@B@@@B@@@B@B@@@B@@@@@@@@@B@@@@@@A@@@@@AB@@@@A@@@@BA@@B@@@@@@A@AA@@@@AA@@@@@AA@@@@A@A@A@@@A@A@@@A@@BA@A@@@A@A@A@CB@@A@A@A@@@A@A@@@A@A@@@A@@AA@@AAAAA@AA@AAAAAACAAAA@AAA@A@A@@A@@A@@A@@@@AAA@AACAA@AA@@AA@@@@@@@@A@@@A@@@AA@@AA@AAA@@A@A@C@A@@@AA@@@@A@@@@@A@A@@AA@A@@@A@@@@@A@@@@@@AA@@@@@AB@@@@@@@@A@@@@@@@@@@@A@CC@@BABABA@AAE@A@@@ACCEG@AA@CACAA@@@A@CA@@ABA@A@ABABA@A@@@@AAAA@@@AAACAAACAAC@G@A@AAAACAC@CAA@CAIBC@C@A@AAA@A@A@A@A@C@@BA@AB@@A@@AC@A@A@CAA@A@A@A@C@A@AAA@AAC@@AA@AAAACAAA@@CAA@A@@@A@ABA@@BA@A@A@A@A@A@A@AAA@@AA@C@A@@@A@A@A@A@A@@@A@A@A@AAA@CAA@A@AAA@@@A@A@C@AACBA@A@@@AB@@A@@BAB@@A@@@A@A@A@A@@@A@@@A@@@A@@@@@A@@@A@@@AA@@@@A@A@@@@A@@@@A@@@@@@@A@@@A@A@CBA@@@@@@@@@A@@@@@@A@@@@@@@@AA@@@AA@@@@A@@A@@@@@@A@@@@A@@@@@@@A@@@@AA@@@@@C@A@@@A@@@@@A@@@@@@@@@@@A@@@@@@@AB@@@@@@A@@@@@A@@BA@@BA@AB@@A@AB@BA@@@ADA@@@@@@@A@@@@@@B@@@@@@@@A@@@@A@@@@A@A@AA@@A@@@A@@@A@AAA@A@A@A@A@@B@@@@ABA@@B@@A@@@@@@@@@@@AB@@@@@@A@@@@@A@@@@@C@A@@@C@@@@@@BA@AB@B@@@@A@@@@@AB@@@@@@A@@B@@@@@@@@@@@B@@AB@@@@@@@@@B@@@@@@@B@@@B@@@@@@@@@@@@AB@@@@@B@@A@@@@@@@@@@@@@@@A@@@@@@@A@@@A@@@@@@@A@@@@@@@A@@@@@@BA@A@@BA@ABA@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@AA@@@AAAAAAAECC@@AA@A@@@@AA@@@@@A@@@@@AAA@A@AAA@A@@@AA@@@@A@@AA@@ACA@@A@@@@@@@A@@@@@@@A@@@A@@@A@A@@@@@@@@@@@@@AB@@@@@@@@A@@@@@@@@@@@A@@B@@@@@@@@@B@@A@@@@@@B@@@@@B@@@@@@@B@@@@A@@B@BA@@B@@@@@BA@@@@BA@@B@@@@@BA@@B@B@DA@@@@@A@@@@@@B@@@@A@@@@BA@@BA@@@@@@@A@@@@B@@@@@@@BA@@BA@AB@@@@AB@@@B@@AB@BA@ABADA@@@A@@@@@@AA@@A@A@A@AAA@@@AA@A@A@A@@@@@AA@@A@@@E@C@ABA@@@A@A@@@C@A@A@AA@@A@@@A@C@@@ABABC@ABA@@BABA@@B@@ABE@ABC@A@A@C@AAA@@AEAA@@ACCCCAGC@AAAAA@A@EBC@C@CBABABCDABADADAFAF@DAD@DAFAHADCHADADADADAFCB@FAD@F@FBDBF@B@FBD@DAHADAD@DADADCDADCD@BAFAFAHADAFAFAD@BADAFADADADAF@F@B@DAB@BABABA@C@AAAC@E@G@CBEBCAE@E@C@A@AC@A@@BADADAD@D@D@DAD@BADC@ABA@CBEBA@@B@D@DB@@BDBBBBDAF@FAFBH@F@DBBAFADCFADAD@DAD@D@DBB@FBDBDF@@B@F@DAFAD@D@DBBAF@DAFADCDAD@B@D@F@F@DADADAFCDAF@F@DBFBDBHBF@D@BAF@FAD@FAF@DBF@D@F@J@DAF@JDF@DBD@F@DBDBBBBDB@DBHBD@B@B@BABCBAD@DAJBHBFBJBFBFBFBBBF@B@FAD@DAF@DABA@@BCBCDABAFABCDA@@ACAEAACCAACACACACACC@ACAAC@AACBCBE@CAE@EACAC@CAAAACACAEACACAAA@ABEBEDEBEBGBADCDADABABC@@@C@EAEAEACAAC@CAE@C@ABA@CDABABCCCAACC@CACAEAA@ACAAAA@ABCBCDG@CBEBC@A@C@C@CACACACAAACECAACC@AACBC@AFCF@FBBABAAEAEAAA@CAA@A@AAA@BCBCFCBCBAB@@AACAC@C@C@EBE@C@C@KAE@C@ACCAACACAC@E@A@C@C@CACAAAACACBCBADCDADADCBEBCDA@CBCBC@EBA@CBCBABABCFAB@DABABABEBCDCDCDCDABADAAC@EBC@CACACCACACCEACACAC@C@A@CAC@C@E@CBEBA@CBC@CBE@C@C@C@A@C@AAAAACAAACAC@E@C@EAC@C@CACAC@EACAAACAAA@AE@G@C@CD@DBBABABCAA@AAC@CAABC@CBA@CBABABABCBAAAAAACAACA@ABADAF@F@F@B@BABC@ABABBFBDBB@BBDAF@F@FDDBB@DADCDABCBEBCBABCBCFCBAHADCDAB@@A@AA@CAC@G@E@EAC@C@CAA@AAAC@@@C@C@AACCAAAA@CBC@C@C@CCBABABABA@@ACCCAAACACACAAAACAGAAAC@AA@A@CACACAAC@CAEAC@AAC@@ABCDC@A@AAAAAAAC@CAAC@@CCCEACECADABEBCBCBEBE@CBC@ABA@ABA@CBC@A@C@EACAAAE@E@CAA@EACAC@ECCAEACACAC@CACCCAEECCCCECECCA@GAAAAACECGCCCCAC@CAC@C@E@C@CBA@ABC@AACCCCAACAG@EAE@C@CAA@CCCACACACAAAA@EBGBC@CBE@GBG@C@G@CACCCCCCACAA@C@GBABCDABAD@D@DADABABABC@CBAACBEBC@CBA@A@CCCCCEAACAACECECGAIAEACCCAAAC@EBC@ADCDCBADADAF@DAD@F@HBFBF@D@D@FADABA@A@A@A@CCCEEEAC@ECCAACCCA@EACAE@AAGAEAAAEACAE@CAEACCGEAACCECCCEACAC@CACCA@CAACCCCCCCAEAA@CBAHCFAHCFCFADCBADCDABCDGBCDIBIDEDGDCDEDCDEFCFGFCBADCBEBCBEBC@CBCDCBCBE@C@E@ABCFADCHAFCD@DAFAFADABABC@EBAH@H@F@F@DAHBJBH@FAFABAH@DADA@C@CFADCFCBB@EBCA@CCCACC@A@CBABADCDABCBC@EAEBGAE@C@EAGAEAE@C@AA@C@E@C@AAAAAC@@@ADCDADADEBC@C@CAC@C@EAC@EAAAEAAACAAGACACACAAACA@AAA@A@CDCFGDCBABA@A@@ACBC@E@C@CACAC@CACACACACACAEACAA@A@C@ABCDC@CBC@C@CACCAA@CAAAAA@A@CCAACBE@C@EBABCBADEBC@ABC@CBADADCD@FAF@BCDABA@A@CAC@CAAAC@ACCAABAF@HBF@H@H@D@F@H@H@D@DADAD@F@D@B@BA@A@CACAAACAA@ABAFBF@F@F@FBBAF@B@DBBDFBD@@@BABADAD@@BBFBBD@D@D@F@B@DAD@BD@D@D@DDBDBDBDBBBD@DBB@FBF@DBDBDBDDDDBBDF@DBDBDDBDBD@FADCBABAFADAB@BAB@DABADA@CBADADBDBBBF@DABABCBAB@DBBBFBDDDBBBBDDBDBDBDBDAD@D@DBFBDBDDDBD@D@FAFAFAD@DAD@FCFCDCDCBCBCDADAB@B@B@BA@@@@AACAAAACAC@C@ABCBABCBADEBABA@A@CA@CAC@ECCCAAAA@CBADAF@DBH@DABADAHAF@H@DABA@ABCBABCBA@@@C@AAC@CBCBCDCBEBE@C@G@E@C@C@EBADADAF@FADAF@DC@@BCACAAAACAE@C@A@A@CAACA@EACAEBC@A@C@AAEA@@CAAAEACACAAAAA@ABABC@A@ABABC@AAC@CAACCCCCACACAA@AAAA@AAAC@CACA@@AA@CBABCBA@AACAAAE@CBE@AB@AAA@A@A@AAAAAAAACAC@CBA@EBEBE@GBE@E@EBCBA@CACCAC@CCEG@E@EAE@C@@@AA@C@AAAA@E@A@E@AAE@A@AACA@E@@CCAC@@BCBCDCDCBAB@BA@@CACAEAA@ACAACCAAACBE@@DCBAFABAFABCBABC@C@A@EBA@EACACAC@ECCAACAACCAAA@C@ADAD@D@DAD@DCBABE@CBCBCDCDCFAD@HAJAH@F@DADADAFA@@DB@DBDDBBBD@B@BABABC@C@EBADCD@DCD@D@BAB@@AACACACACAE@AACACBC@EBA@A@C@ACAC@C@C@AAC@@AACC@CAAA@A@ABE@AAABCBCBA@CACACAA@CDCFADCDAFCFAFCBCFCDAFEDADAFCDABABC@AAAACAAAEBCDCDADCAAACCAC@@CBCBCFAB@BC@E@AAECCAA@C@CACCAAEBABC@@@AAC@ABCFCBCBA@C@E@AAAEACCCAAAAABCDAHCBABCAE@CBCDAFCDCACAA@AAAAC@EBCBA@CACAC@CAEBCBC@C@C@CAC@@AACAECECAAAC@A@CAC@CCA@ACAACAAAAAAAA@@@A@CFCFCHCHAH@D@DAD@BA@AAC@A@A@E@C@CAAAC@ABABCDCDADAB@FCDAHAFCHBHBHBHBDAB@DEBC@EAC@E@C@AB@B@D@D@D@BABABCAGACAEAECECCAAECA@CAAA@CAAAACAAACCCCAACACAC@CACAAAGCCAAACAACAC@C@EDGBCAC@CBCBADADEDEBCDE@A@C@CCACC@EAEAC@GAEAGAECCACCCCC@CAA@CDAB@BA@C@AAAAC@A@CBAB@@CAA@C@ABABA@C@@CBC@EBEAA@AA@A@C@AAC@@ACCAA@CAA@CA@A@ADCDCFCFCB@DCBA@EACAECCAAEAEAACCACAECCACCACCACACACAC@C@EBCBCBC@C@EAC@EBC@C@A@ACAC@CCC@AACAA@C@A@EDC@@BE@E@CAA@AEAEDEDABEBE@EAE@EBI@A@E@A@EBEBCBC@@BCA@@AAECCBA@C@CAEAA@CAA@@CAEBAACA@ACAACC@AACCAACCCCACCCAA@EAC@E@ACAA@AA@AACBC@E@@C@C@A@EBC@AAAA@A@A@CA@ABA@E@A@C@ADE@C@C@C@C@ABCBCBA@AACCAAACACAC@A@G@E@CACA@A@CBIBADAF@L@BABADC@@@A@ABCAC@A@C@CBABABCDCB@DAD@B@DBBABABA@A@AACBADABCDABCBCDC@A@CBABA@ABAHEBABA@@@A@@AACAAA@A@CBCHIBCBEBC@C@CAK@AB@BADAF@H@H@FAFAFEDADCDABCDIBABEBCBEDE@ADABA@ABABA@CBADAJCBAFCFAFAF@HAFADAB@BBB@B@@@BA@ABEAEAE@CBGDADCDCDAHCFAFCBAAE@CFEDABCFEHGDAFAF@DBFBDADAB@BAB@B@B@F@D@DDHDFB@@HBF@FAFAHADCDCFCDAHEBCB@BA@AAAAA@A@A@CBEBA@AD@FA@@D@DE@C@@@G@A@A@@BA@@BAB@NEB@DAFAD@D@FABAB@@A@CHS@AB@H@LBFBH@F@FBFANENEDA@A@@GAGEEKCCAAIMEMACIIGEC@AACAAACAAEDEBCDECE@CBEDCDAH@FBDBDBF@F@J@FAF@FADAJCFALCFAFADALAF@HCD@DAFABA@E@ABAACEE@@@A@CBC@CBAACAE@@BA@@@@B@@@D@B@B@@AB@D@DADABABADCBA@@B@B@BB@@BADADCBCBCAACACAAAAC@AAA@@AACC@A@A@AAA@@CAAAAC@ABCB@@AB@@@B@@@BA@E@@@AFA@@BA@A@A@C@AA@AA@AAAAIA@AAGCA@AGAGAAAEAGBE@GACACAE@CBCDE@CDABEACBE@C@EACAC@GF@FCFEDEDCBIFGBCBCBCBCDCBABCDAFABADADADAF@FAF@HAHAF@D@DADABCBABABAF@B@DAFAFAF@F@F@DAHADA@ABC@ABC@CBC@E@C@EBE@EBA@C@E@C@C@CBCFA@@DCBA@CAAAECE@C@C@CBCBCBADEDADAFCFAHADAJAHALAHAFAPCNCDADAJAFAF@JCJAJAFAH@HADADABCB@DADAF@D@F@H@F@D@PDB@DAB@DABABA@ABABADAD@DAD@FABADABADABCBABCFIDEFEDGDCFEDEB@¤ÃPWTSvoBABA@CBC@CBAB@@@@@@BB@@@BB@@@BB@@@B@@@BAB@B@@@BAB@D@BAB@BA@@B@@@B@B@B@B@@@B@B@@@B@B@@A@@@@B@@@@@DA@AB@@@B@@@BA@@@@B@@A@@@@@A@A@@@A@@@AB@@@B@DAB@@@BAB@@@BAB@@AB@@@@A@@@ABA@@@A@@BA@@@@B@F@HBD@N@fDR@BBB@N@H@JBH@L@DBD@B@@@@@@BB@@@@@B@@@B@B@@@B@BA@@B@BAB@@ABA@ABA@AB@@@@AB@B@@AB@@A@@BA@@BABADC@@@@B@@@BAB@BABAB@DADADAB@BA@@@@DA@AB@B@BAB@B@B@DAB@B@@@@@B@BAB@B@B@B@B@@@B@@@BA@@B@B@D@BA@@B@B@B@@@DAB@DABADABADAD@FABABA@@BADA@@B@AA@@@A@@@A@A@@B@@@AA@@@A@@@A@@B@B@DAB@BA@@@@@AB@@@@@BA@AB@@A@@B@B@@AB@@A@@B@@@B@B@@@B@@AB@B@BA@@D@B@@AB@@@@@BBBA@@@@B@@A@@@@@@DABAB@B@B@BA@@BABAB@@AB@DCDCB@@A@@BABA@A@@BAB@@@@AB@@@B@@A@@BAB@BA@AB@B@DC@AB@B@@A@@BA@@@@DAD@@CB@BA@@BA@@@AB@BA@ABA@AB@BAD@HCD@B@DAFAB@DCFCD@BADABCBABABAD@BABABAD@B@BAF@LEDADAFCBAFC@@D@DADAB@@CBABABAB@@ABADAD@BADBD@PDDBB@F@HA@AB@F@BBD@DBBBBBBBB@D@B@B@B@B@D@DAD@DA@@@AB@BAD@BAB@HABA@@BA@ADABABA@@@@B@@ABA@@@@B@@@B@@@BAB@BABAD@@@B@B@@ABA@@BA@@L@DADABCB@BADA@AB@@@DAD@B@B@B@B@B@@BB@B@@@BBB@F@@@B@D@DB@@B@B@BABA@@D@B@DAD@@@BBB@D@B@B@@@B@@A@@B@@@B@BA@@BABA@@BAB@@@B@@@B@@BB@@@@@BBBBD@B@BBB@@@BB@@BBB@BBB@B@@AB@B@B@@@@@B@BB@BB@B@BBD@B@H@F@D@B@@@B@BBB@B@@@BA@@@A@@@@@A@@@@BC@@BA@ABA@ABA@CB@AABE@A@A@A@A@A@ABC@@B@B@B@B@BC@A@@BA@@BA@@@@BABABCDIBB@BBB@BBD@BBBBB@BBBBD@@@BBFBF@H@DBBDDFDFDJFFDH@FBFAHCFCF@F@FADBFDJBJ@H@J@H@JBDADBBABAB@BAB@B@D@B@B@BAB@B@@A@@@@@@@@@@@@B@@@@@@B@@@@@@@B@@@@@@@@@@@BBB@@@@@@@@@B@@B@@@@@@@@@@@B@DBB@@@@@@@@@B@@@B@D@FEDEBAFAFADCFADCBA@@BA@@@A@@@@@@BA@A@@@@@A@@@@BA@@@@@@@@@AB@@@@A@@@@B@@@@@@@@@B@D@DBB@BBDBBBBBDBBBB@FDDBBBB@DBB@@@@@BB@BB@B@@@@@B@@@@@@@@@@@@@B@@@@@@@B@@@@BB@@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@AB@@@@@@@@@B@BAB@@@@A@@@@B@@A@@B@@A@@B@@@@AB@@@B@@A@@B@@@@@@@@AB@@@@@BA@@@@BABAB@@@@@B@@A@@B@@@@AB@@@B@@@@AB@@@BABA@@B@@A@@@@B@BABAD@@A@@BAD@BAB@@AB@DAB@@@@@B@@@DA@@B@@@@AFAFABA@@@@@@B@@@@AB@@@@@@AB@B@@ABABAB@@@DA@@@AB@@@@@BA@@B@@A@@B@BA@@BA@@B@@@@@@A@@B@@@@@B@@@@@B@@@B@@@@@B@@@@@D@@@@@@@B@@@BA@@@@@@@@B@@@B@@@@@@@@@@@B@@@B@@@@@@@BA@@@@@@@BB@@@@@@@@@@@B@@@@@@@@@AB@@@@@@@@@B@@@B@@@@@@@BA@@@@BA@@@@B@@@@@@@@@BBB@B@B@B@BBBBB@BABBB@B@@@@@@@@@@@@BB@@@@@@@@@@@@@@B@@B@@@@@@B@@@@@BBDBB@BB@@B@@@@BB@@@@@B@@B@@@@B@@B@@@@@@@B@@@@@B@@@@@@@B@@@B@B@@@B@B@@@@@B@@@@@@@@@B@@@@@@@B@@B@@@@B@@@@@@@@B@@@@@@B@@@@B@@@@@B@@@@@B@@BB@B@@@B@@B@@@@@@B@@@@BB@@@@@@@B@@@@BB@@@@@@@@@B@@@@@@@@@B@@AB@@@B@@@@@B@@@@@@A@@B@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@@@BA@@@@@@@@B@@@@@B@DAB@@@B@@A@@B@@@@@B@@@@@@BBB@@@@B@@B@@@@BB@@@@@BB@@@@@@B@@B@@@@@BB@@@@B@@BB@@@BB@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@BAB@@@@@@@B@@@@@BAB@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@B@@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@B@@B@@B@@@@@@@BB@@@@@@B@@@@@@@@@B@@@@@B@@@B@B@@@@@@@B@@@@@@@@@B@@@BA@@B@@@B@@@B@@A@@@@@@B@@@B@@@@B@@@@@@B@@@@@@@BBB@B@@B@@@@BBB@BB@@@@@@B@@B@@@@B@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@@A@@@@B@@A@@@@B@@@@@@@@@@@B@@B@@@@@@@@B@@@@B@@@BB@@@@@@B@@@@@@@BB@@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@@@@@B@B@@@@@@@@@B@@@@@@@B@@@B@@@D@@BB@B@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@A@@B@@@B@B@@@B@@@B@@@@@B@@@@@B@B@@@B@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@A@@BA@@@@B@@BB@@@@@@@B@@@B@@@B@@@@@B@@@@@@@@@B@@@@@@@@@@@BA@@@@@@@@BA@AB@@@@A@@B@@A@@@@@@@@@A@@@AB@@@@A@@@@@@@@@@@AB@@@@@@@@@@@B@@A@@B@B@@@BA@@@@@@@@@@B@@@@AB@@@@A@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@BB@@@@@@@@B@@@@@@B@@@@B@@@@@@@@@B@@@@@BAB@@@D@B@@@B@@@@@@@@@@A@@B@@@BA@@@@@@BA@@BC@@B@@@@A@@@@BA@@@@@A@@B@BA@A@@BA@@@A@@@@B@@A@@B@@@@@@AB@@@D@BCDCFAFAFCD@L@HBF@DA@@DCD@F@F@D@FAHCJAL@H@HAJAF@JCHCHC@@DEFE@CF@BAHCDABAFAD@H@HBH@HAF@DCBABC@A@@BC@CDCDADA@A@@B@B@@@B@@BBB@B@@@@B@@@@@B@@B@@B@@@B@BB@@B@@@@@B@BBB@B@@@B@@@D@@@@@B@@@@BB@@@B@@@@@B@@@B@@@@B@@@@B@@@@@AD@@@@@B@@@@@@AB@@@@@B@@@@@@@B@@@@@@@B@@B@@@@@@B@@BBBDBB@@@@@@@@@@@@BB@B@@@B@@@@@@@B@@@B@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@B@@@@@F@@@BBB@@@B@@@B@@@@@@@B@@BB@BBB@@@B@@B@@@@B@@B@@B@@B@@B@@B@@BB@BB@@@@BB@BB@BB@@@@@@BB@B@@B@@B@@@@@B@@@@BB@@@@@B@@@@@B@@B@@@@B@@@@@B@@@DBB@B@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@BAB@DC@ABABCDCDAD@B@B@DDBBDBD@BCDABCDADBBBDBB@DBDABCHCFCBCBEBCDADAD@DADABADE@ABEAACCCCAA@E@E@CBCBAF@F@BABA@C@E@CDADAD@HAFADABCBE@AFCHAHAJAJ@HAHCFAFCD@H@D@D@BEDAFAFAFADADADEFCDAH@HCF@D@FBD@@C@CDGCKHADAB@BADABADABABADADAF@DAD@D@D@D@D@DADABAD@D@B@D@DAB@BADAD@D@DBB@D@B@@@BA@E@CBCBCDCFADADAF@FAF@DBD@D@B@BADC@CBCBABADADBD@D@DCDCFCDABEDCDCBADAFADAD@D@D@FAD@B@BF@HHBFBDDDB@@BBB@FADAFAFCFEFBDBDBD@B@D@BA@ABA@C@CDCDEDCBEBE@CBGBCDAHADCBA@EBC@C@C@CACAA@A@@DA@@FBDBD@DBFBDBF@D@D@FAF@D@D@FBDBB@B@@CBCBCBCBC@ABCAAAAACEAACACBCDBDCDCBCBCBABC@ABADABBDBB@DBD@B@BADCDABABCBEBCBC@CBEBADADABADCBCBC@CBCBCBEBABAD@D@D@FE@AAABC@ADCBCBCBADAD@DBDBB@F@HCF@DBDB@DBD@BB@BAHAL@D@BBBF@HB@BDBBB@D@FADADAD@D@FAFABAD@@@F@DDBBDD@D@D@BAHAD@D@DBDDBDDDBDDDFDBBBD@DAD@BADADCD@D@DBDBFBDBD@F@F@F@F@FADAF@FAFBF@HBD@FBFBFDDDDBBDBDDD@DBBBBB@DBF@DBDBFBDDFBDBD@DBF@DBDABABABEBCBC@CBABAD@D@D@F@D@D@DADADAD@D@DAD@D@DAD@D@F@FBF@F@DAB@DCAA@E@C@CBCDAFADADABCDADCDADCDAFADAB@@AACACACAE@CBCBE@CCCC@EAC@C@C@CCCAACCAC@AAAAACCAECCCCAA@CA@@BADAFCHCFC@@DCDABCBC@C@CAEACCC@AAC@E@A@C@EAEACACCCAAAAAABAFCDAFADABCDCBCBCBCBCDCDAD@HBFDDBDBB@BAB@BA@ABCBADADABCDADADADABADABCB@D@D@DBDBBAD@D@B@DDDDBBBDBBD@DBB@DADAD@D@B@BBBD@D@D@D@DBD@BDBDBDBBB@DBBBBB@D@D@FBF@F@D@FAD@D@FADABABA@CDCDCBCDAB@HBB@BBB@DBB@BBBBD@BDBBBBDD@@@B@D@D@BAD@BAF@DAB@BB@B@DADADAF@F@FADAD@DAFADADCDADAD@F@FAFAF@HAFADAD@D@BBBD@BBBB@DBDDDBFBDBDBDBDBFBDAD@B@D@B@FDFBDBD@DAD@BCDCBCBCDCBADADADAF@FADAB@BCDCB@DAF@DBDBB@DADABCB@BBDBBBB@B@DADAFAD@F@BABABA@CBADCB@FBD@DBD@F@D@B@BBD@D@BAD@BADC@A@@BAB@@@@E@ABGBCHCDADADEHENEFCIGAACABCAEIMCCACFIEGIMGOAACAGCACGIGGI@IFEBE@C@AACCCEAA@EAEFMDCPQFEBC@AAAEIBCBC@AA@IEEAMGGAEAK@CAAACG@CCCAAKCC@C@GBE@AAACBE@GBG@EDGAAEGAEACMGG@IGCGEI@ABACAEAOEIECC@E@CACCAIECAC@C@IBCAIKKQCKCICGMOGEIAQAMCKIKEGICCCEAAACCI@A@KBO@AFGDE@C@G@ABC@A@GNW@AGAK@S@IBCB@@A@EACA@ABA@A@AACEEACSCEA@A@A@C@A@A@ABCBAAE@A@CAEAAAAAA@@GEAC@O@IBKDGBEDCBCBCDB@AAE@IDIFEHIHIBKBK@MBKHGJOHKBGAO@KDIDCB@AIEUCGACCCIEEAECCECECEGEGEECCCCECECCACCGECIAE@EAECEAABKHGBGACBGFGBMCIBEBEBABEDCFGDE@E@ECC@ABCBC@EFEFEDEBA@EGCGEAG@QAK@MDQBEDAAA@A@EBEAG@E@CAEEGECAI@GBA@GDC@IAGAG@KDMFKFGBG@I@I@ABCDGBKFMFOHKJMNCDC@E@EAE@GCEEAE@EAEAGCCCCGEICEAGAIAE@G@G@EBGAGAIAKAGCG@K@E@GBIBG@GAGCCAGCG@E@IBE@GBCBE@G@GAG@GGAEAG@C@I@KAECEACEGCGCGAGCI@I@GFI@E@C@CAGAEECECEEAAAAAE@A@EACAE@C@E@EBEFGFGFEHAHGFGFEHGFGFEFAHE@CBEFEBGAC@G@G@G@IJKNONMNINGNETG\\KZMZINAFCB@D@B@DABADEDEHIHIDGBABAHCHEFCFADAB@DABADABABCBADE@CAC@A@E@AAC@ACA@A@C@A@C@EBC@A@C@CBC@EFGBG@CCEAAAACACAGAE@A@G@A@C@QM]U§LCACA"],encodeOffsets:[[94127,32599]]}},{type:"Feature",id:"542428",properties:{name:"班戈县",cp:[90.009957,31.392411],childNum:1},geometry:{type:"Polygon",coordinates:["@@OUKSEKAUAUUCSDMDOCCUBQFUESCSGMKIWIMCKEEAQGSBM@OJOFSCIDGHIJGHKBUQWDQRQHSJUDQBEBABKPIRGZATALBVENMLaHYJSJENENGP@REVDNJJJPBXGHGFILGZWHQLQ\\OJSOKQME]GUAUAILHPIHS`ER@VBPMDQBUKSMII[CUHQF[FOJMRMRWHECEUUOSEQFIVINSLUNUDMHKCMAUF[F_BW@KGC@MAABAD@B@H@F@F@BHCRF@R@PDXCdGĀnKDALBFBPPHDH@FAFCDERYF@PBLJFFAJ@^L@ZBFBDBV^RBNAL@RCRETC`ILCBLARFDLCJDANIPEDSHALXt@FE@UA]JEDYBMBGDKHeAIAE@CDDHFP@\\FBF@P@JCF@DBDBBB@BBRHLFFJBRBD@FFD@FFBF@PDHDFDDB@B@BBDDAHIP@FDFTNNBDADADABBDB@DPLFDFBTCDDHNBPAFCDGJCHAJAH@HCHCDGFGDG@GAEBABALAFAHCFCD@@AFEFGJEHMFIJKNIBML@@CHABA@C@SGEAE@C@ADADBTBJFDFAJCFAD@B@B@FDHBB@HAD@FABADBDFBBBBD@VDVGTEPGPC\\IPCFCB@DHBBDDD@D@DAFABABAFCDADAFADBBDBBDDBDBBB@D@LBDBFBD@DAB@BABEBADGDABAD@@@XFNBJJLLTVBLHR@RBLBXLPLPHT@JCHGLCDCFILADCBAFCDAFELABEDADAF@BENMTKHUHEH@DBFHPFLDFBHBFBFDBABADABEFCD@B@BDDBB@B@D@B@FAD@BCDCBCDAB@@AFAB@F@DBF@FAB@DADGJ@@BDDJ@B@BA@ABC@C@KC@@A@@@ABABABCBAB@@MEcWSEFXCF@B@BABABA@C@C@E@A@@@A@@BENIJ@D@DLLNP@B@FELAHADGJCFCDGDSFIDE@GDEDABCRA@C@CACCAE@ABE@A@@ABEFABCBGBGBG@SAWAyCIAIEGCCECG@A@SAGEIGGOGIAI@O@OAC@AAAAB@DGP]BE@A@A@ACAAACAAAA@I@C@CAC@ABAD@DAB@DCFCDABC@C@EBCBG@C@AAAA@A@@BIAC@CAAACAAEAC@E@E@C@EB@@A@BCB@JELIHGFGBEAAB@BCDCBCDIBCeaBE@@@CBEDCDGDADEJKBADGBC@ABCDADA^im_ECEAOKAAAAECA@CAC@E@EAGCCBCAC@EA@@KAA@CAACC@]¶IHGFMXJNNFRHiDCNJV@JDHDJBDBF@B@BA@ABCBA@@BDRAD@DCDADCBGFKFOFKFCBAD@B@J@F@B@BCDMJMHGDCDADADBH@D@BERKVADCBEBIDMFIFQJ@@AD@F@@CBGBEBCBAB@B@JABCDADBH@FCLBL@DBBBBF@@ADC@@BAB@BBHFDBBBBBBD@D@D@B@DA@ABEBCBIFCDGFADAD@H@D@DBBDDDD@@DCBCFC@@RBHH\\FPN@VCPGVF^PJJFLL^CPAPDPHHNLLHLDLEZJPNRDTATSHOLML@D@B@@JTDHNjC@QBEBG@EBC@ABC@CBC@CBCBABC@EBEBEBGDEBA@CDC@ABC@CBAD@BBBBBB@BBD@BD@@BBBDB@@BD@B@DBD@DDDBBBBDBBBDBBBDDB@BDBBBB@BBDBDBBBBB@B@D@BABA@ABABCDA@@B@@@B@BBBBB@B@BBB@BBD@BBBBB@D@DBDBDBD@DBD@DBD@FDDBD@DBBBD@DBF@FBDBH@DBBBD@D@BCDCLCFELKRCJA@ABAD@BAD@FBFAB@B@BDHBFCBABA@CDADCBADCBABABA@CBABABCBABCDAB@D@B@B@BA@ABA@A@@BAB@@AB@@CBħCKDKJy^ßC@CBEDED@@GHABCHAFADAD@D@JAF@FAR@D@FAH@FAF@FAF@F@DAH@B@DAFAD@D@DAJ@JAD@F@DAHAF@DCL@B@B@DAF@H@D@FAF@D@JAD@D@H@F@HAFAD@L@F@FALAH@F@LCR@LALAD@D@DALAFAFBFAHAD@J@DCR@DAF@DAFAH@HAL@DBD@D@DDPBD@BBD@DDF@DBD@D@B@BA@FHBBƤVBQ@A@EAIBE@OAC@EBC@CBEDCBAD@XDB@DAB@BCDAJ@F@L@H@H@FBJ@BAB@DBN@R@JAJAF@D@FCD@F@JBB@B@B@BABABAB@DBF@F@BBB@IfUF@D@BBB@DBBBBBB@B@@BB@@B@@BB@D@D@F@D@D@BFFDD@BDDDDBBFDB@@B@@L@H@D@@@BAB@@BBBB@D@B@BADAF@@@BABCB@DCFA@AH@FAB@BABA@@@AAC@A@@B@FAB@@ABADEBGBE@C@AA@C@A@A@@AAA@A@CBEBA@@@A@ACCCCGC@@@AA@@C@A@ABAHI@A@@ACAI@A@A@AB@BAB@HBD@D@B@FCHEBAL@BAB@@@BCB@BABAB@B@B@B@FDD@HDB@B@B@JCD@BABABCB@DAB@DCD@B@BB@@B@DEDEFGBADAB@D@F@B@H@B@B@B@JJBBD@F@F@JED@D@D@DBDBFHD@FBP@JBD@BBB@BB@B@D@D@B@BBDBB@B@F@BBB@BD@BDBBDB@BD@DAD@B@B@@@BB@BBF@BJF@BB@DAZIVG_ĘÈQ¶YDAdv~@ÄD\\@N@P@VAfI\\ERCH@H@TBLDd@@AB@BBBnEh@JÖpÀBBtJ\\HFBHDJDRBJ@TD`@LBD@DAHCTCDCB@F@DCDADA@ABAF@F@DEHAHAJAL@J@HBJ@LCN@BB@FBFBPD\\FDDBBD@F@H@FBD@D@FBD@FBJDDBHFDBJDHBLFFBHAJ@FAHAF@HBNDHAJBH@HAFBJBJDFBH@F@D@BDHDHFH@J@TELEFEDEHAFADAD@HDD@FADCJEDAF@FBL@FAFA@@F@JAJ@H@HBF@FBFDDBJ@DBFDBD@@DBB@D@BBBBDBHWHYniz»DAXKBC@E@CJOBMEIIW@WD]AW@C@@CACCE@G@AAEACKECAC@A@IAAACAKAAWQWKIE@A@CECCCAEAC@CAEAECG@CAA\\aBA@@AE@AB@BA@@D@B@DAFAB@HALAHCB@BBFBD@B@D@F@PDb@AACAG@ABEFEBCBCBI@WAO@A@ABAHAJDB@F@JAD@PCFALBHDFBFBBB@@DBDDDH@@BBB@@@VEH@LDB@F@FBDDHBDBD@D@D@HBD@DADADCDCFEBCDADCOKDIHEFE@G@G@IFGFGDIbWJ@H@DAFAB@DAFALEDA@CBA@ABABABABAAAAACG@A@ABA@AFOPOPEXGTCPMHO@UAKGMKKESCUFMBODMAEOMAMDQLSRQJEHCHEFEB@B@PBA@AJGBABCBAB@JMLODEBEBMBE@GBM@E@E@GBE@CBGBGBKBCBC@GBQ@C@M@E@EAEAEACACCAACECAACCACACACAE@C@A@E@CBGBEBE@CBEBI@E@IBC@IBC@ADAHCFAxuNIDAREBIPO@QGICQCIEANAJERBLCFOHIBIBaAIMSfirstu^aBA@CAG@C@ABKHWDIDIJQR[JIDAFGDEBABE@AAGBI@@@AHODEDE@@D@FMAEAAAACCC@A@ADEBGBABCBeC[IeMSGGCEAAC@A@@A@ABCBKDCDCFAFCHA@KBGBGDC@ICGBIDEDIDARUIQIAA@AA@@A@E@CACAAACAC@@AC@AACDE@GDIHIFGDCBADE@AAE@CBGBEVED@@ABEDEFEFATED@HC@C@ACCECAAAECCCCCAGAaGI@KCIEGCGA@ABAJ@PCLK@C@CECEGGGIEBEBGCGACACCCAAK@CACAEECCCACAC@G@C@EDEBOFIFOHKHEDKHMHKHCBIFUL@B@BNNLVBF@@AB@@C@SMIAC@AAAA@AAE@@AAQDCBEDCBALEX@D@BHH@@BFBBAD@BCLCDADCDCDEFEFABEBCBABCBEDABAD@BADEFAFCDCDA@ABGDODE@CBCDGFCBA@E@@BA@E@C@AIAGGEMGECMICECCAACG@CAA@@ABGDEBCDCF@BKJQFWJGHIDO@EBIBGBEBEBGBCBC@CBWE]@@@CEGCEAA@IBE@AEECCEICACAC@AAEC@@AAAA@ACGCEGEAA@ACs@C@aEGOgGcSK_Smk©¹ƗCEAC@EAEBCDCBAFCFCBCDEDCBCBE@C@ABCDEDCBCBABIFIHKLQFMHOHKHGLIHKIEQASGIEIOAAIGQMOIMKOGYMIIGKKIOCOFKJS@OEKKKKGKMKIESGYDGLKFO@EOAOBOKKQGUAWCQIEQBOBMFOBMCOEOIM[CUAMLORKHQBU@S@WEQEQIWCSCQ@SEKKGMAQKGMMEOAQCMAOBKAQBOESUKOGKOFIFI@MGOOAQGKIGKMMGSCUBOIQKKKMKS"],encodeOffsets:[[92300,30785]]}},{type:"Feature",id:"542429",properties:{name:"巴青县",cp:[94.053438,31.91847],childNum:1},geometry:{type:"Polygon",coordinates:["@@MPSJ[DWFY@SJGN[H[@[C]BSAIGEOMGS@WB[BYBWBUAQBQAcDMLSRMJWJUPKR@B@JFH@DCHEFCFEHABKFEBEBE@G@E@GBC@C@E@GBMDE@EBGBEDCBCBGBC@G@E@EBE@C@EDEBEBCBEFABCFEHCDCDEDEDEDGBCFGHAH@BADADEHAR@DADADEFMFO@KFEJINIJSHEFANBPDRDHBJ@DHDHDHDLBFDDFFHBDBF@D@DAFBFADAHBDFBFBHDH@JFFFFFBF@JADAFABCFADEDAFBFFDDHBHAH@FCF@D@DFFFBDADCDAHCDADAFAF@F@DBDDFFFBHDDDFBLFHBJAFAFAFAHAFADAHEFAFCBEDEDCFCDCHCHCJG@CGEEC@E@C@EBCFAFADCHADCFEDI@C@G@CBABCJEDCDCDEFEDCHAHCHCJADAFCJCHAHAJAL@H@H@F@F@FDH@FBF@FF@FBDDFFFFFF@JDFGBEDCBCHCHCJ@HBFBFBFBHDFDDDDDFFDDHHFBFFDFDDHBFAHAD@H@FBFBFBDFDFDFBDBF@D@D@D@DBBB@B@B@BA@B@HAF@D@D@D@B@B@DD@DADAD@F@DAB@DBFDBDDB@B@D@B@DAHAH@BEBGDCFCBA@IEC@A@A@A@AB@BCJAHELAF@B@F@D@DCB@BBBFDF@D@LAJAD@B@BB@BBBABCBA@A@EFCBAB@BA@ADADABCBC@CACAC@A@A@ABA@ABABADAHCBEBCA@ADABABAAC@CC@@AA@ADABAD@@ADAFADCDC@AB@B@BB@FBFBBBBBD@DB@@DBDBDBD@DAB@D@B@FBB@B@@D@@@DBDBDBBBBFFFFDBDFBDFDDD@@B@HCB@DAB@DAFCD@BBDBBB@@JFNHH@F@DBFDDF@JBFFHDF@D@D@@@BA@AB@B@B@B@B@D@B@B@@BDBB@BBB@BBD@B@B@@@BCFCDEDCDGFCF@FBFBF@FAF@HAFADADAFCF@FBD@FBFDDHDHBFDFBJDDBJ@J@FDDFBD@FBFDFBDFDHDDD@@@@BCDABADABAB@D@B@DBB@DBD@BBDBB@DBB@@@BABC@A@ABCBA@CDA@@BCBCBABC@ABABC@A@CBABABADAF@@A@@@@@@@AB@@@@@B@@@@BB@@@D@DADBFB@FAD@BBBBDBFB@BBD@@@BBD@FAD@DAF@D@BAB@BBBBBBBBDBBB@@B@BB@@BB@DAB@B@B@DFAD@B@BBB@BB@@@B@B@DBDDAFAB@DBB@DBFBDDBBFBDBFBD@BBFBDBDBBB@@BD@B@B@@D@DBBAF@B@B@BB@BBD@DBB@D@BBBB@BBBBBB@DDDBB@B@@@B@BABAB@BABAB@BABB@@BDAD@D@F@F@DBBBBDBBBB@DBBBBBBB@@BDB@B@F@B@BBBBBBBD@DBF@D@@@BBDADBD@BBB@DC@A@ABABAB@BABAB@HDDBDBD@D@DBB@DBDBBBD@BBD@@@D@D@F@D@D@B@B@DBB@D@D@F@DCFAF@H@D@FADABABABCBEBA@ABAB@B@DBBBDBDDFBB@B@BABABAFCDCDA@@B@DAB@BADABADAD@B@DBDABDD@D@BAD@B@FAD@BAD@DADADAFADAD@BABADABABCBC@C@C@ABCBABA@ABC@A@C@A@CAA@AAA@@@@@ABABAFADCBADABAF@DBDADADAB@BA@A@AAABCBA@E@A@CAA@A@ABCBABADADABCBA@A@@ACAC@CCCACBA@A@@BADAB@AAA@AAAA@EBAB@BABBB@BBBDBDDDBBB@BBB@D@DAF@FAF@BABBF@DBD@D@D@D@BAB@BCDADC@@DC@@HCDA@@D@@H@DBBDBFDJDDBFDF@B@D@DBB@D@DBDBDBDBDBD@FBD@DB@@B@BAB@BC@@DCDABA@CBCBA@@DAB@@A@CBE@C@ABA@ABAB@B@DABBF@FBDBFBB@D@B@D@BC@ABC@C@ABADADAFCDCBA@AB@BAB@DBF@F@DBB@DAD@B@DBDBBD@BABAD@BAB@B@DD@FAF@@@FBDDDBBDDB@DBBBB@@DBD@D@F@D@BADBF@B@DCDCBAF@B@FAD@F@F@D@B@D@@@BABA@CDEDCBCBCBCDCFABAD@BADADAB@F@D@DAB@BADABAB@DADAD@DC@@B@BAB@D@BBFBFDBDBBBF@@BDBD@BBDBD@B@BBB@@FAF@HBD@B@DAB@BAD@D@FBDBB@B@@B@D@F@D@BBBBBBBDBBBB@@@@B@@ABA@ABA@@DCB@DCDADCD@DBD@DBB@DBBBD@B@DBDBBBBDBB@BDBBBBDBBBBBBB@BBB@BBFFAHAFAHAD@FAD@D@D@D@D@DAD@F@B@B@B@BBDDFDFBBBD@D@D@FADABCBADAF@DAFABAD@@@D@D@DAF@FAFCF@DAD@BABB@BAF@D@D@F@F@BBBBBJDHDNHJDFDBCDCBAB@B@BDDDDBDCBAB@BABBDBFD@@DBF@F@B@DBD@BA@E@A@ABAB@BA@A@EBAB@BAD@B@D@BBFBDBBBBB@B@D@D@DBD@BBBD@DBDBBDBB@@BD@D@D@BBB@B@BADABADABADAF@B@D@DB@@BDBB@@BBBBDDDBBBD@B@B@B@B@B@BBDDD@BDDBDBBBD@B@DAB@D@DAB@BD@FCBA@AB@D@BAB@B@BABCBA@CBA@C@CBAAA@CAADCB@F@B@DCDADCDABCB@@ABAD@B@BABADEBC@ABAB@D@DBFBBBD@FDB@F@F@DBDBD@BBDB@B@B@D@D@D@B@@BBBB@BAD@B@B@D@@@B@B@B@B@BABCBCD@BBDB@@D@BBB@@BBB@FBDBFBB@B@D@B@BABABABC@A@ABAB@BA@C@ABCB@DD@BBB@@D@B@BADC@BBBBD@BBBB@BBB@B@DBF@F@BABABEAADCD@J@F@FBDBF@J@FBDDFDFDBDBBF@DDBBDDBFDHDFFFDDBBD@HB@@JBFAL@J@F@FB@@@BADAFCFCBBFBDFDFDDD@BBDAF@F@HDDDFFFFDBBFABADCFGHEJGHGDEDEBGFEJGJEJGJGPGNIFAFCJ@FABABCCCCEACBCHAF@FCFCHAH@FBDBBFB@FBFADC@C@CBEDCDAF@HADCDA@CAAAE@EDABC@CFCFCBCAAAC@EDAFCBAFAHALAH@H@D@B@@@@A@@@A@@A@@@A@@@@@@@AA@@@@A@@AA@C@A@@@A@@@AAA@A@@A@@@AA@@@@A@@A@AAA@@@@A@@@A@@@A@@@@@@@A@@A@@AAA@AB@@ABA@@@A@@@@@A@@@@@@@@AA@@@AB@@C@@BC@@B@@@@@@@AEA@EAAAACCAE@AAC@AAAAAAAC@ABI@ECE@E@OAA@AA@CDADCBABKFEBEBE@EAGCIKESCGAECM@CAAA@A@@@A@@@A@AAC@A@C@A@C@A@A@CACAO@KCI@EAA@K@@A@@JCFADALCVGJCFABCBA@CACAKBCBAFEBEBE@CACAACECE@C@EHKFCDCDEDCFGFGDABABCBCBANIFIBCBARIRKHIFIB@B@H@F@DABABBBADA@C@AAAAC@C@CBEAAAIEKCEACCCAAAA@AAE@A@@@@DA@@@AAEAEAC@CCE@A@E@EBEBE@A@AACAC@C@C@EACACGECCECACCAA@C@AAA@@AAAA@A@AA@AC@ICCA@AC@@BA@E@ECA@CA@A@AAAA@ACCCEAMKKGGAGICCEC@I@EACCCCC@C@CFEDCDAHBAACCECAAUGAA@IBIBE@EBC@ECCECECAA@I@AAC@CBK@CCG@ABK@IAIAMAC@IBCBATMHEDCBEA]@ECGAI@A@@BAB@F@F@DAFCDA@ABBD@B@B@DANCBABA@C@A@@CE@CAE@CAAAAAACAAAACAAGE@@AECG@GAGEE@ACAAAAAAA@C@@CAMKA@AAC@A@C@C@C@JCBADCBADAB@DAD@D@@A@ADC@@@AGMHSPMDWB[@UBQEUMIKCSEEIAKFUBQBWEKJMPINE`GJMLIHGBAFE@AACFK@EGM@AHGDCDAFCFCB@@@DDJBDBHDHJFD@B@DH@D@DCDCBC@AAC@CBGBKAO@CBCBCH@B@NOFCAMAAAAC@AACECEsgUMAA@@CBCBCBC@E@KDE@CDCBCBADAB@B@BHDDBDBADAD@BBB@B@DBF@BCDABCFABC@CAC@@DBDBDDDHLBDBD@D@BDBBB@B@BCBCDGBEBABAB@CEAK@E@EFEDCFADCBC@S@C@EBEBC@K@A@@A@CCGEIGIKKGCEAC@A@CBA@EAA@KFA@CCACBCBE@CAAAAC@CA@@ECGBE@C@A@AH@BCFCDA@A@A@AAA@A@ABA@CBC@C@A@AAA@@ACBC@C@@@@BAB@BCBC@CBABA@AB@AEAA@C@C@ABC@AAACA@AAC@GBC@A@A@@C@A@@AAA@@@@E@A@AAA@@AA@E@ACCCACEEGA@AA@@@@C@@@CBCBC@A@A@ABABADC@G@A@@D@D@DCLCFCD@B@B@@D@DBD@DHNPBD@DAB@@WCEDIHEBEB@DAD@DAD@D@@CDC@E@C@CBCBCDCBA@CBCDA@ADADAFCFEBGBE@I@GB@@AAA@@@CACCA@EAI@KBGDGDA@A@CA@@ACCCA@AA@@A@CBCBCBC@G@E@ABAAA@CAEEEC@AC@CBCDABGDC@CBIDADEBCBC@ECEAAGAAAAAB@H@LAFCDEB@DAFBDBB@FBD@BA@@B@B@DA@@@CC@@A@CB@@ABIHGBE@EA@AEA@@EBIDI@A@ABED@B@@IBC@CAGAC@C@E@ED@@@@CDCA@@@A@AAAACGE@A@AACAGAGGG@GACA@AAAACCADCBABAD@DDBBBBB@DAJ@HCFWL_KEMAC@@I@CBC@A@@ACAA@ABCBCBC@A@EAABA@AA@AA@@B@B@BC@ABCBE@C@C@@@@@XhBD@BABCBIDCDEFEDABCACAA@C@C@A@AACACEEECECCAAAC@CAEAACCA@ABADABC@EBG@EAEACBEDEBCBC@CAA@@AA@C@C@ADAF@DBD@DBBBBBBAD@BA@AB@@ACAACCACAEEEECECCBE@MAEAAE@@AC@AAACAE@CACCCAC@ABEFKBAB@DAB@BCFBDC@CBABADBD@H@F@DBBBF@BABC@G@EBCB@DCDAF@BADCBEBAB@F@FDHBDDF@B@FCFCDE@I@O@KBIDG@EDMDKBIDABA@A@C@ABABAD@BA@A@@@AAACAAA@@@C@A@AB@BAD@DA@ABA@EBA@A@AAAAA@AB@BA@C@CAC@A@ABA@@B@B@DBD@B@@@DBDHNDFBB@B@@DDBBDDDD@@FBDF@B@@A@CBEDCBC@AB@BABCBAB@FADADCBE@K@C@ECCA@E@AAAAAC@AAIECCC@IAAECEAAGICGAAACCAAC@CC@A@ABEDEDCBABADABC@A@@DAB@B@BABCBGDEDG@CBABAAKIKIEEGGCCAGACAAA@GBC@CAGAE@EAAACA@@@DABAD@BA@ADAB@B@D@@@F@BBD@D@D@B@BABA@A@E@A@CBE@IDEBAB@@AD@BABCBABGDIBA@AB@BABBB@BBF@B@BABABA@A@GAA@A@CDAHADCBE@C@A@AB@@C@@BCB@BA@A@C@C@A@ABA@CBEDA@A@@@AAA@A@A@@@CBADA@CBCBAAAAA@AAA@AA\\I@EBEBG@AAA@@ABCBA@AAAACM@CAG@A@AA@C@OFA@@BADCBG@EBCBCBA@C@EBABCBE@AAAAACCACCG@C@C@G@EAACCCCICECCCCAE@EDAHATIFCDADADAVGFAJABAHABA@@ACAAAAGEACKJGDEBCBA@AA@EIEEG@AA@@AC@EAAAA@A@CBCBE@C@ADCBCBE@@MAC@@@AECGDCBEBEBG@K@C@UNG@GAM@KDMASCI@OGUBKDOHKN"],encodeOffsets:[[95733,32697]]}},{type:"Feature",id:"542430",properties:{name:"尼玛县",cp:[87.236772,31.784701],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@N}cCEAGAU@AAAA@CAOCCAA@GBCBC@C@EAA@@@CACACC@ABGJEFEFIDCFCDCDABGBECCQECA@C@E@EAA@A@C@ABA@E@A@CAA@@CCAACAGEA@AA@AAAACAA@AKBMDGBIDGBKGQW@CAEBGFM@CAEACBEBEDK@E@AC@AAA@@@AA@@@AAA@AC@A@A@AAAAA@@CACAAAA@A@A@AAAA@@@AAC@AA@@AA@CAAAAAA@AAAAAAA@@AAAACAAA@A@A@A@AAA@@A@AB@BA@@@A@@@CBA@A@@@@@C@@@@B@BABCDAB@@AAAACCCAA@A@C@A@CA@@AAAAAACAAAA@AAA@A@C@CBABC@CBE@ABABEBBBBD@BBB@BBB@BAB@@@B@B@@BBBB@B@@BFBDBB@@A@A@E@A@C@E@C@C@AAA@A@ABA@A@A@A@C@@AC@C@A@C@C@A@ABABA@AB@@A@AAA@A@@A@@AAAA@@C@CAE@AAE@C@E@EAE@C@A@GACAA@AA@AAACC@AACAC@@AAA@CBA@ABA@@@AB@BCBABC@AB@BABABABA@ABC@A@A@A@ABABABABABADABABCDEFCDGFEBCBBHDDBJDH@BFAFAHAHALCB@FAD@@@F@D@DAF@D@D@BAB@D@DAB@D@D@DAF@F@DAF@FAFAFAD@BBB@BB@B@F@D@DBB@@BBBBFD@@BBBB@D@BAB@D@BHF@BADCDEFAD@D@DA@@BADADADADABABCBCDCFADAD@BA@ADIFEFINCHKHMD`L@BA@@@CAIBAB@DABAB@BKBCB@B@DBDBF@FADAB@FAD@F@DBBB@D@^@FBFBDBHCBABCBODCBAB@BBB@@BBB@BBBD@B@B@BCBABA@A@@@A@@B@BB@B@@B@@@B@BADAD@BADADCDA@C@A@A@AACAAAA@C@C@C@C@CAA@C@C@E@CAEAA@C@@@ABA@ABABABEBC@EDABGBCBCBE@ABCBEBIDGBIDGBEBE@CBKBEBWDC@IBG@IBOBGBI@G@KBE@G@C@C@C@GA@D@HBH@FBJBJBJBFBFDJBH@DDJ@F@DBD@B@BBBDBBBBBBBDDDBDDBBBBB@B@BBD@DBD@@BB@BB@B@BB@DBD@BBFDB@BBDBD@DBFBB@@@D@DAB@@AB@B@BB@@BB@BBD@B@B@B@BB@@@BBB@DAD@DAB@B@FAD@D@DB@@BBBFBB@B@BAB@B@BAB@B@B@D@B@DBB@BBDBBBBBB@BBB@B@BBB@BD@DAD@DADAD@BAD@D@B@@BBBDBB@D@B@BBDBB@FBDBFBD@D@B@DAFADAD@B@BBBBBBDDBBBBDBD@N@H@F@@ABAB@B@D@BABABABAB@B@B@DBDDBBD@B@B@B@DABAB@B@B@BBBBBBB@B@BCBADABADAF@D@F@B@B@BABADADAB@B@B@D@B@B@D@D@D@D@FAFAHAF@D@D@D@DAB@D@BAB@BBB@B@B@D@B@B@B@F@B@D@B@B@B@@@BADA@ADADCBA@@B@BAB@B@BA@@B@B@BDB@B@B@B@@@BA@@B@B@BBBB@@BBBBBBBBBBBB@BBBB@@@B@B@D@DAB@BAB@D@BAB@BABAB@BAB@@@B@D@B@BAB@@@B@BABA@@@A@AB@@A@A@C@ABA@ABA@ABA@@@A@GBQX"],["@@NOAECGMGs[IIOEOSEQGU@KBETOPKPGBEbaAO@@EOI[NUDCAQASA_BED@ZF`HJHFJNjHN\\P\\FP@M}Q[KAI@AEAIBIBM@OBUGSAE@CCCBSBABAP@JOHCJAJAHCH@H@HDFB`CNINGHGDC@EBQDYF[MEUAKOKCwQOFQ@aBKHININKHSGQKOIYOKJMTMGUSOMMOUEKOKKKOM@UDUNQFILOJQGQCQ@OBKNKJMHQJSLWFO@ODQAIQQGMHKNGJWEQ@QLGT@RDNRVKLQB]DTZLNDP@HBD@FBH@DBDBB@BBBCBCF@BCBCDABABA@EBE@C@CBCBCBCBCBABEDABEBCDA@EDCD@DDFDDBDFDB@DDD@BBBB@B@B@F@D@@@FBBBDB@M@G@C@EB@@A@EDIJIPADAB@BA@A@E@EAECCACAC@ECC@IDCFGFG@OGOKYF]PQFA@A@CACA@@GCIIGeADADABCDCDCDA@CBGAEBEBEBEBEBA@ABM@UCKCQ@U@JKBSTMAQHIHKDUGAAACCCECEACAOGABBDBFCLCJGHGHIFIJCH@VBX@JBFDFBDBFJHFJBH@F@BAFADAR@ZAV@P@`ATKNWPONKLGLMTWZKXOdDRJVX`JRFRARCRBlAfERCPMNWNWDQ@MCGCKKEMIMCEGOIGGEQHSM@K@QCKCIAQBOEKEKAOBMGKAKBMACCAEAAAA@AAA@EACAEAE@CAEAGCC@AA@@@AAAA@ACAAAAAC@AACACAAAA@CCACCCCCCCAAACACAECA@CAA@CAC@AA@@AAA@AAA@AAEACA@AIAGAI@QAG@I@IBC@EMEOGFEDGFEDGFC@ABCF@BBBBDBD@D@BBDBBBBB@D@D@D@DBDBDBDDDDDDDBDBFDDBFBDBDAD@DBDDDDDDFHDDFHDFFFDFBD@B@FBD@BBFDDBDDDBDDBFDFDDDFF@@IBLCDCFABBFLRNLFFDFREHCF@FN»T@@A@@A@@A@A@A@kaSAëÃFGBCECAA@CBA@ABCBCDEBAFCDCDABCDEBA@@@E@EBEBCBCBCDADEFCDEBCBAD@BBFBD@D@D@DABABCFGDEBE@A@CAAAAAA@ABABABAFCDADCBCBC@CBAAG@E@EAGCG@EBEBADADCDAFIDEBCBCACACCC@EACCAAAC@E@C@EAE@K@EAO@GAE@@C@C@CDCDCHEBADABABANGFCFAHAFCHADCDAFADCDCB@DAF@D@H@F@H@DBD@DBD@FAD@F@DBF@D@F@FAF@FAF@H@KTEJCÂ~DN@JaF]Em@G@K@CAICCCGCCC@E@ABC@KCCA@AACDYDK@G@GAEACCGGIEGGCGAGAGAGCEQ@CB@FCLCLCX@B@NBHBFBH@BDBDBBBD@B@B@DB@NHJFBBJJJJD@DABC@ADABABEBQBSBCDCBCBI@MDYBMDWAKD_EEC@SFIFKTGPUTOB_AIDMhGJO@IOMCIKUMWOICCEAAA@A@ADCDCDIHCDEBCBEBEB@GAC@GDECGAGGKAALE^SRCAC@EACAA@U@OMOG[SCQJQDOFOPEDK@G@EACBCBCDCBA^WLG@A@AE@KBG@UCSBiTKDE@ECCAAAAEAAAECCACEG@WCG@Cëd_VA@@@@@LMBADAFABAB@BEDA@@DAF@D@DABAPeFCH@F@TAPAFEDEDAGCMEIGGECCACGCCAC@GHCFCFADCFAFADADENCFCDKJAD@D@D@FCDKNCFGJCDGJAB@D@HBNDL@F@BABCBE@UBC@AA@AAGACMAcGQCSCU@I@[FKFGJV\\@LILMNANBLHNJRHV@PANGLMPWFGJBNLNRNKPKPEHGL@RAPARAVDLFNHJFFFNDFWRONILAXEVOFUBKPGLGRCNIPM@KHGJADAZJNGPOnARAdFRA\\CPEXGNIHGRBD@F@FABAB@B@DBDBDBDN@@¡ns`KHGFABCJCNAP@TDLJPBR@VGLKPMPQTE\\DVCVDNBXBTAVEPQHGHYHERCLKLM@IA@AWG_CYCS@SJSHSHQBWA[FUFUEOB]JONOROF]BUBKGUFOFUHQBGNBNHZONwFiBe@QBMLMNKTOLUE]@OFWDOHSHKNYFSJQ@KDMJIJYB[AKFOHGJIP@LKPKNCRAVAPGNMNELQPOL@LHVR\\AFOHOFMDiNWFQFWEMAAF@VKLQFMDKFGHEFALDLCLDNDNAJ]FWHKHKHQDSIKIKUKOQMIAK@OHOPQ@KDKHIJCVEVMRUDGF@JFXGVMFWHWDWHKLMLSNcBSAUEMI@@CCA@ABC@A@E@CAECEECAIAEBGB@@ELAB_TEHAF@DAFDLJhJ^PdBDBBHHBDBDBD@DABIRETGP@D@B@BFBB@B@A@@D@BAB@BBD@B@B@B@BAB@BABAD@@@B@B@BBB@@@D@D@BUPLZJJ\\\\HPVFLLCPEPQTMFMLETDNFJLJBN@P@TMPATCJETDRUJKNQ`URgL[RQ\\GZAXCJ@TRTVZHTFNXR\\VJLLRTdR\\`bLHdhJTA\\DL@THLLNHRJRJZL`NZLXBJ@HAJC`EbLVVJRLCTAVXjJXDBXTtLdLpJVPP\\T\\PFPh\\^^PVEhQfCnNhDzVpVplL`DjN^|PnR^RĀF`DlBjPn\\\\b`\\\\LFNH\\XN\\JTFrNXCd@XTrR\\DFB@@FADABADCBEBCBCBEFCDADGHCBABKDGFCBEFCBCDEDCDABINAD@D@BHRLVDRFJHLNJBBBBBF@B@BCNABMPEH@@AB@BBBABDDBDBB@DBB@BFDDDFDDDBHFFBBBB@BB@D@DAD@pTVJ@FDHDPBLCHCFMFWJEDBJRZTVJfJRJJ^LZVHXENCBA@EBGBGBEBI@cCG@E@CBMFIBEBEAC@QEcQoEuJBB@@AB@BABEBABA@IJ@BABBB@F@H@H@B@D@B@D@BBBBD@B@DAB@B@B@BHJ@BBB@B@BCFABAB@B@B@BBBB@D@FBB@D@DBB@BBBB@BBD@B@HAF@D@D@DAH@D@DRB\\JfBjRtRND^PZPnXxJFFbTJP`B@x@RFHHDBBDB@D@FCT@F@DHHLLJFVJPHB@VNTBVDTFcPCLQ\\IVwABAH@HBNPNDJ@HGvEjAreWAk@GBGFWNSXKlEbBhFFNFBDDJDLFFPTHHBF@dBb^b|x`j@RDfBb@DADCHGRCHia\\PQL@@ADCBABEDGFKHEDEBEDCDEBEDABAB@H@DBDAL@DBD@HBLBD@FBD@DAD@D@DAD@JAF@F@BDHBFBFDDBJBD@FBFAJ@F@FAFAD@FCLADCFADGLMVCDCFGFCBEDCBCBGDCBCBCDCFADGTADAFCFCDABEDIHADADCJ@H@FAFFHBD@D@FAB@D@DBNABCJ@D@F@F@F@FCT@F@J@D@FF^FHHFBDDBBD@D@D@F@DBDBDDBDDDDFFFFDBHHDHBDBBBBDDBDBB@F@DBJAF@F@F@DBFAF@FADADADADAB@BBFADCFABCBCDIDC@CBKBIDEDGFADABCFAF@@ADCHEJEHADGFABCDGDCDEDEBEDCBKHEDGFEBEDCBCDCDADC@CBEBEBE@EBCBC@KAC@E@EBC@SHSHI@CBC@C@EBKDCDIFKFEBEBE@SDC@E@E@EDCBCHCDADAFAH@F@J@DBFBHBFBL@D@D@F@DBDBDBDFFDDJHHFJFDBJDDBDDFFDDBDBBFDDBF@FBFBDBFBFHHHBDBDBBD@NBB@DBBDBDABBF@DBBDPBFBFPJDDLHDDBDFDAB@BCDABABCDEF@DAD@D@BBDBB@B@BABEFABAHADABEFAF@D@D@F@DAF@H@FBDDDBDDDBDJNBDBD@D@BADCDADBB@DBBDBFDBBBBBLDFBBDBBBDBJJHHHFLHDBDDFJHJDDDFFFJFDDHJBDDDAD@FBDBDBFDJDDBDBFBDBDBD@DBDHRFJBDDDDJBDDHBF@FBL@HBF@F@D@H@D@DBB@BHH@D@BADAB@BBBBH@BABABGBI@K@GAE@E@C@CBA@CB@BABBBBDFFDBBBJPDFDFDFFJDDBDDDJHDHBD@B@H@DABBD@BBBFFBDBD@BBB@B@B@BAFAHBD@DBB@@BDBBB@@BDBBBBBDD@BBBBD@D@BDJBDBFBB@DFJBDDFBBBBBBBBBBFHDDBBBB@BDDDDDBBBBB@BBDBBBDBB@BDBDFBBDDBBDDBBBD@BBDBH@BB@DD@BBDBB@BBD@D@B@B@FAD@BAD@BAJ@D@B@HBF@BBDB@DDDDB@B@BBD@@@BBDDFDBDBB@@BD@@BB@B@B@B@BBBAB@D@BCFABAB@BAB@BCDAD@BAB@BAB@BELCFAFAFABABABCHABEFCBABCDAB@B@BAB@H@F@BAB@BA@@DAD@D@FBBBF@B@D@@ABAD@D@BBB@F@DBD@BBB@DBDBF@DBB@F@@BDBDBB@B@B@B@BBD@BAD@D@BEJABAFAD@D@B@B@BADABADABABA@ADABABABADC@EFA@EDCB@BABCD@@@BFBFDDBDBHDBBHDDBBBJBFBDBD@F@HBB@H@FBL@DBB@D@BBB@BBB@BBB@@BBBB@@BBBBBABBB@BAB@B@B@BCDCJ@BABAB@B@F@B@BDDD@DBFBB@H@DABBB@B@HBJBF@B@DAB@JCHAF@H@FB@@BBB@@BBB@@A@ADA@CBCDCBEBABCBABCBADADAF@BAD@BAD@B@BADEL@DCFABABCHABABABCHAB@B@F@DAD@B@DAB@B@DAB@BAB@FAD@@AB@B@B@BAD@B@FBF@HDF@@BB@B@B@D@BAB@B@BAH@B@@@B@BBD@@BBBB@DBD@B@@@D@@@DBF@BBB@BBB@DLLFDBDDDDFHDDBBBB@FBF@BBBADBHAB@DBB@F@FBBBBB@@ADBDAB@@@BABA@ABE@G@A@C@C@ABIBCBA@EFA@AB@@@@AB@B@@BBB@@@D@BBDBJBDBDBBB@BBBABADAB@B@B@B@DADG¾]XMTMBWPEXFPRZBÆAXGLKVMrC\\CKxQALFLDAXKFKAQOGUKA}SWI_BSHSJIXCBHVHHMBKDYHKBMEKCM]AYAYMOKEKBORkTuAyIGFKVIZDTZAZGZ@`JdDpWv[Tql«ZG`@HGBoPS`]DOAWMQ}HgBq[}eIYD{PHeMYOaBYAQF}IK@QDIXArIfCdILKA÷ngnMdKVUZO~M\\QDoIYUK{MsOYMYUEM@mJKJEDRIPUH{NS@KQKqYEM@KFKDYMQUgQSAwQSqEMDIRGFUEWGeK]GQDgBQdUtNeCOUYQe@mFMzsLO@MMMCIDIFGFKOKQAGGBIHGHGJK@MKEEI@HYbnCFKNODcL[HKBOSQIIDkQWMSCeQUSOAOFS|mRKB]NMZIZETMHMFEJKB]bB[@©GK@ÓKMCoCMEIAIVG`@XI@QI]CMUKGMCqe]WYEMBKLCTKJMCQYWSMaKk[SSIiCWFMVQRMC¯IOCJIlaVUBsKIBkTiv@MUBGEAIBEDEFKCWGIFHIPCd@\\CFEJKA[@sFUVcNWAGG@EBEDGxcVSFMBGBIHGFIBkBCDCLKzÓLICKQGq@GGBerÁFmJKJI@IIMKiCAyIOIÛOkQkAeHQ­hxZs@_Q[EKDMXKtQeCLTzLŚDt_fWFWjBBDG@ABE@E@CAAACEGCC@A@A@ABC@C@CB@BCBC@A@A@A@CAEXoBA@@@A@@@C@C@A@ABA@ABABCBCDEBABABCDCFGFG@A@C@E@E@E@C@A@A@C@GBC@@@C@A@ABABABADADCDCDADADADAFAJKBMBOJSNCNILKJM@KBKNGHGHOCKGUDYIIMKBQCM@OTOFQBEFINIPMNKRKRIHMLCPEDSTI^KTC`JASBI@ABEBEBEBE@EAE@A@@B@BADCFCFES[OUAA@E@CNOTYCCAGDGAICGKAEGCEDIBAAC@MAI@KGMACDIDIBGAK@@AIGIGGEMA@SBGDOBE@]BC@iiki@@VFBBDBD@H@DAD@FBJDJ@DBJBD@BB@@@A@AEMEKAABA@@DDHBB@D@NK@AAQ@C@@BAJAPALCVEPGL@RAFAFCDAL@D@@ACYBE@@DUCMLEL@F@BAFM@EFGNGBC@KCEIGOASCOBcMI[MWUOCA[JI@gICAEGAIOMCKBGFKDIAAEAM@[ACCcWKFe@WGAC@BEFIEGWGsGUCsGGBY@IGM@GAAEA]COBMAa@M@GDEPS"]],encodeOffsets:[[[88184,31742]],[[89954,31445]]]}},{type:"Feature",id:"542431",properties:{name:"双湖县",cp:[88.837641,33.188514],childNum:1},geometry:{type:"Polygon",coordinates:["@@KDCA@EICOAIAGBIAYGM@IASAA@@AAA@AQACHGDE@A@A@A@AAG@A@CAGCCCCECCKGAKHEBC@OI·kKEŽÇAAS£@A@AAAiEXeXs`řCyKSKDfsRWLCNFLR\\@`Ytwg®GRBfRlPlJÜJPBzDLjJN@JIJILEnqÂAfHHr@RHDLKJÔyKLCDADAlEJGHAJAHENUTwdCHAF@FHHBMXUdEV@tB\\ILEF[Dc@ODGJEHJDXELCFAFBJHFVA@NuSjAlLJAtUVkbIJDJPD°QNURENDXJjTTl\\bLTNZXDRINSLKDALFNXZf^DrHNVLDNJ^@RWJ_@UHBJFJDNDpLN@ÔHL@ªA\\aA^ILEFGNSNYFYJMNA^QL{nETBPTPRVDfNTRXClJJTRAPGLK\\CdMPELDmaGZ@FJLF@NILGHGHAJHHRBPLELEHCJDJNN@NKPytEN@nRfVZDPMfscVARChHRL^HfFXEVQHCJFNrRTBxRTVhNRCZEL@LFNrZRL@LMTG|OVQJCIFIL@nFNZVZNtP|NVLJZCp[R}NYPUVcLmNmhBøKLcJeDqJWBCJ@RJLE~BRAZPbNZGfOC|JZf\\~ArGh~NRBXCP_^OTApGH_@YHk¬Sru\\oXcC_IY@YHYBSYCUJELJHBzSvQlAPFLPLZNZB^BDNFLANGLCZALGNUGGAWDIJGTATJ`TXB~VLPHBRELWLBKCKEBwRL[DqDUNKLWHÅBYAOQWEOFAXSNWN½^HAD@B@DAJ@F@FA@ADABABCB@B@BFDBFBBB@BBDBBB@BBBB@BBB@BBBDBBDHBDD@DBB@FBDBBH@FBJLXAFABC@K@E@C@E@ABCBGDE@CBADCDAFAF@B@BBD@BBB@B@B@BABCD@BAB@D@@@F@B@BABCDA@A@ABEDCDEFABAB@BCFADABABEDA@EBCDAB@BAB@B@BAFCBAFADAFABABA@CBCBC@IBG@C@G@AAC@C@E@C@EBA@ABABAB@B@D@D@B@J@D@@AB@D@@AB@BA@ABABABA@ABABABA@AB@@A@A@ABC@A@ABCB@D@F@BA@ADCBABCDA@A@CACAC@AAC@KDA@C@ABC@ABE@@@CBA@EDABEB@BC@@@CBA@AB@BAB@BCFCBEBABABABABA@EDA@ABA@ABEBABEBABGDAB@B@D@F@BCD@D@@BBDDFDBB@@FF@BAB@@CB@@CDABCBA@CBA@E@E@C@CBA@CBAB@BAB@@@BADABA@ABEDCBABA@ABEF@B@@@D@B@B@B@BAB@B@B@BBBBD@D@D@BADBBD@D@D@B@DDBB@B@@AB@@@B@BBB@BB@@@@@BA@@@@@@@A@@@BB@@@@B@B@BDBFDBB@@@B@@@B@@BF@BBB@@@DBB@BB@BB@@@B@@@B@BAB@@ABA@AAA@A@@B@@A@@B@@BBB@DBDBB@@@@B@BBB@B@BADAB@B@@ABBB@@BBBBBB@@BBBB@@B@BB@B@B@B@@@B@B@B@BBBFDBBBB@@B@@@B@B@B@@@@@B@B@@@BB@BB@B@@ABBB@B@B@@@@B@@@BB@@B@@BBF@B@BB@@@B@@@B@@A@A@@AAB@@@@DB@BB@@@BB@@AB@@A@@B@@BB@@@@B@@@BDBBA@@B@BB@@D@D@BDF@BAD@@BHAB@BBF@D@B@@AB@FBB@B@BB@@FBDBDBBBBDF@BBBBBDBDB@@@D@D@BBB@B@DBBBDHDB@@@DAB@FBDDB@B@B@@@@@DBDBD@FABBB@@BBB@@A@@@@B@@@@A@@B@@@@@@@B@@@B@@@@@@@BB@@@@B@@@@@@@B@@@@@B@@@B@@B@@B@@@B@@BB@@@@@@@B@@B@@@@B@@BB@@@@@B@@B@@B@@@@@@B@@B@@@@BB@@@@@@B@@B@@@@B@B@@@B@B@@BB@@@B@@@@@B@B@D@D@B@@BB@@@B@B@B@@@B@@@B@@B@@@@@@@B@@BB@@@@@B@@@@@B@@@@@@AB@@@@@B@@@B@@@@@BA@B@@@@B@@@@@@@B@@@B@B@@@@@B@@@@@B@B@@@@@B@BAB@@AB@@@B@@@@A@@B@@@@A@@@@B@@A@@@@@@@@@@@A@@@@A@@@BA@@@@@A@@@@@@@A@@@@@A@@@@@A@@@@@AB@@@@@BA@@@@@@B@B@@@B@@@B@@@B@@@@@@@@A@@BA@@@@@ABA@@B@@AB@@@@@B@@@@@B@@@B@B@@@@@@@B@B@B@@@@@@@@@@@B@@@@AB@@A@@@@@@B@@@B@@@@@@@@@BB@@BBB@@@BBBB@BB@@@@@BB@@B@@@@@@@BB@@B@@@BA@@B@@AB@BAB@B@@AB@@@B@@AB@@@@@B@@@B@@@@@@@B@@B@@@@@B@@@@AB@@@B@@@B@@@@@@@B@@@@@@B@@@@@BB@@@B@@@@@@@@B@@@@AB@@@B@@@@AB@@@@@B@@@B@@@@@B@@@@@B@@@B@@@@@B@@@@@B@@A@@B@@@@@B@@@@@B@@@B@@@B@B@@@@@@@B@@AB@@@B@@@@@B@@BB@@B@@B@@@@@@@B@@@@ABAB@@@@@@@@@B@@B@@B@BB@@@@@@D@BA@@B@@B@@B@@B@BB@@@@B@@@@BB@@@B@@@B@@@B@@@BA@@@@@@B@@@@@BB@@@@@@B@@BB@@@B@@@B@B@D@D@B@B@@@BBB@B@@@B@BB@@@@B@D@B@B@B@@@B@@@@@B@@B@@@@@B@@@@B@@B@@@@B@@@DB@@B@@@B@B@@@B@F@B@F@B@B@@@B@@@BB@@B@@@B@B@B@D@B@@@B@@@@@@@B@@@@@@B@@@B@@@B@@@@@B@BB@@B@@BB@@@@BBB@@@@@B@@@B@@@@@B@@@@@@BB@@@@@B@B@@@B@@@@@B@@BB@@@B@@@BB@@B@@@BB@@@@BB@@@@@BB@@B@@@@BB@@@@@@@@BB@@@@B@@@@@B@@@@BB@@@B@@@B@@@B@B@@@B@@@@BB@@@B@@@@B@@B@@B@@@B@B@B@B@B@@@D@B@@@@@B@@@@@@@@@@@@@@B@@@@@@@BAB@@@@AB@@@B@@@@@B@@@@@B@@@B@@@@@B@@@B@@@@BB@B@@@B@B@B@@@B@@@B@@@BA@@B@@@B@@@BAB@@@B@F@JAJALBN@TARBRDF@LJJHDHFHHLHLHJHFHDLBRAP@NDJ@JFTHNHNDNCJGNGTGPCJAJBLEPFHNHPHJJDTBPBPCPGVAX@X@NBXFVBbHLBF@@@@B@@@@@B@@A@@B@@B@@BB@BBBB@@@@@B@B@B@@AB@B@@@@@B@@@@@B@@@B@@B@@@@@B@@@@@B@B@@@BB@@@@B@@@@@B@B@@@@@B@B@@@D@B@@@B@@@@@B@@@@@@ABAB@@A@@B@B@@@B@@@B@B@@@@@B@@@B@@@@@B@B@@@B@B@BBB@@@@@BBB@@B@@B@@@B@@@@B@@B@@@@@BA@@DA@@B@@@DAB@@@B@@@D@@@B@@@@@@BB@@@@B@@B@@B@@BBB@@BB@BB@@@@@B@@@@@@A@@BA@AB@@@@@@@@BB@BBB@@@@BB@@@B@@@@@B@D@@A@@@B@@B@@@@@@@B@@B@@B@@@@@@B@@@@@@@B@BAB@BAB@@@B@@@@@BBB@@@B@@@B@@@@@B@DA@@HBJHDJ@JFPNJHFZDXDL@B@DAB@@@@@@@BA@@B@@A@@B@@@@@@@@@BB@BB@@B@@B@@@B@@@B@@@@@B@B@B@@@B@@@B@@@BAB@B@BA@@D@B@B@@@D@B@B@B@@@@AB@D@@@B@@@B@@@B@B@@@@@B@@@@@B@@AB@BA@@@@B@@@@@B@@@@@@@B@DABA@@B@@@D@D@BBF@F@BBB@B@@@@@@B@BB@@@@@B@@@B@DB@@B@@@B@@@@@B@B@@@B@@@@@BB@@@@@@@BB@@B@@B@BBB@BBB@@@B@@@B@@@D@B@B@@@@@B@DB@@B@@@@@@AB@@@@@@@BA@@A@@A@@AA@@@A@@@@@@@AB@@@B@BA@@B@@@@@@A@@@@@A@@@@@AA@@A@@@A@@@A@@@@BA@@@@BAD@BA@@B@@@@@BA@@BA@@@A@@B@@AA@@A@@@A@@@@@AB@@@@@@A@@@@@A@A@@@@@@B@@@B@@@BA@@@@B@@A@@@@B@@@B@B@DAB@@@@@B@@A@@@@@@@A@AB@@@BA@A@@@A@@@@@@@A@@@@@@@C@@@@@@@AB@@@@@@@B@@@@@B@@@B@@@B@D@F@B@@@B@@@@BB@@@BB@@B@@@B@@@@@@@B@@@@@B@@@@@@BB@@@@@B@@BB@@@B@B@@@B@@@B@@@B@@@@BD@@@@@B@@@@@B@@@@@B@@@B@@@B@DADAB@@@@@B@@@B@@@B@@@B@B@B@@@B@@@@@@@BB@BBBBD@BDD@B@@@@@B@B@@@BB@FFHJJDNFP@PBJHRJNLJHRAJCPGVE\\ARCJBJFPBLHLFLFLCPALIRGR@XCRBJARHDJBFCJAFBFFLHDNHJDHLHHRLVFXBVBNBRC`BTBVFJ@RBLFPDPHJJJHNJNJLFFDDD@@@BAB@@CD@BAB@BB@BBFBFBF@DBBBBBBDDFBB@BBBAD@BAFBBB@D@F@F@BADABAFAFAFCB@DAD@B@BBB@BBBBB@DBF@F@D@DBFDDBHDDBDBBBB@FBB@D@BABABCBCBC@A@ABADAF@JAH@F@D@B@F@BAB@DCBA@@AKE_FOFOHSHO@ALAFAPBD@H@B@B@D@D@HARANBVETG`EP@R@LFTHTDLDLDLFLDT@VFTBPBNBNDZFXBRHPHNDPBJFLANCTCHCFABBD@B@@ABCDA@@B@BBD@F@F@H@LBFBD@D@JBDDBBDFBB@DAB@D@@@BB@B@DADCLE@AD@DBB@FDBFBB@BB@DABA@A@CBA@AJAF@H@DBD@DDDBJDHBHBD@D@F@FAFCB@D@H@D@F@PBFBJBFBR@F@DBHDHDBBBDDDDBB@DCJAFAD@FBJHJHDLFLJRFDJJNDJGRATCP@TDXBP@TA\\BZBL@N@XCZERCRARFTFNDLJPLRNNBNETET@P@HLLTRJPDXBRALANBTGVC^IRALF@@F@B@BB@@@@B@BA@@BADABAB@B@L@F@D@D@D@DAD@B@B@@BB@@BBD@B@BCDADAB@B@DABABCBC@A@@@@@@B@B@@@@@@@BBBDBFBB@DABANFJDT@PGPENENBRFPDJBPBR@PBN@LIDE@K@EJILGLKHILAPBPDRBTDTDPHRDPFPEPGRKLMNEJIFKDM@M@UDSDIPEXGRENGPEPBPCPGNKPENILQ@@@@A@@A@@@@@A@@@@B@@A@@@@@@@@@A@@@A@@@@BA@@@A@@B@@A@@@@@@@@@A@@BA@@B@@@@@BA@@@@B@@@BA@@B@@@@@BAB@BABA@@@@BA@@@@@@@AA@@AA@@AA@@AA@@A@AA@@@@A@A@@@A@@@A@@@@@AB@@@B@@A@@B@@@@@@AB@@@@AB@@@BA@@B@B@@AB@@@B@@@B@@@B@@@B@B@@AB@B@B@B@@AB@B@BA@@B@@@B@@@B@@AB@@@@@BA@@@@@@AA@@@@@@@@@@@A@@BA@@@A@@@@@@@AB@@@BA@@@A@@@A@@@@@@BA@@@@BAB@@@BAB@@@BAB@@@@@BAB@@AB@BA@@BABAB@@@B@@@B@@@B@B@@@D@B@@AB@B@B@@@B@B@D@B@D@@@BB@@BBDBBB@@BB@B@@BB@@@B@@@B@@BB@@@B@@B@@BB@@@BB@@@B@@@B@@@@@B@@@@BB@@@@@BB@@BCB@DADAB@B@DBDAB@BB@@B@@FB@BB@FJBDABBBBD@BB@B@HBD@@BNFFBBBB@D@F@B@DDLHHHP@P@VILAVFLFNHTHnFLIJQHKHQHMPKPIVATGRATBPBD@D@DAFCFAFADA@@B@@@DBBBB@B@@ADAB@LEDADCBCB@BADA@ABCBE@@BAB@@ABBB@DBB@B@D@BALIB@BAB@F@FAFAD@D@NAFADBDAB@DC@@BAA@@AAA@A@A@ABADA@ADEDG@ABABABAB@FCF@D@BABABABAB@B@BA@@BA@A@@@A@@@A@A@@@@B@@BB@BBDBDB@@FBB@BBB@BBB@@@B@B@@@D@D@NBBBB@DBDDDBBB@@F@NAH@HA@@FAB@DCBABAB@@BB@BBBBDDB@B@DALLJNNLFHHJNLDDJAPOLKJKJEPMHEB@B@FACECAAA@@@A@@BADABCDEDEDCLODCBAA@@@A@BE@AAG@@@A@A@E@C@E@ABCBA@AHGBC@A@A@A@C@@@A@@DCBCBCBC@C@AAACECAAAGCA@AACAACA@@A@A@I@A@CA@AC@AAAAAGGKGAAEAA@A@A@CBEFABEBIBABEDCDA@I@A@CAA@K@ABGDE@GDC@E@C@CBIBA@CBABA@CFA@IFA@C@ABABABCBABCBGBABABAB@BAD@B@DAB@@AB@@C@C@C@A@CAE@A@E@M@IAMBC@C@A@AACAIGC@@AA@C@CBCBIBE@A@I@CAA@AACACCACAACACAAA@@@AB@BC@AB@B@VIFCFABAFADCB@JAD@HCNEPIHEB@BC@ABABADAFCBADAB@TGHADADCDCB@DAF@BA@@FEBADCDADA@@FAHAJEDABCB@BCDC@@@C@I@A@ABA@E@ABABE@AAA@ABABA@@BA@AB@DAHALAD@FAFEB@FABA@A@@BA@ABCBG@AA@@AB@@A@@E@CACACA@@AAAA@A@A@A@@BADAHCBAD@B@B@@@HBD@FBD@DAB@D@B@BADE@@BAB@DBB@B@BADADCFCDCBA@A@@BABABA@A@ADIDEDCBCBEDE@C@G@CAG@EBCDGBI@E@A@CACACOE@AA@BAL@D@@@@E@ABAB@BAH@H@PAD@FCDCDABAFAD@JCBA@CBA@CBABEDE@ABA@AAEDI@AAACCAACCGCCCAAAAAC@AAEAACCA@E@CAA@@A@A@ADABAB@@A@A@AEAAAAACE@A@ABCDABC@A@AA@CACAAC@ABC@ABABAF@BADABADAB@D@B@B@BA@A@CACBC@C@A@A@AAAAA@AAG@E@CACAAIIAAEE@CAAACAAC@A@ABEBC@@@C@A@CACACAC@E@C@I@A@IBC@C@A@GCA@EACACAAACAA@C@AAEAAAAAA@@A@ABABADADADADAB@HGB@DAB@LCDALADCDABABCDEBIBCBC@@@ACCCAA@EAG@E@KBA@@AAAAABEBIBABABCBABC@E@AJM@CBG@K@CBG@AAACICGAACCOIEAA@@A@CCGCK@CAAACAEBIBA@CFGHE@AACAC@C@G@CACAA@AA@AA@@@@BCLOBC@A@AAEGAODGBIBC@C@A@CCAC@ABE@@CA@CAC@E@A@CBABADE@A@CBCBEDCBABCACAC@CDGFGDEDCBC@C@IAG@CIOCEEGECEGCEAE@ACEEEECEECCGIGI@CBKBGDE@@BA@G@E@CAA@@ECC@G@CAGCEAIAICEAK@E@GCE@CAECE@G@A@EAC@CCACCACACACACCAACACACAGCGCC@EAA@CCAEAA@CBCBCBE@EBE@G@K@ABEDCBABCBEAC@ECEAC@C@C@CEGGEA@CACACA@AC@GAA@CAA@GAECICGEAACAGCGEEACAE@A@CBCBCBA@EBA@C@GAC@AA@A@G@CAAAACCECGAGACCCAEBMDC@E@KCCAAASIEACAEAA@E@GAA@EAEAGAC@C@GDC@A@C@MCEAA@CBC@I@MAGBC@O@Q@C@C@CB@BC@]BIBQDCBC@EAECA@@AGKGMKOEGEICGGOpąACAAAC@EAGAAAEAAAE@A@CCCACAAIAECIGAE@AACEGAECCAG@IBE@EAC@GBEFCJGDEHCHAL@FADANEBC@ABCBCDM@E@C@E@G@E@CCACCCE@ACEBECI@CBEBA@CACBCBADAHIACAAACBCBEDEDIDGFEHKDEDIDE@G@ECCCCGEGEGGACCAGECCGGCECAC@AAAAACCACCSGCAAECEEECEA@OMOGA@@AGEIIGK]@OBUBSCYEGAE@CYmS_CICCUEECCGEAA@ACACE@@AGAGACAABK@C@C@E@A@ATCRAJ@FCBA@AGGGCCCCC@@@ANGRCAICEAACEEIAKBEBEB@FEDCJ@FAD@FAH@jBD@DADCBCBCBABC@ADAB@B@D@DABAB@B@@AB@FAB@BABAFAFABAB@JCDABAFCB@HG@EDCDCF@FAB@@ADAB@DAF@FA@@@ABA@C@CB@B@DA@A@ABAB@BADCBCB@@A@A@AAAAE@C@C@E@CBE@C@A@CBABE@ABABAFCBCFCDAB@DABALCB@PEDAJBDCBCD@JADABABADEBE@@@@DE@ADABC@ADA@@HE@GFE@CACCUCI@A@CAE@AB@FADADE@E@G@AHE@EFC@E@@@C@ABADCBG@A@CBADCDA@@BA@A@CBE@CBCAAACBCBEAA@AAC@@BCBCBC@AB@CCAAAA@C@C@K@EAEAC@AAABA@GBAAGBC@C@E@A@ACEAA@ABC@AAAA@AA@@AA@CBCBC@C@C@ABCB@BADC@A@ADABABC@@@C@CBCBCDCDAHGHALIFCNIDCDCFEFC@CFEBCBABC@ADGBABCBADABEBQBA@ADADEDAB@DABABADADAJID@DCBABC@A@C@G@AAGBE@A@CAAACCECCCAAA@A@CBKBA@CDCHCD@DABADCDADADAD@DADAFCFCBAD@BAFBDAF@FADCBADADADADABADCFEDCDABAFADAF@DCDABCDCDCDE@ABI@EAI@G@A@CDGFIBEBCBADG@A@A@CACACAGAC@EBABADEFEDIDGBCBE@A@A@ADCBADCAE@A@ABAFEFCBABCBABA@CDCBEBABADM@C@CDADADADAHGBCBABABCBABABAB@DABABABADCD@BAB@F@F@D@NBTAJDFBHDAF@BBFBL@FBDBBBBDBBD@BBDBBBDBBFBBD@BDBBBFDB@@BDDBFDH@B@DBDBDBDBBBFBDBBDDBBBFDFBDBB@BBD@D@D@FBD@BBDDBBB@B@B@L@D@B@F@F@B@@BDB@DDFB@BBB@B@B@D@D@BA@ABCBCBCFAFADEL@DABBB@@BABABA@ABABAFIDCBCDCBCBABABCDCBCBE@ABABCF@BAFAB@FABADAF@B@DAHCJABADALAFCFABABAB@BABADA@@DABABAFABAD@D@JAD@DADEBA@A@C@EDG@ABCDCB@FCB@BABCFCBAB@FAHAB@F@DA@@DCHGBABA@C@C@C@@@C@A@ADABC@ADKBABABCDKBG@C@C@C@C@ABE@A@CAG@EAC@CAAAACCACAAAC@CBC@CBA@A@A@C@ABE@C@C@CBCBC@AAAA@A@@ABABA@ABA@@@ABAAC@CBGBC@AFAPETGBADAJ@H@@@@A@ADAB@B@F@@@BADABADGFGBAD@@@B@B@B@HBB@B@DC@@B@JBDAD@@@BA@@BC@ABCDCB@FCBA@G@ABAB@BAHADA@@@AACAE@ACEAIACECA@A@AAAACEA@@G@A@@KCCA@AA@@ADCBAHMBCDCBABAFCBADGBC@A@C@AEE@A@A@@FE@C@ACCAAAAE@A@@A@@@ABA@A@A@CA@AC@G@IAGBAA@@A@AIKACAEAE@@@AAAAACAA@@@AC@CACEAAAECC@A@IDC@@@@C@C@@BCBADCBCDKDI@E@QBGBAHSFEHEPE^GTCPEB@FBHDFBHBHBFDRJFBDBDBHDJBB@BBD@BAB@DCBABC@ACG@IBO@ABABCBAHCD@DAL@LCJAB@DADA@ABAEG@E@@@@BGFGBI@E@AEGGEECECECEAECIKEGCG@EACBEBAJE^Un[lUB@DADCFE@@@ABC@@BAH@BABAFEBA@C@E@AHG@C@A@AAACAA@A@A@@BA@A@@@@A@A@@@A@@@A@@@@A@@BA@A@@@@F@B@@ABA@@@@AAC@A@AAAAA@C@ADCFED@BAD@DBFDBBBBD@BBBD@BDBBB@@BA@@@AEEAC@@AA@ABCDCDERGNAD@BBDBH@JBJ@FBHHJHJTFFFJ@DAB@@@BH@F@F@F@H@HAD@QĄWSP]J]FOHM@AA@AAAAAC@ABE@C@ABAB@DAH@JCHAHETIBA@APQFEBCDCFE@CLOBABA@@DIBGAQEGIEEICIAACE@C@I@E@CGEGCII@A@CAE@CBCBIAGACDEFENAHGHAHAD@F@L@D@H@H@DAJEPKDC@@@AECEAE@GAECEI@ABGBABAACBABABCDEDCHCDEFADE@IBA@AFEBCBA@E@CBA@C@EAAGMAACG@ADK@CBI@AHIBGBQHGLEBE@A@EEGDMDWMo_IW[o­më_­@A@AB@J@DADCBADAFCBA@A@A@AAAEE@AAA@C@AAAACCAAA@@JAFALEH@P@J@N@JAJ@P@JAhLPDTDFBHBFBFBFBF@HBFBHBH@RBH@B@FCLIFCHCHAD@D@FADCPGLCHCFCDCDA@CDCHCBCBGEAGECCE@EAAEGAAAGACBCBEBC@CCCAI@KAEAKIAEMK@A@CFIBCBEBEAECCA@BCBABAFCD@JAF@B@B@B@BADCFIBCACEGGCEECABCTSVWV[daZ[hi¥¶¹@@GECAA@AA@@A@@AAICIBADCBC@K@CBABAF@F@D@B@@CBM@E@AD@B@@@BC@CBM@EBEbXqr³PmESSõO·GECAICEAC@EAC@C@EAG@E@C@AACC[EOCEAEAA@@ADM@KAI@G@IBKBIBGFG@C@EBEBAB@BCDC@C@EDADCDSBG@CAC@KC_@SAICQCIAGGEI[As¿AÕo@IFgmAAAA@@Bc@KCSAG@G@QD[FeJUBO@M@[@ÃC}@cuCBµZÇRė`UHYJCBA@@AIE@AAE@AAA@@A@A@C@CBC@@ACAAAACC@@AAA@A@E@AAAAC@A@A@C@C@AAAA@AAC@IAO@EAC@EGCACAC@C@C@IFE@E@C@AAIIA@A@A@G@A@E@C@A@CBABEHCFCFA@@@AAA@C@CDA@CBA@ADABABC@IDA@A@A@GCC@ECA@A@A@A@ABABA@AD@@A@ABK@ABGFEDA@C@C@GAA@ABA@@B@B@BBJBD@@@BGJAB@B@B@DB@@B@@HDDDDD@B@B@@ABAF@D@BBB@BB@B@D@B@@B@DAFAHCFAB@BA@EBA@@@@BBD@B@@ABABA@EBG@@BEBCDA@ADAB@@E@CBABA@C@A@AA@AA@AB@@C@G@K@@@D`AH½ÆAAEAGAI@MBA@CACAC@E@CBAB@DBFCBCDMJELEDGFE@MDC@IECAEAACEAMACBEDgnGHKHSH{\\EDAF@FDFLJHHBDFV@FG@QFE@MAUDGDCBA@EBA@C@GACAAA@E@AA@ABMBABAD@B@DQREF@BHd@H@BABCFOLiGIIEGICCACCEICGAG@M@E@AB@AC@A@C@C@C@CACBC@ADE@A@CAAAC@AAA@A@@BECCICICmS@EBAGk@C@ERsPgL_@A@A@AAC@ABADEDEBE@G@E@AMWS}OcI[CIAcAgCOŗƷ¥ùąA}¨C@EBMBQDQBGBOBC@CBCBEDGDEDCBEBA@CDABCBC@EBU@GBG@E@C@ABABE@kumakIqIA@ECICM@QCQAE@QDE@GDKBKAOBGCIA[GAFAHGHID"],encodeOffsets:[[90389,32675]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
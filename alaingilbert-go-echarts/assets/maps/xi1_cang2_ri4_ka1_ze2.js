@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("日喀则",{type:"FeatureCollection",features:[{type:"Feature",id:"540202",properties:{name:"桑珠孜区",cp:[88.898483,29.24779],childNum:1},geometry:{type:"Polygon",coordinates:["@@EEQIOIQCKBMRAREPEPQ@KNABMBIL_CGBI@OHW@UCQQIECAQMWKKEOAGAU@UIYAOASMGUQISAIJ@BiXQLEFCDUL@BEXHVLNDLGZKHUBYE[@KRCT[POBUBSMQQSGIKUEc@QDYCOBSLQAEAA@E@GAE@EBE@E@E@OACAA@CB[LiFKEFG@A@CACBC@IBABEBC@ADCDADAFADAFCB@DAHEBC@CBM@EDGBEBCDGPD\\DB@H@HADCBADK@QAQAA@@GOASBKBAFK@@FELGDADA@AJCJCNCDAHIDC@ADEBOAMCSKECK@_BMBOBWDUAOICSGWEOCQCUCUB]@MEGCSCSEYDMWIQGUCMKASCmFA@A@C@CBEBIBE@CAGAAAACCAAACAAAG@E@CACAEHCFEBIDCBEBIBCBCBC@MJCBCBAB@DCDCBC@C@E@EAGEACCCEOAEGGAAEGCCCECCA@AAABC@EDIHA@@@AAEMCMA@AAE@E@C@EAECE@EACBAAEACAABEBADGNDNCTQFQNGLKJGDIDEAUGODYFIKQCKAQD]DMASMCRCNSFDRHNHVAROHGNAPMN@D@BABA@GAC@C@EDABGJEFEJEHCFCBGFCBEDB@B@FDFBDBDBD@FBDD@BAD@B@BBBDBHBDDDDDBD@DBDDBBCHB@@RHPNLPJBNEP@L@\\HLVHLHEZCLAPKNMIWCWAWBQPINKHQNMDOFQLEREJADIJOFM@M@UGU\\A@ABADCBADKHCDILCFCVAFADEDEFDFDDBDDDBDDFBDHFB@B@B@F@D@H@F@F@FBDBDBD@DBNFHBDBB@D@HABABEBCACBCBCDAHCHAFCD@DBDBDDFDB@D@D@BAB@BABABEDGDEBCDAF@DAD@FADAFAD@B@BBBB@@@BD@D@FDBBDFDHBFDDDBDDDB@BD@BB@B@BBB@@B@B@BB@@BB@BBFBHAFBJFPFRBTBPCV@B@NGVNRPDRHNFXFVNPJPNPJNFPJLNR@RDHJBP@FBRGRKRM^@PbLPPJLDNLHDLJ^HHBPLPPAHJCJJPLJFKLWJIbKNELSJQJKTIRGXCZCFU@WIMCMFMRIFMNMPILIPKHCRAJ@J@ZLPDPFXCRCZOPMJKNIVBHIHMDOBG@YBMDOFKD@BCAECGAOLCLALEDA@@@AAEECQMCA@CBA@A\\AB@DEBMBG@SDMHQLKHGLEDABABC@CAGCILCFAFBHBHBF@HAPCDAFBFBJBH@FBDD@DAFABABABBDBDDDDDHFHFDBF@D@BAB@AC@CBADAFAF@NFNDLBHBDFDH@F@DABCD@B@B@BBBBB@B@@@B@BB@DBDBBB@B@DBBAB@@@BB@@BH@D@BBDDB@B@D@B@B@BADBF@F@DALALADAFAB@D@HBHDDBHDHBB@B@@BBBFDD@FAJCJAVEHAL@LBH@JAB@BDBDBBBBD@HCLAFA@ABCBABAFCD@H@DAD@DEBAD@FBHBJBD@D@FCHCHG@E@G@CBADADAF@HBHBFBBDDBFBF@DAFADCF@F@BAD@D@BBBDB@D@B@FEHEFGBEFEHAT@HBDADADCDCBAFBDDHBJFP@LBD@DABAF@FADBDBHFDBF@D@D@HBLFXJJFHBHBFBFAFAB@FAD@FAFCDCDCD@LBD@DBDBDBFBFAFCDABCBAH@FBJ@F@FBH@JAF@FBFDFFFBDDHHBBBBF@BAD@BADAB@AABABEDCDC@AKEGAA@@GAA@@@IIKIIMOGKAC@M@QEQEMCSFOB_ES@EACGEGEBI_GOEUISBKGMICC"],encodeOffsets:[[91738,29897]]}},{type:"Feature",id:"540221",properties:{name:"南木林县",cp:[89.099242,29.68233],childNum:1},geometry:{type:"Polygon",coordinates:["@@GHGDEDC@C@IAGAEAC@ABCFC@CBG@C@EDABABAD@BEBKBGDC@AAAAACACA@IBG@KAK@GBUFIBIDEBC@ECAA@AA@A@GAGCCAGCGAC@A@EBCBKBKBCBE@E@CAABA@A@C@A@A@CCAAC@G@@AA@@A@@BAAA@C@AAACACAA@@A@A@@@AAAAA@A@A@ADCBA@C@ECGCEGAKAMCMEE@EBCBAB@DBDA@ABC@E@CAGEGECCCCACACBABABABE@CCCEAG@IAEAEACBODGBE@GAGAEAEBKDDJBH@DADABCBKFGHKLGRCN@TAHANCFA@[B@BAB@DDBRNFDBF@B@@CBKFKBKDBPDHBFADC@ELCPAN@ZAHCPGNGJUAMJILONYPQDWDOEOCYKI@I@QBGDOLKJOJMNENQJENDNJN@XEVYDWDQHSJILIRKTMFaLIJKXELVPNLZBJPHJHLVLHNDPBJJHFBZDLDRHPAVIHJLFJHBV@RFJRT`FRBNANELOVANGZBXCNJLLPPBP@FAFGPKPCTGLMZELA@GDEDAB@FBDEHEDADCJADCDCFABCDAD@D@@@D@DDFBDBD@D@J@FBBBDDDAJKRALANITMNOLQJQLOHOFEJEVHTATDN@TDNFPHNFJTLRBXBN@TBJFRPBBRZUn@XCREROLMNQLINCLERBX@RCLELSHRFHV@TJPTFRBPDNRFRPVVAT@TKV@TB\\CRAPBLAP@BLDRTRLNL@VPPCTMPZdVTJRBLQDQAOROTGNOPGNANFdFZAbX`EPYXQJKJMZORGRC\\@RBLRNNJVNBD@LHX@`A\\EVENBLDNGVCVMTKJMJURETFVPFVFDXGNQNQPI\\EREVG\\DJJTNVLRANCAO@UFQT_JGGOJKVBVB^HNFLRTPPIR[RKXGHYJKHEHGAWIOIICMFU@QHOFMFMTIZIbGNKFMAUBKBSHYJQLOBAFAECE@EBGDIBI@SCUAMGKIEKEMAI@UBWBOAEGOBKBCLIBAAAAIGI@@BAHEHEBEDMFIFIHS@CIEAEDEHEBA@ACEGEACCEBI@EAEII@GDGFEFEHIJEHGFKDIFGLILEH@HEFMCEICKKCMIOOKAKFKBEYKGCQAQE@@E@E@A@CB@DA@A@A@KCKEECGCECC@A@AA@AB@DADAF@H@BADGBECK@ABCBAFCBABI@A@@ACCA@CA@A@G@E@AA@@@C@CA@EC@CCCAAACAKIQSQCC@@AAB@EGMMCEI@GAOOBGNEHGFCFGHQLOLKNIJEHCVKB@JKBC@CCKCAGDI@AA@G@MCEICY@WBIMMBO@IHMNOEWOAE@M@A@@CGCCAIAECACA@AAA@I@CACEGECEAACAAAAAA@IAC@CIIACIG@AAC@CACCACAA@AA@A@E@CA@AAG@CA@@@K@CACAE@G@CAC@ACAIIAACGAAGOACFM@CFGBC@@B@BBFBB@D@EEECEAE@IBG@EAE@I@EAG@ABADCBEDEBEACACACAC@KAC@CDCDEDEBC@EBA@EBEBEAGAGAIEWIKEGAC@C@E@CAGECACAEBE@ABCBC@KAO@IEGACCEAABCDCDCBCBGAS@GBEFAFEHGFEFA@C@A@ACAAC@C@ABE@E@CDEBCBE@EACAACEAGAGAE@CBCBAB@D@H@F"],encodeOffsets:[[91544,30065]]}},{type:"Feature",id:"540222",properties:{name:"江孜县",cp:[89.605627,28.911626],childNum:1},geometry:{type:"Polygon",coordinates:["@@QICAA@@G@AAA@ACCC@CBABCFABA@OEE@EBE@GAKGE@BDDFDDDDDBBFDDFFDDDFDFBDBF@DBD@F@DBDFFDDBH@DBBADAD@BBL@D@JBNDXFRDPCRMRQFGJMLEPAP@TALDXHJ@@CJAFAF@F@BB@@BHAFAJBN@LBD@BADCFADCFAHAFADBLBFAD@FBNDD@BABADG@ABCBCBCD@D@LFHBLFDBDFDDBDBDBHBBADAF@FBDAHCDADBFBFDDBF@D@FADADAFADEDCD@DDF@BCDAB@DBDDD@D@BCBQ@E@EBE@EBKBC@E@AAA@ABAF@F@FAHAJ@H@L@DHJDDBD@DAHBDDBDAJBFBDD@@DFBF@F@BAJ@FDL@DAHAF@D@DDBFBBDDFDDBD@FBDBBB@F@BBADCBG@E@G@CBABCDIHAB@BEFCBE@CBCDABABEHBJ@F@DBD@F@PBF@F@DCJAF@D@DBFHB@@PNPNBBLFTFTJRJJLNR`ML@PKVGNOFERCLCZDTSRENCLFA`ETIREPMTGNKXCPAD@JBLFLNDLPDNBLBTFLXNPRHHDHBDHRHJB@NJRDBDPR@J@HEXER@@MVCTAPAJ@RIVHBPBLFXLRNDBJFRRVDX@PGJ@HA`DJKNABALMR@FOFOBQNQLARDPJRJFFDDNJLHTAVJPF`HNKNKHKHMBAHKRCRF\\DNCKUBKHKLMHUDCFGVFRD`DPEDAHEHK@KEUEMBMLS@Q@QPAZNR@NEPMDS@MEO@QAUJIHKBOCKNMRAR@F@P@D@NBPFPNNFTNJHTF\\BR@TDXFRHTB\\BDAJELAPHNHPTXLRJLJVBTCRARANKLMFSASBE@OBQNOTDVDXCZCRIFCBA@@FEBCD@FAD@FAFCBCBE@C@A@AB@@@@@CAACCCAACAACCAA@@CBI@C@G@C@C@CACAAEEAC@C@K@G@ABA@AD@FAHADAB@D@DABABA@A@CAGCGAC@@@EBGAA@ACCACAC@C@A@@DCBCBEBCBADADAFGBC@C@A@CDGFE@ADGAOJKFIFE@A@EAC@ADGDGDEBCBC@EEQACA@ACAACAAAAABA@CAACEACCCCAIACA@ABCBGAI@@CBCBI@A@CDCDEFGEEEAEA@@@AAA@C@AECGACAC@C@A@CDABAB@D@BCBCDKBC@CAC@C@ABCBA@A@AAAIECAAAAC@A@A@G@AAAAACAC@E@C@EBA@E@CAKQSIUIMKCWEACAEAA@A@EACAE@A@CBA@AFCJ@DABABABAAA@EAICE@GAG@E@AB@DADABABCBG@GBCBCB@BBDBDDJFP@R@LMJSLMRMLINMBMAGFOVIJKP]COAOCOEKGYFOCMEOIQCWEMAQEUGMKIKQKCCOIOAUDWGSUMBMHKNRXNVQXKLQLKHGDSLIJQLQNGP@PEPB@FBDB@D@B@DFP@F@HADCDCBEBABEB@@I@GBA@A@EAEAA@C@C@C@CBC@C@E@E@IBCAEAC@@@A@CBEBK@C@AADE@AA@GAIGAG@CACC@A@C@CC@CK@YIGAICEAIAQI]OOKUQASBOCSAMEEEECCMGSKSCM[EKIAOISGWKQEY@GN@NQAaAaFSDQMO@]FSAEKKOOKAAIESWMMMGWD[GEICOKM[K@MDMJQBOLSNQEKGSMGKOQKAKBCBCBCBCAC@EGGCCCE@C@EAECEOGAACB_WI"],encodeOffsets:[[91326,29177]]}},{type:"Feature",id:"540223",properties:{name:"定日县",cp:[87.12612,28.658743],childNum:1},geometry:{type:"Polygon",coordinates:["@@SI@AAODQBQBOAQAMEK@M@KEQGQKMEIEGCGAIODK@Q@MASIMKCC@COSAGGEIBM@SDOBO@O@QBMFKFOJGFIBCBI@K@KBIFGFCJCHCHAXELCFEFGFGFKTGJCLEPCHCFGDIDIDKDEFGLIJKJIFKBaFUCm@uHE@[FGDAH@NBNCNGHKBSBKDQDUJILKRGJKLSBMGOEYGMDKHUD_@ULWFQHMPAFBDLTFLDFNH@BCBMFIDGHAF@JAFQHSFQFUDS@UAwOAECMGKOMQGc@MDEDIRCPGXCDIBUD]@EMAYBKASE[GMUCSAGGAI@WCKIMKOMMBOBGBIFKBICQGKEAAASAMCSEOCGGCGAIAIGIQUKEE@MCUAOAK@KDEFILGJILIHEDKHQFSCM@MDMJ@PATEFEMMSMCKBIHEJCJCHEHKHKHIBQ@UEUEMCABYHABKVGVDfAL@VGTENELELEPCRDPNLVDh@JBBBDDBDBFBDFBBBFDFBDBBD@B@DAF@B@DBDBBBBDBDBFBLAFBB@BB@BHTHLJLjPNFVFLFGR@LANCPEJILDLXHFBLN@XB^ALBJDTAPBNCVSJ_AWJQJODMBGNCXCFBTBN@XE\\QNVLLLDDPNNNHNJRHVFPDRVLJJDLATBP@LKDYFUAMBMDKJHVHHDBHLBFDJBPFPBPD`BV@TFNCVBJr@NCNINKFINIRQRIPFNFL@VILFFDFDJNBHFLBRLJLZCNGJBLJLTLnGTBTBXHPLHPJPDRDTBLALBLPJBLELKNFNPJPJTPCVGNCJARAJFRDNLJJJHFNJFPBRAPINAPEREL@LJNLJJJPNJJNRBL@L@LDLBLEVGRBNTDGRINIJMDKFMDIDKHGLABGHKFINGRILIRNELGLKLMLKPA\\BPD`FPERAJLLNNJPFRJFJLTNFRFJJNL`EH@FAPDNDF@VJNETBX@TFP@P@PCZETBFAJA`GNDNVTDVAVFXJZCREPGTAPNLF\\ALBJHJJRBB@PAJAXGZEZBP@N@@AAQGQAOA_JQJKHM@UHGPIPAN@DAHEGQAQLKTC@MGMHMPGPEHILCP@VAVAV@L@H@TBT@NCNBTBRELEPIP@THNDZC\\HRHVDVBP@NBR@\\CLAFODSNQN@NCTBPAHAXDJKFIPGL@TLJF`PESEIIIEMJGRY@QEOEMAK@MHMJKHKLMPGNKDK@OMQOIBOLMPEP@dV`BNEVGPSHOJORKTEXFTDZNPIRUPKPI\\IVATBLG@@PYDOFOJWAMBO@O@E@IBS@QBKLOFQ@UAOAAAGHWCSMIISBM@OGOESSOUOCCKKCKIKEIKICCAIAQEMKMOKIKSGMIEKGOSIGOKMOAK@YAQHSUOCOGMOEUOEMCOGMKYCKKGGKIMCM@QIMKGGKCEE@ATILOHKLSRQJGJKDQHMPGBKEOBYTK\\DTAXATMRKTGL@@@HAF@FCD@BA@G@ADADABC@A@A@EAA@CCACACAAACIA@ECK@EAGAOGEEGEMEICMAAAGCA@ECGCECCAGKA@@C@CBCAC@A@CBC@G@A@E@A@K@CAEDBDAXELAL@AAACAA@AAGAAAA@@ACACAM@CBGDG@C@AAC@AAABC@A@ACE@A@CAG@C@CBCBADCDC@@^k@A@@AAQUIQA@A@CDCBA@A@EAYJODMFQBUIOCW@KAFKBMCEECIAQAYGQASCQBC@@BADCFCDEFEBABA@AA@A@ABE@EAAACAAAAACGEACA@@@AECCCEACAA@CEEAAA@C@C@SFGDC@AAICE@EB@@ABA@CBEBQBQA"],encodeOffsets:[[89418,28630]]}},{type:"Feature",id:"540224",properties:{name:"萨迦县",cp:[88.021674,28.899664],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAGMAMGOC]HMBOCYIMKYHQAQCIMWSAGIAIBQA_@GB@@C@AC@C@C@EAC@EAAAAAC@MFC@CDA@A@@AEECE@G@C@CAACAGEEAC@CAAA@@CCcSAC@CBS@@BAD@DABABO@AAC@CCKEQCIEGKAGGGkyLGqEL@DADCDABE@GBCBCBC@EBA@CDABCDABABABCDGA@@E@CBCBCFCJEFMHIBQ@ZTLFDDPL\\LPCF@DBB@BD@DB@DBD@FBDBD@D@FBD@DBBBBDJLDBDBD@FAD@F@JBFBFBBDBD@DAJ@DBBDBLADBDBDBBDDDDD@DBDCBC@I@CBE@C@CB@BBBDBF@HDDDBB@FBDDB@DAFEBCDADBBFDDD@DBDBB@D@DAF@DDFBB@BGFCB@DADE@ADBF@F@BADABADABCDABEAC@GCEAIDOBABEBCDKDCBCBCBE@CD@BC@E@OEGCIAIAM@OCICGE@A@EBK@CAAACCAUIC@A@ADADELADADCBCBIBEJCH@DFBpTPJHBZDFBBB@B@@@H@J@DBBIH_FWEUAQA@NLTBJ@BBF@F@DADAFCJAFFRBB@D@DBF@FBD@BBBBBB@BBBDBBBDFBDAL@JB@@FFENMLGJWDcDKHGTCRERGJMFQEKAOFOBSAOEBQOSMGQIWKEKBUEWQEMMSEODSL]BKIKMUGMLQJIGCUAOOKM@U@WGYCSEMCOE]RKBMAOEQPOCSGMFCLWLWBMHWRSUE@INATFRLNFPKHWDOJQPIHOCYEUBYJ[BYCOCODMHMJKHUJS@GYWSBIXSFOOEY@U@OCIE@MAMNWGMEIHQCMEYHWKKQIWAO@UCOOOSKKIOISMQOOQGOAKAYCYEUEEASEUKOIKGIKOQOOK@CHIJMJYFABA@EAECGEEAKBEAEAYSICCAC@C@A@ABGBABI@A@@BABEDADAB@B@BABCDAB@@C@AA@@C@ABA@@DEDCBEJAB@BBD@DAFADA@EEIAIBQBOAI@CDVPTPFTHP@PANJTNJDTGXBHBBBP@VERKPAL@RAT@J@F@PAPBNIXEPCPOZ@@KHSAUB[JOJOLQVOJJ\\RHHHFTLHPJ`JNLRDHBJBRDPDB@ZAVHJJHRDFLJHLRPRNFF@@HF@DABC@IBEBEDGBUACB@@BBTHFBBBBLLJT@VNBTJLNJZJGLBVCHCHIRFNHRLNJLLHXT^PJF\\V\\FRA\\HTCT@NAXFXGDIBGDOASEODYLGTAHBLBZ@nB^@RILDPDTFNGBCHIISJSNElDVDPCRGTFHJLLBRBT@PBNGV@PET@BAHCNAXHTTJTDP@TARHNETBBBLDXRR@TRLRFPDNBBJLLZBPLHPDVAHFD@BPBF@FBDDNJbBH@DEfmBSDQFQPBD@LBJ@DBJDRRfNZHFJDBLDN@PBD@HBF@HBDBFDDDCLIHCFCHCDCDCBAFCB@FBD@HANAF@DBBDBHBBBDF@F@FAD@DCDEDEDADAD@J@FBHBJFDBDBB@B@DBD@BB@BBDBJB@@BD@BBD@@@FEFCBCBEDUDEJKDCLGBCDABCBAB@V[VHN@N@PEJIBCFIFQRKPENCRMLGJMROXAXBXDNJLMBODKFYKGUGGK@[@KFOAMOIMKGO@QA@DGAACCCAC@CACCCCGACAAA@A@ABC@ACCEAC@CACAEAECA@A@FCDAHEDADEFGFIFEHIBAFCD@D@HBB@BA@A@CNMBOHMPGBQGUGMCQTEDMDQTNNB^CRCLBRDJLZEPCVHFBJCHCLIHKRMREDSCMHMBCFABADBFBBBDAFBF@FDFBD@F@F@BBB@DNFNBB@@B@JGFCD@BABBB@DDDFDDFHBBHHBFFPDDBDHFFBF@D@D@DADC@CBADADANID@DADAJAFADAJCFADEFGGG@@EGACCEE[AEACAAA@GAACAAAEBEAEBE@GAKDGDEF@JGBE@[AUCGAE@A@ABCHG\\SHIB@DGLSDERMTKHANCJAJEJAHKHGDCBC@E@EBC@@DABAF@HCFGXGLMDUAA@MESDQ@WMMIISIQSUIGG"],encodeOffsets:[[91091,29364]]}},{type:"Feature",id:"540225",properties:{name:"拉孜县",cp:[87.63704,29.081659],childNum:1},geometry:{type:"Polygon",coordinates:["@@GAQCMK_IOIKGESGGQGI[YMSCWESFQLIPGPOTUHMF_AcUO@OFKNAPPJNR@PCLMLOHKNGLILGN@NBLFNFP@RQZIHFNJJFJFT_OIESKK@OHEJILWCGBOBSAMDM@MRCTEPKB[DQ@MAO@UAUCQG[GYDMCSGO@OJKFQFSAMAMDS@SAG@BFBDDH@DB@DBFFDD@@PDJFDDFD@B@FADBD@DDDFD@D@H@BDBFBD@DAD@BCDCBA@AAIBABA@@LANBHBHAFAFAH@P@F@J@L@H@LBNDHDHBNELEFCBEBA@A@ABCD@FAH@F@HBB@FBDBDHHDNBHBLHFBHHHBH@HBFBFBLHDDDHBHDDBHBBBZBFBFFDHDFBDAFAJBD@BADKDADIDAF@HDBDBD@BHJHFFHCBAF@D@BBBBBBD@BBF@DAHANAD@D@BBDBBBBBHBFFDB@BBDFB@BBD@D@BAB@DADADAD@D@@FADM`CHCDABEFEFCHCFAH@B@DBDDDFFDFBBDBFDB@HBT@LAFB@BDN@B@DAJ@B@J@B@DBDADBHBD@BBBBBB@@@DBVTGPSJULIPQPCRBVFLNNHLJLFTXJVCRA\\@PAXFRDRGVAF@NBNBTNTFNNFFFPZLH@PBRFR@ZNRFVJHNHRVFRAFBJBJLT@VFPH@BD@BBHAJ@ZCH@DBB@DJDFADAFCHAJAH@FBF@LAB@BAACBC@SACBCAA@IEA@AAIBC@CBAACCCBIIAAA@ECC@@AICECA@ICAACAE@@DCACBABAD@BAF@DADADADEHABIHAD@J@LANCPATBRBDBLCN@DHLR`BNDTfJPFTL\\DJJBLCR@PVJTDDGV[NCF@N@`HDJDLDTJLdDTBTCTGTQNERCXBPBPAJOBSAKD_DOF]FSHQHOPQNGLAXANATEPSXAJQPIPMJS@SHMBUBSRSNMJODMLWHUDO\\GLANANQH@RCL@VCPVBDLNJPFPBRBJNFJCTKVKTKd@JENJB@BBD@D@D@BAB@BA@ABAB@DAHGBA@ABEBA@ADAFAH@H@FAFABACCAEAC@GAE@GAC@OCMAKICGEMYQeCQAI@CAI@KACRORETCnAFe@CAGIaCMAC@EAEAOC@GEUBOCKGAOKYIKAACMEOKQSQQ@WQKCAASAMFQGSBO@SCSIGSBWDMBG@AFS@OHUAM@OASAQKKGISEQHODUCkCMFITJTGJADMHSEOCKCQJ]@mAY@KAGASBKHCZFPBTCPAHCJWHWEMBS@SD[GQB[E[UIE]OWSKGIKKMGQEMJQDGDGAUHKYIMIIKASUMS@KIAKAAEASGAA@@DAVBHAFCFAJAD@BA@CGE@@EEQMQOGKKICEGQIIUGYBA@OCQCIA"],encodeOffsets:[[89833,29518]]}},{type:"Feature",id:"540227",properties:{name:"谢通门县",cp:[88.261664,29.432476],childNum:1},geometry:{type:"Polygon",coordinates:["@@AIBEAGAE@AAA@@AAA@A@@@AA@A@AAAC@@ACACCCACCAECGCEAAECC@C@@A@@AAAAA@C@EBCBEBC@CBE@CBADCFCHAFABABA@ABC@C@A@ECCCCACAC@EDGBGDCBADADBDADAFABGBC@A@CAGAMECAC@CACAEAE@E@G@C@E@A@A@A@GEACCEACCCACCCCE@@C@AAC@@AA@AIAC@AAAC@CAA@A@CACAIEGAEAI@C@CBCBCFCFCDC@EBE@E@ACAAAGACCAE@MBGBC@EAA@EDABCDCDGDEDGDKJCDABEBEBG@G@EBCB@BABAF@BABGHCBA@AB@BABA@ABC@C@C@AAA@MIIFc@SLULSLIDMEAIAQEOIOKMACOUUDK@QDG@MRMBKB[HCPGVKXCNIPMNQTATAVGN@TITONOJIRWBOTSFMBWBKBMHORGPGRETE^CPC`BLATIPOBOAWAQDMFSRSHSDSAcCIKCSCKCI_GM@E@MDU\\CHBTCXALIJOJKEQDMBMIOCMAMBM@ABORENBLCNARB\\NFBLEPMVBLPLLDVJZDZFTAVJAPCPARBN@TEJILIFMFUFSHODMHODGBOBSJCPIPMDOHWJULKFQBO@OLSPK@OGSG[NCJ@BBFDFBH@DDBBDBBBD@BBBB@FBDBBBB@BDBBBBFDCBCDIBGBKHCHGFG@KBIGCGAGAAEACACACE@@GA[EGO@O@OAQEMIQIIMMEO@OCKDUHOLGHODKBKEMOEWCYCMGIKKM_GOEOO]]OUFKEUQIQCMDGNKHOFMCeIOGEK@QCQGMIK@KJYHSGKAQFUCGGSUKGMYKK@@@GFEFCB@FBFBFDBBFDBHDDDDDBFDDBFABDF@BFBBB@D@RFVAJCFCDCDIHGDEFCF@JBDER@HIFEFCBG@KGMGGCIACCEACC@CEAE@UBAEACAEACAE@A@C@E@E@IBCBCEAaPENGHeTORITYDKFMFSNOFCDIFIIKEQHSCMVE`JPFLK^KVARDRKLENCPBVNJRFLLFRIRIDQD]JKJ@ThJHPFLHLJNGZCLCTVJBTBNANDT\\MLIV@PPXDNJPPBRNPNFZFRCPL@NLNR@RPVNSTWVCVEN@LATCTHZ@JATFNFPFNAXDN@PK`WBODWBOHERCPCVDPMNELMNEVATCNERSbQFU@URFPCN@RFLXL\\FRFf@TEL@jHRBR@PCTGRG\\JXKFTBR@NTHRAPAT@nMR@RFTCPKRILKPFLT@RETCRBLNJJNJNRJNLLDTAPKLRBPHVLLLD^D^CRALKQUCM@QHSRKR@XFHILMNGRHJRRBPCP@XETKRINGLILMPAR@RDRHPIJKREVMVCN@LPLLLPVFNPPNVTNHNSLIZPPJRLTHLGJMJMLGbAR@PEXCZDVJVTR@JAVANARAFMDK\\EPKP@PJ^ZTD^@NILICOFSLSHMHUBM@OBUBUBEHMPAVBTCTGPATGLCPKHOHQHSFK\\KRBZLJDRHPEPMNCRRHPLVZX`BV@X@JAVAZCTGV@\\IPGBUJSBMTCP@LCRQPCTENQDKJQDQCMBKEKSOCIBODSCQEWEWFQPOXIPMTE\\GTJVVBV@RD\\FJLPTDNLDZANBNZL\\L\\BHHJVNNNPJLJVJ`TLXBRBdBVA\\ATANCVC\\BXCL@L@TCJIRMRCRDZCPCJMPDHHAX@PLPP@`UVDVFPINGPKTQLITGFKDK@QAWFQDKJMRKNMPKFQDQ@WVmQYAAQOIESAM@WAQASKEIGMEOCM@SCMBSGSFUFIPEPGRKRIPKNMJSBMBKLQBICCACAA@E@I@CACACCE@C@C@@@CBCDCBADEDCBCDIBCFCFGAC@EBAFCHCB@FKNYHKDSLOHOBE@EAOOOKKMIWDYAMHUBKPMFMBQA_EQSEI@QAUIGKEGIUJOBQGKCYCEAIGAICOGMUKGKGIIOYAMKUOKIIODIGIOBKOAOGGI]CKKGCMIKOOaK@ON]LQHQAQ@EAOGIQCQ@KMOIMEOIOMOIUMWEMEQGOCMQHU@M@ADUAOASEQEO"],encodeOffsets:[[90733,30074]]}},{type:"Feature",id:"540228",properties:{name:"白朗县",cp:[89.261977,29.107688],childNum:1},geometry:{type:"Polygon",coordinates:["@@AQEESOEAE@M@GBEAE@WFGBG@A@CCCO@E@ALKHETOHEBE@EAICGAO@GAC@EAAAAAAE@CAA@A@CBA@ED[BSDOBOBQFMDOF@@A@CBA@EBQ@A@ACBMBMAEAA@CBCBE@CAAAAAA@@C@AB@B@DABADIFC@ABCBEDCH@FAD@FAD@@ABAB@B@@@BBDDDFHFHBF@FADIHKFC@EAABDJAJAFCDEDKH@HDHFJ@HEVGHC@AAG]CACC@CCECCACKMAABC@EAC@CACAEAA@C@CBGBG@AAAKIA@ACACCCC@ICC@AAECMECACCEGCECEIKCAAAGAG@CACAAEAB@BCFAB@D@D@DBB@D@F@BAF@H@FBD@BAD@H@B@B@B@B@B@NAJAHADQR@@EPALBJBHBFHrzKHlHHLBFHDJFRDL@DBD@BAPABCBC@AB@@AT@DBDdTDD@@BBDBD@FBHFDBBB@D@D@HDFFF@BB@B@DCD@NED@BBBBFBD@FBD@D@D@@B@DA@@HB`ARBJHJTBNXDJBRGRLZJNDZAPGND^HPBNHNDBHHVJRTTJJJNN@XCRFT@NBBCVKNWHEHGDE@ABCB@@AD@F@FADCDGHGLIBIFIBMDGBSLQNCFKTCHA@GJ[TGHAD@B@BBFDHBV@\\AFIHE@CFCHBL@HAFBFAFBFBBBDHBB@BBBDBFF\\DFBDFH@@HHDBDBF@H@BBDBBBDBBDBBHBDBF@JAFADAD@B@B@nETDLBDNHVJRNXZCTFTDHDNF^@VAVDRDPDXFTHJDBPCVAXAPAN@`DLLFDTBNAPCF@BCDGJCBMDIDID@BCBCBKHEF@@ELABALBTHP@@BBBR@RCLABCDGBG@A@[COCCHADAFCH@FAN@DADGFCBA@EDCBEBCBCBCD@BADAFAB@JADBD@D@BEHLFjE\\KDAB@DBPBF@F@F@FAF@HBF@B@FBRBTKPAZDRCd@VFJLTHRRTNVAPA\\ODSLQ\\@ZFVALGHYCKKMGUFW@AVKDCFERKjW@AJITBRJHVTNPBZBVJV@JU@QBIBODSNU@@FQFW@G@IOQACQCMIA@GIGQACCGGGOQWMEKASAKCMKOMCEKAK@IBCDOLWHMNSFOJQFSB_KEMDQFSTYCKDQDEFMPUHOLK@_NMQIKQISISEKEAAOMOM@@GAAE@C@CBEDI@C@EAE@O@EAC@C@EAIFGBABADCDAF@DAFE@ABAJGDCBADAH@F@H@DABCAAE@A@AAAC@EACCCCEACEACA@C@CBEBG@CCK@EBI@A@EAECE@@CCEAIACBCAACBG@CACCCGI@C@K@GBIBG@E@EBEBAB@BBF@D@LAFAF@FAF@R@DA@A@CCCAC@CBADC@ACE@CDCFCBCBEBCBC@E@CAECCAEAEBCDCBGAC@EBEBCAAAGACACCCCECAKEGAKEC@C@ADADAD@BCHABABC@MCEAC@EBKACAEBGBEBCDEBCDABC@KAM@IAEBGB@AA@@A@EBEBEDI@@GICWBK@SBOFONKHIRENQDQCOEQCWAM@I@CAK@ABCBCAA@CAGCCEEAC@C@EAC@CAEACCECECCEECCAECACCCCCEAC@A@EBCAE@CACBCDIDCFEDEBEBCACCA@CCCCCEACCACCEAECCCAAAE@SAKAEAIECCCCC@EAC@EE@ABCEa"],encodeOffsets:[[91219,29070]]}},{type:"Feature",id:"540229",properties:{name:"仁布县",cp:[89.841983,29.230933],childNum:1},geometry:{type:"Polygon",coordinates:["@@KAICEAA@@CBC@KBCHIFGBCAEAEA@ACAAEEA@AA@A@A@ABABABA@EAA@CAAA@AAC@CBG@C@@@A@@B@B@DAFADEDEBC@EBC@ADEF@@ABEDQJYDWDUCSCMPAR@PAFBTETKNMLQBQBSDUAKIQIWKOSMGOGKBIFCB[ASAQGWESCQ@[ASEIGSMMEOMOEMAC@O@E@Q@QBMNDLAPGLIJBV@RFP@NCTONMFQ@YMOB@R@RKTANFNFV@LGLGFCBOF_CQCUEEHCDGVKNGLALLVMD[CQEQDGLABGNGLMLMLAJHFHFBD@FFTA`EPDTFNFR@R@NBDHLNPJJJL@J@@BB@HB@HBLF@BCDCDAFABBBA@CBABC@ABE@AAAAGGCCEAC@A@EAAAA@@@ADEH@DENBDHPBBDHBBJJDB@BBD@D@HBFBD@D@L@@DBH@BBB@@D@F@BBBB@DBDBBD@DBD@BJHBDJJ@DBD@JBBBBBBBDFBFDFHBD@D@JBB@BDBDBBFBJDDDH@@@B@NBFXPPFNMJGP@NABSRMRMDYBS@OAKCSBGLKPIXC\\GTGNSPKXARIXMNINMDM^IJIVCZELAHBr@RBRDJCJCF@ZJX@j@TFNLR@hAJAADBTFnObDD@R@dQRMF@F@FBL@jDVENG`CbMB@@@JED@\\EN@HAD@BABAE@AACCCI@IEGDOCOMKKEMEQCKGJSAOGKGS@SESKKKIKMIOOKWCSAMOESEGAECSEUAODWNQLQJMDSDQEQOIOICGBECCGIGC"],encodeOffsets:[[92386,29726]]}},{type:"Feature",id:"540230",properties:{name:"康马县",cp:[89.681663,28.555627],childNum:1},geometry:{type:"Polygon",coordinates:["@@G@E@GA{GEAAAMMMSQSQQGIMCIGQUGMCG@@GBEABC@I@K@OACAC@A@KAA@C@CBCDA@@@A@CAA@C@GDCBC@E@CAEACAC@EAIA@@ABADA@ABABELCF@F@F@FDJAFSJnuhCFKLA@EAC@ABCBABAHGDCDCBC@I@A@GCCAC@CBC@CBI@C@A@E@C@@DBDBD@BCBCBC@OLKFU@SAOIOUKQKGOAUEO@SCQCSEICMCU@SAOBQNCLERIXIVCVALCNCPDLNN@@DDDBDF@DADADABCDE@CBA@ADFFDD@D@H@FC@CBCDCBCDCBC@AAE@CBCBABC@CAAACAEAC@C@E@ABCBCFADEFCBEBG@EBAAE@A@C@ADADCDADB@DBF@BBBBBB@FBD@HBPDHBJ@FAFGFSPGFKL@B@FDPDDB@H@HAXEF@FBHAN@F@FBTPFFBRFbAD@BFFD@FBD@DDDDJFFBLBTBF@BBDBDDBFDFBDDDFBDDDD@DDBBDADAFCFEFCDCJADBD@DBFAD@F@BF@LHHBF@FAF@PFB@BADEBADAD@DD@BBB@B@HB@DBRJXJ`DABBPHDFBF@F@DDFDDHH@FBDADADADADBLRLLPNHHTFLMRKTAPIRCN@N\\LLNDPFJ\\HXCNHNNTXJFBBPLLPFLTB^EP@RNTCbEbBRB@MHMZ@RFXLTHPJJBFLN\\TDTLNHDDFFFFBNDTAPBTVRPL^PRJJBFBJDHBZJL@@DDDD@B@D@BD@DBHJHHBB@@BCFBBD@L@FADAB@@@D@FBDBJAF@F@D@D@DAD@D@D@B@FBFBB@B@HAJ@@@FABAFADADCBC@G@EEO@C@A@CCAEAA@FO@OHORMRKJITKHCLGRKLKRWMUQWLMNGNATVXHVCPBPJDDRLJLNLVHRFNBXFRDPJNFPDZELHPFPDPB^DLOJIPUHENBNAJMNKNQTKNI@K@QEOCIACAC@ADADAHAH@DABABABC@CBAF@H@HBF@JDFBB@BBBABABA@CDIBEB@DAB@F@DBFBB@AA@@A@MGKIOEMGQSEO@QCQHGLDPBNCLCNKNKJMHOHSBSOENWJUCWCSNS@MGOMKUCKGIEQGMGCKFOLKDQAM@QHSAUAOCKDONSVKZJLDHGASEQAIBAFGFEBEDCD@B@FBD@D@D@BBDLBBBBDAJANAF@FCFC@@DDFDFBPDJBJ@HAN@^@H@@@B@AACA@@AAB@@CBAAAAC@ABABCBA@@@CACAAAA@EBC@A@ACEB@DA@@BEBABA@CBAFE@A@C@@@@AIAIACAAAACCAC@CAC@A@CKGAC@A@E@C@@@C@C@EACACCCECACECAE@E@E@EAIAGCS@EAEAECGCCCGCECKCSCaAB@JEHGFMLMHSDYBI@]@AC@K@SAIEIKSGGGIEEEAGDMPIFUDSEOGGGCGGGEASBGDCHEHAFCHIRGHQHUPKLWJWCW@OBUEkGM@GBMLGJGNORKNGLOJM@W@SHKNKHUAIFGDO@IGAGBI@EKCQCAEDGLCFEBGMWMKKCMAQCKAKKSQEAE@E@ODiLI@KDIIEIAACAQ@M@I@"],encodeOffsets:[[91802,28845]]}},{type:"Feature",id:"540231",properties:{name:"定结县",cp:[87.765872,28.364159],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CB@@@@A@A@A@@@@@AB@B@@@@@B@@AAAC@@AAAAIACACCCCAAA@E@A@A@@AA@ICA@@@A@A@ECIEMIYUMCKDMEM@QBKDIDGFGFMHKHMFC@BJDHFHFJLNHRFR@L@NFLBNBRAPARCRBP@BTJRBRAFADAB@BA@@FAF@JDBBD@HCTED@D@B@BBFF@DBBBDDFDDBF@@B@BDHFBDBBBBBDBB@FAF@B@BBBB@BAFAFEDCDEBC@AD@RATDRBZHRBJBFDDFANELLBX@PDVJRANEPCZIFBB@B@DADCB@B@JRRVBB@@@B]l@@CDCDABAD@D@DBH@D@BDF@B@BADBB@BBD@B@DCHAH@DBNBDBD@@BBBBBH@BBBBDBBK@KBWFCBCABF@D@L@B@F@B@HAD@D@BBDAD@D@DB@HLDBFDHDFDB@HDBBNBJDNFHFFFPHHBFBL@FDB@DJBBDBDBDB@DBB@F@B@BADCBCB@B@HABC@EDE@GB@@K@SHQLSNWBSB[CSLAZFPALOHGNCRILIHQRKTGLKPSJ@BFFLDHHNLRJN@NDLJHHLLZDNLPHNDPFFVNPPHPDTVRGZBL@PBLNHPTJHPFLNJTHJLPLLNFNBRBJDDLJFJJLDLLLDDDCJ@PBRAJAJBFFB@BCBE@CAC@ABAFIDAFC@CB@BAD@@@BBD@@@BADCBA@A@ABABCFCBA@AB@J@BAHABAB@D@D@DBJDZTFBFBLAFBHFFDFBB@BAZENIJIDGL@PPPRJLLHPJVLTFFBVFZFZDLBPBRHPPNRJTJPLLPTPPVDP@XBRJLLGXFZDNGRFJHNMXBN@NJFPDV@Z@PFEPWTAJXTHZT@VILGNINGPCPDZD\\AZIVAZFPDJGROPIXCLGEOKMEQBSJMF@TVXQNGXAXKDKNETHPDROIGGQESAUAULSFWHQBKC]BQCI@A@AB@HCBA@CEO@EESBGAKBE@I@E@GA@AGAECACCE@E@GBQBE@AA@C@E@GDE@CBE@ABEBC@M@G@GAOAMBE@IBMBO@AFS@MBGRuFIDWLq@ABKBIAIEK@AMCOOKMULEBGNCDAFAB{E_AiEGAC@E@IDGBEDC@EAGIGGACCAA@CB@B@@ABC@CBA@AAC@ACA@CBABC@@A@@@CAC@@A@A@CF@@ABA@A@CACC@@CCEAA@E@CBC@@@E@ECCACCB@DCFCFGBCDEBC@C@A@C@ABC@CBA@ABE@A@E@C@C@A@ABGB@@CBABCACB@@@BABCDC@ABC@@@ABC@@@C@A@C@C@ABADE@@DK@@AEBGBCBE@E@GBCDCBCFCDCLCB@DADCDEHEBCFIFGBEBC@CAE@GACBEBEBEJKBCBCBMAAAAYMWIGCGECAGG@@AAGICCCCAGACCC@AAGCI@EAG@@AAAA@CAE@EAC@C@GAGAC@AAGAEACAECKAAIGAEAE@A@CBCDADC@@BEAEEIGIGECA@AAAAEOFO@ICKQB[USMIAEHKNQLc@I@@IAEEIEICOIWDGVIPKHMBQEOAYPWPMCMEICUGYIOCI@SHKJMLYLQFOBQDO@]CM@A@[@G@KDQL@NDLDJCDCFEDCBOHQBIBGCGEAGDK@ICGIIQMIGGG@@GEIECCGGIEACC[BQEKCCIGGCISEIOEIEIOCOCMKEGEI@IBODO@EBUAM@C@EAG@CAED@HBTDPAJKBC@K@OASCECCMIKIGQCG@MDMFEJ@VEXCLGLILMFKBI@IACCIIIKKMECG@UAQ@I@UAQCOEMGGEYMG@QDO@iFIDOF@B@@BBBBDBBBB@D@BBB@B@B@D@BBB@B@B@@@B@B@@@B@BCD@@@B@B@B@@BB@@BB@BD@DBD@@ABADCBABA@@BA@@B@BAH@B@@"],encodeOffsets:[[89527,28525]]}},{type:"Feature",id:"540226",properties:{name:"昂仁县",cp:[87.236051,29.294802],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@ABENCHCHEJEHCFCHILEFEFCFGHIHGHEHEHCDEJ@BCJEL@DCHAHAL@F@HAH@DHBD@D@D@H@F@LAH@J@HAPAJAH@JAD@XCFALADAF@FAHAJCHAJCFADABAF@DADAHABAFCD@FABABABAB@BA@@D@B@FBDBF@D@D@B@DBD@D@D@D@B@BBDBBBB@B@D@B@DCBCBC@ABCBC@A@A@@@AA@A@@A@AB@@@B@B@BADA@A@A@AACAAA@AA@@AA@ABADAPCDABADAAGACAE@E@]@CAACAE@C@EBA@CBEBE@CACAA@A@ADALA@ABCBA@ABBJ@D@@ABK@_NCLGDGJMFEJEBCB@@ABCBCDEDCDABABABCBCBCBC@AB@@C@CBCFEDCBC@AGE@A@CBA@A@CAAAA@@ECAAAA@@AA@C@C@E@AAAA@AAC@EBEBEBE@CBE@E@CBC@C@A@CBC@A@ABC@C@E@CBC@E@@@C@EBA@KDGBGBEBEBBHBDBJ@HBHAD@JAFAHAFCHAF"],["@@PETMNELEZCJSPQfSHGFMbOFBADAD@J@F@F@D@BBFBDBFBDBFVAF@FB@DDDFBDDJBHDNHLHH@DAFEJE@GFQAC@IDEFEHCJGDCDCDEBIEU@Q@CAAEA@ACEBAAECCAECCCCGCCAAECAAEAE@EDAFEHE@@L@ZLHNVLHTDHEVBRHLGTIZ@LJLHNDR@RFLPHfJNDPELGHMNCRDRJFVELPV^^PPPF`HLNJLNHZDXDPFFNALCLGPKHGPCVDL@PFPNNJJJRFNBR@P@PHP\\FHB@@DFDBDBFBBBBHDHJHLAH@HEDGLGHAJADCDAECAAAAACA@AACAEAA@AA@AACAAACCA@CAGCEAE@ADI\\MTHPHL@TOPKP@RALEVKXIPGNCJODOTIPAHAPCNGPCTGVENEJEJKFI@SAMBQDOBOUISBYEYCUIKCOKAKNUFOAKMEA[BQDMAKFMPQBAN@NANBPDNJNARCLFPIJIBKDWASSCUI@ODQAKII[CSKOEeICSAMQ_GK@CDMAKACAQBSDOBM@K@IBCJGBAFGBCBCBC@CBE@ABCBADADB@CF@DBBBJDB@FDJD@BD@FDB@BBJJDADDBBDAD@JABBB@JFB@DBDATBD@DABB@ABA@KAE@EBGBIDGBEBCCECIA@CAG@YDI@GBAAC@@AOGUES@IKIAEAQBUEGQGMUIQEYMQ@QEOAG@YKEOEEMMSESMMAMAE@UBQHQCWEOB[@QBUDWIESIKGKMMEKAUDQROJOVKTIHOUSCA@@A@AAAA@AACAGBCAC@C@A@I@ABI@C@ACM@AEAKBS@GAA@ECCAAACEEECCAC@C@ABGDEDGFEFEBADCDGN_BC@EC@C@CBCBCBA@ABC@C@AAA@CEAAA@ECAEAGAAAAAC@A@CBCBMBG@CAE@AACAAAA@A@CBEDAEGGEGI@AACACGCE@CBCJCBCLABC@IAEBCBEAGCECAEAEAYAAAGCCAGCGCCKGEAEAGAG@GAGGEAKGGAMAGCCGCAEAA@GAE@G@EBC@AD@B@BABAFEDKFMFGAGCMCKAG@K@I@E@O@G@EBEBGBGAMAKB@@ABABBJ@BABCDADC@CBC@EACA@A@G@CECCC@CACBC@E@AECCCIEOC@@CCEECAA@@CCGACAEK@U@UBUBO@KDGJOFOHGNHN@NSDKLBRHRGFCBM@OBOJGH@VGNILIRB`BPHRBR@BM@O@YAYFWHIBOBA@QAIIIGKA[BKEOMSBOHQFYDWIUEUBSCMUMC_HIBEBSAYFODO@O@SEW@SAMFUIE@MCOCEBG@_FMKIIQEMEKSEIQIOEMIKMIKQBOF_EOC[AOBKLKNKLKHMFUFUH]CA@[JeBUEKBSBOLUVANGLGNIVSAUGODMHIRENGRILgLSFUPMFMLOJGLDJRDNHBL@NERANLL^FLHHLHNNPHL@TFTPNNPDJAPCJOPIPGJAPBVELCJOLQDQ@O@QC[AODMHINIJQBKGMGOGWAO@SA[@[OIXINOBOEQGIGMAENOLKNQ@]GSFOCMCSC]JM@MAQD_GKAOCS@KMBMEKBMMOMGOCQOQCQFKEKFGXCLKXKJELELHLHLCTGLSXANBLFNFPBFBT@NBP@NBTJNHJFRBLCLDLBP@XGN@J@NPPTTHV@ZCL@PALBPHNJLFLHVKBUKKAEZGAIKCMIKGKGEYOIIEOC_@O@MLIRKIQDKNGJUAKMKMIU[@OCKCKQKQGQIOIQEKOYMIAIFKFCJMJCJMRKPELALCLGNONMAQDOHELIPENGNGJOHMBODWDABEJBFDHBDBFBDBB@DDDFJDDBBBFBBBBBBBBDBBBBBB@BBB@ARCLBLDP@LKXELKHYLOJOLKPGNATMRaJYLKTOVUBaBSDMGBOAKEMMCQ@OPCR@XOLUFAD@BDBBD@BAD@D@BBB@B@BAD@BAB@D@BCD@BCDABBBB@BBB@BDAFBD@@DDBDDDB@B@B@@@JKFEDAHABAFA@A@EBC@E@C@CDAFCBCAAAC@CBEFCFGDEDE@EB@BFFDFDFBHBR@D@FCD@DCFAFAH@H@FBBDAFBHBFDFFHHHADCFGNE@EBKFCBIDABGBEB@@CBCDGHGDCBEBCBGBGBABAF@BAJAF@FAJHHDDBD@DCHDBJBHDD@FBBBAB@BBBAB@BH@DBN@D@D@B@JBBBHBDBD@FBDBD@JBDBFDFBB@DBJDD@D@DAF@H@N@D@D@D@F@LAD@DAD@B@JBF@D@NBB@@@LBDBD@FBLDBB@B@L@N@H@H@D@FAD@FCFCHCHCFENEFCFABWRCB@BBDFDTLBLPLVHTLXFVFZCR@V@TDRHHPBJCPH@BBBBDD@D@@CDAB@B@B@BHDF@B@BDHJABCH@@IDADABAJ@@@@UCE@CBA@ADALADCF@D@FDJ@H@HBHBH@HAHCFAD@PDN@J@JADDFDBBBF@FBB@@@DABA@@@G@ABCBALGFCBADO@GBABAFAH@F@F@D@DAFEDAH@FABAFEF@LBBBFHBDDF@FBDAD@JBDDDDFBBABCDCFEHCHALAFoÈCDEAI@A@ABC@CBCDEFCDEBEDEDEDCDCDCBIDCBE@EBC@ADEB@BBB@BA@@VRAVAd@ZNTLJPDPFRLFZBHLRP@PCV@R@BBDFDBBD@DB@BCH@BBBB@D@@ABC@ABADA@@DABA@EBABADADABADADA@ABCBABAD@FAF@FAJED@JCZIF@@@DBDJ@RABEDMDAB@B@BDN@DADCDMH@@EAEAC@CBADADAFABABEDGFABCDADADCH@FAB@FBD@F@N@B@D@BCN@LBF@FDJBN@@BB@BBD@B@BAJ@BBN@DBBDD@BBB@DAB@FBB@B@BBDBFBD@D@DAD@DBBBBAF@BK^ABMdKpAXADCDCBABADJL@BIV@BABECCCAAC@GDQHCBCAEAGCEA@@C@ABCDC@EBC@EAI@G@C@CACAAAC@E@E@CBEDEHADCBA@G@E@A@CBABAB@DAB@DC@CAAA@AACAAAAC@A@EBGBC@C@CFCBA@@B@F@B@BABMJEBABCBA@CAEAC@E@E@CBA@@D@B@B@D@DADAFABCD@D@BBDDFDDDBFJFFDHHH@DB@@BAF@BDD@BFDHDLBhBL@D@FAHEDENKNCNGpH\\DJPJNGJWFORILSRMRMVCHDFV\\HLFNPbDAFAHEDCFEDCBABABCBABABABABAB@B@B@D@BAB@BABABA@ABAD@BADA@ABA@@B@BAB@DAB@BB@@BDBD@BDDBB@BBBB@DBHBB@D@F@FBF@D@F@BBF@DBD@@@BBBB@@@BB@B@BBB@@@BAB@BABAB@D@D@B@D@D@@BD@B@B@B@B@BAB@B@BBD@D@F@D@B@F@B@B@@@AAACAE@@@AAAAA@@@A@A@@BA@AAA@AAA@AACAAFABABAF@DAD@BADAD@B@B@BBB@BBDBBBBBBB@@DBB@D@B@B@DBDDBBBB@@BADCBA@A@A@@D@@@@@B@B@DA@@B@@@B@@ABAB@@@BB@B@B@B@BBBDBBBBB@@BBBBBB@BBBBBBB@DBB@@BBD@BB@@B@BB@B@B@BBBBBBD@DB@BBBBB@B@D@@BBB@B@@BB@@B@BBD@@B@FCLAFAFBDBF@DENAHBF@DRXLHHAJCHANCLA@BBBBDBB@BBBB@HFDBBBDD@@BB@D@B@FAB@B@D@BBB@F@F@DDBRFDDAFAHCBCDEDCDEJEFIFAH@BDDDBDB@@B@FBD@D@DAHAB@DBPDDBB@BB@BBVBHDFd²~ZJFDHELGt_¢m@@MACACAC@C@ABABA@E@EACHQJGHMFWDOB[EQBcBQPmHOIMBYBCHILGN@JODMHQHKLOVAPEFUBWJKPMXQCEEMEEGIEMCKBUBQBO@QHKFGLOLOQMKMAMHIXENOHKBM@OGUIQGMAKBMNMJK@KU[HILE\\EJ@V@TDRDdHNBBDBH@BBBD@VAF@DABA@A@ECKAM@G@CBAHIDCHIDELMDC@E@C@CBCLIDCDEFMBCBCBEDEBCDEDEHGD@DBHDBDDDHFJHNFHDCBCFEFOBSBE@G@EDOfABCBC@E@CB@@CBAFA@ABEBCBABKN@@@@B@`Uìc@DDH@XFHBDDDBFBBBFBBDBFDF@LCjSTAVDH@LAF@@B@BKH]XABCDADADBD@F@HCLOFEPCPIRDR\\TPHPNV@B@DBFBD@DBTQF]BKBHLBHDHCF@HBD@HFAFADAFADCJGDCDCBCB@B@BBDFJDXPVNJLNDJPP@HINgJC`BPAVSHOLSJETED@FFC`BLCXANCZ@NAJADCDADATARAFABCB@BADCBC@IIIIAAIEMGA@@C@A@AACAAACACG@EAGAMAA@W@KDKDEDA@@DFRHDHBHBHBHDFHHJDHBDBF@H@HCLCZBD@BDBLDD@BAF@D@DDDHDDBJ@D@L@HFnE^IbM@}CDÁFILSG@E@EBE@EBE@C@E@CAE@C@EBC@CAC@CAG@E@G@C@E@CBA@CDCDEBCBCDGBEDGBEBEDMHABABCBABGFCDCD@D@D@DF@HBP@FBL@F@FBD@F@D@BBDBBD@FDDBDBDADADCFEJCBCDCBABAF@FDHBH@F@FBHAB@DADADCDCBEDABABAB@BBBBBBB@D@BAFCFEHADABCBC@C@C@EAAAC@ABADCFEDCFCBADADADAF@F@F@@ABCFADCBCDEDABCFADAD@BAB@DBBFDADEHìÄBbTlB@B@B@@@@BB@@@¼SEME@GDQFCEEEMKKQAEBADEDCAKJ@@EECCECECCAACCCACCCAE@AAC@E@AACCEEECEEGCCEGCCCCCCCAC@CBCAEACAECCACACCCCCCCACACAC@C@C@A@AAAAAC@A@CACACAA@ADEBAD@HEFCHEFCHEFPFND@JAJ@H@RBJ@HBJB@BDBFBBBB@BBB@BB@@BBD@DBB@DBB@FDDBDBBBDBDDDDDDDB@DBBBBBDBD@BBDBBBBBDB@BB@B@@BBD@HDFBDBF@FBDBFBB@BBB@BBFBDBBDANBLHLANBPFLFLAPBRDJDL@R@LTNRGHFJHHPDFJNFNLLHDNDR@XCXMNMDOFQBeAkDQBQEQIQW_IUCQPcLWXYNSHKLKPMXOLMBS@_@OBU@YBQBCBE@A@EAGEIIGAEACCEAE@IAW@UDGJIJEHGHGDIDKAEACBAPHDBFBFDDDBDBBVHLCJGRGNBTSLAIV@R@LDVDN@BAB@FAFAFAFAFAHBDAB@DCDCDCBABCBCHfJJHD@@DBDBB@B@RE^OZEPLPHH@HEDEJCD@FDD@DBDBFDFBF@B@B@@ABABCJOJIFCB@@@FAD@H@N@A@ACAA@E@@@C@E@A@AAAAAC@CCA@ECACCCCE@CDCFCB@DCFABAFCBADADADADADAD@F@FAB@BABADCDA@ADEDAAA@AAAAC@CAG@EAC@GCOKMSY]CKCKKGUAOKQOLSBKCMKQIIMIMMIAKDQFS@QKSOEKLQJOLSDQEQ@mNS@OBQBSG@MAQESWL[IQHSHODQ@QAiGK@SFe@QE[EWKEK@QDMEOVQV@RETaFQDMBSFUNMFKNMCODUDOFQPGXAPCXAL_@OCMBWEMEOEMBS@IGYDSBS@KFMDUXUTSUMQOQ@KM@MOKQDYEMEMOAQOOMIWCOOU@KJ[NCSBMAMASUIDSDKHYIMGKEKGOgI@SLI^IRCJCJQEQKKQEMIAUDOFMLKCQBQLUL]EKIOF_NUTDRGLFJJJEDC"]],encodeOffsets:[[[87884,31580]],[[89246,30387]]]}},{type:"Feature",id:"540233",properties:{name:"亚东县",cp:[88.907093,27.484806],childNum:1},geometry:{type:"Polygon",coordinates:["@@GAYCaUE@QPORMPINGTKPIFOHOFABKDIBEB@BFRBZBRNZBNGDUBOBIHKLMPCLIPONAVAJIFM@OMKAGDQZCPAHE@O@IBCDB^CFQFCD@L@NGJEJGHCHAJ@HBJ@FBJBHFBFB@BEFALBH@HHRHTAPCDK@IBEFALAJELCNEJ@HFFR@J@JDDHBNFHJHNLHTB^CVHRLJRD@@RHLTLTANAJ@FDJ@FAFAL@J@HZTFFJR@VONCDC`F`B\\AVBHdZDDLh@PGLCHDRCbOTK`GNKDYCE@@FDL@bBN@JAP@NAR@LBRHFNDLFPJ\\PVPHR@RBPAP@VAPAPFRR\\FLEPMDMLEVF`JPVLXBTBTANFDRITUTGHBPDRP^GLERBPBPTZBN@BADABA@A@BBBBBB@DAFAD@DBBBFANANBDB@R@FAB@DAB@@@PENCREPAPATC\\AFCB@DAB@BCDCBCBCD@B@F@BBFAH@FADAFEBCDEDABAF@D@D@FBDBBBDBD@BADADAF@BBD@DADCDADCDAD@@E@G@CCCEEBCB@DAF@DCBABCBC@CCECACC@@MMCKDODMBKDUJUJWFQDKRMPATBV@NDJDTFRDTDP@VFPBLHLRPVPJTBV@LEPKD@DADA@AACAC@CD@F@B@D@J@DAD@DAD@DBHDB@J@D@DADCHCBGBADABAD@FBB@LKDEvgmTIBECI@E@E@EDEFKBABAB@BCBA@@JBFBD@DBFBDBF@D@DAHCD@B@DBB@@@B@DCDAD@B@LBB@D@DBPBL@J@D@BAFHAEKICOASDOAECGIECIKESQQQOMMIGEE@ETSBGBECEUGA@GCEM@IAIEIEKEOIQGKIOGGMMW[GKEIMYIIKQMOMMICeGOEMMICM@OBSCEAECGKEKMGQMGMKQOIUGKIIMEKIMCYAQDUBMFMCKMEK@I@KIGYIMGKGOCI@KIIEA[Q]MOQAIGEIC@ICK@W@ALGBCZQHWVQCGOSSSUMQGQAMCGECOB_@I@GGIGK@GAaZQLQL[DAD@HBJBDAFGBGDCZQRQDK@ODONKTOBGGEUQMKCOEcGWIA[EYGKGMGUIMG[MIIAOFeCQIMUKeIYIIC"],encodeOffsets:[[91158,27877]]}},{type:"Feature",id:"540234",properties:{name:"吉隆县",cp:[85.297534,28.852393],childNum:1},geometry:{type:"Polygon",coordinates:["@@AACAAAAA@B@B@@AB@BAB@BA@CBABA@CBA@C@EAC@CBC@A@A@C@@@A@ABA@A@AB@BAB@@BB@B@B@B@B@BBB@@@BAB@@BBAB@BA@UBWGA@GK]EYAWEM@G@K\\IAK@C@ABABABAB@BA@@B@B@@@BAD@B@BAD@B@@AB@BA@@@@B@BA@@@@BA@@@A@@BA@@BA@C@AB@@A@A@A@@B@@A@@BA@@@@B@@@@A@ABA@@@A@ABABCBAB@BC@@@AB@@A@ABA@AB@@A@@BABABAB@@AD@@@BA@@BABABECWO[K[@YB[H]HOJGPBNHNLPJVELO\\CPAPCZAPAREP@XFNFJPLPFdBLHLLDPHXB\\HFRHPNFJBRBNOx@HBJNNFLBR@NIPKLIHcAMFEHQXORSPOHU@iUOBSJKBMC__MMUOIKUYMMKMEQAMGKKGSEUCMBOHMDMBYB[CUAMGWI_SIAÕGFMDYAM@ULcPSFSHMHCPJX@LBPEJgNUDCFGJM`KVSTYLQDSBiBUFYHQLWGS@UAU@MDMGKMG@ODYXKJMPHPNJNFPJJVBNJNJL@TFPPHNDT@J@FLELINCFHZNRHLA^HL`RRPPPL@ZCRCNSPWHKNMNQPQPCNDXFTFZOFKFQCKVMVHPFJTBTHNVJd@NJFROVIVGZGRGPIRIJMNCLBPDRHNDPBRAFDL^LPJLJJNLLZJVBZAVJFNBNQPCLBLPNHJGPCNLFPPJJHR@L@TFL@@@DDDBBBDBB@BADAB@B@@BBFDBDDBJLDHBBBDBBDBB@BBBDDFB@B@B@@@BCFCBADAD@D@HBD@F@DAB@DCBABC@G@ABAAA@@BAB@BCDEB@BAD@F@F@B@NDB@JANGNANCRETGJGVDHFB@FDD@D@F@DBDBBDBB@D@DBDBDBBDBN@DBD@BB@DBB@BBBFDJJBBBB@H@BBDDDBBDBJBDBB@BB@D@H@B@FDD@D@DAB@B@BBD@DDFDFFDJHHFDBHHBDDBD@FAF@B@HBLBFDPHFDDBDBHBJBDDDDJJFDJJDDBDBBDDDBDDFLJLFHFHBDBBD@D@FAHCDCDADCBALAP@LDR@NDRDLAHCRDPATHX@RAPAP@VALEFCHEPSHGNKHOBEPKLAJ@TCNCZBTAJ@H@PFX@NEFCLMFKPEJAXBLFVHNAPAN@RBPDPDVJRDJGPKDANIPIVELERKBGBK@O@EAE@KBIDIJGNGLCPCJCNGNENAVDVBLHVHLGLELBLHRNN@NIVCFNBLFLNFNEJMNNAN@BAJJJT@LAP@NAVATANMHKPMPCNATCNCLCNIPKJGPEZDHDLHLDVAFANELELEPCT@T@XBTBVBZHTDT@XCJGRAROHUDKF]BKBOBKFOBWBIAIFS@OFMVETKOWIWKAMEWQKUKKMMMOSIQEMMQKOMEKHOLG`APQGKKOYCSASBaAG@QBSFQPMBSCKESEQQKQWUYHKHKJ]ZMJSHYLKBOHOHUHYBODWBUASCSCOCSBMHKJOVGHOHQDGY@CECAC@@FCHCBC@AAAĭ£BYB[BgCcFBGDGDCHETIJA@@FADA@ACAG@A@CAAACCCCEAGGAACAA@C@GBKBCBEAA@@E@C@ECO@C@I@@@C@C@ABGCAAAA@C@A@C@A@C@CAEAEAEACAACACACCCAAAEAAAACAC@ECGAEAC@E@A@CAACEAAAAEA@GAC@ECAAEAAAAA@A@AAC@CAAAC@@@G@CBE@C@@EEAC@IAAAACACA@ASIKISGMOAMRGVGNQFQFONS@YLKRHRAZC^WLGNSNMBSJSRO@KDUFUNKPKLMPO\\APANQJIJWLQHOFYTUAOCMAGSCGASAMG[K[KOOQ[QSKOAGF@`@VD^EFQDQBUDSDKFABA@A@ABEBA@@BA@ADA@@@CBA@A@CDC@@B@B@@A@CDC@ABA@CBCDA@C@CB@@CBA@C@A@ABA@A@@@@@A@AB@@A@ABC@A@A@A@@@A@AAA@A@A@A@C@CA@@A@A@@@A@A@@@A@@@A@CB@BA@A@A@@@C@@@A@C@@@C@A@A@CBE@CBA@ABA@A@A@A@@@A@A@A@A@C@A@AAC@A@C@A@C@A@A@A@C@A@A@AAA@CCCAAA@@A@C@E@@@CAA@A@AAAAA@A@@@A@@@A@AA@AAA@A@A@CBE@AAA@E@A@AAA@@AA@AA@AAAA@AA@CCAAAACAA@@AA@A@@@A@@A@@@A@A@A@AA@AAAAACAA@AA@@@A@A@AAAAA@@CC@ACCA"],encodeOffsets:[[87434,28957]]}},{type:"Feature",id:"540236",properties:{name:"萨嘎县",cp:[85.232941,29.328818],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@A@A@CEACAAA@CAAAACAACGIKCAACECAA@@@ABABC@AAAACAACC@C@@EK@S@KGQIIOOKEDMHOGIOMAKDKROAMEMUIYBUAYIKKIMKIOI]KCKBEAQCOGMCQAODKNMJIJQHOHQHYJUPUEQMIc@UIGMASISOEUGUNDLERELYPSEWEMCODORMRMNGLOXMTQDYDK@OOQO_QGKB]GKMQGYDEJMFKEKI@S@MCOGEO@SIKIMAMIUOIMEMIGOYJELF\\ETMNERHTCJKDOB_BADDLHJFJ@FCFCFYFQHODUCO@ODMHK@SOOF_PWFQJMPILMHWC[IOBOPAFCL@jBRF^HNHNFNHTBDDTBPDPDTC\\CFGLMNWF{IGDCRD`BRBJFFJ`N`EHYDKFMHEFALCZEREP@XDRDJLJRJXLHNDVAXOB]@BLDNHTDPN@TLDTHVDNFPNNHR@RJZZHR@XHNFNHNLHHNDNANIRG`IVBLJFRPZHNNJJLJJHJZLLHTJNJDB@@FPFZFRFRBJIZANOHEPKVAV@T@NGPCVITBRENGHQR@RHPFPANDLDPDNARFLXDNBR@XCLAZLPDRFVFPFPHPHNFZDPDPFNNNNTFV@PAVAXHLFPLNLJLJNHTCN@V@RDPGXCL@TKR[AWJERGRSFKPIhGNSZCLDTTDLFPJLFhNN@PCPAVFP@LLPJNHTJJNPP@ZBNFJHHBBR@RAZANERGLGNCZPR@JGDKNMNAN@dB\\FVDLGFQEKGQ@SPEJINOTG^AJINKFULOJILORMFSPQPCPQRQRITGRONKNIPIPMTIJIFQLGVK\\INFN@LILQJQTQVETIPERKDKASJQRITBZFPFPJLDV@LHPDTBVEVETKLGRSNKRCNFJFVJLNT\\NATFXBPD`RFTBPEPEPSRCL@NDLCNDTBLVXJRLPJVPFPVRGJMJQJORUN@LBFCD@D@BBBBFBHBFAJC@@D@ACBEACA@AAA@AABADC@ADC@A@CBA@ABC@A@AAA@A@CBC@AACCA@ABCVEPK@WDQPOR@NDFNBLAPNHTCbAVAPULSZKbINQBSHMLOPKPIZKLGFKLW@KCOAKDKBQA@AAA@AAAACAAAAAAAAAAEAACCEICC@CAAACAEACCGAEFIBAXCPCNAPGHIHMFMJOFKPGRCNBPMHMDKBKFKLONQDINIDILEJEJBZNLPRFPJRJRHRLDLDL@PV\\NJNLBLIVMHCLJRQLKJ@N@PD`FPJJZPHFHLJLDNJLHBFYLBVLLAGUEKIKGMAOBK@ODK@YGUSSOO@M@IHM@WAOCKDKAKEQGIIMAS@MAO@MASAEEOEMAKBMTWHKDSGKGKFKFKLILWDKHWLELFRERDRPPDNHNPANFLANLNT@PDLB`HRCNBN@^ITDNDPDTE^HR@LMPKFMNBJHRHPFPAJMJW\\P\\@TBP@XBPHNHLHRAJIJMNGPC\\BRDP@R@RCPKDIFKAUBOHIJOPODIBOCIMOOMES@SGKMOGMGKKG]EKKBMFQ@MAKMGQCCIHKPINKNEVOTEhKJKHQFMQSGSIKGKM@MRUEKAQEWDOCKIYSRSRS@G@EIGQEUCWBOEOASCYAKKIEQ@YJSAO@WCSLUFEN@PETBJAJAXEPALAPALE^CLGVQPQBIHWDS@SCYGUASAWAS@S@ODKFKFMFEBUBKCKGGCYCOFIHOLMJKDMDSDMBODONGLMNSBUBMBO@KBS@IIBI@ABMMMINMFMEEKAKEMUDMJM@QMKGKAKFKHUGKGUAUCMBMFMHIDODKDMHIHCJAJ@LBF@F@PALAHQLKFUFOJMJCBOLIHQCUIOCOCQAM@OBMBUGKEWAIBOFELKNEDMFW@OEG@I@SBYAMDSDI@KBOLAFGPMLGHOTGFEDKFUBO@OBQBW@SGOBQCGDKBQCMCQ@KCO@KBABCDCBCDGDEBC@C@AAACEGEGIKEKCCCACCAAACCCIIECIICCCCIAGACACAECOGECKAGAA@E@EBC@CAACGGCAGEIGECCECE@CAC@A@ABA@C@CCC@E@A@G@CAAA@CAIACAAACCAC@A@GAAAAIIECAA@AAA@CAAC@CAM@CAAAACAC@C@CAAACCACAE@C@C@ECA@GEUCIHSHQFMDMBMHIBA@MCA@E@E@C@ABA@CFADA@AB@@BBAB@B@HADABCDA@CBE@C@GAC@C@CBABEDAD@@"],encodeOffsets:[[86917,29904]]}},{type:"Feature",id:"540235",properties:{name:"聂拉木县",cp:[85.982237,28.155186],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@BB@@@@B@@@B@@@@@@@B@@@@@B@@@B@@@B@BBB@@@B@@@BBB@@@BB@@B@@B@@B@@B@@@@@@BB@@B@@@@@@B@@B@@@@@B@@BB@@@@BB@@B@@B@@B@@@@BB@@B@@@@@@@BB@BB@@BBB@BB@BB@@@BB@BB@@B@@BB@@@B@@@B@@@B@@@@@B@@AB@@@@@@@BA@@@@@@B@@@B@@@@@@@B@@@@A@@B@@AB@B@@@@@@A@@@@@@@@@A@@@C@AAA@@@WJSPSRMNQNQPKLORCN@PDTDL@VGNQNQFKLGRCREROVCNEXGJIDMFMDMDSFKDYFIDYNONKHGJOTIVEPCRCNEXAZAVCdCJEJCZAL@PEPG\\GPGBKAMCW@IESVEZGPKRIXIJMROB[BOPKNOLMLEVCV@LQPITATMNMTKH]XYDQBQGKL@ZMTEPERMRUHQHBNNPTHLJTJ@BDBDBBBBB@JBDFF@@@DAF@D@H@@BDBB@DBD@B@BBBBBFBBBFDD@HBB@BFBBFBBDDBB@F@D@FBHBFDD@DBBBBBBFBBDDBDBDBDDBFBFBFBDBD@B@D@B@D@B@BBDBAH@B@D@D@@@J@DDP@F@D@FB@FBDALAHAD@B@DBBBHHFBDDDDBBDBB@H@DB@BCBEB@@IBSJGFCDCHAHEDdAhA\\AZĮ¤BB@BADGDED@@BDFD@DHZRCPGHGPULINGTAPDTDTDVBXAPCZAVGPGPGLAZKTGNI^YLILGZGXVLRRRTFLFTDNAROTERAH@bBTATBZDLPHLOR_BKHGPFLPNRLNNRFTJNPNNLLLVXRNFLBJXPXXDP@TBZIR@JFLLZBTDPBPFXAVDRFJH@F@HQTQTZTLJPDXCRFLBVFNQN@HLJLHTRTJQNGPCVHTBJUHMHKBMVUPKTALAVFfA\\IB@^DVGVEJQJKHQJMLEHGBAHKLGJCNCLENCJIJMHQSCAMHQFUAKCK@K@KAKMQIIOMIIKIIM@KFKFQBOJMBOAQEOMIGEIIKICMEQBIBQDIHMDUSOOIOIEMLMFKAKOIAKBKAKCSCQIOGOOKWGSASAmHSKIKAKHIDMKYKIAQEKAGIMECECKEUJK@MEOEQJQRMJEJMLMJMDq@AIDUEM@SAUC_AOEOAOCIAEGKCAGGGULINCNAVBZELC@KAOBSCKIIUKCQEOGUIQGMMMOMCCKKUKRMF[@WAMASDEDWHMNAPCRIXI`BTIDUAMBOCSAIBKA]@WKMEAWGCKJKFIDOBM@KHQKEUEMEiOIKGKGS@AAAA@EAKBEACACAAAAAAC@C@ABE@C@AACCAEAECAAEAACAEACCCAAIAg@ANILOREJEJEPCLAL@HALETGHEDE@MGEEBWCOWOICIGMMKMIQGQEUYBSBgDGG@MDOJ]ASAOCUEUBO@@JOPKLODIBMFKBIBIDUFELKBAJGDG@A@C@ACE@CAAC@E@EA]EUGYIWIQEICC@@@ABAB@@A@C@A@@AC@CAA@A@A@EBCBC@CBC@A@C@A@A@ABABEDADABA@@@A@@@EAAAC@A@CAA@C@A@A@@@@@AB@BA@A@@BA@@BA@A@C@ABA@CB@BA@A@CBABA@ABAB@@AB@@ABA@@BA@A@A@@BA@@@ADABCB@BCBA@@BA@C@CB@@CBABAB@@A@A@@@@BA@ABA@A@A@@@AB@@A@AB@B@@@BB@@B@@@@@BB@@@@BB@@@@B@@B@@@@B@@BB@@@@@@@B@@@@@B@@@@B@@@@B@@@B@@@@@@BB@@@@@@@B@@@B@@@@B@@@@BB@@@@@"],encodeOffsets:[[88022,28624]]}},{type:"Feature",id:"540237",properties:{name:"岗巴县",cp:[88.520031,28.274601],childNum:1},geometry:{type:"Polygon",coordinates:["@@EJQvAH@NET@BAPAN@JAFBNBP@H@H@NADAF@BAF@DCF@H@F@DBBF@RAHAF@F@DDDBBFBHB@@H@F@JAFBLAHFT@FFP@DABGDA@@B@BDJARD^ALGREXKTBVBVFTHRJHPFNBLA^QPFNDTFZDXHV@N@PLBPDVJHRINKVHLNLJ^ATKPCTFNNRFFXAVFLXLRJNHPTARPFTBPAPELBRFNEHIFQDQHSLGdCXCHINKFMEE@@IAK@CBEAACAAACAAA@AAAA@AAC@EAE@C@CAAEQBEDIBEBC@C@EAE@AAIKS@MRBVBXF`EJGAA@C@I@G@@@AAAEAYCGAOIoSEA@CDGFIJADADABCBCFKBCBCB@D@VJDBBDBB@DAL@F@BHFJDPDN@JBJBHDPFF@D@@ADCF@DADADALCDCFABAPAJCFBHDD@FBBADCBABCBABC@A@EAEBCF@BC@CDAHE@AAACE@CBE@C@CAAAC@CCCECAABCDCFABE@CCAAC@EAACCGCE@CAAA@ADAD@F@DAJ@D@DAAC@CCCCCACCACACAKBCAAA@CBI@CACACEAEAIAE@C@EBC@CACAIKACAACAC@EAC@C@CAEAC@CAA@@CACA@CAE@OD[KOKCCKEYSR@JANGFEDIDEDADAF@@@HBDCBABABADCBADCB@FAD@DADAHAF@BADCBC@CFKAEAGAIBKFO@@RQBCBGBI@M@A@A@A@A@A@GBC@AAC@E@GBE@A@E@CAA@C@C@CBADE@ABABFDBDBH@HBBBDBJLDFDFFHDDDBNFFDBBD@JDD@DDBDBDB@LJBB@BAHAH@D@DBBBFBD@DBD@FADBBLNBDDDDF@DDDDBH^BBD@HGFU@GEICG@GLGFCDCBEBICIBAFBD@LEJGBC@EAEEGEGCCAC@A@@@ABABA@@BC@EBC@EDGFCDABAD@JEBCBA@C@ABAD@@@B@B@BABC@AAMSYAOAOFQHKO]CQAOHGVSJSCQMESBSAWAUKIOE_FUNKNCFOEKQ[EQBOBO@UBOAO@QGQUO[OOIKEMCGECTOL]JQBKHKRIPQNILGFEFGJMLIDOB[CSCKDSB[BMCQCMBEVOPMHIJGLCPEFIAIEEC@@W]SIAIBMFG@EAEQAECOGm@OAK@@GAaDKDWNGDODM@UGOGEKAQAIIGQAcGSEKESUCOIEIAI@UFOFYHY@GEMCIGKEMAICOGMGW@MIKE[O_QA@GVMVALGAIAGG@@IGMHGFEOKUCESB_LYHABBFBB@BDBHFHJFJBFAF@@CDCBAD@D@BBFBFJHBBDLBFBDBFBH@BBDBH@H@DBD@FBF@DBBBB@@BH@FDJBH@BDDBDBHDDDDHJBB@@HHDBHFHDXJZNBBBBANADADILAFAFAFBD@HBF@DADAFEHEJADGFCFCDCBA@KDCDEDADCDAD@H@FAFADAHBF@@CL@@CFAB@B@D@D@B@D@@AD@B@@AD@BCDADAB@@A@BDADAB@DA@AH@B@B@D@D@F@BAF@BAB@DAD@B@D@B@DADCFADEHEDCDA@DDDBFDF@@@D@DAF@B@FBDD@@DDDBB@B@BA@@DEB@B@@@BD@D@@@BD@BADAB@BDD@BBB@DAD@BA@@@ADAB@DBBDHHHJFBD@FCHAJCF@D@HBjF`B|FBABEDCHMFAVKLNPPND@BFLBJAJAL@BKrCX"],encodeOffsets:[[90487,29027]]}},{type:"Feature",id:"540232",properties:{name:"仲巴县",cp:[84.03153,29.770279],childNum:1},geometry:{type:"Polygon",coordinates:["@@KAQIMCSGMCKEQGMKMGMAOLUCIEEGKKGGCAEGIGDGFCFSKMGAEAKAMAUCU@WDU@SAOGEEICIIGEKIIMAAGGKGQIIECKEGASAGIQOIQ@IDMHINIBYAQAWHMRBX@JIDG@OGMAMBWNKNKNDTARGNEJQNGFEBEDEFED@JEJOBOCKDMJEJEHGFCLILBXDJBNCLCLIN[LIHALCLFLDNFPAJE\\KJEHKBaESFEJQRAFEJGJKPKNEN@JBH@RQTQHMFSIMCCAC@KBOBMFGDIHCPATENEHEFCNBJHBZFDFMRQLWJQDO@GBOJGPIROFIBIBICIIUOM@IDEHBFDJBHEHKJKDIBWDODMAYQOEMEMEKBILKLOJQHKLITEHGHG@GDIJEHEJEDI@aCSCUGMAQDMJCNBRCpCHKCMCYEQAMAQCUQQCM@a@Y@UBGFENCJBL@HEFEDSFWFOBOBKDGFALAHCNENKRQHQ@SAOAOAWEODEH@JFJNR`TLD^TBHEPIPOLe`ODOAMEUIMESGYCOBKBE@CBAB@DAB@DBBBDBBJBD@B@BDBBDFFHHLBPGHIHMD[FKJUrabYbOXOFUBMBQCMGIGIEKAKBEFGDGHGJGLINWFSFOFQFKHOTUZ[LaLI@GOCCG@GDUVQZKJIB[MQEOHKJINSLYJUHKHMDKAMGCAOASBW@_@QDGN@N^lBLMZHPNHPFLPDNGJQFM@CBB`@VEBMBSAgPGFEDD@FAB@D@@BB@LTFFPNFLRLPNRLJJNFLHFHFBDJBBBDHBF@HDDBHHDDDBDDB@DBBDBDBFBBBBBDBBBBBDBBBBFDBDBDDD@DFDDB@B@BABABED@@AB@B@F@D@DBBB@BBBBBHDF@BCLAF@BAF@FBBBDBBD@B@DBBBBF@BBD@DBBDFDB@BBF@B@@GDAB@B@@CDAB@DBDBDABA@ADABBBEF@D@DAB@BD@B@B@B@B@B@DABAD@B@DADADAD@B@D@BBB@lzBD@@BB@BAD@B@@D@FABABCBC@A@CBAB@F@F@H@FAD@BADABCFGDCBEBABA@A@IDAD@DAB@D@D@B@BAX@D@F@DBD@D@DBNJD@JDB@D@B@B@AA@CCSAA@ADCDABAAAAECACCAA@GBAB@BADEBC@E@I@CB@NCFA@A@C@AB@@@BB@B@FCH@DBFDPBBDF@FB@H@LCFEDCFAFADCD@D@B@HLFB@@HFDDDLDD@B@BGB@D@D@RAD@DBFCFABEB@B@F@DDB@@B@BAFABABABCDA@@BAF@BAB@@A@CAG@C@A@@B@DADBFBBBB@B@D@HADAD@B@FABAFAFALCLAF@HAFAFADADCDADEBCDA@AD@FAF@@ADA@@BCBABEBABAFCB@DAB@DAB@BABCBEBGBCBADCB@D@DA@ABAB@@@B@@@B@JAB@B@BBDBB@D@B@B@@@FAB@BBB@BDBDBDFDFBFAFAD@D@DBF@HB@@B@@BBDA@@DAB@B@D@DBBB@BB@@JBD@AHGFADCBABA@@DAH@B@@D@F@BBDDHFD@D@DAN@DBB@B@@J@JBFDHBFEFEJCDCDADEJCDCHCHADADADEDEDABCFADADADEF@B@FBF@B@@@B@BA@BB@F@@@BADABADCFABAFADABAFAFABAFCD@D@@AFADCDAFAF@BDDJLBBDJDFDDDFFB@B@@@@FFBD@H@JADCD@DFLFDHFFBFD@@FPPVBDAFKN@BILA@KFABCP@@DTJTD@@DJBFDDDBHAJA@AFBHBFBDAFADADABABCH@D@HHL@B@DADCB@@@BEBCH@HG@IBABAFFDDLDF@FBDBDLDNDDBH@DAJBPFFBABGDEF@FBDBANBF@DBJFFAJFDDFDBDD@DDDD@DCBEDADBJFJDDDAHCLCJABA@CAE@C@@DCHAFCDAF@BBB@FBPLJ@JHZJFEJMLOHCJGRILQ@GCCEIFG@EHIFMBKAK@MBG@KFGLEH@TCXBP@RCNIFQNMHGDELGNCTEXC^CTAJG@MIGMGSMIMCCFQE]DSHOFOFOHOHKHGPAPBLBTH\\JVBNALMFcLKLGR@LILGF@NCNDJPTZLRHXHNRJHNBPJDPFEJBJ@JDLJF@DNFRD@LDPFLHLJRDL@BDXHTBDDNJPHNBL@JCJGDWV@`@RDZCVCTAREJGLIJ_@SAYFMHGDMHOAIASG]ASBMHOJINKND\\IPSJU@MHKJFLBVKLGFKDMBGBENGJ@JANCHGNKLUAOG_OIIGGE@A@ADCDCDABADAB@DCBCBEDADCBCFGFCDCFADAD@B@@GVADCD@@EFABED@BEDABEDIDCBCDAHA@AF@DCFGHGFCDOJEDGHABCFCDBF@H@H@HAFILAFCLAJBJBHBRFHBFDFBH@F@HBJBD@BBB@B@D@BBB@BDBBBBDBBBNDJDDHLABBHDFBJBH@JXFFBVF\\BFAB@DAD@FDNAD@FAHAD@HAF@THXTJNDBJ@LCDALBFHH@JCDEHCHCF@DF@FCJ@DADABEFCDABAB@D@FDJHHFHFFDDFBBDBB@DADADEBEBE@ABADBFHN@HBFFDXJHDDBMJCDCJEZCT@FF\\BPBNCXCFCFCFABCNG^ELKLQnALD\\BZBDFXDJBDFLBBNN@LCFIHYVODOFQDSDUDQFGFOLGLINMLKHCCKCIAI@MDEBENAHGJCJCLCRCJBHHAHELBLFDFBFAJBHFEFCFEFGHENEF@HBFBL@LCRGJELBJBJBPBJ@JBLBLFN@RERGLAHBHDFFAHIDKDMFODIJIJ@R@LBPEFG@KGIEIIICGD@JFJHJGDG@MADJRHNFFBLBH@HBFDNBJDHBH@JELEJGDCLBHBLBHDHAFCHELGLEEKKGEGDCJ@PBN@NGDER@FLEFGJGHBHFJLJFBRJJBJHLDHCLGHBFBJDJFB@PHDJHPJHNHLLNDPDTDRDN@HEL@NIHKDMFGFONSTCXDRDP@LDD@D@DfKfEFGJB@@DB@DAD@BB@B@BAB@@BBBBB@@BBD@@B@D@@BB@@BB@BD@@`R`RFBB@BEJSDODMFGHETG\\U@@NILELEAK@ECEEKCIJADGHQDKDCPCHCHEHMHKDCPAX@PANIPGjGZAHBHJLDLAJEJCZCVCRENBZBbDR@H@\\BJ@JA^AVG\\UB@F@HBTLVHRDHFNHBBH@FAR@D@FAFAFCFAHCFEHEJEFEDEDCFAHAHAJAF@F@DBBBBBVFRLXNFBHAHINKNCNHJLRNHDLBN@ZC\\ERCP@T@NBJFTJNDN@TCLAVBLLLHJRJHDDTDNFNLJLPRT@P@NILIA@CCCA@A@AB@BAH@LAD@B@@@B@@A@C@ADCFCHAHAHAB@FBB@B@BABADEBABABABBBBLFD@B@@B@@@BBBBD@B@@B@FAB@D@FBD@D@BBDAD@BBF@DBDDFFBDB@B@B@BBBB@B@BAB@B@@@BAB@D@HAFAF@DADAB@B@BDBHDBB@FHL@@HDDBB@DABAD@F@DAPEBADBD@JBB@BBDBJDDBJHDBB@B@D@BABADEBAD@J@FAD@DAB@BC@CBCBAF@DABBD@DDDBJBDBD@DADCDAB@D@FBH@D@BBBBBBDBDAD@BADEBCDEDK@ABC@A@G@ABCB@@ABC@M@GBQBE@AB@DAHBFDD@DADAD@D@D@DBD@BDBDBL@DBD@DBDBDBBDDD@JLJDD@D@BA@@BAB@D@DBB@D@PCJABABEBCBEDEPSDCB@D@B@DBPFBD@B@BABCDEBEDCDCDADAD@B@B@DBDDDBDBB@B@DAL@DBD@BBDBFDJAD@BABEH@DCHCJADCDEFCDABEDCDCB@B@D@FCFABAB@BBFAD@BAD@B@F@BABAFABADAD@BABAAAAEAAB@H@DBB@B@B@BCDEJAFAD@HADBD@D@BBD@D@DAD@FDDHHNPBBB@DBDBFHBBBBBBDBBBBBD@B@DADAB@B@B@D@FBBAD@@@DAD@FEB@FABAFAFEBA@@B@F@BA@@BC@ABC@@HEBAFC@@BAB@DAF@BADAFCBAB@BG@CACCEBGFOZYJOAOMG@YHGPIZEX@jCV@V@TGFKNAL@F@D@F@D@F@D@FBD@F@DBFALCD@FCFA^KB@F@FBFBB@B@@ABAB@FEB@BCDAJCZMBABC@@FGNCHC@AAA@EAAAAA@GBCBCBEBI@C@C@CAAAACEAEAC@G@EBE@CBAAA@CCA@@C@A@AFCBA@C@AACCAAAC@@A@G@AACA@CAI@CAAAAA@ADEDKBMUO[QMMIES[ACGIAGEM@CHEDEAECE@I@E@A@AAAWKEEUUAKGGkOuPGFMLABWAKBED[AIDE@A@ACCGSMC@EFGF[@GAMGAAAIBIKMCKWKKAIAC@AAACBEAA£KCAAEAGQ]AAEAK@EAA@CAECKECAE@IAA@CCA@EAE@C@E@A@GCC@CBC@KDKHAKAAHAC@ABAHABAB@DCDAFAD@DAN@B@BABA@AH@D@@@HD@@FBB@@@HCB@@AB@D@FB@AB@BCBADADAB@B@DBDB@BB@D@DA@CBCDABADAF@DCB@B@DBF@F@F@B@FADADA@@BABAF@DCBADADABADCBAD@FABABABCBAFCBABA@C@CB@DAB@DADCDADBD@DAD@F@F@FBFBF@F@HAF@FAF@H@DAD@HCB@JAJ@H@D@D@DBDBFDFDDBBBH@BBBAB@BABA²RB@BADABAAE@CCECC@A@@BA@ABEBEBCBA@@B@H@D@BADABA@@CC@@BAB@B@BBBBDA@@BAAABAB@BAB@LGD@BAB@B@bOJCB@MHCFQDEFAFEBIAA@A@AACCAMCAA@@@ABABAHA@@B@@AAE@C@EBA@ADABABA@AAAEICICC@iHQj]@AImMcAEBK@ENCPKFCUWEGEICQBE@CB@HCHCJGDEFE\\JdL@@@ABA@C@G@ABAF@DAB@DCBEDCFAHIHEDCFABABABCBI@AAA@AAE@EBCBE@A@CCE@CAA@CACAG@@@@ACAAAACAEAC@AAA@@C@C@C@GBCDCFCDAD@B@B@BBDBBDBBBBD@D@D@BCDABABADADADABABABE@ADATOVONEFABAB@DAN@BABADCBABADABADCHG@ABC@CAC@AAACAIGAAAAB@BABABA@CAACIACAACAC@CAI@CBC@ABCB@BAB@DABA@CBA@K@EAg__YIGGEECGEAAICMQOKIGMMCEAG@OKKQEiSSK@A@@AAAEACA@EEECBKDSAG]QGAGAECAAACAICACCQC@CLO@@@@GMMOIMWKIKIQAQBSAYCUBEKC@@@A@@@ADE@AIIKAGCKCA@A@C@AAAAC@AAE@IACAGAAA@A@C@A@@TEDABABCDC@A@@AAA@O@O@CACA@A@C@KAI@A@ABAH@B@@@HELGFCF@JAHCFAFCDG@EDCJEAAECCAE@KC@A@A@AB@HCB@@@@EAI@AAA@AA@C@GAECA@E@C@A@AAACCACAOIAC@A@ABADC@A@A@C@C@ABABAXKDCB@@AAA@@MKGGIECECEACCCCAEAKEEKAC@C@C@EBADG@CBABA@AAC@AAC@ABCBCFCDABAZYFCBCEEICCCAEDEDCJAJ@HBJDFFDDZAFBJBDBB@FAJCJETIPCHBHFFBH@F@RAJCDABEDEB@BA@ABG@C@ABCBE@EBC@CAGBCBABCBABABC@CB@BAJAD@BABA@C@AAA@AECACAA@C@CBABABADCHGDEJGFCDC@CACECACBCHMDG@MDEDEDCJGLIBCBCJIBCFKBCBAFGB@DAD@D@B@FBB@FBB@BAB@BAD@B@FDD@D@B@FAJ@DANCFAHABABABA@EACAE@CBC@A@@COEO@SRMNINGPMFGFMBCHI@UAQCOEMSOFKJMCOFIDU@SCWHQBOHQESMIGQNKBQEQYBQBQ@AAGGEIAM@YOOIMSIMGOIKKO@UEOBODM@gMKEOIKESCCSDKTYHMJgLOTEHQFQXI\\BLQ@SDKHWCO@Q@UDMGSIMIKMKOKKEWGUBOBU@SEMMMMOEOCYCMEOGOGOEUEQEOCYKKBWDQ@MAWCEKBQCMCOCKBMEOGO@QRQHGFMAQJSDUHO@M@SBULUFOPGBMJYAIEQEQEYEO@@CAMISIKGYKGIIIIKMIGMOYEQKIUA_JQHMJMBMCGGMKMGMEWGQ@YGIY@QGQMMEOCMGUCSSKM@COGSCMAK_BYEU@UBMNKRBNFR@PIPWLSDWIOGGBQNGFM@[GYIO@MFQBGHAHBFHNDHCDKHSLMDWAICIIAAIGEAIDEDYLWD"],encodeOffsets:[[85932,30029]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
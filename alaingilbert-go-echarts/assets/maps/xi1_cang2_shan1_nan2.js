@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("山南",{type:"FeatureCollection",features:[{type:"Feature",id:"540502",properties:{name:"乃东区",cp:[91.761538,29.224904],childNum:1},geometry:{type:"Polygon",coordinates:["@@AMIGOMIYASG]EW@]LULYTOPDJHPFNCVEPKJKBQDMHIJG@AFCLIDOBGFMLOHOLOHINIHGLQBMDQ@QCSESFQJONELMHOHGHIBK@MFSLKBWDQ@UDM@WAQBMEK@MDQJO@E@C@A@AAAEAKCAACKEEEQCC@@EEK@@@BC@CBEBEFI@CAE@CACAEAAEAGBI@YAI@AAEGA@AAEICECCCE@CBAFCDAAAGIEECCCACCCCAECCCCCIACACC@CCEAC@C@C@AAEAGCA@A@ABAD@FADADABABCBA@CAKDC@ICCAC@CBCFAD@BFHHL@DBP@F@R@BD@LAHAF@FAFBD@@BADELCFIJADAFCDCDCDAB@B@BABCAK@E@KAA@CB@FKNOPWPYLWBYCYIYAUCYAaDWLQHHPLRNNJPCV@XFXDZAZ@THRLNFJNPTRNPJPFPIVUFWLGTFVBVCZEXCVC\\A\\DRCB@BAB@@EDGDEDI@C@ABEHMNGHCLCDAFELCFEB@BCDGJAHIPIJONO@W@SEKGIGKIOGIGKIQEOFMHO@SGGNERCPMVCPAVIROPKHCD@PPNTD\\DbDXJXHRLJTNZH`D^GZDVA^M\\I^CRA^GXE\\DTHPHJCNAPDPFLBPHTPETALPLRTAXGLGHYdDRBRDBNBLFLTAPAVCRFLJLJLFPARIFLLXDNJHREPHNH`A`@XERCNIDORAJJJNTDLCX@XGTALJREJELOJKNE@KEQKIKCOMMWGOBQNCP@PEEKEMES@QBS@UIQGOGMOUKSQIQGQGOOKEMMMCQCQEOMESEW@[GW@@GY"],encodeOffsets:[[94076,29995]]}},{type:"Feature",id:"540522",properties:{name:"贡嘎县",cp:[90.98414,29.289455],childNum:1},geometry:{type:"Polygon",coordinates:["@@KGCCAEBCFMFKBEBGAGACAAGMUIK@KHMPIFG@GB[D_JWJkXWRSRIP@BABQVIPGPIdETGZ@jAnCLELMXIJGHKHSF]NaNMJCD@DBFBHHJ@BETUBULWDU@_CYGSIWGYCYGUGSCSCGVFRFTDZBRJLFNNPFJD@B@H@LHD@FAD@DEJ@jTXB@@@@B@TB^PTLBDLCFKVBJHFJXFL\\DBPBJDB@@@FH@BFH@B@H@BDFDBHDHBJFDBFAHAB@BBBBBBDHFDJDJBDBLJNBPBXCTCB@DCPEBCFAHGLBL@^F^FbDdDdLHBRDZBZBVJ`FTJHNLP`B@@\\DZFZJbTPLXPNJVDTBDNCNCNFHR@TMNHHNLPJLRLPAPDTBLHRJPPFPHRPNJDXHNFNHPHRJLMEQDQFQFMFWFaAcISKSIQEUAS@@BEFC@C@aBIBCD@JADADIFW@GAGMQCO@ABC@CGCMEACBG@GBEHG@C@CBCDIHIHEBE@CACEECA@ASIGECCCIMWEMEO@WJaLSLOJIB[QQQOKOKU@[@_B_AcC_OSSMQMDYBO@OKEOCWCUDQASAM@Q@QDMFIHQJUHBVGNKJMJOJMJKNGPKJMLOBUDSGSEMJELIHOEKGKCOCQCQAKELKLEJIDOGOFMDM@EEIAGEGMCCA@@AA@CCEKIKCMBCCMCGC"],encodeOffsets:[[93046,29676]]}},{type:"Feature",id:"540521",properties:{name:"扎囊县",cp:[91.33725,29.245113],childNum:1},geometry:{type:"Polygon",coordinates:["@@QIMEMAOCOOCWFSD]EaCMWFSHQDWHO@MAWDELULSLSHUDY@W@QCQBKHKHWBQFKHMDKFAPCZRNTNPTD`BdA`@`@\\LVLPRPRRA\\IJKPKTIb@XFPFNNXDJDDHFTJ@BDBFFBD@DAFGFGJCJAD@D@DGHAF@HAHBDNFHD@DAD@BDPNRBH@HEXCJCBIBC@ADAJ@b@DEDAF@@BTFVJRLTJTBdEbEXENERCRFR~FNBxFNAPCVEHINORIREZDNEPIPIRETATHJLLLLPPJTFVFTFHJJNZBZHXJXNPHPTPNNHRBPIRKN@PDRNRAT@PHVCAQEYISHQFMGSAOEKCOBODMGIGOCSF[HWB]DQJ]N[B]CUHYC]G_MYISQKWGWIaC[CSCOM@ODCLGPOJQBUDONUDOFQHMEKMOMMKGKGKKKIQOSSM[AgFaCeO]UQ]GU@Y@SCOEUDUEYE"],encodeOffsets:[[93601,29718]]}},{type:"Feature",id:"540523",properties:{name:"桑日县",cp:[92.015818,29.259189],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@P[X[RM^UFOGOWMMUUIMMOODUGISIQEMEMEQGKNMFIPERFTDT@RCRANKRGHMJGJKPGPKPENAHCPKJED@BIHGJCNARILOLUFMDOEIGOCSPKZKV@^FXH^BTJZPNJHBNHZ@@HX@\\FXFTPNRFRDNDNNLFPPRHRHRJLTPVHNHPJR@VAT@RFTFNFLOFO@MDARHPNXPNLDLJFR@LNCVDVCN@DKHOLKRCRHJDFBFBJ@JALEJEJIFAN@JAHADBDDFHNHFDHNBBTJFBJBLLNFFDHDFANGNCR@`ERCRDLFJRHLJJLERGPAHJ@V@BBLFBJFFDHJBDJDJHHHBFFLDHD@HEDEFIHADBPFVBNDTFPHDLBNABILKPGPANFNPLHJ@BEN@PBDHP@DCTCLKHQXEHGJAJDX@FBFDFHBFBDBFFFNFNDHDLFTFJH@F@LMDWBADAHBH@HAFA@@B@BBH@HBNAFB@A@AAE@C@CB@BABABBB@F@D@DCDCDCFAF@@BLHTFNFTBLID[CYA[AWJWNKJKLGCMKMIOKQMMIMIKOKECDOGOCSBQBQAK@EKKKMBSJO@@BQFONETCRGVEHKVATBV@`M\\KVETCJEASMEEUOKQMIQU@UKESKOSKSQYIWKSIKGJKNKNGVMXKRENIJOHU@SKGSBQFSFQEKGGQSMKHKDOABKB[FUHWJSLWFOBYAIBA@K@GAEAC@CAEKNADCF@DADCDGDCDE@Q@G@IAIAEAI@EBMFIFKFCBICAACGG[GGIGKESIKAIAI@K@G@CBGBKDI@Y@EBE@K@M@EBCBEDEDGFEDABEFC@CBGDG@G@K@G@EAG@C@K@EBC@GDGDEDGBC@EACAGAKEEAC@C@@@MLclS@OMC]IO@AMIQMAAGQGWIMSI_A[@YBKMMQOQKQ@@@@CECUNY"],encodeOffsets:[[94251,29835]]}},{type:"Feature",id:"540524",properties:{name:"琼结县",cp:[91.683881,29.024625],childNum:1},geometry:{type:"Polygon",coordinates:["@@HSXKVEJUEOIOMOSQMOEIKMGQ@SBYCYEW@WDUIOMMKQGOO@SDUDMABOLMLICQMG[IQEQCQAMDMJGNGLKLKBOHQJQNOJOJMNQFUASCYAQAINALAPKLOBYDQ@KCM@GJKFMDSBOAQFQDMLSFQFMNIJIJMJDNFbC^ETDXPPPDNBNFRJZFVFVCPFTDZ@V@^HVRP^DfEbBhN\\TTRPLJLLLHLHNNNPFLTHP@NGPERFLJJHPHLJJHLHTFX@P@PMJIJOBGHIDC@AFADEFKBEDCDKHGNMFGBAD@J@FCHCFC@@BA@ADACQB[D[DUFWDYAUEU"],encodeOffsets:[[93964,29739]]}},{type:"Feature",id:"540525",properties:{name:"曲松县",cp:[92.203738,29.062826],childNum:1},geometry:{type:"Polygon",coordinates:["@@JNHXHRBBRNNJ@BJPD^PNT@dkNK@@D@D@FBLFHBDBFBD@HAFCHCHCD@FAL@D@H@FBH@L@H@H@HCDAD@FEBAFCHEFCFCDAFAN@L@F@FAZ@J@LCHADAH@L@J@JBLBTJLF@CDIBGBKFQBEJO@AOKIGICQAAA@ADCHIBCCMAECI@EAAIGEIAAA@G@AAGEEAQEA@ACA@@E@CAG@G@AG]CYIYB[BSHWXOZURMHSJOHWLY@APYL[JMDS@KAKES@WNUHONGPCPDNKPIZKJCD@BA@@BAA@A@@C@E@AACACCAECCAE@C@EACACCCCAA@C@A@C@AAACEACCCCCACAAACCECCCEAACC@@@@ABABADABCBEFCACCCCAAAAA@EACACACCECAACCACCCEECCGGEEEECCACACAACCAA@@A@C@C@@@CCAACACCCACCECCC@@C@QIGSJSCOQISBUHMHMJMBKDABABADCBABCF@DBD@D@@C@gAYCUCIEOGIAI@I@E@AC@EBEDCBCCM@GBADAHGBEBCDAFEBC@AAGACBA@@DCDADEBGBABCBCB@BABA@A@A@G@A@AAA@ABABADCB@@ABC@A@IA@C@EBEBEDC@EACEGICECE@E@ECE@GCAUA[ASBOHMJK@SBWHYJQBMPKXITKVQPKPIN@BCBCBCDADADAB_REAKCI@gTCBIBQDE@GDGDG@CB@FBJEDC@CH@HCNEFEFABHJBBCBEDAB@DDFDDDFFJBBB@FHBBJ@ZBJ@HAFBBBBFBD@DBF@DEJAFAF@DAD@@L@FF@@DDFRFFDLBBLDFBBB@B@B@D@FIPCR@NFLANBR@XCN@VCRAXKLET@NALGJGHGPRHNFNFRFTJHJCVPPNNVJNVXNHPEP]VQNW\\O\\A@MZDVDF@@@@LRPRNRLNZA\\@`BTJ"],encodeOffsets:[[94328,29901]]}},{type:"Feature",id:"540526",properties:{name:"措美县",cp:[91.433509,28.438202],childNum:1},geometry:{type:"Polygon",coordinates:["@@DQLILSSCMAOABU@QAKBOIKIK@AAAHIBKIUEMEKGIKGMFMBMAIGCIKIC@M@QDMIMFKDUFSJWJSDSBQAKCUA[@MCWEUFQCYAO@UCSE]K[KSMQGQGA@CCIECASEOESDQFMJOFMPCTAXDZ@PFR@VEVEXAPCJ@PANBNJLJHJJHHOTELALETILINKLIHG\\DLFLJVAZCLALHP@@CPFJDDH@@@BB@B@D@BAB@BABABED@@@BBBHHFD@@@B@BA@ABMDOHCDCBC@A@@BBD@DBDDDBFDDPPFFHFDDDFBBNDFBDBBBBBFFBDDBB@HBD@FDFBFBJBFBFBHFFDDDB@B@DBHDFBB@DAB@FEBABBB@@BBDBBBDD@D@FAHCHCF@HAB@@B@B@BCFAH@B@DADCHCF@BBB@@FAPE@@DCDCB@B@@BBDBDD@FBBBFBBDBBB@T@F@D@FAHAB@B@DBBBDFDHDH@BBFAD@F@HAB@BBBJDALJFHPRPHLDNBRDBTBFB@DAJ@TCPBD@DAB@B@@ABGFCD@BADBD@DDF@BBD@DAB@BABBDPBDFF@TELEH@B@A`BPLRFDBFKF@HBHBFCBM@GAEKIIGCQ@OFWFA@A@C@CBI@E@IDC@EBE@E@SBG@@BCHCTDRCTAVKNKJIJIFBPN@JJCL@PDRJXNXLPJPJVJTNTHPBPXCNBP@XGRCTGXENIJIJINMRETENKRCREPBTANCLEHIN@LDR@ZCPALKBOBKJMRBZBTDVBRENMPIPIRMRIPGLALKHKHMNINCRBRDRF\\JNHDRKJKNAPNBVCTCP@RGXKbCZBVDZBZJZDXAZKXOPOLM@EDAB@LBF@L@DBBA@A@ABADCDCDCBEBCJIDEFKBC@AC@EAEBE@GBKBC@@A@Q@EAO@CGKEG@ABCDEDAD@EAACACAAACCACA@G@CAECEAA@C@A@E@ACMAUNSNKDKBCFEBEB@BI@CFGFADAFCDABCDCDADEBC@C@CACACNGHADEBE@A@C@CCCEACAAA@AAABACCAC@E@C@ABCDE@AB@BA@CAAAAACACBCBCBCBCHCFADAJCJCDCAEAC@G@IBEDCFCDABABA@A@@FCD@B@DCDAHCJADAB@NIRUBUCMCOCQGQCOGSEMKIS@KDKCQIMEKGIIBUEM[IMHKHOFMDMFQBUDC@OAU@UFO@WCQEWCQ@[BKC@@C@C@A@AAAACA@@E@EBEBG@EBGB@@AHCH@DIPADCBCBAB@DAH@B@B@@@@A@EACA@@C@CB@@A@CA@@G@C@A@@@EBA@A@KBA@C@E@@AA@GCEAE@CAE@A@EAE@EAC@GGAA@@A@@@ECGCAACAC@CACAAAA@AAEGAACECA@AACAEAA@EBABCDGDCFAAGAEBEAEBE@CC@@AC@@@A@AB@BAD@BBB@BA@AAA@A@AB@AAGEEAAGGM@WBM@QAOEMEIQ@QAQAO"],encodeOffsets:[[93735,29026]]}},{type:"Feature",id:"540527",properties:{name:"洛扎县",cp:[90.859992,28.385713],childNum:1},geometry:{type:"Polygon",coordinates:["@@YAU@ECAKBKFG@IKMIIM@KGCEEGKMEO@GACEKIIMOQKQFKDMLQJIFI@KAOCOGGKWKGAOAC@@@@ADCDGBGDKBC@EAECECE@@EEIEIEECA@@CBCBGBCBE@EBGDI@GBE@C@AACAACAAAECACCAEAA@CCCCCECECEACCACCECACACAGACCCEI@@A@A@A@C@EAcBU@KDIBUDUD]FIDSLOLUVWLWPQNEFFDDNDRHRFL@HKHQTOGYGKCIAODKDIAQ@KBQFKJ[LSJIHM@EDGNINILMLKLA@GLGJEDCJ@H@XCJGFEBGCGCIAGBG@KDGBKDMFKFIDQJULEFMFKFIFKBIAGAGIIKECGCSKOEKEOGOAWAIEIK_WEAGHGFGLIHMNWHSBSHC@kRIA@@SKWOQESBK@QDCFINGFMFSBOBQ@QAUCMAIBGFERERCLINKHMHMJGJGJGHGJIHGDKAODEHG\\CFD@B@DAD@B@F@HDDBFFJHBBFFBBBBDAFCD@B@FBHBJAD@DBHALA@@@B@FABE@I@ABADCHCD@F@D@F@DABCDADCB@DCHABABABGHAFCF@D@FBDBD@@@BG@E@C@CA@BA@BB@BADABCDABCH@B@B@DBF@B@BBDB@DBBBBBBB@BA@ABABAB@BABCB@BAB@BB@FBBBF@BB@BBDDRBFB@@@RPTNNVLVC\\ARAVHV@NAPBRTJ@NGTGHOJA\\FNPJTNRMXQ`OT@XAZCZETGRDPLP@NGNKHCNAPAXBR@XHRLLLRHN@PBTBZ@RCTGVBXDPDHNDLEVHNPVTJPDR@NCVCXATCXBZBB@@@BBFFB@B@B@JEF@DAL@TAD@H@B@B@BBHHDDDBDBHBFDDDBBHDBBDBDBFDLDBP@FJVEJ@HELAJDFbPNJFJJFF@FCBED@B@DDHBHCDEBAD@FBDBDBBBB@BABABADCBADCB@B@D@BBBBFDDBHFBB@FADBB@B@BBB@@BBHAJAFAD@DADA@ADABCBAFCF@F@B@@@BA@A@E@@@@B@B@D@F@F@F@DBBBD@D@D@DADCBAH@H@JAD@B@BA@A@A@E@ABADAD@HAB@BA@A@E@C@C@EDEBCFEBC@@BABCQI@EN@DBJ@@BJAJ@F@DDB@D@H@B@B@DDBB@B@B@BBBDDDBBB@@FCBABEBAD@DAZEPBHFDDFCB@D@DADCPGNCBAB@@A@A@@ECGGAA@A@@FCBABA@ABA@A@C@AAA@@G@CCEIDO@@GOBKDKBYIUEKCKH[JGLKJMJKFSBKFKPSGGIIIGIKAMBM@ODIBOFWFU@UEQ@OCYBWDSNOPENIRETCPFTFDBJFDDB@RHRHTN\\L^LTFVDP@ZBRDVEXFND\\@VBLDRBTATCXITIVELCNELYRKNKRGLKJMAMGIIKMQPQNSAMIGIGQCMA[AIGEKCMBKAMOQOIGOEKEIGWEUMDOHQFIHON[@KTQL"],encodeOffsets:[[93529,28735]]}},{type:"Feature",id:"540528",properties:{name:"加查县",cp:[92.593993,29.14029],childNum:1},geometry:{type:"Polygon",coordinates:["@@[UQWCGIOEOCM@SCYIMKQSGABEDA@CBA@E@C@CBABABEBC@ADABCDABCBC@A@A@C@A@CACAA@CAEAE@EAAAAAEAA@C@ABCBA@CBAAC@A@ABA@@BC@C@CBC@ABA@CBE@C@C@A@EBADAD@B@B@DBB@DC@CBC@CBAB@B@DAD@DA@@BCACCCCACCEEECCA@C@C@ABCB@F@B@BCD@@CACACAA@C@ABCBABADABADCBAD@B@DBF@BAB@BABC@CBC@C@C@A@CCCCA@@AC@A@C@EBAACCCCAACACAAAAB@@ABC@IDYLOJMLOCODMHGPMV@XFTBL@LCTINK\\OZ@BKZGXIPGTQNYVWPGXATA\\JZDZH^@B@HBH@D@FB@BDB@RFFBHFBBH@B@BBFJJHBB@FDJBFDNADGJCD@BBBRBJDJHPL@BIPAFERALAHCJ@DJHHHH\\DHBBJDDALEJENEFAJ@FBJBJBH@R@F@DCHCDCBC@CDEBCLMBF@DBDBF@H@LABBJAZEPKXITGXEVA\\ALPBLCLGTNHRLHRFTERETALH@TGVIPMJQFWLUNMHMLILLHTJXLZJTRTLLPFTVLV@JRRNPLFVNFBTIFSDUF[L_NU@SAUBGLJF@N@XNJTJDRCTBPNRJPNRPXJTNJPLTFP@TANENGLHLRPRRLVJVFXARENGFQNKDMAORIVAXAVBNFNLJLNNRFTDNCZCZADPBNJLNJ\\IVHDLJLLRNDRIRKVAPCTANGHOPMRITGPGNINMJQJOAKGMEQEWLOLSVSNONMVKTGB@VAJMBOLGVIJQDSBUFUNMEKSKKKAMBOCQCSJSJERGFQCY@WBYLGRKNICOMICIGMKGWEUA[CYFECAIAMIIGEOAAAAIDQHKBCAQIQCGGSE_KQGGCAKCUKQQUOQMMKIWAOESEIACMEMMCMKOaEQ@W@[EUMIOMOQKCCCCKIWIYKOO@QESAQKYGYCYQWEaLUHMI]IKEEYYaASDYM"],encodeOffsets:[[94937,29604]]}},{type:"Feature",id:"540531",properties:{name:"浪卡子县",cp:[90.397977,28.968031],childNum:1},geometry:{type:"Polygon",coordinates:["@@AQBO@MGUBUBQD[KUMUSMQO@@A@AECQAC@AAAE@AAEAA@@ABA@ADABA@ABABABAB@@AAAAAAACAA@AC@A@AAE@C@A@ADGBADCBABC@AAAB@@ADBD@F@H@@A@@ACAC@E@CDEBEHGBABABADG@CDABCDCBA@C@E@C@EDCDGBCBAJ@F@BA@E@A@@KBGBCAC@IBGAEAA@C@EDCBAAAAEEAAIGEECAGCE@A@C@CBA@C@@@EBYCOCUCUAKCOAK@MBUDOLMHKBGFCHDbDTDLDFDHDDDHBFBF@FDTBHBJ@F@F@FBFFDBDFDDDBDBD@F@D@D@@@D@F@BBDLH@D@BBD@DBDDDBBBBBDBJBJ@@@@@D@BEFAB@DABABAF@@CBA@DF@B@BAD@FBBBBBD@D@@ABADAB@BBDBBAB@DA@BB@@DBBBA@@@G@]@M@GBI@IAOCEAECCC@@EDEDE@MBIBCBAAAACKAAC@C@C@EAA@C@CDAFEFEHABBJFRBTGHKCYIULMTCPDLBPBVGT@RBNCRKLEPDLNHRHJFLHVDNLHP@NMTDTDXIVMXPFATGTGPINMLMLKDMDOAKCGHDR@RFPRTNHPFLJNHB@@@BBB@FBDBFBDXNLVJTJLRDBF@B@FAD@F@D@DBBBBB@B@H@B@BBDBBDBJFBB@B@BABAD@B@DBD@DADCLADADC@A@ABCB@D@B@DBDBDDHBFD@B@BB@@B@BFFFHFFEDCDCB@J@DADA@@BJAHAD@BDBJBDBDDBDDFBB@DABBBBBDBBBBDB@BDFR@FADADCFCHCH@BBD@F@BEFEJILBPCH@BEFCH@D@B@DADEHCBCBABADAFADCD@@@B@DBDBDDD@BBBAH@F@@BDDHBH@D@BABABCBC@A@CBGBEBC@@BAB@B@H@L@DBDFFBBBD@D@D@D@H@DAJ@DB@DBBDDBBBDDBDDB@@D@H@DAD@BBB@BB@DBB@FABABAB@B@B@BBBB@FFBBBDB@BFBFADEHGJAD@LAD@DB@FBJDLBHDHJDDAFDHPJPJFRCRCTINKRMRCXBPFVDTBFFHFTNPTBXDPLJPLNLJLLFT@THTHLBPITLHRDNFLFNLDPCPFH@JDJDDBBF@BAFCFEHEFADCZEHCFE^_FCXSFGBCHEBCDIDCD@DAJEJAH@B@@@PFjPJFHBH@D@B@NIEIMOEMIKAQCYESEQHUTDTDVHZHZDXHTJZH`DV@XCVKVAFS@AGIAGAE@CDCNIbM^MTELGHGJINWFKDKBm@iHYFSJcHOJORUBA@AJOTQXQlWXI`I\\CHAH@JENOLGL@VJHNBBBDBHAHAFELENADBFDDLHHDNDDDNALDLJDF@DBB@@DBNDFHBHFJ@FCNENHPCPIJKFKLLFRBRDPDLDLHPFJGFKNITFTHVCPANKLIHOLMNIPINILIHMAUVGRIJGNERCR@N@TBRBVCXDPDLF@PLENCLGREXALGLGRARDX@Z@VCTGTKVKFKAOGOMSISIUIOKOMWIWCQ@ODKIIM@AOJEJILILMBUDSCQDSDG@AH@TAF@F@FAD@JCF@J@DAD@B@B@XEPER@HDJJFLHBN@DAAEAG@GLEAEECKQAOB_A@G@KFSFE@CEOAACBA@ABA@CAC@ACE@CACBC@ADCHEBA@@@ABA@CACDO@SBI@CEASACAAQCMGKQOGOIEBKICAA@ABA@G@EBCAE@ACGCGCEAACAA@A@GBEBC@E@S@A@AAACEAAAEAC@ACAC@AA@A@CDCD@@OFEB@@AA@ADEDGBC@C@ABGDE@A@A@AA@GBE@GDGDEBC@C@ACAAAC@AA@AAABEFA@CBA@EAGCCAA@A@CCECGEEAEAIAEAEAECC@GAA@CAACEEAAAACAEAMCAACECCGEEEOOCCAECCAC@CAC@AEDCCGEOAYFCBC@ABAFABED@@AACACCAA@A@A@AAACCA@A@G@C@A@CCE@I@IB@AI@CAM@@FRJADAB@@ADEFADCF@F@D@D@F@BABA@GBC@CBAB@B@F@B@BABA@C@IBG@G@ABCDCBC@C@C@AACAE@E@E@C@A@A@@@@@@F@BAB@@A@E@E@EDABADCB@BCBCBC@EBIBGBAA@@AA@A@AAABC@EAAGECAECAAAAC@A@A@CDABCDABABABA@AACACAEAC@ABCFGDGACCA@C@AFEDE@IEEIMIaOCEBIFK@GFIIU@EAOKCECCACAAAGCAACCECGACACACCGGAAA@A@G@C@SBK@CBE@IFA@A@A@EEAA@@A@YAWASDWBUDMDQ@OCSIOUGMFUCKGMOCWCUASHQDY@SAOAM@QGKKQKWGQ@WAOBMBGDMLMHO@OKQCSHYFYDWBS@_PWRQNSMOIEMB[PIHGHS@MSI"],encodeOffsets:[[92526,29062]]}},{type:"Feature",id:"540529",properties:{name:"隆子县",cp:[92.463308,28.408548],childNum:1},geometry:{type:"Polygon",coordinates:["@@LNHLDFAFUdBLEJDH\\\\\\PBHBHEH@bAD@BG@UAOB[DI@ABWJQAWAM@MAUFWDYFWBSBYAWA[BS@QBQDQEEIAMASKCQDKHODMDQNMRUFSAMGUEWCQEY@SCKASIMGIMBKNGFIAOGGMIOCODQAOEUGSEWEUMOIECGGMIQGWFGRQZKPYDQAUBDXJLLZB`KXMbUHWBQPKTSLSBWGOHObQVQJQFOHAPBT\\JFNAVJJLHNFRJLDLCT@LJFNHTDPHRDRDPDNAVQVMJA@CBIBGDCBCDA@C@ED@@@BABABCBEDCDAF@J@HBDBFCDIDIDCBEBGDADADADADBDBDBBBB@DABA@@BCFAD@B@D@FBDDDABBB@BBBDBFBDD@D@D@BAFCFGBMHBDBD@D@DADCFCBCDADCBEDCBEBEH@DAJA@AFEFADCLMLMTBVDN@B@F@B@DBBDFBF@D@HDBDBBDBBBDBDFBDBJDD@LCDBB@DABABABCBC@EBCBAB@B@HDFBBBD@D@D@FBDDD@BDBDDJDDDDBFDDDDDBDDFFBAFEFEDM@GDGD@FCAI@EDAH@HCHCF@RCJADAhSJ@LDFB`QBABCBCDCDADA@AJMLOROLUJSLWNORAZIXGTAL@NIPGTA\\BVBDB@HDF@F@FDFDFHJDFFBD@FCFAFAD@B@@J@BAD@BA@CDABAB@BBB@B@B@H@B@BABABA@ADADABAHCFCBCD@@ABBDBH@BADEFCBADAFGHCBAB@HDNADCDAF@FBDF@J@J@JBPHJFVDZDhBD@@@@CAC@CDEBADABCBABALCNANINGVGTARJDPITHTRJD@@@DDFDDDDBDDDBBBDD@@D@D@B@@@BBDDBBBDBDDDFFFFHHDDFFDDBDDDBBFDDDDBDBFBB@BBBBDDDDDBFEDABABCBABA@@@ABCDCDGBCBC@A@C@C@AAE@A@CBABEBE@CBABC@C@ADCBCBADCBEBABABABADADCFADCDADCDADADCDCBCBABCB@BAB@BADAFABADCD@BAB@BBB@B@BA@E@CBC@ABAB@DBFBDBDBHDDBBBBFBF@D@DBBDBDB@B@D@DBBDBFBDB@B@FCB@BDDDDDDBBAD@B@D@BBDBD@FBDAFBD@D@D@BDBDFDFDF@BDDDBBBDFBBHHFBFB@@B@JAFAB@@AD@BADABADABABADADAFABAB@AC@@@ABABADAB@F@DAB@DAFAHADADAFABABAD@@AD@BAD@D@BAD@BADCDADABAD@DAB@@@B@@A@CB@@A@@B@@BBB@@B@@A@A@@BABABA@AJEDCDBHLDBBD@@RGXDRCVELATDTKTIZB^CZCPSJOHGFADCDA@@BA@CBE@A@A@C@C@C@CBA@ABAD@B@D@D@DAD@FADAB@B@DBDBDBDABADABAB@DBF@DBF@DAD@DAB@B@BBDDBBDBD@DAB@D@F@B@@@@@BHBFBF@JFH@LBFAFJLFFBBBB@B@HBFHJH@HDJJDBJ@LDLJHAB@NCVKD@HNB@H@HEBA@CCEAC@@BCBABABABADCDCBCBCB@BCDA@ABABCBCBE@ABBFBDBFBFDFBDBFBFBB@D@DABAFADADCF@H@F@LCTAbCKJxAdBZCbE`THFVnBB`JpIL[LWNQZMB@dB^JVARC`ApQJgB@@AAACCCCCAAE@EBAB@DAFBFDD@L@NCNAJCJA@@F@F@JFHBHBJBFAHAD@DBFBFBRBDBVCRAVHPFXFZAVKHeVCbRJ`C\\NXPZTNKRUJ_NMHBRHFBD@D@BC@ECGCC@CBADEBCBE@EDEFCFAR@N@HAFCBE@GAKDGBG@GDEFIDCLIDEBCDAJ@JEDEHEJANCB@@ACAAC@C@CBAFEFEDABC@CA@EGAABABCBA@GDC@@AAIMAGAEBA@GAAEEAC@@BCBCDE@EAKDCHGDEBCCEMEACKM@EFKAEAE@ABCB@HMJIBE@CAEAE@E@CACBSBEDIBIDGFGFCJIHEDGD@F@B@DA@@BC@AD@BAFAB@H@D@B@B@@ADA@AD@F@B@B@BCD@B@@@BBB@HBB@D@BABCFAB@B@DDB@D@N@B@B@@@DB@@B@D@@AH@H@FAFABABAB@F@DB@@BB@BF@DBH@H@H@F@FAD@B@H@B@BA@@@@@AAC@ABCBAB@F@D@@@BA@@AC@C@ABE@A@AAC@A@@BCBABEBABABABABABAB@BCDEDEBCBCBABA@@BA@ABEBCBADAPQLSHCBABCBA@E@CBAFAFAD@B@FDB@B@HCJAF@DADADAB@DCBCAA@C@A@C@ABA@AB@BOAG@AACAA@AFE@A@CAE@E@AAIAAC@GHKHKHOFIDIBG@I@GBIDIHGFEHMRGFEBE@IBGDGBGHEFGDIDQBI@G@GBKDKFGBEBEAEAIBI@YCC@ADEHGHGFSVGDGBCAICOKKOGGKMEGEECAA@IDEDKDIFOFGBG@K@GAGCICEEEGGCE@CBEFEFIDIDMDODiPQDGBKCCAEEIGMMGECIS[IKAACAABGHGHGDIHIFEDGHEFKFIFGDSDOBMDUHSDUFYDKBM@Q@O@I@K@K@QAMAKAGCMAE@I@IDGBK@GBIBIFCBI@OBA@IBGBGFUJG@MBMFYJWHOBK@A@C@OFOFYFMDcNGFEDGBKDcNIBGBU@I@IAEBE@QGOGUIEAE@IBEBE@OAGAMFEDGBG@IC[CK@K@KAK@IAEBEBEBOPCBABE@G@IEOGOIEECCMEQICAA@KBI@IBOAOAOCG@GBCBA@CCGACAAA@EBG@IAQB[@CGEQKIEOOEC@@EEEI@ACAAAE@E@CDENCJILCD@@[RE@CAUSC@ACkQWOKOQgWKECCAIEEEQIEAEAGAEAGCECOOE@E@AFKNGDE@IOSGMKK[BQ@WKQCKCQOKSG_CKASAUBKBC@I@OBIBKBEBWAiCA@CBE@E@E@GAE@GA@@C@[@mAk@eFYNeVONCB@V@T@@DZDHDPD`CV@HDBVDN@DD"],encodeOffsets:[[94765,28879]]}},{type:"Feature",id:"540530",properties:{name:"错那县",cp:[91.960132,27.991707],childNum:1},geometry:{type:"Polygon",coordinates:["@@AgEKqMgIUMM[AD\\FdFb@D@^ERHHDFJN@V@PSXSXCbONCDMJKT@TIPQH[DSLOVK^CZAd@NBLJHHTAJGdBR@DJNLJ\\LHJFT@HNX@lLV^`NBR@PFNDHFCHADMJAZWhS`O`ObGRQfIVKTYdGHSLKLErGHID]IeGiCS@mLZLQ@IBQmQiMMES@WB[HaPkXOJS`IBK@Q@Q@OBOLO`KFSDWJENCVKPOPAPJPZT@FOLKJGPENDXNXNJPNRPVRd\\LREJRXDH@XAVHJHJAZEPCTCNKVY^MDM@uMYBWHOH@JFLLXFPJHTDbElDTBLZIVOV[PQDWAUMWGADAVALUTSAQLMNMBYJSFEJ@TDJ@DHV@B[JOP@ZELQHU@KGGAEDCLEHIFQB[RFVHXFJFLHPPJPRBNALDNFLJH\\BNBRDJHJHBNMTORNRJLHJBNINKLQHMLQLKZNJRCN@D@LJDJJHNBNANELHHJFLFNJVALGJBB@BJLJLAPBL@RAVPBNBTDKTKJCRBPBR@RJRNFPFRBN@XAN@HHBBFFBH@BBAB@B@BBB@@AAA@ABC@ABAB@@@D@@BD@@DAFBFAFBFBHEBCDCHADAB@FBBBFBD@BDBDFBBFHBBB@BBDBDBD@DBBBHDFD@@B@@@BBHHD@FBF@FBB@F@DBF@FBHDB@@BF@D@B@LAB@B@FA@@B@D@H@@@DBB@@@DAD@@@DBFBB@@@@@@A@ABG@CBADADABCJO@CDGBG@@HAFAH@FAFAF@@@DBBBBBB@D@D@@@LD\\AR@XDRFXDP@VEV@PBD@VCRANENCPELGNGASBOPGRERIRUPaPGXHTATKLSROXAVGNaLWA_KYIKCWVARBZCLORYHQXERHNJHHFDPJVNXFTFVHPFRBPCPDNJHHBPEJMHALJNNHTJLBTDZ@RFXDVFNHTBVENQRMNCPCLGRCLDBTBNFJRFRCRAT@\\AXBZBTAXAZEXCVENBN@XBRBXIBAJ@\\CPAVBH@@ABC@aFGAGAG[O[[CGFIAKVcBECEGKKMCCM@UCCA@GDUC_COCGCY@@@S@UDAPMfUZMfEl@nB\\@D@@@HBF@HBF@F@F@DAB@jDXBFALAJAPAJ@D@LAVATBLB`DTHPLDRDLLR@XARL\\NLTHJPF@HCLMBEF@F@PPFDHDFBHBFBFBRJFFJFDBFDXLRhLPXPlRBDD@VTDBF@\\Q@@DCJKDIFMDCF@F@BBDB@BFJFF@@FDPPJFRLHF@DA\\BR@JAH@FBBDBHBDDB@DAHAH@PDPBPBJAJ@LAB@DBRJNFDDFFPJPHJFH@F@BADAPOFAFAFAJBL@LBL@L@\\DJDH@HAFCNEHBPBF@FAJAF@FBVJPHRHF@FAJBJ@V@HAJAdMLCHAFCHEdMNCZEPEPED@B@L@PAXGZINENAH@VIHEHAJAB@PAJ@DAJEJAHAL@HAJCJ@F@NBHDLBNBRBL@L@J@P@R@N@LAZCVETCVGNCPATCHCJELEFEHGFCJEJGHCHGHGBADBBBJLT\\DJHFNNJHFFDBLDHARCjOPCNCJCJCFEFEDAF@HDFHFFJDHDHBL@H@HAPEJELCFCJCB@DBFFFHLNHHLPPLJDDBHAHCTUHEHGFGBCD@ZDJ@JAFBFBFAHALELCHAH@J@RAJCHCFEHGHAHCJAF@FAHENQFGHEJGJCHAJ@H@JAJCPELGLGHGD@CAAAA@A@C@@@A@@CBAB@@A@A@CBAFEDCBA@A@AAC@@AA@@BCBADCBA@@@A@AA@@AA@C@AAAAAC@@@A@A@CA@@C@ABE@ABABA@A@AAAAABC@C@C@A@ABC@CBABABABC@A@C@EACAAACCAAACAEAAA@CAC@CAA@@ACAAAA@ABC@A@A@ADCB@D@B@B@BCBCBADAFAD@@A@A@@@A@A@CBE@@DAB@B@D@DAJIDC@C@C@C@A@CDCDCBABAFCFABCFEDCBG@C@A@EDODG@@BE@A@CBG@CBCDEDABADAH@LAFCDA@@BABAB@BCHEFAH@H@L@LBJBD@HCFGFA@@@@@@BA@@@@@@B@@@@AD@@@@A@@B@@@@@@@BA@@@@B@@A@@@@B@@AB@DABAFCB@BA@@BA@@B@@AB@@@@A@@@@@@B@@A@@@@@@@A@@@@@@BA@A@A@@@A@@@CB@@A@A@@@@@@@A@@@@@@BA@@@@@@@@@AB@@@@@@@B@BAB@@AB@@@@@@@B@@A@@@@@@@@BA@@@@@A@@B@@A@@@@@A@@DEBG@ABA@CBG@A@@@C@C@@@@@EDCDCBGBEBA@@BC@C@C@EBADADADCBE@A@@BEAGAEAEAC@GAE@A@@@C@EBGACCCAA@@@A@CBCBCDABCBA@@B@@@B@@@@AB@@@@@D@@@@@B@@@@@@@BA@@@@@@@@@@@@BA@@@@BA@@BA@AB@@@@A@@@@B@@@@A@@B@@@@@@@B@@@@@@AB@@@@@D@@@@@@@B@@@@@BAB@BA@@D@@@@AB@@@@@@@@@BA@@B@BA@@@AB@@@@@@@@@@A@@@@B@@@@A@@@@@@@@@A@@A@@A@@@A@@@@@@@@@@@@@@@@@@A@@A@@@@@AA@@AAA@A@@A@@AA@@A@@@@AA@@A@@AAA@@@@@@A@@@@A@@A@@@@@@@A@@A@@A@@@@@@@@AA@@@@@@@@A@@@@B@@@@A@@@@@@@@BA@@@@@@BA@@@@B@@A@@@@@@@@B@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@@@@@A@@@@@@A@@A@@@@@@@@@A@@@@@A@@@@@A@@@A@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@AA@@@@@@@AA@@@@@@@AA@@@@@@A@@@@@@@@@@@@@A@@@@@AB@@@@@@@@A@@@@@@@A@@@A@AA@@A@@@@@@@A@@@@@@@@@A@@A@@@@@@A@@@@A@@@@@@AA@@@@@A@@AA@@@@@@@AA@@@@@@@@A@@A@@@@@@@AA@@@@A@AA@@@@AA@@A@@@@@@@@@A@@@@@@@A@@@A@@@A@@@@@@@A@@@@@@@A@@@@A@@@@A@@AA@@AA@@@A@@A@@AAA@@@@@@@@@@@A@@A@@@@A@@@@@@@A@AAA@@@A@@@@@@@A@@@A@@@A@A@@@@@A@@@@@@@A@@A@@A@@@@@A@@AA@@@@@A@C@A@@AC@@@A@@@@@A@@@A@@@@@E@@@A@@@@@A@@@AB@@@@@@A@@@@@A@@B@@@@A@@@@@@B@@A@@@@@@B@@@@@@AB@@@@@B@@@DA@@BA@@B@@@@@B@@A@@@@B@@A@@B@@@@AB@B@@A@@B@@@@@@AB@@@@@@@BABA@@BA@@DCB@@@@@@@@AB@@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@B@@B@@@@@BB@@BB@@@@@@BB@@BB@@@BB@@@@@@B@@B@@@@@@@BB@@@@B@@@@@@@B@@AB@@@@@@@B@B@@@B@@@@@@AB@@@@@B@@@@A@@@@@@@@B@@A@@@@@@@@@@JAHE@ICEUCUGOIMGMMKKMESQWMSQUQQIQI[QOSOUCK@EJGPOBQ@MFGDELENCFAFOSSmQUQu{KMsoyqMIoqMKaYi[]Wc__aY]iqcgmigkacYeE]Dm@C{GsMMII[OqEkGqEYUQiUaQgYaIyEOCAeAsEOAEGOAGIiAsESEQESKmImA]BoHSDgLqNPqJwFqEkG[C]GMYFaJSJ\\bcTaJe@Eg@qEgIC@]KmM_MyOMSCgE}A"],encodeOffsets:[[94592,27548]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
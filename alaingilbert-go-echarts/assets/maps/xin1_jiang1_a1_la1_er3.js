@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("阿拉尔",{type:"FeatureCollection",features:[{type:"Feature",id:"659002",properties:{name:"阿拉尔市",cp:[81.280527,40.547653],childNum:1},geometry:{type:"Polygon",coordinates:["@@_IQWEQC@AB@@@DAB@@@BAB@@AA@@@@A@@A@C@ABC@C@A@@@A@A@@AA@@@ABA@@@@AA@B@@AA@A@CBA@@BAB@BA@@B@B@@AB@BA@@@@A@AB@@@@AA@BAA@@@@@@@AF@BB@@@B@@@BABA@A@@BABABA@@B@D@D@BB@DA@AB@@AACBA@A@A@@BAB@BEB@@A@A@A@@A@A@@A@@@A@AB@@@@AA@@A@@@@B@@@BB@@@D@BB@@@@@@C@EACAAAEACA@AA@@BA@@@@@B@@B@@@@@B@@@@A@@@@@@@BB@@B@B@@@@@@@B@@@B@ABB@@@B@@@@B@@@@@@@B@@AAA@A@@@@@AB@@AAAAA@AA@@@@CAA@ABABABA@A@@@@@@A@A@@A@@@@BAA@@@@A@@@@AA@@C@@@@A@@@@A@@A@@@@B@B@BABBB@BB@@BB@B@@@BAB@@@B@@B@D@@BB@B@@B@BBB@BBB@B@BB@B@@AB@@@@A@A@@@@AAACA@@@@A@@@AAA@@B@@BB@B@BD@B@B@FB@@@BA@A@CAC@C@A@CAA@A@A@A@@AC@@AB@@AA@@@@A@@@@AAB@@@BA@BB@@@@AB@B@@@@@B@@AB@B@@@B@@BA@@B@@@@@@BBB@B@@@@@@A@AC@@AAA@@A@@@AB@@@@AA@@@AA@@@A@@@B@@@@BB@A@@@@@@B@@A@A@@@A@A@@@@B@@C@A@A@@@A@@A@@A@@@@@@@@B@@A@@@AA@@@B@@A@@@@@A@ABABA@@B@@@@@B@@@@ABA@@@@B@@ABA@@@C@@@AB@@@BBB@@@@@@A@GAEBA@@BA@B@@B@@B@DBD@@@@@@BCBA@@B@@BB@@@B@@AB@@C@A@AB@B@@@@A@@@A@A@@@A@AB@@A@@@@@@AA@@@A@@@A@@@@@@@A@@@@AAB@@A@@@A@@@@@@@A@@AA@@@A@@@@@A@@@@BA@@@@@@@@@@@A@@@@@@@@@@@AB@@@@@@@@BB@@BBB@@BB@BB@@@@B@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@A@@B@@@@@B@@@@@@BB@@@@@B@@B@@B@B@DBB@@@DA@@B@D@B@@@BAB@@@@@B@B@B@B@@@BB@@@@@@@@C@@@E@CBCBA@A@A@A@A@AAA@@BA@A@A@@BA@@@A@@@@@A@@@@@AA@B@@A@@@@@@@A@@@A@@@@@A@@@@B@@A@@@@@@@@B@@@@@@CA@@E@@@A@@@@A@@B@DABAD@@ABB@@B@@A@@@@A@@@C@A@A@@@A@@A@@@@A@@A@@@@@@@@B@@AA@@@A@@@AA@@A@@@@@@BA@@B@@A@@@@@@@@A@@@A@@@AB@@@BA@@@@@@A@A@@@@A@@@@A@@@A@A@@BA@A@ABCBABEBA@A@@@@@A@@@@@A@A@AB@@@@@@@@B@@@D@@@B@B@@@B@BAB@DADAFA@AB@@@B@B@@@@@@BAB@B@@AB@@@@@@@A@@A@@@@@@@@@A@@@A@@B@@@B@BB@@@BB@@BA@@@@@A@@@@@@B@@@@@@B@@BAB@@@@@BA@@B@@@@@@B@@A@AB@@@@@@@@A@@B@@A@@D@B@B@@@@@BA@@@A@AAA@@@A@@@@B@@@BA@ADABAB@@@@@B@BA@@@A@A@A@CAA@AAA@@@A@@BA@@@@@A@@BAB@@AB@@@@@B@B@@@@@BB@B@BBB@BBB@B@@@B@@@BB@@BBBBBBBBB@DB@@@@@@@BB@@@@B@B@@@@AB@@@@@@A@@@A@@B@@@@@@@BB@@@B@@@B@B@B@BB@@@@BD@B@BBB@@BBBD@BB@@B@BB@B@@BB@@@B@@@@@@B@@A@@B@B@@@D@BBD@@@@@B@@A@@@AA@@@@A@@@A@@@A@@@@BA@@@@@@@@@@B@@@@@@BBDB@@BB@BB@@B@@B@@@@@@@B@@@BA@@@AB@@@@AB@B@@@BB@@B@B@@@B@@@DC@@D@B@@@B@B@B@BA@@@@@@@A@@AAAA@A@@B@@@B@@B@AB@@@@@@B@@B@@@@BB@@@AB@@@@@@@@@@@B@@@@A@@@@@@@@B@@B@@@BB@BBB@@@BB@@@@@B@B@@@@@@A@@@@@A@@@A@@@A@@@@@@B@@@@B@@@BB@@B@@B@@BBB@@BB@@@@B@@B@@BA@@@@BA@@@@@@BA@@@@@@BB@@@@@@B@@@@@@@BA@@D@B@@@B@@@@@BB@@@@B@@A@@@@@@B@B@B@@@B@B@@AB@B@@@B@@@@@@AB@@@@@@@@BB@@B@@D@@@@@@B@@A@@B@@A@@@B@@@@BB@@@@@@@B@@B@@B@@@@@AB@@@@@@@B@@@@A@@@@BA@@@A@@@@@AB@@@@@B@@@@@BA@@@@@A@@B@@B@@@@BA@@@@@@@BB@@@@B@B@@AB@B@@@B@@@@@B@@BB@@@@BB@@@B@@@B@B@@@@BB@BB@@@@@B@@@@AB@@@@A@AB@@A@A@@BA@A@@@@@C@@@A@A@@@A@A@A@A@@@@@A@@@C@A@AAA@C@A@@@A@A@@@A@@@AB@@A@@@A@@@A@@@A@@@A@@@A@@@@@A@@A@@@@@@A@@@@@@BA@@@@@@@@@A@@A@@@@@@@@@B@@@@@@@@@@BBB@@BB@BB@@@B@@@@@B@@B@@@@B@@B@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@A@@@@@A@@@AA@@@@AB@@A@@@@B@B@@B@@@B@@A@BB@@@@@@B@@@@A@B@@B@@@@@@@B@@@@@@A@@@@@A@@@@B@@@@@@B@@@@B@@@@@@@@AB@@A@@@@@AB@@@@@@BBBA@@@BB@AB@@BB@@B@BB@@B@@@BAB@B@B@B@@A@@B@@@@@@B@@@@@@@@A@@@ABC@@@@@@@A@@@@B@@@@B@@@@@@@@@B@@@@@@@@B@@@@@@@B@@AB@@@@B@B@BA@@BA@@@@@@@@@@@@@@@@@ABAB@B@@@BD@@@@@B@BAB@B@@BA@@BA@@@@B@@@B@B@@AB@@A@@@@@@@A@@@@@@B@@@BB@@B@@@@@B@@@B@B@D@B@B@BABAB@@A@@B@@A@@@@B@@@B@B@B@@@@@BB@@@BBAB@BAB@BADABADA@@^WLu@A@M@CJ@JBFBD@@AB@BABABC@A@@BA@AD@BBDBD@BB@@@B@@BBBB@@@@B@@@@@B@@ABC@@@AB@B@B@DBBBDBB@B@@ABA@@B@B@HB@BBBBB@BAB@BB@BB@@@@FEDA@A@ABA@A@A@@@AB@@@D@@@BB@BBBBB@@BBB@D@B@@@DBDF@B@B@BAH@B@@BBB@BA@A@@@ABA@A@@BA@ABAB@@A@@BADA@@B@@@BBBBDBB@@BBBA@HL@BBBBBBDDDDF@@BB@@ABC@C@C@EAAAA@IBK@C@EBC@E@CBGDABEDCDAF@B@DBBBH@BBD@BAD@BCBABAB@BC@CBC@CBEBCBAAE@A@A@ABIFCBGBC@A@E@A@ABCD@BBF@H@J@D@@@@ABCBC@EBC@A@@@A@CAE@A@CAA@CEAA@@@A@A@A@ACA@ACEA@@AA@AAG@CA@@I@E@AB@@@B@@@BBBBB@@@B@B@@BBBB@B@@ABCB@BAB@B@BBBBF@DBBDDBB@B@B@BCBAFEFCDA@A@@@CA@@G@C@CBA@ABCB@B@@@BBB@B@@A@ABE@@BAB@DA@@BABABA@CBEBA@A@A@AAAA@@A@I@IAA@I@AA@@A@@EAACCC@AA@@E@A@@@GACAGA@@A@A@ABAD@B@B@@DBBB@BBH@D@DA@@BA@A@A@@@ADABAB@@AB@@ABA@CD@@A@A@C@@@A@@B@BAD@BA@ABABED@B@B@BCBEF@@@B@@BB@@@@@@@BED@@@B@BBB@D@B@BADCB@BAB@B@BBBBBBB@BXFHDDBDDBDFDBBBDRJlT@D@L@BLA@@B@@@B@D@HB@BBBDFFDDBH@D@JBF@FDPL@@BBB@D@@BDLvhB@DAHADAF@B@B@B@F@B@B@B@HBB@J@P@HBFBB@FBH@D@B@D@F@DBF@DAB@D@HAJCB@DAFAFABAB@DAD@FABAFADAHEPODANKFCJGVQrRĊêCÊCnPÞvrPÄnG¼hÒg¶h\\LȎÜXØtB@FABAF@HAD@F@H@CCGIACECACIGMOAA@A@AKOCCAA@@A@BA@AEGEIAEAAACQg@AEM@AACAEEMCEEKAECKCG@CGO@CEIACEICCACOS¸W@ċxuVUæBLƟĉA}M{O»]ğ@čB­@U@{@@Y@i@ąE@@ICUGCAGEGEEA[QcSUM@@¥@×@I@MT@BCDEHCBCBCBABED@D@B@BA@ABA@C@A@ODUDEBA@CBEBE@CBC@C@CAA@KDUGGCGGCCEAC@CBAB@B@FADABC@AACAAC@A@G@CACAAGCA@@@ABA@@@A@@AA@A@A@AAAAEA@@A@A@BBABCAIEKEAAC@@@CAC@[GGCoMA@"],encodeOffsets:[[82754,41361]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("阿勒泰地区",{type:"FeatureCollection",features:[{type:"Feature",id:"654301",properties:{name:"阿勒泰市",cp:[88.131842,47.827308],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CA@@AB@@@D@@A@A@CBCB@@CDC@A@ABA@A@ABA@@@CBC@CBCBC@C@GAC@KAY@C@IBA@Q@M@GA@@E@G@@@A@IBCB_@A@A@C@SDE@CCIGIIKGIGEEWOCEEE@AWCG@MCMAC@@@A@AB@@@@A@@A@A@@E@C@A@GAKAIAKCEAC@C@EAGAAAA@GEC@AAA@C@CA@@CACAGCCAA@AAC@AAIAC@GAGCA@EACAECEAIA@@C@AAGAE@C@ICC@C@KCCAICE@C@E@I@CAC@AAC@A@GAOAWCMAIAEAC@CAA@A@E@IAEAGAEAA@A@CAC@AASCC@EACACAKACAGAMCMEA@CAQEEACAGCWE_GEAEAC@A@E@AA@BBHJNJJHJDBJFBDDHDJBD@HCPCDCL@D@B@@BBBB@DAJ@BBB@@@BABIBAB@@BF@FADIHCBCHEHGHOJYRGDIDOFKFUJOHSHGBQFQFIBcJ[HODHJblbpVZJL^ZHFNLLJZT`TJHHDBDbXFBB@F@HAF@BBF@D@B@@@@AAC@ABAB@HBF@B@FCBA@AHEBABCBAFAD@FDFDDBFBFAJAFCLCJCFARAHAD@B@DDBDBBDBD@H@PDB@B@BA@CAA@AIEEG@C@ABCDCD@FBFBFHAFDL@H@DDF@B@BB@BBB@@@BDB@BBF@F@D@BABAJAD@BCD@B@HBDBDAD@HBBBDAB@@ABABAHAB@BAB@D@DBD@B@BA@AA@ACCC@AAABA@@@AHBB@HAD@BBD@D@@@DB@B@B@BFDB@D@BA@@@ACC@@@AB@F@B@B@BABE@AB@D@BBB@B@FAHCDAD@B@BBB@@BCBEBEBAB@B@@BBBADABAB@BBBDBBDB@@@DA@CBC@ABA@BBB@DADB@@BBBBAHBBB@B@B@BC@IDCBAD@B@BB@D@F@BBB@BBJBBDBF@HPFJDF@D@B@@BD@DDJ@BFJBBFJBBBB@DDDBFBDDHBDBFDFDHBDBFFNBBJP@DBD@F@J@N@D@B@H@DBDFLP`FNJNBHBDBHT|BD@BBB@DIHEFADAD@BA@CD@B@B@BA@@B@FA@@B@B@@@BBB@B@B@B@B@BBBBD@B@BGH@B@@@@@B@@A@AB@BABAF@FCD@D@D@BDD@B@B@B@@@@@B@@BB@BBB@DBBAB@@BDDDJHLHFDD@FBB@FBDBB@D@FBD@H@FBD@BBBBBB@BBDAB@BAB@BBBBBBBBB@B@B@DADADAB@BBB@B@BAB@BDBDBBB@BDDBBBBBBBBBBBBD@B@DBF@B@DBFBDBFB@BDBDBB@D@BBFBLDD@BBBBBBBB@@BBB@BD@B@B@BAB@DAB@DBD@D@B@DADABEDADGJCDADEBEDEBCDAB@B@@BBDDBBDBB@BBB@DBBBBBD@@BBDFDDDB@DB@@@B@BDBB@DD@@@B@@@@HDBBBB@@ABCBGBEDAB@@BBBBD@H@DBLBD@DBB@FBL@F@D@D@B@BBD@D@HBF@FBFBDBB@B@FBB@BBBBBBB@B@D@@AB@BBDBD@B@HBHFFBPLHFDFJHFBD@BB@B@BABCBGDQHGFE@AB@BEP@BEFCD@BAB@BBDFFFHBD@B@@@B@B@DCFBBDB@B@FBBABAD@BAB@BA@ABCH@@AB@BABAF@@@BAB@@CF@@@B@BAB@@@BAB@B@D@D@B@B@BA@@B@@@BAB@@@B@BBBB@BBBBB@BBBBBBB@BBB@BBB@BBB@@BBB@@BBBBBBB@BBBBB@@@@B@D@D@BBB@@B@AD@BABCBEFEDBBH@T@F@HDBBHBB@B@BB@@@BB@BBF@BBB@@B@B@BBBFDB@@@FBFBD@B@DBD@BBB@DFB@FDDBB@@@@BA@@BCBAB@@@BB@@BB@B@B@HABAD@B@B@@BBB@BBD@B@B@@DBB@D@@@B@B@@@DBB@@BBB@@@B@@@B@@ABAAEEA@A@@BCBGDE@EBC@A@A@GBCB@DBF@BPLFFBFDBD@DDDDBDBFAD@D@DABAF@JFLDF@DBBBDAF@BABCDABAB@B@D@JABCF@BDBFDBFBJDBDD@BCHGDGBGBEBGDEFEDCDIFA@BD@@DDFBD@F@F@JAF@F@JBF@RAL@FBHBFDJDDFBFBPBJBR@NBHFJDDFDTNJDDB@B@DB@DDHFBBJH@BBDBDDHBBABABDF@J@NCFANCJKLGFMJIJQJIFHBJ@BBB@BHBFFHBFBDBD@JDFDFDJBBD@FBLBJ@LAVDDB@@BJBDB@HDLDJDHD@B@@XBTBXDT@VDPBJDHDFFJHFFJLLFRJJBNFHFDDDD@D@F@H@DFJFHJLFH@B@BAHCLCFCB@BFDJDHBDDBDB@HBBBBDBDDDFBF@FBH@FADCDCDADAH@H@D@DBJDL@D@FCJ@NAJAH@DBFBDBJBH@HAJCHEDEFGDG@AL@JGPEWGaEkYAODGVWfM^EPEdSDAFCJCXCL@ZB^B^@PCHGHIHKFKHGF@HDHHJBHAJCFC^SPORQVGDBZJLHLBNKPAX@F@J@HAPIXEUVGPCNCLEPED@DCDCKY@I@EBEFGhQrWLEPELGJEDCNGLEN@N@JBZLPLPLZFTELGHCBQ@K@CBUHINEXAVB`BAI@G@IBGFCTE\\ILIBOISUYQQGIIQBMLKJGJEJIDQFM@KCK@KHIHIJCJCPGROH]DEFCA@@A@A@AA@AAECA@C@E@GEAA@A@EA@A@EAGCI@C@C@CBEAA@A@A@AAEAEAA@E@GACA@A@EAAAACAC@EAEBCAEEAAA@GCA@ACAAAAACA@EAA@CAGCA@ACAC@AA@IEGCKAMCGAMEUIOIOKGECICG@I@MBG@GAEAGCGAEAKBIHIDIHKFEBI@G@OBEHGFC@GCECAGEAAICA@I@G@ICEAICGCGCECEABCCEAACCGCEAGACCKGIAAAECIGGIGEC@EAE@CAAAA@@ABA@EAEBA@CDCDAHAJCHAFADAFABAFADCHCHANID@FAPAH@DBD@FBHBNAHAFAD@DBDBJBJ@L@F@JAXEDADCBATCFEFC@A@E@AAC@ABABAHCHCD@LAJCJ@F@DADEDEA@C@A@ICC@ECAC@A@ABCBABCBAACCAEAGEIE@A@A@AB@BABATEBADED@F@D@DAF@BAHE@ABA@E@A@AAAAACG@CBC@C@EACAAEEGGAACC@A@@BAJBDBDCD@D@NGDAD@DAF@F@D@@AD@D@F@B@BAB@BA@A@@BAB@D@B@BDB@B@DABA@A@@@A@@@@AA@AB@BAB@F@H@B@@@CCCAC@G@A@E@C@E@ODE@C@AACAIACCC@I@K@EBABC@E@A@A@EDC@I@A@EAEAC@G@O@IAA@CAA@@C@GAI@G@EDEBAFEBABA@CCACEEKAACAMGGAAA@AAC@EBABCBG@C@C@AAAEGAAAAGCCAG@A@K@ECECCACAKGCEAAAAA@CCACCAECACCCAC@ABCACAAAC@CACAEEE@A@CACAACA@CCCAEAAAGACCEACEGACAEACAAAA@A@@BA@A@@AAAC@@IA@AAA@A@A@A@ACG@AAAAA@C@A@@AC@@@C@EAC@ACEAAACCCCE@@ACA@@E@ABABA@A@@ACAGAGAAGIAAAAACAAACA@AAA@AA@@@A@@AA@ACCCAKCEAA@A@C@A@CBAAGCGGC@AAAAA@AAEAEC@AAC@AAA@@BC@ABA@AAA@AAA@AAIAA@CAAB@@@BC@@@AA@A@BAA@AA@@@A@AAC@A@A@A@C@@BA@@AA@@ABA@@AAAAA@@@@@CKICAAAAEAAAAAAAAA@GKC@B@CEAAACAACGAK@AAG@C@C@ABCBAAI@Q@eAUAQ@GC_AWAK@CAM@K@C@I@MBC@AF@D@DADCLCDA@@DADAHCBAFE@AB@BA@@DCB@BA@AFCFCDABADAJGB@FADAB@FABABANEFAFADADCDA@CBABAFAN@D@FAH@D@HAH@D@DAHAB@B@DCBCBCAE@C@AE@EBG@K@GAUGECE@ECICCBCBIAACKAM@EBCBE@E@C@C@E@A@A@@@C@A@C@EBE@CBC@E@A@CDQA]BEDEBCBGAA@E@CAG@CAEAAAAA@AC@CAC@@AYDUD_FI@[JUFCBODODMBKFA@CBVYHGBE@IDMBABA@ABA@GHA@ABQF@@KDEBCBCBEDQJWNABID@@ABA@U@A@C@EBIDWB[@E@C@@BOHC@CBSDCBYFA@UFA@@BA@@@@@@@@@AB@BA@@@A@]HMDEBC@CBEAK@CAGA@HADABCBABC@@BA@A@AAA@ABC@ABCBABA@CB@@AB@@BBD@BBBB@B@B@BABABA@A@@@AAAA@A@@@AA@@@@AA@A@@@A@@B@@A@@@@BA@@@A@A@@@@@A@@@A@@@A@@@@@@B@@@@@B@@B@@B@@@B@@A@@@@@A@@B@@A@@@@@A@@@@@@@AA@@@@@@@@@A@@A@@@@@A@@A@@A@A@@@A@A@A@@@A@@@A@@@@AA@@@AAA@@@AAA@A@A@A@A@@@A@A@@@A@@@@BA@@@@B@@@@@B@@@@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@A@@@@@@AA@@@@@A@@@A@@@@@@@A@@B@@@@BB@@@@@B@@@@@B@@@@BBA@@@A@@@@AA@@A@@@@@AAA@@A@@BA@A@@B@@@B@BA@@@AB@@A@A@A@@@A@@@C@@@A@A@@@ABA@@@AB@@@BAB@@@B@B@@@@ABA@A@A@@@@@ABA@@BA@@B@@@@A@@@@@AB@@AB@@A@AB@@@@A@@B@@A@AB@@@@AB@@AB@@A@@@AA@@A@C@A@A@ABA@@B@@A@A@@@@@@AA@@B@@AB@@A@A@@@A@AAA@A@A@AB@@@@ABB@@@AB@@@@A@@@@@A@@B@@A@@@@B@@A@@@A@AB@@A@@@@@A@@@A@@@@@A@@@AAA@@@A@A@@@AB@@A@@@A@AB@@A@ABA@@@@B@@@@@B@@B@@BA@A@ABA@A@A@A@A@@@A@A@@@ABA@@@ABA@ABA@@@A@@@ABABA@AB@@A@AB@@ABA@@@A@@@A@AAABA@ABABA@C@C@A@CBA@@BA@@BAB@@@B@@A@A@A@A@@BA@A@@@A@A@A@AAA@@@A@@BA@B@@@A@@B@@@@AB@@A@@@A@@@A@A@@@@@A@AB@@A@@@A@@@A@@B@@@@A@@@@@A@@@A@@@@@AB@@@@@@AB@@@B@@@@@BA@@BA@CAABA@A@A@@AA@A@@@AB@@ABA@@@A@A@A@A@E@A@C@A@ABA@@@@@ABB@@BA@@BAB@@@@A@A@C@A@A@@@AAAB@@A@AA@@A@A@A@GA@@C@AAK@C@C@@BA@AB@B@BA@@B@B@@A@A@IAKCEBGBC@AB@@BBBB@B@@@BC@@@@@AB@@@@A@@@@@A@@@CB@@A@@@ABA@ABABA@A@@DBBABCCCEGGGGIBM@@ABCBCDAGA"],encodeOffsets:[[89660,48561]]}},{type:"Feature",id:"654322",properties:{name:"富蕴县",cp:[89.525504,46.994115],childNum:1},geometry:{type:"Polygon",coordinates:["@@@M@ƿ@ٯ@ADAFEDCFGFGHADCDCD@D@FBFDHBFFLDDDFFHBFFFBDDJDFDFFFBHDHDNBFBXD`Bx@PAFCJEHAH@VD^AFBPBNDP@B@BCBCFEDA@CD@@AF@FBJBBBBBBBB@DBDB@DLB@BF@F@BDFBBJD@BDHBDFH@BBFBDDDBFFHDFBDBDBBBBDHBFBB@BBDBDBFNbBB@BDFBFBD@DDFDFBDBDBDBDBFBHDJBDBDBDDJDFBJBDBBBD@BBH@B@D@HBF@DBH@DBF@DAF@H@J@H@D@DBD@B@DBFBHBFDRBF@DBN@FBFBF@FBD@BBR@B@D@HBP@JBF@BBD@B@D@B@DDJ@D@BBD@B@DB@@L@DBDBF@BBH@B@DBFA@@D@B@B@D@DBD@D@DDH@BBDBF@B@DBDBD@BBFBJ@D@DAB@B@H@B@BBFBB@HBB@DAF@B@D@DBH@FBFBF@J@P@B@F@@@D@BBt@BEL@DHZ@DBD@DBV@D@D@LAH@B@FBF@D@D@DAH@F@D@D@BBL@D@J@J@DAH@B@BBD@LBF@H@DADAD@B@\\@F@@@B@D@B@BAHALAH@B@D@BBBAF@L@J@DAB@F@D@BCJ@FAH@FAD@DBFBD@@@H@DBFDFBB@DBJBDBDBD@F@H@D@D@DBBBHBD@DBD@BBHBFCBKLKLOREJCHKRADABGLIHKJIJEDCDEHORKNCHKNILINOVMTIP]jIPYbEHEH_nKNGHQLMHMHQP]ZURIF_VGFUR[XUR[\\GHKJIH[XCDEFSRGFKPCBIFQLEDEBEBMHMJ_Xwla^WVMJILEF[V{pzAB@BBDBFBH@LBJ@HABBDBFBHBFBFFRDZ@HDTLFb@fAdAB@^@LANFFTRBBFN@F@DDBF@B@DADABAP@D@JFD@FFB@HBJDH@JBL@RBP@F@X@FAHAF@DBB@RFD@F@HBJFFDF@FABBB@BBFDDBD@F@PBD@BABAD@BAHAB@B@D@BDFBHAD@D@FBHFB@B@B@B@DCD@B@D@DDFDD@B@HAB@DBFBDBBDDHDDBBD@B@DFBDFFFHFBLFD@JBB@BBB@DBLAFCDCB@B@DDBBDDPJHBPDHDLH@BBBDHBFB@BBBBH@DADCBAB@@BDD@BH@JBBBB@@F@HBBDDB@BFAFBBFDFDFBD@D@BABC@CBCBA@@B@DDHJBFD@B@FBPHFBF@FBD@DBBDFHBBBBD@DBDDJBPLPLHFNHPLZZLLT\\JL\\fNNPVNNR\\BFDHT\\dtV\\ XbJJPPDDBBFBDBBDBDDFBFBBBBDB@BBDBBFBBB@@@BAB@D@B@@BBJFB@D@D@BBB@@B@BABCBGDGD@BAB@DBBBBB@FBDAB@BABA@EBCBAB@BAB@BBBB@BBN@B@BB@B@F@L@D@BBFBB@D@B@BB@@@B@DEF@B@BBFBB@@@@B@BADCBA@BB@B@@@@BABCDABADAB@BB@NHNJLHBB@DAFABEHCFB@JDJBJBJBBBFFDDFFFFDBHDBBJDDDFFDFDHAFCF@HAF@FAHABGDADEF@B@HB@FFHDJBBDBHGDGDGDGD@BGFEDBFDFBFBFBHF@F@B@@@@B@@A@A@A@ABAB@@A@@@CB@@A@AA@@A@A@@@CA@BA@@@A@AAA@@@@@@B@@C@ABC@@B@@@@@@@BB@BB@@AB@@A@A@CA@@@@@B@@@BD@@@@@@B@@AB@@C@A@@BAA@@EAA@@@@B@@@@BB@@@BA@@@A@@@AA@@AA@@AB@@@BG@@@ABABA@ABADAB@@@B@@A@AB@BC@ABA@@@BBD@@B@@A@A@A@@B@@@BEBAB@@@B@@CBAB@@@BBB@@@B@@@BA@G@A@@BA@C@A@@@AA@@@A@@@@A@A@C@AAE@AAAB@@EDA@ABC@ABAB@@AB@@@BCBCBC@GBCBKDCBIFIHMHIDIFEDKDMFSFMDQFEBIDcJOBEDEBC@EBC@CBEBADABAB@D@BBB@@B@@@B@B@B@B@BAD@DAD@D@FBD@D@D@B@@@FAD@D@DBFAB@@@BABA@@B@B@B@BB@@@@@BBBB@B@D@B@BAB@@@@@@B@@BBB@@BDB@B@@@BBBDBBB@BB@B@@@B@@BB@@@BB@@BB@BB@@B@@BBBDBB@@DB@@BBB@DBFBDB@@@B@@BDBD@DBDBB@XBDBH@BADAD@FAFGJCDIHEFABFF@BADAHABEDABC@ABABABABAB@B@BBB@BAD@DA@@BE@C@CB@BA@AF@BA@ABA@A@CBA@@@@ACB@@C@@BA@CBA@EBA@ABCBA@A@C@ABA@EBCBA@A@@BBB@D@BBB@@ADABCB@B@@@@BBJ@FBJBFBJ@F@JBFFFFBBDFFFB@JBLBF@JBF@JDA@ADCF@BAHBBBFDFFFBFBH@DAFBDDHHDBBFFBDFFBBFFBBFFFFDBFFBBDFBDFFFDFFBBFDBBJDHDD@HFBBHFFFB@HDJDFDBBDD@@BB@@DBBB@@@BBBBD@@BBBB@B@@H@BBB@DDFDDBBBHB@@B@BDDBBBB@HAD@BBDBB@DBB@F@DBB@DBDBD@BB@@DBHDBBJFBBHDDBFFHFBDBHBHABAHCBEFEDGFABGF@@GFGDABCFCDCH@@CFCH@@AHAFADAF@BCFAHCFADEFCHABCFEFCHCFCFCHABAFADB@@B@B@@ABAB@@@B@BABCB@BBB@BA@@BA@EBCBABA@@B@@@BAD@B@D@BBFBDBB@@BD@B@@AH@BBBBD@D@B@@@BFBBB@@@D@DAH@BB@DBHBB@BBBB@@@BABAF@BB@@BB@BB@B@BBFA@@BABIDA@KDILFXHPN@RDLFTLJFRH\\JJNFFNJZNFLBFRIT@Z@@@L@HBJ@J@JBHBJBFBJBBBJBBBLBD@J@J@JAHAJABARGHCFAPCJCRAZAZHXLRFP@B@JBH@FHBBFFBJDFBJPJRF\\DXFNBH@PAN@NFJFJJJRF@NADCNKREbBLBN@PAH@`GbEJ@LALBRFLDPFLDRDZ@^CLANCVGJEJMFMBK@GAICECM@AAKBIBKHGFKLENEVGHC`GFG@OAICMDOFEPAXCRANCTA^G^EVEFCJMJKAYJSFOBGBGBCHMLEP@VBB@RHHBPALAL@J@PALANAN@H@D@NBJ@LDHDJDJ@P@J@F@PAHCLAVAJB\\BNL@P@D@J@F@DARBJ@LDHLALANAPAN@J@JA\\EVCZCjTJA\\UNMCQQyIOBODuDQ|w^UPQPO\\AFZEpaXCVHT@ZU\\CXCTCSVI`QxokJUEMQHQNMheFQHOTYJe@O\\S`IPMyDMHyASQEKUF]ASPSpC`EPKX]ACIMWQQWNKBOgYePgL_HycYUyHmHMKiGyBiQcMq]iOS]M_OeAYEASGcIe_qKi_F[h[AOAQBOHe@SOS]OOSI]]_cQu@A@@@AIACCEGCICA@@@CBA@@ACAAAAC@@@@AAA@C@C@I@C@A@@@A@AC@A@C@AAAA@IAAAEAGCC@A@AAA@CAC@C@CACAGCAA@@GAA@MGAAEE@AAC@@A@EAA@ABA@CBOFIFABA@@ACAAAA@AA@@@A@CFEBC@AB@@AB@BABA@@@AAE@@@C@A@A@AA@@AAA@AAA@@@EACAC@A@E@C@A@G@CBABADCBA@A@@@AAA@E@ABABAHCDAFCD@DADCB@@AAACEAA@@@AC@CBC@AAA@A@A@@@A@@@A@A@AA@CCACCA@ACAAAC@@BC@A@AAEEACCA@AAAAACCCAA@EAC@G@IAK@AB@@A@CAG@C@AA@AA@AAE@A@@A@BADADAB@@C@AAACAAC@A@A@E@C@C@E@A@@@C@A@AAA@@AA@@BABA@A@@@E@ABA@C@@@C@C@A@ABCFCBCBABABA@E@ABC@AB@DA@AB@@CAEAG@@@AA@A@ACAAAA@A@A@ABABA@A@AAAA@CAC@A@ABCBEDIFOHIDIBC@AAECGEMKOKIIMGECKKAC@ABGBGBE@CBA@A@ABA@A@A@A@C@ABC@EBC@CBIBC@E@A@A@AFG@AFKBCBC@CBCDGDGBCBE@@BEBABADGBEBC@E@A@@BABA@CBCBIBE@A@A@C@ADM@GBEBCBE@@@ABGBCBE@AFODM@@@C@E@C@C@A@A@AAE@I@A@C@K@A@E@A@Y@I@ABG@A@A@C@A@C@A@C@ABCBCBCBCB@@ABEDGBGDC@A@ABE@E@C@E@ABA@C@ABABE@C@CBA@CBA@@@@BG@A@K@EAA@C@A@A@A@@BE@A@A@A@AAC@C@A@E@AAC@CPMJINMNOVWTSVSPOLIDCBA@CBEBEACBAB@@@@ADEFKDCHGHEdUDCDCBEDCHQBABAJ@B@@@@ADABABA@CBABABADADADC@ABABA@ABCFCAA@CBCBADADIBE@ADC@EBA@ADEFEDCBEDC@ABC@CBA@A@K@ALOBCDEBCDA@ABEHK@CA@AAA@EAAAA@EAA@A@A@@@AA@AA@A@CAQCC@C@ADC@CAAAA@A@A@ECCAC@A@ACECCEA@A@CAKE@@C@CB@@AACA@@AAB@BCB@BAD@B@FEBAFCDABAFCHGDCBAFG@ABCB@DEDCJGDCHEFCBAJED@@@BA@@BA@@BC@AAA@AAA@A@ABE@CBEBC@EBABABA@@AA@@AA@AC@@A@@BAAACAA@C@A@@A@A@A@AB@AA@@C@CAEAAAGCA@GAC@A@AAA@EE@@@ABA@@@AAAAA@@AA@A@@ECEAC@E@A@C@G@A@CAACAEAAAACAC@CC@ACAAC@@AA@ABA@AB@BAB@@CAAACCEAAA@EG@ACE@A@A@C@A@C@@CAA@AA@AAEAGCEEG@A@ABA@A@@@EACACACAAA@ACA@CCAAACCCAC@@@A@ABCBCFKDGBGBE@GBCBCBIBABM@IBABC@AFEHIFEBCBA@AAUAKC]AK@C@ABGBC@GBE@E@E@E@CDGDEBGBE@W@E@E@E@A@C@ABEBE@IACAI@A@A@AFEBCBC@EBK@EAM@G@E@E@IAEBEAQCU@MAOCGCEACECGECCCCCAIAAAAAA@AAC@A@CCC@KCE@KEAAEGAACACACAG@CAAACCAAA@@A@E@C@ECCAA@@BADABAF@DAHCHEDAFAPGFAH@FCHAD@DADCNEDELEJEFCB@B@@A@EBM@G@EAC@K@C@C@CBEBGFGDCBA@ABC@ABE@EBEBMBK@ABCAEBI@C@A@C@CAE@A@E@G@A@M@AAG@CAEAGAMAGAEE[@E@ABKBM@E@GBE@C@GBO@E@CB@@A@ADABC@ABAAA@ACA@A@AAAAA@AAA@ABABABA@CAECC@A@A@AAA@@@@BCBE@GAEAAAE@AAECKAI@I@I@A@C@GCK@GAE@EAA@G@CAIASAG@EAC@EAEAMAECQAC@C@CAACQ@EAEAC@EAEAEACAGAC@CCCAEACACCI@CAEAECIAEACACAKCCAIACEMEMGQAA@ABADAFC@AHI@A@A@ACC@@@A@AA@@A@AB@@@@C@CDEDA@A@@@C@AFEBCAC@ECACCEEIEEECACCCACCCAEAECGCGEEAMGIGIGKEIECAKCMAIAIAE@EAE@CAEAECQEC@GACAEAA@C@CAG@AA@@CCAA@AA@AACAA@ECAA@A@CAEAACE@A@E@CBCDEDADCBC@C@A@ACAKEAA@AAAAAAAAAAAAA@@@C@AAA@ACAA@@EAE@"],encodeOffsets:[[91888,46082]]}},{type:"Feature",id:"654321",properties:{name:"布尔津县",cp:[86.874923,47.702163],childNum:1},geometry:{type:"Polygon",coordinates:["@@CDEDABCJCDABC@CFADCFCBCDADCFCB@BCBIHINGJEHADCLCFAD@BADBB@@BBBH@DBBBHHJDBJBFAJCD@TGRCH@LBF@L@F@HBHFNFFDJDJB\\LFDRDLBFBNBLBJBJBBBBBBBBDFDBBDBB@FBDBD@DB@B@DJJDBB@@BBBFBH@BB@@JDD@BBB@@B@@BBABEDIDUH@@@BDFLX@DBBDJBFBFBD@@@@D@D@@@H@BB@@@B@@@@BBB@@@BBD@FBB@BBB@BB@@B@BDBBBBBB@BFHDHBD@D@D@BAB@B@@@BBBBBBNBDBD@DBBB@BBB@D@BBB@B@D@JBFBB@H@F@D@N@@@B@@BABCD@@@BADADAFBB@F@D@BBD@@@@ABA@GBEBMDIFAB@B@@FPBDBD@BBFBNBH@FBD@FB@BDJTHNDHDHBBFLHLJNFHBDBD@BDDNDFBHDHBDBDB@@@B@DAN@JAHBH@F@FDPBL@D@B@BB@B@H@PBJ@L@H@B@@B@@@@@BB@@@BB@BDBBB@@BB@@@D@@BBB@D@BBB@B@B@B@@@@ABABABAB@B@B@@@BB@@B@@B@B@B@BAB@BBB@B@B@DBB@BBB@@@B@@ADAB@@@@DD@BABB@@@@DAB@BDBBDBBB@BB@@@B@BAB@BABB@@B@B@@@BA@@B@@DB@@@BAB@@A@@B@@@BBB@@AD@@AB@@@@BD@BBB@@@B@BD@@DB@BD@DBBBBDB@BAB@@A@@BADBBDDBDBBBB@@@B@B@BB@@@DB@BDF@DB@BBBFBDFDBDBBBBBB@B@B@DBBABAB@D@BBDDDDB@B@B@@AD@BA@DF@@@BAD@@@@BBB@DB@@@B@@B@@@B@@@B@@@@BDD@@B@B@BBDAD@@BBDDDDF@F@B@BAD@BC@@BABEBAB@BADAD@DAH@@@BBBBB@@FHB@BBDDDDB@JDB@PJ@BB@@D@@@@BBB@DB@BBADBF@D@BBF@F@FBF@L@HBJ@J@D@B@B@B@D@BBDBDDB@B@B@BB@@BB@BDBBB@@D@@@B@DBB@B@D@DBLDBBBFBD@@@B@@CB@@@D@B@B@DBBBBHBFDJFDDBD@BBB@BFHBBDBBDBB@F@DBBHFBF@@BBBB@BBFDFBB@@DBBB@BABABBB@BD@B@F@D@HBDBD@DBDBBBFDBD@BBB@D@H@BABAB@D@DBDBBBDBFBB@@BB@@B@@@BBBB@@DBGBABABABAB@D@@@DDFJLBBAB@BAJADAHENGLCFAD@DCD@B@BADBBBBDFFDHDHBD@DBB@FHDBHFFDBBB@ADDF@H@DCD@BEFIDADADA@CDCBCDABCDB@@BDBBBBBDBADADADAHAHAD@D@BCDA@C@C@A@@B@DABCDCBCBA@EBYFODIBGBGBI@MDGDGBEBEDOFGFIDIFCBEDCBQLEFEDSPEBABAB@B@FAB@BAFAD@DAB@BCFAD@DB@@BDBDDDBHFDDDBB@BBF@N@H@NBH@HBH@DBHDDBFDHFBB@DBD@B@B@@ABCDAD@BB@@BBDB@BD@BBBDDB@FBDBD@J@F@D@HFDFFJDH@DBD@B@BAD@F@JBB@B@H@D@BBD@D@BBFBD@FBFBB@BDBDFDD@BHFDDFFBDB@D@D@D@DAF@J@V@D@HAFAB@HFBBBD@BBD@DB@DDBB@@@@@D@BB@@@DBBBFBBB@@BBBBFDBDBBB@BBD@FBBBB@D@B@B@D@BBB@DD@BB@DBJFFDFDF@FBB@BB@@@@@B@@B@@@B@DAB@BBB@DBB@F@F@B@D@B@B@J@B@BBB@B@BAB@BABADBD@B@D@B@BBDBB@D@BADAB@D@BBD@D@BADBB@JAB@BBBBD@BBBB@D@BBBDBBBBDBBDBBBDB@@FF@DAHHDB@BBBBFDBDPLBDFBDDDDDBDDDDB@@BBDBBFJDFFDDB@@D@B@DBDBBD@B@@CDAD@@@BA@CDAD@D@HAD@B@B@B@DABADAB@BBBDDBBBDDF@D@D@@ABABAB@@@BBBBD@BBDBBDBF@FBD@B@BBJBJ@F@J@BBFDBB@B@DBBBBD@DDD@DDBD@@CFGF@B@D@@BBHBFFBBAB@@ABCD@@@BDBDDFD@B@BADEB@FBD@R@B@B@FBDBBFDJDDBFD@@BDAB@BCBABDHFLLD^CfGjCRCHCHEHKNKPGTCX@XHRJVFPDVDLDVHPJHLAJMNILEFGHDLDFLDXFh@hENAN@TFPH^FTARAP@ZBLDJBLBLAPBJBHBJFLTTNRDZF^@REPCRGRBXDLNJ@RCfM@O@Q@G@IF@P@PBLELQFEBEHM@AJOFMGKCCICQICGFIFIHGFE@CAEGGEEGCICKGCGDIJGPI^CR@TIDKCuFGdITG@ImMUIMIHKBI@GEAIEKAK@MBKBKBIBI@KAOA_]ECCCyAE@E@CE@E@E@EAGEGGOGKAICIACBGBGLIL@RBLBJBJBFADADEFG@G@EBGBGDCXGNELELGJGAGSAM@I@G@ECAC@ELCHANCFGJMFAJCH@PBLBJANFXG^OVMXOLIp[FAFDRXHDHBLCLCJA^MXEdKNEfWLEHIHIBQ@EDCFBNFLBVDNCDMEMEIGG]S[ScWaUEE@E@CJIHCAIIKSKKEOFIHK@@BCHEHCFGFIDGBG@IACAEACAG@IBMBI@EDC@K@ICCAC@G@G@CBCBCDCDEBG@EAE@EACCACACAAGAA@ACCCGAICEC@ADADEDKBG@A@AEGIKEGEI@C@G@E@CCCCCGEMEIAQIKEIKEEIGEEGCICOAUCS@WCSAWA@@@AGCICKCGCA@ACAI@@CAUCKBI@KAEAC@AACICECE@IACACAEEGAEAGA@AAI@GAJERIJINIHELKDIBMDE@M@ICEBABAAACGACAC@AIGAAGECCA@@C@ACAICSMECCCEIAG@MAQAIAOAECEICECGAEAK@QBE@IAE@E@IBE@E@C@EACC@@ACB@JEDCFCFEHCFAHAHAHCDG@ACCCAAIAEECCA@ADEBA@I@C@ABABADCBA@ABEACAA@CCEEK@IBEBA@C@CBCAEACCCCCC@CAAEEEOK@AAE@CDAHAB@B@D@FAF@HCDA@AB@B@FFBBBA@@@A@@@A@@AA@AA@CA@@A@A@@@C@A@CA@@@A@AAC@AAA@AA@A@C@ABGBA@A@A@@AA@@A@@BADA@AB@@A@@A@CAECA@CEA@AAC@CAA@C@EAEA@@A@ECAA@A@A@AA@AAE@AAA@@A@@AAA@A@GAAAGCE@S@G@AAFCFEDABA@ABCA@@@AA@A@C@C@A@@A@AAAAA@AAAAAA@@AA@AA@AAA@AAA@AAA@AAAAAAA@AAAAA@AA@A@A@@BA@A@@@AB@@A@A@A@C@C@ABA@A@@BA@A@A@@DE@@BA@A@@BEBA@ABA@@DGBAB@@ABA@ABCBAAA@E@ACAAADE@C@A@A@@@AACEGEEAC@ABA@ADCFE@AFO@ABAF@HERGHCDABA@A@AAAC@EAIGCEGEOKEAGEGAA@C@CAAAA@@BC@A@A@AAAAAAA@EAA@A@CAEAEAE@GAC@C@AAA@C@C@E@K@EAA@CAC@KACAG@C@AAAA@@BAFCHADABA@@AAAAGC@@@@@A@@CCA@CA@A@A@@CAA@CCECAC@AC@AAAACAA@AAA@CAAACCAA@@@ABADCFAFCFABCDCHIBCFCBABC@C@A@CAC@CBA@CBA@A@A@AACA@AA@@AAAAAAAAC@KCEAAAC@A@CACA@AEACAEACAA@E@CAA@C@AAAAAAAAAAAACC@AAACACA@ABA@A@AAA@ABABCBC@C@A@AAAAAAAAA@ABA@ABAAC@AAAAAAAC@EAG@C@EAC@A@CAEAA@EAC@ECKGIGCCAC@@BAAA@CAA@AAA@@@A@@@@@A@A@ACC@A@C@CDC@EBEBA@ABAB@@@@A@@@@@AHG@A@AACAA@A@A@A@A@AAA@A@@@A@AB@@E@AB@@A@A@ADCB@@ABCBCFEJG@CAA@AACS{AGACAGIMEMO_EKAC@C@G@A@C@M@I@EAC@CIOAAEMAEACCGCEAEACCGACAECC@CAAAAEIAAEI@ACI@CAC@@@A@CCEEIGOE@CAAAAI@AAA@A@E@CAAA@C@ABCD@JADA@A@A@AABGAAAA@@CACBA@AAB@BAD@DAB@@C@@CAAAACAAA@ABCBABAA@@@ABAFAFADA@AA@AAA@C@CBGDEBA@A@AAC@A@@BAFABA@A@E@A@@B@@DD@B@@ABC@A@EC@A@A@ACA@@C@C@AAC@GBA@GA@B@@ABBB@BDDBDB@@BABA@C@CAC@A@ABA@GBABAB@BA@CBAAGAC@CBCAGAA@C@ADC@IBABABC@E@E@AAA@AC@@A@AAA@@A@ACE@C@GCKBEEGEAEAC@CDAD@B@DFHJF@BBB@DABA@A@OCG@C@CAAAACCCA@C@GBQBEBIDKDEDIBEBEACAECECC@EBABADABGF@BABEDA@E@GAA@AB@BBD@B@@A@C@E@AAE@GBE@A@EAaWACGCIG_SYSKIMKGE]YIKUYaoakGI@@UF[FOFODKBQB{@AeAKI@KBWDQFYHUHYLGBQHoN[F_FaNYHSD_FODKDYLIFGJAFETAHBDBTBbBRDHBDFJFFJFLHDBNRHRFHBD@DBJ@HAZ@PAJAH@DAFBH@H@JBN@HDhBDALBXAH@H@FBNBH@PBJ@HFTDJ@BCFEFCDADAF"],encodeOffsets:[[88594,48958]]}},{type:"Feature",id:"654323",properties:{name:"福海县",cp:[87.486703,47.111918],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BA@@DBB@HBB@FAD@BABADA@C@A@A@A@A@AAAAAAAAE@AACA@CEAACC@A@A@@BBDDDDBDDFFJDDBBBCDCBCBC@E@GAIAC@AAAACACCEAACCACAACCAAACAAKGAA@ACACIIE@IOGECQGLKbY^UVQ^UXIdEFANE`UFAFAHBHBF@DALGLIHCFCB@DEFCDGDCDCFADCFAHCBAFGHGDCEEAABADABA@ABAD@BAB@FCDAB@AA@CB@BCDADADC@@DAFEDADAB@BABAB@DBBBB@@ADAB@@BB@B@BABABADCBA@@B@@BB@BADABAHAB@F@DADAF@DAB@DAB@@B@@AB@@D@@@B@B@B@BAB@B@B@BAB@B@@@BB@BB@BAB@B@AB@BB@B@BCB@DAB@B@@A@@B@@@BA@@A@BADA@ADA@ACBHG@AB@@@@BDADAF@D@DAH@B@FAB@B@B@B@@ABAF@D@D@DAD@DADAD@DA@A@@@CBAFAF@JAB@HCD@FADBA@@B@@B@B@B@B@@@@BFABB@B@@BB@@B@@AAABA@@B@BBB@B@BB@BBBB@@BBBB@BBB@BBBD@BB@@BD@B@@@B@B@B@D@D@BBB@BCA@B@@AB@B@AA@A@AA@@@@@@@BAB@F@@@DABBBALAB@BA@AA@@ADCPABAFAF@B@B@B@@@@@BAB@D@@@B@BB@@B@B@B@@@@@BA@@B@B@D@BAB@B@D@B@B@@A@A@@B@B@@@B@@A@@B@D@B@BAB@B@BAB@@@BABAB@D@DBB@BA@@B@BAB@@AB@BAB@BA@@B@B@BAB@BAB@B@B@B@@BB@@B@@BBB@FAD@BA@@BA@@B@B@BAB@B@BA@BB@B@D@B@@BB@@@BBBBBB@@@@B@BA@@B@BB@@B@BBB@B@D@DBB@D@D@B@B@B@FAB@@AB@B@@@B@DAB@B@@@DAD@B@B@B@B@B@@AB@BA@@BA@@BA@@BA@@BA@@@A@A@CB@AA@@AAA@AAA@@ABA@@@A@@@@@AB@@@@@B@@@@@BB@@@AB@BA@@B@B@D@B@BAD@D@B@BAB@B@@@D@B@B@BBB@@@B@B@@@B@@@@BB@@@@@BB@@@@BB@@B@@BB@B@B@BB@@B@@@B@@@B@@AB@@@B@@@B@B@@@@@@A@@BA@@B@D@@@DC@@BBB@BA@BD@BAB@@@@@AA@@A@BA@B@@BAA@B@@@A@@AB@@@@@BA@@B@B@@@BB@@B@@@B@@A@@A@A@@@@@AA@@A@@@A@@AA@@@@A@A@@C@@@@AABAAB@@@@AB@@@BA@@DAB@@BB@@@@@B@A@BA@@@BB@@@@@@A@@B@@@@@@BB@@@@@@AB@@@@@@@@@@@@@@A@@@@@@B@@@@@@B@@@@B@@@@@@B@@@@@@@@@@@@@B@@@@@@@@B@@@@B@@@@A@@@@A@B@@@@BB@A@BB@@A@@@@@@@@B@@B@@B@@@@@BA@@B@@@@@B@@A@@B@@@@@@@@A@@B@@@@@@@@@B@@@@@@B@@@@@@@@@@@B@@@@@B@@@B@@@@@B@@BBB@@@@@@@B@@@@@B@@@@ABB@AB@@@@@@@B@@@B@BA@@@@@@@@B@@@@@B@@@@@@@DB@@@@@@B@@A@@@@B@@@@@@@BA@@@B@A@@B@@B@@@@@@B@A@D@@@B@@@@A@@@@@@@@B@@@@@B@@@@@@A@@@@@@B@@@@AH@NFDBBBB@@BDB@@DBJFB@F@FBB@@@BBB@B@DBJDJFD@B@@@D@J@H@@@@@B@D@F@D@H@D@B@F@F@J@NBTBF@DBBB@@BF@BBDDHJDTJTHPHHBZLRLB@BB@@BB@BBB@@AD@LAJ@F@F@B@D@B@@@B@B@D@@@B@@@BA@@B@FAF@BABADABA@@BBB@D@D@D@B@B@B@B@@@B@@BB@@@@PBF@H@D@~@rBb@BFBFBL@F@B@DBFADADCDA@A@GBCBC@G@GBC@G@EBC@M@EBABAB@DCBCDCBEBEBMFABABEBA@CBEBA@IHCBABCBEDED@BABA@CD@@ABA@@BEFABGDCBCB@@CBKDCDCBC@E@@BAD@N@J@D@LBN@DBLBXD`@HBRBV@f@RBJABAD@B@D@DBH@BBLDHBBBDBBDFA@D@HLB@BBBBBBBBBFBBDBLJ@D@@@@BBBB@BB@BA@@BB@@AB@@@D@B@B@BBD@B@B@@BBB@ABB@B@@B@@AD@@A@BB@DBBBJ@BBB@BBB@BAB@BAD@@BB@BBD@BFDFBBBB@BBBBD@HHHDBBDAB@D@B@B@FBLDDBDD@BBB@@@B@@BBB@BBB@BDBBBDBBBBHJBBBHBHBD@@@BABAB@B@FB@BD@@DFDDBDBBDF@BBD@F@D@@BD@@@B@DBBBB@BDH@B@B@B@BBB@BJB@@BDBB@@@BAB@@@BBBBBBDBFBDFHBDDFBDBHBBBFDD@DDBBBBD@D@BFFBFBD@DBDBBBDAD@BBDDDBDFDDBBDDDB@BBBBDFLHDBDBFDFDL@B@H@DBHDBBBBFHBB@B@D@DAHADAB@FBD@BBBHBNHDBBBFLDFDB@DABABEFABCF@F@HBJ@H@DB@DBB@JBP@H@D@FBFBB@J@D@FCB@B@F@D@BAFAL@J@D@DDJBDBBBD@F@PCF@D@F@B@H@D@DBDD@@A@G@E@A@ABA@@BBB@@@@@B@@@BABCBA@A@ACA@C@A@AB@@@BABA@ABA@E@C@C@@BC@E@E@CBC@CBMHC@C@CDCAIAAB@@@BDDBBHHFFBBBD@F@DAD@DDHBBBB@B@B@FAB@BGFABE@CBC@E@C@CFABSFABABA@@B@B@BJFHFFBDBBDABADABAD@B@BBDFDD@JDB@D@B@CFCFCBE@I@IDKBC@GDGDABAB@BBD@B@F@BEDEFSDABCDCBWFIBE@K@I@IACACAC@EBGBMBGAEAC@CAG@OBEBC@MJGBGDCDEBABEBCBEBGBIDGBCBCD@DABBF@FAB@BB@BBDBF@FBD@HFHJJHFDBBJBLHDDHBFBHDDDBBDFADFBFDHDHDJDFBJDH@J@B@JDBBHFDBDF@HEDGHAF@P@HAJEFGLCJGJAJBLBFDHBHBF@HAH@N@JDHDJHFPLPJVJNFHBNDLBHDJFB@@BBDBDB@HDDBB@FBB@BDBBBBBDB@HDB@BBFFDBFAFBD@DBBBBB@F@BDBHBF@B@FBFBBBB@B@B@FBDAD@D@J@HDFBB@B@@F@BBBHFF@D@B@FDBBB@@B@B@BB@PIRCJELAVD`JLFH@HAHCJSHOJCbE`KPITMlGPODGVWHEFAL@NBPHX@dA^Dx@LBJFFFFALEBG@GDEBGLEJOLCJEhYTGcHGNKLUBI@_LAVENGBABK@GAEEKYMMIEEIM[IQGIESKKEQCM@GOEWJKLCB@JCBA@AB@AE@A@AAAA@@AA@@ABEBA@A@@AAAAA@GACAA@@ABG@C@C@@AAEA@A@@@A@CACAA@ABG@@@AAC@@AAACAE@A@C@ABC@A@@@AB@BADAFAB@@AB@@AAA@ADABA@A@A@@BABA@@@A@AA@BCBEBADGDEDEDGFEDEBADGFEBCDEBGDE@ABEBCBEBG@@DGDE@@DGDCDEBAHCHE@@HEBAHEFCFEDABGBAAGAGACGEEECAGCAAIEAAGCCA@@AAC@CACAA@CAE@A@CAA@CAAAC@GBA@AACAACA@@@GAAACAECCCA@AAG@@@@AAAAA@@ACAA@A@@AACA@@AA@@CCAAECICGCA@EEGEAAGEC@GCICAAECAAEEECEEACCEAAEECAEEEEAAEEAAEEACEEAAGCCGACBE@CAGAEEECEAEAABG@ADEBCB@ICE@IAE@KAIAA@EECEAAEEEEIAE@I@EAIAEAI@AA@@@@@ADABABC@@AA@A@CAA@AB@B@DAFAB@BAD@B@B@DABAB@FAB@DAB@@AD@@@DA@B@@B@DAB@B@BAB@@ABEB@@ADAD@F@@AB@@CBC@AAA@A@ABABABABABAD@BAFCBABGBC@AEEBAFEJGDCHIBE@EBCBC@AAGAC@WAAAC@CACAC@@@A@@CAEACAA@AA@@CA@@AAACAA@@@AA@@AAA@@AA@@A@@AA@@@A@A@@AAACAAA@A@@@ACA@AA@AA@@@A@@@@A@ABA@C@A@A@AA@A@@@@AAA@A@A@@@ABAB@@A@EBCAC@C@EB@@A@C@C@C@EAC@C@CBC@ABA@A@A@A@@@A@@@AA@A@CBABABCFADAD@FAD@FAFCPAdIJCFARENCTENELCFCJEJCNGJGJEDALCDAHAD@DADA@A@@BA@@BABAD@BAB@FC@@BABBF@BBD@B@B@@@@@@B@@BB@@B@D@B@@AB@H@B@@A@@@A@@AA@A@@BADA@@@A@@BAFA@A@@@AB@B@B@@@@AC@AA@@B@BAD@@ABAB@@@@A@@BABCBAB@BABA@@H@@A@@BA@@BB@@BB@@B@@@B@@A@@AA@@@@@A@@B@FB@@BB@AB@D@@@BA@@@A@@@@C@@A@@@A@@@@DBB@B@@@BA@@AAA@@A@@@@@@@AD@BAD@@@@A@@@@B@BBB@@@B@@ADB@@B@B@@@BBB@@@DA@@B@@@BABAB@B@B@@@@A@@A@E@E@AGAEAECEAEFCHE@AHCHCHCHCAGACIAGCEEA@@G@AFEBCHCBABG@EBE@GDEBECGCEEECCICAAGCCAEEEECCEEAAIAIAIAICA@DEFGBABE@CAAKGMIMGA@@ABABCBADCBA@A@@A@A@@AABCDABA@@@@@AAAE@A@AFE@C@A@@AAA@C@A@EAAAC@K@E@A@A@@A@AAM@AAAAAA@ABA@ABAD@FABABA@CBEAA@AAAA@CBA@AHCHCDABA@A@AA@AAC@C@A@IEAA@@@A@CBA@A@@AAEAAAAC@ACAAAAAAECEACACCAEAAACCOOIIWaU[csS[CGAEQ[MMOUMM[eIKS[KKYYOKMGGEOKOKIACCCAC@AAAAEGACCAC@EAE@EAOGEAA@C@AEGICCA@@@ABAD@DADABC@C@EAECECAABEAEA@CCAA@G@EA@AAIAG@@ACC@AA@ABCDCBG@AAAAA@AECGAA@AKGGCOCGAOICCAACCA@A@CDEDKBCAA@AAA@IAC@KEEAEGEEACCEA@C@AACCCGACCAEACAA@GBA@C@ECCCC@A@C@CDA@A@A@A@GEEAC@C@GBEAACC@A@A@GBABC@ABABC@OAE@C@CAECAAA@AAEBE@ECIEGAE@C@QEA@CAE@GBEBW@E@O@QAK@IAG@ICGAA@EEC@IEC@O@ABCBCBA@E@CA@C@EEMAASQEEBM@K@]BABc@eEaKCS@GCYEQAEAEAGAEACBA@GAI@KAGAEAC@ABAy|o\\UFEJKNIXUb]xk`WNINGFAFAFCRKJEDALOHETQFEDC\\WJGLIHG\\[VQ\\WVQHE`UJEVQ^YRONGNGRKHGLM`mFGFGZaJO^iJONSPUJMJKLMDGLMPQFGDCFCJILIJGHKBABCLQDGFIPQLKLKDAAEAG@AAC@CACAGAA@C@C@C@G@EACACACAI@CAACEAE@C@G@@ACAE@CBC@EBG@EDI@A@C@EBA@C@I@KBEAA@A@C@ABGBKBG@A@A@C@A@@@E@[@ABCBC@C@GAE@KAC@A@ABG@C@I@I@CAK@A@C@C@EBG@C@C@CAE@E@ABG@K@C@CAU@CAC@CGY@CFK@AAs@A@C@@@E@A@O@IAEAE@EAG@C@C@ABE@CAA@GAAAE@A@A@G@ABA@C@CAIAE@AACAC@C@AAEAC@ACG@C@CAC@C@C@A@A@CB@AE@C@AAG@AAEAC@C@KA@@C@AAC@A@CCI@C@A@C@AAC@AAE@IAO@G@C@AAQ@AAC@EAEAE@EAM@CAECQAEAGAE@C@AAC@C@C@G@I@GBE@CAE@CAG@CAE@G@C@AAG@AACAAACAICECIACACACCIAGAEACACACACCECE@CACAECE@AAAMaAEACAC@AAAAECGAAAAACACCEEGAECCACAE@AEGACCG@AICAACE@A@EAEA@CKA@AC@CAAAAAAAAAI@EBE@@DCB@FCDEDA@A@ACOAMAOBEC]@UBGFGDIBE@OAwC_AWAECMCGAGEECECECIACEEAEEGCECCEKAECGAE@E@CDCDCBCHGHEDEFCBEBCS@׻@Ε@Ɂ@@ˢ@D@HBF@H@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@F@H@HBH@F@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@HBH@F@H@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@BBHBF@@@H@H@@@H@HAD@HA@@HAH@DAFAH@B@B°Æw¸yÖ¡âÌEHBH¸Ü¼ª\\bLN@X@BB @DiZ½s^QNSLQJABADAFCJABIDABEDCBCDILGHKHCDGHABB\\B`BVDhFDjDf@DE@CZE~M@FEjEt@@CnC^ABA@@BADAB@@@BBB@BBF@BBBBB@BBBBD@@@@A@BBHFHDBBF@B@D@FBFB`HXFHDDBFBRFDBB@NFNDHBDBLBDBDBFBD@TDBBD@DBB@B@FBHBFBJBF@B@B@DBD@FBJBNBXDPBHBB@D@BBD@DBJ@F@D@F@JDDBLDD@D@JDD@F@HBBBD@@@JBFBFDDBFBB@HDHBD@JBBBD@BBB@DBHDDBDB@@DBD@B@BBD@HFB@BBHBFBD@D@FBLDJBLBHBB@D@F@@@@B@BB@@@@@BAB@@@D@NBNDH@XD@BFFDFXPFFJHLHJJJHDDF@TCD@B@B@`@DAJAB@@@H@F@@@HBN@R@B@JAD@Z@LBD@HBD@D@DADAD@DA@@B@BAB@B@BAB@D@DCA@UGYG[IUEFG@@B@@@@@B@@@B@@@B@@B@@B@@@B@@@@@B@A@@@B@@@@@@@B@@B@A@@@@B@@@@@@@@@B@@@BBBAB@@@B@@@@@@AB@@@@@D@@@@@B@@@@@@@@@@@A@@@@@A@@@@@ABB@@@@@@ABB@@A@@B@@@@@@@@@B@@B@@@A@@@@@BB@@@@@@@@B@BBB@@@B@B@@@B@B@@@@@@@B@ABB@@@@BBB@@B@B@@@BBB@B@@@@@B@@@B@@BB@B@BB@@@@B@BB@@BB@@@@B@D@@@B@@BB@@@B@@@B@@@@@B@@@@@B@@@@@B@@@B@@BD@@@BB@@"],encodeOffsets:[[89656,48561]]}},{type:"Feature",id:"654324",properties:{name:"哈巴河县",cp:[86.418621,48.060846],childNum:1},geometry:{type:"Polygon",coordinates:["@@UDO@{FK@]BG@aBQBMDOBMBWJOFMDKFEDEBEBMAMCIAC@L]DODKBGBM@_@S@GDGFKHKHONKJCDCBSHIDgTSJSJIDMF{NEBIBMBQB[DQFOFIDEBMFKDiJIBQDGBiREB]HGBSFGBUFIDKDM|ABEBA@CDAB@DBD@D@DAA@AA@@@@DBDDF@@BBDDBFBDABADCFAHEHCFE@GBAB@B@D@@@@@@FFBB@B@B@BAB@B@BBB@BAB@B@BAB@B@BD@B@D@BBBBBBAB@B@BDBB@D@DB@B@BABAB@B@B@B@BBB@BABABAB@BBBABABCB@BDBB@DD@BAB@BD@BBD@B@D@BBBBCBABAB@@@B@B@B@B@B@B@B@BBBBBDB@B@BABC@ABABBB@BDB@BABAB@BDBB@DABB@B@BAB@BABADAB@BB@D@BB@B@B@B@BAB@BAB@B@BABBBBBD@BADABADB@B@B@B@B@BBBB@DBB@CBABCBA@CBABAB@B@B@BBBBBBBBBD@B@BB@B@@ABA@@B@@BBDAB@B@AB@D@@DBBB@BBD@@B@B@B@B@@@B@BAB@@BBB@DBD@B@B@BBB@@B@DBBBBBBBBBCBABCBDBD@BBDBCB@B@BBBBBBBABABAB@B@B@B@BBBB@D@BADB@D@BAB@BD@B@D@BBD@BBBBBBDBBBDBBBBBD@B@DAB@D@DAB@DABBB@DB@B@DAB@BABBBBBF@BB@B@BCB@B@BDB@B@BBBBBBBBB@BBB@B@BAB@B@B@BBBD@B@DBBBDBB@B@DBBB@@B@@CB@BBBBBBABA@@BDB@B@BABBBB@B@DABABADABBBB@BBB@BBB@BABB@@BB@BB@BABCB@DBBBBD@B@BBBBDBBBDBB@DB@BCBABABBB@B@B@BDBB@DADB@BBB@BA@C@A@@BBBB@D@BBAB@B@B@B@B@BBBBBCB@BBB@BBBBBD@BB@BBBBBBB@@ABAB@BB@B@D@B@BBABAB@BBBDBABCB@BBB@BBBABABAB@BBBBB@BAB@B@BAB@BBD@B@B@BBB@B@BBD@BBBBBBBDBBBBBBB@BAB@BBBBBDBBBDBBBBB@B@BABBBBBDBBB@BA@CB@B@BBBD@B@D@BBBBDBBBDBBBBB@BBB@BBBBBDBB@D@BB@B@BBBDBBBDBBBBB@B@BDBB@BB@DBBB@D@B@B@@B@@ABBBB@BBBB@BBB@BBB@B@B@BBBDBB@D@B@DBBBBBB@@@B@DBBBDB@BDBBBD@BBF@D@BBBBDBB@DBB@DALAHAD@BBDBD@B@B@D@B@FBHBBBDBBBB@DBBBBBBBFBBBD@F@D@BBD@B@D@JDB@D@B@D@B@DABBDBB@DABAD@B@DAD@BBD@F@B@D@BBBBD@BAD@B@D@B@DBD@BBDBBBD@B@D@B@DBFB@@@@N@R@N@P@PBV@T@R@VBR@T@P@T@XBX@X@P@PAR@VAX@R@XA\\AP@ZAP@ZAN@TAPAR@JAHBFBFFDDFFDDHFBDDFDDHDH@B@B@D@BAB@B@BABAB@B@B@BB@B@B@@BBBB@@BB@BBBD@BBB@BBBB@@BB@B@B@@BB@B@@BB@DB@@BBBBBB@B@DB@@BBB@BBBBB@B@BBBB@@AB@BBB@@BBBB@@BB@B@B@@@B@B@BBBB@B@B@B@B@BAD@B@B@B@B@B@BAB@BAB@BAB@@@B@FBBBBB@BBBBBDBB@@ABADADAD@D@H@HBH@F@D@F@F@D@FBB@D@BBBB@BBB@D@BBDB@DABA@A@A@CBABABAB@D@BAD@DABABABADCDCDAHAD@FAD@F@FBHBJDFBHBJDDBHBHDNDJDJDLDHBJBRHJBHDHBFDJBDBJBHDJBNFJDJBNFHBHDLDLDLDHBJDBBFBDDDB@BBDBBBDBDBBBBDBDB@BBB@BBB@BA@@D@@@DA@@B@B@B@BBB@BAB@BA@@BAB@@@BAB@@@BBBD@B@BB@@@DBB@@BB@BDB@BBBBBD@DBBBBBBBBBBBDDBB@BDDDBBBDBFBDDDBBDBBDDBB@B@DAB@B@D@D@BBDBDBBDDD@DBDBD@F@D@F@F@D@F@FBB@DBBBDBBBD@F@B@DBDBBBBBFBD@DBBBB@BD@BAB@BBB@@@B@@B@DB@@B@BB@@BB@B@@BBBB@B@@@B@B@BAD@@BD@B@@@BBB@BB@@B@@BBBBB@BBBBB@BBBBBBB@BBBBB@BBB@BBB@B@DBDBBBBBB@BBBBBBB@@BBBBBB@BB@BB@BBBB@BB@BBBBFD@@BDB@@BBBB@DB@@BBBBBB@@BBB@BBB@BBBBB@@BBBBBB@BBB@BB@@BBBBD@BBBB@B@@@B@BBB@@BBBB@@BB@B@BB@@BBB@@BBBB@BB@@B@B@B@@@B@B@B@@AB@B@@AB@B@BA@@B@B@B@@AB@BAB@@@B@BA@@B@@@B@BA@@BAB@B@@AB@B@B@@@BABA@ABABAB@@ABAB@BA@AB@BAB@BABAB@@AB@BABA@AB@BAB@@AB@B@B@@@B@B@@@B@B@B@@BB@BBB@@BB@BB@BBBB@BB@@BBB@BB@@B@B@@@B@B@B@@BB@B@BB@@BBB@@BBBBBBB@@BBBD@B@BBB@B@B@BBB@B@DBB@B@BBBBD@BBB@BBBBB@BBBBD@BBBBB@BBB@BBBBB@D@BBB@DBB@B@BBB@BBB@BBBB@BB@@D@@BB@@@B@B@BB@@B@B@@BF@F@@AB@B@B@BA@@BAB@BA@@BAB@BA@AB@BAB@@ABAB@@ABABABABABABA@ABABA@CBA@ABA@ABA@ABA@A@ABA@ABABAB@@AB@BAB@@AB@B@BA@@BAB@BA@@BABCBABCBA@CBA@A@ABA@A@ABA@A@A@CBA@A@A@ABA@A@ABAB@@@BABBB@@@B@B@B@@@BAB@@ABABABA@@BABABA@@BAB@BA@CFA@@B@@@B@@@BAB@BA@@BAB@B@@AB@B@B@@@BAB@@@B@B@BA@@B@B@B@@AB@B@BA@@B@B@@@B@B@B@@@B@B@BA@@B@B@B@@@B@B@@ABABAB@@AB@B@B@@@B@B@B@@BB@B@@BB@B@BB@@B@B@B@@ABBB@@BB@BBB@@@BAB@BA@@B@BAB@@AB@B@@@B@B@BB@BB@B@BA@@BABABB@BBBB@@@BAB@B@@@BBBB@BBB@BBB@BDB@BBBB@BBBBDBBB@BBBBBBB@BBB@B@BB@B@@BBB@D@@BBB@D@@BBBBBBB@BBB@BBB@B@BB@BBBBDDBD@D@D@B@B@B@B@BBD@@@BBBBHDFDDF@BB@BBH@F@BBB@@BD@DBDBDBDBFBD@B@BBBBFBFBD@D@D@BB@BBBBD@B@@BBDDBB@@BBB@DBDD@@BD@BB@@B@B@BA@@BABAB@BBB@@BBABABABAD@D@D@F@DAFBB@FBDBB@DBB@BDBBDB@BBBDBBB@BBB@DB@BB@BDBBBBBBDBFBD@D@BBB@D@@@BB@B@B@DBBBBBBBBB@DBB@BBBBB@B@DBBADBB@BBF@HBD@H@F@HAH@FAB@F@D@B@BAB@FAJ@L@L@D@F@JBJBNBJ@JFFBBBDBB@D@B@D@B@BBBBB@B@F@@BDAH@HAD@F@DADAFAHANAFAB@RAPBbHNATIXPNFLXHBEPCZO\\@fAVCPOTSTQ@@VAP@|HXDNMbGJAKCEKCGBADA@ABAAC@@ECCAICECAAAC@E@A@A@QAC@EFABC@A@AECCCCA@A@@DCBA@@BAAAEEGAAA@@@C@AHEDE@@ACCCC@CCC@AAAA@C@AAAECAAI@E@I@IAAAA@C@EAE@CAAAAC@AACAA@A@@BABABA@@@C@CCEACAACCAA@ABABCBA@C@A@A@ABC@G@CBCDCB@@A@@BCDC@@@AACCACAA@C@@@CAECCEEIAAAC@AA@CCCCCACCCCEAACOKACECAAAAA@GCBG@CEE@@CAAACAAAACAACAAA@A@CAAAAC@AAAAA@IBA@CAABC@C@AAC@A@CBABC@A@CAAAA@C@A@C@CAABABA@ABA@A@AAA@I@A@A@C@A@E@E@A@CAA@AAA@CBA@@@A@@@@A@@@@AAA@EAE@ECECIECAA@@ACCA@AAC@A@A@C@A@AAEAC@AAA@AAACECAAAA@@AAEAAACA@@A@@A@C@@@@AACCA@@CAC@AACAAGEA@EBGBC@U@I@E@CBC@C@C@A@ACEECCGE@ACCCECA@AAAAE@EACAE@A@CAC@A@C@G@AAA@I@EBC@A@AAC@CCGEICEGEC@E@I@C@CAEAA@CCAA@AACA@AC@AA@@ABCDCBA@@@A@AAC@CAAGEECCAGCCAG@GAG@MAG@M@E@AAA@CACCGECACCCA@AA@@CBCDE@ABA@CBCBE@ABA@E@ABABAFATOFCFERKDAFCDAJEJCHEPEFCFAHAHCNCJ@HAHAJAPCZEFAB@DADADCBA@C@AB@D@D@B@DC@A@CBCBGBGBCBCBCCAAAAACA@AA@DCBADCDADCB@BCBCJCFE@ADC@C@GCEBCA@AAECGECAEGA@CAC@GAGCECCEAAAABC@A@ADC@CBCDEHKFMBGBCBI@ABAAAIKCE@C@@@CBABABABAHACA@@AAAA@@A@@@AA@@AAAEACAAAC@C@CBABA@A@G@CAA@AACECAACACAC@CAGAC@E@A@C@@AAABABA@AAACA@@AACEAE@AAAAA@@AEGEAA@C@EAAACCAAAEG@AAA@AACCCIEECGAAAAA@C@A@A@C@@DA@@@A@@ACAEAAKCCAC@A@A@CAA@@@C@@@AACA@AAA@@AAA@A@A@CCCAAAC@A@A@A@C@I@I@GAK@E@EAE@E@AAC@E@CAAB@ACAA@AA@@@@@CA@@AOIA@ICA@CCCCAAA@EG@@AAAA@A@@BG@CBCBC@ABAFABA@AD@@ABC@A@A@ECECCAC@AC@CBAAA@A@@@CC@A@@A@@@A@@@A@@@@A@@CAA@AA@@@@BC@A@@CEB@@ABC@@@A@ACACCAC@A@CBABAAA@C@A@AAAAAAAACECACAEAAA@@CCE@ACA@@A@@A@A@A@@AAAAACCCAABC@AB@@@BA@ACAAAAA@CACA@@CC@@A@A@@AA@AAC@@@@BA@@BC@@AA@A@@@AB@@@BA@A@@CA@@@AB@@A@@@A@AA@BA@ABA@A@A@@AAA@AAACCA@ABA@C@@A@BA@ACC@@@@BABC@@@A@@AA@AAA@C@A@AAA@ABA@A@A@A@AA@@@AA@@A@A@A@ABABAB@B@@A@A@A@A@AAC@A@AA@@@C@@AA@@AACA@AAA@@A@@A@@@@@AA@G@K@I@OAG@A@A@@A@A@CAKCO@E@EAGBG@IBM@C@A@@CACAGAGCEAMCCC@AACACEGIMGKEKAACGCGGMISACA@@EAC@EAGAMAE@AACACEO@@@ABAJENCFAHAB@BA@@@@AC@A@C@EAABEBCBC@A@@DCBA@AA@@@M@C@E@G@A@EAIAC@A@A@AAC@A@AAA@AA@CACACAMAAAA@A@@@ABA@A@C@CACCGEG@AAAAAAAACA@@@AAA@AAA@EAC@AA@@A@AA@@@@@A@@AAG@@@C@C@@@@@ACAEAECIAA@CKWCE@A@@VGJCFCBAAA@@@AA@AAC@IC@@AAG@EAAA@AA@CAII@C@ACAC@CAEAA@CAAAECACAAAAAAIAIAKAMAEAKAQCEC[KIAICECMEGEGAE@K@E@KAG@QDSHC@IDEBIACAGIAGAA@CAGAA@@AABC@ABCDEDKBCFGHIJMJGDA@ADADEBCDCDADEBCDED@BADCDIBAFCDCBEBCDCFEDE@ACIES@GAI@OAGAM@E@GBGAWBKACCg@GAM@I@GAGBE@CBGBI@OBY@GAIECGEEAGAKA_BG@M@EBODQFePWLyXWHYLaX[X_^QBĭXCDQAC@@B@B@DC@@DBB@FAFAD@BB@@B@B@B@BBDBBAB@BDBD@@@@D@BDBD@DBABCD@BCDADAB@DC@ABBDABG@A@@D@@KDAB@BDB@@ED@@BDBFADBDBDHDDDBB@B@@CBIBO@UBCB"],encodeOffsets:[[88222,48946]]}},{type:"Feature",id:"654325",properties:{name:"青河县",cp:[90.37555,46.679113],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@KC@AACGOACAA@AAAAACEAA@AA@@@@@@@@A@@@@@A@@AAAA@@@@A@@@@A@@AA@@GGEEACCCEGIKEEEEAAEECCCECEEGGGEEKOCCEGEEAACGEGEEEG@AA@@AGGEEIIEGACCCCECAAAEEAAA@@CAA@A@ABA@A@AA@AA@@@A@CB@AAA@@CAA@@CEACACAACICGAAEEACACCC@AA@AAGIACA@CECA@ACACCCCOKMKCCEEIECC@ACCCCEC@ACA@AAACA@@CAGEAAAAAAAA@@@AECAAAGEEAA@AEEGQEKAC@ECGEICIACCI@E@@AAACCE@AAC@CAG@AACCCCCCCA@@A@AACBGAA@CAAECCAEACAAACCIIACCAAAGEEE@@@C@ABADC@ABABEBEBE@CAC@@BCBA@CBE@A@A@ABAJEB@@@@AAA@@@A@@@AD@DCBA@@@AA@C@CA@@AA@A@A@ABCAA@@@A@@AC@@@ABA@AFEBA@@@AA@AA@@ǉ@Ǖ@E@Ã@BF@FB@DB@BBB@B@D@@BBBBBBBBBBBB@BBBLFDB@B@B@DADCDCBCFAD@D@F@BDFBBBF@D@BBBFDB@DBBBB@@BBBDD@@BBH@DBD@B@FBDBHBD@RFFDFBDBF@FBF@JBJBNBLDDBJFLFJHJHNHFBHFHDFDFBDBDDDBDDDBFFJFFFDDDB@FBDADEF@B@D@@@BCBCF@D@D@@A@@B@BB@@B@B@@DD@B@B@BGJ@BEDCBAB@BBBHRFNFNBDBJDDBLBDBDBFDJBFBF@DDJBDBDBFDD@DBDBHBDBFBF@FBDBF@FDRBB@D@DBDDRBFBNBF@FBD@FBHBTBJ@D@HBB@FBF@HDL@H@D@B@J@JBJDLBF@BBFBBBF@HAFAD@@@@BB@B@B@BDDBF@DABABAB@BBB@BBBBB@B@BDB@BBBAB@BADCB@B@BA@@D@FAP@H@DAF@H@FANAL@B@FF\\BFBHBNBHBF@DBH@B@N@B@H@F@BBF@D@D@B@DAJBFAD@BALANAF@FAF@BAD@BABCDEHAHAF@D@D@D@LBD@F@HAN@F@BA@A@EDIFKFCFMFCDCBC@GBEDG@EBOHEBCBGFGDCBE@ABCBAB@@BBDD@F@D@F@BB@BBDDBBDBH@DBDBDBBBFHBBLFF@LDD@DDB@D@BBB@BBBBJBDBDDDDHFFDBDDFDHBP@NDVBRAFBF@J@F@F@HBN@FAL@FADADEF@B@B@BBJBD@JAFAF@B@D@B@F@F@F@XAFAHCFCH@D@F@F@FAF@HADAH@B@DBLD^BLBV@BABADEFGJEF@BADAB@JANABAJADAD@HAFAHCHELADAD@B@B@@BDDDBDBBDDB@BDB@BBBDBDBD@F@@@BAB@B@BFHDFBHBF@BBBB@DB@@@D@B@D@B@BDF@BFHB@BBDFBDBB@DA@ABA@@BAB@BBB@@BDDB@BDDD@DBBBBBBFBDDBB@H@D@B@F@D@FBFD@@@BBB@@BBBB@B@@AB@B@@FFB@BBB@D@HBB@HDBBFBDBD@@@BBA@@B@B@B@BB@D@B@DBBBAB@@@BD@@BBB@@BB@@ABABAB@FADAF@DAF@B@BBB@BBB@BAD@@AB@@AB@@C@IFABEDGFCDIHCDCFA@AD@BEHABCDGHEDABCBEDABEFA@C@ABA@ADA@BB@@DBBB@@DAD@@@LFDBB@B@DFFDBDB@D@DBFDB@B@B@BBDBD@BCD@D@RDDBB@B@@BBB@@B@B@B@FBB@BBFBB@BBB@@DGLAF@BCBADCFADKP@B@L@BAB@DAD@BCDAFCDEFCF@BAB@FCD@BAFCJCBABAD@DBBEDAD@BABAB@BCDCBCBABABAB@DABABCB@B@@A@I@ABABGRCDAFCDCDcVGFGHCDELCF@B@@A@ABBDAFAF@DABCDKJOPUTSTUXMPMNIJON@DBD@B@F@B@DBD@B@B@B@BAF@@@B@B@B@DBB@F@L@BAH@@@@AB@DAB@D@DAFAB@B@DAB@B@F@D@FAF@B@BCDAHCHAF@BA@ADADADAD@B@D@B@D@B@D@B@BAH@B@J@Z@B@F@B@L@D@B@JBF@B@B@B@D@D@F@D@@CNEP@BAFADAH@B@@AFADAF@HCN@B@D@B@BAFAJAD@DABAB@@@B@FADAFCHABABAF@@AFADCHCHAD@DADADEL@BEH@B@B@B@FADAJ@DAD@FAD@B@D@B@B@BAB@B@BAB@DAFAHAH@BBDLLFDNHJJPLNLHFFDBBD@JAJCPGJEFCDABAB@D@DBB@BB@B@BABAB@B@B@BBBBBBDB@B@@B@@BHBF@DA@@BCBA@@BAD@B@FABABABADEDAD@B@B@D@D@@@DAB@B@F@@@BABAB@@BB@@BB@B@B@D@@@B@F@D@D@F@B@BBDDBBB@B@DA@CBCBABB@@@@BBF@BBBB@@B@DBH@D@BA@@BBL@J@HBD@FBBDDBDBBBBB@DDFBBF@B@BAD@@BDBBBDB@DDDB@DBBB@B@@@B@@@B@B@B@BBD@DAD@@B@@BBDFBB@BA@CDCBC@EDCBGDABAB@B@FBB@B@@@BABCDABAB@D@H@B@D@F@BBDBD@F@@BB@BBB@BB@@B@B@B@D@@BF@B@@ABABA@@BA@@BADEF@D@B@@BBB@BBDB@BB@BAJEPEDAB@BAB@FBB@@@BD@BFFBBNHB@HB@@BBHDDBDBD@D@DBB@BBB@D@HDFBBBJBB@BB@B@D@BBDB@@@B@D@J@D@D@B@BB@@@@BDBBDB@BB@DA@@B@JDHDDFBDBJ@@B@v@dR^`J^PT^PPT@TGfAPBRBPg\\E\\j`rLf`dJTHFBBZPfN`T^jPr^dNjRzAjHNLnGzGZVzd`GhKfO@MncLOHM\\qDOP[TEPSAWBMHI\\GNMHUTYVKXKFUDCLOfEJKE_dcj[NU`cPMFOxgNMF]JG|FX@n@nSRQvY\\GDGFI`YGc@OJMN[LIbGJIEwIMIQCU^UNKNKXITO@kvELIB[VUdgOsGOK[GiCMOIwGZBHIHIHUGSMQMMAaOQ_OEIHGCIKIAMGOAMBa{qSEWUOugMMg]MSYGDWNSvhqIQEKdyh}LMRIfiFOAcAiG_XUHcE__WcWkaaWskycgiw}sSOMUUQM@Y@{AWDMGC]KOEKC[IKCGCSGYMEiOKCIC]KWGKCAA"],encodeOffsets:[[92673,46513]]}},{type:"Feature",id:"654326",properties:{name:"吉木乃县",cp:[85.874096,47.443101],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AA@@CA@@C@C@@@A@A@GAA@@@CA@@G@E@G@EAA@E@E@C@AAA@EAE@A@EA@@A@CBE@GDC@EBA@I@GBE@SA@@A@O@Q@E@EA@@A@@@@@MCIAMAC@CAQCCAOEE@EAGAIC@@@B@@BDBD@@@B@@A@A@@@EAOKKCC@ICCAICOCC@EAA@CAGAMCEACAECA@AAMBKCIAQEE@GCI@CAEAA@A@@@IAA@@AE@K@E@CACAC@C@A@ABAA}IgEC@A@E@E@C@AAA@EACAI@E@S@@AGAA@@@EAC@A@C@C@G@A@ABA@A@G@A@AAC@UAE@AD@DBBBBDHBB@@@BA@CBEBGDABABGFAB@@@B@@@BA@ABC@@B@@GDEDCBC@G@EBABGFABABAB@BADA@@BE@A@GBGBCBCBA@A@EBGBA@CBE@C@C@I@C@ABEDCBC@C@A@A@A@A@CCAAAA@@E@@@CAA@@@CBA@A@A@E@GBC@A@GAA@@@@CA@AAA@@AA@A@IBC@C@A@ABED@@A@GECEA@CA@@A@G@A@CAEAA@@@AAEC@@G@G@G@ABA@C@ABEBC@A@AA@@AAAAA@G@E@A@IBA@A@SCC@A@@@@A@AA@A@@@A@C@EDG@CBC@@@AAA@CCA@A@CGA@@AC@AAC@E@KB@@C@A@EBEDA@A@@@A@AC@@ICC@EAA@AB@@CAC@A@E@A@ABABA@CA@@ECA@A@A@@@A@ADABA@C@CBA@@@ECC@A@A@ABABEBGBC@ABA@@BA@CAA@CACA@@AA@@C@EBE@A@CAKCE@CAEAC@A@A@GB@@A@AA@@A@A@I@C@CBA@@BA@@B@@@@ABC@C@@@A@A@C@A@A@A@A@@@ABC@ABAA@@A@AAAA@@CAC@ACA@@@A@ABCBA@ABA@AB@@@@@BA@A@ABC@@@A@AAAAAAA@A@AB@B@@A@CBA@A@CBC@C@ABE@I@E@ABA@A@A@A@A@C@A@A@AA@@CA@AC@AAAAAC@ACC@@A@EBC@A@C@A@A@A@A@A@A@@@AB@BA@CB@@C@@@C@AACAA@CCA@AACBC@B@@@DB@BBD@BBD@@JBBJEd@DDLBDAFDHFJBHHLLLHFRHDBDBBH@J@DDDBDBDFBRJJHNHRLJHBBNLFDDFDDPHDFBH@BBBBBBBBFBJDFNHFHDBJFTFHFJLDFAFBHDFAFADIDABAD@@BBJJBJHJDHBBBDAHFHDF@HAFINCDCDCBCJCDKDABADABAB@@@BBHDHHDHFBF@BBL@PDNAJ@BBDBFD@D@F@BBFFAFFJALABGFOLABADAL@@@B@F@BBD@DCH@F@HAPGJILEFADADGFIFG@MFIJMLGJ@BGNIPMLGHCDADABAJ@BCF@BBBBHAF@H@RBDDDBDBFDTI­̎GhLCJCVEHATEHA^GFAjQHARCJAjILCNEFAJCPERE\\CRANAJAFA|MNEJCTITIhSJCTGDADCLIPMLGLGHEHCT@`@N@HALAPC^CKD@JBNDNBFAFAFCLENCPEXINAPANCRAbAH@^AL@|EP@VCDAVAP@JADA@@@AAACCGCACACBCAEAC@@FC@@CA@ABALC@@@CB@H@BAACBAD@@CBABCDC@ADCBACAC@CA@A@C@@C@CA@ABAAAAC@A@A@A@AA@@ABCBE@EAA@CD@@C@A@AD@RBDCĮWRA`]\\WbWZKXGzWXKfOREPCFAN@H@`ALBHBFBHFFD@CACEGGQMQCAKGIEEEEIACCGAQAaASACBGFSBEHIJEZKLCPC`ETCZGbM`E\\EpMRGHAZKVGZGREXCLAJ@LfBB|@RALAPCPE\\EVE@@PC\\GdIJAREREHATGPGVILEPEJCHCZQPIHGFGDGDAJGBC@EAE@@BAJABA@A@@AA@ABI@CAAAA@@@A@CDKDCDO@GACCICGACIECAGIIIIMAG@AGCGEAAB@@@@@ACAA@AAAAA@AAE@AAA@A@@BABC@AB@BAD]Dm@@FsFi@ENF}DYC@³OKKK­OEG@@@AA@E@CAG@aAcACAE@IACAE@GAG@GAQ@M@EAEAGAEAA@A@C@EBÉUUCMAQAcEK@G@G@KBCBGCIAE@E@A@C@CBGFEBEBC@EBCBA@EBC@KBO@I@A@CBEBA@C@EBDBBB@@@BABABA@A@AHAD@B@BA@@BAB@BAD@@@BBBB@@D@@@@BBBBBB@@@B@BA@C@CBC@"],encodeOffsets:[[88562,48222]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("博尔塔拉蒙古自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"652702",properties:{name:"阿拉山口市",cp:[82.559396,45.172227],childNum:1},geometry:{type:"Polygon",coordinates:["@@FCLGBATKB@BG@ALGJIFENGLIDANIDAJEDAFCDABAHCDAFCHCBANEB@DABAB@BEA@@AA@@C@@CC@@AABC@@AA@@AA@A@@A@C@E@C@I@AAE@CAA@CCEAAAEAGAGAG@ECA@CAOIAAGIGEAAAAAAAA@@AAAACE@AKSGQQcCG@I@ECGAAACBK@E@ASBMJCH]ZKFIHCFYZGB@BWTOHCLOHEJaTMPaZ@JILGDIFQCEEeV@JGHm`KCMHkJIFK@xYLEJQHEBRDRDTBaTKRK[KpIYPAÜph^@@PC"],encodeOffsets:[[84573,46303]]}},{type:"Feature",id:"652722",properties:{name:"精河县",cp:[82.890656,44.599393],childNum:1},geometry:{type:"Polygon",coordinates:["@@J_DOB]DKBC@AB@@CEAAAAAAAA@AI@A@ECC@EAG@C@CAC@CAC@CAC@ABE@E@I@IA@@A@I@E@I@Q@E@C@G@GAC@ODUFIBG@AIAE@G@G@CBIDCBIFIDG@@DEBEBEBADI@EA@@ACAAAIGIIIGAAEEAA@CCMA@@AEEGAAC@@AAECAEECCI@@AI@A@AA@B@@@AA@@@A@CBA@ABE@ADADC@@@A@A@A@AAAAAA@AACCCACCAACCAAAA@AAA@@BABABADABADA@@DA@AB@@A@@@@@A@ACC@@AAAAA@ABA@@@@A@@@@CAA@AAAAAA@ABA@@@AAAA@A@@@CE@@A@A@C@AAAA@AEAA@A@AAA@AA@@@C@@@AA@A@AAA@EAAAA@@ACAA@A@A@CCAA@@@ADE@A@@AACAAAA@@@DIB@@C@ABA@CBADABADA@ABCBC@A@@@AC@AAGAA@A@IBE@AAA@AAEAA@EAAA@@@ABAHEFAD@FIFEBEBIAGEMEMIKGGEIIKCKCG@G@IDINQJGJIJKJIDKDGDI@KBMBKBI@OAKBK@CBAFAB@D@BADAB@B@B@BAB@BA@A@C@A@A@AAA@A@C@A@ABAAA@A@@@A@@BE@@@@F@B@@ABABA@ABCB@@AAA@ABA@@CEAA@@@A@A@@AAAAEAAA@AAAAA@AA@AAAAA@A@CAEAAAA@@AA@A@AAA@CC@AA@AAA@C@E@CBAAC@AAA@@A@A@@@A@ABABABC@AAAAA@@A@KFEBA@A@CAA@ACCAA@A@@@ABADE@@BC@AB@@CB@@AD@B@@MCUCSAQCU@Q@UAQ@O@MBKHQJULIJGLCDGDA@GDABABA@GAABC@ABA@A@A@A@@@CAKBA@EB@@BBDB@BBBBB@B@@JJB@@B@@A@A@@BEHAD@B@BBB@BA@@B@@BF@@@BA@@B@B@@ABEBIBEB@BABABA@CBE@A@ABAB@@A@EACAE@A@I@G@A@AAA@A@E@E@@@C@CACAA@CBEB@@ABCBABC@E@C@ABA@ABQBOFODODQBK@Y@_AUAcCaCOASAK@IBMBSDKDCBGC@@A@A@A@A@C@C@A@AA@@AA@AC@@@@C@AAA@@@A@A@A@A@@AAAA@AECA@CC@@A@C@A@A@ABABE@AB@BA@ABA@ABID@BA@EDA@A@CAA@E@AACCAAA@@@@E@A@@@AAAACAA@@@@@A@@CACAA@A@E@A@AB@B@B@@A@C@C@@@EC@AACA@@AA@A@CAAA@A@ABA@@AAA@@ACCAAAAA@@@A@ABA@AAA@ABE@ABA@@BAACAC@AA@@AB@BABABAF@BAB@BBDA@@BA@@BA@A@CAC@@BIBAB@@@@@@@@C@CAEACA@@A@A@CBA@A@CB@BA@ABC@C@ABA@CD@@@@A@AAA@GAEB@@ABABABA@A@ABA@C@E@EAEACAC@C@I@CBABCBA@A@A@ABAB@BABA@ABC@AB@@@BAB@@A@ABAB@BA@@AE@CAA@@A@CAAA@EAC@EBA@E@A@AA@AA@AA@@A@AACCAAA@C@C@EBE@A@C@@AA@AAAA@@A@G@EBADA@ABA@@AEAAAA@C@E@EAIAA@A@ABCB@BA@CAA@@@ABAB@@AB@BCD@BBFA@@FAHBBBD@DAFCDGDEFCDABC@A@@BA@@BADCHE@CBIDCBEDA@A@@@A@@@GD@@ABC@A@A@@BA@E@@BC@ABAAE@@@C@A@E@C@KFA@CB@@A@QBMBA@E@@BAACBA@IBGDA@E@A@OBEDEDGBA@A@@@IHABE@ABEFMHCBEDA@E@A@AA@@EDCBCDA@A@A@AC@@A@EB@@@@A@@@@@CBABEBA@A@A@AAA@A@C@CBEFEDIBCBEFEBKBW@C@KHGBIDEBCFABABADCDEDEDA@CD@NCDCDAB@BA@BDA@EBCBC@GBE@ABE@GBBDA@EDCDEJCFADAB@D@D@D@BAD@BABADEDC@CBC@E@A@CBEBABADABABBD@BCBABADABADA@C@C@A@AAC@@@IA@AC@@A@A@@@AA@AACAC@G@C@CBA@CAC@EAAAA@C@C@C@@@A@A@ADA@AACAGCA@I@C@@@AACACAA@EAA@C@C@@@A@ABA@ADA@CB@@AB@BA@G@CBE@ABA@A@G@ABA@C@C@A@CAA@C@C@E@A@@@AAA@E@CAA@CBA@C@C@C@A@A@CBA@C@ABC@C@C@AB@BA@@@C@A@A@A@A@A@E@C@G@E@CDCBA@A@A@G@A@GBA@C@@@C@C@AAGDGDIFEFEDCDKBIBMBUDOBKDOFKDGBIBKBG@I@KAKAGCGAC@CAC@ABAA@@A@A@A@E@AAA@@@A@ED@@A@C@@@EBAB@@EBGBEDG@K@KCKBKBGBIDSDOD]HWDQDOBODQBSFOFMFMDGFAB@@CD@@ABA@@B@@A@A@C@C@CA@AA@IBCCA@A@C@A@A@E@C@A@CBEDAB@@@B@B@BA@@BGBABA@A@A@@@@@@A@AC@G@A@@DAB@B@@ABA@@@ABC@ABA@@@C@C@C@@@CB@B@B@D@@A@ABA@A@CAA@CAA@@DAB@@@@CDA@CBA@A@A@ECGCA@@AAACACAC@ABA@ABA@A@A@E@@@@BA@AB@BBB@BADAD@BBBB@@@@D@BBB@@@@DDD@@@BBFBHFBBJDB@H@FBFBLLBDBB@D@JHDHHFLDFHFLH@BBDBDNJBBFBLJDHFHBDDBBBHBFBFBF@HAD@B@HDD@BAD@B@F@JADAFAJAF@F@FBLAFA@@DBRDHBFBH@F@D@B@DFB@HBFDF@BAD@DBHCJ@H@D@LFB@H@BAD@FDLBH@D@D@JBHBJBD@J@@B@@@@ABIBE@C@ABA@ADADA@CB@@@@@B@B@BCDEFED@BA@EF@BEBCBCBAB@@BBBB@BB@BBlNFDLFFD@@XBD@LBLBDBDBJF@BB@BBB@PAB@FBF@BBDBBBD@DBJDH@BBB@FD@B@B@B@FA@AD@DCFCF@B@B@B@@CDABAF@@@F@BBB@@@B@F@H@@@@BBDAB@HA@@BA@@B@@@B@BB@@@@FADCB@B@@@BB@@@BA@AB@@@DCBAB@@@@@B@@FB@D@@@B@B@@BBCBAAA@@@ABGD@@@BABA@CBAB@@@BEAE@C@C@G@A@A@A@@@AAABCDA@EBABEAC@AAGA@@CBA@CA@@C@A@AAA@G@A@ABA@EBAA@@AAA@A@A@C@@@A@EB@@AAAAC@@BA@ABC@C@A@A@A@@@ABA@A@A@EBG@A@CAA@EAA@CA@@ACC@@@@B@@@B@@@D@@@BCBABEBCBEFA@AB@BA@@D@@A@A@A@CBA@A@@B@BA@@B@B@BA@ABAB@@AB@B@BBBBDB@BB@@B@B@@BBBBBHN@@@DBDBBDN@DBBBJBBBBBDDFN@J@F@`AF@@NBj@H@H@B@B@B@D@B@@@BAFAHA@CD@@@@A@CA@@@@BD@B@B@B@@AB@@A@@@AAA@A@C@@@CEAAAAAAAAAA@AABA@CF@D@B@B@BA@@@@BAD@@A@CBA@C@AB@@CCA@EE@ACA@A@A@@CCAAAAA@@@@@@D@@AB@BA@E@GBG@E@EAA@AA@B@@@BHL@BB@BBBD@@DFBDBBDD@DBB@BBBDDB@BDBD@BBB@BBDBB@B@FDBB@HDFDB@@DB@@BBB@@@B@BDBBBBBBBBBB@BBB@DBDBBBDBFBBBB@@BAD@B@D@@@B@@@DBB@@ABCDEDCDB@BBB@DBD@B@BBH@F@BBHBD@BBB@BB@@B@BBB@DAD@DBB@@BF@H@BBD@B@@BDBFFB@BBLDB@@BD@B@B@BABA@A@A@@@AA@@ABA@C@@@AA@@A@ABA@@BA@@@A@A@ABCDGFGHMDGBABC@@BCDC@ABABCBABA@@BC@C@G@EAE@@AAAA@AACAE@E@@@ADA@@@A@A@CA@AAA@B@@A@@@ABAD@@@BAB@DA@@D@BADAHA@@B@BA@@B@F@BAD@B@B@DAB@F@B@B@FADAFA@A@@D@BA@@@@B@@A@@D@@A@@BAEBKDaUWOGB@ACUAI@EAEVCAA@AAE@@CADAVEFNLAFJPEB@RCNhBHD@@@V@DFDD@@FFB@B@BA@@BB@@@@@@B@DA@CDC@@FAB@FABAL@B@B@D@B@B@B@F@BBDA@BBBB@DDB@@BBBDDFBHDFDB@BB@@DBHDHDBBH@B@D@B@D@D@B@BBF@BBB@@BB@@BB@@@BBB@BBB@@@@@BBBBBBBB@BB@@@BAD@DAB@HAF@D@DAB@D@DAD@DADAB@B@BAFCFAD@FAB@DADADAB@@@D@@@D@DBB@B@BAD@@@B@B@@ABAB@BA@@@AJCBAB@@@B@B@BAD@B@BAB@@@@ABAD@@@B@@@BBDB@@D@BA@DAB@BF@BABAF@@@BC@AF@DDL@DBN@DB@@B@D@DB@BB@B@FA@AD@DA@BF@@A@CDAD@@B@BBBF@BCB@H@B@DBBBF@@ABAB@B@@D@@F@DBFFD@BAH@FBB@D@BB@B@B@@BBBBF@BB@DB@N@DDB@@FABBBB@@@@@BAB@@@BB@@AB@@BBB@@@B@BAF@B@@AAC@ABAF@BBBBBDDAB@@DA@@BB@D@B@DAB@@ABA@A@@A@A@C@B@BA@ACC@C@@DADBF@DAB@@BAB@@BBB@@@D@@@D@B@FB@B@BC@@BABDDFDF@B@BAF@B@@DHB@BBB@@D@B@@A@AAA@AF@B@H@@@B@BAF@BA@ABEBAD@D@@BBDD@B@BA@AFCHAD@DB@DD@BAB@B@B@@BBB@DA@@B@DAD@@AHC@A@ECC@ABCD@DDBD@B@ADBB@D@BBDFBFADDD@B@B@BA@AAA@A@@BAD@DBB@BBBBBAFAD@@BDDBAB@B@BB@BCBA@AAABADA@BBD@D@BBBB@B@BB@D@@@BAD@BBBB@BB@D@B@F@BBBB@BB@BADADBB@BB@BA@@BBBH@B@@DB@BBB@D@DB@DBBDADBBBBBABCB@FDHFBD@DCHF@@DBBAD@@@@BBBBB@BA@C@A@BDBBDAHF@DDBB@@ADA@B@BA@BBD@B@D@BBDBBB@BABA@@@A@ABDB@@DAB@DBB@B@B@DBDBDBD@DBDB@F@@DBBBBBC@AB@@BBBADB@DA@CD@BDBD@DBBD@DBBAB@@ADDDDBD@FADB@BBBB@B@@@HBH@@@BB@DAB@B@BDB@FCBBBDBB@BADANH@BCBCB@DB@@@BBPDD@D@BBBB@ABB@AF@@BFBBA@@BAFDCB@@DHDAB@@BBBBDAB@B@BBB@DCBA@CAAB@BFBF@@DA@A@A@@H@BDBB@BABA@ADB@BGF@B@BF@@BA@ABA@@BA@@B@DDB@@m@_DKDMFIBADABCD@J@JAFCFIDAFBBCBCB@FFDFH@B@@ABAFAFAB@@@BAB@BAB@HAD@D@BAHCFAF@BCFADADCDCBABADCDADA@AD@BAB@BABADAB@BCDADAFADABABAD@F@@ADCD@@CDAB@BABAB@B@BEHABABAB@BABA@ABABABEFCDAB@BABAB@@AD@BCBIBCBABCBADCDGLAD@@@BAFAD@@@B@BBB@B@FALBDBBDH@F@JDHRdHRLT@BDFBBBB@@BBBBBBBBHFHJBBPJDBB@FDH@HBHBFBBBFBDDB@DBF@BBJ@D@F@D@B@@@@BBB@@BB@@ADBB@@DD@@@DB@@BB@AFA@ABCBtJH@JBDBDAXETATELATKFALEFCBAHCHCHCJEFAHEHAFCD@HCDAREFAFEDAPIZMFAFAHCTEFARGhMNCDAD@BARCLCJCD@DAD@HCFAJAHCDAFABADAD@BAFADAFAD@ZGTGpIHA^GpIRCdID@hILA`ELCPArG\\EJ@HAPANCZAXCVCJAVCD@PAPAFAD@J@JAF@FAN@J@TAJ@BADAB@B@@@B@BBB@@@DBBAD@D@B@@@B@@@BBB@@@B@@@B@@@B@@@BA@@@@@@@@@@@AD@BAF@HAVERCJAFAHA@@H@J@ZAZ@RBD@HBL@J@D@JAD@JALCHCB@BCBABEBC@AJEBAH@BAJ@BAB@BABCBADABA@@@@@A@A@@AACC@AA@@@A@@A@@B@B@@ABADCB@FB@A@@BAB@BA@A@A@@@@B@DABA@@BB@@@@BD@@B@BB@@BAB@B@B@B@D@@@@@@BB@@@B@B@@A@@@A@A@@A@@A@@@@B@BAB@@@@@B@B@B@D@@@B@@A@@@A@@BBB@@@DBB@@@B@@@B@B@B@@@@@@@@A@@D@BABBBAB@@@@ABA@@A@@@B@@AB@@B@@B@@A@@@A@@AB@A@@@A@@B@@@@@B@@@BBB@DBB@DC@@BBB@DAB@BAB@@@@@@BA@@@@@@@@@D@@@@A@@@@D@B@@@@@B@@@B@@B@@B@B@BAB@BA@C@@@@BB@@@@BA@A@@@ABBB@@@@A@@@A@@BCBABABA@@@C@@@@B@@@B@@@B@B@@@@@B@B@BABA@@BAD@FC@@@A@@@A@ABADA@@@AAA@@@ABA@CBAAA@@AABA@@A@@AA@B@@AA@@A@@@A@@D@B@@@B@B@@A@@B@B@@@B@DA@@B@@@B@@@B@@@DBBB@@@@@@@BB@@@B@B@B@BAB@@@@AB@@A@@@ABBB@B@@AB@B@@@@AB@@@B@@@B@B@@@B@@@B@@@@@B@B@@@@BA@B@@@D@B@B@B@@@@@@@D@B@B@@@BA@@@A@@DAB@B@@@@@BA@@@@B@@@BB@@B@BAB@B@@@@B@@A@BB@@B@D@@@@A@@AA@@@@BAB@B@@@BBB@@ABA@@@@B@@@@BB@@@@B@@@B@@@@@@B@BA@@B@@@@@@@@A@@A@@A@@@A@@@@@@@A@@B@B@DA@@C@@@A@@@@AB@BAB@@@B@B@@@@@@BBAB@@@B@@@B@@AD@BA@@@A@AB@@@@@@BB@B@B@@A@@@@A@@AABAA@CB@F@ACBADC@ABA@AJC@ABCBEDEDAD@B@@CDCBAC@@@CA@AD@B@B@B@BA@A@AAC@A@C@@@ADAHCBC@A@@AABA@@@A@@BA@ABAB@BA@C@A@@BA@@DCB@@@B@BAAC@A@@B@@AB@@@DBB@@C@@@@AA@@@AB@@@BAB@@@@@B@B@DA@@@AAACADCBAB@B@AAA@ABAA@A@AB@DBBACA@ABABAB@D@DB@@@A@AA@A@A@@@A@A@AAB@@AD@@@B@B@BA@CB@B@BA@@@@BAD@B@@@BAA@B@@AB@@@BB@BDA@@BA@@D@AAA@@ACBAA@C@AB@BA@@B@D@B@B@B@@@BADB@@@@@B@@DA@@DB@@@A@@@AA@A@A@@@@A@@B@B@@@@BB@@A@@@@B@B@@B@@@BB@@A@@AA@@B@@@@@B@@B@@@@BB@@B@BAD@@@@A@@A@AA@@@@@@B@DA@@@@@A@@A@@@A@A@A@A@@@@A@AB@@@@@D@@@@@@A@@@@BAB@@@@A@@@@BA@@@@@@B@B@DA@@@B@@@@B@@@B@@C@@B@B@@AA@@ABA@@B@B@@AB@@@@@ABB@@@@@B@B@@B@@A@@@B@B@@@@@B@@AA@A@@A@@@A@@@AB@@BB@@BB@B@@A@AA@A@@@@A@CB@@@B@B@BA@@DBB@BA@@@@A@A@@AB@@@@@B@@B@BB@BA@A@AA@A@@@A@@C@@@@B@@@@A@@@A@ABE@ABE@I@CBA@AAEAAAGAKAC@CACEGACGOEG@AAA@CEKCG@CCEAA@AAAACAG@E@A@AEO@AAC@AACAEAC@EACAECM@ACUAIAK@OAW@A@A@E@K@AAC@AAMACAEEKEMBABAB@FAB@NGJGB@FEFADAFA@@@@@CBCBCDCDAB@D@FGDCB@BC@ABE@A@@A@@A@@@A@@@A@@@@@@@A@@B@AAB@@AA@BA@@@@@AA@BA@@@ABABA@C@ABCBABABA@@@E@@@CCCACAA@C@CAC@C@C@SGEAC@iDK@IB"],encodeOffsets:[[85729,45532]]}},{type:"Feature",id:"652723",properties:{name:"温泉县",cp:[81.024816,44.968856],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABA@ABA@C@@@A@@A@CAABA@A@@A@AAAAE@C@C@AAA@@@CAA@A@@@AA@ACC@AC@@@@A@AACAA@@A@@A@AA@CAA@A@E@C@@@C@@@CA@AA@E@GAA@A@GDA@@@AA@@E@AAA@CBABCBA@A@A@A@CBC@C@A@CBA@ABE@C@CBA@A@K@A@A@A@A@A@ABA@@@AA@@A@E@E@CAA@@@ABEB@@C@EBA@A@A@@@AB@@A@C@AA@@@B@@ABAD@BABA@IBC@C@AA@@A@@ABA@@AA@@A@GAE@@@A@ABEBC@A@A@A@C@@AA@@@A@C@A@CAABC@A@C@E@AAAA@A@@@AC@G@A@@A@@@AA@A@A@A@@@AAA@A@A@A@ABA@A@A@A@@@AAAAA@C@A@A@@AA@@@@AC@AA@@@@@AAAA@@A@A@@AAAAA@C@@@CBA@AAA@CCCAC@CA@@AAAA@AC@CAA@@@B@BA@A@@B@B@BAB@@@@A@@A@@@AAA@@@@AEAE@ECA@C@G@A@@@AAGC@@@AGCEAAACACAC@A@CAAAA@AC@@@@AAA@GCA@CAC@A@A@A@E@CAE@IACB@@AAAE@C@@A@@AE@C@A@CBA@A@E@AAA@ABABA@A@@@A@@@A@@BB@@BDB@@@B@@CBA@G@EBA@CBABABEBAAA@@@CAAAC@@AM@C@AAAA@AAA@@@@C@E@A@CBA@A@EB@@A@EA@AAAACEAACC@CAAACAA@@AAACCECA@AA@@@@@@E@A@IDGDADA@EBAB@@ED@B@@BB@B@@B@B@@@@BABADAB@B@FABABAB@B@BA@ABCBABABA@GBM@@@A@ADCBGDC@C@@B@B@BBDBB@BBB@B@@AB@@@B@FABADABAD@B@@E@AACAC@CAC@I@CBA@ABCBABCBE@ABEAC@CCAAAAACAAA@AAEAA@C@A@A@KBA@@BA@C@WDUBSDUBSDGBWBQBaFSFGFGFGHGHIDKDIBMDODOBQD[HIDIDGDQJADA@A@@BEB@@@B@BA@@BAB@BA@ABAD@@@B@BAF@@A@C@@@A@AB@@A@CDA@@@A@ABCBA@@BAB@@BDDDDBB@B@@BAB@BAB@BABABAB@BE@M@MAKCICICMAEAGBEDGDEDCF@BB@@@BDB@FB@@BB@@BB@B@@@BABCBABE@@@GD@BABADCBA@I@GBC@@@GDA@@BA@ABG@EACAA@ACA@CAG@@@@A@@@@@AC@EEMGGCKEQAM@O@K@I@G@CDSHIRELKDQGCQEKOEiMMEWDa@cEME]IUES@SESGQEKJDLDLBLBFCJKNMH_P_NEJAD@ThXQHOF_F_PSBaCIFEPBJNJ`NNL^FZPBXLP|NXLJNXNbJPJ\\XRLBLHRTNPDZJLRNJP@rWLH@LFPbERMRMNITMbADVFbljTXFhFLCNQFKNOPKJArFRBLDPFHJRLTLPLRLDHBF@L@HAHJJLJ\\RVLZJHCNOPKNElF^BTCLAZCTCL@THTJdD|PNF\\DbNJDTP^TYXGNVFTGpaXGZ@hB\\A\\IXKJIJITIv@T@PHXPLLHHRXdFZJlKdAL@\\B\\NhNZDTJDFJTNFTDHFDRDLJFT@P@VGXO\\BFJBLBNJFNDPD\\F\\AFDHNPDNEPG\\ETATD|`\\N`DR@\\ATCNEFDJRRN^L\\FVAHGDMLMH@TAXFPDXLNJPDX@PHFNDLFLLFVBZ@D@P@@BDA@@J@H@B@BAFAHADADCDCB@BAB@@ABA@@P@@@@@BA@AD@B@B@B@B@BBJAD@DAB@@BB@F@D@F@@AB@BA@ABA@C@@BCB@@AFCB@@ABABALED@BA@ADA@ADC@ABA@@@AD@BA@CBE@A@A@AJE@ADC@AAA@A@AAACE@A@ACCAAAA@@@A@@B@D@B@@ACA@A@A@AB@ACB@@ABAB@B@@ABAB@BAB@BABAB@@A@@ACAA@AA@CA@AAAAAAAC@AAAAA@@A@C@C@@@A@@@@BAAA@A@@B@B@BA@@@AB@BAB@@@BABA@A@AAAAECAAA@A@@@EAAAA@A@E@C@CBA@C@K@A@C@ABEBC@AB@@CBCBA@CBEDG@C@AA@AAA@@A@@AAA@A@AAA@A@AA@@@ABABA@ABC@A@AAE@@@C@ABA@A@ABABCBGBC@@@A@A@A@@BA@A@AA@@AA@AACCAEA@@A@C@@@C@A@@BA@@AA@A@@@AA@AACE@@@ABA@A@@@A@@@AB@@A@@BA@@@@@ABAAC@@AA@A@A@@@A@A@@@AAA@A@@AA@A@A@@D@@@DCD@@AAA@A@@@@@A@A@A@ABAAA@C@@@AB@@A@A@CAAB@@A@@@@@AA@@ABA@@@A@A@A@@B@AAB@@AB@@A@A@AB@@AAA@@|HF@CGJGFAHC@OBOJA@A@EB@@@FAHCFADAB@@@B@@@FADAFAHAD@BCB@BAPO@A@@@@AG@C@KEG@AAA@@@@@@CAIDCBE@A@ABA@CACBC@A@IDCB@@@@A@@B@@@CAA@A@@@@BAD@@@B@@C@@@AE@ABIBKFA@E@IAC@EBG@A@CAA@C@E@K@EBGDKDEB@BA@@@CBIFEB@ABA@AB@@A@A@@B@B@BCB@@@@@AC@@DA@@@@@@@@AA@@@@AA@@AA@@C@C@C@GBA@ABAACB@@@@A@@ABA@@BA@@@A@@ACAA@@AAA@AA@@BC@@B@DAFACCECAAA@@ACACACCAEEGCEAA@AAC@@CG@@AE@@AE@@A@AEACCEACA@@A@A@AEGAECEAACCCCCAAAAA@@AA@A@@AAGEACA@CAKIEEAAAAA@AAA@CC@AAAICAAEEOMAAA@@@AAACAACI@AAA@A@A@AACCC@A@@@A@A@A@@AACCAC@@CA@@BCCA@AB@@@AACACAAC@CAA@@CA@@BAAA@@@C@@AA@@BA@@AA@ABA@@@C@A@A@@B@@A@A@A@@@A@A@@BA@A@CBC@A@@@A@GB@@ADABABA@@BA@@@A@C@A@C@@AA@@AAAA@@@@BAA@@A@@AA@C@@AABA@@@A@AA@@@CA@@AA@A@A@A@@@@@@@A@A@@@ACA@@AA@A@@@AA@@A@AA@@@CAA@AA@AA@A@@AACA@AAA@@A@@@AAAA@A@@AAAEC@@A@@A@@@AA@@A@@@A@A@ABCBE@@AA@@@@@AAA@@BA@A@@AAAA@@@AA@CAA@@@@AA@C@A@A@@ACACA@@@@@A@@@A@@A@@AA@@@A@A@AB@B@@EAA@A@AB@BA@CBA@@@AAA@@AA@AB@@A@AAA@AA@A@@@@A@@@CB@@@@A@C@C@C@@@AA@@@AA@A@A@@@AA@@A@AAC@AAAAA@@AA@AA@@GAC@CAA@@AC@CBE@C@CBE@EBA@CBE@EACCCCCA@AAACACA@A@@C@A@A@AAA@EC@A@@CBA@CAIAC@A@C@AA@@A@AA@@A@A@EBCBC@CBA@A@AAC@CAEA@AA@C@ABCBA@ABA@@B@BABA@AB@@A@CBA@ABAAA@@AA@@AA@E@AAA@AAAA@@ACCAAAC@A@A@EDABA@AAA@@@ABA@ABAB@@ABA@AD@@EBE@A@E@A@C@@@A@@BC@GB@BABC@A@C@A@A@C@A@A@C@GBA@EAEBA@@@A@@@CB@BCBAB@@ABA@A@CBC@AB@@ABBB@B@@A@AB@BBB@@@B@@@@ABEBCBAB@B@@A@A@CB@@CB@BA@@B@@@B@@@B@@A@A@CAABA@A@@@AAA@A@A@CAA@C@C@A@EA@@A@ABA@@@@BBB@@@B@BEF@B@BA@@F@@ABGBAB@@A@AB@@@B@B@@@B@@ABABA@@BC@@@CD@BCBAB@@A@@@A@CAA@@AA@A@A@A@A@@@A@@BA@@BEAC@@@A@A@ABGD@@E@@@A@@A@A@AA@AA@@BA@@A@A@AAA@@A@@AACA@AA@@ACB@@CBABAB@BBB@@@@BA@@@@@A@BB@@@@BB@A@@@@@@AAB@@@@@@@B@B@@AB@@@AA@@@AAGBA@A@@BC@@BCB@@AB@@@B@@ABE@A@A@@@A@@AAA@AA@E@A@@AA@@A@@AAC@A@CAAAA@CBA@EBABABC@@@A@@BAB@@C@A@@@ABAB@@A@C@A@"],encodeOffsets:[[83042,45831]]}},{type:"Feature",id:"652701",properties:{name:"博乐市",cp:[82.051004,44.853869],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@AACGBACKXA@I@A@I_DGLILEHGFKHAFBFBB@BB@BB@B@@@BB@@B@BB@@B@@@BB@@@@B@@@@@@@B@B@@C@@BA@ABABAB@@FA@AF@NCfGbGJY@AU@C@IBABIB"],["@@Ɛm`¾ſE@@GGAKGICzHn_DSeY@LĚtBjB^HFARGFIZKwL@JElINGLDn_HG@IfUFFRDJEHCJK@IbYNObSFIPGDKPGXS@AHAZYDEJGLE^YDGNITAAA@A@A@@BCBE@A@@BCHKDCBCDABADAJADA@ABC@@BABA@ABADCFEBABABAB@BA@ABABABAFG@A@ABABA@ABADC@@DCBC@@@EBCBABABCBEBCDC@ABABCBA@ABA@ABCB@BCDCBCBADA]AOWOCßqQEB@gUCBmJË\\G@KAQHOBABCDGBCBCDEFADCBED@BOTID@JCDBDE@CB@PEF@FCBKTE@_|AJ@DFXTNBP"],["@@TAD@IXCH@P@\\A@FCB@BBBTJDDCF@BA@BBA@@@@BA@BB@@@B@@DBABA@BBBB@BB@A@AB@@@B@@@@@BA@@BD@A@ABB@A@@BAAABB@BB@@BBC@BBA@@AA@@B@B@@@@B@@@@@@@BBCBBBA@@@C@AB@B@@@B@@@B@@@@@BA@ABB@ABABBB@BA@@B@BA@ADCBADCFFFHL@HSBCNABQNFDEHAR^JLPIXH~AP@ZQLMPOZIDM`ETCJMDIDCFDHGAOFlZdDTLVH\\FzFbPKęZ@EK@@J@h@yOI@E@KB@@CFCAEAE@ALGFEFIACBAAABCBGAC@CEAAEAAECKMDAAABAAOSMEW@CBI`{F@LSDA@EFE@ODAF@ACDC@IJCPS@AFCDABCFEDCDAHADCBAPARGLBH@UMMIGC@COA[EOISK[QECSHQNC@OJOJEBGBOHA@EBABE@CBC@E@E@CBGAGBGBMBEBG@EA@@GA@@EBA@UB@@K@GBKDCIACA@GI@@UFA@WBSHMDAB@BA@E@AB@@A@I@BD@B@@@B@B@@@@@D@BA@@DD@@@@BABA@DD@@B@LBFBD@FB@B`@PDD@CFEBEJ"],["@@@AHKFG@ABQ[]GuE@GBA@CBC@AB@@A@@AAAAAAAAA@@@@A@AAA@AA@@A@@AA@@AA@AAE@AJ@ARAFYB_N@@@HMC@ND@BDQHERGAFIiAACIOIMCMM@@kYA@AB@@@BC@@@@BA@@@@@ABC@@@@BEBCBEBA@A@E@A@CBA@A@C@ABE@A@@@ABA@@@GBCBABC@@@CBA@AB@@C@AB@B@@@BA@B@BBB@@D@B@B@@CB@B@@@FBFBD@BBBBB@@BF@F@H@DAD@@ABABADAB@BCDAD@@ADABCHGNEHCHAD@B@B@B@@AB@@AB@B@BB@@B@@@DAB@BB@@B@@@B@BABABA@A@C@@AA@KCAAA@EECA@AA@C@AAG@E@@AA@CAC@CBA@AAA@@@AAA@AAC@GAAAE@G@AAA@C@CAA@AAA@DCFCDCBA@@AA@C@@@A@@@C@ABC@AA@AAEACAAACACAA@AAA@AAAAAAAACA@A@A@@AA@AA@@CA@ECGCA@CA@E@AAAAC@AAA@AACACA@CCAA@AAA@CCCAAACCE@@ACAAA@@AGK@A@@@ABBB@FBF@H@HAF@B@@ABA@@@C@@@@B@BBBBDD@@@B@BDB@BFFB@DD@@BAD@B@DAB@@@BC@A@@B@@A@A@A@CDEB@BA@BBBBBBBBBBBDF@@D@B@B@BB@@B@@@BA@@@A@A@AAC@@@@DBB@@@@@DCB@BGBE@A@@@A@C@A@A@A@G@GAi@ME@_BE@I@M@CEACAAAAAIAA@CCMAAAC@C@@GMAAAA@AA@A@@@AAA@ACAA@A@ABA@@BABAB@@A@A@AB@@A@AB@B@DAB@B@B@@@@CB@@ABAB@FEDAFABADA@A@@@C@@@A@@@A@@D@BD@@DBB@FBB@DBB@H@FAB@B@B@BA@@B@B@B@D@D@BAB@@AD@BBBB@@FAB@@@D@B@B@B@BB@@BBFAB@BAB@H@B@BBB@D@@@DBB@DA@@HBBBD@FBBAFAB@DCBABB@@B@B@B@H@D@D@F@FB@A@@BADAB@BA@A@@HCBA@@B@BBDAAA@@@A@A@@@CEA@@@A@@@@BADA@C@@BAB@@A@@AA@@A@A@CDEB@@@@AAA@@@A@@@AB@@GBA@CBAA@@@@@G@E@A@@AA@A@E@@BEBADC@@@A@A@ADEDE@CBCB@@E@A@A@AECA@AAG@ICCAC@AACAAAE@EAA@OBA@AAA@@AIECACAKAKAC@WA@@ECKEECkMAAA@@AAAAA@@BADADAFA@AFEB@@AFCFEDC@A@A@A@@@@DAB@BCBCB@BAD@F@JABA@@@@@AI@C@IAGAIAC@C@G@KAECC@ABG@A@KEC@G@I@GDCAC@ABE@ECGAA@CEA@C@E@G@EAGAQCCA@@EBKBEAE@E@IBEBCBIBE@A@C@ABC@GCA@C@GBE@EAEAGAAACAACEGCGKIEAAAMIACAC@AKGGECEEKGGGC@I@CAAACKKEAEAG@A@ICAAGEEAAA@@C@CC@@@@AA@A@C@@A@AA@ABCBC@AAA@ABAA@C@E@A@ABCBABABCB@BAB@B@@BB@@@@AB@@@@BB@@@D@BB@@BA@@@A@A@@@AB@@@B@@@BA@C@CAC@@@A@@BA@@@A@A@GA@@EDA@A@C@@@@@AB@B@BCD@BABABAB@@@@@BD@D@BB@@@@A@@@IDAB@B@B@@@BBB@@AB@@@@BB@@B@FBBB@@BFBBAB@@C@C@A@A@@@ABA@EBC@GAA@A@C@A@AAE@C@E@C@CA@@ABC@CAC@CAAAAACC@A@AA@C@@@C@ABEBE@A@C@@@@B@@BBBD@BCBBBBBBB@BBB@F@@A@CBA@@@AFA@A@A@A@A@A@ABA@A@CBCAA@A@@@CHA@ABGB@B@@ADCBBB@B@B@B@BB@B@DBA@ABADA@ABC@@@ABABABA@A@C@IAE@CAA@G@C@A@@@C@A@@@@@@A@C@@@@@AA@C@@@@A@A@CAA@@@@A@A@A@ADC@A@C@@@E@A@C@C@E@A@@@A@@B@D@B@@BD@@@@CBEBA@CBC@G@C@A@EAAAA@A@A@CBA@ABA@ABE@CDABABABA@A@CAE@C@A@ABCBEDAB@@C@A@G@C@A@C@A@@@CAA@@@AAEAA@E@G@C@A@@A@B@BA@A@EBC@@BABA@IDABGDABED@@@@AB@D@BAB@@ABA@@@AAEECAA@A@C@AAABEBCBA@A@EACAEACAA@ACAAA@A@AAA@C@E@A@C@CBA@ABA@A@A@@BA@ADA@EBCBA@A@CAA@@@@B@B@@CAABGB@@ACCBADA@A@C@@@ABA@@@@AA@ABA@A@CAA@@AAA@@CAC@A@A@AACAA@@@A@@A@@A@A@@ABA@@A@A@A@EBA@ABEFEBEBA@ODEBODA@A@AAC@A@C@MA@BC@CBA@A@@@@ACCG@G@A@C@ABABA@AAA@EDA@C@CBC@IDA@CAE@GACA@@A@CBABCB@@A@E@A@ABCBEBA@A@C@A@A@C@E@AACBABA@A@A@A@E@C@A@CBG@EBA@@BA@@B@DAD@@AB@@O@G@EBA@E@ABC@ABEB@BABCD@B@@BBB@AB@B@@EDCBAB@@CBA@GBEBA@A@ABEBADCBGBCBA@A@AAA@AACAC@A@A@CDABABC@CBABC@CB@BAB@@ABA@EBABEBA@C@ABA@ABA@I@K@I@A@EAA@@@A@ABC@C@A@E@A@A@A@AB@@C@A@EAA@CC@@EA@AC@A@AAEBA@EB@@C@@@CA@@A@ABABA@A@C@EB@@@@@B@@ADC@IBA@@BABA@A@A@CAEAA@A@CBCBABAD@DAB@B@BA@A@A@@@A@A@ABA@CBADABB@DD@B@BAB@BABAB@@ABEDC@EBA@A@C@GBC@A@EA@@EDA@@@CAC@AAC@AAE@E@OBM@A@@B@@@B@B@@A@CAA@@A@@ECCACAAAA@A@@@@@CDA@ABABC@EBCBC@A@@@A@EAEAC@A@@@@AAA@@@@G@E@AAA@@C@@@AAAAA@@EBA@A@A@@@A@@@A@@BBBBBBB@@AB@D@@ABEB@B@B@@@B@@CB@BBB@@@B@BABABCBA@@B@BB@@BBB@@ABCDCBADCB@B@B@B@@@@ABA@CAC@A@C@C@KDA@A@@@A@ABA@C@A@@@@BCBE@A@A@A@@D@@ADA@ABA@K@C@IDCBEBCD@BB@@BA@@@C@AB@BCDEB@@E@C@A@C@AB@@ABCB@@ABABA@A@KBC@A@AB@@ABA@A@A@@B@@@D@@AD@@CBCDA@ABC@EHA@C@A@EAA@A@ABABA@A@@BA@ABABAB@@AAA@EAC@CCAA@@A@A@A@A@@@A@@@AAC@AAC@EBABCD@@C@A@EAEAA@E@GCGCA@CBABCBABAB@BBB@B@@C@@BA@CBABA@CBEBABCBA@@@A@@D@@@D@@@BG@C@CB@@CDA@@BC@GBA@A@@@C@ABA@AD@BAF@BBD@BBBB@BBBBB@B@D@F@DBHD@@@B@BBBBBFBBB@B@BBF@D@@BBBBFBBBABAD@D@BB@@B@B@@DDB@BB@@@@AB@B@D@@BBB@FDDDBB@BB@DBBBDBD@BDFBBDBB@BFBB@@@FAB@B@DAB@F@D@@@@@BB@BBBBBD@N@@BD@BBDB@@B@BBFABABADAB@FAH@B@DA@@@A@@CA@AA@@AB@@@B@@@B@B@BABAB@BBF@B@B@DAB@D@F@@BB@@@@DBFBB@@DAJBF@DBF@B@B@B@D@DBB@HDB@BB@@@@BDB@BBDBB@D@DBDBBBFBHD@B@@HDBB@@B@H@D@B@FDF@FB@B@@B@BB@@B@@@@B@@A@ABA@A@@@@BABA@@@B@DBD@@BBBBB@@DBD@DBDDB@BBB@DA@@D@B@BBBB@@@B@BB@BB@B@@@@BBD@@B@@B@@BB@B@D@B@BBBB@@B@B@B@B@BAB@B@B@B@BB@@B@B@B@B@@B@@@BB@H@D@@B@@@BBBBBF@D@B@D@BADBB@D@B@@@B@@BD@B@B@B@D@FABAB@@@F@HBB@@@BB@@AB@BB@@@BBD@D@JAB@BA@ABCBA@@@A@@BBD@B@@@BA@@B@B@B@FAD@@@FABA@@B@DBF@F@B@@@BB@@B@BAB@B@B@B@B@L@B@B@DAD@F@BAB@DAB@D@D@DAB@B@B@B@DABADAB@BBF@@@BB@@B@HCB@B@HBF@B@@BDB@@D@@@D@F@B@B@DBB@@B@BB@@@BBBD@B@B@@D@@BDD@BBB@@B@B@DB@@B@BBD@D@F@BBBBB@@@@BABBB@D@BB@@@D@B@BAB@BAB@D@B@@@BABA@@B@D@@@BA@AB@@@D@BABAFAB@DAB@BBDBB@D@BB@@@BB@@BB@F@B@@BBB@BB@@@B@B@F@BA@@@A@@BA@@DA@AD@@AB@B@HABB@@B@@B@@BA@@@A@A@@@@@@BA@B@@@@B@A@@A@@A@@A@B@@@@AB@@@@AA@ABABADA@@DA@BB@@BDBBB@@@BB@BBB@B@@@AB@@BBB@@B@B@BB@@@F@@@HCBAB@B@@@D@FB@AB@@AB@@@B@B@B@B@B@@BB@DBB@@@B@@@BADA@ADC@@D@@AB@BABA@@@A@@@A@A@@BAB@@@BAHABA@@@EB@@A@AFE@A@A@@AA@A@@B@BAB@@@FBB@D@D@B@DBB@B@B@BB@@B@B@BADBB@B@@@@A@@@A@@@AB@@ADA@@DAB@B@@@@ABADAFABA@@@@@A@@AA@ABAB@@@@AAABA@@BAD@DAB@B@BA@@BADA@ADA@@B@@@B@FAFBB@HAD@B@B@D@B@B@D@B@D@BA@AHAD@@AB@@@D@B@F@B@F@FA@@BCB@BA@@BABAB@BA@@B@BBB@BAFCB@B@D@BBDBBD@@BBBBB@BBF@B@@BB@@BB@BBBAB@DAB@@@BAB@BA@A@AB@BAB@DABAD@B@@BFBDBD@BBB@B@DAD@DAFAB@B@@@BBB@@@BBD@B@D@JBDBB@DA@@@BFDB@BBB@B@D@@@@BDBDBBB@BDBDDDDFBF@DAB@FAF@DAD@F@DAD@@BB@DBD@HB@@BBB@@BB@BBBBD@BBB@@@BB@@B@B@B@@B@@BB@@D@D@D@B@@@@@DA@@B@@@@@@BBBB@BBB@@@BAB@@BB@BB@@B@DAB@@ABAB@B@FB@@@ABAB@B@@@B@@BB@@@@B@@@B@@@@DBDB@BB@B@D@B@@B@@B@DBB@@B@@BBBB@@@BAB@@BB@B@@@@BB@@AFAD@B@B@B@@@BB@@B@@@BB@@@FDBB@BB@B@BB@B@@@BB@BBB@BD@BB@B@@BBBB@DB@@B@@B@BB@@B@@@BBB@@DB@B@@@B@B@@@@@@@B@B@BBB@@DB@@B@@B@B@@ABBB@@@DBB@@@BB@AB@@@@BBBB@@BB@@@D@B@D@B@@AB@@ABABCB@BA@@H@B@@@BAD@D@BAB@@@B@B@@@B@B@BA@@@@B@B@D@@AB@BBB@@AB@@BB@@@D@@BBAB@@DB@@BB@DBDDBDBBB@@A@@BDBAD@@DB@@BDDDBB@@@B@B@B@@@BDDBD@B@B@BBB@BDJBBBDBB@@B@BBPNFFBBJDBB@BDDB@BBB@BBBBFFLJDBB@BDHFBB@@@BBB@@BBBBDBDDDDBBDFBFFH@B@B@BB@BDDFBDBFB@@@BF@@BF@@DH@@BD@BBBDFFHBFDDDBDB@BB@BBFDDDEBCBA@@@AD@@BBB@BB@@BBBD@@@B@@AB@@AB@BB@@@@@DABBBAB@HAD@D@D@@@BB@@BB@@@@BB@@@@@@@@CB@@BD@@@@A@ADA@A@@@@B@BA@@BAB@BFAJEDA@@B@@AFALCHCFAL@F@D@B@DBB@H@FAD@JBF@B@LEJABAF@@B@@@DA@@@C@AB@@@@@BBB@D@@@AB@@@@@DAJCB@D@DADBB@BAB@F@DAJCDB@@@@@@BB@BFH@L@DBH@@@@@BOPABA@ADC@GBEBCBEB@@A@@@A@CBEBGDEB@@A@@F@BIBAP@PGDEBIHDHE@{G@@BB@BA@@B@B@BA@@BA@BBA@@@@B@B@B@@AB@BB@@B@@@@@BA@BB@D@B@BA@@B@@@DBBAB@B@B@B@B@@@@@BBB@BC@CD@@C@@@@B@BBB@@@BBB@B@@@B@B@@@B@BBB@@BDAB@B@@@@AB@@@BA@@B@@@B@@@BAB@B@@DFBBB@@B@@@BBB@@AB@@@B@D@@@D@BB@BFDDBBB@@BB@@B@BAB@@@B@B@B@@ADAHADAB@B@BAB@B@D@@BF@B@BAD@BABAB@B@@BBB@B@BBB@B@BB@@@BB@BBB@@B@DCHAF@DABAD@DA@@BADAF@B@D@B@L@DAB@D@D@F@BBBBB@F@@@BBBDBBFBB@B@BABAB@@A@ABA@@B@@ABA@A@@@@BBBAB@@@@@B@@@D@D@BB@BBBBD@BBBBBB@BDBB@@BBBBD@@@BA@ABABA@ABA@AB@BA@A@AB@BA@BDA@@B@B@BDB@BA@C@A@@@@B@@BBBBDD@B@BDFBB@B@BBB@BCD@BIF@B@B@BAF@DABC@@B@@AB@BCD@BCB@BABC@KFABAB@BA@ED@BA@AD@@@DAB@BABA@@BE@C@E@A@@AA@CBC@IBAAA@A@A@A@C@@BAB@@@@O@@@AB@BA@ABA@CDCDCBGBEBABA@G@I@@@CBDNAZLPbLfJRJPLVBlIVBNJPJNHPBREPEPAFLlVLAPALFJFP@@CBAHKFEFADCHELK@CAC@@BCFELIDCDIDAFCDC@@BEDCBC@EAILM@@HAD@DC@CAA@ABANCFAJOHCBAHEFCHAFCFCBCFC@AFEDGHEDEHI@CJEDEBABCDKLIFE@CNDXBbHl@`CXDNHRJF@LCrRPBXEh@FGCYC]RKTIkYPEHBCGDEJCNCDIFSN_JCPYNORK@YBOG}JWKO]IBQFGECÉFOBcKkOC@C@GE@A@IUGIQDMBKAI@DGBEDGFI@EFCFGLIB@B@B@B@B@@@BAB@B@BAB@@@BEB@BA@@@@@A@@@@B@@@@@B@B@@EB@DE@ADA@@BABA@AHAHA@@BAB@BBB@BDABBBAHADEF@B@BABBBFCNGBBDABAFCFDB@JGBDFHCFADD@BEBAB@BBJB@A@@DAF@H@FAABB@@@DAB@@BADBAB@@CF@DE@@JONS@KJYVA^@BGXAP@HAH@FAFIFADEC@OC_@@AEAC@EAKAA@@@CCB@BA@A@@C@@CB@@A@C@@@@@A@A@@@AACJ@B@@@BAF@A@A@@A@@@@AA@@A@EB@A@@E@@@A@A@@@A@A@A@ABA@@@DCBAB@@ABC@ABCBA@CBA@A@@BA@A@AB@D@@@@DBB@@RC@M@@p@@BJAtOPAAA@@@@BCqeEBQJUDCBOJIBIKLGeUf]habVFCF@DAB@LGHEaKJU^JABLBHKG@eKT]fLLDDBBFBF@H@J@B@JWBDLABHABD@@B@B@BFBAJAD@V@@BIZRCFAzJLDVDH@ZFBAFC`UFCLI^IDGT@\\CVCFARE@ALIXIF@B@DCF@DDZI@EBAF@AAA@ACC@CECBFE@CGIUEEBMGCEEAKGDACCEBBGBGDB@AA@BAB@AABAK@AQAAN_TAA@A@A@CAC@CEA@D@F@D@BBBAFCJKNA@oVMBCB@ABAV_"],["@@VMHHB@BBDCD@@BDB@E@CFB@DBD@D@B@BBB`SBMBBRL@ABBBA@ABB@@BCAAHAHFADDCBLHFBDFNHFAVFHJ@DEFDADFD@BDB@BADABBABBBFA@@BA@AD@BA@@DDD@ACDABDBCAAD@BA@AAAB@ACJADBDA@AB@@A@@AAAABAB@@@H@B@BBD@@AA@A@AADAD@DB@@BAB@@BBBBA@CD@NCBDD@JBDBDABBB@B@@ABAB@D@@@@ADA@@B@F@BA@@BABAB@B@BCBABBDCAAB@D@BAB@B@@ABAB@BAB@BA@@BABBB@BAB@@@D@@A@@B@B@B@BABBD@BANADBB@F@BAD@BBD@DBBAJ@DBD@F[QBHYA@C@@CBCDB@BD@AA@ADBACEAAC@A@CAABCA@C@CA@CDCBAD@FDB@D@BG@@BC@C@AB@@CAA@AA@A@A@ABC@@CCAC@GBED@BABA@C@AC@AC@C@ABAF@BABE@ABA@@@G@A@E@@BBB@B@BA@C@@@AA@AGA@CA@E@ABA@E@ECCCBA@AD@@A@AEAA@C@@@C@@@A@AA@@BA@AA@CBE@CACB@@@DDD@BABA@D@B@B@@@@BAB@BA@CBA@C@A@@AB@@CA@CBACAAAAE@AB@BBD@BA@E@ABA@@@A@AA@@BA@@AA@@A@AB@@@@A@AABA@EA@CCM@A@@CAAE@AAAA@@@A@AAAC@A@EAG@ABC@EECAE@@@@CA@A@AB@BE@AACAA@G@A@ADB@@BKF@@@FQDEAEBC@A@C@A@E@A@@BAB@BEABBABABAFADCB@B@@AACBCAABA@CBA@@AW_AAHC@BFBBAF@F@ACLCFBHAK@CCE@@BAD@@E@ABABE@@ABA@CABC@@@CAAA@@A@@@C@AB@B@@A@ABA@C@ABA@A@@@A@ABID@B@@ABA@AB@BA@A@@@C@ABA@A@CAC@@@C@@@A@CBCBCBA@EBC@EBEDABA@A@CBCBC@CBC@A@CBC@Hv\\^AR"]],encodeOffsets:[[[84149,45889]],[[84250,46111]],[[84092,46005]],[[84249,45825]],[[84256,45813]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
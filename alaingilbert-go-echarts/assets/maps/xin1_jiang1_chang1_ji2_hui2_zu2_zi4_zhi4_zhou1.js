@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("昌吉回族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"652301",properties:{name:"昌吉市",cp:[87.267532,44.014435],childNum:1},geometry:{type:"Polygon",coordinates:["@@CA@@@AA@AAAC@@AAAA@A@A@@@@@@@A@@AAA@@@@A@@BAAA@@AA@AAAA@@A@@A@AA@@AABA@@@@@@@A@@AA@@@@AAA@@@@@@AB@@@@AA@@@@@@@B@@A@@@@@@@AA@@A@@@A@@@AB@@@@A@@@@@A@@AA@@AA@@A@A@A@@A@@AA@@A@@@@@@@@BA@@@@@@@A@A@@@@@A@@B@@@@A@A@@AA@@@@A@@@A@@BA@@A@@@AAA@AA@@@A@@@@@A@@@@A@A@@@A@ABA@A@AA@AA@AA@AA@@@A@A@AB@AA@@@@@@A@@@@@@CA@@A@A@@@A@A@AA@@@@A@@@@@A@@@A@@@@A@@@AAA@A@@AA@@AA@@C@@@A@AA@@@AAA@@@A@A@@AA@@AAA@AA@AAA@@AAA@@@@AA@@@A@@@A@@AA@C@@A@@@@BAA@@@@A@@A@AA@@@A@@@@AA@@AA@@A@@AA@@@@AA@A@@@A@@@@A@@@A@@AAA@AA@@CAA@AA@@@A@ABA@@AA@A@@@A@@@@@A@AAA@@AA@@BCA@BA@A@@@A@AB@@A@@@A@A@A@AA@BA@A@@@A@ABA@A@@AA@ABABABA@A@AB@@@@A@@@A@A@@B@BA@@D@@@DADA@@BA@@B@B@B@@@BA@ABA@@B@FAFCBAFA@@DCBAB@BA@AB@B@BC@ABC@@@AB@BA@A@A@@KMACCCEIGECKACAK@IDE@EEEGGIGECIEGGCAAA@ALQAG@AAIAQ@IAAACEAG@M@G@EE@K@ICGEGEGIEAACCCCAC@IAKAGB@A@@CAC@ACAAAAA@AA@A@AAA@A@AAA@AAAAC@AA@@AA@@A@@@@@@A@@@A@AA@@AB@@@@A@@B@AA@AACACAA@@@AA@A@@AA@A@A@@@AA@@AAAA@@CACACAA@CAAAAA@A@A@A@A@AA@@A@C@@B@B@DAH@DAB@BA@@@@B@DAB@B@BA@@BABAB@@ABAB@DAD@@@B@BAB@@@@A@@@ABGBEACCC@@KEIAKAIAEAAA@@AA@@@A@@@A@A@E@@AC@AAC@ACCA@EAEA@@GECE@@AE@@ACCAKCSCA@GASGE@EAUCQAWF[NKLMDSDOEOOIE[KGAQAWHYTKFaJSFUDaF]DcBgJ@FCB@BEDGAK@KB@BE@EDCB@HBNBFEJKHMR]\\IHGHEHEJEH@FFF@D@J@HGPCNFBFALBHHBDAJAFEDCFEFAD@B@D@DFHAFEFADAFFFJJNHDD@B@FIFKFIDIFQNCDCFAHADBFBHANCN@D@BBBDBDAHALFNBF@FDDBBDFBDDDFJP@HBLBHDDDFAFIHAHBL@LBHABAFAFADCBIFMHKHIHADAF@F@B@F@FBDAFCD@DBHFNFDFDBFHJFHFBF@FALCJ@H@DBHBHFHDBDAB@DAJ@DDDDFHDHDBBDDDDFJBDAF@BBBFDFBHDFBTDDDB@DBJBJFHDHBFDDDP@FBDDHBFFDDBLCTBNBHDBJBB@TDFDFD@DDFFDNHNDJ@FADAJCF@NBBDJDNBJBFBFFFDDDFBTFRDHDFBBBBHBFBFLLFHADADCFAD@DBBDDHFDFBFDDFFDDFBFBHHFDJFFDLRJLDFJFH@HD@@@DAFAFAFGFOLKFCFCDEJ@FBJDJFHJFJADAHADCF@JBBFDDHN@DBD@JAHBD@F@DEJCF@DGFKDGDGBABEHCLBLBF@F@@@@BD@@@@@BDJFHDDJHDBH@FAR@DBJBHDFBLCHAF@DBBBDDHFFFB@PBBB@BADAFEH@D@DDDBFHJBJDLBFDLBFBFDDFDFDXLJHF@JBLBLFJ@JAL@V@N@HBN@JAPBRBLBLBHBD@@@BB@@BD@B@B@@BB@F@@BD@B@@B@@B@@BB@B@@BB@B@B@B@B@BBB@@@@@D@@DB@B@@@@@DABBBB@@B@BA@@B@@BB@@@B@BBBBB@@@@AB@@@@BBBB@B@F@BBB@B@BBD@B@@AD@BB@BB@@@@ABA@@@@BB@B@BB@@@D@BB@BDBBFBBBDD@B@B@B@@@B@B@B@@@B@BBB@@@B@BBB@B@D@@@D@B@B@B@@AFA@@DAD@F@@@BAB@B@B@B@B@D@@@D@B@DBBED@B@DAD@DAD@B@BAB@DA@AD@D@B@DAD@B@B@BBH@BAD@@BBBBD@@@AB@B@@B@BB@@@DBB@BBBBFBB@@DDBBB@BBBBBBBB@BB@@BB@@BB@@@@B@@@@@@BBB@BBB@@@A@@@@BB@@BB@@@@@@@@BB@AB@@B@B@B@AB@B@@AB@BAB@@@B@@AB@@B@@BBB@@@@ABB@@B@@@BB@BB@BB@@@BB@@B@BBDBBDD@@@BB@@@@B@@BD@B@BBD@BBB@BBB@BBB@BBDBDBBBBB@BB@BBBDDDDD@BB@@BBBBDBD@BBBBBBBB@BB@@D@@BB@@BBBB@@@@B@B@@BB@@DFB@DB^THFFFDD@B@DCDBBABEDAHADEHFL@@B@@B@@@BDJFN@B@@BB@@@B@BDH@@BBBDDJ@@DJBDDH@D@DBBBHBD@F@DBB@B@F@B@@@@@D@@@@@@@B@@@BBDBB@B@@@BBB@D@@BB@DHPDFPFDX@HBH@@BF@@@@@B@@@@KNOT@D@FAF@B@F@B@B@FAB@D@B@DBB@BAB@B@BBFJRHR@F@Ro@E@CIIJCL@HA@CD@@EF@Q[K@I@@AEEEGAAFG@@CCFAD@@EAAACDA@EdE@@APJHJNdCPCjE\\FKVQFEBIBERMLTXXVTNPb@bAFI\\Wn}vwdR@R@T@R@T@d@R@f@R@h@x@J@BQtERERSvWCNGRAF]EPERGRERERERERGRERSvQtKhI\\AN@˞˨A@ˡBCXalXeNSPW^oV_bsNWDCLSNUXaVa^mJQDA@AbsduVaLQ@A@A@@@AB@@A@@@A@@BA@@@@@A@ABCBA@A@CB@@@@A@ABABC@CBA@A@ABA@A@AB@@C@@@@@AB@@A@ABA@CBA@ABA@CBC@A@@BA@A@ABA@@@A@ABA@ABA@ABC@ABA@CBA@CBA@A@ABA@@@ABA@CBA@ABA@AA@@@@@@AA@@@@@@@AA@@@@@@@@@@@@@@@AA@@@@A@@@@@@@@@A@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@B@@@@@@A@@@@@A@@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@A@@@@@@@A@@@@@@B@@@@@@@@@@@BA@@@@@@@@@@@A@@B@@@@A@@@@@A@@@@@@@A@@@@BA@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@BA@@@@@@@AB@@@@@@@@A@@@@BA@@@@@A@@BA@@@@@@@AB@@@@@@AB@@@@A@@@@@@@@@@@A@@@@B@@A@@@@@@@@@@@AB@@@@@@@@@BA@@@@@@@@B@@A@@@@B@@@@@@@@@BA@@@A@C@A@@BA@@@A@@BA@A@A@@@@@A@@@@@@@ADA@@@A@AAG@@@AGAABQ^MXW`AHEB_HEBTAEBGBIBCBEBAAAGCACDABEBAHKDIHM@CA@K@G@k@Y@K@G@@B@H@H@DCBI@ABCDE@ECA@M@QBFaDOBAAAC@C@AAAACHEDCNAJABCDAHAH@B@BC@CD@DBBAFCFABA@EAGACACBEFED@BA@ADCBAAAECDCBC@C@ADBD@BACCCCBEDCDADBFBFABCCCAA@GEA@CDAH@H@BA@CAACBAADAFCF@ACCCABAB@BAACA@CBCHGHCDCBODIDGBEDECEAEDG@EGEACBC@I@EGEE@GCGEAG@EBEBA@C@C@E@E@E@E@A@A@ABCACAG@CBAB@BAA@AAECCA@CBABBB@@A@AEECC@AB@@AAA@AB@B@D@@AAC@A@@B@@@DA@@D@@@HELGNGTMXQNKRKHIAAC@CAAA@@C@G@CA@ADABA@@AAC@@B@@AA@CB@B@BBB@@@AC@AAAAACBA@@@@@@ABA@@@A@ACE@AFALCFAB@BA\\WJIBAFE@@@@BABA@@BADEB@B@BA@@@C@AC@BA@ABCBAA@@A@CBAB@@@D@@A@ABA@AA@@@AA@@BAB@@@BAB@@@AA@@@@B@@A@@@AC@@@@B@A@@BAB@BACACB@@AA@@@AB@B@@@@A@@@AAAA@DI@@@ABEBCAC@AAE@AA@A@@@@@AAA@@A@AAAAA@@BA@@B@B@B@@A@@AAA@@AAAAA@@@@AAB@@AB@B@@@@@BABAA@AA@@AAA@A@@@A@AA@@AA@@AB@@@BABA@@@@A@A@@@A@AF@BA@AAAAA@@B@D@@A@A@AAAA@@C@C@@@A@AA@A@AAAA@A@@AAB@BAB@@A@A@AAAFEJIB@@AJE@@AC@@II@ACCEECCBE@CBA@CGGCEAAGMIICAFERMHEBC@AAC@@B@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@@@A@@A@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@AA@@@@@A@@@@@@@A@@@@@A@@@@A@@A@@@@@C@@@A@@A@@@@A@@@@@@@A@@@@@@@A@@@@@AA@@@@@@A@@@@@A@@@@@A@@@@A@@A@@@@@@@@A@@@@BC@KJKF@BCBA@@BCBA@IB@Ca@ABAABAB@CC@A@CCEEE@@@@A@A@@@A@@A@@E@A@C@@BA@@B@@AAA@CCAA@@AB@@KC@@KGC@GCA@@@AAA@@@CA@@@@@@@ADA@@@AA@A@A@@@AAA@CAA@AAA@@@BA@@@AA@@AG@@@A@@@@@A@BBA@A@@@@@A@@@A@@AA@@B@@AA@@@@@AA@AAA@@@A@@@A@AB@A@@@@AAC@A@@@ABCB@@AA@@A@A@@A@@CCCA@AAA@@@@AA@@A@@@@A@@A@@@@A@@A@@@AAA@A@A@@A@@A@@AA@@@A@@A@@@@@A@@@@@@@A@@@@@AA@@@@@A@@@A@@@AA@@A@@@A@@A@@A@@@@@@@@@@AA@@A@@A@@@@@@AA@@@@A@@@@A@@@@A@@A@@A@@@@@@A@@AA@@A@@@A@@@@BA@@BA@@@A@@@AA@AA@@AA@@@@@@@@AA@@@@@@@@A@@@@AA@@@A@@@A@@@AAA@@@@@@@@AA@@@@@@A@@@@@@A@@@@@@@@@@@@A@@AA@@@A@@@@@@@@@@AA@@@@A@@@@AA@@@A@@@@@@@@@@@@@B@B@B@@A@AA@A@C@CA@@A@A@@@@AA@@@A@@@AA@@AA@@A@@AA@@@A@A@@@@AA@@@@@AA@@AACA@@A@AA@@AA@@@@AAA@@@@AA@@@@@AA@@@A@@@@@A@@@@@A@@A@@A@@@@A@@A@@A@@A@@@@AA@@@@@@@@@@@@@A@@@AA@AA@@@@@AA@@@@@A@@A@@@@@@@@@AA@@@@AA@@@@A@A@@@@@@A@@A@@@@@@A@@AAA@@A@@A@@A@A@@@A@@@A@@AA@@@@@@@CA@@AAA@@@@A@@@AA@@A@@@@A@AA@@A@@@AAA@@@@@@A@@@@AA@@@@AAAA@@AA@@AA@@@@@A@@AA@@@AA@@@@AA@CG@@@@@AA@@@@AA@@@@A@@@AA@@@@@AA@@@@@AAA@AA@@A@@AA@@@A@@AA@@B@@AA@@@@A@@@A@AA@@@AAAA@@A@@@@A@CA@@@AA@@AA@@@@@AB@@@BA@@@A@A@AA@@A@@@A@@@A@@BA@A@A@A@@@A@AA@@A@@@A@ABABA@@@AB@@@@@@A@A@@B@@ABA@@@ABC@A@CB@@A@A@AB@@A@@B@@A@A@@@A@@@A@@B@BA@@@@BA@A@@@A@A@@B@@A@@@A@@@ABA@@@@@@BA@@@A@@@A@@@A@A@@@ABA@@@ABA@ABA@@@@@A@@AA@AA@@A@A@@@A@AB@@A@@@A@@@A@@@@@C@A@@@A@A@A@@BA@A@A@@@@@A@@@A@@@A@@@AB@@@@A@@@@@A@@@A@@@A@A@@@A@@@A@@AA@A@@@@@AB@@@BC@A@@@AAA@@@A@@@@AA@@@A@@@@@AB@@A@@@@@@@A@@@A@A@A@A@@@A@@@A@A@@@A@A@@@A@@@@CCA@@@@A@@@@BAB@@A@@@@@A@@@AB@@@@@@@@AA@@@A@@@@A@@@@A@@@A@@@A@@@A@@@AA@@@A@@B@@@@A@BB@@@@@BB@@B@@AB@@@@A@@A@@@@A@@@@@@@@@A@@@@A@AA@@@@AA@@@@@AA@@@@@A@A@@@@@@@@A@@A@@A@@A@@@@A@@@AA@@@@@A@@@@A@@@@@A@@@@@@@@AA@@@@@A@@@A@@@@@@@@AA@@A@@@@AAA@@@@A@@A@@A@@@AA@@@@AA@@@@@A@@@@A@@@@A@@@@A@A@@@@@@@@AB@@@@@@@@@@@@@A@A@@AA@@@AA@@@A@@@@@A@@@@@AA@@@@@A@@@@@@A@@A@@@@A@@C@@@@A@A@@CCAAAAEA@A@@@@@A@@@AA@AA@A@@AA@@AAAA@A@@AC@AA@@@A@@AA@@AAAAAEECCA@@A@A@ACAAAA@AA@@@@@AAC@C@@CA@ACIAA@E@A@@AAAA@A@A@ACA@AA@@A@AAAAA@@@AAA@@CA@AA@@AAA@AAA@A@A@CAE@@CA@@@@@AA@@AE@@AAAA@EAEACA@@EA@A@A@@A@A@A@A@@AAA@AIC@@AAAAA@A@AA@@AAAAAA@@@C@A@@A@AAAAAA@A@AA@@@@AA@@@@@AA@@@A@@@AA@@@A@@@AA@@AAA@@A@AA@AA@@AA@A@@@AA@@A@@A@@A@@@AAA@@AAAA@@@@EA@@A@@A@@@A@A@AA@@AA@@A@A@@@@@A@@@A@@@@@@AA@@@@AAA@@A@@@AA@@@@A@@A@@@@@@@AA@A@@@AA@@A@@@@AA@@B@@AA@@@AA@AA@AAAA@@AA@@AA@@@AAAAA@AA@@A@@@@@AA@@A@@A@AAAAAACA@A@@@A@@AA@@AA@@A@@A@@AA@@@@@@@A@@@@AAA@@@@@AAA@@AA@@AA@@A@AA@@@@A@@AA@@AA@@AAAA@@AAA@@@@@CA@@AA@@AAC@A@AA@AA@@@AA@@ABA@AAA@@@CA@@AA@@A@@A@@A@@@@AA@@@@@@A@@@@A@@@@@A@@@A@@@@AA@@@@AA@@@@@A@@A@@A@AA@@@@@@AA@@A@@@@@A@AAA@A@@A@@@@@@AA@@@@A@A@@@@@A@@@@@A@@@@AA@@@@@A@A@@@@@AA@@AA@@A@AA@@A@@@AA@@A@@@AA@@A@@A@@@@CA@@AA@@@@@@@AA@@@AA@@@@@A@@A@AA@@AA@@AA@@@@@AA@A@@@A@AA@@@@@A@@@@A@AAA@@@AAA@@@@@A@@AA@@@@AA@@@@@@@@A@@@@@@A@@@A@@@@@AA@@A@A@@@@A@@A@@A@@@@A@CA@@C@@AA@@A@@A@AA@@@AA@@AA@@@A@A@@@@A@@@@A@@@AA@@A@@A@@@@@@@AA@@@@@A@@@AA@@AA@@A@@@A@@A@@B@@A@@@AA@@@AA@@A@@@@@AAAA@AEA@A@@AC@CAE@A@C@@@A@AAAA@@A@AAABA@A@@@AAA@A"],encodeOffsets:[[89081,44555]]}},{type:"Feature",id:"652302",properties:{name:"阜康市",cp:[87.952991,44.164402],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@AAA@CACAECC@@ACA@@CAA@CCC@CAC@@BA@AD@B@B@DBD@BABA@A@ABA@A@AD@F@BBBBB@@BBBB@HCFEDMFEBEBCBCDABEBC@E@AAAACGEGEGCCAAA@A@A@A@CDCDA@C@C@A@A@AAAEAABEAC@ACAAABCBAFA@AFEFGBCBE@CCACCCCAACCACAAECCACA@AA@AA@@ACCCCCABABCBA@CACBE@GBABA@C@C@A@A@A@@C@E@C@EECEBCBABCDCDCBC@EBE@GBGBEBEBCDC@A@AAAAAA@CBEBC@CEAG@GBIBIA@@A@A@CAEAAAC@BLBH@B@@DB@@@BBB@@BB@B@B@@@BAB@@@B@@AB@@BB@@@@@@@B@@BB@@@F@@@@ABA@AB@@@@@@@B@@AB@@B@@BA@@@@@@@@B@@AB@@BBA@@@BD@B@@A@@B@BAB@B@B@@AD@B@BAB@BAB@D@BAD@BAB@B@D@B@@A@ABA@A@A@CBA@A@A@@@A@@@@B@@A@@@@@@@ABA@@@@@@@A@@@AA@@A@@@@@AB@@@@A@@@ABABA@A@AAA@AAC@@A@AAAA@A@C@A@CA@A@@@A@A@@@AA@@@C@C@A@A@AB@@A@@B@BA@@B@B@@@@AB@@@@A@AB@@@@A@@@A@A@A@AB@@@@@@@@@@@@@@@B@@@@A@@@@B@@AB@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@@AB@@@@A@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@A@@@@@@@A@@@@@@@@B@@@@@@@@B@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@A@@@@B@@@@@@@@@@A@ABAB@@AB@@@@@B@@@@@B@@@B@B@B@@@B@@@B@D@@@B@@@@@@@BAB@AA@@@A@@@@B@B@@@@A@AB@@A@@@@@@B@@@BA@@@A@A@A@@BA@@@ABA@@@A@ABA@AA@@A@A@A@A@A@A@@@A@@@A@AB@@C@ABA@ABA@@BA@@@@B@@@BA@AB@@A@CB@BA@@BAB@@AB@@A@A@@@@@ABA@@@A@ABA@@@@@@@@B@@@@ABAB@@ABA@CB@@A@@@@B@@@B@B@@@@@B@@B@@@@BA@@@@@@B@@@B@@@B@B@@@@@@ABA@@B@@@@AB@@@BABA@@@@B@@@BA@@B@@@B@@@BBD@B@B@B@B@@BBDBBB@@BB@BB@DBFBB@@B@B@DAB@@CBAB@@@BAB@@AB@@@B@@@BB@@B@@@B@BAB@@@B@@AB@B@@AB@@@@@B@@@B@@AB@@@B@B@@@B@B@B@@@B@@B@@@BBB@@@@@BB@@@@@BA@@@@@A@@B@@AB@B@@A@@B@@@@@@AB@@@@@@AB@B@@@@@@@@@B@@@@@@@B@@@@A@@@@B@BA@AD@BA@@B@@@@A@@B@@A@@@@B@@@@A@AB@@AB@@@BA@@@@@@@A@@B@@@@B@@@AB@@@B@@AB@@@@@B@@@@@@@@@B@@@@A@@@@@A@@B@@A@@B@@@@@@@B@@@@@B@@@B@B@@AB@@ABABA@@@@@@B@@@@AB@@@B@@@BB@AB@@@@@@@B@@@B@@@@A@@@@B@@@@@@@@@@@B@@@@@B@@@@@@@B@@@BAB@@@B@@@@@BB@@@@B@@@BB@@@@B@@@B@@BB@@BB@@@@@B@@@@@B@@@@@B@@@@@B@@@BA@@@@@AB@@@@@B@@ABABA@AB@@@@@B@@@@@@@B@@@@AB@@@@@@@@AB@@@@@@A@@@A@@@@@@BA@@@@@A@@BA@A@ABA@CBCBA@ABA@@@@@A@A@@@A@@@A@@@@@@@A@@A@@A@@@@@A@@@A@@@A@@@@@A@@AA@A@A@@@@@@@A@@@@@@@A@@@A@@@A@@@AB@@@@@@@B@@@@@@@@ABA@@@@@@@A@@@A@@@A@@@@@@@AB@@@@@@@@@@A@@@@B@@@@@@A@@@@@@@@B@@@@@@@@A@@@@@@@@@AB@@@@@@@@A@@@@@@@@BA@@@@@@@@@@@@@@@A@AB@@@@@@A@@@@B@@@@A@@@@B@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@A@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@A@@@A@ABA@@@@@A@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@A@@B@@@@@@A@@@@@@BA@@@@@@@@@@@@@A@@@@@@B@@A@@@AB@@@@@@@@@@A@@@@@@@@@AB@@@@@@AB@@@@A@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@A@@B@@@@@@@@A@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@@@@A@@@@@@@@@@BA@@@@@@@@@@@@@A@@@@B@@@@A@@@@@@@@@@BA@@@@@@@A@@@@@@@@BA@@B@@A@ABA@@@AB@@A@@@@BA@@@@@A@@B@@A@@@@@@@@@A@@B@@A@@@@@A@@B@@@@@@A@@B@@A@@@A@@BA@@@@@@@AB@@AB@@@@A@@@@BA@@@@@A@@@@@@@AB@@@@A@@@@@@B@@@@A@@@@@@B@@A@@@@@A@@@AB@@@@A@@BA@@@@@@@@@A@@B@@@@A@@@@@@@AB@@@@@@@@A@@@@@@BA@@@@@@@A@@@ABA@@@AB@@@@@@@@A@@@A@@B@@@@@@@@A@@@@B@@@B@@@@@B@@@@AB@@@BA@@@@@@@@B@@@@@@BB@@@@@@@B@@@B@@@@A@@B@@@@@@AB@D@@@@AB@@@@@@A@@B@@A@@B@@@@@B@@@@@B@@@B@@@BBB@@@@@B@@@B@@@@@@AB@@@@@@@B@@@B@@@B@@@B@@@@@B@@@@B@@B@@A@@@@@@B@@@@@B@@@B@@@@AB@@@@@B@@@@AB@@@@@B@@@@@@@@A@@@A@@@A@@B@@A@@@@@@B@@@@A@@B@@A@@@@@@@A@@BA@@@A@@AA@A@@@@BA@@@@@A@@@A@A@@@A@@@A@C@A@@@A@@@A@@@@@AB@@@@@@A@@B@@A@@@@@@BA@@@@@@BA@@B@@A@@B@@A@@@@B@@@@@@@BA@@@@@@B@@@@@B@@A@@B@@@@AB@@AB@B@@@@@B@@@B@@@@@@@B@@@@@@AB@@B@AB@@@@@B@@E@AAG@C@GAC@C@EAA@A@E@AAC@C@C@EAA@A@C@C@CAE@E@C@@@BDDJHRFPHRFNHTBD@@@@BFFPFNFNHRFNBDFPFN@FAT@T@TAT@TAXCHCJGNGPEJGPEJ@HBB@@@B@B@J@B@B@D@H@F@H@H@B@B@BJTDLTzR~\\NpJZXVRzXLjFP^ VRzVLhJ`ZZT`׼@@O@[@¿@m@@[@¥@[@S@{@g@o@e@uAq@ĉBA@e@_@W@EB¥@@OKKGGECACCAAGCCAIECC@@B@@A@@@A@@B@@A@@@A@ABA@AB@@A@@@A@ABA@@@ADA@@BABABABABAB@BA@ABA@AB@AABCBA@@BADABABA@@@A@@BA@AB@@A@@@AB@BABAD@B@BA@A@@BAB@@A@ABA@@BA@A@@@@BC@@@C@@@A@@@AAA@@@A@@@A@@@@@AA@@@BC@A@AB@@A@@@@@A@A@A@@@@AA@@@A@@@A@@@@BAA@@@@@@A@@BA@@@AB@@@@@@A@@@@BA@AB@@A@A@G@@@@BADA@@BA@@BABA@@BABC@@BA@@BA@@@A@@B@@A@@@AB@@A@@@@@A@@@A@@@A@@DAB@@A@@B@@AB@@A@@@A@@@A@@BA@@A@@A@@BA@@@AAABC@@@@@@@A@@@@@ABA@@AA@A@@AAAA@AA@@A@A@@@A@A@@BA@A@@AA@@B@@A@@B@@A@@BA@A@@@ABA@@@@@A@A@@@A@@@A@@@A@@AA@CA@@@@@A@AAAAA@@@AA@@@A@@@A@@@@@A@A@A@@@@@@@A@@A@BAA@@A@@BAAA@@@AB@@@A@@A@@@@@AB@A@B@@@AA@A@@@A@@AA@@@@AA@@@AA@@A@@AA@@@A@@@@@AA@B@AAAA@@@@@@AA@A@A@@@A@@@@BA@@@@AAB@@@@@@@@A@@A@@A@@@AAA@A@@@@@AA@@A@AA@@A@@@AA@@@@A@@A@@CA@@@@A@@@@AA@@@AAAAAAA@@@@AA@@BA@@AA@@@A@ABA@A@A@CAA@@@@@AA@@A@AB@@ABC@@B@@@AA@C@@@AAA@@AAAC@@@ABA@@AA@@BA@C@A@@@ABC@@@A@A@A@@@@@ABE@ABA@@@AB@@@@A@@@A@@@A@AB@AABA@@@A@A@A@@@A@@@@BA@A@@DC@A@A@@BAAABA@A@A@@BA@@@A@@B@@@@A@AB@BCB@@AB@@A@@@A@@BA@A@A@ABCCE@@B@@AA@@@BA@@A@A@@AB@@@AA@@@A@A@@A@AA@A@A@A@@@A@AA@@A@@A@@AA@@@@@@A@@A@A@@@AA@@CA@@@AA@@@A@@AAA@@@@AA@@@@BA@A@@AAA@@AA@@@CA@@@@CA@@AA@@A@AC@A@A@A@A@@B@@A@@A@@A@@@@@@@A@@@A@@@@@A@@@@@AA@@A@@@@@A@@@@A@@@@A@@@A@@BA@@@@@A@@@@B@BAA@@@@@@@BA@@B@B@@@BA@@@AB@@@@@AA@@B@@@BA@@@@@A@@@@@@@A@@@@@A@@AA@@@@CAAACC@CAEAAEGAA@@@A@A@EA@A@C@@BAA@CAE@AEACAACAAA@AEACAE@EAAEAACAA@@AC@E@ABC@ABA@@@@@A@@@@A@AAA@A@@@BAB@@A@@@ACA@A@AB@B@BABAB@BABC@A@@@A@@EAAA@@AAAAGCAAA@A@A@@AA@@AAA@E@@CCCA@@AAABCAA@AACCEEAAA@@AE@C@AAECA@CEAC@AAAA@A@G@AAA@ACCCCAAAACAAEEA@AACAC@CACCA@AAC@G@C@UCKGMEA@C@A@C@@@AA@@AAKCA@C@E@A@A@@@AA@@@A@A@@AAEAAAC@A@A@C@C@C@@@CBCBA@@@ABABABC@CAA@GCEEEAGA@@CA@@A@@AA@AA@@AAA@E@GEKECEAEAG@@ACCACCCC@@@AAA@A@AAE@A@CAC@AA@AACECAAAA@CAAACAAAC@@@AAA@A@@@A@AAA@A@A@G@C@CBC@CBCBCBA@@BAA@@ECCECCEAEC@@A@CAGGAACECAEAE@GBABABCBABCBC@C@ABC@C@E@C@C@@@M@EBG@E@KAM@MDGBABA@A@"],encodeOffsets:[[90459,44853]]}},{type:"Feature",id:"652323",properties:{name:"呼图壁县",cp:[86.871584,44.179361],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@B@@FBBB@@@BFBHDDDFBBF@BBBB@BBJRDHBDELENCHCJAFEN@B@@@B@BAB@B@@BB@D@BBD@B@@@B@@@BB@@@@BB@@B@B@B@DADAB@B@@@D@B@@AB@BA@@B@B@@AJ@D@F@F@N@TBL@\\k`BBHFFD@BCJ@D@B@F@B@@B@@DB@BD@B@BA@INCDGHCDAFCH@BEL@BIVTHfRJBF@AD@@@D@@@B@BAF@BADAB@BAB@@ADADBH@@BB@B@B@@ABCD@BAB@BB@BBHDBBAB@FFD@@@DB@@B@B@B@DBB@DB@AD@H@B@D@@AD@BAB@@@B@@AD@B@BNHhTB@NH@F@@@B@BB@@B@D@D@@@BB@@@BB@@@DBB@B@FBB@@@BB@BBBBBBBDBB@B@B@B@@@@AB@D@@@B@B@@CAABC@ABA@@BB@B@D@B@B@BBBAB@@@@D@B@@@@@BB@@B@@@@@BAB@@@@A@A@AAAB@@A@C@@@@B@@BB@@B@AB@@BB@@AB@@@@@B@@@B@BB@@@@BCB@BABADADAB@@A@@BCDA@@B@@A@@@@BBBAB@@ACAAC@@AC@@@@BA@@@BBB@B@@B@B@@@BA@ABAB@@BBBAB@B@@@@B@@@@@BBB@@A@A@CAC@A@CB@AA@A@A@@BABCAE@EBGEEAC@A@CDCFADA@B@DB@ADABCBA@@DBB@@AB@@B@@ABA@@B@BC@CBC@CB@B@@@BA@GBIF@@@@@B@BA@A@CB@BA@@BAB@@BBA@A@A@ABA@@@BB@@AB@@A@A@@@@B@@@@@BB@@@@@@@@A@@B@@@@@@@@@@B@@@@B@@@@@@B@@@@B@@AB@@@@@@A@@B@BB@B@@@@@BC@A@@@A@@B@@AB@DA@AB@@@@D@@@B@@B@@@@ABB@@@B@B@@@@@ABA@@@A@B@@B@@B@@@@B@@@@@BB@@@CBA@A@@@@A@@B@@A@@CAA@@@@@@@BB@BAB@@AAA@@@@@@B@@A@@@@@@@@B@@A@@B@B@@B@@@DA@@A@@AB@@BB@BA@@@B@@AB@@@@@BB@@@B@@@A@@@A@@@@@@B@@A@@@@@A@@@@@BB@@@@@@ABA@@@@@A@A@@@@@@@BB@@A@@DB@BD@@@BBBBB@BB@@B@BDB@BBB@B@@BBBD@@BB@BBBBDBB@@BB@BBB@@BBBFDFB@@BDD@@@B@@@@DD@B@@@BAB@DA@@DA@@DAB@@@BAB@B@@IVADCL@@MdEPENADERENAD@BAHAFCHK^CNAB@HCRAT@@@DAB@B@B@BCR@BAD@BAB@@AB@BCHA@@NAZ@B@BJLLNV^FBPRFZHDBLBDBB@DBF@DDV@D@H@D@`@x@n@H@J@@@dAf@dBf@d@dAd@°@@|@^@NÒ@t@~@\\@ÜA @@Ŏ@z@`@p@p@@˝BMJ[LgRsTuFQHQFQFQFQFQHQFQFO^BEHQDMXTuFQFQRs@A@I@w@g@Q@e@Q@c@S@Q@S@QQxc~uXmJ[BE@aOaSMWUSWNKFQJAFARELU[EiFODcDIMIGBO@@cF@FCBBDBB@FC@EBDD@@EHBBFHFF@BJ@L@R\\E@@FC@@DGBK@IDJJ@D@Fp@Q@EGQIQAE@A@ABA@AAA@C@A@CBA@E@A@A@E@ABE@E@CPSLM@@@@@A@@@@AE@@AG@GCWOECEGO@CAA@@@CAA@A@@@AAAAC@A@@@A@@@@@@@C@@@@@A@E@AAA@C@EACAGAA@C@CCGACCI@@CIACAA@@CG@A@A@@AA@@@AEMCI@A@@@AA@@@EKFGBCBGFCBAAADC@C@ACCEEGE]SCAA@CE@@AA@@@A@A@@A@AA@AA@@AC@@@AAA@AAAAAA@AACACAA@AA@@ACCCCACAAA@@AAAAACACAAAA@AAA@AAA@AAC@AAA@C@@AA@@@@@AA@@C@ACCAAAA@@@AA@@A@@AAAA@@A@@@AA@BA@@@@AA@AA@@@BA@@@A@@BA@ABA@@@ABAA@A@A@@@BAA@@A@@@@@@A@@AA@@A@@B@@@A@AAA@AA@@@@@@@A@@A@@AA@@AA@@AAAAAAAAAA@AACC@@AAAEAA@AAA@C@@AAA@@@@ABA@@C@AAAA@@BC@AAG@A@A@ABC@C@A@CBCB@@CBA@A@ABC@CBC@C@AFCAA@C@A@C@@@C@A@A@A@ABA@A@@@EBC@CB@BE@@@A@A@A@C@@@C@AAA@A@A@@AA@A@A@@@A@A@A@@@A@A@ACCAAEAAAACA@@A@C@@AAA@A@@A@@B@BA@@@@AAA@@ABC@@@AAC@A@AAA@A@E@AAAAA@@@@BA@@@@AAAA@A@A@@AA@@@AB@@A@AA@AABA@C@@@@@ACA@@@C@@@@AA@A@A@A@A@AAA@@@AAA@@@AA@@@@AAC@@@EAA@@@A@AAC@@AA@@C@GAKAKAQAOAIBM@GAM@U@K@IBI@KEKAIAE@IGWKECECCCAEAECKAECKAIGIAECC@C@CFGBEBC@AAAOAA@EEGECCAACAE@GBKDEAGCIACAQ@EBG@CAIGCCEGCI@A@@@@AC@@@@@EAEAKDKFGBAHAHCLCHE@CDEFI@C@EACBG@IAC@CGMCCAEIAE@CDGBCBIBIEEGCIAI@EFIDCDELEPKHEBEBEBE@C@@GCG@IECEIKKQECIEECGGEAEACCEECCAECEGECCAA@CBCDEBCBCEGKKAEAEAGAAEAGCQCSEEACCECEEEAIAMAICACMAE@IDCBEBI@MCMGECCE@CECECSCA@IACAAGAMDSAKCCEEGACCEAO@CCECGAGCIEIACAA@CCSCEAGCEAECAA@ABEACEICCCCAAGCGCCECC@CBI@CBAACGCGEGACAG@I@KDEBE@EAEGGIAEECECEMAG@CDCBEAC@E@E@A@EBEBCJGLGNGJEDABCBEBEBAAG@KAKBGJGBECECCAGAK@GIOCECCEAACCAECE@MAKEGBCBCAAA@A@CDMBMAGAEBCBGDEDCRMJEJCLEJE@E@ACCMGIIEEBEBCFEBEEG@C@C@ABCFEDEFCBEBIACGGKAEBEADMHO@G@I@CEE@EFGFIFGHGJG^[NQLGFIAEAM@GA@ADABABADABADAB@BA@@BADA@@BABAB@BA@@B@@A@AB@@C@A@@@@@A@CACBA@A@AB@@@B@@AB@B@@@BAB@@ABA@A@C@A@A@ADA@A@@BA@@BAB@BKFADCBAB@B@@@BA@ABABAB@BABA@CDABABABCB@BAB@BA@ADABCDCBA@ABCBABCBABAB@BABCBABEDABGDEDABA@@@A@@BABA@A@CB@BA@A@C@C@EDG@C@C@@BEB@@CBC@CBA@CBC@A@CBA@ABEBA@EBCBABAA@@GD@@C@A@G@C@@@E@@@A@ABA@ABGDA@A@G@C@EAC@EBC@@@CBCBIFEB@BE@A@@BA@AD@@@@AB@@@@ABBB@D@@A@@B@BA@BB@BB@ABB@@B@BADAB@BA@ABABABE@A@CB@BA@A@ABC@@BABA@A@AB@@@BA@@@@B@@@BABIHGDI@K@I@MDUHQFSBG@GDQHQFGDCFAF@FBHHJAHIDEDEDBHFL@FAPEFMDUFMBKDAD@DBDDJ@DADAFIFEBEDEFAFCBGBEBGFCD@D@HAJCN@T@BEN@DBHDNBFDFJHFDFDBD@N@JBFDFDBDJBF@DBBDHBFDDHJDDBF@HAH@FDDFFHDD@HBLCbGLCDBB@@JHJBFFLBBLBL@DBDD@H@FAHAFAH@DBBBBD@F@FH@@FDFBJ@JARBB@DDDDHFFBJ@D@DDDDBF@D@FADIJCFCF@F@HBDCDCFBDDFDDBDFBDDDDFDFFDHFFADDDPHFBFBLFFBTJLHFHDFDDDDADADAFAF@DADGFIJCDEL@JCFEFEBSDKDGBIBM@KBCDEBAF@FDFFBJDFFDFAFAFBLBHFHBDHDDFDDDFHFFFHJBFDHBJBLBJBDFLBFDDHHDFDDBDBBAFCFFBF@DDBFDH@^BHCFALAH@F@HFJDDNFPBLDLFB@JFHFFLFJFJFFFHDDDHFFBHBDAHEDCDALALAFEDCDEDCDADBBBHHFJDDFFBFBBBBDBB@@B@DBBBBBDB@B@@BBDB@BBB@@DBB@@BDBD@@BD@B@BBDDFBBBBB@@D@FDBBD@DBFBHBH@B@B@DBD@LBB@DBB@DBBBB@BB@B@@CBEBIBC@CBA@CAK@@B@DCDABAD@B@D@BADABCB@@@B@B@B@BAB@BABB@@@B@BA@AB@BBDBB@@@DCB@B@DB@@BBB@BB@@B@@BB@B@@@BAB@D@B@BA@@DBB@DBD@DBB@B@BBB@DAB@B@B@BD@@D@B@B@B@@@B@BB@AB@BDBB@BBBBB@@ABCDAB@B@B@BBB@DBB@BDDBB@@ADDBB@@BDF@B@BA@AD@B@DB@@D@B@FAFAD@B@D@FA@CDAD@@@B@@BBDB@@BDB@DD@B@@@B@@BDBDBBBBBBDBBB@@BBBBBBBB@BD@B@@@F@@BDF@@B@BB@B@@BBAB@BBB@B@B@@@B@@@@BBDBBBBBBDBBBDBB@B"],encodeOffsets:[[88577,45227]]}},{type:"Feature",id:"652324",properties:{name:"玛纳斯县",cp:[86.20368,44.284722],childNum:1},geometry:{type:"Polygon",coordinates:["@@BKBKDCFCBGACAGEECGCCEGEEEIEIEKGEIEA@KEKCOAMECCEI@G@EBGBKDEAG@]CGAECCE@EADEBEAAACCCCEGGCCAEEKACAIAKAICGAEGIEEGECECCCEGCACEGAGAKBEBECEEEICEACE@EBEFADCLAN@JAHALCTCFAFEDE@IFKDCJIHEBC@CBEBEBCBCCCCCCEEGKGSIEAKEEAEAOGCCBCEECGEEECCCCCEAACCCCEACDEDCAC@G@EDEDEJIBC@E@CAECCCCC@I@EAGECCCCA@QAIBI@EAEC@@EGE@C@AAAA@CBGBEBG@E@GCCCAK@KAAAEKAEGI@IA@CAKDaHKDGAC@GCEECC@EBG@GAECCGICCAECGAA@CAECICACEAE@I@MACECECIGCEAECMAG@CFMA@A@A@CAAACAA@A@@@A@ABA@A@@@A@@@@C@@@@AAAAKCA@C@A@@@@CCAAAAACCCAEAC@C@E@ABA@C@EBA@@@AA@E@@C@CACACAA@C@CAAAAA@AA@@@GBCBADA@@BCBGDABABA@A@A@@AAA@@A@AAA@AC@AAA@C@@A@A@CCGEAACBE@A@CBCFIF@@A@A@E@A@A@A@ABCBI@A@EBAB@@@B@@@B@B@@AD@@CDABABGAA@E@C@EBCBAB@BABCFABA@CAAAE@MAA@CBCDCDGFA@GAC@ABCBAB@BA@A@G@A@A@ABABA@A@EA@@A@CBCBCB@@ABAAC@ABEDA@@@A@AACCC@AAAA@AA@A@E@CAC@CAA@A@A@@@A@A@@BCBG@EBABIBCBABABCBABA@E@ABIBIDA@C@A@CAA@@@CAGBA@E@C@@@ABADABA@A@ADAD@@ABEBAB@BAB@@A@GBEBA@@@C@A@EBA@EDGDEDABA@C@A@ABA@A@@@CBCBCBABCB@@CBC@ABA@A@CDADA@@AA@AAEAA@E@E@ABEBABA@ABA@A@GCA@A@A@A@A@CBABA@ABAB@BC@ABABA@CBABA@@BCBABAB@B@B@BABEBGHCF@BCB@BAD@DA@@@ABC@A@A@@BAB@DAD@BA@A@C@CAAAI@A@C@C@@@ABABA@A@A@C@C@CBA@CAAA@@ABA@CBA@@AEAC@@AA@ICM@GBEBEBC@@@@@DDBB@BB@F@B@@BB@@BBBBB@B@@@BA@A@C@A@CB@BA@@@@BBB@@@@@BC@A@EBC@A@AB@BA@@@@BBDBB@BDB@@@B@@BB@B@@AB@BAB@B@BAB@@@B@@BBDB@@BBBFDBDDBBBB@B@FB@BBB@FBBADAB@D@DBB@B@B@B@@BBB@FBB@BB@B@B@DAB@B@D@@B@BB@BB@B@@CJB@@BBBBBHDFBBBBBDFB@BBBBDD@BBBB@LDB@BD@D@BA@BD@BB@B@F@@@D@BDB@@B@BBBBBFBBBDB@B@@AB@@@@NBBBD@B@@BBAB@DA@@DA@AB@@AB@B@D@D@B@B@DAD@H@@@BBB@BBB@B@FDBB@D@@@D@BBB@@BBB@FBB@@@BBBBB@DDBB@B@@@BABAB@B@@@DBB@@DDB@@B@@AB@BB@@BBB@B@BAB@BA@BFABAD@BADBB@BBBBBBB@DBB@BA@CD@B@B@DBBBBBBDBBB@@@DBDBBDDFD@@BB@B@B@D@DBDB@@B@BBBBH@D@B@BABCBABA@@@@BBDBBBBB@@B@BBB@B@B@BDBBBA@ADA@@BBB@@D@HADBBAD@BABAB@@@BBB@@B@@ABABAB@BB@B@BB@@BB@@@BABAB@BA@BB@@@BB@FAB@D@DB@@A@ABAB@B@B@@@BBBFD@@BB@B@B@B@@BBB@FBBBB@BB@@BBBB@@B@B@B@@@BB@BBB@B@@AD@B@B@BBB@BA@@BAB@F@@BB@@AD@BA@BBAB@@@BCBABABA@@BABAB@BAB@B@DBBBH@D@B@B@B@@BB@BB@@@DA@@B@B@BB@B@FBDA@@B@@@BB@@B@@@BA@@B@@BBB@DBB@B@@B@@BB@@BBB@@@@B@@AD@@ABB@@BB@@F@B@B@D@@BB@D@B@@AB@BBB@BBBBBDBB@@@B@BBB@BBB@@B@@ABA@@DB@@@DB@@B@BAB@@@B@@B@B@@B@J@B@B@@@BB@B@@AB@@@B@B@@BBBBBB@@BBBDDBLDBBB@BB@BB@AB@BBBADB@BBBB@B@D@@DB@BBD@@B@@BB@D@@@B@BBB@@BB@@B@@CBAB@B@@BB@@BBB@@BBB@@BBB@D@B@@B@B@@B@BBB@@@BAB@B@@@B@B@@B@@BB@BD@BBBBBB@B@@@@BBB@B@B@BB@BB@B@BB@@BB@BBB@BB@DAH@@BB@@@@DDD@B@B@B@@B@D@@BB@BBB@@DB@F@@@BBB@D@D@B@BB@@@B@BB@@@BBD@DBBDB@@@B@@@AA@A@@BAB@B@BBB@@BDD@@BBB@B@DA@@B@@@@@@BBB@@B@B@DAB@@@B@@@B@@B@@@BA@ABC@A@@B@@B@@@D@F@B@H@D@F@B@F@@@D@B@FAF@D@B@D@@@D@B@@AB@B@BBD@FAD@B@B@DBB@B@BB@@@B@@ABAB@@CBC@AB@@@DA@@BAB@@A@A@AB@B@BAB@BBBDB@B@B@@AD@F@BABA@A@CA@BC@CBAFABAB@@BBD@B@DBBB@@@BAB@B@BB@BB@BBBABAB@B@BDD@B@BBD@@ADAB@BBBBBD@D@@BB@@BAB@B@BBBB@@B@BBBF@@BB@@BABA@@B@B@@FFBBFFNJLFJFJHNJLLLJNLHHLFHHDJJLJNNPDJHHBB@@@BBB@BB@BBBBB@@BB@BBB@BBB@BBBBB@BB@BBBB@BBBBB@@BBB@B@@BB@B@BB@BB@BBBB@BBBBBB@@BBBBBBB@BBBB@BB@BB@@@BBB@@BB@BBB@@BB@BBB@@BB@BBB@@BB@BBB@@BBBBBBBBB@BB@BB@BB@BBB@BB@@BBB@BB@@B@B@@@B@@AB@B@@@B@B@BA@@BAB@@AB@B@BA@@B@BA@@B@B@@@B@@@B@BBB@@@BDDDFBBBDBBDD@@DDBHBBBD@BDLDP@@@BAF@DAFAH@B@FAD@F@BABAD@BBJBBB@@D@DAF@HBF@B@BADAJ@DADBD@B@BBJ@D@BBBFDDFBBBD@B@B@DB@DD@BBDFL@B@B@B@D@DAD@BBDBF@H@DBH@DCDADAFGVCHAFBHAH@@@B@H@DCFAB@B@@@DAB@BAP@J@LBBADAF@FAFBL@DAB@BEFADCFCLADAFB@@BBD@@@B@DB@@B@BBBBDBDBBBBBBDDJLBF@B@D@@CBCB@@A@C@A@A@ABA@@BADABA@ABABA@CB@@IAABBB@D@@A@C@CCAA@A@A@AA@@A@A@AA@A@CBABAB@B@B@@@BABADEB@F@BBB@BABABABA@A@ABABAB@B@BFDDBBB@BABA@ADABABADABADCDCH@@BD@B@DADABCDAB@BBB@DAB@D@FBJAB@BABCDCB@BAB@BBBBBBF@BEHCDAD@B@DBB@@ABBBBBBBDBBBB@@B@BBB@D@BBDDD@B@BABCB@@GDED@BAB@B@B@B@@@BADAB@@@B@@@@BBBA@@BABA@AB@B@BB@@@@BB@@@@AB@BBB@B@@AB@@@@@BA@@@@@@@ABA@C@A@C@EAC@EAA@AAA@@@@@@@A@@@@@@B@@ABAD@@C@AA@@@A@A@AA@A@A@AB@B@B@B@BA@C@@@AAACBABC@C@@AACAABC@CDA@EAA@@BABBB@@B@DB@@B@AB@@@B@B@BA@ABA@A@AACAC@A@A@AB@B@BAB@BA@C@ABCBAB@B@@AB@D@@BBBBB@FB@BDD@@CBCAEACAA@@B@BDBDB@B@@ABA@CAEACA@@ABAD@B@B@BABGB@@GDEBEAABE@ABAB@BBB@BB@BA@C@AD@@ABBB@DDB@@D@BA@A@AAC@AA@B@B@BBB@BA@A@EGA@A@C@A@@D@BA@A@@@@A@A@@C@A@@@A@@DA@ABCBA@AA@ABA@A@@AAC@CBE@@B@FAB@B@BBDAL@B@@BBB@@@DA@AB@@@BB@B@DADE@C@CBED@@@B@@@B@@E@E@ABABAB@B@BAB@BA@A@AA@@@C@@C@CB@@@BBBDBF@BBB@BBB@B@@@@B@D@B@BA@A@@AAAACC@A@@B@BAB@DBBB@F@J@B@BBABABA@ABG@@@A@@BAB@@E@@@@B@B@BBB@BABABADCBABA@A@AA@AIC@AA@ABA@@B@BB@@BB@BADB@@BF@B@B@@BB@@D@BBBB@B@@@BABA@A@AA@CA@A@A@CB@@@@@BDBB@ABABCB@BA@@@@AAA@A@A@AA@@BA@ADABA@@AA@@@@A@@B@B@BA@@@AA@A@A@@@@BA@@B@BDD@D@DA@CBCBA@@B@@DBB@B@B@BAB@@ABAB@D@BBDBBB@@ABA@ABABABAB@B@@A@@AAAA@A@@@@BB@@B@@@@ABA@@@BB@FAJ@@GDCBBBFBPJVNVNFDGTCJKLSVeZEFCBEFEFAFEN@FAD@B@F@F@@AD@DCFEJAHAD@BGJGJCFCDKHOLEDOJGBKHEDGFEFCDEFADCFGHCDGDCBMHKDE@KDħG@@Z@T@F@B@H@H@F@H@H@H@F@H@H@F@H@HBH@F@H@H@F@H@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@H@F@H@H@FBH@H@H@F@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@H@F@H@H@F@H@H@F@H@H@H@F@F@HAF@F@D@H@FAH@FAH@FAHBF@F@B@HAHմB¶@r@@v@z@@M@]@{@@¯Bc@c@cAe@cBe@c@@@I@G@m@w@_@C@G@CCU@CAE@CAAACAKGCEYOQEAU]KMIK@A@ABY@MB@DG@ABA@@BA@ABC@ADQ@A@A@ABA@C@@BSDQ@GBADML]DGBEBG@ABCFMFQBCFMFONc@@DKBCJU@@@ABA@A@@BA@CB@@CB@@CBA@A@@@ACC@@@@@A@@CC@AA@CEAEAA@@AA@AAA@@AAACAA@AAA@@ACAA@@@AAA@ACA@A@AA@@AAAAA@A@@ACA@@CB@@@AA@@@@@@B@B@@@@@B@BA@@@@@@AA@@@@B@@@@@B@@@@A@@@@B@@@B@@@A@@@A@@A@@@@BA@@@A@@ABA@@AA@@BB@@@CB@@A@@@@A@AB@@@@A@@@@@@B@@@@A@@@@B@BB@@BA@AAA@@@@@@B@DB@@@BA@@@@B@@B@B@DA@@A@@A@@@@@A@@A@@@@AA@B@@@B@BA@@@@A@A@@@A@BA@@@@@AA@@@C@@@@@BAB@@CBA@@@AB@@@B@D@@A@@@@@AAAA@@@@B@@@@A@@BA@@@@@@A@@@@A@@@@@@A@@@@@@@@A@@@@B@@@@@@A@@A@@@@@A@@B@B@@@BA@@AA@@B@BAB@B@B@AA@@BA@AB@@ADAB@B@@A@A@@@@JEHAB@@A@@@ADAD@DAD@@A@AB@BA@@@AA@@BA@CA@@ABADCB@BCAA@B@BCDEDCB@D@FBHFFAF@DBBA@AB@B@B@@BDAB@D@DBB@B@@@AA@A@@@@@A@@A@A@ABAA@@BABAB@@A@@@A@AA@A@AA@@B@@A@@D@@BD@BBBD@@BAAA@A@@B@@@@AB@DC@AB@@@BABCBCBA@ADA@A@@A@@A@A@@@A@@@@BA@@AA@@BAA@@@AA@@@A@@D@B@@@BABBB@B@@@@@BA@A@@@@@AA@@A@@@@@A@C@@A@ABAAA@A@C@A@A@@AB@BAD@BADB@@@A@A@@@CBA@@@@@A@A@AAAACAAAAAAA@@A@@AA@E@AAA@C@@AA@@A@@A@@@C@C@AA@@A@A@@@EMGA@gSMG@A@ABC@@@A@@BA@ABC@@@C@A@GBCA@@CAA@C@A@A@AA@@C@@EC@EBAAAGCAAA@@ABA@ADCBA@@@A@AAA@@AGBCBC@@BA@ABABC@ABE@A@A@@@C@@BCE@IAeQSGJU@AFK@ADGBEDCHGDCJMB@@A@AACA@@CA@@@@A@E@A@CDI@AECGEAAl_@[AK@S@M@E@E@CBI@@@A@AB@@ABA@@@A@C@@@ABABC@C@A@A@AA@@A@@A@@A@@@A@@@AAC@A@CAA@@@ABA@A@A@@@AFMBEDIDGFMFKACCGIQAAA@AA@AAEEACCGCEA@A@@AAEA@@@A@@@AAAACAAACAAAACAAA@@@@@A@@@A@AAA@ABAAA@@@AAAA@@@CE@AE@@@A@C@@AAAAAAAAA@@AACAAAAAAAACAC@@@A@@@ACCA@AC@@CAAA@@@A@@BCDCB@@E@C@ABCBE@E@A@CA@@C@ABCB@@A@ACE@AA@CABC@@AACC@AAA@CAA@A@A@ABADCBA@@AAAA@AAAACA@@BAAA@@@A@A@A@C@@@ACA@A@A@CBA@AAA@A@CAC@CAA@CA@@ABA@C@A@AB@@A@A@@AA@@@AAA@AA@@CAA@A@CD@@A@CAAAA@@BABA@@@A@BA@ABA@A@A@A@A@@DABABC@A@C@ABCBADC@C@AL@DBB@DAD@JAFADA@@@AAAA@AACAA@CAA@KAC@CAA@A@G@GAEACAC@AAECC@@@AAAAEACCAAA@C@@AC@CA@AA@CA@@AA@ACAAA@@@ACAAAAACAA@@@AAACAAEAEACEICGEAGAABCDCFCDCFCBE"],encodeOffsets:[[88435,45029]]}},{type:"Feature",id:"652327",properties:{name:"吉木萨尔县",cp:[89.180437,44.000497],childNum:1},geometry:{type:"Polygon",coordinates:["@@BC@AAAAE@EBG@ABCBGDI@S@C@EBCBAAAAC@C@@@@@AAC@CAABCB@@ADEBA@A@@@@AE@E@ABADCB@@ABCBC@IBCFCDA@CAC@ACC@@CCOK@A@CBCBCDOAECK@@AAAG@CAEACAAAC@I@EAE@CBAACAC@ADC@@@A@CCAEEACCIAAEAEAAA@E@AAA@AAEEC@CA@A@@@A@AAAA@CAC@ABE@AAA@A@@@AA@@@@@@B@@@B@@@BAB@D@@@BAB@BA@A@@BA@@@A@ABA@A@@BA@AB@@A@BBDHBHAFBDDF@DCFCFCBCBGBGDAF@D@FDDADCDEDEDEBGDGDCDA@GBEBEDG@EBCBG@GBE@G@GAGBGBE@EAGCE@GBIBKBIACAACCCG@EAEBC@EBCBG@GBEDGFE@G@KBGBCBADCBEDEBCAE@EAEAGAI@GAG@CBABADAD@BC@G@EBC@CDEBEBC@E@C@E@EBEBEBCDAFCDCFCBC@C@G@I@@@@B@@@@A@A@CBA@@B@@@B@B@@@B@B@BB@@B@BA@@@A@@BA@A@A@A@ABC@C@A@A@A@A@A@AB@@A@@BAB@@A@@B@@A@A@A@A@@@AB@@@@@B@B@@@@@B@@@B@@@B@BAD@BABAD@@AB@BAB@D@@AB@B@@@B@@@B@@@BBB@BBB@B@@@B@B@@AD@B@@@BAB@@@@AB@@A@A@A@C@CAAAC@A@AAA@A@@@ABA@AB@@ABA@@BCBAB@@ABABABABCBABA@@BA@@B@@@B@@@B@B@@@@ABA@@@C@A@@@A@A@@BA@@@AB@@@@@@AB@@@B@@@@A@ABA@AB@@A@@@AB@@A@@@@@@@@BBB@@BB@@@B@@@@@B@@AB@@@@A@ABA@AB@@AB@@@@@@AB@@@@@@A@@@@@A@A@EAA@A@AAA@A@A@@BA@@@@@@B@DBB@@@B@BA@@B@BA@@BA@@@@@A@C@C@AAA@@@CAAAA@A@@@A@A@A@@@A@A@ABA@@@@@A@A@A@A@ABA@@@ABA@A@@@A@AA@@@AAA@@A@@A@@A@@@A@A@@@C@A@C@@@A@@AA@@@AA@@A@@AA@A@@AA@@AA@@@AAA@@AA@A@A@CBC@A@@@C@AA@@AAA@@@C@@@A@@@C@AACAA@A@CAA@A@@@@@A@@@@@@B@@A@@B@@@@ABA@@@A@@@A@ABA@A@A@A@@@A@ABAAE@@@C@@BA@A@@BA@C@ABC@CBA@A@A@ACA@AA@A@@A@@@A@@@A@AAA@C@CAA@@@C@A@C@A@A@AA@@A@@BA@@@@@@@A@@BAB@B@B@B@@@BB@@B@@@B@BA@@B@BA@@B@@@B@@@@@B@@@@BBB@B@B@B@D@BBD@BBB@@@@@@B@@BB@@BBB@@BB@@BDBBB@@@B@@@B@@AB@B@B@B@@@B@@@BB@@BBBBB@B@@@@ABA@A@A@CBA@A@@@E@C@C@AB@@A@ABA@AB@B@@A@@BA@A@ABA@AB@@AB@@@@AB@B@BA@@BAD@@@@@@@@@@@@A@@@A@AB@@A@@@@@A@A@@@AAA@@@A@C@A@ABC@AB@@CBAB@BA@AB@@AB@@A@A@@@C@AA@AA@AA@AA@CAAAA@@@A@A@A@A@@BA@@B@BAB@@@@ABA@A@ABA@A@ABABABA@@@@@A@@@C@AAA@CAA@AAA@@@AAA@E@C@CBA@A@ABABA@A@C@@@AB@@@@@@@B@B@@@B@B@BBB@@@B@@@@ABA@@BA@@@A@@B@BA@@B@@A@ABABAB@BAB@BA@@B@@ABA@ABABA@CB@BA@@B@BA@@B@D@@@B@@@BB@@B@B@D@@@B@@A@A@A@AAC@CAAAC@A@@@E@A@A@A@A@A@CBA@A@@BAB@@@@@@A@@@A@@AA@@AAAA@AAC@AAA@A@A@A@A@A@ABA@A@A@ABA@@BABA@@@@BA@A@@@A@A@@@A@A@A@C@A@@@A@@@AA@AAA@@@@@@A@@@@@A@A@A@A@A@@@A@A@A@@@@@ABAB@BAB@BAB@@ABAB@B@B@@@BBBBB@@BB@BB@BBBB@@@@@B@D@BBBBF@BAFABABCBC@EBEBCBABAD@DAD@BAB@@@D@BBD@B@D@B@@@BA@@BA@@BAB@@A@@BHBFBFFHDB@DBD@BABABA@@B@DADA@@D@D@D@B@B@D@BBFBBB@@@B@B@@BB@@B@B@F@D@B@LDBB@@BB@@D@B@D@B@NFLHVDD@H@D@BBB@DDDBD@DBBBB@FFBBBDBBDBDDBDB@BBH@B@B@BB@BBDDFB@FDBBD@F@@BB@BBFFDDBBB@DBBABB@@DBDD@@@FBB@BB@@BB@B@B@BBHDBBBB@@BBFB@@@B@@@BADABA@ABABA@A@@B@BDB@B@@@BA@AB@@B@B@BBB@@@@@@B@@@@AB@BAD@B@FBD@@BBBDFBBB@FBFBDBFB@BBBDDBFB@BBF@DBB@AD@B@B@@F@B@B@@BBFHBBBF@DDDBBDB@@@@BB@@@B@@@@@B@@@@@@@B@@@@AB@@A@@@BB@@@@A@@B@@AB@@A@A@@@AB@@@@@@B@ABA@@@@@@B@@@@AB@@@B@@@B@@B@@@@@@B@@@@@BB@@B@@@@@B@@@@@B@@@B@@@@@@@BB@@@@BA@@@@B@B@B@BBDB@@@BB@@DB@@@@DB@@B@@BB@BB@@@BAB@@@@BB@@@@BB@BB@@@B@@B@@DB@@BB@@B@B@@@@B@@@@B@@BB@@@@BB@@B@B@@@B@B@BBBB@@@@B@B@@BB@@A@@BB@B@@@AB@@B@@BA@@@DFAD@B@B@BAB@@@B@@@BA@@BA@ADA@@B@B@@A@@@@B@@AB@@@B@BABBBAB@@@B@BCD@@@BAB@@@@@B@@@B@B@B@@ABBBA@@B@B@@@B@@@B@@A@@B@@AB@BAF@B@@@@@B@B@B@@AD@B@@@B@DAB@@BB@@AB@B@@BDBB@@BB@B@@@DBB@@A@@@AD@BA@@B@BB@@B@@@@BB@D@B@BAB@B@B@@BB@@AB@@BB@@@@BBBBBB@B@@BB@@@@@B@@B@@DB@@@@B@@B@@B@@@BB@@B@BB@@B@@@@@BBB@B@@@BB@@@@B@@@@@@A@BB@@@@AB@@@@@B@@@B@BBB@@@@@@BBBBA@B@@B@@@@@B@@BB@@@BB@@B@@BB@@@@BB@@@B@@@BBB@@A@B@A@@B@@@@@BB@@@A@@B@@BBAB@@@BB@ABB@@@@B@@@@@@@B@B@B@@@@@B@@@B@@BB@@B@BBBBB@@@@@B@@DBB@@@B@@@B@@@B@@@B@B@@@@AB@B@@@BAB@@@BA@@@@BA@@@BB@@@BAB@@@B@B@@@B@BB@@BBBBB@@@BBB@@AB@B@@@@@B@@@@@@ADBB@B@@AB@@@BB@@@AB@@@B@@@B@@@BA@@BA@@@@BA@CB@@@B@@@B@@@B@@@@@BA@@B@@@BA@@@@B@@AB@@AB@@ADAB@@ABAB@@AB@@CBAB@@@@@H@B@BA@@BAB@@@@@B@@@@A@@B@@AB@@@B@@@@B@AB@@@@@B@@@B@@BB@@@@@B@B@B@@@@@BA@@B@BAD@@B@@B@@@@@B@@@B@@BB@B@@@B@@@D@@AD@@@@@BAB@@AB@B@BA@AB@@@BABA@C@ABABA@@B@@@BA@@BAB@@@B@@ABABCBAB@@ABADBBA@@BAB@BABA@ABABABABAB@@CB@B@@AB@B@B@@@BA@@BAB@B@B@@@BA@@@@B@@@BA@@@DDJFDBHDBBDDDBHFLHPL@@A¦@F@X@`@fBA@ĊBr@v@f@p@h@|@T@\\@¦@\\@@n@À@\\@PT@ٰ@@ūCAAAAAA@AAAAGCCA@AAACAAAACA@@E@AA@AAA@@A@CAACAEGAAE@@A@A@E@CAG@AB@BABC@A@EAA@AAAA@AAAACEAA@A@ADC@ABA@A@AAA@ACACAA@AAAA@CACAAEE@@AABA@@AEUYEGOQ[c]cMQisOS@AY_YcMQY_GIQWBCFEBADEFGDCDCHIDELS@@@A@ABADCBABABEBGBEBADCFI@ABCBCDCHI@ABC@@DEDCBCBC@@BA@CDIBC@@@CDE@A@A@@DCBE@ADABCB@@A@@DC@@DAD@HABAB@@AJCFEHCBA@AFC@@DCDADAB@DABCDADCDABABABA@AFABEDCFEB@BC@C@AAACEAAEGIKQQEEKGKICA@EAEAIAGAG@CAC@CAECICG@CAOAQAGCIAIACAEBSAIBMBIAI@MBGBIAC@I@CBC@EBE@CAEAI@YAEAQ@G@ABA@@@@AA@ABA@@AC@C@@@A@@FABAB@BA@CBA@AAEAC@A@A@A@C@CDAJIDCLGFEJIFCB@BEBABADCMKuCQAQIiAEEWMmIYOq@CAA@A@AJIBE@A@CBC@EDC@AAG@ABC@@@A@AEBE@aD@@K@AB@@OAGAIAAA@A@A@AEAIAKCA@SAC@A@CAAA@@EAA@AAAA@A@@BA@AAA@@@@@A@@@@AA@ABAA@@A@AA@@A@@AA@A@@@A@AA@@AA@@@AA@@@AA@@AA@@AA@@@@AAA@@@AA@AA@@@AAA@E@AACAK@KAK@EB@@A@@A@@A@@@@@@@@@A@@@@@AA@@@@@@A@@@@@AA@B@AA@@@@@C@@@A@@@A@@@A@A@A@@A@BAA@@AAA@@BA@A@AA@@AAAAA@@@A@@@AA@@E@@A@@A@@@A@@@A@@@A@@@@@A@@AABAA@@@@AA@BAA@B@@AA@@AAAAA@@@ABA@@@A@AB@@@AA@@BA@@B@@ABAB@@AB@@A@ABA@C@A@@AAB@AA@@@@@AAA@A@A@@A@@A@A@@AA@@@ABABC@AB@BCBA@@@@@A@@@AB@@C@@@A@@BA@@A@@A@A@@@@@ABA@@@@@@@A@@@C@A@AAC@@BG@GAE@CA@@A@@BA@@A@@A@@@@@@@@@A@@A@@A@@@A@@@A@@@A@@@@BAA@@A@A@@AA@A@AAC@AACKSAAACC@CGCEEEA@GGAEAA@AEGGGACCGAAACACCG@C"],encodeOffsets:[[91380,44780]]}},{type:"Feature",id:"652325",properties:{name:"奇台县",cp:[89.593967,44.022066],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@BA@AB@B@@A@@B@@AAA@@@A@AAABA@A@A@AA@@A@A@A@A@A@C@@BA@A@A@@@@@A@@@AA@A@@AA@@@A@A@AAA@AAAA@AA@@@@A@@@AAEAIAACAEACAAEAEBEAECCCGCI@ID@@A@A@A@B@@B@@@BBB@BAF@BBD@DBBBBB@@@B@B@@DFDBF@BBB@B@FBBFBFBBBDJBDFFDB@D@B@@CD@BBDBDAB@DBF@F@JBDBBBDBF@DBHBB@@DLBFCPADAD@D@BPLDD@@DD@BBD@DCBEDAD@JADAD@BA@CDAB@B@FBF@@@@@BABCF@BA@ADBB@DBD@B@@@@@DBDBBABAD@F@D@TCJAHAD@BAH@FBFBB@BAD@DDHBDBDBBDHBDHHFH@BBBBFHHB@FFDFDHD@BDBBLTBD@BBD@B@BBB@@@B@BB@AB@@@@@B@@@B@@@B@@@BB@@@@B@@@@@@@@@BB@@@AB@@@BB@@DBF@HAH@@BD@B@B@D@@@B@@@@@@AB@B@@@@@B@BB@@@AB@@@B@@@DA@@B@@@B@@@@ABADA@@BADAB@B@@BB@@@B@BB@@@@B@BBB@B@@@@BBA@BB@@@B@DAB@B@BA@@BA@AB@BA@@@AB@@BB@@A@@B@B@@AB@B@@BBBB@BB@@BA@B@ABB@@B@@B@ABBB@@@B@@@@@B@@@B@@@B@@@BB@@@@FB@@B@@@B@@BBBB@BB@@B@BAB@@BB@BB@ABB@@@@B@B@B@@@B@@@B@@@D@@@@BBA@B@@B@@@@@B@@@@B@@B@@@@@B@@@@@@@@@BB@@@@BA@@FBL@LBLBD@B@FBB@B@@BBB@@B@@BB@B@@B@@BB@@BB@@B@@BB@@B@@BB@@B@B@@@BBB@@@BB@@B@BB@AB@BBB@@@@@B@@@@BB@BAB@@@BBBBBB@FB@@BBDBB@D@TBB@LDJBFB@B@B@BBBJBHBPB@@BAL@@@bCF@FA@B@B@@AD@BBH@BCD@FAD@D@BAFIJ@B@BBB@DPrJZNnFXBFJjBRDRLvNCDABABAFA@EDIJEFKHCDIJCB@D@D@B@B@BBDBF@BAB@DABA@ABEB@@@B@@@DBD@@AB@BBB@@@@AB@B@HBRBF@ZBJBF@DAF@FAD@D@JBDAJAH@NBJAJANBJATBFBDBJDJBHBRBP@DDHDJBF@DBD@DBHBHBJBF@FDBLJLHFFRRJLFHBBDFBB@B@DADA@EFCDAFEB@BABABABCBCDCBADCBA@CBCBCD@@ED@BABGDEFID@BA@ABGBC@CB@@CD@@@BA@ADCB@BAFCD@@@B@BCF@D@@ADCJ@DAB@@ADADCDCF@@AD@BGJCDADAD@BEJCDABAFAHAFABABCDAB@B@B@@KTCFGJCDCDEHCFABEFADRXHJZ`NRZdZ`@BPTjtNR^d\\dPRFHVZBF@@ABBB@@FFBBBD@DBBBBB@DBDB@BBB@B@BAB@BCD@B@BBBDFBBBBB@BB@BBB@F@BADABA@@BBH@D@F@B@BF@BBFHDBBB@D@BB@BBB@@B@FB@BDBBDBBB@BDBHDBBBBB@BBBBDB@Ŭǀ@N@@@Ä@F@ǖ@Ǌ@@@BBB@@B@@ABEF@BAB@B@@BD@@@B@@BBAD@B@B@BBB@@DBD@B@@B@@ABCDC@@B@@@B@@BB@B@@A@IFAB@B@B@BAF@DABAD@@BD@DAFAFAFAB@BCDAB@B@D@@FFHFBBDBBDJJDDBBDBFBDBFDBB@DBBAHBD@B@BB@DDDDDDBD@BBH@DBD@BDFBDBB@@@FDJBDDJFJDH@FBDFLHRFF@BBBFFBHBBFD@B@@BBBBBBBBHFDB@@DBBB@BDB@BFDDDDD@BDDJFFFDDNLPLDDDDDB@BDBDFB@BDHJBBB@@BDDBDBDFFBBDHDJBBBDBDDF@@BB@DB@BBA@@D@B@@BBB@@B@BAB@B@BBB@DB@BBFFBBDBDFDDBDFHJJFFHH@BB@@BFHFFFHDHBBFFFHDDLPFFHHFHDFDFDDFFBBFFFFJLFHDDBDFFHH@@BB@@@B@@B@@@@@BBBB@@@B@@@@@B@@@@@@B@@BBBDFBBBB@BBBBDHPBD@BLDBBLDXH^LJDLDjPNFZTHHDLD\\JLDPF^LHDZSL@PENINAZI^OfUPKNMBABM@a@EXqDKXkNYNY@KCcKUvLpQnUhEMwdYCItD®PXHDBfT^DXE~MzNfD\\GfFbOtQAODKLUdavERCVapCtEjCrA\\O^K\\IECCIgEKEIM]K_MkEQKeAEAECMAEI]AGA@@@CA@@CAA@@@@AA@@AA@AAAAAAAAAACAAAA@A@CA@@@@AAAA@@AAA@@@A@@B@@A@A@A@@@A@@@@@A@@@EA@@@@@AC@A@A@@@@@@@A@@@A@@@@@A@@@A@@@@@C@@@A@@AAB@A@@A@@@@@B@CA@@B@@@AA@@@@@@@AA@@@@@@@@@@@A@@@@@@A@@AA@@A@@@A@@@@@A@@@@@@A@@A@AA@@B@@@@AA@@@A@ICSKIEaQECEA[OQIUKg[KCACAAAA@EAA@EAICCAC@ICC@@@@AA@GICCCGCC@@A@@@BG@C@AAAAAACEEAAIEEAAAA@@ACC@A@@A@@@CAA@@C@@@@@ABABA@A@@@@GIGGACACIICE@@CAACGIACEE@@AACEACAAAAAACE@AA@@A@@CGCCA@EE@@@@@AA@A@@@@@@@ECAC@@AACA@@AA@@A@@@AACAC@CAAACAA@A@@@A@@AA@@@CACACAC@@@@@AAA@@@@A@@@@AAACAC@@@@ACA@@C@@@AAA@AAA@A@@AAA@@@@ACA@A@@A@@AA@@@AA@@@@@A@@@@AAA@@AA@@@@@@@AA@@@@A@@@@A@@A@AAC@AAA@@@A@ACA@@AA@A@AA@@CA@@@@@@AA@@@@@@@AA@AAA@AC@@AAACAA@AA@@@@@@@@A@@@AA@AC@@A@@A@@@@A@@AAAAAAAAA@AAA@@@@@AA@KCCACAA@CAA@A@AAA@@@@@@@@AAAAA@@@@@AA@CE@A@@@@@@@AAA@@A@A@@@@@A@@@@@A@@@A@@AA@@@A@@@A@CCAAA@CA@@@@CAAAAAA@A@AA@@A@@@@@@@A@@@@A@@A@@@A@@@A@@@@@@@AA@@AAAC@@@@A@@@AA@@AAC@AAC@A@@@AA@@C@@@@@EA@@@@A@A@CA@@A@CAAAA@@AA@@@C@@AA@A@@@CA@@A@@@@@A@@A@@@@A@@AA@@@@@CA@@@A@@@@@@A@@@@@AAA@@@AA@@ECAA@@A@AAAA@@EECCA@AA@AAAAAAAAA@@@ACC@@ECACA@AA@AAA@@CAAAA@C@C@@@A@@AA@A@AA@@A@A@@@@BA@@@@@AAA@@@A@@@A@@@@@@@A@AAAAA@@@@@A@@@A@@@AAA@@@A@@@AA@@@@A@@@@@@@ECA@@A@@A@A@@@@AA@AA@@A@@@@AA@@@@AA@@@@@CAA@AA@@@@A@AACAA@AAGC@@A@@@@A@@AA@@A@A@A@@@@@@@CA@@@@A@@@@@@@A@@@@@A@@@AAA@@@@@CAA@AA@@@@AAAA@@@@A@@A@AA@AA@@@@AA@AAA@@A@@@A@A@A@A@@@A@@@@@@@AAA@AAAB@@A@CB@@@@@AA@@@CCA@CA@@@@A@@@CA@@@@AA@@@@A@ACA@@A@@@@@@@@@@@E@@@@A@@@AA@@A@@AA@@@A@@@A@@@@@@@@@AA@@AC@A@@AAA@A@@@@@@@A@AAAAA@@@@@CAECC@AA@@@@@@AA@@A@AA@@A@AA@@A@@AA@@@@@@@AA@@@@AAAAA@@@A@AA@@@@A@@@AA@@AA@@AAC@EAA@@@A@@@@@A@AAA@@@@@@@@@@@AAAA@@@@A@@@@@A@@@CA@@CAA@A@@AC@CAA@A@@@A@@@@A@@@@A@@@@AC@@AA@AA@AA@@@@@@@AA@@@@@@A@@A@@A@@@AAAAAAA@@@@A@@@@AAAA@@@@A@@@GGAA@@@@@@A@@@@AAA@@AAA@@@@@@@@@@@AA@@@@A@@@@@@@@AA@@@@AA@AAE@@@@AA@@@A@A@E@@@EB@@A@@@A@@@C@A@@BA@@@A@AAA@C@@@@@A@@@A@A@@@@@@@A@A@@@@@@@A@@@CB@@AAA@@@A@A@@@@AA@A@@A@@A@AAAA@@@@CA@@A@@A@@A@AA@@A@AACAA@@ACAOGCAAA@@A@AAKECACACAGCECKEGCAAEAKEEAQCIACAA@E@C@A@IAAAA@C@ABCAC@A@C@C@AAEAA@E@IAGAC@CACAEAC@AAA@AAA@C@C@GAAAC@E@EBE@CAGAEACAC@C@UEGAIAICQAM@OAA@A@CAA@C@@@IECCA@ACACEEAAAA@@A@A@A@CCCACAIEUECAGCC@GAECC@AAAACAEAC@AAC@@@CA@@CACAA@@ACACA@@CEAAAA@CAAA@AAACCCACAAAACC@C@AA@KAC@E@CAM@E@I@GAC@GAC@A@AAA@AACAI@C@I@KACACAA@EAC@A@AAMCC@@@IAEAEAGAMEGC_OEAECMGMGIEA@AAAACGAAEIAA@AO[EE@AAAACCKCI@CA@@@CA@@CCCECCCE@CAAAC@@@@@ADG@A@@@A@A@A@@@A@@@@ACAA@@@@A@@@@@@A@@BEBA@@@@CC@C@@@@@@@@@@A@@@@@AA@@A@C@AAA@@@AAA@@A@A@A@@@A@@@A@@@@@AAAC@AAA@@@@AGA@@@@A@@@@AAAAAAA@CAABA@@@@@A@ABA@A@@@C@A@@@A@@AA@@@@@A@A@@AAAE@C@@@@AA@@@A@A@@@A@@@C@AACCA@AAAAACA@AAAAA@@CI@AA@@AAAAC@C@CAC@AAAX_RUDCPSZaDE@WHE@@DGFCNSFKCCBCKGEEAA@AAAEAACGECCCCAAAACACCAAAAAAAAAA@@CCECAAECAC@@AAAACAKKCAGGBAAABA@A@A@@BA@@@AA@@AB@@@@ACA@@@A@@@AAC@AB@AABA@@B@A@AC@@AABA@A@ABAA@BA@@@A@ABA@A@@@A@ABA@A@@@A@CB@BA@@@@@AAC@@AA@A@@@C@@@A@@AE@A@AAA@A@AB@@ABADG@A@A@@@A@A@@@A@A@A@@@C@@AC@A@@@@BA@A@@AC@A@@@AB@@C@A@A@@BA@A@AA@@AAAA@AA@@@AA@@AC@A@ACA@@@@A@AB@@AB@BA@ADA@A@@@AAA@ACAA@A@AAA@@AC@CAC@AA@@AA@@@A@@@ABA@A@E@@@@@A@AA@C@@AAAAA@@BE@@BA@A@A@A@@@@DBDB@@BA@@@C@@@A@@@A@A@@@AB@@C@@@A@@@@AEBAA@A@AA@@AA@AA@C@@@A@@AAA@@CAAAAA@@@AB@@@@@@AB@@AB@@A@@@@A@@@A@@AAA@@BA@A@@BE@@@A@A@A@@@AA@A@@AA@@A@@@@A@@A@@A@BAA@@A@@CAAAGAGEAA@ACCKCA@@@@EAADE@E@CAEACAECACC@GDMACAAEEAICIBABC@A@A@A@E@@@AA@@AAAA@C@@@AAA@@@@AA@@BA@A@AA@@AA@A@AA@@AA@A@@@AE@@@CGG@ACCAAAAACCCCEBAAA@@A@@A@@@A@A@@A@@A@@AA@@AAAAA@CA@@C@A@@@CAA@AA@@@@B@@@BA@@@A@A@@@@C@AAAA@@@AAAB@@@@@@A@@AA@@@AA@@A@@BAA@@AA@@A@@@A@@@AC@@@CC@@@A@@@C@@@A@A@A@@BA@@BA@AA@@AD@@@@@@@AA@A@A@@BA@ABAB@@A@@AA@A@A@AA@@ABC@CBGDKBIDGBEB@@@ACBKAI@CBG@CAC@ABAAGAAAAACCABA@@AA@CAA@@AA@@@AFE@@@G@@BAAC@C@ABAB@DABADA@@@@AACAAA@@@AAA@@AA@@BABA@A@A@@BA@@@@CAAA@A@ADABA@@DAFA@@BBB@BBF@@@B@@@@AB@B@B@BABABAB@@AB@@@BAB@@@@A@@@C@@BABAB@B@B@BA@@@A@AB@DABA@A@@@A@@A@G@A@A@AA@AA@ACC@@A@@@@DCBA@@BCBAF@@ADADABADA@@BAD@FFD@@C@CACBCFABABEDCHEBGBEBAAAB@B@FA@@@B@BB@@ABGBC@A@E@@@A@EDEBABA@A@A@ABAAADAB@@A@AA@@A@@A@@CB@BABAB@BAB@BA@@@@@A@@A@A@@A@@@C@@A@@A@@@@@A@@AA@ABABABABA@A@@@@@A@AA@@@@@@AAA@@@@@A@@@A@A@@@@@@BAB@@A@@@A@CAA@@AA@@A@C@C@@@A@@@A@@@AAA@A@@@C@@@A@A@@@A@@AA@A@@@A@@@@@A@@@A@@@@@A@@AA@A@@@A@AA@@ABA@@@AB@@@@A@@@@@A@A@@@@@@@A@@@ABA@@@A@@@@@A@@@@@A@@@@@@@@@A@@@@@@@A@A@@BA@@@AB@@@@A@AA@@A@A@@B@@A@@@A@@@@B@@A@@@@@@@AA@@A@@@@AA@@@@AA@@@A@A@@@A@@@@A@@@@@@B@@A@@B@@@@@B@@@@AB@@@@A@@@@AA@@@@@@@@B@@@@@@@B@@@@@BA@@@@@A@@@@@A@A@@@@@A@A@@@@@AB@@@@@@A@@@@@A@@@AA@@A@@@@@@A@@@@@@@AA@@@@IEKEKCIEKC@A@EAE@@@@A@@@A@A@@@A@@@A@@@@@A@@@A@A@@@A@@@@@EAA@A@A@A@@B@@A@@B@@A@@B@@@BA@@@A@@@ABA@@BA@@@ABA@A@@BA@@@AB@@A@@BA@@@@@@@A@@@@@ABA@ABEB@@AB@@A@@@@@A@@@A@@@A@A@@@@@A@AB@@A@A@@@GBIBAA@AA@@@A@@BA@@@A@@@@@C@@@CAG@A@E@A@A@ABA@@@A@@@A@A@@@A@A@@@A@@@A@@@A@@@A@AA@@@@A@@@@@@@@A@@A@@A@@@AA@@AA@@@@A@@A@@@@@A@@@@AA@A@@@@@A@@@A@@@@@@@@@A@@@@@@@A@@@@B@@A@@A@@A@@B@@@@@@@@@@A@@@@@A@@@@@@@@@A@@@@@A@@@@@@BA@A@@B@@A@@@@BA@A@@@@@A@@@A@@A@@A@@@@@A@@@@AA@@@A@@@@@@A@@@@@@@A@@@@B@@A@@A@@@A@AAA@@@A@@@A@@@@@@@A@@@@@A@@@@@A@@@A@A@@@A@AB@@A@@BA@@AA@@@@@A@@AA@A@@AA@@@A@@@@@A@@@A@@BA@@@@B@@B@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@A@A@A@A@@@A@@@@@A@@@@@@@@B@@@@@@@@@@A@@B@@A@@@@@A@@@@@@B@@@@@AA@@@@@@@AA@@@@@@A@@A@@@@A@@@@@@@A@@@AB@@@@@@@@@@A@@A@@@@@@A@@@@A@@A@@@A@@A@@AA@@AA@@AA@@AA@@@@A@@B@@A@A@@BA@@@AB@@A@@BA@@BA@AB@@A@@B@@AB@@A@@@A@@@A@@@A@A@@BA@@@A@@A@@@@@@AA@@@@@@BA@@BC@EACCEEEAEEC@CBE@A@@A@@A@@A@A@@A@@AA@@@@AA@@@A@@@A@@B@@AB@@@@@@@BCBCCC@AEAEBC@@@@@AB@@@@@@@@@@AA@@@@@@@A@@@@A@@A@@@A@@@A@@@AA@@@@@AAA@GBEBC@CA@CCCC@AB@@A@@@A@@@@BA@A@@@@@ABB@A@@@@B@@AB@@@@EFEBEB@@@@AAA@@@A@C@AB@@AA@@A@A@A@@@A@@@A@@BA@@@@AA@A@@@@@A@@@@@@@@A@@@@@@@@@@@A@@@A@@BA@@@AB@@@@@@@@@@@@A@@@@A@@@A@@AA@@@A@@@@A@@A@@AA@@@@@@@AA@@@AA@@AAA@@@AA@@@A@@@@@A@A@@BA@AB@@@@@@A@@@@@A@@@A@A@A@A@@@A@@@A@@@ABA@A@A@@@@@A@A@@@A@A@@@A@AB@@A@@@@@@B@@@B@@@D@@@@@@@BBB@B@@@BB@@B@@@@@@@@@@@@A@@@AA@@A@A@A@@@@@@AA@@@@AA@A@A@A@A@@@A@@@@@AB@@AB@@@@@@A@A@A@C@@@@BA@@@A@A@@@@@A@ABA@@@A@AB@@A@AB@@A@@@A@@@A@@BA@@@A@@@A@A@@@A@A@A@A@A@A@A@A@@@@@A@@B@@@@A@@@A@@@@@A@@@A@@@@@A@C@@@@@@@A@A@@@A@@@A@@@@@A@@@A@@@A@@@@@@@@@@@A@@@A@A@A@A@A@@@A@@@@AA@@@A@A@@@A@@@C@CBC@A@A@ABA@A@A@AB@BA@@BA@@@@@@@A@@B@@A@@@@@@@A@@@A@@@AB@@@@A@@@@@A@@@A@A@@@A@@@A@@@AB@@A@A@@@CAAAA@A@C@AAA@A@A@@@AB@@@@AB@BA@AB@@ABA@@BAB@@@@AB@@AB@@ABA@A@@@A@@BA@@@A@@@A@A@CAA@@@AB@@AB@@@B@@A@@@@@CB@@A@@@ABA@AB@@ABA@@B@@A@A@@@@@A@A@A@A@@@A@@@A@@@@@A@@@A@@@A@@@A@@@@@A@@@AA@@A@@@A@@BA@@@A@@BA@@@A@AB@@A@@BA@A@AB@@A@A@@@A@@@@@ABA@@@@@@@A@@@@@@@A@@@@@A@@A@@A@A@A@@BA@@@A@@AAAA@@AAA@@AA@@@AA@@@A@@@@@A@AAA@@@A@A@@@A@@@A@A@A@ABA@C@ABA@AAA@CA@@@@A@@@A@@@A@A@A@A@@@A@A@@@A@@@@@A@AB@@A@@@A@A@@@A@ABA@@@AAA@C@EAA@@@@@A@@BAB@B@@ABA@@B@@A@@@ABA@A@@BA@@@@@@@A@A@A@C@@@CA@@CBA@A@@@@@ABA@A@@@A@A@A@A@AA@@AA@@AA@AAA@AA@A@@@@@A@@@A@CA@@AB@@@@@BA@@@@BA@@B@@AD@@@@@B@@@B@@AB@@@B@@@@@@@@A@@@A@AB@@A@@@ABA@@@A@ABA@@@A@@@A@@@ABA@AB@@AB@@@B@@@@@B@@@@@@@@@B@@@@@BA@@B@@@@@@@@@B@@@@AB@@@@@BA@@B@@@B@@AB@@BB@B@@@B@@@@@@@@@B@@A@@B@B@@@B@@@B@@@B@@@B@@@@B@@@@@B@@@@B@B@@@@AB@B@BAB@@@@AB@@A@@@A@@@@@A@@BA@A@@@A@A@A@@@AA@BA@A@A@ABC@@@A@@BA@@@@@A@@@A@A@A@@@A@A@A@AB@@A@A@ABA@@BA@@@A@@@A@@@CA@@A@A@ABC@@@A@@@AB@@@@A@@@AB@@A@A@A@@@ABA@A@AB@@@@@BB@@@@BBB@B@@@B@@@B@B@@@@@@AB@B@@@@AB@@@@@BA@@B@@@@AB@B@@@B@B@@@@ABBB@@AB@@@BABAB@@ABA@ABA@AB@@@@ABA@@@@BBB@@@@@B@@@B@@@@AB@B@@@B@B@@@@@B@B@@AB@@@@ABA@@@A@A@E@C@ABA@A@A@@@AB@BA@A@ABABA@AB@@ABA@AB@@ABA@ABA@A@@BA@A@"],encodeOffsets:[[91427,44595]]}},{type:"Feature",id:"652328",properties:{name:"木垒哈萨克自治县",cp:[90.286028,43.834689],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@BA@CAA@A@C@C@AAC@A@CAC@A@A@C@A@ABA@A@A@@@A@A@AAA@A@A@A@A@C@A@@AA@A@@A@@@A@@@@@AA@AA@@AC@AA@@@A@@@A@A@ABABABA@@BA@ABA@ABA@ABABA@A@A@A@A@@@AB@BA@AB@BAB@@@@A@ABA@ABA@A@@@C@A@A@A@A@A@@@A@@@@BA@@BA@@BAB@@C@CB@BAB@@A@@BA@@BAB@@ABABA@@BA@A@E@A@A@A@ABA@ABA@@BA@@BA@A@C@C@@@@@A@A@A@@@AB@@AB@@A@AB@@AB@@@@@@A@@@CA@@A@@@A@@@A@A@AB@@@@A@A@A@@@AACBA@@@A@A@C@A@A@@@C@C@@@AAA@AAAAACA@@@AAA@A@A@C@@@A@ABA@A@A@A@ABCBADA@@BA@CB@@A@A@@@C@A@A@A@ABC@A@A@A@ABA@@@@B@@@BABA@@BAB@BAD@BABC@@BA@A@@@CAA@EAC@@@CAA@AACAAA@AAAA@CAA@A@A@@@A@@@AAAB@@A@A@A@A@A@A@AB@@A@A@@@A@@@A@A@CAAAC@@AA@@@AA@AAAAA@@A@AAC@@@A@A@AAA@@@AA@CAA@A@AA@@A@@@A@A@@@@@@@AA@A@ABA@CBA@ABABAB@BA@@BA@@@A@A@CACAA@CAAA@@A@AAA@A@A@A@@AA@@@@@A@C@CBA@A@@@@A@@@AB@@AA@AAAAAA@AA@@A@@A@@@AAA@A@@@A@A@ABA@@@A@@@@@AAA@CA@@A@C@@@A@A@A@@@AB@@ADABADAB@@@BA@BD@B@@AB@@A@@@@@A@@@@@AA@@AAA@@AA@@AA@@@C@CAA@@@A@@@@@A@@AAAAAAAAAA@@@A@A@@@A@@@A@@@ABA@@@@B@@@@AB@@@@A@@@A@A@A@A@AAA@A@@@A@A@C@A@@@A@A@@B@BA@CBBBAB@B@B@BABABCF@F@B@@@F@BADAH@BA@@A@A@@EBA@A@BBABAFAHGFCDAFABEBADBD@D@DC@EEC@AB@@CBABCBCB@BE@ABAD@@ABCD@@@@@BD@BDB@@BBBB@B@H@B@@@@B@@@BABCBA@@B@B@@ABA@A@A@ABAB@@@D@@@B@@A@AB@@A@@BA@ABABABA@A@A@@B@@A@@@E@AAA@AA@@EBCB@@ABCB@B@BBBDB@@@@AB@@@B@BABAB@@BB@@BB@B@@BBDBBB@@@@CBABCBA@AB@B@DBDAB@@@H@@EF@B@@BB@@BB@DBB@@ABDBBDBBBBBHAB@BBD@DAH@DBJALBD@@A@AFCHAJCLAH@DAD@BB@@B@B@BBB@@@BA@AB@BAB@@@B@BBB@@@@@@C@@BB@@BAB@@AB@@@B@B@B@@@D@@@B@@DD@@D@@B@@@B@@@BB@@BB@AB@@@BB@@B@@BB@@@B@@@@A@BB@B@@BBBBD@@@@@@B@B@@AB@@A@@@@@BBB@DB@@B@D@@@DBB@BBBB@@BB@@@BB@@@@B@B@@@BB@@@BBABDFDDBDBBBBDD@BHH@D@@BF@@B@B@@BB@@B@BBB@@BBB@B@@AB@@B@@B@BB@@D@B@BB@BB@@B@@@F@B@B@BADABDJBJFFBBBDCN@HDDDBBFBDBF@D@FCFBB@F@@B@LDDD@BBBHFHBBBDB@@@BB@ABB@@@@BB@@@@@@BB@@BB@B@@B@@@B@B@B@@AF@@@BAB@@BB@BB@@@B@@@@@@BA@@BA@@B@@@@A@@B@@BBBBDB@@BB@BB@@@D@B@@BBB@@BBB@B@ABBF@@@@@B@@@DA@@B@@@B@B@@@B@@@D@@AB@@CACA@@@@@B@B@BAB@@AF@@BBBB@BD@B@@B@B@@@@@F@BAB@B@@@B@@BB@@BBD@DBD@@BB@BBB@B@DB@BBB@B@@@BCB@BABA@@BA@@B@B@@B@BDB@D@@BB@@B@@BBB@BB@BB@@B@BAB@@@B@B@DA@@B@@@BBD@@@BAB@@@@@BBD@@@D@@@B@B@B@@@B@B@@@B@BCHAB@BA@@B@BBB@B@BBF@@@B@@@D@@@BBB@@BD@B@@@@ABA@@D@B@@@BAB@B@B@@@BAB@B@B@@ABB@AB@B@BABBB@@BDB@A@@@ABBBA@@BBD@B@@@B@@DB@B@@A@@BB@@B@@AB@@@B@BABBBABHHDBLLDBBBBB@@BDFDBBFDDD@@BBBBBBBBBBDDDBBBBBDDDDHFBDFBBB@BBBFFLHADDDELMTEDCH@@GF@XCFYbOTCDQVW`BB@BBD@D@DBDBB@BB@@BDJ@@BBBB@BDBBBBB@BDBBD@B@D@@@B@@@B@B@@BB@@@@@DBFBB@@@B@B@@@@BB@@@B@@@B@D@@@BAB@B@B@@@@ABBB@DBBBBBB@B@@B@@@@@HB@B@@B@BBD@BB@B@@@@@B@@@B@@@B@B@BB@BB@@B@BBD@B@@@BB@@@@B@@@@@@@@@@@@DDD@@@@ABAF@@@B@@@@B@@@@@BBBD@@@@@B@@@B@B@B@@@BCH@B@@@@BDBB@DDFDDDFDD@@DB@@B@@DDJDLBDBB@BFFP\\@BBBFJBBDHBBBBB@JFNHNHFDFB`PHDNFHBFBFBJB@@D@NDBBB@D@FBB@DBDBLBJ@D@J@DBBBB@BBB@D@HBD@HBJ@F@N@DBF@D@LBB@@B@DDDBBBBBDDDBDBBB@BB@DBBBBDF@@DBDB@BB@DBDB@@DB@@D@BBD@FBDBBBBBD@FDHBD@HDDBVFJFDBDBDDB@B@B@@@BBBBFFBDBDB@DDJF@@D@B@DBB@B@PBN@RBJDJBHBVFD@D@DBFBHBDBF@FAF@D@BBHBD@D@B@BBB@BBD@FBDBDBD@HBJBF@B@FBBBD@D@B@D@DBBAD@B@BBJBB@D@F@B@DBJBRDFBLFFBBBHDLFFDHDDBDBDBLFBBB@@@BBDBPHDB@BB@DBBBB@@@BBB@@@@BB@@@DB@@@@BBBBB@@@@BB@B@@B@@B@B@@@B@BB@@DA@@B@@@@@@@B@B@@@@@@@B@B@@@B@@@@@D@B@BBB@@@B@@AB@D@@@B@@@B@@@FA@@F@B@B@@@B@@B@@F@BBB@@B@@B@@B@@@@@@B@@@@@BB@@@@@@@@@@B@BB@@BB@B@@B@@@@@@@BBHH@@B@@@@@BBBB@@@@@B@@B@BBBBBB@@B@@@@BB@@@@@@@BB@@@@@@B@@BBBB@@BD@@B@@B@@@@@@B@@B@@@B@B@DBD@@BB@B@DB@@DB@@B@@@@@B@@@@@BBBB@@@@@@@@@@B@BBB@@@@@B@@@B@FBD@BB@@BB@@BB@@B@@@@@BBB@@@B@BBBB@@@@BB@@@@@@B@@BB@@@BBB@@@BBB@@@BB@@@@@@BBD@FDDB@@@@B@BBBBB@@@@@@@B@B@BB@@@BBD@@BB@@@@@@@@B@@@B@@@B@@BB@@@BB@@B@@@@@@F@@@@@@@@@@@BB@BDB@@@@@BB@@@@DB@@B@@@@@DBB@DD@@B@@B@@@@DAB@@@BABBB@BB@@@@@@B@@@B@B@B@B@@@B@@@BB@BBB@@@@BBB@@B@BB@@@@@BBBB@@@@BBB@DB@@@@B@BB@@B@@@@@B@@@@@@@B@@@@@DB@@@@@@B@B@B@@@BB@@@B@@B@@@HDBBB@DBBBB@@@@@BBB@DB@@@@B@@B@@B@@B@@B@@@BBB@@B@@B@B@@@@BB@FD@@@@@@B@@@@@BB@@B@@@B@BB@@B@@@B@@@@@B@BBBBB@@@@@@@B@@@B@@@B@BB@@@@B@@A@@B@B@@@BBB@B@@BB@@@D@D@B@BBDB@@BB@BBBB@BDFD@@DD@B@@BBBBBBBB@BBBB@DDFF@@BBBBB@@@BBFD@@BB@@B@BB@@@@B@@@@@@@@B@@DB@@@@B@@BB@@@@@@BB@@@@@B@@@DB@@B@B@@BD@@@B@@BB@BBDBB@@@DBB@B@@@@@FB@@@@D@@@BB@@B@D@BBD@BB@@BB@@B@@@@@BDBB@@BB@@@@@@B@@@B@@@B@@@@B@@B@@@@@@@B@@@BBB@B@BBBBDB@@@@DBB@BBDDB@@@B@@@B@@BB@@@B@@@@@B@@@@@B@B@@@BB@B@@@@@@@BDFB@@B@@@@BBBB@B@@@@@@B@BBB@B@DBB@DBDBLDB@@B@@@@BB@BBBBBBBBB@BB@@@@@@BB@@@BDB@@B@@@B@@@@@@B@@BBBBDBB@@BDB@BBB@@B@@@@@@BB@@@@@@DB@@BBB@B@@BB@BDB@@@B@BBD@BBB@@@@B@@B@@@@@BB@@@@@@B@@BB@BB@@@@@B@@@@BB@@B@@BB@@@@BDB@B@@B@BB@@@BBB@BBB@B@@@DB@BD@@@@BDBDBB@@@@@B@@B@BB@@@@D@DBDBDB@@B@@BB@@@B@B@DBBBDBD@DBBB@@B@@@BB@@DBBB@@BDFD@@@@@@B@B@@B@@@@FFB@DDDH@@@BB@@BDFBBBBBBBDDFBB@@FFBDHJBDDB@@DFJJBDBDHHHJ@@@@@BABAB@B@@@@@DB@DB@@B@@@@BDD@BB@BBFBJFBBFFBDBBBB@B@DAH@@B@@@DDDHDDHJB@@B@@D@JDD@DBJDFBB@FBB@BBDBDB\\LhVLRJ\\PFBFDbRJFTLJDB@@@B@@B@@A@@@BBB@@@@B@@@@B@@@@@B@@@B@@@BB@@@B@@@@B@@@@@@@@@@@B@@B@@@@@@BB@@A@@@DBA@@@@@B@@@@BBA@BB@@@D@@@@@B@@@B@@@@@B@@@B@@@@@@@B@B@D@@B@@@@FB@@B@@@@@B@@@B@B@B@@@@AB@@@B@BB@@BBBB@@@@DBB@B@BBDBBBBBBBBBBBB@@BB@@B@@B@DB@@DB@@B@BHJ^BFDNBFBFLfFRNlL`N^FJFLhDJFDZHC\\t``Zh@VQZU`WZkPGVEIAECCAAAACAC@CAA@A@CAA@ABA@C@E@E@GAC@C@G@A@E@AAA@E@E@E@AAE@E@C@CAC@A@A@@D@@ADAFAJEDCB@BCBABC@CBEBCBABCBA@@@C@A@@BABADA@@HKFGB@DEFEBCBABCBA@@AAAA@@CA@@A@@ABCBE@@@CBCDC@AB@@A@CBA@AB@BADCDCDCBA@ABCBA@@@C@EAC@C@I@E@A@C@A@C@@BA@A@@@G@A@A@A@CAA@A@@@ABC@ABABC@ABADADABABAB@@ABABA@A@A@ACC@AAAACCCEE@@CEAAAC@A@A@A@AB@@A@A@C@C@@AAAACEGGCEEEAAAA@@@@A@A@A@@BCB@@ABAB@@@@C@C@A@@BA@@AC@GCGCOG@@@@@@BC@@@@@CAA@AAAAA@@AA@@A@A@A@AA@@CA@@A@@@@@A@EA@A@@OKKGia_YUOMKi_ECACA@AAACACAAKOKOCEAA@@BC@@@ABA@AB@@A@CBA@@@C@A@A@A@AAA@@@A@@@@@C@EBE@A@C@@BA@ABABA@A@@B@BA@@BC@A@A@@@AB@@@BA@@@@@@AAAA@@@ABCAA@@ACAA@@@@@AB@@@AA@A@@@A@A@A@ABA@ABA@ABCBA@C@A@CBA@A@@@@BC@C@ABC@A@A@CAA@@@A@ABA@C@I@E@K@GBI@C@A@C@@AAAA@ACEEIKSGOGOGKCCAGCEAACGEIEKEGKCMCAMAMAU@I@K@O@K@@AI@K@UAG@IIUCGGESKMKIEGEIEESG_GYCQCOAEFIHQN_HMDG@@HQHQLWJSHQFO@@BC@I@E@K@Q@O@]Bk@M@k@KBEAK@@Aa@QAG@E@CAEAI@AAIAK@G@CAIAGAKAI@GAIAMCUAO@IAECMI_GUAI@@@@DCJEDBB@B@@@@@B@@@BAFC@@@A@@BAB@B@B@BA@@B@BC@@BA@@BAB@@@DA@@B@@@BC@@@A@A@AB@BCBABA@@@@A@@@AAA@A@@A@@@@B@BA@@B@@@B@BA@A@@@@@A@@@@B@B@@AB@D@@AB@@A@@B@@@@@B@@@@@@AB@BC@@BA@@@@A@@A@@@@@ABAB@B@@@B@B@@@B@@@@A@AB@B@B@@ABAB@@@BA@A@AB@@A@AB@@A@ABCBABAB@@@B@@@B@BABADAB@BAB@@@B@B@@@D@DAB@@A@AB@@AB@@@B@@A@@@A@@@@BABAB@B@@A@@@@@@@AA@B@@AD@@A@A@@B@BAB@BAFABAB@@@B@B@B@@@@@@@B@@@@AB@@@BAB@@ABA@@@@@A@@B@BBB@B@B@DABABAB@@@@A@@@A@@BA@A@@@A@@@A@@@@DA@@BAB@@@B@@@@@BAB@BA@ABABAB@@AB@@ABAB@@ABABA@@@@B@BA@@B@@AB@B@@@B@@@@A@@@A@@@A@@@@B@BAB@BAB@BA@@B@B@@@B@@@BAB@@@B@@@B@@@B@@@@@@@@@@A@@@@@A@@@@BAB@BAB@@AB@@A@@B@@@B@@AB@@@BAD@D@BCB@@AB@@AB@B@BABABA@@DADALEDABAB@BAB@@@DAB@DABABAB@@@@@AC@@@@@A@@B@@@B@@@B@B@D@B@B@B@B@B@B@@@B@@A@@@A@@@A@@@A@@@A@@@@B@BAB@BAB@@@@@@AA@@@AA@@@@@A@AAC@@@A@@@ABA@@@AB@@A@@B@@@B@@@B@@A@@BA@@@A@@@I@E@A@ABA@AFG@CBA@@@EAC@@@ABABI@@@A@@@CBA@@@ABAB@@@FIFG@AB@@@BAB@@A@@B@@CFGDG@@BCDCFIDCBC@@BA@A@@@A@A@@@@BABAPIBAB@@@@A@@@A@@@AACAA@A@A@@@E@A@ABABC@@@A@@@E@C@C@@A@@AA@A@AA@@A@AAA@A@@@AA@@@A@@@A@@@@AAA@AAA@CAA@A@@@@@@@@@@A@@@@BA@@AAAAA@AA@@A@@@A@A@@@@AA@@AA@AAC@A@AAA@A@@AA@A@@@A@@@A@A@@@@@A@@@@@A@@@@@@@@@@@@@A@A@AAA@A@EA@@@@@@A@@A@@@@A@@@@@@@@@@@@@A@@@CB@@A@@@@@CBA@C@A@C@@@EAA@A@ABC@A@A@A@@@A@@@@@A@@AC@AAA@A@A@A@@@A@@@AAA@@@C@AAA@A@@@C@CAA@CA@@A@@@A@@@A@A@@@C@A@@@AAA@AAA@@@A@A@A@@@A@A@@@A@@BA@@@A@A@AAA@AAA@@@A@@@AAA@A@@A@@C@A@@@AA@@A@C@A@A@A@@@@@@@@BA@AAA@@@A@A@A@A@A@A@A@A@@@C@C@C@C@@@AAA@A@@@A@AAA@ABCAC@@@A@@@A@@@A@@AC@@AA@AA@@A@A@A@A@@@@BA@@@C@ABA@@@A@AAA@A@@@@@A@@@@BA@C@AB@@A@@A@@A@AA@@AAAAA@@@A@@@A@A@@@@@@A@@@@AA@@A@C@A@A@@A@@@@@@D@@@DA@@B@@@B@@@BA@@BA@@B@BAB@B@BAB@@@DAB@F@D@D@B@BAB@BAB@NABAHAB@DAB@BABAB@FADAFCDABADAB@B@@@DBD@F@D@D@BAB@DAB@B@F@BAB@JEBAFA@@B@@@@@@@@@B@@@@@A@@@@@@@@@AA@@@@@@@@@@@@@@B@B@@@BABB@ABBBA@@B@@AB@B@@@H@B@@@B@@@@ABABAB@BABA@@@A@A@@@@@A@@@@@@@@B@@ABABA@@@A@@@@@@@@@@AA@@@@@@@@@A@@@@A@@@@A@@@@AA@@@@B@@@@@@@@@@@B@@AB@@@@@@@@@@A@@B@@@@@@@@@@@BB@@B@@@@@@@B@@@@@@A@@@A@@@@B@@@@@B@B@B@B@@@@@B@@@@@B@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@A@@B@@@@AB@@@B@B@@@B@@@@@@@@@@@@A@@@A@@@@@@@@@AB@@@@@B@@@@@@@@@@B@@@@@@@@B@@@@@@@B@@AB@@A@@@@@@@@@AB@@@NAD@B@B@@@B@@@DB@@@@B@@@B@B@@@@@B@BA@@B@@@B@@@DBB@B@B@@@B@@@B@B@BA@@B@HAD@BAB@B@B@B@@@@@FAB@@@BA@@@@@B@@@@B@@@@@@@@@B@@@B@@@@@@@@@@ABAB@@@@@@@@@D@@@@@@@@@A@AAAAAA@@A@@BA@A@A@@@A@@AA@A@A@C@A@C@A@A@A@A@A@A@C@A@A@@AA@A@A@C@A@AAA@@@C@AAA@A@@@A@@@A@@A@AAA@A@@A@A@@@A@AAA@@@@@A@@AA@A@@AA@@@CBA@@@A@AAA@@@AA@@@@A@@@@@A@@@@A@@@@CA@@A@@@AA@@@AA@A@@@AAAAAA@AA@@@@@@@@AA@A@A@@@A@@@A@CAA@CACAA@@@@@A@@A@@BA@C@ABC@@@AB@@A@A@@@@B@@@@AA@@@@@BA@A@@A@A@C@A@E@C@A@AAC@A@CAA@@@A@@@A@@@A@A@AAA@@@AA@@A@@AA@A@@@AAA@@AA@@@AAA@@@A@AAA@@@A@AAA@A@@@A@A@A@A@AAA@A@A@A@CAA@AAA@A@@@A@@@AA@@AAA@A@AA@@A@AAA@@AA@A@A@A@C@A@A@A@AAA@A@@@A@AAA@@@A@A@@@A@@@A@@@@@A@@@@@A@@@A@@@@AA@A@A@A@A@A@@@AB@@A@@@A@@@AAA@@@A@@@A@@BA@A@A@@@@@A@@B@@@@@@@@@@A@A@A@A@@@A@@@A@@@A@@@C@A@@@AA@BA@@@AB@@AB@BA@A@ABC@@@A@CAA@C@AAA@A@@@A@A@A@@@AAA@A@@@A@@@A@A@A@A@@@@@A@@@A@A@@@A@A@A@@@A@@@A@@@A@@@A@A@A@@@A@@@A@@@AA@@A@@@AAAB@@A@@@A@@A@@BA@@AA@@@AA@@@A@@@A@@@A@AA@@A@@@AA@@A@BA@@@A@@@@C@AAA@@@A@@@A@A@@A@@AAA@@AA@@@@A@A@@BABC@@@ABA@A@@@@@@@@@A@@@@A@@@A@@A@@A@A@@@AA@@@@@A@@BABA@@@@@A@@@A@@@@A@A@@A@@@@@C@AB@BAB@@A@@@@@@A@A@A@AAA@AA@@AAA@AA@@BA@@@AB@B@@@@@@A@@@@@@CAA@A@A@AA@@A@ABA@@@A@A@@@A@A@@AA@A@A@CB@@A@A@@@A@@@A@@@@BA@@@A@@@A@@@A@@BA@A@A@A@@@A@@@A@A@@@A@A@@@@@@@A@@A@AA@@@A@A@@BA@@@A@@@AB@@A@@AA@A@@@A@AACAA@A@AA@@@@@ABA@A@AA@@@A@@@@@A@@BA@@@@@A@@@@@A@@AA@@@A@@@A@@@AA@@AB@@A@@@A@@@@@AB@@A@@@A@@B@@AA@@A@A@A@@@@@@@AA@@@AA@AA@@A@@@@A@@@@@@AAA@@AA@@A@@AA@@AAA@@@@A@@@A@A@@@@E@A@A@@@A@@@@@AA@@A@A@A@@@@AA@@@A@@BABABAB@@A@@@A@@@A@CA@@A@A@@@A@@@@A@@ACA@A@@AC@A@C@A@A@AA@@@@A@@@@@A@A@A@A@A@A@A@A@A@A@@AA@@AA@@@@AA@@@A@@@@A@@@A@@@@A@@A@@AA@@@@A@@AA@@@@@@A@AAAB@@AB@B@@@B@@ABA@@@@@@@AA@AA@@@A@@AA@A@@@AA@BABA@@B@@A@@@@@ABABA@A@@@A@@@A@A@@@@@@AAAAA@A@A@@AA@@@A@C@A@@AA@A@A@AAA@@@AA@@A@ABC@A@C@C@C@@@AAAAAACA@AC@AAC@A@A@@AA@A@@@@@A@@BA@@@A@@@A@AAC@@@A@A@AB@@A@A@A@@@A@CBCDABABABABCDAB@@CBA@A@A@@@A@EAA@A@A@C@A@ABA@@@A@@@AAA@A@A@C@C@C@A@A@A@@@A@@@C@CA@@A@A@C@AAA@CAA@C@CAA@AA@@AB@@@@@B@BAB@B@@A@@@CBAB@BA@ABA@CBA@A@@@A@A@A@@@A@A@A@A@@@A@@@AA@@EA@@A@@@A@A@@@A@AAA@A@AAA@A@A@A@A@@@A@@@AB@@@BA@ADAB@B@B@@@@@@@@B@@@@@B@@@@B@BAB@B@BCBA@ABA@@@A@AB@@ADAB@BABA@@@A@A@A@A@A@@@@B@@@@@@A@@@A@@AAAA@A@C@C@A@A@A@AA@@A@A@A@AAA@@@AA@AA@@AA@AA@B@AAB@@AAA@@AAA@@ABAAABAA@BA@@BA@@@A@@@@@A@@@A@@@@@@BA@@@A@@@AB@@A@A@AA@@A@@@@BA@@@A@A@@@AAAA@@@A@AA@@@@@A@AC@@A@@@@@ABC@@@A@AB@@@@AB@@A@C@A@@@@BA@@@A@@@@@@@A@@@@@@B@@@@@BA@A@A@AB@@A@@B@B@B@@@@AB@@ABA@@@A@A@@B@@@BA@@@@@ABA@@@CB@B@A@@A@@@@@A@@@A@@AA@A@A@@@@@A@@@BBA@AB@@A@@@@@A@A@A@C@A@A@A@A@A@A@@@A@A@@@A@@@A@@@A@@@A@A@AB@@@@A@@@@@BB@@@@@B@@@@AB@B@@@B@@AB@@AB@BA@@@@@@B@@A@@@C@ABC@A@ABA@@@A@@@AAA@AACA@@A@@@A@A@@@A@A@A@A@AAA@A@C@@AAACAA@@@@@A@A@AB@@A@@@A@@@A@AB@@A@@@C@A@C@@AC@AA@@@A@A@@@A@@AA@@@@ABA@A@C@CBA@A@A@@@A@AA@@@@@@@A@@ABA@A@A@@@@@A@@@A@@@A@@@@A@@A@@@@BA@@@A@A@A@AAC@A@A@@@AA@@A@A@C@A@ABA@@DAB@@@@A@@@ABC@A@ABA@C@C@ABA@A@A@A@A@A@AAA@A@@@C@A@@@A@@@A@@@AB@@A@@@A@@@AA@@A@A@@@A@@@A@@AA@A@@@@AA@@@@A@@@@@AA@AAA@@ABA@@@@A@AAA@AAC@A@A@A@A@A@ABA@A@A@AA@@@@@@@AA@@@@@@@AB@@C@C@A@A@A@@BA@A@A@A@A@A@AAA@A@@@CAA@@@C@A@A@A@@@C@C@@@A@A@@@A@A@@@A@A@@@A@@@AA@@@BCBCBABA@C@@@A@@@AAAACAA@@@A@A@ABABC@CBA@ABABCBC@A@@@@@A@A@AA@AC@CAA@@@A@@A@AAA@A@@@AA@A@@@A@@@A@A@@AA@AAAAAA@@A@A@@@AA@@@@AAA@A@@@A@ABA@C@@@A@@@A@A@A@@@A@A@AAC@@@A@A@A@A@@@AAA@@ACA@@@@AAAAAAA@@AA@A@@@A@A@A@A@A@ABA@@@AAA@@AA@@@CAA@A@C@C@A@C@A@A@A@AB@@@@@@@@@@@F@@@@A@A@A@CBA@CAC@A@A@A@CBA@A@AAA@A@A@A@@BABA@@@@@A@@AA@A@@@A@AAA@EAAAC@A@@@A@A@E@C@@@@@@@AA@@@@AAA@@@A@CBA@A@A@@@@@@@A@@@A@A@A@@@A@@BA@A@@B@B@@B@@BB@@BDB@@@B@B@BABCBCDABA@@B@BAB@@A@A@A@A@ACC@CAA@A@@@EBCBA@@A@@@@@A@@@A@@@A@@@ACA@@A@A@A@A@@@A@C@CACAC@AACAA@A@@BA@A@A@@AA@@@@@A@@BA@ABA@@@@@C@@@A@A@A@C@@BA@A@ABCBABA@ABC@A@@@A@@@A@@@A@@@A@@@@@C@A@A@AAA@@@A@@@A@A@@@A@A@A@A@A@ABA@A@@BA@@B@B@B@@AB@B@@A@@@@B@@AB@@@BA@@@@B@@@@A@@@@@AAA@AAA@CAC@AAA@A@@@A@@@A@@@CAAAAAA@@@@@A@A@A@@@A@@@AB@B@@@B@D@B@@AB@@A@@B@@@@AB@@AA@@A@@BC@AB@@A@@@A@@@C@A@A@A@@@A@@@A@@@AAAAA@@AA@A@A@@AC@AAA@AAAAAAC@@AAAA@AC@@A@@AA@AA@@A@AA@@@@AA@@@@@A@@@@AA@@A@@@A@A@@@AAA@@@@@@@A@@@@@@@A@@A@@BA@@@@@@@@@AAA@@@AAA@@@@AAAA@AAA@@A@C@C@CBAB@@ABA@AB@@A@AB@@A@@B@@@@BB@B@@@B@BABADA@@BC@ABCBABA@"],encodeOffsets:[[92532,44560]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
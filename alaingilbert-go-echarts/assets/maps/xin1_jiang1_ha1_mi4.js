@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("哈密",{type:"FeatureCollection",features:[{type:"Feature",id:"650522",properties:{name:"伊吾县",cp:[94.697074,43.254978],childNum:1},geometry:{type:"Polygon",coordinates:["@@DcDQP¥LQt}VUrg{RMNIDCNKTOwZQmTQKYIQEMEMACCEAAEEIIOMIIOKSSIGCAIE@@YMAA@ABGBGBIDMBK@C@ACIOk@@HKHMHKJMHIBCFEBA@CBE@A@A@AEC@@@AAECCAAA@AAA@A@AA@@@EAC@AA@AAC@GGCAA@AAA@C@G@AAEACACACCCACACAA@A@EAG@I@GBI@EAA@GECECGGKEAKBOFGB_L_JaJaLODKDMDUJ]HQFWHIDYHYHOFKDG@Q@[@K@_@A@SB_@I@M@Q@UAQ@A@EBQ@K@i@]@]@Q@G@IBKBYBE@K@SDM@GDK@IDKBOFIBIDODQDMDGDIBEBABEFGFEFGDCBEDAB@B@@@@@B@@@@A@@@A@CBE@E@GAA@@@@BB@BBBB@@@B@@@@AB@B@@@B@@ABA@@@A@ABAB@@ABA@CBABCBGDA@CBC@I@A@CB@@A@@BABCFABABEDCBAB@BCBCDC@ABC@EBC@C@KAC@A@@BCBADADA@@BBB@BABADA@A@A@A@A@G@A@A@C@@@A@A@ABC@ABE@A@CAE@A@ABC@@@AA@@A@A@C@CBABABA@@@A@CCECEECCAC@CBACAAA@ACAC@CAM@K@G@M@A@ECICC@@@A@C@A@E@E@C@GBA@EBCBCBABABCBCDA@@BA@A@EBA@CBEB@@IBABA@E@C@A@EBA@A@A@CAA@CAA@G@EBA@AB@@A@CACAA@A@A@CBA@EDA@EBA@A@@@A@@@C@GBG@A@E@CBUBK@CBCBABA@AB@@EDCBKFQLWNOJKFGFAB@@@@@AA@@@AA@@@@AAA@AAA@A@@AC@@AA@A@@@A@AA@@A@A@A@A@A@A@A@C@@@E@A@A@A@@@A@A@@@A@A@AAA@A@@@@@A@A@@AA@A@A@@@A@AAA@A@@@A@@@AA@@A@@BA@@A@@@@A@@BA@@AC@@@A@@@E@C@A@A@@@@@C@K@G@A@EAEACAA@ABA@A@IBABC@A@@@@@AC@C@A@@@@A@I@G@A@CBA@C@A@CC@ACA@ACEACAAA@AA@@ABC@ABABA@A@@@@@K@EBAAAACA@AA@E@OAE@@@CACAA@C@ABA@A@@BAB@BA@AA@@AACAEAC@A@@@C@@@EBA@A@A@@@@@@@@@@@@@@@@BA@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@AAA@@AA@@@A@A@@BA@@@A@@@A@@@A@AB@@CDC@ADED@BA@@BA@ABA@@D@@A@A@@BC@@@@@@@@B@@@B@@@@@@A@@@@@@@AA@@A@AA@AA@AA@AAAA@AA@@A@@A@@A@@AA@@@A@A@AA@@AAA@CC@AA@@AC@@AC@AAIAE@AAAAA@@@AB@@@@@B@@@@@B@@AAA@E@C@E@A@@@CBA@A@C@A@A@A@AAA@@@A@@@A@A@A@A@@@ABC@A@A@E@A@AAC@AAA@ABA@CBEB@@@@@@A@C@ABE@A@A@ABAAA@C@A@A@ABA@@@@@A@@A@@@@A@A@C@CBA@A@@B@@AB@@@@AAA@@@@@A@@BC@A@A@A@AB@@A@@@@@AA@A@AA@C@A@AAA@A@C@AA@@@A@A@ABABC@AAA@A@A@A@C@AAAACAC@A@CA@AAAAA@@@A@CBABABCD@BA@@B@@AB@@A@A@EA@@A@C@@BA@A@ABA@A@A@@BA@AA@@CAAAA@A@CAA@A@C@CBC@@BA@ABE@@BC@A@ABA@@@ABABC@@@@@A@@@@AAACAEAAAA@AC@@AA@AB@BABA@AB@AAAAC@A@A@CBA@A@C@C@A@C@C@A@CAA@@@CBA@AB@@CB@@ABAB@B@B@BA@A@C@EBC@A@AAA@A@A@C@CB@@A@EA@@C@A@AAAAA@CCA@A@A@A@@@ABA@E@AAA@CA@@@B@@A@@B@BAB@BA@A@A@C@A@A@A@A@A@@@A@AB@B@@AB@@@B@@A@@@AAC@@@A@A@@@AB@@A@A@@@AB@B@@A@@@C@C@AAA@E@A@A@@BAB@@@BAB@BAB@BABABABC@A@AB@B@@@@AF@@@BA@@B@@BBA@@@@B@@A@@@@B@@@@ABA@@@ABABCBADA@@B@BAD@@@@@@@@A@AAA@C@A@A@A@A@CDABAB@B@@AB@@@@@@@B@@A@@B@@ABA@A@AB@BA@@BA@ABA@ABA@C@AB@AA@@AAAA@@AAAEMAAACA@CAC@G@IAK@C@ADGLCJCHABMHIFGJCDMJEBG@ICK@C@SFC@CBCBE@C@AAGAEAGCCACCECEAA@CBCBEJGD@BBNABABE@E@CBCBABEFCBC@CBCD@HADEBABKFE@E@CAC@ABCBAFCBAB@@A@G@CB@D@BBDBF@BCD@B@LBBHFFJDJBDDFDHFPABADABCDCHABIFSLULADB@@FEFQNEBCBIBE@E@EBEDAD@@AB@F@BEHCFABA@GBEDCHCDCDEBCDABAJAHA@KCKBCBCB@D@B@H@BC@M@A@CBABAB@BADCFAFCDA@C@ICC@CBEDCDAHABG@CBEDABIDE@CBCD@BCJCDQDEDMFABCAEBCB@DBDABABC@E@G@AAA@A@KCGAEAC@CBGH@BDH@BCFILIHULEHCDKFKDE@C@GFADIHKBGDELABEHMFKAA@@@ICEAIIAAMIEAKCCCICA@CECAMACAABAB@BIHGFKDE@CCEAEBQLCDEDKFCBKDIBO@KDIBIDMHIDMD[DSDUBOBPJRMDGJGBMNET§@C@@Y@@@ABCBCBE@A@AAA@CAAAEBA@C@EBA@ADEDMFKHA@C@ABA@ABABEBEHDV@L@JA^ABA@EDABCD@B@DANEp@DEjAJAVCPDFFBTLHFNBN@RAJ@DB@H@DBFFFLFNDFBHFDJDD@@BDADCBGFABBBDB^L@@BBBBBBBBB@FBB@HBFFDHD@DHBBJHHD@@HDH@LDDHBJDDD@FDBH@L@B@B@@CBGHEDEH@D@D@LHP@@HBDBFBLDB@D@D@D@N@LDFADAD@BBD@F@FAFAB@J@DALAJAVFB@DBFBBBFDB@H@DBB@BB@BBBHFDF@DBDDD@D@@D@BBBDHDFFB@@FDBDBFD@@HH@BBFHDHHDFJTJLBBHJBBPTVLTHBBZP\\NNLNFFBBBPFJBNHPJXJp^VNLLJNHHJFNFFFHJLNJPJJNFNFNNPHVHPLFDBDLRLHVDNFBBRJRHJHBB@H@@@VCNADHLNRNTV`@@V\\T\\XbX`^hBB`T\\P^RNJ\\N\\RfXXLdVRLRJJBNDdJbHTRxPNDLDVFZFFBVFlLLDdJRzPDBfJFBVDTFXFNDZKlEMXObBTGlMJOdU~iZDzFxHLbD¢B`FXBDbFJfDHH`HPORQI]OYMSqWSKaG_A]Cc@{A[SsMcQeCVATGY~AAt@BKHMfy^oT[PWdwp^N{JMFSVidr\\bNW`q\\gXaZgZerfwLKJJu"],encodeOffsets:[[98169,44397]]}},{type:"Feature",id:"650521",properties:{name:"巴里坤哈萨克自治县",cp:[93.010383,43.599929],childNum:1},geometry:{type:"Polygon",coordinates:["@@IBKB@BCBC@C@AACC@A@@@A@A@CAAA@G@@@GACAGAABA@KDGHA@ABCDE@KA@@C@GBA@K@E@I@EAC@E@CDGBGAI@ABCDCBA@GAEBIBA@@AA@@AGIEAA@@@E@I@IBEBKBCBABA@@@EDEDC@A@EBA@EDADCDA@QAIBIBCA@@A@@@G@AAEBA@A@A@@BGFEFCBCBA@A@E@EAEBIDODEFEHEJ@HCBG@ACAAACA@AACBGDE@CC@@ICI@G@GEGAKBOBICGCE@GCMCIBEHEBIAK@G@GBCDIBC@@@C@EAGC@A@EAGGEOEI@CAE@EBKBCFCBGBI@G@KBGBEBOBE@OAEAE@CBEBG@K@E@IBCBIDGBCDAFEDIBIBEFIBO@KAECICECIAGEEAGBGBY@I@I@IAGGGCICEEMEGAA@GAGBSCGAEC@CIDKDI@G@A@CCCAW@WBE@K@EAAC@EDG@@DE@EEBODEBG@@@M@QBIBEBQDE@AFGFKDGDGBI@E@CAC@G@KCG@CG@ECEIGKEK@CDCFMBKECAI@ECKCIEKC@@ADADEDICGAI@GBGDIAI@C@GFEBI@IBEAGACCGAEDI@IAG@CCCAO@O@GDM@GAGBEFMBIAK@MBGBEBMAEAI@EA@ABCJE@CBEBAAAOCMDOBI@KCIAGAIBO@IBWBMDEBCDEDI@KDKBC@E@C@GBCAIBMBK@KBOBSFGDGFGDCBKBMDCBKBMDI@GBIFEDGBKDIF{PIDCFSFKHMBGDKNIPILQPOJMJMFWFKBKDKDCBOFADABBFSLUJEFQFCBK@KBMDOFEDMBWHCBODKFA@KDEDCBEBK@M@KB@@KBMBG@IAA@@@@@@@A@@@@@AB@@EDABCBGBGBABA@A@A@@AEAMEKEKGCCCACASGEA@ACACAKKA@AAA@@@A@A@AB@@AB@@BBBDFHBB@@BBBB@@B@@B@@BD@@@B@@@@@BAB@@@@@B@@@B@@@B@@@B@@@BGDABAB@@@BAF@B@@AB@BA@A@@BA@E@@BA@@@@@ABA@@@@BABAD@@@@@@@@@@@@@@C@@@@@@@@@A@AB@B@@@@@@@@A@@@A@@@@@@@@@A@@@@@@A@@@@AB@@A@EB@@@@A@A@A@A@ABC@GBA@@@ABA@A@@@A@@@A@A@A@CA@@A@@@A@@@ABA@@@@@A@A@@@A@@@@@CA@@A@@@A@A@C@MB@@A@@B@@@@@@@@@BA@@BA@@@@@@@A@@@@@@@@@@A@@@@@@@@A@@@@@A@@B@@@@@@@@@B@@@B@@@@@@@@@@A@@@A@A@@@A@@B@@A@@@@B@@@@@B@@@@@@@@@@@@@@@@@@A@@@A@@@@@A@@@@@A@A@A@A@@@@@A@@@@@@B@@@B@@@@A@@@@@@@A@@@AA@@@@@@@@@@A@@@@B@@@@@@@@A@@BA@@@@@@@@@@@A@@@@@BB@@@@@B@@B@@@@@@B@@@@@@@@BB@@@@@@@@@@@B@@ABAB@BA@@@@@@@@@@B@@@@@B@B@@ABABA@ABAB@B@@A@@@A@G@@@A@A@@BA@@@ABAA@BAAAB@@A@A@@@@@@@@@@@@@@@BB@@@@@@@@B@@@@@A@@@@@@@@@A@@@EBABIFA@ABE@A@A@CBA@ABC@C@E@C@CA@@A@A@CBABCBEDCBEBA@ABABA@CBA@GBABMBA@ABA@ABA@C@C@E@A@CB@@A@ABA@A@ABA@@@AB@@AB@@A@@@A@@@CB@@C@@@@@@@@BB@B@D@B@@@BB@@@@@B@@@@B@B@@@B@@@B@BBBB@@BBB@@@@BB@@@BAD@B@@A@@B@@@@@B@B@BBB@@@B@BAD@@@B@@A@@B@B@B@B@@@BBB@@BD@@BB@@@B@@@B@@@D@DBBAB@BBB@@@B@B@BB@@D@D@D@D@@@B@B@B@B@B@B@B@B@@@B@BBB@@A@@@@@@B@B@B@D@B@@@BB@@B@D@@@@BB@B@BB@@B@@@B@BBB@BBB@B@@@B@@AB@@@B@B@@@B@B@B@@@B@BBB@BB@@B@D@@@B@B@@@B@@@B@@@DBB@DBD@@@B@B@BBD@@@B@BB@@B@@@B@B@B@B@BBD@@BB@@@@@B@@@B@B@B@D@BAB@B@FB@@D@B@D@B@DA@@@@B@@@DA@@B@@@@@@@@@@@@@B@@@@@@BB@@@@@@@FBB@B@BBB@B@@@@@@@@@@@@@B@@@@@B@@@@@B@B@@@B@@@B@B@@BB@B@BBB@D@BBB@@BB@@B@@B@B@@@B@@@BBB@BBBB@@AB@@@@@B@@@@@@@@B@B@DBB@BBB@BB@@@@@B@@@B@@BB@@B@B@BBB@@@BBB@B@@BB@@@@D@D@F@@@B@@ADAB@B@B@F@@@B@BBBBD@B@@@B@@@B@@A@ABOJABAB@@@@@B@B@@@BAB@@ADCDEJCDAD@@CHEH@DA@@@@BA@AB@@A@@BEHEJ@@A@AB@B@@AB@D@@@B@@AJAB@B@@BD@F@@AB@DEH@BAB@B@B@F@J@@@B@@AB@@@BA@@@A@@@A@@@@BA@@B@@AB@B@@@B@@BD@B@B@@@@BB@@B@@B@@@@A@ABA@ABA@@@@@@B@@@B@@@B@@@B@@@BA@@@A@A@A@A@A@A@C@A@A@@@A@@@A@@@@B@@@@BD@@@@A@ABABCBA@CB@@A@ABA@ABCBKFCBCB@@ABABABA@A@@BA@@BA@ADC@C@AB@@A@@BA@@@A@@@@BA@@BA@ABA@AB@@@@@B@@@@@B@@@@@@@@A@@@A@@@A@@@A@AB@@A@@@A@A@@@ABA@ABA@ABA@@@@@@B@@@B@@@B@@A@@@A@A@@BA@@@ABA@@@@@ABAB@BA@AB@BA@@BA@ABAB@BABA@AB@@@@A@@@A@AB@@CB@@@@@B@@@B@@@BAB@@@B@@@B@@A@ABABCBA@A@A@AAA@@@@B@@@@AB@BA@AB@@A@@B@@A@@@@@@@A@A@A@@@A@ABEBABA@ABA@@@@B@BC@@BA@B@@B@@@@@@@BA@A@ABAB@@@@@B@@@BA@@@A@@BA@@B@BA@CBC@@@A@A@@@A@ABA@CBABABA@@@A@@@A@ABABAD@B@BA@@B@BA@@B@BAB@@A@AB@BA@A@A@@B@B@@A@@@A@A@@@A@A@AB@B@@@@@BB@@@@@AB@@ADA@@B@@@@A@@@@@A@@@@BA@@BC@A@@BA@A@@@@@@B@@@@@BABA@@@A@@@ABA@@@@@@BB@B@BB@@B@@@@@ABABADA@@B@B@B@@AD@@A@@@CB@@A@AB@@AB@@ADA@@@ABA@A@A@AB@@@B@@EDAB@@A@@@@@A@A@CAIFCD@@@@BJHVJ`DNBF@JBPDVBNBJ@HBJBLBHBJ@D@HBLBJ@BBJBF@D@FBH@RBb@@BLAF@L@l@NAl@^@P@R@L@F@JAD@@EPGRITKXGRGR@@CHGNM`GREJBFDPDRHZH`FTJFHFJFNLTLHFDHJV@JBH@V@LBJ@@@L@P@L@JBVBNBNNDLDFHFLFJDHBBDFBHDDHLHPHPLTFJDF@BBBBB@@@D@B@DAJ@H@L@F@J@DAB@B@B@@BB@D@B@BAD@B@DAD@@@@@BAB@D@B@DABAD@BAB@BAB@B@B@B@B@@@BBB@@A@@B@@@@BBBD@@BBAD@B@@BBBB@@@@@@AB@@A@@B@@@B@BAD@@ABA@@@@BABAB@BAB@@@D@BAF@F@D@@@@@B@@BB@B@B@B@B@D@@AB@D@BA@@BAB@B@@AD@@BBDFLPLPBBBDBDBBB@BDFDj`NLVP`ZjbLHPL@@@BFBB@@@@@B@@@DB@@BBB@B@B@@@BB@@BBBB@BBB@D@@@@AD@@@@@@PHHDHDD@@BB@@AB@D@D@@@@@BABA@@DA@AB@B@B@@@@@BBBBFFDFHHDFBBBB@@@D@D@B@BA@@B@B@B@BBDBBDF@@FFDDBDBB@BDD@B@B@BABAB@BA@ABABCBCBAB@BADAB@BAD@B@@@BBB@D@B@B@B@H@@@BAB@@@D@B@D@B@F@J@DBD@F@D@@ABAD@BABCDCDCDABA@@BAB@D@BA@@BCDAD@D@@AFAD@BB@@@DB@@BBBB@@ABADABADEFCFA@EHGL@@CBABAB@@@B@D@@ABADABADAF@DADABADA@CDIFEBCB@BC@@@@B@BBD@D@D@FBF@B@F@F@FBB@B@F@B@H@DBD@H@F@F@DAB@BBB@D@BBB@DBDBDBBDBFDJBhOp[\\N^EfKTI@bCfCrJ¶RvHz@XAEj@D¢B²FXDVCEPGz]fOpYlSaVP\\EfBfJZDLCzYpFBfESXCPCJ@PFfLjPAlHrBHb@tNvNVVIIbCdLfDhMfEVgr@^A\\[bPVDH^DZKtIjFxHLCT@VFJAnMjM~QpIfIR@VAB\\O\\AZ@PMXAXKXQ^OnFZA^JrMBZXKxWXG¬ipMU\\M^MdADeLSZK¦eúºm¤gY etY^StYlWbIOTGXm\\Yf]j]hQlMRKbwL]EcTMLpG´QdMredOv[bGStguTKPSjqPU\\WZABbEhORGMCWESEUCEAeICAyOQcIKCkKUEEAYEUEKCMCwOQSaGcIMCIAQIQKcUWKeW[Q[MMI]Q[O_SAA]gW_WaS[U[@@U_MSMQGKBCDM@U@@@GAAIGQGQIAAMEUCKGKQACECOKUGOGMMMEMEIIIOKMGIEEMEIEGGIMKKUMo]WIOIMGIAOEAAEAMEMK[MYOAASGUKOSAAGIAAIKISCEGGGCAE@AGG@@ECCACA@EA@EEGCACAAC@@@@CCCAC@CCEGEAA@AAAA@CAG@A@ECAAEACAA@UEIBKBCBI@A@EBEBE@C@AAC@CBEBKCM@C@C@C@A@KCEACAGA@@GO@K@C@CFGFCHGDA@@@A@A@KAGECC@CCAICGKCG@GC@@GCIGAACGC@CGEEGAA@EAA@AAAAAAAA@@]KCAAABAHEDABCAC@@CCCIGEEAMCKEEEAE@C@GCAI@QBM@MAGESKEACEDOAcDMNgUOSHo`SDgmAP[aqm_Mu]KOUQMKYKEC@K@]AGBA@AD@BAHADCBGBCBCD@BAJ@DCBABC@EBCDGFCFADCFEDGDAD@DBBADADGBC@A@ACACMMEAOCI@G@ADAB@BHHBB@BADABCBC@IAEBEBCBIJCBC@E@AA@@EBABABCD@BDNABA@A@A@A@GD@BAD@B@BA@ABC@MBGAA@CBKDA@C@CAA@A@A@KDCB@BABEBABG@EBIDCBGAGBCBC@IB"],encodeOffsets:[[95432,44511]]}},{type:"Feature",id:"650502",properties:{name:"伊州区",cp:[93.514797,42.827254],childNum:1},geometry:{type:"Polygon",coordinates:["@@wq¹­QQACãđ[ećēC@C@CBEBC@C@E@CBE@A@A@A@CAA@CAG@EAEBGAEACAA@ACA@AAG@EBG@C@A@AAEAEAG@C@K@GBE@A@CBE@A@C@A@ABG@CAKBA@C@CBCBCBCBEDEDEBCFGDEFGFKHKFIFSLEDWLOJGDCDCBCBCDABCDCBCBCBC@ABCDEBCBCBCBABC@A@ABA@ABADCBCDCBIDEDGBEBE@C@@@A@E@C@E@A@C@CBGBA@CBA@A@AAA@C@IBE@EBGBEDEBA@@BA@C@EBA@CBA@A@EBABE@ABI@EBG@C@G@EAC@C@GAA@A@I@C@E@A@A@ABA@C@E@G@A@CBCBADAFCD@FABA@ABC@E@A@CBCBC@C@ABA@ABC@A@A@@@A@@@ACA@A@@@C@A@A@G@A@ABCB@@ABA@@AA@A@AAA@E@E@CAE@CBA@ABABCBCBEBC@A@E@GAA@@@A@C@A@G@GAC@CAGAGAC@G@O@EAE@I@IAG@GAI@K@MBG@A@C@E@E@@@IBG@KBI@O@SBMBO@EBGBKDGDIDGDC@CB@@EDEDEBEFIDEBEDCBGDEDEBCBCBABEBEDCDEDCDCDIDCBIFEBABCAABA@AAC@CBA@ABC@C@C@GBC@CBABCB@@A@C@A@A@E@@@CBEDCDMHIHCBEBEBA@C@ABIBEBGDEBGBEBABEDEDEBGDCBGBC@EBIBI@MBG@EBG@GBG@CBEBSFUFEBABGBQHUFGB]JQDGBGBIBGBE@E@A@C@U@]DaBU@IBWDQBQDOBE@EBE@EB@@A@ECICC@A@C@@@A@ABA@@@A@CACAA@C@A@CAM@KAM@EAI@EAC@C@K@I@I@A@A@AAC@C@A@A@C@A@A@A@EAC@A@C@G@A@C@E@IBGBE@C@G@I@@@I@C@M@I@I@IBIBI@I@KAGBE@KBK@E@G@ABC@@BCBADEDCBABIFCDEFEDABAD@@C@IDYD£RUB]DgHsJSHSF[JkLaH[H]DOHM@KBOFKDIBIBKDKDGBIFMHWFOFE@EBGBMHIFWL\\KDSFeNKDIBKBK@IDEBCACBA@ABADABA@A@C@A@C@C@K@IAKASCA@A@CAEAE@CAG@M@Q@[BM@UBYB[DE@@@@BDD@BBDAD@B@DAFAF@BAFBDBHBDBDBD@DDHBD@FAD@BCFADAFABADABAD@F@B@B@@AFAJ@FAH@D@DAL@BB@@@DB@BBB@@@BABCB@@@B@B@@@BBBBD@D@@BFBDBBBF@B@B@FADAHADCDCD@@ABA@A@O@A@A@ABA@ABCB@D@DBDAB@@AB@DA@EFABA@ADABCB@BAD@DBDBF@B@B@B@BBB@B@B@@ABA@CD@@CBC@CB@@EBCBA@ABIHABAB@BCBA@@BCFAB@B@BAHA@@BABCBGFA@A@@@AB@BAF@D@DADAB@BA@@BA@@BCBAB@@AF@FABADADABA@ABC@A@G@E@A@A@C@A@A@G@GBABA@@@@BCB@BC@ABC@EBAB@BAB@F@BBB@BAB@@A@@B@BBF@BB@@B@BABAD@@AFABAB@BBB@BAB@B@@@BBB@@@BADAB@@AB@DBD@@DDBD@D@@@BB@BB@@B@B@@B@B@@C@A@@B@B@@@BB@DBFBBBDDD@FBDBHBFBHBHDBBHBB@B@@B@B@BADAB@BADABADBB@@B@B@BB@@D@@BBD@B@@@DAD@BAD@BAB@@@BBBBDBBBD@B@@@B@D@B@D@BDB@BDBDD@BB@@BABADABAHAB@F@@@@@@@D@J@B@F@JBHAD@D@D@D@FBH@BBB@@@B@B@FAD@D@B@@@BC@@B@BADAB@B@B@B@@DAB@B@B@B@BBB@@@BBBD@BBF@B@@@BBD@@BD@BBBBBDBD@DBBB@B@B@D@B@@CB@B@BBB@BDDBBBBDBB@BB@@@DBD@@@D@BBDBB@DBB@B@@@BA@@B@@AB@BBD@B@B@B@@@BABABAD@BAB@BCD@DABA@@BAB@@@@@B@B@D@D@@CF@@@B@BBB@B@D@BA@ABAB@@@BAB@BABADABAB@BAB@BABADABABADCDABAB@D@D@BAB@DB@@BAD@DABABA@@B@BAB@BAB@B@@@B@BA@@B@@CB@BA@@BA@CD@B@BAD@D@B@B@B@BCBAB@BABABAB@@@D@BCD@@BB@B@BABAB@BAB@BBB@BA@@DAB@D@BABCFAB@B@BAB@B@B@B@@@B@B@@BB@BAB@BBD@BBD@@ABA@@B@@@B@B@@@@ABABAF@DABABADABABEDGFADAB@BCDADAD@D@FBD@DBB@D@DA@@B@DEFABCBABAB@@CDGFCFCLELENEJ@DCFEPCNCHEPAHAB@DCFADCHEJELCFEHCHCFCFEJGHCDEFEDIHCBA@E@EBIAMBE@G@G@C@ABA@A@CBC@CBIDA@C@C@A@CBGBCBGBAB@@@B@B@F@F@FBDBDDDDD@@B@DDDBBDBFBH@HAH@BAHAHCF@FAHAHADADABGFEDCDGFIDA@GBEBGBEBGBEDEDGDIDIBGDA@GDCBEFEDIFEBCBIBEBIFC@A@C@ABE@CBGDEDGFCBIDCBKFCBCH@BABAB@BADABCFEBCDCDEFABELCDEJEDGDGFCB@D@BBFBFFLBDBF@DBL@FBD@F@H@L@JALCHAFCJAHAFAH@DAF@N@@@@GrEjC^C\\C\\ATCL@NBX@H@F@RBPAJ@JAFADADEJ@BEFCHELAJAF@DAD@HAF@H@D@J@P@L@HBD@DAH@J@JALAHAFAHCFADEFEFCFCF@DEHALANCHAH@FAJ@J@HBD@JBL@@@FBFDJDJBHDJDHBF@BDJDJBFDJBHBDDF@B@B@B@DAB@BAB@@@B@@AB@BAB@B@BA@@BB@@BBB@BBDA@@@@B@@A@@@@BA@@@AB@B@@AB@@ADAB@DBL@JADAD@B@@@DAB@@@BBB@@@B@@AB@@AB@@ABABABAB@BCDABAB@BA@@BBB@B@B@F@BAD@D@F@BBD@DADBB@JJN@@@D@BBF@D@DBD@@EXCR@DAD@DADAHALAFAJ@DADALCPCNAH@DAD@DADAJ@FAJADAFAJALAH@DCJ@HAFAD@DAFAFAHCL@DCLCLALEPCNAH@BADAH@FAFADAD@FCJ@BCL@DCHETAHALCLERCP@BAB@@GHGHIJGHCDGFGHGHEFAB@BAF@J@F@D@@ADABADCBCDADADADAB@B@B@B@B@B@@@D@DAD@BABAD@BAD@DAFAFABCLADAD@BA@@DAFAJCHCLAD@BAB@BADADADEPCLEPGXAFABAFAFAFAB@BAB@B@BAD@BAB@@@BAD@@@BAB@B@DAB@B@@BB@B@@A@@@@BA@@B@B@DA@@B@@A@@@AB@@A@A@A@ABA@@@@BB@@BB@BBBBBB@@BB@B@B@@@@@@@B@@@@@B@@@@@B@@@@A@@@@B@@A@@B@B@BB@B@@B@@B@@@@B@@@@BB@@B@B@@@@@BBB@@@B@@@@B@B@@@@ABAB@@@BB@@@@@BB@B@@BBA@@@@BA@BB@@@B@@@@A@@@@@A@A@@@A@@@AAA@@@A@A@A@ABC@A@@@A@@@E@A@A@A@ABA@@@A@@@@@ABCBA@CBA@CBAB@@BB@@BB@B@@A@@@C@A@ABAB@@@@@B@B@@@B@@@@A@ABA@A@A@@@ABABABAB@@@@A@@@@B@@AB@BA@@@A@A@@@AB@@@@@B@B@@AB@B@B@DB@@B@@BB@B@B@BB@@B@@@BB@@BBD@@B@@@B@B@D@BBB@@BB@DDB@BBB@@B@@@D@@@@@@BB@@@@@@A@@@@BA@A@@BA@@@A@A@A@@@@@A@@BADA@AB@@@@@@AB@BAB@DAB@@ABA@A@@BA@A@A@A@@@A@@B@@@B@BAB@@@B@@@@@B@@BB@@@@@B@@@@AB@@@@@D@@@BA@AB@B@BBB@B@@AB@@ABA@@BA@A@A@A@A@@@@@BB@B@@BB@@BB@@@B@@@@DB@@B@@@BBB@BB@@B@@@@@A@@B@@A@A@C@ABA@@@A@@@A@@@@BA@@@A@A@A@A@ABA@@@AB@@@@@@@B@@@@@@AB@@@@@@@@AB@@@@A@@@A@@@@@C@@BA@A@ABA@@B@@A@CBABABA@@@ABA@@@AB@@ABAB@@AB@@AB@@@@@@@@@B@@@BAB@@@B@@@@@B@@A@@B@@A@@B@@A@@@AB@@@@A@@BAB@B@@@B@@@@@@BB@@@@@@A@@@@@@@ABA@@@@@@BA@@BB@@B@@BB@@@@@@@@@@A@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@AB@@@B@B@@@B@@@B@@AB@@@@@B@@@@@@@@@@@@@@B@@B@@@@@@A@@B@@@@@@@@@@B@@@@@@@BB@@B@@@@@B@@@@@BB@@B@@BB@B@@@BBB@@@B@BBB@B@B@BBB@BB@@B@@@@BB@@@@@@B@@@@@@BB@@@@@@B@@A@@@@BB@@@@@@@@@B@@BBBB@@@@A@@BA@ABA@AB@B@@@@@B@@A@@B@B@D@@A@@B@@A@@@A@@B@@@@@@@B@B@@@B@@@BABAB@B@@@@@BA@@@ABABB@@B@@@BBB@@@B@@BBB@@B@@@@@@AB@BA@@@A@A@@BA@BB@B@B@@@@B@@BB@@@@@BB@@@BB@@@@@@B@@@B@@B@@@B@@B@@B@@BB@@BB@B@B@B@B@B@B@B@B@B@@@@@B@@@@@BBB@B@D@B@D@@BB@B@BD@@@B@@B@@@B@B@@@DBB@@@B@@@B@@@BABABA@AB@@@B@@B@@B@B@B@@@BB@@@@B@@@B@B@F@@@@@@B@B@@@B@@B@BB@@BB@@@BB@@BB@BB@@@@@@@B@@B@@@BBB@@B@@BB@@@@@@B@B@B@@@BB@@@AB@@@B@@@BA@@@@B@@@B@@@BA@@BB@@B@@@B@@@B@@BB@@@@@B@@@@@B@@AB@@@@@B@@@B@@B@BAB@B@@@@BBB@B@DBBBB@@@B@B@@BB@@@BA@@B@@@B@@AB@B@@@B@@B@BB@@@@@@@B@B@@@B@B@@@B@@@B@B@B@B@@AB@@@B@@@B@@@B@@A@@B@@@B@@@B@B@@@DAB@B@B@@BB@B@@@B@B@@@B@BAB@@@BBB@B@B@DB@@@@@@@B@@@@A@A@@B@@AB@@BBB@BB@@BBB@BBB@B@B@@@@@@@@BA@ABA@@B@D@@@@@BB@B@@@@@@B@@@B@@@@ABAB@@@B@@@@BB@@B@B@@@@BB@@@B@@@@@@B@@@@@@@@@BAB@B@@ADAB@@@B@B@@B@@BB@BB@@@BB@B@@@B@@@B@BBD@@@@@@B@@ABB@@@BB@@B@@@BBB@@@B@@@B@@@B@@B@@BB@@AB@@@BB@@@B@@@BABB@@B@@@BB@@B@@@B@@@B@B@B@@@B@@@B@@@B@@@B@B@B@@@B@B@@@B@@@@@B@B@B@B@@@B@@@B@B@BB@@B@B@B@@@B@B@BBD@B@DBB@@@D@BAB@B@@ABA@@BA@@B@@ABB@@B@D@@@B@@@B@@@B@@@B@B@B@B@@@@@@@@@@@@AB@@@@@B@B@B@@AB@@@B@@@B@BB@@B@@@B@@@BA@@B@B@B@B@B@B@@B@@B@@@B@@@@@B@@@@@B@@@B@@@B@B@@@B@BBB@@@B@B@BBB@B@B@D@B@B@B@B@@BB@BBB@@@BBB@B@BB@@BB@@B@@@B@B@BBB@DBB@B@B@B@BBB@B@B@B@@@B@B@BBB@@@B@BBB@@@B@BB@@B@@BB@BB@@B@B@@BB@@@BB@@B@BBB@B@@@B@@@B@@@B@DBB@D@BBB@D@F@B@D@B@B@@@@BAB@@@@B@@B@@A@@@@@@B@BA@@B@@AD@B@DAB@@@BB@@@@@B@DBDBB@DBB@@@B@@@B@B@B@@B@@@@@@B@@BBBBBBB@@B@B@@B@@BB@@B@@@DB@@@@@B@@B@@@@@B@@@@@BB@@B@BBB@@@B@DA@@B@@BB@B@@BB@@@@@B@BBB@@@B@B@@@@BBB@B@BB@@@B@@@B@B@BBD@@@B@BBB@D@B@B@B@@BB@B@D@B@B@B@B@B@B@D@B@D@B@B@B@@BB@@@B@B@B@@AB@@@BBBBBBB@@@@@@@@@@@@@@@BCBA@A@@B@BA@@@@B@@AF@B@@AB@B@@ABA@@@ABE@A@@BABAHC@A@@@A@@@A@@@A@@@A@@@@BA@A@@@@@A@@AC@@@AA@@@AAAA@@AAEGACAA@@BA@@BAB@B@@@B@BBB@LLDBDB@BFBTHDBDBDDLHLFNFFB@BB@B@B@BAHAHADABAFC@@BA@@@@B@@@@@@@B@JBH@NALA@@LAN@L@FADAFCLCB@LEPCDAXGNAFCPENCLAL@DAREFEVITKAEBABCPEDALCLCLAXENENIPIROJKJOLMHCNALGTEDEJC|OJELCHAFCJEHAJ@NCLADANCLADAHCHEHCTEPALAL@NAJADBHAD@F@D@LALCJ@FCDCFANCXAJAP@JAHBJBLDJ@PANCPDBBABAF@DIFAD@BFBJ@FBNBFAHANAL@JBNAFEHAHBN@HCP@P@DBDDH@JBJ@FCHBDDHBFBJAJ@FAHED@J@JBHCHAJ@HBJDFCBCBC@@LDJFLDFDJ@DBLFNADEDCL@LFJHDF@FDHH@LDH@D@DBF@J@HAHCLCHEBEF@RCFAJARAN@@@H@FAPCFA@FCF@@CH@FBDFBL@F@XAX@DBDDB@H@J@LCJC@DFDHBTDHAHBB@HBNFFFJDHDHHJBJ@J@Z@HAHAFBHFJBFDJDFDLBP@JAFEJAJAFCBEDCHAJCDAJAF@L@H@FADAF@FBPBF@PAFAHALAH@J@HADADELAFAF@DBJ@PFHFBH@F@BHDFBD@@@D@JADCHAH@L@JBFAFGJANDHDF@HDJDPALAHBHFH@J@JD@@DDF@HCDABBB@BDBBBDH@DA@GFIFGFEPCJCFAFBF@B@B@DADAFEHE@AB@B@B@FABBH@@@B@@@DBJAJARBB@DCBCFCB@FAB@D@FCFC@@B@BADALAFAJAJ@F@@@B@FBHJ@BB@@BB@JAFAHBB@DADCBAJ@HBHADCF@D@FBJ@F@L@B@HAD@@@LBF@DCBAB@HGLCB@BAHBDBHB@@H@B@BB@D@B@B@@@BDDBBD@D@DA@ALAJAJAD@DAHAHBDAJCFAH@BAFABA@ADALCB@B@B@DBD@B@LCDAB@HBNAD@BAB@@A@ABC@AHCB@B@B@B@BACM@ADCBABAFA@@BBF@D@DAJIDAFAFAJBD@DABABC@AAAGG@ABABCH@J@PDFBNNBDBDB@D@HABCBCAA@CBCHCFCDEBCDEHEDCFAD@BADA@CBI@ADCDAHADABCBG@ABCB@HA^BL@D@LFLZRNVLPv^`NrnbO\\nBCh_TGpPThVNMdCBBUBIFi@CFoBM@C@ADCBAFCB@BAB]@I@KCUFGFABABAB@BAD@B@LGNEFCBCB@FAD@B@FABBDBB@BBB@F@DADABA@@Z@@D@¨@FSNMHAHINCIQOPAVATC\\CNCJCNGJCJALCP@JALCDALEFCDCRKFAFBDDF@LCHEJG@ABABADBNBDBDFB@JDDDLDFBNJBBJJFBJD@@B@LBNEFGBAFKHCLAJGBCHED@F@LCLEDCFGVKJGJKDE@ACG@AHGDAD@FBHBLDB@B@BBH@F@D@BABAAC@CDAFADBBANEFCRCDCDI@ADCDAF@JCBAFCDAH@BABGDCFCDAD@JDD@B@DCBEDEBC@ABABADAB@N@D@@A@G@A@CDADALALDB@BGBIBADCFADCDCDGFCHAB@BADEFG@A@EBA@@BCFCFAF@F@JADAFARMFE@EA@BCVKTKJEBADGDCBABCBAEOCGCEACCIEIGEAA@K@ADC@AAEAC@A@CDAH@B@@@BADABEDABAD@DBF@F@LEBAFABC@GDCDAD@DAFEBADADAF@F@BABAAM@AHCFIDADAB@FBFDDDDBHDFBHBBBD@F@DADAD@TED@L@JDH@FANIDCHIJENGBADGDIHKBCD@L@JBH@D@DBB@BDBBFNBB@BB@BB@BB@@BBAD@B@BAB@BAB@@AB@@ABAB@B@BA@@@AB@@@@A@@@@@@BA@@@ABABADCB@B@B@B@D@B@BBB@@@@@@@@@BC@A@AB@BCDABABA@@B@BA@@@@@A@@B@@@@A@@B@AA@@@AB@@A@@BE@@@@@ABAB@D@BABABA@ABA@ABA@A@@BA@AB@B@F@B@BBD@D@@@B@@@@ABA@@B@B@@@BA@@B@B@@@D@BB@@B@@@@A@@BA@@@ABAB@@@B@B@B@B@B@D@B@B@B@@ABA@A@AB@@@@A@@DBB@BBF@B@BA@@B@B@B@B@DDB@BBBBB@D@@@FBB@@@DAD@B@B@B@BBB@D@FAD@B@B@@A@A@ABABA@@DA@@BAB@DA@@B@DBB@D@D@B@D@D@B@B@DAB@B@D@BBBBA@@BABABA@@BBB@@BDB@BBFBDBBB@B@@B@@@@@D@BABA@@B@BAB@D@@AF@BAB@@AD@DAD@B@B@DBB@B@BBDB@@BBB@@AB@B@B@BAB@B@@AD@B@@@FBB@B@@@BA@@@AB@@ADCBABADAB@@@B@BBBBB@@D@BBDBDBB@B@D@B@B@BBB@BADAB@B@B@B@@BBD@B@B@BBB@D@B@@B@BBB@@@@B@@@BAB@B@B@D@@AB@@@@@B@BB@@@@BA@@@AB@B@DAD@B@B@@@@@@BB@@@@@B@BAB@B@D@B@BBBAB@B@F@BAD@B@@@@@@@FADAB@BAB@BBD@BBB@F@B@B@D@BA@@B@B@B@B@@@B@@@B@BBB@B@B@D@B@B@DA@@B@F@D@F@B@BB@@@A@@@@@A@@@@BA@@B@BBBBF@JBBBD@@BD@@BB@@BDDB@BB@@BBB@B@@@B@@BB@@@@BB@@@BBB@BB@BBBB@@BBBB@@@BB@@@@@@B@@@@@@@@A@@@A@@@@@@D@@AB@B@@@@CB@BAB@@AB@@AFCBCD@DC@@BAB@@@B@@@B@@@B@@AB@B@@@B@@BB@BB@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@B@@A@@@@@@@@@@@@@@B@B@B@FA@@D@@@B@D@FBDBBB@@BBB@@ABA@AB@B@BAD@B@DBDB@@F@PBF@B@@BDBBBBBFAL@@@@@B@B@BABAD@BA@@BBB@BBBDDF@BDB@BDDB@D@B@DAB@H@J@B@@@@@@B@DBD@@@@B@D@BAJAB@B@BAB@DBFBFBB@H@L@D@@@@@B@B@D@F@@@B@@@D@@BB@@AB@@@@@@BB@@AB@@@BB@@B@@@B@B@BBB@@@B@B@B@@BB@B@@@@@B@B@BBB@B@@@B@B@@@B@B@B@F@@@D@B@B@B@B@B@B@B@@@BBB@@@B@B@@BD@@BB@B@BBB@BB@@@@BB@@B@@B@@@@BAHELEPIXMRKLEDAFC@@BAB@BADADAL@VADAF@B@H@HAD@@@B@@@B@B@FAB@FCB@DAB@B@B@DBDBB@@@BAB@FAH@B@DBB@DBB@B@B@FAB@D@F@B@BAJA@@FADAB@FAB@B@@AB@DCDABABADADAFAB@HAD@F@F@B@D@B@@@D@JDFDB@N@H@L@N@DBD@DB@BBBDBAB@DBDDDFFFDDDB@@@B@BABADAD@B@B@@@BB@@D@BAB@F@DBB@F@BAD@BAB@B@@@D@B@B@H@B@B@B@B@B@BCBA@AAA@AB@BCBCDA@AB@D@LBD@D@FAD@BAD@DCDA@ABADAFCBABADEBA@AB@@@DAB@J@D@DAB@HCDABADAB@BA@@BABAB@@@B@BA@@@A@@@ABA@@@@@A@@AAAAA@@A@@B@HBF@F@DAB@@@B@@@@@@A@@@@@ABAFCDAHCFEHEFEBAFAJAHCNCRCPCJCJAPELAJCL@HCN@TCL@F@ZALAJAH@R@^@^@j@L@R@FAB@R@VBR@N@J@`@TAB@`@L@\\@R@H@LCPEZGZGJCXGRE^GVINCLCPCbKbI`I`KHAPELAFBHLDHDFHFB@FBJ@HAJ@H@FBB@B@DBDBDBDDDBDBFBBBH@D@B@BBB@DBHHD@BBB@@BBD@F@@BBB@B@BBB@BBDDBF@B@@FD@B@B@BAF@DABEFADGJINGLGNGL@@PlDJ@B@DALCNAJAHAH@BBBZN@@JFDBJHTTPLJJPNJJFFBBDFBDFNFNJRLZHENKLGHGyyRKmviVQyuFYHJNFAKDGGIAAA@@@AA@@@@@@AAA@AA@@AAA@AAA@AAA@A@AA@@A@AAA@CACAAAA@AA@@@@A@AAA@@A@@A@CAA@AA@@A@@@AAC@CACAEAECA@A@CAA@@AA@A@A@@AA@@@A@AA@@A@AACAA@@AC@ECA@EAICIEICEAAAA@GAAAA@CAC@CAAAA@CAIEA@GCEAEC@@SI_@A@@AAAAAAEAEAA@I@@AA@EACCIEC@AA]CG@CAA@@@@A@@@AAKAA@@A@AA@@QCEAAAA@@@@@AA@@AAC@C@EAK@U}£wGEgagIMCAMQCCKMLCvM°[o½Ac@@@QŘĝykwe¾ăIoBABABA@A@A@CAAB@@A@A@A@@ACACA@@A@@AAA@C@AACCACAA@C@AAA@A@ACAACCA@AAG@GACAC@@AAMKCAGC@@EE@AOIAA@@AACAECCCCA@@UOGIA@ACAAECAA@@IIA@CCCAA@CCOKAAWIOCCACAIGSGA@AA@@CACAC@AAC@EACACAEAEAE@AACACAC@CC@@EAEACAAAAAA@AAC@AAC@E@C@C@@AC@A@CACACAAACACAC@CACAAACAA@CACAEAC@E@AAE@E@EAC@A@CAC@AAC@CAAACAA@AAAAA@AACCCAAACACAAACACAAAAAA@AAA@ECCAEAC@M@I@A@G@ED@@C@C@ABA@EAE@UAKAICMGKAQEQAMAE@A@GAgAE@G@K@E@E@EAC@AAC@AAC@CAA@CAC@C@E@C@G@EBC@C@A@A@AAC@EAC@EAE@CAGAA@CAC@AACAAAEACCCAA@AACACACAA@A@CCC@ECEAA@SGOC@@wKGA@@AAAA@AAAAC@@AAACA@CAAAAACAEAAA@AC@KCYGAAAACAAACAACEAAAACEACCCACCAACAAAC@AAC@AAC@CCEACAAACAAAA@CACACCCACCAAAAC@CAC@A@AAA@CCECCAA@CCAACACACACCCACCCAC@CACCCAEAGCCACACAC@AACAAAA@CAECCAECCACACACAECEA@@QMIGGEGEECKGGESS]]OiGQEKKYCGAQ@AGOAEAAIGAAABABABC@WVYV_\\IJEJYnOZCNCHADADGDCBAHAFAHYXKL@LAFHNBJ@BDB@B@BABCBGDKDKFMFSHY\\QTADADADCLAFA@sIċMě§][AAmCEOƟqKIucǃŅ}iA@ygËïÛ"],encodeOffsets:[[96357,42182]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("和田地区",{type:"FeatureCollection",features:[{type:"Feature",id:"653201",properties:{name:"和田市",cp:[79.913534,37.112148],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BADGLAB@B@B@DBL@HDV@B@D@D@BBD@BBBDDBDDBBBBBB@FBDBD@BBDBD@@BBD@F@BADEJCDKRCBC@ABABIFUH_JEBSFKDEBDT@D@H@B@HADADAB@@@@@@CFCHAD@DADAD@D@B@B@DBB@B@@BD@@@BB@@@@B@@BB@@@D@@@B@BAB@@@B@@BB@BBB@@BBDDDD@@BB@@@@B@@@@B@@B@BB@@@DA@@B@@@BAD@@A@@BA@@@@@@@BD@@@@@B@B@@@@@@@@@BB@@BA@@@A@AB@B@BAD@F@J@@BBBF@@BB@@@BB@AB@@@BB@@BB@@BB@@@@BB@@BABBB@BAB@@B@BB@@@B@@@@C@@@AB@@BB@@AF@B@@AB@@@B@@BBB@@@@B@@@@A@@@A@@@A@@@AB@@@BD@@@@B@@@@A@@B@@BB@@@B@B@BAB@@BB@@@@BA@@@@BB@@B@@@@BA@@@A@@B@@A@@@ABA@@@@B@BA@A@@@@@@@@BA@@B@B@@@@ABBB@@BB@@@B@@B@@@@@@B@@B@@B@@@B@@@@@B@@@@@@BA@@@B@BB@@@@@@@@BB@B@@@@@B@@BB@@B@@@@@@@@@B@@@@B@@@@B@@@@@@B@@A@@DA@@@B@B@@B@@@B@@@ABB@ABB@@@@B@@AB@B@@@@@@B@@A@@B@@BB@@B@BB@@@@F@B@B@@@BB@BB@@@@ABBB@@A@@@@@@B@@@@@BBABBB@@@@DCB@BA@@@@B@@A@@B@BBBBB@D@@@BCHIN@B@@@B@BBBBBB@@BB@@BB@@@@BB@@B@BB@@@@@@A@@@@B@AC@@B@@A@AB@@AB@BBB@D@@@@A@@@@BA@@@@AA@@B@@@BB@@@B@B@@@@D@B@BAB@@B@@B@BA@@@A@@B@@@@A@@B@B@@@B@B@DDBBB@@@B@@DBB@BBHBB@BBBBBFB@@DB@@BBBB@B@@BB@@DB@@BBJJBBBAN@LBFB@@B@@BAB@BABAB@@B@BF@JCF@DDFHHLJFHHNFNHNJ\\PXBHDN^@CA@AGGCEGSGGI_AAAAEGCECAACCIGGW]BABEDAHG@A@`@j@PLDBJLN^BBDBdRCBCBAFK@CDEFM@IGIGCEEESGOKGECAAAAAEAECGCECEA@I@IACCEAACAEAQAWAECAA@A@ACA@AEG@A@@AA@AAC@@@AJBF@J@P@D@FAHC@A@C@A@IAGAA@CCCCCAACCOCAAEACAA@W@@ACMJAACA@@AA@CA@A@CCCAAAA@@AEAGIO@@@@@@GGAA@@@@@@EOEK@AAEA@CEAACGAAFA@@GE@AA@@A@AGAUKEAU@@@A@@A@A@@BA@@AA@@AEAC@AAC@@@@BA@A@@@AAA@@AA@ABC@AACEC@A@ABA@C@C@CAA@AACAAAAAA@A@A@CBAAACC@A@A@@BC@@@EA@@A@AB@@A@AAC@A@AAAA@A@CCE@@AGCAAAC@ACA@A@ACAAA@A@E@@@AA@@@C@CAA@AAAA@@@AA@A@@A@AAA@A@ACCCAAA@@@A@CA@@C@@B@@@BA@@B@@A@@@AAAAAAA@A@C@@@AACCCAC@@@@@@DABA@A@C@C@@@AAA@AB@@ABA@@A@A@A@CBA@@@CA@A@B@F"],encodeOffsets:[[81811,37807]]}},{type:"Feature",id:"653222",properties:{name:"墨玉县",cp:[79.728683,37.277143],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@B@@@@B@@@B@B@@B@@@@@@B@@@D@@@@BA@@B@B@@BA@@@B@BB@@BAB@B@A@@BB@BBB@@B@@@FB@FFHCBHFBBF@BAHBB@@@B@@A@A@AB@@B@@BAB@@NI@B@D@FB@BD@@BFF@@D@BBB@BBDDFB@B@DDF@@@BB@@@D@BA@@BA@A@B@F@@BDA@BD@@@@DHBFKADATAR@@AB@BB@BD@BA@F@@B@@@B@@BB@@B@B@BBBAB@B@CBCDFDdRDB@BB@DB@@BBB@BABBB@@BB@BBB@B@HFRL@@BPBH@LBTBVDlBTD\\BTBXDRGBC@ABCAABEAC@A@E@ABCJEPETAJEVCRIfD@XAjC~CGEbAtCH@AIEMI]KYCOaPq@@LU@B@HAIWGSEQCEI]EKEQIW@CCICGAGCIIWAECGIBCBQF@@C@C@C@A@EBE@GBA@BDD@BDBBBFBDBFA@GBCBACAEAEB@A@@EACAC@@@@@B@@EBIDEDACACFAA@BA@B@A@@GKACCGAADAFA@@BA@@B@@AFAAAE@CCAB@@A@@@A@@BA@A@AA@A@@@@B@@@B@B@@@@@B@@@@A@@@@@@@@A@@@AAB@@@@@@ABAA@@AAABA@@@@@AABABAACCAAAAACCA@@A@CDACABCBBBBBB@@B@@@@DDBB@@BBABA@@@ABA@@@@@CBAAABBD@@AC@@BAAC@@CB@@ED@@A@@@EB@@AAAABA@@BAAA@@CBA@A@A@AAACB@B@DADDBADCJC@@HCEG@@@@@AFCBB@A@B@ADD@AAA@@@@FCA@BA@@BCA@@A@@@@@@@A@@@@AAIFA@@@A@BABA@@B@ACB@A@@@ACA@AAAA@@A@CBA@A@CB@A@@B@ACCDA@@@A@@@@@A@AABAA@ABC@CBCB@AA@@ACC@@BAACCCD@@@FAB@B@B@BAB@BCDAAAAACCAAHCFADA@@@@DCJCBA@ACAB@DABAAC@@FCAC@@BAB@AAB@DCA@BA@A@@@@@ACCBAB@DA@A@@B@A@B@@A@@@@B@@A@@@A@BAC@AD@@@BB@@B@FC@BB@@@B@BAB@@@B@@@@BB@@@BBBA@BBA@@DABBBBBAFABB@@BBB@BABBBBBA@@@@@@B@@@@A@A@@@AA@@AAA@@@A@@A@@@@A@@@@AC@CC@B@AA@AAAB@@AA@@@@A@@BA@@@AAB@@A@@@ABEEBA@AA@A@CCABAA@@EBA@AAB@A@HCA@A@ABA@ABAA@AA@A@@@A@@@@A@@@@@@A@@A@@@@@@C@@@@@@@A@A@AB@A@@AA@AABCB@A@@@BCC@@@@@AABA@@AAB@AAB@@A@@@A@B@A@@@@AA@A@A@@@@@@@A@C@A@@@@@@@@@@BA@@@@@@@A@@@BD@@ABB@A@@@AB@@@B@@@B@@@@B@@@@@@B@@@@@B@@@@A@@@A@ABCB@AEDA@@@@@@@A@@@@@CBCBAAAAEDB@AB@@ABCBAB@@B@@BA@@@@A@@ABA@B@@@@B@@CB@@@@@@@@@@@B@@@@@B@@@@AB@@A@@@B@AB@B@@@@@@@@@BC@BBCBB@ABA@@@AB@@CD@@@@@@AB@@@@ABCE@A@@DAHIDCFE@EBC@EDEBE@AIBAA@@CAGOECBCFAEGCEDEFCDE@@@ID@@@@CAK@CBG@EDE@@@AIABACABAGAABC@AEIFC@C@I@C@AD@B@B@@C@@AEDBDCBBHFJ@BQBGBA@@@E@CB@@CHDF@BABCBABADEFA@@B@BCD@@AFGHBFGD@@@@B@@@@@@BB@BD@@@F@DBB@@@BA@@DEFAB@B@B@DCDB@BB@BCBCDA@@BABA@A@@@GH@B@@B@@BB@@BD@@@@@@B@@C@@@AB@@BBABBDE@AF"],["@@GBAbOW}"],["@@@DAV@d@B@BATC@C@AAC@EDC@@BBB@B@@ABABCBAA@@BDABA@ABBB@BADEB@AC@CBADCBABDB@BBD@B@BBDB@D@@B@@DBFAD@F@D@F@B@@@BBD@DBB@FBBBB@BJBJBLDNDRS@m@IAGBF`DPP|RbÐH`Z®bÎfÔ`ÂNR~NNt^ÖN¬JVÄLLNNP¨P¨@DR¬P¬\\üG\\YDj@XBZFTBP@F@@@BBB@FBFBDDDDDDFBDBFBDBHBBBBDDBDBDLFDDFFBD@VBDBTDDJJ@BBDFHDH@@AB@J@H@L@BBDBD@DABCHGJ@@BB@BFBBBBBBDDD@D@B@DCHBLBHBJDPBLDTBFBNDLBJ@FBDBLRT¦LvPL~X®rĪBNT¦^ÆHd@BDXN|DZJtLrF\\DVF\\BFǎÝÌwºoa[ǾLv@vBÎBȌJŐF@AACEAE@CAEAG@GG_AKGW@A@C@ABCB@@A@CBGDEBCHI@@JOHIFIHIFGHEFIBCBEDE@E@GAGAEAECCAGCGCEECEGCCCEAECIBIFGFI@MFWHKHODM@GAE@IAIAEAI@GAGCEAMGICMCI@GBI@EBIDGDGBE@E@E@C@ACECGCMAODKFGFEDEFIFGFMDGBEAGBIBIAM@MCQ@KCK@GCGCICECGCEGEEGCECIBIBGDE@GAICGEIAICICGCG@GBOAM@IBKAIAMAMBKAG@KCIAEEIEEGEKEOEKEGECIEIEIAKCK@K@G@IBI@IDEDKFGHIFGPQLKNGNGNERENENEREPEJCHEFCHCLEFGBE@EBG@KAKCYCOAOAQAIAK@K@I@KBKBIBMBM@K@GAK@GAG@IAGACCGCGEKEGEEGICGCE@GAG@I@I@GDK@KBGAIAKEKGGGMGIGICGCE@SEKGKEMIOEKEIEIEMEICKCIAICKAMAM@GAICIMOMIOKQKMGMIKKIIEGCGCGGGEGECGEKEIGGCEAKCIAOCKAIAC@GAM@OAOCIEIGGEGECEACAKEGICGCGCCCGAKAWGKGMIKEECIEGEGEKGGKCO@KAO@KAOAGAGAGCEAGEG@KFKDMFI@KAEAGCICGGEGCECKEKCCGCKEKCAAAACACCCA@CA@AAAEEGIEKEECIEEEEIEICGCICGEICEAIAG@I@IDOBECEGAECACAC@G@IBEBIBIAEKFIBGBEDC@CAEG@EBUHEACGDEJGBC@C@CAEEEMGGGGGAIEICCEGEGGGIGIIEEAE@E@EAAEAEAG@GDIBOCKCEEEE@EAEG@EAE@I@GCAGFMAEECE@GAMCICDMFIFGAI@ACEACAC@CAEKCIAIAEEDGJEPIJEFEDE@CACGAGDGBGDC@C@KCCCECECGEGGCEEG@GBGDECGCAAAI@OBEIBIGEKBKBIAICIGICKEEEIEOGEIDIFEDGBEAEECGAGAGAGEGGCGAC@ECGCGAGAEEEMAI@MBIAIEGCCCCCACCCCEGGGGGCGACAEAA@CE@EAE@EACEEIE@GHIBEDGBEACGGGGGCAAIIGMWQUCOAEAKUIKGCKDIFGBWIYCIIOKG@QIA@AAEMGQEEKCIAGEUI@AGIEI@AWWGCAAAAEGMSCMKO@OFK@ADMBECEAC[eCCECAA[KEGI_IISCGKEOA@AEQS@@AAA@EA@@@A@@@CA@C@A@@BA@A@EB@@A@A@BB@@@@@@AB@@ABA@A@A@@A@@A@@@@@AA@@AB@@@@@BC@@@@@@A@AABAA@@A@EB@@@@@B@@A@@@B@@BA@@@A@@@@@A@@@@AA@@@@AA@@@B@@AA@@A@@@AC@BBA@@B@@@@GBAAB@@@C@@@@BA@@@A@@BA@AAAB@@EBCB@B@@A@@@@@@BABB@CBBDA@BB@B@@BBC@BDB@@ADBCDB@@@B@@DA@@D@@@@AB@@BBBDBD@@BD@B@B@@C@ABABGBBD@A@BBA@BB@B@CB@@CB@@@@A@@BA@A@CBA@@BK@@DE@@ACA@AEA@CG@AGACAMABCGBABA@@B@@AJA@C@@AGBA@CB@D@DCPAD@D@@@@EH@@@@@@A@@BACG@@@CB@@@@@@@@@@A@B@A@@@@AA@@@AA@@@@AB@@CA@@A@@@A@@@@@A@AAAAAB@AA@@@CAAAA@CB@D@AA@A@AA@@AAAD@ACA@@ABAA@AA@@A@@C@@AC@@AA@@A@@@@@@@ABAAAAABA@AA@@AAEBABAAAACB@@AB@AABAA@@A@@A@@A@@@A@ABA@@@A@@AEDA@@@AA@@C@@BBD@A@B@@@BA@@@@@@BA@B@A@@@@BCBA@ABDD@B@@@@@BABB@CDA@BBA@AB@@BDED@@BDABCBA@DB@BABIDCD@@DFHFAB@@ABDF@@BBABB@ADDHABEB@@A@@@@@ABA@@@@BDAB@B@DAB@@ABAD@BDB@DBAB@@@BCBB@A@BDA@@@ABABB@@@B@JEBB@@@@@B@@@@@@@BB@AD@@ABB@ED@@@@BB@BCC@B@A@BAAED@B@@@@FHGD@@IDCDABCCCBA@A@BDBBB@B@B@DA@@BBAB@@ABBBBB@@FA@@B@@@FC@@DA@@BDAB@@BD@@ACBABBDA@@@@B@BA@@B@BAAA@@AACC@@@@@AA@AAAADABABDDCB@@@DBBDBBBBDDBBBABA@B@@@@ABBB@BB@AB@B@@@@A@BB@@B@@@@@@@@@@B@@A@@@@@A@A@@@A@@@@@@BBBB@B@@AB@@@B@@@BADDF@BBEB@BA@@@AB@@EBCBBBDHBDHL@@@B@AABB@EBBDBDFCJCFA@@@A@@@@BDBD@FB@A@BFBFBDDAHAB@AEACAEAAACC@ACB@HAF@FAB@D@D@D@@@REDAJADHBFJXDJBHDHDJ@DJXFRFLJ^DFFRHTJXX~DPLZJ^FNBJG@sDaBFH}DiDWBC@JeDQFUBIFSFODIA@ICGEE@KGEEA@EG@@@AAEAAAAAAACB@CCEGAACCAAACAABAB@@E@EAC@C@@AAAACQAIAABCBI@AAEAGACAEAIB@BCBADCBADC@@DABC@CDC@C@CBCBC@E@CKEECA@EGAAAM@CAIAAAG@@B@BA@@BBJFFBDCDAA@A@ABAAA@A@@@AA@@@A@@@AE@B@@AACA@@ABA@@BQBSBCELGA@C@@C@@ACB@AE@A@IACCCBC@AA@ACBDDCBEBGA@EC@BIAAGNSBAGFAFAAIEBCBE@EMCBCDEFB@CB@@CDCBABABEDCEEBCE@AAA@EGKIGOEGK@CAEAAMIAGAK@@AKE_CCAGDA@@KCG@W@»@¡@kB"],["@@KCMCEEEIAAMMCAAAECGCEEGWAAAA@DAFADBHAB@@C@AA@BC@@@A@AAE@@@AHA@A@@@AB@@CD@@PA@@@B@B@@@BBBA@@@B@@BDA@@B@@@D@D@D@@A@@@@@@B@@@B@@A@@BB@BBDB@FDNH@FDBDABBDD@B@BDDBBBBFDDFF@TCDA"],["@@DFBA@@@@BA@@@@@@DC@@BA@@B@BAA@DAAAD@@A@@@@@@@@@ABAA@@@B@@@BA@@@@@A@@@@@A@@@@@@@@@@DA@@@A@@A@B@BA@@@B@@B@@AA@@@BADABA@@BAA@FCBBBBDADA@@@@B@@@@@@@B@FC@BDABAB@@@B@@@@@@A@@@@@A@@@@A@@@@@@A@@@A@@BA@@B@A@BA@@AC@@B@@@@@@@B@@A@@@@@@@@B@D@B@@@@@@@B@B@B@@B@@B@A@B@@@B@@@BA@BBA@BB@BA@B@@@@DD@A@@@BDABA@BBB@@@BBAB@B@@@@@@@D@@@@@@@@BB@@@@@@@@B@@B@@@B@B@@BBBBAB@BAB@B@GDB@A@BBB@FA@@BBBADDB@B@@BABFFBA@@B@@@BA@B@@AB@@@B@@B@@BA@BB@BBBA@D@@DBD@@@@@B@@B@@@@B@@BB@BB@@B@@@B@BBB@@BD@@@DB@@@BBB@AB@BB@BDC@BB@BB@@B@BBBC@A@@DBBBB@D@@BBA@BBBB@B@B@@@@@B@@@BB@@DA@@B@@B@@B@@BB@@@@@B@A@B@@@@@@@@A@@D@@DHAB@@@B@@@@G@@F@@BLBH@D@@BJDA@BB@BB@BBB@@@BBB@D@@ABABABCB@@A@C@AB@@@@@@@B@@ABB@A@@B@@@B@B@@@BB@DAB@B@@AB@@@@@DA@@DAA@A@@AAB@A@BACHABABAD@@@@A@AAC@@ACACAA@@BA@@@@@CB@@CA@@@A@DCCA@BA@ACD@AA@@@AAAB@ACDAA@BA@A@@@@B@@@@ADAFA@@BABBB@@AB@@@B@@A@@D@@@A@BBHA@@@@@AB@AAD@@B@@@BB@@BA@@@B@@B@@B@@B@@B@@@@@B@@@B@@AA@@@B@@@@A@@@@FAB@@@BBBA@B@B@@@@D@@A@@@@BA@@BB@@@@B@@@@BB@B@B@BA@@BA@@@@@@AAB@B@@@FAB@B@@AB@D@B@@D@@@B@@FBB@BBCEAGBIDI@AHSDIIQACOO@KAA@ABADIAICBSDE@CEECAAAACC@A@ACCAACBMD@C@AEAC@AG@AHAECA@AC@AAA@@@BA@@@A@@@@@@@@BC@C@C@@@A@@@CB@AA@@@B@AA@A@@@A@A@@OB@@DC@@BA@@B@B@BG@@F@BBB@@@D@@ABBD@@@BAAGBCBE@C@A@@@@AAAECC@@CGCKAKCOBIDY@E@M@@@EAG@@@@@A@@EMACAGGSCEEIUKCK@ABAAAAKAQGKCCIKIIEI@E@ADE@@DI@AAQCKAAAEAGDIDS@QDI@@HYRIDA@@F@DCNGJKBAHMFGDUAM@ACAAACAAA@@AA@@@@MGKEMCUECACA@@MEOMACOSKAOCG@oaC@A@EAEAKCA@@@KCEEIGKWESCCIIQCOB@@@@EBA@OH@BI\\Cn@BABABELA@IJSJ_Bq@KBA@EBA@ETDTAJAFCFKPKJkFKAKHEDIAyOQQKGIBGJQBEDMB@CSDANQNKDE@@BMBCBCFEPOJQJKFK@I@MDGpcJQDMEQEGBMNQBKEEKE]CGCG[EKI_EYIOWD]KI@MFCBCDGFQBG@YOQAKFQLQDcMEAuHQIYGwFKK_SEEIAMGGGCYAiAIcYMADFCDAFEF@DCNGHELEHELIJKHGJKHKJEJGJEPQXELELIHKLMJIHIHOFILIJCJCLELEHAJAL@H@NBJ@HBPFfFPFhBJDP@LBLFNJPLNJHJHFLBBDBRDJD@HETCLG\\GbALAL@FDJHNDJFLFJHHPTNTLPPLLLDJFNBJDLDFPPPNJPXNJFHHRPJLVLLFJDZJFFDH@B@@BBBDFDDBDDPPRZHP@D@DBFBFpOYVGPINGlKApAnAxAbCv@BAjlA¢@¼@X@H@LD@@CBBHDDF`BL@@BLBHNJBBBF@DHLPFJHHL@FBB@BDFFADFFCBABADADC@@DAA@@@ACAG@C@AAC@C@A@AHEDCFGFK@EAE@C@A@GBEF@ACBAAA@@C@BG@@@@@AHG@@@@BCBABBB@DCDA@AAAA@DC@C@A@ABAFE@CB@@A@@AA@C@E@@ACA@@A@@@@A@@@@@HCAEHGBE@@DC@A@AB@FEBCBADABA@ACEDG@@DAF@@@B@HARA@AEIAGDAACFC@BD@@@@A@ABCD@J@D@D@JEBFD@BAHBABDBABJB@B@@CF@FAH@DBL@D@@C@@J@@CFEDCFDFFHEBADFDHPDB@@BBJA@BAFCF@FAD@FEFCDGJCB@@@B"]],encodeOffsets:[[[81491,38237]],[[81739,38438]],[[81273,38208]],[[81722,38194]],[[81566,38225]]]}},{type:"Feature",id:"653221",properties:{name:"和田县",cp:[79.81907,37.120031],childNum:1},geometry:{type:"Polygon",coordinates:["@@aGS@WNWLI@ODMAQASAU@OCQCIGGGMKOGQEWCYB_DMJKAQDMNMJINODSEUCSEUKUCWIUQACA@GBA@CB@BAFCDADBD@BABAB@@E@A@E@CBC@C@A@CCCACAGAGAAACA@C@A@CBCACA@E@E@EACCGCQGA@BCFEBCBAB@DCBEDABC@EBC@ADCBC@AAAAAAAB@DCDADAB@@CBCBC@ADCDADCBA@ABAD@BABADCBABAIGIScJKDcRQDKJCBOHABIPETMLKAICKEOOMIMGGEIAACUEOBSLGLGPFZBFMXCBQJIFIBYDODGDAFCBABABSNABCBUJA@ODC@EBIAOAIBGBGBOLCDMJFFFFHFBDDDDB@DDF@D@BALABKHA@gJOJA@ABIHAB@BCJ@B@DCREDYFKDWFCBC@A@MDABCBA@WDCBC@Q@C@A@]AA@C@MESKKKKSIKEAKBQHA@EF@BCLBBCNB\\@RANABAB@BIJADEFCDEDA@ABMBM@CBC@WBA@C@M@G@MBICQGSEGCGCEECICOMIIEE@GBCH@BCbADBDAVBF@FGNADIDCB]JQFA@ABY@iACAC@QBAAC@SDABCBIDKDCBC@SDeJGBKFIJCBABIJ@DAF@LCJBLDLBB@DHJFHBF@F@D@BANCFEH@DABELABKHG@SDOD_DK@GDGJCJ@TBTDJHPRNNNBFBNBL@F@P@N@LBXDjDz@P@BOHQDSBC@EFABBHEXCLOJULAL@LFDNJJHHHFLBJALCLEHMHOFKBMBEBOFSVivABMPDPNJPHPTHNMjY`MDmGoG[PCNACvCJKT_^SNSXER@TDbDNBfDvEIT@B@HBBFDHBLFLHNFNJJNFPJFFCZARBLCRCZBXHXHVFf@vDTJHRBXNXADDFBFDBFDFF@H@FAJCDOLCHHPNZBL@NCH@FFJ@D@FDTDPBHDTHTDL@BCNALBHDDDFHLDH@H@L@D@RBFBFDFLDLBNJFFHDT@LAJAJABDFFIFE@EFCDBFDDFDDDFJDJFFFDJBLBVH`PNFF@DBLARBLBVHLDDF@DEJBJBDDBHBF@R@PBREDAF@B@NDJBD@FAN@DAF@FBFBD@D@FAJAHEFAJ@FBFBDBFDNDDBFFBDD@FBDAJADBHDDFBHCH@BDFDHDFBDJJFBJDD@FBFBFDXVFJDDJJHFHFJDNBVHB@B@FCLAB@DAD@@BBHBBFBJDNDJDJBBBDJDD@BAD@B@@BBDDDBD@@B@F@FHLFDFDD@B@JADAD@FBLAB@DDDBDBD@FBN@D@@FJJB@B@LAJAJ@J@FAXFHAHADBPFLBHBLBH@JDBBAHDFFBJBBBDBJV@F@JABABA@ABABCFCD@BAFAB@@ABAHAFCD@DEBCDCDCDCB@BABABCBEDABGFEDC@A@A@E@A@C@GDCBCB@BGFCBABABCBADCBCDEDABE@ABODABCDAF@B@BAD@DCFABCDGFADA@ADAD@B@D@F@@GDMDMFKB[JE@DBLHJFDHAHCZCL@D@F@BHHHFDFHFFDHDBDDF@BBD@F@BABBF@@@BDFDFFHBDDDBBHHFLBDBFFD@BBFBDDFDBBBFF@@BD@DBB@@DF@BDHBBDBBB@@DDDJ@D@FDHDFB@J@FBFBHDRHDDBB@RAPC`@P@HAHCHEDCBEDCBGHEHAHAHCHKTAJ@HBJ@FBDDBFDBFADAFCJ@H@JDFFFHFJBFDLBHBBDDFFHFDHBBBRDBDDPFJFBDAPELGHBFBNFFBDHFHJLFDLHHBDBFB@@LJJJLLLLHHLJHLDH@H@NBF@NDFHPFJJJDDFJ@JBH@N@NBFFDLBLDTDNDNHRFNFRJNHNJLFRDPDLFHHDLLLBNJHHJLFNL@HAHBDJHV^BF@RQlRCDBXXHBNCLKNGXGdDBBNBdZBJBjDZHHNHJBFF`TLLxEZHRJvGFBdNRCRKLERBZPH@RAHEDCDANEJ@^LXCJPFZJ`FLH\\HD^DLFFFALMRANFHFRCNIRodCH@N@JELILIROPEFADADAN@@CFMLMRCBDTA@CNAFIRAHHJRLPRzJBFCLGLBlELILODEBEBICSFSB@FAB@LAr@`ATIJIB@FKBABA@ADmJ[@APGB@FA@@@@PARDJJDDFTLXJHFFLD@@B@LDFBFBB@D@pbH@PDLBPTBDPNNF@@DBDBVFNDLFNH@@@@BB@@BBDBBBDB@BBNCVEHGNABILMHCDE@@@CBQJGZ@@CJ@RCTCJBHBFBBDLBR@BCJ@@CF@B@FFJJJJLDDHLBRBLBBAB@BDLVLFJDFHTBHBDFN@@@B@@@@BH@F@@@N@FCZAJDPBLDLDH@@DDBFBB@@@@@BBBBBHXFFHDFDBBDBNNBBFJFFNDLDBJCJAB@BBB@LPPBDJRCJGT@BCJAJBHDF@@RTBFB@FPHLTDJJJ`FH\\LBBFDDD\\fBDDFAFCN@BEL@PLPDNNTFHBBBBHDXX@BFJHJ@BVJHFJBLDFFHRFNBBB@RJH@PLJJZDXJHAJELCHDJLLVFBPBVDXRHNJJBBHDHHHHBDAFCHAFGJ@HJFFFBD@FBF@FDFB@FBDBHBHDHHHHDFDDBDDDDDHDJFJBNAJ@NBFFBFBHDHDH@FBDDHHHHFHBHBHBFDBFAFCHEFCJFJPHJFFFLFJDJHJDJBLALAHFAJFJPAJ@BBDBDHCFAH@HFHDFHHHFFDFDDDLDD@D@HCHAHCHBBD@DCFEFIFOJIFCHFFJBJBLDBF@DBDBDDF@BBJEHEJCNJDNDHBF@FDBFENBHHDJ@F@FBH@BF@FFFFFLDPDJAHCH@FBFBBB@F@FBFFFJJJHHHFHFHDDFJBJHHHHNHFFBF@D@DADIHCFDHFBVGFAH@BF@DCDAFAHEJFLJBJAFAJAH@D@DBDBBFFHFDPAJCJ@H@JBFBJDHFJDHDJDJFFFFFDJFFFLHJFFBB@BDBB@BCBCBAHEDCLCDADAFABC@CAI@AACGAEEAAAA@AAE@C@AACACGEAAECGEECEEEAEAGAACAG@A@AAC@A@GAE@C@AAAGCCAKBCBC@ABCF@BCBA@AAC@A@AA@AECAAAC@ACMDIFCJCH@FDDABAFGECKEUAICQCOAOECECE@GFCLCLCHAFEDGBGCCI@MAKCGCGG@CFENIFE@IAGAGGGEICEBGDAHALCDCBCACWMMCICGAGEDEJOHKDEFILUHQL[CICMKmKoKiEUMsCSE[E_EUEYCMAGEUGCSIYKGCKEy[[MYK_OmUKEUKoUKEaOCAICCAaQGCAEA[MECUIYKgSICYKGCUKICkUWK]MaMEECI@EAA@CAAAAA@AAKCG@M@IAGEGIIEGCEIICICI@@CMAGOWI[GMEMGMEGKIGGCE@CDE@IAEA@@@BABA@ABA@AA@@@EAKAM@ABAAIIAA@@CA@@AA@@@AAAAA@@CA@@EAAAAA@AAAAG@AAA@CA@@@A@AACCA@A@@@A@A@@@@B@@A@@@@B@@ABA@@@@AA@ABA@C@@@@@@A@A@@AA@@A@@@BB@@@@AB@@@@@B@@C@A@AAA@@BA@@B@BA@@@BDA@@@@@@B@@@@A@@A@AA@@A@@A@@AA@@AA@AAAA@A@A@@@AJMDG@A@@@CAAAA@A@AB@@@@A@@B@@ADA@C@@A@AAAB@A@@@@@A@@@@B@@@AABA@@@@AAA@@A@@@A@A@E@@A@@A@AA@@AA@@@@BA@@@@@@@@ABA@@@A@@A@BAA@BA@@A@@@A@@@@A@A@@CB@@@BA@@@@@@@@A@@A@@@@@@A@@@@@@@@@AA@@AA@@@@@A@A@@A@@@@@@A@@A@A@@AB@@@@@@@A@@@@@A@@@AA@@@@A@@@@A@@@@A@@AA@@AABA@@@@@A@AB@@A@@@@@@B@B@@A@A@@B@BA@@B@@@@AB@@@B@@A@@A@@@AA@@@@AB@@@@AA@@BA@A@A@A@@AA@@@AB@@@@@@A@@C@@A@@BA@@B@@@B@@@B@@@@@@A@@A@AA@@@A@@BA@@@ABE@@AA@@BA@@D@@@@@@A@@AAA@@@BA@AAABA@AA@@A@@A@@AA@@AA@@A@@BAA@@A@@AA@@AEAA@@@I@EBC@A@ABAB@@@B@@AA@@A@@@@@@@@@A@A@@@@AC@@@@@@B@@AB@@@BC@A@@@AB@@C@@AAA@@@@A@@A@@@@@AA@@CCCCAA@@AA@AAA@@@A@@BA@A@A@@@C@@AA@@@A@@A@@A@@AC@@@AAA@C@A@A@CBCBC@CBCDGDE@@@@@@BABCBC@G@A@G@CCSFALCTEFA`IVGJEBABAD@DALQDCFIBC@A@EAC@AC@CAAAC@CAEAA@AAAACAACCCAA@AAC@A@C@C@ACU@GAK@C@A@ABAHKBC@A@E@AA@@@@CBC@@BA@A@AAAAC@AAABA@A@AB@@ADAB@@AB@DCBAFCDELKDCBADCJIBCLGDCJEFCHIDABADCFE@CDCFCDAFEB@BAB@FCDCBADADCBCBC@@D@D@PBD@J@L@D@BAB@H@F@DAF@HAD@D@D@DAF@H@D@FAB@HAF@F@DAFABAFABAB@FAJ@D@DCFAB@HEJCDAD@DAB@BCDABABA@C@EBEDADIBALIFEDCNKDAFEFEBA@C@C@GAG@CAAEMAAACACCEAC@CGMCCCC@AAACC@A@AB@FCB@@ABCB@BADAF@DABAJCFEFC@AFIBA@ABA@ADCDCDC@@BE@E@CFGFE@AB@BABA@A@CDC@@JABABCBABA@AFA@CBADA@@BADA@ABA@C@@BAD@FCBABA@ADA@ABA@@FC@AB@DCBA@A@@@A@CAADADA@ABAHCB@@@DA@@B@BA@AFE@@BCB@DADCBA@ABA@@BABADCDABA@ABABCBABCBEDABAFADABA@@AC@CAA@A@C@AACACAA@@@A@ABABCBAD@BABA@C@AAE@A@ABC@C@C@C@GB@BABA@ABA@E@AAA@A@ABA@A@CDCBAB@BAB@DADAB@DABADCB@B@DBF@DBBFBBB@D@D@B@F@@@DBDDBBB@B@BBBBADB@@BBAB@@@D@B@BAB@@@BADA@@BABAB@@ABABADCBCBABA@@BCBEBA@A@A@A@A@@B@DC@@BA@CBG@@BA@A@@AAA@A@@A@ABC@@@A@@CAAAA@@AAA@A@A@@@A@CB@B@DCB@BABABCBA@A@@BABABCBABACAEAAAEAAAC@A@AA@@CAAA@A@@@AB@BAB@@ABABEBC@@D@@ABAACACAA@C@@AA@A@CBA@A@@BCDABC@A@A@A@C@A@ABABC@A@@AACAA@CAA@@AAA@CBABADC@@DC@A@ABABC@ABABAB@@C@C@C@CEAAAA@ECCAGAC@EBKCAAA@@AAA@ABGBC@ABC@G@A@AAEAA@@AACCAAKEGEC@CCCCAC@AAC@AECAA@CAC@AAA@A@C@A@AAAAAAACECCCAACCAACAABE@A@AA@@A@A@A@CAAAAEAA@E@CAA@A@AAAAEC@A@A@AAAAACAAAEAEAC@A@CBAA@@AA@AAEAAGACAACAAA@@@@@AAAAAAAA@CAACG@AACAACA@AAA@@AA@A@@CAA@ACAA@AICAAGCA@GCECCCA@A@ABA@@AA@@AAC@A@@AACAA@EAA@CCAAA@AAC@AA@AEC@A@ACA@A@A@@C@ECA@@C@A@A@AC@@AAACEAABEBCFCBA@C@ABA@A@C@AAA@A@@BADCDCBA@@@A@A@C@A@EAC@ACE@A@A@A@@BE@CACAAACCCAA@@@A@ABAB@B@B@AA@A@EAA@A@AAIAAA@CC@@CAA@AACE@@FC@AB@@AAC@A@A@AAEACAA@ABA@CBC@C@AAC@A@C@A@A@A@A@C@A@@BC@C@A@C@C@E@A@A@AACAAA@AAAAAAAACAA@C@@AAA@CAC@C@ABC@C@C@@BABADCBABA@A@A@@BA@C@A@A@ABABC@A@CAAACAE@ABA@A@A@A@E@ACCACEEAA@A@@BABABC@A@C@ADEB@BCDCBE@@@CCEACGGEEIAEAGEKSCG@EBABA@GBG@AFYFGFEJEHELGDCFCDC@AKICAAC@EACBGBGDGFGDADC@EAI@EAAEACBMDA@IIKEMEoYUEA@BGHENKJQPQJOFI@A@G@CCGEEAGAG@CCGGMIICCGAIA@C@EDAFCBA@CBGBIBIFED@H@T@HCVOPQJGHCHAJENGLGLIXgHGDEBMBAHEDE@E@G@EBGFIBK@E@MBIFGRKHCFGDGDUFOJOJMPSHGJ@DFDBD@FCFCJBHFHHLHFDD@DCLMDID@H@NDJAF@JEHIBEEGAE@EDCPELELAD@F@DDAF@DD@B@TEDCBOFKNKLCNAJEHAD@D@J@FE@ADAD@LFF@FCDADGDEHIDC@G@MAMD@FCBEFILKD@ECMGECCC@EAAGAAA@CHI@CA@CAGAGAMSIEIGEKAA@@ACAA@ACCAAAACCEAC@AAA@A@E@G@C@E@G@C@CAA@G@A@G@C@E@C@A@OBA@C@ICC@CAECA@AAEAAA@A@ABEAACE@CAAAACEECAAAAEEEE@AACAIACAA@C@E@C@C@AAAAAAAACAA@E@A@CBC@C@A@A@AACA@ACAC@C@A@IACCAAAA@AAC@@A@ABADADABABADCBABCFCFGHEDABABADCBA@@BCDCHEFCFAHCDAFEFIBCHELIBC@MBM@AB@FAD@DAFELEHCHCLEB@@AGKAA@@CDEBMBIAM@OAECK@OFYHWBC@BCAEAACAO@GBM@IBCAACAE@GCGGIGAQEYGAA@CFKAG@EDAHAHCDCDE@AAC@CCACBE@AAAEACCACCGAGIGQCCUC{sE@A@CBC@I@E@GDODYLE@CAUSECICCACC@@IOGKAAA@@A@@SI@A@ABCDEPKDC@A@ACKESAC@@A@A@CBAACAAA@A@AAA@A@ADGDEDAHAFADKACE@K@EAA@@AACACECAABCBEBI@M@CBEDCHCFAH@BABE@EACCE@ECGGA@CFQBEAECA@ABCBA@A@@CAE@[@EAWKAG@A@@AAACACAEAI@C@GDS@A@AACAAA@AAA@A@CDCBC@CBCAC@A@A@GBIBE@CBGBA@GAE@GCCAK@CBM@DC@CAA@@E@GCCC@CDABE@EP[DEHAFCDCCEECCI@IBABA@CCCIEEIGI@@@GACAAA@@ABA@A@C@EIACCAE@GGIEE@C@C@C@@C@AAECCCAGACGI@C@CDE@CBMBSAKHWBKBAA@WEIAMKaEGKHORMVOBM@QIKM@GIIQIUEOCUKSOWDKHGLKNKAOOIIIMIKGSWKIMGWS[EaA[AU@UEcJQJSEGIKQ][UCeCgDWNSNWEWEU@WLENIHKFQGCGIWOKOESNS@UCkEUAaASEWEWIYI"],encodeOffsets:[[81295,35259]]}},{type:"Feature",id:"653223",properties:{name:"皮山县",cp:[78.283669,37.62145],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@v\\XNrr¢trbRhTHFVJLFLDLBTDR@H@H@nCJAJEB@B@F@FBJDNDZHNFPDHBDBBBFJBF@BBBDDB@DBB@DAB@B@JCF@HAF@D@D@D@FAFAJEDABAHCD@FANAHAD@F@B@B@DADCHCFADAVCBAD@D@LBD@D@F@B@F@FANA@@XAD@HCHCFCFENEPGHAHAJAF@B@D@@EAOESAY@WCiZH[[ûO«Q«@CO§O§MMKKUÃIM«]ÕMsMQ}M_ÁeÓaÍY­G_aÏQO{COE_A©AHù@C@]BkD£EYNGMoLmNaAcA[AA@iCÛUX©DcjNPbZœ°ïfmuquu}IKqABPKBCEB@@ABA@AB@AC@@EBEI@@UD@B@@AAA@@@E@KB@C@@A@AF@DA^BD@D@@CFF\\Dz@PyHéFh¼BHN\\VnBDVrPdHNHTDHFLDJJRHNDA@B@A@AB@DBHAF@D@BBD@B@ACDAD@@ABAAAA@BC@@FCCCDABD@BBDBAB@@BBBBFADAB@FA@EB@DGFF@FA@DDBBFCBAD@BBDADA@BFB@BBHAAIDC@AA@BCAABADAACAAVADDBABAN@¨A̾ࣨ"],["@@FdHAAEAE@C@CGOQYOOCCCAECACAA@@@ACGEEYIICKEUKIKQOGGIEWMIOOMOOCECKAIEMCIKKOKKOMSOSGGEIEKCIGMCI@EBKBKHaH[DKFS@GICQCCAAAEKIGIGKMIOEMAK@KCOAIEgEOEeAO@GAI@M@GBKBIFGFKDKDIJIJKPEJGJGNILKJGFKFKRWFOHIFILILGHILGJIFKFGFKHGDM@CFEBEDCCEAAcCWHMHKLMDGAWWCAQDRk@QAEU]IGACBG@GMKKEGIIGAMKKCKGGKEOCQCKEMIMGQIMEQEMGMCSCKCKAECAE@M@MAG@IEICCIIEIGOCE@MAE@M@GCGGKKIGGKKKKIIKI@@EACAGAKGECIKEGCGEAMEEAGAKHOFCBEAEICOACQCAAGAECEGCEACGAKAECIAGEEECE@I@GDIBEBCAEECCAAC@EAI@GBILSDGBGBGFGHGDAFCDAFCDGBG@G@OD_BO@QAACCQGGCEAEAI@A@CECG@E@CCICC@@AACAAACG@ACE@@AA@CAC@@EEAACACEACAE@AECAEACEKGGAACCACEGCECE@A@@AEBA@A@EAC@ACEACGCECGECEGEGG@A@E@CDKDYBGCGIEKGCAF@\\ILANENCHC@@@E@C@ABCBCB@BCHEDCBADE@CBC@A@ABEDCBAPCBAF@BAFCDCDABCDABABADAHE@ADADAHCD@B@F@B@B@D@FCHEBAFCDABABA@ADADCDCDCFA@CDCBEBGBA@@BABE@ADCDEBABAB@BABA@I@EIUCAAAIAEACEBGAAICG@KAGAKAOECAGBGBWEEBI@I@IBKBA@A@II@EC@M@EAC@CACACCA@KBEAC@CBIBA@C@ECECGK@E@E@AC@CACCAA@@@ABC@ACCCIAAIAICMCICEAAAAG@AC@CBA@KBEDA@A@UGMAICGEGEIICCEIWUECEAEAC@ICEAIIACCECGCE@ADGAGCEGCCAIBCBEAC@ACEECAMCECCAEAEAI@EBGFIBEBC@C@EAEAE@CBM@EBC@IAMCA@E@CBQFOAQ@E@GACAACAIFI@CCEKCUGKAQAKBCAE@ME_OUGKAIAECEECIEICCECCCAEDCFEF@JEEEACIBIBKBS@GCEEMIKAKCCEAEAE@Q@C@K@GCGGKCECCAGBKDM@ACKGSCSAGCOCS@E@CEI@EDG@MAKMYGODGPKDCBI@E@GEEECCAAECEBCMWAWGQSIuCe@UEWGWGYAQDKDQAYBED@BXRHJFHFBHFLHPDJHHFDLBJ@NBLFNJLLJLPLNHDGBC@C@GBQ@M@I@MBOAQA[EGCE@S@ABI@CGEMCMKKKBKJGJCBMTAB@BOAGCIAU@Q@IGGGMAM@SHOJOJIBGEB_GSMAQHKHMLUZIJM@MOURQHONONIJAD@BBB@@BBB@@BB@B@@@B@@@@@@B@@AFAJ@D@@@@AB@@ABA@ABA@A@@@AAA@A@A@C@CAA@EEA@AAA@@@A@@@A@@BA@ADCJCFAFADCDCDABC@AB@@@@@@@BB@BBB@BD@BB@FBBBBB@BBB@@@BAB@BBBBB@@B@@BB@@B@@BDBFAB@B@DAB@B@BB@B@@@B@@BBBB@FDDBLFFBD@B@@B@@ADAB@@AD@B@B@B@DBB@@DBD@DBLFFFFBJBD@D@F@@@BB@@ABCD@B@B@DFJ@DBHAHAJAJAD@D@L@BABGBGBCBCDCDCD@D@DBBBBB@B@H@B@B@B@D@BDDBDFDDHHDFBB@B@BA@CDADAB@B@D@D@B@BABEDCBCF@BABGFCBABA@A@C@G@A@ABCDAB@B@B@DBBBD@BCFAD@B@DDH@HBFBBBBBBFBN@JBF@D@HABADBD@B@DB@B@J@B@BABCBEBA@ABADCDAD@@@D@BDH@DBDBB@BAB@@GF@@ADGFADA@ABC@AB@@@NDHDDB@BBB@B@B@JEDCF@@@B@BBDFDFBDBBB@F@JBD@D@NC@@BB@BBJ@J@F@D@DBB@HCLCFAHAD@DA@@BGBA@ABAJAD@D@B@FAD@DB@BB@BBB@B@BAD@@@B@B@@@FCHEJCDEFCBCBABABADCBCBADABBFADBD@@@@D@DADAB@BB@@B@B@F@D@F@JAB@@ABADAFAB@DADA@CBC@@B@BADB@@DBDD@B@BABBB@B@@B@LDBBDBB@H@BBB@DBBDD@FB@B@D@BBBBBDBBDDDHD@BB@A@@BE@ABEHAB@@BB@J@BBB@BBBFBB@@@@B@BABGDA@EH@BBB@B@BEF@B@@A@GAAAECCCA@E@CBA@AB@DABA@CBADABEBCDAD@BAB@BBBAD@D@@ABA@AD@@@BAD@@ADA@AF@B@DA@ABA@CBE@A@C@C@EAA@EBABC@@B@@@B@@ABADCDEFIDKDIDIFMFIDIFMHKBE@I@I@EBEDGBI@O@MDKDGFCJ@NAFMV@LBF@DAJ@FBJBLCHADFFDBBFDFFBHDB@D@H@JCRCF@D@FBFDF@B@NAD@BBAZ@NDDFFLBHBBFBFFJBHJFHHHDHBLDBBFFBFFHNLFBH@DANGJAF@F@DFJJLFJ@LDRBHDBDAJEDGDQHEDEFABAHGHUPEHGFBDAHCHGDKLQPKLGPEHKFGLWPC@K@EDEDIBqCEAKIGIEEEAIBIFC@GCECM@E@GDEDELCFGDGDOFSPGLGJGBIBIFG@GAEDADALADIFGHK@G@GDAFMLGDIH@HFFTXDH@LEFINEHKLGJCFQJOHE\\@JAFCFGHOJMLCFI@ECGCIBKFGBKDEFEHCDK@GCGB[HCFIRK@K@KAOCK@KBIBG@IAGBIBIHGFIFIHCDAF@DBLAHBFDDDFBF@DAFAH@HHBDBDADAJAFBFDL@REH@JBHBLJHFFBHBJAPCLAPLJDBFFHDFAFDBDHBH@HBHDFFBFBJHFJFHDFNBJ@N@PEJ@L@NBHBBFFL@D@@@BC@E@@AG@CBA@@BBB@D@F@F@@BBdND@NDNB\\@HDDBDFDHBZFFJ@F@HB@B@DAHIRGJGJGDIBI@IDSZCDE@IACBCDEHEFKFKFGDg^EBG@E@EDADEHIFEDA@GHEHAFCFIHMNGBKHEJAJANDHFJBLDHL`BHLFLDLLLV@H@BABIFCFCL@PDFDF@F@JG`@L@B@D@DLHHDNAJCLEFAH@NBNFNDP@T@R@P@DDDJJNDH@HDHJJLFVPHPBBBBDP@FBLBD@HDFFDFDBBAHCJMX@PAJBNFPDHHFHDHBDF@FCRRbHNBN@T_bCJBR@PGJKLGD@@ABAB@B@BFFRJBD@F@DAD@D@DAB@@@BCFABCD@@ADAD@B@BDF@B@BBB@B@B@BABABCBEBABCBAD@B@BAB@B@B@P@B@F@DDF@D@D@@AFADAD@@CDABABCDA@OHCBCBMDC@@BABABCBA@ABCFADCD@BCD@BCDA@@B@B@B@BBB@@DFFDFFHHDHBH@HAJDHHDNFRJJHBDBDAFEFCDADFHFDF@BBIFAJDHBNHNNXBJDTHRFLBDFNBJCJ@HDLBJ@HCNAJELAJDHFF@FENCXBPBHFH@FKNEJCL@HLDBF@HAJBJFFHFFBFARCF@D@JBJDZ\\@FFFHFLPHJRNHH@FELENAJFLHPVVfb`hx|rzrznx^bnvBBLPZ`RTVVXXjh`\\xt®¦®¤ZXFFPNRPª¨ LJjfVRTPLJJDªvÒfv\\LFhT|^z\\z\\x\\ÞbzZ̽ࣧ§BM@ABAB@@@tB@èċWGcI¥__I@@kTSSE@IMIA@CQAIDIGI@E@@BASI@EDCXAHD@BHABBFABBFBB@BBB@LDDFJANFNBNBFFJBFATDxj\\SbWRKTDC¡TCGMIQCIEKCGGSGMOcUqACUmM[AGg»êEzG@OCyE[DE@@@CACB]@CBEB@@@@DLAF@@@B@BB@@@AVC@@FJFA@@BDA@@BAB@BA@DFLAAOrBJLv~rvnveðŔ¯aYMOdiªCWÜVjDB@\\BdBbBnMpKSc®S"]],encodeOffsets:[[[80873,39582]],[[81154,38090]]]}},{type:"Feature",id:"653224",properties:{name:"洛浦县",cp:[80.188986,37.073667],childNum:1},geometry:{type:"Polygon",coordinates:["@@@D@B@BBBBBBBBD@BBB@D@D@DAB@B@BFDBD@BAD@BBB@@BB@B@@@BAB@@@@BD@BBDBF@@BB@@AB@@@B@BB@@@V@FBVLHB@B@BB@@BHF@@EBBBDHBBDFB@BF@BFLFP@@@@@@BBHH@@@@@@JPBHBF@@BBBBDD@D@BDBB@@BB@BDIBDN@BX@B@DBFBBBPDDDBBDDDD@DBBBH@J@B@D@BGDEBC@O@I@E@IA@B@@BD@BBB@@@BFH@BDB@B@BBBFDXBRBFBDBBBDFBD@J@JFBFDHDFDFBBBBBDBHFPLTHFFDFJHJH@ENCF@DELABADDcQCAAAM]IKCAOKi@_@@@BGHCBAFABX^HHDJBDDBDFFHBBBBJ`HHHTDFHH@BDB]@@@DJDJJJDFFHJJFHBH@J@NDHBL@BBBBBDBB@FBJ@FDNFb^NXLlVJDVLHDZLJDhTZLVJFD\\NFBDBRHbDBJDDBbPLFpVVLLFnV`PZL\\Nz\\LFHDZLTJHDFVBHDNFZFVF`F\\DTNtFVLjLpLnDNDJK\\GRKVEJCFGLIPCFHFHBJDNDXNBDADCDKDGBCBAHDFFJHHBHBH@JEFMJEF@DHHHDLDNBJ@DDAHCHEFGBKDKDED@HDFDFPFPBRDJDVBLFFDEHABCBECG@IDEDCJDN@BBDBBFD@BBBB@D@BBB@DA@ADEBAD@DALADBHDBB@B@DBF@H@BBD@B@BBHBDHBFBFBFFFDHFFDBBHFBDBD@B@DBF@BBBBBFFHBBD@BBJ@DADEBCBCBKDCDGFABADADDDBDBDBBDBFLDLDHLDLFFDHDHFDHDJBHBF@LEJCNEL@LFHBHDFBHBHBHBP@LBP@LDPHLLHHFHFJFFDLFNJLHXHLBHBDDHDHDJDFHBLBDDFHFHFJHJFPDPBN@HBD@JBLBPDJBLDFBHDJHLFHFFDFHHHDHDHFHJJLLNJNHRLPLNJNPDJBJ@HBNBNDLBJDJDLFJFNFJFJFLJPFNHLFL@TDFDHHJHJHNHHFLBLBJAH@LCL@H@J@JBH@HDFDHHJFFFHFLDHDHBDBH@JBH@HBL@H@LANANAJAL@L@J@LBLBJBRBPDPDZBL@LAH@FAFEHKFGDEDGFIDOFQFMFMFQFMFMHMHKLOREHGJEHCLCF@JAJ@J@H@LDLBLFJFJDJHFLFPFLFHFFFFJBFDJ@LBHALBNBNBJAL@JBNAP@HDHDHDJBJFJDHBJ@HCFAHAJDJDFFHHFDFDHDFDJDH@HDL@LDR@NBNAJAJBHAFCHENEHEJCFEFEHCLBPDNDHDF@B@D@F@FAFCHCHAJ@FAJ@HDJDNHJBNDFBH@HBJBFBJ@JBF@HCNGPGLEX@NEJEHAJDJBFDFDDFHFDDFDHBHDDBFBFBH@H@FCFAFADEJGFEHGJEJGJIP@@GJADCFAH@D@BA@AD@B@D@BHXBLH`@HBHBF@DBFDFBB°BĦFnAòBŮBt@AºBº@v@A·EùAA{@oCGayK_}ßccSqGUGOCIIYMcY[YYEQEOGO_WySqKaISCIAGCIM_WucISEQGQGQWwGQGQEOOcOcSoIUGSIUEMACEMEMGQK_EICKGQACAAMgCKIWU{QmGYEKAGSuK]CKIYEMSwqÉK[IYK_CMQgISWsIUKW_aYw[w[y[y[}gaas±QeOa_WsćkkUkÉk]yBICqAcAQAQAG@GCKCcCQIcAGCMEYQ¡OEaAKAKAE@ODGDIHEBEAIQKKOKOEK@KCIAKAQEMCIBKBKBK@KEMEGCGGCGAKEECA@ECE@ECIKIECEAC@GCEIM@ECAACAACACC@@IGIG@GAA@ABABA@@@CCABEBCDABA@@BABCB@DG@ABC@C@A@AE@C@AA@ACE@AAAIE@AIECA@C@ADCBAA@DCBCBCDCB@GQEE@A@@CKCQCKAG@UBGJQHGBG@GCEGCqSK@I@KAEAEAIEE@GBCBI@KEOEGCKEGAM@GBQNGDE@S@SFIBEFGDABAAABAB@@CBAB@@A@ABA@C@@@A@AB@AA@BCAAAAA@A@AACCCA@@E@A@C@C@A@AAAECAE@CAA@A@CDABCBA@CBCBA@ABA@ABCD@D@BAB@B@BBB@B@FAB@BABABA@@H@D@D@DAD@B@BBF@B@DABABC@ABADAB@B@B@@BBBDBD@B@D@BBB@DBD@@ABCBEBABCBAFADABADAB@BABCBCDABAB@@AB@BABCDCBA@AD@@EF@BABA@@@CB@@A@GDAB@BCBCBBB@D@B@@@BABCDA@@BED@@AB@BCB@BABABEDC@AB@@@DAB@BCBAB@@CBAB@DEB@BABABADABIB@@CD@D@BABABA@@BEFEH@D@FAF@@CDCDCD@BAB@BABEJ@BEDEFIDABCBE@CBABA@AD@BA@EDA@@B@BDDBB@BDDDDHN@DBDDFBDBDBBFNBB@DBH@H@D@DABEFEFCBMLCDEFKJABCJCBAF@F@DABABCBADA@CBC@CBIDGFA@EBCDC@I@EBA@ABEBABEBCBE@E@GBA@EBC@G@E@CBC@C@C@GBE@CBE@G@A@ABC@K@I@C@OAC@C@@@ADADCDCBABCDEDA@ABA@EFCBEDCD@DEFCDABCBGJEDIFCDKHADIJCDABCDKLCFEDABCDA@@BA@CB@BA@@B@BABBB@BBDBB@B@BAB@@AD@D@@B@@BDB@@B@DAB@B@B@@@AB@BA@@BBB@B@@@D@D@BABCB@@@@@@BDDDBD@B@@@D@BBBBBBB@B@@@BA@@@AB@@A@@@@DB@@D@B@@BBDBDD@B@BBB@B@BB@B@@B@@BBBBB@DBD@@@B@@B@@@F@BBBDB@B@BDB@BBDBBHD@BF@DDB@B@BB@B@BBD@B@BA@@B@BB@@F@@AD@@@B@BDDBBAB"],encodeOffsets:[[81857,37889]]}},{type:"Feature",id:"653226",properties:{name:"于田县",cp:[81.677418,36.85708],childNum:1},geometry:{type:"Polygon",coordinates:["@@ANCLEPINEJCNIHGLILGTELKNILAPCRCV@NCLEHEJsÜgÂUUUIbAHKbI`ETCHsQ^ctKTap¢MZS^ILEJMTMTKTGNAJ@JCT@PAJ@JAfA^BHDLDJDJBF@BHVLZHRBHr¼HTBBöøXz|ÊPbôL`TVPtbÀL`XvhPhXxJT`zÌVrJXPdRhFNBJJ`HRl¬L`Tn^NbTnNddtÂPdFPBD`xVdLTJRJRHPVfTdLRÒP\\^tNXLXNXd~NZT`DJb|ZlNXLTXjLRÚÀDDDDBDBFPrHRH\\HZFRHZFTFNBDŘņ\\|ĒJ\\@BBLNrJ^ż@ @Ô@ÀA@O@Q@Y@gAc@@Q@]@G@GAI@GBkAG@I@I@W@I@c@I@Q@Ae@G@@G@G@S@GAs@Y@c@[@w@G@O@QA]Aç@ěAyByCƓCğ@ç@ƱA¿@é@AY@MAECQC]CYI£@EE}EoCuQãGAaK¯EwK³E}MÇGMÙIKKSµA[EmA]Eō@Q@E@ELST[^gpfuNOfmPUDIDGDKJOBGX[jruX]FGVWHMV½JFGGGCCOKIECCGCKCMKKIAACCAAECGEAACACAMECAAAAAAA@@@AAAAAA@IAA@AACEAACECCCAAACAACAA@C@A@A@CBCBA@C@ACGCC@AEEECEGACAA@ABA@A@@CI@A@A@@@AAC@C@E@C@AB@BADAB@DCFCDCDA@AB@DADAB@@A@@B@B@B@@AB@BABG@A@ABA@ADCBCBA@AB@AAA@EECAAA@C@AACAAAAAAA@A@@@@A@CAAAA@A@AACA@GAC@A@CA@A@@ECCC@AAAAACAAAC@A@A@@A@CCGACCC@A@E@EAA@AA@AA@@@A@A@A@ACCAAEEAA@A@A@AA@CCCAA@@A@E@@BCDCFE@@AAAC@A@A@C@CAA@AAA@A@A@C@AAAEA@@A@CAECCCGA@@@@AA@@BAAAAA@EFEBABA@A@ADA@A@@@AA@A@IE@@EEEGEGEEQGIEEEAE@E@AAECECCCEHKBEBCAGBGFGBEBG@CFCD@FDFFHBNABCF@@AFCLENCJ@HBH@FATINALAP@H@H@HDFDHFHDJDJBD@L@H@DAFABGBMBK@MAE@I@KAQCK@GFQNWL_JSDGNSLMBKAM@I@GFOLQHKFGHIBIDM@EFEFCLANEBECGYcEOAA@ABGBGAGCGBCBCDIBK@CCICEBALCDE@ECE@CDE@CAICIAG@I@GHK@IEEAAECAA@A@CBIBQ@QACAAKCIGGIEGAC@EAC@G@CDCJAHAF@FAJAFATGJCDA`@J@FCDABC@@BEAEGCACACBCAG@GBCFA@A@GAGCEACDC@@H@DCBAD@D@D@DAF@FAFFFBDBL@JCFAFAD@FAHADAHAFEJGDCBABC@E@C@EBEBEBC@G@IACEC@E@E@EACCGIMKEEEAE@CBAB@DBF@F@DADCFIHGFCFADEAAA@CAECA@EMCKEKSIMEKGMGUQSGWFMPUNWJUFcLSHKVOHSHH_BWJUHWFOEYMWAWHUJOF_PUBM@_ESESIMOCMAKAWKWKOUCO@[DW@YGBQHSHOHGBUMOOOISDMFMAKMMOKSKUESDQFQBQCGGIOQQWOOCMBYDWEIIAWEWGCaPK@KCSQWGSIKCYAQEOEQUOKK@AB@BA@E@IAE@AAA@EBCBA@A@C@OAAA@@@AFEBCBC@@@@CEEGCE@CAC@E@CB@@EBA@ABCBC@@@AAA@A@AFCBA@@@C@@A@@CBAB@@ABCBAH@B@BA@E@ABCDA@CEAAA@A@E@CBCDCBABABA@CAE@C@ABEBEB@BA@@BA@E@C@AAG@ACAA@CAA@@C@ABA@C@ACCCAEAAA@ABADAB@@AAAGEKCEEAAEAIAEACAEKACEAOAEBE@ECCAAAA@GBA@IBABEHC@E@CAICIAGACCEGAE@@ECEGEOEKEGGEEAKBGBe@UEOGMACCQGSAWFOF_H]FOAOA[Ku@WA[QOGaQYIUC]AaB]@cD]@_AGBA@I@A@CBC@EAI@C@@@CDCDEBI@I@IECAO@E@ECCAE@CBCFSVCJ@HBFFN@FBL@FCHILCDAB@BAB@B@BHFFFDBBDBFBBD@NBNDFDDH@HAHAP@DDHNJJJFH@T@FBDJHB@@FAF@B@BFBHDDBBD@FBT@FFJJRDR@JCF@FDBFBFDLLNLJHFHCFIBCD@J@HAX@D@L@NDJJR@D@JCHEJADHJAHEL@JBRB\\AXGXIRCF@BGN@JCVQFQBUBQFOHSBQBQBQBQDOBODOHMLGHCTGJSJOFKDOFILEJ@LHJLRFPBPANAVANGPOJOHSHKLGRCP@LAL@NBRFJJJHLLJHJRJVLHHJHFHJTHHHNJVFHFLHJNBPBPDPHEPIJKLFRHPDJDJNTBXJNHVKLCFALELURMJGNHRDX@PJbD\\ANADILGBKHYHEDGHKLCJBTFNBJDLBLDT@HDJBP@JBNAP"],encodeOffsets:[[83397,37106]]}},{type:"Feature",id:"653225",properties:{name:"策勒县",cp:[80.806159,36.998335],childNum:4},geometry:{type:"MultiPolygon",
+coordinates:[["@@OBUFOFELAVGLUA_@GHKJSNUBQGIIMKMAIEA@CBEBCBAB@@CD@BE@GDEBEBABEBA@A@GBC@CCAAAACEGEGCCAC@C@GBCBEB@@CB@F@FBB@D@BABE@EAC@C@C@EBA@MDCBABEB@@A@CBCBC@IBC@OBGB@@C@CBCFCFAH@HDRDLABCBGBGBC@KLEJAFEDC@BN@N@HCDGJCFCHCBEDE@KEC@CB@BEFJRJVNXFNFJFJHNDJHTHPBHFRDJDLDJ@FBFBLBLBNBH@NBJ@H@DBD@HBH@DAH@F@F@L@L@DBP@J@P@T@L@L@LBR@LBL@J@NBL@NBR@H@NBH@N@F@J@DDZBPBPDPBLDRDJDLFLDHBHJRFJFJDHFHHNDFFFDHDFFFDBDDDBFBDBDBPJJFHFHHJFJHDDHFDDHJHHJNDJAD@NALABAFCFCHADCFADAF@DAFCFAFAF@BADAF@DAHAD@DELCHCFAHCFCJAFELCFCJCH@F@DALCNAH@FAHAH@D@L@D@B@BBDFHDFBBDFFFHHġĀEGAE@ACEAFBD@DDDDDABIHDJDB@@@@@A@@@A@@BD@@BBC@BBBA@@@BBA@AAA@A@C@EBABCŪ­DDBBB@DBDDBDBBBDBBBBD@DFDDFHHHHHHHJHJHLLJLHHHJFHFJDDFFBDJHJHFFNHJJNFHDPRPJFBLHRTDDLLHJJPJJHJNNHF@@DFBFDABC@EBGDE@CHGBI@CdaAE@AAA@@@A@@BA@C@AA@@ABC@ABABDBD@BB@BB@@@@@@BB@BA@@BB@@BBD@BNGBBBA@CACB@D@BAA@@@@@B@@BNFBBJ@@@FD@BADB@@@B@@B@@@BBA@BB@@@ABBBABB@D@B@F@X@IBG@C@AĈaEBCDBBDBD@DABABC@@DF@@B@@@@@B@B@ADB@J@@BB@BA@@@DJ@@F@@@HD@ADH@@M@I@K@G@AB@FA@@B@ACCA@CAEAAAI@QGGC@@DCBBB@DC@BF@D@BCD@BC@@BDD@BADCBKFED@DCBDFčA@ABC@AAAAA@A@AAC@A@AAAA@CAAAAC@A@GACAE@CA@@CB@B@FABCFEBEBIDIFKDIBE@ADEBADCBABC@ACEEG@A@A@A@@DADADC@@BA@@@AAAAACAAAEAECECECCACAAIBC@CAGFAFABABA@ABCBAB@F@BAB@HCF@H@F@FBFBJHFDHDHFJDJBHDJ@D@DADCDAB@B@BA@AAC@AACAAAACAA@CCCAAAA@@AAC@A@EAA@GAECECEAACCCECICEACAAAAAAEECEACAAACCECCCEAACCACA@AAA@EEEAECECAAGAAAEAEACAAAGCAAAAC@CA@@AACACACA@ACACAA@CACAEAAAGAEAAE@GAEAI@GCUAIAKLBB@BABADCBABC@ABCAAAAGAOE@A@A@@BALEB@@A@@AACAMCCCKGCI@CCGCAACAEBA@ADAD@DAD@BA@@@C@KAKAQAMAK@E@GAO@KCOAKAGAKCYAKCMAI@AAIEOGWCICICKAGAIAKAC@EAMAM@K@I@G@GAC@E@C@EAE@KAOAIAKAK@GAK@G@IAK@E@K@O@OAG@KBE@E@EAG@GASAKAC@MCOAEAIEMCMAE@CAICIGSEMCGAGACCGCKCIAEEOEMACCIEMGOGQCECECGEOACAGAEEOACAECIAACGAA@ACGAEAGCCAGCICGAGEICIACCMCGAC@AAGAGAA@A@I@G@AAE@@@C@A@C@A@@@A@A"],["@@HGFCZGLGHAJKBCBMC[Ia@OCWGQHMNIVQFKBKDELKGUIMAWMSCICIGOEQLKJIFOOGOCOAMAGIEKEGIUGMGGISEGIGGGUKQIGIKIGKIIEIAQ@MBK@KDOHQLKTGPGPIHOBMBUBMAOEOKQGI@KFIJKPELCPETIHIDSHGNKPGPCPARCRARARATAPGREVARAREDU@IHM@ADEJQHWBWA[AQ@IFKBGGIBCFIDG@I@CIQCI@M@K@CBW@G@IDCJADEEGIGMKKKECEACA@EDE@ICQIQEI@EAS@EACCAGCEA@A@ABE@EA@IGAC@E@SEGIIMICG@CBOBG@GCGECMCMAC@AAAEACCAEEGE@A@ABAK@I@KDEHCFEFKDOHKHEH@F@HCFADCFCBE@CBCDEBG@ICI@MDEFCBC@ICGAGCC@AAA@C@@B@BAD@BAFABA@EBE@C@ABEDABAB@BA@AB@BA@@BAB@@A@CAAAGG@AAAAACCCAE@EBADABABA@CBABC@A@CACCAA@K@AA@CAKBC@CCA@C@CDKFGBEBKA@BAAABA@GBA@@@@@UEMGC_UDKDQDOC[OGBOVIXCRIRGLODQCeG]FSPENUJOBMBGfAFcKUEaHIIQQQQ@A@@AA@C@A@A@C@AA@AA@@BAB@FAFCBAD@B@@A@A@@ACAA@A@@CAAAC@@@@ADA@@BC@A@CAACCAA@@@A@A@A@E@CAAAA@@C@EAEAEAC@A@M@G@E@A@@@@@DI@@@A@@@AB@F@B@BA@@BAFGFEHCBABABABCB@@@@@@@@AA@A@EAA@@@IDYAeBiAUBKFKNIJWHICYIY@UFYJWT]HcC[A]C]CUCYCSBS@U@U@WBOBUDDVHRGL@B@B@@@B@D@B@D@@BF@B@H@J@BBBBHBH@BBDDHDNBDDJFJBHDHDJBHDDBHBFDH@BBBDHBBDJBFBDFPBFBHBDFPDHDFDFHRHPFNDJBDFNFPBFDJDLDHBDBHDHFNHTDJBJ@DBFDNFNBJBFDP@NBDBLBT@HBH@F@FAF@LBH@P@P@L@FBL@J@HBL@HBLBLBJBP@LBF@F@D@FBD@H@H@J@LBNBN@FBDBLBJBHDLDJDJHXFPBJ@BBJDNBLDZBLBHBLDP@LBP@H@FBLBNBRBL@L@D@@ABC@CBC@CB@BABBFBDDBDH@DDJLHDDNDDBBB@@@BA@KFAB@@@B@BPFHBBBBBAD@BADABCDABABA@KABLBJDV@HBJBF@HBFFBHBBBFBDBDBB@DBDB@BDBDBDBBB@@DBD@BBBBHDBBDBFBFBBBHBBBFDFDFBFFB@BBB@BDDDBBDFDDDFBDBBBDDFFFBBBBBBBDDFDJDFDDBBDFDFBF@HBB@F@BBD@BB@BBDBDDB@DBBBBBBD@BBD@BABA@A@CBCDCBC@I@GCIAICGEGCECIGEAEAE@G@E@GDA@ABE@A@ABAD@BABABEBEBBH@DADBJDBDBFDFDFDFBBBDBBBBB@B@@AB@@CDCBCB@@@B@B@BFHDF@BADABCDABCF@BAFCJELCJAJAFEFADEBA@A@@DB@@DBFBD@H@BBDBBDBB@BB@B@BBD@B@BBBBB@BAD@BĎBCEDA@CFCLEDABC@ACC@AD@@ADC@A@CAED@@CAADA@C@ADB@@HH@RBJBBBF@DDBBDA@@@EBA@@B@HNG@IBIB@D@B@@@BBB@B@@@B@@B@@BA@A@@B@@B@@@@@A@ABAB@@ABE@G@IC@A@BAAGD@B@@AF@AG@A@AJALAFLDJALAHADEBBHDHALDRdF@BBAjHDFBA@ABD@BDBBA@AB@BDBABA@@DBDB@@D@BAB@@@ÆXFOBKDGBEFEBABC@ABAB@BABABA@AA@AA@EBAF@DE@E@GBEDCDEFEDCDAFCBE@ABE@GBE@G@E@C@A@C@CBCAEBA@CBCAG@EBEBG@ECEBAACACB@FAL@LAHAJCFCDAFAFAHAJCMGACCEGEACEEACCGAA@AGGW@@@@ƌH"],["@@G@C]HA®FIFGDK@MDUDQBOJKLMFKHSJKHKJGDMFIJMFODKBMBOAM@IAOCI@GCSAKCKAIEMASDILKƋG@@@@XHH@BBBDHBDFFBDHFDFBDNHIDGBEBEBCBEDIDGBKBK@EBA@BDBDABDF@FAHAF@FBHAD@DABBFAD@D@D@B@D@F@HAF@HAF@BAFEDCBCDEFCFCDAF@H@FCFE@AB@FBBB@@BABABABA@AB@BADABEFAFCHALEPÅW@@A@ABC@@@CACA@@ABABACA@@BABCA@AAC@BABCEiGAB@AcE@D@P@L@FF@BPBNM@AB@BGBIB@@CCEK@CA@@@@@A@@@A@@@AA@@A@A@@A@@@A@@@@CI@@B@@@FACEH@LA@IAE@E@A@AAQAGAI@@@@@@A@BA@@@EA@AB@B@B@@@@A@@@AB@@@AA@@@A@A@AA@@A@C@A@AJ@JMH@L@J@NG@BCC@@G@@@EI@@C@@ABA@@AI@A@BCA@A@@@@@A@@@CE@@ADABCBC@CAAADCFAćb@B@DAH@JWE@A@C@A@BAAABA@@A@@AAB@A@@@AA@@@A@BC@AEC@@I@AAME@AA@@@@@B@ABC@A@BD@DABAAMH@AAC@AA@@AB@@AAA@@@@@@AAA@@AACCB@C@BAD@BB@@A@AB@BDB@BDBF@B@B@@CBC@@AAA@@@BBFcb@DAJGH@DCFAH@FADCBAECE@@GEMMGIIIIOGIKKCCQSKGEAOIOQGCMEIIMGEEIGIGACEECCEIEGGIGGIKKKIGIGGGGGGGEGCCCEC@AAAAACAAACCCCAA@AACCũ®ADAB@F@D@BBB@BAB@A@@ABAA@@@A@@CACIJGBACCCC@CACBEDF@BBFFHĢÿGGEECEAACEEGAC@A@A@C@K@CBGBG@EBGDMBK@C@EDGDIDEFKBEDIDEBGDEDGFK@CBCBG@CBEBC@ABEBEDEBE@CBEBCDEBCDGDEBEBABK@MBCCIIMGGGICCGECCIGIEGGGEIEOICACAEACACCCAEECECGEECEGMEGCGEIEIIQAGCGEKCKCICQAKCOAOAOCY@C@I@E@MAG@M@GAQ@MAK@M@IAK@KAQ@K@K@K@S@O@IAO@C@K@K@E@EBG@CAG@GAC@C@GAI@MAGAMAKAKAE@ECICKCIEQAGGOGSCIGMEIEIEMMWIUIQI@C@C@GBIFMBKDMLELAPCDSFA@C@@CBECCE@C@KBKFOFCD@FBFFHAFGJIFE@IBMCG@C@CJKNCDC@ECKGGGGEIAEDEDC@CACEI@GHOTINIPEPCVCHEHGDQLEHAJ@N@FALEJAH@F@H@FCFGFABANCFGHWhKJKHMHIFGBGDIHORUPGDS@G@C@EFAJAJAH@DABEDCB@F@DJBHBDDJJHNDH@DBHBHFFDH@D@H@BEJIPORIRMLGFAHB@VFpZNFLFJJB@NCDAFBBB@FBJ@FCDCBEHCHAHAHBD@FBDDBLJ@BCDEDCDKHGFIFEFEHEZ@BAH@HABAB@FDHLTHFFBJBFFHHBDDF@D@@AFCDADA@CF@B@D@BADABAB@@@BBBFFBDDD@B@F@B@B@BAB@BBFBDBB@D@BADAB@B@B@B@DAB@@@B@BABABCDABAB@@@D@DAD@B@DBD@DBB@BD@B@DBBBBBBBBBB@BBBD@B@B@B@F@D@D@B@DAD@@@B@D@B@B@B@B@D@BBD@B@DAD@DAB@BBBBDBF@B@B@BBD@BA@@BED@@DFBBB@DB@@DDB@BBBJ@B@BBB@F@BBBA@A@A@AB@B@B@@BBDDBDBBBD@DAF@@@B@B@BDF@BBD@F@B@D@B@B@@ABCDCDAB@@@BBB@B@D@BAB@B@DABEDADAFBBDFBB@BD@@B@B@B@DB@FDD@@@@B@BDB@B@BFD@BBBD@BBB@BBDDB@FBB@DBBB@@@BBD@BB@@BB@BAB@B@DDFDHDB@HDBBJD@BBBBDB@DB@@@BBB@@BB@BDBBBBD@BDHBB@DBBBBBBBB@@@@B@BBBDDBHBBBBF@BBB@@BBDAB@D@FBFBBBDBBBBB@B@B@BFDBBBBB@B@DBF@B@FBBBBB@D@B@B@BB@@B@BAFBBBDDBBDDBDDDFBBBBBB@B@B@D@BBB@BBD@DBBFD@BBD@BBDDDDDD@HFLFBBDDBB@@BBBF@B@B@HAD@BADAH@BBB@BB@BBLDFAD@HBDBFDB@BBFB@D@D@D@DA@ABAB@BADAB@B@BCD@@CDABAB@DBB@BB@DBB@DBBB@@@BADAB@B@B@D@B@B@BADCBAD@@@BAB@D@BBB@@@DBBBDBDAB@BC@@@ADAFAB@BA@ABA@@B@@@BBBDB@@BBB@D@BBFBBBFBDBABABADABAB@@@BABADABABA@CDA@A@@D@B@@@B@BBB@BB@BBDB@@@B@@AD@B@BB@B@BB@@@BAB@@AH@DAB@@CDA@@@@B@B@B@BABAFAD@@ABABADCDABAB@BA@BBBAHCFEJATET@F@HCRMHAN@HBLFHDPFLFJ@DAHAF@JFFBFBLBJ@L@rTHDDF@HAHGHIRAH@VBHDLDRDL@@@BFFHRA@CDADADCDB@ABCD@B@DDBJF@BJFBB@BDF@BBBD@F@@B@B@DAD@BCHA@ADAB@@ABCBADAFDB@D@@ABAB@BBB@HJHJH@@DDDBBBBDDB@FJNDF@HBDDFJFJLFDF@FDB@FDLFHBHDDHFHFN@LALALALDJFNBRBLDJ@LFLLPLPRLBJAFGFCJCH@PBFBLBLFbPR¢FZDNBHJdDRDdDL@HBHBRBRBdDrAJ^zlÊVlllĈXt`PbRft²bbh\\~\\z\\z\\xZxb`LXJVXtJTRhDNL`JZL\\rÊTxFNJZDLL^TvBHFLHZRnV|JXDLNhBBBDHRDLFJL`HRFNFNBDFNJVHTJVTpPdPdFPHRHRXxHRHRFRJTdXvN`DJBHDJJTLbTrXz`HPFPFRZZ\\ZNdJZDJHPHVTrd d~àL`bzDH@pB|BFúB¸@¼AºBº@bAİ@Ð@^@@P@L@ÄBz@â@I]MqAK@AI[{đ[ŅŗACEMESGYEQGYG[GQOqAEACCCCC¿ÙKQWiKSMWYka{CIS_MYc}MWKWMW]sO[ÑKQScUeGOIQIQKSUc_wACEOOcsÁcMcSmMa]SmK_k«GQI_AIEMQgOcIWUqyË_ISWwOggWuK_a¿OsUSK_óOa{ÉWy÷õAAGSq»AGGQKYGU@AAECICICKAGB]Be@IBI@ODS@IBIHMLSNSNSFIJKT]NY¡boLSdsR]tDGFSJ_LaBGJaVVVhÁum"],["@@@@@D@@@B@@@@A@@@@D@@BB@@@B@@@@B@@@@B@@@B@DBDAFvntÛ­B@"]],encodeOffsets:[[[82628,36214]],[[83392,37014]],[[83283,37296]],[[83282,37280]]]}},{type:"Feature",id:"653227",properties:{name:"民丰县",cp:[82.695861,37.06408],childNum:1},geometry:{type:"Polygon",coordinates:["@@fBAĒ@rAl@bBP@F@B@D@~Ah@cŕAYAY@uAW@e@KAQ@q@GAu@M@KAM@yAa@C@O@e@I@GAW@K@C@S@AAAe@KBa@å@{@W@Õ@ãA§BwAk@G@W@A@K@i@K@Q@U@I@g@q@ABS@SAIJGVWPOTSNKFCHGTOJGPIPKVKr]ZOoXOZQ^Q\\QVOVOzkXOZSXQZQVOwTQXWPQRU^mPUR]HKDEHMHGVaVcNUJOPaXoLYJ[FUBCBGFKHMBG@GCO@CAM@KAO@ECCACACBABCBA@CAI@A@A@ADEBC@E@A@C@A@ABEDABCDABCBE@W@AAEAC@@BAD@BABA@A@EAEAE@A@@FCBA@@@AAC@ECECEAA@ABADCDAFAHAHCBA@AAAEG@@@A@AB@@AB@B@@AAA@@@ABAB@BAB@@@BA@@@C@A@CAE@@@A@@@C@CBC@E@IBCFI@AB@B@D@B@@@BAJOBA@C@EBGBCBCBCDEDCDABC@A@AAAAC@AEMAK@E@K@G@CBCDEBCBC@CBEAK@I@Q@G@OAM@G@I@G@CAEAGAIEMCGIIAA@C@C@ABCDCBGBIFMFO@EBEAEAICIAOAEESAGCEAEIKCCGESIKCICKCA@CE@@@CCEACCAEEECC@ICE@E@AAA@AA@CCEACECAACCCACA@AACAG@CAEAEAGAE@C@C@@@CAAAACCCCQKAAA@@AAECEAC@A@E@AAC@ACAAAA@EAA@C@GCC@QEEAA@CA@@CEA@ECAAE@CAA@KKOMMIGEIECEA@BEDEBABADA@@BA@A@@@G@AB@@CB@@A@@AA@CAA@A@C@ABCBC@AAG@A@A@AAAAA@@@A@A@AB@BABAB@@@B@DDBBB@BAHCHAD@@A@CBABADC@@BCFIBC@@@A@C@A@ABABC@@BABCBA@C@@@CBABCBE@@@@BA@AB@@ABA@A@ABE@ABABA@ABAB@BEAE@E@A@C@@BA@@BAAA@@BCDA@@BA@ABAA@@A@A@ADADCBABA@A@A@A@A@ABAB@@C@A@CBA@C@C@E@A@EAC@@BC@ABC@@BA@@@E@A@AB@@ABA@A@@@C@A@A@A@@BC@AA@@A@ABA@A@C@C@CAC@@@AAA@ACC@A@A@A@@@AA@AC@AAA@AAC@@@A@@BADC@@@AAA@A@@@AAAAACCACAAB@@A@@@A@C@A@CA@CCEC@@ACAA@@AAA@@AAA@AAA@@@AAA@@AA@A@A@@AACEACCI@ACCCIAKAa@@A@@@AAA@AA@A@MBADEDAHEBABABEBI@K@G@ABABC@QDKDKPSNQFKBM@K@MAEGEIGCCAIAGGECIIMACAUBGFGHED@HA\\CRGNCHIPEFIJGJGPCPUHCPDH@JELCZ@TBLDJ@LBNDHBHCFCD@F@BABAAG@CBADCB@BAB@@@@A@A@A@@BA@A@@@AAA@ABA@@D@@A@@BE@AB@DCDCD@B@DCBAB@B@@@DE@@@@D@@@BABAB@B@D@D@B@B@B@BABAB@D@B@B@FCFCD@BAB@BA@@@@B@B@@@B@@ABGBCHIACAA@C@AB@BAHCDABAD@F@BAB@BA@@B@DA@@NBJ@D@D@DAHCDABAB@D@DBH@@@HBNBB@DAD@JADAHAD@DAB@B@DBB@J@B@@@BBBB@@FBDDB@B@D@DADCBABABAFABCFEFIDIBC@ADADAB@BA@A@A@AB@BABABADA@@D@F@FBF@@@B@DAD@FAB@DADCBABA@A@A@ABABADCB@B@DA@@BAB@FAD@BAB@D@@@DBB@@@B@B@DA@@BA@@BC@@@A@A@@@C@C@@@A@A@@BABKB@B@BADC@AJABABAFAB@BCB@BADATEB@DAB@B@B@DBJ@F@BAFGDGDG@CDGBCJGDCHEFGBCX[JOFSBE@CACAGACBEBE@CEIACAC@E@I@CBCCKBC@CBAACBAAAAA@AAA@@E@AAEGA@AAA@A@KCACA@@ACCAA@@@ADCB@BA@@@AA@AC@A@@@A@A@A@AAAEEAA@CA@@CBC@C@AAGAA@AACBA@A@AA@AAA@@A@C@ABA@@ACCC@C@A@CB@BAD@B@JFF@FABADABABA@C@A@A@ADAFCLIFCBAFAB@FA@@@@B@@@@@BCFCB@@A@@@A@A@ABABA@AAAAAAA@A@C@A@A@ACECC@@CAC@@@CACAACAAAA@AFAFEBABC@A@EBC@CBEBO@C@IBGBG@ABADABADCDKBGDED@F@B@FED@DBDBDBF@B@BBHCD@B@DBD@F@D@J@BAH@@A@@FcF]D@F@F@D@FAD@DBD@@@D@FAD@F@FBBAFAB@@@DDB@FBD@R@D@B@BAFCBAH@J@HAB@DAB@L@D@B@DAF@FAFBD@JAB@D@D@FAB@F@BBF@B@BAFABADAFABABAAAAC@ABCD@JCFABCBA@ABABAHIBCBABC@E@GBC@CBABABAD@FAD@FBBAFBDAF@D@B@F@HAHAF@FAB@BABBFAD@B@@ADCFIBCBG@A@@BAB@BA@@BA@AA@@C@AB@JGB@BABA@AB@B@D@B@F@BAB@BA@ABAB@DABBD@BAB@@A@@BA@@BAF@B@F@D@D@DAD@BA@@ACACAA@CBAACAA@A@C@ABABABA@@@AEGACDCFC@GB@D@BA@ABC@ADA@AD@B@D@BAJEBADCDAB@FCFADAD@F@B@BAB@D@FAH@B@@@BA@C@AB@BAB@@ABAB@LAD@D@B@FAFABAF@DBD@BBBAB@@ADABABABABC@ABABABABCDADAB@FCBAD@FAD@JBB@D@DADAB@F@B@L@H@N@FAF@D@DAD@D@FBFALBD@B@D@B@DADCBABADC@A@A@A@@AAEAA@AA@C@ADEDABABA@E@AA@AACAEACACCAA@A@A@ABA@CBA@AACACACAA@A@C@@B@FADA@ADG@ABAB@DAD@H@@@BCBCBCBABAHE@ABC@AAA@ABAB@B@@A@AAAAA@ABABABA@C@AAAAC@CBC@AD@BABA@A@ACCCA@C@CBCBADE@A@AAAAACACE@CBCBCBA@C@EC]ACECCAAAACBCDEDALCHADABABEAO@CDEDE@ADEBC@AAG@CBE@CBCHEB@@C@CBCBEDCBADADADCBE@CBCDEHEFCBA@ABA@II@CBIBEAEBGFSF]BEBABADAfGJAFCDGHKBADCB@NADABABABKFE\\UGGSWCCQEKAOCE@MAEAAACAG@QAECEAAAIAICMEEEQCkMAAAEAACCWAGACAKUCAGCQAMGCAG@ODCAA@QOOEMCMKEECAEAG@IAGASKOCKAK@IBEBEBCBC@G@EAG@EAGACCC@G@A@ECAAC@CAIAE@AAE@GCG@CACAECC@ECGAEAEACAMAMACAC@CCC@C@KBGAE@E@G@YAkQKGQEEAC@AEGESAE@C@C@M@QCCAC@EAECECGAEAG@C@IAAAKCKAAAA@AEBA@CBCAG@CBCFE\\ENADADIBE@CACAACAG@AACAAAACBQ@AACACEEACCCAECAAA@ABI@CAAEA_EA@QEOGSIEACEECCCAAIECAECE@CAGCGAECC@GCKEECCAECKECA@AACCCCCAACAEAI@KAEAG@G@EAE@CAKAMCAAK@E@EAA@CAE@C@I@OBICCCAACA@CCAAACAC@C@CDC@AAA@CAEGIEA@EAC@C@C@AAGAEAEAA@AAAAGICCCEEECCAACCECEACACAC@C@EBC@C@C@ABABAHCDGDEFIHCDCDC@A@AACCCAC@A@K@C@CAC@CCGCGECCGEC@CAC@ABABADCLAF@FAFCDCHEDADCDAB@DABCH@BCBEDEBCBABCHA@CBE@GFCBCBC@KGEACAC@GAA@AFA@ABCBC@A@CACAE@A@A@ABABBDA@CFCDCBC@CAA@AAEAGAGAC@A@CACAKCICI@E@GBCBC@C@CBE@A@AABA@@@A@AAACAAAC@C@CAC@E@C@C@ADCBCBA@E@ECA@CAGCIECAAA@ECCMIGICC@C@IAA@AAA@@AA@C@E@AA@GCEAEAG@A@A@KAC@AAAA[@YAUAcGO@ILMD]BgAoBgA]@Q@OCQIKGSKSIQEWEQFKVQDYA_GaCYEGFDXB@BBBD@B@@AD@BABGBC@E@C@ABADABA@EBAAAACCG@E@AAGCECC@Q@K@EDADCFGLEBAAEAEAOBEBELGDCBSAW@UAWBO@IFQDSAMDWLSDWFkAeDYDYNQHOLQDOEOQSMUK[E]ESFaHY@YFKHOHSPMJMLKXETINOR]LULUD]@QFQLGLCPCJERENEPINILARJFHJBN@LBN@PAN@TELGLIPMNMTBPFRJLDRMHQDaBS@Y@MAUBOFANKHMBWEQCI@GBIAK@W@Q@QDA@IDABA@CFEBEDGHEJCDCBE@E@CAA@AB@DBFFFLFJNDHBD@F@F@FFDBD@J@HADAFAF@F@D@FADABCDIHEFGBCBGBEBC@EBEBIDK@CAEAEEEBE@CBC@C@C@ABCDG@@@CDBDDFBH@H@BEBAD@HBHADBDBDHDBFAF@@ADCBEDI@_@CBIDSHEBIBEBE@GBIBCD@D@HBD@FBDFHHJJHLDBBBD@RARAJ@D@BBBFDBBFF@JGL@H@JBHDJBJ@DCF@DDF@FCFKDABDFDJ@DALCJADADDHBHAHAH@BBBFPZdDHAFMFKBEDEF@FCNAJGJEHGLKREP@H@JBNALKNMTCHITK`MXER@HDLBR@L@JBF@NALANAHEBCBG@K@C@IAICGCGEECGCG@G@O@KBMBSJEBG@GAI@MDKFED@BE@ADMBGAEEECC@ED@DAHAFEHAHBHADAFGLDFDDDFBF@B@FBFFFJFRHFFFHFHFF@@JFB@B@@B@@@BCB@B@BABABEF@FBBBBAB@@BB@@@@HBDDFDDBB@@@FBBB@B@D@B@BBB@BBB@D@D@B@BBDBB@@EFCDAD@@@F@BB@DBDDB@@B@B@BBBFFBBDD@B@B@B@B@@BBB@@BBB@F@F@BDDBDDH@D@BB@B@D@BBDBBBBB@BDDFD@@@BDBB@D@HBB@BD@B@BBBBB@D@B@@B@B@BBBBBBBD@B@DBBDBFFB@BBA@@BABADCD@BAB@B@BAHABA@@BA@A@A@@@@BA@CBCBA@@BCBCDEDCDA@CBABA@@B@D@F@DBD@B@@@B@BDJ@@@BAB@BBBBDFHFDFF@BDDDH@B@DABAD@D@B@B@DBBBDDBBBDBDDDFBBDFBBB@JBB@BBBB@B@@BBBBBBDBNFDBDBBBHFFDBBDDBBLJNLLDHDDDJFPLDDHHEHIU¾GNUXEHW^qviW\\AHIPCLCHCJOVenMPevo]hS\\KT@F@F@RFŎB^FnB\\T¶LLJNÚHNÈF~L´FxL°BbHRäDvFpF~@FJ¤DZD^DRBF@NBZ@@êBÀ@Ʋ@èDĠDƔAzBz@ĜBèB^@R@P@H@x@\\@d@ZBt@H@T@H@H@@HBf@@R@J@d@J@X@J@JBHAl@HBJ@H@H@^@R@Bd@h@Z@R@Pr@p@l@|@f@r@@lBh@V@ð@¾A@¨@¬@p@Ø@"],encodeOffsets:[[85129,40432]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("喀什地区",{type:"FeatureCollection",features:[{type:"Feature",id:"653101",properties:{name:"喀什市",cp:[75.99379,39.467685],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ACAE@CAE@C@ABA@GBA@EAG@A@ECEAGCCACAAAAAAAE@EDABA@AB@BCDAJA@ABCBEB@@C@ABA@@@A@AAA@EBCBCBEBABA@CBA@C@A@A@AAACAAAAE@A@@@AB@@AAAAABEBA@ABABC@ABA@G@E@@@ABA@EBCBIBA@A@CACACAC@E@A@C@C@CAECA@AA@AA@CBCBEDA@EAEAECCAAAA@GCA@AA@@A@A@ABABA@G@A@C@AAA@CBA@C@IAG@A@C@C@C@GAC@C@MDA@C@EBA@CDABGBA@AB@@C@ABEB@@A@C@@@BAHE@A@A@@BA@@@A@AA@@A@@A@@@A@DE@@AAE@E@@AI@@@@AC@@AA@@A@C@@@AA@CBO@ABI@EB@CAAA@AA@AE@C@@@CBEBCB@@ADABA@C@A@A@ABCBEFEBA@EBGDCBEHC@ABE@GAK@G@ABEFCDA@C@A@AAC@EAG@EB@BEBCBEDCB@BC@EBE@IAMBC@AAA@A@E@EBGBIBC@E@EBC@A@A@A@ABABAFAB@@CBE@ABA@A@@ACAEB@@CBCDRBR@PBPBL@JBF@DB@BBBBBCBE@EFAFC@BHBCJABBB@D@F@@BDBB@@@BB@@BBB@@H@@@@BB@@FABAB@BBBD@@@B@BB@@CB@BFDFB@@@AD@BEBBFK@@FD@@HHB@BB@BD@@@@D@BA@@@A@@@@AA@@@AB@B@@@B@@@B@@@B@@@@@B@@@B@B@D@B@B@B@@@B@@@B@@@@@B@@@B@AB@@@@@BDBF@F@D@H@@BA@@B@@@B@BAB@B@BGL@DC@@BC@IAGHIJENI@AFC@lDBDBB@B@B@@B@@BBB@BAB@@@BBB@BBBB@@BBB@D@B@BA@@B@B@B@B@B@DA@@BBBBB@@@D@B@B@DBBB@@BABBBADA@AB@DADA@@B@BAB@@@B@BAB@@@DBB@B@BAB@@@B@F@B@B@B@BBB@@@B@BBB@B@BAB@BA@@BBBAB@B@B@FCB@BA@@DA@@BA@@BAB@B@B@B@BB@@B@AB@DGLCLCHAD@B@BBBD@H@D@LBH@J@NBXB`@`DZBP@FBB@D@BBFBDDBBD@BAD@D@J@HBFDF@B@BABCD@H@VBDBD@DEF@H@D@DCJIBADAF@LDPDTFDBD@D@B@BAB@D@HBJ@BBBBDBB@D@DAD@F@D@B@BBD@FBBBF@FAD@BCB@@@H@DADAD@DBB@XELCHAdULEBAJIFEBADAB@B@NBHABA@@B@B@B@BBB@D@B@@@B@@@B@B@@@B@B@@@B@B@@@B@B@B@@AB@B@@@BA@@@@B@@@D@@@BA@@BA@@B@DAB@B@B@BBB@BBBBB@BA@@B@BBB@BAD@B@D@@@D@B@B@BBB@B@B@@@B@B@B@@@B@@@@@BA@@D@B@@@BAB@@@@@@@B@@@B@B@@AB@BA@@@@B@@@B@@@B@@@B@DD@@@@B@DB@@BAB@BA@@@@BA@@BABBD@@@B@@@@@B@@A@@@@B@@@@@B@@@@@@@@@@@@BB@@@@@BB@@@@B@@@B@@@@@B@@@B@@@B@@@BBB@B@@@@@@B@BB@@@BA@@B@B@@@B@@@@@BB@@B@@@B@@@B@@@@@@AB@@A@AB@@ABABA@@BAB@@A@@B@@A@@B@BA@@@@B@DAB@B@@@B@@BD@B@@AB@@@B@@AB@B@@@BB@@B@B@@@@AB@@@@ABB@@@B@@B@B@BAB@@@BA@@@@@A@@@@B@@@@BB@@@@@B@@@BA@@BB@@@BB@@@B@@@@@B@B@@@@@@BD@@BB@B@@@B@BA@@B@B@@B@AB@@@@@B@BB@@B@@@DB@B@@B@BB@@@@B@@BB@B@B@B@@@B@BB@BB@@BB@@@@BB@@BB@@@B@B@B@D@@BB@B@B@B@@@BBB@B@@@B@B@B@@@@@B@B@B@B@B@@@B@B@@@B@@@@B@@B@@B@@B@BA@@B@FABAHAB@@@@B@@B@B@B@@@BBBBB@D@J@HAD@B@@@D@F@F@D@B@BBBADA@@B@B@B@BBB@@@B@B@BBB@D@B@B@BBDBD@DBFDBB@BHJDBBBF@DA@@BABBBBB@@@@CBA@A@AAAGEAGAAKK@AAEB@BA@@@@AACA@CBA@A@@A@C@AACCAA@AA@@@CBA@A@@A@C@ABA@ABA@A@CAAAA@AC@AAA@@@AAA@@A@@@AB@@A@@@AA@@AA@C@@@ABA@A@@@A@@A@@@A@@B@DABBFABA@@B@@A@A@@@AB@@@AACAA@CBA@A@@BAA@@@@A@EBC@@@A@@@CA@@AAA@@A@A@@@AA@@A@@@ABA@CBC@CA@A@@@A@@@A@ABA@@@@BA@A@A@@BAB@@CBABCACAAAA@@A@@DCJCFA@@BA@AB@@AA@@@@A@A@A@AA@@AA@@@ABAA@@A@A@A@A@@BC@@@A@@B@@AB@@A@E@AB@@AAA@@@A@A@@A@A@@CCAAAAE@C@AACA@AAE@A@AAAAAAAAA@ABABABA@ABABC@A@AEEAAC@AAACEIAC@@A@WFUFGBS@YJODKBKBGAWQKGOI@@CAGEGCEGCCCAC@C@CAGEECIGCCAA@CAA@A@@BCBABAB@@@D@B@@@@@@AGGEE@A@@DABAB@@AA@@AC@G@A@A@@B@@@B@@@@@B@@A@C@EACAEACACAAAA@A@AA@A@@@ABA@A@AAAAAC@C@C@A@CBAD@BC@ABA@AACAACAAA@E@CBC@I@E@GACAAACCC@A@CBA@ABCB@B@BBB@DA@@B@B@B@BABA@A@C@AAA@@A@E@A@A@AECA@"],encodeOffsets:[[78101,40369]]}},{type:"Feature",id:"653121",properties:{name:"疏附县",cp:[75.862813,39.375043],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAAA@GAAAA@AAECA@@AA@AB@AA@@AAA@@A@A@AA@A@@@ABAB@@A@C@@@AAA@AA@C@A@AAA@@BA@ADABA@ABE@@@A@@B@@@BA@AB@@A@A@ACAAA@E@@@AA@@BABC@A@AA@C@A@A@ECEAEAAAA@CCCAAACAAACA@@A@A@AB@BCDCFADC@CBGBG@G@AAKKECE@A@C@CDEDEDCDCDEBCBKBCBEBC@GAS@G@O@I@CBEBILCHCHBBBDDBJDFF@FDDBFHHBB@D@DBFPbFRDLBH@LAJ@NAV@TBHBFBJLZ@HBBDFFJDJDDDFBFDH\\dNPDHHHbpLPJLTRFP@HBBBHBH@D@D@@BF@BH\\BD@F@D@@BB@BHDNF@@HDBBB@HFLDFBHFB@BB@BBBBB@@@B@@@@B@@@D@DAB@@@DBD@BDDBD@B@B@F@@@@@DBB@J@JBB@B@D@@@@@@@@@@@@@@BA@ABABCBA@A@CBC@EAC@A@AA@@@@AA@AA@AAA@@@@B@@A@@B@@@@AD@@A@A@CAA@ABA@@B@BB@@BA@@B@@A@AA@@ACA@@@@@A@@@A@AB@@A@@AA@@A@@AA@@@@A@@@@@AB@@A@@@@AAAAAA@A@A@@BAB@@BFAB@@A@CBAAA@@@A@@B@@A@A@AAC@CAAA@A@@@@A@@@CDA@A@CACA@@CAAAC@C@CAAA@@C@@A@A@@@AB@@@@A@@A@A@CBA@@@A@@A@@@A@A@A@@@@@@EB@@A@A@A@A@CACAAA@A@CAAAA@@A@A@@@ABAF@B@@A@@@@AACA@EAA@C@AA@A@A@A@A@G@CEMGS][[YQOW[EAA@CFCHGLABCFCBAD@DBHHH@B@B@@ABE@OCIA@@ADAB@@@@CH@@E@GAIC]M]OAK@ABG@A@@@COIEIAAAAACAACAACAAAAAACA@CAAACAAAA@CAACA@AACAAAAACAACAAAAACACAA@CACAAAC@CACAA@CAC@CAC@CAC@C@CAA@CAC@AAC@C@C@C@AACAAAC@CACAA@CAC@CAC@CAC@C@AAC@CAC@C@C@C@C@CAC@C@CAC@@@A@CAC@C@G@K@C@C@C@C@EACA]¼MzCNANAHKbIzAFGlG^AnATCXAj@F@LBLBHDTBJDF@JDDDFFVFJHBB@JAHAD@BBBBDFFDLFT@B@BBBF@DDDDDHFFFBDBDJHRJJF@D@HKV[hWjELAHAH@HV\\DB@DA@@BA@IDCBAFAFAJ@B@BADCFKNEFABAFCH@DB@\\DB@BDFF@DBD@LBHBBFBH@TAH@RBH@F@FADCFAF@D@D@FBFDD@B@RAJ@BBD@@BDL@XBBB@B@B@B@DAB@BDBBBB@@LAB@B@@HBBB@H@FABABADCBC@ABA@AB@BADBBB@@@@BAHI@ABAB@F@FAL@H@F@F@F@HBFBD@B@B@B@@@AE@A@A@CBCBCDED@@AJ@D@DCBAB@B@BB@BADEH@D@@JFD@B@FBB@BB@B@BBBBBB@BBBDB@BA@@@ABE@AB@B@BDD@J@B@BABCBOBA@@DABAD@B@B@@DDJBDBJBBBBD@L@LAbEJCHAH@F@H@BADCDCDAHBD@FAFCVOl_XOLEDCBABCCYAE@CB@TCN@JBFBH@FAHALAPAH@F@D@JBHANCNAFAP@F@NBN@FDDBD@D@JCFANAN@DAN@J@DAXBZDB@VF@kDBEJ@FMJIHGJBD@@AD@@CHK@A@ABA@A@A@@@AB@@AG@C@E@E@CA@A@@@@BAA@@@A@@@@@A@@@A@@@A@A@A@C@A@A@@@A@@@@@A@@@A@@@A@@@A@A@@B@@BB@@@@@B@@ABC@@@@@ACA@@AGA@GC@@EL@AEFA@ABC@@A@CEAEA@@DA@@A@A@@ACAAA@ABEB@@AA@@@@@GA@AA@@AA@@A@CA@AE@C@A@AAIBADAGD@BEFEF@DAAAAA@ACAE@IAK@OAOAQ@QADCDA@@FADB@BB@B@BAF@DA@@BABEBABAB@B@B@D@FAF@D@JAHAFAF@B@B@BBD@NAJBF@FAD@@ADAFCDAFA@AFAH@FBD@BBB@D@B@DCFEBAH@L@HBF@BAD@FGDAHCFAB@FAFEDABAB@B@D@B@BABC@@DAFADA@@D@F@D@D@B@@@@ANEBA@@@@@AC@A@E@CACA@A@@@AB@D@H@D@B@DABABCOE@@GAFIEADE@EICDC_KBC_I@DKCCBCEA@MEEEEC@IACAG@CAAA@E@ECcWEEB@@AN@B@@@@@@CB@@AH@BABA@E@@CACAM@CBEBEBA@C@CAAAACAAAAE@KCA@MKMAI@@AB@HCNBB@LDFFD@DBDBF@D@D@B@F@J@DAF@JBLABADAD@HAH@BAD@FCD@DA@ABCDCBADAFEBCBEDCBKBCAC@@A@A@A@@@A@CDCBA@G@G@MAKCQCM@E@C@CBC@CDAB@@KH@BCDA@@@FF@@@BAB@@OPA@ABA@A@IAOAQCA@@@@@@ABC\\[FCDCAA@@CBGGSQEEOKGGA@@A@@@@BC@@DGBEACIEOKEEACAAB@@@B@@@D@DAB@DABAFCDCBC@@BCBE@C@CCEMEA@CEACECIAK@SAC@EBIDE@G@QAC@C@MDGD@@ABAAE@CA@ACCCGEIACKSGKCGGKAACECCCCCCA@AAECMGYGAAICAA@@BADAFAHELKHGBABCFAJAJ@PAT@JAJEHE@C@@WGKECC@@BCBCDCHCHCFCBABCAE@ACG@EAE@CCM@C@E@CBABCDG@C@CAAEEAC@CBCBE@A@ABGB@AAAAMKAA@@@ABA@A@A@A@ECC@AC@CAA@E@C@@AACAAC@C@E@C@AAA@@@A@"],encodeOffsets:[[77550,39925]]}},{type:"Feature",id:"653122",properties:{name:"疏勒县",cp:[76.048139,39.401384],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@ABEBABCDABABC@AAE@C@ADA@@@A@C@AIECCAEGAAAAA@ACA@A@ABABADENOBADCDCFIBEBA@CB@@AB@D@H@DBFBDBB@D@B@JAB@@ABAHEB@DCBCBABAB@BADBJBRBDBBAD@FCLADA@@B@D@@@B@BADEDEHIDABAD@BCLIB@LEFADC@EBAB@B@DABA@@@A@AD@BAHBD@B@@@BCB@@BFBB@B@HABAB@HAB@B@B@JDBBD@HBFADCDAHEDABAJAFAHCHCBAFADAF@B@BBBDB@BAFCDAHAFBD@J@H@JCJ@HBPDHAPCNERGHCLCD@J@F@J@H@F@FAHCNIXK@ADCHEPMHGJGFCB@F@H@D@DAFEFENIHAF@HAFADAHAB@D@B@HBBAB@D@BAB@BADAFAFABAFALCHE\\GLAHAFADCDEBIAQA]GAoCIC]AOASmLIB]J©fVŝVePGBIDOBEDGHUJAB@B@BA@AB@@CAA@A@CBCB@BCDEFGF@@@B@JAF@@CHBBCFABA@A@I@E@ABCBC@SFA@C@CBGBABC@IAUAC@S@CAC@SFKDWFODE@CAC@ABC@A@A@@@ADCFEJKRABGDE@IBG@SAIAC@EBA@KCC@C@CCAC@AA@C@CAGEKCICG@Q@MDGBE@GBEBGBMBMFEAIDMBG@IBM@GBE@@@A@O@E@G@MAGAI@M@G@G@KBOAKA@@@@AH@FCHABGHEHAH@JBNBFMNSVMLA@KPCBCDCFYVIJGHA@STOPGHANBFDDABA@E@IBCAAB@@@@@B@@@BD@DBBD@BBFBDDBF@F@H@H@HAL@D@@A@A@AOCGC@@@@@A@@DAZ@FBD@BLBDB@B@DBHDBB@BBF@BFBF@FBXFJDTDVDLFHFHHFFBFBB@@BD@B@BCDAD@B@B@@BB@DABEDCBG@E@C@ICEAA@C@G@C@A@@@CBA@KAC@EBA@M@IDWJEBE@M@CAC@A@C@@@CBAD@BA@@@GCCAC@CBE@C@A@CBCBCBEBOFG@GDEBEDABEHILEBCBC@A@GCEC@@EBABA@@@EAA@A@KFIFC@C@C@@@@@ABCBABC@CBBDADALCDAFADEFCBABCDAD@BCBC@EDC@ABG@GBC@CBABKBIAE@CBI@E@A@C@C@E@CACAC@EEKCA@MAGDA@@BJ@NBNLB@LDF@BBBBBDBBDBD@B@FAFADAN@DBDB@@@FABABG@@BA@@D@@@@A@M@@BA@FFdXFDF@B@BB@DBHBD@JFDFFNFB@DFDALD@C`JAD`LCDJD@FCFFBEJHB@@PFADABCBA@C@G@C@A@@B@@@BDBDBF@B@D@@B@@@@ABMF@B@@A@C@C@@BBBB@BB@DFAJ@BAP@DAB@@B@@@D@BB@@BD@@B@@J@@BF@F@BB@@CFB@@@B@@@@BB@@B@B@@AB@@@B@BGFAB@@D@B@@@FABAD@@@BAB@HABADCB@FAD@B@NCD@D@HBD@D@D@B@H@JBD@B@DAB@BBD@B@H@B@BABAB@B@@@BBB@HDB@BBDBFDFBFBB@FCDADAB@@BBBB@FDDBD@D@B@F@D@DBDBDBB@B@JADAFAB@BA@@F@H@B@BAD@BABAB@FABABBBB@@BA@@B@F@BBBBBDBBB@B@D@B@DAB@BAFADADAFAB@BBB@@@B@BAD@@@FADABAB@BIDC@ABAB@BAFCF@BBBBBBDBDBHDFBFDB@H@FBB@HAB@BAD@F@DBF@DB@BB@FD@B@B@B@F@BB@BBD@B@B@BA@A@A@A@AB@@CAA@A@ADABAB@DAB@D@DDBBDBHBF@J@D@DAF@B@BBBDDBBBB@BAD@@ABCDAB@D@D@D@BBBB@B@BAB@B@@@BBBB@B@BBDBDBFBDBFBD@B@@@@A@@@@@A@@@AB@B@H@D@@BB@@BA@ABCB@@@BFFHH@B@@@@A@C@@@A@ABABAD@@@BBB@DBBDDJHFDHFDBD@D@DBDDFHHDHFDB@@PJLHXRHBLALAPCZIT@HAVEXEACECAAC@CACA@AAAKEECAC@A@ABCBC@AACBA@@B@@ADCFIBEBC@C@ACAAAC@AAACAACAE@G@A@AAA@AAGDA@CACAACAECEACMQ@ACICACACACAGCCACACCAC@EAECACAGBC@AA@@BAHE@A@ACA@AC@KAE@CAG@K@C@C@EBCACACA@AA@@CACGAE@A@C@AAA@EAE@C@CA@E@CCCECKE@@@@BCHGDAD@`BB@BABA@C@CB@B@@@F@JBD@F@B@B@FAB@D@HD@@B@D@DAJAF@HADADADAHA@@B@BBNBFBF@HBJ@F@D@NFDBDBTBRAB@F@P@D@HAD@F@TBPDD@HABABA@C@A@IEGCWAI@CAC@@CEAC@ECI@E@A@@@CAK@EAAACCCAAAAECCCCACGIGAAACACEGAC@C@A@C@AEC@@@CAAME@EACAAA@AAAACAAAAAAA@AAAA@K@@@C@AACCA@AA@@@C@AAAA@IACAACCCKEAAIOAEEEAACA@@CA"],encodeOffsets:[[78255,40138]]}},{type:"Feature",id:"653123",properties:{name:"英吉沙县",cp:[76.175729,38.930381],childNum:1},geometry:{type:"Polygon",coordinates:["@@AGCEOKcQWKYKCMWqQoEMWGKEGo`KHQJUN]NQJSJWHG@EBADCFCBIF]JUHIBQ@yCA@EAGEUGQEE@I@SFUDWJGDCBAD@BDJJXDDFBHDJDPDZHPHNJDDBB@B@DADAH@FBBLXF~@F@HADCBEBIASCaCA@G@GAM@MBGBIDEBCHKXCDCBK@QA[@EBABA@CDCBABG@E@GCSAOEKCKECEGEG@AAIBUAU@I@O@KAG@MDG@KCKCOEE@E@EBADAD@@@@@B@JBHBF@@FHHV@@BD@BB@BDBDBDCDGFIFIBC@M@KBEBABGPGJEDILMLEBABIBGDEBEBEHWLEFADAFAFCDCBIDGFCDCF@DFHBDCDC@GCGCIAC@EDCFCF@FDJFJAFCBIBIAG@@@I@CBADAB@@FFJFABM@I@IAMAIC@@MAEAA@@B@DJHDN@B@HCLCJCJAJABA@@@A@@@@AAAGECECAA@EAEBCBEF@JADABCBCBE@EBC@@@BFAJBJFFABM@BBDFABCDAAAXGVCHMVWViX·}ZsREBWHCD@@BBBBB@BBB@B@BBFDFDDB@@DBFBDBB@FDDDFFDDFBHBB@FFBBB@B@FBB@B@DBFDDDFFBBFBB@DBDDDBDBB@DBHBF@DBD@@@B@D@D@DBHBJDB@DFB@@@BBBBBBB@@BBDB@@BBBJDDB@@@@DDFDD@DDHJDBFBDBHBFBD@DB@@BBNLFFB@D@JBBBB@B@@ABGBI@OFQDEDCBAB@N@N@F@LBB@LCV@LARBNFHBB@L@J@B@NCNApBJ@H@NBNBFBHBVLFDFDRHFDB@DDBBD@DBBBB@BBBD@@BBD@@@@@@@JBJBHB@@@@LBPBLAH@H@N@J@HBNBH@F@P@B@@@F@HAN@JAH@NAJCFBNENAHAFAHAF@HANCR@H@JDLDHFDBD@B@@BBDDDD@D@LDB@FAD@JBTBH@JAF@HCBALQFIDEBC@@B@B@D@BAD@DBF@PCXELCTED@DBT@D@VBJBD@BAHADAD@B@TED@DABAF@J@B@B@BADEAADG@@BE@I@A@@HEFEDC@ADADAB@B@DB@@BAB@@A@ABAVIHGFCPAJCHAfOUŞUªe^IJAnKe{GMQ]EAS[q·CCMS@I@GDILMDGAOAEUOiOKAQGQG_eKMAK@GJgBM@G"],encodeOffsets:[[78534,39490]]}},{type:"Feature",id:"653124",properties:{name:"泽普县",cp:[77.259675,38.18529],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAAAGAA@GAE@C@ABABAD@FAD@BA@A@S@DBk@GCEEOGUQAAECwmCCyg]YSRWT~ABSTCDON@BA@@BBBFDDDDD@B@DBFBDBBBDB@DBFFFDFBDBF@FBD@BHDHDDHDFBFDHD@BFFDBBBJHFDFDVNFDHFFDLFBBHBFDHBBBDBHDHFBB@@BBBHDFFDPZ@@@@FFDHBHDFFFDBFHPLFFZLJB\\NZLHDDDDDBBBFFHBDBBFBJFFDLBJDNBFBFBDDFDHFHJB@@BPNFFBBDBDDFFDDFFD@FDFBHBJ@B@BBBHBDBBB@FHHDDBDBFBB@FDFD\\PHDPFHDLD@@NFBB@@FB^DLD@@B@@@B@B@DDDHDBDBBBFDFDB@FB@@FBBBBBBBDDDBDDJFDFBF@BBBFDFBJJNFFBP@PAHBD@FB^LJFDB@F@DEHADBBPFPDH@FADCBCHMJILEJC@AB@DCBCBIBEDGDCB@@@D@JANCDADEBAD@F@D@DCDA@EACGGCIBECIEECAGAEAAABCHEDC@A@AEAE@@@@@@ABAHAFCDA@I@A@@B@FBFDFBB@@A@@@ACKAACAGCCA@@AABA@@@ABAFCBA@CAAAACAE@G@A@CCAC@E@A@ACAEACA@ABABADC@@@AAAECCC@A@CDC@ABC@AA@AAA@C@A@@A@CDABA@A@AAAEAKKAA@C@A@AAAAA@A@A@C@A@AAEAA@@BAB@D@D@BABAAAAAC@@@A@A@AA@A@CBABAB@BAB@BA@EAAA@A@A@A@A@@A@A@A@AA@AAE@@A@A@A@@@AAABAFCBC@C@A@AA@AAABA@@B@@A@A@@@@E@@@@AAAAAA@@@C@AAAC@C@AA@E@AAAA@C@A@C@@@AAAAAC@@AACCCAI@A@CA@CAACA@@C@@@CAAA@@CAMAC@EAA@@AAA@C@@AAC@AAECA@AACAC@A@A@A@CAA@A@A@AACAA@EAAA@@@AAACAAA@ABC@CAA@ACC@@CAECCAA@ACCAAAA@A@ABA@@@A@AC@AAAAACAAA@@@AB@@A@AAAAACAE@@@@A@A@AC@CAC@AB@@A@A@@AB@@A@AAA@@@CAC@AAAEA@@CCA@@@C@C@@@BAAA@A@@A@C@AB@A@A@A@AB@BA@@AACCGCA@CCA@A@CB@AA@EAAAEAEAAA@@@AA@@A@@E@EAC@@@@@AFA@@BE@A@A@CAC@A@@@ABA@A@A@AAAAAA@CAACACAGAA@EAC@CCIA"],encodeOffsets:[[79066,38899]]}},{type:"Feature",id:"653125",properties:{name:"莎车县",cp:[77.245761,38.41422],childNum:1},geometry:{type:"Polygon",coordinates:["@@CC@A@@LE@AB@@A@@CCGAG@AAC@@AAC@ABABC@CAAAACEAC@@@C@CBEBEFEDC@@BC@ABAACAABG@EACGAYCO@M@EAGAGECAG@O@QBIAKCaIIAQGQIQKQOmSIIEGYOIIMSEGGCEAKCI@MBGGOMOGSGKEEACBEFEFEDIDCBEDG@CAICC@C@E@@@CBAA@@EEEAE@EFE@CDIBEBE@AACAECGAIAIAE@IAGCCCMEKCE@E@E@E@AC@GCMEOGEGAQ@GAEAEGEEGIAIDQBEBEAECC@EBGFCBA@G@@ECUMKC@@IPEBKFED@DEFEDGBIDCDCB@FAF@DDDNFB@@LBJAHEJ@F@L@LAHAHBFBFFLHJHFDDDFAPAR@NCPAFENADDDHBBFGPAHADFBRRRLBFADABC@GFGHCJ@LCFEF@BEDAHAD@J@ZADGHAD@@CD@@@BBD@B@@QFOJCDCDAD@JA@ABG@CBUR@@@B@BDLDHFJDJHPDHN`HJBJADABAH@LBDBBBBHD@@DFFFB@N@VBNDTBHBLBNFFDDJBJ@FBDBDBHBJAPADCHADBBFBH@J@NDHHFBD@FCDGDEBCHAFERKHCXGRC^BNBRHFF@HAJCDEDOFCDAD@DDNFD@DAFCFCFOTAF@BNJ@BAB@BCD@@CDAF@DFFVRLFDD@HDFBBHBDBBB@B@DAF@BADBFBDDDBB@@F@D@@BB@@B@@BB@BA@@BAB@BAHBFBJ@BBD@B@@@@AB@B@BABBDBH@F@JBDBDDBDDJHJHDF@D@BEL@FBFHPHJJDDD@HAVIjCXNnPZB@HJZ`XdT^HLPTDP@XAT@N@`Ad@F@J@NF¾@hDpFRALCHILCDFHHLXFNRpXrDNZLXLdRPLDFBH@HANIh@HBLLN`fRHRHLBjPVPBFBPCHKNCJ@H@JNTDD¸rT\\FBR^HNFAB@D@LA²I\\Al@nCŊU\\CLAB@N@H@DBFDDDFDFHVZpn¢ nr¤ªXZPFL@J@F@HDDDRXRPLPNPDFRTJ`DJBFA@@@@@C@ADADCFADAHIFEDE@AB@B@JCLA@@@A@AEGAAIMCA@EAAA@CAAA@A@A@ABABCBC@C@ABAH@BAACEGAAA@A@EFABAAAAAAACBGAG@C@KBO@YBIDEBGACGGCE@CBEDCV[DKDCDCJCNAF@FDBDFNBFB@B@DADAD@PHHB@@B@F@FABA@AGM@@OYCCFCBCDCDAFCHAF@B@@@@B@DCFAHAF@DB@L@J@BADA@A@EEQAGAG@AB@B@HFD@F@DA@AGE@A@CBADARGBAFC@CCMCC@CACCECAEECAGCGCI@CDEBC@MACCGIOKMGECACCG@CAKAG@K@EDCDEDC@C@ACECCAAGCACBADA@AF@HBDBJAZ@FAFADEBA@AEA@AE@A@AA@ADCDABADEB@F@DBJBHBB@B@B@DCLW@ADKHGBE@C@A@I@EAC@C@C@@BA@A@@AC@@@AB@@AAAAIAC@CCEAGAEEQAG@C@E@C@E@I@E@GBEAG@G@C@EAI@C@CDCFCDC@A@GBC@CDG@C@CAKACAECIAEAECGAGACCCCCEEICCCCAAE@E@AAAA@@@@AA@@ADE@A@@CC@A@AAG@@@@C@A@@@@AAABABABAFC@A@EEEEGECAACC@GACBECCBABABB@@BB@D@DDJDHPJABFHD@@BDB@B@BBH@J@FFPFFDBHBJFFBD@LBPBF@B@B@N@J@B@D@DAHCLKPKNMFCXQJIDGHIHAHAZ@z@Z@RBrCZ@JCPYN_FWDcB[BaBm@SDAkEEcSiWCCACBCFO@CAAAAEEIESYQWKMEEYMIGGEMCËEiAK@G@eAiCOCWEKAIAM@C@EAC@QFC@C@A@CCECA@C@A@EB@@DBHDDBBBDL@B@@@BA@EAECEAA@@@@B@JCBEDGBAB@B@@@@F@FB@B@BCDGFADBBFBHBDBFFDJAFDJHHBD@FCBCDC@E@C@ABCFCBMDIBC@@@A@CDCHAFAJADCDA@@BIDKFIJGNADCDEBG@OCOEAABCFG@C@ECAIE]KEAC@GAOBO@EAMEIIEAECAA@AAECEIECCCACCAAAAAAEA@@EAA@ECECAACACACGCCA@A@@@A@@@KC]CEA@@AAME@@KCGCOEGC[OECECA@EACACAGCEGA@AAACAGAAA@I@GAEAECC@EECCEECCCAAAEEOM@AA@GIGEECCCEAEAMAICKAECIEEAAAACEGAEAACCCCGCYK[MIAYKEEOKEGCAEECEAGCGEE@@@@OYECCEAGAA@@AAGEGCCAAAGAECGAAAKEECGEECUMECECIGAACAEE@AGCECEAGCCCCGAGC@EAE@CAEAECEECAA@ACAAACAE@C@ACCCCECAA@AB@@APMDCTSBA}XSTQkeea{smcAA@@EGMGGGEIIKCGGQAAGMACUQEGEGAIBKASCIISCGCG@AAAAEAACK@AAA@A@AAECGAA@C@@@@@A"],encodeOffsets:[[78644,38614]]}},{type:"Feature",id:"653127",properties:{name:"麦盖提县",cp:[77.610125,38.898001],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@A@I@M@A@A@E@OAKAC@EAIEGACAEEEO@E@IAG@A@ACA@AC@EGBAOICGCI@C@CAA@@AAABABDDAFBD@HDDBBFDFHFF@F@BEDABABABBB@B@@B@D@@@@@BH@B@BDD@@@BCF@BB@@B@@B@BB@B@FBFDBDDJDFFDDDDBDBHDHBFBFDJBFBDBL@D@DCH@DAD@H@BCDEDCD@D@DBJ@F@D@HBHAF@H@F@J@F@D@F@DBHFRBFBHDF@DBDBJBB@BA@@B@@BD@@@BAB@@@D@DBD@F@J@B@DAFGHCL@BKXCDA@A@A@GAIACAE@A@CFABCBCD@BBBB@F@@BFB@BABCFEBEBY@IBCAGAE@@BCBABBDHDBBDDDF@B@DCDCFCD@F@LBHBL@DDHBDFDNHPLHJDDNBD@FADCJ@HDHDDBFFDBDFBD@DDDDN@DEDABQHCBAB@D@BHF@BCBE@C@GEA@A@@BBHBHFR@F@BCBABI@K@A@@CBEBGDE@C@A@@A@E@GBEDCBCDADEDDDPZ@@HN@BABEBE@A@@@GAOGC@CBCBA@A@AEEMACECE@MBIDCDCDCLU\\CDAF@DDFHHBDAHCFAJ@ZAP@L@DBHAHBDBBBBBBBAFEB@B@BBFHBDABG@AB@B@DADADAB@B@B@BBBDBB@BB@FDBJNBBFH@B@B@@KBIDA@D@D@FHSF@HABBBCDFD@DIB@^zYBBB@@@T@BA@@L@DBFBFDFDFDB@DAB@BCBC@IFCHEBADAFEHG@ANEDAFC`EFBH@rJTDHDFBBDBDBF@H@D@@AFAR@BA@CJAF@D@DDJBHBJBHNPHHJHPLFHFH@@ADKLENEJ@FBBFBF@H@JAJEFGFIBCFIFCHED@B@HBBDFN@JBHDJRbBHFJDB@BF@D@JAHEd[JGLGD@FBD@HDB@BBB@@BBL@DADCDCDCBABIDABA@AB@DAFCBA@I@EDAFBFLXDBFDD@N@B@@DAJ@BBRBBD@HAD@FAJAFAH@HDH@HBDBBBDDBJBDFFDBJDFBBBBD@B@DCFBDBDBDFBBBLBD@BBDFB@DBF@HAB@V@B@NB@@JDDD@FBB@BNHB@VFNFDBDBRFFBBDBBDBBBLJDBBBBBBBD@D@H@L@FBHBHBFBDDHDBF@BAFCFABKH@BA@BFFFBH@J@JADER@DBBB@B@D@DAPEDAD@D@PDH@L@D@F@BBNJHDDBFBLFBBBDBBB@B@JCLAJ@F@F@HAH@FBBBBBBB@@B@@@DAB@B@B@B@@BDDBDBBF@FAB@BABAB@B@@@@BDFBBBBD@FAB@D@B@D@BADCDCBABABA@@BEBEDEFEDADAD@B@BBBDABABAD@D@DDHDDHHFDFBBDDBDBB@BAB@BABEBCDCBC@@FCBCBABAD@DABB@@BH@DBBBDDB@B@B@BAB@B@@@BBF@@@@@BA@@@A@@B@B@B@B@@AB@BB@BBBBBBD@B@FAJGB@HAFAD@DBB@B@DAB@DAB@D@NBHDDDD@B@BBBBD@BBBAB@BADABABCACAC@C@@BABABAFCHCB@D@D@BBBBBFDLAD@B@BABAB@B@B@BBBBDFDFDB@B@D@BA@@BBFBFDBB@B@B@BA@CBEBABAB@D@B@BB@BBDBH@D@FAHCFCFCNOFCB@B@BBBD@DB@@BF@BBDDDF@BDBBBDBHB\\FB@DCnmbaĐċvuxuB@¶µ¤£°­F@HADAD@LAF@DAB@BAB@@@FC@@B@B@B@DB@@B@DA@@BAB@DA@@B@@A@AB@B@D@B@B@B@DABA@@ACB@@@BADBH@@BB@B@L@BBB@FBHBFD@@B@D@D@D@BBBBDBBBD@DBHBJBDBB@BBF@D@F@F@BBLALAL@D@D@FAD@H@BADAFAF@DAF@FCF@FCDAHEBAFGBADADCFCBADA@@DC@ABAHEDAFCHCDABAHGHEDADCBADA@@BA@@BCBADAFAB@BCDCFEBCDCBABEBA@EBEBCDCDCHCHEBAHEDEBCHOBABEDEBCDCDCBAHIBAJGDADAD@DAHCDAFABCFADAFCHEPKBABADCD@FAHADCFAHCDCFEBCBADCFEDCBADC@ABA@ADADCBAJANCF@HCD@FCDCB@B@F@D@F@VAF@F@HADAJCFALAFAFAHCDCDABCHAD@FAD@F@B@J@FBLBFBF@H@DAJAB@D@JAD@F@H@HAJAFADAJALEB@@@@@FDFFB@@@B@DBB@D@D@FAF@bCV@B@D@DAN@HAPCF@D@F@F@H@D@HAB@D@NCHAFAJCFAD@HAFAJCD@D@D@HBFBDBFBFBLDP@H@JANCHACAA@CCAA@AAEEIAACAGAOCMEYGMCICEAE@A@A@IFIBmDG@G@Q@SCKAKCKEUIGEgSaQq¡sqqWMu[yYaÝw[y[y[{]gSKEu[eÑ©uICKISOMBG@A@GAE@G@EA]DEBE@@@GCA@C@E@E@GAI@OBCBIDC@KBMBECA@C@C@WAC@KBI@GBG@Y@I@QBO@C@OBA@CACAE@E@C@C@E@CBMAIBC@C@C@G@A@IBCBkBIAQBM@E@MB·BM@M@{D«@ÿBËB@TAnAbA\\CdEXM`OZIDY@qDQAY@y@Y@GBGBGJCHIJWREDMNOLKLGDCB"],encodeOffsets:[[79453,39484]]}},{type:"Feature",id:"653126",properties:{name:"叶城县",cp:[77.413836,37.882989],childNum:1},geometry:{type:"Polygon",coordinates:["@@PGRIDEHILKFGJMFE@KCGSWEE@GJGHCNKBEHCH@L@HGJEBCBKBCFCHBH@JEJAHAHIHKTOPEHCHCDEFKFCHCF@N@FDHDD@JEJAFBFFHJLJFBrDJAFCFCL@D@XOHKLEFGHOLKROLKHCDGBGACHEFGVOHGBGBAFEFCRGHCFCBIACGCQAKCI@KEIICEE@E@IBMHCBG@EAMKEGAEEEAAKCGAGCGGIEAGEIAEAEGAKAEECC@MBYAAC@MBA@E@ECEAC@E@QDIDG@C@A@GCEACEAECAEEBCDGAKAI@EBI@CAE@KNUBE@MDIHELCNCP@J@HAFCFAJ@J@F@LANGJEJCNEJEJCLCJCFEDCBCBA@@@A@@@AD@BAFAB@FBD@D@B@F@DAB@BAB@@C@ABEB@BC@@BC@A@@BCB@BA@@@CBCAA@ABA@ABCDCFABABCDAB@BA@CBAB@DAF@B@DDFDBBHBB@@@@AFE@A@AAA@AFGB@HCBA@A@A@@A@EAAA@AAA@A@IAA@@BAFGBAF@@AB@A@@AGCCCACCAAAAA@A@C@AEAC@ACCAA@AAG@A@CAAAKCA@@@@AAABA@A@ACCCA@@CAABA@@@AD@DCBCBA@EBCBAB@BA@IBE@C@E@A@A@@@AAA@CBCBC@@@@@ACBCAEBABCDADABCBABADADAFEDCFIDG@E@@@A@A@@BC@A@AAA@AAAA@@CBC@E@A@CBCBIBAB@HA@AB@@CBCBGDEDK@GAA@C@C@E@IAI@AAA@@MDC@C@IAE@A@AAACCECEAAA@@@E@CDIFA@A@A@AAA@CCCG@M@@BAD@BAB@BCHEBC@@HE@@BA@AAAAC@CCG@A@C@@BCDCBCBAB@FADABA@A@A@I@ACAA@C@CAABGBC@E@IAM@EAAAAAAAAE@GCG@C@ABCDE@AACAA@C@A@ABADCBAB@H@D@B@B@BADAHEBA@ADEDAFCBA@A@A@C@C@ABABCDCB@@A@AAACEGGCCCECAACC@A@A@A@G@A@A@AAAA@C@CDCDCDCDAHAHABA@A@K@CBCBIBIBGAG@CEI@C@A@ADCBA@@AA@@E@C@C@IAEAEEKECAC@CA@@AA@C@A@A@ABC@@BABC@@@AA@C@EAKECAECA@AA@AA@@@A@A@@A@ABA@C@ABAAEAC@@@AA@@AA@@@AAAA@ABA@A@@AA@AAAAAEAA@@AACA@AAA@@A@@@@@@BAD@BADCDCBCBEDEDIBCB@@AB@@@B@@@B@BBB@FFB@DBD@B@B@B@BB@@B@B@BAB@BA@@BA@@@@@CBIBE@@@A@@@@A@@@A@A@@AA@AA@@AA@ABCQKEME[IAQBWBWFOBGIGCSMIOCMFOECICc@OAIEEIG@MPQROLSLQBMGBaGEUJWFYDQAIEC@CFALEHGFkFcFQKWLaC_GSEcOOIUBODQHGDU@YAQFOLERBNEDEBI@KAM@OLERK^MNYLUH[CYE[AiMcGcIa@SHQN]LSHMLQLKHQF_J[N[XMTOJSDE@@LBNCJEHCH@H@J@HBJDH@FBDCHEHOPWLONIJKHMNCHAFAJELBHDHDHFDJPJLDJDHFDDBHBTAPEN@HDLHHJDF@RAJCJKPKLGLEFGJEJ@DLDH@NHHFBH@BEHCFEJCFIDIFCDBLDFLNBF@BAFIFGDCFEJCJ@TE|IVC\\@L@B@HCFCJABIDGD@DAD@H@F@@@BABA@GDEBCBABABAD@BAJCPGLCH@@AFGFINADABBBFHNNDDBD@BADGDIJOJCDCDAD@F@FBHFTBBFNFPAJ@D@BDDJBN@JDDHH^NVZLHFDF@DBFCFCHGLADBHD\\ETAFGFCFMJEHAFCBMTEH@J@NDAH@FAHCDCDCLEPBP@@ABEDSLE@I@a@OAGBCBEFCBGDGBIBcF[HWNULCBGBC@YBSFCBEDEDEHABC@C@E@CBED]RCDCHEDEBEFADAJEfCFKHKFOHMNMHKHIFEDCFWZ]fINCLCDEDKDG@M@EACBIBIDGBAFCDCDEBE@IDIFKF]NE@E@WAC@C@CDCD@B@DDDJBHDHDBDFH@NAHCHCDEDKDIFGFWRGDEBGBGFEFEJKPSNEFEFEFABABA@C@A@CAC@C@A@A@ABELC@CBA@A@CDEDCHAB@@CDEBG@MAG@GHULSHIDABABADCBABG@O@E@CAEC@@CBABKTGHQLA@C@A@CCC@A@C@EBIFGBKBKAIBSBEDGHABABEBEDED@B@BBF@FAPABIHCDCBCDAB@H@FDHDHBB@FCHABMFA@A@GCE@AAIEA@C@E@EDCFCHEHGDKDCBI@C@CB@@AB@DDDBD@DAFABEDIBC@CBCD@BAFCFMPGFGDCF@JAF@DAF@DBD@BAFGJGDGFGJEDABADAPAFCDQPAB@BDTBDBBBD@D@JEJEH@FAFDH@BABCBADAB@D@BDFBD@BEZAFCBEFAB@B@BFDNHBDBHFFDFBHBJ@BDBFBDBDBFN@B@BCPDJJRHLBH@PHHDJDHJFFH@F@DBJBL@HAJGLAFBF@BLLFJBLDF@HCNCRBFFH@HCN@HEX@NBHFDBD@FAJMRGPAJ@JBDBFDF@BGDIDGNCFURCLGHGFGBEBKHADADAJBNDVHRAFAXDPDFJFDF@JATBH@FDJFDBF@NFBJLJFFHDADADAHAJBDBLJDBH@F@L@FCFAFAJDHDFBFAHAD@LDJDLBF@J@HHJH@FDBDBF@LHFBJ@P@L@B@LDB@FBFD@H@H@NCJAJ@FBHFFLDVNFD@@@HABEDAH@FDDBFAFAFCRBJHJFFFHFBHBR@HBHFFPDN@HBDF@F@F@F@LDNFDDHDJBF@JBJBHBFDDBBBF@FAJADCF@FEF@FBFF@@BBDA@@F@D@D@JDDBH@FCDAJCFCFEFEDAFBLFTHPHPNHHNAJ@LDFBHDFHNTJJZPFHJJnTRPRLRJRHJBbJLDJBRAP@H@DBHFHBFBN@P@ZDHBBD@FAHBBBDAB@BAD@@CDEFAFAF@D@D@@BDDFBBBB@DADAB@BBD@BD@BBH@HBDD@@@BA@@BKF@@@BDD@B@@@@@DBBDHBF@B@BBB@BDLBBBFBB@BDHDHJTDJBTALBJFHFHVRBDHNBBHRDHJLFJHHNHFH@@BBnd|tfblf^ZzhDDxnFDBBVRPHFFHDl@ ACT@B@B@@ABC@EBCBABAD@F@HBB@HBBBDBJBDDD@FBB@HBDBDBBB@DBBBBBBB@B@B@BA@@B@D@DBB@B@F@@AB@BE@@@@D@FBF@@@@BB@@B@@BBFBFBBBFBB@@BDAB@B@DDB@HDDDBB@@ABA@@B@B@B@BBAD@B@@@@BBBAB@@D@D@@@B@DD@@FBBB@BBD@D@@BB@B@BA@@BB@B@@@BAD@DBD@@B@B@B@@F@DBBBBB@B@BA@@B@@BBDBBBBB@BBDB@@@B@BAB@B@BBDBBDB@DBFDDB@@DD@BBB@DAD@BBBDBBB@B@@BBFBB@DBBBB@B@B@DBB@B@B@D@DBBBB@FDBBD@BB@@@DBB@BB@FBD@NBDB@@BBDB@@D@@@DBBB@DDBB@J@DBDDBB@@BDBBBB@@D@B@D@B@BB@B@FBBD@D@BB@B@D@@BBBBBB@@@@@F@@B@B@@@@AB@BABBB@@B@B@DADEDABBB@B@@@B@B@BF@BBB@@B@B@B@BB@B@B@B@B@BB@FABA@ABA@ABAB@D@BBBB@B@@@D@BBBBABABC@C@A@AB@@BBBF@B@B@D@B@BBBBB@B@B@DBBLLFBBB@B@BABCB@D@BB@D@B@BBB@@BAD@BCD@D@BDDFDBB@B@@CDABAB@BDBFBDB@B@B@FBDDDB@H@F@DBBBBB@DABEDAB@B@@ABBBFAB@D@B@FDDDB@D@D@RED@FBD@N@JBLBXFPDjDfBH@L@jBÌFNDHFJHZNFFLNRXTZJFFFBBBB@DEPADBDDDjXdTFFBlCÌAĀA¬@|CN@N@¸ANAF@N@RAJBlADAJAB@H@D@D@D@JANBDAF@D@D@F@F@DBDBB@PAD@P@RAJ@Z@H@HAJ@LAD@XBD@D@B@DFNALAD@JCDAPAJ@HBF@F@D@B@HD@@F@FA^CFBH@F@HBB@H@NAUQieKI§©QOOMEEYW­£­¥ws_[igWWUUQSY_KOAAmu]amwqyqy{w_geaUUGOEKBIFMFK@EGGQMGIKOGEEE@EY[ICIAC@E@QDEBEAGEEEAIBI@GAEKC@GDKFILM@EEGAGAODWFM@EEECGBIFKBIDM@GAICK@GDIAIEMACEKGQCSAIMWGMAMCGBIJEAAE@ECEGBCDCFEBEACACIGQIMEGCCGBI@GAGCGGGEEECCE@@AA@A@A@A@AB@DC@ADC@ADCBCDEBAB@DABABA@AD@NCDADAPGB@DCBABADC@@BCBCBE@@@C@CCE@C@E@A@O@A@ABA@A@ABCDABAFADABABA@A@A@AAA@A@ACE@A@ABCBC@@DCBADE@A@@BA@C@CBC@C@EACQIEE@A@ABABA@@HCLKHI@OAQDI`a@SAMGMQaDQ@ECEGAGCGECGEOAMBI@ONWDIBGAAECECCE@GACAK@ECOAAAAGOUOKEIICG@GCGIMCICCO@Q@S@O@MCMEMAG@EBKFIDMBGCKG@C@C@A@KH_@I@ECECE@ODKDEJEBA@A@GKUKKKCKEAGK_CGAKEICGBMBIFILGHANMJGDEBEFGHGB@FCJEFGBCFCF@H@FAh]HCLELEFEFGDCDAJBF@DCTYJCJ@JAHCHIHIJQBG@C@AGAE@I@EEAYCGCECAGC[@MAMCC@cMAA@@@E@E@CAA@AB@DAH@@BF@D@@A@@@CEKAEGAMAK@I@OFM@I@MACEEGEIIGEAEACEAG@GAGCGCABECEEGAEICOKKBODIBGAEAGEKIGAIAG@QFK@ECEAIBCBCBCAGA@GBGBE@CAECECCAEBGAK@CBEDCJGJEHEJGJAHAJBH@JALAL@PDLBL@L@JQDE\\GHAHDL@DCFGFELCHALEJAHDFDJ@DENKPIHGDEBE@IF["],encodeOffsets:[[79448,37299]]}},{type:"Feature",id:"653128",properties:{name:"岳普湖县",cp:[76.8212,39.2198],childNum:1},geometry:{type:"Polygon",coordinates:["@@[DŉVmDk@[B±JKBC@A@EBf|BTBPD^DJBpHB^BRAJCFCDEBGBKB[HGFKDEBABEBEBCBABA@ABC@A@ABGAA@C@A@GBCBEBGBE@GBMJEFEFCBC@G@E@A@EDIHGHONGFCD@BWLMJGDEBE@G@I@E@I@C@KDGDQHMFODGBOCGAI@IDG@I@C@EAGBCBEDABA@ACAAA@E@CBEBABGDGDEBIBABCBGFCBCDEBGAC@AAICA@A@A@GBA@ABGBA@A@EA@AA@AD@@A@C@GAABC@@B@B@@ABCBA@A@AB@FCDEBKFA@KJADC@ABCBGJCFCFABA@@@C@A@@@CBKBEDC@ABCAQAIACAABA@ABABADCDA@GFAB@BA@IBA@C@A@CAEACAG@C@A@@BA@@DABAFEJCDCDABMPCFABAB@B@BDB@BBBBBHBBFDDJF@B@D@B@@CB@B@DBF@BADABCBADABAF@B@@DB@@DBBBFFBFJPBBLFDDBDDBJBB@BB@B@D@@BBB@DDBBD@@@L@B@BB@BBBBBBBDBBBBBB@BBBD@FNFBB@D@@FD@B@D@B@DBDFHBDBDBBJHDHDBDDFDBBBBDDBDBB@FBL@D@@@B@FDJ@FBDDF@@BD@DDBB@B@B@B@HAD@F@DAB@@ADABAPCFAD@J@B@B@BA\\EB@RAF@B@ZBF@D@DADAB@DAB@HANFLHD@F@HCDAHAJ@\\@H@PCDCHGLKBCHEJEPAJCNCPAZGLCJALBB@LBJBPDL@XALADAFAHAJ@VFLDHDHFHFFFDJFJJFJFPF HöHbDF@tDP@N@LCDAF@RAPAPCLEPKFCDCLARBP@jDTBH@VEZC\\C^GTCJANDRHRHRDVHLDVFVDPDN@L@PALANCHED@NmbR]PcBELsPs@K@GCMKgIeCIDEF@TBDABA@EG_AGCWGMEIKOQSCEMOKOQOQWCCGCE@I@K@OEWY£©mq¡omUYEGECCCECCAG@M@A@KB"],encodeOffsets:[[78981,39738]]}},{type:"Feature",id:"653129",properties:{name:"伽师县",cp:[76.723719,39.488181],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBKBWBK@OCIAKAA@KAIBKDYHOBMDIDOBIFGFADKLGHCDODG@[@I@GBCBGDE@C@KGMEGBA@CBA@CBCBC@E@YAA@E@QBA@[FABA@A@I@C@EBODABCB@BA@CBE@C@GBA@A@A@A@CABJDXFH@J@B@DABABGBC@OCSAE@C@GBC@O@E@A@QBSACACAMEC@E@I@GAE@EAMAAAA@@@GBCBCBCBGBE@IBCBC@A@@@GCC@A@EBA@A@E@C@IAE@@@A@A@@D@DABABA@_AC@CBGHAD@@@@LFFDDD@D@FDBD@F@FBB@BBD@B@F@HBBD@DB@@BDBDBDBFAD@D@L@H@DBF@LBD@@BDB@B@BGFAB@@BBD@HADBDBBF@FBDDDDBDBHDDBDBDBDBDJ@BNRBDDFBFBDDBDBB@HCBBB@BBB@H@F@DBBBBDBBD@BBDB@B@DADAFEJCD@BA@@@ABBD@BADAD@B@BBDFDLFBB@BDBDBD@BBFDBDB@@@BDFJBDBBD@BBFF@B@BADAB@BABABAB@BBBBBBBBB@B@BBF@BDBBBD@F@BBBBDD@@@B@BB@B@@@B@BB@@BAF@B@@@BA@@@AB@@@D@@AB@B@B@B@@@BBBA@@B@@BB@@B@B@B@B@@B@@BA@@BAB@@EBIDCD@@@BB@BBDBDBBADA@@BA@AB@B@B@@A@@B@BAB@@@B@@@B@B@@DAD@DAB@B@@@BB@@B@@@B@BB@BB@@DB@@B@@@D@FAB@@@@@BB@AB@B@DAB@DBBB@@A@@B@@@B@BA@@@ABEBAACBA@@@@B@@@BB@@@B@B@BA@@D@B@@BB@@B@@@BA@@B@@@BB@BB@@B@BBD@@BBBBB@D@BAB@BAB@B@D@BB@B@DA@@B@@BBBDDBBD@B@@@@BAB@DDBBB@@@@ABA@BF@BLLBBBHHFBB@B@BAB@DB@@BDDHJ@B@@ADA@@B@@B@@@B@ABAB@B@D@F@BAB@B@B@B@BB@B@@@@@@A@@B@DBBBF@BB@@B@BB@BBB@@@@@B@@@BD@hTB@@@NHTTFFDDFDDDFDBBDFB@@@DDDBLFPJ@@DDLFHFFDDBB@BBDDBBFFDDB@LJHHB@BB@@B@@BBBBBHFFDDDBBDDDBFDBBBDDBLFHD^NBB@@@@D@RHHDF@@@@B@@@@DBBDBBDBBDDBDDBDBBBBRNLHJF@BBBDDDDJPRBDLD^@TB@@@@¶HVBHRBB@@@rDXBPDHDHHVTLBPArEVELBlAXAf@ZC\\CPA\\@PAfF\\@\\FXDRBVF^HP@L@^ABBPCT@H@FAD@D@@DCF@BBBPB^@BbBVHL@XAl@P@B@NBNBHDBD@D@DC\\CFBD@HDDHFHB@B@BBBBBNAJ@NDJDNBNAT@L@RBB@bDVDFALAHEF@D@D@RF|HjD^D^BDBHBH@THB@D@DAPGFCFAB@PBD@H@DBBABAFCBAF@DAD@F@B@B@DABC@I@IBE@CBATKFALGXITGJEdSFCDA`UDAD@B@JDB@B@B@@@CC@@B@HBJBF@B@BC@A@AEC@C@AB@@@L@D@DANEDAJOBCFUBCLUJOLMBCHCJENIBADARIHADALEHALKDABC@A@ACAA@@A@A@@B@BAB@BA@@@A@@A@G@CBC@EBE@C@A@@@@B@B@@@@A@C@CECE@A@ADC@@A@A@@@A@AB@@AJ@@AA@@@CAA@AB@B@@@@A@A@@@A@AC@A@ABAB@@@@AA@A@A@@A@ABABE@@@@ADGBC@AAC@@@@@@HBB@@A@@ACCCCACAA@@A@A@@BABAAA@@A@CF@@@@@A@A@ADC@@@AA@CDAB@H@@A@@@ACAI@EBA@@BA\\@H@@@B@D@L@DA@@B@@EMACACACAAC@A@M@E@KHGBE@SIMGA@MEEE@C@UKWBGFEFE@G@CEE@A@C@A@ALWDEBKCACAI@A@G@GACGCYSOMGQIYIO@C@IDKBM@gGCAAEEIIEOIAKGGKGgMQIMEQGOIOIQSKKMIKGMIIMGGOQMKKG]KOEKAGAC@K@GAMCIEQAGAICIIUYACMKOKCCCCCAG@IDGDEDGAGCAG@IAKG[CICE@EDOBKA]AEGQKMUGEBI@C@UBUBO@OBQBSBKBM@_KOAK@K@SDSBM@E@MDEDC@GFMDKBOBK@M@OCUCUEKCUGQCQGQGMCIBSD]H[DYDUFG@SAiCO@QAKBCDEDOLKFODOBQBE@CBKDM@O@sCE@aCõGGOEIEIEEICIEEGEGEGCKCUEI@GBEB"],encodeOffsets:[[78643,40219]]}},{type:"Feature",id:"653130",properties:{name:"巴楚县",cp:[78.549296,39.785155],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@WJKHEBSLAB@DAF@J@JADCBA@A@E@C@CBE@ABEDABABCAG@C@OAA@EBEDOHCBC@A@SGG@GACA]A]CiC{GQEC@C@E@GFKBEBUCaCA@QAK@S@MBMAICMCI@MBHLHHLBhHLBjDnDHDB@DHHHBBB@H@L@^BP@RBZ@jFZFLBJDNFHDHFVFN@HBHBP@F@NDFBBB@DBBBBD@NBBBDBB@BFDDB@DBD@R@R@XEJAFAR@F@N@HBVDD@H@DA\\ELCH@X@J@FBD@TAFAFADABABAB@JDDBD@F@LAHAF@DAB@D@BBJDDBBBB@B@VALADBB@D@BBBBBBB@@@@@BAB@@@@@@@BB@@@@@BB@@@B@BB@@B@@@B@DBB@@B@@B@B@B@B@B@D@@@@@B@B@B@H@D@D@DAB@H@BAB@D@DAD@DAD@B@DAB@BADADADABAFADABABAB@@@B@@@B@@@B@@@B@@@D@B@B@DBD@DB@BDB@@BBB@B@@BB@@@B@@@B@@@@@B@@@BB@@B@B@@@B@@@@@B@@BB@@@BA@@B@@BB@@@B@@@B@@AB@@@B@@@B@@BBB@@D@B@D@BBB@B@@@@B@@B@D@B@@@B@@@B@B@@@@@B@@B@@@@B@@@B@@BB@@BB@BBB@B@@AB@@@@@B@@@@@@@BBBBB@@@B@B@B@@@@@@@@@@BB@B@B@B@@AB@@@B@@@@B@@@@BB@@D@@@B@@@B@@B@@B@BBB@@@B@@@@@@@@B@@B@@BD@B@@@B@@@@@B@@A@@@@@@B@@@@@@@@@B@B@BA@A@@B@@@@@B@DDBB@@BB@@@@B@@@@@B@@@@@@@@B@@B@@@B@@@B@@@@A@@@@@@B@BB@@B@@@B@@@BA@@@@@BB@@@@@B@BBB@@@B@@@@@B@@@B@@@B@@@B@@B@@B@@@D@@@D@BB@@B@B@@@B@@B@@B@B@@@B@@B@@B@BA@@BB@@@@B@@@@@B@@@@@@@B@BA@@@@B@@@AB@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@B@@@@@B@@@@@@@@BB@@@B@B@BB@@B@@@B@@B@@@@@@@B@@B@B@B@B@@@BBB@B@B@@B@@BBB@@@D@@AB@B@BAB@B@@@BA@@@@B@@@B@@@@@@@@A@@@@@@B@B@@@B@@@B@@@B@@@BA@@@@@@B@@@@AB@@@B@@@@@B@@@@@@BB@@B@@B@@@BA@@B@@@@@@@@@@@@B@@@B@@@BB@@B@A@BB@@@@@@@B@@@@@@@B@@@@@@@@A@@@@@@@A@@@@@@@@@A@@@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@A@@B@@@@@B@B@@BB@@@@AB@@@B@B@@@B@@A@@@AB@@@@@BB@@@@@@@@@@@AB@@@@B@@@@@@@@@@B@@@@BB@@@@@BA@@@@B@@@@@@@@@@@@B@@B@@@B@@@B@@A@@@@@@@@B@@@@@@@@@B@@@B@@@BBB@@@@@@@@@@AB@@@@BB@@@@@@@B@@B@@B@@@@@BA@B@@B@@@@BB@@@@@@@@@@@B@@@B@@@BB@@B@@@B@@@@@@BB@@@@@B@@@B@@B@@B@@@BBB@@BBBB@B@BB@@D@BAB@@@B@@@@@B@@@B@@@@@@AB@@@B@@A@@D@@@@@@AB@BA@@@@B@@@@@@@D@B@@@@@@@B@@A@@@@@@BB@@@@B@@@@@@@DAB@@@@@B@@@B@BBD@@@B@@A@@B@@@@B@@@@B@FABAD@@@B@B@DBD@B@D@DBB@F@B@B@B@B@B@B@@@BBB@@@B@@A@@@@BBDB@@DBD@DAFADADBDBDBBDHDDBFBL@H@TDTHLFLDD@B@DAD@F@B@@D@BBBD@DDDBFDD@FBRFF@DB@@@BBBDDB@@@FCBAB@BBBB@@@BCFEF@BBBBBDAJAHCDAD@DADBF@F@F@B@D@XHDBLDHDHDHDFBDD@BFRB\\BDD@DBF@LJBBLDTFXLJBDBCRDFFLDHFDJ@FBFFRDRFbLNBP@FAJDHNVJHBRDVHXJRJLFFDPDNFJBHJDFDLFJFFJHNFNFNDJFFFPFJBXHVFXFPF\\LDBJHNFRBL@NGLCRELALGZM¯}Ù³kmmQ@wA¥@}@@aAE@CAA@C@CACCEAIA@AGAE@GFADKFAB@@@BAKBLIJE@A@@@@@AA@@A@AD@@@HAD@BB@@B@BADB@@BA@CBC@AFAB@B@BAB@@@BABBDAB@BAB@@ACB@AMAA@CCIK@EDGFEAEICC@ACAC@AFAB@BC@@@A@AA@AAA@@A@@@ABABBB@B@@A@@AA@AAA@A@ABABA@@@AB@BADA@A@AAABAGMGAICKAQGKCKKEEEGGCECAC@ECCQIcS[IKEEC@CDQ@AFOJGJEBCBCEG@CDCLAFCDBF@@@DABABC@ABCB@BAD@H@B@F@DA@@@A@@@@BA@@@A@@BAB@@@BA@@@A@A@@AAA@@A@@@A@ABABA@@@C@@@@@AB@@ABCBA@CBC@@AAAACAE@AACCAC@EDEHCNCR@D@hBTFTBVF\\JFBDCFGAECGGMGIGGCCPECKkeqYK@QMG@CDEACQKAIGFEBAAAS@IE@BBD@DEAKGK@¿ÄMB@DCBE@@BG@CDCDC@@BFFB@DDFD@BD@@BCHIJIFC@A@MAI@@AGAA@CC@AA@IC@A@CGCAE@A@@ACBA@G@A@A@E@GB@ACCCA@@CCAEDEAC@C@AACAABCBKnWrEPRdFD@BJT@@BFD@FABFABDBBLHrEDALAKRAFCFJL@@BBCF@JCBABC@ABG@A@ABBBDFBBBBBBABCDAB@B@@BBBB@@B@@AB@@A@@BBB@BBCFB@@D@BFABDDFDFFBBCBAF@FCDFB@BBFFCPAHBBD@ZTBB@BHDEpAHBBAHB@AJACĲmMmaA@§mQ`gGAAA¥]oCa@KMnYC_CA[AmIūmRd]DAFwOKoOcAec"],["@@øOüeVCN@@@BABABEBE@@B@@@@DBBD@B@B@HAD@B@PAD@@@F@DAF@DBBBF@D@B@@@DAD@JABAF@HAJAF@DAB@L@D@BBBBB@HAD@BAD@JE@AB@@AB@@@F@BB@@B@BAB@@Bfį@A@@CA@AA@A@AAEAA@C@@@A@@AAA@@AAC@CCAAEC@@AA@IBI@A@A@C@AAGBA@AHE@AB@BAD@@@B@DCBCBCFCDCFC@@@ADE@@BAB@@A@@@AA@IEGEAA@@@CDA@@@AAACA@A@A@CB@BCBAB@DCB@BBB@BB@BB@@@BA@A@AAE@A@C@CCCAA@AA@A@CAABC@CBCBC@@DA@@@A@A@ACAA@@@C@C@@BCBA@A@C@@@@AAAB@@A@@DA@A@@@@ABC@ABABAHA@@BA@@@A@CCAACA@GA@@A@CB@@A@@CAAA@AAA@A@A@@B@@@B@DADA@ABA@C@ABEAKEA@AA@@BCA@MA@@A@@AB@@AB@FAF@D@B@@@@@@A@ACCACA@@A@CBABCBAFA@@FDHFBBB@B@@A@A@AAAECGEAAAAAAAAA@QuEOGUQyAE@MAeCqE]GqASEcE_ASGuCQAEE[CUE[KqIsCYM{CW@AGc]ÅS¥AMqĩW­K}OKuS¥QAKAC@EAICKAMAECSAKCOAIAGAKDG@C@A@CCCACAAAAEA@AAA@@HIDGBA@CACAC@A@K@G@IBA@@CGEGAC@AIICCASAC@UACEECCKEACACCCAAAAAGACAEACCECCCCACAE@EAA@A@@C@A@E@IBGBGBOHMFEFEDGDGDC@WB@@MBEBE@A@E@C@C@KAC@C@ABUDCBEBGDCDCBA@A@E@C@GBMBEBC@GDABCBIFEBEBC@C@C@E@GBE@IDA@A@CBA@GBMDIBG@O@KCEAEACAEAGAC@C@C@IDEBGBC@EBIDEBGBMDC@A@GBC@G@E@E@C@E@ODGBM@CBC@A@U@aDE@EBC@C@A@CAA@@@A@EEEC@@@@A@KFIBCBEBIBGBG@E@C@IBC@A@IBCBG@E@EAKAEAI@A@E@C@EBC@GBADCBCDGDEBEBKBEBIDCBGBE@E@UBE@C@E@A@A@CDEDC@GDE@MDIBABCDCB@BAB@BCDABCDEFCDABADEFCDGDEBCDGBEBC@CDABABOLGFEDCBEBADEBCBGDCBC@CBCBIHABGJABCDCDADCFAFABGPADCFGFABGFGDCDCDADAF@FABAFABCDADEFCDADA@EBCBABAD@@AB@@CBABCDCBGFGHABCBGDEDCBGFAB@BCD@@CBABEDCDCBABEHABGFCBEDE@EDE@CBE@EBCBABG@C@EBC@C@K@KBKBAAE@E@C@E@AAA@CAIAGACAC@AACAAAAAC@C@C@A@@@ECGAEAA@AAK@A@A@@AG@CAAB@@A@BD@@ABCBA@A@A@C@A@A@@B@BA@@@CBA@AB@@CBA@@@CAA@A@A@@@ED@@A@ABA@CBE@KBC@CBGBE@¯®£¤µ¶A@wvuvďČabmnCDA@[EGACAAACA@ACECCAAE@@AA@@CACAAA@A@EDMPEDEDGDEBC@G@CAAAA@@A@A@CBABAFADAB@@A@A@AAAECEAAA@@ABC@A@A@ECECACAA@A@A@ABABA@A@ABCCKAEAAAAC@C@A@GDEDABABAB@@@DBDBDADABCBABA@ABAAC@AAAAA@C@CCGCMAC@A@CBA@CBA@A@CAC@EBGBA@IHEBA@C@AAAAAAA@@ABA@@@A@A@A@AB@@@B@@A@@@@AE@A@@@ABA@A@A@ACAACAA@CAG@@AACBC@ABABADED@@ADCDADAFABA@ABA@CACAACEAECGGCCCG@C@CBCBABAACAAA@C@CBCBEFCFAFAF@@ABABABCDCDABC@A@C@A@EBC@AAAACE@A@@A@A@ABABA@EBE@AAACCC@AA@A@A@A@CB@@A@@@AAAAAAEAG@GBE@E@I@KBIDA@A@AAACAAKEEACAGCMIAAE@C@K@G@OCC@C@CBOFCBC@A@A@AA@CFQBC@I@IAGEEAEB@@ALGBADEBE@AAEGCCCEAGAGAEAK@G@C@C@AAAAAACAKIAACAAAACEAQECACAMEUEA@MG@AAA@ECCIC@@MAA@U@A@GBE@CAA@CEAAC@KAAAEAACACACDE@C@AACAAEAICCAEEACAICCAACAGAG@GCG@EBIBEBC@GBC@AAAQ@ABI@CA@M@C@ECCAKWAEBEFCJ@B@DABE@CBAB@BAJCBADADCDCBC@CAK@AA@AAA@GCC@EAC@KHIHc\\GFIBC@E@@ACAEIAGQaCIAG@IEMACGAA@C@GFEDEJADEJEHIFIBG@E@EAAA@EFIFMLKBC@@EGEGOKIGGGMOAGAIAGCI@C@CBEDIB@@ABQBE@@@C@GAEACACEAGCSCqIG@EA_FEDCBMF@BGHEFCBABGFED@JADADA@CBA@ECECECEACAK@@@ABS@@@A@AAyZ@]JA@CECDCAABA@GTEEGC@C@A@@BCFEFGJCBEBCDCB@B@D@@@@EBIA_CILPFJHNDXBHH`@FABCBSAE@CFDJJfLhDN@H@LOtKtAFOdQ^aMnFCNCF@N@TATCL@L@PB`LN@LATARAPAP@VAVAD@J@FAVHLNHRBFB^ALCP@FDFDJH\\BL@JBHHDHBFCHCJCH@DBDDDDPLNLBDVZJJJDHBRBJFNDHBL@D@HBLBPF^LLHNLPRHHJNNJLHNJLLRTPJPJRHNFRJhNLHHHBLPJJFFJBFDBhHN@LAJCD@P@ZJRJNHTPDZDHHBH@B@J@DBDBALCFKX@B@B@D@BFF@D@HEFEFAHLX@V@DFFNFB@NHTJF@HALGF@N@B@D@BBBDBDBDN@FA@@@CBK@C@A@@@G@[@AB@@AB@FBJBD@@B@@@@GBADCB@@B@@CD@B@B@B@@@@DEB@@@BBABAB@@@B@BB@DBDBDDBD@@@BA@GA@@@@@@BD@BADCH@B@@F@BABAB@@@@B@BBB@@@@BABAB@D@@B@B@@@B@B@@A@A@@BBB@D@@BB@@BI@@BAB@@@B@B@@@CD@B@BDFDFD@B@@@@@@A@A@@B@D@F@FAD@DAH@B@@@@B@@ABA@ABA@@@@B@BB@DB@B@BADCBKLGBKFCBGBQJCBABMJIFGDADKNIPKVADEVADIPCBMFCBC@K@@@A@@B@DFD@B@BADA@E@IAGAA@@@DD@@A@A@A@ICA@C@CB_VCBEDcTIFSHfddBpP LxPEB^CcQŬnnJ\\BDB`ZDNmLb@pD¦^BBHB_hnR¨B@nbnNDıBBIA@BGAABGFoGC@AAAYSC@AABGDOEEAAA@CEEDE@ABADEACECEACEB@A@CA@DEAAA@AA@@@BA@@BA@@@AAAA@@@ABADCBAAAAAAACEAABAB@H@BAD@BADA@IDEAA@@IKDEBELQKBCBqFGAKCABAAEEBC@AE@@IS@AECQcFOXqLmDABADBBBD@D@FBFCDB@DB@DDBDA@@H@F@B@B@HABBD@@@BBFHD@D@BJDB@@BDDB@HB@BJ@NBB@D@JEJIDG@AC@@AECCCA@EE@AD@DCDCH@@AF@DA@CNAÀÃL@LHFB@CAC@AJFT@BBABEFJHLBDRFBDCH@RNL@rZlfDLJCF@HDTHHDD@FCFITOLKFCj@fBDAL@LCJALBX@`FxJZDJB`DRD@F@T@DBV@LDFJBlJnJ\\HLBV@TLJLDB@JFHTZLLF@VBhCJ@\\BlJLDbHhJPDBDHPB@@B@@@@BBB@@B@B@BBB@B@@@B@@BA@@BB@@@B@@ABAD@@A@@@A@@B@@@B@@@BDBB@@B@B@@@B@@B@@@B@@BBB@@@F@B@B@D@D@B@@@@BB@BB@BBBD@BBB@DB@@BBABB@@@B@B@B@B@D@B@BB@@B@B@BAB@B@@@@@@B@@@@@@B@BA@@BBB@D@BAD@B@BBBBB@@@B@@BBBB@B@@@D@B@@BB@@@BAB@B@D@B@@D@F@D@HBB@BA@ABEB@@C@A@E@ABC@@DABA@@@A@@A@EAA@CA@GAOAOBGJAFBHFFNFHJDFAJMNcdEFADAFBBP@FB¨JTB|H`DhDH@\\@bG^GVE^G^ID@H@pKVGPAP@BBJD`XPLlbJJ@FAN@FIfKfERGXAJCRKZELELQRUV]\\UXSRMNMJCFCDSTKJE@SAKA@@@@@@A@@@@@@@@@_EOAKA@@gCUCI]E[CqGGMAGAC@kGgEG@C@_EiC]EmCUCmEO@[EWEW@cE]EQEICCCAABC@CFKPQDMNQFMBEBAAAA@GCI@MAOAMAEACAAC@CIgES@E@C@C@AAAECYI]MYIIEAAAADEFGDGB@B@D@DBF@B@PCNGHC@ABAAAA@CA@A@AAG@CACDE@@BAA@BA@A@@B@@@BAB@M@MCMEKAMEEAM@G@CAEG@MAEGEIGICGC@CCA@@BA@EDC@@B@DABAHABAFCDABA@@DAB@B@@AB@B@B@DAFABADA@@BABAD@FAB@H@FAB@FAD@H@FAFAD@D@J@B@D@D@FBDBB@@B@@D@NAB@BAB@BAB@@ABCBA@A@@@@AACA@@AA@A@@@CBA@@@A@@AACCA@A@@ADA@@@AA@CA@AAA@A@A@@A@Y@c@@_@E@AMCYC@I@Y@U@BGBSH_BIBCHKFQ@A@EAEECGBIHCBEEEGEACB@DHFGNEFIAMCICGIEICOCKAM@QAGACCCIAGACECEEAEDMPERKLEJIHAFAFBBBDTFHHFLGNEFCFABJDJ@JDHNDN@FMJILGHEBIBIAGAAAABCDBLDBJFDHDN@LILCDGBCD@BFD@BEF@L@H@BBFBBB@@BDHADGDG@GEAA@@@@@@ECC@A@A@@@A@@@ADA@EB@BBDBDD@JDBFEFCH@FJLDDB@NB@BDA@BA@ABA@CBAAAB@@A@ABA@A@EB@BAD@DAB@@CAABA@@@AAC@GB@@C@@B@BB@@B@@@@@BIFKJLAAB@@A@EBCLEB@HBFBHB@BJDFBD@D@DBB@DBF@b@@~B¦@xnRlnÚ´°~JEPELCJCVGHAJCL@J@ZDXD\\FxRPB^HVHrLfLB@HBLALCTCR@Z@XBLBXAV@L@LAPAfAHAJAT@bCfCfG¬SMXCXEXCjEVE"]],encodeOffsets:[[[79695,40849]],[[81406,41177]]]}},{type:"Feature",id:"653131",properties:{name:"塔什库尔干塔吉克自治县",cp:[75.229889,37.772094],childNum:1},geometry:{type:"Polygon",coordinates:["@@BEFY@AACCE@A@CBABCDABA@ACGBE@EFGFI@I@CACAAACCS@ABARODCBEBOBCBAFCHIHEHCHIBE@AAC@CBE@CBE@IDEHCHENODEBE@ADCDAD@JAFCBABE@CACCC@CBA@@DAD@J@DALCHCFGDGDEFCF@D@B@JFBBF@HDB@B@NEBADG@EAACGCG@E@GBADCDADCJGBABO@EAE@A@AFCFCFABABAHGFCTAJALBLAHAJEFAD@B@D@DDB@D@B@RKHGLSBADA@@FDDBF@P@H@BADABCBABAJCTGVKHGH@NBH@FADC@@BADGFCDCB@B@DAD@FKBAB@B@D@D@DBB@D@B@BABAFEFEFETMLOFIFEHEHAFAHCXQHEJELCFCDCDGBG@MEGACGCGCIACC@C@ADCDCD@D@XBF@F@^MLEJEJCF@FADCDCBEHAJCJADAFBN@H@LCFCDCDKJM^eXYDEFCJELGNGNMPGLELGDEFeBIBCFEFAFCDGDC^QFCDAF@D@D@BAFGFCFCDATEZAD@HADAVKXM\\GdEJAHAHCDAFEDAHAPBb@J@F@TKFCBA@@AOFODKDCDCBG@EBGC@M@IFGNSDABEFGNIDEHEBEFSC[AGBCHKDGDEAE@CCEGEYKMUG]CGICM@IACC@A@CBIEOEMAAESAG@E@EBCDCDCPIJIHCBC@AACCCMMEGAABABCJMHEBE@@DGHKDOBI@ABCBABADAFAHCB@BA@A@@@E@GBC@CHCJCBADIDE@G@A@KD[JUF{@SDIFIDEHCJEBE@AAEKMCEAKDCJEJCDEFIDEFG@AAGGEMGG@KC@CFIHIFEHKLKLODIBI@QCEGIKGGCM@OFSBGACAECCGCIIKIOECCGCGAGFKBIBEDGNMLGJIPMXKPOFGDGAC@ECGAI@G@I@GDGFGDIAM@KEBO@WBUJSLU@KIEKUIYGOBMHSJOFOBQHULKJKN_XKJMJEFGJIPKJGCQIQ@YA_EOCU@WCODMdENMPWTMRONOHMFIDAT@PIPQFQAQDWJILIFG@WAOEaLWJIVO\\KJEJKZNPXTFHAP@BMdDNRVNXIVINWNcFQGGaKMq]oPS@SEOIMKOSKKWOSCQBUBeJ]NSKCQHOJ[AE[DYDQBcLIDSHW@QCYESBKBM@Q@IAAKCKICIAYB]AaAWFOJKH@NFPJJJVALCP@TJRDNBLCPKVGPIJKTKLILQNKNGNINCLEJD\\ALMHO@UDWBQ@[BGCYOGASDUHUHSL]VMRGN@TODPRORGLCVHPBJKJQJDPNHjDXPMVRFf@jH@N@N@TCR@PCVAZTLTCbIREJ@HJEZMTUN[VCbKNMPKDWRGTETCRBXBBh~JHXFHPFVG`CJGPGJGJDB@BB@@B@B@B@@@B@@AB@@@B@@@B@BAB@BA@@@@BA@@B@B@@@B@@ADA@AB@BAB@@@B@BAB@BC@ABCBAB@B@BAB@BAD@B@BABAF@BABA@ABGBCBA@AD@DAF@B@BBDBB@F@B@BA@ABA@@BA@A@A@AAE@EAC@ABA@CDEDCFEDAF@B@B@F@D@BBBB@@B@B@BA@CBCBGDCBEDCDCFADCHCFCDCDADAB@@A@G@ABA@A@CBABADCD@@@D@BBB@@BBBDBDDF@BDBDBFDFBFFFDBB@BBF@DAH@BBB@@@B@@A@@@A@A@CC@@A@ABA@A@A@ABABAD@BA@AD@@ABABABADABEDCDABEDADABAB@BABCB@@AD@@@@CD@@@@A@A@@B@@@@@@@@AB@@C@A@C@@@A@CB@@A@A@@@CBCBABC@@BA@ABAB@B@@A@@BAB@B@@AD@BCBED@@@B@@@BABCD@@@BA@@@ABCHAF@B@@@@AB@B@@A@ABA@@@AB@@@@CBABCBA@ABA@CBC@A@A@A@A@C@ABA@@BABABA@ABABCBA@A@ABABA@ABABAB@B@BA@@B@@@B@@AB@@ABABABABA@A@A@ABA@ABABA@C@ABA@C@@@@@A@A@@BABAB@BADADAB@B@@ABABA@@BA@AB@@A@A@C@C@@BA@AB@BA@CBGDA@ABC@ABABCBA@ABC@ABC@A@A@A@CB@@ABA@@@CBA@GFEHGHOJSHUJWGOAOPOFMLYFkL[BYEcE_E_NSB[@WDAQEUBMDaIEMAUHS\\KCOEOMSUOAMBINGPGJGVERKPOVCN@XDNDPHZHRCT@JAZCZKTUVWLKHALATDLHNPBLDHHAVEJSFICOAK@MBYJ[BK@MFYHQD[F_BeEKGMCYCQDQLMFSBWHSF[LMHHnqXEASCEUAAkA]BiKWBSJUJSBOKQOGGMIYASDMAKGSAYEYGOIBMDGFQLOBIAEWDG@EQOEUBMHADaCPKVILGJULKLCNHVDZA\\SC}AOKGG@[EEQ@OAQAU@WBKBGHKJINGLFVCJKHGNCRMJSAOIUK_GUE_GGGCQKMGG]IqIG@KACNKTOLS\\KPMLOEUKCGEAGACDAFAPATA\\@XOJQHUFKLD\\LLbRHNE\\UTAPNNXLJPJZLNPF\\JZDPGPCRIRORBNHD\\FRTBTKRTNDPGNSHQLINCLHRVLRXPbRZNXFPANGLIJIPMJCLGNINAHIH[JONSP@^BRDhDXFXTREXFJJHNHdBRFLJFVJ^DdC`BbJVD`L\\JdN\\N`AXCZDNJDdBPLFLJ@LANSLKPFNLJHHTLLDBJ@HCLGNO^AHADOZYNUR]XOZEPCHIBIBEAWKMEOEG@QPIAI@OBMFAPJNKJiRUHYL_TSZCTAPKfMbGRKRU^CHAPLJPH^PFPA^BTZ@ZPRRNLRXPNtZDJCPGHYV]PQJKFA@YL]RSPIRFJFLPVLT@PKR@BMLIP@P@X@N@FMXCTBRBJHNDPJLDXBZGRUJcK]GEPIJSjOTYZORKRKREjGXKXAF@@@@CLCXNdJZBdIRQ^CZGRGVPPLNHERCPENCLAJAJBH@LDNCF@NAPGLCLAHDJFJBLDLBJ@HAHAJ@FBHABGDGFILGB@LGNCHADCBEFCDAH@F@DBB@DBDDDBBBB@@@D@LBH@RBFDNLRLFDPD\\FzF\\DFBJADG@KDKNMJQNSJELKLGHIJGNILELELCLCD@L@JDPBXDXBJBRBRFFBHCJAPGTQRQLKZQRQVOTQPOHEJGDEDCHCJCH@HABCJK@AB@B@DBBD@@B@DCDADC@@@C@A@C@AACEECCEC@AGI@A@A@CBCACBEBA@A@AAAAC@A@ABA@A@E@I@EBADE@@CGAA@A@C@A@A@EBEBEBABCDAFE@ABCHKDGBIBEBCLIDCAA@ABABC@C@E@CBA@ABADCBABA@EBC@A@CAAAAAEAC@A@CBA@@AG@AAEACA@BCBCBM@@ACAEAC@C@ACE@AAGAEB@BBFFD@DBF@F@DAFAB@LHD@HBD@D@DBNHHDD@B@FCDAD@J@H@JCZED@BA@A@IBAB@D@H@NDHDFDL@JCFEHCHAJBLBH@JCXIFCNGPEJALAFAHEFEBCJALAJ@HAHCB@@E@EDCHAHALARABCFAF@FHJLDFBFBBB@D@FADCB@D@BBJDFDBBBBD@R@D@PHJFB@D@B@BCFENGHGDCD@D@@@BB@B@FBBFBRBRDFFHHFF@BABC@C@ABADE\\@DBDHDBF@DDDFBJ@JCPCTGXEHCPAJ@B@DFBBD@JAD@D@DBBBDFB@D@ZGF@DCBC@CAGCGA@@EBEBCFCJEFCDCFKCWEGECICOEGGKEOCIEAAGKCG@AFEPOFIBECGEIAAEEOOIEEGMYEGCIACGCICCEEE@GBK@G@ACCGEECCEAGEEAE@EBCBG@SBMBIBKFS@I@GDO@G@AEIAC@G@GBABAF@DBJJJHTRLDRHL@FBFAJ@J@HAF@BDBDHHFFRJPLVNJHJBJFFFHTFFJFB@LHJALCFAH@H@FBTFHCHCJ@DD^NF@FAHCLCJAF@D@JFLHLDHBFBFDPFFBJDPLDDFBHBFBFHDFHDJ@LAD@D@DBH@H@NNJDDBL@JAHAJ@JFLHNLJDHDFDHNDJFDJBPDF@DA@GBCBCBAH@DABABC@GAECCEE@ABABAFCFAB@B@D@@@BABA@CJOFCDC@C@CDCDIFEDAFCFADEFCFE@O@ABABAB@D@BDDBDBF@JBHAN@@A@@VQDAH@BAB@@IBCDCDCPIRE@@@AAC@A@@DC@@BCHGBC@Y@IBCBGFC@AFEDE@KDIHGHED@BABCAEQKQQEABCBGHOAEGACCBCFMBEDO@MBQBOCECCGEGIEKAEAEBGBG@K@K@EFIBGAI@KA@MECC@CBE@EDADCJCHAFCFE@CFCLEFAJO@@EEAG@EBIDI@M@G@GECEAA@KCA@K@O@I@EAKGE@CACA@EIGGGI@E@KAICKCC@GBEBEAGCICEBEBEDK@E@G@CAKICAIAGBCBCBCBEGIEIKEA@MAEECCI@EAGBS@ICEIECECOBWBEGQCUAMBIBCBCLGFAHAHEHGDKVQDEHMJCHC@ACEAEAC@IBIHONQBI@EACECAG@MFW@GDM@GEGAEDQDM@GCEAKEIKK@AAEBEHKBI@GAKAI@C@EEGIECGCIGG@OAGGKIQCIDO@A@AEMCACAEACA@AAIAGCEEEAGACMGEC@A@ABAFEDA"],encodeOffsets:[[78008,37842]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("可克达拉",{type:"FeatureCollection",features:[{type:"Feature",id:"659008",properties:{name:"可克达拉市",cp:[81.044542,43.944798],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@yF}F]D@@@@@EIAOCAAC@CAM@EAMAOAMAOAE@E@@@CAM@MAIAEJGJEJCFGJCFEHCFCF@@CB@DD@@FD@F@@BB@DABBDBN@HBBDDDFBD@FAAENDFDJ@DDFBDBB@H@F@GKH@B@BBBBD@DBD@D@H@BD~CBLBRBDB@B@DA@AB@@@ABBBB@BA@@DAB@@B@@A@ABBDB@@AB@D@@AAA@AB@@@@@@@@@D@BAB@BA@@BABA@@B@F@B@FC@ABCBC@AD@HD@AD@F@F@@AB@@A@ABACAAA@@@A@AB@DAD@B@B@B@B@D@B@FAB@BAB@DBBA@AB@@CB@BABC@@DCLDNBLBNDD@FBF@DBHEFEBAD@D@B@H@F@DBBBBBB@DBBBDAD@B@BA@AA@BABAA@@@@A@A@@@@BAB@BBBBBBB@BADA@ABCDAFEB@BAB@@@B@B@@ADA@@@@B@@@@AB@@AB@@A@AB@B@@@B@BABB@@B@@@B@@B@@@BABBBDBDB@BBB@BBD@BD@B@BBBA@@@A@A@@@ABA@@@AB@B@B@@@BAB@BBBBD@DBD@BAA@BAHAD@BC@@B@B@B@B@BB@@@@B@BBD@BB@@@B@BDB@DBAD@@@@D@F@BB@B@B@DDBDDBFAD@DCB@DADAB@@@B@FET@@@@ABAD@@ABA@@HGFC@E@ADABBDADBB@BADA@BD@DA@ABAB@B@@ACABA@A@@@@B@@@@BB@B@B@B@BAB@B@C@AA@@IASBCA@@@@KEA@M@@@@@A@O@M@I@UBEWAGCIMBA@@A@CM@O@@LCDBBC@GAO@K@A@@B@H_@[DkD@@gD[BEBA@UB"],["@@mCSfCÅúTC¶oDAIACACACAGEFGDA`QDAFAJED@PGBANKBA@ABA@AJOJBFBFBzPJYFBFOSCMWIQ"],["@@MHWJB@GB@B@@BBBFBF@FDBNDBB@BPDB@@@@@B@B@BAD@H@B@@B@@B@@BB@@@BBB@B@B@B@B@ACAEACAC@CAEAC@AEM@ACIACKD"],["@@OC@AAAMCCA@ECBIH@@EE@AB@@@CCHE@@AA@@DABABBBA@AHAA@XINGLC@CGSEQEA@AAAA@CAG@C@E@@@C@@@A@C@GAC@E@CACAAAA@C@C@A@E@CBA@EDCBABEBC@IBE@G@A@AAEBQ@SAK@KDIDAB@@A@ABABABA@@BQHKFGBA@CDIFDLDFHLRTJADFB@@@VZbjLHjKF@@HD@DCHAFCHCJCFCBAFAHAJCFAJCHCF@HCFAFAB@@AF@@A@AAACEA@"],["@@{IapyDTenDJRNXTDEPEAIZQZELDN±HAZYICBµpSDBJ@DB@@@@B@@@@@B@@A@@@@@@BB@@B@@@@BB@@@@@@@@AB@@@B@@@@A@@BB@@B@@B@@@B@@BB@@@@@@@@B@@@@@@@@@@@@@@@B@B@@@@@@AB@@@@@@@@A@@@@@A@A@@@@@@B@@@@@@@@@@B@@@@B@@@@@@A@@B@@@@@@@B@@@@@@AB@@@@@@@B@@@@B@@@@@@@@@@@@B@@@@B@@@@@B@@@@@A@@B@@@@B@@BA@@@A@@@@B@@@@@@@B@@@@@@@BA@@@@@BB@@@@@@@@@@AB@@B@@@@@@BB@@@A@@@@B@@@B@@@@@@@@@@AB@@@@A@@@@BB@@@@@@@@@@B@@@@@@@@B@@@@B@@@@@B@@@@ABA@@@@@@@A@@@@@A@@A@@@@A@@@@@@B@@@@@@@@@@@@@BA@@@@@B@@@@@@@B@@@B@@B@@@@B@@@@B@@A@@@@@@@@@@@@@@@@B@@@@@@@B@@A@@B@@A@@@@@@A@@A@@@@@@@@B@@A@@B@@@@A@@@@@@@@@A@@@@@@A@@A@@@A@@@@@AB@@@@@@@B@@B@@@@@@@@@@@B@@A@@@@BB@@B@@@A@@@@B@@B@@@@@AB@@@@@AA@@@@B@@@@@@@B@@A@@@@@@@@@@AA@@@@@@@A@@@@@@@@@@@A@@@@@@B@@@@@@@@A@@@@@@@@@@BB@@B@@@@B@@@@AA@@@@@@@B@@@@@@@B@@@@@@@@A@@@@B@@B@@@@@B@@@@@@@@A@@@@@A@@@@@A@@@@@BB@@@@@@@@@B@@@@@@@@@@@AA@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@B@@A@@@@@A@@@@@BB@@B@@@@@@@@AB@@@@@@@B@@@@B@@@@@@@BA@@A@@@@A@@B@@@@@@ABB@@@@@@@@@@B@B@B@B@@@@B@@@@B@@@@@B@@@B@@@@@@@@@@A@@@@@@@@B@@@B@B@@@@@B@BA@@@@B@@BB@@A@BB@@@@@@@@@@@@@B@@@@B@@B@@@@BBB@@@@@@@@@B@@@@@@BB@@@@B@@@@@B@@AB@@A@@B@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@B@@@@@@A@@B@@@@@@@B@@A@@B@@A@@@@@@@@A@@A@@@@@@@@@@@@BA@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@A@@@@@A@@@@@@@@@@@@B@@A@@@@@@@AB@@@@@@@@A@B@@@@BA@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@B@@A@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@A@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@@@@BA@@@@@@@@@@B@@@@@BB@@@A@@@@@A@@@A@@@@@A@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@B@@@@A@@@@@@B@@@@@@@@A@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@B@@@@@@@@B@@@@@B@@@@@@B@@@@@BB@@@@@@@B@@@@@@@B@@@@@@@@@@A@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@B@@B@@@@@@@@@@B@@A@@@@@@@@B@@B@@@@B@@@@@@A@@B@@A@@@@@@@@B@@B@@@@@@@B@@@@A@@B@@@B@@B@@@@@@A@@B@@A@@@B@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@@@@@B@@@@@@B@AB@@@@@@@@A@@@A@@@@@@@A@@@@@@@@BB@@@@B@@@@A@@@@@A@@@@@A@@@@@AB@@B@@@B@@@@BB@@@@@@@@BA@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@AA@@@@@@@A@@@@@AB@@@B@@@@@@@@A@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@B@@B@@@@A@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@AB@@A@@@@@@B@@@@@@A@@@@@@@A@@A@@@@A@@@@@AB@@@@A@@A@@@@A@@@@@@B@@@@@@BB@@@@@@A@@B@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@A@@@@A@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@BB@@@@@@@@@@B@@A@@@@@@@A@@@@@A@@@@@AA@@@@@@A@@@@@@@AB@@@@A@@@@@@@@@@B@@@@B@@@@@@@B@@@B@@@@@B@B@@@@@@BB@@@@@AB@@@@A@@@@@@@A@@@@@A@@@@@@BA@@@@@@@A@@@A@@@@@@@@@@@@B@@@@B@@@B@B@@@@@@BA@@@A@@@@@BB@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@B@@A@@@@@@@A@@@AB@@@@@@@@BB@A@@B@@@@@B@@@BB@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@B@@@@@@@@A@A@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@B@@@@@@@@@BB@@@@@@@@B@@@@@@A@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@A@@@@BB@@@@@@@B@@@@B@@@@B@@@@@@@BB@@@@@@@B@@AD@@@B@@@BAB@@@@@D@@@@@BA@CBA@BBBB@B@@DBBAB@@B@B@@ABA@@B@B@BB@ADB@DCBB@B@BABA@A@ABB@BBFA@@CBBBBB@BA@A@AAAB@B@BBBJ@@@@B@@@@@@C@A@@BBB@B@B@@ABAA@A@AA@A@CDABBDBBFBB@ABC@A@ABBDB@D@BBB@@B@BCBA@ABA@BBD@ABE@@BBDBBAFABA@@AA@AB@D@DCDABHNAJQLFDBBZHFBDBBGBCFKBMrB@QPCFBZHJBBUHa@A@EJQtK~KCD]~GPBCDUL@FB@FR@FFPAJANBHHFBB@BDDBBBBBB@B@B@BBDA@E@AB@BAB@DA@@B@BBDBFABDBBB@@@@@@@B@@@B@BA@@BB@A@@@@B@@@@BBD@D@D@D@RDbDD@B@PBPBBANBB@B@PBBA@@BC@A@@@@@A@@AAAA@@A@AA@@@@@AB@@A@@@@@A@@B@H@F@DBD@F@J@@@AEAEB@DABDDBDCHA@@AGCI@EBCEGGG@GTFDFBBLHDBB@DCNGLGPCAGAAFACIEIGECGACCCAE@AGGKCCCCADABAACBC@CFCBABCFADCHANA@@FMDICEiIFEFCHCBABBBABA@@BA@ADBBAB@BA@CB@DABA@@B@BADAB@BAB@BABABABAFB@CBABB@@B@@C@AB@B@@C@ABAB@BABA@@DABADA@CDCBAB@BABAD@BA@CB@DAAC@AAABABA@CBACC@ACADAACC@@CAC@ACA@EBACEDACCAAHCKMKKDOH_FUDQ@KYSEiEA@A@CDE@CCAGAC@EDCDEBEBAAACBGAEOICCGOCICCGGGEAE@ANEPGFCPEJEBAPERIACA@@EBAPGh@@@`@DAJENGEA@@AACC@AEEEIGEGEBE@C@IBMBGBGDGBGDGBIBEDGBGBA@AB@PqBGDKO]EKGICYIKAI@eIQ@[A"]],encodeOffsets:[[[82863,44915]],[[82596,44761]],[[83023,44988]],[[83022,45016]],[[82598,44717]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("石河子",{type:"FeatureCollection",features:[{type:"Feature",id:"659001",properties:{name:"石河子市",cp:[86.080602,44.306097],childNum:1},geometry:{type:"Polygon",coordinates:["@@COCK@AACAAAGCC@@CCAAACAACECC@A@@AA@A@A@@@A@@@A@AB@@A@AB@@A@ABA@@BA@AB@@A@A@A@@@ABA@@@A@@@A@AA@@AAA@AA@@AAA@AAAA@@AAAA@AAAAAAAA@@AA@AAA@@AA@AAA@@AA@AAA@@AA@AAA@@AA@AABSDKBA@AB@B@@JN@D@@@B@@A@A@CAGCA@@@A@@@@B@BBBFDZR@B@@BBAB@BA@A@C@C@E@ABA@A@GBCBABA@CBA@A@AAA@@A@@C@AAAAA@E@CBA@AAA@A@GAGB@@A@A@A@A@AAABCAABA@@@C@C@@@A@AACBGAA@@@@@@AA@AAC@GBC@CBCAA@CDAAABA@A@ABC@C@AAA@A@@@A@G@@@AB@@@@B@@B@@AB@@@@@F@B@B@D@B@BBB@BAB@BABAB@B@FAB@BA@ABAB@@A@E@A@AB@@@DAF@BBB@BBBB@BB@BB@AB@B@B@@BB@B@BBDBBABBB@BABAB@BAD@B@FAD@BGTAHABAB@BBBCFAB@B@BA@@D@@FB@@@@@@B@BB@B@@@BABCBABA@@@@FAH@DEB@LCX@R@@@FABCBCFEBD`OBG]OBGBHhE@KDOFILQH@BBHDd@L@@@JBb@BCAC@AAA@@@A@@@AA@@@B@D@D@H@BCFCD@@@@CB@@ABB@ABMDCDDBFF@BAB@B@@@BBHB@@B@@B@@BB@@F@FN@L@DADADA@DBLCBC@@B@@@BAB@BBBDBBB@B@BBBB@BDA@BB@BDB@@@BA@ABC@A@BB@B@BABA@C@AAAAC@CBCBCHM@MJAB@JXVBRABBBBNF@FFD\\B@NDFENFI\\BDBB@D@DAD@D@BA@@BBBBB@@@B@@BB@BDB@@ABB@A@@BBD@B@@@BBB@BB@BB@B@@HBB@@@@BB@@BNHDB@@@@@@@@DA@@B@D@DB@@@@AB@@@@AB@@@@@B@@@@@B@BB@@@B@@@B@@@@@B@B@@@@@@B@@@@@B@@B@@B@@@@ABA@@@AB@@A@A@@@@@A@@BA@@BA@@@A@@BA@A@@@A@@@@BB@@@B@@@BB@@BB@@@BB@@@@@@B@@B@@BB@@BB@B@B@BB@@A@ABA@AAA@AAA@A@@@A@@@A@@B@B@@B@@BB@B@@@@B@@B@@@BBBBB@B@@@BB@@BB@@BB@@B@@BB@@@D@B@@@B@B@B@B@B@B@@B@BA@@BA@A@AAABA@@B@@@B@@AB@@@B@@@@@BBBB@B@B@B@BA@BB@@@@B@@@@@B@@@B@@A@@@AB@@A@@@A@@AA@A@@@@A@@@@AAA@A@@@@@@@@B@@@B@@@BB@@BB@@@@@@B@@@@@BC@@AA@@AA@@@@@A@A@@@AB@@@B@@@B@@@BBB@BB@B@BB@@B@BAB@BA@@@AB@@AB@B@@@B@B@@@@B@@@@@@@BB@@B@@@B@@A@@B@@@@@BA@@@@B@@@@@@AB@@AB@@@@@B@@@@BB@@B@B@@B@B@@@@@@ABA@@@@@@DB@@@BBA@@@@BA@@@A@A@@@AAA@C@@@A@A@ABA@@@@B@@@@@BB@@BB@BBB@B@@@BBB@@B@@@@@B@@B@BBB@@AB@@@BA@@B@B@@@@B@@A@@B@@@B@@@B@@AB@@A@AB@@@@BBB@@AB@BAB@@A@@B@@@B@@@@@@B@@@@AB@@ABA@@@@@A@@BA@@@AB@@@@D@BB@@@BAB@BBBB@DABAB@@CB@@@B@D@@@@B@B@@B@B@@A@CB@D@B@B@FHB@B@@AAA@A@A@ABBD@BBB@B@@A@CA@CCA@AA@BC@@B@DABA@@AAA@ABABAF@BAFBFAHC@@HABA@A@A@ABCBA@@DBFBDBB@BA@@@ACACA@A@AB@DBFBDBDA@@CC@AEAA@AAAA@@@CBA@@@ABADABAD@B@@ABA@A@ABAB@B@D@DBBBB@B@BAB@@A@A@A@@BAA@@@CAA@@@AABA@AB@FBB@DCD@BADBBB@@@DADABBDBB@@D@B@@A@A@A@ABAB@B@B@@B@B@B@@BBD@@@BCBA@@@A@@@@B@@@@@@@B@BBB@FBD@FBD@B@D@B@BA@@@@@@B@@A@@@@BA@@@AAA@ABA@@@@AA@@@@AAA@A@@BABAB@@ABAA@@@@@A@@BABC@A@@@A@A@ABA@AFCHC@@DABA@A@ACCAC@A@CAA@A@AA@AACAAAAAAABA@@AA@C@ABCDCFG@AAEAAAA@ABA@ADADCBA@ABAAI@E@CBA@CAA@ABADCBABC@C@AAC@@DGDCBCBABCBABABCB@BA@AAACAEC@A@ABABABAB@B@BABABA@AAA@A@EFABCBA@A@@@A@ABABADAB@B@@B@B@BB@@B@B@BBBDDD@B@@@@CAABAJB@@DAB@BABAB@BABC@AB@BAB@B@D@B@@@DADA@@@C@AAEIKCCAAAAAAACACAA@A@AA@@C@A@@AC@AA@BEBCDKDEBCFE@ABA@CAKBE@EBEBCAA@K@IBO@ABA@C@@@ABADE@C@G@A@@BGAGBEDGHUBEBCDC@CAG@C@GAEAC@ABC@C@C@A@A@AEKAC@ACCA@@C@A@AACAACEECAA@A@CAI@A@AACBC@CBIBC@A@AAE@GBE@C@CA@AAAI@ABCBA@A@EBC@E@ABGBE@CBE@A@@"],encodeOffsets:[[88197,45269]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("塔城地区",{type:"FeatureCollection",features:[{type:"Feature",id:"654201",properties:{name:"塔城市",cp:[82.986978,46.751428],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@DNJpRRHhRTBPBRJTFRAP@LANALAjDHL@dETHZJdB^GZD\\LTLV@PKPMHI^EdCHILKF[FC`ATSVEfBdDXHTKRKPAbJlLBS@I`QJMFCHITQT[X@ZNTHVFLGHKVGHMJGfBLILINKJKHGPEj@NI@MEK@CJCx@PMDAJE\\AVCAEAA@ACA@AAE@A@A@A@@@AA@@ABA@@@A@@@A@@@A@@AABA@A@A@@@A@A@ABA@C@@IAE@A@@AA@AAA@AACAAA@@@A@C@ABCBE@@AA@ACCCG@GAAA@@A@AA@C@@@@A@@@A@A@@BAAA@A@ACAGGA@C@AAGB@AA@AA@@AA@AAEAC@ABAHOFECC@CAA@G@CCEAGCCECGAQIECEGIKCE@CBA@CAE@AAEACGKC@AAC@GCIACAEAC@I@A@EBA@A@CAA@AAAAGEACAA@A@CACAE@A@A@AAA@E@A@A@A@G@A@@@@ACACEI@AAG@GAG@C@@@A@E@A@CBA@ABA@AAE@E@ABC@CDC@A@G@C@CBADEFGFIBE@ADCDCDCBCBEBABAFCBABEDC@A@CAE@EBCBCDC@@@A@A@ABO@YBI@C@G@A@AAA@@@A@C@C@CCYCK@G@AACACACEEEEA@AAE@ACACQMG@G@QBS@E@AA@AAAYkCIEECAIO@CKQO[EKESC@AAA@@@ECAAKAAAAABC@EAA@ABAFEBCHIBCBCDO@ABCQEKIGGIO@A@@@GBIBOBQ@SEKAE@ACMAM@AACASCSBIFUGKAAABEBA@GBK@K@AAA@_AAA@A@G@G@ABOBA@A@CBE@ABC@EBgNKFaRKD[NKF]RIFcVOJKFYZABMTCFADADEH@BEDCFA@ABAAAB@@C@A@BCA@@AA@G@CCABA@A@@AAAAAAAB@@A@@AA@@@AAE@A@@@A@A@@D@@A@AIGC@CAAAA@@A@@C@AAAAG@@@@EAAHEJIKKgAiHIP@AA@GBA@@D@B@DCB@@@@CBI@CAA@EBA@C@E@K@IBCBCBE@C@EAAAIAGGEGECE@[AY@A@aBM@CACAGBA@C@CAI@EB@@CBCBCD@@GBKBC@EBS@EACAEBCBE@CBC@A@EDQ@C@C@C@OBC@IAC@CBABABA@A@ACE@CAA@ABE@C@A@E@A@ACAACAEB@@CAKBA@@@GCC@KB@@ACAAA@C@CAC@ODA@C@@@G@C@C@ADA@A@EAA@ABA@AAEBC@IFGBGFA@AAA@ABABCBG@@BAFCBCDA@AAC@ABEBE@A@A@A@ABEBC@A@@@@B Ė^àDHn¨b¤rCt@BbTrjHZPpBZDDDRJf^Z^PRJL"],encodeOffsets:[[84985,48195]]}},{type:"Feature",id:"654221",properties:{name:"额敏县",cp:[83.628303,46.524673],childNum:1},geometry:{type:"Polygon",coordinates:["@@DN@BBFFL@TARAPAJ@H@@@BJPHHLJRFAD@BCPADADGJADEFAB@BBB@FADBBBBLBBBFD@@B@BBD@FTFLP\\LR@DJPDBFFDJZlBB@BBBF@T@RAH@H@RNBDBDF@BBB@FFFFBDBDBD@B@HDLDZ@D@D@D@B@@BB@B@B@H@DAJ@ZAP@B@B@B@@CDADAD@FBF@D@BCDAFABEDABABAFADCDCDCD@BAFEJEHCFAB@D@D@H@BCD@DAD@B@FBF@BAB@BAB@D@B@F@B@@@DBH@HBH@BFJBDBD@@@@@B@H@B@B@B@FBB@B@B@BBFBD@D@BBBBDHFBBBBB@DBB@B@FAB@J@D@FBDBJBHDD@BBD@HLBDBF@BBF@DAB@DDFJLFHFDRJHBFDDDBHDF@D@HBB@DDDEFGPAB@BBDBF@BBB@@BBB@@BHABBD@B@HHDB@B@BBBAB@@@B@B@@@B@@D@B@@B@BB@BB@HDHDD@BBB@@AFAD@B@D@B@@BBDBBBB@BBB@@BB@F@JB@@@DAB@B@B@B@@@B@BABBB@@@B@@@B@@@B@@AB@BB@@B@@@B@B@BBF@BDB@BBBBFNAbArOVGDIEURCJF\\P^BRD`A\\GTIRGfCVI\\KVMn@RIB@DOP@\\HLIDQRCR@JP\\RXF`OZPVBVQNCfM`EXERAXAdBXBZFHBJDZHRJXNRJ`TJB`KVAVHLIPCRLRFVIJCLCPAPDTDRHNFLBJEPKVEX@LBLBVFV\\@VEJIHILGNCZKZKTKVBZ^JfFNB^BtCVAVLZ@LIXT@@ZFNBX@DZSLM`[\\O^EZCLAjSDKP[PSbUd[\\[`gHKNCF@ECA@AA@@AC@@CECA@@GAA@GBA@A@CAEAA@A@A@CBEBC@C@A@CBE@E@CAA@CBC@GBABC@E@C@AACCEAC@GCICAAC@CAC@E@A@G@E@A@A@CACCAAA@@C@AAA@A@@@A@AAAAAC@CAC@M@AK@@@@BAACAG@ADCACAAMAIEIG@ACAC@MAEAQCICGAC@E@ICAABCACB@@A@CBA@EBG@@@G@C@G@G@A@E@CAECGAAGCEEGCGEEEGC@AEECEAACECG@@CEAG@@AG@EBEBEAG@EACAGAEAECECE@AEECAGEAAGEAAEEAAEEEG@@CEACCEAA@GAGAEBGBE@ADEDGBCFEDEFEFEFEDGDCHEDAFEDAHEBAHCHCFEHEB@HEHEBAHCHE@AJCHCB@HCBAHCFCJAJAJAJAJC@@L@JA@@BGBEJCFCHAJCHCJCHCHEFCFEDEB@HCFAJAB@JCFCHCDCDEFEDEDEAGAEAG@@AG@CEE@AEEACCECCAGACFEBC@E@G@A@G@C@G@EDGDEDEDEDEHCBCDEDGBEBGBE@C@E@G@AAGAA@G@AAG@CAG@ABE@G@CFEDGFEBCBEBEBE@CDGBEBCDEDEHCBAHEDAFEBAFEFEHCBAFEBCFEDEFEBAHEBCHEFEB@HCHCBAFCHEB@HCHE@@JCHCD@HEHAHEFCHCDAHCBCFEFEBAHEFEJCBAHCHCHEB@HCBAJAJC@@JAJAH@JAFAJAF@JAJ@B@JAJABAJCHCB@J@H@J@J@A@@E@ECEEEEEAAGECAGAGAGGEEGEWECBAAE@GAE@GAKAG@C@E@O@E@G@I@IBE@I@E@S@a@Q@E@GBA@C@EAG@E@CAC@A@C@A@AAO@AAC@A@C@GAE@A@K@C@CBA@GBE@CmAiBgBgBkFoFC@ECI@K@C@CAE@@@MAI@UAGAEBK@@@CBO@EBE@ABKDCBEBC@@@EBE@C@C@E@C@G@E@C@AAC@OD@BE@I@E@EBEBE@C@EAA@EBA@C@IBC@C@ABCHA@ADCDCDABABA@CFMNG@MAEBK@MBG@G@EDCBA@EAG@A@C@I@EBIFABCBE@ICG@ABK@C@C@W@E@K@GBAAM@CBE@AAK@GBEA@@G@A@IDGBABG@E@@@K@G@CBCBC@C@EFCBC@CBA@CBCBOAG@CAQC@@ACI@EA@@IAGAE@SASD@@C@ABE@E@KCA@A@MAM@@@G@G@@@C@CAIBI@QEE@KGAAIA]A@@CAG@AAA@I@ABC@A@C@ABA@E@A@CAABAAC@A@@@E@A@A@E@A@A@A@A@A@EBABA@G@E@A@A@@@@@C@A@@@CB@@C@C@A@A@A@C@@@E@C@EBC@C@E@C@A@EDA@@@CBABCACACAC@CAGAC@A@E@A@A@A@A@OAA@EAIAECMECAGEA@AACCGCCAGC@AECCAAAAACCAA@@@@@A@@AAC@C@CDE@CAG@EDM@EBIDEBK@A@CDADBD@BABABA@BD@DAFEBG@CACAA@EBG@E@C@CB@@A@C@EDABA@EBCBC@C@C@IAģÒŧ²ECIEECECCCC@CCCEC@ACECCCGDADABABCHCAAASGA@ECAB@@A@SEI@AAC@A@C@AL@@ADABCDCDA@I@kBMCEFADABCACACD@@@BA@CDIJ@H@B@@EB@B@@AB@@A@CD@DCDA@AB@BGJS\\ABCDCFABYByBE@YFG@eJEBCBOFYJUHQJBNC@@B@@A@C@C@@BA@CB@@CB@BADAB@@CBC@@BA@EBA@AAABCAA@CBE@CBBBHLEVAJDTBTBD@BBN"],encodeOffsets:[[85415,47583]]}},{type:"Feature",id:"654202",properties:{name:"乌苏市",cp:[84.713396,44.41881],childNum:1},geometry:{type:"Polygon",coordinates:["@@CA@AAAC@CA@CAA@CBABC@@EEECE@CAG@CA@ACAA@A@C@EEEEGEGAIBCAMGCCCAC@C@KEGIIKIGCGEE@AAC@CAA@A@C@A@AAA@C@@CC@@CCCECA@AA@@A@AAAAA@AAA@@@AAA@E@A@@BE@CBCAA@A@A@@@C@A@A@A@CAA@A@@BA@CAA@@BCBC@@BA@AFCJKBA@@@A@A@A@@@AB@FAB@@@BAB@@@@C@ADC@@@A@A@ACCA@@A@@@@@A@@@A@@@A@@@@@A@@A@@ABA@A@@@ACA@A@@@AB@AC@A@A@A@@AA@@@ABA@A@A@@AAA@AAA@AA@@C@AACAA@A@A@AAEA@@A@A@A@@@@A@AAA@@EC@@@@BA@@@AAA@A@@@ABA@@@A@@AA@@@A@AA@@AAA@A@ABA@CB@BABA@ABC@@@@DABA@A@A@@BA@@DA@@BCBAB@@@DAD@DADCB@BCDA@@AA@C@A@@DA@@@ABC@AA@C@A@C@A@AA@@@AACAA@AAA@C@A@A@@AAA@AA@@AC@@AAAACACCCAAAAAB@@A@@AAA@AA@@CCCAAA@@@@AA@@@AAAAAA@@A@CA@@@AA@@@A@ACC@@@ACCA@@A@A@@BA@A@@@@@A@@@A@ABA@ABA@@B@@A@@B@AA@A@@@A@@@@@C@AAA@@@@@A@A@A@A@A@@@@@@@ADA@A@A@A@A@ABA@AACAA@CBA@@@A@A@@@A@A@@@A@@AA@@@ABC@A@A@A@@AAA@AAA@A@C@C@CA@@@AA@CAAAAA@A@@ACCCAA@A@@@AAA@@AAACAA@A@AA@@A@@AC@AAAA@@A@A@A@A@ABA@A@A@A@@CA@AA@@@BCAA@@BA@A@@CC@A@@AA@@@A@AA@@A@@@E@A@CAA@AA@@A@A@@@A@AAA@A@@@@@A@@@A@C@AAAAAACA@CCAAAABAA@@A@AA@@@@A@@@@@AAA@@AA@A@@AA@AA@AAAA@AAAA@AA@@@AA@@CA@@@@A@@@@@AA@AAA@A@AAA@@@A@@AA@AA@@AC@@AAA@CA@@AAAAAAA@A@@A@@CAA@@ACA@A@A@AAA@@A@@A@CAABA@@AC@A@@@@@A@@AA@A@A@@BCA@@A@C@@@CB@@@AA@@@A@A@A@@@C@A@@@@@A@A@A@A@C@A@@BC@@FE@ABADCBEBA@ABA@A@A@@BA@A@@@A@@AAEAE@CAAAA@C@CAA@G@KBE@CBA@@@A@AB@@BB@DAF@@BB@BB@@B@B@@ADA@@D@DADABA@@BA@@BAD@B@BABADAD@DABAB@B@@B@@B@@@BBB@BA@@@CDA@EBA@ABABE@C@A@@BAB@BED@@AD@BAB@BA@BB@@ABA@AB@@@BAB@B@BABA@A@AB@@@@ABABA@@@C@@@AA@@CAAACAAA@@@AAAAAU@WEYC[AY@SHQJmTQH]FQA_B]HMR@VJVFFB@@@HDDB@BBBBB@D@@BBB@@BBB@BB@@B@BA@@BBB@B@B@@BBBBB@DBB@D@F@DB@@BBABBBBBB@B@@BAD@B@@@B@BB@BBC@A@@BA@CDABA@CAC@CAA@ABCB@BA@A@@@GBA@E@A@CA@@A@EBE@E@ABC@@BA@CB@@C@GDCAA@EBC@@@ABAB@@AB@@ABABA@CB@@AB@B@B@@CBC@A@E@EBA@C@@B@BA@@D@@A@AAC@C@A@AAA@A@AAEAE@C@A@@BA@A@ABA@@@A@A@A@@@A@A@A@A@A@AACBCBC@@@CBABA@EAA@EAAA@@AAAA@@@@BA@AB@@AA@CEAA@@BA@@@@A@A@ECCACCC@C@A@@@@D@@A@@@AAA@ABA@@@@@A@C@AAEAA@CBA@A@CAA@OBA@E@CAEAA@@@A@EBCD@@ABA@AB@@AAA@EAA@AAACA@CAA@CAEBA@I@CAC@E@E@E@AAA@@@@@@A@A@ACCA@EAA@C@A@A@ABCBA@C@ABA@ABABAB@B@B@B@@CBIB@BCBEBCBA@AB@@ABAB@BA@CAECAAA@AAC@AA@@@@AA@AEAGACAA@@A@@ACAA@A@A@AA@@@C@C@A@A@A@@AAA@@K@CAA@AAAA@@ABA@ABA@A@ABADAF@FA@A@C@C@A@ABA@KAECA@C@C@CBA@@@@BBBBDBB@B@BA@@@EB@BE@C@@BA@BBBH@@CDAD@@@BBBBBBB@B@@@BCDAD@@B@@BB@@D@B@B@B@BA@ADABABA@C@ABCAC@C@CB@@@BAD@BAB@BC@CBCBA@@@ABAB@DAD@@ABC@CBE@E@AA@@ACAEAAAAA@EACACAACE@C@A@ADA@CBABC@CBEBC@A@A@@@@A@AC@CAA@CBE@@@A@IDA@A@A@A@ID@F@@GDEB@@ADADAB@@AB@B@B@@@@G@K@CBCBABE@A@@BA@C@EDC@EBA@A@@BABABABCBCBA@A@C@A@AEAA@AA@AAA@C@A@A@@@ABABAB@@A@A@A@A@A@CD@@@@@B@@DB@DBB@DA@C@ABEBA@A@A@A@@@A@@@A@CBC@A@ABA@A@A@GD@@@B@@@BABABCBAB@B@@@BBBFBBB@BBB@BD@HBFBDBHB@B@@@@ABED@@DDBBDB@@DDDBBBB@@@D@@@@B@DBD@@@B@@A@A@A@C@EBA@@@EBGAA@A@C@C@E@A@@@A@@DAB@BADAB@@AB@BAFAB@B@@ABA@ABB@BBDDAB@BC@CB@@C@ABAB@@AD@@ABA@A@CBA@@@CDCBC@E@AAAAA@A@UEOCMBEDQJ]JEBEFAF@@EF@@EFEFGDEDA@I@E@I@CAEC@@EEEEEAIAG@IBA@IDEBGDA@EFCBGDEDGFEF@@EH@BCF@B@BFBHBBBDB@B@@@@ABA@CB@@CDAB@@ABA@A@@BAB@D@B@BA@CBA@C@A@A@@BA@A@@@A@EB@BABAB@@@@A@@AA@A@C@A@A@CAA@EAA@C@C@EBABEBEBAAA@CAAAA@@A@A@A@AA@@AA@A@@@A@AB@BA@EDA@@@A@A@CCECCCAACCEA@AA@I@E@IACBI@GBA@GDGDABGDGFABGDE@E@GCEACCGAECA@I@I@A@IBA@GDGDGDABEFABEFEFCDEDGDEDGBCBGBCBID@@@BCB@@AB@BAD@@@@A@@@AA@A@AA@CECACCAAA@@@A@ABE@C@AACAAA@@AAA@CA@@CAA@@@A@GBEAC@A@A@AAA@GFCD@@@@GCC@A@CAAB@AC@CCCAA@C@CBGDA@GFEBCDEFCDCFEFCDEHCFCDCHADGHEDEDEBEBK@I@OCI@C@A@A@AA@@A@@@CAE@CAAAAAA@ECAAA@EBAAA@ABAB@@@@A@A@A@@@A@CAA@C@@@A@A@A@AA@@E@C@@@@BAD@D@@@BB@BD@@BB@@@B@@@B@B@B@B@B@B@B@H@BAB@@C@CBA@ABCBA@C@@B@DAB@@EBABA@ADA@CBC@CBE@I@@B@B@@BBDF@@AB@BBB@BA@AD@BABAB@BA@E@@@@@AF@@@B@@@BBBAB@B@B@D@BBB@B@B@B@D@BABA@ABA@A@A@CBABC@A@EBAB@DALBL@PAJALAN@LCJCHCLIJILIJIHMRCJ@J@HDHDLJLFJHHJLFNFNBHAJAFEFEJC@EBGFAB@B@@BBFBB@FBBBB@BBF@JAB@B@HBBBD@@B@@@BADAD@BCBABCBAB@DAB@B@DA@CJ@@B@BBDBBB@@@BCF@B@@BBDDB@B@B@DB@BB@BBFBB@BBB@B@@B@@@D@@BBB@BBB@B@FB@BBBBBD@B@B@@@DF@@B@B@BB@B@@AB@BBBBBBBB@DB@@@@@B@@B@BAB@BBBB@@DD@B@B@@@@@BA@@BCB@@CBABCBABABAB@@BB@BBBBBDDBBDDDBDDBBB@BBBB@B@B@B@B@@CDCB@BAF@BAB@D@B@@BB@@A@B@@B@BBJ@@DJFDBFFDBB@@BDHBFF@BB@DN@DBBFFBBJHJJJHBBDB@BB@@FCJABAFAFCF@@CHEJAJCDAJ@D@H@HBFBJH@JAVEPCD@HBH@D@F@R@J@F@J@B@@@JBJ@F@F@BAD@DBD@DBD@DBD@H@FBD@FDB@J@@BBBBBBBBBDF@@BAD@LA^CPA`CIJAL@jCD@FBH@T@D@DBD@D@DBBBDDD@D@@@F@@ABABABAD@B@DABAB@B@@ABB@@B@@@@ABB@@BA@BBA@@@@B@@@@@@@B@@@B@@@BB@@@@BAF@BADA@CDEHC@A@CBCDADAD@D@@@@EBCBEBEFA@IHMHA@EBA@ABABFNFLBFBDBN@BBD@B@L@F@B@BBX@PBLBJDV@BDNBFBD@FBDBFBD@BBD@BFP@B@B@FBHBDBB@BBBDF@DDHFL@DBB@BFHHPBDFHBD@DBDBLBHBBBF@BAB@D@JAF@BAF@B@B@@@B@@A@@@@@@DB@@@B@B@@B@BABA@@A@AA@@@@@A@@BB@B@@@@@ABA@CA@@ABA@A@@@A@@D@B@@B@B@@B@BA@A@@AA@@AA@@B@@@B@@@BB@B@@BA@@@@@A@A@@@B@@@@AA@A@@@@@A@BA@@@@A@@BA@A@@@AB@BB@@BA@A@@@@DA@@@A@@@@@@A@@CBA@A@@@@@@@AB@@@@@B@@A@AB@@@@@B@@@@C@@@@@A@@B@B@@B@B@B@B@@@B@@@@B@@@@CBA@@@@@@@BBB@@@@B@@C@ABA@@@AA@@@@@AA@@@@@A@@@BB@@@BA@@A@@@AA@A@@@@@@BA@@A@@A@A@@@@B@@B@B@B@@B@@@B@@CA@@CB@@@A@@@@CAAB@@A@A@A@C@A@@@ABA@@B@DBBDA@BB@BBC@@@AB@@CB@AAA@@A@@BA@B@AB@@A@C@AB@@@@ABA@A@@DABA@A@@@C@@BA@BBB@B@@@B@B@B@@B@B@@CAC@A@ABAB@BDBABCAA@@B@BBBBAB@BBA@A@ABCDDBBB@B@@CBA@A@@@@@A@AB@@A@@B@@BB@@@@@DA@CA@@A@@BA@@@@BBDABA@@@A@CD@@AB@@@B@DABA@AB@BAB@@@B@@ABBB@@@BADGDCB@B@@@D@BBD@B@BABA@A@A@C@@BDB@@D@ABCD@DA@C@CBCFAFAD@BID@BAB@BCDABBDE@A@@DBBBA@BB@@@@@@BA@A@A@@A@@@@A@@B@B@@ABC@@BA@@@A@@@A@AB@A@@@@A@A@@@A@ABA@@B@@B@@@D@@@CBA@A@@@@B@@@@@@@B@@@BB@@@@B@@@@@@A@@@ABA@@@@@@@@A@@@A@@A@@A@@A@@@@@AB@BA@AA@@A@A@AB@@@@BB@@@B@@C@A@@@AAB@@@@A@@A@A@ABA@@@AA@@A@@@@@AB@@@@A@A@CB@@@B@@AB@@A@A@C@@@@@@@A@A@A@C@@@A@B@@A@@A@A@@@@@A@@@A@@@A@A@@@A@@@A@@B@@A@A@@BA@A@AA@B@@@BA@@B@@A@ABA@A@A@@@A@@A@@@@@@AACA@@A@@@A@@@A@@@CBA@@@A@A@@@@BA@A@@@A@C@@@@B@@@BB@@BA@B@@BB@@@ABBB@@BBAB@DAB@B@@BB@B@@CBAB@B@B@@@B@@EDC@AB@@ABABA@A@@@@@A@A@@@A@@@A@@@@@@D@@ABABABAD@@@B@@@B@@A@AA@B@@@BAB@@@@AA@@@@@DABA@ABA@A@@@@AA@@@A@@@@@A@C@@@@@@B@@C@@@@@@@@@B@@A@@@@A@ABA@CBA@AA@@CDA@CAA@AA@@A@@@@@A@@@@B@@@BBA@@@B@@@BA@@@@AA@@BA@@@B@@@AB@B@@A@ABAAABC@@@@B@@@@@@A@A@A@@@A@@@A@CA@@A@AA@@@B@@@BA@@@C@A@A@A@@@@@A@ABA@@@@@@BB@@@@B@B@@@BA@A@@@A@@A@@@@C@A@A@A@A@AB@@AAA@@@AC@@@@AA@@ABCBA@@@@@@B@BABA@AB@@@BEAA@CDAB@BA@A@@@@BB@@@B@@BDDBB@@@B@B@@@@ABCBABADABA@ABI@ABG@ABIF@BADAFABADA@DBN@NBL@L@PBNBPBTDVBTDRBRBVB\\BVFTDTBRDL@LDF@HBLBxNJBPD\\FTBHB@@D@D@BBHBB@DBFBB@B@BDB@FBFBHBFDHD¦GrAB@PJ^FJBPDVDrJjHBBNFlTDB@@A@ABABAB@D@@@BDB`JDFBlRlFJRF@P^FnHVFfDRBPBDBD@NB\\DD@^FrJfJJ@BBD@`LXDBDBÈt¤RJTL|bD]F[BODUHGDCB@@ADE@ADADABAB@JIDCDADABABABEDCDCBEBABGDC@ABCBCDEBA@ABC@A@A@@BABABA@AB@FA@@DABCDAHEDCFEFCHEFCFCBCDA@GAMAKI@@GAMEkC]AUQKTEGIOBAk@E@A@G@E@E@AD]@C@AAI@E@A@@@@@AZ@B@@Q@OB]@@Be@KDgY@SBA@C@@@@@AA@MDAFCFCDAB@LGBABAFK@ABA@@FAJCbMBA@C@@@@@A@A@@B@BA@@BAB@@A@A@@AACACA@@AABABAB@DAH@DBH@HCFCB@@CB@LAB@B@@B@BD@B@DC@@DEDGGEAE@ABCFEF@D@@@BBBB@@BA@AB@B@FAHCDAB@D@@@D@B@DB@@BA@A@A@@B@BA@@@@@CBEB@DA@@@@@A@@BCBA@CBCBAFAFC@A@C@CB@JC@AFEB@@A@A@A@@@AB@BBB@@@@ABADAB@@@DBBBB@B@BCBCDADCB@BAB@F@B@BBFFB@B@B@@@B@@DDLBB@BFBD@B@@@H@JBZ@xDELGPEJ@@@BGPKT@B@@ABCHADEN@@CDCJGNAB@BCHGNQdUlAB@B@F@@JBHBLBHBB@PHHBRHB@FFD@D@LBDBB@@B@@D@JCDCB@DALEBAVIJELELEFABAR@H@HAB@HBVDLDB@@ADCBA@@D@@AD@DAJ@B@B@FEB@@CBCBABG@ABAFEBC@@@@B@BADADCDCDABAB@DC@ABA@@DADAHA@@BB@@B@DBB@@@DAHCDADC@@BCBABA@@B@DC@AB@@A@@B@@@BAD@DCHAFADAFAJEHAFAFAJEBADEDEDK@EBAB@AG@AAAAA@C@AC@@@@ADUBGOACAC@CAC@GAGAAAAAACAAAABAAG@A@C@A@EAA@G@C@C@ACY@EEcAYzHRBTBHB·©ÉSKA@MDMBABC@CBAAAEE@CBG@CGK@EDIBA@G@AHG@C@CDC@EGOACKKKKMKGCGCEAAA@A@@CAACOOCCMIGCIG@ACCAA@@AAACAA@AAA@ACG@AAC@A@C@@@E@IBCACBE@OA@AACAI@CA@A@@@C@E@I@A@G@G@M@IBG@C@C@C@C@KBGBI@CDKDEBABI@CACACAC@CBADCJIBCBE@E@IDM@CBC@C@EAC@CFM@ECIAGCMCO@A@C@I@C@EKSCCESGIEMCIEKIICGEEKKMQMKACACCIEI@CACB@DABAB@B@DBBBDBFDFBHDLFFDHDBBDDDBTLDBHDDBBBBBBBBBFBFDJBD@D@DBF@F@F@HCDAB@BAB@BB@@BAB@DBD@@B@DD@BBBB@B@BBB@@BB@BB@BBB@D@B@B@BBFBBBB@BAB@D@@@B@B@@@FEBAB@@AB@D@D@D@FBD@FBB@H@F@FBD@D@D@FBBBJFDBDBB@F@J@DBB@@@BB@B@BDDDHFDBDBFDB@BBBBBBDBF@B@DB@BB@@@H@D@B@BBDAB@B@@@B@D@B@B@B@@DF@@@@@B@B@@@B@@@@@B@@@@B@@B@@@@@B@B@@@B@@@@@@@B@@@@@BB@@B@D@BA@@D@@@BA@@@BBBB@B@B@B@D@B@@B@@BDD@@FBB@BBBDD@BBBBDBDBBBBF@@@BBB@BBB@D@D@HBB@@DF@BBDBBFFBD@B@BAD@@@BBBB@LBB@B@BBLFDBBBBDHDBBHDFBF@F@RFH@DBB@FBD@N@L@HB@AF@B@F@F@D@L@FBDAB@B@D@B@FAD@DAJ@HADAD@LAH@FAJAB@BAB@@A@A@ABA@@BA@ABAAA@A@@BA@@A@@@@A@@@A@@@C@@BA@@@A@@@A@@@AAABA@AA@@A@A@@AAAA@@A@AA@A@C@@@A@C@EAG@G@E@A@E@G@@@AA@@CAC@A@@@@@A@@A@BAAC@@AA@@AA@@@A@A@AAA@A@C@A@AAA@@BAACBA@@AA@@BA@@AA@@@@@A@@@AA@@A@@@@AA@AAAAA@AAA@@@A@@@A@A@@@A@@AA@A@A@ABA@@@@@A@@@@@@@A@@@A@@@@AG@@@A@@@A@@@@BAAABAA@@AA@@A@A@ABA@C@@@@@AAA@A@A@@AA@@@A@@@CAA@@@@@A@@AC@@@@AA@AA@AC@A@A@A@ABC@@@A@@@@BA@A@AAA@AA@@ABCB@BCB@@@AA@@@ABA@A@A@ABA@@@A@@@A@A@@BAA@@A@G@C@CDGBG@GBE@C@MBIAC@A@A@CAG@A@A@C@A@AAAIISKAAKEA@CACAGAOIGC@AAAE@"],encodeOffsets:[[87090,45127]]}},{type:"Feature",id:"654223",properties:{name:"沙湾县",cp:[85.619416,44.326388],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@CA@@A@@CB@BA@@@AA@AAA@AAA@@@A@CAAAAA@AAA@ABA@@@A@CAA@@@C@A@A@EA@@AA@BA@@BC@@@A@@A@AA@@AA@@@AA@A@CAA@AA@@@AB@@A@@@AA@@A@@@AB@AC@E@AAAA@A@@@CB@@A@@AAA@@@A@A@A@CAGAA@C@ABA@ABABA@AB@BABADA@A@@BAAAB@@ABC@@AA@@@EBA@AB@@AAA@A@A@ABC@@@AAA@AAA@@A@A@A@@@AAAA@@AAA@AAEAA@AA@@@A@A@AAA@@ECAA@A@@@A@ABABAB@@@CAC@A@EBA@@A@@AAB@@ABABA@A@@AA@@AAA@A@@ABABABA@@@AA@AA@@A@ABABC@ABCAGBC@@@AA@AB@BCB@AACA@A@A@AAA@A@AA@AAAAAC@A@@B@BADABA@A@A@CAGAA@A@AA@AC@C@C@A@AAA@@ECCCAAAC@C@@AACAAAAAAA@C@A@ADCB@@AAA@CAAAAAA@AAABC@ABCBAAEB@@ABA@A@AAA@AA@@ABA@@@AA@CC@@AA@C@@@ABABA@A@@@AAACCA@AAAA@@A@EAA@AA@@AA@A@C@@@CAAECA@A@AAA@AA@@G@C@CBA@A@C@C@A@A@@BA@@BCB@@CBA@AB@AA@C@AAMA@@@@BA@@@ACAAAEAAAAA@A@AA@ACC@@@E@A@A@@AACB@@A@CACA@KCA@AA@ACCAAAAA@CEAAAAEAGCAAAA@AA@DI@@@AAAA@@A@AC@A@A@CBA@A@A@@AAA@EAA@AA@A@A@A@CAC@A@CBABEAA@AAA@@E@AAAAACCCAAEAA@@CAAA@@@A@@BA@A@ABA@ABA@@@AAA@@@A@@CA@AAAAC@A@@B@@ABAB@D@FAB@D@@A@@@@AA@A@@B@@ADAB@D@B@B@@A@@CAAAA@A@A@EBA@EBA@CBCAA@A@A@A@C@C@G@E@E@C@C@C@CBABG@CBE@CBA@CAE@EAA@A@A@ABABIBE@ABAAA@CACAAACAAAAA@ACEAA@AAA@@A@C@EBEBC@@@AAAACCAA@@@A@A@@AA@CAE@@@@@AB@@AB@@A@A@@AC@@@CCAAAA@CC@@BA@A@@@@AAA@G@E@A@CBA@CAEAC@C@C@A@@@AAAA@A@A@AA@AAA@G@AACAAC@C@AA@AACA@AGAC@CAA@E@E@EBE@A@C@@@C@CBA@A@AAG@ABC@CAC@E@CBE@E@A@ABA@A@A@AAC@C@GBG@EBABCBC@C@E@A@A@@CAA@@A@A@ABA@I@A@EBIBA@@@C@C@A@CBCBC@@@CBCB@@AB@BADBB@@BB@B@B@B@B@@@D@B@B@@AB@@AB@@@BBDBB@DBD@B@DB@@DB@@BAB@@ABABA@A@@DAB@BA@CDCBABA@@@A@EAIAA@A@A@ECAACCA@@C@A@ABCBA@A@AAA@A@A@A@@@A@@@@AA@@AAAAA@CAAAEAA@@@CBCBCD@@@@GBCBA@GBABAB@BA@A@GAC@ABA@@BAAABA@CB@@AB@@@D@BA@@D@B@@A@C@EBA@AAA@A@@@AAAAACCC@@A@A@@@CBEBA@A@AAC@CAC@EAAACCAA@A@@A@ABCBA@CAC@A@A@A@@BA@@@A@A@ABC@A@E@C@ABA@@@@DAB@@@@C@@@AA@@E@A@C@@@CACAA@EA@@AA@@@@ABABA@CB@@AB@@DDBD@B@@@BAB@@@BB@ABGDABAB@@@B@@@B@@@B@BABABA@@@AB@B@BABBBALBB@B@@@@@BC@ABC@C@A@CAA@G@GBAAG@IBOBA@CBA@ABA@CBABA@C@ABA@AB@@C@C@GAC@EAA@A@@@CBCBABABA@CAA@G@@@A@A@@@A@CBCBEDCB@@@FA@ABA@ABA@@BA@@@@BB@@BB@@@@B@@C@@@CAAAC@@@BG@A@@AA@AC@AACCA@AC@@AACEEACAAAAAA@@A@@BC@@AA@C@@A@@AG@A@@@A@@CA@CE@@@A@C@C@CAA@ACACCA@@A@@@A@A@@CAAAA@@A@A@@@AB@BE@@@A@@CAA@EACC@@AAAA@A@@KBA@CCAAGCAA@@AC@@AAC@AAA@@ABA@C@CBABADC@A@@A@GAC@CCEAAAAA@AA@@@E@C@A@ABEB@@EDABA@CBABC@ABA@AAC@AACAA@AAA@@BC@A@A@A@A@AB@AA@A@AAA@AAA@@@A@@@A@AB@@@BA@@@@BA@ADABAB@@A@@@AB@@A@@@@@ABA@@@@BAB@@@B@B@@@B@@@B@@BB@@@B@@B@@@WROL@B@@@@ABCDABAB@@ABAB@@@B@D@BAB@@@DAF@@ABC@ABCB@@BD@B@B@@AB@D@B@B@@@BA@@@AH@@@B@B@@BBB@BBBDBB@BA@@BCDA@@@@B@@B@FBBB@B@B@@AD@D@@BBFB@B@BB@@BA@@B@DAB@@CBC@EAM@@@ABA@@@A@AAABKASAAAAACAAAC@@@A@E@AAA@AACBA@C@ABA@@@A@EBA@CDAB@B@@@D@@H@B@DBD@B@BBDBF@FBBB@@@B@@@BAB@@@B@BAB@BABAFCDAB@BEF@@AD@@@B@D@B@B@B@B@@@@@B@D@@@B@B@B@@BB@@A@@D@@@D@BB@AD@@@B@BBB@@@B@@@@@BBD@@ABBB@D@BB@@@BB@B@B@BDB@BB@DB@@@BB@B@BBBBBB@@DBB@BB@@BD@@BBB@@BB@@@B@BBB@B@BBB@@B@@@@@B@@B@@DB@@B@@BBB@BB@BBBBBB@@BBB@@@BBB@@BB@B@@@@@B@@B@@B@BB@ABBBBBDDB@BDBBBB@B@D@B@@@B@@@@@BBB@B@B@@@B@BB@@BBB@D@B@F@@@BB@@B@B@@BB@@@BDD@@@BAB@@BBAD@@B@@BDB@@@B@B@BAB@B@B@B@B@BB@BB@BBD@@@BB@@B@BBBBDBB@@BB@B@@@BBBDDBD@@@BBBBBDBB@@B@@DBD@D@B@B@BBB@BB@@@B@B@BAD@B@@BB@@@B@@@B@B@@@B@B@@AB@DBBBD@BAB@B@B@B@B@BCB@B@@@@@@@B@B@B@B@B@@@@BB@B@D@@@@@B@@@BBBA@@@@BA@@@AB@BAB@B@B@@@B@@@@@BAB@@@B@BB@DD@B@@DD@B@B@@BB@@B@@D@BB@BBBB@B@@BB@@@@BBDBDD@@BBB@BB@@@BA@BBBBDBDDDBBBBB@@BD@@BBB@BB@@@B@B@DBB@BBBBD@B@@BBB@D@B@D@B@@BAD@B@@CB@@@B@DBB@@CBADA@CDCBC@CB@@A@ABAD@@CB@@AB@@@B@BABCB@@@@AD@BABABA@@DAB@B@BBB@BB@@B@B@@BB@@@B@@AB@B@@@BBB@B@@AB@@@@FD@@BB@B@B@@B@B@B@@@FBBBB@B@B@DBBBD@@@BBB@BBB@BB@@@B@BAB@B@@BB@@@B@B@BBDA@@B@@@BDB@B@@@BAB@BB@@@@B@@@@@B@@@B@@@B@@@@@BB@DD@B@B@B@@CD@B@D@@A@AB@@A@EBA@@B@@@B@B@B@@ABILED@BAB@@ADAD@@BB@DAB@@@BBB@D@B@B@B@D@@@B@BBBAD@DAF@@@B@FBB@B@@BB@BBBBB@B@BB@@BDBDFDD@@DD@@@DBB@B@B@D@BBB@DBD@BFFDHJHJLHJLFD@D@DBDDNHDBJAHBHFFFFFD@B@B@DB@BDBH@DBF@FDFF@@ADAB@DBB@DDBD@BB@BDBF@BB@BHDPJHBDBDBB@LFBBTLJJBB@B@B@D@B@BBH@D@B@BBDAJ@N@DAF@HAHCH@D@D@H@BB@AB@@@B@B@@@B@@AB@B@B@BAB@B@@BB@@A@ADA@AD@BB@@BBB@B@BAB@@@@@B@@AD@B@B@B@BBDB@@BBB@@@@BD@@@B@@@@BB@D@@@B@@BB@@@B@BBB@B@@@@@DAB@B@B@BB@@BB@ABBBAB@@@@@B@@@B@@BH@@@@@B@@@B@@@@@@@B@@@@AB@B@B@BBB@@@B@@@B@B@@@B@@BB@BBBBB@BBB@@@@@BB@@B@@@B@@@@BB@@AB@@BB@@ABBDAB@@BB@B@B@D@BBB@B@B@B@@BB@@BB@@BDABB@@@@B@@@@@BBD@DB@@B@@@H@F@B@F@HBH@F@D@B@@@D@BBBB@@@BBBB@@@B@BB@@BABBB@B@@@B@@@B@@AB@@@D@@@B@@@B@@B@@@AB@@@BBBAB@BAB@@AB@B@B@BBB@@B@@B@B@@BF@B@DBB@D@B@@@B@B@B@BAD@@B@@B@BBB@@@B@B@B@B@@@D@HAF@@VEREzO@@@ANCFAF@B@B@B@@@BADB@@@@@@BD@D@DBB@B@BADBD@@@B@D@D@FBJBJBFBF@B@DBF@HBBBN@BBF@DBH@BBFBFADDH@FBFBLDL@B@DBD@B@B@B@D@DBD@BBDFDBD@FBD@DBBADDD@DBB@BBHBD@@@D@D@B@@@BBD@@@BBD@B@@@B@B@BBD@BB@BBP@LAB@L@L@RAHABDCB@BB@@FABDH@D@BGDIBABCHAB@D@BCDAD@D@B@BGF@BFD@@CBAAABAD@HBF@BAB@FA@ABCBAF@DBBAF@DB@@BAD@DBB@BD@@B@BA@DDB@B@@FA@BDBBCB@F@BFD@B@@AB@BB@@DFBAF@DBBADBB@DBD@DBBAD@@@B@@@DABAHGD@@@B@@@@A@ABA@@@ABA@@B@@@B@@AB@BCBA@@B@@B@@BABABC@@B@@@B@B@@@@@@ABC@@@@BA@@@A@@@@@A@@BAB@BBB@B@BE@@BC@@@@B@BABA@@@@@@B@@AB@@A@@B@@@BA@@B@@ABA@ABA@@BABC@CAWBMCcEC@UCO@UAW@AAE@E@S@G@KBW@EASAGASAQAyGBZFd@FDZ@B@D@D@HBB@F@B@D@BBHABBBBBBDBBBBHBHBD@DBD@DBPBAHCV@B@@D@@B@DBBBB@BBHA@AB@FCLCFCFABIFEBEBGBIFEBCBEBGBCDC@ABRFPDHBNF@@NHDDB@@@@FAJ@L@H@BAD@@@B@@C@A@@@CD@BC@CDA@A@@BA@CDAFABABCFABCD@BAB@DAB@BAF@BAF@H@J@FBDABBBJPHHDT@B@BARCVAFAD@@BDBDBD@DBBBDBHBB@BBD@@BF@BBDBDBBBDDFDFNRBB@BRDJDVHJBHDND`NPFJDPFHBJDXJPF@BFNDPBHBHFPLlpNfCB@LEDAF@^GB@BBB@ZPFFBFBFCNGNADCB@DDH@BCFAPABABAB@@A@@@A@EDCBCF@@@F@D@@ABAB@@@@@B@FBVBP@L@D@BDRBD@HBBBDBDBDBFBBBL@B@BBDBF@D@@A@ABEFGHCDA@@BKJW\\ILKPGJAB@F@H@N@H@F@BAF@DAFCL@B@BFHBDBDDDBBHFBDFBBDDBDDDDBBBD@@DDPZ@@DHDF@BBFBHBF@FBH@FBFBH@D@H@FJBLBJ@B@JBJ@J@L@JBH@L@J@J@J@L@H@L@JBĦ@L@JAJ@H@J@J@J@J@LAJ@J@@@L@J@J@J@L@J@J@D@J@L@J@J@J@JAJBL@J@J@F@JBJ@J@L@¢@JAD@BG@G@A@EAEBG@EBG@EBG@E@G@C@EBE@G@E@E@G@G@G@E@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@GAG@E@G@G@E@G@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@G@E@G@G@EAG@G@G@E@G@G@E@G@G@G@E@G@G@A@E@S@Y@ĨHLCF@LCNGDAHCDCHGDEBCFEDCFEHEFCLGHAPIFCPKLGDCDEHIHI@ABCBGFIDE@CBC@@@E@E@ABC@EFMBEFEFEDAFEfYTULKDIHSECUMUMOIEAAADAHC@@BI@EAA@@B@BA@@@@@AA@@A@@B@B@BB@BB@@@@ABABABABAB@BA@@AACAAAC@A@AB@BA@ABA@A@A@CA@@@AB@DADAB@@C@CCC@A@AB@@A@@B@B@B@@B@@ABA@A@@@@B@@B@@BB@BABCB@@AB@@B@B@BBB@B@@B@@ADABABAA@CA@A@@@@DAB@B@B@@DBBB@B@BA@A@@@AAAAAC@@@AA@@@A@AAE@@CAABA@@AA@@A@AB@BAB@@BJD@BBBB@B@BADABCBABA@AAA@A@A@A@@F@@@BA@AB@@@H@BAB@BABAAAA@I@E@A@AA@CBA@A@AB@D@BDBB@BB@B@@A@A@C@A@@A@A@AAA@AAE@CAAA@A@@DAD@@@@D@@BBB@B@@ABA@A@ABABABAF@F@@@@A@@@A@@FCDAD@F@BC@C@AAA@@A@@BCB@@A@AA@@@ABKAC@A@ABA@E@AF@DA@@@@BA@@B@@AB@@@@@B@BA@@BA@@@@@A@@@@A@@@A@@@@BA@ABA@@BA@AA@@@@BAB@@@BA@@@A@@@A@@@AB@@@@A@@A@A@@@AB@@A@@BA@AAA@@@@A@@@@@AA@AA@@A@A@AAA@@AA@@A@@@@@A@@B@BAB@B@@@D@B@BB@@B@B@@@B@@A@@B@AA@@A@@C@@@@B@BA@@@@@@@A@AA@A@@@AA@@@@@A@@@@BA@@BA@@@@@@@A@@B@@A@@@@@AB@@@@A@@@AA@@A@@@@@@@A@@A@A@@@A@A@@BA@@B@@ABA@ABA@@@AAA@A@@AAA@A@@@A@@@A@@BA@@B@B@@@@@B@@BB@@BD@@A@@@@@A@@@@A@@AA@@A@@@A@@@A@@@@@@B@B@BB@@@@@B@@B@B@@BB@@@B@@@BA@@B@@@@A@@@A@@@@@A@@A@@AABA@A@A@A@AA@A@@@@@A@@BA@@@A@@@AB@BABBB@B@@AB@@A@AA@A@A@A@A@A@@@A@C@@@A@@AA@@@AA@@AA@@AA@@A@A@AAAA@@A@@@@A@@A@A@@AA@@@@A@AB@@@B@@@B@B@BBB@BBB@BAB@@@AAA@A@A@@AA@@AA@@@@A@@@@A@@A@@AA@@AA@@A@@@A@@A@@B@@@B@B@@AB@@@B@@AB@@AB@@@@@B@B@@@BA@@B@BA@@@@@AA@@@@A@@@@@A@@@@A@A@@@@@A@@@A@@@A@@A@A@@@@@A@@@@BA@@@@BA@@@@CAC@A@@@CB@@@@@@@@CAMG@AA@@A@@A@GA@@@AAAA@@AAA@A@@@AAC@AB@A@BA@@CA@AAA@@@A@@AAAA@AB@@A@CBC@C@CAAACJ[MEEFMCA@C[EEE@AMAABAAQWU@IBANIN@DGDADAD@BBBBD@B@BA@A@AAAB@D@BAB@@A@@CA@AAAB@ACA@AA@A@AAACAAA@ABA@A@@@AD@DAAK@CCBCBCBK@M@@E@EA@@AA@@@@AA@AG@A@@@ABA@AEECADCNCBAA@BA@@DA@@@@DCDE@A@G@C@C@A@@BB@@B@@@B@BBD@DB@AAa@I@@@KCcAG@ARGJKPELCF@GgHAPAH^PAC_FADEDABA@E@@@QDW@KFA@CBG@E@@B@BADABA@A@@@AAAA@@@@@@@EA@@@CB@@A@ABADEAA@ABABABGHS@ABC@E@ABC@ABABA@AAABAAAAC@A@AAA@@@A@ABAA@@AAAA@AA@AAA@ABE@C@@BAB@F@B@@@BABAB@@ABA@E@ABABA@ABA@AAA@A@A@C@A@A@E@@@@BA@@@AA@@@@@BA@@H@B@@@B@B@BBD@D@BAB@B@BABBDCB@DBDAD@HAD@BBB@@B@@@@B@HBDABBB@@@D@D@@@B@BADBBABBB@B@B@B@@@HAHBB@B@BBB@DAF@B@BBBBD@@@@BB@BBB@B@DAB@BADAHAB@B@BAF@D@D@B@B@@ABAAA@@@AYQECAA@A@A@@B@@@B@HDDBB@B@@@@A@@@CIM@@@ABAB@LATCBA@@AAA@@AAAAAA@AAAAAA@@AAAAAAA@AA@AAAA@@A@AAA@@@AAA@AA@AAAAA@AA@AAAA@AAAAA@AAA@AAA@@AA@AAAAA@@AAA@A@@AAGGCIMOIMIKCIGGKEGGMKKIKKMIIGIEKEMIEEAAEE@@@A@AB@BA@AA@@AE@AA@A@AA@AA@A@ABA@AA@@AC@C@AAAA@ABABC@@AC@A@ACC@A@ABABAAA@AAAA@@A@ABA@A@@AACAA@C@AA@@BABABEDAD@@ADBB@B@BA@A@EBC@@@A@ACAAA@ABA@A@ABAB@B@@@BA@AB@@C@@BAD@DA@@BABA@@@A@@AAA@A@CAA@A@C@EBC@AAA@A@@BA@C@@@C@A@C@E@EBA@C@@@E@A@E@C@G@A@E@C@@@A@@@@AB@D@BAB@@A@@@AA@@@A@@@A@CBA@A@@@AA@A@@@@A@@@CBA@A@AA@@CC@AA@AAA@A@AB@@@BBB@@A@@@A@ACCAC@AA@@A@@A@A@@AAA@C@C@A@AA@@E@A@@CA@AAA@@AC@A@@@@A@A@ACC@C@@A@@AG@CBA@@AAA@AAA@@AAA@A@@AAAA@A@A@AA@@@@@AAAAAAAC@@AAA@@@AA@A@@@A@A@AB@@A@AAA@@@@A@AA@C@A@AA@@AA@AA@AA@@AA@@@ABADA@@@AA@@AA@AAA@@@C@A@@AA@@@AC@ACA@@@C@AAAAAA@BCAA@ABAA@@AAAA@AAKCCAACAA@@AAAAAA@@@A@A@@BA@@@AAA@@A@A@I@A@@@@A@AA@@@A@AB"],encodeOffsets:[[87858,44903]]}},{type:"Feature",id:"654225",properties:{name:"裕民县",cp:[82.982667,46.201104],childNum:1},geometry:{type:"Polygon",coordinates:["@@CA@A@@CAE@@@@@AA@@AAMAA@CAAA@AAA@@AACAEA@@@EBA@A@ADGBA@CBABCBA@G@AAC@@A@BC@CAC@A@@FEDCDCCAA@AAAA@@@ABABAB@@A@@@@AC@A@@BADA@@BAAA@AAACC@A@EBAB@@@DA@@@@@@AAAAC@@@@C@AAAA@E@AA@@C@CB@@CCA@G@AAA@CA@@AA@A@@CAA@I@@AC@AA@AC@G@C@CAA@@@AA@@@@AAAAA@@AA@A@@@AAA@ACAA@@@@A@C@A@A@A@E@AAC@AA@@AC@ACAAAA@@A@A@ABA@ABABAB@AE@AA@IC@@EC@A@A@@BC@A@@@AHE@@B@B@@BDB@@NED@BAHADADAD@@@DADAB@JA@@@@BABAHCHABA@@FABA@ABA@E@G@AAAAAB@B@D@D@DAB@LAB@JADCBABA@@@CAA@@B@BA@@B@@ABA@E@A@@DM@C@@AACC@AAACC@A@@BABA@A@AB@BA@@BABA@@BADA@ABABCBC@ABAFEJIFE@@@A@@BAD@@A@@@AACAA@A@A@CAAAACACCAAA@AACCCAG@CACAAAA@CAC@@@A@E@A@AABCAAAC@AECAAGE@@CA@@AEAAAAACAA@CCCAAECAAEGCCAACC@A@AAC@C@E@@CC@@AA@A@A@@BAB@FCBAB@DCBCDAFEDABAAA@@@@@K@@AA@@GCCAEACCCAACAA@AA@CA@@AA@AKCA@C@AA@@@@BAB@BAB@BABABA@@@CB@BADA@ADABAB@BA@A@A@AA@AAA@AA@AA@CCCCCCCAIEAAAACACA@AA@@A@C@@AAA@@@BA@AAC@@@AA@@@@ABC@@@@@AC@AAA@A@A@A@A@AA@@@A@AAAC@AAA@@AAAAAA@ACA@@AC@AAA@@@A@A@A@A@@@AA@@A@@@AA@AC@A@C@A@AB@@CBCBC@CBA@A@@@E@C@A@@@A@E@EBA@@AA@A@A@A@AB@@A@A@@A@@@A@AA@@@@A@@AAA@@AA@A@@@@@@@A@AA@@A@A@A@@@@@A@@@AA@@ABA@@@AAB@@AACA@@@@@A@A@@@A@A@@A@@B@@@B@@A@A@@A@A@A@CAABA@@@@@@AA@AAC@CAAAA@A@@@A@@BA@@@AA@@CAC@AAA@@@AAA@A@@@A@@@@AEA@@A@A@AAC@CA@@C@EA@@A@A@A@ACA@E@C@ABC@C@@@GACB@@@A@@@@A@@@A@AAA@C@A@E@C@C@CB@@A@AA@@ABA@EBG@CBCAE@A@E@C@CAA@AAA@AAAAA@CAC@GBAAC@A@A@A@C@@@C@@@A@A@A@C@@@E@KAA@A@AAAAA@AAEA@@AAA@AAA@AAEAAAAA@@GAGAA@GEEAOGECCEAA@EAE@AKKIMKKKIAA@ACCACACCCctABĉƓÞ@H@X]ÈZfLPFRBBfJVRRDPFvFNDRCpEPMTETBRPXBX@NAXCVHRVP\\XNnAZCPHdNBDh¼rØXºn~¢LTĦǄ@@B@D@FABAB@B@B@F@FABAD@BBB@DCDABE@AH@DABABAB@BBB@HEHAJED@FABBB@BAB@FBB@B@BCD@D@H@@@D@B@PCD@DBD@B@BBBD@@LAD@HD@@B@LADB@@FADBBBBDB@F@B@D@F@BAB@DBF@BDB@B@BABADAD@JBD@PAD@D@D@R@FCB@D@DAF@DAFADBFBT@FAD@LAHA@@DCDADA@@FAJ@DBD@B@HADBDBN@bAB@Z@\\BF@FDFHHHJBBBFBD@F@DADAJAL@F@D@B@FAB@DBJ@DA@@@@DA@C@A@CB@HAB@@BJOjGhBLLIJGFBB@F@@H@BBBBD@@@@BB@BBDBD@JH@B@BC@@@@B@B@@@BBF@B@@BB@@@BA@BBBBBB@BB@B@BADDH@B@@BB@ADB@D@@@BABBBAB@DEFC@AFGBCBCDENSBAZYLEPIdUJE^QLE\\MLCbQLEhMFAD@BAF@DAB@B@PABAH@H@B@B@BB`B@BBL@L@HAB@FABADAF@DAB@DBBABBB@FAB@@AD@DA@@BABC@ADA@@DAB@@AD@D@B@@@@AD@AMRIVGCoAOE[@@@C@AOKAAGCYUc_gaKGyqECCC@AAGKGAC@@IGUKHMFI@@EEA@EBIAAA@@CCA@@AA@CC@AAAAAAA@A@CA@ACA@@A@@@A@@@C@A@AA@@A@@AA@CA@@AACAA@@AA@@@AA@AACCA@@A@@AAAA@AAA@@AAAAA@AAA@AAAACAACCA@@EAA@AA@@AA@ACA@@@AA@@AA@AAA@AA@AAACCA@@A@@BA@ABA@AB@@ABA@A@EBABCBA@@BABA@@BC@@B@@C@@BG@A@A@AAA@@AAAAEGAA@@A@AA@@@C@AAA@AAA@C@@@@C@A@@@@A@AA@AA@A@A@A@AACCCAA@A@ABC@C@C@A@C@ABC@A@A@C@A@A@@@AAA@A@ABCBC@C@@@A@CAC@A@E@A@A@ABCBA@A@@AA@C@C@ABA@A@AAABC@@@E@A@A@@@A@A@A@C@@CC@AA@@AB@BA@@@C@@@ABA@AAE@C@A@A@ACC@AAAACAAAAACAAAC@@ACAAA@@AAA@AA@CA@@BA@A@@@A@@@@CAAAA@@@@A@@@@@ABA@@@AAC@A@@AAB@BCA@A@A@AACAA@AAA@CCAC@@CACE@ACC@@@AAAAC@@ABA@C@A@B@BA@@@AA@@@AA@@BA@A@@A@@CA@AA@@CBABC@AAA@CAAA@@A@@B@@A@@AB@@AACCCC@A@AA@@CCAA@AGCCACAC@A@A@A@CBAB@BA@CB@BCACAKEG@@@A@@A@CBI@ABC@ACAA@CAA@@A@ABC@ABA@AA@@ABE@A@@E@C@A@@@CBA@C@A@ABC@CDA@A@AACACAA@C@A@@@@@@@B@BABCBABCDC@@AA@C@ABC@C@A@A@ADC@@@C@@@AB@BABC@ABAB@BAECCCAAA@CAEAEACAA@@AAA@@AA@AAA@A@@ACCGAK@@AA@@CAAA@@AC@@@@BA@AB@@@@AAAA@AK@C@C@C@@@A@@A@ACA@AAE@CAAACAAAAA@A@@@A@@BAB@@@BC@@@A@A@A@C@EA@@@AACCA@CCC@EAA@CAAA@AAAAAA@CAA@A@ICA@@@C@CB@@CDA@A@ABA@@@@@BH@BA@ABC@@@A@@B@B@B@@@@AB@DCDCDABAB@@A@@DA@CBABAB@@E@AAA@CBA@AA@@ACA@AA@AA@C@C@A@A@ABA@E@A@CA@@E@CAGAAAABABAB@@AD@@@B@@BB@BBBBBBBDDBDB@@@AB@B@B@BDBBBBBBBBBBB@@@B@B@B@@@BB@BB@@AB@B@D@B@BBB@DBDDD@B@@A@CAA@A@C@C@A@C@@@A@E@C@A@ODA@CBE@CBA@G@I@A@@BA@ABC@E@A@EBA@G@C@CACBC@@@AB@@ABA@@BC@C@A@@@ABA@A@C@A@"],encodeOffsets:[[84944,46986]]}},{type:"Feature",id:"654224",properties:{name:"托里县",cp:[83.60695,45.947638],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@ĤÑJBD@D@D@DAFAB@BAFCD@B@@@DAD@F@H@FAB@DBDBH@FABE@CACB@BABA@AACBCDCB@L@FAJCFAN@FCH@DBF@DCD@D@BB@@@B@@@@BBDDBBBBDBFD@BHDDBHDDDBBB@HFDBNFFDJBFBB@PBB@B@B@B@F@B@D@HBDBD@DBDBDBBADA@@B@FCB@D@F@D@D@FAD@F@@@D@B@B@B@D@D@@@DA@@B@D@@@@@B@B@F@H@B@BAFAB@B@B@B@B@F@B@B@F@@@B@D@BBBADBB@F@B@BAD@B@D@BAJ@B@BBH@DB@@^BJBBBLHF@RFJ@JADBD@@@H@H@@@N@NBB@B@LDF@F@BAD@@@TCTBF@HBJB@@FBJ@BD@@RDDBH@PBDADAB@DAD@DAFED@D@DADAH@L@@@F@H@BAHAJCB@H@@@FBHAL@BBF@DAN@BBHAL@F@X@D@D@L@BAH@JDF@DABAJEFAJ@D@B@H@FBB@DAFCH@H@NAL@FANBH@NMDEB@BABADCDCBCB@DGBAD@D@JAD@B@FAB@FBD@F@FAFAF@J@F@@APCD@BBD@F@H@D@F@D@D@F@FA@@D@FADALCBAF@FAP@DA@@L@FAHBVBJ@NB@@F@DBD@L@J@FDD@pElEhAhAjAnBDF@HAB@DAD@L@B@F@HBD@B@D@BBP@BBB@D@B@D@DBF@H@FBD@B@HAF@R@b@T@F@J@F@JAJ@H@F@P@F@D@H@LBHBF@HBF@BBDAXFHFFFHHHBHBDBHFBBFFFFDF@F@FJ@L@@@JAD@LAF@JAJCJA@@JCFAJCJABAJAHAFADDF@NCDAD@F@H@F@HCB@F@BBB@F@FCD@BAJ@B@@CBAB@FAHBDA@@@E@@F@D@B@@CFCBCA@@CD@J@B@FABAACBAB@DBB@BABAF@D@B@DBDABAHAD@BADA@CDAFAJBJ@^G^GF@CAAAAC@C@@AAGAEAA@CAIIC@AAC@C@A@GAC@E@AAA@AAG@A@A@ACCACAECA@A@@@A@@@@A@ACI@A@AACCIAA@AEACAA@EACAE@G@EAC@KEEAA@AAA@AAA@@E@E@@CA@@@ABA@A@A@@@AC@@@BABA@@@@@@@@ID@@@@@AB@BABABC@@A@CBA@A@@A@@B@@C@@@@AA@@@@@AB@A@@AAA@@A@A@A@@@@C@C@@A@A@A@@@B@@A@@@@@AAA@@A@A@@@@AB@@@@C@A@@A@AA@@@@BAB@BA@@@@@A@@A@@@E@@@A@AA@@@@B@BA@@BA@A@@@A@@AAA@@@BAB@@@BABAB@D@B@@@AAAC@@@@AAA@@@@AB@@@@A@@@@@A@@B@@A@E@@BA@@@A@@@A@@B@H@B@BABA@@@@@AAAAC@@@AB@D@@@@@@AC@CA@@@@B@B@JBB@B@AA@@AAC@@@AC@@B@F@@@B@@@@@@AA@AA@@@@@@@AB@B@B@@@@A@@A@@ACAA@E@@@@AB@J@@@@A@@CAAAA@A@@@A@@AC@AA@AA@@@@A@@B@HDBBD@@@B@@@@@@@@A@@B@@@@BBBBBBBB@@@@@@A@A@A@@@@FB@@D@@@@@@AACAA@@@ACA@@C@A@@@@@@AB@BA@ABAAA@A@@@@B@B@BB@@BA@@AA@A@@@@BBB@@@@@@@@A@C@A@@CAA@A@GBCBAB@A@@DA@@A@@@@AA@@@B@DA@@@AAAAAA@CB@@@@AA@@@@@@I@G@C@@B@A@@AAA@@BA@A@@@@A@A@@A@@@A@@@A@A@@A@@@@A@@ABAB@@@BB@@D@@@D@@A@A@@AA@@A@ABA@@@@A@A@@CCA@AA@@@@BA@A@@@@C@@@@@BAB@B@@@BB@@B@B@DAF@B@@A@@@@AAA@@@BAFC@@@@@B@@@@@B@@DBB@FAB@B@D@B@@AD@@@FA@ABA@A@@A@@@AA@@@AA@ABA@@@@AB@B@@ACAACEAA@CAA@@@@@ADA@A@@B@BADAB@BA@A@A@@@A@@ABAA@@A@@@AB@@@B@@@B@BA@@BADABA@@BA@AA@A@A@A@AB@B@@A@@@AB@@@@A@@@@A@@CB@@@@@ABA@@B@BABA@@@@CA@@A@AA@@@@B@DBB@B@@@@AA@@AA@A@@@A@A@C@C@AA@@@@@AB@BBJ@BAB@@@@@BB@@BAB@@@BDB@BBB@B@@@@A@@AA@@B@BA@@@@@A@A@@B@D@B@@@@@@AC@AA@AABA@A@A@@@CAAAA@A@@B@BAB@@@B@@AA@@@AC@@@A@@@@@@AB@BAB@B@B@@A@@@@CAC@A@@@@AE@A@@@@@@@D@BA@@DBBAB@B@B@@@@AB@BB@@DA@ACA@@@A@@D@BA@@@@@@AA@@@AA@A@@@A@A@@@A@@@@AB@@@@A@@@@A@@@A@@@@@A@@AB@@@@A@@AAA@@@ABA@AAA@@@@@@A@@@A@@AAA@AAA@AA@@@@AB@@@B@@@AA@@A@@@@CBA@CB@@@@AAC@@AA@@AB@@@BBB@@@B@B@DEB@DC@ABA@@@A@@@@@AA@BA@@@A@@A@A@@@B@BAA@@@A@CBABABA@@@BCDAB@BAB@@@@A@@AAA@A@A@@@B@@AB@@A@AA@@@C@@@AB@@@@AAB@@AB@@A@@A@CBAB@@@@@ABAB@@@@AA@@@C@@AAA@@@A@@@@A@@@A@@B@A@@@C@A@@@@B@@A@@@@@@@AABA@@@@@@AB@@@@@AA@@@BC@A@@@E@A@@A@@@@F@B@DAB@DABA@@@A@@@@@@@@A@A@@@@@@@@@BA@A@@A@A@@@C@@@@@@@@@AA@BA@AA@@@@@@AA@@AA@@@AB@BBB@@@@ABAAA@AB@@A@@@A@BA@@@@@@A@@@@@@CBA@A@CBA@@AB@AA@@B@B@@AB@ACB@@AB@@A@@@A@@A@@BA@@@@A@@@@@@A@AA@@B@B@@@@A@@AAA@@@@@@@B@@@BA@@CAA@@@AB@@@@@@@@@A@@@@CA@@@@@@B@B@B@D@D@@@@A@@@@@@C@AAA@@@@@B@@@@A@@A@AC@@AA@A@@@@@@@A@@A@@@@@@ABBBA@@A@@A@@@@@A@@@A@@@@@@AB@@A@@@BA@@@A@@ABA@@@AAC@@@@A@@DB@A@@@@@@@@CAA@A@@@@@@@@@D@@A@@A@@@B@D@B@@@B@@@@@@A@@@@ACA@C@A@AB@@AAC@@@@@B@D@BAB@@@B@B@B@B@@@B@@AA@@@A@AAAA@AA@@@A@@BA@A@@A@@B@B@@@@@@A@@A@C@CB@@A@@AB@H@@A@@@@@@DA@@@@AAA@@@@@@@B@@@@A@@@AA@@@AB@@@AA@@@@B@@C@@@EB@@A@B@DABAB@@A@@AAC@@@@@@@B@@@@@@A@@A@@A@@B@@@B@@@@AB@@@@AA@EB@@@@@@@AD@B@@@@AAA@@A@@@@AA@@@CAA@AA@AB@@@@A@@@@A@AA@@@@@@@A@@AA@@D@@AAA@@@AA@@@@@B@@A@@AA@@@A@@AAA@AAAAA@@A@@C@@@AAC@@@@A@@B@@A@@A@C@@BA@@@@@@A@@B@B@BA@A@@@@@@B@@A@@@@AA@@BAB@@A@@@@C@A@@@DA@A@@A@A@A@@@DA@@@A@@A@AB@@AAA@@@A@@@@B@B@B@@A@AAAA@@@@@@D@@@@A@@AAA@@@AAC@A@B@BA@@@@AA@@A@@@@@@@@AC@AAACAAA@AA@@AAA@A@A@@A@@@@F@D@B@@@@@@AA@A@@@A@@AA@A@AAEBC@@BC@A@@@BAD@@@@A@@C@@AA@@@A@@@@@BA@@A@A@@@C@A@AA@@C@@A@@DA@A@@@@C@@@@A@AA@A@@@@AA@@AA@AAC@@A@@DAFA@AB@A@AA@@@AA@A@A@C@A@@@B@DA@@B@A@@A@@B@@@@AA@C@A@@@@A@@@A@@A@@A@AC@A@@@@@B@@A@@A@A@@@BAB@@A@@G@@@@@@C@@@@@AA@A@@@CDA@@@@AB@@A@@@@A@A@A@AB@@@@@@BCB@B@BABA@A@@A@A@@@ABA@@@@BA@@@AA@@@@DA@@@@@AA@@@A@A@@@AA@AA@A@@A@@A@@@AA@@B@@AA@A@@@C@@@@@@@BAB@@A@@@AA@@@A@A@A@A@@@@@@@@A@@@@@@A@A@AAA@@@@@B@B@@A@@CAAB@@@@@ABA@@@@A@A@@@A@B@@@AAA@@@@@@@AAA@A@CAAA@BAB@@AA@@BA@AA@A@@@CB@@@@@A@AA@A@C@@@@A@@A@A@A@A@@CA@E@A@@BA@@@@A@@BAA@@A@@@@CB@@AACCAAA@A@ACA@@A@@A@@@AAAA@@G@@A@@@@@A@@CAC@C@AAAAAAA@CAA@@@@A@AA@@BA@CAA@@@@@@BA@@@A@@@C@@@@A@@@@A@@@@BA@AA@@A@@B@@@B@@EBA@E@A@CB@@C@A@A@ABA@@@A@A@C@A@@BA@GBA@AB@@A@A@BCA@@@@BAA@@@@A@@AC@@@A@@@@A@@A@BAAA@@@@AAC@ABA@@@AB@@AA@@AB@B@@@@B@@B@@@BC@@@@BA@@@@AA@C@A@@@C@A@A@CA@@C@@AA@C@AA@@A@C@@@AAA@A@@@A@@AE@@@A@CAA@CACAA@@@@A@@BAB@BA@@@@A@@@A@A@@@@A@@AAA@@@A@@BCBEBABCA@@A@CA@@@@@ABABA@AAC@@A@@B@@AD@DA@@@@@A@@@BA@A@@@@A@AACAAACA@AA@CC@CA@@@AA@@AAA@@AA@@A@A@A@AAA@ACAAA@A@@@@A@A@@@@AAA@@@@A@@BA@CAEC@@A@A@@A@AAA@@@A@ACCA@@C@AAACA@A@@@ABA@@CA@@@AAAB@CC@@A@@A@E@AAC@ACA@AA@@@@AC@@@A@@A@@B@@@@AB@@@CA@AA@@@A@@@A@@@@@A@@@@@CAA@A@@@AAA@@BA@A@@AA@EAA@EAAACA@@@@A@@@@@@@@A@@@@A@CCCBAA@@CAA@A@CBC@@@A@@@@AA@@BC@@AA@A@A@@@A@@@AA@@@@A@A@A@A@@A@A@AC@A@AAAAE@E@C@ABCBA@@@CB@@A@A@@A@@@AAAA@ACAAA@EDGFBH@D@J@B@@DD@@EDCBCBA@@@C@C@AB@AE@A@@@C@GBED@@AF@BCDGBABCBABA@@@G@CAAC@@@ABAAAACAAEACAECC@AAA@@ABEBE@A@A@AA@@@@AB@D@DB@@BATGDADABCNOD@BCBA@@AA@@@@BABC@A@A@A@@BA@A@@@A@@@@A@BA@A@@@@@A@@A@ABA@@@@@AA@@@CA@AAAAAAAACAA@@AA@A@@@@@@A@@A@@AABA@CC@@E@A@@@@@AACC@@@A@@A@@A@@BA@@AA@@A@A@A@A@@A@@B@@@AA@@@@ABA@@@@AB@@@@@@A@@B@@@B@@A@@@@@A@@CA@@@AA@@@C@@@@@@A@@@@@@AAA@AA@@@@B@@A@AD@@A@@@A@@ABA@A@@@@@BA@@@@@@@A@@BA@@@A@@@@@@A@@@A@AC@ACCCCECAA@@@@@@B@@A@@@@@@A@A@ABAB@BA@A@@A@@@ABA@@@@A@@@@AB@@@@AC@@@@@@AB@A@@AA@A@@@@@@@DCDABA@@@A@@@@A@AB@A@@B@@A@@AA@@A@@@A@@@@@@AAA@AAAA@@@A@AAA@@@@A@@@AAAA@@@AA@@@AA@A@@BA@EAA@@AA@A@AA@A@A@AAABABA@@BA@@@@@A@@AAA@@A@@@A@@@AD@@AB@@@@AA@C@@@@@BAA@@A@AA@@@@@BAD@B@@ABA@@B@@A@@AA@@@@@@BA@@@A@@A@C@@@@A@@@AB@@AA@@@@@BA@A@@C@@@@@BAB@@@A@@@A@A@@@@@@A@A@ADC@@@@@@A@C@C@@@AA@@A@A@@A@@@@BA@@BA@@@AA@@@@@@@@A@@A@@@@@BABA@@BA@@@@@A@@A@AB@@AA@@BA@@AC@A@A@C@@@@A@@@AA@@@ABAAA@A@A@@A@@A@@@ABAB@AAA@@ACB@@A@BA@@@@@A@A@@@A@@@@A@@@@@A@@@@@A@A@C@AAA@@@@A@@BBBA@@B@@@B@@@DAB@@ACAAA@@A@A@AB@@@@A@@A@A@A@A@CA@@@A@@@@A@@AAA@AA@@@C@@@A@AAA@A@@DCA@@A@AAAAA@@A@@ACB@A@@@@AA@@B@@A@@@A@AA@@@A@AB@@AB@@A@@@BA@A@@A@@@@A@@BA@A@@@@E@A@A@@@A@@@@BA@@@C@AA@@@A@A@@@AAAA@A@@@C@@@A@@@@CAAA@@@A@@@@@@ABA@A@EBAAA@@CC@A@@@A@@@@BAB@@@A@CA@@AAA@AA@@@A@@@@A@AB@@C@@@@@B@@A@AB@B@@@@AC@A@A@C@C@@@A@@@@@DA@A@@@@A@A@@@ACA@AA@@AA@@@@B@BAB@@A@@@@AABABAA@@@A@A@A@A@@A@@@AA@@@@ABA@@@A@@AAEA@@@@A@@B@BA@@@A@@@AAA@@@AA@@A@@A@AAA@A@@ABA@@AB@BCBCBABABABA@@@@A@CB@@ABA@A@@AC@A@AACAAA@@@A@AB@@@@AA@@A@@B@BA@@@@BAAA@@A@@@@@@AB@@A@@@AA@C@@@@@BA@A@@A@A@EB@@A@@AB@@@BA@A@@@C@C@@@CAAAC@@C@@@@A@@@CA@AC@@C@AA@@B@BA@@@@@ACB@@@@@@AAAC@ACA@AA@@CA@@@ACA@@A@@@A@@AA@@AA@@A@AAA@ACAAA@AAA@AA@@@AA@A@@A@@A@AA@@AAA@EEMGA@AAA@@A@AA@@@@A@@AAA@@AA@A@@@BA@@A@@AC@A@@A@@@@A@@A@A@@@A@@CBA@@AB@B@@@@@@ACAC@@AAAA@BYBI@E@C@@{aSKQI£ÇsCACAW_KC@AAI@eIqI]EC@[CMAC@CAOAQAeCUEmG]EOE@QIkEkQEAIC_CA@A@@@CBABABAB@@@CAkSMEAAiGqIUCOCIA]EOIA@qB¥HGCECGAEAEAA@ACA@A@EACAA@GAAAC@C@@@GASA[EOCIAwMKAGAE@KCK@QCSASCUE[AUAQAQASCUASCOAMAOAK@K@MAM@CAGDKDIBC@IBC@I@K@GAC@QAY@YBI@G@@@GBEBIBQDUFGBE@ABC@@B@@@@@@@@@@AB@@A@@@A@@@A@@@A@AA@@A@@@A@C@C@ABCA@@A@AAA@@@A@A@CBABI@SBI@M@EBE@IBI@C@EBOBOBC@UDIBUDWDYBMDOBGBI@[FqHOBKD_FKBgJC@cJQDoJ]HGBoJSHYHC@EBCBEBABC@CBABEBCBGDIBEBGDC@CBC@IDKDQDABC@CBMDgNQHEBSFGDEBEBYNOJCBEFEBQFCBGDC@EDGBGFEBIFGDGDGDABEDKFEBSLKBSFSBWFCBCAIAG@sIA@MFABGDEDCBGDABCBEDCBIFCBMJCBKJMHEFIJKH@BAHA@SLABKHEDODJJ\\ĺ®DDBDBDDD@BBBLJLLJNLL@BBF@FBBDFFDPHFBHFB@HBHB@@BBBBFBBBB@BBB@BB@@FBBBB@BBBBB@B@LBF@@@D@B@B@B@@@D@@@D@B@B@B@D@BBHAD@DBB@BBBBB@BBB@DBD@F@B@F@DBDAH@FAB@BA@@BBB@@@DAD@D@F@B@D@B@BBB@@@B@@@@@@B@@DAHB@@D@D@BAD@F@B@BDB@B@B@@@FBD@@@DBD@BBB@B@@@FB@B@@B@@@B@B@BB@@B@BBD@DB@@BB@@B@@AB@@@B@B@BBDBD@BBB@@B@@@@B@BADBB@B@B@@@@B@BA@@@A@@@@BB@B@@@B@B@@@@@B@BD@BA@BB@@B@BA@@BB@@B@@@@@B@B@B@@@BBB@@@@@@@B@B@@BB@BB@@@B@@B@@B@B@@@BB@B@@@BAB@B@B@B@@BB@FAF@B@@@B@D@F@@@B@B@DAD@DADA@@BAB@D@B@D@@BBB@@B@@@BB@@B@B@B@B@@@B@BBD@@BB@BDB@BBBB@BB@BBD@BB@B@B@@BBB@B@B@B@B@BBD@@B@@@@AD@B@@B@@B@@BD@BAB@@B@BB@@@D@BB@@BDBDBBBBBJFDBDDDDDDB@@BBBB@BBB@@B@B@BABA@ABCB@BCBABA@@D@@ABABABA@ABA@AB@@@@BBD@B@LD@BBB@@DBB@@BBBBDDBDDFBDBHD@@BB@@@L@@@@BBABCBEFCBADCDA@ABEDA@AB@@@B@BBB@@DD@@@F@DBD@B@BDDBBDDFHBBFDBBDD@DBBBDBBBBBF@@DB@@HFBBFD@BBDBBADBBB@F@B@@@D@DBB@BBDBDBH@DBDDBBB@BBDDDBBBBB@D@B@BBBBD@B@@@BC@AB@@@B@@EFIJEFAB@BADADAB@BCBAB@@ABAB@@ABA@@B@BABAB@@@BDDBB@BDDBB@@@DCN@@@B@FAB@BA@@@ABA@@@BB@D@@ABABCDIBA@KBA@CBC@C@A@A@BBBB@B@H@FAB@BABEB@@ABGBGDABAB@@@@IBA@CBCB@@C@CBCBGBABC@MF@@CA@AA@A@@@GF@B@@@BAD@@@B@BFD@@JDB@@BBFA@ABAB@BAB@B@B@BB@BBDB@BBD@@BBD@BBF@B@B@B@D@B@@@@@BBBDB@BB@@B@B@@BB@BBBB@@@@BB@@B@DBD@H@D@@BBBD@@BJ@B@DB@@@BBB@@DBB@BBH@B@DD@@DAD@@@BBF@B@BB@B@D@@D@BBBB@@@@@@CB@@A@AB@F@BDDBB@BBBAB@@CBAB@@@BBD@@@@@BA@ABAB@B@@BBBBB@DBCDCDEF@@@BBD@DADB@@@BD@B@HABADAB@DABCH@B@BAB@F@@FBDBBB@@BB@BBBDBB@NBBB@@BB@@@@F@DB@@@BDBB@D@B@B@BA@@B@D@D@@AB@BA@@BA@@D@DADBD@H@B@FAB@F@D@BAB@@AB@J@H@B@DAF@DAB@PCB@D@F@B@@@D@B@D@D@B@B@DBB@@@@ACCAC@CAA@A@A@C@ABA@@AAA@@A@@@A@A@A@@AAAAAAAAAACA@A@A@ABA@@A@ACCCAAAAAA@AAA@@@A@@BC@@BABABABBHBDBF@@@DBB@F@B@BAB@B@D@D@B@@BBBB@BD@@BBB@DAB@BBF@@@BABADAB@@CB@@@BABADCDC@CBA@@@@@A@A@AB@@@D@BAB@@AAG@@@@B@BAB@B@DC@@DAD@@@B@JDB@B@DBB@BBBB@BBBDBB@FBD@DDB@DDBB@@B@@F@D@B@B@B@@AD@@A@AB@@@B@@@BBBBBDBBBDBF@BBB@BDB@@@@B@@@D@D@DBLB@BB@B@@A@@BAB@@@@BD@@BBDB@@BB@@BLDHBD@@@BBB@BBB@@BB@BB@DBFBFBDBB@BBDDFDABA@AB@BADABA@@B@@@D@@CD@B@B@B@DAD@B@DBB@@CDADABADABA@@@@@@@B@D@B@DBDBBBB@B@DCD@BAB@D@B@DA@@B@D@F@@@@BAF@BB@@BAB@BAD@B@BB@DBB@DB@BAD@BAJ@D@BB@@@H@LFDBDB@ADAB@@ABADAB@B@B@D@DBDBHD@BBBDD@@BBB@D@DDBD@BA@@BB@@@@AB@@@BBDBB@BBD@BADA@@BBB@@DB@@@@BAB@@BB@@B@@B@@ABA@B@D@B@BA@@BDBB@B@@DD@BDFDB@@BDDDB@BBB@DBBBB@B@B@ADA@BB@@@BBD@B@@AB@B@@@@@B@@B@BBDB@@@@@B@@@BAB@@DBB@@BBB@BB@BBBD@@BDBBBDBBBBBDBB@BDD@B@B@B@DBF@BAB@B@@@D@@ABA@@BB@@BDD@@@D@B@B@B@@@B@B@F@@ADBB@B@BAB@B@D@DBB@@@BABAD@B@B@B@F@BBD@D@B@@@DADAD@B@BBB@B@@@B@B@D@B@BAD@B@D@B@D@DAD@B@BBBDDBD@B@B@B@BBBB@@B@B@@B@D@@@@@@DBB@BBB@B@D@@BBB@@@BBFHBBBB@@BB@B@B@BAH@@@DA@@@AD@@ABAB@@ABADAB@F@BAB@BA@@BAB@BAB@@@BB@DDBB@BBBB@BBB@@BB@@B@@DB@BBB@@BBB@FB@@DBBDDBBBBBB@BBB@BBBB@@BB@BBBBB@@@BB@DDBBB@@B@@BB@@BBBD@BB@@DBB@@@BB@@B@B@D@@@B@@@BB@BDB@@D@BBBBBBB@BDDB@@BB@DD@@BBJBFAB@FF@@EJGNVLJH@@BDLHBH@BDDFDzrLHhbd`ZVHDBBPL@B@D@@F\\BPDpZIPEDAFAfIH@ZEF@zAZABADEDCBAT[HI@ABAB@DC@CDCB@@@BA@@@AFA@@@A@GJIDCB@@A@@DCDBDBBABCFENDlAJ@B@DCDCBABC@@BKD@B@D@BBJ@TFB@@@BAFDB@THBBDBDGBABABCHCDDFDBDD@DFDDD@DDFDFDJFFDŨ±"],encodeOffsets:[[85889,47380]]}},{type:"Feature",id:"654226",properties:{name:"和布克赛尔蒙古自治县",cp:[85.728328,46.793235],childNum:1},geometry:{type:"Polygon",coordinates:["@@AH@FAB@D@BA@BDADBBJDF@D@HBJDRDFBNBD@DB@BJHJFNBBBBDCD@BBHBDAB@@@@BLN@D@DBD@BBBB@B@B@@@BBB@B@DB@BBDDDBB@B@F@H@B@F@D@DBD@BBJDHDD@FBDDBBD@F@D@BAHAD@DAB@DBF@F@DAB@D@D@FADAB@B@B@FBDBB@B@HAB@HB@@DBDF@@BD@@BBB@FDXAHFFNDHHDPFHH@DAH@B@H@F@HBD@HDFDFBDDHFDHFDBHDFBJBLBJ@J@J@J@LAJ@H@L@BBJBJDJBFBJDDBFFBBDF@@BHDFBFDHBBFFDFFDHFHDJDFDJDB@HFJDHDB@JDHDJDFDJBJD@@JBJBJDBFFHPDJLFFBFFJLLJLJLLHXNNH^ANARDHLFJBDJ@H@LCXKHEVODALILBHDNFRFJBD@LBJ@F@RCL@VBVDVHPB^D@@L@J@PAN@J@FAJALCPAL@F@TBJ@JAJ@B@N@N@@@AC@AAC@ACA@@A@D@DABBB@DDB@DBBBD@@@D@@@DAB@@ABA@@B@B@B@B@B@B@D@B@D@FAB@@@DD@BBDBBBBD@@BDB@@BBB@B@D@B@B@B@B@B@BAF@J@F@BAD@D@DAB@B@DAB@@@@ABAB@B@BBBBBBB@@@D@BAB@B@@A@@@@BAB@BAB@DABAB@@@B@BDD@DB@@BBBBB@@@BBBAD@BA@@B@B@B@B@D@B@B@@@D@D@BA@@@@@AB@@AB@DAD@J@B@B@@@BBB@@@HAB@B@D@FBDBF@LDDBB@F@FAD@@@BB@@DBDBB@DBB@@AB@BAD@HAFABABAB@B@D@FD@@B@DAD@B@BABCB@@@B@B@B@FD@@DBB@BABAB@F@B@D@DB@@BAB@FBD@JD@@BDB@@@B@B@FCFAB@D@@@LAF@D@BBD@@BB@DHB@B@DDB@BB@@D@DAH@FCD@B@@@B@B@@B@B@@B@D@TDB@B@JAB@F@H@B@BBBB@@BBB@D@FABAD@B@BAH@H@H@@@FDBB@@B@FBDBB@H@B@@@DBB@DFHFB@@@FCBAB@D@D@JAB@B@@BB@BBB@@D@@B@HBB@D@HAF@B@B@B@DA@@B@DB@@F@@@BBBBDDB@B@B@B@D@D@DAFCBAD@J@D@D@F@DAB@HAFAB@B@DADAHAHAB@F@@AB@BC@ABABABAHEBAFAH@D@DAFCHC@@@AD@BAB@@A@@@A@@BAHEBABAHCFADAB@@A@@AACGAAAA@CBCF@VBD@BBB@H@B@B@BAB@H@D@D@B@D@FB@@B@HB@BT@F@J@DBFBB@BBD@F@F@B@D@hF~JBBBAB@D@D@DBDBF@L@F@@BB@JB@@B@B@FBDBJ@HDF@RFJBLDNABBB@FDDBFBNDHBDBB@FBD@PDJDDBJDD@LDPLFB@@B@B@@@@A@@ACAC@@@A@@JDHBFBF@PFDBRDDBD@NBJBND@@@@B@@@FBF@R@P@B@@@TBF@HAJ@B@FAD@HCF@DAB@@@FBB@F@FBB@BBD@F@F@B@FBH@F@H@@@DB@@B@HBB@B@@@D@D@@@DB@@BBB@D@DAD@B@@A@A@@AAAAAA@@@@@CA@AA@A@@BC@ABA@AB@@A@ABCBGB@B@BABA@A@@AACAFAD@B@FADAB@J@P@LAD@FAB@DAFAD@FAFAHEDAD@B@F@F@JBHDDALAH@H@L@dFRBNBVDÊVFAD@B@B@FBHBFBFBN@R@HBH@HBF@DBJBF@DBdBbBH@DBF@B@@B@@FH®PLLL´PD@F@@CCeCiECgAUA_A[BAHGDCLGHGJKDCDAFCBAJCBADIBEBCBARITKRMt]¾jY@CAA@@WKM[a©»·ÛAGFGË¢áÕz·xÅ¯@A@ABGBE@CBG@GB@@GBC@G@G@@@G@G@@AEAG@A@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@G@G@EAG@G@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@EAG@G@G@E@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@G@E@G@G@E@G@G@E@G@G@GAE@G@C˧Bo@o@_@y@ō@@@ÛB[@}@s@Ñ@y@u@@q@µ@ճAC@IB¡@K@I@I@IAE@I@I@K@IAIBI@I@I@K@I@C@I@I@K@I@I@I@K@@@I@I@KBI@I@I@I@G@I@IBK@ĥ@IAK@G@K@I@I@I@K@G@IAK@I@I@IAA@I@KAIAAFAHAFAFAF@DAHAH@BAHBDDFDHDD@@IL@@DFFJBFDDDFDFBDn^DBBBDJB@@BB@@@B@@B@BBBD@DBB@B@FADAF@B@@AB@F@DAB@@@B@BBB@HDBBB@B@D@DBD@B@B@zJFBTDfHLBBBFBDDHBZPDDNFDBDBFBFBDBDBB@@@JJJJFFJHTVFDRPFDFFHHRNBB@BBFJRHP@@NVBDBDB@DDDBNHLHFBBFBBBBDFDFDHB@@BDV@B@D@@B@B@@@@BBB@@BB@B@@B@B@B@F@@B@@@BB@DD@@@B@@@@B@B@@@HDD@B@BB@BBB@B@BA@@B@@ABA@@BB@B@D@@BABA@@D@BB@@BB@AB@@AB@@@@BB@B@@B@DAB@BBB@BB@BB@@@@BB@@BBDBBDFPLHHBDDBD@BB@@@BCB@B@F@B@BAB@@@BCB@@@@@@@BA@A@@@@@@B@@B@@@@BNhJ\\DLDJHXDH@JBFF`@JBHBLBBBJBJDBFDFDNHB@HDBBBB@BA@@B@@BBB@@BD@BBBB@@@B@@A@CBABA@@B@BAB@@@B@BNJBBDBBBB@DDB@BBDD@@D@BB@@BB@@D@@@BB@@@@@BD@B@BB@@AB@@B@B@B@B@@@@@B@@@B@B@@@@@@B@BB@D@BBB@@@BB@@@@D@BB@@@@AB@@BBB@D@@@@B@@@@B@B@B@@B@@@B@@B@@@BABB@@@@AB@@@@B@@BB@B@@@@@AB@BB@@@@@@B@@@@B@D@B@@@@B@@@@@BB@@@BBD@B@@@AB@@@BB@B@@@B@@@B@@@@@@BA@B@@BB@@@@@@B@@BA@@B@B@@B@@A@@B@@B@BBB@@@@B@@B@@@D@@@@@AB@@@@@@@BF@B@@@@BB@B@B@D@@@CB@B@@@BB@B@D@BA@B@@@@CD@@B@B@@@B@@@B@B@@@@@@BA@@@@BB@@AB@B@@@@B@B@@D@@@@@@@@BAB@@B@B@@B@@@@AB@@@@B@@B@@B@@@@A@@B@@@B@B@DBBBB@@@AB@@@B@@@@B@B@@@B@@AB@B@@B@@A@@BB@@@B@@@@B@@B@BB@@@B@@B@B@@@@@@B@@@@B@B@BAB@@@@B@@AB@@@@B@BBABBB@@BAB@BB@B@@CB@B@@B@@@DA@@DB@@B@BB@@@B@@@B@@@@B@B@@@ABBBB@@@@BDA@@@@@B@@@B@@B@F@@@B@@B@@B@@@@B@@A@BB@@B@BAB@@@@BCB@@B@BB@@@BDAB@@@@@@@AB@@@@B@@@D@@@@D@@BB@@D@@B@@@@DB@AH@@@@@@@AB@B@@@BB@BAB@BBBBB@B@@@B@@B@B@@BB@BB@@@B@B@@@AB@@BB@@B@B@@@@@BB@@B@@B@@@BB@BBB@BB@@F@B@@@@@@@AB@BDBB@@@@@@BAB@@B@B@B@HD@B@@A@A@@BD@B@B@@B@@AB@B@@B@@@B@B@B@B@@@@BBBB@B@B@BB@@BB@@B@@@@B@B@BB@BB@@BB@@@BADB@DB@@@@@@AB@B@@B@@@LAFB@BDB@BB@@@B@@@@@@B@B@@B@BAB@BAB@D@D@D@D@BBB@B@@D@@B@BB@@@BAB@@@B@@D@B@@@AB@B@@@@F@D@@B@@BBB@D@DB@@AB@@@@B@@BA@@@@BA@@BD@D@BAB@B@B@@@@BBB@B@@@BCB@@CAA@C@CBABABC@@@@@@@BD@@@B@@AB@@@B@@B@D@B@@B@@@BB@BBB@@@D@@AD@@ABB@@AB@BB@B@B@B@B@B@BB@@B@B@@BB@@BABB@@@B@HAD@B@@B@B@BC@EBABAB@B@@B@B@HAJAB@D@@@B@@BA@AB@B@BB@BBD@B@D@B@B@@@BB@B@@B@@@LCD@BABA@@BAB@D@DAHAB@@@DBBB@@@DABABA@CB@@A@@B@@A@ADCBCB@B@BBDFDHD@B@@CH@B@@A@@@AD@D@@B@B@DBB@B@BBFDHDDDFD@@AFADAB@DBJAHBB@BFFJLBB@B@D@BBJ@LDBDBNFZJBBD@\\HD@B@JFHBDBPHBBABAFEBGFCD@DABAJAB@BADADEDEF@BAB@BDB@@BB@BABABABCBABAB@BAFABAFEN@BEDSNq`o^IHIF[RED@@EBI@K@G@E@KBK@IBKB@@@@ECAAA@ACC@@@A@A@C@A@EBCBA@A@A@@@A@@AAAA@@@A@@@A@A@EBE@C@A@AAA@ABA@AAAAG@A@CAA@@@IJCFA@CBKFIDABA@O@e@Y@U@q@G@A@KAC@@@EBG@C@CAA@G@KAE@@@EBKFQHCBA@@@@BB@@@ABA@CBA@CDAB@BADA@@B@B@@EDA@AA@@CAE@A@EAI@A@A@@@ABC@EBG@A@@BA@G@@@AAI@AA@@ACA@AAAAA@A@@@A@A@ABC@E@AA@@CAA@C@@@A@A@C@A@@AA@@C@@@@AAA@EAAAC@A@A@AAA@A@@AC@A@A@C@ABCAE@A@GCC@CAA@A@AAGCECAAC@C@C@AAAACCCAA@CACAC@AAA@GAGAEAAAAAAA@A@@C@A@@AC@AAAA@@CA@@C@AACAA@AAA@G@A@AA@@@A@AA@@@@A@@B@B@@AAA@@A@A@@@ICC@EAEAKCC@CCC@C@CAA@ABA@AA@@AAA@A@E@A@AACAA@ACEA@A@A@ABA@ADC@A@@@@CCAA@A@AAAAA@A@@A@A@CBAAE@GEEACB@@A@A@A@AFCDCBA@@@GDE@A@ED@@@B@B@BA@EB@BA@@B@DA@A@EAA@CAC@E@]H]HI@IAEBCB@DCBABC@GBABCBCAA@C@E@ABABA@CAA@ABBDABEBA@I@C@@DB@ADED@DA@C@E@@@@F@@CBGAEBA@AB@DA@I@ABC@EDE@A@AAE@A@GDE@G@E@C@CBMDE@CCEBGBIBABIBIDEBID@@IBIDIBE@KBC@IB@@K@I@B@I@I@G@I@A@GDIDABIBIBA@I@IBE@IBEBIBG@IBIB@@IDIBABGDA@GFGDGDABIDEFGFABEFEFADGDCBGDEDGFGBGFC@GDID@@GFGDA@GFEDABGDGDA@EFGFADGFABEFCFEFADEFABGDEFEFABEFCBGFABGDCFCFADAFCH@DAFAFAFADEFCHEF@D@HAF@BBH@DBH@B@HBBBH@B@H@F@DAFAHAFCHCFADGDCFCFCFCFCH@F@H@D@H@B@H@FADEFBDBHDDDFBDFF@BFF@DBH@@BHBFBHCFCFEFCFCDGDEDIDA@IBEBGDA@CFEFEDGFGDIDGDIDGBEDIDAFAH@@IBK@@@IDIBIBIBIBEDGDABGDA@GDID@BGFGDABGFGFA@GFEFGDGDABGFCBEFCBGFCDCHEFEFEFCFEFADCHCF@BAFAHBFBH@HBBDFBDDF@@FHFFBBFFBBHFBBHFDBFF@BDFDFBFBFBHBD@FBHAFAF@FBH@@BHDF@@DHDFBBDFFF@BHDFFHFHDFFHDBBDHBF@D@F@B@H@H@D@H@@"],encodeOffsets:[[86799,47903]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
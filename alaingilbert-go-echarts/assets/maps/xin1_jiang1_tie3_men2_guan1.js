@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("铁门关",{type:"FeatureCollection",features:[{type:"Feature",id:"659006",properties:{name:"铁门关市",cp:[85.501217,41.82725],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@SFODJRFAHLNABAhUCGCAECA@A@AAA@AC@@KFA@EB"],["@@@CAK@K@MA@@@C@@DE@O@@AE@@AK@@@@AE@@DC@@BE@@DA@@BA@@BCBE@@BC@IABDG@@BD@@LC@EDE@IBA@E@A@A@E@@@CAEBECA@CACABA@A@AF@FADCB@D@FBDAB@BAD@D@@AD@@C@ACBA@@@AB@@@ACAE@@DC@@AO@A@G@@CO@@A@A@@C@C@@BEB@ABA@@@A@@ACFAHBNBBBB@F@@CA@@CA@@AC@@AC@@@A@@@A@@AKA@BA@@AA@A@@AAAA@@DIBBD@FBDCBBDBBLDBD@@B@BBFB@@ADMEKI@GI@ABA@@@A@AAA@@@A@@AA@C@AA@BIACAE@AAA@ABS@@BFBABB@@BDBAB@B@D@BAD@BA@AJA@[ABl\\@DNFdpAF@FAP@ZOJCTA^@\\E\\CRA`C@EJ@T@TF@@\\ATBB@XALCLC@@EQ@AAE@A@@AC@A@AAC@@ACACAGE@@Ba@A@A@C@[B@ZG@C@C@I@K@Q@@A"],["@@ZEHAZELEtSD@JARClK¼aLATAj]ACNEAAVC`KFCAEB@AMKAAO_B@MQ@EciiOCJG@CVEBCDCBAB@@A@@BA@@BAB@@A@AB@@A@ADA@ABCBAB@FA@A@@BABA@@BA@C@CAA@GBA@C@ABI@A@EBCBC@A@A@AA@@A@A@@@A@@@ABA@@BAB@CA@@BC@ABABAB@@AD@B@@A@AB@B@BABAB@EGCEDKBGAGAIAAiAKB@@@@EA@BC@@AKB@@[FCEAd@Po@AA~W@EEE@FFW@·AA@@@MwGaCDNzF\\@EA]@{@YA_DYBQX[@AÑGHzF\\DZLt¶`HBbJV`JFdBLDNBD@DFVBHNxOMZE"]],encodeOffsets:[[[87484,42940]],[[88091,42788]],[[87627,42906]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
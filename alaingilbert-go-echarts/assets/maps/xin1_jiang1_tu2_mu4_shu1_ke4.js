@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("图木舒克",{type:"FeatureCollection",features:[{type:"Feature",id:"659003",properties:{name:"图木舒克市",cp:[79.073963,39.868965],childNum:1},geometry:{type:"Polygon",coordinates:["@@@C@S@EQC_CIAYCwI_EW@KAIBKDK@CBeAi@EDKLSPEJEDC@GCSGGCE@IDOFDDHHHJHNDHBFEHCDEA[IUESASEgAC@Q@MDGDCF@FBDDDBBF@DBBBBB@@AD@DABAD@BA@@B@@@@@D@@ABAB@B@B@@@BB@BB@@@B@B@@AB@@A@AB@@@B@@AB@@@@@B@@CBE@A@G@C@ABA@AD@BADABCB@@E@CAEDKBCD@DFHADADIFIHEP@BCR@DFDLF\\JdTRJDD@FBDFDHDFHFFLLLDRHLBJDHBHNABBB@B@BCBABA@@B@@ABAB@B@BBB@BBB@@@BA@A@AAAB@B@@@BB@BBB@@B@B@@@@B@@@B@B@D@FD@@@@@@BBHFH@HCBCCG@AA@AAAE@A@G@KFE@AEC@ADCHADCJK@KCMCGIECAAKDCBABBHBJBJAFAHGJKNI@ECMGMICI@ICBADEFEHMEKGGSEACAABEBEJGFILKFQNOFCFBDFDFHBJBDDBDBH@RBNDLDPFJHJJDNDJBFEHMGE@CDAFBFHFFDAJGHAFDBF@F@BERGLADAJG`ATAHV@Z@J@D@DZBNF@`@@d@Z@B@@@@B@BBB@BDBB@@B@@CB@BB@B@DDBB@@@B@@AB@D@@@BBB@@DBBB@@@@@BABAD@BA@ABA@ABA@MBC@@@@AA@CAEAC@C@A@I@C@C@EBEBG@C@EBA@EBG@A@EBC@ABAB@@CBABEBCBA@A@A@@BA@A@CB@@ABCBEDABGBABCBA@@@CD@FAB@@DB@DHDJDJHHFBF@NFHDBH@N@FBNFLBNFNDN@A@AB@@A@@@@BABB@AB@@CFBD@DBH@B@BDBB@BBAB@BGDMHODA@E@CAC@A@A@CHEHCFBBBBJFZJ^NZJFDBB@B@D@D@FFTJh@DBDDBFBNBPBNBJ@HDB@BBABAFENMRCNOREL@DADBBDDJDRF^FdFX@XF\\FP@nFVDnD^FjD`FD@H@hFlHD@HBNBHrH\\D^FJVDhD@@LBPB`F@@@@@@@@B@@@@@@@LBTBF@LITSDCDENINMTQVW^[VURQFKFKLYDQBIHWFQLeJe@EBM@EIIkaOK_WICAAO@OBUHoLG@C@]J]HUF]HaH[@G@gC_C{GSA§IEAO@AABEBCFEdcNMBICEGIMEEEAGBEHIPAPBHBB@@DBB@F@BB@@@B@BA@CD@BAF@B@D@@@FABAB@@AAA@G@C@E@CA@C@A@A@AB@@A@@AA@C@@@A@A@AA@AA@@@A@AAAAA@C@ABC@A@AA@@ABA@@@@@@@@A@@@@A@A@ABA@A@@@AAA@C@A@A@A@A@@@A@BAAA@@CAA@AAC@AA@AAAA@@A@@A@C@C@A@A@E@@@A@AA@@@A@@@AA@@@A@A@@@AAAC@@A@@@A@@@@B@@@BC@AB@BA@@@A@@AB@@AA@@@A@A@AAA@A@@@AA@A@@A@@@AGOACOCgIaGKCkI[AI@gDUAE@KKSYEG@ICAIKSKU@KA[GmIkIIACE@KAU"],encodeOffsets:[[80897,40592]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("乌鲁木齐",{type:"FeatureCollection",features:[{type:"Feature",id:"650102",properties:{name:"天山区",cp:[87.631676,43.794399],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@BB@@B@@@DD@@@@B@@@@@BB@@B@D@@@@@@DB@@@BBHBBB@@BB@@BB@@@@B@@@B@D@BAB@@BB@F@@@@@B@B@@@@@BABABABABA@A@ABA@@BABA@@@A@AAAB@@A@@@ABAB@@AB@@@B@@@B@@@B@BBB@@AB@@@BA@@BA@@@@BA@@B@@@@@@A@@@AB@@@@@@A@@B@@@@@@A@@@@B@@A@@@AB@@A@@@A@@@AB@@@@@@A@@B@@A@AA@@AAA@@@A@@@A@A@ABA@A@A@A@@AABA@@B@B@B@@@@@@A@@@ABA@A@@@@A@@@C@@@AA@@@@@CBABABABA@@B@@@@A@@@@@@@A@@@@@A@@@B@A@@A@@AAAAAAAAA@@A@AA@ACEA@AA@A@AA@AAAAAAAA@@AA@@AA@@A@@AA@@A@@A@@A@@A@@@@AA@@@@@AB@@@@@BA@@@A@@@AACACCCCA@@A@@@ABAB@@@B@BA@@AAAAAAAAAAAACCEG@@AAAC@@EEEGAA@A@A@@@A@@@@@@B@@A@@B@@A@@@@@AB@@@@A@@@@@@@@@A@@@@@@@@@AB@@@B@@@B@@A@@B@@@@A@@@@@@@AB@@@@@@A@@@@@@@A@@A@@A@@@@A@@@@@@@AA@@A@A@A@A@A@A@A@A@A@@@A@@@A@@@A@A@C@C@@@A@A@AB@@@BA@@@ABC@A@A@C@@@C@C@@AAAB@@@BA@@DAB@B@B@BAB@@@D@B@DA@@B@@@B@DAB@@@B@B@@@B@B@B@B@B@B@@@D@BA@@B@@@BA@@B@@BB@B@B@@@@@B@B@B@@AB@B@@AB@B@@@@@B@@@BA@@@@B@@@BA@@D@B@B@B@BBB@@@B@B@B@@A@@@@@@@@@@AA@@A@@AA@@@@@@@@A@A@@@A@A@@@@@@@AAA@AAA@@@A@@@A@AB@@@@A@A@@@A@@@A@@@AA@@A@@@A@@@@B@@AB@B@@A@@@@B@@A@@@AB@@@BA@@@@@@B@@@B@@AB@@@@@B@@@@@B@BB@@BBB@B@@BB@B@@@BA@@@@BA@@BAB@BA@@BA@@@A@@A@@AA@@A@@@AA@@A@CAA@AB@@A@@B@@@@A@@BAA@@A@@@A@@B@BA@A@ABA@@@@@A@A@@@AAAAA@@A@@AAA@@@@AAA@A@C@C@A@I@KBQ@C@A@A@A@@@C@C@G@M@CBA@C@E@MA@@@@@@@A@@@@BA@@@@@@@A@@@@@@@A@@@A@@@@@A@@@@@AB@@@@@@A@@@@A@@@C@CBKDC@C@CBC@A@A@@@@A@@@A@@@@@@AA@@@@@@@AA@@@@@@@@A@@@@A@@A@@@@@ACA@AAA@C@@@AA@@@A@A@@@@@A@A@@B@@@BBB@@A@@@A@A@C@A@@@A@@@A@IDEBA@ABA@@@A@@A@@A@@@C@A@C@CBA@A@@@@@A@@AAAAAAA@@AAA@AA@@AA@@@AA@@@AA@@@@AA@@@AAA@@AA@AAA@@A@@@C@@@C@@AA@@B@@@B@@@B@B@BBB@@@BA@@BA@CBABABA@AD@BAD@B@@ABA@@BA@@B@@AB@@@B@B@B@@@B@@@B@@@@@B@D@B@BBB@@@@BB@@@BAB@@AB@B@B@@@D@B@B@B@BB@@B@B@B@B@B@B@DBB@D@B@@BBA@BB@@@@@@@@@B@@@B@B@B@BBB@B@@@B@@@B@B@@@@@B@@@@@B@@@@@BB@@@@B@@@@@B@@B@@@B@@@@BB@@@@@BB@@@@@BB@@@BB@@@@B@@B@@B@@B@@B@@@@BB@@@B@@BB@@@@@@B@@B@@@@@B@BBBBB@B@B@F@F@D@B@B@B@@@@D@B@H@B@@@B@B@B@@@B@B@B@@@B@BABB@@B@B@@@BBB@B@@@BBB@B@@@BBB@@@B@BBB@@@B@BBB@@@B@B@BB@@B@B@@BB@B@BB@@B@B@BB@@B@B@@BB@B@BB@BB@BA@BB@BBD@BB@@@@B@@@@BB@@@@@@@@@B@@B@@B@B@@@B@B@@@@@@@@@BB@@B@@@@@@B@@@@B@@@@@@@B@@BB@@@@@B@@@B@@@@BB@@@B@@@@@@@@@B@@@@BB@@@@@B@@@B@@@BBB@@@@@@@BBB@@@B@@@BBB@@@B@BBB@@@B@@@@@@@@@B@@@BB@@@@B@@@B@B@@@@@@@B@@@@B@@@@@@@@@@@@@@B@@@@@@A@@@@@@B@@@BA@@@@B@@@@@@@BA@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@B@B@@@B@@@B@@@B@@@B@@@BA@@B@@@@@B@@@@@@B@@@@@B@@@@@@@@@@@@@DB@@@@@@B@@@@@B@B@@@@@@BB@@@@@B@@@@@@@@@@@@@B@B@B@@@DD@@@@B@@@D@@@B@@B@@@@B@@@@@B@DB@@@@@@B@BB@@H@@@@@@@B@@@@@@@@@@@BB@@D@@@@@B@BBB@B@B@@@B@B@B@"],encodeOffsets:[[89748,44865]]}},{type:"Feature",id:"650103",properties:{name:"沙依巴克区",cp:[87.598195,43.800939],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@A@@A@@@@@A@@@@@A@@@@@A@A@@@A@@@AAA@A@A@A@A@@@A@@@@@@@@AAB@AA@@@A@CAA@C@A@A@A@A@A@AA@@A@A@A@A@C@@@A@ABA@@BA@A@@AA@@@@AA@A@A@C@A@@@@A@A@@AA@@@A@A@@@CA@@A@@@@@@@A@@BA@A@A@ABA@A@@@A@@AA@A@AAA@AAA@@A@@A@A@@AA@AAA@@@@AA@@@A@A@@@@@ICA@A@@AA@@@A@@AA@@@@AA@@@AA@@EAA@AAA@@@EA@@AACAACAAAAAACE@@AA@A@@@AEE@@CACCCCECCAEC@@AA@AA@A@A@@@AAAA@@AAA@A@@@A@A@A@@@E@A@A@A@AA@@AA@@@AA@A@AAAAAAC@AAA@@A@A@@A@@@AAA@A@CAC@AACAEA@A@@A@@@A@AA@@C@@BC@@BA@A@AAA@@@GA@@AAAA@@EAAA@@C@EAA@A@CAA@EACAC@CAAACAAAA@AA@@@B@D@D@B@B@B@@@B@BB@@BA@@B@FAFAHAFAD@@@B@@@B@@AB@B@@@D@FBJAD@@ABCDADABABA@AB@BA@CBAB@@AB@DAFAD@B@@BDBFBBBBDHBHAF@BAH@@@B@B@B@D@@AB@F@@A@@@@B@@@BAD@@@BAB@@A@@BABA@@BA@@@@@AB@@A@@@@@@D@@@@@B@B@@@D@D@@@B@B@@@HBD@B@B@B@@@D@D@B@@@@BBBHBBBFBD@FBD@D@FBDBH@B@B@@@@@@@B@B@BCH@D@BAD@BAFAF@BAFAB@BAHADAJAHAFAF@FA@@B@B@F@B@B@@@@@B@D@B@BA@@BAB@BABABC@AD@@A@@B@@A@@@@@A@BB@B@@@@B@@@B@B@D@B@B@D@D@D@D@F@H@F@F@D@D@F@B@B@B@B@@BB@@@DA@@@@B@@@B@@@B@B@@@B@B@BBBB@@FB@@B@B@@@B@@@@@B@@@@@B@@@B@@@B@@@B@@@B@@@B@@@BA@@B@@@B@@@B@@A@@B@@@@@B@@A@@@@B@@A@@@@B@@@@AB@@@B@@@B@@@@@B@@@BB@@@@B@@@@@BB@@B@@@B@@@B@@@@@@@B@@@@@BB@@@@@@B@@@B@@@@@B@@@BB@@B@@@@@B@@@B@@@@BB@@@B@@@@@B@@@@@B@@@@@BB@@@@B@@@B@@@@@B@@@B@@@B@@@@@B@@@@@B@@@@@B@@@BA@@@@B@@@@@B@@@B@@AB@@@B@@@BB@@BA@BB@@@B@@@@@B@@@B@@@B@B@@@B@@@B@@@B@@@B@@@@@B@@@BB@@B@@@B@@@B@@@@@BB@@B@@@B@@@@@B@@@@@B@@@BB@@@@B@@@@@@@@@B@@@B@@@B@@@B@B@D@B@B@B@DB@@BB@@B@@@B@FAD@BB@@B@@B@@BB@@B@@B@B@@A@@BA@ABC@A@A@@@A@ENDNBHZRDBHB\\@CPDFBD@@@@@B@@B@@@@@@B@@@@@@@BB@@@BB@@DB@@@BB@@@@@BB@@@@BB@@@@@@@B@@@@B@@@@@B@@@@@@@B@@@@ABA@AD@@@B@B@@@@BB@D@D@B@DBB@@@@@@B@@@@@B@@@@@BAB@@@B@@@@@BB@@@@@B@@@@@@A@@@@@@@@@@@A@@@@@A@@@@@@@ABC@@@@BA@@@@FA@@@@@@BA@@B@@@D@@@@@@B@@B@@@@@B@@BB@@@@@@A@@B@@ABA@@BA@@BA@A@@BABABA@@@@@@BA@A@@BA@@@A@@B@@@@A@@@AB@BA@@DCBBBA@@AD@@@@AB@@@BB@@@@A@@F@H@F@D@D@B@@@@@@@DB@@@@B@B@HB@CAA@A@A@@@A@A@A@A@A@@@A@@@A@G@@AG@@@A@A@@@A@ABA@@@A@AB@@A@@@AB@@@@AB@BA@@BA@@BA@@@@@A@@@@@AB@@A@@@A@@@A@@@A@@@A@@@A@A@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@B@@A@@@@@@@A@@B@@A@@@A@@@@B@@@@@@@@A@@@@@@@@@@@@A@@@@@@A@@@@@@@A@A@@@A@@A@@A@@@A@@@@@@@@@A@@AA@A@A@@AA@A@@@A@@AA@A@@@@@@AA@A@@@A@@@A@@@@AA@@@@@A@@@@@@@@@A@@AA@@@@@A@@@A@@@@AA@@@A@@@@@@@A@@A@@@@@@@@AA@@A@@@@@@@@@A@A@@@A@AA@@@@A@@@@@@@@AA@@@@@A@@A@@AAC@AAAB@@AAAA@@A@AAA@@@A@AA@@A@A@AA@@A@AAA@@@A@AA@@A@A@A@@AA@A@A@@AA@A@A@@AA@A@@@AAA@A@@@AAA@A@@@A@AA@BA@A@A@@@A@A@A@@@A@A@A@@@A@G@A@C@@A@A@A@C@E@E@A@A@A@AAAAA@@@@@A@@@@A@@@@A@@AA@@@A@@A@@A@@@@AA@@@@AA@@@@@AA@@A@@A@@@@AA@@@@A@@A@@A@@@A@@@@A@@"],encodeOffsets:[[89655,44732]]}},{type:"Feature",id:"650105",properties:{name:"水磨沟区",cp:[87.642481,43.832459],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AA@@@@@@AA@@@@AA@@AA@@AA@@@@AA@@@@AA@@A@@@@@A@@AA@@@A@@@A@@AA@@@@@A@@BA@@@A@A@A@@B@@@@@B@@@@A@@B@@@@@B@@@@@@@B@@A@@@ABABABAB@D@@@@BB@@D@@@B@@@@@@BAB@B@@@B@@@@A@A@A@@@ABBB@@@B@B@BAB@B@B@B@@@B@@BB@BB@@B@BA@@@@B@@@@A@@B@@@B@@@BA@@B@@@BA@@@@@@B@@@@A@@@@B@@@@A@@B@@@B@@@@A@@@AB@@@@AB@@AB@@A@@BA@AAA@@@A@@@A@@@A@@BA@AB@B@@@BA@BB@B@B@@ABAB@@AB@B@BABABABABAB@@@@A@A@@@@@E@A@@AA@ABC@A@@@A@@@@@AA@@AA@@AAGAAA@@A@@C@@@@C@A@@@AA@@@@A@@@@@CC@@A@@@AAA@A@A@A@@@A@A@A@AAA@@@@@C@@@AA@@@@@@@@@@A@@@@@@@G@@@AAA@@@@@@@CAA@@@@@A@@@@@@AA@@@C@@@A@@@@@CC@@A@A@A@@@@@@@@@@@@@A@@@@@A@@A@@@@A@A@@@@@A@@@@@@@CA@@@@@@@@@@@@A@@@@@A@@@@@@@AB@@AB@@ABA@@B@@A@@B@@@BA@@B@B@@AB@B@B@@@B@B@@BH@@@H@B@@@B@@@B@B@B@B@B@@@B@BBB@D@B@B@D@B@B@B@@@B@@@@@@@D@DBB@B@@@B@@@@@B@BA@@@@@@B@BA@@B@B@@@@@B@@@B@@A@@D@B@DBD@@@B@B@B@@@@@B@@@@@@@B@@@@@B@@@@@B@B@@@BBBJ@@@@@D@L@F@@@@@@B@@@@@B@@@@@@@BA@@@@@@@@B@@@@@@BB@@B@@@BB@@@@BB@@@@BAL@BBB@@B@@BBB@B@B@@@@@@@@B@@@@@@@@@B@@BBBBBBBBB@@BB@@B@BBB@@@BB@@B@@@@@@@BB@@@@@@B@@@@@@@B@@@@ABB@@B@@@@@@@@@B@B@@B@@@@@B@@@B@@@B@@@@@@@@@@C@@A@B@@@B@B@@AB@@@@@@@B@@@@@@@BB@@@@B@B@@@B@@@@@@@@@@A@@@@@@@@@@@@BA@@@@@@@@@@@B@@@@@@B@@@B@@@@@@@B@@@@@@@@@@@@@@@B@@@B@B@@@@@B@@@@@@@@A@@@@BA@@@@@@@@@B@@@@@BB@@@@@@@A@BB@@@@B@@@@@BB@@@B@@@@@@@@B@@@B@@@B@@@@@@BB@B@@@@@@B@@@@BB@@@@@B@@A@@@B@@B@@@@@@A@@B@@@A@@A@@@@@@B@@@B@@@@@@@B@@@@@@B@@@@@@B@@@@B@@@@@@@@@BA@@@@BB@@@@B@@@@@BB@@@@B@@@B@@@B@@@@@@@B@@@@A@@@@@@B@@B@@@@B@@@@@B@@@B@@B@@@@B@@@B@@@@B@@BB@@@@@@B@@@@@B@@@@@@BB@@@@@BB@@BB@@@@@@@@@A@@AA@@@@@@@@@A@@B@@@@A@@B@@A@@B@@@@@@@B@@A@@@A@@B@@@B@@@@@B@@@@A@@@A@@@@@A@@@@@@Z@A@@A@A@ABC@A@@BAKE@AB@@@B@@@@A@@B@@@BC@@@AB@@A@@@@@A@@@@B@@A@@@@@@@AB@@@@@@@@@B@@@@@@@@AB@@A@@@@B@@@@@@@@@@@BB@@B@@@B@@@@@B@@A@@@@@@A@@@@@AAA@AA@@AAA@AAAAA@@AC@@A@@A@AAB@@A@A@@BA@AAC@@@A@A@A@AB@D@@@B@B@B@B@@AFBJMLFJGlDREnY@f@PQFTD@BBJHBJC\\JFBHAB@D@NALMYDCB@HADA@CECBAbabaDETSXIdMVLPGZANGRKxmCAA@A@@@A@@A@@AA@AAA@AAC@A@AAA@AA@@AA@AAA@@AA@@@A@@@A@@@@@A@@@AB@@ABCBCDABC@CBABCBCB@@C@G@GAC@AAA@AACACACACAE@A@CBC@A@A@G@IBIBE@A@A@@B@@A@@@ABCBCFAB@B@B@@BBBB@@@@@@AB@@AB@@@@@BA@@@@@A@A@A@AAA@AA@@C@@@A@@@A@A@@@@@AA@@CAC@CB@@C@ED@@@@@@@B@@AB@@@@@B@@@B@@AB@@@@@@@@@@@@A@@@@B@@A@@@@@@@@B@@@@AB@B@@@@A@@@@@@@A@@@@@@AA@@@@@@@A@@@@@@@A@A@@@AB@@A@@@A@@@A@ABA@@@A@@@@@A@A@@@@@AB@@@@A@@@@B@@@@A@@@@A@@@@@@@@@@@@@@A@@@@B@@A@@B@@@@A@A@@@A@A@A@A@A@@@A@@A@@A@@@A@@@@@A@AAA@A@@@@@A@A@@@A@@@@@AB@@@@A@@@@AA@@BA@@@ABA@@@A@@ACA@@AA@@A@@@@@AAA@A@AA@@A@C@E@@@A@E@@@@@A@@B@@A@@B@@@@AB@@AB@@A@@@A@@@@@A@AAA@ECA@AAA@@AA@@@A@@@A@@@AB@@A@@@A@A@@@A@EA@AA@A@EACA@@EAAAAAAAAC@CAA@@@C@A@@@AAAA@A@@@A@AAC@A@A@A@ABCAA@C@A@A@@@C@ABA@@@A@A@@@@A@AB@@AA@@@A@A@@@AB@@@@@@BB@@@B@@@@@BB@@@AB@@@@@BA@@BA@@@A@@BA@@@@BA@A@@@A@A@A@@@A@A@@AA@A@@AA@@AA@@@A@@BA@@@EBCBA@E@@@A@@@A@@@@@A@@AA@@@@@AA@@A@@@A@@@A@@@@AA@@@@@@@A@A@@@A@@@@@@@A@@@@A@@A@@B@@A@@@A@@@@@@B@@B@@@@B@@@@A@@B@@@@A@@@@@AB@@C@@@A@@@E@@@@@"],encodeOffsets:[[89830,44824]]}},{type:"Feature",id:"650104",properties:{name:"新市区",cp:[87.569431,43.855378],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@@@@@BD@@@BB@@@@B@@@@@B@@@@@B@@B@@B@@@@@B@@@@@@@B@@@@@B@@@@@@@@@B@@A@@@@@@@B@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@B@@B@@@@@B@@@@@@@@@B@@AA@@A@@@@B@@@@AD@@@@@D@@@@@@@@B@B@@B@@BB@@@@@B@@@@@B@B@B@@@@@BB@@B@@@B@@@@@B@@@@A@@@C@CBEBC@ABA@@@@@@@@@@@AB@@EBGB@@EBABA@[JAB@@IFEF@@DBDDDFBBFB@@BBDDDJFJGBDDB@@BAB@@DHEBFNCBIF@B@BB@HDD@LH@@LD@@BA@@BBDDB@BB@@@AB@@AD@B@F@@@@BB@@@B@B@@@@@FFDF@D@BDDA@ABBBBAb@@DJAB@DA@AB@DA@ALELID@@A@@B@@@@A@@@@@@@A@@@@@@@A@@A@@@@A@@@@@A@@@@@@@@@A@@@@@@@AAA@@@@@A@@@@@@@@AC@@@@@A@@CO@AACAG@GAOAC@E@@@@@A@AAC@G@@@@@@@@@AAC@AAG@@@A@@H@BKF@@DAHFAD@D@@EF@@A@@B@@BBDBJB@BBD@@B@@@B@BBB@BBB@@@@AB@@@BEBABA@A@EBBVBHBBB@@B@@@@@B@@@B@@@B@@@B@BBB@@@@@@@BB@@@@BB@@@@BBB@BB@@@@BB@BBFBBBD@B@DB@@B@@@@@@@@CAA@A@AAA@CB@@@@@@AB@@@BBD@B@@@B@B@@@DB@A@AAC@@@CF@BBD@B@@@@@F@@@@@B@@@B@@AB@B@B@B@@@B@@@B@B@@@B@B@@@@A@@@@@A@A@@@@B@@@B@@@@@@A@KBIA@@E@C@C@@@@@A@C@@@A@A@@B@@@B@B@@@@AB@BG@KF@AE@@B@@@@@@@@@@UAE@A@CFC@@BC@@DAB@@@JCDAFAB@FA@@DEHEJEFG@@FGB@@@@A@ABA@@DEFCBCB@BAHE@A@@@@@@@A@@@@@@@A@@@@@A@@@@@AA@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@AA@@@@@@@A@@@@@@@A@@@@@@AA@@@@@@@A@@@@@@@@@@@A@@@@@@A@@A@@@@@A@@@@A@@A@@@@@@@@@@@A@@A@@A@@@@@A@@A@@A@@@@@A@@@A@@@@@@AA@@@@@A@@@A@@@@@A@@@@@A@@@@@A@@A@@A@@@@@@@A@@@@@A@@@@@A@@@@@A@@@@@A@AAA@C@A@@@A@A@A@@@AA@@@@A@@@@@@@A@@@@@@@AA@@A@@AA@A@AA@@A@A@@@A@C@@@A@AA@@A@A@A@A@@@A@@@@@@@AA@@@@A@@@A@@@AAA@A@@@A@A@@@@@A@@@@@A@@@@@@@A@@@@@A@A@A@@AC@C@A@CB@@@@A@@@A@@@@@A@AB@@A@A@@@@B@@A@A@@@@@A@@@AAA@C@C@@@@@@@A@@@A@A@A@C@A@AGAA@A@@@@@CA@@@@@@A@C@C@E@G@E@@@@B@@A@@A@@BA@@@@BC@@ABAACD@@ABA@@B@@@B@@A@@@@B@@AB@@@BAB@@@@@@ABABAB@@@BAB@@AB@@AB@BA@@@@B@@@@A@@AA@@@@@A@@@@A@@@@C@@@A@@@AB@@@@@@EB@@@@AB@@@@AD@B@@@@@@@B@@@@@B@@@@@@@@@@@B@@@@A@@@@@A@@A@@@@@A@@BA@A@@@@@A@@@@@A@@@@A@CAA@C@C@A@@A@@A@A@@@C@@BAB@B@@A@@@@@@@A@@@@@A@@@@@@A@@@@@@AA@@@@AA@@@@A@@A@@CA@@AA@@A@@A@@@@@@@A@@@@A@@@@A@@@@ACCEDO[@GACAYQAGCMFM@BA@A@@BA@@@@@@@A@@@AB@@@@@@@@A@@BA@A@A@E@E@EBC@A@C@A@A@ABC@G@EC@@@@@@GAA@A@@B@@A@@@A@@@A@@@@@A@@@@@A@@B@@@@B@@@@@@@A@@@A@@@@@A@@@ABA@@@A@@@B@BB@@BBB@DBD@BB@@@@BA@@@@B@@@@AB@@@@@B@@@@BB@@@B@@@@@B@@@@BB@BB@@@@@@@BAB@@@@@BA@@@@BA@ABA@ABA@A@@@@BA@@B@@@@@B@@@JD@D@B@D@B@@@@@AH@@@@@@@@@B@@A@@@@A@@A@A@A@A@A@CJT@@F@HP@D@HDHDIJAJ@@AFADADB@B@@@H@@@@@B@B@BADBF@@BAB@DF@@B@@A@@@@B@@@@AB@@@@@@@@@@@B@@@B@@@B@B@DBB@B@B@@@D@B@@@@@BB@@@@B@@@B@@@@@@@BB@@@@@@@@B@@@@@@B@@B@@@BB@@B@B@@@@@@ABA@ABAACAAAAAEBABCBAB@@CBABC@C@A@CBGFH@ABCBA@CBA@ABA@CBA@@@@B@BA@@@@B@@B@B@@BD@@@FBB@@@B@B@B@B@BB@@B@B@@@ABBBA@CDABC@CDA@ABA@CA@@AA@@@@A@@@A@@@@@@B@@@@@B@@@@@@@B@@@@@@A@@B@@@@@@@BA@@@@@@B@@A@@@@B@@@@A@@@@@@B@@@@A@@@@@@@@B@@A@@@@@@BA@@@@@@@AB@@@@@@A@@B@@@@A@@@@B@@A@@@@@@B@@A@@@@@@BA@@@@@@@AB@@@@@@@@AB@@@@@@@@A@@B@@@@@@@@A@@@@B@@@@A@@@@B@@A@@@@@@@@@@BA@@@@@@@AB@@@@@@@@A@@B@@@@A@@B@@@@@@A@BB@BBB@BBBBBBB@BBBBB@B@@B@BD@BBBBBBB@@@@@@B@@@@@@@@@@@@@BA@@@@@@B@@@@@@AB@BA@@B@@AB@@@@@BA@@@@B@@@@A@BBD@B@BBF@DBF@@@@@@@@@@B@@@@@D@@B@@@@@@@@@@@@@@@@@@B@@B"],encodeOffsets:[[89553,45018]]}},{type:"Feature",id:"650106",properties:{name:"头屯河区",cp:[87.428141,43.877664],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BIJIGCGCC@O@@G@ES@DIB@B@B@B@B@@@@B@@B@@@@A@@@@@@@@BG@@@@A@C@A@C@C@@I@@@A@@@@@AB@@A@@B@B@BAB@BAB@@A@@B@@A@@@@BA@A@@@@@@AAA@@A@@A@@@@@A@@@A@@A@@A@@@@@A@@B@@A@@@@@AB@@@@AAC@CAA@AA@@AAA@@@B@@@B@BA@@B@@@@@B@@@B@@@@@@@A@@@@@@AB@@@@@B@@@@@B@@@B@@@B@@@@AB@B@HB@@@@@@FDH@D@BAB@B@D@B@D@FAF@F@B@B@B@@AB@@@@@@@@@BA@@B@@@@@@@B@@AB@B@@AB@@@B@B@D@BAB@@AB@@@@A@AA@@@AA@@@AA@@@AAC@EBA@@@A@@@AA@@CAA@A@A@C@A@A@@@A@@@A@@@A@@@@@@@@@A@@@@@AA@@A@@@@@A@@@@@A@@@A@@@AA@@@@A@@@A@@@A@@@AA@@A@@@@@A@@@A@@@A@@@A@@@A@A@@@A@@@A@@@@@A@@@A@@AAB@@AA@@A@@@A@@BA@@@A@@@@@A@@@@@AB@@A@@@@@A@@@@@A@@@@@A@@@A@@@A@@@@@A@@@A@@@@@AA@@@@A@@@@@A@@@@@A@@@A@@A@@A@@@A@@@@@A@@@AA@@A@@@@@A@@@A@@@@@@@AA@@@@A@@@@@@@A@@@A@@@A@@@AA@@@@A@@@@@AA@@A@@@@@A@@@A@@@A@@B@@A@@@@@@BA@@@@@@BA@@@@@A@@@@BA@@@A@@@A@@@AB@@A@@@A@@@A@@@A@@@A@@@A@@@@@A@@@@@A@@@A@A@@@EA@@AAAAA@A@@@A@A@@@A@@@A@@@@@CB@@A@@AA@A@A@A@E@C@C@E@E@G@E@C@C@C@C@A@A@C@A@A@@@A@@@@@@AAAÃ\\AAAAAA@AAA@@@AA@ACA@AA@AAAA@ACAA@AA@@ACA@AAAAA@@A@@@@@@@AA@@A@@@ABA@@@A@@@@@@@@@A@@B@@@@@@B@B@@@@@@B@@B@@@@@@B@@@@BB@@@@BB@@B@@@@BB@@@@@BB@B@@B@@@BB@@@@@@@@@B@@@B@@@@BB@@@@@@@@@B@@@@@B@@B@@@@@B@@B@@@B@@B@@@@BB@@@@B@@@@@@B@B@@@@@B@@B@@@@BB@@@@BBB@@@@@@B@@@@@@@@@B@@B@@@@B@@A@@BA@@@AA@@@@A@@A@B@@A@@@@B@@BB@@B@@@B@@@B@@@B@@@@@@B@@B@@@B@@B@@@@@@A@@B@@@B@@@@@BA@AB@@@@@B@@B@DD@@@@@B@@@B@B@@@B@B@@@B@@@B@B@B@B@@@B@@@@@@@BA@@B@@@@@B@@BB@@@@@B@@BB@B@@@BAD@@A@@B@@@@@BBB@@@B@@@B@@@B@B@@@B@@@B@@@@@B@@A@@B@@@B@@@B@@@B@@@@@B@BAB@@@B@B@B@@@B@D@@@@@B@@@B@@@BA@@B@B@@@B@BB@@BBB@@@B@@@@AB@BAB@B@@AB@B@@@B@B@@@B@@@B@@AB@@@@@@AB@B@@@B@@@BA@@@@B@B@@@BAB@@@@ABA@@@@B@@@B@@@B@BA@@@@BA@@B@B@BA@@D@BAD@B@@AB@BA@@@@B@B@@@@A@@B@@ABAB@B@B@@@BB@@B@B@@@B@B@BAB@@@B@@@B@@@BB@@B@B@B@@AB@@A@@B@@@@BB@@BB@@B@@D@B@@B@@@BBBB@@B@@B@B@@@B@@B@@BA@@@BB@@@B@@BB@@@BB@@BBB@B@@@@BB@@@@B@@B@@@B@@B@@B@@B@@B@@@@DHB@@B@@B@@B@@BB@@@B@@@@BB@@BB@@BBBB@@@@BB@@@@@B@@@@B@BB@@B@@@BBB@@@@@@BB@@B@@@B@@B@BB@@DB@@@@@@B@@BB@@@B@@@B@B@@@@BB@@@BB@BB@@@@@@@@BB@@@@@@@@B@B@@B@@B@@B@@B@@@@@@@@@BB@@@@@B@@B@@@@BBB@@B@@@B@@@@@@@@@@@@BB@@@@@BB@@@@BB@@@@@@BB@@@@B@@@@@B@@@@@B@@BB@@@@B@@B@@B@BB@@@@BB@@BBB@@@DBBB@@BB@@@@B@@B@@B@B@@@B@@BB@@@BB@@BB@@B@@@B@@B@@B@B@@@DBD@B@B@@B@BA@A@A@@@@@@@@@@@@@B@@@B@@B@@B@@@@@BB@@@@@@@@@@@B@@BB@@@B@@@@@@@@@@B@@@@@@@@B@@@@B@@B@@@@@@B@BB@@B@@@B@@@B@@B@@B@@@@@@@@@BB@@@@@@@@BB@@BBB@@B@@@B@@AB@@AB@@@@@B@@@BB@@BB@@@@@@@@BB@@@@B@@B@@@@@@B@@B@@B@@@@B@@@@BB@@B@@@@@@@@B@@@@BB@@@B@@@BB@@B@@@B@@@@@B@@B@@@@@B@@@@@@@B@@@@@BB@@@B@@BB@@@@BB@B@B@BB@@B@@@@B@@B@@@@B@@B@@@BB@@@@BB@BDBDD@@@BB@B@@@BB@@DABA@@B@D@BB@@@@@BBAB@@@B@@@B@BBB@@B@@@@BB@@@AB@@BB@@@B@@@@@B@B@AAB@@@@@B@@@H@@BB@@B@@AB@@B@BBB@DBB@BB@@B@B@B@@B@@CB@B@@@@@@DB@@B@BB@@@A@AJEDAEMFACG@@BA@AA@CCHAEICICCAA@@EAAACECCCA@@FEJE@@BA\\IB@BAFA@@HAFA@@BA@@@@@@@@@@B@BAD@FADAD@@@B@@@@@@A@@@@@A@@@AA@@A@@@@@A@A@A@@@@@A@@@@AA@@@AA@A@@@@@@@@@@C@@@@BC@@@@@A@@B@@@BB@@@A@@@@@@@@@A@@@@@AA@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@A@@A@@@@@@@B@@@@A@@@@@@@@@A@@@@@A@@@@@@@A@@@@@AA@@@@A@@@@@A@@@@@A@@A@@A@@AC@@@@@@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@AC@@@@@A@@@@@@@@@@@AE@CAE@A@AAC@A@B@@A@@@@@AB@@@@A@@BA@@@ABA@@B@@@@A@@@@@@@AB@@@@@@@@@@@@A@@@@@@@AAAAAA@AACA@@@@AAAAA@AAAAAAA@AAA@AAAB@@@@@@@@AB@@@@@@AB@@@@@@@@@BA@@@@@@B@@A@@@@@@@@B@@@@A@@B@@@@@@A@@B@@@@@@@@@@AB@@@@@@@@@BA@@@@@@@@BA@@@@@@B@@A@@@@B@@@@A@@@@B@@@@A@@B@@@@@@AB@@@@@@@BA@@@@@@B@@A@@@@B@@@@A@@@@@@B@@@@@@A@@@@B@@@@@@A@@B@@@@A@@@@B@@A@@@@@@@AB@@@@@@@@A@@@@@@@A@@@@@A@@@@B@@@B@@@@@BB@@DBB@BAB@DCD@BADCB@AABA@@A@A@@@AAA@A@A@A@@@A@EA@@C@@AA@A@@@@A@@B@@A@A@@B@DAB@BAB@DAB@DABAG@HEDAB@D@D@BADA@@BADABAFABBBBDBBBBAB@BA@@@@@@@A@AA@@A@@@AA@@@@@@@@A@@@@@@A@@A@@@@@@@A@@@A@@A@@A@@@@@A@C@@@A@AAA@C@A@A@@@A@@@A@@@@@@@@@@BA@@@@@A@@B@@@@AE@@CBA@AE@CAABA@A@@@@@G@@@A@A@BCBCBE"],encodeOffsets:[[89572,44908]]}},{type:"Feature",id:"650107",properties:{name:"达坂城区",cp:[88.311099,43.363668],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@EGBAECB@BB@@BMDFLOBDJIBA@YDDN@@C@ABA@EBA@EDA@C@A@@BEBA@A@CBC@ED@@BBDL@@DLBNDJFF@@@@DAFADAFAD@FADAD@FAFABAH@DALCJAB@@@@@@B@@@B@B@@@B@B@@@B@@BBB@@@BB@B@B@B@@@@@@A@@B@@A@@B@@A@@@@BA@@@@@@BA@@@@B@@A@@@@B@@A@@@@B@@@@@@AB@@@@@@BB@BB@@@BBBB@B@@@B@B@@@@AB@B@BABA@@@@B@@@BB@AB@@@B@@A@@@@BA@@BBB@D@BB@@B@@@BB@@BB@@@@B@@AB@@ABA@AB@@AB@@@@AB@@A@A@@AA@A@AA@@AAA@@@@@A@@@@@A@@BA@@@A@@@@@@@AB@@A@@B@@@BA@@@@@@BA@A@@BA@@@@@@B@@@BB@@B@@@B@@@B@@@B@B@@A@@B@B@@@B@@BB@BBB@B@@@@@@@B@B@@@B@B@@@@@@B@@BB@@@BB@@@@@@@@@@@BA@A@A@@@A@AAA@A@A@C@@@AB@@A@@@@@AB@@A@@@@@A@A@@BA@A@@BA@A@A@@@@@A@A@A@@AA@@@AB@@A@@@ABC@@@A@A@A@A@A@A@@@A@A@@@A@CBA@@@A@@@CBA@C@@@A@ABA@A@A@CB@@AB@@A@BB@BD@D@@@D@B@B@D@BA@@B@@A@@BAB@B@@@D@D@B@B@@@B@@@B@@@B@B@B@B@B@B@B@B@B@@@BB@@@@@@B@@@@@@BB@@@@B@@@@@@@B@@@@A@@B@@@@@@@B@@A@@@@BA@@@A@@@A@@B@@@@@@@@@B@@@@@@@@@B@@A@@B@@@@@BA@@@@BA@@@@@@@@B@@@B@BBBFHFF@@BDBB@@FHDDBBBBBBBBBBBB@@ABA@@@A@AB@B@@@BB@DDDDDBBB@@B@@@B@@A@@@@BA@@@@B@@B@@B@@@@BB@@@@BB@@BB@@@BB@@BB@@BBBBBBBBB@@B@BBBB@DF@BBBB@@@BBBBBBBB@BB@@@@B@A@@B@@@@@@A@@@@@AB@B@B@@@B@@AB@@@@@B@@BB@@@B@@@B@@BB@@@@@B@@@BB@@@@BB@@@@BB@@BB@@BB@@@@BB@@@@@@BBB@@@@@F@@@B@@@D@@@BA@@@@B@@@@@@AB@@@@@@A@@A@@@@A@@@@B@@@B@@@@AB@@@@B@@B@@@@@@@B@@@B@B@@@@@@@B@@B@@B@@@B@@@B@@@BB@@@@B@@BB@@@@@B@@@B@@@F@B@DAFA@@B@@AB@@@B@@BB@@BB@B@@BB@B@@@B@B@B@@@B@B@@A@@B@@AB@@@B@@AB@@A@@@@BA@@A@@A@@@@@A@@AA@@@@@@BA@@B@B@@@B@@BA@@B@B@@B@B@@@B@BAD@@@B@B@D@B@DBBAB@B@B@D@BBB@@@B@B@BB@B@@@B@D@@BB@DBDBBBBBBFB@@DBFBB@B@@BFBB@@@B@B@@@B@@@BA@@B@@@B@@@B@@BB@BBB@FDB@BBB@@@@@B@@@B@@@BA@@BA@@@@@AB@@@@AB@@@@@F@B@@@F@D@B@@@BBB@B@BB@@@@B@@@BB@@DB@BB@@@B@BA@@B@@AB@@B@@B@@@@@BA@@@@B@@@B@B@@@@@B@B@BBB@@@@@B@@@B@@@@BB@@@B@B@B@B@B@@@B@B@@@@@@AB@@@@A@@B@@@@@@@@@@@@@@@@B@@B@@@@@@A@@B@@@@@BA@@@@B@B@@@@@B@@@B@BAB@@@B@@@B@@@BA@@B@B@@@@@@@B@@@@@@@B@@B@@@@B@@@@@@@B@@@@@@ABA@@@@@A@@@@@@B@@@@A@@B@@@@@@@@@@@@@BA@@@A@@@A@@@@BA@@@A@@@@@@FCD@@@DAD@DB@@BB@@@@B@B@@@B@@@D@@@BBB@BBB@B@B@@@@@B@@A@@@@BA@@BA@@@@@@AAAA@@@A@ABADEDABA@@B@@@@AB@B@F@JAJAH@B@B@D@DAB@F@DBDBDBDBBBB@BBD@HBH@D@@@DADABADAD@BADCDABA@@BA@@B@@@@@B@@@B@@@B@@BB@BBB@@BB@@BBB@B@BBD@BBB@BBB@@@BB@@@B@B@DBD@@@@@BBJB@@B@B@@@BBB@B@B@B@@AB@@@B@B@@@BBB@B@B@DB@@B@B@B@BB@@B@@@HBFBB@@@BBB@B@BB@@B@@@B@BA@BH@J@H@H@DADEBADCD@FAF@HBD@D@BADAFG@EBABGAEACDEDCFCHBDBDAF@BAD@DFDDDDDBDBDABBD@BBFBDBB@B@@@JBJAHAH@FB@DADAF@DBBBBBBB@D@DCFAFAHAHAF@FAD@DADCDCBADAFAFD@F@D@F@DB@B@B@D@D@B@BAHAF@DADBB@DABABADDDDBD@@BBB@@BDBDBFDBBBDDDBBDDDDDB@DAFADEHEF@BEBABADBBDB@BBDAFBBBFBBB@B@D@D@B@DCDCB@B@B@B@BBDDFHFHDHBBBBF@D@FABADCDAFAFANEFCDE@GAAAA@@AAAA@A@EBCB@B@BAB@B@BA@AAC@C@A@ABCB@@AD@DBD@DDB@DB@@DB@BD@FDDBDBB@BB@@B@B@B@BAHANCN@LBF@H@FAN@@@D@D@F@D@D@BAD@D@DABADABABAHAF@FBDBDFBBHHDBB@@@FDFBDDDFFD@@BB@AB@DADADAD@DAD@H@B@B@B@BBB@@@B@B@BB@@D@BBDBBBDBB@BBDBDFBBB@@BBD@D@BBF@B@BBB@B@@DDDDDBBD@@BHBFDFLFHFF@B@BB@@BBB@@BB@@@DB@@@AB@@@BA@AB@@AB@@A@@@A@C@AAC@A@C@@BA@ABC@CBCBADAFAFAD@DABABABE@AAEAA@A@C@A@@@@AAAAA@@AAA@@AAAA@A@@@A@ABABA@@BA@ABA@ABABA@@@@B@B@B@@@B@B@B@B@B@@@@@B@@@@@@@BB@BBB@@B@@@B@D@B@B@B@@@B@B@@@B@B@@A@@B@BA@AB@BAB@B@B@BAB@B@B@B@B@B@BBD@BBB@BB@BB@@BB@@@B@@@@@@@BA@AB@B@DAB@B@B@B@B@F@@@B@D@BBDBD@BBB@B@B@@@@A@@@C@A@AA@@A@@@A@@@C@AB@@A@AB@@ADAB@BABAB@BA@@@AB@@ABA@ABABABAB@@@@AB@@A@AB@@@B@@AB@BA@@@@@A@@AA@A@A@A@@@A@A@@@@@@BA@@D@B@B@BABAB@B@DAD@F@B@BB@@B@BBB@DBB@BBD@@@B@@@@@B@BABABAB@B@BAB@B@BA@@@@BA@A@AB@@AB@B@B@B@@@B@BBDBB@@BBBB@@BBBD@@@B@B@@@BA@@BAB@@ABADA@@BAD@BAB@D@B@@@B@BB@@B@B@@@@@B@@@BAB@@@B@@@@@@@@@@@@@BC@AB@@A@ABA@@@@BA@@BAB@BAB@B@@AB@@@@ABAB@BAB@@@BAD@D@F@@@B@B@DAB@B@B@BA@@@@@AAAAA@AA@@A@@@A@@@A@A@ABA@@@A@@@A@@AACA@AA@@AA@AA@@AA@@@A@@@@A@AAC@AAC@A@A@A@A@AA@@@@@A@@@@@A@@@AB@@A@AB@@A@A@@@AA@@A@@@A@A@ABA@AB@@@@@@@B@@AB@@@BBB@B@D@B@D@@@B@DBD@B@BBB@@@B@@@B@@@@BBBB@BDB@B@B@DAD@BAD@B@@AB@B@@AD@@@F@BBBAB@@@B@B@B@B@BAB@@@B@@@B@BA@@@@@AB@@@@A@@@@B@@@@@B@B@DBB@B@DBBBD@@@B@@@D@@@B@BB@@BBD@@@B@D@DAB@B@B@@BB@BB@@B@@BB@@BB@B@@BB@@@BB@@B@@BB@@@D@B@D@@@B@B@@@B@@@@BB@@@BB@B@@BBB@@@B@B@BA@@B@BAB@B@B@B@@@@@B@BAB@B@@@B@B@B@@@B@B@BBDB@@B@BBD@D@B@@@@@B@@AB@@A@AB@@A@A@@AA@C@A@@@@B@@AB@B@B@BBB@B@FBB@B@@@@@B@@@@@@@BA@@@@@@BA@@BAB@BAB@@@@@BA@@@A@@@@@A@@AA@@AA@A@@@@@@B@@@BA@@B@@@BAB@BAB@@@@@@A@@BA@@@@@@BA@@B@@AB@B@@@B@D@@@B@BA@@@@@A@A@@@A@@@AB@@AB@BADABABABABA@@BADA@AB@BA@@BAB@BA@@B@B@BBB@D@BBDBD@B@B@B@@@BA@@@@BA@A@@@ABC@@@A@A@@@AAA@AAA@A@@@A@@@A@@@ABA@@@CBA@ABA@@BCBA@ABC@A@A@@@A@@@A@@@@@A@A@@@@BA@@B@B@B@B@@@@AB@@@BA@AB@@@BAB@B@B@B@B@D@D@BAB@B@B@B@@AB@@@B@@A@AA@@A@A@A@@@A@A@@@AB@DAB@B@@@@@@A@AA@AA@ABECEAADC@ABAD@BABGDCBABA@ADCB@HGHEBADG@C@A@EEKAG@A@AD@@AB@@ABA@@@A@@BA@A@@@ABABA@@@@@@BABAB@B@@ADA@@DAB@B@BC@@@A@A@@BA@AB@BA@@@A@@@ABABAAA@A@@@@@AA@@A@AAAAA@A@@@AAAAA@@A@A@@A@CAA@A@@AAA@AA@AA@B@@C@@@A@A@@AAAA@ACCAGA@@AA@@@AA@A@@BA@A@@@AAA@ACE@ACC@A@AA@@A@@A@@AA@@@@AA@@@AA@@A@@@@A@@A@AC@@@AA@@A@@@@AA@@@@@@A@AA@A@@A@@@@A@@AAAA@AAA@@@ABA@A@A@A@@@A@A@A@@@ABA@@@@@A@AAC@@@A@@BA@A@AAG@AAA@A@A@@@CBC@A@@@@AA@ACACA@@A@@A@@@@DC@A@A@A@@@A@@ACACAA@A@A@A@A@CBC@@@A@@AAA@AA@@A@@C@@AAA@AAA@AA@@E@A@C@A@A@AAA@CAC@C@@@@@CACAAAA@A@A@CA@@A@@@AA@@AAC@A@AAA@A@CAA@@@C@@@@@AAA@@AA@@@AAA@A@AAAAC@A@A@@@A@AAAAA@@@@AA@@@AA@@@@@AA@AAAA@@A@@@AA@@A@@@A@@@@@BAAA@A@@@@AAAA@@@AAAA@@@A@AA@@A@AA@@@@AA@@AAA@AA@A@@@AA@@AA@@@AAA@@A@@A@@A@@CBA@A@CBC@C@@@A@ABC@@@A@C@C@CB@@C@A@C@C@CBC@E@A@@@A@A@A@A@A@@@A@@@A@@@@@A@@@ABA@A@A@A@A@A@A@A@A@@@@@A@@BA@@@A@A@ABA@A@@@A@C@ABAAA@@@A@@@A@@@A@@@A@A@C@A@@@@@AA@@@@@@A@A@A@A@A@A@A@A@A@@BA@@@@@@@@@A@A@@@A@@B@@A@@@@@@@A@@A@@@@@@@A@@A@@@A@@@@AA@@@@AB@@@@A@@AA@@@@@AA@@@@@A@@@@AAA@@@@AA@@@@@@A@@AA@@@A@@A@AA@@@AA@@@@A@@A@@A@@A@@@AA@@@A@@@@@A@@@@@@B@@@@@@AB@@@A@@@@A@@@A@A@@@A@@@A@ABA@AAA@@@A@@A@@@@A@A@A@@@A@@@A@A@@@@@@@AB@@A@@@A@@@@@A@A@A@C@A@A@A@A@C@A@A@@@AA@@A@@@@A@@@@A@@A@@@@AA@@@@A@@A@@A@@@AA@@@@@A@@A@@@AA@@A@@@A@@@AA@@@@AA@@@@A@A@A@AAA@A@@@AAA@@@A@A@AAA@C@@@A@@@ABA@@@A@@@A@A@A@A@A@@AA@A@@@@@@A@@@A@A@@B@@AA@@@@@A@@@AAA@CAA@A@AA@@@@AA@@A@A@AA@@A@A@@@@@@@AA@@A@@@@@@AA@@@@@AAA@@AA@A@CA@@A@A@@@AA@@@@@@@AB@@@@A@@@ABA@@@@@A@@@A@@@@@A@@A@@@@@A@@@A@@@A@A@ABA@A@A@A@A@@@A@@@A@A@A@@@A@A@A@A@@@A@AB@@@@A@A@@@A@A@A@@BA@@@AB@@@@AA@@@@AA@@@@A@@@A@C@C@ABA@@@AB@@@B@@A@A@@AA@A@A@@@@@@BB@@B@@AB@@@@ABA@A@A@A@CAA@AAC@A@AAA@AA@@AA@@AC@@A@@@A@AACA@@A@A@@@A@@@A@@BC@@@@@ABA@@@A@AB@@A@@B@@@@A@@@@@@A@@@@@@@@@@@@BA@@@@BA@@@A@@@A@@@@@A@@A@@@@@A@A@A@@@A@@AA@@@A@@A@@C@C@@@A@A@A@A@ABA@@@A@@@@B@@@B@@@@AB@@A@A@@@@@A@@@@@@@@BAB@@@B@@A@@BA@@@@@A@@@A@@@@@CB@@A@A@@@@@A@@@@AA@@@@@AB@@A@A@@@A@@BA@@@@@A@@@@A@@@@A@AA@@CA@@AAA@AA@@@A@@B@@AB@@@BA@@@@@A@@@@@@AA@@@@@A@@@@@A@AA@@A@@A@@@@@@AA@@@B@@A@@@@@@BA@@@@@@@A@A@@@@@A@A@A@ABA@AB@@AB@@CB@@C@@@@BA@@@@@A@@@@A@AA@@AA@A@@@@@A@@@@@AA@@@@A@@@@@A@@@@BA@@@@@A@A@@@@@A@@@@@A@@@@@@@@AA@@B@@A@@@A@@B@@@B@@@@A@@@@@@@A@@@@@@A@@@@A@@@@AA@@BA@A@A@A@A@@@@A@@A@@A@@A@@AA@@@@@A@@@@@@@A@@@@@@@A@@@A@@@@@@B@@@@@@@@A@@@@@@@@AA@A@A@@@AB@DABAB@@CBC@C@A@CAA@C@@AAAAAC@AA@@AA@AA@@@AA@@AAA@@@@A@@A@@A@AA@@AAAA@@A@AA@@CA@AA@AAA@AA@@A@A@@@AA@AA@@@AAA@@@@@CA_IGCGGECAGAIAGEGIGCEACECGAMCMEOGECEAE@I@ODWJSDOF]RSLMHIBKBGACCAEC@IBGBI@CACAAG@GACEAE@S@KFKDI@IBODG@E@C@C@EBMFGRCHQ^[hCL@PDPHNJHBNBJEF@BHBBBCDIDQ@GAI@E@I@C@CDIJA@CCEAE@QDIBC@C@GAEAE@EAEAG@KFEDEBAAAICA@@@@AB@@A@@@A@@@@@@@AB@B@@@B@@A@@@A@@@AB@@C@A@AB@@A@A@@BA@@B@B@@@@A@@@@B@@@@@@@B@@@@@@@@A@A@A@A@C@C@CBA@A@GBGBEBA@GBMHE@IDGDGDKD@@ABCB@b@Z@XBV@P@@@@CDAFCDAB@@SFoL[HaHCBA@CBCBA@CBKBABA@IDE@ABA@EBCBE@MFIBIDIBCBCBA@GDIBIDA@GBYHIBaJSHMDCBA@A@A@ABC@@@ABEDCBA@A@AB@B@@EFCDAB@DAB@B@B@@AB@@@@CD@@@BAB@@@BADCDAB@DABCDABADABABADCDCDABABCDADEFAFEFILGLABEDINABEFABCB@@ABABABCD@@@@@BA@ADAB@BABEHCDCFADABCDABCFABA@AB@@CBCBCBA@CDEDGBE@I@C@E@ABC@CBED@@@AAA@C@A@A@@AA@ABARAbEAI@@AEAC@CAG@A@@@C@@@@ZH@A@A@@JDB@AKFAEECCIBKBE@@@H@DNOCA@KBCKG@_F"],["@@J@DAFCFCD@D@HBBADAJEBBDBB@DBBBB@@BB@B@BABIB@BCLAF@H@FBD@@ABADCFABADCBAB@LA@@@@@AA@@@AA@@A@B@B@@@BB@@B@B@BA@@B@@BB@DC@ABA@ABEFC@E@@@AT@@@@AB@@A@@BA@ABEB@B@BA@A@AACAAA@@ABA@@B@@@DC@A@@@@AA@@A@@A@@BABABC@O@KACKCA@C@AAGA@ACAA@CAAAACQMYSGEGGKMEBMHCFABIDMDQFIBC@I@GAC@KFA@IDIBMBQBGDEBGDEDABGH@@A@E@C@A@@@SLC@A@MAG@IFYNEFEF@@CDEBIBCBMFIBA@KP@BEDABCBA@IBEBmT@@EBG@GBE@GB@@GHKLEDKDEBEF@@EBC@ODMDOBIBGDE@aJGBC@ECE@EDIHC@@B@D@H@@@BB@DBB@BBBBBB@@@BBBBBBDBBBB@B@B@B@BB@DBDBDBBDB@BBBBBBB@BBABA@@BCD@@@B@D@D@DBB@B@B@B@BA@ABAB@B@@@B@B@B@@BBB@B@B@B@DBFBB@@@AD@B@D@D@B@BBB@@@B@@@@@@BAB@D@D@B@BAB@B@D@B@D@DBD@DBD@B@BBB@@BA@@B@BB@DBB@B@BB@@@@BB@@@@@@@BB@@@@B@@@@FF@@@B@@DH@@B@@BB@@B@B@@@@B@@@DADCBABC@ABA@ABA@A@ABABCAA@A@A@@@A@A@A@@@A@@@A@ABC@@BA@@@ABCBAAAAA@@@A@ABA@CBA@AAA@A@CBA@C@@@A@A@A@A@A@@DAB@B@B@D@BAB@D@D@DADADA@ABA@A@ADAB@D@@@@A@AB@D@B@DABAB@B@BBB@D@D@BAB@B@@@B@BABAB@BAB@@AD@F@B@DAB@BBDA@@@@BA@AB@B@BA@AB@@A@AB@BA@@B@@@BA@AB@B@@@@@BAB@@A@@B@DA@@DAB@B@DCB@B@B@@@BA@@DA@ABA@@@ABAB@B@B@B@B@B@@@BAD@@ADABB@@D@B@@@BA@@B@@@B@B@BA@AB@@A@ABABAB@BBB@@@@AB@@AD@B@@@B@BAD@B@B@@AB@D@BABBD@D@B@BAD@D@BABBB@B@B@B@@AB@DAB@B@B@BBBAD@D@D@DABBB@@BD@@@BA@@N@F@J@J@D@PDNBHBJD"]],encodeOffsets:[[[89814,44621]],[[89586,44072]]]}},{type:"Feature",id:"650109",properties:{name:"米东区",cp:[87.655935,43.974784],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@B@@A@A@@@A@@@@B@B@@@D@B@@@@@D@D@FB@AJ@L@B@@@@A@@@A@@@@@@B@B@@@@@B@@A@A@@@A@A@@@A@@@A@A@A@A@@BA@@@A@@@@@E@@@@@A@C@AAE@@D@@BD@B@BCA@@A@A@@@A@C@AA@@A@@B@@@@A@@DBB@B@BBB@D@@@@@@A@@@CAA@C@AAEAAAA@@A@@@@@B@DBB@B@@@D@BBB@@@@@B@B@BBB@@@B@@@B@BBD@@@@@B@BBB@@@B@@@B@@@@@BB@@B@@@@@B@@@B@@@B@@BB@@@B@@@B@@@@@BBB@B@@@B@@@@@BBB@@@B@@@@@B@B@@BB@@@@@B@B@@@B@@BB@@F@B@@B@BB@B@@@B@@@@@@@@@@@@@@@B@@@@@B@@@@@B@@B@@BA@@B@@B@@@@B@@B@@BA@@@@@AB@@@B@B@@@@D@@B@@@@B@@@@@@B@@@B@@@B@B@B@B@B@@@@@@@BB@@@@BAB@B@D@B@B@B@@BB@@@@B@B@B@@B@@@BBB@@@B@@@@BA@B@@B@@@B@@A@@@@@@@@@@B@@@@@@@B@@B@@@@@B@@@@@@@@@A@A@@@A@@@@@@B@@@@@@B@@@@@@B@@B@@BB@B@BA@@B@@@B@@@B@B@@@@@@AA@@A@A@@@@@@@@BA@@@@@@B@@@@@@A@@@@@A@@B@@@@@@@BA@@B@@B@@@B@@@B@@@@@B@B@F@@@D@D@B@BB@@B@@A@A@@BA@@BAHDBEFCAEFCB@BCB@@@@@B@F@D@D@B@@@@BB@D@BBB@FBD@B@@@@@B@D@D@@@BBB@B@B@@@B@@B@@@@@BB@@B@@@@@@B@@@@@@AB@@A@@B@@@@@@A@C@A@@B@B@B@@@B@B@B@B@@BB@B@B@@BD@BB@@B@B@BBB@B@@DAB@B@@@@@@@@@@@@@B@@B@@@BB@@B@@@B@@@@@@@B@@@@BB@@@B@@@@BB@BB@@B@B@@B@@@@B@@@@B@@B@@@@@B@@@@@B@@@@@B@@@@@B@@B@@B@@@BB@@@@@@@BB@@@@@B@@@@@B@@@@@B@@BB@@@@B@@B@@@@@@@@@BAB@BAB@@@@@B@@@@@B@@@D@B@@@B@@@B@B@B@@@B@B@B@BBB@B@B@@@B@B@@@B@B@@@B@B@B@@AB@B@B@@@@@@B@@@@B@@@B@@B@ABB@@B@@@@B@@BB@BBB@@BB@@@BB@@@@@@BB@@BBBBB@@@BB@BB@@B@AB@@@@@B@@@@A@A@@@@B@@@@@@A@@@AB@@@@A@@@@@@@@BA@@@@@AB@@AB@@A@@@AAA@AA@@@@AA@@@@A@@H@BAB@B@F@DAD@JA@@BAF@JCB@F@LCFA@@@A@@A@AC@@@@@A@A@CAA@C@AAC@C@A@A@A@A@C@@B@@AB@@@BAD@@@BA@@DA@@B@@@@A@@@@@A@@BA@AB@@@@@BAJAPLTNBBhZ@@@@@@@B@@@@A@@@@B@@@@@@@@@B@@A@@@@@@B@@@@@@AB@@@@@@@@@BA@@@@@@B@@A@@B@@A@@B@@PHAJALTPRNDDB@@@@B@^BdBbBX@H@NB`@H@F@@EDABCB@@EDABCBABEDC@@@@@CBGD@BP@F}B@@@@@@@@@B@BABAD@BADCF@@@DAB@D@BAB@@@D@B@@@BA@@@@B@@@@@BB@@BAB@@@BADAB@@@@@@@B@@@@@@AB@@@@@B@@@@@BKBODADAFADAFGDGFGFIBGDIBCBC@ABA@A@A@C@A@@BCB@BAB@@@@@B@@@@AB@B@@AD@@@BBB@@@BBBBBBDBB@BBD@B@DBD@@BBAB@BADCF@BADAB@DA@@BAB@D@@@BBB@B@B@@@B@@EJA@@B@B@@@B@@@D@@@B@@BH@B@B@@CB@B@@@@@@@B@@@@@B@BAB@@@B@@AB@@@B@D@B@@AB@@@@@@@@A@@@@@@BA@@@@@@@@@AB@@@@@@@@A@@B@@@@@@@@@@@BA@@@@@@B@@@@@@@@@@@B@@A@@B@@@@A@@B@@@@@@AB@@@B@@@@AB@@@@@B@@@@@@A@@B@@@@@@AB@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@@@AB@@@@@B@@@@@@@B@@@@@B@@A@@@@B@@@@@@@@@@AB@@@@@@@@@@A@@@@@@@@B@@@@@@@B@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@B@@@@@B@@@@A@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@B@@@@@@@@@B@@B@@B@@@@@@@@@@@@@@BB@@@@@@B@@B@@@@B@@BAB@BAB@DAB@B@@AB@B@BAB@DAB@DAB@BAD@BAB@BAB@B@B@@AB@B@BAB@@@BAD@DAB@BAB@DAB@B@BA@@B@@@@@DA@@B@BAB@B@BAB@DADAB@B@B@@A@@D@BABAD@B@B@@@@AB@@@B@@@BA@@B@@@B@BKRUbcvat@BCBIR]nUbWbMVKTCDMXatU`]pOXMTWfkWbADɂ@Ζ@_SYYI_KgUQyU]EOKiWQyUWIYMo[Q}SyCKIS@A@A@A@G@G@E@G@C@A@A@I@A@A@@AA@GFIHOFIHOHMDIDGBW@SBS@S@SBS@EEMEOACEMGQEMEMEOAE@@@@ACGSEMGQEOGQCIAC@@D@F@F@DBD@D@B@B@FBD@D@D@BBF@B@B@FBD@D@HBD@H@BBF@@@@A@@@@BAA@@@BA@@@@@@@A@@@@@@@A@@@A@@@@@ABA@@BA@@@@@AB@@@@A@@@@@A@@@@B@@A@@@@@@@A@@B@@@@AB@@@@AB@@A@@@@B@@A@@@@B@@@@AB@@@@@@@BA@@@@B@@@B@@@B@D@B@@@B@@@B@B@@@B@@@@@B@@A@@B@B@@BB@@@B@@AB@@@@@@@B@@@@AB@@@@@@A@@@@B@@@@AB@@@B@@@B@@@@@@@@@@A@@@@BA@@@@@A@@@@BA@@@@@A@@@A@@@@@A@@@@B@@@@AA@@@@@@A@@@@@A@@@A@@@A@@@A@@@@@@BA@@@@@@@A@@@A@@@@AA@A@@@A@@@A@@@@@A@@@@@AB@@@@AB@@@@@@@BA@@@@@CBA@@@@@@@AB@@@@@@A@@@A@@@@@@AA@@@@@@@A@@@@@@B@@A@@BA@@@@@A@@@@@A@@@A@@B@@@@@@@@@@AB@@@B@@@@@@@@@BA@@B@BA@@B@@@@@@@B@@A@@@@B@@@@@@@@@BA@@@@@@B@@@@@@AB@@@@@@@@@B@@AB@@@@@BA@@B@@@@@B@@@@A@@@@B@@@@@@A@@@@B@@@@@BA@@@@@@B@@@@@B@@A@@B@@@@@BA@@BA@@@@@@B@@AB@@@B@@@@AB@@@@@@@@AB@@@@@B@@@@AB@@@@@@@@@B@@@@AB@@@@@B@@A@@B@@@BA@@B@BAB@@@@AB@@A@@@@@@B@@@@@@@B@@A@@@@@@@@B@@@@@@A@@B@@@@@@@@@@@@@B@@A@@@@@@@@B@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@BA@@@@@@@@B@@@@@@@@@@AB@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@B@@@@@BA@@@@@@BA@@@@@@@@B@@@@@@@@@@@BA@@B@@@@A@@@@B@@@@@@@@@@@@@B@@A@@@@B@@@@@@@@AB@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@B@@@@@B@BAB@@@B@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@B@@@@A@@@@B@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@A@@B@@@@@@A@@B@@@@@@@BAB@@@@@@@@@@@@@@@B@@A@@@@@@B@@@@@@@@@BA@@@@@@@@B@@@@@@@@@@A@@@@@@B@@@@@@@@A@@B@@@@@@@@@@@BA@@@@@@B@@@B@@@B@@@@@@@B@BA@@@@@@@@@A@@@@@@BA@@B@@@B@@@B@@@@@@@B@@@@@@@B@B@B@@BB@@@@@B@@@B@@@B@@@@@B@@@@BB@@@@@@@B@@@B@@@B@B@@@@@B@BAB@DADAB@BAB@B@@AB@@@@@B@@A@@@@B@@@B@@@@@@@BA@@@@@@@@BA@@@@@A@@@@@@@A@@@@BAB@BABA@@@A@@@@BA@@@@B@@A@@@A@@@@@A@@@@@A@@@@@A@@@@AA@@AA@@@A@@@A@@A@@A@@@A@@A@@A@@@@@A@@BA@A@@@A@@@@@@@A@@@@@A@@@@@@@@@@@A@@B@@@@@@A@@@A@@@@@@BAA@@A@@@A@@BA@@@@@A@@@@B@BABA@@BA@@@A@A@@@A@@@@@A@@@@@@@AB@@@@AB@@@@@B@@@@@@A@@@@@@@@@A@@@@BA@@@A@@BA@@A@@@@@@AB@@@@@@@B@@A@@BA@@BAB@@@@@@A@@B@@@@AB@@@@@@AB@@ABCB@@A@A@@B@@@@@@A@@@@@@@A@@@@@@@@@ABA@@@@@@BA@@@@@@@AB@@@@ABA@@@AB@@@@@B@@A@@@@AA@@@@A@AA@@A@@@@A@@@A@A@A@@@A@A@@BA@@@A@@@A@@@@BA@@@ABA@@@A@@BA@A@A@@@AA@@A@@@A@@BA@@BA@A@@BADA@@BA@C@A@AA@EACAA@@AAA@@AACAAA@@@A@A@A@AAC@A@@@A@@@AB@@A@@@A@@B@BA@A@@BA@@@@@AB@BA@@@@@@@A@A@@@A@@@A@@@@B@@A@@A@@@@A@@@@@A@A@@@A@@B@@@DAB@BA@@BABA@@@@@A@@@@@@B@BAB@@@B@BA@@@@B@B@@@BA@@BA@AB@@ADAB@@@BAB@@A@@@A@@B@@AB@BAB@BAD@@@BAB@@@B@@@B@B@B@B@B@B@@@BBB@BAB@@@B@BA@@B@@AB@B@B@@@B@@A@@@A@@@@B@@@BAB@@@@@@A@A@@B@@@B@@BBA@A@@@@@@@A@@@C@A@@@A@@@A@A@A@@@A@@@@@A@@@@BA@@BABAB@@@@@@@@@@@@A@@B@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@A@@@@@@@@@@A@@@@@@B@@@@@@@B@@@@@@A@@@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@B@@@@@BA@@@@@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@BA@@@A@@B@@@@@@A@@@@@@@@@@@@@@BAB@B@B@@@B@@@@@BAB@@@@@BA@@@@@A@AB@@A@AB@@@BAB@B@D@D@@@B@@B@@@B@B@@@BDBB@D@B@B@BB@B@BD@BBB@BBB@B@BABA@@B@@@@@BA@@@@B@@@BB@@B@@@@@@@B@BA@@@@@@B@@@@A@@B@@@B@B@B@DAB@B@B@BAB@@@@A@C@ABA@ABC@A@CBA@ABA@A@ABC@@@A@ABA@A@AB@@@@AAC@@B@AA@@BA@@@A@@@@@@B@@AA@@@BA@@@A@@@@@@BAB@BA@@@@@E@@AA@@@A@@@@@@AA@@BA@@@A@@BA@A@@@A@AAA@@AA@A@@CA@@@AAGAKAACBCACACCCCCEC@ABE@CBCAGAEDCDCFBDBFAHAB@FEHCBABC@C@GAE@EBC@CDABCFCBG@G@I@G@@AABA@@@A@@@AAA@A@AA@@A@EAGA@@A@@@AAA@A@A@@@CAA@A@A@AA@@A@A@@@A@@BA@A@A@A@AA@@A@A@@@IAAA@@@@C@wnQLMHYBOHUKcNWJSTCFababABFD@DCBGBA@CDZKNMBC@A@GBEA[IIDGAAI@ASCRE@O@emZQFkCIHKEINEA@BA@A@A@A@@@C@A@@B@B@B@B@@BD@BAB@@@B@BA@BBB@@@@BD@@BB@BBBBB@BB@@BBB@BB@@@@B@@@@@@@@BA@@@@@A@@@A@@@AA@@@@@@@@@@A@@@@@@BA@@B@@@@@@A@@@@@@@@@A@@B@@@@@@@BA@@@@@@B@@@@@BA@@B@@AD@@A@@@@B@@A@@@A@@BLFAB@@@BAD@B@B@BB@Y@@@@@@@@B@@@@@B@@@B@@A@@@@@A@@@A@@@@B@@@BA@@@@@@@A@@@@BA@@@@B@@A@@@@B@@@@@@@@BB@@@B@@@@@@@@AA@@AA@@@@A@@A@@@@A@@@@@A@@@@@@@AA@@@A@@A@@@A@@@@@@AA@@@A@@@@@A@@@@@@AA@@@@@@@@B@@A@@@@@@@A@@@A@@@A@@@@@AA@@@@A@@@@@AA@@@@AB@@@@@@@@A@@@@@@A@@@@A@@@@@@@@A@@@@@@@A@@@A@@@@B@@@@B@@@AB@@@@@@@@AA@@@B@@@@A@@@@AA@@@@@A@@@@A@A@@A@@@@A@@@A@@@A@@@@@@@@@@A@@AA@@@@A@@@@@AAB@@@@@@@A@@A@@@@@A@@@@@@@@AB@@@@@B@@@@@@A@@@@@A@A@@@A@@@@@@@@@@@@@@@A@@@@@@@A@@@A@@@@@@@@A@@@@@@@@@@AB@@@@@@@@@@@@@B@@@@@@@@A@@@A@A@@@@@AA@@@@@@A@@@@@@@A@@BA@A@@@A@B@@@@D@@@@@@@@A@@@A@@@A@@@@@A@@@@A@A@@@@@@@@@AA@BA@@@@@A@@@@@@@A@@@@A@@A@@@@@@@AA@@A@@AA@A@AA@@AA@AAAAAAAA@@@A@@@@@@@@@A@@@@@@A@A@A@AA@@@AA@AAK@AB@@@@AA@@@@AA@@A@@@AA@@@@@@@A@@@@@@B@@A@@@@@@@A@@@@@A@@@@E@K@C@@@@@I@@B@@@B@@@B@@B@@B@@@@@@@B@@@B@B@B@BB@@B@B@@@D@B@@@B@BB@@B@BBB@@@BB@@B@@@@@@@B@@@@@@@B@@B@@B@@@B@B@B@@@B@DBB@B@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@@@BB@@@@B@@@@@B@@@@@B@@@@@B@@@B@@@@BB@@@@@@@B@@@B@@@@@BB@@@@B@@@@@BB@@@@B@@@@@@@@@@@BB@@@@@@B@@@@@BB@@@@@@@@B@@@@@@@@@@@B@@@@@@BB@@@@@@@B@@@@@@@B@@@@@@BB@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@B@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@BGFABA@ADEDCF@@AB@B@B@@A@EH@@EHIFGFCF@@EBA@EBCBID@@A@CB@@AD@@ED@D@BBF@V@@@@@@@@A@@@BFE@@LAH"],encodeOffsets:[[89664,45071]]}},{type:"Feature",id:"650121",properties:{name:"乌鲁木齐县",cp:[87.409417,43.47136],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@K@AAE@CAGAG@C@CBC@AB@BADEDA@CBAAAACCCAE@ABCB@@KBA@EB@AC@C@C@C@AC@ECC@MBCB@@CBAB@@ABA@AB@@A@@@A@@@@BC@AB@BA@@BA@ABA@ABA@@BABCBAB@BA@CBA@E@A@@BC@C@A@CBAAEB@@@@A@A@C@@AA@@@A@A@A@ABA@CBAAA@C@A@A@@@A@A@A@@@A@A@A@C@ADABA@ABABA@A@@@A@@@A@@BADCBCBG@AACAA@A@CAA@A@@@ABA@@AA@ACACCCCCA@@BA@CDG@G@CAIBE@K@E@G@IAGBI@ABC@A@AB@E@ABA@A@AA@@@@AB@@A@A@@BA@ABABA@@@AB@@A@@B@@A@A@@@A@@@@@A@@@@@A@@@AB@@@@AA@@@AAA@@@AA@AA@@C@@@A@@@AAAAA@A@@@@@A@@@@@AB@@A@@BC@@@AB@AA@A@ABA@A@A@@@A@A@@@@BA@A@@@A@A@A@BB@@@B@@A@@B@@@@AB@@A@ABA@@@A@@@A@@@AA@@A@@@A@C@A@@@A@@@@@A@A@A@C@@@A@@@@@@B@@A@@@@BA@C@@AA@@B@@C@@BAB@@CB@@@@A@MD@@A@GB@@@@ABABA@ABC@CBA@A@@@AA@@@@A@ABA@AA@@A@ABA@@@A@A@ABA@ABA@@BA@A@@@@@A@A@A@@@A@A@@@A@AB@@@@A@A@@@@@@A@AA@@AA@@@CG@@@A@@EE@@@@@A@@A@@A@@@@@@AA@@@@AAA@A@CAA@@A@AB@@AA@AAA@C@CAC@CAC@A@C@A@A@ABA@C@C@A@AB@@@@@@@A@@AA@A@A@C@C@ABC@@A@EACAA@A@A@A@AA@@@A@A@A@@@ABABAB@@A@A@A@AAA@C@C@C@A@@DC@AB@BAAAA@AAAAAAA@ACCACACAA@@A@A@A@AAAAAACAAAA@A@@AAAAAAA@CAA@@A@@@G@C@AC@GBG@C@WBA@QB@@EBUDA@EB@BC@A@C@C@A@IBCBGDCBE@E@C@C@AABIAG@E@C@EAAIAEAECMGICMC@@EAGAGECEACC@EAE@I@A@EDCDELIJEBG@GACACC@ACE@AIBUHKDE@C@IDGFELCDE@@@AAIAKECAE@KFIBA@M@K@A@CBKDIBGHGJEJ@J@DADCBC@CAKCCDCDADCDI@EBGDABCDEBE@A@IB@BIAOGE@AB@DGFIBG@CBCBCBEDAB@@CFE@GBCBCBEBGDG@EBCH@BCDC@@@BD@@BF@@DFHF@@FBFBB@DD@BBD@BBD@@@F@B@B@@@B@@BB@@BBFBJBLBJBLF@@DDBDAFAH@B@@@B@@A@ABA@@@C@CBA@AB@BA@ABAB@@ABA@A@CBA@@@@@ABA@CBG@CBA@A@@@@D@BB@@B@B@B@B@BBBBBDBB@DBDBDB@@BBBB@@BB@@B@B@B@@BB@B@@B@@BBBDBD@BBBA@@@@B@@A@@BB@@B@B@@@B@@@@B@@@BB@@BBD@BBBBB@BBB@B@BBB@B@@BBBBBDB@BBD@DB@A@BHBL@JBDDDDDBBJFFHFHDH@J@LFFH@N@H@FBBDBB@JBRBJ@BBHKR@BBBDBHHJFFDJHHHFF@FCF@JBLBDDLHFFJDDBDLN@@@B@BABA@@B@@AD@BADA@A@@BABA@ABCD@@EBABEDEBA@@@AB@BAB@@A@A@A@@@AB@@CBCB@@C@@@ABA@@@@B@B@@@B@@A@@B@BABABAB@BBB@@@BAB@B@B@@@BABB@@B@B@B@B@@@BA@@B@B@@@BABB@AD@@BB@@BB@B@B@@@@@B@@@BBB@@AB@B@B@@BBB@DB@@BBB@BB@@@B@@@B@@B@@@B@B@@B@@B@@BB@@@BB@@BB@@@@@B@@BBB@@@@B@@B@AB@@@@@BD@B@@BB@@@B@@@B@@B@@B@BB@@BB@BBBB@BB@@BB@@@B@B@@BB@B@@BBB@@@D@@@BB@@BB@@@BBB@B@@@B@@B@@@B@@@@@B@@@@@BBB@B@@@B@B@@@DB@@@@@@@B@@@@B@@BBAB@B@@@B@@BBBB@@BBBB@B@BAB@@@B@B@@@@@@B@@@@@B@@BBB@BB@@B@@@AB@@@B@@@B@@B@@BB@B@@@@@@AB@@@@@B@B@@@@@@@B@@A@@@@@@B@@@BB@@@BB@B@B@@@BB@@BB@@@B@@@@@B@@A@@B@@@B@@@BB@@B@@@@@@@BA@@@@@@@B@@B@@A@@B@@@@B@BB@@@@BB@@@B@@@@@@ABBB@@BBB@@@@BB@BB@BBB@@BBAB@@@B@@B@BB@@@B@@@@@@@B@BBBBB@@BDBBB@@B@@DB@BBB@B@@@BABBB@B@BB@BB@B@B@@@D@BBF@DBD@@@BFB@BBBBB@@@@B@@@BB@@B@@B@@@BA@@@@BB@@@B@@@BB@@BB@@B@@@@@B@@B@@@@@@@BB@@@BB@@B@@@@@@B@@B@B@@@B@@BB@@B@@BBB@@@@BB@@BD@@@DBB@@@@@@BB@@@@B@@B@B@@@BB@@@@B@@@B@@@@@@@@B@@@@@@B@@B@@B@@BB@@@@@B@BB@@B@BBB@@@@@@B@@@@BBB@@@B@B@@B@@@@BB@@BB@@BBB@@@@B@@@@BB@@B@@B@@@@@@BB@@DB@@@@@BB@@@BB@@B@@@BB@@B@@@BBB@@@BB@@BB@@@@B@B@@@@@B@@B@@B@@@@@B@@@@@B@B@@@@@BB@@@@@@@BB@B@BBB@@@@@B@@@BB@@@@@@BBB@@@@BB@@@@@B@@B@@B@@B@@B@@@B@@@@@B@@@@@@B@@@@B@@B@@B@@@@BB@@@BB@@DB@@B@BBB@BA@@BB@@B@@BBBB@D@BB@@BB@@DB@@@@B@BB@@BBBB@@BB@@BB@@@B@@B@@B@BB@@BB@@BB@BB@@@@B@BB@@@@@B@@@@@@BB@@@BB@@@BB@@BB@@@B@@@BDBBBBBBBB@@@@BB@@B@@@@@BB@@BBBBB@B@@BB@@BB@@BBBBB@@BBB@@B@@BA@@@BB@@@@@BB@@B@@@BBB@@@@@@B@@@@B@@B@@B@@@BB@BB@@@@BB@@@@@@@B@@@B@@@@@B@BB@@BB@@B@B@B@@@BB@@@FB@@@@BBBB@@BB@B@@@BB@@@@BB@@B@@@BBB@@BBB@@B@BB@BB@@BB@@B@@@B@@B@@@B@@BB@@@@B@@B@@B@@B@BBBBBBBB@@@@B@D@@BBBBBB@@BBB@B@BBBB@@JD@BBB@BB@B@B@B@@@@B@BFB@@DBFBFBB@BB@BF@@BB@@B@@@@DB@@BF@D@B@BBB@BBB@BB@@BDB@@BB@B@@BBBB@B@BB@@BDB@B@B@BBBBB@@@B@FBBDJ@BDB@@@DBD@B@@@@BBB@BBDB@B@B@BB@DDFFBBBB@BB@@BB@@@B@@BBD@@@BBBBB@@BB@@@BBBB@@B@@@B@@@@@BFBBBBBDD@@@B@B@@D@@@@B@@B@@@@B@@@@B@@@@@B@@B@@@@@B@@@@@B@@BB@@B@@BB@B@@@@@@@@@@@@@B@@@B@BA@@B@@@BB@@@@@@B@@@BBBB@BDB@BB@@BBBBDB@BB@BBBB@BDB@@B@@BB@BBBBBBBÄ[B@@@@@B@@@@AB@@@BCD@BABA@ABA@AB@@A@A@C@A@@@@@A@A@E@A@AB@@EBEBEBGBIBCBG@ABABE@ABEBE@ABC@A@CDG@A@A@A@@@@@@@A@AAGAC@E@CAC@EACAEAAAGAA@@@@@A@C@C@@@A@A@AAC@G@@@A@A@@@C@C@@@A@A@@@@@C@@@@B@@@BA@@@@B@@AB@BA@AB@@@BA@A@@BC@A@@@A@@B@@@@EBA@@@C@A@A@A@@BG@ABEAGCGAAAAAEAC@@@ABCBE@CBA@@BADAB@@ABAB@BABABCDCBA@@BCAI@E@C@@@ABA@@@A@@@A@@BCBEBGBE@E@AB@@AA@@A@A@@@A@A@A@C@C@A@@BBB@BBDBBBDBD@DBFBB@DBB@B@FBD@@@BBFB@@BBBB@@HB@@B@BBB@B@@AD@@AD@@@BBB@@@B@@@@BFBDBBBD@DBB@B@BB@@B@@@@B@BB@BBD@BBBBBBB@B@@B@@BB@@BBB@B@B@F@@@B@B@B@@@B@B@BB@@BBBB@@B@B@B@@BBB@@FDDBFDDDDDDB@@FF@B@@@BBB@@DFBBBBBBBDDBBB@@FB@@B@BBB@FB@@BB@@B@@B@@B@@BB@@@B@@BB@B@JD@@@@B@B@@@B@@B@@B@BBB@@BB@B@@@@BB@BBB@BBB@B@@BB@@@B@B@BAB@B@B@@AB@@@@@@@B@@@DB@@B@B@@@B@@BB@B@@@@@@A@@@A@@@A@A@A@@BA@@@AB@@AB@BA@@@ABC@ABCB@BABADAB@@AB@@A@@AA@A@A@A@@@A@@@AB@@BD@@@D@@@B@@@BB@BBB@@BB@B@@BB@@@@BB@@B@@B@@BB@@BBB@BB@@BBBBBB@BB@@@@@B@B@DAD@B@D@@@B@@@@BB@@@B@BAB@FAJCB@@@B@@@B@D@B@B@@@B@@@AA@A@@@AB@B@@@@@B@B@@@B@@B@@@DBB@BDB@B@@@@@BB@@@@@@B@@@@@@B@@B@@@@@@BB@@@@@@@B@@@B@@B@B@D@DAD@D@LCDAD@@@B@@@@@@B@@@@A@@B@@@@@B@@@@@B@@@B@@@@@@@B@@@@@@AB@@@@@B@@@@@@NBF@D@B@DAN@H@D@D@@@B@B@B@D@R@LAJ@B@D@D@B@B@BB@@@@@@BA@@@@@@@A@@B@@@@A@@B@@@@A@@B@@A@@@@B@@A@@B@@@@AB@@@@AB@@@@@@@@A@A@AAA@@A@AA@@@A@@@A@A@@@A@A@@@A@@@@A@IBKDCBG@ABEBEBC@CBEBC@EBCBEBCB@@@@EECIAMCK@@CKAA@@FCD@DAB@B@FA@AB@D@B@FCB@FAB@BAD@@@CMZCB@JACIPAEKNC@AA@@ADABFHA@F`EH@DLLAB@PDCMG@@@F@LAJADDFFEBBLA@IC@@@B@BYG@@@@@D@@@BBH@DBDBF@@BJaFQBAB@BBB@@@B@B@DBB@B@@FCDAD@BAF@D@J@F@HAFCDCB@DADADA@@BAB@BADEBADCBABCDEDCFGBA@ABABCB@@A@@@@DCBABABA@@DABAFEBAJMFCBAHKJKFEBEFEBCDCBABADCDCBCBABABCBADCBA@CBADCBC@A@@BA@A@@DC@@@@BA@@@A@ABA@CBADCFE@@@ABAB@B@DAFCBA@@D@BAB@B@B@DANCTGbIJAZGHAB@JCJAHCB@DADAJAJCJANEF@DAFAB@BAF@JCB@BALADAB@DADAB@DAbG\\GpKTE@@BADCBEDC@@@@@OAU@W@Y@aDABA@@LCHCHCJCF@NGHAB@FAHAHAB@B@DAD@D@B@B@B@B@@@@@@@@@@A@@@@@@@A@@B@@@@@@A@AB@@AB@B@@@BAB@D@@@BA@@B@@@B@@@@A@@@ABA@@@@@@B@@@B@@@BA@@@@@@G@GBE@GAGCCAM@I@G@C@KCGCEAEAC@CDKAICE@GDE@K@UCKAMCKEOIIEGCODONGHEBC@YE[C_CY@MCMEOCKAMAG@A@@@A@ABAAA@E@@@ABAB@@A@@@A@A@AAA@A@A@C@@@A@EAE@C@E@A@@@E@@@ABA@E@@@CDEBE@@@@@A@@@@@CACACA@@@@CAAAIAA@CA@@G@@@C@I@@@IBG@A@C@C@A@A@@BA@C@A@@@A@CAA@IA@@AACAC@A@A@A@E@GBE@A@A@AB@@A@ABA@CD@@A@A@@AA@CAEA@@AA@@GCI@G@A@C@A@AACACAC@A@EBEACACAA@A@C@C@AAA@C@ABADA@C@@@@@@@@A@A@@@AAA@A@AB@DA@C@A@@@@AA@AA@ACA@AACA@@A@A@A@CAA@E@A@@BABA@I@C@E@C@E@A@ABCBCB@@A@A@@@CAGC@@CAAACCAAEACAA@ECEAE@CBE@CAGBA@C@CAGAGAE@C@EAC@C@CA@@K@I@G@IAG@CBCB@B@B@DABA@@@A@A@CAE@M@E@EACBC@CBEBEBA@C@A@A@A@@A@@@@@AA@A@@@A@C@ABEAC@E@EBMBEBA@A@@AAACAE@C@A@G@G@AB@@ADEBCBE@C@E@GAA@ECE@GBEAE@C@CBABABCBGFC@ABABAB"],encodeOffsets:[[89529,44318]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("五家渠",{type:"FeatureCollection",features:[{type:"Feature",id:"659004",properties:{name:"五家渠市",cp:[87.54324,44.166756],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@@@A@@@A@A@@@@AA@@@A@A@@@@@A@@AA@A@@@@@A@@@AAA@A@@@@@A@@@A@@AA@@@A@@@A@@@A@@@@@AA@@A@@@@@A@@@A@@AA@A@A@@@@AC@A@A@@@A@@AA@A@A@A@@@@AA@A@C@@@AAA@C@A@@A@@AAA@A@@A@@A@@A@@A@@@@@@AA@A@A@@@A@@@A@@@A@@@@@AA@AAAGAUFAB@B@BAFA@A@@BA@@@@AA@AAA@A@A@@@AC@AAA@AIAC@AA@@@@BE@@FC@C@EBBG@CE@ALG@@@@B@@BH@BBD@B@@@@@@@@@HBD@B@B@@@@@FBDBP@HBHBD@BDP@@@B@@@@BD@@@@@@@@@B@@@@BB@B@@@@@@@B@@@@@@@@@B@@@@@B@@B@@@@B@@@@@@@B@@@@@@@B@@@@@@@BB@@@@@@B@@@@@B@@@@@B@@@@B@@B@@@@@B@@@@@@@B@@@@@@@B@@B@@@@B@@@D@@@@@BB@@@@@@B@@@@@B@@@@@@@B@@@@BB@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@B@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@@@BA@@@BD@BADGFQNEFDBJJHNBBDFHH@DAB@DAFDDFFDD@BJJ@@BD@@IF@BA@IJEFBB@B@B@BA@ABA@BB@@@BBBBBB@B@@B@B@@@D@DB@BB@B@B@BC@A@@@BBBB@BABE@@B@B@@@B@B@@B@BA@A@@BA@@BB@@BBB@@@B@B@BB@@BBB@ABAB@@@@A@A@@BA@BB@@@@BBBB@BB@BB@@@BA@A@A@@@AB@@BBBB@B@BB@BB@@@@B@B@@BBF@BBDADAF@B@@CJB@BB@B@@@B@@A@A@@B@@BB@@DADBABA@AB@@@B@A@@D@@B@@@BA@@@@@BB@@A@AB@@A@AB@@BB@@B@@BAB@B@BC@@@A@AB@D@BB@ABAD@BABD@@B@D@@ABA@A@CFAB@@ABAB@@@@EFABIJ[XABA@EBKDEB@BDF@B@B@@AB@B@@@@B@DABBBB@BBD@@A@AAA@A@@DBB@@@AD@BB@@ABCB@BDBH@D@@@BBDBD@BBGJQLMLWRSNMHKHGF@@C@@@CB@@A@@@@BBD@BC@A@A@@BBB@BA@@BDDFF@B@BA@AAAB@DDBFDBBB@ABA@AB@DBHBDAD@B@B@B@F@F@F@F@D@DABAF@FBHHFHDF@HF@F@JADBDHF@FCHBFDFCFAFCHCJAPCDGDGHAD@DDBBB@ABABADDBDE@EDCBBBDABB@DABG@G@CB@DFB@HBBDDADEBEACACBCDAFDDDDABC@CA@B@DADCDFDBBABCD@BABC@EFAFBDBDBH@FABEBEDABCAC@@DADA@G@GBCBADIBMBCDGFBDBB@B@DBDBBPAbCERAN@B@FDF@DCBAJ@DA@C@G@G@AH@L@Z@l@H@L@B@@DGNCJGLABAFCBBDHDBBABAFADAJAHBFSFA`GFABGX_NWR]BAHB@@@C@@@A@@@A@AB@FI@@@A@@@A@AAA@A@@@CBA@AB@@CBABC@ADEBC@ABAAA@@AC@C@AAC@AAAACAAAA@A@@AA@A@@BC@@@ABA@@@@@A@@@@BA@ADA@AB@D@B@B@B@BAD@DAJAHCJAHEHEHCBEBCBEBCPCLA@A@@@@@A@@@@BA@@@@@@@A@@@@@@BABC@A@@BA@AA@@A@@@@@A@@B@@A@@@A@C@@BA@A@CBA@C@@DEBC@ABCBA@A@A@@@@@@@@~A@EAO@HCDA@@@@D@FCBADABAFC@@DABAFC@@@E@GA_@M@GAWAaAc@]@A@@A@CCQMSOBKBIOG@@@AB@@@@AB@@@@A@@@@B@@A@@@@@@@@BA@@@@@@@A@@@@B@@@@A@@@@@@@@@A@@B@@@@@@A@@@@@@gYAASMOKIBAB@@@@A@@BAB@@@B@@@@@B@@A@@@CB@@AB@@C@AB@@A@@BA@@@@D@B@B@B@B@DBD@B@DBB@D@B@B@@@@BDB@@@@B@@EBKDE@A@IDE@AB@@IBC@CBE@A@A@ABG@@@@B@@B@@B@@B@@BBB@B@@@BA@@BA@@B@@@@AB@@@@@@@@@B@@A@@B@@@B@@@@A@@@@@@B@B@@A@@@@@A@@B@AA@@AAA@@A@AAAA@@AA@@@@@@AA@@A@@AA@AAA@@AA@@@@@@AA@BAA@@@@A@@@A@@A@@@@@@@@A@ABA@@@A@A@A@@@A@A@@@A@A@@@A@AAA@A@A@A@A@@@A@A@A@@@A@@@A@C@@@A@@@@@A@@@@BA@ABA@A@@@@@@@@@AA@@@@@AA@@@A@@@@@A@@@@@A@@@@AA@@@@@@A@@A@@@AA@@@@A@@@@@A@@@@@A@@@@@A@@@@@AA@@@@@@A@@A@@@@A@AA@@AAA@@@@@A@@AA@@@@@A@@@@@@@A@@@AA@@A@@@AA@@@@@@@@@@@@@A@A@CB@@@AAA@A@A@AA@@AAC@@@A@AAA@@@A@A@A@A@@@A@A@AB@D@B@@@@@@@@AB@@@BA@@@@@@@A@@@@A@@@AA@@@@A@@@@A@@@A@AAA@A@@@C@C@A@@@@@AAC@EAA@A@CAA@@@@@A@C@C@E@A@@@@DA@ADAFEDBFECABG@AB@@AB@B@@@@AA@@A@A@C@C@@@E@A@A@@@@@A@@@A@@@AA@@@AB@@@@@@A@@@@B@@@@@B@@@@A@@@@@@@AB@@@@@@@@@B@BB@@B@@@@A@A@@@A@@@A@@@ABA@A@@AA@@@@A@@@@A@@@@@@@@A@@@@B@@@B@B@@@@@@@@@A@@@@@A@@@@A@@@@@@@A@@@@@@@@B@@@@A@@@AA@B@@A@@A@@@A@AA@@A@@@@A@A@A@@A@@AA@A@A@C@A@A@AB@@@@AA@@@@@@A@A@A@A@A@@@A@@@A@@@@@@@@A@@A@@@@C@@A@A@@@A@@B@@@@AB@@@AA@@@@@@AA@@@AB@@@AA@@@@@A@@@@@A@@@@@@@@@@@@@@@A@@@A@A@@A@AA@E@"],encodeOffsets:[[89629,45152]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("伊犁哈萨克自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"654002",properties:{name:"伊宁市",cp:[81.27795,43.908558],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@YF_J]H[BMB@@S@KBKBGDGDC@EDE@AA@EC@GHC@GBDDBDADDBAD@FDBFAH@ABPAAXPBEJ@@EACBQAKAKEWICAGEEACAE@A@ABC@CBA@ABABA@E@A@AAA@ECFRHT@DBDDJ@BFN@BBDBF@DBDBDBFBDA@A@A@A@A@AA@@A@@AA@@@@AA@G@C@ABA@A@@@@@DFBB@B@BTRZZLLJNTz\\pNB`HADA@ABABA@CBABCDCBAH@FB@FJ@DB@BVH`DBBBJ@DBBBB@@BBD@DAF@F@@B@D@B@B@@@@BDD@BBBBBB@ADAB@BABAB@D@DDJ@BBD@HBF@B@B@@AB@B@BB@@B@@@DABBDBB@BB@@H@B@DBD@BBBBD@@@BB@DFBDBBBB@@BB@@B@DD@BB@B@@B@@@B@@BB@B@BBB@F@@@B@@@BBBB@@BBBB@BDB@B@@BB@FBBBB@@B@BA@BD@@BBB@@B@B@@@B@@@@AB@@@DBB@@B@B@@@@BBD@B@B@DAB@BA@BJBD@BB@@@BBB@BD@@BBBBDDLFFDBBHDDBBBBBBBB@B@B@D@B@D@@@B@@B@@DBBBB@BB@BB@@BDAB@@@DBDB@@@BBB@@DAD@@ABAB@B@B@B@@@BA@@D@HAB@@@B@DA@@DBBA@@D@B@@@B@B@B@@@@@D@BAD@BA@@D@BBB@D@FB@@BB@@B@BB@AB@BA@@B@B@BABBB@@@BB@@@@B@B@B@BBB@B@BABB@@BBB@B@BB@@FDDBFBDBD@@@BBDD@@@D@@AB@B@@@B@B@B@B@D@@@@A@C@@@@AA@A@@BAB@@@B@DBBBBBBBB@B@BBBBD@@DBBDB@B@B@@B@BBBBB@DB@B@B@@B@@@B@@BBBBBB@BB@BB@@BA@@BB@@@@BAB@@@BBD@B@@@BB@BDB@@BAB@BBBB@@@BBBBBAB@B@B@@@BAF@FBBAB@B@B@@@@ADAB@B@@BAB@D@D@DCB@BA@@BA@A@A@A@AB@@ABA@ABABA@@BCA@@A@A@C@@DCBCDAB@DC@ABABE@ADADC@@B@B@@ABA@A@ABA@@BA@@BABAB@B@@ABC@@BA@@@ABAAA@AAAAA@C@ABA@@BAB@B@@@BABAHABABA@@@A@AAC@AA@@AAAAACAA@@@C@E@AAAAA@AAAAA@A@CBA@@@A@@@AA@@GA@ACA@@CA@@A@ABA@ABA@@@@AA@@AAAC@EAC@CAA@CA@@A@A@@AE@C@A@A@AAEAA@C@@AA@AAA@A@A@A@@@AAA@@@A@@@AAABA@AAA@@@A@@@@@C@@@A@@BA@C@A@A@AAA@A@C@A@CAAA@@AAA@@@CAA@A@A@A@C@C@AAC@@@AA@@A@C@A@@A@@@AA@A@@@AA@A@E@A@AAAAEAA@@@@AA@A@@AA@@@A@@CEAA@AA@AAA@AAA@AAAAA@@@@A@A@@@AAC@@@A@A@A@@@A@A@@@AAAAA@A@CAACE@C@CAA@A@A@AAA@A@@@A@@@@B@BAAA@A@@AC@@@@AAA@@A@C@ABA@AA@@GA@CAACAA@AA@@G@CAACI@AAG@@BC@@AG@C@CAGAAAG@A@AAE@@IIAAEIAE@@BCAC@AAA@AAAGGECOOA@CGCA@@@CCGAGCEAAAC@@@ABABABEFCBA@ADCDCB@@AB@FBDBB@B@BBB@FBB@BAD@BBXD@@BF@D@BBDBB@B@DAB@DBDBH@DBD@BB@B@CK@ABC@C@GBCDEBAF@^@@@F@D@DA@@FBJBHBFBDDD@BAD@JFD@BADDD@DB@B@BD@B@D@@B@BB@HFB@BABABCJAN@L@BAGACABA@AJGFAHAHCH@RCB@J@DAFADDJBB@FDXRJFDDB@B@BA@A@CAAAA@AAA@AA@AA@A@@@AA@AAA@@A@@CAAAACAA@A@@@@DCLGBCB@FACCA@EE@AAA@AA@@@@@@IA@@@@@B@AMBA@EIC@ACCCGGOAA@@CGA@AECIBA@@@@B@NCTARCAAKEAAEEA@A@A@CB@@A@@@@@A@@@A@@@@A@@@AA@@AAA@AA@@AA@@CA@@@A@CAAAA@@@E@G@A@A@C@C@A@@@GCA@ABA@AAEAC@@@A@@AAA@@CA@@ACA@@CAA@@@CA@@A@@CAA@GAA@EAA@@@@@A@@G@GACB@B@D@DAFADAB@BA@@@@@AAA@@@A@AAA@@@A@@@AAA@@@A@AA@@AA@@AAAACLGDDDC@@@AA@@A@@@@B@BAB@B@BBBDFF@@B@B@@@B@@@@B@@@B@@@B@@@B@@B@@BBB@@BB@@C@ABABAB@BC@ABCD@B@@B@H@@@@B@BC@@D@@DBB@B@FBB@D@LC@@@AACAACCAAAA@E@A@AA@AAEC@@@A@@@A@CD@F@@A@CA@BABADA@@DB@@@BBB@D@@@B@B@B@B@B@@BB@B@@ABED@B@BBDBDBDB@BD@@D@DA@@B@@AH@@@BBBBFF@B@BPAEECA@AA@BC@CAG@AAA@@@@C@@@@@A@@AABA@AACAAAA@@@AC@@@@CC@@@@@A@@@ABCB@JCFAD@DAB@@@B@B@B@D@B@BB@BBB@B@B@@@@@BD@DBBBF@FAH@@@BA@A@@BC@@@@BAB@B@B@BB@@B@BBDBB@DBB@BBDAB@B@BBB@B@BADAB@BAB@B@B@DAB@DAB@B@A@@@AAGAICA@A@AACCA@CACAGCICCCA@AAC@@AA@CAAAAAAE@@@CB@@@AAAAAA@@CAAA@A@@AA@@@@@A@A@@@@AAACAAAA@A@@@AAA@@ACAC@AA@@AAAAAA@CCC@GDCBC@GBC@IJEBCBCBCBEDEBC@C@EAE@A@@@A@A@C@CDOJCB@@GDCD@D@DEFA@KBE@E@EACDC@CAABAAE@@@@@C@ABC@C@ABCBKBCBCBCBEBA@A@EBADAB@BA@A@C@E@LWMDA_@CECIAMDCFIACBEECBC@EBAD@@AB@KA@AAAAIBKA@EAAC@CBK@ACABE@GCEAEB@DBBADE@E@ABACKB@DC@A@ABEJ@@LBB@@@D@TFFAPDDEDAFBBABABADDHAL@BBABFDDBK@IDBBMBIB@BKH"],encodeOffsets:[[83187,44927]]}},{type:"Feature",id:"654004",properties:{name:"霍尔果斯市",cp:[80.411271,44.213941],childNum:1},geometry:{type:"Polygon",coordinates:["@@OJMLgZMPGHCL@FV^DPANCTEb@`DRBXFRHhDVAHADD@BB@DAFA`EPCDA@CLEDCFCNAH@BAJADAHBJ@BCHALAF@HAL@F@D@NAPAFEJCL@D@J@FBB@@AJCB@FAHCDCHALENCHAJADKNAB@F@@ABADCDEFAB@B@@BJBFABAFAD@F@@CD@@BFDFNJHFBBDHHJ@HBBBBBD@FCJADABBFFD@HBBDBBDFHBJ@FDDFNB@@BB@@DAB@FDBHBDB@B@@@J@@@J@FDH@@AD@B@DCFDDJDFDNLBD@DBBBDEDABA@EJCHCFCF@BEBGDCDA@ABED@@AFABGFGLILA@@BA@A@A@GDMJINCHCDABAB@F@BC@AF@BA@@B@@A@@B@@A@@B@@@BABE@EBGHGDIFEDEDC@GBODG@EBKBCDGBABEBA@CBCBSFEBE@C@SBE@IDMF@@MJGDCJWTMR@JBLGLSTIPGN@BHNFPBLBNDCH@J@L@P@N@RBLFHDNHFFD@@B@@@@@B@@H@DBB@BDB@DBFBH@BAB@@AB@HC@@D@HAJ@B@DABCBA@AHC@@F@BADABA@A@@@AAA@@AA@@EAA@AC@@A@@ADEFCHCFCHAFBNBJDJDLDNBN@F@@ABABABA@ABA@ABA@AA@A@CACCAC@@BA@AB@DABAB@@@B@DCB@@@BAB@@@D@B@@@BE@A@A@@BCBAB@@ABA@AB@@A@A@@FA@AB@B@BCRIHCJCJC\\GRCPAPCNCJALCJCHGHGHEHETEbERAXAHATCVATCVAXCD@B@@AB@LAB@B@D@B@FBBBB@BBBDBBBBDDD@FBBAF@DABADABAB@DAJ@D@DBD@DBBBF@@@@ABCBABCBA@E@A@@BA@@@AAA@AAAAC@A@A@AD@D@HCDABCB@@@N@HAB@BABADABAB@@A@ABABABA@E@ABABCBA@A@@A@A@@@@AAA@@@AFC@@BAFAB@BCHCJCB@F@@@@@@C@ABA@@@@AAA@CC@@@A@AA@@A@CBCBAAAEAAAAA@@@CAE@A@AAAEAAAAA@A@A@@GCCAE@C@A@A@AAAAA@AA@AAC@ABE@AJ_^ebadI`AXM\\YHÃ¥īUsOK][AAGSGWMYiUEBCAAC@GDCAK@Y@EiCMAAAEE@UBAEKUABCIE@MBSA@B@HGJUH[aCQCC@C@C@C@AA@@@@@A@@B@A@@AB@@A@A@@@A@@@@@@AACABAAEAC@A@AB@@CBA@ABAF@B@AC@A@A@AAAAAAACC@AAAGEAGBMBIEO@EEQA@@EVKDCAHO^}DC}LsLIR@F@BGbAVIAYGEAOD@RqAANELADAHCAEAYGAAEC"],encodeOffsets:[[82372,45142]]}},{type:"Feature",id:"654003",properties:{name:"奎屯市",cp:[84.903267,44.426529],childNum:1},geometry:{type:"Polygon",coordinates:["@@iBiBBQ@BKBO@@@@BDBJ@DBBBB@@PAFBDAD@J@F@@@D@BBD@BDH@BBB@BBBBDBB@@BBDD@BJHHDNJDDPPBDDB@@@BBBFBHDHDNLLLLLBDHP@FCD@D@DGH@B@HABCJ@FHL@DAH@DFFBBAB@DADABCNNB@LÊT¸ªTBFBX@LAH@T@F@F@BBX@VBP@VDD@dFNDXADBD@BA@AB@BAB@BA@@@AB@@A@@@AB@@@BA@@@A@@@@B@BA@A@A@@D@@AF@@A@AAA@ABA@AB@@@@@B@@@B@@A@@D@BA@@@@@@@A@A@@@AD@BABA@AA@@@@AB@DA@ABA@@@A@@@AB@BA@@B@BAB@@@@@@A@@HCBGBA@C@@@A@@BCAA@CAC@CAABCAA@CBEEA@CA@@ABA@@@AEC@A@EDAAAACB@@EA@A@CCB@@A@AC@@AAA@CBC@AA@@CBEAA@CBEDABAB@@EBA@AAE@GBCBABBDA@@EC@AHE@A@A@CBCDC@A@CBADGBAJAHC@A@CCGBA@EA@@ADAACGBQBK@K@A@KBO@AAA@@AAC@A@A@A@@@AAC@A@@AC@A@@@A@C@C@@ACAG@AAA@CCCBCAA@CAC@EACECAC@AAC@C@C@A@A@AAC@C@ACKAKAE@ECGBCAEAE@AAG@CAE@AAMAA@GAE@C@AAEAEAIAI@E@C@C@A@@ACBC@A@AAA@C@CAC@@@@@@CAAB@@A@A@A@E@EBMD@B@@yPQFUFyRSFVGB}TODWHKBGBIBBU@Q@A@@@"],encodeOffsets:[[86952,45452]]}},{type:"Feature",id:"654022",properties:{name:"察布查尔锡伯自治县",cp:[81.151337,43.840726],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@yOEAEAIAIP@BAB@BABMLABOHC@IFEBCB_RCBEHHFDBDBDBJBZJBY²GCMFKRY"],["@@FIBAB@D@@CLABDBAF@F@BCAA@CFAFBHDF@BABDL@DAD@BB@FLBJABBBBB@@LBA@@BCFAD@DAFFDAJBDENCJBFD@DB`NCKXF@D@B@B@@ABABCFAB@B@FADADADALADABAD@D@BAD@@@@@F@BBBADBD@DCFBF@F@LAB@FE@C@CDCHC@@DAPIDCD@B@B@@@B@F@FBD@D@FAFCDADADAFAJID@HAD@DAHCD@FABABABA@A@CBABA@CDAFCBCBABCBABABAFADABABA@ABAD@DADADAFAD@DCDGFCDCHCFA@AB@HAHAB@D@DABABAAC@@@ADADA@@BE@CBABABAB@H@BAD@B@BABA@CDA@AFEBAB@D@D@JBD@FBB@B@BABA@A@ABA@ABCDABCBC@A@A@@BAB@FAB@BAAA@@CC@A@@@ABCDCBABAD@BABG@CB@DAJCB@BADADABC@ADCBAB@DADAJGBAF@BADA@AAE@A@ABA@AB@D@DAB@FEDCHEB@B@B@@BB@F@B@D@B@BC@A@AAA@A@CB@@AF@DAD@@ADEBCFIBA@ABE@@BAB@@ABAB@BAB@B@DCD@J@DANCFADAH@DAFCDAHCDAFCB@HAB@D@FCB@DAB@DADAFCDEDCBCBE@@AAA@AACCA@@@A@@AAAA@@A@@AA@@@A@A@@AA@@A@@AA@@@AA@@@@@A@@@C@@@A@@BA@AAA@@@A@@AC@@@AAAAA@AAC@AA@@A@@@A@ABABA@@@A@A@AB@@A@@@A@@@A@C@@BA@@@AA@@A@A@A@AAAC@CA@@ACA@@@C@CCA@AAA@CAC@AA@@AAA@A@A@@AC@CAC@@AA@C@AAA@AAEACAA@@@AAAAA@@AAA@@@AEA@ACC@AA@@@A@@AAA@@GCGCCAAAAAAAA@@@AAC@A@EACAAAA@@@AAAA@@A@@@A@CA@@@@A@@AAA@A@AAAAAEAEEAAAAACCA@@AAEA@@AA@A@AAAAA@@@A@A@A@AAAAA@ABA@CBA@C@A@A@@@A@AA@@A@@@ACCAAA@AA@@@AAA@A@EAA@A@@C@@AAAAA@@BA@ADA@A@A@ADC@A@A@AAC@AECAAAE@A@AAACAAC@@AEAA@@CAAAA@C@AA@@AA@A@@ACA@@CAA@@@AAA@C@AAC@A@A@A@A@ABA@AB@@A@@ACAAA@CAA@A@AAC@A@C@AACAAA@@CAA@AAA@ADA@@@A@AAAACAAACA@@I@G@GCIAA@I@IAIAA@I@GCGCA@IAIAIAC@IBI@GAI@ACCEAAEEEEE@K@@@GFEFEBGDE@I@C@IAIAGEEC@AEEEEAACEA@IAC@IAGDA@EBIDA@IDGDA@IDGBEBC@@@AACCEBC@C@ABA@A@@AI@E@A@AB@@C@CAA@A@AA@@AAA@@@@C@@CAAA@@EC@AA@AA@@A@AB@@A@A@AA@@CEAAEEGCCAI@GDIDGDC@I@I@GAI@IAE@ICACCEECEEIAICE@IBEBGFA@GFGFEBGBCBI@GAICA@CAEBC@ACC@G@A@A@CB@@A@AAG@EBC@C@CBA@CFCBIBC@AB@BABABA@C@ABA@CACACAC@AAA@AAAAA@CBCBABABC@@@CACAC@EAA@CB@@AAC@A@A@A@EB@@CAC@E@GB@@ABE@A@C@CAA@E@CAKCCAA@@C@C@C@A@@AACC@AAA@A@@@ADA@A@AAEBC@C@@@AAAA@AA@ACCAAAACAAA@A@@@ADA@@BC@CAAA@AAC@@AA@AAC@CACC@AA@@CAC@A@AAA@@BA@C@@BA@C@@ACEEECEEGCAAICC@IAGAG@IBABIBIDI@I@E@IAICIACCIAAAA@CAICCAIAIAGBI@IBIDGBID@@I@IBGAICGCICGCCAICGCA@I@E@C@EBIDCFEFCFABEDKNGBI@ECOISGC@EB@F@DAHABGF@@QFqA_@QBWDSHKLANLPTPDLDHNNNLHJFDFFJJ@@DFBH@FBLF\\BF@PDNBFDF^PK¢ª¯Ú|J\\BR@fJJ@LBZJJDLH^FPCLAHOrA@@BABAHCHAFAJCHAHCHAHAHAN@J@DAFHFHFFJFF@BDDBB@@FBMHIFCB_@@@g@OHAB@FB@BDQJOFABIFOFEDOHMF@BBFHFHHDDDJHPDDPJBFAHBDBBFAFADCFCD@HBDB@DCF@D@BFBFAHCR@H@@BDEDEFGDEHIDEFIHIFIJBNBN@DB@@F@F@PBNBPBNBFBN@DBD@BBPDJB@F@@@@^C~EzEVAB@FA\\AhC@@lC\\C`@@G@AB@L@P@HBD@AADC@KP@N@@D@BB@NADJBHFXVAJ@N@P@B@@@@@N@B@LF@@@@DBTAJB@@BBD@A@A@ABA@A@A@A@@A@@A@@@@@@BABDB@BA@A@AB@BCBC@@ACBABA@CACBAACB@B@FEDGH@@AB@BC@AB@B@@S@EFA@@@A@CBCBA@CDC@EBCAACCCA@A@A@@A@E@C@@C@AB@CCA@A@A@@AAC@AAA@@@@@AAA@A@A@A@@@ADC@GBABB@ABC@CAC@AAAAA@AB@@A@A@A@@B@@AB@B@@@B@B@@ABAAA@C@@AAC@AAA@ACACAAABA@A@@@AA@@@A@@@AAABA@@@A@A@@B@BA@@BA@@B@@A@@@@@CB@BA@A@@@A@ABA@EFCBAD@BCBABA@AAAAAAA@AB@@@@@B@B@@B@ABABB@@BABA@C@CBAACAA@AAAACAE@G@A@C@C@ABEFGFCAE@EAC@MCKAMAKCCD@@ADABA@@DA@@BABCAA@ABA@EBA@C@A@A@A@A@C@CBA@@B@B@@BBDBAB@B@BA@@BE@E@C@@BGCC@@BADAD@BEDA@E@A@@@ABAB@@ABA@ABC@@@@@@@@@A@@BBB@BC@A@@BA@ACBAB@@@@AA@CB@@ABA@AABA@@A@@BCBA@A@ACAQAK}DACG@C@C@CAC@AAAAA@G@HLE@G@B@BDFFHLBD@DBJBBHBHBH@DDBBFJBFBBDDBDFBFAFCFGDAD@J@JCNCDBF@JHDDDDJFDDHADCHGFCFCB@LAR@J@NEXEJEDCB@HALERG@AB@BABABAB@@@BAJCLCL@TBR@FABBB@H@F@JAD@FABADAFCB@DAF@B@D@D@B@BBDBDBF@D@HBD@B@@@D@@@F@D@H@DBB@BB@BFBFDB@BBB@F@B@BABAB@DAD@BAB@F@DBFBHFDBXJLFLBRBDAFB@@FIOABWOBBAG@EBCA@EBCCABCACCCHAD@HGD@@FBBF@FCD@HCHCLALAT@@@NA\\A^G`IZE@@B@LG@AJANAAAJCL@CAECBAAAK@GBCCABABABEACBCFOCEBSEC@@@A@KA@@"]],encodeOffsets:[[[82607,44804]],[[83158,44912]]]}},{type:"Feature",id:"654021",properties:{name:"伊宁县",cp:[81.52745,43.977119],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@K@CAEAEAAAACACAAGAC@CACEACAAAAA@I@E@CBKBCBA@ABE@ADE@ABGDABABABA@@@AAA@ABAB@@@B@B@@AB@@CBCBA@C@ABADAFADCDCFEDCBCBA@CBA@EDC@A@GBA@EDCBGDCBEDCBG@CBEBMDCBI@C@CDA@A@ABA@AB@BA@AB@@AF@BABEJADCF@BC@CBE@@BA@@D@BBB@B@BADA@C@A@E@A@@AA@A@A@GFCDEFA@CBC@A@@BAB@B@BBF@BCBABE@ABIHCBCBA@ABCD@BADCBCBABA@IDCBA@@DAHABC@ABABCDAD@B@@@BDD@@BBABA@EBA@AB@@@B@BADADCBAD@BAB@B@BABABA@A@EAC@IAC@C@A@ABEF@BCB@DABABA@C@ABG@A@ABABAB@DAF@@CBCB@B@@BDABABCBC@A@GBGBA@@BEBGDCDEDCHCDC@EBCBCBCBC@AB@BABABCBEBABABABADABADEDCB@DABAB@D@BABABABEBDDB@BBBB@BB@@BBDBD@@BB@B@@@BBBBBBDBB@@@@@B@B@@@@BB@@@BBBDB@@BBBBBB@@A@@D@@BFBBBBDBB@@BD@BBB@DDJDHDDBDBB@DDBBB@B@JDHBBB@@B@A@A@CBA@CBA@A@A@ABA@CBABA@A@AAA@A@CBAAA@CAA@CAAAA@@@AAA@A@A@AB@@@@AD@@@BAB@@G@EBE@AACAC@@A@@@@@A@AAA@AAAA@C@A@A@A@@@A@CBC@EBIDA@AD@B@@@B@@@@DD@@@@BD@@B@BBDBBBB@BA@BB@@@@@D@@@@@BB@BBH@DADB@@BDBFFOB@A@AEEAAAA@@G@@BA@@@CBC@@@ACA@ACACAC@A@AFCBA@@@AAA@@@A@A@A@A@A@@@CAA@A@@CA@@CBABABB@@D@BE@C@@D@B@@@B@@FDBBB@@B@B@FBBBBDDBBBD@B@@KDC@A@EAA@A@CA@@@CD@@A@A@@G@A@@@@ADCBAD@@ABABABAD@@@AA@@AA@AA@@@@A@@@A@@@A@@@A@@A@@@A@A@@@EEACAAA@A@ABA@@@@@@BB@@B@@CDCCKHBDBB@BB@@BB@@B@B@@BB@B@@@B@@BB@B@B@@BB@B@@@@ABA@CBEBCBC@A@A@BD@H@HB@@@@@B@FBB@HBB@DB@@@BB@@D@@BB@DB@BD@@DB@@BB@BB@@@D@FBBBB@BAB@HD@@B@D@D@B@B@H@F@@@B@BBDBB@@@B@@DB@@BB@@BBB@BB@@B@@@B@@B@@@B@@@@@B@@@DAB@B@B@FFBBLFBBQDSBMDA@@@@@ABDJBFB@DH@@BBHPDHDD@BJD@FABBNA@@@@@B@@J@@@@B@@BBB@BFFB@DDEBA@ADKHCD@@@@@BBBBDBBDB@@@BB@BBB@@B@@@BBBB@@BBB@BBBBB@D@BABA@A@CCIEWQECA@IACCEBCBI@A@QDG@GDGBEBIH@BABDBHBABK@M@IBADABABA@GEA@@A@AC@A@C@@A@ACAC@CCABC@IEC@ABC@CCEAGAIAEA@@CBC@E@@@]@E@ABCFAD@H@DAD@BDLA@A@@AAC@CAGAC@CBA@C@AAAAC@A@CAE@@WCAAC@ABA@EAA@AAA@A@CAEAA@@BA@CDCD@BABEDAFABAB@B@@BDBBDFBHDH@D@@DBDHB@PPFDHHBB@BBB@BBDAD@@BFFJBBJJ@@BF@B@BBHBBBH@D@DBH@@AD@@BH@BDJBB@D@HB@@BBBBDDBB@@HB@@BAB@B@D@BB@BB@@@@BD@@@BBBABA@@@@@@B@@@BBB@B@B@BBB@D@DDFBB@D@BBBBB@B@@@B@B@@@B@B@B@@BD@B@@@B@B@@B@BBBBB@BBB@BBB@@BBBDF@@@B@@BB@@@BBB@@@@BBBFBB@B@B@F@BBB@@B@B@@B@@@BB@D@B@@@BB@@D@BBD@D@B@B@B@B@DB@@B@BB@@BBDBB@D@B@B@BBB@B@D@B@@AB@@@D@@@@@B@@@B@BBB@BABB@@B@@@B@BB@@B@B@B@B@BBB@@BD@B@FBBBB@B@D@F@@BB@B@@@DBB@DBD@FBD@BB@BB@@B@@B@BAB@BAB@@@DB@@DB@BHB@@BB@@B@@@B@DAB@B@BBBBB@BBBBF@D@@@B@DBBBBB@BB@@BBD@B@B@@ABABGBABAB@@A@A@AB@@AB@B@DBBBB@BBBAB@B@@AB@@AD@BA@A@ABAB@@AB@@AB@B@BAB@BA@A@@@CDCB@BAFAB@BCDA@CBADCD@@@D@B@BB@AD@@ABAB@BAB@BA@@B@B@B@BAB@@ABA@CDC@C@A@ABABA@@BABAB@BABC@CBABCB@@A@A@ABABABADA@A@C@AAEBA@A@GACCC@A@@@C@ABA@@D@@@@ABAB@@@B@@@B@B@@A@@BBBA@@@@@A@A@@@BB@@@@@BA@ABA@AB@@@@BB@B@@ABA@AB@@AAAB@@@BABCFA@AB@B@BABABA@A@A@@B@BCDAD@@@D@B@D@FAF@DABB@@BFFBBBBDJ@B@BABABABA@@BB@B@@DEDDBDBDDB@FBB@BBBBBDBBDDBB@B@@@D@B@BB@BBDBFBFB@@@BAB@BB@@@@@D@@@ABCB@BAD@BBD@B@B@@BB@@BBDBB@@B@BBB@@BD@@@B@B@@A@AB@BABABA@ABC@A@@BA@@DABBDBB@@AD@B@BADB@@BB@B@B@B@@@AB@BB@B@@@@BB@@@B@DBBBB@B@D@DB@@BB@BB@DBB@B@BAB@@B@@B@BA@@D@B@B@BCDABD@@HABADB@@@A@A@@B@DBB@B@DAFAB@BCB@@AB@B@B@BAB@DAD@B@F@D@B@BBB@B@BBBDB@DBFBDBFBB@B@DAFABABBD@B@B@DBFFBB@@B@BA@@BA@A@CBA@@@@FCBAHCBAJCB@BA@AD@FAB@B@@A@A@BB@D@H@F@B@FBBB@@B@DB@@B@D@B@D@H@B@D@@@BAFCDABAB@D@F@DBB@B@BABABADCF@BAB@BAB@DAB@B@B@BBFBB@D@H@D@DAB@FADA@@@@AC@@@A@C@AB@@@B@F@D@D@B@F@@@D@B@D@BCB@B@B@@@@@BB@D@B@B@@D@B@@B@@@@@D@B@@@@B@D@@@B@D@H@B@DBF@JBD@B@B@BABABA@@D@BAB@BCBAB@CAA@A@@A@A@A@AAADABC@@@AHABAB@DG@@B@B@DBDAB@B@BAB@B@B@B@B@B@BE@@B@DAB@@@@EAA@AAAAAAADA@AACAA@@@A@@D@B@F@FABAD@@@D@B@@B@BDDBBBBDBD@DBD@BA@@DBD@F@D@F@BBB@D@B@B@HBD@FAB@BA@@B@B@D@D@@@BAAAAE@@AAEAA@@@AA@@@@BA@@AA@A@@@A@ABAJC@@B@@@@@AAC@C@@A@@@@BABABA@ADC@A@ABA@@@@D@B@B@FC@@HBB@B@@@B@@AB@@@D@DBD@B@@A@@@A@@BA@@B@B@@@B@@AA@@A@C@@AA@@@@BA@@@@AA@@@ABA@ADABABADABAB@F@D@B@B@@A@@F@B@B@B@BAB@BAD@DBDBBB@BB@HDFDB@B@B@DAB@DC@@@@BA@CB@DBB@DBB@B@BAB@@@@C@A@ADA@@D@D@D@@@B@BAD@BA@@B@BA@@@ABA@CB@H@D@@B@B@@@@B@B@B@BAHA@AB@@A@A@A@@BAFCDAB@D@F@B@B@D@B@B@DDJAB@@BDBD@D@B@B@@@@AB@BA@@DC@@BAHENCNEPETERAPCPARCXC^GPCTCJCHALALALDL@H@FCHAFA@@BAFA@@D@DABCBE@C@C@C@I@C@EAABA@EAG@CAG@CAG@CAA@A@A@AAAAC@A@AA@BA@@BABA@@BAE@C@DA@A@CCEACCCCG@A@@BC@@DABAB@BAD@B@@@B@B@@ADADC@@BCD@BAD@FAB@B@BA@AB@D@DABAB@B@BADAD@BAB@FAFAB@B@D@@@@AB@@AD@@AA@@@AAAA@@@C@C@CHEBIDC@AKEAECA@A@C@ACEDAHCBABA@A@AAAAA@@@AAA@A@AAAAA@A@ABAACAA@A@@CCAA@@@C@C@@AC@@@AB@@A@C@@@A@ADABADA@ABC@C@A@AAC@AAA@C@ABA@ADA@@BAB@@A@ACCAAA@CAA@ACAAEAEEAA@ACAAACAC@ACA@CCCAAAEC@AB@BC@A@AEEAAAABE@MAE@E@G@CAAAACCCC@AAA@M@CDGBEACAACCAABE@AAAACEAEACCACEA@A@@@@@AA@AA@@@@A@A@@AA@A@AAA@A@@@AAA@@@A@A@@@A@C@@AA@A@AAA@AACC@@@AAAA@A@AACAAAA@AA@ACA@@AAAA@@A@@A@@@@CA@AA@AAA@A@@ABABGB@BCPADAB@D@D@H@@@DABADA@@@@BAAABC@AB@BADGAA@ABA@@B@@AD@@AB@@AAIACAA@A@CACAG@CAA@A@AAAAA@CAA@A@@@AB@F@B@D@DBB@FAB@@@@A@C@A@@A@@A@@AA@A@AB@B@@@@@@C@AAI@IBA@A@@B@@AD@@@@@@AA@C@@@@@@A@EB@@@HAB@B@B@BAB@@@B@@@B@BA@@B@B@BAAA@A@A@@A@@@@@AACACCCCA@AAAAC@@AA@@@@@A@AAA@@@@@@@@G@AAAA@@A@AB@@AB@D@BADA@@DA@@DC@@BA@ABA@ABABA@ABA@@@A@@AA@AAC@@@C@A@AAA@@A@@AB@@ABAD@@@@ABA@@B@@@HDFBB@B@D@D@@AFADC@AAA@A@@@AB@B@B@B@DBDBB@D@F@BBD@HD@BD@B@@AB@@@@A@@ECAA@A@@BABABAB@@@BB@@@BCB@@@B@@B@B@B@BABCB@BABBB@BBBBBBDBBB@@B@B@DCDA@@B@@@BB@B@B@B@B@DBDDBBD@BBBB@B@B@B@@@B@@A@AAEAAB@@A@@BAB@B@BBFDDBB@F@BADA@@B@B@BBBBBB@@DB@BBB@BD@B@B@F@B@BADAB@D@BABB@@D@FBJ@F@B@BAB@@@BBB@H@B@@BBBB@B@B@@@BA@A@ABADABAB@D@B@BAFCD@@ABAB@BAD@DCB@B@B@B@B@B@BA@CB@@AD@JCB@D@B@FAB@BBBBB@B@B@BAB@BC@@@@BA@AB@@C@@AEAEAECGAC@A@ABEBABKDC@@B@{UE@]JiABQB]HQBQLADYNoFi@WBGIYYEUH]\\aTKDAdO`SDAdYHGTWBAtSUTF\\AFULSAAEGCEIKEGCEF[J]tOZC^CpBfBVJLDhO@AB@DACC@@CAEACAC@CBCBC@CBCDAFABA@CBC@A@CAGEGCAAAACCAAAAA@A@@BCHABABC@C@A@C@AAA@AAA@CBC@ABABA@AAAGAA@AA@AAE@ABABAB@BA@C@C@EAC@A@CBCBA@AA@AAAAA@@A@A@@B@BABA@CBA@C@CACAA@GB@BABABA@A@A@@@AA@@BE@AAA@ACAAAA@C@ABA@ADADCBC@ABCAAAAAACAAC@A@A@AB@B@D@BABA@CBCAEACACBABABA@CCABA@ABCFABCBA@A@EAA@C@C@ABABA@A@G@A@ACCAG@CAAA@@@CAA@@A@AAABABABA@C@AAC@A@A@ABAFADABAB@B@F@BAB@@A@AAAAA@@@A@ABEDC@CAECAAC@A@CDA@E@C@CAA@ABABABABA@CAC@ECCACAC@C@CDCBC@CAA@CBAB@D@BBBBB@D@BABCBC@C@C@AAABGDCBC@C@C@E@C@C@AAAAA@CBC@A@CBGBABC@ABGCC@C@CBABABABCBA@A@AAA@@BA@@BB@@B@@C@CAC@ABABCDABC@CAAAACAAC@A@A@GDCBC@ABABABA@C@C@A@CCAEAAEGC@S@E@CBABCBC@C@A@CA"],encodeOffsets:[[83769,44676]]}},{type:"Feature",id:"654023",properties:{name:"霍城县",cp:[80.87898,44.055984],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@ABCB@@BB@@GFDD@@A@@BFF@@JGDAAEAEAA@@ABAA"],["@@FBB@D@HAD@B@B@FAD@FCBA@@BABA@ABA@A@ACCA@BABCDAB@BAB@B@@@B@B@B@@A@ABA@CBCBADADAB@B@FBDBB@B@B@BA@AB@JAD@BC@@@A@@@@FAD@B@B@BABAB@@@DB@@D@@@FAB@FABBB@D@@BFB@@DDB@FBB@D@@@BAB@B@B@F@B@D@D@BAB@@@B@FBB@J@L@J@B@BAB@BAD@B@FABAFAB@BA@@BA@ADAD@BADAD@BABADCB@B@D@DBBBB@BBB@B@DAHADABCFABAB@B@FAHAB@DA@@BADAFC@@@ABAA@AA@@@ADCBA@AFABAD@BAF@B@FAH@P@@@BA@@BC@C@AB@@AB@FAH@DAB@D@F@B@B@B@B@BADABBF@D@B@B@D@B@B@FADABAB@F@B@@@DABADAB@@@DBHBF@DBB@JCD@DAD@B@FCB@BBB@BABAD@B@H@H@DD@B@@B@B@DAD@@ANBD@B@D@BBB@B@PCFAPCB@FAFAFEBAB@FAA@@AA@BC@A@ABC@@AAACBA@CB@@AB@D@BAB@BABA@ABAB@@@@A@A@@AC@@AA@A@AA@CAAA@@AA@@@A@AAC@ABC@ADABA@@C@@@@@A@@ABA@A@@EAEACAAAA@@A@A@C@@@AAACCAAACAAAAA@EAA@CCCACAFC@CA@A@@AB@BABABA@A@ACIAAAAEE@AA@BA@CBE@E@C@A@C@@BCDC@A@AB@B@B@BABA@A@ABAB@DEBA@A@@BABB@@BAB@BA@@@AAA@@@@BAB@BAB@@A@@@@AA@@B@B@@@@@B@AA@AB@@@@A@A@@@A@@BABA@@@@@CB@BAD@@@B@D@DDHBB@B@FABBD@B@B@BCBABABAB@B@@@DABADAD@BA@ABABA@AB@BA@@@ABABC@@@@@A@ABAAA@EBE@A@@@A@ABAAAAA@A@@AAAAA@AB@@CA@AAA@@A@C@AA@@A@AB@@@@AA@@AB@@AAA@@AAAAAAA@@@A@@@AA@A@A@@CAAAA@A@AA@A@A@ACCA@@ACAA@A@AAAAAAAAA@C@A@@BA@AB@B@@B@@D@B@@@@@@C@A@A@A@A@@@ABA@@@C@@CCAA@@C@CAEACAEC@@AAA@A@AA@@AAABA@A@AAA@A@A@@@@@AA@@A@AAABA@A@@@ABA@@BAAA@@@AA@@EAC@A@AAC@@@ABC@ABC@@@@@A@A@A@@@A@C@@@ABCA@@CBA@@@A@GBC@@@AB@@A@A@A@A@AB@BC@CB@@AA@A@@CACA@@A@CB@AA@@AAAA@AACA@@@AA@@@C@A@C@A@A@A@AAAAAACAGCAAECKECCAAAA@@ACA@AA@@A@@AACAIB@@ABA@C@A@AAC@A@@A@A@@@AA@C@@BA@@@@@A@@@A@AA@AA@@ACB@@A@AA@AAEAA@@AA@A@ACA@AA@AA@AA@A@@@A@@@EAA@A@AAA@@@A@@@AA@A@@ACCA@@@AA@@AAAAACCEA@@A@@ACAA@AAC@C@A@GA@@AAAACBA@C@@@AA@@A@ABA@@@A@AAE@GAC@ACI@C@CBABA@ABABCA@AAAA@ACC@A@@A@A@C@A@@@@EBE@CAC@AA@AACAI@AACA_UG@ACAI@@EEAG@ABCDADAB@DABAB@BCBGBA_oM[SyIMKKYYSQE@@BA@EBEBGDE@GDIDEBIDGBEBABEDIDGDEDGBCDC@@GE@iLKGaiUY@@A@CEIBQSGKCECKWFMFI@Q@KBA@EDEDGHCDGBCCIECCCCIGE@CAMDIDI@C@CBEHEDEBEAACCCAAAEEIAACCG@GAGAAAAI@CACGKEEACA@A@CAEACCI@ECMCBFEBC@EACCACGAM@CAAACBA@@AE@C@@EC@@CDA@@@AG@Q@GDEBFjZT@LCREVG`CPLLLNGDBBDDCBDFAB@FDB@BBD@DD@BDCBDB@BDDAB@DABABBB@BBDCBA@@DABC@ABABA@ABCD@DCBABCB@@ABABA@AB@B@DA@A@@B@DA@@@AAAB@DEAABABABABA@ABA@CBABA@@@ABCBA@@DABA@ABCA@BAB@@ABABAAABGDEDEFjJDFCJEN@@MBGBCDEBADABED@DADBDABCBDBDDLDHH@BBFDDBDDHHFFJDJEBBBBHODKHMHCDA@CAKGAACESE@HHHFHAD@FDJBH@@GBCDCAACCBA@BFBF@@I@E@C@CAE@G@A@@@@B@@@@@BA@@B@@@@BBB@@@BBBB@@@B@@@@@BAD@@ABOAA@A@MAABOAOAA@C@G\\IVGHA@B@AT@NJFADVBFLAB@VFFBBNBjD@F@ZBLCD@HBDDBFAjVNZHXHTBB^\\PLVt¦ĬGÄ[ZWN_BcJab]fI`BCB@BAD@@@B@B@HAD@@AB@DC@@DAD@H@@A@@@C@@@CB@@@B@DABAFADAB@BADAB@@AD@@@@AAA@ABABADABADAB@HDHDF@B@FBFBB@D@@@DCBAFAD@BBD@BB@@B@@@B@B@B@B@@@BBDDD@FBB@BB@@BABABAB@@AB@B@BABAB@B@FBB@D@B@FGD@BAB@DCDA@@BC@@@C@@@AB@B@B@BA@@BAB@D@LAB@B@BABA@@DABA@@BAD@B@D@F@@@FADC@ABAD@@@B@@AA@@ADCFADAJCD@L@B@BAB@BC@@@CB@B@B@F@DA@A@@B@D@B@BAB@@@B@B@LCD@D@B@D@DBB@BA@@@@@A@A@ADABCDADCBA@@AA@AA@@A@AB@DABABA@A@A@@AA@ADA@@@A@@@A@AFABA@@@CBA@@AAAAAA@AB@@@B@@@B@B@B@FA@@BBBB@B@@@DB@BBF@H@@@@@BB@B@@B@D@FBFBB@@@B@D@DAFAD@BABAB@DC@@@@B@B@BBDBDBFD@@@BB@DBB@@@@A@A@@@AB@N@PAF@F@BBD@BBD@DB@@B@FC@@"]],encodeOffsets:[[[82999,44999]],[[82924,45606]]]}},{type:"Feature",id:"654024",properties:{name:"巩留县",cp:[82.231718,43.482628],childNum:1},geometry:{type:"Polygon",coordinates:["@@AMEGKGQAGAO@IDKDEAEABCGAS@GAE@I@_FSBK@I@IBIBWJAB@BBB@DAHADAL@HCFAPADCFABCHAJ@BAD@B@BBBABADCD@D@BABAB@@@DAB@BAB@B@@@B@BABCD@@@@AB@B@DA@@@ABAD@@@BEDGFABC@@@ABA@ABGBC@EBCDC@CB@@ABABA@A@@@@@CB@@@BA@ABCBCBCBAB@B@B@BADAB@BCFA@AB@B@@@DA@@B@B@B@B@@E@KBABABADAJBD@@@D@@@HBBBB@B@@BJ@@@BBB@B@D@D@BCDCHAB@BAB@BADAB@BABABABIFCBCBAB@BABAB@BBB@B@B@B@BBB@@@BBBBBB@DB@@@BBD@B@@@@@@@B@B@BAB@@@B@@@BBB@BAB@@@DAB@BBD@B@BCB@@AB@B@D@B@B@B@B@B@DBB@DBF@BB@F@DB@BB@D@B@F@@@DBDBBB@@@D@B@B@B@@@@C@I@A@C@ABC@ABABABA@@D@B@@@BBD@B@@BD@F@D@BBDDHB@@B@BA@@B@D@DBDA@AD@@@BBFBDBF@BBBAB@B@@@BBDBD@B@D@DABAD@B@B@J@DBB@D@@BB@@B@B@D@BBDD@@@BDDHHAD@BADAD@@@B@B@@BB@BBB@BAD@DCJAB@BADADA@CFC@ABEBADCBCB@BABABABAB@BAD@B@JAB@@AF@@ADAD@@CDABCDABEF@@A@@@AA@@@AA@A@AAA@AAACA@IACA@@ACA@CAAA@@A@A@ABC@AB@@A@A@A@A@@@A@C@ABEAABA@CFABA@@@A@A@@A@BA@A@CAC@A@E@AAA@ABE@EBC@ABEBEB@@C@@@A@AA@@AA@A@@AA@@AAA@@@@@A@AB@@A@A@C@@@ABA@@@AA@@A@A@AAAA@@C@A@@AA@@AA@AAA@A@CBA@C@A@EAC@CA@@IAC@A@ABA@@BCFC@A@A@C@ABC@ABA@@@ABA@A@@AA@@@A@A@A@AAA@EAEA@@A@ABA@@B@@@BA@A@@@A@C@C@ABA@@@A@CBA@@@A@@@C@A@CAC@@@C@AAA@CB@@A@C@C@A@@@AAECA@ABA@GACAC@G@A@ABA@@AABGBA@E@@@A@A@A@@AA@AAAA@@@AA@@A@A@A@AA@@@@AAAA@@@A@A@A@A@A@C@AAA@AAA@@@A@A@CBA@A@A@EBA@A@A@A@@@A@CDABA@@@EAA@EBA@AB@@AB@D@B@D@DA@@BA@A@A@A@@BA@@@A@A@@AA@@@A@AB@@E@A@@@ACAA@@A@ABABA@@@CBABC@CAABA@@@A@A@A@@@A@C@CDA@@@A@@B@B@@ABA@AAA@AAA@IBCAA@AACB@@@@C@@@A@@BAB@@AAA@@BAB@@CBA@A@A@AAA@A@A@@@A@C@A@@AEA@@AA@AAA@AEAA@CAC@A@ACA@A@A@CAC@A@@B@BABAB@@C@A@C@CBCAA@@AA@A@ABCDCBA@A@AAA@C@AAAAC@AACCA@@@A@@@A@C@C@@BA@AB@@EBC@@@@AA@CAC@ABABABA@@BCB@@A@CA@@CB@@CACB@@A@AAAAA@CAAAA@AAAA@@@A@AAA@@AAA@C@CACAA@A@C@A@CA@AA@EA@@AAA@A@C@A@CBA@A@CBABA@C@CBA@@@AA@@A@A@A@A@A@C@@@E@A@@@C@ABAB@@E@A@@@A@@@CAC@A@ABCB@BCDABA@A@CAA@A@A@CAA@CAC@AAA@CCACAAACACAC@AC@@BEBABA@A@@AA@@B@@AB@@A@@@AA@@@@AA@BAA@@AA@@AB@BA@@@A@@BA@ADA@@BA@AB@@@@AB@BA@@@A@AB@@A@A@A@@@A@@BA@ID@BA@@BC@@@ABA@ADA@A@@@A@CACAA@A@@@@@AB@@A@A@AA@@CBA@AAABA@C@ABIDA@CDC@ABCBCBA@@BA@ABA@A@C@@BC@GBCD@@CBAD@BAB@LABADCD@BA@A@ABAB@BABABABABABABABA@ADA@AB@BA@@@A@@@AB@@@B@BB@@BAB@@C@A@A@C@A@AAA@AACBA@A@C@A@ABADCBABC@A@EAA@A@I@CAAAAACAC@@BCB@@A@AA@@@BCBA@A@@@ABA@ABE@CBC@AB@BA@ABAA@@ECAAAAC@ABC@A@C@A@GFABA@EBA@ABAB@@AB@@A@C@E@AAA@@@A@ABA@C@@@GDABCBABA@CAE@A@EFABA@A@@@A@C@A@A@EBCDCBA@I@C@A@A@CAAAAA@@ABA@A@@AABA@A@A@ABA@A@@@@BA@@@C@A@@@@BAD@@ABC@ABA@AAABC@@BA@AB@@A@CACAE@C@@@AAA@E@C@C@@@C@@@A@CAAACAA@CBA@ABABCBA@ABA@A@A@CBCBABA@@B@@@BBBB@@B@D@@E@GBE@C@C@E@IA@@ABA@A@A@@@ABA@@BAB@D@BA@CBEBA@ABCD@@BBB@DB@@BBDBBBD@B@D@BBB@B@DBB@BBBD@@@BA@@BAB@B@B@B@B@BBD@B@DBB@B@@BB@DB@BD@@@BBB@@BBD@B@BBDB@@BBBF@@BDDBBB@B@BBFBBFD@BBD@B@B@BCD@B@B@BCB@BAB@@BBBB@BD@@@@BBB@F@BBB@B@@BBB@BBDD@B@@@BB@@B@B@@@B@B@DAB@DAB@BBBBB@B@B@B@B@@BBBB@B@BBB@@FBBB@@DBBDBBBBFFFBBBBB@B@BBB@BB@@@@@DBB@@@B@@@BBBB@@B@BBDBFBB@D@BB@@B@BBBBBBDBHDHD@@BB@BB@@@B@@BDD@BFB@B@@BB@BB@BBBB@@B@DBFBBBB@BBD@B@@BD@DBD@@BB@B@B@BB@@BBD@DBB@BBB@DDD@@@B@BD@@DBD@BB@B@B@B@BB@@B@@AB@@@D@B@@@B@@@BA@@B@B@B@@ABAB@B@B@@@BB@@BBD@BBBBB@B@@BD@@@B@@BB@BAB@@@B@@@D@@@B@@@@BB@@B@@BB@@@BB@@@B@B@@BB@@@BB@BB@BB@@@B@DDBBB@BB@@BCBAD@B@DADA@@BA@@@A@A@@BABAB@BB@@B@BABABAHCBAF@BCF@BAB@DALADAF@J@B@BBBBBDDFDBD@HBBBBDBDBBFBFBDBL@F@DBB@D@D@DABADAF@T@D@FHBBBFDDB@D@D@B@BABABAD@DAHCB@B@D@BBBDBBDBD@BADCBABAD@DBD@@@@AA@@AB@@AB@BBB@B@DABABABADAD@D@HDBAD@BAHADAB@D@DAB@BBBBD@D@F@D@D@D@DAHCBABBD@D@D@DABA@A@CAAAA@A@CBADAB@DBD@DADCD@D@DBDBFDD@DBB@BABABABAB@DBD@F@B@DCB@D@BBFDDBD@FCBAB@@@B@BBBBB@@@BA@A@E@ABABABCBEBAB@B@D@BBD@B@BABABABBB@@@BB@D@@BBDBH@DBBDB@H@B@B@BABAD@D@B@FBB@B@DABADEBAB@BADDB@BABADADBFBDBDAB@BA@A@C@ABAB@B@D@BBBDBBBBDBBAD@DABCBCB@BAD@B@BBDB@BBB@BAF@@BB@@B@B@B@BABA@AHAB@DBDBD@B@DAB@BA@A@AB@B@@@BBBB@BBBB@DADAB@D@FBD@D@B@@ABABABAF@BBB@@BBBBHBBB@BABAD@DAB@BBB@BBD@B@D@D@BABADG@AB@B@BBBBDDBBBBHDHFDBB@D@DAB@BABEDCDAD@DADAD@DBFBDB@@DD@@B@BBB@BAD@DAB@D@J@B@FCB@D@@@HBLDDBJBB@FBD@F@FBB@B@@@DAFADAL@DBDD@FBBDBDBD@D@FABAB@B@BABABABAD@D@PBJBF@H@F@HBF@FFBBBBB@DBD@H@HAFAB@D@FBD@DBB@B@FAD@HAF@HABAHD@@JBB@JCJAHCJCHCFAJAJAJ@HAHBLBJ@B@JAHAJAHAJ@F@JBJBFBH@HEFCBADCBE@GBEBEBGBGDEBG@C@G@AAG@ACGCEAGCECGAECG@@AGAGAEAGAACGCECECEAAB@BGDGBE@AFE@E@G@E@G@@AGAG@E@G@G@E@G@GACCGCEGCCEEE@@CGAAAECGBAFEDEFGBADGAE@GAGAACGAA@@@@ACCG@@@G@EBGBGBEBGB@DEDAHCJAHCFCHCB@JCB@HCHCHEJCHCHCH@F@D@FAJ@J@HAHA@@@@B@JAFAHCD@B@FADALEXIFADC@EBAFAD@JAB@B@DAJEFADAD@B@DAB@JAJCB@D@F@B@H@JAH@F@DBD@D@DAHAF@B@B@D@FBFBDAB@@CD@F@B@DAH@B@DADAB@D@B@FCFABADAB@BAFAHAF@F@DALEB@FAD@FBD@D@JAFADCHAFADC@@D@D@FCFAJ@HAF@JBDAB@F@B@FADAD@B@DAD@D@BA@A@@BAD@PBBBF@F@@@BABAB@DBN@B@BDB@F@B@B@DB@@D@B@D@DAD@BBFAF@HAHAFAFCDCDAFAJ@DAD@BADC@ABA@CDABC@A@@BCHEFEFEHCDCHCBAHCHAJCHC@@JAJAD@J@J@J@JAJ@J@J@D@JBJBH@J@JAJ@J@D@JAJAJAHAJCJABAHCBCFEHEFE@@HCJCHCHCHCB@J@JAJ@J@BAHAJCJA@@HCDA@@DAHCFCDABCDCBABAB@FCLCBCBABABCBADAHEHAD@FAHA@ADAFABEDCDABABCBCD@BAF@D@B@FADABAJ@@@HBJBJ@JBHBJ@J@J@J@D@B@F@J@D@FAF@FAHAHCDCDA@@DADAD@B@HCB@DAFED@D@D@FCDAHAHAD@B@D@FALBP@PBX@D@D@DAHAXEB@FC@@BATQD@BAFKDABAFUBEBAD@D@DAFG@CBA@ABGA@AAA@A@A@@@AAA@CA@@@@AA@A@ABAA@@AA@ACEAAA@@@@ABA@ABA@A@ABA@AD@B@BA@AB@@A@C@C@C@@@A@C@E@A@EA@@AAC@@@AAAAA@@A@AA@@@A@C@C@@@C@A@AAC@A@A@@AAAAA@@@@A@@@@ACAA@AAA@AAA@@A@@A@A@AA@@AA@ABA@A@@@A@@A@CACAA@CAA@@AA@@@@BA@@BA@A@A@A@A@A@C@CDEBA@C@E@E@CA@@@B@@A@@BA@ABADAB@@@BBB@B@DABADAB@@A@A@@BA@A@@@A@@AA@@AA@ECAA@@AAAACAAAAAA@CAC@GAC@GAA@@@A@CBIHA@@@AAA@AAA@@@EAAACAAA@AAACACCAAAA@A@C@C@A@ACAAA@@A@A@ED@@@B@@B@@B@@@@AB@B@@D@@@ABA@CA@@ABA@AB@@BBB@@B@@@@A@ABA@A@A@A@A@ABA@@@A@A@@BC@@@@AAAAC@@GCICECEEEEEEIAIAIA@@I@I@IBEAI@I@IAI@I@GAIAECGECAEEEEIAICGBIBA@IBC@IBI@IBEAI@C@IAIAEAI@IDGBGDGBABI@I@IACAIAGBIBCDEFA@EFA@IBICGAECGCECGC@@ICIAIAG@I@I@I@IAGAICEAC@IBEBCBG@E@GCGAE@CDC@OBE@IDECGIAEECIAE@GACAAAQKC@CBCBEBGHMDIBKBGCCKBIBEBGAIBACCEAKAK@KEECEI@GAEGAEAOBGBE@IBIAEBODKDOBIAI@OLGBIHC@G@MCCA"],encodeOffsets:[[84685,43980]]}},{type:"Feature",id:"654025",properties:{name:"新源县",cp:[83.232848,43.433896],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@DAH@D@B@FABADCBA@@FDFFB@H@FBDBD@BBFBHBBBB@B@B@@A@A@@BAB@FBB@B@B@BABABC@AB@BABCBAB@DA@@D@@@@@@A@@@A@CA@AA@C@@@A@@DBF@H@F@B@JAB@B@REVC`BjLTD\\BPA^OTGNKNOLILGR@TFLBfB`@~CZAZCXB`JBABAD@B@D@B@DBB@F@D@@@PFD@BAD@B@DAD@B@B@LDFBB@BAB@BBB@B@@ADEDCB@HCB@@ABABAFCB@D@FAD@D@@@@@BA@AB@B@BBBBB@@@B@D@D@FAB@HAD@BA@@@@@CB@@AB@B@B@DADADAHAB@B@HBB@DBB@BB@@B@B@@@@A@CBAD@B@FAB@D@B@@A@@@AAA@@@A@ABABA@@BAB@D@@@@@BABC@CBC@@BADAD@DADAF@B@DA@@DBD@B@D@B@@@BBBDBB@BBB@@F@D@B@B@BCBCBC@A@@@AAA@A@A@AA@AA@ABA\\GdIN@@@@A@@B@@@@ABBDA@@@@B@BAB@@@@@BA@A@@B@BAB@B@D@@@B@D@B@B@D@D@@@D@B@BB@@B@BAB@BBB@@@@@@@B@@B@@A@@@@BA@@BAB@B@@B@@BB@B@BBD@@BB@@@B@@BB@@@B@B@@@D@B@B@@@B@B@@@BB@@@@@@@BB@@@@@@@B@@@BA@@@@B@@@@@BA@@B@@@B@@@B@@@@@B@@@B@@@@@BB@@B@@@@@B@B@@@@@BBB@@@@@BBB@@@BBB@@@@@@@@@B@@@B@@@@@@@B@@@@@B@@A@@@@BA@@@@@@@AB@@@B@@@B@BA@@BB@@D@@@B@@@B@@@BB@@@@BB@@B@@@BAB@B@@@@@@@BEBA@ABA@A@@@@@@@@F@@@D@BAB@@@BAB@@@@@BA@@B@@ABA@@@@@@BA@@@@BAB@@@@@D@D@D@BA@@B@@@@@BA@@@AB@@A@@@@@A@@AA@@@@@A@@@A@@@@@@@A@@@@@A@@B@@@@A@@BAB@B@B@B@B@BAB@B@@@B@@@B@BAB@@AB@@@BA@A@@@@@A@@@@B@DAB@B@B@B@B@@@@AB@BA@@BAD@B@D@B@B@@AB@@@BAB@@@@A@@@@@@@@@AA@@@@A@@@AA@@A@@@AA@AA@@@@@AA@AA@@A@@@AA@AAA@@@A@@@AAA@@AA@AAAACAA@@AAAA@@@@B@@A@@@@@@@A@AA@@@@@@AA@@@@@@@@@@A@@@@@@@AA@@@@@BA@@@@@A@@@@@@@@@@@@@@AA@@A@@@A@A@@@A@@@AAA@@@@@@@A@@@@AA@AAA@@@AA@@@A@@A@AAA@A@CAA@A@@@@@@@AAB@A@@A@@A@@@@@A@@A@@@@A@@@@@A@@@AAAAA@@@@@A@@A@@AA@@A@@A@A@@A@@@@@AA@@@@@@A@@@@@AA@@@@A@AAAAAAEAA@@@AAAC@@@@A@AAA@@A@@@AA@@A@@A@@@AA@@@@@AAAAA@AA@@@@@@@@@@@@@A@@@A@C@C@A@A@C@C@ABC@A@A@ABCBABA@CB@@A@A@AA@@@AAA@AAA@AA@A@@@@AA@@AA@@A@@@AA@@AA@@A@AAA@@@@A@EB@@E@C@G@C@K@CBQ@G@IYAEEIKQEIEIBKBMBABBF@DAB@BA@A@@@AAAA@A@A@A@A@AA@@@@BKBE@C@AB@@@@@@@@@BBBBBBB@B@@@B@@@B@@@B@@@@@B@@@@@B@@BB@B@@BB@@DBBB@@@@@B@@@B@BAB@B@B@B@@AB@@@B@B@@@B@@AD@B@B@@@B@B@@@B@@@B@@@@@BA@@@@B@@BB@@@@@B@B@@@B@@@D@@@@@B@@@@AB@BA@ABAB@@A@@@A@A@@@A@A@@@A@A@@@@@A@@@@AA@@A@@A@@@AAAAA@@AC@@AAAA@@AA@AA@@A@@AA@A@A@@@@AAAAA@CA@@A@AA@@@@AA@@@AA@@@AAA@C@A@@@A@@AB@@@BADA@@B@B@D@B@BAD@DAD@B@BAF@D@BBD@B@D@B@B@F@F@B@D@B@B@DA@@@@DADCB@@AB@AA@A@A@@@@B@BAD@B@@ABCBABADA@@B@@A@C@A@CBA@@BABA@@@@@A@A@@B@B@B@DAD@@@B@BAB@BAB@BAD@B@B@@ABBB@B@BA@@BA@@@C@A@@@AB@@@BBB@B@B@BAB@@@BA@@@AAA@@@A@@@@@@@@@AB@@A@@B@@A@@@A@A@@@AACBA@A@@@A@@@A@ABA@@@A@AA@A@AAA@A@C@A@A@ABCBA@A@A@AAAA@A@A@@AAAAAAA@@@A@A@@AA@A@@@@@A@A@A@A@A@AAA@C@A@@@ABA@@BA@@@ABAB@@A@@@A@AAC@CAC@A@A@@@@@A@@@AA@A@@@@A@@@A@ABA@A@A@A@ABA@A@A@AACAA@A@A@C@A@A@A@A@A@A@ABCBA@@@A@AAC@C@@AAA@@AAA@AAA@CAA@A@@@CAA@@AA@@AA@@@AA@@C@@@A@A@@@AAA@CCAAC@AAAA@@AB@@ABABCB@@C@C@CAC@A@A@@@AB@@A@ABA@A@A@A@@@A@AAA@@@A@A@A@ABABABADA@ABABABA@@@A@@@@@@AA@@@A@C@A@A@A@AA@@C@A@A@ABA@A@CBC@A@A@A@A@AACAC@A@E@C@A@@@A@AACAAAA@@@C@C@C@C@ABA@@BCBABABA@C@@@A@AAA@A@CAC@C@C@A@C@ABA@A@A@C@AACAAA@@@AB@@A@A@A@AB@BA@@@AAA@AA@AA@A@ABCBA@CBA@ABCBC@A@C@A@AAA@@@A@@BA@@@C@GAAAAACAACAAACAAA@@BA@CBABCBA@@BABA@A@A@@AAC@AA@@C@AA@@A@A@@@A@AB@BAB@@AB@BCB@BA@ABAB@@ABAB@BAB@B@D@BA@@BABA@CBA@ABA@@B@@A@@B@@@@AD@@CBC@ABA@A@C@ABC@AAA@C@ABA@@B@@A@@BAD@@ABA@@@@B@BA@@BA@ABC@EDA@CB@@ABA@@B@B@@BBDBBBDBBBBBBBAB@@ABAB@B@B@BAB@BAB@@ABC@A@A@A@C@ABA@ABABABA@ABA@@BA@A@@BABA@A@A@A@A@A@A@@@C@@@A@@@@@AB@@A@A@A@A@C@AAA@@AC@CAG@E@C@C@A@@B@B@@A@@B@@@BA@AB@@A@A@@@A@@BA@A@C@A@E@A@A@A@A@A@A@AAA@C@@@A@@@ABA@@BA@A@@BA@ABA@AAA@C@C@C@A@A@A@A@AA@@CAA@@@A@A@C@E@A@@@A@@@A@@@@@AAA@A@@@A@@B@@A@@@@@A@A@A@A@@@AA@@@@AA@@A@@@@@A@A@@@C@A@@@@@@@A@@@@A@@A@@@A@A@@BA@@@A@AB@@@@A@@@A@EACA@@C@AA@@@@A@@@@AA@@@@AA@@A@@A@A@CAC@A@A@A@ABA@A@@@A@AB@@A@A@A@@BA@@@@@A@@@A@@A@@@AA@@A@@@A@C@@@AA@@@A@AA@@A@A@@@A@A@A@A@CAA@@BA@A@@@@A@@AA@@AA@@A@@@@@A@@BA@@@@@@@@A@@A@@@@@@@C@A@@@A@@@A@@@@A@@@@@A@@A@@A@@@AA@@AA@AA@@AAA@A@A@@@@AA@A@A@A@AAA@A@@@@BA@@B@@@BA@@B@@AB@B@BAB@@@@A@@@@@AAA@@AA@AA@@A@@@AAA@A@A@A@A@C@A@@@A@A@@@@@@BABA@@B@B@@@AA@@@@A@@AA@@@@@AA@@A@@AA@@@@A@AA@@@AA@@@A@@@A@@BC@@@A@@BA@@@@@A@AAA@AAC@AAC@A@A@AA@@A@A@@@A@A@@@A@@@A@@@A@@@@@@B@@@@@B@@@@A@@@A@@BA@@@@@AB@@@@AB@@ABA@AA@@ABA@@BA@@B@@A@@@@@@@A@@@@@AA@@@@A@AAA@@@AAA@A@A@@@A@AB@@A@@@A@@AA@A@@@@@A@@@@@AA@@@@@@AA@@A@@A@@@@@A@@@A@@@A@@@AA@@@@@@@A@@@A@@BA@C@A@AB@@A@@@@@AB@@@B@@@BBB@@@BA@@BA@@@A@A@A@A@@@ABA@@@A@@AA@@@A@@A@@A@@AA@A@A@A@AA@@@@A@@@A@AAA@A@A@@AA@@@A@@@A@@AA@@@@A@@@@@A@@@A@@@ABA@@@AB@@@BA@@@@@A@@@@@@AA@@@@@@@AB@@@@A@@@AB@@@BA@@@A@@@@@@A@@@A@A@A@A@A@@@A@@AA@@AA@@@@@A@@B@@@BAB@@AB@@@@A@@@C@@@C@A@@@AB@@@@A@A@A@@@AAA@A@@BA@A@AB@@A@A@@@A@@@A@@@A@A@CBC@ABA@@BA@AB@@AB@@@BA@@@AA@@@@@@A@A@@@AA@@@@A@@@A@AAA@A@A@CBC@@B@@CHAFADEHC@GFA@IAI@I@IAA@ICIAIAECICACEEEEEEEECCEEGCGCGCI@I@E@IBIBEBIBGDA@IBGBEBIBIBA@I@I@C@IAI@IAA@IBIBI@@@IDGDEBGDCBGDIBGDGBGBIBA@GCICGCGCGCEEIDEBIBGAGCCCCEEEAAAECGCECGEECEEG@@GEGCEEEAGAICGCC@GCIAGCEAIAIAICIACBI@IBIBGBIBIBIBIBGBIBIBIBC@AA@A@A@A@@@AAA@@@A@@@A@@BA@ABA@@@C@@@CAA@A@@@@@@A@@@A@C@C@@@A@@@AAA@CA@@A@@@@@AA@@AA@A@@@A@@A@@@C@C@ABA@@@A@@B@@A@@@AB@@@@A@A@ABABA@A@@@A@CACAA@CAC@@@A@@@A@A@@@A@@@@@C@CCA@AC@@@@@@@AA@@@@A@A@AA@@@A@@@AACA@@EAAAA@AA@@@@A@@@@@@@@BA@@@@@A@A@A@AAA@A@C@C@@@A@AB@@ABA@CBEBABC@CB@@AAA@AAA@@@A@A@A@A@C@E@A@A@ECAAA@@@A@@@C@@@E@C@CACAAA@@A@A@@@@@AAAH@BAB@DEHCBC@C@ABAFEVABCBELABC@SRAB@@EDA@WFGBCBC@C@W@OAO@KAEBC@A@C@GBGBCBEDC@C@C@EFCBA@GDA@C@CBCB@@CBCDGDGBEBE@EBC@I@E@A@C@I@I@I@I@GAIAI@IAGA@@I@ABCBEBA@C@E@ABC@ADADABCBCDAFEBCB@BGBEBC@GBGFCBABADABABADKDEDA@ABABCDADCBEDGDCB@@CBGD@@IBIDGBABI@I@IBI@A@GDGDGDIDGD@@EFGFEFADGDABIBIDGBIBIBIBC@I@I@IBI@G@IAIAC@I@I@I@IBI@I@I@C@IBIB@@GDIDGBGDABGDCDGDEFEFGFAD@@@BADCB@DAB@BCDABC@CBI@EBCBCDEDEBGBGBE@EBAAC@CBC@A@C@@@CAA@A@E@A@ACA@M@CAA@ABAB@@E@E@AAOAC@AB@@@BABC@C@CBA@C@CBEBA@E@A@CBIAE@GBI@EBEDC@C@@@CDEBGBCDEBIBC@C@EAC@EBA@KFCBE@E@GBEBABA@CBABEBEDA@C@A@CBCBA@G@CBA@E@C@@DA@CBEAEAC@A@A@E@GBCBC@C@CAE@G@IBG@A@E@C@A@IDIBA@CBA@C@CBEBIFCBA@A@IBC@EBAB@FCDEBWJKFCBEBA@C@GDEBIBA@@@@@GBGBI@I@EBC@E@G@GDGDIDGFGDGDA@IDA@GDEDGDIBGDCBCFA@AHAFAHAH@F@H@@DHBD@@@@BBDHBBBH@HBFCHABEHCFEFABDHBFBBDH@@FFDFHDDFDHBD@H@H@F@H@H@FBHBH@@@H@F@H@FEF@BAFCHAHA@BBDFDFDFDHBBBHBFBHBH@@DHBFDHDFBHDFDH@BBH@B@H@DAHCFAHAHAFAF@HAFJ@JBJ@J@JBFAF@B@B@DBF@B@F@B@F@D@B@D@F@D@D@B@B@D@B@D@DBHAF@HA`GH_AOJCB@FEFGDEHCHEBAHDFDDFJADAHCBAFEDGDEFCFEJAL@F@DGBCAEJAJAJAJAHCD@DFBDBH@FDFJBJAJ@J@JAJ@D@J@J@H@JBJBB@JDJBHDJBJDD@JBJ@JBB@JAJAJ@JADAJADADCBADADGBGBE@CDE@@JCJAJ@JAJAF@FCHDBBBBD@D@B@@BTCVE@AAKlLH@HBNAF@LADEdHTB^B\\IJDbX@^CTADADAR@JAV@NTL@PEFFRJhBAB@B@@@B@@@D@B@B@DBF@F@B@B@FAB@B@D@D@DBJFBBB@@BBB@BDDBBBFDFDBHNFFBBDBNHDDBBBDBBDD@BB@BBB@B@@@BA@@B@BA@@@A@@BA@A@AB@@@@AB@DB@BB@@BBBDBB@@@@BAB@B@@@DB@ABAB@B@B@@BD@@@@F@D@B@B@@BDDBB@@B@@@BADAB@@BD@B@D@B@B@FB@@BADAFCB@FCFCB@@A@@@AAAAA@A@AAAA@AA@@@AFADAD@BC@@FADA@ADCFAF@HADABAB@D@@@B@B@B@B@D@D@B@@AB@B@BADABABAB@B@BBD@B@B@BADA@@BA@@B@DBB@B@BA@A@@BA@@BAD@D@B@B@B@D@B@DB@@BBBBB@FDB@DBBBDBDBB@B@D@BBB@D@B@DBHBD@BBBB@@BDB@B@FBBBB@BBBBBBBBBB@@@BB@B@B@@AB@B@B@BBB@@BBB@B@B@D@DBBFH@@F@B@BB@@DBFDBB@@BAD@FBDAB@B@B@JAB@B@@@BBBABABA@@B@D@D@F@@AB@DBDBBBBB@@DB@@@@FABADA@A@@@AB@HBD@B@D@B@RBB@BB@@@DB@BBB@B@B@FAB@@A@@DAD@DCB@B@FDD@B@BDBDD@@BB@@BDBFDB@B@@@B@BABCDEDE@A@ABA@@B@BA@@AAA@AA@A@A@A@E@A@CBCDEFW@C@CBABAFI@A@@BCBADA@@@@@A@@@@@@BAA@BA@@@A@@@@@C@@B@@AB@@@@@@A@@@@B@@@@@@AA@@AB@@@@A@@DCBABC@A@C@EBBBBBB@B@B@B@@@DBBB@@@B@DA@@D@@@BBBF@DBBB@BBBBB@BBBA@@B@@@HBBBBB@BB@BBB@BB@BB@@@BBD@B@@BBBB@@@B@B@BAJAFVBZD\\BDL\\@F@`I@DAB]RIXBH@H@BDBB@B@B@@@BABADA@AB@D@BCBAD@BABABAB@D@DBD@BAB@DCBABABAD@D@DADAD@BBBBDAFAF@D@FBF@B@FAH@FAD@DCBAB@D@@A@A@@A@ABC@C@@@A@ABA@@@A@A@@CACAGAI@eM_GuCUHABAF@H@B@B@FBB@D@@@BAB@B@@@@@B@B@B@DB@@F@FAJDB@DB@@BBB@DD@@BB@@DAD@@@DB@B@@@BB@@@DAD@D@BAB@BADCBABCBADGDADCBAB@B@DAB@B@DBB@@@F@@AB@D@@@DABABAB@@@B@@@BBB@F@@@DAB@B@B@D@@@B@DDB@F@B@@@B@BAB@BB@@B@@BB@@ABABABAB@D@B@L@DBHA@CBABABA@AB@HAD@@ADBB@@ADAB@BAH@F@JAD@B@BB@@B@B@@@DABADA@A@AB@D@B@F@@@@@B@@ADABA@ABABA@@@A@@AA@@@ABA@ABC@@@ACC@A@@@ABAB@@@B@B@D@BB@@DDBBDBBBFDDDB@@@D@BAD@D@B@B@@ADAB@BAB@@@JJBBF@B@DAD@D@DADA@@BC@ABABADAB@BEDEBABA@EBCBEB@B@B@D@B@@@BADABABAD@B@FADAB@BAB@FAB@B@D@DDD@D@D@B@DBDBBD@ADADABADAB@BABBD@NDF@D@B@BABABCDC@AB@DGDAFCJCD@FAFB@@B@@AAC@CBE@I@A@ABAD@D@D@B@@@DCBAF@F@F@HBD@FAHAFADAB@F@F@B@D@HDF@BB@@BBB@D@BAD@FABCDABADAB@D@D@FAB@DBB@F@J@FAB@BF@BBBBBB@D@B@BDBBFBH@D@BBFBB@D@B@DAHAJC@ABAA@@CBEBCBEBAD@B@BBDBDDFDJBB@D@"],encodeOffsets:[[85987,44577]]}},{type:"Feature",id:"654026",properties:{name:"昭苏县",cp:[81.130974,43.157293],childNum:1},geometry:{type:"Polygon",coordinates:["@@MCO@IAI@IAECEOEECCA@C@EBCBA@C@A@AAEE@AG@C@ABE@EA@AGCEGAGCAEGGIAAA@OBGAGAA@IBQACAAA@@BA@A@OGEA@MBEBC@@@GCA@A@EBADIDA@A@KFABAD@DABSFGFIBGBE@ECMKCAC@UFODC@A@CAOMA@EAG@GBKFA@C@I@MAA@GD@BGBOLC@ADEBABA@ABABCBCB@BCDCB@BCB@@CDA@C@C@@@A@C@A@C@CBA@@BCBAB@BA@@BABABA@ABAB@B@BCB@BAD@@@D@DBD@B@D@BBDDDBBDF@@BBBB@B@B@D@BBD@BBD@B@B@BA@EDA@ABA@A@AB@BAD@BBBBBDDBB@B@BABAB@BCBABA@ABA@A@EBC@AB@@CBABAB@B@D@B@D@DAB@BCBE@CB@@CB@BAB@BBDDDBBBBBB@DBD@B@BBD@B@BBB@B@D@DABADABAF@D@D@@@DADBBBBB@@BD@BBB@BBB@DF@@DHBDBDDDBBDBB@BBDBFBD@D@D@B@B@DDDBBBBBDBDDBBDBB@DBFBB@B@B@FBBB@@BBBBBBBB@B@B@BBBA@C@A@A@E@A@G@@@C@GFCDAF@@@@AHCBEDGBC@G@E@C@EDA@CBABEDA@@BABGJAFCH@BA@@BEDABCFADA@ABAFINCF@BA@CDA@A@@BGHADCHELAFAP@B@D@B@@ADCDEFCHAB@BCD@BGNAB@DDJ@BDF@@@BDFBH@FB@JFBDBD@DBF@DAB@D@BBDBBBBBBBBFBDBBDDBBBHFFBFBBBBDDBBBDDD@F@FBFDD@J@DABB@D@B@B@DAD@DAF@DAD@F@B@DBDDDBFBDBFBDBFBDBDBFBDBDBF@DBDBBBDBDBDBFDF@DBDBBBFBDBBBFBFBB@DBDBFDF@D@FBD@D@DBD@FBF@B@D@D@DAFAF@FBBB@@@B@@@BA@@B@@ABB@@@BB@@BA@@@A@@@A@@@ABA@@BB@@@BB@@@@BBB@@@@@@A@@@@@A@@A@@@@AB@B@@@@B@B@@B@B@@@B@@@@B@@@@BBB@B@BB@@DB@@B@@ABB@@AB@@@B@@@BBB@BB@B@@@@@BA@A@A@AB@B@BAB@@@@@B@@@AB@BA@@BB@BBD@B@@@BAA@@A@@B@@@@BB@B@BBBA@@@@@AAC@A@@@AB@@AB@B@@B@@BB@@B@BA@@B@@@@@B@@@@@ABCB@@@@@BB@B@@@B@BAB@B@B@@B@B@@@B@@@BB@@@B@BA@@@@@A@@@@@@B@@@@@B@BB@@@@@@@@ABA@@@@@@B@@@@@BB@@@BADABA@@B@@@BBB@@@BA@@BA@A@@@@B@@@@B@@@B@@@BB@@BB@B@@@B@BA@@B@@@@@@@@@@BAB@@@BB@@@B@@A@@@A@ABA@@B@@@BB@@@@@BB@@BB@@AB@BA@@B@D@@@B@@@@A@@AAA@CAAA@@@A@@B@@A@@@@@@@@A@A@@@@@A@@AA@@@@@@A@@@@@@B@@AB@@@@AB@@@BA@@@AB@@@BB@@@@@BBB@@@BB@@@B@@A@@@@B@@@@@@BB@AB@@@@@B@@@@@@@@@BB@@A@@@@@@@@@@BAB@@A@@B@@@B@B@@@B@@@B@B@@@@@@@@B@@A@@@@B@@@@BB@@B@D@BA@@@A@AB@B@@AB@B@B@@@B@BB@@B@@@@@AB@BA@@B@B@@@@@BB@B@B@B@BA@@B@@@@@@B@@@B@B@@B@B@@@B@@A@@@C@A@@B@BA@@@@@@@AA@@A@@B@@@@@B@BBB@BBBBB@@BBA@@@@@@@AA@@A@@@@@@B@@@B@BB@B@@B@B@@@@A@A@@@AB@@@@@@BB@@@@B@@@@D@B@@@@@@B@@A@@B@@@@@@@BB@B@@B@AB@@@B@@B@@@@BB@@B@B@B@D@@@@@@@@BAB@BB@@BB@B@@@B@@ABA@A@@@AB@B@@@BAB@B@@@B@BB@@@@B@@@@A@A@@@@D@B@@@B@@A@@@@@A@@A@@A@@@@@@B@B@@@B@DB@@@@@B@@A@AB@B@@@BB@D@BBBBD@BBB@@BB@AB@@BB@@D@B@D@D@@@@B@@@@@@A@E@@BA@@@@BB@DBB@@@@B@@@BCBABA@@@BBBB@@D@B@DA@@B@@B@@@B@@CBA@@B@B@@BBBBD@B@@@BAB@B@B@@A@@AA@A@@@@BA@@B@BBF@D@@B@@@@A@@@AB@@@B@@@B@@B@B@D@@@B@BB@@@@@B@@@B@@@BB@D@D@D@BA@@BA@ABABAD@DAB@BBB@@@@BBB@@AD@@@BBD@@@B@@@@@@F@BAD@B@@BB@@AB@@@@@B@@B@BB@B@DB@@@@@@@BCB@@@B@@BBDBBAB@BABA@@B@BB@@BBDDBBBBB@D@B@DADABAB@@B@@@B@B@@BBB@@BAD@B@@@BB@FD@@B@F@B@DA@ABA@@@AAA@@@A@@A@BA@AB@@@B@@@B@@BB@@DBBBBB@DB@@D@B@BAB@B@B@B@D@@BBB@@@D@BB@@@D@B@B@DABAB@B@B@@@BBBFBBB@D@B@@AB@@A@C@ABA@AB@B@B@BB@B@B@B@D@BB@BBB@FADBD@DDDBB@D@FABAB@@@BB@@@BA@CBAB@B@B@BB@DBD@B@BABA@CBA@@B@@BBB@BBB@@BBB@B@DABCD@BABBDBB@B@BAB@B@D@@DC@@FABA@BBBD@B@@C@ABAFBB@B@BC@ABAD@DA@JB@@@BCB@DEBCDI@IDAB@@EDCBG@E@EBCBIDMDKDE@SDE@CBKBA@Q@SFC@ABE@ABEDC@EBC@ABiTA@ABEBGDCBABABA@CBABA@C@EBCB@@MHA@ADADC@IFEDEDAFA@ABC@CDABE@OHCBADEDADYb@FADAN@BNLPLLFHDJHRJ\\HHD\\HFB\\@LBZBLFFBNFHFFBHBTJ\\RXFXLPLRNVRTP^DNHLDJBJDJBJBB@J@JBB@\\BNA`CJ@NAP@FEFCFABAHELCDCHBFLHJFFJFJ@HAPCLEDAHCJCPALBFL@PBRJ`BFAFAHABCDCFEFABCHCF@PA\\@Z@NKNGHMNEJBLFZBJFNBF@BFAD@THPJFDJ@HALMFCBADEFEDEJCFAD@F@J@B@HDJDDBHDJDHDJDHBJAJ@@@JCHAJCJAJ@HAJBJBDBJDDBB@BBJBDDJBJDJBF@J@J@JCJABAJAH@HBJBD@JDBBHDFFFDFFBD@@@DAB@@@DAB@@BB@B@BBD@DB@@BDDDBD@BBB@@BD@BBB@BB@DAD@@CB@B@@@BBBDBBBBBDD@BBBB@BB@B@@@DADBF@B@BCB@B@@@BBB@BDDBB@@@B@D@D@DB@DBLDDBF@B@DBD@B@F@BA@@HAF@D@DB@@FAB@B@B@D@BB@@DAB@FBD@DBDB@@D@BABADADAB@BBBBB@BBD@DBDBDBB@BAD@B@BABA@ABAD@JADADEB@DAD@D@FAH@BBB@@@DAB@B@H@D@BDD@FADBB@JDHBJ@DAHAFAHEHEB@HEFAJAF@JDJBFFFDDFBDJDF@JBJ@HBJ@J@D@HCJCHCJ@DBHDFFBBDF@@BBB@B@@@BAB@@@BBB@@BFD@@BBDB@@@D@@B@BB@@BBB@B@DBD@@@BAB@F@AAAAAAA@@AB@@CA@@A@@CCA@@ABCACBA@A@ABABABC@ABABABA@EA@@AAA@A@A@@@AF@@@B@@A@@ACA@@C@@@ADA@ABABAB@@AB@@ABABC@ABA@A@@@A@@@A@ABA@@@@BA@C@A@@AC@AAA@@@A@ABA@@@CBCBCBABCBEBA@E@@DCDCDAB@BCBA@@B@BALAD@DAJ@D@D@J@BAB@B@@@@@FABA@@DABAB@FCBAD@B@DABAD@FAF@B@B@F@H@BAD@@@DBH@F@B@B@DADABCB@@AB@@ABABAB@@@BA@@B@@@FADABAB@B@@AD@DAF@BAB@B@DAB@B@BADAB@@ABABA@A@A@A@C@AACAA@A@A@@AA@A@A@A@A@ABABC@ADC@CBCBC@A@AB@@AB@@A@A@@@A@@BAB@BCFE@@BABA@ADAB@@@BEB@BABA@A@@DA@@D@B@@A@@@A@@B@@AB@BAB@BA@@BABA@@BAB@BABA@AB@@A@A@@@@DAB@BA@@@@@A@@BABCBA@A@@B@BABAB@BADA@@@@@@DABA@@BA@@@AB@@ABABAB@B@BABA@@B@@AB@@AB@B@@A@@B@BA@A@@@@B@@@B@@@@A@@FC@@BABAB@@AB@@@DCBA@@@AB@@@@AB@@@B@@AB@@@BA@@BABA@@BABC@@BABA@A@@BA@@@@JAHCB@@@@@RBPDD@@B@@B@B@F@H@HAB@F@B@BAB@B@BC@@BAAA@AACAA@CAC@E@E@CBCFGDEBC@@D@H@H@BBBBB@B@BABABA@@D@@CBCBCB@BAFDDBBBDDB@@ABABABC@@@E@I@E@EAE@A@ABA@A@A@ABG@A@A@C@@@AAAAC@A@@@A@@AC@@A@GA@AA@AA@AA@@C@AA@C@A@AA@AA@@AA@AAAAA@A@AAA@A@AAEAAAA@@AC@AACAIAAAAA@@CA@A@@AAC@EAAACAAAAAA@AC@ACAC@GCEAA@CA@ACC@@AAA@CAA@AAAAA@EAA@@ACEAAAAA@AACACA@@A@AAA@@AAAA@CACA@ACA@AA@EAAAA@@AA@A@@@CA@A@@@AC@A@CAAAAAC@AA@AA@A@A@A@A@A@@A@@ECA@A@@C@@AAC@CAAAAAA@EA@AAA@@@@@AA@AA@@ACCEGCCCEAIEIASEGCUGGCMKGCACFA@CBC@CEGEECKCCASBIBQASAYBQBECKBCCA@GBS@GAGAKBECABS@EFCBIDG@MDIAEBI@EDGAIAG@KFCBCEOAGDG@EHE@CGCGEMKGIKKKMKIIMGGCGBELIF@JEBA@CAGACFM@EEG@IAOFE@AACGGAG@CFCHCH@HCRGZIJCNBN@NADEEC@EFAFAPBXHLDJDJF@@H@TDRBNDL@JAP@LCHGFAFBFBJDNHJFHDJDNAJELELBJGBGBANBJBNJHBJDHBJGH@BEPGJBHDFDJJJAJAPIJAD@VDNFF@DAH@JCJDFDF@JFRCHEF@RHPALATDFBH@JCFG@EACAMDAHAHGDGFMAEDGJCNCBEFCR@AGCEBCFMEEMIM@GAIAGCGECGCCBIAAGAE@IEEGAAACCEGCIAEEMCKDG@MEEE@ICGAECCAEFKACECCCCAEC@CJKNGPIPKFI@IAEFGFCLC@EFEJCHE@CAG@@EEEEAAEECGAAAGAA@GBG@@ECECIIY[EEIIEAIGEGGAOECEIAA@IHEHIFGBIBM@IBCBEBACACCAEBSCC@EDAHDFAHCHGHIHI@OGGCE@MIGOG@IAI@MAIAMBKFMBGAEEIICAA@GFIAGCEGG@O@ECC@C@IBM@CACCC@KECCEAABEBUDGDKDE@EBQTIDAACE@MEAGAGBE@MAEBAAEEA@GBAFKBAECIEBEBEBEAICQAIBG@AAEKIMIKKCSAGBEDKJGJOJKHCFKDEAGGAKKKG@G@@@AA@AA@CAECA@@AAACAAA@@AD@B@BA@CBABC@C@CB@@CF@BC@A@@@A@CBC@C@A@AAAAA@A@@@A@ABC@G@C@C@C@@@AAGAAAAAABABA@A@C@AAA@C@E@C@K@EBA@CAEBA@AAA@C@A@@@A@A@CDADA@AAAAGCAAAAAACCA@@A@AAA@GAAAAAC@@@@A@CBG@C@C@C@AAC@I@A@@@E@EAA@AA@A@A@@@CB[DEBMAIFCNAFEBCCKIICQBEGAKDQCGBKPOR[TMFKDMBAAC@A@A@A@@B@@A@AAICIAEAE@EAE@C@C@I@@AA@@AA@A@C@ABCBCF@BABAF@BCFCD@B@@B@DBHFBB@@@DAD@BCD@BADA@@BCBADGD@DAFADA@CDCB@@A@BB@B@B@BAD@B@B@DAB@BA@ABABCBC@ABCDA@CBABABCDA@ABA@@@BB@BA@ABCFEBEA@@AD@B@BCFCBFFBBBD@@KJA@IBM@C@GEIGAC@EEK@ASCE@MBEBI@I@A@CCACCAGHAB@DC@QFWFE@ECAAAAIEG@CAC@QDC@@@CACAC@GBIAA@BCACCCQK@DEBED@@GGIGAAiMWBQFEB@BA@ABKDEHEFIF@BAFOAGDE@A@CGCCA@SBC@C@GDGDSDC@CAAAQBC@AAECI@ICGDC@MFKDGBEC@@CBE@CBAACAAAKEEGKEKFGFIDM@IFUDQ@AAG@@@ED@@E@QEG@E@A@"],encodeOffsets:[[82490,43381]]}},{type:"Feature",id:"654027",properties:{name:"特克斯县",cp:[81.836206,43.217183],childNum:1},geometry:{type:"Polygon",coordinates:["@@_@GCACBEGEOOSMQCM@UFQFSAYBMBI@CBG@I@KDKBEE@KJG@COGBEFE@CIKGCM@ABMD@AK@E@ABEBIBGDCFBLGJEBADMLGBOC@@AH@HBBBHBBDHFFBBFFFF@@BH@DGFIDEF@FKDEDEHBF@JEJOLOJMHIL@DFDDBDDFDBDELBFDDBFDH@JFFNFH@LCNDFFJBHDDFBDBBFHJFF@HBBBAJDDDHHFHDJBHBN@NJFFENADDFBHQ@EDAFMDIDCHBFENCHGHGBCBBNBD@FEHIDG@EASCKBOBQGE@GFQDIEE@ECICIDG@CBE@MEUCC@IBOJIBIBIIECGCIAOHAFG@IHGAICGAMIIAMAABAHIHKAKFIFMBICGCIEMGICEAEAEBGHKDO@IBK@MCQASCG@@@IEICKCWGOAEBEB@FFDCFMBM@MAIDYJQHGDG@GDED@DBHHHBD@BEFBP@JFH@FENBDBH@DABIFE@KJAFDHHHJNLJLNLLHJNLHFHD@DGF@FCHBHFPADED@LBHBJCH@FAJBFCJ@NCHAJED@FATDBAFBLBH@HAT@HDBADDLAFARBZBTARAJBTDDDLFFFH@DAD@DEBBDHDNLHDVHHDTFJBJFFBDDHDDFBD@@BBB@@B@@@@BB@BFBB@BBBBDBD@BB@@@DB@B@FD@@@BB@B@B@B@B@B@@BBBD@BBBBDBB@D@@B@@@BDB@@B@B@@BB@BBFBB@@BDB@BDBDBB@BB@BB@BBB@@@DBDBBBB@BBBBDF@BB@FBB@BBBBB@DBB@BB@@DD@BDBB@FBHDD@DB@BBDB@BBBBDBBBFBD@BB@@@BDB@@BBBBJBDBBBD@@BB@BBFBBBB@B@BBB@B@BBBBB@@BB@@BBBB@D@B@@B@DB@@BBBB@@BHBB@@@BD@@@B@@@BBDBB@B@@@D@B@BAH@B@B@BAB@B@BBF@F@F@J@F@@ADABAB@BA@CCAACAECABA@ADAD@DC@@@ABABABA@A@AAAAG@G@C@@@ADCFEHAD@D@F@FBD@DBBBD@BBBAB@@ADA@A@ABA@E@A@GBG@E@A@A@@@@AC@OCQA@@@@A@GDIB@@@@AB@@@BABAB@@ADAB@@ABAB@@AB@@A@@BA@@@A@@B@@A@@B@@ABCD@@A@@BA@ABAB@@ED@@@B@@A@@@A@@@@@@BABA@@@@BA@A@@BA@@BA@@@ABABA@A@ABAB@BA@@B@@AB@@ABCB@@@@@@CBABA@ABABA@@@@BABADAB@@@B@@@@ABA@CB@@@@@B@BA@@BABABA@AB@@ABAB@@ABA@ABA@@BA@@@@B@@@BA@C@@@CB@@@BABABA@AF@@A@CB@BABAB@@EFADA@AB@@@B@@@B@BA@@BA@@B@BADAD@DCD@BADAB@B@B@B@B@BBB@@@B@BBBBD@B@D@B@B@BABAB@BA@CBABA@A@CBA@A@ABE@CBC@@BA@A@ABCBEB@@A@@@AB@@A@ABAB@BA@@BA@ADCBCBA@A@E@G@CA@@C@ABG@E@A@A@E@EBC@ABCBA@C@ABEDA@ABCB@@ABEB@@@@A@A@ABI@C@C@I@CBC@KBABA@@@ABADA@CBCDCD@@@FABAFADABADAD@D@@AB@B@B@@BB@BBD@@@B@DAB@@@@AB@B@B@@@B@@@BAB@BADAB@BA@@BA@ABAB@BCB@B@@@DB@BD@@@BA@@@E@@B@@@B@BBB@BB@@FABABAB@BADABAB@B@BABBDAD@BB@DD@@@BB@@DA@@BB@BBBBBBJ@@BB@B@BAD@D@FADDBB@@D@FAHAJCB@HCJCB@JCFAB@HCJBD@JBB@DFBBFFFF@BFDHFJBJBD@J@F@HCFAFEHE@@L@F@FFFFBBDFBDJ@HBJ@JAD@JBJBJBB@HDHDJ@B@JBJBJ@B@JBHDH@J@@@DBBBDBBBBBB@@@B@BCB@@@DCBAB@FADAB@@A@CBA@AB@BA@@B@B@B@BA@@JBF@D@D@F@HAF@@@@C@AA@AA@A@@@AB@BADADAB@B@B@BAB@DABABAB@DAB@DBBBDBB@@@D@@@D@D@F@B@BB@@D@F@DBDBB@@@BAB@@AD@BABBB@BAD@BA@@BC@A@@B@D@@@B@@A@@B@B@BAB@B@B@BA@BB@B@BA@@BBBBDBB@B@D@J@B@DADCFAB@B@D@B@@@B@B@BAFEB@F@DBB@BADABAHC@@D@B@BAB@@@B@BBF@D@B@@@BA@@BABAB@FAB@BAHEB@D@B@D@BAD@BBBBFD@@BBBAB@@ABAD@DAF@BAB@BA@@B@B@DA@A@@BBB@@@DA@AD@DBBBBBDBJ@B@B@FBB@D@BADABCBAB@D@B@B@DABBB@BBB@D@B@B@D@@@BA@AA@@A@A@@BA@@B@@@B@@ABAB@BCB@BABABABABABABA@ABABAB@B@@ADCBCBA@KBA@ABCDA@@DCHAD@@AD@B@B@BAB@@AB@DADABAD@DCB@JCBAD@B@BABBB@DA@@BBB@B@@@BA@@@@B@B@DBDBB@@@B@B@BCB@BA@@D@@AB@@AJCB@@AB@@@B@B@B@@@BAB@@@B@@ABA@@@@BAB@@AB@BCB@@AB@@@B@@ABA@@BB@@BB@ABB@@@@BB@@B@@@BA@@@AB@@BB@B@BAFA@AD@@BBDBDBDBBBDDDB@BBD@DBB@DBB@B@B@DBB@B@BADC@ADABAB@D@DB@@B@@@B@F@@@BABAD@@@B@F@@@D@B@B@B@B@B@@@BB@@B@DAD@B@BADAB@B@DAB@D@B@B@BB@@FBB@@BDBB@D@B@B@DBDBD@B@BB@@BB@B@B@@BBBBB@BBDBB@BBBBB@@@DADB@@DA@@DBB@@@DA@AB@BABABAD@DBB@@B@@D@FA@@BAB@@AD@D@B@@@B@@@B@DDBBD@BBBBD@B@BBB@B@DADCBAB@B@@BB@DBDAD@B@D@@@BABA@A@AB@D@DBB@B@B@BDB@D@DBB@FB@BBB@BBB@@FB@BB@D@B@@@B@B@B@BBB@B@B@DA@@BA@AB@BB@@BA@AB@@@D@@@@@DABBB@DBJAB@BBB@BBB@BA@@@A@AB@@@B@DCD@B@@@B@B@B@@@B@BADBD@BADA@@B@BABAB@@@BBBD@@B@F@@@BAB@@@B@@BB@B@@@B@@AB@B@B@B@@AB@@C@C@A@CBA@@BAB@FAB@FB@@B@BADCB@@@B@B@B@B@FAB@B@B@DAB@B@@@B@BBB@BBD@B@B@B@B@B@@@B@BB@B@@B@@B@B@B@BB@@B@@BBBBB@@BB@B@B@@@F@B@HABA@BB@BAB@H@D@DBHBB@BAB@FDBB@@B@D@D@B@@@DAB@BBD@@@D@DBB@D@@@B@@@B@DAB@@@B@BAD@D@B@@@B@B@@A@@@AB@BAB@@@FBFBB@BBB@B@B@@@B@@BB@B@BA@@B@BAD@BAD@B@B@D@DE@AB@BAB@D@JB@@DBD@FBB@D@B@DAB@B@BBB@@BB@@BB@D@@@BBBBB@B@@@BB@@B@BA@@D@B@B@@@BAB@@@@@B@BB@@BB@@@BBB@@BBB@@@D@@@FAFABAD@FAF@BAB@BBF@B@D@DBB@B@@A@BB@B@@@B@BADEB@BAFBBAD@B@@@B@B@B@B@@@BAD@BAB@B@@@BBDBB@BD@@DBJBB@BDBBB@BBB@B@@B@@BB@@B@@@FEBADCBADC@@BCBC@@BE@@BA@I@ABC@ABABABABA@ADADABCFABAD@DEB@BCBC@ABADI@CBC@AAA@AAA@@@A@A@@BCBC@ABCGGCC@A@@CCAAC@A@A@@@AA@@@CAA@C@I@A@ABCBA@C@C@AACAC@A@@@ABAAA@AAEACAE@A@@BCB@AC@C@C@AB@@A@AA@CGAC@A@C@EAC@@@AAC@A@@@A@CB@BABABAD@BAD@B@J@D@@@@@@A@A@A@C@@AACACA@@E@A@C@A@@ACAE@A@@AAE@CAA@C@A@A@A@A@A@C@ABA@@DA@A@AAC@ABA@C@@BA@AAA@A@@@A@@BA@A@A@A@@@@@@@AAC@A@@CAA@AAAA@A@@AA@A@A@A@AAA@ABABA@ABADADAJEBABABA@ABABC@ABA@ABADGDC@A@C@C@AAA@A@@AI@@@AAAAA@G@@@C@@ACBIBCBABALAF@@@@A@A@A@AB@@C@@@ABAB@DE@ABABC@A@A@ABADADADABAB@@A@@DA@@@@B@B@BABA@@DAD@DCFAD@HABAB@BA@@D@BAHEFC@A@@BCBA@@B@@C@ABA@@@@DCBA@A@A@@@ABA@ABA@C@@BABA@A@CDCBCBAAA@A@ABC@ABIDGBADEBCBODE@GBKBCBG@CAA@ABAXIJAJAJ@L@TA`EJ@F@HBT@HBACCC@CAECA@EBCBIAGAG@MBIBKAGCECAC@IDMCIDE@GDKBODCDC@EACCCAGAGBMBG@IFCDCDIDMHIBMFK@K@EAMGGEAMDIFI@AAEBKEIMGI@KBcAKIIIGK@QFMBI@YHIDIH@JALFL@FAHCPKFCBEFCBCDCDCHAXKDADA@EAE@A@IDEFCHCJAFANCJGDGEEA@@CDAJAJIDCFCNIHCDABCAE@GDKDE@G@EJKDEAGAC@CJGD@FAF@HC@GDG@CAAAA@ABABADCHCBC@AGK@E@K@AAECEGGEE@G@CFIFIJGLEMCGEHGDG@AACCAAC@EIGEEAEBCAMAAICAAM@IECGGCICK@KFOFQ@QGWGOBSFWB[BUCOAcF[ACO[IIDQFOPEFEDEDWEQKQ@I@GCCGBANGBA@CCEAGEG@CBIFGACEEWDK@KECGCOO@OAMAUGKEI@MFKBOFGAO@G@SHUFQASGeSGKQOMBIFQJKFQFEDAFMFG@CAAGBIBE@E@EEQAGB@DK@GGOKCGEKAQBMFEBUBK@ICIKCOCEGCQAG@MBUFMDM@GBa@"],encodeOffsets:[[83969,43445]]}},{type:"Feature",id:"654028",properties:{name:"尼勒克县",cp:[82.511809,43.800247],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@JCDAHADAHAFCHCFCDCFEFEBAFEBAHCHCHCB@JAB@J@J@B@FDHBDDFBHDF@F@HCBAHEHCBAHCHCB@HAJ@DAJBF@J@B@@BFBDDBBDDFDDDB@B@@@B@FCB@@ABAB@@@B@B@@BB@@B@B@B@BB@BBDBB@BBFAFABAFAD@D@B@FBB@DBB@B@D@B@B@@BB@@@@@BABA@AFAB@@@B@B@@AB@B@D@B@DAB@@A@A@CBA@AB@B@BA@@BADC@@DAB@BA@@@@@ACAAAGAEA@A@ADE@AFG@@FEHEFCHCDAFEB@HCFAJCB@JAH@JBFBFFFF@@FDDBJ@F@J@B@FCHCFEFE@@FE@@BEFEFA^IRIFCNAPDVFB@B@BBBBF@D@DADC@@B@DAB@B@BA@@BC@@BABAD@@@DAD@@ABACCAAA@BAB@BA@@@ABABE@ABA@@BABC@ABA@CB@@@B@F@D@D@B@B@HBFA@@B@FAD@B@B@B@@@@A@@AC@C@A@@C@@@A@AACACC@@CAAACC@@FCBA@@@@@AGACAEAGAC@@AAA@AAAEAAA@A@@@ABADABABA@A@@@A@@HCB@B@B@BAB@D@DAB@@@B@@@B@B@B@B@FABAD@B@@CAA@CCA@@@A@@@@DCB@B@B@B@B@@@BABABA@@B@B@D@B@BBB@@BBBBFB@D@B@B@DADABABABA@AB@B@FAD@FCD@B@@AB@F@BADADAL@H@@@@@@A@ABA@@BABCBC@@FAHC@@@EJCB@B@B@B@JCB@@@F@DAB@DBD@@B@B@@B@B@D@FADAD@BADAB@BCB@D@F@BDDBDBFBB@BBBBBFBD@@BBF@F@DAD@BA@@BC@CBABA@@B@DADAD@@ABA@ABC@A@@DAD@D@DBBAD@B@BABABCB@@A@A@A@A@CA@@AA@@@BCDC@A@@@AAAAAAA@A@@BCDC@@AGAAB@@AD@F@@AFA@@B@@A@AAAACAA@A@@B@DAD@D@B@FDLBB@BAB@D@D@B@B@@EBEBCBAB@B@BAB@BA@@BBBBB@DBL@@@BB@BB@B@B@D@D@@@B@@B@B@BBBBD@@@BB@DBHBFB@BBB@@@@BBD@BBB@BBFDDBB@@ABABA@@BAB@DAFADA@AJADA@@@A@A@ABABABAB@BAD@B@DABAB@B@D@B@FBB@DD@B@B@B@@@@B@BBF@F@F@D@DBJ@B@FADBB@DBB@BDBBB@FBB@BB@@BAB@BA@@DCFAB@@@B@FBDBF@B@PAB@DBB@B@DAB@FBBBD@B@@@@@B@BAB@BB@@B@@@@C@@B@D@D@DDDBFDB@B@@@@@AB@@BBDFB@@BA@@BAB@@@@BBBB@@BBFBB@FBB@BADA@@D@DADABBB@B@B@B@B@@@B@B@B@@@B@BAB@B@@AB@D@F@FBBBB@B@BBB@D@D@BBB@@@@CB@@A@AD@B@FAF@B@D@DA@@@A@ABA@@DAB@BABA@@BA@@BABA@@D@FAB@DBHCD@@@DAB@@AD@BAF@F@FAB@@@DBB@F@B@HA@@B@B@@ADABAB@DBD@DBB@BADCB@@AB@D@AAA@@A@A@@@ABC@AA@A@AAAABAAA@@CAE@C@A@CAA@AAAA@@@A@AAA@AB@@A@AA@@AAA@AA@AA@@@CAAAA@ACAGC@@A@EEIU@UNQ^G`ARB^ERGnSRITGZ@\\BZDXFV@BBBB@B@@BBDBBBDB@@BB@@D@@@B@BABA@@@@BAB@B@BA@A@ABA@A@@BAB@BA@@AAB@@ABA@ABC@@FC@ABA@AB@D@F@BABAB@FAB@DC@@B@@AAA@A@@@AA@@@@ABABA@CBCBCBA@A@ABC@AB@@AB@BABC@C@CB@BC@@@A@AA@@AAA@@BE@CAA@@BAB@@@B@DAF@LA@@@C@@@ABADCB@FAB@@@B@BAD@B@DABBB@BBF@B@@@D@BBDBBBBBTBLBBABBB@@@B@BA@@N@FBD@DA@@BA@C@AB@@AA@@A@AEAAA@@@CBC@@@A@AAAEAA@@@@A@@B@DC@AB@@AAAACAAA@AA@@@A@A@@BG@@B@@A@@@A@A@CBA@@@A@AAC@@DABAD@BA@@BE@C@@BA@A@C@A@@BABA@@BABADCBA@@@@@APKXQ@@A@@@@A@@AA@@@A@@@A@@@A@A@@BA@A@@B@BA@@@@B@@@BA@@B@@@BABABCB@@A@@B@@A@@BAB@@@B@@@B@BBB@BBB@B@@BBAB@B@B@B@D@@AB@@@@@@@@A@A@@AA@@A@@A@@@A@@@A@@@@@A@@@A@@@A@@@@@@AA@@@@A@@AA@@A@@@@@A@A@@@@@AB@@A@@@@@A@A@@@A@@@A@@@A@@@AAA@@AA@@@@@A@AAA@@@@@@@AAAAA@AAA@@AA@A@AAA@A@@A@@A@@AA@@@@BABA@@@@AA@AA@@A@@AA@@@@@AA@@@@AA@@@A@A@AAA@@@@@AA@@@@BA@A@@AA@A@A@@AA@@@A@@AA@AA@AAAA@@@@A@A@CA@@A@A@@@A@A@AA@@A@AAA@@AC@AA@@A@A@@@A@A@A@@@A@A@@AC@@@@@A@@@A@AB@@A@A@A@C@A@A@@@CBAB@@AB@B@@A@@@@BAB@B@@@@@B@@@@@B@@A@@@@@A@@@@@AB@@@@A@@@@@@@A@@@@@@@AA@@E@A@C@AAA@@@ABA@A@A@AB@@@@A@AB@B@@A@@BA@@B@B@@@@@@A@CBA@ABA@@@A@@AA@@@@@@@@@A@@AA@@@A@A@AA@@@@@@A@ABA@A@@@A@A@A@@AC@@@A@A@@BA@A@A@A@AB@@ABABA@ABABAB@@ABA@@@AB@@@@AB@@@@@B@@@@@@A@AA@@A@@@@@A@A@A@@@@@A@A@A@A@@@A@@BA@@@A@@@A@@B@@A@@B@@A@@B@@@@@@BB@@@@@@B@@@@@BB@@@@B@@@@@@B@@@B@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@AA@@@@@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@@@@@A@@B@@@@@@@@@B@@@@@B@@@BB@@B@@@BB@@@@B@@A@@@A@@BA@@BA@@B@@A@@B@@A@@@A@@@AB@@A@@@@@@@@@AB@@@@@B@@@@@@@BA@@@C@A@@@@@C@CBA@A@@A@@A@@@@@@A@@A@@AAA@@A@@A@@A@@@A@@@C@@@A@A@@@A@@@A@AAA@A@A@@A@@@A@@@A@AA@@@A@A@@@A@@BA@A@A@A@A@A@@@A@C@AB@@A@@@A@A@@@@@A@@A@@@@AA@A@@C@C@@@A@@@A@@@A@@@A@AB@@AB@B@@ABA@@@A@@@@A@@BC@A@@@A@ABA@A@@A@A@@@A@C@@BA@@@C@A@@@@@A@@BC@ABA@@@@@A@@@@@@@@@@AA@@@@A@@A@@@A@A@@@@BE@@@AACBA@A@@@A@AB@@ABA@ABAB@@@BAB@@A@ABCB@@@@@@A@@@A@ABA@@@A@@@@@@@@@@BA@@@@B@@AB@B@D@B@@@B@@@B@@@@A@@@@@A@@@ABA@A@@@A@@@A@@B@BA@@B@@@BB@@BA@@@A@@@AB@B@@@BA@@BA@@@ABCBA@AB@@AB@@@@ABC@A@A@@@A@A@A@AB@@A@@@A@A@@@C@@BABAB@@ABA@@@A@@B@A@@A@@@@AA@A@@@AA@@@@@A@A@A@ABA@AB@@ABAB@@A@ABA@@@A@@AC@AAAAACAE@A@A@@@@A@AAACAAACAAAA@A@AA@@@A@A@A@A@@@@C@E@E@@@@CAA@@AA@CA@@A@@BA@@BABA@@@A@A@@@A@@@@@A@@@A@C@@@@@A@@AAA@@@CA@@@@A@@@@@@@@@A@A@AB@@A@@@AA@@@@AA@@A@@@A@@@C@@@AA@@ABA@@@A@@@A@@B@@@@@@AB@@@@@BA@@@@@A@@@@@@@A@@@A@@@@@@@@@A@AA@@A@AA@@@@A@AA@@@@A@A@@@@@A@@@AA@@@@A@@@A@@@@@A@@@A@@@A@@@AB@@@@A@@@@@AB@@A@@@@@@@A@@A@@@@@@AA@@A@A@@@A@A@C@@@A@A@@@A@@AA@@@A@@AC@AAA@A@@AA@@@@ABA@AB@@A@@B@@@@AA@@@@@@@A@AAA@ABA@@@AAA@C@@@C@C@A@A@C@A@@@C@A@A@ABA@@@@BAB@@@@A@ABA@@@@@CBAA@B@@A@@@@B@@M@cJ[HAB@BBBB@@B@B@BBB@B@@@BADADADA@A@C@E@@@AA@AAAACAA@@A@C@A@C@CA@@CBA@E@CBCBC@CBAB@@AD@DADAB@@@@C@A@AB@@ABAB@B@B@@BB@B@@@BA@C@A@EBA@C@AB@D@B@@A@A@@@AAA@CAA@GAA@A@GBCBCBCBA@A@A@@BA@@D@@@@ABC@GBA@EBC@C@A@@@A@AAAAA@A@@BAB@@@@C@C@EBC@A@EDABAB@BA@GDA@CDCF@BA@A@AAA@ABA@EAKCA@A@C@CBA@C@ABC@OE@@C@E@A@CAA@C@A@C@ABAB_IWAYDYB}D_@eAKASEQ@KHKJMPMLSH]POB[ASCiK_AUDQFA@A@IBA@E@G@E@CA@@@B@@@DBBB@@D@B@@@B@@@@C@@@CBA@ABADABA@@BADABABA@A@A@EAA@AB@@@B@BA@A@A@AAGAEAAAC@CAEAG@A@EEEC@@ABCDABEBA@C@G@CBC@A@IAECCCCAAAA@C@ABAFADAF@DB@AB@BIDGBCBA@C@A@EAAAC@G@EAAAACA@C@A@AAAA@AAEA@EBI@E@A@CAA@EBC@C@A@CBABCBADEBC@ABC@A@AA@@AAE@GCC@A@E@E@A@CBEBGBEBC@GAE@E@E@ABCD@@A@C@C@C@AB@B@B@JAF@DBD@BA@@@EAEBC@IDEDCBCHA@@BCDADABABA@C@E@MCC@AAABA@CBABCBCB@BACCACAA@C@C@C@CCC@A@A@EBA@ABA@CBEBA@C@ABABCBAB@@A@C@A@A@A@AFAD@FABABCFAFA@CBABAB@BAD@@CBCBC@C@CBA@E@AAII@@A@ABA@CB@BA@A@C@C@ABC@@@A@CCECAACAAACC@@AAC@A@A@@@A@AB@B@@@BDD@B@@AD@BAB@B@@BB@@@B@@ABAB@BABCB@BA@@@@@E@A@C@A@@B@BCBABCB@@A@A@@@AAA@C@IBE@G@ABA@CB@BA@CA@BC@GBA@@BABABAB@DGBCAK@A@C@A@ABABAB@BA@@AA@@@AAA@ABA@@@A@E@A@CCA@@@C@A@A@A@CB@@E@A@AA@@A@@@A@ABABCB@@C@A@@BE@@@A@CAA@A@CBA@A@ABCDCBCHABADABCDABA@ABC@C@CB@@A@@A@@@ACA@@C@CB@@AA@@CCA@AA@@CAA@ICEBE@@@CAA@A@A@@@@@A@A@AB@@C@A@EAA@A@G@E@ABGBDVHvN`@fBJBHBD@DB@B@@@B@BAB@@@D@D@BAB@@@@B@BC@A@ABCDC@EBG@EBA@E@EAC@E@EBCBAAAAC@CBCBC@C@ABABABCDA@ABC@CAC@A@ABABABC@ABADC@A@@BCBABAB@@A@A@A@CA@A@GAGJW^QBACJ@@_@EK[ACC[AYEUIBABA@A@@@A@AA@AA@C@AA@@A@@AAAA@AAA@@AAAAAGA@@A@@@ABAAA@AAAAA@AA@CAEAA@@C@@@CBA@@@A@AA@C@@@A@A@AAAAAAA@F@D@BADABCD@@@B@@A@@BB@@B@@@@A@@@@@@B@@@@A@@BA@@@@D@@@@@B@@ABB@AB@@@@@@@B@@@@CBABAD@@@BEJABAB@D@DEXCFAD@D@B@F@B@B@BBBB@BB@@ABA@@@AB@B@BCFCFADABA@@@A@A@ECCA@AA@@AC@ACACA@C@ECA@A@CDC@CB@@@BA@EBA@A@A@AAA@@C@@AAA@QAA@C@A@C@GAA@@B@@@BCBABEB@@@@CA@@AAAACACAA@@BE@C@C@A@@@ABABABAA@@A@A@IBA@A@A@CBEAC@AB@@AAECCA@@AAA@E@@@EGAA@C@C@A@AAA@AA@AAA@A@A@@BA@A@A@@A@@AAAAAAAAAAA@AAEAA@A@AC@@AAAAC@GACAA@C@A@AAC@A@A@CACAAACAA@ECA@AAAA@@CAA@C@A@A@A@C@C@AB@@AB@@@BABA@A@CAA@@@AB@@CBABA@A@C@AAA@A@ABABCBABA@A@@BA@C@C@A@A@A@A@@@C@A@ABCBGBE@EBCD@BCBEB@@ADC@CBEB@B@@BBB@BB@B@BBBBB@B@@@BA@EDEDA@EDCBAB@@EAA@A@C@A@C@@AA@CBAB@@A@@@AACC@AA@A@C@E@@@@@AC@@@A@ABABAA@@C@@@ABA@A@@A@CAAA@AA@@ACAA@@B@@A@@B@BAB@@@B@@ABA@@@AB@@A@A@AAA@@ACCAAACAACCMGCAAAEEGMCACEAEAACC@AAA@AA@AAIECAC@C@A@A@EBA@A@E@E@CAA@A@C@@@A@@@A@A@ABIgEQFE@OSK@MBU@IBQBCBCDS@]aWIC[J]ASAcGCFKBE@MBGAG@kKBL@BUFSD@AA@C@C@AAAAGCEDE@IBIBI@IBID@@CF@DAFAHCHCBABCDCBIBCBIBI@IBIBA@IAI@IAC@ICIAGCIAICA@IAIAG@I@I@C@I@IBI@I@IBIACE@EAGACCEC@GDIBIBIBIBBFADCHE@K@IBEFEDCFCHEFABGDCBIBCEECGCABGFGDCFEHEFA@IDBPG`_HGBE@GBCAC@A@C@A@A@C@C@E@C@A@C@E@A@E@A@E@CAA@A@E@EBIAI@I@IAI@CDABEDGFG@EAIAIAE@I@GBIBGBIBA@I@KAGAGBI@IBIBEBGDIDGDIBIDA@IA@@GCABGBE@GBC@EBA@A@CAC@EAC@A@EBGBG@C@CAA@AAAAEEE@GAE@G@E@IAOAC@C@ABABABABA@A@ABEBC@C@CACAAA@ECCCAK@CBEBCB@@A@A@EAE@C@EAA@IACAKCGA@@C@A@EDA@I@C@A@CBC@ABA@AAA@@@CBA@@BgPKCUIeAoA]DYDsPI^E\\DFFHJLDFFHBBKTEV[BSEVsTABSXGHcZCB_TcPCBSL[bG^FVZZHJXAj@pEZMBCRKRA^GRAAjB^I@F|VA@@@CDALABAF@B@BBDDHBFBFBF@@@DA@@BAB@@@@ADA@ABA@A@A@AAAAA@EBA@C@A@IDC@@BA@@DABA@A@A@A@A@A@CDC@ABA@AB@BC@EDABA@C@A@ABCBAB@B@BAB@@A@A@A@AA@AA@G@A@AA@@A@ABA@E@I@EAC@@@AAABC@A@CBABA@E@A@A@C@@AAA@ACA@@AAAAAAA@A@@@CBABE@A@CAECAAA@A@AB@@@BA@BBBF@B@BA@@@A@A@A@A@AA@AACCAAC@C@A@A@A@AAA@@A@@@CBCDA@A@@@AACAAAAAAAA@AAABA@ADABA@A@A@@@@A@@DA@A@@AA@@A@ABABAB@@@BBBFD@@@B@@A@@BA@C@@AGCC@AAE@C@A@CACAA@A@A@A@@B@@@BBB@BCDEB@BC@C@A@A@EAGC@@A@@@AB@B@@C@AB@BA@@BB@@@BB@B@B@D@@BD@BBB@@@B@@AB@BABAB@BAB@BAB@@CD@@CB@@CBABC@A@@BA@@B@BB@BB@B@H@@@@@@B@BBB@@@@@B@@BD@BBBBB@DDDDDBBB@@@@B@@@@B@BBBABA@A@@@ABA@@@A@@@A@ABA@A@A@GB@@A@@F@B@@@@D@B@@B@@@@C@@BA@@@@BAB@JBJ@B@D@@@@A@A@@B@BBB@@@BB@@@@B@D@B@@A@EBA@CAC@A@E@A@@B@@@BBB@DBBBB@B@BBB@DBHBD@D@BBBBDBJ@BA@@BC@@BA@@@AB@BBBCHABA@@BADBBAB@@@@CBABCB@@G@C@C@A@CBOBADA@AHAB@BB@B@BBB@@BDB@@@@@BB@@@BBBB@@DB@BBBB@BBDBBBB@B@BB@B@@DDBBB@BBB@B@@BD@B@@@B@B@@@B@BB@@B@B@BBB@B@@BB@B@@@@@BBB@@B@@@@@BFBBDDDFBFBBDBB@BAFBBDDBBBDAFCH@D@NBB@BDDDDBBBB@D@H@FBF@NAFBBBBFF@B@BADA@@BFDBBDBDDB@BDD@DBBBDB@BBBFFFBBBBDB@DBB@BBDD@B@BA@AB@@CB@BAB@B@DBB@BBD@B@B@DAD@BCBABCB@B@B@@@D@BA@@B@@BD@@@D@D@@BBDD@@@BBBBDAB@B@BBBBB@B@BBB@B@@BBBB@B@BABABGDCBDF@B@D@BDBBFLF@BCDAJGF@D@D@D@@BBBB@@B@@BC@@BA@@B@@C@A@A@EBEBA@ABC@CBABA@A@ABCBC@A@@BABA@A@EBC@ABC@AD@@CDCB@BA@A@@@A@C@ABA@ABCB@@AD@@@BDHDDBDDF@D@BCBD@F@AB@@ABAB@@ABB@@B@BBDBB@B@B@BBB@DBH@DBH@DBH@FABBB@F@D@J@D@D@DAFADCBB@@@FCB@@@B@BBF@B@B@B@@@BBBAD@DBD@HBHDLBLBJ@H@LAJAHALCPELCPAVCNAJALADCFCFEJEHCHCBBD@D@@@D@B@HAB@H@B@B@B@DADCF@H@D@F@B@B@B@B@B@D@@@B@@ABAD@D@D@BAD@B@DAB@B@D@D@D@B@DAB@DBF@B@BB@@B@F@D@D@B@DBB@D@D@B@BAH@B@B@BAF@DAH@B@@ABA@@DAB@BCB@BAB@@@D@D@B@FBB@DBDBBB@@D@J@B@HDDBBBB@BCB@B@@@D@D@D@B@BBFBD@DBB@DAD@H@D@DBBBB@@B@@@B@BD@@BJB@@D@BBB@D@D@B@BCBABCBADA@AACBABABCBAFADAB@F@D@DAD@FCBCBA@ABC@A@C@C@CBABCDEFIDCFCB@ACHAF@BAF@HAD@DAFAB@ACB@@ABADCDC@MDCB@FCFCDCBCBABADEFAJCHALGD@X@LAFAFEDAJAFCFEDAD@B@B@BBB@B@B@FABADA@@@@B@@@@@FAB@@@BDB@B@B@DCDAFC@@BBB@F@B@FCDANGFEBAF@BAJG@@B@B@HAFCFCPAB@F@B@HCJAB@DABB@AF@B@NARAB@@@DAB@LED@F@B@D@@@F@BBBAD@@AF@B@@AB@B@D@BA@@HC@@B@@@B@B@FCDAJCDAF@DGBC@AB@@AB@D@BADCFEHCDCBE@CACAABG@EB@AE@ADC@ABA@@BABA@@B@DBB@@ADABAB@B@JBFBF@D@B@BBFB@BB@BAB@BCFAH@B@@@BBBBB@@BD@B@F@FAD@D@B@BBDDBBB@@@BBB@@BBBB@F@B@FAD@FBB@BB@D@BB@DBF@@BB@@ABABAB@@@BA@A@@BAD@BAB@BA@ABABAB@B@B@DABADAJ@D@D@DBFBFBF@D@B@BAB@B@BABABA@@FAHBB@BBB@@@@@DCB@BAD@D@BAB@@ADAB@B@DAB@B@@@DBFBDBD@@@@@@@@@BAJA@AD@DBB@B@@AB@@AB@AC@ABA@ABEBABA@ABA@@BBD@DBBB@AB@BAF@BAB@BBB@BAB@@@B@BBBBDD@BB@BB@@AB@B@BBBDBB@B@@BB@BD@BFD@@D@D@B@@@@A@ABAB@F@B@B@DBDB@@@B@@@@BBBDBB@B@@@B@F@@B@BBDDBBF@B@DBB@B@FCB@@AJCBAB@BAB@@ABAF@BABAB@B@D@B@@@DDB@FD@BBBBB@@@B@B@B@B@@BB@B@D@@D@@BBB@@BBB@D@D@B@B@B@B@@@HDDALCTCNAJAL@TBPBbDdDVB`BZ@L@RAPCPCPERABAB@BAD@F@D@BADABA@@FADAB@DBDBD@@@F@F@B@B@BBB@H@J@B@F@DBFBB@@@BABAB@F@DAB@BABA@AFAJAFABA@@@A@AB@@A@@AE@@@AB@@AAA@A@ABCFG@AB@B@@@@AA@II@@@AAAAA@ACAAA@@FAB@LADB@@B@B@B@B@BAD@BAHBB@BABAHCB@HCDCHKJIVKRILGNAP@R@VBR@V@RDTBVDND@@@ABC@@DA@@BAD@@AF@BCBA@@B@B@DBBDB@DBB@B@FALEB@@@BBBB@BADABAB@B@B@@@B@BB@BBD@BBDAF@D@B@BBB@@BDDB@BBB@B@@BB@BBFBDBB@B@BBBBB@@BBBBB@BBBFBBBBB@@J@F@DAD@DAB@BCB@BAFA@@BA@C@AD@B@DABAB@DAD@@@BA@A@G@A@A@A@A@A@A@A@@@A@@AA@@ACA@@A@@@CBC@A@@D@F@@@BBB@B@B@@@D@B@DBB@@@B@B@B@@@@@BABAB@BBFAB@BBFDB@BBBBDBF@DB@@B@@@BBB@B@D@J@PDJ@L@FAFAFCFCHGBCDGDCDEFGDCFEDEDCFEDCFAHEB@HCDAD@B@DBDDD@@BBADBB@D@HD@@@@DCHEB@BBB@B@D@FBHAB@@@B@DB@@DBB@BB@@BBDBBBD@F@BAB@@@B@BBDDDBDFB@@B@BBB@@B@@@@@BC@ABA@@DA@A"],encodeOffsets:[[85701,45032]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("保山",{type:"FeatureCollection",features:[{type:"Feature",id:"530502",properties:{name:"隆阳区",cp:[99.165607,25.121154],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@I@@AA@ABEDA@@@A@ACAAEB@@A@@C@@AAA@AA@@A@@AAB@@AD@D@@AFCFCFAD@@BD@D@DCBGBABA@AD@D@D@D@@BDDDBD@BABADAJ@TAFCBABKHIHEDEDIBKBKBO@O@KFEJCLCNIREHED@@EBOBGDAD@@CCCCCA@A@@BA@CA@AAA@A@A@@B@B@F@B@@ABAB@D@BA@A@@B@B@B@@ABA@CBAA@@AA@A@@AAA@@@ABADABCDAB@@A@C@AAAAAAAAAAC@A@ABA@ABC@A@@@@B@@B@F@BB@@@BB@FFB@BD@@@DAB@@@DAFAB@BCFEDKBI@EBGHGDA@CAA@ICGIGOIOAAIIGGMIKIGGMCIBEA@AC@CKGIGCIEAAABCFCB@@AACACEAAAAAA@CAAA@A@@A@AAA@C@ABA@AAA@@C@@@@A@ABA@@@@BBDDB@@@BAB@BABAB@@@BB@@ADB@@@B@B@@ABCBCDCFEB@BC@AACACAA@@CAA@C@EAA@CCAA@C@ABABA@@BA@AAA@@DCB@AC@CAAA@@@A@@@A@@A@A@@@@A@E@@@@BB@B@@@@B@BA@A@@@@B@BCAAAA@@@@A@A@A@AEAA@CC@@@@@C@@@A@@ABABAFAB@@A@AB@D@BB@B@B@@@@BAB@BDB@@B@AC@@BA@@B@@@BBB@BABA@@@@@@BB@@@B@@CFC@@B@BA@@@AB@BAB@B@BA@ABA@@@AB@B@B@DA@CB@@CDA@EDA@@@AAA@C@A@ABE@A@A@AA@AB@BC@@@A@EAAAAA@A@ABABAFADCBC@A@AABA@CA@A@AA@@@A@E@AB@DAB@BC@CA@A@AAA@A@CA@AA@@AAAA@C@CAA@@AAA@AB@BBB@@@BA@@AAGA@ACAACAAA@C@@@AC@ABCBAB@DB@BBBBBBBJ@FABABCBC@EAAACA@AA@@A@A@ACCA@@AACAA@AAIAACA@@@A@A@A@A@AAABC@C@@@ABC@A@C@@@@BA@A@A@A@@BA@@BC@AB@BA@CB@@A@@GDEBEBEBCFADAD@BCDCBCBGJQHMHMDEDCCA@AA@CAACAAA@ECCCA@A@@AAAK@@@AAAAA@A@C@@@CBA@ABA@A@CBA@A@AB@@CBC@AAABAF@BD@DBB@AD@@CB@BC@ABAB@F@DABABK@AB@D@LAXADADCDEBG@ABAF@F@D@DAB@@CBG@GBC@GBE@A@G@@@CAED@@A@@BA@C@AAABAAAA@@C@A@AACAAACAAAA@A@@AA@@@@A@A@@@@A@C@A@@@C@A@@@C@C@@@C@C@AA@@CAAA@@C@A@AAC@A@@A@@AA@@A@@@@@@A@@@AAA@@IEOECAABA@CBGJADAFCFAFAD@DCR@DAB@DAN@P@RBR@NCNIT@LAPBPB@@@@@D\\ALBLGRCPHJBHCPENALARETBNDRFRAXALGNCLOHIFCHCPAHANFJJRDNCHAPITARLTHLFNDJELGHEDIHCFBJBF@RAJDLBH@DDLHRDP@TBJENCR@N@NBPBP@LFHBDFPDHBBHJBJEXAN@FFNHP@DAJHNFLDJ@L@BCJAN@RBH@DBRBNFNHLFLALALALCR@BADADCD@DAFBFDFDFBFBD@D@FBD@FBF@D@DCBABCBCDABCD@B@DDBB@B@BADAD@DAF@FAB@HAFAD@JBDBDBHFDDDBHBD@J@H@DAF@FAD@FADAFAB@B@DAF@F@D@D@H@HCF@DBB@BAB@BAB@B@@B@@@BAB@BAB@F@HBD@DBBF@D@B@B@BFFJBD@D@FADCFAB@D@BBBDDFD@BBBBBBDBBBD@D@D@BADEDCDEDA@ABCBA@CDCDAD@BABBBAB@B@BAB@DBBFBBBF@B@FADCB@DBD@BD@A@@@@@@B@@A@@BA@@@@BAB@B@@@BABA@@B@B@@@B@@ABAB@@@B@B@@@BB@@@@BA@@B@B@@@B@@@B@@@BA@@D@B@BB@@@@BABBB@@@BB@BB@@@@@B@@@@@@@BB@@@@B@BBB@B@@@BB@@@@@B@B@@BB@@B@@@@AB@@BB@B@BA@@B@@@@B@B@@B@@@@BBAB@B@B@B@D@D@B@@BBA@@B@@@B@B@@@B@@@@@@@B@B@@B@@D@@ABB@@@@DBB@@@B@@@B@@@B@BADCB@B@@@DAB@B@B@B@BB@AD@B@@@B@@@@B@@@@@@@@@@B@@@DAB@BABADAD@@@B@B@B@B@B@@AB@B@B@B@B@B@D@@@BA@@B@@@B@B@B@@@B@FAB@@@@@BB@@B@@@@B@@@B@B@@B@BB@ABABC@C@E@CAECC@@AACCAEAICA@AB@DCHABA@AAC@GAG@AAAABCBA@@@AABC@EDGBABC@@ACC@A@AC@ABC@E@C@C@C@ABADCB@DA@@LDB@BBHBB@DAF@F@F@F@D@F@B@FBB@@DDDB@@BB@BBF@DADABIBEBA@CB@BCBC@ABCBABCBABADADCBAD@BABABC@E@C@C@A@ABA@ABA@@@A@@AAAACAAAECCCAAAA@A@@@A@C@ABABCBA@A@AAAAACA@A@ABA@@DCBABA@ADCBABAB@DCBA@ABEDABCBC@@@ABCBA@CBABA@@B@@@D@DBDBD@DBB@B@D@B@B@B@B@DBDBBBDBB@DB@@H@D@B@B@BB@@@B@@BB@@B@D@B@@BDD@BDB@@DBDBB@@@BD@BBBBBB@BBDBBBBBDADBB@DBFDD@BBB@@BB@@B@BCB@@AD@BA@@BABAD@BA@@B@BCF@@@D@D@BB@@BB@B@BBF@B@DBB@B@B@B@B@FBB@B@B@DABAB@@A@A@ABA@A@@B@B@B@B@BAB@B@@@BA@@@A@@@A@A@@@@@AB@@BBBBB@B@@B@@BB@@BDFBB@@D@@@FDBD@@@@B@B@B@DAB@FB@@@@BBDBBDB@BBBB@FBFBD@BBBDBDBFBDBD@F@FBDAD@BBB@B@BBBAD@D@B@@@B@BBBBB@B@B@DABCDAB@DAB@DADAB@BAF@BAB@BABCBC@@@@BA@@DADCDA@@BADE@@BA@A@@B@DABAF@F@DBDBB@@AB@BABE@@BAFAD@BAD@D@F@@BF@FBBBB@B@DA@A@A@AACCCAAAC@E@CDEAA@A@@BCBCBCBCDCBCBABCBADAB@BA@CBAB@@A@CBCBC@ABCDABADAB@@ABABABA@A@@@A@A@AACACECA@@AAACC@@AA@AAAAC@A@A@A@C@EBE@EBC@A@C@CBA@A@@BA@A@@@@@A@C@A@AAA@A@@@@AA@AA@AAA@EBC@A@ABABA@C@A@A@ABA@A@@BA@A@A@C@CBCBCBABABAB@BABA@A@A@C@A@@BA@AB@@ECEDABADA@A@A@AAA@A@A@C@A@@@@@A@@AAE@@@ABADA@ABCBC@ADC@@@A@A@CBE@C@EBC@C@I@A@CB@B@BBB@BABABA@C@A@A@AAC@@AAA@AAABA@A@ABABABC@C@@@AAAA@A@ABCDA@AAE@ABABA@ADEBAD@DA@ABA@AFEDCDCDCBABABABE@ABCBABABC@CBEBCBCBCDCDCDCBABA@@BA@A@ABC@CBCDCBABCBCDEB@DCBCBABABABABADAD@D@B@F@D@B@B@B@@@D@B@B@B@DCDAFEBCDABCBE@ABA@A@A@@@A@A@@@AAA@ABCBABCBABEBEBABC@CBADABCDG@@BE@@BCBABCDCBAB@BADC@ABAFABABA@A@ABABEDCDADADCFA@@BADEBADABA@ABAD@BADADABA@CDCDADCDCDCDCBABABABCDCBADADC@A@@BC@@B@BABABCBEBC@CBABA@AB@FCBAB@BABCBADABAD@DCDA@ADAB@BCBAB@DCDAB@DA@ADC@ABAA@A@CAA@AA@A@@@ABC@CBEDCBCBABC@CBG@ABABA@CGKAEAEA[@IIQIQCEGKGOEIAG@G@IAA@A@A@@@A@A@CAC@@AA@A@@AA@C@A@A@A@EAAB@AA@AAA@A@AAA@@@A@AAC@AAE@@BA@A@A@AAA@@AA@AA@@AAACCA@BC@A@@C@A@@B@@@BA@A@C@C@IAEBA@A@IGCCAAA@GAA@AABCACAAAAAA@A@ADCBA@A@C@A@ACC@A@@@ADCBA@C@AB@@A@CAI@@EAAAAA@@A@C@ADEDAB@@A@@@BA@A@C@AAAABC@@@CGA@A@CBCD@DBF@BABABABCBC@ABAECOCKCG@IDK@IAEMOIIEGGMA@AECCIGKGKEGEKIGGIKGGECIEGEMBIBEDA@"],encodeOffsets:[[101681,25432]]}},{type:"Feature",id:"530521",properties:{name:"施甸县",cp:[99.189221,24.723064],childNum:1},geometry:{type:"Polygon",coordinates:["@@YQSCGSWSWaQ@ANAFBR@XBZCXGB@@@@EBOFKB@@QRGHGJINGRADMRGHSVSLINKAYC_BCBOLCDBBLLNNDDRPJLPZT\\Fb@PHLDHDF@VCJHLFDDPBPDNDHALBPNVHF@@FDHHFHGTAHAHCHITGLFNBfMPCNAL@FAHULQFWLMPKNADGTOJ@@ABSBOHS@MNGRDXAJAB@B@BBDDDBBBB@DABCFABA@CBEBA@CDCFGNGNIRAHADCDADC@CBEBADAFAFCF@HB@@@DAB@@ABAD@@AB@@AB@B@B@B@@A@@D@B@D@BA@@D@D@BABBB@B@B@B@@@B@BDJBBBB@DBBB@@DBBDB@B@@@BBB@BDBB@FADADABEBI@AAAAAA@ACAA@ABAD@BBD@@D@B@BBBDDB@BHBBB@@AB@@A@AAA@@BBB@BB@DBD@B@BB@BB@@BDBB@B@BBB@B@@DADA@CBA@@B@F@B@@BBB@B@@DABBBB@D@DABCBEBABAB@B@BBBB@F@B@@ADA@@BBBB@B@F@BAB@D@B@BB@@B@FCB@DC@@DAB@@C@A@ABA@@B@BAB@@A@ABA@ABA@@B@@A@AD@DE@@@A@@AA@@@@@@ABABA@AA@@A@@@AB@@BDA@@@CA@ABA@A@@A@A@A@@A@CBAB@@@BABEBABA@@@B@@@D@@@@DDB@FB@B@B@B@B@@B@BBDB@A@A@@B@B@@A@A@@A@A@@A@@F@B@@@@@@B@BB@@@B@@@B@BB@DBDA@CD@@BB@BAB@@ABAB@B@DBBDDB@FBD@B@DB@@BBBDBD@BADA@EFCDADAD@BA@A@@@A@BC@@AA@@A@ABABA@AB@@A@CCAA@@@@AB@B@B@@D@@@BB@BAB@B@DBB@B@BB@B@BB@DBBBBBBDFDBBB@@DADEBABBJFHDHJDLD@@BFBJANDHHLJNJHHJJBBJPHPHJJDB@DBB@HCHGFAJ@LAFCDE@ABABE@C@@BA@C@@ACA@EEA@@A@@AAE@A@@@@A@@B@D@BAB@BAB@D@BBBBBBBBBBD@B@@@BADCBABCBA@@B@BB@@@BBB@@BBDAB@BA@@@A@A@AB@B@@A@CBABA@@@A@E@A@AB@B@B@BBB@@DAB@@@BDBDDDD@@BCHCPAFA@@FCFGJQDMDKFILEP@P@LALAJAFCFCJGLGBADABE@SBIBCBA@AACCCAC@@@C@C@CBCB@BAHADA@C@CAC@@BCDEDEBE@@@CBC@@BA@A@@A@@@ABABAB@BCA@@CKACBCBCBAFCDABAH@DABA@@@SDGBEBABA@@DD@BDADADAB@@ACSES@A@AB@B@NBD@BA@@CI@ADAHABAJADAFAD@H@B@B@DE@A@CCA@A@AB@@AACAG@ABA@@B@BBB@DBD@B@B@B@FADCDADADCBCBA@AD@DAFABAD@DA@A@AGKCEACACACCCACAA@C@@@EDEAA@A@ABCBC@@@CAGACACKOACIQAAAC@C@E@A@A@A@I@CDI@A@CAE@AB@BCFCBCBAAEBA@ADEB@@AACAA@A@A@A@@DA@A@@AA@AAAAC@A@C@CBABA@@@@BA@ABC@CACCCGE@A@@@CBEBGAAAA@AAC@KACAAACEAEC@C@ADA@E@CAGCACAA@EACC@ABA@CEGEGECEAAA@A@CBCBG@@BAF@F@F@FBF@AECC@A@ACEACCEA@BEBGBCBAFC@ACCEGGECCCOFIFCHEHGDEAIGCGGIESLOEW@GAIAOAIQMOIQ@A@@@@AKOEQYMQGECCACGIB]B[CIIIOCKAMIAFUASK"],encodeOffsets:[[101548,24909]]}},{type:"Feature",id:"530523",properties:{name:"龙陵县",cp:[98.689261,24.586794],childNum:1},geometry:{type:"Polygon",coordinates:["@@E@C@E@E@G@GAGAEAECE@CAE@C@E@CBCBCBABCAA@CAA@AAA@C@C@C@ABGBG@G@E@CACAACAAAAAB@@@@@BA@@@@@@BA@@@A@@@A@@@@@A@@B@@A@@@@AA@@@A@@@A@@@A@@@@@AB@@@@AB@@@@@@AB@@@@@BA@@@@BA@@@@B@@@@@@@BA@@@A@@@A@@@@@@@@BA@@@@@ABA@@@A@@@@@A@@@A@@@A@@@@@A@@B@@@@@@@@B@BB@B@B@DAB@BBB@BBB@B@DBBBD@DBDBDBB@D@D@BBB@DABA@ABA@AB@B@B@D@BBD@B@DBD@D@D@DBBBBDBBBB@DBB@B@DBB@BBDBBBBDD@BBFBDBD@DBBBDBDDBBBBBBBB@B@DAB@BABA@AD@@@B@D@BAB@D@BADADAF@FAD@DAD@@CBA@ADABCBADCDCBADCD@DCB@F@B@B@BB@BBBB@B@B@DAD@D@D@BADA@@D@FAB@D@DBDBDBBBDBDBDBD@DBDBBBB@D@D@DBD@@@@@DDBB@FBDBDDBH@B@BA@@BDH@BABABA@@BA@ABC@ADAB@B@BBBBB@DBBBD@B@DBB@DBDBBD@B@BBBB@BA@@B@DBF@B@BC@A@A@ABEDCDAD@BBD@BABABA@AB@B@BFH@DAB@DABABA@AB@BCBA@CBCB@B@B@BBB@B@DAB@BA@ABAB@@ABEB@@CDC@CBADAB@DBBBBBBB@B@B@BB@@BB@B@BA@AB@B@BAB@B@D@BB@@B@BA@@B@@A@@BA@AD@@@B@@ADCBADABAB@DBBBB@B@@D@BAB@D@BBBBBB@BBBBBBBD@BBBBBD@DBD@BBDBBBFBBBDDBBBB@BBDBBBB@DBB@DBBBBBBBBDBB@D@@ABA@A@A@A@ABABABCBC@A@@ACBADAH@JCDEFABGB@BA@AB@B@B@DBB@B@BG@A@@BBFADEBMDABADAB@F@DBBFFB@BBDBB@JB@@DBBB@@AB@BABAD@BAD@DADAB@DABABABABA@A@EDCBA@AAGAAA@@AA@AA@@@A@ABA@AAA@@@A@CDA@AB@AA@AAA@@@ABAB@BA@ADABABC@C@E@CAC@AAABCBAFADA@CBEBC@ABCBCBA@AAA@C@ABC@A@C@CACAAA@@GCA@@@CACACAC@ABA@A@A@AAA@A@ABCBA@E@C@CAA@AAEEACCACAAAA@@@ABA@AAAAA@ACA@CAE@CACAGEACAA@@@@A@ABA@AAA@CAI@C@AACAIEAAA@ABA@C@AAABA@CBKFCBA@A@@@AAAA@@E@AAGCCAC@AAACAACAA@@@@@ABAJ@B@@BB@@B@BB@@@B@@CBADABA@@BAAA@CC@AA@@@@BBHDJ@B@B@B@B@BD@@@D@B@B@B@@@@B@BABEDAB@B@@BB@BA@A@AB@@@B@@BBB@@@@B@@A@AB@@ABBF@B@@@BA@EFIFCBEBCBABAB@@@@BBD@@B@B@BA@ABCDABADAD@@@B@BBBB@BB@@@@@BA@A@A@CDA@ABC@C@A@AB@B@D@B@@@@EBC@A@@B@@BBBB@BAD@@@@CB@@@B@B@B@B@B@@A@C@@@E@KHCBCBA@AAEEAAA@C@AB@@A@@BDB@@@B@B@@@BBB@B@@@B@B@@A@@B@@A@@B@@@B@@@@@B@@BB@@BBB@BBB@BBB@@B@B@BBB@B@@@BB@B@BBB@B@B@BAB@@@B@@@B@@B@@@@@B@@@B@@@BDBBD@BDB@@BB@BB@B@B@D@@@B@B@DBB@BBFBBBB@B@D@BABAD@D@JGJGNGLCD@T@XBTBHCJAXOLMNIFCBAFAJCFADAD@LALCD@FAPAD@NBLBB@ZDFFFD@BHHHJJJNFALBBBJDHDD@@@@DF@@@@@@PLJH@BBV@BA@@B@BAF@BBLFLDJ@BBDBHADCPML@@ADGPEPCJ@LKTKN@BAL@^DDDFLFLNDBNLNNFVV@TCVIB@DAR@D@BDFBFBFADAB@DAFBDBFDB@J@B@DAB@B@BBB@D@BBAF@D@BB@J@BA@CDQ@CBCBEDEBEBCHIDAB@BADBPFJF@@BB@B@@@B@@@@B@@@BB@@@BB@D@BBB@D@@@BBDB@@BBD@D@@@D@D@@@B@D@@@B@D@B@@@@@@B@B@@B@@BB@B@BBDBBBDBBBB@D@@@BBBBBABBD@B@@AB@@@FCDB@@H@B@F@HAD@HAH@DA@@BA@C@C@EBEBAH@FADCBCBCBW@K@CBAL@BABA@C@EBABAD@@ADA@@BCA@CAC@@ABEBABBD@DA@@BAB@B@DAB@B@BAB@DA@@D@B@B@BBBB@@L@BB@BB@B@DDFDB@BBBDDBB@@BDBB@FADAB@BADEBA@CAAAACCAC@A@ABABICWHQNMT@PGTABA@@PIHSBCLMNOXKREVKBG@EBKDMNOAeEMHKJSDGBGBGHSEGGGEC@@GEMUAOBKCGCMAOCOECGKDI@UCECGGK@OEaS[OYIKQOCCMMKKAADCPKDA`AZDLBJMTKTUHGNQBCHQJMHIHGRQ@@LAPEFA@@@@HADWAY@WAQBEBMR@XbXTHTTDZRTLVBBE@OMIGAAAKGIKAOIMSOSMQKMIOOCAAAC@G@EAA@AAAACICEAGCCCAA@CAEBC@IBC@CB@@@@@@CAC@C@KEKECAEAEACAC@@@C@EBEBIBGBE@C@A@AA@@@A@@@CBCBC@C@CAA@ACCECCAC@GAC@CAAAG@GA@@CAC@CAE@C@C@CAC@EACAA@ACEAE@CAAAAAACC@CCCAAAC@C@EAC@AAAAECA@@@A@E@E@CAGAC@MEC@E@G@EAC@CCAACGACEGCCACGCCAAACACCKGGCAA@@ACCCCACEGEEGACCAIGGAEAGAEAE@EBCBA@CBGBIDCBA@IAE@E@EAE@E@E@EA"],encodeOffsets:[[101165,24710]]}},{type:"Feature",id:"530524",properties:{name:"昌宁县",cp:[99.605142,24.827839],childNum:1},geometry:{type:"Polygon",coordinates:["@@MICIMCOBC@OBYFQFOFIAEAQF@@HLAJAVEhBJNRHFBPKTEHINGHCBAR@NBJCL@DETQ\\CLAPEHCJ@TIFIJINANGHJFHHHDBJCFGHGFEDEJDPDDHFFHDD@BEDABADAHAFB@DFBDDF@B@BDDBFE@EAE@E@E@AB@@AHAD@D@BBBFBFDFHFH@DAB@BDDFBB@DBDBBH@D@FCB@B@DFDFBBDBBBD@LBD@BBBBBAHAF@D@@@BHFDDBD@DAD@BAB@@@@ABAB@D@D@BBDBB@BBB@@@BCB@@@B@B@BBBBD@BA@CF@BABBFABADEDADA@@BBF@D@BCJ@D@J@B@B@B@F@DBDBBJRBDLPBDBDBH@D@@ADAD@B@BBBCF@F@@@DBBBDDDBDBDBDDFHL@B@BCBC@ABEBCBC@@BABADCDCBCBCDEBA@A@A@C@CAA@AAA@@@AB@BBHBD@BA@@B@BDB@D@BCFA@A@G@C@EBCBIBABGBCB@BDJ@@ABC@MAA@A@@B@BFTDT@BA@CBCBCB@ACC@@ABABAFCH@T@@ABCBG@ABCBEDABADADBDDL@@DB@ABABABA@@B@@@@B@BB@@@BBB@BB@@@DB@@@FABBBDB@@@B@FCBAB@@BJ@B@B@FCJANAHFJFFDHHJLHHLJHFLFLHJHDDBFB@HNFHJJNPBF@JCL@JDHDLDPBFBAD@DABABABA@AAE@CDCDAB@B@DH@@D@BABB@B@D@BAB@@B@@@BAFCBCD@B@@@BBBBFB@@BJ@D@BA@@B@DABCD@B@@@BDD@B@B@D@BABCD@B@BBBBBBBBDADBBB@HBB@BBDDJHB@B@FAJBD@D@B@B@@A@@@AB@D@@@@BADB@DDBB@BB@@BBB@@BB@B@B@BAB@@BF@BBD@B@B@@BB@B@BBB@BBBA@BB@F@B@B@B@DBB@@@BBB@@BD@D@B@B@@@B@BBB@J@HBHFJHPHLDFJRJR@JB\\BFBFHL@DABAB@BAH@DADABADCDAF@DAD@B@@@BBBB@DBB@B@@@@ABAFABABCHKBAD@D@@@BB@D@B@@HBF@D@F@H@@@DD@@BBD@D@B@BBB@BBHDDBB@B@BABABCBCDGDEBADAD@FAF@BAFADANIDAB@H@D@JAH@BAB@DAJAHAF@DAB@JEHED@DAFADCBCJIFCFCFCDCDCDABCDCBA@AB@BBB@B@BBD@B@BBB@@@@@B@@A@@BA@ABABADAD@@@B@B@B@BBB@B@@@B@BAB@@ABA@AHGFEBABAB@@@BABABA@@B@FBB@@@DA@@F@F@B@D@B@BBDBBB@@DAD@B@B@DBDBBBDBBBB@BBB@FB@@BB@@@BB@B@@@BBAB@@BB@BBB@BABCDCD@B@BBB@@B@D@B@@B@D@B@BBB@BB@F@@@DB@BDABBBBBBB@@@B@D@BADAB@B@B@B@DADAB@BBBD@@D@@@B@@@@AB@AA@A@@@@BA@@@@@@BA@@B@BB@@@B@BBBBB@@B@@@B@BB@@B@@@B@F@D@B@B@B@@@@BBA@@B@B@B@B@B@@@D@F@B@B@B@B@B@BBB@@@B@@@D@@@B@@B@@D@@@@@B@B@@BB@BA@@B@B@B@B@@@@@B@@@B@B@B@@DBFBD@FBD@B@B@DADADAB@B@BBBBBBB@BBB@DBDBBBBB@@BBB@B@D@DBDDBBD@@BBDDBDDDFFDDB@@BBDDDBBBBDBBBBB@BADCHAF@BAB@B@D@DA@@FBB@@@BFDFBFDDDDBDB@@BA@@B@BDDDDBB@FDBBD@F@DAB@D@F@F@DBDBDBB@B@FBDBBBDBDBF@FBB@BBDDDBDDB@BC@EBC@C@CAAACCE@CB@@AF@FCDAFEDEAADA@G@G@C@CBEBCJEHCHAF@BABA@ABEBELIBE@M@EBABADAHCBCBCAE@CBABABA@CAM@ABAHHTJFBD@F@F@JCFABBD@FBDB@@B@B@FCDADCDADADAD@BABA@A@ACCECACCACC@AACBABC@ADCBADCDAD@BABABA@@@C@ABC@A@C@ABCBABABA@A@C@AACAACA@@@@A@A@@@@@A@AA@@@@A@A@CAE@CCC@AAAAACCAEEGCCAC@C@C@G@OA@@ACE@@@C@MCICA@ACAEEIGIEEGACAKAQDO@OMQSKEEAA@IDE@C@IKEQIIMIOIQKMCOIIKEK@INSBCHKHKHEDCEGOGIGEKIOEMICC@O@QAAECEAECUNINKJGFCJEDAQG]GKCCEEWIGSKYIKGAODAREJCNERE@@LKHSFSDIAUIQCM@M@AAGWCW@MAOIUKKMOOOAMDGABQDYFOCSO@QBUCKEO[ESCMCAACAAEAIBC@ABCBABA@AAEEAACGCEECKCEAACBA@ADCHGFCBAFBDBHBF@D@FEDCBCBM@EAAAAKECC@A@ABADAF@F@DADABCDMDC@IIKAC@C@C@@@AB@@ADA@@F@HBD@DA@@@C@CAACG@E@CAC@@EAG@GCEC@A@C@CDCFEDADCBA@E@W@E@EAC@CCAA@AAAAQFSFODMAOGIW@@OMQKEMCKKOEMIUESCM@KAUMGGIMCEAGIIG@@UQ[BWIICK@KAKEA@CKISKMEGEOAQDM@MKCICCIAIIGKAE@@@@@KDIDKE"],encodeOffsets:[[101827,24825]]}},{type:"Feature",id:"530581",properties:{name:"腾冲市",cp:[98.490966,25.020439],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@CBEBIDEBABEDMJKNWPIBGDSAWAS@C@KDMHIHIHC@C@ABABC@A@A@CB@TGTABCBC@CBABAD@FNXHN@HEHAF@NADABC@I@C@EDABADAD@B@D@DABAD@BADABCBADAD@B@DBBBDDBDBFFDBD@B@D@BBD@BBB@B@D@@AB@D@D@BBBB@@B@B@B@D@D@D@D@D@DBDBDBDBDDBDBDBDBDBDDBBBDBBBBBDBDB@@DDFHDBDBF@J@FABAFADAP@D@BAD@@AB@D@FBDBD@D@@ADAACBAB@B@@@BADAB@@@@@D@B@@BBB@FAD@HHH@B@D@B@BCDAB@BBBBBDBBB@BAB@@ABA@AB@@ADABABCBADADABAB@BABADAD@DADAD@BADABADAB@D@BBB@D@B@D@B@DAD@B@D@B@D@D@B@D@B@B@BAD@BADAB@DABADC@ABA@C@ABABC@C@ABC@A@C@E@C@C@CAC@CAA@C@C@AAAA@@BA@A@CBAACA@AAA@C@C@CBCBABA@AAA@AAC@A@@@AAACA@A@A@@@CBC@C@CGA@AACCAACCECICCAC@AB@@CBABAB@D@BBBBBBDBBBB@@BHADABCFABA@@@ABAB@@CBA@E@EAE@E@EBCBEBAACACACBC@AAACCACAEAA@A@ABA@@B@FBFBBABIBA@AAEEEKA@A@IBABA@EAABG@C@ACCCA@@@CDABDF@B@BABGDKHCBA@@@@A@ABCAAAAGBIFADABABADAB@D@BBB@B@@BBB@@B@@A@ABA@@@@B@@@B@B@BDD@B@@GFA@@B@BABED@@ABA@AAA@@@@@@B@BBBB@@@B@BA@@BAB@B@DBBA@@BCDEDAD@BAB@B@@BB@AD@FAD@DABABC@@BA@@@ABABA@CAA@AA@AAA@@A@@@@@A@EBA@@BBD@FBDBBDB@D@@EDEBC@CAECMMEEQKCACA@@C@G@G@E@E@EAAA@@@@CAEGGEEAA@AAAAACAGA@A@A@CB@BCBEBGFADCFAFAHAF@H@BBBD@DAB@B@@B@B@BABMPCBA@EDEBA@ADBH@JHdAD@HCD@B@B@BBFBF@BBD\\lFHBBDDBBBDBDBFBF@DBPFTDHHFFHJBBBBB@B@FELADCFADABAFGJCBEBEB@@CACAEAAAAAECA@AACACBADCHA@AB@@EDCBGFCDCBEFCBA@AB@BABCDAB@@@BABAD@BADABCDA@C@C@A@@@C@C@C@AB@BA@@BA@A@A@@@AB@@A@@B@BBD@@ABBBAB@B@B@@ABCB@@A@@BA@@BAB@BADABAB@@@@@BB@BB@@@B@@@@@BABABA@@B@BBB@@@B@@ABABA@@B@@BB@@DBB@@BB@@B@B@B@BB@BD@@BDBB@@BD@@B@D@@@BBB@@B@@@B@B@@@B@@@@D@@@@@@B@B@BB@@@@B@B@@AB@B@@@BDBBDBDDDBB@BDDAB@BA@@DAD@BA@ABAB@@@D@@AD@B@@@F@DBB@@B@@B@@@B@B@@BFBDBBBB@B@@@D@B@@BB@D@BA@CBCDCBEBCDEBCBEBEFEBADADEBAAA@A@ABABADAD@BBFCFADADCBEBCBCBABEBC@E@EACACAC@A@C@CBCBCBCBADCDADAD@D@F@D@F@DABCDABCBADADBBBD@B@D@D@BC@A@C@C@C@ABCBAD@BAD@B@BABAD@BBB@B@DHFDJHLBNBJAL@bDHF@NEFAPCNENCLDFJBDFVDNBR@XG@ADHFJVBP@LAJAHCLEHGNGLGNGNELADFHJF@@bHPHFH@LDJPJLLHHDNDPCJELCNCPCPELGPCJJFLAJCLGFGNAJ@HBPALCPAL@TAVIJGLGLGPQFIHGHIJOHQFKFEN@@@JRN\\JHnNL@HFHBHFLRBB@@BBD@BBB@D@B@B@D@@@B@BA@@DBD@DAD@B@BAB@@@@B@@@B@B@FFHDNFT@FAH@L@TAVDVHHDH^NNFNBL@PB@@FJJTDJCN@NGLCLDNHLLJHNHFDJANCLAHDNNRHHHJHNHBFHFDLHFFFJFJHJFHRHN@RBPANELMHEJGNKNITKLCREPEJEPAHBDJHFPLLFTEHDBL@JDNHJHHB@DBFBFBFDDFDDBFBBB@D@D@DABAD@BCBA@CBC@ABC@CBABADCBADABABC@AACAC@AACAC@EAC@CCACAAA@C@AAAEAA@CAACAA@CBABADA@ABEBCBCBA@CBC@CBCBADADCBADADABCDAD@D@D@DADADACE@AACAECCAEAC@CBABA@C@EACAC@A@ADADAD@BADADADABADCAEBA@CBCBE@C@C@CCAACCCCCAA@C@CBADADABAFABABGBADCDCBADCDABE@EAA@EACACACCEACAAACCC@EBCBKAG@EACBCFCDCDCBCBCAGAGCECIAC@EAEAEAEAGAGAEAGAE@EBEDEBEDCDCD@D@B@B@@@B@@A@A@I@@@C@CA@A@CA@C@ADCBADCDABADA@C@CAE@EAC@E@CACAECECEAEBE@CDCBCBC@ADQBKBKBKEKGKEMAMAQ@CAG@QBMDI@A@KCIEKGMBI@CGOEM@EBMFWAIGIAACGEOACEG@KAOAO@M@MDQFMAI@SCOGQCK@CAGCKBI@QAEAIDEJGFCHGFKCIEMGKKSBQJSBODGCMIQEIBMBGDODGJEPGDKHMBKBWEQCQAMFSBQBKFMDOAGGIDOHQAKBKC[@@@@A@AOBO@KJSDM@MAQ@Q@OBM@CI@A@@A@CBEAAC@A@AAA@A@CBA@I@A@ECCAEACBA@CBEBEAEAACC@Q@CBA@UJSDU@EUMMMKCAKMKECECC@]BK@ALMLS@KDIFOHOBC@@NKDOBCAGAC@ACIEKAK@ABE@A@AB@@AAU@AIGOK@@@@@@CE@@@@CCCGAIAABKMEIIGIGG@AECEEYCA@KAMAC@OBEBC@KDKB"],encodeOffsets:[[100937,25221]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
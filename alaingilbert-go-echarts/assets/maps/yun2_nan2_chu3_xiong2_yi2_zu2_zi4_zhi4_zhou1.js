@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("楚雄彝族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"532323",properties:{name:"牟定县",cp:[101.546566,25.313121],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@CA@A@A@@AAABCBCA@@@AAA@A@A@CAAAA@BABA@@@A@@A@A@@@ADA@A@E@C@AAAAAAA@A@A@A@@@A@@D@BA@AB@@@A@@@ABCA@@AGACCA@ABCFCBABA@AAA@@AB@BABA@A@CA@OEEAECCCA@@@E@CBEBCHC@EBA@CACAAAA@CBA@ABABBBFDBD@B@NADADABADA@GFGBG@G@G@C@ABEBCDA@CFEFGLADC@AAKAE@A@CBAFABGF@BGLCBADCDC@@@E@I@A@I@CBAB@DABABEBC@A@ICCAA@G@A@@BABA@CAE@E@C@EBABCAOKEAC@A@AB@BA@E@E@CD@BABBFBB@BABABA@@BCJ@D@BBBB@DBBBBBBB@B@BDBBBB@F@F@BBBBBBABAD@B@BFHBBB@BABABA@@B@FDBD@B@@GNAD@F@B@F@DABABCBA@@B@@@B@B@B@@A@A@AAA@AA@A@CACA@ICCAA@CBCBCD@B@@DD@B@BCDGHGFABBBBD@D@B@BA@@B@BFFBDBDABABA@IAA@CAA@@AAA@AAAAACAA@C@EDC@A@@AA@@@BAB@@A@@H@BAB@BABCBA@@@ACKAABA@AB@HFDBB@B@@@@@@CGKAAAAE@E@C@AA@ABABA@C@G@C@@BA@CAAAACBCB@@A@BABCB@@CQMCCC@ICMAGACAC@ABCDA@C@CAIEE@@@AACCE@CBAB@BEFCF@@ADAL@T@FBHBPHLJNDJJJHLHNBJ@DGJGHABSJSPBDDHHJTAH@LD@DBBB@B@DDBD@D@BABEFAHABC@AB@B@@@BHB@@@BA@C@CAA@A@AB@BABABAFABAB@B@D@@@BA@ABA@A@ECC@@AA@CAC@@BA@@@CACCA@@@@B@@DF@@AB@@AAGCA@A@@@@D@BA@C@AA@@AB@BAB@@@DCT@D@FADCFAD@BDFBBBDBJ@FAF@B@DFDFDB@BBD@D@D@@A@@@@@ACAECAAAC@IAC@CCEAAAAB@D@BBDBDFBBDBB@DADCBCDCB@B@DBB@B@BKJGDC@CDABADAD@D@BBFDB@BBBADADABAB@D@B@B@DBDDBBB@BABADEBCB@BAD@D@@A@A@A@@@AB@DBD@ND@@BA@@@AA@CC@@BAF@D@D@BBB@@@BFBBDBBBBF@@FFB@B@DABA@A@A@C@C@@B@DDFDFDHDBB@BADABABAJAF@B@B@BDBHBBBDB@DBLCF@B@@@DAJAFEFAD@@CF@D@B@BFDBBAD@@C@C@CCAAAAABBBBD@BFBDDDBBBFBB@DADCDCBA@C@CB@BADADBDD@BB@BADABAFBB@@CBC@G@CB@BADBFDDBBBB@BE@E@EBCDGBCHCBA@ABADEDEFEBAHIDAHJDFBBABAD@B@DD@DBHHDBDADAB@@B@F@D@BH@DD@@DDDD@H@BADCPABBFBD@D@BADABC@C@A@CBAD@F@FAFGJQRQN@B@BDBDBD@DD@@B@AA@C@A@ABAD@PBJFFBH@D@FABAD@B@BFHJJHFHBF@DABA@@BB@@@D@B@DBB@DH@D@BAD@BB@@@JBD@DBFBB@B@B@D@B@F@DBBA@@DBH@F@F@BAF@BAB@B@@B@@@B@@@@@B@@@@B@B@B@@@BABA@@BFB@B@BAB@B@B@B@BB@@BAAQB@@AB@@BB@DFDDBBBF@BBDCBAD@B@D@B@BBBBABBAF@DBDBDB@B@DABAD@B@DBFBRJ@@B@B@B@D@@@@@B@@B@@ADABAJ@@@BABADAB@B@B@B@BBBDHBB@BFF@B@@@BADAD@@@BB@@B@@B@@@H@F@D@DBBB@@BBDBJFHB@BB@B@D@HHDFBD@BB@BBH@BB@B@DBBBBB@D@B@@@B@@@BB@BBB@DB@BD@@@@@BA@ABC@CBABA@@D@@@BBBB@BBB@FBB@@ADC@@BA@@B@B@@BBB@B@BBD@@BBDBFBF@DAD@D@D@D@FA@@B@B@B@BBBBD@F@FADAD@DBB@DJBDB@B@F@NCFAAEACACCC@A@ADADBFBB@@E@C@CB@D@DBDBB@F@B@CCAA@CBABBDFBC@KCEGG@A@@@@BCDODC@AA@CCAEAMBKFEHENMPK@CGGACFERIHCD@D@DDFDLHF@FA@CBAD@DBF@BABC@EBA@K@G@ABABAB@B@B@DABA@A@AA@CAC@AAAAAAACAIASAG@E@A@ABA@@DC@AAC@C@A@E@ADC@EVURMBGDGCK@I@ABA@@@A@CAAECAA@B@BABMFCBED@@ABA@OAGAKCQGMGOGGCKGGCCAGCCAIEKGKIAACCEEAAEAKGEEAAC@AJADCBCE@IIAG@CAMCIDCBC@E@CA@ACAG@EAKAEAACBADBF@DABCACACF@D@DABEHEF@BQBA@A@GAE@A@@FAB@FAB@@AGEAA@@@@D@FBB@@@@AA@CAA@AAAECAIEIEEECAC@IAA@ABABAD@D@DAB@@A@CAAAAAB@BC@@@A@AAAOEA@A@@B@BA@@@CAA@@ADCBAACGEA@@@ABA@A@@@A@@AGMEIEGC@C@CEDADEFALAPBR@N@JEDEBCDEDEFG@IFGBG@@BIAKAM@CBBB@D@DCD@D@DBDABAB@BA@CGI@A@@BAB@BA@A@AACCCGC@@@@DABA@@@C@AA@@@C@A@@A@@@CBAAA@@A@A@CDA@CBG@AA@@BAB@@A@@@A@@AA@@ABA@ADA@@@A@CC@AA@C@ABCDA@CBA@A@A@AAA@AA@B@@@BBB@BAB@BEBABABA@@A@A@A@AB@BAB@@A@EC@A@A@@A@@BAB@B@@@@AA@CAA@CDCFABC@AB@B@BBBABA@A@A@AA@A@E@CBA@A@@BA@A@A@EB@FEBE@AGGCCEAC@@A@CBAROHCLGDCDE@CCCCAMECCAABABA"],encodeOffsets:[[104093,25782]]}},{type:"Feature",id:"532301",properties:{name:"楚雄市",cp:[101.545906,25.032889],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@@A@@A@AA@AA@A@A@A@@A@AA@CAAA@@AA@@AA@@BA@@AAA@@A@A@AAAC@@AAA@@A@@@AA@C@@AA@@@AAAA@@@AC@@AA@A@@@CAA@A@@@AAC@AAAAAAAA@A@@AAAA@AAAAAA@@E@MBE@A@@@@AJQ@A@AA@A@IHC@@@A@AA@@@CAA@@EAEEAAAEEECA@@CBC@EBC@CBABADAHABILCJ@P@NCPIPAHFJ@LAHCFKHMJERGPEJGFCBKJKTELEJAHELAHCHGPGJABGNMLSNMHCDAD@DBB@B@D@JBB@DDBADCFAD@D@FBF@DBDBBFBDBBB@FADCFEJGHA@A@A@A@CCAAECE@E@EBCBI@AACAA@@DA@CBA@ABADAD@B@DBBDDFDDFBB@BABGF@B@BDRBHBB@BFBHFHFFFJJFHBFDDBDB@DBDBB@D@B@DADADABAB@@@BBDDBBBD@B@BCD@BABE@C@A@AACAA@AA@@A@CBABA@@B@@@DABA@@@ABBB@B@B@BADEDAFADA@@BAB@B@FAF@F@DFJ@BAD@D@@CDCBCBC@@BCBCBG@A@@BCBGJABC@AB@B@FBF@B@FAF@@@BA@@@C@@B@DAD@FBD@D@DAB@BADAB@D@B@BAB@@AD@B@B@@@B@B@H@D@B@@B@@ABABA@@B@@BBF@BABANAL@BCD@BAD@F@B@BBBBDBB@F@D@B@HAF@@@BDDBB@B@B@DABADABADAD@B@B@BBB@D@D@BBH@DDDBD@BBBAD@@ABAD@B@B@@BBFJB@BB@B@BEJ@D@BB@BD@B@B@DADAH@B@B@DBD@D@B@DABCFABEBI@IBGFAD@H@DCFAB@B@BBFDFBBFHLDLCTED@DBHFDNHVDBDDFAHAB@LCD@F@L@D@F@FEJGD@@@DBPPBJ@HBFDFDDFELKFABDRLF@RGNGHCDANGHC@@DAZE@@B@JCHCBEBCLAJAHCFCFEHCPDJBNAF@BAFCHGRK@C@ABA@@@@B@@BBBBABA@AB@BAB@B@BBBB@@B@@@@A@ADA@ABAB@@ABA@@B@B@@@@@B@@@@AAAAAB@@@B@@AB@BB@@BAB@DABA@@@@AA@AB@@AB@@@@A@A@@BA@A@ABA@@@AD@@A@@@@AAAA@@@ABA@A@A@@@AB@B@ACBA@A@ABC@AB@@CBADA@A@ABA@@BA@ABA@@BAB@BA@@@A@@@AD@@@@A@@A@@A@@@@BAB@@C@@@A@AB@B@B@DA@@BA@ABE@A@@A@AACA@@@A@ABC@AB@@AD@@@@@DB@BB@@@BA@C@@@@B@@@@@B@@A@A@ABA@A@A@@@E@ABA@@@A@A@A@A@ABA@AB@BBB@D@@@B@@AB@@@@@B@B@@CAABC@A@C@@BEBA@@BBB@B@DB@@DB@@D@D@B@@@B@@A@@D@BAB@FAH@BBB@@BBB@@B@B@BBBAB@@@BA@@@@BBB@@@DADCBC@@@C@@BABBF@BDBHBBBDHBFBL@FBHFDBBADAFCDAD@PNBDBBDJADGJGJABC@@@AB@@A@EDAB@BBBBBBD@BCF@B@DDHD@B@D@DABAB@DBFJBFFFF@D@D@D@D@D@BBBB@F@BC@ABE@G@C@@D@B@DDD@F@BABAF@B@DAD@F@BBDDBFBDDRHFHDJ@H@LBFDDTNNPDD@BB@F@F@B@@@BBDBDDFD@BCDAB@HALBB@FFLDFBH@DABEBK@EDEFEBG@ABABBBDDD@D@HCD@BBHVBBDH@@HLHPHNDLBHAHABADAHCDABBDDF@D@DA@@@CAAAAAA@@@AB@@ABBD@LHJBB@BABCDG@EAC@@@A@A@A@A@A@@@A@@BBD@BB@DD@@CFA@CAA@@@CBAB@BBBBD@@ABABA@@@@B@H@@ABA@@B@@DDBB@@F@JFDBD@B@DCBAD@DBHBNBJDD@DDRN@DA@ADABB@@@DADABBBB@DAB@@@D@H@DABAB@BBBD@F@F@BBBBHL@D@@@@A@A@CAGEA@@BABBBDL@B@@ABADABA@ABG@@@@BA@AB@@B@@BB@D@FCD@B@DBBBBB@BBB@BB@DBB@JBB@BABAACACEE@A@AB@@A@A@CACAABAHEHGDC@A@ACC@@@ADCDADAB@DBJDB@BD@D@BBBB@BBB@B@@@@A@A@A@@@AB@DABABA@C@E@A@EBCHM@@@AACECA@@@ABABABA@AAEG@A@ABCBAAAAAAAE@E@A@AACA@A@AAAAAAACAA@AA@A@CDI@AB@BABA@AAAAEBA@ADCF@F@B@@ABAB@D@FBPLDBBAFAD@F@F@DBB@BA@AB@H@B@DBJDB@D@FABABA@CBADAJ@B@J@F@@@D@DCBCDAHK@AHEBABEDAB@F@LBBBD@BCHKFEDEB@DCFABAD@H@H@H@HAHEB@BCBABCBC@M@AACECAABABAB@DAB@BBDBDBB@FAD@DGFADAF@@@B@DDFDFBPFB@@D@BABABA@@BB@BBB@BADADEBAB@DDHB@BB@AD@B@@@B@@BAB@@A@CB@@@B@B@B@B@BBBBBBD@F@B@B@BC@@B@B@@@@B@@ABABB@BBDBB@B@B@BB@@B@B@@@B@DBH@D@DABAB@B@D@B@FBRJD@D@BC@C@ABKBAHEHGHEJIPOHO@C@C@@AGKOAO@ABAFCF@F@D@FEB@@AAACAAA@C@EDGBC@C@AEKAC@CBCDEFAF@H@D@DABABA@A@Q@ABQ@@BCBAD@FBDBD@DADCJSBAH@X@HBF@DADCDCBKDG@@B@B@B@B@@A@IBADCBAB@ACAAEEAQ@CHGRKLIFIBCAIAEACGIEEEEOIME]IECECECEAG@CCCA@C@CBC@AAC@CAG@EBAB@FABAD@B@J@B@DABBNDLDDBDAF@D@BAD@BABADAF@HADCBABADC@E@AAACEEGACAA@A@A@@BAB@DABADABCAACCACAACCA@@ABADAD@D@B@BBD@BCGOIQEGGMEEC@CAGKAAA@SBE@A@@BBH@B@BAB@@C@A@C@A@@@A@@A@@@AA@AA@AA@@AA@@AA@A@@@AA@@AA@@A@@@@@A@@AABA@@BAH@BABE@C@CA@A@@HI@C@A@@A@A@@AA@@A@@@AA@@@@@A@A@@@A@@A@@AA@AA@@AAA@@@@AA@A@@@AA@@@@@A@A@@@@@AA@@@@@A@@@@AA@@@@@@@@@AD@@@@A@@@@@@@@A@@@AA@@@AA@@@A@@@@@AB@@@@AA@@ACA@@A@@@A@@@A@@BA@@@@A@@@A@@@AA@@@AAA@AA@@AA@A@@@@A@@@@@@@AA@@@@BA@@@AA@@A@@@A@@@@@ABA@@@@@@@AA@@@A@@A@AAA@@@AA@@@@A@@BA@@@@@@@A@@@A@@@A@@@A@@@AA@@C@A@A@AH@FDF@B@B@BC@C@CBEDE@GBEAEAAACECEAAGCA@MEGAAAABADEHAF@FADCBEBGBEDEBCDC@A@ABA@@D@DILIFIHC@A@A@C@@@AAECBA@@AC@@ECAACBC@@@A@@@EEAAC@@ADA@ABC@@@AA@CECAABABADABA@BCAACC@@@A@E@EDGBA@IBCA@A@C@CBA@AB@B@@@B@B@@A@C@@@A@A@A@CCECCEA@CAEC@A@@@@@A@ABADABABA@A@A@@A@A@A@A@@@A@A@A@CBAAA@AE@AGGCCA@ADADABADA@ABA@A@A@A@A@A@A@@B@BAB@B@B@BABCBCBA@ABCAC@GCC@IAE@C@@@A@@BBD@D@DA@IFC@ABABA@ABG@G@C@A@@@@A@ADAHEBA@A@@AC@C@ADGDKDGDEBABA@A@A@@B@BA@A@C@@BAB@@AB@@@@@@A@AB@BABABAB@B@@A@@CGAACAKAMBI@MBE@E@A@AACAAAEAEC@@AC@ABAB@DAD@FEDEBE@GAE@EACACACCCGEAACAA@C@A@C@A@AACACACAA@G@G@CBG@EBA@EAEBE@GDE@C@CAI@AAAA@@AC@ABA@@B@B@B@@AB@DABAB@@BB@B@B@HADABADC@@BAD@@ABCBGDC@AAA@CCCAA@C@CBC@AB@D@B@B@@A@AAE@@@AB@JBB@BAD@@ABCDE@@BAB@BCB@@@D@B@B@D@BAB@@ABC@C@CBAFEDCBA@AB@BAFB@@DADAF@DEB@@@B@BBFBBBB@B@DABA@C@ADABC@ACKAAAAAAA@@ABAB@DAB@@A@A@AA@A@AB@@C@C@GAIAC@C@EACAECEECACACACACAAAGCCEAA@A@AAAA@C@A@AACCCC@@IGC@CAA@A@CBABQFG@C@A@ACAAA@@A@@A@@@ABC@C@E@A@C@E@GAKGEC@@C@AAC@@@A@CBC@C@CAE@EAC@C@A@A@ADABABAD@@A@@@A@A@A@@AA@@E@CA@@@@@@A@@A@@@@@@@@A@@@@@@@AA@@@@@@A@@@@@@A@@A@A@@@AAC@@@AA@@C@A@A@AAA@A@@@AAA@@@A@@AA@@@@@A@@@A@@AA@@A@AA@@@@@C@@A@ABA@ABA@E@EAA@EAACAAAE@CBEDG@CBCACACCCACAEACAAC@GCEEKCKCC@ECCAA@AAC@EDABCAABCBCBEF@DCFAFCD@DBJ@BADABCBCBG@A@A@EAC@CCAAAAAAC@A@A@C@ABGBABCAA@C@AB@B@BBD@BAB@@CBABAD@BBBB@@BDB@B@BCDEDA@AA@@A@ABGHCFAB@@@D@D@BABABC@C@C@CAA@C@GBABCDAB@@A@AAC@ACCACA@CAAA@@AA@@@CA@@A@@BA@ABC@@BA@@@@@@BA@EBA@A@CAA@A@@A@@@AA@A@@@ABA@AA@@CB@@@AAA@@A@@AA@@A@@@@A@@@AA@@@@AA@@A@@A@@AA@@CA"],encodeOffsets:[[103562,25139]]}},{type:"Feature",id:"532322",properties:{name:"双柏县",cp:[101.641937,24.688875],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@@@AA@@@@@A@@@@@A@@AAAA@@A@@AA@@@AAA@AA@AAAAAAACCACAAAC@@AAAAAAA@AC@A@A@A@AA@CEAAAC@@@AAA@@AA@A@@@AAAAA@CAA@@AA@A@AA@@A@AA@@A@A@A@AAA@A@A@@@A@@BA@CA@@A@@@@A@AAAA@@@A@AA@@AAACA@@AAA@@@A@@BA@A@A@A@@AA@E@AAA@A@A@A@AAAA@@CACCA@@AA@@A@A@@A@A@AAA@A@AB@@@B@BA@ABA@AA@AAA@AAA@AC@A@CA@AA@@AAA@AA@A@@@CBA@A@A@@@AA@AA@A@A@C@A@A@@@CAACAACACAA@CBAAA@CBC@@@AB@@A@@BCB@B@BA@@BCFAB@BA@A@AAA@AAACAAAAA@@BBB@B@DABADADCBAB@BA@CBAB@BAB@B@B@@@BB@DBBDDB@@@B@BAB@DABABCBCBAB@B@@BBBDBBBDDD@B@B@@@D@B@BAB@DABABA@@B@B@B@@@BCBABC@CBC@CB@B@B@@@BBBBB@B@B@BAB@B@BBD@D@B@B@@ABABABABABAB@@AB@D@DAD@DAB@B@D@@@B@D@BA@@BABCFABCFADAD@D@DBB@B@B@D@@ABADABAD@B@BAB@B@D@B@B@D@D@HADABAFAB@B@BBBBBBD@DBB@B@B@BCBEDABCDABCBCD@@EB@@CBA@ABA@ABABC@ABA@A@A@AAA@A@A@C@A@CBA@ABA@@BAD@DAB@BABABABADABABCBA@ABA@@B@B@@BD@BBF@DCC@@A@@@AAAAAAAAAAAAAAACAAACAAAAACAA@CAAAC@AAA@A@C@A@A@A@ABA@ABCBABABCBA@@BA@GBECIAK@E@A@AAA@A@CAAAA@@A@AAA@A@A@A@C@A@C@A@A@CAA@AA@AAA@@A@CE@C@ABCBA@AAA@AA@C@GBA@CAC@CAC@C@C@@@A@@@AA@AAA@AAC@AAAAAAAAA@AA@AAC@AAA@C@A@CAC@A@A@A@C@AAA@CAA@AAC@A@AA@@C@@@C@@@A@ABA@ABA@ABA@@@A@A@@AA@AAAAACAA@A@A@@@ABA@CB@@ABAB@@A@A@@A@@AA@A@C@A@A@C@C@C@A@A@@@@A@A@@BABABCBA@A@A@@@AA@@@AAA@@@AA@@AA@A@A@A@@@ACBU@MDEBMJEFKNEFQRIFONGDKHYJSFM@ILCTAF@BGPCFABKLKHGD[hAFMPYVOLEBEDCDGHCDGBABAB@B@B@B@BBD@@@DEDADAFABBD@JAFCDABC@C@EBABABAD@BDBFFBFBBFFFB@@BB@D@@BBB@@@D@JGB@B@@B@BIR@B@@B@F@NAF@@@BBBBBBB@BB@BB@B@BBBBBB@BBD@B@@@BBB@D@@@BBB@@BD@@B@BB@B@@BB@@@DBB@@B@@@BB@BD@BB@B@B@BB@BB@@AB@@BB@@BB@@BBDBB@@B@BB@B@B@B@@BBBB@@@@B@@@B@@DB@@BB@@@BB@@@BB@@@@BB@@B@@@@@@BB@@BB@@@BB@B@@DA@@BBB@BA@@B@B@@B@@@BB@B@DBB@B@FAB@@A@@@@B@@AD@BAB@@AB@@@DB@@B@@BB@BB@DDBDBBDD@BBB@@@BADCBAHAD@B@DBD@D@D@BABA@A@C@C@@BADEHGBAB@@@BBB@FCDC@A@ACA@AA@AA@ABCBADA@@BA@AAC@A@ABAD@B@DBBAHABAD@B@B@D@BBBBBBDDD@FBB@B@H@DADABABC@AAI@CDCBEDE@CFEDADABADBBAFCD@BBB@DBFDD@LDLDFFHDD@BBBDBFBDDDBDBDAD@DCHAF@DBFBBBDFBB@FBF@B@BAB@BAB@@@@D@@@@BBB@@@BB@@@B@@@B@@@@BB@@@B@@BB@B@@@BBB@B@B@B@DB@@B@@BD@B@@@B@BB@@@@@@@@B@@@@B@@B@@@@@@@B@@@@@@B@@@@B@@@@B@@D@FB@@BB@B@B@@@B@@@BCBABABCB@B@D@D@FBF@DBD@D@DAB@@@D@BBD@@@FDLHHBF@D@B@F@D@D@BA@@B@@@@BB@BBBDB@D@H@REBADAB@B@DBD@JH@@DDDDBBB@D@B@BB@B@BBBDFHDBBDBDBDBDBDBFFFDDBFBD@D@JBHBD@D@@@BAB@B@@B@B@BA@CBA@AB@BB@BBBBBBDL@BADCB@B@DABCBA@A@AAEAAAA@@@A@CFE@CBCB@@EAABA@@BABCDEFAB@D@DAD@BA@ABC@A@A@C@@@A@ADA@AB@@CFAD@BC@ABA@IAA@@B@@BF@B@BA@A@C@A@@BAD@D@DBBDD@DBB@BCDAHAD@BC@AB@@CDABCBGBA@A@A@@AA@ABCBA@@BA@A@A@@@AB@BBD@@BBBBJ@DBD@F@HCF@FAFBB@FAH@DAH@H@B@DBDBDBBBB@D@B@D@B@DBBBHFDDBDBDBD@FBF@HAFCFEFC@CBA@AB@BBD@@FDFBBBDBBBB@F@F@NAJ@NALBDBBBDH@@@BA@A@ABABABA@@B@B@@@@A@@BA@AB@@@D@BABA@@@@B@BABABCFCHCLCH@B@DBD@@@BABGFCB@B@B@@B@D@H@H@BAB@BABAD@JEB@@C@CAC@AB@@@D@F@JBD@HDD@DBBAB@DADABA@A@A@ABA@A@AB@B@B@B@B@B@B@BAB@BCBABCBCB@DDHH@BBFB@BBDAB@B@B@@@B@B@B@B@@@@B@BABABCBAB@B@B@@@@@BFDDBB@DFFDDDB@B@B@@@D@B@@@@A@A@@@ABAB@DAD@B@B@AD@JABCH@F@F@B@@DDBBADB@BABCBABADBDFB@@B@@AD@BCB@BD@BBFF@@B@@@D@DABBFD@@BD@@ABFDBB@@D@B@B@D@JGJEJK@C@CB@BAB@D@DCFAFCHAFADABC@EBEFGBCBABBHBNFB@HDBBDFDFBBFBFBHAF@FCDAD@D@@A@A@ACE@EBGB@B@D@@@BB@@B@@@B@@@B@@@B@@@@@@@B@@AB@@@@@BB@@B@BBB@@@@B@@BB@@@@@@B@BA@@@@B@@@B@@@BB@@B@@A@@B@@B@@@@@@@B@@B@B@@BB@@BBB@B@@BB@@B@@@B@@@@@AB@@@B@@@B@@@BB@BD@@BB@@@@BA@@@@B@@@B@@B@@BB@@B@@@@@@@@@@B@@C@@B@@@@@@@@BB@@@@@B@@@@BB@@@@B@B@@@@@B@@B@@@BBB@@@@BB@BB@@BBB@@@BB@@@B@B@@@@@B@@B@@@BB@@BB@B@@@@B@DGJ@@@BDBD@F@BA@ABG@AB@BA@BB@@@@@B@@@BB@@BB@@B@B@@BB@@BB@@BBBB@@B@@@BB@@@B@D@B@D@@@BA@A@AAG@AB@F@TAB@BBHLDBD@FFHNFHJRHPJKBA@CAC@A@@D@HAD@DAJ@LBF@LBJBDBB@BBBBDF@BAF@FBFDJBFB@BBB@BADEBCB@@@D@BDHPBDF@B@BADGD@HFABDBDBBBDCDCFAFAFADAFCB@F@B@@@@BBB@BBBFBDBDBDFBBDHBFBFAF@DBBB@B@DBF@DAHABAD@F@F@F@D@B@D@D@LAD@F@JEJCFIDCFGDIBCDG@E@@@A@AAA@A@A@AAA@A@A@A@ABA@ABA@@@ABA@@@A@A@A@A@C@A@AB@@CB@@AB@@ABAB@BA@@@A@@BA@A@@B@B@DA@C@CAAA@@@@AAAAA@A@AAA@A@A@@@ABA@@B@@@B@@@@A@AAA@AAAA@A@A@A@AB@@@@A@A@AA@@@@BAB@BA@@@@@A@@@@@@@@@@A@@AA@@ACG@@@@AA@@ABAD@@A@A@@B@@AB@@A@A@@@C@@AA@AA@@@A@@@A@@@AA@@AAA@@@ABA@A@A@C@A@A@AAA@@AA@@@A@A@C@A@ACAAA@AAAAAAAA@@CA@AA@@@@@A@AAA@@AAA@@@C@@@@@A@A@@BAB@D@BABA@@@@@AAA@@@A@CACAA@@A@C@@@AA@@AA@@A@@A@A@A@@AA@@AAA@@@@AAA@AA@A@@A@@@AA@@A@@A@@@@@AB@@A@@@A@AAA@A@AA@@A@@AAB@@A@@@@@A@@A@@@@@A@@@@@A@@@@@@A@@@A@A@@@A@@@A@@@AA@@A@@@@BA@A@@@@@A@@@@@A@@ABA@@@@@AA@@@@A@A@A@@@A@@@AA@@@@@@BA@@@A@@@AB@@AB@@@@A@@B@@A@AAA@AAA@AAA@@AA@@@@@ABA@@@@@ABA@@@@@@@A@@@A@@@@BA@@@A@A@@@@@A@@@AA@@CAA@A@A@A@@AA@@@@@AB@@A@@BBB@F@BAB@@@B@B@@@B@B@BA@@BA@@B@@@@A@E@A@@@ABAB@BA@AB@@@BA@A@@@@@A@@B@DAA@@@A@C@CBABCB@@C@C@AAA@@A@ABCBE@@BCDA@CACAAAAA@AAAC@A@@@@B@F@@@B@@BB@B@DB@@B@D@@@@@B@@@B@@@B@AA@AAACAACCAEA@A@EAAC@A@BDADABA@CA@I@EECACEOCAAA@BCBIDIEGIMSCCBA@@D@B@B@BA@@BAAA@@@A@@@A@AAC@A@A@ABAFCFCF@F@D@BB@B@BANAL@@@BBB@BBBB@B@BAJEHADADA@@@A@@AEIIAA@C@ABADC@AB@D@HBDB@@BA@A@A@@@A@A@A@@@ABAA@@A@A@@@ABABABA@A@ABC@AAA@A@@@AB@BA@@BA@A@A@@@@AA@@@A@A@@C@@AA@@@AA@@A@@@AA@BA@A@@@@AA@AA@@A@@A@@A@@@@@A@A@@@A@@AA@AEACC@C@ABABA@AAC@A@ABADABEB@@A@AB@@AAA@AA@@A@@A@@A@@@CBA@@@AB@AA@CGEECAGCAAAC@@DAFADAHC@@B@@@AA@@A@AACAA@@@@AB@@@DBB@@@@AAE@A@@B@@@BB@B@BB@@B@B@BB@B@B@BA@@DG@AFEDIBG@EACCEC@CCCC@EDGFE@AB@B@B@DB@B@ABABA@@BA@ADC@CBA@@@A@AB@@C@@ACAE@@@A@ABA@@@@AA@A@@B@@AB@@@BA@@@A@@@A@A@@@AD@B@@A@@@@AA@@@@@A@@@@@A@@@@@@BA@A@@B@@@BBBB@B@@A@@@BB@B@B@@@@@@@@BA@@@A@@@AB@@@@A@@@@@@A@@@@@@AB@@A@@@A@@@A@@@@@@@@@BDBBB@BB@D@@@B@B@@@B@B@BB@BB@@@@@@@B@@@@@BA@@B@BA@@@@BC@C@A@@BCBABCBA@@@ACA@@AA@A@@BA@@B@D@BABA@@A@@AA@@@@AA@CBA@A@A@@AAAAC@@@@@A@@B@@BBBBB@BB@@AB@@A@A@AA@@@A@@ACA@A@@@@@@B@DD@@B@@A@@@A@AC@@A@@@@@AB@B@BB@@DDBBB@@@B@@A@A@@@@BAB@D@F@@@D@B@B@DADAFGB@B@@@B@B@B@B@BBBBDBDB@B@@D@B@@@D@F@BB@@DD@B@BAB@B@BB@DD@@F@DBB@B@F@D@D@B@BA@A@@@@AAAA@@@@@AA@@A@A@A@@AA@A@A@A@@@@@AA@@A@@C@@AA@AA@AAA@@AA@A@A@A@@@AB@@@@A@A@@@ABC@@@A@AB@@A@@BA@@BA@@BA@@BA@@BA@@B@@@@@B@BA@@@@@A@A@@BAB@@AB@@@@@BCB@@A@A@A@@@@AA@@@A@@A@@AA@@@@@A@AACA@@AA@@@@@A@@B@AC@@@@@AAAA@@AA@@A@@@AA@@A@AA@AC@@CEBMBABAD@BBD@RDB@FADA@AB@@ABA@ABABA@A@ABC@A@A@C@C@AAC@C@A@A@AB@@A@AB@@A@A@@AA@@@A@@@@@AB@BA@@@AB@@A@A@@@A@@A@A@@A@@AAAA@@CAA@A@A@A@AAA@@@@@@A@A@A@@AAAC@AA@AA@AA@AA@@A@A@A@A@@@A@A@@@A@A@@@@AA@@A@@AA@@@@BA@A@AB@DAB@B@@AB@BABABA@@@AAAAA@AAA@@A@AAA@@@AA@@@A@@@@@@B@@@BA@@@@AA@@@A@C@A@A@@AA@@AA@A@A@@@AB@@@@A@AAA@A@@@AAA@AAA@A@C@@@E@C@A@CAA@@@ACC@@@@@@A@@C@AAACCC@A@@ACAAAA@AACA@@ECA@C@A@@AA@@AAA@AAAACA@AAA@AAAA@A@AA@@A@@AAA@CAA@@@A@A@A@ABABABGD@@C@A@CACAA@@AA@@@AA@@@AACCCA@@AA@CAA@C@@@@AA@@@@AAA@@@AAA@@@A@A@@@A@A@@AAA@@AA@A@A@@BA@@B@BA@CFABAB@@ABA@C@CBE@C@C@CAA@CA@@AA@A@C@ABA@ABA@@@A@@@AC@C@E@C@C@A@AACAB@@A@@@CBCBAFAB@@@@AA@GCC@CBEDCDAB@BAB@@AB@BA@@BAA@@A@@A@@AA@@AAAAAA@@AAA@AAA@@@CBA@C@@BA@AB@@@B@@AB@@@BA@@@A@A@A@AAA@@ACA@@AAC@CACACAA@AAA@A@A@@@ABEDA@C@@@A@@@AA@CAC@CAAAAA@A@@@AB@@@@A@@@@B@@@B@@@@BBB@@BB@BB@@@@BB@B@@@B@@@BBB@@@B@B@@@D@B@@A@ABA@CAA@@AAAAAAA@AAAAAAAC@A@A@@@A@ED@@CB@B@@@B@@@B@BBB@@BB@BBB@@@B@BBB@@@B@@AF@@AB@@@@A@ABA@A@A@CACAA@@@A@@@@@A@@B@@@@@B@@@@@@@B@BBB@BB@@BB@B@@BB@B@BBD@@BB@BD@@@B@@AB@@A@@B@@@B@@AB@B@BABB@@@@BBDFFDBPHDBBBBBBV@B@@E@K@E@E@CBGDGFABAACACGAO@AAAC@A@AACC@A@A@A@ABC@ABC@A@@AAA@A@C@ABABABA@ABA@@@A@A@C@AAA@A@A@A@A@@B@@A@@B@F@BBB@@BB@@B@@@@@B@@@@B@@@B@B@@@B@BABABAB@@AB@@A@@B@@@@@B@@ABA@A@@@A@@@@BABABCD@BA@@D@B@@A@A@ABA@ABA@A@@@A@@@AB@@@@@B@@AB@BA@@@A@@@C@@@AA@A@A@@A@@@@@A@A@A@@@@BA@@B@@@@@B@@@@@B@@A@@BA@@@@BAB@@@BA@@@A@@@A@@@A@@@A@AA@@A@@@@@A@@B@@@@@@AB@@@@@B@BB@@@@B@@@BA@@@A@A@A@A@@BC@A@@@AA@@A@@@ABA@@@A@@@CB@@ABA@CBAB@@A@@@A@@B@@@@@BA@AB@@@@@@@B@@ABA@@@@@AB@@ABA@A@A@A@A@AA@@A@@BA@@BA@@BAB@@@@@B@BB@@BB@@@B@BB@@@@@@@@BD@@@B@@@@ABCB@@A@@@A@A@C@AB@@A@AB@B@@@@@@BB@@BBB@@B@@@@AB@@AD@@ABA@AB@@A@@@CA@@A@@@A@@@A@@B@@@@@@@B@@@B@@@@@B@@CB@@@B@@@@@BAB@@@BA@ADA@A@@@@@AB@@@BA@@@A@AB@@A@@AA@@AA@@@EA@@A@A@@@AAAA@@A@AAA@@A@@A@@AAAA@@@A@@@AA@@@AA@@AACAAAA@AA@ACAAAA@A@@@AAC@@@@CCC@@A@@@A@@@@@A@@ACAA@AAA@@A@@@AA@A@AAC@A@@@A@@@AAA@@@AAA@@@@@A@A@@@A@@@ABA@@AA@C@@AA@@A@AAA@@AA@@C@AAA@A@@AA@A@@CA@AA@@@A@"],encodeOffsets:[[104059,24896]]}},{type:"Feature",id:"532324",properties:{name:"南华县",cp:[101.273577,25.192293],childNum:1},geometry:{type:"Polygon",coordinates:["@@QRC@MGCGAC@@CCCAEAGIACCACAKEA@A@EBCBADAB@@A@ABC@CA@@A@G@A@GBCBA@ABIACACAA@IAA@A@A@GHCBEBE@CAEAAA@AEEAA@A@CA@EBCBCBCDCFCFADA@ABC@CBE@CBCDCFCBAB@@A@CDAD@DAJ@DBP@BADABADABEBGBEBGDCBA@CAC@C@A@ABAB@D@@ADBB@B@@B@B@BAB@@ADCB@@@B@@@BB@B@DAB@D@BAD@DAFEFADCH@F@BB@DB@@BFBD@@D@HBDBB@BDB@BAJCD@@@BBADAD@BADAB@@@@@@AB@B@@@D@B@@@@BB@DE@E@IA@BABABCBAD@BAB@B@B@D@B@B@B@B@B@B@D@BAB@BABA@@BABBB@D@DAF@BABA@@B@B@BFFBFBD@J@BBBDDB@B@BAB@B@DBBD@B@D@@BBBBJDDD@BBB@DADADAD@B@@@DAD@BAJBD@B@@BFBDBDAB@DAFAB@BC@@@ED@@A@KCE@C@ABCBCDAFADFRBH@@ABCBAB@@@B@@@D@BA@A@ABCBAD@B@B@BA@@@A@C@A@A@@BCDADB@@BHBB@@@@@@B@@@B@FA@ADA@B@F@D@B@DDBDB@DBB@DBBBB@DBD@D@B@BBD@D@BB@@BDB@BBBBBBB@B@DBB@DBDDB@DBB@D@@@DBBBB@D@BBB@AB@B@BABA@AB@@A@A@AB@B@D@DADCD@BABA@ABADADEDADEBEBEDCBCBCD@B@D@@@BAD@DBB@@DBB@F@D@BBB@BDD@BBD@D@B@@B@BBBFFDDBBABADAB@B@B@@CBC@A@@BA@@BABAD@B@B@B@@@BADABAB@BA@@BBB@@BB@B@@@B@BDD@B@@@@ABA@CBAB@@@BAB@BCB@BCBAD@@@BAB@@BB@@@@@@BB@B@B@F@BBB@B@@BDBB@B@@AB@@@B@BB@BBBB@@B@BB@@@B@B@@@B@@@B@B@@ABEDABADBF@DABADCD@F@DBBBDB@B@BB@BBB@B@F@B@D@BBB@@B@BAB@DBBBBFHJBFBBHHBD@B@BABA@@BAB@BBD@DDFDFBBBD@B@BB@B@B@DAFAFAB@BBLDB@B@D@DAB@DC@@@@BABC@C@@B@B@@@@BB@@@@@B@@@B@@@B@@@B@@AB@B@@@@@@AB@@@@@BA@B@@@@B@BBDH@BA@AB@BBDDL@BA@@BA@A@AB@BABAB@B@BDDDDBD@DA@ABABA@AB@@@@@BBBFDDBF@F@BBBFBJAD@DBJ@@ABABCAAAA@A@ABAB@@@B@DADAHCNADABBBBBFBFBDDBB@@DCBAFEDADAHAB@BCBCDCB@FAD@D@FALG@@D@HCB@LAL@LBDAHELEBABADADC@ADA@@@@D@FBJDDBD@F@JCJ@DBDBBB@D@BAB@B@B@@FHBBBF@@@@B@DAB@DAD@D@B@BC@C@@B@BBDDB@BB@@BC@@A@A@@@AA@A@A@AD@D@DA@@B@B@B@@A@A@@B@@@DBBBB@B@@@BBD@DAB@D@@@B@@B@D@@@BBBDBBBB@@B@@@BAB@B@F@BB@@@B@@B@B@BAB@BA@@BABABCBEFGHCDCFEFCFADA@AHCD@DBDB@F@HBHDDF@FAFAFCBCDAD@B@BBD@@@@DDBDD@DBD@B@DADABAB@FBHD@@@@@@@B@BCDAD@BBBHFB@@B@@@B@@@@@BD@DBDBF@B@DADEB@B@BBDBHFD@H@H@B@B@F@B@@@B@DDFJ@BB@@@BAB@@ABGB@@@B@D@LFB@@@BA@@ACIACAAA@@BABA@@BA@@FAF@F@D@D@DBB@BB@@@@B@DC@@@AAAB@@A@AACECCEB@@AD@FBD@DBBDDDNBB@BCBCCCAIACAGAEBIDEFGDADCFCBADBB@BABABA@AB@B@BDDHFFBADC@@B@@@B@DAF@@BBD@D@BFDFBD@HCHGH@FCB@@B@ZDF@FBHFL@B@BCAC@A@@B@BBB@B@BADABAB@@@BBBD@JCDBDBBDD@B@@ABM@AB@BCBGC@ABAD@@A@@CBC@CBADEDAFIBAD@BBBAAAD@BAFEFGBEB@BDFFBBDBD@@DDD@DBDB@DBD@D@F@D@F@D@BB@F@BBBB@LEDA@A@AAIBCDAD@H@FEHGFCBA@@BB@@@H@BBBLD@B@DAHBLDPAFBDBB@DBBB@@A@AD@DFDHDF@BDBDDDFAB@@ABABBBBBD@DC@CAAAACG@ACC@CAE@K@C@@@@DBDFDHBDFJTTB@HBB@DABCDEDCDCDAB@B@BBBBHDHDJFFDFBF@DBBDB@B@B@DCDCBGGICGACTOTIBAHGHI@CAIGMGKIICIIMGKAOAG@E@SBKBC@@DEFE@ABADAF@@@@AB@BA@@@G@A@@B@BABA@@ACAA@ABADA@@B@DBB@DE@@CCA@@AAC@AB@@@B@B@B@B@B@@@D@FBH@DCBA@AAAGI@KACBA@@BA@@B@BBBBDB@@B@@C@CCEACBADCBGBCBABGAGCKGMGOGK@@CGAAGUAAC@GDC@C@CCAABABAH@FAFEFCL@FABA@CAGCEEK@EAABK@GBADC@AECCCCAAA@@A@E@E@A@@ACCMOSMCCAE@K@GCIEGQGCCEACAAC@A@EBC@C@ABEBA@A@ECC@C@A@CD@H@F@BAD@@A@EAAAAC@C@C@C@C@E@EEAEEICAA@ABCBC@A@C@CG@C@ADE@AACAAAA@ABAFCB@@@BA@@D@BAHIHIBCCIAAACOMC@CBEDCBABCAGEEAK@EAGAACAAAGACE@AAAB@@@D@@ADCDCB@@A@AA@@@@AB@@A@ABAAA@A@@@AA@AA@AAG@EBA@ABC@@@@BA@@@A@C@C@@@CA@@CAA@A@AA@@ABAF@@@D@BADBB@DA@A@@@@@A@@BA@@@C@A@AAA@@BAB@B@B@B@B@B@@AB@B@F@@@B@BAB@B@B@BA@@@@@A@@@@@@DAB@@A@@ACA@@@@C@@BA@@BAD@B@B@@DBBBB@@@@BAF@BAB@@CBA@A@A@@B@B@@@DA@AB@@@@@BB@@@@B@@C@@B@@@B@@ABA@AB@@AB@BAB@@AB@B@BCBAB@DA@@BAD@B@BABBDA@A@@B@@@B@BAB@B@@BBBB@@@@@BC@@B@@AB@B@BAB@@@B@B@@A@@BA@@BBB@@@@ABCBA@AB@@AAA@@BA@@@A@BBBB@B@@A@@@@@A@A@@@AB@BA@AB@BCB@B@B@@A@@@AAAAA@A@ABA@@BABABAA@AA@@@@@AB@B@DQLGHEDABE@MBIAOCGDEFEDGDIBKBADAFGDIDA@@@YFCB@@GDMHCBGDMHQHE@QKACEBKLEFCCCEAE@GAIOOCA@@C@IHEFE@C@K@E@C@KDA@GBEBCCCAGUCMGECAC@SFKDKCEGAACEAE@A@ABADE@C@GBCHEJAJ@FABADEBA@C@A@CAC@C@A@ABGBC@C@A@AACA@@A@CFI@A@AAAA@EIAA@@@A@ABCBA@@BCAA@AACCC@CAG@A@C@CAA@A@A@ABCBCBABCBA@C@A@AAACC@A@@BE@G@A@C@EAAACAA@A@A@EBC@ADC@ABKBMBA@AAE@AA@@@ABAB@BA@@@@A@C@G@A@A@@@A@ABC@@BA@A@A@CBABC@ABA@C@CAC@EBC@C@AD@@@B@@A@@BE@E@AAE@E@ABAD@BAHIDA@AB@H@DADA@AD@DADADC@@@CBC@AEI@C@EBE@E@ABA@AB@BCBEFCBC@A@A@AAABA@@B@BA@C@@@AB@BADAB@@@BBB@DBBBB@D@F@BA@ADC@A@AACAACCAA@@A@ABCBCBCBA@C@A@CACAA@ACCCAEEGIIEEGEGEEA@AAAAGCQ@A@AHEBA@AAACE"],encodeOffsets:[[103336,25334]]}},{type:"Feature",id:"532325",properties:{name:"姚安县",cp:[101.241728,25.504173],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@SSEIACCGCECA@@@@@D@LBF@DDD@BDHBBBB@DCDC@AAAABABA@@BACECCCA@ACECGCEC@@B@BA@AA@CAAACBECOAKBG@C@AKCAA@A@G@@AA@@ABEDGHEFG@C@CBADBJ@B@BCBKFA@AA@A@EAAC@E@C@E@C@C@CAA@AC@CCC@CC@CAAAEEACA@AFEHEFABC@BBABAAC@ABEJCBCFAB@DAD@DB@@@BCBAD@AHADA@@BAN@BA@C@ACCACAIDC@AA@A@@BABABC@A@AAA@A@AB@D@DB@A@AEKAG@ECE@Y@AA@EDG@GHGDC@EAEC@A@CAC@AE@CBA@@@A@@@CDABEECGACA@A@@BABABABA@CAABEDCDCBEHCFAJBFBHBDBJDDADADA@MACCACCAC@EAC@@BA@DFFDBD@B@BA@BB@B@@CDA@@@@@AAA@CAC@C@E@E@EB@@AB@@ABAB@@BBDBJBBD@@AB@@A@KEC@A@@@A@AHBB@@@B@@@BA@@BA@AAAB@@@@AD@@@BAB@@A@A@A@ABGHCBAB@B@@@BDDFDBBBBHBJHBB@B@@AB@@A@A@CAA@KFAB@@BBDJDL@D@B@DCBABA@@@@@A@@AEC@@A@A@AF@B@@C@@@A@A@CBABAB@B@D@B@@ABA@A@AAC@C@AAE@C@AB@@C@@@@@@@BA@@DADCBA@A@@@@@AA@A@A@ACAAA@A@@@@@ABB@@BB@BB@@@D@BABAB@@@@A@@@AAAA@AA@@BA@ABC@ABA@A@EAEBC@@BABADAD@D@D@BBBB@@B@@A@A@GBA@CDAFCB@@C@C@ABA@BBBB@BABGFC@ABC@CB@@A@AB@BBB@B@H@D@DDFBDB@D@@@@B@@@BCHABGF@@ABAFABCBE@A@@B@@BBBB@B@@@DABAB@@A@CB@@@B@@BBD@HCD@BBB@@D@@@@CDABAD@BBB@@@B@DAB@DBBBB@B@@BF@B@@AB@@@B@@BBB@BB@D@BB@DDBB@B@BA@@DABAB@@ABCDAD@B@BBD@@@D@@@DB@@D@B@@BBBB@B@B@@BB@@BBB@@@BB@F@D@BBB@D@B@B@B@B@B@D@BBB@@LD@@DBD@F@F@D@B@BBD@D@B@B@B@B@BABABABADAB@B@D@@@BBDBD@B@B@BB@F@DABA@AB@B@H@B@@BBB@BB@R@BABCDGH@B@@@BBF@FAFA@@B@B@FBDABABAB@B@@BB@@BB@BAD@BB@BBAB@D@BADABEF@DABB@@BFD@@BD@BAJABAD@D@B@@@@@B@BA@ABAB@@@B@BA@@@A@C@AAC@ABGDEBEDC@E@A@CAA@@AC@AACAA@CAC@C@A@A@@BB@@BBB@@BBBDDDBB@D@HBD@BBB@@@BADCBKDGDCBADALADABGFAB@BDDFFBDBDBBB@BBDBDBB@DBDB@BBBBDDDHFFFDAB@@@BA@@B@BCB@B@DBDBFBFBHBD@JBDBB@@AB@BCB@F@BADA@@@ACAAAHAFCFC@CCGEG@CDGDAH@H@LCD@FDDBHA@AACBCFEFBDBD@B@DCBAFBDDBDDDBDBDAB@B@BBBB@B@BA@AF@BDBFBNBDBFJ@HBJBHHDBDDJBFADC@SBGFEFADCBS@CHADABCD@DBD@B@@C@A@@BA@BB@B@BBFDB@F@HBFAB@BBBB@@DBF@FDFBDBBAB@BGHAD@FDB@@@DABCAACCAA@A@ABAD@DBBAB@@CBCAAG@CABEDAD@JADC@CEG@CAGBCF@F@BC@GAKAGACBCB@BDB@@ABAFF@A@ACC@EAC@A@@BADAB@B@@G@A@@DAHADBHHFJHFHDB@D@BCB@D@BBBDDA@E@EBCBAB@DBBB@@F@B@DADANCJBLAJBL@@CAACAGC@A@A@ADAH@FAJGHGAI@GD@FAB@DDBBHA@EDADBDB@@BBABABC@@B@B@@DBHBBBBABA@CBAD@BBBB@DBF@FDHDDDDBBF@BAD@BAB@CE@@AABABAAE@A@AFCH@LAFCTKNIHCH@JAJEH@D@BDAFCF@DBBD@B@FEDB@D@NBDF@@@J@J@BA@CDEAGDADEFADDJDJBLCHAF@FBLFNJJHPDFBJB`BXAREJEDC@@HGNE@@B@FCDCBCB@BAFD\\RFBFA@@HEFCBC@IAIBKF[DIB@@ABA@@B@DBB@@@BE@C@@CAE@A@C@A@A@A@EACAC@IA@@A@@ABC@A@CCGA@CAA@C@@@A@@AB@BA@CAEEGIGGIAEA@C@ABEBC@G@EAIEOAC@AB@B@B@DBBA@@@CCC@CACA@A@ARMRQHIBE@E@EBCDAB@D@D@BABC@A@CACAEBADOBC@A@GCCCC@@CCG@@A@C@E@AA@CBCBCAGGCAC@@C@ABCBAAACEGICBGJABEFCFCFAB@BABGDADCHAD@F@FAFA@AACAECCAABA@@D@HAD@DA@EAABCBABA@@ACCCACBABA@@D@DABCDCDCBA@EAAACACCEA@AACAABABBBBDDD@D@@@BCAAEC@A@A@CDE@@BCFEBEBI@C@@@ADEAK@CCAAAGACA@A@A@ABEBIBABABC@AAAGCECECCCA@@@@D@D@B@BABCBA@A@EE@@AEAACAAAAE@@A@AAC@C@E@AB@@DDB@@B@@AB@@MCC@CAA@@B@@@B@B@BC@C@ABA@ADCFABABA@AACCCAA@A@C@A@ABCBCBABAAA@ECAAC@C@CBABCB@DCDIHALA@A@CAA@A@CDADCDCBA@CAAACECAAAC@A@BBBBDF@DBD@JBDBBFDDB@B@@@@@BC@C@C@AAA@ECEC@C@ABE@EAIACAACE@ABCDEBC@E@CDS@C@@BA@ABA@@BBD@B@@A@C@@B@B@HDBB@@BA@@CE@@@A@@B@DDDB@@B@@AD@DBB@@BD@FDB@B@BAB@@A@@@C@ABABABEBABA@ABAB@B@DBD@B@@A@@GA@A@@@ABAD@BABGFEBA@A@CACCCA@A@AA@CKCG@SBAHCDCDA@A@A@ACCAE@EAECIEGCGCAAAAA@A@CBCDCDCFADCBA@GA"],encodeOffsets:[[103710,25971]]}},{type:"Feature",id:"532326",properties:{name:"大姚县",cp:[101.336617,25.729513],childNum:1},geometry:{type:"Polygon",coordinates:["@@HAHAF@DADABADBLFDBD@BABCFGBA@@BBBB@@B@F@B@B@DEBCAK@AGAIECAAA@@AACAC@E@G@@@A@@@@AA@@A@@BCBC@A@@@AEE@AAACGAA@A@A@A@ABABCBA@A@@BIBABC@@@AA@@@@@C@A@A@A@@@QIEACAA@C@ABCBA@A@ACAC@CBEAAABAA@A@A@C@ABCDAAC@AAEAACCCEA@@AA@@BA@BRAB@@AAA@A@A@A@ABA@A@AE@@ABAB@@A@A@A@@@@@@A@@@@@A@@@AA@A@ABE@ABE@E@G@CA@@AB@@@DAF@@A@CAA@@@AB@BA@CJE\\ALBJ@JADEDGF@@EBEA[QECABA@ADCDEDA@@@MFGH@@CDIFQFWB_AIAEAOCIGMIKEEAE@GBKDIAICCCEBCFCBBHCF@DABI@I@@@E@AC@M@CCAEFA@C@AA@CDEBEACC@G@IFIBG@GDMJSLEDKBG@ED@B@BBFABABBB@@DFA@ABC@ABE@AACCCCCG@EAE@CAAAAC@AB@DABABAAGACA@@@A@AD@BABAAA@@CACACB@FGBAACCA@EBC@@HBJGHIHEBG@CB@B@B@BHDDBBB@DK@IAKBIAMDCBCBA@E@@@AACAA@ABAD@F@FCBACAAC@A@ADC@A@GCGEEIGGCAGBCB@@@B@HA@A@CBAB@@@BBD@FDD@B@BEEAB@BA@ACA@ADBDBHBL@HADE@E@ADBH@DFH@DCDIBC@CBAFDBH@BBAD@DA@ABCAC@AB@B@BBBDDBBADCB@@A@ECC@GBAHA@ABCAEAECE@CA@@AAAAA@EBGAE@A@ECAAA@A@@AAB@@@B@DA@C@CAC@ADCBGB@DATCDEBEFAH@TCDEBIACCCAGGIAGAI@AEACAMAEACE@@BABA@A@AA@A@ABAACACCCACCCEAABCDA@C@CAEAEFADBD@BGBCAECC@KDG@G@CBCH@DFHDH@DEDEDGBBBDB@B@@CBABE@A@ADA@@BA@CAIAC@GAEAEACACAA@A@ADA@@@AB@@A@CBFFLLBHBJ@BAFCBA@CAEAC@A@@BCBA@A@A@CACAC@A@GAGCE@AACAA@A@@BBDDLBDBBDBDBBBBBBDHJ@BADEFEF@BEFQRADABCRADADABC@C@E@E@@@AB@BADBB@BABAAEAIBCBE@@@GH@@CBABAD@D@BCF@@B@@BB@BBB@B@D@DBDBBBB@@DBB@HBDBDBBNDLFJJHHDFBBBBDBDB@B@B@@ABABCB@@@BBBBBBD@BABCBA@E@G@EBGF@B@BDDLJLFLBJBHFHJDB@BBBBD@DBD@FBFFJBFAHAH@D@FDLDHDFDDB@BBABCBE@EAABCFADINIPCBEAKEGACBG@C@SRGLABCN@FFH@@@B@@BB@BFDDDBDDJDDDFDBDBD@DDDBB@D@DBB@@@BDBDFF@D@B@B@BADC@@BA@@@AB@@@B@B@DBF@@ABABCBOHABA@@@CBC@E@A@AB@D@F@BABAF@BBFBF@F@BBF@D@@AD@B@DBD@FAD@D@F@B@B@BBBBDBB@F@D@B@B@BAD@B@DBDBBDF@BB@BD@D@BAD@@@F@F@DBF@DAFAD@D@FABABEDABAFCH@DCB@@@@@BBBBB@@BBBDDB@BB@@BB@BB@BBBBB@B@D@BBDBB@BBBBDBDBDBDBDBB@DBB@BBB@BBBBBBBB@DD@BFDBBDFBB@@DDB@BBDBDBDDDBBDDBBBBB@BBBD@BBBBBBDDBBBBD@BBBBDBBBBBBB@@BBBB@@DB@BDBDDDBDBB@FDBBBBDBB@BBDBFBFBFDDBDBD@DBFDHBFDFFBBBBDBBDBDBB@DBBBDBBBBBBB@BBBBBDBBBBBDBDBBBB@BFDBBBBBBB@D@FBBBBDB@BBBBF@BBF@B@F@D@B@DBFBDBB@DBJFBBDBBBBBD@BBBBBBBBBBBBB@BBDBBBDBBBDBBBBBD@B@F@F@D@D@B@BBD@B@BBD@F@BB@@D@D@D@B@D@D@B@D@B@D@B@D@B@B@D@BBD@BBBBDBBBBBBBDDBBBDBDBBB@BBBBDBBBBB@@BBD@B@F@FAB@B@D@BAB@DCBABA@@DAB@DAD@FAB@HAB@B@B@DBD@DBDBFBDBDBD@HBDBB@DAD@D@FAB@DAD@B@D@D@BAB@B@BBDBB@BBB@BBBBB@B@DBDBFBFBDBD@J@DBB@F@BBDBDBBBDBB@BBD@DBF@HBDAD@FAD@B@B@BBDBD@DBDBBBFBFFBBFDFDFBFBBBFBDBD@D@BBF@B@B@FBD@F@B@D@DAD@DAD@D@DB@@DAB@B@B@BADADABADAB@CAE@A@C@CAECIKGECIQUMIWOCAGAEAC@CCECA@AA@EDOBKCECI@EAQEUEOASEG@ECEEEICEACGACAABI@AD@FBD@BAFCLAJEV@L@LCF@F@FALCPIDOGKGEYAQCEC@AAG@MDQHSLIFEAACA@C@GDCBCBCHOAA@@AE@GEAAA@C@AAACGAAOSDEBEAEEECC@K@CAC@CEICIAUBG@EMUIIKCICE@GBA@AAAC@ABANADAACAAFMCCACF@D@BABA@AGCG@A@CC@G@CCEEEGC@AD@J@BAAAAC@C@@DABABEFGB@LDLBFBFJNPBDHBTCH@BAD@FMBABCDKBABAF@DBFBBBD@@@BAACAC@E@CB@J@DABAD@FBFDBBB@D@DGRCLEHIDC@KGO@IBKDI@ICKAGCK@GAK@IBIBKBEDGHGHILQJEDAFCLAHTFRDH@DAH@DBBBBB@NGHCB@DAD@DDBBB@B@D@BA@@@E@A@AB@H@DAFCBAAA@@DE@@DA@@BABABABABA@CBAJEDAFAF@B@@@B@@ABA@CBCFED@@CBCBAB@BBB@D@@@BADCB@B@NFDDB@B@B@BA@@DC@CBBJ@FAPCRALBHBBAFEB@D@AC@@@A@A@A@ABAAE@E@ABABABAD@F@HDB@DBB@@ADEFCHADELKVMDAD@FBD@B@@AAAMOAC@EBC@A@@DAFCB@FAB@@A@@@A@A@A@A@@AAA@ACCC@ABC@ACE@A@AHIDADAD@B@B@BADA@A@AAA@@@CAACAGECAAEAACA@@@A@ABCFC@@@AS@CBCBA@A@A@CDA@@@AA@@BE@@AAC@@AEECC@@AAA@@@C@A@E@CBCAGACAAAAA@@@A@A@A@A@A@C@AA@@@@AA@A@@@ABAACCACA@BABC@ACCCG@A@@B@BA@ACAA@A@A@AC@@@AD@@ABC@C@CACCCAAAC@AAC@ABANABCBA@AAACCEEAAAA@ABAHAV@B@HFABCBC@ABCBAB@DBBD@LFLFFBFBB@HLB@@@B@@CAA@G@@@A@@@AA@@@@BA@@@AAA@@@ACA@@@@B@B@@A@@@@A@A@@@C@ACE@CACAC@CGIGGAC@GBA@CFE"],encodeOffsets:[[104060,26257]]}},{type:"Feature",id:"532327",properties:{name:"永仁县",cp:[101.666132,26.049464],childNum:1},geometry:{type:"Polygon",coordinates:["@@JDBB@@DBD@HAH@BB@FK@GBCDCHCFEHKDIBCDA@@H@N@D@DAHGBEHEFCD@D@DBD@DADEFIJGFEDELQDCFCLAFC@@JG`Q@ABECGAE@A@@^@DADABEAEGYEQ@G@EBAHCDA^CHEFCDA@CAEEOKQCAI@EAAAACBE@AHC^IDA@C@CCAKAE@E@QFcJI@EAECKCMIAGEIAAAAA@@ABABA@A@AGCEC@ABE@@LAFCDCBCAGCGAC@CDCF@F@F@HCJEDCBE@OBCFGTUDA@@FBHDLHJFHBFANCH@H@PHLFJFF@B@DADCHWDCBAL@FAJBF@HCDCF@D@D@D@BADALOZ_@ANQFM@C@AB@DBFHDBD@BA@AAECGCC@A@A@@DAN@hQB@FBD@B@@AACGIGICIAS@@@W@KFKNODCFERQJEPCL@D@@A@EACACBC@ABEBEACACCCCECAAEBC@CBCBC@@BC@A@C@CAAAGACC@ABCBKNCBCBEDGDGBA@A@C@EAAA@A@C@A@AA@IFE@C@AA@A@ABEJGBABA@ACAAADEFGJQFOGOKCICGCI@MFSAECEAGECEK@K@IBKGAAIEIACEAGAIAIAGBGAMGUMGCEKKEKAA@@AGBIAIMOAAIAAABAFIHIBABABA@AAAC@ACEICAECAAACAA@AAAA@CBABCBABABC@A@A@E@AACA@AAAAA@CCACAE@A@CBC@CCEAA@C@CBCBA@MGA@G@E@CAAAAAAECCC@A@EFABGAKAQBODEBI@AA@DCD@@ABA@A@A@CCMEA@A@CDAB@@C@A@AAA@ABAD@DC@EFAD@DAB@BA@@@A@E@EBCBIFAB@DABABABABAB@@CB@@CF@@BBABEDCBG@A@@B@B@F@@ABC@A@A@AACCC@CBA@GDMHA@AAAA@CBG@CCGEQGSKBEDCBIFKRGJGHCHAFALAJ@JBL@HDLBHDL@JCJAL@JHP@LCDGJKFQDCHC@A@AAECEAC@ABCBI@A@@D@FBDBDAB@@C@AAEACAE@ABABCLADABENC@ABG@SDGAACMOEIEAKAKCA@EHAFABCB@@@DBDBBABI@C@@BHDFFDF@D@HDDB@H@HD@BABABC@E@BDDDENBBBDCBMBAB@BBDBBB@HAF@JDLDJJNV@FAHBVDJFJ@DBD@D@LDDFFBFAFCFPTBBDHBB@B@DBBFB@HBF@@BBGPADADCD@H@DDBBBEFKJGTCR@NBH@BFDRDZBHFHLCPOJKDEBE@E@KDK@U@IFKBEDABC@EAC@@BAJBBBDDHFBJDFFDF@FFHBTFPFVBR@FDJDFALCP@FBBB@FDDDD@FBHBDBXPNJRVDJHFJLFDDBD@B@F@DBBAD@D@B@BB@@D@BBBBD@BBBB@BBB@BBB@B@B@D@B@BBD@D@B@D@BBBBBB@@BBBD@DBD@B@B@BBB@DDB@BBB@D@DBD@B@DBBBB@BBB@@BDD@BBBDBBBBBBBBB@B@B@D@@AB@D@@@B@@@B@F@D@D@B@BAB@@AB@BABE@A@C@A@C@A@A@ABA@ABADCBCDABAB@DAB@D@B@B@B@B@DBBBDBBBBBBBBB@BBBB@BBBBB@B@D@B@BAB@DADABCD"],encodeOffsets:[[103894,27132]]}},{type:"Feature",id:"532328",properties:{name:"元谋县",cp:[101.87452,25.704338],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACFEFCBCNG@E@ECKGKA@CAE@EEEAE@KCCC@@CACAC@KDE@CAAC@CAAC@CBCAEEIKCCCCAA@CB@D@FB@CBCCAECACCDQ@EAIAI@IAA@@ABAH@D@BAAAAAE@@A@@DAD@@AAACAACB@BAD@F@DEFEBCH@JAJDNBHANEF@BMBOBEFKFK@O@QBCJGHEHAHGJGFCDAH@J@FA@AAAAAEIAE@EBCD@FBDBBDB@B@D@@A@@AE@ABE@A@CB@@A@ABC@C@CBCBA@@HAJEFABEBC@@@CAAEEGCEEECAAAAC@AA@@@C@ABABADE@CCGKMACICIAMCEECEGECE@A@IBCBA@ACACACCCAC@E@EAG@EC@C@EDAD@FBDBD@D@DAFAFAB@DAB@@A@AAAEACEGEICIEAAGA@AAABADAJGDC@C@C@ABABBB@D@D@DAHCJEBA@AAAACCCAA@C@AACBCJGFC@A@ID@F@L@J@RBFA@CBG@G@G@EACCBAACCEECEC@GBEBAFCBEFAB@DBBBBCHADIDCBK@E@ACBC@CACGEKCEK@KDGDC@@@@@@FAHA@@@@D@D@HBH@@@@@@@@@@@@@FCZOH@DF@FBFBDLF@JFF^QBADCB@GI@AGKCIAICM@C@EHB@CAAEAGA@CDSBGBCFCT@FANKHGMCGIBCFEFGFGHGFMQKGAE@GBEDG@AAAAAK@ICIEAAAACBCDADADMACAGBS@CCIECG@I@CAC@@@CAAA@@@WAAG@EAIEC@CBABE@CAGE@@ABCHAD@DBJAFABABCD@BDF@H@BAB@@EDA@@BAB@D@D@BBDB@BB@B@BA@CAA@@ACCA@CAA@@@@FADA@E@AB@B@FAB@@A@A@@@EGAECCCAMGCACAA@A@@D@DDJBJ@B@BABADABA@C@@@CBA@ABABCB@BABE@C@CAC@ABEDCDABCBA@@AAAAAACCCIEGAEA@@A@@@@@CDABA@EECAEIAA@@A@@BAD@JCHAD@D@@B@BB@@@BABCBA@AB@F@BDHBD@BAD@JAD@BABAB@@@BEDBBFDBB@D@B@@AB@B@JDLCHAHQNUV@FCD@B@F@B@DBD@BCD@@AB@B@B@FBHBTBJBDBBBBBBD@DBB@@B@BABCBA@A@A@ABAB@B@H@LAB@FADABE@CAC@AB@DEBE@KGECCCC@C@GDQJEFBDHH@DOLMNGFEFALBNBFDDB@@BCDCPAD@@@@@BHHDF@LADCEAAAB@DBBDDA@E@A@CACAC@A@@D@D@FA@EACACB@B@BDDBDBDBFEBMDE@A@A@ACCIA@CAC@CBEBE@C@AAAAA@A@A@@@EBC@C@C@C@CBE@EACAAA@@AC@A@AAA@AA@A@@@AB@@CD@BA@EAA@AAA@AA@A@@@CB@BADAD@BAB@@A@@@@ACA@@CAA@AAA@@A@@@A@C@A@AAAA@C@AAAG@AAA@@AACCEGGC@A@A@BLADCFA@A@E@A@@@AAAA@@ABEHADABC@CAKECAABCBCBE@GBGBEF@DAB@HBDHHHJ@DBDBD@DDF@B@D@@@B@B@@B@@@@A@A@@B@BD@@B@BB@@B@@A@@B@@B@@@B@@@HBB@DA@@@A@GKA@EAEAKEKEC@AA@CBADABAD@DABAGEA@U@GBAB@BBBBBFFDDBB@BABADMBAB@BBD@BBDBBDDBD@D@DAD@BC@@B@@BDB@B@B@DB@BABA@@@@BDHDD@BADABB@BDDDBBBA@@B@B@@B@@B@@B@D@B@B@B@B@B@@BBBBDBHBDBDAF@B@D@@@B@BB@@DDFF@BD@BB@@AF@@BB@@B@DCB@B@B@DADAT@@B@@EDAD@B@B@@DBBBBFDBHFDBBB@D@@BB@B@BCBABA@A@C@CBCBGJ@B@BDF@BAD@BDDBDB@BB@@@B@B@B@B@@@BA@EBA@EDCB@@@BAD@FBDNPBB@BA@C@EAC@CBUNKLCFGBEDCF@BA@CAA@GCE@C@ABABAB@B@FBFAB@B@B@B@B@@BDDDBFBBBBDBF@H@B@NHB@DADAD@B@FBDDD@DAB@F@DBDB@DBBBB@BDBBBF@B@B@D@BABADABADAB@BB@BBBBDBBFDDBFJBDD@BB@BABABABGJEJABBBJBBBNPBJAJBH@@BBFLLLDFNHHVBNAHBHBJBJBHDFJBJFBBLHJAL@L@DFHFFBFDTBNEJ@HDJDLDHPEPIREHCFBBDB@BABABIHAF@B@BBBD@F@JEB@@B@B@D@BBBFBD@B@B@HAHCFCDADALMDABAD@BDBHBB@D@D@BADdFJBLFDDFFZ`BBNVFJHJHJHDLHPFL@LCLEBCHERMBAJKPCNDVJB@@@B@BA@@@@@@B@@B@@BAB@@@@@BA@@@@B@@@B@@@BB@@@@B@@@D@B@@BB@B@@@B@BB@@B@B@@@B@@@B@@@@@@@B@@@@@@@@@@@@@@@BB@@A@@@@@@B@@@@B@@@@@@@@@@@B@@@@@@@@A@@B@@@@@@@@@@@B@@@@BB@B@B@B@@BD@B@@BB@@@@@@@B@B@@BD@@@BB@@B@@@B@B@B@HAJGFGdWJELCHCJAHCHAJ@pF@@@@EUCEGCCAAE@U@CDCBA@AAID]Fa@C@CA@CAIDC@C@A@EIACCAEAGCIEEAEAAAAE@@AEDQ@GAKAGACACEGCG@A@AAGAEGDEBIDCDCBADAFAHAHA@A@CAC@EAEECAA@A@C@ABAAC@C@ABCDC@A@C@A@AA@A@AB@@A@A@AA@C@@@@C@E@K@CA@CAEFABEBE@GCCAAE"],encodeOffsets:[[104429,26547]]}},{type:"Feature",id:"532329",properties:{name:"武定县",cp:[102.404337,25.530389],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@BEBAFEDCBEAIAABIDE@@@DBFDDFBDBF@@@B@BABA@CAKIKAACAEEGEGCI@I@GA@@CCACECACE@CBEDGDEACCCGEAG@@LBDBFABEFCFCFEDEB@@CBEPABGBABCAC@A@C@A@CCSDC@GBGDGLCJCL@BAJEFIBG@OBGFGJAPA\\@J@N@JAPENGHEHEHEFADHJNDGHMLEBS@EDADAHCT@DHBFBBB@DGA@F@DDNBJDJHL@BHJA@CDAB]REE@IKEACAE@ECEG@YPED@@@@@@@@@@@@G@GAC@C@@@@@GBEB@@@@@@CDCH@LFLLDHFBD@DADBDF@L@DAJCBCDGAAAA@CBAFEDABEFAHAD@DFFFDDBBDABD@F@H@HAH@DEBQAI@K@E@C@@J@BEDIHADBD@B@DBBDDBDBB@BABIFGDCBC@C@A@AAAB@B@D@DCDIHCBABBB@BHBBBJFJDHFDFFBBB@B@BA@CBA@EBEBCBC@C@CAEAC@CB@F@DFDH@FBF@D@DBDDDBDB@BABAD@J@BDFHFDFFFNDJBJDBDLNDH@DCFABAB@B@D@@BBD@BBBBFDFFHDFFBB@D@@ADAFEBIFGB@@ABAD@D@DAD@B@BA@@D@BAF@BBF@@@BC@A@A@ACCAEAC@AD@FBFFJBBBB@BEBI@G@CBEDIHGHGBGFIHAD@R@PELELAFAPANE@MFGBMAICIBG@ADEFCFE@C@ABA@BDDBBB@BC@CB@@@BF@BBBBABC@G@AB@BB@JBJ@JBFBR@DCBDFDDBAD@DEAC@A@@DBBDDDDJLFFDBDAD@BB@DBDDBF@LCD@DBDB@@DDLDF@FBFFF@DBB@HLDL@F@FMHADEDEFBDBFDBHDF@FABAFEDBB@@D@L@F@D@@D@B@@B@B@BA@@B@BBBB@D@B@D@DCBAD@D@BBBAD@B@B@DBFFFBD@DBB@B@BGBGBEBCDADCJCFAHCBFBH@B@BDHFHBDBDBHBL@HCRBF@@BFBBFBFBJFHDFBDBBDFJB@D@D@JCDBB@@D@DEbC^BJ@BABCD@D@VBFDBHDDFFV@@@@@D@BBDDDBB@DBB@FBFDBBBD@D@F@D@F@D@BB@B@B@FADBD@@BB@@BBBB@FBDBFBBDDD@DBDAD@FAB@BBBBBDBB@DBD@BBBDDDFJNDFBDBFDFDDDFDDFDFBDDBFFBDBDAB@F@FBFDRNPJDBHBDDLHBBBDDD@@@@@A@A@ABAB@@ADABCBABABABC@A@CBABABC@A@C@C@C@A@A@@BABABABADABADADABCBCDA@A@C@@@@@EAC@C@CB@@@@AA@AAAA@@AABA@@B@B@@@BA@@B@@ADABAD@BADA@@BAB@B@BAD@B@DABABABCBADADAD@DAD@DADABABABCBA@@DABADABABADABC@CBA@CBCBAB@D@BAFABADADAB@BBD@B@B@B@FABADABAFA@AD@@AB@DC@C@GBEHGBCAAA@C@AAC@AABABABA@E@CCEAAA@AA@A@ABA@C@@@EACAA@AAABG@ABAAECEACECAA@A@CBA@A@@AA@AEC@AAC@ABABCBA@C@A@CBCAABCACACAECEACACAAAAACCAACACAC@@@A@ABCBABCBCECAGBKDCJCNCFCDE@EAECCKCGCAC@I@IDC@CDEDCLEAAI@E@GICCCE@KHG@MFQAM@EJID@F@F@L@JAHC@AJELEJGDCHGDQCG@A@AAEACCE@@AA@AAEHBAGBIDGDC@@ACEAE@MAC@AACC@@@ACI@E@E@A@KBO@E@C@AACCAECCAAA@C@A@@@C@C@@@I@C@A@@BABABABA@CBCBALKFEBA@@@@NILKJI@A@AAECECI@G@C@E@A@A@EBC@C@AACCCCEACEEACCA@CACAEAC@C@A@AB@BABBD@D@D@DABCDC@EBE@E@EBE@CBA@ADAD@F@F@FAB@DA@ABC@C@CBC@CDADEDCDADADADBD@DBD@BAB@@ABABC@CBC@ABCDCBCBABAB@DBDDDDBBDBF@FBFBF@DABA@A@E@C@ABCACAG@EIEAA@CBC@EACAA@@@A@CB@BCACAC@A@A@CBADCDCDADAF@D@DABA@A@A@CAAACAE@CBE@CAC@CAA@CBABCDEHAJEDADABADGBCDQEQAIBIBGA@@A@@ABIBG@A@@AA@@@@A@C@@BEBCBC@@ACGCCECECGCA@WBCCAEACAACAAAACECAE@C@@CAAACAB@DABCAA@CC@CBEFCDC@EEC@ADEACCAAAEACACAAA@@CCECC@C@C@C@GCEAIAKB@@GF@JAFEDABFFBF@DABCBEDA@A@AAEGC@C@C@A@A@C@A@ABA@EAE@A@A@@@A@@@GXEBADCHABC@KBE@EBEH@@@@EJKPCHCNA@@BANENEJ@@ADADMHIHABIJWVCNENCBG@IBMAC@EGCAEACAAAAAAC@@EECCBABCBCDA@AAACGA@BACCAE@K@A@C@CFCBC@CGAOEE@EBC@CBEAECECAICG@M@AEEIKAOAMISKOII"],encodeOffsets:[[104632,25991]]}},{type:"Feature",id:"532331",properties:{name:"禄丰县",cp:[102.079027,25.150111],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BBB@B@FCDABA@CAEEEGDCDADABFH"],["@@DAFAH@F@DAFA@@@@BK@AAA@@AAAC@ABC@A@ABAB@CA@AA@@AAAAC@A@@AC@AAAAC@@@CACAAAAACA@AAAACCCC@@@EKQCM@C@EDEFC@@@C@@ACACGCA@A@A@A@A@C@C@A@ABA@CBC@CBC@A@C@A@E@CBABKH@@IAC@C@EBCFAFCDEAG@EBCD@D@FFDB@@FABCBC@A@ABCBCDCBA@A@C@A@ABEDEDABABEDAFCAIF@GCIAA@A@EBA@CBA@CAK@AAECEAACA@ACECECECCC@E@C@AACAAAGCOIAAII@EDGFG@GECEEAEACG@KBM@E@A@EACE@@ACA@A@MDGDCBCBMBCBQ@E@@@GAIHA@A@A@A@AAAAA@@@@FCHADCJEHCDEJIDIFE@C@KBC@C@A@C@E@E@E@C@ABGBCBE@CAA@A@AA@CBEAEAECGAACECACAEAAA@AAA@A@@A@E@A@EDCBEBEBEBCDCDAACACABAGEC@CHABA@E@ACGOACC@@@A@ADCFABA@AAA@AECIAE@EBE@ACEAAAAA@CAIAKAE@KAI@CBC@GBC@@@@BBD@DABILADC@AAA@C@C@CBAB@BB@DDBBBDDDBBADCBABCBA@AB@@@B@BBBBDFHDFBB@B@FCDABABCDGBE@CBABABC@ABC@E@CBCAKCMCAACBA@I@A@C@ABEBA@AB@FBH@DBD@BAD@D@DDBDDH@FBFDFDFD^JNFPJFFFFHJBDBFBJADEJKJQLGH@DBRFFBBBDA@ABCDAB@J@BA@A@A@A@@@CHALCDCDCBE@GAW@G@ABITCDCBC@CAEAC@ABAD@@AR@B@R@BABABCBC@G@E@EBCFAD@DBDFL@B@DADCH@F@DBBDBBB@BA@EFC@E@E@EDAB@BBPLPBH@@@D@DGPOPIJGFGHGFABAL@B@DADC@C@QIEAA@C@A@A@ABCBC@G@CAA@@@A@ADADBB@BB@B@B@@D@BABABBBDDNFDBDD@DCFCDKHGDQPAB@D@BD@FBDDHH@BAFEFA@@F@B@BAB@@@BAB@D@F@BBBB@B@B@BAAA@A@ABAD@BADEDCB@DBB@@B@@A@A@AB@@@BB@B@D@@F@BA@ABA@@B@B@B@BB@BABAFA@ABA@AAA@A@@@ABBB@BBB@B@B@DAB@DCBAD@B@@BDDB@@@B@BCB@BA@@BB@@@B@@@BA@AB@@BBH@DAB@DCB@B@@@BBAB@D@@@BB@D@@@B@@B@D@@ABCB@@@@HDDDBD@B@BABA@AB@@@BHJ@DABA@ABCBCAC@C@CDC@A@AA@DBNBLAJ@@AHEH@JEHCFCFADCFIFM@Q@OAKBEBCFCBDFD@D@FHFJHN@BB@@@B@B@BA@@B@HFBDABCD@BB@DB@@B@@A@AB@B@PFBB@B@B@@ADA@BBBBDBB@@@BA@C@CBCBABAB@JBD@DBFFJFJFDBBFBBB@DBB@@B@@A@EAC@@@@@BBHF@BA@EBA@EB@@@BBF@H@BABARE@GFAFCBC@E@BDBDADCBE@CAABBDFBLBFBH@DB@BDBF@D@DAJCNDDBH@JB@JDFDABCBID@BBFFLHFBBBFFDDBBLJLHJFDBHDDBHDLHHDPHNHRHLDHBPBB@BA@@FCDANEBA@A@AFC@A@@BABA@ABC@IBC@AACCG@A@EBAB@DABA@A@@AAA@@@@CBCDG@IBC@AB@@@BBFJDBFFB@BADC@@@@B@@@FBHBJFDDBDBBBB@BB@DABADCFCBAD@DBD@F@BA@ADABABAB@DA@@D@B@BABCBA@A@AAICI@C@CB@B@DBDBNHDBDDBFFH@@B@B@@@BA@E@ABAF@B@BC@E@@B@DBB@DD@BB@DBB@@A@AAAA@AC@A@C@CBA@AB@FC@@BA@A@GCE@ADCBABABEAI@CBCDGBA@@HFDBF@BADAD@JFFBH@BB@X@@BBDB@@D@DBJ@H@FDDJ@DATBHBDCNCBCBADBDBBFBDJ@JBLBBBBH@FCHAF@HBRLBO@I@M@IB[BOHIHEPAH@JAFEBI@ADKDIHKHCHAD@TCDDB@D@B@D@DBBAHABAFODA@@FAFCDEDEFEBAAEAC@KH@FBDHDDFBHCFCDAF@BDFDBDDD@@HBJ@J@HDHFFFDBBBJLBL@DABABA@@@E@CAEACCAE@C@@CFAJBBBJAFCDEFABAFJJLPJTBNBPJLFF@B@NDHBJFDFDFBDAD@FAF@PFHB@DADED@D@D@B@LBFDDABB@DHBB@BCBADADABDDFF@@BDBBBBDBFBDBFHD@NBJAH@DAFMDMXUJIBAJGNGBCBC@@FIFMBM@AB@DMDGLOFI@@@@FGFAF@LAD@BADGBCFAHW@@B@@@B@B@F@FBB@BAB@D@B@ECIOEIAC@C@C@ABABC@CBEACACEAEACACAAC@A@@BABADADABABCDCBALCFA@C@CAAEEAE@AAC@C@@AE@CBGFEDEHGDE@G@KAYDGHEH@B@B@FBXJHDD@D@@A@CCM@G@G@ADCFEHG@@BCBC@C@C@CAGACAE@C@AIECGEE@C@C@EDCFCAAA@E@E@EBEDC@CB@@AAAAACACGGGKAAAAEEOe@ACOIUOKBCBAHBFBDEBG@C@I@IAG@GDCFE@E@@AAACAA@CAC@CAA@@CAC@AAAACCAECE@EBC@CBCAC@AAAAE@CBCACCACCEGBCCAC@CDEBE@CAC@A@EBI@MBADCBCBEBC@A@@@CAGGCCCEECECCACCAEE@EAC@QMYKUCM@GFABBFFN@F@B@BCDABMJMDC@A@ECC@C@CBCDCDADABBD@DBB@D@D@D@DBD@DADA@C@C@C@CBCACAAA@E@A@CCEECGEACA@AEAEACEE@A@A@CBEDGFEFADCBEAEB@@C@E@CAEAECC@CBADCFCBCFEBA@E@A@CBCBA@ABAFEFAFAD@FBJDFBHBB@BDHBDBD@B@BABA@A@ACCAEBCDE"]],encodeOffsets:[[[104838,26003]],[[104685,25587]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("德宏傣族景颇族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"533102",properties:{name:"瑞丽市",cp:[97.85559,24.017958],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCIAAF@D@F@HCFCCACACACACECAC@EACBCBA@AACCAC@@BAJ@BABAD@F@D@BABEFAB@DBFBJ@BC@CBE@AB@JABA@C@G@E@ADELABABC@E@C@A@CBEBA@EDC@ABABADABABABABADCBABE@GBABCBCBABABCBAACAAAA@A@A@EDABADABMJA@A@EB@ACAA@A@@@CDCBEBABAB@@ED@@A@AAA@@@AB@@@BB@@B@B@BAH@BBD@@BD@@@B@B@B@@@BDFBBB@@@BDBBB@@@DBD@B@BB@@@BBB@BBB@@F@@@DBB@D@BB@@BBBBB@JBDBBB@BBB@B@@B@BBB@@@BB@DBB@B@D@BBB@B@DBDBDBBBBDBB@@BD@B@@@BBB@B@B@B@@@D@DBBB@@BBB@BBB@BBB@B@@@B@BBB@B@B@BBBBB@DAB@@@BBBBFBFDBBBBB@B@D@@@BBB@BB@@B@BAB@B@@@@B@D@@AB@BCBADABB@@F@B@@AD@B@DBB@@BBB@B@FCDAB@@@BBAB@B@B@F@F@B@BA@@B@DBDB@@DBBBD@BA@@DAB@D@@BBBBB@@@BABADCBABBB@@@BBBD@BB@DBBB@B@B@B@BDBBB@BA@AB@BA@BB@@B@D@B@BBBB@@@B@B@BB@B@B@D@B@@BB@BBDFBBB@B@@@BABB@@B@@BB@@BBABAB@@@BBBB@@@B@DBBBBBBDBBB@B@BBBB@BBDBBBDB@B@D@B@DBBB@@@@@DAB@D@D@BBD@BB@@BBB@B@B@BB@BAB@BBBBB@@B@@BBBBBBBBBB@DBD@B@DBD@BBBBBBB@B@B@@B@B@@@@AB@BAB@B@DBB@B@BBBBD@D@BBBBBBBDB@@@B@B@D@BB@@BBB@B@B@B@BABADA@AB@D@BB@@@B@D@D@B@@@BB@@A@@@DB@@DBB@@ADA@AB@D@D@FBD@B@DC@AB@BBDBB@B@DAD@HADAB@B@FBLHBB@B@BAB@@B@F@D@DADAFCBA@A@ABAB@F@D@FAB@BBBB@F@N@DAF@BBBDBF@B@B@B@B@BAB@BAD@BCB@JBDBFBHBDBB@DA@@BAD@JBHAB@B@B@D@BA@AJGFCHC@@B@BABABABABA@ABABCB@BADAB@DAB@D@D@B@BABAB@B@@@FEHGDAD@B@DBDDB@F@HCD@F@HDZNNFJDHBLBHD@@D@BABAD@DBFDD@BADCFIDAJEFAB@FDLHDBHFB@HFDC@EBCFAHCJGDEBQ@CBAD@@@D@B@D@B@DBD@FBB@B@BAD@B@BB@@BDBBBBDBD@BDBBB@BBF@D@FDB@BDB@BBB@@@B@DCLSHELAJABBDAD@B@D@BAB@B@DBBBDBBBD@FBD@F@D@D@D@@@BAFCDAFCFAD@DBFBB@B@@A@K@EBCBCFE@@@ABABAA@@@@@@ABABABADA@AA@@@AAABE@C@A@C@A@CACAC@CAA@CAA@@@C@C@C@A@CAC@C@A@AAA@A@ABA@A@A@C@A@AAA@A@A@AAABA@A@A@A@C@A@A@@@A@@@AAC@AAA@@AA@@@@A@@A@A@AAA@AAA@C@A@@@@@A@A@@@A@A@CA@AA@@A@ABA@@@AAAA@A@A@A@A@A@A@AAAA@AA@@@@@A@@@AA@@A@@@C@@@@@@@@B@@@@@@@B@@@A@@AB@@@@A@@B@@@B@@A@@@@A@@@@AB@@@@@@@@@A@AA@@@A@@@A@@AAAAA@@@@@@@B@@@B@@@@@@A@AA@@A@@@ABA@@@@@@@@A@@@@@@A@@@@@@@@@A@@@@@@@AB@@@@@@@@A@@@@A@@AA@@@@@@@@@A@@@@AA@@A@AAA@A@CBA@@@A@@@AA@@AAA@@@@@AAA@A@A@A@@AA@A@@AAA@@A@@@@@AA@@A@@@AAA@@@AAA@@AA@AAAA@@A@AAAACAAAA@@AAA@@@AA@A@A@A@AA@A@@AAA@A@AACB@@AA@A@AA@@AAB@@A@ABA@AAICAA@@@A@AAAAA@A@AAAAAA@AAA@@AAA@AAAAA@@CAA@A@EAG@IAEAAAEAICGAC@ECEAGCCAC@ABC@CBE@CBE@EBEAIAMEKCGGGICEKGAACC@CBCFCBEBEHADCB@@@B@@@@@@@BEAA@AACA@A@MCGAIBEBCBA@EDMFE@GECCCECEG@@DCDEBCCBEBEBEACECE@CBCDADAJCDE@GAAEAADCHCFA@EAACCCAECCAAE@ECCE@CB@DBBBDBDDFBD@DCDCBCAAA@C@E@EIAE@C@GD@@@G@CEECCEAICEAGAGACCCE@@CE@CBE@C@@ACCCFCBEACEEEBG@GBE@EC@CBC@EE@G@EBECACGAE@ACACACEAAC@E@@@CACC@C@ADEBG@CAEACC@EBCBCFBB@B@BBD@B@D@BA@ABABABE@@ACEEEAIBEBA@E@CDGFCDCBBFBDCFA@ICAEACAE@E@C@GACE@G@CCAC"],encodeOffsets:[[100015,24428]]}},{type:"Feature",id:"533122",properties:{name:"梁河县",cp:[98.296657,24.804232],childNum:1},geometry:{type:"Polygon",coordinates:["@@BA@A@AAA@C@CBCG@G@A@A@ADAJABABA@E@KEC@A@C@ABGDIDABEAC@CBEBA@CBABC@@@CAA@C@A@CA@@C@A@AD@BA@A@A@A@@@CC@@A@A@ABABA@ABA@A@CAAA@AA@A@CB@@AB@B@BA@@@@BAB@B@@@B@BA@A@@B@@@BA@@B@@A@A@A@@AA@@@AB@@A@A@AA@@@A@AA@AA@@AA@ABA@@A@@AA@@BCBABA@@BCBAB@@@@A@@BAB@@@@ABA@C@EBABABABA@CB@@AAAAA@A@AB@@CB@@CBA@C@AB@@AB@B@@CB@@A@AAA@@@A@@@ABAB@BA@A@A@AB@@AB@DA@@B@@@D@B@@A@ABA@@B@BBD@@@@@BB@B@@B@@BBBB@BDD@B@@@BC@C@EBADCBA@CBCBCDAB@DFN@JAB@DB@FLFFDB@@@B@@@@A@@@CB@B@@CBAB@BABAB@@@B@@BBBBFBDBBHBDBDFBFJDDBBDBBBBBBF@DAB@B@BB@D@JAJABBB@BAFAD@B@D@DBDDDBB@B@B@@BBBBBD@HADBBBDHDBH@BBBBB@AB@@CD@B@BBFHD@B@B@J@@BB@BBBB@D@B@F@@@DHDBD@D@D@B@@@@B@@ABAB@@AB@BBFABAD@@EHADABABCBABAFAH@BABA@A@C@A@@BA@@BABBD@BCN@B@DBDLHDB@BAD@@ABABEBABABCBAB@B@DDFBBB@B@B@B@@B@BBBAF@@AFADCF@HAD@DAFCDAB@@BD@BB@@DABELABIHEDGHCDABABGBABC@@B@@@JBJDF@BA@A@@BFNHPFH@BB@F@B@DBFFB@BB@B@D@H@BBDBFBBBBBBD@BBDBDBF@BBBFDBDBBBBBBB@DBB@@DDBBFDLRJN@BBBBBDD@DBDBB@BDHB@BPDHFADA@ADAB@B@B@BHBDBBBBB@FBHFFHDB@@@@BBFBF@F@H@H@D@@@DBDBRLFFNNFDDBD@FAFC@@@CCAAAAC@EAC@AB@FAB@@@@@B@@@BB@BBBB@DBB@BABA@@B@@AD@BABA@CBC@EBCA@@AA@A@ABC@CBCFAD@@ABCAA@A@AB@@ABA@@@A@AA@A@A@@@@B@BBB@BA@@FCBA@A@AB@HE@@@ACC@A@A@A@@@A@@B@BAB@@@@AA@AA@@@AAA@A@CBABCBABABCJEHABBBBAD@B@B@@B@DALGHCBA@A@ACEBADC@@B@DDBDD@H@BAFBB@BAJAB@B@FLFFBBB@JABAAAAE@E@AB@BAB@B@FBDBDBBDBBD@DADBDBBBFADAFAF@F@FBF@B@DA@@BABA@@B@BADEBABCAG@@AAAAACAAAA@A@CBABADA@@BAD@DBJDFDDDBBDDBBB@DHD@D@DA@@B@B@B@BDBB@@B@D@BBB@BBB@BADADAD@D@B@BBB@BDAB@D@BAB@@BBBBD@D@B@DBD@DBD@D@F@D@B@D@BAD@D@BABAD@B@BAD@BCBA@CBABC@ABC@A@A@A@C@A@C@C@A@C@ABC@C@A@C@A@CAA@A@CBABCBABC@ABCBC@CBCBCBA@ABABABCBCDABABABC@@BAB@BA@@BA@AAACAAAAA@ABADC@A@A@C@AGG@GBC@EAA@AA@C@@@@@A@CBAB@@A@A@ABBDCB@BC@C@CAEAC@A@@BC@ABC@O@CBEBABEBI@E@CACAEGCC@@CACAAAAACAAACAACACACACACCCCACACACAC@C@C@C@C@A@A@A@@@AAAAC@C@A@@BC@A@A@AAC@AAC@A@C@CAEECACAACAA@C@ABCBCDABABC@ABCBA@C@C@ABCBCBAFCD@J@D@BABC@MBEFG@GGMMW@EBCBADAD@DABAHS@SDAAAEAAAA@CAA@A@@@C@A@A@A@@AAA@@CA@AACCA@A@@@A@@@A@@@@@AA@@@A@@@A@ABA@A@A@AAA@A@@ACBAB@@A@@@CGCCAAC@@AICC@C@AA@@@CCK@CBA@A@@FEBABC@GGOBABC@AAK@ABADCBABC@AAA@AA@AA@A@@BA@A@@@AA@C@A@A@A@C@A@A@@A@@AC@CC@AAAAE@G@A@A@A@A@ABA@@@A@@@AAACAC@@A@A@@BA@AAA@@@A@@@CB@@A@CA@@C@A@A@AAA@C@A@A@E@C@C@A@CDCB@@A@C@A@ACA@A@@@A@EDCBA@@A@A@EAEACBA@A@A@CA@ACACEEAACAAA@@A@@A@@@@BA@@@A@@@A@AB@@ABCBCDA@@@A@@A@@@EBA@A@C@G@ABC@A@ED@@A@A@AC@@A@@@A@ABABA@@@@@CA@AA@A@A@@@@@AABE@@@A@@@@D@B@@A@@AC@ABA@@@@BA@AACAACI@AA@A@CACAAA@@@A@IACBA@A"],encodeOffsets:[[100645,25109]]}},{type:"Feature",id:"533103",properties:{name:"芒市",cp:[98.588086,24.43369],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@C@A@C@@AA@A@A@C@A@C@AAC@CAC@C@A@C@EAC@A@A@A@C@A@A@CAA@A@A@AAA@A@A@@@A@A@CAC@CAC@@AA@AAC@A@A@A@A@A@C@AAA@A@A@A@A@A@A@AAA@C@A@AAA@A@A@A@AAA@@AAA@@@@@AAA@CAA@AAA@A@A@A@A@AB@C@A@AB@@A@AB@@@@@B@B@B@@A@@BA@@AA@A@@@AACAAAC@A@A@ABA@@@A@A@A@A@ABA@@BA@A@C@@@ABA@A@A@A@A@CAC@A@A@A@ABC@A@A@C@C@A@AAAAA@AAA@A@A@A@CBA@A@A@A@A@A@C@ABA@ABCDEDA@A@A@A@CBA@A@@@CBA@@@A@A@AAA@C@AAABA@A@A@ABA@A@C@A@A@A@@@A@AAC@AA@@A@ABC@ABA@A@ABA@@@A@@@A@A@ABA@C@C@A@CBA@ED@@A@A@C@A@AAA@AACACAC@@@@@@EC@A@AAAACAACA@CAEAABBEEBEBC@CBA@C@C@C@CBC@A@C@A@GBABA@ABAB@B@@EFADAD@F@L@BA@A@EACAC@EBEDCBEDAB@@C@C@C@E@C@EAC@AACAAACAA@A@ABC@A@C@CBAAIBKBGFKTCDA@@@A@AAA@ACA@ECC@E@AAA@AAACC@CAAAAAAC@@AAA@C@ABA@A@EAC@CAA@C@A@C@@@C@AB@DARCFIHGDEBAD@FCD@@BBBBBBBBB@DBBB@BBDB@DDB@DBBBD@BBD@@BRJVTBBBDBBB@BBB@DB@BBB@DAB@B@B@D@@@B@@@DBB@@@BBB@B@B@@@BBB@@BBBB@DBD@DBB@D@B@B@@AB@BAB@BBB@BABADABAB@B@B@D@BBB@@HJBD@@ABA@ABA@CAG@A@C@A@A@CBA@@@A@A@@@AB@@ABADAB@BAFAD@B@D@DBF@FBDBD@@@B@B@DBB@@BBBB@BBBBD@BB@BBBBBBD@DBDBD@B@B@@@BB@@BB@BBF@@@B@BBBBB@BB@DBFB@@D@BBBBBDB@BB@@B@D@BAD@BABB@@B@@B@D@BB@BBB@DBB@B@@@BBB@BBBBB@DBB@D@@@BBB@@B@B@B@B@B@@@BB@@B@@DF@B@DBBA@@DABA@ABABABA@@B@B@B@B@BADABAB@B@@AD@@@BBBDDBBBB@@B@@@B@B@DABAB@@@BA@@B@B@@BB@@BBBBBB@BBDBBBDBBBBBBD@BBDBBDBBBBBDDBBBBBBB@BB@@BBB@BB@@BB@BBD@B@BB@BD@BB@BBDBBBD@@BDD@BBB@@BBB@BBDBB@BBB@BDBBFF@@FDDB@BDB@@BB@BBBB@@BB@@@BAB@BA@@BABAB@@@D@B@B@@@DBB@DDDBBBBBDDBBDD@BBBB@BBB@B@@BBDBDB@@BB@BBB@@@BBDB@BB@B@B@B@F@B@B@@@BDBBBB@B@BBB@B@DBB@@BBBBBD@@@D@B@B@BBBBD@DBB@BA@@B@@@BB@DB@BBBBD@BBDBB@@@BA@@B@BBB@BBBB@BB@@BBBB@DB@BBBB@@B@BBBBB@@BB@@@D@@@B@@@BB@B@BAB@BB@@BB@B@B@@B@BBB@@BBB@B@B@BFDBBFBBBF@DBDBBBDAD@D@DBB@B@BAB@BABBD@J@B@@BBDBDBB@B@@BDJBBBD@BAB@@@@AB@BBD@@@BA@C@@@@@@B@@AFBB@@@@B@B@B@@BDB@@@@B@BABAB@@@B@@@BDB@B@@@FCB@D@BAH@D@B@B@FA@@B@@@@B@@CBADAD@BA@@B@B@@@B@@AB@@@@@BB@@@BBDBBBFFBDBDB@@D@B@BABBDBF@F@B@BB@DAFCB@@@B@B@BDB@D@B@@@DADCB@D@D@F@B@B@D@B@BBB@B@D@@@DBB@@@DA@@B@@@B@BBB@@AB@B@@@BDBDBB@@B@@@B@BAB@B@B@B@H@F@BBBBD@@DBD@@@BB@B@D@B@B@B@D@B@@B@@@BAB@@@BBBB@@BBB@BADABCDAB@BBL@BADABHP@HADABEF@@@BAB@DDL@D@@BBD@D@JD@BD@BBDDDH@@B@@@BADA@@@AAA@A@A@AA@AAA@AAA@AA@@AA@@@A@@@@@A@@@AB@@@@AB@@@@A@A@@@AAA@A@@@A@A@@CA@AB@@@BAD@B@BBFFBBB@DADALGF@@@D@B@@@@A@A@A@A@A@@DA@@@@BC@AAAAA@@@AB@D@FA@@@@@A@C@ABAB@D@D@BAB@DCB@B@B@@A@@@@AAA@AA@A@A@@BCBCBADCBAB@@A@A@AC@AA@@@@BABADAFADAJEFEB@@A@@@AAEBA@@BAB@@@@A@@A@AA@@@A@@BAB@B@@AAA@@@ABAFCBA@A@A@@A@A@A@C@@@C@@A@A@A@A@ACIAG@A@@B@@BDDB@BB@AB@BABCDA@@@A@@AAA@@@AA@@@ABIBA@@@@B@DBBBBDBBD@DBHDBBF@@@BBBB@@B@B@DALEDAB@BABBD@B@BAB@BBJFDBBBD@J@DBB@BBB@BAB@@@@@BBBDHFDBDBF@DBB@BDB@BBBBB@BA@@B@BBDBDBBDFFBBB@DBD@F@B@DABAB@B@BBB@B@B@BAD@DBDBDB@@B@HD@@BBDBDBD@B@D@BAD@B@BBB@DADABAD@FADAB@BCBEDABABBD@DBF@D@D@BABABCB@@ABABA@@B@BBB@@BBAB@DCB@@@B@BBB@BAB@@@B@@BBB@@BBHBBBB@DAFCB@B@BABABABA@CBABC@CBC@ABCBA@ABA@@AACA@@IAA@CAAAA@EEAA@C@EBABCBANCFABCAE@AB@H@@A@AAA@C@A@ABAB@@AHABAFEDC@IBGBCDA@BB@D@DABABABAB@B@B@B@BA@@@CAAACAAAAAACAA@CAA@AACAAAA@AACAACAAAEAAAC@AAC@CACAAAAC@AAAAAA@AAAAAAAC@A@ABC@@@@AAAAA@CBABABCDABC@@@A@@BCB@@AB@@@@AB@@A@AA@@A@C@ABA@A@ABAB@@A@AAA@@AAA@A@A@AAAAAA@CBABCDAD@DC@@FABA@@BABAB@@ABA@C@AAA@A@A@ADADAB@DA@ABAB@BABA@CBA@CEG@A@ABAB@BABA@AAC@ABCDCFCBAB@B@D@@A@AAE@C@AB@@AAAAAA@C@AAAC@CAA@C@AACAA@CAAAA@A@ABABCD@BAB@@AB@BABA@ACG@AB@@A@AAGCCCAEAA@CA@C@@@@AC@C@C@CAAAAAC@CACACACACAAACAC@C@CBA@E@CB@BC@A@C@CBC@C@A@AAAAAA@@A@A@A@EDA@CDCBCDADCBCDABABCB@DA@@BC@CBC@EBEBCBC@A@CBA@A@C@A@@BCB@BA@ABA@C@AAAAAAAAACCCAAACAC@CAEAAAC@ACAACAAAA@CAA@A@CAA@AACAAAAA@C@C@CAC@C@AAC@A@C@A@ABAB@BAB@BA@CAA@A@C@CAAACAC@CACAA@C@AAA@AAA@ABA@C@A@AAAA@@@@@@@@@@AB@@@@@B@@@B@@@B@@@@@B@@@B@BA@@@@B@@A@@@@@@B@@@B@@@B@@A@@@@@@@A@@B@@A@@B@@A@@@@BA@@@@@@BA@@@@BA@@@@B@@@B@@@B@@@B@@B@@B@@@@AB@@@@@B@@@B@@@B@@A@@@@B@@A@@@@BA@@CGCECECCCCECEAAACAC@EAA@ECC@A@C@CBOBA@AB@@GDABE@C@E@EBC@CACAICEAAACCEAAACACCGEIGICCAGAG@EAA@AAC@AA@@A@@@@@@BAB@B@B@@@B@@AB@B@@@B@BAB@BA@A@ABABA@A@A@A@ABA@A@@BC@@BABA@C@ABABABABADA@A@A@ABA@ABAB@@ABC@A@ABA@AB@BA@@B@B@BAB@BABAB@B@B@B@B@D@B@BAB@@ABAB@@CB@@A@A@C@A@AAA@AAC@A@A@@@C@A@A@AAA@A@A@A@@@CAA@C@A@A@C@A@A@A@C@A@A@C@CBC@A@@AA@@AA@A@AAABA@A@A@ABC@A@@@A@A@@AA@A@A@A@A@CAA@A@A@AB@@ABC@C@C@C@@BA@A@A@C@A@A@ABAAA@A@A@@@A@A@@AA@A@C@C@"],encodeOffsets:[[100814,24704]]}},{type:"Feature",id:"533123",properties:{name:"盈江县",cp:[97.931936,24.705164],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBCDAF@FADCD@F@F@DBDBD@DCB@FBBCDCBCD@BABAD@@C@[EYEYE]GGAC@A@A@CBABA@CD@@A@A@@@IEAA@@AEA@@@A@A@QJABA@A@A@@ACA@@A@@@A@AB@@AAGA@AA@A@A@@@ABA@OCA@GBA@ICA@EAA@AAEAAAAA@FA@@@@B@BB@BBBBBB@@@BA@A@@@A@A@@BA@@B@BBDBBBDBDBBBB@B@B@B@B@@BBBB@@BBBDBB@DDDBBBBBDBB@@BB@B@B@DBBBD@BBBBB@@BB@B@DB@@B@DBB@DBB@D@D@BAB@B@BBB@B@@@B@B@BAB@DBB@@@B@@@B@@BB@D@BAD@BAB@BBD@@B@BBB@@B@B@BBB@B@D@BABBD@B@D@D@BBB@B@B@B@D@@@B@B@F@DBB@BBDBB@B@B@B@DAB@D@B@DAD@DBD@B@B@@BB@@BB@@@BBB@FBD@B@DAD@B@B@@@B@@BDBDBBBBBB@BBB@B@B@@AB@BA@@@AB@B@@@BAB@@@B@BBB@B@BBB@@BB@@B@@B@B@@AB@@AD@B@D@B@B@B@BAB@B@B@B@B@B@D@BBB@@@BBB@@@B@B@BAD@BAB@B@DBB@B@BBBBBB@B@@@BBBB@B@@@B@@@B@BABAB@@A@A@AB@@A@@B@D@B@B@B@B@B@@@B@B@D@B@@BB@@AB@B@B@BAB@BBBAB@B@B@B@B@B@B@B@BAB@BAB@BAB@BB@@D@B@@@BBDBB@DBB@B@BBB@B@BA@@BAB@B@BBB@B@D@@@@@BAB@@ABA@@@@BAB@B@@AB@@A@@BA@A@@@AAABA@A@A@ABC@AB@BCBAB@BA@@D@B@@@B@B@@A@@B@@@BB@@BB@@BDBBBDDBBBB@@B@BD@BB@B@BB@@B@BBBD@B@B@DBB@B@BBB@BB@B@BB@BBBB@@BD@BBBAB@B@BBB@B@B@B@B@B@BAB@D@BADBB@B@BBBBBBBBBB@BD@B@@@B@BBFAD@BBB@B@BBDBB@D@@@D@B@@@BB@BBD@@DB@BB@FDFBFDB@BBD@DBB@DBFB@@@@BBB@BBB@B@@@BABABBB@@BBBBB@BBBBBBDBBBDBBBDBDBBBDBBBBDDD@ABBB@BBBBBDBBBBDBBBBBDB@BBB@@@BAB@B@DBB@DDF@BBDDBBBBBBBBBB@B@B@D@@BB@@DA@ABCDCDA@ADAFAF@F@DADAD@D@D@@ABA@C@A@@@AB@BB@@B@BABBB@BBBBB@D@DBDADADAD@DAB@D@D@DBFBBABABAFBFBDBBBDBBBBBBBBD@BBBD@BBDBDBBBDBBB@@BBDBBBDB@BBB@@ABB@@B@B@B@BBB@B@@BBABABABA@ABA@A@A@A@@@AB@@AB@B@B@D@B@BB@@B@BA@ABABA@@D@@@BAB@B@@@BAB@@C@CBCBCBABABA@ABA@@@C@A@AAA@AA@AAA@@AAA@A@@@AB@@@@CBA@AB@BAB@BABA@ABAB@BA@A@ABA@A@@BA@ABAB@BAB@@AD@@@BABAB@BAB@@ABADABABC@ABA@CB@BAB@BBB@B@F@B@B@B@DAF@D@D@DBBBD@BBD@DAD@D@D@DBB@BABAD@DCN@D@BABEB@BGDAD@B@BBBAFCDAD@B@BBBBF@B@B@B@BBB@B@D@BCB@B@BBBB@BB@@@F@D@F@D@D@BAB@BA@@BDNBF@DDHBFBDBD@B@D@BBF@@B@@BAD@@@BBD@B@BADA@BH@D@BAB@B@F@B@BA@AB@B@B@B@B@@ABAB@B@D@@ABAB@D@@@BBDB@@@B@B@BBDBDD@@B@@AB@@C@CBABABAF@DAD@BBD@DBBD@DDJDD@BBBDBB@B@@@B@BBBB@BBF@BB@DDB@@FBBB@BBBBBDDFDDDFDDBB@B@B@B@B@DBFBFBDDFDDBB@B@F@BBDDDFFBBBB@BB@FB@BBB@B@B@BBDB@BBBB@B@@ABC@@B@B@@@HBDB@BBB@BB@@@B@BAB@B@B@D@DBD@BBBDBBDBB@B@DBB@@@D@@AD@@@BFHDJDJBD@FBDDDDD@B@@AB@D@D@BBDBBDFHHBDBDBFBBBDBBBB@DBD@D@H@FBBBDB@BBDAD@DADADABADADABADAD@BAD@BBD@B@DB@ABABAB@BAD@BABA@ABA@ABCBABCBABCBA@CBADABABABC@ABABABADABABABCBABABCBABCBCBC@ABAB@DADABAB@DABAB@D@B@D@@@DBDBDBB@DADBD@BBB@D@D@B@D@D@BBBDDBDBBBBBBFBBBDBDBBBBBBBDBB@DBBB@BABAD@@@BBD@@BBBBDBBBDDBBBBDDDDBDBDDBBDBBDBDDBBFBBBDBDBBDBBDBBBDBBD@D@BB@@BB@D@BADAD@DAD@D@DBDBBBDBDDBBBBBBBDBDBFBDBD@B@BGD@DAD@FAD@D@F@DBD@F@DBFBD@B@DBDBDBDBBBDBD@BBBB@DBB@D@D@B@D@B@DBD@DDBBDBDB@D@D@B@B@D@B@DBB@@B@ABCBA@A@ABC@ABCDABAD@D@D@B@D@@A@C@C@AACAABCBCDABADCBA@C@E@C@E@CBCBCDCBCDADADADAD@B@D@DBDBFBF@D@FABADADAFADABCBCDEAE@ABCBCBABAB@B@BBFABCBCFAFEFADAFADCFADADCDAB@@A@CAA@@@A@C@@@AAAAAACAE@@@A@A@@@AA@@@AA@C@E@@@ABC@@@C@@BABAB@@ABC@CB@@ABACC@AAACCACACCA@A@@@ABA@@@A@A@@A@@A@A@A@@@@C@@@@@@A@@@A@A@@@AA@AA@@C@A@@@AC@@AAAC@@ACA@@A@A@A@AA@@AA@CA@@AA@@@AB@BABA@@@A@@AA@A@AB@BABA@A@@@@@A@@AAA@@A@@@@BABABC@ABA@AB@@AB@@@DABA@@@A@ABAAABA@@AC@A@AB@@@BA@@B@B@B@@AB@@ABAD@D@D@@@B@D@D@B@DCBABC@ABCBA@A@@BADCBA@ABAB@DAFEDADCHEDAFC@@BAB@DGBCDADBBBB@FDBBBBFBDBDB@@FAFADAHIBEBABCDEBCFK@E@AAAAAIAEGGECGESAO@CAEAEACACAACCAAEG[kAC@AAEAE@A@A@ADC@GBCGc@IAGBCB@FAFCB@DANOBA@A@A@AA@A@CBC@AA@A@GBEBGBEDEBCHECGAOA@CG@AAAAC@CCCAAAA@AIMKQECAACC@@AA@CAAAAAACACAAEAAE@CACAAAC@AAAAAAAEAC@A@G@C@AAAA@EECAA@E@A@@AEGGOEM@AB@B@@ACEAI@I@@@AD@BAHABABADCHGFCJGBAFKBA@CA@@AAC@@BADCBE@CBC@GDEBCBE@@BEAA@A@AA@A@A@A@AACE@C@ABADABABAFABABA@@BC@ACAKGAC@C@ADM@AACBA@AB@@AB@D@B@B@BA@ABGBEBADABABABCFG@@BCBAAE@ABA@@BABA@@@A@@A@C@C@C@CACG@@E@A@C@A@AA@AAA@@@I@A@AGCAE@A@ADC@@BAA@AAAAG@CACGAACAGBC@AAAA@AA@A@A@CACCCAC@A@C@EBABA@AAIBIBC@A@@A@ABA@CAEAAAACAAACCEIEAACACAGCAEAAAAAAFAFCD@BBBHBDBBD@D@BABABABE@CBCBABCBABCJAD@@CDEDEBG@CBEDCHGHAD@@C@@@CAIGA@CACACAAAAC@@@AAC@AAACACCAAACACAACCACCAAAC@C@CAAACAA@CCCACACAC@C@AAACAAAEACACCCAAC@AAACAACCAAAACCAE@AACAAAAA@CAC@CAC@CCACAAACACAAACACCACACCC@CAC@AACAA@A@E@C@C@C@C@CAAACA@CCC@@MKGGEEIAGDMBI@CAMGIACACECAA@A@CA@@AAACAA@CAC@CAAAAACACCAA@CACAA@IAC@@@CAA@CCAACAA@CBABC@@A@@@A@ABCBCAC@CAAAAA@C@A@C@A@CAAAAAACCAACAAAC@CAA@AAACAAACD@DABC@AA@@o[YQ@@UOQKEIGGEKEEA@@CB@@A@ABA@@@@A@@A@@@A@@@@@A@A@@@@@AA@@A@@@@@AB@@@DA@@@@@A@A@@@A@@@@B@@A@@@@@A@@@@@@BA@AAG@AAAI@EBSHEBMAIACA@@@@@@@@@@@@GCGIAC@CAIIKEAACACACACA@A@CDCFABABC@AA@@CCAAAAAC@ABA@AA@GDC@CDCFAFCFEFAF@DEFBDDBFBBD"],encodeOffsets:[[100029,24999]]}},{type:"Feature",id:"533124",properties:{name:"陇川县",cp:[97.792104,24.182965],childNum:1},geometry:{type:"Polygon",coordinates:["@@CD@B@BB@F@DBJD@@BB@@AB@B@B@BB@@BA@@BA@@B@@@B@@@BA@@@A@AB@@AB@B@@AB@BAB@@ABABA@@BB@@B@@@BA@A@C@@@ABA@A@A@@BA@CBA@A@CAACAF@H@BAFAH@D@DDL@PBD@FBF@HBBBBBBBDBBJLDDBDDBBDB@DBDBDBDBFBDDBDDBFDDB@B@@AB@BAB@BABAB@BABABC@A@ABAB@B@B@B@B@@C@@@A@AB@B@@ABA@@BAB@B@D@B@@A@A@AAC@AB@@AB@BA@@BABA@@B@B@@@@A@A@CA@@A@ABA@@@C@A@A@ABAB@BB@@B@@A@CB@@CBABA@A@A@A@@@C@CAA@CAA@A@ABA@@@AAA@A@@@C@A@A@@A@@AAA@@ACBA@@@ABA@A@CA@@C@@@ABABE@CBEBC@E@C@A@CAA@ABA@AB@FAF@F@DABADAFADBDADAF@DAF@DBF@DBDDHBBFBFBCFN@DAFCFEDCDBDDDBDBF@HBD@D@DDDDDFBDFFDABDBF@B@B@D@D@DAJAF@@ABCBE@EBADCHE@C@@BAB@BBDBBBBDD@@BBD@BABADEDCB@B@BDBDBDBDFBJLBJ@DBDHJHD@@@@@@@@@@@@DBJBNBFATGFAJ@BB@BBH@BAB@@@@@@@B@@@@@BA@@@@@@B@@@B@B@@@@CB@@A@@B@@@@@BB@@B@@@@@B@B@@@@@B@@@BB@@@@@AB@B@BA@@DB@FFFLHHFJRLVP@@ZRp\\@@BBD@BA@CDCBBDBBB@BBB@DBDBBBDDBBDBBBBDBB@D@B@D@B@BBBB@DBDADAD@B@B@@@BD@BADAB@DBBBDDB@DB@@D@JBB@DBDBB@DBBDBDBBBB@DBD@DBBBDBB@@DBB@B@DBDFDBJBNHDBJ@NAHCJBFFHHNL@@DD@DDBBBDBD@D@D@D@F@B@B@DBBBD@DBD@DDDBDBBDBDBBBDBDBBBDDDD@DBD@DBB@BBBBBD@BBFDDBBBBDDBBBDBBD@BBDDBDBDBFBBBDBBD@D@DBDBDBDDB@DBBBDBD@D@BBDBBDDDBBBDBDBBDDDBBB@BBD@B@@BDBBDBDBDBB@JHDB@@D@@@BCHGDGFCDAH@FAFCDC@@BCDIBADABADADAF@BABABA@A@CACCAGAAA@ADCBEBE@@@A@@BABA@ABADA@@@ADA@@B@@@@@@A@@CAEEEKA@@CBA@IEM@CBADCDADAB@DABCFAD@D@@A@@@ACC@AAAAA@@@AA@A@@A@@@@AC@A@AB@BAB@@@@A@C@@@AB@@CBA@@BAB@B@B@@ABABA@@B@@@B@BBB@@@DA@@@ABA@@BAD@B@DA@@DA@@BAB@B@BBBB@@DAB@BABABAFAD@B@BA@@@@BA@AB@@@@@BADA@AB@BADA@AB@@BB@@@AB@BBB@@BBB@@B@B@@BBB@B@@@BA@@B@@BB@B@B@@@@AB@@A@@@AB@B@@A@A@@@ABA@A@@B@@A@ABA@@DAB@B@@BBBDBB@B@BAB@BABAB@B@@@DD@@B@B@B@B@@ABCB@D@@@DBB@D@B@DB@@D@BADAB@FADAD@FBBAJCHCBAD@B@D@LFF@B@BABABIBCB@B@H@H@ACAAAC@CAEAAAECAAEA@A@A@AA@@AA@A@AA@A@A@@AA@@ABA@A@AAA@@A@@@C@@@A@@AA@AAAAA@@@AAAAA@@CAAAA@@AAA@AA@AAA@A@AB@@A@@AAAC@AACAA@ACAA@@A@@@AB@@AAA@CACAA@A@A@A@C@@ACAAAA@@AA@C@AAA@A@AAAAAAC@@A@A@E@A@A@A@A@@ACAAA@@A@AAA@@AA@ACAC@AA@A@AAA@AA@ACCAACCAAAACACCA@CA@@A@A@C@@@A@ABAB@@ABA@AB@@A@@AA@AA@AAA@@CA@ACAEC@@EEAAACA@AAA@CAAAA@AA@@AA@ACC@AC@AACAAAA@@AACA@@A@AAC@AAA@@AAA@AA@@AAA@AAAAAACCAAAACAAAAC@AACAAAACAAACAAAA@AAAA@AA@@AA@A@@@AB@@A@ABCBA@A@@@A@@@AAAACCAA@A@@BC@@@ABABABC@A@A@A@A@AB@BABABAB@BA@CB@AA@C@ACE@@@AA@@A@@@A@A@A@A@AA@AA@@C@A@CAA@AAAAA@AA@@A@A@CAA@AAA@@A@C@AA@@@AAABC@ABC@A@@@AAA@ACAAAAC@@@EACAA@@AAAAA@A@A@@AE@AAA@@AA@@A@A@C@CACAC@AAAAAAA@@AACAA@AAAAA@@AA@C@A@A@@ACAC@EAE@C@C@ABCBE@ABABCBA@@BA@@B@B@@@B@DAB@B@D@B@H@DBB@BAB@BA@@ACGI@@AA@A@C@A@ABABABCBA@AAA@ABA@ABA@@@A@A@CAA@CAC@CAAAA@@AA@A@@@A@AAA@A@@AA@C@@@A@@@C@A@ABA@CAA@ACAA@AAA@AAACAAUSQI@AC@AAC@AACAA@CCA@AC@AAACAA@AAAAAAAA@@GEA@GECAKGECA@EBIFCBEJCDABC@ECCAC@ABABC@@@GCKAGAICMEYMGCE@C@GDE@A@CCCAA@C@CBGHEF@@A@A@ABABA@C@C@A@CBA@CBABA@ADAB@BABABABABABA@@@GDEDIH@BABC@A@A@A@GBIAC@AB@@CBA@CAGAEACAIAA@ADC@ABA@ABA@A@A@A@E@CAAA@ABE@C@M@EAAAAA@EBC@E@A@AB@B@BABEDCBCBC@E@A@@@BA@A@AAAKGEAA@A@CBGBC@CBA@A@CAAAA@@BCDA@C@EAC@C@A@@BCB@BA@CA@@CAA@ABA@A@A@BB@@DBD@D@@BB@@B"],encodeOffsets:[[100091,24736]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("迪庆藏族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"533401",properties:{name:"香格里拉市",cp:[99.700904,27.829578],childNum:1},geometry:{type:"Polygon",coordinates:["@@BBDF@B@BBB@@@BBB@@@BBBBB@@@BBBDJ@@BD@@@@@BABBB@@@@BBB@@@DDB@BBB@BAB@B@@@B@BB@B@B@F@D@D@BAD@F@D@DADAD@B@DABAFABCDC@EBABEJGPEFAD@BBBDBFDHBDDD@DLAT@@@H@L@@ABADBF@BFDRJDHBZBFBDHDLJHL@D@BAPIL@BI@I@G@A@CDADOZAP@FBBHBDBDDB@DBD@DBB@FBB@BBBB@BA@@@@@A@@B@@A@@@ABA@@@@@AB@@A@@BA@@B@@@B@@AB@@AF@D@B@@@@@B@@A@@@A@@B@@@@@B@@@B@@AD@B@B@BAB@@ADAB@BABGHOHCBAD@@@FBP@D@B@D@BBBD@BBB@BB@B@BABCHIH@BBBNLDD@D@DAJAD@DBBDDB@DDF@BAD@DADBDBBDBBDBFBF@F@FAD@DBBADCBCDAD@DBDBFADBB@FABAD@BD@D@BAF@FBBDFBDAB@DADBBADAD@DDDNLPNXJFFBFAR@FBDPFF@FADAHEDAF@F@JBJHBB@BDBDBDBDD@BCFAB@D@BBD@DBHBB@D@D@FAF@B@DBDBDBD@B@D@D@DADABCFCBEBABCBAB@DBBFFDBBDD@DFDDDBDBBDBDBFBFBD@FBDDDB@DBBBBFFA@@DCDAB@HBJDJDJDFDDBDB@@D@FBDBBDDD@DCDABDBFBFBD@DBDDBDADADCDADCDAD@FBDDD@DABAFCDAB@D@DDD@D@DBDBDBDDDDBDDDBFFBBBD@BBD@HAH@DBD@BBDFADAD@D@D@D@DBDBDBB@D@F@FADAD@FADBF@D@F@D@DADCDAB@FBFBBBDBD@DD@DBB@BDFBDFNFHLDJBB@B@@DBDBDBBDBBCBEAC@E@ABE@C@C@C@EBC@CBCBE@EBAACAEAE@C@C@ABCBC@EBE@G@C@C@E@EAE@C@EAC@C@C@C@CBABEBCBEDCDEDCBADABCBEAE@CACAC@C@@AA@A@A@CHCLCFAFCDCHIBGBADAD@F@B@X@DABBBAF@DAJBDBD@BA@A@CBABAB@@@B@FAB@DCHGFEFEFCDCBCFGFABAB@@@DCHGBCBABAB@FABA@A@C@C@AECGI@ABCD@JA@@BAAAGEGK@C@@D@J@F@B@@@B@@@B@@B@@B@@@B@@BB@@@@@B@@B@@BB@@B@@@@@BB@@@@@@B@@BB@@@@@@@DB@@B@@@B@@@@@@@D@@@B@@@@@@@@@BAF@F@DABABA@E@C@AAE@@@AB@BADC@A@C@CAC@@@@BABAFCDABA@@B@D@B@B@B@B@@@DABAB@BAJ@B@BAB@@@B@B@B@B@@@BA@ABABAB@B@HBF@B@BA@@@C@ABA@@BAB@NGFABA@@@ABA@@DA@ABCBAB@BAJ@B@BAB@BCDEDA@CAC@A@@@C@@@A@A@ABABCB@B@D@B@@@DD@@BA@ABC@A@CBA@C@A@C@A@E@A@C@A@CBABABABCD@B@D@BBD@B@B@D@BCDABABEBCBCBE@C@EBCAC@A@C@AAACEAACABA@ADABCDA@C@CBAAC@@@C@ABCBA@CBA@A@CAABABEDCBABCDADCBADAFAF@F@FADCDADAD@DABCDADEDAB@D@FDD@DAB@@ABCBCDADBH@B@D@DBD@B@B@B@@A@CBEBEBEBCDCDCDCDABABA@AACCCACCCACCCCCACAAAC@A@@DAD@BA@C@AAAAECEAC@AACACACAECEAC@ABC@A@CBABAD@DBDBB@D@DADAFAF@FAD@FAF@F@FAB@DABABADCB@DAB@F@B@DAD@DCDADAB@DBDBBAD@DABADAB@D@B@B@BABABC@A@C@C@CBAB@DAF@F@D@BADABCDABABABABEDADBB@DBD@BAD@DAB@BADA@A@@AACAAC@CAE@E@CAE@EAE@A@C@CAAAAC@EAEBC@EAEACACC@C@CBEDCDCBCBADCB@DAF@D@D@BAD@BCBCBEDA@ADADCFADCDADCBA@A@A@ACAAACACAAACCAAAAACCAACC@GAA@AA@AAABCBCDCBCBA@A@CAAEECCEECCCAAACAGAGAI@G@G@CAC@CAGAGBG@EAE@GAEACAAAAC@CBA@C@@CAAACAAAAC@CAA@A@AAAA@CACDC@C@E@@@ADABADCBC@CBCBADCBCBC@AAACCACEA@AACEAAA@C@C@EBCBC@C@CBC@@@AAAA@AAABC@ABEBA@A@@ACCCCCACACA@AC@@AAB@A@@A@@@A@@@@@A@@@@A@@@@A@ABC@E@A@@@@@@@AA@@@@AA@@@@@A@@@@A@@BA@AB@@A@@@@@A@@A@@@BA@@@@BAB@@@BA@@@A@@@@@A@@B@@AB@@@B@@A@@@@@@B@@@@@@@AA@@@@B@@@@@@A@@@@@@B@B@@@@A@@BA@@@@B@@@B@@@@@B@@@B@B@@AB@@@BA@@@@DCBA@CB@@A@A@@@A@@@A@@@@@@@A@@@@@A@@B@@@@AA@@@BA@@@@@@@@BA@@@@@CBAB@@C@ABA@@B@@A@@@A@@@AB@@@@@@@B@@@BA@@@@@A@@B@@ABA@A@ABA@A@@BA@@@A@@@A@@@C@@@@@@@A@AB@@@@A@@BA@@@A@@BA@@@A@@@A@@@AB@@@@ABA@A@@B@@ABA@@@@@A@@BABA@@@@@@@AA@@@@AB@@@AA@@@AB@A@@A@@B@@@@@@@@A@@@@B@@@@@BA@@@A@A@@BA@@AA@@B@@@@@@@BA@@@@@@AA@@@ABA@@@A@@@@@@BA@@B@@A@@A@@A@@@@@A@A@@@@BAB@@@@@@@@ABABA@A@A@@BAB@@ABA@@@AAA@@B@@A@@BA@@@A@A@AB@BCB@BA@AB@@A@@@A@@@@B@@@@AB@@A@@BABA@@@@@A@@@@BAD@@A@@@BB@@@@@B@@A@@B@@@@@@@BA@@B@BAB@@@DAB@@@B@@@@@B@@@BA@@@@B@B@B@@@BAB@@AB@@@B@@@B@@@@AB@@@@@B@B@@@B@DA@@B@@A@@BA@@B@B@@@B@@@BABAB@@ABCB@@AB@@@BA@ABABA@AB@BC@@BC@A@@BAB@@ABABA@A@A@@BAB@@@BC@@@@B@@ABABAB@@@B@B@@@B@@@B@BA@@BABA@A@@@C@@DC@@BA@@BC@@B@@AB@@@@ABABA@@BA@@BADABABA@A@@BA@@DADCBCB@B@@ABA@@B@@@B@@@@@BA@ABA@@BABCBADAD@@AB@B@B@BA@@BABCBA@A@A@AB@B@@A@A@C@ABABABCBABAAA@AA@CACCEEEEGICEAEECECEEAG@E@GDAFBDBJ@F@FEDEDAF@DA@ADE@@DAFAFEFEFAH@DBH@DAD@FEHEFCD@F@L@H@FAHCFCDBFBFDFBF@FAFCHCD@FBFDFBDBFADCFEFAD@DC@CCICECAACBAFCAE@CBCDEBA@CACECEACCCECAGEECACCE@EAEBEBCFEDCHCFAHABCBC@GAEAGAE@EACBEDEBCBGBG@E@ECECECCAEACA@A@AC@GAABA@C@CAC@EAEAC@E@A@ACAAAEACAAAA@AA@A@CACAA@AACAE@C@ABABADAFAFAFABABC@G@EBC@E@ADC@AACAACCCCEAAAACAC@E@ABC@ACAAAA@G@C@A@@C@@AAAC@A@A@A@CAE@ACAA@C@C@C@CAAAACAC@A@EBCBCBCBCBCBA@EBCBC@AAACAAACACAAAC@C@A@C@A@A@GAA@C@AA@A@A@C@IBA@CBC@A@A@C@C@C@ABC@CBA@CBC@A@ACAAECCCCAAE@AAECCACAA@CACAAAAAAA@EAC@CAA@AAACECCCCAAAAAAA@EACCCAACCCEECCACACAC@CAEAC@CACAA@A@CAAACACA@CACAAA@A@A@C@EBCBA@@FE@ABABCDCDCDCBCBADEBCBCBEBCBEDCBADEB@@ABEBCBA@A@A@A@E@A@EBC@CBCDABABCBA@CCEAC@AA@ACACAA@@AABA@ABA@@FE@ABA@@FEDCBCBABABCBCBABADCDCBABEBEDCBE@C@C@A@G@A@A@ABA@ABA@CAEAC@@@C@A@AB@BAB@DABA@@@@@ABA@C@A@C@G@CA@ACAAAA@C@A@AAAA@CACAEAA@EAA@A@ABA@CAC@CA@@AA@@C@A@C@A@A@AAAAECAAA@ACACACAE@AAAAAECAAEEAA@@@A@A@AAA@@EAA@@AACCEAAAGACACAACAGAAAA@AAA@AACACCCAACCACCAA@C@C@@AAAAAAA@@CAAACAACCCCACACAA@AA@AEACCCECAACCCCACACACAA@CAC@ACCACAACAAAAA@ACA@AAA@EAAAACAECE@C@ICKAICC@A@ACA@ICCACACCCECIAKAGAIAK@KAI@C@A@IAEBIDEFEBIBABC@A@E@K@IBABCBABABAD@DADI@GBIDIBE@ECCEEEECEAC@A@EBCBCBADADADCBE@C@EAGAA@CCGACACACEGAAAAACAC@CAE@AAACE@C@A@A@C@EAE@AACAC@C@A@ABC@A@C@CBC@EBG@GAG@ACACBC@C@CACACEGK@EACCCA@AC@A@CBA@A@C@AAAAAACGCC@GAEAAAAAAAAACAAACACCCCA@AAC@A@CAC@CAA@@AA@AAAC@CAAA@E@C@EAEAA@CAAA@AAAAAACAAA@CAA@CBE@CFABABAD@DBB@DDDBDBD@D@D@DAD@DABCDCBCFEDCDAB@BBFBDBDDDDFBD@D@D@F@DCDAD@B@BBB@DBB@D@BAB@B@B@BADABAFADA@ADB@BDBBJDD@D@B@DB@B@B@B@BADADCDCFEFCDCDEBA@@B@@@B@D@B@F@H@BADABABABGFIJABCBCBCD@BCB@@@DDF@B@BEFCFADADAFCDADABCBC@CBCDEDEFCDEDCF@F@D@HBFAF@FCFGFIHMHEDE@C@CAE@E@EDGHCLENGRAB@DAF@F@F@BABC@EDCBCBCFEHEFABABAFCHABABCBGBMDGDGFCFADAB@D@FAD@D@F@DAFADGDGDQDA@ABAB@DAH@DEHEBGBGBI@G@GDEDCDCBAHAFEHCHEFCDIDEDADAD@B@D@BADC@C@E@GBIBCFAD@HAH@BCDCDEBEBGDIHEBAB@B@BBB@@@@ADADEBABGAA@CBEBA@AD@B@B@BBH@HBD@BABCDABA@MDIFEFABAH@F@HDHBH@BAD@BBDDDNFFDFD@BBDABADCDCFGHCDCF@DADADCFEDIDGDEBABIFGDADCF@B@DBD@@@D@D@DAFCDADEFEDIHGFCD@BBDDDDHBB@BAD@DBBDBDDBDBFADADCDA@CBEBA@C@AAACEAC@ABABCF@H@DCBCDEDEDEBEBADAF@HBH@DAB@BCBABCDAF@B@F@FBHBBBDDDBFBF@FBF@F@FADABA@A@AACBC@CDEFCDABAB@BBD@DBBBD@D@F@FAF@DAFABEDABA@C@A@A@@A@CACAAC@ABCBCFCFADADCDCFBDBBBB@BCFADEDAFAF@FBFBF@D@DCBAFAD@DBDBBDBBDBB@BABABCBCDABEBC@ABE@ABCACAEAC@E@CBABADADADCDCDABABBD@D@FADAFEFCFEBAB@BAHAFAF@BCFAFBF@HABABAB@@CBEFEFAFADBB@BADCHCFCHABC@GDCBADAB@BBFBF@J@F@FADEFEJCDCFCFAFBF@DBD@FBHBFAB@FDFBDFDFDFDDBDFDFFDDFBDBBB@FBBBDB@D@DABEHADABAD@D@DBD@DCD@D@D@D@DADADAD@D@B@DAFCFABAB@B@D@FAHAFAFAD@JBDBH@D@BABADGHA@EFADCH@J@BAB@BBFADABCBABABC@IBCBABCF@BBFAL@DARAL@BBJ@DADAFAHABABABABAB@B@BFXDFBDAD@DFH@F@HAD@DADADCHAHAFAD@HAD@DAD@D@@@B@BBDBD@BBBBB@BAD@BADCD@@@@@BABAB@DAB@D@D@D@D@H@B@D@D@BBBBDBDBB@DBBBBBBDD@@@@@@BD@DBD@@@@@BADABCDCFCDCDCBCBABA@A@A@ABA@AB@B@B@BBD@B@DAB@BABBBAB@BBB@@BBBBB@DBBBDDBDBDD@@B@B@D@B@@AB@BB@BDB@@B@D@BAB@@@B@BA@@BABA@CFA@@@@BABB@@D@@CFAB@BA@AB@B@B@B@@AFA@CB@BAB@D@@BB@B@@@@AB@@@@BD@@@BA@@D@DAF@B@@@B@DA@@B@@@@@BBF@B@B@D@DBFBD@BDLBB@@BD@B@@BD@DBB@D@D@D"],encodeOffsets:[[101781,28917]]}},{type:"Feature",id:"533422",properties:{name:"德钦县",cp:[98.911559,28.486163],childNum:1},geometry:{type:"Polygon",coordinates:["@@BABABA@GAEBEDE@ABEBEBG@ABAFADEFEBEBC@E@CACBABADCDCBCBCBCBADAF@D@FBDBDBBAF@BAD@FABADCACAAAAA@A@A@AAAA@@ACCAAAECAAEAAAACCCCACCA@A@A@E@CBCDCBEBABCAC@E@EAC@C@CDCDA@AB@@A@A@@AA@CAC@A@EBCDABADBD@BBD@BA@C@AACAABAB@DAFADABABE@EBCBCBCFCFC@ABC@C@EAE@C@CBCBEDA@A@AAC@CCAAC@A@ABABCDADCDCFAFADEFCDEBCBEBCACAE@E@EBCBEDEFEBCBEAAACCEECCAEAC@CBC@ACAACACCC@E@EACCECEEEACAA@A@ABCBAAA@CACEAEEECGCECCEA@CAEAC@C@CBC@C@A@C@GACAC@@BCBCBCBC@@@CACCCECECEECECEAGAEAAC@CBCBGBC@CACECCACECEAAAA@AA@CBC@@BG@C@CAECOGCAA@C@@BA@AB@B@D@HAF@BAHABABABCBCDCFCF@D@F@BBFADADADAF@DBH@FDFBF@FAF@D@HBFAH@H@@BB@FBFBBBJA@ABCBCBCDAB@JABA@ABEACAE@A@C@ACCA@A@@ACACCCACACCAIECA@B@BBDBDBFABCDCBEBEFCBCDCFADCDA@CBE@G@EDABAB@@BBFFFBFHDFDFADADAF@D@FDDDFBD@H@FBHBFAAABCD@@AB@BABA@ADAF@FBBBD@B@DADAFAD@F@DBDDDDDDDDBBBBDBD@D@F@FAD@@ADCDCFEHADCFAHAJ@H@FBFBFBDDFDD@B@DAD@D@F@D@D@DAD@BBDBBDDFDFBFBDF@D@DADADCDCB@DABBFAHAL@HAHAFADCBCBGDEBGBEBI@G@EDCBEDCDCHAHCHGJAB@DAF@HBDDF@F@DAF@HFH@BABA@C@A@CDABCBA@CBEBMDABABGLCD@@ABA@A@A@C@ABKFAB@FABABA@ABCAA@C@E@CBGDABA@@@AB@@AB@@BB@B@@@DAJ@BAF@B@B@BA@@@CBG@CBABCFCD@DABA@@@ABA@C@A@@@CAA@A@GDMBIBK@IAI@I@GAIAAAAC@EAE@GAC@@@CCCCCAAEAECGAM@A@EAA@CBA@ABA@A@AAAA@AA@A@A@AB@@A@@BA@@A@@@A@C@ABA@ABC@ABA@A@A@AAAAC@C@A@CBE@AA@@ABA@@BAB@B@@@BA@@@ECAIAGGGCC@I@UAc@G@K@EA@@AAACAC@@@A@A@@A@CBABADCD@@CBC@EAGAGAE@C@ABEB@@A@EBABE@MGCACAEIA@CCCAA@@@ABABABAD@D@DBDDBBF@B@D@F@D@D@DAD@D@D@DBD@BBFBB@DBF@F@D@@@B@@@BBD@B@@BB@@AD@B@B@@@DADAB@F@F@BCFAH@D@D@DBFBDBDBDBB@BA@ABA@C@A@@D@B@FBD@DAD@BABCDABADABADAF@BABC@E@E@E@C@@BCDAB@F@B@@ABABA@E@IBC@ABA@A@ABAB@F@BDBDB@@BBB@B@B@BAHE@@B@@@@B@@@BAD@FAFEHAD@DADAFABABCBC@E@SAA@@B@L@D@BAB@BABCFADAB@D@BBL@F@B@B@@A@@BC@C@EAA@C@A@A@A@AB@D@DADABABC@C@ABA@@FAF@D@F@D@DAF@D@D@DBB@B@BABA@E@CBC@@B@B@DAFAFCFAD@DADADABABAB@@@BBBDDFDDFDDBD@DBBADBD@DBD@B@B@BA@A@BBBB@B@B@@AD@DBBBBNDFBFBB@@DAB@B@DBFBBAF@FAFBD@D@FCDAD@DDHBFBFBDBDBD@BAFEHABAB@D@BDLBJBHBDBFFDJFJDLBLDFDDBB@@BBDDFDHBF@@BD@DABCDEFGFEDCFAFADADADBF@DADADCFCBEDCDEDCBAF@D@D@FCBEBCBE@E@A@@BBDBDDH@F@FAFCFEHCFCFAD@BCDADEFEBEBCB@@@DDDDDBDADEJGJEJCHCHAHAD@FBFEAE@G@EBIFKDEBCBEBE@G@E@G@CBADCFAFCHCH@H@D@DDDDDDD@D@DBDBDBDFDDFBDBBADADAF@FBFBDDDDB@@ABGBIDCD@BBBDBBDBDBDAFADCDCBCFAD@DAB@DCB@B@BBBDDBDBBADCFCBCDAD@D@DCDADAB@BHBDDBF@B@FADCDADADADCDCBAFCHAFAFBB@DBD@DA@EFGDCHEDAD@DBFBD@DADAF@DB@HAFBF@D@FAJAFBHBHBJDFD@@@BB@DHDP@@XFDBBBNH@@HDFBDDDBFFDFDFDFBDDDAB@@@F@D@B@BB@BB@@BB@@AF@BABBD@B@D@B@BABABC@C@ABAB@B@D@BBD@D@DBBBD@DBB@B@BCDCDABA@A@@@A@AB@B@B@DABA@ABC@C@AD@B@F@DAB@BABAD@B@DB@B@BB@@@D@DAB@@A@A@CAAA@@A@A@CF@B@DAB@B@F@@BB@@@D@BBD@DBBBBDBBBB@@B@D@D@D@B@BBB@@BBBDDBB@BBB@BB@@ABEDABABA@CBCDABADADADADAD@BAF@@@D@D@D@B@BADADAB@BAD@B@B@BAB@B@D@@AB@DAB@D@BBDBBB@DBDBBBDDBFBDBF@BA@ABE@A@A@A@AB@B@DDFBBBDDDBBDDBB@B@B@@AB@@A@A@A@C@CAA@A@ABCDADAD@BCD@BA@CBEAC@@@ABAD@B@BBBBBDDB@@B@B@B@DAB@BABC@EBE@A@A@AB@BAD@BBDBBFBB@B@@@B@@@@B@@BBAD@B@@@BB@D@B@B@B@@@@@@BB@@@B@@AD@@@@@B@@@B@B@@BB@@@B@@BB@@@@@B@B@@@B@@@B@B@B@B@@@B@@BB@@@BB@@@AB@B@D@BAB@B@B@@@@@@AB@@A@@B@@@@@BA@AB@B@@A@@BBB@@@B@BAB@B@@@D@B@B@B@@@DA@@D@@@B@BA@@B@BB@@B@@@@AB@@@@@BA@@@A@@@@B@@@@@B@@@@@B@@@B@@@@@B@@ABB@@@@B@BA@@B@@@B@@@B@B@@A@@B@@@B@@@@@BB@@B@@@B@@@B@@@@@BBD@@BB@@B@@@@@B@@B@@@B@@@B@DB@B@@@@@B@B@@BB@@@B@@@B@@@@@B@@B@@BB@@@@BB@@BB@@@@BB@@@@BB@B@@@D@@BB@B@@@BBB@@@@@D@BBDHDBB@F@DBF@FDDDBB@B@BB@DDFH@BF@D@BBFBB@@AD@FIBAD@RA@@B@B@B@@@BA@@@@@@AA@@BABABC@C@AAACAA@@ABCHCFCDC@EHGDAB@DCBCBG@EBCBCFEDCFE@C@ECKCIAG@ABCFCFCHABA@ACAAAACAE@K@G@E@GDGBCBABADAB@BEBCBAAACAC@AC@G@E@E@CDCDEBC@ACACACCCEAA@@B@BAF@FADA@ABC@KAEAC@CBA@AAE@C@EBADEDADCDAD@DABA@C@EAC@ABA@@BAD@FAFABABAB@B@FBFBBBBBBDDDFBDBF@F@HAFAFAF@DAD@B@BCBCB@BA@@DBDADBDBD@B@DAB@D@@BBBDDBBD@DBB@BBBJBHAD@FAB@BBBBBDDBBDDBBBBDADCDAHAH@D@D@BBDDBBDBF@B@DABCBABADBFBD@DB@B@D@DADAFCH@DADADAD@@BBFDDDBDBDBD@FCFCFCDCDEAGAGAGAABCDGDIDGDGDEBGDIBCDAB@D@HAJAFAFAFCB@B@BFFFDBDBD@H@JBFBF@D@DDH@BAD@DADAFBFDDBFBFBFAH@F@FBFDFDBBDDBJ@PADBF@DDBHFH@@BDBD@@@B@BBFB@BDBBDBBBFDDDB@BDDBDD@@D@HBD@BBDDBDDBDDFBFBFBB@DADBDBDBFJBBBDBH@D@F@F@D@@ADAD@DADAFCFADADAD@D@B@B@B@B@B@BABABADAF@DABABABAFCFAFCFA@@B@BA@@BCBEBA@@@EBCDADAHAHCHCDE@GD@F@B@DADAFCF@FAH@JAH@H@H@H@FBD@BJDHDDBBB@F@H@FBF@@AH@DBDBFF@F@HALAF@DADCDADAJAF@DADCFGDADAB@DBBDBJBFBDDFFDDBFBD@PBRBJ@FBF@H@B@F@DDDDDDD@JBFDBD@BBBB@P@ZCAAACACBE@E@@BABAFCBCBA@ABC@CAAACCCCEAC@@@ABC@A@AAC@A@ABG@A@AAE@A@AAA@AA@@@CBA@AAAAAGBABADCHABCBA@A@ABE@CACAAC@AA@@ACAC@A@@BEBCDADA@ABEAC@CACA@@CBEAI@A@CDCDEBC@@@A@AAGAA@CBA@A@CAC@EAC@C@A@EAC@A@CBEBA@C@ABGBCBK@K@C@C@E@C@A@C@C@A@ABCFCBABCBEBEBA@C@G@EAECKCE@C@A@@@ABC@A@A@ABE@C@C@C@A@@BABA@ADC@C@A@A@AAA@AACAA@C@CAC@A@CBABAB@BADCBCBAAA@AAAACAA@A@AAA@@@CAC@CAC@A@ABCBC@CBABA@A@CBC@A@AAE@C@CACAC@A@E@ECC@AAE@AAAAAAACACAEAA@@A@ABCBC@C@C@C@CACAAACACAA@ACE@C@EACAGAEAEAA@CBABABA@IAA@C@EBC@E@C@A@@AACGBABCBC@EBC@CBC@ABC@CBABEDGDGBCBA@A@A@CAEAEAABAAC@A@C@A@ABABA@ADGBEDABABABABCFEDEBCBEDA@ABC@C@EAAACAA@AAA@A@CBA@CBCBA@A@CCEAAACACCAAA@A@ABA@ABCFCBADCFCDEDCBC@A@A@C@AAEAA@A@A@C@ABCDAFEBABC@A@AAGAEACBC@CBCDAHCHAHAD@BCBA@CAAACAACA@AAA@A@ABA@AD@DA@@FADADABABCBC@A@CACAACCECAAA@@A@A@CB@BABAB@D@DAF@HABADABABAAC@AACACAAACAAAC@C@CAAACAAAACAAA@A@C@C@CBA@AF@BCBC@A@CGE@A@ABABC@ADG@CDCBC@A@E@CDE@A@AACACBA@AB@BCDADCDCBABC@C@CBEBC@C@CCCAA@KACAC@CAC@@ACCA@C@ABC@G@E@C@E@C@C@C@CBCBEBA@C@AACA@@AACCAA@CAC@CAC@CAAAAACAAAAA@A@ABADCDADA@ADC@ABA@CBCAGAC@ABCBABADADADABCDCBEDCBCBABABCB@HCDCBABABCACAE@E@A@ADAH@DADADABEBEDABABAH@DAB@F@B@BABA@AAE@E@G@CBABADEBABA@I@EBCBA@ABADADAD@FCB@DCDCDADCBA@@BABAB@JAFAFAHAHAD@BAFEBABCBCBABE@A@GAE@EAE@AAAA@A@AAAAAAAC@C@CBADCD@DAD@DBDBBBDBB@DA@ABAAC@AA@@A@A@@DADEDCBABC@EBE@C@EAC@C@ABADCDADAFADAB@B@BA@AAC@CBA@AD@D@BBBDBBB@@@B@B@@@BAB@@A@ABCBE@ADABAD@B@DCFAFEBABA@C@A@E@@FCDCB@BABAB@@C@CBABA@ADAB@DBFBB@B@B@B@B@BAB@BABABABABABCBADADADCBADCBA@A@C@C@CBABCDADCB@DABA@ABA@@@A@C@A@AC@ACACCCAACAA@AAAA@@AA@ABAAABA@ABA@C@AAC@A@A@ABAB@BAB@B@B@BADADADCDCDEDCBABC@A@@@@AC@CAC@@@@@@CCAAAAAA@CAAACACAA@A@C@C@A@G@C@C@C@CBA@CBABA@A@@@@DCBC@ABC@AAAAA@AACAC@A@A@@@CBC@CBC@GBCBEBGDGBCBC@CBC@G@EEG@CBCACCEEW@A@ABABABABABABGBEBC@CAI@ABKBQ@CBKAE@ADEBADAJAD@BABADABABCAE@ABA@A@IDGBCFEB@HGBCBA@A@CAGAC@IBCBEBEBG@E@C@ABABADEBE@C@A@CBCBCBC@C@C@C@CDC@CAC@C@CBCBABCFGBA@C@CCAAAEAA@AAACCEECCECECAECECECACCE@EBAAEAG@EAC@CAEBEDEDEDCFIFEBC@E@E@IAEAE@ABABCDAHCD@BADGDEDGBC@AAABCBEFEFEDA@@"],encodeOffsets:[[101854,28356]]}},{type:"Feature",id:"533423",properties:{name:"维西傈僳族自治县",cp:[99.287173,27.177161],childNum:1},geometry:{type:"Polygon",coordinates:["@@AABEBABAH@F@D@D@DADCDE@G@CAC@K@E@E@C@CBAFCDAB@BCBE@CAC@AEDEBG@CBA@E@CAAA@C@ABEDAFAFC@CBC@C@CCKAI@CCG@G@EBIBEBEBCD@DAD@BCAEBG@EBE@GDCDCFEDCBCHEFAFCFADAFAB@D@BC@EBGBEBIBGBCBC@A@@BAB@BABAB@@CBABCBAB@B@BAB@BABABC@AAA@A@A@CBCBCBC@C@E@CACADABABC@E@E@GAGAG@IAGAC@@@IFCDCBCBABA@A@@@A@@@EAECEAGCECCC@@ACAB@@ABAB@BAD@DADA@CDCDADA@ABAAAAAA@AACAEACACAAACAE@AAACAC@C@E@AAA@AC@@@CBABABA@ADCBCBADCDADAFADADAD@FAD@BAAAAAA@A@CAECEA@@QEC@CCAAICIEEAAAA@AAECAAC@A@AB@BAB@BBB@DBDBDBD@D@BAB@BCB@BAB@D@BABCDCBCDCDCBADABAD@D@BBDBBDBDB@B@B@BADABEFCBCBABAB@BBBBBBB@D@@ABADC@ABAB@B@@@BBDBDADAB@BCDADEFCHABCBEFCDCDADAHCHAFAHCDADAB@BBD@BAB@DCB@DCDCDCFAFADABAFCDABEDCBAFAHADABABC@A@A@ADCBCDA@A@C@C@E@ABCBABC@G@UCCAAE@C@CBEDCBABABCBC@ABCBE@CBCBCBCBEBCDCDCBCBA@CAA@C@AACCCCCCCACAAEC@AA@A@@@A@EA@@C@A@AACAACAAACAA@CACA@AAAA@AA@@A@@CAEAAACAACA@@@A@A@A@@AA@CA@AA@A@A@@AA@@@@AA@@@@@A@@@@@AB@@A@@@@AA@@@@A@A@@A@ABA@@@A@@AA@A@EBC@ABA@A@C@ABA@@@ABA@A@A@AB@BA@@@C@EB@@A@@B@@A@A@A@EB@BA@@@A@@@A@AAABA@@BA@@@A@@@A@EBA@C@A@AAA@C@A@A@A@A@A@AA@@@A@@A@@@@@@BCH@@@B@D@@AB@@@BCB@@ABA@AAA@@@EAA@A@C@CACAEAAA@@A@A@@@A@A@@@A@A@@@A@A@AA@@A@@BA@@@@@CBC@@BA@AB@BCB@@ABED@@CDABABCBA@AACCAAAAA@@@CACCACACAACA@@AAA@CAC@C@CAEC@AC@ABOBE@C@CCKEEAC@C@@@GDEDCBA@@@A@E@KBABCBC@@BA@AB@B@B@@AB@@@@AB@@@@AA@@AAA@AA@@@@AA@@A@@@@@A@@@A@AAA@@@A@@@@@A@@@A@@@@@@@A@@@@BC@@@@B@@@@AD@JAJAB@BABABA@@BA@@D@@BJDN@BBB@@@@B@@@B@BAB@B@B@@AB@D@B@F@DA@@B@BA@@@@B@@@@B@B@BB@BF@F@B@DBBDBFFBB@B@@C@C@IC@@ECEAC@E@E@A@@@CBCBCBA@ABCDCBABA@CBC@ABAB@@@DBBBDDB@D@BCDADCDCD@@CDADCDAFCDAFAD@@@D@BBDDBFD@BDFBFDF@HBDABCF@F@DBF@FAF@F@D@F@DBBBDBBBDBB@BAF@D@B@B@D@B@BBBDDBF@D@FABC@E@E@CBCDC@EBC@ADADAF@DBFBDDBFDBDBDADCBAD@F@DDDDBDBDBFBDBDBBDBDBBBDDF@F@DBDBFBFAFAF@FAF@D@B@BBBDFBFBD@BAB@BCBCDAB@B@D@B@BBDADBDBBDDBBBBB@B@DBDBDBB@B@B@BBB@BD@F@D@FBD@F@F@D@F@DAF@FCH@FADAFABABABADABABAF@BADA@ABAAA@CACBA@CBC@C@EACACAEAEACACBEDCBCDAFABCDCBCACAC@CD@@EBABA@A@@CAAACCACAA@@@C@I@EACBABBBBBBDBDBF@D@DAF@DBF@F@F@DABCDADADADAFBFAB@DC@C@CBCBCDCDAD@DAD@D@DBBDDBDBFBFBFBFBDBDBDDB@B@B@BAD@D@BBFBF@D@DADAD@BBD@DADAFABAD@D@B@D@D@DBB@BABABADAB@DABABCDADCFADCDABA@A@A@ABADAB@@@BBDBFBDDFDDBDBDBF@D@FBDBDDBDDBBBBADABAFAD@H@FADCDA@AB@BAFADCBCDCBA@C@@B@DBDBF@D@DABCDCBE@CBCB@D@F@BBBBBBBDBDBBD@DBF@DBFDDDDDDBBD@DBBB@B@F@DBD@BDDFFBDDDBD@BBDDDDDBDDB@@@BABABC@C@CD@D@D@DCDCBCDAD@BAD@DABC@ABABABADCDCDADADCBCDADEDEBEDCDEFCDADABCDE@CAE@A@ABCDCDCDAFCDCBCDCBEDADADAFADAFAD@FBBBDB@DBDDHBDDFBFBF@FDH@DDDBFDDDBFBBBDBD@B@BADAD@F@FAD@DADADCDEBABABADBB@DAFADAD@D@FBDBD@DADADCDCDCDED@BCBCF@D@D@D@FBFBF@FBF@BBB@B@DCDABABEBC@EAECEIIGECA@C@EBCBA@CDADADA@ADADCBCBCDADAD@@A@ABA@@B@BBF@BBBBDDDBB@BA@AH@DBH@@@BC@ABA@A@CB@BA@CDABAD@B@B@F@B@DAD@DABCDAF@B@DBD@DBD@BADCD@B@D@FBD@FBF@BBBDD@BB@DBDDB@FJDBDBNHF@BAFAB@@@FABAD@F@HBHBFBD@DA@@DCBCBADAB@@@@B@B@@BDBDBB@@FBL@H@d@VBJ@D@HDHHJBDB@F@@AB@@A@A@AB@@AB@BB@@BAF@D@B@DBDBB@B@B@BAB@BAD@BAB@B@D@B@@@BB@@AB@@@BAB@B@B@@BBBBBB@B@BAB@DAB@FBB@N@HBFDFBBBDDDD@D@@BD@HBF@FBDBBJBHBJ@J@JBL@JANAHCB@B@DB@@B@D@B@BA@@B@BA@CDCDEBADAH@DA@@B@@A@A@ABE@ABI@C@@@AAA@@BA@@BA@@B@BAHCDAF@D@B@DBBAB@BABA@EBALEBAD@B@B@B@BA@@DCHKBABANCFADAB@DABADCB@D@B@BA@AEG@GBE@C@ECEAC@GBE@CBAHIDGBGDGDCFCDAFCH@J@FAHAFAHCDADABCBEBG@GBKBGAEBA@CDADCBCBC@C@CCEEAEAECCCAAAC@ABC@C@C@C@E@CBC@C@ACCCEACAEAE@E@GBIBGDEBCFGDEDCBC@@BC@E@E@CACACAACACCCCCCAC@C@EBCBEBC@C@AACAA@EBEBCB@BA@ABA@@DCBABBAEAG@E@GACCECC@E@CBEBCBCCECEEGEAEEAA@@BABAFCH@F@DAB@DCBCDEDCDAFEFADADCBAAEACAC@A@ADBJFDBBDBDDDBDBD@@@BDBBDD@B@F@DBFBBAB@BA@IBADCDADABAB@AIAAAE@EAA@@@GBGAE@G@CBE@EAECE@EAG@CBEBCBCBCAE@A@E@CDEDEDCDABABABABG@ABE@G@C@ABAB@@AD@B@DBPHFDDBD@H@@AD@DAB@@BBBBBDFDFDBFDBD@DADAHAD@DBDFBHBFBFDFDDFDFDFDDDB@@D@DADADA@AD@DBHBD@B@D@D@DAD@D@FBDBB@DFFDHDFDFFFBBD@DBBABAD@B@BBBBDFFDFDFBD@F@FDDBDBDDB@BAD@DBDBFDDFFDDBBFBDAFAFEFCDAFAF@F@DBDBFADAFADCFEBCBEDEDCBCDCBABAB@D@BBDDD@BBB@B@FCDADAD@F@FBD@D@BAD@DEDEDADAFAF@BABABCBE@CBABADBBBD@B@@AAC@AACBCBADCFAB@D@DBB@@BB@B@@@BAB@DCDCD@D@FBF@D@DBBAFADADCDAF@B@B@B@DDDBDDBDBBFBBBFDBBDBBD@@BBBBB@B@B@BBBBBDDABABA@AAAACCAAAAC@CBCBEDA@C@CAEAE@EBEBEFCBCDE@AAAAAACDEDCBCBCDEDEDABAD@BBBD@D@BB@BC@E@CACA@CAGCECE@CACAECACCACAGAEAGAE@CACAAC@CBEBCBA@CAECCCEAE@CBEBI@AEGGEAEEECEEAEAE@C@C@AAICGAMCG@GACBE@CBCBCACCEAECECC@EAI@C@C@ACCA@CAC@EBEDEBABABC@AAAAA@A@CBCBCBABA@A@AC@CAECCEACAEAEAA@CAABCBCDADABABC@CBCDCDEBABEBE@E@CAEAGACAA@C@CBC@CBA@C@A@C@ADADCDAD@B@B@B@@AAC@CBGBADCBAD@DABABA@A@CB@BABA@A@ACCEEGEEECEAAAA@C@CAAACAC@A@CACCCAAACAEAEACAEEAEAE@A@C@AAAEAE@C@CAC@C@EBIBGDEDEDEBEBGBEDIDIBCDIDCBCBE@C@@CAGAE@EAE@CACAEEEECCGCIAAA@@BA@@B@@AB@@@@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@A@@@@@@BA@@@@@@@@@@@A@@@@@@BA@@@@B@@@@@B@@@@A@@@@@@@@@@@@@@@@B@@A@@@@B@@@@@B@@@@@@A@@@@@@@A@@@@@@@AB@@@@@@@@@@@@AB@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@A@@@@B@@@@@@@B@@@@@@@@A@@B@@@@@@@@@B@@@@@@A@B@@B@@@@@@@B@@@@@@@@AB@@@@@BAHADC@ACCEK@G@CFCFAFEDCAO"],encodeOffsets:[[101803,27813]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("红河哈尼族彝族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"532501",properties:{name:"个旧市",cp:[103.160034,23.359121],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@B@B@BBB@@@B@B@@@B@D@B@B@@@DBBB@@BBDBB@BBBBB@@BB@@BB@DDBBBBB@BDB@BBBBBBDB@B@@D@DAFADABAHCBADAB@@AFABABABCDIBABEAACACAGCECA@@ACCCAAAGAAAA@CCECC@EAAACC@ACAA@@AA@ABA@AB@BABA@CBCBCBC@CBABABABA@ABC@A@@BA@A@A@AAA@CBCBGBGF@@ABA@ABCB@BA@ABABA@@D@BA@CBA@@@CAA@A@ABCBCB@BA@AB@BA@C@A@C@A@ABA@ABA@C@A@C@ABCDABABABABA@@@AB@@E@@@ABGBEBCBCBA@ABCFA@ABC@A@A@A@C@A@ABADADAD@BA@C@EA@@CBAD@BABC@EBIDCBCBABCBABCBABABCBA@ABCBC@BBPDHBJAJCHCHEFAF@JCJBJAHAHAPAPHRJLAFCFEJ@BBFBBARAFABCBABABAACCAGGIICGAAGGAC@GPIVEZCD@DAB@"],["@@DADA@CBADA@CACACAE@CACDCBABCD@DADAD@DADC@EACBCBABCBCCA@CBC@CBCACECAABCD@@C@ABGBIAIAEEAEACACAA@E@A@IBIDOFG@AAGAM@EAEAE@CCAEAACCAAC@C@GAE@@ECAABCBCDC@BE@ABAFa@G@ECAE@KFI@MAEEGIAE@CBG@IDEBEFEF@B@DAFABAFALCFCFEDC@CB@FABCBCDCBC@CAACCDAD@D@FAFADABABCDA@@FKJGDG@EAEAC@@AAAAEGAAGEGCAACAKEECEAAAAAAACEGEEEIGECCAA@C@A@A@ACCA@AACAECCCAAC@@ACABA@C@@BABCBCBE@C@C@ABC@ABC@AAA@A@A@A@A@AB@DAB@@AB@@AD@@A@@BA@ABC@ABA@A@A@@BA@A@A@A@AAGBA@A@C@EBEAC@C@CBC@CBC@CBABABADA@A@CACAAA@@CBC@CB@@ABA@A@CB@@C@A@C@C@CBA@A@ABC@CBA@C@EFA@A@A@A@CBABAD@BABCBEBA@CBABAB@B@@@D@BA@@@C@E@A@A@C@A@CD@B@BBD@BABADABABA@@@AB@@@DA@@BA@A@A@A@A@ECCAAAA@A@C@A@CBABA@CBA@CB@BA@A@A@A@AA@@C@@@ABABABCBAB@@@B@D@@AB@BAB@DAFAFAB@@A@A@AAC@A@CBAB@D@DABA@A@A@AAEAA@A@A@C@ABC@ABC@CBCBCBC@CDABCBA@C@CDCBCBCBCBABA@C@A@AA@C@AAAA@A@E@@@@@A@@@@@A@A@@B@@AD@F@DAB@DCB@BA@A@CCA@CBC@EHGFABAB@DAD@@AB@BCB@@A@CAAAC@CAC@C@AAA@AC@AA@AACBABA@A@A@C@C@A@ABAB@BBDBBDFBD@BADAB@BC@C@CAAAC@A@C@@BA@C@A@ABGDC@A@CAAAEAA@EACAC@@B@B@BAB@BBB@@B@@BBD@@B@B@@@DD@@BAB@@BB@@@@BAB@@@BB@B@@@BBBB@@@B@@@BBDB@@@B@@@B@@B@B@B@F@@BBBB@B@@F@BBDDBBDDB@D@B@@BDB@@BB@BBBB@B@@B@B@BB@BB@B@@B@BB@@BABBBBBBBBB@FDBDB@@@@BDBB@@B@B@B@B@BBBBB@BB@BABB@@@B@BBBBBBB@BAD@BB@BB@@@@@D@BBB@@@B@B@@BB@B@DB@@BABAB@@BB@BB@A@@BAB@BAB@B@BBB@B@@AD@@@B@B@@@BB@@D@B@DDD@@@BC@@B@B@BDBB@B@B@@BBBB@@B@@@@AB@@@@BBD@@B@BA@@B@@@@@BB@A@@B@@BBB@BA@B@@AD@@ABAB@@@B@BB@B@B@@@@B@@@B@@A@B@B@B@@ABBBBB@@BA@CB@B@@@@D@@@ABABA@AD@B@@DD@B@B@DABBBB@BABB@@AB@BAB@@A@@B@@@BDBB@AB@BABA@@DBB@@@B@BA@@BBB@@DB@B@@AB@B@@@@@@D@@@@B@@A@@BAA@B@@@@@B@@@@@BB@@@B@@@@B@@@@A@@BB@@B@@B@@@B@@@@B@@A@@B@D@@BB@@@@@BC@@@@@BB@@B@B@@@@@@B@@@@AB@B@@BBAB@@BB@@@@B@BA@AB@@@BD@@@BAB@@@@@BB@BB@BB@@BBBBBB@B@@@B@BB@@@B@@@@BB@@AB@@@B@@B@@@@BB@B@@ABA@@D@LDFDBDFBDBD@FFFBFDF@BBF@H@HEFADEBCDAHAFCH@F@D@FBBBB@@D@DAFALAJDLFJBBHBDBD@DDHDBDADAHAJFJHHHFJDLFNDFD@D@B@FFBF@T@BALEHAH@H@TDN@FBBBBD@F@D@@AF@B@FBDBFDFBFDBBBBF@D@BAD@D@B@DBBBDB@D@@DAB@BADCBCBA@C@@D@HBD@F@F@B@BADAJ@BADAHAFAD@B@DABCDEBEDCDCD@D@D@FDDDBDBBBDBDBDBFBBBBJ@J@FB@B@@AB@@@BAB@@@BA@@B@@A@@@ABAB@@A@AB@@AB@B@@AB@B@@@D@@@@@B@BBBAB@@AB@@A@@AA@@AA@@@@@@@A@@BA@AAA@A@@DAB@BBDDDVBDB@@@DHBHBHDBFBJ@RBF@BAFAHEJEREDABA@AAACCSUCMCK@G@CBGHI@@DAF@DAF@DCHALADAH@FDHBBDDB@B@B@@@F@FD@B@BAD@B@HBDBF@DAFCBADAHBF@B@BADCDCBC@A@A@AAAFEHCFBDBH@DADAB@HCBADC@ADCDAA@ACIM@ACAECAAECCCCACCCCCEECECKCCCAIACAEAAEC_ECAQEECEECE@@EEB@BC@ECGD@B@HBBABA@A@AQO@@CE@CBCDAFCBCBABED@HBBCFCD@H@L@F@DALAFA@@BADEDBD@J@B@HDRHFBFBFCDABEHYJOB@BANUBA@AEG@C@C@ABIHAFDHBF@DADA@@VOFE@@DAAA@@ACCECCC@ECC@E@CC@ACCA@IECCAEAAEDABCBADCBC@ECCEBGAECACCAC@C@CCC@EBC"]],encodeOffsets:[[[105864,23569]],[[105781,23894]]]}},{type:"Feature",id:"532502",properties:{name:"开远市",cp:[103.266624,23.714523],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@C@@E@E@@@A@ACAACGAECG@CBKBGBCDE@CBE@CB@@GJAH@D@HDLDNTVDDBB@BABCBQFIFGFEBABE@QAI@EACAAGAGCG@@A@ACCUCCAAA@CB@@@BBB@BAB@@@B@@@@@@BB@@BB@@@BA@@BA@ABAAA@@@@@C@@@A@A@@BA@A@@BA@@B@BA@AB@B@@@BA@@@AB@@A@AB@@A@@BA@A@BJ@HADGFC@C@ABCBABE@GAA@EAC@E@EFCBCDCFADBDDDBB@DBBDB@BCBABADABBJ@D@BAD@D@BABADBF@BBBD@DBFDDDDF@J@DCF@BBDBBBDDBD@B@@B@@CBCBEBCBADAD@F@@C@CBC@CB@BABA@E@E@AD@B@HBH@D@FAB@BAD@BBD@BBDDDBDDDBBD@BF@FAFAHEJCDABEFA@ADCFCDCHABADCBABC@CBABAB@B@@BB@@FFB@BB@DBDBDBB@B@F@FADCDAD@F@DBDD@D@DBBBHHFJFDFFFHBHBH@F@HBDBDBDBD@@@BB@H@D@B@DABBB@D@BABCD@BD@BBDBB@DBFDBDBBB@BB@B@BB@B@BBDBBBBBBDBB@D@BAB@BAD@@@BBB@BAB@B@BBFFBBDDBBHDDFHDD@DBD@FABADBBBDBH@FHBA@ABCBA@A@ABC@ABC@AAAAAAA@@DABEDIDKBI@G@UBMDGFEFAHAH@H@PD@@hHHBDFBFDFJFPBLBDDDHBHDDDBHBDBDBBBDDDBB@BA@CBEBCBA@CBA@GB@B@@ABA@AB@BAD@DAB@H@JAFAB@B@D@B@@@B@BB@@B@@@B@@@B@@@B@B@BAD@@@BB@@B@B@BA@@B@@@@A@@D@B@@AB@@AB@B@DCBA@@B@B@@@B@@@B@DAB@@@B@@@@@BA@@@A@@B@B@@@B@@@BBDA@@B@@@@A@@@@FA@@B@@@BB@@B@@B@@DD@@@@@B@DA@@B@@B@@B@B@BDFBBBBFDB@@@@B@@@@@B@@BB@@B@B@B@@@DBBBB@B@B@B@B@B@@@@@BBBB@@B@F@B@LDB@DDB@DBB@DDDBB@FBB@@BDDBD@@B@@@@BDD@@@@@B@@BB@@B@@@@@B@@@B@@@@@B@B@B@@@B@@@B@BA@@B@@@DAB@BB@@D@B@@@FBFDDBB@@BBB@B@BBB@BDB@@B@@@@@@@B@@@B@@A@@B@@@@@B@@@B@B@@@B@B@B@@AB@FEBABAB@@ABABA@@BABCDGB@@AD@BAB@@@@@BB@@@@@@DBB@B@@AD@@@D@B@B@BBB@B@@@B@@A@@@@DA@@@@B@BAD@BADAB@@A@ABA@@B@FABA@@B@D@BBB@B@@AB@@@@@BA@@D@D@@@B@@@DAB@DAD@@AB@@@BAB@@BB@BB@@B@B@B@D@BAB@@BB@@@@@@@B@D@FBDBDB@@B@@B@@D@BBBBBBDBBB@@BBDDB@BD@@DDB@BBF@B@B@D@D@B@D@B@B@@BB@@@B@B@B@B@@BBB@BBBDDBBD@DBB@BBB@H@BBB@B@B@@@@B@@BD@@BB@B@@BBA@@D@D@B@D@BABADCFADAB@B@@@B@B@D@@@@@B@B@@@BAD@B@BAB@B@B@@@@BB@BBB@BB@@BB@@BBBB@BBBDB@BBBBB@B@@BBD@B@B@DA@@@@@AB@@@B@BBD@@BH@BB@B@B@@D@BBBBD@D@B@B@@BDB@@@@B@B@@A@@B@BF@BBREJAHCFCHGHIHCDCFCJKDC@@BAD@DBLHHBDCFGHI@I[QBCDE@@DCHEFAFCDCBC@CCGACACBCFABABACAECMEECEBCA@EAEAAECEAO@KAGCCEBCDEBG@E@GAEGGCGEGAK@@AC@ECAABABC@GEAGBCB@H@F@FBBACEEG@C@E@GAGAGCCAE@K@KBGAI@GEICEEEEEGEHIFEDCBBJDBCCICEJGBMDIBAACCCAA@CFCDABE@CACACCC@EDADGE@E@@G@C@CBCBCFEHCFADCAIBE@@BCBGEIEGAAIKAE@GACCC@ASQGKCG@GCEEGQI@AAGECAAI@GCGACAM@CACCAADGDCDGBIAI@QBIDEVKTMJEDABABE@E@@CBC@@@A@EEE@C@CFEBCAEGGKAAAAECCBEBADABCDADABADCBEBEBCDABE@CBCDAAE@CFADCDCDGF@BCBEDGDEBC@EAGAABADABC@CACAC@CBGFIDA@CBC@E@C@C@I@E@IBCBEBCDCBCFA@CBCFEBGDEBCBC@EBG@CBCBI@E@C@E@C@G@EBC@CAEBCBA@ABGBG@CAGCEGCA@@AAAAEAEB@@IGCEAAAAEAGAQCGAGAACE@E@C@C@AAGACAEB@A@@A@@B@@@@@@A@AB@@@BBB@@@@@B@@@B@@@@B@@@A@@@@@@@@@A@@@A@@@@@A@@@@A@@@@@@@@@@AB@@@@@@A@A@@@@@A@@@@@@@@@@@@@@@A@@E@EACAACAC@CBCBCBC@A@A@CBCD@BCDABGDA@CBCBG@CAEAGDEFBB@B@B@BADCDCDABA@E@GACBABEDCBE@CAGAA@C@AB"],encodeOffsets:[[105720,24136]]}},{type:"Feature",id:"532503",properties:{name:"蒙自市",cp:[103.364905,23.396201],childNum:1},geometry:{type:"Polygon",coordinates:["@@BABCB@DC@@DABADA@A@A@A@A@CBA@ADCBCDC@ABC@@@AAA@A@@@@AA@@AA@A@@@A@A@@@@@C@A@@@AAAB@@AA@@A@@@@AE@@@A@@@@@AA@AA@@@@@@@ACAAAAAAAA@ACA@AAAACCA@@AA@@AA@AAAAA@CAAA@@AACA@@A@A@C@A@@@A@A@@@A@AAA@A@@@A@CBC@YDUFOJ@HBDHHBBDHJJHHDBBDABABABADEBQBABEAAAI@EFEDKBQIOGOBGBGBIBIAIDE@EBGFGDIDIBGAOCAAC@EBABA@CB@@ABC@@BC@A@E@CBC@A@CB@@A@A@C@EDADABA@BD@@BDDBDDBFBD@BDBBDB@B@D@B@DBFDJHFFHFDFBBBBBBFBFDLFDBBBHDHFBBFHBBBB@@BDBF@FCHIHEL@@CBADABCBEBEBC@C@CBDDBB@DADCDADADEBA@@DCDEFEDKDEBABEBCBA@E@EFAFCF@JAH@DBFHJFFNBJ@LEF@DB@F@HEbAB@BAFD@DCDABADB@FF@HBD@D@BBDDBBBFDDF@FBFBN@HBBBH@PEJCJAB@F@B@DBDBFBFBBFBJAJAH@B@DC@ADBBFDBDAD@DAD@DDBADADABADBD@FCDCBC@CBCBC@ADABCDBD@DBFBDBD@DCBAB@DCBCBAD@FDD@D@DBDDDDBBFAHDFFDD@DABCDABAFCBBBFDDJFB@DD@BDDF@D@FDD@DDDFBD@@BBCB@@EFUP@@CBCBE@GAECGBAJ@B@D@DFH@BABMVABA@IPGZAFCBEDEAEAQGGCA@I@C@CACFAB@@EBKBCBE@K@G@C@EDADGAC@AFABADEDCBAD@DDF@@RP@B@BABABGAA@C@DH@FADA@FF@@DFFFFDRFDB`FFDBBBFBDBJDDLDFDFDDFDDDDDBDDFDBBFDDB@BJNBDB@B@B@D@DADADAD@DBBBBD@F@FB@@@@@@@@@@@@@@@B@@@@@B@B@@@@@@@BA@@@@@@@@@@@B@@B@@@@@B@@@B@@@@@@@@@B@@@A@@@@@@A@@@A@@@@AA@A@@BAB@@@@@@@@AB@@@@BFADBHBBBD@D@F@F@BDHBHBRDHBFBBBBBDFJH@@FAFBBBBB@@DBFHHDDBH@HABAB@DAFADBD@FAH@D@F@D@F@J@DADAH@FAD@DAFAHCFADEDAB@DEDADCFADAJAF@J@D@D@F@D@DAB@JCHEDAD@DBDBD@BABCBAHBFBD@FAHCFCDA@AHEDCDCBCDEF@BBDCDAF@BADCFAFADABCBABCDCBABCFADAFDBBBBHLFHDBFADED@F@FFB@@@D@DABADEJIHCH@B@NALDJFJHDB@@HJHHBBLFDANIHCDADBJVBDBB@F@DFHHDJHJDJBFAHGDG@ABAFAH@J@B@B@BA@@BAAAA@OAC@CGAE[ICAAEFCHCLEFCBC@EEIAKBCF@HBJDHAF@HBJBDBFDDBB@FADEDEDCJ@H@JAFADDDBB@B@B@@A@@GKIKAACKDCFCLBL@DBDEBGAIAIEGGCCEHENELKBAFI@KCMKEICEEACHKHIDC@CFiGMGK@EDE@C@CBEBA@@BC@@BCBABAAA@A@AAA@AAA@@GICA@AAAAA@@AAAAECEEA@AAC@AAA@C@CBCBGBABA@A@CAA@C@A@ABEDCB@@CB@@C@O@C@CAA@A@A@@@@B@B@D@@@BAD@@@B@@@B@@@@@@@B@@@BBB@@@@AB@@@@A@AB@@@@A@@B@@C@@B@B@@AB@@AB@BABCDAB@BABAD@@@@@B@@@@@B@@@@@BA@@B@@@@@@@B@@@@A@@@@@@B@@@@AB@@@B@@@@@B@@A@@@@B@@@@@@@@AB@@@@@@AB@BA@@@@B@@A@@BA@@@@B@@@@A@@@@@@@@@@BA@A@A@ABA@@@@BC@@@@BA@@@@@A@ABC@@BA@@@@@@@@@@@A@@@@@@@@B@@@@@@@@AB@@@B@@AB@@@@@@@BA@@@@@@B@BA@@@@B@BAB@@@@@@@B@@A@A@G@EBG@GDCBEDAAEA@AGKCECCEACCAACAACKAGE@C@E@AFED@D@DABE@A@@@@@@@AACAAAC@A@A@@A@CMACDCDEDEBAAIBEAI@GBAHG@A@@@C@I@IBIAAC@@A@ACAECGIIICEECCCAAGCEGAC@EACBA@@@AB@BABAD@BAD@BA@@DBB@B@@@@@B@DCBA@ADA@ABA@AB@DEBABA@ABA@A@@@ADC@ABA@A@C@ABCBA@CBA@@DCBA@A@@@A@@@A@@A@ACAACAEACAGEAGHK@A@@BCACACAE@E@CDAJAEG@CLCHCFCBCBEACCCACCAECCE@C@EBCDEACECCCEAEAAAC@@@CBABA@A@CDEBA@ABA@AB@@EBA@@@ABG@ABMD@@A@ABA@A@ABC@ADCD@BAB@BABAB@BABAB@BAB@BAB@@CD@BEFIFCBCD@BAB@@@BAB@F@D@B@@AB@BAB@BCDABABCDCB@BAB@@@BE@@@GBCACCACAC@AACAA@CCCACAAAAC@GAAAEACAE@AB@@@@@@@@@@A@@B@@@@@@@@@@@B@@@BA@@B@BA@@B@@@@A@@@@@A@@@@B@@@@@BA@@B@@@@AB@@@B@@@@@B@@@B@@@B@@@B@@@B@@AB@@@@A@@BCAACCAAAECE@@@E@E@C@@AAAGGGG@@AAAAC@AAA@A@C@@AA@@@A@@@@AA@@@@AAA@@A@@A@@@A@@CAAA@AAA@AAA@A@AA@@AA@@C@@AA@A@@AA@@@ABC@@@A@@AA@AAC@A@@AAAA@@A@AA@@AA@AB@BB@@@@@AB@AA@A@C@A@@A@@@@A@A@@BA@AA@AAAA@@AACACAA@E@GBE@A@AAEACAA@E@EAC@C@C@C@E@EBA@EAEECECAC@GACAAAEAAAGEEGEE@@@AAA@CBABE@A@GDE"],encodeOffsets:[[105889,23635]]}},{type:"Feature",id:"532504",properties:{name:"弥勒市",cp:[103.414874,24.411912],childNum:1},geometry:{type:"Polygon",coordinates:["@@EBIBG@A@CBC@ABA@@BAB@BA@A@@HAB@DABADAF@DABA@CACCAACACAGACACCAGCGCCKAOAIECEAECEGAgG@@OCG@G@GBEBEFCHAN@V@HAJCLCJAFCB@@BBBBBB@BAD@BAD@B@BABAD@BABADAB@BAB@BA@@BADAB@B@B@@ABAB@@ABA@@BAB@D@BAD@@BF@B@B@@ADABA@@BAD@@@FAB@@@BA@@B@@B@BB@BBB@B@B@@@BBB@BBB@B@B@BBBB@@B@BBD@B@D@BBB@@@B@BAD@@@B@B@D@B@@BB@BBBAB@BCBCBA@AACAA@A@CB@BA@AB@@AB@@@@ABC@A@@@A@@B@@AB@DAB@BABAB@D@@BB@B@BAB@BC@AB@B@B@B@D@BAB@BAD@BAB@BA@ABA@AAAAABCBAF@DA@E@ABABC@A@@B@BBB@BABA@ABAFAD@B@@@B@B@D@BBDAB@DAB@DAB@D@BAF@B@B@D@D@DBB@BB@@B@B@D@B@@@D@@@F@@@DBBBDBBBBBBBDBB@BBBBB@D@B@D@@BD@BCF@BCF@BBBB@@B@F@D@F@BBFBBBB@D@B@F@B@BCB@BAB@@BB@@B@B@DA@@B@@@BB@BAD@D@F@BDBBDDDDFBDBFBD@DADAH@D@BABCB@D@BAB@D@F@BA@@B@BB@ABABA@@BCBC@ABE@A@@BA@CBCBC@EB@@ABA@@B@BAB@@@B@@A@BB@@BBB@B@D@B@B@BB@@BB@@@BBF@F@B@D@D@BB@@@@@@B@@@BA@@B@@@FDD@BDBDEF@BB@BAB@DFFJJBF@BEDCB@FFNAFCFGHGFEDE@C@AAEDCD@L@LBB@FH\\B@HDHF@B@B@FAFBJAJCFGBKBI@EDAF@@ABABAD@@AB@@@B@@@B@BAB@B@@@BBB@B@@AB@@A@A@A@AAA@@AAAAAAAA@@@AAA@@@ABCB@B@@@B@B@B@B@BBB@B@B@@@B@BAD@@@B@B@@@BA@ABA@@@ABA@@@A@@@CD@@A@@@@@A@@B@@@B@D@@@BB@@B@@@@@@B@@@@@@B@@@B@@@@AB@@@@@BAB@B@@@B@@BB@@@@@BB@@B@@AB@@BB@D@@@BBB@B@@BB@@BB@@B@@B@@BB@B@@BB@@@B@BA@@B@B@@@B@B@@@@@B@@@B@B@B@@AB@B@@@B@B@@@B@@BB@@@B@@@@BB@@@@B@@@BB@@@@B@@@@B@@@B@@@BB@@B@BAB@B@@@BB@@B@@@B@B@@BB@@@BAB@BAB@@AB@@@@@@AB@@A@@BA@A@AB@@A@A@A@@@AA@@A@@@A@@@A@@@@BA@@B@@@B@@@BA@@@ABA@@@AB@@A@@@A@A@@@A@@@A@@@A@@A@@A@A@@@A@A@@@A@A@@@A@A@@@@@A@@B@@@BA@@B@@@B@@AB@B@@A@@B@@@B@@A@@B@@@@@@@BA@@@A@@B@@@B@D@B@B@B@B@B@B@B@@@B@@@BB@@BB@BB@@B@BBBB@BB@@@B@@BB@D@@@B@@@@AB@@@@@B@@BB@@@B@@BB@B@BB@@B@@@BA@@B@@@B@B@@@BBB@B@@@@@@BB@@B@@B@@@B@@@BB@@BB@B@B@B@@@B@B@@BBBB@BBB@B@@B@@BB@@BB@@B@@BB@@@B@@BB@@@@BB@@@B@@@B@@@@BB@@@BB@@B@@@B@@BB@BB@@@@B@@@BB@@@@B@@@B@@@@@B@@@B@B@B@@@B@@A@@BA@@@@B@B@@@B@@@@AB@@@@@DA@@B@B@@@B@@B@@B@@@B@@AB@@@@@BBD@@@B@@BB@@@BB@@@BB@@BB@@@B@B@B@B@@@@@BBB@@@@@@@BB@AB@BDB@@BBBDFF@@B@BBB@BBB@DBD@D@BAFCBA@ADDLXDDFBFBDAHAJKDOFEBAD@DBBB@BEDAD@BBDDADCDADBBD@DDFH@DCDADADBB@BDFHFDDBB@BABC@K@CFAJ@FDDBD@@ADODAFAJ@D@FALCD@JBDDBD@DCDCBE@ABCB@DBBDBBB@B@BA@CAA@ABBD@D@@@DHFJFBFFBFBHDFFFJ@FAFAHALGTADA@CBCB@BBDFBHDDFAVBL@@@@BBD@HAFALCF@DBDB@F@D@F@@AB@B@B@B@BDD@@BDADEFEBE@GDAFBFBDDBLDD@@B@B@@@D@BA@@B@J@D@B@@BBBBDBB@DAB@B@HCDCDCDEBCFCFEDCF@FDDDBFBBD@D@BABABCCA@C@@BAH@B@DA@A@ABCFCHAFCDCDEFABA@ACC@CF@F@F@JCF@@ABA@CBABCDAFEFA@@F@D@D@DBB@D@DEFCFEF@DCFED@B@FCFEFCFE@CFGFCLEFAFAHAF@FBFBD@B@BABAEE@A@AD@F@DADABABCBAFBHBH@FANAJ@H@DA@ACCC@A@@@CAICGACECC@CBCFCHCFADA@CAEIGAAACAAAAAACAA@EAE@AAC@C@C@A@A@A@AACA@A@A@CB@@AXEHGDABGD@B@DBFBVHFDDBFFHD@@BB@DBB@DBB@DBBB@B@DAD@DABABABABCBA@CAIAC@IBCHCDC@GAEECEEACAEBCBEFGHADCDA@@@A@@BABA@@@C@AB@DBB@DBDBBDD@D@BBDADAFADCF@D@FABBDBB@B@B@D@@ABE@CDABA@ACAAE@C@CDEBADCFCB@DCHCNEJCJ@HBB@FBFBFFHFFFDBBBB@B@BAAA@ECE@EAC@CAEAGDAD@LBHAACICI@K@E@AA@CDETSJGFABCDSFE@@DE@AAEEAGAAEE[@OKE@AC@CAC@CCCCCEEEAEA@@@DCFEFEBG@GAGaCGACCAE@CDAH@HAL@FHDBDBDBFAFAD@D@HBD@D@BBB@BBB@BBB@D@B@BD@BBBB@B@HBNFPJDBLFJBDBN@J@FDFBFADAFAF@F@FAHC@AB@BA@@FCB@FCFABCAC@AGMCGEUCOAC@KBYDAH@HDLH^LFCHQBABBXHPFJFVFHDJDFBHDFBJ@@ABA@A@C@A@A@A@ABCBA@AACAA@@AACAAAC@AA@A@A@A@A@A@A@@@AA@CAA@ECCACAEAE@CACAA@A@AA@@AA@A@A@A@ABCDE@A@A@CAC@ACC@AAC@A@@@AB@ACBA@CAA@CACAC@ACECACCAACAC@C@ABA@A@C@C@C@ABA@AAA@EAC@C@A@A@C@CAC@AAAAA@A@A@E@AAA@AAC@A@E@A@C@C@A@CBC@CAABC@C@A@CBA@CBADABAB@@A@ABAA@@@@AA@A@C@AAA@A@A@A@AAA@C@@@AAA@CAC@@A@@AAAAA@@A@@@C@A@A@C@ABC@CBABA@C@A@C@@@A@AAA@AAC@CACCECA@CACAA@EAA@@AA@A@CAA@AA@@CACAAAAAACAAAAAA@AAAACAAAA@AA@@CAA@AAC@AAA@ABA@@BABA@A@ABA@A@A@@AAACAACAAAC@AAAACACAA@A@EAA@AAAAA@A@AA@@AACAAACAAACCAAAAACAACA@AA@@A@@@ABA@@@AAAA@AAAACAACACAC@AAAAAAC@C@@@A@AAA@AA@ACAA@A@C@A@ABA@A@@@A@@AAB@@C@AB@B@BA@ABA@@AABA@@B@@CBA@A@CACBA@A@ABABA@AAC@CAA@A@AA@BA@AB@@@BADA@A@@DCBA@AB@BA@@BA@ABA@AB@BADCBAB@BADADCDABABCBA@A@@BABADADA@ABAAA@A@@@ABAB@BABABBBAB@BABA@A@CBA@@BABA@@@A@ABABABABADAFCB@DC@ABA@C@ABABA@ABA@A@CAAECAA@@@A@A@@@A@@CAACA@A@@@@@AA@@@@@A@A@AB@@@@A@A@@A@AC@@@A@A@CACAA@A@CA@A@A@@AAG@@AC@A@A@@BA@@@@B@@C@A@AAC@AA@A@AAAAA@ACAAA@AA@AA@@AA@@AAA@AAA@@@@@A@ABA@A@ABC@A@@@A@A@@@@@C@A@A@@@ABABCDEBCBA@A@C@A@C@CB@AA@@@AAA@@AC@@@A@@A@A@A@AAG@A@AAA@CAC@AACCAA@AAA@AA@A@A@A@@@A@@AA@A@C@A@C@C@A@A@E@AAA@CC@@ACA@CCAA@@AACAAAAAAAC@@@@AA@@@CACAEAC@A@@@@@@@A@@AA@ABC@A@A@A@@@AAA@@AA@AB@@A@@BC@CBA@CB@@A@@@C@C@@@AB@@@@A@@BA@A@AAC@A@@@ABEBA@@@AB@B@BA@CBABC@ABA@@@@@CB@@@@@BA@@@A@A@AAA@A@C@@@C@@BA@A@CA@@@@@@AA@@@@A@ABC@@BA@CHADAB@@ABAB@BA@ABABEFA@@BA@A@A@@@A@A@@@A@@@@@A@@@@BA@@@A@@@@@@@A@@@CA@AAA@A@AAA@AA@CAECEA@@A@C@@@AAA@CB@@A@@@ABA@@@A@@@A@A@A@@@@@A@@@A@@@@@A@@@AA@@@A@@@@CC@A@@A@@@ACCC@AA@EAA@CACCA@CAA@CCA@KCA@E@A@@@AAAA@@@@A@A@A@A@A@A@AACA@@A@A@A@@@AA@@@A@@@@@A@@A@ECAAAACE@A@A@AA@@@@AB@@C@A@@@@CC@@@AA@@@AA@@A@@@EB@@@@@B@@A@@@CBAA@@A@@@A@A@@@@B@@AB@@@@A@@@A@CBA@@@A@@@A@A@@@ABCDA@A@@BA@@BA@C@@@@B@@A@@@ABA@A@@@AA@@C@ABA@A@@@A@@@A@@@A@@@AAA@@@A@C@A@A@"],encodeOffsets:[[105805,24421]]}},{type:"Feature",id:"532523",properties:{name:"屏边苗族自治县",cp:[103.687612,22.983559],childNum:1},geometry:{type:"Polygon",coordinates:["@@EDE@GBE@AEAAC@CDEDC@IA@@C@ABC@A@C@ABCA@@E@A@A@A@@C@AAA@A@@AAAAAA@@AAA@CC@AAAACEEAACCCCEAA@CA@@AA@@@@AECGCEECCAGCACAC@AGCEAK@EBCB@HAH@NAFAHCB@FCB@FADODEBEDEDIBG@K@GBE@CBCDEHEDC@G@CF@DBFADABADEDCFAF@BAFCDEBCDADCBCF@FGDCDCBEHCB@BA@C@GA@@E@EAE@C@CDCDEBA@A@AAA@A@@@EAA@A@AA@@AACAA@AAC@A@CAA@@@G@CAA@A@A@AAA@AAC@A@EAAAA@C@A@E@C@A@AB@DAB@FBFBBDF@DADBFBFBF@DCDCBCDEFADADAH@BAB@DAF@FEJABCBIHEB@DCFAFCFEHCDEFEFGFGDE@GBKDKF@@GDOJEBC@CGEGCAABCBCBIBA@ABC@CBA@ABE@ABEBA@@@CAEEAAA@AAA@EA@@GEAAA@@AECAAGEAACA@@ECGCAAAFABCJADABABEB@BA@CBABGDABCBEBCBC@@@@@BBB@@B@@@@@B@@BF@@@@@BB@@BA@BB@B@@@B@D@@@@@B@B@@@BBB@@BB@@@@@BBB@B@@AD@BCDADCD@BAB@D@B@B@B@BCBABCB@@CDA@ADABCF@H@BAFAB@DBB@B@@FFFHHFBBFBBBDBHBD@DBDFFFFBB@FAF@D@D@D@D@FBF@B@DBFBBBB@F@HAF@B@DBDBBB@@BBBBB@@BAB@@@B@B@@B@@@@B@D@BBBA@@B@@@@AAA@@BBB@@BBB@@@BBBB@@@BBD@BBB@@@B@@AD@B@@BB@@@BBB@@@DB@@BB@@B@BBB@BBB@BBBDB@@@B@@@BB@@@BB@B@@B@@B@@B@@@B@@BD@B@B@BBD@BBBB@@HHHHBB@BD@F@F@@@F@FDBBDBBDDB@AB@@@@@BA@@@A@@@A@@@A@@@A@@@A@@@@@A@@BA@@@@@AB@@A@@@@@A@@B@@@@@B@@@@@@AB@@A@AB@@A@@@A@@@@@@@@@@@AB@@@@@@@@@@@BAF@DBFBBBHBD@BBBBBDDD@DBBBD@BBDBDDDDBHA@@F@@A@@BA@ADADCBABADC@ABA@ABA@@@A@C@EBA@A@@BA@ADCDAJEFE@ADC@@BA@ABA@ABABA@ABABA@ABA@ADCBCD@BAB@B@BAB@@@NCBAH@BA@@B@FA@@BAB@BAB@FADCB@B@BADA@@D@BBFBFBDDFDBDCFAD@F@DDFFDDBBDDDBDAFADEDGDKD@DFHIBCB@D@FBFBDBDAD@@@BGLBHHFDBFBDBBBBDB@@@@B@@@B@@@BABCD@@AB@DABAD@B@D@BAB@BCD@B@@@BAB@BABABCFA@@BAB@BCB@BABCDA@@@@@A@A@CA@@ABC@ABC@ABABA@@B@@ABBD@FBDFHHDBBDDFDDFJJHJFDDB@B@BD@BBAJ@J@J@D@@@BGHAB@HBJAFBJABCFCFCDBDDNB@@@@B@BBDBBBD@B@@@@@@@BAFCBC@C@EF@B@F@DHFLBBDDBBBDDFBDDDFHL@BFBBBFCDAHCH@FAH@B@B@@@@A@@@@@@BA@A@A@@B@@A@A@@@@B@@A@@@@@@BA@@@A@@BA@@@@@@@@@A@@@@@@B@@@@@@@@@@@B@@AD@BAB@@@@@B@@A@@D@@A@@B@BAB@B@B@@A@@@@@@@@B@@@@@@A@@B@@AB@@@@A@@B@@ABA@@@@@@BA@@@@@@@@@A@@B@@@@A@@@@@A@@BA@@@@@A@@@@B@@@@@@A@@@@@@@AB@@A@@@@@A@@@@@A@@@@BCBA@ABADCBA@ABA@@BA@@@A@AD@@@@AB@@@@@BAB@@@@@BA@@@@AA@A@@@A@@@@@@@A@@@A@@BC@A@@@C@A@A@@B@B@B@DBD@P@D@@@DA@@DAFCBAB@D@B@DBB@B@BAHADADAD@B@BBD@BBB@FFFDBBBB@@BBBB@BDBHJ@@BB@BBB@B@BBBBBDBBDBBBDBDDDFDD@B@@@B@BABADABCBABCDCDCFAD@DCDABADADAD@HADBBADADA@@@@F@D@F@D@BADADABA@@BA@@@AB@@A@@@@BA@@@@DABCB@@@@@B@@@@@B@@@@@BA@A@@BA@@@@@@@@@AB@@@BA@@@@B@@@B@B@B@B@@@@@B@@@@AD@B@@A@@@@@C@@BA@AB@BA@@BABA@@BB@@B@@@BA@@@@@AA@@@@A@@@A@@@@@A@@@@@A@@BAA@@@@AB@@@@@@AB@@@@A@@@@@@BA@@BAB@@ABA@A@@@A@@B@@AB@@@B@@AA@@@@@@A@@BA@A@A@@@@@A@@@A@@@AB@@@@@BABAB@@A@@@AB@AA@@BA@@@@AA@@@@@A@@B@@@@A@@@@A@@@@A@@@@BA@@B@BCB@B@@@@A@@A@@AB@@@B@B@@@@@@@@A@@BAAABA@@@A@@@AB@@@@@B@@@B@@@@@@@@A@@@@@AB@@AA@@A@A@A@A@AB@AA@AA@@AA@@A@A@@@AA@@@@@A@@AAA@@@A@AAAA@@@AAA@AAAA@@C@A@AAAAA@@@A@@AAA@@AAA@@@@AA@@@A@AA@A@CAA@A@@BA@@@AB@@@@A@@AAAA@@@AB@BA@AACAAA@@ABA@@BA@@AA@A@@@@@@AA@@@A@@B@AC@A@@@@AA@A@@@A@@@A@AAE@@@CB@@A@ABADCDA@A@@BA@CAA@A@@@A@AB@@AB@DCFE@@B@@@@A@ABABA@@BABA@@BCBA@A@@B@@A@A@AAA@AB@@A@@BABAAE@AB@@AB@B@BCDAB@B@BA@A@A@@AC@@@A@@BAB@B@B@@AB@@A@A@AA@@@A@@AA@@A@A@ABA@A@A@@@AAA@@@A@ABCAA@@@AA@AA@@@A@A@@A@@AA@@A@A@ABA@@@A@AB@@A@A@C@C@@@A@C@A@A@A@A@@@A@@@A@E@@@CBABABAB@@AB@@A@@BAB@@ABABA@AA@AECCACAECC@A@A@A@ABADABA@A@A@A@AAACEAAAACAA@@AA@@A@AAA@A@ABA@C@A@C@A@C@A@CB@@AB@BABABABA@@@A@A@ABABEBA@@@@@A@ABABABA@@B@@A@@@@@A@@B@@AB@@A@A@ABAB@BABA@ABA@ABABABA@A@ABAB@AA@A@C@AAC@@@C@@@A@A@AEIAA@A@@AA@A@C@AAC@C@@@ABA@A@AA@@A@A@C@@@AA@@A@@BABAAA@AAA@@@A@A@A@@@A@C@A@A@AA@@AAA@@@@A@A@@@A@@@A@A@C@AAA@A@AAAA@@AAAAAAA@ACA@@C@@CCAC@A@@@AAA@A@ACCAAA@@@CAC@AACA@AEI@@@AAA@ACA@CA@AA@C@AB@BCBA@AB@DA@@@@B@@A@AAA@@@A@ABA@ABA@C@ABA@ABC@A@A@A@@CC@AA@AC@@@A@ABA@A@ABA@ABC@A@E@AB@@ABA@@@A@@AA@@AA@@@A@ABA@A@A@@AA@A@ABA@A@@@ABC@A@A@@B@@AA@@@@A@A@A@A@@@AAA@A@@@A@AAA@@@C@ABA@AAAAA@A@AAA@C@@@AAAAE@A@AA@@@AACEA@@AAAA@A@AAA@A@A@A@C@CBCBCBA@A@C@CA@@AAAA@@AAA@@BAB@BAD@@ABABAB@@CDAB@B@B@B@@@B@@@B@D@B@@BFB@@B@@AB@FAB@@BB@B@BBBBBBBBB@@BB@B@@AB@DBB@B@@@B@@@B@B@B@@@BAB@@@B@BAB@B@BAB@BABA@@BA@@@@BA@AD@BABABABAB@BAB@B@D@B"],encodeOffsets:[[106361,23435]]}},{type:"Feature",id:"532525",properties:{name:"石屏县",cp:[102.494983,23.705936],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BCBABCBCDCDE@E@CACAA@CAC@ACEGQEM@@CAABA@ABC@C@CBCBCBA@C@A@ABABAD@BADABCBABA@G@A@A@A@CBABA@@@A@CB@@AB@BABABAAC@CAEAC@A@A@@BABA@G@A@CB@AA@AAA@CBABIDCBAB@B@BBBBDDBADBBB@BB@B@BC@EFCB@@ABA@CB@BABABA@C@C@A@C@CBC@C@EBCBAB@@ABABA@CBABAB@@A@A@A@A@A@AAAAA@A@C@ABEBABABC@C@A@AAAAAAAAA@AAC@ABABCDABABAD@D@B@DADABABABAB@BA@A@A@@@CA@@AAAC@A@EA@AAA@A@AAA@C@AAA@CBABCBABABCDABCBCBC@A@A@A@ABA@C@C@CBCB@@A@C@C@A@A@A@AA@@A@@AA@C@A@A@@@CB@@C@A@AAA@AAA@A@@@AAC@A@@BA@A@C@A@AA@@A@C@A@A@A@A@A@CBC@A@AB@@ABA@ABA@@@A@A@@@ABA@@@A@ABA@A@A@C@@@AB@@A@A@@@A@A@A@@@ABA@@@AB@@AB@DAD@BABABABA@@@A@ABA@@@A@@@A@A@@@A@A@@BA@@BA@@@AB@@A@AB@@A@@BA@@@A@A@A@@BA@ADA@@BA@AB@BA@AD@@AB@@@BBB@@@BBB@@B@@B@@@BB@@@@B@@@@@BB@@BB@@@@B@@@@@BA@@@@B@@@@AB@@@B@@B@@B@@@@@B@@BB@@@B@@@@@BB@@B@@@@BD@@@BB@@BBB@@@B@BB@@@@@@B@@@@@@@@@B@@A@@BA@@@@B@@@@@BB@BB@BB@BB@B@@@BB@@B@@BB@BB@@B@@@B@@@B@B@@@@@B@@@B@@@B@@@B@@@@BB@@BBBB@@@@@B@@BB@@B@BBBB@BB@@BB@BBDBB@B@BB@@B@@@@@@BB@@@BB@@@B@@BB@@@@@B@@@@BB@@BB@@B@BBB@@@B@@@@@@BB@@BB@@@@@@B@B@B@B@B@D@@@BB@@B@@@@@@AB@@@@AB@@CBABA@@@@@@B@@@@A@@B@@B@@B@@@@@@@BB@@BBDB@@B@BBB@B@@@B@@@@@B@B@@@@@B@@@@ABAD@@@BAB@@AB@BABAB@@B@@B@@@@@B@B@@B@@BA@@@@BCBA@ABA@A@@@ABE@A@@@@BA@AB@@AD@@ABA@@BAB@BA@@@ABA@@@ABA@@@@BA@@@@B@@A@@@A@@B@@@@AB@@@B@@@B@@@B@@@@AB@@@@@BABAB@@@B@@@B@@ABCBA@@@A@@@A@AB@@CB@@AB@@@D@BBBBB@BAD@D@@@BBDAD@@BB@@BB@@@BBF@D@B@@@B@@AB@BA@@BA@EF@@@BA@@@@@A@@@@B@@@BA@ABEBAB@@A@@@@@@B@@@@AB@BABIF@BCB@@AF@HBB@BBD@B@B@@CDAD@BB@AB@B@B@BAB@@@@@B@@@B@B@@@BA@@@@@AB@@@@@B@@@B@@@B@@BB@@@@@BB@@@@@@@@B@@A@@@A@@@@@@@A@@A@@A@@@@@@@ABA@AB@@C@@@@@A@@B@@@B@B@@@B@@A@@B@@A@@@@@A@CA@@A@AB@@ABAB@@@B@B@B@@@@BB@B@@@@@@@B@@A@@B@@A@@@A@A@@@A@@@@@@@A@@@@@AB@@A@@B@@AB@@AB@@@@CBA@@BA@@BABAB@@@B@@@B@@@B@@A@@B@@A@@@A@AB@@A@@@A@@@A@A@@@A@@B@@A@@BAB@@@@A@@B@@@@AB@B@@@B@@@BB@@B@@@@@@@@@BABA@@BA@A@@B@@@@@@@BA@@B@@A@@@@@A@@@A@@@A@@@A@@@A@@@A@@B@@@@@@@@@B@@@B@@@@@@@B@@@@@B@B@@@@A@@B@@@@A@@@CB@@@@@@@@@@AB@@@@@@A@@@@@A@@@@@@BA@@@@@@@@B@@A@@B@@@BA@@@@B@@A@@B@@@@AB@@@@@@@@@B@BAB@@@@@B@@A@@@@@A@@@@@A@@B@@AB@@@BA@C@ABEBCBA@A@A@A@A@A@CB@@A@A@E@A@A@A@A@A@AAA@CAC@A@@@A@CA@@CACAA@C@AAEBA@ABC@ABA@K@A@C@@B@B@BCDABED@@A@ABA@AD@D@FADAF@HDJ@@BDFFFHBFBH@FBF@@FDFBBF@@B@DBFBD@DB@@DBDDBDBBFBBB@@A@A@ABA@A@AB@@A@A@A@@@A@@BAB@BDHBBDF@DAFABCBCBAB@@@B@@@@@B@B@@AB@@AB@@A@@BA@ABA@@@A@@@A@@@A@A@@@@B@@@B@@BB@BBBB@@@@B@@@@@BA@A@ABC@ABA@@@A@AB@@AB@@@@@B@@B@@@BBB@BAB@@@BB@@@@@B@@ABA@@@A@A@@@A@@B@@A@@BA@@@A@CB@@ABA@C@@B@@@@@FAB@@@BEB@@@@A@@@A@@BAB@@B@@DB@@B@B@B@B@@ABA@ABC@A@ABAB@@@BBBB@@B@B@@BB@@B@@@B@@@B@BA@AB@@A@@@@B@@@B@@@@@BB@@BB@@@@BBBB@@@BB@@@@B@@@@@@@@@B@@@B@@@@@@AB@@@@BB@@@@@@B@@@B@@@@@@B@@@@AB@@@@ABA@A@@@A@@A@@A@@@@A@@A@@@@@A@@B@@@B@@@@A@@B@B@@@B@B@@@B@@BB@@@@DD@@@@B@@B@B@@@B@@@BB@@BB@@@B@@B@@@@B@AB@B@@B@@@B@BBB@@@@@BB@@@BB@B@@@B@@@@@B@B@@B@@@B@B@B@@BB@@@D@BAD@@A@@@@@A@@@AA@@@@AB@@@BB@@@@@@@B@@@B@@@@@B@@@@@BB@@BB@@@BB@@@@@BB@@B@@@@@@@@@BBB@@@@@B@@B@@@@BBB@@@BBD@@@BA@@@@@A@@@A@@@A@@@@B@@@B@@@@@B@@BBB@@B@B@@B@@@@B@@B@@@@@@BB@@B@@@@B@@B@@@@BB@@@B@@@B@B@@@B@@@B@B@@@@@@@BBBBB@@@@@B@B@@@B@B@@@@@BB@@@@@@B@@@B@@@@@@@BB@@@@@B@@@B@@@@@B@@A@@B@@@@@@@B@@B@@@D@F@B@@@BB@@B@@BB@@B@@B@@@BBB@B@BB@@@@@B@@@@AB@@@@@@@@B@@@@B@@B@@@@@@BB@@BB@B@@BB@@@B@@@B@@@@B@@B@@@@@@@@@@A@A@@BA@A@@A@@@A@@@@@@@@A@@@@@@BA@@@@@@B@@@B@@AB@F@@@B@B@@B@@@@@@B@BBBBB@@BB@B@@@B@B@B@B@BBB@@B@B@@DD@BB@BBB@D@B@B@@@@AB@@A@@@@BA@A@@@A@@@@@A@@@@B@@A@@B@D@BA@@@@@A@@@@B@BA@@@BB@B@@@BBBA@@B@@BBBB@@@@@@@B@@BD@@B@@B@@B@@B@@@@B@@@@B@@BDBB@@@@BB@@@@B@@@B@@@@BB@B@@@@B@B@@@BD@B@@@B@@@B@B@B@@BBBB@B@@@@@B@B@@@@@@@@@B@@@@@@BBA@@@A@@@@@A@@@@@@@@@A@@@@@A@@B@@@@@@@B@@@@@@@@@@A@@@A@@A@@@@@@A@@@@@A@@@@@@BA@@@A@A@@@@@A@@A@@A@@@CAAA@@@BA@@@@@A@@@@@@B@@@@@@@@@@@BBBBB@@@@@B@@@@A@@DB@@D@B@BBB@B@B@@@@@BA@@@@@@B@@ABA@AB@@@@AB@@B@@@@@@BBB@@@B@B@@BB@B@@AD@B@DAB@B@@@@BB@B@@AB@B@B@@@@@@@B@@@@A@@@@@AB@@ABA@@@@BA@@B@@A@A@A@@@AB@@A@ABA@@@@@AB@@@@@@AB@@A@@BAB@BAD@B@BA@@@AB@@AB@@AB@@@@@BAB@@AB@@@B@B@BAB@BABA@AB@@@@@B@@A@@@@@@BA@@B@@AB@@@B@@@@@BA@@B@@@@@@@@A@AB@@@@@B@@@DAB@B@@@B@@@BABA@ABA@A@@@AB@@@@@BBDBF@BBB@@@B@FDF@@B@HBF@H@HBJBJBHDHBJDFBF@BAFAB@DAF@FDBBFBL@L@J@JBFBJDDB@BB@BB@BB@FBBBD@BB@BBBBDBDBF@DADABCBCBC@C@CAE@@@C@GAA@CAC@EBC@AD@BCFCFADAFADAFAD@B@BBBJ@DBBBBB@BABCB@D@@B@BBDDDBHBLDB@@@BADCDBF@F@DBDBFDD@D@B@BADCFAJ@DAFAFBNJF@B@BABCFI@CDAD@D@HBF@DABABAAACA@ABADABAB@DAD@BABAB@D@D@DADABABAD@BCBABABADA@@@ACCCCAAACCA@A@@BABADAFADADCDAD@B@F@FDHDHDDBB@B@B@B@DCDADAD@DAD@DBDDB@F@B@D@B@B@BDBDBBDBDDDDDDB@D@@@J@FADABA@A@AA@AC@ABCBCBA@AJAHKFSFA@@BCFCBABADBDBFBH@F@FBDBBDBDDBH@DBBDB@D@@@DCHEFAFBD@BABGDEDAHBLFJF@B@BDB@@B@DADADAD@B@BDDB@@DDBBBBBBBBB@@@BBD@B@BBBBF@F@D@FCFGDCBEACCCSAEAAC@ABAFAJAR@PAFADABCBC@E@CBADABC@ABAAA@AAAA@CAEAACACAACEDA@CD@DADADCFA@ABEBEBE@CCGCGCC@@@EAAAA@CA@GIAA@AB@JCFE@A@A@CA@BA@C@@FA@AB@@A@@@AECACCI@A@@BAD@F@F@DADABABABC@AII@A@A@AB@B@F@JNDDFDLHB@B@@A@@AE@C@AD@HBBBDBDBDBB@DG@CACGECEAEBEBKBEBAJ@H@@@VHD@BA@CBE@EAA@CAA@@ECCCCACCAAEEEA@@@A@C@A@ACAAAC@C@G@A@AAAC@E@ABCBGCCAGBEBEBEDABEAEAC@CBC@CB@BC@CGAAABG@AAC@G@CDE@C@E@C@CAC@ABGCEAEAEECC@C@C@A@CAG@E@E@GACAE@C@C@E@CBC@CBEBCABA@ABA@@AAAAAACCACAA@A@ABAB@BA@ADCDADA@E@IFADCDBD@BC@@DCDC@CBCBCAECCCG@A@A@C@CACAEAGAE@EAC@EBA@ABABCFABADAB@DAFADC@@DAJCFCDCFABAJAB@FAD@DAF@PCHAFABEBEBE@EAGAEEAGAGAA@A@AA@AAC@EAAAE@E@A@CAA@CAAOIA@A@EAE@ACAABC@C@CAAAAAAA@C@E@C@AAAC@E@A@CAAAAGAEAACAGAEACAACAAACAACCAACCACACACACCAC@AEEACCAACA@AC@C@CACAACAAAC@CAEACACC@C@EAEAC@ABA@AAAAC@@@A@@A@@@@@A@@@@@@@@A@@@@@@@@@@A@@@@@@@A@@@@@@@@@AA@C@A@ADCBCBCBADAD@BCDABCBABGEAEAAACACACABGDEDCFC@ADADAT]FAHCFABAFAD@BECCGAE@EAE@EAC@CCAAECC@AACCCCAEDK@EAAAE@ACCAA@E@C@CBAFAF@DA@AHCDCHCHCDADC@C@ACAAAACAEAEBCDEBEBEDE@CDEBCDEDCBC@@DADA@CCAECCCAACAAC@A@A"],encodeOffsets:[[105086,23916]]}},{type:"Feature",id:"532524",properties:{name:"建水县",cp:[102.826557,23.6347],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@A@@AA@CBAAAAA@C@@AA@@@AAA@CB@AAB@@A@@@A@A@A@AA@@AB@A@AA@AB@A@A@@B@@AC@AAAACBAAA@@BA@A@@B@@AA@AA@A@A@AB@@A@A@@@A@@B@@AB@BA@CBABA@AB@@A@AB@@A@@@CACBA@@@A@A@A@@@@@AB@@@B@@C@A@A@ABAAA@A@AAAAA@@AC@@@@BA@ABC@A@A@@BA@ABA@@BABA@@@A@A@@@C@A@A@C@A@@@@B@@@@@BB@@@@BABB@@B@B@@@@BBA@@@BB@@@B@@@B@@B@BBB@@@@BB@@BB@BBB@DB@@B@DB@B@@BBB@@@@@@B@B@@@B@@@B@B@B@BBB@BB@BBBB@@B@BB@@@B@D@BB@B@@B@BBB@@@B@B@BB@@BABA@A@@BC@A@@@AA@AAA@AAC@@CA@ACAAAE@A@@@CBA@CBC@A@@@A@BAAAA@@@A@A@@@@@AA@@AA@@A@@@A@@@A@@AA@@@AAA@A@A@A@@BE@BBB@@BDDBB@@BB@@B@B@BBBD@B@@@B@BB@@B@BB@ABBB@@@BB@@D@B@B@D@DBBBB@B@BBBABBBB@BB@@@@@B@@BB@@AB@@@@@B@@@B@B@@@B@@@BA@@BB@A@@B@B@@BBA@@@@BBB@@@BBB@@@BB@@@@B@BBB@@@BB@@@D@@@B@@B@@AB@@@B@@@B@@A@@@@@@@A@AA@@@@@@AA@@A@@@@@A@@@A@@@@@@@@@B@B@@@@@@BB@@@@@@BB@@@B@B@@@BB@@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@BB@B@@@BB@@BB@@BB@@@AB@@@A@@A@@B@@@@@@A@@@@@A@@B@@@@AB@@@@EBA@@B@BDDDB@@BBDBB@BAB@BBB@@@B@@@BB@@ADA@@BA@ABA@A@@@C@@@A@A@AA@BB@BB@@BBBBBBB@DBB@@BBAB@BAB@B@BA@@BB@@BBB@@B@@@BB@@@ABBBA@BBB@@@BBA@@@BB@BBABB@@@BBB@@@B@@@BA@A@@B@@@B@@BB@BB@@B@@@BB@@BB@@@@BBB@@@BB@@B@BB@B@@@B@@BB@@@B@@B@B@B@BB@@@B@@BBB@@ABABC@AB@B@@AB@@AB@B@B@@@B@B@BB@@B@@@B@BB@@B@BAA@BA@A@@@AB@BBB@@@@BB@DDB@BBD@D@DABAD@BDBFHBH@BBHDJDJDDBBDBB@@DBD@F@HBDBDBBDBDDBBDDFBDDDBFBBBD@DAB@B@BD@DBDBBBDFBD@BB@@BB@AB@F@BAD@DA@AB@BABAA@@A@AB@@A@E@CBA@A@CDABA@A@CBGBC@CACAEACCECCACCA@A@E@CBC@AAA@@A@@@CBA@A@@@AA@@BA@ADA@A@A@E@A@CAAAAAE@A@E@C@E@AA@AA@ADE@ADE@AAC@@@C@A@CAAAA@AAAACAAAAAAACAA@C@@@E@@@C@@@A@C@A@AA@@AAA@C@C@C@A@ABE@A@CBA@CBA@CBAAC@A@C@A@A@@@ABCBEBAB@BA@AAA@A@AB@D@BABAF@B@@CBEDABABBBBB@BAB@@ABA@ABC@ABA@A@C@A@A@ABAD@@ABA@A@AAA@@@CBABA@ABA@CBA@@@AB@@@B@D@BA@@@@BA@@BAB@@ADAB@B@DBBBB@DADA@ABAAA@AAA@@@A@C@A@A@@BC@A@A@@AA@A@C@AAC@A@AA@AA@A@A@AAA@AAA@A@@@A@AAA@AAAA@@@@AB@@A@@BA@E@@BC@AB@BABC@@@A@AAE@@BC@A@CBA@AB@BA@@BABA@@@A@ABABC@AB@@ABA@ABABCEGG@CAAACAABEBC@CAC@@D@D@B@BAB@B@BBD@B@BCB@@AB@BBD@@B@DB@@BB@@AB@BBB@@ADABED@@GBABEBCFABABABA@AB@B@@@B@@BBA@AB@AC@A@A@@CA@A@C@@AAAAAA@@AAA@A"],["@@@DABABCB@BBBDDDBDBB@BD@@@DBDRHPHBBFBDBFBHD@CAA@@@@AA@ABA@@B@B@@ABB@A@AA@@A@A@@@AA@@A@A@A@@@A@ABA@@BA@@@ABAD@BABA@@AA@AA@@@A@@A@A@A@AA@@@A@@AA@@@A@A@@A@AA@@A@@AA@A@@A@@AA@@A@@@AA@@AAA@@@A@@@AB@B@@A@@@A@@AA@A@@AAAB@AAA@@B@AA@@A@AAB@AABA@@A@@A@@@AA@AA@@AA@@ABA@A@ABA@AB@AA@CAA@AAAAAA@@AAA@@A@@AAAAAACACEGEC@AAECAA@@AC@CH@DBFBFDDD@B@@BB@DBDB@DDB@BBD@DAF@A@AAA@ABA@CAAA@@CACC@A@AB@FA@@@@BA@@@@@AB@@@@@B@@@@@@@@AB@@@@B@@BA@@A@@AA@@AA@@A@@@AAA@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@A@@AA@@A@A@@@A@@A@@@@A@@A@@@@A@A@@@@@@@@@B@@@B@@@@@B@@@BB@@@@@@BBB@@@@@@@B@@@@A@@@A@@BA@@@AA@@@C@@@A@@A@@AA@A@A@@A@@A@@AA@A@@AA@A@@B@AA@@@A@AB@A@@AB@@A@@@A@@@A@A@@@A@@@@BA@@AA@@@A@@@@AAA@AABAAA@A@AAAAA@C@C@A@A@CA@@A@@AABAA@@A@AA@@A@A@@@AACAAA@A@@@AA@@AACC@AA@AAF@@AB@B@B@B@BB@@B@@BB@@@B@@@B@@@BB@@BB@@@@B@B@@@B@BBABB@@@B@D@DAB@DA@@B@F@BBDB@BDB@@BD@BBB@BBB@@B@D@@AB@B@BA@AA@@A@A@A@@AA@A@AA@A@@A@C@A@@AAA@@@AAAAA@@AAA@A@A@A@A@@@A@@@A@A@@@@A@AA@@@ACAA@@@CAA@AAA@@AA@@A@@A@AAA@@@@A@@@A@@AA@@B@AA@@@@@A@AA@BA@A@@A@@A@@@@@A@@B@D@B@B@D@@@B@B@@@B@BA@AB@BAB@@AB@B@D@BAB@@A@@D@@BB@BBBBB@B@BBBAB@B@D@@@@A@@BA@@@@B@B@B@@@B@DADB@@B@@@BAB@@@BAB@BADAB@@ABA@@@AB@@@B@B@@@BAB@B@B@@BBB@@@AB@B@@AB@BBDABBBBD@@BA@@@@B@BBAB@@B@BBA@@BBB@B@B@@@B@@@BA@BDAB@BB@@B@@BD@B@BBBBDAB@@BB@@BBB@BB@BBBB@BD@B@B@@DB@B@D@@BBAB@AA@@@A@@@ABAB@BABABADEFABAHA@@FCBABC@@AA@ABA@@AA@@CAA@@@AC@ABA@@DA@A@AAC@A@ABA@A@A@C@CGCCEGCAACCAAEEAAA@A@ABA@AA@@C@ABA@ABC@A@CAAAAAAAAC@A@AAAA@A@@AAACACAAE@CAAAC@AACC@ADABC@A@AACBA@C@G@A@@A@@ACACACAC@G@EAGAGEGEEECEIGGAACAC@C@AC@C@EBCDCBC@E@E@AAAACAC@CAAA@EE@@AA@@@ABABADAD@BADABCBADGDCDEBCB@FEBADCFIBGBE@EAEC@AACCACCCAC@AAC@ABC@ABA@E@CAG@G@ABCF@F@B@BA@ADAD@DAD@@@@EBCBCDAFADADA@@@AA@C@CAACAAAC@ADE@C@ICECCECCAC@AA@AAEBCBA@A@CBC@A@CAIBABCBADA@ACAAA@CAACCACBCDEDCDAFEF@D@FBB@HBF@BADABAD@D@HEBC@GAI@E@IAIAAEACACACAAACACACC@E@C@CDCDCFCFADCBA@C@ABCBEBGBC@ABIBC@A@A@E@EAC@G@CD@B@DADABC@ABA@CC@A@ACAA@C@A@CBC@A@CAEAACAAECEAEAC@E@ABE@@@C@EACAAEAM@SCG@G@GBKFABS@E@EA@E@A@CECMCKEICGEGGEIBIBGBCACGCCCC@CAGAAAEICKBIBKBE@C@CA@AAEAC@E@G@EDGBCBADCFEBGFG@E@AAE@ECEAEEC@CAEAACECKCC@@@AB@BA@A@@A@@A@@@@A@@BA@@AA@@@@@A@@AAA@@@A@A@AAAA@AA@@AAAA@@A@@@@BA@A@@AC@@A@@BABA@@@@@AA@@BAAA@@@ABA@@@@@A@@@@A@A@@@AA@@@@D@@A@@@@AA@@@C@AB@@@@A@@A@@@A@@@@AA@@AB@@@@@@A@@A@@@A@@A@@@@@A@@@@@ABB@AB@@@@A@@C@@@@@@@@ABA@@@ACA@@AA@AB@@A@A@@AA@CB@BA@ABAA@CA@A@@@AB@@@BA@ABA@@AAABA@AABA@C@A@ACC@@@ABCB@BABA@@C@@@@@@ADAB@@AA@AAAA@BA@A@A@B@@@@A@@@A@@A@A@A@@A@A@@BABA@@BC@@@AABA@AA@@@AB@A@@A@@@@@AB@@A@AC@AA@@@@BA@@@@@AA@AA@AA@A@A@CA@A@A@AD@@A@@CC@C@A@CA@@A@@@A@A@@BC@@@AAA@A@ABA@ABA@AB@A@@AAA@@BABA@AA@@C@AAA@@@A@A@@AA@A@C@@@@AAA@@ABC@AAAAAAA@A@A@@AAABA@@AAAAA@A@A@A@A@AA@CA@A@@A@ACECA@AAAAAAAAAB@@AAA@@@@AAAA@@A@A@AA@A@AA@AAA@@CA@AA@C@A@CCAACCAAE@@@@AAAAA@@@E@A@A@AA@@@A@@@A@AC@A@@@A@@AAAA@@A@A@@A@@BA@A@@A@@AA@AB@@CC@@A@A@@@AC@AA@@@AA@ABA@A@A@ACBCDCF@@A@A@A@C@C@@@ABBDBBB@BFDD@D@BDDBD@B@DAB@BMLABAB@DADA@ABABADA@C@CDCD@BAB@B@BBBBBBBBDABABABEBC@CBA@C@A@A@A@ABCDEBABA@AAA@@DA@@DADA@A@EBA@CBA@ABEDCBABA@C@AACAA@C@A@@BA@@BABA@ABC@A@EBCBCBCBCBA@A@@@ADA@ABCBA@C@A@A@C@E@C@A@GBA@A@ABA@C@A@ECAAA@@AA@@AA@CAC@AAA@C@AAA@AAAAAACC@@@CA@ACGCCAA@ABA@@BAB@B@B@@ABC@ABABA@@@A@A@CBAB@@@@AAA@@@@@E@C@A@ABABAB@DABEDCB@BA@A@A@AE@ACC@AAAA@C@A@AB@DABCB@B@B@BBB@B@BABCDCB@@AAA@A@ABA@C@A@@@A@A@C@AAEBCBCDA@ABC@@@A@AAACCAA@C@C@ABABCBA@AB@BCB@BA@@B@DDB@@FNHRDF@BBD@DBBBD@D@FCFCDADADABAD@@@B@BBDDBBBDDFDDB@DCBCB@@ADCDCFADCF@DCFAFAFCFADBFBFBDBBDB@B@DCDCBGDGDCDGD@BCBE@EBAB@D@D@FBBDD@BBFBB@FCLBFDDDDBBD@FDBBDDD@FBF@FBF@HBDDAFC@EBABEBGDEBS^CBCB@BEDCDCFAHDBDBDBBBFBFBAHABADCBADC@CBABADADCD@B@B@DBB@@@@@@@@B@@@@@@@B@@@@@@@@@@@@B@@@@@@B@@@@@B@@@@B@@BDBB@BAB@BBDBF@F@DDDDBFBDBD@BBDBBBBD@D@DBDB@BDDBBDFF@BBDDDDBDBDBDBBDDBBDDBBBDBBBBDBFBHBDFBHBBBBB@D@B@FBDBBD@F@D@B@BBBBBB@D@DADBBBDF@FBB@B@PJBB@DBB@D@B@FBFBB@FBD@BBBB@B@HBHBFBBFBH@FAFAFAFEBGBODE@CBC@EBA@IBABEBCDEDIDCB@@CDEBCBA@CBABEBADAB@BAB@FBD@FBFBHBFBD@D@D@B@BDHDDBFADAD@DCDCD@@ADC@CACDEB@J@FCBCBCD@BABA@AB@B@BBBBDDDBBBBBB@@AB@BABDBFADAD@DAF@D@D@F@DBHBF@F@H@DBB@D@D@D@FDBFBFDFAH@BBD@D@D@F@DCF@D@HBD@BAHBBHB@DADA@@DAD@DBDBFAFCBAFAFAFBHDDAHAD@B@FBDBBB@H@D@D@BBDB@B@B@D@B@@FBFFBBDDDBDDFD@@BB@DBB@FAF@DABC@UG@@G@I@ABAFALAFBFDFHFBD@DCH@@BBDD@@BDBDADAD@BCBC@AB@D@BDH@BBDDDDDBDDDHDBBDBJNFHDBFDHBD@FAHADBBBBHAH@FBDDBFBB@@BDDBB@BBDA@AD@BA@@BHFJJBDIAADBHFLBBBDFBB@B@BAB@BADBHBF@DBD@B@BADAFCJAB@D@@D@DABJFFBHAJADFDBFBHDFFD@FDHFDFDFBBB@@@B@LAFAFACCCCCE@E@CACBEAEDAH@FADEBCBABC@ECC@AFC@IBI@@@@BA@@BA@A@ABABABA@AB@BABAB@BA@@B@@@B@@BB@BBB@B@@BF@D@D@B@DBPFBBB@ABADCFCD@DABAF@FHFHDF@L@LCHAD@BBB@@BB@@@@B@@@@@B@@A@@@AB@@@@@B@@BB@@@@@@BB@BB@@@@BB@@B@B@@@@@B@@@@@B@@@@B@@B@@@BB@@@BB@@B@@@@@B@@@@B@@@B@@@B@@@@@B@@@B@@@B@B@B@B@B@B@@@@@BB@BBB@@@B@@BB@@BB@BBBBB@@@@BB@@@B@B@DBD@B@@@B@@B@@BB@B@@@B@BB@@BB@@BB@@@B@@B@@B@@@@@@B@@@B@@B@@@@@@B@@B@@@B@@@@@B@@@@@@BB@@@@@@@B@@@@@@@B@@BB@@@B@@BB@B@@@B@@@B@B@@@@@@@@@B@@@B@@B@@B@@@@@BB@@@@BBHBLFF@DAHKLK@@@ADABCF@DAHAF@B@FC@@DABABCBABADABAB@@@B@BABCBCBAB@DABB@AFBD@F@JAB@D@DFBH@B@DABE@CBAFBDDBLNJJDD"]],encodeOffsets:[[[105615,24497]],[[105520,24642]]]}},{type:"Feature",id:"532527",properties:{name:"泸西县",cp:[103.766196,24.532025],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@C@AAAAC@@@ABA@@AA@@@@A@AAAA@@@@@A@AACACCCACA@AA@AB@@A@C@AACAACACACCCAEAC@CAC@CAAA@@@@AA@AA@@C@A@CBABC@A@A@A@CACA@@@A@@@@A@AI@EAGCEAICGCUEIEOEWGAAABGRED]KKGGCG@CBAZ@LBDDPFVDHHN@BBDADEBEDA@ED@@ABA@@BGDEBE@E@EBCBEBEAECI@M@CAIAKECAOIMEGAA@A@AA@AACA@C@A@AAA@AAA@AAC@C@GAC@C@EBEBCACACAEGK@GBG@CB@DBFDDHBbDBH@HAHEFEFCD@@B@BFFFDFDDDDD@DBD@@BLF@PF\\BFHBFBBF@BCF@@EFCTADEBIHSTCF@DBBF@L@J@JDBDGBKAC@CBBHBF@DBD@FDF@FBBABA@A@AACAEEGEEEEAEAA@GAI@IDMFGDCDA@EDCDABCF@D@DBFDB@BABCB@DAF@BC@A@A@A@CAAAEBC@E@CDEBCBCBAAC@C@ACCACAA@CAA@@B@D@@ABAB@@@B@@CBCDGBEHAFADBFBDFFFDBF@HCDGDAD@JBDBJ@DBB@B@@@BBB@@@BD@B@@BB@B@DBB@@@@@B@BBBBBBBBDD@B@@@B@@BB@B@@B@@BBB@B@BBB@@@B@@BBBB@@@B@@BB@B@@AD@B@BBB@@@BAD@BBB@@@@@B@@@B@@BBB@B@D@D@D@F@F@D@D@D@BBD@@BB@BDBBBDDBBBDBDBD@B@BBB@DBBBBDBD@BBBDBDBFBB@DHHHNJHDDB@BDBDBDDDBBDDBBDDBBDBDBBBD@D@B@D@BAF@@ABEBE@CBCB@D@BDDDFBBBBBHBFFDFDHDFHBJ@BABCBCBCDAD@DBDBDBDBD@DB@BBBBBB@DBB@BBB@@@@BDBB@DBDDBBBBFDDBDDDBB@DBD@DBD@BDDBDDBBD@DBDBDBBBBBBBBBBBDBBBD@BBBBF@@DDFBH@B@BADA@C@AD@FBFDDB@D@BFHDFDDDDBBL@H@H@HAHCH@@@HFRPFDF@BABCCQAECKEEEACC@G@GA@EFCHC@C@CGEECG@CAECECG@CD@D@BADAJC@@BCB@LINILCHCJAF@DBAFDDFBD@@F@L@@BLHLBB@@HJJJBBFD@AAC@CD@FA@EDCJDFFFDBAD@F@BB@CACDABADC@CDADAD@DAFABGFGFG@CB@@ABADGDAH@F@FBHFDBB@ACEEEG@CDAHBFBJCHED@BADC@@DAHGJIRGLAJBJHFBH@@@JATIHEDE@C@IAAGACC@EDCJEPI@@JAFADBDB@HFNDJJHJBHAJEFEBE@GCGAGFCF@FA@CAC@EFAD@DEBEACACIEKCCGEI@ACGCGAG@CDE@A@E@EFBHBHBD@@CBGDIFEDGFC@CBA@C@ABABADFDDHAJILODIHI@G@C@@BCDCFAFDF@DABEAGBCBEBC@CACAAAAD@BAB@CCEAACBE@GCAEBG@ECECCCE@CA@AA@ACACCBA@A@AAAA@C@@@@@ABEAEDIHCHCHK@GE@EDC@ACACAEA@C@C@A@A@A@CACACCAA@@CECE@@AA@@AAAAC@C@EBCBCBEBA@E@CBABA@A@CAC@ACCACACA@@AACCC@EACACCCCACAACAACACACAAAACAAAAAAC@CAAGGEEAACCAA@AAACAAA@AAABC@CA@AA@A@AAAEC@AA@A@CBC@CAA@ACA@CAAAA@AACAAAAAAAAC@A@CAA@@AAA@C@A@CACAC@A@G@A@C@AAAAAC@AACACAAAA@A@CAAACAAEAAA@AA@@C"],encodeOffsets:[[106344,24924]]}},{type:"Feature",id:"532528",properties:{name:"元阳县",cp:[102.835223,23.219932],childNum:1},geometry:{type:"Polygon",coordinates:["@@IA@@A@E@C@EDGFCDADCDABABEFEFEDCBCBEAGCECCAEBC@E@@@CBABABABAB@BCF@BABA@A@C@CAAACAC@AAA@A@C@ABCBCBCAA@ABCDA@E@C@E@C@E@ADCBADCD@BABAB@B@B@B@BBD@B@BBD@DADABCBADABC@EBAD@BABADADADADCDCDC@A@ABAF@B@D@BAB@BAB@BCFCBCBCBC@CBC@CBC@@@CB@BCB@BEB@BC@C@C@A@C@@@A@G@EBA@AB@@ABCDCBABABC@CBCDABAB@B@BABCBC@E@CBG@EACBE@EBED@BCBAB@B@B@BBB@B@D@@@BBD@B@DBDBBFBBDBBB@BB@@B@B@B@@@@@D@@@B@FAB@FBDAD@F@F@@@H@D@F@D@DADAB@F@D@DBF@F@D@D@H@@@BAFABAB@D@F@J@B@F@H@D@D@D@B@J@DB@FAD@FADCD@FDDEFC@C@CBA@ED@D@B@BBBDDD@DBDBBBDBBDDBBF@HDDDBBD@DAFABAB@DABABA@CBIFCDEBMFKFADABAB@B@BBJ@BAB@H@B@@A@AB@@A@A@@@A@@@AB@BA@@@@@@@A@A@AA@@A@@@A@@@AB@@AB@@@B@@@@@@@B@@@@@@@@ABA@AB@B@@@B@@A@@BCBA@@@@@AA@@A@@@@BAB@@A@AB@@@@@@@@@@@B@@@@AA@@AB@@@B@@AB@@@@@AA@@@@@A@@@AB@BA@@@@@A@@@BD@@@BA@@@A@@@AB@@@@BB@@@@@@AB@@@A@@A@@A@@@@A@@@A@@B@@AB@@A@@@@@B@@B@@@B@@@@BDBDDDDBDDBBDDBBBDDBBD@@AFAB@@BBDBB@BB@B@DAF@B@DBFB@ENBHDDFDD@DABCDCFCF@JBFBF@FAFEPIFABA@@BC@C@CBAB@B@DBNFJ@JAH@DBFFFLBDBH@D@D@D@D@DFLP\\DDDF@B@DADABA@EDC@CDADC@ADCAE@CBEACD@BAB@B@DAD@F@DADADCFAFAB@@@AE@E@CAEAABCBAB@@A@ABE@EBCB@@A@A@A@CAC@C@A@AAEAAACA@BA@A@@DCBCFEBCFCFCDBB@BDDFFBBBB@B@BAFBD@DAB@BAB@BAD@BAD@@BD@@F@D@D@DAHAFCDCDCDAB@DDF@DCBCBCDAD@F@DB@@@D@@DFB@@BBB@D@@BFBF@BBBBDDBDBBB@DBDA@BDBDB@BDBBFFBBBB@BBBBDDDFFBDB@BCBADC@ABAD@BBDBBBDPFHDBBBFBDB@ADA@ABAB@DABABAD@D@B@DBBDBBB@@@D@BAB@DCDAFABBD@B@B@@@B@D@B@BAB@B@BB@@DADCBA@A@AAA@A@A@ADABA@CBAB@D@B@BB@BDD@BBFB@B@B@@ADAFCBA@CBABABAB@D@F@@@@@B@BB@@@@BADAB@B@@@B@BABAD@BA@@@A@ABA@AB@BAB@DBHDBDB@@D@@DDBBBBBBB@BBD@B@BBD@DBB@@BB@@BB@BBFDB@D@B@BAB@B@HAB@D@F@D@B@B@D@B@DABAB@BC@@B@B@DADADADAFAB@D@BAB@BA@AB@@AB@D@B@DBBBD@B@BADAFCBAB@DAB@FAB@B@BC@CB@@CB@BBB@BAFADCBAB@B@B@D@B@DAD@FABABABAACAAAAAA@A@ABA@ADCDCD@B@BCBABAB@BC@CBABANK@ABA@C@AACCC@A@CCCAEA@AAACBA@@D@D@B@B@B@@@DEDCDAD@DBFBB@FBBBDBB@D@HCBAB@D@B@@AD@B@D@BBDBD@D@@ABABC@AACCEAAAC@ABABAB@D@D@B@B@B@BADABBB@@BBDB@BBD@D@DBD@BBDBB@@@DA@ABA@@BC@CBABAHEFGD@DAB@DDB@B@@ADA@CBA@C@EBC@@@AB@B@@@@@B@@@@@F@B@B@BB@B@DBBB@D@B@BADADADADADCD@B@DABADCD@DADADAD@BAD@BAD@B@B@B@FBBBB@B@B@BA@C@CBADAB@D@BBB@B@@@BABEBE@CBA@ABA@@@C@A@@BADABABABA@@D@@@BBB@B@B@B@@ADAB@DAB@BADAB@D@B@B@BBDBFDB@B@B@B@B@@AB@@C@@BA@@B@BABABCBA@AAC@A@ADCB@D@B@B@F@D@@@B@@A@C@@@ABABADAB@FADABA@ABCBADAB@B@B@B@FED@B@DAD@BAB@B@DAD@D@B@D@@@DAB@B@BA@@DAD@DA@@BBDBDBB@B@BCBABADAD@DAD@DAD@D@FBFAD@B@B@HABBB@B@B@B@@AB@B@B@BAD@BAB@@AB@@@BC@@BA@@BA@CBAB@B@B@B@B@BBD@BAD@BAD@D@F@DADABA@AD@B@BAB@BABCFCD@B@B@@@@ACCAAAA@C@A@ABABC@A@E@A@A@AECEEAA@@@@@A@EB@@ABEDCCACCECCA@AAC@CAAAA@C@AAACCAAAAAE@A@CAAACCCAA@A@AMMEEBA@@@A@ABABCBA@@DAD@@AB@D@DAHC@ABA@EAA@CCAA@AACACBA@@@CBA@ABCBA@E@A@C@AAC@C@E@A@CBA@A@IBE@A@E@G@G@A@CAA@CAA@CACAGAECICKCAAAAAE@CAA@AACAAAACAAAAABEBCHE@@B@BADAF@@@DABA@@D@FCB@B@B@DADAHAFADCB@D@@AB@DAFCBADAB@DADC@@FCBA@EAE@@@AAC@AAC@EDCDCFABCB@@A@@BC@@@C@E@C@AAC@C@EBA@A@CDC@AAC@ACAAACAAAAAAA@AAAA@AA@A@AAC@E@E@CBCBEBC@AAECCAACAC@CAEBABCBABAD@BGH@A@@@A@@A@@@@A@@@@AA@@@AA@@AA@@@@@AA@@@AA@A@@A@@A@@@@AA@A@@AA@@@AA@@@AA@@@@A@@@@@A@@AA@AIEAAC@@B@H@@CFABADEDCDEDIFGBGBC@C@@@A@CACAC@@AC@CAEAA@AAA@A@CAC@ABC@AB@B@@AD@DAD@BBDBD@D@B@D@DABAB@BAB@BCF@BAB@B@BADCD@BAB@BAFCBAB@@ADEF@@@BAB@BABCFAD@BA@CJAB@B@BAD@F@B@FABC@A@A@ABBD@DBD@BABAB@BCBABG@EDEB@BA@ABCDEBABGDEDIFCD@BA@@BAB@D@B@DAB@BA@BD@BBBBBBDDBBDB@@BAB@DA@@@AFCBCDA@@BGFCDCDCBEDCB@BAB@@ABAB@BCDABEDCBC@CBG@C@EBABCBC@EBEAACCEA@@A@AA@AAE@ABA@C@C@AAA@@ABABA@@B@AAAAAAC@A@G@C@CAABGBE@C@A@A@EBA@EBC@ABEDADA@BBBB@D@BADABCB@D@FBD@D@DABADABCDCBCBEDADC@GBCAAACACAEAC@C@EDABCDCBCDCBC@CACCGC@@@@AEAAAEAAAAAAACBA@AACACAA@E@@AEBA@E@A@AAABA@A@A@CAC@A@@A@A@CAA@AAA@GGACA@AC@@@AAAAC@CA@@ACC@AAACCAAGGCAA@@@EAAAC@A@CCAAA@BA@@@AB@@AB@BA@AB@BA@ADAB@@ABCB@BA@A@A@A@@BA@A@A@@@A@ABC@E@@@A@C@AA@@@A@ABA@@@@AA@EAEAA@@@E@C@@@CB@AC@@@@@C@@@A@AA@AA@A@E@@@A@AAAA@@A@@@@@AA@@CA@@A@@AAA@A@@A@AB@@A@@@AAA@@@@@A@@@A@@@AA@@AA@@A@@@@A@@A@@AA@A@@@AA@@@A@@AA@@A@@@A@AAA@AA@@A@A@@@A@BC@E@A@CBA@CBADCFCBABCBAB@DADAB@D@D@B@F@BBBABA@C@@@ABC@A@ABABCBABAB@BAB@DAFCFCDEDABCB@BADC@C@C@@@C@AA@@AE@CAC@EAAACCAAAC@@@AAAACCACACAC@CAAAA@AAEAGAI@K@C@GDCBCFABADAD@BAB@BAFABCBEAEACACAC@C@C@CBABC@A@ABGDABCBIDC@A@E@CACEIC"],encodeOffsets:[[105144,23397]]}},{type:"Feature",id:"532529",properties:{name:"红河县",cp:[102.4206,23.369161],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@AC@AC@@@@A@@B@@CDC@@@A@@@@@@@A@@A@AB@@A@@@A@@AA@@@@@@@AE@@A@@@A@@@AB@@@B@BA@@BA@@@A@@@@@AA@@A@CBCB@B@@@B@@@@@@@@GB@@A@@@@B@@@@@@@@A@@BA@@B@BBB@@@B@@@@@@@B@@@@A@@@A@C@A@@@@@@A@@@A@@A@@BA@@@@@A@@@@@@B@@A@@@@@A@AB@B@@A@@@A@@@A@A@@@AA@@A@@@@BAB@BA@@B@DA@@@AA@B@BA@@@@@@BAB@@@@A@@@AB@A@@A@@B@@A@@@A@@BA@@@A@@@A@@@A@@@A@@B@@@@AB@@A@A@@@@@AB@@@B@@@@A@@@@@A@@A@@A@@@AAABEBEBEDCBABC@AE@C@CAAAEACCCACAACCC@CAA@GC@A@EACAAA@CAE@KAM@MCMEGAG@MBMA[CMACBCDEBCDCBABC@CDC@ABE@C@C@CBCDABADEDE@CACCACCCAAACCECCE@ACAAAACAC@C@E@C@C@ABADAD@DEAC@C@CBE@EBCBC@CACAAACCAACCCAC@CACCC@CAC@C@CBA@ABC@CDC@C@@BE@@@@B@B@B@BDBBD@B@BABAB@@A@CBCBCA@@C@A@CB@BAB@BBB@BAD@BBBDBBBA@A@C@C@C@C@CACCCAC@C@C@EAC@E@ADABADABCDCBAFCDCAAACCAAECCACAEAA@GCC@CBCCAAAEEACACA@@A@ABCBABA@ABE@K@G@AB@@A@ABAAA@A@A@ABABAB@BAB@BA@A@ABABADAD@DBDBFBBCDCBCBEDADABEDCBIBC@EBC@E@A@A@GB@@@@@@@@A@@@A@@@ABA@@@@BAD@@AB@B@@@B@@@@@@BB@@BB@B@@B@BBB@@B@@@@B@@@@@@B@@BBBB@B@@ABA@@@@@A@@@@@A@A@@@@@A@@@A@@B@@A@@@@@A@@@@@A@@@A@A@@@A@@@@@@@A@@@A@@A@@A@@@A@@B@B@@ADA@CBCD@DBBD@B@BB@@@B@@B@@@@@@@@B@@@@@B@@@@@B@@@B@@@@BB@@@@BB@@@B@@BB@BB@@@@BB@@B@@B@@@@B@@@@@@@BA@@@@@@BA@@@A@@@A@@@@@@@A@AA@@A@@@A@@@@@A@@B@@A@@@@@@B@@@@@B@@@@@@A@@@@@@@@AA@@A@@@@A@@A@@@@@A@@@@@@AA@@@@@A@@@@A@@A@@@@AA@@@@@A@@@@A@@@@A@@@@AA@@@@A@@@@AA@@@@@@A@@@@@@AA@@@@@@@@A@@A@@@B@@@B@@@@AB@@A@@@AB@@@@AB@B@@A@@@A@@BA@@@@A@@A@@@@@A@@@A@@@@@@@A@AA@@A@@@@AA@@@@@@@@AA@@@@@@AA@@@A@@B@@A@@@@@A@@@AB@@@@A@@@@@@@@@@@@A@@@A@@@@@AA@@A@@@@@A@@@AA@@@@@AA@@@@@@@@A@@A@@@@@@A@@@@@@A@A@@@@@A@@B@BA@@@@@@@@B@@@B@B@@@BA@@@@@A@A@@@AA@@A@@@@@@@@@@@AB@@@@@B@@A@@@@AA@@@@@@@A@@@A@@@@@@@A@@@AA@@B@@ABA@@@A@@@A@@@@@ABA@@@A@@@AA@AA@@A@A@@@A@AAA@@@A@@@@AA@@@@@@@@A@@@@@@BA@@B@@AA@@@@@@AA@@A@@@A@@@AB@@A@@@@@@@@B@@A@@@A@@@@@@@@@@@@@EB@@@BA@@@A@A@@@@A@@A@@@@B@@@@@@@B@@A@@@@B@@@B@@@BB@@@AB@@@@@B@@@B@@@BA@A@@@AB@@A@@B@@A@@@@@AAA@@@A@@@A@@@@@@AA@@@@@A@@BA@@@A@@@@@AA@@@A@@A@AAA@@@A@@@@@A@AAAA@@@AA@A@@@@@A@@A@@A@A@@AA@@@@@A@@AA@A@@@A@@@AA@@A@A@AAAB@@A@@@A@@@@@A@@@A@@@@@@@@BA@@@A@@BA@A@@@@@@B@@@@A@@B@@@@A@@@A@@B@@A@@B@@A@@@@@@@A@@B@@A@@@A@@@@@A@@@@@@@A@@@@@AB@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@A@@@@@A@@@@@AA@@A@@@@@@@ABA@@@@BA@A@@@A@A@@@ABA@@@A@@@A@A@@@A@@@A@@@A@@@@@A@@BABA@ABAB@BA@@@@@@@A@@@@@@B@@A@@BA@A@@@A@@@A@@@@AA@@@@@A@@@A@@B@B@@@B@BA@@@AB@@@@@@@@@B@@@B@@A@@BA@@@A@@@@@@@BB@@AB@@@@AB@B@@@@BB@BBB@B@B@B@@@B@@@B@@B@BBBBBB@@BB@B@D@@@@B@@@@B@B@@@B@@@@BB@@@@@B@@BDBB@B@D@B@BAD@BBB@@B@BB@@@BB@@B@B@@@@@@@B@@B@@@@@@B@@B@@B@B@@@B@BA@@@@@A@@@A@@@A@A@@B@@@@@B@B@BA@@B@@@@@BA@@@@@A@@@A@@@@B@BA@@@@@A@@BA@@@@@@@@BA@@@AB@B@B@@@@@@@@BB@@@B@@@@@@@BA@@@@BA@@B@B@@AB@BA@@@@B@@AB@@AB@BAB@B@@@@@@BB@@B@BB@@@BBB@@@@@@@B@@@BB@@@B@@@@@B@@B@@@@AB@@@B@B@@BB@@B@B@B@B@D@@@B@B@B@@@B@@@B@@@D@BB@@BA@@@BB@@B@@@@@B@@@B@@@BB@@BB@BBB@@@@@@@@@@B@@@@BB@@B@@@@ABA@@@@@@@@@@BB@@@@@AB@@A@@B@@@@@B@@@B@@@B@@@B@@@@A@@@@@@@@B@@@@@@@@@B@@AB@@@BAB@B@@@B@@BBB@@@BB@@@B@@BBB@@@@@@@@@@BA@A@@@@@@B@@@BB@@B@BB@BBBB@@@AB@B@B@@BB@BBB@@BB@@@B@@B@@B@@@B@B@@@D@B@@@B@@@BBB@B@@@@BBBB@@B@BB@@@@B@@@BB@@BB@@B@@B@B@@@BB@@@B@@@@@@@BB@@@B@@@B@@@B@@@@AD@@AB@@@@@@A@C@@@C@A@A@@@@B@@@DA@@BA@@BA@@@@@A@A@@@A@@@ABA@@@AB@@@B@@@B@@@BBB@@BB@@@B@B@B@@@@AB@@A@@B@B@@@B@@AB@@@B@@@BA@@B@@@@@@@@B@B@@@@@B@@@BB@@@@BB@@B@@@@B@@@@@B@@@@@@AB@@@@@B@B@@@BBBA@@@@@@@@A@@A@@@A@@@@@A@@@A@@BB@@BA@@B@@@@@B@@@@B@@B@B@@@B@B@@@@@@@B@@@B@@@B@@@@@BB@@BB@@@B@B@@@B@@@B@BAB@@BB@@@B@@@@@BB@@BB@BBB@BB@@B@@@B@B@@A@@B@B@@@B@@BB@@B@@@B@B@@@B@@@@@B@B@B@B@@@BB@@B@B@@B@BB@@@B@@@BBB@B@B@@@@B@BB@@B@@@B@@@B@@BB@@@@B@@@BBB@@BB@@@BB@@@A@@@@@A@@BAB@@@BAB@@@@@B@@@BA@@B@@A@B@@@@B@A@@B@@B@@@@@@@B@@@@@@@@B@@B@@@@@@@@@@B@@@@@@B@@@@@@A@@@@@@@@@@@@@@B@@@@@@@B@@@D@B@B@B@B@@@B@@@@@BA@@BA@@BA@@BA@@BA@@B@@AB@@@BAB@@@B@@@@@BBB@@B@@BAB@@@DA@@B@@@DAB@@@B@@@B@@@B@@@B@@@@@B@@BB@@B@@@BB@@@B@@@B@B@B@@@B@B@BAB@@@@AB@@@B@@AB@@@@@B@BA@@BABA@@@@@AB@@A@CA@@@BA@@@A@@BABAB@BABA@@B@@AB@BA@@B@BAB@@@B@@@B@BAB@@@B@B@B@@@D@B@@@D@FA@BB@B@@@@@B@@@@@DDDFDFFDF@B@FCFE@AD@HAD@B@@AACAE@ABCDABAHAPAJCB@B@FEFAB@BBJJFDBBF@H@F@D@HADAFGFIDEDAD@F@R@@@BBB@@@DBHF@@FDDDBBFA@@D@B@B@D@B@@BBBBB@@B@@BB@@@B@@BDD@@B@B@@@B@BBBBBB@B@@BB@BB@@@@@@B@@@@@B@B@B@@@BB@@@@B@@B@B@@BB@@@B@@@@@BB@@@@@B@@@@B@@@@BAB@@@@@B@@BB@@AB@BA@@@A@@BB@@B@B@@@B@@ABB@@B@@BB@@@B@B@@BB@B@@@BB@B@@@@B@@@B@B@@@B@@@B@@@@B@@@@@@B@@@@AB@@@B@@BB@B@@@@BB@@B@@B@@@B@@@B@@@@BB@@@@@B@B@@@B@@@B@@@B@BB@BD@@BBB@@B@@BDBB@@@BBB@@@B@BB@@@@B@@@BB@@BBBBB@@B@@@@@ADAB@BB@@B@@B@@@BB@@B@B@@B@@@@@B@@@BB@@@B@@BB@@B@@@@ABB@@B@@@@B@@@@@B@@B@B@@@@@B@@A@@B@@@@DB@@@B@@BB@@B@@@@@@@@B@@@BB@@@@BB@@@BB@@@@@BB@@B@@@@B@@@B@@@B@@B@B@DB@@@F@HAFCFCBMBEFCLCJ@FFBB@DBB@BBB@D@B@DBBF@@@B@B@D@DBD@D@DAB@D@B@BB@@DBB@B@D@@AJ@B@HAB@DBF@BBBAB@B@@ABAB@BAB@BBB@B@@@DABAB@@A@@B@@@B@@AB@BA@AB@BAB@B@BAB@B@@@B@@@B@B@B@@@B@@@B@@@B@@@B@BAB@D@BAD@@AB@@@B@@@B@B@@@B@@@B@B@BBBBB@D@B@B@B@@A@@@A@@BA@@BCB@@ABAB@@AB@BCB@@AB@B@B@@@B@@AB@@@BAB@@@BA@@B@@AB@@AB@B@@@B@B@@@B@@@B@BAB@@@B@BABABA@ABC@CBA@@BA@@B@BA@@B@B@B@@@B@B@@@BA@@D@B@B@B@BAB@@@B@BA@@B@B@@@B@BAB@BA@@BAB@D@DAB@B@B@B@B@D@B@@@BBB@D@B@B@@AB@D@BB@@B@B@BBB@BBB@D@@@DA@@B@B@D@B@@BB@@@BBB@B@B@D@D@B@@@DADAD@D@B@BAB@B@B@D@DADABADCBABADABADAB@BBD@B@BBB@B@BBB@@F@BBDBB@@DB@@B@B@B@@ABABABABABC@C@A@CBCBABADCBABAD@BBB@BBBBBBBBB@D@D@BABAFABAD@B@B@BBBBB@B@B@B@B@@@BABADAB@BABA@@BADAFAD@D@DAD@B@D@D@B@BABA@ADAB@BA@@DAFED@@A@AAAA@AABCCAACAA@A@ABADAJCBADAB@BBB@@BDAB@H@B@BA@AB@B@D@FBDBD@BBBABA@ABA@@DAB@@@B@BADAB@B@B@H@B@BADABABC@ABCBABAB@D@B@DADADAD@D@BAB@BA@C@AB@CAEAAACAEGCOAACAAAC@AB@BCDABADA@ACEECCACAA@AAAAAEEAAACA@ACACB@AC@CAACACAACAA@AAEAE@@@CAA@AA@CE@@@C@@CAE@C@CBADADCDE@CCA@CBCDCDEDGBCBC@C@E@@@AC@@BC@ABC@ABA@ABA@CACBE@A@AAAAAEECC@AAADCDEDEFADEDA@CB@B@@ADBBBFBBBB@D@D@DBB@B@B@@@DAFAF@BAB@@@BADABAFBDBF@F@@B@@BABEDEBCBC@C@EBC@C@ABA@ADCFBDAF@DBBCD@BCDCD@FCB@BABC@C@ACECCO[EK@C@C@C@C@CAGACEKEECAG@IBI@MECAA@A@AB@D@DAD@@ABEBOJEFEBE@EAIAE@EDCDADCBC@ECCCAGFMA@AE@C@ABE@C@AAAA@CAAA@@BABE@@ACCAACAACCAACCCACCACACA@AA@@A@@@A@A@@B@@BB@@@@@B@@@BA@ADA@A@A@@B@@@B@@@@A@A@@@@A@@@@@A@@A@@@A@@BA@@@@@@BAA@@@@@@@A@@@@AAA@ABA@@B@B@@@@ABA@A@@@@A@@@@A@@@@@A@@@A@@@A@@B@@A@@@A@@AA@A@C@A@@@@B@@AB@@A@@@AA@@@@"],encodeOffsets:[[104917,23664]]}},{type:"Feature",id:"532530",properties:{name:"金平苗族瑶族傣族自治县",cp:[103.226448,22.779543],childNum:1},geometry:{type:"Polygon",coordinates:["@@BADABC@CAA@CACAADADADCBABABC@C@G@G@C@A@CACACAAAAEAE@E@A@EBABA@ABA@C@CAACA@CCC@C@A@A@E@E@A@E@CACACACBA@ABCBADCDCBABCBC@ABABAFABCDA@ABE@GBE@E@A@A@CDABBF@BBDBDABCDCDABABC@A@A@E@GAC@C@C@A@EAC@CBCDAD@BCBCBAAA@AACAEBEBEBE@EAC@AACBCBCDAF@DAD@BADADBD@D@B@BABGBC@C@ED@@AD@BAB@DAD@F@B@BADCDCBA@ABC@ABEDCDCB@DA@@B@BDFBBBBBBADABGFCDGBABABABA@ADBDBFBDBB@BCBCDGHC@ADBB@FBBDDDF@BBD@BCFADEDC@ABEBCB@BABAHADCDCBA@EBE@G@EBA@ABA@AB@B@FADABC@ABCBEBCBCBA@A@EBCAA@A@AAGAEBEAE@E@C@E@GBABEBCDCD@@@BABCF@BBDBBBD@BBBABCDCDE@E@G@EBC@A@CBE@ADEDABCLAFAHABCBE@EAI@E@C@IDCFADABC@EBCDCBEBEBAD@B@B@D@FADCD@BABA@CBEBEBEDEBE@GAGCA@ICGAC@CACACACAAAA@CAA@ABAB@BADABABC@ABGFEBABABEFCFC@ABABCDA@EDADCBAB@BGFCDCBADABABCDCBA@C@A@A@CAAACCCCCAAAC@AAAAAAAC@C@IBEAAACA@@CA@CAG@A@EACAA@@@ABAFAFAHANABCDEJEDCBAB@@ABA@@@ADA@@B@D@D@DBBBB@BABCBCBEAA@CBCDCFADEFEDGDAB@@BB@@FBFBD@D@BBDB@@DDF@DBB@DAB@DABAF@BBD@DB@@DBDBDDDFDBB@@ABB@BBBDDBBB@BB@B@@@BDDDB@BBDBB@BBB@FB@@BB@BBDB@B@BC@AD@B@@DBBBDC@@@@@@BB@@B@@@@@B@@@B@@@@@@A@@@@BA@@D@B@@@BA@@B@B@@@B@BBB@@@B@BABA@@B@BB@@B@B@@@B@BD@B@@@@@B@AB@@@BA@@B@@@@@@B@@@@BBB@@BA@@@A@@@@B@@B@@@BB@@BA@@@CB@@@@@@@BBB@@@@@B@@A@@@@B@@@B@B@@A@@@@@A@@@@BAB@@@BB@@B@@@B@@D@D@BBB@BBB@BBD@@BB@B@BDD@@BB@B@BB@@DAB@BAB@@@D@DBDBB@B@B@B@J@B@BBD@BB@BFBF@D@DBD@DBDBBBD@BBBFEFEBABADCBCDCHEFCD@F@B@@@JBJDDFDBF@B@D@JCDABAHCBAB@D@BADAD@D@D@DBDBFBFBDABABE@ABA@ABCBCBADEDAHCD@L@J@HBFBBBB@BBDBD@DBDBDBBDBB@B@@BDBBDDBBFBD@DBF@@BB@@B@D@@@D@DCDABA@ADCBCFEDEDCBA@ABA@ABABADAB@B@BAD@B@@@DABABAAE@A@C@C@A@CBCBA@ABADABEDCDAB@DAB@D@B@FADB@@@B@B@@@BBB@BBB@@@B@@@BB@@@B@@BB@@B@B@@BB@@@@B@@B@@@BB@@BB@@B@@@B@@@@@B@BB@@B@@@BAB@@@@BBB@BB@@@DB@@BB@@@@B@@@BBBBB@@@F@B@B@@BBBB@@@D@@@@@D@@BDA@@D@F@@@B@FBFBB@@B@@B@BAB@@@B@@B@D@B@@@FAD@B@B@@@B@BAB@@@B@B@BABA@AD@BA@CB@BABA@@BABA@@BA@@B@@ABB@BBDDB@D@BBFB@@B@DBHHBBDDBB@BDD@BB@@DBDBB@B@@BDB@BDHHB@BBB@DBB@B@@@@BBD@D@B@BABBB@B@B@FABBF@@@FBBBDBD@BABBDBBBBBBBFBBBF@@@@HDDDDBD@DADCDADCBAFCD@D@FBDBDBBBDBHAD@BCFCDADADCBABCBA@C@CAC@E@CDABABC@A@CAAAAB@BCFCBAD@FAB@FAB@B@D@F@HABADBD@H@B@D@BBBBBBA@@@ABAB@BB@BBD@D@B@BAF@BBB@@B@BB@DFBDFBFAD@DABAFAD@H@DAD@DAFCBADC@ABABA@@BA@ADAFCDADCDCHE@AB@DCDABE@@B@@CBA@AA@ACCAACAAAA@AACB@@ABA@C@A@CBA@AB@@ADCJEFCHCBAFADCBAB@@AFAFCH@BADA@ABABA@AAC@CACBAB@B@D@BA@E@A@EBC@A@ABADIB@@ABCDEBA@ABA@A@@FEBC@@BADABE@ABA@ADCBC@A@ABA@ADE@ABA@ABABA@C@C@A@CACAC@ABC@CBC@@@ABAD@BAD@DBB@B@BBB@FBDBD@@BD@DBDBB@@@D@D@HAHAJEFCDCFCBCBADE@@@G@AD@BBJF@BBB@@@B@@@@@B@@B@@B@@BB@@B@@BB@B@@B@@B@@@@BB@B@@B@@BB@@@@B@@BB@@B@@BB@@@@@B@@B@@@@B@@@BHG@ABCBADABAFADBD@DBBBDDBF@BADAFAD@D@F@FBD@B@BBBB@BB@BBBBBBBDBBBDB@BBD@BCD@D@BAB@F@DBD@B@D@F@D@@AD@@@BA@ADEBCDCD@FBD@BBD@B@@BF@FABED@@CDCBA@CBABEDCBA@@BC@A@CDEBGBCBCBA@A@A@EDC@@@ABCB@@E@CBABA@@@GFADAFBBBBDBBBBBBD@BBB@DBFBBBBLDJDFDHBDBDBB@DBB@DBB@H@H@F@B@F@JAB@B@DAB@F@D@D@BBD@B@F@B@DABAB@DA@@B@DADBBBB@DB@DBB@FAB@BGDCBC@A@@BC@CB@@ABADAB@B@B@@ABFFNN@B@BBBDDBDBB@D@BBFBBBBDDBB@B@DBBBB@DBD@BDBFDDDDBCDAF@BA@@F@B@@@@BBFFFD@B@B@B@F@BADAB@B@B@DBBBBDD@BDAB@D@DAF@B@D@@AD@BA@@DAB@BAFAD@D@DABAB@DABABADABADABADADAJCFAD@BA@ABCDA@@FBD@B@@ABCBCBCBAB@D@B@B@B@D@BAB@DEBAB@DADAFAHABA@@F@@@BA@@B@BABABABADCBAD@B@D@B@BAB@BAB@D@B@D@B@@ABAB@@ADADABAB@B@DB@@B@DAB@@A@CB@BABAB@@ADABAB@BA@@HEHADADAB@BBB@B@B@@AB@D@BAB@BABABADAD@DADADAB@BA@ABAB@BAB@B@@AB@@AAA@@C@AAAABA@A@@BA@@B@BAB@DBB@BABA@ABA@A@ABADC@AFCBA@AFEBA@@BADABADABAD@FAHBD@D@@ABCBABA@@BABCBCBCBABADC@@DCDAB@@AB@BABAB@BADAD@B@B@@DB@BBB@BAD@BAB@DAB@BAB@@ABABABAB@BABC@A@ABA@CBABCBC@AB@BAB@B@B@BB@BBBD@B@BABADCBAB@DABABADAB@DAD@FCDCBABAFCBCDABABABAD@FEDEBADCDABADAB@BADADCB@BABABABCDADC@ABA@AAAAAAA@ABAB@@C@EBEBCBABCDABAB@DCB@B@B@BBB@D@DBBBF@B@D@B@@AB@@ABABADABABC@@BA@A@@@AAA@AAAAA@ABA@C@A@A@ABABAB@DAD@B@F@H@B@B@@AB@BA@ABA@AB@BCBAFCBABADADCFCFEBAFEBABABABAFEFCB@@AAAA@A@@B@BABA@A@@A@A@AAA@AA@A@@@@@@ADA@ABC@@A@E@ABA@ED@BAAA@CCAAA@AAA@EB@AA@@A@C@ABABC@C@C@AAAA@@@@@A@@@A@EE@ACCCC@A@@@A@C@ABC@@AACAA@@AAA@A@@@AAACAACC@AAA@@A@AAA@AAA@AECAAAA@AAAAACACA@@EEAA@A@A@C@AA@C@CC@@ACCGAAAC@@AC@@ACCEAA@AACAC@K@ABI@AAABCBCBCBCBCB@HGBADCBA@A@A@AAA@CAGAA@C@CAAACAEAC@A@A@AAAAAAACAC@AAC@EAA@CA@@EA@@AEAE@CAA@AAACEAA@A@AAA@C@CAA@AAA@AAA@@CC@A@@AAAA@AEE@@@AAA@@AA@AAAAAA@A@C@A@C@@BA@A@ABEBA@CBA@A@ABCB@@@@CBCB@DBD@DCDCBCBCBABEBCBCBCBABCDADAD@BCB@@EDADCFAD@DCFCDEBABCBA@@BCDCFABGJAF@H@LBD@HBB@B@BABCBCFEDA@GHCBIFADCDABADA@@B@JAD@FAFAB@H@H@L@D@DBDJFDBBBFFBD@F@D@DCBADABEBEBCBIDEBC@CBADCF@@AD@DAD@BABEDEBEBE@GBC@EBC@C@CBADAFADABEDIHCDEBCBKDE@CBA@GDE@C@M@EBA@A@IDCBCBA@C@CBA@AA@A@@AAAC@C@E@A@I@C@A@C@@@E@EBE@@@AA@AAA@@A@@AAACAA@A@AAC@@@ACG@A@AAA@@CEA@AAA@AAA@@@AAAA@@@A@AACAACCAACCCC@@CC@AAAAECE@@AA@@@AAE@EBC@@@C@CBC@CAC@C@@B@@A@ADEA@AACCAAAC@CAC@AAE@CBA@EBA@E@AAA@AAAA@AAA@ECAAA@AAAAA@CAA@AACAAAA@C@C@AAA@C@C@CAC@@@CAIECECCCAA@AAA@CCCCAAAGAACAA@EAA@A@A@E@G@A@A@C@GBA@ABA@EB@@CB@@A@@@ECGEAAAA@AACAC@C@C@C@C@@AAA@@@C@C@@@A@@@AA@@@A@AAAAA@AA@ACAC@ACA@ACC@AACAA@@@ABA@AAAAA@@@ABAA@@AAA@@@A@C@A@ACBAA@A@AACBA@C@ABEDG@@BEBABADC@ABABABABCJIB@HG@A@@B@AC@ACA@@@AA@AAA@A@@AA@AA@@A@@@AAA@AA@@A@@ACA@@CA@@CAAACAAACEA@A@A@AB@@A@ABA@A@AAAAEAC@EBCAAACICCACCACCA@IECCECEAGCEAE@MCEAGAC@CAA@A@A@CACCCECKAC@CCC@ACCAA@A"],encodeOffsets:[[105546,23027]]}},{type:"Feature",id:"532531",properties:{name:"绿春县",cp:[102.392463,22.993717],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@FADADA@A@A@A@AA@AAGAI@C@CAA@EGU[GKOSEECECAEAEAC@GBG@E@CAEACC@C@C@A@AB@@ABAB@@A@CBA@@BABCB@@@DA@@BA@AACBCAAEAC@AACAC@CACAAAGAC@GAI@A@C@AA@@@@A@C@CAC@ABA@ABABABCD@BABA@AB@BABADA@A@AB@BA@ABC@@@ABABA@CD@B@B@DADABABABAB@B@D@F@BAB@DAB@BABA@ABA@@DBBADADAB@FABAD@@@BABABCA@AAAA@@@@@A@A@AACCAAA@CAA@AAC@ABCBABABABE@ACAAABCBAB@@C@A@A@ABABCBABA@ADCDABABABABABCBA@C@ABCBC@A@A@AAC@A@@ACAAAECCCA@A@@CAAA@A@CBA@ABAB@BCBABAB@@A@ABA@A@ABABA@AB@@A@CAA@EBABABA@CBCAAAC@A@A@A@C@AAE@C@ABABA@EBCBC@ABGBA@C@EBABCBCBA@ABABCBCBA@@BC@ABABA@@@ABCBA@@@@BABA@CBC@ABA@A@ABCAABC@@@A@EBA@GBC@ABC@ABGHA@AD@B@BAB@BABABABAB@BAB@BABA@@B@D@BABA@@@ABADADABAD@B@BAD@B@D@BADAF@B@BAB@B@@@D@B@D@B@FABBB@B@B@D@B@D@@BFAD@B@B@B@BABCB@@CBABABCDABABEDADCBABA@ABA@ADABA@ABABCBAB@BAB@BA@@BABADADCBADAB@BA@@BABAB@BABAB@DAB@B@BADABABABABA@ABA@ABC@ABCBA@ABABA@ADABCDABAD@DABC@CBABC@A@A@EBA@CAA@C@CAA@AAA@EDA@C@A@ABABABABC@ABAD@BA@ABCB@BADABABA@CBABCDEBCBCBABC@C@ABC@C@ABC@CAC@AAA@AAA@@ACAAAAA@ACEAEAA@A@AEEAAAAEEC@AAC@ABABA@A@A@A@AAA@CD@BC@CBABA@A@@A@@C@ABADCDEDABA@G@ABCBABABADCFADABCDABCBCBC@ABA@A@A@A@ADC@@DCBADABABAB@@A@CBABCBAD@@AB@BADAD@DADAD@B@BAB@BA@ADCBAB@D@BEDADCBABABADABCBAD@BJJDBB@DBB@D@DDDDDDBD@@BFBBDFFDDBDHBDDBDBBBBBB@D@FAJED@FFBH@HAF@@@BEJBFNLHJ@DABAB@BAD@D@BABAF@@B@@B@DABABADAD@DABCDA@CD@B@BB@BDBBD@HCFBDBJDHNENBN@B@@@BB@@B@@@BB@@@@@@B@@BB@@@@@B@@@@@BBB@@@BB@@B@@B@@@@@@@@BB@@@@B@@@B@@@@@B@@@@A@@@@@A@@B@@@@@@@@BB@@@@@@B@@B@@@@@@B@@@@@B@@BB@@@@@BB@@@@B@@@@@B@@@B@@@B@@@@@B@@@@BB@@@@@B@@@@@B@@@@BB@@@@@B@@@B@@@B@@@@@B@@@B@@@@@B@@@B@B@@BB@@@@@@@@@B@@@@@B@@@@@@BB@@@@@@@B@@@@@B@@BB@@@@AB@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@BA@@@@@@@A@@@@@@BA@@@A@@@@@@@A@@@@@A@@@@B@@@@@B@@@@@@AB@@@@@@A@@@@@@@@@AA@@@@@@A@@B@@A@@@@B@@@@B@@@@@@@@@B@@@@@@@BB@@@@@@@@@@BB@@A@@@@@@B@@@@@@@B@DBB@B@B@B@B@B@D@H@D@B@@@JBB@D@B@B@@BB@B@DBHB@@@@@@B@@B@@B@@@@BB@@@B@@@B@@@@@B@@@@@@@B@@@@@BB@@@@B@@@BB@@@@@@BB@@@@@B@@@B@@@@@B@@@@@B@@@@B@@@@@@@BA@@@@@@B@@@@AB@@@@@@@@@@A@@@@@@@@@A@@@@@@@@B@@A@@@@@@@@AA@@@@@@@@@@@@B@@@@@@A@@A@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@AA@@@@@@@A@@@@B@@@@AA@@@@@@@@A@@B@@@@@@@@A@@@@B@@@@@@A@@A@@@@A@@@@@A@@@@B@@A@@@@@@B@@@@@@@B@@@@@@@B@@A@@@@@@@A@@AA@@@@@@A@@A@@@@@@@A@@@@@@@A@@@@@@@A@@@A@E@@BAB@@@DAHCDAR@D@FBFADBD@D@DBB@BBHBD@D@@@BA@@BCBAB@DDBBBB@DDB@BDBDDDBBFBBDBBFBD@DDDB@BDBBB@B@H@HADBBD@FEFCF@DBBPLFFBFAHBF@BBB@FCBEDCBADADAFCFABAF@DABBDDDFDF@DBFDBBBDBBBDDBBBDBBDBD@F@BDF@@DBDBFBBFBBDDDAD@HDB@FBDBDBFDBBDDBBDBDCBEDADCBABCBABCF@D@FBD@D@D@DBDDDBD@D@D@D@B@B@AACAAA@ABC@AAA@ABA@ADAB@D@@@DBDADAB@@@BABA@A@AACCA@A@A@A@A@@F@@AD@D@DCD@BAB@DAD@D@DBD@DDDBD@DBDDBBDDBBDBDBD@DAFAF@DAD@D@FB@CBCBCBAD@D@F@D@D@DBBBBBBDF@DDDFBDBBDDBDDDDBF@FCBCBADCDAD@D@F@BAD@DCD@BADADCFADCDANB\\DNBNAH@HBNFNDN@LBF@DBB@BBBD@F@BHDB@DBD@DDBBBDDDBDBFBB@D@DBFD@BADAFCFAFABABB@@B@@@@BB@@@@@B@@@@@@A@@BA@@@@B@B@@@BA@@@@@AB@@@B@@@B@@@B@@@B@@AB@@@B@@@@AB@@@@BBA@@B@@@@@BA@A@@@@B@@A@ABB@@B@@C@AB@@ABA@A@@B@@@BB@@B@B@@@B@@@B@@@@ABAB@@@@@B@@@@A@@@@B@@@@@B@@AB@@@@B@@@B@@@@B@D@B@@@B@@@@@@A@@@@@@@A@@AA@A@AB@@AB@@@@@@@@@@A@@B@@@HA@@@@@@@@@A@@@ADADAB@@@BB@@@@B@@@B@@AB@@A@A@@BA@@B@@@B@@@BF@@@@@@B@@BB@@@B@@@BAB@@@@B@@@@@@@B@@CD@DA@@@@B@@D@@BBD@@BB@@@@BB@@B@@@BA@@@A@@B@D@B@B@@BB@@@B@@@@AB@@@B@@@B@@@@@B@@@@@@B@@B@B@BA@@@@@A@AB@BAB@BB@@@@@B@@@@@@BB@A@@@@B@@AB@@@B@@@@B@@@@@B@@B@B@@@@@@A@@@AB@B@B@BCB@@A@@@A@@@@AA@@@AB@B@@@B@@@BBB@@@@@@A@@@AA@@@@@B@@@BA@@@AB@@@B@@@@@@BB@@@@B@@BA@@@@@@AA@@@@BA@@B@@@B@@A@@AC@@B@@@@@B@@ABA@@B@@@@@B@@B@@@@BA@@@A@@BA@@BB@@@@@A@@@@@@@@@@BAB@@@BA@A@@B@@@BB@@@@B@DA@AB@@@@A@@@ABAB@BA@@@@@@@@@A@@@@@@@A@@BA@@BA@@B@@@B@@@BBB@B@@@@@@@B@@ABA@@B@@@B@B@@@BAB@@@@A@GBA@AAI@A@ABABABCLENEFADCJEDAB@BABA@CBABABE@CACCACC@GAECAACCAAACACAC@CCAA@A@A@CFCB@DAD@D@FECC@EDCBC@EBC@ECAI@A@C@C@C@G@E@A@I@E@C@A@ABEBAB@@G@C@C@E@E@CAC@E@A@CBCBC@E@C@G@@@E@E@C@CBEAA@EBA@@@C@@@@@A@A@A@@@AAA@AAACEAAAAC@C@AAC@A@@@C@AAA@A@A@ABADA@AFCFAF@DAFBH@DAF@D@DABA@A@ABABADCDAD@BABADADCBA@@BAB@FAH@B@@@D@B@D@D@D@@AFA@ADA@ADA@@D@DAD@DAD@DADADADE@ABA@ABA@A@C@ABEBAB@D@DCDCBCBCBCBCBA@ABCFAD@BABCDABABC@CAC@A@AAC@A@A@A@ABABA@ADCBCDABCF@D@F@D@F@B@DCBAB@DBDADABAD@B@B@BBD@DBBBDBD@B@B@BA@ADE@ABABABABADA@@F@D@FADBFDHDFBDADAFCAA@AACAAAC@CAC@C@CAEAEA@@AAC@A@A@I@A@A@AAAAC@C@C@@BA@ABA@CA@@A@AAA@@CC@A@AAA@@AC@AAA@AAA@A@C@CA@@@A@@@AA@@A@AB@@@@@B@@@@@BA@A@@@A@@@@@@BA@@@@@A@AA@@@@@@A@@D@@AB@@AA@@A@@@@A@@B@@@B@@AA@AA@@@@@A@@@@A@@@AB@@A@@B@A@@@@@AACA@@@A@A@@@AAA@@@ABABA@@@A@AAA@@@A@A@@@AB@@A@C@@@AB@@@@@B@@@@A@@@A@@@@@A@@@AA@@@@@@CDAACA@@@ABCD@@A@ACAAAA@@AA@@EAA@AAAAC@ACACC@A@@@AAAA@AACCAA@AAA@BA@CACECCCACA@@CAC@AAE@ABCBA@CBA@CAE@CC@@CAAAC@C@EAEA@@AAA@ABA@ABEDED@BAB@B@@ABABADCDA@ABA@EAC@ABABCBC@C@I@C@EACAA@@A@I@AAACAGBI@EAECECC@ACAEACCAAE@EAABA@@AC@G@A@A@CB@BEBABEACECGCGEA@ACAC@CBCAE@CCAACAC@CCECEIEIEEACAEACCCCA@AAAAC@A@IFCBGBE@CCECA@@ACAAA@C@ABCFKBEBGHIDADEB@@ABABC@CFEFCHE@@D@"],encodeOffsets:[[104871,23184]]}},{type:"Feature",id:"532532",properties:{name:"河口瑶族自治县",cp:[103.93952,22.529645],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABC@A@C@A@C@C@ABAB@BABABAH@DABCDCBAACCEEC@CBADDF@B@DADKJKJEFGJEF@BABCBA@ABC@AAAAACCAC@EDEDAHEJEHCFEFGDEDEBGDEFCFAFCDILEDEBCBEDADABCDAHCJEJCFCFABCDABUZADADA@ABEBCDMFADAD@DAFCBEFCDCFAF@BABE@C@KCA@C@ABIPCBCBEBABADAB@B@DBD@BADABEDCD]\\UVEDCBCBA@A@C@CBEBKHADCH@FCD@BABA@EBCBCBC@CDABAD@B@DAHAHADADCDEBIBCBA@AB@BABCB@BA@EDEFABABABABEFABEFEDCDCBABABEDABADA@@BAB@BABA@@BA@A@G@E@A@C@CBA@ABAB@B@B@B@DAB@BBBBB@BBB@B@@@BAB@@ADABCBABAB@BA@@BA@C@A@E@AACAC@A@AAA@A@A@CDA@ABCBADABADAF@F@DA@AB@BBBBBBB@BAB@BCDCBADABABABA@CDCBABA@CBABCBCDABCFEFC@ABABABCBADEDABABCDEDC@CBA@CBABABCBA@ABCDABABA@C@AA@AAAA@A@A@ABA@@BADADAB@DAB@B@BADABA@ABABAB@BA@ABA@CBA@ABC@ABA@AAA@@CA@A@C@CBABA@ABABA@@BA@CBCD@@CDABABADADADAB@@ABABAD@BC@C@GAEBC@ABCBABCBAB@@ABEF@BABED@BCDAB@B@BAB@BABABA@CAA@ABA@@@AB@@@BABBBBBD@@@BB@BA@@BA@@BB@DB@BDDBBFBD@FDDDB@BBHBBBDBDD@BB@FDHDDBDBBBBBHDFD@@DBBBHFBBFD@BB@BBHF@@FBB@BBB@BBFFDB@@B@FABAF@BAB@DAD@BAB@JADADABADBFHDHD@FAPIHC@@LELCHAF@HCHEFEFEDCFGDEBEDE@CFAJGDABAFI@EBE@CBA@ABGBCBCFEDCDADC@CAEAEAEBC@CCEAAAE@EBA@CBAB@D@F@B@D@B@BBFBB@D@BBB@BBB@B@B@DBH@@@B@DBB@D@BBB@DBBB@@BBB@B@FB@@B@B@BBB@B@FADCDCD@F@FBF@@@HBD@B@@ADAFGDADCHC@EDEDABCDCFADCBE@ABEDEFCBCBABCAE@CDEH@D@FCFGDCDAF@HAL@H@JAFCFCFAPCBC@EDA@EDABGBE@MBG@GDAFAL@FBHD@BBDBDHDDBFDDFDHBF@@@@BB@@DBB@FBDDDDBBFFBDBB@BDDB@BB@@BBBBBB@@@BBB@B@DB@B@B@F@@@DBBAD@B@D@BAD@@@JBD@FCDCD@BBBFF@HAF@FC@A@C@ABA@ABABABABA@ABCB@@A@@B@@AB@BA@ABA@A@ABA@A@A@@BA@A@@@A@A@A@@@A@@@AAA@CBA@@@AAA@@AAAAAAAA@A@AAA@@BA@EBA@@@AA@AE@@@A@C@A@@@A@@@A@A@ABADC@@BABABA@@BC@ABA@AB@@A@@@@B@@ABABA@@@AB@BAB@DABAB@@AB@BABABA@A@@@A@@@A@A@@@A@CAA@@AC@A@AAA@AAAAC@A@@@C@@AA@A@@BA@A@A@A@A@A@ABC@@@ABA@A@A@A@A@A@AB@BABCBC@@BA@A@ABC@CBC@ABA@ABABCBABE@@@A@A@A@@@AAA@@A@AAA@@AAAAAAA@ACA@AAAAA@@AAA@AA@@AC@A@@A@A@@@@@@A@@BA@ABA@A@A@C@A@AAADIJQNKRGVAHBBF@HAFBFDFJFD@FBHBHAD@D@FADCHGDAAG@AAEBGAG@EFAJRHHHDNRDBFDDDFFDFDFBFDDDD@@@@@@@D@D@DAH@FBD@D@B@BA@@B@BBHJDFBDADE@A@ABDFLFFFBBBBFFH@@@@@B@DBDB@@@@BBBABAJ@BAFBB@DFHPP@DADGECBAB@FFDFH@FCBECCAG@@FCF@FCFBDFDDDBFADDHDBFDFBJADCBGDG@EH@D@FAD@DCN@HFDBEBKDDDDBFBDBDBJ@@F@BBDDFBFFFDDDFBDDFBF@D@DJPJ@JHFBBABBBH@FBHDHFBNAJBBDFBDA@C@E@@@CAE@CDCHABABEBEBKDIJEDAF@DBBBFBDADBB@@BFFJJ@@B@@@@@BE@G@G@EAICAAE@GDCHCJ@B@DBJHBDDFFBHDBDJLFFJHDBF@DBD@HEDEBA@@DAH@B@@BB@B@BBB@FBBBD@FDBBDBD@D@B@BADCDABAB@@@DC@@FAB@DCDC@@@A@GBA@C@@DE@ABC@C@ABC@AAA@A@C@@@A@@AACCCGCA@A@@@AABCJCJG@CDEFC@ECEGIFEDEACCECEGICECECE@EDC@ECEAE@G@GCA@EAEEEACHCBECCAEEC@G@CBCBCCCCCCGAGEICIGGAG@EFCHEBE@E@CAGACC@AAACCEEGCCGCCCCCECEAEAGAGCECCEEICCGECEAGCEACGACCCGKGGEC@@KMCEAIAEAGCKAE@CAIAACCEGACDGDCDCDAFADCCGEIACAE@K@CCG@E@I@CCEAE@E@E@G@CCEAC@C@GAC@CAAAEACAC@A@A@A@A@ABAAA@AAAA@CCCA@CAABE@A@GAAAAA@CAA@AAAA@A@ABCJG@@@@@@@@@@BCB@@@@@@@@@@@BA@AAA@@GAA@@@DC@C@CDEBA@AAAAC@CBCAAAAE@@@AA@AA@@A@@A@@AAA@@@A@@@@@@@A@@FCB@@@BA@CBA@A@A@C@A@AAA@AAA@AA@AAA@AAA@A@G@A@CAA@EBA@A@AA@ECCC@EA@AACACA@CACAA@A@C@@BAB@B@D@@A@A@A@CACC@C@AA@A@IHGDADCDAFADCFABAB"],encodeOffsets:[[106442,23063]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("昆明",{type:"FeatureCollection",features:[{type:"Feature",id:"530102",properties:{name:"五华区",cp:[102.707262,25.043635],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@FELBNHJJFb@LDHHD@@DBD@F@D@HABCDB@MAA@AAE@CDGAQEEF@GCCGBG@ABGEG@@KCQIWaQMICEJAJBJHHBD@B@D@BADCBABA@A@CAC@A@ABABAB@BA@C@A@G@E@C@EBEBCBAB@B@B@DB@EJA\\BFFFDBH@N@NANEB@D@DABABABA@@@@@A@A@E@CBE@A"],["@@@@A@A@@@@@A@@@@@@AA@@@@@A@@@@@@@@@A@A@@@@@AD@@@@AD@@@@A@@B@@@@@@@@@@@@@@@D@@AB@@@@@B@@@@@@@B@@@FC@@@@@C@C@GB@@@@@@A@A@C@A@@@A@C@A@@@@@A@@@C@ABE@A@A@@@A@E@C@A@@@CB@@K@AAAACC@@AAAA@AAAAB@@@@CB@@@@@BA@@AA@B@AB@AAD@@A@A@@AEACBSFA@@BCPBB@B@DCD@FFHC@A@B@@@@AAAAAA@@F@L@HB@AHB@@B@BA@ABBFHDABB@B@@@B@@@@BABCHCF@@@@CDADCBE@ED[\\QZYfMTF@LBDD@FCHGHKJABDDH@FBAFGDCH@DBBB@JCNAJ@NFJFHBF@L@FBD@FD@DCDCF@DFDD@D@BC@ABCDAF@D@DCB@DADFHNFFHF@B@BBFAF@FAF@DAFADAH@D@H@F@DBB@B@BB@BBB@@D@FBD@B@PDHH@F@LAHBBBBBPRHHH@@@D@F@D@DADADADABADABAB@@BBBBBBBB@BBD@DDBBBBDBB@BBF@F@@@JAFCHEDMFGB@@@@CAC@A@W@ABCB@BCBGCs@EBABAB@D@BBB@B@@@@ABA@C@E@E@EBA@A@@BA@@AA@AAI@@CA@A@@@I@@BC@AAUAEEKACCACACACAAIKg@@@AACEWAGBC@E@@B@@AB@BA@BB@@A@@BAB@@FBAB@@@B@@@F@BDDFBBBDBADFDCB@FCBCBABABACAEAA@@AA@@@AEAA@C@GAG@CAA@AAE@AA@@AE@A@@@A@@AA@AAEE@A@@@@AA@@@CAA@A@AA@@A@@@@A@@AA@AAEC@@A@@AAA@AAA@A@@@@@AA@BA@C@C@A@@AA@A@@@@@AAC@@@@@A@@@A@@@@@@@A@@DA@@B@@@@A@A@@@A@@@A@A@@@@@ABA@@@@@C@@@A@A@@@A@@@AB@@A@@@@@@@C@@@A@@@@@@AA@@B@@A@@@A@A@@@@@A@A@@@@@@@@A@A@C@@@@@A@A@@@@@A@@@A@@@@@@@C@AA@@A@E@E@@@"],["@@NGHKBG@EAESMICEAMAABA@@@@C@AAAC@E@CA@AAC@CBC@CBCAACCC@@@AACBADAFCJAFEFEHCDADADAFAFADABCD@BBFBD@FAD@DAD@DAB@F@D@BBB@B@BDBB@FBDBDB@B@@FC@CD@F@DHDPBFF@H@HIDU@ADGPGLK"]],encodeOffsets:[[[105124,25992]],[[105165,25634]],[[105082,25906]]]}},{type:"Feature",id:"530103",properties:{name:"盘龙区",cp:[102.751941,25.116465],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@C@@@G@ACBEB@@A@AB@@@@C@@@A@@@@@@@A@C@C@@@A@@@A@@@A@@@@B@B@@@@@B@B@@@BA@@@BB@@@@@@@B@@@D@@@@@@@BA@@B@@@B@@@B@B@@@D@@@@AB@B@@@@@B@B@@@B@@@B@B@@A@@@CB@@@B@@@@@@@B@@@B@@@@BD@B@@@@@BBB@@@B@D@DABB@@B@@@@@BBB@BBB@BB@@@FDBBB@@BB@@@@@@BB@@B@BBB@D@@BB@@@@@BFFBBB@@BB@@@B@F@@BB@@BBF@BBB@DBH@H@DBBBF@@B@@BB@FBDBABABABADEDA@CDCEABACAACEACE@@@A@@@A@AB@EA@AB@@@BA@@AABA@@BA@@@@FADBHFXBD@B@@LhBJDBDBDBDBBDFLBFBV@BAD@@@J@@@BDB@@BJ@BBB@@AB@@@BAB@F@F@F@DAB@B@@A@A@AAC@A@ABAB@FDtAHADA@AD@B@X@BBD@D@@A@EHCNGFEDIB@@HLBDBDBBABA@A@CBCDA@CBAFA@C@G@@D@D@@DBDBBDBF@D@F@FBF@DBF@DABEBEBCB@BBBDBF@DADAD@DFBL@FCF@B@BBBF@FBHD@BDBFD@BBB@DAH@FADC@I@EAEEC@C@EDCB@ACCACA@ACC@AAA@CBCBAHDHHDE@FFBRCH@DBF@BBB@NPFDADAFAB@D@D@B@@B@H@D@BBDF@JENGFEHB@@BF@F@FHNNDJBDFDH@@@D@@B@@BD@@@DABC@@@CAACC@CBADCB@D@FDFBDABCECGCCCCC@CBAD@DCB@BABDDBD@F@H@BB@D@B@BB@D@@@B@@CB@@@B@BFBDFDJBH@H@HDBDBDFDNBXHNDFBNBXFDBJEDG@I@GB@B@B@F@L@D@DABBB@D@D@FAB@DHBBBBB@@A@A@AB@B@BCB@@DBDDBDBDAB@FCD@D@B@DABABCFCH@NFVDHBFDJBFFDDDBD@FDBDBBAFBDDAB@@BBBDAB@DBBALCHCDCFGHGB@D@BBDAHB@BDBHAHCHBAACAGCQE]MAAKGAE@A@ECCCCUO@@A@@AA@AAECCAAABEDCDCBCDC@@CAEBEBCDADE@C@CCAAIQCGOIKGGCGCGAECGAGAE@GBG@IAAACA@EDEFKBAFKBCDEBAFAH@F@F@H@DCDCBE@CB@BG@C@K@WEkEQ@AEGAC@CBCDCFAFCLCHAHC@CUDG@E@CACC@@@@CC@C@CAC@AACAA@C@CAA@A@CAA@@ACAA@C@CAAAACACCCAEECEAC@A@A@A@C@AAACCECECCCEC@CEY@C@C@ABAJE@CBCE]AGEOEIEGCIKY@@@@IUOUGEKCEAEAGAECGMCEK]CEGKIMCANSBCA@AB@@@A@@@C@@E@EB@@@BCBCBCBCDCBE@MBA@A@A@A@@@CAC@E@I@E@C@C@@@C@A@C@A@C@C@@@@@CBA@A@@@A@AAA@A@A@@@AB@@@@A@@B@@AB@@@B@@@@@@@@@@@@A@@BA@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@A@@@@@@@@@@B@@@@@@A@@@@@@@@@A@@@@@@@A@A@@@AB@@@@A@@@@@@@A@@B@@@@A@@@@@A@@@C@@@A@A@@@A@A@A@@@A@@@@@@ABA@@@@@CBA@@"],encodeOffsets:[[105201,25640]]}},{type:"Feature",id:"530111",properties:{name:"官渡区",cp:[102.749026,24.950231],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@ECICA@A@ECKASCMCMEOE[CMNGHKH[VMLKHCH@HEJAFAHvRBBFDFF@@@BD@B@@@@@BB@@FDDD@@BB@@DDBBFB@@@BB@B@@BDDBB@@@@BB@@BBBB@B@@BBBB@B@BBB@B@@@B@D@@@B@@@B@B@B@BBB@@@@@@@DBD@BBB@B@@@@BB@@@@@B@@BD@B@B@@@B@B@BA@@D@BAD@@@F@@@@@B@@A@@B@@@B@@@@@@@B@@@@@B@@@@@BA@@B@B@@@D@@@BAB@@@D@B@@@B@@A@@@@@@@@B@@@@@B@@@@@B@BBB@@@BBB@@@@BB@B@@@@@BB@@@B@@@B@@@D@D@B@@@@@@@B@@@D@@@@@BAB@@@FADA@B@H@@@D@B@@AB@D@@@@AB@B@@@@B@@@B@B@B@@@B@B@@@D@@@B@@@@@B@@@@@@AB@@@@@@@B@@@@@BA@@B@B@@@@@@@B@@@@@@@@@B@@@@@@@@A@@@@@@@@B@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@B@@AB@@@@@@@@@@@@@@A@@BA@@@AB@@@@@BA@@B@B@B@BBB@@@B@B@DA@@@@D@D@B@D@B@D@@@D@D@F@J@F@D@DB@@B@B@B@B@NAF@DADCDADADA@A@@FAF@@@@D@@@B@@BAB@ADMTDBJNHLDFL^DFHNFDHBFBFBLDHFPVJV@@@@LZDJFHFJFPBHF^AD@DIFAB@B@D@DFZ@DFDDDFDFDDDBB@B@D@B@B@BBDDFFFDBDDDBBBBB@D@DBBBD@@BB@D@BBB@D@DBBBD@BBD@D@DDD@@@@DDDBF@H@VC^CRC@@@AFBBA@CAACB@C@EBAJCDAJCDA@@@@FCFEHAFBPFD@JBHADCFEBE@K@K@MAQEMGECACAICK@EDAJGBCA@C@CHMJIHELGJI@A@K@I@CBA@ADCBEBCBCHCJCDABCBCFABABADCBCDG@M@A@C@A@CAAAA@A@@AACBC@EDA@@A@CBABAB@D@BA@@AG@@@CAA@CAC@EAC@AAAAACAAAAA@A@C@C@A@CACACAA@@AAAAKBCDAD@@A@A@AAAA@ABEDC@CCGEIAI@AFEFG@C@CGC@CACAECEAACCCAACAAAC@C@AAC@CBA@A@@@A@CAAC@AACA@ACCCECEEECCCA@@CA@@CACAA@CACAE@A@C@C@IIGAGBGFGDCDBBAD@BAFADE@SBC@CCCAAAAACAC@E@C@A@CAA@AACACAA@CACAA@AACACAAACA@@ABABABABA@A@A@@@CAAAA@A@@@A@K@C@KBCLA@ECA@E@@DAFG@A@A@UG@@C@@A@@CC@CBC@CAAACE@IICCCGCEAC@C@CCCEAEC@ECEGCGEA@EE@@ECCCCAAAAACACAEC@@@ADCDA@A@@@@@@@A@A@@@@@@@A@@@CB@AACCAAC@AAA@ECGE"],encodeOffsets:[[105237,25499]]}},{type:"Feature",id:"530112",properties:{name:"西山区",cp:[102.664382,25.038604],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@AAAC@CCGAC@AC@AAABC@AACCCCA@E@G@A@E@CAI@EBE@EDABADABCBGFADGDADCDCFCDCDCBC@G@IDEDEDABAFAFHDBD@DEFEJ@H@B@B@B@@AFAH@BAF@B@BDHBF@@@@@B@@@@@D@@@@@FBFBDBD@D@BAD@DABAD@F@FDBFDF@DBL@JBBBDBBBF@B@HDFBFDBBBD@FBD@BB@DBD@BBF@F@BBD@BAB@DA@ADCFCFCHCFABADAFAB@D@DBBBB@DAHADABA@ABE@EFCDCDAD@FBF@DBBDDDDBHBDDDBF@@HFHJHLHHHDJ@FABBDABABCBCBCAA@A@A@AB@B@@AB@DBDFDBD@@@BAFDF@DADGBEB@FAHFJBHBDBD@BADCBCBC@AB@D@D@BB@D@HEB@DAB@B@AJEH@FEHGDM@GDGFAB@@AB@@CBCFA@@@@BCH@HCFCB@BBFBD@B@@E@CA@BABCBA@CCEE@@CCAAAAA@A@EBCBCBABABCBC@EBCBCB@@C@KECGE@CDIJABA@@BAD@B@B@DAD@DABE@C@ABAD@@ABBB@B@BADABC@A@A@CAAAACCAC@ABCDABCBC@EBE@E@E@CAAAACCA@AA@C@C@ABA@CBCDABCBCBA@AACAC@CBCBGBEBCB@@@B@B@DBBB@FB@BAHBB@BDFAFCFGBGHCFCDIBCBCF@@CD@@AB@@@LHZBHA@@@@B@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@@AA@@@@@@@@@A@@AC@C@E@EBEAGAEAE@ABCDEDCBE@C@EECCE@GHAHAJBFAB@D@D@D@DAHADADCDA@BB@DBD@DBBBDBB@@@FEFCD@HBH@J@J@DAHCFEAGAABADPLJVDP@BPfFFBBBBHLHHBDBDBBBB@@DAD@FCFAF@F@B@BBBABABCBABAD@D@F@FAD@DA@@LGRIFIDEDCFAHB@FBFDBFBFBF@B@DABA@CBCBEBCBABABABADC@AACAABC@KAG@EDCF@L@JAHFHFHBD@BCBE@KCECEBEDAHFLPHFHBD@@CQUEK@EDAFAHBLRHFH@D@JAH@FB@J@H@F@FBBJBF@NSZeRY\\[FCF@DABCDC@@@@DEDGBA@A@@A@@@A@A@BAGCAEBAB@@A@AA@BGA@@G@K@EB@BBBB@B@@A@B@D@EG@EDC@C@AAADO@AB@TEDAFB@BB@B@@@BC@BBAA@B@@BB@@A@@@@DA@@@@BABB@BBBBB@@DDBBBBL@@@DA@@B@D@F@B@@@B@B@F@BAD@@@B@@@@@B@D@B@@@B@D@B@B@@@@@@@HAD@D@@@@@D@@E@@@A@@@@@@@A@@@@BA@@@C@@@@@@@@@@@@@@@AB@@@@@BC@@@@BC@@@@B@B@@@@@@@@@B@@@@@B@@B@@@@B@@@@@B@B@@@@@F@F@B@@@BBD@@@@@@@B@@@B@@@@@B@B@@@@@D@B@B@@@@@@@@A@@@@@AAA@@@@AA@A@@AA@A@A@@@@@A@@@@@A@@@@@@B@@@@A@@@A@C@@BA@A@@@C@@@A@AB@@A@@@@@A@@@@@A@@@@@@@A@@@AB@@@@A@@@@@E@@BC@A@CB@@A@A@A@@@A@AAC@@@A@@@@AA@@@@@AAA@AAC@C@@@@@@AA@A@A@A@A@@@A@@@C@A@@@AAA@A@AAAAA@@@AAAAA@@AA@@@@AACC@AA@A@@A@@EAAACC@@AA@@CCEC@@AA@@@@A@C@@A@@EEECAAuQBGBEFI@GDGLGNK\\ULGHGNMZYHKDGPYL_D[ABC@KEGGMKCC]Y[WCC_[SIOC]AYAa@AB@@@@@@GBE@GBG@A@G@CAA@IBA@AAAAC@EAIAEAA@CAAA"],["@@@@C@CAA@@A@A@ABA@C@AAAE@A@C@@@A@A@AB@BB@B@D@B@BB@BBDBD@BDBD@D@@@@@@A"]],encodeOffsets:[[[105025,25345]],[[104968,25457]]]}},{type:"Feature",id:"530114",properties:{name:"呈贡区",cp:[102.821675,24.885587],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@AC@AAC@CFEBGBEDEFEDEFIBA@CBE@ABA@ABA@CAA@AAC@AAE@@AAABEFCFGDGFAFBDH@H@DB@DAFADCDA@CAACAEEAEDCHABEBE@G@ECGAIBIFCBGDGD@BAFCHCFCFA@CDAD@@I@C@G@AA@@I@ABID@@GHCJ@JBDBDDFAHGF@@ED@@EZK`CPCBC\\K`OZCHGLYZ\\DPFNFNDTDLBFDB@B@JDFDB@@BHFFDB@BBD@BBDDBBA@@D@@@B@@@@@@@B@B@@@@@@@BCBCD@B@@FDDBDBBBBBDBDDFD@@FFB@HFHDDF@FFDFBDD@D@DBDDFDHDDJJF@BDBB@DAD@DDD@@@BD@@@VHB@B@H@BE@CF@B@FDB@DKLAD@L@B@@@B@B@BBDB@@B@B@B@BABABABA@@DBBBDBDBBBB@DBDBB@DBDBBBB@DBB@D@F@D@DBBBBBDBDDD@TAF@BCBE@ABCAADCHCHEHAHBJJD@D@B@F@DBDBB@DBDBDIFCFEBABE@GEKSWEW@M@@@C@EBCB@DDDDBBJDJLD@DA@C@@@EBE@@BCBABCAA@K@G@K@G@ECGACACCACCEA@@A@GCCAEBIDADEDECCBAA@G@IACAEEICCBE@@@@@A@AA@A@A@CBEBA@AAA@@@A@G@KE@E@ICAGFAB@MEGC@ADBLCD@HCDABCBCAECEGAE@C@EAA@A@GAONC@ACCAAA@CAA@C@AAAA@A@CAG@C@CAAAABCBC@A@A@E@@DADCDEBE@CAE@GBADABAFADA@A@A@@AACACC@C@ABC@A@A@@@AAA@@C@C@ABAAA@AAGCC@AC@IGAACI@C@CCAKCK@@CACCCAC@A@@BAB@FAF@BAB@@CBC@EBGBG@G@M@G@"],encodeOffsets:[[105310,25343]]}},{type:"Feature",id:"530113",properties:{name:"东川区",cp:[103.187824,26.082873],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAIOEGC@ABGLA@A@C@A@@@EAAEG@C@AF@B@@G@CAGCI@E@CC@GCEE@ED@BA@CBCBA@E@CBCBEDCD@B@BBDD@DBBBD@BBBBBDBBBDBFBBDB@BBB@@@D@@@BBBB@BADD@D@HHHBF@DEB@BA@K@C@C@@BA@@BABAHAHCFAB@@CDMHEF@DA@E@GCE@CD@FBD@B@@BDABADEBIB@BAF@FADE@@BABC@ABA@AAAAAAA@EAE@EACAAAACCAEAA@EACACAAAACAAAACAA@C@CBE@CDAFAF@D@D@D@@@BA@CB@B@@@BA@@BABABAB@B@D@BAB@@A@ABAD@@ABABABAB@BA@ABA@A@@@ABA@@DADCPADBFCFEFCBCHAFCDG@ABAD@BCHAFCD@DBD@DBD@BEDMDE@E@E@EBCACA@CCGCAIDKDIHCHAB@DBFBDBB@BBDBFBF@FED@H@FFLBFBHABAJADCHAH@BBDFD@FDH@B@D@BBBBB@DBDAB@D@B@B@B@B@DBDBB@B@D@@ABBDAB@D@DABAB@D@@ABAFADADABABABGDC@ABABABAB@@CB@@ABABCBA@CBCBC@A@C@CBA@A@C@A@E@ABCBABABABCDCBCBABA@CBA@ABC@CBA@A@ABC@AB@@A@@BABB@B@BB@@BB@B@B@B@BBBB@@B@BAB@B@@@B@BBBDDBB@@@BA@@@C@ABA@@@@B@@@B@@AD@DAD@@@@@BB@@@@B@BABCDAB@@ABB@@@@B@@ADA@@B@BA@@B@BAB@BA@@BBB@@BB@@@B@BA@@B@B@@@BBDB@@B@@AB@@@B@B@BBD@B@@B@BB@B@@@BBB@B@B@BBB@BDD@@DB@B@@AB@@@DA@ABA@@B@BD@D@DBFBDBD@FA@@L@D@F@DBDBB@@DBBBDBBBBBBD@HDHFDBBBBD@DBB@BBBDDLHDDBBDD@BBFBHBDBDDHDBDJDF@DB@B@B@DEBCDADABA@A@CEK@C@ADEDEDCD@B@DBF@D@FBBBD@B@D@B@D@D@D@B@BAHGBABAB@BBDBBB@@B@D@FBDBDBF@FBF@FAF@D@N@N@F@BBF@FDDBDD@@FDFJ@D@B@B@FBHBDBDFFDB@DADADADAF@B@DEX@DADADAD@H@HBHBH@H@H@DBDBJDHDFBH@@BF@HAH@BCJELCF@FAD@B@B@B@B@B@@@@B@@@B@B@@@@@B@DBFBH@D@DAH@DAF@D@D@D@DBDBDBB@B@B@DBDDDFDHFFDD@B@D@F@@BFBFDBF@DBB@BD@DBBBDBFBD@DAH@BAF@DAD@DF@BABA@ABCB@BA@ABA@ABABC@AAA@A@A@A@C@@AE@A@AAAA@AA@A@CAABCBC@ABE@C@C@CBCBABABCBABCBC@ABA@ABA@AAA@AA@@AB@B@BA@@@@BC@@BC@@@A@A@@@A@A@A@A@C@A@C@AB@@CBA@ABA@A@A@ABA@@@A@A@@@ABABA@AB@@@BA@A@AAA@A@A@CBC@G@C@A@A@A@A@A@A@ABABABA@@@A@@A@@A@@@A@@BC@@@A@@AA@A@A@ADGBA@A@AB@BE@EBCBE@C@E@A@ABCBABA@ABEB@AC@A@A@C@A@A@@@@BAB@BCBCBEDG@A@A@C@A@ABA@A@A@C@@AA@A@@@A@A@@@A@@@@B@@AB@@A@@@A@@BA@A@A@@@@@A@A@@@@@AA@@@AAA@@@A@@AACAA@@@G@E@A@CBG@ABA@A@@BA@@BAB@FEB@@@@A@ADGBA@A@@BAB@@@BA@@@@@AAA@AB@@AB@@@BA@A@A@ACCA@BA@ABA@@AA@@AA@@@A@@AA@@@A@@@AAEAE@A@A@AAA@@@A@C@C@@@ABCBA@@A@@CAA@AB@HGPE@A@ABA@ABAB@@AB@BAB@@@B@BAB@@@BA@@B@BA@@B@B@B@B@B@BBB@BBBBB@@@BBB@@@BBB@DAB@BA@EAMAKBC@EFELAT@DE@CEC@ADEHIDKFEHAFBJBBFEFCF@FJNF@F@FADCF@J@F@B@JGFEDEBABCHCJCFEDCFCHE@GBCDAB@BADABABABA@ABA@A@AA@AAAAAACCAAAC@E@@AAEAG@CC@A@ABAD@HADADCJGFC@CBG@ICCAEAE@A@EBCDGFGFCFEDGBKBG@C@CHBFCDCFEDC@AACAABAF@D@BA@EBCDEDCHAFC@CHIFG@E@CEECEACACCGACAECEEKC@@A@AAA@CAE@AAAAC@AAC@AAC@C@A@C@AAAAA@CBEDEBABC@A@@BABAD@N@BBLABEACMAA@AACAAABA@CDADCBC@CEAE@CA@EAG@EDGFCLAFAFA@@@E@CAA@CAC@C@CAE@ACEDEDCDE@CCACCAE@AA@@@FGBC@A@AIAA@AB@@C@EEAECE@KBMB@@C@ABABADAJC@AB@@A@AA@MAAA@ADEAACAA@@A@CJBFBD@HCHCBCFCC@C@A@AAACA@AECE@BCCCA@@ACA@CC@A@@BA@@FCDA@A@AACACAABAC@CAACBEA@G@C@GCCAC@EBGB@CCEA@CAAA@C@C@ACC@@CCA@AACBC@CAAAA@@ABAFA@CCCCBEBGDC@C@CAEAAAEACCC@EACCA@AAAAAACAO@QFMBQ@KDAB@@AFABBBFFFD@B@B@@ABCBAB@@@@AHBHBBBBFD@D@F@DDBF@DBDFDFAPAFCD@J@FHD@BBBDB@BBD@F@DAH@FAF@DAB@@EJAL@F@BDJBD@FBB@BAJ@HAF@N@H@HAD@BEBK@OI@EDAF@PCFA@G@ACAA@C@CAAAEAA@C@A@A@EA@@C@CACCCAACA@@AAA@A@@@A@AB@BAD@BG@"],encodeOffsets:[[105590,26538]]}},{type:"Feature",id:"530122",properties:{name:"晋宁区",cp:[102.595412,24.66974],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCAA@A@EBA@@@ABCDAF@NDH@@@@GAAGBECCOCG@@@@@A@@@AA@@A@@@A@@@A@ABC@@@@@AB@@@BA@AB@@@@A@A@@AA@@@@A@@@AA@A@AA@@@@A@@@A@@AA@@CAC@CFEBG@CAEAEBGAGEEAGEICCC@CECEBEFB@CD@BEFCFEBC@CB@BAFABCB@B@@HDHDFDFF@B@BCDCDAFCDGFC@EAEBEDEFKDG@E@IDEFE@E@KCAB@BDDBBADABCHADELEHKFKHY\\QC@DCDCHCXGJADBB@BD@DAJAH@@BBDNHFDPP@BBDJBVDJFDH@JBFNBHDDFBFDB@@FG@@JIDCBEDIJGPCPENIHIDCHAJBJB@BB@B@B@B@FBDBB@D@B@BBBBBB@F@FBFFHHBDJB@D@B@DAD@DBD@DBBBB@@BTTV@NDDDHHDHFDEJAJDJBHPJLFJDJAHADBD@H@B@FBDBDB@BJFDLJLDH@JCFGFEFA\\AHCBI@@@CF@BA@EHAFCDA@IAC@AB@BDDFBJB@@FBFJCJAHCBC@E@GCE@G@@BA@KFAJLDJDLHBFABC@CB@B@BJHBFDDHHHDDHAB@BJDEHEBAFHXAHKHBTTNHDRHBHDHFFLH@A@GFIFE@CACGCBEBEBAFCFCJCH@D@DADCDCDEDCBCHCBCHEDABABCBAFCF@FAJ@DBF@B@H@F@B@DDDDBBD@BABBD@@BBDDH@DBDBB@@@@BBDBB@FBJBFBD@BBBBB@JAB@DBH@B@H@HAF@HA@@@@@@BAb@ZB^BPDTJ`\\DD\\X^ZDDNLHHLFD@BADADOL_FY@@FC@@HEBGCEACAC@IDIHG@@JCBAJ@@@BBH@D@J@@@BCDCB@DEDEDGBE@AHCHCDAJEJAHBFDH@F@FABADGFCFBBFBDDBB@DCBCBE@CCAG@G@ACBEHEHCDEFEBA@@CGACCECAA@CCA@CACCAEACCCECCCAAAC@C@E@G@AACCCAAAC@A@C@G@GAC@ACEAAAACAC@CAC@CAA@A@AA@CB@@ABABCDABABAB@@A@A@C@C@ABABC@A@AAAA@C@AAA@@AAA@CAACAAA@AB@BA@A@AAA@@AAC@A@A@A@AA@AAC@E@ABCBABAB@@C@A@AAACIAGAIAAACCCAEAAAECG@IDADABCGI@CDCFCFCBEBA@EBAHCBCDAF@H@@@@@FA@A@AMCGCECGCI@ACCKICG@GFC@CCAAEC@AAEACCEAA@CAA@CAAIGG@K@CB@DDD@F@DCBGDG@E@AAAEAEEAQKAAI@OCC@IACGECCBAB@BCDC@EDEF@F@D@DA@ABADBLHBBBBBCDCDCBABABCDGFC@@@ABC@A@E@C@C@E@E@CACAAA@AACAAA@C@C@E@A@E@CAC@EACACAEAC@A@C@E@C@CBC@CDCDABABABA@C@@AA@A@A@@@AB@@ABA@@@EDC@AB@@BDJFB@B@DDD@BBF@D@DDF@@BABABABC@A@A@EBA@FH@FADAFA@BBAHIFGBCBICCACACAI@EB@@ABGDAAECCEGEGCCGECI@CA@@@ABC@IE@K@MFEFC@E@ADBFFDH@BDADABA@EAOCEGAC@CECGSCAC@EEEEAAG@GAABGDED@JAAEAE@CBEBQJGAA@E@KA@A@CDCDEBE@CIEKKIG@ECEECGAEAEBC@C@AA@@@D@@@D@F@DADEFALCBGC@@C@EBE@CCCCEIGKC]@KEKCGECIAAAC@CACA"],encodeOffsets:[[104816,25033]]}},{type:"Feature",id:"530124",properties:{name:"富民县",cp:[102.4976,25.221935],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@D@B@@@@A@@@B@@B@@@@BB@@@@@@@@@@A@@@@@A@@@@@A@@@@@@@@@@@@@@@@B@BB@@B@@@@@@A@@@@@@@A@@AA@@@@@@A@@@@@@A@@@AB@@@@B@@@@@@@B@A@@@@@A@@@@@@@@@A@@@AB@@A@CB@FAHGLMHKLOHCH@BCVGJG@@D@DBHBF@BBBFBDDFFB@RJ@@BABAF@@HAF@BAB@BA@@B@BBBB@D@@@BA@@DBJDFFD@D@F@H@B@D@B@@ABABABAB@D@DBB@B@BADABC@A@C@AACGGAIBIFIJDRNXbRJLD@@FHAH@BDADAB@BBD@BDB@BDDD@BDAFCD@D@FFFBJ@D@BC@EBG@CAA@AECCA@AGCEAE@AA@A@ADE@EAKCEC@CBCBE@CAAA@ADAFAFABA@CAE@CAE@E@E@CAEACCACA@@@C@CH@D@B@BEDAB@DCDAB@B@BAAAACACGKE@E@AAA@CAAAAACCC@AAA@AAAAAA@AA@ABCBABCBCBCBCBC@E@C@@@G@GGOQAAAAGAKBE@G@CG"],["@@@O@AAC@E@CA@AAA@@A@AAA@C@E@G@CBGBCBE@CBE@EBEAE@A@AGEEEGMCECBA@CDC@E@CBAD@BADC@C@EC@CDEDC@CECC@EAK@E@GAIEMEI@MBIDA@AA@CDGHCBEEAG@CCBALIHGDG@ECCKAE@E@IAAA@E@E@G@IEAG@IBC@G@GEKQGAEBCB@FFLRV@DC@GAGEKOGECBAFDFDF@LAFADC@GAGEGEIBK@E@CD@FBH@LADBBBD@BCDABABABABADAFAD@DABCBA@E@EAEACAAE@EGAEBCDCFEJQJKH@@CBC@EBE@C@C@ABABADABABEDCD@F@D@DFFDHJF@B@DBFBDBH@D@D@DADAD@@GHEFCD@B@H@HDN@D@BC@C@GCWIEAA@A@G@GFCHBZ@L@HCFGHCFEFAH@DBF@@@DBD@BBFFFBB@D@DEBKDABCDADABCBCBABAB@@@BBDDBDBFBFBBDBDAF@DADAB@B@D@DBDFJJPFDB@D@D@D@BABCDCHCBAFCBE@IHE@@LAJBFBHDD@D@D@D@FDDD@@BBDBDBFBBBDBBDCF@BFD@FCDEDAF@DDDB@DBBA@CBABDBB@DD@F@DBDFBBBBBDDBFBDBAD@XDBDAB@@AAC@ABAB@D@FABA@A@@DADADCDCFCBABADADADAB@D@B@BB@LDHJLPJLJVVHFFBHAbWHCDAB@@@B@B@BB@@B@FBBB@@@@BB@@@@BB@B@@@@BB@BBDBBBB@B@@@B@B@BB@@DB@BB@B@B@B@@BB@@@BB@B@BB@@BB@B@@@B@BB@@BDDDBBB@BB@@D@B@@@BDB@BBB@B@BA@AB@B@@@BABAB@B@BA@@DAD@@A@@DA@@BABADA@@@BB@@@@@B@BBB@B@BBBBD@BBB@@B@@@DBB@@@B@@BB@BDBBBBB@BDBB@@B@B@B@B@B@DBB@BB@@DB@BB@BBDBFDDBB@BBB@DBBBB@B@B@B@@@BBD@BBB@BBBBB@BBB@B@F@BBB@FDDBBB@BBB@@DBBBB@B@@BB@@B@DB@@BBBB@BBBDDB@B@B@B@@AB@D@B@B@BBBB@@B@B@B@BAB@@AB@@BB@@@BBBBB@B@@@FBDB@@BBDB@FFFJHHHFDFB@BBDDDBFBDBDBF@FBDBFBDBB@DBD@B@@@DCB@B@BA@GDEHEHEDG@KEMCIAE@CFCJARCJAP@HAFGFG@GDOBKAKA@@AAA@E@E@EAAAA@@I@GCAC@I@OACICUAGG@CDCHAJEJEBAJCXPRDF@HEDMFGJG@EA@@AAACAAACAEAC@@AA@A@G@E@CAACAC@ABADEBC@@@@@C@@CGCEIAMCGM@E@EAE@@GAEFMHIFE@AC@A@C@G@AA@C@C@A@EBCBCBOECAADGBC@E@C@CA@@GCCG@KEaIIMGKAEF@BAF@D@F@B@B@@@@ABABABCBC@A@MFMBM@G@CAEEAEB[FIA@@C@A@ABADAFAFAC@EEICCA@@AB@@C@A@AA@A@AB@@ABA@ABE@GE@ABAB@@QIA@EECCEAAA@AAEAG@C@CE@AECOCGE@C@@DED@@@ACACAEAA@CA@A@AAA@A@C@EBA@CBC@CBC@EACAE@ADCBABCBEBEBCBCDCFGFEBEDIBEBCDABB@@D@DDBBAD@DAD@DBD@BDBF@D@BB@B@D@@B@BANBFBJDTNBFDAB@@@BA@@B@@@@@@@@@B@@@@@B@A@@@@@@@A@@@@@BA@@B@@@@@@@@B@@@@B@@BB@@@@@@@B@@@@@@@@AA@@A@A@@@@@@@@@@@@@@B@@@@@B@@@@@B@@@@@@@@@@@AA@@@@@AA@@@B@@@@@@A@C"]],encodeOffsets:[[[105105,25880]],[[105105,25880]]]}},{type:"Feature",id:"530126",properties:{name:"石林彝族自治县",cp:[103.290536,24.771761],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCAAAAAAAAA@@@@@A@CAA@A@@AA@C@@A@@AA@A@@@AAAABADABABABCBC@CBA@A@AA@CAA@CAA@CAA@@GCEECAECUGEACAA@C@AHCBGHWF@BA@@D@B@BDBBBB@B@B@D@D@D@BBF@FBB@DBBBBBBBBDBBJHBF@DCBEBGDEDAD@DDDDFHBJDDB@@B@D@DD@BCBG@I@MBEBG@GAEAABADABCBCBE@C@@B@BFFABABA@C@EAEAE@GBEBEBKFEDEH@DEFEDEFEDA@C@EFCDE@EFEDCFC@A@CAC@C@E@@@EBEFCBADAB@DAB@BE@IDE@E@E@@DDD@BABEBCFCDEDGBEDAD@B@BCBA@G@AB@@@DDBADABABC@C@AAAECCECE@CDEFEDADCFCDCDGDA@A@CBA@CAAAAA@@@A@C@I@AB@@A@C@@@A@AC@KCCAACAEBEHCF@FAFEBCAC@@CC@A@A@A@ABA@@@E@C@ECACAE@KDEBGBC@AA@@@@AKBUCEGCEAAC@ADADAB@BCHSBKBGBE@EEIEEGCEAEAAEIEGE@C@@@CACBAB@DBB@@A@AAACAAA@CDABAF@DADC@CACCCIAC@KDEBC@I@EBCBCP@BC@CAECI@EB@D@LADABA@CAECEGACA@CACBCBCDBXBB@HBF@DAD@DBD@BBDBD@D@BHDJFFDBFBHAHAFEBIDOJEDCBA@ABEAGAE@@B@BRLDDBBABCBA@@B@BBBBFFJ@@@B@DBFBF@BBDHDDDFDDH@D@DEDCD@BBBDBD@@AB@BB@FBH@H@DABEBGD@BAB@@@@ADAB@B@@ABCHCH@LBDDD@B@B@@BDBDB@DABCD@F@HDDD@@@BABABA@A@AAA@@@@BADAD@@@BB@@B@D@B@B@D@B@B@B@BDD@@FHBHBH@F@DAFADBH@L@B@H@DDD@BBB@DCDCD@BA@@BBBDDD@@BDEF@BBBBFF@@@DAD@BCB@D@BBBBB@BBFBB@B@BCD@BBBFDDDD@DBD@JAFBD@@@NBDFDBHFDDDD@FCNEHEDEBEAEAEAEBCDBFHD@J@B@D@D@DBDBBBDDF@BBBDBDBBBB@@D@D@B@BBBB@@@JDFFJFDBDHBD@BB@FBF@BBCDADA@ABC@A@A@@@EDEFCDAFB@@BBDBFBBBBB@BVDFHDH@@@FBB@B@B@FA@AB@@@BBBBB@B@DAB@D@DBDDBBBDBH@F@BCD@D@D@D@D@DAD@D@DDD@FBJBDBJDHDDFLDH@DDHFFBBDAFAH@DBB@B@D@FAFCF@DDDBF@@A@ABADCBA@A@A@ADAD@FDRXFBD@FAJGH@FJJJAB@D@DB@B@DABAB@B@DB@@BAB@@AB@@A@@AAFEFCLIFCF@FDBLBDBBD@BABA@AB@@AB@BABA@AB@B@D@B@B@BA@ABABA@@@AA@EAAAAA@@AAA@CA@AAA@@@ABA@@BAD@@@BA@@@A@A@@@A@A@AAA@@A@@AC@A@CA@AAA@A@@@AB@B@B@B@BADAB@BAB@B@@A@@AACA@A@@@A@ABAAA@@CA@AA@@@@A@@@@A@@AA@@@@A@@@A@@B@@@B@@A@@@A@@BABA@@BA@A@A@@@@A@AAA@@AA@AA@@AB@@A@AA@@AA@A@@@AB@BAB@DA@ABA@@@ABADABADA@@BA@@B@@@B@@@BBB@DDB@@BB@@BDAB@B@B@D@AC@@ACBAHCBCBABA@A@E@AAA@@@A@A@C@E@A@EEO@ADEHCJEFABCBCDMDG@@FAFAHC@@B@FABEAM@EBAB@B@DC@@BABA@A@@@A@AAAAA@@AC@@BA@@B@B@@@BBB@B@@BB@@@B@HEBA@C@AHBBAB@D@BBD@B@FAD@D@@@@A@AB@@@@@B@B@BBB@B@B@@B@@AB@BAB@BB@@@B@B@DAB@B@B@DBBBBDBB@BBD@BBB@BDB@BFV@HFDDBD@FADC@CB@BA@ABABCB@DADAD@FIHAF@FB@HDb@DBDDLBH@BB@@BB@@@@@BDDF@@@@B@HBJ@DAD@BE@EBGDEH@H@HDTJHBJ@H@LCHCHEFEDEBGASAOCSCKBWDGHEFIFO@M@ICEIIEG@@@@BCBCBABCBADC@ABEBEDCFADA@CACCIACEOIM@A@ALK@@DEAAACAC@CACCAACCC@AAC@CAC@CAACCACCCAEAACAAC@CAAAC@@@@AA@AAA@CAAAAAAA@@CACACACAC@CBCDCDADABA@AAIEGGCECECAEAGAAAACECC@A@CDADAF@FABA@@BE@A@C@A@CACAAACACCAACCAACCACCCACA@ACAGCMIGGCGA@EACACAAA@AACACAACAA@AAA@C@CACAAACAACAAACA@@AC@AAC@C@C@E@E@C@C@C@A@A@AA@@@A@@@A@@@@AA@ABC@A@@AA@A@ABC@@@AAA@@@A@@AAAA@@@A@@AA@A@AAA@AA@@@@AAA@@@A@@@A"],encodeOffsets:[[106021,25117]]}},{type:"Feature",id:"530127",properties:{name:"嵩明县",cp:[103.036908,25.338643],childNum:1},geometry:{type:"Polygon",coordinates:["@@@FCDC@CBA@@B@@@BADABE@A@A@@C@C@ACACAAAAE@@CDABABEBADADCBIDGDADADAFCD@BAB@D@J@L@BIJKHGFIJGN@D@DDBHABIFCL@JDDBDBHFFNBR@N@L@LAFEFCDGBIAC@OEEAGBEFED@@@@CBIDCBIDAB@F@DDABB@DABEA@B@@QD]D@DGDGBKDEDEBCDAD@DBDFH@BFRFl@X@L@DAHA@@DAFCDCDG@E@E@G@EBABCFADELABELCF@FDBBBJBH@HAF@HBHBFDHBHDHDLHPJDHJRBBDDD@F@BCDCFAFADB@@CDADCDCDAFBBDBFDBBB@@BB@@@VPDDDD@F@BBFLHBB^NRFHDDBBBLFHBF@FCTYFGJCLBLDL@LAHBFBDBFLDDD@FABCB@@A@@@@@@@@@A@@@@@@@A@@@@B@@B@@@@DAA@B@@@@@BA@@@@A@B@@@@@@A@@BAAA@@B@@@@A@@@@@@BBBB@A@@@@@@@@@@NGF@B@B@DABCB@DIDQFEHEFCDGBGBABABCHAAG@AAGF@F@BBBDDD@DHFD@TJBD@FCF@B@HNJFFD@FCFADDDA@AAE@GBCD@HBL@FABABCAEMM@AD@FBF@FAFCDEDG@E@C@C@EBEBAAABCBAD@@C@AH@BAFA@ECI@C@CFCHGHGB@F@B@HBH@D@JAF@B@DDD@F@DABEBAAGLKFCFAD@FDFBF@J@FAFABC@A@@CECKCACCA@E@ABA@@@A@@@@CAC@ABADA@C@AAIEQEEECE@A@C@CGAE@E@@BAB@@ABA@ABA@ADCBG@@@AA@AAABE@E@C@ACAAC@C@C@E@@@CDAB@B@BG@EAGDCFCFEFKHEFIBG@G@EACAAFEA@CCCCCB@@ADABCAC@CCECC@C@C@C@@IGAEAKA@@@CAAD@FE@CAC@CBEDGBAEECCA@CAE@EAACAAAEGCCACAEAA@C@ABCBA@EBEBC@CAA@CAC@@@CACACAEACAC@C@A@C@A@E@@@@@ABEBEBCAAA@CBA@ACCEACACAABABADAB@@C@AA@A@C@CBC@CACACBA@AB@BCFAFABA@C@C@@ACAAAE@GACAA@C@CAAAAC@ACEAE@E@CAEACACACACAA@C@CACCCEACCAEAA@C@A@ABCBEBC@C@@@C@C@GGE@@CECGG@CBC@G@G@EC@CCCEACBCAABGNAAADCAAA@AAB@BBD@DB@BDADE@BD@FDHBD@DAF@X"],encodeOffsets:[[105539,25728]]}},{type:"Feature",id:"530128",properties:{name:"禄劝彝族苗族自治县",cp:[102.471518,25.551332],childNum:1},geometry:{type:"Polygon",coordinates:["@@DBDDBDBDBJ@PBDBBDDFDJBH@LBD@FAFCFCHENELGHANAFCFAD@L@DAB@DADCB@@@DCDCBCBEBEDKBCBEDO@C@CCCAACAGEOOMICCCCAEAGAE@KAC@@@C@CACCAAEACAC@@@A@ADAHEF@F@P@B@BA@EBADABAJEFCFCJCJCLCDAFCBCDEBC@CBE@ABADAJCHAD@DBD@DBD@FBDBNJD@D@B@@ABCBA@@FAHBFBBBFBDDDFBHBD@J@D@J@DABBB@B@DBB@DJNFHPLBDBDAB@DBBDBDBDBD@PBHBD@BDDBBDDDFNFFHFDBDBDBDDD@FBHBLBBBD@@@@A@AB@BAB@@C@@BA@@@ACA@@CC@AAA@A@A@AAA@A@@@AAAA@@@@AAC@A@A@A@@BA@@@AA@AC@A@@@A@AB@@A@A@@AA@@AA@AB@@ABA@A@AB@@A@AB@BC@@@A@@A@BA@@BADCBA@A@A@@A@@A@@@@BC@CBC@@@A@@@A@@B@BAD@@@B@@A@@AACCAA@A@A@@@ABA@A@AA@AA@A@A@A@AAA@@AAA@A@BA@AB@@@BAD@BAB@B@DAD@BAB@DAB@BADADADCBABABADABAF@B@D@B@B@DAD@B@D@DADAB@DABABA@@DA@@BABABABAD@HCBABABABCBCBEBA@@@CBABA@C@CBAACBA@@@C@AAAAC@C@A@A@A@A@CBAAC@CAAAA@A@C@ACG@EECAC@ABGDGBCBIBAAGAEEK@E@GFC@EAEAEAC@AAAACAE@CBADGJGLCJCDBDH@DDBDBFAF@F@F@NCFC@AAC@CAC@CDCBEDG@ABCBAH@DCBEDGDAFEDEAEBCDOBC@C@@@EAC@CCACCCCECEAAAGA@@EC@A@AAAAAAAC@@@@EAACE@@ACACCGCEAECE@AAG@C@AF@DBFE@ABABA@AAC@CDABA@ECCACACCCEACBE@A@CCD@HAFGBEBAB@B@B@BF@DDAD@BA@CAAAACAAA@AAADABC@@@C@CAAA@AAAADAF@BA@@BGBC@A@CBBDBBABCD@DAB@@CCA@@AAAC@ACBC@ADA@EB@CA@E@ACA@ABACCACBA@A@AGBGEICGAC@A@CBA@CAACAACAAAAACAAAEEECGAEEGGECECAC@A@C@EBEBG@E@EA@@@CDCBA@A@CAC@CAG@C@G@EBCBCFCBABA@C@A@EACAGAGAA@@EDABC@CBEBG@A@@@@C@ABABCAAAABAB@FCL@DAB@AEACCCA@GA@AA@CIGG@C@ABE@ABADCBC@CBC@A@C@ABCDADABA@AAABA@ABAB@BA@AA@AACACAA@EAA@E@GBEAK@CACCCCCAAAGEBCAECBEDCDADCBG@KCGEAA@E@ACCGKACBC@A@ACACAAEEGIGKG@@@CAACAC@CAE@G@E@GAC@C@AC@CAC@CGDABE@AABABCFAFC@AAA@ABABABA@AAAAA@@ACCAMAKAEAAC@E@EDGDCDIAGECAA@@ACAAAAAA@@@@AA@E@ADABC@CBE@A@AA@C@CAA@CAEACAEAE@CACAEACACCAAA@CEGEGGEIEEA@AC@AA@AC@E@@@AAAAA@A@@AA@@BA@@BA@A@A@A@AA@AA@A@A@A@CBA@@@A@A@ACAACAAA@AA@AA@@C@AA@@AA@A@AACA@@AA@AAACAECA@AAE@A@A@AAA@AAAAA@AAC@AA@@A@A@A@A@AACAA@AAA@CAECCAAAA@@ACA@@AAA@CAA@A@A@A@A@@@AAACA@AAAAACA@@AA@@@A@CA@@A@@@AA@AACAA@A@AAA@A@A@@@@AA@@B@BCBA@AB@@CB@@@BC@CB@@A@ABABA@A@@@ABAB@@A@AAA@ACA@A@@@A@CA@@AAACACC@AA@@A@A@@@AAA@@AAA@A@@A@@AA@@@A@A@AAAA@@CA@@A@A@A@@@AAAAAAC@AAA@@@@@AAA@@@@AA@@@@AAEAA@@@AAA@A@@@A@CBGDaXGBEAGEUUKIOIIKCG@KAAA@C@A@CBCBCBABABEDCDCDCBCB@@@BABEBC@A@AB@BBD@BA@CBDHDFDFHDBD@@ADADAF@@@D@B@@B@@BB@H@JABA@@@BB@AHAJBJFRCRADCHABCBCBIFGBCFADAB@DBB@DBD@DAF@DBFBDBB@D@B@BABCBC@E@CBCBCDCDAB@D@B@BBDBDADA@@D@B@@BBBD@FAD@DBBJF@FBHBDAD@B@D@F@BABCBE@EAEAE@CAAACCCCCAA@ABABADCDAD@BAD@DADAB@BA@ABC@CAC@CACBCBCBCDCFCB@DAD@D@DAD@BCBA@EBE@E@C@CB@BAB@DAF@F@FAF@FCDADCBC@C@C@AAABA@@B@B@DBDBFBD@DDBBDFFBDDFDDBD@B@DAD@F@B@B@F@D@HDJDFBF@B@BIJKLMJ@@@@ABEFKLABAD@DABABABAB@@@B@D@J@@@D@D@@@B@DBBDBFDDBBD@B@D@FAP@L@B@F@FDJ@B@@DDBBD@NBF@FBBD@@CDCHAJBHGABF@BBB@@DFBDBF@B@BDHCRGHCDIHKFIF@BGDIBK@E@E@C@IJ@FBNER@NGH@LDFDDHJF@J@BBKFCDCF@DCD@J@JBDHDLDDDBF@FCFEDMDIDCDALBHFDADADABAD@B@B@@BDBDBDDBBDBBBBBDBDDFBFBDBDADBBAD@D@B@DABADAB@BBD@BFD@BBB@@@BAB@D@BBBFDBDDFBFAB@BAHBB@BBBBD@F@@@DAB@B@BBBB@BBDF@D@FABABABBBD@BBD@B@BBADGHAF@H@DCDA@@BC@@BEBABCBABEBA@A@A@C@AAA@CBCBABEBABC@A@ABAD@DAB@DADCBABABCBABCB@@ABADABABCBCBC@CBC@CBCBABADABABCBA@C@ABA@A@AB@@CBABC@ABCB@BA@@@AB@@A@A@@@ABBB@@BBBBB@@B@@A@@D@DBD@F@@@@@D@BCBADADCBCBABCBABABABAB@@@B@B@D@D@D@BADABAB@D@BADABABABADCB@BA@AB@B@B@B@@@@BB@@DBDDBDBFBF@H@J@JBDBDBBFBDBDBBBDBD@DBF@FAF@H@H@J@F@B@FBJBHFFBDBH@H@D@B@BB@BBDBBB@HDDBHBDBDBB@B@B@FDFDDBDBB@D@DBDBDDDDBBHJBDDBDFFHBDDDFFBBDB@BBBDFBDDFBF@DBBJJHDBBD@J@D@DBFBH@DBD@LAF@J@@@F@F@FBD@DBBBB@FBDBFBHDFBFBBBDBFBBBD@B@FDFBPFDBDDDBDBBBD@BAF@F@DBDBD@BBB@B@LDJBFBLDHDFB@@"],encodeOffsets:[[104999,26968]]}},{type:"Feature",id:"530125",properties:{name:"宜良县",cp:[103.141603,24.919839],childNum:1},geometry:{type:"Polygon",coordinates:["@@CBEAEACCKWCC@BABEDABC@C@CAA@AAA@AAA@@@EEACAA@@CA@BAB@D@BAD@B@@BD@@AB@D@@BF@B@@BB@BBBBD@B@B@B@@@B@B@D@BBF@BBD@DAB@B@B@L@BBB@B@@@BAB@B@D@@BBBB@@@@@B@BA@ABA@@@A@A@CACACAA@A@A@AAAAAAA@AA@@A@A@A@AAAAAAAA@@AA@AA@A@E@C@A@@BCBCBA@ABA@AAA@A@@@CA@@ABA@A@A@C@A@EAC@@@A@A@@@A@@@AB@@@@@B@@@BA@AB@@@@AB@B@@@BAB@@@BA@@BAB@@@@@B@@A@@BC@A@@@@@ABB@@B@BB@@B@B@B@B@B@@AB@@ABA@@B@@@@BB@@@BB@BBBDBB@@@B@F@@@BA@@@AB@@AD@B@@BB@B@B@@@BA@BB@B@BB@@B@B@@AB@@CBA@@@@B@@@B@BAB@@A@A@A@@@@BBB@B@B@B@@@B@@ABA@AB@B@D@B@@@@B@B@@@B@@B@BAB@@@D@B@B@B@B@B@B@@AD@B@@@BAD@BAB@BA@@B@B@D@B@BBB@@@B@B@B@@@B@B@B@B@B@@BB@B@@BB@@@BB@@BAD@BBB@BBBBB@@@BAB@@@B@@@@DJ@@@BBD@D@B@D@D@BB@@DDD@BBB@BB@@B@B@@@B@B@B@B@@@BBBBBBBB@D@FB@@BB@B@B@B@@@BB@@@BB@@BB@BBB@B@@B@@B@B@B@BB@@B@BBB@@@@@BBB@@@BBD@B@B@B@@@@@B@@@@@BB@BBBB@@@B@B@@@B@@@B@@@@@BB@BB@@@@@D@B@B@BB@@BBB@BB@BD@@@B@@BB@B@B@B@@B@@BDD@BABCFADC@CDCBC@@@C@E@E@CAA@CDABA@@BABEFABABA@A@CAACACCECCAAEEGGCBCBEDCBEFAJADBBBF@D@D@F@H@FBFBD@D@@AFDBHBDD@H@HAF@@@DBDBD@DBD@BBBBD@DAB@B@BAB@B@BABABAB@B@BBBDDBB@BBHBH@D@B@B@D@BBD@DBD@B@DAB@BABADAF@DAH@BADADABABAD@DAFCHGHIFCJ@HEHCH@@A@ADABAD@@AF@F@@@DCBC@IKICAACCCCA@AD@F@D@@@NFXTXFL@HAFABEFEDCJ@@DB@@DBDDFFDFDFDD@BDBBBD@BB@D@B@@@BAB@DBD@B@DBDBBBDDBDDBBDFBFBD@DHD@D@DEHEF@BBJFJDH@DCDAF@BBBBBB@B@@@BCDCLABBBB@@BBBDBD@D@B@D@D@BBBBBDBBBBB@BBD@FBD@DBB@D@@BH@@ABC@A@ABAB@D@BB@FCD@DABB@@@BBBBB@D@B@D@B@NCHAD@@BFBBDBDB@B@D@DB@B@F@BABC@A@@@AB@DAD@DC@E@WBE@CACCG@EACF@BCACA@@CAC@ABA@BBBDBBCBBHMBADBDAFBDD@DFDH@H@D@DAH@DHDF@@HF@H@D@D@@@DADAFAD@B@B@DBBBFDDFBDDBD@D@DBBBDBDBDBDBF@D@FBFDF@BBDBBDBD@B@DBHBF@BBDB@BD@D@B@BABEDE@ABAB@DADBDBD@DAD@B@B@@B@DA@CBABABBBBDBDDFBDB@DAB@BBADAFAF@B@@@@@F@B@D@B@DBDBDBFBDBD@D@@BD@DBB@DADAF@FABAD@B@DBBBFBDDDFHBBDBBB@FBF@DDBFDBFHAFCDAD@DBF@@EBCDB@@B@BLBFJH@@@D@D@DDDDF@DBDADCB@BA@DDDD@DFBBEDBFBH@H@JAFELGFEDEDEHCFBH@@A@ABADC@@AE@CACAEAAACAC@C@CAAACACCCAEACAC@AACAG@CBA@@@@@@B@@@@@B@@@B@@@@@B@@@@@@@B@@@B@@@@@@A@@BA@@B@@@B@@@@@@@B@@@@@B@B@BA@@@@@@B@@A@@@@@@BAB@@@@@B@@@@B@@@@@@B@@@@@@@FADA@@BCDABABBDB@BFADAD@HCA@@@@@@@AA@@@@A@@@@A@@B@@A@@@@@@@@@@AA@@@@@@@@@@@A@@BE@@@@@A@@A@AA@@A@@@A@AA@@@@@A@@@@AA@@@@AA@@AAA@@@@@@A@@@@@A@@@A@@@@@@BA@@@@@A@@@A@@@@@AD@F@DADCBABADAB@DBB@B@DADE@EBK@CBC@AACCCCG@GFEHCJCHGD@@BBD@@@BBDADDHAF@F@D@FBBBBB@FFH@DBFALCBA@@@@P@@AB@@@BABDB@BBBAB@J@DABB@BD@DBDAFCHCDGBAFGFCBAB@@D@D@@B@@B@@B@B@JEB@B@BD@BABAD@@@B@@@BD@BB@@@@@@ABABCBABBBDD@D@DBH@F@BBB@@@DHDHBBFDFDBH@DCBAB@FADCD@BAB@@FHBF@BCBCBCF@DAD@D@BBBA@AFAB@DDB@BBB@@@@C@ABEBCBA@ADKEGAC@CAAACAA@@DCJGLEHG@CACKE@@@EAEAECABCAABCBC@@DCD@L@HAHEJIDE@G@IEGA@@ACAC@A@ABCBE@EBCAAAECCACAAAACACCAAACCAAAA@AAAEAGBGBK@C@IAWEKGOK@I@K@GBCFCN@LEB@BAFCBCBCDAFCFCDCBE@AEACA@A@ADABAFCF@DA@A@C@E@ABADC@@BAB@B@BAFAFC@AAA@CDAFE@EDCAADA@ABC@MBABADAD@D@B@@A@@CC@@@@DC@@@A@@AAC@CACAECCC@IBC@E@EAA@A@A@C@ABA@CA@A@A@@AAAACACACAAA@A@A@AA@AA@CAC@@@C@C@@@ABC@A@A@A@CB@AA@@AA@CCA@AA@@A@@@A@@@AB@@CBABCBAB@B@@AB@BCBA@ABA@@B@@@BBB@@BBB@@@BA@@BBB@@BB@BBB@@@@@@B@BAB@@ABAB@@@B@@@BA@@@A@@@@B@@@B@@B@@BB@@@@@@B@@B@@BDB@@BBAB@B@B@@@BDBBB@@@BA@A@ABA@CBABA@A@A@A@@B@@@BBB@BDBB@D@@BB@@@BB@B@B@B@@@B@B@@AB@@C@AB@@AB@B@@BB@BDBB@BB@@BBBBFBB@@B@@ABAB@BABA@A@C@A@A@@BABABA@@BA@@BABABC@AAACAKECE@EDKJEDEFBB@@@BA@@BA@AB@@CAA@A@ABCBA@A@@C@CBAIIEIG@IHEBC@EAQWECC@CB@B@B@BABCDAB@B@BE@CACCE@EDEBC@A@A@CAG@EBCBAAEECG@CCGEKCCCGAIACAI@ECC@C@CBC@C@C@C@C@CDC@A@EAGACAACCCAC@A@CBA@A@AAAA@@A@@BEBA@A@A@EA@@G@GCCEAUA@AAAAAEAC@AA@BEDCFEFC@@B@B@D@BAB@BCDCAAE@EAA@@AACCGCAIEEEIC@@A@AA@A@A@C@CA@AACACAAA@ACEACAAAC@C@C@C@A@IGCAEDCFAFBFBFBFAFCFGDM@ECCCCGECACEMA@@C@EAIBC@CAC@CCECAA@ADC@A@AAAAE@AAAAA@A@CDA@ABC@C@@EEAAAAE@CF@AC@CCAA@AB@@ADCDC@CAA@ACC@C@G@A@KAGBCBE@C@EAGAGEG@@CC@A@A@A@A@C@A@A@C@AA@@A@@BCBC@A@@B@BBB@B@BABA@A@@CCGCE@C@ADCBA@ACAC@@@A@ACCAC@KDGDGBA@@@ABABC@@@@BA@AHCFABA@C@GAG@EAAA@@BC@CAAA@ADCFC@C@CCGECCCGCAC@AAEAE@C@A@@EIAEAA@A@AB@DABAAACCQK@A@AF@HBFBBAB@DAFCPIJCFABEBGAGAEECIEGC@A@CACAC@AAC@CBC@CAE@GAAAWG@CE@CACCACBCDCBAC@ABCFC@AAACAC@ABEFCPILGB"],encodeOffsets:[[105625,25118]]}},{type:"Feature",id:"530129",properties:{name:"寻甸回族彝族自治县",cp:[103.256615,25.558201],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCEBEDC@EEMI@G@ADE@EACSIC@GE@CCCACAAE@E@BH@BBHGBADABABAHCHEDGFEFCRCJA@ADCBA@A@E@MH@@@@@@@@@@@BAAAA@@@@@@@B@@A@@@BBAB@@@B@@@@A@B@@@@@AB@@@@A@B@CB@@@@@AA@@@@@@B@@@@@@@B@@@@@@@@@BA@ADEBC@CCEKCAEAGAKBK@KCKAIDEHSZEDE@GAKEGAGDGBCA@AGACBAAC@A@GHEHCDGDKDABCAA@CBAA@AA@CBACBEAAACECC@CACCEEIAECGAUCMEG@EDADABCBA@C@C@EDA@CBCACAAC@CA@ADA@A@@B@B@BA@AAAACGA@EBC@C@A@AACBC@K@E@A@A@A@@H@JCHIFCAWEMAEAMCWGMAECACACGCG@G@IAECACAEA@@@A@@DA@@@C@A@@A@A@CAAG@E@C@CAACABA@CDC@AB@DDDDDHDFDADCBEAECC@A@CDAB@DDDBB@D@@ADCB@@C@@AA@@@ADCFAB@BBDBDDBF@H@B@B@@BD@FBDBBBDBBB@BB@@FIHEHCNGFE@QCWOIDABIFIFGBCD@DHHVBJDBD@P@JBDHDJ@@@BBBB@F@F@FBB@BB@BLALCP@HEHEHGBO@IBQDIBED@DBFDJFN@LCHGFGFCF@HABA@A@CD@@@B@BAF@DADCB@B@FBB@@@@BBBBBBBD@@BBFDBHCJCDCH@F@FBDFBLBNBDBBD@@BBBB@BABABAB@BBB@BEDEBADABBBF@BAHC@DBD@DBDD@D@HBF@H@F@DBD@DBBB@D@@LHJHFHBFDBDB@B@BADBDHLDD@B@FBBHFLDH@DABCDCFCDABFADHFBBDBDDDDDBL@FBHAF@B@FBB@DBDBBBB@@BABA@AB@BABBB@BABCBCBAD@B@D@BAD@DADCDAB@BAF@B@DHHDJB@@BHBB@DDBDBFA@CBK@EDA@ABBBBBADAB@B@D@@B@H@FADAD@BAFC@@BBBHBHBD@F@B@DABABEDADAD@F@H@DBH@DBD@D@BABCD@D@@FBF@H@FAFAD@B@D@DBDFHFFHBFDHFFBFBBBDBBBBBDDBBB@DAB@D@BBDDHFJAHBHB@B@DADBBDBAB@BDF@B@@DFAB@BCD@DA@BBDBB@@DB@DA@CBC@ADABCAAA@D@BADAH@@ABE@CBBBBBB@BB@D@D@@ADCBBB@BBBDBBBBB@DABC@CB@CAEA@A@A@ABAFEHGBC@DDB@F@DAFBDDBDBDDD@FABCB@DBD@BABAB@BEFCAE@@B@DBH@BDFBFDFDHBDBD@@DFBB@F@@D@BBBBBB@B@BFD@@HBBBFBFDDDDDDB@DBD@F@@B@BA@@B@B@BAB@@ABABABABA@@BCBAB@@@BA@A@C@ABABABA@AB@@A@@@ADAB@@A@@@C@C@CBEBEDCF@DAD@B@DBBBBBBDBBDBDBFBB@FBDBBDBBDBFBF@FBB@BBBBBBB@BAD@BA@AF@BC@EBE@AJAFABCBAAC@@@AAC@EDCF@HDF@B@@CFENGDC@@BADEBGBGBA@AB@@AD@D@L@B@@AFA@CAEGG@G@CCCABA@AA@A@@@C@@AA@ACAAAAEACAAACAAAAC@AACAC@AC@A@ADCFCDADAF@B@DADAB@@AFCF@DF@HDDF@J@HDDBH@@@@ABED@H@BFFB@@B@D@B@B@HKBAD@FHJPBBH@@ABC@ABAB@@@B@B@BB@@DBBBDDBD@D@DB@@F@B@B@DBBBFBB@D@DBBBDH@B@DE@OBEFCJ@PL@FA@ABC@G@G@MBE@GBI@AAA@EACCI@A@EBKFI@@BA@CBE@EBG@C@EAC@ACAAA@AGC@E@IDCBEBOCECECAE@CA@C@E@CECAAAAAGBG@@@@BADABA@@@A@AECEEAABABE@@BALCR@NAREP@DBBBBBBBB@DDFBD@DDFBBBFBDBD@D@HCFADADD@DEBAB@BB@BBDBD@DABBB@DD@@DD@B@D@DBBDBB@DF@DHAFAD@DBHDD@H@B@AFBDDBD@ABBBBDBD@B@BCBED@@AB@@@BDDB@BD@@DBDD@ADFBFJ@D@DAACBCBABDDABCFBDDDBHDDDBB@DABEFAB@BDBF@HBH@NAD@BABABADADABABEBCHAHDLJHDFBBBJ@F@D@B@@C@ACEAAAC@CAA@C@ELILEHCRAB@F@JBFDJHLFJHFJBD@@@@@BAFBDFBBBBD@DB@D@J@D@D@BDDD@@FAFCBC@ADADA@E@EBC@GBC@G@CACCCAACCAEAC@CDEDCBADADAD@BB@@@@@@@@@BB@@@@@B@@@@@@@@@@B@@@@@@B@@BB@@@@B@@B@@@@@@@@BB@@@@@@@BB@@@@B@@@@@BB@@@@B@@B@@BBB@@B@@@@B@@@@@B@@@B@@@@B@AB@@@@@B@@@@@@AB@@@@AB@B@@@BA@@DBAFAB@F@BB@DCDABK@@@@BCBABB@AB@AE@A@AAC@@@A@ABABCBA@@DA@@B@@@B@BAB@@@@@B@@B@@B@B@@@B@@BB@B@BB@@@@B@@B@@@@BB@@@@@BB@@@@BB@B@@@B@B@DB@@@@B@@B@@@@@BB@@B@B@@@@B@@@B@BB@@B@B@B@@@B@B@@@B@B@BAB@@AB@BCBABA@A@A@A@A@AAACC@@AAA@A@@AA@@A@@@A@A@@BA@AB@BAB@DABABA@@BADC@@BA@@BA@ABA@@@@BA@@@@BA@@@A@@BA@A@A@A@AA@@A@@AA@@AAA@AA@AA@@AA@@@AA@@@@@@AA@@A@@AA@@AA@@A@@A@@@@A@@@@@A@AA@@@@AA@A@@@A@A@@@C@A@A@A@A@@@A@@AA@@@CCAAA@@AAA@@@ACCA@@AAAA@A@@@A@A@@@A@@@AA@@@A@@@A@@@A@@@AA@@A@@AAA@@@AA@AA@@A@@BA@A@A@@@ABA@A@@@ABA@ABADABAB@@@DABABA@@B@@@@ABC@@BA@@@AB@BA@ABA@@BA@@@A@@@A@@@CGCEGBEBA@CAAG@EAEACC@AAAAAA@@ABADABA@C@A@AC@@AA@BADCDC@ABC@CACAAAACAAAAG@ACCAAAEACEECA@@AAEAAACAAAAEAC@CBCBCBA@A@CACCCAC@A@C@CBC@CFCFCHCDABI@IB@BAD@B@DAB@D@BBDBDBDDB@BBD@BADABABABABABA@AAC@A@A@AD@B@D@BABABA@CBCBABCBABABA@@BAC@A@GAECCEAA@ABE@A@@@@C@E@G@G@CDE@A@CACCCECCCC@@CAA@@AAAC@C@CCCAGCCAC@AA@@G@I@GBGBI@EHKDE@G@GFGJABINGDI@E@EGCGBCFCFCFIDIFEBEAEKOMMAGBIHCDA@@BABCDADCDA@A@C@C@@GG@G@EACGC@CBC@GACAAEAEDEFGBGBE@CCCIEAC@CBCJA@A@C@ABEBCBCAAAACCACAC@A@@B@F@BA@AAAA@CACAA@@AA@@A@ABA@@@@A@@@@@ABC@C@C@@AAA@A@CA@@@ADADA@A@A@CAABABABADA@ADCBCDE@CCAAACAAAAAAAC@A@AACA@ACCCAAACACA@A@C@C@A@AAAAAAAACA@AAA@@AC@AAC@AAECC@CCCAEACCEAKEGCCAECAAAACCAAAC@ABC@CAAAAAACAAA@@AA@C@C@A@A@CA@ACAAACCC@@AEAEGAECAC@A@ABAAC@C@AACAC@ECE@CACIBC@G@GAA@E@A@GHGHED@D@DDJ@FEBABG@@B@DC@ABADBBABAF@F@D@D@FCHCFEDEBE@EAC@@BNNBFADABEBK@GAC@AD@HBF@BCB"],encodeOffsets:[[105680,26028]]}},{type:"Feature",id:"530181",properties:{name:"安宁市",cp:[102.478494,24.919493],childNum:2},geometry:{type:"MultiPolygon",
+coordinates:[["@@B@@B@@@BBB@@BB@BBB@@A@@@@BA@@@@@@B@@@@A@@BA@@@@@@@@@@BB@@@BB@@@@@BB@@@A@@@A@@B@@A@@@@B@@@@@@@B@@@@B@@B@@@@@B@@@@A@@@@BB@@@@@@@BBB@@@@@@BB@@B@BBB@@DBB@@@@BA@@@@B@@@@@@@@@BAB@B@@@@@B@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@A@@@@BA@@@@D@B@BBA@@@@BA@@B@@A@BB@B@@@B@AAB@BB@@@@A@@B@@@@@@@@B@@@B@@@@@B@@B@@@@@@AB@@@@EA@B@@A@@B@@@@@@B@@@@@B@@@@@B@B@@@@@B@B@@@@BCB@@ABBDL@DDDB@BD@B@D@D@ADBDBD@DC@DDDDD@FFBDDFBFABMAEAA@GBFB@DB@@BA@EECA@B@D@@ADA@AAAD@@AB@@@@@B@B@@A@@B@B@B@BBD@B@@BB@B@DABB@BA@@B@@A@A@AFFB@@FEFBBAJA@AFCBBDBDDDD@ADAD@@@DDCF@DHLFD@@@DADAFAD@DABABADADAFAB@B@BBBBDD@@FFDDB@DABA@ADBF@@@@AACAE@ADADE@GDG@A@@B@DEDA@@BA@@BAHEHCN@HCFG@EFGBIA@A@CBA@GFC@A@@A@C@CBAD@DADABC@AACACAGEIBG@EFAHABC@CCEBE@A@@ACECAC@CBA@@@ABAB@B@B@DBDADABABAACBA@ECIGGKGIGEG@GE@CACCGACACCACCAE@EAC@CBCDED@FAF@BABCBGBCBA@AACAC@A@EBCBABEBGDEDEDCD@BCBA@ABC@AAE@E@AAC@CAA@@AAC@EACAAECEAGCA@BBA@@B@BBB@B@DAB@B@B@BB@DBD@@@"],["@@GAEGAE@E@EAAAAAAA@C@A@CAEAA@A@A@A@@AIAIAGBCDGJMJOFODIHCJAFCDIJ@@EH@@GFDD@BHCDADAJBHDHRBFLHDbGJALAVCJBFCHIJOTKVBFBDL@N@HBBBBBDDBBABCF@@@BABEFAVCNHDDLFDBH@FGFAHHJBP@DDV@F@B@J@DBDFF@DADGJAB@BAD@B@H@BBB@@DD@B@BADABC@CDABAB@D@D@B@@@@@B@@@B@BAB@BBB@B@@@@@@@BAB@B@B@@@BB@@@@BAD@@DBPB@@@H@LELEHCB@@B@@B@@@@B@@@@@@@@B@@@@@@@B@@B@B@@@@@@B@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@BB@@@@@@@@@B@@@@@A@@B@@@@@@BB@@@@@@@@@@B@@@B@@@@B@@@@AB@@@@@B@@@@B@@@ADCJBDDNEBEBCF@DBBF@FD@BCDEDCDBHDJBD@BCDCBA@@@G@G@AACAC@CDGFEBADCDAHAFCJ@HDFFHBF@B@DCFGBG@ICSMGCIBIC@D@@EDCF@F@DDNLR@F@@DDDDBBBBB@BDBBBBBD@D@@BDBB@BBD@@@BBDBB@BB@@BDBA@AB@B@BAD@BBDBB@@BB@BAL@@@@EBCBE@G@EBCBCFADBFDD@B@BABABA@C@CAGAACA@GAEAICEAC@EBEBEFAB@BABAD@D@B@FABEFADEDCDAB@DDDBFBF@D@F@DA@BFAFCDEBEFCHAF@D@B@BFFBDBFBFB@BDHFFDDF@D@B@FBBDBDBDAD@D@D@B@BC@CAC@C@C@C@CAA@CACBABCDCDCDAD@D@FDB@D@NCNIBADC@A@A@EEMAEBAHEN@VDZLRND@FBF@BFDDDBFDFDDFDDHHDB@@B@D@FADADABCNAJ@FAB@D@DBF@FADCD@DBADFHDDDBBDAD@DBFBB@BBDAD@DAD@FDFBFDDBBBBD@DB@@B@DCBCBCBG@C@C@C@CBAAEBIBGHGF@DDFFD@F@DAFCDCBAF@FBHBFBFAF@D@D@@BB@@@@@@@@@B@@B@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@A@@B@AGGY@K@@BA@@DC@@DEDAJADCDEHGHADEBECE@AAABG@AEAA@AA@C@A@A@@DAFAHADADAD@DBBBB@DADABADCDAB@BAD@D@B@@BDBBDBBDBF@F@F@FAD@DABADCBAD@DBBDBBDBB@B@D@BABC@A@AAABA@@BCBAD@F@BA@CBC@C@A@ABC@AB@BAJI@C@@BCBCC@CCACACDABEB@BIAAFE@EA@EE@B@B@BA@@@ABA@BA@C@AAA@@@AAC@A@A@A@AB@@@@A@A@@@@BA@@BCBBB@BC@@@C@ADBFFB@@AA@@CEAHAB@FBNBBAAECEACEEC@CCCCD@@CACACBCC@C@A@C@@ACACCK@ACBA@@DA@A@@A@A@@@@@A@A@@@@@A@@@@@A@@@@@@@@AB@@@@AFB@@@@BA@@@@@@@AA@@@@@A@@@A@@@@@@@@@@AB@@@@@AAA@BBA@@@A@A@@A@BA@@@AB@@@@AB@A@A@C@@B@@A@@B@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@A@@@@@ABA@A@@@@@@@@@A@@B@@A@@A@CA@@AA@A@AA@@A@@@@A@AA@@@@@@A@@A@@B@@@@@@A@@@@@AA@@@@@@A@@@@@@@A@@B@@@@AB@@@B@@@A@@A@@@@AA@@A@@A@@@@@@@@B@@AB@@@@@@A@@@@B@@A@@B@@@AA@AAA@@AA@A@@@AA@@B@@@@C@C@CA@AACAC@AAAA@C@A@A@@ABAB@B@@@D@B@F@@A@AB@AAE@AACAAAIAK@CAE@ECCA@E@EBCBA@CBC@A@CACACAE@E@@@@@C@@@@@A@@@@AECG@A@ABE@ABGBE@@@A@AKGEECGAGQGGCSMASLGBGGWBEFAFGIC@ABACGGCGGCCAEIG@A@ADAD@BAAEKGICKCBILEB@@AH@F@HDF@D@DABGDIEIEA@@IAEACC@ABAD@JBB@DCBEFGB@@ADE@@J@DABGB[FEHEDE@ICGIKCKIE@ACACAEAA@G@C@CAGBIBICKEOIAGCIBIFIECCGGGCCMCU@SS@AA@AACAC@CAC@CBA@C@A@CI"]],encodeOffsets:[[[104968,25457]],[[104809,25125]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("丽江",{type:"FeatureCollection",features:[{type:"Feature",id:"530702",properties:{name:"古城区",cp:[100.225784,26.876927],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ACCAACAC@CAA@@AAA@@AAA@AAC@C@AAA@@AAAAAAA@@E@I@C@@@ADABA@A@CAAACAAAC@AAA@AB@B@B@BBBBD@D@BABABC@CBC@E@E@C@C@A@@BADA@ADABCB@@AB@DBB@DAB@DAB@BADCDEDDdFnDHPRDF@F@FAF@F@HLPTFVBTAVAF@HADBD@FFJHLPLJHHFDDBJ@D@L@TBJ@DB@H@JCjDNDDBDLLBDFAJAB@TELAH@DBBDFJF`Jh@HCDEFBFC@UGAAGAOCGACHAFAB@B@BABAB@BABAB@DADA@@B@@AD@BAF@HC@EA@@A@@@C@A@C@A@E@Q@C@EBCJENGJADKTKPERCXDNN\\HFHBPERIVGDAD@PCLAJ@NAZ@T@H@@@HBHDBH@F@HAJ@LBLJVL\\@FFT@@BB@B@B@BB@@B@B@@BB@@@B@B@D@@@B@B@@@B@@@BBBABB@@@@B@B@BB@@B@@@B@@AB@@@B@BBB@B@B@B@@@B@B@BBB@@@B@D@B@B@D@BAD@BA@@DAB@@@D@@@B@B@@@@@B@@@B@@@BAB@B@@@B@@@B@B@@@B@@@D@B@@@@B@B@@B@BB@AB@@@B@B@B@BAB@B@@@B@@@B@B@@@B@BBB@BBB@BB@@B@@BB@@BB@BB@B@B@B@BA@@@AB@@@B@B@D@B@B@B@DAD@D@B@BBB@B@B@BA@@B@@@B@BAB@D@@@B@BABABC@@@AB@BABC@@@EAA@C@@@A@AAA@@B@BE@ABA@A@@@AB@@C@C@@BG@@@A@@@A@@B@@A@A@C@@@@BAD@B@@BB@@BBB@B@@AB@B@@AB@@@B@B@@@@@B@FBF@@@@D@BBD@BBB@BBB@B@@@BBB@@@B@B@B@BAB@BAB@BADAB@BAB@B@BAB@DBBBAD@DEDEDCBC@E@E@A@A@@@@B@@AB@@@BAB@@@BA@@B@@@B@B@@@BAB@@AB@@@B@@@B@DBBBBB@@B@@@B@B@BBB@@@B@B@@BB@B@@AB@BCB@@AB@@@@@@@D@B@B@@@@@B@@@BAB@B@B@D@B@@BBBBBBB@BB@BBB@@@B@B@@AD@@@B@B@@@B@B@B@B@@AB@B@B@BAB@BAB@BABAB@@CBABA@ABAB@@ABA@@BA@@BABAD@@AB@@@B@@@B@B@BBDBB@@@B@B@B@@@B@BBB@B@BBDBD@@B@@@@BB@@BDD@BB@@B@@@@@@BBBDB@@B@@BB@@BBBB@@@@@B@BBB@B@@@B@DABAD@B@@A@ABA@A@EB@@ABA@@@@@@@@B@D@B@@B@B@BB@@B@@@BBBBBBB@@BB@@BBB@B@@@BAB@@@B@B@BBD@@@B@@B@B@B@D@B@B@B@@@@@@@B@BA@@F@B@B@BBB@B@@@B@B@@@B@B@DA@@B@@BD@BBDBB@B@@@@BB@@@@@B@@@@@BA@AB@@A@AB@@AB@@AB@B@B@B@B@BAB@@@B@@@BBB@BB@@BB@B@@@B@@@@@BCBE@ABABBB@BB@B@HCDAD@D@DABABA@A@A@@@A@@@@BA@@BA@A@@B@B@@A@@@@@@BA@@BA@@BA@AD@@AB@B@B@@@B@BAB@@A@@@@@@B@@AB@B@D@B@D@BA@@B@@@@@B@@A@@BABA@A@@B@@@@AB@@@B@BA@@@ABA@@@@B@BA@@BA@@B@@A@@@@@@AC@A@AA@@A@@@A@A@ABA@@BA@@@A@@@A@@@AB@@A@@AA@@B@@A@ABA@CBCAA@AAC@CBABADABCHCB@DADABAB@BC@C@A@AACBA@A@E@E@ABADCDADADAD@FADABADABCBA@A@CACAECCAE@C@@@E@ADEBEDADEBABCBA@A@A@CAE@ACA@@@AAAACAAAAECCAECA@CACAEAA@@AAA@AAA@@AA@CBABCBA@C@CBCAACCAA@A@AAAAAAAAC@C@A@E@C@CAA@AA@@AACAAAAAACCCAC@C@C@AAC@@A@E@C@E@E@AAG@E@ADCD@DADCDCBA@CAC@CBGBG@EBABE@CBCBADCBABA@C@C@CAA@C@@AA@AAA@C@ABCBEBEBCBG@A@C@AAEAAACAAAEAA@A@C@ACEACAAAGCGAC@A@A@C@ABA@CBABCBC@GBE@CAGBC@C@E@AACCCACACACAA@C@ABC@CBA@CAC@CAG@EACBE@CBCBCBCBE@A@C@C@CAAACAACCCAIAE@EAG@E@CACBEBCBCBC@ABA@AAA@CAAC@@@AACAAAAAAAAECAACAGACAA@EAAA@A@ACAAAA@CE@A@AB@BAB@DCBABCBCDABC@C@AAA@AAEBAB@B@BC@EBC@ABCAAAA@A@E@AAC@E@C@CBABC@ABABADCDEBCBC@ABC@ABC@CBC@E@A@A@AAAAAA@AACAAAAA@C@A@ADADC@ABC@A@C@E@E@E@E@CBE@ABABABADAD@DADCBABABC@A@AAAACAACCAAACCCCAAC@A@CBCBCBADABCDAFEDADAJAFCDABABABC@A@CBCACACAECCCGACAAEAACAACCCECCACAE@GEI@@EBE@GBGBC@GBKBE@C@CACAA@KACBABADAF@FHHDJJJ@BBDADEDC@A@A@@B@DHLAFADEJC@CACECGCM@ACCG@GDEBAB@B@@@D@B@BDF@F@@BJ@B@BB@DDBDBD@BABA@CAG@C@@BCBEBABC@A@A@A@AAAAA@AAC@@@A@A@ABA@C@GAC@A@EFA@ABCDCBCB@D@B@JBJ@LAD@@C@EEC@SAE@C@GICAC@C@GBE@GAIEE@C@CB@B@@@B@B@D@B@DABABAB@BAB@DBB@DDD@BBDAD@FAJAD@DADBB@BBBBBBDFBBBAF@B@D@DAB@BA@@@AAA@EAECCAA@AACACACAC@C@C@ADCBCBCDC@@@A@@@AA@AACCAAAA@CAC@CAAAAA@CAC@CCACCAAAC@C@CCEAAACEKEGAAEGEKGKCEIKCEEK"],encodeOffsets:[[102562,27258]]}},{type:"Feature",id:"530723",properties:{name:"华坪县",cp:[101.266195,26.629211],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@C@CBA@CAGAC@CACAEACACAC@CAA@A@A@GBA@EBC@CBA@CB@@ABABCDA@ABC@A@A@EBE@A@CB@B@@ABE@ABE@@@AB@DBF@B@HBJ@F@BUJWLEDABADAD@JADADADAB@D@DADAD@DADDHBBDDBF@D@DAFADADEFCBEDKJCBA@EDCDGDCDCDEBEAA@E@CBGFAD@BCFA@ABA@A@ABAFAB@BG@G@@@C@AAA@A@ABEFCDEF@BADBD@F@DADADAD@BABCD@D@D@DBBDHBD@D@DABABADGJEDGBEACACBGBCAC@ECC@ABCDCDC@EBCDADADCDEDC@EBABABCDEDGJCFCFADAHADCBADCBADABADABC@CACDABAFABADB@BBB@DBB@BB@@@B@@BB@BBD@@BB@@@@@B@BB@@@B@@B@@BB@B@B@B@@@BBBB@@@B@@B@@BB@@BBB@@BCBA@C@C@@B@B@J@BBBD@BBBD@F@FBL@B@@ABC@A@A@A@ABA@CD@BAB@B@B@BBBDBB@@BBB@FBN@B@@ABCBA@A@ABABAHAB@@A@@@CAC@C@A@@BA@@B@B@DBBBBTJF@HDFBHJ@BJFFHBB@BAB@B@B@@BBD@B@BBD@BBD@BD@@@B@B@DAJ@B@D@D@BADELADAB@B@B@@BBB@D@FBHBBBDADBD@HHBBB@@@F@BA@@@@H@H@F@BBBB@J@BB@DBNBTFB@DAD@B@B@B@HDB@B@BAB@BCRGBAB@FAFAFADCBA@@B@DBHFHJFBDBD@BBFD@B@B@DCBGDCB@DAFAB@BA@AAA@A@A@ABCF@DA@@@A@@BA@AB@DBDAD@DAB@BABABABABCBCBEDCBE@GBE@EBC@A@@@ACCCCCAACACAAAAAAA@@@B@B@BDB@B@BC@ABAB@@B@B@BABAB@B@B@BBB@BDBB@BABA@ABAB@BABC@A@@BBB@@@@@B@BA@@@A@A@@BA@BBBB@F@D@BBD@@@BB@@@BABAB@B@B@@@B@@AAA@ACC@CAA@ABABCBA@C@AB@B@BB@BBBDBDFBB@B@@B@@A@ABABEBCBABABAB@FAF@HEJCDABABA@A@A@A@@@@BB@B@B@D@B@B@@ABA@@@A@@@AB@@A@@@AB@BAB@HAB@BA@ABA@@@A@@@AB@@ABAJ@H@BA@@BAB@BEHAD@BBH@DABABAB@B@F@DBH@BADAFABAB@B@F@DAP@DBLJJNPDF@FCDCB@@M@MACBABAFCDCFABGB@@A@A@ABA@A@@@AB@@@BA@A@@@A@A@@@CAA@ABA@AB@@A@@@ABA@@@@@A@@@AB@@@BA@A@A@ABAB@B@@@@@B@@BB@@@@@B@@@@@B@@@@@@@B@@A@@B@@@@A@@@@BA@@@ALBRDD@BBBB@FAFCJGB@B@DAB@D@FBHDFBDBD@DAB@BABBBBBB@@@BABA@C@A@@BAB@BBBBBD@F@D@BABADAD@H@B@B@DAB@@A@@@CAA@CBEAC@A@A@CBCDCHIBCDAB@@@BBBDBDBDDBBBDAB@BB@BNTDBB@F@@@JAHAN@FAB@@@D@F@HBB@@B@@@BBBD@BB@ABA@AAACA@A@ABCBC@@@AAAC@AA@AAAA@ABA@A@A@@A@@FCBA@AAA@@AA@ADAJEBA@@D@@BFL@BAB@B@@BBBBF@H@DAB@B@BABCBC@OBCBEFEFCBABADAB@BBFDDDDBHBF@F@B@BCFG@@B@B@FDVPFDB@@A@ACCAAAA@ABABCBADEBEBG@ABAB@B@DBLFBBB@B@DCB@BBBBBBDBDHB@D@@@D@FADADCFAFAH@JDDBF@DDBBBBD@D@BCBCFCDCDCBE@@DCDAD@LBD@DABADAD@FBBBD@F@FADAD@FAD@FABC@C@CBA@ADEDC@CDCBALEFABCBCBADEBCBCBCFCFCDA@ABC@CACACEGCCACCEAA@CAC@CCACEEGACAACA@ACAEAACB@BA@@B@BABA@@D@DC@@@A@C@CAABCAAACCCKEGGEACAECAAAC@@BADCF@DBB@DABABCDEBADABABABC@A@CBA@AD@HDFD@@DA@ABC@A@CAC@A@@@CFIASACAABABADAF@JBHBDBH@@@D@DA@@DAB@DBDD@B@H@FDDDBDDBBFBFBD@DCBADCBAD@JELCF@BB@FBV@FABAFAFCDAH@DADEDCFAFADBFBDFFFBHDDBBBH@B@B@DCHCH@HCJEFEJCDAFCF@D@DBBBB@HADABA@E@C@G@@AE@ACCA@C@GDEB@@CACCGOACBCFEHEBA@@@A@ACCOGMCGCIC@@CAAACMACAAC@A@CAACC@EA@AA@AAAAAC@E@AAC@ABC@CFCBADA@@BADA@@DBD@BBB@B@@A@ABA@ABCBADABADCB@B@BABA@AAAAA@@@@AAAIACAAA@AAAA@C@C@GACCCCACCACCCAA@E@C@AFEBCBC@C@CAAAC@A@ABA@AAIAAB@AA@CACA@@@IE@@CCCAA@C@E@CBGDG@C@GCEAA@A@G@GBC@[FE@AAAA@@CKBAAC@@@CBKBABMDG@E@ECEGE@@@EB@@@BABABABABAD@BBB@B@B@AA@AAKCCA@A@CAA@@@@ABA@ADA@AB@BBDBPNFDB@@AB@@ABA@ACICE@AB@B@BBB@BA@CBEBADA@@B@D@FBLHDDD@@@BA@@DE@AB@@ABA@A@@@AB@@A@@@A@@AAAA@AAAAA@AAAAC@A@C@CBC@@BADAFCBADCBADCBABABABADCBA@@DCBABC@CBA@A@A@C@AAAAA@AAAAAA@AAAAAAAAACAA@C@A@A@A@A@CBA@CBABADCDABCBAB@BAB@B@D@B@D@B@F@BA@ABA@@BA@A@A@C@C@E@A@@@A@@@CBA@@@C@A@AAAAAAAAACAAA@ACC@AA@AAA@AACAA@C@CAC@A@AAA@CCA@AAA@A@C@CAC@AA@AA@AAAA@A@C@A@CAC@A@A@C@A@AAA@AAA@AAAAAC@AAAAC@@@AAA@C@C@ABA@CBABCBCBABA@A@A@CB@@CAC@C@CBC@CBC@A@E@C@EAA@A@E@AAC@C@CAEAAAEAEAECECAAEEEAAACACAC@CAAAA@A@C@EBC@CBGAE@CAC@AAA@CAAACACAAAE@A@CAI@C@CAEAEACACAA@A@AAAAA@AAA@CAAAA@A@ABC@C@A@C@CBA@EB"],encodeOffsets:[[103690,26992]]}},{type:"Feature",id:"530721",properties:{name:"玉龙纳西族自治县",cp:[100.236954,26.821459],childNum:1},geometry:{type:"Polygon",coordinates:["@@C@EBA@C@EAAB@@@BA@@B@B@B@B@B@BABA@AFABABCBABCDCBEDGFCBCBAB@BOHUHM@CA@C@EF]@ECCIAI@O@WFI@A@A@UBKCIAG@IBA@ADABABCFCDEFEBE@E@@@ABAB@BABCBADEBEBEBEBC@C@C@G@E@AAC@AAA@ACCAA@AAC@EAG@KCEAIECAECCCA@A@CBA@A@AA@AACAAAA@AA@@AC@I@E@A@CAABC@EBIFCBA@@D@B@B@DAB@@ABEFCDCDAB@BAD@BBD@D@FAB@D@BAFCBQNEF@BKDOHKDE@IBI@CAGJGJEFCDEDADC@ABA@ABC@C@KDMFEBAFAD@FAD@F@FBH@D@B@BADEJEHEJCVERERAD@@@DADADEJEJAFAHAD@D@F@BBDBBDD@DBB@B@@@BA@A@@BA@@BBBBBDBDB@@BB@BAD@BAB@B@B@DBBB@ABABA@C@A@E@CAC@AACAC@C@C@EBCBEBAB@@@@AB@BBB@BBBBBDABAB@DADAF@DADBB@BBBB@B@DADBD@BBBBBBDDD@BBD@@@BABA@C@CAA@@@A@A@A@@B@@@@ABABA@@BA@@BA@@@@B@@@B@@@BAB@@@BA@@@@B@BA@@B@@@B@@AB@@BB@@@@@D@B@@@@AB@@@B@@A@@B@@@@@BA@@B@@@@A@@B@@@B@@@@BB@@@B@B@@B@@BB@@@@B@@B@@@@B@@AD@JDHBHEFKFABA@CBABC@ABA@EAK@GAC@C@ABABABADCDABABAFABCDAD@D@B@BAB@DADCBABCBADCBABC@AB@BABAD@F@B@BA@@FAD@DAF@BABBD@D@F@DADADAD@D@B@BBB@BADABABA@ABA@A@ABADAB@DA@ABABA@AB@@@BADADAHAJAFAH@FADC@A@EBCBEBEDEBGFADCDEFCDCD@HAF@FAHBFADC@CBC@ADAFAFAJ@F@HDH@DBJDL@D@DAD@DEDEBCBAF@B@DBBDBF@B@DAH@FAFC@BBD@DAFADA@CBEDAB@D@D@F@F@LBD@D@HCFCDCBC@C@E@G@ABABAFBBBPCDEFEBED@D@HFLDD@BCDGBAB@@@@A@@B@@@@@@A@@@@@@@A@@@@A@B@@@@A@@@@@@@@@A@@@@B@@@@@@A@@@@@@@A@@@@@@B@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@B@@@@@@@@@@A@@B@@@@@@@B@@@@@@@B@@@@A@@@@@A@@@@@@BA@@@@@@@@@@@@@@@@@@B@@A@@@@@A@@@@@AB@@@@@@@B@@@@@@@@@@AB@@@@@@@B@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@@@@A@@BA@@@AB@@BBJBHDDDFFFFDBDBF@FBF@HBDB@@@DAFADCDCJADCJCJAFAHAFCFCFCFAHAJ@F@DBD@D@DBFBFBBD@B@F@FBFBBFBDBFBFBDBBDDBD@D@BBDBDBB@D@DBBBBDFFFHFFFDD@B@BABABA@@D@BABABCBC@ABCDABAH@DBD@BA@A@A@C@CBCDCB@B@D@B@DAB@DAD@D@DBBBDBHBF@D@FAFAFABCFCDAD@DADABCBCBADADBB@DBBBFBFBDDFFDDBD@@B@BABABADAD@D@BBBBB@BADABABCFAF@FBD@DDBBDD@D@J@FBD@FDFDFBDDDBDADAF@DAHBH@NDHBJDBBD@D@F@FBFBDFFFBFHFFH@BAJAF@DBFDFDDBF@DABADAF@DBDDBDBF@HBFBHBDBDBBDFDDBDBF@FDHDDBB@BD@D@FADB@D@B@BAFCBABE@CBE@E@E@CACAA@CAC@ABABADCFEDCD@DABBB@B@BABC@E@EAE@EAEAECCACAAAG@E@E@ABEDCBADA@ABA@CAG@GBEBCFAFAFCFCDCDA@C@GDEBABAD@FBBDBBD@B@FADAB@DCBCBCAEACCCCAAA@CBC@AAACGCCAC@ADCHEJGFCFEBCDCBE@C@C@C@@AC@C@ADEBCHCJEBAFAHCJCFCDEBCBC@CDEDCHGDEDCBCBAAC@AECECMECCAC@ABC@AAGCG@G@EBGBAFEJENCB@BADCBA@AAC@GAG@A@A@ABCB@FADAB@HBBAFABCBC@@@@AA@A@ABAFAJGHCFAFADCDC@ABG@GBCDEJAHAF@D@D@BC@A@C@ABCBCFCJCDCFEDGFGBEBGDADCFCHCH@J@HAHAFAFG@CBG@CBABAB@RCHCHCBCBE@C@E@CBC@E@CBABCDEHEHCNCHADABABADGBEBABAFEFGDEDADAFCD@BA@A@E@EBE@CBAHQFMDKHGFCF@F@DBD@F@FCNGJGHEDE@EBEAE@G@C@EDEFCDCFEFCDCDAD@DABABCDCBEBCBCDEFE@A@ACE@C@@DA@ADCDADABAJIHEBABABABC@A@G@E@A@C@A@@@AB@FADCDCFEDEDCBCBC@A@A@A@ACAA@C@C@ICAAACA@BCB@BCBEBABC@A@A@ABA@A@CAA@CAA@A@ABCDC@C@E@C@CACCECCACACAE@ABADCFCDEDADCBA@CBC@C@C@CACACCC@CAA@CBCBABADEF@DAB@DBB@BBBDBBBB@BBBDBB@FBFBD@F@B@BB@DBDBBB@@BB@DBD@DBB@D@BBB@DDDDDBBBDBBBBBBBBBFBHBD@HDBDBBBB@B@D@BAB@D@BBDB@DDBD@FHLDFDBDBD@D@DADB@BBH@HAH@FAD@D@D@BAD@B@B@DBDBD@BBF@F@D@B@B@DDFBB@BBF@DBDBDBBBBFHBDBDBDDH@DBBBH@F@DAFCDCBCBABADAD@F@BBDDFFFFFDD@FAFCJAJ@HCJCBC@ABABABADAB@J@L@F@BADABAJEFCFAJBF@J@B@DBJ@LBLBJBHBLDJDFDDDBDBJDB@BDB@D@JDLBJDD@F@FDDBBBBB@FBB@BDB@BBBBBDBBBBDDD@BBD@DBBBDBDBDDDDDBBFDDDBDBFB@@BBBBDBDDDDDBBBDBB@DB@BBBBBB@@@D@DBBDDDBBDDBDDDBBBB@BBB@BBHBDBBBBDBDBHBBDFBD@BB@FB@@BB@B@B@B@@BBFFBBFDBBBB@BBFBDBDBDB@BBFDBBBBB@B@D@B@D@@@BB@@DBD@DBB@BAB@B@FBB@FBDBDBB@BB@B@B@DBBBBBDB@@D@H@D@B@DAB@B@@@@ABCBA@ABA@@B@B@D@@BDBF@DAB@BAB@B@B@B@H@B@D@DAFCDAFAFABCDCDABABADADABABADCDEF@@AB@BEF@@AB@BABBB@@BBBDBDB@@BBDDF@DABADABCBAD@DAD@F@B@F@B@B@BABADAF@BA@CFABCDAFADAFADADCFABADCDCDCDADAB@BEF@@ABAD@F@D@B@BBBDBDBB@BDBDBB@D@BBBBD@DBDBF@DBDBDBDDDFFDDBDDBDDFBB@BBBBBBDDDDDFBB@BBB@DBD@FBBBBBBDBDBB@DBDBFDBBF@BBDDDDBFDB@B@BAD@DAB@DAD@B@D@D@D@B@BAD@DAB@J@D@B@BBBD@B@HBB@B@D@B@D@D@BBDBDBBBDBBB@BADAD@FABADADADADAD@F@BBDBDBBDBD@D@D@B@DB@BBF@D@B@B@BBDBB@@@DB@D@H@B@BBDB@BAD@B@FBDBDBBFBDDDDBBBD@BCD@B@FAD@F@HADABEBEBEBCBABAB@B@DBFBD@BBBBD@D@BBBB@BBDBFBBBDB@B@B@FBDBF@FBD@D@DABBB@HBDB@B@@@HBDADCBC@CBCDCBCBAFCF@F@F@FADBD@@DD@DBDBDDDFDDBDBDAFBF@D@DDBDDBB@BAFBBBDDBF@FDF@DAFCJAFA@@@@HCDADEFCBADAF@DADADEBEBCDCFCDCDADAFCDC@E@E@GCE@CAA@CAA@C@E@GDEBE@CCEAEECCCCCAG@ABCDEDAFAHCF@D@DA@ABAFEDCDEBE@CBC@CACAEACACBABADBH@H@F@FABABADADE@CAE@C@CEEAEAC@A@E@E@EAG@EBABADC@G@CBCBADABC@C@G@E@E@CFE@AAEAE@CAA@A@AAE@ACE@AAACCCCACAC@A@C@CAE@E@AAAACACAEAC@CAAACAAACACAE@CAA@E@A@ABCBA@ADEDABABC@C@A@ABCBC@A@E@EAEBA@CBCBABCBABA@C@C@ABC@ABA@ABCBCFCBABCDCBE@CBC@CAABC@ABAFADABAFABA@@DAB@BAD@DBFBB@DBD@DBD@B@F@BA@@DA@ABABCFCB@BAB@@@BA@@B@DAB@D@HBD@D@D@@@BAB@BABCBA@A@ADC@CBA@C@AAAACAAEEACCEAEAA@C@AACAA@CAAA@CCCA@AACACAA@CA@BA@CBA@ABA@G@A@A@ABABA@A@EAC@A@A@A@C@CBEDEBABC@A@A@CBAAC@E@CBA@@@ABE@ABC@C@A@A@A@C@@@A@C@A@C@AACAA@A@C@ABCB@BABCFCDGBIDG@ABCBABAB@BABABABA@@BA@A@ABA@CBABA@CBCBE@A@A@C@AAC@A@CAC@AAA@AAAAACC@AAA@AAAAAAC@C@ABA@@@EAC@A@AA@CEAA@AAA@A@A@@AA@AAA@A@CAA@A@@AAAAAAAACEAAAAAACAA@AAABA@CBCBABA@CBAAA@EBC@GACCACACAC@EAEBA@EACAACAA@C@CDA@C@A@EBADEDEBA@EBAAA@CACCAAACAAAD@DAB@B@BA@@@BB@@@BA@@B@@@B@@@B@@AB@@AB@B@B@B@@@BB@@B@BBD@@@@@@@BA@@@AB@@ABA@@@@@AB@B@@ABA@@@A@@B@@A@@@@BABAB@@@BA@@@@@A@@@ABC@A@C@A@A@@BA@@@@@@@@BA@ABA@@@A@A@A@@BC@@BAB@@AB@@AB@@@@@@@BA@A@@@@BAB@@AB@@@@@B@@@B@BABABCBC@C@CBGDA@A@@AAABABAF@DA@A@@@@@A@@@AAA@@AAA@AA@@A@@@A@ABA@A@A@A@A@@BA@@BA@@B@BA@@BAB@@@@A@@@@@A@@A@@A@A@CAAAC@@AA@@@CBA@A@@@A@A@@@A@A@AAA@A@E@@@ABA@@@@@@@A@A@A@C@A@A@A@@@@A@@AC@A@A@A@@BA@A@@@AAA@AA@@AA@AAAAAA@@A@@@AAA@A@@@@A@C@A@@@@@@B@BA@@FAB@B@BAB@@@@ABCBA@C@A@@@AAA@A@A@@@@AAAA@@AA@@@AA@ACAA@@@@@@@AA@@ACC@AA@@A@@A@@@ACAC@A@AAA@A@A@@@A@A@A@@AAAC@A@A@A@@@A@@BA@@BCBA@AB@@AB@BA@@BABAB@BADA@@BABA@ABA@ABA@A@A@ABA@@@A@A@A@A@@@A@A@@BC@@@A@A@@AA@AAAA@AAAAAA@@@A@C@A@ABA@A@@@A@@@@@A@A@C@@@@@@BA@@DA@ABA@@@AAA@@@A@A@@AA@A@A@A@@@AA@AAAA@C@A@@@A@@BA@@BA@A@@@A@A@@@AB@@A@@BA@A@@BA@@@A@@B@B@F@F@D@DAFCFC@CBCAACAA@ABA@A@ABA@CBABA@ABA@ABA@A@A@@@A@AA@@A@A@AAA@AAC@AAC@@@@@AE@E@A@@@@@A@A@@BA@@@ABA@@@AAA@AA@@AA@C@AB@@@@@D@B@BA@@@@B@@@B@@AH@@@D@DA@@B@@@BAB@BAFA@@@BB@B@B@@@DBB@F@@ADABA@@B@@ADABABA@@@C@A@ABA@@@A@@@ABA@A@A@AAA@C@C@CBA@A@A@C@A@A@@@A@@B@@ABA@A@A@A@@AAA@@AA@@@AA@@AAA@AAA@A@A@@@A@A@@@A@@@ABA@A@A@A@A@@BAA@@A@AA@A@@@@@@A@C@@@A@@@A@A@@@A@@@ABA@A@@@A@@@A@@@@@A@A@@@C@@BA@CB@@ABC@A@C@A@A@C@A@@AA@A@A@A@@@A@A@AAA@A@A@@BA@@@A@@@AA@@A@A@A@@A@BAAA@A@@@A@@@A@A@@@C@A@A@@AA@@@A@AA@@A@A@AAA@@ES@EK[IUAK@KBI@G@EAGGCGA@@G@S@Y@MBI@KBODC@CBUHQJOFGAGEM[CMDWFQLOLSBCHIFMDIFAD@R@F@B@D@B@D@@@B@@@FBD@@GBE@ABC@@@AB@BC@CBABA@ABABA@A@ABABEDGHBPDHBBBVHD@AEFEDC@GIgE_EIACCAG@KBSFA@IBEBACKKACCCCMDi@I@GCAI@SAK@C@I@CAECGGKIKOIGEEC@CAGBE@UBSBUASEKO@G@EBE@E@ECEOQCGEmCc@@ABAAOBGBCBEFA@A@A@C@A@AAACAG@CACAC@C@EAE@G@A@CBCBA@CBC@C@A@A@E@EBIA@@ACACAGBE@@AC@@@@@CAAACGC@E@A@ECCAEAC@@@G@IFIFKFOHeJKFEDED@@AB@@ABEB"],encodeOffsets:[[102357,27249]]}},{type:"Feature",id:"530722",properties:{name:"永胜县",cp:[100.750826,26.684225],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@BAB@BBDBDBB@BA@E@ACAABAAA@@A@A@A@A@A@A@AQGCBN\\"],["@@@CCCCAAACCEACAIAAACAIAEAGBEAGAA@GA@EACCECAEACAKCC@CCAA@CBEE@C@A@CAACEAGBEA@C@CBE@C@CACECCCCCCACACAGACCGGAC@EDEDGBEDC@C@CACACCAAC@CBEBGBGBEBG@C@E@CBCBABCBE@CBCBEDC@CBA@E@A@CBCBCDEDC@A@@BEBC@C@AAAC@C@EBIBG@C@CBA@ABA@@@A@@A@EA@@@@@@ACEACA@@@@@ABABA@@@A@A@A@@@ABADABEDABEBAAC@IACAOCA@A@C@@@AA@@AA@@AAA@A@GGA@A@CAA@CBA@A@C@A@C@@@@AAA@@@@@AGAC@C@@AA@@@AB@@@BCF@@@B@@BB@B@@ADAD@B@B@@A@AB@B@@@BBB@B@B@B@B@@BB@B@BADABA@@ACAA@A@C@A@EB@BA@ABBB@B@@@@@@@@AB@@@B@B@@C@CBC@EBQHIDCAIM@@@AAACEAACACAC@E@E@CBABC@A@@A@@A@@@@@@@AA@@@@ABCBABC@@BA@AB@D@B@B@D@DAD@BABCDCDADAD@DAF@F@DBDBD@DBFAD@B@D@D@BBD@@ABA@ABCBADCD@DAD@D@D@D@B@B@BBDBFDDBB@B@@@DADEJAD@B@DBBDBF@HAB@DDDF@B@BADADINGPABEDEBEBC@C@ECAAAACAEACACAC@E@CBG@IDGDCDEFABGDA@@B@B@DBB@DBB@D@DABADKRGNBNDHLJp^@D@DCB@@G@A@CDEDAB@BA@@D@B@BBBBBDBB@@D@D@DADABCBEDEDCDADCD@DCDAD@F@D@F@DABCHCHAFAHAF@F@D@FBHADADCFCDCDABC@A@CAA@A@A@AB@B@B@DBDDFBDBDDDDDDDFBFBD@DBBAB@BCBAB@BAB@BBB@BDB@DAB@BBBBBBAFABABA@@D@FBBFFDDDFBBBD@D@B@BDBDBDDBBBDDBDBBD@BCDA@C@ACCACCCAA@CBA@AB@BABAB@D@DBBBBBDFBFDBBFDBDBF@D@F@D@F@D@BDJDFBDBBBBD@FAFADAF@DAF@F@FBF@B@JAF@FAB@B@@BB@@BBPBFENABCBCBCBCBA@C@CBC@A@AB@BABBB@BABADABCBC@A@CAE@AAAAECACCAAACAC@CAABCBADAFAFABA@AH@BBBBFBDBDABAFADCBC@CBCDABADAFADBB@BBDBDAF@FABABABCBBB@BDFFDDDD@DBF@DADAD@FAD@BBBBB@D@HAF@H@D@BB@B@BAB@B@B@B@B@BABA@CDC@CBABABADABABABIDEBC@CBAB@BBFHLHJBD@BBJ@D@DADCBC@GBC@A@IA@@[CM@CBCBABABAB@D@B@DBBADCBABAD@BAD@B@D@F@DFJ@HBFBDDDDFDDBBBDFBBBBDDHDDBFBDBDAD@D@BADABABCBEDIBCBCBEFCBADCBABADAD@D@BBDDBDDBDBBDDBBBDBB@B@BADABABCDCBC@CBABABAB@BAF@D@F@F@F@F@D@BAD@BCDCB@B@B@DBBBBDBBBB@BBBB@B@B@B@FAD@DAD@BAD@BADADCFCDABAB@BADAB@D@D@FBD@B@F@BBBBBAD@BAD@FADA@A@ABBF@BBB@B@DADCBADADABCDA@ABA@@B@BDFB@BBDB@B@BBBFBB@DBHBDBBBFDBBBBBBBBBD@B@@BDDBB@BBB@BAD@DADAFADADBF@H@FBF@JBDBDDBBBDBB@D@D@D@BAFADADAD@DAFBD@FBH@DBD@DAB@DAD@B@DBBBDBDBDDDBD@B@F@DADBH@DAF@HADADAB@DAB@B@D@B@BBDDHBHBBBDDF@B@D@BBBBFBBBDBBBF@B@D@BAHADAFAFAD@B@DBB@BBB@@@DBB@D@D@DABABCDABAD@DAFAB@FAHAH@DBD@DABCDCDCBC@CD@B@FBH@B@F@F@D@F@BD@BBD@D@D@DBDDBBBBBBBD@BB@@BBB@D@D@F@B@DBDBBBBBB@B@BBBDDBBAD@D@DABADAB@DBB@@BB@BBB@BB@FBDBDBB@FDDBFDBBBBBDF@D@DADCBABAD@DAD@BA@AB@@AB@@@BAB@BAB@BAB@@ABC@@BADABAFADA@@HAFA@@@AAAACCCA@AACAC@C@CAA@CAA@A@ECA@A@@ABAFGBC@A@CAACCACAA@CBA@CACAA@A@@A@AAC@A@@@C@EBA@A@@@A@@A@C@@@A@A@@A@@AEAGAECGCAA@@AA@@NMJEDCFCDADABAB@B@FAD@B@JBD@B@DAB@DABADABCB@BABAB@B@BBD@BBFHDBD@D@BD@BBAB@BAB@B@DBRFBBBADA@@FGBA@AAA@@@A@CBC@AAA@AAACC@@AABCDC@AA@@AGAA@U@C@AA@@AA@ECCCEA@@@@A@A@CAE@A@ABA@@B@B@B@BB@@B@B@@@B@@@@AB@BA@@B@@B@@BBB@B@BA@@BEB@B@@@HHB@BBB@@@BADABCHCD@F@HBRHBBBABA@AACAAACAA@A@AAAAACBA@C@A@AACAACBC@CBCDCBABA@@BBBB@FBBBBDBD@BBB@@BB@@B@@@F@FB@B@B@BAFKBABAD@DBJBDFHDB@D@@@FEB@H@NBHDDB@B@@@BA@A@@BIBC@GBGBCBA@ABCBCBG@ABAB@@BDDBNLDH@DADABAJ@D@DPL@B@BABABA@C@OGAAA@ABAB@D@BDDFBJBRH@@FDHJBBD@B@D@B@D@@@LCHEBAB@B@BB@@B@@DBBB@D@D@B@B@@@DFFDB@@BB@@@BB@@B@D@B@@@B@@@@@BA@@B@@A@@@@@A@@A@@A@A@@@@@A@A@@@AAA@@@AA@@A@@@A@@AAA@@@@A@@A@@ABAB@VAB@DA@A@A@@@G@AGAA@CCEECGMIQCGCAAAC@K@ABAF@NBDAJCLGHA@@DC@@ACCCE@CACC@A@A@AFG@EAC@AEAAAAA@CBE@EACACCAEAA@CECCAQ@G@@@KDGFGJEJCFEBE@ACCAA@A@AJEFCHKBAJMBCHCD@B@FF@@DABADAD@HBDDD@HCNEFEM[CBC@C@AAAAAA@AAAB@@AD@DAB@F@F@F@BB@@@BABEDRHDABA@@CCAAAAAA@A@@@A@@DC@@B@BADEFCFCHADABAAC@G@@BAD@D@B@BA@A@A@EBA@AD@FBF@@BF@FAFABADCBCHKDCDADADBBDBDB@D@H@H@D@B@DHFHDNBLBH@JDFBDDBHAH@FBLDdLNLHLHF@F@FDJNLBBBAD@BC@AAC@AIQAA@ABAB@@A@@B@BABA@ADEBEB@HCLMLKHCDC@C@EBGFGB@FC@CEIEMCO@ABCFELGBEDA@A@CAAAACAAAACACCECGAEDKACACECGCCCAMCCIAMAGAEAAE@C@IGWMOIEEGBABE@C@EAE@CBAB@FBD@F@FAHABA@ABCFEDABADBDDDTBBBBP@TBD@BCDCB@B@@@JLB@B@BABCBABAB@F@BBBBBDB@DBBABABAB@BBBBDFDDFDB@F@D@DABAAA@A@ADADC@A@AACGKCEACCACECCCCEAEAOAAAAAACCCACACCAGIEEA@AAEBGDIDC@EACAEACCCC@EBGBCDAJABABCDC@CBEBEDCDADABBDBLLLRFBFBF@HFDBFAB@BADBDBFDFBDBBAB@FABBDBD@D@B@HCLCDAB@AI@ABA@AB@B@D@DB@BB@@BBB@@B@BCBABEBADCDBD@BABCBABCDABCDABCBGBCDEDEHIFCDCBABAFAD@FCDCBCBCDCFAD@DCDCBAD@FDD@DBHADADBFBHAFCHIBCBABA@C@CACCGAA@C@C@CDCBA@ABCBCBC@C@EACBC@AFEDCFEBAB@B@BBD@@@H@H@@ABABEBAB@B@BAB@DE@ABCHEDAF@B@FBFADCDCHCDCFCB@DALIFCDAFEBCBCBE@C@CAECCAACGBC@CBCBC@C@CBABCBCBC@IBCBCBAFCXKVI@A@EAI@G@AAE@CBA@@F@BAF@BA@@@ADAC@AA@@AAAACAAAAAA@AAACACAACCAAAAAACAAAAAC@AAC@A@A@C@A@C@A@C@A@C@C@A@C@C@C@@@AAE@C@AAA@C@AAA@C@C@E@E@A@C@AAAACAAACAAACAAAA@AAAAAAAAAAAAC@AAAACAAAIECAA@CAEACAA@C@E@A@E@AAE@AAAAA@ACAAEAC@A@AAAAAAEC@AAAAAACACAAAAACAAAAA@AAAAAAACAA@CAAACACCAAAAAEEECGAECCAC@CACAECEAEACAAAA@CAAAAAECA@CACACCCA@ACA@@AAAA@@AAAAAACAAAAAC@AAAACCAAAAAAC@AA@AAAAACAACCACCCACAAAA@CC@@AACEAAEC@ACCA@AAAAAA@AAA@AAA@CAAACACACACACAA@AAAAC@A@C@AAAAA@AAAA@@AA@@ACAACAA@@AAAAAAAA@AAAAAAA@AAC@AAA@ACACAC@CAA@E@CBC@C@CB@@C@CAE@A@C@C@A@C@A@A@ABABAB@BABCDADADCDCBABABCBA@EDC@CBE@EB@@C@A@ABA@CBAB@@ABAB@B@B@B@B@B@B@@DFFF@@@B@BABA@CBC@@BCBAB@BA@C@C@C@A@CAABAAC@CAA@CAAACCAACACAECAAA@AACAA@A@A@A@C@EBC@CBA@E@CBC@AAA@@@AA@A@@@CBA@C@A@@@A@AAA@@@CAA@CAAAA@@CAA@C@AAC@C@AACA"]],encodeOffsets:[[[103145,27469]],[[103169,26793]]]}},{type:"Feature",id:"530724",properties:{name:"宁蒗彝族自治县",cp:[100.852001,27.28207],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@AAE@E@E@A@CBC@@BA@BB@BBBBBBBD@D@DADAFCBA@A"],["@@AA@@A@@CA@@A@@@A@@@A@A@@@A@ABA@@@@@A@@B@@@@@@@BA@@B@@@@@@A@@B@@@BA@@B@B@@@B@@@@@@@@AB@B@B@@@@@B@@BB@@@@@B@@@B@B@@AB@@@@@@AB@@@@@BA@@@@BA@@@@@@@AA@@A@@A@@A@@A@A@AA@@A@@AA@@@AA@@A@A@@@AA@@A@@A@@@@@AA@@@@@@A@@@A@@@@@A@@@A@@@A@@AA@@@@@@@A@@A@A@@@A@@A@@A@AAEIACAEAA@@BAFARB@BDBDBBBBBBH@BHBHA@@BC@CC_AGBGLCH@HCHMJMLOFIDG@@@AB@@IAEB@DADABA@ABA@ABCDC@ABABEDEBABA@ABABADABAB@@ABA@ABA@ABCBMAWEK@EGKEEAABEBABCBMCSAOA@B@AA@@B@B@@@B@BB@BBB@@@@D@@@BBBBDB@@@@BB@@AB@@@@BB@@BBBB@BBB@BBB@BB@BBB@DBB@D@B@@@B@@@BAB@@@B@D@@@B@@@B@@@BAB@@@@@B@@@B@@@@@@A@@@A@@@@DA@AB@@A@@BC@@BA@AB@B@B@@AB@B@@@@A@@@@BA@@@A@@@@@ABA@@DC@CBABABABABA@CBABC@A@A@AA@AA@AAAA@@AAAAA@A@A@A@C@ABA@@BABABABABC@ABABABA@ABCB@@A@A@A@A@A@A@ABC@ABAB@@ADAB@BAD@@ABBBA@@BAB@@ABA@ABA@C@ABA@ABA@ABABABA@A@ABABABABAB@BAB@BAB@BA@@BA@ABA@ABCB@@A@ABA@A@A@C@A@A@A@A@A@A@ABABCBABABAFABAB@B@B@D@B@BBB@B@@AB@B@B@B@@AB@@@BA@@@A@@@A@AAA@@AA@A@@@A@A@AB@@AB@BA@@@ABAA@@A@AAAA@@@AA@@AA@AAA@@AA@@AA@@@AA@@AAA@@@AAA@@@AAA@A@AA@@A@@@A@@BA@@B@BABAB@@@BAB@@AB@BA@@BAB@BA@AB@BABABAB@@@FGBEBO@ICECK@C@CBEDCFCHMLQBC@@@EBE@AEACAAA@ADOBABGBADCBCDADAB@@@B@BAB@B@@@BA@A@@BA@@@A@@@A@@@A@A@@@A@A@@@AA@@A@@@ABA@@@@BA@@B@@AB@@@BAB@B@B@B@B@@@BAB@B@@@BA@@B@@@BA@@B@B@B@@AB@BBB@BA@@@@@@B@BB@@B@@@B@@@@BB@@@@BB@@@B@@B@@@@@@@BB@@@@@B@@@B@@@@@@BB@@@@@B@@B@@B@@A@@@@@@B@@@@@@B@@@@@@B@@B@@@@@@@@@@@@B@@@B@@@@@B@@@B@@@BB@@B@@@BB@@@@BBBB@B@@@@@@B@B@BA@@@@B@@@@@B@@@@@@@B@@@@@B@@@B@@@@@B@@@@@B@@@@@B@@B@@@@B@@@B@@@@@B@@@@B@@B@@@@@@@B@@@@@@@@@BB@@@@@@B@@@@@B@@@@@B@@A@@@@B@@A@@B@@@@AB@@@B@@@BA@@@@B@@@@@BAB@@@B@@@@@@@B@@@@@@BB@@@@@@@B@@@@AB@@@D@@@@@B@@@B@B@@@B@BA@@@@B@@@@@BA@AB@@@@@@@B@B@@@@@B@@@@A@@@A@@@@B@@@@AB@@@B@B@B@B@@@B@D@@@BB@@B@@@D@@AB@@@@@BB@@@@@@BA@@@@B@BB@@@@@@BB@@@@B@@@@@BA@B@@B@@@@@@A@@@@A@@@@@@A@@B@@A@@B@BA@@@@@A@A@A@A@@@AA@@A@@A@@AB@AA@@@A@A@@@@@AB@@A@@@@@@@A@@@@AAA@@A@@@@@A@A@@@@@@@A@@@@B@@@@A@@BA@@@@@@@AB@@@@@@@@A@@@@A@@AB@@A@@@@@AA@@@@@AA@@@@@@@A@@@@@@@A@@@@@A@@@A@@@@@A@@@@@@AA@@@A@@@@@A@@@@@@AA@@@AA@@@@A@@@@@A@@@@AA@@@A@@@@@@AA@@@A@@@@AAAA@@@@A@@A@@@@A@@@@@A@@A@@@@@@@@B@@A@@@@@@@A@@@@@A@@A@@A@@B@@@@AB@@@@A@@@AAAB@@A@@@A@@@@AA@@@@AA@@@@@AA@@A@A@@@@AAAA@@@A@@@@@@@@@A@@A@@@B@@A@@@A@@@@@A@@@A@@@@@AB@@@@@@@@A@@@A@@AA@@@@@@AA@@A@AAA@@@@AA@@@@@@@@@@C@A@AA@@C@@@AAA@CAAACAA@AA@@A@A@ANKHABA@A@C@C@IAA@@ACAAAE@CAAAAC@AACA@ACC@GBCBA@C@CAAACDG@CAC@CAA@CACAAAA@AA@EE@CAQ@CBCPGPIREJANA@@DABAD@DABADADADADABADADADA@@BAFAFCF@D@DBD@DBFEFENEBADE@EACAGAO@EBC@CDADCDCD@H@H@L@H@H@DBDBF@B@DG@CAECEEECIAGAEAC@C@AFIDCBABABC@IBCDCDC@@D@FA@@CGCAAAAAAAA@CDA@A@AAKECAA@A@AB@BAHAFCFABADAB@BBBBBDD@B@BA@ECUOECA@A@@@EHADA@E@E@GACACCECAAA@CBABABEDEFAFAD@PADADABA@A@CBG@E@AAAA@@@ABA@AEK@AC@@@ABIFCB@BBB@@BB@BABED@@@BB@B@B@BAB@BB@BBBD@BB@B@@ADAD@B@BDBBB@BAB@BAAC@AA@A@@@AA@GAE@C@@@A@EBM@GBIB@@E@A@CAMS@AAAA@CBAACAACACACAA@@A@CBADGJCDAD@D@B@BBDAF@DBB@D@@@BA@CBA@A@G@C@CBABABC@E@C@AAAA@ABA@AB@D@B@BA@A@@AAAAAAABA@CBC@CAEAGCEAC@A@CBA@A@IHEDEBA@AA@ACCAQBK@@B@@A@@B@@@@@@AB@@@@A@@@@@@@A@@@@@A@@@@AA@@@A@@@@@ABABAB@B@B@@A@@BA@@B@@@@@B@BA@@B@@@BAB@BAB@DB@@B@B@@@B@B@@A@@BA@@B@B@BAB@B@@@HABADEDCBEBADANBN@@@DADC@ECEMOIIAK@CBO@C@E@ABABABEBC@AAG@C@E@ABABABA@CAG@ABCFG@ABA@AB@@A@GBIBA@@BA@@B@@@B@BAB@@ABA@GBA@ABA@@B@@@BA@@B@@@B@BA@@@A@A@C@A@AAA@@@@@B@B@BABABCBIDGFE@EBA@ABABABADAFAB@B@BA@@@@AAACECAAA@AAAA@A@@B@DABADAB@BBB@DDD@BBB@BA@@@A@A@A@ABAB@@A@@A@@AC@A@C@EAAAAB@@AB@B@@@B@@A@A@@@@AA@AB@D@BA@ABABAB@BA@AAAACA@AAA@A@A@ABABA@A@@@BABAD@@A@ACA@A@A@A@@BBBBBBDBDBBBDDDDBD@@B@D@FAF@HAF@DAFCDADABABABABA@ABA@CBCAC@CBAB@@AB@@@B@@CDEBAB@B@B@BBB@@ABABE@CDAHCDA@C@A@AECAAC@CAEAGIGECAA@@@ABCDEBEBEBA@ABQHADA@ABA@A@GCA@A@A@C@CBA@SEMACAA@@A@IAAAAE@G@G@@@@@ABE@@@A@AAGGC@CACBAAGAEAC@A@AA@@@A@ABABCFKBC@A@C@C@ABI@C@A@A@@ACC@AAC@AAA@C@AA@@@A@ABA@AAAEGIE@AGIEAGCE@SIAAAA@C@A@AB@@AB@D@D@DB@@B@@@BABGBABAB@B@DABA@@@AAM@EAA@AA@CAAA@A@A@ABA@ADCB@BAB@B@B@D@BA@@@AAK@E@EACAAC@AA@A@I@A@AD@D@B@DA@AA@AA@@AA@@@AA@@@A@AA@A@@@A@A@AAA@@@AA@@@A@@A@A@@@@AA@@AC@AAA@@@A@@AAA@CAA@AAA@A@@@AA@AA@@ACAC@A@A@@BAB@BBJA@CBKDGDA@C@C@CAAAEBA@ABCAEAECCACAABA@EBCAGEE@EAEAKQKKCAAACBCBCDAFAF@DCDADABIBCBADAH@FDDDDFBDBFBD@JCHCFABBB@FFHJDBBDBDDDBDBBBBPBFBFBDDDDDFDBBDDFHLBD@B@BCDCB@B@BBBABCBC@E@A@ECCCCEAAAAA@ABABABCAA@ACAAAAE@A@ABABADABA@A@IK@@A@A@CDADC@SAO@AAAACSCCCAABCBEFAD@BABGBEBE@C@EAA@AB@DBF@F@DAFABFHJFNPHX@J@DBFFBHBNBJBDDBNDDHDFDBDBDCLBFDHDFBDBDBBDBBBBB@D@BCBAFKHEFAD@BDPFNFJ@DEDA@EHAH@F@DCDGDKLKNGDA@AFCF@BABABA@@@@BA@AB@BBBJR@BBD@BADC@ABAAMKCI@E@EGEGKMKcKKCEAG@GBCAACCE@IAGAKCMEGCGA@C@G@G@C@A@ACACCACBCBCDGLADCDABEBEBE@@AE@EAC@@BAB@F@B@BABA@C@C@AB@@@HBDABCBGBEDEDCFABA@@@CD@@@B@@@BBBBBBBDD@@ABCB@B@B@B@B@B@B@BB@BBBADB@B@FABA@CACAAAA@ABEFMFGDC@CCGAC@CBABCB@@EEA@C@GDADINABGLEDIF@B@BBBDD@BAFEFIDIFEHCH@L@@@HBRDDDFB@FBDBBDBD@FAF@DBBBBFB@BBD@FEH@B@B@BDDDBF@DDBD@@CD@@GBKHIDCBMAE@AB@B@LBDBBHDRDNJDHFFDDB@HB@B@H@@@B@BCBA@UBA@AB@BB@@@@B@@B@BB@@@B@@@BB@@B@@BB@B@@@B@B@@@@@B@BB@@@@B@@@@@BA@@@AB@@@@A@@@A@C@A@@@AA@@A@@AA@ECCE@@A@A@C@C@A@AA@CA@@@AAA@A@ABGFKD@@C@A@C@A@C@AAGIEC@@QGIAEACC@A@CBABAB@BBPHD@B@BABA@A@AOK@C@CBIBABC@CCGMKCAAC@@BABAH@DADABAB@DAHAHAD@JA@AB@B@@A@@@ACAGCMAG@A@EF@@C@A@GCCEIACAC@ABABELABA@A@A@@E@E@@@AA@@AA@AAC@CAAAAA@EAAAA@@ABABCDAD@DADBDDBBBB@D@B@DABBBB@B@BBBBDBBBD@BABABAAQGGAE@C@GDADCBAB@@A@AAA@GG@@A@A@AF@@ABA@A@AA@@@AA@@@ABA@@B@@A@@@A@A@@@AAA@A@A@@@AB@B@BBF@D@B@B@@B@DFDD@FBB@@BBD@V@B@HB@BB@@BCDADBB@@DDBB@BBB@BAD@D@B@@BB@BABEH@@CBABAAQECAA@A@ABA@AB@AACC@C@CAEGAAC@AAA@A@ABABA@ADCBABCBA@CBA@C@IAA@C@EBA@A@ABCBCBEDCDIFMN@@BB@@BBHDFDHBFB@BB@@@@B@B@@@D@BB@@@B@B@FAD@@@B@D@BBB@@@@BBBBD@DAB@DBBBDDDBB@D@BADEHAB@BB@B@FDB@B@DBB@DBD@D@DBBBB@DDBDBB@B@@EBGB@@CBEBABCBAB@@AD@BA@ABA@ABA@AB@@A@@BA@@BABC@CBC@ABABCDCBC@E@BB@B@@DB@BBF@D@B@BABADABCFCBAFCF@B@F@@@DBFDDBFBD@D@BABADCBABCBEBC@CBCBCBCDAB@B@F@F@BABBD@B@B@DADA@ABCBCBA@GDADCBABAB@DBD@BBBBBBDBBDDDBB@BBFAB@FAFCBCFAB@D@B@DCD@B@DBBBBD@FABBF@FBDBDBDDDHBD@FAB@BBDAB@BADADAB@BABBB@DBBBBBBBDFBBBBBBBB@@@BBB@D@BBB@BBB@@@B@BBB@BBBDFB@@B@BBD@F@@AB@B@DBDBBBB@BBB@BDDBBBB@BBB@BBD@D@BBD@B@D@B@BAFAD@DABAB@DAB@B@BAB@@ABABABABA@ABABAD@BCHAJCHEDADABA@AD@B@D@BBBBD@B@D@B@D@B@@@D@B@B@B@DAD@BAF@B@@AB@D@FBDAB@D@B@BADABCFAF@D@D@B@B@BBD@F@BABAB@B@B@B@HAB@BAB@DABB@@DBBBDBD@BDBDDB@BB@DBBBD@B@DBBBFDFBDFFBBBDBB@B@DAB@DCD@B@BABADABA@AB@@C@C@C@GAC@A@CBA@@@AB@@A@ABA@EDADAB@BCB@@ABE@A@C@CAC@CAA@EACAC@ABA@CB@@ABEBABCBEBAB@BADBB@DAD@DAFCDADABEDADAD@BAB@BAD@B@D@DABABADABAD@DABBF@F@F@BADAD@B@B@DADABCBCF@BABAD@B@B@FBB@DBFBDBDBBBDBB@DBDBFBDBDBB@B@FBF@D@D@BBDBDDDDDBB@BDF@BBF@B@BBB@DBFBF@BEF@D@F@F@H@DADCBABAD@D@HCDABAB@FBH@F@F@F@BBDBFFF@D@DBF@DCFCBABABEBE@G@G@CAABABBDBDBFBD@DAD@DAFCFCDEFAB@BCBC@E@GDEBCBCFAD@BBHDDDDFDBFDF@DAFCF@H@F@DBB@DBB@DDF@H@F@FCDEDCBCBCDEDCDADAFCFCBCBE@CBABEDCFCBGDB@BB@H@B@BBFBD@BBB@D@BAB@B@BA@@B@B@BB@BBBBB@B@BDDB@@BBDBB@FFBBBD@B@B@BBBDBDDDDDDDD@BBBBB@B@BBF@BBBB@@DBBBB@@@DBBBD@@CB@B@FADBB@D@@@B@B@BBBBDBD@B@BBBB@BBB@B@B@BD@@B@B@BBB@@DBBB@B@@@DBBB@B@BBB@@@B@@@DBB@B@B@B@BA@ABABBBBBJBD@HBHAHAHAHCJAF@F@JAHCDCFADEFEDAFCFCBDDFFD@@B@BBB@B@@A@AB@@C@A@CBE@ABCBA@C@CBIBA@A@AB@DABADABABAB@D@B@B@BBB@BDDBB@D@F@DABC@ABABABA@ABC@ABA@ABA@ABABAB@@@B@@@BBBBBBB@BBBB@@BB@B@@BB@B@@@BBB@BBBBBBBB@BBBBB@BBBBD@BBB@BBD@BB@BBB@@BB@BBBBBDBBBB@B@B@DBB@D@@BB@BB@BBB@@BB@DB@@BBBBBB@DBBBDBB@BBBBDBB@BBBB@@BB@BBB@B@B@D@B@D@@AD@B@@AB@B@BBD@DB@BBBB@BBBBDBBBBBBBB@BBBBD@B@B@BBB@BBBBB@BBB@BBB@@BBB@BBDBBBBDBB@B@B@@@B@B@B@BBB@@BBB@D@@AB@B@B@B@B@B@D@BAB@DA@ABAB@B@B@D@@@BBBB@BBB@BBBBB@@BBB@BBD@B@B@B@@@DBB@BA@@B@BBB@B@BBB@@BB@@B@@B@BBBA@@@A@A@@@A@A@A@A@@@AAA@A@@@AB@@@B@BAB@@@B@BAB@@@BAB@BA@@@@BA@@BA@A@@@A@A@AB@@A@A@A@A@ABA@A@ABA@@BAB@@AB@@AB@BAB@BA@@BAB@@A@AB@@C@A@A@CAA@AAAAAAA@AAC@@AA@A@ABA@AB@@AB@@AB@BAB@B@BA@@B@BA@@B@BA@@B@BAB@B@BA@@BAB@BAB@@A@@@A@A@A@A@ACA@AAA@AAA@AAAA@AA@AA@A@AAA@@AAA@@AA@A@A@A@C@A@C@@AA@A@A@AAA@@@A@A@A@A@A@AAA@A@A@@AE@@@A@@@AB@@@BA@@@@@A@@@@@AB@@@@A@@@@@A@@@@@@@@@A@@@@@@@A@@@@@A@A@@@@B@@A@@@A@@@@@@@A@@@AB@@@BA@AB@@A@@B@@@@@B@@@@@@AB@@@B@@@@@@@B@@@@@@@@@@@B@@A@@@@B@@@BA@@@@@@@A@A@@BA@@B@BA@@@@@@B@@@@BB@@A@@@A@@B@@A@@@@@@@A@AB@@@B@@@B@@@B@@@B@@A@@@A@@@@@@@@B@@@@@BABA@AB@@@B@@@BA@@@A@@BA@@@C@@B@BA@@BABAB@DAB@D@D@BAB@B@@@BAB@BADABB@@B@BA@A@A@A@@BC@@@ABA@A@ABA@A@@BA@@@ABA@@BAB@BAB@BADABA@@BABABC@ABABA@ABA@A@C@A@@BA@A@A@A@A@C@A@C@C@@BE@A@C@C@C@A@ABC@A@A@ABA@C@A@A@ABE@A@C@A@ABA@A@ABC@ABABAB@BA@AB@BA@A@AB@B@BAB@@@B@BA@BB@B@B@@@B@B@B@@@B@B@B@B@B@B@B@BBB@B@B@B@B@B@B@D@B@BB@@DAB@B@B@B@B@D@BABBBAB@B@B@BA@@B@B@BABAB@BABADABABABABA@@@A@@@@@@B@@@@A@@@@@A@@@@AA@@@@@@A@B@@@@@A@@A@@@@A@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@AA@@@@@BA@@A@@@@@@@AA@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@A@@@@AA@@A@@A@@@@@AAA@@AA@@@"]],encodeOffsets:[[[103144,27450]],[[103255,28325]]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
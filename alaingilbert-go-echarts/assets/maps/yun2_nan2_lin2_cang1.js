@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("临沧",{type:"FeatureCollection",features:[{type:"Feature",id:"530902",properties:{name:"临翔区",cp:[100.082523,23.895137],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@ACIICAGCEAE@GEAA@C@CBK@A@CAAGCAAIAOBI@SFGFMLKHQFG@A@QAGEIAIAG@C@BFBBBFAP@RGPCTAD@TDBBBD@FBFFBLBH@F@BBFHJDDHBF@D@@D@B@BEF@D@BBF@DBBBBDBNBBBHFBFBBBB@BABABC@CB@BBDEFCLCFCLENGNEPCFCDMLAF@B@B@HCJEP@NBNCFOJGNCHABEFADQNILINCJMRKLKHMDIDEBADCBEBBJ@@@BKFEDC@A@GAC@AACBCDCBABAAEECAAAC@CBG@MAEAK@ABADBDBDFJBB@DAJCDAJAFEFADAHDDNFDFBDFL@BDFBJDJFL@BFHBFADCBGBCF@D@BBFBHDDFDDBDDFDDBLLHJBHDL@BDHBHBLDJ@T@HAJ@B@@@@@FILGFKHMFL\\HHFDLHJJHHJFHDBBFDBDRHJDRAPAFBFH@NEJBLFDZ@HDFNLPJLJJNDP@HDBJCN@F@D@PCLFHDBL@V@NABADAFADCBABA@@B@D@@@@AB@B@@B@@B@@BB@@@B@BB@@DBDB@@B@@B@@B@@BB@@B@B@BB@@BB@@@BBB@@B@@B@@B@@@BB@BB@@B@@@@@BBBBB@D@@B@B@BBB@B@B@B@BABA@AB@BDF@@@B@B@BB@BBBB@BADEFCBA@@@A@@B@@@B@BA@AD@@@BBBBBBBBB@@@B@BA@A@E@A@CDCF@@ABC@AAA@EBA@A@AFADA@ABCBC@@@@@AB@@A@ABE@A@ABA@A@AACAC@@B@BBB@B@@CF@@@BBD@B@@@@@D@BBBBB@B@@@B@@@D@@@BAB@@@B@@@@@B@@BBB@@DB@BB@B@DB@B@BBBBH@F@B@BAD@B@B@@@B@B@@@DC@@B@BB@@B@B@B@@BB@BA@@@@@BD@@BB@@BBBB@BB@@DB@@B@BB@@BAB@B@B@@BB@BDBBB@@D@@@@B@BBB@@BB@@@B@@@B@BB@@B@@@@@@B@@@B@@@BB@@B@@AB@B@B@@@BAB@@@@B@AB@B@B@DAB@B@BAB@@@@@BB@B@DB@BA@@B@@@D@@@B@B@@BB@@@B@@@B@@B@BB@BB@@BB@@BB@BBDAH@H@FAB@BAB@BBDABA@C@E@AB@@CBCFIBAB@FAB@BA@C@G@A@ABAB@H@BAFCFADAJE@@DCBA@AAC@ABCBG@ABA@ABA@C@AAC@@AC@A@ABCB@B@B@B@BA@CCEAG@K@ABGBEBABABEJMBE@E@CACEGCG@GACACAACACCAC@C@A@A@AC@AA@@AA@ABAFCDCDCB@B@BBB@@BFANEFCNGHCJEHIDEBGDIDCPKFEBADEBAHEDABC@A@@AEBADE@@BA@CBC@C@IBEBGBE@C@G@A@C@A@CBC@C@ABAB@DCD@FCPGDCFADC@@@EAIAE@AAC@E@KACAC@C@C@CJMDCFADCDAF@B@DBDFDJBH@LBBBBDDDBB@D@DCHELCLEJMFE@@DFFLBBH@LDJ@HAF@FAJ@DAPCREBAB@LIFCHENE@@BAB@B@FDB@@@B@BAB@@BDB@@BBBA@EDGBCBCDAF@@AF@D@@ABE@ABAB@D@@EBEBGDG@G@CEA@GAI@CDCBC@CAACCCE@GIYAAC@ABCDEBE@CACGAAA@@BABC@CACCACAC@@BC@GAAACCACAABC@CAABA@@FADABABA@AAA@EKCCEEAC@E@C@ABAJEDAB@@@B@B@DAB@@CAGAGAACA@@EIGIDMDMDQBC@EBK@EBOAKIGIECCAKDIHELCNCLCLGJMDGBGBC@MEOAGAEDIJILETKREREFCJCNKAEMGOCMCEGAAGAAAECEEAAE@A@CA@AAEEEAACEAACCC@A@AAC@C@ABC@A@CA@@AAAAAAAAAE@@@ECCA@A@IHA@C@@@AAA@A@GBA@KAG@A@EAC@MBA@CBCDEBC@CACBCBEHEJ@BGJKLEDC@KBO@IAEAICIECGCKISIIECI@MDIBCLGFE@@@@@@@MCMCICECA@GAAAAE@C@G@CBEBE@C@EACEECEACAC@GGYACACCEAK@EAEAGEU@CAAEIACCEACAE@E@IC@ABA@@B@@C@A@CB@B@BAB@BA@A@@@A@ABA@AB@@@@@B@B@@B@@@@BAB@@BB@@@@@BAD@@@B@@A@@@@@@BA@@@@@@@AA@@C@A@AAA@A@C@@@ADA@@@CCCCAECAAAEEKIECICC@A@@BHZBF@HBBBBB@@B@B@@@DADABABA@"],encodeOffsets:[[102508,24096]]}},{type:"Feature",id:"530922",properties:{name:"云县",cp:[100.129354,24.44422],childNum:1},geometry:{type:"Polygon",coordinates:["@@ICQGACECAAGCIEGGIIKGECGGK[KDKDKDIFGDEF@BA@@@CDAB@BAB@@CJABCBABE@G@EDIBCBADA@ABADGHADAD@@DBF@D@@BBB@B@B@@DBB@@@@BCH@DABCDA@@B@@BBHBDD@@A@@BA@GAC@EDA@CFCBABA@C@E@K@I@GC@@@B@DBF@BIDGHABABA@CBAB@BABCH@@EBCACBCBGDABCDADA@@BCBGJAB@B@BBDDB@@ADCFCLCFEFADEB@BADABA@@FEH@@A@@BCB@@@@CAA@ABA@CDCF@B@@ADBLBBDBBBBB@B@D@B@BBD@FAD@@@BBBNHDBDB@@D@DBDBD@D@D@B@D@FDB@B@JBD@DAB@B@D@DBDBHBD@JHDBR@NALBNDP@NEHEBDDB@Z@HBJFLJLFN@D@FAF@FDHFJ@PDJ@L@DAFAL@FBFTEXAV@@@^DR@J@L@P@L@`AXCFBLD^@X@B@NHFF@@@@@B@@@DBBF@BBDDBBBBFFBD@BBBBBHHDBFDDBDBDDDDD@@BABCFCDAFCHADADAFCDADCJ@B@BADABEBMBI@C@@@AAC@AADDBBDFFJD@DDD@BD@BBD@D@D@BAD@BABADADCBEDCDADAB@BBB@BBF@@@DAF@D@BBDBB@@BFBH@D@@@BADCBABCDABABAFENABCDABCBABADCDEDAB@BAD@F@FAJ@DBBHAJ@D@FDDBDBBBBBB@HAB@BBDDD@@B@DBBBBD@B@H@D@FADADCDCDCDCHEBCDADAD@F@F@FDBBD@BBB@B@DCD@F@D@D@FDB@D@FBF@BBBBBD@D@@@F@@BBBDHJBBBBDD@@D@B@DA@AB@B@BCBABAF@D@F@DBBHDD@BB@DB@@BBAFBF@BDDFHDDBD@B@B@@@BCDAB@B@B@BBBB@FF@B@@CBAB@D@BBD@DBBDBFBBDDDBBFF@BDLBHBDBFBH@DBDDDBB@BAB@DFJBD@HBD@D@BBFBF@BDFBD@@JH@BDBDDFD@@BDDFBBB@BBJBBBB@DDHFDH@B@@@DDDBB@DBB@D@PBB@BDBBBBBJBDBB@B@DDHH@@BBA@AD@B@BAD@B@BBHBDDD@@@BB@@DABA@AD@B@H@DABABA@C@E@C@A@AD@@@BBF@F@B@FBF@B@DBBBDBD@BAB@B@D@B@DBF@B@DADABCDAB@@CDADAD@B@F@BADABGFADEF@@ABABB@BBD@F@D@TBR@PBR@J@LFRBPDLDHBJ@LDNFNDRBHBJ@L@HAHIJGHEHAH@@@FBB@FDDDB@D@B@DCBEBE@ABCHK@@BABCBABADCDADABBHBBBB@DDD@BAB@BEFIDGDCDADAD@J@DA@ABA@A@ABA@ABC@AAAACACAA@GAGAK@ABGBABADAB@DBDBBBD@FEHG@ABIBI@AAACCCA@A@A@A@GBABEB@FAHADCB@DCDADCBC@A@EBA@@BCFCH@FAD@@A@@CCCA@@@A@CBCDAB@FABCDADCB@@A@A@@AA@@AABC@KBCBCBAB@B@@@@DBDB@@BB@@@BAB@BADADADABABA@E@CDCBADCDCBC@A@AAC@@AC@A@A@AB@BABABABBB@B@D@@@BA@@@E@A@@@ABAB@@CBCAE@AACCE@A@A@ABCDEBEBE@CAC@A@A@A@A@A@A@C@@@AB@BA@@B@B@HAF@@@B@BAB@DABAB@DEB@FA@AB@B@B@B@B@DCB@@@B@@ABC@@@@@C@C@ABC@A@@@EBE@CBCBEDCBADADABA@@DC@ABA@A@ABC@EMKYMMIKMCMAECGEQDUBQ@ICMAABIBIBIAGCU@AAQCOBEJSDSEUDI@@@QHWCWEOEMBABABABAFGDCDCDODS@CAOAGFMBWDK@I@ACKCO@G@SACAACGEWEW@AEMESGOAIGQOMIEIESCWEGAKCWCWGcHOJG@E@OEOGIKKMBGBI@ONQNK@GBKIY\\m@]@@AAAC@CBEAA@@CA@AA@ABA@@@A@ECA@A@AB@@MFGFEDKJA@ABQFODCBI@EBE@GBI@KCG@AAEKCE@@EFINKFKDGFCDC@A@CACCAAAA@KAGCICECAA@E@CBCDEBCDIN@D@D@DBDBD@L@FBD@BBFBJ@F@@CDEBCDOHEDC@CDA@AB@B@DAD@D@B@D@B@H@DAFAHAF@J@DAD@DAB@@CFABBF@@@BADCBGFABCFABEFOLCDCJAHCFGJIFGDMHEDMFEB@AA@AAA@A@CDCDEDAB@BBB@@BBD@@B@B@B@DBDDDDBBBBDBD@HDHFHBD@D@FAFINAFABABAFAH@B@LBHDF@DABA@A@A@A@AD@B@BBD@@BD@B@DAB@BAB@BAHAD@BBD@BABCD@@IFCBEBEDABG@A@AB@B@B@H@DABA@EBA@ABEJAD@DA@@B@F@DABCBAAA@ABA@EBG@G@CBAAA@@AA@@AA@@AAAA@@@@A@@@A@@AA@@@A@A@@@C@@@AB@@ACAA@A@@A@@@@BA@A@ABA@C@A@ABAA@@@@@BA@A@@@A@ABA@@@AA@@A@@@A@@@A@@@@A@@@AAA@@@A@@@A@@AA@AAA@@@@@@CA@AAACA@@AA@A@A@AB@@AAA@@@CA@@AAA@AA@AA@@AC@@A@@@@ABA@@AA@A@A@@@AAA@@@CD@@A@A@@@A@A@C@ABA@E@G@AAAAA@A@@C@AAAA@@CA@AA@@@A@@@@@A@@BA@A@@@C@@@A@@@AAAAA@A@C@@@@@AAC@A@@DE@@@AAA@A@AD@DBBBB@B@BAB@F@BAB@@@BA@@@@D@DABAB@BCBEB@B@FAB@BBD@BA@@DEDCB@F@B@B@@A@A@@AAAAAAAA@A@@BCB@@A@A@@@AB@@@B@DAFEBC@AAAAAA@@A@A@A@@CE@ABAB@BA@A@A@A@AAA@A@A@AC@A@AAAA@@@@A@@@AAA@@A@@@AA@@@@AA@AA@@A@@AA@@A@A@AA@@AA@@@@AA@@@CACA@@AAA@@@A@@AA@@@@AA@A@@B@@C@A@@@ABABCDEBCBABMBU@K@CAEGDK@O@C@EDMAIGCO@MCIIIKKOEMGCY@ECAKFI@MEGEAOBQB"],encodeOffsets:[[102334,24608]]}},{type:"Feature",id:"530921",properties:{name:"凤庆县",cp:[99.928459,24.580424],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BABC@EB@@CD@@E@G@AB@@CHABC@GAI@KAIBA@C@A@ACAAA@AAC@AAA@E@CBG@A@EBG@CBCDCBOFC@A@C@A@CBADEDC@C@CBCBAD@BG@IBKAA@C@A@@B@@@@A@C@@B@B@@A@CBADABADC@ABC@CBADADAHCDADAFA@ADCFAB@B@BA@CDC@CAEACGEAA@ABEHA@C@CCE@@@C@A@EFGDA@EB@AA@@A@E@@C@AAAAAC@A@@BA@AAE@I@EA@A@GBA@@E@AAAC@KDCAEAECAA@@KBIHGREHGNEFQF@FAFAHABAFNHXJ\\AVR@@JHHJFBNDHJNHBV@LDNFTJVFNLPDLFNRLPN@@JXPHNBPCTEREBBBBB@DB@DBD@F@F@X@FABCDCBEFCD@D@D@BFDHDH@FB@@BD@D@FDHBB@D@D@@CBC@GAE@@@CB@BA@@B@@@D@DBDJL@JCDCNADCBCBE@E@CBAB@B@BDDLFBBBB@FANADCDEFC@E@GACAEAABEDGHCD@BABBDFBLDFDDFDHBBFFBBB@BADABAD@JAFBBBBDDBDNFTP\\LFVDRAP@DTEPCZARHBNCPBPPLNVLPJNBX@XDBH@B@NDNJRBVCJETGTKL@@QFMFIDQFCBBPLHZJTLJHFXDFLD^HRHCBIFEDIHMLMJDVBFDFBFRBP@D@JDFNJPFLJHPHFHCDGFGLGLADMT@JFLJLPJNDRLPJNJJJFRJLD@F@JCB@FBLFRTPNP@RCLBDBHBFFHJFJBFBDB@JDNDD@@@@ABA@@BAJKDCBADABADAD@F@BABA@@@CDOBI@C@A@A@@BEBCBA@E@EAC@EAEBG@C@CDI@@BEBE@CBE@AB@BEBADAHCD@FAHCD@F@JBDBBBB@F@DB@@DD@DBB@FBHBF@JBD@BBBDBDDBDBBBBB@@@D@D@DAD@B@B@B@B@FAB@B@DDFHBBBD@@BB@BBB@BDBB@DBD@DAF@D@BAHEDCD@D@B@DBD@B@@@BAD@FBB@D@BABCDAB@BAD@BBBBDBBAB@DABAB@BBBD@@DBD@D@@@BBDB@@B@DAD@H@H@BABABA@ABE@C@CBAD@D@D@D@D@FBD@B@BABABC@AFAFCBA@A@CCECE@C@I@M@AACAC@EAC@ACCCACC@A@G@E@CBABABAAA@AACACC@CAAAAAAC@CACAACCACA@A@CAAA@C@ABCBC@AA@@CAA@ACAAA@A@AFCBA@AAC@AAAA@BE@@@AAAAABA@A@C@@ACAAAAA@@AAC@A@AACAAAAAC@CACCAECEE@@CCIEAA@ABC@A@CAAAACCAC@A@ABA@EBA@A@A@A@C@E@A@A@A@C@C@A@C@A@@@CAC@AAA@AAAACACCC@A@@@C@C@AAC@AACAAAACACAEA@AAC@AAC@AAA@E@AAAAG@C@A@C@@@CBE@C@A@ACCA@@AA@A@CAABC@C@@BA@A@A@AAAAACCCCCCC@@A@A@A@AAC@ACCCAAAA@@A@@AA@@AAEAI@EAA@@@ABA@A@ABEBABCDGBC@I@GAG@C@EBA@AB@DB@@BAB@FCJAFAF@F@FBFBDDDBDFBF@DAF@D@DDDBBBB@DBH@F@DBH@B@DAFBBBB@BBBB@F@D@DAB@B@DBBBBDB@BBJFLFHFJLHDNJBBDD@BBABA@@FEBCHEBABC@A@E@ABCBCDC@@BADCBABC@C@AAE@C@A@C@ABA@AACACAA@C@AAE@E@A@EAE@A@@BCB@D@F@D@B@BABA@C@G@ABCB@BA@CA@@A@@CCACAG@A@ABC@A@ABCB@AA@@GGCCA@A@CAIAAAAACA@AAA@O@CAA@CAACC@C@@@ACGGECCA@AAIAAAA@AACEAC@@ECCCCA@AIG@@ACCE@AAEAE@A@CAC@GACEI@CBA@AAACCAC@CAGAEACAGCK@AEEAACCACEACAAA@CAC@A@CBADA@@@AEEA@AA@A@A@ABADC@A@@@A@AACCCEGCC@AAEBEAA@@CAA@@ACCAGCAE@C@E@ABABADA@A@@BCBA@C@@@CCAAAAGIACAA@@@E@@@CACAAAAE@EAC@A@ECC@C@E@C@CDA@A@AAC@AAECE@E@C@CBCBADGFCDCDCDCDCBEBC@G@A@C@AAAA@C@AC@CCAAA@GBA@AAAACACAECC@I@GBAA@CBI@E@EBC@ABAFCDCBCBADABADCBAFMBEBABADCBADABC@A@@@CAGAE@@AAAC@A@CBE@C@@AE@AAA@ABABCDCFCDABCBCBA@ABC@A@C@CAC@AACC@CCC@EICEAACCBBD@BB@@D@J@NAFABABC@A@ADIBCDCBEBCBCDGBEDCDEBA@AC@CCCCCACAECCAGGAAAA@AACEEAAAACCAAE@AA@C@@@A@@@@EEMGA@W@]@KCEAWD_BK@O@K@I@Q@]C@@U@WBSFAE@EBKBE@C@KCI@OEICG@EKJS@MBABEDA@CBAAA@AC@ABCDC@A@@A@CBGFABCDABE@C@AAIEECC@GDCBAB@F@D@BC@CBCFAB@DA@"],encodeOffsets:[[102170,24826]]}},{type:"Feature",id:"530923",properties:{name:"永德县",cp:[99.259339,24.018357],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ADEDCB@BAB@DB@@@@DA@AB@@@FG@EB@BABC@AFABCFEDEDKDEBC@@CAAC@A@ABAHIDA@AB@BCDCBAHCDADADBFA@@DGBA@ABADAB@BABAHGJC@AAE@C@A@@HDJ@L@F@D@B@BADADEB@FCD@HBB@@AB@@@CCGAAA@@@AB@DCBA@CDG@A@@A@CA@@@A@AAA@AC@E@CA@@AAAAACACAA@ACACAC@A@E@C@EB@@GBE@G@A]@CAACAA@CA@@E@@@@A@@@A@G@CBADEDCCAC@C@G@A@IBA@sLA@iSA@kCA@]QAAwKa]OM@@@@CC@@@@]YGEc_EKHMLUyMECqaAKEgOSAC@@EGO@S@eM@C@@KEGMCGa@AAig{WUQgIBB@BBB@D@FCBCDC@ADCDCBBBADADADAD@BBF@BADAFABCD@DADCBCDCBABCAA@ABCB@BBB@@CBABEACBAAE@C@ABABCAEBA@CBC@C@EJCNEJEHKDG@A@WACAGAOCKAICIGIC@@YGCA@@M@E@C@YEAAAC@@AACACC@@A@EJA@A@CA]MCAC@C@AF@DDFBB@BCDADABC@AD@@BBNJDD@D@BAJCNENCBCAGCECC@C@A@GT@DBBNHLFHB@BDBHHDBFBDD@DGFCDDFBDDD@FGL@BCB@DDHAFABEJCLAJAP@LBJ@NBLAFABKFIDKDGH@P@XAD@F@JAL@@BBA@CDCD@@CBABCBC@C@C@EBC@CBADABABCDEDCFADEBABEDA@CD@BAB@DBFADAF@B@@A@A@A@IAE@C@A@CAA@ABAD@B@B@BBBBBFDDBDBBBB@@@BB@@@B@BDDDBBDAB@DADAB@DADCHEDA@A@E@@@CB@B@B@B@BDF@@AB@B@BCBCFAF@B@BBDBBHDFBFDB@BBDBDDFFBDBB@@@B@DBD@B@BDBHFBD@B@BAB@BBBDBDDHHHFBBB@FBD@DB@D@B@BADCB@B@DBB@@B@DBJ@BBFBBBCD@D@B@BDDHJDD@@B@ADBLOHOHGFED@PJT@@TLNPDDGTOFADKHUHSVPPNJRLTNTPJNBPJLLHBBHBNJ@PNJLBPDJJDJA\\A^HJBDDDHFNRRZPFBL@@@@@BJRNPJRPBJBHBX@PFTKHGBMJMJIJE@SDIFGBODKR[FS@CDKAI@MBQDAHGJMFGLSAOGEMQAIFgBUBIGK@@REFBJBPEREZEPAD@PANDDJNJLFJCLC@@@@F@LBJHBJDJJDLD@NCNBRFPFHLNJTDLB@LFLBL@JDMGBEBABGBE@EREFEHMFGHQJGLA@@BBFDFBDBLCD@BB@B@FB@HAB@B@@F@JBF@BAB@@@BBDBBBBD@@@@F@BB@@BFAB@HCFEB@D@@@F@DDD@B@FGBAB@FBDHFBDBD@DCB@@A@ABADEBCB@BEBCDCBGBCBCDAD@BAD@BCBABCDAB@@@@A@AD@B@@@@@@AB@D@B@LBJAH@@ABCDADAD@D@FCBCDAB@D@B@D@PEDADCDAH@FAB@H@DAF@B@BBD@BBB@BBBDB@D@B@JALBJ@HBD@BADG@@BAH@F@@@DC@@FAD@BA@AB@@CBADEDAD@@A@C@EBADAHCD@FDJFBBD@F@BADCBAHEDAB@@@@BCDAD@BBDB@BBDAB@FCBANAT@LIBE@E@CEMIKEKAI@G@YCAACGFMFO@MCKAMBQ@CAIGC@GACACAC@A@A@CBC@IAA@A@ECC@A@C@C@C@CACAC@@@CACAMGAA@A@@BC@EAC@A@A@C@AAAAACAAAAKBC@@"],encodeOffsets:[[102113,24714]]}},{type:"Feature",id:"530924",properties:{name:"镇康县",cp:[98.825284,23.762584],childNum:1},geometry:{type:"Polygon",coordinates:["@@AA@@CGACA@KCCAEAC@E@EACACAC@K@SCI@ABCBEDA@AACAC@@@A@ADABA@A@AB@BCH@BA@@@AACE@A@C@ACC@A@@@ADA@AB@@A@@DC@A@@AA@AAAA@E@@@AB@@@@AB@AA@A@@@ABAB@@A@@@BEA@A@@B@BAB@B@HA@@B@@A@AACAA@A@A@A@MAC@CBCAA@ECE@UBM@G@KCIAICKCMAGDUBIAMBEDGFIBCBA@ABKPGHABEBCBCDAD@BAB@J@DBBABBDAH@H@DBB@B@@@DA@@B@DAB@BA@@BBBAFABADA@@H@DAB@@CB@AAAA@@@A@@B@BBBDB@B@@CDCBUJCB@@@B@BFJBB@B@D@JABADGDABABC@AACE@AGQ@AACMKCCACECKEAAA@@ABGAEACA@G@MAGAC@A@@B@B@BABCDA@@BARBD@D@D@DA@ABA@C@GDA@A@E@GA@@A@@J@@ABA@GBMB@BERY\\CFERKVILKHC@CAC@CBCBSI_CGF@@A@@B@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@@A@@@@@@@A@@@@AA@@@@@A@@@@@A@@B@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@B@@@@@@@BA@@@@BA@@@@@AB@@@@A@@@A@@@A@@@A@@@A@@@A@@@A@@AA@@@@@@BA@@@@@@@A@@@A@@@A@@@@@@@AB@@@@A@@@@@@BA@@@A@@@A@@@A@@@@@@@@@A@@@@@AB@@A@@@A@@@A@A@@@A@@@@@AAA@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@AA@@@@@@A@@@AAA@@@@AA@@@@@@AA@@@@@@AA@@@@@@@A@@@AA@BA@@AAB@@A@@@A@@@A@@@@B@@@@A@@@@B@@@@@@@BA@@B@@@B@@A@@B@@@@@B@@A@@@@@@B@@@@A@@@@@@B@B@@@@@B@@@@@@@BA@@@@@@B@@@@@@@@@B@@A@@@@@@B@@@@@@AB@@@@@BA@@@@@A@@@@B@@A@@@@@@@A@@A@@@@@@A@@@@@@@@@A@@@A@AB@@@@@@A@@@I@ICFPDFPFNFJHHLJPELOP@FJTBR@BCLATQ`APDNDLHPNPDRAFIDOAGBQHAJPPPTLDNDPIPKVANLLFLHHJLNDHJNDJFNLJTD\\@TBNNNTJFPFPFXNNNFJDNDJHFNHJDJFFHDBFD@BBFB@DDBB@B@B@BABAB@B@B@@A@C@CBA@AB@B@@BD@B@B@@BBB@@B@B@BAFCFABAFAH@DADABEHEFCD@@A@ABBBHDLHDDDBBBDBHDBDDDFHBDDHBBDDD@FBH@F@D@NFD@HBDBF@F@B@@@B@FDBBBBD@FBD@D@BBDBDDD@BDBBBBDBF@FBBDB@DBFBD@DBD@D@F@DBD@DB@@HBH@BBDBD@HBD@DBFDDD@BBB@D@DADAD@D@@@B@@BBB@D@F@HAJAFAFAD@@@D@DBFBFBDBLFLFD@D@DB@@@@@@DAD@JAD@FADBB@DBDDBHDFDJBBBBB@FBH@D@BBDBTUVGLGBCPEHSCCMOSK@@IS@OFCHEPGPGAKBCA@@@CCGICC@A@A@CDCAAEAAAI@CAA@@@AA@C@ADABC@A@A@CCAC@EAA@AAGEGGCCCAAA@ABA@A@AACGECA@A@AAC@C@A@@AAACEECCCAAAA@ECEAGCAAAC@A@ABEDEDA@A@ABA@@CE@A@A@A@ADA@@F@B@B@FCDGBC@CBABC@CBAACCACC@A@A@@AA@@A@AACACAECAAAA@A@A@ABCBAB@DBB@D@F@JBB@B@B@@@@ABEBCAE@CBA@ADCB@FCBAFABCDEFCDCBABABCDAD@FAD@D@D@DABADA@@DCDCB@AA@@BK@I@EBC@W@OHGLCJCLEBABEAK@MAI@KBOBIDKFIBABECG@CDA@AHK@ECCACCEDCHE@CCCEACAGGCA@AGAKEMGAA@CHSB@D@D@FDHDDBDAFMDMBI@A@CCCMIAA@@BCD@BABCDC@AAACE@CBED@D@DB^NDBB@B@FIB@@@DDDBBB@@BDBBZFD@F@N@@@DBZH@@JDJHJDLBPDHBDBXBB@H@LCFGFIDMFID@D@DAB@FADBBABAD@F@BBDAFBBADA@@AA@ADABAB@DBBADADCDABC@CDCBABEBC@AAE@ABCBCBCBCAADADCBCD@DCDA@E@CAA@AAAGCGCMGEAECC@OQCCMMGAIAUAA@AAMMGIOQCAWCQQSDICQOE@AAGAAAA@KCAA@@A@@F@B@@@@ABE@E@ABCFC@A@A@CAEEAAC@AA@A@@@CCAA@EBGDA@@@@ABE@AB@BA@A@@AA@@A@CBAB@@@@@A@@@E@CA@AAE@A@CBA@A@@AACACA@AAA@ABA@@BA@AACA@@C@A@ABA@@@ECC@AA@A@@DCBAB@BADBD@BA@ABC@C@@CCA@CBA@ABEJA@ADA@@@@@@@@@AB@@A@A@A@"],encodeOffsets:[[101542,24222]]}},{type:"Feature",id:"530925",properties:{name:"双江拉祜族佤族布朗族傣族自治县",cp:[99.827697,23.473499],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@aaqIUQOCodgNKD_LwJXEPFhZ\\tFFLENJ`CXAFBbETY`]OMA[TGFSBCJANEDKJKJGPCNEPCFKFMDA@GBE@_JALBRFLDPBPDRDNHLHJHDJFJFHBJBJCJENIFEJGHCJCRDHFBJDR@J@@BJ@J@J@V@FAHHHHFH@JGFDFJHNDHDRFLHLJFJFHBJFJDHJDHDNBHBLDLJJHJHJLNDNBPFPJNDNFJDJBFHTFPBBDHJJJJPJNLLHLFHHHPTPLJBH@@FDDBBB@B@BABA@CN@FL@FBNBH@DAD@BBDBFFBBBADADCDABBD@HBB@D@FCLE@A@@AIFADABCFAJCNCLGLKNQDIJMJKRMBCFEBADGHMPIDEAM@MFODI@G@A@ABENKDCDEFOHMFMDKDEDKFEAC@ADAD@BABA@AAAAAAEGEAAMACAAAAA@CAE@A@CFE@A@A@CC@E@GACCGIAE@A@EAGAKEEEAC@AACA@SBCDSHO@QBOAEAAAED@H@JBJBHFRBB@H@RELGNKHETEJ@PAJBBBHDBB@D@BAL@D@DBBHFF@FBHDDBJJBDB@B@BABABC@C@@@A@AA@AAAA@GAEGY@AB@D@JDFDLJFFBBDBBFDDDD@@B@BC@@D@B@B@BBB@D@@@BB@@@@@@B@@A@@@@B@@@@A@@BC@A@@@@AA@@BA@A@@A@@@@A@A@@@@BAB@BAB@@@B@B@@ABA@A@ADAB@D@@@@AB@BAD@@E@G@G@A@CAECKACGQAEGMQWIAkiKcBK@AB@@A@@@@JasCCA@@CEsPg@AH_BK@I@QA@Ok_]m[A@]AuRD]V]V_FGBcFgAsq"],encodeOffsets:[[102257,23789]]}},{type:"Feature",id:"530927",properties:{name:"沧源佤族自治县",cp:[99.246196,23.146712],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@LABVFFBBB@@@DBrTjGB@PA`CdErU^CL@F@NBVEFALAH@LFJDpzH@TAHE\\SNB^PZ_FSAaBEDWI_FMEKsEY[EgFOMBGUCMKKSOUQKIAC@@AAACCCUQBAD@BABA@E@C@A@@DAB@B@BABA@CAA@CD@BADC@AB@@AB@DABC@ABA@AAA@ABABC@CHGB@@@AB@BB@BA@@@@@A@@@@AAC@ABC@ADC@KDC@A@AAAIA@CBC@@B@F@B@@@BAD@BADABCDCBEBG@EBCBEFA@EB@AGAEAEAECOIAACAAAAAAAEAAAIGA@CCC@CAEEECGIAAB@@A@IBC@@EGGCUEMAMEGICEJQBKBKH[GDYFWDQHQ@M@OEM@KAODOFIHKDM@ODEBGBIDOHQNEDEFONILIHIDEBEDK@E@EADE@EC_AAMGECMEC@C@EBG@G@GAG@G@EAGACAEEACAACECCAEC@AEIGIBE@GB@@AB@@AB@@A@@@@@@BA@@@@BA@@@@B@B@@@@@B@@@B@@@@AB@@@@@@@@A@@B@@@@A@@@@@@BA@@@ABA@A@A@@@AA@@@@A@@AA@@@@@A@@@A@@@A@@@@@A@@@@@A@@AA@@@@@AB@@A@@@@@A@@@@@A@@@A@@@A@@@@@@BA@@B@@@@@@AB@@@@@BA@@B@@A@@@AB@@A@@BA@@@@BA@@@@@@B@@@@@B@@@B@@@B@B@@@@AB@@@@@B@@AB@@@@@BA@@@@B@@@@@BA@@@@@@BA@@@A@@@A@@@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@A@@@@@@@@@AA@@@@@A@@@@@@@@@AA@@A@@@@@@@A@@AB@@@A@@@@@@@@@A@@@@@@@@A@@@@A@@@@A@@A@@A@@@@@AA@@@@@CAiDEE[BK@iEMFIDC[ECaLYNWLcTALBPDPFLN\\@JEHIG@@A@AB@@@@A@@@AB@@A@@@A@@@@@@@A@@BA@@@@@A@AA@@A@@@AAA@AAA@@AA@C@@AA@@@@@@BA@@@@@A@A@@B@@A@A@@@ABA@A@A@A@A@A@A@@@A@@@A@@@A@A@@BA@@@A@A@A@@AA@@@A@@@@@A@@@A@@@A@@AA@@@A@A@@@A@@@A@@@A@@@A@@@@@AB@@A@@@AB@@A@@@@@ABA@@@A@@@A@@B@@@@@@@@A@@@@@A@@@@@A@@@@@A@@@A@@BA@@@@@A@@@A@A@@@@@@@@@@BA@@@@@@@A@@B@@@@@@A@@@A@@@@@@@@@AB@@@@@@A@@BA@@@@@A@@@@@A@@A@@A@@@A@@@A@@@A@@@A@@@@@@@A@@@@@@@A@@@@@A@@AA@@@@@A@@@@A@@@@A@@@@@@@A@@B@@@@@@@B@@@@@@AB@@@@@@@@A@@@@@A@@@@BA@@@A@@@AB@@@@A@@BA@@@A@@@@@AB@@@@AB@@@@@@AB@@@@@B@@A@@B@@A@@@@@A@@@A@@@AB@@@@A@@@A@@@A@A@@@A@@@AB@@@@@@A@@@@@A@@@@@AB@BA@@@ACA@@@@@A@A@@@C@A@@@@@AB@@@BADA@@B@@A@ABA@A@A@AB@@AAA@AA@@A@@@A@@@A@@@A@@@A@@@A@ABABA@@@A@@@@A@@A@@@@@@@A@A@@@@@@@@@@AA@@A@@A@AA@@@A@@@A@C@@@A@@@@A@@@@@A@@BA@@@A@@@A@A@@@A@E@A@@AA@AAA@@@@B@@@@@@@B@@A@@@@@@B@@A@@@@@A@@@@@A@@@@@A@@B@@@@AB@@@@@B@B@@B@@B@@@@@@@@@@@B@@@@A@@B@@@@BB@@@@@@@B@@@@@@@@@B@@BB@@@@@@@@@B@@@@A@@@@@@@@@@@@B@@@@B@@@B@B@@B@@B@@@@BB@@B@@@@B@@B@@@@@B@@@@@BB@@B@@@@@@@BB@@@@B@@BB@@B@@B@@@@@B@@@@@B@@@@@B@@A@@B@@A@@@AB@@A@@@A@@B@@@@@B@@@B@B@@@B@@@@B@@@@@B@@@@@@B@@B@@@@@B@@@@@B@@@@@@@@@@@@B@@B@@@@@@@B@@B@@B@@@@@@@B@@B@@@@@@BB@@@@BB@@B@@@@@@B@@@@B@@@@@@@B@@@@B@@@@B@@B@@@@@@@@B@@B@@@@@@@B@@B@@B@@@@@B@@@@@@@B@@@@BB@@@@@@@BB@@@@@@B@@@@B@@B@@@@B@@@@B@@@@@B@B@@@@BB@@@B@@@@@B@@@@A@@B@@A@@B@@@B@@B@@@@B@@@@@B@@@@@@@B@@B@@B@@@@AB@@@@@B@@@B@@@@@B@@@@@B@@@B@@@@@@@B@@@@BB@@@@@@B@@@@B@@@@@@@B@@@@@@@BB@@@@@@@B@@B@@@@@@BB@@B@@@@@B@@BB@@@@@BB@@B@@@@@BB@@@@@@@@@B@@B@@@@B@@@@@@@B@@@@@@BB@@@@@@B@@@@@@@B@@B@@B@@@@@BB@@@@@@@@@@BB@@@@@@B@@B@@B@@@@B@@@@@@@B@@A@@B@@@B@@@@@B@B@@@@@B@@@@@B@@A@@B@@@@CdEFDBFAFCDDARFHDDGDEDodW@QIGEIBCDEFDHJDNLRXHHL@VK@@JF@NEXIVIR@ZHNDJAN@FYPGNBRDRMHQTCBMTABA@@BA@@@BBDDB@DBFBH@F@JBJDNDFBH@FBBBHDFBB@HBJFD@DBBCFEBIBADCB@D@FBDAB@BABANIB@BBD@BAHEDCB@LEHEHDFFLJLFJDPFLBLDJBFBF@D@B@B@@@@@@@H@LMBC@EBIDIJILGTKFKCMEMBGBCFQRQNKPEJBJFPFL@RIFCLEPKRGTKNKRE@@RGRGNIDAFAHAPJJFLFHFLFJBB@FBD@DB@@B@BBFBBBB@B@@BD@@BB@@BBBBDLNf^^H|GZNLABId@BAJIB@B@B@@@dDNMNC@@DWDK@A@ABA@C@@@ABE@CBA@C@@@ABIVc"],encodeOffsets:[[101821,23911]]}},{type:"Feature",id:"530926",properties:{name:"耿马傣族佤族自治县",cp:[99.397126,23.538092],childNum:1},geometry:{type:"Polygon",coordinates:["@@GCAAEAG@EAMCICIAE@G@EACAA@CCAACAC@A@A@C@CA@@@@AA@AA@ABA@CBA@CAC@CAAA@A@@BABAAAA@CAC@A@CBE@C@CACACAAAACE@AJCFEFCJELAZELOV@TBTHNJJVR@@PNLHNNZFFBVLNHJFDLBZELQJOBOFUHELCXAL@DETCbOLMDMJELFHFHHRDNNAHAB@BA@@@IB@@@HBF@B@B@HCD@B@BAB@@C@C@CACBQ@AB@DCBA@A@A@AB@D@HBNBH@B@BDBFAH@BB@BBLFFDBDDDNLBD@BHR@BDFBBD@BABAHCBCBA@I@C@AAAEI@A@A@@DAVIDADC@@@ACAAA@A@AB@@@B@BB@BDA@@BA@C@GB@BCBABEAA@AB@@ABA@C@AB@@C@@@AAA@C@GBGACBAAA@C@IBA@ABCDCDAFABAHGLOBAB@DAJAHEFCNAJBVAHCNBLDJDJBLDH@N@VAF@FDB@DBDAD@NBB@B@B@B@DBBBB@@@@AB@@G@ABA@A@AB@B@AF@@B@@@BABA@@B@B@@BBA@@@@BA@@F@B@BB@BBB@@@BCD@@@BA@@BCB@B@@@BDD@B@D@BDFBB@@B@@ADG@ABAB@B@BABCB@@@D@DBBBB@FCDABAJ@TDL@D@DBDBFBF@D@FBDBLDB@BDDH@@BBB@B@B@@@BA@@@@@@@@B@BCB@FIBAB@DAB@DD@@@DAD@BABC@CAABA@ABCD@@@BBBD@FD@@B@BAB@D@@@DBBBB@@AB@BAB@BBB@BDBD@BB@B@DAB@F@BBB@@D@F@@@B@@@@BADAB@@@BB@@@BABA@@BAF@B@@B@HCFAB@DB@D@@@BBBD@BBFFDBB@B@D@DEBAF@F@BA@@@@@A@EB@@@BBLDB@BBHBBBF@RPJDTCRRXDDBPRHJNNBBB@VBJBHBNNDDPRD@FDFBNHHDHDhJVR|XjhBBb@DHHNLF@@@DfNT@P@FH@@BDPTFhBLrbFDzNKVGNFLd`HF^Z@@@@DD@@@@PNb^xLBB^RB@lDB@jTB@tKB@JAB@H@D@D@DBCDCFAB@D@H@B@@@B@@F@@@DBB@DBBB@DB^H@F@HA@@FAD@F@B@D@DBDB@BBBBDBDBBBBBCBCHGBCBAB@BCDAJAFCH@F@BADABADI@@BA@ABADC@@B@@AFEHCJELCLCLCNELGHEJK@E@@@@@ABI@G@SCIAKAGCG@ACKAGGIKKCAECCCCAECCCAGAE@A@CDEHADABCAEEG@AEKCIAICE@AEKACCEMECCBGBCFEBEBIDCBI@CAAEIACACBCBA@EDMB@BA@A@AAACAEC@@AGKISOGOGGKEKGMKOIIIIICGAAEOGSAECIEICMIMEOAOCMKMGIGIIICKAKAGCMCGGIICIEGAIEIEGKEKCQCGGMEIECIHG@GEGGBG@E@U@I@IAI@@@ICQAIGEQCIDGDIHEFMJIFIDIAGAIEIEGCGIGKCMCQAOCOEKAQBK`IF@HAB@NCLEDEFODMHOLILIFCBMDIG@oyICKEG@KBEBUFMAE@K@]DqVcF_DOBA@iHqSCA@@A@AAEEAUKB@@UdAJ@B@@@DAB@DAF@B@@@DAB@B@BCLCX@@MDMNcC@@A@A@A@IJABc@AJKBYM{H]Ge]KMACAA@AA@@AC@@AA@A@AAEAAAA@@@CAC@EAA@IAKEGEKEIEOIGBEBCBMJQHQH@@QFMLSLQHOLKFEDQJK@OEIEIAOFMLQRERADAHFNDNELSLKHIJCJAJ@FADKNG@@@@@@@A@A@C@E@EAIAKCKAOEICKEKIEEGCGFKFA@CDGFABC@AAA@MJABABA@CBEAC@A@CDABAJEFADCAC@IEGAA@EA"],encodeOffsets:[[101293,24064]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("怒江傈僳族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"533323",properties:{name:"福贡县",cp:[98.869132,26.901831],childNum:1},geometry:{type:"Polygon",coordinates:["@@@BABA@G@EBI@GAE@OFMFGDCDC@A@AAAAC@A@C@C@ABA@ABC@ABEDC@CBCBCBE@CBC@ABCBCBC@CBC@A@C@EAE@E@CACAE@A@@@ABAB@BABAD@DAFAD@BA@@BC@EAC@A@AAAA@AAC@AAA@AA@C@A@CA@@ECCCA@IAA@AAAA@@AA@@A@@BA@@BBBBBBBBB@D@B@BBB@D@B@B@B@F@B@BAB@B@B@BBD@DBF@BAD@B@D@D@F@D@FAD@B@BBDBDDDBBBBDBD@BBB@D@@@BBBBBB@BDFBDBBBFBDBF@DBDBDBD@DBD@D@B@B@DABADAD@B@DBBBB@BDB@B@B@DABADABADAB@DABABCD@@AB@BADAF@DAD@DAB@D@BABAB@DADCFAD@B@BBDBBBBDBBB@B@BABAD@BADBB@DBBDBBD@DBD@D@BA@@@ABA@C@CAA@C@C@ABA@ABA@CBABEBABA@A@@B@BABBD@DAB@DAB@B@BAD@B@DBB@B@BBDBBBD@BBD@DBDDBBDBD@B@B@DAB@DBBBBBDBBBBAD@F@D@F@D@B@D@BBBBB@DBB@B@D@D@B@D@DAFBD@B@D@@@FBD@DBBBD@D@D@BAD@BAFAB@DAD@BABA@ABABAB@BADAD@BADADABABADCBADCDEDCFCDAFCHAF@B@BBDBDBDD@BDBBBD@@BBBBDBBBDBBBBBD@BBB@BB@BBBBBBDBBBD@B@D@DAD@BAD@B@D@D@D@B@DABAD@B@B@B@B@D@BAD@B@D@D@DADBB@B@D@BA@ABCBABCDABAB@B@B@D@DBD@DBBAD@B@BABAD@D@D@@@BABA@ABABAB@BAB@BAD@D@D@D@BBB@BBD@BDFBF@BBD@DBF@DAB@DADCD@B@BA@CBA@A@A@@B@@@D@B@BB@BBBBBDDBBBDB@BBDB@BBD@FBJBD@FBDBB@BB@D@DBD@D@B@B@D@DBDBDBD@D@BABAB@DBD@DADABCBEBABCDCBCDCBAFADABCDCBCBABA@C@CAECCAE@AACBCBC@CBABCFABCBA@@D@B@D@D@BBD@BBF@DBDAB@DADABCD@B@D@D@D@D@BBBDDBB@DAD@DAFCFAF@D@D@DBBBBDBBB@BABADCD@DADBD@BBDABADAFADAD@B@D@F@D@DADAF@D@DAB@DCDADCBEDCD@DADAB@BBB@BD@B@@B@B@D@B@D@DBB@BDBBBB@B@B@D@BB@@BD@@BBFBD@F@D@B@FBD@@B@@@D@D@B@BABEDCB@B@@BBBBBBBDBB@BBB@BBDB@BBBAB@B@B@BB@D@BABABA@CBC@CDC@CDCDAB@BBB@DBD@B@B@D@B@F@B@BDBB@BBBBD@BABBBB@DBBDFBBDDDD@B@BADAD@D@BADBDBBBB@B@BBD@DBFBFBD@DBD@D@@@BABBDBD@D@DABCBCDCBCD@B@D@D@B@DBDDDB@ABABA@C@C@EBE@C@CBCBABAB@DCDABE@CBA@@BADADAD@DAFAB@FAB@D@B@@BBBBD@@B@B@D@DAF@F@DAD@BAD@D@DBB@D@D@D@BA@ABABE@ADCB@B@BABCBC@ADAB@B@D@B@D@BBBB@BBB@@B@BABAB@DAD@DBBBDBBB@BBB@FBFAD@B@@BDD@BAD@FBD@F@BCBABCDCBABAD@D@BABCBCBABAB@B@B@BCBCD@B@BBBBDBD@@BFDFBFBDBB@DABABCBAB@@BB@B@@F@DAFAD@DAF@D@D@FBDBH@@@BBF@D@FAB@B@D@D@DCDAFAD@F@D@DAB@D@DAB@B@DAFADADBDBBBFBDBF@F@DBB@B@@@DBB@@@BBF@D@B@F@DBB@DB@B@BD@D@BABABA@@@A@@@@B@@@B@DBB@@@FADADBFBD@DBB@DAB@B@@@@@F@FADBFAFADAD@H@FBFBH@HAB@HAFADCDC@AFCDADCDAFCDCDA@@BABCBAB@B@B@BADCBCDEBCDCBABA@CBABCBABA@AAA@C@C@C@A@CBCBABEBC@CAC@ABCBC@C@CAEAE@A@CBC@A@A@ACAACACACAEAEAEAEACCCAA@C@CBC@CBCDCDCDADAD@D@@CBAAEBEBCBCBCDCBA@C@E@EAE@CBE@C@CAEACACAAAABADAFBJ@D@@@B@DBDBBDBB@DB@B@BAFA@@DCD@DBDBDADCBABEDCDAFCDADBFBFBDBDBFBD@D@DAB@DADBB@BBBAB@BC@ABEBABABCBABABABEBC@EDG@EBE@C@E@C@E@EAC@E@C@EACA@AAA@A@A@CACACAA@A@AAAACCAAACBCAC@A@A@C@ABADCDA@ABA@AACAECEAA@A@A@CBE@EBEBEAEAEAC@C@ECEACAAACACCACAEACACACACC@C@EBCDABCACACECCAACAE@CBEBCBCD@FAD@DCDAF@F@D@BA@E@CAECCAA@A@A@C@A@A@CBE@AAAACAAACAA@C@E@C@EBE@EAE@C@EDEBAAC@GCEAECE@AECCAAC@A@C@@BCBEDCBEDCBCDC@@DCDCBCDC@A@CCAACAA@C@@BAC@CCAAAAAAAAAC@CACAA@CAE@C@C@AACACEAAAC@AA@A@E@C@E@CAEAC@A@ADCBCBABCDG@ABA@ABABABA@ABCBC@ABC@CBC@C@ABA@C@A@A@CBC@C@C@CBA@A@ABABCBCBC@CBABCBA@@BA@CAA@CACAC@CAECCAC@A@C@C@CBA@CBC@AAA@AACAA@ACCAC@AAC@C@C@A@C@CBC@@BC@EBCBCBCBABCBCBABAFEBA@AD@B@DBB@@@F@DBD@@@@A@@@@@A@@B@@AHGBCBCBABCBCDA@C@A@EBABEBE@CDIBCBABADADCDCBA@ABC@C@A@ABADA@@BAB@BA@C@E@CBEAKACAACCAACC@A@C@GAEAAAAA@CAA@CCEAAAAAAA@E@AAG@A@@AC@A@@@ABABCBCBAB@DAB@JAH@B@@A@@@A@CAAAEAE@AAAAACCCC@A@A@C@ABCBCDCBCFCFABABA@A@@AACAACCAAA@C@CACAC@C@A@A@ABEBEBABEBCDCBABA@C@ACCACCCAA@ABABCBABABAAC@A@C@A@AB@BADABABA@A@A@ACCCCCGAE@EACAAACAAEACAAACCCAACAA@A@AB@BAB@DADA@A@A@A@C@A@CBABC@A@A@A@AAAAAAAACAEACACAABA@ABADABA@A@AAAAAAC@E@C@G@G@C@E@E@A@AACAC@C@C@C@C@E@EAAAA@A@A@ABCBCBA@CAA@CCA@A@A@@@A@@@@BCBA@C@C@C@E@A@A@ABA@ABCBA@A@C@AAAAECACCCACAAAAAAA@A@A@@A@@BA@A@C@C@A@@BC@MCEAC@E@CBA@A@A@@A@@A@AA@@CAE@AAEBC@@BCBABA@A@@@E@AAA@@@A@CAEAA@E@C@CBC@A@@@A@EAEA@@E@@@CAEACAAC@@AACCCCCCCE@@KCCAA@@@AB@@@B@@@DAB@@ABA@@@AB@B@@@@@B@B@B@DBDBFBBBDBBBBBD@DBDADAB@BA@C@AB@D@B"],encodeOffsets:[[101271,27129]]}},{type:"Feature",id:"533301",properties:{name:"泸水市",cp:[98.857977,25.822879],childNum:1},geometry:{type:"Polygon",coordinates:["@@DCDCB@DABAB@FCDCFCBC@A@C@CACAAACAAAA@AECCCAA@A@CBADEBC@E@CACEIAEA@A@C@E@AA@CAC@G@EBA@ABA@A@@@AA@A@ABA@ABA@CAE@GDG@C@C@E@E@CBA@A@EBCBEBC@EBE@CBG@I@C@GACACCGECACAIAC@EBGBA@EBE@CBC@CBAB@D@D@@@J@B@BA@@@A@A@C@C@CDCDAFCFAF@FBFBHBFBHBHBFBFBF@FBDDJDFBHBHADADCDCDEDADBD@FBHALAD@FDDBDBBBDDFBDBDBD@FBB@FAFCBCDABCDCDABAHABEBABCBCBAB@D@DBBDDDDBDDB@D@D@DAFAD@DABBFCDABCBCBCBABC@CBCB@B@BBDBD@F@DABAB@DBDBFDDBFBD@BDFCBCBCBC@C@C@CBADCBCBABCDCBABAD@DAD@DABADADAF@BCBABAB@DBBBDDBB@FBBB@B@DBBDBDB@DBD@FBDBD@BBDBD@BADABCBABCDABAB@DAD@BAD@DABADC@ABCBC@C@A@AFAD@FDD@DBFAF@FAFAFADABADBDBD@DCFCDCFCDCDCDCB@BAFAFAF@D@F@HCDAFCDADCBEDEDCDCDAFCDADCFEDA@E@CBA@A@EBCB@FAD@B@DADEDEBE@C@CAGAC@G@E@C@EAA@@D@F@FAF@DAF@DCDADADBBBF@BAD@BAD@BBB@B@B@@ABA@AB@@@D@D@B@@B@B@BB@@@B@BA@@BA@ABA@@@A@@BAB@B@BAD@DAB@BAB@DBF@D@F@D@DAD@B@D@DBD@DBBAB@DABADADCFADCFAD@B@@CACAGBAAE@@@A@@@BB@DBB@D@D@@@BD@DBBBBDAB@B@BAD@@@@A@@B@J@BAB@@@@CDADADAD@DABAD@DAD@DAD@@@@@D@B@BDDDBDDB@BFBDBD@D@BADAF@BD@BBDADADABADADABADCBADCBADBB@BBDBBBDBBB@BDDDBD@DBD@BB@@BBBDBDD@BBD@D@FADAF@F@FAB@DADAD@BABADCBABA@CBABA@C@CBABCBABA@CBC@A@CAA@AACACAAACA@AAAAAA@AAE@A@C@CBCBCBC@@@ADAB@D@B@DB@BBBBBBDBBBBD@B@DBB@BBB@BBBBBBBDD@BB@@BDBB@BBDB@BBBBBBB@D@BAD@B@@@@DBB@BDBBBFBB@DDDBBDDBBDDBBB@B@BAB@D@D@BABAD@BABABC@A@ABABABAB@DBD@D@BBDBBBBBBD@D@D@F@DBD@BBBDDDBDBD@FBFBDBF@FBF@DAD@BABABCBCDADCDAD@DADBD@B@D@DADADADCDCDCDADAB@DAD@B@DABA@ABABADCBAD@@A@CBA@ABC@A@@AAACCCEAAA@@@A@ABCBABC@CBEBC@CBC@@B@@@B@BBHBDBDBDBB@DBDBD@DBB@D@D@DAFABADAD@D@B@BBD@BBDBBDFDDBDBB@D@BA@ABAB@BAD@DAD@D@D@@B@BA@@B@@BB@DBDDDBFDDDFBDDBBDBDDD@BDBB@DBBBBBBBBBDBBBB@BD@BBD@BBBDBB@BBBDBBBBBDDB@BB@BBBAB@DADAB@DABAB@B@B@@AD@B@D@BBD@BBD@D@B@BBBBBDBBBD@DBB@DBBD@BBD@B@D@D@B@DAD@D@BBB@BBBD@BB@B@B@BAB@BAB@B@D@BBB@BB@BBBBBB@BBDAB@@@BABA@ADABCBCB@BAB@BAB@BBB@BBBBBBDBDBD@B@D@BAD@F@B@DBDBDBDDDBD@B@D@BAD@D@D@DBDBB@DBDDDBB@DBDAD@F@BADABABCDAB@DAB@B@@@D@@B@@AB@@@BB@@BBBBB@JBB@DDFD@@DBB@D@B@@BBB@BBD@BBBBBB@D@FBD@@AB@@ABCBE@CBCBA@ABABA@@B@F@DBDBF@F@FBD@B@D@DAD@DADABAD@DAF@DADADAD@FCBAD@BAB@BAD@D@B@D@BBBBB@D@DCHCNEPEF@HBJ@FAH@B@BA@A@A@CBAD@B@@ABABCAC@CACAAAAACAAAEAC@C@A@A@A@@@@@ABA@@B@BA@@BA@C@@@A@@BA@@B@DBLD@@DFDDDDDDBB@@BDDBFBDB@@F@@@FBFBB@@@B@D@DAD@F@B@FBDBB@@@B@BBF@@@B@B@BADA@AD@FABBF@DB@@BBB@@@@BB@B@B@DAF@D@FBNDD@@AB@D@D@B@B@@AB@@@@ABA@A@ABA@C@A@CACAC@EAABA@ABAB@BADADCBABABCBC@CBC@ABABCBA@@BA@ADABA@A@A@AACCECGEICGAEAA@E@C@C@CDC@C@A@E@G@A@C@E@CAE@A@C@A@@B@@ABABCDA@A@A@A@CAA@E@E@IBC@CAAAAACEA@AAA@A@A@AAA@A@CBABABEBC@C@E@E@G@EAC@C@C@EBE@CBCBCBE@C@C@E@CACACA@AACCAE@C@ABA@@BAD@FCDAFCDADCDAB@BABAB@@A@@@AAAAA@C@@BA@ABABC@A@A@@AACAAACAACAC@C@E@C@ABA@ABA@C@C@E@C@C@E@AAAAAAAAA@AAA@A@A@CAC@CACAA@C@ADEBEBCBABAB@B@@ABAAA@C@EAEAEAC@CBABABAB@@ABCAA@ABCAEACACCCCCAA@A@A@AB@@@H@DBBA@@BA@A@C@E@A@ACAAAOKAA@@@A@A@CAAA@AAA@A@AAA@AA@AACAAACAGAEBE@A@@BAB@DC@A@@@AAAACCA@A@@BA@ABA@A@A@@AE@EAE@AAAA@C@ACAG@C@C@C@A@ABABA@A@ABABA@A@A@AAC@E@A@@@@@A@@@ABA@@@A@@@@@@@A@@@A@@@@@@@AB@@@@@BA@@B@@AB@B@@@@@@@@A@@B@@A@@@@BA@@@A@@B@@A@@@@@@@A@@@A@@@@@A@@A@@@BAA@@@@@@A@@B@@@@A@@A@@A@@B@@A@@@A@@@A@@@@@@@A@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@@@@@A@@B@@@@@@@@A@@@A@@@A@@@@@A@@A@@A@@AAAAC@AAAAAAAA@A@A@@BC@A@ABCBC@A@CBE@E@E@EBE@E@A@CBC@CBC@@AI@C@C@@B@B@DBDB@BD@H@DAFAHED@@@JBdNFDBBB@BABA@@FEFI@AB@BCDEBEFIBEDEDEDEBCDEBEBCBCBEBCBC@CBE@CBC@ABCBE@CBC@C@C@C@C@ABCDEBEDCBCBABEBC@C@CBCDE@CBCBCBCBCBCBCBCBCBA@EBEBEBEBCBCDC@CBEBEBE@CBCBCBEBEBEDEBCBCBA@CDC@ABAAAAAAAC@ADC@ABA@CAE@C@EBE@C@E@CB@@C@Q@C@EAQEKCCAA@GCC@EJGTCLAH@BDDBBFDBB@BBB@@ABA@@BABA@C@EBEBE@E@A@CACAA@A@A@ABCDEBGDA@GACA@CAEBGDEBG@G@GCECAECAA@ACAAA@@@AB@D@@@@@@@B@BABABADCBCBE@CBEBADCBCFCFCDADADABABABE@CBEBCBC@C@AAAA@A@EBC@C@C@E@C@ABEBABC@@@AA@A@A@CAC@CACAAAAAAC@C@C@C@A@EACAEAC@AACAC@EBC@CBGBEBEBEBEBCBCDCDC@C@CAA@AD@DABCDADCDGBCDCBC@ABAB@DCBABC@AAAC@AC@AAC@A@ABCB@@CDCBCBE@C@CBC@CBA@ADADAB@B@D@DBB@F@B@D@BADA@ADA@ABCACAC@CACACAC@E@E@C@C@G@C@C@C@C@AAAA@CAAACAA@GAE@GBCD@DABCBEBC@CBCBA@ADAB@BAD@BAD@F@DAF@ACAEACACCCCCCACAAA@@C@@ABE@CBGDE@A@C@CACCKCAAA@I@I@CBED@F@BABABCAG@I@E@E@ABCDAD@FCBCBA@C@E@C@C@E@C@CAAACA@ACC@CAA@CDEBA@E@AAEAAA@CBA@A@ABAAABA@ABC"],encodeOffsets:[[101245,26237]]}},{type:"Feature",id:"533324",properties:{name:"贡山独龙族怒族自治县",cp:[98.665964,27.740999],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@A@CBA@CAC@EACACBEB@@A@CAA@@@A@@@@@@B@@ABABABC@C@@A@ACAA@CAE@A@C@E@AA@@A@CA@@A@A@CAE@E@CAEAAACACACBEBCBA@A@CBC@A@CBC@E@C@EBCBCDC@C@A@A@EBC@E@AA@@G@CAEAC@C@E@CBC@EBCBE@B@@DDBBBDBF@B@D@D@B@BBAD@B@DAD@DADAFCDAF@B@D@D@D@DBDBDDDDDDB@BAF@DCDCBC@AAC@CAEBE@ABCDCDCDCFADAFCFADABCDABCBA@CBC@C@EACACCCCAC@E@C@EAAACCCAACAA@CD@@ABABCBCBEBEDEBE@CDC@A@A@GAE@C@CBCDABCAE@CACCAA@CBCBCA@CAC@AAC@A@CBABEHBD@F@DBHBFBDADEFCFADAJABCFAFCDEJ@DAFADAFCF@F@DCFADADCFABEDC@A@CAAAAAACBC@@AAC@EAA@C@CAAAAA@CAC@A@C@ABE@AAA@AE@C@EAA@CBCDCDCBC@CAE@E@A@A@ABBD@B@DABABABAAC@C@EBCBEBC@A@AAA@C@C@CBGAC@CBCDEBADADABCBAB@DABADCJABCBC@CDCBABCDABABABA@A@E@CACAE@@AAABAAA@CAA@A@CBCBABCBA@C@CAA@CAABA@@BABCDABCBA@C@CAA@E@CAAAAAACAAC@A@@AA@@@ACCBA@AB@BABC@A@A@C@AAC@AACAAAE@A@A@ADCBA@C@C@E@ABE@C@C@C@C@CAC@C@ABCAAACAC@A@C@CACGAG@C@C@EAC@CAEACCCACA@AACAEAC@AAACC@CACAE@ACCCCCCCAA@CCCACAECC@CACBE@E@CBEBCDCBE@CBCAC@CCCAAA@@EACBCBCBABCDCBCDEBGBCBABABBF@DBD@DAFADADEDADCDAFAD@FAF@D@D@B@FBBBDBD@D@D@D@D@D@D@DBF@BADCBCFAFCFAF@F@DAD@DCF@F@F@@@FBDBDDD@B@DABCDABABC@EAE@A@AB@BA@@BAD@DAD@D@F@F@DADADADCBADCB@@C@AAAAE@C@AACB@B@F@BBB@BADAD@B@BCBA@@B@B@BA@@BCBCBE@CBABCBADGDCDEBCBCBAB@@@DBD@DADAB@F@BAB@BCBCDEDC@C@AB@BBBBDBBBBDB@D@BA@AB@BABBBDBDBDBB@BB@B@D@@B@B@B@BADBD@BBDBDBB@@B@B@BAF@BADABABCBC@CBABABC@CBC@CBC@ABAB@B@DABABCBAD@BAD@DBBBB@B@B@@@DAD@B@BBFBDBB@FBB@BBFBBDD@FB@ABAB@BAB@D@DBD@D@D@BAB@BC@E@EAA@C@CBCBADCBCDABGDCBIFABCBEBEDGDC@ABAD@BCFABBD@DADADCHBBDBBBDDBBABABAD@BB@@BDBB@B@BB@D@D@BBBBB@BD@D@D@D@D@F@F@D@DDBB@B@DAD@D@DBBDDBDB@AB@BCDADAB@B@D@DBDA@@DCD@DCDADADAD@D@D@DADABCDCD@B@B@J@D@BA@ABC@C@E@CAC@A@C@EAEACBE@E@C@CBABABCBCDCBADABCBEF@D@BAD@B@BAB@BAD@BAD@D@D@D@D@D@D@BABADCD@B@D@D@BBDBB@@BBFBDB@BB@AB@@BD@D@DAD@DAD@BBBDBBDBBBH@DAFCFADAD@D@H@DAD@BABBDFFHJFFBDBBBBBD@DBB@B@D@D@BBFBD@BBB@B@BBBBDBD@DBFAF@D@BBF@D@B@DADCBCDABCBADCBAD@BBDBB@B@D@BCBABAB@DAFADCFCJAD@DA@HHDBFDBFBD@DD@DAD@DBBBBDBFDBD@DADAD@FBBDBD@DAD@FCHED@@BJ@F@HAH@D@D@N@BBBLLHDBBB@F@B@F@F@DBHDHBF@DABCBCAAFAFAHBDFDFFFDDDFFBF@FDBBFDDDHBJDJBLBFBBBBD@D@DADABABADADCBCBC@@B@BBD@B@BABABAB@BADBBABCBABCBCDCD@D@B@BBDAB@@CB@@ABAB@D@D@HAFAJAFAHCFEFGFGDIFIDGFEFADCFAH@JBHBJDHBBBBDAD@BAD@DABCJGFCDA@@B@@B@D@B@DBFBLDPB@DBDADBBBFDBDFBBBFAH@F@FBJ@HDDBFFDHBFBDBDD@D@DABAB@BBB@B@BA@ABAFCHCBC@CBC@CCEACBC@CBCBEBC@CBC@@DADADABABADABA@ABABAHABABE@C@CBC@EAEAEACACCA@C@CBCBCBCD@FAD@DCBEDEDGDEFEBAB@D@FAF@HCFA@AAC@C@CDEBCDIDEDEDADCBC@EBEBCBEDADADA@A@C@CCACCCCEA@CBABAJADCBC@C@GAEBE@G@EAICICICKAK@CBCDABAFAH@BADEBEHGBC@CACCCBABCBM@GAGAE@CBCDCBCFCBC@C@CCEAACECC@ABABCFGDABAB@DAD@DBHBF@F@DAHAJ@J@@D@BDDBDDHDDFBFBJDHHHDHFBB@F@BCDADABADDD@BB@B@BBDB@@@@BB@@@BBB@@B@@B@@@@B@@BBA@@B@@@B@@@B@@@BBB@B@B@B@B@@@@@B@@@B@@@B@BA@@B@@ABB@@B@@@@@B@BAFCBABCFCFCDADBF@FDFBDDDBDHBHDDFDDFDDBB@F@DDBHDHFFBFBD@D@FBJDJDFBBAHAFAFAD@FBD@HDF@B@@@@@@@@@BAB@BADABAB@@@@@B@BAB@@@BCB@@AB@@@@@B@BA@@B@BB@@@AB@BA@@B@B@FCJC@@B@@@B@@@B@@@@A@@B@@@B@@@FAB@B@@@D@DA@@@@DFBBB@@B@@@B@B@B@B@@B@@@@B@@A@@@@@BB@@@B@@@@@B@@BB@@@@@@B@@B@@@BBB@BBB@@B@@BB@@B@@B@@B@@@B@@BB@@@@@@BB@@DDBBBB@BB@BB@@DD@BDB@BD@B@B@B@BBB@BBB@BBBBBBBB@@@B@@BB@@@@B@B@@@D@B@LAH@F@DBDBFDJDDDB@@@B@B@@A@A@AAC@CACBCAA@CACCCCEECCCAA@A@@BABABABCBC@CBCDEBEBE@C@A@AD@DAF@B@BA@A@AAA@C@C@CBE@C@C@E@CBE@EB@BAD@D@BABABC@C@CBAB@B@B@D@B@FBD@D@@AB@@@@A@A@EAK@A@CBABCDEBA@ABA@A@C@K@AB@TBF@D@DABABABEBC@CBCFGBE@EBC@A@@@A@@A@@@GFABA@A@A@AA@@CACA@A@EBABAB@B@BAD@JAF@B@BABA@@@A@EBADC@AD@F@F@F@D@BA@ABEBCBABCBADCBA@ABC@CAC@E@A@CB@D@B@BAB@@AAAACACACAE@C@C@CBGDE@A@E@EBABC@C@@@A@ABC@@AA@@@AAC@A@@@A@@@C@EAE@CAAAE@AAC@C@C@CBC@C@C@C@E@C@AAECAAC@C@CBCBABABA@@@ACCAA@AAE@EAC@E@C@ADCBC@AAC@CAC@C@ABEDCBA@CBC@C@A@E@A@ABCBADCB@@ADAB@B@BAD@@A@@AG@CBGB@@AAACCACAA@AAE@A@AB@BAB@@@BCBCDCDADABCBCB@BCBCDCB@DAFAD@B@FDJHFJFDFBD@FABABADC@C@AAA@AAE@EAEAE@E@C@C@CDEDA@AFCDCDCDCBCBC@CACAC@E@CBCBCBE@CAABCBABAFADCBCBC@CBC@E@EBCBC@A@AACACAAAECCECCACCG@ECE@EAEACCGACCCAA@ACAA@EBCBEBCBEBCBCFCDADCDADCBEDCDCDCBAB@F@DBF@DCBABCDCFEDCFCFAFCBCDCDABCBCDCDCBCBABABAD@BA@CBC@ABCDCDADC@C@C@CDCD@D@BABA@A@@CAACCCCCAC@AACCCACEECC@AAC@C@E@AAACAC@AACCCCCCAE@CAE@CACCACAAAAAAA@A@E@CDADAF@DADCBA@C@CAEAC@C@AD@B@DADCDABCBE@ABAB@DCBC@E@GBCBEBABCAAAACCCAACAC@E@CAEACACCCCEACAEAC@A@@@@CBCDEDCBCDCBED@BCDCDEBGBA@GBG@EAEAG@C@CBEBEBCAEBE@@@"],encodeOffsets:[[101201,28171]]}},{type:"Feature",id:"533325",properties:{name:"兰坪白族普米族自治县",cp:[99.416677,26.453571],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAACCAACCCCAEACCCA@AAGCGAICA@@A@ABABANQDEBEAI@CBCHGTOT@NEFA@A@C@ACCACCCCAC@C@C@CBABA@CBABADABADABABE@CBE@ABA@ABAB@BC@CBCBABC@E@A@A@C@ABABCBCBCBA@CBABCBA@AB@BCBC@CAC@ECAAC@AC@AAAABA@C@EBCAE@A@A@AAAAA@@BCB@BAB@@QBCBA@AB@BDBD@D@HAB@@@B@@B@B@BBBBP@D@B@@AB@BA@A@A@A@C@E@Q@I@G@E@C@C@CBCBCBC@CBIBG@CBA@AA@@AA@@AA@AA@AA@BA@AB@@A@@@AA@@ACCAAACCAAC@AAA@AA@A@@CAG@@@A@@B@B@BBBDDDDBDBDBFAD@BBBAD@BA@ABABAB@DBDBFBF@F@DBBAB@BA@A@ABABADAFCF@B@DBBBD@DBD@D@B@BBB@BBBBBBBBB@B@F@D@D@F@D@DAB@BAB@B@D@F@DBDBDDBBBDBBB@@@B@BADAB@BAB@@@DBBBB@B@@@BA@ABABA@CBCDCBEDCBEDC@AB@@AB@B@DBFDDBBB@BDBD@D@F@D@DAFADAD@DAF@F@D@DBD@F@H@F@F@DADAFABAB@D@BBB@B@B@BBB@BFBBDBBBB@DAD@J@F@FBB@D@B@B@BCBADAB@BA@@@@B@D@BBF@D@F@D@B@H@F@B@DCD@D@D@D@FBBBFDHFJDHDFBD@B@B@BABCB@BAB@@ABADAB@BAD@DADADABABCDCBABA@AB@BABBB@FBDBD@D@B@DAB@B@BAB@B@B@BBBBBBBDBDBDDDBBFBB@B@D@BABAD@BAB@B@B@B@F@D@D@DABAD@@@@@B@@@B@BDB@DBB@DABADAD@B@B@BBBBB@F@F@D@D@D@DBDBD@B@B@F@F@D@H@H@D@FBDBBBB@B@BABCBAB@BABBBBDBDBFBDBBBBBB@B@B@B@BADAB@D@B@D@B@B@BCBCBA@ABA@@B@BBBBDDBDDBBDBFBBBBDBBBD@FBFDHDDDD@B@B@BABABCBABA@@B@B@D@BBDABABABADAB@BBBDDBDDD@B@DABABCDADAFABAFAF@B@B@B@DBDBD@D@DBBDBBDDBBB@@@BABABEBEDADCDADAD@B@D@B@BDDDDBBBB@BBFBFBB@D@B@@@BA@G@IBA@CBA@ABADADAB@B@@@BBD@@@BBH@B@FBBBBBBFBDDB@DBB@BBBBBF@H@D@BDDBBDDBBBDBLAF@D@F@DABA@AB@@CBAB@B@B@DAD@BABCDCDCBABABADCJ@DAFAFAB@F@B@DCBADADABADADGH@BA@@@@B@@@@@B@@C@CAE@@@A@CAA@C@@BABEFABABADADABADADAD@FAD@@AD@D@D@B@D@DBD@BBDDD@BBBBD@BBB@BAD@DAB@D@D@D@BBDDDBF@DBDBD@DBB@DAB@@ABADAB@DADADADAB@B@BAB@D@D@DAD@D@B@B@DAB@B@DAD@DAD@BADAD@BABABAB@BAB@BCHADABADCD@B@BBDBF@D@F@D@F@BBBD@BBFBBDBD@B@D@DBF@DBBBD@DBDBBBBBBBBDDD@BAD@DAB@BADADCBAB@DADADA@@B@F@F@D@FBFD@@JDD@D@@@@AAAEECAAA@C@A@EAEA@@A@A@A@@A@@@@@ABA@@@CBE@A@C@A@@BA@A@A@ABA@@@A@@@@@AA@ACMAI@@@CB@@AB@BABA@ABABI@IBC@@@@@A@@D@@A@@B@@@@@@@B@@@B@@@@@B@@@B@BBB@@@B@@@@@B@@@BB@@@@BBB@BB@@BB@@@@BA@@@@BA@@@A@ABAB@@AD@DABALAF@B@@@B@DAFCHC@@D@D@FBLFDDD@F@PABAD@@BFDDBD@D@DBB@BB@@DBBBBDBDDDDB@@B@BBBBDDBBB@DABABADC@@FCBA@@DA@ABAB@@AD@DA@@@@B@@AB@@@BBB@B@@@B@B@@@B@B@@@B@B@@@BBFBDBDBD@B@B@FB@@B@BBB@BA@@DA@A@@BA@@@C@A@@DG@A@@@@B@@@@B@@BBB@B@B@B@B@D@B@BBB@D@B@FAB@@@B@@@B@@AB@BABBB@@@B@@@B@@AFAB@B@B@@@@AB@@@FAD@@@B@@ABAB@B@B@BA@@B@BAD@B@B@BAD@FAB@B@@BB@@@B@BAB@@@@B@B@@B@@B@@B@@@BA@@@@B@@@@@B@@B@@B@@BB@B@B@@BDBB@@BB@B@B@@@B@BDDBBBFBDB@@@BB@@BBBBBB@BD@DBBBDBBBDDBBBB@D@@@FBB@@@B@B@@BFDBBBDDDDDDDBD@B@DBB@DABADCDCDADAFADADAD@DAFAD@BADADABABCDAF@D@DBFDBVDH@D@BADABAF@D@D@B@B@DCDABCB@B@D@BABABCBGBEDAFCBADCBEBABCBEDEDCDC@CDA@CBA@AAC@ABABCDCBGBEDGBGBCDCDCFEDABADGFEBCDC@ABABCACAC@A@@@ABABAD@BCBA@@@CAAAAAA@ABABADADAFEBABC@A@A@ACACAAAAC@A@CBCBABCDADCDCDADCBA@A@CBA@ADA@ABA@A@CACACAC@CAA@ABA@ABAB@D@BBFDBBB@BBFBJFJDBBDDD@RF@@FBFDDBB@B@BBBBABC@EBC@CBCBEBCBCBCDABADCD@BABABAB@D@@BDB@BBF@D@D@DBBB@BBFBDBBBDBDBFBD@BBBBBBBBAB@BCDCDCB@BC@CBC@ABABA@@BABD@@DDFDHDFBFDFB@@B@@@B@B@BADADADCJE@@D@HBJBH@HBHBF@F@D@BABABCBA@ABE@CBC@EB@@A@A@EBCBA@ABAD@BADABCDABADABC@CBA@A@A@CBCDCBABEBABADCBCBABABAD@D@HBL@FBB@BAD@BADAB@BALEFEAGCG@IBC@@@A@@A@@@@A@@A@@AA@@@@A@A@@AA@@@@@A@@@AB@@@@@@AB@@A@@@@@AB@@@@A@@BA@@@@@A@C@@@@AA@@BA@@@A@@@AB@@A@A@@B@@A@@BA@A@@@A@@@A@@B@@AB@@AB@BABA@@@@@AB@B@B@@@B@DBD@B@BA@A@@AC@ACCACAAAA@AACBC@C@AAAAAA@CACBE@CBCBA@ABCBAAAA@AAA@ABA@@@@BAFADAFAD@D@D@DBBBD@DBF@B@D@B@BABAA@AA@C@A@ABA@ABC@AAA@@CACAAAAA@AB@@AB@B@@A@@@AAA@CCCAAAC@A@E@CBCBGBEFIFIBCBC@C@@BCFQFQDUFIFGFIBC@A@A@CAG@E@EBC@EBCBEFANELCD@D@BAB@BAD@BCFCDCFEHIHICACCGCGCECCC@@@AAA@AAC@A@A@C@A@ABA@EBC@A@AAA@@AA@AAA@C@A@G@EAC@CCCACAAACECAA@@A@C@A@C@CAA@A@@@A@ABEBCDC@@@A@AA@@CACCAAA@@@A@A@A@A@AAAAAACCCCCCA@SEECEAMEMEAAGEECEAA@E@AAYICAAACAECICCCAACAGAECAA@A@@@CBA@A@A@CAC@A@C@A@ABABABCDCDCBABABCBA@A@CBA@CBC@A@A@@@AAC@A@CBCBM@C@C@C@AACAA@CA@@A@A@@@A@@BA@ABCBC@CBC@C@CAC@C@@ACAAA@CAAA@AAA@A@ABA@AB@BAB@FCB@DADADABCBABABE@C@A@A@C@ABEBABABABABA@A@AAAAIACAEACAAACAC@CACA@@@AAC@A@CAA@@@@ADIA@@@@@AAA@G@A@AAECACAECCCCGGAAAA@E@CCICCIIEECA@@EAI@GEAC@A@CAACAAA@A@CAA@ACAAACCA@CACAC@A@A@EC@A@@BABC@@@AAAGECACAA@A@@@C@A@C@C@CBCDG@SAC@ABAD@BA@A@G@E@CAA@AAA@@CBA@C@@AAA@AACACAA@AAAA@AAC@AAA@@CAA@CCEAEACAA@A@AACAAAAACGEIAAA@C@A@AD@B@D@BA@@BABEACBABCBA@@@EA@@ECABA@A@A@@AA@@AAAAC@A@AAC@C@C@AAAA@CAA@ACCGCCACAAACAA@A@@@CAA@CAC@C@AAC@A@CACBA@ABCBADADAFADALADADADABA@A@@@A@AAA@CAACCA@A@AAE@CA@@AA@A@AAA@A@CAC@AC@EBC@C@CAA@AAAAAACCAC@AA@A@@@ABUBGBE@A@AAA@AACCCAACCACC@AA@C@A@A@CBC@EBCBC@C@@@A@@A@A@AAGAC@AAAA@GCAAA@@ACEC@E@EAE@CACACEACCAEAEAEAAAACAGAE@@EEA@EAC@A@AA@@"],encodeOffsets:[[101568,26811]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
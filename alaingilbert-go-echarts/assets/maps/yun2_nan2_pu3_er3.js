@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("普洱",{type:"FeatureCollection",features:[{type:"Feature",id:"530802",properties:{name:"思茅区",cp:[100.977256,22.787115],childNum:1},geometry:{type:"Polygon",coordinates:["@@BAB@B@BADAB@@@B@@BB@B@B@FEFA@@@A@AAA@@@AFG@CB@BAB@D@B@B@@@BEBC@AAE@CBCBABAD@BABBF@B@B@B@BA@A@C@C@A@AAA@A@ABABABC@AB@@@BBD@B@BC@A@AA@AE@ABABA@@@BB@BBBB@BB@BABA@A@E@C@A@AB@B@B@@ADC@ABC@C@@BA@CBAB@DAB@@A@AAE@@AA@A@@AACCAA@AA@A@@BABCBA@A@@AC@@AABA@ABA@CAA@A@AAAA@A@@ABA@@@@A@AAAAA@C@@CA@CA@@@@B@@@D@@AB@@A@@@A@ABA@A@@AA@@A@A@A@@A@A@A@ABAB@@@B@B@@ABA@A@CCAACAA@@BA@@AAAA@AAA@CAC@AA@@AA@A@@@@AAA@@BAB@AA@CCAACAA@A@A@A@C@@AAA@A@@@@CAA@EBA@@@AA@@@AA@@@A@A@CDA@C@A@@@A@AAA@A@@@@AA@@AAA@@GCSIOKQIQIO@SFODIDK@UDMBMCA@A@@AA@SCOCOCI@O@MDKHIJABKHKDUCUAI@SA_EOBUDQDSHIJq\\WPQJAFABA@AAA@@B@@@BAA@@@@@A@@A@AB@@@@A@@@@BA@ACA@@A@@@@A@@A@@A@A@A@@@AA@@BA@A@@@ABA@@@@A@@AAEA@@@AB@AA@A@AAA@A@ACA@@A@@@@@@@A@@@@A@@@A@ABA@@@@AA@@@A@CDA@AB@@BD@@ABABA@A@A@@@@@@AA@@@A@ABAB@D@BA@@@AC@EAACEA@@C@ABC@CDCBEFCBA@A@AECEAGAE@ABWBC@EAC@C@E@AA[OGGIGSKEGICEAA@I@G@G@GFKJEFKBABAFAHBFAFAPILCFEFCD@BEFCLGF@@C@A@@@A@A@A@CA@@AAA@C@A@@@@@@@A@@@ABA@ABA@C@A@@BA@@@@A@@AB@B@@AB@@@BA@C@A@@@@@A@@@@@A@@@@BAA@@A@@@@B@@AB@@@@A@@@A@A@@BA@@@BA@AA@@@A@@@@@@A@A@@@@A@@B@@@@@BAB@A@@@@CA@@ABB@@BA@@AA@@@@@@B@B@@A@AB@@AAAB@@@@@@A@@@A@@B@@@@@@A@@@@A@@@@BA@@B@@@@A@@@@@@A@@@@@@A@@@@BA@@@@A@A@@A@A@@B@@@@@@@@A@@@@@@B@@A@@@A@@@A@@@@BA@AAB@@A@@A@@DC@@C@A@AA@@A@A@@@@@AA@A@AA@@BCBA@CA@@EB@@@B@@C@A@A@@A@@C@@AA@A@A@@@BA@@A@CBA@@@A@@@@@BA@@AA@@AB@@AB@B@@@@@@@@ACAA@@AAA@@@@@AB@@@BC@@@@AA@@@@B@@A@@@@A@@C@@A@@A@@@@@@@AB@A@@@@@AA@CBA@A@@@@A@@B@B@BA@@@@@@A@E@A@AA@@A@ABA@@A@@@A@@AA@@@@@AA@@@@@A@@@@@@A@A@@@@A@A@@@AAAA@@A@ABC@@AAABC@C@@KIACAGACCACAAA@I@GAACCCEEGECG@GBE@CEECICK@EBIDIDIBE@KCECK@E@AAAKAIDM@EGEA@IAA@AAA@A@C@C@A@EAAAA@CACBA@CAI@GACGCICGCG@IDGDEDCBGCGGIIGABC@CBCB@@CA@AA@@A@@C@@@A@A@@@A@A@@BA@A@A@@@ABA@@@A@@@@D@@C@@BA@@B@@@B@@@@A@AB@@A@@@@B@B@@B@@@AB@@@@@@@@BB@@A@@B@@ABAB@@BB@B@FEHEH@DADEDGBCDAB@DFDF@LCD@FBFJAJAJCBEBADBNAHCJCHEDADADCFAHADABABENCDIBCBKBKBCBCDCBEDGBIDGDABINEBIFEDCDAFADABCDABA@C@EBKDKBCBEDEDCDGFAHABCDCHEHCFGNCBCFABCBEFCDCHAFABADADEHMNOLIFOHGDEBWFEDCHALBR@D@BFH@FBFDT@ZCJADAB@D@DCJ@FDRBL@LAD@DAF@HAFEDEFENCHAH@DCDGFK@ADAHCNCHIJAFAJCHADE@G@CBCB@D@FD@JBDBFBD@FBD@JAD@D@BBDBB@D@J@@@B@JDB@BADCD@F@B@HDNFDAFAHAFAB@D@D@FAHCJERKB@DCD@DAF@D@DADAF@D@FAB@DADAB@BBBABA@@B@BBDBDFB@DADA@A@A@@@CD@@ABCDCFAFADABA@ABABEDAB@HAJ@D@DABCBADEBADAF@DAFBD@J@NBBBNDB@F@B@B@BBD@BB@B@B@D@DAFAB@F@F@F@DAFAH@B@BBB@F@BA@@B@BBBB@@BNBH@HBB@B@BBBBB@B@BAFCB@D@F@H@F@DBB@D@FAD@B@@B@D@DAB@D@BBBFFBD@FBBBDB@BBB@B@B@@AD@B@BBFDB@@B@D@@BB@@D@B@B@@@BBBDBD@DBB@D@H@H@B@DABABAD@@CBEBABCDA@@DA@ABA@ABAB@B@@AB@DAB@D@B@BABABABAB@B@B@D@BADAB@@ABABABBBDB@@@B@D@B@D@BBDB@B@BB@BBB@BBBBB@FBBBBB@DBD@DBB@@B@FCHADAB@@BB@DBDBFDBBBB@@@DA@AB@BAB@BB@B@BDDBDBDB@DBFBFBDDB@D@D@B@BBBD@BAB@BBBBBFBBBFFHH@D@JAH@H@BBHADAFB@B@HBJDD@@@D@B@D@F@DAD@B@BBBBBB@B@BAB@B@@BDBB@B@BDDBBB@BBB@BDBBB@BBB@@B@B@BAJBBBDB@B@@AB@BABBDBBBH@D@BB@B@B@@@BB@@ABA@@B@@BBB@@@B@@B@B@B@@A@@@ABB@@@@B@@@BABBBB@@@A@AB@@A@@BB@@@B@@BB@B@@B@B@@@@@BAB@DA@@BB@@B@@BAB@@B@BA@@@B@@BB@@@@B@@D@@BBB@BBBBBABBBAB@@@B@@@@@@B@@@B@@@B@BB@@BD@B@@BB@BBBB@@@@B@@A@@BB@@@@BAB@@@@@@B@@@@@@BB@@B@@@@ABA@@B@@@@@@@BB@@@@@@B@@ABB@@@@@AB@AA@@B@@@D@@@DADA@@@C@@B@@A@A@@@ADA@ABA@A@A@@@A@@BA@@@A@A@EBC@@@@@@@C@@@ABA@@@@@A@@B@@@@AAC@A@@@AB@@A@AB@@AA@@A@@B@@@BA@CB@@EB@@A@A@@BAB@B@@@BA@@B@DBB@@@B@@@DABABBB@D@B@@BB@@AB@@@@A@AA@@@@@@@AA@@@AA@A@@@A@@@@AA@@@@@@@@@A@@@@A@@@@@A@@AA@@@CAAAAA@@@A@@@AA@@@A@AA@@A@@AA@AB@@@B@@@B@BAFABABBDABA@@@ABABA@A@@B@@@H@@@@A@@BE@A@C@ABCBA@@AA@@@A@A@@DA@@BA@@CAA@@AFE@@A@@@ABA@A@@BA@A@@AAC@A@CBABCB@B@HC@AFCDC@@LADAH@@@BA@A@@@@A@AA@@@AB@@@@AA@@@@AB@AA@@BA@@@@AA@@BA@A@@@CB@@@B@BABAB@@@@A@ABA@AAAA@A@A@@@@A@EDCB@@A@A@A@A@@AA@AAA@A@AB@@A@A@AB@@ABAB@BAF@B@@@B@B@@@BB@BAB@BBB@BDB@@B@@BA@AB@BA@@BBD@DB@B@B@B@B@@@BB@@BAD@BAFAB@B@@A@CBABADC@@BAB@BB@@BDBBB@@@BBBB@@@BB@BBDDB@BBF@B@@@DD@@B@B@B@BBD@@@FBB@@B@@@B@BB@B@B@@ABA@AB@@ABBBB@@B@B@B@B@B@@A@ABAB@BAD@BABA@C@A@ABA@A@@JKLULORCdJZ@VWNGHEFADUD]XQbGB@B@B@@B@B@@@B@@BB@@BB@@DBBBB@BBB@B@@@B@BBB@B@DB@@DBD@DBD@BABB@@BB@@B@@@@@BC@ABCAAB@D@J@DCDABABA@ADADA@AAA@@@AAA@@@CDCFCDAFAB@B@B@B@@@BBDAB@@@B@B@B@BBB@@@B@BAD@BCB@@@BA@@B@DAB@B@@A@@BAB@BBBBB@@@@@BBBADA@@B@B@BA@@@@@A@@B@@@@@B@@@BAB@BBB@B@B@B@BAB@D@B@@@@@DAD@B@@@@@BA@@B@@@BAB@@@@@B@@@B@@@B@B@B@B@@AB@@@DAD@B@BAB@@@@@BA@@@@B@F@BAB@DADC@A@A@C@@A@@AB@B@BBB@@@BA@@@@B@D@B@BB@@BBB@BBB@D@B@@BB@@@B@@BB@@@@ABA@CB@@@@BB@@AD@F@@@BA@@B@@@B@D@@@@ABA@AB@@@BABAH@@@@@@@@AA@@A@ABA@CBA@@@AAA@A@A@A@@AAAC@ABA@ABA@AD@@A@A@@A@A@@@AA@A@C@@@A@C@A@@BAB@B@@@DABAD@D@D@B@FBFB@@DBBDBB@@B@B@@AB@BBB@B@D@B@B@BCB@B@B@DA@AB@@BB@B@B@@@B@B@DEB@FABAB@B@@A@A@@@A@A@@@AA@@@@@@AAAAAAA@AAAB@@A@A@@AAAC@@@A@@AA@@@AA@@AAA@@@A@@AC@AF@BABC@CBCDAB@R@@@J@LAHCB@DCBADAFBBCDKJKJGFEB@HBB@B@B@BDBBFFFBB@@@@AD@@ABADABABABA@C@ABA@@B@@@B@B@H@FAB@@A@CBA@A@AB@@ABABA@AB@@@BAAA@A@@B@B@B@@@BABAB@@A@@@ADC@C@@B@@@BBABB@@B@@BA@@@C@ABABA@A@@AC@A@@B@B@@@BBB@B@@A@A@@@AAAA@@ABAB@@@BC@A@@@@AA@ABA@AB@@@B@BB@@BA@@@@@AB@@@BBB@@AB@@AAA@@@ABC@A@ABAB@B@BB@@BAB@@AAA@@AAE@@@@A@@@AB@@@B@@@B@@@@@@A@AB@BABAB@@BB@BBD@B@@BBDBD@@BB@@BAB@D@@C@A@AAA@A@ABC@@@ACC@@@AB@B@DBB@FCB@B@@AA@EC@@AC@@"],encodeOffsets:[[103816,23210]]}},{type:"Feature",id:"530821",properties:{name:"宁洱哈尼族彝族自治县",cp:[101.045837,23.048401],childNum:1},geometry:{type:"Polygon",coordinates:["@@IGEGGCMAKAKAG@G@GDGDGNELELEPEJQFOHQJMHIFABCJ@JFJFLEJEJOHEDIFGFOJMNCL@BBJDH@N@J@JBL@R@NGNKXINMHIDOFSBW@WGOCGCOIMCEEKGIGIAK@OAMCKAKEEOAMDCLKNINGJGFAFCJI@@@AAE@AA@A@ABA@A@ABEBA@CFA@A@@@A@A@A@@AA@@BCBA@A@A@ADA@A@C@A@A@AAA@@BA@A@@@AAACCA@@EAEAA@C@C@C@ABCB@@A@A@AB@@@B@D@B@@@D@BBB@@B@B@@@@B@BC@@BAB@BAB@BBDBB@@@B@B@BBB@B@@AB@DAB@B@BB@@B@@@@@@G@ABAB@@A@@BAB@B@@C@A@@@A@@@AB@@E@C@@BA@@A@@A@@DAB@B@@A@@AA@@@A@@AA@C@A@AAA@AA@@AAA@C@A@@@@@AB@@A@AAA@A@@BB@@@@D@B@BCDCBA@ABE@A@@@@@AB@@@@A@ABA@C@CB@@A@@BA@A@A@A@@@A@@@A@@@@@A@AB@@A@@@AB@@@@A@C@CB@@@@A@C@A@ABA@A@A@A@AAA@AB@@A@@@@@A@@@@B@@@@ABA@A@@@CBABAA@@@@A@AAAAA@AB@@@BA@A@CBA@@@AB@@A@ADC@ABA@@@A@AAA@A@A@@@A@CBAA@@A@A@A@A@EBCBEDCD@D@@BB@B@@BB@BCBCB@BABABCBCDI@C@A@BBAD@BAD@@@@A@@@AA@@AAABC@CAC@CA@@CAA@A@AAA@@@A@A@AAA@AACA@@AA@@AA@@@A@@@A@AA@A@A@aHWRC^CVEBGFMHUXY@cIQDKPKVIL@@@BAB@B@B@DABABC@ABA@AB@B@BA@A@A@A@A@@@AAAA@BA@@BAB@BA@A@A@@A@A@@@AA@EA@@C@AAA@A@A@@@CC@@A@E@AAA@CCAAA@@A@@AAAA@@A@AAAC@@AAA@AB@@CDABAB@D@BA@A@EBABC@AB@@AA@@A@A@A@A@A@@CAC@AB@@ABAB@@AA@@@CA@AAA@ABA@AAA@@A@A@@@A@E@ABA@AB@BA@@B@B@BA@@B@BBB@BBB@@@B@B@B@BA@CD@F@B@@B@B@B@BB@BAB@B@B@@A@ABABA@@@A@@D@@@BAB@@BB@@@@AB@@BBA@@B@@B@@B@@A@@B@@BBB@@@@@@BAB@@G@CBKB@@CDED@BGDA@A@ADAB@D@BBD@BB@B@@AB@B@BA@@B@@@EF@BB@DB@@AB@@CB@@@B@B@@BB@@ABAD@B@D@BAF@@@B@@G@@@A@@@@BABAB@B@@ABCBAAABEBABA@@@A@@@A@@BBB@@@BB@@B@B@@BB@@B@@@B@BBBB@D@@BB@@@B@@@@@B@@B@@@@@@@@@B@@B@@B@@@B@B@@B@@BB@@@@@@B@@B@B@@A@@BA@@AA@C@A@AAABCB@@A@@@A@CAA@@@AB@@A@A@AB@@@B@BA@@FA@@DAB@@A@@@@BB@@BA@@B@BA@@B@@@BBD@B@@A@@@@B@@@@AB@B@@@D@@@@@@AD@F@B@B@@AB@@@B@@@B@BAB@BCB@B@@@B@BA@@@@D@@CBCB@@C@@@A@@@BBA@@B@@@@AA@BA@@@@@@@AA@@@@@@A@@@AB@B@@A@@@AA@@@@@@@A@@@@A@AB@@@@AA@@@BA@@@@@AAAAA@@AA@C@@AA@@A@A@@@A@@@A@@@@A@@@A@ABAAABAAAAA@AA@@@CA@@@@@AA@@@A@@ABA@@@BA@AA@@@AA@@CBA@AB@@@@A@A@@@@AAA@@@A@@AA@@@BA@@B@B@@AAAAAB@@A@@@@@AA@B@@@BA@A@A@@@@A@@AA@AA@@@AB@BA@DDB@HBH@BBB@@BBF@B@D@@@BDL^PHFHB^Nr@tHDFRTDDFBBBxNPZLRNXC`FDVM`TPHhXHC@@NGHDXFR@LHFDBB@@DBHJBBDBB@@@B@FGDABAB@BBBDDDBDAFAF@F@B@B@D@BBD@B@B@B@BBB@B@F@@BB@BBFAD@@B@LHNDRBPDJDNBRALDJHLHJFDBHHNBPDRFTFNHDHFJBP@LEREJILABGHMTGNGDEHGJAFCHAJEL@DCH@BAHABCJARGPGRCHGNIPEHEJBPAH@J@FARADCRGHELCHAF@FBF@FDNEJENGJETALIVGVFDH@RCNCLCL@LBJDLFHDNDLAJBRAJANCXBJDBBJHHFNABACNHDFH@B@@BE@A@@@ADA@@BA@AAE@@BABA@@@A@C@@@A@AEAA@A@A@AA@@BAFE@C@@ACEACAAAAAAC@ACEAADAB@B@BB@@F@HAB@D@B@@B@@B@D@D@BBB@BAB@BBB@B@@BBB@D@DBBBBB@BBF@B@F@H@DBB@DAB@B@B@BBFDD@zB`QAUBEBCJGB@T[DERWF]A_NkBCBI@MFYFYBKF¥B[YgaqBIBg@I[aBOLMXC@@@@B@@@@@NAHBPFV@ZSV[PA^LHCDAJMDcASAc@CBoCGAEMMCCO_LW@AtmRIFCTFVRPNNLCXGJELGZMDCB@XUhsLa@@@CASMCcMkm@aJKZKBQDGBAJOb]LUDaBIDOFCX@\\czSXOKYa[AIPOZEDEDC@@AImWNOB@DBB@BA@C@@@C@@DCBAB@FAH@BADCBADCDABA@AA@@C@C@CDEBAFEHEFEBCB@DEB@DA@@BA@@AAGCA@OEAAE@G@A@CBG@CBCBA@ABA@A@ABC@C@MAE@GEEC@E@GHE@A@ABAHG@A@ABA@@@ABABC@A@ABCAAA@ABC@A@AEAAA@C@CBA@EBABA@ABCAAAABABCBABA@G@ABG@A@ABCBADA@ABEH@BCB@@IBA@IEGGGIKOIMAA"],encodeOffsets:[[103932,23237]]}},{type:"Feature",id:"530823",properties:{name:"景东彝族自治县",cp:[100.833877,24.446731],childNum:1},geometry:{type:"Polygon",coordinates:["@@EBK@MCICKBIB_MISwiUcGGOM@BE@CBADADCH@FABAF@DBDBB@@@^[nJZAL@HMLMR@PAJAHLNJLPHPFF@H@PIdGXHXDLDHBXFTDJFJFPNHRBJHPFTFN@BFXFXDHBBBD@T@HDPDL@B@JCLAXENBHBP@DCTCPCDCDEHABABABABFNFPDXGX@R@@CJFVCTITAFDPBR@BDVBHAJAJAJBBDN@JARCVFRDHBFDNLNNJZNNL@FAD@B@BAB@BCD@@ABCBCBABCDAFAD@DAF@F@@@BAD@B@D@D@@@@AD@BA@@@A@CDA@A@A@A@A@@BEBA@CFA@ABCBA@ABA@@@E@GBA@A@@@ABA@@B@@@D@B@B@B@B@B@BBD@DAFAFCFAD@B@B@BDFBD@BBFAD@DA@AB@B@@@B@F@@AB@@C@A@A@AAABABABA@@B@B@BBD@@BD@B@BADCDCDABCD@D@FABABCBCBCBABA@AB@@A@@AA@AC@C@@A@A@ABADAD@LADBB@@BB@@@B@BA@CDCBADEBA@CBAD@D@B@@DBDD@@@BC@EBG@EDAD@@AB@F@BADCDCBCDA@CDGBEBA@AFAB@H@B@B@BDBDDBB@BAJAJ@BGHEFC@AACACAA@CBABABAH@BBLBH@HBBBDBDBB@BAD@BD@@BDBFBB@B@FCB@@@BBDBBB@BBD@BDD@DDDDFFFHFDBBD@@FBBBFAHAJEDA@C@CBABBB@BBDDBBDBBF@BB@FBDBFBB@BB@@B@F@D@B@B@DADAFABAD@DAD@@BB@BB@@BBB@B@B@B@B@D@B@B@@@@@@C@C@AAE@@@A@A@A@ABA@ABA@AB@BAB@B@D@BAB@B@BADABABABCBABA@AB@B@D@B@B@B@DBD@DBD@BBB@B@B@B@BABAD@BADABAD@BADCD@@AB@BB@@BBBB@@BDDBBBDBDBBBBBBBLF`\\LHNBB@@BB@BBB@DDBBBDDDBDBB@D@BBBDDBB@BBHBD@L@B@D@HBDBD@@AB@FAF@B@DBBBD@D@DBDBDBFBDBB@BADCF@DAFABAB@BDF@@B@@@BCB@@@FDH@B@D@D@DAD@DAD@B@B@BABAB@B@BAD@B@DAD@B@D@BAB@BA@@BCBEBABABAB@@BDBBBB@B@D@BA@ABCBA@AB@BEBCBEBABAB@B@DBDBB@DBDAB@FAB@@AB@FCBABABA@ADA@AD@JAJA@@B@B@B@BBB@@AAABC@@@CBABAB@D@D@DBB@DAHCFAHAFABABCBABC@AAO@CBI@CBCDCB@@@BADADEDCDAF@DAD@BAB@BCDEDEDCDADAFAB@@D@BBBFF@BBBFBDBF@FADAHGB@B@B@JBB@DBDBJBBAB@DAHAB@H@B@@@DBD@BAB@@@BABCDAFAB@B@LFDBDBBDHJFBDBDD@@BDDHNHD@RQECCCAA@C@ABCBCBAB@DAB@@CB@DBBBJ@DAFAF@F@FDBBDDB@B@B@B@HGFIDEBC@EAACAEAAAAC@CAE@E@CBCDEBCCA@CAA@I@C@AAA@CBCDCNGTMNKHMBAHIHODGBGFKBGFIFKLSLIDAHEFIHOFQNILGDEBG@KEIBGJODO@M@ODIJKBABGBCBADAD@FAD@DA@@@ABCBABAFAD@D@BADCBE@IACBABEBCFC@C@@AC@A@A@A@ABABAHADCHGDCFCFAPKZUNOBE\\gHCLGLKBADEHO@ABEDSJKN@TEZILGHCPMJERQFELMFENIIQIMGIEIAOAO@CMGYMSOKQ@QAOGKGGGGAI@KRGJAAEAAGMCKCMEEG@CEKAKAQCW@[FM@CAGCBK@OFMHKHMJGCKGEQCKAUAcEMEMIMIGCKMIOGMGOEMGECAaMQDQA[CGAACGKCIGCOJQFQNAPEPCTSRIDO@UDSNAHBVMZI^@JDNBD@@AD@D@DB@@D@B@@@@ABEFA@@B@B@B@@@BBBBBB@@BABCDAFA@ADCFAFABA@A@@@C@GA@@A@ED@@E@A@CBAB@B@B@B@BB@@BB@B@B@D@BBBB@@@BBB@BBBBBDD@BBB@@@BAD@@CD@@ANMLCDMNIRIPONKLCLGRIF@@@B@B@@@D@FBJ@H@B@BABA@CNABCBA@CAECAAA@A@@B@B@BBLMLGPCJEH@LFPDJBJIFMLADILADGAKAYAYAOEAG@ENMNOJO@S@_CQAQEQ@A@@@EBKAAAACAC@A@AAACEICCACCIAA@@CAECAACAABANABA@EAICKKAECW@W@KGS@OLSFCDCG[GYASFKCKAMCG@GGMGKGOAEGQEYKCE@CBCBIBYCQCKEII@CCMEECCI@SBI@MEMMOOIEECOCIC@@CACAEBAAA@AABCAA@AA@A@C@KBCBCAEAA@EDCBACGEEAEC@@EACFSHGB"],encodeOffsets:[[102915,24582]]}},{type:"Feature",id:"530822",properties:{name:"墨江哈尼族自治县",cp:[101.692461,23.431894],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCGEI[QKSA}HWPyT[dW@EDCPAJCbKVa^IPABCHARYLIL@blndNNDBT@D@@KbgtWVA@CDYNKHIFWHDMKOMUQSEEDQJsn@BKXP`DDNNBFDHAp@DBdBTCdINCBGD]KOBU\\YTU@OEGAMB@@@@A@@@@@WDKNAP\\b@JAhAJbrZhA\\E¦ALEZEZ@NAJADMlB`E^QXCFS\\A@IHADAFBVBFBDBJBJ@HBL@N@JFHDDDBBBDBD@F@BBBBAB@DA@A@A@@B@BAB@@AB@@AAC@AAA@@B@@@@BB@B@@@B@B@@B@@@@@DFB@@BBB@@BBBABBDA@B@@ADA@@AA@ABA@@@E@C@EBC@@@AB@@DB@@BB@BBD@BA@@@@@AA@@CBAB@@@@@BCB@BAAACAAA@@A@A@@@@AAA@@@CCCAA@A@@@AB@@A@@@A@@AA@@B@@@D@B@BAB@@@@AB@@C@A@CBC@@@@B@@@BBB@@@@@BA@@BCBCBCBAB@@@B@H@@@B@@CB@@@BA@@@C@A@@@A@@BBB@B@D@@AB@BA@@BBB@BABCB@B@D@B@D@B@B@B@BABC@ABABA@ABA@@B@BAD@D@@C@@@CB@BCBA@ADB@@DBB@D@D@B@F@B@@@F@@@BAB@BAB@D@@@@A@A@ADCBADABA@@BCD@@A@CB@@A@AD@B@@BD@B@BB@ADAF@F@BAB@D@BA@ABCF@@@@@B@@DB@B@@@BCBA@A@@BC@@B@AC@ABC@ABCB@DAB@@CCA@AFBNFNBRDNFLDP@JBN@PCN@@ILKHGHCDBPBL@JCJEDELEPATDPHXJVHF@BLDNBNFJHLJFJLLFNLRDDRCPELGNMHIDO@KBKDKFMHONI@APIPILBNFTFFDL@HG@KCOBK@IJKLDNBNFHFJJFB@@FGHM@KDMHCFGHEFOBIBGNQPEVKNAHAB@@B@@BB@@B@B@B@@@@@B@@A@@B@B@B@@@BAB@@@BA@A@@B@B@@@D@@@B@B@@@B@BB@@B@B@B@B@B@@ABA@@@@BADCBA@@BA@@BA@C@@@C@A@@AAAAA@@A@@AA@C@A@@@A@A@ABA@@BA@@BA@@@AB@@@BCB@BA@A@ABC@A@A@AACAC@AA@@@A@A@AAA@@A@@AC@C@@A@A@E@@@A@A@@@@@AACAA@@A@@AA@A@A@@@A@A@AB@@A@@@A@ABA@A@A@@@@@AB@@ABA@A@@BA@A@A@@@@@A@@@A@@@A@@BA@@@@BAB@B@BAB@B@BAB@@@B@@@@@BA@@BA@A@@@A@AB@@@@@@BB@@BBB@@@@@@@C@@AA@A@A@@@A@@@@@@@C@A@A@@@A@A@@@A@@@@@A@@@@@@@A@A@AB@@@@AB@@@@@@@@@@A@@@@@A@@B@@AB@@@@ABA@AB@@@BBB@B@B@@@B@B@@BB@@B@B@@@B@@@B@B@@BB@@@B@B@@B@B@B@BB@@B@@@BBB@@@BA@@B@@@@@B@@AB@B@@BB@B@@@@@B@@@@AB@BA@@B@@@B@@@B@B@@@@@@@@B@@@@@B@B@@BB@B@@BB@@@B@@@B@@@B@@@B@@@B@@@@@@@B@@@@@@@@@B@@B@@@@B@B@@@B@B@@@@B@BB@@B@@@@@@@@@@@@@BB@B@B@@@B@B@@@B@B@@B@@B@@BB@D@@@B@@@@B@@AB@@@@@B@@AB@@@@@BB@@BB@@BBB@@@BB@@@B@@@@BA@@B@@@B@@@@@B@@A@@B@@@B@@@@@@B@B@B@B@B@@@B@@BB@@@B@@BB@@@@BB@@@@@BBB@@@@@@@B@@AB@B@@@@@BB@B@B@BB@@@B@@@@B@@@@@@@@@B@BB@@B@@@B@@BB@B@BB@@B@@@@AB@@@@@BA@@@@@@B@@@@@@@@@@@BB@@BAB@B@@AB@BAB@@A@@B@@@BAB@@@B@B@B@B@@@B@@@@@B@B@@BB@@@@@B@@@@@BB@@BB@@@BDB@BB@@@@@BB@@BBB@B@@@BBBB@@B@@@B@B@@@B@B@@AB@@@@AB@B@B@@@B@@AB@@@BA@@@@BA@@BA@@@@B@@A@A@@BA@@@@@@BABA@@BA@@@@@A@@BA@@@A@@@@BC@@BA@@@A@A@AB@@ABA@@@A@@BA@@@@BA@@B@@AB@@@B@@@BA@@@AB@@@@A@@@@@A@@@@B@@@@AB@@AB@@@@@@A@@@A@@BA@@@ABA@@@@@A@@@@@AB@@A@@BA@@BA@@@A@@@@@A@@@A@AB@@A@@@@A@@A@@@@@A@@A@@A@@@@@@BA@@@@@A@@@A@@A@@A@@@@@A@AA@@@@A@@AA@@@@@A@A@@@@A@@A@@A@@@@@A@@@A@@@@@@@AA@@A@@@@@A@@@@@@@A@@@@@A@@BA@@B@@A@@@@@A@@@@BA@@@@@AB@@@@AA@@A@@A@@A@@@A@@@@@A@@@@@@@AA@@@@@@A@@@@A@@@@A@@@A@@@@@AAA@@@@AA@@@@@@@A@@A@@@@@@@A@@@@@AA@@@@A@@@A@@B@@A@@@@@A@@@@@AB@@@B@@A@@@@@A@A@@@@@A@A@@@@@AA@@A@A@@@@@A@@@@@@@A@@AA@A@@@A@A@@AA@@@C@@AA@@@A@@@@@AA@@@@A@@AA@@@A@@A@@@BA@@@A@@@@BAA@@@@A@@@A@@B@@@BB@@@@B@@@BBB@BB@@@AB@B@BA@@@@B@@@B@@A@@B@@A@@BA@@@AB@@A@ABA@@@@B@@@BA@AB@@@@AB@B@@@BAB@@@@AB@@A@@@A@@@A@A@@@A@@@@B@@@@B@@B@@B@@@BB@@DAFBB@B@D@BBB@BBBDDBBB@@@BBB@B@@BDBB@B@@@@@@@@@B@@@BA@@@A@@@A@AA@@@@A@@BAB@@A@@@@BA@@@AB@B@@AB@B@BA@@B@@AB@BAB@@AB@@A@@B@@A@@BA@@B@@A@@DA@@B@@A@@@A@@AA@@@A@@@A@@BA@@@A@@@@@@BA@A@@@@B@@@B@@@@@B@@@B@B@@@@@BA@@B@@AB@@@@A@@BA@@@@@A@@A@@A@@@A@@B@@A@@BA@@@@B@@@B@@@B@@@B@@@@@B@D@@@B@@@B@@@@A@@BA@A@@@@@A@@@A@A@@BA@A@@@A@A@@B@@@@A@@@AA@@@@CB@@A@@@C@@B@@A@@@AB@@A@@@@BA@@@@B@B@B@BBB@@@@@B@@@B@@@@BB@@@@@B@@@D@@B@@@@ABAB@DA@@B@B@@@@@B@@@@@B@@@B@@@B@@@@B@B@@@B@BBDBB@@BB@@@@@@@@@@@@@D@BB@@@BB@BBD@@@@B@@D@HAD@B@@@BD@@BBBBB@D@DBD@BBB@DB@@DBB@@@B@@@BB@@B@@BB@@@BA@@BB@@B@@@B@BB@@@D@@@@@@@@@B@@@@@@BB@@@@@@B@@BB@@@B@@@B@@BB@@@BBBB@@B@@BB@@@B@B@@@B@@AB@@AB@@@BABA@@B@BA@@@A@@@A@@@@@A@AB@DAD@DAB@B@DA@ABA@AB@D@B@@@@ABA@@AA@ACAAAACA@A@CAA@C@AA@@AAB@@A@@@A@@@AAAAC@@AAA@CACA@@@A@ABA@CB@BC@@BA@@@@A@AA@@CACA@@AA@A@@@A@AAA@@A@@@A@CB@BA@A@ABCBA@@AC@C@ABGB@AA@AA@AAA@AAAAA@@BEFEBCAA@E@AB@DBF@JBBBD@F@B@FA@@B@@ABC@@ACAA@AC@AC@ABAB@DABG@ABA@@D@BA@@@EB@FA@@DABCDC@@DCBA@A@@BC@G@C@AB@@@B@B@@@B@BBB@@@BBB@B@B@B@B@BADABAD@@@DA@@@@@@B@@@@@@@@@B@B@@AB@@@@A@@AA@@AA@@@A@@@A@CA@@@@A@@A@@@AA@@A@@@A@@AA@AAA@@@A@@A@@A@@@A@@@@@@ABA@@BA@@@ABABA@A@@@A@@@@A@@@@@@A@AB@@A@@@AAA@@@AAAA@@AA@@@AA@@AA@@A@@A@@@A@A@A@@@@B@@AB@@@BA@@B@@BB@@AB@@@B@BAB@B@@@B@@AB@@@@@BA@A@@BA@@BA@AB@@A@A@A@@AA@AB@@A@A@A@@@@BA@@BA@A@AB@@@B@@AB@B@@BB@@@@BB@@BBB@@B@BBB@B@B@@@BBB@@@B@B@@@B@@@B@@@B@BA@@BA@@@A@@@@B@@@@@@A@@@@BA@A@@@AA@BA@@@AB@@@@A@@@@B@@@@@B@@@B@@@@A@@@@@@B@@@B@@@@A@@@@@AB@@@@@@@@A@@B@@@@@@@B@@A@@B@@@@AB@@ABA@@@A@@@@@@@ABA@ABA@ABA@A@@BA@A@@@A@@@A@@@@AA@@@@@AA@@A@@@A@@BA@ABAB@BA@@BA@ABA@@@ABA@@@A@@BAB@BABAB@@AB@@@B@B@@A@@@@BA@@@AA@@C@@AA@@@A@A@@BA@A@@BA@@BAB@BA@@@A@AB@@AB@AA@@AAA@@@AAA@@A@AA@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@AB@B@@@@A@@@@BA@A@@BAB@@AB@@A@@@@@@@@A@@AA@A@AAA@AAA@@@A@A@A@@@A@A@@AA@A@@AA@AAAA@@@A@@@@@A@A@@@@@A@A@@AAA@@@@@@A@@@@@@@@BABA@@BA@@@A@@@A@@@@AA@@@@@A@@@AA@@@@A@@@@AA@@A@AA@@@@ABA@@@A@@@A@ABA@@B@@A@@@AB@@@@A@@@@@A@A@@@A@@@@@@@AB@@@@AB@@A@A@A@@@@@AA@@A@AAA@A@@@@@AA@@A@@ABA@A@@BA@@BA@@BA@@@A@A@@@A@@@AB@@AB@@A@@@@@C@A@A@@@A@A@@@@@A@@@@@A@@AAA@@@A@@A@@@AA@@A@AB@@@@AB@B@BAB@B@@@@A@@@@@@@A@@@@BA@@@A@A@@BA@AB@BABADA@ABAB@@A@@@@@@@@@@@@B@@@@@@@@@B@@@@B@@B@@@@@BB@@@@@@BAB@B@@@@@BBB@@@@@@@B@BBBA@@@B@@@@B@@B@@@@@@B@@@@B@@@@B@@BB@DBB@B@B@B@@@B@@@B@B@D@B@@@B@@AB@@@B@BA@@@@B@@A@@@A@AB@@A@AB@B@@BB@B@@@@@B@@@BA@@B@@@B@BABAB@BAD@B@B@@A@@@@@@@@@@B@@@@@@@@@@@BA@@@@@@B@@@BAB@B@@@D@B@BAB@B@B@BA@@BA@A@@@A@A@@@A@@@AA@@A@@AA@A@@@@@AAA@A@@@AAA@A@@AA@A@@AA@@@A@@@A@A@AB@B@@@@AB@D@@@B@B@@@B@B@B@D@B@B@B@@@B@B@D@B@@AF@B@B@DB@@@@@@@@@ABA@AB@@AB@@ABA@A@@AA@A@@@A@@@AB@BABA@@BA@@B@@@B@B@@@@@BA@@@A@AB@B@@A@@BA@@BA@@@@@@BA@@B@@BB@@@@@@@B@BBB@@@@@BA@@@@@@@@BA@@@@B@@@B@BA@@B@@AB@@AB@@@@@B@@@B@@@@@B@@@B@@@@B@@@@@@B@AA@A@@@A@A@@@@BA@@@@@@@A@@@@@A@@A@@@AA@@@@AA@@A@@@@@A@A@@@@@@@@@@AB@@A@@@A@@BA@@@A@@@A@AB@@@BA@@@@@A@A@A@@AA@@AA@A@@@A@@@A@@BA@@B@BA@@B@@@B@B@@@@@B@@AB@@AB@@C@@@A@@B@B@D@@@D@B@@@@@@@BA@@BC@@@@@A@@@A@@@A@@AA@@@@@@A@@@A@@A@@@A@AA@@@AA@@AA@@A@@@@@AAA@@@AAAA@@@@@AAA@A@@@A@@@A@C@@@A@A@@@AA@@@@A@@AA@@AA@AAA@@@A@ABA@@A@AA@AAAA@@@AA@@A@@@@@@@@BAB@@@@@@@@@@AA@AA@@@A@@A@@AA@AA@@@A@A@AB@@A@@BA@@@@@@@@@A@@@@@@@@@@B@@A@@@A@@@A@@@A@@@@@A@@@@BA@@B@@@@AA@@@@@@@@@@AB@B@@A@@@AA@@@@@A@@@@@@@@A@AAA@@AA@@A@@@A@@@A@@@@@AA@@A@@AB@@AAC@@@A@@@A@@@A@A@A@@@C@A@A@A@A@@@AA@@@A@A@@BA@@@@@AA@@@@@A@@@A@@A@@@A@@@@@@AA@A@@AAA@@@AA@@@@@@@ABA@ABA@@BA@@@A@@B@@ABA@@@A@AB@@A@@B@@A@@@@@@@A@@AA@@@@@@@@@A@ABA@@B@@A@@@@@@B@@AB@@@@@B@@A@A@@B@@@B@@@@@B@@A@@@@@AB@@A@A@A@@@@@AB@B@@@B@@@B@@@@@B@@A@A@@@A@AA@@@@A@@@@A@@@@A@@@@@@@A@AA@@A@@AAA@@@AA@ABC@A@A@C@AAAAC@@@A@@@@AA@@@@@A@@@A@A@@A@@@@@@C@AAA@@AAAAAAA@@@@A@@@A@@@A@A@AAA@AAA@@@@@ABA@@@@BA@@AA@@@@@@B@@@B@@AB@@@@A@@@A@@@@@@@@BA@@B@@A@A@@@A@AB@@@B@@@@@B@@B@@B@@@B@@@B@B@@AB@@@@A@@@@B@@@@@@@B@@ABABAB@BA@AB@@@@@B@@@B@@@B@@@B@B@@@B@@@B@BA@@B@B@@@B@B@@A@@B@BA@@@@@@B@@@BB@@@@B@@@@@B@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@BA@@@@B@@@@@@@B@@@@@B@@@B@@@@AB@@@@@@@B@@@@AB@@@@AB@@@B@@@@@@AB@@@@@@A@@@@B@B@@AB@@@B@@A@@@@@@B@@@B@@@@@B@@@B@@@BABBB@B@@@BB@@B@@@B@B@@BB@@@@@B@@BB@B@@@@BB@@@@@B@B@@B@@BBBBB@@@@@B@@@B@BBB@@@@B@@BB@@@@B@@@B@@AB@@@@@B@@B@@@@B@@@B@@@B@BB@@@@B@@@@AB@@@BA@@B@B@@A@@@A@@@A@@@@BA@@A@@A@@@A@@@A@@B@@@@A@@@@@@@A@@B@@@@B@@B@B@@@B@@A@@FA@@@@@@@@@@@@B@@@B@@@@A@@@@@@B@@@BA@@B@@@B@@@BB@@@@@@BB@@@AB@@A@@@@B@@@@@@@@@BB@@@@@B@@BB@B@B@@@B@BB@@BBB@@B@@@B@BA@@@@B@@@B@@@B@BA@@@AB@@B@@@B@@@@@@@B@@@B@@@@@@BB@@@@@BA@@@@@A@@B@@@@@@@@@@@BB@@B@@@B@B@@@@AB@@A@A@@@A@@@@@@@@@ABA@@@@B@@@@@B@B@@@@B@@@@@@@@BB@@@@@@@@@BB@@@@B@@B@@@B@@@@@BB@@B@@@@@B@@@B@@@@@@@@@@B@@@@@BA@@B@@@@@B@@@@AB@@@B@@B@@@@B@@B@@@@@@B@@B@@B@@@BBB@@@@@@@B@@@B@@@@@B@@@@B@@B@@AB@@@B@@@@ABA@@@@BA@@B@@@BA@@@@@A@@@A@@@BB@@@@@@@@@BB@@@@@@@B@@@@B@@B@@B@@@@@BB@@@@@B@@B@@@@@@B@@@@BB@@@@@BB@@@@@@B@@@@BB@@@@@@@B@@@@@BB@@@@@@BB@@B@@@@@@B@@@@@@@@A@@@@@A@@@@B@@@@AB@@@@@B@@@B@@@BBB@@@@@@@B@@@B@@@B@@A@@@@B@@A@@@@@@@A@@A@@@@AA@@A@@A@@AAA@@@A@@AA@@@@AA@@@@@A@@@A@@@@@A@@@@@A@@@@@@A@@@@A@@AAA@C@AA@CDCDAB@BC@@@A@AB@@@B@@@@BB@@@B@@@@@@@B@@@B@B@@@B@@@@@B@@@@@B@@@@AB@@@B@@@@@B@B@@@@@B@@@@@B@BA@@@AAAAA@@@A@@@@A@@@@@@AA@AAA@@@@AAA@@AA@@@@@@@A@@@ABA@@BC@A@@B@BA@@B@@@B@@@@@@@@@HAB@B@F@D@FAD@JADAFCBABCFCDADADCAAAEAC@CBCBCBABAB@B@@ABA@ABABABAB@B@B@BBBAB@@@BAH@L@F@BAB@BADABAB@CE@A@EACACCAAACAACAAACAAECCAE@ECCCACBA@CBEBADEBEBCBCDAFCDA@EAA@AAEBGAEEEOKAA@CDEFE@EACCAGBG@A@A@AAACA@CC@CACAECAAAAECACCCA@ACA@CAAAACCA@ABAD@@AB@@C@C@GAAAA@CAC@C@CAEBEAC@Q@CBGDCB@@A@AB@@@F@B@@@B@@@@@@@B@@@@@@@B@@@@@@@BB@@@@@@@BB@@@B@@@@@@@BA@@@@@@@A@@@@@@@A@@@@@@@@BA@@@@@@B@@@@@B@@B@@@@B@@@@A@@@@@@B@@@@@@A@@@@B@@@@@@B@@B@@A@@@@@@B@@@@@@BB@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@A@@@@@@B@@@@@@@@@@A@@@@@@@@@@B@@@@@@B@@@@B@@@@A@@@@@@@@@@@@@BB@@@@@@@@@BA@@@@@@@@@@B@@@@@@@@@B@@@@@@@@A@@B@@A@@@@@@@AB@@@@@@A@@@@@@A@@@@@A@@@@@A@@@A@@@@AA@@@@@@AA@@A@@@@@AA@@@@A@@@@@@@A@@@@@A@@@A@@@A@@A@@A@@@@AA@@@@@@@GACAA@A@@AA@A@C@A@IA@@A@C@G@C@A@A@A@A@A@A@CAA@@@@@@@A@@@@@@@@BA@@A@@@@@@@@A@@A@@@@@@@A@@@@@@@@@A@@A@@@@@@BA@@@@B@@@@B@@B@@@@@@@@@B@@@@A@@B@@@@A@@@@@A@@@@@@B@@@@@B@@@@@@@B@@AB@@@@@@@B@@@@@@AB@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@A@@B@@A@@AA@@@@@A@@@@@@@A@@A@@@@A@@@@@A@@@@@@@@@A@@AA@A@@@A@@@@@A@@@A@@@@@A@@@A@@@A@@@@@A@@A@@A@@@@@A@@@@@A@@A@@A@@@@@A@@@A@@@A@@@@@A@@@@@AA@@@@A@@AA@@@@@A@@@@@@@@AA@@@@@@@AA@@@@@@@@@AB@@@@@B@@@@@@A@@@@@A@@@A@@A@@A@@@@@@A@@@@AA@@A@@AA@A@@@@@A@@@@AA@@@A@@@@A@@A@@@AA@@A@@@AAMFMGMICCAEAGDC@AAACA@@A@ADCB@DCBA@CBCBCBABA@C@AA@@@BEBA@A@CBC@ABABA@CGIMKAEFI@A@@BE@GAGEEC@IFEBC@A@AAAACACAACCGCAECCEAAAE@@ACCCCCCCC@A@CAA@CAII@AADABEDCBCDADCDCHABADAD@BABA@A@@@C@A@GFCBCBABABCDAB@DADADA@AAGBUTaBmE]KCC@@@@_SGKKW]W"],encodeOffsets:[[104091,23424]]}},{type:"Feature",id:"530824",properties:{name:"景谷傣族彝族自治县",cp:[100.702871,23.497028],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@QLIFGDEBC@C@A@EBGBEBCBMEGCA@E@C@CDABA@ICA@@@I@C@A@CAAAC@C@IBC@EAC@EACAIAC@HHBFBFAD@BCLKP[dCFG\\AD@L@RBBTn@DOHA@IAC@@C@@KMQCA@A@KNALAB@NABARAB@L@D@HAPCN@DCA@@EAeOI@iR_fAFObMBWB_ZGBABIDENKnIjAD@`@D@DYrY\\w`uTEH@@MV@NB@n\\`^PlB@@R@JALG`@BOhFt@DB@DDtIb@@@@@BA@@BALLdljJBRXHNBFHRBDDLBF@D@B@H@H@F@J@FBFBDDFBDFJBB@DFVBHBF@FBLDFBDBDHZ@HBDBDDFFFBD@F@DAFAF@D@H@DBFBBHBB@FDJDNDND@@@@@@F@HEDKJANCJ@FDJJJTDLDHJFJDFBJBP@LAD@FCLKHI@AFIFGDADADBD@FADCDAB@NAD@FBB@H@LBB@HAB@B@BB@@D@B@JGB@B@DD@F@@BFBBBBBBBB@@DBB@D@BAD@D@BBB@D@DDBBDFBBFFBF@BDBB@F@BBFFFDBBHBBBFHNDPDNHBFMLIDEDQFQFSLKFIJCJBFBHFP@NADAHCHINKHKDMDKDGFCJBLDDJFJHBLAP@FAL@FADCRCNCNHJFJ@@DBBBBHBH@DBB@BPEJANBT@VBXAR@J@LAR@RBJBJBDAJCLIFMNCJAF@FBNHNHNJJBJFJJNLJHFDHFNDNETINCNEPELIHGRMFAJC~@VKNKFMLMFKJKBALGBADAXIL@tA\\@^LLGPMtOZEB@BB@B@VF@FADBB@B@B@D@@@@@@BBB@@BBBB@@@@BA@@BA@@BA@@BAB@B@@@B@B@DAHEB@BA@@DBLBRGDIFCJCNAR@R@NCHGBAHGNOBG@AEQAQ@KHQVKHQAKASBODGDCJEJELGHKDELKBOBMBEBIDIBGFCFCHENCBCFELGFCHCHUJUBKFSHIFMFICM@EAE@EBEDGFKHGDQBCBQ@E@IBGAOFIFGJOHMDGHQHOBQDIBABG@ADG@CFKBIDGBEHIFGHCHMNSHGBAJKFIFQ@KAOEICGMGSEQEOCMAGGCAIEKGIGKCQBMAICOCQAMCKGA@@@BCAE@AAA@@@E@AAA@A@A@A@AAC@A@C@A@A@EBEBEACCCACAAA@ABCBEHA@@@A@CAAAGICA@@AAECKGQ@WEGCMH@@GDgWOG_SUNECD_MWKQOYwMAAEACCQSCEsGq@]MGAGE]OCK@A@@@C@AAE@AA@AAG@GAA@CC@A@@@A@AAAC@G@AACAAAABA@@BA@A@ACAABI@A@A@AA@AAA@AAACA@AAA@AACC@A@AAAAC@@@ABA@A@AAAAAAAA@C@CBE@C@A@C@@@C@ICGAA@A@BEBCAG@A@GBG@I@CGGEEAAEAAAAA@ABA@AACAAA@C@C@A@CCEAEACAA@ACACCC@A@AAAA@ABA@@BCB@@A@AACAAEAC@CAA@@BABCDG@E@AA@CAC@CAA@AAAA@EAAAA@AAA@AAAA@A@AC@A@C@A@C@A@@CAAABABABA@@BABC@A@C@A@ABABABABA@A@A@CBA@CBA@@@ABABAB@BAB@@CB@DCBAFADA@@BCBABA@C@A@G@G@CAA@CACACAA@@A@A@C@@@AA@@@C@AA@ECAAA@C@@BA@A@A@AAA@ACAA@EACEEAA@A@CBA@C@C@AA@C@EBC@A@CAE@G@E@C@A@EDABA@A@AAAAA@A@GAG@MA@AA@AA@A@AB@@A@EAA@A@ABGBE@C@E@E@EBABE@C@C@A@AAAC@AAA@A@E@A@MCAAMAI@C@EACBE@CBABCFABADCBC@I@GBA@CBAFAB@BABCBEBEBCDAD@BC@@D@@@B@BCBCBA@CECAAAA@@@ABABAAA@CBCBA@EBC@E@CBCBC@E@CBC@CD"],encodeOffsets:[[102836,23394]]}},{type:"Feature",id:"530826",properties:{name:"江城哈尼族彝族自治县",cp:[101.86212,22.585867],childNum:1},geometry:{type:"Polygon",coordinates:["@@GAOGIOKOGMIE@@CCA@ABCBA@ABA@AB@@ABA@AB@@@B@BAB@@CBAB@@@@BB@@@@ABABAB@B@B@BA@@B@B@BBBAB@B@B@BDF@@BB@B@@@D@B@@A@A@A@A@ABAB@@A@A@ABA@A@C@ABA@@@AB@@A@A@A@@@AB@@AB@@AAA@A@@@AD@@A@C@AB@@@B@@A@A@C@EBA@A@C@AAAAA@AAA@@@A@ABA@@@A@CBC@C@IJULQFMFKBSEKKUKWGMIGEMEKAK@KDIFKDMBIDQBM@UAUAEE@GHMF[@YIKIMKAOBQ@aJONMTQNSLMR@bBZGJGDEDEDEJAJMBMHCFGVILOJS@KGMMCEQAQAIGGCOMSEQ@QBSBKCCGBIFKEOACASCOIOKMAAMKCKDINQNMLOFICMMGK@OBAKBU@WAEG@I@GBABGFGBSBEBE@EBCACAACC@G@AAAA@C@ACAAAE@@@IJABABC@EA]KOAEBADAHJ`BFJLBH@D@BBD@D@B@@AB@@ABA@CCGAC@@@ABDB@@BBABAB@@A@AAA@ABA@@B@BCBABA@AAA@A@EDA@C@C@@@CDAD@BGFOJOX@@@BDFBD@@@B@BAB@@@B@B@B@B@B@BABADAD@@@BAB@B@@AD@B@@@B@B@B@DAB@@ABA@@BA@@BABA@ABABADCD@@AB@D@B@@@@A@@@@@A@@BA@@B@@BBBB@B@BB@@BAB@B@B@B@@@@@B@@AD@@@BAB@B@B@BAD@@@B@BADAB@DAD@@@BA@CFABADABAB@BAB@@@BAB@BA@@@@BAD@B@BA@BB@F@B@BA@@BA@@BBB@@BD@B@B@B@B@BABABCB@B@@@B@B@BAF@BA@ADABAB@BABB@@B@BBD@B@@@BA@@BA@AAAAAAA@@ACB@@A@AB@@@B@BAD@@@BAB@BA@@BAB@@@@AB@@@BABAB@B@B@B@B@@@BA@AB@@@BAD@BA@@BBD@@@BBDB@@B@B@D@@BB@@@@@BA@CFA@@@AB@B@@@D@B@@B@@BB@@B@@@@@BA@ABABABAD@BBB@@BF@B@BA@CBA@AB@DAB@@@DAD@BCD@BA@A@A@@B@B@D@F@BABABA@@AAAAAA@@A@@ABAB@BBFB@@B@BADA@C@AA@@A@@BADABAB@B@BBB@B@B@D@D@BABA@A@A@E@AAABC@ABABAD@DBF@BADAF@@A@A@C@A@ABA@@DEH@B@@BB@B@B@@EBEFA@A@A@@AA@@@A@CBABA@A@AB@@BD@@FDB@@BA@A@EDA@CAA@A@@B@@DD@B@@AD@B@BBB@B@B@DC@A@AB@@AA@@ACAC@AA@C@AAA@@AA@ABABA@@B@B@@@@A@@@A@@@A@@B@@@B@@F@BB@@BB@BA@AB@@AAA@A@AB@B@BAD@B@@BB@BA@@BA@AA@@A@@B@@@@AB@@AAA@@@A@@BAB@BBB@@@@@BAD@@A@AB@BB@BB@B@@@B@BA@A@AA@@A@A@@@@BBD@@@BABAB@B@D@@AB@@@AA@BAAA@@A@@@@DCD@B@@@BA@ABAB@@A@A@A@@@@BBBAB@@A@@BABAB@BA@@B@BAB@D@BA@EBG@A@A@@@A@@@AB@B@DABABABCBAB@BC@@B@@A@EAEEAAACA@A@A@GAA@EFIHILCLADEACBABCDA@GDKBI@@@Q@A@CBAD@DADABE@@BBD@@@B@@BB@BB@@B@@BB@@@B@@BDBB@@@B@BA@BB@BBBBBBB@B@@@@B@@B@@@B@B@@@B@BBAB@B@@BBF@B@@IJEDEBIHMHMJKLCDBNFPLFLBNDPBL@JBJHLHFFNDPJHDPDXHX@TAPEJCNGJMLWHM@M@QAK@I@I@MCGAI@ADKNMPIHEJEFCPGFIFIEKEI@IDIBAJENGRIPGREFIFOFKFKHMHCHCH@H@LBLBNBHDFHJHBBJNLPHJHHJFB@JA@@DA@AFGBAB@BCDABAB@H@BAH@B@BADABABABBDBBAB@BAFAB@DAD@B@BBBFB@D@BAB@BBAD@B@BADAB@B@@AB@B@BGHAB@B@BGF@H@FFDHFF@NBD@D@BAB@B@BAB@DADAH@DAB@H@F@BBPFB@HDBB@@AB@@CBA@CFA@ADEFGFEFABCF@D@D@DB@@BABCBCDABCDABG@EBA@ABCD@@@D@@@DABA@CAA@MPnXBJ@@CDCFYFOPBJb\\LZ~GTBRLJ\\HFDD^XLXHL`T@@@@DD^LnFbAVSHABBB@BCBC@CBADCBABADADAHEB@D@@@B@B@BA@ABCBCBADGDCBCDCDAFCBABCBCDABABCBABADABCFC@A@CBADABCB@@ABA@A@ABCBC@CBCBC@ABA@@BCDABADAB@@@BABABABCDA@CD@BCB@B@B@B@BAD@DADABADCBABCDEBCBABADABAH@B@BAFCDCBCBAD@@@@BB@B@BADAD@@ADCB@BBB@B@B@B@BABAD@BBD@FFBBBBFF@B@BBBBFDF@BBBBBDB@BB@BBB@BBD@DBD@BAD@D@BAD@D@BADADAFADCBADAB@BABABC@ADABAB@@ABCBAD@BABABABAB@D@B@FCB@BBB@DBD@B@DBB@FAB@B@D@BADAD@BA@CBCBADCBABCB@BABAB@DABAD@BAB@BAB@BABABABABC@A@ABA@CBABA@ABABA@AB@@ABABCDABCBCBA@AB@@ABA@ABADABABAB@BABCB@BAB@BADABCFCBABADCBABADA@@DABA@A@A@A@ABCAE@@@C@A@C@A@AAABA@E@A@C@A@C@@@ABA@A@ABEBC@A@C@ABC@A@ABCBABCBCBA@@B@BA@A@C@AB@BA@ABA@ABABABABA@ABA@A@ABCB@HGBAD@BAD@HAB@FAB@@@D@BADBBAB@B@BAD@DAB@BA@A@@B@DABA@@B@BABAD@@AB@DADABABAB@DADABAFAD@B@HABAD@DAFAB@BABAD@F@BBD@B@B@B@D@BBDBDAB@BABAFAB@DBB@@@BAB@BABAB@B@BAB@@@BABADA@ABABAB@DAB@B@BB@DB@B@DDFDBBDB@BB@D@BBB@B@D@DABAD@B@DABABABABABADCBCB@BADABABAB@B@D@@@BADABABBBDF@BABABADABAD@BBB@DBB@BBDDBBB@B@@@@@B@BB@BDBBABA@A@@BCBA@EBABCBCAA@CB@BAB@BA@ABA@CBA@A@E@C@ABABABABABC@C@A@ADCB@BABA@@D@BAB@@ABAB@B@BCBA@ABAB@BA@ADCBABABAB@BAD@DBD@B@@@@@@I@ABC@@@AA@CA@A@@AA@@AAAAAAIGSOKSKQKQKUDMHGOCWUMMCCBEHOJSF[BGAIMBQFSLMFYBWBMBm@G@"],encodeOffsets:[[104632,22969]]}},{type:"Feature",id:"530825",properties:{name:"镇沅彝族哈尼族拉祜族自治县",cp:[101.108595,24.004441],childNum:1},geometry:{type:"Polygon",coordinates:["@@MBGEIGAAICWAMDIBQBIAKBMCGCKEICKAK@KDMDQDG@ECGDEDKHEFADMDGFEDEDAHCJAJAFANAPKLCFGLKHIFIFCDCHAPBTBLGRULGR@LBRFR@BAHMPGHABGHMDQ@Q@MBIDEDCJQHKACA@@ABA@GFCBA@A@@@A@A@AB@@AB@@AB@@AB@@@@AAAA@@AA@A@@@@C@A@A@A@CAEBE@@U@AAAA@YFsPONKH]K[@sBK@WJCBABKHABILELKNENMLUL}@IDEBQNGHKJOFMFMDSJMFMCGEECIGMKIIIEIAMIMGMGEAE@IBMDENKJIDCBIAIAQAQ@KBI@Q@WBUAS@MAIBOF@AAAA@CBA@A@@@A@CBIFAB@B@D@FBDFFDDFLB@BBB@BABABC@EB@BADBD@BADBDBBDBB@HAD@@BDBDDDDBD@BA@AB@BBDHDBF@FADCBAD@BBJZ@HDFDDBB@DADCD@DBJ@HFB@D@HCHAHAF@FC@A@AB@BAF@BC@E@PNHHVdxjJT`NJALAJDNDL@FAHATGDEFB@@FDFBHFBDDAFCB@FBDBDALAD@B@B@@BBBADBBB@BBFADBDB@@JDPDFDJFPPNNNFJ@TAJ@DDFFDN@DJJLFRDZDJADADAF@LDFZHRBFHPHLHN@HDHBNDLELBTHZH\\CDEDKT@PHT@L@XDXBFLLJDFBB@BABMBADBBBFDDB@@BBDJBDDDFJBDBBB@D@DBBBBBAL@F@@@BFRBRDR@`@TIPMPMN@FBHPFZBZBLBHBBCJKBCNKJEAICIEO@KFGDIHONKAK@A@A@AB@B@BBFDDBB@DABADMB@BA@A@A@GAI@E@C@@@A@A@@JEHQDKLKPMJOJQNMDCNKBM@@DC@@BC@A@@AA@ACCAAAA@AAA@A@@AAAAC@A@A@A@@AA@@A@A@A@ABADAB@F@@@FCB@@@HBD@@@B@B@BABEDEBCB@BEDCBA@AA@AAAA@A@@@A@A@AB@FEBA@@@@@A@CA@@C@CBC@@ACCM@IJ]NYAUBGTMVCP@JCTQDSFOBORMREPIHDDJHLBDHB\\DRBRCbNDBHFFNHPHNJPLNHDNJNJNFdFVBLBRDHFDLIHGNGLEN@PALHDDBN@\\EX@RDLBLBDFH@FFDNDLHNBBBFIBQH@LBJHHHHHLBP@RLRTPZNNH@DBPBPFJHJJNJRFANCV@DABABABABC@@HEHCBC@A@CBAHKDEDEBCBABABC@ABA@ABABADEBCBADADABC@@BABCBAB@@A@A@CBCBC@A@ACA@AA@@@@ABA@A@ABABABABA@ABADCBABCBABCDABCDABADABABABAB@BABABA@AB@@A@A@A@A@AAA@CBC@@BA@A@AAAAA@AAABA@A@AB@@ABA@ABA@C@ABC@A@A@C@A@A@C@ABA@ABA@@BCBA@ABA@A@A@A@A@A@A@CBA@ABABABABAB@DAB@DAB@D@DAB@D@BAB@@@BABADAB@BAB@B@BAB@@ABA@A@@@A@AAAAA@AAC@AAC@A@A@C@ABA@A@CBA@A@A@C@ABC@ABA@ABAB@BABABAB@BA@ABAB@BAB@DAB@@@BABCDIDEHEFGBA@A@ABA@@BA@A@A@A@A@C@AAAAAA@AAAA@A@AAA@ABCBA@ABA@A@AAA@A@A@ABA@A@ABA@@BCB@@ABABAD@BABABAB@BAB@@@BAB@B@B@D@DBD@B@BAB@BAB@D@BAB@B@@AB@B@@A@@@AAA@A@C@ABA@A@A@A@A@@@ABA@ABABAB@@CBABABC@@@ABA@A@@BA@AB@@A@@@@@@BA@A@@@@@@@A@@AA@A@@@@AA@@@@@A@@@A@@@@AA@@@A@@@@@A@@@@@AA@AA@@@@@A@@@A@@B@@@BB@@@@B@@AB@@@B@B@@@B@@@BB@@B@B@@@B@@@@@B@B@@@B@@@B@@@@BB@@@B@@@B@@AB@B@@@B@BA@@B@BAB@@@BA@@BA@@BA@@@AB@@@BA@AB@@@@A@@BA@@BA@@@A@@@A@A@@BA@@@@@ABA@@B@@A@A@@@A@@@AB@@@@AB@@@BC@@@@BA@@@A@A@@@A@@@@@AB@@AB@B@@A@@@AB@@A@A@@@AA@@A@@@@@@@@B@@A@@B@@@@@@@@@B@@A@@B@@AB@@A@@@AB@@A@@@AB@@AB@BAB@@@@A@@@@B@@@@@BAB@BAB@@@B@@@B@@@@B@@@BBB@@BB@BB@@@@@B@@@B@@@@AB@BA@@@AB@B@@AB@@@BA@@BA@@A@@A@A@@@A@@@A@@@@@@@AB@@@@@BA@@@@BA@A@@@@@AB@@@BA@AB@B@@ABABAB@BA@@BA@@BA@@BA@AB@@@B@B@@@@@@@@@@A@@A@@@@@@@AA@@A@@AA@A@A@@@A@@AA@@@A@@@A@@@AB@@AA@B@@@@AA@@A@@AA@@@AA@A@@@A@@AA@@@@A@@@A@@AA@AACA@@A@@AA@@@@AA@@@@@AA@@@@@AA@@@@@AA@@A@@AA@@@AA@@@@@AA@@@@AA@AACC@AAA@AACAC@C@ABGAA@@AACAACA@AA@@GGEEA@AAAAA@@@A@@A@@AA@AAC@E@EA@@A@@CCCA@AA@ACAA@@CCCC@@AA@AAA@@AA@@@@@BA@@@@@@B@@A@@@@B@@@BA@@@@B@@@B@@@B@@@B@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@AB@@@@@@A@@@@@@@@@@@@@@@@@A@@@@AA@@@@@@A@@A@@@@B@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@AA@@@@@@B@@@@@@@@@@@B@@@@A@@B@@@@@B@@B@@@@@AB@@@@@@@@@@A@@@@@@@@@@@@@@@@AA@@@@A@@@@@@A@@@@@@@AB@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@BA@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@AA@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@A@@A@B@@@@A@@AB@@@B@@@@AB@@@@@B@BA@@B@@AB@@@@@@A@@@A@@@@@@@@A@@@@@@@A@@@@B@@A@@@AAA@@@A@@@A@@@@@A@@@A@@@AAA@@AA@@@@@A@@@A@AAC@@AA@AA@@@@A@@@A@CCCAA@AACEA@ACA@@@CAA@CAC@@A@@@A@@DCB@@AB@BAB@BABA@@B@B@AAA@@@A@A@@@C@@@A@A@@@@BAB@@A@AB@@A@A@A@@@@BA@@@@@A@A@A@@@AA@@@AA@GBMBULOFMRAHAJEPGFEHGDCN@LGNEH@@EAIIGEMEMAKCIL@JALDP@LGHK@ECSEMEKAOJOJ@BMJGPENCLAL@LCPGJMNKHOFQDCCKQEMKKEIKIIGMEMAKC@AGEIUGWCOBSFOFKFCDI@IAKAODCHGLGJK@@DM@OAM@ICOEKCMAQEMAMBEB@DD@@BA@CDABAD@BAD@@B@AD@@AB@B@DA@A@@@ACA@@@A@@@@DEBAB@@A@CBA@A@EBEBCA@@A@AAC@@@ABCB@@@DAB@@@DC@AB@BABCDABCB@B@@@@@@CBA@ABA@A@@@E@@@A@E@A@C@CAA@CA@BCB@DA@ADA@@D@@@@CBC@A@AB@BAB@BABAD@BA@A@A@A@A@C@A@C@ADABA@AAA@AB@@ABA@@@C@AAA@AB@@@B@D@@@B@@A@@DA@@@A@@@G@A@@BADADADA@AB@@A@@@@AA@A@@@A@@D@DAB@D@@@BA@@@@BA@A@A@C@@@AB@@BB@@@B@@@BA@@B@B@DBDD@@B@BB@@@@@B@BB@BBBDBB@ADA@A@@@@BADA@@BB@@@@B@@AAC@AAA@@CA@@BA@@D@FAD@F@@@B@BAB@@BB@BC@@@ACBAAABAA@@AA@AA@CE@@@@A@@@@A@A@@@AAA@@@@@AB@BBD@BB@@BA@@BA@A@AB@B@B@@CBAAAAAE@C@CAAACACCEG@I@MAK@GAIAIACAE_RyAC@ECAAA@A@A@CBA@CAG@E@A@E@AAA@AAAA@C@CAA@AA@A@AAA@ABA@AAC@C@A@@@@AA@C@A@GBE@@@AAA@A@CBBBDF@BBDBBBBDBFBBD@@@DEFAB@@BBB@B@B@FB@B@B@@@D@B@@ABAB@@BF@BAB@@CB@B@@@BAF@@@AEGGCDMAB"],encodeOffsets:[[103566,24158]]}},{type:"Feature",id:"530827",properties:{name:"孟连傣族拉祜族佤族自治县",cp:[99.584157,22.329099],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AA@@@@A@@@A@@B@@@@A@@B@@@@@@A@AB@@A@@BA@@B@@@@AB@BA@@@@B@@@@@@AB@@@@A@@@@BA@@@A@@B@@A@@BA@AB@@A@@A@@A@@@@A@@@@AB@@@@@@@B@@BB@@@@@B@@B@@@@@@@@@@@A@@@AB@@@@@BA@@B@@@B@@AB@@@@@B@@AA@@@@AB@@@@A@@@@@@@A@@@@@@@@@@@AB@@@@@@@@AB@@@@@@A@@@@B@@@@@@@B@@A@@@@@A@@B@@@@A@@@AB@@@B@B@@@@A@@BA@A@EB@@A@@@A@@@@@A@A@@B@@@@@@@@BB@@@@@B@@@@@@A@@@A@@@A@@@@B@@@@@@@@A@AB@@@@@B@@A@@@@@A@@@A@@@@AA@@@@@A@@@A@@@AB@@AB@@@@@@A@@@@@@B@@@@A@@B@@A@@@@B@B@@@@@B@@A@@@@@@B@@@@@@@@A@@B@@@@@@@@@@A@@B@@@@A@@@@@@@AB@@@@@BA@@@@B@@A@@@@@A@@BA@@B@@B@BBBB@@@D@BDBD@FCJBFB@@FABAB@@CB@F@D@HBD@JDDBDBBB@@BBBBABABGAEEC@A@@BADAB@DA@EAAAA@CDGDCB@B@@BBB@FABBAD@BCDCD@B@BF@BBBBDFDFFBBBBBBBBB@F@D@B@DB@@@BADC@AB@B@@B@BAD@B@DAB@BDBBBBBBB@BBBB@FA@BBFAD@DDHDDDBF@B@D@F@HB@BFFBDDBB@BDDDBBBFBDAD@BAD@BADAFAD@B@B@BBD@B@F@BBB@DBB@BBB@BB@@B@D@D@D@BADADAB@FBBDBB@@B@B@B@BBBDFBBBDFDDBBDBBBD@@DB@BBB@BBH@BBF@DFFFFDDBBFDBDFBD@D@BBD@BB@B@B@D@D@D@DBFADAD@@ABEDCDABCFCDCBCDCBCBEBCD@@ADADA@@BAB@D@B@BBB@BBBDBB@FBDBDBD@D@BBF@B@F@@@BBD@DBFBBBB@BBBBBBBBB@DBBBDBBDDBD@@BB@BBBBDDBDBDBBBDDDBB@DBBAB@B@B@@BDDDBDB@FBFBBBD@B@B@DB@BFBBBB@@@DBBBBB@BBB@@@B@@DDBB@BDBDB@@@@DDBDDDBFBDB@HFFDHDB@@FBBBD@BBDBBFDBBB@B@HDFB@BB@@@BD@@@@@@BAHGBAJEDAFELM@@DAB@FBFBD@HDNF@@D@BAFEFCDCBAF@B@FDNDBBB@@@DBBBD@BADEBAFEDAFAFAD@D@BC@EBC@@@EBABCD@DEBADAD@N@HBHCF@DCHGJCJA|@F@RBJ@J@JDR`FPHJNLFFFDPBNBNFD@LFPHBBLFJHJLDJ@L@JBHJHLBREJGPOHIDE@@ACAE@@FEHAJADBDDDF@T@J@DDBH@HCLABA@CDGBC@GCICGCEGCICAC@CBG@CBCAC@EBG@KDIAG@E@ABAD@F@JDD@FAFG@CAAEC@A@@@AJCB@FBF@F@FE@@DD@JB@F@BABAAEAE@QBCFC@@AIIECC@C@A@CAACCA@A@A@AAEAGAG@C@A@CACC@ECCECCAA@EEMGEAY@AAA@AA@@@CDAHAJCHCBA@ECC@AA@GGAAAE@EBKDOBQBKBIBGCOCKAMFMFGJIFKDK@KEOIIM@KFKBQPGAG@CBE@C@KOCAC@ABAB@B@BB@HB@D@DABEBA@C@CE@ICACEGMA@@AAA@ADANIDCHI@EEICEAE@A@ADELEBAEGBCBEFC@CAAUKA@@C@C@GAACAICCAAC@CFKHQDIJOHMEMAMAUAGGUKD@@@@@@A@@@AA@@@@@@A@@AA@@@A@C@@@A@AA@@A@@@@@A@A@A@@@CAAAA@@A@@@@@@@@@@@A@@@@@A@@@@C@@A@@@@@@@@@@@A@@@@A@AB@@A@@@@@@@@@@@A@@@C@A@A@A@@@@@A@@@@@@@@@A@A@@@@@A@@B@BA@@B@@@@@@@@AB@@@@@@@B@@@@@@@@AB@B@@@@@@@B@@@@A@@@@@@B@@@@@@A@@@@@@@A@@@@@A@@@@@@B@@@@@B@@@@A@@@@@A@@@@@@@@BA@@@@B@BA@@@@B@@@@@@AB@@@@A@@@@@AB@@@@A@@@AB@B@@A@A@@@A@@@@@A@@@ACBC@AAAA@AACAE@@@ECACAAAA@@@A@A@AAAACABQ\\GJO@OEMEKIQGSBQPUTKPGHEBWDOIKMSKYGQAK@KCKESGQAOBO@UHGIKGQDQBS@gHI@AA@@@@A@@A@@@@A@@B@@@B@@@@@@@@ABA@@@@@@@@@BB@@@@@@@@@B@@A@@@A@@@@@@@@B@@AB@@@@@BA@@B@@@@@@@B@@@@AA@@@A@@A@@@@@@@@@@B@B@@@BA@@@@@@@@@@AA@@@@@A@@@@@A@@A@@A@@@A@@@@@AA@@A@@@@@AA@@@@@@A@@@@@A@@@AA@@@@A@@@@@A@@@@@@@A@@AA@@@@A@@@@@@A@AAGEE@IBGHGNSHI@IC@@@B@@A@@@@@A@@@@@A@@@@@A@A@ABA@A@A@@@@@@@A@@@@@AAAA@@A@@@A@@@@@AA@@AA"],encodeOffsets:[[101603,22643]]}},{type:"Feature",id:"530828",properties:{name:"澜沧拉祜族自治县",cp:[99.931975,22.555904],childNum:1},geometry:{type:"Polygon",coordinates:["@@i}WQgUcDGK@G@ACMEcGOCECAECCA@CAA@C@EAEA@AB@@QFSCWDQFM@MBIDMDWFO@MBSAKGAIDMPYAIGGaISBQDGLKTSJQDQDSHODQJOJSDUAQCKCMGAQGQGMOBSFGJBLHbCJEFM@OAINQN[ZHVBHBVBNFNGNIPCJGREL@DBDDBJDDBBB@H@D@DB@VLBB@DEDAFADFHABKFCF@B@BBFDFFJ@FGJCDMJCB@BBB@BB@HNDFDB@JDFD@B@FABA@C@CGAA@@A@ABABAD@DBLPD@F@DAH@HBROLALEN@JJFP@LCLELIJEHENBNDLDPAHAJALARCPAL@FBFBBHHB@@BDD@FABGDIDGBCB@D@@BBB@BBZ@FBNHFFB@DBFDDD@FDDDBB@D@H@HBFBBBB@B@B@DDBB@D@B@DDDJFBJ@@EDAD@RBFBFABABE@A@@ICC@@EFE@E@EAA@ID@B@@@BFDBB@DEHEBC@ICE@C@AB@B@FBHCJ@LAH@FBDAD@DAH@DBDJDHDDFDHDJ@HADCH@DABKBGDG@CA@C@I@SCECCCAIBGBEF@@BFBD@@CFGJOPIHQFKAIGAG@I@KCIIKIGKEAAOGKEC@MEMAOAECEEMKGIEOQ_ICI@I@QAE@{@IBIDGHCDE@GDCB@DBBBD@DBHBBDDABABCB@DDBJHBB@DABAFAFBBBBBFDBHFJH@L@JRV@BNDDDXDFLDBJBDBDHHFFDDDDJ@H@BBFDDDBDD@HAJDHBBDBD@DAD@@IAGH@H@HBD@HCJEJGHIBGD@DBBD@X@FDDFBDBLFHFF@DBJDDBDFDDDFBDBDDDPBHDJFNLFHHHFDNJHFJJHPDXOTEDOLKFKJEJEJCLCNAL@LC@GJEBWASBMBS@@@A@BJAJBNCBAB@BDFBDB@DBRA@BD@BBDBDBB@@FADAD@@CDADCJCHCDCDGBIBIB@FAD@F@FAFBBB@J@D@FDLBNCLCLGB@HDHBJBJDFD@BDDDBBFFJBBDFBD@DABCHCJAFCFCHCH@FBHABEDMBAAO[QKE@E@EFCREFGDEBGDAFEP@bDLFJTPHLA^CFCBECGEC@IACAK@ECEEEECAA@EBEDIBK@[D@@E@GBEBOHNTDRAJERS\\GLQDEBARKRGRIXANDLDLFRDRDJDL@J@HAHANQJIDG\\ALALIRDFHJNFNBVFHDFH@@AD@J@BA@BBHJFDFFDBD@DDB@JHBBFBBBBBBBDBBBPJFDFBFBHB@BFAB@FEDAFAH@FADADCBABC@ABC@A@@@A@E@AD@DAB@BJBBB@D@LCD@BCD@BAD@BB@@@@@B@@@@ABA@@ABA@@A@GH@DADAB@BBB@BAB@BADCBA@@BA@@BCDABC@@DBB@DABABA@A@CB@@@B@D@FABABC@ABVRDDBDBB@@BDLJVRTPLLDNHVNAWxI`KLChMpcPDVRrJbbB@trhBdEHA`E^U^UCvQ^B@MNU@@FGvSx_Z[Zq@C@C@_BCJiLmFMJCBAHA`YXANAPaBE`ejQJ@fPFB@@DB@CDMBO@G@C@KBABQBA@MBABKLMB@B@RDLN@@@DD@JBB@PG@CSmAA@Q@KBCH[DE\\cLODK@ABCAEAEGG@E@CDADAH@F@BCDGBIBEJIDGDMBGBCL@HEDC@CBGDGFMFEFCBE@GBE@CBC@KAKCQ@EDI@C@CBABCDI@YCSAE@EEG@A@CAQBKDGFCXEFAHCPGJEPKNMFGBCBCBABEDGDCFEDABADEDAHMDEFGDGDCBABGHEDCFCFCDALALCFAD@B@BADCBABCBEDCFCJEFAJMBAHCJCHAFCDADCDALALADAJADCFMBABABCBGDEBCBCFCDGDIBGAMBCFADABIBIEIEAC@KDE@EC@CBADCHAFCBC@CFGFG@EC@ABKAABC@A@@@AAA@CAC@GBEDC@G@EACAEBMBABABA@CAECIA@@@@IAI@GAG@A@I@M@G@KCEGCACBEDKCGKGAECAEIA@A@CBCA@B@@GCEKAG@ELEJEBIDI@GCCIEGCGEICMCEEC@@A@A@CAECCCG@EAAAEIAEBEHI@ACCCACCA@CCC@CAJANALAFABE@ICMEWBAB@BC@CCC@E@CFIKCACBGBGAI@ECGEEAE@EDE@MGECGBEBGAKGMGCCAMEGKGGEACCKDULGFMBMJOBOFE@cR@DBD@@C@CBCDGJEHEBKAE@G@KFCBI@CBGBKEIAKBIDI@MI@AAE@OBEPUAGCEEA@@K@G@K@EEAECIEGICMAW@CACEGAABGBI@A@@@@@ABA@GDSBQRMBEEG@AC@A@I@GAEG@GEAEBICGE@I@CCHIBCDCDEAACACCIiCMES"],encodeOffsets:[[102469,22693]]}},{type:"Feature",id:"530829",properties:{name:"西盟佤族自治县",cp:[99.590123,22.644508],childNum:1},geometry:{type:"Polygon",coordinates:["@@@GCICCECGECGCAIACAEKWCCCMC@AQU@I@KIGGECAAEAAAABEBEBA@CAAIGCA@CDABABACCAAAG@CACAA@CDAGAM@C@CBABCFC@ADAB@F@@AD@FADC@C@EBEBCBEFABCFABC@AACA@@A@AAMCECA@E@ABCDEDEFABC@@@MEGCC@EAEAA@CB@@KNEFCBIFABGHAB@@B@@B@@@B@B@B@B@D@BAFADABA@EDEBGDA@ABAB@DA@ABCD@@AD@@AB@@@BAD@B@BBB@B@B@B@D@D@D@@BB@BBBBBBBBBBBBBBD@BBB@DBB@BBBBB@BB@BBDFBDD@@BBD@BDD@BBBDBBB@BB@@B@DADAF@B@BA@BB@B@B@B@B@BABABCDCFCDAB@DABCBAB@B@@@@@DBBBBBB@DABABEBAB@@@B@D@D@BAB@@AB@D@D@DBDA@@@A@A@@B@D@DAB@D@B@B@@@B@B@B@BCBAD@BBBAD@@AB@B@B@B@D@B@BB@B@AD@@BD@BA@ABA@@BABCB@B@BA@CAAB@B@D@B@@A@AAAB@@@@ABBD@D@BA@AD@D@B@D@DADADAF@D@@CDAB@D@@@FAB@F@@AD@BA@@@@@@BA@@@@@AB@@@@A@@@AB@@A@@@@@A@@@A@@@AB@@@@@@@@A@@B@@@B@@@@@B@@A@@@AB@@@@@BA@@B@@@B@BA@@B@@@@@B@@@@@@ABA@@@A@@@AB@@@@@@AB@@@@A@@B@@@B@@A@@B@@A@@B@@@@A@@B@@@@@B@@@@AB@B@@@@@B@@@BA@@@@B@@@@@@@B@@@@B@@@@@B@@BB@@@@@@@B@@@@@B@@@@@B@@@B@@@@B@@@@@@@@BB@@@@@@BB@@@@BB@@@@@@@B@B@@@@@@@BB@@@@B@@@@@@@BA@@@@@@@@BB@@@@@@@@@@BB@@B@@@@@@@B@@@@@@@@@@B@@@@@@@B@@A@@B@@@@@@@@@B@@@@@B@@@@A@@@@B@@B@@@@@@B@@@@@@@@B@@@@B@@@@@@@@@@AB@@@@B@@@@@@B@@@@@@@@@B@@B@@@@@@@@B@@@@@@@@A@@B@@@@@@@@@@B@@@@B@@@@@@@@@B@@@@A@@@B@@@@B@@@@@@@@B@@@@@@@@@@@@@@B@A@@@@B@@A@@B@@@@@@@@@@@@@@B@@@@@@@@@B@@@@B@@@@@@@@@@B@@@@@@@@@@B@@@@@@@B@@@@@@B@@@@AB@@@@@@@B@@A@@@@@@@@B@@BB@@@B@@@B@@BB@@@@@B@@@@@@A@@@@@@@@BA@@B@@@B@BB@@@@B@@@@@@@B@@@@@@@@@@B@@B@@@@@@@@@@A@@@@BB@@@@@@@@@@B@@B@@@@@@@B@@B@@B@@@@B@@@@@@@@@@@@@@@B@@@@@@B@@@@BB@@@@@@BB@@@@B@@@@@@@@@@@B@@@B@@A@@B@B@@@B@@@@@@@B@@@@@@@B@@@B@@@@@BA@@B@@@@@B@@@@@B@@@BB@AB@@@@@B@@@B@@@B@@@@@B@@@B@@@@B@B@@BB@@@B@@@B@B@@BB@@@B@@@B@B@@@B@B@@@@@B@@@@B@@B@@@B@@@@BB@@@B@@@@@@@B@@A@@B@@@@AB@@@@@@@B@@AB@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@B@@@@@@@B@@B@@@@@@B@@B@@BA@B@@B@@@@BB@@@@@B@@@@@BB@@@@B@@@@@@@B@@@@@B@B@@@@@@@BB@@@@@@@@@AB@@@@@@@@BB@@@@@@@B@@@B@@@@@B@@@@@@@@@B@@@@@B@@@@@@AB@B@@EDRH@B@B@@@@@@@B@@@@@B@@@BB@@@@@@B@@@@@@B@@@@@@@@B@@@@@@@@@@@B@@@@B@@@@B@@@@@@BB@@@@@@B@@B@@B@@@@@B@@@@@@@@@@@B@A@@BB@@@@@@@@@@@@A@@B@@@B@@@B@@@@@@@@AB@@@@@B@@@@@B@@@@@B@@@@@@@B@@@@@B@@@B@@@@@@@@@BB@@B@@@@@@@B@@@@A@@@@@@@A@@B@@@@@@@@B@@@@@@@@@@B@@A@@@@@@@A@@B@@@@@@@@B@@@@@@@@@@A@@B@@@@@B@@@@BB@@@@@@@B@@@@B@@B@@BB@@@@@B@@B@@B@@@BB@@@@@@B@@B@@@@@@@B@@@B@@@B@B@@@@@B@@@@BB@@@@@@@BB@@@@BB@@@@@BB@@@@@B@@@@@@B@@BB@@@@@B@@@@BB@@@@B@@@@BB@@@@@B@@@@BB@@@B@@@@@B@@@@@@@B@@@@@B@@@B@@@@@B@@@@@@@B@@BB@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@@@@B@@B@@@@@@@@@B@@@@@@@B@@@B@@@@@@@B@@@@A@@B@@@B@@@B@@@B@@@B@A@@@@B@@@@@B@@@B@B@@@@A@@B@@A@@@@BA@@@@@@B@@@@@@@B@@A@@B@@@@@B@@A@@@AB@@A@@@AB@@@@A@@@@@@B@@@@@@A@@@@@@B@@@@@B@@@@@@@@@BA@@@@B@@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@B@@@@@@@B@@@B@@A@@@@@BB@@@@@@@@@@@@AB@@@@@@@B@@@@@@A@B@@B@@@@A@@@@B@@B@@B@@A@@B@@B@@B@@@B@@@@AB@@@@@@A@@B@@@@@B@@@@@@AB@@@@@B@@A@@B@@AB@@@@@B@@@@AB@@@@A@@@@B@B@@@@@B@@@B@@@@@B@@A@@B@@@B@@@B@@@@@B@B@@@B@@@B@@@B@@A@@B@@@@@B@@A@@B@@@@A@@B@@@@@@@B@@A@@@@@BB@@@B@@@@@@@B@@@B@@@@@B@@A@@B@@A@@@@B@@@@@B@@@@AB@@@@@@A@@@@B@@@B@@@@@@@B@@@@@@@B@@@@B@@@@B@@B@@@@@@B@@B@@@@B@@@B@@@@@@@@@B@@@@@B@@@@@@@B@@@@@@@@@BA@@@@@@B@@A@@@@@BB@@@@@B@@A@@@@BB@@@@B@@@@@B@@@@@@@@BB@@@@@@@B@@@@@@@@BB@@@@B@@B@@B@@@@@@@@B@@HDLAHAXMPGRKHGNGVK^GVCHBDBD@^P@@PGFAHAF@@@\\CL@JAFCFAB@DBFFFFFDL@DBJBD@HFFDDADEB]GKSOEICK@aFOBEHCFAHCFEDQFEF@F@RLP\\BBNAFCBAAG@EDGDGDEBEDIDGBA@CACCEAAEIAECACC@AECICIAGAGCA@KHKDMDKAECC@I@A@AABE@E@EBC@EJAJAHADCDCDGDIBCDC@@BCBC@EA@CACAAAC@@AQBCAA@ACCE@ABADAAMBIAIB@@@T@NATAXBFAHID@@KBKDMDKFIFILILEPKFCPSCWGOIIGEMIECGGEGMKIEGCOACCACACCECCCECAICCAE@GEKECAEACC@E@WACCAC@AHGJIHIFGDC@GAG@G@BH@JC@CBC@CAAACGBI@GCCCACCAE@A"],encodeOffsets:[[101961,23095]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("曲靖",{type:"FeatureCollection",features:[{type:"Feature",id:"530302",properties:{name:"麒麟区",cp:[103.80474,25.495326],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@A@E@K@IAIBGBC@CCCEAEC@ABABAB@ACA@A@@ABABBB@@@B@B@B@B@BA@AB@BB@B@@@@@BBABBB@BDB@BB@@B@B@@A@AB@@@B@@D@@BBBA@@@A@AB@@@@@BB@B@B@DBBABB@@@BA@@B@BB@@BB@@@@@@B@@@B@@@@BB@@DD@B@@ABABA@CDAB@@C@C@CCEAEACAEAEACAEAAAEAAAAA@@EAIDKFIFODIHIJGJQJEBABA@@@@@ABC@ABIDC@C@EAKWE@I@E@GE@@AA@E@GHIBG@@@AAAA@CAAAA@EFCLERGLOFEBG@ABEBK@M@A@CBABAD@@@J@JEFCDABCDCBCDCDCDADCDAB@D@D@F@F@DBDBD@BAB@D@@B@B@B@FDFPBPBJADBB@D@D@B@B@BAB@@@BADADCDAB@D@@A@@BA@@@ABA@A@C@C@@@QFMNILCFGBG@KCMKGCEDADEFGDCBAB@BA@DF@D@@@D@FCFAD@HDR@B@@@@@@@@@@@BGDIBEJCFADGLCBAD@DBHFL@@@DB@@@BB@BABA@A@@@C@@@G@EGEGC@KJKRAH@FBDFHDBHGHIHCFBDF@F@DEH@@GLCDADBDFD@@@@JHFJAJEJGDA@O@GCEKOMC@I@A@MFMJKHCDED@B@@BB@BBD@@@B@BA@@B@B@B@BBDBBBD@@DDDH@B@BB@BBB@@@BB@D@DBBBFFH@B@@@@GCCD@@CDFBBAFBBA@DAB@BA@C@@@C@@@A@AFBB@DFBF@BBA@@BABLF@DA@CFFDBCF@@A@AD@@CB@HCB@DBJL@B@DFD@BABABADB@B@@B@DEBABABGBGF@B@DDJHP@H@H@@ADABAB@BABAHFJBJCBADKDK@G@E@AB@@CBAD@DBDDPDHHFBB@@@@D@JJBDBB@FAH@JB@@BDBJBDDBB@B@B@BC@ABA@@@ABA@@@AB@BBHD@B@B@HGB@DCF@D@BDD@DAJGFEFAB@FA@@BA@@BA@AAA@ACAGGACACBCJEFE@AACAEBA@AJAF@B@@@BBHD@@FFB@H@H@F@LDF@D@HCDCFAFAFABCBK@@HAB@P@FCHBHD@BHBADFBB@D@@BJA@@B@@@B@DA@@B@@A@@B@B@@M@C@@@CL@@@B@B@D@D@@EACFC@AB@BA@ADABAFAZID@B@D@DDBB@@BBFDLJXDVJF@LFB@JDDB@BB@@B@B@@@D@F@FAHB@@HBDJHFJHLNHBBFHBBB@D@B@BA@A@@@EBMLWDIF@H@H@LEPGNIZ]LMBM@KAMDIHIFADA@@BAB@@A@@@@BCBA@@@@@AAB@@A@@@@@A@GDKDG@CC@@@@GIAICA@C@CBAAAECAAACDI@C@@AAEAA@AA@CBAB@DBBBBA@CBAB@BA@AAAEAC@A@AC@CCAEB@D@BCAA@@A@CBCCCAAA@BAB@DBJ@B@@AAAAEDC@@@@@@BAB@B@D@B@B@B@@A@@D@B@B@B@@@A@@A@@B@B@BBB@B@BB@@@BB@@@@@@B@@@@@BB@A@@BADB@BABBF@BBB@B@BAB@@@@A@@AA@@@AB@BA@@AC@ABAD@DAD@F@DBDBBDDBFAD@BA@E@GACEQ@@@@A@EM@ABAB@DABAACCGCGACAA@ABG@C@G@C@@BAFEDEBCAAC@A@A@A@C@AA@@@@AAGAKACACAIIAABA@ADEHIHGFABA@AAAEAKACAAC@CDAFABBB@B@LDB@D@H@F@DAFEFAJAH@HC@C@C@@EBC@A@@CAEIKCI@CAEBADCBG@A@@@ABCB@B@D@D@BCDCD@BBBBB@BBBD@DBF@BBFBFBHFHNHBBBFBB@@B@P@J@F@B@@@@B@B@B@B@BF@@@B@DBD@DBHBDB@CBABAA@ACBAD@D@@@B@B@D@B@BCBEBCHA@@@@@@FCDBBDDFBFDFFBBB@@@@B@B@@@@@BB@@@@@AB@@@@@@A@@@@@A@@@@@@@A@@@@@@@@AA@@@@DIBGJAFAFBDADEDCB@D@BBADBBDABCB@B@D@B@@BBB@@@F@L@BBBDD@@BBF@L@TAHCDENCTENA@@BC@@@A@@@AAAC@AAABCA@ABC@E@CBC@CDCD@DABA@AAAAAACACC@C@AAEAACAECCAA@ACAA@@@A@@@AAAC@ABECCGGGCGAE@C@C@C@AAAE@CCAC@C@CAAGBA@A@C@@@A@EBE@@AC@CBEBABCBA@@A@C@A@@AAA@AA@AB@A@CAAC@ABE@C@ECCBEBEBCB@BA@A@A@@@@@CAC@AAA@CA@AAAAC@A@@@@BE@GDGFCBA@AAA@@EAKEA@ECECCCCECC@C@AA@A@A@@AAC@@A@ADADABEB@@A@C@EAEAIGC@A@C@ADCF@B@@A@A@AA@A@CAI@AAACA@@@@GCCA@A@A@K@AACASAGBA@@DGJEFAFABE@C@A@AA@AA@E@@A@A@ABAD@BEDABA@C@AAC@A@C@A@CAA@CCCCACAEBEDCDCDA@EAC@ACAACA@E@EBADAFABC@@@@CAAA@A@ADC@C@C@ABC@C@@BE@C@CB@D@BA@A@"],encodeOffsets:[[106520,25731]]}},{type:"Feature",id:"530321",properties:{name:"马龙县",cp:[103.578478,25.42805],childNum:1},geometry:{type:"Polygon",coordinates:["@@CACEAEGAGC@C@@AA@A@EAG@C@CCCAABADABABA@@@@@@AAC@@A@@@A@@BCBA@AACA@A@IFA@A@@@@AA@@@@C@CA@ABEDEHABCHGDEDCBCAC@@AAACBI@A@ABAAA@ACAB@@A@@BO@@@@@ABKDEBCAG@EEA@AAAA@E@C@EBECGBCAC@A@@AC@AC@GHIDGDEF@HDHDDBD@BAD@DAL@FCFCBA@A@CAA@CBABABCDCBE@C@@B@@@@@B@@@B@@@@AB@@@@@@@B@@@B@@@@@B@@@@B@BB@@BB@@@@BB@@@@@B@@@@BBB@@@B@@@BBB@@@@B@@@@AF@@@B@@@@@@@@@@BB@@@@@@@@@@@BA@@@@B@@B@@@@@BB@@@@@@B@GDC@CBEB@ACAAAABCBAD@@CBEB@@@@@@A@@@@@@@@A@@A@@@@@A@AB@@@@@@@BA@@@@@@@ABA@A@@@@@A@@@@@@@A@@@A@@@AB@@@B@@@@A@@@A@@@@@@@A@@@@@A@@@A@@@@@A@@@@@@@AB@DBHBD@BBDBDBFDDBDBDBB@D@DBDBDBBBFBD@DBFF@D@D@D@BBBDD@F@F@BABBB@@B@@AHCD@BAB@BAB@BA@AB@@@FBFDHD@B@F@FDFFFRBJ@B@DCBAB@BBD@D@@B@@@B@BAF@B@DDDBDLDF@@@BADEBEBI@E@EAECC@EBEDKLBHABAFCBE@C@CCA@E@BD@DDF@FBDBD@B@DBDAB@B@BBDFDHBBFBF@@DDBDBBBDB@@D@B@B@D@DBB@@BBDBBBBBBB@DAD@BBDBBDDBBBBFDDBHDLFFBDDFBDBDDD@FDBBD@BBD@@BB@BBB@BDBBBBBB@B@B@D@D@BDBDBBBDBDD@BDBBBB@D@BBBBBBDBBBDB@DCFADCD@BCBABABABBB@D@B@BCBCB@B@@DBB@B@BB@@@D@DAD@B@@@@@B@@B@BAB@@@BB@@BBBD@DBBBBB@@A@E@AB@D@DBDBBDBBDBDAFABAD@B@B@DIDAD@FBDJDDF@HAHAFEFCFBBBBD@HAD@DHDBD@F@HHH@@@D@D@BCBCDCBADAB@@CBGDAJBHNNLPBFAFEFCJEJEDEDADDHFHF@J@HCJMBAHIHEH@F@LCFGJ@HAHAJ@H@@@BBD@DBHDDBDDD@D@BB@BB@DB@@DDDDDFDDDBB@F@DCH@H@F@D@@@@@@BAF@BBBDFFDHBB@D@@@@@BABAD@LBHDB@B@@@@A@C@E@@@AD@DBB@B@BA@@DAD@B@DBBBBBBABADABAD@DFBBBBF@@BBBB@BFBHBJBHDHFBFDHBF@F@DA@CBAF@D@@@@@@@B@DCDGFAD@B@DDBDDBFBDBD@BC@C@AB@B@BBBD@BD@DADAD@DBDDFFBBB@DBB@D@B@D@H@F@DBB@BABAAG@ABA@@B@BA@@B@BAD@@A@A@AAACCIACA@AA@@IBG@EAAACIIC@@@@@AAGECGCOAC@CBCDA@@BAF@H@L@LCBCDAAIEIBGBA@ABABABC@@@G@GGOCI@C@AHEHABABAFA@C@AA@A@BCBABA@AEC@C@AIKCAA@GDA@@DC@@B@BE@ADECDEB@@CKEBA@AB@AAE@EA@CAABEB@@@D@@@D@B@@ABA@CABEAABEADC@@DCHD@@@@@AEGAEAA@C@CAA@@A@AAA@@A@ACGCC@@ACAAAC@A@A@A@AB@@A@A@@AC@AAA@@@AFCDCLGNINEB@J@D@PNFLHDP@B@HCFIBIEIIG@@@@ECACBCDCHK@@FG@C@ECEEAGDGJGHCAEGAC@EBGLQLID@FHFHH@@@D@@@B@B@BA@AAA@@A@@C@@EKAG@CBCDAHKBCDEFIJAHC@A@@@@@@@@@@@ACQ@GBCDE@E@C@@@CCEECKI@@ACEGCGAA@AB@B@FABAEEGGKMEEIGIKA@GAAAC@C@@AAAC@AACBA@A@A@CAAAAACEACCECACAAACAAAA@A@C@C@AAA@@@AAAAAACAEAA@@@A@@B@N@DABADCDEAA@AABECGCAG@G@AAAE@O@AECECCCECEACCEAEAA@ABGAEAAAAAAAAC@EACACCCA@CEECAAC@AAC@ABC@C@CBA@ABADABABCDABEDABEDC@CBA@CAC@EAA@A@C@ABABA@A@@@A@AAAACCACAAAA@@@A@A@@AA@E@C@CACACAACAEBA@ABA@@@CFADABCBA@ABA@AB@B@D@B@BABABCBA@ALE@EFA@C@C@A@EEGGKMGK@A@CGCCA@BABADAF@B@D@@A@AAA@CCA@EB@BABAAC@C@CBE@ADADADE@GA@EA@ABC@CDEBA@ABCDG@"],encodeOffsets:[[105902,25796]]}},{type:"Feature",id:"530323",properties:{name:"师宗县",cp:[103.985321,24.822233],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BABABAB@B@@@BABABA@A@A@@B@@ABABBB@D@D@@@BA@@B@BBB@BBB@B@B@DB@@@@B@@BBBB@@A@C@C@ACACAGECAAE@CAAAAAAAC@AAACAAAC@@ABAAAAA@ACAEAAGAGACEEGCG@EACCCA@@CEGEEAI@MDEAEC@CBEDCFABC@E@EE@C@E@KECC@CBAHGHE@@HCJ@B@@A@CEAGECE@EBCDEFABC@AAAAE@CFAFAF@HFJBHDHDFBFA@CBEACBCBE@E@ADBDBFFDDFBBBBDB@BCD@D@B@@D@DBBDB@B@B@B@BD@DCFBJFRDPAPAF@FADCFC@CFCD@BA@@@@@AB@@A@@@@@CAC@AAEB@@@F@@AAEBC@GBCD@FA@@DBBD@FB@BADCDCBA@ACBC@@CBCDCHADAFEDGDEFCFADBF@H@JCFAFCDGDA@ADAFCF@H@DAFCFIDEFCDCB@@EBEFADA@ABA@EBC@AAC@ADCBCCECIAIBEFCDBFBDABE@CBCBAHAFBH@D@@CAE@EDEAE@EDCHEDCFCFBDDDFDBB@DEFAHCBCDCDAF@BAAACCBADADCBGCGAEBGBC@@ACAGA@AAEE@@ACAAAA@AACAA@C@@AA@A@CBC@A@E@C@C@A@@@E@G@G@IBGD@@EAECC@IAECE@EAE@AFCHAJ@NDNFDDFAJAJ@HAH@FDJBH@HDFFD@H@FBBBABCDBFCB@DBBDAFGFCDADCJCLGDAB@J@LHXTFJBBDBD@@@AA@C@CBC@CB@@CBCBAAC@A@@BAB@D@@AB@BA@ABAD@B@B@BBBB@DB@@@B@@ABA@ABADCBABA@@BEBADAD@DA@@BABA@A@CAC@CAA@A@AC@C@C@AC@A@@@A@ADADC@@BCAABCBC@C@AAA@ABADABA@@BC@@AAB@BEBABABABA@AFADAB@D@B@DA@AE@ACEEEQCECCKAI@I@ODEBCAAC@EBEDGDGAE@AE@CBCBE@E@ABCBCBEBCBE@EAE@GAE@E@E@EAGAEAEAA@ABAD@BA@C@C@C@CA@@CCCACAEACAC@AAEAC@A@AACACCEAEACAC@E@CAAA@A@CBC@A@C@CCAAAC@C@AA@ABABA@CBA@A@@HIDC@G@GKIG@A@G@K@ICGAG@O@IAKAIDOAIEGC@E@E@EGCK@QCC@ACCCCACACACBEBC@C@EAAAGGCEE@GDIDKNEDIHIDI@GAEGAECA@BABC@AAC@ACAAAAA@CBADCBCDC@CBEAG@C@@DADA@CAEAAAACACC@ABCBADCD@BBD@D@D@D@F@@JHFBDDRX@D@DCFEFGJCHCDGDGFGNMXEFEFCDEF@BC@ABAB@BADEBA@EACACAC@A@G@_KI@AF@FGDEBAAAACCCAAAC@AAA@EAA@EAC@EACAEACAA@CCEACCEACAA@A@C@CACAE@@AC@CAEAECEACACACAECCAA@AAE@E@C@C@ABABADADADADA@ABCBCBADAB@@ADADABC@A@CBEBA@EDCBEBCBC@BFBDBDD@FCF@@HGLGDGDCJBFAF@B@@@@@DBBBBB@B@DABDBDB@@BDBF@DDFDFDH@FADB@HAFBDFBDDA@ABC@BBBBBD@DADAFADBHAFCBE@ECEBCDAD@@@D@HGJCJKPIJGBCCCEABAB@B@DAB@DEDCHEFCJAH@DC@GAGAEA@F@F@BCF@DBHDHDH@BFJDHLDJFBDBDAFCFC@EB@FBD@DEBE@EDBHDH@HAFEFIFGBIAIGCIEM@GCACAEBIB@@OJIFCD@FDDHBBB@J@DCFGFSJIB@@G@EAIGIAKBQHIJGHCB@@CDABC@GFIDEAGACB@DFHFFBDA@CAGEEAE@G@CBCHAB@BA@@DEHEHAHEBCBC@CBCB@DCDABCBBD@DAAE@C@ABECEEICCD@FEBC@@DBD@BECAAIIGI@@AAGKAK@@@K@EC@EACCBECAE@IBGDKDMJKJA@AD@@IDCBABC@C@@DDHDFBF@DDHFFDHD@D@DGFEB@@H@HDDFBFFDLBFDRADABE@ECQOGE@@G@GDGBG@G@K@ABADCB@B@DBBBBB@D@@AD@F@DBDBAB@B@B@BB@BAB@BBBBADABABABBB@BD@B@DAB@@A@C@AD@D@BBBBBB@D@DAF@DAD@DDBDBFBDDB@BABA@C@AF@FB@@BHHHDBJDJDLHHF@BBFFJDFBDEDGJADADBBDDHDH@LDNFLJBBFFBHADADEHCNAJ@FBBFDFDTJNHHFJHLLNRFHBF@@@@@@FHBDDBDAB@DBFFBBFDH@FCF@@@@@B@DDHHDNJJJLHPHJDFHFHHFHFFLBHBJDLBJDFDFDFD@DA@BB@BBDBBDDBDDDDDBDDBB@DBBBDBDDD@@BDAB@BCBAD@BAD@DABADC@@@@@@DCBCBADCBADAD@BAFAD@D@D@D@B@B@DAD@BA@@@@@@B@BAFCDADADE@EBADBDBD@DAFAD@F@JDHD@@B@@@B@B@FABABA@CCG@EDEZGTGDEDCHBJDJ@DCHKRGD@LA@@@@JAJ@J@@@DBD@@DDDDDDBBF@D@DBDPFRHJDDBB@BB@@B@@@H@D@FCFCBCBCBE@GCECC@C@CBABA@CCG@EBG@CEKCGAEAG@EBABA@ABABA@EMQ@C"],encodeOffsets:[[106677,25498]]}},{type:"Feature",id:"530322",properties:{name:"陆良县",cp:[103.666663,25.030051],childNum:1},geometry:{type:"Polygon",coordinates:["@@CACA@CBC@CBE@C@CAAAAAAC@C@@B@D@BA@CBA@C@@AAABABABABCAAAAA@ABA@@A@A@ABACACAE@C@@BC@A@AAAA@C@ADABCBAAACCCCCEEG@A@CCAECEAC@@B@DCBABA@G@EACC@@AEAA@AACAAACAAAAAAAACF@@KL@B@BJNFPBDDJBD@DCBEBCDAFAF@BCDABADABADAD@@@@FHJJDF@J@NEPEJGFCHAXDLDTBPBTAHCFEFGFGDKDG@I@GASIGCG@G@CFAH@FAFC@CBI@GAA@@@@@CEAC@@@@A@@AA@@AAGCKAC@CCa@GEAE@GBEJC@CBCBA@ADAB@BABA@@DCDEBC@CAEC@GEU@ACA@AAA@AAC@AAAACAACAA@A@A@CBA@A@@@A@@ABA@ABA@@@AA@A@A@AAA@A@@@@@A@@B@B@@C@C@EBA@C@AAC@A@ABGA@B@DABGFA@@@A@@AA@A@AA@@A@A@@@AB@@BD@@BBBB@B@B@@@BABAB@@CDA@A@AB@FBNAFEBA@@@GDEBEB@@CHCNADADEBIFGDCF@BFP@F@B@F@D@B@B@@BB@B@F@BABABADGDABBD@@BDBA@@D@D@@@D@DBB@@BBBB@B@B@BBBDBDBDBB@BB@B@B@@DAB@B@D@B@BBB@F@FAD@JDDFDDBDBD@BB@@@B@@CD@@@@DD@@@BA@C@C@CBABAB@NAD@BCBBBCD@FEFCB@DBB@BEDEBABA@A@AB@@CDAB@B@F@D@BCBE@EDABCB@B@BDBFB@BAFCDEDEDCBADADEDABA@KFM@EDAD@H@L@JPLLHXFJBD@L@HAHAFBBB@BBBBBDDBBDBBDBDBBDBDBFDBBDBFAF@DABAB@D@DB@BB@FH@J@HCFIJGFGBK@C@CD@@ADADBBADDBBFBF@F@@LFBD@DGHKFIHCD@@BBBDBB@DBDFHCLDBHD@D@BHLLNHHFFB@D@D@B@FEF@BKB@DABABA@A@A@C@ABAB@BAB@DABABCDE@@B@BAB@FADBBBBDBD@D@D@FBB@@@B@B@@BBBBBDDDBBBBB@@@B@B@BABAD@B@B@FBD@DBB@DAD@FCBAFCBADCBABABCBAB@DAD@D@BAD@BBD@BBFDDFB@DDBDBD@FBDBBBBBBFBHBBAB@FBFBDDFBFDDDFDFD@B@PBFBBH@H@DBDHAFBBB@FBDCBCBA@C@M@AB@@@B@FBDBBBBBBB@@B@BBD@D@B@B@BBDBBBDBDBDFBDDFBBBBDBB@B@B@DABBD@BB@BD@D@BBHBB@JLJHFFLNHHFFABEBA@A@@BBBDHFHBD@@LJFDB@@ABADAHCFEBCFCHDNLLDH@HADEJKNMRE@@D@D@B@B@BA@@B@@AB@@@@CBADCBCBC@A@@BA@A@A@A@C@CAABCAIAOEOECA@A@A@@@@CBA@AACAC@C@E@E@C@CBADCBCDCDCDCDADCBADCFE@I@I@@BCBADAB@N@L@FABAH@FAPEHKFQDKFEB@BBDBB@BB@B@@AHGJ@H@FBB@@HFF@J@F@LXFBD@D@JCBAD@BA@@@@B@BAFARIHIJIJGPCJELEJCFB@@BBBBFBBBFBDBFBFBDBFBFBDDD@D@@@BADCB@BABA@@@ACC@@AA@@@@@A@@@A@@@@A@@AA@@A@AB@@A@@AAABCAA@A@A@@A@@@@BAB@@@B@AA@AC@@@@A@@BAB@@@@A@AA@@ACA@AAABAAA@@@@A@A@@ABAB@@A@A@A@A@A@@AABABA@@B@BAB@@@@A@@@@A@AAA@@ADA@AB@BB@@B@B@@@B@BC@@DAB@@@BABC@@@A@A@AB@D@B@B@DB@A@CBC@AB@DAD@@A@A@AAA@ABCBAB@BA@@DBB@B@BA@CC@A@@A@@DAB@@A@A@C@A@AB@B@B@BB@B@B@BBB@B@@DA@A@A@A@AAA@@@ADA@@BAAA@A@ABCBA@ACA@AAAB@D@DAB@@A@AA@@A@A@AB@B@@A@@@A@CCCA@AA@@CA@@AC@A@AA@AAA@@AA@@A@@AA@@AAAAA@@@@AAA@@CAAC@@A@A@A@C@A@CAEECCCEA@A@AAC@A@E@AAC@AACAAAEECACCEAAACC@AC@CCCAAACAA@CAACCCCCACCCAAAC@AAAB@@CECECECICKAICGAKAEEEGGGGECEGIGOIKIICMGGCCA@@@@@E@EDG@ECAAEECAA@CBCAACEG@@@@@@AEEGMQKKIGGEMGSIECECAA@EBIDMFGBCBCAGEEAAKIMEKCG@GCCCAABCBCHIFCACCEEIAE@AGEKGICICCAGGAG@@EAE@@B@DABABA@CCEA"],encodeOffsets:[[106223,25384]]}},{type:"Feature",id:"530303",properties:{name:"沾益区",cp:[103.822324,25.600507],childNum:1},geometry:{type:"Polygon",coordinates:["@@DADAFCDEBCF@F@HEHKJIFGBEIIIUMKCC@EAAAAAC@AAAA@@@AD@DAB@@CBG@KAA@CB@@CBA@CBC@C@CA@@EAA@A@@@@B@@@@ABAD@@@@@BA@AB@@CBEBGJCJBN@LANKNY^MJOHKFG@G@E@CJKXAN@F@@@BABA@C@A@AAEGAAMGGKEIIGAC@GA@BG@E@E@C@@@A@AA@@ACAICA@KEE@UIWCKIECAA@@AACCC@A@C@YJEBABCB@BABA@@BEDBD@FC@C@A@A@@@K@@D@@@D@NA@A@@@@BA@@@CBA@@@A@@@IB@AC@A@EABCGA@AGCGAEDO@A@GB@@ALADEBEBEBCDGDC@E@KCE@G@G@A@EE@@GCAA@@A@E@IB@BABBFBD@BEFIFADBDBDHHDB@BBB@BAB@@AB@@EBA@EBEFIHCBC@ACC@E@CDA@GHA@A@C@ABABA@CAE@G@C@A@C@A@CAA@AAEECCCAC@CBCBC@@AACAAA@A@@B@DADC@CAEACAACCCA@C@EBCHCDA@@@@@@@C@E@AB@DCBE@E@GAECEACGAGAIAGAEA@AA@AE@AAAACEC@ABCBABABAAAACAA@C@CB@@ABA@A@CAC@@B@@@F@D@B@@A@A@GCKAC@ABAB@@@@AB@@ABABABADABAD@DABABABC@A@C@@B@B@BBD@BABABABABABCBABC@AAA@CCCACAAAC@A@CBA@C@ABA@@JAJCBGDEDED@DAD@D@D@BBDDDBD@D@BABADAD@DBDBFBBDBBBFBBB@@DBFFBDBFBBDD@BBHBBDBBBBBBD@DAD@BCDCDABB@@BD@@B@B@DABCBAB@BB@BBBB@BDDFBFBH@BB@DABAFFHHD@D@@@B@@@B@@AB@@AB@BABA@@B@@AB@@AD@B@@A@@@ABABCB@@A@ABCBAB@BAB@B@@@BAB@B@@@B@BAB@@@BB@@BBB@@B@BB@@@BB@@B@@@B@@@B@@@B@@BB@@B@@@B@B@@@B@B@BB@BB@DD@B@@BB@BB@BBDD@@B@@BB@@@B@B@B@B@D@@@B@B@@@B@B@@B@@B@@B@B@@@@@B@@B@@@@BB@@BB@@BB@@@BB@@@@@@BB@@B@@BB@@BBBB@BB@@BB@@@BB@@B@B@B@BAB@@@B@@AB@@@@AB@@@@AB@BAB@@AB@@CDAB@@ABABCBA@ABA@@BAB@@@B@B@@@BB@@BB@B@BB@@DDBB@B@B@B@B@BABABADA@@BA@ABA@A@@@A@A@@@A@@B@@@B@@@B@@AB@@@B@B@B@B@BAB@@@B@@@B@@@B@B@D@B@B@BBB@@BB@BBDBB@B@B@BB@BD@@B@@BBB@BBD@B@D@F@B@B@@@@@B@@@BBB@BBD@D@D@D@BBB@BBB@D@BABBD@BBD@@BBDBB@BBBBBB@B@DCFABC@G@A@ADBDC@A@A@ABFFBFBBBHFDHDFDDD@F@FAH@DBFHJFJBDBF@@DBBFDDH@L@BF@D@@@B@FAD@DBDFD@HCFA@ABCDABADC@CBE@C@E@C@ABE@EBC@C@EAAACEECECCACAC@A@@BABADAD@B@BCD@BEF@BABABADABA@@BBB@BBD@BBB@D@DABABADABABABC@@BC@ABA@A@A@@B@@@BAB@B@BAB@BADAB@@@@DFBF@H@F@D@FDJ@FBD@@HBN@LDJDJBH@DABEBAF@FBPLPJLFJ@L@HDFF@@@BAD@B@DBB@@B@@BB@BBD@F@DBD@B@@@@@BB@@DCBA@ABEAABC@C@E@ABCBCDAGEECAGACBAFAHADCFGBICI@AAKGIGCEEACBCB@FBFDDBDHDBD@DABADA@@@@B@BB@@@FBD@BBD@BB@BA@@@AB@DAB@@@@@@ADABA@A@AAAAAA@AA@CBA@A@@EEECA@C@CAAAEIAA@AAC@@@A@@@A@@B@B@BAB@BA@@@A@@ACAAA@A@A@A@@AAA@C@E@AEA@AAA@@DADCB@BA@@BBHJBBD@BAD@HAD@DA@ABC@AB@BBDBB@BABA@C@AAA@@@A@ABADAFBBB@B@BCBIJABBBBBHFBBB@B@@AAABCBCDADBD@DBB@BABCBE@A@E@CDCBCFKBGBCD@FBB@HFRD@@@@@@L@N@N@PGDGBAHCJEFCF@FDDFDDDFDHDFB@DCF@BA@A@CCACE@C@@@@@@BABADCD@D@BBBBB@D@FAH@HJXNPFJHJDDBRADAD@DA@ACG@GCECCAC@@@@B@B@HJBB@@B@BA@CB@BAB@DB@@@A@@@@@A@C@E@ADAH@FBD@D@B@DBB@BA@A@CCE@CB@BAD@B@DDDFB@DCDAFBD@D@B@NAF@HCD@D@HDHBJBJDDBBDB@LDB@B@BBDD@@@@B@B@BA@@@@CEA@@@@AB@@AAAA@@A@@@@B@B@BBB@@@B@@@@@B@B@BBBB@DBBDFBBB@D@D@DADA@ABABA@@B@@B@@@D@B@B@@CBA@@BABA@ABC@A@AB@@A@@B@@@B@BB@B@B@@DAB@@A@A@@@@@@BABAB@B@B@BBB@B@B@@B@@@@@BA@@B@@@BBBDDBD@BB@@@@@@AB@@AB@B@B@BBB@BAFAH@D@@@F@D@@@B@@@B@@A@@B@B@DDDL@B@@@@@L@J@DDJJHHHDHHLLF@N@H@FA@BDD@D@BDBH@DAB@F@DB@AFCFETa@ADMAGBC@A@A@ADCDCB@BA@C@@AAEABCB@@EEEACACBCD@FBFDFDFBDDHDD@BCDADAAE@AACDAB@@CBADA@CAC@CD@DBBCBADBF@DABAB@D@DDBDDDBFBFFBFDHHDDBDDADAB@DA@A@EFCF@@CFADABB@D@DBDB@BEDCFAD@FBDBD@NBF@FCDCFGBCD@DB@DDBF@D@@A@E@@D@BADEBABCDEFEFEB@@A@AB@@@B@LDB@@@@DAFBBB@B@D@BA@ABOBI@AA@AC@AA@@BABA@AA@ADCB@AACA@A@ABAAACEAE@A@AJCBA@@@AEAAA@A@A@A@C@@BA@@BA@@DBF@DA@CDCBABAACCC@CBEDAD@D@BH@@B@B@DAFAB@@BBBBBB@B@BABA@A@E@@@AB@B@B@B@@BBDBBBBF@BBD@D@DBBODCBCBABA@@@@@AA@@A@A@C@AA@ABAAAA@CDC@E@E@A@@MBIDEDE@EE@C@I@KAGAMEKGG@AACCCCCCCAG@M@A@A@EAECEAG@EE[AA@@AEK@Q@IAGGCECACACAA@@@A@@B@B@BABA@AAAAA@AB@BA@A@A@@@@A@A@CA@]CKGIECE@GBGFGFEBCDA@@B@BAJBDBD@F@B@JABA@C@C@CBAFCBEAE@CGEIEKE@@AAGAI@KHGD@@C@CACACBC@CCCACAEBCBE@CACACAE@E@A@AAE@CA@@@@@A@A@C@A@ABAB@D@DADA@CBEDGDGFE@C@AAC@CBIACAAA@CBAAAAAAAC@E@A@@A@@@@@A@@AA@@@@AAA@@A@@AA@@@C@AACAAAA@AA@AAAC@C@A@ACBADAFABA@AACAAAACAAAACAAAAAAB@BA@@@AA@AAC@ABAA@A@A@@@AAA@AB@DABABBB@BAJAFEJE@GCCK@IDG@AC@AAACAAAAA@CDBBA@CD@@@BDBDBADCDCBCBA@ABABC@EEUIEMA@@EAECCC@@@E@EEIBE"],encodeOffsets:[[106536,26169]]}},{type:"Feature",id:"530324",properties:{name:"罗平县",cp:[104.308675,24.884626],childNum:1},geometry:{type:"Polygon",coordinates:["@@AD@D@BBB@@@DBBBD@BBBBBBD@@FFBBB@BHBD@@ADAHBFDHAHCDCBABDDBBABE@CBCDADGDEBCFA@CACECCEAEDCDGFCD@FBFCF@FBF@DC@G@EAGBABAD@DAFCBEACAEDAFBJDJDFADCD@BBD@BAD@FAB@BCBEBAF@FA@CDEDCFEJEDCBG@E@EDCB@BCBCHEDEBIDG@E@CAEBEDCFCHEFCBGBCDAD@DD@DA@BABCDCDABA@@EACCA@@EBC@AD@HADBF@BE@@@A@BF@BBD@D@@@@@BA@@B@@@@ABC@ED@DEDCDEBE@OBOBQCIEEACDC@@A@A@A@ACAAA@C@CA@C@C@ADA@ACAAEACCEECACA@B@FAFADBDAF@DEBEAGCGCIAGEE@EBEB@DBFBB@BADEBCFAD@FDFHFFB@D@BA@I@GD@@GFGHAB@DDDLFF@D@F@@F@FADEBCDAF@DFDFBNCJ@FBHFDF@@DBDDFBH@HDFFBDBHBHFBDB@BBBBBAB@BD@BBDBBB@BBDBBBBBB@DBFDBHFDBDB@B@D@D@BA@AA@AA@@@@@CAA@A@A@AAA@AAA@@@AB@@C@C@A@AAAB@BA@@@@B@BABABAB@@A@A@ABABAB@@@DNR@FABAB@BABAB@FBHBFDHFL@DAH@FDH@DABAB@D@DDDDF@HAFADADEDEDC@G@@@A@@@AAA@CAICQGOEAC@C@CAECACCCC@CC@CA@@I@I@IB@@@@KBC@QHGLCDI@ICGACDCFSHYHCF@FDH@DABABEBA@A@@@A@@@GCICE@C@EBCBC@CACAAB@FCFCBCBEDABA@@@@@@@ABC@CBA@A@C@C@C@C@EBABC@CBABCDABADCD@@@@@@CDABCBC@ABC@ABADA@CBDDBBFBDDDBFFBBDBBBD@BBF@B@D@BBB@B@DFDDFFDBB@D@B@B@B@@@BDDB@@BB@B@@B@BBBB@@BB@@@BB@@BB@BBB@@B@BBD@@DB@@BBB@DD@D@B@@@BA@A@@B@B@BB@@B@BA@CBC@A@BB@BDB@BABAD@B@BBBAB@@CB@B@@BB@B@B@B@BCB@@@AAA@A@A@AAAA@A@A@@B@B@D@B@BA@CB@@@BB@D@@DABA@A@CA@@ABA@ABAD@BBB@B@B@BC@CBA@@BAD@D@BCAA@A@C@A@@B@B@B@@ADAB@@A@CB@@ADA@@@A@A@@@AAA@@BCB@BB@BBB@@@@@@B@@A@ABA@@BDB@BBABABAD@BFDFDDD@HAJAJBL@F@B@@@@@B@B@@A@CDAD@F@@AD@D@BAD@D@D@BCB@B@BB@D@@D@BABEBCFAF@B@BDDB@BBD@FCBCDCDAFBFBDDDDDB@DBB@D@B@D@BBD@B@BAFC@ABCBAB@B@@@@FBBB@@B@B@DAFEBEBIFCH@@ABBHBTBD@B@L@B@BDBHD@@@@DBBB@BBJ@D@BBBB@B@@@@ADEBCD@B@D@JHFBFBD@B@@@FABABCBCB@@@BD@BB@B@B@@B@DDDDFDDFDFDB@LFFB@@BB@BABEDCH@HAF@@@@@BBDBB@BDBB@BBD@DB@@@@B@B@B@@ADAFAFADAFDD@F@BAD@BB@D@BBAB@@BBB@BB@D@B@@@ABADABAF@DBD@@AF@F@B@@@D@BABBHDBD@D@DB@DBFBBD@D@D@F@HBHDHHDDAF@BBDBB@@B@@@B@DB@BBBDDBFBDFBBBD@D@BDBDBBBB@BABCBC@CD@DAD@D@FAD@BDBBABBD@BB@BDBD@DBB@DABADA@CBCBAD@B@@AHAH@DB@F@FBB@BDHBBF@B@HIBAD@DBF@D@F@D@D@DAD@PAVCJAR@NAJ@DD@BEDEFAJBLBFDDFBFB@@DBD@DBB@BBBB@D@B@B@@@D@B@@@B@FBBBDBBBFBBBB@DBD@B@BBDBBBDBD@BBDBDD@BBB@@DBD@B@D@B@BBBBDBD@B@B@D@BBB@B@@@B@B@@BB@B@BB@@@B@BBBAB@DADABAB@BA@@D@@@B@BBB@B@D@D@FABCBABAB@BABABA@AAC@AAC@AAC@ABCBE@C@CBC@@@A@AB@BADABEB@BAD@@ABA@@BA@A@C@AAA@C@ABC@A@@@A@AAA@AAC@A@C@A@A@@BABA@@@A@@ACBA@ABCBAB@BCBCDA@ABA@@AA@A@A@A@@@EAAAA@AC@@ABA@@B@BA@@@AA@@ABA@A@@@A@@@A@@@@@C@@DA@@AA@A@AAC@@@CA@ACAA@@A@@AAAACAAA@@@CA@AA@A@B@BA@@B@@AB@@ADCBABCBA@ABA@C@CBC@AB@BAF@DABCDGBCDC@A@ACCICEGAGCICQGEEEEEGGAAAC@A@@CAEAA@BCA@@A@CBG@AB@BA@AB@DAFCD@@AB@@@DA@A@@@GBABC@A@@@C@@@ABC@A@ABAD@B@@@BDBBAD@B@B@HBHDDDBBBDBB@BE@ADAB@@AFGHEFEDCFGBG@G@G@EBKDAHABAFIDODGDE@EBE@CB@@A@AACDEBI@A@@@EAA@AA@CCCCAC@AAC@CDAFAFABAACBCAGBG@@BGAEBCDEBCAEAAACGGCGAE@EBABACCAABAFAHCFCFCHBLBHBNBFBFBFBD@BA@ADABAD@H@D@B@@@B@B@B@B@@CD@F@J@F@TBR@FBRBTBB@NFNBFDFBJDBBBB@@B@@@BE@@BCB@BADBFBFBDBDBD@FBFBD@B@B@DBB@@@B@B@B@B@B@B@BA@@B@BA@@@@DAD@D@B@B@D@B@D@DBB@@@DBBBD@DB@@D@F@B@B@B@DAD@@@FBB@D@B@D@BAB@B@BADAB@DAF@BABCBCBADCDAFEBADCBABABA@A@AAA@C@ADEBABABA@C@ABAAA@A@CBC@EBEBADEBABABA@EBA@A@A@AEACAAAA@C@A@@@A@@B@@A@A@C@ACCCAAA@CAABCAAAAAA@AA@AACBCBABCBA@A@@AAICCAIAA@ACECCAA@C@AA@AA@@A@A@CBE@A@A@EAC@@ACA@AAECCC@@AAAAA@A@@AAABA@@@C@A@AAAACACCECA@AA@AA@@AA@ACCACAC@CAC@@@C@A@AAC@@@CAAAAAAA@AAA@AACAAA@@CCA@AAA@CAA@G@A@A@A@A@AACAAC@@CCGAAAAAEACCCEEGAAACCCACACAA@CAAACACAAA@AAA@AA@A@ABC@@@AAAAAACAAACACCACAA@CAA@@CACAAEECAAAAA@@@CA@CAA@CAAAAC@A@CAAA@ACCAAA@A@@@C@A@A@ABA@ABA@ACCACAC@C@C@@@A@ADCBABA@C@CAC@A@ADABADA@C@CACAAAAACAA@C@EDC@A@C@CCCACECCAAEACCCCE@C@C@CBCA@EAEACAAA@AAA@C@C@CAEAAACAA@C@E@@AEAEEEAEACCACA@CAC@A@C@@@C@CAEAGAC@AAECEACCEACCCACAACAA@C@C@GAEACCAGEEEACACBGBGDE@A@A@AGBCFAFAD@BADAACB@HBFBHBDHBF@FFBFAF@D@BABE@C@ABADADAD@BADCDAD@DADC@C@A@CAC@C@C@C@CAC@A@A@EBCAABC@AAC@AAA@C@C@EBAB@BABA@C@A@@BA@A@C@A@C@CBC@ABADABABCBABC@A@C@C@CBA@ABA@A@ABAC@CAAAEIWSKGI@A@CBKHIDCDCBEDEHCBAA@CDAAEDCBAAAEAG@C@EEGCG@IAECG@GBI@IBEBCCMEMCI@GBED@BBF@FDFBF@JDDBF@FC@AH@J@H@H@F@@@B@D@D@F@B"],encodeOffsets:[[106899,25172]]}},{type:"Feature",id:"530325",properties:{name:"富源县",cp:[104.255014,25.674238],childNum:1},geometry:{type:"Polygon",coordinates:["@@@C@GCGCAAAA@AAA@@AACCAA@E@A@CACACCCAACAC@C@CBEBCBE@C@CBC@ABABAB@BBBB@@B@@BHDFBFCDCAQB@BA@C@C@CBC@ABABABABA@AAAAACCAACACEGEEKESBGFI@@DGDG@GEEMEIGAACOCKA@EAABEBA@@@@E@CBMGiCIG@E@AEAE@@AECEAGAAACACCCCCCAAAACAAEACAABCAABCDCBABC@CBCDABAB@DBD@D@@C@AB@@EBADEBEBABABCBADA@CBABABABA@@@AA@@AC@@ACAE@A@E@CBC@ABC@@@A@ABA@CAAAA@@CAAAAC@ABC@ABC@AAC@AA@@G@C@EDI@A@C@AACAAAC@A@@AA@C@EAAACAA@EAABC@C@C@A@CAAAA@AAC@ABC@A@AACC@AAC@@AAABCBC@C@G@CBABAB@BADAD@BAB@F@BABA@AACECCCCAA@A@ABA@@@AABABCDCBCFCFEFCDCDCBABCBC@CCC@@AC@CDC@CBADA@@B@LBNBB@@@DAD@@@B@B@DBB@B@D@DADCBAD@BCBA@AACAC@ABC@A@AACAAACAABAB@DBBDBDFDDBDBD@B@BBDBBDDDBDBBBBB@F@FADABADCB@DAFAFADABAD@D@D@D@BABABCDCDCBCBA@AAAACCCAAAC@A@ABADCDABADADABC@A@EAE@AAC@ABCBA@A@ABAB@@C@C@AACAC@CBEBE@CCICQACICGAECA@DEJCFGBCB@@C@C@IAC@CBAB@DADCF@FB@JD@FABADJ@DB@AD@F@FBF@BBDFBDCB@D@@@D@D@D@D@DAF@B@F@D@F@D@@@D@@@BBBBDBBBDBDBBBBBBB@BDBDDD@D@DBDBBBBD@BB@@@BBBB@@@@A@@@@@@BB@@@@@@@@@@@@@@@B@@@@@AB@@@B@@B@@@@@@@@@@@@@@@BB@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@B@@@@@@@@BB@@@@@@@@B@@@@@B@@BB@@@@@@@@B@@@@@@@@@B@@B@@@@@@A@@@@@@@@@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@@B@@@@B@@@B@@@@B@@@@@@@@@B@@@@@@@@A@@B@@A@@@@B@@@@@BA@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@B@@@@@BA@@B@@@B@@@@@B@B@@@@@@@@B@@@@@B@@B@@B@@@@@@BA@@@@@@BA@@@@BA@@@@B@@A@@@ABA@@@@@@B@@@D@B@DA@@BCB@@DADCDA@@D@DBBBBBB@@@@@@@@@@@B@@@@@@B@@@B@@B@@B@@@@@B@@AB@BAB@@@@A@@BA@@@@B@@@B@@A@@BAAAAECE@C@ABCBE@CBC@CAA@EACAC@EAEAC@C@G@E@E@CACACACCAA@ACAA@EAEBC@E@ABCBC@C@@@C@C@E@G@E@C@EAE@E@EACAEACA@AAAC@C@CBA@@BCDABAD@D@B@BABADCBCB@DA@@B@B@D@BBBBBBBDBDBBBBB@D@F@DADABA@EBADAB@D@BAFABADABADA@A@A@AAAA@@A@@BAD@@@FC@ABCBABA@ADABADADAB@DABABADCB@@@BCD@BBB@B@B@@@B@B@@@B@@@@@DAB@@@B@@@@@B@@@@@B@BB@@B@@@B@@@B@@A@@B@B@@@@@@@B@@A@@B@@@DABCDCFCCAAA@C@ABADGBEBG@CCI@EBG@KAI@@DCBADC@ABABC@C@ACAAAEAC@CACAA@AA@C@@BABAB@FAHAFADCD@B@DCBADCF@@@F@DD@F@B@DBBB@H@B@J@D@JDDBJA@@@@B@B@@@BA@@@@BA@@BA@@B@@@@BBB@BB@@BB@@@B@@AB@B@@@@@@@@B@@@@@@BB@A@@@@BC@AAA@@BA@@B@BA@@@A@CAA@G@A@@B@DALCHCHCHAHGDCBAD@@@D@FAH@BAJBD@D@@DCBABBDBBABAB@BBBDAB@FBD@B@D@DABAFCJAFCR[HEH@HFLNF@F@F@BAB@BADIBA@ELYBC@@@A@@AAEAE@EAC@EACAA@AA@@AEAC@C@CBE@GBEBEBEBCBCBCBABA@@CCAACCCC@C@C@CBCDEDCDCDADCBABABA@A@ACACACAAA@@@A@CBABAB@@AAAAAAAAA@DAB@BA@@BA@ABA@C@C@A@@@ABCBCBABADA@CD@BCBADABCDABADCDABCBABCBA@A@AB@DAB@DAB@@ADADADADCB@DABABCDABIDCDABEDCBA@CAA@CAAAAA@AACCCCAAAABC@A@A@AAAAAAACACCCA@AABAAEB@DAB@BADCBABCBABA@A@C@C@A@C@@@AAE@AACAAAAA@AAAAA@@A@@AA@C@@AACA@AAA@ACAAA@A@A@CAA@C@A@C@C@A@@AA@@CAA@@AAAAC@@A@CAAACACC@@AAA@AAAAA@@AACA@AA@@A@@A@@@@@A@@BA@@@A@@@@@A@@@AACAA@AAC@A@C@A@A@C@AAAACAAAAAAAAA@AA@A@ABC@CAA@CAA@C@G@CBC@@EAAAA@EGMGqIg@C@KAEC@A@@BAA@A@C@EAEAC@CACAEAEACAABA@AD@@AF@@A@@@AAAAICEAECMAMEA@SAQAEAQ@SAE@I@E@C@@DA@A@A@A@@@A@C@G@C@ABCB@BABC@EAEAEAMAGAKAGAEDEDGDEBABBBDDABAB@FBFDHHHBDBBBFADCFADBFAH@@AHBHADBDABEBEBCB@DBD@BBDDDDDB@@BBB@F@@@BAJCFBD@B@BA@@DAF@FCFCHCPEJABGBCBAL@F@H@HAHEHCDEFGFEH@BA@CB@BAFA@CAAACACCAG@G@A@ABCAAAC@@A@C@AB@B@BAD@B@@@D@@@BADAB@H@@@BCB@@A@@BC@EDCBA@@BABA@@BAH@D@BB@ADB@FBDB@@@BBDBBHHFFFFHFDRDJBHFHJDDD@B@BCDADCHADCBE@ABA@@BAD@D@DAB@BABADABCD@BA@@BA@@@ABA@B@B@@BDB@@B@BBBDBB@BB@@@BBBDB@@D@@BD@B@BBB@@CB@@@D@@@@@B@@@B@@@BAB@BB@@B@@ABA@@@AB@BD@@BBBBB@F@@@B@B@BBB@@AB@BCBADADA@ABAD@BABBD@@@B@@ABAB@@@B@B@D@BBD@BBB@B@B@@@BAD@B@DBB@B@D@BAB@@AB@BC@ABA@AFCBABA@@B@B@@AD@D@DAFAD@BBD@BBD@BBD@BABABABA@ABABADEBC@C@A@A@AAA@@@C@@@ABA@ABCBCBA@ABAAA@@@A@@A@AAA@@@A@A@@@AAA@A@C@A@AACACAA@A@A@C@AAC@CA@AAC@ACAC@AACACAAAC@A@AAC@CAAAAAEAAACAA@E@A@@@A@C@@@A@A@CAAAAA@CAC@CA@@EAEACCAEAKBIFEFC@ACCI@MBQ@IBUDOBC@CBC@C@E@C@E@CAC@ABGJA@E@AACG@AAA@E@ECAG@GB@BA@C@ABAD@DCBABCBA@CAC@CA@@@B@@AD@@MBSFMDCFGDSBK@E@AA@@CCAA@A@K@E@@AA@AA@C@A@A@ADCBAABCAAC@A@CDCFCBEAEBIBAHCJ@@@@BB@@@@@@@@@B@@@@@@@B@@@@@B@@@@A@@B@@@@AA@@@@A@A@@@@@AAEACEAECEACCAED@@@@@@GBADAFADA@C@A@A@@@C@C@ABBDB@ABAB@DCAGACAC@CAA@@@E@@A@A@A@A@A@@A@E@I@O@A@@@AAAEAAMGEGAGAEAE@AAE@CACAAA@AAAAC@CDADC@C@A@A@AD@B@@@BAHCDABBF@DDJJLBF@DB@D@FA@@@D@DGDG@IBEBEFCBE@G@C@A@KCA@A@AAEBCB@DBDDBLBFBBB@BABEBGHGJCF@BABBBJJDBDBLBHBBB@@@@BBD@B@B@B@D@BBADCFEFAB@@@D@H@DAH@BBBBDDHDHBDABCBA@AB@BFNB@@@@@FRBD@H@FABC@EBCAACCACAE@C@CBC@AB@BBD@@ABA@@B@@BB@@@B@@A@ABA@A@AAE@AAABA@BC@AB@A@@A@@@@@A@@@@A@@A@@AAA@A@AAA@A@@@@BB@@@A@A@A@C@@@@BA@A@A@C@A@A@AB@@@@@@CDBFBB@BA@I@CAA@ABB@BBDDAD@D@BB@DB@A@CFADB@DBDB@D@FBBB@BABA@AB@DABAACAA@AB@DBBB@FBBB@@@DCJBDBBFDBBAB@D@DDBBJHJ@@@@DDH@LCHCB@@@@@B@@@BA@@B@B@FB@@DBD@D@DAB@DA@@DAB@LBH@DA@@BA@CBC@@B@BB@BBDBBBB@FDDNLJVJJAFEHIJGLGFE@E@ADCFEDCBCBAFFJ@F@F@@DDFDFB@@NBJFFV@FADAB@BABADCDCDABACAC@@C@@DABCA@DBBBBDBBB@BBDH@JCL@DD@HIFEFIBABA@AAABCBA@@BBB@B@@@B@BBBBAD@BBB@@B@@ABA@BBBBBBBDBBDBBBBBBD@BABEBCBABBDB@D@D@BB@BBBB@BBDBBBD@@@B@@BB@@@BB@B@@B@@BB@@@@@B@@@@B@FBDBBBBBBDAB@BBBDAJ@DBD@B@DEFCHCHAF@DCBCBC@A@AB@B@B@D@B@B@@@@DBF@BBB@F@F@DBDBDBF@DAFADBDBDDD@DADBDBD@@@HCLGJ@HBBB@@LFJFHF@DBFAFEDAB@D@D@DABIBA@E@C@CAIAABA@@@CBADEFEHAH@HDFJFLH^DB@@D@B@B@@B@B@B@@ABAB@BBBBB@BA@A@A@AB@@@B@DBDBDBDFHHJBR@L@BF@@BBF\\@FBHDFBF@F@B@B@NBHDDDDDDBD@BHHFLBNBH@L@J@DFFF@FCJCNA@@@B@F@FCD@DBBBBBAB@@B@D@B@BB@@B@@@@ABABADCDAPCABDHFBDFNDFDH@DBBBDBBBDDBDDDBDDDDDDDDB@BAD@D@B@D@D@FBDBFBDBD@BBF@B@B@DABCB@DEHETC@@@@FA@@BBB@@BBB@B@@AB@@AB@@@BDD@@DBB@BAB@D@FDFBDDDB@@BB@BTFRBTBN@NCH@HDDHLTHTBDF@B@D@JBD@@@BB@BAF@F@DBB@@B@BABO@GDCDEDA@ADADADABAB@@CBADCBCDCBCDABCBADAB@DAD@B@@ABCBC@@"],encodeOffsets:[[106947,26560]]}},{type:"Feature",id:"530326",properties:{name:"会泽县",cp:[103.297386,26.417345],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@LNRSCAYH"],["@@B@BA@@B@BA@@@A@@@@@@B@@@@@BA@@BB@@@@@A@@@A@@B@B@B@@@B@@@@@BA@@BA@@@@@@@@B@@@B@@@@@@A@@@@AA@@@@@A@@B@@@@@B@@@@@B@@@BCBA@@B@@A@@@@@AB@@@@@BC@AB@@@@@B@@@@@B@B@@@@@BA@@B@@@@A@@@@AAAA@@A@@A@@BA@A@@@@@AAA@@@@A@BE@A@@@@B@@A@@@@@AB@BA@@@A@@@@AA@A@A@A@@B@@@@@@A@@@@@@@@@@@@@@B@@B@@@@@@@@B@@A@@@@B@@@@@D@@@BA@@@@@AA@@@@@AB@@@@@A@@@@@@@@@A@@BA@@@@@@B@@@BB@@B@@@B@BAB@@@@@BB@@@@B@D@B@B@@@B@@@B@BB@BB@B@@@@@B@@@D@@@@@B@@A@@@@B@@@@@B@@@B@BA@@B@@@@@@AB@@A@@B@@A@@BA@@@C@@@@@@@@BA@@B@@@@@@A@@B@@@D@@@@@B@@AB@@@B@@@B@@@@AB@@@@AB@@A@@BA@@@AB@@@@@@A@AB@@A@@B@@@BA@@@@B@@@@@B@@BB@B@@@@@@@B@@@@@B@B@@@@@BB@@@@@@B@@@@@B@@@@BB@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@BB@@@@@@@@@@@B@@@@@@B@@@@@@B@@A@@@@@@@@B@@@@@@B@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@B@@@AB@@@@@@@@@B@@@@@B@@@@@@@@A@@B@BA@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@@@@@@A@@@@B@@@B@@@@@@@B@@@@@@@@@B@@@@B@@B@@@@@@@@AB@@@@@@BB@@@@@DAB@@@B@@@@@@@@C@ECEAGCEAACEECAC@E@CCACA@@@@AAA@AA@ABABA@C@A@A@CA@AAAACAC@@AA@@A@ABABABABABCBABABCBA@AAA@@AAA@@@C@A@ABA@CAE@GEGECEEEAG@AFG@CC@A@@A@CAAAAE@CBC@@A@E@ECAC@E@AAAAAACB@D@DA@CAEAC@CBAFBDBD@DCBG@GAA@IAG@ODIDMJE@G@ABADADCHEFADCDADC@ABAAA@C@AD@FABCBC@CCGGAAG@G@C@CAEAAACCAC@@BCBCFI@@@ABCBABABG@G@IGEMG@AACA@@CAAACAAAAC@CA@ACAC@AAAAC@A@AAA@@A@@@ABABA@A@A@@@CBA@ADCBA@A@A@A@AAAAAA@C@AA@@AAA@A@@ACA@@C@A@@FCBG@GEGEAI@EAAC@CBC@C@GCQBM@GECE@EBERGFEDGGQ@OFI@IAEAUEW@EGSEKAIAAGCGCAA@C@EBG@AAA@A@@AC@C@@AGEOA@C@AC@C@@AA@A@A@ADATIDA@@@@DC@@@@@AHEB@ACAABC@AD@B@BABC@C@AACACAAAAAA@AACAA@CAC@A@CACAC@AAAGAECAAA@@AAC@C@C@@AEAAAAAAAAAACAC@C@AACCAAAC@CACAAAAE@CBOPG@G@ECCIIYIk@G@IDEDAD@F@H@F@@@B@@CFKAA@AA@@@A@@A@A@IDCDC@E@G@EDIBIJCHAJ@H@DCBAAC@E@E@I@EAGCGAEAE@@ABA@@AAC@AAC@E@@AAA@@@@@CBABCBC@CACGCAECEAA@ADBDFFHDHJBL@BDJAJEHCDGBEBABBDBHFDHFCBADAD@B@F@DADBBAF@BABCD@@AA@@@@A@C@CAE@C@AAA@@AA@@@AA@C@ABC@A@@EEGCK@I@KEOIOKEAE@ABAFCBG@IAICKCM@GA@@AC@ECI@E@C@E@GAECE@@@@BABC@ABA@A@ABA@A@@@AB@B@B@BAD@@AD@BABABABCBABA@C@CAA@AAC@AAA@AB@BABCBABA@AFE@ADC@A@ABCBCBA@AB@D@DBDBFDFDDFBBFBD@D@FAF@BAD@F@D@F@DAD@BCBADCBAB@DE@GECAC@CBC@E@A@@@CAEK@G@CCAECA@@AEACEIGIAE@CBG@E@ECCECGCECAGAAAEEEBAB@B@D@ACBCB@H@D@BADE@C@AAAAAAAA@CAAA@@AC@AACBA@A@CAA@AAA@A@C@C@CAC@AAA@A@@@A@@@@@A@A@E@C@AAC@AAA@AA@@@ACA@@A@A@AAAAC@AAA@@AA@A@A@A@C@A@A@@@A@@@A@@BA@A@A@A@A@A@@BA@@@A@@@A@@@AB@A@A@A@@@AAA@@@A@@@@@@A@AA@@A@@@@@AA@@@@@CAA@A@@@A@A@@A@@A@@A@@@@AA@@@@@AA@@@@@AAA@A@@AA@@@A@A@@@@AA@@@@@A@ABA@@@A@@@CB@@ABADAB@B@B@@BD@B@BBFA@@BAAABAD@@@@ALCBCDA@@A@EBABECA@@AB@@A@A@@B@@A@@B@@@@A@@@@@A@@B@A@@A@@@A@@@@@A@@@@@@AA@AA@@@AA@@@@@AA@@@@A@@@@@AA@@@@@@A@@A@@@@@@A@@@@A@@A@@AA@@@@@@@@A@@@@@@@@A@@@@@A@@A@@@@@@@@AAC@CBCBABCDCF@DBDBFDDBBDDBD@D@HAD@HAD@F@FCBCB@BADEDEB@@CCACC@C@I@C@A@@CACAAEAACBE@A@@@@ACEIIGKEIGECIAE@A@QBGDKFKJ@F@DBB@DBDBBDF@B@DA@C@E@I@AAEAGCKIGCGBADAFABCBCBABABABC@MBG@GAE@CA@ABAFEBA@CAACCGCCACCEAADCBACABADBDCBC@I@B@BDBBB@D@D@EDADGDGDC@EAIA@D@BB@DBBBCF@BBBNBB@@B@BA@@BIDCBABAB@B@DA@AN@LDFBFFFD@@@BAB@JB@B@BADEH@@B@@BBFDDDB@DCFCDCFDF@BBF@D@DBD@DBB@D@F@@EBEBKBEDCH@FBH@FDBF@FB@DADCDCB@DABBBDBBBB@NBBDAFKBAAM@C@ABAB@@@BADABCFAF@DBBBB@B@D@B@DBD@BBD@BBDBB@BBF@DBB@B@BD@FLDFBFBDDHBDBDDFFF@D@FEHGJ@DEDGBCDCFAD@FABC@E@ABBBBD@BCDEFCDEDGA@D@DAHALCHEFEDEHCHAD@F@BBFBFDD@JAH@DEDIHCDCBGBC@AB@B@BDDH@FBBB@@@FBDBBDDBBBBBBB@@B@BAB@BABABABCBABA@CBAD@HGFEDCDEFIDGDADABCFEFIHA@E@I@E@CDEBE@E@IM@EDEFEAEIAEAGBEFCLGJCF@BFD@DCFS@KBEF@FADBLBN@FABA@CBA@AA@@A@AA@@A@AAAAA@AAA@A@A@A@A@@@ABA@@@AB@@A@ABA@@@A@ABA@@BA@AB@BAB@B@BOFGHA@@BBB@DB@@@ABAD@B@@@D@D@B@@BB@B@B@BBFBF@B@@@B@@BB@@@B@@BB@@BB@@AB@BABB@DD@B@B@BAB@@A@@BA@@BBB@B@@@@AB@@A@AB@@@BABCH@B@B@@A@EFA@AB@@AB@@@BAB@BAH@D@B@F@H@@BBBD@BB@@@B@BB@@B@@B@@@@@B@B@@@@@B@BAB@@@B@@@BA@@BA@@@@@@B@@@B@B@@@BBB@@@D@B@BAB@B@B@D@B@BCHAFADADA@AB@@@@@B@B@D@B@BBDA@AF@BABABAD@B@B@F@DAFAD@FAFA@@B@BABCH@B@B@BBB@@@B@@AD@@@B@@@BB@@@@B@@ABABAB@B@B@B@B@B@B@B@D@HAD@D@B@BBB@B@BAB@@A@@BABAB@B@@@B@B@@AB@B@B@BAB@BAB@DA@@B@D@B@D@B@B@B@B@@@B@B@@AD@@AD@@@@ABA@A@@BB@@BBB@BAB@BAB@BADADABADABABAD@D@D@DAF@BADADBB@D@BBBB@BB@B@BBF@@@D@B@B@BBB@BADAB@BABHA@ANDFBFBF@F@B@@BABCBQCI@EBCHAH@JBL@J@FBFHH@@DFAFCJ@TBDCDC@CB@BBBLFLDBB@DCBCB@DDDFBHBF@DCDCFBJDJHJDDB@BDHBDAH@DHBFDFBF@JAFCHCD@F@@@@A@@BA@@@A@@B@BA@@B@@@B@@ADA@@B@DAB@B@@AB@B@@AB@@@B@BA@@DAB@B@BADAB@B@@@B@@ADA@@@@@ABA@@@ABCD@@A@AB@@@@@D@@@B@DBB@BBB@@@NDJDFBF@BDDBJDJHDDDB@DBBADEH@BAD@BBBDDH@BFDDFFDD@F@BAD@BGHEH@DDDH@DDBB@FBFHHDFBDB@DDFD@B@BEDCDAF@BBD@DBF@DBF@JBBDDDBDBDAVEJABB@@@@BBDHHBL@H@D@D@DALABAD@@@FBBBBBBN@@@@@BB@BAHEDKDCB@BAFALEHEDADAFAD@F@FBTNHNLHNFL@FABCESBMJCLARCJED@D@HDDFAF@BEDIDEDAD@DFDDB@DCBE@A@IAED@DABBJZGA@A@@AA@A@A@A@@BABAB@@@@A@A@@@@A@@BAB@@A@@B@@ABA@AB@B@B@DB@BB@B@B@BBDA@@DBD@DB@@@B@@@@C@A@E@C@A@QT@LCJEDKDIHCHIJCJBJLLHVBJ@@@@@FJHFFBJBJBFDNBDADEJ@DFDDDHHFFDHDFBD@F@D@@@@@@DHFBAD@DBB@F@H@FCDGBKDCF@DDFHAFCNAH@@BDDBBBB@B@@@D@DBD@BBF@BBH@BB@@DBBDBDDB@BBFFBJ@BBFBDBDDH@FBD@JADCH@@AB@DLL@JEHGLEFCBCDCBGB@@EBAD@B@BBBBBBBBBB@BBBB@@BABCD@DABAD@B@BAB@BA@A@@DCBADA@AB@BABAB@BCDAD@B@D@D@B@BBB@B@@BBBB@B@BBDBBBDBDBDBB@DBBB@BBBDDBD@BBBBB@@B@@@B@B@B@FADBD@B@B@B@B@@@DA@A@ABA@A@@BABADAFCDAD@BAHCD@D@DBB@D@DBBDDDDDD@B@DBD@D@F@D@B@DBDBDBBBDAB@D@B@DA@@FAHCBA@ABC@A@A@ABABAF@FADAD@DAD@D@H@D@FAD@BBB@B@F@FBD@B@BBDBB@BF@B@B@B@B@BAB@B@D@BBB@BB@BBD@B@D@BABB@@DDFDB@@@BBBBDBDABABBB@FFBBDBFBBBBBBBB@D@BBBBBD@B@DAB@BBB@BBBDDFDDBB@BBBBBFDFDDBBB@BDB@F@D@B@D@B@F@@@D@H@DAB@D@BAD@D@B@B@B@@AHEBA@ABC@A@ABCDCFC@@B@DCDEBADCFEB@@ADA@ABA@C@A@AAA@ABC@ABA@EBABA@ABAFCDEDCBCBABA@ABE@ABC@ABA@ABA@ABADGBA@ABC@CAC@C@CB@@@DCB@FCB@DCB@FEB@@@D@BAB@BABABABCDE@A@A@A@C@E@CAA@@ACCECGAACECECE@AAC@A@A@AAA@E@@@ABABCBA@ABA@A@CBC@A@C@A@CBABCBCBADADCB@BA@ABABC@A@@AA@@A@AA@A@CAACCCAC@A@EBC@A@AAE@AAEA@AAA@AA@AAC@C@C@@AA@@@AAA@AAA@A@A@A@CAA@E@C@GCA@CAAAAA@@@@@C@@BC@A@A@A@@BA@A@AAE@@CCAAAAACA@AAAAA@C@C@C@AA@A@@@A@A@@@@@AAAACBAAC@A@A@ACAAA@C@ABC@A@A@A@ABC@A@A@C@ABC@C@A@@D@BABG@@BC@ABA@ABADEBAB@BCDEBA@C@A@C@A@C@@@AAA@A@C@A@@BCDCBABA@@B@@A@CAABC@C@A@C@ABABABABCBABAB@FEBABA@C@@BAD@D@DADABAB@B@BBB@DDBDBBDDBBBBBBDBBBD@DABADA@A@A@CBABC@ABA@A@A@CBA@A@ABAAA@@@AACAAACACAC@A@A@A@CBAAE@AAAAACAAACCA@@AAC@C@C@@@C@A@ABA@A@A@A@C@AAA@A@C@AAC@C@ADCBCAC@@@A@@@CBA@C@C@@@CAACEAAAAACAAAA@ACGACAE@A@AAC@A@ABABADC@@DCB@B@@ABAB@BAB@@@BABCDCBE@ADABABAB@AA@CBABC@AAAAECA@A@A@A@AAAA@A@@A@@@A@@@@B@@@@@BA@@@@@A@@@@B@@@@AB@@@@@B@@@@AD@@AB@B@@@@@B@@AB@@@@A@@BA@@B@B@@ABAB@@@DAD@@AB@BA@@B@BABB"]],encodeOffsets:[[[105867,27383]],[[106280,27210]]]}},{type:"Feature",id:"530381",properties:{name:"宣威市",cp:[104.10455,26.219735],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@B@B@BB@@B@BBD@BBB@DB@@D@@@B@BA@@BABABA@@BEHCL@PBB@HBL@L@H@FCFIH@D@BBBBBBDBD@@@BBB@@BBBDBB@@ABB@BBBBB@BD@BB@@@B@@BD@@@B@@A@A@@B@BAHBD@B@BCH@BBD@B@@ABAD@BBD@@A@ADADABBDAD@B@BAB@BA@BDBDBBBB@BBBBBBBAB@@A@@B@D@BBB@@DB@AB@DDDBBBDB@@BBBB@@BB@BBBDBB@@BBB@DDBBB@BBBBBB@BB@BAB@@FD@@@B@B@BBBBBBBF@DB@B@D@@@B@@@D@@@BDBBBBB@BBB@BB@BD@@B@B@B@@BABDFBDBD@BBDDB@DB@@B@BFD@@DBDDBBB@JAF@HAB@D@DBD@B@@@FF@BF@B@D@B@D@HGD@DCBADC@ADCB@FBD@B@DAB@@@BBDDB@D@BBBAB@@A@@@A@A@@@A@@B@@AB@B@@@BA@@@AB@BA@@B@FEDAB@BAB@BBB@B@FADAB@B@BABAB@B@BCB@B@BBD@B@DA@AB@@BB@@@BBBDBBHDBBBAB@B@BB@@B@B@DAD@D@@@BCB@@@B@BABC@@BCBA@@DB@@B@BAB@BAD@@@B@D@DB@@BBDB@B@DBB@D@B@BAB@BBD@@@BB@DBB@D@D@D@D@D@DAD@D@DABAD@BAB@BABA@@BABA@@BABAB@@A@A@@@A@@@E@C@C@ABB@@BAB@B@DA@@DB@@B@@@B@@@B@BBB@B@@BBB@@B@B@HDBBF@@@B@B@@@B@D@BBB@B@BAB@@@B@BBB@@@B@FAB@@@@@@BB@D@B@B@@BDD@BB@@@@D@@BBBDBB@BD@@@B@BB@B@BAB@B@BBBBF@DBBB@BBB@D@@@B@@BBB@@D@D@@@B@@@DDB@@BBB@D@@BB@@AB@@@@B@@BBB@@AF@BAB@DBBBDB@BDBB@BB@BBB@@B@B@DB@BBBB@@@@@B@BBBBDBBFFB@AD@D@B@DBB@B@B@B@BAF@B@BBB@B@@@D@B@@BBBB@@D@@@B@F@@@@BBBDBB@B@B@@@DABA@AB@@BB@DBB@@BB@BABABC@A@CA@@AB@@CB@B@B@BA@ABA@@BAB@B@B@@@BA@@@A@@BA@C@CB@DABABCDABE@@@ABA@@@AACAAAABA@A@@@A@ABADADCFADABA@@B@BABA@@@@B@BA@@B@@AAA@A@@BAB@B@@A@EBA@AB@B@B@DABA@@BAAA@@@A@EBA@@AA@A@@@AACAC@@@A@A@@@@@A@AA@AA@@BA@@@A@@AA@@@ACA@@@AA@@@@@@@@A@A@@@@@@@A@@@A@@@@AA@@@@@@@A@@@@@@@AB@@A@@@A@@@AA@@A@@@A@@@A@A@@@@@@A@@A@@@A@@@@@A@@@A@@BA@A@@@A@A@A@C@@@@@A@@B@@A@@B@@A@ABCBA@@@AB@@A@@B@@@BAB@BCDADCDA@ABA@@D@@@DCB@BADADCB@B@B@@@DDFBBBBBBB@@@B@B@@D@@@DBBBB@D@B@B@BABA@AB@B@B@BBB@D@B@B@D@@@D@DAD@B@DB@@B@BAB@B@B@FAB@F@BBBADA@@B@BBDBB@B@B@BB@DB@B@B@BBB@B@DADA@@BABAFA@ABADA@@@ADCDABE@A@EA@@C@A@@DAB@D@D@B@@@@A@A@@BAAC@@BE@ABC@@ACAAAAAA@AA@CEAC@A@CA@@CAA@AC@AA@A@CAC@ABA@A@ABC@A@CB@BAB@FBDBB@B@DAB@D@DAB@DAB@D@B@BABAB@@A@@B@BBBAB@DCDABA@A@AB@BA@AFADCB@BA@B@@@@B@@@@@@@@@BA@@@@@A@ADE@AD@BA@A@A@A@@@ADAFEBABAB@DCBABA@A@ABABADCBCBA@ABAAA@AA@@EAC@@@AAC@AAA@@AAAA@AAABE@C@C@A@A@ABABADC@@DC@ABA@A@ADCBAB@DAB@B@BBD@BADAD@D@@@B@D@BAD@BAB@BA@A@AAC@ABC@@@C@A@A@A@@@AAA@ABABA@A@ABEBA@CBA@AAAACCCAA@A@A@C@@BCBADCBA@A@C@ABABABAB@@AD@@ABADA@@DCBAFA@@AA@@@A@C@CBA@AACACA@ACA@AAA@A@CBCBC@A@AACAAAA@ACCAAACC@CAA@C@@@AB@@A@A@A@C@A@ABC@A@A@A@A@@@A@@@AA@@AA@CA@@A@A@A@CAAAA@AAC@E@GAC@AAE@AAEBA@A@A@C@A@C@A@ACAAGCAAA@C@GAE@A@C@AAAAGAA@AAA@@AAA@@@AACACCCCCAAAEAA@@AA@A@ABA@AAC@@AA@ACACCAACCAAA@AA@AACAACAAAECC@CAA@@AAACAACCGAE@@ACACAEAACACC@@AA@@@@AA@@BC@@@C@@@A@A@AB@@A@A@@@AAAB@@A@@BABE@@@ABA@ABA@C@ABA@A@@@AD@DAB@@AAA@@A@BA@@AA@AAA@A@A@C@@AA@@A@A@C@A@AAA@AC@@AAAA@A@AAA@ABEBA@A@A@AAEAAAAACAA@CAAAA@@AE@@@EACCE@AA@@A@@EA@AAAAAA@AAAACA@A@@@A@A@A@C@E@C@AAA@@@C@ABCAA@A@A@G@A@A@A@A@ABA@A@C@A@A@CBABA@ABABA@A@@BABABABA@A@CBEBA@CAA@A@C@@@AACAABA@C@A@AAAAEA@@A@@@@AAAA@AACACAACAAAEAC@AACACA@AAABC@A@AA@AA@AAAAACAA@@@A@@@A@@@A@C@C@@BABABA@ABA@A@CBA@A@ABA@CBABABAB@@GBCB@@AFABABC@CAA@AAA@A@CI@@AA@AAA@A@A@A@ABA@ALCDEFGFGFK@E@CCEAEAG@ICEE@CA@@@A@CBA@A@CBA@A@ABCBC@ABA@AB@@AB@@A@@BA@A@@AAAAA@@AAAAA@A@A@A@A@@@A@AAACAAA@G@CBE@A@AACACCACCAACECE@ACCACAA@CAAAACCACAC@M@CFADEAGKC@@ADAD@BA@C@CBA@CBABADCBADCDADCDAB@DA@ABCBCBCB@BCBCFCD@HAPABA@@@AA@C@EBE@AAA@@C@IAC@A@E@ACGSKSCGGCG@MDM@SAQASE@AAA@@CACCEAECC@A@ABA@CA@@CC@A@@BA@@BA@@@AAA@AA@AA@@EB@@@@SDGFCFA@ADCBA@A@E@AAC@CAEACAEAC@C@A@C@C@ABA@CCCCCCCCCACCCAACAAACAA@CCGCEEMACGEACC@C@AAE@AAAAAC@AA@A@A@A@@B@@@F@BABABA@A@AAAA@AA@EBCBA@A@@@AGC@C@CBAF@DDDBDABABCD@DCBE@CA@@AB@@AB@@@D@B@B@BBBFB@B@@ABID@B@BBFDFBBAB@B@BDBBBA@CD@BBBB@BA@AB@@BBDB@@BAJAP@BABC@A@A@AABE@C@@A@KCA@@@A@@B@BA@EFEFCFADABCFABC@@@@F@BC@E@CA@CCAC@ADEHCDEDE@MAC@CAEAC@EBCDAFA@AC@C@CAACBEB@DE@ED@F@BCBA@CBCBACCCGGECEAAEAECCACCCC@A@ABCBE@CAABADCAC@@DBD@DCBAB@DA@CBBD@BBFCBCBADC@GCCCEAECECEAC@ADBDBDFF@FA@ADFBBB@@@DABA@CDCD@B@B@BADBHCN@BSbEFED@BCAE@A@CBG@CA@A@CCC@AEBG@M@E@KKGGGCGGIICCI@K@@@@@A@K@CC@C@A@AB@@@@A@@@A@@@C@E@@@CBGBE@AAA@A@A@ABA@@BA@@@@@@AAC@CAACAA@@A@@@AB@@@@A@@@@A@AAA@A@A@ABABA@A@@@@B@B@@@BA@CA@A@A@@A@A@@@AB@@@BAB@D@BAB@BA@AB@DA@@@A@A@C@@@AA@@@ABAB@BCBCBC@C@A@AACEAA@CAAAAA@A@@@@@A@@@A@AAA@A@@@@@@BB@BB@BA@@B@@B@DF@@@@ABA@A@@@@@CCAAA@A@KCA@ACCAICIAGAGCC@C@GDE@MBA@C@C@EACBCDA@CECCA@C@ABA@@DDF@D@BABA@CAA@C@C@EAG@CB@B@F@D@B@@@@@B@@CAA@ABA@@DABA@@@AAGIA@A@@@@@BDDDDF@HDH@BCBC@CBQBCAICIGOEWMGIG@EBC@A@AAAAC@C@CDABAB@@@@@@@DDFDB@D@BABE@CDA@CECGCECCCEECE@EDIFGDABCHOHM@M@K@@@@@@@QCGEA@EAC@ADAHELADCD@D@F@BAFADABA@CAC@CACBADADBB@BA@A@AAGEAAAABAJIDA@A@AAAEACBAB@B@B@@BB@B@DABABA@CAAAA@@BAD@BCBC@GBC@ABC@AAGIAA@@ABA@CDCB@@BB@BFB@B@F@DBB@BB@B@B@B@BBBD@@@B@@ABA@ABA@A@@@@B@@@B@@BD@BBBFJBBDBD@B@FDFF@@@BAB@DBBB@BBBB@B@BABCB@B@@@@A@CBA@@BBFBFDHBH@F@J@F@FBDABCDG@I@GBIDAJCJ@F@H@FCDCD@J@B@BB@@@B@@BBBEL@DA@@@E@G@E@C@CBCF@J@HJlJZDJFDH@H@PODAF@BBBBBD@DBDBBDDBBD@D@DBBBBBBBBBBBBF@@@D@DBD@BB@BBFDHBBB@BBDBD@D@BBD@DBBBD@BBBBBBBBDBD@B@DADABA@C@@BADBBBDA@GF@B@@@@CD@@@@CBSJCB@B@B@BBB@@@DBDD@B@FPBH@@@DBD@@@BBB@BAH@F@DBBHDHDBBBJFLHT@FFXBVBF@JEJ@PHRCHEFQHAF@FDFHFN@RAHDD@D@DAD@BB@FBJHFHFH@DA@EB@D@@@DB@BB@B@BB@@BBD@B@BBBB@B@B@B@BABCD@BAB@D@@@B@BABAB@B@@@BB@BBB@D@BBBBD@DB@BDBD@BBBBBDBB@DB@BD@BNHHF@J@HAHABABAD@B@@EJADAD@@BDDDBBFBDBD@H@H@BBHHDDD@DABA@EBCD@B@BBBAD@BCDCBCFEDGBCBCBAH@F@NIJCPCH@JBB@HBH@DA@CACACBEDAD@FBDBB@@C@CDABBBBBBF@D@DB@F@F@BD@DAF@BBBB@D@BB@D@@DEH@BBHFFDFHFHFF@DBB@BAB@D@@@B@BB@@BB@BABADABABADABABABAB@B@BB@@BD@DBBBBBB@@D@B@B@DABAB@BBBB@BB@@@@DBDB@D@FBDFDDFBBDFBHDF@F@D@@B@B@DAB@B@B@B@B@B@BBB@B@BBB@BBB@BBB@D@B@@@B@B@B@DBB@B@D@B@BBD@B@B@D@BBB@B@B@DBB@B@@@BBB@BAB@B@B@DAB@B@@@BADAB@B@BAB@@@BAB@BBD@B@B@BBDAB@BAB@B@B@BB"],encodeOffsets:[[106482,27154]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
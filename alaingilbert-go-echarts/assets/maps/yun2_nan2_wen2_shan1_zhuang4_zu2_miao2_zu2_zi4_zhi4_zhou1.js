@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("文山壮族苗族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"532601",properties:{name:"文山市",cp:[104.232665,23.386527],childNum:1},geometry:{type:"Polygon",coordinates:["@@OEAA@@@@KAEAOCIDEHAFALBLAFCDC@GAMIMGKCI@GBQFMBOBO@QCOAKCMCG@QGAAIGGCK@GBQHK@ABSDOFSJMFQJQJOBKCKGQKMKOKAAEEGCCCGGMKOOOCMEUAMAMCC@A@A@CACAA@AAAB@BA@AB@@@BA@@BA@ABABAB@D@@@F@B@@@B@@@B@B@B@B@D@B@@@D@D@B@BA@@B@B@@AB@B@B@BB@@BB@@@@B@B@@BBB@@B@@BBAD@B@B@@BB@B@@@B@BAB@B@B@BB@@BB@@@B@@B@B@BA@@BA@A@A@AB@@@B@@BD@@@B@BABA@A@CBADA@A@@BA@@BBFABAB@@@BA@@BBB@B@B@BA@@@@BABAD@@ABAB@@ABAB@B@B@@A@@@EFCDA@@BA@@B@B@@@BBB@DAB@@@BCBCDAB@B@BA@@D@@BF@B@B@@@B@@@BBB@@@@@BBDA@@@@B@@BB@@@@@@@BBB@@AB@@AB@BB@BBBD@BABA@@B@@BBBB@@@B@@A@@B@@AB@@@BBB@D@BBBB@@@B@@B@@B@BB@@BB@BB@@@B@BBBBB@D@@@BBBBB@BB@@B@BB@B@B@@BB@BB@@@@@B@@B@@@B@BB@@BB@@BBBA@@B@B@B@B@BB@@BA@@B@@@@@B@@@@@@A@@@A@@@@@A@@B@@@B@@ABBBAB@@@B@@@@@@A@A@@@A@@BB@@@@B@@A@A@ADA@@@AB@@@@@B@@B@@@@@@B@@A@@@@B@@@@BB@@@@AB@@BBA@@B@@@BA@ABAB@@@@A@@B@@@B@@@B@@@@@B@BAB@@@B@@@@B@@BA@@@A@@BA@@@@B@@@BAB@BA@AB@@AB@@@@@@@B@@A@@B@@@@A@@B@@B@AB@@@B@@@@@B@@@@@B@@@B@@B@@B@@@@AB@@A@@@AA@@ABAB@@ABA@@BAB@@@D@@@@@BA@C@@B@@A@@@@@A@A@A@A@@@A@@@@@AB@@A@@B@@@@@@@@AB@@@BAB@@@@A@@@@@A@@@@@A@ADCB@@@@AB@@@@@BA@@B@@AB@@ABCBCBABC@E@C@E@@@@@CBCBABCAGBC@CBCBABCBCDC@EBCDCDADABADCBABABA@@@A@C@ECCCACACAAACCAAAABABAD@@AD@@ABAF@D@DCF@FHLHNEj@DCDGJGLBDFFJDLFDN@LEJABKLMFGFDFHDFHBJBJAHCFCAK@KAEDCDDLBBJLHL@@@BA@A@A@CACCEBIBG@I@CDCFCFEBA@CAECCAIAGAE@GBICGAE@ADBLFJ@FADEDKFGDEDBFDB\\JBFDHD@PBB@BBAB@@ABA@@BAD@B@@BBFDHFJHHFJFJJHHBDFJDH@JDBBJ@N@B@DBDHHFBJDFBBBBH@JBZADABGFAD@BFDBDBB@@HHHBD@DALGPGPELAJBHB@BBDBR@NBJDFFBHBJAD@B@@AB@@@DA@@BADCB@LKLAXFLFNJLFNLPLNFLDL@RGLAL@NBD@@@B@JDD@HBHBF@HELGPGF@DBDFDF@LBJGFCD@D@DBDFBHAD@DFBBF@NBDFBBBDDBF@LCH@H@JALCBCHGHCLENGLELEBAPIJGDAFCJINQFKFMFIHKLCLALCLILCPA@AFAFALEHEDKDIDMAOCMDGDQBSAODGIKMKOIIIGO@I@A@IDEFGHINGLGJEDAFALCHAHAJ@RBF@HBN@TDNDJ@B@PCNGNEP@PDN@LANELILIDEPINIBICQGGAECCHELCLGHQFEBOCO@AEKIQ@KHILGLOJCPCLELIJGLMBI@@@@DEFSDMCEACCICGCOEIEIEECCIGMAE@I@OBKAGC@EH@DAHCFCHEDCRKLEVENKECEGGIGICGCCGGGIEKCIEMIOIGIGOEQEOEQEQQGKKIOKQIMG@@@@A@EA"],encodeOffsets:[[106801,23683]]}},{type:"Feature",id:"532623",properties:{name:"西畴县",cp:[104.672597,23.437782],childNum:1},geometry:{type:"Polygon",coordinates:["@@GGACBGDIFMHU@MCIIGKGKICGEMAGAO@KEBKFGFOLDNDTMFQHALANINIIUAO@A@M@M@]JDH@@@@FRFPHPFXBRAR@F@LITMJKDC@OFMLGNCBMLUFKFQLCDGFEDGDCBG@@FHDLBPAJ@F@NBJHDDFFFJFJDPDHDJBDDFBHFFHJFFFHFJBFCN@HBFBDFFJDJBHAHBHDHHFHJJBBDBBBJDHAJCPCB@@@@@HFFDHHHFDNDHDFHPFLJFFAN@P@HDHJFL@D@@DLFLFLJDF@D@L@F@NBNBR@TBZDPDT@P@RBNDBF@DADCFCHENGNEN@HADDJHDLDLBNDPJNFPLPJJHHFBBDJDJ@HENIHCFADELBJDFDFFHDFFJHDLDLBJAR@HF@JBJFFFFFABCJKHMLGTIZAVCPATGLKJITGJCVCTANEHA@@FAB@D@DABAFE@ABA@ABC@AAG@EBABABBBADABCFCBADA@@BCBCBAHC@AAAACCAAABIAUF@BE@I@GEICKCGGIICIAIE@@AG@EDEJGZGNCNEHMDGHMJATAN@PAFC@GKCIAKEKCMGKIAEAGBIHKAEDCAGSGM@E@AAECAACAC@ABCDEFGDEBEAUGI@I@EBSAEEGGCAA@C@C@C@AAEI@AA@MAKAGSISSGOFKJQHKEGKUKIOCMCMEMAQLQBYAOAG@CG@A@AABCJCDEAGCKGMGOMSOSCOEQKMKKK@EBKFGDEJELGLGPKRGHGBE@MMIMOOKESCMCKAEIBIAM@IEKOCI@O@KBGBAF@DJHFHDLAFCDE@IAKEKCKEQGMI"],encodeOffsets:[[107072,23725]]}},{type:"Feature",id:"532622",properties:{name:"砚山县",cp:[104.337211,23.605768],childNum:1},geometry:{type:"Polygon",coordinates:["@@FHFJAHAD@@AFBJCDEBGDEFADAD@D@D@HF@F@CHCB@FDDBDBD@DAFCBED@DBBDDBDABCJANIHDFDJADICAACDEFGJHFFFFFDFFJ@HBJAH@L@LBFDDBHBH@H@F@DFHDFABEAE@G@A@ADBHHFD@BABADB@FBD@@BLFHDHHHBF@H@FAHCFADDFHDLBP@FBFDBBBF@FDBFAFDNFFDDBABJ@F@FCHAJAD@LIHCDCNMNGHEF@FBH@FBDDBBDFDLDJHFDBJLNHbLF@F@DABADABCBEDKBEDCHAJDDBHCBCDAHBF@HCLDFABEFEBG@ECGEECEEIEECC@EBGBCJCLARCD@B@P@R@RJLLPHNBNDNALAJCHGHMHQDMBMDKBIBKBEFEJ@NCPARENCLDRFRBT@LGNCDAFCHGDKDMFIPGPGD@F@DBD@FAHGFCLANDLDFF@B@DAFCDMJCD@DBFJH@FADBFDBD@DBDJDDVTJHJDF@B@BB@BCJ@DHDJ@JBHBBHADEFAL@J@HCDMHALGBCBBFJHD@\\BN@L@FDFDBHJBFDHAFCH@HDFFfTFBJBV@H@BADCDAD@FBR@RCRERCNARELCD@LAL@NDRLTJLLHLHFRHPDJHNRHJDDFFPJJJHLBHDHBFNLHDPHBFDHDFDHDDXBPCV@NANELKDGDIDI@GHGFAH@H@LDHDLAJEFIBMCGAKDIAIEGGIMCKCICCECQ@IDGBEJG@@BCFAH@LDLHLBJ@H@B@HAB@HAHAFEDC@CBIDMDIBIDGFEBADAFCDED@LBDBDBD@AEBK@ECECACGBEDCD@JDH@BEFICAACBAPKB@FEHCHAFCDCBE@GD@D@FFDBHGFAHDJDN@JCFCBGCGACIEAE@CHA@@FABA@EAECCEAMCG@CCCC@CAM@EAEACA@@ACEGECACCCOCIBO@MFMDKFGB@HEN@NBJ@FEFICIIKGKEK@ACKDOFSFQHONIBCFEJOJEJEFAHAAIFKBCDEJGFM@GCICIAAGEIGOIOKMEOIMCKAKCGCCIBC@GFMHMFMDGDEBC@CAEMCQAO@S@OCYCSAQ@MAMAE@K@C@E@ICEKEKCK@@@CEKGIGCO@M@EBIEEKGOCECGCMGEGGECGE@@@@A@ODIDGBICAACAAAIIEGGGGCGAGBIAICEEACAE@GDMAEEIEGEEGIEEAGCNETCF@@@@AJKNIHKJKFODIDKPKHGJ@LJRFL@BDPAPEFGRKHKDGFDDBFHHDRAJMJOJCFKJKJMFKBM@OCO@MFMHODA@I@MCSCM@GAE@QAI@GBGBKDEBCBIFKHMHGJEHCF@J@B@JHPJJPJNLJLCHBPATCRCHDNBPCNCJCLGFKFEBEB@BOBKDKJKDKBKDGLEJENELMRIJEDCBIHOJABKFKFMHKFGDGHADKDIBG@G@KDE@CAACAACEMAE@AACEC@GBEAAC@C@CDCHEAI@KCECECAE@OHKHGFE@GAGAC@ICA@@@C@MAK@KBQHK@KCMEOKMKKEMIKEWEKBKLA@CDAB@@CB@@A@@BA@C@IBGAEACEAI@MAQAC@AGAIAKBOFOHKHCBC@GAGG@@AAACEC@ABCHEBABCAY@IAGAAEAICEAGGAC@C@A@MAICA@ICGEIACGGIIIEGEIGGEECAA@@@ABC@AA@I@G@EBAB@BCHGHEBIAICIGGCEG@C@EAAACIUCACBGDMJCBKEAAGGGI@@CAIGIEKCMBA@G@GDIJCFAB@@@FAFABCBIFSNULCFAJ@RBJAJCHCDCHBBDDDBN@DBHBHDJ@BBFDBH@BRJFHDF@HDHHLTR@BDDBD@HBFJLBB"],encodeOffsets:[[106145,24212]]}},{type:"Feature",id:"532624",properties:{name:"麻栗坡县",cp:[104.702799,23.125714],childNum:1},geometry:{type:"Polygon",coordinates:["@@KE@@KGMGOCKAMCIEKOO]G_QIOAOAGGBQFQBQCEWCUDU@QCMCMCK@KHILKHMBK@KBKDM@A@BHHRLPLPPFHF@N@J@H@@@BCFADABADJJT\\AJEBE@IEKEE@EBEBA@IDADIHK@K@CAQDQBOFKBGJCH@@CDAD@DDHFJ@FAJAFAHEACBI@CFCBGFCDCFBF@D@FAJ@FCB@HBF@FADBBDDDBNAFBFBHDDBFD@B@DGHCDELADBD@FBDAFDBDDBNHNIB[DENFN@LU@KF@LBPBHFNDHLJLHJHDJ@NGVENCJAHBDHHNJRHLFLDLFJBF@DCBECKEGIG@CBEHALAP@J@PDFL@JBNAJFJLBNDTDLFPPJNNNF@HAHGLQHOHKFKFIHCLEFAL@LLLNFRDPPTNTHPHNDLBHCFIDADBBB@H@@DBHBPAZKRBRFNDNDNJPVLHLLFRGLIPETHJTHTLBNBB@@BFJBBD@D@D@B@DBHHFFTBFAJ@J@VHFBFAHCFEDCBAD@DBBBFDBBF@N@THBHCDBFGLAJBHBFLJNHLDLFJBLD@HEDOBM@SBIBGNCHGNMFMDYHIHCF@FBH@@JFJBJDHJDHDLFJ@H@JAFE@BVAJBBDBBDBB@BAFB@FBBBHHBBD@DCDAPABA@CAEBCDAD@@@JLDJFFHFH@LAPINKTIJCPENEPCLCDAJCDALCDAFBDL@F@NAL@JHPNNHBFAJEPILANCVCTEDAN@RDRBJCLAJILKRETGTAXGLEPGDGLE@I@CBAFGFCF@DAF@FCBC@@@CGCKCIG@@AGCMHORKFGB@B@FABAB@J@ZCJ@@FFBJMDCECK@G@IEBC@MKM@A@EDOFIDKNEPCVANAF@GKAECGGGGMMMKMGEeAGIDSBKGSIUMOAMAQCIEII@MBSDODSLOFQBSK_MUKMGQGOIMAOACHGJCDMJG@OAKIIGKGSCUWQSWMWCQCEKASCCoDKAGCEEEEAMCICU@KEGCIE@I@CFAJGBIAKDEDCFKTgIMEGIAKBSEOAQAEEIIAM@MBOEQCMEEEGIEDCLIDMAIMBUFeNGDG@K@[JIBECO@KLIFQDOHQDMLSLMJENGDMBKBOAECOOMOMSCMAMG"],encodeOffsets:[[107339,23470]]}},{type:"Feature",id:"532625",properties:{name:"马关县",cp:[104.394157,23.012915],childNum:1},geometry:{type:"Polygon",coordinates:["@@BG@C@C@C@@@@@@CCCCAECECEEECCECCAMQGCGGIQEB@FBHAHBF@BBHCBGHCDEBC@C@GBGAEAC@IECEAEBE@GAEGAUBQHMLIRCJBB@B@B@D@B@BAB@BAB@@@B@@@@B@B@@@@BBD@@BBB@BB@@BBBB@BDB@BBBBBBB@BB@BBB@@@BB@B@@@B@B@B@@AFABADAB@BAB@BAD@DAD@B@BAB@@ADADABA@@B@B@B@B@B@BAB@B@@AD@B@B@B@B@B@BAB@@@BBB@@@D@@@BBDBB@BBB@B@BBD@@BB@D@B@@@B@B@@@B@@@BABABABA@@BA@ABCBA@ABA@@B@@ABAB@BA@@@@@@BBB@@BBBB@@DBD@B@B@DADADAD@B@B@B@B@BBB@B@BB@BB@DFBB@@B@@B@BBFBB@B@@@DBB@B@BBBBB@BAB@B@D@@BB@B@B@@@BBB@B@@@B@B@B@B@@B@@BA@@@@B@BAD@B@@@BAB@B@BBB@@@B@BAB@B@B@@BB@@BB@@@B@@AB@BA@@B@F@BAD@BAB@B@BAB@B@B@@BDB@@BDD@@@B@B@BAD@BAB@B@DAB@BAB@B@B@@BB@B@BA@@@@@CBA@@BABADA@@B@DBBB@@DDB@BBB@B@@FJ@BDBBBD@DB@@B@BBDD@B@BBB@B@@@BBDDD@@@DB@BDB@BBBBBB@@BBBBB@B@BBD@B@B@@@B@@@B@B@@@@@BB@BB@@B@B@B@D@B@@@B@B@B@@BB@BBBABAB@@@BB@@B@@@D@B@BB@@B@BAB@B@@@DBD@B@D@BBB@@@BBBFJ@B@B@B@@@D@@BD@B@D@BBBA@AB@B@BABABAB@BAB@BABABA@AB@B@B@BA@@BA@@@@B@@@@@BA@@@ABABAB@B@B@@@@ABAFAB@B@B@B@@ABABABABA@@BA@@D@B@D@B@D@B@DAB@B@BBB@B@BB@@BB@DBBBBBDFBB@B@B@B@BABCBAB@B@B@B@BDDBFBDDDBFB@@BABBBB@DBDBB@B@D@NDNBVBNFPDPPNLHHDDHDFFBBPLNLRLLHLDPARIRINETIPETCBAL@RGHAL@HDJHBBRHH@NDLDPBRDP@PANAREHAJ@LDNHNJHBD@DCBEAKBKBEFGJCPDFBLB@@@@BBPFFBB@@@@@NHRJPLLJHLRRRFPFRFPFJHJHJPFNDJFLHJHHDDDHHJHJFHFDDAHMNKPED@LCNIJS@K@EBQAQEWGOEOEQ@@@@CG^IN@N@B@P@VBJJJMBMBKRGNECSCMPKHELEFALEV@@KEMFM\\CJAGMAMCCCABEAC@EACBCFKDCHG@C@AECCAGCEAEAMBCACCAABC@EAE@GDA@EBI@E@CAEDEDCHEDADEJ@DAFBBGBEBI@EEICG@CBCDC@@DGHILAPERARCDBL@L@JGBCJCB@FAFAF@LFJFF@FABIS[IIBCBABCDE@A@@@G@I@MGEOEKOKOGQAGIB@A@@@@B@@@@A@@@@@A@@A@@@@@@@A@@@@@@A@@@@@@@@BA@@@@@@@@B@@@@@@@B@@@B@@@@A@@@@@A@@@@@@BA@@@@@@@@@@B@@@@@@@B@@@@A@@A@@@AA@@@@@@@@@@@@BA@@@@@@@@A@@A@@@@A@@@@A@@@A@@@@@@@@A@@@@A@@@@@@@@@AB@@@@A@@@@A@@@@@@@AB@@AB@BA@@@A@@@@@@@@@@A@@BA@@@@@AAA@A@@@AA@AA@@AAAA@@@@@B@@AB@@@@A@@@@B@@@@@@@BA@@@@AAA@@A@@@@A@@AB@@A@@@A@@@A@A@A@@@@AA@@A@@A@@@A@AB@@@@@@A@@BB@@B@@@@@B@@@B@@@@B@@@AB@@@B@@@B@@@@@@B@BB@@B@@@B@@@@@@@@@@@AB@@A@BB@@@@@@@@@@@@B@B@@@B@@@@@B@@@@@@@@@AB@@@@@@B@@@@B@@@@@@@@@@@@@@AB@@@@B@@B@@@BAB@@@@A@@@@BA@@@@B@@@@AB@@@B@@@@AB@@@@@B@@@@@@@@A@@@@B@B@@@@@BA@@@B@@B@@@@@@@B@@@@@@@@A@@@@@@@@BB@@B@@@@@@@B@@A@@@@@@@@@@@A@@@AB@@@@@BA@@@@@A@@@@@@@@@@B@@@@@@A@I@E@E@I@KEGIEIOIQCKCEAMGMKAMAGGOOKAAQCCEAMEEYIMISMIIOIOCSGOEQGGIMOEOCKGKU@MEEIKIMAMDIHOLKH@B@B@B@B@B@B@B@DABAD@BABAB@BA@A@A@ABABABC@ABA@ABABAB@@C@ABABA@C@C@C@C@CAEAE@E@EBA@CBABABABCBCBABEBEBABA@ABA@A@AAC@A@A@C@AB@@AB@B@BBD@B@B@B@B@BAB@BADABA@@BABABAB@@ABABABABAB@BA@@B@B@B@B@B@@@B@@AB@B@B@BABA@@BB@@BB@@B@D@@BB@BBB@B@B@B@BBB@@@BBB@BBB@B@B@BBD@DBB@DBB@@BBABB@@BBBB@@B@@@BAB@BB@BB@B@@@B@@@BA@@B@BA@@BBB@BBB@BDBBD@@@D@@BFBA@B@@@BDBDHDDBB@@@B@@@D@BBB@BAD@B@DAD@BCF@@@DAB@H@B@@CDCDA@EB@@CD@@A@ABCBCDABA@C@C@CAAAECC@AAEAA@AAA@A@@AA@G@CB@@ABCFGFC@CAE@CAIGEEIKACGCEACEACIGCAA@I@GDCD@HBFDBBJ@F@H@HAF@@@@A@@@IIEE@AA@CACBEAAACAE@CBIFCJALAFAFABGBCD@DBF@D@@@F@DCBEAACIAMBEACGAG@EAGAAABEAIGI@IO@C@CAECEACCECCEEAECEAC@A@EI@CACAEACACCLCFACAGEM@CDC@EBC@G@@FCHAHCDIBEAECCACGBCAECCECACDE@EDE@EH@DBFDDA@EEGEC@EBADAHFBC@COOEG@CAABE@ABIBAAA@A@@A@AC@C@A@@@@EGAEAAEAEEEKAC@B@BCFCBEAICAG@A@AB@@A@A@CAC@E"],encodeOffsets:[[106497,23281]]}},{type:"Feature",id:"532626",properties:{name:"丘北县",cp:[104.166587,24.051746],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@CAC@A@CC@@C@@A@A@AA@WMMGSCM@BARyDeBGPg@O@G@wEMKK@@@@IK@@@@GEISDQJOdSTSJQEQCMACCGEI@@IOIKIOCMCS@W@SDS@QBKBSJUJSBEB@@CBCF@RAHDD@BAC@@ABE@@A@EDAAC@CA@@@ADAJ@BABC@@AKC@CDA@C@@CAAEAACDEDEF@FBBBF@BADCD@BCBABAB@LS@C@M@ICIBMBGBICAE@GBGDKBIACCGIBGGCKCG@G@EBGH@HCJCJCHKLMFMBU@ODWACCCGCECGAEOGGCMKAECGAGGKIIOIEECCGIMQIGOCQGGEGKKKSIQKMCK@KBC@KDQFMBQDQFQDQ@EAC@CBCDABG@U@IAEAeSEEGCG@EDGBECIAAGECECK@M@[AC@IGAEDAHABKNGDC@G@IBKFEBCAGGAIAI@GC@CDI@AAAA@E@ICIGUSCCCICAC@CAAEBC@EIGAE@CDCNIDCBE@C@AEEKCMCKBEDGHEBC@CAE@C@OHOHEJCNCLGHEDCBMDKHS@QAQEKCMDQFOBMDI@EFAFALAJCLANCNGRGNGHIDKBMBMCMAOGKKQIQ@O@A@C@QDKBIDADAH@FDDFFFJDFFFDH@FAHEFAFEBKCGDE@GACBADGDCAICGBCDAFCLAFADCBABCBE@E@aKMGIKCAGECICKCEAACCEAG@EAE@GFMHMNCDGDKJC@IBGBEDE@I@ABEBADBDBDDH@DADCDEDEBGFCD@@CFAD\\R@JGJEHCDGAKGCAC@AB@@CDILEDCDGDGJGHEDGDIBQFAAE@@B@@@@BB@@@@B@B@BDDB@@@B@@@B@B@@BBFDBB@D@BAB@BABAB@B@DAB@BCDA@EDCBABABABAB@B@B@@ABAB@@AB@D@BABABA@ABAAABABA@AB@B@@@BBBAB@BCBCBABAB@@@BABADABCBCDCBABA@ABCDABA@@BAB@BAB@@ABA@@BABCD@@@BCBAB@@A@@BABB@@B@BBB@DBD@BABAB@B@BABBD@D@BAB@DA@@@ABBB@@AB@BABA@A@@B@DA@BB@@@B@@@BAB@B@B@D@BBBBDB@@BBB@B@B@@@DBDBBBB@BBDBDBDDBBBBBB@BB@B@@AB@B@@@BB@@BDBBBBDBBBBDDBBDBBBDBBB@@BBB@B@BBBBB@FBB@B@DBDBBBBBD@BBDBBBBDBB@@@B@BAB@B@BABAB@@AB@BBB@BBD@BBB@DB@@BBBBBBDBB@BBBBBBBBDBBBBDBDB@@BBB@DBB@B@@BB@FBB@DBDBB@FDDDDBD@BBB@BBB@@@D@B@D@B@BADAD@BAD@B@B@D@@@B@@@BBBB@BB@@@BD@DBB@B@@@DBB@B@B@B@BBB@B@D@BBB@@@@BBBAB@@@BABABCDAB@DAB@D@D@BADBD@DAB@D@D@B@F@B@D@BBB@BBF@B@B@B@BBBBD@DBD@B@B@D@D@FBB@BBB@BAD@D@D@B@B@BAD@D@DBBBDDDBDF@BBDBD@DBB@DABBDA@@B@@@BBD@BDD@BBD@D@B@BCFAD@B@B@B@BBB@@BBB@B@DBDBF@FBDBDBFDB@DBB@@B@@@B@B@B@B@B@BBBD@BBDBBB@@BBBD@BABAD@B@B@B@B@D@BAB@B@B@B@@B@@@B@BD@D@B@B@BADAB@D@B@DB@@BBB@@@@BBDBD@DBD@FBDBDDDBDBBBBD@B@D@BA@@BBBB@BDDDBDBD@B@B@@@@BBBBB@@@@@BB@@AB@B@@BDBB@B@D@@@DB@@BBBFBBBBDBB@D@BBBBBBDBD@BBDBBBBD@B@H@B@D@DBDBB@D@B@BB@@BB@D@BBDBBBBBBDBBBB@BBDBB@BDB@DBD@DAB@B@@BFDBB@B@BBBB@@DADBB@BBBDBBB@BBBDDBBFFHHBB@DBDBBBBDBBBBBBDBDBDDBBBBDDDDDDBFBD@DDBB@@DBDBDBBDD@DBB@B@BADAF@B@FADADAFAD@D@BBBB@@BB@@DFDF@@BBDDDBDBB@B@B@D@D@B@D@DAFADAFCB@FADAB@D@BABCBC@@BABCDADABAB@BCBCBCBCBABAD@D@F@F@BBB@DBFDDBDBDBFBFDFBDBD@@BF@DBDBD@B@B@DBFBDDFBDDB@DBFBDBFBD@FBB@FBB@BBD@BBDBDDBBBBFAHC@EBEJ@`LH@B@D@DBDBFBB@FABC@ABABAD@@AFEDCFEFENWHMHEHCDCDGHIFEDE@C@CQWCCEAIG@@@E@C@C@CAC@ADCBCDABAD@BDBDBBFBDBB@BC@CD@H@FBDAD@DCDABCDAB@BBBBBDD@BBD@BA@ADBBFFHHBJ@JCJGFCLMJCHCF@DFHHBBFBD@D@FADADBDBDBDDBDD@RDL@HD@F@F@FHDJFPBJCLBJBP@H@HBJDL@H@B@H@LJ@H@HCDGJ@@@BAB@DABAB@BBBD@D@BBDB@D@D@BAD@D@BBBDBF@D@DBFBFBDDDBBBB@D@FBBBD@DBFBDBDBDD@@DBD@D@D@B@@ABCBAB@FBFBHBFBF@F@F@HBF@FBF@DAFADADABAF@F@DADAF@@BBFCHCHAF@FBDDBFAPCJ@J@LBDDDFFRFFBDF@@@BA@AAC@A@A@C@A@CBABCBABABA@C@@BC@CBA@AAC@AA@@ACA"],encodeOffsets:[[107080,25030]]}},{type:"Feature",id:"532627",properties:{name:"广南县",cp:[105.055107,24.046386],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@BA@A@CBA@@BA@ABC@A@ABC@A@C@A@ABC@ABA@ABCB@DAB@BBB@DBBBDBDBB@D@D@B@D@BAB@@@@AB@F@B@D@HBD@F@BA@A@CBCB@B@FADSDGDC@AAAMECAEKCEEKAABC@@@@D@D@JAFCJCB@DAD@FAJAB@LGPKJCFGLKJIJKJILEJINCH@FBLFFHFLBLALAVCLABAR@BCJEPCPERANAN@F@DBBDD@BBF@@BBB@BBBBDLBFBDB@BBF@D@JFD@FCDADADADAB@HEHADADBBFA\\@FDBBBD@HBHBF@BADABABABCBE@@DAJGFCRARALBN@PBRDJBJAJCJAH@PGVGRAD@JAPENAJAJCJGBAFCFGJIJCRAPDPDL@RB@@L@NFNHHHDHHJLFNFFBVBPBJBN@FAB@BC@C@CBE@@@@@@@@B@@@@@B@@AB@@A@@@@B@@@@@@B@@@@BA@@@@AA@@@@B@@@@@@@@@@BB@@@@@@@@A@@@@@@@@@@B@@@@@BA@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@A@@@@B@@@@@@@@@@B@@B@@@@@@@B@@@@B@@@@B@@@@@B@@A@@@@@A@@@@@@@@@@@@@@@@@@B@@@@A@@B@@@@@@B@@B@@@@@@A@@B@@@@@@@B@@@BA@@BA@@@@@@B@@@B@@@@AB@@A@@@@B@@@@@@@@@B@@A@@@@@@@@@@B@@@@@B@@AB@@@@@@@@A@@@@@@@@@@BA@@@B@@@@@@B@@A@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@B@@@@@B@@@@@@@B@@A@@@@BA@@@@B@@@@@@@B@@@@A@@@@@@B@@@BA@@@@B@@@@@@@@@B@@A@@@@B@@@@@@@B@@@B@@@@@B@B@@ABA@A@KEMEMAIBIHKJGJANDVDHBNAHEFCLGNKLGLGNE@@JEJKFGBG@A@K@@CECCAACEC@GCGEEGIKAGDEFCBG@CAAE@G@EBC@IM@@@CBAD@H@DAHIJKFEDCAE@AAA@@A@AA@C@ADAD@H@JBH@DADCFCBGDM@ILYFGDADABBDBDDB@F@DBF@B@B@BABA@AAEAABCBCBADFD@DABA@C@GAGCK@G@AAAAAA@@IACAA@@CAC@AA@I@KCCEAEAG@C@AACCCCCB@F@HADABABG@AAACAACBABCBCAABC@@A@CAACBADC@ABAC@C@CAEEGAEBCD@D@B@BC@EAAAAA@@C@ADC@A@@@CACBCFEJCDCDEHUDKJEHGHGFCHEHADADCBEBEDCFEJALAHCDAFGBCBADADADBDBBB@BHADAH@B@FBF@DBB@BDFLFDD@@B@D@DCBCBE@CDCBAF@BCBC@ABABED@N@BD@H@FADAD@DFDJDFDFDBDADAF@H@JFJDB@D@B@FEJKBC@A@CBCDCBA@C@ADABBBBBA@BD@JEDC@IHCF@H@F@JMHAFBD@D@BABEBCFGD@DCD@BAF@DBD@DABGFMDIFIHEFEPCPCNALAH@@@FCDAFAFBF@F@FCFADCBADAD@F@BABAFCJ@FAB@DADBBAHEFCFCDCBCDCBCBA@E@A@@BEAC@GBCBECO@KIIBMBKBADGFGF@F@DBHBL@H@DAHABABA@C@A@A@AFEFILIBABEBCBADAB@DAB@@A@AAGACA@@AEAQEECGIAAEAECACAA@C@A@C@AA@A@C@EAG@C@E@A@C@IDE@EEIEK@GAICUEIGECCGAE@CDA@EBADAJABA@ABAD@FBFABABA@AAEAEBGBA@CFCFCBC@EBADCD@FBXHNBF@DAFCDCBA@A@AAA@CBCDC@A@@@AAAAAACBAHCB@@C@C@CCC@A@C@A@ABCB@BADAFAF@DADABCBA@A@ACAGACAGGGE@EBABCLEFEDGB@B@D@DBD@BABCBADADABAB@B@BABA@C@@B@DD@D@DDBD@BADCHBB@BAB@@CEGBCBA@AACAAAC@A@CBEFCBADADBD@D@BADCHCFAB@BBB@D@B@B@@ABC@C@CACACAAAACAC@A@E@E@CACAECE@CAwCOAIAEG@@EAAAACAA@ABCBADABAB@BA@A@A@AGEIQGECCAE@GBG@AEAACAEAAAAICCC@A@I@KBIAE@EAAACACA@AAEAEAGCIAC@G@CAAABE@EECAACBABABO@C@ABADAD@BADABC@A@IDC@A@A@A@@AABCA@ACA@CDEBCBEEEC@E@EBC@DE@ECAE@@AACA@AAGCECG@EB@@EBEDABCBA@ECCCACCAGBAFEFIDCBA@EBEDIFEBEDCBCHGDEJ@@ODGDGBGFKBMACA@CBEFGDG@IBKCO@M@IBKBIDK@KGAKCKAICQCQBGDKHGJEJEJAFABIFEDEFMDOBIBGAGEGGEQAK@KBGBGDMHMHIN[@CDA@ABA@GEI@@QCKFCHOHKFWHSBSHQFKLIJKBIDQAQCM@CBSFUDMDKBOJIFEBGAMMGO@IBK@M@ECKEACBKDCBIDCBKDODMFOFIDSJMLOJKBG@GEEECIIK@@C@CBADBF@DABOBCBCDC@AAGGAAEAA@BEGDABADAD@@CBABEDADCBABAAABAB@FBH@BAD@BAB@BEFABCBC@A@EB@@GBMFSBUDIDSHIJKLSHOBUDYBSJKHGNILADEBEEEEAI@IGEQ@IBKAKCGCEICEEGCECEGBEBIFIFIPEFADMJGPERETCPDL@BFLHLJLDJEJEFI@MAM@GFA@EHCLEN@NAPDJDPDDDBHFDF@BB@BDBF@FBN@DDDDDH@NDFBDDBF@FABEB@@GB@DBFJFBDDHAHEDIDM@ICGCEBGHCAEEC@C@@HAFCDEDGBGDEFA@OLABBDDBEJAFG@ICC@CDAFDHDBDF@FALBFC@CACAKAC@CFEDCBABEFCHAJCJCNAJ@DCDEFGBGBA@GBA@G@I@KAKGKCG@EBAD@@IHAFCH@JDRDFJDLDNDHJFHBJCJBLDHANEJIFKBAHHJDDJBLAHCHAF@DBAJAHANDJ@J@N@DKTA@ABABADC@CDABE@AAEAE@CFCFBDFBBB@DD@B@DCD@BL@@ADABI@CB@B@@DBD@BBFCB@@@AF@BD@ABC@GCQBE@AD@DA@AFITIVATAL@RCT@T@XDTDNJPJLJP@@FJDHBDDNFRIRSTcTIPCRJTHF@@@@JL@@@@LLFN@x@H@POhAHCfQzAB@DNHTNNXB@@B@B@BD@@@DDB@D@DBB@"],encodeOffsets:[[107080,25030]]}},{type:"Feature",id:"532628",properties:{name:"富宁县",cp:[105.630999,23.625283],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@A@@B@A@ACAA@GSBGBGNIDG@OHKHGTAROREPEHGHGHGBEDIFIHELEN@JALCHILKHGBG@EECKIEMCKDIJOXGLI@A@CBEDABEBABCBCD@BA@AH@@@BCDABCDCD@BA@@BABCD@BA@AB@BA@@B@@AB@@@BA@@B@@AB@@AB@D@B@B@B@@@@@BAB@B@@@@@B@B@B@B@BBB@@@B@BABBB@B@BABAB@BABAB@BA@ABCBABABABA@ABABA@ADCB@BA@@@AB@@A@@BABAB@DAB@B@BA@AB@@@BA@A@A@@@CA@AA@ACAAAA@ACAAA@@AAAAA@A@A@A@@@@@E@MBUBODMFCLEJCP@F@BLN@NADJFH@L@FDCDINEA@EI@YDI@A@ABEBA@A@EHQLGPDNBH@@JHLDHD@D@@ADEDE@CBE@EDEHAB@D@JRD@@FJ@HAB@BCB@DM\\GJGNCNAHAH@LBLFRHHHFHBJAPANCFEFCJEBABEFIFIHILGHCRARDJDLBLDHB@LCLAJAL@J@NDPAL@JCHEHAF@DDBNBLAHEHAHCPC@@FIHCDGDAFCFAJEFCFAB@DAJCFEBEHADBBDDDFDB@DABAFCFA@@FAH@FDHDBBB@BD@BF@DB@FCFD@FAF@D@FFAFADCF@DDB@BDBBA@BB@B@B@D@JCB@D@BABC@ABCBCBAD@P@BABADABBFD@FAFBBDBH@D@JBHDFBFBBBB@BDBDBB@FBFAJ@L@J@BDDJDBBBBBFBDFB@BAH@HBFDDHFJRHF@B@B@BABA@ABCBABAD@BBBBDBBFB@@FHJBPBxDDBF@FDDBDBF@F@B@D@DBBBBBBDBD@D@DAD@BA@A@C@A@AAA@EBGDCDABC@C@CACBABEDAF@D@BBDBBBD@BABADFH@DA@ABA@GACDABC@CA@C@CCCA@@@@DABABA@A@ABCBCBABADABC@CAC@A@A@CHEFKFADAB@FHFHHDBHBDB@B@BABADCBCBE@EBCBABA@AD@B@B@D@BDD@D@D@DA@GDABBDBBBB@B@@@BCDAD@DBB@B@BABCDEDCBE@MAWGEAC@CDAB@FADEDED@DABAHBFBF@BABABEBEAC@AB@BABIBCBAB@FCB@DBFDHFDJHVFJDHBL@JFFFF@JCD@B@F@D@H@FBD@B@B@@B@D@B@DBBBDFDFBBBHJFDRFFB@BB@BDBH@B@BA@CBA@CBABADAFABKJEJEF@B@B@B@DABABGBCBG@K@GACAE@E@EHCHABALANJJ@LDPAFAD@HBDAF@@@B@FABADCDADCDEDEDGFABBBBBDFDDB@F@D@F@BB@B@D@F@F@@ABADEJ@BAB@@BB@@@@@@BB@B@@@@@@BD@BBBB@@B@@@B@D@D@F@B@BB@@BBBDFDDBB@B@B@B@@BB@BBB@H@B@B@B@B@B@@@BA@@B@@@B@D@@@B@D@B@@@@BB@@@@@B@B@B@@@@@BBD@BBB@@@B@@@@@BB@@@@B@@B@@BBB@@BBBBBBB@BB@BB@BBBB@@BB@@@B@BAB@@CD@BAB@D@@@B@D@BBBB@@BB@B@B@B@B@B@B@BA@@D@@@BAB@BABABABA@@B@B@@@B@B@@@BBB@BBBBBBB@BBB@BBB@@@B@B@B@B@BBB@@@B@@@B@BB@@B@@@B@@@B@F@B@B@B@@@B@BAB@DA@@B@F@B@B@@@@@BDBB@BB@@@B@BB@@B@@B@BB@@B@@BBB@B@B@B@DAB@B@BAB@B@@@D@B@B@@AB@@@B@@AB@B@@@B@@BB@B@@@B@@@BCBA@A@@B@B@B@@AB@BAB@DCBA@A@A@A@A@@AA@A@AB@@A@A@C@ADC@C@@CI@C@A@@DADA@@B@B@@B@G@IBQFQHSBCAGAEAGDQBADGFIDCFAFBDHFBJ@FAHBFBJH@BB@FHLHDJFLLHHBJANCPAHCJCN@LFJFJFLFB@FFP@JALANCJELGFKCIIIEE@@ECCEAGAGDEFCHEF@PHFBLAHDFBD@JCDAD@JDFBDDDFBHDHFJ@DAF@FDB@@HAFBFEFEDALAH@BCFED@FBFCDCDAHBFDFHBJFL@BAB@FBFDH@DEFBFAJAL@@ALAJDL@@HLFHJJHFF@DBR@FAJENELGH@FAFA@@NDJDDFD@PDNDJFHBH@BAB@@@AAA@EAA@C@CAAA@A@A@ABABABADADCBA@ABC@ABCBCBADCBAFBF@DBDDD@D@@@BA@A@@@A@@@@@@@@B@@@@@B@D@B@BA@@@A@@B@@A@@@AB@@@@AB@@@B@@@B@@AB@@@B@@@@AB@@@B@@A@@B@@AB@@@B@@@B@@@@@B@@@B@@AB@@@B@@@@@BADI@@DO@QA@@A@A@A@ABC@A@EAE@A@@BA@A@A@@@A@@BA@@BEDCNGJEFG@EFCF@B@HF@@F@DADCHGBCBE@CGY@C@A@@@A@A@AA@A@@AABC@A@A@AA@@@A@@ACAAAA@@@A@@B@BAB@BABAB@@AB@@@B@@BB@@@B@B@B@B@B@B@BA@@BA@A@@B@@A@@BCBA@@@@B@@@BA@@B@BCBA@ABABA@A@@@A@@@AB@B@BA@@BCHIHGJCFEFGDIDIDM@MDIBE@@@AB@D@B@B@@@@A@@@ABABA@@BA@@BABADABA@ABABAB@@A@@BC@A@C@A@AB@@AB@@@B@@AA@@A@@AA@@A@@A@A@@@A@@DELILENCL@JALCFEDE@AAKIEK@IBIAGAJMJMDIPO@G@MCCOGKEMBOCKACAQMGGCECG@IDMBODO@MBSBCBIJQJKFK@Q@KEKCGCQAO@O@SEOEQEMAEEMGOIK@@GMGMAIBKDIDGHANIBAFEBA@ABGCECAGCOEICGIGOEMCIAAMKM@MGEEEKCIGMAAAIACACEEICIAMAMAICGAIGKGECEKAAA@@AAAAA@CIKIGKGC@E@EBIL@FDFDJJHDJ@FAHCDEFKHKBODKBI@CBIDKFOJIJK@IAKGECCCCAG@GFEFCJALEJGHKFKBK@IAC@O@OAOEQGSICAEEIGCIAI@G@E@M@GAKCGGEI@MAO@O@MCMCMEEEAICCEEEIIKGIIGKGIEMKMKGEIGGCIBCBCBID@@CDC@A@EBA@CB@@ACEGAAAACBKBGBKBI@IAEAGAAA@@NEB@D@FBJALEFEJCBCDAB@@BB@B@B@FEFC@C@E@C@ABADAD@HAFAF@DADCBA@ABCAAAAAACACEAACAC@A@C@GDE@CA@AAA@C@CBABADAF@DBF@FCHIFABCBAB@DCFE@CBA@CAAAAC@AACAE@EDA@ABAAEGAAC@CBGDCD@B@D@DFJBBAD@BIFABAFC@ABC@C@GAA@E@EAA@@E@E@AAAA@CACCC@EBCAIEEGCCECC@CBABA@@@AA@AACCA@@@A@CBABAB@@A@@@AAAA@A@KBA@GAC@C@E@CAA@AE@@AAAAA@AACIKOGGEEGIGOCOAKEGOEKEOAMCGGAG@KFODI@E@AB@BA@@BA@@BABA@@@ABAB@@AB@BA@AB@@A@@BA@@@@@@@AB@@@@@@A@@@A@@@A@@BA@@@AB@@A@@BAB@@@@@BABAB@CAA@AACA@C"],encodeOffsets:[[108078,23750]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("西双版纳傣族自治州",{type:"FeatureCollection",features:[{type:"Feature",id:"532801",properties:{name:"景洪市",cp:[100.799545,22.011928],childNum:1},geometry:{type:"Polygon",coordinates:["@@OIOCQ@M@OAOMKOQQMIQCSDSGSGUO@@OGUEUKKESCUBSLKHKJGHKD_EYKKES@KHIHOXGXENO`OLCREJGFCDHLLHFBBB@D@DAHEL@B@DBBD@H@DAD@BBDDNJF@FALEDA@@D@BJDFTP@BBB@@@@B@@B@@B@@@B@@@BBD@BBBBB@DB@@@@@@BB@B@@BDB@BB@@B@BB@BB@BBB@@@@B@BB@FLBLDJB@@@@@B@B@@@@AB@@AB@BB@@@@BA@@B@@@@@@B@BA@@B@@@@@B@@@@B@BBB@D@@@@@@@@@@@B@B@@BB@BB@@A@@BA@@@@@AB@A@@A@C@A@A@A@@BCBA@@BA@@B@@CB@@@B@B@BA@@@BD@B@B@B@@@B@B@BAB@B@@@BA@@@@B@BAD@@@BBBABBB@BB@@B@@ABCJGJA@AB@D@B@BBBFAD@DB@D@F@BFBHDJZBBFBRJF@FCNCFAFBBDBHBDJL@@LFAFDJCLKJMPBJAFEjCNER@B@DFBLCB@FBFFLRFFFBJBD@DAH@FBHFDBF@DAFADCFAJ@XCB@B@BDCR@HIN@B@PBH@BGVDFBBBBDBFLB@DDF@F@FBDDFP@BBBBB@B@BA@@BA@AB@@@@@DA@@@AD@B@BA@@DA@@B@@@@@BAB@BAB@@@@@B@BA@@@@B@@ABA@@@@@A@@BA@@B@@@BABBDHDBBDB@B@DLHBB@B@D@@CBA@@B@@@B@BBB@@BB@BB@B@BBBB@@@@B@@AB@B@B@B@@@B@@@@@DB@@@@@@B@@AB@@@D@@B@@B@D@B@@@@@BB@@BBB@BB@@BBB@B@@BBFAH@LADADCBA@CBCBABAHAPADGB[DEBCBAB@B@BABADADAB@BABCR@D@B@D@BDBBBBAB@@AF@B@F@@AB@BBB@F@B@B@DD@BBB@BB@B@B@@@B@@BB@B@@@@@@B@BEDEJEHAHAPBJDJDJBHHDZ@NBHBGFIJAHCHCDAFAFEJA@MAG@CJADBD@DDFAL@JIZAHBLBF@F@FBDG@A@CDE@ABEFB@@BFHBF@BCDEDAD@BBBNJDH@HFRDBFBL@B@B@@B@@@@@@@B@@@B@@@BA@A@@@ABA@AB@@@B@@@BB@A@@B@@A@@B@B@B@BA@@BA@@B@@@DAB@B@@A@AD@@A@@B@@@BA@@@A@@@@@@B@@@@@@C@@@@D@@AB@@@BA@@@CD@BADBDFFFBHHDFHHDDFFBB@DGJAB@DAF@F@B@D@FA@AJAHAH@D@F@B@@A@CBABC@KDA@E@E@@@C@IDGBC@IDCDCF@@ADCF@DAD@D@FBJ@D@DBBBDBBBDDDBDDBB@FBLJBBF@B@D@JGB@LCB@D@D@D@DBBBBB@B@DADCDADGD@@ABABAD@FABCDEFCFAFEFILAB@D@DADABGLCLADEDGFA@A@IFKHCDCBAFIPKJGFIHCDEFIF@@CBGFABAB@F@DAD@BBDBFBBBDBDBDDBHDDBBBBBJF@@BABA@@@AB@@@AA@@@@@@@@BA@@A@@@@A@A@@B@@@BAB@@@@@@A@@@AB@@AD@@@@C@@B@@@B@BA@@B@B@B@@AB@B@@@B@B@@@D@@@@BB@@BDB@@DADAD@BAJHHJDHAHCDCFCH@JDHDHDJDHHBJ@DBB@DADBB@BBFBB@D@D@B@B@BBB@JBB@HF@FCNBJBLBBF@L@FDLDF@JAJCJCFAL@JDFDDFF@HAH@FDFHDFDDBB@H@JBBDBDBBDBHBDLJ@@@DADBB@BD@BAB@@@BBBB@@B@B@@@@@@B@B@@@@B@@@@@B@@B@@@@BB@@@B@@@BB@BAB@@@BBB@F@B@@@@@@@ABA@A@@@@B@@B@B@DAB@@B@@@@@BBA@@@@@@B@@@@BD@@@@B@@B@@@@A@@B@@B@@D@@A@@BA@@@@B@BB@@BBBD@@@@@@@@@ABA@@BA@@BB@@AB@@@@B@@@B@DAB@@@AB@@B@B@B@@BD@@@@BB@B@D@@@@A@@FA@@DBB@DA@AB@@B@BBB@@@@B@B@@@BBB@D@@@CD@@@BB@@@BA@BAB@@@@@B@@@B@@@BA@@@@@@@@B@@@@@@A@@@@B@BB@B@@@@@AB@@@@@B@@@@B@@@@@@@@B@@A@@@AB@@@@@B@@B@@@@@@@@AB@@@B@@@@@@@BABB@@BAB@@@@A@A@@@@B@@BB@@AA@BA@@DB@@@@@BBA@A@@@@@AB@@@@@@B@B@@@@B@@@B@@BAB@@B@@AB@B@@@B@@@@@BA@@@A@@B@@@BB@A@@B@@@@@B@@@@@B@D@B@@A@@BA@@@ABA@@@B@@B@@AB@D@B@BAB@BA@@B@@@@@@@B@D@B@BB@@DBB@B@B@@@B@D@@@HEDKFE@ADCFEDEJKBOBEAEBGBEBALAFELIHEH@H@J@B@FBJDFHTLJHHH\\PBBF@D@D@FBD@XABAF@HBFBFD@B@BABEDAFCD@DAD@B@DB@DFBB@FBD@@B@@A@CBABAB@@@B@@B@@@@B@B@B@BABA@@AC@@BAB@DCB@@@B@@B@@B@BAB@@@B@@@@@@B@@@@@@@BB@BDB@B@BBB@B@@BBA@@B@BF@BB@@@@@AB@B@@@BAB@@BB@@B@B@B@@@@BB@@@@@@BB@BDB@@A@@B@@@@@BAB@@@@B@@@@BB@A@@@AB@BBB@BABERIXOr[JITGRCVCPA`FTBJ@VBVDLCLGBAJILGNCP@J@PDPDTDB@@BB@B@NDNAVCL@JCPCTEP@RJRJPLTJHD@@BB@BB@@B@@B@B@BBB@@@B@D@B@DCB@B@@@B@@B@@BB@@B@FAB@DB@@@@@BBB@BD@B@B@B@B@DBBBDDB@@BBA@AB@BB@@@@@BBB@@BBD@DBB@BBB@BB@BB@@AB@DBBBDDB@B@BA@@@A@A@@BABAB@B@B@@@@B@B@BB@@BB@B@BAB@@@B@@@BA@@@C@@@A@@B@@DDB@@@DBBBB@B@B@@B@BA@@@BBBBBB@B@DBB@BAB@BA@BD@@BB@B@DABA@AB@B@@BBBDDBB@@BCBABABAB@@A@@@@@AA@@AA@@@@A@C@@@ABA@@B@DEB@@A@@@@AA@@@C@A@AA@AC@A@@AC@AB@@ABC@A@@BAB@@A@@@A@A@A@ABABA@A@@BA@@@@BA@AB@@ABA@A@@BC@A@A@@BAB@@@DA@BB@BBBBBBB@@AB@@A@@@AAC@A@AA@BA@ABABABCB@@ABE@A@A@A@@@ADABABA@A@A@A@A@AAC@@AA@AB@@AB@@A@A@EAAB@@A@ABC@A@@B@@ABA@A@@BA@ABABABCBADEB@@A@@BC@CBABC@A@A@@BC@A@A@ABA@A@@BC@@@A@@@@@A@A@ABA@AA@@A@AAAAA@@@AB@@AB@@@@@B@@@@@@A@CBA@@DCBCBABAB@BA@AB@@AB@BA@@BA@C@A@A@A@@@ABC@@@ABA@A@@BCBCBA@A@A@A@A@A@A@@BA@A@A@@ACCE@AA@@AA@A@A@A@A@@@@@@CA@@AC@@@AAA@AAA@@@CAC@@AA@@A@@AA@AA@AAC@@@CAC@A@@A@@A@@A@@@AAAA@AA@@A@AAA@A@A@AA@@A@@AA@@@@AA@A@AAA@A@AA@@@A@@@ABA@A@@@A@@AAAA@@A@AB@BA@E@A@C@C@A@C@@AA@@A@A@A@AA@@ACA@AA@A@@BA@@BA@A@AA@@@@@AA@@BA@A@A@@@ABA@CBAB@@A@@@A@@@ABA@E@A@C@AAA@@@A@A@C@ABA@@@A@@@AAEEEEA@A@AACBC@A@@@EBA@A@CACAC@GAC@C@CACA@@AACAA@A@A@A@A@A@@BC@A@A@A@@@AE@@CCA@@@@@ABABAB@@A@A@@AA@@AAAAA@@A@AAA@AAC@A@@AA@AA@@AA@AA@@AA@A@A@A@@AAA@@@@A@A@@@CCAAA@@@@A@@A@AAA@@@AAA@AAA@AAECA@AAA@AAAAA@C@C@A@@@AAAA@@@ABA@@DA@@BA@@@A@A@AAAA@CAA@@BA@A@@A@@AA@@@A@@AAA@@AA@@A@@AA@@CC@AACAA@C@@@A@@@A@@@@CC@@@AAA@@AA@@A@A@@@@A@A@@@@@A@@@A@@@@AAA@@A@@AC@@ACCA@@AAAAA@CA@@@A@@@A@A@CBCBA@@BA@A@@AA@A@C@@AA@AACCACCCAC@AAA@ABC@ABA@C@AAA@@AA@@AC@@ACAC@CAAAAAA@A@CAAAA@CAA@C@AAAACA@AAC@AA@@AAA@A@A@ABG@CB@AA@A@@@AAA@AA@@AA@@@C@@AA@@CAA@@@AA@A@A@A@A@EAAAA@A@@@A@@@A@AA@@AA@@A@A@A@@@AA@@CC@A@@AABC@A@@@CBA@@@A@A@A@@AAA@@@@AA@@A@A@@@A@A@@AA@@A@@@@AAEAA@@AAA@E@@AA@AAAAAAA@@@CAAC@@@AAA@@AA@AA@A@A@A@@@CBC@@BA@@@A@A@@@@A@@@A@@@@CA@@CC@A@@@@@A@@AAA@@A@A@@@AA@@AACAA@A@CACAAAAAAAAA@@AAA@A@CEGAAC@CAC@SBG@E@GAICCCCECCACAU@CCCCAC@I@GBEAC@AA@@BA@@DCH@DCDALAF@H@BCBEDODGDAFAPDHBBIAO@E@EAECGGCAEDEHCDAFCFCHCHAJAFADEDCDEHAH@DCHEHEAGDEHABEDIFEFEFDDDDBD@BABEDCF@F@BCFCJABEBIJCLCLKPEBABADKBABADEAKA@CCAAKKAA@AAAC@AC@CCGMGACCBABABEBA@C@AAAAAEAA@@C@CFABCEAACBCBCBADCAE@G@AAACDCACAAC@CAG@G@C@CA@C@CCGA@ECAGBAB@@@@@DA@@@AB@@@@@BB@@@A@ABA@@BBB@@@@@BB@@BAA@@AB@BA@A@B@@@B@@D@BB@@@@@AB@@@@BB@@B@@@B@@@@@@BABAB@BB@@@@DC@@BA@@B@@AA@@@A@@AA@B@AA@A@@A@@@@AA@A@@A@A@@B@B@B@@@@AB@@AB@@@@@@AB@CA@A@@@A@@@@@A@@@@A@@@@A@@ACAAAA@A@@@@@@A@A@A@@@@A@A@@@@A@@B@BAA@@@CA@A@@@AA@@@@AA@@@@A@@@@@@B@@A@AB@@@@@@A@A@@@A@@@BB@@@BABA@@@AB@@@@A@@@A@@AA@A@E@ABA@A@@B@@@@A@@@A@A@@@@@@BAB@@@@@@@@AA@@@AAACAAACCACAC@ABE@ED@B@BFBBBDBBD@B@B@B@BC@C@KAAC@G@CAACBCLCAC@AA@COAE@E@CDCDEFADCBCACAE@C@EBC@@@ADK@EBAFEB@B@AAAAEE@@@A@AAACAECEEAA@@@A@ABA@@B@BBBBBBB@B@B@DADCDADEDCDK@A@A@AACAAACCAAAEEAA@AAAA@@AAACAACA@AA@@EBE@A@@@C@CAC@A@C@AAC@C@GA@@C@@@CAA@A@C@A@CAA@AAAAA@@@AABB@@@BA@@BA@A@@@@B@@A@@@@AA@AAA@AA@@A@ABA@@@A@@@AAA@@@A@A@@@AB@@A@ABA@AB@@A@@@AAAA@@@@A@A@A@A@A@@@A@A@ABA@A@@@A@@@@AAA@@@@A@AA@@@@CA@AAA@A@@@A@@@A@@@A@@A@A@A@@@@AA@B@AA@@@@A@C@@@AA@@@AA@A@A@@A@@@@A@@@A@@@A@@@AA@@@CAAA@@ACAA@AAA@A@CAA@@A@@AA@@@A@@AAAAA@@A@AAA@@@@A@@@@B@@A@@@A@@@A@@ACC@AA@@@A@@@@@@A@@A@A@@AA@@AAA@A@A@A@A@A@@A@@AA@A@A@A@@A@@A@@@AA@A@@AA@@AAAAA@@AA@@@AB@AA@AA@@AAA@@@A@A@A@@@AAAA@@A@@A@@BA@AA@@@A@AAAA@@@AAA@A@A@@@A@A@A@A@@@@AAA@AAA@@A@@AB@@@@AB@@@@A@A@@@A@A@@AA@@@ABA@@AAAA@C@@@AA@@AA@@AAA@A@AB@@A@A@CAA@AAABA@A@A@A@A@AA@AA@@AAAA@AAA@A@A@@@@BA@@@A@AAAAA@A@A@@@@B@@AB@@A@A@A@AAA@A@AB@@A@@B@@A@@@@A@@AA@@AA@@A@A@@BC@ABA@@@A@A@C@A@A@AAA@A@@@AA@A@AA@@@A@@@A@@@@@@AAA@A@@A@@@@@A@@@A@@@A@@AA@@AAA@@A@@@A@@@AAAAC@A@A@@BA@@@A@AA@@@@A@BB@B@@@B@@A@AAA@A@C@A@@@@A@A@A@@BABA@AB@@@@AA@@A@@@@A@A@A@@@AB@@AB@BA@@B@@ABA@@@A@A@AAA@A@AB@@A@AAAA@@A@AAA@A@AAA@@@A@@BA@@BAB@BA@@@A@@@AAA@@A@A@@@@@A@@@@@@A@@@@AA@@@@@@@@@@@@A@@@@@@@@@A@@A@@@A@@@A@@A@@A@AA@@A@@A@@@@@@@A@@@@A@@@@@@A@@@@@A@@@@@@@@@@A@A@A@@@@@@@@@@@@@@@A@@@A@@@@@@@@@@@@A@A@@@@@@@@@@@@@@A@@@@BA@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@AB@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@AA@@@@@A@@@@@AA@@@@@@@@@@@@@@@@@A@@@@@@@@@A@@A@@@@@@@@@A@@@@BA@@@@A@@A@@A@@@@@@A@@@@B@@@@A@@A@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@A@B@@A@@@@@@@@@A@@@@A@@@@@A@@A@@@@@@@@A@@@A@@@@@A@@@@A@@@@@@@@@@@@@@@@A@@@@@@AB@@@@@@@@A@@@@A@@@AA@@@@A@@@@@@@A@@AA@@@A@@A@@@@@@A@@@A@@A@@A@@@@A@@@@A@@@@@@A@@A@@A@@@@A@@@@@@B@@AA@@@@@AA@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@BA@@B@@AA@@@@@@@@@AA@@A@@@@@@@A@@@@BA@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@A@@@A@@@@@@@AA@@@@@@A@@@@@A@@@@@A@@@@@@@@A@@A@@@@@@@@@@@@@@@A@@A@@@@A@@AA@@@@A@@A@@@@@@@B@@@@@AA@@A@@@A@@@@@@@A@@A@@@A@@AA@@A@@@AA@@@@@A@@A@@@A@@@A@@A@@A@@A@@@A@@@@@AA@@@@@A@@A@@@@@AA@@A@@A@@@@@@@A@@A@@@@@@MAIKEQES@@GUKSIGQESEWKQUIOISGO"],encodeOffsets:[[103149,22036]]}},{type:"Feature",id:"532823",properties:{name:"勐腊县",cp:[101.564635,21.459233],childNum:1},geometry:{type:"Polygon",coordinates:["@@KAQ@MGQIOKMIOIOI[GGCO@IAICKKKCGCEDGJKFKJKHIHIFIHILCHEDEBGBIDGFGH@JDFDLDJBPLLLPLLDLDPAPIJWHWHKFUNYROLE^GJOLMXEJQ\\IXENHJJBT@VA\\DHFJNBTAT@XCT@PJLRJRLHFDDBBFF@DIBKDGJCRAPDJFTGP@RWBOCSAaFGBKDI@A@C@ADCDAFAB@B@DBD@BBDBDDFDDBDDDB@FBB@DBD@D@F@D@D@DB@BBD@DBF@D@BADAB@DCDADABADADAD@D@BADAD@F@D@B@BBDBBBB@DABAD@B@DADABABABA@A@C@A@AD@B@F@H@D@B@DBDBD@D@BABABC@C@CAC@EBGBA@ABADCDCDCFAB@B@D@@@DAB@BCAA@CAA@CBABAB@BBD@DBH@DBDBH@D@FAD@D@FADAD@H@BADAB@BAD@DAD@B@F@B@BAB@BAB@D@B@D@D@B@B@DBB@BBDBB@BBDBBFFBDDB@@BB@@B@BBBBB@DBB@D@B@B@DB@@D@@@HBD@D@BBD@B@D@DBD@@@B@F@FA@@BBB@BDDBBB@BB@BB@BBBFFBBDBBDBBBD@B@B@BCLCDCFCBCDCBA@A@A@AAAAAAA@@@AB@B@B@@BBFFFDDBBB@B@B@@FFBBBBA@A@EFAB@FCL@B@@AD@F@DBFBDADCDEBCFCD@D@FBFDPB@@BBDKDADBDDBH@D@BB@L@DADA@A@A@C@AAACAAAEA@C@@FAF@BBDBDDDBBDBBB@B@@BB@@@@@@@@BA@A@@@@B@B@@@B@@@@@@AB@B@BAF@B@B@@BB@@@B@@@@@BA@@B@BA@A@@AA@@B@@@B@B@@@@@@@BAB@@@@A@@@@B@@@@@BB@@@@BB@@B@B@@D@@BB@A@AB@@@@@@B@B@@B@B@B@@@@@@@@BBBBBBD@@@B@@B@@@@@@B@@@@@B@@@BDBA@@B@@@@A@@BA@@B@@A@A@A@@@@B@BB@B@@B@@B@@@@BBBA@B@@BB@@@B@@BA@@@AB@@CD@@@@AAA@ABAB@@@@@@@A@@@AA@@A@@A@@B@@@@AAC@@@@A@@@A@BABA@@BB@AB@@AA@@@@A@AA@@AB@B@B@@AA@@@@A@@B@@CB@@@@A@ABBHFDB@DH@D@DDBD@H@H@DBD@BBBDCDBDBBH@F@DBBCDADADABBDFBADED@@@BBBFBBBBD@B@FABABADABDNHDH@DBDD@BB@BBBLLBBDDB@BLCFABABCLABABOFKLKDIDAJAFIBEDADE@E@CDAFABC@CACCECEFEFCJAFGBCFBHGFGFCDG@GBCFCDCFEBIBGBGDEDEDCBGDCFBFHDDHBF@F@FBPAJGAOCEBCBCHCPAFADG@E@KBCBCDG@CD@@AB@@BBD@FBHAJ@D@DBDD@DBVBDDDDFDDJDHBF@H@TAD@DBD@BBFH@D@BBB@BB@BBBBBBBBBD@D@BBBBD@BB@@B@@@B@BB@BB@@@B@@@@@BDD@@DB@@@@@B@@@B@@B@B@@@B@@AD@DA@@B@B@B@B@@BBB@@BB@B@@BDDB@@B@BBBBBBB@@BF@B@BB@@BBBF@B@@B@@@BB@@@B@B@@@B@BB@@B@@B@BB@@@B@B@B@@AB@D@@@BADBB@@@BDD@@BB@@B@B@B@@@BB@@BBB@@@B@@@B@B@BBFBB@B@B@B@B@@B@@BB@DB@@BD@@@B@@BB@@BBB@B@@@BBBA@@DAH@B@B@BBB@BB@@BBD@BDBBBBBD@B@DBB@BBDBB@B@BBBBDBD@DB@BD@@BB@@BB@BBD@B@BAD@BAB@BBD@DBDDDBBD@BBB@@@D@BBB@@@BAB@@ABAD@D@B@B@@@B@@DBB@BBBB@@DBBD@@BD@@@BB@BB@@@@@B@@@B@@@@@B@B@@B@B@@@BB@@BB@B@@DD@@@@@B@@@B@@@DBBBD@BDD@@BB@@@BB@@BB@BB@@@B@@BB@@@BB@B@@AB@DBB@BB@B@B@B@@AB@@CB@@AB@B@@BBBB@@B@D@D@B@BBBBB@BBB@FDBBB@BBB@BB@@B@BBB@@@@B@@B@BBDD@@B@B@@@@@BB@BB@B@B@B@@BB@@BBB@@BBB@@BB@D@BBB@BBB@@@BBBB@BB@@BB@B@@@BABABA@@@@B@DD@@BF@@B@B@B@D@@AB@B@B@B@B@B@DBBB@@DBDBD@D@HBD@DBDBB@B@FA@@B@D@DABBB@B@FFFFBB@@B@@@B@BAD@B@B@@@B@BBD@B@F@B@BA@@B@@@B@@@BADAB@BA@@B@B@B@@AB@@B@@@@BBB@B@@AB@@AB@B@@BDB@BB@@B@B@B@BB@@BD@B@D@D@B@F@B@@ABAB@@@BBBB@@@B@@@BAB@B@@@B@@BBB@B@BBB@B@@B@@B@@BB@@@BBB@B@B@BBB@@@BBB@BB@B@@@BB@@@@BB@D@DB@@D@BBB@@BBB@@@BB@@BD@DB@@B@BBB@BB@@D@@BB@@D@@@@B@B@B@B@B@@BB@@@PWPIHE@ABCDC@@D@D@B@FCB@B@BBB@BADA@A@AB@BAB@BBB@@@BABAAA@@CABA@@D@HBDDB@BA@@BA@@@A@CAC@A@CAGIKAEI_BGBCFAPB^LFBD@BABAJI@@F@BBDB@B@DBBBBH@D@BDDBDBFAF@FATAHAHEBAHAJ@H@AKWU@GCIGQCKCQMMMEOCYCQ@UHOECICIKUMW@MJGNKPUFOBKFIHKLIJKJOFMBI@G@OJMBIGGSASCIIAKHODKFMBEDMDKFMDIHIPKFMJOJOBKBKHIDGDMMK@E@SCQMOIEBGXANEJENCJGZIPUHCJDLDLDJFRAJEDK@GAKCIAQ@OBIHGHKJIB@RGPAHCHIBMAWBMJGTKVCN@FCDIAICIKQIOEQ@OBOCQIQCGIMAI@OBIHGHCNGJGDKDUAIBIBG@MAW@MDEFIDGDG@GB@FAJ@HBLDTBHE@M@KNGHCHADIAECICICICCGGEMIGEIMGQA]FSLSFOECK@IPKLC@GEOAEAKBIDKHKLCHIDKCMKMKGMEGGIGECAGFMDOBSBOBQ@QBODO@GKKIECKAeCSEOGKBOBOJ[FMFOJWLMJAFAFAHAJ@NDJCN@DAPYDGFIJGXONBLABK@KIMBOHUBMAGGAE@M@IHIHGGI@MBKQ@AEIAOI[IOKQIOQIQ@MAO@IBI@WBQDGJCJBRAHGFGFOHADGP@BGLKDM@MAOCOCOI_UGAGEKBOJGP@LJTPVFXBLGFOFMDSH[FUHUDQDMAUCYEMCUAQCSQQEWEK@O@O@YASB"],encodeOffsets:[[103836,21737]]}},{type:"Feature",id:"532822",properties:{name:"勐海县",cp:[100.452547,21.957353],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@A@@@@@@@@A@@@A@@A@@A@@@@A@@@@@@A@@@@@@A@@@@A@@@@@@@@@AA@@@A@@A@@@@@@A@@@@A@@A@@@@@@@@@@B@@@@A@@@@@@@@@@A@@@@@@@A@@@@@@A@@@@A@A@@@@@AC@@@@@@@A@@@@A@@@@@@@@@A@@@@@@A@@@@@@@@@@AA@@AA@@@A@@A@@@@AA@@@@@@@A@@A@@@A@@@@@@@A@@@@A@@A@@A@@@@@@@@A@@@@@A@@@@@@@@@AAA@@@@A@@@@@AA@@A@@AA@@@AA@A@@A@@@@@@@A@@@@A@@A@@@@@@@@@@@@AA@@@@@@A@@A@@@@@@@@A@@@@@@@AA@@@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@@AA@@@@@@@A@@@@@@AA@@@@B@@A@@@@@@@A@@A@@@@@@BA@@@@AA@@@@@@@A@@@@@A@@@@@A@A@@@@@A@@@@AA@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@A@@@@@@@AA@@@@@@B@@@@A@@A@@A@@@A@@B@@AA@@@@@@@A@@BA@@@@@@AA@@@@A@@A@@A@@@@@@@@@@AA@@@@@A@@@@@@A@@@@@A@@@@@A@@@AA@@@@@BA@@@@@@@@@A@@@@@@@@A@@A@@@@@@@@@A@@@@BA@@@@@@@@@A@@@@BA@@@@@@B@@@@A@@@@@@@@@A@@@@@@B@@@@@@AB@@@@A@@BA@@@@@C@@@@@@@A@@@@@@A@A@@@@@@AC@@@AA@@A@@@@A@@@AB@@@@A@@@@@@@@@AA@@@@@@@A@@B@@@A@@@@@A@@@@A@@@@@@@@@@BA@@@@@@@@@A@@A@@@A@@@@@A@@@@@@@@@@A@@@@@@@@@@B@@A@@A@@@@@A@@@@@@@A@@A@@@@@@@@@@@@@@@@A@@@@@@@@@A@@A@@@@@@A@@@@B@@A@@@@A@@@@@@@@@@@A@@@@@@A@@BA@@@@@@@@A@@A@@@@@@@@@@@@B@@@@A@@@@@A@@@@@A@@@@@@@@@@A@@@@@@@@@AAB@@@@@@@@@B@@@@A@@BA@@@@@AA@@@@@A@@@@@@@@A@@A@@@BA@@@@@A@A@@@@@@AA@@@@@@A@@@@@@@A@@@@@@@@@A@@A@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@@@@@@AA@@@@@@@@@AB@@@@@@@@@@@@@B@@@@@@@@A@A@@@AB@@@@A@@@@B@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@@@A@@@A@@@A@A@@@A@ABA@@B@D@@@BA@@@A@A@@@@@@B@@BBBBBB@B@BABABC@ABAB@B@B@BA@A@CBABCBEBABAB@D@D@B@BCDCBAB@DAB@@CDADA@@B@@BB@@@@ABA@ABA@A@AB@@ABA@@@A@A@@@A@@@CA@@A@@BCBCBEB@@A@@@A@A@AACAAE@@@A@A@A@@@@AA@AA@@@@ABC@@@@@@@@AAAA@@@A@@@@@@@@@A@A@@@A@@@@@@@A@@AA@A@A@@AA@@@A@AAA@C@AAA@@AAA@AA@@@AA@@@@BABABCBABA@ABCB@@ABA@ABABABABA@ABABABA@@@CBA@ABA@CBABCBA@A@C@ABC@ABCB@BABCB@B@BADADAD@BAB@B@B@D@D@B@@BD@BBB@BABBB@B@BAB@D@BAB@B@@ABAD@BAB@B@DBB@BBB@BBD@B@B@B@B@DBB@B@B@BAD@D@DADAB@BABABADABABA@ABABCBA@AB@BAB@B@B@B@B@D@B@DBBBB@DBB@BB@@BBBB@BBB@BBB@BBDB@BBBBBB@@@BBB@D@BBB@BBB@BB@B@BB@@B@@B@@BBB@B@B@B@B@B@B@D@BBBBDBD@BBBBBBB@BD@BBB@B@BB@B@BBBBBBB@BBB@BB@@BBBB@@BB@B@B@B@D@D@B@D@BAD@DAB@B@BBB@@BBB@@@B@B@B@D@@AB@BADAB@BA@@DAD@@@@@@ABA@@B@BA@AB@BABA@@BAB@@@BBB@@AB@@A@A@A@A@ABA@@B@BAB@B@BA@ADA@@@A@A@C@A@A@A@@@@B@BBB@BBB@@AB@@C@A@A@@BA@A@C@@BA@AB@BA@BB@BBBB@@B@@@@@BA@A@@@A@A@@AA@@@A@A@AB@B@@BB@@@B@@A@A@CAA@AAA@CB@@ADAB@BA@ABA@@AC@AAA@C@A@A@@A@@@A@A@A@@@A@@AAA@ABEBABAB@BAB@BA@ADA@C@CAAAAECC@A@AEGEAS@ICSKOKGMEAIDUFCFFPDJ@D@@C@ABCBA@@BA@AAA@CACAC@@AABA@A@ABA@ABAB@@ABE@ABA@ABAACBA@CBCBABCBABA@A@@B@@@BAD@@@BAB@@AB@BA@ABA@@BA@@B@BAB@B@@@B@B@D@@@D@DA@@B@BAB@BAB@BCDAD@@AD@BAB@B@@A@@B@B@@@BBB@@BB@BABAB@BAB@B@@AB@@@BAB@B@B@B@BAB@B@B@@@B@B@B@B@@AB@@AB@@AB@BA@@BAB@@ABABAB@@AB@BAB@B@D@@@@@BABBB@DAB@BBD@B@BAB@BAB@B@B@D@@AB@@CB@@@BAB@BABA@@BABABA@@BA@@B@BADAB@@AB@B@B@B@B@B@BAB@BAB@BAB@@BB@B@BBB@@BB@B@B@B@B@@@B@@AB@@@B@@@@@BBB@BBB@@@B@B@@@B@B@@@B@@AB@BA@@B@@@B@B@BBB@B@@@BAB@B@B@@@B@B@@@B@B@@@B@B@B@BB@@B@@DDBBBBBBB@BBB@B@@BBBB@@@BBBB@@BB@BBB@BBDBB@DBB@B@B@@@B@BA@@D@@ADAB@@@B@D@B@D@B@B@B@B@B@B@@AB@B@BABB@@B@@B@D@B@B@@@B@DBD@BBB@DBB@BBB@@BBB@BBBBB@@BBBB@BBB@B@B@B@B@B@BAB@B@BAB@B@@@B@B@B@@@B@@BBBBB@@BBB@DB@@B@B@BBB@BBB@BBD@@BB@@@@BB@B@B@BA@@B@@@@@BBB@DB@@BB@@BB@@DBB@@BDDB@@BB@BBB@B@B@@@B@@@@ABAB@BA@@BAB@BAB@B@@AB@DAD@B@@ABA@@B@BA@AB@B@@CD@BCBA@AB@@ABA@ABABCBAB@BADAB@BAB@@A@ABA@@B@B@@BB@B@@A@@BC@EB@BA@@D@B@@CF@B@B@BAB@B@BCF@B@B@BAF@B@BB@BBBBBBDBDBBBDB@BBB@DBD@B@@@@BB@B@@BAB@BF@F@DBB@DDBFDDBDFHPFdDN@B@HHLdChVXRj~FTDNJjDDDBBBCFCDADGJDDJ@F@DHAJBFHFH@BF@H@J@BBDH@FFNARQTAHCB@BA@@@@B@J@HABAHBDFDBX@NBJDFHDJBFFFL@H@L@@@FBDFBHOVAF@PBF@BNJJ@JCLAJBLFHADAJ@DALEH@F@LBFAFGHIDCDAD@@@AC@CdQF@PEPANINAHEVKLCDDFBHHHLNFDBHDHNBLAHAFDHHF@NCF@FBFFFDH@FBJAHAHBDLDEJ@D@FDD@DADA@ABFXDN@JAFEBKBMBIBDBD@DDB@DDDBDD@BGJAFBFFJBBFBH@DDFDDBB@B@@@FDDFDNFJDHFHDJHDJ@JCFAFIFKH@LBDF@HA@B@AD@D@BJBBFFDHBHLLDFCDADBFHLDH@N@J@B@H@HBJ@JB@@@@JBFDDBB@BABANAFADBFBH@D@FCHAD@DBB@BB@@B@D@BALBBAD@@AAAIEAAAACAGCCAACACACAAAEAC@ABC@C@EBABAHEDA@@JEFEDCJGHELIJOBEDADCLGJEB@B@HEFCBCDKHKBABC@C@CBAJKFEBEDEFEDCBA@EBCBABA@@HCBCDCBC@C@AAAAACAC@C@C@A@KDA@IHC@A@E@AAKIEAA@CAACCCACAAACAA@C@CAI@E@CBC@CDEBC@@DEDCJCD@HAJCD@@@F@F@B@LCD@BADAB@@@@A@E@CBGBGBIB@@E@C@A@EBE@CBAHI@CAAEECCGGCEGGEAEEACBC@ADC@@B@@A@@BA@@@C@@D@@@@@@@@A@@@@B@@@B@@A@@@AB@@@BCB@@@@ABA@C@@@AB@@AB@@A@A@A@AB@@@@AB@A@@A@@@A@@BAB@BA@@B@B@@A@@@A@@@A@@@@@@@AA@A@K@EACAEQ@GCGMIAA@ABCFCDC@AAEEG@AA@FEBAF@DCB@H@AC@E@EAEAKBGJY@IBKCE@CACBCDIH@NBB@FIBEBEDCDGBGJIHEGAMAY@GCAGCICIAIBOBGFGFIFC@A@A@@@@A@A@@AA@@@A@A@A@@AAA@ACCA@A@E@A@AAA@@BE@A@E@@BA@ABAACA@A@C@A@CDQBA@ABABCBCBA@A@ABADAFA\\CHABCBOBGBADADAB@DABCBC@KBGAE@AA@A@AA@@AAA@AA@@AA@@@@A@C@A@@@@AC@@@A@@BA@@@@@@@CA@@@@A@@@A@A@A@A@@BA@@@@@AAAAA@A@@AAA@@AA@A@A@@@AB@DA@@@C@AAAKG@C@ACAAAGCACBA@A@@@AB@@AB@@@@@B@BA@@@A@@B@@A@A@@@@BA@ABA@A@@@@@AB@@CB@@A@ABC@@B@@C@@@@BAB@@AB@@A@AAAAA@AEOCCEAE@E@CCA@EKCAAAAACEHU@AAG@O@AJM@GDQACA@A@WDI@EBCDEBCBE@CAGEEAG@CBC@IAEAEEKQEEEAA@KDEA@C@AFQDMFiBEAINOLIDKCIBEKE@@IKACAGACEAEBMDEDE@QIEAAAIYGCEA@A@E@CCAC@EBAA@A@A@CBAB@HIDIBA@@@AA@@AAABAAA@A@@BC@A@A@@B@@A@@@ABA@A@A@A@@@A@A@AAC@@B@@A@A@A@@DA@@@AB@@AB@DA@AB@B@B@D@B@@@@BBA@@@@B@@AB@@@AAA@@AA@A@@@@@@@@@@@C@A@AAA@@@@@@A@@@@@AB@@A@A@@@@A@@@AB@@@@AAA@@BA@@B@@A@A@@@@@A@CIAKEKA@@A@A@@A@AAA@@AAAA@@@AAA@AC@@@AAA@@@@@@CAA@AAAAC@AA@@A@@@A@@@@AA@@@@@AA@ASOCEAIC@@@CBKFEBE@MICCAAC@CBG@C@AA@C@AFKBG@C@CAAEAKGGKCFK@cQKGSGMBIFA@@@A@@@A@@@A@AAA@A@@@GB@@A@A@A@A@@@A@@@A@A@@@A@@@A@AB@@@@A@@@C@A@@@@@AA@@@BA@A@@B@@"],encodeOffsets:[[102760,22047]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
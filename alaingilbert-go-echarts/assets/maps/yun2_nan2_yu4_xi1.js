@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("玉溪",{type:"FeatureCollection",features:[{type:"Feature",id:"530402",properties:{name:"红塔区",cp:[102.540122,24.341215],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCAEGGGCACE@GBA@CACACE@I@GBEDE@E@@AEAEU@@CBAAEDA@A@CGK@CAAA@E@A@@A@CBCFAFCBC@CDADA@A@M@E@CCCCAE@EAE@AAAAA@A@AAC@C@EAECIEECEHDHBFBDBD@@HLBF@B@B@DCDGBI@CAEDEHIFCFEBC@A@CAGJCDCD@HC@GCKAEBGFAHEHKDEDAJID@DBF@DGJK@AFEDIAECI@AFG@KCOACIKEKFKBMCEFBXF^CHOFGLA@CD@DDBB@DBABABC@CBEBCBCB@D@DBF@DABAD@B@DDDDFBF@F@DABABCFGDIAIACBELCPCDANALAH@HABEJIJCHABGDCACAG@GCEAABAF@DBBDBHBHBBBCDED@F@FBF@@FDHBHDBFFEFAFD@DDDJDHFFBHFHBFAFBDBH@FADED@DB@@BB@@@B@@@B@@B@@B@BBB@@B@@@@@BB@@@B@B@@A@@BAB@@A@@B@@@@AD@B@B@@@B@@@BB@@B@@@B@@@@DHDPFDHABB@H@@G@MCE@CBAD@B@@AB@F@BBBDDDBDBD@BBJBFDDHFL@LD^HLFJDDDDF@FAD@@@HDDABKFEBC@C@E@C@@@C@@BBD@D@FAFBHBFDDF@FJHLLJF@DAFCFCD@D@BLBF@B@HBRIFADAF@FBBB@IFCHCBAHBH@BBFFFFD@DBHTFD@DBDFHPDFBB@BABCACG@ECAEBCF@D@FENEL@F@@JAD@B@@DBJ@FDDHHDHFDFFDBBHCBA@@FAJ@DBDBDBJDDAHAJEBGAAB@BEBC@EEGB@FAB@B@D@BABABA@AE@CCC@E@AAC@CCA@A@IEAC@@BAD@FC@@B@BA@@BA@@B@B@B@@BD@B@BABABADCDCD@DAD@F@D@B@D@FBDBDBFBD@DBF@B@F@D@D@B@BBBD@BBBDBDBF@F@D@D@F@B@D@BA@@D@HEDCBABADADCDCAAAAGAAKBCBAB@@C@C@EFEFCD@DC@A@C@ADADA@A@@@A@AE@C@C@ECCGACGAIAK@K@C@CCBC@CFC@@@@BB@@BBBA@@@@@@@ABE@A@@AC@CAA@@@@C@A@AB@@@DA@CBAB@B@@@B@@ABA@C@AA@@ECCCAE@@AAEKEGEECCAAA@CCEAGAE@CAACCGCCAA@@BCBCACACHCBABABA@AHGDEFK@@@E@GDELGHCD@@AAACCGCGCACDCBAFC@AACEAKAKCKAGCCAECCAAIBEBABC@EEAG@AACCEEEAEAAA@A@E@EEAGEEA@CBCDADAF@H@FCDCBCBEDGBGHCHCFEBCBU@IGEAC@CI@A@EBEBC@CBE@E@IACC"],encodeOffsets:[[105041,24823]]}},{type:"Feature",id:"530403",properties:{name:"江川区",cp:[102.75344,24.287485],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABIDEBCDCBA@ABA@AA@ACIACAAC@GBE@E@CBCB@BAD@BCDEFEFGFCDABE@E@IAE@C@CDCDE@C@@@MYIEGCIBGDIBI@KA@@E@G@E@A@GCCCCA@@CGCAC@AAI@E@C@AB@DFF@@@FABIDEDCBCFADENABAD@@@DBDHF@JAVADEFGDGDAHCHAFADCDEDG@E@CBCBAD@DFBHFFB@F@F@BBBFBFBFFDDBBH@FB@FADABAFBJDBFDDBHDLBLDLBFBBD@BEDABCDBDHDHDDDBB@BC@GDKHCF@H@F@@ELCFGH@BABABABGDBDBDADAD@@BBDDDHBDDBF@HBFBDDB@BBDDFFFHFLBB@@BFDDFD@@BBD@B@BA@@@A@@@ABADAB@@C@@BAB@D@@@@@BB@DBD@@@BAF@B@@@@@@ABAA@@AA@@@@ED@DADDDD@L@L@JBHBBDDHFDD@D@F@@B@B@@@BCBCB@B@DBADAFDDHJBD@PDJ@BBRLFBBFBFBBF@H@HCDA@C@ECC@CDAL@H@JHBB@DBB@DBBDFBDBF@BFDBBDDD@HEH@JDDLBDJ@HDFDHDNDB@F@D@B@DAD@BA@ABC@@BAB@D@DBB@JDHBJBD@D@D@@@@@B@@@@@@@FB@BF@BBHBHBFBF@JBNAH@@AAO@QAMAS@C@A@MAQ@QBW@S@KBKBK@@@ABEFEDAH@F@@C@CFKB@FADC@@@@DAFEDG@@@@@AECGACCCCAAABC@E@CCAC@GDCD@BA@AAGBEFE@ADC@ABC@G@EAAACACAC@AACCCEG@CAABE@CCAECAABA@A@CACCCAA@C@ECI@GBCBC@E@I@G@AAACCB@@ABCF@BA@G@G@CGGAA@C@ABC@CCCEGCAECGBCDA@ABA@A@CAAA@CAGCACACBIBEBC@C@CCACCCEECCIEGEKGGCEAEA@CHABE@EAAEDA@EBEASAOAGBIHOJ"],encodeOffsets:[[105242,24797]]}},{type:"Feature",id:"530422",properties:{name:"澄江县",cp:[102.904629,24.675689],childNum:1},geometry:{type:"Polygon",coordinates:["@@I@GA@@G@MBIAE@EAGAGAAAE@@AEA@@@@@@A@@@@@C@C@C@IAGAICA@CAC@A@AB@@AD@BABC@CBA@C@E@A@@B@BEB@@@@G@E@CBADGDAB@FABAFEDEDCD@DHJADCBCB@JDHBFBBBFDDBDBBBJBHDJBB@B@B@DA@ABABAD@B@FBD@BBBB@B@B@D@BB@@BB@B@BABA@@BBBDBBB@DBB@BB@BBD@B@BB@B@BADAB@B@D@D@B@BA@ABABCBADAB@BA@@DBBB@B@DBD@DBD@DBBBBBDF@BBD@H@H@D@BBDBBDDBD@B@H@F@DBDBBDDFDDDBDBFDDDBB@DDB@DBDFBDDH@@BB@@BF@BBD@BBB@DAB@BAB@BAF@DABEJCFEFCFAFAHEF@DBD@BBDB@H@N@H@H@HAFAD@DA@@BA@ABE@EBA@AB@D@DBDDDB@@DLBLDDD@J@BDHB@JBDD@HDBBB@BBBAD@D@@@BB@B@@@B@BAD@B@DDDDBBB@@@B@BCBEBABCBAB@HBF@DAFCFCDCB@@@F@B@BADADBBBB@D@DBH@D@BBBBBD@B@DBB@BBDD@BMDBP@H@BBB@F@DBFFHFDDBDABADC@GDCAKBCD@FH@NBAHEDB@J@FLFH@B@@@B@BBB@FADAB@B@B@@B@B@@@@AFDDFJBFBD@J@HBBDAFDFCBCJCFADBHDB@@@FBDDDBBDBDDH@F@H@L@H@LBBB@@@DGFG@GDIJEHGDGBE@CBCBABABCBC@ABG@CBEBCBA@ABA@C@AAC@CAC@A@C@A@A@CAGAG@AAACCAA@A@ABABABA@A@ABA@A@ABA@CACAA@AAC@CACAC@C@@BE@G@GCCGACABE@@@CACAE@E@G@E@C@CAEAABCBIFEDAFCDADAHHFFBBDDDFBDBDDBB@B@BABAFEBA@AB@BADCB@DBF@F@D@@@D@DADCD@BCDEBA@ACC@AA@@@@A@A@AAA@@@A@@ACA@@AAA@AA@@A@A@A@C@@@@AAA@@A@@@@@A@@@A@@@A@A@@AAAAA@@A@@@@@A@@@@@A@A@AAC@A@@AA@A@@@@AA@A@AA@@A@A@A@AA@@@@AAA@AAA@@AA@@A@@A@@@A@A@AAA@@EAC@A@AAAAAA@A@@@A@A@A@A@@@A@AA@@AAA@ACC@CA@@A@C@C@A@CAC@A@@CI@@@@@A@@BA@A@@AAAA@AAA@ABC@AA@@A@@AA@@@AAA@@@A@A@A@A@A@@@A@A@A@@AA@A@A@C@A@AB@@ABA@ABC@A@@@ABC@@@A@A@A@A@A@A@C@@BA@A@AA@@@A@A@@@@@@A@C@ABAB@BA@@@A@@@A@A@AAA@A@@B@B@B@@@BA@A@A@@@A@@B@DA@@BA@@@A@AA@@A@AAAB@@A@@@A@AAA@@@ABC@@BA@@B@@A@@@E@A@@AAACAAA@@A@@AA@@@@@AB@BA@@BA@@@A@A@A@A@AA@@A@AA@BA@@@@@@AC@A@A@ACG@AB@@@B@BDBDDFD@@AB@@A@@BA@AICEEAC@C@AA@C@AA@@@A@@@@@@A@@@A@AA@@AA@@A@@@A@A@A@@@AA@@@@AAA@@@@@@AA@@@A@AAA@AA@@AB@@@@A@@@A@@@@AA@A@@@AA@@@@A@@@A@@@A@@@@AA@@AA@A@A@A@@@A@AA@@A@@@CAA@@@@A@@@@@A@@@@@A@@@@@@BA@@A@@@@@AA@@A@AAAAA@@@@AA@@@@@@AAAAA@@A@A@AA@@A@@A@@@@A@@A@@A@@@@AA@@@@@A@@@@@A@@A@@A@@@A@@@AA@@A@@AAA@@@A@@@@@@@@@A@A@@@@@A@@@@A@@A@@@@@A@@A@@A@@@@AA@@AA@@@@@A@@A@@@AA@@@@A@@@A@@@ABA@@@A@@AA@@@AB@@A@@@A@A@BABEAGDK@GGIAAECGACNGJKFI@BJAF@@CF@BAHGJADCBCDC@GAEAIAGAGAI@"],encodeOffsets:[[105371,25107]]}},{type:"Feature",id:"530423",properties:{name:"通海县",cp:[102.725452,24.111048],childNum:1},geometry:{type:"Polygon",coordinates:["@@CCACCCCCAC@ACG@A@CBAD@DA@ABCBCACAC@@CCAA@@A@CACACAAAGAC@@B@DBF@@@BA@A@KGECCCIME@A@A@@B@B@BJJ@BADABABCBCBE@E@C@AB@@@BDJBDFD@B@@@BA@@BEB@@@DABB@@D@B@BEFIDA@@BBBHJB@@DBBBB@F@@DDDHDH@DAFAFAF@BEBCDCBCBC@@DCBDFBBBDBDFBDBB@BB@BBBAB@BADCBAB@D@FADADCBEBOBQ@IBEBAB@BBDFBTBDDBDAFCDEHEDC@E@E@AAAAA@C@AA@@A@AAAAAAAACCAH@@A@@B@BBBDBBB@D@@@D@B@@BBD@LFB@A@@@AD@@BB@@NHHFBB@B@@@@ADCFAJEF@HADEDA@CACAEBGECBG@CFED@DHJABABCAKKCGCE@A@@AA@C@AAC@@A@CAC@@@@@ABBD@@@BA@A@@BABA@A@@@@B@DFHBNADBDJJNL@@EJED@@A@CCCECEEGAACCC@AACBA@AB@BA@@BABA@@@A@@@A@A@@@A@CBAB@B@BA@ABA@A@@@A@@AA@@@@AA@@BA@@BA@@@@B@BAB@BBB@BA@@BA@AB@@CB@@A@@B@@@B@BA@@@@BA@A@@B@BA@@@ABA@A@A@ABA@@@A@@@AB@@@B@@@BA@AB@@ABA@ABA@ABABABAB@BFDFDJFFDFBD@D@BBB@B@BBBBF@FBF@DBDD@D@F@N@BCBCB@DADEDEBAD@D@BB@F@B@BB@DHL@D@BCBBFAB@DV@BFBF@@@FCFAF@H@JDFDBDBB@HAF@BDHDHHBFDDDDJBF@F@DAD@FAFAB@J@@@BCBAFMBCDEDAFCJCBA@E@@EE@CBAD@F@J@BBD@DBDH@@DBDDHDB@F@H@F@@@LBJ@JAHCJAHDJFNZ@@D@F@DCDCD@F@JBF@F@BADCHEFEFEDC@ABC@ADADAF@F@HAD@BBBDDJ@BBBB@BAB@DADCFAJCBAPIJGHAPBTBFBFAB@FCLCHBTHJ@DA@CCAEI@IKC@@AA@C@ABALKJGDCB@B@HDBBDAD@H@J@F@DADECCCAE@CCCGGGKEGCAEAEBCD@DCH@HBDDD@DABA@CBECCAEAC@ABADCHCF@FADABCDAD@F@B@B@@AACAK@@@@@IBCAAA@EBEBEACAAC@EBCHK@CA@CAG@EA@AB@HCFCDEDCDE@EGQ@@@A@A@@@ABA@@BA@AA@A@@@E@A@GCEAEBAAAAAGEEGCKEGCE@AAEBEBKBA@@@A@AACECEGEECC@EEGCEACACEIBGBEAIEBA@C@CC@A@IBEDCBABA@C@CAE@GACAABA@ABA@A@EAACAAEKAGBCJBACIIGE@AB@@ABCB@AC@AAACC@AA@EACAAC@EBGAGAACAGBEBC@GAECCAEGIMCAAAGC"],encodeOffsets:[[105162,24529]]}},{type:"Feature",id:"530424",properties:{name:"华宁县",cp:[102.928835,24.19276],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@AB@@@@A@@@@BAC@CEACACA@@@A@@BDH@B@B@BBD@@B@D@"],["@@AC@G@EAC@CA@CAAACCCICIAG@AAGEGCA@ABCBA@C@CAC@ACAGCEACAEAAAOGQGAC@C@@ACA@CACACCAA@ADABABA@CCCIIKMCAACBEDAF@BA@C@AAGCEC@A@IBE@C@EA@BAACBA@ABADADABA@@@A@ABCBABABADABCB@@EDA@E@GBCBE@ADCB@B@@KLGLCBE@KEGAAA@@@@AA@@@@A@@@@AA@@@A@@@@@@@@@A@A@@@A@@@A@A@@AA@@@A@@AA@@@@@@@A@@@@@@@A@@A@@@@A@@@@@A@@@A@@@@A@@@@A@@@@A@@@A@@@@A@@@@AA@@@A@@AA@@AA@@A@A@@@AAA@@@AA@@@A@C@CAA@A@@@A@@A@@A@AAAAA@@AA@@AA@@@A@AAA@@A@@@@@A@A@A@A@A@A@@@A@@@A@@@@@A@@@A@@@A@@A@@@@@A@@@AA@@A@@A@@@AA@@@@@@A@@@@@A@@@@@A@AA@@A@@A@@AAA@@@@@@AA@@@A@@@@BA@@B@@@@A@@@@@A@@A@@AA@AAC@GBKDK@E@GCGE@EBEBA@CDCDEBCBAA@AAOECAA@C@C@E@@AA@A@AAA@@AA@@@A@@@ABA@ABABA@@BABABAB@B@BAB@@AB@@@@AJ@JED@BDD@FADABADCFEBG@CBBFAFBD@D@FDFDDDDBBF@HDLFHDFFBHBBBBFAFBHDB@F@@@B@B@@BAB@@AB@B@@@B@B@@HR@FCFCDCFEDGDA@@BFBH@DBB@@DGLAD@FBDDBFBFAFAB@BBAD@J@@@@BLBD@BA@A@E@C@CBADCBEBE@GDCDAB@BBDBFDDAF@DABCBC@CCGAG@CDC@ADBFBFHDLFHHDHDDF@DBDDCFCBE@I@G@C@CBAAGCA@A@CDIHKLAB@B@DBB@@LD@JFJDB@DCBI@SGGAKDBB@FAFGB@DFBFBHDLHHFJFDDFFDDBDDDD@D@FAJADADBDBBH@DBBDBB@B@BAB@DCHAFDDBFHDD@DAD@B@DBBHH@D@H@HABE@AD@BA@DDBB@B@H@J@FADAD@HDJ@F@DBBDDBD@D@BABBBFDDB@DAFBB@DFHDDBD@BBDBDBDBB@F@HAD@BCD@BEFAFBH@BABC@CD@HBDDDF@D@BABBDDDDHBFD@B@@@@CHEFCB@@@@CDEBA@EL@D@DE@G@CBEFAF@B@@ALAL@L@TAX@RBR@N@B@DBTBN@RBP@B@@HBJ@J@HBHBJBFBHBD@DCDABCHIBG@ADE@@BEAIJ@LEHIDMHBFDBBHJ@HCLBHAFABB@B@@@B@@@BA@@B@@BB@@@B@BA@@B@@@B@@@@@BB@@B@@@@B@@@@BB@@BB@@@@@BB@@@@B@@@@@BB@@@@@@B@@@@@B@B@@@@@@@@@B@@BB@BB@@@BB@@B@@@B@@@@BB@@@@@B@@@@@B@@B@@B@@@@BB@@@@@@BB@@@BBB@B@@@BBBB@B@@@@B@@B@@B@BBBBB@@@BB@@@@B@@@AB@@@@@@@B@@@@@B@@@@@B@@B@DB@@B@@@BBB@@@B@B@B@B@@BB@@B@@B@@@B@@@B@@@@@BB@@B@B@@B@@B@@@B@@@@@BA@@BBB@BBB@@@B@@B@@@@B@BB@@@@BB@@B@B@B@@@B@@@BB@@BBB@@@B@@@@@@@@B@@BBD@B@@B@DBDFFJD@@@ABA@@@@BAB@@A@@@A@@@@BA@@B@@@B@B@@@D@FBB@D@B@B@B@BA@@DB@@B@B@BBB@BAB@DADA@AB@D@F@B@B@@BBB@@BBBBBBBBB@B@B@@@BBB@BBBBBBB@B@B@DBDBDBB@B@@@B@BAB@@A@A@@@@AAAA@@@C@ABA@A@@@AAA@A@K@A@ABA@CAC@AAE@A@C@A@A@@@A@A@AACAA@AAA@@@AAE@@@CBA@@AC@@@ABC@A@CBA@A@ABAA@@A@@@@@@AA@A@@@@@A@A@A@A@@AA@@AA@@A@@A@@AA@@@A@@AC@A@@@@BA@@@A@@@AA@@@@A@@@A@AB@@C@@@@BA@@@@@A@@@A@A@@B@@AB@@@@A@@@A@A@A@@@A@@@@@A@@@A@@A@@A@@@A@@A@@AAA@@@A@@@AA@@A@@AA@@@@@A@@@A@@AA@@@@AA@@@A@@AA@@@AA@@AA@@AA@@@@AAA@AAAAA@@@A@A@@@A@A@AAA@@AA@@A@@@A@@@@AA@@A@@@@A@A@AA@@A@A@@@A@@@AB@@A@@@AAA@A@@AA@@@A@@AA@@@@@A@@B@@A@@@C@A@@AA@@@A@@AAAAAA@@@AAA@@AA@@A@@@A@@@A@A@A@A@A@A@A@C@A@@@AB@@@B@@A@@@@@@@AB@@@@A@@@AB@@@@ABA@@@A@@@AB@@A@@@AB@@@@@B@B@@@B@B@@@B@B@@@B@B@@@@BB@@@B@@@B@@@B@B@@@B@@@BA@@B@BA@@B@@A@@@A@@@AB@@A@@B@@@B@@@B@@@BB@@B@B@B@@@BAB@B@@AB@@@BA@@@@@@BA@@BA@ABA@A@@AA@@@A@A@@@AA@@A@@@ABA@A@AA@@A@@@A@@@A@@A@@@@@AA@@A@@@@@AA@@@@@A@@AA@@@A@@@A@A@@@ABA@@@A@A@A@@@A@@@@@A@A@@@A@AB@@A@A@@AA@@@AAA@@@AA@@@AA@@AA@@@AAA@A@@@CAA@@BA@@@AA@@A@@@@AA@@@A@@@ABA@A@@@@BA@@@@@A@@@A@@@@A@@@@@@@@AA@@A@@@C@A@@@AB@@@@@B@@@DC@@B@@@B@BA@@B@BAB@@A@@@A@A@@BC@A@A@@@A@AAA@A@A@A@A@A@@@ADABA@@B@BB@@B@BBBBBB@BB@BBB@B@B@@@BA@@@AAA@A@@@ABA@A@A@@@A@@BA@@BCBABA@@BEFCJ@LAHADEBIAIBE@E@A@AGEGCA@G[@EAA@K@KDCFCBBD@F@FCHEHGDEBEEM@EDAFC@AAEIIEE@CBA@AAAE@CFCA@ACC@E@@@AB@@A@@@A@@@@A@@A@C@C@A@EAE@A@@AA@@AAA@A@C@A@A@AA@@AAB@@@@A@@BA@A@AB@BA@@FAD@DADAB@AA@@AAC@EAACAAAC@CACA@A@CBC@AAEACACCEACCAACCCAAAAC"],["@@DFDBBBBBBB@@BBB@B@@@D@@@B@B@BAB@@AB@BC@@AA@@A@@@E@CBC@AAA@CCA@AC@CAA@@@ACCECEACAG@@DBD@@BBFDBBD@HF"]],encodeOffsets:[[[105519,25158]],[[105576,24726]],[[105520,24607]]]}},{type:"Feature",id:"530425",properties:{name:"易门县",cp:[102.162531,24.671651],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AEE@GGMGIC@A@CBGNKFCJGHIBGHABGL@@CDCDI@DJADE@EBABAF@DCDCNAFCH@LADGA@@@@EHEDKBG@CBCDE@G@E@KDO@AA@C@CFEFAB@@@@@FADCACB@A@@BA@@@AB@@AA@@A@A@@@A@@@@@@@@B@B@@@B@@A@A@AAA@A@@@AAA@E@ACAACBADC@ECCBA@@@AAAA@@@@@@A@@A@@@@@@AA@@@A@@@@A@@@A@@A@@@AA@@@A@C@@@@@@A@@@@@AA@@@@@@@@AC@@@A@@@@@@A@@@@BA@@@A@A@ABA@@@@@@@AAA@@AA@@AAAAA@AAA@@@@@@A@A@@@@AA@@AA@@@@AB@@A@@@@AA@@@AA@@@@A@@@@@A@@@@A@@A@@A@@@@A@@@A@@@@@ABA@@@A@@@AA@@@A@A@@A@@@@@A@@@@AAAAA@@@AAA@A@AA@@A@@@A@@@ABABA@A@@@A@A@@AAA@AA@@A@@AA@C@AA@BABA@C@C@E@A@A@CAE@@@CCA@@A@ABA@A@ACC@@AAE@C@@@A@C@@@@ACACAAAAAA@A@A@A@@@A@A@EHCBCBA@A@C@@@E@C@A@AB@@@@@B@BA@@@A@AACC@@AAA@A@@B@@@@@BD@@B@B@@@BA@@@CCA@@@@@@@@BDB@BB@@@B@@B@B@BA@@BA@@AAAAA@AA@@@@B@@@@BDBB@BB@B@B@DAB@@B@@B@@BB@@@ABABC@A@@@AB@@@BBB@@DB@B@@ABADABAD@@@B@DAD@@@@ABA@@@AB@@@@A@@@@@@@A@@AAAA@A@@@A@A@@@C@A@@AAACA@A@@@@@@@@@B@@@B@@@BA@@B@@@@B@@@@@@@@B@@A@@B@@@B@@AB@@@@@@@@@A@AAA@@B@@@@AAAAA@@A@@@@BAB@@@@@@@B@@@@@B@@@@BB@@@@@BA@C@@B@@@B@B@@@B@@AB@@A@@BA@@@@BBB@@@@AB@B@B@@BFBD@@@DA@@B@B@@AB@DCD@BAB@@ABAB@B@ACAA@A@A@@BEFCH@FDDDDD@DFBD@FAHCJEF@BCH@@ABA@A@A@@A@A@AA@@A@AAA@@A@@@@BBF@B@@A@CA@@A@@B@@B@DBBBB@@@BB@@A@@@GDCBEBCB@@BDBBHDDBFFDHB@@BBA@@B@DA@@B@@@@BB@@@BBB@BB@@BAB@@@FABABCBAB@D@BBB@BABAD@D@BDBFB@@BB@@@B@B@@@@@B@@@@BB@@@BBB@@B@@B@B@@ABB@@B@@@BB@@B@@BD@@@@B@B@@BB@@@@@B@BAB@@ABA@@B@@@BBB@BAD@B@BABABAB@B@@@B@BB@AB@B@@@B@B@B@@@B@BAB@@CAGAC@A@@BCDAB@B@DBBJJBF@@@B@@CBCBGBIFABA@A@AA@AAA@A@@BKBM@A@AAAC@E@E@EDEDAB@B@B@BBD@B@B@@@B@@BBAB@@ABA@A@C@@@ABDDNTHJJFJCDA@ABBDBFPBDFD@F@JDBB@BABCACB@D@BB@F@BFBDBBDDBBB@BBBA@@@A@@@A@@@@@C@A@@@CAA@A@@AA@@@E@A@@@@@@BBDBBB@BBBBBD@DCBAD@@AFAD@B@BB@BBD@D@@@DABADAD@B@@@B@CBA@@@@B@@@@@BAB@@A@@BABA@AB@B@@@B@F@B@@A@@@AB@@ABA@A@@@A@A@@@A@ABE@A@AA@@@BA@@B@@@@BB@@@B@B@BBB@DB@@B@@@B@@@@@B@B@@AB@@@@@B@@@B@@@@@@AB@B@@@@AB@B@@@@BB@@BB@BBB@BBB@B@BA@@@@B@@A@@BA@@B@@@B@@AB@@@@@@BB@@B@@@B@B@B@@@@@BB@@@@B@BA@@@B@@@@@B@@@@@BAB@@@@@BB@@B@@@B@@@B@@@B@B@@@B@@@@B@@@@@B@@@@@B@@@@B@@@@@BA@BB@@@BB@@B@BBB@B@B@@@BA@@B@@@@@BB@@@BB@@B@@@@BBBB@BB@@@@BB@BB@@BB@B@B@@@@BB@@BB@@B@@@D@BB@DBDBB@@@B@BB@@@@B@BA@A@CBA@AB@B@@@@@D@@@B@BB@@BB@B@B@@@@BBB@@DB@BBBBBB@BBBDB@B@B@D@B@B@@BB@@BB@B@B@B@D@B@BAB@B@@BB@BB@@B@@@B@@@B@@BBB@@BD@@@B@B@@@BA@@@AB@B@@@BCBA@@BB@@@@DH@BB@@BB@@@@@@@@@@@@B@@@@ABA@AB@@@@BBB@B@@@@@BAB@B@B@B@BB@BBB@B@B@@A@@@A@@@AB@B@@@B@BBB@B@BBBBB@B@@B@BB@D@DCBA@A@@@@BAB@@@B@@ABA@AB@BA@@BA@@DA@@B@B@D@B@B@B@B@@AB@B@@AB@BAB@B@B@B@BBB@B@B@BBB@B@BB@BBBBB@B@B@B@JGHB@@F@R@DANADADAHCNCB@B@BD@@DFFBB@F@N@LAH@BDBFFFFD@HEHCH@FJJBBPJHDBBDBBBD@F@D@DDDFDFDF@BDBBBDFBF@BBL@DAB@DAB@F@BBBDJ@HJEDBBEFCBABAFCFCBAB@D@B@B@DADCDABAB@D@DABA@EA@EC@E@CDCFAH@FBDCBEDEFAD@D@JB@@LGBADAF@B@D@B@D@DAD@DAB@BAB@D@D@B@B@B@B@B@HDBDBD@@JDJAHDTNJDH@HADE@C@AAEEGCE@GDIBEBGDCBCFAHEDCD@DBBBH@H@@@B@DADC@AACCIAGDCFCDC@AECE@AA@CDEFAFACMACDIBC@@A@@@@@@A@@@@BA@@@@@A@@A@@@A@@@@@@@@@@@AA@@@@@@@AB@@@@@A@@@@@@@@@A@@A@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@A@@@@A@A@@@@A@@@@@@@A@@@@@@A@@@@@@AA@@@DAFGFK@K@G@@OACA@@BC@A@@A@@A@@@A@ABA@A@@@@@@@AAA@ABA@A@A@@@A@@@@@A@C@CBABADCD@BABC@A@ACC@@AA@A@G@ABC@ABAHIBC@CEEAC@C@I@A@ECU@CAOGIBGHE@EAGECCKGCDMBUFEBA@A@@DEBAAACCAAAAGAM@K@ACAELUPSJIDGAEDIBUBKHICaKGAEGQGCIACBCBGD@ACCHECAAECEGCMAAE@ICGIEUCIAAC@AOOECMGAC@AG@IBCBC@@AAABCHIDWDGDC@C@EAKOAG@AAEA"],encodeOffsets:[[104644,25057]]}},{type:"Feature",id:"530426",properties:{name:"峨山彝族自治县",cp:[102.405819,24.168957],childNum:1},geometry:{type:"Polygon",coordinates:["@@B@@@B@BAB@B@B@BA@@B@@A@AB@B@@A@@B@@A@A@@@AB@@@DA@@BAB@@AB@@AAA@ABA@A@A@@B@@AB@@AB@@B@@B@@BB@@@B@B@BAB@@A@ABADAB@@@B@B@@@B@@@B@BA@AB@@ABAB@DABBD@DDBBFHDFDFDDB@@@FCFI@@MKIIACBCAMEG@C@A@@B@B@BA@AB@B@@A@@ACBA@@@@D@DBB@@@BD@B@DBB@@@BDFDHLLDBBABAGI@CFCDEH@DAHFFADBDBB@FCBC@GFEBIDEBC@@@@@AAAGEMG@@AA@@BC@@B@A@KEC@AA@@@A@C@@@CAACAAA@A@AB@@@BG@@CAACA@C@CBCBCBA@@@CA@A@AIEKEGACBCFAHABC@EAEBGFCD@@C@A@ACCAG@CAACACCAEAE@G@EACACAABABEDAD@@EBETGLIB@BABADAD@BBDB@@B@BABCBEBI@@@C@A@CCCCCCCAAAACACA@A@C@A@E@A@CCCAC@CBC@CBCBCDA@A@A@A@CAGCGCECE@A@C@CBCDCBEBCBABAB@@@BDBBDBBDDDD@B@@CBABABABADC@ABABCBCBC@C@A@ABABC@CBA@ABCBAB@BDBBBABABCBE@GAC@C@CB@DEJADABA@E@MIEAEBCBI@EBCDABA@C@C@ECCACAE@E@CACDAB@@A@KCGACACCAAA@@@@CDABA@AAAAACAI@AA@A@ABCBEBCBEBCDEDE@ABCD@FAD@DBB@HBD@@@F@DBD@D@DADABABC@CAEACACAA@AAAC@AAEAA@@AAAA@@ACAICEAIAI@K@K@EAAAECE@CBA@EBABE@EAICGAGCIAIAGAG@E@GAA@@@CE@AECE@KAGAEB@HBJAFCBABCJEJEDABAACAAGAMCIEA@@ABCBC@CBC@E@C@@@AAG@CAC@CCA@AAC@A@A@A@AAA@@ACACAA@@BAD@FABA@CBC@CDCFCDBJ@JDHDBFDCDADDFFHDJCFGFOJCJ@@ADA@C@EAAAABA@@@CAA@C@@@AFA@A@E@@@@@@B@B@@AB@@@@A@A@A@A@@@AB@@@BA@@@A@@AA@@A@@@B@@@@@BAB@DAB@@BBA@@@@BA@@@@@@BA@ABA@@@@B@@@@@B@B@@@BA@@@A@@B@@@@@@@B@B@BFDBDDD@D@DAB@BFBBJXNFD@H@D@FBHAJCBABEDIBIAI@I@IGCKEIEBEFGDMHI@@FEBI@GDKHE@IEKAO@KEIBA@CDCBC@EBCB@@ABA@A@ABA@@@AAA@A@A@AAA@A@AAA@A@AAC@AAA@AAAAAAC@AAA@C@C@ABC@A@A@CAA@AAA@AAC@AAA@A@A@A@A@ABA@ABAB@B@@@B@BBB@B@BB@@B@B@BAB@B@BAB@BAB@BAB@@BB@B@BBB@B@BB@BB@BDB@@BBBD@BBB@DB@@D@B@BABIDG@CB@DA@AB@BC@AAE@EBCFAFABCD@BBDBBBBD@F@B@CDA@IHGDCBA@E@C@ABA@CBEDEBCBC@E@C@C@@@A@@B@BADADADAHABABCDEBABABCB@D@D@BA@ABC@BB@@BB@@@BB@@@BB@AB@@ABA@@BA@ABADCFCDAD@HDB@@B@@A@EBABAD@D@@@BA@DBBBB@D@D@F@D@D@@B@@@B@@AB@BAB@B@D@BBB@@DBB@DBD@D@F@DAD@B@BA@@BABADEB@@A@AB@@AB@B@B@@BB@BB@@@B@B@@@B@B@@BB@B@@BB@B@@B@@B@@D@B@DBB@@BB@DDBD@B@@BB@@B@@BB@DBDBB@D@@@HCBABABAB@B@B@@@B@DBB@BB@@@BB@@B@BBBBBB@BBB@BDBB@BBB@BB@@BB@D@B@FD@@DBBBB@BBDB@BB@D@DDBB@B@DB@@@@@@@DD@B@@BB@D@B@D@F@@@D@BBB@BBB@B@@@BBB@B@B@@A@@B@@@B@BBB@@BB@@@B@B@D@B@@BB@@@@AB@@A@@@@@@@@B@@BB@@B@BBB@@@BB@BBBBB@B@@ABABABA@@BA@A@CBA@@B@BAB@@@@BB@@@BB@@B@@B@B@@@B@B@@@B@B@B@B@@@BBB@@BBBB@@BBDBB@@@B@B@B@@@@B@BBB@B@B@B@DB@@BBBB@@@BB@B@@@@B@@@B@BA@@B@@ABA@@B@@@@@B@@BB@@@B@BA@@B@BA@@B@B@B@DBD@B@D@D@B@BAD@B@BABAB@BAB@BA@@BCBEBA@QCC@AAC@ABABANDF@@BDB@@B@BB@@B@@@BB@@BB@BB@B@@@@BDA@@@@B@@@@BB@@DBBBB@@@@@B@@BB@@@@B@@BB@@@@@B@B@BA@AD@@@@A@@BA@AB@@@B@B@@@@ABA@@@@@A@@@AB@@AB@@AB@@AB@@AB@@@BA@@B@B@@AD@B@@@B@B@@A@@B@@@B@B@BBB@@BB@BBBB@@BD@@@@BB@@B@@@@@B@B@BBB@@@B@B@BB@@B@@@@BBBB@@@@BBD@B@@BB@@@BBB@BB@@@B@B@@@BABAB@B@@@B@@@BB@@B@BBB@B@@BBBB@B@@B@@@@@B@@@@B@B@@BB@@B@@@B@BA@@@@B@@@B@@@@@@BB@@@@B@@B@@@@@B@@@@@BB@@B@@B@@B@@@BA@@@@BB@@BB@@@@@B@B@@@@B@BBB@BBBB@@BB@@BB@B@@@@@@AB@B@B@B@@AB@@@@@B@@@@B@@@D@@B@@@@@@B@@B@@@@@B@@@@D@B@@@B@@B@@@BB@@@B@@@@@@B@@BB@@@@@@@BB@@@@@@@BBBB@@B@DAFDD@BCDABBBDF@B@BB@@B@B@BBB@B@@@@A@@@A@A@@@@@@B@@@B@B@@@BB@@BA@@B@@AB@A@BDCDEB@@@@A@EBEF@D@DBBP@LCF@H@F@DCDAH@LAFCFG@@@@HBBC@KDGBEDMDC@CBEBAFAF@BCCIJ@DCDC@@HKBAHGJAHGDILEHMDAB@D@HJHN@HFF@BFBBBH@PBBL@FRDZ[LGLEFGFKBCDGBABCAACC@ABALDF@F@FEJCF@H@LCFEFCFAFBD@HEDCBEDCDC@A@AEEECGCGC@@@ADABABE@ADAD@FADEFE@ADCA@AEGCGAEC@@AE@E@EFCDCAAGAGACAAA@CBEBAFBHDH@DBDBHCBADGJIFIBA@GBGBKBMDCDOFKDAJBJBHCDEBABA@C@EAECECC@C@ABCBA@CAE@C@CDADAFADAD@BABACAA@CA@CDCB@HKPEDGE]AWFENDLALELFDJPBLDH@BEJ@FDJBFCBEL@HI@CAE@CJCBIFCLCFGBGHEFALBHDD@@GDCDCHIDBB@D@FADEJEFGFCDBJ@HADC@C@A@AAEGK@@ACACAECGFGEC@ABABABABAB@BAB@BA@@BAB@@A@@@A@@BA@@"],encodeOffsets:[[104981,24708]]}},{type:"Feature",id:"530428",properties:{name:"元江哈尼族彝族傣族自治县",cp:[101.998103,23.596503],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@CC@AA@@@A@@AA@@@AAAA@AA@C@A@A@C@@@EBAACCEC@@GECA@@A@AA@@Q@E@C@CBCFEJEHCBGBC@E@G@E@AAECIIAAA@EBEFA@A@IDOBGBABCBAD@BBFBD@BA@C@GBC@@BEFEDA@E@ECCECECC@@@@A@@@@@A@A@@AEBC@@@A@C@@@A@A@A@@@A@ABA@@@A@@@A@ABA@@@ABA@@BA@@@ABABA@ABAB@@@B@@AB@@B@@D@BA@@B@@@@ABAB@@ABA@@@@@A@@BA@@@A@@B@@A@ABA@A@@@A@A@A@@@A@@@A@@A@@@AA@@AA@@@@@A@@@A@@@A@@@A@@@A@CB@@A@@@CB@@A@AB@@@AA@AA@@@@A@@@A@AB@@A@@BA@@@AB@@AB@@AB@@AB@@AB@@@@A@@@A@A@A@A@C@@@A@@@@@@@A@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@A@@@@@@@@A@@@@A@@@@@@A@@@@@@@A@@@@AB@A@@@@@@A@BA@@@AB@@A@@@@@A@AB@@A@AB@@@B@@@@@B@@AA@@A@@AA@AA@@A@@@@@AA@@@A@@@A@@@AA@@A@A@@A@A@A@AA@@A@@@A@@A@AA@A@@@AA@@A@A@A@A@@@@@A@@@A@A@@@A@@@AA@@@A@@@A@AB@@@@A@A@@@AA@@AAA@AAA@@AA@@@@A@@@A@@AA@ABA@@@A@@@A@A@@@A@@AA@@A@@@@@A@@@A@@@A@@@@@@@A@A@@@A@AA@@@@@@A@@@@@AB@@AA@@A@@@@A@@BA@@BA@@@ABA@@@A@@@@@AB@@@@@@@@AB@@@@A@AAA@@@@@@@A@@AA@@@AB@@@@@@AB@@AB@@@BA@A@@B@B@@AB@@@@A@A@@@A@@@AB@@ABABA@@B@@@B@@@BBB@@@BAB@BA@@BA@@BAB@B@@@@@@@@CAA@A@E@@BA@C@A@A@@@A@A@A@C@A@A@A@@@A@A@@@C@A@@B@@A@A@@B@B@B@@@B@@BB@@@BBB@@@BBB@B@@@BBB@B@@@@@BBB@@@BB@@B@@@B@@@B@B@@@BAB@@ABA@A@A@ABA@C@@@A@A@AB@@A@@@@@@@AB@@@@@@@@@@A@@@@@@@@@@@@BA@A@C@ABA@ABABA@@@A@@@AB@@A@@@@@A@A@@AA@A@@B@BA@@B@B@@@BA@@@@@ABA@@@A@@BA@@@A@C@A@A@@@A@@@A@A@A@A@CAA@@AA@@@@@@A@@A@@@@@@@@AA@@@@@@A@@ABAAA@@@@@@@A@AA@@@@A@A@AB@@@@@@AA@@@@A@@@@A@@A@@@@@@@@@A@@@@@@@@@@@@@@BA@AB@BCBABABA@@BAB@@@B@B@@AB@@@@@B@@@@@@@B@@A@A@ABA@A@@B@@A@@B@BB@@B@@@BB@@@B@BB@@@B@@@@@B@@@@@B@B@@@B@B@D@@@@@BA@@BA@@B@@@B@@@B@B@@AB@@AB@@AB@@@BAB@BB@@@BB@@@@B@B@BBB@@@BB@@@@B@B@B@@@BA@@@@BA@@@@@@B@@@B@B@@@@@B@@@@@BA@@B@@@@AB@BAB@@@B@@@B@BA@@@@BBB@@@BB@@@@@B@@B@@B@@@B@@@@BB@@@@@B@@@B@@AB@@ABAB@@@@@@@@@B@@@@B@BB@@@B@B@@@@@B@B@@@@@B@@BBBBB@@BB@B@@BB@B@@@B@B@B@@@B@BBB@BBB@B@@BB@@@@@@@@@@BA@@BA@AB@@@BAB@@@@@B@@A@A@@B@@@@@@A@@@@@@@@@@@@@@@@@@B@@B@@B@BB@BB@@B@BB@@BBA@@BA@@B@B@@ABA@AB@@AB@@@BAB@@@B@B@@BB@@@DB@@B@@AB@@@@@BA@A@@@A@@BA@ABABA@AB@@@B@@AB@B@@AB@BAB@@ABA@AB@BAB@@@B@@@BB@@B@@@@BB@@@@@B@@@B@@@BAB@@@BAB@BAB@BAB@B@@@@@@@B@@AB@BA@@B@@A@@@@BA@@@@@@@A@@@@B@@@@@@A@@B@@@@@B@@A@@@A@@@@@@@@B@@A@@@A@@@@@A@@@@@@B@@A@@B@@ABB@@B@@@BAB@@@@@B@@@@A@@@@@@B@@AB@@ABA@@@A@@@A@@@A@A@@@A@AA@@A@A@A@AAA@@@AA@AA@@A@@A@@AA@A@@BA@@@A@@B@BAB@@AB@@@@@B@B@BA@@BBB@@@B@B@BA@@BAB@@AB@@@BAB@@@@A@@BA@@@A@A@ABA@@@A@@BA@@AA@@@AB@@A@@BA@@@@@@B@B@B@@@BB@@@BB@@BB@@B@@BB@BB@B@@BB@B@@@BA@@B@B@@@@B@@@@@@B@@@BABAB@B@@AB@@AB@B@@@@B@@@B@@@@BB@@@B@BBB@@BB@@@B@@@BB@@B@@@@B@@B@@D@B@@@B@@BB@@BB@@@B@@A@@BA@A@@@@@@@@@A@@@@@@@CB@@C@ABCBABA@A@A@A@A@AA@@A@AAA@@@A@A@@@A@@B@D@HAD@@@BABCD@@CDADCB@@EBA@@F@@ABC@@@AB@BAHCBA@AB@BBDD@@BBBBD@@AD@BA@@@EBA@E@C@AAIAE@CAA@@B@FBBADEFAF@@BBBB@BBB@BBBB@@BHABAD@D@@BB@DABAB@B@@ADAB@@@B@@@BB@B@B@@@BBB@@DBDB@@BBB@@@@@AB@@ADA@@DAB@B@B@@DBDBB@BB@@BDBB@B@@@B@@@BA@BB@@BBD@B@DBB@B@BDBBDB@BBB@@AB@B@@A@C@A@@BAB@BCBA@BBBDBB@@@BA@@B@@@@AB@@@BA@@BA@@@AB@@@@@BAB@@@@@B@B@@@BA@@@@B@@@BA@@@@@@B@BA@@@@BB@@B@@@@@B@@@@@@@@BB@@@@@@@BB@@@@@@@B@@@@@@@B@@@@A@@B@@@@@@@B@@@@@@@B@@@D@@@B@@@@@B@@@@@B@@@@B@@@@BB@@@@BB@@B@@@@@@@B@@@@@@@@@B@@@@@B@@B@@@@B@@BB@@@B@@@BB@@@@B@@@@@B@@@B@@@@BB@@@@@@@BB@@@@B@@@@@B@@@B@B@B@B@@@D@@@B@@@B@@B@@@@B@@B@@@@@@@B@@@B@BA@@B@@AB@@@@@B@@@@@B@B@@@@@B@@@@B@@BB@@B@@BB@@B@@B@@B@@@@@@B@@@@AB@B@@@@BB@@@@B@@@B@@@@B@@@@@@@B@@@BB@@@@B@@B@@@@B@@AB@@@@@@@B@@@@@BA@@@@B@@@B@@@@@@AB@@@@A@@B@@@@@BA@@B@@@@A@ABA@AB@@A@A@@BA@@@@@A@A@@@AB@@@@CD@@AB@BAB@BABABAB@B@@GHCDAD@BABCDDB@BBB@@ABAB@@A@M@A@@@BDAD@BADAF@@@B@@DD@@DBB@BBD@@@D@BAD@BAH@D@B@@@B@@A@@@@@@B@@A@@B@@@B@@@@@@AB@@@@@B@@@@@B@@@@@@@B@@@B@@@@@B@@@@@B@@@@A@@@@@@@A@@@@@@B@@@BB@@@@@AB@@@@@@@@@@B@@@@@@A@@B@@A@@@@BA@@B@@@@B@@@@@@@B@@@@@B@@@@@B@@B@@@@@@@@@B@@B@@@@B@@@@B@@@@@@AB@@@@@@@B@@@@@@BB@@@@@@@B@@@@@B@@@B@@@@@B@@@@@@@B@@@@B@@@@BB@@@@@@@@@@B@@@@@@A@@@@@AB@@@@@@AB@@@@@@@B@@@@@@@@@B@@@@@@@@@@@BA@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@BAA@@@@@@A@@@@AA@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@AB@@@@@@@B@@@@@@@@@@AB@@A@@@A@@@AA@@@AA@@B@@@B@B@@@@@@@B@@@@@@@@@@A@@@@@@@@B@@@@@@AB@@@@@@@@A@@@@AA@@@@@@@@@AB@@B@@@@B@@A@@B@@@@AB@@@BA@@@@@@B@@@@@@@@@@@@@AA@@AA@@@@@@@A@@@@B@@@BA@@B@@@B@@@@A@@@@@A@@BA@@@@@@@@B@@AB@@@@@B@@A@@@@@AA@@@@A@@A@@@@A@@@@BA@@@A@@BA@@@@@@B@B@@@@@@A@@@@@A@@@@@@B@@@@@B@@@@@@@@A@@@A@@@@B@@AB@@@@@@@@@@A@@@A@@@@@AB@@@@@@A@@A@@A@@@A@@@@@@@@B@@@@BB@B@@@@@B@@A@@BA@@B@@@B@@@@@BB@@B@@@@@@@@@@A@@@@@AA@@A@@@@D@@@@@@A@@@@@A@@@@B@@AB@@A@@B@@@@A@B@@@@B@@@@B@@@@@@@B@@@B@@@@BB@@@@B@@@B@@@@BB@@@B@@@@@@@B@@@B@@B@@B@@@B@@@B@@@@@B@@B@@@B@@@@BB@@@B@@@@B@@@@@B@@@BB@@BB@@@@B@@@BA@@B@@@BA@@B@B@B@@@B@@@@@@@@@B@@@B@B@@@B@@@@@@B@@B@@BB@@@@@B@@@BB@@B@@@B@B@BB@@B@@@BB@@BB@BB@@BB@@@BB@@BB@@@@B@@BB@@BB@BB@@BBD@@BB@@@B@B@@@@@B@@@B@@AB@@@B@B@@@BA@@B@B@@B@@B@@@@@A@@BA@@BAB@BAB@@AB@B@@@BAB@@@@@B@@B@@@@@B@@@@@B@@@@@@@@@BB@@@AB@@@@@@@BB@@@@B@@B@@B@@@B@@@BBB@DBBBB@@@F@B@D@DBB@DB@@BBBB@@DBD@BBDBFFB@BBB@@BHFHFDB@@B@DBDB@@B@BADCB@DABAD@B@D@D@@@@@@@B@@@@@B@D@@@@@B@@@@@B@@@@@@@B@@@@@@B@@B@@B@@B@@BB@@B@@BB@@@B@@@@@B@@AB@B@@@@@B@@AB@@@@@B@@@@@B@@@B@B@@@@@B@@AB@B@@@B@@@@@@@@@BBB@@@@@@@@BB@@@@@@@BB@@B@@@@@BB@@B@@@@@B@@AB@@BB@@@B@@@@@B@@B@@B@@@@@B@@@@@B@@B@@@@@@@@A@@@@@@@@BA@@@@@@BA@@@@BA@@@@@@BA@@@@@AB@@@@@@@BA@@@@@@@AB@@@@AB@@@@@BA@@@@BA@@B@@A@@@@BB@@@@@@B@@@B@@@@@B@@@@BB@@@B@@@@@@@BB@@@@@B@@@@@BB@@@@B@@@@@@@@@B@@@@@@@BA@@@@@@@A@@@BBA@@@@@@BB@BAB@DEDABA@BBB@@BB@BBDBB@BB@@B@BB@@DBB@@@B@@@B@@@B@B@@@B@@@B@@@B@@@@@B@@@@@B@@@@@@@B@@@@@B@@@@@B@@A@@BB@@@@B@@A@@B@@@BB@@@@B@@@@@@AB@@@@A@@@@@@B@@@@BB@@@@AB@@@@B@@@@@B@@@@B@@A@@B@@@@@@@@BB@@@@@@@@@B@@@@B@@@@@B@@B@@@@B@@@@@B@@@@@B@@@@B@@B@BA@@@@@BB@@@B@@@@@B@@B@@@@@@B@@@@@@@@B@@@@BB@@@@B@@B@@B@@AB@B@@@B@@@@@B@@BB@@@@BB@@@@@B@@B@B@@B@@@BB@@B@@@@@B@@B@@@@BB@@@@B@@B@@B@@BBB@@B@@@BB@@@@@BB@@@BBBB@B@@@@BBB@@@BB@@@@BB@@B@BB@@B@@BB@@B@@@@BA@@BB@@@@B@@B@BB@D@@B@@@@@@@BB@@@B@@FAHBDBBBBDD@DDBBBDBFBBF@FAHPDRJJBB@@CDC@CDC@CDEDCB@BFBDDFBBDFD@DBFBD@D@DAF@DBD@F@D@FAD@H@FADCDCDA@CDCBCDCD@D@D@D@D@FADADABADA@AB@@ADADAFCD@BABA@@ACAC@AAA@A@@@EAA@E@ABA@@B@@A@ABA@CA@ACCC@CAAAABA@A@@@@BA@@@@B@@@@AB@@@@@B@@@@@@@B@@A@@@@@@@@@A@@@@B@@@@@B@@@@A@@@A@AA@AA@A@@BABAB@D@BAB@BA@@@A@A@A@AA@@CA@@@BA@AB@@@B@@@@@FA@A@@BA@E@@@@@AD@B@BA@@DAB@@@B@@AB@@@@AB@@@B@B@@@B@BA@@@A@@@@AA@@A@ABA@AA@@A@@@@A@@@@BA@@BAB@@@B@BAD@BAB@B@@A@@@@@A@@A@AA@AAA@@@A@@@A@@B@B@@@B@@@B@@@B@BAB@@AB@@@BA@@BA@@@A@A@@@@@A@@BADADABABE@CCEAACG@ABA@AB@@@B@B@B@@@BAB@B@BAB@B@@@AAEAAAACCCCA@@CAC@EACAA@@@AEEAEC@@AE@EAGAEEGEEAC@@CI@GBEBC@E@CBCB@BAB@@@FCBADC@A@A@AD@B@L@B@BAD@BAB@FABBD@B@DBDB@@DBB@@@B@D@DBB@BBB@B@B@B@B@F@B@B@@@DAB@B@B@B@B@B@DAFABAD@B@@A@@BA@@@AB@@@@@B@@@@@B@@@@A@@@@BA@A@A@@@@@@@@BA@@@@@AB@@@@A@@B@@A@@@AB@@@@A@@@@@@B@@A@@@@B@@@@@B@@@@@@@BA@@@@@@@@@@DA@@B@@@@@@AB@@@@@@A@A@@@@@A@@@@@@@A@@@A@@@@@@@@@AB@@@B@@@B@@@B@@@B@@@B@@@@@B@@@@AB@@A@@@@@@@AB@B@@AB@BA@A@@@@@@@@@AA@@A@@@A@@@ABA@@@@@AB@@@@@BA@AB@@@@AB@@@B@B@@@B@@@B@@@BAB@@@B@@@@AB@@@@A@@@A@@@A@@BABA@AB@@AB@DA@@@@BA@@BA@@@AB@@@BA@@@@B@@@@@@@B@@@B@B@@@B@@@@AB@@@@A@@@@@@@AAA@@@@@A@A@A@@BABA@@BAB@@@DBB@@@@@B@@@@AB@@@@A@@@A@A@@@AB@@@@@D@@@BAB@BA@@@@@@B@@@@BB@@@@@@@B@@@B@@@@A@@@@@@A@@A@@@@AA@@@A@@@A@@@A@@@@BA@@@@B@@A@@@A@A@@@A@@@@BA@A@A@ABAA@@ABCDC@@@A@AAC@AAA@GBE@@DA@AJEBA@ABA@@@@@A@@@@B@@@BAFABAB@@A@@@A@@B@@@@@B@@A@@FEB@@AB@@ABA@@@A@@@A@CAE@A@@AA@@AA@@BCAC@A@@@CBC@AAAAA@A@C@@BA@@DA@@BAB@@@B@@@B@DABA@@@A@@@A@@BABA@A@@@@BA@@@@@A@@@A@@@A@@BA@@@@@AB@@@B@@@@A@@B@@A@@B@BA@@B@BA@@B@@ABA@AB@BA@@BC@@BAB@@A@@B@F@BA@@B@B@BAB@DA@A@@B@@AA@@@@A@A@@@@@AA@@@BABA@ABA@@BA@A@@BCBA@@@@@A@@@@@A@A@@@@@A@@@AAA@A@AA@AC@AA@@A@@@@@@@AA@@@@AB@@@@@@A@@@@B@BADA@@BA@@@@BA@@@@@@@AA@@A@@@C@A@A@A@A@A@@@@A@@AA@@A@@@@A@@@A@AAA@@@AA@@AA@@@@@A@@@@AA@@@A@@AA@@A@@A@@@@A@@@AAA@A@CAAAA@@AA@@AAAAAA@@@AA@@@A@@@@AAAA@@AA@@@@@A@@@A@@@A@@@A@@@@@A@A@@@A@@@AA@@AAA@@@AA@@A@@@AAAA@@AAAA@@A@@@@@A@@B@@AB@@@@A@@@@@@@@@A@@@@A@@A@A@@AA@AA@@A@@AC@@@@@AA@@A@@@@@A@@AA@@@A@@@@@AA@@@@A@@BA@@@@@A@@B@@A@@@@@A@@A@@AA@@A@@@@@A@@A@@A@@@AA@@@AA@A@@AA@@@BA@A@A@C@A@AAAAA@A@@@A@@@A@A@@@A@@@A@@BC@ABC@A@ABA@@@A@@@A@@@A@@@A@A@A@@@A@@@A@A@ABA@A@ABA@@BABA@@BA@@@A@@@@BA@ABCB@@A@A@AAA@ABA@AB@BA@A@ABAAE@CAA@GBA@I@@BC@A@A@CA@@AAA@C@A@CBC@C@CAC@A@A@@@E@AA@C@A@CAA@AAA@CAAEEI@KDEDAFANEDEDGBE@@@A@@C@A@AA@@@A@@@A@@@@@@AA@@A@@@@AA@@A@@A@@A@@A@@@A@@@@@@A@@@AA@@@A@@CA@@@@@AB@@@@A@@@@@A@AA@@@@@A@@@@@@AA@BA@@@@@AA@@AA@@@A@@A@@@A@@@@@AA@A@@@AA@@A@@@@AA@@ABABC@@@@A@@@AAAA@AA@@A@@@A@@A@@A@A@@AA@A@@AAAC@@@AA@AA@@ACA@@A@A@@@A@@@A@@@A@A@@@@AA@@@@@A@@@A@@@AA@@@AA@@@@@AAA@@@A@@BA@@@@@A@@@@A@@@@@@A@@@A@@@A@A@@@A@@A@A@@A@@@AAA@@@A@A@@AA@@@AA@BA@@@A@@@A@AA@@AB@@@B@@ABA@@AA@@@A@@@@BA@A@@A@@@@@@A@@@@AA@@@@A@@@A@@AA@A@@@@A@@A@@A@@@A@A@A@@@@@A@@@@A@@AAA@@@AAAAAAAA@@@A@A@"],encodeOffsets:[[104589,23891]]}},{type:"Feature",id:"530427",properties:{name:"新平彝族傣族自治县",cp:[101.990157,24.07005],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@B@@A@@@A@@B@@A@@@A@A@@@@BA@A@@@@BA@@B@@AB@@A@@BA@@@@@AB@@A@@AAACAAA@CBC@CBA@@B@B@@@@@B@@@B@@ABA@@@ABAB@@A@@BA@@BA@@@A@A@@@A@@AA@@@AAAA@@A@@AA@@@A@@@A@@AA@@@@@@@AA@@@@@@@A@@@@@@@@@C@@AAA@@@A@@@AA@@AB@@A@@AA@@@AA@@A@@@A@CA@@CAA@AAC@CAC@A@AAAA@@AC@@A@C@GBC@@@@A@@C@AAA@@A@@AAC@@@@@@@@@@@@@A@@AA@CAAAA@@@A@A@@@@@@A@@@A@@@A@@@@@A@@@@@A@AB@@CBABA@@@@@AC@@@A@@@@@A@@A@@A@@@A@@@@@A@AAA@A@A@@@@@AB@@@@@BA@@B@@@BA@@@@D@@@BA@@D@@B@@B@@@B@@A@@@@B@B@@@BAB@@@B@B@@@B@@@@@BAB@@@B@@A@@@A@@@C@A@@@@@A@@@A@@@A@@@A@@@@@AB@@@BA@@@@B@@@BB@@@@B@@@@AB@@@B@@A@@BA@@@AB@@@@A@A@@@A@@@@@A@@@A@@@@@@BAB@@@@@@@B@@AB@@@B@@@B@@BB@@@B@@@BA@@@CB@@@BA@@@AB@@@BA@@@@BA@@BA@ABA@@BA@@@ABA@A@@BA@A@@B@@AB@@@@@BA@AB@@@B@@B@@B@B@@@B@@AB@@A@@@@@@@@@A@A@CA@AA@A@AA@@A@AACCAA@AAA@A@C@AAABE@CA@@A@@@AA@@@@A@@A@@@@@@B@@@B@B@@@B@@@BA@@B@@A@AB@@A@A@@B@@A@@BAB@@A@@@@@AB@B@BA@@B@@AB@@@BA@@@@BA@@@A@@@@@ABA@A@@B@@AAA@AA@@A@@@@@AA@@A@@@@B@@@B@@B@AB@@@@@B@@AB@@B@@@@B@@BB@@@B@@B@@B@@@@@B@@BB@@@D@@BB@@@B@B@@@BBB@@@B@@@@@@@B@@@@@B@BB@@B@@@@@B@B@@@@@B@B@@@@@BA@@@A@@B@@@@@B@@@@@BA@@@@B@@@B@@B@@B@@@@@B@@@@@@@BB@@@@@@@B@@B@@B@BB@@@@B@@@B@@@@@@B@@B@@@@@@@BB@@@@@@B@@@@@B@@@B@@@@BB@@@BB@@@@BA@@@@B@@A@@B@@@@@B@@@@AB@@AB@@@@@B@@@@@@@B@@@@@B@@@BB@@@@@@B@@@B@@@@@B@@@@BB@@@@@@B@B@@@@BB@@@B@@B@@B@B@@@@@BB@@@@B@@@B@@@@AB@@@@@@@BB@@@@B@@@@@BB@@@@@@BA@@B@B@@@B@@@@@B@@AB@@AB@@@BA@@B@@@@@B@@@@AB@B@@AB@@@B@@@B@@@@A@@BA@@B@@A@@@@@@B@@@@@B@@A@@B@@AB@@A@@@A@@BA@@@AB@@@@AB@@@B@@AB@BA@@B@B@B@@AB@@AD@@@@@B@@AB@@@B@@@@AB@@ABAB@@@@@@AB@@@B@BA@@@@@AB@@AB@@@@AB@@A@@BA@@@A@A@A@@B@@A@@BA@A@@@A@A@@@A@@@AAAA@@A@A@AA@@AA@@@@A@@ACA@A@@AA@@AA@@@@A@@@@@A@@AA@A@@@@@A@@@A@A@A@A@@@A@AB@@A@@@@BA@ABA@@BA@A@AB@@AA@@@@@@@@@@A@@@@@@@AB@@@@A@@B@@A@@@AAA@A@@AA@@@A@@@AAA@@@@@@@@@A@@@@@@A@@AAA@A@A@@A@@@@@ABA@@@A@@@@@@AA@A@@@@AA@@@@AA@@@A@@AA@@@A@@@A@A@A@A@A@@@@A@@@A@@@@BA@@@@@A@@@A@@@AB@@@@@A@@AA@@A@AA@@AA@@AA@@@@A@@BA@@@@@A@@BA@@@@@@A@@@CAA@@@AA@@@@A@A@@@A@A@@@A@AAA@@@@@@@@@@@@A@@@AAA@@@@@@A@A@@@A@A@@A@@@@A@@@@@@@@@A@@@@@@@A@@@A@@@A@@@A@@@A@@AA@@@AAA@@@A@A@@@@@A@@@@@@A@A@@@A@@@A@@@ABA@@B@@A@@@@@A@A@@AA@A@@BA@@@@@A@@@AB@@A@AA@@A@@@AAA@A@A@@@@A@A@@AA@@@A@A@@@A@@@A@AA@@AA@A@@@A@A@A@AA@@A@@BAB@B@@A@@BA@@@@B@@@@@B@@@@@@@@A@@B@@A@@B@B@B@@@@@@@B@@@@@B@@@B@B@@@B@B@D@@@@@@@B@@@B@BBB@@@D@@@@@@AA@AA@@A@@@@A@@B@B@@@BAB@@AB@@@@A@@@A@ABA@A@ABA@A@AB@@@@AB@@@B@@@B@@@B@@@@@B@BAB@@@BAB@BA@@B@@@@@B@BAB@B@B@@@BA@@B@B@B@@@B@BBB@@@BB@DBBB@@@@B@B@@@F@B@B@@@@DBD@@@BB@BBB@B@@@B@@BBDBD@B@B@BAD@B@BABA@AD@@A@@B@@AB@@AB@@AB@B@B@B@@@B@DBB@@@BB@BBBB@@@B@D@@@DAB@@AB@@ABCDAB@@@@AB@BA@A@A@A@A@@@A@A@@@ABABA@ABA@@BA@CD@@@B@@@BD@DBB@DB@@B@BDB@DFBBB@DBDDB@@@B@@@@@BBB@@BD@BBB@@@B@@@@@B@@BB@BB@@B@@@B@@@@@B@@@B@@@B@BB@@B@@@@A@@B@@@@@@@B@@@@@@@@@@B@@@B@@@@A@@BA@@@ABA@@@@@A@@B@@A@@@A@@BB@@@@@@A@BB@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@B@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@AB@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@BA@@@@@@B@@@@@@@@B@@B@@B@@@@@@@@@@@@@@B@@@@@@@@@@@BA@@@@A@@@@A@@@@@AB@@@@@@A@@@@@@@@@@@A@@@@B@@B@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@A@@B@@@@B@@@@B@@B@@B@@@@@@@@@@@@@@@@@B@@@@@@@BA@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@A@@@A@@@A@@@A@@B@@A@@@A@@B@@@@A@@@@B@@A@@@@BB@@BB@BBB@@DDDD@@BBBDB@@BDBDD@@@BB@@F@FBD@BBB@@@BB@@@B@BBBBB@FFHH@@BBB@BDDBBB@@BBAH@B@DBDBD@BBB@BDDBBB@@B@@B@@B@@@@BB@@B@@BB@@@BB@@@@B@@B@@@@BB@@@@B@@B@@B@@BB@@@DBBBB@@BB@@@B@@@@@BB@@@B@@@BBB@@B@@BB@@@BB@@@@@ABB@@BA@@B@@@B@@@B@@BB@@@B@B@B@@BB@@@BB@@@@@@B@@@@B@@@@@@@@A@A@@@A@@BAB@@AB@@AB@@ABA@ABAB@BA@A@@BAB@@A@@B@@@@@BAB@@@@AB@@@@A@@B@@@@@@@B@@@B@@@B@BB@@@AB@@AB@@A@@BA@A@@B@@ABA@@B@@A@@@A@@@@@A@@AAA@@AA@A@@@A@@A@@@A@@@A@ABA@AB@@@@A@@@@@@B@@A@ABA@@BA@@B@@@BA@@B@@@BA@@BA@@@@BA@@@@@@@@@A@@@@BA@@@@@@@@@@BB@@B@@@B@BA@@B@@@BA@A@@BA@@B@@@@@B@@@B@B@@AB@@@@AD@@A@@B@@A@@B@@@B@@@B@BA@@@AB@B@@@@AB@@@B@B@@@B@@AB@@AB@@@B@@A@@BAB@@A@@B@@AB@@AB@@AB@@A@ABA@@@ABA@@@A@A@@BA@@@A@@@A@@A@@A@@@A@@@A@A@@@@@A@@@A@A@@@AA@@A@@@A@A@@@A@@BA@@@@@AA@@A@@@@B@@@B@@@@BBB@@B@@@@@B@@@@@B@@BB@@@@@B@@@B@@@@BB@@@@@BBB@@@B@@@@@@@BAB@@@@@@@BA@@BAB@@@BAB@B@@ADABAB@DA@ABAB@BAB@B@@@B@B@B@BAB@B@D@BBB@B@@@BA@A@@BA@A@ABC@A@ABA@ABA@C@CAC@A@A@A@AB@@A@ABA@ABABABC@ABAB@BA@AD@@AB@B@BAB@B@B@BBB@B@BAB@BABAD@BBB@B@BBBBBB@BBBB@B@D@B@B@B@BAB@@AB@B@BABEHGFCFCJADAB@@A@CBA@ABA@AB@BABA@ABABABA@AB@BAB@BAD@B@D@B@BAB@D@BAB@B@D@B@BBD@BBD@BBBBB@B@B@@@BAB@BA@ABA@A@ABA@CBABAB@@A@ABC@A@CBC@A@CBA@CBA@ABABABAB@BAB@D@B@B@B@B@B@BAB@BABAD@@AB@BAB@B@D@B@B@D@B@BAD@B@DAB@BAB@BA@@B@BABBB@BBBBB@B@BAB@@AD@DBB@B@B@B@B@BA@@BABABABA@ABABABCBABCBADCBADABADABCDAB@BABABABAB@B@BAB@B@@B@@BDB@B@BADAD@D@B@BA@ABADAB@@ADCBCBABADCFABAB@BAB@BADABABADCFCFGLAB@D@BADGDGF@@ADABABAB@B@@@B@B@BBB@@BB@@B@BB@@B@@B@@@B@BABADABAB@@@B@B@@B@B@D@D@D@B@B@D@B@B@@BB@@@@B@BA@AB@BA@@DAB@B@@@B@BBBBDBBBBB@@BB@B@@@B@BAB@BAB@BAB@@@D@@@D@@@BBB@D@BBB@DBB@BBD@B@B@B@D@DBB@D@B@BBD@BBB@@BBBBBBBBB@BBD@BBB@BBB@@B@@@D@D@D@DBD@DBB@HAD@B@@BBB@BABAD@B@DDFB@@@BB@BBBB@DBB@B@D@B@D@B@B@B@B@BBB@@@BBBB@D@BBB@B@B@FBLDJAF@HAB@@ABADABABAD@BAB@B@B@B@B@D@BBB@BBDBB@DBBBDBBBBBDBBBDBBBBBBBBBBBBBB@@B@@@DD@CAE@AAC@@@A@AB@BAB@DABABABCBABABA@ABA@CBC@AB@BAB@DAB@D@B@B@B@BBB@B@B@BAD@BABAB@BAB@DA@@FA@@DCDABADCBAFCDA@A@A@AAA@CACAAAA@A@ABABEBABC@G@C@C@A@A@C@ABA@A@ABCBABCBA@@@C@A@AAA@C@CBCBCDEBADEBA@AB@@A@C@A@@@C@ABA@CBC@C@CBA@@BABABABABABA@@@A@A@CAC@A@ABA@A@A@AAAAA@A@@@A@ADAD@DAD@BADA@A@@@A@A@AB@BABA@CBA@A@A@C@@@A@ACCACAAACAA@@@ABADADABABA@CBA@A@A@@CAACCAA@@A@@@A@ABA@ABADAB@@ABADABCBCBA@C@AAA@AB@BBBBBDBBB@BBB@B@@ABADE@AB@@A@ADA@AB@@@BA@@D@DAB@BBDAB@DBDBBBBDDB@@B@B@D@B@B@B@@BBB@@B@B@B@DA@@B@B@@BBB@BB@@BDBB@D@@BBB@BBB@BBBB@BAB@@A@A@@BAB@B@BBB@B@@@@B@BB@@BB@DDDB@@BBBBB@B@B@B@BBF@B@@BB@B@B@B@@AB@@@B@BB@@DBBB@BB@@B@B@@BBBBB@@@@@@BB@@DAB@@@B@@@B@BBB@B@B@B@BB@@B@BB@@B@BBB@@BB@DBBBB@B@@@BBB@@BB@B@@BDBBDFB@@B@B@B@BBDB@BBBBBB@@BDBBBDDDBBBBBB@BBBB@BB@@B@@BB@@@BBBB@@@B@@@@@B@@@@BB@@@@@BB@@@B@@BDB@@@BBB@@@BBB@B@DB@@BB@BBB@@@BB@@@DBB@@AB@B@@@B@@@B@B@@@@BB@B@@BB@B@@@B@@@BBD@B@BBB@@B@@@BB@BBBBD@@@B@@@@@B@@@BD@DD@@@@BD@B@@@BBBBBBDB@@BBBBBBD@BB@@B@@BB@@B@@@B@BB@BB@@@@BB@BBB@@@BBBB@@B@B@@@FB@@B@@BB@@BB@@@BAB@@@B@@A@@BA@@@@B@B@BCB@@A@@BA@A@@@@@A@@DA@@@A@@@@@A@@@A@@@@@@@AB@@@B@@@B@@@DB@@B@@@BAB@BA@@BC@@BA@@@@@AA@AA@@AA@@@@@@@ABAB@@@BAD@B@B@@@B@@@DABA@@@@@A@@AC@@@@@@@@AAA@@@A@@AA@@A@A@@@@BA@AB@@AB@@AB@@@BBB@B@B@B@B@BA@@BA@@@@B@BA@@@A@@@@@@BAB@@A@@@@@AB@@@B@@@BADAB@BA@@DA@@B@@@B@BA@@B@@@BB@@B@D@@AB@B@B@B@@@B@@A@@@A@@A@@A@A@@@@BA@@@@@@@AB@@@@@B@@@BBB@@@B@@@B@@@B@@@B@@A@@BA@A@@B@@AB@@@@A@@@@@A@@@@@AB@@A@@B@B@B@@@@@B@@@@B@BBB@@D@@@B@@@B@@ABA@@@A@@@@BA@@B@@@B@B@BAB@BAB@B@@@@A@CB@@ADCBABA@A@@B@@@B@B@BA@@@A@@@@@AB@@@BA@@BABABA@A@A@@@A@A@@@A@@A@@@@@A@@@AA@@AA@A@E@AB@@@@AB@B@B@B@B@BBD@B@B@@@B@BAB@BABABAD@B@B@BB@@@BAD@BAD@B@B@B@BDDBBB@D@BB@BBPDHDBBBBAHEHCDAF@F@L@F@@@@AAUAAAACAOGCAEEAC@A@@A@BA@A@ABA@@@A@@@AB@@@BA@@@A@@ACA@@AC@AAA@A@@AA@A@@AA@@AAA@A@A@@@@@@@A@@@@@AB@@@@@B@@@B@DBDBB@B@B@BAB@@@@@BA@@BE@@@A@@AA@A@A@@AA@AAA@@AA@A@A@@@A@@@ADA@@FCB@@@B@B@D@BBBBBB@BBBBBBB@BB@DBB@BAB@@@@A@C@@@A@A@@AA@A@@@A@@@AAA@@@@AAA@@AA@AA@@@@@A@@@A@@B@@@@@BA@@B@B@BBBB@DBD@DBB@@B@@@D@B@FCBA@@B@B@B@BBB@DBDBDBD@BB@@DB@BB@BBB@B@B@@@B@@A@@BA@@@A@@BAB@@AD@B@DA@@B@BBB@BB@@BBBBD@BAB@@A@C@CDABABAFADCBABABGBCBCBC@A@AB@@@D@D@F@D@DAFAFCDAB@BAD@F@B@DAHCJGB@DCA@E@C@AAAAAC@ADCBABEDEFAF@BBD@@ABAB@@CDAH@JCBA@A@A@CA@@CAA@AACAA@@CA@AAAA@@A@AAA@A@AAA@@BA@ABA@ABA@A@ABA@A@A@AA@@A@AAA@A@A@@@ABABAB@BAB@B@B@B@B@BBD@BBB@BBB@DBB@B@D@BAD@D@B@BBD@BBBBBBB@BBD@BBB@B@BBB@B@BBB@B@B@BB@@B@BAB@B@BA@@DAFAD@DADCB@JALFP@LBJFF@LGHCJ@FA@EJ@NGHCFEFAFJDLJHJ@J@JBJAFCBADABIAG@E@C@GECWMAIEA@ABA@C@CCCACEC@A@A@A@@@@@@@AB@@@B@@A@@@A@A@@@@@A@@B@BAB@@A@@@@B@@A@@B@AA@@BA@CBA@A@@@@@A@@@BB@@BB@@@B@@A@@BA@@B@B@B@B@@@@@BA@@@A@A@@@@F@B@B@BE@@D@B@DB@@B@BABBFBD@B@BC@@DIPIHEDECIEGCEBCDCECCACG@IAIDCDEDCD@DAB@BA@EBC@AB@DBDB@BB@BBB@B@B@D@BBB@DDD@DBH@BB@@D@F@D@DAD@DABA@@FBDJBNBHDBBBBAFCFIDIBADABEAI@GFAHBLBF@FD@B@E@A@@AA@AAEAC@A@@@@BA@@B@B@BAB@BA@A@@@A@@@ABA@C@@@A@@@@BAB@@@@@@@@@@AB@@A@@@@@A@@BA@@@AB@@A@@@@B@@@@A@@@@BAB@BA@ABA@A@A@A@@BA@@BA@A@@@@BA@@BA@@BA@@B@@A@ABC@ABA@AB@@@BA@@@@@@BA@@@@B@BAB@@@BA@@B@B@B@@@@AB@@A@@B@BA@@BA@@@@B@@@@@@A@@@@@@@A@ABA@@@AAA@@@@@ABA@C@ABC@@@AAA@@@A@A@@AA@A@@@@A@@@BA@@@@BAB@BA@@@A@@@@B@@A@@@@@A@AAA@A@A@CA@@CB@@@@@@A@@@@AAAA@A@@@@@@@@@@@A@@@@B@@@@@B@@A@@BBDB@@B@@@@BB@@@@@B@B@@@B@@A@@@@B@@@@@B@@@@@@@@BB@@@B@@@@@@@@@@@@A@@@@@@@AB@@@@@B@@@@@@@@@B@@@@@B@@@B@AA@@@@@@@A@@@@@@@@@A@A@@@@@AAAAA@@@A@A@A@@@A@@@AAC@@A@A@@@@@@AAA@@@@@A@@@A@@A@@A@@A@CA@AA@@@@@@A@@A@@@@AA@@@@AC@@AA@@@@@@@A@AA@AA@@@ABAA@@A@A@@A@@ABA@@@@@@B@@@@ABC@A@@@@BA@@@@@@B@@@@@B@@@BAB@@@@@BA@@B@@A@A@C@A@AAA@@ACC@@@A@AA@AAA@A@A@A@@@A@A@@AA@AAAAA@@@@@@@@AA@A@@@E@A@@BA@@@A@@@@@@@AB@@@@@@@B@@@@@@B@@@B@@@@BAB@@@B@B@@@@@@@@A@@@@A@@A@@@A@@@A@@AA@A@@AA@@A@@@@A@@@@A@@A@@@@@@@@@BA@@@@@A@@@@AAA@A@AA@@A@@@@AA@@AA@@@AA@@A@E@C@@@A@@@@A@@@@@@@AB@@@@A@@@@@A@@@A@@@@AA@@@@@@A@@@A@@@@@@@AA@@@@A@A@@@A@A@@@@@A@AAAA@@@@@@A@A@@@A@@@A@A@@@A@@@A@@A@@A@@@@A@@A@@@AA@@@@@@@AA@@@@@@AA@A@@@AA@AA@@@@@A@@@A@@@@@@B@@@B@@@B@@@@AB@@C@AA@@A@AA@@@@@AA@@@@@A@AA@@@@@@@@A@@@AA@@@@A@@A@@AA@@AA@@@@A@@@@@A@@@A@@@@@@@A@@A@@BA@@@@BB@@B@@@@@B@@@BC@A@C@@AA@@@A@A@A@@@AA@A@@@@@A@@@A@A@@A@@AA@@@@A@AAA@@@A@@@@ABAA@@@@@@AA@@@A@@AA@@A@@@A@@@A@AA@@@@@CC@@@@AA@@@A@@@A@A@@@A@AB@@@@@@A@@@AB@@@@@B@@@@B@@B@@@@BB@@@B@B@BA@@@@BA@@@@@A@@@@A@@@A@@@@@@@AA@@@@BA@@@@@@@A@@@A@@@@@@@@@A@@A@@A@@AAAA@@@@AA@@AA@@@@A@@@A@@@@@@BA@@BABA@@@A@@@A@@@AA@B@@A@@@@@A@@@@@@B@@@@@@@@@BA@@@@@@@A@@@@@A@@B@@A@@@@@AB@@@@@BABBBBB@DDDBDB@@DAB@B@BA@@@AB@B@FBB@F@@@BBB@BBDBD@@ABABC@EDCBCB@BA@@BCBABCBCBEBC@C@C@C@C@CDADCD@DCBCDCDEBG@C@EBC@E@C@CAE@CBC@C@EACAC@CEAACEACAEA@CDCF@DCD@DCD@DA@IAQIOCBG@EAEEACAAACA@CCCAAAAACBG@EA@@@A@@A@@@@@@@AC@A@@A@AA@@@@@AA@@AB@@@@@AA@@AA@@@AAA@@@AA@@@@AA@@A@AA@@@@@AAAAA@@A@@A@@@@AA@@A@@@AA@AA@@@@AA@@@@@AA@@@@@AA@@@@@A@@@AA@@A@@@@A@AA@@@@@A@@A@@A@@AA@@@@@A@@@A@A@@BA@@@@AA@@@@@AA@@@@@A@@@@@@A@@@@@@@@AA@@@@@A@@@A@@A@@@@ABA@@@@A@@A@@@@@A@@@@@A@@@@@@AA@@@@@A@@@@@@A@@@@@@@@AA@@@@@@@@@AB@@@@A@@A@@@@@A@@@@@BA@@@@AA@@@@@A@@@@B@@@@@BA@@@@@@@A@@A@@A@@@AB@@@@A@@A@@AB@@@@A@@@@@A@@@@@A@@@@@@@A@@@@@A@@@@@A@@@A@@@A@@@A@A@@@A@@@A@@AA@CA@@A@AA@@AAAAC@AAA@@AA@AABCBCFA@ABA@@A@@@@B@AA@@B@@@@@@@B@@A@@@@@@@A@@@@@@@@@A@@A@@A@@@@@A@@@@AA@@@@@@A@@@A@@A@@A@@@@@A@@@A@@@@@@@AA@@@@@BA@@@AB@@@@AB@@@@A@@B@@A@@B@@@@@@AB@@@@@@A@@B@@@@AB@@@@@@AB@@@@AB@@@@@@AB@@@@@@@@@B@@@@@@A@@@@A@@@@@A@@@@@AA@@@@A@@@@@A@@AA@@BA@@@A@@@@@AA@@A@@@@@AA@@A@@@@@@AA@@@@@@@@AA@A@@@@@@@@@A@@@ABA@@@A@@@@@A@A@@@A@@@@@A@@@@BA@@@A@@@@@ABA@@@A@@@@@A@@AA@@@AA@@AA@@@@AA@@@@A@@@@A@@@@@@@A@@@@@A@@@@@C@A@@@@@A@@@@@@@C@C@A@C@ABCBA@CDABA@@@CACAA@@@CAGEGE@AA@AAA@EECAAAC@CA@@AAAA@@CAA@CAC@A@E@@@A@AACAA@AA@@A@@@A@@@@AA@@@@@AA@@@@@@A@@B@@AA@@@@@@@@A@@@@@A@@@@@A@@@@A@@@@BA@A@@@ABA@@BA@ABA@AB@@AB@@@@@A@@@@AA@A@@@AB@@A@A@@@A@@BA@@@A@@@@@A@A@@@A@@AC@AA@@AAA@@AA@@AA@@@@@AA@@AA@@A@@AA@@AAA@@AA@@A@@@AAA@A@@@A@@@AA@@A@@@@@A@@AA@@@@A@@@@A@@@A@A@@@A@@@@@@@@@A@@@A@A@A@@@AB@@A@@@AB@@A@@@@@AA@@AA@@A@@@@@A@@@@@@A@@AA@@@@@A@@@AA@@@@@A@@@A@@@A@@@@AA@@@A@@@@@@@A@@@A@@A@@A@@@A@@@@@AA@@@@@A@@@A@@@@@@@A@@A@@@@@@A@B@@A@@@@BA@@BA@@@@@@B@@@@@B@@@@C@@@@@@BB@@B@@@@@B@@@@@@@@A@@@AA@@@@A@@@A@@@AB@@@BA@@@@@A@A@@A@@A@@@@@@@@@@B@@@BB@@@@B@@@@A@@B@@@@@B@@@B@@@@@@@@A@@BA@@@@@@B@@@B@@@@@@A@@@@@A@@@@@@@@B@@@@@B@@@@A@A@@@@@@@AB@@@B@@AB@@@@@B@@B@@@@B@@B@@B@@@@@BA@@@@@A@@BA@@@@@@@@@AB@@@B@@@@@B@@A@@@A@@@AB@@A@@@@@@B@@@@@@BB@@BB@@@@@@@@@@@@A@@@@@@@AB@@A@@B@@A@@@@BA@@@@@@AA@@B@@@@@@@@BB@@@@@B@@@@@@A@@B@@@@A@@@@@@@@@@B@@@@@@@@A@@@@@@@A@A@@@A@@@BB@@B@@B@@@B@@@BA@@B@@@@@@@@A@@@@@@@A@@B@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@BB@@@@@B@@@@B@AB@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@AB@@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@B@@@@A@@B@@@@@B@@@@A@@@@@@@@@@@AA@@@@@A@@A@@@@@@@A@@@@@A@@@A@@@@@A@@@@@@@A@@A@@@@A@@@@@@@A@@B@@@@A@@@@@@A@@A@@@@A@@@@@@@@@AA@@@@@A@@@@@A@@@@@@@A@@@@@@AB@@A@@B@@@@AB@@@@@@@@A@@@@@@@@A@@B@@@@AA@@A@@@@@@@@B@@@@@@@B@@A@@@@@A@@@@@A@@@A@@@@@@@A@@@@@A@@@@@A@@B@@@@A@@@A@@@@BA@@@@@@@@BA@@@A@C@G@ABC@ABC@@@C@AAA@CA@@CC@@@A@@BEBC@ABCAC@@B@N@B@@@BABA@@AA@ACADCBA@ABCDCHG@@@ABABABA@ABA@ABA@@DC@@@@BA@@B@B@@@@@B@@AB@B@@@BAB@BAB@@@@@@AB@@A@@@@@AB@@@@@BA@@@@@@@A@@@A@@B@@A@@@@@A@@@@@@BA@@@A@@A@@@@A@@A@@A@@@A@@@@@@@A@@A@@@A@@@@@AA@@@@@ABA@@@@@A@@@@A@@@@AA@@@AA@@@AA@@AA@@@@@@A@@@@@A@A@@@@@A@@@@BA@@@AB@@A@A@@@A@@@@@@@AA@@@@@@AA@@@A@@@C@@@A@A@A@A@@@A@@@@@A@@@@@AA@@@@@@A@@A@@A@@@A@@@@@A@@@@@AA@@A@@@A@@AA@@@@@@AA@@@@@A@@@@@@@@@A@@@@@@@A@@@AA@@@@AA@@@@@A@@A@@@@@A@@@@@A@@@C@@@A@@@@@@@A@@@@@@@A@@@@B@@A@@@@@@@A@@@@@@@A@@A@@@@@@AA@@@@@@@@@A@@@@@AA@@A@@B@@A@A"],encodeOffsets:[[104138,24245]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
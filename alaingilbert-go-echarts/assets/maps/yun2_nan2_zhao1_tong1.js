@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("昭通",{type:"FeatureCollection",features:[{type:"Feature",id:"530621",properties:{name:"鲁甸县",cp:[103.558042,27.186659],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@@A@@ACAA@@AA@@@A@CAICE@A@A@A@@@@@@D@FAD@DCFC@ARAHBB@@@DBBBD@DAB@@ABA@C@CBGBCBABA@CBA@A@CBA@A@@AE@@@A@AA@CBA@C@@@CAA@C@A@C@CB@@ABCBCBA@A@C@EDCBA@A@ABEDEDABC@@BA@A@C@AAAAAC@AAC@AAC@AAC@ACC@@AAE@A@AA@A@A@@HIDG@E@ABADC@ABABA@ABAB@BA@ABAB@BABAB@@BBABBB@B@BA@@@ABA@A@AAA@A@@@A@ABA@A@AB@@@@ABEDE@@AA@@C@A@C@GGCCE@AA@AAA@A@@@@DAAA@A@C@ABA@A@A@A@A@AAEA@CAAAA@C@EAE@A@A@AAC@EBC@G@C@C@CBC@CBEBE@ABAB@B@B@BAD@BABGDEB@@CBA@C@A@CBAACACACAA@C@E@C@C@CAA@C@CCCCACCAC@A@CAC@C@GDABC@CBEDCBABAB@@@BAB@B@BCB@@A@A@A@A@C@CAEBA@A@A@@@A@@@AAAA@AACCCAA@AAACAA@CACACAAACAAAA@A@AA@AA@A@AAA@C@C@A@C@CBADA@ABABA@@BCBABCD@@@BABA@ABA@C@ABCBC@ADAB@@@F@B@D@B@B@BBB@BCD@BAD@D@BCDABA@ABEBA@CDABA@GAABC@A@A@CBEAE@C@ABAB@@@B@D@B@BDF@D@BADABABEB@@IBC@A@A@CBC@A@ACCCAEACACAA@EAEAA@CA@CGCECEAAAAA@EAA@AAAAAAGGAAACAAA@AA@A@A@C@A@AAAAAAACBA@ABABABABEBABA@@@@B@B@@ABAB@BBB@D@BADCBCDABABCBC@CBA@E@C@A@ABAB@@A@@@C@ABA@EBEDGBCBABA@@D@@@B@B@BAB@BA@CBABADAD@B@B@@BB@DB@DDB@DBBDBD@B@B@BAF@@@B@BBBDFDFBB@BBBBFBBAB@B@B@B@BBB@BBBBB@@BB@BB@BDBDBD@B@BAB@B@DBBBB@B@BBD@B@B@B@BAB@@@BABB@@DAD@BAB@BAD@BABAD@@A@A@A@ABABA@A@A@A@A@C@CB@@C@C@A@A@C@A@C@C@A@C@A@AAA@CBC@C@ABCB@BAB@B@BA@ADCBA@ABAF@BABCF@BAD@BABC@@BC@A@ABA@AB@@A@AB@@CD@BADBH@@ABABCF@@@BBB@DA@CDABABEBAB@D@BBD@B@B@BABADAB@B@BBD@BCDADA@CBCBED@B@B@D@B@BCDINCBCFAF@BBD@BDBDBBD@B@@BBDBBBHD@BBBB@B@BBFDDB@@@DBD@BBBFHDFDD@B@D@BBF@@@BCD@B@BB@@BBBBD@D@B@F@DAD@DBDBD@DADAH@FCHEHAD@BBD@BAD@BAF@D@BHL@@BB@DAHBJ@DADADAB@DCDABADADAB@BADAF@BAB@BBJ@@AB@@@BABCDABEBAB@DABA@A@A@CBCBCBCBC@EAEAGAC@C@A@CDADA@C@ABC@A@@BCDABCDCBCDEDJ@D@F@DAD@F@D@DDBBFAD@B@B@B@BBBDB@D@D@D@F@DBDLB@DB@BBBDBB@@A@AB@B@D@LHD@@@BCFI@EAEAC@AB@B@DBBBBBAHBBBDB@BA@AB@B@B@BFDB@@BD@D@@@@B@BCFAFCDCHA@A@C@ADAD@D@DADAFC@CACBABCDCDADABAB@DAB@@@B@@A@AAAIEAA@A@AB@BALC@@BA@A@C@A@ABABA@ADAD@F@@@FAH@BADC@C@GDEDCBCBCDCBADCBCBABCBCBEBCFADCDADCDAD@FAHCHADAFABC@ABABAFBFADADB@DCDCDADBDFBDBB@BF@DADAB@B@BBBBB@B@D@BD@F@FA@CDEHAD@BDBDBD@HFFD@DADCJOBCB@BBJDDD@B@BCDADAD@@A@@DAHDFDBDDBDBDABGDM@MFEF@B@BHJHBFBPDD@BB@BABCBKLEFCFGFCBC@ABED@B@@CB@DB@@BBBFAF@B@B@B@B@B@BB@@@B@BABEBADC@CDCBCFABEBEBAB@BA@@BA@@B@@@B@B@B@B@B@@BBBBDB@@A@@@AB@@@BAD@@@BAB@BA@@B@BBBD@D@BBHBDBDDBBBFDDBD@DBHADADCBCBCBE@CDCDADAFAFAD@D@DBDCFAB@H@DBJ@B@@BB@@B@BB@@D@@BB@@BB@@B@@B@@@@@BB@@@@@A@@@@B@@@@@B@B@@@BBB@BBB@BBB@@@B@B@BB@@B@@@DBDB@@B@BAB@BBB@DA@@B@B@B@@@@@@@BAB@B@@@BABABADB@AB@D@BABAB@@@B@@A@@B@BA@@B@@A@A@@BA@@BAD@BA@@BAB@B@BBB@DF@D@D@F@@@B@DBFDDDDBDBDADDDFCDADCDADCDCBADA@CCE@C@CDCDC@AEEAG@GFE@@@A@@A@C@AAA@@@A@B@@AB@BABA@@BABC@AB@DAB@DCDEBAB@@B@BABABBBDB@@FFDBD@@@BA@@B@@ABABAB@B@BAB@B@@AAAA@@ACCAGAC@@CCAC@EDCDADAFA@@BABC@I@KAEAACEDCBCACAEACACAA@C@A@ADCDCBCDGDCBEBADCBADCBCDCBEBADCBABE@ACCAICEGS@E@GDIDEFIBE@ECIAACC@EBAAGCCCCCEAEAACICEEEAECCCECEAEAC@E@CAEACAGAEAECECE@@AC@EBCBCFA@@DAJBDBF@BBJJBBHBFALADCBAB@DAB@BA@@B@@@B@BB@@@HBBD@FCDAB@D@HDJDBBD@@@BABA@A@AD@BBBBDFJDDB@@B@BB@B@@HFD@NGHEDGDAB@BAB@BAB@B@B@B@@AB@BA@@B@@@BA@AB@D@B@D@B@DAD@B@B@BBB@BBBBB@@BDB@D@@D@@@B@D@@@@B@DBDB@F@HGBEBCJGBCBCBMCKIOAAAE@EBCBABE@C@A@AB@BA@ALANCLAH@@@B@BABAD@BABABA@ABABABAB@BC@ABADABABA@ABAB@BABABA@AD@@ABABABABA@A@A@C@@@C@ACAEAIAUEKAIA@A@@AA@C@A@AAA@AAAC@AAA@AAA@@ACA@@AA@A@A@A@AAAA@AA@AAA@AAA@A@@AABC@A@A@A@A@A@@@A@ABA@ABADABC@@BABA@A"],encodeOffsets:[[106115,27768]]}},{type:"Feature",id:"530622",properties:{name:"巧家县",cp:[102.930164,26.90846],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@B@D@@@@@@A@@CAC@CA@@CBAAA@A@A@@ACAA@A@A@@BAB@BA@@@@BA@AB@@@B@@B@B@@@@@BABA@AB@B@B@B@@BB@B@DBB@D@F@"],["@@AACCG@CC@CFGHG@ABC@A@ECCEECCAEG@CCAA@ABC@AFGBCAA@CCACCIGICCAACE@EAICMC@@A@AAA@CAA@@@C@@@@@A@@B@BC@AD@B@@AB@B@@@@CB@BA@@@A@A@CBABA@A@CB@@ABA@@@A@@BA@A@@BA@A@CBA@@@CB@BA@@@A@@@ABA@@@@B@@AB@@@B@@E@C@GDEDIBE@EAECGA@CBGACCG@ACAICIGICEACDCDE@GAEACC@CDADA@CAAKCKEAA@ADAD@DCAC@SDIBECE@@GGAE@E@IAK@IBGDGFAJ@RDDABA@AA@E@E@EAEAMC@BGB@BABA@AD@BABABE@BDBB@B@DAD@DABADABABC@C@C@CACAE@ABA@ADAB@@ADADAB@D@DAF@D@DABAB@DADABABABABADABCDAD@B@D@B@@BBD@DBHF@@BBBD@B@DAD@H@DAB@DAD@BBB@DBD@D@B@DABCD@B@B@FADAD@BBBDDBD@B@DAB@D@B@DBD@B@D@B@DCDEDCDCBABEB@@ADA@@BA@@@CBA@A@AB@@A@@B@@AB@B@@@BBD@B@@@DAB@B@@BB@B@BBB@B@@@B@BAB@B@B@@@BB@BBBBB@@D@B@B@DAD@B@BABAB@@@BCBABEDA@ABCH@BAD@B@BB@B@B@BBBBB@@B@@@BBB@@AB@B@B@BABABA@CBC@ADAB@D@B@B@BAB@@@B@BADABAB@DAD@DABADA@@BAB@@@BAB@B@@@B@@BBB@@@BBDBBBDD@D@BABEDCBCDADADCD@D@D@@@D@BB@@B@@@B@B@B@@@B@B@B@B@B@BAD@BCDABCDCBABAB@BADADADAB@@@DBBBB@BBB@D@B@BADADCBCDCBABA@A@@@C@AACBGBCB@@ABABADCDAD@BCFADADABABCDABABABABABAB@@@B@BABGF@@AB@BA@ADAD@DABADADADABABADAB@D@BA@@D@B@BA@@BA@ABCBABA@ABA@EBADABAD@B@D@B@BBD@B@@@BCD@DAB@B@BBF@D@B@@@BCD@BCDABAD@BAB@DBBAF@@@D@F@D@BDB@BBB@BBD@D@DBD@BBB@F@DBDAD@B@DAD@F@D@DBB@D@B@D@D@DCFADEFCDEDEDEDCFCDCBAD@B@B@BBDBBBB@DDBDFBBBDBD@FBDBD@D@BBD@DBD@BBDBB@BB@BB@BBBDBBBDBBDBBBDBB@D@BAF@DBD@D@D@FBDBBBF@@BDBBBDBDBD@DBDBDBD@D@DBJ@@BF@FBD@DBD@@@B@D@F@DADAF@BBBAB@BADAB@DAB@BAD@DADADAD@DBDBBBD@B@DBB@BADABAD@@ADAD@DAD@DAB@BBBBDBB@B@D@D@D@B@DAFBF@D@D@D@FAD@D@DADAD@DADC@CDABCBCDCBABCB@B@DAB@BAB@DADAD@B@FAF@B@F@DBB@DBD@FBB@DAD@DAB@DAD@D@B@D@BBDBBBBBBDBBBDBBB@BDDBBBDDDBFBDFRHPBHBHFNDFBBDFBFBDFFBHBHBFHJHHFJDLDNDHBBDFBD@@DBBBB@D@B@B@@@B@B@BA@CBADE@CBG@CBCDKBCDCFGBABEBABAFCBAHGHC@@B@F@DB@@DBHBDDDBFBF@JFHBF@LFFBFDBDHDBBDFDBFDHJFDDFDDDDBBF@DBD@D@BAD@FADADCBEBABADEBA@@B@HBD@FBF@HAHAHBDB@@FBFBFFDBFDHFDFDBHDFBFDFBBBDDBC@ABABABE@C@CBEDGDEHG@AFCDCDADCBADC@AB@D@BAD@B@BCDCB@D@D@HBFBFBD@DADADADAB@B@B@BA@CBAFABADCBA@A@@BA@@AI@ABA@ABEBC@ABABCBCBADC@CBABCBC@CAIBG@CAA@@GK@A@CBE@ABC@AAC@ABCFGDG@EBGBC@CACAC@CBC@C@E@A@CACAA@AA@@A@ADC@A@@AE@A@C@ACCCEEGAA@AAC@C@@CAECAAA@A@AA@AGCAACAAA@@@AACCACA@AAC@ABEDEDAJMDC@A@A@C@A@AFCDADAB@BCDC@AAC@A@ABABCBA@A@A@AAC@A@CBAFABABADCB@@CAA@A@@DEBABA@@AGBC@ADC@@BAB@@@BAB@BAB@D@@AD@BA@ABC@ADEBA@ABEBAB@DABCB@@A@ABA@ADABAD@D@DAB@BBB@D@B@D@D@B@D@B@B@D@D@@@DAD@B@B@B@B@B@BABAB@B@B@@@BCBA@ABC@ABA@ABC@CA@BA@A@@BA@A@A@A@AAC@A@AAAAA@C@ABA@A@AACACACA@@AAA@@AAAA@AAA@A@A@A@ABAAAAEAA@AAACECEAA@A@A@@BE@A@A@AACACCAA@CCA@@CAA@@@A@ABCBCBADAB@@ABA@A@A@A@@@CB@BADAHAFCFAB@BAD@@@B@@@BABAB@D@F@B@DAD@DABABADCDABC@A@CAA@ABABA@@@A@A@@B@BAFABABABABAB@DABBBBBB@B@B@D@B@BBBB@BBBDBBHHBBBBBBB@FBB@BBBBDFDFDHB@@DBBBF@FBBBDBDBFDDBDB@D@DAB@B@D@JA@@FABABABC@A@CCE@A@A@C@A@@BABAD@F@FBDAB@B@D@BAHBB@BADCB@FABAB@BADC@A@CBC@ADC@AAA@A@A@A@C@A@E@@@@AAAAA@AAAAAAAA@A@ABCFA@@HADADCDAFEHKFG@IKK@CBA@@DGBC@IAC@ECGACACAE@AAIEEAAA@CCCAAA@CA@@AAG@AAE@AAC@C@C@@@AAAAACC@AG@MBEDGBCE@CDELCHADC@E@G@EAA@CBCEACG@@@@@@@C@EACCECGEEGGCCEC@CFIBCACCMAEAIAIEEIG@E@@@@AIGUKKAIDIJIDGJGLCFCDI@KKMAIBA@CFCJBB@F@DA@CCAEC@CBCFCJCFC@ABECEGCC@C@IFQDKBIDANFTADEBK@MEKGGMSMEAE@C@EBCBCBGFKFEBABA@CDCLGFABA@@A@@@@AMAAAAEA@@C@ABKBCBC@C@G@K@GACGAA@@@@AAIBUFCBCACACCAA@IAE@CAE@CAC@ABEDCFC@A@AECCCA@ACCEGGAE@E"]],encodeOffsets:[[[105888,27380]],[[105704,27317]]]}},{type:"Feature",id:"530602",properties:{name:"昭阳区",cp:[103.706539,27.320075],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@A@@AA@@@@A@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@AA@@@@@@@B@@AA@@@@@@@@A@@A@@@A@ABA@@@@@@@A@@@@B@@AA@@@@@@@@@@@@A@@B@@A@A@@@@@@@A@@@A@@@@@@@@AA@@A@@AC@A@G@C@ADC@A@C@CECAA@ABA@A@A@C@@E@E@AA@A@G@OAC@CBE@E@@A@A@AB@BCB@BA@CBI@GBCBA@AAAAAAAA@AAA@AA@BA@A@ABA@A@A@A@A@AA@AAA@AAA@@AA@@AAA@AA@AAA@C@A@CA@@A@AAA@A@A@A@A@A@AB@@A@AB@@ABC@A@A@@@A@C@A@AB@@A@@@C@CA@@@@CC@CBQ@GAEA@A@GDEHA@A@@@@C@A@@BC@@@@@A@CBA@A@AAA@@AA@@AAAA@@CAAA@AAA@AAA@A@CA@C@A@C@A@AA@@@AB@BADA@A@A@CC@E@C@AA@@AA@@AAAA@AA@AAAEAACAE@K@G@ACAKAE@@ACCAGC@A@@AA@A@AAA@A@C@A@@@A@AA@@A@@A@AAA@AAA@A@AAAAAA@AAC@CAAAAAC@AACAA@AAC@@@AA@AAAAAAA@A@A@A@ABA@A@A@A@@@A@@A@@AA@A@@BEHY@CAEAACAG@CAA@AAACAA@AAA@A@@AA@BA@ABABA@A@@BA@A@A@A@A@A@A@A@A@@@A@@@CBA@A@A@C@AA@@AA@@AAA@A@AAAAC@AAAA@@AAAAC@ABA@@@ABCBC@E@ECA@A@@AAAA@A@CB@AC@C@@@AAC@AA@AAAC@A@@AA@@BABAB@@ADCBAB@BAB@B@B@@@B@B@B@B@BADBB@@@BBB@BBB@BBBB@BB@B@B@B@BBB@@DB@BB@BBB@BBD@BB@BBB@B@B@DBB@@@BJBLBVFJBFBDB@B@D@@@D@B@BABABABAB@BC@@BABABABA@AB@BABABCBAB@BADA@ABABAB@BABABABC@ABABA@@@G@KBMDKB@BABA@@B@B@DAFABAD@FBFBBJPDLANADADIHADAFGHE@A@AC@C@A@@C@A@@@C@@@@CCA@AA@AAAAA@AAA@A@C@CBA@C@A@C@A@@BAB@@A@@@ABA@@BA@A@A@A@ABA@ABA@CBCHGFMHC@GE@@@AAAA@@@CAICCEAAAAC@@B@BABAB@@C@AAICGCC@A@CBEDC@AA@G@@AAA@@@A@@@ABA@CBA@ABCDKBEBGAAAIIAAE@CAIACB@@EBADAD@FBD@@DFDFBFBFBHBDBF@D@FBDBFDFDFDDBFFFDFDJBBBFDFDDDDBHAB@FDDBBDJ@FAFEJCFCJ@H@FHTDFBJDD@BAFABCDABAFCDADCDABCDABAFCDCHADCDCD@B@B@DBBBDBDBFBDADCDDFBBBF@L@JADAB@@EBCBCBCD@FBDDD@@BDBHDD@BB@BB@BA@A@ABA@A@ABAB@BA@@@AB@@C@CAEE@@CAAABABA@A@AA@ABCFCDA@CBA@@BADAB@@ABABA@@BA@B@@@B@BBD@B@@@@B@@EF@HBHFF@BCDCD@D@DDF@DCBABCDCDCBCDCBEDCCBCACACCCCCAE@C@A@@@E@C@CCEA@AAA@A@AB@@ABC@AB@@AB@@@B@BA@@@ABA@@@@BA@@@A@ABABC@A@@BCAABABAB@@A@A@AB@@@@@@A@A@A@@@CBA@AAA@ABA@@@CACA@@A@@@AAA@A@@@A@AAA@AAA@AA@@A@A@@@@@A@@@@@@B@@@@AA@@@@A@@@@AA@@AA@@AC@@@AAA@@@AA@@@AAI@C@GBADEAC@C@CBCBEBEBCDCDCF@DADADABCBCAG@CACCCAEAACCCAGAAAC@C@AA@A@AB@@ABA@A@@BC@A@@BA@@B@@@CAAAAA@@@A@A@A@A@A@@@AB@@AB@@ABAFAFABADEDADCD@BCFABA@A@A@@AAA@A@A@A@A@E@EBAA@AA@@CDA@@@AFCBAD@DAHEDEFELKDABA@AAAC@OCEAGAGI@A@AFENEN@HCBAACACCCCACEBG@CB@@@BCBCDC@A@ACCICAAA@ADIPCDCBC@EE@GACACACC@GBCF@DEBE@C@@A@C@AAAAA@A@ABABC@CAEA@CAEAACBCDCDC@CCACBEBEAABAB@BADEBCBGBGDEBC@CBCDCBCDEBADAFADADABADCDABCDADADCDCF@H@DCDABG@EB@@E@C@CB@BABAB@B@B@D@BAB@@KDABA@@B@BBBJFBB@B@BA@@@A@CBA@ABCBCBCDADABBD@DEDCBCBC@C@CB@B@D@BGBCDEDEBADA@@@@@@CAC@@CAAEA@A@A@@BABA@ACAABGAAAACAA@A@@BBDBF@FEJAD@@C@KGC@A@A@@B@BA@CAAA@ACAA@CKCAE@C@C@C@A@ACAAA@A@A@C@EBAACCC@E@C@CBE@C@I@@BGHCFCHAF@D@DAFABAB@BADBBBD@F@D@F@F@HAD@D@F@D@DDDBDBDBFBFBDDBBBBBBDDHBBBHDBDFDDBB@DBFBBDB@BB@BBB@D@BBDBBB@D@F@FBDBFDFBFBDBDDDDFDBFBDBHBHBFDBDBB@FBDFFBD@BBB@HDJ@HBHBDFDHDFBHBFBF@@@FAFAJ@F@D@D@F@DAB@@@BADMBCBABCB@@@FCFCF@B@@@DCDA@@DEROBABAFCDCFCFC@@FADAFAHEFADABCFAFADABEBCFEDCDAD@BD@DBDF@NGF@L@HBLJLDPDBB@F@JAHEHEL@J@FBDLF\\LTFF@@@B@J@@@HBF@D@B@D@LAD@D@DB@@F@HBDBBB@BAB@D@BBBDJ@DBDBD@@BBHADAH@DCFCFCFADADAD@FADCJADAF@BABADCDABCB@B@BBBBJN@BBD@B@B@@BF@@@B@D@B@B@B@B@@DBB@BBF@DADAB@DADA@CBA@ABAB@@@@A@ADCB@B@BBDBB@B@@A@AAAAC@AAACAAA@ABAB@DB@ABA@A@AA@CA@A@@BCDCB@AAA@AAAA@A@ABC@A@@@@@@@@@AA@A@A@@A@@BC@ABAB@DAB@D@B@BAAACA@AB@B@BBBBB@DABC@A@AB@DBB@BABABBBBBBBABAAAAC@@BAB@FDB@D@B@D@BBDDB@DBFBF@B@BBDFBDFD@DB@@B@@@BABAB@@AB@B@B@@BB@BB@BBB@@BB@D@B@D@B@B@D@B@@BB@@B@@@@@B@BABA@ABEFMFCDAB@H@B@@@@BDBB@B@B@@ABABCBAB@B@@@DBDB@BBD@DANGLCJED@B@BB@BCP@DDJ@DBBDBJBHBD@BAB@BAD@BADA@@BABABCDABABAB@BA@@BAB@BBB@F@D@BD@@DDB@DBB@B@@B@BAB@D@D@F@@A@@BA@@BA@@B@@@B@@AB@@BB@BD@D@B@D@BAB@B@DBBAB@D@BAB@BAFABAF@B@B@B@F@B@B@D@F@BBB@B@B@DAB@D@B@@B@BAB@BAFAD@@B@@@@@BBD@BBBBBB@BBDBBB@@@BBB@D@BA@@@@B@@@B@DAB@@@F@B@H@F@H@D@B@DADEB@B@DBJDDB@B@BABABA@BDB@D@D@DBBD@D@D@D@BBBDFBBBB@@@BB@B@D@@@D@BB@@BA@@BAACBABABAB@BAACA@AAEAAAA@@A@@@AB@BAB@@CDABABB@@DBBDBBBD@@DDB@D@D@B@D@D@B@D@F@D@@@B@@BAD@B@@DBBBBB@@B@B@DABAB@DBB@B@B@BB@@@BBB@@BB@BBBDB@@B@BABAB@BBB@B@BA@@BADEBADA@ABABA@@DABADEBABAB@D@BAB@@A@@CACA@@@A@ABA@A@ACE@A@ABAB@HCDABCB@BAB@BBBBHHDBB@HDDDDDBBB@D@B@B@@@BA@@@E@AB@D@B@@@B@B@B@BBB@@BB@@@BBBB@DAJB@B@@@BBFDB@BB@BFBDBBBD@B@D@DBD@BBBAB@BCBA@@BABABA@ABAB@DAB@B@D@B@B@J@B@@ABABA@A@EEECKEI@I@C@C@CAAAC@ECIAA@A@AA@@A@@@CAAAA@@@A@AAA@@@AAAAA@AA@@A@@AA@A@A@@AA@AAAAA@AACA@@A@AAA@A@ABC@A@@BABA@@DAD@B@@AB@@@BABABABC@CAAEAECCCEKAACAAAAAA@AAAAA@CAAAAAAAAAACAA@A@AAA@A@@@AAA@AAA@@@AAA@@AAA@A@AACAA@A@AAA@AA@A@@AAAAA@AAAAA@@AA@AA@AAAAA@AC@M@IACC@M@@@C@CBA@A@C@C@A@A@AAA@AAABC@A@A@A@ABA@AA@@AAAAA@AAA@ABA@ABA@ABABABA@@B@@@LHFAD@BABA@@B@B@BAB@BC@CB@@A@@BA@AB@@AB@B@@CB@D@B@@ABA@C@A@AAAAAAA@AAA@E@CEEEGAE@CDCFCB@B@BADADCBABA@@BAB@BADABAFB@@@@@ABAB@BABCBAB@@ABA@@BA@@BC@@@@ACAA@C@C@C@G@@@E@A@AACBA@A@CAC@C@A@G@C@A@CA@BC@CBA@@@A@@A@@@@@@@A@@A@@@@CA@@A@@@A@@@A@@@@@@@A@@@A@A@@@A@@AA@AA@@AAAA"],encodeOffsets:[[106384,27995]]}},{type:"Feature",id:"530623",properties:{name:"盐津县",cp:[104.234441,28.10871],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@@B@@@@@BBB@B@@@@@B@DCBAB@BCDADABCDABGBC@@DCBADCFCBABABA@ABAAA@AA@A@A@A@ABAA@@@A@ABABABA@@@@@@@@BADABABABABABABAB@@ABA@@@A@A@AAAAA@AA@@A@@@AB@@AB@B@@AD@B@BABA@ABA@ABC@AB@@ABCBAB@BABAB@B@D@B@DBB@DAB@D@BAB@DA@ABABCBABCBABABABA@AB@BAB@B@B@B@B@B@BAB@BABA@ABA@ABABAB@BAB@BAD@B@D@B@DAB@BABA@AB@AA@AAAAA@AAA@AAAAAAAAACCCAAA@AAA@AAA@C@A@ABC@A@C@A@A@CAC@A@C@@@C@@BAB@DADCB@HGBAB@DADADCBA@CAA@ACA@@AAA@A@AAC@A@A@CAA@CBA@CAABAAAAAA@A@C@AAA@AAAAAC@@@A@@B@B@B@@@B@BA@ABAB@@ABA@ABABA@ABA@A@CBABA@A@CDAB@BAB@B@D@BABA@ABAB@BA@C@A@AA@@AAAAA@AAAACCCAAAAAAAAA@AAAAC@CAC@C@A@A@@@A@AAAA@A@C@@@C@A@A@A@A@AAA@AACAAAAAAAAA@AAAAAAACAAACAA@AAC@@@A@EEGG@C@E@CCAE@EBEJABAB@BABC@AAAA@AC@AAA@AAAAA@@AAAAA@@AAA@AA@@ABC@@BAB@BCBAB@BABABA@A@ABA@A@A@A@A@C@@ACAAA@@AAA@AAA@A@ACAEGAC@EAECCEE@@BCB@BA@@BA@ABA@A@AB@@AB@@@B@B@B@@@FABABABC@ADABA@C@AA@BCBA@A@A@AA@@A@@@CBCB@B@DEBEBCDADCB@@@D@B@B@DB@@B@BBBBB@BBBBBBDBB@DAB@B@D@B@DBBBB@B@DBBBD@BBDB@BBFBBBBBBB@B@B@B@D@B@BAB@B@BA@AB@BABA@ABABA@ABABA@@BABAB@BAB@B@B@B@BBB@BB@BB@@BBB@@B@B@B@@AB@BEBCFAB@@AFAB@@@D@@@B@B@D@DCBCBCBCECCCCCAGEAAGCEAAAEAEG@AACAAACAACCEACACC@@AEAACCACCAAAMECAECGCA@ECACAAIGAA@AACACMK@@AAA@@ACCAACE@ACEAEACCACCAC@AAA@CCACCCAC@@AEBC@CBC@@@A@CACAA@C@C@A@@BADAD@B@D@BB@BDBDAD@BB@BF@@B@JD@@BBDB@NADAF@D@DDDDBJDBAB@B@B@D@B@BBB@B@BBB@BB@B@B@BABA@ABCDA@ABA@AB@BABAB@BAB@BABABA@ABC@ABA@ABCDAD@BAB@BAD@BCB@BA@AB@B@BAB@B@B@@@D@B@B@B@BAD@BAB@BAB@@@F@FABA@A@IAA@CBABCBGBEBCBA@AD@DAL@DFHFHBD@@@B@B@@@B@BA@A@AB@@AA@@AAA@@@AAAACAA@AAAAA@CAA@A@CAC@A@A@A@A@A@A@CBA@@@AB@BAB@BAB@@ABABA@ABAB@@AB@BADAB@BAB@B@B@BAB@DAB@BA@@@@DAB@B@B@BBBBBBBB@DBB@@BBBDDFBBDBD@@@HBJ@@@@B@B@B@B@D@D@B@BAF@F@B@@@B@@BB@@@B@@@B@@@@@BB@@@@@@B@@@@@@@B@@BB@@@@@@BB@@@BB@@BBB@@B@@C@MAC@ABABAB@@ABCDCBCBA@CF@H@DDDFF@B@F@FADAB@D@DADABABCBEBCBGAI@G@C@EB@DBBBBDBDBBDDB@DADABABAD@F@DABC@C@E@CBE@CBEDABCB@F@@@HADABCDA@ADADBD@D@FDDBBBB@D@FCDAB@FBD@DBFBF@DBFBD@@@BBF@FDD@@BBBBB@BBBBFDF@DBFADB@D@B@D@DBB@B@FADAF@DBD@DBF@F@FBF@DAFABG@G@EBCBCDADCDABCDAD@D@BADADCBCBCDA@AD@D@FAFEDCDADADABAD@@AB@D@B@DBD@FBDFDBBFBDBDBD@BAF@DB@@BBDBDBFBFBD@FBBB@DCDCBCFADADAD@DADABCBADAD@D@F@FBF@D@FBDDH@DADCBADABADADAFADCDADCD@B@BBDDFBDBDB@BDFDDBDBFBFBF@DAHAB@FBHBDBDAFAFADDBBBDDBD@DADADCBAD@F@DBF@DBDB@DAD@F@DAF@D@F@FADBBBBD@D@D@FAB@BADAD@DBDBFBB@F@F@D@FBBDDBBBBDDDFFDBBBDBFDFDFBH@F@DAF@HBDBD@F@DBH@F@F@D@B@D@B@D@@C@ADAB@@@FCFGJCD@D@FBD@FBBABAD@BAD@D@D@FCFAFAHAFADA@@@AAAEAC@GAE@IAE@CCCACCBAF@D@HAFBF@DBFBD@B@D@F@DAB@DBDDDDDFFDBFBFDDF@F@DBB@DBBBDAB@B@B@@@B@@@@@BBB@B@D@B@@@B@BC@AAAAAACAAA@AA@CAC@A@CBEBCDCBCBEBEBEBEBE@C@A@@BA@A@C@@B@BABA@A@@F@D@@@BAA@AE@A@ABCDADAHCJADAB@@AACGGCE@@CCGE@ABAB@D@BCBCBAD@DAF@FAD@H@D@DD@@FDHBFDDDBFDFBFDFFDDDFDDDD@D@D@DADBDDDDBBBAB@FAH@D@D@DCFADADAD@DABA@@@@@@@@B@@E@CBCBEBCBC@E@E@E@CDC@@BADADCAEACAGBCDADADADCBEDCDE@A@CACFCFAD@H@FBDBDB@@FBFBD@F@DAFA@@F@D@FADAAEACCCACACDEBADADABADCDCDCDABADE@A@AACAACAC@EAC@@EACAABE@CBAACACCECCCCAAEACAC@C@A@E@A@SAAAA@@A@A@A@@AAC@@@C@C@C@C@IA@AAC@CBE@ABABEDCBCBC@CACAAAC@CDCB@FBD@BCDADA@@D@DAD@H@DAF@F@F@FBFBDBDBHDDADABCBA@C@ACAC@EAE@CAACDCBE@E@AAEACAC@G@C@@@CBCB@FCDEBE@CAE@EAGAEEAGBG@E@C@CABK@CA@CAAAAC@AEACAAAA@E@C@EBEACACACACACCACAC@C@@@CBC@CCC@A@CA@BABC@CAAAECC@ACAA@A@C@@@A@C@CACCKMA@CAC@C@EBC@G@EBGBE@CBEDCB@@C@A@CACCEAE@E@C@C@G@I@GAIAECCAEAAE@CD@BADCFCDCFCFAFC@@D@FAB@DADC@CBAB@@@@@B@@@@@B@@@@@B@@@@@B@@@B@B@@@BA@@B@@@B@BAB@B@@@@@B@@@B@DA@@BA@@@@B@@BB@@@BA@@B@@@@A"],encodeOffsets:[[106854,28693]]}},{type:"Feature",id:"530624",properties:{name:"大关县",cp:[103.891146,27.747978],childNum:1},geometry:{type:"Polygon",coordinates:["@@CAA@ABCBA@A@@@AAAACA@@@ABC@AA@@@C@E@C@A@C@C@A@C@C@A@CC@@ACAAACCA@@AAABCB@DA@ABA@@B@@@BB@BBFBBBB@BDABA@ABABABBDAB@@ABAB@BA@ABA@@@@B@D@BA@@B@B@@BB@@@@@B@B@B@BA@@BCD@@CBC@@BCBA@@BA@@B@BA@CBCBADCDAB@BAB@FA@AD@@@BABBB@@@B@D@BBD@B@D@@@BAB@@AB@B@DBD@@AB@@@@ABA@@BA@@B@B@B@B@FAB@@@BDD@BABBD@B@@ABAD@DAB@B@BBB@BBBBBBBBF@D@B@B@FBBBB@B@D@B@BBD@D@@@B@@ABAB@@@B@B@@ABA@@BA@@BB@@D@BABABEBABCBAB@BAD@D@@@BA@A@AB@@@BBDA@@BABA@@@CBA@@@ABA@@B@DAB@DCBAB@BADADABAD@@@@@BB@BB@@@D@@BBB@BBBBBB@D@@BB@@BB@B@BBB@@B@DBB@BBDBDDB@B@BBBBD@B@@@B@BABAB@B@B@DADA@@B@B@D@DAB@BB@BB@@@DAB@DA@@BBBB@B@BB@DBDB@BBDBB@@BBB@B@BBFDB@BBDDB@DABB@@@@B@BDBB@@DABBBB@@@BA@@B@@@B@B@BCD@@ABABABCFAB@@AB@BAB@B@B@BBB@DB@@@BDBBBBBBBB@D@B@B@@ABA@@BABA@@@@B@D@B@BBB@B@B@D@B@BCD@@ABCB@@AB@BABBB@BBB@BB@@B@DAB@D@B@B@B@B@B@DABABABCB@@GB@BAB@B@D@@@BBB@B@B@D@B@@@B@@ABA@ABA@@B@@AD@BAD@BABADA@ABCBA@A@A@A@A@@@CA@A@@A@@AAA@@@@A@ABA@ABCBA@ABABC@CBABA@EFAB@BA@A@C@A@@@A@C@A@@BB@@D@B@B@DA@CAC@A@E@A@A@C@@@ABC@CBAB@@ABABABA@AB@BAD@B@BABAB@BAB@B@B@BBDBB@@BD@B@BAD@BABA@AB@BABADABAB@DAB@BAB@B@B@B@@@@@BB@FFFDFHDF@BB@@DBB@BAB@BCBCBC@KIE@ABABADADADADADBDDDFDDB@BDBBDBDFBFDBBDBFBFBB@DADCBABADAH@FBB@HBFBB@BB@B@B@B@D@B@DBF@DBF@F@D@FADBB@BDBDBBBDDDDD@D@DABABADAFCHAF@DBDBDBBBDBFDDBD@DABCDABAD@HAB@HAF@DBB@DBDDDBBBBBBDBFABADAB@DBBBFDBBDBBD@B@DAD@D@FDFDDBFDFDB@FBDBBDDDBBDDDBDBHDB@HDBBBBDFD@D@D@DAF@HAD@F@BCDABCBCBCBCDADABADABAAEAABEBAD@@@@BB@BDDDBDDDDBD@F@FBBB@D@DBBBFBDB@BB@FABCBCFABADAF@BADAFCFAD@DF@NAFDBBAD@@CBCBKDI@C@CDCDAH@B@B@B@F@D@B@B@B@D@DADAFAD@D@BAF@DBD@BADCD@@@B@DAD@DAFBD@H@B@DADAB@D@@@DDBBDBBDBD@BAD@F@D@FADBDBDDD@FBF@BADCBADADAF@HAFAFAFABAFABCD@F@DBD@DBDF@D@DBBBFBDBDD@BBD@D@DBDFBBDBFBD@BCBABCBCDCFCBE@E@CBCB@DCDADABCBC@A@CBCDCBADCBCDCDAFAH@H@BABE@CAE@E@EAE@CAC@CBEBC@E@AAA@C@C@A@CCAEBCAE@ECAAAAA@AAAA@@CC@EAE@A@@ACAE@CAEAE@CAC@EBADC@E@CAAAACC@E@CACBCBCB@DCBABC@G@@@EDABAFCDAF@DAF@D@D@BA@C@EBCBABABC@CCAACCACAAAAA@CFAD@H@J@HBDAFADABABABC@C@CBABC@E@E@AEECC@C@GDEB@DADADCBA@@BABABAD@NBD@@@@AA@AA@@AA@@A@@A@@@@A@@AA@@@@@@@A@@@@@@@AA@@@@A@@@A@@@A@@AA@@@A@E@E@ABA@C@C@A@A@A@A@@@@@AI@G@@ACACEACCAA@AA@CAA@AAAAAA@A@A@ABA@C@@B@@ABA@CBA@A@A@ABA@ABABC@ABA@@BABAB@BABA@@BA@ABA@ABA@@B@DAB@B@B@B@B@B@D@DBB@B@DBB@BBBBB@DBBBBB@@B@BB@@BB@@BAB@B@@A@A@@@A@A@@ACEGEG@CBK@CBCB@DAFAHADABADAB@JBB@B@BA@E@E@@BA@ABA@ABC@A@A@A@A@C@@@A@ABA@A@ABAB@@ADA@ABC@ABA@ABCDCBAB@BAD@BAB@BABA@ABA@ABABA@ABAB@BAB@DCBAB@BA@A@A@AAAA@AAA@A@AAA@C@A@A@A@ABICCACC@C@CBEBC@MCAAA@@ICA@@BA@@@@@CDIHEBGAKC@@GAC@AAACACAAACCGAAAC@AA@@CAA@A@C@CBABCBA@A@A@AACCCEACAA@A@C@CAA@A@AAAAAE@CAC@AECACAAAA@CAA@A@AAE@C@@ACAAA@CACAAAC@CCAABAA@A@A@C@@@@@@ABC@A@E@A@A@@@A@CBABA@A@E@E@CB@@ABA@A@A@CAA@@AABA@AD@BAB@BEBABA@ABAAA@E@CA@@AC@A@A@@AAAAAA@A@C@A@A@A@A@A@AAA@CA@@A@@@@AAECECEAAAA@@AA@AAE@@@A@A@A@A@ABADCDABA@C@AAC@A@A@@BABABABADADAB@B@@CB@@CBCBC@ABA@@AA@AAA@AAC@@A@@CACACA@AA@ABCBABA@A@A@ACIAA@@@A@C@A@A@ACEAAAG@@@A@A@A@AAA@AAAAAAC@@AAAA@@AAAAEAAA@CAACA@A@CCA@AAAEAC@@@A@AAA@AAAAA@AAAACGCCGCKCGCGGECAAEC@AC@AA@@ACAAEAICCCCACAECA@C@A@A@C@AA@D@@@BB@@B@BBBDJ@FBDBB@D@D@D@JFJDLFF@F@BABAB@BA@I@A@A@C@A@A@CBA@AB@BABABAB@@ABADA@ABAAC@CAC@A@C@AACAEA@AAAA@ECAA@@A@A@BI@CAAAA@@A@@AA@AAA@A@A@@@A@C@A@@B@F@@AB@@A@A@C@A@AACCCCGCA@CAGGAAAAA@ABA@ADCBGDA@AB@B@BDF@B@BAB@B@B@@DBDB@@@BA@ABC@A@ABABCFABCB@@ABAB@BCBABCFAB@@ABA@A@AAA@ABABA@@@CAAA@AAA@@AA@A@@AAA@A@A@"],encodeOffsets:[[106305,28306]]}},{type:"Feature",id:"530626",properties:{name:"绥江县",cp:[103.968978,28.592099],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CDABCBCBCD@DABABCBCDABCFCBA@CBC@ABCBC@CBCEBC@C@C@AAAABC@E@E@CBE@C@EBC@CCACAE@CAE@C@ABCDCBCBC@CAACAACCEBEBCBCAGAEAA@GBCBE@EAEACACAECACABABAD@DAHBBBDBDABABCD@D@D@D@D@F@D@D@FBBDDBDDBBD@BADABADCDCDEFADCDABCDABADAB@FABABCDE@EBEBCAAAACCACAE@EAA@AECAC@ABAB@BA@ICCAEAEACACCEACAEEEACAA@EBCD@@AB@B@DBDDBBDDDBD@B@D@BCDCBCBCBCBABA@CDABAFADADCDCBADCFAB@F@DADAD@DBDABADCDCBCBC@ADBBDBDDADABAD@D@DADABCBC@CCCACAC@CBE@CBEBCBCFCD@D@D@DBDBJ@D@B@@@DC@E@C@CBCDABCDEDEDCDA@CAC@ACCACAC@@CBABADCBABC@EAAACAC@ECCACAECEBEBC@ACAAA@A@@BCA@@AEAAAEFCFELIVEHEFGDEFOLCDADADGHADABAD@B@@@@DDJBD@FDDBBBFBBBDD@DBFBP@FABGF@D@B@BBBBDDBFDRFFDB@DDDDBBNJJFDDBD@B@B@B@FCH@BEL@D@H@JBH@D@FBDHFDFDD@D@F@DCHAH@FBHDHBDHJ@@FFHBJ@DAB@B@BADEHIFKBC@EAI@@CKAEBEBCDEDABAJED@HC@@F@J@HBFDJNDDB@B@F@FCFAPELAFCBCDC@I@ABMBCBABAD@BAB@F@F@D@B@BBBBFDBBDBDBH@HBF@JDFB@@@@D@H@F@FD@@NFLDJBJBH@DB@@JBZDFBPBDBJ@JA@@DAFEDCBAHADADC@CDI@@@@BCFCFAHAF@DBB@HDLHBDBD@F@F@DBDBDFJDHHDHBD@LBH@H@B@@@D@BBH@FBLAH@@@FBHDDBB@DAB@DENCJCDAFAB@HBJBJDFBL@LCL@@@B@D@HBFDDDDFBH@FAHAF@BBDDDDBD@B@BAB@DCHIHMBCJOHGDCFAD@@@DGACCAACBABA@G@CAAC@CCCACCEAC@CBA@@@@A@ABCA@EACCACAA@C@ABAAEAE@AA@EAC@CAE@@CA@@AA@@AA@KAGAGCECMEKAE@IAGAGEAACCACCECACAAA@EAECCAACCCAAACAC@C@EACCC@G@AACEEECAC@EACAC@E@EBE@G@C@CDCDABEACA@CCCCAECCACACACACCCEAC@CEECGAC@AAC@CAC@CBEDCBCBC@CBE@CAC@CAC@CAE@CACACAA@ACCCCCCAEAC@C@C@CBA@CBAAAECEAAAACBA@CBA@C@CACACAACECCCCCCACAC@@"],encodeOffsets:[[106562,29094]]}},{type:"Feature",id:"530625",properties:{name:"永善县",cp:[103.638067,28.229112],childNum:1},geometry:{type:"Polygon",coordinates:["@@VDJB@@HBB@TFNFFDBBBB@B@FBBHHPLHBB@B@D@HALENMNGHCFCHEHCFAFAJC@@FCBCDE@E@GAGAGBEDGFEFGB@DAD@FAD@DBB@B@DBFDBBBFBDBFDFDRDNBDJHDBDBHBD@FAB@JAHAH@D@B@F@D@B@B@BBBBFDHLDJBDDHFBHDJDBBDBFDB@LBFBBBBBLLFHHLBDBBB@HAD@LBFBBBB@LJ@@DBHFLDDBDBDBDDDFDF@BBD@D@DADAF@BENCFEFMPILAFCF@FBHDD@DBJDHBD@J@B@DCNAJELADCHGHGJCDCFCFCHEJEHAH@@BJBX@DADADCHAFBBFB@BB@AD@@@BBBDB@BADAFDFBFBDDD@FBDBDBB@FADABCDABAB@DD@DBDBBDD@DBB@DCFCFCDCBADCDAD@F@D@@C@@@A@CAIAC@C@C@CDCDEDAFADAF@DAD@DBDBDDD@DABABC@C@CBCBABCCCCAAABCD@DADADCBCBAAC@CBCBC@C@EBADEBCDADCBCBCBEBADCB@BADADADADADC@A@C@AACCCACCAAC@C@ABA@@DCFAB@DBFBFFDBFBDDDBFBFBDBJDB@@ABABAD@DBBFB@FBF@DBDBBDBBDBFAFAF@DCBABA@EBABCBADCBADCBCFEDCDCBCBABC@AACCAACCCAA@E@C@C@E@C@C@C@CDCBABAACACAABG@CBCBABAA@ACACCEAC@A@ADCBCDCBCBEBCBCBABCDABC@CCGAC@E@CAE@E@E@CBCBCDABABC@CBCBCBCDEDADC@CAAEAC@EAEACACAAA@@CAE@ABC@CACAEAAAECAC@EAC@C@A@CBA@@C@EACAAACECAC@C@AAC@ACACAEAA@C@CCECAC@CAE@C@ADEBABEBEBEBGBE@CBCBABCDABE@EAC@CCCACAEBC@E@C@ABC@CAAAACCA@C@@@CBABC@C@A@GACBE@CBC@C@A@@DCBC@AAC@CBE@A@CBCBEBC@C@C@A@A@A@C@E@A@A@ABGDCDCD@J@LCDADA@@BCAAECMBE@@CBCDEBEBC@ABEBCBADEDABA@EAAA@ACAEAA@C@CAAEAE@C@CACCACCCACA@@A@@C@ABAFBBBFABCBABCBCBADADADADCBADE@C@GBE@CBC@C@C@CEAAAAGCA@GCCACACCAACCACCAEAA@ECECCAECECC@C@CBA@C@AAACCAAEAA@CBABCBAAEACAAAACACCCAA@CAE@GBA@GBC@ABCBADCBC@CAECCAAACACACAE@GBEDCBABABCBC@C@CCCCAACACA@AAABC@E@C@EAE@CAE@C@A@C@A@A@AAAA@EAGAA@EAG@CBABABCDCBA@EAEACAAAECEAACACCA@ACAECCCACBCBCBCBCBCBABAF@LJD@DADA@ABA@AAA@CA@@ACEEGECEEA@@A@@@@@A@A@ABA@ABA@CBABABCBA@ABAB@BA@ABC@A@AAC@@AAAC@A@A@ABA@ABABA@A@ABC@ABAB@BABABA@@BADAD@BA@@D@B@B@F@B@D@DBB@@C@A@A@CA@@AB@D@B@@@B@D@B@B@@ABAFEB@BADAD@BABAB@DABAB@BAB@@@@@BB@BB@@@@BDB@@B@B@B@B@B@DABAB@BCBA@ABC@ABC@@@AB@BAB@BA@@@A@@@A@C@A@AAA@A@@@C@ABA@AHA@@DABABABA@C@A@A@A@A@A@CBA@C@AA@@AAA@AAABA@ABA@@DABA@@DC@A@A@C@A@AAA@A@A@C@A@@B@BA@AB@BA@@@A@A@CAAAAAAAAAC@@A@@CAA@A@A@ABA@ABA@@BADEBABABA@@DC@A@A@A@@@AB@@A@@AAAACB@@AAACA@@@@@AACBA@CCAAA@ECAAA@A@AA@@AAAC@ACACAA@@A@AAAAA@@CBA@CB@@A@@AAAA@CBC@A@A@@@CBCBA@A@A@ABABA@@@A@C@AAAAA@A@CCCAAAA@CAA@@@AA@A@AAA@@AA@@@CAAAAAAA@AA@@@C@@AAA@@A@@@@BCBABCBC@ABADA@CBA@C@AB@BA@@B@DA@@B@BA@AB@AC@A@@BAB@B@@A@@@CBC@ABADABAFABABA@A@CA@@AB@@AB@BA@@@A@A@@BABA@@@A@@@CAC@A@A@C@AAAAA@E@A@A@CAEAAAAAA@AAA@A@ABA@CBCBA@@@AACBA@ACC@A@@BA@E@A@A@A@AB@@AB@BA@@@@BA@@AC@C@ABA@@BA@A@@@C@AAC@A@C@A@@AABA@A@@BCB@@EBA@ABADCBCDADAB@@A@AB@@AB@DA@AD@DA@@DC@AB@@A@A@A@A@@@@AA@@@A@AB@@A@C@A@@B@BAB@@ABA@@AAC@@@C@A@A@@A@@AAAACEAA@A@C@C@CACCAC@C@A@ACB@BABA@A@ACAICCAA@A@CFCBA@C@G@E@G@A@E@@@A@CBA@@@A@@@@@ABC@A@AA@@A@AAAC@AAAAAAAC@AA@@@@A@@@BCBE@ABA@A@AA@C@A@CBA@A@A@AAE@C@A@A@E@A@A@A@E@ABEBABA@ABC@A@ABCAA@A@ABC@A@C@C@@AAA@@BA@@@A@@@AB@@AB@@AB@@@@E@C@CBA@A@AA@A@CAA@CC@@ACC@E@A@AAA@AB@@ABA@ABABCBADABAB@@CBABC@ABA@ABC@GAIACAAA@CCI@CDO@AAAA@C@IFKDMHCBC@AAA@AC@C@@@ABADABABA@@@A@AAAAC@@@@@A@GBADCNEFEBAB@BA@A@A@@@@@AA@@AA@C@A@A@C@A@C@A@@AA@AAA@@AAA@@@A@ABA@@BABA@A@@@AA@@CECACCEAAA@E@EACAA@CCAAC@A@C@A@ECA@AB@@BDBBABABAAAAAAABABA@CAA@@B@BADCBA@AAAAA@A@@BDBBBABA@C@A@CBA@AB@BAD@@@BB@B@B@@B@@@@@@@@@BAD@B@BBBBBB@BBA@CDAD@@@BDBB@@B@BAB@BCAA@AB@BBBDBBB@BBDBB@B@BA@A@CAAAA@A@CD@B@B@@A@AB@BAB@DCBCBA@CBCBE@AAA@CA@@@A@A@A@A@C@A@@AE@@@A@AAC@AIMAAAAA@A@ADCBCDABABE@CBIBCDEBC@CBCBEBEDEDCDG@CBGBAA@@ACAC@CCIAA@A@CBA@AAACAGAE@@@CAC@C@KBC@A@C@E@GA@@I@A@@@E@SE[KKEAC@E@IFKFGBG@I@EAAOCKCKIGAK@E@MHE@AC@CACC@CBCDEFADAFCBEBEBADCBEBGFEBCBEB@@EDEDCDEDABABQPCF@@CBCD@@A@E@EDED@@A@ADABADCNABD@DED@FAB@BAD@DAD@F@D@D@F@DBBBDBBBBB@D@FAH@F@DADBD@DBBBD@D@BBBBBBF@DADADCBCHCDABA@CBKJABADCJ@JBFB@DDDBD@FCD@D@DBBFDDDDDBDBFFDFDDFFDDDBDBBBDDHDFDBBDBHFBD@B@@DDF@FBFDFBDDDDBDBDBF@FDDBDFDBB@BDDBDBDBDBB@@DBFBDBFBD@D@D@DAHC@@D@FBBDBDBFBDDDBDBDFDBDDDDFFDFFDFBBDDDBDBB@FBF@D@F@DFBDBDD@DBD@DBDDBDDFDDBD@D@B@DBDBHDDBBDDJDHDBBB@JDBBDBDDFD@BDB@B@B@DDDDDDDDFBDBDBHBDBHBB@BBD@BBB@DBB@DBDBB@DBB@B@B@D@BABABABABAB@F@@AB@D@B@BBB@BADAFAD@D@JCHDFBJ@F@F@P@BBD@DAJ@DBB@BB@@BBD@BB@BBBD@@BB@BBB@@BBBBB@BB@@DB@@BBBBBB@@B@BB@BBB@BBB@@@BBB@B@@BB@B@@@@BB@@@BB@@@BB@@BBB@BBBB@@BBBBB@@BBBBBBB@BB@BBB@@BB@B@@@B@B@@@BBB@B@D@@@B@B@B@@@B@B@B@B@BB@@BBB@BBBBBDBB@BBB@B@B@B@@@B@B@B@@@D@B@B@B@B@B@B@@AB@@A@A@@BA@A@A@A@CAA@@@CA@AA@AACAACA@AA@@AAAA@AAAAAAAC@A@C@AAC@CBA@A@A@C@A@A@C@C@C@A@C@ABABABABCBABABCBADAD@DCDABCD@D@D@@ADAHAD@BAD@FAF@D@BDFBN@D@HCFCBCFAB@BCBCBC@C@E@A@ECE@A@ECAAAGA@CAAAAACAC@EBEFC@EBEFCFEDGHEHAF@@AFBH@D@DAF@B@DDH@LBBBDDDJFJBJLDBBB@@BHBDDHBB@B@D@B@BCBGDCFABAB@D@F@DBFBBBBB@BBB@F@FAF@DAF@DBBBBBDDFFFHFDBBLFD@FDF@B@B@DDB@BBBB@DBFDBB@BDHFBBNHLHLHFFFFBFBHBDHFHDDDBBDLHLHLDFBD@L@FBDBDBBDDFDJB"],encodeOffsets:[[106064,28910]]}},{type:"Feature",id:"530627",properties:{name:"镇雄县",cp:[104.87376,27.441622],childNum:1},geometry:{type:"Polygon",coordinates:["@@@CBA@A@ABC@A@A@A@A@A@A@A@@AAAA@@AA@@A@AAAAC@CACAECA@@AAAACAA@@AAAAAAAAA@@AA@AAAAAAC@ACA@AACAA@CACAC@C@A@AAC@@AAAA@@AAAAAAC@AAA@AAA@@@@ABADABCD@BC@@AAAAA@C@ABA@ADABA@@BABAF@@@@A@@@AAA@AA@@AA@@@A@AA@ACACAAAA@AA@A@AB@@@BAB@@A@C@A@@CAICCAA@@A@@A@@A@@@ABA@A@AB@@AB@B@@@B@B@B@@@B@DBBBB@BBBB@@BBB@B@@AB@@AB@BA@A@@B@@@B@B@B@@@B@@@BA@@AAAA@AA@@@AA@@@A@AB@@ABA@@DGBABIBE@C@A@CAC@@ACAA@AAAAA@@AA@@A@A@@@AAA@A@@A@@@A@A@A@C@AAA@A@@@AAAAAAAA@A@A@A@A@A@A@AA@@AAA@AA@AAC@A@@@C@A@A@A@@@A@A@C@A@ABCB@DA@ABA@@@ABAAA@A@AAA@AAA@AAAAA@@BE@AACAAA@CC@@AAAAAAAA@AA@@@@A@A@A@A@AB@BAB@BABADC@AAA@A@A@@AC@AA@@CA@@AA@@AAA@A@@AC@A@@@AA@@AAAAAAA@@A@AAAA@A@AAA@AACA@AA@AA@AA@A@C@AA@@AA@@AAAA@AA@A@@AA@@AA@A@@ACA@AAAA@@AA@@A@AA@AA@A@@AA@A@@AA@@A@@AA@C@@AE@A@@@A@AAA@A@@AACA@AC@@AAA@@A@@@A@A@@@A@@@AB@B@@AB@BBB@@BB@BB@@B@@@B@B@@@B@@@B@D@B@B@B@BBD@B@B@B@B@BB@ABABABA@@@E@@@A@AB@@ABA@A@A@@BAB@@AB@B@BAB@DA@@BAB@B@B@@CBA@A@A@A@AAAA@@@AA@ACA@@@A@A@A@A@A@A@@@@BA@@BAB@BA@@BA@A@ABA@A@A@AB@BCB@@ABA@@B@@A@ABC@A@A@A@A@A@A@A@A@@@@B@@ABA@A@A@A@@AA@@@A@AAC@A@A@A@A@A@AAA@AAA@AAC@AAAA@@A@A@A@A@@@A@AB@@@@A@CDABABAB@@@@CBCBA@AB@@@@A@A@A@C@C@CAC@C@A@CAA@AA@@AA@@AAAAAA@@AAAAA@ACA@C@@AA@C@@AC@A@A@A@A@A@@AAA@AA@@A@AB@@AB@@ABA@A@@@ACA@AA@@@A@A@@B@@AB@BA@@BA@ABA@A@E@A@AAA@@@AAAACCAACAAAAAA@AC@@AAAACCAA@CAAAAAACA@AA@AAA@CAA@A@@@A@@A@@A@CE@ABA@A@@@@A@C@ABA@AAECAAC@A@EAECA@AAAAAA@@@@A@CAA@A@@AAAC@E@E@EAEAACEEGCA@CACAA@A@@AA@@AAAAAAAAA@AAA@AAAAAAAAA@@A@AAC@AAC@C@AAA@A@AAAAA@C@AA@@AAA@AA@@AA@@A@@AAA@A@CAA@AAA@AAC@AAA@AAC@AACAAAAAA@@ABA@C@@@A@ABA@A@@A@@@@AA@A@AAC@AA@A@AAA@A@A@C@C@A@CA@@AAAAAA@@AAAAA@@@CC@@AB@@ADA@C@ABABCD@@ABABE@G@C@AB@B@B@@@BABBB@B@B@@@B@BBB@B@@@B@B@@A@@B@BA@@@@B@BAB@B@D@@BDBB@D@@BBBBFDBB@B@BCBED@@ABABA@AB@BABADA@AB@@A@C@A@A@C@C@AB@@ABABC@EDAB@DABABG@C@ECA@A@@@ADEDA@@@AA@@@A@AAA@@@A@A@@@A@@AAAA@@A@AACCAC@A@@AAAA@@ECAEACAAC@AA@A@C@C@C@CCAA@A@A@C@A@ACA@C@CBCAC@A@ELADADDD@BCBIJ@@AB@BCFABA@@BAB@BA@A@A@A@CAA@AA@@AAC@ACA@@AAAAA@A@@A@@@AAA@AA@@A@ABA@A@AB@@ABABA@ABA@@BEBAB@@A@A@ICAAABA@AAA@C@@AA@C@AAA@CBC@EACCCCA@A@@@A@@BG@A@@@AA@CAA@@AA@@BABA@AEC@@AAA@@@BAAC@AA@A@C@KAIAAAA@A@C@CHA@ABE@C@C@C@C@AACAC@E@C@ECE@@@AAAAAAA@AA@@AAA@A@AAAAA@A@@AA@@@@AA@@@A@AAA@AAC@@@A@A@@B@@A@@BABABA@ABA@A@ABA@AAA@@AA@@@A@@AAB@@AB@D@BA@A@A@AB@@@@@BAD@BB@AB@BAB@@A@@BA@CA@@A@ABA@ABAB@BABA@@BA@ABCBA@ABC@A@C@A@A@C@AACBA@@AA@A@C@A@CA@@A@EBC@AAA@@C@@@A@@AAA@@@A@@@A@@B@@AB@@@B@BBB@B@B@D@DABAB@BABAB@BA@CBCBAB@@ADA@ADA@ADABABABA@@D@@@DBH@FADCBAB@B@BAB@BA@@BAB@BAB@BA@CDAB@B@B@B@BA@AD@BA@@@A@@D@B@@@B@BBBB@BB@@BBB@@BB@DB@BBB@@B@@B@@@BC@A@A@A@@AA@AAA@CBAAAAAAA@A@A@ABA@@@@B@BEB@@C@GB@B@B@DB@DDFFBB@B@BABABC@E@C@AACC@@A@A@ABA@ABA@A@ABA@A@A@A@A@@@A@C@A@CA@@A@AB@@A@ABA@@BAD@BCBC@C@C@A@ABCDCDEBE@C@EAA@@@ABA@@@AB@BAB@B@B@@AB@BAB@DAB@B@DBD@B@DBB@@@@B@B@DAD@@BBBHPBDA@@D@BBF@B@BCBCB@@@BA@@B@B@@@B@B@B@@@B@B@B@B@BBB@@BD@@@D@@@D@@@D@BAD@@@BABAB@BA@ABABABA@ABA@ABCBA@AB@@A@@D@BAB@D@B@B@D@@AB@B@B@B@BAB@@@BCB@BAACBA@CBCD@B@B@BADCDCDCD@D@D@B@D@D@BAB@D@BBF@B@BCB@DBBBD@FBB@@BBDB@B@FAFCFCHADCFCFCFAB@B@DAHAB@B@B@@B@F@BB@@@BABCBC@CB@B@B@B@BBD@BBD@BBB@B@B@B@@ABA@CDC@CDA@A@CB@@ABA@AB@@AB@@ABA@CB@@C@AB@@@@CAC@ADCDCHABA@C@CAC@CB@@A@A@AB@@A@A@A@A@A@ABA@A@@@@@EACACE@ECCEAACCAC@C@A@CB@BAB@@@@@B@B@@@D@B@B@B@@AB@B@BC@CBA@CACAC@AB@@A@@@AB@B@@ABBB@DBB@BBBBB@DBB@BAB@D@BAB@B@B@BAB@BAB@D@BAD@BBD@D@BBDBBBBBBBBBBDDFBDBD@B@DCDA@AFAFE@@B@BAB@B@B@@BBB@@@@B@FBFBFDBB@@BBBBB@D@D@B@BBB@FDB@DBDBD@D@@@FBD@DBB@BBD@@@D@B@BBD@@@BDB@@B@@@@B@B@DCFEDAF@HAD@DBDBHDBB@B@BAB@BBB@BABAB@@@BJFBF@D@@@B@BAB@B@BBD@D@D@B@D@B@BF@NBD@DBB@B@D@BBDB@BB@@B@BD@J@B@B@D@D@D@D@B@DBB@D@BABADA@@BB@A@C@AAA@C@AAAB@BANDTFLDB@@@BBB@BBB@@@B@@BB@D@BAB@@ABABA@ABA@AA@@A@AA@@AA@@AAA@A@@@@AA@A@@AA@A@A@@@A@A@A@ABA@ABAB@@ADAHEDA@CCE@ABAB@DAD@@@B@B@@BB@B@BBBA@@B@BAB@B@BBD@B@@BBABBD@B@DBBBB@@BBB@@@B@BBB@B@B@B@B@D@BB@BBBBBBB@BD@@@BBB@B@BBBBBB@BBB@B@BBBBB@BBDB@@BBBB@B@B@B@BB@D@B@D@FBHDJ@@B@@@BB@@BBBBB@@BBBBBBBBFD@B@B@BBB@BBBBBBBB@BAD@F@@@B@BB@@@@DBB@B@B@BAB@BABAF@HADGFCFCBCBADAB@DA@@BBBB@DAB@DCB@DBJBBJDFDBDB@@B@@DD@@@BBD@@BD@@@@BAB@DBBBD@BBF@BD@@BBD@B@BAB@DC@BB@@@B@JHDDDDB@DBDBBDBBBDBBDD@DDDBBBDBBBDBBDB@DBBBDBDBBBDBB@BBD@DBBBD@B@BDFBBB@D@@@B@BADABBDDDHFD@@@@DB@A@A@GBAHED@D@B@B@B@B@@@B@BA@AB@BA@@B@BAB@BAB@@ABA@A@ABA@A@AAA@@AA@AA@AAAA@@AA@@AAAA@@@A@@AA@@@ABA@@B@@A@@DBD@BABA@@B@D@B@BABA@A@CEE@A@@B@D@B@B@BAB@B@B@B@@A@@BA@ABCD@DABABA@CAACE@@@A@A@A@A@A@AB@DAB@B@DBBBB@DBBBBDB@BBBBBB@@DBB@D@B@DBB@DBB@DBB@D@B@B@BABBBBBBBD@BBDBBBBBBBBB@B@BBB@B@B@B@D@DA@A@@BC@ABA@A@A@A@AB@@A@@BAB@B@@@BA@@BAAA@AAAAA@AAAAA@CACAA@AAAAA@CAAAA@AAA@A@C@A@A@C@@BABAB@BAB@B@BAB@B@B@B@B@B@BB@B@BBB@B@B@BBD@B@BBDBB@@BDBBBBBBDDBBBD@@BB@BBBBBBBBDBBDBB@BB@@DBB@BBB@BBBBB@BB@BBBDBBB@@BBDBB@BBD@F@D@B@B@DBB@D@B@DBB@B@DBB@B@BA@ABC@ABABABADAB@DAB@B@BAB@B@D@B@B@BB@@D@B@B@B@D@B@B@B@BBBBD@BBB@@BBBB@B@BBDAB@B@BAB@BADADAB@B@DAF@B@BAB@B@BAB@D@BAD@B@DAB@D@B@B@D@DBD@B@BBD@D@D@D@B@DBD@B@B@DAF@B@BAB@B@B@BAB@@@BAB@@A@@@AAAAAAAA@AA@@AA@AAA@A@A@ABA@ABA@AB@@AB@AA@@@AAAA@AAA@AAA@AAA@CAA@AAC@@@AAA@AA@AAAC@A@CAC@A@A@C@AAA@C@A@A@A@A@@AAAB@@C@ABA@@@A@A@AA@@AA@AAA@AAA@@@@A@A@ABABA@@@@BAB@BBD@D@D@B@DAD@B@B@BAB@@@DABA@@B@B@B@B@D@B@F@B@D@B@DA@@B@B@BAB@BABAB@BABA@ABABA@@BAB@BAB@D@B@D@D@DAD@BADABA@@BAB@F@D@F@DBHBB@DBDBDBBBFBB@D@BBB@B@D@D@D@B@DBD@DBBBDBD@BBD@FBDBB@DBD@B@B@D@DBB@B@FBDDBBBB@@@B@HBB@B@B@B@BBBDBBBDBDBBDBDBBDDBBBBDDDBFBBBFDDBFBDBB@BBBBDBB@DBDBBBDBDDDB@BDDBBBBBB@@B@@@@ABA@AB@@ABC@@B@DABABADG@ABCBADCJEBABA@@@C@A@@DAFAD@DBBBDB@@@B@@ABADABABBB@DBB@B@@B@DBB@B@@@BBDBBBBBAB@D@DAD@B@D@B@B@BDBBB@@B@BADA@C@CB@@AB@BADABABC@A@A@C@C@A@A@ABC@@BCBA@AB@B@B@D@@ABAB@B@DCBA@@BAD@BABCBC@A@A@@BCDC@@BABA@@BA@ABABADGB@BAB@B@B@B@F@BAB@B@D@B@BAD@B@@AHAB@BAB@B@BBB@B@D@D@D@BA@@BAB@BA@@BC@CB@BA@AB@BC@@BABADADABAD@D@B@@@B@BAB@DA@@DC@AB@D@DBD@B@BABA@@BA@@@AA@@@CA@AAECCAC@A@C@E@A@@@C@@@C@CDGDGHI@C@A@A@A@A@C@A@A@CBA@@@C@AAEAA@AA@AAAA@A@A@@@A@C@A@AAA@@AA@@A@AA@@IEAC@@@@@A@@B@DABAB@BA@ABA@@BA"],encodeOffsets:[[107831,28290]]}},{type:"Feature",id:"530629",properties:{name:"威信县",cp:[105.049027,27.8469],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@BEBCFEFCDEAC@CAAACAC@A@ABC@AEACAAECCCAACCAACCA@AAC@EDEBCBEDC@ALOJK@@BAB@D@D@B@@AD@B@D@DAD@B@DA@@D@F@DAD@B@B@DAB@D@B@FBBB@BBB@@BB@B@@BBDDDDDBBBD@DAB@BBBBBDBBBBB@FBB@BA@AAE@@BABADAF@DAF@F@FAFADABAB@AAAC@C@A@AAC@A@A@A@C@A@ABA@A@ABABABABADABABABABABA@ABCBC@A@ABC@AAA@C@A@A@AAC@ABA@ABC@ABE@A@A@A@A@A@A@CAAAA@@AAACAACCA@A@AAAAA@AA@A@@ACAE@CAAA@AAAA@@AAA@A@A@A@ABCBABA@A@A@A@A@A@CAAA@@AAAACCA@@AAACAAAACAAAAAAAAACAA@E@A@CBAD@BABABC@A@A@A@A@A@AB@@ABCBA@AAC@A@A@AA@@CB@@AB@BAB@@ADA@A@ABA@C@A@A@AAA@@@AAABA@ABA@@BCBABABAAC@A@@AAAA@AAA@@AA@AACAAAAAA@@AABA@@@AB@BA@AB@@A@AAABA@@B@B@@@DB@BB@@@BBB@D@BBD@BBB@B@B@@@BABA@A@AB@BA@@BABAB@BABA@@DAB@FAB@D@DBB@D@D@D@BABAB@B@B@DBB@DBDBB@DBB@B@DBB@BAB@B@B@D@B@B@BABA@@@A@A@A@AAA@@AAAAA@CAC@@@@@@BA@@B@@@@A@@@@@@@A@@AA@A@@@A@AA@@C@A@C@AAA@C@A@A@@AA@@@AAA@@@@@@A@@@@@A@@@@@A@@@@BA@A@@@A@@@A@@@A@@AA@AA@@A@@A@@@A@AAA@A@@@@@A@@@ABAB@@@@AAA@AAA@A@BE@A@A@A@C@ABA@ABA@CBABABABABAB@BAB@BAB@D@BABABABABA@@@CA@@@AAAAA@@AAA@ABC@A@ABA@ABCB@BA@A@@BAAA@AA@AAAAAC@AAA@@@AAABA@E@ABCBABC@A@C@A@C@AAC@A@C@A@A@A@A@A@@@GA@C@C@ABCBCBABAB@@ADA@@BABA@@DAD@@ABA@AB@@ABC@C@C@A@A@AAA@A@ABA@GB@BA@C@ABA@C@A@A@ABE@A@A@A@A@ABA@CHABAB@BAB@@ABAB@@CDAD@@@B@BADADABC@AB@@ABCDA@A@AB@BC@A@A@A@@BABAD@@AD@B@B@B@D@D@B@BADABCBABA@@BA@@D@DCBABA@@@AACA@A@A@A@C@ABC@C@CBAAAAACAAA@@A@A@CAA@@@@AAA@CAABABABCBA@@@A@@CAAACAC@EBCB@@@B@D@@ABABIFCDABAD@BCHABABCBA@@@AD@BA@@BAB@B@@A@@@AAAAAACC@ACACCCAAACACAA@CAAAAAA@CAEACAECAAEACACCAAAACCAAACACCACAAACAAA@A@A@A@AAA@G@A@@AAAACCEAA@A@CAC@A@A@C@CAA@CAEAC@AAC@CAAACAC@CAA@C@C@C@A@A@AAC@A@EAAACACACAA@GACAE@C@E@A@AB@@ABCBABC@CBC@C@A@C@A@ABA@AB@@ABAB@BABABA@ABABA@ABA@A@@@CBA@C@A@E@A@C@A@A@A@A@@@ABCB@@A@ABA@A@C@CBA@C@C@C@AAA@AB@@@@ABAB@B@B@B@@B@BBB@BBB@@BB@@B@B@B@@AB@B@DA@BB@BB@B@B@B@D@B@BBD@B@B@D@DBB@D@BB@BBBB@BB@@D@BBB@DBB@BBB@BBB@BBB@BB@B@@BBA@@BA@@BAB@BAB@B@B@BBB@BBB@@BBB@BBBBBB@B@@@BA@AB@@A@ABA@A@A@ABA@E@CBA@A@C@CAA@C@C@C@C@AAA@C@CAC@A@A@C@A@CBA@C@ABC@A@ABA@A@ABA@E@CBA@A@CBCBABA@ABA@A@CBAAA@A@AA@AA@AAC@AAAAA@A@A@C@A@A@A@C@@@AAA@A@C@A@A@ABA@A@CBA@CBABABAB@BAD@BABA@A@CAA@A@CAA@C@A@CAA@A@C@E@C@AAA@CAAA@@AACAAA@AAAA@AAAAA@AAA@CA@@AAA@CAAAACAAAAAA@AAA@@ACAACCAAAAAAAC@@AAAC@A@AAC@A@A@AAA@A@AAAA@A@A@A@A@A@ABA@A@ABA@ABAB@@@D@B@B@D@BBB@BBBBB@DBBBB@BBBBD@DBBBB@BBBBB@BBBAB@@AB@@A@A@AB@@@BA@@B@B@B@BAB@BAD@@@BCBC@A@A@A@A@AAA@A@AAAAAAAAAC@AACAAAAAAABA@A@C@A@CAA@CAA@CAA@C@A@CA@@AAAAAAA@ACAACAA@AACAA@A@CBA@@B@B@B@B@B@B@@DFBB@DABABCBC@AD@BAB@@@BA@A@A@A@ABA@A@C@A@@@@BFF@D@BABABA@C@A@@@ABABC@CA@@@BA@@@AB@B@@BB@@@B@@BBBB@@BB@@BBBBB@@BBB@@BB@B@BAB@B@BAB@BA@ABA@ABA@@@ABA@@BABA@@@A@A@A@A@C@C@GFAB@H@B@BCA@@@@ECCGCCAACBABA@@@AB@@@B@@ABA@@BAB@@AB@B@B@BBBBB@BBBBBBBBB@@BBBBBDBBBB@B@@BDBB@BBBBD@@BBB@BBB@B@DBB@DBBBD@BBDBDBD@@BBBBBBB@B@@@B@B@BAB@B@B@DABAB@D@B@B@D@B@B@BBDBB@BBBBBB@BBB@B@B@D@@@BBD@@@@ABABABAB@DAD@B@B@B@DAB@B@BBDA@BB@B@B@BB@B@@@B@B@@BBB@BBB@B@B@@@B@BBB@JBLDDFBBBBBBBBD@BCB@B@FBD@DBH@D@@@@AB@@AB@B@B@D@BAB@B@B@B@D@BBB@B@BBBD@@BBBBB@B@B@BABCB@BAD@J@B@HBB@@@B@B@B@B@DBB@BBB@BBB@B@D@BBB@D@B@B@DB@@BBB@B@BB@@BBB@BBB@B@BBB@BBB@@BBB@@@B@B@B@D@B@B@D@BA@@B@D@B@@@BBB@BB@DDDBDBB@BBD@B@BBD@B@BBD@BBB@DAB@D@@B@@BADB@@B@B@B@@BBBB@@BA@@BAB@BA@@BAB@@AB@@AB@BA@A@A@C@KA@@AB@@@B@B@B@D@B@B@BAD@BBB@DBBB@BBDB@@BBB@B@B@B@D@B@B@BBBB@@@BB@@B@@@BBB@B@BBBB@BD@@B@FJBDBBFAJENAD@B@@BEH@D@BBD@BBD@B@@BB@@BBDBB@BB@@DDDBDB@BBD@@@D@BBB@B@B@BBBBBB@B@B@D@BBD@BBD@@BBBBB@B@BAB@B@BAD@BAD@D@B@D@B@@@DBD@B@DBB@DBB@B@BBB@D@B@D@B@D@D@B@BBB@@B@@BB@@@DBB@B@D@F@FBD@D@D@D@B@F@B@@BD@HBDB@BFDFBDBBBFDDBBBDBDDDBDDFDBBDBDFBD@D@B@BBBBBBBD@DBFAF@B@F@BAD@AEDEDADAH@D@FCBA@CDAD@DBHBDBFABADAB@FAFAB@DBDAD@FCFCDEDADC@@D@F@DAF@F@FADAFADAD@H@F@@@DBDBHBHDH@D@BEBCBAF@FB"],encodeOffsets:[[107702,28741]]}},{type:"Feature",id:"530628",properties:{name:"彝良县",cp:[104.048289,27.625418],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@@AAA@@@@@A@@@AA@@@@A@AA@@AA@@AACACAA@C@@B@H@B@@A@CA@ABC@ABC@E@CAAA@AAA@C@CBC@@@AAAA@CCGCC@@C@CAA@AAAA@C@AAAA@A@@G@@@AGCEA@A@ABEBC@ACC@A@AB@B@D@@A@@@A@AA@@AAABA@@BABAB@BA@@BABA@A@ADCBABA@@AAAACA@CAAC@CA@A@@@ABA@@BA@@BA@A@@@A@@AAA@AAAAA@@@@BAFA@@@@B@BABA@@BC@A@GCCAEA@@@EA@CAA@@BCDCDADCBODA@CCAAAAB@@ABA@@B@@AB@BABA@@@@BA@A@@@@@A@@BA@@@AB@@@@ABEAE@AC@A@@@CA@@C@GAC@IFABADABC@@B@@A@ABABA@@@A@A@@@AAAEBG@CAAAA@A@@AA@@AA@@AA@AA@AA@@AAA@@AA@@@A@A@AA@@AA@@AA@@AC@@A@ABABABA@@BCBA@@BABEFCDCBADABABC@@BA@@BA@@@ABABA@@B@BA@@BA@CD@@@B@BABAB@BAB@B@B@BA@EB@@@BAB@B@@ABCB@BCBAB@B@B@D@@BD@B@D@B@BBBB@@B@D@DAB@BABCB@@CBA@A@EDG@A@@B@@A@ABAF@BAB@BAB@@A@A@CAA@C@C@CAEAA@AACCA@AAC@C@C@CAA@A@ABAB@BAFADABABC@@BGBA@@@ABA@ABABABC@CB@BA@@B@@ADABCD@B@BA@CBA@A@@@A@ABA@EFA@EBC@CBABA@ADA@ABABC@A@@@A@@BABA@A@@BABABAB@BA@ABA@AD@@ABAF@BAB@BBB@DB@@B@BBBAB@@@BAB@@@B@@BB@B@@BB@@@BA@@D@BBD@B@B@B@B@@@B@B@@@@@@@B@B@@@@AB@@A@ABA@@@@B@B@B@@@B@B@BAD@@@BDB@BAB@B@B@@@@@B@@AB@@@B@@@@@@@@A@@@A@@@@@@@AB@@@@A@@@@@AA@@A@@@@@A@@@@@AB@@@@A@@@A@@A@@@@A@@@AA@@@@AAC@AAA@@@@A@@@@@A@@A@CA@@@A@@AA@@AAAAA@A@@@AA@@@@A@@@@@@@@@AA@BA@A@A@A@AAA@A@@AA@@BA@@BABAD@@ABA@@BA@@@A@C@A@A@A@A@A@CBGBG@AB@@@DBF@BABC@C@A@@@A@A@A@C@A@@BA@@BA@AB@BA@ABA@@BAB@@@BA@AB@BA@@B@@A@@@A@A@@AAA@CAA@A@A@AAA@C@AAA@AAA@A@A@C@AAA@A@A@A@A@ABA@A@@AA@@@A@AA@AA@AA@AAA@@AAA@A@A@A@@CCEGAEAM@A@AC@C@C@@@AAAD@@AB@@AB@BA@ABADABA@AB@B@@@@EAABCBABA@AB@@ABABCDCBABA@A@EDCD@DBFFHFF@D@FBB@BBBBBBB@B@B@DAB@BA@C@A@@DA@A@@BA@@BAB@@@BA@@DADA@ABA@A@@@ABABC@EBKG@@A@@@ABABAB@BAB@BAB@BBB@BBBBB@BB@@BAB@B@B@B@BADBB@BBB@B@B@B@D@D@BAB@D@D@@@NDDJBN@D@@BBBBB@BBBB@@BB@BBBBB@BBBB@@@BBBB@BBB@B@DBBBB@B@BB@@BB@B@@BB@BBB@B@@@BBB@B@BBBBDBBBBBBBBDBB@BBBBB@BBBBDBBBFLDDFDFBBB@DADABABAB@@A@@BA@C@CB@@ABAB@@@BAD@B@BBB@B@BB@BD@BBBBB@BBB@@@B@BBB@@@BB@@BBBBB@B@@BB@B@B@@BBBBBBD@B@B@D@B@FDDBDBDDJDFBBBBD@@BBD@@BFDBBFDHHHDLDHDDDDHBBBBB@BBBBB@BBB@@@D@FBBB@BDB@D@BDBBB@DBBFBBBBB@@BBBB@@BDBBBB@BBB@B@B@B@B@@BHBBDF@B@B@B@D@B@@BBDJ@B@B@BABABAD@BBBB@BDBD@DB@@@BD@BBB@BBB@@AB@BADAD@DA@@DA@A@CBCBABABABAB@@@B@BBD@B@DABCBCDAB@B@B@B@B@B@@BF@BBB@@BBBBDFDFBF@B@@B@@@DBB@BBB@B@B@B@B@D@B@B@BBBB@BB@B@D@@BB@@D@FBBAB@BABABAFA@ABC@@BABBB@@BB@D@B@BAB@BA@@D@F@F@BABAB@D@B@@@B@B@F@BAD@B@@@@D@B@B@B@ABBBDDD@BBDBDBB@BBBD@@@DBF@B@BBB@DBBBBBDFD@BBD@DBFBBBBB@B@DBD@B@B@DBFBDDBD@B@B@BABADAB@D@D@BBB@DB@@BBDBBDHBDBBBDBDBBD@HB@@LDHBFAJGDC@@@@B@@A@@AEA@@ABCACACA@@A@C@ABCBC@AB@D@D@B@DBDBB@@@D@DAD@FA@BD@DBDDDB@DBB@BBDDDDBBDBFDF@BDFBBDD@BB@BB@@NLBDBD@BBBJHBBBDFDB@HDFDDBNFBBDBBDDDBBBF@@DDDBFBDDBBBDBBBD@BFHFBBBFBHDBBHFDBDDDDDFDADADA@C@C@A@A@@@C@@BABE@@BADEFA@ABA@@@A@A@AA@AA@@AAA@@AAA@A@A@A@ABA@ABABA@AB@BABAB@BABAB@BA@ABAB@@A@ABA@A@A@C@A@A@AAAAAAAEAAAA@AC@AACAA@C@AAAAA@C@A@C@ABA@CAAACAAAA@AAAAA@A@AA@@C@A@A@C@@DABCDCFAFA@C@ADADA@@B@@@BBB@B@B@DA@ABBD@B@BABCD@BABABA@E@@@A@A@A@@BA@@BAB@B@BAB@@AB@@ADA@AF@DFFDFBD@HBBFBDB@B@BBB@BB@@BBDB@BD@B@B@B@B@B@BAB@B@BABA@ABADA@ABA@AD@BA@@BBB@BB@@BBBB@BB@BBBBB@BBD@@BBBBBD@BA@ABABAFIFAF@DB@D@F@DHHFFB@@@D@BBB@DBBBDBBBBBBB@BBBBBBBBBDBBBB@BBB@B@B@B@D@@@D@B@B@BB@B@B@@@B@B@DBD@DBDBB@BBBBBBBBBDBDDBBBBB@BBBB@@BBB@D@B@@ABABAB@BA@A@C@ABA@ABADCB@B@BADAB@B@BAB@BABAB@BA@@BABAB@@A@A@@@A@A@AB@@@D@BBBB@BBB@B@D@BBBBBBBBADBB@DAB@DBB@B@D@BBB@B@BB@@DB@BBB@DABCDCBCBA@ABGHA@CDCBA@AB@@@D@@@D@BBD@D@B@B@D@BAD@B@B@DBB@BBB@BBBDBDDBBBBBBBB@BBB@BBBBB@BBB@@BA@ADA@ABADCBABAB@@ABABABA@@B@B@B@B@@@B@@A@AA@@AA@@A@@@ABABAB@DAB@DAB@BAD@D@D@F@BAB@B@@A@@BA@ABAB@D@B@B@@@BA@@@ABAAABA@ABA@A@ABABC@ABABA@AB@BABA@AB@B@BA@@B@B@BBB@B@B@DBB@@BB@@BB@@B@@BB@B@@@BBB@BB@@BB@BBB@B@B@D@B@DAB@DAB@B@BA@@BABABABA@ABA@@B@@ABA@A@ABA@@@AB@@@B@@@B@@@@@@BB@@@@@BB@@BA@@@A@A@AB@BABABAB@BA@A@@@A@AA@@AA@@A@A@A@ABA@A@A@@BA@AA@@A@A@AB@BAB@B@B@D@B@BAB@@@B@@A@AAA@A@@@A@CB@BC@@B@BAB@@AB@B@B@B@B@BBB@@@B@BBFFDDD@DBBBB@FBB@DBDBHFFDDBD@D@DB@@B@B@B@DAF@HBB@BA@ABA@AB@@ABA@@BA@@@AB@@@B@@@@@@@B@@BB@BBBBB@@BB@BBB@@@BBB@BBB@DBHFFFFBFFHFHD@@FFB@B@BBBB@BB@BBDBBBLDB@D@FAHBPDHFJD^LNBHBNFL@D@B@@A@ECCAABC@AA@@A@AC@AA@@C@A@A@A@AAA@AAAA@AAAAC@A@A@A@C@A@A@CBABA@C@A@ABA@A@A@A@@@AAAAAAA@AC@CACAAAC@AACAA@CAA@A@AAA@AA@@ACAA@AAAAC@@@AAAAAACAAAA@@AAAAAAAA@AAAAA@A@A@ABA@@BA@AB@BA@@@A@@BAC@A@AACE@A@AACAA@CAC@AAAACAAACACAA@CCAAAACAAACAACC@CCCAAACAAACCACAA@CCCCIGA@@@A@@ACDA@ABA@C@AA@@ACE@AAC@AACAA@AB@@@@AC@@AC@A@@CC@@@AA@ACECICAAAI@CDA@CBA@CAAAA@@CBA@CBABADEDEDCHGBE@ABABA@ABA@A@A@CA@@@@AAA@@@E@C@ABA@AAAAAA@AAA@A@A@AECAAAAAAAA@@AAAA@AA@@A@@@AI@GCEAC@A@C@A@@A@A@A@AAAAA@@CAAAA@AAAAA@A@AAA@AAAA@A@AAA@A@@ACA@AAAAAAA@@A@C@A@A@A@AAA@A@A@@AA@AA@AACAA@C@AAAB@AA@C@AAA@A@ABA@@@ABAAA@A@@AA@A@@@C@CBA@AB@BDF@DCBGFCB@BA@AB@BAB@B@B@B@B@@@B@BBB@@@BBB@@@@@BBB@BB@@BB@@B@BB@@BAB@BABAB@BA@ABC@A@@AA@@@A@AAA@AA@@A@KCSEMCABA@BB@B@DBB@B@D@BAA@@CBABABC@A@CAA@C@C@C@C@A@A@I@C@@A@AA@@ACAAAC@A@A@CAC@MAE@@A@A@C@A@C@CAC@A@ABA@A@A@@@CAEIE@A@@BABA@AAA@ABA@A@AAAGCCACAC@GBE@CBEFCDA@A@@@@@@AA@AC@@C@AAA@C@@@C@AAA@CAC@EA@@C@C@CACAA@ECA@AAA@C@C@A@AAAA@@AAECEAEAA@@@@@AA@AA@A@A@ABA@@@EFEB@BCBCDA@C@CAEACCAAAAAAAAAAAC@A@CAC@ABC@A@CBA@ABA@A@A@ABA@A@CBA@AAA@CAAAA@AAA@CAABA@@@ABA@@B@@@BAD@DBDBB@DAD@@A@ABA@@@A@A@A@C@@@A@A@@@@BA@ADAB@D@D@DBBDFBDD@FDFDBFB@@@@B@B@BAB@B@B@B@B@@@BAB@B@@@DAD@DBD@B@BADGDCBCD@DB@@@@BAD@@@DAB@BA@@BA@@BAB@BA@@DAB@B@DCD@DCB@BA@@@A@A@AAA@AAC@AAC@A@A@A@ADAD@DABA@A@@AAE@A@@@@A@ABABG@C@ABADEDEDEBCDGDEBE@E@ACAAA@@AA@EACAA@CDA@A@AAE@A@CBA@A@C@C@A@C@CDCDCDCBC@A@A@ADCDAB@DABB@ADA@A@@BA@A@A@A@ABA@@@C@A@A@CBA@A@CB@@@BAB@DABAB@BAB@BABABAB@@ABABA@A@@BC@A@C@@@C@@@C@@AC@@AA@A@A@A@A@A@@@A@A@A@@@A@AB@@A@@DADA@A@AAE@A@CB@ACGOAA@AC@CBA@A@@@@@AA@C@AAC@C@ABA@CBA@ABA@@CBABCBG@A@C@C@C@ABAJAD@@GDAH@DDFBD@DA@@@EACACAABA@CDEDI@A@CBABA@EBA@ABA@CBA@A@A@A@AA@@@@A@AAA@A@@@A@AB@BA@ABABCBA@ABAAA@C@A@A@C@A@AAC@AAA@AAAAAA@@B@BA@@B@@A@A@@AA@@@AAA@AA@@A@A@@AAA@@AAAAAA@CA@AC@@@CAAA@@AA@A@AB@@A@@DCBAFADABA@AAC@A@CBEAAA@@@A@@@@D@BABEDA@@@AA@@@AB@BA@@@A@AAAEEAEEG@@@A@AB@@A@@@AAAA@CA@@CA@C@@C@@@C@@@I@I@AAA@@CAACCAEAE@CBA@CAAAA@A@@@@@@A@@AA@@@@C@@A@@A@A@AA@@@@A@A@A@AA@@@@A@@@AA@"],encodeOffsets:[[106799,28034]]}},{type:"Feature",id:"530630",properties:{name:"水富县",cp:[104.41603,28.62988],childNum:1},geometry:{type:"Polygon",coordinates:["@@AEECCECCCCCAA@CBE@C@A@C@EACAE@EAGBC@E@ABDDDBDDF@JBF@HBD@FBBB@B@@CBEBGBEBEBEDC@C@C@ABC@ABABEAC@EAC@C@IDEHED@@A@CB@B@DC@A@C@A@C@E@E@G@CAE@C@CAGAE@CBE@G@EAECECCAAACAEECCACAACAACEAC@E@E@A@EACACAC@CBABA@AD@DADAD@BAD@DABEDADCBADADABCBC@ADADADCB@D@@BDBDDDDDDDDFBBBDBD@D@DAB@DABBDBBFBFDBBAB@DAB@D@D@DBDBFDDDDDD@BBBBDBD@DBF@DBD@DBD@DAF@DADADCDAF@DBD@DBD@BBDDHFF@DBDDFDDDBDBDBDBFDDBDD@DDBFBBADCDCD@H@F@FAF@D@DBFBD@DBFFDFBBH@D@DDFBD@D@DBBBDBDDBBDDBF@FBBDBDBDFBDDDBBHFHBJBF@LBNFFDHDHBLBB@@BB@@BB@@DF@DBD@FBB@@BBFBFAB@B@DBBBDDDFBB@AD@B@B@@B@DAD@FBDDDBDDD@BB@D@HABABBDDBBDCHF@FBNDF@HAFCDABA@C@CAI@C@@BCDABA@@FAF@DBD@FBFDHFLP@@DHDDFDNH\\HHBNDHBD@DAHCFAHCB@HAJAF@@@F@D@HCDCBADA@AFCHEDAFC@C@AE@ACAA@C@E@A@ACMCGBE@CAAA@AAG@GBA@IBMBE@E@@@ICIAEACECCCCAC@@AC@C@@@A@CCEAE@AAAACCA@CAA@@@I@CAAAAAAAAC@G@EBA@E@ABKFC@C@C@ACACACACAKAGAACACA@@AA@@A@@@AA@BA@A@@@A@A@ABA@@@C@A@A@ABC@@@A@A@@@A@A@A@A@AAECCACCACC@A@@@C@@@ABA@@@A@@@ABC@@@@@ABA@@@A@@@A@A@@@A@@@A@@@A@@BA@@@@@A@A@AB@@A@@@A@C@AAC@CCAAA@AAA@AAA@AACAAAAC@A@@AE@C@@@A@@@A@@@@@@@A@@@@@@@A@@@@@@@@@A@@@EBC@@BCDABAD@BA@@DABA@@DAD@B@D@BAD@@CB@@AAA@@ACAA@@A@AACCAG@E@A@ABA@@@AHIFKDE@C@@@A@@AA@AECCCAACACCAC@E@C@ABA@ABGFIDEBE@ABABG@G@AAAA@@AAAA@AA@@A@A@AAA@@AAAAAA@@AACAA@C@EBGDG@@BA@ABAB@@@@AB@@@AACAA@CAE@E@CCAE"],encodeOffsets:[[106749,29066]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,4 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("杭州",{type:"FeatureCollection",features:[{type:"Feature",id:"330102",properties:{name:"上城区",cp:[120.169312,30.242404],childNum:1},geometry:{type:"Polygon",coordinates:["@@EACAC@A@CH@@@@@@@B@B@@B@@BB@@B@@@B@@@@@B@@@B@@@@@@BBD@B@@B@@CD@B@B@@@@AB@BABBB@@B@@AB@@AB@B@H@@BBB@@B@BB@BDB@@@@@@B@@@@@@AB@@@@A@@@B@BB@BB@B@@BB@@BB@BB@@D@B@@@D@@BB@@@@AB@@@B@DA@EDAA@@@DAB@@@B@BB@@BA@@BBB@BBBFD@BB@@@@BA@@@A@@@BB@@@@@BBBBBB@@@B@@@@B@@@@ADA@AD@@@@@BA@@@@@A@@BABB@@@B@B@@@D@B@B@B@B@F@B@B@@@B@F@B@B@D@B@@@D@D@@@B@D@DA@A@A@C@AAA@AB@AAAC@ABAB@B@@@D@@@@@B@B@@A@@B@@@BAB@@@B@@A@@B@BA@@@@BADA@@FEFCNI@AEAACA@EEAAA@CCA@EC@@CAA@CCEAGCCAA@AA@@A@ECGCCA@@GECCAAAAC@A@AAA@@@E@EAA@C@EAC@"],encodeOffsets:[[123031,30921]]}},{type:"Feature",id:"330108",properties:{name:"滨江区",cp:[120.211623,30.208847],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAAAAA@@@@A@@AB@@AA@@@@A@@A@A@@AA@@AKGCBEDKJEFABA@AD@@AD@BAB@@CHADAFAB@D@D@D@F@F@D@BBB@F@BBB@BFBBBBBDBFDBBHBB@D@DBFBD@FBD@B@FBF@@@B@BBB@D@BBBBDDHF@@DBHDFDB@@@BBB@DBHDFBDDB@DB@@FDB@DDB@BBFFB@BDFB@BFFDDBDNGDCB@@@FA@@D@@@@A@@B@@AAE@A@U@@AW@A@E@E@E@E@E@E@AS@@ABC@@A@C@@GA@BCBB@@@ABA@@@A@@C@@A@@A@@@@@A@@@@A@B@AAA@@@@@@@@@@@@@@BA@@AA@@AA@@@A@@@@@A@@@@CA@@@@@AB@@@@@B@@@@@@@B@@@B@@B@@@@B@B@B@@@B@B@@@@A@@@@BA@@@@@@@A@@@AEA@@@@@ABA@A@A@@@@@@@@@@A@C@@AAA@A@@@@@A@@@A@@@@AA@@@@ACAA@A@A@@@@@@BC@@BA@@@@CCA@CA@@ABCC@@A@CB@@@@BB@B@@A@AB@BCB@B@@A@@@@AAAAA@@AAA@ABC@AB@@@@@B@@A@@@BBA@@@@ACFA@@BAB@@@@A@CA@@AA@@A@@AA@@@@@BD@B@@EC@@ABABAB@@@BABA@A@@BAB@@AB@@ABA@AA@@CA@@AAAA@@@AA@A@@@AB@@A@@@A@@@@@@@AAAA@AA@@@CA@@@@@B@@B@@@ABAB"],encodeOffsets:[[123043,30873]]}},{type:"Feature",id:"330103",properties:{name:"下城区",cp:[120.180891,30.281677],childNum:1},geometry:{type:"Polygon",coordinates:["@@@B@@AD@@CD@@ABABCD@@AD@@A@@B@@BB@BB@@@@BB@@BBB@@@@BBBB@BBBDDBBBDBBB@@DBBBDDBBBBD@@BB@BDD@BB@BBBB@BFFBB@B@@BD@@B@@@BB@@@@B@F@B@DBB@@@FD@@B@BC@ABABB@A@@B@@AB@BA@@H@F@@C@A@A@A@A@C@A@A@AA@@A@@@AA@C@E@@@@A@@@@@AA@AA@@@@@@A@@@@C@@@@@AB@@AB@@@AA@@@@@@A@@A@@B@@@@AAAA@@@G@@@A@@CD@F@@@DB@@B@BAB@@@B@@@A@@ACCCCAA@@AA@@@@AA@DA@@@A@@@@@@AC@A@@@@AC@C@@B@@AA@A@@@C@@@@A@A@@C@@@C@AD@FB@E@@@AACAA@AA@C@AA@@@C@@AA@@@C@@@A@@@@A@@@@C@@AE@A@C@@B@@A@A@@BCBA@ABA@@BABCA@C@BA@A@CBE@CA@@C@C@@@A@C@@CBC@A@@@C@C@@@A@C@A@A@E@A@@@A@A@E@A@A@A@A@C@@@A@@DAB@@@@@DAD@B@@@BA@@@@@@B@@@DAB@@@@@B@BB@@@B@B@B@B@@@B@@@@B@@@B@@B@@@@B@DA@@B@B@B@@AB@BA@@@A@A@@@A@@BABAB@@@@AB@B@@@B@@@@@@@BB@DBFBB@@@@@DBB@B@DB@@BB@@AD@@@B"],encodeOffsets:[[123050,31025]]}},{type:"Feature",id:"330104",properties:{name:"江干区",cp:[120.205001,30.257012],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABA@@@@BA@@@A@AB@@A@@@@BA@A@@@@@C@@@A@A@AB@BBDBBA@@BBB@B@D@B@B@@@D@B@@@D@DB@@DAF@D@BABD@B@ADAB@@AB@BABAD@@@B@BA@@@@D@BBF@@@D@@B@@@@@@B@@@D@@BB@@@D@@BBD@B@@BBBBD@B@@@FEAC@@B@D@@@DB@B@@@@@@D@@@BBB@@@AD@D@@B@@B@D@@B@@@@B@@@B@@CBB@@@@BB@@BBDDDD@BB@@@A@@@A@ABA@@@CA@@E@C@@DB@@@H@@@B@BB@B@@A@@@@BB@@@@@@@BB@@A@@BA@@B@@@@@D@@B@@@@@@@BBB@@B@@@@@B@@F@D@B@@B@@@BB@@B@B@B@D@B@B@B@B@DE@G@@@ABA@@BA@@@@BAAAB@BADD@DBBBBBFDDB@BB@DB@@BDBB@@@@A@BD@@AB@BAB@@@@A@@@AB@B@@AB@@@@@@@@@@BB@@@B@@@B@@@B@@AB@@AB@B@B@B@B@@DBB@@@BB@@@B@@BBBB@@@@BBD@@@BB@@B@@BB@@BB@@BF@@@BBBBBB@BBADA@@B@@@@@B@@BBABAB@@ADA@@@@BB@ABAB@@@@A@@CCCGCCAA@@AC@@BA@@BA@@DBB@@ABA@@DABA@@@A@AAA@@@@@@BA@@@BB@@ABBB@@@@@D@@A@C@@@@AA@@@@@@@AB@@@@ABC@@@@AAE@AA@@@@@@BC@A@ABA@A@@@A@@CCACA@CAKEAA@@@AB@@@@@@@BAB@BBD@FB@BBABABABCBBH@BBBAB@BCBB@@B@@@B@B@D@B@B@@@@@BB@A@@@A@@CAAAA@@@@A@@BABA@A@@B@@@@@@@@ABABA@@@A@@BAB@@@@BB@B@BB@@@@ADAB@@JFDBDBB@DB@B@@BA@@@@@ABA@CBCBA@@@@@AB@@AC@@ACC@@@@@@@@@A@@AA@@A@@@A@CC@@A@@A@@@A@@B@B@F@DA@@B@D@JAH@@@@A@CB@B@D@ADB@BCDEA@BAB@@D@HBB@F@H@@I@@C@C@EC@@D@B@F@B@B@@@B@@@BBFBB@BBF@@BD@@@BBBBDDD@B@@@@@B@B@B@@FD@@B@DD@AB@@@B@@@@@HDD@B@DBB@@@B@D@B@D@B@@@@BBB@@@D@@BBBB@@@@@AD@@B@BB@@B@B@@@@@@@BB@DEJFBFB@FDB@@@D@B@@BB@@B@B@@@@@@BB@@@@@B@@@B@@@@B@@@BB@@@@AA@@@A@@@@@A@@@@@@BBB@DB@@F@D@B@BB@@D@DB@@B@@@@@BBDBD@B@BBD@B@BBDBB@B@B@FBH@HBDB@@D@DBB@@@@@BW@@AAAA@@C@OAA@@@CEIMAEDACICKBCB@@AFAXSTQRG@A@@IUAECGGQACAAAEEKGKCCGKGGAAAAGEGEAACAA@A@GCC@A@@@@ABC@I@AGAA@A@C@G@@@@BBDKBGDCBMEB@@@@AAEC@E@ABC@A@A@A@C@CBEBA@@@@@A@@B@@HJA@CDADCDELADMC@D@@ADHJ@@C@@@A@@@@NA@EACA@@AAC@AA@@BA@CEBACA@@DGBCBCB@@@@CBABC@MC@@A@@@@@C@CCA@C@IIE@IJCAGKACEIIKIOMOACCCEEMJEDEF@@CBAB@@@@"],encodeOffsets:[[123084,30968]]}},{type:"Feature",id:"330105",properties:{name:"拱墅区",cp:[120.141406,30.319037],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@@A@@@A@@A@@@A@A@A@A@@@A@@DA@@@@@@BCDA@@@@B@BABADAB@@ABAD@BAB@@AD@B@@ABAB@@A@@@C@A@CAA@C@A@@BCBA@A@A@A@CA@@A@G@CAC@C@@@C@E@C@AJ@B@B@BG@@D@B@BBB@@@B@@ABB@BBA@@@B@AB@DADCF@BAB@@@B@@ADCF@@CAAD@@@D@@AA@@@@CB@B@B@@@@@BABA@@@AB@D@@BBB@@@B@@@BADB@@@@BA@@@@@B@@@@DB@@@B@@B@@BB@@@@@@@@BB@@@ABAB@@FB@@D@@@@@@@D@@AB@@DB@@@@@@B@@B@@@@@B@@A@@@@@A@@BA@@BA@@@@D@@@@A@@B@B@@@@A@@B@@@B@@@@@B@@@F@@@@B@@@@BA@@B@@B@@@@@BB@@@B@@@@A@@@@A@@A@@@AB@@@@@@@@@BD@BBBB@BB@@BB@@B@@@@A@@D@@AB@D@D@B@@DCJ@DAH@BAD@@@@A@@@@B@@@@@@@BA@@@@@@B@@@@@@@@@B@@@@@@@BA@@@@@@@@B@@@@@@@@@B@@@@@B@B@@@@@BAL@BAB@HBD@B@B@BB@B@@@@@@@@AB@@@B@@@@@@AB@@@B@@@B@@@F@@A@A@CF@B@FA@B@B@@BB@@B@@B@@@@BB@@B@B@B@B@@@@@@A@C@CD@@@D@@BBA@@B@@@B@AAB@AC@@B@D@BAB@@@B@B@B@@@BABB@@B@@@@@@BBA@B@@@@BBA@@@@@A@A@@@@B@@FBB@BA@C@AB@@@@@DFBDBBBBDDBBB@B@@@@BBB@@@@@BBBBFBBBBBBBBBB@@@@AB@@@BADABB@BB@@BB@AF@ABD@ADB@@@@@BC@AAABA@@@@@A@@@@@@@@B@@AB@@@B@@@@@B@@@@A@@B@@@@@@@@@@AC@@@@@DA@@DA@ABA@BB@B@@@BA@@B@@@B@@@B@DAD@@@B@@BBA@@AC@@@AA@@@@A@@A@@@AA@@@@@@A@AA@@@BA@@CA@A@@@AAA@@AA@B@BAB@@@D@@A@A@ABA@@BA@@@A@@@A@@@A@@AA@@@@@@@@@@BA@@@ABA@@B@@@@@BA@ABA@@ACB@@@@@AAAC@@CAA@@ACAECAAAACAC@A@@@EC@@A@CAA@E@A@@@@@AA@@A@@@AC@@@AAAEE@AAAAAA@@ACC@AAA@@ACAACAACAA@CA@AAACAACCAA@AAAAA@@@@AA@AA@@A@@A@@AAA@@@AB@@@BC@@DCBABA@@DC@@BC@@@A@A@@BC@@AA@@CAA@A@CA@@@@A@EACAA@@A@@@@@@@A@@@ABA@@@@BABA@AB@@@B@B@@@B@@ABA@@@A@A@AB@@C@A"],encodeOffsets:[[123046,31002]]}},{type:"Feature",id:"330106",properties:{name:"西湖区",cp:[120.130194,30.259463],childNum:1},geometry:{type:"Polygon",coordinates:["@@EDC@CDA@CDA@@BCBCBCDAB@@CBA@C@A@A@AAA@A@@@AC@@AAAA@@AA@A@@AAA@@@@AAB@@A@@@AB@B@@@B@B@B@B@@@B@@AB@@C@A@@@A@C@A@@@@@C@A@A@AB@@@@AB@@@BB@@@AD@BABAB@@CDBBA@ABAB@@A@ABA@@AAA@@AB@@@BA@@BA@@@AD@@@B@@@@BB@B@@@B@@@BABCBA@@AA@@@C@A@@@A@A@AA@@@BA@A@@@C@@B@B@@@B@B@B@@B@@@B@@D@@@B@B@@@BB@@@@B@@@B@@@BB@@@@BB@@B@@@@@B@@BB@B@@@B@@@B@B@@AB@BB@@BB@B@@@@@@D@BBB@@@B@@BB@@A@@HD@@A@@@@@@B@B@@@@B@@@@@B@@ADB@B@BB@@A@@D@@@@A@@B@B@@A@@B@B@B@@@@BBB@B@@@@@@B@@BB@@BB@@@B@@@@A@@@@B@BAD@@AB@BA@@BA@@@@D@B@B@BB@B@@B@@A@BB@B@@@BA@ABA@AB@@A@@@A@@BEAA@AB@@@AC@A@@@@@A@@@A@ABABA@@@BBBD@BB@@@B@@B@@@BB@@BB@@@DB@BB@BBB@B@BB@@@B@B@BA@AB@BA@@@BBA@@B@B@@@B@@ADA@@B@B@@@@D@D@@@B@@@@@AB@@@@@B@@@@B@B@@@@BB@BA@@@@@@@B@@B@A@@BB@B@@@@BB@BA@@BD@@@B@@A@A@@@@A@@EB@@A@@BA@@@A@AB@B@@@BAB@@@@A@@B@B@@B@B@B@@@BC@@@AD@@@@A@@@AB@BD@@B@BA@AB@DD@@@B@@A@@@@B@BA@@B@@AB@B@@@@@B@@B@BB@@@@@B@@B@B@@@B@B@@BB@B@BB@AB@B@@B@@@@BBB@BB@@BA@@@@D@@BB@DB@@B@@BB@D@@@@@@BB@@@AB@@BB@@@@@BA@@@@@@BB@@@B@@@@@@AD@@@@BB@BB@@@@A@A@@@@B@@@@A@@@@B@@@@B@DB@@@B@@D@@AB@@@B@@B@@BBB@@B@@BB@@B@B@@@@@@B@@@@@@B@ABB@@B@@@BAB@BDB@@D@@B@@AB@@@@@BB@@@@B@@A@@@@B@@A@@@@@@@A@@@@B@@@B@@@B@@@B@@@BB@@B@AA@@@ABBB@@BB@@B@AD@B@@@@@BD@@@@B@B@B@@@@A@A@@@@B@@A@A@@@A@@@AB@@@AA@A@A@@@B@@BA@@@@@AB@@@B@@BD@BBBBFBBBBBBBB@BBB@@AB@@@AA@A@@BA@A@AB@@@@@@@@AA@BFHFFABA@ABA@A@CFABC@CBC@@@C@CACA@@EACAA@@@@@AB@B@@ABAB@BA@AB@@@B@@BB@@AB@@@@B@@B@@@@@B@@A@@@@@@@A@@@AB@@A@@@A@@BA@@D@B@@@@B@B@B@@B@D@B@@@B@@@@@B@@@@@@A@B@@@A@@BA@@B@@B@@BB@@DA@A@@@@@@B@@@@@B@AAB@@BD@@CA@@C@@@@@@@@B@D@@B@B@F@FB@@B@@B@B@D@@@BAB@D@D@@@D@@@@ADAB@@@@@@B@@@B@BB@@BB@@B@D@@@@@@@DDBAB@B@B@@@@@B@@@D@B@@@B@@BB@@@@A@@@A@@D@@@BBABD@@B@@@B@@B@B@@@BC@A@A@@B@F@@BB@@@@@@@B@D@@@B@BBBABB@@@B@@@@B@B@BA@@@@BB@@@B@B@@B@B@B@@@@A@A@@@@@AB@@@@@B@ACB@@@B@B@B@@@@A@@@@DAB@B@@@AAACD@@AB@@@BA@@@@@AB@@@B@AA@E@@B@D@@@@@@CA@@A@@BAB@@ABA@AB@@@D@BA@@B@BA@A@@@@@A@ADA@@@@BB@@@C@@BCDB@@DEBC@@@A@@BA@ADEBC@CBAA@@@B@AAA@BA@@@A@@AA@A@A@CH@@A@A@ABID@F@D@@@D@D@DBH@B@@@DBB@B@B@B@DA@AB@D@B@DBB@D@@@B@@@BABA@@@ABC@@BA@ABCBA@@BABCBA@A@A@@B@DC@A@@@@B@@C@A@A@@@@BA@C@@@A@@@@B@@A@@@ABC@C@@@@BA@CA@@@A@BA@AB@@@@@B@@A@@@@BCB@BC@@@@@A@@A@@@A@AAAA@A@@@@AA@@B@@@B@@A@@A@@AECAA@AAA@AB@@AA@@A@A@@BA@C@@BBFCB@@C@A@@BA@@@@AA@@@C@@@A@CA@@AAA@@AA@@@AAAA@@A@A@@@B@@A@@B@@@@A@@@@@@@CA@AAAA@@@AA@AG@A@A@@BA@@BA@@@AABA@ABA@@@@@A@ADC@@@AA@C@AA@@@@@@@A@@@A@@@@@A@@@AA@@AA@@@@A@A@@@@@@DGGAAAECCAAAAAEA@AAA@A@EAA@A@C@E@E@C@C@CBABEBCDG@@BA@ABC@@BCB@BAFELIFCDABADANGDABABABAFCBABCB@B@BADADAD@BABABABADA@A@@BA@A@A@C@A@AAGCG@C@CBA@@A@@@ADCA@AA@@@ACAA@AAA@CBA@ABC@@@@AAGDKA@BE@C@@D@@@@@@J@ED@@@D@B@@@BA@@@AB@@C@EAECAA@@AFIA@B@@BB@@CFB@@@BBB@@BB@BB@@A@GAA@A@@@A@@@C@@@@@C@C@@@@@@BAAA@CB@@AAACCCAACEAACE@A@AI@@@ACA@@A@ABEEAEAE@@BBD@B@B@@@B@B@@A@A@A@A@AAC@@AA@GAC@E@C@E@A@A@C@AB"],encodeOffsets:[[122962,30803]]}},{type:"Feature",id:"330109",properties:{name:"萧山区",cp:[120.264253,30.183806],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AAA@@@AAC@@@@AAA@A@@@@@CFAB@@@@@ABA@@A@@@AB@BA@@@@@BA@A@ABABA@A@@@@AAAA@@AA@@AAC@AAA@ABAAABAAAA@A@@@@@AAAAAA@@AA@@@A@CAAAABA@@BA@AB@@@B@@A@@B@@@@@B@BBBBB@@@B@@@@A@@@CBA@AB@@@BAB@@@B@@AB@@@@@B@B@@ABCBA@ABADAB@@BD@BA@@@AAABAB@@ABA@C@@BAB@@@B@@AB@@@BA@@BA@@@@B@@AB@@A@@B@B@B@@AB@@@B@B@DBB@@@BB@BBBA@@@BBBB@@@@@@B@@@@@BBB@@@D@D@@@BDD@@@B@BA@@@@B@B@@@BB@AB@@AB@@CBABA@@D@@@@BB@@@BBB@@@@@B@@@@B@B@@BB@B@@@@@BAB@B@@@DBB@@B@@@@@@B@BBB@BB@@@@@D@@@B@@B@@@@@AB@@@B@BAB@@@@@B@@@@@@@@@BB@B@@B@BBD@@BBBB@BBBB@@B@@ABAA@@A@@B@@@BA@@BBBA@@BAAA@CFAA@@@BA@@@CD@B@@@B@@@BB@BB@@BB@@@@B@@@@@@@B@BD@@BB@@B@@@@@BBBB@@@BB@@@BD@@B@AB@@@B@@@@@B@@@@@B@@@B@B@@@@@B@@B@B@@BBB@@@BAB@B@@@@@B@@BB@@@B@@@@B@@D@@@BB@@@CD@@A@C@@BC@@@@B@@A@@BB@BBDB@@@@BC@@@@@@D@@@@B@@B@@@D@@@B@B@@BB@@BBD@@BB@@BBB@BADB@@@@@@BB@@BB@@@BB@B@@@BBB@BB@@@@B@@B@B@B@@@B@@BBBB@@@BBB@@BB@B@@@B@@@B@BB@@B@@@B@BB@@BB@BB@@@B@@@B@B@@@B@@A@@@@@BD@B@B@BB@@B@B@@@@B@@B@B@@ABBB@@@@@B@@@@@@@B@@@BB@@@@BA@@@@@A@@BA@@@@@@B@@@@@@@@@B@@B@@@@B@@@@@B@@AB@@@B@B@@@BA@@B@@B@@B@@@B@D@@@@@B@@@@A@A@A@A@@B@@@@A@@BAAA@@@@@A@@B@@AB@@AB@@@@BB@BB@BBB@@BB@AB@@BBBBBB@@B@DDDB@@@BBBA@@@@BA@@@@@@B@@A@AB@@BD@@@B@@B@@BB@@BBB@B@B@B@@@@@BABAB@@@@@B@@B@@@BA@B@@B@B@@BA@@BD@AB@AA@@@A@AA@AA@A@@B@FBB\\P@@BBFDFBD@@@BA@@B@@A@@@A@C@@FCI@@@@@@@@CD@F@@ALBHCBB@@@@AD@BAB@DBB@BBBBD@@B@@BDBBC@@B@@@AB@D@DDHBH@B@B@D@B@BAB@@@BCBABABABABC@CBCBABA@A@ADABEDABABABCBMHCBABLH@BB@@BB@B@@@@B@@B@@BA@@BB@@@@@BBBBBB@@BABA@@A@@@@A@@@@DB@@B@@BBBBB@@@@@@B@@@B@@@BA@@B@B@@B@@BBBB@@DB@@BBB@BA@@BA@@BA@AB@B@BA@A@@BABABA@@FD@@@AAC@@@@B@@BB@@@BB@@DBB@@@@@BA@AB@DE@B@@B@AA@@B@@@@A@@@@BAD@BAB@BB@@BBBB@B@@B@@@@ADA@ABAB@@@@AAA@@@@DAB@@@DDBA@@DBB@DD@@@@AB@@AD@@@@@@@B@BBBBD@@@@BB@@@@@B@@@B@@@@@BBB@BD@B@@@@@@@@@@@@B@BAB@B@@@@FB@B@@@B@@@@@@AB@@@@@B@@A@A@@@A@A@A@@@@@@AA@@@A@@@@@@@A@@@@@A@@B@@@@DB@@@@@B@@@@@B@@BB@@BB@@AB@@@@@@@@@@@@@@BB@B@A@B@@B@@@@@B@@@@BD@@@@B@@AB@B@@AAADB@@HD@B@@@AD@BT@@B@F@F@F@F@F@F@BBX@@@V@BBF@BA@@@@B@@C@@@EB@@A@CDMHNPJPJLFJBDHLDBJIF@JJD@B@DDD@@@@@B@@@NDD@BADA@@@@DADAHA@CB@BDFA@DAB@@BBD@BB@@DBFBB@@M@@B@@@D@@@GIBC@@@CNDBCFKDCBCDCB@GI@@@AB@@@@@B@FADAD@B@B@B@D@BAF@D@BF@B@@A@NFDAHCLAAC@A@@H@D@B@B@HB@B@JAD@B@@B@D@HDB@B@DBBBHFHFBBBBHHHLDDHLFLBFBBBDHRDHBFJV@@@BJV@BBBHRBBHTBDHRJVBB@@BBDDB@BBFBHDHBD@HBHBXDVDVDB@N@F@f@NAD@F@l@P@Z@R@\\BF@X@D@Z@D@N@DA\\CDAHAH@HAFAF@PCTEB@DABADCBABCBABE@ABY@Q@[@]BMFKDKHGHGBA@AWSY]@ACEOQAAACIIOQAAAAAAWMUKAAB@@@CEMQKUCE@@@@@AEG@@@@AAAC@@@@A@AEEG@AAAAC@AACAAAC@A@@@AAAAE@AB@A@@@@AACAK@@@A@@CAA@C@AA@AA@CB@A@A@@@@@@A@@A@@@@A@@@@@@@@@A@@@A@@@@@ABC@C@@D@@@B@@@A@@@@@@A@@@@EA@@@@@AB@@A@@@@BA@@@@AA@ABA@@B@D@@@@A@CB@@A@AB@A@@ABA@@AA@C@@@A@@@A@A@A@@@A@A@@@@C@@A@CB@@ACA@AB@@AC@A@@CBA@BB@@A@A@@@ABA@@@@@@@@@B@BAB@@@BB@BA@A@@D@@BB@@ABABA@@@@@@@@@A@@@@@AB@@@@A@@@AB@@A@A@@@A@C@@@A@@@@@@@CA@@@@@@A@@B@@@@A@@@A@@@A@@@@@@@A@@@@@AB@@@@A@B@@@@B@@@@@@A@@@@@@@@@@A@@AA@@@B@@@AA@@B@@C@@@@AA@@@@@A@@@@@ABA@@@@@@@A@A@@@@@@A@AA@@AA@@@@@@A@@A@@@AB@AA@G@A@C@@@AB@B@@@@A@ABAB@@C@@B@@BB@BA@CB@BA@@B@@AB@@@@AA@AA@@@@B@@@@@BA@@BCC@@@@@@AB@@AB@B@@@B@@@@@@@B@@@@B@BB@@A@@B@@@@B@@BA@@@@@@@@@@B@@AA@@@AAB@@@@A@@@@@@@AA@B@AAB@A@@B@AA@@AA@@@@@BA@@@@@@@@@@@@AA@@@@@@@@@@BA@@@@B@@@AA@@@@@@@A@@A@@@B@@A@@@@@@@@BA@@@@BE@A@@@@@@AB@A@@@@A@@C@@@A@@@@C@@ABAA@@@@A@@BA@@@C@@@@@@@A@A@@@C@A@A@@@A@A@@@C@@@A@@@@A@@A@@@A@@@A@@@@A@@@A@A@B@AB@@@@A@@@ABA@@@@@@@@@A@A@CA@ABA@@@@C@@B@@@@A@@@A@@@@@@@A@@@@@@@AB@@@B@@@@@C@@AC@@@@A@@@C@@@C@B@BA@@@@BG@C@@A@@A@@@@@A@@@@A@@@AB@@@B@@@@AABAA@A@@@@@@@@@C@AA@@@A@@@@@@@@A@@@@@@B@AAB@@@A@@@@@A@A@@@A@B@@C@@@@A@A@@@B@@@B@@A@@@A@@@@@A@A@A@A@@@@@@@A@@A@@@@@@@A@@A@@A@@@A@@@@@@A@@@@@B@@A@@B@@A@@@@@@B@@A@@@@@@B@@@@A@@@@@A@AA@@@A@@@A@@AB@@IB@A@@@AA@@@@@A@@@@@@@A@@@@@@@@@@@A@@AA@@@@@A@@@@BAF@@@B@@@@@B@@@@@B@@@B@@BFBD@BAB@@@@A@@@@B@@@@@@@AA@@@@@@B@@@@B@@@@@A@@B@@@@@@@@A@@@@@@@@B@@@@@@@@B@@B@@@@@B@@@A@@@B@@@@@B@@A@@AAC@@@@@@AD@@A@@B@@B@B@@@@B@BA@A@@@@@@A@@@@AAAA@A@@B@@@A@@@A@@@AA@@A@@@@@@B@@@@A@@@ABC@@A@@@C@@@@@A@A@@@C@CBA@A@C@E@E@@A@@@@@A@@@A@@@@@@@@@@A@AC@A@@@A@@D@BBD@B@B@@AB@@@@A@C@CA@@A@AB@@AB@@BB@D@B@@@B@@@@CBCBABABABADAD@DAB@BAB@@@@AB@@A@CA@@AAAAA@@@E@C@CB@@GAEA@@EAAAA@AA@AAA@AAA@@A@@BCDABABA@@@@@AA@@@A@C@A@@@ADC@A@@@A@@@@C@C@C@C@A@A@C@C@@@A@@A@@@ABA@A@@@@AA@@EA@@AAAAACAAEGCC@A@@A@ABED@@A@@@CC@@AA@@A@@B@@@DAB@B@DAD@BAB@BABA@@@GAGCAAGECC@@AABA@@B@F@B@B@@@@@@AAAAEAC@@@A@@A@@AA@CAAAAA@@CE@AAAC@CAAAAA@@@@@CDE@A@@@A@@BA@@@BB@@BA@@@@@@@B@B@B@@ABA@@B@BAB@B@@@@B@B@@@@B@B@BAFBB@@@@B@@@@@B@@BB@@D@@@@@B@BBBD@@@B@@B@@@@B@@@@@BB@BB@@B@@A@A@@@AB@@A@@@A@@BAB@@ABA@@BADA@@@@B@@@@AB@BA@@@@BB@@@@B@@BB@DBBB@@B@@ABA@CBA@@@@@@BADC@@@@B@@@B@@AB@@@@@@@C@AA@AA@BA@@@A@A@@@C@@@@@@C@@A@@@A@@@ADB@@B@@@@ACA@@A@@@BAA@@@C@@@@AB@BA@@A@@A@@@@@@@@@@@A@AA@AA@@B@@AB@@@B@@ABA@A@A@@@@AABA@@AA@@@A@@@A@@@A@@@AA@@@@@@A@@B@@@B@B@@@B@@A@@@@A@@@@AB@@@@@B@@@@A@A@@@AA@@@A@@@@@AA@A@AA@A@@A@@@@@AA@@A@A@@A@A@A@@@A@@@A@@@A@@@AA@A@A@@AA@@@@B@@AA@@ABA@@BAB@B@@@D@@@@@BA@A@@@@@@B@@C@@@@@@BABA@@B@@AB@@@B@@@@@@@B@BA@@B@@@@CA@@@B@@BB@@BB@@@B@@@@@@@@AAA@ABC@@@AB@@@@B@@@BA@@B@@@@B@@@B@B@@AB@B@@BBB@@@@BA@@@@AA@A@@@AB@@@@@AA@@A@@@@A@A@@C@@@@AA@@@BC@AB@@AA@@AA@@A@AB@@@@@BAD@BB@AB@B@@@@@BB@@@A@A@@BAD@@AB@AA@@BA@@BA@A@B@@B@@@@@@A@@@@AAAA@@@A@@@A@@@@@@DA@@@@@@B@@@@@@A@A@@B@@@@A@A@@@A@@@@B@@A@A@@A@@@@AA@@@@@BC@A@AAA@@AAC@C@@@AA@@@@DAB@FABA@@@A@A@@B@BB@@BA@A@@@@@@AA@@@@BA@@AA@@@ABA@@@AB@@@B@@A@A@AAA@@AA@@AA@@AB@@AAA@@@A@@@C@A@A@ABA@E@@A@@@@A@A@A@C@@@A@@A@A@A@@@@DC@@A@@A@@@AAA@AAA@@@A@@@A@@AA@@@A@A@@@A@A@AA@@AAAA@@@AAA@@@A@AAA@AA@@@A@A@@@@AA@@@@A@@AA@@@A@@@A@A@@@BA@AA@@A@@A@@AAABA@CBAA@@@@@CAA@@@AAA@@@AAAA@A@@AC@A@@CAA@@@@A@@@@@@A@@AAB@@@AA@CBABA@C@A@A@A@@@@@@@A@A@@BAD@@A@@B@@AAA@A@@@@@ABABAB@B@@@@A@@@A@@@A@@A@AB@@@@@AAAAA@@@@A@@@AAA@A@@CA@@@@@A@@@@AA@@A@@A@@@@DB@@@AAA@ACB@@@@@@@AB@@@AA@@AAA@A@C@AAA@A@C@@@A@AB@BA@A@@@A@A@ABAA@@@BAB@@@D@B@@@BBB@B@B@B@@@@ABA@@BAA@BB@AB@@@@@@AB@BB@@B@@A@A@@@@BB@@@@@AB@@ABA@@@ABA@@@@B@@@@A@A@@@AA@@@@@A@@@@@@A@@A@@AA@@@@@AA@A@@A@@A@@@@@@@@A@@@@A@@@@@@B@@@@@@AB@AA@@@@@AA@BA@@@@B@@@BBB@@@@@BA@E@@@AAC@A@AB@@AAA@A@@@AB@BA@A@@A@@CBA@@@@@@@BA@@@@DA@@@@@@A@@@@@AA@@@@@AA@@@@@AB@@BB@@@B@@A@AB@@A@@@@AB@AA@@@BA@@B@BAA@@@@@@BA@@A@@@@@@@@BA@@AA@AA@@A@@@A@@@@@@A@A@@AA@@@B@@A@@@BDA@@@@C@@A@@B@@@B@@@@@@@AA@@A@@@BA@@@@AA@@@@@B@@@B@@@@@@A@@A@A@AC@@B@@@BB@@@@@@@@BA@A@@@B@@BB@@B@@@@@@AAA@@@@B@B@BB@@@@@@B@@@DA@@B@@AB@A@@@@@@A@A@@AA@A@@A@@BAB@@AB@@A@@AA@@@A@@@A@@@A@A@@B@BCBA@A@@@AACA@@@@ABA@@@@@@@AAAAA@@A@ADC@ABAB@@A@@@@BB@@AC@@@@A@@A@@@@@@B@B@B@@A@@BA@@BA@@@@@@AA@@BA@@@@A@E@ABA@@@ABA@@@@@AC@@A@A@B@@@BA@@@@A@@@C@A@@@@A@@@@B@BA@@@@@A@@A@@@@BA@@AAA@@ABA@@A@@A@A@@@AA@@@A@@BA@@B@B@B@BB@@@A@@@@@AA@@@A@@AC@AA@@@@BA@@A@AA@AAA@@@BB@AB@@@@AB@@@A@A@AB@@@A@@@@@A@@B@B@@A@@@AB@BA@@@A@A@AD@@B@@@A@@@AAACA@A@@@A@@@@@BBB@@B@@@@@@@BAB@@@B@@B@@@BB@@@D@@@@A@@@@@@B@@@@@@@@A@@@@BA@@@A@@B@@@@A@@@@@A@@@AB@@@AA@A@AAAB@@BB@B@@AAAA@@AA@@@AA@@@@A@@@A@@A@@@@@@@@@@ABA@@A@@A@@AB@@AA@AAA@A@@CB@@@A@@@@D@@@@@@A@@CB@@A@A@@AA@@B@@@BA@@@@@@@A@@A@@@@@B@@A@@@AA@AAA@@@A@@@AAAB@@@@A@@BCAAA@A@@A@@@@@A@@A@@@@A@@@@AAAA@@@@B@B@B@BA@@@A@A@@A@@A@ABA@@@@A@E@@@@@@@A@@@@A@@@@B@B@@@@AB@B@B@BA@@@A@@@@AA@A@@@A@@@AA@A@@@@AAA@@@A@@AA@@A@@@@@@@@AB@@@AC@@A@@AAAAAAAA@AA@@@AA@AAAA@@"],encodeOffsets:[[123019,30582]]}},{type:"Feature",id:"330111",properties:{name:"富阳区",cp:[119.960076,30.048692],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@AA@@@A@AA@A@AAA@@@@AA@@A@AB@@ACAAA@CCAA@@@@A@@BA@A@@@A@CBA@AAA@AB@@@@@@E@AA@@@@A@CA@@A@A@@A@@AA@@AA@@@A@@A@@AA@C@CA@@AAA@@@AA@@@AAA@@A@@A@@ABC@@B@@@B@B@@AB@DCB@@A@A@@@A@ABA@A@AB@D@@A@A@C@@@AA@@@@A@AAA@ABAA@@AB@@ABAB@BA@@@AB@@A@@@@B@@A@@BA@@@AB@@@@A@ABA@AB@@AAA@CBAB@@ABA@A@C@@@A@@@@BAB@@A@ABA@AA@@A@A@A@@BA@A@@@@BA@AB@B@@@@AB@B@B@@@B@BA@A@@A@@@AAA@@@@ABADA@@B@BA@AB@@BB@@@BAB@@@@@B@B@@@B@@@B@B@@@@BB@BB@@@BBAB@BBBBB@@BB@B@@@B@@@BBB@@@B@BA@@@@B@@@@@@@B@@CB@@A@@@@@@@@B@@@@@@@B@@@@B@@@BB@BB@BA@@BABC@@BA@@D@BAB@@AB@@BBB@@@@BBBBBB@B@@B@B@@AB@B@@@@@@B@@@B@@@@BB@@A@B@@B@@@@@@@B@@@BB@@@@B@@B@@B@B@BA@@B@@@B@B@@@@AB@B@@AB@@BB@B@B@@@@@BA@@B@@@@@BBB@@BB@@@@B@@BBB@@ADBB@@@B@@BB@@B@@B@@@@@BB@@BB@@BA@@@A@C@ABA@A@AB@A@@ABA@ABA@A@A@A@@AC@A@AA@@CBA@@B@@AA@@A@A@@@A@@BA@A@A@@BA@AA@BAAA@A@ABA@AAAAA@A@@@AB@BA@ABAB@@AB@@ABA@@BAB@D@B@D@@@@AB@@@@B@DDB@BB@@A@@D@B@@@@A@AB@@@@ECC@@@@@AB@@AA@A@@B@@A@@A@ABA@ABA@ADCDAD@@AD@DAB@B@BAAC@AA@@@AABAB@BAB@@@@@@@A@A@@@@A@@B@@@@A@@@@BA@@@AB@@BBA@@@@B@BA@AB@@AAA@A@@@CBA@A@@@A@@AA@ICAAA@A@A@MBCBE@AB@@@@A@ICMEA@A@C@@AA@E@AA@@A@C@@@C@@BA@@@@@A@A@@@AAA@A@@AA@@AA@@@A@A@A@A@@@A@A@@@A@@@A@@@A@@@A@ABABC@A@A@@A@@AAA@A@AAA@@AAAA@@@A@A@A@@@@AA@A@A@@@A@@AA@A@AAA@@@AA@@@AA@@@@@AAA@@@AA@@AAA@AAA@@@@@A@@@ABC@@@@B@@@BA@A@A@@@@@@B@B@@A@@B@B@@@D@@@BB@B@BB@@@B@D@B@@@@A@@@A@@@@BA@@BC@AAABA@@@@@A@@AAACAA@@A@@A@CDBB@@BB@@@B@@@@BBBB@@A@@@@@@@A@@@@@@@@B@@A@@BAA@@AAAA@@@@@B@@@@@BB@BBB@@@@@EF@@BB@@A@@@A@@BA@A@@@@B@@ABA@@B@@@@AB@BA@@@@@A@@@A@@B@@@@@B@B@B@@@B@@A@BB@B@@@B@@@@@B@@@@@@@@@@@@@B@@BB@BABA@@@AB@@@D@B@D@@B@@B@@@B@@@B@BAB@B@@@BBB@@B@@@@@@B@@@@B@@@BB@@@@@@@@@@A@@@@@BB@@@@@@AB@@@@@BAB@@A@@@@@@@@B@@@B@@B@@@@@BA@@@@@BB@@@A@@B@@AB@B@@@BBB@@AB@@@@@BA@@A@A@@A@@@A@@B@@@@@@A@@A@@@A@A@@@A@AA@AAA@@@EB@@@B@B@@@B@@@B@BA@@@A@@@@@A@@@CBA@@@A@CDA@@@@AAB@@CAA@@@A@ABAA@A@@E@A@A@A@@AA@@@A@@A@@AA@@A@C@@@@@A@@@@@A@A@AA@@A@@@A@@BA@@@@BE@@@@B@@@@@@@B@@@BB@@@AB@@A@@B@B@@@@AB@B@BA@BB@B@@@@BB@@@B@@BBAB@@@@ABA@@BA@A@@@CB@@@@@BA@AB@@@B@@@@A@@B@@@BAB@B@@@@A@@BB@@@AB@@@BAB@@@@A@@@@@A@A@@@@@A@@@A@@B@@@B@@@BA@@BBB@B@@@@@BBB@@A@@@A@@@CAC@A@@@A@@@A@ABCDAB@@AD@@BB@BA@ABA@@B@@A@A@@@@@AB@@@@A@C@@@A@@@@BAB@@@@@@CAA@A@A@@AA@@@ABA@@A@B@@@@AB@@@@ABC@ABAB@@A@AB@@ABA@A@@@ABABA@@BA@A@A@@@@@A@A@A@@@AAC@@AA@@@@AA@@AA@@A@@CAA@@@@@CAA@AAA@@BA@AB@@@B@@AA@@ABA@@B@@@@AB@@@@@BB@D@@B@@A@@@BB@A@@@@B@@@@@B@@@@@AB@@CBA@@@@B@@@BC@@@@BB@@@B@B@DC@@@@@BAB@BA@CB@@A@@B@@@@@B@B@BA@@B@@AB@@@@BDBB@@@D@@@B@B@@BBBBB@@@@@@BAB@@AB@BA@@@A@@@A@A@@@@@AB@B@@AB@@BB@B@@@@@@A@@B@@A@@@ABA@AB@@AAAAA@A@AA@@@@A@@B@@A@AB@BC@AB@@@@A@C@@@EC@@AAA@A@@@A@@@@B@@A@@AAAACA@@@@BA@@@A@@B@@@@@B@@@B@BA@@@AB@@ABAB@D@@@@@@AB@@@B@@@@BB@@B@B@@@@@@@@BAB@@@B@@@@BB@@BB@@@B@@@@@B@@@D@BB@@@@B@@@@@B@@BB@@@@BB@@B@BB@@@BB@B@@BB@B@@B@@A@@@@BCB@@@B@@ABAD@@@B@@@@@BB@@B@@AB@@@@B@ABA@@@@B@DAB@BBB@@@@B@@BABBB@@@B@@BB@B@B@B@BA@@B@BA@AB@@C@@@AB@BA@@@@@ABAB@@@@ABA@@@AAAAAAA@A@CD@@@@AB@@AB@@ABA@@@A@@@BB@@@@A@@@@@@DA@A@@@A@A@@@A@A@A@@@A@@BA@@B@AA@@@@@A@@@A@@@A@A@@@@@AAAA@@@@AA@AA@@AC@@AAA@@AAA@@B@@@BAF@BBBAB@B@@ABBBA@@B@@AB@B@@@@A@@@A@@@AB@@AB@BA@@@AA@B@@@@A@A@AAAAA@AAA@A@@@A@@@@@@BAAAB@@A@A@CB@@AB@@@@@B@@A@A@@@AB@@@B@@A@@@@BB@AB@@B@@B@@@B@B@@B@@B@@@B@@B@@B@@@B@AB@BB@@B@B@@B@@DB@@B@B@@@BB@@B@B@@B@@@@@@B@B@B@@@B@@B@@@BB@@BD@@B@@@BB@B@@@B@@B@@@@@BAB@@BB@@@BB@@@@B@BAB@BBDB@AB@@AB@@@@@@A@@@A@@@@@A@A@@@@@@@A@A@AB@@@@A@@BCB@@A@A@@@A@A@@BA@@B@@AB@@AB@@@@@B@@@BA@@@@@@B@@@@@@BB@@@BA@@@B@@B@@BB@@A@@@@B@B@@BB@BD@@@@@BB@@B@B@@@BA@@B@@@B@@AB@@@@A@@BB@@@@BBB@@@@BB@@@BB@B@@B@@B@@B@@BBAB@B@@@B@B@@@B@@B@B@@@@B@@@B@@@@A@A@@BA@@BA@@BA@@@@@@B@@@@@@@@B@BB@@B@@@@BBB@@@B@B@B@B@@BB@BB@@@@@BB@@ABA@A@@@@@A@A@A@A@@B@BAB@BBBBB@@@@@@B@BB@@B@B@@B@BB@@@A@@@AA@@AB@@A@@D@@AB@@@B@@@@AB@@@@BB@@B@@@@BB@@BB@BAB@B@@B@@@D@B@@AD@@@B@BB@@BBB@@@@@BBB@B@@@B@BB@@@BBB@@@BB@A@A@@B@@A@@@A@@@@B@B@D@B@@B@B@@@B@B@B@B@B@@ABBB@D@B@B@B@BB@@@B@@@@@D@@A@@@@B@@@@BB@@BBBBB@BB@@B@B@@B@DDD@BB@@@@B@@BB@B@@@BBB@B@@B@@@AB@B@@A@@BB@@@@@BB@B@B@B@@B@@@B@@@BAB@@@B@@AB@BB@@@BB@@B@@@@@@B@@@@@@@B@@@B@B@@@@@BB@@BB@@B@@BB@@@@BB@B@@@BAB@@@B@@@B@@@BBB@BB@B@BB@@@@@DB@@B@B@@@BB@A@@B@@@B@@@B@@B@@B@@@BB@@B@@@@@BAB@@ABA@A@@B@@AB@D@@@BAB@@@@@B@BA@@@A@@BCFA@@B@@A@@A@A@ABA@@@A@@@A@@@@A@@@A@@@ABA@@BA@A@@A@@@A@@A@@@A@@B@@@@A@A@@@@@@@A@@AA@@@@@@A@@A@A@@@A@A@@@A@@@A@@@A@A@@@A@@@@@A@@BA@@@@A@@@BA@@B@BB@@@@B@DAB@B@@@BA@@@AD@BA@@B@DAB@B@B@D@B@@AB@BB@@B@@A@AD@@@AA@@@@@AB@@@@@B@BA@@AA@AA@@@BA@AB@B@@AB@@@B@B@@@@A@AB@@A@@AA@@AA@A@@@@A@@AA@@ABA@CB@@AB@BBD@@@@@@AB@@CCA@@AA@@BA@@BA@@@@@C@@@A@@@@B@@A@@@@B@B@BAB@B@BA@@B@@@@AD@BAB@@@BA@@@@B@@AB@B@@@@@BBB@@@@@B@@@B@@B@@BA@BBA@@@BB@@B@@B@@@@BB@@@BBB@@BDB@@BABAB@B@@BB@@B@@@B@@D@@@B@@@B@@B@@@B@@@@@@B@@@B@BABA@@@A@AB@@A@@@@@AD@@AB@BA@@@A@@BA@@BCB@B@BAB@B@@@BAB@@@@@B@@@@@@AB@BA@@@A@A@A@@B@@@@AB@@AB@@@@AB@@@@@@AA@@@@A@@B@@@@@BABABA@@DA@@@ADA@@@A@AB@@@@AB@@@@AB@BBB@BAB@DA@@B@DA@@B@@@@@@BB@@@B@B@@ABA@@@@@A@A@@@ABA@A@AB@BA@@B@B@B@B@BBB@B@@@BA@@BA@@AABA@AB@@@DC@@BBD@@@@A@@D@@@B@@ADBD@B@@@@BB@BB@@BBBAD@B@B@@@B@@@BBB@@@BA@@F@@@@BAB@@A@FB@B@@@@@@B@B@@B@@@B@BDB@@BABBB@BC@A@A@@@@B@@@B@BA@@@AB@B@@@B@@@@AB@@@@AB@B@@A@A@@B@@AB@@B@@B@B@BB@@@AB@@B@@BDB@BB@@@AB@@@@@@A@@AA@@A@@AB@@@@@B@BBB@@@@ABA@@@@BBB@@@B@BBB@@ABB@ABB@@D@@@@@@@@B@@@B@@@@@B@@@@AB@@@@@BB@@@@B@B@@BB@@@B@@@B@B@@@D@@@@BB@@@BBB@@@@B@B@BA@@B@@@B@B@@@B@@B@B@@@@B@@B@@@@@DA@BB@@@BAB@@BBB@BBD@@@@@@B@@BB@@@@B@B@@@D@@@BB@@B@@@BB@@@@B@BB@@AB@@B@@B@@AB@BA@@B@@B@@BB@@BB@@@@B@B@@@@BB@B@@@BB@@@@@B@B@BBB@@BB@@@BB@@@@@B@B@BB@@@@@DB@@B@@@B@@@BB@@B@B@@@BBB@BA@BB@B@BB@A@BB@@B@@@B@@A@@@B@@B@@@@@B@@@B@@@BB@@B@BB@@@@@@@BBB@@B@@@@BB@B@B@B@@AB@B@BAB@@@B@B@@ABABBBB@@@@B@@B@@B@@B@@BBA@BB@B@BB@@B@@B@@@B@@B@@B@BB@@@@B@@@@B@@B@@B@BBB@B@@B@@@BBB@@@B@@B@@@BBD@@@B@BB@@@BB@BB@BD@B@@@B@@BBB@@@BBBB@B@BB@@B@B@@@DA@AB@@@@B@@D@@B@@BBB@B@@@BB@@@DB@DBBB@@BBB@BB@@BABCB@@BB@@@BA@B@@B@@B@@BA@@@@@@B@@B@@B@B@@D@B@@B@BB@@B@@BB@DB@@BADCB@@@BAB@BA@AB@@@B@@@D@@@BB@B@BB@@@B@B@BAB@BB@@B@BBB@@AD@B@BBBB@@@@B@BA@@@@AA@@B@B@BAB@@@B@@AB@@@B@B@B@AABA@@B@@AB@B@@A@@@@AAAA@@@AB@BA@@@@@AB@B@B@@A@@@A@A@@A@@@@A@@@A@@@@BA@@A@A@@@AA@@@@@A@@@A@@BAA@@C@@@A@A@@@A@A@@@A@@A@@@BA@ABABA@@@@D@B@D@@@BBB@@BB@@B@@BBBDDB@@B@B@B@BBBB@@@BD@@@B@@BB@B@@@BAB@@@B@D@@@@BB@@@@BB@@@ABBB@@@@@@D@@@@ABBB@@@BB@BBB@@B@@AB@B@@@B@@@@@B@BB@@D@BADA@@B@B@@BB@@@DA@@B@@@@@B@@@DB@BAB@@CB@@@B@@@B@@@@B@B@DA@@@@BBBB@@@B@@FBB@B@BBBABAB@@@B@@@@@B@D@@B@@BAB@FB@AB@@@B@@@BAB@BAB@@A@@@AAAB@@@@AA@A@@A@A@A@C@@B@@AB@@ABA@@BC@A@A@@B@@@@@@A@@AA@@AA@@@A@@@@A@A@AA@@@@@A@A@AB@@@@A@AB@@@@@@CB@@@AAA@A@BC@@@A@@@@@A@@A@A@@@@@@@@BC@@GB@@@AA@@@A@@AA@A@C@@@@A@A@@AA@@ABA@@@A@A@@@A@@@AAA@@@A@@@@@AA@@A@@A@@A@@@A@@@A@@A@@A@@@A@A@@@CA@@@A@@@@A@A@A@@@A@AD@@@B@B@@A@@BBB@B@@@B@D@@@B@@BB@DABA@A@@@A@@@AAA@@@@@A@@BC@@B@@AB@@A@@BA@@BB@BB@BAB@@@BABAB@AADC@@BABA@ABC@@A@@A@@BA@@@@BAB@B@D@@@@@B@D@B@@@B@D@@@BA@@@A@@@A@A@A@A@@@ABA@@B@@@BA@B@@B@BB@@@BBB@@BBBB@@BD@@B@B@BBB@B@D@B@DA@@BADCDADA@AB@DCB@DCD@FCBAD@B@B@F@D@F@D@HBB@@BD@BBB@B@B@B@@@@A@A@@@A@AAC@AF@FBFBAF@B@BB@BD@@J@@B@BDFBBDFBBDDBDBB@@DAB@BB@A@@@@D@D@@@@@D@@@B@@@B@B@HBB@@@AAA@@AA@AA@@A@DE@@AA@@@AJBBE[OAA@E@AB@B@@BBBB@@@B@@BBAC@@AB@@AA@A@@@@AAB@@A@@@@A@@@@BABA@A@@@@@A@A@AAA@AA@@AA@@@@A@@AC@@BAB@@@@A@@@@B@@A@@B@AA@A@@CACCA@@@AAAAAA@@BAA@@AA@AAA@@AAA@@@@BA@@BA@@@AB@@@@@B@BB@AB@@@@@@AB@B@B@B@@@@@@A@@@@@C@A@@@AA@@@@AB@@A@@@A@A@@BA@@@A@@@@@A@@A@@@@A@@@@@@@@@A@@@@B@@AB@@@@@B@@A@@A@@A@@@A@@@@@@@A@@@@AABA@@@A@AA@@@@@@A@AA@@A@A@AAC@@@@B@@@@A@@@A@A@@@A@@AAA@@AA@@A@A@@@AA@@A@A@@@A@@@AAA@@AA@A@@AAAA@@@A@@@A@A@AA@@@@@AAA@AA@@A@A@@A@@AA@@AA@@@@@@CAABA@AA@@AA@@AC@AA@@AA@A@@@C@@@A@@@@A@@C@@@@@@@AD@@@@CAAAA@@AB@@@@A@@D@@AD@B@@@DC@@A@@A@@@CA@@@@@@A@@AA@@@A@@@@@ABA@A@@AA@AA@A@@@@A@@@@@A@A@@@A@@@@@A@@@@@A@@BAA@@@AC@@A@@A@@AAAA@@@@A@@@AA@@ACA@@@@@@@A@@@@@AA@@AAA@@A@@@A@@@ADC@@B@@A@@BBDEB@BB@AB@AA@AB@@A@@@AB@@@BBBA@@@AA@AA@AAAAA@@AC@A@AA@A@@A@@@@@@@@@A@@@@BA@A@A@@BA@@@@A@@@@A@@@C@@@@AAA@AAA@@@@@@@@AA@CA@@A@A@AB@@@@A@A@@AA@A@@@@@@A@@@@AA@A@@AA@@@@@CB@BADA@@BA@@BAA@@A@@@A@A@@B@@A@A@@CC@A@@@C@C@@AA@A@@@@@A@@@@A@AA@A@@ABAAA@@A@@AA@C@A@A@@BA@@@A@A@AB@@@BA@@@A@@B@@AB@@A@@BA@@@A@@BA@AD@B@BA@@BABABB@@B@@AAC@@BABCBAB@DABA@@@A@A@@@@BA@@@A@@BA@A@@BAB@DA@@B@@@@@@A@@AAAA@A@A@@@@@AB@@@@A@@BAB@@AB@BABBDBB@@@B@BA@@B@@@@A@A@@@@A@@@CB@@A@BAAA@@@@A@ADA@@@@@@AAA@@AA@@@AA@@@@A@@@@AA@@@@@A@AB@@AA@A@@@ACAA@AA@@@@AB@BA@A@@AABA@@@A@A@@B@@@@@AA@@@AA@@@@A@@@A@@AC@@C@AAAA@@A@@@AAAA@B@@@B@@@@AB@@A@@@A@@@A@@BC@@B@@ABABA@ABA@@BAB@@AB@@@B@@ABCC@@@BA@@BA@@@AAC@AA@@@B@@@@@@A@@A@@CAAA@@AAA@@@@@A@@AA@@AABA@@@C@AB@@C@@@A@@BAA@@@@@@AB@@@@A@AB@@@@A@@AA@@@@B@@A@@@A@AAA@@@@@A@@@A@A@@@@@@AA@A@@AA@@@@A@@A@@@A@@@A@@@@@@C@@AAA@AA@AAA@AA@@AA@@AAA@A@@@@@AAE@@@@BA@@@@B@@@@@BA@A@@BA@@@@BA@A@A@@@A@@AABA@A@AAA@A@@B@@AB@B@@@@@@A@@@@BA@@@@AA@@@@AA@@A@@A@@A@@@@@@AAAA@C@@@@A@A@@AA@@BA@A@A@A@@BAAC@@BA@C@@AAAA@@@@A@A@AB@@A@@A@@@@AAA@AA@@A@@A@@@A@@AAA@@BA@A@@@A@@AA@@@A@CACA@AABA@@@@@A@@AA@A@A@@@A@@@AB@@CBA@@AA@@AA@AABA@@A@CAA@A@@@AB@BA@ADA@@B@@AB@@A@@@@A@@@A@@@@DC@@BA@@@@@AA@@AA@AA@@A@A@A@ABA@@@@@ABCB@@@B@B@B@@BDC@AB@@@@A@A@@@A@@A@@A@A@@@A@"],encodeOffsets:[[122851,30480]]}},{type:"Feature",id:"330110",properties:{name:"余杭区",cp:[120.299401,30.419045],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@AAAA@@@@CBA@A@@@@@@A@@@A@@DA@@BA@ACA@@A@@@@@A@@@CB@@A@@AA@A@@@CBABC@@@AAA@@@@@A@@@A@A@@BA@@@AA@AAA@@A@AA@B@@C@@@@@@@AABA@@A@@A@@A@@A@@C@A@@@A@AB@@A@A@@AA@@@C@@A@@AAAAA@A@A@@@CAACAA@@@AA@@AA@AA@@C@A@C@@@@@ABAB@BAB@@B@@@@B@@@B@B@@@B@B@@@DB@AB@@@B@@@B@@@@BB@@B@B@@@AB@@@@@B@@@B@@B@@@@B@B@@@BA@A@A@@B@@@@ABA@@B@@BBBB@@@@@BA@A@@BA@@@ABBBA@A@A@@@A@@BA@@@A@ABA@A@@@BB@@@@ABA@@@@@AAAAA@C@@BA@AAA@@@AAA@ABA@A@@@A@@A@AAA@@C@@@A@@@A@@BABA@AB@@A@CDAB@@CAA@@AA@@@AAA@@@@A@CA@A@@@@AA@@@@@@@AB@@@AA@@@@AAB@@A@@AA@ADAB@@AAA@AA@@AACAA@@C@@AA@@A@A@AA@@@AC@@@@A@@A@@BCB@@A@A@@@AAA@A@AA@A@@AA@AA@@@A@C@@@@BA@@B@BA@@B@@@B@@@B@B@@@BA@@@A@@@AD@@C@@@@DB@BDA@@B@@AA@@CBA@@@A@@@@@@@AB@@AB@@@@@B@@A@@D@BA@@@CBAB@@@@BB@@@@A@@@@@@BB@@@BBDD@@BB@@BB@@@@@B@@B@@BBB@B@BA@@@BB@D@@BB@@BBAB@B@@@@@BA@@B@@@BB@B@@@@@D@@@BB@@BB@@@BB@B@@@@@BB@@@@BB@BB@ABB@@@BBB@@BBB@@@B@@B@@@B@@@A@@@@B@B@B@@B@BBB@@@B@@@@@@@@B@BB@@@BB@@@D@@BBA@BBAB@BBB@@@@@BA@@@@@BB@@BB@@@BBH@B@@@@@BCBA@@B@@@B@B@B@@@@@@CB@BBD@BB@BB@@@@@@@DBBA@@@@BB@@@@@@@B@AB@BA@@@A@@B@@@@@B@@@BA@A@@@A@@@AAA@@@@@A@@B@@AAA@@@AB@@A@A@@B@@A@@A@@C@@@A@@A@A@A@@AA@@@A@@@@@@@@A@AB@BA@@@A@@@B@@BA@A@@@C@A@@AA@@@CA@@A@AAAB@@@@AB@@CAA@A@ABA@A@BB@@@BA@B@@@AB@BA@@BBB@@B@@@@@@AB@@@@BBB@BABA@@B@@BB@B@@A@@B@@@@@@@BA@AB@@@@@B@@@BB@@@B@B@@@@@B@@B@@B@@@B@@@B@@@@@D@@BB@@@B@@@@B@@@@@@@B@@@BB@@B@B@@@@@B@@B@@@@@@@BB@@C@@@AB@@@@C@@B@@@BA@@@@B@@@BB@@@@B@@@B@B@@@@BBABB@AB@@@@A@@@C@A@A@@@A@A@A@A@A@AA@@AAAAA@AA@@AA@@A@CA@@A@ACA@AA@AA@@AA@AAA@@BAAAB@@A@@@A@A@@@A@@BA@@@A@@B@@AB@@BD@B@@ABB@@B@@@B@@BB@@BB@@@B@BB@@B@@A@@BBBBB@@A@@@@B@@@DB@@@BB@@@@A@@@AH@@@@@BA@@B@BBBBBDB@@@@@B@B@@@B@B@@@@@@@@@B@@DB@BA@A@@@A@@A@@A@@B@@A@@@@@@AA@@@@@C@@@AA@@A@A@C@C@@AG@@BA@@@B@BBB@@BB@@@@@BBA@@@@@B@@@@@@@@@BBA@@@@BA@@@@@@@B@@@@@@@@@BA@@@@@BB@@@@@AAB@@AAA@@@@BA@@@B@@@@B@BA@@@@@B@B@@@BBBBD@B@@@B@B@@BA@@@@@@@@@@@AA@@A@@@@@@@AAA@A@@B@@A@@@@D@@@B@@@@D@@@B@@@B@@@B@@@A@@@A@A@@BAB@@@@B@@AB@@@BBA@@@AB@@B@@@B@@@@@B@@@@B@@B@@@B@@AB@@B@AB@@C@@@C@@@ABAAABA@@A@@@@AA@@@@@@A@@AA@@@@@A@AB@AA@@@AB@@ABA@@@@@@BBAB@@@@B@@@@A@@@@@B@@B@@@@ABBB@@BB@@@@@B@B@@@BAB@@BB@@BB@@@@@B@@AB@@@B@B@@CB@@A@@BA@@B@@A@@@ABA@A@@@A@@@@@@BAB@@@@@BABA@@@@BC@A@BF@B@@AA@@A@@@@B@@A@@B@@@@BBB@BB@@A@@@AB@@A@@DA@AAABABABAD@@A@@AA@@@@@AE@AA@A@@@A@@@A@@B@@@B@@B@AB@@@AA@@@@@A@@@@@@@@A@@@@@@AA@@@@@B@@A@@@@A@@@@A@@@@BAB@@@@@B@@@BA@A@@@@@A@A@A@@@@B@BAB@BAB@@@@B@DDB@BBB@@@@@@B@@@@A@@BCBA@@@A@CDA@@B@@EDABA@E@G@@@@B@@BBAB@@C@CB@AAA@@AACA@@A@A@@@AA@AAA@AAAAA@@A@AA@@A@@@@@CBABA@@BAB@@ABABA@A@ABA@@@CB@@A@IBEB@BA@AB@@A@@DA@@BC@@@A@@@A@@@@@AB@@ABA@AB@@@@A@ABA@@@@@A@A@@B@@@@BBBD@BBB@B@@@BB@@B@@@B@@BBB@BBB@@BB@B@BB@BBB@B@@@B@BB@@@B@B@B@BB@@B@BB@@B@@@B@@AB@BBBBB@@B@B@B@BAB@@@B@DA@@B@B@@@@@@BB@@@B@BAB@@@BAA@BABB@@B@@@BA@@AC@@BAAA@@@@B@D@@@B@@A@@@A@@BA@@@A@@@@A@@A@@@@@@BA@@B@@@@DB@@@B@@B@@B@@@BB@@@ABBB@B@@@BAB@@@B@@@B@@@@BAB@@@B@@@@B@BB@BBBBB@@B@B@D@@@BB@@@@@@B@B@D@@@@BB@@@@@B@B@@@@B@@@BB@@BB@BB@@B@@@B@B@@@B@B@@DF@B@@@BA@CDABA@@@A@@BB@@BB@B@@BBB@B@@@B@@@BBB@@@BBB@@@BA@@B@B@B@@BBABB@@B@@ABAB@BAB@@@@@BBD@@@B@BB@@@@@@BA@A@@@AB@@@BBB@@@@@B@D@BA@ABA@@@A@@B@@@BAB@@@@A@BB@BA@AB@@@BABA@@D@@@B@@@B@BAB@@A@@@@D@@@B@@@B@@@@@@@B@@@B@@AD@B@BA@ABAB@B@B@B@B@B@@BB@@@@BB@@@@D@B@F@@@BA@@D@BB@@@@@@BA@@@@B@B@B@B@BBF@@B@@@B@@D@BBB@B@B@@@B@@@BB@@BAB@BC@AB@@@AA@@CAAA@@@@B@BAB@@@@@AABABAD@B@@@BBB@@AB@BA@@BBB@@@B@@@@@B@@AB@BADA@@BA@@@A@@BBB@@@AB@@@@B@@BB@@BB@B@B@B@B@B@B@B@D@B@@@@@BB@@B@B@@B@@B@@B@@B@@@B@B@@@B@@@D@@@B@B@@B@@@@BB@@B@@AB@@@@AB@@A@@B@B@@@@AA@@@@ABA@A@@@A@A@A@A@@@@@AAAAA@@@@@@AAC@@@@A@@@AB@@A@AAA@@@ABAA@BABAB@@@@A@AB@@@BA@@A@@@BA@A@@@@@C@AA@@@@A@@A@AA@@@A@@A@@@A@A@@AAA@@A@A@@@@AA@@@AA@@@ABA@@@@B@B@@AB@@@B@B@B@B@@@@@@AB@@@B@B@@@@AB@B@BAB@B@@@BA@@B@@@B@B@@B@@BAD@@AB@@@B@BABC@@BA@@@A@@@@B@BA@@B@@B@@B@@BB@@@B@BAB@@@B@@@@ADBB@@@B@@@@@@A@@@@B@@@B@@BB@@@B@DB@BB@DAB@B@@BBB@@BB@@DBB@@@BBDA@@B@B@@A@AB@B@BAB@@AB@@AB@B@@AB@@@BA@@@@@C@@BA@@B@@@BA@@BA@@BA@AB@BB@@BAB@@@B@BABB@A@@BBB@@@@@D@@@B@B@@@B@@AB@B@@B@@@B@@A@@@@BB@@@@@BAB@@A@@@@B@@ABABA@@@A@@@@BA@@@@BA@@@A@AB@BA@@B@@@BA@@@@BABA@@@@B@BAB@@C@@AA@@@AAA@@@@@A@@B@B@BAB@@@BA@@D@B@@B@@B@@@B@@@@@@@B@@AB@B@B@@@@@BA@AB@@@@A@@@@@@A@A@C@@@@A@AA@AAA@@@@A@ABA@@@A@A@@AA@@@A@ABA@@@A@@@@BAB@B@B@@@B@BABBB@B@@@B@@@B@B@@@B@@AD@@AB@@@B@@@B@D@B@B@@@B@BAB@@@@BB@@@BBBBB@D@@@BABADA@@BAB@B@@@B@@BB@@@@B@@@B@@D@@@@@BB@BB@@@DBBA@@@@@@B@@A@@B@@@@A@@@@BA@@@A@@@AB@@ABAB@B@B@B@BAD@@@@@@AA@@@B@BA@@BA@@@ABA@@A@@AB@@@B@@@@ABA@@B@@@B@@AB@B@BB@@B@@@@B@@B@BDBB@@B@@BB@@BB@B@@@AA@@@@BA@A@@B@@@DABA@@A@@A@CAA@@BA@AB@@A@@@A@@@A@@@A@ABAB@@@BBB@DC@AB@@@B@@B@@DA@A@ABA@@BBB@@BBB@@B@BAB@BBB@@B@D@@BB@B@@@@@B@@B@B@@B@@@B@@B@@@BB@@D@@@@@BB@@B@BBB@@@@B@B@@@B@@@@D@B@@@D@@@B@B@B@BA@@B@B@@@B@@@B@D@@@BA@@@@BB@@BB@@B@BA@@BAB@@AD@@A@@BAA@B@BAB@@A@A@AB@@@B@B@BAB@@@B@B@B@B@B@@@B@B@B@B@B@@@@BBBBBBAFB@A@A@@@@B@B@@@BAB@@@B@BA@@D@B@@@B@@A@A@A@A@AAAA@A@A@@A@@@@@A@A@@BAB@DBFBD@D@F@F@FDD@@@F@B@D@DB@@@@@B@@ABAB@@AD@@@@BBB@B@@BBBBBBB@FBBAD@@ABADABCDA@@DCF@@AB@B@DBD@B@B@D@F@BADBB@B@BBB@BDB@BBBBB@BBB@B@B@D@BBD@BBH@D@@BB@@B@B@HCBA@BB@@D@@H@D@F@F@D@J@DA@@@@@A@@B@@@@@D@@@BAA@AA@@@@AA@@AA@A@@@BAA@A@@B@@@@@@@A@@A@A@@B@A@@@@A@C@@B@BAB@B@@@@@B@@@@A@@B@@@@A@@@@@@@@@@@B@@@@B@B@@B@A@@@@@@@@BA@@B@@BA@@@@B@@@@@@@@@BB@@@@A@@@@B@@@@@@@@@@A@A@@@@@@@@@@DA@@@@BAA@@@@@A@@@@BA@@@A@@@@@@A@A@@B@@A@@@@DA@@@@@@B@@@@@B@@@@A@@B@BA@@B@@@BA@@@@BB@@@B@@BB@@BB@@@@@@@B@@@@@@@@@B@@A@A@@@@B@@@@@@@@B@@@@@B@@@@@@B@@@@@@@@B@AAB@@@A@B@B@@@@@@B@@@@B@@@@@@@@@@BB@@@@@@@@@@A@A@@@@@AA@B@@A@BBA@@FB@AB@@AJD@@AB@@B@B@@@@@B@@@@@BB@@@@@@B@@@@@@A@@B@DB@@@A@@@ABB@@HB@@AB@@@@@@A@@@@@@@@BF@@AB@@@@@B@@@BEBBBCA@@A@@@@@A@@@@A@@@@@@C@A@A@C@@FE@AB@DBHBHB@@@@@@BB@@@@@B@@@@@@@B@@@@DBB@@A@@BA@B@@@@@@@B@@@@BB@@@ABAHBBBB@@@@B@@FBB@@@DB@@@BA@EFCD@@@@@BB@B@@@@@BB@@@@BA@A@@B@@A@@B@@BBC@@BA@@B@@A@@@B@@@@@@@@@BA@@@@@@@@@@@B@BB@@@B@@@@@@@@@@@@@B@AAB@@@@@@@@AB@AA@@@@BA@@@@@@A@@@@@@A@@@@BB@@@AB@B@@@@@BCDCB@@A@BB@@BBB@B@@@DC@@DB@@@@@@DD@@BA@@@@DBAB@A@B@@@@A@@@@@AB@A@@AB@@A@B@@BB@@@@B@@@@@@AB@@@@BB@@@@BB@@@@@@@@BB@@@@@@@B@@B@@@B@@@DBB@DAB@BA@@@@@@B@@B@@BFBDDF@DBB@BBABC@A@A@AB@BG@A@@BBD@DBDB@B@B@@B@BCBBB@P@D@H@@@@@@B@@@@B@@@@@B@@@@@ABAD@@@BB@@BB@@@@A@@BAB@@@@A@@@@B@B@@@B@@@@A@@@@BAFAAA@@@@BA@@@@@A@@@@DAB@B@@B@@D@B@H@@@@@B@DBD@@@@@@AB@@A@@D@F@D@@BBA@BB@@@B@@BB@@@B@@@@@B@D@@@@@D@@AB@@@B@B@BA@@B@B@B@@@@B@B@@@@@BB@B@BB@@DAD@B@@@@B@B@@@B@B@B@@B@B@@@BB@@DA@@@BB@B@@@@B@@@@@@B@@B@@B@@@B@@B@@@BD@B@@B@B@@@BD@D@B@BBD@B@@A@@B@B@B@B@@@@@@BB@B@F@D@@A@@BA@A@@@C@@B@B@@A@ABA@A@GA@A@@A@@BC@@BB@A@@B@@A@@@ABC@@BB@A@AA@BA@@@A@@@@@@@A@A@@@@B@@A@A@A@@@@@@@@@AB@@@@A@@@@@@@AA@@@@@@AB@A@B@@A@@@@@AA@@@@@@AB@@@@@A@@AB@@A@@@@@A@@@@@@@@@A@@@A@A@@@@A@A@@@BA@@A@@@@A@@@@@@@A@@@AB@@A@A@@B@@@BC@@@@A@@AA@@@@A@@@A@@@@B@@@@@B@@@@@B@@AB@@B@@@@@B@@@@@@B@@@B@@A@@FF@@B@B@B@@@BABAB@AABA@A@@@A@A@@@@@@B@B@@@@@@@@@@A@@@@@A@@@@@@A@B@A@@@BB@@A@@@@@@A@@@@@@A@@@@A@BA@@B@@A@A@AA@C@A@AB@@A@@@@@@A@@AA@@@B@@A@@@@@@@@A@BC@@@C@@@C@AB@B@@@@@@@@AB@@GA@@@B@@A@@@@@A@@@@A@BA@@B@@@@A@@@A@@@@@@@@A@@AA@@@@A@C@@@A@@@@BC@@@@A@@@@@A@A@@@AAA@@@BC@@A@@@@@@AB@@AA@@@@@@@A@@@@@A@@@@@@@A@@@@@@AAAA@@@@@BABA@A@@@@@A@@@@@A@A@@@@B@@AB@@@@@B@A@@@@A@@@@@@@@A@@A@@@AA@@CA@@B@@@@@AA@A@A@@@@A@A@@A@@@A@@A@@@AC@@@AA@@AA@@@A@@C@@AB@@@@A@@@C@@A@AA@@A@@@A@@A@@A@@@@A@@AA@@@@A@@A@@A@A@ABAD@@@@AABA@@AAAAA@@@AA@@@@AA@@@@@@@A@AB@@@@@@@A@@@@CB@BBB@@A@@@@BBB@D@@BB@@@B@@B@ABABCB@@A@@@@BEA@@AB@@AA@@@@@@@@AB@@A@A@@@A@@@@@@@A@A@@@@@@@AB@@AB@@AB@B@BA@B@@@@@B@@B@@@B@@@BA@@@@D@BADAB@B@D@@A@@BA@B@@@@@@@@@@B@@@@B@@@@@B@BB@@@@A@AFB@C@A@@@@@@@@@@@@@@@@@A@@DA@@@@@@@AB@@@@@@@@@@AB@@@@@@@@@@@@@@A@B@@@A@@A@@@@A@@B@A@ECA@AEIECFA@@A@@@@@@@A@AA@@A@AC@@B@@@@AAAA@@@C@@AA@A@@A@C@A@C@A@@@A@CAA@C@GC@@@@A@@@A@@BCCA@@@EC@@@A@A@A@@@@@ACCACAA@A@@AC@@AE@AAAAE@A@@@A@@@A@A@E@A@CD@@F@D@DJ@@@@G@EAA@G@CA@ABB@CFADA@BCC@A@A@@D@B@@G@IBC@A@@@CBE@A@A@@@@B@@@BB@@@DDB@@@B@@@BB@@@B@@@@@@@@DD@BD@@BA@@B@@@@ABAD@DAB@B@@@@AB@@@ACAA@CACAIE@@BABC@@@@AAA@A@@A@@A@AB@@@B@@ABAB@B@@@@@@A@@@@BABAB@@@B@@B@BBDB@@@B@@@BAA@@@@A@A@C@A@A@@@A@@@AAADA@ABAAG@AAADABABAB@AEAC@AAA@AB@@@@@@A@@B@@BBLFDBB@BDDD@@@B@@@BAB@B@BAD@@@@@@BBF@BB@@@@AD@B@@A@@B@@@@@@BB@@@@@D@BC@@@@@A@AA@BA@@A@@AB@@@@@@BB@B@B@@ABCB@@AB@BA@CA@@AB@@AB@@BD@@BBDDDHDD@@@B@@A@AB@BAA@@@@CB@BA@ABAB@AA@@@@@A@@@CBAB@AAAAAAA@@E@@AA@@AA@@AA@@@AA@@C@AA@@@@AAAA@@@A@@AA@@A@CA@@@AC@@@A@ABA@B@@BB@BB@@B@B@@DB@@A@@BBB@@@@@@@BB@@B@@@@B@@B@@B@@BD@@AB@AA@@@C@CBA@@@A@@@A@@@AB@@A@A@@AAB@BCB@@CB@@@@D@@B@@@@@@@@A@@@@B@@A@@@@@A@@@A@@BA@@@@@@@@@@B@@@@ABBB@BAD@@@@A@BCC@BAE@@BAA@@AAA@BABC@A@@BA@@@@AAAAAAAAAAAEAA@A@@@@AA@A@@A@A@AACCAAAAACCE@@@@A@@B@DABA@EA@@@A@@B@B@@@@@B@AA@@@@@AAB@A@@@@A@@@AAAB@@A@A@A@@@A@ABC@A@@@BDA@BBA@@@A@@@AB@AC@@@C@@D@D@B@@@@A@A@A@A@@@AA@@@@@AA@@@AA@@@A@AEBA@E@@D@B@BE@@@A@@@A@@@A@@B@@@@A@@@A@@B@@@@@@A@A@@A@A@AAC@GBA@ABK@A@@@@@A@A@@@@@A@@@@@@@@@A@@@@@@B@@A@@@@@@@A@@@@@@@@@A@@@@B@@A@@@@@@@A@@B@@@@@BC@ABG@CDI@CA@C@C@A@@BC@@@@B@@A@@@AA@@AAA@AA@AAC@@@@@@@@A@@B@@@BB@@@@@@B@@A@@@A@@A@@@@@AA@@@AB@@@@@A@@E@@@A@@@@@A@@@A@@@@B@@A@A@@@@B@@C@@@@@AB@@AB@@@B@@@@@BA@@@@@A@@@@A@@@@A@@CA@@BC@@@@@@@C@@@EA@@BABA@@A@@A@@@@@@A@@AA@@@@A@@CA@@@@@A@@@@AB@@@@CAAB@@A@@@A@AA@@@CC@@@A@@BAB@BA@AB@@@BB@@D@@@@C@A@@@@FBBA@@@A@@B@@@@AB@@A@@BC@BDBB@@A@A@CB@@@@@B@@A@A@A@@@A@BDA@@@@@A@@B@@@@@B@B@@A@A@A@@@@A@A@@AA@@@@ABA@A@@@@@@A@@AAABAAA@@@C@A@@@@@@@A@@AE@A@@@@B@BAD@@A@A@@@@A@@@AC@BAAA@@C@@@@B@@@B@@A@@AA@@@A@C@@@A@@@@@A@A@A@ABCC@@@@@@C@A@@@AA@@AAA@@@A@@@@@@@BABC@@@@@C@@@C@CBA@A@@@C@A@AA@@@EAE@A@A@@@@C@A@@@@@@D@@@DB@@AC@@BA@B@A@@@@@A@@@@B@B@@CA@@AA@@@@AB@@AB@@@A@B@@@@@@@@A@@@@@A@@@A@C@AA@A@A@@@@@@A@CB@@AB@@@B@@@BA@@B@@@@@@@B@@@@A@@@@@AA@@@@@BA@@AA@@@A@@BAB@@ABABA@@@ABA@@@@B@DBFB@@DBDBD@@@D@DAD@BADEB@B@BAB@BAEEEG@ABB@@@@@@@@BAB@B@@AB@B@@B@@BA@@AA@AAAAAAAAAAEAA@AAC@@@A@@BA@@@@B@@AA@@@B@B@B@@B@@BA@@B@@@B@B@@@@A@@B@B@@@@@@A@A@A@@C@@A@@@@@ABCA@@@AA@@AABA@@B@@B@AA@@A@@@A@@@A@@@A@@@A@@B@@@@@@@B@@@@A@@B@@@@A@@A@@A@@@@BA@@@AC@@@CA@ABA@A@@@AA@BAA@@@@@@@@A@@@@A@A@@@AA@@@AA@AA@@@AA@@@A@@BC@@@@A@@CAA@@@@@@A@@B@@@@@@A@@B@B@@@@@AAA@@A@@C@@B@@@@A@@@A@@A@@@@B@@A@@@@AA@@BA@@A@@A@@@@C@A@@AA@@@CAA@@AC@@@@@AB@@AAA@AA@@@@@AA@A@@BAAA@A@@AA@A@@@A@A@@@@A@@@@AAA@@@@A@@@@@ABA@@@AB@@A@A@@B@@@@A@@CCA@@BABA@@@ACA@@B@@@B@@C@@B@@AD@@A@A@A@@@@A@AB@@@@@BA@A@@@ABAB@@@B@@AB@@@FA@@@B@@B@B@@@@A@@AC@@ABA@@A@@A@A@@AB@A@@@@A@@@@@@ABA@@A@@A@A@@@@@@A@@@@BA@@@@A@@@C@C@@@@@@A@AB@BC@@@A@@@A@AB@AA@@B@@ABAB@@A@A@A@@AAA@A@AAA@@ACA@@A@@AA@@A@@@AA@@@A@@AACAA@@B@AAA@A@EA@@@A@@"],encodeOffsets:[[122873,30906]]}},{type:"Feature",id:"330127",properties:{name:"淳安县",cp:[119.042037,29.608886],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@ABAA@@@@A@A@A@@@AAA@A@AA@A@A@A@@@A@@@A@A@A@A@A@@@A@@A@@@A@A@@@A@AAA@@A@@A@@A@BAA@A@AA@@@@@@AA@AA@AA@@@A@@@@A@@@ABABABABA@ABA@A@A@CDA@ABABA@@@A@ABA@@@ABA@AB@BA@@@@@AB@@@BA@@@@B@@A@@AA@@BA@@@AB@@C@@@@@AA@@A@A@AAA@A@A@@ACB@@A@A@A@A@@@A@AAA@AAA@A@@@AAA@C@A@@AA@@BA@AAA@AAC@@@C@@@A@@@A@AA@@E@@AA@@@@@A@A@CAA@A@@@A@@@@@AAA@CBA@A@A@A@@@ABBB@@@@@BA@@@AB@@@@@BABB@@A@@BB@@@BB@@@BB@@@@BBB@@BB@@B@@@B@@@@@B@B@B@@BB@@@B@BA@@B@@@BA@@B@@@B@B@@@B@@@B@@BB@@AB@@A@@BA@@@A@C@A@@AA@@@@@A@A@@AA@@@A@@@ABAD@B@@ABAB@@@B@@@BABA@@@@B@@@B@@@@AB@@ABAB@B@@@BAB@@A@AD@BA@@@AB@@@@@B@@B@@B@@BBB@BBB@@B@@@B@@B@@BB@@BBB@B@@@@@B@B@@@B@@ABAD@@AB@@@B@BAB@@@BAB@B@@@@@BAB@@@B@@AB@BA@@BAB@BB@@BB@BBB@@B@@@D@@@B@@BB@BAB@B@@BB@@B@BB@@@A@@B@BAB@BA@@@@BA@A@A@A@@BA@A@@@A@@BA@@B@BCB@BA@A@@B@BA@@DBB@B@@@DA@@B@BCBABA@@B@BD@@AB@B@@@B@BABA@@@@D@@@BAB@@@BAB@B@BA@@B@@@BABAB@@@@@BA@@BA@@@ABAB@B@@B@@B@A@@B@@@@BA@@@@@C@AB@@@BA@@B@@@B@B@@@@@@CBCBABAFABA@A@@@@@BBBB@DBB@B@@@B@@@@@B@@@B@@@@@BA@@@@@A@A@AB@BA@ABA@@DABAB@@@@@@A@@@@B@@AA@@AB@BC@A@@BBB@B@B@@@@@BBB@@AB@@@@@B@@@B@@@B@@A@@@A@@BCD@@AB@@C@@@A@A@@BA@@@AA@@@BAA@@AAA@AA@@A@@@@@@BAB@@@@@@B@@@@B@@@@@@@B@@@@@BA@@@@@A@ABCB@@@A@@AA@@@@AA@@ABA@@@@BA@@BA@@BA@@@A@@B@@AB@BA@@@A@ABA@@@@@A@A@@AA@AB@@@@@B@@A@@@@@A@@@@@A@@@@A@@@@A@@@@@@A@@@@A@@@A@@@@@@@A@@@AB@BAB@@A@@@A@@@A@A@@@AAAA@@@A@@@@A@@@@@@@@@@@A@@A@@A@@@E@@@A@@@@@A@@AA@@@ABBBA@B@@BAB@B@@@@BBA@@BA@A@@@AACA@@@BA@A@@A@@ABA@@B@@@B@@AB@@A@@@@@@@A@@@ABA@@@@B@@@B@@@@AB@BABA@A@@@@@A@@@AB@B@@B@@BB@@@@BAB@B@@BBB@@B@@@B@@BDA@@@A@@@A@A@@@A@@@@@@@@B@@B@AB@B@@AB@@AB@@A@@@@AA@AA@@A@@@A@AA@@@@@A@@AB@@AB@@AAC@@@@@BA@@A@@@@@@A@AA@@@@AA@@@@@@BAB@@A@A@A@@BA@@B@@AA@@AA@A@@A@@@C@@@A@@@A@A@@@@@AB@@@@@DABAB@@AD@@@@A@A@@@@@@BA@@@A@A@@@AAAB@@A@@A@AA@@A@@A@@@A@A@@@AA@@AAA@@A@@A@@AA@AA@@@@@@CA@BA@@@A@@@A@AA@@A@@@@@A@AA@@@@AB@B@@@B@A@@ABABA@AB@BAB@B@@ABA@@@@BB@@B@@@@@B@@@@@B@@A@@@ADC@A@@@EBA@@BA@A@A@BB@@@@ABA@AA@@A@AA@@ABA@CB@@ABA@@B@@A@@BA@@@A@@@A@AB@BA@@BA@@@AB@B@@A@@@@@ABA@@BAB@@AB@B@@A@AAA@A@@BABA@@@B@@BBABB@@@@@@B@@@@@A@@@@@@@@@@@@@@@AB@@@@@B@@@@@@@@@@A@@A@@@A@@@@A@@@@@@BAB@@@BB@@B@@@@BB@@A@@@@@A@@A@A@@@@@CB@A@@@A@@B@@@@@B@@@BB@@B@B@@BB@@@@@BB@@@@B@@@@AB@@@DA@@@A@@@A@@@AA@@@@C@A@A@ABAB@@@B@@B@A@@BA@@@@@@BB@@@@B@@B@@B@@@BB@@@@B@BD@@@@B@@@@@@@@@@BD@BB@@@B@@B@@@@@@B@@@@B@@@@@B@@ABAA@BA@ABA@ABABA@A@AB@@A@A@A@A@A@@BA@@AA@@@B@A@@@@@A@@AA@@AAB@AABA@@@@@A@A@A@@BA@@B@@AB@@AB@B@@@@AB@B@@AB@@@BA@@B@@@@@@A@A@A@@@@AABA@C@AB@@@@AD@@AB@@@@@BA@@@ABA@@D@@ABB@AB@B@DA@A@@@A@@@AB@B@BA@@@@BA@@B@@@@C@@@A@@@ABA@@@A@C@@@A@@@A@A@AB@@@@@B@@@B@@@BA@@B@@@B@@AB@@AB@@A@ABA@ABA@@@@B@@@B@@BB@@BB@B@@AB@@AD@B@@@BBB@B@B@@AB@@@@@B@@BABAD@DBF@FBDBBBBBBDBFBBDBDFDDDB@DBBBDDDDBBDB@@BDFBD@BBDB@BAFAFAD@B@B@B@F@H@D@FBD@FBD@F@FAFCDCD@BAB@B@DBD@FBBD@DB@BBBA@AB@BBDBFDBBD@BCDAFCDC@@BAD@FBF@FBDBFBDBDBBBDBFBFDDBB@FBD@@D@@@B@B@B@F@HADBD@BBDBH@D@B@BADADAD@D@DBB@BBDBDBB@F@HBD@HBDBBD@DBFBDBFDDDDBFBDBD@HBHDHB@BBDDDBDBDDJBBDDFHDFDAB@DBDDFJDBDFFDBB@@@B@F@DBDDDFBDBH@JBHDF@DBDBF@F@B@VLBBFBDDB@B@@@HCFAFAFADCDCDCDAB@B@D@DBDDDB@BFDDHBDFDNNHDDDBBBB@D@FBBDDHFBB@B@D@DBDBBBDFBDBDB@BBF@BDDDDDDDDD@D@\\FDBFHBD@D@B@BBD@DDDDBDDFBHJDDFBB@BBBDBH@DBDBD@DBH@D@D@BADCFAB@DBBBDAD@BADAFEDEFCD@D@BBR@F@@@FBHBJBDDBDDB@D@H@HAD@D@DBBB@@@B@@BBBBBBB@@B@@@BABCBAB@@A@@BA@GBA@@@C@A@AB@@ABBB@BDD@BBB@D@B@F@@@DB@DBDBF@BBBBB@B@B@BA@@DAHCBADABAB@B@D@DBB@DDBBBF@DBBDBFFDBDDDBDBDDBDB@@DDBJFDDDBDBJHBBFD@@FBDDDDHFFDBDBBBB@FBB@D@D@F@F@B@F@FADAF@FAJCD@@CH@D@BBBDDFBD@D@D@B@@AFAHAFAB@D@B@BBDDBD@DDD@@BBB@@@B@@@B@DADADCFGB@B@D@@@D@B@B@@A@@D@B@B@@BAD@DAFAD@B@B@DBD@@@HCDBHBLBDBBBHDBBFBDABCHGHAD@DBBBD@BB@@B@B@B@DAD@BCDADA@@@@B@BDBBBDBDDBCB@B@@@BAB@@@DAB@@@B@B@BB@AB@@@@@@BB@BA@@@@B@@AB@B@@BBABBB@B@BAB@B@@AB@@@@AB@B@@@B@B@B@BB@@B@@@@@B@@A@@BA@@@@B@BA@@@BB@@B@@B@DB@@DAB@@@B@@@B@@BB@@BB@B@@BB@@@@@BB@@BB@@BB@@@BB@D@@@B@BB@@@@B@BB@@@@@B@@@BD@@BB@@@@@B@B@@@@BB@@@B@@@@@BBB@BA@@@@B@BA@@B@@BB@B@@B@BB@@@@B@BB@DB@@B@B@B@BBBB@BB@@BBBB@@B@@BB@@B@B@BA@@@AB@@AB@@A@A@A@A@A@A@A@@@@@A@A@@BC@A@A@@AA@@@A@@B@@AB@@@@@BBB@@@@@BBB@@@@@B@@@@BB@@@@@@@B@@@@BBAB@@@B@DDBBB@F@@@BDBB@@@@@B@@DABBBBB@@B@@BBBB@@BD@B@@B@@BB@@AB@B@B@@A@@BAB@B@@@BB@@@@B@@@BB@@@BB@B@@@B@@BDAB@@@@@B@@@@A@@@@@A@@@@@A@@AA@@@@@@AA@@@@@@AA@B@@AA@@@@BA@@@@@AAA@@@A@@A@@A@@AAA@@@@A@@AA@@BA@@@@@A@@AAB@AA@A@CB@@@B@BBBAD@B@@@B@F@@A@@@@B@@AB@@@B@@@B@B@@A@@@@B@@A@@@A@@@A@@@ABAB@BB@AB@@A@@@AB@@CB@@A@@@A@@@A@AB@@A@@B@@A@@BD@@@@@B@@@BB@BB@B@@BB@@@B@@B@@BB@B@@B@@@B@@@@@B@@@@BB@@@@@@@B@@@@BB@@@@B@@A@@B@@A@@B@@@@@B@@AB@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@BB@BA@@B@@@@@@@B@B@@@B@@@B@BB@@B@B@@@B@B@@@@@D@@@@@B@@BB@B@@BB@@@@BBB@@@@@BBA@@@@BBB@@@BB@ABB@@B@BB@@@B@B@@B@@@BBB@@BB@@B@AB@BA@AB@@A@@B@@@@@BA@@@@@@@@BA@@BBBB@@B@B@@@D@@@BAB@@@@A@@A@@A@@BA@A@@BA@BB@B@BA@B@@B@B@@@BBB@@B@C@@@@@A@A@@BA@@@@BAA@@@AA@AC@AAB@AA@AAAAA@@@A@@@A@@AA@@@@@@@@BC@@@@BA@A@@@A@AB@@A@@@A@AB@@A@@B@@@@AB@@@AA@@BADA@A@@AA@@BABA@@B@@@@A@@@A@@@@@@BBB@@@B@DD@@B@B@B@B@@BB@@B@@BAB@BAB@@@B@@@BBB@@@@@BA@A@@B@@A@AB@@A@AB@B@@@@BA@@@@BAB@@@@B@@B@@@@B@@@B@BAB@@A@@@@@@@@@@@@BA@@A@BA@@@@@@@AA@@@BA@@B@@@B@B@@@@@@@@A@A@A@@B@@AB@@@B@B@BA@@BB@@@@BBB@DA@B@@@@B@@@@A@@@CBB@BBAB@@AA@@AB@BABB@AB@D@@@BAD@@AB@@AB@@@B@B@BBB@@BB@B@D@B@B@@@B@@@B@B@B@@@B@B@@@DAB@B@@@B@@@BA@@B@B@BA@@B@B@@@BA@@B@BB@@B@@@B@@AB@@@@@B@@@@@BA@@@A@@BA@@B@BA@@BA@AA@@@AA@A@@@@@AA@@AAA@A@@ABC@C@@@A@A@@B@@A@@B@@A@@BA@A@@@A@@@A@A@@AA@@BAAA@A@AA@@A@A@AAA@@@@AA@@AA@A@@@@A@A@@@@@A@A@@@AA@@A@@@@@@B@@A@@@@BA@@DABAB@@@@@@A@@@A@@B@@@B@@@BB@@@@B@B@B@@@DABA@@DA@BB@BB@BBA@@B@@@@@B@BB@BB@@@B@@@BABAB@B@@@D@@@B@@@B@@@B@B@@@B@@@B@B@B@B@@@B@BC@@AA@AAA@@@@@C@@@@A@@A@@@A@A@@@@A@A@@A@@@A@@@@@@@AB@B@@@@AB@B@@A@@BA@@BAB@BABA@@BA@@B@B@@@AA@@@A@@AA@@AA@@@@@A@@@A@@BA@A@@@@@@@A@@BA@@BA@AB@@@@AC@@A@@AA@@@@AA@@@@A@@A@@@@CA@@@AA@@AA@@@A@@@@A@@A@@@A@A@@BA@@@AA@@A@AA@@@@@@A@ABA@A@A@@BA@@@@@@@AA@@@@A@@@@AE@@@@A@@@A@AA@@@@@@A@@@AA@A@@@@@AA@@@A@@@A@AA@@@A@@@@AA@AA@@@@@A@@AA@@@@@C@@A@@@@A@@A@@A@@@A@@@A@A@@@@@A@A@@@@@A@@@AA@@A@@@A@@@A@AA@@@A@@AA@C@@A@AA@AA@A@AA@@AAA@@AABAA@@A@AA@@A@@B@@A@@BA@@B@BA@@AA@@@ADA@@BA@@B@@A@@B@BABABABA@@@C@AB@BC@@@A@@BAD@BA@@D@B@@AB@BA@@B@@@DA@@BB@@@B@@B@BBB@F@@@B@@@DBBB@@@@@@BB@@B@@@B@BB@@@@@ABA@A@@BABA@@@BB@@B@@B@@@@B@@B@B@@@@@@@@A@@BA@@@AB@@A@@FCBAB@@@B@BD@AB@D@@@BA@A@@BAB@@AB@@@BAAA@@BA@A@@@@@AB@B@@A@@@@@@B@@@@@B@@@@A@@@@BB@@@@BAB@@@B@B@@@@@B@D@BA@@@@@BBB@@@BB@@B@@BBBB@@BB@@@BB@@BBBB@@B@@BB@@@BB@BB@@@B@@BB@@BCD@DCB@@@@@BC@A@@@@A@@@@A@A@A@@BA@A@ABA@@A@@@@@@@@A@ABA@@AABA@CB@@ADA@@@@BA@@AA@A@@@A@@@@@AB@@@@AAE@@AA@@AAAA@@@@CCAC@@@@A@@AAA@A@@@A@@@AB@@@@A@@@@BA@@B@@@@A@@@AB@AAB@@@B@BAB@B@B@@@@@B@@@B@@@@@@AB@@A@@@@B@@AB@BA@ABA@A@@@AB@B@@AB@@@B@@@@@BAB@@@@@BA@AB@@@@@@A@A@A@A@C@@@@@AA@@@@CBA@A@A@@AA@@@A@A@@@A@@A@AABA@@@@BCAA@@@A@@@@AA@@@@@@@A@@@A@@@A@@@A@@@A@@BAAA@@AA@@BA@A@@@A@@@AAA@@@A@@AA@@A@@AA@@A@C@@@@@@@C@@@A@@AA@@B@@A@@@A@A@@B@@AB@@@@@@A@@B@B@@@B@@@B@@@D@B@@@@@@AB@@@BBB@@@D@B@@@D@@@BBB@@A@@BA@@B@BBB@@@@@BAB@@@@@DBB@@A@@BAB@@@@A@ABAA@@A@@@A@@B@BA@@B@BAB@B@FA@AB@B@B@B@@@B@@@B@B@@@BBD@@@@@B@B@B@@@@BB@@@B@@BB@@@B@@A@@B@@@B@@@DB@@BA@@BA@@B@@@@@AAA@@A@A@AA@AA@AA@@CAAA@A@A@@A@@AA@AC@@AB@AA@@A@A@A@@@A@A@A@A@@A@@@AA@@AA@@A@AA@@AA@@A@@C@AA@A@@@AAAAA@@A@@A@@A@BC@A@ABA@@A@@E@A@AAAAA@@@A@@CA@C@@AA@@AC@@AA@AA@@BAAA@A@CC@@@A@@B@@A@@AA@@AA@A@@@@AA@A@@BA@@AA@A@ABA@AAAAC@@C@@A@@A@@@@@AA@BA@C@A@CBA@A@@@@@@@A@A@ACA@@AA@@A@@A@C@@@AAAA@@CA@@A@A@AA@@AA@@@@@A@A@C@A@@@@AA@@@@@@A@@@A@AAA@@@AA@@@@A@A@AA@@CA@@@AA@CAAA@@@@@@A@A@A@A@@@A@AAA@@@AA@@@A@@AA@@@AAA@AAA@@@AAA@@BC@@@A@@@@AA@@A@@@A@@AA@@@@A@AB@B@@@@A@@@A@@BA@A@@AA@AA@@AA@AA@@BCAAAA@@BAA@@A@@BAA@@ABA@@AA@@@A@AA@@AA@@@@A@A@@A@@A@AA@A@@@A@@A@AAA@AC@@AA@@@AA@@A@@@@A@@@@BABBBA@@B@BA@@@AD@@CB@@AB@@AB@@@AA@@@A@@@A@@@A@@@AA@BA@@BAB@@AB@B@B@B@@@B@BA@@@ABA@@@@@BDB@@BBB@@@@@@@B@B@B@BA@@@@B@@@B@B@BAB@B@@@B@@BB@@@@BB@BBB@@@D@@@B@@BB@BB@@BB@@BBBB@@@@BAB@@@A@@AB@B@@@B@@@@@@BB@@@@@BA@C@AB@@A@@A@@@@A@@A@@@A@A@@@A@@@@@A@A@@@AAA@@@AA@A@@@@A@@A@@A@@@A@@AA@@A@@A@C@A@A@AA@A@@@AB@@A@@@CA@@A@@BA@A@@@@BA@ABA@@@AAA@@@A@@@A@@@@AA@@@@@@AB@AC@@@@@AB@@@AC@@@AA@@@B@@@@AA@@AAAA@A@@A@@AA@@@A@AAAA@@AB@@A@AACBA@@@ACACA@AAAA@@AA@@AAA@@AA@@@AA@@AA@@@@@@AACA@A@@A@@A@A@@@@AA@@A@@@B@BA@@@@@A@@AA@@A@@AA@@@A@@AA@@@ABABA@@B@@ABABABC@ABA@@@AB@@BD@@@BA@@B@@AB@BAD@@@B@@AA@@@@A@AA@@A@@A@@A@@A@@A@A@@@AAC@@@AAAAA@@@CC@@BABA@@BA@@BA@@A@@@@A@@@A@AB@@@@ABAA@B@@A@A@A@A@A@@HGAAC@@AA@@AA@AAAAAA@AAA@A@AAA@@AA@@AA@@A@AAA@@A@@AA@@@@BC@@@@AA@@A@@A@@@A@@@A@@@A@A@AAAB@BA@@@A@A@@@@@A@A@C@A@@@A@A@@@A@@AA@@@A@@@A@A@@BA@@@@@@@@AA@@@AA@@A@@@A@@@@A@A@@@AAA@AA@@@@@AA@AA@@AAA@@@AA@@A@@AA@@A@@A@A@C@ABA@@BA@@AA@A@@AE@CA@@C@@BAAA@C@AAAA@@A@@AA@A@@@A@A@A@AAA@@@E@A@@A@@AA@BC@AAA@@@AB@B@@A@AB@@AAA@C@@@AAA@@B@@A@@@A@@@@@ABA@A@A@@AA@AA@@@@AAA@@A@@AA@@A@@@@@A@A@A@CB@@C@A@@A@BA@@@@@A@@A@@@A@@AA@@A@AA@@@A@@@A@@A@A@AA@@@A@@A@@AA@@@@AA@@A@@@@AAAA@@AA@A@@@A@A@ABAB@BA@@@C@@@A@@@A@@@AA@@@AA@A@AAAA@CAA@@AC@@@A@C@A@AA@@ABAA@@A@@@AA@@@@C@A@@@A@@@AB@@AAA@A@A@A@A@A@C@@@CA@@A@AAA@AAAA@@AAA@@@@A@@AA@@A@@AA@@A@@AA@A@@@@AA@@@A@@AABA@A@@@@@A@@@AAA@@@@A@AAA@A@@@@A@@@@@@@A@A@@@@A@CCA@A@@AABA@AB@@@@@B@@A@@@@@CA@@AC@AACCAAAAAA@@@@AA@@@AA@AAA@@@A@@@AA@AAC@@@@AA@A@AAA@A@EAA@@@AA@@A@@@A@@@@@A@@@A@@AAAAAA@A@@@AA@@AAA@CB@A@@ABA@A@A@A@G@@@A@AAA@@@A@@@AC@AA@@AA@@@@AA@A@@AA@AC@@@@@AA@@@A@AA@@@@AB@@@BA@@BA@@@AB@BA@@BAA@@@B@@@B@@A@A@@@@@A@@@A@@@CA@@@A@@A@@@A@C@A@A@@@C@@@A@ABA@@@A@@@A@@@@A@@@A@@@A@@@@@@@A@@@A@@AA@@@AAA@@@CAAA@@@A@C@@@AAA@A@@@AA@@@@@@A@AA@@A@C@@@A@@A@A@@A@@@A@@A@@A@AA@@AA@@ABA@@@AA@@CA@@@A@@A@A@@AA@C@C@A@A@@@A@A@A@@@A@@@AC@@CA@@AA@@AAA@@AC@@@A@@@A@@@A@@@A@A@@@A@@@@A@@A@AA@@@@AA@@A@@A@@A@@@A@@@AAA@@@AAA@A@@@A@@@A@@@@@@A@@@@AA@CA@@A@A@@@@@A@@A@A@A@@@@A@BAAAB@A@@A@ABABA@@@@@@BA@@@A@@@A@@@@@A@@B@@A@@@@@@@@@A@AA@@A@A@A@@AA@@@AA@@AA@@A@@@@@@A@AC@@@A@@@@A@@@@@AA@@@@AA@@@@@AA@@@@@A@@A@@@@@AA@@A@@A@@AA@@@AA@@@@A@@AA@A@@@AA@@@@@@A@@@A@@@@@AAA@A@@@@A@@@@@AA@@@@@@A@@@@A@@@A@A@@A@@@A@AAA@A@@@@@A@@@@@A@A@@@A@A@@AA@@AA@@A@@A@@@AC@@@A@@AA@@@@@@A@@@A@@@CC@@A@AAA@A@@@A@@@@@@A@@@AAA@@@A@A@@@A@AAA@@A@@AA@@@A@@AA@@@ABC@ABABA@@@@@A@@AA@A@A@@@A@@A@@@@AA@AA@@@AA@@A@A@@@A@@@AAA@@@AA@@CAACA@@@A@@A@@A@A@@AAA@@AA@@A@AA@@E@@@A@@@@@A@A@AA@@@AA@@@BC@@@A@AACA@@A@@@@A@@@A@@@A@@@@A@A@@@AAA@@C@@A@@B@@AA@AA@@A@A@A@@@A@@AA@@@C@ABA@A@@@@@AAAA@@AA@@A@@@@A@@@C@A@@@AAAAA@A@@@A@@B@BA@A@@B@@AB@BAB@@@@AAABAA@@@@A@A@A@@@@@A@A@@@A@@BABA@@@@A@@@@A@@@A@@@AA@@AAA@@@@@AA@C@@@AAC@@AA@@A@AA@CA@AA@@@A@@@AB@A@BAAA@@@A@@@A@@@A@A@@BCAA@@@A@A@AA@ACACA@A@@A@@@AAC@@@@@"],encodeOffsets:[[121583,29909]]}},{type:"Feature",id:"330122",properties:{name:"桐庐县",cp:[119.691467,29.79299],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@A@BA@@AA@@AEE@@A@@@@@@@BAAA@A@@BA@AA@@@@@CC@AA@DAB@@A@A@@AA@@A@@@A@@AAACAAA@@@A@@AC@@A@@@A@@ABAAA@A@A@A@A@@BA@@@@@ABAA@@@@@B@@@B@B@B@B@B@BA@@BA@A@@BAB@@@BA@@@@@A@A@A@A@@@A@A@A@@@@A@@@AA@@BA@AA@A@@AA@A@C@@@@A@@AA@@@AA@@A@AAA@@BA@@@A@AABAA@@A@@@A@A@A@@@@A@BA@DD@BDD@@@B@BB@@BB@@B@@ABABAB@@CA@AAA@@@A@A@A@A@@ABAD@@@D@@@@CC@AA@@CBG@@@@@@@@A@@@A@@@@B@@AB@@A@@BC@AB@@ABA@@BA@@@A@@@@BAB@@AB@B@@@@A@AA@@ABABA@AB@@A@@@A@A@A@@@@@ABABA@@@@AA@A@@@ABA@A@@@BB@@@@@@@@A@@@@@A@@AA@@@@B@@BB@@BBB@@@BAB@B@B@@@AD@@@B@@@B@@A@A@A@AA@@A@@AAB@AA@@A@@@A@@@@@@AAA@@AA@B@@@@@@A@@CA@BA@AB@@A@@@AAA@@A@A@@AA@@@A@AAC@A@AAAAA@@AAA@@@AA@@@@@A@@@AA@@@AA@@@@@A@@@A@A@@@A@@@@A@@AABAA@@AA@@@@A@@@@@CB@@@@AB@BA@AB@@@B@@@B@@A@AB@@@@AD@@A@@B@B@@@BA@A@@B@@@@@BB@@@@BA@BBB@AB@B@@@B@B@B@B@BBB@B@BB@ABAB@@@@@@@B@@@BBB@@@B@@A@@B@@A@A@@A@@A@@@AB@@@@@@A@@@A@@@@B@@A@AAA@@@@B@@@B@@@@@B@@A@@BE@A@@@CD@@@@ADBB@B@@@B@B@BA@@B@@AB@@C@A@@@@@@B@@AB@BAB@B@@@B@@A@AB@@BB@@@@@BB@@B@@DA@B@@@BBB@@@B@@BB@@@@@@ABA@@AA@A@@A@AABA@@@@B@@A@@BC@A@@@AB@@@@A@AAC@@@@@BB@BBB@@@@A@@@@@AA@@A@C@@@A@C@A@@@A@@@C@EA@@A@ACAAA@@@A@A@@@@B@B@@A@AB@@@@@BB@@@BB@@A@@@@@AB@@A@@A@@@@A@@@A@@A@BA@@@A@@@AA@B@BA@@@@@@@@BAB@@C@A@A@A@@@@A@@A@A@A@@@A@@@@DBB@@@@@B@@@DBBA@@@AB@@@BCDB@B@@@@@B@@@AB@@ABA@@BA@@@@@ABAA@B@@@@A@A@@AA@@@A@A@@@@B@@AB@@@B@B@@@@@@@@AB@@BD@@@BA@@B@@A@@AA@@@A@@BB@@BBD@@@BB@@BBB@B@@@BA@@@@@@BA@@@@@@@A@@@@AB@A@A@BD@DBB@@B@@@@@CBA@@B@@@B@@DDA@@B@@@@@@@A@@@B@@@@@@@BA@@A@@@@A@@B@@@@@@A@@@AB@@@@@@@@@@@B@@@@A@BAA@@@@@@BAB@@A@@@@B@@@B@@@B@@AB@B@B@@@BB@B@@B@@@@@B@BAB@@B@@@ADA@@B@@@BA@@B@B@@A@@B@@A@@A@@@A@@@@A@@@@@@@BA@@@@@@C@ABA@@BC@@@A@A@BB@@@B@BBB@@@D@@ADAB@@A@@BA@ABA@@@A@A@@B@BA@A@@@ABA@@B@@@@@BA@@B@@@BAB@BAD@@@@AB@@ADAB@BADA@AB@@@@AB@@@@@@@B@@@B@@@B@@@BAAABA@ABA@@@A@B@@@ABA@C@@A@@@@@@AB@A@@@@A@@@AAA@@A@@C@A@A@@A@@@C@@@@@AA@@AA@@@AB@@A@@@@@@AA@CB@@A@@AA@@@@A@@@@@@A@@@@@A@@@@@@@@@A@@B@@A@A@@A@A@A@@@A@@@@A@@@@@@A@@@A@@BAA@@@A@@@A@@@A@@@@@AB@@ABAB@@@AA@@@@AA@@A@AA@B@BB@@@B@@@@@@B@@@@@@@BB@@@ABABA@@@@D@@@@@BB@@@AA@@@@@AAC@@@C@@@@@AA@@@@A@@AC@C@AA@@A@CA@@A@@AA@@AA@@AA@@AA@@@A@@@@@AA@@@@@@A@AA@@AB@@@@A@A@@@A@@@A@@A@@@A@@CA@A@@AA@@A@A@AA@@A@@@A@A@@BA@@BAA@@@@A@C@AA@@A@@@@@A@@AABA@@@@@@BA@@B@@@BA@@B@@A@A@@BAB@BA@AB@BCD@@@@AA@@A@CBC@A@@@ABAB@B@B@@AB@@AB@@@BA@BBA@@@AB@@AB@B@@AB@@@@A@@@@AA@@@A@BB@@@@A@@B@@A@@B@B@@@@@@A@@@@B@@A@@@A@@@AB@@@@@@@@A@@@AA@@AA@A@A@@A@@@AA@@@@@AA@@@@A@@C@A@@@@AAB@AA@@@AB@@@@AB@@A@A@@@A@AAA@A@@@A@A@A@A@A@@@A@@BA@@@BB@@@@@BABAB@@@@@B@@ABA@@@@BA@A@A@@BA@@@A@@BABAB@@AB@B@@@B@@@@@@@@B@@@@@AB@BA@A@A@@AABA@@@CA@@A@AA@@@@@@AABA@AAA@@@A@A@@A@@AA@@A@@AA@@B@AA@@CAA@@@@@AAA@@A@@A@@AA@@@AA@@BD@BABCDCBE@AAA@@AA@A@@@@@@@A@@@@@@@@@A@@B@@@@@B@B@@BB@B@@@B@@@@BB@@BB@@@B@B@@@@A@A@EBA@@@@B@@B@@@@@A@@@A@@@A@@@BB@@B@BA@@BBA@ABABB@AB@B@@@BAB@@B@@BAB@B@BAB@@AB@@A@@@A@AAA@AA@A@A@@AAAA@@@@@AA@@@A@@@@AC@@AAA@@@@@A@@A@@A@AA@@@ABA@@@AAA@A@AAA@@A@BAA@@@@@@@@@ABA@ABA@@BAAA@@A@@@@@AA@@@A@AE@CB@AB@@AA@@@ABA@AB@@@@@@@@@AB@AACE@@B@BABAB@@A@@B@@AAAA@@C@@@A@AA@@A@@@A@ACA@AA@@A@@@A@A@@BA@@@@@A@AA@@ABAA@AA@@@@AAA@A@@A@AAC@@AA@@B@A@@@AB@@AB@@CA@@A@@@A@@@@BA@@@A@@AA@@@A@@A@@A@A@A@@@@@C@AA@@A@A@@@A@@@A@A@A@A@@BEBA@A@ABA@@@ABA@@@@B@@@BB@AB@B@B@@A@AB@@@BA@CA@@@@A@AB@@@@A@AAA@@@AB@@@BA@AA@@C@@@A@C@@@A@AA@@A@@B@@@@A@C@@@A@@@A@@@A@A@@@@B@@@@A@@BA@@@@B@B@@@BA@@@BB@@@B@@@D@@@@@@@D@BB@@BB@@@BB@@@B@@BB@B@@@B@@@BAB@@BB@@BBAB@@@B@@@B@@@B@@@B@@@B@@@@@@BB@@@@@B@@BBAD@@@@ABBBB@@@@B@@@B@B@@BB@@@B@BAB@D@@B@@B@@@@@D@B@B@B@B@@@@A@@BAB@@@@A@AB@@@@A@@@A@@BA@A@@B@@@BAB@BABA@@BA@@@@@@BA@@B@@@@A@@@A@@@@@A@A@A@ABA@@@A@BBA@@B@@@B@@A@@@AB@@@@@B@@A@@B@@@B@@@BBB@BB@@@@@BDDD@@@@BBBB@@BB@@BF@B@@A@@B@@@@@B@@@BBB@@AB@@@@CB@BA@@DABBB@@AB@B@B@@@@@@B@@@AB@B@BAB@@@B@B@B@@B@@@@@@BAD@@@@A@CDC@AD@@AA@@@A@@AAA@@A@@AA@@@AA@AA@AA@@A@@AA@@AAAA@@@AA@@A@@AA@A@@@@ABC@A@@@@@A@A@@@A@AB@@@@AA@@@@@B@@A@@@@@A@@@@@@@@BA@A@@B@@@@@BAB@@BBAB@@A@@BA@AB@@@BAB@@C@A@@BACA@@@A@ABED@@@BA@@B@@AB@@@B@@@@@@A@A@@@@A@@A@@@@AA@@A@@ABAB@@@BAB@B@@@@AAA@@@A@@@AA@@@@@@AACA@@A@@@E@A@AAA@@@@A@@AA@@CB@@A@@@ABA@@BA@C@@@ABC@AB@@@B@@ADA@@B@D@@ABABABABA@@@@BA@@@AB@@CB@B@@BB@@ABA@@@AB@@@BA@@@@BB@@B@BB@ABBB@@BB@BB@@B@BBBB@@B@BD@B@@BB@@@B@@B@B@@@B@@@BB@@B@@@B@@@@@B@B@@@@@B@B@@@B@@@BB@@@@B@@B@@@@D@@@@BB@@@B@@@@BBB@@B@@B@@@B@@B@B@@@B@@BB@@@@B@B@@B@@@B@@@@B@@B@B@@@B@@F@@B@@B@@@@@BB@@@@@@B@@AB@B@B@BAB@@@@@@@BBB@@@BB@@B@@AB@B@@@B@@@@B@@B@@@B@@BB@@B@@DB@@@@@BB@@@@@BB@@@@BB@@@BD@@B@@A@@BAB@@AB@@@B@@@@@@@BAB@@@B@@@B@@@@BB@@BB@@@B@@BB@@A@A@@@AB@@ABABA@AB@@AB@@@BA@A@@B@@A@A@@B@@@@@@@B@@@BB@B@@@@@@B@B@@@BB@@@@@@D@@@@BB@BBB@@ADA@@@A@A@A@A@@@A@@@A@A@@@A@@@A@@@C@@@A@A@ABAB@@A@@@A@@AAAA@@@@@A@@@AB@AAAA@@ACB@@ABCB@@A@A@A@@@@@AA@@A@@@A@@@@B@@@B@@@@A@ABCB@@AB@@@@@BA@@@@@@@@BB@@B@@@B@B@@@@@B@B@@B@B@@BB@@B@@B@BBB@B@@@BBB@B@BB@AB@@BB@B@@@B@@@B@B@@AB@@@@AB@@@@AB@B@@@D@D@BA@@@BBB@BB@@B@@@@@BBB@@B@@BAB@@ABB@D@B@BAB@@@@@BAB@B@@@B@@@B@@@BB@@@@@B@@@@@BB@FB@@B@B@@AB@@@B@B@D@@AB@BA@@B@@A@@B@@A@AB@@A@AA@@@@AB@@A@A@A@A@@@A@@AA@@@AB@@@B@@BB@@A@@@@@@@AB@AC@C@@AA@@A@@A@@B@@AB@B@@@B@B@@B@@B@@ABAB@@ABC@@B@@@@@BB@B@@B@B@BBB@@@@@B@B@@B@@B@BABBB@@@@B@@@@@B@@@@@@@BD@BBBB@@B@B@@@@B@@FDBB@@A@CBABAB@AA@@AA@AA@AAA@@@B@@@B@@@B@B@B@@@@B@BB@BBBB@@BB@@@@BBB@@@BA@@B@@@BB@@BB@@@@@@@A@EA@@A@@@@@BB@B@B@B@@ABB@@BA@ABA@ABAB@B@@CB@@AD@B@B@@@B@B@B@@ABBB@@@@@B@@@B@B@@@@B@@BB@@@AB@@@B@@@@@B@@@@A@AB@B@@AB@B@@@B@@@B@@@@@@A@A@CBA@@B@@@B@@@@@B@B@@BB@BB@@BB@@BBB@@B@@@D@@BBA@@D@B@@@@@B@@BB@B@@B@@@@@@@B@@B@@@B@D@@B@@@@B@@B@@B@B@@@@@B@@@@A@A@@B@@@@ABA@@@@@@B@@BB@@@B@@@@@BB@@@@B@@BB@@BB@@@D@@@B@@@B@@@@B@@@@@BB@@@@B@@@B@@@@BB@B@@B@@@B@@@B@BB@@B@@@BB@@B@@@@BB@@@@@BAB@BA@@@@B@BAB@BA@@@@@A@@BA@@@AB@BA@AAAAA@A@AB@@ABA@@BA@@B@DA@@@A@@B@@@B@BA@@B@B@B@@@B@@BB@BB@B@@@@@@B@@@B@@@B@@@B@@@BA@@B@@BB@D@@BB@BB@BB@@@@@B@B@BB@@BB@@@@@B@@BB@@BBB@B@BBBB@@@@B@B@B@@@@B@@@@BABA@@@@B@B@@@@AB@@@BA@@@ABAB@@@B@BAB@@@@@B@F@BB@@B@BAB@@@@BB@@@B@BA@@BAB@B@@@D@@@@@B@@AB@BABABA@@DA@ADABA@@B@@@@BADABA@@@BBD@@@@@B@@@@@@A@AB@@AB@B@@@BADABA@@BAB@BAB@B@@@@@@@@@AB@@@B@@@@B@@B@@@@CB@B@@@@BB@@@@@A@AB@@@B@@@@B@@B@B@BA@BB@B@@@BBB@@B@@@@B@B@@@@@@@D@@B@@B@B@@@@@@@@@@BBAB@B@@@B@B@@AB@B@JADBD@B@BAB@@ADABA@AAA@AAA@@CEAC@@@@@@@@DB@B@@B@BB@@@@@BDDBD@BBB@BBB@@BB@B@@BBA@BB@@@BB@@B@@@B@@@B@@@@B@BB@@BB@BBB@@BB@@@B@@B@@B@B@@@B@B@BABABA@@@BB@@@B@B@B@BA@@B@B@B@B@@A@@B@B@@@@@B@@@D@@@@@BABABA@@B@@@@@BD@BB@@@B@B@@B@B@@@@B@@@B@@@@BBB@@@@B@@BB@@AB@@@B@B@@AB@@AB@@ABA@@@@@@B@@@@@B@@B@@B@B@@@@@BA@ADAB@B@@@BA@A@ABA@A@AB@@A@@BABA@B@@B@BB@BBB@@@B@@@BB@@B@@@B@@BBAD@@@B@@@B@@@B@@@@@A@@BA@A@@@@@A@@@@@@@@@BBDA@B@@B@ABB@@B@@A@@@@@@BB@@@@@C@@@A@@@@B@@BBB@@@CBA@@@AB@B@B@@@@@B@@@@@BBABBB@AB@BD@@@@@BBB@ABB@@B@@@BB@@@@BD@@B@@@@@@D@@@B@@B@@B@@@DAB@B@@@BABB@A@@@@B@@@B@B@BBB@BBBBB@B@@@@@@ABB@@B@@ABA@@BA@@B@@@B@@@@@@ABA@@@AB@AABA@@@ABAAA@ABE@A@@@AB@BB@@BB@BD@@BB@@BBB@@@@BBBB@@@@B@B@@@B@@@B@@@@@B@@B@AB@@AB@@@B@B@B@@@B@B@@@B@B@@C@@@@B@@@@@AA@@B@@@B@BA@@BA@@BA@@@@DCB@B@BBBBBB@@B@BA@@@@BABA@@@@B@@ABA@@D@@@BAB@@A@AB@@A@A@A@AAA@@@A@@AABA@AA@@@@@AA@ABA@C@A@@B@BAA@@@@@BA@@@AA@@A@@@@@A@@BCBA@@@A@@DA@A@@B@@@@AA@A@@AA@A@@A@@AAA@@@AA@@@@AA@@@A@@@@@A@@A@@A@C@@@A@@@@@A@@AA@@AA@@@@@A@@BA@A@@@@@@A@A@@@AA@@@@@A@@BA@@@@@@@CBABA@@BA@@B@@A@A@@@A@@@@@AB@@@B@@A@@B@BDBB@BB@@@@A@@B@@@B@B@BB@@FD@@D@B@@@@@BAD@@ABAB@@@@AB@@@@@BBB@B@BBBB@@BAB@BA@@B@@@@AB@@@@@@@@AAA@@BA@@@ABA@@@@B@B@@@B@@@B@@ABA@@BA@A@@@@A@AAAA@@@A@A@@@C@@AAAC@@@@BA@@@AB@@A@A@A@@@@@AB@@@DAB@@ABA@A@@@@CDA@A@@@A@@A@@D@@A@@@A@@B@DA@@BA@@@@A@@@@@A@@@@@@BAA@@B@@@@AC@A@@A@@@@BA@@@@@AB@BA@@BB@@@A@@BAB@@AB@BBB@DB@@@@B@DB@@@BB@@BB@@B@@B@@BD@BB@@B@B@B@@@@@B@B@B@@AB@BABA@@B@B@BA@@BAB@@@BABAD@BA@@@@BA@@@@@A@BB@BA@@B@@BB@B@B@DB@@@@@@BA@A@@B@@@D@B@@@@@BA@@@@B@B@@@@AB@BAB@@AAA@@BC@@BADCBAB@@@B@@@B@D@DB@@B@@@B@@@AA@A@@@@@AAA@AB@@A@@@A@@@AB@@@B@@@@@B@B@@@@@B@@@@@BA@A@@BA@@A@@AB@@@@@@ABA@A@@@AB@@@@@@A@@BAB@@A@@@@DA@@B@B@@AB@BA@@@@BAAA@@@A@@AA@@@@@AAAB@@A@ABA@@@@@A@AB@@@BA@@A@@A@@@A@@@@@@@A@@F@@A@@B@@AB@@@B@@@BBB@B@@@@@B@@@@@D@B@@@BB@@@BB@@@B@@BB@B@B@F@@@@BBBBAB@@@B@DB@@BA@B@@B@DCB@@@B@DA@@B@@@@@B@@@B@@A@A@@@A@@@A@A@@FA@@B@BBB@@B@B@@@B@B@@@BB@@@@@@@@AB@@@B@@@@B@BB@@A@@@@BA@@AA@A@@@ABA@@@AB@@@A@@A@@@@AB@@@@A@@@@A@@@A@@@@@@B@@@BA@A@@@@BA@@@@@@AA@@@@B@@@@@@@@@@@AA@@A@@@@@@A@@@@A@@@AA@A@@@ABA@A@A@@@A@@@AA@@@@C@A@C@@BA@@B@BA@AAA@@@A@@@@@@@@@@@@@A@@@@@A@@@AAAB@@@@A@@@A@A@A@@@@@AB@@@B@@@@@B@@ABA@@@@@AB@BA@@@A@@B@B@@AB@@@B@@@AA@@FE@@@@A@AAA@@A@@@@@A@@@@BBBB@@BB@AB@@@@A@@@@@@B@@@@@@@B@@@AAAA@@@@@A@@AA@@AADCB@@@@BB@DBBB@BB@@@@@B@BABBD@@AB@@A@@B@@@B@@@@@@A@C@A@@AAA@A@@A@@@C@@@A@AB@@@@A@A@@@@B@B@B@@A@@@A@@D@BA@@B@@@@@B@BBB@BB@@BB@@B@BB@@@@B@@B@@BB@@B@BBB@B@@BB@@@B@B@B@@B@@B@B@B@@@B@BB@BB@BBB@B@BB@@@BB@B@D@BABAB@@@B@@@B@@@B@@@B@B@@@B@B@B@B@@@B@@BB@@BB@B@BB@@B@B@@@@@B@@AD@@@D@B@@@BBF@B@@BD@B@B@NFJDB@@@@@BAF@DANAB@B@B@BBJDB@@BB@@@B@B@DA@@B@B@BB@@BAB@@A@A@@B@AA@@BA@@B@@A@@B@@@@@@AB@@@@@@B@B@@@@@@BA@ABABA@B@@BBD@BB@A@ABA@CBC@@BCDCBCB@BAB@BAB@@@@BA@@@@BBB@@BA@@@@D@FD@@@@BAB@@@@@@A@CB@@@AAA@CCA@@@@@BA@@@@@C@A@CBA@AB@BA@@BA@@BABAB@@ABA@@B@B@BBBBB@BAB@B@BB@ABBB@@AB@B@B@@AB@@@B@B@@@BB@@@AB@DA@@BBB@D@@BB@B@B@B@BAB@BA@@@BBAB@B@BAD@B@@@B@@AA@@AA@@A@@@@@AA@@@AA@@@A@@AABC@@AA@AA@@@@@AA@@AA@A@@@@@AB@@A@@@@@A@AAA@@BA@@@ABA@@@@@A@A@@@AB@@A@A@AA@@@@A@@A@@A@@@A@@@@@@@AA@B@@@AA@@@@@A@@@A@@@@A@A@@BA@A@@@@AAAAAAA@@@@AA@AA@@BA@ABC@@@AB@@ADAB@@ABA@@AAA@@A@@@@@@A@@@@@@@A@@@@@@B@@@DA@@@A@@@@@@@A@@B@@A@A@@AA@A@@@A@@@AAA@@AAAA@ABAAA@@A@@AAA@@@@@A@A@@@A@@@A@A@@@@BA@A@@AA@@BAB@@A@AB@BCBA@@@@BB@B@@@BB@B@@A@A@@@A@ABA@@@@@ABAB@@A@@B@B@@AB@B@B@@@BBB@BAB@@@BA@A@@B@@@D@B@B@BA@@BADAB@@A@@@A@@@AAA@A@AA@@A@@@C@@BA@AB@BABA@@DA@@DC@@B@@@BABAB@@@@@@A@@@@A@@AAA@@@A@@@A@@@ABCB@@AAA@@@@@A@@@A@@B@@@AA@A@A@@DA@@B@B@@A@@B@B@BA@@@@@ABA@@@@B@@@BAB@@@BA@@B@@@@A@@@A@A@@@AA@@@@C@@@A@@BA@@@A@@@@@A@A@@@ABA@@@AB@@@@AB@@@@@@@@BB@@@B@@@B@@@AC@@D@B@B@@@F@@AB@DABAB@@B@@@@@@@BB@@@AB@@B@B@@A@A@A@@@@BAA@@@@A@@BB@@@@@B@@B@@@@B@@AB@@@@AB@B@@@@B@B@@@@@@@AB@@@B@@B@B@@@@@@B@@B@@@BB@BB@@A@@@AAAB@@@B@@@BADBDB@@@@@B@@BB@@B@@@@BA@@@@@BD@B@@@@B@B@B@@@BAB@@@D@@@B@@@@@BBB@@@@ABA@@@@A@@AC@A@A@A@@@@@@AD@B@BB@A@A@@B@@A@@@@@@AAA@A@@B@@@@A@@@@A@@A@A@@@@@BB@@B@@B@@A@@@A@@@@@@@@B@@@BA@AAB@@A@A@A@@A@A@@B@@AA@@@@@@@A@@@@A@@@@A@@@@BB@A@@A@@A@@B@B@@@BA@@@B@@@@C@BB@@@@@@B@B@@@@@@@AC@@@@@@@@@ABB@@@@@B@@@B@@@@B@BA@@BA@A@@@@BAB@@@B@@A@@@@BABAB@@@BA@@BB@@@@B@D@BAB@A@@A@@BA@A@A@@BAB@B@BA@@@AB@@@@@@C@@@A@@@@DB@@@@@@@@@AC@@@AA@@B@FBBB@ABA@@@AB@@@@AB@@AA@@@AA@CBA@@@A@@@@CC@@B@@A@@@A@@@A@@AA@A@BA@@@@@A@@@A@AA@A@@@@DABA@AA@@A@@A@A@AB@@A@@@A@A@@@ABA@@@@@A@A@@@AB@B@B@@@@@B@@ABABABAB@@@AAA@@AA@AA@A@A@@@A@@B@@B@@B@@B@@B@B@BB@@@@@C@AA@AA@A@@BA@@BA@@@@@@@AA@@@@@@A@AAA@@@AA@@A@E@@@AA@@@@A@@A@@@@AA@@@@@@@A@@AB@@A@@@AACAA@@@@A@@@@@@@@B@@A@@A@@A@@AA@@AA@@@BBBA@@@BB@@@@@@B@@B@@@BAA@@B@A@@@@@@@@B@@@AA@@A@@A@AA@@A@@AAA@@@@AC@A@@@A@@@@A@A@@@A@C@@@A@@@@BA@AB@@A@@@@@A@@A@@@@A@@@@A@A@@C@A@@@C@@@@AA@@A@@A@A@@@ABA@CA@AA@@@A@ABA@A@@@A@@@@BA@CA@@@@A@A@@@AB@@@D@B@@@BBB@BB@@BBB@@@B@@@BBBBDBB@@CB@@A@AC@@AAA@@@@@A@CA@A@AAAA@@A@AAAA@@@ABA@A@A@A@@B@@A@@@A@A@@A@@CB@@AB@@A@A@@@A@A@@A@@A@C@@@A@@BA@@BA@@@@@A@@@@@A@@@@@A@@@A@AA@AAA@@@@ABA@@@A@AB@@A@@B@@AB@@A@C@ABA@@B@BA@@@@B@@A@@@@@@@@@A@A@@@@@A@ABABA@@BABA@@@@@@AA@@@AAAAAA@@AAA@@A@@AAAAA@@AA@A@A@@@A@@@@@A@@BA@AB@@@@AA@@A@A@@@@AAAA@@@@A@@AA@AA@@@AAAA@@AAA@A@ABAAAAA@@A@@@@@AA@@@A@@@AA@@@A@@AA@A@@@@ACA@ABC@AAA@AA@A@@@AA@@@@@@@A@A@A@AB@BA@"],encodeOffsets:[[122660,30367]]}},{type:"Feature",id:"330182",properties:{name:"建德市",cp:[119.281231,29.474759],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@@@AA@@AA@@BA@@AABA@@@A@A@@@AAA@@AAAAA@@AA@@A@@A@@AA@@A@@@@@@@A@@BA@@@CBA@A@A@@@A@ABA@A@A@A@@BA@@B@@@AC@@@@AA@@@@@A@@@AA@@@@A@@A@@A@@@A@@A@@AA@A@@@BA@@@@@AAA@A@@@A@CBA@@@AA@@A@@@A@@@A@@AA@@@@@@@A@AA@@@@@@A@@@A@@@A@C@A@C@@AABA@@BA@@@A@@A@@A@@A@@@A@@A@@A@A@@@AA@A@@@@AA@A@@@A@@A@@AB@AA@A@A@A@@AC@A@A@@@@@A@@AA@@@AA@@@@A@@@@@@AA@@@C@@@@@AA@B@B@B@@@@AA@@@CA@@@@@@B@@@BBB@@@@@@A@A@ABAB@@@B@B@@@@@BA@@B@BA@@AA@A@@@@@A@@@@@@BA@@@@B@@A@@A@@@@A@@@@B@@@BA@@AA@AB@@@@@BB@@@@@@@@B@@@@@BB@@@@B@@@@A@@@@@@AA@@@@AAB@@@@CA@AA@BB@B@@BB@@BB@@@B@@AB@@@@A@@@@@@@@ACB@@@BB@@BBB@D@B@@@@@@AB@@@B@@@BB@@@@BA@@@@BAB@@@@@B@@@B@@@@@@BB@@@@BBB@@@@@BB@@@B@@@@@@@B@@@@@B@@@B@@@B@@AB@@@BA@@B@@AB@@@B@B@@@BAB@@@BAB@D@@A@@@@@@@@AA@@@B@@@A@@A@@@B@@@@A@@B@@@@@BB@@@@@A@@@AA@@AAA@@B@@A@AA@@@@BB@@@@@BB@@@@@A@@@B@AB@@ABA@A@@@A@@@@BABA@A@@@A@AB@BAB@BA@@@A@A@@AA@A@A@A@@@@@BD@@AB@@AB@B@B@B@B@@A@@B@@@@AB@@@@@B@@@B@@@@A@@@@@@B@@@@AB@@BBA@A@@AA@@@@AB@@@CA@@@@A@BB@B@BB@@B@@@@A@@@@@@@AA@@@@BB@@@BB@@@@B@B@@D@@@@@@@A@@B@@B@@A@@@@B@@BB@@BBA@@B@@B@B@BB@BBBB@@BB@@BB@@B@@@@B@@@BCB@@@B@@@@A@A@AA@@A@A@@@ABA@@@BB@@CB@@AAAB@@A@@@ABA@@@A@A@@B@BA@ABADA@@@@@AD@@AB@AA@@@A@AA@B@@A@AB@@@@A@AB@@A@A@@B@@A@A@@@CA@BAACD@@ABAB@BABA@AB@@AB@@@BA@@@A@@@CB@@A@@BAB@@A@@B@BA@AB@@AB@@A@A@A@A@AD@@@BA@@B@BA@AB@@@@AD@@@@@BB@@@BB@@@@BBB@@@@B@@B@@@B@B@@@B@@@B@@@B@@@D@@BB@BB@@BB@@DB@@BD@@B@@@B@B@B@@@B@B@D@D@B@@BB@B@@@@B@@DB@@BB@@B@BA@@BB@@BBB@@@@BB@@@B@@@@B@BB@@@D@B@@@BBB@@@@@@@BB@@B@B@BB@@D@B@@@B@BB@D@@BB@B@@BB@@@B@@@B@@@@@@@B@@@B@@@B@@B@@@B@@@B@BAB@@@D@@@B@B@D@B@@@B@@@@B@@DB@@B@@@B@@@@@B@B@@@@A@@@A@@BB@AB@@ABA@@B@@AB@@A@@BA@@@@BBB@@@B@@B@@@@BDB@@BB@B@@B@@B@@BB@@BBD@@B@@@B@BBB@@@H@B@B@B@B@BA@@@BDAB@BB@@BB@@B@B@BBBB@BB@@@B@@@@@B@@@B@@@BB@@B@FBB@B@BBB@B@@B@@D@BBB@@B@@@B@@BB@BBB@@B@@B@@B@BBBBDBBD@BBD@@DB@@@@B@@@@A@@@@BAB@BA@BB@B@DDB@@@@@@B@B@@@@@@@B@@B@B@BBB@@@@@BB@B@@@B@@@@@BABBB@@@B@@BB@@@@@BBB@@@BB@@BB@@@BB@@@B@@B@BB@@BBBBB@BBB@@@DB@@D@B@B@B@B@B@B@BB@@BA@@B@@@B@D@@@@@BB@@B@@@BBBA@@BBB@D@B@@@D@@BB@DBB@BB@B@BBB@@B@@B@@@B@@@B@@@D@@ABA@AB@B@B@B@@@BBB@@BBBB@@@@@BB@@B@@B@@BB@@@@B@@BBB@B@@@@B@@@B@@BBB@@@BB@@@B@@@BB@@@@@B@@A@BB@D@@@DAB@B@B@@@@@B@@@BB@@@BB@BB@@@@BBB@@BB@B@B@BA@@@@B@@@B@@@@AB@BB@@D@B@BB@@BAB@@@@ABA@@B@BBD@@ABB@@@BB@F@@@B@BBB@B@B@@@B@B@@BB@@@BBBBD@B@BB@AD@@@DBF@@BB@B@@BB@@AB@BAD@B@B@@@@BB@@BB@@@BB@@B@BB@@BBB@@B@@@@BBB@BB@@B@B@@@@@@B@@@BB@@B@@BB@@@@@@@@AB@@@B@B@@@B@@BB@@@B@@@B@B@@@B@D@B@B@@@@@B@B@@ABA@BB@B@B@B@@@B@@@B@@@BB@@@BB@@@@AD@@@@BB@@@BB@BBB@@@BB@@BB@@BB@B@BBB@BBBBBBBB@@BB@@BD@BBGH@@@B@B@B@B@BA@B@AB@B@@A@@B@B@@@B@@B@@@AB@@AB@@ABAB@@DD@@B@BBBB@@D@BB@@B@B@@@@BB@@@@BB@@@BBB@@@@@BB@@@A@@BC@ABA@@@AB@@A@@AC@@BA@@B@BAD@BABABA@@@AB@BABA@@B@@BB@@@B@@BB@@@BB@@@B@@@@ABA@@@B@@@BB@@@@@B@BB@@@@BDBBB@@@@@@BB@@BB@@B@@BB@BB@@BB@@BBBBB@BDBD@@B@DABBB@@@BA@@BBBBB@@@B@@BB@@@@BBBBB@@BB@@@@@A@@BB@@D@@B@@BA@@@@D@@BBA@@@@@@BB@@@@@B@@@B@@BB@B@@AB@BAB@@@@@BAB@@@BB@@D@@@BA@@B@@@BBBB@B@D@B@@@@BB@@BB@@@B@@@@BB@@@@@@BBB@@B@BB@@B@B@@@@@B@@@B@B@@@B@@@@B@@B@@@@BA@@B@DAB@@@@A@@A@@@@A@@@A@A@@BB@@@A@AB@@@@AAAA@@AA@@AAA@@AA@@@C@@@A@AAA@@A@@A@@AA@@@A@A@ABA@A@@@A@@@@@ABA@A@A@@@@@@@A@AA@@CA@A@@@@AB@B@@ABA@@@A@A@A@A@@BA@AB@@ABB@@B@@@B@@@B@@@B@@BB@@A@@BA@@BA@@DC@@B@@ABA@@@ABAAAB@@@@@B@@B@@@BB@@B@@BD@@BBB@B@BB@@@B@B@@B@BB@@@@B@B@@B@@BB@@B@B@@BB@@AB@BB@AB@@@BB@AB@@BBBBAD@@BBB@@BB@@BBB@@@BAB@@@B@@@B@@A@A@@B@B@@B@@BB@@@B@@@BB@@@@@B@@AD@@BB@B@@BB@BBB@B@@BB@@@B@@BB@@B@BBB@@@B@B@B@B@@@@@@@BBDBB@@B@@DB@@BBB@B@@@@@BB@@B@BBB@@@B@@@@@@@BB@@@@@B@D@B@B@@@@BB@@BBB@B@@@DB@@BBBB@@D@B@@@@BB@@BB@BDB@B@@@@@@@B@B@DAB@D@B@@ABB@@@@B@@@@BD@@@BDBB@BAB@B@BBB@@AB@@@BBB@@@@@BBB@@BB@@@BA@@@@B@@DDB@B@BB@AB@@BBB@@BD@@BB@@@DDB@@@B@@BBBB@B@B@FB@@@AB@B@BADB@@@@BB@@@BBBB@B@@@BBBD@@@@BB@@BB@@B@BB@@BB@@B@@@BB@B@B@B@@@B@B@B@@@BBA@@BD@@BBB@@@BB@B@B@BB@DB@@BBBB@@B@B@BB@BB@@@@A@@@BB@@BD@B@BB@B@BB@@@@BBB@AB@BB@@B@B@@@@AB@@@B@B@@@BB@@BDB@B@B@@@BB@@B@B@@@DB@BB@BA@@@@BA@ABABA@@@DFBBA@@B@@@@@@@@BAB@BA@@B@@BA@@BDAF@@B@B@@BB@@@@B@@@BBAB@@AB@BAB@B@@@@@@@@BB@A@BB@BBB@B@BB@@B@BA@@B@@B@BB@@@@B@@@@BB@BD@@B@@B@@@B@@B@@@@BBBB@@@B@BBBB@BBB@@@B@@@BA@@BA@A@ABA@AA@@@BA@A@@@ABAA@BABAB@AA@@ABA@@@AA@@B@@@B@@@B@@@@@A@@@@A@@B@FAB@B@@@@@@A@A@@AA@@AA@@@@@A@@@AAA@@@A@A@@@@@AB@@@@@@@@@B@@@@@@@B@B@@BB@BBF@DADCBA@AAC@@BB@@B@@BB@@@@BB@BB@@@@B@DB@@BBA@@@BB@@@BB@@BB@@@@B@B@@BB@BABBB@@@@@@BBB@@@DB@@B@BA@BB@B@B@@ABA@@@@A@@@@@@@@@@A@@@ABA@@BABA@AB@@@B@@AB@B@B@@A@@B@BA@@@A@@@@BABA@A@@@@AA@@B@@AB@@@B@B@B@B@B@@@B@B@BBB@@@B@B@@@BA@@@@BA@@B@@BBA@B@@B@D@@@@B@@B@@B@@@@BB@@B@@@@B@BBB@@BB@@B@@@@@@@@@BA@@B@@@B@@@@A@@B@@@@@@@@A@AB@@@@AB@@@@@AAB@@@B@@B@@B@@@@@BA@@@ABA@@BA@@B@AAB@@A@@BA@@BA@@@A@ABABA@@B@D@DAB@@@BB@@@@DC@ABAB@@ABA@AB@B@@@@AB@@A@@@AB@@A@@@@B@BA@BB@@@@@B@@@BBD@B@@@@@BB@AB@@AB@B@@@B@@@BBB@B@@@BB@@@BDB@@@B@@@BB@@@B@@@B@B@@@@@BA@@BBB@@@@@@@BB@@@@B@@@B@@BB@@BB@@BB@@BB@@@DBB@@@BBD@D@@BB@@@@@BB@@@@D@@@D@BB@@@@B@@B@@AA@@@@C@@@@@ABAB@B@@AA@@@@@@A@@@@@@@@A@@AAA@B@@B@BB@@B@@B@@B@@BABA@@BA@@@@B@@@B@@@B@@@B@AB@@@B@@@B@@@@B@@@@@@B@@@B@B@BB@B@@@@AB@@@@@@@@@B@@@@@B@@@@@@@@B@@B@@BB@@@DAB@@B@@@@B@@@BA@@B@@BB@@B@@@@@D@@@BB@B@D@@@@BB@BB@@B@@@@@@BBA@@@@@@@BD@B@BA@@A@B@@@B@BAB@BABB@A@@@A@@@A@@@A@@@@@@BA@@@@BAB@BC@ABABC@@BA@@@@BC@ABA@A@@@AB@@A@@@@@AB@BA@@B@B@@A@AB@B@@@B@BAB@@AB@@@BABC@@@C@@AA@A@A@@AAB@B@@@D@@AB@BAD@@@@@@@AB@@@@@@B@@@@@@B@@@BB@@@@AB@@@@A@AB@@A@@@AB@BC@@A@@@BA@A@A@@@@@AA@A@@A@@@A@ABA@@@A@@@A@@@A@@B@@@BA@A@@@@B@ABB@@@@@@A@@@@@@@@@@BA@@B@@@@@@@@AB@@@@@@BB@@A@@@@@@@A@@@B@@@@@@@AB@CC@@@A@@@AB@DA@@@@A@@@AA@CACB@B@A@@B@@B@@@@@@@B@@A@@@@B@@A@@@AAA@AA@@A@@AC@AA@@AB@@@B@@BB@@@@AB@@A@@AC@@BA@@@@@@@@@A@A@@BA@@@A@@B@B@@@B@@BB@B@@@@@@ABBBA@@@@B@@AB@BA@@BA@@A@@@@@A@A@DC@A@@BA@@B@AA@C@@@A@@@@AA@C@@B@@@B@B@B@@@@B@@B@B@B@D@@@BA@A@@@@@@B@@A@ABB@@B@@@B@@A@BB@@@B@@@@@@BB@@@BA@@@@B@@@AA@@A@@A@@@@BAB@@@@A@A@@B@B@@@B@BBBDB@@@FBD@@@B@@@B@D@B@@@D@B@@@BB@@@@B@@@@@AA@AAA@@@@D@BBB@@@@@BA@@B@D@@AB@@@@A@@B@BA@B@BB@B@@BB@BA@@@@@@AA@@@A@@AA@A@@@ACB@@@AA@@A@@@@AA@@BAB@@@@A@@@ABA@ABA@@@A@@@@B@D@@@BA@@@AB@@A@A@A@@@AAABC@@@@DC@@B@F@@AB@@@@A@@@@@A@@@A@@B@BBB@@@@A@@B@@@B@@@@@@@BA@@B@@@@BB@B@@@@AB@@@@A@@AA@A@@@A@@@@@@BABAA@@A@AAA@A@A@A@A@A@@@ABAA@AAB@@A@@A@@A@@@@@AB@B@@A@@@A@AB@@@BC@@@@BAB@@@@A@@@A@@BAB@@ABA@@@@DA@@@@B@@@@@BB@@BBBA@BB@@@@@@B@@@B@B@@@B@@@@BB@@B@@B@@@B@@@@BB@@B@BB@@BBBB@B@BBD@B@B@@BB@@@B@BB@BB@@B@@@BAB@@ADB@@@B@@@@A@B@@BB@BB@@@@@@@B@@@BB@@BBA@BB@@@BBB@B@B@@@@A@@@A@@BC@@A@A@A@AB@@A@AA@@AA@@@A@@B@@BB@@@@@B@@@@@@@@@AA@@B@B@BA@@B@B@@B@@B@BABA@@@@B@B@B@@@B@@@BAB@BABA@@BBB@@@@@@ABA@@BA@A@@B@@@B@@AB@BA@@BAD@@AB@@@BA@@@A@@B@@@B@@@@@B@B@@A@@@A@A@@AA@@@@BAB@B@BB@@@@B@@A@@BA@@@A@AA@@A@AAA@@@A@@@@@AB@@@@AB@BABABA@A@@A@@@AAB@@AA@C@@@@@A@C@A@C@A@C@@@@A@A@@@ABA@@@@@@A@@B@@@BAB@@AAAA@@AAAAA@@@@AB@BCBABA@@@@BA@@@@AAA@AAAAAA@@B@@@B@@AB@@@@@@@B@@@@A@@@@B@@AB@B@@@B@@@D@@B@B@@BB@@ABB@B@@@@AB@@@@A@@BA@@@AB@@A@A@A@@A@@AAA@AB@@@A@@@@@@@A@ABA@BB@@@D@BA@A@A@@@@A@@A@@@A@@@@@AA@@@@@@A@@@@BA@@@@@C@@@@@@A@BAB@B@@@@A@@@@@A@@@@@AAA@@AAC@A@@AB@@A@@@@A@A@A@A@A@@C@@@@@A@@@A@A@@@A@@AA@@AA@@@A@@@A@A@AA@@A@@@@@A@ABA@AB@B@@ABA@A@A@@@@A@@B@BA@@@ABA@@DC@A@@C@A@@BABAB@@A@A@A@AB@@ABA@@B@@@AA@@@@@@ABA@@A@@@@@BADAB@@@@AB@@AB@@@@@BA@@@@A@@AA@AA@@CA@@BA@@@@A@@@@BA@@@A@@A@@@AB@@@B@@@A@A@@@@AB@@@@@@@@A@@@@B@@@@AA@D@B@@@B@@@@@@A@AAA@@@@B@@@DB@@@BA@@BB@B@B@@@@B@@@@B@BABA@@@@BAB@@@BAB@B@BA@@@A@@@C@A@@@@BA@@B@@AD@B@B@@ABA@A@@BA@@@@@A@@B@@AB@DA@A@@BA@A@@@@@AA@@AA@@AIBMF@AA@A@@B@BAB@B@@A@@A@@AAA@@AAC@B@@AB@@@@A@AA@@@@@D@DB@@B@@@@A@AAAA@AA@@CA@AB@@A@A@AD@@@BA@AA@@AA@AA@@AB@B@BAB@B@@@B@B@@@B@B@@@@AB@@@B@@@BA@@@@B@B@@ABA@A@A@A@@AA@@A@BC@@@B@@A@@@AAA@A@CAA@@@AB@@CBA@@@@@@AAAA@@A@@@@D@B@@@@@@A@@D@B@@@@AAA@@@@@A@@A@CCDA@BB@@@@@@@B@@A@@@@AA@@@@CAAA@@ACB@BBBA@AA@@AA@AA@@BA@A@@@@AAA@@@@A@A@@@BA@A@@@BBAB@@@B@@BB@BB@@@@BA@@@AA@@A@@B@@@@@D@@A@@B@@@@ABCA@@A@AA@@@@BA@@@@AAAC@@A@@A@@@ABA@@A@@A@@@A@A@@@@@A@@BBB@@@@BBA@A@@@AA@@A@A@@@A@@@@B@BAB@BA@A@@B@@@@@C@A@@CB@@A@A@@B@AAB@@AA@@@AAA@@AB@@@B@@A@@BA@@@@AA@@@A@@@AB@@A@AB@@@BA@A@A@@@A@@B@@@B@@AB@B@@@@@BA@@BA@@BA@@@@AA@@@A@@@AA@AA@@@C@A@AA@C@@A@@C@A@@@@@A@AA@AA@@@@AA@@@@A@@BBBA@A@@B@B@B@@@B@@A@@@A@@@@@A@@@@CAC@C@A@@@@@B@DAB@@@BA@AB@@@@@@@A@@@CAA@@@@@@B@@@@@@AB@AAB@CAA@@@@@B@B@@BB@@A@AAA@@@@B@@@@@@@BA@@@A@A@E@@@@B@BAB@B@@BBC@AB@@@A@AAAB@@A@@@AA@@A@A@@@A@@AA@AA@@@AB@@C@A@AA@@AAA@@@AA@@@@B@BAB@D@CAAA@@B@@@@@@@@@A@@@A@@@@@@A@@B@@@B@BADAB@BAB@@A@@AA@A@@AAA@A@A@@@@B@@@@@B@@A@A@A@@@A@A@@@@ABA@A@@FB@ABAAAA@@@A@A@@@A@@@@@AA@@@@BBC@A@@@@B@B@@B@B@@@BA@B@B@@@@@BA@BB@B@B@@C@A@AA@D@@AD@BCBA@A@@B@@@@@@ABAB@@@@A@@@A@@@AA@A@@@@@@@BA@@@AAA@AA@@A@A@@@A@AAA@AA@A@A@@@@A@@B@@ADCB@BC@A@@@@B@@@BAD@BAB@@@BAB@B@@A@AAABAA@@A@A@@A@@B@DA@A@AA@@A@A@@@@@@CDAB@BA@@@AB@@A@@@@@A@@@@@@@AAA@@@@@@@@@@@A@@AAA@@@@A@@@@@BA@@@@@A@@@AD@@A@B@BB@@A@@@@D@@@B@@B@BB@@BB@@@@ABC@A@@AAA@@@@A@@A@@AA@@A@@@@@@B@BA@@@@@@@@@@@@@A@@A@@@@@A@ABA@@A@@A@AAB@@A@@@@A@A@A@@@C@@@A@CA@@@@@AA@@@@A@AA@@@@CAA@AAAAA@A@A@@B@DA@@@A@@@A@@A@@AA@A@AA@@@A@@AA@@@A@@CCA@A@@@@AA@@@AA@AAA@AAAA@@@@AAA@@@@A@@B@@@@@B@@@@A@@@A@@@A@@A@AAA@@BA@@@A@@B@@AB@B@@@@AB@BA@@BA@@BA@@@@BADA@@BAB@B@@@@@@A@A@@BA@@@@AA@A@A@@BA@A@@BA@AA@@@AA@@A@AA@AA@A@@A@@@@ABA@@B@@A@@@CB@@A@A@@@AAA@@@A@A@A@@@@B@@ABA@@@AB@@@@A@@BA@@@ABCB@@ABAB@@@BA@@@A@A@@@A@A@A@ABA@@@A@A@A@A@A@C@@@@AA@@A@@@A@@A@@@@AAA@@AA@@A@@AA@A@ABA@A@@@@AA@C@@@@@A@A@ABA@@@@@@@A@@@A@A@AA@@AAC@AA@A@@@@@AAAA@@@CBAAA@A@CB@@A@@@A@ABA@AB@@A@@@@@@B@@AB@@ABA@A@AAA@@@A@AA@@@A@@A@@@A@@@@AAA@@@AA@A@@@@@A@@@BA@@A@@@A@AA@@DEBC@C@@AE@AAAACACA@CEEGAAAAACACAAAA@AAAABA@@B@@BB@B@@@@A@@@@@AAAA@@@@@D@A@AA@@BA@CB@@@AA@A@C@AA@@@AA@@@B@AA@A@@@@@@@@@BA@@@@@@@@@@@@B@@@@@@@@A@@@@B@@@@@@A@@@@A@@@@@A@@@A@@@@@@A@@@AA@@@@@@@A@@@@A@@A@@@B@@@@@@A@@B@@@@@BA@@B@BAA@B@@AA@@A@A@@@A@@@BBB@@B@@B@@@B@@@@@@B@@BA@@A@B@@@@@@@B@@@@@@B@B@@@@@@A@B@@AA@A@AB@@@@@AA@@@@BAB@B@@A@AA@@A@@AA@A@A@A@@@B@@BB@@@BBB@B@@B@@@@@@A@@@@@@@AA@B@@B@@B@@@@A@@B@@@B@@AAA@@@@@@B@@ABAB@B@@@@BB@@@@AB@@@@@B@B@@BBBB@B@@A@@@A@@@A@AB@B@@@BC@@AA@A@@@ABAB@A@@A@@@@AA@@@@@AA@@@@@@@@@D@@A@@@@@B@BB@DEB@@A@A@@@@DA@A@AB@@ABDB@@@@@B@BAB@@AA@@@@@@AB@BBB@B@B@B@BDB@@D@@B@B@BB@@@@B@B@@@B@@@@@B@@B@@B@@B@B@B@B@@@@B@@@@@@AD@@A@@@@@B@@B@@@@@D@@@B@@@B@BA@@@AB@@A@@B@@C@AAA@@@@@@@@B@@@@BBDB@@AB@@A@CA@@@B@B@@B@B@@@B@@@D@@B@BABCBABA@A@AB@@@B@@A@@BAC@@ABA@@@@B@BB@@@ABAB@B@@BB@@@@@@C@@AA@@@@BA@C@@@@@A@@@A@@@C@ABA@A@@@@@@BB@B@@@@@@BA@@@BB@@AB@@@B@@@@A@@@A@@BB@B@B@@@@BB@A@AB@@AAA@@B@@AB@@BB@@AB@@@B@@@B@B@@AB@@@@@@A@@@A@@@AAA@A@A@CAA@@@@@BA@@@A@A@A@AA@A@ACA@A@CA@@AE@A@A@@@@DBBBB@DBB@B@@@AEAA@A@@@A@@@@AA@@AA@@A@@@A@A@A@@@C@@@A@A@AA@BA@@B@@ABA@@@AA@@@@AB@@@@AA@@A@C@@@@B@@CA@@@@CAA@@B@@@B@BAB@@AB@@AB@@@@AB@@A@@A@BA@@@@@@@C@ABADA@@@@@AB@B@B@B@@@BA@AB@B@B@@@BBBAB@@@B@B@@A@AD@@A@@@@BC@@BA@@@@BA@@@CAA@AA@@AAAA@A@A@@@@A@@AAAA@AA@A@@@AAA@A@@@A@@AA@@CA@@AAC@@@@BB@@B@@@@AB@@@@@A@@@@@@@AAC@@@@ADA@A@A@@@CA@@@AA@AA@AA@@AA@@AAAA@@@A@A@@BAAA@@@A@@@A@@@AAA@A@@@E@@@@@@@AA@@@@AB@@AA@AAA@@C@ABA@@@@@@A@@@A@@@@A@@@@A@CAA@@@AA@@@@@BA@AA@ABA@@A@@A@B@BA@@B@@@B@@@B@@AD@BA@@B@@AB@BBB@@AB@@A@@@@A@C@@@AA@@AA@@A@@@A@@@@AB@@ABAB@B@DDBB@@@@DAB@BA@@@@@@@AA@@AAA@@A@@@@A@AA@@A@AAA@@@AA@@@@ABCBA@@@AA@@@A@@@@AA@@@AA@@@AA@@A@@@A@A@@AA@C@@@A@@@@@ADA@@AA@@@A@@AA@A@@@@@@@AA@A@@A@@AABA@@@A@@BA@@@A@@@A@A@@@@A@@A@A@@B@@@B@@AB@BBB@@@B@@BB@@@@A@@@A@@B@B@@@AAAACCB@@@B@@@BAB@BA@@B@@@@@@AB@B@@@@@@@BA@@@A@@@A@@A@@@@@@A@@@@@A@@@ABB@@@A@@@@B@@@@@@AAA@@BA@@@A@@@AA@@@AA@A@@@@@A@@@A@@A@@A@@B@@@@A@BB@@@@@@A@@CA@@@@@@@AB@B@@AB@@A@ABA@@@AD@BAB@@@@@@AA@@@A@@@@A@@@@@@@A@@@@@@@@AB@@A@@@AB@B@@A@ABAAAA@@@A@A@@AB@@A@@@BA@@A@@B@@AA@@@B@@A@A@@A@@@AA@@@@BA@@@A@@@A@@@BA@@A@@@@A@@A@@A@@@@@A@@@BA@A@@@@A@@C@@A@@AB@@@@A@@@A@@@@@@@@@@@@@@A@BA@@@@@@@@A@A@@BA@@A@@@A@@@@@@@@A@@@AB@BB@@@@@@AAAA@A@@@A@@@@@AB@B@@AB@@C@A@@@@@@@@B@@AA@@@AA@@@@@A@@@@@@D@@@@A@@@@B@@AB@AA@A@@@A@@@@@@@@@A@@@A@A@@@@@B@@A@@@@@BAA@@@@@@B@B@@@B@@@B@@@@A@@@@D@@A@@@@B@@@@AB@@@@A@BA@@@ABA@@@@A@@@@@B@@A@@A@@@@A@AA@@B@@@BA@@@@@@A@A@@@AAB@A@@@@@@BAA@@@@A@@B@@@@@@A@@@@AB@@A@@A@@@@BA@@@@@AA@BAB@@A@@@BA@@@@@A@@@@BA@@@@@@@@@@@A@@B@@@BA@@@@@AB@@@ABA@@@@@A@@@@@@@@@@A@@@@@CB@BAB@@AC@@@@@@BA@@B@@@BA@@B@@@@AA@@@@A@@@A@@@@@@A@@@AB@@A@@A@@@@@@@@@B@@@@@@@BAB@@AB@@A@@@@@A@AA@@@@AA@@@@A@@@BB@@@@@@A@@@@@@@A@@@@@B@@C@BA@@@A@@@@C@A@@AA@@B@A@@@CA@AB@@@A@@@A@@@@@@@@AA@@B@@@B@@@@@@@@AA@@A@BBA@A@@@@B@@@BA@@@@@@@@A@@AC@A@@@@B@@@@@@@AA@A@A@@@@@@A@@@BDAB@@@@AB@@BB@A@@@@B@@@@B@@B@@BA@@@@@@B@@@@B@AB@@@@AB@@@BA@@@B@@@@@@@@@@B@@@@@@@BA@@A@@@@@@@@@@@@A@@B@@B@@BA@@@@A@@@@AB@@@@@@@A@@@@@@@B@@@@@@@AA@@@@@@@@@@@AB@@@A@@A@@@@@@@A@@@@BA@AB@@@@A@AB@@@@@ABA@@@@@@@BBA@@B@@A@@@@@A@@BA@@@@A@@A@@@@@@@@A@@@@@@@A@@AAB@@@@@AA@@@@A@@@A@@A@@@@@@A@AB@@A@@BC@A@@B@BA@@AA@A@@@@@@@@B@AAA@@@@@@@@@@AA@@@@@@A@@@@A@A@@@@@A@@@@A@@@@ACB@@@@@AA@@@@@@A@AA@A@@@A@@CB@@@A@@@@@@AAA@@AAAA@BA@A@@AA@A@@A@AA@@AA@BA@@@A@AAA@A@AA@@AA@@A@A@@@A@@@A@A@@BA@A@AB@@@@A@@@@@ABA@@@B@@@@@@BA@@A@@@@@B@@A@@BA@A@@@@@@@@@A@@@@@@BA@A@@@@@@@AB@@@@@C@@B@@@@@AB@@@@B@@A@AB@@@@A@@@@B@@@@@@@AA@@@@@@@BA@@@@BA@@@@@@@A@A@B@@A@@@@@@A@@@@@@@@A@@@@AB@@@B@A@@AB@@AA@@@@@@A@@@A@@@@@@AA@@@@@@A@@AA@@@@@@A@@@@@BB@@AB@@A@@BB@@@@@A@@BB@@BA@@@@A@@A@@@ABA@@@@B@@@@B@@AB@@@@@B@@@@@@B@@A@@@A@@@AD@@@BDA@@A@@@BABB@A@@B@@@@@@@B@@B@@@@@@@B@@@@@@@@B@@@@BBB@@@@@@@@BBB@@@A@@@A@B@@@C@AA@B@@@@B@@@A@@B@@@@@@@@@B@@@@@@@@AB@BA@AB@BA@@BABB@BB@@B@@@@B@B@B@@ABAB@@@AA@@@@@@AA@@@A@@@@@@@@@B@@B@@@@@@A@@@@@@B@B@@@@@BA@@BB@@B@@@@@B@B@@@@B@B@AD@B@@@B@B@@@@BB@@A@@@A@AB@@BD@@B@@@@BB@@B@@AB@@A@@B@@@@C@ABB@ABA@@@CC@BAB@@CB@@A@AA@@A@AB@@ABAA@@@A@@ABA@A@@@@BA@A@A@BA@@BA@C@C@@AA@@A@@@@@AB@@A@AB@@AA@@BAA@AB@@A@AB@@AAA@@@@A@@@A@@@AA@@A@A@A@@AA@A@@AA@@@C@E@A@@A@@A@A@@AA@@@@@@A@@@A@AA@@@@A@@BA@@@@A@@@@A@@AA@AAA@@@BA@A@@@@B@@ABABAB@@@@@@A@@@AA@@AB@@@B@@A@@@A@AB@BA@@@A@@B@B@@@BA@BB@BB@B@@B@B@@@@@BA@AB@BA@@@ABAB@B@@A@A@BB@@@@AB@@A@AB@A@BAA@@AB@@@@@BBB@@@@@B@@@B@@AB@@@@@B@@@@@@@@A@@A@AA@@@@AA@@A@@A@@@@B@@@@@@@@@B@@A@@@@@@@AB@@A@@@AB@@A@@BAB@@A@@@@@@@AAB@AA@@BA@A@@@@@@@@@@AA@@@@@@A@@@@@@@AAB@@B@@B@@A@@BC@A@A@@@@A@@BA@@B@@@A@@B@@DA@@B@@@AC@@@A@@@@@AB@@@@@@B@@@AB@@@@@A@@@A@@A@@@@BA@@AA@AB@B@@@@A@@B@@@@@B@@@@@@@@@AA@@B@@A@A@@AAA@@@@@@B@@A@A@A@@AB@@@@@@@A@@@@@AB@@@A@BA@@@@@ABA@A@@@@B@@@@@@@@AA@@AB@@@@AB@@@@B@@B@@@@@ABB@@@@A@@@@@@@A@@@@BB@@B@@@BA@AA@@@A@BAA@@@@@@@@A@A@A@@A@@A@@@AB@@@BA@@@A@@@A@@AA@AA@@@@@A@@@@@A@@@A@A@@@@@AB@@A@@@A@@B@@@@@B@@@@@B@@@@AB@@CB@@A@@A@A@@A@@AA@@@AA@@@BC@@@@A@@@A@@B@@A@@A@A@@B@@@@@@AA@@@@@@@@@@AB@@@@@@A@@A@@A@@@@@@BA@@@AC@A@AAA@@@@@@A@@@B@@@B@@@B@@@@@@AA@@@@@@@@@@BBA@@@@@A@@@A@@@@B@@@@@@@B@@AA@@A@@@@@@A@@@A@@@AA@@@A@@@@@AB@@AA@B@@@B@@ABAB@@@@@@BBB@AB@@@BAD@@@B@@CBA@@A@@A@A@@@@@@@BBB@@BA@@@@@@@@@A@@@@@@A@@@@@@AB@@@B@@@B@B@@A@A@@@CA@AA@@A@@A@@@AAA@@A@@A@@@@@@@@B@@@@@@@BB@@@@@@BAA@@AB@@@@B@@B@@@B@@AB@@A@BB@@@@@B@@A@@A@@@BA@ABAB@BA@@@AB@@@@@B@@@@AB@@@@A@A@@@A@AA@@A@@A@@@@@AA@@A@@@@@BA@@@@@@@A@@A@@A@@@A@@@A@A@@@A@@AA@@A@A@@A@@@A@A@@@A@@B@BC@ABCB@@AB@@@@AB@B@B@@@@@B@BB@@BB@@BBB@B@BA@A@A@@B@@CBA@@@A@@@AA@@@@A@@@AB@@AC@@A@@AA@A@@AA@@@A@@@AA@A@@@@A@C@@@AAA@@@AA@@@@@@@A@A@@@A@@@@A@CBA@@@A@@@CBA@A@A@@A@@AB@@A@@AA@AB@@ABAB@@@B@@A@"],encodeOffsets:[[121951,29933]]}},{type:"Feature",id:"330185",properties:{name:"临安市",cp:[119.724734,30.233873],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@B@@ABA@@BC@@B@@@@@BA@@B@B@@ABABA@@@A@@@@@@@@A@@@AA@@@A@@@C@@@@A@@@AA@@AA@A@ABAB@@CA@AA@AA@@A@@A@@A@@@@AA@@A@@AB@AAB@@@AA@@@A@@@@@A@AA@@@@A@A@@BA@@@@@AB@@A@ABC@@B@@A@@@ABA@A@ABA@A@@@@@@BA@@@@@@B@@@D@@@@AB@@AB@@BB@@DB@DA@@B@@@@C@AAA@@BA@@DAB@BB@@BB@@@@@@BBB@@BB@@@BA@@B@B@@A@A@@@A@@BA@A@@BAB@@B@@BBB@@ABA@@@@@A@@B@@@@BB@@C@@B@BA@@@AAA@@BA@C@A@A@A@CBA@@@ABC@@B@@AB@@A@A@CBA@@@@@AAA@@@AB@@B@@@@@AB@@@B@@@@@B@@@B@B@@@B@@@B@@@B@B@@@B@BB@@@@@@@BB@@@B@@@@@@@B@B@@A@@@@B@@@BB@@@B@@@@BAB@@AB@B@@@B@@@B@@B@@@B@@@B@BAB@B@@@@BA@@@EBAD@@@B@@ABA@@@@@A@AB@@C@A@@BA@@@@BAB@BA@AB@@@@A@@@AA@@A@@@@AA@@@A@@@A@@@@BAA@@A@A@@@CA@@@@A@@A@AAAA@AA@@A@@@A@@@A@AB@@A@A@@A@@A@@AA@@@AA@@AA@@@@A@A@@@A@@@@@@@A@@@@@@@@AA@@A@@AAA@@BA@@@A@AB@@A@@@A@@@@A@A@AAA@@@@A@@AB@@@@ABA@@A@@@@AAA@A@@@AAA@@@A@@A@@ACC@C@AA@A@@@AAA@AAAA@@AA@@@@@A@@B@@@@C@@@@@A@@AAA@A@A@C@A@AA@BA@A@A@A@A@@@A@A@@@@A@C@A@A@@B@@@B@@@@AB@B@A@@A@@AA@A@@AAA@@@A@A@AA@@@@A@AA@@AAA@@@C@@BA@C@@@A@@@@ABA@AAA@@AA@@@@@AA@@A@@A@@B@@A@@@A@@BC@@@@BA@@BB@@B@@@B@@AAA@@@@A@AA@@A@A@@@@A@AAAAA@ABA@@@@B@B@B@B@@@@@BAB@BA@@A@@@@AAA@@AA@A@A@A@@@A@AA@@@@@AA@@A@A@@@@@@A@@@@@@@AB@@AB@@AB@@@B@B@@A@@@A@@@@@@A@AA@@@A@A@@@A@A@AB@AA@@@@AA@@@@AAA@@A@@A@@A@AA@@@@AA@@@B@@A@@BA@@@A@@@AB@@A@A@@@AA@@@@C@@AAA@@@A@A@@B@@@AA@@@AA@@@B@@A@@AA@@@@@@@A@@@@B@@A@@@A@@@@BA@@BA@@@AB@@AB@B@@@B@B@@@DA@AB@@@@@BAB@B@@@@@@@B@B@@@@@B@@@B@@@@@@@BA@@BAA@AC@ABA@A@A@@A@@A@@AA@@BA@A@@@@@AA@@@A@A@@A@@@AC@@AA@@A@@@AA@@@A@A@A@@@@@@@@AA@A@@@AA@@A@A@@@CA@@@AA@A@@@AAA@@B@A@@@AA@@@@A@@@AA@@@@A@A@@@AA@@@BAA@@A@@B@@@@A@@BA@@B@B@@@@A@@@@BA@@@@A@@@@AA@@@C@@@@@@@@AC@@A@@A@@A@@@AA@BAA@AA@@@@C@@ABAA@AAAB@A@@@@@A@@@@@A@ABA@@B@DA@@A@AA@@@A@@B@@@D@@@@@A@@A@@@@B@@@@AA@BAA@@@@ACBAA@@@@@@@@B@@@@@B@B@@AB@@@@@A@@@A@@@A@@@C@AB@AA@@@A@@@AA@@A@@@A@AAA@@A@AA@B@BA@AB@@@BAB@B@BAB@B@@A@@@ABABCB@@A@@@@@A@AA@@@@A@@@@@A@@@@B@BA@@BA@@BA@@@A@A@@BA@@AA@@@A@@A@AA@@@@@A@@@A@@A@A@@@@A@A@@AAC@@A@@@@@AB@BABA@A@@@@@C@@@A@@@@@A@AB@@@@A@A@A@AB@@A@A@A@A@@AA@@B@BABA@A@A@A@@@A@AA@@@@A@@AA@@AA@AAA@@AAA@@@@@@A@@@A@@@AA@@A@@AAB@AA@@@AAA@@AA@AAA@AACCC@A@@@@AAA@@@@ACA@@@@@@@@BDDF@@BB@BBB@BABCB@BA@ABA@C@CAIBA@A@@BA@A@@@A@A@AB@A@@@@@@@@A@A@@@@AC@@@@@@@A@A@@@@@@AA@AA@@A@A@@AABA@A@@@@A@@A@@@A@@B@B@@@@AA@@@@@ADA@@@@@AA@@@@@@A@@BA@@@@@@@@A@A@ABA@AB@@ABCBAB@@A@A@@BA@@B@B@@@@A@@@@@C@AA@@B@BABC@A@@A@@@ABCB@BCB@@ABABABA@@BA@@@@@C@@@A@A@AB@@ABA@@@A@@A@@A@ABA@@@AAE@A@@@@@A@ABA@@@A@AB@B@@AB@@A@@B@@A@A@@@@@ABAB@@@@@A@@A@A@A@@@@@AAAAA@A@AA@@AA@@@A@@@@AA@@AAA@A@@@@@A@@AAAA@@AC@A@@AA@@@AB@@A@@@A@@@A@@@A@@@@@@@@AAAA@@AA@@@A@A@A@@@ABA@@@A@@@@B@@CBA@@@AB@@AB@BA@@B@BBBBB@BABA@@B@@AB@@@B@@@@ABA@ABA@@@@@ABA@AB@@@@A@@A@@A@@@AA@@A@@@AAA@@@A@@@A@@@@AAA@@@@@A@@@A@@A@@A@@@@@A@@A@@@A@@@C@@@A@@AA@@AA@@@@@AA@@@@A@@@A@@AA@@@@@@@AB@B@@A@@@@B@B@@A@@@@@A@A@@@@AA@@@@@@AC@A@@@A@@@@A@@@@@@@AA@A@@AA@@@@@A@C@@@AB@AC@@@A@@@AA@AA@@AA@@AAA@@@A@A@@@@@A@@@AB@DAB@B@@@@@@@@A@@@A@@@ABA@@@ABAB@@@@@@A@@@@@A@@BA@@A@@AA@@@@@@A@A@@@A@@@@AABA@@@A@A@A@@@A@ABC@@DA@@@ABABAB@BAB@@AA@BA@@@A@A@AAA@@@@B@@@FBB@@@@@@@A@@AA@@A@@@AB@@A@@AA@A@@A@@AA@AA@AAAA@@@@@@A@A@A@@@A@@@A@@BB@BBBB@@BB@@BBABADAB@@@AAEC@@@A@@A@A@@@AAAAC@@A@@@@@@@A@@@@@A@@A@AAABA@@@@AA@A@@@@@A@AAA@A@@@@AAA@@@@A@@@AD@BA@AB@BA@@@@AA@A@@@A@A@@BA@@@@BB@@@BB@@@DBDA@@B@@@@@@@BA@@AA@@@A@@B@@BB@@@B@@@B@B@B@BA@@B@@B@@B@BA@@B@BA@@@@BA@@@ABA@@BC@A@A@@@A@@BA@A@@@EAA@@A@@@@@A@@@@AA@@A@@@A@@@A@A@AB@@@@A@ABA@C@A@A@@@AB@@@B@@AB@@@@A@@@@@A@@BA@@@A@@@AAA@@@AB@@A@A@@@ABA@A@@@AB@@A@@@A@A@CB@@A@A@@@A@A@A@@@A@@@A@A@C@A@A@@AA@AAA@A@@@A@@BA@@BC@AB@@C@A@@BAAABA@@BB@@BA@AB@AAA@D@@@B@@A@@@@@@ACBA@AA@@@@AA@@ABA@A@@@A@@BA@@@@B@B@B@@@@@@A@A@@@A@@@AB@@B@@B@@@@@@ABB@@@AB@@@@@@@@@@@@@BA@ABA@@@A@@@@@@AA@@@@@BA@A@@B@@A@@A@A@@B@BA@@B@BA@@@@BAB@@@@A@AA@@A@@@A@ABA@AB@@@AA@@AA@A@A@A@@@CCA@@@A@AA@@@@@@@B@@@B@@A@@@ABAB@@BB@@@BCBAB@@BB@@A@@B@@A@@@@BA@@B@B@@@BA@@B@B@@@BAB@@@@AD@@@@@@@@BB@@@B@@@B@@BBBB@BBBA@BBD@@BBB@@B@AB@@@@AB@@@B@B@@@@@D@AA@AA@@A@A@@@@AABA@A@@AAB@@@BAB@@@BB@@@@B@@A@AB@@C@@@A@A@@@AAAA@@AB@@@@@@@@AB@@@@A@@@@BA@@BABA@@B@AA@@AA@AA@@A@@@@A@A@@AAA@@@AA@BAA@@A@AA@@@@AB@A@@@@AA@A@@A@@AA@A@@AA@@@@@C@@@@@A@A@@@A@A@@@AAA@@@A@@@A@A@@@@@@@A@@@ABA@@AA@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@BA@@@@@A@@@@BA@@@@BA@@@@@AA@@@@@A@@@@@@AA@@@@@A@@@@@A@@@AA@A@@AA@@@@A@@AA@@@AAAA@@A@@@A@@@@AC@@@BA@@@@BA@@B@B@@@B@@@BA@@DA@@B@@@BA@@BAAA@AB@B@@@B@@@B@@@BA@@@@@@BA@A@@@A@@@A@@BA@@@@@@BE@A@@@A@C@ABAAA@@@A@@D@BBBA@BB@@@B@@@@AB@@BB@@@B@@B@BB@@@BB@@@@B@@BB@B@@@@AB@@@@BB@@@ABB@@@@@@BB@@@@@@BB@@@B@@@@@B@@@@@B@@A@@@@@A@CB@AA@@@A@A@@A@@AA@@A@@@@@AA@@A@A@AB@@@BA@A@A@@BA@@AA@@@@AAC@@AAAA@@@AA@AAAACB@@@A@@@@AAAC@@E@A@AACCA@@@A@AB@A@@A@@@@@@@A@@A@@A@@@@@A@AA@@@@A@AA@@@@A@@BA@@@@B@@BB@@@B@BAD@@@B@B@@@@@B@B@B@B@B@B@BA@@BA@@B@@ABA@A@@@AA@@@AA@AA@AA@@AAAAAA@A@A@@@CAA@@A@A@@A@@A@AA@A@@AA@@@ABA@@@@@ABA@AA@@@@A@@@A@@A@@A@A@@@@@A@@AC@@A@@@A@@@@AAA@@@@@AAA@@@C@A@@A@@AA@@AA@@AA@@@@A@@AA@A@@AA@@AA@@@A@@@A@CB@@CAA@@@@AA@@A@@ABA@@@@@AB@@@BA@@@@@A@@@AAA@A@A@@@A@A@@B@@A@@BA@A@ABA@A@AAAB@AA@A@@BA@@@@@ABA@@A@@@@A@@BAAA@A@@@A@CB@@A@AB@@A@A@ADB@BB@B@B@DBBDJBBAB@B@BA@C@EBCFCFCFBB@BBBB@BDDDBB@D@B@@ABCDCBAFCDABCBABC@C@EBAD@DADAB@FADBDADAB@DAH@F@D@D@BABEJ@D@D@DDFBFDDJDD@B@F@B@B@BBBB@D@D@J@@@BFFDDBBBDBFBJ@B@FABA@CACAE@G@E@G@EBCDCBABG@CCCAA@CAE@ABEBCDABCBABABBFBDBDDDBBDBFBDBF@D@DBLDFDHBNFDBB@HDB@LDFBD@BDBDH@D@BBBB@BDDBFHFBBFBBBDBD@B@@BBBAB@DCF@DA@@BABCBGBG@G@MBCDADCFAHCH@FABEJ@D@BABA@ABCBC@CBCBAB@D@BBBHBD@B@BBAB@@@BADCD@D@BAB@D@DCFGPAF@BAD@F@FBDDDBD@D@F@FCP@D@BB@DBNBJDHFDBDDDDJHBBDBB@B@D@D@DBFBDFHFFBDBDBBDBHADDBB@BBLHHDJBBA@A@CBC@CBEBABAB@@BB@BDD@BBD@D@B@BABCBAB@D@B@FBD@HBB@B@@ABC@AAC@E@C@G@ABA@@D@FA@@@AB@@@@@@@D@LADAD@BABABA@ABADCD@FAB@BABA@CDAFCDAD@F@DAD@D@LBH@@@B@@ABABC@ABA@ABADAF@F@B@B@BBBBFDFHDDDDNHDDHBDBD@B@DADCBADAD@DAFEHCDCF@LADCDADCB@D@D@FA@@N@H@H@B@D@@@D@@AD@BCBCDAB@B@BBB@B@B@B@B@BCB@@ADADAD@FBD@D@DAFCBAB@D@B@D@H@F@B@B@BB@BBF@FDD@@B@HCD@B@DBDBB@B@DABABABC@E@CBC@AB@B@BBB@DBBBDDBBBFDHDHDLBDDJBH@BABABA@CBAB@BDHBFJL@BB@B@FAFCDAD@D@D@DBB@BBHF@@B@@@B@DAB@B@B@DB@BDBHFDBDDHHHHBBBFBB@BD@DBFBHBZHFBD@@@DADCDADAHEDABABA@GBEHIF@H@HBRFFBHBFDFBDFDDBBBBDBFBDADBFBB@DD@BBF@BB@FA@@BB@ABBBAB@B@B@B@B@DDFBB@@@@D@@BBB@@BDD@@DBB@BBB@@@@@D@D@@@@B@@@BB@@BB@@B@B@BBBD@@@B@B@B@B@@@@@B@D@@@B@@@@@@B@@@B@@@B@B@D@B@BB@@BBB@@@@B@@BBBBB@B@@A@@B@@@@@B@@ADA@@BB@@B@@B@B@B@@@@BBBB@@@@@HAB@@@B@D@BA@@@BB@BA@@B@BA@AB@@@B@@@D@BA@A@@B@@@B@B@BA@BB@@B@@BB@@B@B@@@B@FA@BB@B@B@B@@AB@@BBA@@@B@@B@@@B@@@B@@@B@BA@@@@D@@@DBB@@ABA@@BA@B@@BA@@B@@@D@@@BA@@B@BABAB@B@DAB@BAB@B@@@BBB@BB@@BBBB@@D@@@D@B@@@@BBB@@DBB@@@@B@BDAD@D@@@BD@@BB@B@@@D@B@B@@@BABA@@B@@@BBB@B@B@B@@@BB@@@B@B@@BB@@@BBBB@@@@@@B@B@@BB@BBBB@B@B@B@@@B@@@B@@D@B@@@@@BBB@BDBB@@@B@@BAD@@@BC@@BA@BBB@@BB@B@B@B@@BB@@B@@@@@@B@@B@B@@B@@DA@@B@@@@ABABA@@@@@ABA@@BA@ABA@C@A@@@@BA@@@A@@@A@AB@B@@@@@BAB@FE@@BA@@BAB@BA@@@@DA@@BBB@B@@@@@B@@B@@B@B@@A@@@A@@@@DA@AB@BB@@B@B@B@BA@@@@B@BA@@DDBB@@@B@@BBB@@BB@@B@@B@@@B@@@@@B@@@BA@@D@B@BBBBB@B@B@@@@BB@B@@BBBB@B@B@BB@@B@BB@@@BBBBD@@B@BB@@BBBB@BBB@@@@@@@B@@B@B@BBB@B@@@FA@AA@@AD@@@@@BA@@@@BB@@@B@BB@@@BBB@B@@@@A@AB@@AA@AA@@AA@A@@@A@A@@AA@@AA@@CA@@@@A@@@AA@@BA@A@@@@@A@@@@@@A@@BA@@@A@@AA@AB@AA@A@AA@@A@@@A@@AA@@A@A@@AAB@@A@@@AB@@ABA@@AA@@DADCAA@A@@@A@@B@@AA@B@@A@@@@@A@@A@@@@AB@@@AA@@@@AB@@A@@@A@A@AA@@@@BA@@A@@@BA@AB@B@@A@@B@BAB@@A@AAA@@C@AA@@@@@C@@@C@@@@FA@@BABABB@@D@@@B@BB@@D@B@B@@@@@@B@@BB@@B@@B@BB@@BB@B@BBBB@@B@@@@@@A@ABC@@@@BBB@@@AB@BABCBAB@@BBBB@@@@@@B@B@DA@@B@B@BAB@BA@B@@B@@@B@@@@@@A@A@@BABA@@BB@@@B@@AB@BAB@@@BA@BBDD@@B@D@@@@@BA@@AA@@@A@AB@BA@@B@B@BB@@B@@B@B@@B@@@BB@@BB@@@@B@@B@@B@@@@AB@B@@@@@B@BAB@@@@@BAB@BA@@BA@@@@B@@@B@@@D@@AB@@CB@@@BAB@@AFAJAB@@@DA@@B@BAB@B@BABA@@BA@AB@BADA@@@@B@@@BBB@@@BBBB@BBB@BBB@@B@B@@@DBBB@@BB@BDAD@@@BAAA@@@A@@H@F@B@BAFC@@@AB@DCB@@@B@DA@AB@@@@@@A@@@@A@AAA@CCA@@@@@BA@ABA@A@A@@B@B@B@@@@@B@B@@A@@@A@@@@BA@A@@B@@@@@@B@@B@@@@A@@@@BB@@@@@@@B@@@@@@B@@@@@B@@B@@BAA@@@@A@@@AB@@@B@@@B@B@@BBF@@@@B@@BB@@@BCBABABABBB@@CB@@@BA@@B@@@AAA@AA@@@@@AB@@@@A@@B@@@BB@@@AAEB@D@@A@@B@BA@A@@@@BA@A@@@@B@@@B@B@BA@@B@@@@AB@@AB@@@DA@@@A@A@@BA@@@A@@@@AA@@AA@@BA@A@@@A@A@@@@AA@@AABA@@@@@AA@@@@@B@@@@@@A@@A@AB@A@@@@B@BA@@BA@@B@@BBAB@@@@@B@@BB@@@@@@@BB@@@@@BB@BABBBA@@D@@@D@@@BAA@@@BA@@@A@@@AA@@@@@@A@@@@@A@@@AA@@B@@AB@A@@BA@@@A@@A@AB@@@B@B@@@B@@@A@@@A@@@A@@@C@@A@@@C@@@@@@BA@@@@BBB@B@@@@@@@BB@@B@@@@@@@@@@AB@@@A@A@@@AACAA@A@@@A@A@@@@ABA@@@@@@A@@AB@@@@BB@BA@BB@@@@A@@A@@@@AB@@@@@@@@A@@@@@@@B@@A@@B@AA@@@@@@@@A@@@@@B@AA@@@@A@@AA@AAA@@@B@@AH@@BD@D@B@B@@@BB@@D@@@@@B@@B@@@@B@@@@AB@@@@BB@@@B@B@@ACA@@@A@@@@@@@@@A@A@@@A@A@@@@CAAAAA@A@AB@@A@@@@BG@@B@@@@@AA@@A@@C@@@A@@B@@@AAAA@AB@@@@AA@@A@A@@AA@@AA@@@A@@@AA@BA@@@AAC@@BA@@@AB@@@B@@AB@@@B@B@@@B@@@BABB@AB@BBB@@BB@@BBBB@BDB@@@DBB@@@BB@@BBB@BBBB@@BBB@B@B@B@B@@@B@B@D@@@B@@@@@BAA@BAAA@@@@@A@A@@@A@@A@@A@@@A@@B@@A@@@AD@@@@@BA@@D@@@AA@@@@@@A@@@@A@@@@@A@AA@@A@@@A@@@@@@@A@@A@@@A@@AC@@@@@A@@@A@@@A@@@@AA@@@@@A@A@@@A@@A@@@A@@@@BAB@@A@@@@@@@AB@@@@AAA@@@AB@BA@AAA@A@@A@@B@@@@A@@@AA@AB@@ABA@@A@B@@A@@AAB@B@BAB@B@DB@@BA@@@@BABBB@@@DB@@B@@BB@D@@@B@B@@AA@@@B@@@B@@ABAB@@@@@@@@@@B@@BB@@@B@B@BB@@@D@@@@BB@@@@AB@B@@@BA@@B@BB@@@AB@@@@@B@BB@@B@@@B@B@@A@@@A@@@@@AB@@@B@@ABAA@@@@@@@A@@A@@B@AA@C@@@@@@AAA@@AAC@ADA@@@@@@@A@A@A@@@AB@DA@A@@@@@AAG@A@@AA@@AA@@@@B@@A@@@@AA@ABAAAB@AA@@@C@@AA@@A@@A@A@@@@@@A@@@A@AAA@@@@A@A@A@@B@@@A@@@A@@@@A@@AA@AA@AA@@A@BAA@@AAA@@@@AA@@@@A@A@@A@@AA@@AA@@C@@@@@A@A@@A@@@AB@@A@@@@@ABAAA@@AA@@@CAA@@B@@A@AAA@AA@@@@A@@@@AA@@AA@@CCAA@@A@@A@@@@B@@@@@AA@@@@BADA@@B@@A@CB@@@@A@@@@BA@@BA@@@@@@B@@@B@DA@@BB@@@AB@ACA@@C@@D@@@BC@@B@@@B@@A@@@A@A@@@A@@@AB@@A@AB@@A@@@AAAA@@A@@AAA@@@C@AA@@A@@@@A@@AA@A@@@AA@A@AAA@@@@AA@@@@@@A@@A@@A@AA@@@@A@@@AA@@@AAA@A@@AAB@AA@@@@AA@@@@A@@A@AABABA@@@A@A@@BA@A@ABA@@@A@A@AAA@@@@@AA@AA@@@@@@A@@A@AA@@A@@@A@@@A@@@@@AA@@@B@@@@A@@@AA@@A@BAAA@A@@AABA@AA@@A@A@@@AA@@A@@@A@@@CA@@@@A@@A@A@A@@@@AA@@A@@AA@AAA@A@@@@@A@@A@@@AAA@@@@@A@A@@A@@AA@@AA@@@@AB@@ABA@@@AA@@@BA@@AAA@@@@@AA@@A@@@AA@@C@@@A@A@@@@@AA@@@A@@@@C@AAA@AA@@BA@A@@AAB@@C@@@@@AA@@@@@@A@AA@@@A@A@@@A@@@ABA@A@@@@@AA@A@@AA@@@@@C@@@A@A@@@A@@AA@@@A@A@@A@@A@@@@BA@@@@@A@@@@@A@@@A@@@@@@C@@@AA@BAA@BA@AAA@@@A@AA@@@@AB@B@@A@AAA@@@@@A@@BB@@@BB@@@B@@@@A@@B@@AAA@AC@@@AA@@B@@AAA@A@@@@AA@@BA@@@@B@BA@A@@B@@A@@B@@A@@@A@A@@B@@ABA@@@A@@@@@@B@BADA@AAAB@@CA@A@A@@@AA@A@@@@@@@@AEAB@@@BA@A@@E@@@AB@@A@AA@@A@@@A@A@C@ABAA@@AAA@@A@@A@C@CA@BA@@@C@@@@B@@C@AA@@CD@@A@@BABBB@@AB@@AB@@A@A@AAA@A@A@A@@@ABA@@B@BAB@B@@@B@B@@@@AB@BA@A@@@A@@A@@@@A@@@CBA@@@CBA@ABA@AAA@@B@@A@@B@@A@@B@B@@CB@B@@CB@@ABABAB@@@@A@@@@B@@B@@B@@@@A@@B@@A@@BA@@B@@A@@@@B@B@B@@ABA@@B@@@@A@@@@@A@AB@@A@A@ABA@A@AD@@AB"],encodeOffsets:[[122535,30846]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
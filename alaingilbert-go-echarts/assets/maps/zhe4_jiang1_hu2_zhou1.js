@@ -0,0 +1,2 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("湖州",{type:"FeatureCollection",features:[{type:"Feature",id:"330502",properties:{name:"吴兴区",cp:[120.185838,30.857151],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@AA@A@A@@A@@A@@AA@@@A@@BA@@@@@AA@@A@@@@@A@@@@@A@@@AA@@AA@A@@AC@A@@@@E@@@AB@@A@@@@@AA@@@@@A@@@@BA@@@A@@@@A@AA@@@A@@@AA@@AB@@A@@@A@@B@@C@A@@@@@@BA@@@@@ABC@@@@BAB@@@@A@@@A@@@@@A@A@A@@@@B@@ABA@A@@B@@A@AAA@@@@@A@A@@@AA@@@B@@@BA@@@A@@@A@@@A@AA@@AA@@AB@@A@A@@B@BA@CB@BBBA@@BC@@B@@@BAB@@@@@B@@@B@@AB@@A@ABA@A@@@A@@A@@ABAB@@@B@@A@A@C@@@@@A@AB@@A@C@@@A@A@@@@@A@@A@@@A@@BA@@A@@AA@@@@AA@@@A@@@AA@AA@@A@@AAAAAA@@@@@A@@A@AAA@@A@@@@A@@@@@A@AACA@@AA@@A@@@AAAA@A@A@@AAAA@@C@@@@BA@A@A@C@@@A@@A@@A@@@@@A@A@AA@D@@A@@B@@@BA@@@A@ABAACAABA@@@A@@AA@@@A@A@A@@@@@@@A@A@@@C@@@A@@@@@@@@B@B@@@B@B@@B@@@B@BBB@@B@@D@@BB@B@@B@@@@@BB@@B@B@@B@@@B@@AB@@@@@@BB@@@@@AB@@BBB@@@@BBD@@B@@@B@@@B@@BD@@@B@@@BBBB@B@@BBB@@BB@@@ABB@BB@@DBB@@BB@BBBBB@@BBB@@@@AF@@@B@@ABAB@@ABAB@@BBA@@DCB@@@B@@A@AAA@@AAB@@@B@@CB@@@B@@@@@@@B@@@@A@A@A@A@@@A@@@@@A@@B@@AB@@@@CBA@A@@@A@A@C@A@@@A@@@@BA@@BAA@BA@A@@BA@ABA@@@@B@@@B@@@@@BAB@BA@A@AB@@A@A@A@@BA@@@@@@B@B@@@@@@A@AB@@AB@B@BABC@@BB@@BB@@B@@@B@@@BB@BB@@@B@@A@C@A@A@@@@B@@AB@@@AA@A@A@@@A@@BA@@@AB@@@B@@@@@@@@@BB@@B@@@@B@@BB@@BB@BB@B@@B@@@B@@BB@@@@B@B@@BB@@@BB@@B@B@B@@@BBB@B@BD@@AB@@B@@BB@@B@@@DB@BB@BB@B@@BBDB@BB@@B@B@BBBBF@B@@AA@B@@@BADBB@AB@BBB@@@@B@@@@B@@@B@@@B@B@B@@BBABB@@@@@AAA@@@ABA@AB@@@B@B@@A@@@AB@@@B@B@@@@@B@@AB@@AB@BA@@BA@@D@B@@@@@@@B@@B@@B@@@@B@@@@@BB@@B@@B@BB@@@@@B@@B@F@BBBB@BB@@B@@B@@BBABBBB@@B@BA@@@BB@@BB@BBB@@@B@B@B@@ABBBB@@BB@@@@B@@@BB@@@BA@B@@@@B@B@@@B@B@B@@AB@@@D@@@DBB@@@@@@B@B@B@B@@@@@@@ABB@@@BB@@@@BB@@@@BBB@@@@@D@@@@BB@@B@@B@@@B@@@@B@@BB@@@@@B@@@B@@@B@B@@@B@@A@@B@@@B@B@@@B@@AB@@A@A@A@BBABB@@DA@@@@@C@A@A@@A@@AA@@@@A@AB@@BB@@AB@@ABA@A@ABA@@@@BA@B@@BB@BB@@@B@@@BAB@@@B@@BB@@@@@BBB@@@B@BB@@@@B@@@BA@@@AB@B@B@B@@@B@@@@@B@@@BBB@@@@BB@@@@B@@@@@DBB@@@BBBBBD@@@@@B@B@@@B@@@@A@@B@@@B@@BBB@@@@B@@@BAB@@BBA@A@@@@B@@@BB@@@B@BB@@@B@BB@@BB@@@@@B@@@HB@@@@@B@@AB@B@@BB@B@@@BB@@B@B@@BBA@@B@@@@@B@BA@A@@B@@@@@@@B@@@@@@A@@@B@@BA@@@@@@@@BBB@@AB@B@B@@@BCD@@@B@@@B@B@B@@@@@B@@@BDD@B@@@@@B@B@@EB@@@@C@@FAB@@A@CBAB@@@BFBB@@@@@BA@@BB@@@@A@@BA@@@@B@@@@A@@@A@BF@B@D@@@@F@@@@@@@B@@BB@@BABD@@@B@@B@@@BBB@BC@@@@@@@B@@B@@@@@@A@A@@@@B@@@@@@@B@@@@A@@B@@@@@B@@A@@BA@DBABB@@B@@@@@B@@AA@@@BA@@@@@@AA@@@@@@@@B@@@@@AAB@AA@@BBB@B@@BBA@B@BF@BBDBB@BB@@B@BBB@@@ABAB@BAFC@@@HBH@@B@@@BB@B@@B@B@@@@@BB@B@@BB@@@B@@@B@@B@B@@@@B@B@B@BA@@@@BBD@B@BBD@B@@EN@@B@B@BBBB@@BBBA@@B@@@BB@@@@B@B@B@B@D@BB@@BA@@BA@@BAB@B@B@@@B@B@F@B@BA@ADAB@@ABA@@B@B@@@@A@@B@@@@@@@BBBB@@B@@B@@B@@@B@@@B@B@@@B@@@@B@BA@@@@B@@ADB@@BB@B@B@DB@@@@@AB@B@FA@@B@D@@@B@@@@@BD@@BB@@@BB@@@B@@BB@B@BD@@@BBB@BB@BC@AB@@@BABAB@BADAB@B@@@@@@@@B@B@BB@DB@@B@@@BBB@B@@B@@B@DCD@@@B@@@B@BB@@BBD@BB@@D@@BB@BB@@@@BB@@FB@@@@BB@@BB@@B@@B@@B@@BB@B@@@D@@BD@B@@@B@@@@@B@D@@BB@BB@@B@@@BB@@BBBA@@B@B@@BB@BBB@@@@@@@BBBB@@@B@@@@B@@@@BB@@@BB@@B@@@B@@@BBBB@@@BFD@AB@@B@B@@BBBADC@@BABADA@@BAJEJEHEB@BADA@@@ADAB@FBB@D@B@@@@AB@FA@@BA@@@A@@FCRAF@LANAD@D@VCHAFAB@@ADA@@B@FBB@BBD@BAD@B@B@B@LABA@@D@B@DAD@H@D@B@D@BABBJ@@@D@H@B@@@@@B@B@B@B@B@B@D@D@PDJ@H@@@F@DBB@D@B@D@B@BBF@F@D@B@HBHBH@NBBBNBRFJ@JDD@B@@@FBB@BBDBB@D@HBBBDB@@B@B@BBB@B@FBB@B@BB@A@A@A@AAACCGGGGAA@A@@@CBCDG@A@CA@CAAAAAAK@C@C@@BCBC@A@A@EBG@EAA@@@@@@A@@@A@CAC@CAE@@@@D@BB@AB@@CAG@@@@C@C@A@E@C@A@A@AB@@ABC@@@@@@BAA@@A@A@@@@B@@@@A@@A@@A@@@A@@CA@C@A@@@@C@AA@BA@C@@@@@@@C@@@@BA@C@@@@@@DA@@@@@A@A@ABAA@@@ABABKDGBE@AGAAAGAC@GCE@@@AAGEEACAAAA@GC@AC@ECC@CAC@IAA@C@CBCBA@C@CAEAIACAC@C@CACAC@C@C@@@C@MBQ@C@G@E@CA@@@@@@@AB@BAB@@@BC@AA@@ACCCC@C@@@@BCD@@A@@@AA@@A@@@CB@FB@A@@@AB@@@@C@A@@@@AAA@EA@@@A@@@@AAA@@A@@BCE@@@@@C@A@A@CAA@A@@@A@@@CBA@@B@@@@@B@@A@A@AB@@EECE@@AC@A@@C@@@@B@BC@@@AB@A@@A@@E@@CBA@ABIDA@ABE@GFA@EDCDCB@@A@@@AA@@@CA@@@AA@B@@@AAB@@A@A@A@AA@@@@A@@@@@C@C@@@@A@@@A@@@AA@AAA@@DADAB@DA@@BA@@@A@AA@@@BA@@D@@@B@@@@@@@B@@A@@BA@AB@@@AA@@@A@A@@AG@CA@A@A@A@@@AA@E@@K@A@@BAB@@@B@@AB@BA@@EGAAAAA@@AA@A@A@@AAAAAACCEGKMQGIEGGMGICE@AACACE@ABC@@@A@BC@@BABA@@AE@A@A@@AAAA@@@A@@BA@@ACCEA@AAAAAA@A@AAAAA@A@C@C@A@@@@DABA@@BDDA@@FAFB@@@@@C@A@A@@@@DB@@@D@@D@@@D@@@@AB@F@J@B@H@FAHAD@BAF@FAB@@@AC@@@C@C@AB@D@D@HAB@D@D@H@B@@@FCFCHE@@A@CAA@@@@C@G@C@@BAB@@@@A@A@A@A@AB@BAB@B@B@F@@@@@@A@@ACAA@AA@AAA@@G@AB@@@@@@@@BB@B@@A@@B@@@@B@@@BB@@@BAB@@B@@D@@@@B@@BBB@D@B@B@@@B@B@BA@@@AB@@@@@@@BABBDACEAA@CAC@CAE@@@@C@A@@A@@EA@@A@E@@@@@@B@@@@G@C@@@A@A@@@@B@@@@A@A@A@@@@@BC@@@@C@@A@BAB@@A@@BB@@B@@@BE@ABA@@@@@@A@A@AA@@@A@@@ABA@@C@@A@A@C@A@A@@@@AA@A@@A@@C@@A@@@@@@@@@@@EA@BAAABA@AA@@@@@A@@@A@@@@H@@A@@@C@C@A@@C@C@@@@C@A@@@C@A@A@A@@@@@A@@A@@A@@@B@@A@@@@@@A@A@@A@@AB@@A@A@@@ABEBC@C@EBA@@@AB@B@@@DAB@BB@AB@D@@@@BB@@@@@@@BA@@@@B@@@B@@A@@@A@G@@@C@C@A@AAA@AAA@@@@@CA@@@@@A@@A@CA@AA@ACAA@A@@AA@@AA@@@@A@@AC@A@@@A@C@@@@@@A@@AAA@ABAA@@A@AB@@AAA@@A@AA@@AA@@@AA@@A@C@@@A@A@@BA@A@ABA@@@A@A@ABAAA@A@@@@@A@AAA@@AB@AA@@BA@@AAAA@@@A@@@A@@AAA@@@A@EB@@@@AB@@AB@@EBA@A@@AA@A@"],encodeOffsets:[[122895,31368]]}},{type:"Feature",id:"330503",properties:{name:"南浔区",cp:[120.418513,30.849689],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BB@@D@B@@A@A@@BA@A@@@A@@@A@A@@F@@A@AD@@@@A@AF@B@@@@A@@@A@@AA@@@ABA@A@@@@AAA@A@C@A@@@@A@@@A@@@@IDA@CBA@KDIDA@CB@@A@IBE@A@K@@B@@@B@@B@@@@BABB@@BA@@B@@@@@@@BB@@@AB@@@@@@A@@B@@A@A@ABA@@@AAABA@@@@AA@A@AA@@@@A@@@@A@AAA@@AA@@@A@@CBA@ABCB@BA@AD@@@@@B@@@@B@@@B@@@@BA@@@@B@@@B@BBD@@@@B@@B@DBB@B@BBB@B@B@@@H@BB@@@B@B@B@@@@@BBBB@BBB@@A@@BB@@@@@BB@@@@@@AB@@@@@@@@BB@@AB@@A@EBA@C@@@@@@C@@E@@@@B@@@@A@A@A@@B@@@@CBA@@@A@C@A@EBA@KBA@A@ABE@@@C@CBAAGEAAAAAA@@@EACAAACCBAAAB@@@@@@A@@B@@ABA@A@@@A@A@C@A@AA@@@A@@C@@@@AA@AB@@A@@A@@@A@@A@@@@BA@A@@A@@@@@@A@@B@HB@BBB@@BBBBD@@@B@@@@E@A@A@A@ABA@@B@B@B@B@B@@A@AB@@@D@H@D@@B@DBB@@@GFEDED@@A@G@C@C@A@GBC@C@A@@B@D@D@@BD@@A@EBE@ABC@GBEBG@A@I@E@A@@B@@C@@@C@@@@C@@CA@@@@@B@B@D@@@@EAEB@@CBAC@@ABCB@@@@@B@D@D@BBBBB@B@BBBBBBBB@DFBD@@AB@@@B@@BBBB@@@B@BBF@@ABAB@@ADB@@@D@BAF@BDBD@BDFHJHNFHHJNRHLDFBDBBBB@BB@B@B@@BB@BBBBFH@@ABA@@BA@@@A@AB@@@B@LF@B@@B@@@B@B@BDBH@@BB@B@@@B@@B@@BAB@@AB@@@@A@@@@@@@A@@@CB@@A@@BBB@@@B@@AB@@CBABC@CB@BBB@@B@@@B@@@B@@D@D@@@@@B@@@@@BBB@B@B@@@BA@B@@@ABB@@B@@D@@BB@@B@@@DADCFCB@HEF@BAB@JCBAB@DA@@@FB@@@@BBA@@D@@A@A@@D@@@@BBD@@DFFF@@BAB@B@@@@A@@@@@AB@DA@@B@@@B@B@DBB@B@D@@@@@F@AD@@@BB@BB@@@@@B@@FBB@BB@@@@@B@D@@A@@B@@@BEAA@@D@@@BB@@B@@@BC@AD@@@@@DDDDD@BB@@BAD@@A@ABA@@B@@@@@@DBF@H@D@R@NAD@@@D@D@D@DBDBD@D@DBJBFBDBD@B@DADAD@B@JBD@DBD@FDD@@BHDB@BBDBFBHFBB@@F@HDD@HBBBHB@BAFCHALAB@B@@BBBAB@B@@@@@B@@C@@@@D@B@@A@@D@@@@@@@D@B@@ABBD@@@@@@B@DDB@@@B@@@BB@@@@B@@A@@@@@@B@BB@AB@@@@@@AD@BA@@B@B@B@D@F@B@D@D@@H@DB@@BAA@@A@C@@F@DBD@DBB@@@B@@@@@@@A@BEA@@A@AFEDADEB@ND@D@BJ@BHBDB@H@F@B@D@F@D@RDBBLBD@BDB@@@FDB@BBJBCLCPAFAD@BAHBBDDBBBAB@BARGDCBCBCBC@A@AAABGBC@AFC@C@AAACAA@CAAC@E@EAABCD@FA@ABAB@BEDEDEDAD@DC@@BCACCA@@EAGE@ACAAC@A@@@CBADCFADAH@BAB@BG@CDC@@DABA@EBQAC@AGA@@AAAA@A@EBCBCDAF@BCDGDAFAD@F@BC@@@@BABE@A@AA@@A@C@E@ABI@ABABC@ADEFKFEBC@ABC@A@@@@A@C@A@AA@@A@A@A@ADC@E@A@A@C@AA@@BCBC@ABABC@A@@A@@@@@@@A@@A@@@@@A@@B@B@@A@@@@@@A@@A@A@A@@@AA@@A@@@AB@@@B@@A@@@@@@B@ACBE@@D@@@@BB@B@@@@A@A@@B@@A@@@@@A@@BA@@@ABA@A@@B@@@D@@A@@@A@@@A@@A@@AB@@@DA@@@AAA@@CGEGCEAE@@@@B@@A@@@@@A@@A@@AAB@@@@A@@@AAB@B@@@@@A@@AA@A@EEGGAA@@AB@@C@@@@@AAAA@@@B@@AA@@@@@B@B@B@@@AA@@B@@@@A@@A@BAA@@@@@@@A@@CA@@@AA@@@B@@B@@@@@@B@@BA@@@@@@@A@A@C@@@@@@@A@A@@@@@@@E@@DC@@@@A@A@@A@A@@@@A@A@@@@@A@@A@@@@AB@A@@@@@B@@@@@@ABA@@@@@AA@@@@@@@@@@@A@A@@B@@@@@C@ABA@@@@BB@@BA@@@B@@@@@@BBB@@AB@@@A@@AB@@@@@@@@ABA@@@B@@@AB@@@@@B@@@@A@@@@@@BAB@BAB@@@DA@A@ACE@@C@BB@@AB@@A@@A@AB@B@@ACB@@A@CBC@@@BC@@@@@@@AA@@@A@BBA@@@@A@@@@A@@@B@@BA@A@@@@@@@AA@B@@@@@@@@@@A@@BA@@B@@@@@@A@@@@@@@@@@@A@@A@@A@@@@@@AA@@@@BA@@@@@@A@B@@@AA@@@A@A@A@@BAAC@A@AB@@A@@AAAC@@@AAC@@AA@A@A@C@GACB@@@@AC@AACAC@@AB@@@@C@@@@@@@CBA@@@C@@@@@@@@@A@@@@AC@E@@@A@@@@AA@C@ABA@AAA@@@AB@@@A@@@@@@@@@B@@AA@@@@EA@A@@@@@A@@@@@C@A@@@AB@@A@CAA@AAC@@C@EBA@A@A@@@@@AA@@@@@@A@A@@@@A@@@@A@@@@@B@@BA@A@@A@@A@B@@A@@@@B@A@@AC@@BCB@@@@@DA@@B@@@@A@@@@@A@@@@@@BA@@B@@BB@@A@@@@@A@C@@D@@C@AD@@AA@A@A@@AB@@@AA@@E@@@@@@@A@@@BA@@@@A@AA@@B@@@@@A@@@@@@@BAA@@@@@@@@@@A@@B@@@@AACBAB@@A@@@@C@@B@AACB@C@@B@@@D@@CB@@@B@AEB@B@@C@@@A@@@A@CA@A@@@A@C@@BC@A@A@@@BB@B@BA@C@A@AAA@@@CBCDABA@@EA@@C@A@AAEEB@@@AAA@@A@@@A@@AB@@@AA@BA@@@@B@@@@@@C@A@@@@@A@@@A@@@@D@@B@@@@BA@@@@AA@@B@@@D@AA@@@@@@@A@@B@@@@A@@@A@A@C@@@A@B@@BFFDBB@@BBF@@A@A@@@@@@B@@@@@B@@C@@A@@A@@@A@@@A@@@@B@@@B@@@@@BB@@@A@@B@@@@@@A@@B@@HB@B@@BB@B@F@D@BBB@@C@AB@@@B@BA@@@A@@A@C@AA@EB@AAC@@A@CBA@A@@@@@@A@@B@AC@@ACA@@@@@@@BA@AB@@AB@AAAA@@A@@@@AAAA@@@AA@@@@ABA@B@@BE@C@A@@@@A@@@A@@@A@@@A@@C@@@@@@A@A@A@@@CBC@A@@@A@A@@C@A@E@@@@@@BAB@D@@A@A@A@@BA@@@ABA@A@@@@BA@@@@@@@@AA@C@@BC@A@@A@@@@@@A@@@@A@@@AB@@AB@@A@@@A@A@@"],encodeOffsets:[[123148,31381]]}},{type:"Feature",id:"330521",properties:{name:"德清县",cp:[119.9774,30.54251],childNum:1},geometry:{type:"Polygon",coordinates:["@@BA@@BCAA@EAAAAAA@AA@A@AA@@@@BC@@BABA@@@A@@@@CAC@A@E@@@C@ECE@E@C@C@EACAA@AB@@@B@B@@@@@BB@B@B@BB@B@B@B@B@BA@@@A@C@@@ABA@@@A@AB@@A@A@@@@@@B@BEAABAAAA@A@@A@A@A@A@A@@@A@A@A@A@A@@@A@ABA@A@@@A@@B@B@BA@ABA@B@AB@@@BC@@BA@AB@@ABA@@@AA@@AA@@@@AB@@C@A@@@A@@@A@A@@@ABA@A@A@@@C@@@A@C@@@@@@A@@@A@A@@A@AAA@@@AA@@@@C@@@AA@@A@@@@A@@@AA@A@@@@A@@@@@AAA@@@C@AA@AAA@ABA@@@AA@AA@AA@@AB@B@BCB@@@AA@@@A@@BCDA@AA@@A@AB@B@B@@@B@@@B@@@BA@@BAB@@BB@D@BB@@@ABCB@@A@@@@BAB@@@@BB@@A@A@@AA@@AA@@@AAACA@@@@A@@A@@@AAA@A@@BA@@@A@@@AB@B@@A@@@A@@BB@@@AB@B@@AB@@ABA@@@B@@B@@@@C@ABA@A@A@A@AB@BA@@B@@@B@@AB@@@@@B@@A@@@@BA@@@@@@@ABCA@@A@@AAA@@@@C@@@@A@@@A@@A@@AA@@@A@A@AB@@CBABAB@@C@A@AAAA@@A@@A@@A@ABA@@@A@A@C@A@@@A@@@A@@BC@@BA@@@A@A@@@A@@@A@A@AAABA@@@A@A@A@AB@@@@@B@@AB@B@B@@BB@@@B@B@@AB@B@B@@B@BBB@@B@B@@D@B@B@@@@@@@@B@@A@@BAB@@@@A@A@A@@BA@@@@@@@A@@@A@@@@AA@C@@@AB@@A@ABA@A@@@@B@@@@BB@B@@BB@@@DA@ABA@@@@@ABAB@@@@AB@@A@@@ABA@@B@B@@AB@@@@AB@@@@@B@@ABAB@BA@@@@@@BA@AB@@@@A@@A@@B@@@@A@@@AA@A@@BA@@@A@A@@@C@@@@@A@AA@@@BAAABA@@@A@AB@@AAA@@BAB@@AB@@AB@@A@@@AB@@@D@@@@AB@@A@@BA@A@@BA@@BA@ABA@A@@B@BA@A@@@CBAA@@A@CA@@AA@@AA@AA@A@CBA@@ACAA@@@A@@AA@@@A@@@@@@B@@@@A@@@A@CA@B@@A@@@A@ABA@@@A@@AA@@@@AA@@@ABA@@@@@@B@@AB@@ADABA@@@A@@BC@AB@@@AA@A@@@A@@@AB@@A@A@ABA@A@@B@@A@A@@@A@@B@@@@A@A@A@A@@@A@@BA@A@@@@@AB@B@@BB@@B@@B@@B@B@@@BB@BB@B@@@B@@@@B@@BBB@@@@B@@B@@B@D@@@@@BAB@@B@@@AB@@A@@B@B@@A@ABABB@AB@B@@BB@B@BA@@B@@@B@@D@BB@@@@@@BBBB@B@@@@@B@B@B@B@@@BB@BAB@BB@@B@@@@@B@B@@@@@B@@@B@B@@@@BB@BBD@@A@@BAB@@@B@BA@@B@B@@@B@@@BAB@@A@@BB@B@@B@@@BBDBBB@@@B@B@@B@B@@@@@BA@@B@@@D@@@DA@@B@@B@@BAB@BB@BB@A@@BA@@B@B@@B@@BB@@BD@@@AB@@@@A@AAAB@BA@@@A@@@@@AB@@@@A@@@AB@@A@@@AB@@@B@@@@@@AB@@@BA@@@AB@@@@A@@@@@@@A@@BAB@@@AC@@BA@@@@@A@A@@@@B@@@BA@@B@@@BA@@B@@B@@@@BB@@@B@@@@@BB@@BAB@@@@B@@BA@@@@@@D@B@@@B@BDB@@@BB@B@@@@@BA@@@@@@B@@@BBB@@BB@@AB@@BBB@@B@B@@@B@@@@BB@@@BA@@B@@A@@@A@AB@@@BA@@B@@ABAB@@@B@B@BA@@@ABA@A@A@@@AB@@@@AB@@A@@BAB@@B@@BB@@@@D@@@@@B@@@@@B@@B@@B@@@B@@@@@@@BB@@BB@@B@BBB@@@@BB@@B@BB@BB@@@@AB@@BBB@@AB@B@@@@B@BB@@@@@B@@@@@BB@@B@@AB@@AB@@@BBB@B@@BB@BB@@DBB@B@@@B@BA@@@A@@@AB@BC@@@BBBBB@@BD@@@@BB@@@B@@@@A@@@B@A@@BAA@@@@@@@B@B@BB@@ABB@BB@@B@@@@@B@BABAAAAA@@F@B@@A@@@A@AB@@@B@B@B@@B@BB@D@BBB@@B@B@@@B@@B@@BB@@B@@B@BA@@@BB@@@BB@@B@BB@@BB@@@@B@@B@D@@@BBB@@@@@@@@E@A@@@A@@@CBAB@@@@@B@@B@@@B@@AB@@B@@@@B@B@A@@@A@@@@@BD@@@BBBBB@@DDBB@@@D@@@@@B@@@@ABA@@@@BA@@@@B@@A@@@@B@@BB@@@@@BA@@@A@@@@@ABBB@@AB@@@B@@B@@@B@@@B@@@B@@@BBB@@AB@@@@BBB@B@@@@ABCFBB@B@@BB@@B@B@@@@@BA@@B@@@@@BD@B@@@@@@@BA@A@@@@@@BAB@@@@BBB@@@B@@@DBB@@@B@@@B@@AB@@@B@@@B@@B@AB@B@B@@@BA@@B@@AB@@@B@@AB@@@@AB@B@@@B@@@@@@A@@BA@@B@@AB@@@B@B@B@@B@@BA@@@A@@B@B@D@B@@@@A@@AAA@@A@@@A@@@AA@@AA@@AD@BA@A@ABA@@BAB@@@@@@@@A@A@@@@B@@AB@B@B@@@BAB@B@@ABA@A@@@@@A@@@A@@B@BAB@@AB@B@@ABB@AB@@A@@B@@@B@D@B@B@@@B@B@DA@@@@BA@@@AB@@@@@B@@@B@B@B@B@@@@@@A@@@@@@@A@@DA@@@A@@BA@BB@BBB@@@@A@@DA@CB@AA@@BABA@@BABA@@@A@@BE@@@@AA@AA@AAAAA@@AA@CA@@AAA@BA@@A@@AA@AA@@@AAAAA@@A@@@C@@AA@@@A@@@A@@@AC@A@@A@AA@@BA@@@@A@@A@@@@A@@BA@@@A@@@@A@AA@@A@@@@@AA@A@@AC@@@@AA@AAA@@@A@@@@A@A@@@A@A@@@@@@B@@@D@@@B@B@@@@@@@B@B@B@@@B@@BB@@@B@BADBBBBAB@@@B@@A@@@AB@@@@CBBB@B@@@@@B@@@@BB@@@D@B@B@B@@A@@D@@@BBBB@@@B@BBBBB@@B@@@BB@@DBBBB@@@@@B@@@@@@BB@BBB@@@@B@@@@BBBBBB@@@BB@@BBB@@B@@@B@@B@@B@@BB@@@AB@@@B@@@BB@@@@@B@B@@@D@B@@@BAB@@@@@D@B@B@@@@A@@BABA@@@BB@@@B@B@BAB@@@BA@@@A@@@A@@@@BA@A@@@AD@@AB@AA@ADAB@@A@AB@B@@@BA@@BB@@BBB@@@B@@@B@@@B@@A@@@A@@BB@@B@B@@@@@B@BBB@@@@AB@B@BA@@@A@@B@B@B@@@@@B@@@B@@@@@BA@A@@D@BA@@@@B@@A@@@@B@D@@@@AB@@@B@@@BA@@BB@@B@@@B@@B@B@@B@@@B@@A@@B@@@@@B@@B@@@@@BA@@B@@@F@@B@D@@BB@B@@BB@@B@@@B@@@@@B@@@@@BB@@B@@@@AB@@@B@@BB@@@BB@@@@B@BBBB@@@B@B@@BB@B@FA@@BA@@BA@@@@FAB@@@B@BB@@@B@@@B@@BBBB@@AB@@BBA@@BB@BBB@@@@@B@B@BBBAB@B@@@B@BAB@B@@AB@B@@@D@B@@@BB@@B@@BB@@B@BB@BB@@BAB@@@BBBAB@BB@@@B@@@@D@B@@@B@D@@BB@@@@@BB@@BB@@@BBBBDB@@BDBB@@@@B@@@@DB@@@@B@BBB@BBB@D@D@@@H@B@@@B@@@@A@@@A@@B@@A@@@@@@AA@@@@@CBAA@@ABA@C@@@ABA@@B@FAD@D@FABA@@B@B@@@BA@@@BB@B@@@@@@@@BA@@@B@@@@BB@@@@@B@B@B@D@@@B@D@@@@@@D@DB@D@D@@@B@@@@G@@B@@@B@@@@@B@@BABBBABB@@F@@@@@@@@@@@BD@@@@BB@B@@B@@B@B@D@B@B@@@@DB@BA@@B@@@B@@B@B@B@@@@B@BAF@@A@@@AA@@AB@@@BA@A@BD@@@@@AD@@@@B@B@B@@@@@@A@@B@B@@@D@H@@@@@@A@@@@F@B@@@FB@@@BB@D@@@@@BF@DBD@DBBDFBDBBBD@F@@BBBBBBHFBBDAD@@@F@BAB@B@LAB@FAB@D@B@@@B@DA@@@@@AB@B@B@@@@@@A@@F@@@@D@@@@D@B@FAB@@@BA@@AA@@@@@@@@BA@@@@@@AA@@@@A@@AB@@@AA@AAAAA@@@@A@A@A@@@A@@A@G@@@A@AAA@A@AAA@C@AA@@@@@AC@A@A@@@A@@B@@A@@A@@@A@@@@@@A@@@@BCB@@ADABAB@DA@@@B@@BB@@BB@B@B@@B@@@@@BBB@B@@B@@B@BABB@@B@BAB@B@@@@AB@@@@@@@BA@@A@@A@@@@@@@AB@@AA@BA@A@@A@@@@A@@@AL@B@F@JAB@@@DAB@JCLCB@DAB@JC@@@@@B@@@B@@B@D@B@B@BB@@@@@BAB@B@@BB@@@B@@@B@@A@E@@B@B@@C@@B@BE@@@@B@B@@@B@@@BAB@@@B@BA@C@@@AA@@@@@B@B@@@BA@@BA@@B@@@B@@B@@@@@@@@BB@D@@AD@B@@B@@@@@@B@@A@@B@B@BA@@B@@AB@B@B@@@@CBA@A@@@@F@B@D@@@@B@B@@@BAD@D@@@B@B@B@@@@D@@@@B@@@B@@@B@@@B@@B@D@F@@AA@B@BA@@@@BB@@B@BB@B@@B@@@BBBBA@@BA@@BAB@@@@@@B@BD@@BDA@@@@B@@@@B@B@DAB@@@BD@BFAB@@B@D@BB@@@B@@A@A@@BAD@@@AA@A@C@E@AAA@@@AGA@@@AB@@@@@@@@AB@@@A@@A@@@@@A@@@A@@B@@@B@@@B@@@@BD@@@@A@@@@@A@@@@B@B@@@AE@AA@CAEE@AA@B@@@D@B@B@@@B@@@@@@AB@@@@@@@B@@B@C@@@AB@@B@@B@@A@@A@@@@C@@B@@@B@@@@@B@D@@@@@@@@A@@B@@ABB@@A@@BB@@@B@@@BB@B@@FABF@B@B@DB@@FB@BADCDA@@B@BBB@D@B@@A@AAA@@B@B@D@@AD@B@@@@AB@@@@AB@@C@@A@@@@@@@@A@@@A@@@@A@@@@@@A@@A@@A@@@AA@A@@AB@@@@@@A@@@@BA@@@@@@@@@A@@@@@@@AA@@A@C@@@A@AC@ABC@A@@@A@@@@A@@@A@@B@B@@BB@@A@A@@B@B@@A@@@@@@B@BA@@@@A@@AB@AABAC@@@@@@@@@@@A@@A@@@A@@@@A@@A@@@@@@@@@AA@@@A@@@C@@A@@@AA@A@@@A@@@G@AAC@C@@@@ACB@AA@@@@@@B@@@@A@@BA@@@@AC@A@@BA@BBA@@@@@AAAAA@@@AA@@@A@@A@E@AA@@@@BA@@@AB@BA@A@@A@C@A@@ABCB@D@@C@@A@@@@B@@A@@@@@@C@@@@E@@@@@@A@@A@BA@@A@@A@@@@@A@@AB@@@A@@BAHEFCDCBABADC@AB@DBB@@@@@BCB@@A@E@@@AA@@@@@@A@AA@@@A@@@BAA@@@B@@A@@A@@@@@@AB@@@A@@@C@@A@@@@@@@@@@@@@@@@@@A@@@@@@@AA@@@@@@@@@@@AA@@@@@A@@@A@A@B@@@@AA@@@A@@@@@@B@@@@A@@@@@@@BAA@@@@AB@@@@@B@@@A@@@A@@@@@@@@@@A@@@@@@@@@@@@@A@@A@@@@@@AAAA@A@A@@D@@A@@AA@@@@@@@@@@AA@@@@BA@A@B@@@@B@@A@@A@@@@A@@A@@@@@@@@@@@ABA@ABABB@A@@@@@@@@@@@A@@@ABA@A@A@@BC@B@A@A@@@@@CA@@@@A@@@@B@@A@@A@A@@@AB@@@@@A@@@@@CBB@A@@@A@@@@@A@@@B@@DIB@@@@@@@B@@A@@@EA@@@@A@@@@@AA@@@@@@@@@A@@A@@BA@AA@@AA@@@@@@A@@@AB@@@@A@@@@@@@A@@@C@@@@AA@@@@B@@@@@AA@@@@@A@A@@@@BA@@@@@@@A@@@A@AA@C@@A@@@AA@@@A@@@@AA@@@@@@@@A@@A@A@@A@@CB@@AA@@A@A@@@@A@A@A@@@A@A@@A@C@CB@@AAA@A@@A@@@@@A@A@@A@A@A@@@ABA@A@@@A@@BC@@@@@C@A@@@@@A@@@A@@AA@@@A@@AAB@AC@E@C@@@@BA@@B@@@@C@CAA@@@@@G@A@C@@@@AA@A@CB@@@@@B@@@@AB@@@@BBEBAB@@@@@B@@A@@@A@A@@@@@@B@@A@AB@@@B@@A@@AA@@A@@BCBA@@@@A@@@@@A@@@@@@A@@@@G@C@O@A@AAADA@@@@A@ACACAC@AA@@@BAHA@@B@B@BADAB@AAA@CCEACAE@@@AA@@@@@@@ABA@CBA@CA@@A@@@A@@@@A@@@@@@AA@@@@@@@@AA@@@@AA@@@@BA@@@@@@@A@@A@@AA@B@@@BA@@@BBA@@@@B@@@@@@A@BBACA@@@@AB@@CC@@@@@@CA@@CD@@A@A@AA@@AAB@@@DADC@A@@@@@ABA@@A@@A@@B@@@@@@@@B@@@@B@@A@@B@@BBA@@@@@@@@BA@B@A@@@@@@@@@@@@@A@@AAA@@@@@@@@@@@B@@A@@@@@@@@@A@@@BA@@@AB@@AD@AA@@@@BA@@@@BAB@@@@AA@@@@A@A@@A@@@@DCFEB@@A@@CA@@A@EA@@@A@@A@AAGAAB@B@@AA@@@@@A@@@@@@@AAB@@@BA@CA@@@@@A@@@@@@@A@@@@AA@@@@@@GAGACAA@@BEF@@@D@B@B@D@@@@B@@@@@@B@@@@@BB@ADAAAF@@A@@@@@A@@BE@@A@@@@@@B@@@@@@@BA@@GA@@AA@B@@@B@@CAA@@@@B@@@@A@@@@@@@AA@@@@A@@@@@A@A@@@BA@@IC@BA@@BEA@@AB@A@BA@B@@B@@@@@B@B@@@@@@@@A@@A@@@@@@@@A@@@@@@A@@@@A@A@B@@@A@BBA@@@@@@@@@@A@@@@A@@@@@A@@@@@@@@@@A@@B@B@@@@A@@@@@@@@@A@@@@@@AA@@AA@@@A@@AA@@@@AB@@A@@@ABA@@@@B@@A@@@@@A@@@@@@@CB@@@@@BA@@@@B@B@@@@B@@@B@@A@@B@@@@@B@AB@@@@CB@@@@@@@@@@@B@B@@@@@@@@A@@@@@@B@@A@@A@@@@@@@@@A@@B@@AA@@@AB@@@@@@@@@B@AA@A@@@@@@A@@@@@@@@@@@B@@A@@@@B@@A@@@@@A@A@ABA@@@@D@B@@B@A@@@@B@BB@@@@@@@A@@@@BBB@A@@@BBB@@BB@@@@BBB@AB@@C@@@@@A@@@@B@@@@CBI@C@E@E@C@G@@@@CA@@AABGDA@A@@@AA@@@CAG@AAC@A@C@A@AAA@AAAAA@ACA@AAA@A@AAABC@A@E@C@A@AAC@C@ABA@@DE@CB@DCBABC"],encodeOffsets:[[122949,31178]]}},{type:"Feature",id:"330522",properties:{name:"长兴县",cp:[119.910952,31.026665],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAAB@AA@A@A@@@A@@@A@@@@@@A@@A@AAA@@BAABCA@A@A@@@@@@@A@@A@@@@@@@@A@@B@@@@@B@@@BB@@B@@@@@@B@B@@@@@A@@@@B@@@@@@A@@@@B@AA@@@@@A@@@@BAB@@@B@@@B@@AB@B@BA@@@@@@@AA@A@@@@@@@@@@@B@@@@@BA@@@@@A@@@@@@@@B@@@B@@@@C@@@ABABABAD@@ABA@@@ABCBCA@@ABA@@@A@AA@@A@@@@BA@@BA@@B@@A@@BA@@BC@ABCB@@A@@@@B@B@B@BBB@B@@A@@B@B@@A@@B@B@@@@@B@B@@@BA@@B@@@B@@@BA@ABA@@BA@@BA@AD@B@@AB@B@@AB@@@BA@@@A@AB@@@BAB@@A@ABA@A@AAA@@@ADA@@BABA@@@@BA@@@@@@@@@AB@@A@@B@@A@@B@@A@A@@@@@A@@@@@ABC@@@ABAB@@@@@@ABA@@BA@@@@@AB@@@@A@@BA@@@@@AB@@A@@@@@A@@@AB@@@B@@@@@BA@@@@@A@A@@B@BA@@@ABA@A@@BAB@B@@@BA@@@A@@BBB@B@B@@@@@BBB@BAB@BB@@@BB@B@@@@@B@B@B@@@BADBB@B@@@B@@A@@@@@@@@@@@@B@@@@@@A@@B@@@@@@ADBB@@@B@@@B@B@B@BB@@B@B@@DBB@F@B@B@D@DB@@DDBBB@@@B@D@DCD@DAB@B@B@BBB@@BF@@B@@@B@BD@@B@@CB@BAB@@@B@@BB@B@@@B@@A@AA@@AA@@C@AAA@@@A@@@@BBBBD@B@@A@@@@BB@@BA@@A@@@@@@AA@@@@A@@B@@@BA@@@AB@@A@@@A@@AA@A@@@A@BB@@@BBBB@@B@@@B@@A@AB@@AB@BABAB@BAB@@@@@A@@A@@A@@A@A@@@A@@@@@@A@AA@@@A@ABCBABAB@B@@A@@@@A@AA@@CAA@A@@@@@A@@@@@@@A@@@@@@@A@@B@@@@@@ABA@@@@A@A@@AB@@@A@@@AA@AE@@B@@CE@@A@@BA@@A@@@@@@A@A@@@@@@@A@@@AAAB@@@@@@@@@@@@@A@@@@@@@@@@@AB@@@@AA@A@@A@@@@@@@@@@@A@@@@@BA@@@AA@@@@AA@AA@AB@@@@A@A@@@@BA@@@@@A@@@@@B@@BA@@@@B@@@@@@A@@AA@@@A@@@@@@BB@A@BB@@@@@@A@@AAC@CAA@@@@A@@@B@@B@@@@@@A@@@@@A@@@@@@AA@@@@@@@@B@@@@@BA@@@@@@AAB@@@@B@@@@@A@@@A@@@@@@@@@@@A@@@@@@@A@@A@B@@@@A@ABAB@BABAB@@@@A@@B@@AB@@AB@@AB@B@@@B@@B@BB@@@@A@AB@@@@C@A@@BABA@A@@BABA@CBA@@@AB@@A@ABAAA@@@A@A@@@A@@A@@@A@@@AAA@@A@CA@@A@A@@@@BA@@@@B@@AB@@CA@@A@@@@@@AAA@@CAA@@A@@@A@@@AA@@AA@A@A@A@A@@@A@@@C@A@ABA@@@ABA@ABAA@@@AAAAA@@A@A@A@@@C@@@@A@@AAA@A@@@@@@AA@@AA@@@@B@BA@@@@B@@@BA@A@A@@AA@@A@A@A@@AAA@@@A@@@@@AA@@ABA@@@AAC@@AA@@@ABA@@BA@A@@AAB@BCB@@@@@@@B@@BB@@@B@BAB@@E@AB@@@@BB@BA@@B@@A@A@AB@@AB@@AB@BAB@@@BA@@B@BBB@B@BB@@B@B@B@@A@A@@AABAAA@A@@@AAA@A@A@A@@@@A@@C@@@AA@@@A@@AAA@@@@B@@C@AAA@AAA@AAAAA@@@AB@@@B@@@@@BA@@D@@A@A@@BA@@@@A@A@AB@@A@@@A@AAA@AAA@@AA@@A@A@C@A@A@A@AA@@A@@AA@A@A@EAC@A@A@A@@@CAA@AAC@AA@@A@@@AB@@GB@BB@@@B@@@A@@@@@@BA@@@@@@A@@@B@@@@@B@@@@A@@@@B@@@@@B@BA@E@@AA@@A@@AAA@@@AAA@CAA@A@A@A@AAA@@@A@C@AA@@A@@@EA@@GFCFCFILCFAFAB@D@DDN@B@B@B@FEJ@DBBFHDH@J@D@@@DDFDBB@DDFBJHHDBB@DCF@FADBBFN@BAD@HCH@D@D@BBB@D@BBD@BDFDFFFFDLHBFFBFBBBFBBFHHHHNFHF@BDDDFFJAJAF@FAL@VBFDPBJ@DAFCHAF@BAJAF@FBFDFDFHFHDHBB@DF@D@@GDSFULENBJBFNB@@NF@BB@@B@HBHDHB@HFFFDBLBBBDDFB@F@DBBRFL@BA@@@@DE@CDCFCHAFALGDAF@DB@@DBFJFR@@FDDBLARDB@JD@@D@@@BABADCD@B@LDDADBDBFBH@@BNH@@FBF@B@@AFADADC@AH@DC@ABCACEG@A@A@ADEFAHDFAJOD@D@HBLFHBLJ@FCDGD@FFJBB@@B@DABAD@HBJID@FAH@DBDBBBB@DABAF@D@B@B@RKFC@@JBDBBBJBJDHFJ@R@D@B@@@DB@@@@B@@@@@@A@ABC@C@A@ABAFIFEBARKBABA@ABA@CBCAA@G@A@E@CDEJ[@A@A@A@A@@@AA@@@CA@@@@@A@@@@@@B@@BB@@@BA@@B@JOBCFEDCFEBCFG@AJKDEBAB@JEHEFEFE@ABA@CBGBEDEDEBABE@GBIDCBAHELEJC@AB@@@@@JCVGZGJCDAHCBADCBABA@@@AHCBANKRILGNMRQFCBAAA@@@A@AA@@BEC@A@@AAAA@@A@@@A@@@AA@@A@@A@@A@@@@@@A@@AAAA@@@@@@A@AAA@@AA@A@@@ABAA@@AA@@A@@@AAA@@AC@A@@@@@A@@@A@C@@AC@@@A@A@@AA@@@@AA@@@AA@@AA@@@@EA@@AA@@@@AAA@@AC@@@AAC@AA@@AAA@@@A@@@C@CDA@@@@AA@A@AA@@A@@@CAA@@A@A@A@@@@@@A@A@CBABA@ABAB@@A@@BADA@@AAA@A@@ACA@A@@AA@@@A@@A@@AA@@AC@@@@A@@@C@A@@@EBA@A@@B@@@@CAA@A@A@@AA@BC@@@A@@B@@A@A@@A@@@A@A@@@A@@@A@@@@AA@@@AAAA@@@@@@A@@@@B@@A@A@@@AB@BA@CB@BABA@E@A@A@@@A@A@A@AB@@AB@@AB@@AAC@A@A@A@A@@@@@AA@@A@@@ABAA@@AAAAA@A@@@FM@@@AAC@A@AAC@A@@B@@A@A@A@A@@A@A@@@@A@@@A@@AA@@@AAA@@@@A@A@@@@AAA@@A@@@AG@G@@EDABA@AB@B@@AA@A@AA@@AAAAC@AAEA@B@AA@@@AAA@AB@@BBA@B@@@@@A@@@@@@B@@B@@@@B@@A@@BB@@@A@@@@@AA@BACAB@@AB@@@@A@@@@@AB@@@@@@A@@@@@@@A@@B@B@@@@@@@@AA@@@@@@@D@@AAA@A@@@AA@@@C@BA@AA@@AA@@@@@@@E@@@@@@C@AAEB@@@B@@@@@@A@@B@@AB@@@@@AA@@AB@@@@A@EA@A@@BADAB@@@BA@ED@@@@@FA@@@A@A@@@@@ACC@A@@@A@@@@@A@A@A@@@A@@DC@A@@@A@ABA@@AA@A@@@@@@B@@AA@@@B@@@@@@@@A@@@@@@@AB@B@@A@A@@@@@AB@AA@@@A@AA@@A@@@AAA@@@ABA@@@A@@@@GA@@A@@@@@A@@AA@@A@A@@AAA@@@A@@A@@@A@@B@B@AA@@BA@A@@@A@@A@AA@@@A@@@AB@@@@@@A@@@A@A@@@@ACAAAA@@A@CA@@@@A@@@@@AA@@@@AA@A@@@A@@@@@A@@@A@A@ABA@@B@@A@@@A@@A@@A@A@@AA@A@@@@AA@@@A@@BA@A@@@A@@AAA@@AA@B@@A@@B@BAB@B@BA@@BA@@AA@@BAB@@@@@BB@@@BB@B@D@@@@@B@@CA@BAAAB@B@B@@@BA@@@A@@@A@A@@@AB@@@@A@@@A@A@@@A@@@A@@@@AA@@@A@@A@@@A@@@@AA@@A@@C@@@@@A@AA@@@@AA@@@@AA@@A@BA@@@@@@A@A@A@A@@@@@@@AA@C@@@C@@BA@@@A@A@A@@@A@A@@A@B@@A@@AA@@A@@@@@AA@@AAAA@BA@A@A@@@A@AAA@@AA@@A@@ABA@@@AAAAAB@AA@@@@AA@@AAAAAE@A@@@@A@@@@AAA@@@@AA@@A@@@@@A@@A@@@@AA@@@@@@@A@C@@@AB@@ABA@@BA@@BA@@@@@A@A@@@A@@B@@@BA@A@@@A@@BAB@B@@BB@B@@"],encodeOffsets:[[122777,31467]]}},{type:"Feature",id:"330523",properties:{name:"安吉县",cp:[119.680353,30.638674],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@@AB@BC@@@A@@@@@AB@@@@AAAAA@AA@@@@@AA@AA@@A@A@C@A@A@@@A@@@A@@@@A@@@C@A@@@@@A@A@A@A@@@C@AA@A@A@AA@@AA@@A@@@A@@C@C@@@@@A@AAA@CA@@CC@AA@AA@@@C@@A@EACCA@A@A@A@A@ABAA@BAA@@EBA@EHEFEDKBKBG@EAG@C@CBAB@D@FDL@FAJADGFCBA@A@GAGAA@A@@BAB@D@FDFDD@DBF@@ADADCF@DBJABAB@@CBEBABKDA@@B@DDLAJAB@@AF@B@H@T@FAFEF@B@DBFDHBB@FDN@BEJEHCJADBDADBDAD@BA@C@C@EAC@A@EAGCCAAEQKQICCGAIAG@E@UPBD@H@DA@CBA@CBCBABK@E@C@CDABAPAFAFBFFDB@DBBD@D@F@BBB@@FFNFBBFFBBD@DBBB@B@BAD@BBD@DCBCDADG@CBAB@B@DBBBBABABMFCDIH@DADDFBDFHBBFDB@B@DCBCDCF@F@DBH@HBJBF@@@LCD@@@D@F@DBFDFBFBF@F@F@BB@@DDHFFDDDDD@BBF@BBBBBBFDHDD@DBDFJHHBBDFDBHDB@FAD@BBBBFFDDDBD@BDFFDBB@B@B@DAF@DBHHDBBBD@@@BABABADEBCBG@EDIBODIDCBEHGDADADAF@HBF@HBB@J@@BHDNHHJBJBJDLFFDFBBDDDBHFDD@BDDDFLPJJAX@\\EF@D@BBB@B@@DDDHBBAD@BGHAB@B@B@FBFBD@B@HAH@B@BBBBBDBD@HBD@FCBCDAFCH@F@D@@@BDFBD@D@D@DBHDBBBDBBBB@DDFHNBFHJDFFPDFBFDFFFDFHD@@BBBBB@D@D@FAJ@DBB@BDBDADCFCL@FBL@BFB@@B@@@BBD@B@@@B@BBB@B@B@B@DBB@BB@@B@BB@@@BB@@BF@B@@A@A@@@@@A@@B@@@@@@A@@@@@A@@@B@@@@B@@A@@@@B@@@A@@@A@@AHA@@BA@@B@@@BBD@BBB@DB@@B@B@B@D@FBB@B@B@@BB@@@BBB@B@B@D@B@B@@@BB@@BB@BBB@B@B@@@BA@@B@B@B@@B@@AB@B@@@@CB@@A@@@@@A@@BA@@B@BBBBB@BBB@BBD@@@@A@@B@BB@@@B@@BB@@D@@@@B@@B@B@B@B@BB@@B@B@BBBA@BB@B@@@@A@A@AA@@A@AAA@A@AB@@A@@BA@ABA@@BA@@BAB@B@@@@AB@@AAA@@@@BAF@@@BA@A@A@@AA@@@A@@@@@@DA@ABA@BB@B@@AB@BA@@B@@BD@BB@@B@BA@@BB@@@@B@@@B@BB@@@B@B@BB@@BB@B@B@@A@@@A@@B@@A@A@@B@@BB@@B@@@@B@B@BB@@@B@@D@@@B@B@B@@@BBBB@B@@BBBAB@BA@@B@BAB@D@@@B@@@B@B@B@B@B@@BB@@B@@@B@@@BB@DB@@BB@B@@@@B@@@DB@@BA@@@A@@B@@A@@B@B@@@DBB@@@BB@B@@@B@@@BB@@@B@B@@@B@BBBAB@@@BA@@B@DAB@BA@AB@B@BA@AB@D@@@@@BAB@@@@@AAA@@@@A@@@ABA@@BA@@BA@@@AB@@@@@BABA@ABABAB@@@@@@A@BB@@@@@@@B@@@@@@@@@@@B@@@B@@@@@A@@@@@BA@B@@@@B@@A@@@@@A@@@@@@B@@B@@@@B@@@@@B@@@@@@@@AA@@@B@@@@@BB@DBD@BB@@@@@@@AAB@A@@A@@@@B@@@B@@BB@@@@@@@@A@@B@@AA@@@@@B@@@@@B@@A@@B@B@@@@@BAB@@BBB@@@@BB@@B@@A@@@@@B@@@@@@@@@@@BB@B@@B@@A@@B@@@@@@@@@@@B@@@@@@@@@@@@BABB@@B@@@@@@@B@B@@@@@@@@BB@@AB@@@DF@@@AF@@BBB@@B@@@A@@BB@B@@@@@AB@B@@@@A@@@@B@@@@@@@B@@@@@@@B@@@@@BBB@DB@@B@B@@B@@@@ABABADABAB@@@B@@B@B@@@@B@@@B@B@@@@BB@@@@B@@@@BA@ABABA@ABA@@BAB@@@@A@@@AA@AA@A@@AAB@@@B@B@@BB@@@B@@@BA@@B@@A@@@AB@@@@@BB@@@@@@@BB@@AA@@A@@B@@@@AACAA@A@@B@@@B@BBD@@@BB@@BBB@@@@A@@@AAA@@@A@@BA@ADA@@@AC@@A@A@@@AE@@AA@AAA@A@A@CBC@CDC@A@@@A@AACC@@CAC@A@A@E@A@CA@@@A@AA@@A@A@A@A@@@A@@AABC@@@@@@@AB@@@@@@@@A@@@@@@@@@@B@@@@A@@@AAABC@A@@@A@A@A@@@@@AAA@@A@@ABA@AAA@A@@@@@A@AAA@AB@@@B@@A@@@ABA@AB@B@BA@@B@@A@AB@B@@@@@B@@A@@@@@A@@BA@@B@@@@@B@@@BA@@@@B@@AB@@@@@BA@@@@B@@AB@BA@@@@@@BABA@@D@BA@@@@B@@@@@B@B@@@@AB@@@@AB@@@BA@@@@@@@@B@@A@@B@BA@AB@BC@@B@BBB@B@BAB@@@BA@A@@BAB@@@B@@A@@BA@@@ABA@@@ABCB@@AB@@AB@BAB@@A@@@A@@@AB@@A@@@A@A@@@@@A@AB@@@@A@AB@@@@AAA@A@A@A@A@@B@@@DABAD@@AB@@AB@@@@AB@@AB@@A@@B@@@BBB@@@B@BA@@DBDABA@@B@BA@@BCBABABA@@D@@@@@@A@@@A@@@@@@B@@@@@B@@A@@@@@A@@@@@@@@@@@BBB@@@@@@B@@A@ABA@@@A@@@A@@BA@A@@B@@@@@B@@B@A@@B@@@@@@@@A@@B@@@@@A@A@@@@@@@@AA@@A@@@A@@@@@AB@@@@@@@@@@BB@@@@@@@B@B@B@@A@@@ABB@@@AAEAA@A@A@AA@@ACAAA@@@AAAA@@ACA@@A@@@AA@@@AA@@BC@@A@AAA@A@@@A@A@AA@@A@@AA@@@A@A@@A@@AA@@@A@@@@AAAA@@AA@@AA@@@@@@AA@@A@@@@A@@@A@A@@B@@A@@BA@@@A@@BA@@@AB@@A@A@A@@B@AA@@@A@@@A@@BA@@@A@@@A@CA@@A@@@A@AA@@@@BA@A@@@@B@B@@A@@@@@@@AAC@@@@A@@@AB@@@@A@A@@@AA@@@AAADEBA@@@@@AAA@A@@A@@BA@AA@@A@@@A@@@A@@@A@@@@A@@BA@@AABA@@@@B@@@B@@A@@@@AA@@@A@@B@@@@A@@B@@A@@B@BA@@@@@A@@@@@C@@AACC@@AAAA@A@@AC@@@@B@@@B@A@A@@@@@@AA@@BA@@@A@@@@A@@@@BADA@@B@@@B@F@@@@@@@@@AA@A@@@C@AA@@@@@AA@@AAA@@@AA@@A@@A@@ABA@@@@AA@@AA@@@@A@@@A@AA@AAC@A@@A@AA@A@A@@@A@@B@B@@@BA@E@@@BBBBABABA@@@@@A@@@AAA@BA@@AAA@A@@@@@@@B@AB@@@B@A@@@B@@A@@@A@@A@@C@@AA@AAAA@@D@@ABA@@B@@@B@@A@A@@@AAA@CA@@AAA@@@AAA@A@@BA@@BA@@@AA@@A@@@@@A@@@@AAA@@@@@@ABA@@AA@AA@@B@@A@@AAAA@@@AA@@@@AA@A@AA@@AA@@A@@@@@@@A@@@AA@@@@A@@@@@A@@@@@C@@A@@AA@@@BA@AB@@@BA@@@@BA@@B@B@B@BA@@B@@A@A@A@@BABA@@@AB@@A@@BAB@@@B@@@@AB@@A@@AA@@@@@A@@@A@AA@AA@@BA@@AA@@AA@A@@@A@@@@B@@A@@@@@AAA@@A@ACA@@@A@C@@@@@@@AB@@@A@@A@AB@@AA@@@@A@@@A@@A@@A@@@@AB@@A@@@AB@@A@@@A@@B@B@@@@@B@@AD@@B@@BA@AB@@@@@@@B@@@@@BA@@B@@A@@BA@@@@@@@A@@BA@@B@@@BA@@B@@@@@BA@@@@B@@@B@@ABABBB@@@@@BA@@C@@AA@@AA@@@@A@AB@@AB@A@@AAAA@AB@@@AA@@@CB@@C@@@A@@@AB@@@@A@A@@@@A@A@@AACAAA@@A@@@@AAA@@@BA@AB@@A@@@A@A@@@ABA@@@A@AB@@@BC@AAA@@A@@A@A@@@A@@@@@A@A@@@@@A@@@AAA@ABA@@A@BAB@B@@B@@B@@A@A@@@@BA@@B@@A@@BA@@@AA@@@@@AA@A@@@C@@@A@@@A@A@@@A@@@@AA@@@@AA@A@@@AA@@@@A@C@A@A@A@A@A@A@A@A@@AA@@AA@@@@@BA@@A@AA@@@B@@AB@@CBABA@@BA@@@@@A@@@A@AA@@ABA@@BA@AA@@A@C@ABABBB@@@@A@ABA@@@@@BBDB@@BB@@A@@BADA@AB@@AA@@A@@@A@A@A@AAC@@@@A@@@AE@AAA@A@A@A@@@@@AB@@@@@@AAC@@@AB@@E@A@C@@@@@AA@@@@AA@@@A@A@A@A@ABABAB@@A@ABC@@@A@@@A@@@@@@@A@@@A@@@C@@B@@@BA@A@A@@@A@@@CB@BA@A@@BAB@@AAAB@@@@@BA@A@@@AB@@@B@BAB@@A@C@A@@@@AA@A@@BA@@B@B@@A@@@@A@@A@A@@AC@A@@@@BA@ABABA@@@AA@BAAA@@@A@A@AB@@A@@AA@A@@AA@A@@@A@@@AAA@AA@A@@AA@@AB@@@B@BADCB@@A@@@ACE@@@A@A@@@A@A@@@AA@@AAA@@AA@@A@@@@@@A@A@@@@AA@@@@@C@A@A@@@@A@@A@@@C@A@AA@AAAAA@@A@A@@A@@@A@AB@@@@@A@@@A@@BA@A@@@AAABA@@A@@A@@@AA@@@@A@@CA@@@@@AB@@A@@@@B@@@@B@@B@@@B@@AB@@@B@@@@A@@@C@A@@B@BB@AD@@BB@@A@@@AA@BA@ABB@A@@BA@A@A@@AA@@@@@@@A@AB@@C@A@@BA@A@A@A@AA@AAAAA@@BA@@@A@@@AAA@@@AAA@A@A@@@A@@A@A@@@AAA@AAAA@A@@AA@AAA@AA@@@A@@@AA@@A@@@AAA@AACAA@@A@@@@AA@@@@@AA@@AA@@A@@@@A@AA@@@AAA@A@@@ABA@@B@B@@BB@@AB@@@@C@A@@@CCAAB@@A@@BA@ABA@@@A@@AA@@ABAB@@@B@B@@@@A@@@A@@@@AABA@ABA@A@@@CBA@A@@@@@@@AAAA@@BADABA@ABA@@A@AA@@@@AD@B@B@@@@A@@@AAAAA@A@@AA@@A@AA@@@AA@@@A@@@@A@A@C@@@AAA@@@C@@@AAABAB@@EB@@@@@D@@@D@@@@BBD@@@BB@B@BA@ABA@@@@BA@A@@BAB@@B@@@AB@@@@BBB@B@@@B@@@BA@@@@BB@@A@@B@@B@@@@B@@@@@BA@B@@BA@@@@B@@@BBBCDCB@@BB@@AB@BA@@B@@@BA@BB@@@B@BB@@BB@@@B@@@BBB@B@@BBAB@@BB@@@B@@AB@@@@@@@@B@@@@@BAB@@BB@@B@@@@@DB@@BB@@BB@@@B@B@@@BBB@@BBB@@BA@@B@B@@A@A@AA@@A@@A@A@@AA@@@@AB@@@@C@@BB@@BEB@@A@A@AAA@A@@@@A@@@@@@AA@AAAAA@@AAA@@@ACAA@A@@AAA@@@AAA@A@A@AA@AA@A@@A@@A@A@A@AAAAA@C@@@AB@@A@@@@@A@@@A@@@@AA@@AA@AA@@@A@@AACC@@ABA@@@@@ABA@A@A@@@AAA@@BCB@@@@@B@@@BA@A@@@@AA@@@@@A@A@AA@@CB@@@@ABA@AB@@AB@@EFA@AB@@@@A@A@@B@B@@@B@@AB@@@@@B@DAB@BAB@@AB@B@@@@ABAB@B@@A@@@CB@@@AA@A@@@@A@@@@A@@@AA@@@A@A@AAA@@AA@AAB@@AD@@C@AB@@@A@@AAACA@AA@@@@A@C@@@@A@@@A@@@A@A@AAAAAA@@AA@A@@@@@@@AAAA@@A@@AA@A@@@A@@A@@@A@A@AAA@A@@@AB@BA@A@@@A@A@C@@@AAA@@AC@@C@C@CB@A@A@@A@CA@@AA@A@@A@C@@@C@@@AAAA@@AAA@AA@@A@A@ABA@CBA@A@ABABA@@@AB@@C@@@A@@@AB@@@AAB@@AB@BA@CA@@C@@@@@ABA@@@A@@@A@@@A@@@@A@@AB@AA@@BA@A@A@A@@AEBA@@@A@A@@@AA@@@AA@@AABA@A@@@A@@@@BABC@@@A@@@A@@BABA@@@ABA@@A@@ABC@A@@@A@GB@@@@A@AA@A@@A@A@A@@@"],encodeOffsets:[[122316,31141]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,2 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("嘉兴",{type:"FeatureCollection",features:[{type:"Feature",id:"330402",properties:{name:"南湖区",cp:[120.783024,30.747842],childNum:1},geometry:{type:"Polygon",coordinates:["@@DEB@@A@@@@@A@@@@@@A@C@@@@A@@A@@@@@@@@C@@@@@@@A@@A@@C@@@@A@@@@@A@BC@@GA@@@BA@@@A@@@@@A@@AA@@@@B@@@@A@@@@@E@@@C@@@@B@@A@@@@BA@B@@@AA@@@B@@@BA@@@A@@BA@A@@@@@A@@@@@@@A@@AAB@AA@@A@@@DB@@@@@@BA@BB@@@B@@@@@@@@A@@A@@@@@@@A@@A@@B@@A@@@@@@@@@@@A@@@@@@A@@@B@@@@@@@@A@@@@@@@C@AAA@@@C@@ADB@A@B@A@@@@@@AA@@@@@@B@@@@@BAAAAB@@C@A@@@ABBB@@AB@@@@@BB@AD@@@A@@@BE@BAA@@AA@@@@@ABAAB@A@@@@B@@CACAC@@A@@@B@@@A@@@AA@@@@@@A@@A@@@@@A@A@@B@@ABA@@@@@@@EA@@A@@B@@A@@@@B@@@@@@@@A@@@A@@A@@@@@@@@@@@AB@@@A@@A@@@@C@@@@@@@@BA@@@@@@@@@@B@@CA@@AB@@@A@@@@BAC@@@@A@@@@AAE@@@@@@BA@@A@@A@@@B@@@@@C@@@A@@@C@@@@@@B@@@AA@@BE@@@@@@@A@@@@@@A@@@@@@@DC@@@@@@@@@@@@B@@@BA@@@@@@@A@@B@@@@C@@@@@A@@@AD@@@@B@@B@@A@@@@@@@@@@@@@@B@BA@A@CAIFC@EBA@@B@DAB@AA@@@@@@@@@@@A@@BA@@@A@@AA@@@@@@@@@C@@A@@@@A@@@@B@@@@A@@@@A@@@@@@A@@B@@@@@@ABB@A@A@@@A@@@@@A@@@@@@@@@A@@@@BA@A@CA@B@@@BA@C@ADEJ@@@B@@CA@@@B@A@@A@@@A@A@AA@@@@@B@@@@CA@A@A@@BA@@@@@AEA@@@AB@@@@@@A@@BA@@@@DC@@A@@A@@@GBC@@@@@@EABA@@B@@@A@@A@@@AB@@B@@@A@@@@@@BA@@@@@@@@@@@A@@@@B@@@A@@@@A@@@A@@@@@@@AABC@B@A@@@A@A@@AA@@@A@@@@@@ADB@@@A@A@@@@A@@A@@@@@@A@@@@AA@BBA@@A@@@@@@@BA@@@@@@BA@@@@@A@A@A@@@AB@BAFADEH@@A@@@A@A@@@AD@D@DAHAB@B@BIA@@AA@@@@@@A@A@@@@@@@@B@@@@@@@@A@@B@@@@A@@BA@@@@E@@@@@@@@@@A@@A@BAA@@@@A@@@@@A@A@@A@CC@A@@B@@A@A@@@@BA@@@BB@B@@@B@@@B@@@@BBABBB@@B@@B@@BA@B@@@BA@@@B@@BABAB@@@B@@@@B@@@@@@B@B@BB@@@@@B@@@@B@@@BBB@@@@@@BB@@@@BBABCBEFAB@@@@@B@@@@@BA@@@@AAB@B@@@AB@@@@B@@@@@@@@B@@@@ABB@@@@@@B@@@@@@B@@@@@B@@@@A@@BA@BB@@@@BB@BF@@@BA@@@@B@B@@BBBBBB@@B@BB@@@A@@BAD@@AF@D@D@BA@BBMNGFOLDDBD@BA@@D@BABG@BA@A@@E@EACA@BE@@@@A@@A@@@BAAA@B@@@BA@@@BAAA@@@@@@@@@@@@A@@@AA@@@AA@@@@AB@@@A@@@@@@@@@C@@A@A@BA@BAA@@DAAA@AB@@@@@@@@A@@BCA@BAA@B@@@@@@@D@B@@EA@@AA@@A@A@AF@@@BA@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@AAA@@A@@@A@@AA@@@@@@@@@@A@@@BA@BCA@@@@@@@@AA@@@@@@@@@@B@@AC@@@@@@ABB@A@@BBD@@@@B@BBAB@@@B@@B@@@@@@D@@@BA@@@@@@BA@@@@@@B@@@@B@ADAASICDCCA@@@@B@BAA@@@A@@@@@@AA@@A@@@A@@@@A@@@A@@@@A@@AC@@@@@@@@BAA@@AB@@A@CDABDD@@JJ@D@@@BB@B@@BB@@ADD@BCFAF@BABA@ICAD@@CA@@AA@BA@@AA@@DCAAAA@@A@@@@@@B@@@A@BAA@@@@@AAA@BCA@@@A@A@@@@BAA@@A@A@@@@@@@@@A@@BB@A@@@@@@@@@A@@@AB@BB@@@A@@@@@@B@@@@A@@@A@@@@@@@AA@@ABBB@A@BBA@B@@@@@@@B@@B@CBB@ABB@A@BB@@@@A@@B@@@@B@@@@@@@@B@A@@B@@@@D@@@@@@@@@BB@@@@@@BB@AB@@@@@@@@@@@B@@@@AB@B@@LFTJPHPHBB@B@@@@@@@@B@DD@@@A@@B@JDHDBBBB@@BBLBJBB@D@B@F@J@@B@D@BB@DB@@@@@BD@@A@@@@B@B@@B@BAD@@@B@@ABBD@@@B@BBB@@@@@BB@@DBDBB@BA@A@ABABABA@@@AAA@@@@@FFB@@@BBH@FBJB@@J@B@H@D@HBFOBA@@DBBBBBBDB@BBBBHE@ABA@CBABCHB@@VJFDXJ@@CFABMJAB@@ABFFG@@BD@@@BBABDB@A@DB@@A@ABAFFADB@@B@BB@@BA@@@D@B@@@@AFFDD@@DDHFDBBD@@DDCB@@AB@@@BD@@@BBBC@@BBBBDBDDBBJF@BB@HDDBJDDB@A@@@@@AB@@AB@@ABB@@B@@A@@B@@@@@A@@A@@BAB@BAB@FA@@AADA@A@ABDHCAABA@BFC@B@@@@B@@DBAB@BADA@@BA@@B@@@ACAAIEECA@@AAAAE@AA@AAACAACB@AA@@ABA@AAAAE@A@AAAGE@@A@@A@@@A@C@A@C@A@CAC@A@@DBFDHDB@B@B@D@DAD@@@BB@@D@B@D@@@DBD@D@B@D@B@BA@@BA@@LD@@@@DAB@F@D@D@F@@@@C@A@C@ABA@G@C@@AAAA@A@@B@BABBB@@AD@@@F@D@D@DD@B@@@BF@@BA@@@@@@@A@@@@A@@@@@@@@A@@@AB@BFBBBBC@AB@@@@G@C@@@AA@@CA@@A@@@AA@@@B@@@AA@@B@@@@@@@A@@A@@BA@@@@@@B@@@@AA@A@@@@@BA@ABA@@B@BDDB@@DBDDDBDD@@BBDBDDFDB@D@F@H@B@@@DBDBD@LB@E@A@CA@@@@@A@@A@@@ADB@ABA@A@AC@@A@@BA@CBC@AAACC@@BAF@@@@@@A@@@@B@ADB@B@BA@@BG@C@CF@BBB@D@@@@@@ADBB@EICG@A@CAA@A@G@A@CAE@CB@@@@@DBF@BAB@@A@@@@@@B@@@B@@@B@@@@A@@@AF@@C@@G@@@@@@AA@@E@A@A@AC@@AB@BK@A@@A@EAUCE@C@A@EBGBA@@@@A@A@A@AAB@C@@@AB@@A@CBC@GBE@E@G@E@@E@@AFA@@@A@C@C@@BA@@@A@A@A@C@A@ABE@@@C@@BC@C@@@AA@@A@C@A@A@@AAAE@@AA@A@A@CBA@A@C@AB@@@B@B@@A@A@@BC@@@@AAC@@A@@AA@@@@@@A@@AA@@B@@@B@@C@AA@@C@@A@A@@AA@BA@@@@@A@@@@BA@@A@@@@@A@@EA@A@@"],encodeOffsets:[[123843,31356]]}},{type:"Feature",id:"330421",properties:{name:"嘉善县",cp:[120.926028,30.830864],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@G@E@C@A@ECCCCAAA@@CCCACCCA@@CAACA@@@AB@BAB@@@@B@B@@B@@A@@@@@@@AB@@@BB@@@@@@@A@@@BB@@A@@@B@@B@@@BB@@DB@@B@@@D@H@@A@@BADAAEA@ABA@@B@@@@@@@@@@B@@B@@@@@@@B@@AE@@A@@@ACCC@C@E@@@C@@BA@AAABA@@@@BBBBB@@@D@HAB@B@D@B@D@@E@C@C@E@A@CB@@@@KC@@AB@@ABA@C@A@C@C@CA@@C@A@C@@@AA@@C@CBC@A@A@A@GCECCA@@@BBD@D@B@D@B@D@B@@@BB@@@HFBB@B@BBFBB@BAB@BB@@BDABBBDBBB@@BBFBB@BB@FDJFBBBD@@A@@@AB@@CBABA@AB@CA@@@@@@AED@AABBBGDAC@B@BCBBB@@EBA@ABA@AB@@@BB@@@@@A@@@@BA@@@AA@BA@@BA@@B@@@@@BCAICCAGCA@@AIEAACCCAAAAA@@ADABAH@B@D@D@@BDK@@EA@@BA@@B@@@@@AA@@BA@@@A@AABID@AB@@BBB@@AB@@A@@@ABAC@BAA@@@AABACA@@CC@@C@@@@@ADCA@@@@BCA@AAAH@@@@BB@@B@AB@BA@@@@B@A@@@AA@@A@@@AEA@BAA@BA@@@A@@H@@@B@@@BB@AB@@@@AD@@@BA@B@A@@@A@@@B@@@@A@A@@@@A@@B@BDBA@@B@B@@BB@@@B@D@@@@BB@B@B@B@BAA@@A@AB@@@@@@@@DB@BB@@BF@@@@B@@A@@DEB@AA@@A@@@@@@@B@BA@A@@@@@@DA@@B@BBB@@@@B@@BB@@@@@@J@@@DBB@@@@@B@@@BA@@BA@@D@B@D@BB@@BA@@B@@@DD@B@@@@@@@@BAD@B@F@@AF@B@BAD@B@@ND@B@@@D@@B@@@B@@@@B@BABA@AGA@@B@DC@AA@@@A@@@@@@@AA@A@A@@@AD@@@@G@@AC@AFA@CAA@@@@C@C@C@A@@G@@FGA@@@@AD@B@B@@A@A@@@@@@B@@@B@BBBDD@BAB@BA@@D@@AB@BAB@BCA@B@@D@@@AB@@C@CB@@ABAB@BABB@@@@B@@B@@BB@@@AB@@BB@@@@D@DDBBB@BBD@BBB@FDDD@@B@@BABAB@@CB@@@D@D@@A@@B@@A@@AA@@BABA@A@@BCD@@@B@@@@@@@@B@@@BB@@@@DBAB@@@@B@AB@@B@@BCD@@MBAB@BB@@@B@@@@@@DBA@BA@@@@@@@@B@A@@@@A@@@A@@@C@ABC@AB@@AAA@CB@@@AACAC@@@@EDB@@BBD@@E@DDBBBBDDDFBBDBDBFBH@FB@B@B@DABAF@D@B@D@BBBBD@D@BB@@@B@@ADAB@BBDDBDDBHBTDDBNBH@N@NBDAPETIBCBC@AB@@@@@@AB@@@@@@@B@@@@B@@@@B@@@@@@@@@@@@@@@@A@@@@@@BA@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@BB@@@@@@@@B@@@@@BB@@@@@@BB@@@@@@@@B@@B@@@@@@@@@@B@@A@@@@@@@@@@B@@@@@@@@@BA@@@@@@@@@@@@@@BA@@@@@@@A@@@@B@@@@@@@@@@@@@@@BB@@B@@@@@@@B@@@@A@@@@@@@@@@@B@@B@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@B@@@@B@@@D@@@@@@@B@@@BB@@@D@B@D@@@@@B@@BB@BBD@D@@@@BRHDBBNFB@LJ@@@B@B@P@@C@EBCFBFDD@PEBDDADBBADAHAD@@BGJABBB\\BDLEBBFCBBBB@BADB@@B@B@B@FABIBCBAF@BAEEBAD@D@F@F@B@H@J@@ABAB@D@B@B@B@B@CABEDCAABC@@@CA@@CB@@A@ECABCHBBEAABABCB@BI@A@A@ABAB@B@B@@CCA@A@A@AA@@AC@@AA@@@@@@@@@@@A@E@@AAABCA@@CD@@A@A@@ACDGE@BGD@BGB@@KAABABCBCDA@@@A@CAG@E@S@E@A@A@@@A@@AAB@@AA@@AB@B@D@B@B@@EA@@AAAA@ABC@@CC@A@A@@AA@AA@@@C@@@ACE@@@AB@BA@@@A@C@AB@@@XADAB@B@@A@AAC@@@A@@DAB@B@H@B@BABA@@BABA@@A@ACFAAAA@@ADACACDEEFAACA@CEDCCCB@ACABA@@ABACCHCCCC@AB@@A@AC@AC@ACAA@AA@A@A@@AAAAACBAC@ABAA@ACA@A@A@@@AA@A@@CC@AAAD@DAGEBAB@DA@A@@CC@@@ABAD@B@BB@BA@BBBADFA@BDB@BBB@@A@ADA@@@@B@@@@BAB@@BAFFHH@@DBB@BCJAJCFADA@@@@HIBEB@@ABA@A@@@ABC@@@@@AE@@A@@BS@@A@C@@A@@@@BA@@AA@@@A@E@C@@@ACA@@C@E@@@@@@C@@AA@@@@@@@A@@@@@@BAB@@A@A@@@@B@@A@@BCC@AA@@A@A@@@@A@A@@A@@AA@@B@B@@CBCC@@@@@@@BB@@BA@A@@@@@@@CCAAAAA@@C@A@AA@@@A@@A@@@@A@@BA@B@A@ABE@E@AA@@A@C@@@@@@@EA@BBHC@A@@@@AC@@@@@@BABA@@@EBACCB@@A@CB@B@@@D@@MACAA@@A@@@C@@@@CAK@KAC@CACA"],encodeOffsets:[[123854,31513]]}},{type:"Feature",id:"330411",properties:{name:"秀洲区",cp:[120.710082,30.765188],childNum:1},geometry:{type:"Polygon",coordinates:["@@BO@A@@@A@B@@@@A@BAA@@@@@@@ACC@@AAAAA@B@@AAAAA@ACA@A@A@G@EBC@A@@@EBA@CAADB@@@I@A@A@AAA@@DA@@A@@@@A@@B@A@AC@A@E@@@IAEA@BA@@B@@AA@@@@@@@A@@@@C@@A@@@@@BA@@C@@@BA@BAA@@@AB@@@@@@A@C@C@C@@@@@@BC@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@A@@@@@@BA@@@@A@@@@@@@@@@@D@@@@A@@@@@@@@@@@@@@D@@@@@@@@A@@AABCA@@@DAAA@@B@@@@@@@@B@@@A@@B@@@@AB@@@@A@@@@@@@@@AA@@@A@B@@B@BAA@@@A@B@A@@A@@B@@ACCCAECA@@@A@@B@@@@A@@@ADAD@@A@AAAB@@@@@@AB@@@A@@@@@@@A@@@@@@AB@@@@@A@@@@@@@C@@@B@@@B@@@@A@@A@@@DA@@@@@@@A@@@AB@@@@@@A@B@@@A@@@@@@@@A@@A@AB@@AA@AA@C@ABA@@@A@@BA@@@C@@AAB@AA@A@@DAB@B@BAB@FAB@@@D@@AAA@@@@B@@@B@B@BB@@@@@@BAA@B@@@@@@@B@@@@@B@@@@A@@A@@CDADABAD@@AB@B@@@DBDAB@@@@A@@A@@@BA@@A@@@BB@@@A@@BD@@@@@AD@@D@@AB@@B@@@@@@@A@@@@@ABB@AB@@@@AA@@@B@@@@@@@C@@AD@@@@@@@B@B@B@B@@B@@@@B@@A@@@@F@@@C@@B@@B@ABB@@@AB@@B@@@B@@B@@@@A@@A@@@D@@@@@@@@@@@B@B@@@@@@@@A@@@@@A@@@@@@@@BB@@@B@@A@@@BBBAD@@@B@@@BDAJA@@A@@AD@@@@@@@@BDA@A@@@AD@BB@BA@B@A@@@@BB@@@A@@@@@@@B@@@A@@BB@@@@@@@B@@@@@A@@@A@@B@@@@A@@@@@@@@B@A@B@@@@A@@@@B@@B@@@@B@@@@@@@B@@@@@@@@@@@A@@B@@@@@AB@@@@@B@@@@@@@@BA@@ABBBBA@@B@@B@A@BA@@@@BB@A@B@@@BBB@@@@@@B@@@@ABD@B@@@B@@@B@@BADAB@BB@@BA@@@@DBA@@B@@B@@@@@@@@A@@B@A@B@@@@A@@@@@@BA@@@A@@@A@@@@@@AA@A@@@@B@@B@@@@@@@@@@BD@@@@@@@B@@@B@@B@@@A@B@@@BA@@AA@@B@@@@@@@B@@@@@@@@@@@@@@@B@@@B@@@@A@@@@BBB@B@@BBHDCD@B@@@BB@@@BABB@DAD@B@BB@@@B@@B@@@@B@@B@@BA@@@B@@@A@@BBB@@@B@@BA@A@@@A@@D@BB@@BB@AB@@A@B@B@BB@@FB@BAB@BB@@@@@@@@@@B@@@@@@@@@@@BAA@@@@@@@@@@AB@AAB@@B@@@@@@@@D@@@A@@@@A@@@@@@@@@@BA@A@@@@@@@@@A@@@C@@@@@@@@@A@E@A@@@@B@BE@@@@BA@ABCDCDADABAB@@@@ADAB@@EHAB@B@@ECA@CACAECCA@@@@@B@@B@@@BBU`GJGFECIHBBA@@B@@DB@@@@CDCBADA@@B@@@@@B@@@@@B@@B@AB@@@@@@@@@BC@@@@B@B@BA@A@C@IA@D@@@F@B@B@B@F@D@H@F@F@@AD@B@D@B@D@B@B@B@B@BAD@B@@AB@B@D@BA@@B@BAB@@A@E@EA@DEAABA@@B@@C@@AA@@A@@E@@@@B@@AB@@@@A@@A@@@BA@@AA@@B@@@A@@@BA@@@@@@@@B@@@BABABA@@@BDDDBDDDBFB@DFBDDFBDBDDBDF@@BDBBFFBBBB@B@DFFDBFDFBDFF@@@BBHFB@BDBDBBFDBBBBB@@@@@BABAD@B@BADAD@B@DAB@B@@@B@B@B@BA@@B@DBF@D@BBB@BB@@BBB@BBBBDBBBB@@@B@B@B@BAD@@@D@BAB@B@B@FBB@D@@@@@B@@@AEB@@AFADCD@@@@AB@B@@@B@@@@@B@@@@B@@AB@B@B@@@B@B@D@@@B@@B@@@B@B@@B@@@@@B@D@B@@@@@B@@@@@B@@@@BB@@@B@@@B@@@@B@@@@@@@@@BA@@@@@@@@@@B@@@@A@@BC@@BA@@BDBB@@DDFHFJ@BDBB@B@FBBB@BBDBBBBB@@ABA@A@A@ABAHAFCAA@C@ADABBBABABAB@DB@@DDBDAFAB@BABAD@DBBBBH@D@JB@BAD@FAF@BAH@HAF@DBBBD@DBD@B@D@D@B@BAF@DAF@DADCHCHCBADCDCD@@AFA@@@GBIDGD@@BDFFDBBBBBDD@DB@BBDD@BDA@@DCBADAB@BBBB@BBB@BBBB@BAFAD@DBF@D@F@D@DCDABADCBCDAF@D@F@BBB@F@@@AC@AA@FC@@@@BDBD@B@@DAB@BB@@BAD@BAD@@@B@@@B@@@@@@B@A@@@@@@B@@AAB@C@@@@A@@@A@@ABANA@@DC@AA@@@BAA@@@@@BACA@@@@AA@@A@@@@@@@@@@A@@DC@AB@B@BA@AB@@BB@@@@AB@@@@C@C@@DA@@BABA@AA@@@CCECA@AAC@AAA@AACCC@@@@@AA@@BA@@A@@AA@@@@A@@A@BA@ABABA@@DAD@@@BA@@C@@@@ADB@ABA@ABA@@@CB@@ABA@ACCAA@A@A@@@A@@@@B@B@@@@A@ABC@@@@HB@EH@@@@B@D@D@D@@B@DBB@BED@@BH@@@@@BC@@B@B@B@@B@@@@@@@B@@BBD@@C@AB@BHB@BA@A@A@@A@@@A@@@@C@@@AMC@@@ABC@A@ABE@@@E@ABC@A@@@@@@A@C@@C@@@AB@@AA@@A@C@A@CB@@AB@@A@@@A@@@@AA@C@@@I@@@@A@@AA@@@@@AA@A@AB@@C@@@@B@B@@A@A@@@@@@@BB@@BFA@CB@@@@A@@E@@AA@@ACA@@@@@@@@BAB@@@BB@A@A@A@AAA@@@@@C@A@@AA@@@A@AB@CA@A@AB@@@@@@B@B@@A@@@B@@@B@A@B@@A@@BC@@@@BAA@@A@@@A@@@GB@@@B@@ABB@AFB@B@@@BB@@B@@@B@A@@B@@ABAA@@@AA@@@@BGBBB@AD@@@@DBBC@@@@D@@@DD@@DBABBB@@B@ABD@AB@B@@@BA@@BA@AA@@BAC@AJBBB@@@B@@AB@@B@@@@@AB@@AB@@FL@AC@@@C@C@ABGBAAA@@C@@A@@BA@@DACC@@ACCAGECC@@CCEE@B@@A@C@@@B@@AA@@A@AA@BCEEAB@B@BA@@C@BCABAAA@@C@@AH@EEBA@@BANIBADE@@WIECUI@@GAADAB@DAB@BGFAAAAA@ACAAAACA@@ABEPGAC@G@A@I@@@IAEAG@AA@@A@EE@@@@B@BB@@B@BABABAB@B@@AAAAC@CA@@A@@@@AA@A@A@@ACBA@@@A@@BC@A@AA@A@@@@@@BC@@A@@@@CAA@@A@C@AI@E@A@C@A@IAKAAA@@AAAAGCICA@@@@B@@CCA@@@@@@@@@@AAAOGOGSIKE@@@ABA@@@@@A@@@@@@@@@@BAA@@A@@@@A@@A@@@@@@@@@C@@A@@@@B@A@@@@@@A@@@@@@AB@@@@@AAB@A@BAA@DAA@@@@A@@@@@@@AAB@A@BAABA@@BB@@@@@@B@@@B@@@@@@A@@@@B@@@A@@ABA@@B@@@@@@@@@B@A@@AB@@@@@@@@@B@B@@@BB@A@@B@B@@@B@ADB@BB@@@@B@ABB@@@A@@@@@@@@BB@BBDB@CB@@BB@@ABB@@DB@@BCJDB@BA@ABEDE@ACC@BA@@AA@A@@A@@@CII@@CCBADCB@@@BA@@BB@A@@@@@@D@@BB@@@@@@B@@@B@@B@@@B@@@BB@@@@@@@B@@BB@A@A@@B@DDDCTJBBBCA@@@@@@A@@@@B@@A@@@@B@@A@@@C@@@@A@@@@A@@BAAAA@@@@@AC@AB@A@BA@@@@@@BD@@@A@@@@@@@@B@@B@@@@@@B@ADB@@A@@@B@@@@@@@@B@@BB@@@B@@@BB@B@@@@@@@@B@@@@@@@@@@@@@@B@@@@B@@A@@BEB@B@@@BB@@FB@@@A@C@@@@@@@ABB@ADB@AB@@@@@@@@@BAB@BB@CB@ABB@@A@B@BD@@@@@@@@@B@@@A@@B@@B@@B@@BB@@B@@@@@@@@@@@@@BBAB@@B@@A@@@ABBAB@@B@@@@B@@F@@ADBFBF@@@@BABH@BA@A@CB@@AACCCPKHENMAAB@@A@C@CBE@@BC@AB@@@A@@A@AA@AAAA@AA@A@@@@@AB@@E@@AAA@@@@AAB@@AB@@@@@@A@@@@@A@@@@A@@@@@@@AA@B@@A@@@@@@@@@@A@@A@@B@@@ABA@B@@B@@A@@@@@A@@@@BAFEDABAAA@@@@AA@@@@@@AA@A@@@A@@A@@@@@A@@A@A@A@@@@A@@@@@@A@@BABA@AA@@@B@@A@@@AAB@@@AA@@@AABAAA@@@@@A@@@A@@@AAA@@B@@A@@B@B@@@@AB@D@@D@BB@B@@@@@B@@@@@BB@A@BB@@@@@@@@@@@@F@@B@@AB@@@@@@AB@@@@@@@@@@A@@@@@@B@B@@@@@@@BB@@JB@A@@AAAACAECGE@@ABAAC@@@A@A@A@@@@C@@@ABC@CCB@A@A@@A@@@@@A@@@@@AA@@@@@@@A@@D@@@AA@@@@@@@@@B@A@@@@A@@@@@@A@@A@@@@@@@@@A@B@AA@@@@A@@A@@C@@AB@@B@@@@@@@A@@@@A@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@BAA@@AG@@@AA@@@@@@@@@A@A@@BA@@@A@@AAB@@A@@@@@@@@@@@@@@@A@@A@@@@A@@@@@@@A@@A@@@@@@A@A@@@A@@@@@@@@B@@@@A@@@A@BAA@@B@@A@@@@@@A@@@A"],encodeOffsets:[[123629,31334]]}},{type:"Feature",id:"330424",properties:{name:"海盐县",cp:[120.946263,30.526435],childNum:1},geometry:{type:"Polygon",coordinates:["@@wO[KMGiUUK{QQCSEiII¦@BAPCf@@@@@B@F@@BB@@B@BB@BA@AD@B@BAB@@AB@B@B@@BD@@ADABB@AB@@ABA@@@BBA@@@@@@B@@@BA@A@@@B@@B@@@@A@CBBBA@BBA@@@A@@BA@@@ABA@@@A@A@A@@@@@AB@@AB@@@@@@A@@@@@@@A@@B@@A@@@A@@B@@@B@@@@@BAB@@@@CA@B@BBBAB@@A@@@@B@@A@@@A@B@A@BD@@@@CB@AAB@BBDBB@B@BBDB@@@@@@@A@@@@@@B@@@@@@@BB@@@@AA@@@B@@@BA@@B@@@@@@@@@@B@@@@@@@AB@@@@@@@@@B@@B@@@@B@@B@@BDC@@B@@@@@@@@@@@@@@@@@D@@B@@@@@@@@@@@@@@@B@@BB@CB@@@@@BA@@@@@@@A@@DB@@BBL@B@@@@@B@@@@B@@@@@@@@@@@BB@@A@@@@@@BB@@BBBAB@B@@@@@@@@A@A@@@B@@BB@@@A@@@@@@B@@@@@@@AA@@BC@@@@BAA@B@@@@@@@@@@@@@@@B@@@@@@@BB@@@@@@B@@@@@@B@@@@@@@@@AB@@B@@@B@@@@@@@@B@@A@@@@@C@@DA@@@A@@@@@@@@@AA@@@@@@@@@A@@@@@@@@A@B@@@A@@@@@@A@@@@@@@@@@@@@@A@@@@@@@A@B@EA@B@@A@@@@@A@B@A@@AA@@B@@@@@@@@A@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@A@@@B@@@@@@@@A@@@@@@@A@@@@JB@@BB@@@B@@B@@@@@@@B@@BAB@@@@B@@@@@@@@@@@@@@@@@@BA@@@@D@@A@@@FB@@B@@@@@BA@@A@@@@@@@BA@@@@B@@@@D@@@A@@@@@@@DB@@E@@@B@@@@@@@A@@@B@@BA@@@B@@@@@@@@@@B@@@@@@@@C@A@EAE@@@@FAB@BA@BH@@@@AB@@ABAA@@@@@B@@@@A@A@@B@@B@@@AB@@@@ADB@AB@@@B@@@@B@@@@@@@@@@AB@@B@@@@B@@@@B@@@@A@@B@@@@@@@@C@@@@B@@BB@@@@@@@@@@@@B@@@@@B@@@A@BB@B@@@BB@BBCBBB@@@BA@A@@@ABCA@D@@CDAD@@A@@@@BA@@@@@@@@@@BB@GRA@@@@BB@@@@@AFCDABBB@ABB@@@@@@A@@@A@EJDBB@@@@BB@@A@@@@B@@B@BB@@@@BDA@BB@@B@@@@C@@B@@@@@@@@A@@@@@B@@@A@@@@A@@@@@A@@B@@@A@@@A@@@@@@@A@@@@@@@@B@@@@@@A@@B@@@@@B@@A@@@@BB@B@@A@B@@@@B@@@@@@A@@@@@@B@A@BA@BB@@B@@@@BA@B@@@@@@@@@B@@@A@@@B@@@BB@B@@@@@@@@@@B@@@BA@@A@@@@@@@A@@@@C@@@@@A@@@@AA@@@@@@@A@@B@@@@A@B@C@@B@@@B@@A@@A@@A@@@@@@@AA@@A@@@@@@@@@@@@@@@B@@B@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@A@@@@B@@@@@@@@AAAB@AAB@@@@@B@@@BAC@@@@@@@@AB@@@BC@@@A@@BA@@@@@A@@@@B@@AAEBGDGB@B@@@@@@@B@@@@C@@B@@@BA@@B@@@@@B@A@@@@@@BB@@@@@B@@B@@@A@@@E@AFAB@BBBDDBB@@@@@@@A@@A@@@@@@B@@@B@@@@@@@@@@@BB@@@@@@A@@@@@@@@@@BB@BBB@B@B@@@@@@@@B@@@@@@A@@@BB@@@AB@DBB@@@B@@AB@@C@@B@@@@@@@@@B@@@@@@@@@@@BA@@@A@@@@@@@@@A@@A@@@@AB@@B@@@@@@@B@@BB@@DFA@B@@@@@@@@@@@BB@@BA@AAA@@@@@AD@DCB@@@@A@@B@B@@@B@BA@A@GBAAC@IBA@A@A@A@@@@@@BA@@B@@@B@@A@BB@@B@@B@@@@@@@@@@@@@@@@@@B@@@B@@B@BABADAA@@A@@@@BA@@BB@@@BBA@AB@@BB@@A@@@A@@@@BB@A@B@@@@@B@@B@@@B@@A@B@@BA@@@DB@@@@@@A@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@ABBAB@@@A@@@BA@BB@BD@BD@@@@@@B@ABB@@@@@@A@B@@@BAP@B@@@B@@@@B@@@@AB@ABB@@@B@@@@@@A@@@@@@B@@@B@B@@@@@@@@BB@@@@@@@B@@@@@@BB@@@@@@@@@@@@@@@B@@@BA@BB@@@B@@AB@B@@@@@@@@@B@@B@@BH@@BB@A@@@@B@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@B@@@@@@@AA@@BD@@@@BB@@@@@BBA@B@@@@@@@@@B@@@@B@@@@B@@@@@@B@A@@@@@@@@BB@@C@@@@B@@@@@@BB@@@@B@@@@@B@@@@B@BDA@DAD@B@@@D@@B@B@B@@@D@BBBA@@HFFDDBBBBB@@@ABABG@C@CBC@@B@B@@@B@@@FGBCBE@ABA@@B@B@B@@@@@B@@A@@@@B@@A@@@@@@@BB@AAB@@B@@B@@@@@@@@BB@@@@@@B@B@@CA@B@@@@B@@@B@@BB@B@@@B@A@D@BA@B@@@@@@@B@@@B@@B@@@A@@@@@@B@@@@@@@@@@AB@@@@@@@B@@@AA@@B@@@BB@@@A@@@ABFB@@@@@@AD@H@@@BB@@@CD@@@@AB@@@B@@@@A@@B@@FB@B@@@@AB@@@B@BDB@@@@@A@@@@BBB@B@@@B@@@@B@A@@DB@@@A@@FIBCD@B@@A@@@ADBB@B@@A@@B@@@@@@@@@B@@@@@B@@@B@B@A@BA@@@@@@@AB@@@@@@@@B@@B@@@@@@A@@B@@@@@@BD@@@@@@@@@B@@BB@@@B@@AB@@@@@@@@@@@B@@BBA@C@AB@FAD@JEDBB@B@@A@A@@@@@@@@@@@@B@@@@AA@@@@@BC@@B@@@@@D@@@@@@AB@@@@@@@B@@A@@@A@@@@@@@@@@D@@C@@@@@@@B@@@@B@@@@@@@F@@AB@@B@@@A@@@@D@@@B@@@D@@@@@A@@@B@@@@BB@@A@@@@F@BB@@@@@B@@D@AB@@@@@B@@BA@@DB@@@A@@@@@@@@B@@A@@@@@@D@@@@@@BB@@@A@@B@@@@@@@@@@@BB@@@B@@@@@@@@@@A@@B@@@@AB@@@FB@@@@@@B@BA@@@AB@B@@@@@B@@@@B@@@@B@@B@@@B@@@A@@@BD@DBDB@@@A@@B@A@BBBA@@@@B@@BB@ABF@@A@@@B@@BCA@@A@@@@BA@@AABA@@B@D@@@BABBAB@@@@A@@@@@@@BB@@@@@@@B@A@BCA@BD@@@B@BBD@@@@@@@B@@@@@@@@@@A@@@B@@@@B@@@@@@@@@@@B@@@@AB@@@@B@@@@@@@BB@@@@@@@@@@A@@AAB@@A@@@@A@@C@@@BB@@BBA@BB@@@@@@@B@@@@@B@B@@AB@@@B@@A@@@A@@BB@@A@B@@A@@B@@@@A@@D@@@F@@@@@B@@@@@@A@@B@@BB@@@@@B@@@B@@A@@HB@@ADB@@@@@B@@@@@@DB@@@@B@@@@@@@D@@@@@@B@@@@B@@D@B@@@@@@@@B@@@@@BA@CF@@@BFB@@@B@@@@@BB@@A@@B@@@@@B@@ABB@@@B@BD@@@BBD@@@@A@@@AB@@BB@@@@@@@BB@@@BD@BB@@@@AD@@@B@BD@DBF@BBA@B@@@BB@@B@@ABB@@B@@BA@@@@@A@@@@B@B@@BBB@B@@ABB@CB@@B@@@@@@@@B@@@@@@@@@@@@@@@D@@@D@@AF@@C@@BAA@@@A@@AA@@@@C@@@AA@@A@@@BA@@@@AB@@@@@B@@A@@@@B@@@AA@@B@@@CA@@@@BA@@@A@@@BD@BE@@@@@@@@A@@@@@@@@@@@@@@A@@B@@BBA@@BA@B@@@@B@@B@@B@@@AB@@D@@B@@@@B@@BB@@@A@BDA@@BB@@@@@@@@@@@@B@@B@BA@@@@@@@@@A@BBA@@@A@@@@D@BBB@@@@@@AB@@ABB@@AB@@B@B@@BD@@@B@B@@A@@A@BABAB@@@@ABAB@@@@CBA@@BABA@@@@DBDD@BB@@A@A@@@@B@B@@@AB@@@@@@@B@AB@AB@@B@@@B@@@@@@@@@@@@B@@AB@@@@BBBA@B@ABA@@B@@B@@@AB@@@@B@ABBA@B@@@@@@@@@@B@@@B@@BC@BBA@@@B@@@@@@@ABB@BCHDDBBDE@A@B@A@@@@BB@@DB@@@@DBB@@@@@@@@B@@@@AB@@@A@@AB@@DB@@@@BADCB@@A@A@@@CBA@@CA@@DEA@@A@@B@@@BA@@BA@@@@@@@@@@@@@@B@@@BA@AA@@ABA@ABA@ADB@A@@@@BCA@@AECEABCDCBB@@B@@B@AB@@A@A@@@@B@@@B@@@B@BIFK@@CABA@@@@@@@@@@@@@@@A@@@@@@@@BA@AKCGCNYry`iLKvuVSIAKEICCEEGGQM]@EEQG_Igwi[_KOKUCISMEEKGQCSC"],encodeOffsets:[[123900,31048]]}},{type:"Feature",id:"330482",properties:{name:"平湖市",cp:[121.015142,30.677233],childNum:5},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@A@@@@@A@@@@B@B@@BB@BB@@B@@B@@@B@@@@A@@@AAA@AAA"],["@@A@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@"],["@@@@@A@@@@@@A@@@A@@@AB@B@@@@@@@@B@@@DA@@"],["@@A@@AA@AB@@@@@@@@@@BB@@@@B@@@B@@@@A@@@@"],["@@KAwW\\kqzMZHDLD@BAB@@@@@@@@@B@@@@@@@@@@@@@@ABDB@@ELAJA@@@A@@@A@@@@@@B@BA@@B@AA@@@AACDADFBFD@BB@AD@@@@@BCA@BAB@BAB@BB@@BAB@@A@@@@@@@@@@@@@@@AB@@AB@@A@@@@BB@CF@@DB@@AB@D@@@B@BA@CDAB@@@@CA@@BA@@@B@@BA@@@@@A@@@@@@A@CA@@@@CA@@AA@@@@@B@A@BCFAACCDG@AAA@B@@@@@@@A@@AB@AAD@@@A@@@A@@@@@@@@A@B@AA@B@A@@A@@B@@@AA@@@AB@B@AABAA@@@@BA@@@A@@@@@@@@@@A@@@A@@@BAA@@B@A@@@@@@BA@@A@A@@@@@@B@BA@@ACCCA@@@@ABAB@@AB@D@@A@AB@B@@A@ABABB@@@@BA@A@@@C@@AA@A@@@BA@@AA@BA@@B@@@@A@AAC@@@@@@B@@AB@A@B@@@@@@@@ABA@@@@A@@@@@@@@@@A@@AB@ACB@@@A@@AA@@@@@@AC@@@BA@@A@@@@AA@@@@@@AAB@@AB@AA@@@@B@@@@@@@@@@@@B@@@@@@@@@AFC@@A@@@B@@AB@@@@DB@@A@@@BB@@A@@@@@@BA@@@@@A@@B@@B@@A@@@BB@@B@@@D@@B@@BB@@@B@AB@@@DE@@BC@@@C@@@@@@@@@@@@@@@A@@@@@@@@@@AA@@DAA@BA@A@AA@@@A@A@@B@@@@@B@@AA@@@AA@BA@@@AA@@A@B@AAE@CAC@A@A@@@A@@B@D@BAB@D@B@BBB@@BFBB@@@B@B@D@BB@@B@@@DAD@@@D@@AF@B@B@D@B@B@B@@AB@@@D@D@B@@EB@BF@@@@F@H@FAF@HAD@D@BA@@B@@@DBA@B@B@B@B@@B@HAFAB@D@F@VDFBB@@@@BALA@@BD@@B@B@B@FB@@B@@@@H@@@@DE@@B@@@B@@A@@@A@@@A@@@@@@@@BA@ABE@CA@@@@A@@DBF@D@B@H@BBB@D@BDHFJA@CA@B@@@@C@A@AAE@@D@DAH@@ABA@A@BCA@@@@@@B@@@@E@AB@@DDBB@BAD@DAB@@@BD@@B@BAB@BCA@B@@@BB@@@@@B@@D@B@FL@DB@@@@@D@@@BB@DBNB@@@C@@@ADAB@@@DABDFA@@B@BA@A@@@@D@@B@@B@D@AG@AFB@@@@@@D@B@@@BBF@F@BAB@A@B@@AB@@@@@@BB@@@B@@B@B@DB@BBBBDD@@@@@@B@B@@AA@@A@@@@@@DDDA@@@A@AB@@BB@@@@B@B@@B@B@@@BBD@AD@@@BA@@@@@@B@BA@AB@@@@@@@B@@@@@@BB@@@D@@@@F@D@@@DB@B@@@D@F@B@@BB@@AB@@@@@BD@B@@@AT@@@BF@@@FBJBCHA@AB@BA@BC@AA@A@A@AB@@DB@B@B@@@@BB@@@B@@B@@@@B@@ABA@@BB@B@B@BBBCBAB@FAENJBJFLDAB@D@BADD@CDHDDBBBB@F@B@D@J@B@B@B@FBBBDBHDFDFB@@B@A@@AB@@@@A@AB@B@@@@AA@BADA@AD@BAFDF@BD@@B@A@@BB@@@B@@A@@@@BCJCBBB@@@BA@@@A@@BAEGAA@ABCACCCB@BBBA@BF@DAD@D@BABDBABABAACBA@@@CDA@D@@BB@A@A@A@A@C@@@@@@A@@AAA@AA@AA@AAA@AAA@@@AA@@@@@@A@@AA@A@A@@@@@A@@@@AA@@@@@@@A@A@AAC@A@A@A@@@A@A@@AC@@@A@A@A@@@@@A@@@A@@@A@@@C@@@A@A@@A@@@A@A@C@A@A@@@A@@AA@@@@A@A@@@@@A@@DA@@BA@ABAB@@A@A@@B@F@B@B@B@@AD@@AH@B@BB@@DB@A@AB@BB@@D@@@@@BB@BD@D@BAD@D@DABABBB@BA@@D@@@@GBBBBB@D@BBD@B@@@@BB@B@@A@@AAB@BAB@BA@AB@DBDBB@B@BBB@@@DBBB@B@B@@BBB@BAB@@B@@BB@@@ABABABADCBCDBD@ADAF@DBB@B@BB@FBDBH@H@H@BA@ABCBABCBCBADBB@@C@A@AB@BAAABCBABCBC@EBE@C@AFADAAABA@BD@@A@@BBDAAABABBDAHCLEDAB@BADEBCDAFEB@@BB@DA@AB@@AAA@A@@BA@AAA@@B@@AA@DC@AAC@@AA@A@C@AA@CABEAA@@AA@A@A@ABA@@@@B@@A@A@A@ABAD@@AA@BA@AA@@AB@@AB@@GBIDUC@[CCCYeCEEEOIUG]GcEaO]SCAEACCAC@E@G@A@A@@@AA@AAA@EAOGC@ABE@ECCAEEKGOCQGQE"]],encodeOffsets:[[[124055,31329]],[[124038,31326]],[[124039,31322]],[[124037,31313]],[[123998,31311]]]}},{type:"Feature",id:"330481",properties:{name:"海宁市",cp:[120.680239,30.511539],childNum:1},geometry:{type:"Polygon",coordinates:["@@GSAAGQAA@AIUQHSRWTEB@BA@ADDLDJCBBFJNDF@@B@PBD@@@BBBB@@AX@@@@A@CAC@@@CAGAG@EAA@A@A@CAAAA@C@AAA@C@CAAA@@@@A@@@CAC@@@AAA@C@E@@@CAA@AA@@@@@@@B@@@@@B@@BB@@@@AA@@A@@@@@@A@@@A@@@@AA@@@@@@@A@AA@@AA@C@@@A@@@@B@@B@@@@B@@@A@B@@@@@@@@@@@@A@@B@@@@@@@@A@@B@@@@@@CB@@@B@@@@@@@@@@@@@@@@@B@DEA@B@B@@A@@A@A@@@@@A@@A@@@@@@@@@@@@AAB@@@BC@A@A@CBABC@@@@@AB@@A@@@A@@@@A@@@@@AABA@A@@BA@@BA@@B@@@@@@@B@B@@@@@@@B@@@B@BA@@B@@@@@@B@@BA@@BB@AF@@@@@BA@ADAB@B@AA@@@A@@AC@A@AA@@@@@BA@AAA@@D@@B@@@@@@@A@@B@B@@@@@@BB@@@@BB@@B@BBBB@@ABBB@@@@BCBAB@B@@@@BB@@@@@BB@@@B@@B@@@@BB@@@B@@@BBB@@@@D@@@B@@A@@BD@@@@B@@BB@@BB@@D@@B@@@BB@@@B@@@@B@B@@B@B@B@@B@@@@@AB@@DB@@B@@@BB@@@@@@@@@@B@@B@A@@@@@A@@BA@@@@@@B@B@@@@@B@@@@@BABAB@@@@B@BB@B@@@@B@@@@@@@B@@@@@B@@@@@@@B@@BA@@B@@@@B@@@AD@@B@BB@@B@B@@@@@B@@@@@AD@@@@@B@@@D@B@@B@@BB@@@@@@@@@@B@@@B@@A@@@ABB@@@@@@B@@@@@BA@@@B@@HA@@B@@@@@@A@A@@B@D@@@D@@ADB@@@@@@@@@@BA@@@B@@BB@@@@@@@@BA@@B@B@DBBB@B@@@@AB@@A@B@@B@@@@@@@@B@@@@B@@@AA@@B@A@B@@@@@@@@B@@@@@B@@@@@@@@A@A@@@@@@@@B@B@@@BABBB@@B@@@@@B@@@@@B@A@@BB@@A@@@@B@@@@@@B@@@@@@@B@@@@@@AH@@B@B@@@@B@@B@@@FBB@@@@@@@F@@B@@B@@A@@BA@@@ABB@@DB@@@B@@B@@@@AB@@@A@@BD@@@@@A@A@@@@B@@B@@@B@B@@@A@@@@@B@@BA@@@@@@@B@D@@@A@@A@@@@B@@@@CB@@@B@@A@@BA@A@@@@B@@A@@@@A@@A@@@@B@B@@AB@B@DD@@@@@@B@@B@D@BB@@@B@BB@@@@@@@@@B@@BA@@@@@BAB@@@BA@@@@B@@A@@@@BB@@D@@@DB@ADHDBA@ABBFB@@B@BAB@@@B@B@@B@BB@@@BAB@BAD@@A@@@@@@@@@@BB@A@A@@@BHAB@HCLA@C@@B@@A@@@@@A@@B@@@@BB@@B@@@@B@@B@A@BB@D@LC@D@@@@@BD@@D@@@@H@@@@BA@@BD@@H@@@B@B@F@F@@@@D@@@@@B@B@BA@BB@BEBCBEBCBBB@@@B@@@@B@@@B@@B@@@B@@@@@@@@@@@@@@@B@B@@@@@B@@@@@@@B@B@@@B@B@@@@@B@BA@@BB@AB@BB@@B@ABB@A@@B@@B@@@A@@@@BB@B@B@@AB@F@@A@@B@@A@@@@B@B@@DAB@@@D@@@@B@@B@@@B@@@BB@@@HBF@@A@@@@BA@@@@@A@@BA@@@@@@AAB@B@BA@@@A@@@A@@@@B@@C@@B@@BB@@@@@@A@AD@D@B@B@F@D@D@@@BB@@@@DBA@@BB@@ADBB@@B@@@BD@@@@@@CA@@@@@@@B@@@@@@A@AD@@@@@B@BG@@@@@@@@B@@A@@@@@@A@BAFB@@@AB@@AD@@EB@@EL@DBP@@CB@D@@@@A@@@B@@@@B@B@B@B@@B@@@@@@BBFBD@B@J@H@D@F@LB@BBB@@B@@@A@@@AB@@AA@BDB@BADEAAB@@@@@B@@@@D@@@@@BB@A@BAB@@A@@@@@A@@D@@@@A@BB@@@@@@@@@BA@@@@@@@D@@@B@@B@@@BAB@BA@@@A@@@@@A@@@@BB@@BB@@@@@D@@B@@@@@BFBB@DBBBBBD@@BBDD@@F@D@B@@@BB@@@@@@@@B@@@@BBD@B@@@B@@@@@@@@@D@AB@@@@@@B@ABB@@A@@@@B@B@@A@@B@@@@A@@@A@@D@@B@@@@@@@@@@B@@@@@@A@@@@BB@@@@@@@@D@B@@@@@@@B@@@@B@@D@@@B@@@B@B@@@@@@B@@@@@@@BA@@B@@@B@BA@@@A@@@C@@BB@@BD@@@@BD@@@@AB@@@B@@@BA@@BAB@@@B@@@B@B@B@B@B@@@@B@BAD@@BB@B@@@@@D@B@BAB@B@@@B@@@@B@@@B@@B@@@A@@@A@@D@@@@A@A@A@@BA@@BBB@B@B@B@F@B@D@@@@@@@BA@@@@B@B@@@@@@AD@@@@@B@@@@@@BB@@A@@@B@@B@@@@A@@HB@B@A@@F@B@B@@@@B@@F@@@@@B@D@@@B@B@B@@@BBB@D@@@@@B@B@D@B@B@@A@@@D@@@BAD@@@B@B@@@@BBB@D@@BAB@BAB@DAD@@DBB@BBB@FBDBB@AD@@AB@@ABAB@@C@@@@B@@@@@BA@@@@@BB@@CB@@BBA@D@ABAB@BA@BBAB@@ABA@@@@@C@@@CF@@B@BB@@D@AD@BA@@B@BA@AA@@A@@@@AAAAB@@@@A@A@@@@B@@A@@@@@@B@@@B@B@B@@BB@@@BDBDD@BA@@@@BB@A@B@@@B@ABA@@@@A@B@@BB@@@@@@@@B@@@@@BA@@@@@AB@@@A@@@@@@@@@@AB@BB@C@@DBBA@BB@@@@@@@@@@C@@@@@@@@@@@@B@@@@@@C@@@@@@@@@@@B@@B@@A@@@@B@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@AD@@A@@@@D@D@D@B@@@@@@@BA@@B@ABB@@A@@@DB@@A@@@@@BD@@@@@@B@@@@@@BB@@@AB@@AFBJB@@F@B@D@@B@B@AB@@@@@@BB@@CB@BBB@B@J@@@A@BCDBB@FA@@B@D@FAH@B@B@B@BDB@BBBB@@@ABBB@@A@@@B@@BAAA@B@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@CA@@B@@AA@B@@@@A@@@AA@@@@@A@B@A@@A@@B@@@B@@@AA@@BAB@AA@@A@@AB@@A@@B@@@BBBCBA@A@AA@@@A@@@@@@@@@@@@@@@@@@@@AA@@@AAB@@@@A@@@AB@@A@@@@B@B@B@B@JAD@BBHAB@B@@A@A@@@A@AB@@@@@DA@CBC@@@@B@BBB@@AA@@A@@@@@@@@@@@AEB@CA@@AA@@@@@@@A@@@BA@@@@@BB@@@@@@@@@B@@@B@@A@@@@@@@@@@@A@@@@@@@@@AD@@@BA@@@A@@AA@CBA@@A@@A@@@B@@@@A@@@@@@@@@@A@AAA@AAA@@@@@@@@@@@B@@@@A@@A@@@@@@@@@@@A@@@A@@@@B@@@@B@@@@@@AACCAA@ABABEF@@@B@@@A@@@@A@@@@AA@@@@@@@B@A@@@@@AB@@A@@@AD@@@@@@A@@@@@@@AHAHCFABB@@@A@@B@@@@@B@@AB@@@D@@A@@BA@@@@@@@@BD@A@@@A@@@@BA@BBABB@@@@@@@@@A@@B@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@AA@@@@@@@@@@@@@@@B@@@BB@@@@@@B@@@@BB@@@@A@@@AD@A@B@@@@@@AB@@@@@@@B@@B@@B@@@@@D@@@@@@B@@@@@@@BB@@A@@@A@@@@@@@@A@A@@A@@@A@@@B@@@A@@@@@@@@@AAB@@@@@AA@@AABB@A@@@@@@@@B@@@@A@@@@@@A@BA@A@@A@@B@@@@A@@@@@AB@@@@@@@@A@@@@@@B@@@@@@@B@@@B@@@A@@@@B@@@@@B@@B@@@A@@@@@B@@@@@@@@@@AD@@@@@@AA@@ACB@A@@A@@A@AA@@@@@@BA@@A@@A@CAFIB@@@B@@@@@@@AA@BAABADCBE@@@@A@@A@@B@HQA@@A@@@@@@@@B@@A@@B@@@BCDC@@@CDBBA@@B@B@@A@@AADAAAA@@A@@@AAAB@@@A@@@@@A@@@@@@@@@@@@@AA@@@A@@D@@@@@@@@@@AB@@@@@@A@@A@@@@@@AA@@B@@@@@@@@A@@@@@@A@@BAA@BC@@@@BA@@A@@@@AB@B@@@@@@A@@@@BBBA@@BA@@@@AGB@@ABA@E@@F@FBB@D@@@@@@@@@@A@@@@@@@@A@@@B@@AA@@@B@@@@@@@A@@@F@@@CA@@@@@@B@@@C@@@@@@A@@B@@A@@@@@@@BB@@A@@@@@AA@@E@@@BC@@@@@AB@@@@@@@@@@@@@@@@@@@A@@A@AB@@@A@@@@@@@AA@@@A@@AA@@I@@B@@@@@@@B@@@@@@@@@@A@@@B@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@@@@@AB@@BB@A@B@@@@@B@@@@AFBA@B@@@@@@@B@@@@@@@@@@@@@@@@B@@@@B@@@A@B@@@@@@@@@@B@@@@@@@@BB@@@@@@@@B@@@B@@CD@@@@@B@@@@A@@@@@@A@@@A@@@BA@@@@@@@@A@@@@@@@@A@@@@A@@A@@@@@@@A@@@@@@@@@@@@@@@ABB@A@@D@@AB@@B@@@@@@@A@@@@B@@@A@@AA@@@B@B@@@@@@@@@@ABAAA@AA@@A@@@@B@@@AA@@@@@@@@@@A@@@@@@A@@@@@AAK@AA@@CB@@@@@@@B@@A@@@@DAA@@AA@@@@@@@@@@@@@@@A@@@@C@@@@@@@@@@@@@@@@@AD@AC@@@AA@@@@@@AA@@@@@@@@@A@@B@@@@@@@A@@@@@@@@A@@@AB@@A@@@B@@B@@A@@A@@@@@@@A@@@@B@@@@@@@A@AC@A@AAAAC@ABA@BDA@@@@ACB@A@B@@@B@@@@A@@B@@@BAAA@A@ADB@@@@BA@A@@@@@A@@@AB@@@B@@@@AB@@@@@@@B@@@@@@@BA@@BA@@@@B@B@B@@@B@BA@@B@@AB@@@B@AAB@AADAB@@@@@@AA@@@B@B@@A@@@A@@@@B@AA@@B@BA@@BAA@BABC@@AC@@@A@ABA@@BA@A@ABCB@@AAAA@@@AA@@@E@A@@@@DeBO@AJ¥mIq@]BG@wRIBKDIDSJUNABGHGHCLELAN@^@\\@RAZ@BAFABADABCDABCBA@SFODE@EBGBG@GBCB[DCBM@C@Y@C@W@E@[AQ@Y@O@k@E@C@MBe@E@M@A@UCUCWCGAGAC@GAGCEAAAA@CCAA@@AAIUGQAC"],encodeOffsets:[[123319,31080]]}},{type:"Feature",id:"330483",properties:{name:"桐乡市",cp:[120.565098,30.630173],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@EA@@A@@@@A@@A@A@@@BG@@@@@@@A@@@@@@@A@@@@A@@@@@@BA@@AB@A@@@@@A@@@@@A@@@A@ABAB@@A@A@A@@@EE@@@BA@@@A@@@@@@@@A@@@@@AA@@BA@@@@@A@@@@@A@@@@@@B@@@B@@B@@BB@@@@@AD@@A@@@@B@BA@@B@@@B@@@@@@@B@@B@@@AB@@B@B@@@@@@B@B@@@B@@@@@@@@@B@@@@@BA@@BB@@@@@A@@B@@@@B@@B@@@@@BA@B@A@@B@@@@B@@B@@@@@@@B@@A@@B@@@@@@@@@B@B@BA@@@@@@B@B@@@@@@@B@@ABB@@B@BAA@@AD@B@@@BA@@@@BAA@@AD@@@BB@B@@H@BAB@B@BA@A@@@@D@@@BAB@@@BC@E@A@A@@A@@@@A@A@A@A@@@@BA@C@AAA@C@C@@@@@@@AB@@@@@B@B@@@@BB@@@@A@@@@@BB@@@@@D@@@B@@@@@@@B@@A@@B@@@B@@@@B@@BB@@BAB@@@BB@@@@@@@@@B@@B@@@@@B@@B@@F@@@BA@@@@@@@A@CJ@@@A@@@B@@@@@B@@@BAA@D@@@@@B@@@@BA@@B@B@@@@BA@@@@@@B@@B@@D@@@@@B@B@AAD@@@B@BAB@B@@@B@@@@@@@@@@@BAAAB@BAB@B@@@@@@@@@@@BB@@@@@@BB@@@@A@@A@B@B@@A@@B@@B@@@@@@@@B@@BB@@@@CB@B@B@BB@B@@@@B@@@@B@@@@@@@@@@@@@B@@@@@@@@B@@@B@@@A@@@@@A@@B@@B@AB@@@@@@B@@@@@@A@@@@B@@@B@@B@@A@B@B@@@B@@@@@B@@B@@@@@@@@@@BB@@@@@@B@@@@@@@@@@@@@@@@@@@@BD@@@B@@@A@@B@@@@B@@@@BA@@@B@AB@@B@@@B@@B@B@@@@B@@B@@@F@BA@AD@@@@A@CAA@@BCDABABCDEDGFAB@@@B@@BA@@@B@@@@@BB@@@ABB@@@@B@@@@F@@@@@@D@@@@B@@@@A@@B@@@@DC@A@AD@BB@D@B@@@@BABA@@B@@AB@@@@BBF@B@@@@B@@BB@@B@BBBB@@@@B@AAB@@AB@D@@B@@B@@AB@@@@@@A@@@@B@@BDA@B@@D@D@BBH@@@B@@@B@B@@B@@@BD@@@B@@@B@@B@@@@@@@@@BB@@@@@@B@@@BB@@@@@@@@@@@D@ABBBA@@BB@@@@@ABA@@@@@@@@BA@A@@@@B@BA@@AA@A@@@@B@@@B@@B@@@B@D@BAD@@B@B@@@D@BB@@B@@@@@@@B@@@@@@@@AB@@@@@B@@@@A@@BB@B@@B@@@BB@@@@B@@@@B@@@@@@B@@@B@@@@@@B@@@@DA@@B@@A@@BB@B@@D@B@@@B@@@DA@A@BFA@@@A@@DC@@@A@@@@DDABBA@@@@D@@B@@@BADABB@@@@@AB@@@@@@@@@@@BB@A@@@@@@@B@@@@@AB@@B@B@@B@@A@@@B@@@@@@@FB@@B@@BA@@@B@BBB@@BCD@@@@CD@B@@@@@B@@@AA@@@AB@@A@@@@B@@@@@B@@@@@@AB@@C@@@@DA@AD@@BB@A@@@@@@BA@B@@@@BB@B@@AA@@@@@B@@@@@@B@@B@B@@@@@@@BB@@@@B@B@B@FAD@@@BD@BBB@D@BA@@B@@@B@D@@@@@B@@@@@BFB@@@@BB@@@A@@@@@@@@@B@@BA@@B@BBB@BAD@B@@B@@B@@@F@D@@B@@B@@@@@@@@@D@@@B@DA@@@@@@D@@@@@BA@@BDBD@BBD@@@@DAHBD@B@B@B@@BD@BB@@D@BB@BB@@@BAB@D@BB@AB@B@B@@@B@@B@@@A@B@@@@B@@A@@B@@B@@@@B@@@@BB@@@@@@@@@@@B@@@@@@@@AB@@AB@@@@@@@@@@@@ABB@@@@@@B@B@@AA@@@B@@@@@@B@@B@AAB@@@B@@B@@@@@@AD@@D@DAB@@@DA@BA@A@@B@BB@@@BA@@AAD@@@DF@B@BCB@@A@ABA@AB@@@@@@@B@@A@@@@@A@@B@@@A@@AB@B@@@@@@A@@BB@@@A@@BA@AA@@@@@@@A@@AB@@AA@@@@AB@B@D@@@@@AB@B@@@@@@@@@@@B@@B@@@@AB@B@@@@A@@@@@B@A@@B@@B@@@@B@@@@@B@B@@B@B@@@@B@B@@D@@CF@@@@@@@B@B@@@@@@@D@B@B@@@@@@@B@@AA@@@@@@@@AA@@@B@@B@@DB@@@B@@@@@@BB@A@BB@@@@@@AB@@B@@@A@A@A@@@@BB@@@A@@BBBB@@@@D@@@BA@@BBHHFFB@B@@BB@@@@@A@A@BB@@B@@@@@BA@BB@@@@B@@@@@BA@@@@@BFDFFHDH@@BB@B@@CB@@A@@BB@@@@B@@@B@@@BC@@@A@@@@BAB@B@@AB@@@B@@@@@BA@@@@B@B@@A@A@@A@@C@@@AFBDA@@@@@@@@BA@@@A@@B@@@BB@@B@@@B@B@BB@@@@@@@@BA@A@@@@B@@@@@BB@@@@@@@B@@@@BADAB@BADAD@@BBD@B@B@F@D@BCB@B@B@@@BBB@D@B@@@BAB@BAB@DAB@BAD@B@DABA@@B@@@B@B@BDDBBDBBBB@@@@HJDDBB@@DFBDDFDD@BBBFFBDBBDHB@B@BABA@A@@@A@@@@@@B@@A@@@B@@@AB@@BB@@A@@@BB@@@@@BA@@@A@@F@@@@BB@@BD@@@@AB@BAFB@CFBF@B@@@BA@A@AB@@A@C@ABA@@@ABC@A@A@A@A@A@C@A@C@ABC@@@E@E@G@C@E@A@A@A@E@@@CJBD@B@B@@A@A@A@@D@@A@@@@@@@@BAA@@@@A@@@@@A@@@@@AB@BCDADC@@@@CA@@@AB@AAJGFDHEHIV_AA@@A@@@@A@@@@DBFDDBDBB@FD@@@ABAFG@@BABC@@@@BABABCDCDCBAB@@A@@F@@A@A@@B@F@B@@@@@@@@@D@@@B@@@@@@@@@B@B@@A@@@@@@@@B@@@@@@B@@@C@@@@@@A@@@BA@BBA@@@@@@@@@@BB@A@@@@@@@@@@@A@@@@@@@@A@@ABA@AEA@@AAA@A@B@@@BAA@@AA@@A@CB@@@B@B@@AA@@@A@AA@@@B@@@A@@AB@@@AA@@@@@@AA@@@A@@A@ABC@CAAAB@@A@@A@@@ADCGCAA@@@AAA@A@@B@@@@@@A@@@A@@@@@@@@@@@@@@@A@@@@@@@AB@@BB@@A@@@A@B@@@AA@@@A@@@@@@@C@@A@@@@@@@@A@@@@A@@B@B@@B@@@@B@@@B@@@B@@A@@@@B@@@@@@A@B@AB@@@@@@@@@@AA@@@AB@C@@B@@AA@@ABABC@AA@@@A@@@A@C@BA@@@@@A@@@@A@AA@@A@B@A@@A@@B@@A@B@AA@@@ABAABA@@AB@@@@@@@@@A@@@@BA@@@@A@@@@B@@@@@@@@@@@A@@@@@@@A@@A@@@@A@@B@@@@@@A@B@A@@@@@@B@@@@@@AB@@@B@@@@@A@@@@@@@A@@AB@@@A@@@@@@@B@@@A@@A@@B@A@B@@AAAC@@B@@@BCB@A@@@@@@C@@BB@@@IBCB@A@@@A@@BCAA@A@@@BA@@@A@@A@@@@@@B@@@@@B@@@@@@@@@@A@A@@@@@@@@@@@C@@@BB@@@@@@AA@@@A@@@BA@@A@BAA@@@@AD@@@E@@@@@@BA@@@@@@AA@A@A@A@@@@@@@C@@BD@@@@@@@A@@@B@@B@@A@@BAA@B@@@@@B@@@@@@@AA@@BC@@@BC@@@@C@@AB@@@A@@A@@@BB@@A@@@BB@@@@@BAAC@C@@@ABA@@BCBABCDC@@@BB@@@@@@A@@@@@A@@@@@@@ABB@A@@@@A@@A@A@A@@@A@@B@BB@@@C@@BA@EBA@A@ABA@CB@B@@BBA@BD@@@B@@AB@@@B@BAD@B@@BBB@@BAB@@@@B@@@@@@B@@@A@B@@@@@@@BA@@B@@@@@@@B@@C@@@BB@@@@@@A@@@A@@@D@@@@@@@B@@@@BA@@@@@@@B@@@@@@@B@@BA@@@@@@BABBB@@@BCBC@@B@@@@@@AB@@@B@FD@A@@AA@@@A@A@A@@@A@@@@B@@@@A@@B@B@@@@@BABB@B@@B@@@BBB@@A@AB@@ABCC@@@AAA@@@DE@@D@@@@@B@BA@@BAAAB@@ABABAC@B@AA@@DA@@AA@@@@B@@A@@@@@A@@D@@@BABA@@BA@@BCA@CAEAA@AAA@CA@@BC@CBA@ABA@AC@A@AA@@@@@A@A@@BC@A@@@C@@@BA@A@C@A@A@@@@@C@A@AA@@A@A@A@@@C@A@@@@@E@@@@A@@A@A@E@@@@B@AGA@@@B@@A@@@@A@@@BA@@A@@@@A@@@@@C@@B@@@@A@A@@@@@AB@@@@@@C@A@E@A@A@A@A@AA@@AB@@@B@B@B@@C@@@@B@@@B@@@AA@@@A@@@@@@A@@@ABA@A@A@C@@@@@AAA@@BC@A@A@@A@A@A@A@A@@@A@@@A@AB@@AB@@A@@@A@@B@@C@@A@@C@@AA@@AD@@@B@@@B@@A@A@@@AB@@A@@@@@@@A@@@@A@A@@@A@@@C@@@@A@@A@@@@@@@A@C@@@@@@@@@AA@@@@@B@@@@A@@@@@@@@@@@@AC@@@@B@@@B@@A@@@@BA@A@@@@@@BA@BAA@@@@@@@BAC@@@@@@@@@A@@@A@C@AA@@@@@A@@@@@@A@@A@@@A@C@EC@AC@AC@AAAACAA@EA@A@@@@@AC@@@@@A@@AA@@A@@B@@@@@B@@@B@@ABA@A@@@AA@@@C@@@@@@@B@@A@@@@@@@@AAB@@@@@@CB@@@@@B@@@BA@A@BAA@@@@C@@@@@@A@@@@BAFBBC@ACA@ABB@@BA@@B@@@A@@@AA@AKAE@C@G@I@A@C@EAAA@@@@@@@AA@A@A@A@@@@@@A@@@B@@C@A@@DO@CAK@@FA@@FC@@BA@@B@@EAABB@@@@@@@@BA@@@@@@@@@AHA@@@@@C@@B@B@@@@A@@@@@@@B@@D@@@@C@@A@@@AA@CA@BA@@AB@CA@@@@AA@@C@C@E@A@A@C@C@@B@B@@@@A@@AA@@@@DA@@@@@@B@@@B@@ABA@A@BB@@@@@@AB@@@B@@@@AB@@@@@BE@GA@@A@@A@@@A@@@AA@@@@@@C@@BA@CA@A@@@@@@BA@@@@BE@A@@BA@A@A@@A@@B@@@A@@@@AB@A@BAA@@@AAA@@BAA@@ABA@@@@@A@A@@@A@A@@@@@@@A@@@@@A@A@@@@@@@@@@@@@@@A@@@A@@@@A@@@A@@A@@@A@AAADAFADAFA@@AABA@A@@@@@C@@@@@@E@E@A@A@@@GC@@AB@@A@@G@@@@@@CC@@A@@@@@CKDC@A@@A@B@AA@@@@@@AA@@A@@A@@@@B@@@@@BA@@@@DKBGDA@GB@A@@@B@BAA@@@@@@@@@@@BC@ABA@AB@@A@@A@AA@A@@@A@ABA@@@EAAA@BABGCBCA@@C@@@CA@@A@@B@@@@A@@B@@A@@BA@A@@@@AB@@@A@@@@@@@@AAA@@@A@@A@C@AA@@@@@@@CCA@A@@BA@A@@@@@@BB@@@@@@BA@@@@@@BAB@@@BA@@@A@@D@@A@@@@@@BB@@@C@A@@@@@@@B@@AA@@@@@B@@@A@A@@@A@@@@A@@B@B@@@@@C@@AB@@@A@@B@@A@@@@A@@CA@@AA@B@@AB@@@BA@@@@AE@@@@@"],encodeOffsets:[[123240,31211]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
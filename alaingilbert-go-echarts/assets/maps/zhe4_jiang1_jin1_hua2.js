@@ -0,0 +1,5 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("金华",{type:"FeatureCollection",features:[{type:"Feature",id:"330703",properties:{name:"金东区",cp:[119.69278,29.099723],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AA@AB@@@ACA@@@AB@@AAA@ABA@A@A@@A@@A@@AA@AB@@AAAAB@@@@@B@BB@AB@@@A@@@AAAA@@@@@AAA@@@@A@AB@@@A@@A@@@AA@@AACA@@@@@A@@A@CACAA@BB@@@@@@AB@A@@C@@@@@A@@@@CA@@@@@A@@B@@@@@@BCA@@@@@@@@@A@@@AB@@@@@B@@@@AB@@CFABA@ABA@ABA@A@A@@@A@CACAEAAAAABA@@@AA@@@A@@@AB@@@@@@@B@B@@C@CBA@A@@B@B@@@@FDJDBB@@B@AB@BABAHCHAH@D@D@@@JBB@DBB@@BBDDBBBBBB@BBD@B@B@B@B@B@@ABA@ABA@EBMDA@CBGD@@C@ABAAGA@@G@@@A@BA@@AE@E@AACA@@@@BAD@@@@A@C@A@@@@AA@@@A@@@@@@@@A@@@@AB@@A@@A@AA@AAA@@@@@@AC@@@@C@@A@A@E@ABA@ABCBCBABCDCDA@@B@@AB@FBD@DAD@B@BCDCF@@@FBBBBBF@@D@@@@B@@@BB@@@@@BA@@@BB@@@@@@B@@@B@@@B@@@@@@@@@B@@@A@A@@BB@@@B@@AB@@@BA@@B@@@BA@@@CBA@CFABABA@ED@@@B@@@@BBCB@@@@BBFBB@@@@@B@@ABBAF@B@@@DB@@@@@@@@@BAB@@B@@FABBB@B@ABBB@BB@@ADBABDB@@ADCD@@ADA@E@ABABAA@AAF@B@B@@@B@@@D@@C@A@@@AB@@@D@@@@@@@@@@A@@@@BB@@@@@@@@BB@@@@@@@@@A@@@@@B@@B@@@B@@@@@@BA@@@@@@@B@@@@@@BB@@@A@@B@@B@@@@@@B@@B@@@BB@@B@@B@@@@B@@@@@@@@A@@B@@@@@@B@@@@@@B@@@@B@@@@@@@BB@@@@@@@@@B@@@BB@@@@@BB@@@AB@@@@@@@@B@@@@AB@@@@@@@B@@A@@BF@@@@@@B@@B@B@@@A@@B@A@@@@@@@B@@@B@@@@@AA@@@@A@@A@@@@B@@@@@@@@A@@@@B@@@BB@@@@@A@@@@@@B@@@@BB@@@B@@@@@BAA@@@BBBA@@@@@@@@@A@@@A@@B@@@@@BAB@@@@AA@@@B@@@@@A@B@@@@@@@@A@@@@@ABAB@@BBB@@@@@B@@@AB@@@@A@@AABBB@B@@A@@@@@B@@B@@@@@BB@@@@@A@@@@@@B@@BB@@@@@@@@@B@@B@@@@@@@@BAAABB@@B@@@B@@A@@@BB@@@BA@@BABA@@B@BA@@B@@AB@@A@A@A@@B@@@@@B@BA@ABAB@@A@@@@B@@BBAB@@@@@@@A@AAA@@@B@@@B@@@@A@BAA@B@@A@@@@@@A@AB@@@@BB@@@B@BBB@@@@@D@@@@B@@@@B@@A@@@@B@@B@@@@@@@A@@@BB@@@@B@A@@B@@@@BB@@@B@@@@B@@B@@@@@@CB@@@@@@@BA@@B@@@@@@@@@B@@@@@@B@@B@@@@@@@@@B@BB@@@@@BA@@@@@BA@AB@@@@@B@BA@@@AC@@A@@@AA@@@@AA@@BA@@B@B@@@@AA@@@A@@@@@@@BA@@@@@A@AA@@@@B@BA@AB@@@BB@AB@@@@@@A@@B@@@@BB@@B@B@B@@B@@@@A@A@A@@@@@@BA@@@A@@BBB@@AB@B@@@@@BBBBBBB@B@@D@B@@B@@@@B@B@B@@@@@@@@@@BB@@@BBB@@@@@@BAB@@@D@@B@@@BB@@@B@B@BB@@@BB@B@@@@B@@B@B@B@@@@D@@@@@@@AB@@B@B@B@@@B@@@@@BA@@@A@B@@B@B@B@B@@BB@B@@@@@B@AAB@@A@@@@B@B@@@B@@@@@BBB@BB@BB@@@@@@B@B@@@@@B@@B@@AB@@@D@BA@@BA@@@@BA@@B@@A@@B@@@B@@@BB@@BBBB@@B@B@@@B@B@@B@DBB@BA@BB@@@@@@BA@@B@BA@@@BB@B@B@@@@B@@ABAB@@D@BB@@BAB@@BB@A@@BB@@B@@@@B@@BB@@@DB@@@B@@@BB@BB@@@@CBB@@@@BB@@@@@BA@@@@@B@@@BA@@@@@B@@@AB@BA@@@@B@@A@A@@@@BB@@@@@@B@@B@@@BBD@B@@BDABBB@@@B@B@B@B@@@@BBB@@@@BAB@@@B@@@B@B@@@B@@BB@DBBBB@B@@B@B@B@@BB@B@@@BB@@@AB@B@@@@BDB@@B@@B@B@BBB@@@BBB@@BB@B@@BB@DB@@@BB@BBBB@@BBBD@@BB@@BB@BB@@BBBBB@@@A@@@AA@B@@@BBBBB@@@@B@@@@B@@AB@@@@AA@@@A@@@B@@@@ABB@@B@@@@@BB@@@@B@@@@@@@@@BB@@B@@@B@@@@@@@@@A@@BA@BB@@@@B@@@@@@B@@B@@B@@@A@A@@@A@@B@@@@D@@BAB@@@@@@BA@@@@@@B@@@@BAB@@@@@ABA@@BA@@@@@@BDB@@BA@@@@B@@@@BB@ABB@@@BB@@BBB@B@B@@@BBB@@@B@@BD@@@B@@@BB@@@@@B@@@@BBB@@BB@B@@@BB@@@@@@B@B@@@BBD@@@B@BBB@@@@@B@@@BB@@B@@@@@B@@@@BBB@@B@B@@@B@@@B@@@BA@@BB@@B@B@@@B@@@BA@@B@@@B@B@@@BA@AB@BA@@B@B@@AD@DA@@B@@@BB@@@@BC@A@@@AB@@A@@BA@@B@@AB@@BBA@B@AB@@A@@A@@AB@A@@@BA@A@@BB@@B@@@@@B@BABAB@@CB@@@BA@@@@B@B@@AB@@@B@@AB@@@@@@A@AB@B@@AB@@@@@AA@@@@@@B@@@B@@@@@@@B@@@@@@BDA@@@@@@@A@@A@@@@A@@B@B@@@B@@B@@@@DA@@B@B@@@@A@@@@BA@A@@BABA@@@@BA@A@@@@B@@@@@@@@BB@@@B@@@@@@@B@@@@@B@@@A@@B@@@@@AB@@@@@@@@@@@BA@BA@B@@B@AB@@@@@@@B@@@@BA@@@@@B@@BB@@@@@B@@@@A@@@A@@BB@@@@@@@A@@@@BB@@@A@@B@B@@@AA@@BA@@A@@@BA@@A@@A@@DA@@@C@@BA@@@A@@@@@@@A@@@@B@@@@@@@B@@@@@B@@@@B@@@@B@@B@@@@DA@@@@@@@@@A@@B@@@@A@@BA@@@@@@B@@@BA@@@@@@@A@@@@@@B@@@@AB@BA@@@@AAB@@@@A@A@@BC@@A@BA@@B@@@@@@B@@BA@@BB@@@A@@@@B@@@@@@A@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@BB@@@@@@@B@@@@A@@@@@AB@@@B@@@@A@BB@@@@@@@AA@AAA@AAA@A@@@AB@B@@A@@@AAAB@@@B@@@@A@@B@@@AA@@@@@@@@@@B@@@@AAAA@@@@@A@@AAA@A@ABC@A@A@AAEAB@@@@@B@@@@@@@@@@A@@AA@BA@A@@@ABA@A@@@BA@@@@AA@@@B@@AB@@@@@A@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@A@@@B@@A@@@@@@@ABB@@B@@A@@@@@@@@@@BA@@B@@A@@AB@A@@@A@@@@@@BA@@@@@@@A@@A@@A@@A@BAA@@@@@@@@CB@AA@BA@@A@@@A@@@@AA@@B@@A@@@A@@@@AA@@@@@AB@@@@@@@B@@@@@B@@@@A@@@@@@BA@A@B@@@@B@@@@@B@@@@@B@@A@@BA@@A@@@B@B@@AB@@@@B@@@@@@@@B@@@BB@@@B@@@BB@B@BB@@@A@@@@@@@@@@@A@@B@@@@@@@@@@A@ABB@A@B@A@@@@@@A@@@@BA@@@@A@A@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@@A@@A@@@@@AA@@@@A@@@@@A@@A@@@@@@@@A@@@A@A@@DA@@BB@@@@B@B@@A@A@@DA@@@@@A@@A@@@A@@A@A@@B@@@B@@@@@@A@@@@DAD@FCBADCB@BABAB@B@@@@@BA@A@@A@AA@@@@@@@C@@BA@@A@E@@@@A@@@@@AA@AA@@B@@@@@BA@AA@@@B@@@@@@@@C@@@A@@@A@AB@@@@AA@@AB@AA@AB@@@A@AA@@A@BAB@B@@A@@@@@ABA@@@AB@@@B@@@BBB@@@@@@@@@AB@@@BBB@@DAB@@A@@BA@@AA@A@@@A@@@@@A@@AA@@@@AAB@@@@@@@@A@@@@@@AAAA@@@A@@@@@A@@ACBA@@@AAA@@A@@@A@@A@@@A@@@A@@@A@@@@@A@@@A@@@@@@@A@@@A@A@@@AB@@@BA@@A@@@AB@@A@@B@@@@AA@A@@@ABB@@@A@BBA@@@@AA@@@@@A@@A@@@@B@@@B@@A@@B@@@@@@@@A@@AA@@@@A@@@A@@@@A@@@A@BAB@@A@@AA@A@@@@B@B@@@A@@@A@@AA@@B@@AA@@@AAB@@AB@D@@@DABA@@@A@@A@@@@A@@@@@@@A@BA@@C@A@@@@@A@@@A@A@@@A@@@@B@@@@A@C@A@@A@@@AB@@CBC@ABA@A@@@@B@@A@@@@A@A@@@A@@BA@@B@@@@@@@@@A@@BA@A@@@@@AA@A@@@@C@@@AAA@@@AA@AA@@A@A@@@@@DAAA@A@@A@A@AA@A@@B@@@@C@@A@@@A@@@@@C@@B@AA@@B@@@@@@AAA@A@@@@@@@B@AC@@B@@B@@B@B@@@BB@A@@@A@@@@@@@B@@@@B@@@@@BA@@BA@@@A@B@@AB@B@@@@B@@@DE@EBB@@@D@BD@@@@C@C@@@A@@@AB@@@A@A@@@AB@@AAA@A@ABC@A@A@@AA@A@A@AACAC@@@@@@A@@@A@@@A@@@@BA@@@B@@BBB@@B@@B@B@@@BB@@BAB@@ABA@AAA@@@@B@@@@A@C@@@@AA@@@@@A@A@@BB@@@A@AB@@@@@@@B@@@@AAA@A@@@@B@@@B@@BB@@@@@@@B@@@@@AA@@@@@@@@@A@@@@@AA@AA@@AA@@A@@@A@@@A@@@@AA@AAA@@AA@@AAAA@@@BC@@@@@A@A@@@A@@@AA@A@@A@@C@@A@@AA@BA@@@@BBB@@@@ABCB@@A@@@AA@A@@@@B@@@A@@AA@@@@A@@AA@@@@@@A@@@@B@B@B@@A@AB@@@@A@A@@@AA@@CA@@@@@@@AA@@AAA@@@@CC@@@@AA@@AA@A@@A@@B@@@AAAAA@A@@@@A@@AA@@@@A@A@AA@AA@@@AA@@@@@A@@AAB@AA@@@@@AA@@A@A@@@@@@@@AA@@@AA@@B@@A@@@A@@A@@BA@@@CA@AAB@@AB@@BB@@AB@@A@@@@@@@AAAA@@@AA@@AA@@BAB@@@@AA@@A@A@AAA@A@@@@@BA@@@@@A@@AA@@A@@@@@AA@AAAA@@AAAB@@A@@@@A@@@@@@@@A@@@@@@A@A@@A@@B@@@@@@AA@A@@@@A@@B@@@A@AA@@@ABA@@@A@@@@@A@@@AA@ACA@A@A@@@A@@@A@@@C@A@A@A@A@A@@@A@@@AB@@A@A@AAA@@@@BA@A@ABA@@@@B@@@BA@AAA@A@AA@@@B@@AB@AA@@@@@A@@AA@A@A@A@@@@@A@@@AA@@A@A@A@@@C@A@@BAA"],encodeOffsets:[[122667,29704]]}},{type:"Feature",id:"330726",properties:{name:"浦江县",cp:[119.892222,29.452476],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@@A@@@BA@@@@@@@A@@AA@@@@@@@A@@@@@@@@@@@A@@@A@A@@@@@A@@@@A@@@@B@@@@@@@@@@AA@@@@B@@AB@@AB@BA@@@A@@@B@@ABA@@@@B@@@@A@A@@@A@@@@AA@@@@@@B@@A@@@@@@@@A@@@A@@A@@B@@@@@C@@@@A@@B@BBB@@@@@AAA@BAD@@@@A@@A@@@D@@@AAA@@@A@@@A@@B@B@@@@A@@B@@@AA@B@@@@@@A@@A@B@@@@A@@@@@AB@@@@@B@@@@@B@@A@@@@A@@@A@@AA@@@A@A@@B@A@A@@AB@@AA@A@@@@BA@@@@A@A@ABA@@@@ABA@@BAB@@AB@@AA@@@@AB@@@@@A@@@A@@@A@@@@@B@@@A@A@@@@A@A@@@A@@@AA@A@@@@@@B@@@B@BB@@B@@@@ABA@@@AA@@@@ABAA@@A@@BB@@@@@B@@@@@@@CBB@@BBB@BA@A@@@C@@B@B@@A@@@A@@A@@A@@@A@A@@@@@ABA@A@@@@B@@BB@@A@C@BB@@B@@@B@BAB@@@@@@B@BB@@@A@@B@@B@@@@B@@A@@@@A@@A@@@@@AB@@A@@@@@AB@@ABA@@@@@B@@AB@@ADA@@@@@@@@@A@@AA@@AB@@A@@@A@@@A@@@@A@@A@@@@AAB@BA@AAA@@AAA@A@AA@@A@A@@A@@@AAA@@@ADAD@D@B@@@@B@@@A@@@@BB@BBB@BB@@AB@B@@@B@B@@BB@D@@CB@@@@@BA@@BC@@@@B@@A@@B@@AB@@A@ABAAC@AAA@A@@@@@CA@@A@ABAB@@@BB@@@B@@@@@@@A@@@@@A@AB@B@@@B@@@@BA@@BAB@BB@@@B@@B@@B@@BB@@AB@B@@@@@@B@@@@@B@@A@@B@ABB@@@ABA@@@@B@@@@A@B@@AAB@@@@@@@@@A@@ABAB@@B@@BA@@@@@BB@@B@A@@@B@BAB@@A@A@@@AB@@@@@B@@B@@A@@@@@@@@@@@@@@@@BA@@B@@@@BA@@BBBA@@@@B@@@AB@@AB@@ABA@@B@@B@@A@B@B@@AA@B@@@@B@ABB@@B@@BAB@@B@@@@B@@B@@@@A@CB@@@@@BB@@@@@BB@@@@@@CA@@@A@@@@@B@B@B@@A@@B@@@@@@@A@@@A@@@C@@@@A@@@A@A@BD@B@B@B@@@@@A@AAA@@@@AB@@@@A@@@@@@@@@@B@@B@@@@@@@@B@BA@@@@A@@@A@@@@A@@@@@@@@@@B@@@@@A@A@@@@@C@ABA@@A@ABA@@A@@C@@@@@A@@@B@@BB@@@@@@B@@@@@AA@@@A@@@A@@@@@AB@@@@@@A@@@@@@@A@@BA@@@@@BA@@DAB@@A@@@@@@@@ABA@BA@@B@@A@@@@@@A@@@A@@BA@@@@BA@@@@@@@@@@A@@B@@A@@@@AB@AA@@A@@A@@@@B@@@BA@@@@BB@@@@@@@BB@@@@A@@@@@A@@A@@AB@@@@B@@@A@@@@B@@@AB@@BB@@@B@@@@@@@@BAA@@@BB@@B@@AA@@@B@@@@@B@@@@@@@@@@A@@@@@@@A@A@@B@@@@@@A@@A@@A@@BA@ABA@@@A@A@@@AB@BA@@@A@ABA@@@ABA@@@@AA@@@@AAB@AA@@B@@ABAB@@A@A@@@@A@AC@@@A@@@@AA@AA@@@@@A@@A@A@@@BA@@@@@@A@@@BAA@@@ABA@A@@@@@@A@@@AA@@@A@@AA@@@@@@B@@B@BB@@A@@@AB@@@@@AAA@@AA@@@B@@@B@@@BAA@@@@@@@@@ACB@@@@@B@@@@@A@@C@@@@A@@@@@A@@AAA@A@AA@@@@@A@@@AB@BA@@@@AAAC@@AC@@@@@A@@AA@@@@@@AA@@@@@@B@@@@@@@@A@@@@@@@BAA@@@@BA@@@@AB@@A@@@@AA@A@@B@@@CA@@A@@DAB@@@@@A@@AA@A@@@@BA@@@A@AAA@@@A@@BB@D@@@B@@B@@A@A@C@@@A@@@ABA@@B@@@BA@BB@@@BABA@@@ABA@E@CBA@AB@@AB@@A@AB@@ABAAA@A@@@@AA@@@BA@@@@A@CA@@C@A@AAA@CA@BA@@B@BA@@@@AA@@@A@BBA@@@@BB@C@ABA@@BC@@B@@@@@BA@A@@B@@@B@B@B@B@B@@@BA@@B@@@@AB@@@C@@A@@@B@@BA@@B@@@@BB@@A@@BAA@B@@ED@@ABAAAD@BA@@@@@@@A@BBBB@@C@@@@@@@@@@A@@@@AA@@AA@@@@@@@B@@@B@@@@@@@@BB@@@@A@A@@A@@@@ABB@@@@@A@AB@@A@@@B@@@AA@B@@@@@@@@@@@@@B@@B@B@@@@@AB@@B@@@BAB@@B@@@@A@@@@@@BBA@@@B@@AB@@A@@BB@@@@B@@@@BB@B@@A@C@A@A@@BA@BBA@@@@B@@@B@@@B@@AA@@@@@A@@AB@BABA@@@@@@B@@B@@@B@@@@@CB@A@@A@@A@DBB@@AB@@A@C@@@@B@@A@AAA@@B@@@@@BD@B@B@@@@BAB@B@B@BA@ABABAB@@BB@@AD@@A@A@A@@@AA@@AC@@@BAB@BAB@@A@A@A@@@A@@@AA@@AA@@A@@B@@AB@@@@A@@@AB@@AB@@AB@@@@A@A@@BA@@@A@@@@B@@@B@BAB@@A@@B@BA@@B@@@B@@BB@@@@AB@@@BA@@@A@@BB@BB@@B@@BA@BBA@@@@B@BA@@DB@D@@@@@A@@@@BABA@ABA@@@@@@B@@@B@BB@@B@@@BAB@A@@A@AA@@@B@@@@@B@B@@@BB@@@AB@B@@@BB@@@BDBB@@@@AB@@@@BBB@@@DBBA@@@@@AB@@@@C@@@@@AB@@@BB@@B@@A@@A@@AAA@@@A@@BAAA@@B@B@@A@@@B@B@@B@BB@@@@@BAB@@BBB@@BB@@BABAAA@BD@@BBDB@@@@BB@@@@@BA@@@@@@@A@@ACBDDB@@@@B@@@@BB@B@@A@C@@@@B@@@@A@C@@@@@@BB@BB@B@@@@B@DA@@BA@@B@DBB@B@BB@@B@@@@A@@ADB@@@BB@@@B@B@BAB@BA@A@@@@@AB@@A@@@A@@B@@A@A@@@A@A@@@A@A@ABA@A@@BB@@BBB@@BBB@@A@@BCB@B@@@BAB@@DB@@BBBBBB@@@@@@AA@@C@C@@@@BBB@@@@@BA@@@ABD@BB@BB@@@BB@@@@ABA@A@AB@B@@BNEJA@BB@@BB@@B@@@@@BAB@@@BCBA@@BA@@@@B@@@@AB@@@BAB@BA@A@C@@BA@@@AB@@@@@B@D@@@B@@ABA@A@AB@@A@AB@@@@ABABA@@@@@@A@@A@A@A@@AB@@A@@CA@@A@@@@@BB@B@B@@@@A@@@A@C@B@@B@@A@@@@@@B@@@@@@A@@B@@B@B@@@A@@@A@@B@@@BB@@@B@@A@@B@@@@@AB@@DB@@BBB@@BB@@@@@AB@@@@A@@BA@@B@@A@CBAB@@@@B@@@AB@B@@@@B@@B@@@AB@BA@@BAB@B@B@@@BABA@AB@D@@@@BCD@@AB@B@@ABA@@@@B@@B@B@B@BA@@@ABAB@BAB@@@@@B@@@BBB@B@@@B@@@B@@BB@@BB@@@B@B@@@B@@@@@D@@@@B@B@B@B@B@@B@@@BA@@@BBD@BB@BB@@@@B@@@@@B@@@@@@ABA@A@B@@@@D@@@@@B@@A@@B@@@@@@@BB@@@@B@@@B@@@@B@@B@B@B@@A@C@@AAB@BAB@@@@@@@B@@@A@@BBB@BB@@@@B@B@BA@@B@@AB@@@B@@A@@B@@A@A@BA@@AA@@@A@AC@@@A@@@A@A@@BA@@@@@@B@@A@@@@@@@A@@BA@@@A@@@BBBBBBB@BB@@@@AB@@@@ABABADA@@B@@B@BBBB@@BBBB@@BA@A@@@AB@@@@@@@AB@B@@@B@B@@D@B@D@B@D@B@@@@@D@B@@BA@BB@@B@@@@BABABABA@@B@@A@@B@@@@@B@@BB@B@BB@@B@B@@AB@@@BA@@@@@AAA@A@AB@@@@BB@@@B@B@@@BA@A@@@@@AH@DB@@BDD@@@@@C@@BCBA@@@B@B@B@B@@BB@BDB@@BABABA@@@AA@@AA@@A@A@@CC@ACCB@@A@B@@B@B@B@@@B@@@BBBA@B@B@@AB@@BB@B@BB@@B@@BB@@@B@@D@B@B@@BB@B@@BAB@@BB@@B@@@@@@B@B@B@@@B@B@B@B@@@@AB@@A@AB@@@BAB@@ABA@A@A@A@A@@@A@@@@@B@AB@B@@@@AB@@@B@B@B@BBBAB@BB@@@B@@@BD@@@B@@BBDBBB@BB@@@B@@@BB@@@B@BA@CBB@@BDD@@@@B@@BAB@@@BBBAB@@@@@@B@@@FF@BB@@BB@@A@BB@@@B@@ABAB@B@B@@@@@@@B@@B@@@BBBB@BBD@BAB@BD@@@@@BBB@@@B@@BB@@B@@@B@@B@@@@@BB@BBBBBAB@B@BB@@BBBB@@B@@BBB@@@B@@B@BB@B@@B@B@@@BB@@@@BAB@@AB@@@@@B@@@B@B@B@@BB@BBBB@@@BB@BB@@BBBBBB@@B@@B@@@@B@BA@AB@BABAB@@@@@B@B@@@@@@@@@B@@@@A@@B@@A@AB@BAD@B@@@BA@@@AB@@@BAB@@@B@BA@@@@BB@BBBB@@@B@@@@@B@@@@@B@@@@@B@@AB@@AB@@@D@B@@@@BB@B@@@B@B@@@BA@@DA@@@BB@B@@@B@@@@AB@B@B@B@BA@@B@BB@B@BB@BB@B@BDBB@@@@@B@BB@@BDB@@@DA@@AAACAA@A@@@A@@AA@AA@@AAA@A@@@A@C@@BA@@B@B@@@@@DBB@@A@@B@@@B@B@BAB@@@B@@BDBB@BA@@B@B@@@@BB@@B@@D@@@B@D@@@@B@B@@B@@@@@@BB@@@@@B@@@BAB@@A@@B@@@D@B@@@B@B@@@@@@B@@@BBD@@@@BB@BB@@@BBB@@@@BB@@B@@@A@@@@@@B@A@@@BB@A@@@AA@@@@@@@AA@@B@AA@A@@BB@@BB@@@BB@@@@BA@@@@@@@@@@B@@B@DBBB@@B@@@BA@@@B@@@@@@BB@@@@@BB@@@@@BB@@F@B@@@BB@@B@BBB@@@@@@@BB@@@@@@B@@AB@@AB@B@@BBBD@@@@@A@@A@A@AA@@@@AA@@@@AB@@@B@B@B@@BBB@@BB@B@@BABABABA@@@A@@@@@A@ABA@@B@B@@@@@B@BA@@B@B@@@B@@@BAB@B@@@@BB@@BABCB@@@@@BBBB@@@B@@@@@B@@A@A@@@AAADAD@BAB@@ABAB@@@B@@A@@B@@@@@@AB@@@BA@@B@BA@@BA@@B@B@@ABA@@B@@A@A@AB@AEB@B@@@B@@AB@B@@BB@B@B@D@@@@@B@@@B@D@@@B@@@@A@@BA@@B@B@@@@AB@B@@A@AB@B@BA@@D@@@BAB@@@F@@AB@@@B@@@B@@BB@@@@@BB@@@BB@@@@B@@@@BBB@B@@@B@@BBB@@@@@B@B@@@BBB@@@D@@A@BB@@@B@@@@@B@@@B@@@@@B@BA@@B@BA@@A@@@BA@B@@@@@@@B@@@ABB@@@@@BAB@@@@@@@@@B@@@@@B@@@A@@BA@@@@@BBBABA@@@@@@@A@@A@@@@@@@@@@@@@AA@@@@@@@@@@@A@@@@@@@@@@@AB@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@A@@@@A@@@@B@@@@@@A@@@@@@@@@@B@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@AB@@@A@@@@A@@B@@@@@@A@@@@@A@@@BA@A@@A@@A@@BA@@@A@@@AA@@@AAA@@@@@AC@AAA@AA@@C@A@@@A@@@AA@A@A@@@@@A@AB@@@B@@@@B@@B@@A@AB@@@BAB@@@BA@A@@@A@@BABABAB@B@BAD@@@@@@AB@BA@@B@@@@@DAB@@@@C@@@AB@@@@AA@BA@@@@@@@A@AA@@A@AA@@@@AB@@@A@A@@@@@@@@A@A@@@@@@@@@A@@A@@@@@A@ABAA@@A@@@@B@@@@CA@@@AA@@A@@AAAB@@@@A@@@A@A@@@@BA@@B@B@@CAA@C@@@A@A@AAA@A@AA@@A@@@BCA@@@@A@@@A@@@A@@@AAA@@BAAA@@@A@@@@A@@@AAA@@AAAAAAA@@@A@CA@@A@A@@AA@@AA@AAABA@AA@@@BA@@C@@A@@B@B@@@@A@CB@@AB@@A@@AAAA@@@@@@@@A@AA@@@@@@@@@@@A@@BB@@@@B@@@@@@A@A@@@A@AAA@@@A@@A@@A@@@A@@BAD@B@@AB@@@B@B@B@@@B@@A@@@AB@BA@@B@@@D@@@@@B@BAB@B@@@B@B@@B@@DC@@B@@@B@@@@A@@B@@AB@@@@@@@B@BBB@@@B@@@@BB@@@@BB@@@BBB@@@BB@@B@B@B@@@B@@@@A@@@A@@@CAAB@@@@A@@BAB@@ADA@@@A@AB@B@B@@@B@B@B@B@@AB@@A@@@@@AA@@@@A@@@@@@AA@@AA@A@@B@AA@A@@@@@AA@@A@@@@DABAB@B@@AB@@@@A@@AA@A@@@A@@@ABABC@@@B@@AB@BBB@@@B@@B@@@BA@@@@B@@@B@B@BA@@D@B@@@BABAB@@AB@BAB@@@BA@A@@BA@@BBBBB@@@@@BBB@DAB@BB@@@@BA@@B@B@@@D@BCB@@A@@BBB@@@@@BA@@AA@AC@@A@@B@@@AAA@@AAA@A@A@A@@@AA@@AAA@@@A@@@C@@@A@@B@@C@@@@@@@@AA@@@@BAA@@@@AA@@B@@@@A@@@@@B@@@@BA@A@AA@B@@C@@@A@@@@@@@@@@B@@@B@@AA@@A@@@@A@@@@DA@B@@@@@A@@@@@@A@A@@@BA@@A@@@@B@@@@@@@B@@@@AABA@@AA@@@@@@@@@@@B@@@B@B@@@@@@@AA@@@@@@B@@@@@@@@@@@@@@@B@AA@@@@A@@@@A@@A@@AA@@@@BAB@B@BA@@@@@A@@@@A@@@@@BA@@B@@AB@A@@@AA@@B@AABA@@B@@@@@@@@@@B@@@AB@BB@@@@@A@@@@BA@@@@@A@@B@@@@@@@@@B@@@@B@@BA@@BB@@@@@A@@BA@@@AB@@B@BBA@@@@@@@AB@@@B@BAB@@@@@B@@BBB@BBBBDBABBBBBB@@B@@@BA@@B@@@BB@@B@@@@CB@@AB@@A@AB@@@B@BA@@BAB@A@@@@@@@A@AAAA@@@@@@B@@@B@BA@A@@@@AAAABA@@AA@@A@@@A@@@@@BA@@@AB@@AA@AD@@@AA@@A@@@@A@@@@@@@@@@@@AB@@@@@@AA@@@@AA@@@@@@A@@@@@@AA@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@A@A@@@@@@@B@@@B@@@@@B@@@@@@@@@A@@@A@AA@@@@A@A@A@A@A@A@@@A@@@A@CA@@@@@AAA@@@A@@@@AA@B@@ABB@@B@@@@AB@@A@A@@@@@@AB@@C@@@C@@@AB@@@@ABACAA@@@@AC@A@@A@@BA@@@C@@AA@@ACA@@@@@@AA@@AA@@A@@@@@@@A@@A@A@A@@A@@@@@AB@@A@@@@@@@@@@A@B@AA@@A@@@@@B@@@@A@@@@AA@@@@AA@@@A@@@A@A@@@A@@DAB@@A@@ABA@@@A@@@A@@@@@@A@@@@@B@@B@@@@@BAB@@@AA@@AA@@@AAA@A@@@@A@A@@@@A@@AA@@AA@@@@@A@A@A@A@@@AAA@@A@A@A@AA@A@@@@A@@@@A@A@@@@AAA@@@@A@A@@A@A@@@A@@@@@AA@@@@@DA@BBA@@@A@A@@@@B@@@@AA@A@@@@B@@@@@@AA@@A@@A@@B@@@@@@A@@@A@A@AACB@@@@BA@A@@A@@@@@@@A@@@@AB@@@A@B@@@AAA@@@@@@@@ACA@@AAAAAA@@@@A@@A@A@AAAA@DA@@@@AA@ABA@@B@B@@AB@@@@@@@@AA@@@ABA@@B@@AA@BA@@@@A@@A@@@@@@BA@@AA@@@AAAB@@AAA@AAA@@AA@AA@@AA@A@A@@@AAAAAA@@@CC@@CA@A@@@@AAAA@@@A@@@AA@A@A@AAA@@@@@@A@AB@@@A@AA@@@A@@@@@@@A@@@BAA@@A@@A@@AAAAAAA@@@AA@@A@AAAAAA@@@@A@A@@@@@AA@A@AB@@@@@@A@@A@A@AA@@@A@A@AC@@@@@A@@A@@@AAA@A@@A@A@A@@AABA@A@A@@A@@@@@A@@@@@@A@AAA@@@A@@@@@@A@@@AB@@@A@A@@@@A@@@@A@BA@@@A@ABA@@@AA@@A@@@A@AA@CC@AA@@@A@@BA@@@A@A@@@@@@@@BB@@B@BABBBBBA@@B@@@BB@@BB@@@@@ABA@AB@@AA@@@@ABA@A@@@@B@@@@A@@@@@A@@B@@@@@A@@@@@@@@AB@@@A@@@A@@@@@@@@@@A@@@A@@@@B@@@@@@AB@@@B@B@@@AB@@@@@B@B@@@A@ABA@@@ABB@@@BB@@@@B@B@B@@@@@@BBBA@B@@BA@BBA@@@@A@@@A@@@A@AA@@@AB@@A@@@@B@@@@@@@@@AA@@@@BC@A@@BA@@@@@AB@@@A@A@@BB@@@AB@@@@@@@@@DABA@@AB@@BA@@A@AB@@@@@@BA@@@A@@@@A@@@@@A@@@@B@@@AA@@B@@@@@@AB@@ABBB@BA@@@@@CA@@@B@@CDB@@@A@@@A@@B@@BB@@AA@BBB@@@@@@AAAA@@@@@B@@@@@@@@A@@BAD@@A@@@@@BA@@BC@@@@@ABAB@@A@@A@@@@BAB@@A@AB@@BB@@@@A@ABA@@BA@A@@B"],encodeOffsets:[[122822,30086]]}},{type:"Feature",id:"330702",properties:{name:"婺城区",cp:[119.571728,29.0872],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@@@A@A@@@@@@@@@AB@@@B@@AB@@@@AB@BA@@@@BAB@@@@@@@B@B@@@B@@@B@B@B@B@@AB@@ABAB@B@@A@A@@B@@ABAB@@AB@@AAA@A@@@@@A@CB@@B@@@@@B@D@@@@@@@@@A@AD@@@@@@BB@@@@@@@B@@@B@@@BA@@@A@@@B@A@@@@@@@@@@@D@@@@@@B@@@@A@@B@@@@@@@@A@@B@@@@@B@@A@@@@B@@@@@B@B@B@@B@@BAB@@BB@B@@@BA@A@@@A@ABA@@@@@@BBB@@@B@@BBAB@@@@DBB@@@FD@@@B@B@@B@HA@@AB@B@@@@@B@B@@@BB@@@H@@@@BB@@B@B@@@BB@@@BB@@B@@@@B@@BBA@ADBB@@@B@@B@BBD@@@@@@BB@B@@@@B@B@@@@@BA@@BA@@B@B@@@@C@@BA@A@@@AB@@AB@@@@AB@@AB@@@B@BA@@@@@@@B@@@A@@B@BA@@@@B@@@AB@@@@@@@B@@@@@@@B@@@@@@BB@@BC@@@A@@@BB@@@@B@@@BAB@@@@@@@@@@@@B@@A@C@A@@BA@B@@@B@@@@@@@A@A@@B@@@@@@B@@@@@@@@@A@@@@@@@@@A@@@@@@BAB@@@@@A@AA@@@A@AB@B@@A@A@CAA@AAA@C@A@AAA@C@@@AA@@AAC@@@AB@@@@@BBB@D@B@B@B@B@@@B@@B@@B@@@BA@@@@B@@@@@B@AB@BD@BB@@D@D@@@@A@@@CA@B@@A@@@@@@@@@@B@@@@@@B@@@BB@@@@@@@B@B@@BB@B@B@A@A@A@@B@@AB@@ADD@@@B@@@@B@@@BBB@@@@@@@BA@@A@B@@A@@@@@AB@@A@B@@@@@B@@B@@A@@@@B@@@ABB@BB@@@B@@B@B@BC@@B@@@B@B@B@BAB@@@BB@B@B@B@BB@@@BB@DB@@B@@@B@@ABB@ABBB@@AB@BA@A@ABA@AA@@AB@@B@@B@@@@@@@@@B@@B@@@@@B@@@DAB@@A@@@@BA@@@@@BB@@BB@@D@@@DB@@@@BB@@@@@ABB@BA@BBAB@@BC@A@@@A@@B@@A@BB@@B@BA@AB@B@@@A@ABAD@@B@@@@@@A@@BABA@@@BBB@B@B@B@@@BBD@@@BCD@@@@B@DB@@AB@@A@@@ABAB@@@BA@@B@BA@A@@B@@A@@B@@A@EB@@A@@A@@ABA@@B@B@B@@@B@BA@@B@B@@@@CB@@CBDBB@@@ABABA@@@C@@@AB@@A@@@@B@@B@B@@@BB@BBBA@@B@B@@BB@B@BBB@BAB@B@@@BA@@B@@@BA@A@A@@B@@C@A@@B@@@BBBC@A@@@A@A@@BAB@@@@@BB@@BB@@B@@ABA@A@@A@@@AA@@@@BA@DB@BDB@@AD@@AB@BA@@@@B@@@D@@@@@@@BB@@@BB@BB@@B@@AB@@@BB@@BB@BB@BB@@D@@BB@@@@BB@@@@@@@@@@@A@@BB@@@A@@B@@@B@B@@@B@@@BABA@@@@B@@@A@@BA@@B@@A@A@@BA@A@AABBBB@@@@@BA@@B@@@@AB@B@@@B@@@B@@@@BB@@@B@@B@BB@B@@@B@@B@@@@D@@B@@@@@@BBBA@@@@B@@@@BB@@@B@@BBCB@@A@A@@@@B@@C@A@@@A@@D@@@BB@@@BA@@@B@@@@@@@B@@A@@BB@@@@B@@@@B@@@B@@@@A@@@@@@@@@B@@@@BB@@@@@@@@A@@@@@@@A@@@@DB@A@A@A@@B@@A@@@@@AB@@@@BB@@@@@@A@A@@@BB@@BB@@@B@@@@@BAAA@@@@B@@@@@B@@BB@@@B@B@@A@@@@BB@BBB@@@@@B@@B@B@B@@@@@B@@AA@@@D@@@BBD@B@@B@@@B@@BB@@@@A@@B@@@@B@B@@@B@@BB@B@@@@@@A@@A@@A@@@@@@BAB@@@@@@@B@@@B@D@B@@@@A@@B@@@@@@BABFBB@B@@@DA@@@AB@@@@@B@BB@BA@AB@@A@@@@@@BC@@@@B@B@@@@A@@@A@@A@@A@@B@@@@A@A@@B@@B@B@B@D@B@@@B@@@B@@@B@@A@A@AB@@@@B@@BB@B@@B@@@@B@@@@BB@@@AB@@@@@@@@@@B@@D@@AB@@@@AB@@A@@@AB@@A@@AA@BB@B@@@B@DA@@@@@@B@@@@@@@@@@B@EDCDB@AB@A@B@@@BB@@@@BB@@@@DBB@@@B@B@BB@@B@@@@@B@@@@@@@BD@@@@BB@@A@BBA@@@@@@B@@@@@B@@B@@@@@@@BB@BB@@@@@B@@@B@B@@@B@@@DADAA@B@@A@@@@BA@A@@B@@@@AB@@@@@BA@@BABADA@ADBFTPFDDBDFHFBBB@@@B@B@DDBBBBBFBB@B@B@B@D@B@BB@@BBBD@TDB@D@D@F@@@DE@@B@B@B@@BBNBBJH@@BBB@F@@BB@BB@BDFBBBBDCB@@@BAC@@@@@B@@AB@@A@A@@@@A@A@@@A@@@@@@A@@@@AA@@@A@@@@@@BA@@@@B@@@@@@@B@@@B@@BBA@B@@@@@@B@@@@@@@@@B@@@@B@@@@@@BB@@@B@@@@B@@B@B@@@BB@@@B@A@@A@@B@@B@@BAB@@@@@@@@@@@@AA@@@@BAA@@@AA@@@@@@AA@@@BA@@@@@@@A@@@AB@A@@@B@@@B@@@@@@@BB@@@A@@BA@@BD@@HF@@@@B@@A@@BB@@@@@@B@@@@@B@BB@@@@B@@@@@@@@B@@@@BA@@BBB@@@@A@@@@@B@@@A@@B@@@@@@@@B@@B@@@@B@@@AB@@@@@@@BA@@@@@B@@BABA@A@A@@B@@AB@D@BA@@B@@@@@@@@A@@@A@@A@@@@@@@@AB@@@@@@@B@@A@@BA@@@BB@@A@@@@@@@A@@BA@@@@AA@@@@B@@@@@@@B@@@A@@AB@@@B@B@BBDBB@BB@@@A@@AA@@B@@@@@@@@@@A@@@@@AAA@@@@@@A@B@@@@A@@@A@@@@A@@@AA@@@@@A@B@@@@@@@@A@@A@@@B@@@@@@@ACA@@@@@A@@A@@BAA@@@@@@A@@B@@AB@@A@@C@BAA@@B@@@AB@@@A@@@@B@@@AAA@@@@A@@@@B@AC@@@@A@@A@@A@@@B@@@@@@@AA@@@@@A@AA@@@@@@@B@@@@@@@B@BC@@@@@@@@@@B@@@B@@@BB@@@AB@@@BB@A@@A@@A@@B@@@@@@@@@B@@@@@B@@@BD@@@@@B@B@@@B@@ABBB@@D@@A@A@@B@@@@@@@@A@@@BB@@@B@@@A@@A@@@AB@@@@@@@@A@@@@A@@A@@@@AA@@@A@@@@@A@@@A@@B@@@BB@@@A@@@ABA@B@@B@@@B@B@B@@@@@@@@@DA@AB@@@@A@@@@BA@@@@AAA@@B@@@AA@@AB@@@@A@@AB@@@@@A@@@@A@A@@@AC@@B@@@@AA@@A@C@@AAB@@@@@B@BB@@BA@@@A@AD@@@@@@@FA@@@@B@@@@B@@@BA@@B@@@@BBB@@@@B@@A@@B@@AB@@@@@@B@@@@@B@BCB@@BBB@@BA@@B@@@@@BA@@B@@A@@@@BA@AB@F@@@@@@B@@@B@@@@@B@@@@@@@A@@@A@@@@BB@@@@@@@A@@B@B@@@@@@BA@@@AB@@@A@B@@@@@B@@@@@@B@@@@@BBBB@B@@@@@B@@@@@BB@BB@BB@@BB@@@B@@B@B@@BB@@BB@@@@@@D@@@@A@@@@@@@@B@@@@@B@B@@B@@B@@@@AB@@@@@@@@B@@@@ADA@@@@@@BA@@@@@@@@B@@@@@@@@B@@@BBB@@@@@B@@@@@BA@@@A@A@A@@@@BB@@@@@@B@@@@@B@@@@BA@@@AB@@D@F@@A@@@@@@B@@@@@@A@A@@@B@B@@@D@@@D@@@B@@A@@@BB@B@@@BA@@@@B@B@@@BAB@@@@@@B@B@@BAB@@@@@B@@@B@@A@BB@@@@@BB@@@@BB@AB@@B@BBBA@B@@@B@BB@B@B@@@BAB@BB@@@AB@@@B@@@D@D@@@B@B@@@@BB@@@B@@@@@H@@A@AB@@@BB@@@@B@B@DAB@DB@@B@BB@@@@@@BB@@@BB@@@BD@@BBB@BBBBBBBA@@BC@E@GBC@AB@@@BBB@B@B@@D@@@@A@@@@@@B@B@@@@@@AA@@@B@@B@@@@@BB@@@@@@@B@@@@@BB@@@@B@@A@@@B@@D@@BA@@@@B@B@@@@B@@@@A@@@@@B@@@@@@B@@B@@B@@@@@@@@@@@@@B@@@@@@@B@@@@@BBB@@@A@@B@@@@B@BB@@@A@A@A@@@A@B@@BBB@@@BA@@BB@@@@@BB@@@@B@@A@@B@@@B@@@AB@@@@@@@@@@B@@@@@B@@BB@@@@@@B@@@A@@A@@@@B@@BBB@@@@BA@@B@@@B@@B@B@@@@@@BA@@@A@@B@@@@@B@@@@A@@@AB@@@@B@B@@@B@@@@B@@A@@@A@@BB@@@@@@@@@B@@@B@@@BA@@@@@B@@@@@@@@@BA@@@@@A@@@A@@BB@B@@BAAA@@@@@@@@B@@BBA@B@@@@@@@@@@@B@AB@@@@BB@@A@A@@B@@@@@@@B@B@B@BB@@B@B@@BB@@@B@@@DB@@@@B@@B@B@@B@B@B@@@B@@@@@@@D@@@@BB@@@BAB@@@@@BB@B@BBD@DBFBB@@@BBB@@@@@BDB@@B@@A@@@@B@@B@@@@@BBBBAB@@@BB@BB@@BBBB@@B@@BB@@BBBB@@BB@@BF@@B@@A@@B@D@@B@@BB@B@@@A@BB@@A@@B@@@@@B@@B@@@@@B@@D@B@@A@AA@@@@@BAB@@@@BB@@BB@BBBB@BBBB@@B@B@BAD@B@@@B@@AB@@@B@B@BB@ABBB@@@DABBB@@@BA@@@@BB@@D@B@B@B@@AB@@BB@@@BABABBFA@@B@BA@@@A@@@@@AB@F@B@BA@@D@B@@@B@@B@BBB@@B@BBB@BB@@B@B@@@BBBB@@B@B@BBB@B@DAB@B@BBB@@@B@@B@@BB@@@BB@@BB@@BDB@BBBFDB@@@FADB@@B@@BBB@@B@BA@@@A@@@AA@@@@@AA@@@@B@B@@@B@@@B@BAB@@@D@B@@@D@@@B@DBBB@@DBB@B@@@@@B@@@BB@@B@B@B@B@@@BBB@@@@BB@@B@@B@B@@@BBB@@BB@@B@@@B@@@@@B@@B@@@BBB@@@B@@@BB@@B@@@BBB@B@@@@A@@B@@ABA@@A@@@@@B@@A@@@A@@@@AB@@@@A@@A@@A@DA@@@@AAA@@A@@@A@@CA@@A@@AA@@@@@@AA@@AB@A@@AA@AB@@AAC@@@BABA@@@A@@A@A@A@@A@@ABA@@@AB@@@@@@AAB@@AAA@C@AA@A@@@A@A@@@AAAA@@AA@@A@@@A@@@@BA@@@AB@@@@AB@@ABC@@@A@@BA@@@@A@@@@@A@A@@@@A@@AAAA@AA@@@@A@@@A@A@@@@@@BA@BBA@@@@@A@A@@AA@A@A@A@@@@A@B@@AB@@@@A@@@A@A@A@@@BA@@@@@@C@@@@@@A@A@AA@@@@@@AAA@@A@@A@A@A@@AA@@A@@@@C@@BA@A@@@@A@AA@@A@@A@@@@@@@@A@A@A@@@@@@AA@C@@@@AAAAAAA@A@@@@@ABA@@AA@AB@@@B@@A@@@@B@B@B@@@@@@AA@A@A@@@AA@@@@@AB@@@@@@@BAA@@A@@BAB@@A@A@@B@@B@B@@@@AB@@@@@@B@@@B@@B@@A@A@@@AB@@BB@@@@BB@@B@@@BD@@B@@A@A@@@@BAB@@A@@@@AB@@@@A@@A@A@@@@@@@@@AA@@@@@@@@A@@@@@@@@@AB@@A@@@@@@DA@@@@@@@AA@@@@@@A@@AA@@@@@AB@A@@@@@AA@@B@@@@@@@A@@@@A@@B@@@@A@@A@@@@@@C@@@@AA@A@A@@AA@@@@BAB@@@@@@@@BA@B@ABB@@@@@@A@@@A@@BB@B@B@@@@@@BAAA@@@A@@B@@@BABAB@@A@A@@@@@AB@B@B@@@BA@@@AB@@A@AB@BA@AB@@A@@AA@@B@@@@A@@@AA@BABB@A@@@@@@A@@@@A@@@@@@@@AA@@@A@@@@B@@@@@A@@A@@@@@AA@@@@@B@@@@AAABA@BB@@@@@BA@@A@@@@@A@AA@@BABA@@@@B@@@@@@@@@@A@B@@@@@A@@BB@@@@BA@A@@@@@AB@@@B@@@@@@@@@B@AA@A@@BB@A@@@@@A@@AA@@@@@A@@@@B@@@@@A@@A@@@A@@B@@@@@@@@@@A@@B@@@@B@@B@@B@@@@@A@@@A@@@@@@@B@AB@@@A@A@@@@A@@@@E@@AB@@@@A@@@@@@BA@@@@@A@@@@@@A@@B@@AA@@@@A@@A@@@A@@@@@@@@AA@@@@@@A@@@@@@A@@@@A@@@@@@@@AB@@@@@@@@@@A@@A@@@@AA@@A@@@AA@@@@@@@@AA@@@@B@@AA@@@@@@@A@@@@@@AB@@@@@@@A@@@AA@@@@@B@@@@@@@@@A@@A@@@@@@A@@A@@B@@@@@@@@@@@@C@@BA@@B@D@@@@C@@@A@@@A@ABE@BBBBABAF@B@BC@@DCBC@@CABACA@BA@@AAABAA@A@AAEB@@@AA@AB@@@@@@@@A@@C@@@ABEAA@BA@@@@@A@EAAA@@@@DAAA@@@@@A@@FCB@BABADEB@DA@@B@@A@@@AB@@A@@BA@@@A@@AA@@@B@B@@@A@@@@@@@@@A@@@A@@@A@@@@A@@A@@AB@@@@A@@A@@@A@@C@@@AEAAAA@E@@DEDC@A@ABC@CAC@EBA@@@AB@DCDCBADADABAB@BAF@B@B@@@@D@@D@@B@@@@B@BBB@@B@BB@@@BA@@@@@B@@@@@@B@@@B@@B@@B@D@B@@@@@BC@A@@B@BD@B@FBF@@ABB@@@H@@@HBBBBAD@@@HCDAB@NCFAB@BAB@BA@@@A@A@A@A@AAC@AAAAAAACCAA@@AA@CAA@I@@@C@CBGDGBGBA@ABAA@@@AAICEC@@@@@A@AB@B@DAD@@@@A@A@@@@@@BA@@B@@@B@@B@@ABBBBBFBDBDBB@@@B@B@B@BAB@BAB@BADE@@BA@@@@@A@@@@BA@@B@@@@@@@@@B@AD@@@@@@@AB@@@@@B@@D@@B@@@@@D@@@@BBA@@@@@@AAAA@@AA@@AA@@A@@AA@@@A@@@A@A@@@@@AB@@A@@B@@@@A@A@@@@@A@@@@A@@A@C@A@@AC@@A@@@@BA@@@@@A@@@A@A@@B@@A@@B@@@@AAA@ACAAAA@A@A@@@A@A@@@BB@@A@@A@@@@CBAAAA@@B@@AAB@@@@BA@@A@@@@@CB@@@@@@@AA@@A@A@A@@BA@@AA@AA@A@@AAA@@@A@A@@BAB@BA@A@A@@AA@@@A@A@@AA@AAA@@@AAAA@@@@@AA@@A@@@A@@A@@C@@AA@@A@@A@AAC@@@@A@AA@@@@A@@BA@@@A@@BC@@B@@@@A@@BB@@ABB@@A@@@B@B@@B@@A@@BAB@B@@@B@A@@@@@A@A@@@A@@@@@@A@@@A@@@AA@@@A@@A@@@AA@@AA@@AA@A@@@@BB@AB@@A@@AAAA@@@A@@@A@A@A@@BA@AB@A@@CAAA@@ABA@@AC@@@BA@A@@B@D@@@B@@A@A@AB@@A@@@A@ABA@@A@CAA@@@BB@@ABB@@BA@@B@@@@@@@@@@A@CACAA@@A@@A@@@A@@@@@C@@@@BAFBD@@B@BBB@@@BBB@@@@@B@@BB@@@C@A@ABA@A@@@@C@@BA@@C@@AC@@@@@ADAB@@A@A@A@A@@@@@@@@@@B@AA@@@@AA@@@@A@@@A@@@AAAA@@@AA@@BA@@BA@@@A@AA@@A@@@@@@@A@@@A@@@A@ABAA@A@A@@@@BABAB@AAA@A@@@@@AEBAB@AAAAA@@@BAB@@AAA@@BA@@B@@A@A@CA@@@@AA@@A@@@A@@BA@A@@@@B@@A@@AA@A@@@CBA@EADABCBAAA@@@@A@@BABA@@@@@@@@AAAA@B@BAB@B@@@ACAA@A@@BA@ACA@@@ADA@@@@@@@AAA@@BA@AB@@CB@@@@@@@@AA@@@A@@B@@@@@B@@A@A@@A@@@@@@B@@A@@BA@@A@@A@@@@@@B@B@@@B@BA@@@AB@@@@A@@@AA@@@A@CB@@@ABC@@A@AAA@@@A@ABAA@@A@@A@@BA@@AC@A@@AA@@AA@@AAA@AAC@AC@@@AAACABA@@B@@@@A@A@@AC@@@A@A@AAA@@@ACAA@@A@@A@A@AA@B@@AB@@@@AB@@ABAAA@A@CB@@A@A@@@A@@@A@A@@@A@CBABAAA@@@AA@@@@@A@@A@@A@@@@@AB@@@@@@@BA@@@ABA@@AA@@A@@AA@AA@A@A@@@AAA@@AA@AAAAA@AA@@AB@@AB@@A@@CAA@@A@A@@A@@B@@A@A@BA@A@@B@@@BC@@C@@A@@B@@AA@@B@@@CABCB@@AADE@@@A@@@BA@@BABA@AB@@@A@@A@@@@B@@@BAB@@@B@@A@A@A@A@@@AB@@@@B@@@@@@@AB@AA@@@@B@@@@@@@@@B@@@B@B@@@B@@AA@@AA@@@B@@@@@BA@@B@@A@@@A@@@CBB@@@@@@@AB@@A@@@@@@@@DA@@@AA@@A@@@@@AB@@@@@@@A@A@@@B@@DA@@BA@@A@A@AA@@A@AAA@@@A@A@@A@@@A@@@@DA@@BAB@@@AAA@@@@@@C@A@@A@AAABAD@@A@A@@@@@@A@@A@A@AAAA@@AA@AA@@A@@AA@AA@AAA@@@AA@@A@B@B@@@BABA@AAACF@@AC@@@@@CB@DA@AA@@BA@@@AA@@BA@A@@@@AAA@C@A@A@@@@A@@@@BB@@DA@BF@@@BBAD@@@@B@BA@@B@BB@@@@A@@B@B@@A@@@B@@@BAB@@@BABB@B@@B@@@@B@@@@BA@A@ACA@@@A@AAAA@@@AAAAAA@@@@DACA@@@@B@BA@@@A@@A@@@@@@ACBA@@@CB@@A@A@@A@A@@@@BA@@@@D@B@D@@@@@@AAB@@@@A@@AA@A@@AA@@@@ABAB@@@B@@BB@@@@C@@@A@@BA@@@@A@@AA@AA@@@A@@@A@@AC@@@A@@@@@AAAAA@@AA@@AAA@@A@@A@@@@@A@AA@@@@CA@AA@@@ABAB@@A@@@@@@@@C@@A@@@AB@@@@DDBBBB@@AB@@@@@@A@AA@A@@A@A@@A@@A@@B@@@@@@AB@@E@@@A@@A@A@@@@@A@@@@@A@@@ABC@@@A@@AB@@A@@@@A@@C@C@@C@@A@A@@@B@@@CA@@@@F@@@@@@AA@@@A@@B@@@@@@@AA@@A@@@A@@AA@@@@A@@@@A@@@A@A@@@@@@@AA@AAA@@@A@@BAAA@@BAB@@@A@@AA@@@A@A@@@A@@AA@@@AA@@@@@AA@@AAAA@@AA@@@@AA@@A@@@@@@@BA@@@@FA@@@@BA@@A@@@D@BAAAA@AB@@AB@@@@@@@AB@@@@@A@@@A@A@A@@@@@BB@@@@@@A@@BAB@@@A@@@@@A@A@@BAB@@@@@@AA@@@A@@A@@@AC@@AAA@@B@@AA@@@@AAA@@B@@@@A@@A@A@@@AA@@A@@@A@@A@@@A@@A@@A@@B@@A@@@@@A@AAA@@B@@A@@@@BA@@B@@BBA@@B@@@@A@@@@B@@@@@@A@@A@A@@@@@@AA@@@A@@@@@@AABCA@@A@@A@@@@@A@@A@@@@A@A@A@@@A@@@@A@BA@@@@AAA@@B@@A@@@@@A@@@@@AB@@@BA@@BA@@BAB@@AB@@@@@@@B@B@@ABA@@@A@AB@@A@@@@@A@C@A@A@@@A@ABC@@@AB@@@@@@@B@@@B@@@@@BB@@@DA@@B@BBB@BBA@@B@@@@@BA@@B@@@@@B@@ABBB@@@BAB@@A@@BA@@@@@BB@B@B@@@@A@@@BB@ABB@@@@B@AB@B@@B@@B@@@B@B@@@BAB@@A@A@A@A@AB@@@B@@A@A@@@@@A@@@@AAA@AA@@@ACABAAA@@@@B@B@@@AAA@@@@@@@@AAA@@@A@@@@@A@AA@@C@ABAA@@@@@@A@@@CA@@@@AA@@@AA@@@A@@@A@@@@@AAA@A@@BA@AAABCA@@@BA@@@AAA@AAA@ACAAA@AA@@A@@B@@AAAA@A@@@@A@@@A@A@@@@@A@A@@BA@A@@@AB@@@BAB@@BB@@@@@@@BA@@@@B@@AB@@@@B@@@@@@B@@AB@B@@B@@B@@@@@BA@BB@@AB@@A@@@A@@@A@@B@@A@A@@@A@A@@@@@@@@BA@@B@@A@@@@BAB@@AB@@AB@B@@A@@@@B@@@BA@@B@@AB@@@@A@A@@@A@@BA@@@@BBB@BABA@@@@B@@@@@BA@@@A@@BA@@@AB@@@@@@@@A@@@@B@B@@@@A@A@@BAAA@@@A@A@@@A@@@A@@BAAA@@@A@@@A@A@AA@@A@A@@@A@@@@AA@@@@A@A@@@A@CA@@A@@@@@A@A@@@AAA@@A@@A@A@@A@@@A@A@@AA@A@@@@@AAA@@AA@@@A@@A@@@A@A@@@A@@@A@@BAA@@@@AA@@ACCA@@@A@@A@@AAA@A@@@@@@@@A@@AAABE@@@A@@@AB"],encodeOffsets:[[122210,29449]]}},{type:"Feature",id:"330723",properties:{name:"武义县",cp:[119.816562,28.89267],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@@@@@@AD@@@A@@@A@AA@@AAAA@@BABAB@@@BA@@AA@@BA@@AAA@A@@@A@A@@@CB@@@@@@@@@BDBB@@B@@A@@@@@A@@@@BB@@@BA@BAB@BA@@@C@@@AA@@@@@@@AB@BA@@CAAA@@AAC@@A@@@A@@A@C@A@@A@A@A@A@@BA@A@@@@BA@@BA@@A@@@AB@B@@A@@A@@A@AA@@@@@@B@@@@A@ABE@@A@@A@@@@@@A@@@@@A@@B@D@D@@@@@@A@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@BB@@@@@@B@@@@@@ABA@AA@@@@@@@@@@A@B@@BAB@@CBA@@@A@AB@@A@@@@B@@@@@BA@@B@BA@@@A@A@@@C@A@@@A@@A@@A@@@AB@BA@@B@@B@@@@@AB@@A@@@A@@@@@@@A@BE@@@@AB@BAA@AAA@A@@AAABBBA@A@@@@@A@@@@B@@AB@@@DABA@AB@@@BA@BBA@@A@@A@@A@A@@@@@@A@@@@B@@@@@B@@@@BB@B@@@@@@B@@B@@@@CB@BC@A@@@ACA@@ACA@AAAAB@BA@@B@@@@A@@BA@A@@@A@@AE@A@@@@AAA@@AAAA@@@@ABABA@AA@@E@@@@@AB@@C@@@@@@@@@A@A@@@CAA@@@@@@AA@@AA@A@@@@B@@@@@@@@@B@@@@@@@@@@BB@@@BB@@@@@@@@B@@@@A@@@A@@A@@CA@A@@@@@A@A@@@AA@@@@@C@@BAB@@@@@BA@@@@@@@@@A@@B@@@@@@@@@@A@@@ACA@@@@@AD@@@@@@@B@@@@@@@B@@@B@B@@@@A@@A@@@@AA@@@B@@@@@BAB@B@@@@@AA@AAA@@AA@@@@@B@AB@B@B@@@@A@BB@B@@@@@B@@@@BC@@B@@@B@@@A@@A@@@@B@@@@@@B@B@@CD@@@B@@ABB@@BB@@B@@@B@@@@ABA@@@@@@B@@@@@@AA@@@D@B@@BB@BB@@@A@@BA@@BA@@A@@@@@@A@A@@@@@@A@@@@A@@@AB@BABAB@@A@@@AA@@@@AB@@ABA@@@A@A@@@@@@@A@@BA@@BA@A@ABA@A@A@AA@@A@@@A@@AAA@AAA@@@A@A@@A@@A@@AAA@AAA@@@AA@A@@@@@@AA@B@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@A@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@ABBB@@A@@@C@@@@BBA@@@BB@@@B@@@@@B@@B@@@@AB@B@@@@@@@B@@@@@@@BA@@@@@@B@@A@@@AB@@@@@@@@ABB@@B@@@@@B@@@@@@B@@@@B@@@@B@@BBBB@@BBA@B@B@@@BA@B@@@@@@@B@@@@@BB@@A@@@@B@@@@@@B@@@@@@@@@@@@BBA@@@@@B@@ABAAA@@B@@B@@B@@A@@B@B@@@B@B@@B@@@@@@B@BBB@B@BB@@@@B@B@@B@B@@BBB@@@BB@@@@@BD@@@@BBB@@@BB@B@@@@@BBB@@BB@B@@@B@@B@@@B@@BB@@@BB@@BBB@@B@@BB@@B@BB@@@BA@@@@B@B@B@B@@@@A@@@@AAAAA@@@@AA@@AA@@@@@@@@@B@@A@A@ABA@@@@BB@@BBF@B@@B@@BAB@B@@@BABA@@BA@CA@@C@@A@@C@@BA@@@@@AB@@A@@@@@@@@BB@D@@@DB@@@@B@@@@AB@@@@@B@@B@@@B@@D@B@@B@@B@@@AB@BA@@@A@AAA@@@@BBB@@@@@@@BB@@@BBBA@@@BB@B@B@@@@@@BB@BB@@A@@@BBB@@@B@@BBB@@@@@BB@B@@@@B@B@@@@BB@@@@@@BBB@@@ABAA@@A@A@@AA@A@@AA@A@A@A@A@@AA@A@@@A@@A@@@@A@@BABA@@@@A@AA@@@@@A@@AC@@@@AA@@@@@A@@@A@@@AAA@@AA@@@A@@AA@@@@AB@@AA@@@B@@@@AA@BA@@A@@AA@@A@@A@@@@@ABA@@B@B@@A@@@@@AB@@A@@BA@@@@B@@@@@B@@BB@@@B@@@@@B@@@@@B@@@@@BB@@@@B@@B@@B@@B@@@@@@@DB@B@B@@BBB@B@B@@@BBB@BBBB@@@@@B@@AB@BBB@@AB@@@@@B@@ABABA@@@@AA@@AA@@@@@A@A@@B@AABA@@BA@@@AB@@A@@@@@A@@@A@@A@@A@@AAAA@@A@A@@A@A@@@@B@@@@ABA@@@A@ABA@@AC@@A@@A@A@@A@@@@AA@@@@@A@@@A@@AAAA@@@AA@A@@@@AA@ABAAA@AAA@AA@BBDA@@@AB@@@BA@@@A@@BAB@B@B@B@@@@@B@@@@@@BB@@B@@@BB@@B@@@@AB@@@BBB@@BB@B@A@BB@ADB@AB@@@B@@@D@@@@@D@@@B@@@B@@B@@B@@@@BB@B@BB@B@@B@@@BBBD@BA@@@A@@B@@A@@BABAB@@@@C@@@A@A@@@A@@BA@@@AB@@A@@@A@@B@@AA@@A@AA@@@@@@AB@@A@@B@B@@@@@@AB@@@@B@@@BB@B@@AB@B@@@@@@AB@B@DA@@@@@@B@B@@@B@@A@@BBB@BB@@B@@@@@@A@@BA@BB@@@@BD@@@@@@@BBB@@@BB@@@@BB@@B@@@@@@@B@@@@@BA@@@B@@@@BA@@@BB@@@@@@ABB@A@@BB@@@@B@@@@@@@B@@@B@@@@@BBB@@@@@BB@@@@B@@@B@@@B@@@BA@@@BB@@A@@BAB@B@@@B@@A@@@A@@@@@A@@@@@A@@@A@@B@@BB@@BB@BB@@@@@BDB@@D@@@B@BA@B@@@@B@BA@@@@B@BAB@BBB@@@@@B@@@@@D@B@B@@@B@BAB@@A@@B@B@@@BA@@B@@B@B@@@D@B@@@B@B@@@BA@@BAB@@@D@BAB@@@B@B@D@B@@@@@B@@@BAB@@@B@BA@@@A@A@@@@@@BA@@BA@AB@@AB@@A@@BA@@@@B@@@@@B@@@@AB@BB@@@@ABB@@@@@@B@@@B@B@B@@B@@@@B@@@@@BB@@@DBBA@B@@@@B@@@B@@B@@@@B@B@@@@B@@@@A@@@@@@B@@A@@@AB@AA@@@AB@@@@@BA@@@BB@B@B@@@@@BA@@@@BB@@@@B@@@BB@@@B@@@BB@@B@B@@@@B@@A@@@BB@B@@B@@BA@@@BB@BD@@B@@@BB@@@B@@B@@@@A@AB@@@B@B@@@@@B@@BA@AB@@@@@@@AA@@@@B@B@B@@@B@@@@@A@@B@@@@@@BA@@BAB@BBABC@@@B@@@AB@@@@EB@@@@AB@@@@@@B@@@BB@@@@BB@@BBBB@@BB@@@@B@@B@@BB@@@B@@@B@B@@BB@@@B@@BA@AB@BB@AB@@@B@BBB@@B@@@@@@@B@B@@@B@@B@@@@@BB@@@B@@@BB@@B@@@@@@@AB@@@B@@B@@@@E@@@@@DB@@A@@@B@B@@@@DD@D@@@@B@@B@@@BA@@@B@@AD@B@@@B@@@@@B@@@@@B@BB@@@F@@@BA@@@@@@@AB@@@@BB@B@@@@BBBB@@@@@@@BA@@AAAACC@@@@BA@@B@@@@D@@@@@@B@@@BABA@@B@@BDB@@@@BBB@@@@@B@@@@BB@BB@@BB@@BBBB@B@@@@@B@@BD@@@B@@@B@@BBB@@BB@@@@@AB@@@B@@@D@@A@@AA@@@A@AB@B@@B@@BB@B@@BB@@@@@BA@B@@@@C@A@C@@@@@AB@@@@@B@BB@B@@@DA@@B@DA@B@@@@B@@@@B@@ABA@@@@@DBCB@@@@BBBBBB@@B@BB@B@B@@DB@B@BAB@@@@@A@@A@@@@AAAAB@@A@AB@@A@@@B@@@@A@AB@@@@@AAA@@@ABA@@@@@BCAA@@E@@ACB@@AA@@@@@B@@B@B@D@B@BB@@@@@BAB@@BB@@B@@AB@@BCBA@@D@@@@BD@@DEBB@BABAB@@A@A@B@@@BB@@B@BBB@@BBB@@@BB@@BBB@@BBBBB@B@@@@B@@@@B@B@@@BCBABBB@@@@B@D@@@@B@BB@@A@AB@@CB@@@@@B@@@BB@B@@@B@BBB@@@BBB@B@@@AB@@CB@@@A@@@B@B@@@@@@BA@@@@B@@@BB@@B@@C@@@@@@B@@@BA@@@@@@A@DA@@B@@@B@@@@AB@@A@@@@@A@@BB@@BB@@@A@@@A@A@@@A@@@@@@@@@A@@B@@BBA@@@@@@A@@@@@BA@@B@B@B@B@@@@A@@BA@A@@@A@@B@@@@B@@BAB@BA@AB@@A@@@B@@CFBB@@DABA@D@@@AB@@BA@@@@BD@@@AD@@A@@@@BABB@B@@@@AB@@@@B@BB@DB@@@BA@@BA@@BB@@BBBBB@BBB@@BB@B@@@B@BBBB@@BB@@@BB@@AB@B@@AB@@@@@@A@@B@@@@@BB@@@@B@@@@BB@@B@BBBADAB@@@B@B@@@B@@@B@B@@@DAB@B@BBBA@@BA@@@@BA@@@ABBB@B@@@@BB@DB@B@@BB@B@B@B@@BD@@@B@B@@A@@@ABDBBB@B@@BDD@BBB@BB@@BB@@BB@@@BBD@@AB@@@BB@@@BBBAB@@@B@BBB@@@AD@B@@DAB@@@B@@B@@@B@@A@@B@@ABA@@@A@A@@@@@@@@BB@@@AB@@@BA@@@@@@@@BB@B@@@@A@@@@@AB@@@B@@B@@@@@@A@@DA@@BAB@@BB@B@@@@@@CB@B@@DB@BAB@@@BBBBD@@A@A@ABA@B@BB@B@@@@@@B@BA@AB@@@@@BBABADCBFBB@DA@@B@B@@BB@@@@A@@B@B@@AB@@@B@@@BB@@@@DBB@B@@@@AB@@AB@BB@@BA@A@@BBBB@BBAFA@B@@@@@BBB@BBABA@A@@B@B@B@AB@B@B@@@B@@@B@@@@@@@BB@@B@B@@AB@@AB@@BB@@B@BB@B@@@B@@@B@@B@@B@@B@@B@A@@@@@@@@B@B@B@B@@@BABC@@@@D@@BD@@@AB@@@D@@B@B@BAB@D@@@A@@AA@@@@@A@AA@@A@AAA@@@ACBE@A@@D@@@@@B@@@B@@@@BB@DBDBB@@@@@@@@@@@@AB@@AA@BA@@AA@@B@DBB@@@AB@B@B@@@BA@@B@B@BA@@@C@A@@@@BAB@@D@@BB@BA@@BBDB@@@BBAB@@AB@B@B@@@B@@@B@BB@BB@@@BAA@@A@@B@B@@BB@@BB@@B@@@BB@@@B@@B@@@B@@@B@@@@B@@@B@B@@@@@B@A@@@A@A@AB@@@BA@@@@A@A@@@BA@BA@@AA@@@B@@A@@@AD@@@B@@AB@@@B@@B@@B@B@@D@BBB@@@@BB@@BD@@@@BB@@@B@@@BB@@@@B@BB@B@@BB@BBB@@@B@B@@BB@@@B@BABA@AB@@@B@B@@BB@BB@B@@BBB@@AB@@@B@B@BB@@B@@@@@@DA@@@@B@@@AB@@@@BA@BA@@@BBBBDA@@@@@BB@@@AA@@B@B@@@B@B@B@BBDB@BBB@B@@A@@@@BA@@@@B@B@@@B@@@@AB@@@@@BD@@BB@D@B@@@@B@@B@@@@@B@B@@@@@@AB@@@BA@@@@B@B@@@B@@@B@@BB@@@BB@@BB@@BBB@DBDBB@@@@B@@@@DBBB@@BB@@B@@@@B@@BAB@@@@@BB@B@@@@BBBB@@B@@@A@@BAAA@@@@@A@BBBB@@BAB@@BB@@@@BB@B@B@BAB@BB@@BA@@B@BD@@A@@BBB@@BB@AB@D@@@B@B@B@@@BB@@B@@@@@B@B@B@B@@BB@@@@@B@@BBA@@@A@@BBB@B@BBB@@A@@@A@@B@BAB@B@@A@@B@BBB@B@@@BA@@B@@@B@B@B@B@B@D@@@B@@@B@@@B@B@B@BDB@@B@@@B@@@@@B@@AB@B@@BBB@@@A@@@@B@@B@B@@B@@@@A@@@@BB@B@@@@@@@@B@@@@@@B@@@@@@BA@BB@BB@BB@BBB@@@@B@@@BB@@@B@@@@AB@@@@B@B@BBB@B@@@BB@@@@BA@AB@@BB@@B@@BBBB@@@@@@B@@@BA@@AA@@BA@@BA@BDB@@B@@AB@@@@B@@@BA@@@BB@@B@@B@@@@@@B@B@@@BB@@@@B@@BBA@BB@@@@@B@@B@@BBB@@B@B@B@@B@@BB@@@@@@BBBBB@B@@@AB@@@@BBB@@BB@@@@DD@@@@BB@BB@@B@@@@@@DB@@BB@@B@B@@@@@BAB@@@@A@A@A@@B@@@@@@@BB@@@B@@B@@BB@@@A@@@@@@BBB@@B@@@DABA@@@@AA@A@@B@@A@A@@BA@@B@@@B@BBB@@@BA@B@@@BB@@B@@@A@@AA@@@AB@B@BAB@@@@BB@@ADA@A@@@AA@@@@@B@@AB@AABA@@@@BBDBB@B@BA@@@@@@A@A@@@A@CA@@@@@@@AB@@AB@@A@@A@@@ABC@@@@AAAB@@AA@@A@@@@@@A@AB@@@A@@@A@AB@@A@@D@@@B@A@@@@@A@A@CAAA@@@@B@B@B@@@B@A@@AA@@@A@@A@@@AB@@A@@AA@@ACAA@@@@EAA@@@@@@@@@ABC@@@@A@@FA@@@A@@@@@@CB@@@@@ABA@A@@@@@A@@@A@@A@@@@A@@@@@@@@@AB@@A@@A@A@@@@@@A@@@@BA@@B@@@@@@@BA@AA@B@BA@@@@@@AA@@@@@AA@@@@AA@AA@@@@@@B@@A@@A@@A@@@@A@@@AA@@@@B@B@@@A@@A@AA@B@@@@A@@@A@@B@@@B@@@@@BBA@@@@B@@@@@@B@AAB@@@B@@@AA@@A@BA@@A@@@@@AA@@@AA@@@B@BA@@@A@@@@@BB@@@B@@@@A@@BA@@@@@@BBABB@@BB@@@BB@@@@@B@@@@B@@@@A@@@A@@B@@BB@@B@@@B@@B@@@@A@@@B@@@@@A@@@@B@@@@BBAA@@@@A@@A@A@@@AA@@B@BBB@BA@@@@BB@@@B@@@BB@B@@@CB@@A@@A@@ABB@@@@@@@@@@B@AD@@BAB@@@@BAB@@@@@@B@@@BA@A@@@@@@B@@@@B@B@@@B@@@@A@@BA@@@@BBB@@@AA@@@A@@@@B@BB@@@A@@@@BB@@B@@@@A@@B@@@B@@@@A@@B@@BBB@@A@@@@@@@B@@B@@@BB@B@@@@@@AA@@A@@@@@@@AB@@@BB@@@@@@@@@A@@B@DA@@@@AABAB@@@@AAAD@@A@@A@AB@@@AA@A@@@@@@@@@BA@@@ABA@A@@B@@@@@@@BA@@@@B@@@AA@A@@AA@@@A@@@A@A@A@@@@@@@ABA@AB@B@B@@@A@@B@@@@@@@B@@BA@@B@@@BBB@@@B@@B@@A@A@@@B@@@B@@BAB@B@@BA@@@@BD@@@@BA@AA@@AA@@AB@B@@@@@B@@@@B@@@@@B@@@A@@B@@A@@@@@@@A@@@AB@AABA@@A@@ABA@@@A@@ACABAA@@B@@@A@@AAA@@@@@@A@@@@@A@@@@@@@@B@@@BA@@@@BAB@@A@@B@@@@@BB@@@AB@@A@ABA@@@@@@A@AB@@@B@@A@@@@AA@@@@AA@@A@@A@@A@AA@@@A@@B@@@@@@@B@@B@B@@BA@@AAAA@@B@AA@@BA@@@ABAA@@@A@AB@A@@@@BA@@@A@@@@A@@A@@@@@@A@@@@@A@@@@A@@@@@A@@@A@@@@A@@@@B@@@@A@@@@A@@AB@@@A@AB@@@@@B@@@B@@@@@@A@@@@@@A@B@@A@BBA@@A@@@@A@@@A@AAA@@@@@A@@@@@@@@@BAB@@@@@A@@A@@@@@@@@A@@@@@A@@B@@@@@@A@@@@@@A@@@@BAB@@A@@A@@@A@@A@@B@@AA@@@@@@@@@B@@@@@@A@@AA@@A@@@@@B@@@@A@AB@@@AB@@A@@B@@@@A@AAB@@@@@@@@@@@@@AA@@B@@@B@@@BA@@@@BA@@@@ABAA@@@@A@@AB@@@@@@A@@@@@@@A@@@@@A@@A@@A@@A@@@A@@A@@B@@A@@D@@@@@@A@@@@@@@@B@@@@@B@@A@@@@AACA@@@@AB@@AA@B@B@@A@@AA@@@A@B@@@B@@@@@@A@@@AABAA@@@CD@@@@@@@@@A@AB@@@@@AA@@AAA@@@@B@@A@@A@@@@@@A@@A@@B@@@@A@AB@B@@@@@@@B@@@AA@@@@BAAA@@@A@@@@AB@@@@AC@@@AA@@@DA@@BAB@@@@A@@@@@@@B@@@@@B@@@@@@@@@B@@@@BA@@@@B@@@B@B@@@@BB@@@@A@@@B@@B@@@B@@@A@@@@B@@@@B@@@@A@@@A@@@@B@@B@@@@@@@@@@@B@@@@@@B@@@B@AA@@@@B@@@B@BA@A@@@B@B@@@@@A@@@@B@@@@@A@@B@@B@@@@@@@B@@A@@B@@A@@BB@A@B@@@@B@@@@A@@@@@B@@BA@@@@@BD@@AAAB@@BB@@A@@@A@A@@@@@B@@B@@@@@@@@@@@@@@A@@@@@@@A@@@BB@@@@@@A@@@@@@@B@@B@@@@@B@@@@A@@B@@@@A@@A@@@@@A@A@@BA@@A@@@@B@@@B@@@BAA@@@@@@@@@@@A@@A@@@A@@A@@A@@@@@A@A@A@@@CA@@@@@A@@@@@BA@A@@@@B@@@@@@@A@@@@@@@@A@A@@@@@AAB@@@A@@B@@@@@@@BAB@@BB@@@@AA@@@@AB@BA@@@AAA@AA@@@@@AA@A@@@AAA@AB@AA@B@A@@@AB@@A@@@AB@B@@@@BAB@@B@@B@@C@@@@@D@@B@@@ABB@@@@@@@@@A@@@@@AA@@A@@@@@A@@B@@@@BB@@@B@@@DA@B@@@@B@@@@AD@@@@BBB@@BABB@@BB@@@@B@@@@@@AB@@ABB@BB@BB@A@@@C@@@@@@@@DB@@B@AB@@@@@@@@@B@@B@@@BB@@@@B@@@@B@@@@@@@AB@@A@@@@@@@@@A@@@@A@@@A@@A@@@A@@@@BA@@B@AB@@D@ACB@@AA@@A@@A@@@@@@@A@@@@@AAB@@@AA@@@@@A@@@@@A@@BB@A@@B@@@BA@BB@@@B@@BB@@BB@@BB@@@@@@A@@@@@@@@A@@A@@AA@A@@@AAA@A@@@A@@BB@@@BB@@@BB@@@AA@@A@AA@@@@@@@A@@A@@ABA@BA@A@@@A@@@@B@@@B@@@@@A@@@@BBB@@BA@A@@@ABAB@B@@@B@@@@@@@B@@@@@BAB@@@@A@@@@B@@@B@@@B@@A@@@@A@@A@AA@@@@BA@@@A@@@A@@@@BA@@A@@BABAFCBAD@@A@A@@B@@B@@@@B@DA@@B@@@@@@@B@BBBA@@BABA@@@A@@A@@@AB@@@@A@@A@@@@DA@A@@A@@BA@@@@@@B@@@B@@@@@@A@A@AA@@@@DA@@@A@@A@@A@@@A@@@BB@@A@@D@@A@@@@@A@@@@B@@@@@@@@@@@@@@A@@@@B@A@@@@@A@@@BA@@B@@@B@@AA@@@@@@@@@A@@@A@@B@@@A@@@@@@@C@@@@B@@@@@@AAA@@@@@A@@B@@@B@@@@@B@@@@A@@AA@@C@@@@@B@@@B@@@B@@A@@@@@@@@@AA@@@A@@@AA@@@@@B@@@@@@@@@B@@@@@@@@A@@@A@@@@@@A@@@@@AB@A@B@AA@@@B@@@AA@@@@@@@B@@A@A@@A@@@A@@@@@@AA@@@@@@@B@@A@@@@@@@A@ABA@@@@@A@A@@BA@A@@DA@@@AB@@AB@@AAA@@@@BA@A@ABAB@@@BA@AB@@@@AB@BA@@@ABA@@AA@@A@@C@@AB@@@A@@@@AC@AA@@@@@BA@A@@A@@AA@@@AA@@@B@B@@@BAB@@@@AA@@@A@B@@A@BB@@ADA@@@@@@AA@@A@@A@A@@B@@@B@@@@A@@@@CA@@@A@@A@@A@@@A@@@@B@@@@@@@AABA@C@A@@@AA@AAA@@@AAAA@A@A@@@BB@@A@A@@@@A@@@AAAA@@AA@A@@@@@A@A@@@A@@BA@A@@@@@@AA@BA@@B@@@@@A@@@@@A@@A@@A@@B@@@AA@@@@C@@@@@@@B@@A@@@@@@@@@@@A@BBA@A@@@@A@A@@@@A@@B@@@A@@@@@@@B@@@@@B@AAB@A@@A@AA@@B@@AA@@@@@@A@@@BB@@@@@@@@@@@@@B@AA@@@@@AB@B@@@A@@A@@@@B@@@@@AA@@@@@@@@BB@@@A@@@@@A@@BA@@@A@@A@@@@B@@@@AB@@@@A@@@@A@@@@@@@@A@@@@@@@@@@@@@AB@@A@@BB@A@B@@@B@@@@@AB@@@@@@A@@B@@@@A@@@@@A@@B@@@@@@AA@@@@@@@BC@@@@@@@A@@A@@@@A@@B@@AA@B@@@@@A@@A@A@@@@@@@@@AA@A@A@AB@@B@@BA@@BBB@@B@@@@BA@@@@AA@@@@@A@@B@B@DA@@@A@@@AAA@A@@@A@@A@@@@A@@@A@@@@@A@@@@@A@A@@BA@@@A@@DA@@BA@@@ADC@@@@@@A@CDA@@@ABA@@@@@A@BB@@A@@@AA@@A@@AC@@@@AA@A@@@BA@@@A@A@@@A@@@AA@AA@@AAAA@@A@AAA@@AC@@A@A@@@A@@@BA@@@A@@@@@@A@@BA@AA@@@A@A@CA@AB@@A@AAA@A@@@@BAB@BA@A@@A@AA@@@AA@A@@@@AA@AA@@@@A@@@@CA@A@AAA@AAA@AA@@AA@@@@@A@@AB@AA@@@A@@@AB@@BBA@@BABAB@@A@@@@@@@DD@@@@@@A@@@AA@@@@@B@@A@@B@@@B@@AA@AAAAA@CA@@A@@@@@@AAA@@AA@@AA@@@CAA@@A@@@@@@B@@@BABA@@@@@A@@BAB@@A@@@A@@ACA@@AAACC@@D@@@@@@A@@@@@A@@@@A@@ACA@@@AA@@ABA@@@A@@AAA@AAA@@@@A@@@A@@A@A@@@@@A@@@@@@B@@B@@@B@A@B@AB@@@@@@@B@@A@A@@@@@@A@@@AA@A@CA@@A@@@A@A@A@CAAB@@AA@@A@@@A@@@A@A@@BA@@@A@@B@@@BA@@@AA@@@AAAAAAA@@@@AA@@@AA@CA@@@@@@A@@@@@ABA@A@@@@@@@A@@@@@@@@@AA@@@@B@BAB@@AB@@@@@@@@@A@@@AB@@@AB@@A@@BA@A@@BA@@@@@AA@@A@@@@@@@A@@A@ABA@@@@@BA@@@AB@@@@@@@AAAB@@@@@ABABA@@@@A@@@AA@@@@AB@@A@@@@CB@@ACA@@@@A@A@@@@B@@A@@@@@@AB@@A@AA@A@A@@@@@@AA@AB@BA@@@A@@@@AA@AAA@@@A@AAA@@@@A@@B@BBB@B@@@@@DA@@@@@A@@A@CA@@@@@AAA@@@@ABA@@BA@@@AA@@@A@@@A@@A@A@A@@AA@@@@A@@B@D@@@@A@@@@B@@@BBBB@@B@@@@@@ABA@@@A@A@A@@@A@@@@@A@A@A@@@A@@A@@A@@@@AA@@@@@@BA@A@@@A@@@A@@AA@@AAAA@@B@AA@A@@@A@@AA@@BA@ABA@AB@@A@@@C@A@A@@C@A@AAA@@@@C@A@A@@@A@@@@@A@@@@@@AA@@@@AB@@@ACAA@@@@A@@@@@AA@@@@AAAA@@@@A@@AB@@@@@@@@AA@@@@@@@A@@@@@@AA@A@@@A@@@A@AA@AA@@@BA@@B@BA@AB@@A@@AB@@@@ABA@A@@@@@@@@AA@@@@ADAB@@@BA@@@A@@AAA@A@@@AA@@BAB@@@@AAA@@B@@BB@@@@C@@@A@AAAA@@@BA@@@@A@@@@A@A@@@@@@@A@@B@@@@A@B@A@@@@@@@@@@@A@@@@B@@A@@@@BAB@@@@A@@@@@@@A@A@@@@B@@BB@@@BAA@@AA@@A@A@@BABAB@@@@A@@@AA@@AA@A@@B@AA@@A@A@A@A@@@@@A@@@A@@@@AA@A@@@@AA@@A@@@@BABB@AB@@@@@@A@@@A@@BA@@B@B@@AA@@@A@@AB@BABB@@@@B@@@@@AA@@@@@@D@@@A@B@@A@A@@@A@@@AA@@@@@@@A@@@@A@@@AB@B@BA@@B@@@BC@@@@@@@@@A@@BBB@@@A@@@@A@@A@@@A@@@AA@@@@@@@@@@@@@AB@@AB@BA@@BAB@BABA@@@@B@DAB@@AB@@@@@@A@@AAAD@@@@A@@@@A@A@A@@@@@AA@@@@@@@@@@@@@@@@AB@@@@A@@@@C@ACB@@@B@@A@@BBB@B@A@@@@AAA@@@C@@@@@@B@B@@ABA@A@@@AA@BCA@@A@@@@@@BA@@@@BC@@BA@@BA@@@A@A@@@@AB@@ABA@@@@@A@@BA@A@@@AA@@@@@A@@A@@A@@@@@@AB@B@@@@@B@@@@A@@B@BA@@@A@@@AB@@ABA@@@A@@@A@@BAA@@@B@@AB@@@@A@@@A@@B@@@@A@@@A@@@@@A@@@A@@@@@AB@@@A@@@A@@@@@@AB@@A@@@A@BA@A@@@A@@@A@AB@@A@@@A@@AABC@A@@@ABA@@@@@A@AAA@C@@@@AA@@AB@@CC@@A@@A@@@@A@@@A@ABA@@@@B@B@@@B@B@@@BA@@B@@@@@@@@A@@@@@@A@A@AA@@B@B@@A@@C@AAA@A@AAA@A@A@@@A@@A@@@C@EAAB@A@@@A@@BA@@BA@@B@BAB@D@B@A@@AA@A@AAA@@@@BA@@@@@@AE@@@@B@@ABB@AB@@A@@B@@@@@@CA@@@@@B@@@A@@AB@@@BA@@B@AA@@@AA@@@@AA@AA@@@AA@@@@@A@A@@@@B@@@BA@@@@BA@@@A@@@AA@@@@A@@@@A@BA@@@A@@@@AAA@@@@A@@@@A@AAA@@@A@A@@AAA@@AA@@AA@AAA@@@@A@@A@ABA@A@@@AA@BAAAB@@@@A@@@@@A@@@A@@AA@AA@@@@@A@@@AAA@A@CA@AB@@AB@@AB@@ABA@@@AAA@@ADA@ABA@A@@A@@A@@@A@AB@B@@@@CB@BA@@@@@@AA@@BA@@@A@A@BB@@@@@B@@AAA@A@@@AB@A@@@@@A@@BAA@@AA@@@@@AB@@AB@A@@A@@@@@AB@AA@@@@@BC@A@@A@@@@@A@@@@@@@A@AAA@@@A@@BA@@@@@BBB@@@@@@B@@@B@@@@@@@B@@@@A@A@A@CA@@A@@@A@@@B@@@@@AB@BA@ABABAB@@AB@@@B@B@BB@@BB@@B@@@@ABA@AB@@BB@@A@C@@BB@D@B@@B@@CA@@@@BB@@BD@@@@@@@B@@A@A@CC@@A@@@@@@@@B@B@@A@A@@A@@@AA@@@A@C@@@A@BA@@@ABA@@@@A@AA@@@@@@@AB@@@A@@@A@@@AAA@A@@@@B@@A@CB@C@@@A@A@@BA"],encodeOffsets:[[122520,29223]]}},{type:"Feature",id:"330727",properties:{name:"磐安县",cp:[120.450005,29.054548],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@B@@CBA@@AA@@@A@@B@BA@@B@B@@AB@BA@@@@C@A@ABA@@@A@A@B@@A@@AA@@@A@@A@A@A@A@AA@AE@A@A@A@A@A@AA@@A@AA@ABA@@@AA@@AB@@ABA@@@@BA@@B@@A@@@@B@@@@A@@@@@@@@B@@A@@@@@ABABA@@AACAAA@@AB@@AA@@A@@A@@@@@A@@B@@@@AB@@@BA@@@@@A@@@@@AA@AA@@@@ABC@@@AAC@A@AA@@C@@@AA@A@@@@@AB@@@BA@A@A@@A@AAAAA@@AAAAAB@B@@@@@BA@@A@@@@A@@@@B@@AAACCAA@A@@A@AB@@CBA@@B@DB@@B@@AB@BAB@@AB@B@B@@@BA@@@A@@@A@A@@BGB@A@@AAC@@@@A@@AAABAB@@A@A@A@A@@BA@C@CBA@A@@@A@C@@@C@A@A@@AA@@@A@@AA@CC@@A@@@A@@@@AA@A@@@AA@@AA@@AA@A@@A@@A@@A@AAC@@B@B@BA@@BAB@B@B@@AB@D@BA@BBAB@B@@@@@B@@@BA@@@@B@@A@@B@@A@@B@@@BA@@BA@@AA@AC@C@@A@A@AAA@A@@AA@A@A@A@@@@@@@@@@B@@@@AB@@A@@@@B@@@B@B@@@B@@@B@@@B@@@@@B@@@@AB@@@@ABA@@@A@@@@@AA@@AA@@@@@@AC@@A@@A@A@@AC@@A@A@@@AA@@@@@A@A@@A@AA@@@@B@@@@@BA@@@AB@BAA@BA@AAA@@@A@A@@AAA@@A@@@A@@@A@@A@@AA@@@@AA@@A@@@@@@A@A@A@@@CB@BAB@@AB@@@@A@@@@B@@A@@@A@@@@AA@@@A@AAA@@BA@A@AB@BA@@@ABA@ADA@@@A@A@ABAAA@@@ABA@@@A@A@@@A@@@@B@@@@@B@@@BA@@B@BA@@@@B@BA@@@CB@@@BAB@@B@@BA@@@A@@@@@A@@A@BA@A@@B@@@B@B@B@B@@@@AB@BB@@@AB@@A@C@AB@@A@@BAACBA@ABA@@B@@A@@B@@B@@B@@@BAB@@A@A@@@@B@B@@A@AB@BA@@@A@A@@@CB@@@@A@AB@@A@CB@@@@@D@@@@A@CBA@A@@BA@BB@B@@B@B@@@@@@BB@@BA@@@A@@@@@@B@B@BAD@BA@@@ABA@@@A@@@B@@@A@A@@@@B@@@@BBA@@@A@@@C@@@AA@@@@@AA@@B@@@@@B@@@B@@@BABA@@@@A@@A@AB@@A@@@BBB@@B@@BB@@@A@@@@@@B@BBB@@B@B@B@DBBAB@@@B@@@B@@@@@B@@@@@@@BA@@@BB@BB@@B@BB@@@@BD@@@@B@@BB@@@B@@@@B@@@BB@@@D@@@B@@@@AA@@@@@@@AC@@@@@@B@@@@@@D@@BB@@B@@C@@@@D@@@BA@@BAA@@AB@B@@ABA@@@A@ABA@@@@@B@@B@@@@@@@B@@@B@@B@@B@BBB@@BB@@AB@@@BBB@B@B@BB@@BB@@B@@BBBBB@@BB@@@B@@B@@@B@BBB@@BBB@BB@@B@@@B@@BBB@@B@@B@@@@B@@B@@@@@BBB@@B@DBB@B@@@@@BBBBB@@BB@@@B@B@B@@BB@BB@@B@B@@BBB@@BBB@@@BA@@@AB@@@BA@@@@@@@B@@@B@@D@@@@@@DA@@@A@@@@@@AA@@B@B@BB@@BBB@B@@@B@B@B@@B@@@@@@@BA@@@@@B@@B@B@@A@@A@@A@@@@@AB@@@BB@A@@@A@@@@@@@B@BB@@@@@B@@BB@@@@@B@@B@@@@B@@B@A@@@A@A@@@@B@@A@@@B@@BA@@BA@@BABAB@@@D@B@@@@@@@@BA@A@@B@@B@B@@A@BB@@@@BBAB@@@B@@@@@@B@B@@@B@@D@@DDB@@B@@@@@@@@B@B@@@BB@BB@@BA@@@@@BA@@@B@@BB@@@@@C@@B@@B@@BB@@B@@@B@@@@A@@B@@@@@@@@A@A@A@@@@AA@@@A@@@@@@@A@@@@B@B@@@BAB@BABBBAB@@@@@B@B@@BB@@B@BB@@B@@@@B@@@@@@B@@A@@BA@@BBBBBB@@C@@BA@@@@@@B@@@B@@A@@BBB@@@B@@BB@@B@@@@@BA@BB@@B@@B@@@B@B@@@@A@@B@@@B@@AB@@@BB@@@B@@@D@@@@B@@@@@@BB@BBBBBB@@@@@@@BA@@@@@@B@BA@C@@BB@@@@@BBD@DB@@BB@B@@BBB@@@BBBB@@@B@BDBBDBB@@@@BB@@@BB@@B@@@@AB@@AD@@BB@BB@@@B@@B@@@BB@BBB@@@BD@@@@@@AB@@@B@@A@@BAB@@@B@@@BABA@CD@@@@@B@B@BBB@@@@B@@@BB@@@@BBB@@@@B@@BB@B@B@D@@@B@B@@B@BBDB@@@B@BBB@@AB@B@B@@BB@@@@ABA@@@@BB@B@@@B@@B@@B@D@@@@A@@@AB@@@B@@@BBB@@@BBBB@@D@@@BB@@B@@@BBD@BBB@B@D@B@@B@B@@@@@@@B@@BA@@BABBB@BB@@@BBB@B@@B@@@BA@A@A@@BABBBA@@BA@@BA@@BBBA@BB@@B@@B@B@AB@@BBA@@@AB@AA@@@@@@B@@@@BBB@BF@@@B@@@@B@@B@@@BD@@@@@@@BB@@BAB@BADA@@@@@A@@B@@BB@BBBB@@@BB@B@BA@@AA@AB@@@@@@ABA@AA@@@@@@AB@@@BBB@@@BBB@@@B@@@B@@@@@@A@@@@@@B@@@B@@@BBB@@A@@@AB@@A@@A@@@@A@C@A@A@@B@@A@@BABB@BBB@@@@@@B@@@@A@A@@@@@@@@A@@A@@@A@@@@AA@@BA@AAA@ABA@@@@F@@@@A@@@@F@B@DA@@B@@@@@@BB@@@@B@@BB@B@@@B@@B@AB@BA@AB@@@BAB@@@@AB@@@BBB@@@B@@@B@B@@BB@B@@@BA@@BB@@B@@@B@@@BB@@@@@@@@@@B@@@@A@@BB@@B@@AB@@AAAA@A@@@A@@@@@@A@@B@@@@@B@B@B@B@@@B@BB@@BBB@@@B@@@B@@@@@BBBB@@D@@A@@@@@@@B@@@@B@B@@B@@A@@BB@BB@@@BB@B@BA@@@BDB@@BA@AD@@@B@@@BB@AD@@AB@@@B@@@B@@@@AB@@AB@@@@@BA@@B@@@@A@@@@DB@B@@@@@@@@@BB@@@@@DA@@AA@@@C@@@A@A@AAAB@@A@@@EB@@@@A@@@A@@@A@AA@@AB@B@@A@A@@BA@@@@@@ABA@@@AABA@A@@@AB@B@@B@@B@@@B@@A@AAA@@B@@@@@B@AB@@B@@BD@@@B@@A@A@@@@B@@@@@D@@@B@BBD@B@@B@B@BBBD@@B@BB@@@@BB@@BB@@@@D@D@@@B@@@B@@@B@@A@B@@@@BABB@A@@BB@@AB@@@@D@@@B@@@@@BB@A@@BBBB@@@@@@B@@@B@@@BB@@@@B@@@B@B@@B@B@@B@@@B@@B@@@B@B@@@B@BAB@@BB@@BD@@@B@@@@BB@BB@BA@@@@DAB@B@@A@@BC@@@AA@@@@@@AB@@@@@B@@A@@BB@@B@B@@AB@BA@@@AB@@ABA@@@@B@B@@BBBB@@@@@@A@A@A@A@@BA@B@@@B@B@@B@@@BB@@B@BBB@BA@B@@@@@@@@BA@A@@@AB@@@@B@@AB@BB@@AB@@A@A@@BAB@@@@B@B@@BB@@@B@@B@@@@@@@A@BDBB@@B@@@B@B@@BD@@@B@@B@@BB@@@AB@BB@@@B@@@B@B@@DB@@B@B@B@BBB@DA@@B@@B@BB@BBB@@@@BB@@BA@@A@@@@AD@@@@@@@@@B@@B@B@B@@B@@DB@@@@@@ABA@@@A@@BB@@@B@@@B@@@A@ABAB@@A@@BA@BB@AB@@@@@@@@B@@@@@BAB@@@@@B@B@@@BB@BBBBBB@@B@@BB@BB@@B@@@B@BBB@@B@B@BA@@@@BB@B@@@@@BB@@BA@@BA@@@B@@@@BADA@@@@B@BB@@DDB@@@CB@BA@@@@@@@A@@BA@@@@@D@B@@BB@@DBB@@@@B@@A@AB@@@@@@@@AB@B@@BBB@@A@@BB@@@@@@B@BBBBBBB@B@BB@B@@@B@B@B@@@@BB@B@@BB@@B@D@@BB@@AB@B@@BB@@@B@B@DC@@@@@B@@BBA@@B@B@@BAAA@@BBB@@A@@@@@AB@@@@@@AB@@@@@BB@B@@@@BB@B@@@BA@A@AD@@@@B@@ABB@@@@@@@BCB@BDB@@BB@BB@@B@BA@@@@@@@@@BBB@@B@B@@@BB@@BB@@BAB@BB@@B@@BB@B@@@@A@@B@B@@@B@@@@@B@@@BBB@@A@@BB@@BA@@@@@@@@@B@@@B@@@BB@B@@BB@@@@BABB@@@BB@@@D@B@@A@@BAAA@A@@@@@B@@BB@@@B@@@@B@@@BB@@@@BA@@@BBBA@@@@@@B@AAB@@@BB@@@@@B@@B@@B@B@@@@B@@@@B@B@@B@@@B@@B@@@@B@@BB@@@@BB@BB@@BB@@@@@@@@AB@@@B@@@@B@@@@B@@@@@B@@B@@@B@@BBA@BB@BBBB@BBB@@B@@@@@@B@@@@B@B@@@A@B@@BBBBAB@@B@@@@@B@@@@@@@B@@B@@B@B@@BB@@@@DAB@@@B@@@BA@@@D@@B@@BBA@B@@@AB@@@B@@@B@B@@@@AB@@@BA@@B@B@@@@@B@@AB@@A@@@A@@@@@@@@@@B@@A@@@@BA@@@@B@BABB@@BD@@A@@@B@@@@BA@@@@@AB@@@@B@@@@B@@@@B@A@@A@AB@@ADA@@B@@@B@@@@@D@@A@ABA@AB@@AC@C@@@@A@@BA@@B@BA@C@@B@@B@@@@@B@@B@@@B@@@B@@@@@AA@@@@B@@@B@BB@ABB@@@B@@@B@@@@AB@@B@@@@@B@@AB@@@@@B@@@@@BA@@@@@@B@B@@@BB@A@AB@B@@AB@BBAABA@A@@@@@ADB@A@@B@@B@B@BBBB@@@@@DA@@@B@@@@@B@@B@@B@B@B@@B@@AB@@@@AB@B@@@@@@B@@A@@@@D@@@@A@@@B@@@@BB@@B@@@@BBAB@BA@@@CB@BB@D@@BBBBBAB@BA@@@B@B@@@@@@@@@@B@@@@B@@BB@B@@@@@@@@@@@@@A@@@@BA@@@A@ABB@@@B@@@@B@B@B@@@B@@B@@B@AB@@@@@B@BB@@@@@@@BA@B@@BB@B@@@@AB@@@D@@@@@@@@BA@@BBB@@@@@@@B@@BB@@BBB@@BAB@@@BD@@@ABBB@@@@@B@@A@@B@@@@@BA@@B@BB@B@@@@@@B@@BBB@@@@@@@@@@@AB@@BA@@@B@@@@@@B@@AB@@@@@B@@B@@@@A@@@AB@BDB@@B@@BB@@@B@@CD@B@@AB@@@@@@B@@@@AB@@@@@B@@B@B@@@@BBBB@@BD@@@B@@B@@@B@@@B@@@@@@@B@B@@@B@@BA@@B@@@@A@ABABA@@@@@@@@@BDA@ABB@@B@B@@@B@AA@@B@B@@@@A@@B@B@@@@@B@@@BA@@@@@@B@@@@@B@@@@@@@AA@@B@@@@@@A@@BAB@@@A@AA@@@@@@@@D@BA@@@@BB@A@B@@@@@@BA@@AA@@@@B@@A@@@A@@BA@@@@B@@@B@@@@A@@AABA@A@@@@A@@@@@@@@A@@@A@@A@@AA@@AA@@@@@@@BC@AB@@AB@@@B@@@@A@@@@ABAAA@@@A@@BAAAB@@@@@B@B@@ABA@@@@@B@@B@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@A@@A@@A@AA@A@AAA@@AA@A@@@@B@B@B@@@@AA@A@@@@@B@@@B@@@@@@@@A@@@@@@@A@@@@B@@@AA@@AB@@AA@A@@A@@B@@@@@@@AAB@A@@B@@@BA@@B@@A@@A@A@C@@B@@@A@@@@A@@@@A@@@@B@@@@@@@@@@BB@@@@@@A@@@@@A@@A@A@A@@@@A@@B@@@D@@@BBD@@AD@B@@@DB@A@@B@@@B@@@@A@@B@B@B@@@BB@@BA@@@@BB@@B@B@B@B@@BB@@@@A@@@@@A@@@@@AA@@AAA@A@@@@@@AA@@AAAA@@@BAB@@A@@@@@AA@AB@BA@@B@BAB@@@AAACA@A@@AAA@A@CACBAD@BA@@@ABC@A@@BA@@AA@@@A@@@@BA@@A@A@A@@@@CB@@AB@@@BAB@BAC@AADA@@@@@@@A@@@A@@@@@A@@@@@AA@@@A@AA@AB@@B@@B@@AA@@@B@@@@@@@@@@ACB@AB@@AABAA@AB@@@@A@A@@@@@A@@@CAABAA@@CD@B@@@FA@@@@B@@@B@@@@@@AB@B@@@@ABA@AA@@AAA@@@@@@B@@@BAA@@A@@@AA@@@@B@@A@A@@@A@A@@@@A@AA@A@A@@@@A@@@@@C@@B@BAB@@@AA@@@AA@A@@@A@AAA@@A@@B@AA@@@@@ABA@@@@@AB@@AB@@@@@@AB@@@@@@A@@B@@A@@@@@@AAAAA@@@AA@@AA@@@@@@ABAD@@A@@@AB@@@B@@@B@@@@@@@@@@@@@@@@A@@@A@@C@@AA@@@@@AAA@A@@@@AB@@@B@@ABCB@AB@@ABA@@@AB@BA@@@A@@@AA@@@@@@A@@@@AAA@@@AAA@A@A@A@AA@@@A@AAABAA@BA@@@AA@@C@A@@@@@@A@A@@@AA@@A@@@@A@@@@AA@@AA@BA@@@@A@@A@@AA@@@AA@@A@CAA@@ABA@@A@@@AA@@@A@@A@@@A@@@AAA@A@@A@@AAA@AAABAA@AC@@@@@AAA@A@@@A@@@A@@@@A@@BA@@BB@@@@BA@@@A@@AAAA@@A@@@C@@ABA@@@@@AA@AA@AA@@@@A@@@@BA@A@@@@A@@@C@A@@@A@A@@@A@@@@@@@AC@A@A@@@@A@@A@A@AB@@AB@BABA@@@A@@@@A@A@@@@AA@@AB@@@@ABBBAF@@@@@@@@@@A@@@AB@@A@@@@@A@C@@@AB@@@@@@BB@DB@BB@@BBABB@@B@BB@@@@@C@ABA@@@@B@B@B@@@@ABA@B@B@@B@B@@@@@@AB@@A@@@A@AB@@@D@F@@BBAB@B@@@@ABA@A@@BA@A@@AA@@@@AA@A@A@@@@AAA@@A@@@AAA@@A@A@AA@@@BA@A@A@@@A@@A@@@B@@BBA@@BAAA@CA@@C@A@@B@@@@AB@@AB@B@B@@A@@B@@A@@@AB@@AA@@BAA@@A@@@AAA@AAAAAA@@ABC@@@@@@DA@@@@B@A@AA@@@@@@A@@@@@AA@BA@@@BAAAA@A@AAA@@A@AA@@@@AA@@AA@@AAA@@@A@@@@C@A@A@AAAB@@AAA@A@AA@AA@AA@BAA@@@@AA@@AA@@AB@A@@@@@@@AAA@A@@@@@A@A@@@A@@@AB@@AB@BABABA@@BA@@@@B@BA@@@ABABA@@B@@@@ABC@AA@@@@A@A@A@C@AAAAA@@A@@A@A@ABA@@@@@AB@@@AAA@@AA@BA@AB@@@@@CA@@A@C@A@@AA@A@B@BA@@@A@@@A@@@A@@@@@@A@A@A@AB@@ABA@A@@@@A@@DABABA@@D@BA@@BAB@@@B@@AB@@AB@AC@@@A@@@@A@@A@@A@CA@@@A@@@@@BAB@@ABA@@@@@@B@@A@AA@@@A@@@@@@@@B@@@@@BA@@@A@@@A@ABABA@@AA@C@@BA@@@A@A@@@A@@B@B@@@BA@@@@@@BC@@B@@@@@@A@@BA@AB@@@@AB@B@@@D@@B@@@@A@@BD@BB@BABA@@@@B@@B@B@@@@B@@@B@B@@@BA@@@@B@@@B@@@BBBBBB@@@B@@AFB@@B@AB@@BB@@@@@@BCBA@@CABA@@@@@@AB@A@C@@@A@@@A@@@@B@@@B@@@@DB@@A@@AC@@B@@ABA@@@AB@B@@@FBB@@A@AAC@@@@@AA@@@@A@AA@@@AA@@@A@@@AA@@A@@@@@AB@@@AA@A@@@A@@B@BAB@@@@@@@@B@@@@@@BB@@@B@@B@@@@@@B@@B@@@BA@@@@@@@A@A@@@@B@@AB@@A@A@A@@@@AAA@@@AA@AA@@A@@@A@AA@A@@@@A@@@@@AB@A@@A@@@@AA@@@A@@AA@@BCBABA@@@@@BB@@@@CBAB@@@BA@@B@AA@AB@@C@@@A@@@A@@CA@@@A@@C@EAA@@@A@@@A@AA@@@@@ABA@A@@A@@A@@@@@A@@@@A@AA@@A@@@@D@@@@@B@@AAAAA@@@A@@@@@@A@@@@AA@@A@A@BDA@@@@A@@A@@BB@@@CB@A@@A@@BAA@@@B@@AB@AAA@@@ACA@@@@ABAA@@@@ABD@@@ABB@AB@BA@@@A@@@@B@@@@@@@@@B@B@@BB@@EB@AABC@AAA@AA@@CA@@@@A@@@C@A@@@A@@@AAA@A@A@A@@@@A@@EAAC@@@@AC@@@@@AB@@A@A@@@@@@@@@A@AAA@ABC@CB@@AAC@AA@@AA@@A@@@@@A@@@A@@AA@@@A@@@@A@@AA@@@@A@@@ACAA@A@A@AA@@@AB@@AB@@@AAAAA@@AA@@@@A@A@@AA@@@C@A@@@AB@AA@@BC@ABAB@@A@A@@B@@@@A@@BA@@@@@A@@@A@A@A@@@A@A@A@A@@@AA@CC@@A@@@@@AA@@@@AA@@@AB@A@@AAA@@@@@AA@@@@A@@@@A@@@AAC@A@@A@AC@@AAA@@@@@A@AB@A@@@A@@@A@AA@@E@@BABA@A@CA@@@@AA@@@@BA@@@AB@@A@@@@@AC@A@A@AA@BABA@A@@@A@A@@@EA@@A@A@A@A@A@A@@@AB@@AA@@AAAA@@A@A@@@A@@@@AAA@@A@@@A@@@@@A@@@@AA@@@A@A@@A@@A@@@@BA@A@@@AC@@AA@@@@@A@@AA@@@@A@AAAAA@@@@@C@@AABEEA@@@AAA@@AAA@@@A@AAA@@AAAA@@AA@@@AA@@AA@@@AAA@@AA@@@ABAAA@A@AAA@A@@@A@@C@@A@AA@@@@@@AAAAA@CA@@A@@@@@A@AAABA@@@A@A@AB@@@@AA@@A@A@@@A@@BA@@@ABAB@@A@@@@BAAA@@BAB@B@@@BA@@AA@@@A@@A@@AAA@AAA@@@@@A@A@@@@@ABA@@@ABABB@@@@B@@BB@@@BA@@AC@@@@B@@ABBD@B@@@BB@@@B@@@B@BBBB@@AB@@A@@@A@@@@B@@@@AB@@A@A@@@A@@CAAAB@@A@@@A@@@ABA@A@@@@@@@AB@BCB@@@@@@CB@@@B@@@BDD@@ADA@@@A@AB@@@@ABA@@C@@@A@@A@@AA@@AAA@@AC"],encodeOffsets:[[123403,29577]]}},{type:"Feature",id:"330781",properties:{name:"兰溪市",cp:[119.460472,29.2084],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@AAAAEGAC@@A@@BC@A@A@@AA@A@CCAB@B@@@@@@@@@@@@@B@@@@@@@@B@@@@@@BB@@A@B@@B@@@A@@@AB@@A@@@A@@@@@@@A@@B@@@@@@@@@@A@BB@@A@@@@A@A@@@@@@A@@@@@@@@@A@B@@@AA@BA@@@ABB@@@B@@@@@@@@@B@@@AB@@B@@B@@@B@@@BA@@A@@@@@@@@@AA@@BB@@@@@@B@@@@A@@B@@@@A@@B@@@@B@@@@@@B@@BB@@@@@@@@@@B@@A@@@B@@@@@@@B@@@@A@@@@@B@@@@@@BA@B@AB@@@@A@@@BB@@A@@@A@@@@B@@@A@@@@AB@@@@@@@@@@@B@@@@@B@@AA@@@@@@@A@@@@AB@@@@@@@@@B@@@@A@@@@@BBA@@A@@A@AA@@@A@@A@@B@@B@@B@@AA@@@@@@@BA@AD@@B@@@@BA@@@@@@@@@@@A@@@@@@B@@@@@@A@@B@@@@AA@@@@@A@@A@@@@BA@@@@@@@@B@@@@B@@B@@@@B@A@B@@@@B@B@@@@@BB@@@@@@B@@@@@@@@A@@@A@@@@@@@@B@@@@@B@@AA@@@@@@@@@@A@@@A@@@@@@@@@@@@@A@@@BB@@A@@@@@@@@A@@@@@@A@@@@@BB@@A@@@@A@@@@A@@@@@@@@@@@@DB@@B@@@@A@A@@A@@@@@@C@@@@@@@@B@@@B@@@@A@@@@@@AB@@@@@@AA@@B@@@B@@@@@@@@AA@@@@@@@B@@@@@@@@A@@@@@@@@AB@@@@@@A@@@B@@A@A@@@A@@@@@B@BB@@@@@@@B@@@AA@E@@A@@@@@@@A@BA@@B@@B@@D@@A@@@A@@@@@ABB@@@B@@@@@B@@@CBA@@@@@@B@@BB@@A@@B@@@@@@A@@@BB@@@@B@@B@@A@@@@@@BB@@AB@@@@@@@@@@B@@@@@@@B@@@@A@BBA@@@@@@@@@B@@@A@@@@@@B@@@@@@@@@@@BA@@@@@@B@@@@A@@B@AA@@@@@@@@@@B@@@@@@@@@@@B@AB@@@@@@@@@@B@@A@AA@@@@@B@@@@@@@@@@@BA@@@@@@A@@A@@BB@@@A@@@@@@@A@@BBAA@@@B@A@@@@@@@A@@A@@A@@@@@@@@@@@@@@@@@A@@@@@ABAA@BA@@@@@@B@@@AA@@@@@@@A@@@@@@@B@@@@AA@@@@@A@@A@B@@AA@@B@@A@@AB@B@@@@ABB@@@@@@BBA@@@B@@A@@BA@@@@A@@@B@@@@@@@@@@@@@BA@@@@@@@@B@A@@A@@BB@@@@@@B@@A@@@@@A@@@@@@B@ABB@@@@@@@@A@@@@@@B@B@@@@B@@@@@@@@@B@@@@B@@@@@@@B@@@@@@@@B@@@@@@@@@@B@@@@@@B@A@@@@B@@@@@@@@@B@@@@A@@@@@@@@@@B@@@@A@@@@@@@B@@@AB@@@@@@AB@@B@@@@@@@BA@@@B@@A@B@@@@@@@@@@@@@@B@@B@@@@@B@@@AB@BA@A@@@@@@@@@@@A@@@@@@@@@@B@@A@@@@@@BA@@@@@@@A@@@@@@@@@@@@B@@BA@@@BB@AB@A@@@BA@@@@BA@@B@@@@@@A@@B@@@@@@A@@@@B@@@@B@@@BB@A@@B@@B@@A@@@@@@B@@@@@@@@@@A@@@@B@@@@@@@@@@@AB@@B@@A@@B@@@@ABA@@BB@@@@@@@AB@@@@@@@@@B@@@@@@@B@@@@@@@@@@B@@@@@@@@BA@B@@@@@BB@@@A@@@@@@B@@A@B@@@@@B@@@B@@@@B@@BA@@@@@@@A@BB@@BA@@B@@@@@@@@@AB@@A@@B@@A@BB@@@@A@@@@@BB@@@B@@@BB@@@@@@@@@@@BB@@@@A@@@@@@@@B@@@@A@@@@@A@@@@BB@C@@@@@@@@@@@BB@@A@@BAA@@@@@B@@@@@@@@B@@@@B@@@@@B@@@B@@A@BB@@@@B@@@@@@@A@A@@B@@@@A@@@@AB@@@A@@@@B@@@@A@@@@@@@AAAD@@A@@BA@@@A@@@AA@@A@B@@@@A@B@@B@@@@A@@A@@@@@@A@@@BB@@A@@@@@@@@@@A@@@A@@@A@@A@@A@AB@@@B@B@@A@ABAA@@@@A@@@@@@@@@A@@@@@@@@@@@A@@B@@@@A@@BA@@A@@@@ADB@B@@@A@@@BB@BA@B@@@B@@@B@@@B@@@BAB@DB@@B@@@B@@@B@@@B@BB@@AD@B@@@@B@B@@B@@BB@A@@@BBB@@@@A@BB@@@@@@@@A@@@@A@@@B@@@@A@@@B@@B@@@B@@A@@BA@@@A@@B@@ABB@@@AB@B@@@@@@@B@B@@BBB@@@DB@@@@@@@BAB@@B@A@AB@@@@@@AB@@BB@@@@@BA@@BB@@@@@A@ABB@@@D@@@@@B@@B@@BB@@B@B@@@@BB@B@@BD@BB@@@B@@BB@@@@@B@BB@BB@@@@B@@@BBA@B@BB@@B@@BB@@@@B@B@@BB@B@@@@BBB@@BBB@@@B@DFD@@B@@@B@B@@@BB@@BBB@B@BBB@@@B@@ABB@@BBB@@@@BBB@@@BABB@BB@BB@BB@@@@@@@B@@DA@@@B@@@BBBB@@@@@@@BB@@@@A@BD@@@@@B@@B@@@@@B@B@@@@@@B@@@@B@@B@@@@@@@@B@BBA@@@@@@@@@@BBB@@ABA@@@@BAD@@@BA@@B@B@@@@B@@@@B@@@B@@B@@B@@@@BA@BB@@@@@@@B@@@@@@@@@@BB@@@@@AB@@@B@@@@@BA@@@AB@A@@@@@@AB@B@@@@BAB@@@@@BAB@@A@@B@@@@@@@B@@@@B@@BA@@@@@@@@@@B@@B@@@@@@@A@@@@@@@B@@@@@@BA@@@@@B@@B@@AA@@@@AB@@@@@@@@@@@@@@BB@@A@@@@@@@A@@@@@@@@A@@@B@@A@@BA@@@@BAA@@@@@@A@@@@B@@AA@@@@A@@A@@@@@@BAA@@BA@@@@BAAC@@B@@@@@@@@B@BBB@@@@@@A@@@@@@BBD@@@B@@@@@@B@@A@@@A@@B@B@AAB@@@BB@@@@@@@@@A@@@AB@@B@@@@@@B@@@B@@@A@@BDB@@B@A@@@BB@@@B@D@@B@@@B@@A@DA@@@@@B@@@@@@@B@@@@@@@AA@@B@@@@@BB@@@@BBB@@@@@B@@@BA@@BA@A@@@@@@@A@@@@@@@@@BB@@@BA@@B@@@@@@@@B@@@B@@B@@B@@A@@@AB@@A@@@AB@@@@@@BD@@BA@ADA@@@@B@@@@@@@@@@@@B@@@@AB@B@@BA@@@@B@@A@@@AB@@@@@@@@@@@B@@A@@B@@@@@B@@A@@@BA@ABB@@B@@@@AB@@@@@BB@@@BA@@@@@B@@@@A@@@@B@@B@AB@@@@@@@BBA@B@@@B@B@@@@B@@A@@@AB@@B@B@@B@@@@BA@@@@@B@@@@@AB@B@@ABB@@@@@BA@@@@@A@@B@@@@C@@B@@@@@@A@@@A@@@A@A@@@@B@AB@@@@B@@@@A@@@@@B@B@@@B@@@@@@@@@B@@@BBBA@@BA@@@@@@B@@C@@@@@@@@B@@@@BB@@B@@BA@@@@@@@@@@B@DA@@BA@A@@B@@@@@B@@@BBBBB@@@@@@AAA@@B@@@B@@@@@@B@@@B@@@AB@@@B@B@@@@@@B@@A@B@@@@@@@@@@@@B@@@B@@@@@BA@@@BD@@@@B@@B@B@@A@@@B@@@@@BB@@@@B@@B@@@AB@@B@@@B@@@B@@A@@B@@B@@@BB@B@@@@A@@BB@@@AB@@@AB@@B@@@BA@@@B@B@@BBBBBAB@@@@ABA@@B@@@BA@@@@@@@@@B@@@@@@@B@@B@@@B@@B@@@@A@ABC@@B@@AB@B@BA@@BA@A@@B@@@@@@DB@@@B@@@@A@@A@B@@A@@@@BB@@@@B@@@B@@@@@BBB@@B@@B@@@B@@AB@@BB@B@@@@A@@@@@@B@@AA@B@@@B@@@@@B@@@@B@@@@B@@@B@@AB@@@@@@A@A@@B@@@@A@@@ABA@AB@@A@@@A@DDBBBB@@A@A@@@@B@@@B@@A@@AA@@@A@AAA@@BA@@@A@@@@B@BB@@@@@@B@B@@@B@@AB@@@B@@ABBB@@@BB@B@@B@@@@@@@BBB@@@B@@BB@@CB@B@@@@@B@@@DBB@@@B@B@@@BB@@B@@BB@@B@@B@@B@@@B@@B@@ABAD@B@@B@@B@@BB@B@BB@@B@B@@B@@@BB@BB@@B@@@@@@ABA@CB@@@@AACCA@A@AB@BA@@B@@B@@@B@@@BB@@BB@@D@B@@@@@@BA@@BA@AAA@@BA@@@ABC@@BA@@@A@@@A@@@ABA@B@@@@BB@BAB@@BAB@@@@B@@B@@BB@D@B@@B@@@@@@B@@@B@@@@B@BAD@@@BB@BBB@@BA@@@@BB@@@@@@F@@@B@B@BB@@B@@@B@@@B@BB@AB@B@@@B@BB@BB@@BB@@BBBB@@B@@DB@@B@B@B@BC@@@@BD@B@@@@@@@B@@@@BA@@@@@AA@@A@@D@BB@@DB@@BB@@@B@@@BBB@B@@@BBBB@BB@BB@@@@@@B@BBBBB@@BBB@DB@@B@@A@@B@@AD@@A@@B@@@BCB@@@@A@A@@BAAA@A@@@A@ABAB@@A@@@A@A@ABA@@@@B@BCBAD@@@@@@@B@@A@BB@@@BA@@@@BA@@BA@@BA@A@A@@@AB@DB@@@@DB@@@A@@D@B@@@BB@@@@BA@@@@BB@@B@BA@@@AB@@ABBB@B@@@D@@@B@B@B@@@B@@@BB@@BB@@@@@B@@@BBBBF@@A@A@CAA@AACA@@@@@B@BBF@@DBB@B@BDB@B@@B@B@B@B@@AB@@@@B@DBB@B@B@BB@@B@@@B@@@@@@@BA@@@A@A@@@A@@BA@@AA@@BA@@@AB@BB@@BAB@A@@A@@A@A@A@@AB@@@B@@@@@@A@@BA@@AA@@B@@A@@@@A@A@@A@@@@B@B@BAD@@@B@@@B@@@@@D@B@@A@@B@@BD@@@@@@@AA@@@ABABA@@A@@A@A@@B@BA@@BD@AB@@@@A@@BAB@B@BADABA@A@AC@@@A@@@A@A@@@@A@A@@DBB@@@BA@@CAAA@@@@@A@@@@@@B@BBD@@@@AB@@@BA@@B@@A@A@@@A@@@C@@@@@AA@@@@@B@@@BC@@@@@@@A@@A@A@A@A@@@@AA@@@@A@@@@@A@@@A@A@@A@@A@A@AC@@@CA@A@A@A@AAA@ABA@@@@@@BB@@BA@A@A@@@@CABA@@BAB@B@@C@@B@B@@@FA@CAAA@@@@@B@@@@C@@@@@@@@BB@@@@B@@B@@B@@@@BBABA@@B@B@@BD@@A@@@ABAB@@@B@@@B@@@@AAAAA@@@A@A@@@@BA@@@@AA@@@@@ABABA@@@A@@@@B@BB@@@A@@@AB@@@@@@AA@@@@ABB@@@@@@B@@@@@@@@AA@A@AA@@A@@AA@@@B@B@B@B@@BB@@@BBB@@@@ABA@A@@B@@B@@@@BAB@B@@BA@B@@@@@@@@A@A@@@@A@@@@@@@A@B@@@AB@@@A@@@@A@@@A@@@@AA@AA@@B@@@B@B@@@BB@@@ABB@A@AB@@A@@@@@AB@@@@@@@@A@@@BB@@@@@@B@@@@@@BB@@B@@@@@@@@B@@@B@@@@@B@@B@@@@@@@@A@@B@@@@@@@@@@A@@@@@@@@@@B@@A@@@@@@@@@BBBA@@@B@@B@@BBD@B@B@@B@@DAB@@AB@@B@B@C@@@@BBBB@@@@B@@@@@@AAA@@@AB@BABB@BBBBBBDBDBBBBFHDFB@BDBDBB@BBF@@@DADCF@@BBB@@@B@@@AB@@B@@@@@B@B@@B@@BB@B@@B@@@B@@@@B@@BBB@@@B@BBB@B@BA@@BA@@@A@@@@B@@@BAB@BAB@@@B@@@DAB@B@BBDA@@B@BB@B@@@@@BBBD@BB@@BBB@B@@@B@@@@@@@B@BAB@B@@@@@D@B@@B@@B@B@BAB@B@@BB@@@BB@@BB@B@@B@@@@B@@@BB@@B@@D@B@B@B@B@B@@@B@BAB@B@B@@@B@B@@@B@@A@@BABA@@DABA@@B@@AB@@@@@BA@@B@BA@@@A@@B@B@B@@@B@BB@@B@B@@@DA@@B@@@@AB@BA@@@@@BB@B@@BBBB@@@BB@@B@@BAB@@@BAB@@@B@BBB@@@@AB@@@B@B@@@@A@A@AB@@CBAB@@@@AB@@AB@@ABA@@B@@A@A@@BA@@@@B@@AB@@BB@B@BB@@@B@@@B@@@@@@A@@@@@AB@@@@@BB@B@@B@BB@BBB@BBB@@B@@B@@B@B@DD@@@B@@BB@@@B@@BBB@B@@BB@@@@B@@@B@@CBA@@@@B@BBBBB@BBB@D@@B@@B@B@@B@@B@@@@DBB@@@D@@@B@B@B@@@@@@BA@BBB@@@@BB@BAB@@@@@B@@@@B@@@@@@@@@@@@ABA@@@@@@@@BB@@BB@@@@B@@B@BB@@@BAD@B@@A@@AA@@A@AA@@@C@@@@@@BA@@A@A@BC@@B@@@B@@@@AB@@@@B@@@@@BB@BB@@@@@@@@@@@B@BB@@@@@@B@@@@@B@@@BA@@B@@ABADC@@@@@@@B@BB@@B@BCBA@@@@BB@B@@@BBBABBB@@@@ABA@A@@BA@ABC@A@@@A@@B@D@@ADABC@@@AB@@@@@@B@BBBB@BBB@@@B@B@@@BBB@BB@@B@@A@@@@@@@BBB@@B@@@B@@@@@BABA@@@@@@@AB@B@DA@ABC@@@CBBB@D@@@@A@AAAB@@A@@@@@A@AAB@@A@A@@@@A@A@@B@D@AA@@@@BB@@@@B@@@B@B@@@B@BBAB@BEA@@@BAB@B@@B@B@@@B@B@B@@@@A@@@@@A@@B@B@B@BB@@@BBB@@@BA@ABA@CBABA@@@A@@@@B@@@@B@@@B@@@@@@@@@A@@@BBDBC@A@ABA@@@@@BB@@B@BB@@BBB@D@@@BA@@B@@BBB@@@B@@@B@BB@@B@@@BA@BB@B@B@@BAD@AA@@@ABA@A@A@@F@B@B@@@B@@A@@@@@@@A@@B@BBB@@@AA@@@A@A@@@@BB@DBA@BBA@@@@@@@A@@@@B@DB@@B@@@@@@@A@@BAB@@A@CBA@@@@@B@D@D@DB@@@@@B@@@@@B@@@BA@@@A@A@A@@@@BABAA@@@B@@B@@B@@B@@BBBB@@@@@B@D@@@@BD@B@@B@B@D@@BBB@@B@@@B@@BB@@B@@@@@BA@@@AB@@@BB@@BB@@B@@@B@B@B@@@BA@ABA@A@@BD@@BB@@B@B@B@@@BC@@AA@@BABABAB@@A@A@ABA@A@@A@A@C@@A@@@@@AB@BBB@@@@A@@D@B@@@BA@@AA@C@BB@@@@BDA@@@@A@@@A@@@@A@@@@B@BA@ABA@@@B@@@@BB@@@A@@@A@@@A@@B@AAB@@AB@B@D@B@@@@AAA@@@@@A@@A@@AB@@@BA@@@A@@AB@A@@@@B@@@@@@AA@AB@@A@@@BA@@@@A@A@@@@A@@@@@@@@@@@@@ABB@@A@@@B@@@BAB@@@@@A@BA@@@@@BB@B@@@@@AA@@@@@@@@@A@@@A@@@@@@BB@@BB@@@@@B@@@@@@@@D@@@AAAAB@@@@@@@B@@ABCBBBA@@FC@@@ABB@AB@@@AA@@@@@AB@@AA@@@B@@@@D@@BA@@@@@AB@@A@@@A@A@A@A@A@@@AB@B@@A@@@@@AD@@AB@BAD@A@@A@@B@AAB@@@B@@B@@B@@A@AB@@ADBB@BBB@D@@@DBB@@@@@AB@@B@@B@@B@B@BBBA@@BAB@@@BA@@BAB@DAF@B@BA@@B@BA@A@@AAB@@A@@@AB@BA@@B@@@D@B@B@@@@AA@@@C@A@@AB@@@B@BBB@@@B@@A@@B@B@@BB@@@@@A@CB@@@BB@@D@@@AB@B@@B@@B@@@BA@@@@AB@@@@BB@A@@@@@@@B@@@@@@A@@@@@@@BB@@@@@@BB@@@B@@@@BD@@BDBB@@@@ABA@@B@@@B@@@@BBB@B@BB@@@B@@@@@B@@D@@@@B@@@@@A@@@@DA@B@@@@@@@@BB@A@@@A@@@A@@BB@@BB@B@@@@BA@@B@@@AAA@@@@A@@@@B@@BB@@@B@@B@@@B@@@@B@B@BA@@B@AB@@B@@@@@@@AB@@B@B@@@@B@@@@BBB@@B@@B@@@D@@B@B@@B@B@@@BABA@@@AB@@BBA@B@@B@@B@@B@BA@@B@BAB@@@B@@ABA@@B@B@@@B@BAB@@AB@@@@BB@@@@@@@@AB@B@@@@@@@B@@@@@@@@@@@@A@@@@@A@@BB@@@AA@@A@@BB@A@@@@@@A@@@A@@AA@@B@@@A@@B@@@A@@@@@BA@@@BB@@@@@B@@@@@AA@@@@@@A@@A@@B@@A@@@A@@B@@@@BB@@BBA@@@@@BA@@@@B@@@@@@@@B@@A@@B@@AB@@@B@@@@@@@@BA@@@ABB@BA@@@@@@@@@BA@CB@@AB@@@@B@@AB@@@@@@@B@@@@@@@BA@@@@B@@@B@@@B@@B@@@@@A@@@@A@@AA@@@B@@@@@D@@@@BB@BAB@@@AB@B@D@@@@@B@B@@@@@A@@@@@@@@B@@@@@@B@@@B@@B@@A@A@@@@@@A@@@@A@@@@@@@@B@@@@@BA@@@@BB@B@B@@@@@A@A@AACB@B@@@B@@@@@@D@@@B@@@B@@@@@@@AB@@@@A@A@A@@@@@B@@DB@@@@@@AA@@@@A@@A@@@@DAB@@@@@@AA@@@@@@AA@AB@@@AA@BAA@@@@@@ABB@@@A@A@BA@@@@AB@BA@@BA@@BA@@A@@@@@ABAA@@AB@@@@@AB@@A@@@@@@@@@@@@@@B@@@@AA@@@@@A@@B@@@B@BA@ABA@@@B@A@@@AA@@@@B@@AA@@@BABA@@@B@@@@@@@@BA@BA@B@@@@@@A@@B@BA@@A@BAA@@@@BA@@@@@A@@@@@@@@ABA@@AA@@@AA@@@@A@@AAA@AB@@AB@@@@@A@@@ABAB@@@@@B@@@@@@@A@@@A@@A@@BABAB@@@DB@@@@B@B@BBD@BBBAB@@@BA@@@AB@@@@A@@D@@AB@@A@@@@DA@@@CAA@@@A@A@@@ABA@@AAA@AAA@@A@@B@@@A@@@@@@A@CBCBCA@A@A@AA@@A@@B@BA@@@@@A@CC@@AA@C@@@A@@CBA@@@A@@A@@@AA@@@A@@AB@@@B@@A@@B@@@B@@@@@@A@@@A@A@A@AA@@@C@@@@@@@AA@@@@@ABA@@A@@@A@@@A@A@@@@@A@ABACB@@@A@@@@@AAAB@BA@C@@@@@@@@A@@@@@@@AAA@@@@A@AA@@@@A@@@A@@A@@A@@B@@A@@@A@@BA@@@@@AAA@@@@@@A@@@A@@@@@@@A@@A@AA@@A@@A@@@@AAA@AA@ABA@@@@@A@@@@@AA@@@A@AA@@@@CA@@AA@@@@A@@@@@@B@B@@AB@@@B@@B@@@A@@@A@@@A@A@@@@@@A@@C@@@@@@@CA@@@@AAA@@A@A@@AA@@AA@@@@A@A@AB@@@A@AA@@@C@@@@A@@AABAA@@AA@A@@A@@@AA@AA@@DC@@@@BA@@DA@@B@@@AAA@@@@A@@B@@A@@A@@A@@BA@A@@@AA@@@@A@@A@@@A@A@@@AA@@AB@@A@@@A@@@A@A@@@AA@A@@A@@@@@A@@@AA@@A@@@@@A@AAA@@@C@AA@@A@A@@@@@@@AA@@A@A@@AA@AA@@@@@A@@@@AA@@A@@@C@@AA@@@A@AA@@A@A@A@AA@@AA@@A@BAA@@A@@A@@@@@AB@@CA@A@@@@B@@AB@BA@@@@A@AB@@@@@A@@@@B@@A@@@@A@AB@@@C@@A@@@@B@@@B@B@@@AA@@@@A@@@@A@@@@@AAB@@AB@@@@@@@@@A@@@A@@@AA@@@@@@@@A@@@@@AA@@@@A@@@AA@B@@A@@@B@@@B@@B@@A@@BA@@@@@@A@@A@AAAA@@A@B@@B@@@B@@AAAA@AA@@AAA@@AA@@ACAA@@AAAAA@@A@@CAA@@AA@A@@AA@AA@@A@AAA@A@@@@AA@AC@@@@@ABA@@A@@A@@@AAA@@@A@A@AA@A@AACAA@@AA@@@A@A@@@A@@@A@AB@@@@AA@A@@A@A@A@A@@@A@AACB@AA@C@AA@@A@@@@A@@@@A@@A@@AA@@A@@@AA@@A@@@A@AA@@A@@@@A@@@@@A@@@AA@@AA@AA@@A@A@@@@AA@@A@@A@AA@@A@A@A@A@@@AA@@A@@@@@A@A@CA@@AACAA@@@C@@@A@C@@@A@ABA@@@A@@@A@A@@@@@BB@@@@B@@B@@@B@@ABA@@@AA@AA@@@CAEB@@A@ECAA@ACA@AA@@AA@@A@@AA@@@AA@@@A@AAA@A@CBA@A@AAA@A@@@AAAA@@A@A@@@AAA@AAA@@@AA@A@AA@@@A@C@@@ABA@E@A@@B@@@@@B@@ABA@@@EBAAABAB@@A@@AA@@BA@A@A@C@@@AA@@@@AB@@A@AACB@@A@AA@BAAA@A@@@A@@BA@@@A@C@ABA@A@@@AAAAA@AA@AAA@@AA@@@@BA@A@@@@BBB@@@@A@CA@@@@@A@@@@A@@@@@AB@@@AAB@@@A@A@@AA@@@@C@AB@@@@AE@@AA@@AA@AA@AA@@AA@@@AAAA@@AAA@@A@@BAAAAA@@@@A@@@@A@@B@@@@AA@AC@@@@A@AA@@A@EACAC@AAA@A@@A@@@@BA@A@@AA@@@@@C@@@@@@@A@@@A@A@AA@A@@@@A@@A@@C@@@A@@AA@@@A@AA@@A@A@A@A@@@@@@@AB@B@@@AA@@@@BAA@@@@@@@@@@@A@B@AA@@@A@@@@@@B@BB@AA@A@@AB@@@B@@@@@B@@A@@@@@@@@@A@@@@AB@@A@@@A@@@@@@@@@A@@AB@@@B@@@@A@@A@@@A@A@@@@@BA@@B@@@@@@A@@@@@AB@@@B@@A@@@@A@A@@@@A@@@AB@@A@@A@AA@@@A@@B@@@@B@@@A@@@@A@@AA@@@@@A@@@@@@@@@@@BA@@A@@@A@@@@BA@@@@@AA@@@@A@@AB@@A@@AA@AA@B@@@B@B@B@@@A@@A@A@@A@@@@B@@AA@A@@@@@A@@@@@@@A@@@@@@@@@@@@@AA@@@@A@@@@A@@@@@B@@@@@@A@@A@A@@@@@AB@@@CA@@@B@@@@A@@A@@A@@@@@A@@@@@@AA@@@@A@@@@A@@BB@@@@@@@A@A@@@@B@@@@@@CA@A@A@AA@@A@@BAD@H@FAD@@ABAAAAAAA@AA@@AC@@A@@A@@AA@@@@@@AAA@@@CAA@CBA@A@@@@@AA@@A@@B@BG@@@@@A@@@A@@A@@A@A@@@C@C@@@A@@@A@@B@@AAA@AB@@A@A@A@@A@A@@@AABAAA@@@BAA@@A@@A@@A@@@@AAB@@@@A@@@A@@@@BA@AA@A@@@@@@@BA@A@@@A@A@@B@@A@@@AAA@@B@@@@A@@@C@@@C@@@A@A@@@B@B@@@@A@@@@@@@@BE@C@@@BA@@B@@A@@A@@@@@A@@@@@@@AA@@@@@B@B@B@@AB@@@@A@@@@@A@AA@@A@@@@@@@@@@A@@@@@@B@@A@@@@B@BC@@@@@A@@@@@@A@@B@@A@@@@AA@A@@@@@A@@@@@@@@@@B@@C@@@@@@@AA@@AA@@@A@AA@@@A@@AA@@AAAA@@A@@@@@A@@@@@AAAAA@@@@A@@@@@@@@A@@@@@A@B@@BA@@B@@A@@@@A@A@@@@B@@@@@@AA@@@@@B@@@B@@@@@@@A@@@@@A@@@A@@@@E@A@@BAB@@@@@BA@@@AB@@@@A@@@AB@@AA@AA@ADA@@@@@A@@@@@@@BA@@@AB@@@@A@@A@AA@@@@@AB@@A@@@A@@A@@@@@EB@@@@@@C@@B@B@@AB@@AAA@@@@@A@BB@@@D@BB@@B@@A@@@BD@@B@B@@@@@@B@@@@BA@@@B@@A@@BB@@B@@@AB@BB@@@@AB@@@@@B@@A@@BCB@@@@@@@@A@A@A@@@A@@@@AAB@B@@@B@@AA@@A@@@@B@@@B@@@@@B@@BB@@@@@BB@@@@@@B@@@@@@A@@B@@@BB@@@A@@@A@@A@@@B@@@@@@A@@@@B@BC@@@AABA@@@A@@@A@A@@@@AC@@A@@@@@A@@@@@@@@@A@@@@BB@@@@BAA@@A@@B@@AA@@A@@@A@@@@@@@@@@@ADA@@@@@@@A@@@@@@@B@@B@B@@@@BB@@@@@@A@@@B@@@@BB@@@@@BDA@@@@@@B@@B@BB@@A@@@@@@B@@BA@@A@@@BB@A@DB@@@BA@@@AB@@@@@@@BB@AB@@@@B@@@@DB@B@@@@@@@A@@@BB@@@@@@@@@@A@B@@@@BB@@B@@@@@@B@@@B@@A@B@@@@@@@BB@B@@@@@B@@@@@@@@A@@@BB@@@B@@AAA@CAAAA@A@@@A@@BB@@@A@@@@@@@A@@@@@BB@@@@AB@@@B@@@@@@@BA@@A@@AB@@@BA@@@@@@@A@@B@@@@@@B@@@@B@@@B@@@@@@A@@@ABC@A@@BA@@@@B@BABAB@@@A@@@@AB@@@@@@A@@B@@@A@@A@@@@A@@@@@@A@@@@B@@@A@@@@@B@@A@AA@@AB@@@@@A@@@@@@A@@@@@AAA@@@@@A@@@@@@@AA@@@BA@@@@@GE@@AC@@AB@@@B@@AA@@@@@@A@@@A@@@B@A@@B@@@B@@@@@@AB@@B@@B@@@@B@@B@@BB@A@@B@@B@@@@@@@@@@A@AB@@@AA@@@@BB@A@@@A@@A@@@A@AA@@@@@@A@@AA@@@@@@@A@@A@@@@@@@@@A@@@@@@@@AAB@AA@@@A@@@@@@@A@@@@@AB@@@@@@@B@@BB@@@@@B@@@@B@@@B@B@@@@@@B@BA@@BA@@@@@D@AB@@A@CDAAAACE@AAAA@@AE@A@AA@@IGAAAM@AA@A@A@@@CF@@E@C@C@A@SCC@AA@AA@@A@A@C@A@A@AAAAEAAAACCA@A@@@A@AAGECECAECSOCDAAA@"],encodeOffsets:[[122187,29792]]}},{type:"Feature",id:"330782",properties:{name:"义乌市",cp:[120.075106,29.306775],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@B@@A@@@@@CAA@A@@@@A@A@@@AA@@ABA@@@@@A@@A@A@@A@@@@@AB@@@@A@BAB@@A@@AA@@A@@@CBA@@@@AA@BA@@AA@@@@@@AB@@A@@@AA@@A@A@@@AAA@@B@@A@ABAAA@AAA@A@@@AAA@A@AAA@AAAA@@AC@@@CA@@A@@A@ABA@A@@B@@BB@@@B@@@AA@@A@@@AAB@@A@AAA@@@A@@@AB@@@BBB@@@BD@@@@BB@B@@B@@@B@@@B@B@@@@AD@@B@BB@BB@@BB@BBB@@B@@B@@@B@@@B@@@BB@@BBB@@B@@@@@B@@@@@@@@BB@@@@A@@@@@@@A@@AA@@@A@@@@@@BBB@B@@A@@@@@@@A@@B@B@@AA@@@B@B@@@@BB@@@@@D@B@@A@@@@@BB@BAB@BA@AB@@AA@@A@A@@@@AA@AA@@@A@@AB@@@@@B@@@B@@@B@@@@D@DBBBB@B@B@@BB@B@D@BAB@B@BB@@BA@@B@B@@@A@@B@@@B@@@D@D@@C@@A@C@@AA@FCF@@A@@@@@@ABA@@@A@B@@AB@@AB@@@@A@@@@@@A@@@@@@@B@@@BAA@@A@A@@@@AA@@@BDA@@@@@@@B@B@BB@@@@@@@AB@@B@AD@@@@@B@@@B@@@@D@@A@@@@BBBB@B@@@@BBBCB@@@@B@B@@@BBB@@B@@BB@B@@@D@@B@B@@B@@@@@BAB@@@B@@@@@@@@@AB@@AB@@@B@B@@@@@@BA@@@@@@BAB@BAD@DA@@B@@@BB@D@B@@@@@@A@@B@@@B@B@@@B@@@@@B@D@@@ABB@@@@@@@B@@@@@@BB@@@B@BA@C@@@CBA@@BA@B@@BB@@@AB@@BB@@@B@@@A@A@@@@@@BBB@@@BA@ABB@@@B@@@@@@B@@@B@@B@@BB@@@@@@@@@@AB@@@@A@@@A@@B@@@@B@@@@BB@@@@AB@A@B@@AA@B@@@BBB@@@@@AB@@@BA@@B@@@AB@@A@@B@@@B@B@@@B@@@@@@@B@@@B@@@@@B@@@B@@@B@@@BB@@@B@@@BB@B@@ABBD@@@B@@@@@B@@BBBB@@@@@@@B@@@@@@A@BB@@@@BB@@@B@@@@@B@@@BBB@@AB@@@BA@CB@@AA@A@@BA@@@@@@@@A@AA@@A@@@A@@B@@AB@B@@@@@BA@A@ABB@@@BBB@@@A@@BBBA@@BB@@B@@A@@B@B@@@B@@@D@@@@@@A@@@B@@BAB@@@@A@@@BBB@@B@@@@@B@@F@B@@@AB@@@D@@@@@@BBB@@@@BAB@@@@A@A@ABABA@CDABEDC@CB@@@@@B@@@@A@@@A@@@@B@BB@@@B@@@@B@@@@CB@@@B@BA@A@@@@@AA@@CB@@@B@B@@@B@@@@@@B@@@@B@@@@@B@@B@@B@@@@@BB@@@@B@@@@@@@B@@@@@@@@@B@@@@@@@@@B@@@B@B@@B@@A@@B@@@@@@@@B@A@BAA@B@B@@@@@@@@A@@@@B@@@@@@@@@@@B@@AAA@A@@A@@@A@@AA@@A@@@@@@@@@@A@@A@@BA@A@@@B@@@AB@@@BA@@@@@A@@@@@A@@@@@@A@BAB@@@@@@@B@@A@@@@@A@@@@@@@A@@B@@@@BB@@@@@B@@@BA@@@BB@@@@@B@@@BB@@ABBA@@D@@@@@@B@ABB@@@@BB@@@@B@@@@@@AB@@@@@@@B@@@BBA@@@BA@@@AB@@@@@@@@@@@BA@@@AA@B@@@@@@@BA@@@B@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@B@@@@@A@@BA@@@B@@B@@B@@A@@@BAB@B@@@BABB@@BB@@@@@@@@@@@@A@@@@BABF@B@B@BAD@B@BBB@BB@@@@@B@BB@B@@A@@@@@@@@@@@BB@@A@@@@B@@A@@@A@BB@B@@@BA@A@@B@@@BBB@BBB@BBB@@@@@@A@@A@B@@A@@@A@@B@@@@@B@@A@@@@@@@A@@A@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@B@@@@A@@@@@@B@@AA@@AB@@@A@@@@A@@@ABB@@@AD@@@B@B@@A@BB@@@@ABA@@B@@A@@@@@@@@B@@@@@@AB@@A@@@@@@@AB@@@B@@A@@@@B@@@@@@@@CB@@@@@AA@@@@@@A@@A@@@@@A@@@@@@@A@@@@@@B@@@@@@@B@@AB@@@D@@CB@@@BB@@@AB@@B@@@AB@@BB@@A@A@@@@B@@AA@@@@@B@@@@@@AA@@@B@@@B@@A@@@@@A@@AA@@@@@B@@A@@A@@@@@@@A@@B@AA@B@@AAB@@@@@@@@@@A@@B@@@@@AB@@@A@@@@@A@@@@@@@A@@@A@@A@@@@@@A@@@@@@BAB@@@@ABAB@@@BAB@@@@@B@@A@A@@@CB@@@@@AA@@@A@A@@@@B@@B@@@@B@@@@@@CB@A@@@@A@@@@@@@A@@@A@@@@@@@BB@@@@A@@BA@A@@B@B@@@@A@@BA@@@A@@BA@A@@@@@AB@@A@@DA@ABABA@@@@@A@@@AA@@@BAB@@B@A@@BB@@@@BA@@B@AAB@AA@@BA@@@AB@@@BA@@B@@@BAD@@@@AA@@A@@@CBC@@BA@A@@@ABA@@BAB@@A@A@@@A@@@AB@@@@@B@@B@@B@@@BAB@@@BB@@@@BA@@@@B@@B@BB@@A@@@CB@@AB@@@@CD@@BBB@@B@@@BB@B@@BB@ABBB@@@B@@D@@@B@@B@B@@BAB@B@@@@@BB@@BB@@@B@BB@BB@@@@DB@@@@@@D@@@@B@@@@B@B@@@B@@@B@@@A@@@@A@@BA@@@A@A@@@@B@@@@@BB@@DB@@@@BBB@@@B@@B@@@@@B@@@@AB@BBBB@BB@@@@@BB@@@BBB@@@@B@@@@@@@B@@@B@@@@B@BB@@@@B@@AB@@@B@@@@AB@@@@BB@@@B@@@@@BBB@@@@@BB@B@@@@@@@B@@@@@@D@B@@ABABB@@@@B@@@A@BDBAB@@@@@B@B@@@B@@@B@@@AB@B@@@@BB@@@@@@D@@@B@@B@B@B@B@@@B@@@@A@@@A@@@@BA@@@A@@BB@@@B@@B@@@BB@@@B@DA@@@B@@@DBB@@DDB@@@@@B@@A@AB@@@BBB@B@B@@@B@BB@@B@@@@B@BB@@B@BBB@BB@BBB@@ABA@B@@B@@@@B@@B@@@B@@@B@@@BA@@BB@@@B@@@@@@@@CB@BA@@BA@@@@@B@BA@@BA@@@@B@@@BA@@@@B@@@@B@@B@@@@A@@A@@@@AB@@@A@@A@A@@@@A@ABA@@@A@@@AAD@B@@@AA@@@A@@B@B@BA@@@@B@B@@@B@@@@BB@@@B@@@@A@AD@@@B@B@@AAA@AA@DA@@@@@@A@@@@@A@@AB@@@BBBA@@@@BB@@B@BA@@@@@AA@@A@A@@@A@@@@B@B@@B@@@B@@@B@B@@B@B@@@A@@@@@B@@@B@@@B@@@@@A@@B@@B@@BA@@BA@AB@@AB@B@@B@BAB@B@@@@@AB@@@@@BBB@@BA@@@B@B@AB@B@@@B@@BB@@@B@@@@@@BA@@@@@A@@@@@A@@B@@@@@B@@A@B@@@@B@@@@A@B@@B@@@AB@@@@@@A@AB@@@B@@@B@BB@@C@@@B@@@@B@@C@ABB@BB@@@@A@AAA@@@@B@@D@@@@@A@@@@BB@@@B@@@@@@@@@@BA@@@@@@@BB@@@@@B@@@B@B@@A@@@@@AB@BA@@@B@@@B@@ABA@@BA@@@A@@B@@B@@@@@@@@A@@@@@@B@@B@@@@@B@B@@@B@@@@@@@@@@@B@@@@@@@B@@BB@@@@@@@B@@A@@@B@@B@@@@AB@B@@AB@BAB@@@@@AA@@BAB@@@BA@A@@B@@@@BA@AB@B@@@@AD@@AB@@@@B@@@BC@AB@@@@@@@@@@A@@@@BBBB@@@@@@AA@ABB@@AA@@@AB@@@B@@@A@DC@@@A@@DB@@@@B@@AAABA@@BA@@@@@@@AB@@B@@@A@@B@@@@@B@@@@@@B@@AB@@@@@@BAB@@@AB@@BA@@ABCB@@@@@@@@A@@B@@AA@@@B@B@@BA@@@@B@@AB@D@@A@@B@@B@@@@@@@@@A@@B@@@BA@@B@@B@B@@@B@@@B@@B@AAB@@AA@B@AA@A@@@@A@A@A@@@@@AA@@A@BA@@B@BAB@@@A@A@@@@@A@@B@@@A@A@@BA@@@@@@@A@@B@@@B@@@@@@@@@@@@B@@@B@@BA@@@@@@@@@B@@@@@AB@@@@@B@@@@@@A@@B@B@BA@@@@BB@@BAB@BA@@@@A@@AA@@A@@@AB@AAAABA@A@AA@@A@@@@@@B@B@@@B@@AB@@@B@@BDDB@@B@B@@@BB@@B@@AB@B@B@@ABB@@@@@@B@@B@B@@@A@@B@@@B@@@@B@@@B@BBB@@@@@@@@B@@@@@BB@B@B@BA@BB@B@B@@@@BBB@B@@@BB@@@@@D@@B@B@B@@BBB@B@@@@B@@@@A@@B@BBB@@@@B@B@@@@@BBBBBBB@@@BB@@B@BBBBBB@@@BB@@@BB@A@@@B@@@@@@@B@@BBB@@@A@@B@B@@@@B@BBB@B@B@@B@@@B@@BBBB@@@@@BDB@@DD@@B@BBBB@B@@@B@BBB@@BBB@@BB@BBB@BB@@BABB@@B@@BB@@A@@@@B@@@@B@@B@@ABB@@@AB@BA@@B@@B@@@@@@A@@BA@A@@@AB@BBB@@@@CBB@BB@B@B@BB@@@@@BBBBBB@@DB@B@@@@@@B@BB@@A@B@@@A@@B@@B@@@@@@@B@@@@BAB@@@@DABBB@B@@@B@@@@@@@@AB@@@@BB@@B@@@@A@@@@@@BBB@@@@@A@@B@B@@@B@AAB@@C@@@@BB@@@@B@@@B@B@@@@B@B@@B@BB@@@@@B@B@@B@@@@@@BBBB@B@B@@@BB@B@@@B@B@B@B@@@@BB@@BB@@@B@@B@B@@@@@@BBB@B@@BB@@BB@@A@AB@@@@A@@@@A@@@@@B@@@@B@@@B@@@B@BA@@@BA@CB@@@B@@@B@B@@@B@@BB@@@@BB@@@@@B@@A@@@@@B@@@BBA@B@@@@@@@@@@@@BA@@B@@@@@BB@B@B@@@@B@@@@@@@BB@@BB@@B@@@@B@BD@@BB@@@D@@AB@@@BB@D@@B@@B@DBAB@B@@A@@B@@@D@@@DA@@B@@@@B@B@@@BA@@@@@AA@BA@@@ABB@@@@@B@@BB@B@@@@DBB@@@B@@@B@B@B@B@B@B@@@@@BBB@@@B@@@@@@@@@A@@@@@A@@@A@@@@@@@B@B@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@BB@@@@@@@B@@@@B@@B@@B@@B@A@BB@@@B@BA@@BD@@@@BAB@BABB@@@@@B@@@@@BBC@@@@B@@@@@@B@@A@@BB@@@@BB@@@@AB@B@@@BA@@@@BBBB@@@B@@A@@@@BA@@BA@@B@@BA@@B@@@@B@@@@@@BA@@B@@AB@@@@BB@@BB@@C@BBB@@@ABB@@@@B@@A@@BABD@@@B@D@@A@@@@A@A@@@@A@@@@@@@@BB@AB@@@AA@@@@A@@@@@@@@@B@@@@BBB@@@BBB@@@@B@@A@@BB@BBB@BB@AB@B@@BB@B@@@@@AB@B@@@@BB@@@B@@BBBB@@B@@@@@B@@AB@BBB@@@BAD@@@BA@@BB@@BB@B@@@B@@AA@@@BB@@@@B@@@BA@@@DB@@@@@@AA@@BC@@@@@@B@@B@@B@BB@@@B@@@B@@@BB@@@@B@@@@B@D@@@B@@A@@BA@@B@BA@@B@B@@@@@@@B@@@@@@B@@BB@@@BBBBB@@@@B@@@@@B@@@@@@@@@@B@@@@@@BAB@B@@@@@@B@@@@@@BBAB@@@@@B@BD@B@@@@@@B@@@@A@@@ABB@@@@@@@@@@B@@@@@@B@@@@B@B@@@B@@@@AB@@@@@@BABCAAB@AABA@@@@@BB@@@D@@@B@@B@B@@@@@BA@@B@B@@@B@@@B@@@BBB@B@@BB@@@BB@@B@@BBBDBBBB@@@@@B@@@B@B@@@BA@@B@@B@@BABBB@@B@@@DD@@@B@@DB@@B@B@@@BBB@@@B@@A@@A@@AAA@@B@B@B@BCDBD@BADBBABB@@BA@@B@BABB@ABB@@@@@A@@B@@BB@@@@AA@CCBA@@@@AAA@AA@@@@@@D@@@BCBA@@A@@@AC@@@@AA@@@AA@@@@@@A@@@@@@@CBC@A@A@@A@@@@@A@@A@@@AAA@@BA@@@@@A@@@A@A@A@@@@@CB@B@BB@@@@F@B@@@BBB@@@BAD@@@BAB@@@BA@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@A@@@@@@@@@A@@@@@@@A@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@BA@@@@@@@@B@@@@@@@@@@@@@@@@@B@@A@@@@@@B@@@@@@@B@@@@A@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@AB@@@@@@@@B@@B@@@@@@@B@@@@@@@@B@@B@@@@@@@@@B@@@@@@@@A@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@B@@@@@@@@A@@@@B@@@@@AA@@BA@@B@B@A@@AA@BAB@@A@AB@@@BAB@@@@@A@@@BA@@A@@A@@@A@@AA@@AA@A@@B@@@B@@A@@B@@@@A@@@@BA@A@@@@@A@@B@@@B@@BBB@@BA@@@@@B@@AB@@B@B@B@@@B@@A@@D@@@@@B@B@@@BA@@B@@BB@@@BA@@@B@@AB@B@@@BB@B@B@@@A@@B@@@B@@@@@@@@BA@@@@BA@@BA@ABAB@@@@@B@B@@A@@@@A@@@@@@@DA@@@A@@B@B@@@@B@@@@A@AB@@AB@BAB@@@@@@@@@@A@@B@@@@@@B@@@@BB@@@@ABA@@@@@A@A@@@@BAB@@@@@@@A@@@AB@@@@BBB@@@DAD@@A@@A@A@@A@@@@@@@@@@D@@@@@AA@@B@BAB@BAACACD@@AD@BAB@AA@@A@AAA@A@A@CBA@AA@@@A@@CC@@A@ABA@A@C@@@@@A@AC@@@A@A@@@@AAA@@A@@AAA@@A@AA@@@A@@@@A@@@@BA@A@@@@@A@@@@@A@@@A@@@A@AB@@@BA@ABA@@AA@@A@@@@@@A@@A@@@@A@@@A@@BBB@B@B@B@B@@@AAB@B@B@@@@A@@DCBABAAA@@@A@@@C@@B@BBB@@@@A@A@AA@@A@@@@B@D@@@@@@A@A@A@@B@BA@@@@A@A@FA@@@@DB@B@@@@@C@@@@A@@A@@AA@@@A@A@@B@@@@A@@CAAA@A@@@@@C@@@A@@@A@@@ABA@@A@@@@ABA@@@@@@@@@A@A@@AAB@@AA@@A@ABA@@B@@A@@@@A@A@@C@@BA@@A@A@CA@A@CA@A@@@A@@A@@A@@AAAAA@@@A@@@A@@AA@@AA@@CCA@@@AAA@@@@@AAABA@A@@@A@ABA@ABABA@@@C@@@AAA@@@A@@@@A@AA@@@@AA@@AB@@ABABA@@BA@@@@@A@@AA@@@A@AAA@AA@@A@@@A@A@A@@@AAA@@@@AA@A@@AA@@@A@@AA@A@AA@AA@A@@A@@@AA@@@A@@@A@A@@@@@A@@@AAA@@AAA@@@AB@@AA@@@@@@AA@A@A@@@@B@@A@AB@@A@@@ADA@@AA@C@A@@CA@A@A@A@@@@DA@@AA@A@@AA@@@@@A@A@@@ACAA@@@BAB@A@@AA@@@@A@@@@B@@AA@AB@A@AA@@@@@@@A@@@@@@B@@A@@@AA@AAAA@AA@@A@@@@@B@@A@@@@@A@AA@@@@@@@@A@@@@A@@AABA@@@@@B@@@BAA@@@@A@A@A@@CA@@A@BB@@BB@@@@@BA@CAA@@@A@BA@@A@A@@@A@@@BA@@@@@@C@A@@@AA@B@@CB@A@@@@A@@@@@B@@@@@A@@AAB@@@@@@BCA@@@@@@@@@@@@A@@A@@@@@@A@A@C@A@A@@A@@C@@@@@AC@BA@@A@A@@AC@@@@@@@@@AB@B@@@AACAA@@@AACB@@A@@@@B@DA@@@AAA@@AA@@A@ABC@C@CBA@ABC@G@G@@@A@A@C@A@ABCBA@@@CFAA@@@A@@@@@@@ACB@@@@@A@@@A@@@@@A@@B@@@@A@@@@A@@@@@A@@@@@@@AA@B@@@A@@AA@@AA@B@@B@@BA@@@@@@@AA@AB@@@@@A@A@@BAB@@@A@@@@AB@@@@A@@@@BA@@@@@@@@AAB@@@@@A@A@@A@@@@@@A@@@@AA@@@@BBBA@@@B@@@@@@@AB@@AB@@@A@@@@A@AB@@A@@@@@@@@@AB@@@@@@@DA@A@@@AAB@@@@A@BA@A@@@@@@B@A@@@@A@@@A@@@@@@AB@BAA@B@AB@@A@@@A@@A@@@@@@@@AA@@@@@@@@A@@@@B@@@@@A@BA@@@@AAB@@AA@@BA@@A@@@A@@ABA@@@@AA@@@@AA@AADABAB@@@BABAB@B@@@@A@@BABA@@AAA@AA@@@@BAB@@A@@A@@AC@@A@@@AB@BA@A@@@@AA@@@@AA@@@@BA@@@AA@@@BAB@BA@@D@B@B@@A@@@@@A@@AA@@B@@@@@BA@@B@@@BA@@@A@AB@AA@A@ABAA@A@A@@@@@A@@A@AAA@@A@A@C@@@@A@@AA@A@@@@@AAA@@A@A@A@@@@@@C@A@@@@C@@@AB@@@@@BA@AB@@AA@@A@@@A@@B@D@@A@A@@@@@A@AA@@@@A@@A@@@@C@@AA@A@@@@@@@AB@AA@@@AB@@@@@@@A@A@ABABAB@BA@@BA@@@@A@@A@@C@@@@@AA@AA@@@A@A@@@AAAC@@A@@@@C@AAAA@AC@@EA@@@AAA@@DAB@@@AA@@@A@@@A@@@@@@@@A@C@@@@@AABAA@@A@@CA@A@AAA@@AA@@AACAAAA@A@@@AA@BA@@A@@@AAA@@AA@@AA@@@@AA@@@AA@@@B@B@B@@@@@@@@A@A@A@@@@B@@@@@@@@@B@@@@@@AC@@@@@@A@@@@@@@A@@@@@BA@@@C@@@@@@@BAB@@@@A@@C@@@AA@@AA@@@@@CAA@@@A@@BAB@@A@@@@@@AAA@@@A@@@AA@@@@@@AB@@A@AAAB@@@AA@@CAABA@A@A@A@A@A@A@@@AA@@AB@@A@@@@@A@@A@A@AA@@@A@@@@B@@A@@B@@A@@@@@A@@@@@A@@@@@@A@@@@@@@@@@@@@@AA@@@@@B@@@@@@@@A@@@AA@@@A@@@@@@@A@@@@ABA@AA@A@@A@@A@AAB@@@A@@A@@@@A@@A@@@@@@B@A@@@@A@@A@@@@@A@AA@@@@D@B@@@@@@@@@B@@@@@BA@@@A@@@@@@@@@@@@BAAA@@@A@@@A@@AAAA@@B@@@@@@@B@@A@@@@@@@@@A@@@@@A@@@@@@@A@@A@@@@@@@@A@@B@@@BBB@A@@A@@@AA@A@@AB@@@B@@@B@@A@@@@BABA@AB@@@BABC@AA@@A@@@A@C@@B@@@B@@A@A@@@@AC@A@A@@BA@AA@@A@@@A@@@@CAAAC@AA@A@A@@AAAA@AAADG@@@@B@@A@A@AB@@A@@A@@@A@@@@@A@@@C@@@@BB@C@@@@@@@@B@@@@@@A@@@@@A@BA@@@@A@C@@@CBA@ADAB@@A@AA@@@AB@BA@@AA@AAAA@@@B@@@B@B@@A@A@AA@@@@A@@AA@@@@@@AAA@@@@ABA@@A@@@@A@@B@@@@ABAA@A@@B@@@@C@@@BA@@A@AA@A@A@@AAA@A@@@AA@@@@@@B@@@BA@A@A@@A@@@AA@@A@@@@B@@A@@@@@@@AC@@@@A@@@@A@@@A@@@@@AB@@AB@@@BAB@B@@@@@BA@@BAAA@@@@@@@@AB@@A@@BAA@@@@A@@AAA@@A@@@B@@@@BA@@BC@A@A@A@@@A@A@AAA@@@AA@@A@@@@@AB@@@@AA@@@@AA@@@@A@A@@@AAAAA@@@A@A@A@@@@ABA@@AA@@ABA@AAA@ABA@A@AB@@AB@@A@@@@@ABA@@@@@@@AAAA@@AA@@@AC@@@A@CA@@@A@@AA@@@A@AA@@@@AA@A@A@A@ABC@@@A@A@@@A@@@CBA@@@@AA@@AA@@AA@A@AAA@@AA@AA@@A@@@A@A@@@A@@A@AAA@A@@@A@@@@@A@@@CAA@@@@@@@AA@AA@@A@@@@A@@AA@A@A@@A@@A@@AAA@A@@A@@A@@@A@@B@@A@A@@@AB@BB@@@@@@B@@@BB@B@AB@@@@A@@@A@@B@@C@@@AB@BA@@@@B@@A@@@A@@@@@A@@BBB@@@B@BABA@@BA@@@ABABB@AB@@@BA@ABA@@@@@C@@BA@@B@@A@A@@@AAA@A@@@AB@@AB@@A@A@@@@B@@@@@@AB@@@@AB@@@B@B@@A@@BA@A@AB@BAB@@A@A@"],encodeOffsets:[[122821,29764]]}},{type:"Feature",id:"330783",properties:{name:"东阳市",cp:[120.241566,29.289648],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@A@@@A@@@A@A@A@AA@AA@@B@@@B@@@BA@@@@A@@@@@@A@@@BB@@A@@BA@@@@@@@@@ADCAA@@B@AA@@@@@AA@@@BA@@@A@@@@B@@@@A@@@A@@@@@A@@BA@@@BB@@A@@@A@@@@@@@A@@@@@A@@@A@@@@@A@AB@@@@@BA@AB@@@@A@@@ABB@A@@@A@@@@@@B@@@@@@A@@B@@@B@@@@@BBD@@@B@@@@@@@@A@@B@@@@@@B@@B@@@@B@@B@@@@@@AB@@B@@BA@@@@@@@@B@@@@A@@B@@AB@@@@@BB@@@BB@@BB@@@@@B@@@@A@@B@@@@@@@BA@@@@@AA@@@AAB@@@@BB@@A@@@AA@@@BB@ABBB@BABA@@@@B@@@@AB@A@@@@AB@@C@A@@@A@A@@@@@@@@@@B@@@@AB@@A@A@@@@@A@@B@BB@A@A@@@@@A@@@A@@@@B@@@@@B@@A@A@A@@@A@AAAA@@@@A@@B@@A@AAC@@@@B@@A@AA@@A@@@@BA@@@A@BA@@@@A@A@@BA@@AA@A@@@CA@@A@@@@@AB@@@@A@@@AB@BC@A@@@AB@@A@@AA@@@AAA@A@A@ABA@@@A@@@@BA@@@@A@@@A@@A@A@@@@@A@AA@@AD@@@@A@@@@@A@@A@@A@@BA@@@@BA@@BA@@@@@@@@@A@@@AB@@@@AB@@@@@@@@@@@B@@@@BB@@BB@@@BA@A@@@C@@@@B@@@@@BA@@@@@A@@@A@@@@B@B@@A@@@A@@AA@A@@A@@A@C@@AA@A@AA@B@@@@BB@@BB@@B@@BBB@@@@BB@@@BBAB@B@@B@@@B@@@BBB@@@@@@@@B@AB@@@@B@@ABB@@@A@A@@@@@@BB@BBB@@BA@@@B@@@BA@@@@BB@@@B@@BD@BB@@DBB@@@B@@D@@A@@@@@A@@@@@@B@@@B@@A@A@@A@@@A@@CB@@AB@@@ABAB@@AB@@@@@A@@@@@ABA@@@CB@@FC@@BA@@A@@@AB@@@@@@A@BA@ACAA@@@@@@@@@@@@A@@A@@@A@EB@@AB@BABAB@A@@A@@@A@@A@@@@A@A@@@ABA@@@A@A@@@@DB@@@ABA@A@ABAAA@@@A@@A@@@BA@@@AB@@@@A@@@A@C@A@@@AA@AA@@A@@A@@@A@CA@AAA@@BAA@@@@BCB@@AA@@@@@@AB@B@@@@BBB@@BDBBDBB@B@B@@@@@@@@AA@@@A@AAACCAA@@A@@@AA@@A@AA@@@@CB@@@@@@AB@@ADB@@@@BB@@@AB@B@@C@AB@@@B@BADBBA@@@@@A@A@@AA@@AA@A@A@A@@AA@@@AB@@@@@A@@@@@B@@AA@@AA@@@@@@@B@@@@@B@@@@@@@BB@@B@@A@@B@@A@@@@@@@@A@@A@@AAABAB@@@AAA@B@BCAC@A@@@A@@AAAAAAA@@@AA@@A@C@ABA@ABA@AB@B@@CA@@A@@B@@A@@BA@@@A@@@A@@@A@@@AA@@A@@@@BAB@@@@@@@B@@@B@@AB@@@@AA@@A@@AA@A@@@AB@@@BB@D@B@@@@@ABB@@@@@B@@@@@@@@A@@@@@@D@A@@A@@D@@@B@@@@@B@@@B@@@@BA@@B@B@BA@@@@@CHBB@BBBBB@@@B@BBBD@BBDB@@@@@B@@@BB@@BAB@@@B@BBD@@@@@B@BA@@@A@@@@D@B@@@BB@@BADAB@@A@@BABAB@@@@@BA@@@A@@@A@@BB@B@@B@@@BB@A@AA@@A@@@@B@@@@@@B@@@@B@@@@@@@B@@@@@B@@@@@@@@@BA@@@@@@@A@@@BBBB@@@B@@@B@@BBAB@@@@@@@@@@@@@B@@AB@@@@A@@@@@@@@@A@C@@@@@BBB@@@@@B@@@@B@@B@A@@@@@@@@BB@@@@@@BB@@@A@BBB@@@@BB@B@@BAB@B@@B@@@@@@@B@@@B@@B@@@B@@@@@@A@@@@@B@@B@@@@@@@@@@@@B@@@@@@@@B@@@@@B@@@@@BA@@@@BA@@@@@@B@@BBB@B@@@@B@@@@@BA@@BB@@B@@@B@B@B@B@B@BABBB@DB@@B@@BABBB@@@BA@@@@@@BB@@B@@@B@BB@@@@@@@BA@AB@@@B@@BB@D@@@@BB@@BB@@D@@@@B@@A@AB@@@@@@D@@@B@@A@@@@@B@@@@@@@B@@@@D@@B@@@@A@@@@@@@@@A@@@@@@B@B@B@@@@@@A@A@A@@@B@@B@@BB@@@@BB@@BB@@BB@B@@@BB@@ABB@@B@B@BBDBBB@@BB@@BB@B@BDB@@@BB@ABBB@@@@D@B@@@@@@@@@@B@@@B@@BB@@A@CB@@BB@B@@FB@@BDB@BB@B@D@@B@@@BDBB@@B@B@@@B@@BBB@@@@D@@@@BB@@@@@AB@@ABA@ABAB@B@B@B@@@@@@BA@@B@@BBA@@@@@@B@B@@BD@@@@@@BB@@@@@BBB@@@@@B@B@@@@C@AB@@@B@@@BB@@BAB@@A@@@@BA@@D@@@@@@B@D@@@@B@B@B@@@BB@B@@@@@BBB@@@B@@D@B@B@@@BB@B@BB@@@@@B@B@B@AB@B@BBBA@@B@B@@AB@@A@@@AB@@@@A@@@BB@@@B@@@@@BA@A@C@@@ABA@AB@@B@@B@@AB@@@@BB@@@@BB@@@@@BABA@@B@@@BD@@BB@@@@BA@AB@@@@BBB@BB@@ABAB@@@B@@A@A@ABAB@@A@ABCBBBB@@B@@B@@B@@B@BA@@@B@@@BB@@AB@@BA@BB@@@@ABB@@@@@A@@@@@@B@@@@@@B@@B@@@@@@B@@@@B@@@BA@@B@ABB@ABA@@@@@@@B@@@B@@B@A@@@@@@@@BABB@@@@@BA@B@@@BCB@@@@@@A@@B@@@@@@@@@BA@@B@B@@B@@@A@@BA@@B@@@@@@@A@@ABAA@@@@BB@@@@@B@@@@B@@@@B@B@@@@BA@B@@@@@@B@@A@@B@@@@@BA@@@@@B@@BA@AB@B@@@@@A@@BBB@@@@@@B@@AA@@@@ABB@@BB@@@B@@@ABB@@@@@@B@@@@@B@@@@@@B@@A@@@@B@@@@@B@@@B@@@@DA@B@@@@@@@B@@BBDE@@B@DABAB@D@B@B@@@H@H@D@BAB@DAD@D@BAB@@@BB@@BB@B@@CBA@@@@@@BA@BD@B@@BBBD@B@@@ABA@@@@@@@@D@@BB@B@@@ABD@@B@@@@@DB@@@@B@B@D@B@B@@@@B@@@@B@@@@@@@@@@B@AD@@@@@@BA@BB@@@@@A@@@@@B@@@@@@BDA@@@ABB@@B@D@@@@@@@AB@@B@@@B@B@@@ABB@@@B@DBB@@A@@@@AA@@AAB@@@DB@@@B@B@B@@B@AB@@A@@@@@B@BA@BB@@@@@@B@@@@@@B@@B@B@@@@@BA@@@@@B@@@BBB@BB@BBB@@B@@@@A@@@@B@@@@@@@B@@B@BBAB@@BA@@@@@@B@@B@@BB@A@AB@@B@DB@B@@@B@B@@@@BB@@@BBB@@CB@@@@@B@B@BDB@@@B@DBB@@CB@B@@@BA@@B@BA@@@@@@B@BBB@@@@@@BB@@BA@@B@BB@@BB@B@@@B@@@@@B@B@@@B@@BB@@B@@@@BBBB@@B@BBB@@@B@@BB@@@BBB@@@@BB@B@@@B@B@B@@@BB@@BBB@B@B@@BB@@@B@@@@AB@@ABAB@BA@@BB@@B@@B@@B@B@@B@@@B@BB@@D@@@B@BABAB@BAB@@@B@B@BAA@@CAAB@@A@@@AA@@C@@BC@@@A@@@AB@@ABA@@B@@@BEBA@@AABA@@A@@C@A@@BA@@@@DA@ADB@A@@BB@@@A@@@A@@@@@@A@@@A@@@BA@A@@@@B@B@AA@A@@A@@A@@B@@B@@B@BA@A@@@@D@@@BA@@@@@A@@@@@@B@BA@@@@@@@A@@@A@@B@@@B@@@@@@@@@D@B@@@BADAB@BA@@@@@ABA@A@@BA@AB@B@BBB@@@@@@@BA@@@@B@@B@@B@B@@@@@B@@@@@B@@B@@B@@@C@@@@B@@@BA@ABA@@BB@@B@@B@@@B@@@B@@B@@@A@@@@@@@@@@@B@@BA@@@@B@@@@BB@@B@BB@@@@@B@@@@A@@BBB@@@@@@BB@@@BA@@@@@@@D@BB@BB@@@@BB@@AB@@BB@B@DBBBB@@@BB@DD@@@@B@BBB@@@@@BA@@@@B@BB@@B@@@@BB@@@@@BAB@@@@B@B@@BB@B@BBB@@@BBB@BB@@@@B@@AD@@B@D@@@BB@B@@@@@B@B@@@B@@@D@BBB@B@DDBB@B@@B@DAB@@AAA@@@@B@@@@B@BB@@B@B@B@@@@BA@A@@@A@@@@@@B@@B@@@@@B@@@@@B@B@@@@A@@@@B@@@B@@@@@@BB@@@@AB@@@BB@@B@@@B@@@B@@@B@B@@@B@@BD@FB@@@B@@BBB@B@B@BA@BD@@@B@@@@AB@@@BAB@@B@@BDDA@@B@@B@@@B@B@BB@BDAB@@@@@B@BA@@B@@@B@@@B@@@@AB@@A@@B@BEB@B@@CB@@@B@@@BBB@@ABB@ABB@@@@BB@@@@BB@BB@@@A@@@BBBB@@@@B@@A@@B@@ABBBB@BAB@@@BDAB@@@@@@@@BB@AB@@ABBB@@@B@@@@@AA@A@@BA@@@BB@@@@B@@@@AB@@BBB@@@@@AA@@@@BAB@@ABB@@BB@@@@A@BB@@ABA@@@@@@BB@@@AB@@B@@@ABBBB@@@B@@BB@BB@@@BB@B@BABB@AB@@@DAB@D@B@@@BBD@@@BA@@BABA@@@@DA@@BB@@DAB@@@B@@@DABA@@@@B@BB@@@@@BBD@@@BBDB@@B@@@@BBBB@B@@B@@BBBABB@@@@B@BBB@@BBBB@BBBBDB@@B@@BB@@BB@@@DAB@@B@BB@@B@D@@@D@@@D@@@DAD@BBBBB@B@B@BB@@B@B@@@B@DDBAD@@BD@B@@@B@@ADBBBFD@@@DBB@@@B@@B@B@D@D@@B@@B@@B@B@B@B@B@@@@@B@A@AB@BAB@@@B@@AB@DABA@AB@@@B@DB@A@@A@@@@EB@@A@@@@BA@@DBB@@@BB@@B@@@B@@@B@@B@@BAA@@@CCB@@A@A@@@AB@@@@@@@@A@AB@BB@@@@B@@@B@@@@A@ABABA@ABAB@@@@ABA@AAAAA@C@@@AA@AA@@@@B@@@D@BBB@B@BB@@BBB@@@B@@B@AB@@AB@BBB@@@B@@@D@@@B@B@@@@@BA@@@AB@@A@@@@BAB@@@@@BA@@@@A@@A@@@@B@@@B@@A@@@@@@B@@AA@@@B@@A@@@A@@@AB@@@@@B@@@@@@A@@@AB@@@@@@@@A@@@@A@@@@@@@AB@@@A@@@@@@@A@@A@@@@@@@B@A@@@@@@AB@@@@@A@A@@@@A@AA@C@A@@@@@AA@A@@@A@@@@B@@@@CBA@@@@A@@@@@CAAA@@BABA@@D@@@A@AAA@@B@@@AA@@@@A@@@@@A@@@A@CA@A@BA@@@C@@@@BA@@@A@CBA@A@@@@ACA@@@BA@@@AB@@@B@DBB@B@@@AAB@@A@@A@@A@@@@@@@A@@AA@@A@@@@@A@@AA@@A@@@A@@B@@A@@BA@@BA@@A@BABAA@B@BAA@@@A@@A@@@A@@@@@@@@B@@@@@@@@@B@@AB@A@@@@@@A@@@@A@A@@@@AB@@@@@@@@@AAB@@@@@@AB@@@B@@@@@B@@B@@BAA@@@BAB@BA@@A@BA@B@AB@B@@@BB@@@AB@@@@A@@@@@@B@@ABA@@@@@@@B@@@@@B@B@@A@@@@@B@BB@AB@@AA@@@DA@@@@@A@A@@B@@AB@BAB@@A@@@A@@@@BAB@B@@@B@@@@@@@A@AA@@B@B@@@@@@@A@@@AC@@B@HBBABBBA@BB@@@BDB@B@@@@@@AD@@A@A@@@@B@@A@@@B@@@@@@@B@@B@@B@@@@@A@A@@@AAA@@@@B@B@@@A@@A@@@AB@@AB@@@BA@@BB@@BB@@@@B@@@@@AA@@AA@@@@@@A@@A@@@A@@BBBBB@@B@@@@@@@AB@AABA@A@A@@@@@@@BABA@@B@@@AB@@A@@@A@@@@@@@@@A@@@@@@A@@BA@@@BA@@@@BA@@@AA@A@@A@@B@@B@@BA@@A@@@AA@@@@@CA@@@AD@@A@@A@@@A@@@@A@@@@A@@BA@@A@@A@A@A@@@@B@@@AB@@B@@@B@@@@A@@@@@@@@BA@@@CDB@@B@@B@@@@@A@A@@BA@@@@A@@@@@@AB@@@@@@@@@@B@@B@@A@@@@@@@B@@@@@@B@B@@@B@@B@@@@@@B@@@@@A@A@@BB@@@@@B@@A@@B@A@@BB@@B@@@@B@@@@@@@B@@@B@@@BABB@AB@B@@@BA@@@@AACBCA@@AA@@B@@BB@@@@@BAB@@@B@BA@A@@BA@@B@B@@@@@DA@A@A@ACA@@B@AA@AA@AAA@@@@@A@@@@@@@@AAA@@@@@@@AA@@@@A@@@@@AB@@@@A@@@AA@AABAB@@AB@@@BA@@@@@AA@@@@A@@BAB@@@BA@@@B@@@BBA@@@@@@B@@B@@BBBA@@@@@@@B@@@@B@@@B@@@@@@ABB@@@@@BA@A@@@@@@@@@@@A@BB@B@@BB@@@@@@@@@B@@@@@A@@A@@AAA@@@B@@BD@@B@@BBBB@@@@@@BB@@@B@@@@B@@@@@ABBBA@@BA@BB@@@@AB@@B@A@@BB@@@@B@@AA@@A@@@@@AB@@B@@@BB@@@@@B@@ABA@A@A@A@@@@@AB@BAB@@A@@@A@@@@AA@A@@@@BA@@@@B@@@@@BA@BB@@A@@@@B@@@@@@A@@@@B@@@@@@@@@@@B@@@@@@BB@@A@@@@@@@BB@@@B@@@@A@@B@@@BBBB@@@@B@A@@@@B@@BA@@@B@@B@BD@@B@@@@@AA@@B@B@BAB@@@@A@BB@@@BBBAB@@@@B@@@@BA@@@@B@B@@@@@B@@@@A@@@@@@BA@@@@B@@AB@@@B@@@B@BA@@B@@@@@BA@@@@BA@@@@@@@A@@@A@@@@A@AA@@ACBA@@@A@AB@B@DA@BB@B@@@D@@@AB@B@BB@@@@@@B@BB@@B@@B@@@BD@@B@@ABA@@A@@@BAB@@@@@@BB@@BBBBB@@@@@@B@@@@A@@BA@@@@AB@@BBB@B@@@@@C@@B@B@@@BB@@@B@@A@@@B@B@@BB@@D@@@@@@@@AB@BA@@@@@A@@@@@ACA@@@A@@@@B@B@@B@@B@@ABABA@@AA@A@@@@@ABBBA@BBA@@B@@@@@@B@@@@@@BA@@@@@B@@@BB@@@D@@@DAB@@@B@DBB@@BB@@@BB@B@@BD@@@BB@@@B@@B@B@@@@BB@BDABB@@BBB@@@BBB@BBBB@B@@DA@@F@@@B@@@B@BABBB@@@@A@@B@@ABBB@@@@B@@@@@B@@@@@B@@@@@B@@A@AAA@@@A@C@@@@@BDB@B@B@@@D@B@@A@@A@BA@@@A@@@@B@@A@@BB@@@A@@BBA@BB@@@@@A@@@ADAA@@@ABA@@@@@@@@A@@@ADABA@@@@@A@A@AAA@C@@A@@A@@@A@@@@AA@AA@@C@A@@ACA@@@@B@B@BAA@A@A@@@@A@@@@@AA@@BA@@@A@@@@@A@@@AB@@@@@@B@B@@B@BA@A@@@A@@@@@@@@@@B@@@@@@B@@@@@@B@@D@@B@@@@@@A@A@A@A@@@@@@@ABA@@BA@AB@@A@@@BB@@@@AB@AA@@BA@@@@@A@@@A@C@A@@BA@@BBB@@@@CB@BB@AB@@B@@@@@B@BB@@B@@@@B@@@@A@@@AB@@BB@@@BB@B@@@@BB@@AB@@@B@@FAB@BB@BB@@B@B@@@BF@@@BB@BB@@@@@@CB@@@@@DB@@@@AA@@BA@AB@@@BBB@@@@A@AAA@@@@B@@A@@@ABA@@B@@@@@@AA@@@@@A@@BA@@@@@@@@A@@AB@@BA@@@@@@@@@@A@AA@@@A@@@@A@A@@A@AB@@A@@@@@AB@@@@A@@@@AABA@@C@@A@@BA@AA@AA@@AA@@@A@@@@@@AA@AB@@A@@@@@@C@@@A@@B@@A@A@@AA@B@@A@@@@@@AAA@@@@@A@@B@AA@@@@A@@@A@A@A@@A@@@A@BAB@@@B@@A@@B@@@@@@@@@@@@@A@A@@AA@@@@@@A@@@@@@@@A@A@@@B@@ABAAAAA@AC@A@@ADA@@B@@ABAAA@@@@@AA@@A@@A@@@B@@@@@@C@@B@@@@A@@@@A@A@@B@@A@@BA@@@@A@A@AA@@@@A@@@@@A@@CB@@@@A@AA@A@A@AA@@@@BCA@B@@@@@BABBBAAA@@BA@A@@B@BAA@@A@A@@@@@@@AB@@@@A@@@@@A@@BA@@@@@A@@@BA@@@@@A@@@A@@AA@BAAA@@@A@@@@@BB@@@@A@@@A@@@A@@@@A@@@@@AA@@@@DABA@@@AB@@@B@@D@D@@BA@@BAB@B@BC@@@@@A@@@A@@@CB@BA@@B@BB@A@@@@@@A@@A@@@@@BA@@@@B@@A@@A@@@B@@@AC@@AAABA@@@@@AB@@@@@BA@@@@@@@@@@@@B@@@BA@@BA@@@@@A@A@@@AB@@A@@B@@A@A@@@A@@@A@@B@@@AAB@AA@@@@C@@AB@@A@@@A@CB@@@@AA@@@A@AA@@@@A@@@@@@@A@@@@@AA@ABAA@AA@B@@@A@A@@@@@@A@@@@A@@@AA@AAAAAA@@AAB@AA@@@A@@@@A@@@@@A@@A@@@@@@A@@BA@@@@@@@@AA@@AAA@@A@@A@@AA@@@@@@AA@@@A@@@@A@A@@A@@@@@@A@AA@@@@A@@@@AA@@A@BBA@@@@@@@ABAA@@B@@A@@A@@A@@@A@@A@@@A@@AA@@@@@B@B@BB@AB@@@@A@C@@AA@@A@BA@A@@A@@AA@A@@A@@@A@@@A@@@@@@@@AB@@AA@@@BA@AA@@A@@@@@A@@@A@A@@@@B@@A@A@@AA@@@AAA@AB@@AA@@AA@@A@A@@@AA@A@@@@@@@@ABA@@@AAA@@AA@ACA@AD@@@@@@A@BA@@@A@@C@@B@BAB@@A@A@@A@@A@A@@A@@@@BA@@@@@@BA@@@@B@@@AA@AB@BB@AA@A@@@AB@AA@@@@@D@@C@A@A@@AA@@@ABA@@AA@@@C@AA@@AA@A@@A@@A@A@A@@@A@A@@A@AAAAAAA@A@A@@@@A@@AB@@@AA@AA@A@@B@@@@@@A@@B@BA@@@@@AA@CA@@AA@C@@@@@B@@AB@@@@@@@B@@ADA@@A@CC@@AAA@@@@@CBAB@@@@@A@@AB@@AB@@AA@@@@A@A@@A@@B@@A@A@AA@AAA@@@A@@@AAA@@AA@@@AAAAAAA@@A@@@A@A@@@@BA@A@@@@@A@@@@@@A@@BAAB@@AB@@@BABAB@@@A@@@A@@@A@@AB@@@B@BA@@@@@@CA@@@AA@A@A@@@@A@@@@@@@@BC@@@@@BB@@AA@@A@@A@AAA@@A@AA@@@CBA@AAA@A@A@@@CA@@@A@A@@@A@@AAA@@B@@AA@@@AA@@@C@@AA@A@@@A@@@AAACB@@@@@@@A@@@@A@@AA@@@A@A@@A@AB@@@B@BA@@BA@@ABA@@@A@@A@@B@@@BAB@@@@@@@@@AABA@AAA@@@AA@@A@@@@A@A@@@AAB@@@B@B@B@B@@@@A@AA@AA@A@@@@@AB@BA@@B@@ABA@@BA@A@@@AA@@@BA@@@@@A@@B@@@@B@@B@@AD@@@BA@A@CB@@@@ABA@@AAA@@@@@A@@AC@@AA@@BA@A@A@@@A@A@@@AA@@@A@@@@A@AA@A@@@A@@@@@AA@@A@@@A@@@@@@@AAAA@@@BAA@@@@A@@@C@@@@@BA@@AA@@@BAAAB@@@@@A@BA@@@A@@@A@@@C@C@@@@@AA@@AA@@@@AAA@@@ACAAA@A@@@@AAC@A@A@@@C@@@@@A@@B@B@@@@A@@AC@@@AA@@B@A@@@@@AB@BBB@@@@A@@@AA@@@@ABA@@B@B@BA@B@@AB@B@@@@B@@AB@B@@@@ABA@@BBB@@@B@@@B@@@@@FA@@B@@@BABBB@B@@@D@@@B@@BB@@C@@@@AA@@@@@@@@A@A@@C@@B@@@@@@AB@@A@@@@BA@@BA@@@@@A@@@A@@BA@@BCA@@A@@@A@@BCB@@AA@AC@@B@@A@AAA@@A@@AAA@@@BA@@@@A@A@@A@@@@@@@B@@@@CA@AA@A@@@@@A@@@A@@AA@AA@@A@A@@@A@A@A@A@@@@@AB@@@@@@@@B@@@BBBBB@@BA@@@AA@@AB@@@@@@A@@@@@@@@A@@A@@@A@@@AA@@AB@@A@@@AAA@@@A@A@@@A@@AA@A@@BA@@@@BA@A@@BAB@@ABAA@@@@A@@@AAA@@@A@@A@@A@@@@A@@@CBA@E@@@@@@B@@E@@@@@AB@BBB@BAB@@BB@@@@@B@@@BB@@@@@@@@@@B@B@@A@@@@@@@AA@AAAAB@@@BA@@@@B@B@D@B@@B@@@@BA@@B@@@BA@AA@@A@@@A@@@@@@@@B@@@@A@@@A@@@A@AA@@A@AA@@A@@B@@@@B@@BAB@B@@@@A@@BBB@@ABA@A@@A@@AAAAA@@AA@@@@B@@@@CBABA@AB@@AA@@@@@@C@@A@@@AA@@@@@@A@@AEA@AA@@@@@A@@@@B@@BBA@@B@AA@@BAA@A@@@@AA@@AABAA@@AB@@AB@@ABAAAB@@@B@BAB@@A@@@@AAA@A@@AAA@AAAB@@AB@@@A@@@@@@@A@AA@C@A@A@AAC@AA@@A@@@AA@@C@@@AAAA@@A@AA@@A@@@A@@B@@@B@@C@A@@@@AA@@@A@A@@A@@B@BA@@@@AA@@@A@ABA@@AA@A@A@@CAAAA@@@@A@A@@@C@A@AAA@@@A@@A@AA@@@@AA@@A@@@@@AA@A@A@A@@@@DCB@BA@A@@@A@@BA@AB@@@@A@@BA@@@@@@AC@@A@AAA@@A@@@AA@@@A@@AAA@@BC@@BA@@@@@AA@@A@@AA@@@@AAACCA@A@A@@AAAA@@A@AA@@@AAA@@CAC@AA@@@@A@@AD@B@@A@A@@@@B@@A@@@@@@AAAAAAA@@A@@@@A@@@@@@C@@@A@@AA@@A@@BA@@@A@@@@B@@A@A@@@A@@@@AA@@AAB@@@@A@@@AA@@@A@@AA@AB@@@@A@@@A@@@@B@@AD@@@AAAAAA@@AB@@@BA@@@@@@@@A@@A@@@AAA@@@AA@@@A@A@@@@BAAABA@ABA@A@@@A@A@@B@@@@@@@B@@@B@@B@@B@B@B@@@@@@@@@@AB@@@@@@A@@@AA@@AA@@@@AD@@@@@A@@AA@@@B@@A@@@@AB@@AAA@@A@@@A@A@@@@@@A@@@CA@CC@@@@A@@@A@A@@@@A@@@A@AB@A@@A@@A@BA@A@@@@AB@B@@A@@@@@@A@C@@@A@ABAB@@AB@@AB@@@A@@@BA@@@@@@B@B@@@B@AA@@@@@@AA@@@@@A@@AA@@@@@A@@A@A@@@@@@@B@@@BAA@@A@@B@@@@@BB@@@@BA@A@@@@A@@@@AB@@@@@B@@@B@@@B@B@@@@BB@@@B@@A@@@@B@B@@AB@@AD@@@@A@AA@@@AAAAA@AC@@@AA@A@@@A@@@AAA@@@@A@@@AAA@AACA@@@@@A@A@@@@A@@@@@CA@@@@AAA@@BA@A@@BA@ABA@@B@B@@AA@BA@@B@@@@@D@@B@@@@@A@B@@B@@@@@@A@@@@@A@@@AAB@A@A@@@@@@BB@@B@@B@@@@B@@B@@B@@@@@B@@@@BA@@B@@B@@@@A@@B@@@B@@A@@BB@@@BA@AB@@@B@@@@@@BBB@@@@@BABAB@AEB@B@@B@AB@@@@@@@@A@A@@BC@@BA@AB@B@B@@AB@@AB@@@@@B@@A@@@@@@@A@@B@@@@B@@@@@B@@@@@@@@A@@BBBD@@B@@BBB@@B@@BBBAB@B@@BBB@@@@D@B@@@B@@A@A@AB@@@B@@A@A@AAA@A@@@AA@B@AA@A@@BAB@@@@@@AA@A@@A@@@AA@@A@A@@@ABCB@@A@@@@@AB@@@@A@A@A@A@@@A@@A@@A@@@A@@@A@AAA@@@A@A@A@@BA@@@A@ABBBABBB@B@@A@@@A@@@A@@@A@@@ABA@AB@BAB@@@B@@@B@B@@@@A@@@AB@BAB@@"],encodeOffsets:[[123161,29721]]}},{type:"Feature",id:"330784",properties:{name:"永康市",cp:[120.047651,28.888555],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@@@AB@@@AA@@@A@@@AB@A@A@@AB@B@@@@@@@@AB@@@@@@@@@@AB@B@AAB@@@@@BA@@@@@@B@@AA@@@FABA@@BAB@@@@BD@B@B@B@@@@B@@B@@B@@@@B@@@@A@@@@B@@A@@@@A@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@A@@@@@@@@@A@@@@@@@@A@@@@@@@@@@A@@@@BA@B@@B@@@@B@@@AB@B@@@@B@@@@@ABAA@@@@@AA@@@AA@@@@@@A@@@B@@A@@@A@@@@@@A@@@@@@B@@A@@@A@@@A@@@CCA@@AAA@A@@@A@@@@@@A@AB@@@@AB@@A@@AAA@@A@@@AA@@@AA@@AA@@@@@@@A@@@@@AA@@@AAA@@AA@AA@A@@@@@@@B@@B@@A@AB@@@AA@@ABC@A@@@@@@A@A@AA@@A@@BAA@@AAA@@AA@CCA@@@@@A@@@A@@@@@A@@ACAA@@@A@A@@@A@@@@BA@AA@@@@A@A@AAA@AAA@@@AAA@AA@BA@@@@BAB@B@@@B@B@@A@@B@@ABAB@@@@AA@@A@@@A@@@AB@@A@@@@B@@@@@B@BA@@@A@@BC@@@A@@@@@CB@@@@A@@@A@A@AA@@A@@@@BA@@@B@@@@@B@@B@@@@@@@@A@@B@@@@@@B@@@@@B@@BB@@BBB@B@@@B@@@DABAB@@@@A@@AA@A@@@A@A@@B@@@@@@ABA@@@A@@@ABAB@@A@A@A@@@@@AD@@@BA@@@@BA@A@AB@@A@@BAAA@A@CBCB@BCB@@@@@BB@@B@@@BAB@@A@AA@@@A@@A@A@A@AAA@A@@BA@@@A@@@@@@@AA@A@@@A@@@AA@@@A@@@BA@@@CA@@A@@@AA@@@A@@A@AEBABA@@@@B@@AB@@A@A@@@C@C@AAAB@@@AA@@@@AA@C@AB@@@@ABAB@@@BA@AB@@A@CB@BA@A@A@@@@@@@A@@A@@@@A@ABA@A@@@@BA@@@@B@@A@@B@@AB@@@B@@@@@B@@AA@@@@@@@@BB@@@@A@@@@@@BB@@@A@A@@B@@@@BBA@@@@@@@@@@@@@A@@A@@@B@@@@B@@BA@@@BBB@@@@BBA@B@A@@@@@A@@@@@@@B@@@AB@@@@@@B@B@@B@B@AAB@@@@@@@@@@@B@@@@A@@@@@@@D@@B@@B@@@AB@@@@BB@@@@@B@@@@@A@@@ABB@@B@@@@B@B@@AB@@@B@B@@@@@B@B@@BB@BB@B@@@B@@B@B@@@AA@@B@B@B@BB@B@@BB@BBB@@B@D@B@BA@B@@@@@@@A@@B@@@B@@@@BB@@@B@@D@@B@@@@@@A@@@AB@B@@@@BB@@B@@@@B@BC@@AAB@@@@A@B@@BB@@@@BA@A@@@A@A@@BB@@B@@BB@@@@BAB@@@@B@@BBD@@@@@B@@BA@@@DB@@@BB@@AB@B@@ABA@@B@@A@@BAB@@A@AB@B@BAB@@@@BB@BA@@BA@@B@@CB@@@BAB@@@B@B@@@@AB@B@B@@@@@@@BA@@@@@@@B@@B@@@@B@@@B@@@@B@BA@@@@@@@B@@B@@@A@@BBA@B@A@@B@@@@@B@@@@B@@@B@@@@@@@@@@A@@@@@@@@@A@@@@BB@@B@@@B@@B@@@@@@@@@BA@@@A@@@A@@@@@D@@@BB@@@B@@A@@@@@A@@@A@@@@B@@@@BB@B@@@@A@@@@@@D@@@@@@@B@@@AB@@@B@@@@@@@@@B@@BA@@@A@@@AB@@B@@@@@B@A@@@@@@B@@@@@@@@@@@@A@@@@@@B@@@@@BC@@@@BA@@A@@@B@@@BB@@@@B@@CB@@@@BBB@B@@@@@@@@A@@@A@@@@B@@AB@@@@BCB@@@@@BB@@@@@BA@@B@@@@B@@ABAB@@ABAAA@@@@@@@A@@@CBA@@@@@@AA@@@@B@BC@ABEDABAB@@@BB@@A@@B@@@B@@@B@@A@@B@@B@BB@@@@@@BA@@@A@@@A@@@@@@B@@A@AB@@@@A@@@@@@@A@@@A@A@AB@B@@@BAB@@AA@A@@B@@@@@A@@@A@@@@@@B@@@BABB@BA@@@BB@@@@@@@B@@B@BB@@B@@AA@@A@@A@@AA@@@B@@@BBB@B@@@BBB@@@BB@@@@@@@@@@B@@@@A@@AA@@AA@@AA@@@A@@AAB@@A@@@@BAA@@@B@@@@@B@@@@BB@@A@BB@@@@B@@@@@@@B@@@@BB@@BA@BDC@@@BAA@@@AB@@@@@B@@@BB@@@B@@@@@@B@@@@@@@@@BA@@B@@@@@@@A@@A@@@@@AA@@A@@@@A@@@@@@@@BAA@@@CA@@@@@@@@@D@@@BAAA@@AAA@BA@@B@@@@A@@@@@AA@@AAAB@@AA@A@@C@@B@@A@@@@@@ACB@@A@@@A@@A@@A@@@@B@@@@@BB@@B@@@@@B@@@@@@@@AA@B@@@AC@@@@@D@@@@AA@@@BA@A@@A@A@@B@@@BA@@B@@@B@ABBA@@BBB@B@@@BBB@@@@B@@BBB@B@@ABA@@B@@B@@B@@A@@AA@AB@@@@@@A@@@@B@@BA@B@@@@@B@B@@@@@@B@@@@@@@A@@@@@@BAB@@@@B@@@@@B@@D@@@B@B@B@@@@@BB@@@@B@@@BB@@@@@@@@@@@B@AB@@A@@@A@@@@@@BB@@AB@B@@@@@B@@@@B@@A@@@@B@@A@@@@@A@@@@A@@@@@@@B@@@@A@@A@@@B@@@@@@@B@@@@@@@@@@@@A@@@@A@@@@@B@B@@@BA@@AA@BB@BC@@A@@@@AB@@@A@@@@@B@@A@@@@@@A@BAA@@@BA@@@@BA@@@@@@@A@@@@AB@@@@@A@@@@@@B@@@@@A@A@@@BABA@@@A@@@@@BBA@@@A@@@@@@@@A@@@@@@@@@@@AA@@@@@@B@@@B@@A@@@@@@A@@B@@@A@@@A@@@@A@@@B@@A@@A@@A@A@@@A@@@@@AB@@@@@A@@@@@@@@@A@@@@@A@@@@@@@B@@A@AB@@CB@@B@@B@@BD@@@@BA@@@@@B@@BBAB@@@@BB@@A@@@@@@@A@A@@B@B@@A@@@@BB@@@@@@@@BB@@@@A@@B@BB@@BB@@@@A@@B@B@@@@@@@@DC@@B@ABBB@@B@@@@@@@@A@@@A@B@@BB@@@BA@A@B@@BA@@B@@B@BD@B@@B@@@@A@@@@@A@@@@@@B@@@@@@@@CB@@@@AB@@@@B@@@BB@@@@BB@@@@@B@@@@@@@B@@@@@@@BA@@@B@@B@AB@B@@B@@A@@B@@A@@@A@@@AB@@B@@@@@@@@@@@@BA@B@B@@A@@@@BA@@B@@BAB@@@@@@A@@@@@BB@@BB@@@@@@@@A@@@@@@@@BB@@@AB@@@@B@@@BB@@@BA@A@@B@@@@@@@@B@@@@A@@@@B@@@@@B@@@@@@B@@@@B@@@@BA@A@@@@@@@@@B@@@@BB@B@B@@@B@@B@@@AB@A@BA@B@@@@@@@@B@@@@A@@@A@@@@@A@@B@B@@BA@@@B@@B@@@@@@A@@B@@@@@@B@@@B@@@@@B@@B@@@@@B@@@@@@@@BB@@@@@@B@@AB@@@@@BBAB@@@B@AB@B@@AB@@BBA@@@BBBB@@AB@@@A@AA@@@@@@@A@@@@B@@BBB@@@@BB@@@BB@@@@BB@@@@@BA@@@A@@B@B@@@@B@BAB@@@BA@@A@@A@@@@@AB@@@BA@A@@B@@A@@@A@@@@@@B@@@@@B@@@@@@@BB@BB@@@A@@@BBBABD@@@B@@AB@BB@@@ABBBA@@B@@@B@@@@@@@BA@@@@B@@@A@@@@@A@@A@@@@@A@A@@BB@@BB@@BAB@@@@AC@@@@AB@@@ABA@AA@@@A@@@B@B@@@@AA@@@A@AA@@A@@@AB@@@A@@@@@@@AB@@@A@A@A@@BAB@B@@@@@@@B@B@B@@@B@@BB@@@BBB@@A@@@@@AB@@@@@@A@@@@BAB@B@@AB@@@@@@@@B@B@@B@@BAB@@@@BC@BB@B@@A@ABBB@@@@CBA@@@@B@@@@@@@@AA@@A@@B@@@@@@@BB@@B@@@@A@A@@A@@@AA@@@@@@@B@@@AA@AA@@@@B@@A@@@A@@@@B@@A@@@AA@@@@@B@@AAA@@@@@@B@@BB@@A@AA@@@@AB@@@B@@A@@@A@A@@@@@@A@@@@B@B@@A@@@A@@@@A@AB@@@@BA@AC@@B@A@@@@@@@@A@BA@@@BB@@@DA@@A@A@@A@@@A@@AA@@@@ABA@AAA@@@BB@@B@B@@@@B@@B@AB@A@@A@@@@@@B@@@@@A@@@B@@A@@@@A@@@AA@@AA@@@@B@@@B@@A@@@@@@A@@@@AA@@A@@AA@BAAA@@@@@@AB@@@B@@A@@@A@@A@@@@@B@@ABA@@@B@@B@@BB@@@@B@@@ABB@@@BB@@A@@@A@BBA@@@@@@@@A@@B@AA@@@@A@@@A@@@@B@@@B@@A@B@@B@BB@@@A@A@@@@@BB@@B@@@@@@BB@@@@BA@@@@@@@BBB@@B@@B@@B@@@@BB@@@@@@ABA@B@@BAB@@@@@@A@@@AB@@@@@B@@@@B@B@@@@BA@@B@@@@@@@@@B@@B@@@@B@@@B@@@@@BAB@B@@@@DA@@@@@@@B@@EB@@@B@@D@BA@@@@@@@@B@FB@@@@BBBD@@BB@@@BA@@B@@@BB@@@B@@BB@A@@@A@A@A@@@@@BBDBB@B@@@@@B@A@@@C@@@@BA@@B@B@@@B@@BAB@@@@@@@@BB@@BA@BB@B@@D@BA@@B@@@@BA@@BA@@B@@@@@@DBB@@@B@B@@@@@@@ABA@A@CAAA@@@@ABBBA@@BA@@@@@B@@B@@@BCB@BA@B@@D@@BD@@BBBBB@BBB@B@BB@@B@B@BBB@BBBAB@@@@AB@BB@@B@B@@@BB@@B@@@BA@@@@@@BB@@ABB@@B@@B@DA@@B@@@BB@@@BA@ABB@@@@@BA@@@@B@@@@B@BB@@@@@B@BA@@BB@@B@B@@@@@@BBB@D@@@@@BA@B@B@B@@@BA@ABAB@B@@AB@@@@A@A@@BA@@@@BA@@@@@@@A@@B@B@@@BA@@BA@@B@B@BB@@B@B@@@@AB@@AD@@@@@B@BAB@@A@@BAA@BABA@@B@@AB@BA@A@A@@AA@AB@@@@@B@@@B@@@@A@@B@@ABA@@D@@@@AB@@@B@@@@@BAA@A@@A@@@A@@@@A@@ABA@@B@B@@@@AB@@@B@@@@BB@B@BB@@@BB@@@@B@BBB@@@B@@B@@@BBB@@B@@@@@@BB@D@@@B@@@@@B@@@BBB@B@BB@@@B@B@@@B@@@BBB@@BB@BBB@B@@BB@@BB@@B@@B@DA@@B@@@B@B@@@D@BAB@B@B@B@@B@@B@@B@B@@BB@@@B@@DBB@@@D@@B@@BB@@BBBB@@@@@@B@BA@@@@B@@@BA@@BAB@B@BAB@BBB@BA@@BB@@AB@B@@B@B@B@@@B@BBBB@@B@B@@@@@BB@@@@BB@@@@BA@@@@B@@@BB@@B@BBB@B@@@B@B@B@D@@AB@@A@@A@@@B@@@BB@BB@@@@@BB@AB@@@BA@@@@@@@@BBAB@@AB@@@@A@A@AB@@A@@BA@@B@@@@@B@@@B@@B@@@@@BD@@@@@@B@@@@A@@B@@@BB@@B@@@@B@BAB@@A@@@@@@@BB@@B@B@BB@@@B@BBBB@@@AB@@D@@@@@@AB@B@AB@B@@A@@@@B@@B@@@AB@B@@B@BB@@@@@@BB@@@B@@B@@B@B@BA@A@@@A@@@B@BB@BBB@@ABA@@B@@BBB@@@BABCB@DA@@A@@A@@BA@@B@B@@BB@@@BB@@@@BA@@@A@@@A@@@@@@BA@A@@B@@@BB@@B@@@B@@@B@@@B@@AB@@@@AB@@@DB@@@ABAB@BAB@BAD@B@@@BB@@B@BBBBBB@@@B@@@BBDADA@B@BB@@A@ABBB@BB@@@@B@@@@@@B@@@@AB@@@@AA@@A@@@@@@@A@@@@@A@@@@@@BB@@BB@@@A@@@@@B@@@@BA@@B@@BB@B@B@B@@BB@@BB@B@@@@@B@AABC@A@A@@BAD@@@@ABA@@A@@A@@A@BC@@BA@@@@@@DA@@@@BBB@@@BB@@B@@@BBDDBB@B@B@@BB@@@@@@@@@A@AAAACCA@AA@AA@@@@@ABA@@@@@@BB@@DA@A@@B@AB@@BB@BDBB@@@B@@@@BB@@BBB@@B@D@B@@@B@@@@@BA@@B@@A@@@BB@@@B@BBBAB@B@BA@@A@@C@@B@B@@@B@BA@@B@B@@@@@@BB@@@B@@@@BBABA@ABA@@FAB@@@B@@@@B@@@@@@@@@@B@DB@BABB@@@@@@@BA@@B@@@AB@@ED@@DA@@B@BA@@@@B@@@@@A@@BA@AB@B@@BA@@DA@@@B@@@BB@B@@@@A@@@A@@@@B@@@@@B@@@@CA@@@A@CA@@AAC@@AA@@@A@@A@@B@@A@@@A@@AB@@AA@AAA@@@@@@@B@B@@AA@BA@@@@@BAA@@@@@@@@@AA@A@@@A@@@AA@A@AB@A@@AA@@@@AA@AA@@@AA@@AA@@@@@ABBB@B@@BD@B@@@@BB@B@@BB@@@B@@@@A@A@@B@@@B@@@@@B@@A@@@@@A@@D@@@B@B@@A@@AA@@AA@@@@@A@@@@@@@@@@BA@@@@BA@@B@@@@@@@@@B@@AB@@A@@B@@AB@@@@BB@@@@@B@@@@@BC@@BBB@@@@@B@B@@@@B@@@B@@B@@A@@B@@@B@BAB@B@B@BB@@B@@BB@@@BA@@B@D@@ABA@@B@@@@@BA@@@@B@@@DB@@B@B@@BB@@AB@B@@@@@ABB@@@B@@A@@B@@@BBB@@@@A@@D@BBB@@@@AB@@@@@BBBBB@@@B@B@B@@@@A@@@@@A@@B@@@B@@@@@B@B@A@@A@AB@@@@@B@B@@@BA@@@@@A@@@@@@@@B@B@@@B@D@@@BA@@@@@BBA@@@@@A@@B@BA@AAABAA@@A@@BB@@B@@@AA@@@@BA@B@@BB@@@@B@@A@@@@@@@AB@@@@@@A@@@@AA@@AA@@A@@A@@@@BA@@@AB@@@@@@A@@@@@@B@@AA@@@BA@@@@@@@AA@@@@@@AA@@@@@@@@AB@@@@@@@@@@A@@AC@A@@@@@A@@@AB@@@@@@@@A@@@@B@@@B@A@BA@@B@@@@@BAB@@A@@@@BAB@@@@@B@@@B@@@@@B@@@@@@@B@@@B@@@AA@@B@@AB@@@@@B@@@B@@@@@@A@@B@@@B@@A@@BB@@@@B@@B@AB@DBBC@@@@@@@@B@@AB@@@AA@@B@@@@@@@@B@@B@@A@@@A@@@AB@@BBBB@B@B@@@B@@@B@@@@@BA@ABA@@B@@@@@@A@A@@@A@@BA@ABAB@BA@@B@@@B@@@B@@@B@@@@AAABAAABAB@@@B@@AB@B@B@@@B@BBB@@@B@@@B@@@@BB@@@B@B@B@B@@@@@BA@@@@B@@@DABA@@B@B@@@BB@@B@@@@BBB@@@@@@BA@AB@B@@B@ABB@@B@B@BBB@B@@@@A@@BAB@B@@@@A@@@A@C@@A@AA@@@ABAAA@AA@@@AA@AA@@@ACAA@@@B@@@@@@A@@@@@A@@@@@@AB@@@@@@@B@@@@A@@@@BA@@BA@@@A@ABAB@@AD@@AB@B@@@@@@@@@BAA@@@@AFA@BBABA@A@@@@AA@A@@@@A@@@A@@BAB@@A@@AB@@@@A@@@AB@@@@@@AA@@@AB@@@@@A@@@@@AA@@@@A@@A@@@@AA@@A@@@@B@B@A@BB@@B@@@@@B@@@@@@@@AA@B@@@@@A@@@@C@@@@@AB@@ABB@@@A@AB@BAB@@AB@B@@AB@BB@@@@DB@@@@B@@@@@@B@B@@@@DBBBB@BB@@B@@@@@BB@B@@@B@@@BBB@@D@@BBBBB@B@@BBB@@@@@BC@@BA@@@A@A@@B@@@@A@@AA@@@@@A@A@@@A@@@A@@@@@@A@@@@A@A@A@@@AAA@AA@@A@A@AB@@B@@@@B@@@B@@C@@@@C@@@@A@@@A@@@@@@AB@@A@@B@@AB@@A@AA@@AA@AA@A@@@AAA@@AA@A@A@@@A@@AA@AAAA@@@@A@A@CAA@@@AA@A@@@@@AA@@@@@@AA@@@AA@AA@@@A@@@AAA@AA@@AA@A@A@@@AA@@@A@@AA@AAAA@@@AA@@AA@@A@A@AAA@AA@A@@AA@@@CAA@@@@@BB@B@@A@@@@@A@AAC@A@A@@AABA@A@A@@@A@@@A@@BAAA@A@EA@@AB@A@@A@CAA@A@@@A@AB@@@BBB@B@@@B@@@B@@@@A@ABA@@@BB@B@@AB@@@BB@@@@B@@AB@B@@@B@@@@AB@@@B@@@BA@A@@B@B@@@@AB@AA@@AAAAAAAA@@A@@A@@AAB@@@@A@A@B@@@@@BAA@B@AAB@@AB@@@@@@A@@@A@@@@@A@@@@A@@@@AAA@@A@AA@@A@@B@@A@@@A@@A@AA@@@AA@AAA@@@@@@AA@A@@@@@AB@@B@@B@@@@@@A@@BA@AB@B@@AAC@@A@@@AA@@A@@A@A@A@@AAACA@@A@@AA@@@A@@AA@@@A@@@@B@@@FA@AB@@@B@@BB@B@@@B@@@D@@@B@BC@@A@@A@@@A@@A@@AC@@A@@@@@AB@@@AA@@@@@A@@B@@A@@A@@@@@@AB@@AB@@@@@@A@@BAA@@C@A@A@A@AB@@A@@@A@A@A@@@AB@AABA@AA@@@@A@A@@AA@@AA@A@ABA@A@@@A@ABC@@@A@@@AB@A@@A@A@@@AA@@A@@A@@@A@AA@@A@AA@@CA@@@@@A@A@@BA@@@A@@A@@@C@@@A@@D@@@@C@AAC@CA@@@A@@@A@A@@@@CA@@@A@ABA@A@AB@@@@AA@@@@@A@AB@@AA@@@A@ABA@@@@@@AB@@A@@A@@A@A@@@@A@@@A@@@A@@AB@@@@AB@@@A@@@A@@@@@@AA@@A@@@@A@@A@@B@@@@@A@@@@A@@B@@@@A@BA@@A@@@@B@@@@AAA@@A@@@@@@BA@@@@@A@@@@@@@B@@A@@BAA@@@@B@@A@A@A@@A@@AB@@@AA@@@@A@@@@@@@BAA@@@@@@@AB@B@@@@B@@B@BA@@@@@@@AB@@@D@@@@AA@@@@@A@@@A@@@@@B@@AB@@@@BA@A@AB@@@A@@@@@A@@B@@@B@@@@@@@A@AB@@A@@@@@@A@@@AA@A@@A@@@@A@@AA@@AB@@BB@@A@@@@@AA@@A@@@@@@@AB@@@@@@A@@A@@@@@@BA@@B@BA@@@@@@@@@@CBA@@@@C@@A@@@A@@@A@@A@@@A@@@@AB@@@@@ABA@@@AB@@@B@@@BAA@@A@@@@@@@@@@@@@@A@@@AB@@@A@@@AA@@@@@AB@@@@@A@@@@@@B@@A@@@AA@B@B@@A@@@@A@@@@@A@AB@@@@A@@A@@@@@A@@@@@@@@B@@A@@@@AAA@@@@@@A@@@A@@AA@@@@@@A@@A@@AA@@@@A@A@@A@A@@A@@@@@B@@AA@@A@@C@@@@@@A@@@@@ABB@A@@@@@@@@@@@A@@@@@@@A@@B@B@@@@@BAA@AA@@@@@@CA@@@@@@@AB@@@@A@@@@B@BAB@@@B@@@@A@A@@B@@@BA@@@@BB@@@@BA@@@@@@@A@@BB@@@@BA@@@@BA@B@@BA@@@@A@@@B@A@@@@@@@A@@@@@A@@@@@@@@@@@@@B@@AA@@@AB@@@@@@@A@@BA@@@@@A@@@B@@@@@@A@@@@@AA@A@@@@A@@A@@@A@@@@@@@AA@A@@@@@@@A@@AAA@@A@@BA@@@@@A@A@@@@@@@@@@A@@@@B@@@@@@AB@@A@@@@@AA@@@@B@@@@A@@@@@ABA@A@@@B@@A@@A@@@A@@@E@@BB@@@@B@@@@A@@@AA@@A@@BA@@A@@AB@@@@A@@@@@@@A@@@A@@@@@@A@@ABAA@@@@@AA@@@@@A@@@A@@A@@B@@@B@@A@@@@@@@@@A@@@@AAA@@@@A@@A@@@@@@B@@A@@@@A@@@@BA@@@@@A@@BA@@A@@@@@@A@@@@A@@@@@AA@AA@@@A@@@@BA@@B@@AB@@@@A@A@@@@@@@@@@AA@@@@AA@@@A@AB@AA@@@@@A@AB@B@@@B@@@@ABAAA@A@@@@B@@@B@@AB@@C@@@AA@@@AA@A@@BAB@@@BA@@@A@A@A@@@ABAB@@A@@B@@@BA@@@@@@AA@@@@AA@@@AB@AA@@@@@A@@@@@A@A@AAA@@@@C@@@AB@B@@A@@BA@@BC@@@@@AA@@@A@@ABA@@@A@@@@C@@B@@B@@@A@@@@@A@@@@B@@A@@@@@@@B@@A@@@A@@@@@@@@B@@@@@@A@@@@@@@A@@@@A@@A@@@@B@@@@@@A@@@AA@@A@@@@B@@@A@@A@@@@@BA@A@@@@@@@BA@@AA@BA@@@@@@@@@@@A@@@@@A@@@@@@@@@BAB@@@B@@@@@B@@@@@AA@@@@@@@@A@@@A@@@A@@@AA@@@@@@B@@@B@@@A@@AD@@A@@@A@@A@@@ABA@@@@BA@A@@@@@@@A@@@AA@@A@BB@@@@A@@@AA@@@@@B@@@@BB@@BA@@@B@@@@@@@@A@C@@@@A@@AA@@ABA@A@A@@B@@AB@@A@@B@AA@A@A@A@@@A@A@@@AB@@A@@@@@ABBB@B@B@@@@AB@@AB@AA@@@A@@@@@@A@@@@@@@B@@@@@@AAA@@B@@@@A@@@"],encodeOffsets:[[122986,29459]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,5 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("丽水",{type:"FeatureCollection",features:[{type:"Feature",id:"331102",properties:{name:"莲都区",cp:[119.912626,28.445928],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@A@A@A@AAA@@@A@@CE@@B@DBBA@@@@BB@@D@@C@@AC@@AAA@@BA@AB@@A@@@A@A@AAA@@@CBA@A@A@@@@@A@@A@@A@@AA@@@A@@@A@@@@@AA@A@@@@@AB@@@AAB@@@@AB@@@B@B@@AB@@AB@BCB@B@@@BA@ABC@A@@@@A@@@@@CA@@BC@@AA@ABA@@@A@@@AAAAA@@@@A@C@A@A@A@A@@B@B@@@BB@@B@BBBA@@@@B@@@B@@@B@B@@@B@@@B@@AB@@@B@@@@@B@@ABA@A@A@A@A@@@A@@AA@A@@BAA@A@@AB@@ABA@A@@@A@A@@BA@@@A@@@@@A@@@A@@@@@A@@@A@AB@@A@@BA@@AA@AB@@ABA@C@@@A@@@A@ABA@@@AB@B@@AB@@@B@@@BB@@@@BB@B@@@@@BB@@B@@@@@@BA@@B@@A@@@@@@BA@ABCB@B@@@@@@@@B@@@@B@@@@@@A@AB@@B@@@@@@@@BC@@@A@C@AB@B@@A@A@A@@AAA@@@@@B@@@B@@@B@@@@@@@@BBB@B@@B@@@@@B@B@B@@@B@@B@BB@@@@ABA@ABA@@B@B@@BBBBBD@@A@B@@BBB@@@BBBB@@A@@BAB@@@@A@@B@@@@BBB@BA@@@@B@B@B@@@B@@B@@BDBBB@@B@B@@@@BB@@@@BB@AB@@@B@@@@@@@B@B@@@B@@@B@@A@@@@B@B@@A@@BAAA@AB@@@A@@A@A@A@@@A@@AA@@@A@@@@@@AA@A@@@A@AB@@@@A@@@@@ABA@A@@@AB@@A@A@A@@A@@@AA@AB@@@@@@AA@@@BABBD@@@@@B@@AAA@@@A@A@@B@B@@@@AB@@A@@AA@AA@@@@@B@B@B@@@B@B@@@AA@@BA@@@A@A@@@@A@@@@AA@@@@A@A@A@@AA@@@CD@@@@@BA@A@@@@@BBB@@BBDB@@@AB@@A@A@AAA@@@C@A@@@AA@@@@AAC@@@@B@@AA@@@@@@A@A@@B@@@B@@AB@B@@@@@BB@@@@@@A@A@@B@@@@B@@@@@B@@@@@@B@@@BA@@@@B@@BA@@@AB@@@@@@BA@@D@@B@@@@@BAB@@A@@@A@B@@A@@@@@@@@@@A@@@@@AB@@@BA@B@@B@@B@@@@@B@@A@@B@@@@BDB@@B@@@@ABAB@AABA@@@@@@@A@@@@A@@@@@@@BA@@B@@A@@@B@@B@@@@@@@BA@B@@B@@@@@@@B@B@@@@@@BA@@B@@@@ABA@A@@@@B@@@@@@@B@@AB@@@@B@B@@@B@BCB@@@@@@B@B@BABA@@B@@AB@@ABA@@@@@B@@B@BBB@BADB@BBBB@@D@@@BBBB@@B@BB@@@@BB@@B@@@BA@@@B@@BB@B@B@@A@@@@B@@B@@BB@@B@@B@@ABB@@@@A@@BB@@@BA@@@BB@@D@BBB@D@B@DB@@@@B@@@DBB@B@B@B@@@@@B@@B@@@@@@A@@@A@@BBB@@B@@@@B@@@@@B@@@BD@D@BBB@@BA@BB@@@@@@A@@@A@ACA@@@A@@@@B@@@@@@@@A@@@@B@@@A@@A@@@AB@@BB@@A@@@@@@BA@@@A@@@A@@B@@@@@B@B@@@@@@@@B@@@B@@BABABA@@@@BA@@@ABB@@BB@B@@@@DBDBBAB@B@BB@@@@BBABB@@B@B@@BB@B@@B@B@@B@@@@BB@@@@@B@@@B@@@@A@@@A@@@AB@AAB@@A@C@@@@@@B@@@BA@A@@@@BB@@BA@B@AB@@@@@BABBB@@@@@@@B@@@@@@A@@A@@@@A@@@@@AB@@CDEF@B@@@B@@@BB@B@@@@@BD@@@@@B@BA@@@@@@A@AA@@@@B@BAB@B@A@@AA@@@@A@@@A@BB@@@@@@@@@@AB@@@AA@@BA@AAAA@@A@@@ABABA@@DA@@@@@@A@@@@@@@@@A@A@AA@@@ABA@@@BB@@CDA@AB@@@@@@B@@@B@@@B@@@@B@@ABA@@@@@@B@@@@AA@@@B@@@@@B@@B@@@AB@B@@@@A@@BA@@@BB@B@@@@@BA@@BA@@@@@A@AA@@@@AB@BCD@BAB@B@D@BBB@@AB@@@@@BA@@B@@@B@@A@@B@@@@ABA@@B@@@@C@@@AA@@A@@@A@@@@@@BA@@B@@A@A@@@AA@A@A@@A@@@C@@@A@@@@BAB@@@B@@@@A@@@CA@@A@@@AAC@@@@A@@@@A@@@@@A@@@@@A@@@@@@B@@@@@@A@@@@@@AA@@@A@A@@@@@ABB@AB@BAB@@B@@@@B@@A@@@AB@@@B@@BBA@@AAB@A@@@BA@@B@@@@@A@@@@@A@A@@@@A@@@@@@B@@A@A@@@B@@A@@@@A@@@A@@@@A@@@@@@BA@@@@@A@BAA@@@BABA@ABA@@@@@@@@A@@A@@@@@@A@@@A@AAB@@@DABAB@@@@@@A@@@@@A@@AA@@B@@A@@@AB@@@@@B@@@@A@@@@BABA@A@@B@@@B@@AB@@AA@@A@A@AB@@@@@B@@@@ABAB@BA@A@@@A@@@A@C@A@@A@@@B@@@@D@B@@@@BA@@@@BD@@B@@@@@@A@BBA@@@@@BB@@@BA@@@@B@@BA@@@@@@@@@@B@B@@@@@ABAB@@@D@@B@@@@@B@@@@ADD@@@@ABB@BB@@@@@@@B@@@@@B@@BA@B@@@@C@A@AB@@A@@B@@BB@@@@B@@@@@@@BA@@@@@A@@@@@@B@BA@@@@B@BA@BB@@@BB@@@B@@@BAD@@@F@@BB@@@@@A@@@@B@B@B@@@B@B@@@@AB@@A@@@@AA@AA@@@@@AA@@B@@@B@@@@@BBB@@@@@B@@@@@B@@@@@@A@@@A@A@@BA@@@B@@B@@A@@A@@AA@@A@@B@B@BA@AB@B@B@@@DA@@B@@@BBB@@@@B@@@@B@@BBB@@B@@A@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@@A@@@AB@@AB@@@B@@@@@B@@@@A@@BABB@AB@BB@@BA@@B@B@@A@B@@@@B@@BB@D@@BB@B@B@@@AA@@@@@@A@BAA@BA@@D@@B@@@@A@BB@A@@@@@@@B@BB@B@@AB@@@@A@A@@@@@@@@@B@@@@@B@@@@@@B@@@B@B@B@@B@AB@@@@@@B@@B@@@@AB@@@D@@@BB@@@B@@@@@BB@@@@A@@@@@@@@@@B@@BLD@@AB@@BB@B@D@@B@@@B@B@B@BB@@@@@AA@AAA@@@@@@@@AB@BA@@@BD@@@@B@@@B@B@B@B@@@@@BB@@@@@@B@@AB@B@@@@A@@A@@@A@@BA@@@@@@@@AB@@@@@@A@@B@@@@@@AB@@@@A@@AA@@@@@@@@@@A@@@A@@@@A@@B@BA@@@BB@@B@@BB@@@@@@@@BBB@@@@BBAB@@@@A@@@A@@BA@A@@@B@@BAB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@BAB@BB@AB@B@BAB@BB@@B@B@@@@AAA@AA@A@@A@@@A@@@@DBD@@AD@B@@@BB@D@@@@@B@@@BA@@@@DB@@@B@@@@AB@@@@A@@@@@@@@BB@A@@@D@@B@@@@@BB@ABA@@@A@A@@@A@@@@@BB@DB@@@AB@@A@A@@@@B@@@BB@AB@B@@@@@@A@@@A@BB@@@@@@@B@@AB@B@B@@@B@@@@@B@@@@@@@D@@A@@BAB@@B@@BA@@B@@@@BB@@@B@BBD@@AAC@@@@@AB@@A@A@@@A@@@@@@@@@@A@@A@@@A@@BA@@@AB@@@B@@@B@@A@@@@BABA@@@@B@@AA@@@@@B@@AB@@AB@@BB@@@B@@@BB@@@BB@B@@BBB@ABBBABB@@@@@BB@@@@@AFB@@B@@@BB@BB@@BB@B@BBB@@@B@@@@B@BA@@@@@@@@@@@@@@B@BB@BB@B@B@@BDA@@@@@@@@BA@@@@B@B@@@@@B@B@@AB@@@@BB@@A@@@BB@B@@A@@B@@B@@B@@AB@@@B@@@BABB@@@BB@@AB@B@B@@@@@@BD@B@@GD@BA@@B@BA@BBA@@D@BAB@B@@BBBB@@@B@@A@@@@BA@A@@AA@@@AB@@C@@@@@@A@@A@C@@@A@ABA@A@AA@@@B@@@@A@@@@A@@@@BA@@@@A@CA@@@B@B@B@BA@@@@B@@@@A@@@@@A@@B@@@@@@@B@@@@@@@@@@@A@@B@@@A@@BB@@@CB@B@@A@@@@A@@AAA@@@@AA@A@A@@@@A@@AA@ACA@@@A@@AA@AA@@@AA@@@AA@A@@@@@@@@B@@B@@@@@B@AB@@BBBB@@@@@BB@@B@@@B@@@@CAA@@@A@@@A@A@@@A@@BA@@BA@AB@@@B@@A@@BAA@@@@A@@@ABAA@@A@@A@@A@@@B@@A@@B@@@AA@ACC@C@@AA@@A@@ACBAA@@A@@@AB@@A@@@@@A@A@@@@@AA@@@AA@AA@@@@@A@@C@@@@@B@@D@@BD@@BD@BA@@B@B@B@BBBBBAB@B@B@D@@AB@B@@@B@@@B@@@@BB@@@@@B@@@@A@@@@B@@@BA@@BA@@@@BA@@B@@@@B@@B@@@@B@@B@@@@@B@@AFAB@@A@@B@@B@@BB@@B@@@B@@@BB@@B@@BB@@BB@@@@A@A@@@C@ABA@@@@B@BBD@@@B@B@@@B@@@B@B@@BB@@@BBB@@AD@@A@@@ABAD@BAB@B@@BAB@@@B@DA@@BA@AA@B@@@@@@@@@@@BBB@BA@@@@@@@A@@@@A@@A@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@C@C@AB@@@@@B@@@@@@@@BB@@@AF@B@B@@A@@@@@@@BBB@@@@BB@@@@ABA@@B@@@AB@@AB@@@@@BAB@@@B@B@B@BB@D@B@@@@B@@@BD@BB@@BBDB@@ABA@@B@@@@@@BB@@D@@@B@@ABA@AAB@@A@@A@@B@@@@@B@@@@AA@CA@A@@@@@@@@DA@@B@B@@@B@B@BB@@AB@@BB@@AB@@A@ABAB@@BBBB@@BBB@@@B@@@C@@B@@@@@@AB@@@B@B@@@DDAB@@@@A@@B@B@BB@@B@@@B@@@A@@B@@@@@@BBB@@@@@AB@B@@ABB@@@D@B@@@B@@B@@@BB@B@@@@A@A@@@@@@B@@@DDB@B@@@@A@@@@@@AC@@AA@@@@DB@@@AA@C@A@@AD@B@@@AA@@BAB@BA@@@@@AA@@AA@@A@A@A@@BA@@BABABAB@@ABA@@@@A@@@B@@@B@@@DBB@B@B@@@@@@A@@@@@@@A@@@A@@@@A@AA@@@@B@@AB@@@B@BBB@@@@@@@B@@@@@B@@@@BAD@@@@B@@BBA@@@@B@@@@BBA@@BA@@@@B@@BB@AB@@@B@@@@@BBA@@B@B@BB@@@A@@@@AAB@B@@@B@@AB@@B@@@@B@@ADA@@@@@ABAB@@@B@@@@BB@B@BAB@BC@@BB@B@@AB@BA@@BA@@BA@@BDBB@B@BB@@B@@@@@B@@BBB@@@B@@@B@@@@@B@@A@BBABB@@B@@@BAB@B@BB@@@@@BB@BBB@@BB@@BB@BB@B@@@B@BBB@@@@@@B@@B@BB@@@@@B@@ABB@@@@@@B@@B@@B@@@B@@AB@@@@AB@@A@@@@@@B@B@@@@BB@@B@@BBB@@@@BB@@B@@B@AB@@A@@BA@@@B@@@A@@@@DB@@@@@@@AB@@@BAA@BA@@@A@@F@@B@@@@B@@A@@B@BBB@B@@BB@A@C@A@ABA@@@AB@@AB@@@B@@@BBAFBD@@@B@@@@B@@@B@BBB@B@BBB@B@DB@@@@A@AB@@B@B@B@@@@B@@@@@@@@@@AB@@A@@@A@A@@@A@A@@B@BAB@@@B@@@@@@BB@@@DD@@BA@@BB@@@@@DBB@B@B@@@@AB@B@@@BADBB@@@B@@@BA@@B@B@@@B@@@BABB@@@B@@@BA@@@@@@@B@@@B@@BA@@@@B@@@B@@@@@B@@@B@@@@@@AB@@@B@@@@@BA@@@A@@BB@AB@@@B@@@B@BA@@BA@@B@@@B@@A@AB@@@@@@A@@@@@ABA@@@@@@@BB@@@@B@@@@BB@@B@B@@AB@@@@@B@BA@@BA@@@@@B@B@@AB@@AB@@AD@@@@AB@@@@@@@BB@ADB@@B@@@BAB@BA@A@@@@@@@@D@@BB@B@@B@A@A@AA@@@BA@@@A@BDD@@@@@@B@@A@@B@@@@@@@@@@@@@@B@@B@@@@@B@B@B@@B@@@@@BCBB@@@B@@@@A@@BA@CBA@@@@@ABABA@AB@@ABA@@BA@@B@@@@@@@@@@@@BB@@B@@@B@@@@B@@B@@@A@AA@@@B@@@@@@@@AD@@A@@@ABA@A@@B@@@B@@B@@@@@@@B@@B@@@B@@@B@BA@B@@@C@@@@@@@BB@@@@A@@@@@AAABA@@BB@@@B@@BA@A@@@AB@@@B@@@B@@@@A@@BAAAB@@@@@BB@@B@@B@B@@B@@B@@@B@@@@@B@B@B@B@@@BBA@@@@BBB@@BB@@B@@@@@BABA@AB@B@@@BB@@BB@A@@AA@@@A@@B@B@@@@@@@B@@@@@BA@A@@B@@@@A@@B@@@@@@@@@@@B@A@B@@@@@@AB@@@@@@@B@B@@@@@@B@@B@@A@@BBBBB@@@D@@@@@AA@@@AB@BB@@@@BA@AB@@B@@@BBB@BB@@@B@@A@@BABC@@@@BB@@@@@@@@@BAB@B@@A@@BB@@@BAB@@A@AB@@A@@BBB@@B@B@@@B@@@BBB@@@@@@@B@@@@@@BB@@@@@@@@BA@@@B@@B@BB@B@@B@@B@@@@@B@@B@DB@B@@BA@@@@BB@@@@@@@B@@@@@B@@@B@B@D@@B@BBB@D@@@@B@B@D@@@BA@@BAB@BAB@@BB@@@B@@@BBBA@@@BBBB@@BB@@@B@@@B@@@BAB@@@@@@BB@@@@@BB@@@@B@@@B@B@B@@@@@B@@@B@B@B@@AB@B@@@@A@@@AAAA@@A@@@@@@B@@C@A@@@@B@@B@@BB@B@B@@@@B@@@B@@BB@@B@@AB@BA@@@@BB@B@@@@DBB@@@@B@@@@CB@@@@A@A@AAA@@@@B@@B@BBB@@@B@BBB@@B@@B@@@B@@ABAB@@B@@@@B@B@B@@B@BA@@B@@@@B@@@@A@@B@B@@@@@DB@BA@@D@@B@@@BA@@@@BB@@B@@@@@ABAB@B@@@@BABB@@@@@@A@@B@@AB@@@@B@BAB@@@@B@@@@@@@BB@@B@@@@AB@@@BAB@@@BA@@B@@BA@@B@@@@@@@@@A@@BA@ABA@@@@@BB@@@@@@@@B@@@@@@@B@B@BA@@@@B@@@@@@@DBB@@B@@BB@@@@BBBBBB@B@@BB@@B@@A@@@AB@@@B@@AB@B@@@B@@@B@@@BB@@BADBB@B@B@@@B@@@DBB@B@@B@@@B@@@@B@B@@@@A@@@@@@BAA@B@A@@@A@@@@A@@@@B@@@@@B@B@@@@B@@@B@@B@BBB@BB@@@B@@AB@BB@@B@@DB@BB@@@BAB@B@@@B@@AB@BA@@@@BB@@@@@@B@@A@@@@@@@A@@B@@@@@BBBBABB@@@@@@AB@@A@ABBBB@@B@B@@@@@AD@@AB@@@@B@B@@@B@B@@@@@@BBB@@@AB@B@B@@@@@@@@BB@@B@@B@B@B@BB@@B@B@B@BB@@BABA@@DAB@BB@@@A@@A@AAB@@AB@@@A@AA@AA@@A@@@@AA@@@CAA@@@AB@@A@@AA@@@A@@@A@@@A@@@@@A@A@@B@@A@A@@A@AAA@@AAAA@@@A@@@@@@A@@@@@AD@BBBAAA@ABABA@@@@@A@@A@@@AA@@@@@AA@@A@@B@@@@@B@@@B@@@@@@@@B@@@@A@A@@@@@B@B@@@@@@@@B@@B@@@BABA@@B@@@AB@@@@B@BAB@BA@@@@AA@@BA@@@@A@@A@@A@@@@@@AB@@@A@A@@@@AAAB@@@@A@@B@@@AA@@@@A@@@@@@@@A@A@@@@@@ABA@@@@@A@@@@@@A@@@AB@@@B@DA@@@@@@@@B@B@@@BB@@B@@@BAB@@@@@BA@@B@@@B@@A@@B@@ABA@@@@BB@A@@B@@@B@BA@@ACB@@@@BBB@@@@@@@A@@@@@@BA@@@@@@B@@@@@@B@@@@@@@B@@@@@BA@@@B@B@@@BB@AB@AA@@BA@A@@@ABAA@@@@A@@@@@A@@@A@A@@AAB@@@AAB@@A@@BAB@@@@@BA@AB@D@@@B@BA@@B@@A@@@@@@@AB@BA@@@A@@@AB@@@B@DA@@@@@AA@@AAA@@@@CAA@@@BABA@@AA@@@@@A@@@@@A@A@@AA@@@@@A@AB@BAB@@AAAB@@@@A@@@A@@@@BA@@@@@AA@@A@@@A@@@@B@@AB@DABAD@B@@@BA@@BA@@BA@@B@@@@@@@B@@@B@B@@B@@@@BB@@B@@B@B@@@@@B@B@@@@BB@@B@@@@@BA@@B@DB@@BAB@@B@@B@@@B@@@@AB@@@BA@@@A@@B@@@B@@@@@B@@A@A@@@@@A@@@A@A@A@@@@@A@@@@@A@A@@@AB@@ABA@@B@@ABA@@@@A@@@@@@A@@@@@AA@@AB@@@@ABAB@@A@A@A@ABA@@B@BA@@A@ACA@@A@@@@@@@@BBD@@A@AA@AA@@@@A@A@@A@BAA@@A@@@@A@@@ABADABAB@B@@@@@@AA@A@AA@@@@A@AAA@@@@@B@@@@@@@@@AA@@@AB@B@@@@@BAB@@@@A@@@A@@B@@AD@B@@@@@BB@@@A@@@@@@B@@@B@@@@B@@@@@@B@@@AA@@@AB@BBB@@@@AA@@@@@@@A@@AB@B@@@@@@A@@@@@@@@@@B@@@@@B@@@@@@A@A@@B@@AB@B@B@@@DC@@@AA@A@@@@@@A@@@A@@@@@BA@@@A@@@BA@@@@@A@@@AAAAAA@@@@A@@AA@A@@A@@AA@@A@@A@@A@@BA@@B@@@@A@@@AB@BE@@@BB@@AB@@@@AB@@@@AB@@A@@@AB@BABBBA@@@@@A@@@A@@@A@@DABA@@BAB@B@@ABAB@@@BA@@BBD@B@@@@@B@BABA@@B@B@@@@B@@@B@@BBBB@@BBB@@@B@@BB@@@B@@@B@@@@AA@@@@@BA@@@@B@@@@A@@B@@@B@B@@@@@BB@@@@@@BA@A@@@A@@@@@A@@@@@@@@@A@@@@@@@@B@@@@@@A@@@A@@@A@A@@@A@@@@@@B@@@@A@@@AB@@A@@BB@@@@@A@@@@@@B@@A@@@@@@@@B@AAB@@@@AB@@@@@A@@A@@@@B@@@@@@@BA@@@@@A@@DA@@@A@A@@@@A@@@@C@@BA@@@@@@@@@@@A@@B@@AB@BBBAB@BB@@A@BB@@BBB@@@@@@@A@@@@B@@@@B@@@@@BA@@@B@@@BB@B@@@D@BBB@BB@@B@@@BBA@@@B@BB@AD@@@B@@BB@@BB@B@@A@A@AB@@AB@BABA@@@B@@@B@@AA@@@B@B@@@@BBBA@@BB@@AB@@@BAB@@@BABC@A@@@@BB@@@@@B@B@B@@A@@@@BB@@@B@@@@BB@@@@@B@@BB@@@B@@B@@B@@@B@@BB@@BB@@BBBB@B@@@@B@@A@A@@@A@@AA@@@@@@@@B@B@@BB@@@BB@@@B@@@@@B@@@BB@B@@@B@@@@@A@AB@@@@@@AB@@BB@@@B@@@@@@BB@@B@@@@B@@B@@B@@@B@BB@@B@@@@@B@@@B@@B@@B@@@@@B@@B@B@B@B@B@@BB@@@@@@@@@@@@@@@B@BB@@@@B@@B@@@@@@@@B@B@@@@@D@B@@@@@B@@AB@@@@A@@BA@@@@@A@@AABA@@@@@ABAB@@@BA@@@AB@@A@@@A@@@A@A@A@@A@@@@@@A@AA@AA@AAA@A@@BAB@BAB@@@@ABA@A@A@A@@BA@@@@@@B@@AB@DA@ABB@@B@@@BA@A@A@@BAB@@@B@@AAA@@@A@@@@@A@@@@@AB@@AB@@ABA@@@A@AAA@AB@FA@@@@B@B@@@B@D@B@BBB@@@@@B@BA@@BABB@@B@B@B@@@DCDC@AB@@A@@B@B@B@@A@@B@BA@@@@B@@AB@GA@@@@@@@A@A@@AA@@BA@AA@@@AA@@AA@@@AA@@AA@@@@A@A@@@A@A@@BA@@A@@@@@@A@@A@@A@@@AAACAAAHG@A@@@A@@@AA@@@@BA@@@A@@@@@@@@@A@@@@@C@@A@@B@AAB@@A@@@@@@@AA@@A@@B@@@@AA@@@CAAAA@G@@@C@AA@@@@@A@@@AAQAA@AA@@@A@@AA@@A@B@@A@A@A@@BAB@@A@@@@@@@@A@A@@@A@@A@@@@AA@@@AA@AA@A@CA@@A@@@A@@@AC@@@@A@@@C@@@AAA@E@CA@BA@A@@B@BA@@BB@@@@BBB@B@@B@@BB@@BB@@B@@@@AB@@@@@BB@@@@@@@A@@@@B@@B@@B@@@@@@@@@@A@@B@@@@B@@B@@@AB@@@@@AB@@@@@@@@A@@BB@@@@@B@@@@B@AD@@B@B@BAB@@@D@@A@@@A@A@@@A@@AA@@@@@A@A@@@@@@BA@@@AA@@@@@@A@@A@@@@@AAA@A@@AA@AA@@A@@@@DB@B@@@@@@BB@@@@BA@BB@@B@@A@@@@@B@@B@AB@@@B@@@@@AA@@B@@@@@@@B@@@@@AA@@@@@@@@@@@A@@@@@@A@B@A@@@@@A@@@A@@AC@@@@A@@AB@@AA@@@@B@@@@@@A@@BA@@BA@@@@@@@AAA@A@@A@CACAA@@@ABAAAA@@CA@@@@@@AA@@@BA@@@A@A@A@@AA@@@@@CAA@@@AA@@A@@@A@@BA@@@A@ABA@A@@@@AA@A@@@@A@@@@@@@@@@@@@@@@@@@@A@@@@@A@@@AA@A@@@AA@A@@@@@AA@@@@AAAACA@@@C@@@@@@@@@@@AA@@A@AAAA@@@@@ABA@A@@@@B@B@@@B@@@@ACA@@@@A@A@@@@AAAAA@@@AA@@@A@@@AA@@A@AAA@AB@@A@@AC@@A@BAA@@@@@@@AA@@B@@B@@@A@@@@A@BAA@@A@@@AB@BAA@@@AA@@@@A@@@A@@@AA@@@A@@AA@A@AA@@AAA@@@A@A@@@A@@C@AA@@AAA@@@@@@AAB@@A@BA@@@@@A@@@@@AC@AA@AA@@@ABAAA@@@@A@@ACAA@@@@@@A@@@@@@@@@A@@@@@AA@@CB@@@@@@@A@@@@@AA@CB@A@@@@AA@@@@@D@BAB@BA@@BA@@B@@@A@@@@@@A@@@@@@@@@AAA@@B@@BB@@@B@BB@A@AB@@AB@BA@@AA@@A@@@@AA@@A@@@@A@@BA@AAA@A@@@AA@@@C@AA@AA@@@@@A@A@@@A@@A@@A@@@@@A@@@A@BAA@A@@@BAB@@AA@BA@@@@@A@@A@A@@@@@@A@@A@@@@@@@AA@@@@ACA@@@A@A@C@A@@@@@AB@@A@AAA@@@@@A@@@@@@AC@@AA@@@AA@@A@ABA@@AAA@@@@@@AAA@@@AA@@@@@AA@AB@BA@AAA@AAA@@@A@AAB@AA@@A@@@A@AAA@@@@@@@@AA@@BA@@@@A@A@@@@AA@BA@@@@B@@@@AB@@A@@@@BA@A@@@@A@@AA@@A@A@@@ABA@A@@@A@@A@@@@B@B@BA@@BAA@@@@A@@@@AAA@@@@AAA@@@@A@@A@@CC@AA@@A@@@@@A@A@AA@@A@@@A@@AA@@@@@A@@A@@@A@@@A@@@A@@@@A@A@@@A@@@@A@@AA@@@@@AA@@A@@@@AA@@@A@@@@@A@A@@@@A@@@AA@@@AAA@@A@@BA@@@@BA@ABAA@@@A@AB@@@@A@@@A@@@@A@B@@@@@A@@B@@@@AB@@@@A@@B@@A@@A@@@@@@@@@A@@BA@A@@@@BA@@@@A@@B@AAB@@@@@@AB@@B@@B@@@@A@@B@@A@@AA@AA@@@A@@@A@A@A@@@@A@@@@B@@@@A@@AAA@A@@AA@@@AA@@@B@@A@A@@@A@@@AAA@AA@@AB@@AB@@@@A@A@@@@@BA@@@A@@@@BA@@@A@B@@BA@@@@@@@@@@AA@@AB@@@@@@@ABA@A@@AB@@A@@@@B@@A@@AB@@@@A@@BA@@@@@@@AA@@A@@@@@B@@@@@@@BAA@@A@@@A@@@BAA@@@B@@@@AB@@@@@@@A@@@@@A@@@@@@@@@@@@A@@A@@B@@A@@B@@@@@@@A@@AB@@@@@@@@@@@A@@@@@@B@@A@@@@B@A@@@@@@@A@@BAB@@@A@@@@@AB@@@@@@@@@@@A@@@@@A@@A@@BAA@@@@@A@@@BC@@A@@@@@BA@@@A@@@@A@B@AA@@@@@@A@@AAA@@@AA@@@@A@@A@@@AAA@A@@AA@@@AA@@@A@@A@@A@@@@A@A@@@@@A@CA@A@AA@@@AAA@@@A@@A@@A@@A@@AAA@A@@AA@@BAA@AA@A@@@@AA@@@A@A@@@A@@A@AC@@A@@@@@A@A@@@A@@A@@@A@@@B@@@AA@@AA@@@@@@CAA@@ACEAA@@@@@@AAA@@AAA@@@AAB@@@AAB@A@@@@A@@@@BAB@@@@@@@A@@@@@@@A@@@@@A@@A@@@@@BA@@@A@@@@@A@@@@@A@@A@@@@B@B@@@@@B@@@@A@A@@@@@A@@B@@@@@@@@@@@@AB@A@@@@@@@@A@@@@@@AB@@@@B@@@@@A@@B@@@@@@@AA@@B@@@@A@@@@@A@@A@@@@AA@@@@@AB@A@B@@A@@@B@@@A@@AB@BA@@AA@@@@AB@A@@@@@@@@@A@@@@@@@@@A@@A@A@B@@@AA@@@@@@@A@A@@AABA@@@A@@AA@@@@@AA@@A@@@A@@@@@A@@@AAA@A@@@A@A@@@A@@A@@@@A@@@AA@@@@@AA@@@@BA@@BACAA@@A@@BA@@A@@AA@@@@@A@@AA@@@@AA@@A@@DA@A@@@@@A@@@@@AAA@@@@@@@@@@A@@@AA@BA@@@AB@@@@@A@A@A@@A@@@@B@@@B@@A@B@@A@@AA@@A@@@@AA@@@A@@@BAA@@A@@A@A@@@@@BAB@@A@@@@@A@AB@@A@A@A@@@A@A@@@@BA@@B@@@@@@@B@BAA@@@@@@@@@@@@@@@@@A@@AB@@@@A@@@@A@@@BAB@B@BA@@B@@B@BBB@@@@@A@@@@B@@@BABAB@@@@AB@A@BA@@A@@@A@@B@A@BA@@@@@A@@@@A@@@@@@BAB@@@@@@AB@@@AAC@@@@AC@@A@@@A@@@A@@AAA@B@@@BA@@@@BB@@@@@A@@B@@@@@@@B@@A@@@@AA@@@A@@@@BA@B@@@B@@@@@BB@B@@@B@@@B@@A@@BA@@@@@@BA@@@@@AA@@@@AB@@@BA@@@@@AB@B@@@@AB@@A@@@@BA@@BAB@@A@AB@@A@ABA@A@@@AB@B@@@@@@@B@@@@@B@B@@AB@@B@@@D@D@@BB@@BBA@B@B@@DB@@@@@BBB@B@BBB@B@B@BA@@B@B@@@D@B@@@@@@C@A@@@@BABA@A@@B@@@B@B@@@@@@@B@@@@@B@B@B@D@BBBB@@B@@@BB@B@B@@BBBB@@BB@@B@@B@@@BB@@BB@@B@@@BABAB@@BB@B@@@@@B@@@@@B@@B@@@@BB@@@@@BBB@@BB@@B@AB@@AB@@@BA@@B@BC@A@A@AA@@@AAA@AA@@AAAAB@@A@@B@@@D@BAB@B@@AB@B@DA@@BAB@@BDB@@@A@A@A@@@@@ABA@@BA@@@@BA@@@A@A@@@A@A@@@AA@@@@A@@AA@@BA@A@@B@BA@@@A@A@@B@@AB@@@BA@AB@@@B@@@@@@@@B@B@@@@@B@B@@AD@@B@@@@@BB@@@@AB@@@@A@@@@@@@A@@B@@@@A@@@A@@@@@@@@@A@@B@@A@B@B@B@@@BAB@@@@@BB@@B@@@@@B@@@@A@@@@@AB@@A@A@@B@@A@@@@A@@@@@AB@@AA@A@A@@@@@A@@@@@A@@@A@@@@BAA@@AA@@@BCA@@A@@@A@AA@@A@A@@@C@@A@@A@@@A@AB@@@@@@C@A@@@ABAB@A@@A@@@A@@AA@@@A@@@@@@@AA@@A@@@A@AA@@@@AA@@A@@@@@AAA@@@A@ABACC@@@@AACC@B@@AB@@@B@@BB@@AB@@A@AB@@A@A@@@@B@@A@@BA@@@AB@@CB@BA@@BAB@@AAA@A@@@A@@@@BA@@@A@@@@@A@A@CA@@ABA@@BA@@@@@AA@@A@A@@BA@@@A@AB@@C@@A@@ABA@A@AB@@CB@@@@A@@@A@A@AC@ABA@@AAAA@@@@@A@A@A@A@@@@@CBABA@A@@BBB@BAB@@@B@@@BA@@B@B@@@BA@@BA@A@A@A@A@@BAB@@@@@B@@A@@@A@@@A@@B@@@@A@@B@@@AAAA@@@AA@AA@@AAA@@A@@AB@@@BA@@@@B@@AB@@AB@@AB@@@BB@@BAB@@@@@AA@@@A@A@A@AAB@@ABA@A@@BA@@@A@@BA@@AA@A@A@A@@B@@C@A@A@@@A@@DA@@AAA@@@@@A@@@@BA@AB@@A@@B@@A@CBEB@BA@@@A@A@A@@@A@@@E@@BB@@BA@@B@@@BB@B@@B@@@B@B@@@B@@@B@B@@ABA@ABCB@@A@@@A@ABA@A@@@ABA@@B@@ADA@@B@BA@ABABABA@@@A@@@@@A@ABA@@@CB@BA@A@@@AAA@@@A@@@@@@@AA@A@@B@@ABA@C@A@@@@@AB@@A@@@A@@@@@A@C@@@AB@BAB@B@@A@@B@@@B@B@@@@A@A@@B@DA@@@@@A@@@@@A@@@@@A@@A@@@AA@@AA@@@A@BA@AB@@@@A@@@CBCAA@@@@@A@A@@@@@@AA@@@A@@A@@A@@@@A@@@A@@@A@@BA@@B@AA@@@@@@AE@A@@A@@@A@A@@@@@BA@@@@@A@@@A@@A@@AA@@A@@AA@@A@@@@A@@A@A@@@@@@@@B@@A@@@A@@@@AA@@B@@@B@@A@@B@@A@@B@@@B@DA@@B@BAB@@AB@@@@@@AAB@AA@A@@"],encodeOffsets:[[122660,28798]]}},{type:"Feature",id:"331121",properties:{name:"青田县",cp:[120.289478,28.139837],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@AAA@A@@@AB@@A@@AA@@@A@@@@AA@AA@@A@@A@@@ABA@@@AA@@A@A@@@A@@@@@AAA@@BA@@@@@ACA@A@@@@B@@@@AA@BA@@A@@@@AB@@A@@@A@@@@@A@@BAB@AA@@@@@@@@A@@A@@@@@@AA@@A@@@@@A@A@@@AA@@@A@@@A@@AA@@@A@@@@@@@A@@@@@AAA@@@@AA@@A@@@@@A@@@A@@@@BA@@@A@@A@@A@@A@@A@AA@@@@A@A@@@@B@@AAA@ABA@@@@@AB@@A@@@AB@@ABA@@@@@A@ABA@AA@@A@A@@@@@@@@@A@@@@@ABA@@@A@A@@B@AA@@@A@AA@@@@@BA@AA@B@@@AA@A@@B@@@@A@A@CBA@@B@@@@@BA@@@@@CBA@@@@@@B@@A@@A@@AB@@@@@@@BAB@BB@@B@@A@@@AB@@@@@B@BA@@@@@A@@@@B@@@BA@A@@@C@AB@@A@@@@@A@ABA@@@A@@@AB@@@B@@AB@@@BA@@B@@@@@@@B@@@B@@BB@@@@@@A@@B@@@@A@@@@@A@@@AA@B@@@@@B@@@BB@@DB@@@@@BDB@@BBD@@BBA@@@EB@@@@@@@D@@@B@D@@CBAB@B@@@@A@@AABCDA@@@C@@@AB@@CA@@AA@AAA@A@AA@ABAB@@AB@@A@@A@AAB@@@@@@AAC@@@@@A@@B@B@@@@AB@@A@@@@@A@A@@@@AA@@A@A@@@@@@@@@A@@@@@@A@@@ABAA@@A@@@@@A@@@@@A@@@A@@@@AA@@@@@@AA@@BABABA@@@AB@@@@@@A@@@AB@@@@AB@@A@@@@@@@A@A@@BA@A@E@A@A@@@A@A@ABA@@@A@CA@@@@AB@B@@@B@B@@@@A@@@@@A@@A@@C@A@@@A@@@@@@@AA@A@A@@B@@A@A@@@@A@@@A@@A@@A@@@A@AB@@AB@@@@@B@@@BA@@@@@CA@AA@@A@@@@AA@@@@B@@@AA@@@@A@@AA@@@@@@A@@A@AAA@@@A@@@@@A@@@@@A@@@@@@A@A@ABC@@AA@@AA@@C@@@A@A@AA@@A@@@A@@B@@A@@@@@A@@@@@@B@@A@@@@@@@@B@@@B@@@@@@@@@@@@B@@BA@B@@@@B@@@@@B@@@@@BB@@@A@@@A@@@A@@A@@@@@A@@@@@@C@@@@@AAA@@@A@A@A@@@@@@A@@@A@@@@AA@@@A@@A@@@AA@@AA@A@@AA@A@@@@@@@AB@@@AA@@FA@@@@B@@AB@@A@@@@ACA@@@A@@A@@B@@@@@@@@A@@@A@@AA@@A@@@AA@@@@@BA@@@@B@@@@A@@@@@@@A@@BAA@AA@@AA@@AA@A@@@A@@@C@@@A@A@@@@@@@@B@@B@@@@B@@A@@@@A@@A@@@AA@@B@@@A@@A@@A@@@@@ABA@@@@@A@@@@@@B@@A@@@@B@@@B@BAA@@BA@@A@@@@@@@A@@A@@@@A@@@@@@BA@@@@A@@B@@@A@@A@@@@@AA@A@@AA@A@@@@@A@@@A@C@@B@@AA@@@@BA@AB@@@BA@@AA@@@@@A@@A@A@@@@AA@@@C@@@@A@@@@@AA@@@@@AAC@@@@@@@A@@B@@@@AAC@@@@@A@A@A@A@@@@BA@@@A@@B@@A@BBAB@@ABA@@@@B@@A@@@A@@@@@@BA@BB@@@@@@AB@@@@@B@@AAA@@@@B@@@BA@@@@@A@@AA@BA@@@@@AA@@@@@@AB@@@@@BA@@@@@A@@@@A@@A@@@@@@A@@@@@A@A@AA@@A@CA@@A@A@A@@@@@AAA@@@A@CAABA@A@AA@B@@@BA@@@@@@B@@A@@@@A@@@@@A@B@@@@@@@A@@@@@@AB@@@@@A@@@@@@@@@A@BA@@@@@@B@@@BAA@@@A@@@@B@A@@A@@@@@@@B@@@@@@A@@@@B@@@@AA@@@A@@@@@@@@@@@B@@@@A@@@@A@A@@@@@@@@@@@B@@@B@@A@@@@@@A@@@@@@A@@@@@@@@@@@@B@@A@@A@@@@@@@@@@@@A@@@@@@@@B@@@@@@AA@@@BA@@@@@@A@@CB@@@AAB@@AA@B@AC@@@@@@@@@@A@B@@@@A@@@@@AA@@@A@@A@@BBB@@@@@@@@AB@@AB@@A@@BB@@@@B@@@@@@@@A@@@@B@@@@@@@BAB@@@@A@A@@BA@@@@@B@@@BB@BBBDB@@@@@B@@@B@@A@BB@@@@@@BB@@@@@@BBBB@@@@A@@B@B@B@@BBB@@@@@AF@@B@@B@@B@@@@B@B@@@BA@EB@@BB@B@@@B@@A@AB@@@@@BA@@@@B@@@BADA@A@@@@A@AA@@A@@AA@@A@@@AB@@@@@@@@@AA@@@@@@BA@@@@@A@@B@@AA@@CA@A@@A@@@@@@@CB@@@@@@ED@A@@@AA@@@AA@@@BAAA@CBA@@@@@A@@AAAA@AA@@A@A@@@AA@@A@@BA@@@C@@@@@AAA@@@@A@A@BBA@@@@@AAC@@AA@@A@@@A@ABA@@AA@AA@@AAAAAAAA@A@@A@@@@@@@@A@@AA@@CA@@AAA@CBA@A@@@A@@AC@@@@@AB@@C@@@@@A@@@@@@ACA@@A@@@@@@@@@A@AC@@@@A@@@@B@@AB@BA@@B@@@@AFA@@@AB@@ABAD@@@@@B@@AB@@@@@B@@A@ADA@@B@@A@BBA@@@A@@@@@@@AB@DA@@@@D@@@@A@@A@AA@@A@A@@AA@@@@@AABABADBB@@@@B@@@BD@@@@AB@B@@@B@@@BB@BB@@@B@@A@@BB@@@BB@BB@@B@@@BB@D@@@BB@@@@@BB@@@@BB@@@@@@BB@@@@B@BB@BB@@@@@B@@B@@BB@@BB@@BBB@@B@@ABBBA@@B@@BA@A@@B@@@BB@BB@B@B@@@BA@@@AA@@@B@@A@@@@BA@@@@@B@@B@@@@BB@@@@@@@B@@A@@@@B@@@@@@@@A@@@A@@B@BBB@@A@@@@@@@@@@@@@@B@@A@@@A@AB@B@@@@@@@@@B@@@@B@BA@@@B@@@@B@@@@B@@@BB@@@BA@@B@B@@@@AB@@@@AB@@@@@@@BAB@@@@@B@BA@@@@B@BA@@@BB@@@B@@@@A@@B@@@@@@AB@B@@AB@B@BAD@@A@@@A@@@A@@@@B@@@B@@@@@@A@AB@@AD@@@@@BB@@B@@@B@@@@AB@@BB@@@@@@@@@BA@@@A@@@@@@@@@AB@@@BB@@@@@@BA@B@@@@@@B@@@@BBB@@@@A@@@@@B@@B@@@@B@B@@@@@BB@@B@@@@@DB@BB@@A@A@@@@BA@@@@@A@@@A@A@@@@@@@@BDB@@@@@@@AA@@@@B@@@BCB@@A@@@@B@B@@@@A@@@@@@B@BB@BB@BCB@BAF@@@B@AD@@@@@BB@@@B@@@@@B@@@ABA@ABBB@BB@@@@@BA@@@@@@@@@B@@@@@BABADB@@B@BAB@@@@@B@B@@@B@@@B@@@B@B@@@@AB@B@@@@BBBB@BB@@B@B@B@DB@B@@@@BB@BBB@@@B@@B@@@@@B@BBB@B@@BA@@BB@ABB@@BB@@@B@@@@@B@@@B@@@B@@B@@@@B@@@B@@@B@@ADBB@@B@B@@B@@@B@@@B@@B@@@BD@@A@B@@BBB@@@B@@B@BB@@@B@BB@@AB@BA@@BAB@@@B@@B@@@BBBA@@B@@@BA@A@AB@@B@@BB@@B@@BB@@@@B@@@@B@@@@@@A@@B@B@@A@@@AB@B@B@BA@@B@@@B@B@@A@@BB@@BA@@@@D@@@BBB@B@@A@@@A@A@@@A@A@@@B@AB@@AA@@A@@@@BBB@B@@@B@BBB@@AB@@A@@@A@@AA@A@@@@BB@@B@@@@@@B@@B@@A@@@C@@@@@@BA@@@@@A@@@@@@@A@@@@@@@@@@A@@@@@@A@@@@@@@AB@@@@AB@@A@@@A@@@AC@@@AA@@@AB@@@B@B@@@B@BC@A@@@@@@BB@@@BB@BB@@BBB@@B@@@BBB@@@A@@@A@@B@@@@@@BBB@@BBB@B@B@B@B@BAB@@BB@@@@@B@@@@B@@@@@AB@@@BA@@@A@@B@BA@@@@@ABA@@B@@@@@B@B@@B@AB@@AB@B@BA@@B@@@B@@AB@@ABA@AB@@@B@@@@A@@B@@@BABAB@@@BAB@B@@@@AB@@CBA@@BA@AB@B@@@BAB@B@@A@@B@@BABB@@@@A@@B@@@@@B@@A@@B@@C@BBA@@@@BA@@@@BABAB@@@@@@AB@@@@A@@@@BA@@BABAB@B@B@@ABAB@@ABABAB@@@@@@@@@@@B@@@@A@@@@B@@@B@@AB@@@B@@A@AB@@@B@@BB@@@B@@@@@B@@@B@@ABABA@A@A@@@@@A@@BAB@B@@@@A@@C@@@AA@@@AAAA@@@@A@@@A@A@ABA@@@AA@@@@@@A@@@AAA@AB@B@@@B@D@@@@A@@B@B@@@BB@@@@D@@@B@BB@@B@B@@@BB@AD@@B@@BB@AB@@@@@B@@@B@@@@@B@@@@@B@BBB@@BA@@@@B@@@@@@BA@@@@B@BA@@B@@@@@B@@A@@@@@A@@@AA@@@@A@AB@@A@A@A@B@@@@AB@@@@@@@@@B@@@B@@@@@@AB@@@@@@@B@@@@@BA@@@@AA@@@@A@@@BC@@@A@A@@@@@A@A@@@@@@A@@@A@@BAB@@A@@BA@@@@B@B@@ABA@@@@BAB@@BB@@@B@@B@@B@@@B@B@@@B@B@@AB@@@@AB@@AB@B@@@@@B@B@B@@CA@AA@AB@@CBA@A@@BA@A@ABC@@@A@@@@BA@BBBB@@BBB@BB@@B@@B@B@BADA@@@AB@@A@@BA@@B@AA@@AA@AA@@@@A@@A@@A@@@@A@@@@@A@@@@@AAA@@BABA@A@@@AA@@AA@@A@@@AA@@@AA@@AAAA@@@A@AAA@@A@@@AAAAC@A@A@A@@@@@A@@@@@@@A@A@@@A@@@@BABAB@@@@@B@D@@@@A@C@@@A@A@@@ABA@A@A@AAA@A@AA@@@@A@@CA@A@B@AA@@AA@@@C@C@@@AA@@BA@A@@@@@A@@@@@@@A@A@@B@@@BAB@B@BA@@B@BA@AB@@AB@@@@@BA@@B@@A@A@@B@@@@AB@@A@@B@@B@@B@@@@AB@@@@@@AB@@@BA@@@A@@@A@A@@A@@@@@A@@@AAB@@@@@B@@BB@@@@@BA@@@@@@@A@@@@B@@@@AA@@@@AB@@A@B@BB@@@B@@@B@@@BD@@B@@D@BB@@A@@B@@@@A@AB@@@@@@@B@@B@@@@@B@@A@B@AB@@@B@@@ABB@A@@B@@A@ABAB@@A@@@@@@B@@@@AA@A@AA@@@ABA@A@AB@@B@@@@@@B@@A@@BB@@@@@@@@@@@@@@@@@B@ABA@@@@@@@A@@@AB@@@@@B@B@@@B@B@BA@@B@B@@@@@BA@AB@@@@B@B@@@@BB@AB@@B@@@B@@B@@B@@@BB@@@BA@B@@@@A@@@A@@B@@@@B@B@B@@@@BA@@B@@ABB@@B@@@@@@@@@@@BB@B@@@@@B@@@@@BCB@@@BB@@B@@B@@BB@@@@@B@@BB@@@AB@@@BB@DBAB@@AB@@@@BB@@@@B@@B@@@B@@B@@@@B@@@B@B@@@BBB@B@@@B@@@@@B@@@BB@@B@@@@BB@@@B@@ABBB@@@B@B@@@@@@BB@@A@B@B@@@@B@@@@@@@@@B@@@@@@@@@BBA@@@@BB@@ABA@@BB@@@A@@@B@@@@A@BBA@@@@B@@B@@B@@@@B@@@@@B@@A@@@BB@@@@@@A@@@@B@@@@@A@@A@@B@@@@B@@@@@@@@@@BBA@@@@@@@@@@@@@AB@@@@@B@B@@@@@@A@@@@@A@A@@B@@@@B@@@@@B@@@@@B@@AB@@@@B@@@@B@@@@@B@@@@@@@B@@@@@@BA@A@@B@@@@@@BBA@B@@BA@B@@BB@BB@BB@@@@@@BBDF@BB@DB@@@@@@BB@@BB@@A@@@B@@@B@@@@B@@@B@B@@@@@BD@@B@BB@@@B@B@@@B@@B@@B@B@@BBB@AB@@BB@B@BB@@@BB@@@@BB@@@B@BB@@B@@B@BDBB@@@@@B@B@@@@@@BB@@@@B@@BB@@B@@BB@B@BB@@B@@@@B@@B@@B@@BB@BB@@@@@@@BBA@B@@@@@@B@@AB@@@@B@@@AD@@B@@@@@B@AB@@@BB@@@@@B@@@@@@@@@@@A@@B@@@@@B@@BA@AB@@@@@@@B@A@@@@@@BA@@@@@@@@B@@@@@@@@@@BA@@@B@@@@@@@AB@@@@AB@@@@B@@@@@@@@@@B@@@@@B@@@@@@@A@@B@@A@@@B@AB@@B@@@B@@@BB@A@@@@@@@A@@@@@BB@@B@@@@@@AB@@@B@@A@@BB@@@@A@@B@@@BA@@@BAB@B@@@@@@BA@@BB@@@@@@@@@@AB@@@A@B@@AB@@@@@B@@AB@@@@B@B@@@@@BA@@BA@@BBB@BB@@B@@@B@B@@@@A@@BB@@B@@BB@B@BB@@@B@@A@@@@@@B@@B@B@B@@@B@@@B@@BBB@@@BA@@@@B@@A@@@@AA@@B@@@@A@BBA@@@@B@@B@@A@@B@B@@AB@@@@@@@@@B@@@@BA@@@@B@@A@@B@@A@@@@B@@@@@A@B@@B@@@B@@@@@BAB@@@B@AB@BAB@@@@AB@@@BB@BB@@B@@B@@@B@@B@B@@@@@B@@@B@@B@@B@@@BB@@@@B@@BB@@@@@@B@@@B@B@@B@@@B@@@B@@@B@@@@B@@@@BB@@@B@@@BB@@B@B@B@@@@@BB@@BDD@@@BB@@@@@BB@B@@B@BB@@@@@B@@B@AB@@ABA@A@@@@@@BB@@@B@B@BA@@B@B@@@BB@@@B@@B@B@@A@@B@@@BA@@@@@A@@B@@ABB@@@@@B@B@@B@@AB@@B@@@@@@B@BBB@@@B@@@BBA@BBB@@@B@BBB@BBB@@ABAB@@B@@@@BB@@B@BB@@@@@@BB@BB@BAB@@@BB@@B@@BD@@B@@@@B@@@@@B@BBB@@@BA@@@@B@D@B@B@@@B@BD@@@@BB@@@@@@B@@@@B@@@@B@B@@@@B@@@@ABB@@BA@AB@@B@B@ABB@@@B@@@@@B@@@@BB@@@B@B@@@@@B@@BBBD@@@B@@B@@@BBB@BAB@@@B@@B@@@BB@@@@@BB@@BB@@ABA@@BAB@A@@A@A@@AA@@@AB@BB@@@@@@@@B@@@@@@@@B@@@AB@@AB@@ABA@A@C@@@@BB@@@@@BDAB@@B@@@@@B@@@@@@DA@@BB@@@@B@@@@@@@@@B@@@@@@@BBBD@@@B@@B@BBBA@@B@@BBBD@@B@@@@@B@@@@ABB@@@BA@B@@@@B@BB@@BBD@@@@B@@@B@B@@BB@BB@@B@BBB@@@B@@BB@@B@@@B@@@@@BB@@B@ABA@@B@@@BB@ABB@@@@@@B@@@AA@@@BB@@@@@@B@ABB@@@BD@@@BA@@BBB@B@BB@@B@@@B@@BB@@B@BBBB@@@@@B@B@@B@BD@@@@@A@@@A@A@@B@B@BA@@@@B@BB@B@BB@@B@@@@@@@@@@@D@@DBBBBB@@@@BB@@@@B@B@@B@@@BBB@@B@@@@@B@@@@@@@@@@@@@@@@@@@@@@B@@B@B@@B@@B@B@BAB@@@B@@AB@@@B@@@BB@@B@DB@@@@B@@BB@B@B@@@B@@A@@BB@@@@@@DB@@BBBBBA@@B@DBDBB@@@@BBB@B@@@@@@AB@@AB@@@B@@@@A@@@@@BB@@BA@@@B@@D@@BB@@@B@@@@@B@A@B@@@@@@@@B@@@@@@@@@@BB@@@@A@@@@@@@A@@@BB@@@@A@@@A@@B@AA@@@@@B@@@@AA@@AAB@@@@AA@@@@@@@ACA@@@@@BB@@BBB@@@BBB@B@@@@@BB@@@@@@@BB@@B@@A@@@@B@B@@@@@B@@BB@@@B@B@@@B@@@@C@@BA@A@A@AC@@B@A@@A@@@@@A@@AB@@@@@@@@@BA@@@@A@@B@@@AA@@@@@@AB@@@@@@@@@@@@AA@@@@A@@B@@@@@@@A@@A@@@@BA@@@@@AA@@AA@@AA@@@@AAA@A@@A@@AB@@A@AB@B@@ADBF@B@BB@@D@@@B@@@@@BD@@B@@@B@@@DBB@B@@BBB@@B@@B@@B@@@@B@@@B@B@@@@@@B@@@BA@AB@B@B@@@@A@@@@B@AABA@@@@D@@AB@BB@@BA@@B@BC@@B@@@@@B@@AB@@@@@B@B@B@@@@@B@@@@@@AB@@@@AA@BA@A@@@A@@@@@A@AB@@AB@@@@@@A@A@@@A@AAA@AB@@A@@BAB@@A@@B@@A@@@@D@@@B@B@@A@@@AB@@A@@B@@A@@B@@ADB@@@B@B@@B@DB@@@BB@BB@@@@B@@B@@@BBB@@@BBB@@@B@B@@@@B@@@B@@@@@@A@@@@BA@@@AB@@@B@@@B@@@B@@BB@B@@B@@@BD@@@BBBB@@B@DB@@B@@BB@@@B@@@B@B@B@D@@A@@@@DAB@@@B@@@AB@@B@AB@B@@@B@B@D@@BB@BA@@@@B@@@@BB@@D@@BB@@@DBB@@@@BB@BD@@@B@@@B@@B@BAB@@AB@B@B@B@B@B@B@BAB@@@B@B@@@BBB@B@AA@@B@@@B@@ABABA@@@@BA@@BABA@@B@@@@@D@@AB@@@@A@@BA@@B@B@B@B@@A@@BA@A@@B@@@B@@A@@BA@@BC@AB@@@B@B@B@@@B@@@@ACCB@@@@@D@@@@@B@AA@@@AA@@@@A@@B@@@DAB@@@@@AC@AAA@AA@@A@@@@D@@@@A@@@@B@@B@@@@B@@@BA@AA@@@A@@@@@@@@A@A@@B@@A@@@@@AA@@A@@@@B@@@B@@BB@@@@C@@@ABAB@@@B@@@@@B@@B@B@@A@@@BB@@@@BA@@@@B@@B@@@@B@BBB@@@@@B@@@@A@@BA@@@@BA@@@A@@@A@@BA@@B@@@@ABA@A@@BA@A@@BA@@B@B@@A@@B@@A@@D@D@B@B@@@B@DB@@BB@BBB@@BBB@@A@@@@B@@ABA@AB@@@BAB@B@@@B@@@B@@A@@BAB@@@BBB@@@@@B@@AB@@@BA@@B@@@BBB@@A@@BA@@@AB@@AB@@@B@BA@@@A@@@A@@BABA@A@@@AB@@@B@@@D@@@B@@BB@@BBB@D@BBBB@@B@@B@@@@AB@@@B@@@B@BB@@@@BA@A@@B@BAB@B@@@@@@A@AAA@@AAB@@@@@B@BBDAB@@@D@DAB@@@@@B@B@@@@@@@BB@@B@BBB@B@@@BB@@@AB@@@@@BB@@BB@@@@@@@@BA@@@@@A@@@@@A@@A@@@@@@A@@@@@A@@B@@ABA@@@@@@@@@@@A@@A@@@@A@@@AB@@A@@@A@AB@BABA@@@@B@@A@@B@@ABAB@@@BB@@@@B@DA@@@@@ABAA@@@A@AA@@@A@A@@@AA@@@@@AA@A@@A@AAA@@@A@AA@@A@@A@@@@@A@A@@@A@@@A@@@A@@BA@A@A@@@A@@AA@A@A@ABB@@BA@BB@@@BA@A@@@A@@B@DAB@B@@@B@@@B@B@D@@@B@B@B@@@B@B@@ABA@@B@B@B@BA@AB@A@AAA@AA@@@AA@@C@@B@D@@BB@@@@@BA@@B@@@@@BA@@@@@@B@B@@@@@@@@@@AAA@@A@AA@@AA@@@@@A@@@AB@@@@@@A@@@A@@@C@ABA@@@A@AA@AC@@CA@A@@@AA@@@@@@ACAA@@@@@A@B@@@A@@@@@@A@@A@@@@@A@A@AAA@@@A@@@@A@@BA@@@A@@B@@@@@B@@@@@@@B@@A@@@@@@B@@@@@DB@@@AB@@@@A@@B@BA@@BAB@FBBB@@B@BBB@DA@BB@@AB@B@@@B@@@@@AAB@@@@@@@BB@@@@B@@@@@@@@@@BB@@A@@@A@@@A@@@@AA@A@A@A@@B@@@@AB@@@@@@AA@@A@@@ABAB@BABADAB@@@B@@@BBBADBB@@ABA@@@@@@@@B@B@D@B@B@@@B@@@B@@AB@@AA@@@BA@@@@@@B@@A@@@@@@BA@AB@@A@@BAB@@@@A@@BAB@B@@@B@@@BAB@@@B@B@B@DC@BBAB@@@B@@AB@@@AA@@@@@ABA@@@A@@@A@@BA@@BA@@AA@CB@@@@AAABAA@BAB@@A@@@A@A@@DABC@@@@BA@@@A@@@@@A@@A@@ABA@@@@@A@@@ADB@@@@BA@@@@@@@@@A@@@AA@@AB@@A@@@@B@@A@@@@@A@A@A@@@@@A@@@A@@@ABAA@@A@A@@@A@@@@A@AA@@@C@A@@A@@A@@AAA@@@@A@@@A@@@@BA@@@AB@@@@A@A@@@@@A@@B@@@B@@AB@@@@@B@@@B@@AB@BBD@BAB@@@@@BA@@BA@@@A@@@ABA@A@@@A@ABA@AB@@A@@@A@@AAABAA@@A@A@@AA@@@@A@A@@@@B@@@@@@@@A@@AA@@A@A@@A@@@@A@@@@@@@A@@@@@@@AB@@A@@@@@@@BA@@@@@@A@@@@@@@@A@@@@@A@@@@@@@A@@A@@@@@@@@@@@@A@@@A@@@@B@@@AA@B@A@@@@@B@@AA@@@@@@@@A@@@@@@@@@@A@@B@@A@@@@ABA@@@@AB@@@@@A@@@@A@@@@B@@@A@@@AA@@@@@@@@@@@A@@@@@@@A@@@@@AB@@@@A@@@@@@@@A@A@@@@@@@@@A@BBA@@B@@A@A@@A@@@@@@@@@@A@@@BB@@A@@A@@CB@D@@@@A@@@BB@B@@@@BB@@@@A@AAA@CA@AAB@@@@A@@@A@@BA@A@A@@@A@@@A@@A@@@@@AB@@AB@@B@@@@@@@@@AB@@@B@@@B@@@@A@@@@@A@@@@B@@@@@@@@@@A@@@@@@BB@@ABB@@@B@@@@@B@BC@@@B@B@@@@@@B@@@AE@@BAB@@@BAAAB@@A@A@@@AA@A@A@@A@@@@A@@@@AAA@@@@@@@@@@B@@@@A@@A@@AB@@A@@@A@@AA@A@@@@BB@@@@@AB@@ABB@@@B@@B@B@@@@@@@@@B@BA@@DA@@A@@@@@D@@@@@@B@@@@@@@@@A@A@@@A@@@A@B@@@@@@BB@@@@@@@@B@@@@@@@@@@@@@@B@@@ABB@@@@A@@@@@@@@@B@@@@A@@@@@B@@@@B@B@@@@A@@@AAAB@@@@@@@@@@AB@@@@@@@B@@@@BBB@B@@@@@AB@@A@@@@@@AA@A@@@A@@@ABA@ABA@@@A@@@A@@@A@@AA@@@@A@@@C@AA@@A@A@A@A@AA@@A@@AA@@A@@A@@AA@@@@@@@AA@@@@A@A@AA@@@@@@@AB@@A@@@A@@A@@A@A@ABABC@@@A@@@@@@B@B@B@@@BA@A@A@A@@@@@@@@@A@@@@DA@@@AB@@@@AA@AA@ACC@@@@C@A@I@A@CAC@EACA@@A@A@@@@@@@@@@@@@@@@@AB@@AB@@@@@@@@@B@@@@@@A@AA@@@@@A@@@@@@@@AA@@@AA@@@@@@@@@@AA@@@@@@@@@AA@@CAACCACADA@@@@@@@@@A@@@@@@@A@@A@@@@AB@BBB@@@@@@A@@@A@@@BB@B@@@@@@AB@BC@@@@B@B@@@B@@@@@@@D@D@@@B@@@@A@@@@@ABA@ABA@@B@B@BA@@DA@@@@B@@@B@@A@A@A@@AA@@AA@A@@A@@A@A@@@@BA@@@@B@@AB@BAB@@@B@@@B@@A@AAA@@BA@@AC@@AA@@AAAA@@AAAA@@@@@@AA@AA@@A@@EC@@@A@@AA@AA@@@@@CG@@@@@@@@AB@@@@A@A@@@@A@@@@A@@@@@@AB@@A@@ABA@@A@@@@@@@@A@@@@AA@@AA@@@@@A@@@A@@A@B@@A@@@@@@@A@@@@@@B@@@@A@@@@@@@A@@@A@@@@@A@@@AA@@@@AD@@@@A@@@A@@@@@A@B@@@AA@AB@@@@AA@@@A@@@A@A@@A@@BA@@@@@AABA@@@@@@A@AA@@A@@A@@@A@EA@@@@@BA@@@@B@B@@@@AB@@@BAB@D@@A@@B@@A@AAABA@@@A@@A@@BA@A@@C@@@@A@@@A@A@A@A@@@@BA@@@AB@B@B@B@@@BB@@@BB@@@BA@@B@@@@AA@@@@A@ABA@A@@@@AAA@A@AAE@@@@A@@B@B@B@@A@@@A@A@@B@@@@BB@@A@@AABA@A@@@@BB@@@BB@@@@ABA@@@A@@BBD@@AA@@@DE@@@A@@BA@@@@BA@@@A@A@A@A@@@A@@@A@@AA@@@@@ADA@@@@BB@@BABAA@@AA@@@@@A@@@AB@A@@@@@@AA@@@AA@AACA@@AAC@@@@B@@@@@BA@@@AAA@A@@@A@@AA@@@AA@@A@A@@@@@AB@@A@@@AB@@A@A@C@A@@@AA@@@@@@AA@@AA@@@ABABA@@@@@@A@@AA@A@@A@A@A@@@@AA@@@A@@@AD@@AB@@@@A@@B@@A@@@@@A@A@@B@@AB@@@@@@AAA@@@A@@@A@@AABA@@@A@@@@@A@A@A@@@AB@@@AA@@@AA@@AA@@@A@A@@A@@A@@@@A@AAA@@@C@@@A@@@@@AAA@A@A@AAA@A@AA@@@AA@CA@@AAA@@@AAA@@AAAA@@@A@A@A@A@ABA@A@@B@@@B@@@@@B@B@@@@@B@B@@@@@BA@B@@@@DAB@@@D@@@B@B@@@@C@@B@@A@A@@B@@AA@@AAAA@A@@@A@@@A@@@@@A@@@A@@@@A@A@@@A@@AA@A@A@@@A@@BA@@@A@@@@@AA@@@@@A@A@@@A@@@A@@B@@AA@@A@@A@@@@B@@@CA@@AAA@A@@@@@AA@@A@@@A@@@@@A@@@AB@BB@@D@@@@@B@DAB@@@@@@AB@@@@ABABA@@B@@A@@@A@@@@@@AA@A@@@A@A@AA@@A@@@A@@@@AA@@@A@@@AA@B@AA@@@AB@@@@A@@@@@CB@@@@@@AB@@A@@@@@AAA@A@A@@AA@@@@AA@@@AB@@@@A@A@@@A@@@ABA@@@A@@@@@@@AA@AAA@@@@@A@@@A@@AA@@@A@@@@CAA@A@@@A@@A@@A@@@@AA@@@AA@@@AB@AA@A@A@@A@@@@@A@@@C@AA@@A@@A@@BAB@AA@@A@@@BA@@AA@@@@A@A@@@@@A@@@@BA@AB@@@@@AA@@@@@A@@A@@A@@@ABA@@@A@@@@A@@@AC@@@@@AB@B@B@@C@@BA@@@AB@@A@@@DF@@@BB@@@CD"],encodeOffsets:[[123145,28639]]}},{type:"Feature",id:"331122",properties:{name:"缙云县",cp:[120.091572,28.659283],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@A@@BA@@@@@ABA@@@@BA@A@A@@@@BA@@BCDCD@@A@A@A@@@AAAB@@ABA@@@@@A@AAA@C@A@@@A@A@@@@@EBA@@BBB@B@B@@AB@BA@@BA@@B@@@@@B@@@@@B@@BB@BA@@@A@AB@@@B@BAB@@A@@@AA@BCBA@@BA@@@@@@@AB@@@B@B@BAB@B@@A@ABA@AB@@@BBB@BBBB@@B@B@@@@B@@@@B@B@B@@@B@@@BA@@B@@AB@@A@AB@B@@@@ABBB@@@B@@@@AB@@@B@@A@@BA@@@@@A@C@@@@@A@A@@@@@@@@@@AA@@@@@AAA@@@@@@@@@@@@@@@A@@AA@A@A@A@A@@@@A@@@@@AA@@@@A@@@A@@@@@AA@@A@A@@@AA@@@@A@@A@@@AA@@@@@@@A@@AA@@BA@@@@@@BAB@@@@@A@@@A@A@@A@@@A@@@@@A@@AA@@A@@AA@A@@@@@@@@@BB@@@B@@@B@BA@@@@@@AAAAA@@AA@@AA@@@A@@@AA@@@@A@@AA@@@A@@@@AA@@@@@A@@AA@@@@@BA@A@A@@@@@A@@A@@B@D@BA@A@@BA@A@@BA@@AA@@ABAA@@@@@A@A@@BB@@@A@@@A@@ABABA@@BA@@B@B@BA@A@@AA@@AA@@@C@@BAAA@@@B@AA@@A@@@AAA@AAC@@@A@A@@A@@@A@@AB@@@@A@@@@@@A@@B@@@@@@@A@AA@@AAB@@@AAA@ABAAA@@BA@@@@B@@@@@@@@@@AB@@@D@@B@@@@@@B@B@@CB@@@B@@@@AB@@@@@@A@@@@@@BB@@@@@A@@B@@A@BBA@@@@@@@@@@BA@@@@@@@@B@@@@AA@@@BA@@B@@@B@@A@@@@@@@@B@@@B@B@@@B@@@B@@@@A@@@@@@@@@@B@@@@@@@@@B@@@@@B@@@BAB@@@@@@AA@@@@A@A@@@A@@@@B@@A@@@@@AB@@@@B@@B@@A@@@A@@@A@@AA@@@A@AA@@AAAA@@@A@@@A@@A@A@@@ABABA@@@@@A@C@AA@@AB@@A@AB@BA@A@AB@@ABCB@@@B@@@B@@@B@@@@ABAAABA@@B@@@BA@@B@@A@@B@@A@@BA@@A@@AFA@@B@@@B@@A@@@AB@@@BB@@@@BB@@BB@@@@BBB@@@B@@B@BBBB@B@@@B@@@@AB@@B@@@B@@A@@@@@B@@@B@@@@B@B@@B@@CD@@A@A@A@@BA@@@@B@B@@@@A@@@@@A@@@@@@@@@@@@B@@@@A@A@@BB@@@@@@@B@@B@@A@AAA@@B@@BB@@A@@@@@@@@A@@A@@@A@@@@@@@@B@@AA@@@@A@C@@BA@@@@B@@@B@@A@AB@@@@A@A@@B@@BB@@@@@@@@A@@@@@B@BBB@@@@@BBB@B@@B@@@@A@A@ABCBAB@B@@@B@@B@@@BB@A@BB@B@@@@@BBB@@B@BC@AA@@@@@@@@@BB@BDB@@@ABA@@@AB@B@B@B@BA@AB@B@@A@@BB@@B@@@@@B@@@@B@@@@@AB@BA@@@AB@BA@@B@@@B@B@@@@@B@@@@@B@B@B@@@B@@@@@B@BA@@@@@A@@@A@@@@B@@AB@@A@@B@@A@@@A@@@@AA@AB@@CAA@@@AB@@@@A@@@AA@@@@@A@A@@@@@A@AA@@@AA@@@@@AA@A@@@A@@@@@@@A@@@AB@@AB@@AB@@A@C@ABCBA@@BA@@@@@@B@@@BB@@B@@@@AB@@@@@B@@@B@@A@BB@BA@ABA@@B@B@@@@BB@@@B@B@@@@@B@@@@BB@@ABAB@@B@DB@@@@BB@BB@@B@@@@CBA@@@A@@B@@@B@@ABA@@B@@@@@@@BA@@@ABA@@@C@A@@BAB@@@@A@AB@@@BA@BB@@A@BB@@@B@B@@@B@@@@@B@@B@AB@B@@@BAB@@BBA@@BAA@@A@A@@@B@@A@@@@@A@@@@@@@A@@@@A@@@@@@@AB@@@@@@@B@@@@@@AA@A@@A@BD@@ABA@@@A@@@@BAA@@@@AB@BA@@@@BA@@@A@@@AB@@@@A@AB@@A@@@AA@@A@A@@@@@@@@@CBA@@@A@@B@@@B@@@@B@@@@@B@BA@@@@@@@B@B@@@@@@B@@@@@BB@@A@@@@B@@A@BB@B@@B@B@@@A@@B@@@@B@@@@BB@@@@@AB@@BB@@@@ABA@ABA@@@@@BA@@A@@@ABAB@@A@@@@A@@@@@@A@A@@@@@B@B@@@@@@A@@@@@@A@@@A@@@@@A@@@@BB@@B@@@@BB@@B@@@@B@@@@ABAB@BBBABAAC@@B@@@@@B@@@@B@@@B@BB@BB@BBB@@@@B@BA@@@@B@B@@@@@B@@@B@@@B@@BB@@@BA@@B@@BB@D@@BB@@@@@BB@@BBBB@@@A@@BA@BBB@@@@B@@AAA@CB@@ABAB@@AAA@A@A@@@AAA@A@A@@@@AA@@A@@@@@@A@A@A@@B@@AA@A@@@@A@A@@@A@A@@@@@BA@@BC@@@@A@A@@@AAAA@B@BA@@B@@@@A@BAAAAA@@@@A@@@@B@@@@@@@BA@@@@@@@AA@@@@ABA@@BA@@@A@A@AB@@@B@@@@@B@@@@C@@@DDBB@BB@BD@@@B@@@BA@AB@@@B@@@@ABAB@@A@@@@@@@@BB@DB@@B@@BB@@BB@BB@@@@@@@BB@@DBBBB@BBB@@@A@@@AB@@@@A@@@@BB@@B@@@@@@@CC@@@@@@B@@@BABA@AB@AA@@BA@@B@@@B@@BBA@@@B@@@@BB@@BBB@BBB@BBB@B@@D@@B@@@@@BBB@@B@@B@B@@B@@BBB@@@@BABA@AB@@@@@BBB@B@BA@@BDBB@B@@@B@@BAB@@@B@@@@B@@@B@@A@@@B@@@B@BD@@BB@BBB@@@BBBB@@BBB@@B@@@B@@@B@B@@AB@@B@B@@B@@D@@BB@@@BB@@B@@@AAB@@@@@B@BA@@B@DCB@@@@@@@CD@B@@AB@@CB@@@B@@AB@@@B@B@@@@@B@@@@@B@@@B@@B@@@@B@@@BBB@B@@@B@@CBA@A@@@@B@@@@BB@@@@AB@@@@@AA@AA@@AB@@@AA@@B@B@BBB@@@@@@@@B@B@@@@B@@@@@ABB@@@AB@@@@@@BB@@@@@@@D@@A@@@@@@BB@@@@@@@AB@@@@@B@@@@@@AB@@@@@@@BA@@@@A@@@A@B@A@@AB@@@BA@@@@@@@@@@@@B@@@@@@@@@@B@@B@@@@@BA@@@@@A@@B@@@B@@@@A@@B@@A@@B@B@BAB@@@@@@BB@@@@@@@B@B@B@@ADAB@@@BAB@@A@@BABA@@@@BAD@B@@B@@B@@B@@BABBD@D@@@B@B@@@BA@@@A@@B@BAFA@B@BB@@@B@@B@@@BB@@D@@AB@@B@@@B@@B@@@B@@@BBB@@@@@@B@@@B@@AB@B@BBB@B@B@@@@B@@BBB@@@BA@A@@@AA@@A@@@@DA@ADADAB@B@BB@AB@@@BAB@B@@A@@B@@A@@BC@@@@B@B@B@@@BABA@@B@@@B@BA@@@@@@@AB@B@@@B@B@@BB@@@@@BABA@C@@@A@@@AAA@AA@@AA@@@@@A@@@@@@@@AB@@@@@@@@@@AA@@@@@A@@@B@@A@@B@@@BBB@B@@@B@@@@@DA@@@@B@@@D@@AB@@@B@@A@A@@@@@A@@B@@@BA@@B@@@B@@@BB@@@@BABA@@@AB@@@@A@A@@@ABA@A@@B@@ABBB@BB@@B@BBB@BBB@B@@@@@BBB@@A@@B@@@B@B@@@B@DB@BB@@@@@B@@@B@@@@@B@DDB@@BB@BB@@BB@AB@@@BBB@B@@@@@@@@BAD@BB@@B@@BAB@@@@AA@@@@@@@B@B@@BBB@@BB@B@@BB@@@@B@@@@@@@B@@BB@@B@@BB@@B@@@BB@BB@@@BA@@@@BAB@@@@@@@@B@@@BBB@BB@DD@@B@@@B@@@B@@@@A@@@@B@@@@@@@@B@@@BA@@@B@@@@@@@BB@@B@@B@@@@BBBA@@@@A@@@@@@ABA@@A@@@@@@AA@B@@A@@B@@@@@@@@@@@@B@@@@@@B@@@@@@@@@B@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@B@@B@@@@A@@B@@@@@@A@@A@@@@AA@@@@@@A@A@AAC@@@@BA@AB@BA@E@@BB@@@A@@@@B@@A@@@@BA@B@ABA@@@@@@@@@@BA@@@@@@@@@ABA@@@B@BBA@@B@@@B@@B@@BA@@B@B@@@B@@@@@@AB@BB@@@@@@@A@@@@@@@B@@@@B@@@B@@BBA@@BA@@@@@A@AAABA@@@@B@@@BA@@B@B@@@B@B@B@B@@B@AB@@@BA@@@AB@B@B@BA@@BB@@@B@@D@B@@@@@@@@@@A@@AB@@AA@@@@@A@@@@BB@@B@@@@@AAB@@@BB@@B@@@@@@@B@B@@A@@B@BA@@B@@@@B@@@BC@@BB@@@A@@@A@@@@@@@BB@@B@@@B@@@B@@@@@@@@@BB@@@@A@@@@@A@@@A@AB@@@@@@@@B@@@@@B@@@@@@@@@@@B@@ABB@@AB@@@@@@B@B@@A@@@@@BB@@@A@@@@@@BB@@B@@@B@@@@A@@@@@@BB@@@@@@B@@@@@@@B@@@@A@@@@@@@@@@B@@@BA@@@@@@@B@@@@A@@B@@@@@B@@@A@@@@AD@@@@@@B@@AB@BB@@@B@@B@@@@AD@@AB@@@BA@A@@B@@@D@@B@BBB@B@@@@@B@@@@@B@@BBA@@B@@B@@B@@B@@@@B@@A@@@AB@@@BABA@@B@B@B@@@B@@A@@BA@AB@B@@B@@BB@@D@@@BA@@@A@@@A@@B@B@BBBA@@@@@A@@@ABAB@@@@@B@@BBAB@@@B@@B@@B@@B@@@@@@@@B@B@@@@@BA@@@AB@@A@@B@@@B@@BBB@@@@B@@@@@@B@@@@B@@@AB@@@B@@@@AB@@@@@B@@B@@@@A@@@@B@@@@B@@B@BB@@@@@B@@@@@@@@@BA@@@A@@@@BB@@@B@@@@@B@@B@@@@BBBA@@@B@@@@B@@@B@@@@@@@B@@@@@BA@@@BB@@AB@@@BB@@B@@@@@@A@@A@@AF@@@B@@@B@@@@BA@@@B@B@BA@@@@B@@@@@@A@@B@@B@@@@@BA@@B@@@@A@@@@@@B@@@@@@@@B@B@@@@@B@@AB@@@BB@BB@@@@@@@B@B@@B@@@@@@@B@@@BB@@@@@@BBB@@@@B@@@@@@@@A@@@B@@@@AB@@@B@@@@@@BA@@B@@BA@@@@@@@@@@@@@B@@@@@B@@@@@@@B@A@@@B@@@@@AB@@@AAB@@@@AB@@@@AA@@@B@@@@@@AB@@@@AA@@@@AB@@A@@@@B@B@@A@@@A@ABA@@@@@@B@@A@@B@@@@@@DB@@@@@@BBB@AB@@@@A@A@@@@B@@@@@@@B@@@@@@@@@@@BAA@B@@@@@B@@@@D@@@@BB@@BA@@@@@B@@@@B@BB@B@@@@@BB@@@BB@@@@@@@BB@@@B@@@B@@@@B@BB@@@@@BA@@@@@@@@@@B@@@@@BB@@@@@BAB@@@@@B@@@@@@BA@A@B@@B@@@BA@@@@@@@@B@@@@BA@@@@B@@B@@@B@@BA@@B@@@@@@@@@@@@@@@@BB@AB@@A@@@A@@B@@AB@B@@@@BA@@@@@B@@@BB@@@B@@@B@@@@D@@B@DA@@@@@@@@@@ABA@@@AB@@@@@@@BB@@@@@@@BA@@@@@@B@@@BB@@@@B@@@AA@@BA@@BB@@@B@@B@@@@BBB@@B@@@@@@@@BA@@B@B@@@@@@@A@@@AB@@@@@B@@@A@@B@BAB@@@@BA@@@A@@@@@B@@@B@@@@BB@@@@@C@@BA@@@@@@B@@A@AA@@@@@@ABA@@@@@@B@AB@@@@@@B@@@@@BB@@A@@BB@@@@B@B@BA@@@@@BB@AB@@@@A@@@@@@@B@@@@AB@@@@@@@BB@BB@@@@@A@@B@@@ABB@@@@@@AB@@@@@@B@@@@@AB@@@@B@@B@@@BB@@@@@@@B@@@B@@BA@@@@BA@@@B@@@B@@@B@@B@B@@@@BB@@@BA@@@@@@AB@B@B@@BB@@BAB@@@@@B@@B@@A@@B@BAB@B@B@@DB@@@@@B@B@@@B@@DBD@BBD@@@@@@CB@@@D@@@B@@@@B@@AB@@@B@B@@@@DB@@BBB@@@BBB@@@B@@@@BB@@B@@@B@BB@A@@B@@@B@@AD@B@B@@@BAB@B@BBB@@BB@@@B@B@@B@@BABBBA@@B@@@B@B@B@@@BA@@B@B@B@B@DB@AB@@@B@@@@A@@BA@@B@@@@B@@@@BA@@@@B@@@@BB@@A@@B@@@@@BD@@BB@@@@B@@@BB@@@ADA@@@C@@@A@@@A@A@@AA@@@A@@BEB@@A@@@@@@B@@BB@@@B@@BB@@@BB@BDBB@@@B@B@BB@@@BB@@B@@@BD@BA@A@@BAB@@@B@@@@A@@@@AA@@B@@@@@BBB@@@@@@BB@BBB@@B@@B@BB@@@B@@@@AB@@@BBB@@@BB@B@@B@@@@@@B@@@@@B@@@B@@@@A@@BA@BBA@B@AB@@@@A@B@B@@@@@BA@BB@@@@BB@BBBBBB@BB@@BBA@@@@@A@AB@B@@A@@@A@@BA@@@@@A@@@ABA@@@A@@A@@A@@BA@@@AAA@@B@BAB@@@@@@A@@@A@@@AAA@A@@BAB@@@B@B@DBB@@@@BBA@@FBB@B@BB@AB@@@B@@@B@B@B@BA@BB@B@D@BBB@@@@@B@@@@AAA@@@@B@DB@@B@@BB@B@@@BA@@AA@@AA@A@AA@@@@A@@@A@@@@@@@AA@@@@@B@BAB@@@B@BA@@@ABA@@BB@AB@@A@@@C@@D@@@@AA@@AC@@@@@@@@A@@@@D@@B@@@@@@BB@@@@@A@@@C@@AA@@A@@@@@@A@@AA@@@A@@C@@A@@A@@A@AA@@AAA@@B@@A@@@@@@@A@@@@@A@@@A@@BAAA@C@A@A@AA@AAA@@@@@@@@B@@AA@@@AA@AA@@B@@@BAB@@@@B@@B@BA@A@@@A@@@A@@@@@AB@@B@@@@BB@@D@@@B@@@B@AA@@@@@A@@B@B@@@A@@@B@@@B@BA@@B@@ABC@A@A@A@@@@B@@@B@@AA@@A@@@@A@A@@@@AAAB@@AB@B@DAB@@@@@@C@@@@DAB@@@BAB@@@@@DA@@B@B@@@B@@ABAB@@@@A@A@@B@B@@@BA@A@@@AA@@@@AB@@@@AB@BAB@DABB@AB@@@BAD@B@@@BA@@A@@ABA@@@@@A@A@A@A@@@AB@B@@A@BB@@@@@B@@@B@@AA@@@BA@A@@DA@@B@@A@A@@B@@A@AB@@A@@@A@@@@@A@@B@@@B@B@@@B@BA@@B@BBBAB@B@@@B@BCB@BA@@B@@ABAB@B@@AB@BBB@@@B@@B@@B@@@B@@@@A@@B@@@@@BA@@BA@ADA@@B@B@B@@@@@@@@BB@@B@@B@@BB@@@@B@@@B@@@BB@BB@@@B@B@@BB@BABB@ABA@@B@@AB@@@@A@@@@@BBB@@@@B@B@@@@BB@@B@B@@@BD@@@B@BB@@@BD@@@@@@BB@@BB@@@@B@@@B@BA@@@@BA@@@@@A@@@@@A@@@A@@@A@@@A@A@@@A@@B@@@BA@@@@@A@@@@@@@@B@B@B@B@@BB@B@BBB@B@@@@DBDB@@BB@@AB@@A@@@AB@@@@AB@@@@A@@B@@A@@@A@@@@@ABAAAB@@A@CBA@@@A@ABA@AB@@A@A@A@@@@AAA@@@@AC@@@A@@@@@A@@@@AAA@@AA@A@@AAA@@A@A@@A@AA@@@@@ABA@@A@@A@C@@@A@@@A@@@@@A@A@@A@@@@AA@@A@@A@@@@AA@@@@AAAAAAA@AAC@@@@@A@@@@@@BA@@BADC@@@@AA@AA@@A@@AA@@A@@@@@A@@@A@@AA@AA@@BA@@@A@@AA@@@AB@@AA@@@AA@@AA@@A@@@AA@@@BA@@@A@@@C@A@@@A@@@ABA@@@AB@@AB@@AA@@A@@@AAA@@@@A@AACA@@AA@@AA@@AA@@A@AA@ACC@@AA@ACAA@@AA@@@AA@@A@A@@@AA@@@@A@@A@@A@A@@@A@@@A@@@@@@@ABAA@@@@@AA@C@@AA@@B@@A@@@@@@@A@A@@@@@A@AB@@@@@B@@B@A@A@@@A@CA@@A@A@AAA@@BABA@A@@A@@AA@@@A@@AC@@AAA@A@@AAA@@@AA@@ABAA@@A@C@@AA@@AA@@@A@AAA@A@@@A@@@AA@@ABA@@CA@AAA@@@@@@A@@@A@AAA@A@@@A@@@A@@@@@@BBBBB@B@@@B@@@@@B@@@@@B@@BB@@@@@@@@@B@@@@A@@B@@@@@@@@A@@@@@@@AA@@@@A@@@@@@@AAA@@@@B@@@@A@@@@@A@@B@@@@@@@@A@@@@@A@@@@B@@@@A@@BB@@@A@A@C@A@@AA@@BA@@A@@@AA@@AAA@@@A@@A@@@@A@@@A@C@AB@@ABA@@@AAA@AA@@AAA@@@@@B@@@@AAA@A@@@@@@@@A@@@@@AB@@AB@B@B@@@@A@@@@BA@@@@BA@@@@@@@@@BA@ABCA@C@@@@A@@@@@@@BA@@@A@@@@@B@@@@A@@B@@A@@@@@@AA@@@@AAAA@@A@@@@@B@@AB@@@@C@@@AB@@@BB@@@@@@@@@@BA@AB@@@A@A@@@@@@@@@@@@AAAAB@@A@@BB@@@AB@@ABAB@@AAA@@A@@@A@ABA@A@@A@@@@@@@@@@@A@@@@A@@@ABA@@@@A@A@B@@A@C@@@@B@@@BA@@BBB@@A@@@A@@A@@A@@@A@@@@A@@AA@@@@A@@@@AAAA@@@@B@@A@@AA@A@A@A@A@A@@@@@C@AD@B@@AB@@@@@@@@A@A@AB@@@@@@@@@@ABA@@B@B@@@@AB@@AB@B@@@A@@A@@AA@AB@@@@BB@@B@AB@@@BA@@AA@@@A@A@@B@@ABB@@@@@@@@B@@@@@B@@@@@@@B@@@@@D@@@BABAB@B@@@B@@@B@B@@@@@B@@@@@@@@@@@@A@@@@BA@A@AB@@@@BB@@@BAB@@@B@B@B@B@@A@@AA@@@AB@@@@C@A@AAA@@@@@@BA@@A@AA@@@@@@@@@AA@@@@B@@A@@@@@A@@@@@AB@@C@@@@@B@@AA@@@@@@@@@A@@@@@@@@AB@@BB@@@@A@@@@@@BA@@A@@@A@AA@@@@@A@@@A@@@@B@B@B@BA@@BA@@@@@@@@@AA@@@A@@@@ABA@@@A@@B@BA@@B@B@@@BA@@BBBA@@D@@@@@@A@@B@B@@@@A@@@ABB@@@A@@@A@A@@@AB@@@BB@@B@@@@@B@@A@@BA@AD@@@@ACA@@@AA@@A@@@@@A@A@A@@@AB@B@@A@@A@@AB@@@BB@@BA@@@@@A@@@@@A@@@@@@B@B@@@@BBABBD@B@@@@@AA@CB@B@@ABCBA@AA@@@AA@@@@@@@@@ACA@ABA@@B@@@@A@@BAB@@@@A@@@AA@AA@@B@BAAA@@BA@A@A@@@@AA@@A@@A@@@A@AA@AA@@@@@AA@A@@A@AA@@@@AA@AC@@@A@@A@AAAA@@@@BD@@@B@D@B@B@@@@@B@@A@@@A@@@A@CA@@AB@@@BA@@@AA@A@@@@@@A@AB@@@@@@BB@@A@@@@B@@BA@@@@@@@@@B@@AB@@@@EA@@@@A@AA@AA@@@AA@@A@A@@@ACA@@@A@A@AC@BAB@@@BA@@@A@@@AAA@A@@@@B@@A@@BA@@@A@@@@@A@@BAB@@A@@@@@A@AB@@AB@@@@A@@@@@AA@@AA@@AAA@AAC@@@ACCAA@@AB@@@AAA@@AAA@@@A@@BA@AB@@@BB@@BBB@BA@@@A@A@@@@@A@@BA@@@@@AA@@@AA@@AA@@@A@@A@@@B@@@A@@@@@@@@CAABA@@@A@@AA@@A@@@A@@@A@@@A@@BA@@@AB@@@@@BA@AA@@@BA@@B@@@@AAAA@@CA@@@@@A@@A@@AA@AA@AB@@@AAAAAAA@AACAAA@@AA@AA@@@@@A@@AA@@@@@AAA@@@ACA@@@A@@A@@B@@AAA@@@AAAB@@A@@@A@A@@@AA@@@@AB@BABC@@@AAA@@@A@@@A@@@AB@@AB@BA@@@A@@A@@@@A@A@@BA@@@@CC@AB@@AA@@AB@@@@@B@B@B@@@BA@@B@@A@@B@@@BA@AB@@@B@B@B@@@B@BBBB@B@@BB@B@@BB@@BB@B@B@@B@@@@B@@B@@B@B@@@B@@BD@B@@B@D@@@BAB@@A@@BC@@B@@AB@@@@@@@B@A@@A@ABA@AA@@A@@@@D@@@B@D@@@B@@@@@AA@@@@A@@A@@AAA@B@@@B@@BB@@@B@@@@@@@B@@@@@B@@B@A@@@@@@A@@A@@BA@@@@@@@@@@@@BB@@@@@@@@BA@@@@@@@@B@@B@@B@@A@@@@B@@@@@B@@@@@B@@@@@@@@@B@@@@@@@B@@A@A@@@A@@@@AAA@@A@@@@@A@@BC@@A@B@BABA@@@@BCB@@@@@BC@@@@B@FBD@BBB@B@DB@@B@@@B@DA@BBABBB@@@B@B@@@@BB@@@@@B@B@B@@@B@@B@@BA@@B@BABA@@@A@@@@@AB@B@@@B@@BB@BBBBB@B@@@BA@BB@BA@@B@@A@A@@BA@AB@B@@@@AA@@A@C@@@A@@BA@AB@@@BA@@@A@A@@@A@@@ABA@@@A@@@AAAAA@@@A@A@@@A@@A@BA@@@A@A@@@@@@@AA@@@AAB@@@@A@@@AB@@@@@@A@@@A@@@@AA@@@A@AAA@@BA@ABA@@A@A@@@A@@@@@@AA@@@@BB@@@AB@@@@AA@@A@@@@AA@@@@AA@@@A@@A@@@@AA@@@A@@A@@@@AA@@@@@AA@@@@@AA@@@BA@@@A@@@A@@A@@AB@@@@A@@@@BA@@@@BA@A@@D@BA@@@A@@BA@@@A@@B@@A@@@AB@B@@C@@@A@@@A@A@@@A@@@A@@@@AA@@@@BA@A@@@AB@@AA@AA@@@A@@@A@@AA@@@AA@@A@@A@B@A@@A@@@@@A@@@A@@@@@A@AA@@@@A@@@A@@@A@@@@@@@AC@A@@D@@A@A@@@@B@@@B@@A@A@@@AB@@@@AD@@@@@@ABA@@@@@@@@B@@ABBBBB@B@@@B@@@@@BA@@@A@@@@@A@@@A@@B@@@@AB@@@@@@A@@@A@@@A@@BA@@@@@@@@BA@@BA@@@A@@A@@A@A@AB@@AA@@@@A@@@ABAAA@@@ABA@@BA@@B@@@@@@AA@@A@@@@AA@@@@@AA@AA@@@@@A@@A@@@A@A@@@A@@A@@ABA@A@C@AA@@A@@@A@@B@@A@@B@@@@@BAA@@A@AA@@AA@A@AAA@@@A@A@@@@@AB@@@@@@@@AA@@AA@@@@A@@B@@AA@@A@A@AAA@@@AA@@@@@@A@A@@@@@A@CBC@@@A@CBAAA@@@@@A@BB@@BB@B@B@@@@A@A@ABA@@@@BAB@@@@AAA@@@A@@@A@@B@@A@@@@AA@AA@A@CAA@AA@@AA@@@C@@@A@@@A@@B@@@BABAB@@@B@@@B@@ABA@@@A@@BA@@B@@AB@@@BA@AA@@A@@@AB@@A@@BA@@@@@A@AA@@A@AB@@@BA@@@A@@@A@A@AB@@A@@BAB@BA@@@@@@BA@AA@@AA@AAA@@CAA@@@A@A@C@C@@@@BA@@@@BA@A@@@AB@@@BAB@@@BAB@B@@A@@@AB@@@B@@@B@@AB@@@@AB@@@B@@A@@@@@A@AAA@@@@@@AA@@@@@AB@@@@AA@@B@@@@A@AA@@@@@A@@@A@AB@B@@@D@@A@@AA@@@A@@@@@@BB@@B@@@@@BA@@@@B@B@@@@@@B@@@B@@BAB@@A@@@@@@AA@@@@@@B@@C@@@@@@BB@@BBB@BBD@@@@A@CB@@A@@@@B@@B@@B@@BBA@@@@@C@@@@@A@DD@B@@A@@@A@A@A@@@A@@BAD@@AB@@@BA@@@A@@@@BAB@@@BA@A@A@A@@@AB@@@B@@A@@BC@@@@@A@@@ABAB@@AB@@@@ABAB@BA@@@A@@@BBA@A@AA@@A@A@@@A@ABA@A@A@A@A@A@A@@BA@ABA@@@@A@@@A@@ACA@@A@@A@CA@@A@@AC@@@AA@@@@@A@@B@@AAA@@@C@A@A@@@ABAA@@@BA@@A@@@A@CB@@@@@BC@A@A@A@@@A@@@A@@AA@@@CAA@@@AAAA@@C@@A@@@AA@A@@AA@@@A@@@A@@@A@@B@@AB@@@@@B@@@@A@@@A@@@@@@A@A@@AA@A@@AA@A@@@AA@@@@@AAA@@A@@CAA@@@@A@A@@CA@BA@@@@BA@@@@BA@@B@@@BA@A@@@C@@@@@@BA@@@@BA@AB@@@BA@@BBB@B@B@@@B@B@@@@A@@BA@@B@B@@@@@B@@@BABB@@B@@A@@B@@@@A@@@@@A@A@A@@@@@A@@BA@@@@@A@@@ADA@@@AB@@AAA@@BC@@@@@ABBBA@@@@@@BB@@BB@@@B@@BBBBR@B@@@B@@@@BBD@@@H@B@BBDB@@B@@B@@A@@@@BB@@B@@@@@@@BA@BBA@@@@BD@@@@@B@@@@@@@@@B@@@B@@A@@B@@B@@@B@@@BGHBBDBBB@B@@@BB@@@@B@@@@B@@@AB@@@B@B@@@B@B@@B@@BB@@B@@BB@@BB@@B@@BAB@@BB@@@B@B@@@@@@HB"],encodeOffsets:[[123032,29203]]}},{type:"Feature",id:"331123",properties:{name:"遂昌县",cp:[119.276103,28.592148],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@BC@@@@A@@A@@@AB@@@@A@@@A@@B@@A@@@AB@B@BA@@BBB@@@BA@@@BD@B@@B@@B@@@@@@@DBBAB@@@B@BB@@B@BABA@@@@@@@ABA@A@A@AA@BA@AB@@@BA@@@@AAA@A@AAA@@AA@@AA@A@@B@@A@A@@@AA@@@@BA@@AA@B@@@A@@@@@@@A@A@@@@@A@A@@AA@@@A@@@A@A@@AA@@@@AAAA@A@@@AA@@@A@AAA@@@@@@@@A@C@@AA@AA@@@@@@BA@@@@@A@A@@@CB@@@@A@@@@A@A@@A@@@@@@A@@AB@D@@AAAA@A@@@@A@@@AB@@AB@@@B@@ABCB@AAAAAAA@AA@A@@@A@@BC@@@CA@@@@ABA@@@ABA@@BAB@@A@@BA@AB@BA@@@@@A@@@@B@@C@AA@@ABA@@BA@@@AB@@@@@@@B@@A@@@AB@@A@CB@BB@@BB@@D@B@@@@@BB@@B@@A@@B@@@B@B@@A@@B@@@@@B@@AB@@@B@@A@@B@@@B@B@@ABAB@@@BAB@@AB@B@@@B@@@B@@@@@@@@A@@@@DA@@B@@A@AB@B@B@@@B@@A@BBA@@BAB@D@@A@@@@@BB@@@B@@A@@B@BABA@@BB@B@B@BAB@@AB@@BAB@BA@ABA@@@AB@@A@A@@AA@@@A@@A@@A@A@@@EAA@A@A@A@@@AB@@A@@@C@AA@@A@@@ABA@@B@@CBA@@B@@A@@A@@A@AA@@@A@@C@A@AA@A@@AA@@AA@@AC@@A@A@@AC@C@AA@BA@A@@B@@@@@BAB@@AB@BAB@@@B@@@B@D@@@B@@AB@@ABA@@@BD@B@@BB@@BDB@FD@BBBB@@B@@@BA@@B@B@B@@@@@B@@AD@@@BA@@@A@@@@A@AAA@@@@AB@@A@@@A@@BA@@BAB@@@BA@@@A@@@@BABEDAB@BA@@B@@@@@B@@@@@B@@@@BB@@@B@@A@@B@@A@AB@@@@A@@B@BA@@@@B@B@@AB@B@@@@BB@BABAB@B@B@B@@A@@@ABA@@@A@@BCA@@A@@@AAA@AA@@@@AA@AA@AC@@@@AA@A@@@@A@A@@AAA@@AA@@CA@@BAAA@A@AA@@@@@@@A@@B@@@@A@@@A@A@ABA@@@A@C@@A@@@@@A@@AA@@BA@@@CA@@AAB@@@A@@A@@@A@AB@@A@@@AA@A@@@AA@@A@@AB@@AA@@AAA@A@AAA@@AA@C@A@@@AA@@A@A@@A@@@A@@@@@AAA@@@A@@AA@@@@@AA@A@@@@A@A@@AA@@@AA@@@@A@@@A@AB@@A@AAA@AB@@@AA@@BA@A@@@A@@BA@ABAA@AAA@@@@@A@@@A@A@@AA@@AAB@@@@AB@@A@A@A@@@A@@@A@AB@@A@CAC@@@A@@@A@AB@AA@A@C@@@@@A@@@AA@@@C@@@AAAA@@@@A@@A@@@AA@@@@AA@@BAAA@@@@@@AA@@@A@@CAAC@@B@AA@@@AA@@@AAA@@@A@AB@AAB@@CBABCB@@@AA@A@@@A@@@A@@@ABA@AB@@@@AB@@AAA@A@@@CBGJIJGFAB@J@FAFA@KDG@CB@D@B@D@BBHBFBD@B@F@DCFGJCBABCBC@CBC@A@A@ACA@CAA@@@ABA@A@A@C@AA@@@BB@@B@@A@@B@B@@ABA@AD@@A@@B@@@BA@@@@BBB@@B@@@@A@@@AB@@@BABA@A@@B@@@@B@@@BB@@B@@@B@@@B@BBB@@AB@@@B@B@B@@@BBBB@@@@@BBB@@B@@B@@B@@B@@B@@@@BB@B@@B@@AB@@@B@@AB@BB@@@BBB@@BB@@BBB@@B@@B@@@@B@@@@BA@@@@B@@@@@BAB@@@BBB@@@@BB@@@@B@BBB@@@@@BB@BB@B@@@@@B@@B@@@B@D@@@@B@@@BBD@@@B@@B@@B@@@@B@@@@B@@B@BD@@BB@@@@BBA@@@BB@@@@@@@BB@@@@@@B@BB@@BB@@@@B@B@@@BB@@@A@@B@@@BAB@@A@@B@@ABABBBB@BB@@@BB@@B@@AB@@@@BBBB@@BB@@BB@@@@@B@@BB@BBBBB@BBB@@AB@@A@@@@@BB@@BB@@@DBB@@@@@@B@@B@@BB@@B@@@B@@BBBB@@@@@B@@BBB@B@B@B@@AB@@A@@@A@@@@@@BB@@@@B@@@@AB@@@BB@AB@@BB@BA@@@@@@@@@@@A@@@A@@@@@A@@B@@@@@@@BAA@@A@@@AA@@BBBB@BB@B@BB@@BB@@@BBB@@@B@@@@A@A@@@E@@@A@A@AB@A@@A@@AA@A@A@C@A@A@@@AAA@@@AB@@@@A@@BA@A@@@@@@A@@@A@@AAAA@@AAA@A@@@@@A@@@A@@@A@@@A@@@@@A@@@ABA@@@@@@B@@@B@@@BBB@BBB@@@BABBD@B@@@B@@@B@@B@@BB@@@@@B@@@BB@BB@@B@@@@A@ABA@ABA@@@@B@BA@@@B@@B@@@B@@@@BB@@BB@B@@@@BB@BB@@B@@BB@@@D@@BB@B@BA@@BBB@@@BBBABA@@BB@@B@D@BB@@@@BA@@BA@A@@B@@A@ABAB@B@@@B@@@B@@@@@B@@@B@@@@@B@@@@@D@@@B@@@BBB@@@@@B@BB@@@BB@@@B@@@B@@@@BBBD@@@B@@A@AB@@@B@@@BABBBA@@B@BA@@@@@A@A@AD@@@B@B@@@@@B@@@B@@BB@@@BB@@B@B@B@@BB@@@@B@@@F@@@B@@@B@@BB@@@@DBDB@@B@@DBBB@@B@@@BB@@FBBB@@@BBB@BB@@@@@@@@B@BB@BDA@BBB@@B@BB@@B@@@B@@BD@@@@BB@@B@@@@@D@@@D@@@BAB@DA@@B@@@B@B@@@B@@BB@DABCBAB@BB@@@AB@@ABAB@@@@@B@B@B@@@BB@@@BBB@@@BB@@B@B@B@@@@BB@@@B@@B@@BB@@@BB@@BBB@@BB@D@@@@@D@BBBB@D@@B@B@@@@@BBB@BA@@@BB@@@@@@@B@BAB@BA@@B@@@B@@@@AB@@@@@B@@B@B@@BBD@@@BB@@@BBBB@@B@@@BBBA@B@@B@@@BD@B@@@BB@@B@B@BB@@@B@BB@BBB@B@B@B@BA@@BAB@D@@AB@@AD@B@@A@@BC@@BA@@B@BA@@B@@@B@BBD@BBB@B@B@B@@@@@@@B@B@@@B@@A@@BA@@@@@A@@@A@@@A@@@A@@@@BA@@BA@C@A@@BA@AAA@AB@B@@@@@@BBD@ABBB@BA@B@B@@B@@A@@B@@@@@@@@@B@@@D@@A@@B@@AB@@A@A@A@@AA@@@AB@@A@@AC@A@@BA@ABA@ABA@A@@@@B@BB@BB@BBB@BB@@@B@@BB@@@BB@B@@@BB@@@B@BBBBB@B@@@BBB@BBD@@B@D@@BB@B@@@BBB@@B@@B@B@@B@@@D@BAB@B@@B@@@@@BB@@BB@@D@@@B@BB@@@@B@@@@@B@@BB@BB@@BB@B@B@@@B@@@BB@B@@@@B@BB@@@BBA@@B@@@B@@BD@BB@@BB@DBBB@@B@@BB@@BBB@B@B@@@@@BBB@@BB@B@@ABAB@@CBABA@@@@BA@C@@@@BB@@B@@B@@@B@@B@@@@BB@@@B@@AB@B@@@B@B@@@@BB@@@@@@AB@@B@AB@@@@@B@@@@BB@@A@@B@@@B@B@B@BB@BB@B@@@@AB@@@@@BA@@BB@@BB@B@B@B@B@BBB@@BBB@B@@A@@D@B@B@@@B@B@B@@@B@BA@@BAB@BB@@@BBB@BBBB@BB@@BB@BB@@BB@@@BB@@@@BB@@@@@BB@B@B@@@@@B@@@BAB@@@B@@@@AB@@@BA@BB@@@@@B@@@B@@B@@@@BBBBBBB@@@@BB@@@B@@@@B@@BB@@@BBB@B@BB@@B@@@BB@@@@BBB@BBB@@@D@@AB@@@BAB@@@BBBB@@B@@@B@@@BB@@B@@B@@B@@B@@@@BA@@B@B@@@B@@@@A@@B@@A@@B@BAB@@CBA@@@@@@BB@@@BBB@@@BB@BB@@B@BB@B@@@BBBB@BB@@@@@B@@BBBB@@B@@@BB@@@BB@@@@BBB@@@@BB@@@BB@@@@B@@B@@@@B@@B@@@BB@@B@B@@@BB@BB@@B@B@@@B@@B@@@@@B@@B@BB@BD@B@@@@B@@@@BBB@B@B@@BB@@B@@BB@@BB@@B@@B@@@B@@@B@@@B@@BB@BDBB@D@BBABB@BB@@@@B@@BBBBB@@A@@DDA@B@DBB@B@@@@@@BA@@@BB@@B@@@@B@B@@@B@@BB@@@BB@@@BB@@@BB@@@B@@@BB@@@@@@B@BBB@BB@B@@@@@B@@@@@B@@B@@AB@BB@@@@B@@B@@@@B@B@@@@@@@B@D@B@@@@@B@@@BAB@BB@@DD@@B@B@BBB@BB@@@@@B@@@B@@@BB@@B@@@B@@@BB@@BB@@@ABA@@BB@BBB@@@B@@@D@@@@B@@BB@B@@@@@B@@@B@@@@@@B@@BB@@@@AD@@@B@@B@@@@B@@@B@@B@BB@BB@@DD@@B@@@@@B@B@@@B@B@BBD@@@B@B@@BB@B@BB@BB@BB@@BD@@B@@@BBB@@AB@@@B@@@@A@AB@@AB@@@B@@C@@B@@@@@B@@B@@D@@BB@@B@@B@B@@@@B@@@B@BBBBBB@@@@BB@@B@B@B@@@@BB@@BA@A@@@@BA@@B@@A@A@@B@D@D@@AB@DABAB@D@@@@@@@B@DA@@B@BAB@@A@@B@@@@@B@@BBBB@@@BAB@@@@@@AB@@@B@B@@@D@@@@@B@B@B@@B@@BAB@@@@@B@@@@@@@B@@BDAB@@@BB@@BB@@B@@@B@@@@@B@@B@@B@@@B@@@B@@@B@@@B@@@@B@@BBB@BB@@B@@@@@B@B@@@AB@@D@@@A@@@@@B@@B@@@@@A@@@@@@@B@@B@@@@@BB@@@@@BDB@@@@B@@@BB@@BA@@@@BA@@B@BA@@AB@B@@@B@@BB@@@@@B@@@B@@@B@@@@@@BABAB@@@BA@@@A@@@@B@@B@@@@B@@B@@AB@@@@@@@@ABB@@@@@ABB@@@@@@@@@@@B@BB@@BAB@BBB@AB@@@@@B@@@@BB@@@B@@@B@BAB@B@@@BB@@@D@B@B@B@@@@B@@BBB@@@@B@@@BB@@@@B@BB@@@B@B@BB@B@B@B@@@B@@BBB@@@@@D@@@B@@BB@@D@@B@BAB@D@@@B@@AB@DA@@B@B@@A@A@AA@@A@@AC@C@@B@B@@@B@@@@B@@BDB@@@AA@A@@@@BA@A@@BBB@@@@B@@@@ABBB@@AB@B@@@@B@@A@@@@B@@BB@@@@AB@@@@@B@BBB@@@BAB@DGDCB@@@@@B@@@B@BAD@B@@@B@@@DABB@AB@@@DC@@B@B@@@D@B@B@@@B@BAB@@@@BBB@@BB@@@@B@B@BA@BB@@@@BB@@B@@A@@B@@@B@B@@B@@B@@@@BBBB@BB@B@@B@@@B@@@@BB@@@B@@A@@B@@BB@@@@B@B@@@BBB@@@B@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@A@B@@@@@@B@@@AB@@@BA@@@B@@A@@@@@B@@@B@@@@@@@@@@@@B@@B@@@BB@@A@@@AA@B@@@@@@D@@@BBB@@BA@A@@@@A@@C@@@@@A@@B@@D@@BB@@@BB@@CACA@@@@A@@@@B@@@@@@@@@@@@@@@@@B@@B@@@BBDB@DBB@@@B@@B@@@DA@@B@BAB@BB@@@@BB@@@@B@@B@@@BBB@@@@@B@@@@A@@B@B@@AB@BA@@@AB@@@@@@@B@@C@@@A@@AA@@A@@@@@A@@@@A@@@A@ABBD@BB@@@B@B@B@@BBB@@BABAB@BAB@@AB@@@BBB@@@B@@BB@@B@@BBB@BA@@B@BBB@B@B@@@B@B@@@BAB@B@D@BA@@BA@@B@@@@@B@@@@A@@BBB@B@@@@@BB@@BB@B@@@@@@@BB@@@@@B@@@@@B@@BBB@@B@BB@B@@B@@@@@BB@@BA@@D@B@B@B@@A@@BA@A@@@ABA@@@A@@@A@A@@@@BAAA@@@A@@AA@A@@@@B@BAB@@@B@B@@A@@@AAA@@BA@AA@@@@A@A@A@@@@@A@@B@@@@A@@@@@AB@@@@@@@@@@AB@@@@@@A@@@@C@@@@@@@@@@@B@A@@@B@@@B@@A@@@A@@@A@@@@@@AA@@@@@@BCB@@@@@@@@@C@A@@@@@A@@@DAB@@@@@B@B@BB@@BA@@BABA@@@AB@B@@@@ABABA@@BA@@@A@A@A@A@@@A@@@A@A@@@@@@BA@A@@B@@ABA@@@@BA@@@A@@BA@@@@@@@@B@B@@@B@@@BA@@B@@@F@BABB@@@B@@@@@@B@B@BB@@@BB@@@B@DD@BB@@B@@B@AB@@@B@@@B@@@B@B@@@BB@@@B@@BB@BB@@@@B@B@@BB@B@@@B@@@@B@BB@@@BB@B@@@B@B@@@@@BB@@D@B@@@B@B@@B@@B@@B@@@B@B@@@BBB@B@@@B@@@B@BB@AB@@@B@@@B@B@@@B@BB@AB@B@@@@@@A@A@@B@@@@@@@@@BA@@B@@AB@@@B@@A@@@@@A@@B@BA@AAA@A@@B@@AB@@@B@B@@@@@BA@@@AB@@A@@@A@@B@@@@ABA@@BA@@BA@A@@B@@@@AB@@A@@@@@@B@B@@@B@B@@@@AB@@@B@@@B@@@BA@@AAB@@A@@@@@AA@@@@ABA@@@A@@@@A@@@@@BA@@@A@@B@@A@@@@@@AA@@BA@A@@BA@@B@B@@AB@B@@@@@B@B@@@B@@@@@@BBBBB@@@AB@@@BBB@BBBDB@BBB@BB@@B@@A@@DBBABBB@@AB@B@BB@@@@B@@@B@@@B@@B@@BB@@@@DB@@B@@@@@@@BBBAD@@@BBB@@@@@B@@@B@BB@@@@@@@@BB@B@@@A@A@@B@BBBABD@@B@@BBB@B@@B@@@@@B@B@@@@A@@BAB@B@B@B@@@BA@A@@@A@A@@@AA@@@@ABAA@@@@@AA@BAA@@B@@@@@@A@AAA@@@@B@@AB@@@BA@A@@AABA@@@A@@@@@AB@@A@@@@@AB@AAA@AAA@@@CB@@A@@A@@@@@A@@@A@@@@@@BA@@@@A@AB@@AB@@A@A@@@A@C@@@A@A@@@@AB@@A@@@A@AB@@@BA@A@A@@@A@A@C@@@@@A@@@@AA@ABA@A@A@@B@@A@A@@A@B@@A@A@@@CA@AC@@@@A@@AAA@@AA@@@AB@@@B@@@@@B@@@@@B@@@B@@@@A@@@ABA@AB@@@AA@@B@@A@@@A@@@A@@@A@@A@@A@@@@AA@A@@@@@A@@@A@@@@@@@A@@A@@AB@A@BA@@@@@@AA@@B@@A@@A@@@B@@A@@@@@A@@@@@@@AA@@A@@A@@A@@AA@A@@@@@@AC@@@@AAB@@AB@@@@@@@@AA@@AAA@AB@@@@A@@@A@A@@@@B@@C@ABA@@@@@@@ABA@@@AAA@@A@@@@@BA@@@@@@@A@AB@@@BA@@@@@@BBB@@@BB@@@AB@@@B@@@BA@@B@@AB@@@B@B@@@D@@@@@BABA@AB@@@@AB@@@B@@AACAA@@A@@@@AAAA@A@@A@@A@@@@AA@@@A@@@C@@@@@C@@@A@@@A@@BCA@BAAB@A@A@@AA@AA@@A@@B@@A@@@AA@@A@@@AA@@@@@@@A@@@@@A@A@ABA@AB@@@B@@A@@BA@@B@AC@ABBB@BBB@BBBAB@@B@@B@B@@B@@BBBB@@@B@@@B@@@@BB@@@@@BB@B@@@@BD@@BB@BAB@@@B@BA@@@@@A@@B@B@@@@B@BB@BB@BB@@@@BB@@@B@@@@@B@@@BA@@B@@AB@BA@B@AB@B@@@@@B@@BB@@B@@B@BABA@@@A@@@@BA@@AA@ABA@@@A@@@@AAAAA@AA@@A@A@A@AA@@@A@ACA@@@@@@A@@@@AA@@@@A@@A@@A@@@@@A@@@@@A@@@@@A@@AA@@@A@@@@@A@@B@@AB@@@BA@@@@B@@@@A@AB@BA@@@@B@@@@BB@@BB@@@ABB@@B@@A@@@B@@BA@@B@@B@@BB@@@B@@BB@BB@@B@@@B@@@@@B@@B@@D@@BB@@@@@B@@B@B@@B@BA@AB@@@@@@BB@@@B@B@@BB@B@B@B@B@@BB@B@@BB@B@@@BBBA@@A@AA@@@@@@AA@@@@@A@A@@A@A@@A@@@@AA@AA@@@A@AA@@B@@@AAA@@A@@@@A@A@A@@A@@ABAA@@A@@A@@@@@@AA@A@@B@BBB@@@B@@ABA@@A@@@@AA@C@@@@A@@@AA@@@@@A@@B@@A@@@@@CA@@C@A@@A@@@@@@B@@@BA@@@@B@@AD@@@@BD@@@DBB@@AB@BA@A@@@ABA@AA@@@@AAE@AA@@A@@B@BAB@B@@@@A@@@@@@@@BB@@BB@@@@BBBB@B@@B@@@@@@A@A@A@A@@B@@A@@AAA@@@AA@@@AA@AA@@AA@@A@@AA@@@A@@@@A@@@AAA@@AA@A@@@@@AAA@@A@AA@@@@AC@@@@A@@A@@AA@AA@A@@@@A@A@@A@@A@AAA@A@A@@@@A@@@@A@A@@@A@AB@@@@AA@@@@AB@BBBA@@@A@@@@AB@A@@@@@@@@@@A@@@@@@@@A@@B@@@AA@@@@A@@@@@@@A@B@@A@@@A@AAB@AA@AA@AA@@@@@@A@@A@@@@@@@@A@@@@@AA@BA@@@@@@@@BA@@B@@@@A@@@@B@@A@@@@@@@A@@@@@@@ABA@@@@@AA@@@@@A@@@A@@A@@AB@A@@D@CA@@A@@@@@@@@@AA@@@AA@@BAAA@@@ABA@A@ABA@@A@@@@@@C@@@A@@B@@@@AB@@@@@B@@A@@@A@@@AAA@A@@@@@@@@@@@A@@B@@A@@A@@@@A@@@@@AB@@@AA@@@A@@@AB@@AA@@ABA@@@A@@@@@A@@BAA@BA@@@AA@@A@@@@@A@@@A@@@@@A@A@@BA@@A@@AB@@A@@@@@A@@A@@A@@AA@@@@BA@@@BB@@@@BB@@@B@@B@@@B@@@@B@@BB@@B@@B@@@@@@@@@BB@@@@B@@@@@@@B@@@B@@@@B@@@@@B@@@@B@@@@@@@B@@@B@@B@@@@B@@@@@@@D@@@B@@BB@B@@@@@B@@@B@B@@@BBB@@@@B@B@BB@BB@@D@B@BB@@B@@@@A@A@@@@B@@A@@@@A@AA@@B@B@@@BA@@B@@@@A@@@@@AB@@@@B@@B@B@@A@@@AA@B@@AB@@A@AA@@@@@AA@@@AA@@@@@@@BA@@BA@@B@@@@A@@@A@ABABA@@@@@@E@AA@@@@C@@@AA@AB@@A@@@@@AAA@A@A@@@AD@@@ABA@@AA@@@@@@A@@@AA@@@@AB@@A@@BAB@@A@A@@@AB@@@BA@@@ABAA@@AB@@ABA@@@@A@@@A@@AAA@CBA@@@@@A@@B@BA@@BA@@@AB@@@@@@@AA@@@AA@A@AA@@@@@@A@@@A@@BBB@@BB@@@@@BBAB@@B@@A@A@@A@@@AA@@@@A@@@@AA@B@@AB@@@@@@@@@A@@BA@C@@@AB@AA@A@@A@@@@A@@@AB@@@@A@@@@@A@@A@@@A@@@@@A@@A@@@@BA@@@A@@B@BA@@@@B@@@B@@@B@@@B@@@DBD@@AB@@@B@@@BA@@B@@A@@BA@@@A@@@@@A@@BA@ABA@@@AB@@@@A@@@@@@@A@A@A@@@@A@A@@@@A@@@@@A@@@A@@@@@A@@@@@ABA@AACA@@AA@A@A@A@@@A@@@@AAA@@A@@CAA@@@A@AA@@@@A@@A@@@@A@@@A@A@@AA@@@A@A@@@@@A@@AA@@@AAA@@AC@AAA@AA@@A@ABA@@B@@A@ABAA@@@A@@@A@AAAACAC@A@@@A@@C@@B@@@B@@@B@@@BA@@@A@A@A@@@@BA@@@A@@@@@@@B@@A@@B@@C@@@A@@BA@A@@A@@A@@@@A@@@@@ACA@@A@@@@A@@@@B@@@@@@@B@@@@B@@B@@A@@A@@A@@BA@@@@A@@@@A@@@BB@@@@A@@AAAAA@@@AAC@@@AB@@AAAAA@@AAA@@A@A@@A@AA@A@@@AA@@A@@A@@A@@A@CA@@@A@A@@AA@@@A@@@AA@A@ACA@@A@@AB@@@A@@@A@A@@A@@@IE@A@@BA@@B@@A@A@@@@@@@@@A@@B@@A@@@@A@@@@@@@AB@@@@AB@@@@@A@@AAA@@AB@AC@@@@@B@@AB@@@@EBA@@@@@A@CBA@A@A@@@A@@@@A@A@A@@A@@@A@@@@@@A@@AA@A@@@@B@DA@AB@BA@@@@@A@@B@@A@@@AA@AA@@@@@A@@AA@@@@AB@@@@A@@BA@@@@@A@@A@@AA@@@A@@@@@AAA@@BAB@@A@@@@@A@@@@@A@@@@A@@C@@@@ABAB@@@@A@@@@@@@@@A@@BA@@@@B@@A@@BA@@@@@@A@@@@@@@@@@A@@BA@@B@@CB@@@@A@@BA@A@@A@@@@B@@@@@BA@@@@B@@@@@@AA@@@@@@@@@BA@@@@@@@@@A@ABAAA@A@@BA@A@@@@@AA@@@@@@@@AA@@A@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@AA@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@A@@@@@@@@@@@@@BA@@@A@@BA@@AA@@BA@@@C@@@ABAB@@B@@B@@AB@@@BA@@@A@@@AA@@A@@@AA@A@@A@@A@@A@@@A@@@A@@@ABA@@@@@@@@@@@@@A@@@C@@A@@A@A@A@A@A@A@@@@A@@AA@@@@@AAA@A@@B@@AB@@@@A@@AA@AA@@AAAAA@BAA@@@@AEAC@@@A@@@C@@@A@@@@@AA@@AA@@@@AA@@AAA@@@A@A@@AABA@@@AD@@AB@@@@C@A@A@@@@@AA@@@A@@@@AAAA@@A@@AA@@@AAA@@@@@A@@@A@@AA@@@@A@@@@@@A@@@@@A@@@AAA@@@A@ABA@@AA@@BA@AAA@@@@@C@@B@@AA@@@BA@A@@@A@A@@@C@@@AB@@A@A@@@@@@@@@A@@@A@@@@@A@A@@B@@@@A@A@@@A@@@@@@@@A@@@AA@@@@A@AA@@AAA@AA@@A@CC@@@AA@@A@@ACA@@@AA@@@@@A@@@ABA@AB@DC@@@ABA@@B@BA@@B@@@BA@A@@@A@@@@AAA@@A@@A@@@@AA@@@@A@@@AAA@A@@@A@@@AA@@A@@@A@@@@@AA@@@A@A@@AA@@@@A@AAA@A@A@@@A@@@@A@A@@@AA@A@@@@@BCBA@A@@@AB@@ABAAAB@BA@@@C@@@@ABCB@@@@AAC@@@A@@@A@@@@A@@A@A@@C@C@A@@@@AA@@A@@@@A@AAABAAA@A@@@@A@@AA@@@@AA@AA@@A@@AA@@@A@AA@@AA@@AA@@B@@@@@C@@@A@@BA@@@@B@@A@@BA@A@@@A@@ABA@A@@@@A@@A@@AA@@@@A@@AA@@@@B@@A@@B@@A@@@A@@@@AA@A@@@@@A@@BA@@@A@@AA@@BA@@@A@@@@BAB@@A@A@@AA@@BA@@AC@@@@BA@@@AB@@@AA@@@AA@@A@@A@@A@@@A@@@A@@@@@A@@@A@@@@@@CCCAB@@A@@@@AA@@A@@@@@AA@@A@A@@@@A@@AA@A@AB@@@@AAABA@@@A@AB@@AB@@@@A@A@A@A@A@@AA@A@@A@@@@A@B@A@@@@@@@@@@@@A@BBA@@@@BB@@@@@@B@@@@@B@@A@ABA@A@ABA@@AAA@AAA@@B@@C@@@@@@AAB@@A@@@@B@@A@@@@@A@@BA@@BA@@B@@@@@A@@AA@A@CBA@@AC@A@@@BA@A@@@A@@@@CBA@@@ABA@@@@BB@@@@@@@A@@B@@@@@@@AA@@@@B@BA@A@A@@@A@@@A@CA@@@@@A@@B@@@@AB@@@AAA@B@AA@A@@@@@A@@B@@@B@B@@@@AA@@CA@AAC@A@@@@@AB@@@@@BAB@@A@BBA@@BA@@A@@@B@@A@@@@@@@@@@@@B@@@@@BA@A@@@AA@@@A@BA@@@@@@@@@A@@@@BA@A@@@@@AB@BB@@B@@@@@B@B@@@B@B@@@B@@@@@BAB@BA@@B@@BB@@@B@B@@@@@@@@@B@@@@BB@B@B@B@@@B@BB@ADB@@B@B@@@B@@C@@@AAA@A@@@A@BA@@A@@@@@A@@@@@@@@A@@A@@@@@A@@B@BABAD@B@@@DA@@@@B@@@@AB@BA@AD@AA@AAA@@@AA@@@@AB@A@BA@@@AB@@AB@@@@A@@@@@@@AAA@@A@@A@@@A@@@AA@@B@@AAA@@@@@A@AB@@A@AA@@@@ABA@@EEA@@@@@AA@AAA@@@A@@BA@@@A@A@@AA@@@A@AB@@AA@@@@@ACA@@@AC@@@A@A@@A@@A@@A@@CAA@@A@@A@@BA@@A@@A@@B@@A@AAA@A@AAA@@@A@@AC@@@AA@@CA@@@@A@@@AAAAA@@@AA@A@A@AA@@@@A@A@@A@@A@@@A@@AA@ACA@@@A@@@C@@@BA@@B@@@AAA@@@@@A@@@AAAA@AA@A@AA@@A@@A@@A@@C@@AA@@@AA@@@@@AB@@A@@@@@A@@@@@A@@BC@A@@@@@@AB@@@A@@@@@@BCA@@BB@@A@@@@@A@@@@@@B@@AB@BAAAB@BAB@@@BA@@B@@@@A@@AA@@@@@@B@@@@@@@BB@@@@BB@@B@B@@@@AB@@@@@B@@@@@@ABA@@BAB@@AB@B@@@@AB@@A@AB@BA@@B@@A@@@ABAB@@A@@@@BAB@@@@@BAB@@@@ED@@C@@@A@A@@@CB@@@@AB@@@@@A@A@@AA@A@@@@A@@@@AAA@AAAB@@BB@@@@AA@@@AAA@AA@@@A@AAA@@@AA@A@@@AB@@A@@@@@AA@@CAA@@@AAABAACBC@A@@@AAAA@@@@B@@@@BB@@@@A@@@@@A@@AAB@A@@ABA@@A@@@@@@@@A@@@@@@@@A@ABE@AA@@A@@A@@@AA@@AA@@@CA@@C@@@A@A@CA@@BA@AB@@A@@@AA@@@BA@@A@BC@@AA@@@A@@@AAA@AAA@A@@BA@@AABA@@A@@C@AAAAA@@AA@@@@@A@@"],encodeOffsets:[[121844,28929]]}},{type:"Feature",id:"331124",properties:{name:"松阳县",cp:[119.481511,28.448803],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@A@@A@@AA@@A@@A@@A@AA@BAA@@@BA@A@@@AA@@@@@A@@BA@@@@@A@ABA@A@@@A@@@@@AB@@@EB@BA@@DA@@@AAAA@@A@A@@@AB@@BBB@BB@BB@@@@@A@@@@AAAAA@@AA@@@B@F@@@@@BA@@@@B@B@@C@@@@@A@@@A@@AC@@A@@@@A@@A@@@A@A@A@A@@@@@@B@@@@@B@BA@@BA@@@A@@B@@@@A@@A@@A@@B@@AAA@@BAAA@@@BA@@BA@A@@@@A@@@AAA@A@ABA@AB@@@@@B@B@@ABA@@@@B@@@B@BA@@B@@@AA@@@@B@@BB@@A@@@@BB@@@A@ABB@@@@B@@A@ABA@@@AAA@@AAAAA@A@A@@A@@@ABCB@@@@CB@@A@@@@@A@@@@@@@A@AA@@A@AAA@@A@@@@@AA@@A@@@AA@@@@@@CAA@@CAABA@@@@BAB@@@B@@B@@BB@@@BB@@@@@B@BB@@B@B@@AB@@AB@@CBA@ABA@@@AB@@B@@@@@A@A@@B@@B@@B@@@@BA@@B@BA@@@B@@@B@@BB@@B@@@BB@@B@@@ABA@C@@@ABA@@B@@AA@@A@@@A@A@AB@@A@A@AA@A@@A@@AAAA@@A@@@A@@A@@A@AA@AA@@@@AAAB@A@@A@@AB@@@AA@@A@@@A@@A@@AA@@BA@@AA@@@@BA@AA@@@@@A@@@@@@AA@@@@@A@@@A@A@@@A@@A@@A@A@A@AB@@@AA@AB@@@@A@@@A@A@@@A@CAAAA@@@@@A@@@@AA@@@@AA@A@AB@@@@A@@@A@A@CA@BAD@@@BA@@B@B@B@BA@A@A@@@@B@@@BA@BBA@@B@@@B@@@@AB@@@B@@A@A@EB@@@@A@@@A@A@@@@@AAA@@A@@A@CA@@AAA@AAA@@@A@A@@@A@@@AAA@@AA@@@ACAC@AAA@@@AAA@@AB@@C@A@@BA@A@@@@@A@@@EB@@C@A@C@A@@BA@A@@@A@A@CA@@A@@A@B@B@@@DBBBBB@BB@@B@@B@@@@A@@B@@@BB@@@@BA@@B@B@@@B@@BB@BA@@B@@A@A@BB@@@@ABA@@@A@A@@B@@@@@B@@A@@A@@@@A@C@AA@@@@@B@@A@AB@@AB@@@@AB@@ABAB@@@B@B@@B@@@@@@BA@@@BB@BA@AB@B@@@@@BA@@BAB@@@B@B@BB@@F@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@BA@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@AAA@@@@@AB@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@A@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@A@@B@@@B@@A@@@A@@AA@A@@@@BA@AA@BAB@BA@AB@@@@A@@@A@ABA@A@A@@@A@A@@AA@A@@@A@@@A@@@@A@@@A@A@@@@AAACA@@AA@@ABAA@BA@A@A@@@AAA@@@A@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@A@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@AB@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@B@@A@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@A@@@@@@A@@@@@@A@@@@@@@@@@@B@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@A@@@@@@@@@@B@@@@A@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@A@@@@@@@@@A@@@@@@B@@@@@@@@A@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@A@@@@@@@@@@@B@@A@@@@@@@@@B@@@@@@@@@@@B@@@@B@@@@B@@B@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@A@@@@B@@@@@@A@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@BCD@@AB@B@@ABA@A@A@A@@@@@CB@@@B@@A@@@@@@BA@@@A@@@BB@@@B@@@BBB@B@@@@ABB@@@B@@BB@@@@@A@@@AB@@AB@@@B@@@@@BA@@@@@@BA@BB@@@@@@@DA@@@BB@BBB@BB@BAB@B@BAB@@@@A@@@@@A@@@@A@@A@@B@AAB@@@@@@@@@@@@@@B@A@B@@B@@@@BBB@@@B@B@B@B@B@@A@@BA@@B@B@@ABBB@B@@A@@B@BBB@@@B@@B@B@@@BB@@@@B@@@BB@@@@@BA@DBDD@@@@@@@B@@@B@@@@@B@@@B@@@BB@@@@BB@@B@@BB@@A@@B@@AB@@@@BD@@AB@@BB@@@B@BA@AB@@@@@B@@AB@@BB@@@B@@AB@@@B@@@@@BBB@@@@@B@@@BA@@@@BA@@@@@BB@@@B@@B@@BB@@@@B@@B@B@BA@@@B@@@BAB@@@BA@@@@@AB@@@B@@@D@@@@@AB@@BB@@BB@@B@B@@BB@@@BB@@BBB@@@@BB@@@B@@B@B@BBBABBB@@@@@@BB@@B@@B@D@D@@@@B@BB@@@@@@B@@@B@@BD@B@@A@AD@B@@D@@@B@@ABABBBA@@BA@@B@B@DA@A@@@@@BBB@@B@B@@@@@@B@@@B@BBB@B@B@@B@@BB@B@@@B@@B@@@@@B@@@BB@@B@@@B@@@BBB@B@@@B@@B@@B@@B@@@@BB@BB@@@@@B@@@BAB@@A@@@ABA@@@AB@B@@CDA@@BAB@B@@@B@@@@BB@@B@BD@@@BB@@B@@DDB@@@BBB@BB@@BBB@@@@@BB@@B@@@@@@@@@@B@@@B@B@@A@@@@B@B@@@@@B@@@B@@@@@@@@@B@BA@@B@@@D@@@B@B@@@BAB@@B@@BA@@@@D@@@@BB@BAB@@BB@@AB@B@B@@BB@B@@@B@@@@@B@@@@B@@@@@BB@@@B@@@B@@@@BB@B@@BB@@@BB@BB@B@@B@@@B@@B@@@@@B@B@D@@A@@BC@@B@@ABBB@@@B@B@@BB@BB@@B@@B@@BB@@B@@@@@B@@@D@@@B@@BDBF@@@@BB@ABBBB@BB@@BBB@@@B@@A@@BA@@@@BBB@B@@@@BB@@@B@@B@B@B@B@B@B@@@@BD@@@B@@@@@@@@@@@@@B@BA@@B@@@B@@@B@@@@BB@@@@BBB@@B@@@BB@@B@@@B@@A@@BA@@@AA@@@BABA@@D@@@B@@AB@@BB@@AB@@@B@@A@@@@@@@@@@@@@B@@B@@@@@@@@@@B@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@BB@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@BB@@B@@@@@@B@@B@@@@@BAB@@@BBBAB@B@B@@@@@@@@AB@@@@@@@@B@@B@@@@A@@@@@AB@@@@A@@@@@@BB@B@@AB@@@@@DA@@@AB@@AB@@@@@@@@@@@@B@@@@B@@AB@@@@A@@B@@AB@@@@@@@@@B@@@@@BABA@@@@@DB@@@@@@B@@@@@B@@@@@BA@AB@@BB@B@@@@@B@@BB@@@BB@@@@@B@@AB@@@@@BA@@@@BB@@@B@@@@BBB@@B@@@BA@@@@B@@@@ABA@@BCBA@@@@@@BBB@@@B@@@@B@@@B@@@@B@B@B@@B@@@B@B@B@DAB@@@@@B@FA@@@@BA@@@A@@@@BDA@@BB@BB@@@B@@@@BA@@@@BA@@@@@@B@@@@@@BA@@@@B@@@@@@@@@B@BA@@@AB@@@BJF@@B@@@@B@B@@@B@@BA@@@BB@BDB@B@@B@@@B@@BB@@@B@B@@DBB@@@@BB@@@@BB@@B@@@BBBB@@@@B@BB@BB@@BBBB@BA@@B@@BD@B@@BBBB@BB@@@@@AA@@B@@@@@@B@@B@@AB@@@@BB@@@@AA@@@@@@A@@@@@@@A@@B@@@@@@BB@BD@@@@B@@@@@@BB@@@@BAB@@@B@@@DA@@@@BA@@@@@@@B@@@B@@A@@B@B@B@@@B@@A@@@A@@@A@@@AD@@@@B@@@BBDBDBB@B@B@@@B@@BBBAB@@@@AB@BAB@@@BBB@BBD@@BB@BB@@B@@BB@@@@@B@B@@@B@@BB@B@@@B@@@@@@BB@@@@@BBB@@@B@DB@@@BB@BB@@@@@B@@@B@B@BBB@@DBBBB@BA@@@@B@@@@@B@@@B@@@@@B@@@@@@B@B@@B@B@B@@@@@@@B@@@@@BA@@B@BAB@@AB@@@@@B@@@B@@AB@@@@AB@@A@@@A@@BA@@AC@C@@@A@@@A@@@A@@@A@@B@@A@AB@@@B@@A@@B@@@@B@@@@@B@@@BB@@@@@B@@@@@BA@@B@@@@@@BB@B@@BBA@@D@B@@AB@@@@@@@@@A@@BA@B@@B@@B@@@@@BB@@B@@@@B@BA@@@BAAA@@@@A@@AA@AA@@@B@@@B@@@@B@@B@BBB@@B@@B@@@@@@BA@@B@@AB@@A@AB@@@@@B@DAB@BB@@@B@@@B@@B@BA@@BA@@BBBA@@B@@A@@BA@@B@B@@@BA@AB@@@BA@@@@BB@@B@@@@@@@BB@@AB@B@@BC@@B@B@B@BB@@@@B@@@BAB@@B@@@D@@B@@B@F@@@@B@BABAB@@@B@@@@@@AB@@AB@@A@@@@@@BB@@B@@B@@@@BBB@@@BA@@@ABB@@B@@@@A@AA@@@@@BA@@@@B@@@@@@AB@@A@@@A@AB@@B@B@@B@@@@A@@B@B@@@@@@AA@@A@A@CA@@AAAA@A@@@@@AA@A@@@A@A@@@A@@@@@AAA@@@A@@@C@@@@@@@A@@A@@@@A@@@A@@@@@@@A@@A@@@@@A@@@@@@A@@@A@@@@@@@A@@A@@A@@@@@@@@@AA@@@AA@@@A@@A@@@A@@@@A@@AA@@@@AA@@B@@A@@B@@BB@@@@BB@@@@@B@@@BA@@@BB@@AB@B@@@@@B@@@B@@@@@B@@@BB@@B@@ABB@AB@@@@@B@@@B@BA@@BB@@BA@@B@@@B@@B@@BA@@@@B@@@@@@BB@@@@AB@@@@@@@@@@@B@B@BB@@B@@@B@@@@A@@@@BA@@@@@AB@@@D@@@@@@@@BB@BAB@B@BA@@B@BB@AB@@B@@BB@@@@@@@@B@@@DB@@B@@@AABA@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@B@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@ABB@@@@@@@BBB@@B@BBB@BB@@@BB@@@@B@B@@BB@BBB@BB@@@B@@@BBB@B@B@BAB@B@@AB@@AB@@@@@@@B@B@@@B@BA@@BA@@@@BB@@B@@@BABA@ABA@@B@@@@@@B@@@@B@B@@@@@@@@BB@@AB@@AB@@@A@@AAA@@@A@C@@BB@@@@@@@A@@@@B@BA@@@@@A@@@AA@@AA@BA@@@A@@DC@@@A@A@@@@A@@@@@@BB@@@A@@@A@@@AD@@@@@A@@@@@AAAB@@@@@@A@ABAA@@@@@B@@BB@BBB@@B@@@@@ABA@A@@@@@A@@BB@@@@@BB@@@@@@A@A@@@A@@@@@@@A@@@@@@BC@@@@B@BD@@B@@@@@@@@@@@@AB@@@@@@@@@B@@A@@@@BA@AD@@@@@B@@B@@@B@B@@@@@BDB@@@BB@@@B@@@@@@A@@@@@@A@@A@@AA@@@@@@@@@@@A@@@@@@@@@A@@B@B@@BB@@B@@@@B@DB@@B@B@@@@@@@@@D@@@BA@@@@F@@@BBB@BABA@@@@BBBB@@BB@B@@B@F@@BB@@@B@B@@AB@@@@@@AB@@ABABB@BDB@BB@BD@@B@D@@ADA@@@@@AA@@@@@@@@AAA@@@@@A@@@@@A@@B@@@@@@@@B@BB@@@@BB@AAB@@A@@BAB@BA@C@@BA@@@A@@B@@@@@B@B@AABABB@@@BBB@BBB@ABA@@@@AFB@@@@@@@B@@@B@@@BA@@@@A@@@@AB@@ABA@@B@@@@BB@@@B@D@@@B@B@@@B@@A@AB@@A@@@@@A@@B@@ABA@ABCBA@@B@@@BC@@AA@A@@AA@@@A@A@@@A@@@A@A@@AC@A@A@@B@BAD@@@B@B@@@@@AA@@AA@@@AA@@A@@@A@@@AA@@AA@@@@AB@@@BABE@@@A@@@@@AA@@@@@@AA@@@@@@AB@@A@@B@@AB@@A@@@A@@B@@@@@@A@@@@AA@@@@@A@@@A@@@ABA@@@C@A@ABAAAAA@A@A@A@AB@@AAC@@AC@@@CA@@@@@D@@@@B@@@@BBB@@B@@BB@@@@B@B@@@@@B@@@BA@@B@@@BBDA@BB@@@BB@@@DDD@BBB@@A@@@@BA@@@B@@@@BB@@@BBBA@@B@@@@@BB@AB@@@@A@@BAB@@AB@@AB@@@B@B@@@B@@@B@DB@@@@@A@@@AA@@A@@@@AAAA@@BAA@@@@@A@@@@A@@@@@@B@B@@B@@BB@@B@@BBB@@@B@@DB@BBB@@@B@@B@B@B@@B@@B@BB@@@B@@B@@@@ADA@@A@@AB@@@A@@@@B@@@@@@@@@@@A@@@@@@@AB@@@@@B@@@@@@A@@B@@A@A@A@A@@DBB@@@@@AB@@@@@B@@B@@@@@@A@@BBB@B@BAB@@@D@B@@@@B@@@@D@@@BA@@B@@BB@B@@A@@B@@@@A@@AAAA@@@ABA@A@CB@AAB@@A@AB@@AHC@@@AAC@@@@@@@A@ABA@@AA@@A@BA@A@@@A@@BA@@@AA@@@@AB@@@@AAA@@B@@@AA@@@@BA@@@A@A@@@@@A@A@@B@@A@@@@@@B@AC@@@A@AAAA@@A@A@@@@@@@@@@@@B@@A@A@@A@@@A@AAA@A@@AA@@AAA@@A@@@EA@B@@@@AA@@@@A@BAAABAA@AA@@@AAA@@A@@A@@@A@@AA@@BA@@BA@@@A@@@@BB@@@A@@B@BA@A@@B@@@@A@@@A@@BA@@B@@AB@@@B@@@@B@@@@@@@@B@@@B@B@@@BA@@@@D@BB@@AC@A@A@@AA@@@@@AB@@AA@@@BA@AB@@@@C@@@@@@@A@@@@@A@@@A@ABA@@@A@@@@@@AAB@@@B@@@@@@@@ABAA@@A@@@A@@B@B@@@BA@@A@@CAA@@@@B@@@B@B@@@B@BAA@@A@@@@@AC@@@B@A@@A@@@@@@B@@@@@BA@@@@@A@@CA@@@@AB@@A@@@@@C@A@@A@@@ABC@@AC@C@@B@@@B@@@@BBBB@BB@@@@@A@AA@@ABA@A@ABAA@@ABA@A@A@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BA@AA@@@B@B@@AB@@@B@@@@@BAAA@@@@AA@A@@@@@@A@@AA@@@AA@@B@@A@AB@@@@@@B@@@B@@@@@@@@B@@BB@@@@@BA@@@@@@@AB@@@@@@@BA@@@@@@@@AB@@@B@@@BB@@@@@@ABA@@@A@@@@A@@A@@@@@A@A@A@A@@@A@@C@@A@@ABA@@B@@@@@@B@BBB@@B@@@@AAA@A@A@@@A@@@@C@AAA@@BA@@KC@AA@@@@@@@@@@@@B@@A@@A@@@@@A@@AA@@C@@@A@@B@@A@@@@A@@@@A@@B@AA@A@A@@@A@@@@@@@@A@@@@@A@@@@@@@@@B@B@@A@@BA@A@@A@A@@@@@@@BAAB@@@@@@AC@@@ABB@ABB@@@@@@@BB@@A@A@A@@AC@A@@AA@@@@@@A@BA@A@@@AB@@AAA@@BAAAB@@@B@@A@@@@@A@@@A@@BA@@B@@@BA@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@A@@@@BA@@@AA@AA@@@@@@A@@A@AA@@A@@@CB@@A@A@A@@BABA@A@@@@BB@@BB@@@@BA@@@@A@@AB@@@B@B@@@B@@@@A@@@@@A@@@@@A@AA@@@@A@@@A@@@BB@@@@B@@BBB@@@@@BA@@B@@A@A@@@A@A@A@@@@@@B@@@@AA@@@E@@BC@A@@@A@@AA@@A@@AABA@@@@@ABA@@@@@@@@B@@@@AB@@@@@@A@@@@@AA@@@AB@@@BAB@D@@@@@@AAB@@@A@@@@@A@@@@@@AAA@@@A@@@AB@@@@A@AAC@A@A@AA@@DA@@@@@AAB@@A@AB@@@A@@A@AA@@AA@@AA@@@AA@@@A@@B@@@@@@B@@@AB@@@@@@@@@B@@@@@@@B@@@@@@@@A@@@@@B@@@AAB@@@A@@@A@@@@@@@BA@@@@@@@@@@@A@A@@A@@@@@A@@B@@@BA@@@A@@@AAAA@@@@@@@D@@@B@@@@A@@@AA@@A@@@AA@@@@@@A@A@@BA@AAAAA@@@@@A@@B@@@B@@@@@BBBBB@@DB@@@BA@@@ABA@@@AB@@@@A@@AA@@A@@A@@@AB@@AA@@A@A@A@A@@BB@@@A@@@ADA@@@B@C@A@AAAB@@A@@@AAA@A@@A@@A@@A@A@@CB@@A@A@@@@@A@@@AA@@ABA@A@A@A@A@@@@@ABCAA@@BAA@@A@A@A@@@@A@@@@A@@@@@@@@@AB@@@@AB@A@@AA@BAAA@A@A@A@A@@A@@@@AA@@@A@@A@@@AA@@@AA@AAAA@@BABA@A@@@C@@@A@A@@BA@@BAB@BAB@@@B@BA@@A@A@@@@A@@A@A@@@@BA@@A@@AC@@BA@@AAA@@@@A@@@AB@@A@@@C@@BA@A@A@@@@@A@A@@AA@@@AC@@@B@BA@@@AA@@@AAA@@@@@B@@A@@@@B@@@@@AA@@@@AABAAABA@@@AAC@A@A@@B@BAB@@AB@@AA@AA@A@@@AA@@@ADCBA@ABA@CDA@A@A@@@AB@@A@CB@@A@@@@@ABA@@@A@A@@@A@@@@@A@@B@AA@@BA@@@A@@A@@@@@A@A@@@@@@@@A@@@@@@BA@@@@AA@A@CAAAA@@@@@@B@B@BAB@@@@DBBBB@A@AB@@A@@@A@AB@@A@CBA@@AA@@@A@@AC@A@@@A@@@A@@@A@A@@B@@@@A@@@A@@@@BA@@@ABA@@@ABC@@@@BA@@@@@C@@@@@A@A@@@@@A@@@@@BA@@@CA@@A@@AA@@@@@@@@@@@BA@@@@@@@A@@AA@@@BC@@@@A@@@AB@@@@A@@A@@@AA@AA@BA@@B@@@@@B@@A@@A@@@@@ABA@A@@@@@AA@@A@@AA@@A@@BA@A@@@C@@@@B@@@@A@@@@BA@@B@@@@@@@@AA@B@@A@@@A@A@@@@@@@A@@@A@A@@B@@A@A@@@@@@@BB@@@@@@@@@@@B@@@@A@@@@@A@@A@AA@A@@@@@A@@A@@A@@@AA@AA@@@@@A@A@AA@@@BA@A@A@A@@BA@A@"],encodeOffsets:[[122315,28942]]}},{type:"Feature",id:"331125",properties:{name:"云和县",cp:[119.573397,28.11579],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@A@@@@@@@@@@@A@A@@@@@@B@@@BA@A@@BA@A@@AA@@A@ABA@@@@@@A@@@@@@@@@@@@@A@@@@BA@@AA@@@@A@@@@E@AAAA@@@A@A@A@@@A@A@A@AB@AAB@@ABAA@@@@@@@@A@@@A@@@ABAAA@AAC@@B@BBB@@@@@@@@A@@A@A@@A@@AABA@AA@A@@@@@@B@@A@@BA@@BA@@@A@@B@@A@@B@@@B@B@B@B@BB@A@AB@@@AA@CB@@@@@@@AB@@@@A@@@@A@@@@@@@@@A@@@@AB@@@@@B@A@@@@@@A@@@@@A@@@@@@A@@@@@@@A@AA@@@@A@@@@@@B@@@DAB@B@@AB@BDD@@A@@B@@@BA@@B@@B@A@@B@@BB@@@@B@@@@B@@CB@@@@@BA@@@@@A@@@@@ABB@@@B@@@@@A@A@BB@@B@@B@@B@A@ABAB@@AB@@A@@@A@AB@@@@A@@@B@B@@@@D@B@BB@@B@B@@A@@@A@@@A@AB@@@B@@BBB@@@BB@B@@BB@@AB@@@@A@AB@@A@@@@@@@@BA@A@@@@@BB@@@@@D@B@@@B@B@@@B@BA@@B@@A@@@@B@@C@A@@@CBA@@BA@@@A@A@@B@@@@AA@@CBA@A@@B@B@@@@A@@B@BB@B@@@B@BB@@BB@@ABABB@B@B@F@@@@BA@@@@@BBA@CBAB@@@@@@@B@@A@AB@B@@@B@BABA@ABA@AB@@A@AD@@@B@@@@AB@@A@@@A@ABC@AB@@@B@BDD@@B@@@@@@BA@A@@@AB@@@@A@@@A@@@A@A@@@ABABABA@@@B@BB@@B@@@@@B@@B@@@@@B@@B@@B@@BB@@BB@@B@@B@@@@AB@BA@@B@@A@@B@@@BAB@BA@@B@B@@@B@@BB@@@@@@@@@AB@B@B@B@B@B@@AB@@@@A@@@@B@@@@@AB@@@@@@B@BABA@@B@BB@A@A@@@A@@@@@A@A@@@@@A@@@@B@@@B@@@@@BCB@BAB@B@@A@@DA@@@AB@@@@A@@BA@A@@@AB@B@B@@@@@@@@@@B@@@@@@@B@@@@@@@B@@B@B@@@B@B@@@B@@A@@@C@@@AAA@A@AA@@@B@@@@@@B@@BB@@B@@@@@@AB@@@D@@B@@B@A@BB@@@BA@@@@B@@@@@B@@@B@BB@@@@@AB@@AB@@@B@@@B@@A@@@@B@@@@BBB@@@B@@@@@@@B@BA@@@@BA@@@A@@BB@B@B@@@BA@@BCB@@@B@@@B@@A@@B@@@B@@AB@B@BA@@BA@ABAB@B@B@@B@@B@@@@@D@@B@@@BBB@B@@@@@@@@BA@@@@B@B@@@@B@B@@@@D@BB@@BB@@BB@@B@@@B@@B@@@BADB@@BB@B@BBB@BBB@B@@BB@B@@DAD@@@DBB@B@D@@@B@@BB@@B@@@@@BBB@@@@BBB@@@@@B@@@@@B@@@B@BBB@@@B@@@BBBBBB@@@@BDAB@B@@@BA@@@CBAB@@@@@B@@@@@@@BB@@B@@A@A@A@@@@@@BB@AB@@@@@BB@@@AB@@@B@BA@@@@B@B@@BDA@@B@@AB@@@@@@ABA@@B@@@@@@@A@@A@@@@@AB@@@@A@@@@@A@@@@BA@BB@@@@@B@@@B@B@@@@@B@@@B@@@BA@@BA@@B@@BBA@@B@BB@B@B@@@AB@@BB@B@@B@@B@@@@@B@@@@@B@B@@@B@BA@@@@B@B@@@BA@@@@BBB@B@@BB@@@@AB@B@@A@A@@B@@@@@@@@@@@B@@@@@B@@@@@@@A@@@@AB@@@@@@A@@@@B@B@@@@B@@@@@@@AB@@@@A@@BA@@@ABA@@@@B@B@B@B@B@@A@@@A@A@@B@@AB@@@@A@A@@@AB@@@@@B@@A@@@A@@B@@@@ABA@@@AB@B@B@@ABA@B@@B@B@@@@BBA@@BA@CBA@@@ABA@@BA@BB@@AB@A@BAA@BAB@@AB@@@B@@AB@@@B@@AB@B@@@B@@AB@@@B@B@@@@DBBB@@@@B@@@@B@B@B@@A@@BA@@@@BAD@@@B@B@@BB@@@D@@BA@@BAB@H@B@D@D@D@B@@@@@@BABA@@BA@@@@B@@@DBBBB@B@@@@@@@B@@BBA@@B@@@BB@@@@B@B@@@B@B@@@@@BBB@BB@@@@BB@@@@@B@@@@@@B@BB@@@BB@@@BB@@D@BBB@@@B@@@BB@@B@@A@@B@@@B@@@@@@@B@B@@BB@BBB@B@@@BBBBB@@B@@B@@@B@@@@@BBDBBB@@A@@@B@B@@@BBB@B@@@@AB@@@B@@@@BB@@@BB@@@@@@B@B@@@BAB@B@@@BA@@@BB@B@@BB@@BB@@@BA@@@@B@@AB@@@BABA@A@@B@@A@@B@@@@@B@B@@@@ABA@C@CB@@@@BB@@@BA@@B@B@@@B@@@@@@A@A@@@CB@@@@@B@@@@A@@B@@A@A@@@ABAB@@@BA@@@@@@@A@AB@@A@@@@@AB@@A@A@@@@B@@@B@@@BB@@@@BBB@@@@@BBB@@B@@@@@B@@BB@BB@@@@@B@@@@@@@BB@BADB@@BB@D@@@@B@@B@@@BB@@B@@@@@BB@BBB@@@BBB@@@@@@@B@@@@@B@@@DA@@@@DABA@@B@@@@B@BBBBB@BB@BB@@B@BAB@@@@A@@A@BAB@@@A@@A@@B@@@AA@@@A@@B@@B@@@AB@@A@A@@@A@@B@BA@@@A@A@@@@BAB@BAB@B@BB@@B@@@@@@BAB@@AB@@B@BB@AB@BB@@@AB@@@@BB@@@@@@AB@@@B@@AB@@A@A@@@@@A@@@@B@B@B@B@@@B@@@@B@@B@@@BD@@@B@@@B@@@@@DA@A@@@@@AB@@@@E@A@@@B@@BB@BBBB@@@@@B@@@@AAA@@AAA@AA@@B@@@B@BB@BB@B@@CB@@ABA@@F@@BA@@@@B@@@B@B@BAB@@@@@B@@AB@@@@@B@@B@@@BAB@@B@ABB@@B@BB@@@@BB@@BB@@@@BB@B@@AB@B@B@B@@A@@BBB@B@@@@@B@@BBB@@B@@@@BB@@@@@B@B@@B@BB@@@@@B@@@@@@A@@@@@@@@@@AA@@@@@@B@B@@@@AB@B@@@B@@@@@@@B@B@@@B@@@@ABB@@@@@@@@@AB@@A@@B@@@@@@A@@D@@@B@B@@AB@@@BB@@@BB@@B@@@@@BAB@B@@@@@BB@@@@A@@@@@AB@@ABBB@@B@@@BB@@@@@BA@@B@@@@@AD@@B@@BB@@@@@@@B@@A@@@@@@@@@@BB@@@BB@@D@@AB@@@@B@@@@@B@B@@@@@D@@@@@B@@A@@D@BA@@B@BA@@B@@A@@B@@@B@@@@@@AB@B@@@B@@@B@@@B@D@@BB@@@B@@BB@DAB@@@BAB@@@B@@@BAB@A@AACA@@@@BA@A@A@A@@@@B@BBDBB@B@@B@@B@@A@@@@B@@@@@@@@@@B@B@@@@@BB@@@B@@AB@@B@AB@@@@@B@@@B@B@@@B@BA@@@@B@@@DAB@@@BA@@B@B@B@DCB@BAB@DABC@@B@@B@@@BBBB@@BA@@BA@ABA@@@@B@BBD@B@@ABBBABBB@@@@BB@@@@A@@@@@@BA@@@@@B@BB@@B@@B@@ABA@@@D@@B@@BB@@@B@B@@@@@B@BAB@@@D@@@BA@@B@@@B@@B@BB@@AB@@BD@@@BB@@A@@B@B@@@@B@@B@B@@@ABA@@@A@ABA@AB@@AB@@@B@B@@@D@@@BABAB@@BBBBB@@B@@BB@@B@@@@B@@BB@@@@@BB@B@B@B@B@BB@ABB@@@BBA@@@@BA@@@@@@@@B@@@@@@B@@B@B@B@@@BB@AB@DBBA@@@@B@B@B@B@B@BA@@BB@@B@@@@@B@B@@@DA@@@B@BB@@@@BB@B@BB@@B@@@BABBB@D@A@@@B@BC@@B@@@A@@AB@B@B@B@@@BB@@BA@@B@@@@BB@@BB@@@@@BA@@B@BA@@B@@A@@CA@@AAAA@A@@@@@A@@@AB@@@@@B@BBBBB@@AB@B@@@@@@@BB@@B@@@BB@@B@@@@@@A@@@C@@@@@@BBBB@@B@@@B@@A@@@AB@@@@@B@@@@B@B@@@@@@@@@@AB@@@@@@B@@@B@@@A@BB@@A@@@@@B@@@@@@@@@@A@@@@@@@A@@@@@@@@BA@@A@@@@@@@@AB@@@B@@B@@BB@@BB@@BBB@@@@B@@BAB@@@BA@B@@@@CB@@BBB@B@D@BBB@@@@@BA@@B@@@BA@@@@CC@B@@A@@@@@A@@@@C@@BABA@@@@A@A@@@@@@@@@@@AB@@@A@@B@@A@@AA@@@@B@AAB@@@@@@@@AC@@A@@B@@A@@A@C@@@@@@A@@@BB@D@B@@@B@@@B@B@@ABABA@@@@@A@@@@BAB@B@@@BB@@BA@@@A@@@AB@B@BA@A@@B@@@@@@A@@@@BA@@B@@@@AB@@BB@@@@@B@@@@@@@BABA@C@@BA@B@B@@@B@@@@B@@@@B@@@@@@B@BAB@BA@A@@BB@A@B@@@@AB@@@@@@@B@@B@@@B@@@@@@BA@@@B@B@@@@A@@@@B@@@@@@B@B@@@@@B@@@@@AB@@A@@@BBA@BB@AA@@@A@@BA@@B@@@@A@@A@@@BA@ABAA@BA@@@@B@B@@@B@@B@@@@B@@@@@@@@A@@@@B@@@@@B@@@@@B@@@@@@B@@D@BB@@B@@@DB@@B@@@@@@A@@BA@A@@B@@@D@@@B@@@@B@BBB@@B@B@@@@AB@@A@@@@B@@@B@@@BB@@D@@@@@@AB@BA@@@@@AB@@@@A@@@AB@@A@@@@BA@@AA@A@C@ABA@ADC@ABA@@@@BBB@@@@@B@@AB@@A@@@@@AAA@@B@@AB@@@@@@ABA@@A@@@@A@@@@@A@@BB@@@@@A@@@@B@BA@@@A@@A@@@A@@@A@@@@@@@BAB@DC@@AA@@B@BA@@B@@B@B@B@@@@@@@@@B@@@@B@@CB@BABA@@B@@@BBBBB@@AB@@B@@BA@@@@@@@@@@AAB@@@B@@@@@BB@@@B@ABA@A@A@@B@@B@B@@@@B@@A@A@@@@AC@@@@A@A@@A@@@A@@@AFEDC@@BA@@@@B@@@@@@BB@@@@@@@@A@@@@@@AABA@A@@@@BAA@B@@AA@@A@@B@B@@A@@@A@@@@D@B@@@BA@BBA@@B@@@B@@@@@@A@@@A@@@@AA@@@@@AA@A@@@@AAA@@@A@AA@BAAA@@@@AAA@A@ABCACA@@@@@AAA@@AA@B@@AB@@@@ABABAB@@@A@@@A@@@@@@A@A@@@@@A@@@@B@@@B@@AB@@@@@@@BA@@AA@@B@@@BB@@@A@@@@@@B@@@@@@A@@@@@@B@@DB@B@B@@@B@@@@A@@AAB@@AA@A@CAC@@A@@@@@A@@@@@@AA@AA@@@B@@@B@@@@A@@@@A@@@@@A@A@AAA@C@@@A@@A@@C@A@CAA@A@CA@@A@@AB@@A@@AB@@@@@AA@BA@@@@AA@@AA@@@@A@@B@@@@A@AAA@@@A@@AB@@A@@@AA@@@@AAA@@@AAAA@@C@@@AAAAA@BC@AAA@A@AA@@@@@B@BA@@BA@@@AB@BA@A@A@A@@@@A@ADA@@@A@A@@@@@BA@@@A@@@@@@@A@@B@B@BA@@@@@AB@@A@@@@A@A@@@@@@@A@@@@AAB@@@@@@A@@@@A@@@BA@@@AB@@@@@@B@@@@@@B@@@@@@ABBBA@AB@B@@A@@@CA@A@@A@@@@BA@@@@@A@@@@AA@B@@A@@BA@@@@B@@@@@@@@@@@@BA@B@@@B@@@BA@A@@@@@AC@@@AB@@@@@@BA@@B@@AA@@@@@AB@@A@@@@@@@@A@@@@@A@@A@@@@B@B@@@@A@@A@@@@@ABA@@@A@@@AB@B@@@@@@@BB@@@A@@D@BB@@@@BB@@B@D@@@B@BBB@B@@@BA@@A@AC@AA@AA@@@@B@B@@A@@@@DC@@B@@BB@B@B@@@@@BB@@@@@B@@B@B@@@B@@AB@@B@@@A@A@@@A@A@A@@@@BBB@@BB@@@BA@@@@@A@AB@B@@@B@BB@@@A@@@@ACBA@A@@BB@@@@@@BAB@@B@@@BB@B@B@@@BA@@B@B@BA@@@@B@@@@@BAB@@@B@B@@B@@@@B@@@B@@BB@@@B@B@B@@@@B@@BAB@BB@AB@@@@A@A@@B@@@@A@@@A@@@A@A@@@@@@@A@@BAA@@A@@A@@A@@A@A@@@AACA@AA@@@@A@@@A@A@A@@B@@AAA@A@@A@@@@B@@A@AB@@@BA@AA@A@@AA@AA@B@@@ACAAAA@@@A@AB@BAB@BA@@@@AAA@@@@A@@@A@A@A@@@@@AA@A@AA@@@@@@@@@A@@@A@@A@AE@@A@@AA@@ACAAA@B@@AB@B@A@@AAA@@@@AA@@@A@@AA@@@AAAA@@@AA@A@@AAA@@@A@@@@CAABA@AB@@@@A@@@A@A@@A@@@@@AA@@@@ABAAA@@@AAA@@BA@@@A@AA@@@BA@@BA@@A@BA@@@@AA@@@A@@@@@@@A@@DCBA@@B@@A@@BA@@B@@AA@AA@A@@@A@AA@@@AA@@AA@@@AA@BAAA@@AA@AAA@AA@A@@AA@@@ABA@A@A@C@ABA@@@A@A@AAA@A@A@@@@A@@A@A@A@A@@AA@A@AA@BGACA@A@@@@AA@AA@@@A@@@A@@BA@@@A@@@A@ABABAA@@@@A@@AA@@@@@@A@@@@@@AAA@@A@A@@@@@@AAA@@@@@@A@AA@@@A@@AA@@AA@@@@@@A@@A@@@@@AA@@@@@@@AA@AC@A@@@AA@@@AA@@@@@@A@@@@@@AA@@@@@@CA@AA@A@@AA@@@@A@AA@@AB@@A@A@@@A@A@@A@@A@A@A@@@A@@@@@AA@@@@AA@A@C@@@@DC@@D@B@@A@@A@@@@@AAA@@@A@@BA@@@@@@@@@AB@@AB@@@@A@@@@@A@@A@A@@A@@@@@@@A@@@A@@@@@AA@@@A@A@@@@@@BC@@@A@@@A@A@A@@A@ABA@@@A@A@@@A@AA@@@BAB@BBB@@BB@B@@AA@@AAA@@A@B@BBB@@@B@@@BAA@@AA@@A@@BAB@@A@@@@A@@@@@A@@B@@@A@BA@@@@@A@@@@@@@AB@@@AAC@@@@B@@A@@@@A@@@@@@@@@@AB@BA@@@@@CC@@@@@A@AA@@@@@@AA@@@B@@@@A@@@@AAA@@@@A@@A@@AA@A@@AA@@A@@A@@@@@@AB@@@ACA@@A@@@@@A@@@A@@@ABA@@A@AA@@AAA@@A@@A@A@@BA@A@ACA@@AA@A@@A@@A@@AA@@AA@A@A@@A@A@@A@A@@@AAAB@AA@ABABA@A@A@@B@B@@AA@@A@@@A@@@@AAA@@@@@AA@AA@AAAA@@@@@@@@A@@BA@@AA@@B@@A@@@A@A@@@@@A@@AA@AA@@A@@A@@A@@AAA@A@C@C@AA@B@@ABA@A@AAA@CA@@@A@@@A@@AAA@@@CA@@@@@@@A@@AA@B@@@@AB@@@A@@@@@@@@A@@@AB@@@@@B@@@B@BA@@@AB@@ABA@@BA@@@@BA@A@@@A@@@@@CAA@A@A@AACAA@A@@@@BAB@@@BAAA@AACAA@A@A@@@A@@@AA@@@@@A@A@@@@BABA@@BAB@@@@@@A@@BA@@@ABA@@@@@AA@@@BA@@BA@AAA@A@@BA@A@@@A@@@A@@@ABAB@@A@@DAB@@AB@@@@@@@B@@@@@@A@@B@BA@@B@@@@@BAB@@A@@@C@@@A@@@A@AB@@AB@@A@@AC@A@A@@AA@@@A@C@@@@@AB@@ABAB@@A@@@@@A@A@@DAB@BAB@@A@@@@@AB@@@@@@@@@@@B@@A@@A@@A@@A@@@@A@@A@@@@BA@@@ABA@BA@A@@B@@@AAB@@@@@B@BAB@@A@@@@@@A@@@A@A@@@@AAAA@@B@@AA@@@@AA@@@@@A@@BC@AAA@A@@@@A@A@ABA@@@AA@A@@AA@@A@ADA@C@@BA@@@A@@B@@@B@@AB@@@@@@B@BBB@@@AB@@@BA@AA@@AB@@C@@@@B@@AB@@AB@@@@A@AAC@C@A@@@AA@@A@@@@A@@@@@AB@@A@@A@A@@AB@@AA@@@BA@@A@@@BA@@@AB@@@@@@A@@@A@@B@@@@@@A@@C@A@ABCBC@@BAA@AAAAA@@@@@@@AA@@A@@AB@@CA@@A@@@GAA@A@A@A@@@A@A@AB@@A@@BAA@@AA@@AAC@@@@A@A@@@@AB@@AB@BA@@@A@@@CA@AAA@@@A@@@AA@A@@@A@AAA@@@BE@@BA@A@@A@AB@@@BAB@B@@A@A@A@A@@@@@AA@@@A@@@AB@B@@@@@@@BA@@@@@@@@A@@BA@@@@@@@@@@A@@@@@@@@@@A@@BA@@@@@BB@@@@AAA@A@@@@A@@A@@A@@@@A@A@@@@@@DA@@@@@A@A@@@AB@@@B@@@@@B@@@@AB@B@@@@@B@@@BA@AB@B@@@@A@E@@B"],encodeOffsets:[[122295,28586]]}},{type:"Feature",id:"331126",properties:{name:"庆元县",cp:[119.06259,27.61922],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAAACCCAEAGCCACCAAAAA@AACECACAC@C@CBCDCF@BCBA@A@A@A@AAGEAACACBEDGD@B@FAFA@EDMDCEC@EDCDCDCDCHKFEHGBMAIAG@E@GFGHAAMG@EBCB@FCFE@CAAACBE@CACCACAC@C@C@ABEACAEAC@C@GEECDABCBC@AACAC@CAACGAACAAB@@ABADCDCBA@C@CBC@CBAB@DABA@CBC@C@EAC@A@E@A@C@@AABEAC@EAEBEBA@CB@B@B@DBDAFADADCFCBABABC@AAA@A@@BAB@B@@@D@@BBBB@F@D@BADADADAB@BKCCDDD@DABC@AB@DBBAH@B@BCBABAAA@EAEAAAC@CBEDAB@B@B@DBD@B@@BDBBD@DBFNFH@@HBRDBBLHJRDNHFJBHBDFGDEF@L@J@DAD@B@BDBBBBB@DADBB@@@BBF@F@DBD@BB@DBBB@DBB@D@@@@@BAB@BC@@@C@@@C@A@@@A@ABAB@D@B@D@BBBABABAB@BBBBB@BBDAD@@@@@@@@@@@@@B@DEFCFADCHABCDA@ABGDCFA@EFAD@B@B@B@B@@ADCDABAB@B@B@@@DB@FFDFBFAFEFCFBHDBF@H@J@LAH@BB@D@DCDCFBF@DA@C@EAC@CDCDADCDCFADEDCBC@A@@BA@AD@BAD@B@@ADAFCHCHADCHABADCBADAD@H@D@@ABC@E@ABADGDA@CB@BDDADCHFDJDF@DBAFADCHADBD@@@B@B@DAB@DCBA@EFABCF@FAFAD@BA@BBBDB@B@@AB@@@BBDB@@BAB@@@B@B@B@B@B@B@BBBAB@@B@@BH@@@BBBB@@B@@B@@BB@D@@@BB@B@@B@@B@@@@@@D@B@B@@@BAB@@@BBB@@@BDB@A@@@@@@B@@@B@@@BA@@@@@B@@@BB@@@@B@@@@@B@@B@@B@@@B@B@@@B@@@@@B@BBBB@@BA@@B@BBB@@@@@BB@@B@@@B@@@B@B@@BBBBBBA@@BAAABA@@@ABA@@@@AB@@@A@@AA@@D@@@@@@@AAA@AA@@@@@A@@@@@@B@FA@B@@BAB@@AB@@@@@@A@@@@@A@@@A@@@AB@@A@A@@@@BA@@@AA@@A@@B@BAB@@@@AB@@AB@@@@@B@@AB@@@@@@@@@@@BABA@A@C@CAA@@@A@@@A@@BB@@@@@@B@BA@@B@@@B@@B@@@B@@@@B@@A@@@@@B@B@@@A@A@A@@@A@@@@BAB@@@B@B@BA@@B@@A@@BA@@@A@@@@B@B@@@B@@A@@@@@A@ABA@@BC@A@AB@@@BA@@BB@@@@B@@BD@@@BB@@@@A@@BB@@BBD@@@@B@@@B@@@B@@@@@B@@B@@BB@@BBB@@@B@B@@@DB@@BB@@B@DABB@@DA@@B@BA@@@@@@BAB@B@BA@@B@BB@@B@BA@@@@B@@@@BB@@@D@B@B@B@B@@@B@@AB@@@B@@@B@@@B@BBB@BABADABAB@@A@@AA@AA@BA@@@@BBB@BBB@B@B@BBB@BBB@D@@@BAB@BA@@DADB@@BB@@@A@@AA@ABA@A@AAA@AA@@AAAAAA@@AA@@AECCAA@A@CBE@@A@@@@B@DA@@D@DBD@D@@@B@@@@A@@AA@A@@DBDBB@B@BB@@@@B@BDB@@BBB@BBB@@@@@@B@@@B@B@@@@AA@@@@@@A@@@A@@AAB@@A@@@@BBB@@@BBBB@@@@@@B@@@BAB@@AB@B@B@@ABE@A@@BC@ABA@A@C@AB@@A@A@A@A@@@AB@B@@@B@@@B@B@@@B@B@@@B@BB@@B@@@DD@@@AB@B@@A@@@@AA@A@@@@BAB@@@D@@@@@@@@A@A@@@ABA@A@@AA@@@@BA@@@@@@@AACAA@@@@@ABAA@@AA@@ABCA@@@AA@AAACA@@A@@@@A@@B@BA@A@@DC@A@A@@CB@@A@@@@A@@@@@@@AB@A@@A@E@A@@BC@CBA@@@@B@B@@@B@B@B@DC@@B@@@BA@@@A@@BC@@@A@@@@AAAA@@@AAA@@DA@@@@@@B@B@@@B@@@BABA@@BB@@@@@@@@DCB@DA@@@B@@B@@@BAD@B@@A@@@@@BBB@@BB@@@BB@@@@BB@@@@@B@B@@@BB@@AB@@@@B@@@B@BBB@@B@@BBBD@@@@B@@@@@@@B@@@B@@@B@@@A@@@BBBBDBBB@@@@@A@@@AB@@@B@@@@@ACAC@A@@BAB@@@B@@@@@BBDBBBB@@@@A@@A@@@AA@A@@@@@@BA@@@A@@@@A@@@A@AAA@@@@@@CA@@A@@@@@A@@BA@AB@B@@A@A@@@@@AB@@@@@@A@@@AAA@A@@AA@A@CB@@A@@@A@A@@B@@@DB@@BAB@DBB@@A@@B@DB@BBB@@BB@@BAB@B@BAB@@@B@@@B@B@BAB@BAB@@@B@@@B@BA@A@@B@BAB@DB@@@@@BBD@BAB@@@@@BBAB@@@DA@@@BB@BDBB@BBBBB@@@@ABB@@B@@A@@BA@A@B@@BBBB@@@B@@@BB@@@BB@BBBB@@@ABB@@BAB@@B@@@B@@@B@@B@BAB@@@B@B@@ABAB@B@BA@AB@@@AA@@A@@@@A@AA@@@@@@@@A@@A@@@A@@@AB@AAB@@@@AA@@@A@A@@@C@AAA@@BAB@@@@A@@A@AA@@AB@@@@AA@@@@@@@@@A@AA@BABAB@DA@@B@@@B@@@B@@@B@@@B@BA@@@@@@@B@B@@@BBBDB@BB@B@@B@@BBA@@B@@@@B@BB@F@B@@@@@BB@BB@@@@@B@B@B@@BB@B@BBB@BBB@@@BBB@B@@@@@B@@BB@@@@BB@@@B@@@@@B@@BDBD@@@BBB@BB@@B@@@B@@@B@@BD@@@B@@@B@@BB@@BBB@@B@@BBBBDD@@B@@@BA@@DBD@BAB@D@F@@@B@B@@@BB@@BD@D@@@B@@@B@B@B@B@B@@@@ABA@@@@@@@A@ACCA@@@@@@@AA@@@@@A@@@@A@@A@@@A@BD@B@@@@DBBB@@@B@@@@DB@@B@@B@@BDBB@@AB@B@@B@@B@@B@@@@B@BBB@BB@@@BB@@@@@@BB@@@B@@@B@@@B@@@@A@@B@B@@@B@@@B@B@@@@BB@@@@@B@@@@BB@@@@BB@B@DB@CB@BA@AB@@A@@BA@ABABABA@A@@@EDABC@AA@@@AA@@@@@A@AA@@@@@AAAA@@@A@AB@BCB@B@@@@@B@@ABCB@BA@@DBB@@@BA@CB@B@@BB@B@B@BA@@@CB@@@B@@BBBDBB@B@@ABBB@B@@@@@B@BABB@B@@@BBBB@@B@B@@@@AB@@@B@B@@ADAB@DBB@@@BDB@@BBBB@B@HBD@B@B@@B@@D@B@@@B@@BDA@@@A@@@@@A@@@@@AB@@BBA@@@@@@@A@@@@@ABA@@B@BC@AB@B@@@B@@A@@BA@@B@B@BAB@@@B@@@BDB@@@@B@@@@B@@AB@@@B@@@BB@@@@BBB@B@BB@@DB@@BAB@@BB@@@B@BBDB@B@B@@BABB@@B@@B@@B@DBBBDDB@BA@@B@BBD@BBB@@B@@BB@@B@@@B@AD@@BB@@B@@BB@BB@@@@@@AA@@@@D@@@@@@@@@D@BA@@@AB@A@@@@A@@@@B@B@@@@@@@AAAA@@@@AA@@AAA@@@A@@A@@A@@@@@@AAB@@A@@B@@@AA@@@@B@A@@@@A@ABAB@@@A@@@@AA@A@A@A@@@@BB@@B@@@@@B@@B@@B@@AB@@@@BB@@A@@@@B@@@@@AA@@@A@@@@@@@A@A@@@@@AA@C@@@@CDABA@A@@A@@@@A@A@@@ABA@@@A@@@C@AB@@@@@BBB@@@@B@@BB@@@@B@@A@@@@@@@A@@@@B@AA@@@A@@@@@@A@A@EE@@@@@AAAAAAC@A@AA@@AAC@@AA@A@A@A@@@@@A@@@@@@@CA@@@@@@@@A@@BCB@DA@@A@@A@@@AB@@@BC@@@@AA@@@A@@BA@@B@@@@@@AB@@A@@@@BA@@@AB@@@@AB@@B@@B@BB@@B@@@B@@@@B@@BB@@@BBB@@@DBB@DDD@@B@@@@BB@@@@@DBBBA@@BB@@@@@AB@@@@@B@@B@B@B@@B@@BA@@B@@AB@@C@C@@BC@@B@@BD@@@@@@@BA@A@@BA@B@@@@BA@@@@@BB@@@D@@@B@BB@AB@@@BBBB@@@@BABABAB@@A@@@A@@@@@@BBB@HH@@@B@@@BBBB@@@@B@F@@B@@@BAB@BA@@@A@@@CB@@@BA@ABA@ABA@A@AB@@@@@B@@@@BBB@@B@B@@@BA@@@@B@BA@@BB@@B@BB@AB@@@@ABABA@@@A@@@AB@@A@@@ABA@@@@B@@AA@@A@@@@@@@AB@@@BB@A@@BA@@@@B@B@@@B@AA@@BA@@@AB@BA@@@ABA@A@@B@@@@A@@@@@AB@@AAA@@BA@@@A@@@@@AB@@@BA@@@@@A@@@@@@BA@@@@@A@A@A@@A@@A@AAACGECA@AAA@A@@AA@C@CA@@AAA@@A@@A@BAAAA@AAA@@AA@AB@@A@A@A@AB@A@@@@AAAAA@AA@@@A@A@@@@@A@@@AAAA@@A@@@A@@A@@@AA@@@@@@@@AA@@A@@@@@@A@A@@@AABAA@@@A@A@@@A@@A@AAA@AB@@@A@@B@@@@A@CAA@@B@BA@A@A@@B@BA@ABA@@BA@@BA@@@A@@FBA@@B@@@@B@@@@@B@B@B@@B@@@@BBBB@@BCB@@C@@B@@@BABA@A@@BB@@AAB@@C@@@BB@B@DB@@@@B@@@@B@B@@@@B@B@@@@@@@B@@@@@@B@@@B@@@BB@@CDCB@BA@@BAB@D@@BB@@@BB@@@B@B@@@B@@B@D@B@@A@@@AAA@@A@@A@@@A@@A@@@@@B@F@@@@A@A@@@AB@@@A@AACA@@@@@@A@@@A@@A@@@A@@@@@@@@B@@@AA@@@@B@@@@@@@@@@@B@@@@@@@@AB@@@@@@@@@@BB@@@@@@@B@@@B@@@@@@AAAB@@@@AB@@@B@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@AB@@@A@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@A@@@B@@B@AABA@A@@@@@@BD@@@@DA@A@@@ABA@A@@B@@@B@@@@A@@B@B@@@BBB@@@@@@@@B@@B@@BBB@@AB@@@B@B@@B@B@@@@A@A@@D@@@AA@AA@@A@@A@@AB@@@B@B@@@B@@@@@B@BABA@A@@BABA@@@A@@@@@@@A@A@@@@@@@A@@@@B@BBB@@@@@AA@@@@BA@@B@BB@A@@BA@@@@@ABA@@@AB@@@BA@A@@B@AA@@A@A@@@@BA@@@A@AA@@@A@A@@@@FABAB@B@AABAB@@@B@@A@@@@BB@BFB@A@@BA@AB@@@B@@B@@BB@@B@B@BB@BDBDBBBB@DDBD@@@@AB@BB@@B@@@@B@@BB@BB@@@@BB@A@@@BB@@@B@@@@@B@@@@@@@@B@@@BBBD@@@@@@@@@B@@@@@@@BA@A@@B@ABB@@@@@@@B@@@@@@@B@@@@@B@@AB@@@DABABA@@DD@@@@@A@@B@@@@@@AB@@@@AA@@A@@@@@@@@@A@@@AB@AA@@B@@A@@@@@A@@@A@@@@@A@@B@@A@@BA@@@ADAB@D@B@BB@@@@@BBB@B@B@@@B@B@@@D@B@BB@BB@B@@@@@BB@B@@@@@@BB@B@@BB@@@DBB@@@B@@@@AB@B@@@@@@@BDB@BB@@A@AB@B@B@@@BA@@B@@@BBA@@B@@@@B@@@@@B@@@@@B@@B@D@BAD@BA@@@AB@@@@@@A@@@@B@@@B@@BB@@@@B@@@A@@@@@A@A@@BA@@@@B@D@@BBBB@@BBB@@BB@BB@@@@D@@@BB@@@@B@BB@@B@@BB@@@@@@BD@B@BBB@@@B@@B@@BB@B@@B@@B@@@B@D@@A@@BAB@BA@ABABA@@B@AAAA@@B@BAA@@A@@@@@A@@@@AA@@@@@@B@BA@@@@BAB@B@@@D@@@B@BA@@BAB@BADA@@BA@@@A@@B@@AB@@@BABA@@@@B@BA@@@@AC@A@@A@A@@@CC@@A@@A@A@@A@@AA@@A@AA@@A@A@A@@@A@@B@@@@A@@B@@A@A@A@@@@@A@@@@@A@A@A@@@A@AA@BC@@A@@A@C@A@@@A@@BE@@@@@ABA@@@ABA@@AA@@@@@@B@@@@@@AB@@@B@@@@@BA@@BA@@@@DD@@@@@@AB@@A@@@A@@BA@@@AB@@B@BDBBB@@@B@@@BA@@D@@@@C@A@@@A@@@@B@@A@@BA@@@@@@@BB@@BB@@BB@@@@@B@@@DA@@@@B@@A@@@C@EAG@EAMBEAACCBCBCAG@@BC@ABABE@AACCAOKCCCGACGO@@@@@ABABMBK@ACKKS@E@KCKAAGKGK@@SSIGKCEAICIJGLCN@D@FGJABKFO@E@K@I@UEOCCAGCCAQMGGEEEEIGEG@@B@@AB@@A@A@@@AAABA@A@@BAAA@@B@BAB@@@@A@A@@@A@@B@@AA@@@AAA@@@A@@@@A@@@@@A@@BA@@@@@@@A@@A@@@@@A@@@@@EGKGEAGAKGMEOECAKEOECAIEEMCICI@A@@@CAECCAA@@AACGCE@CAAE@EA@@EBGBGCAACA@@@F@JAFABEC@E@KAAABAAAAA@AAABABABC@GAGAAACB@@EDA@CBCBEBC@AACAAA@@AAEBC@CAC@A@ABCFEBCBA@ABCBCB@BA@C@CAEC@@E@C@A@AB@BAB@@A@@@AACAA@CACBABABA@A@AACAAAA@@@A@AB@F@B@@AB@@CDGBC@C@@@CAAAA@C@A@A@CFCBA@AB@B@DBDBH@BBD@@@BBB@D@B@BCDGFADJDL@D@B@@DAFAF@DADADCDA@ABCBCBEBE@"],encodeOffsets:[[121974,28143]]}},{type:"Feature",id:"331127",properties:{name:"景宁畲族自治县",cp:[119.635739,27.9733],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@@A@A@@@@A@A@@BA@@AA@AA@@@@A@@@@@@A@AA@@A@A@@@@B@@A@@@A@@@@BAB@B@@@BCB@@@@AC@@@@@@@BABBBA@@@@A@@@B@@@@@@B@@@@@@B@@@@@@@@@@@@@@B@@@A@@B@@@@@@@@@BA@@@@@@@@@@@@@@@@@A@@@A@@B@@A@BB@B@@@@A@@@A@@@@@@@A@@A@@@@@@@@A@@B@@@@@@A@@@@@@@@@@@A@@@@@BB@@A@@@@@@@@@@B@@@BB@@@B@@@@@@@DBBBB@@@A@@B@@@B@B@@E@A@@@@@B@@@@B@@@BB@@@BB@B@@@BA@C@A@@@@A@@@A@A@@AA@@A@@AC@A@AB@@ABA@CD@DA@@A@@@A@@@A@@@@A@@@@@@@A@A@@@@@@A@A@@A@@@@@CAA@A@@A@@@DA@BB@@AA@@@BABAB@@A@@@@DA@AD@@AAAA@@@@@AA@A@A@@@@@A@@@@@@AB@EA@@@B@@AB@@AB@@AB@BABA@@@@B@BABA@@@BB@D@B@@A@@@@B@@BAB@BBB@@@@B@@@B@B@@BBBA@B@@@B@B@@@@B@@@BB@@@@@@@@BB@@B@@@@B@@@BB@BB@B@@@B@@@@@B@B@@BBB@BBBB@@@@@BBAB@B@B@@@BAB@@BB@BBB@BBABB@@@@BB@BB@@DBD@B@@BB@B@BBB@FDDHBB@B@BB@@@@B@B@B@@@@AB@@@@@@@B@@@@AB@@A@@B@@@@@B@@AB@@BB@BA@@B@@@@@B@@A@@@@BAB@B@@ABA@@B@@AB@@BBA@@@A@A@@@@@AB@@@BAA@@A@@B@@@@@@@BB@@BA@@@@@AB@B@@@BA@@B@@@B@@ABAB@B@@A@@BAAA@@@AA@@ABA@@@@@AB@@A@A@@@AA@A@@A@@@@@A@@B@BAB@BAB@BAB@@A@@D@@@B@@ABA@AB@@A@@@@E@A@@A@AA@A@@@A@@GGA@AA@@@@@@@B@@@BA@ABABAB@@@@AAAA@@A@@BAAA@@@C@@@A@@A@@@@AB@@@@@AAB@@@BAB@@@@@@C@@AA@@@AD@@@D@DA@@BA@@@AB@@@AA@A@A@@@@A@@@@BA@@@@A@@AB@AACA@@@@A@@A@@A@@@CC@CAA@C@@AA@A@@AA@@@A@@A@@@A@@@AAA@@@@AA@@B@@A@@B@@AB@@@@@BA@@B@@@@A@@@AB@@@B@@BB@@@@AD@@A@@B@@@BB@@@CBA@AD@@@B@@@@@@B@@D@@@@@@@B@@@@@B@B@BBB@@BD@BB@@B@BBDBBBB@B@@@@FFB@B@@@@@@@@B@@BBA@@@@@@B@@@@@@@BA@@@@@AA@@@A@@A@AA@@@@A@@B@D@@@B@@AB@B@@@B@B@@B@@@@BABCB@D@@D@B@@B@@@@@B@B@@@@@@@B@@BB@@@@A@@@@@@BA@@A@@A@@BA@@@@AA@@@@@A@@@AA@@@@@B@B@BBB@@@@@B@@BABAB@@@@@@B@A@@B@@B@@@AB@@@BA@B@@@@B@@@@BB@@@B@BB@@BB@@@@BBBB@@@@@@A@A@@@@@@B@@B@A@@B@@B@@@B@B@@@@@BB@DBF@@@@@A@@@@B@@C@@@@@ABB@@@@@AB@B@B@@@@@B@@BB@@@@B@@@@B@@B@@B@@@@A@BBA@@B@BDBBBB@@BBDB@BAAC@A@@AAA@@@@A@@@ABA@@@A@AB@BA@@B@@ABBB@B@@AA@@@A@BA@@B@@@BA@@B@@@@@@@B@@@@@@@@ABB@@B@BBB@BB@@B@BBB@@@@BB@A@@BA@@@@@AB@@@@@B@@@@@B@@@@@ABB@@@B@@BB@B@@@B@@@@BB@@B@@@B@@@@A@@@ABAAA@@B@@@B@B@B@D@@@@@@B@@@@BB@@@@@BBB@B@@@B@B@B@@@@@@@@B@BB@AB@@A@@@@BB@B@@@@@@BB@BBDD@@@BB@@B@@@B@@@@@B@@@@@BAB@@B@@@@@@ABA@@BAB@@@@@BB@@@BBDBD@B@BBB@B@@@BBB@@B@@@@AB@@@B@@@B@@A@@@@@CBA@@D@BAB@B@@@DD@@B@DFB@@BBB@BB@@@BB@B@@BAA@AC@@@@@@@@B@@BB@@BB@B@@@B@@AD@@AB@@@AA@AB@@A@@@A@@@@BA@@@ABA@@@A@@BA@@B@@@@B@@@@B@@@@@@@@B@B@@@BABAB@@AB@@A@@@@@AB@@B@@B@@B@BA@@B@BB@@B@@A@@@@B@@@@@@B@@@B@@@@@BB@@B@@BAB@@@@@DAB@BA@A@@@AB@B@B@@@@@BD@B@@@@B@B@@A@A@@@A@@@@BBB@BCDA@@BB@@B@B@@@B@B@@@@@B@B@@A@@@@@A@@B@@@BB@@BAB@@@@@@@@A@@B@@@@@B@@@AA@@@A@@@@@@B@BBBBBBB@B@@B@@@@@@@@@@@BB@@D@@BBBB@BB@@@B@@@BAAA@@B@@@@@B@B@B@@@@BB@@@B@@B@@@@B@@@@@A@@D@@BB@@@@@B@@@@BB@@@@AB@@@@@@B@@@@B@@A@@B@@@@B@@@AB@@@@ABA@@@@A@@AB@@@@@@DBB@BB@@@A@@BA@@@@@@@A@A@@@@B@@@B@@@@A@@BB@@B@BDBB@@BA@B@AB@@@@@@@@@@@B@@@@@@B@@@@@B@ABB@@@@B@BD@@@@@@BA@@@@@@B@@@@A@@@C@@@A@@@@B@@@@@B@@B@BBB@@@A@@@@@A@A@@@@@@B@@@@@@@@A@A@@@A@@B@@A@@@@@A@@@@@AB@@@B@@@@@B@H@B@B@@@@@A@@@A@BA@@@@B@@@@@B@@A@@@@DA@@@A@@A@@@@@AA@@@AB@A@@@@AB@@A@@@AB@@@CC@ABA@@@ABA@C@@@A@@@@B@@@@@BBB@@@@@@@B@@@@@@@@B@@@@@B@@@@B@A@@@@@A@@B@@B@@@@@@@@@B@@@@@@B@@A@@B@@@@@@DAB@@B@@BAB@A@@A@A@A@A@A@@@AB@@@@AB@@@B@@AB@@AB@@@@A@@@@B@B@@BABBB@@@BB@B@@@@B@@@@@@A@AAA@@@BD@BBBAB@B@@@B@@@B@@@@@@B@AB@BA@BBA@@B@B@B@B@@@B@B@B@@BBBBF@@@@@@B@@B@@BB@@A@@B@@@@@@@@@@@@@B@@@@@@@AB@B@BB@@BB@B@@AB@B@@A@@@A@@@@B@B@@@@@@@@@@@B@B@@AF@B@@@@@@ABAB@@A@@@A@@@@@ABA@@@@@A@@@@@A@@BA@@B@B@@@@@B@@C@@@@B@B@@@@@@BB@@@@B@@B@B@BB@@@@AA@@@@B@@AB@@@@@@@@@@@@B@@@@@@@@B@@AB@@@@@@@@@AB@@@@@@A@A@@B@@@B@@BB@@@@B@B@B@B@@@@ABA@A@@BAB@@@@BAB@@AF@@B@BBB@@@B@B@@B@@@B@@BB@BDB@@B@@@B@@ABA@@BA@@@@@B@B@@D@BB@@BB@@BB@AB@@@BAB@B@@@B@B@B@B@HB@@B@@@DB@@BA@@@BB@@B@@@@@@BBBB@BBB@AD@DABAB@D@@@@B@@@@A@@@@B@@@B@@@@A@@B@@AB@@B@@@AB@@B@@BA@@BB@B@@@@BA@@B@@@@@B@@B@@@BB@@B@D@D@BBB@@@@@BA@@BA@@@A@@D@@@BA@@BBB@@A@@BA@@A@AAA@@@@@@@BA@@@A@@@AB@@@B@@AD@B@BCB@@@BB@@@BBB@@B@BAB@B@@@@@@BBB@BAD@@@B@@@@BB@@@@BB@@@AB@BB@B@@B@B@@@B@@@@@@@@BA@ABA@@@@@A@BB@@A@@@@BABB@BA@@B@@A@@B@@@@B@@B@@@@BB@@@@BA@@@@@@@@@@@A@@B@@@@@BA@ABA@CB@@@B@B@@@@@BA@AB@BA@@B@@@@@D@B@@BB@@@B@BBD@@@BA@@BA@@B@B@@@B@@@D@@@BA@AB@@@@A@@@ABA@@@@B@@@@A@@@@@@@A@@BA@CB@@@BA@AB@B@@@B@@@B@@@BAB@@@BBB@BAB@@AB@@B@@B@@@@AB@B@@AB@@@B@@@@A@AB@@ABAB@@@@@B@B@@@@BB@@B@@@B@B@B@DBBBB@BB@A@@BA@A@@B@B@DBBBB@B@B@DB@@@@B@@@B@B@@A@@B@@AB@BA@@BA@@B@@A@A@@@A@@@@BA@@B@@@@@@@@@@B@@BA@@@@@ABB@@@B@@@@@@DB@@B@BB@@@B@@@B@@DBB@BBB@B@BA@@@ABBD@D@B@B@BB@@@BB@@@@BB@@BBB@@@B@@@@@B@B@@@BA@@@BB@@AB@@@B@@@@@@B@BB@BBBB@@B@@@@BB@B@@B@@@B@@@BB@@@A@AB@B@B@BABAB@@BBABB@@B@B@@@@B@BB@B@B@@BB@@BB@@@@BB@B@@BB@BDB@B@@AB@B@@@@BB@BB@@BBB@@@AB@B@@@B@@@B@@@@@BB@BD@@A@@B@@@@B@@@@BB@@BB@B@@BB@@@@B@@B@BB@@@@@B@@A@@@B@@B@@@@B@@B@B@@@@DD@@@@B@@ABA@@@@@@@@@@@B@@B@@@@A@@D@BB@@A@@B@@@@@@@B@@@@ABB@@@A@@@@B@@@@@B@@B@@@BA@AB@@@BB@@BB@A@@@A@@AA@A@A@BB@BB@@BB@@@AAA@@AA@ABA@A@@BBB@@@B@B@@@B@BAB@@@@B@B@B@@@B@@AD@@@@@@@B@B@@BB@@@@B@@@B@@@@@@@B@@@@B@BB@@@@@B@@@@@BA@@BA@@@@@@@@B@@AB@@@B@BB@@@@B@@@@BA@C@@@CD@@@@@D@BBB@@@@BB@@@@B@@@B@B@B@@@@BB@B@@@B@B@@@BA@@BBB@@@@@BB@@@BBBB@@D@@@@B@@B@@@@B@@@@@@@BB@@B@@B@@@BBDB@@B@@@@@@BB@@@@B@@@@B@@@@B@@BB@@BB@@@B@@B@B@@@@B@BB@@@@@@@B@BB@BB@@@@@@@B@@@@B@@BB@@@@@BBBABAB@@@B@@@B@@AB@@@B@@@B@@BBB@@@@@BDBHB@ABBB@B@@BB@B@B@B@@@@B@@B@B@B@BBB@B@@@B@BAD@B@B@B@BA@@B@@BB@B@@BBB@BBB@@BBABB@@B@@BB@@BB@@B@@B@B@@@BBBB@@BA@@@AB@@@BA@@@ABCD@@@B@@@@@@@B@@BB@@@@ABB@@@AB@@AB@@B@@B@B@@AB@@BB@B@@BBAB@B@@B@@B@@@@@BB@B@@@B@@@@@BAB@BADB@@@@@B@@BB@BB@B@@B@@BBBB@@B@@BB@@@B@@B@@B@BB@@@B@ABA@@@ABBDB@BB@@BB@@@BFB@@A@@@@BB@BB@B@B@@@@ABAD@B@@@D@@A@@@@@@A@@@BAB@@@@@@@@A@@A@@@@@@@@@@ADABAB@@A@@@@B@@@@AB@@A@@@@A@@@AA@@@@A@A@@A@@A@@A@@@A@@BA@@@ABA@@B@BAB@@@B@@@D@B@BA@@BAB@@BB@@AB@@@BAB@@@B@@@@@B@@@B@@@@@B@@@B@@AB@B@@@B@B@BA@@BA@@@BBB@AB@B@@BB@@@B@B@B@B@B@BA@@@A@@@@@A@@BA@@@A@@@A@@@A@A@@@A@@@A@@B@AA@A@AA@@A@@@A@AB@B@B@B@D@B@@@@@BBBB@B@@@B@@AB@BBB@@AD@@DB@@@@B@@@@@@BAD@BAB@@A@A@ADA@@BA@@BA@A@@@A@@B@@A@BB@@A@@B@@@@@BBB@@@@B@@@B@@@B@@BB@@@@BB@@@@@B@B@B@DA@@B@BBB@B@@@B@@@BAB@@AB@BB@@BD@@@DC@@@AA@@@@ABCAA@@@DF@@@B@@BB@B@B@B@BB@@@@BBBA@BB@@@@@@BA@@BA@A@AB@@C@A@@@AB@@@@AB@@@@A@@@AB@@B@@B@@@B@@@@A@@@@@@B@B@@@@B@@B@@@BB@@@BB@@BB@@@@B@@@B@@@@AB@@@@B@B@@@B@@@@BBF@@@@@@BBA@@@AB@@@B@@@B@@@B@@B@@@@BB@@@B@@B@@@@B@B@@@@@B@DBDA@@B@@@@@BAB@@A@B@@BB@@BB@@B@@@@B@@@@@B@@@@@B@@@@CBA@@@@B@B@@A@A@@@A@@@@BA@A@ABA@@B@@@D@B@@@@@B@@@BA@@B@@@@@B@DAB@BA@@@@BBB@@@@@@B@@@B@BB@@B@B@@ADA@@B@BAB@@@@@B@@@B@BABABAB@@A@AB@BC@@@AB@BA@@B@B@BAB@@@B@@@DABAB@BA@@@A@A@@@A@@@A@A@@@AA@A@@A@@@AB@@AA@@AF@@@B@@@B@B@B@@@B@@AFADAB@@@@AB@@@BAB@@A@@B@@@@@B@BB@@CB@@@B@@@B@B@DA@@@@B@B@BBB@@AB@@@B@@AB@@@BAB@BA@BBB@B@B@@@B@@B@@@@BA@AA@@A@@BA@@BA@@BA@@@A@@B@@A@@BA@@@BB@BB@@BBB@@B@@BBBB@@A@@@@B@@A@@@@B@@@B@@@BA@@@@@A@AB@@@B@B@B@BAB@@AB@@A@A@@@AB@@A@@@A@ABA@AA@@@BABAB@D@@@@@B@B@B@B@@@@BBBB@@AB@BBDB@B@@@B@@@@@DA@@BAB@B@BA@@@BD@@@BAB@@@B@@AB@B@@@BB@@@@B@@AB@BA@@DBB@B@@@@@B@@@B@@A@@B@@@B@B@BB@@BA@AB@@ADA@@BA@@B@@AB@@@@A@@B@B@@@BAB@@@BA@@AA@@@A@@BA@@@ADDBB@@@@DDAB@B@B@@BB@B@@@@@BB@@B@@B@@B@B@@@BB@@B@@@@@@@B@@BB@@@B@@@BB@BB@@B@@@@@@@BB@@B@BAB@B@@@B@@@@@BBBB@@B@@B@@@DB@@@@@@ABA@AB@@@@@B@B@B@BABA@@@A@@@A@@@@@A@@AA@@@A@@BAB@@@@A@@BA@@@A@@@A@@B@@@@@@A@@@@@@@@@@BABABA@@BABA@@@A@ABABA@AB@@A@@B@@@@@BA@@@@@@BABA@A@@B@@A@@B@AAD@@@@AB@@@@A@@@@@AB@@@@@AAAB@@@AB@@@@ABA@A@@@ABAB@@AB@DA@@BA@@@@@ABA@A@@BABA@A@@@AB@@@@@@A@@BAB@BA@@BA@@@A@@@AB@@A@ABA@@BAA@@@@A@A@@@@@AB@BA@@@@B@@A@AB@@@B@@A@@BA@@@@A@@@@@@A@@@@AA@@BA@A@A@A@A@AAA@AA@AA@@@@@@@AB@@@B@@@A@AA@@A@@@AA@AA@@AAA@@A@@A@@@@B@D@@A@A@@@A@A@@BA@@B@@B@@BD@@B@@@B@@@BA@@@@BA@@@@@@B@@@@@@@@B@@@@@@@@B@@@@@@@B@@@@@B@@A@@@@D@@@B@@@@AA@@@@@@@@AA@@A@@B@B@@BB@@@B@@@BA@@AA@A@A@@@AAA@A@@B@@@BB@@BAA@@@B@B@@@B@B@@@B@@@@AAA@A@@@C@@B@@AA@@AB@@@@A@A@@@AB@@A@A@ABA@@B@@@@A@AB@@@@@@@@A@@A@@@@@AA@@@AA@@AA@@@BAB@B@@A@@@AB@AA@A@@@AA@@@A@AB@@ABA@@BA@@A@A@@AAA@@@@A@@AA@AA@B@@@AC@@A@@@@A@@@A@@@AA@A@@@AABC@@@A@@@A@@@A@@A@@@@A@@@A@@@A@@@@@A@@AA@@AA@BAA@@AB@@@AAA@A@A@@@@@AA@@@A@AAA@@A@@A@A@@C@A@A@AA@@AAAAA@@@@@ABA@@@@@A@A@@@A@@@A@@@A@A@@@@BA@A@AA@BCBA@A@@@@@A@@@@@@@@AB@@@@A@@AAABAB@BA@@A@@@@@A@@@A@@A@@@@BCA@@@E@ABA@ADA@@AAAA@@@@@@@@B@@A@A@@@@@@BA@AD@@A@@@@@BB@@@@@@A@AC@@@@@@@@@B@B@@@B@@@@AB@@@@@B@BA@@ACA@@@@A@@@AA@@@@A@A@@@@@@AA@@@@@B@@@@@AA@A@@A@@@@@@@@AAB@@@@@@AA@@A@@B@@@@@@@@@B@@AB@@@@@@@@A@@AA@@B@@A@@@A@@@AA@@@@C@@BA@@B@B@@@@A@@@A@@@@@@B@@@B@@@BC@ABA@A@@BA@A@@B@@@@A@@@@B@@A@@@A@@A@@ABA@@@@@ABA@@@@@A@AB@@@@@@A@@B@@A@@B@@A@A@@@AB@@A@@A@@@A@@A@@@@@@A@@ABA@@@@@@A@@@@@@@@@ABAB@@@B@@@@A@@@@@@@@@@@@B@@@AA@A@AB@@@B@@@@@@@@@@A@@B@@@@A@@@@@@AA@@@@@AA@@@@@B@@A@@B@@@@A@@BB@@B@@A@@@A@AAAA@@A@@@AB@B@@AA@@@ABAA@BA@@@AA@AA@@AA@@AA@@@@A@@@@AAA@@A@A@@A@@A@@@@A@@A@@A@@A@@@@AA@@C@A@@A@@@AA@@AAA@@A@@AB@@@@A@@AAA@@A@@@A@@@ABA@@@@AC@@A@@@@@AAA@@AA@@C@@@A@@@@AA@BC@@@@AAA@@@A@A@@A@@AB@@@@AB@@@@@@@@A@@@@AACA@@@A@A@@@A@A@ABA@@@BB@BABA@@A@@@@A@@AA@@@@A@@@@AA@A@@@AB@@@B@D@BCB@BABA@@@AB@@@B@BAB@@AD@@CA@@AAA@@@C@A@@@@BA@A@@@AAAA@@@@C@A@@@A@@A@@BA@AB@@@BA@@C@A@ABA@@A@@@@@@@BAA@@AA@@A@@A@@@@@@@A@@@@@A@@AAA@@BAD@@AB@@@B@@@B@@@@@AA@BA@@@AAA@@@@A@@@AB@A@@@@B@BAB@@@@ABABCBA@@@@@@@BBAB@@ABA@@B@@B@B@@B@@A@@@A@A@A@@B@@AB@@@@@B@@AB@@AFABABA@@B@B@@ABA@@BA@@B@@@@A@@@@AA@@ACA@@@A@@@A@@@@@@@A@A@A@AA@AA@@@@@A@BA@@A@@@@A@@A@@A@@@@A@@@@A@@@A@@@@@AA@@@@@AAA@@A@@@@@@@AAA@@@@@A@@@@AA@@AA@@BA@A@@@AA@B@@@AA@@B@@@@@@A@@A@@@A@@B@@CA@@AB@@A@AB@@@@@@CB@@A@@AA@@@@@CBA@@@@@A@@@@@@B@B@@A@@@@BA@@@A@@@@B@@A@@@@@@@@B@@@@@@A@@@@@@BA@@@AB@@@@@@CBA@AB@@A@AB@BA@@BA@A@@@@@@@@@@@AA@@A@A@@A@AA@@A@@@A@@A@@A@@AAAA@AA@@@A@A@@@A@@@A@@A@@AA@@@@@A@@@@A@@@@@A@@@@AA@@A@@@@@@A@@A@A@@@A@@@@AA@@@@@@@@@AB@B@@@A@@A@@@@A@@AAC@A@A@AD@B@@@B@@@B@@@@ABABBB@B@BB@@@A@A@ABC@@BA@A@AB@@A@AB@@ABABA@@@A@AAAB@@AB@@@@@@@@@BA@@@@@@@@A@@@A@@@ADA@@@@@ABAA@@AA@@B@@@@A@@A@@A@A@@@AA@B@AA@AAC@@@@A@@@@AA@@AAA@@AA@C@A@@@@@@@AAAA@@@AAAA@@@@A@@AA@@@AA@@@A@@B@@C@@@ADABA@AB@@A@@@@@A@@AA@A@@AA@AA@@@@A@@AA@@A@@A@@@AB@AA@@@@BA@@@@@@@@@A@@@A@@@A@A@@B@@@@@AA@@@A@A@A@@@@@A@@@@@@@A@A@ABABA@@B@B@@A@@F@@@B@@@@@@A@A@@@@@A@@A@@@@AA@AA@@AB@@@AAAA@@@@@AA@@@@A@AA@A@A@@@@@@AC@AB@AABAAC@AB@@AB@BABABA@@AA@@@AC@A@@A@A@AB@@@@AB@ACAE@A@@BAA@@@@AA@@@A@CAA@AB@@A@@B@BAD@BA@AB@@AA@@BA@CBC@@AA@B@@A@@@@@A@A@@@@@@@@@@B@@@DAB@@@B@@BB@B@BAB@B@BABA@A@A@@@BAA@@CA@@@@@A@@A@@@A@@@@@@A@@@@AA@@AA@AAA@@@@@A@A@@@@@AA@AAA@@@@A@@@B@@@A@@B@@AB@@A@@@BB@A@B@@@@@BA@@BB@@@@@@@@B@@A@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@A@@@@@@B@@@@A@@AAA@@A@@@@@@A@@@@@@@B@@@@A@@A@@@@@BAA@@A@@@@@@@A@@@@A@@@@@@@B@@A@@BB@@@@@@@@@@@B@@B@@BA@@B@@@@B@@@@@@@@@@@DA@A@@B@@@AA@@@AAA@@AA@@A@@A@A@@@@A@@A@AA@A@@A@AA@@A@@@A@@A@@@AA@@A@@A@@A@@@CA@AA@@@AA@AAA@@@A@@AA@@A@@A@@@CA@@AAA@A@@@@A@@@A@@@ABA@ABA@@@A@@AA@ABA@AA@@@@AA@@@AAA@A@AA@@A@@@@@ACAA@@@A@E@@AA@@AA@@@A@@AA@CCA@@@A@@@AB@@AB@@AAC@CAA@@@A@@@AAA@@@AAAA@AA@@@A@A@A@@@A@@@@A@@@@C@@A@@A@@@A@@@A@@@AAAA@@A@@@@AB@@@AA@A@@A@@A@@A@@A@@@@@@@@@A@A@AB@AA@@@A@@@AAA@@BA@A@@@A@@A@@@@@A@@AAB@@@@A@@AA@@AA@AA@@AA@@@@@@@A@A@@A@A@@@@@@@AA@@A@@@A@@A@@@AB@@AA@@AAB@@@@@AA@@@@A@@A@@@@@AAC@@@A@@@A@@AA@AAA@A@@@A@@@A@@@AB@A@@@AAA@A@A@@A@A@@@AA@@A@@AB@@@AA@@BAB@BABA@@B@AA@@@A@@@@AA@A@@@A@@@A@@@@@A@@@A@@BA@A@A@@@@@@@A@@B@@@BAB@D@@AB@@CAA@@AA@AA@A@@@A@@@AA@@@@A@@AA@@@AAA@@A@A@A@A@@AA@@@AAA@@@@AC@@@AAA@A@@AAAC@@CAA@@AA@@@AA@A@BA@@@CBG@E@EBC@ADABCBCAA@A@C@ABA@C@C@ICEAA@AA@CACEAEAG@CBCB@@EFCBA@AAACCAAAEAAAEBABA@EEC@@@CBC@CAAB@B@B@HABE@ABAB@DA@@BE@A@@@@CAADEBE@EACACC@AAEBG@EBA@AA@A@EACACAACAEBA@CDA@CBEGG@E@@@@BA@@@@@CB@@A@@@@@A@@AA@@AA@@A@@@@@@AB@@@BA@@@@@@B@@@B@D@@C@@@AB@@A@@@A@AAACA@@@BA@@B@@AB@@@B@@@BA@@@@@@CC@@@@AB@@AB@@@@A@@@A@@B@@@@A@@@@@@@BB@@AB@B@@AB@B@@@@AF@@@B@@@B@D@BB@@@ADB@@B@B@@@B@B@B@@@@@B@@@@@B@B@BA@@@@B@@A@@@@B@@@B@B@BB@@B@BB@@BB@@@@B@BB@@@DD@@B@B@@@@BBD@@@@ABA@@@@@ABAB@@A@@BA@@@@B@@AB@@CBABA@AB@@ABA@@@C@@@A@A@AB@@@@ABA@@@@@@@BB@@@@@B@@@@@BB@ABA@@@BBBBA@@@ABAB@BABA@AB@@@BC@A@@@A@@@@AA@A@@AA@@@@A@@AA@A@AAC@@@@@@AA@@@AA@@A@A@@A@@A@@@C@@A@@AAA@@AA@AA@AA@AC@A@@@@@AB@@@B@B@@@@@B@@@A@@A@@AA@@@A@@@@@@B@@@@A@@B@@ABC@ABC@A@@@@A@@@@@A@@@@@A@@A@@@AB@A@@@AB@@A@@@A@ABAB@@@AAA@AC@@@@@@A@A@@B@@A@@@A@CA@@A@@AA@A@@A@@@@A@A@@A@@@@@AAAA@@A@A@C@@@A@A@@@A@AAA@A@@@@AAA@C@A@CB@B@@AB@@@BA@@@@B@@@@@B@@@B@@@@@BA@@@BBA@@B@@@B@@@@@@@@@BB@@B@@A@@B@@@@A@@@@B@@@@CC@@ABABCB@@A@@BA@@@@@A@@@@@@@A@@@@@@@A@BAA@@@@BAB@@@@@@A@@@@@@@@@C@AA@A@@@A@@@@@@A@@@@@A@@@A@@A@@@BAA@@@@AAA@@AA@@@@@@AA@@ABA@@@@ACCCA@AACACA@AAAA@A@@@AA@@@AA@@@A@@BAB@@@BEA@AAA@@@@@BA@@@A@ABBBA@A@ABEB@@@@@B@B@@BBB@@@B@@A@@B@B@@@BBA@@@@BAB@@A@@B@@AB@B@@@@AB@@@BAAA@@@AB@@@@BB@@@@A@AAA@@@@@@B@@@@@@@B@B@@@@@@@B@@ABAB@@@BABABA@@@@@A@@@A@A@@@A@@BB@@@@BB@@BBB@@C@@@@B@B"],encodeOffsets:[[122210,28364]]}},{type:"Feature",id:"331181",properties:{name:"龙泉市",cp:[119.141473,28.074649],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@B@@B@@BBD@BAFC@@B@B@BABABAB@@AB@@@BAB@@ADAA@@C@AAA@@@@AA@@@@@A@@@@AA@@@@@A@A@@@A@@@A@AB@@@@@@A@@@A@@@A@@AA@@@@@@AA@@A@@AAA@A@A@@A@@@@AA@@@@ABA@@AAAC@@@AA@@@CA@@@@@A@@AACA@@@@@AACB@@@B@@@@B@@B@@@@@B@@B@@@@@@DBBDB@@@@@@@B@BA@@@@@A@A@A@A@A@@@A@@@CAC@@AA@@A@A@@@E@C@A@ABC@CA@@AB@@A@@@CCAAAA@@@AA@AA@@AA@@@A@@@A@@AC@@@A@@@A@@@AA@@AAA@A@@ACAC@@@A@@@@@A@@AA@@@@AA@@@A@@@@@AAA@A@@AA@AAA@A@AAA@@@A@A@A@@@@AAA@@A@@@@@A@EAAA@@@@@@AB@AA@@@AA@A@@ACAAA@A@@@A@A@@@@@@ABA@@@A@@@A@@@A@@@A@@@CBA@ABABB@@B@B@@@@@@@@BB@@@@BA@@BBB@@@@B@@A@AB@@BB@B@D@@@B@B@@BB@@@@BA@BBA@@B@@@B@@@@B@@@@@@B@@B@B@@B@@@BB@@A@@BABA@A@AB@BA@A@@@A@ABA@@@@A@@@A@@@AA@AB@@AA@B@@AAAAA@@A@@AA@@A@@@A@AA@AA@B@B@@AB@@@@AA@BA@@@@AAAA@AAAACA@@A@@CB@@A@AB@A@@@@BA@AAC@A@@@@CAA@ABA@@@@BABA@@@A@@@A@ABA@ABA@A@@@A@@@A@ABA@A@AB@@AA@@AA@ACAA@@@@BA@CAA@AB@@CA@@A@@@@B@B@@@BA@@D@BBB@@@BBB@B@@@B@@@@A@@B@@@@@B@BA@A@@BAB@@@B@@@@@BB@@D@@@@B@BBB@@@B@@@@@@B@@AB@@@@@@@BBB@@B@@@@B@@A@AACAAA@@@@A@@@A@AB@@@BBDBD@@@@A@@@A@@B@@@B@@@@AACAAAAA@@B@@@A@@@A@@@A@@@@@@@A@@@@@ACAA@@@AA@AAA@@@A@@@@@BA@@AA@@A@A@@@@@A@@A@@A@@A@@AA@@AA@A@@@@@BA@C@AB@@A@@@@A@@CBA@CD@@@@@@@@AA@@ABAB@@A@@@A@A@@@@@@@CB@@BB@B@@BBBB@@@@@B@@AD@@@B@@AB@@A@@@CDA@A@A@@@A@A@@@@@AB@DAD@@@B@F@BB@A@@B@@@@@@@B@@B@@@DA@@@B@BCD@@@BABA@@@@B@@B@@@DBBB@BBB@@B@AD@BB@@BB@AB@B@@@@BBBD@B@@@@@@AB@@@@BB@@@BAB@B@@@B@B@@@@@@C@@@A@AB@@@@@BBB@@@@@BA@A@@B@@CC@@A@@@AAA@@@A@A@@@A@A@@@A@@@A@A@@B@@@B@B@B@BA@@B@D@BAB@BAD@@@BAF@BA@A@A@@BA@AB@@A@@@@@@@AAAA@@A@AA@@@@@BA@BB@@@B@@@B@@@@B@@B@@A@A@@@A@@@@@@@AA@AAA@AA@ACA@@@@@AAA@A@CACA@@@BBB@@@B@@A@@@C@C@CAC@@@CBA@@@@@@BF@DAB@B@DBFD@BB@@BB@BBBB@BB@@BBB@B@BAB@BBB@@@B@@AA@@CACB@@ABA@AB@@C@A@AAA@AAA@A@A@AAA@AA@@@@ABB@@BBB@@@BA@ABCBABABA@AAA@@@A@@@A@@@A@@BA@@@A@A@A@A@C@@@A@@A@@A@@@@@ABA@@@AAA@@@ABA@A@AB@@@@@@ABA@@@CB@@AACBA@@@AA@@CA@@A@A@@@A@AA@@AA@@@AA@@@@@A@@@A@@@A@@@@@AC@AA@@AB@@@@@AA@@C@@AA@@@@@AA@@AB@@A@@B@BAD@@AB@B@B@@@@@BA@@@A@A@@@@@@B@@AB@@@BA@@@ABA@A@@@A@AB@@@@@B@@@B@B@B@@@A@A@@@@@BA@@@@@@A@@@AA@@@A@@@ABA@@@@@@@AA@@@B@@@B@@BB@D@D@BABAB@@@@@@@@@@A@@BA@@@@@A@@BA@@B@@A@ABA@@@@BB@@B@@AB@@@@@B@BA@@B@@@B@@@B@@@@@B@@@@A@@BA@AB@@@AEBA@@@@@@@@B@@@@BBB@BB@@@@@@C@@@BB@@@B@@BA@@@@AB@B@@ABBBAB@@ABAAAA@AA@A@@@A@@@A@@@AA@@@@A@AAA@@@AB@@AAAAA@@@@@A@@@A@A@@@A@@@@AA@@@@@A@@@@@AA@@A@@@@@B@@A@@@A@@@A@@@@B@A@AC@@A@AA@@A@AB@@A@A@C@@@@@@@@AA@@@@AAA@@C@A@@AA@@@@AA@AA@A@@AG@@@AA@ABAAA@A@A@A@A@A@@@A@AB@@CAAA@@A@@BA@A@@@AD@B@BADCDA@ADCBEF@BDBDB@B@DBD@FBFBBDD@B@B@DADAFCBEBCB@DAD@B@BDDDDDDEBEDCDCDADADBB@D@B@B@B@DCFCDABFH@DC@IB@@C@EBG@C@@B@@@BBBBBDB@BAFGDABKDQHYFABCBCBC@E@A@C@A@C@A@A@@B@B@DADGJEFEFGHCDADEBCBABAB@B@B@JBD@DBD@D@BAD@@@BBDB@ABADCF@@@@@B@@@@ABABAB@D@D@B@B@F@BBHDJ@@@@@@@@@@@@@@@@@B@@FHDF@@@@@@@@@B@@@@BFADAFCDCDGHIDAF@@@@@@@B@@@@@@@@@@@J@H@F@B@D@BD@D@B@BBBHDBDBFDDB@@BB@B@BBBBDDDB@@@BB@@BFBD@BB@DDFBDB@AB@@@@@@@@@@@@@B@@@@@@@@@@@B@B@@@@@BA@@@@B@@@@@@@@@@@@@BB@@B@@@BB@@@@B@@@B@@B@@@@B@@@@@@@@@@@BF@FBFFFFBFBF@H@FAD@D@DBDHDDDBFBDBDB@@BBAB@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@BH@DAFAF@@@@@@@@BFDHBJBH@@BH@DAJADAFEFCBKDIDI@GCGCGCA@@@@@G@G@@@AB@D@@@@@@@B@@@@@@@FDFLTFFHFFBF@FDFFHJFFBBF@DBB@@@B@B@@@B@BB@BBFBHBDDDBDBFBDADCDCBABAFCFCDEBEBA@@@@@@@A@@@I@A@@@@@@@A@@@@@@@@@@@@@M@GFMDMDIDCBB@B@BB@@BA@@@@BAB@BA@@B@@@B@@@B@B@@B@@DABADA@@BA@BBAB@@@B@BB@@B@@B@@BBA@@@BDDB@@@B@@BB@@@@@@BBAB@@BB@@@@BB@@B@@@@B@@B@BB@B@@@D@@BB@@B@@@@@D@B@B@@BBAB@@@B@@@D@DBB@@@BAB@@@B@@@B@B@B@@@BA@@@@BA@BB@@BB@B@@@B@@@@@B@BBB@AB@BAB@@@B@@@BAB@@BB@@A@@BBB@B@BA@@B@B@@@B@@B@@B@@BB@@@B@B@@B@B@@B@@@@BB@@@B@@BB@B@@@@@B@@@BB@B@@@BB@@B@D@B@@BB@BBB@B@BB@@BB@@BA@@@BB@@B@@@BBB@@B@@@BAB@@@B@@@@B@@BA@BB@@D@@AB@@BB@@@B@@@@@BD@B@@@B@BAB@B@@@B@@@@@@AB@@@@@@@B@@B@BBBAB@@DB@@BB@@BB@BB@B@@@@@@BBB@@@@BDB@@BBB@@@@BBB@BB@@B@@@DB@AB@@@B@BA@@B@@@@A@A@ABABA@AAA@@@@@ABA@@@A@A@@B@@A@AB@@@@@BAB@@@@AB@@@@A@@AA@@@@@A@@@@@A@@@@@AB@@ABAFCBA@A@@B@@@B@@A@@BA@AB@@AB@@@B@@@BA@@@@BB@B@B@@B@@@B@@A@@BC@@@A@@@@@A@A@AB@@A@@@AA@AA@AECA@AC@@AA@@@AAC@@B@BA@@BA@@@A@@@C@A@@@A@@BA@ABA@@BA@A@@@@@AB@B@@ABBD@D@@BB@B@@@BD@@BB@@BB@@@BBBB@D@@@@B@@BBB@@@@BB@@@@AB@DA@@@AB@BA@@B@@@BBD@@@B@@@BA@@B@B@B@B@FB@@B@B@@@@BB@@@B@@BB@B@@@BA@@B@BAB@@ABA@AA@@BA@ABA@A@A@@AB@BA@A@AB@@@@A@@AA@@@@B@@@@CBA@AB@AAB@@@@A@@@A@ABAB@@@@AB@@C@@B@@@@@@@@@@A@@@A@@@ABA@@BA@A@@BABA@@@A@A@@@AB@@@@A@@BA@@@A@@@@@AB@@@@A@A@@@AB@@@@AA@@A@@@@@A@CA@@AA@@ADAB@@@BA@@B@@@@A@@@@@@BA@@B@@AB@BA@@BBD@@@@A@@B@@@@@B@@ABAB@@AB@@@BA@AB@BA@@B@BA@@@@DB@@D@@AB@@@B@B@@BBBBBBB@BDABA@@@A@@BA@@BA@@B@@@@@@BBBBB@@@CBA@@@B@@@@B@@@@B@B@@B@@@@@DA@@B@B@@@@@B@@A@@@@B@@BBB@@@D@B@@@@@@B@BBB@@@B@@B@@@BBBBB@@@@BB@@@B@B@@@B@@BB@@@B@B@@@@@B@B@@@@@@@B@@@ABB@@AB@@@@BB@@B@B@@@@AB@B@@BB@@BB@BBB@B@BB@@@@AB@@A@@BABB@@B@B@BAB@B@@@@@@ABABA@@@AAA@@@A@ABCA@@@@@@A@@@@AA@C@@A@@AB@@A@AA@@ABA@A@@B@@@BA@@@@B@@@B@@A@@B@@@BB@@@@@AD@@@@@B@@@@BB@@BBBB@B@DB@@@ABBB@@AB@@@BBB@BBB@B@@@B@@BB@@ADB@@@AB@@B@@B@@@BA@@BAB@@DBB@B@@@D@@@DB@@B@@BB@@B@@@BB@@@BBF@BAB@@@@@@@@@@B@@@@@@B@@@AB@BB@A@BB@@@B@@@@@B@@A@@A@@A@@@@@BBBB@@B@D@DABBBABB@@B@DB@@BB@@@@B@@@BA@@B@B@@B@@BB@B@B@@BBB@BB@@B@@B@@A@@AA@BB@BBB@B@@B@@@@@@BBB@@@B@B@@@@BA@@@@DA@@B@B@@@D@@@FC@@@@BA@A@@@@BA@A@@B@@@BABA@@B@@@@AB@@ABAB@@@BA@@@@@ABA@@BA@AB@BA@@@@@@@A@@@@BA@@@@@A@AA@@A@@A@@A@@@@@@@A@@@@B@@BB@@@@@@AB@@A@@BA@ABABB@ABA@@@A@@@@B@@@@@B@@@AA@@DB@A@@@@B@@@A@@B@@@@B@D@@AB@@@@@B@@@@@B@@@BA@@@@B@@B@@BB@@@DB@@@@BB@@@BBB@B@@BBBBB@@B@@@@@B@BB@@A@@@AB@@D@@@B@@@B@BDB@@BB@@@B@@@@BB@B@@@@@BBB@B@B@@B@@BBBB@B@@@B@@B@@DB@@B@@BD@@@B@@BB@B@BBB@B@BA@@@@BB@@@AB@@@BB@@@BB@DB@@@@BB@@@@B@B@@BD@@B@BD@@@@B@@BA@@B@B@@BB@@@B@B@@AB@@@B@@BB@BBB@@@@B@FF@@AB@B@@B@@B@BA@@B@B@@@@BB@BA@@@BB@@B@@@B@@@@BB@BB@@@@@@B@@@@@BA@@BA@@B@@A@BBA@@@@BB@@B@BBB@@BBCB@@ABA@@@@@A@@B@@C@@@ABCBA@A@AB@@@@@B@@@@B@@@@@@B@@@@@B@@@ABB@@@B@B@BB@@D@@@@A@@@A@AA@BCA@@A@A@@@A@A@AAA@@@@@A@@@@@@@@@A@A@@AA@@@AB@@ABA@A@@@@@A@@@A@A@@@A@A@@@@@AA@@ABA@@@@B@B@@A@@B@@@@@@@@@B@@A@B@@BB@@B@B@@A@@@@@A@@@@@@@@@@B@@@@A@@@BB@@AB@AAB@@@BA@A@@@@BA@@@@B@D@BBB@@DB@@B@@A@A@@@A@@@@B@@@@@BBBA@B@BB@@A@@B@@A@@@@B@@@@DBB@@@B@@@B@B@B@@A@A@@B@@B@@@@@@@AB@@@@@@@A@@A@@B@BA@@B@DA@@@@@B@@@BAB@@B@D@@BB@DAB@@@A@BA@@@@@AAA@A@@@A@@AA@@B@@@B@@A@@@@B@@@@A@@DA@@@@B@B@B@B@BA@@@ABA@@DC@A@@@@B@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@AB@@@@@@@@A@@B@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@AA@@@@@@A@@A@@@@@@@@@@@A@@@@@@@@@B@@@@A@@@@@@@@@@@B@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@A@@@@B@@@@@@@@@B@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@B@@@@@@A@@@@@@@@B@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@A@@@@@@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@B@@@@A@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@@@@@@@B@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@B@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@B@@BB@B@@@B@BABB@AB@BB@@BB@BDBB@@@@@B@B@@@B@@B@@@B@@@B@B@@BB@B@@@B@B@B@BAB@@@B@@@@@BAB@@ABA@ABBB@@A@@B@B@@BB@@@B@@@@A@@@AB@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@B@B@@@@@@@@@@@B@@A@@@@@@@@@@@@B@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@BA@@@@B@BB@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@BB@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@EA@@A@A@A@@BA@AB@@A@@@@@ABAB@@AAA@@B@@A@@@@A@@@@A@A@@BABA@@BA@@@@BA@@BAB@@@@A@@@@BBD@B@@@@@@BB@@@@A@@@@@AB@B@@@B@BA@@@@AAB@B@@@@AB@@AAA@@@A@@@A@AB@@A@@A@@A@@@AB@@@@@@AA@@@AAA@AAAA@C@@@A@A@BB@@@DBB@B@@@B@B@@AB@D@B@D@@@FA@@B@@@@@B@B@@AB@D@@@BA@@BB@B@@BB@BBDBD@@B@@BB@BB@@B@@@B@B@@@B@BBB@BB@@DBB@@@@BB@BB@@@@B@B@@@B@@@@@FAB@B@@@@A@@BA@@@@@A@@@AB@AAB@@A@@@A@@B@B@B@@A@A@A@AB@@A@@BC@ADBB@B@@@B@@@@@BAB@B@@B@@B@@B@@B@@@@@B@BBDBB@@@B@B@@@B@@@@@BAB@@B@@BAB@B@B@@@@BB@@@B@B@@@B@@@@@B@@B@@@@B@@@@@B@@BAB@@@@BB@@AB@@BB@@@BB@@@B@@@BB@@A@@BB@@@@BBABB@@@@BBB@@B@BB@@@@B@@@BB@BB@BB@@@@BBBB@B@@@BAB@B@@@B@@@BB@@@AB@BA@@D@B@BA@@A@@@AA@@A@@@AA@@@A@@@A@@ABA@@@AB@@@@@AA@@@@AB@B@@@@@A@@@BA@@B@BAB@DA@@BA@@BA@@@A@AA@@A@A@@@@AA@@A@@AA@@@@A@@BA@A@@@A@@@@@@@A@@@@AAA@@AA@@@@@A@@@AA@A@@@@AA@A@@A@@A@@@A@@@A@@B@B@@@BA@@@@B@@@BAB@@@@@@@B@@A@@BABA@@B@B@@@@AB@@@@@@A@@@@DA@@B@B@@@@@@@@A@@@A@AB@@A@@AA@@@@DAD@B@BA@@@@@A@A@@@@@AB@@@@AB@B@BA@A@@BA@@@A@@B@@A@@AA@@AA@@@AAA@@B@@A@@@ABA@A@@@A@A@@@@A@@A@@AA@@@@@A@@BA@@@@@AAA@A@@@A@A@@@BA@AAAC@A@@@@@A@@@AA@@@AAAA@A@@@AAA@AAA@@@A@A@@@@@@@A@@@AB@@@@AA@@A@@@A@@AA@A@CA@@A@@AA@@A@@A@A@@@@A@@@@@A@@A@@A@@AAA@A@@@@@A@A@@@A@A@@A@@A@@@AB@AA@@@A@@@@@@@AAAAA@C@@@A@@B@@AB@BA@A@@@@A@C@C@C@A@G@A@AB@@AB@@@C@@AA@@@A@A@@BC@A@@B@@AB@@@@A@A@A@@A@@@@@AACA@@@@@A@A@@BA@@@A@@@ABA@@@A@@BA@@@A@@BA@@BA@ABB@A@BBA@@AAB@@AB@BA@@B@DAB@@AB@AA@@@@@A@AA@B@BA@@@A@ABA@@B@BA@@@@@AB@@@B@@@@A@@@@BA@@B@B@@@@@BA@@@AB@B@@@B@@@@A@A@A@A@A@@B@BA@@B@@AB@@@@@BA@@@@@@A@@@@@@A@A@@B@@@@@@@BA@@@@@B@@@@@@@A@@@@@A@@@@@@@@@@@AB@B@@@@ABA@@@@AA@@@AAA@A@@B@@A@@@A@A@@B@@A@A@@@A@A@@@@@A@@@@@AA@@@@AAA@@BA@@A@A@A@@A@AB@AA@@@AB@@AB@@A@@@A@@@A@@@@@A@A@@@A@@@@AAB@@A@@B@@@@@B@@@@@BA@@@@B@@@@B@@@@@@@AB@BA@@@@@@BA@@@AB@AC@@@A@A@@B@@A@A@@BA@@A@@A@@@@BAA@@A@@@@B@B@B@@@@AA@@A@@@@@@@A@@@@BADA@@B@@A@@@ABAAC@@@@AAAAAA@@A@@@A@AAA@@@A@@@@@A@@@@@A@AA@@@@AA@A@@@@@AA@@AA@@@C@A@A@CA@@C@CB@@@AAA@@@AAA@AAA@A@AAA@@CAAB@@A@@@@A@@@AA@@AA@@AA@@A@C@@A@A@@@@@@A@A@@B@@A@@@@@@A@A@AA@@A@@@@C@@@@@AA@@@@A@ABABAB@@AB@@A@ABA@@@A@@@AB@@@@A@@@A@@DA@AB@@A@@@A@AAA@@@B@@AB@@@@ABA@@@@@@@A@@@A@AA@@@@@A@@B@@@@A@@@A@@BA@@BA@@@@A@@A@A@@@A@@@@@A@@B@@A@@AAB@A@@@@AC@@@A@@B@@@@A@@@AA@@@A@@@@A@@@B@@B@BBB@B@@@D@@@BA@@@A@A@@@A@A@@@@A@@@@@@@A@@@@@@@A@@@@@@@@A@A@A@@B@@@BAB@@@B@@A@@B@@CB@@@BA@A@ABA@AD@@@@A@@@A@@@@@@B@@@@@B@B@@@@@B@@@B@BAAA@@@ABABA@@@@@@@BA@@@@A@@@@@@B@@A@@BA@A@A@A@A@A@@B@@@@@@@@AA@@@A@@@A@AB@@ABA@A@@@AB@@@@AB@@ABA@@@@@AA@@@AA@@AA@@@AA@@@@A@@@@@AA@@@@@A@@@AAA@@@B@BABABA@@B@B@@@B@@@B@@@@@BA@@B@B@@A@@@@A@@@CC@A@A@@BAD@BAB@@@B@@@BA@@@@@A@@BCB@@@BAB@BAB@BA@A@A@@@ABAB@@@@A@@@@@@BADAB@AA@@@@B@@A@@E@A@A@A@BABA@@AA@@AAA@@@A@A@@A@AB@@@@@@A@AB@B@DA@@BB@@@@@AB@B@@@B@@AB@DA@@B@D@@@@A@@B@@@@AB@@A@A@@@A@A@@@A@C@@@@AA@@@@B@B@@A@@@@@@B@@@BAB@@@@@BA@@AA@@@AAA@@A@AA@@@A@@BAB@@@B@@@B@@@@A@AA@@A@A@C@@A@A@@@B@@@@@BAB@@@B@@@BA@@BABAB@A@@@@AA@@@AAG@A@@@@@A@@@A@@B@@@@@B@@@@@BA@@@@B@@@B@B@@@@@@A@@@@@@@@B@B@@@@@B@@AA@A@AA@@@@@A@@@@@@B@@@D@@@B@@A@@@@@@@AB@@@@@@ACA@@@@@AA@B@A@@@@@A@@@@A@@@@@@@@@@@A@@B@AAB@@AAACA@@@AA@@@B@@A@@@A@@@@@@B@B@@@@@@AB@@@B@@AAA@CA@@@@@@BA@@@B@@B@BA@@@@BA@@A@@@@@@AB@@@@A@@A@@@@@@@BA@@@@AA@@@@@A@@@@AA@@@CB@@@@@A@@@@@@AA@@@A@@A@@A@A@A@@@@@A@@@BBAB@@A@@@A@@AAAAA@@@CA@@A@@@@@@@@@@@AA@A@AAAAAAA@@@@@@@@B@@BB@@A@@@@@A@@@@B@@@@@@A@AB@@AA@@A@@@@B@@@@@BA@A@@@@@A@A@@@A@A@@@AA@@CBADA@AA@@@@@B@@@B@BA@A@@@@@@AAC@@@@A@A@A@@B@@@BABA@CB@@@@A@AB@@@AA@@A@@@@@A@@@A@@@@A@@@@@@BA@@@AAA@@@ABA@@@@AA@@@BA@@@@B@@@BA@@BABA@A@@@A@A@@@@@@A@@@@@@A@@A@@@AB@@@B@@AB@B@@AB@@@@@B@@@BA@@BBB@@A@@BC@@BA@@@A@A@@AA@@AA@@@@@@@@@BDB@AB@@@AAA@@A@@AAA@AA@CEA@@@CC@@A@A@ABC@@@AB@D@@@@@BA@@@A@@@A@@B@@A@@@AA@A@@@AAA@A@AACAC@A@@AA@@@@A@AB@@AB@B@@@@A@@@BA@A@@@@@A@@@@@A@@@AA@@A@@CCAAA@@A@@@@A@A@@A@@B@@@BAA@@A@A@@@@@@A@A@A@@@A@A@AA@@@@A@@A@@A@@@@@@@@C@A@A@A@@@AB@BBBA@@B@@@@@@A@@@AA@@A@@A@@@A@A@@AA@@@A@BA@@@@A@@@@@A@@@@@A@@B@@@@AB@@@BAA@@@@AA@A@AA@@AAA@A@AA@BA@@@@@@@@@A@@@@@@@AB@@A@@@AB@@A@B@@BB@@@AAABA@@@AB@@ABAB@@@B@BA@@B@@@@@BB@BB@D@BB@ACAAA@@AAAAACA@@@AB@A@B@@A@@@@AA@@@@@@A@@A@@AA@@@@@A@A@A@@B@@@@AA@B@@@@@DA@@@@@@B@@@@AE@CAA@@@@A@A@@@A@ABC@@@@@@@@@C@@@@@BB@@@@@@AAA@@AA@@@AA@@BCA@@@A@@@AA@@@AA@AAC@AAA@@@ABA@CCAACAA@@@@AA@@@AAAB@@@A@ACAAAA@@@A@@AA@AB@@CA@@AAA@A@AA@@@@AA@@A@@@A@@BA@@@@@@A@@A@AC@@A@@@A@ABA@A@@@AB@@@BA@@@A@A@@BADA@@@AB@B@@@@@B@@@@@@AB@AA@@B@@@@@B@@@@@B@@CB@AA@@@A@C@@@@AA@A@C@GAA@A@AA@AA@AC@@A@CAA@CB@BA@A@@@A@@B@@A@A@@@AAAA@@A@A@BA@A@A@@@@@AAABA@@@AAAACAA@@@A@@DA@@B@@A@A@AAA@@@ADAB@@A@@AA@CB@@ADABA@@@A@@@@@ADA@ABAB@@@B@BB@B@@@@BBB@"],encodeOffsets:[[122031,28474]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var C=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("宁波",{type:"FeatureCollection",features:[{type:"Feature",id:"330203",properties:{name:"海曙区",cp:[121.550752,29.874903],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@DA@@@AGAAB@A@BA@@@@@@BA@@@AAABB@ABA@@BFBA@JBB@@AA@@@BA@A"],["@@A@AA@@A@@AAA@@@E@CBA@@@@@A@A@AAA@@CA@@C@A@GBC@AAA@@@@A@A@@BABAJE@@DCBC@A@A@@@AAA@@@@A@A@@@A@A@A@A@@@@AA@BA@ABA@A@AA@AAA@@@A@A@C@CBEB@@A@@@A@@A@A@AB@@C@@BABABCBA@A@A@AAA@ACAA@A@@@CB@@A@@BABABCF@BA@AB@@ABA@@@A@A@@AAA@@@CAE@A@A@AA@AA@@A@A@@BC@EDAB@@AA@A@C@CA@@@A@@@ABAB@BABAB@@A@@@CAA@@AA@@AAE@A@@A@A@A@@@A@@@A@A@@@AA@@@AAE@CAA@@A@@A@@AB@@A@@BADCF@BAB@BA@A@@B@@A@A@AAA@A@@AA@@AAC@@@@@A@@BA@@DABAHABA@A@@@A@AAEA@@@@@C@G@C@CBC@A@AAA@@A@@@A@A@@A@AAAA@@B@@CA@@@@A@C@AB@@@BA@EA@@A@@A@A@C@A@A@A@AA@@@A@A@A@A@ABABC@@@A@A@AAA@@@@EACAC@@@A@@@AAA@@@A@A@A@AB@@BB@BA@A@A@A@@@A@CDA@AA@B@@A@A@@@@@A@A@A@@AA@@@@A@@@@B@BA@@D@@A@@@@ABA@@@A@AB@AA@@@@@A@@AB@DA@@B@@@B@@@B@@A@AA@@BA@BA@@@@@@@@@@@@AA@BA@@B@@CBAB@A@A@A@AA@ABA@@@@@@@@@@@@@AA@@@B@@@@@B@@A@AAA@A@A@@@@@@A@A@@@A@@@@A@A@A@@BA@@@AA@@A@AA@@A@@@A@A@AB@@@@AB@@CDA@@@@@@@A@@@@@ABAA@@ACAAABA@A@AB@@@@@DA@@@A@@B@@CA@@A@BCA@@AA@A@@@ABA@@BAAA@@@AA@@@@AA@@@BA@@@A@AB@@A@@@@AA@@@@ABCBC@@ABA@CBA@@@@A@@@A@@AA@@BA@@@@@@@@@@A@@@@@@@A@A@@@A@A@@@A@@@@@AB@@@@@@AACAA@@B@B@@@@@BABAB@@@@@@A@@@ACA@A@@@A@@@A@@AA@A@@@@@@A@@A@A@@@AA@@A@C@@@@@AA@@ABC@C@ABEBA@@@A@AA@@A@@@ABA@@@@@ABC@A@A@@@A@AAA@AB@@AAA@A@@@A@A@@@AA@@A@@A@@C@A@A@@AA@AAA@@@A@@@@@AB@D@@@B@@AAA@@AC@C@@@@A@@@A@@A@@BABCB@@AB@@A@@@A@@@AAAB@@A@@AA@BB@@@B@@B@@BB@A@A@A@@@@B@@BB@@@@@@@@@B@@@B@@@@ABA@@AA@AB@@C@@@A@@AAAAAA@@AC@@A@AA@@@@B@@ABAB@@CB@@@@@A@A@A@@@AA@@@CA@B@BA@@@@@AB@@ABA@@AB@@AA@AC@A@@@@@A@@@@@@AACBAB@@AB@BA@AA@@@@@@AA@@@AA@AA@@AA@@@BAB@@AB@@A@@@@B@@@@C@@AA@CAC@A@A@@AAA@@@@@@@A@@@@A@A@@@AACBCA@BA@@A@@AB@@A@@@A@A@A@@@BB@@@B@B@@@@@B@BAB@@@B@B@B@@A@ABA@@@CB@@A@A@@@A@@A@@ABB@@@BBB@@BB@@@B@ABA@A@A@AAAAA@A@AAA@@@A@BB@BB@@@@B@@A@A@@BAB@@A@A@A@@@A@@@@@@A@@BA@A@@@A@@AA@A@@A@@@A@A@AA@@AA@AAAA@A@A@@ABA@A@@A@@AA@@@A@@@AB@AABABABAD@@@A@@@AA@ABA@@@@@@B@@@@@BA@@@A@@@@@@@C@@@@B@@@@@BAD@@B@@B@@@B@@B@BBB@B@@DA@AB@@@@@B@@A@@@A@@@AAA@@@AA@@@@A@A@@@A@EB@@A@@B@@@@@B@B@@@B@@@B@B@@@@@B@@@@BBAB@@@BBB@B@B@@BB@@@B@@@B@@@B@@B@B@@B@@@BAB@@ABAB@@CBA@@@BBABAA@@@B@B@@@AA@@BBB@F@BB@@B@@A@@@A@A@AA@@@A@@A@A@A@@@@@AF@@@BB@BAB@@@@BB@@@@B@B@@@@AB@B@@@BAA@B@@B@@@AB@B@@@B@@B@@@B@B@BB@BB@@BB@@A@@B@@@@@@@BB@@@@B@@DDD@@B@@A@@B@B@BBD@@@B@B@@@@A@AB@B@B@@A@@AA@@@A@A@@@@AAB@@C@@B@B@D@@@@@BBA@@@CD@@@@@@@@B@B@B@DC@@@@B@@@B@@@@@@@@@D@B@@@@@B@BB@@@@@@ADAB@@@B@@ABA@AB@@A@@@BB@B@B@@BB@@@@@@@B@B@@@@B@@@@BABA@AB@@@B@BBB@@AB@@BBBD@@@@AB@@B@@BB@@@@@BB@@@@@B@@@B@@BBBB@BB@@B@@BA@@B@@ABB@AB@@@BABA@@@@@@B@B@B@B@BB@@@B@DB@BD@@@B@@@B@@ABB@@B@B@@@@A@@@@@@@@AA@@AA@@@@@@@@BB@@B@@AB@BA@@@B@@BB@@B@@AB@@@B@@BAB@B@@BD@@BBABB@@B@@@DB@@@@@B@@@B@@B@B@@@B@@B@@A@ABA@@@BBB@DD@@@BABBBB@@@D@B@@@@@B@@@D@B@BB@@B@@@@@@B@@B@@B@@BAB@@@@B@@BAB@@@BB@@BA@@B@DA@B@B@@@BB@@ABBB@BBB@D@@BBB@BB@BBB@@@@BA@@@@B@@B@@BB@@@DB@@@@@BDD@@@B@B@B@@@D@@B@@@B@BABB@@@@A@@@@@@B@BA@@@@@@@@BBB@BA@@B@@BB@BB@BB@B@@@@BBB@@B@@@B@@ABA@@BAAA@AA@BA@AB@@B@@B@B@BBB@@@@@@@B@@B@@@DAB@@@B@@@@@BDBA@@@B@B@@@@B@@@@@D@@@BB@BB@@B@@B@@@DBB@@@BA@B@BABBF@B@B@@@@B@BAB@BBB@BB@BB@@AB@BBB@@BB@@@BAB@@@@B@@B@@A@@B@DBB@B@B@@@B@@@@@FAB@@@B@B@B@@@BA@@@@@A@@@@@AB@@@BB@@B@B@@@B@B@@B@@@@AB@BBB@@@@@BB@BAB@@@B@BB@@A@A@A@@@A@@@@BBD@BA@@@BBB@DBB@@@B@B@B@F@@@@@CB@@@B@@A@AB@@@B@@@B@@B@B@@@BDB@@B@@DB@BBBBBBDD@B@@@@BB@ABDBBBB@@@@BB@B@B@@@B@@B@@BB@@@B@@@@@@@@BAB@@@BBBBB@@@@@@A@A@@@@@A@@@A@@AA@@A@@A@@@A@@@@@A@@AA@@@A@@B@B@B@@@BAB@@AB@@A@@B@@@BB@BB@@AB@BA@@@@@AAAA@AA@@AAAA@A@@@@@AB@B@@@@@B@BDB@@@B@BC@@BA@@D@@@BBBBBB@BB@DBB@BB@@B@BB@@@AB@B@BA@@@@BBB@@@D@@A@@@AB@@@A@EAA@@@A@@@AADABC@@AAAA@AA@@@B@BABAB@@@@@DB@@B@B@@@@A@A@@@A@@AC@@@@@@B@D@BAB@DBB@B@BBB@@@@@D@B@@@AB@BBB@@BBBB@@BBBBB@@DB@BBB@@BBBBD@@@@@@@B@DB@@B@B@@@B@BBB@@AB@@A@B@BB@@@BBBA@A@@BA@@@C@@@BBBB@@B@@D@@@@@B@BB@@@BBBB@BB@@@B@@B@@@B@B@@@B@@@@BBBB@B@BBBD@@@@D@B@@B@@B@@@B@@AA@@@@AB@@@BAA@B@@@@BB@@B@D@@@BAA@@@B@@@B@@@@@B@@@B@@@@@@B@@B@BBBADAB@B@F@B@DBHB@@D@@@B@BCBABADCBA@ABCBABE@ABA@AD@BAB@B@JBF@D@B@B@BBBBDBDBB@BBB@FBB@BBBAB@B@@ABA@@@C@C@A@A@C@A@A@@BABCDADADCBABABA@@BABCHE@@B@BAFABAB@BBB@@@BBBBBBDH@BBFBDBD@@BBB@BBB@@@D@B@D@BAB@DCBAHG@@@@@A@@DGBCBCBCBA@@BAB@DBDBFDBBD@DBB@DBB@@@BAFAB@@@CE@CAEAGAK@@B@BAB@@@B@D@@@BB@@D@B@@@B@DBB@@@B@B@B@@@@ABCBA@ADA@A@@FGBADEDCBA@@BA@@B@B@@C@@AC@C@@AA@A@@AC@@@@AACC@@AAAAAA@@A@AECAACCA@@C@EBE@A@@@AA@@AA@@@A@A@ABC@A@@@AAA@AA@@@EAA@"]],encodeOffsets:[[[124532,30630]],[[124447,30556]]]}},{type:"Feature",id:"330206",properties:{name:"北仑区",cp:[121.844172,29.899778],childNum:9},geometry:{type:"MultiPolygon",coordinates:[["@@AA@@@@@@@@@B@@@@@@@B@@@AB@@@"],["@@A@@@@@@@@B@@@@@@BB@@@AB@@@@AA@@@"],["@@@BABBBBBDB@@B@BA@A@@ACAA@@A@A@A@"],["@@@ADC@AB@BA@@A@@A@@@AA@@@A@@@ABAF@B@BA@@B@B@@B@B@@A@@@@"],["@@AAAAA@C@AB@B@BBBBDB@D@@@BA@C@A"],["@@AAA@AA@@@@@AB@@@BAA@@A@@AAA@@@@D@B@@AB@D@B@BBBFD@@BABA@@BA@@@@AA@@A@@A"],["@@EAEAEBA@ABAD@D@BBBB@DBN@B@B@BA@CACAC"],["@@BAD@BBB@BCBC@E@CACAAGAC@EBEBCBCDAF@D@DBFDBD@BBBAD@DC@AB@"],["@@WUAPAHAHAH@FCJADCBEBA@EBGDEDA@ABA@C@C@ADABCAA@C@A@@B@BA@@BA@A@E@@@@B@@AB@@@BABABABA@@BA@EBA@AB@@@@AF@B@@CBAAAA@BA@AB@B@D@BB@@@@@@@AB@@@@A@@@@B@@ABA@C@@@@BA@@B@@@@A@A@@BAA@BC@@@@@A@@D@@@BB@@BB@@BB@@@@@@BCA@@AB@BA@A@@BA@@B@@BF@BBD@@ABAB@BABA@@@@B@@BB@@A@@BA@AB@BA@C@A@@@@BAB@BCB@B@B@@@B@@A@BBA@@B@@@@A@@@A@ABA@@@A@@@AB@B@B@@A@A@@@CBAB@@ABCB@@A@@BE@CAA@A@AAA@@@@B@@BBB@AB@@A@@@AB@B@@BD@D@B@@AB@@CB@@@@@BBB@@@B@B@@ABEBA@@@@@AB@@@BA@@@@B@@A@A@@BA@A@@@A@@@@@C@@@C@@@ABA@A@A@@@@@AB@@A@@B@B@@A@@B@@@B@@@BABAB@BA@@@@@AA@@A@@@A@AB@BA@@@CA@@@@@BAB@@CB@@@B@BAD@BAD@@A@@@AACA@@@@@B@@AB@@@@@B@@@@@B@@BB@@@B@B@DBB@FAB@@@AA@A@AD@@@@BB@@@B@B@B@@@@C@A@AB@BA@@@@B@@AB@@AAA@A@@@AA@@A@AB@@@@A@@A@@AA@@AAA@@@AA@@A@@@@@@AA@@@@@AAA@A@A@@A@A@@A@A@A@AA@@CA@@A@A@AAAAAACA@@@CA@@@CAA@@@AA@@@@AB@@AB@@CA@@AA@@@A@@A@A@@@@AA@@@A@@@@A@@AB@B@@CC@@A@AAABAA@A@@A@GHABHF@@@@@@ABA@A@AB@@@@@D@B@@@BA@C@@@BB@@@@A@@@@B@@BA@B@@CD@@@@@AA@A@@B@@AB@@@B@@@B@@@@BB@@BB@@B@@B@B@@@@@BCB@@@@@B@@@BBD@BB@@B@B@@A@@B@@ABAB@@A@@@A@@@AB@@A@A@@@@@AB@BABFFB@@@CF@B@BA@@DAAAB@@CD@@A@@@A@@@@@A@A@BB@@@@@B@@ABA@@@@@AB@A@@@@A@ABC@DFBDDDB@@BD@BBD@FBBBF@D@B@HBD@DBFDBBDDBBBBFJ@BBDBB@BDFDHB@BBBBDB@@D@B@B@B@F@F@B@DAD@BBD@@@FBBB@BDFBBBFBBBDDFBDFDJHDBDBD@BBB@H@HBTHLFFDFDFDPJPIPITMFCf]r[tWbQPATCHDFDAHDFJHNJPLDDB@BDDBVJJBFADGFCD@DADCBEDKBAFAH@DABCDIBCDADCBE@S@C@K@GDKDAFAJ@TBL@PAP@J@^BNCH@HFFDJJHFHDHBF@H@FBNFH@JAXIFCFK@EBI@IAKMIICWIICYGKIKGCEA@EGMEUIeUGEGIEGAOIIOYAEGIY]IEGEOASI[EMAYAK@EAYMGI]c"]],encodeOffsets:[[[124866,30450]],[[125034,30575]],[[125086,30612]],[[124970,30527]],[[124960,30625]],[[124966,30514]],[[124946,30612]],[[124946,30632]],[[124833,30433]]]}},{type:"Feature",id:"330211",properties:{name:"镇海区",cp:[121.596496,29.965203],childNum:2},geometry:{type:"MultiPolygon",coordinates:[["@@@@B@@@@@@@@AA@@A@@@@@@A@@@@B@@A@@@@@B@@@@@@@BB@@@@@@"],["@@NQVOVOH@Z@pDT@L@NAJEOIECECECKESGGAG@A@AAC@CACAIGECACCEACAAAEAACE@AAAEA@@C@AAC@CBA@E@E@A@A@A@C@@@CAAAAAA@CGCE@AAAAC@AEIAAAACCAAECCAC@GAA@C@E@AAEAC@AAC@@AA@CCACCEAA@A@@AC@AAAAC@ACAAAA@AAC@@@C@@@ABAB@@ADDD@@DB@@@@B@BDBB@@@BB@@@@@BBB@@B@@@BA@AFABA@EAA@@@@@A@C@@@ADA@@@A@@BA@@BB@@BC@A@A@@IA@K@@@C@@B@@AJABEECAA@IA@@A@AB@@AB@AABAL@B@D@DBD@@BBAB@@ABABABBB@@@B@@@BB@A@@B@@@@AB@@@B@@A@A@CBA@@@@C@BA@@@@AADCCED@@A@@@@A@@@@EFED@B@@A@ADA@@B@D@D@@ABC@AD@@@@AB@@@@@B@@@@@@@@@@@@A@CBA@@@@D@@@@C@A@A@@@AAA@A@@@A@@@@B@@@@@B@@B@@BA@ABABEF@@AAC@AB@D@@ABAB@@ABCDCDCD@@CBEF@@EDA@@@CB@@@@@@@B@@A@ABABCBA@@@A@A@@AA@A@@@@@@@A@@@AAAAAAA@A@A@A@A@AAA@@BABAB@@A@C@@@A@A@A@@@@BAB@@A@A@AB@@C@C@A@C@A@A@A@CAA@@@@@@@A@ABCB@BA@@@@B@@A@@@@@@BA@@BA@DBBBDAB@BDB@@B@@BA@B@@@AB@@@B@B@@@@@CBGBB@@B@@@@@@@@AB@@@B@@@@@@@BA@@BA@@BBB@B@@@@@@@B@B@@A@@BA@@@AB@@@@@B@@@BA@AB@B@B@@A@@@A@@BAB@BCB@@ABA@@@@B@@@@@BB@@B@@AB@BA@@@A@@@ABABABA@@@@B@@@B@@@@AB@BBBB@@BA@@B@@@@B@D@@@B@B@@A@BB@@@B@D@@@@B@@@B@@DB@@@@B@ADA@@@@BBB@@@@AB@BB@B@B@B@F@@@@@@@FABBB@B@@@B@@@@@@B@@@@B@BB@@ABBB@@ABA@@@A@BBB@@BBB@@@B@@@@BB@BB@B@@A@@B@@A@@BBB@B@B@@@@@B@B@@@B@@BB@@@@@BBBB@@@B@@BBB@B@B@@@@@B@@B@@@@B@@@B@B@B@@@D@@@B@@@B@@B@@@@@@B@@@BBB@@@@@BA@AB@B@@@AA@A@@@A@@@@BA@@B@@@DBB@BCDB@@B@@AB@B@B@BBB@@@D@D@B@@@BBD@B@@@B@@C@@B@@@BAB@FAD@BA@@B@@A@@B@@@AAB@BA@@BA@@@A@A@@BAB@AAB@@@B@FAB@H@@AB@DA@ABAB@@@B@@@B@B@B@@@BABBB@B@B@@@B@BB@@@@BBB@B@@@@@B@@@B@@@@A@@B@@@BBB@B@B@B@@ABA@@BBH@@B@@BB@B@@@BB@B@@AB@@@BD@@B@DFDLFHFLHJRHFCiJKju"]],encodeOffsets:[[[124687,30719]],[[124614,30738]]]}},{type:"Feature",id:"330212",properties:{name:"鄞州区",cp:[121.546603,29.816511],childNum:1},geometry:{type:"Polygon",coordinates:["@@ABE@@AA@C@AA@@AA@@AA@@A@AACAA@@AA@AA@@AA@@@@AA@@@@B@@@@A@@@@@A@@A@@@A@@@A@@@@@@AB@@@AA@@@@AA@BA@@@A@@@@@A@@B@@@@@@A@@@AAA@@AABA@@@@@BBB@@@@@@BCB@BA@@@@A@@AA@A@@@@@@@@A@@AA@B@AA@@AA@A@@@AA@AA@B@@@@BB@@@@C@A@@@A@@@@@@B@@@@@B@B@@AB@@B@AB@@@@A@@@BD@@@@@@A@@@@AA@@@@@A@@@@B@@@B@@@BA@@@AAA@@A@B@@@@BB@@@@@@@@A@@@@@@@@@@B@@@B@@@@@@@B@@@@@@A@@A@@@@@AABA@@B@BA@ABABA@ABA@A@A@A@A@@@A@@@A@@@@@@B@B@B@@@B@@@@C@A@AB@@A@A@@B@@@@@B@@@B@BDBB@@BB@AD@@@@A@@B@@@@@BA@@@@@@B@B@@@B@B@B@@@B@@@@@B@B@B@@@@AB@@BB@@@BDBBD@B@@@B@@@B@@BB@@@@B@@@@@B@@@@@@AB@BC@AB@@B@@BB@@BB@@B@B@@@B@@@@B@@AD@@@B@@BDE@@B@BAB@BA@B@DD@@BB@@@@BB@@@BAB@@@@DBB@@@BB@@@@@B@B@@@BBB@B@B@@@B@DA@AB@B@B@@A@ABA@@@A@@@IAA@EB@@@BA@C@A@@AAA@B@@AC@BAA@@A@@@@C@@A@@AA@AA@B@A@@A@@@AB@@AB@@@AA@ABD@@@B@AB@@A@@BAA@@@@ABABC@A@@@@BAD@@A@A@A@@@AD@@@@@BA@@B@@GDCDA@@@A@@@CA@B@@@@@@@B@@@@@@@@@B@@@BA@@@@@A@@@ID@@IGCABG@@@@CA@B@@C@I@AACBABC@@D@@C@A@@@A@@@E@@@A@@A@@@BA@A@@@@@@A@@@AC@@BA@@@A@A@@@@@@BCBC@CDEB@BA@@@AD@B@B@B@BAB@D@BAB@DAD@B@F@F@B@D@F@B@D@D@B@B@B@B@B@B@BF@@B@@@@A@@BC@@@B@@A@@@@@@@@A@AD@B@B@@@BA@@B@@@@AD@@@@@BAB@@A@@B@@A@@@@AC@@B@@@@A@@@AAA@@B@B@@@@@@@@AB@@@@@B@@@@@@@B@@@@@B@@@@@B@@@@B@@@AB@@@B@@A@A@@BB@@DC@@@@B@@@B@@BBDD@ABB@@B@A@B@@B@@B@@@B@@@@@B@@@@@A@@B@@BBA@BB@BABAB@BB@B@B@@@@BBF@BB@@BB@DB@@B@@@BABA@ABABA@@B@@@B@@D@D@BBB@@BAFCD@@AB@B@@@BBB@@B@B@BBF@D@@BB@BB@B@@@B@BA@@BAB@@ADEBABA@AB@@@DA@@B@B@DB@BBB@B@B@BABADABAB@@@DA@@B@B@BB@@@B@@@FADAD@B@B@@@B@BBB@@B@BAB@BABB@@B@@B@B@B@B@@@B@B@@@@@BB@B@@@B@BADCD@@IFABAB@@@B@B@@B@BBD@HAB@D@@@DB@@BB@B@B@B@@@@AB@D@F@@BB@BB@@@BBB@B@FB@@B@@BBB@B@@@BAD@B@B@B@@BB@@BB@@B@F@FAD@@@DBBDDBBFB@@@BBBBBB@@DDBB@@@@BD@@@BBB@@@DBD@@@DA@B@@BB@@B@DBD@@DF@BBD@@BBBB@@@@@@DDDBB@JFB@DBJB@@FB@@DBB@B@D@B@DBD@@BF@DBB@@@HDB@B@DBDBFBDBD@HBF@B@@@B@BADA@@BABCBCBC@@BABA@@D@@@D@BBB@BBDB@BBDBB@BBD@@@BBBD@BAB@@@@@@BBA@@@@B@BA@@@A@@@@AAB@B@@@@@B@@@B@@@DC@@BABB@CB@@A@ADE@@A@EEBA@ABA@@@@B@B@@@BA@@B@@@B@@@BABA@@@AB@@@@A@AA@@AAC@A@@@A@@@@DA@A@@@@@A@AA@@@AA@@AA@@@@@A@@@A@@BA@@@AB@B@@B@@@@DC@@@AAB@@@A@@B@@@@@AA@@D@B@@A@@@A@C@@@@BAB@B@BA@@@@@@GEBAHGB@@@@BBBBABBB@@@DD@@@ABA@@@B@@B@@@B@@B@@B@B@@@@B@@BB@@DB@@BA@@BA@@@@BB@@B@DB@@B@@D@@DBBBBBBBB@B@@@DB@@BBB@B@B@@@@B@BB@B@B@BB@@@@B@@B@@@@B@@@BB@@B@BB@@BB@@@BB@@@@@BAB@@@BB@@B@B@BB@@BA@@@A@@B@@ABAB@D@@@@@@A@A@A@@AA@@@@BCB@B@@B@@BA@EAA@C@A@A@@AA@@@A@@@@@A@@@@BA@@@A@@@@DBBB@@B@@@BC@ABC@A@A@@DA@@BA@A@@@@DB@@B@@ABAB@@@B@@@BB@@@@B@@ABABA@A@@@A@@@AB@@@@A@AB@@@BA@@@@B@B@B@BA@@D@@@D@@@@@B@@@B@B@@AB@B@@@@A@@B@@A@@BA@@@@B@FABA@@@A@A@@AA@A@@@@DA@@BA@@@A@CAC@@@ABA@@B@@@BAA@AA@@@A@@B@BBB@B@DBF@@AB@@@DABA@@BADA@@B@B@@@@A@ABA@@B@@@B@BAB@@@B@@@@@@AB@AAB@@@@A@@@A@ADA@ABA@A@@B@D@B@@ABAB@@AB@@@AA@@@A@@B@BA@ABABA@@AC@AAE@@@AB@@AB@B@@ABA@@DB@A@@@@A@@AA@@AA@@A@@@CB@@@@@D@@ABB@AB@B@@@@@@AB@@A@@D@B@BA@@@A@@B@@@@@BA@@@@@@A@@A@C@ABAB@@ABBBBDA@@@ABE@@@@BAB@FAB@@AB@BABABA@A@@BA@@@A@@F@B@B@@AB@@A@AB@D@B@DBBABCD@D@B@BAB@FCHCFAB@FADABCDI@EBGBGBGBO{YWOMQOSSOIMIUMCAIECCGEII_TGFBB@B@@@@@@A@@@@@AA@@@@A@C@@@C@@@@B@D@B@@A@@@@@CA@@@@@@@@@@@B@BBBB@A@@@@B@@AA@@A@A@@@A@@@AAA@@@A@@@AA@@@@AA@@@@AA@@A@@B@B@@A@ABABA@@@@@AB@@@@AAA@@B@AA@A@@@@BA@@@@AA@A@@@A@@@ABA@@AA@A@@@@BA@@B@@@BBBBB@@@@ABAB@@A@@A@A@@A@@@A@AB@A@@@A@A@@A@A@@@@A@@@A@@A@@@A@AAABA@@@@@A@@AA@@@A@AB@@@@A@A@A@@@A@A@@@A@@@@@A@@@@A@A@A@ABA@@@@A@ABA@A@@@@@@BA@@B@@@@@@A@A@@AB@A@@AAA@@@@B@B@BA@@@@AAA@@@CB@B@@CB@@@@@D@@ABA@@@A@@@A@@@A@A@E@A@AAAAA@AA@@@@@@A@@BA@A@@@AB@@A@@AA@@@AACA@@@A@@@@@A@@@A@@A@A@AAAA@@@AA@E@C@A@A@A@@A@AAA@@A@@@C@A@A@@@A@A@CBC@A@@@A@@@@@C@@BC@AB@@AD@@BBBB@@A@AB@@AB@B@B@B@@A@AAA@@A@@A@@B@@B@@@@@BB@@@@@@A@@@ABA@@@@BA@AA@@ABC@CD"],encodeOffsets:[[124546,30347]]}},{type:"Feature",id:"330205",properties:{name:"江北区",cp:[121.555081,29.886781],childNum:1},geometry:{type:"Polygon",coordinates:["@@@ACGAAAAAA@@A@AAA@ABEBABA@@@GFADAB@@ABABABCDCBCBADAB@@@B@B@D@B@B@D@D@@AB@BA@A@ABAAA@EAA@AAA@CACAAAAAA@A@C@E@IAA@A@ABC@@BAB@BAFABAD@BABCDABABADA@@@C@@@GACAA@E@A@A@CBABEFCBABCBCBA@AAA@AAEAAAA@A@A@CBIDIDEDA@A@@BB@@@B@B@@ABA@@B@@@BB@B@@B@D@@@BB@@B@B@@@@BB@@@B@B@B@@@BA@@FBB@BB@@DDBB@@@@@@BB@DAB@@@@AB@@BBBB@B@B@B@@@@@@@B@B@B@B@@BB@D@@@DBD@D@@BBBBBB@@B@B@BA@@BADC@@BAB@B@@@BBB@@@@@A@@@A@@B@@@@@@@@BFB@BA@@BB@@AD@@@B@@@@@BB@@@@@B@B@D@@@@@@B@@@@D@@D@@@@B@@D@B@@@@@@AB@@@@A@@D@A@@@@@@A@@B@@@AA@@@A@A@@@@@@B@@@@A@@BA@ABDFDFA@BDEDAB@@A@@@@@@BB@B@BB@B@@BBB@BB@@@@@@A@@B@B@@@B@@B@@@@@A@BB@@A@@@DB@@B@@B@@@B@@ECA@@A@D@@@DB@BBB@@AB@@@BB@@@BBBBBB@@@B@@A@@BBF@@@B@B@@BB@@@BB@@B@@@@BBBB@@AB@@A@@B@@BB@@BB@B@@BB@@BA@@BA@@@A@@@@B@@@@@@@B@@@@@B@@@@A@@B@@D@@@@@@B@B@@BB@@B@@@B@@@B@BBBB@@B@@@BB@@D@B@@@B@@@B@@BBB@@@BBB@@B@@BB@@A@@BABA@@BB@@B@B@@BBB@@B@B@@A@@@@@AB@@@B@B@B@B@B@B@@@B@DBB@@@@@@BB@B@@@B@BA@@B@B@@B@@B@@@BB@@@@B@@@@@BABB@@@@BB@@BB@B@@BBB@@@BB@AD@@@@@@AB@B@@@B@DA@@B@@A@A@A@@@C@C@A@@A@@A@A@A@AA@A@A@@ABA@@@@AA@A@@B@BCA@@@@@CA@@@A@@@A@@C@A@@@A@@A@BA@A@@@C@A@@@@@@AB@@AA@AA@ABA@@@@@A@@@A@@B@BABABA@@B@@@B@@ABA@@@AA@@A@@@@@A@@B@BA@@DA@ABA@AB@@@B@@@@A@ABAB@@A@@@A@@@@BA@@B@@AB@@@@A@A@@@@@@@AAA@AB@@AB@@A@@@@@@@A@@BA@@@@@@@@@AA@HADA@@@@A@A@@@A@@B@@@AAB@@@AA@ACA@CBAACAB@@AB@@A@@@@B@@@@A@@B@@ADABAB@@@@@@@B@DBB@B@B@D@B@D@D@@@BAB@B@@@BA@A@@B@B@B@@@D@B@@@BABA@AB@BBB@B@B@B@B@BBBBBB@@B@@@@@@@B@B@@BB@B@@@B@DABABAB@@@@A@@@@@@DA@@B@FC@@FEDA@@DCDCDCBA@@BABA@@@CBAD@BB@@FEBABAB@@AA@@@@A@@@@@A@@B@@@B@B@BB@@B@B@D@@@@@@C@@B@DAB@@@@@@@@@@@@@@A@@@@BA@@@@BCD@BA@@@C@C@AB@BCB@@@@AFCFE@@@@@B@@B@@@FCDDBC@B@@B@@A@D@@B@DAB@B@@@@A@@BA@@@@@AB@A@@A@@@A@@AABABABA@@BAAA@@AC@C@C@ABKBA@BBA@@BAB@@@B@EA@AB@BAA@BABB@@B@@A@@@@B@@A@BBAHB@B@@CB@@@@@BAB@@B@@BDBFFBABI@@@AD@@@L@B@@JB@B@D@@AA@@AB@@AB@@@B@BC@@D@B@@@@@B@FBB@BABEB@@A@@@AA@AA@@@@A@@A@@AAACA@@@@@CA@@CCADADAB@@CBABA@@@A@E@GAC@CAEACACAA@A@GC@@A@CAE@@AC@CAA@C@A@A@CA@@EA@@IACAA@IEA@CACC@@@@@@AAAA@@AC@ACE@@AC@C@AA@@AA@A@@@AB@@ABCDCFABEH@@@BCB@BABAD@B@@A@A@A@@@A@CAA@@@A@C@@@AA@@C@A@@@A@ABA@@@BLBHBF@DDF@@A@EBAB@@A@CAA@CAC@AAECCACAA@AB@@ABADADADCH@@@B@@@@GHABCDA@ABC@A@C@@@A@AAA@AA@@ACACAE"],encodeOffsets:[[124401,30633]]}},{type:"Feature",id:"330225",properties:{name:"象山县",cp:[121.869339,29.476705],childNum:60},geometry:{type:"MultiPolygon",coordinates:[["@@@@A@@@@@A@@@@@@B@@@@B@@@B@@A"],["@@A@A@@@@@@@@@@BB@@@@@B@@@@@@A@@"],["@@@@@A@@@@@@@@A@@@@@@@@@@@A@@@@@@B@@@@@@B@@@@@@@B@@@@@@@@@"],["@@@A@@@@@@@A@@A@@BA@@@@@@BB@@@@@B@"],["@@AB@@@B@@@@@@B@@@B@@A@@@@@@AA@@@@"],["@@@@@@@AC@@@A@@B@@@BB@B@B@@A"],["@@@@B@@@AA@@@@A@@@@@A@@@@@@@@B@@B@@BB@@@@A@@"],["@@@B@@@@@@@@@@@B@@B@@B@@B@@@@A@@@A@@@@AA@@@@A@@@@@"],["@@@@@@@@@AA@@@A@@@A@@B@@@@@@@BB@@@@@@@BA@@@@BB@A"],["@@A@@@AA@@@BA@@@@@@@@B@@B@@@B@@@@@B@@@@A@@@@@@"],["@@@B@@@@@@@@@@B@@@B@@B@@@B@@@@@@BA@@@A@@AA@@@@A@@@A@@@"],["@@BA@@@@AAA@A@@B@@@@@BB@@@B@@@@@@@"],["@@@@A@A@A@@@@B@@@B@@B@@@B@B@@@B@@A@@AA"],["@@@A@@@@@@A@@@A@@BA@@B@@@BB@B@@@BA@A"],["@@A@@@A@@B@@@@BB@@B@B@B@@AB@@@AA@@C@"],["@@@@AA@@A@@A@@@@@@A@@@A@@@A@@@@BBB@BB@@@B@@@B@@@BA@@"],["@@@@A@@@@B@@@@B@@@@@@B@@@@@@@BB@@@@@B@@BA@@@BB@@B@@@@A@A@A@@@@A@@@@@@@@A@@AA@@@@AB@@@A@@"],["@@@@@@B@@A@@@@@@@@A@@@A@@A@@@@@@@@@@AB@@@@B@A@BBA@@@@@@@A@A@@@@@@BB@@B@@@@@@B@@@@@B@@@B@@A@@@@@@@A@@@@"],["@@@@@A@@@A@@@@@@@A@@A@@@A@A@@B@@@B@@@B@@@@B@BBBA"],["@@@@A@A@A@@@A@@@@@ABAA@@@@AB@@@@B@@B@@F@B@D@@AB@AA"],["@@BA@@@AA@@@@@A@@@A@@AA@@@@@A@AB@@A@@@ABA@@@@@B@@@B@@@@@B@@@B@B@B@BBB@@@"],["@@B@@@BA@@@A@@AA@@@@@AAB@@@@@@A@@@A@@@A@@BB@@@@B@@@@@@BB@BB@@@@@@@@A@@"],["@@@@B@B@@@@@@A@@AC@@@@A@A@@BA@@BAB@BB@@@B@@A@@B@"],["@@AB@@@@AB@@@@BBB@B@@@@A@@@@BA@@@@@@@A@@@A@@@AA@@AA@@@A@@B@@@BB@@@@@@@@B@@@@@@"],["@@C@C@A@@B@@A@@@@@@@A@@@@B@@DBB@B@@@DABA@A@@@@"],["@@AA@@@B@@A@A@@@@@@BA@@@@AA@A@@B@@@@A@@@A@@@ABB@@B@BD@@BBA@@@AB@@@@A@@B@B@@A@@BAB@@@@@@@"],["@@@A@@@@A@@@AAA@A@@AA@@@@B@@@@@@@@@BAA@@A@A@A@@B@@@BB@BBBA@@BB@@@AB@@@@@B@@@B@@@B@B@@@"],["@@B@@@@@@A@@@@@@A@@@@@A@@@@@AB@@@@A@@@@BA@@@A@@@@@@BA@@@@@@AA@@@@B@@@@@B@@@@B@@@BB@A@@B@@B@@B@@AB@@AB@@@@BBA@@BA@@AA@@"],["@@@@@@AAA@@@@A@AA@A@A@@@A@AAAB@@@BB@DB@@@@BB@BB@@@B@BBD@@A@@@@A@@A"],["@@@A@@@AB@@@B@@AA@AAA@A@@@@@AB@@AB@@A@@B@B@BB@BB@A@@@A@@B@B@B@"],["@@@@A@@A@@A@@@AB@@@BA@BB@@@@B@B@@@BB@B@@@@B@@@BAB@@@B@@A@AAAA@A@A@"],["@@@AA@@A@@@A@@A@@@AA@@A@AB@@@BA@@B@@A@@@@@A@@BA@BB@@B@B@B@@@B@@@B@B@@@B@@A@@@@B@@@"],["@@B@@AAE@AA@@@@@AB@@@BAB@B@B@@ADA@@B@@BB@@B@@@FE@A"],["@@@C@@CAC@A@CB@D@@BBB@FBBABA@@"],["@@B@BA@A@@@AB@@@BAB@@@@@@A@@A@C@A@A@@B@BA@A@AB@B@B@@@B@@A@@@@@@B@@@@@@@B@@D@@@BA@@@@@A@@@AB@@@"],["@@B@@A@AB@@A@A@@A@A@@@ABA@@A@AA@A@C@@@@@@B@BB@@BB@@BABB@@BB@@@B@BAB@@@"],["@@@@@A@@A@@@AB@@@@@A@@@@@@@@@@@A@@A@@@A@@@@@A@A@A@@@@@@B@@@@@@@@A@@@@B@@B@@BB@@@@B@@@@BB@@BAB@@B@@@@@BA@B@@BB@BA@@BA@@@AA@@@@@@A@@B@@@@A@@"],["@@BAB@B@@A@@A@@@@AA@@@@@A@@@CAC@A@CB@B@B@BA@@BB@@@B@B@@AB@B@B@B@B@"],["@@@@B@@A@@A@@A@@@A@@AA@@A@A@@@@B@@A@@@@@A@A@@@A@AB@@@@@B@D@@@B@BB@BBB@@@@A@@@A@@B@@A@@@A@@B@@@@A@@@@BB@@D@"],["@@@BA@@BA@A@@@@@AA@@@BA@@AA@@@@A@@@@A@C@A@@B@@BB@B@B@@@BB@B@B@B@BAB@B@@@BB@AB@@@DA@@@A@@@A@@CA@@"],["@@@@@@B@B@B@@A@A@@@@BA@@@A@@AA@@@@AAA@@@C@ABC@CB@@@B@@B@@@@B@@@@@B@@@@B@@@B@@B@@BA@@@BB@B@@@"],["@@@@@AAAA@A@@@A@C@@BAAA@AB@BA@A@@@A@BBBB@@@@@@C@A@AB@@BBD@FBB@B@B@@A@A@AB@@@B@@@@A@@B@B@BA"],["@@@@AB@@@B@@B@@@@@B@@@@B@B@@@BB@@@@B@@BB@@@@@BB@B@@@AB@@B@@@B@@@@@@AFA@A@@@A@@@@@A@@@@@@@AA@@@@@AAA@@AA@@@@@@AA@AA@@A@@@@BA@@@@@AB@A"],["@@ABA@@AA@A@@@A@@BBB@B@@BB@@B@B@@BBB@@DBB@BADABA@ABC@AA@A@A@A@A@@@C@@@A@"],["@@@@A@@AB@@@A@@A@@A@@@@B@@AAB@AAA@@B@@@@A@@@@@@@A@@@A@@AA@@@A@@@ABBB@@BB@B@B@@ABA@@B@@B@@B@@B@@B@@@BB@D@BA@A@A@@@@@A@@B@@@@B@B@@@@@@B@B@@A@@B@@A@@@@@@@AA@@@@@@@@@@@@A@@@@BA@@"],["@@@A@@@@@AA@BA@@B@BB@@B@@@@AA@@@@AB@@@AA@@A@A@@@C@CA@AA@A@C@E@ABABCB@BABABAB@@@B@@@@B@@@B@B@B@@@@AB@@@B@B@B@B@BA@@@AAA@@@A@@@AB@B@B@BBDBDDBB@@"],["@@B@@BA@@@@@@B@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@B@@@@@B@B@@@@@B@@A@@@@B@@@@@@@@@@B@@B@@B@@B@@B@@@@@B@@B@@@@@B@@@@@@@@A@@@@@@B@@A@@@A@@@@@@@@@@@@@A@@@@@@A@@@@@A@@@@@@@@A@@@@@@@@AA@@@@@@B@@@@A@@A@@A@@@@B@@@@@@A@@@@@AA@AAA@@A@@@@A@@B@AA@A@A@@@@@@BA@@@@@@@@@@@@@AA@@@@A@@@@B@@@B@@@@BBA@@@@@@@@@AB@@@@A@A@@@@B@@BB@@B@@@@B@@@@@@"],["@@A@@@@A@@@A@AA@A@@@CA@@@@@B@@A@@@@@AB@B@@B@@@A@@@A@CA@@C@AB@B@BB@@@@@@BB@@BF@@@@BA@C@@@A@@B@@B@B@BBD@@BB@BB@@@@B@B@BBB@@@BAA@@AA@@@@AB@D@B@@A@@A@AAA@A@BA@@@AA@AA@@@@@@B@B@B@@A@@"],["@@@A@A@@@A@@AACBA@@@AA@A@@A@A@@DAB@@A@A@@A@@AAA@CBAD@BAFBBBBD@B@B@@@BAB@@BB@B@@ABA@@@A@@BBB@D@B@DA@A@@A@@AA@"],["@@@@A@AB@@@A@@@AA@@@@A@@AACB@A@@A@A@@BA@@@@AABA@@B@B@@@@ABABABABCBA@@@AB@@BBBBB@D@BAB@@@B@AB@B@B@@B@BA@@BAB@@A@@@@B@B@B@BA@@@@AA@@@@@ABAB@B@B@@ABA@@@A"],["@@CC@@@A@C@AAAC@A@ABA@CBABAB@@ABBB@B@@@B@BB@B@B@BB@@@BBB@@D@B@@AB@B@DBDBBBBAB@AA@AA@@@@@@ABA@AA@AB@BA@@@@@@AAA@A"],["@@@@B@@A@AA@ECCAAAAA@@BA@@B@B@BA@AB@DA@@@A@@CEAAA@A@EBA@A@@B@D@BA@ABE@@B@B@BDBBBBDB@DBF@BBBBD@B@"],["@@AAA@A@AA@A@@@A@@@AA@C@@BC@AACA@A@@BCAAA@EAA@@B@B@BCBA@AAC@@B@D@DBBB@D@DBDBBABBD@@DD@DBBA@@BB@@BBB@@A@@B@BAF@@A@@AAA@"],["@@A@A@ABCBG@E@A@@@FCB@AA@@C@AAAA@@BC@@A@AAEB@@@BBBAB@@A@AC@@A@@@@B@B@D@D@B@BB@B@B@BAD@@B@BBBBBFBB@B@@@BBHADADEBABBF@FC@ABAAAA@ABA@C@CBA@AA"],["@@AACC@AB@AA@@A@A@@@@AAAA@AB@@@BA@A@A@@BA@@B@B@BB@BBB@@@@B@@AB@B@D@@BB@@@BA@AB@@@DB@F@@BB@ABBDBB@@BABBB@@@BA@@@@B@B@@@@A@@BA@A@@AA@@BA@AA@@AB@@A@@@AB@B@B@@A@@@A@@CAC@A@@@A@BA@A"],["@@BAA@AAA@@ABCDABAAA@@A@A@@EAAA@AB@BABCBA@CAA@CDEBEHMPADCBCD@B@F@BLDHBH@B@@BB@D@DA@BB@B@@@B@D@B@@@@C@@GCCE@A@EBADED@D@D@DA@CA@@ABA"],["@@C@AB@B@B@BBBBD@B@@CBABAB@BBB@@BBBB@@@BA@@BB@@BB@FAB@DCBA@@B@BBBB@@@B@B@@DBB@@@BA@@B@B@DBBD@B@B@@C@@B@@@B@@BBAB@BBBB@DBJDB@BBBBD@FAB@@ABA@@FAD@@@@B@@@BB@B@DA@@@A@A@AB@BA@A@@AA@A@@@A@AAAA@C@GBC@E@C@C@C@A@C@@A@A@@DABA@A@ACAAA@A@@BA@A@@AAA@A@A@A@A@AA@AAAAAC@A@CBABA@@BAA@AA@AAAAACACAAA@"],["@@FBDAH@F@FB@D@DA@CAABCBABEBAB@BFBD@DB@B@BABADABA@AB@DDDBADAB@BBAB@BC@A@@D@B@BDDDBDA@A@ABABAB@B@B@BAB@DBBA@AAABAB@BBBBB@DA@AAAACGC@A@@BAFCFC@AAAE@AA@AA@E@C@A@AA@C@EDCFCDAH@DDFBB@DC@A@@B@DBJDHBBA@AAAEEACACCECAABA@AAAEIEE@CBCAAB@DABA@@AAAA@A@@DAB@@A@C@A@@A@ADA@A@AC@A@@A@A@EACEAEBCF@BA@A@AAAA@CAAA@A@@B@BABA@@AA@@AABABAAAAAACBABBDDDDB@BAF@BB@B@BBADB@@D@@A@A@C@ADBD@BFBF@DC@A@@B@BBBDB@BA@@@B@BB@B@@BABA@A@A@@B@B@@"],["@@@CCEA@CB@@AA@@BC@@AAC@EDA@@@A@AAA@EDA@AB@BDB@BA@C@@B@DDB@BABC@E@C@CCAAAAA@ABADEFKLCBEFCB@F@BBFCXBFBBFB`@V@F@HCFADAHGHIHA@C@ACAKBCBAABAB@AC@C@AB@H@FABCACCEE@C@@@AA@CB@D@BC@AECC@@A@@@AD@HA"],["@@@GCGI@IEIEMDGBIBABCBCF@DBN@HADe@QCEDCD@DBDPPDB@B@BCB@DEBIPCJGDA@G@IAMIIIMIKGO@SDABMHORKHGDKDE@IEQCQDIFENETKlA^@HPb@PBDBNDLH@FRH\\FPDNNJFDB@A@B@@BB@BBD@BBDBB@BDDDB@@BBB@BDF@B@D@B@B@BAB@@A@@AA@@@A@A@C@A@AB@@@@A@@@@B@@A@AB@BA@@@@@AB@@AA@@@@@@KG@@A@@@@@BJ@BDF@BEBC@@@A@CA@AA@AAACCAAAAAABAB@@A@@C@@@AA@@@A@@B@@A@A@E@ABA@C@@@AD@@@BA@A@@@AB@@AA@BA@@@A@G@@@@AA@@@@@@@BDA@@@@AA@A@@@@@AC@A@@@@@A@@@A@A@ACA@@A@@@A@@AC@@@@B@@C@CBA@@@B@@@@@B@@BB@@B@@AB@@AA@@A@A@A@@BBBDD@@@@@B@@@@ABA@@@B@B@B@BBB@@@@@@A@AB@@@B@@@@@@D@@B@@@@B@@@B@@@@A@@BAB@@ABA@BBBB@@B@@@BB@@B@B@B@D@@@BBAB@AA@@BA@A@A@@@@B@@@@B@A@@@ABA@@@A@A@CB@@@@AB@@@BABAB@@@B@@@BAFBB@@A@@B@D@B@@@B@@@@BBABBB@@@B@@CBA@A@@@A@@B@@@@@@@@@BA@@@@B@@@@@B@BAB@@BB@B@@@BB@@B@@BB@@@@@@@@ABBB@@@@@B@@B@BB@@@@@BD@B@@BAB@B@B@@@B@@@B@@@BA@@@AB@@@@@@@BA@AB@B@BBB@@@@B@@@@B@@AB@@@@ABBBA@BB@@@@ABBB@B@@AB@@CAA@@BA@@@CB@BA@A@@@A@@B@@A@@@@@@BA@@@@@AB@BAB@BA@EB@BA@@BABA@@BADA@@@@@BB@B@@@B@@@BB@@B@@@BBB@B@@A@@B@@AB@BDB@@@B@B@@@B@@@@@B@@@@AB@@@D@@@B@@AB@@@@BBA@@D@@BB@@@B@B@BB@@@BB@BA@B@@B@@@BABAB@@@B@@AB@@AB@@AB@@@B@@@D@D@@@BB@@B@@@B@@@@@BA@AB@@@@AA@@AAA@@AA@@A@@@@AA@@A@@@A@@@A@A@A@A@A@@BA@@B@@@@ABA@@@A@AA@@ABA@@B@B@@@@A@@@EAA@@@ACAA@@B@@AA@@@@AA@@C@A@A@@@@AAA@@@A@@@@AA@@@@@C@@@@BA@A@@@CDAB@@ABA@ABCB@@AACBA@@BC@C@A@A@@BA@@@EBA@@@AB@@CB@@AB@BA@@BA@@B@@A@@B@@BBA@B@@DBB@@@B@@B@@B@D@@C@ABCDAB@@@@@BA@ABABAB@@CB@@CD@@A@A@@BAB@@@BAB@@@B@B@@@B@@A@A@A@@BA@C@A@A@@@@@A@@@A@@B@@A@@B@@@BAB@@AAAB@@@@A@@@A@A@@BA@@B@@@BAB@@@B@@A@@B@@A@@@AB@AC@E@@AA@@@@@BAA@@@@@@@B@@@B@AA@@@@A@@@A@@@@@A@@@A@@AA@@@A@@@A@CA@@C@@@@@@@A@@A@@A@@@@@@AABA@A@A@@@@@A@@B@@A@@BA@@BA@@@@@A@A@@A@@A@@@A@AAA@A@@@AA@BA@@@A@AB@@@@@B@@@@@@@@ADAB@B@B@B@@@B@@B@@BBB@B@@@@@@C@@@A@@BAB@@@@@@@B@@@@@@A@@A@@A@@B@B@@AB@@A@B@@B@@@@B@@BA@@BA@@@AB@@A@@@ABAD@@@BABAD@A@BBFDDBBB@HDHBF@NDFDVFDBPDHBLHHHHDDBDBBBDD@DABCBK@E@aDLBLDJBPDF@HB^HVBFBJBZJFBPJBBBBFBJFFFDBJJRNFDLLDFDFBDB@B@B@BBDDFFFFJJHJRTBBPP@BJJHFDDJFDBVNNJPJTTFCn]PELA\\DPDJHHHNLPDL@NEHIHCJ@J@LCNCLCHKJOPWFIDCNGJMBG@E@C@CEGOKECAC@IDIFIDCHE@ACEOG[gGMEQDOBUBC@EFIHGRMLKDSCKCGAAGIEKI[CIAAG@MCGCOKKKOUAE@KAGFC@AA@@ACACICG@IBEFCLCTCHCDCBE@EGKCEKQGUCOBSBCDCN@H@JADAJATBHBH@JAHAFCF@BAAG@E@GACBCF@BCAA@AM@EBC@_OAABCACC@CBC@A@@A@EBCFKDIFKBE@C@ADA@AAAAAEaAG@CAAGAI@CBM@E@EAC@CCKECA@A@A@C@@P@H@FADCDGDEFEHEPI^ODE@EAAACA@CAC@C@A@@A@CBADADCDA@CBKDeAEAECCCCA@C@KCCACCCC@ADABBFAF@F@FA@A@CB@BACCCACACCCCEACEAIAG@K"]],encodeOffsets:[[[125017,29888]],[[125180,29556]],[[125128,30086]],[[124745,29735]],[[124827,29698]],[[125214,29579]],[[124965,29801]],[[125005,29887]],[[125017,30213]],[[124942,30212]],[[124900,30195]],[[125141,30094]],[[124772,29769]],[[124916,29784]],[[124945,29746]],[[124997,29917]],[[124926,30277]],[[125184,29574]],[[124991,29908]],[[124972,29965]],[[124959,29725]],[[124710,29774]],[[124855,30367]],[[124985,29829]],[[124974,29980]],[[124936,30268]],[[125172,30172]],[[125143,30153]],[[124965,29990]],[[124733,29739]],[[125189,29572]],[[125195,30161]],[[124922,30344]],[[124956,29988]],[[124904,30113]],[[125160,30171]],[[125179,30169]],[[124919,29993]],[[124912,29935]],[[124941,29995]],[[125162,30085]],[[124942,30045]],[[124929,30333]],[[125111,30106]],[[125203,29576]],[[124925,30183]],[[125165,29553]],[[125129,30164]],[[124946,30114]],[[124930,29923]],[[124942,29932]],[[124913,30053]],[[124880,29741]],[[124925,30210]],[[124962,30322]],[[124910,29873]],[[125115,30114]],[[124955,29879]],[[124763,29756]],[[124896,29759]]]}},{type:"Feature",id:"330282",properties:{name:"慈溪市",cp:[121.266561,30.170261],childNum:6},geometry:{type:"MultiPolygon",coordinates:[["@@ACA@A@ABBDFA"],["@@@A@@@AA@@@A@@@A@@BBB@@B@@@B@@@@@"],["@@@@A@@@@@@@@@@@B@@@@@@@@@@@@@@A@B@@"],["@@A@@@@@@@@@@@@@@@@B@@B@@A@@@@@@@@@@"],["@@AA@@@A@@AA@@A@@B@@@@BB@@B@@@ABB@@@@@B@"],["@@CB@AAAB@@AA@@@C@@B@BAAAB@@A@ABA@A@@@A@@@A@@@@BA@@@@BA@A@A@A@AB@@A@@@@@A@A@@@AAABA@@AABAAABA@ABA@A@C@@@@@AAA@@@@@@AC@@@@A@@@@@AA@@@A@@@@@A@AAA@EBA@@@A@A@@B@@A@A@@@A@AAA@A@AB@@A@@@C@@@ABA@A@@@@@@AA@AAA@@@C@A@@AA@@@A@AAA@A@A@A@@@@@A@@@@@@@A@A@@@ABA@@@@B@@@@ABA@@@A@@@@@@ABA@@@AAAA@@@@@A@@B@@A@@@@AA@@@@@@@AABAAA@@@@A@A@@@A@A@A@@@@B@@A@@@ABA@AB@@A@@BA@AB@@A@ABAA@@A@@@@@AB@@@@@@@@AA@@@B@@A@@@A@A@@@A@A@@@A@@@A@AB@@A@@BA@AA@@@C@@AAA@@A@@A@A@@@@@@A@@AA@@A@@AA@ABA@@@AA@@@@A@@@@@@@@@@B@@@@AB@@@@@@@@@@A@@@@@A@@@@@A@@@A@@@@@@B@@A@@@@@@B@@A@@@@@@@A@@@@B@@@@@@B@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@@@B@@@@B@@B@@@@B@@@@@@@B@@@@@A@@B@@@@@@@@@B@@@@A@@@@B@@@@@@@@@@A@@@@B@@A@@@@@@@@@@@@@AA@@A@@@@@@@A@@@@@@@@B@@@@@@@BA@@@@@@B@@@@A@@@@@@@A@@@@@@@@@A@@BDB@@BBC@A@@@A@A@@@AB@@@B@@A@@BC@@@AB@@A@@@AAA@A@AA@AA@A@@B@@AF@@AB@BA@AB@@AAA@@ACBA@A@@@BB@@BD@@BB@BDD@@BD@D@@A@A@ABCD@B@@AB@BB@BBCDDBAB@@BBB@@@ABCBAA@BAB@@A@@@ABCBCBA@@B@@B@@BB@@@@B@B@@B@ABB@@BBB@@@@BBB@@BB@@BBBB@B@B@@@B@@@BAB@@AB@B@@@B@@B@@BB@@@@B@B@B@B@B@B@B@@A@@@@B@@B@@BBB@@@DBBB@@@B@@@@B@@BBB@@@@BB@@@BBA@@@@@A@AB@@BB@B@BB@@A@@@@BB@A@@B@@@@@@B@@BA@@@@@@BA@@@B@@@B@@@@@@@@B@@@@D@@B@@@B@BB@@BA@@@ABB@@BB@@@DDBB@@@@B@B@@@BA@@@AB@DB@D@@@@@@B@BBB@@@D@B@B@@BB@@B@B@@@B@@@D@@AB@@A@@@ABABABA@@BC@A@A@A@@BA@@@@BB@@BB@@@@B@@@@BDABA@@B@@AD@@@BB@B@@B@@@B@@@@A@@@AAA@A@@BAA@@CB@@C@C@E@A@@@BD@@A@A@A@A@@@A@A@@@@@@@A@@AA@@@@AA@@@@@A@@@@@@@A@@@A@@@BBC@@@@@@@A@@@@A@@@@A@@@@@A@C@@A@A@@AA@@@C@@@@A@@@AAA@A@@@KBA@@BA@A@@@@@@AC@@BA@A@A@A@AB@@@BA@@@@@A@@A@A@@G@IBC@C@C@A@A@A@@@C@CB@CE@@A@@@A@@@@@@A@@C@A@C@@@@C@@BA@B@C@@B@@@BBA@BC@@@K@@DG@A@AAA@@C@AC@AGA@C@@B@BBB@B@@@B@@@@A@AA@B@@BFA@@A@@AAAB@@A@@A@@@@A@@@@B@B@@@@@DC@@AACA@@BCB@@@@@@BD@@@@@B@B@BBB@@AB@D@B@@C@@DCAA@AAC@@BA@@F@@@FAL@BGC@@@DAJ@@HB@B@D@@@BB@@BA@@@AD@B@B@BF@B@@BH@AJ@N@@ABALAB@JAJAB@DA@ACC@@@CR@@@@GCGA@@CA@@OC@@@@@@QEKCA@AAA@AH@@@@@@JHDDB@^LB@@@BB@B@D@D@BBB@B@@@@BDBBBBB@DBBBBBB@@@DBBBB@DDD@@@EN¬[nLP\\`xjJhH`FR@FN^HRFHDFJDLFJBwhaDC{®@¤OuJKfu¶pelkhkVWvNQNQphRkNULKQGGIEKEGCKCEA@@@AC@@A@@BA@A@@A@@@AAA@@@AG@AA@@AB@BA@A@A@A@AA@@A@@@@B@@A@@@A@@@@@A@A@AA@@@@AAA@@@A@A@A@AAAB@@A@A@A@@@A@@@A@AB@BCBA@@BG@A@EBA@@@A@BBA@AB@@@B@B@@AB@@ABA@BB@@A@@@@BA@@@ABC@EBA@AB@@A@@@@DA@@@A@C@AA@@A@C@C@@@A@AAA@A@A@@BA@@@CAADA@CA@@A@@@AB@@@@@B@@@BBB@@A@A@@BAB@@@@A@AA@@A@@@@@@@@AA@@@A@@@C@@@A@A@A@@@A@@@@@@AA@@@@@A@A@A@AA@@@A@@AAAA@@@@A@@AA@@@A@A@@@@@A@A@A@AA@@@BA@@@@BA@A@@AAA@@@@@A@@AA@AA@AAB@@@B@BA@@AABA@@AAA@@@@@@A@@@@A@@@A@A@AAEB@@@@@@E@A@@B@B@B@BB@@@@B@D@D@@@B@B@BA@@@CBA@@@A@A@@B@@@@C@@BAA@@A@AA@@@AAA@@AA@@@@AAAB@@@@A@@@@@AA@@A@@@@AA@A@@@ABA@@@A@A@@A@@@@A@CAA@@@A@A@A@A@A@A@@@A@@B@@@@@BA@A@@@AA@AA@A@@@AA@@ABAB@@@BA@@AA@@@AA@A@@AA@AA@@@A@@@A@C@@@AA@@A@@@AAAAA@@@A@@@A@@@AA@@@A@A@@@@C@@@BBA@@B@@@@A@A@@@AAA@@BABA@@@A@@@A@A@A@A@@B@BA@@@A@@@CB@@ABC@@@AB@@@@@@@BC@B@@BB@@@BB@@AB@@BB@BB@@@@B@@B@CD@@@@@BB@@B@@B@BBB@@@@B@@@@@@AB@BAB@@A@ABA@ABA@AB@@C@@@@@AB@B@@AB@@@@A@@@AA@@A@AAA@@@AA@@@@B@@A@A@@@AAA@@@@AB@@@@C@A@A@@AC@@@AA@@A@@BA@@@A@A@A@A@@@AB@@A@@AAAGB@@AA@@A@A@AA@@A@A@@BA@A@A@@@AB@@AB@B@@A@ABA@A@@@A@@@@@A@@BA@A@@@@@AB@BA@@BC@@BA@@A@@@@AA@@C@"]],encodeOffsets:[[[124230,31211]],[[124252,31234]],[[124251,31225]],[[124253,31228]],[[124219,31241]],[[124256,30792]]]}},{type:"Feature",id:"330226",properties:{name:"宁海县",cp:[121.429477,29.287939],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@A@@@@CC@@@AA@CB@@@ABA@A@A@@@@BA@A@@@A@@@@C@A@A@@@@A@@A@@@@B@@@@A@@A@B@@@@@@@@A@@B@@@@AA@AA@@B@@@@@B@@@@@B@B@@AB@@A@A@@@A@@@@B@@A@@@@@@@A@@@@@A@@@@@ABB@A@@BA@@B@@@BA@@@ABA@@@A@E@A@A@@@@B@BB@@B@@@BA@@@@@@@@@ABB@@@A@A@@@@@@B@@BB@@@@A@BBA@@@B@@@@@@@@@B@@@@@@@B@@@@A@@BB@@@@@@@B@@@B@@A@A@@@@@@B@@AA@@@BA@A@@@@@@B@BA@@A@@@B@@A@@@@@A@@B@A@@A@A@@@B@@@@A@@@@AB@@A@@@AB@@A@A@@@A@@@ABAB@@@B@B@B@@B@@@@BBBD@@@B@A@@B@@AB@@@BA@@B@@@BBB@DB@@@B@@F@B@B@B@@AD@B@@B@@@@BB@A@@@BB@@@B@@@@B@@B@B@@AB@@@@ABC@@B@@@BAB@@BB@@BB@@B@B@BBB@@BBDBB@@A@ABA@A@@B@@@@@@A@@@@@AB@@@@A@@@AA@@A@AB@@ABAB@@A@@@@@AB@@@@@@@@@@@B@@BB@@@B@B@B@@@@AB@@A@AB@@@@@@@@A@@BA@@@@@A@@B@@@@@@@BAB@@A@@B@@AB@@CB@@@BA@@@A@@BA@A@@BA@@BABC@@B@@@B@@@@A@@B@B@B@BBB@@@B@BB@@@AB@@@D@BBB@@@DBBA@A@@@ABC@@@@@AB@@@@A@AB@@AB@@@B@B@@A@@BB@@@@B@@@@A@@@A@@@@@A@ABA@A@A@@BB@@@@B@@BB@@@@@@@B@@BB@@A@@BA@A@@@@@A@@AAB@@A@@AA@@AA@@@@A@@@A@BABA@@@@@A@@@A@@A@@@AA@A@A@A@@@@@@@AB@AA@A@@A@@AA@@A@@@@@A@A@@@A@@B@@@@@@ABA@@@@@@@A@@@A@@A@@A@@A@@A@@AABA@@BC@@@A@A@@@A@@@@@A@@BA@A@C@A@A@CA@@AA@@A@AB@@@@CDA@A@@@A@ABA@A@@@@B@B@@@B@@@@@B@BBB@BBBB@@BB@ABBBBD@@@BA@@BA@@B@BA@@@@BBB@@@DAB@B@BAB@BA@@@@B@@@@@@B@BAB@@AB@@@B@@A@@@@B@@B@B@BAB@@@BBBA@ABA@@@@@BB@@@B@B@@ABBBBB@BA@@BC@A@A@A@A@A@ABBB@@BB@@BBB@@BADBB@@A@@B@BBBB@BB@B@D@BA@@B@@B@@@BBB@@@B@B@B@@@BAB@@@BA@@@A@@BB@@@A@@B@A@@AB@@@B@@@BB@B@D@B@@B@@@B@@@@BA@@@A@@@@B@@B@@@AB@@@@C@@@@@@BAD@@ABAD@@BBABB@@@@B@B@BBB@B@@B@@B@B@@@@B@@@@@@BB@@@BB@@A@@@@@A@@@@B@@@@B@@B@@B@@@@@B@AB@@@BAA@@AB@@BBA@@BA@@@FDDB@BD@BDBD@@@BB@@@@@B@@@B@@@B@@@B@@@@@BBBBB@FDB@BBB@@@@BA@@B@@@@@B@BBBBD@BAB@@ADA@AB@@A@@@AAGCCA@@@@@@ADAFAB@@@B@BB@D@B@@@@@@@B@@@@@@B@@B@@B@@B@@B@@@@@@@@B@DB@@@@@@@B@@@@@B@@@B@BA@@BBB@@AB@@AB@@@@@@@BB@@@@@BBA@BB@B@@A@BB@@AB@B@@ABB@@BAB@@@@@@D@D@BB@BB@@@B@@B@@BBBBBB@@B@@B@BB@@@B@BAB@@@@B@@@B@@BB@@BBB@@BB@@B@@@B@@D@D@DB@@@BD@@@@AB@@@@@B@BBB@A@@B@@BB@@@@@BA@@@B@@@AB@@@B@@@@BB@BB@@B@@B@@@@B@@B@@@@B@@A@A@@B@@A@@BB@BB@@A@@BB@B@@BB@@@@@BA@@BB@B@BAB@@@B@@A@@@CB@@@@@B@B@@BB@@@D@@@@ABAB@@BB@@@BAD@@AB@@@BBB@@DB@@B@@@B@@@B@@@@B@@@B@@@BBDAB@BA@AB@B@B@@@D@B@@@BAB@@@BB@B@B@@@@@B@B@@AB@@@B@@@BBB@BBB@B@@@@@@@B@BB@@B@B@@@@B@@BB@@@@@BB@@@@BBB@@@@@@B@@@BB@@D@@@@@@B@@@B@@BB@BB@@@@@BB@DB@@B@@@@@B@BDB@BBB@B@@BB@@@@@D@@@DBB@B@@@@BB@@BB@@@BB@@@B@@BB@@@B@B@B@@@@B@@@@@B@@@B@B@@@BB@BBB@@BBB@@BBB@@@BB@@@BABA@@@AB@@AB@BA@@@@BA@@B@B@@@@BB@B@@A@@@@@A@@@A@ABA@@@@B@BA@@@@BA@@@@@A@@BAB@@@@@BB@@@@@BABA@@B@@@@B@@AB@@BBDBB@@A@@B@@@@@BD@B@@B@@@B@AB@@@BB@FCBA@@@@BB@@@@@B@@@B@@@@@B@AA@@@AB@@A@B@@B@@A@@@BB@@@@@@A@@@@B@@A@AB@B@@A@@F@@@B@@@@BA@@@A@@B@@@B@@@@ABAB@@@B@BB@ABA@@B@F@@@B@BBBB@@BB@@@@B@@@D@BA@@BABA@@B@BAB@BAB@@@B@@@D@@@BA@@@B@@BB@BBB@@@B@A@BB@@@@A@@@@@@@@@@B@@@@B@@@@@@@BD@BAB@B@B@@@@BB@@@B@@@B@@B@@@B@@@BB@@@BB@B@@BBB@B@BBB@BB@@@@BB@@B@@@BB@@DB@@@@@@DB@@@@@B@@@D@B@B@B@@@BBB@@BB@@B@BAB@@@@@@@@AA@@@BCB@@AB@BAB@B@B@DBBA@@BABB@AB@B@@@@@@@B@@@BAB@@A@@@@@A@@@ABBD@@B@@@@@BA@@@DB@@B@@A@@@A@@@@@@@AA@@AB@@AA@AAB@@@@A@@B@BA@@B@@@@AB@B@@@BB@@BAB@B@BB@@@@B@@@@@B@@@@@B@@@BA@B@@B@@@BB@@@B@@BBB@@B@@@@@@@@@@@@AB@@@B@@AA@@@@A@BB@@@@BB@B@@@@B@B@B@BB@@@BB@B@B@@@BB@BB@B@@B@@B@@B@@BB@@@B@@@BB@@BB@BB@B@@BB@B@@@B@@B@@@@@@B@@B@@B@@@@B@DB@@@@BB@BB@@B@@@B@@B@@BB@@BB@@@@@@@@B@BAB@B@B@@BB@@B@BA@@B@@@B@B@B@B@B@@AB@B@BB@@B@@@@AB@BA@@BAB@@AB@B@B@B@B@@AB@A@BAA@BA@B@@@@@@@@BBBA@@@@@@BAB@@B@AB@@A@A@@AAB@@ABA@A@A@A@@BABA@@@B@@@@BBA@BBBB@@BB@@BB@@B@@@B@@@B@@@@@B@@@BBD@@@@BBA@AB@@@B@@@@@BB@@BA@BB@@@@BB@B@@ABB@@B@@B@@@B@@A@@@@B@B@@BA@@B@B@@@DA@@F@@@@AB@@@@@B@@DA@B@B@@@@@FCFC@@@@@@@@DADAD@HAB@BADAB@DA@@@A@A@A@@@@BA@A@ABA@AT@@A@@jAZ@V@F@PAL@J@RBL@B@@BDBFDTHVJLFF@HBLBbCF@L@DABA@CCCAACACAGCGGKGGAOCCAUEECMCE@GAGCA@AACCAE@A@BBCBA@A@@BCBA@@B@@@BA@@B@@AB@@AA@@@@@@AA@B@@@BA@@@A@AB@@@@BB@@@@@@@@A@@@@@@BA@AB@@@D@@@@@@@@AAA@AA@@@@A@@@A@A@ABABC@@@@@@@@@A@@@@BAB@@@B@@ABB@@B@B@BBB@@@B@@@@BB@B@@@@@B@@AB@@AB@@@@AB@@@@@B@B@B@BA@B@@@@B@@@@BB@@@@@@@D@@@DBB@@@B@@@B@@BB@@@B@@@@@B@@@B@@@@@BBA@@@A@@@@@@@B@AB@@@@B@@BF@D@@BBA@@B@@@@AB@@@@A@@BA@A@@@AB@@AB@B@@@B@@@@@BABB@@BA@A@@@AB@@@@AB@@@B@@@@@B@B@D@B@@AB@B@B@@@@A@@@A@A@@BA@A@@BA@AB@B@@@DC@@DA@@BABABAB@@A@@@@BADCBAD@@@@C@AA@@@@A@@AA@CA@B@AA@@@AB@@@@AB@@AB@@ABA@@DA@@BA@@B@FA@@B@@AB@B@D@D@@AB@DABB@@DABAB@BA@@BADC@@B@B@@A@@D@@@@@B@@B@@B@@@B@BB@@@@@B@B@DB@@B@@B@@BA@@@BBBD@@B@FB@@B@@@@@@A@AB@BA@@BBB@@@B@BA@@@@@AB@@AB@B@B@B@B@@@B@@@B@@@BB@@@@@BB@@BB@BB@@BB@@@@BAB@@A@@@@@A@@@AA@@A@@@C@C@@@A@@BA@@BA@@BA@@@A@@BABA@A@@@AA@B@@AAA@@A@@A@A@A@@AA@@@CB@AA@@@@BA@@@A@@@C@@BA@@@@@A@@@@@A@@@A@A@@CA@ABA@@@AB@@@@AAA@A@@@AA@@A@@@A@@@AAA@@@@B@BC@AB@BA@AB@@AFAB@@ABA@ABA@@@@B@@A@@@@B@@@@AB@@@B@B@@ADA@@B@@AB@DB@@BA@@@AAABA@@@@AAB@AABA@@@@BA@@@A@@A@@@@@AA@A@ABAB@@A@@@@@@BA@@B@@A@@@A@@@A@@@A@ABA@AA@C@@A@@@@AAA@@@@A@@@@AABA@@@@@@@@AA@@@AA@@A@@@AAA@@BA@A@A@@@@@A@@B@@A@@@@@@@@@AB@@@B@B@DA@@@A@@AABAAA@@@@@A@@@A@C@AB@@@AABE@A@@@A@@BABA@A@@BA@@@@DAB@B@@@B@BA@@B@A@@@@@@A@@B@B@B@@AB@@BBAAA@@C@A@A@A@@@AA@@A@@@AAAAB@BA@@BA@AB@@@@@@A@@@A@@A@@@@C@@@@A@@@A@@B@B@@@@A@AAA@A@A@@@B@BA@@@@@A@@@@CCAA@AB@B@B@@@BB@@BA@@@AA@@AA@@@@@A@@@B@DAD@@@@A@@D@@BB@@@B@@@DB@B@B@B@@@B@@@@@BBD@@@@B@B@@B@@B@AC@@@@@@B@@B@@H@B@@@B@@ABB@@BA@@B@B@@A@@BC@@D@B@BAF@B@B@@@@AB@@@B@@B@@@DB@@@BABABBBBDBBDBBB@@BDBB@@@D@FA@ACE@AAI@@@@B@@@LH@@@@@@BB@@BA@@@@B@@ABAB@@@@A@@B@@@@@BAB@D@B@B@@@B@@BB@@@BA@A@A@A@C@ACE@AAA@AA@CCACA@CAAAC@AAA@@AA@B@A@ECMICMEOG[EQG@CKAMAC@OOaSaQ[W^oROFYLwZDE@QBCC]BA@E{@AGAMAC@E@SBIBS@]BMBMBCBIFA@GHABEDABCBEDE@CBI@G@OBC@@@OCBAEAA@@@@B@@@BA@AAAB@@A@@B@@A@@@A@@@@A@@@@B@@AA@@@AA@@A@@@A@@BA@@@CA@@@@A@AB@@@@AA@@@@A@AB@@@@@@AB@@@@A@@B@@@@AB@@AB@@@@@@BB@AB@@@@@@@@B@@@@@@@@@@AB@@@@A@@@BBA@B@@B@@D@@@@@DB@@@B@B@@AD@BA@@@@B@B@@@@@B@@@B@@A@@BB@@B@@BB@@@@ABABA@@@@@@BA@@@@BA@A@A@@@A@@@A@@BAB@BBB@@@B@@A@A@@@@B@@@@ABABA@@B@@@@@@@@B@@B@@@@@@B@@@@@@@@BA@A@@@@BA@@@A@AA@@AB@@A@@BA@@@@D@@@B@@@@A@@@@@A@@@@@@B@@@B@@@@@@A@B@A@A@@@@B@@@@@@C@@AA@@A@A@@A@@@@@@@A@@@@@@@A@@A@AAA@A@@@@@@ABA@@@@@@AAA@A@@@A@@@@AA@@@@@ACAAA@@@A@@A@A@@B@@@@@BAB@@@@A@AAA@@@A@ABA@@@AAA@AA@@@AA@@A@@A@@A@A@@@A@@@A@@@@@A@@@A@@B@B@B@B@@@B@@@B@@@BB@@B@@@@@@@@@@AAAD@@@@@@@@@@BB@@@@@B@@A@@BAAA@@@@A@@@@@@@@AB@A@@A@A@@@A@@@A@@@@@@AAA@@@@A@@A@A@@@@ACA@BA@@@@@@B@@@@A@@A@@@A@@@A@@BA@@AA@@@AB@A@@A@A@@@AAAAA@@A@@B@@AAAA@@AA@@ABA@@@@@@A@A@@@@@@@@@ABBB@@AA@@@@@@@@@A@AA@@BC@A@@@ACCA@@@A@@@BAAAB@AA@@@A@@@@@A@@B@@ABA@@@@BA@@BA@@AA@@@A@@@AB@BA@@AAAA@@A@@AA@@@AA@A@@BC@@AAAA@@ABAA@@AA@AAA@@@A@@AA@@@BA@A@@@A@AAA@@AC@@@@@@B@@@@@@C@A@A@AAA@@@@@AAB@@AA@@A@@@A@@@@@@AAA@@CAA@@@AA@@AA@@@@A@@ABA@@AAA@@BA@A@A@@@@AAA@@B@@CAA@@@A@@@@@@B@@A@@@@@@@AA@B@@@@@B@@@B@@@@B@@@@@@B@@B@@B@@@@@@@BA@@@A@@B@@@@@@@@@B@@@@@@B@@B@@@@@@@@@@A@@BB@B@A@@B@@A@@@@@@BB@@@A@@BB@@@@@@B@@AA@@A@@@@@@A@@@A@@@@@@AA@@@A@A@@@BA@@@EB@@@@A@AB@@A@@@@@@@AA@@@@@A@@B@@AAA@@A@@@@BA@@@AB@@@@@B@@A@@@@@@BB@@BA@@@@@@@A@A@@A@@@@@AA@@@@A@@@AD@BA@A@A@ABB@@@A@@B@@@B@@A@@@@@A@@@@@@@AA@@@A@@A@A@A@@@A@@@AB@"],encodeOffsets:[[124210,29800]]}},{type:"Feature",id:"330283",properties:{name:"奉化区",cp:[121.406997,29.655144],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@@@AA@A@@A@@A@@@@AA@@@A@@A@A@@@AAA@@@@@@@A@A@AAA@AA@@A@@@A@@BA@A@@@@@A@A@A@@@@BB@@B@B@@BB@@@@@BA@@B@@@@ABA@@@@@@BAB@@A@@@@@A@@AABAB@@A@A@C@AB@@A@A@@@@@BB@@@B@@DB@B@@A@@BA@@B@B@BA@@B@B@B@B@@@@@@@B@@CD@@@@@@@@@B@@A@@BA@@@@@B@@@ABA@@BA@@@@B@@@B@@@@@@@@@BB@@@B@@@D@BB@@BB@AB@BA@@@@BBB@BB@@@B@BB@@B@@@@@@BB@@@ABBB@@@@@@@BB@B@BA@@BA@BBA@@@A@@@A@A@AAA@@@@B@@C@@B@@@BCB@@@@@BB@@@@B@@@B@@@@@BAB@@@B@D@@@BB@@BA@@@BBB@@@BB@BBB@@@@@@@B@BA@BB@@@@@B@@@BA@@B@@@@@@BB@@@@@B@@@@@@A@@AA@@B@@@@@BBB@@A@@@@BADB@B@@BB@@A@@@@B@@@@BA@@@@BB@@@@B@@B@@@@B@@B@@@@@B@@@B@@@BB@B@@BB@@@B@@@@@@BB@@@@B@B@@@@B@@@@@@@B@@@@AB@@@BA@@B@@@@ABA@A@@BA@AB@@EBA@@@AB@B@@B@@BA@@@A@@@A@@B@@@@@@@@@B@@@BB@@@B@BB@@BA@@B@B@@@B@@@@B@@B@@@@@@B@@@B@@@B@@@B@@B@@B@@B@@@@B@@@@@B@BB@BB@@@B@@BBDB@B@@@@BBB@@DA@@BA@A@@B@@ABA@@@@@AB@@@B@B@B@@@@@BA@@@@B@@@@A@@BA@BB@@@B@@BB@@@B@BBB@@B@A@@BA@@@@@@@AA@@@@A@@@AAA@@AA@@A@@@@@A@@@AABAAA@@@@AB@@AC@@BA@@@AA@@@B@@@@@BA@@B@@@@AB@@AAA@@@@@@@@@@BA@B@A@C@@@@AA@@@AB@@BB@@B@@@@@A@@@@@B@@@@BB@@@@@AB@@AB@@AB@@A@@@@A@@@@@@B@@@B@@@@A@@A@@@ABAAABABA@@@ABA@A@@@@B@@@@A@@@@BAAA@@@A@A@AA@@@A@@@@A@@@A@A@@@ABAB@@A@@BA@@@@@A@@@A@@A@@@@A@CA@@@ABA@@A@@AC@@@A@A@A@@BAA@@@@@B@@AB@@@@B@@@AB@@A@@@A@@@A@AAABA@@BA@@@@BCB@AA@AA@@@@@@AB@@@@AB@@ABEB@@A@@@AB@@A@@AA@@@A@@@AAA@@@@@ABAA@@@BA@@AA@@@@@@@A@@@AD@@@BAB@@@BBBAB@@AA@@@BA@A@A@@@AD@@AB@@ABA@@@@BAB@B@@A@A@@@@A@@@A@AA@AAEAEAAAC@@@A@@@@B@@A@@@A@ABABA@AB@@AB@B@@A@@BB@@B@B@@@B@@@B@B@B@@@@@B@B@@@BBB@@@B@@@B@@B@@@B@@BB@@@B@@B@B@B@B@@@BDB@@B@@B@@@@AB@D@@A@@@@BAB@B@B@BAB@@@B@BB@@@ABAB@@@AA@A@@AA@CA@A@@ABAD@@A@@AA@@A@@@BA@ABA@@BB@@@BB@@B@B@BBB@@@BB@@@@AB@@@@@@BB@@@@@BA@@@@BA@@@@@@BB@@B@@BB@@B@D@@@B@@BB@AB@@@DAB@BB@@@FABB@@B@@B@@@@A@A@@B@B@@A@@@@A@@A@AB@@@@@BA@AAA@@@A@@@@BA@@@@@@BB@@BBBBB@BAB@@@@@BB@@@@B@BA@CDA@@@@@A@@@A@@B@@@@@@@A@@@@A@@@@@AB@B@@BB@@@B@@@BB@@A@@BB@@@B@@@@@B@@A@@BA@@@A@@BA@@B@@A@@@A@@@A@@@@@BA@@@@ABA@@@A@@@ABA@ABA@@B@@@@@BA@@@A@AAA@@BA@@A@BAA@@CA@@A@@@A@@BAB@@@BA@A@C@@@A@@BA@A@@@@@@BA@@@@BA@A@@B@BAB@@BB@@@BABB@@@@@@@B@BB@@BB@@@@ABAB@B@B@@@B@BB@@BBB@@AB@@@BAB@@@B@@@D@@@@@B@@@@@B@BA@BBABC@AAA@@@BB@@BB@@BBBA@B@B@B@@C@A@@@@@BBA@@@CDABA@@B@@@@AB@@A@A@A@@B@B@B@@@BAB@@@@B@@BB@@BB@B@@@B@B@C@@DA@@@@BB@@BB@@@@@@B@B@B@@A@C@E@C@@B@@BBB@@BA@@B@@A@@BAABD@BA@@B@@@@B@@B@@@@@B@@BB@B@@@D@@A@B@B@B@B@BBDAD@@@@A@AA@@@@@AA@A@A@ABA@@@@BA@@B@@@@AB@B@@A@@B@BABA@A@@B@B@@@@BB@B@DB@@@@BB@@@@B@@@@BB@@@@@B@@@BA@ABAB@B@B@@@BB@@BBB@@@@B@@BBBB@@B@B@@@@@BC@A@@B@B@@AB@F@@@B@@@@A@@BABC@@AA@@BABABABA@@@@@@@@BA@@B@@AB@@@@ABA@@@@BABAB@B@@@@B@B@B@@@@@B@@@@@@AB@@BBB@BB@@@@@@A@@BB@B@@@@@@@@A@@AA@ABA@A@@BB@@@BB@BB@@B@B@@@B@@@B@DAB@@AB@@@BAB@@@@B@@BB@@A@BBA@B@BB@@@@@@B@B@@A@@B@@ADB@@@@AB@B@BAB@@@B@B@@BBA@B@@@@@BB@BAB@@A@BB@@@@@@@@@BB@@@BBB@B@B@B@B@@@@BBB@B@B@B@BD@B@B@@@@@B@B@A@@BB@@BB@@BBB@BBB@@@@@@@BCB@@@B@@@@B@BBB@@@B@BBBAB@@A@@@@@@B@DB@A@ABA@AB@@@@@BA@@@@@@@BBBB@@B@@BBBDB@@@A@@B@B@@AB@@@@@B@@DB@@@B@@@@BB@@@BA@@@@B@@BBB@@@@@AB@@@@@BB@@@@@@@BA@@@@@BB@@@B@B@B@B@@B@@@BB@@BB@A@@BB@@@@@B@@@@A@@D@B@@ABB@@B@@@B@B@BB@@@@F@@@@@@B@BBB@@BB@@AB@@AB@@BD@B@BB@AB@@@B@A@BB@@@B@B@@@DA@@B@BAB@@@@A@A@A@@BA@A@A@@@@@A@A@@AA@@B@B@B@@@B@@@BA@@@BB@@ADBDABB@@B@B@@@@@@B@@@@@@BB@BB@B@D@DBB@@BD@@@@@@A@@B@@@BA@@BA@A@@BB@@BBB@@B@@BB@@@@@@BBB@@ABA@@BADABB@@@@@@@B@@@@@BBDB@@BA@@BB@BA@@BA@@@@B@@A@ADB@@B@@B@@@B@B@B@@@@DA@@BABA@@@A@@B@@B@BD@@BB@BBBB@BB@@@D@@@BAB@@BB@BA@@@@@A@@@A@@@@@@@@AA@@@A@@B@B@B@A@@AA@@@@A@@AAB@@BB@@@BABB@@B@@@B@@@BA@@DABA@AB@@@@B@@@B@@D@D@@BB@BB@@@A@@@CBA@@@@B@@@B@BBB@@BB@B@D@@@@BB@@@BB@@B@B@@@B@B@BB@@BAB@BBB@@@B@B@D@BA@@@@B@BA@@B@@@BBB@@@B@FABAD@D@BA@@BB@@@@D@B@@@BB@@B@B@@@@B@@@@B@B@@BB@@@B@@@B@B@BD@@B@@@@@@@BABA@A@@@@@A@AB@DBBB@@@@@@BA@@@@B@@@B@B@@@B@B@@@@@@@B@@@@@@@@@@@AB@@BB@@@B@@@B@@B@DAB@BA@@ADAD@B@@B@@B@@B@@@BAB@@@B@@A@@BB@@@@BB@@B@BB@AB@BA@@B@B@@BB@ADB@@@DB@@@AB@@@B@@C@@@@BAB@B@BABBBD@@BBBA@@@@B@@@@@@@B@DC@@BA@@@@BAB@B@@@B@@@BBB@@@BB@@B@@AB@B@B@@@@@@B@@@B@B@@@@B@B@B@BBB@@@@A@@@@@A@@BB@@@@@@@@@@@@B@BAB@@B@B@B@BBADA@@@AB@@ABB@@@@@@@@@@@@ABB@@AB@@B@BA@@@A@@@A@@@CBA@@BB@@@@@B@@BBAB@@@B@BA@@@@@BC@@@ABA@@@@@@B@@B@@BB@B@B@@@@@B@B@@@@ABBB@DCB@@@B@B@B@B@@AAA@@BAB@B@B@@@B@BB@@B@@@D@DBFB@@@@BB@B@B@B@@ADAB@B@B@B@B@B@@BBB@B@B@D@B@B@@@@BB@@FAB@@A@@B@D@B@@B@@DA@@@BBBBB@@@@B@B@@@BB@BBB@D@DAD@H@D@@@@@B@BF@B@B@@@BABGBABCB@@AB@@@B@@@@BD@BB@@BB@B@BBB@B@@@@AB@B@@ABA@ADEBC@AB@@@BA@@@BB@@@BB@DBF@B@@BB@@B@B@@@B@@@@ABABA@AAAB@AA@@@AB@@@@@A@@@@@A@@@A@@@@AA@B@A@@@AA@BCCAA@@@A@@@A@@D@@CA@@AB@B@@@@A@@BA@@A@@@@@@A@@@@@A@@@@@A@@@@@@@A@@@@BA@@@@@@@@@A@AB@BB@@B@@@@@@AD@@B@@B@@@@AB@@@BA@A@@@@BC@@@@@AB@@A@@@A@ABCB@@@@@@@@@@BA@@@D@@AB@@@@@@AE@@A@A@A@A@A@A@A@C@C@A@E@C@A@E@E@ABC@CBA@A@CBA@A@A@A@ABC@@B@@AFADCD@DA@A@@@@B@B@@@B@@AD@@B@@@B@@@@B@B@@A@@@BB@@@F@@@B@@@B@D@@@@CD@BADABBJ@D@@@@ADB@@@@AHDBJH@@JC@@B@@@@@B@@A@@@A@@@@@@@@@A@@@@@@@ADB@@B@@@B@DCHC@@@AB@@A@@@@BC@@B@B@B@@@BC@A@@B@D@BABA@@@@BB@AB@@@BAA@@@C@BAB@@B@@BA@@BA@@B@@@@B@ABBB@@BB@@@@D@@B@@@BB@ABD@@@ABB@BB@D@B@@A@@FAB@JB@@B@@@B@BAB@@@@A@ABAB@@C@A@@@A@AAA@A@@@A@A@@@@AA@@A@CA@@@@BA@A@@AA@@@@AA@@CCA@B@@ABA@A@AF@AC@@@A@@BC@@@A@@A@@@A@A@@@AA@@AA@@@AA@@BADA@@B@@@@A@@@@@A@@@@@AA@@@A@@@A@@@AACCA@A@@AA@@BA@@@@@A@A@A@@@@@A@@@A@A@A@@@A@A@@@@B@@A@@@@@AB@@@@@BCA@@AA@CA@A@A@@@A@@@@@AB@B@@@BAB@D@@@@@@A@@@A@A@A@@@@B@@@B@@@B@B@B@B@B@BAB@BABAB@@A@AB@BA@B@@@@@BB@@@@@@@@A@@@@@@@A@@@A@@@@@@@@B@@@@@@@@@AA@@@@@A@BB@BB@@B@@A@@@A@@@A@@B@@@@@B@@B@@B@@@@@@@AC@@B@@@@@BAA@@@BA@@@A@A@@@@@A@@@@B@@@B@D@@@@@AA@@@@@ABBB@@B@@@BBB@@BBA@B@@BB@@@@@@@@@@BBB@@@B@@B@@ADA@A@@@@A@AA@@@@B@BA@BB@BB@@B@@@@@@@@AB@@@@@B@@@B@@ABB@@@@BB@@A@@B@@@@B@@@B@@@B@@@@B@@@@@B@@A@@@@@BB@@@@BB@@BBB@@BB@DBBBB@@@BB@@BB@@BBD@B@@BF@BADCD@BA@@BBB@@A@@B@BA@@B@@@@@@@AA@@@@A@@@@AB@@@@BB@BBB@@@@A@A@ABA@@BAB@@@AAAA@@BC@@BAD@@AD@@@@@B@@@B@D@DAB@B@@@B@B@D@@@B@@@BB@B@BB@B@B@D@F@B@@B@@BBBBB@B@@@@B@@@B@@@@@B@@DBBB@@B@@BB@@@BA@@B@B@@AB@@@@@@@BBB@BBBBB@F@B@B@@@B@@@B@@@B@BA@@@C@@@@DA@@@ADA@@B@BB@@@@ABA@A@@@@@BB@BB@A@@BB@B@@@@@@@@AB@@A@@@@B@B@BAB@@@@@AB@B@B@B@B@@B@@@@@B@@@B@B@@@B@B@B@@@@@BAB@@@B@@BB@@@@@B@BABBB@@@B@@@@B@@@B@@B@B@@@@B@B@@@BBAB@@@B@@@@B@BB@@@BABA@@@@AAAA@A@@@AB@@A@@B@B@@BB@BA@@B@@@B@B@@B@@B@@A@@B@B@@B@AB@BB@@@@BA@@@@B@BABAB@@@@A@AB@@@BB@@@@BB@@@@BB@@B@@@B@BB@@B@@@B@B@@@BB@@@A@@B@A@AA@A@A@@@@@@@@@@DB@@@@B@@@@A@C@A@@D@@@D@B@@@@@BB@@@@B@@@@@@@@AAAHE`S@AOOAAQSGIIIEEEECCAAA@A@A@ACCECEKKECQMIICAEEIEEAAAAAOIEAYIIAEAUA]GGAE@OCIAKCKAKAGAE@KEUISGECCA@AA@K@QAI@K@OBE@U@Y@iB@@@BS@@BAB@B@BAB@@@@@B@B@B@@CBA@CBABA@GBC@CBCB@@@@@@@@EDED@@@@A@A@B@@CA@@@@@A@@B@@E@@@CB@@A@A@@@AB@@@A@A@@B@@@@A@@@AA@@@AA@BA@A@@A@@A@@AAB@@AA@@@@A@@@A@@BAB@A@@C@AA@@A@@@@@A@@@A@@@A@@@AA@@AA@@AAAAB@AA@@@@@A@@ABAB@@@B@B@BAB@BA@BB@@@B@BA@@B@AA@AB@@@@@@ABAA@@@@@@@@@AABB@ABB@A@@BA@A@A@A@A@@BA@AB@@ABA@@B@@A@@@AAA@A@@BA@A@A@A@A@@@A@@@ABA@@@AA@@@A@ABA@A@A@@@@@@A@@AA@@AA@@@@A@@@AA@@AAA@@@@CAA@@@@@@AA@@@@A@@@@A@@@@A@@@AAA@@@AAAA@@AA@@A@@@A@@AA@@@AA@@@@AA@A@@AAA@@A@A@A@@A@@AAA@A@A@@@@@@AAA@@@@AAB@@@@@BB@@@A@@BA@@@@@@@@@@@@@AA@AA@@@A@@AA@@A@@@@AAB@@A@@@@@A@@@@@A@@@@@AAA@A@AB@@AA@@A@A@@B@@A@@@ABA@@@@B@@A@BBB@@BA@@BB@@B@@@@@@@B@@@BA@@@CA@@B@@A@@@@@AC@AA@B@@@B@@@@@BA@AB@@A@@@@@@@A@A@@BAAAB@@ABCAA@A@A@ABA@@BA@AD@@B@@B@@@@@@A@ABA@@@AA@@AA@A@@@A@A@A@C@@@A@@@@CA@@@@@@CA@@AA@@A@@@AA@@@@AAA@AAA@A@AA@@@AAA@@A@@A@@@A@@@AA@@@A@@@A@@A@@A@A@A@ABC@@A@@@@@@@A@@A@@@@@@@@@@@@B@@A@@A@B@A@@AA@AAA@@@A@@AB@@C@@@A@@@A@ABA@ABA@@@ABAB@@ABC@@@A@@@@@AA@@AAAAA@@@E@A@@@AB@BAAA@@@A@AB@B@@A@@@A@@@@B@@AB@@@@@A@@@EB@@@@ABAB@@@@A@@B@@@@@@@AA@@B@@@@AA@B@@@BA@@B@@B@A@@@@@A@@@A@@@@AA@@@@ABEDA@@A@@BAA@@@A@@@@AAC@@@@A@@@@BA@CAAA@@BA@@@A@@A@@@ABAB@@@@A@@A@@@@BA@AB@@@@@B@@A@@B@@A@A@@B@BAB@@@B@@@@@B@@@@AAA@@@@@A@AB@@A@@B@@ABA@@BA@@B@BA@A@@AA@@A@AA@@AA@AA@AAA@@A@@@A@A@@@A@@@@@A@@A@A@A@@@A@@AA@@@A@@A@@AA@@AA@@@@@AAA@C@@@C@@@@AA@@@AAA@ACA@A@A@@@@@AA@@CAA@@@@A@@AAA@@@A@@@A@@@@C@@@AA@@A@"],encodeOffsets:[[124157,30120]]}},{type:"Feature",id:"330281",properties:{name:"余姚市",cp:[121.154629,30.037106],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@A@A@@@AAAA@@@A@@A@AA@@AA@@A@A@A@AB@BAB@@A@@@@@A@@A@@A@@@@@AA@@@@CAA@A@@A@@A@A@@@@BABABA@@@@BA@A@@B@@A@@@AB@@@@AB@B@B@BBB@@@@B@@B@B@@C@CBAAA@A@A@A@@BA@@@@BA@AA@B@AAA@@@@AA@A@@AA@A@@AA@@@@AA@A@@A@@@@A@C@A@@AA@@AA@@AA@@@@A@@A@@@AAA@A@@@@AA@@@@@@AA@@A@A@A@@AA@@A@@@ACA@@@@@AB@@@B@B@B@@@A@@@@AAA@@@@@A@@@AA@@A@@@A@@AB@@A@A@@AA@A@@@@ABA@A@A@@AAAA@@@A@@@AA@@@A@A@@BA@@@@@@BB@@@@B@BB@@@@B@A@@B@@B@@A@@B@@@@@@@@A@@@@B@@@@@BABA@@@@BA@ADA@AA@AAB@AA@@@B@@@A@@@@@A@@@@BC@@@A@@@A@AB@@@@AA@A@@CA@@A@A@@@A@C@AB@@A@@@@AA@AB@@@@AB@@@@@@A@@@@BA@@@AA@@ABA@@@@@@B@@A@@@AA@A@@@BA@A@@BA@@@@B@@A@@@ABC@@BB@@B@@BB@@@@A@@B@@@@AB@@B@AB@@A@@@@B@BBB@BB@@BBB@@BB@@B@B@@@B@@@BC@@B@@A@@@@@A@AAAA@@@@A@@@@BBB@DB@A@AB@@AA@@@@@@AB@B@@@B@@@BB@@@@@@BB@@@@BA@@@BB@AB@@@@B@@B@@BAB@@@@@@@@B@@@@B@B@@A@@@A@@B@BBBB@@@@@A@AB@BAB@@@BB@@@@@@@B@@@B@@@@A@@@@BB@AB@@@@@B@@@@BB@@@@BB@@@AB@@@@@@@B@@BB@@B@@@@@B@@@@@@B@@B@@ABB@@@AB@@C@CBABABAB@@@BBBB@A@@@@@AA@@A@BB@B@@A@@@AB@@B@@@ABA@A@@@@B@@@@@AA@@@@@@@@F@@@B@@@B@@CBBBBB@BBBB@BBB@@BB@@@B@@@D@AA@@@@CABABB@@@@B@@B@@@B@@@BB@DB@@AB@@@@BB@@B@B@BAB@@AB@@@@@B@D@B@@B@@B@B@@@B@@@@@BBB@@@@B@@B@@@@@@@@@@A@@@A@@@@@@B@@BA@B@@B@AB@@@@AB@@@@@@A@@@B@@@@B@@@B@@@@@@@@BA@@@A@@@@B@@@@@@@@A@A@AB@@@@B@D@@@@@@@@BA@@@@@@@@@A@@@A@@@@@BB@@@@@@CA@@@@@@CB@@@@@@AB@@@@@@D@B@B@@@@@@@@@@BA@A@@@@@A@@@@@@@@@@@C@C@AB@@A@AA@BBB@@@@@@@@@AA@AA@@@@@@@B@@@@@@@@@B@@@@@@AAA@A@@AC@@@BA@@A@A@@A@@@BA@@BB@@@A@@@A@A@@@@@@B@@@@@@@@@@BB@@A@@@A@A@@@BB@@@ABBB@@B@B@@@@@B@@AA@@@AA@A@A@@@@@@BAD@B@BA@@BA@@@@B@BBB@@@BBB@B@B@B@B@@D@@B@@BAB@@@BAB@@@D@@@B@@@B@@@@@A@@BA@@@B@@@@@@@A@@@@BA@@@@@@@@@@@@@A@@@@@@@@@A@AA@@@BCB@B@@B@B@@@BAB@@@D@B@B@BBB@@@B@@@@@@BDB@@@@@B@A@@@BB@@@@@@@@ABA@@@AA@@@BAB@@@@@@@DAB@@@B@BB@@BBBB@@@@@@C@@@@@@@@@@BAB@B@@BB@@CDAB@@@BAB@@@@A@A@@@@@@B@@@@@@@@@D@@DB@@@@ABA@@@A@@@@BA@AB@@@@A@@B@@@BA@@@@@@B@@@B@@BB@@@AB@@@@B@@@BBA@@B@@B@BD@B@@@B@@@@@B@@@@@B@@@@@@@B@@@@@@@BA@@BBD@@@@@BABA@AB@@@@AB@@A@@@@DBBB@@@B@BB@@BB@@@@@B@@A@@BA@AB@BA@@AA@A@@B@DBB@AC@@B@@@BA@@B@@@@@B@@AB@@@BBB@@@@@@B@@@B@@@@@@@B@@@@@B@@@@@BB@@@BB@@BA@@B@BA@@BBBBB@@@@@@BAB@B@@@@@@@@BB@@@@@@B@B@@@B@DB@B@@@@@@@@B@@B@@@BB@@@@@BA@@@@B@@@@BDAB@@@@B@BB@@BB@BBAB@@@B@@@B@B@@BD@@@@@@A@AB@@@@@@BD@AB@D@B@B@@BB@@B@@B@@A@@BB@@BBB@BBB@@@@B@@@B@@AB@@@@B@@B@@@@A@@@@@A@@B@@@@B@B@BB@BB@@@B@B@@@@A@BB@@@AB@BB@@B@@D@@@BA@B@@B@@@@@B@@@@@@@B@@B@@@B@BB@BBD@@@ABABBBABBBA@@@@B@@@@A@A@@@A@@B@B@@ABA@A@C@@@A@@@A@@@@B@@@@@B@BBB@B@@@B@BA@BD@@@B@@@@BB@@B@@B@@BBB@B@B@BB@@@@@@@BB@@BA@@B@@AB@@@@@BBB@@B@@B@BB@@@ABAB@B@@A@@B@@BB@@@B@@@@@B@@BB@@@@@BABA@EB@@ABA@@BA@@@A@AAAAA@@@ABA@C@A@@BAB@@@B@@ABA@ABAB@@A@@@AA@@A@@@A@A@A@@@A@A@A@A@@@A@@BADA@@@AB@@ABAD@B@@AB@BA@@@@@@@AA@@@AA@@AA@@AA@@@AA@@AA@@@@@AAA@@@A@@A@@@@@A@@BA@@@A@@@AB@@@B@BA@@@@@A@@@A@@B@@@@@D@B@B@B@@@@AA@@@@AD@BA@@@A@@BA@A@CBAB@@@@AB@AA@A@@@A@A@@BAB@B@D@@@@@B@@A@AB@BAB@BC@@B@@@B@@BD@@@@@B@@@@A@A@@@AAA@@BA@@@@@@@A@@B@@@B@@@B@@@@@BA@@BA@@@A@AB@@AACBA@AB@@@BA@@@@@@B@@CDFBB@@@@B@@@@@@A@AB@B@BA@AB@@@BA@@@@B@@@@@@@@@DB@@@@AB@@B@@@@D@@@AA@@@A@@B@BB@@@@B@@@@B@@@@A@@B@@@BCBA@@@@@AB@@@@BBBB@@@@BB@@@BB@@A@@@@@@@@B@@@@@@AB@@@@@B@@A@@@@B@@@BB@@@@@@B@@@@@@@@@B@B@BB@@@@@@@@BBB@BBB@@BBB@BB@@@@BADCD@@A@@@@@@BB@@BB@@@@B@@@BB@@B@@B@@B@B@@B@@BB@B@@@@@@BB@@@B@B@@@@B@@BBAB@@@@BBBD@@@@@BB@@B@B@B@@@@@B@@@@ABB@@B@@@@BB@B@B@B@@@BBB@@BB@B@B@@@D@@@@@B@@BB@@B@@BB@@B@@AB@BB@@BB@@@@B@@B@@B@@B@@@@@@B@@@@B@@A@@@AB@BBDBB@BBB@BA@@@@B@BA@@BA@@B@@A@@BBB@@@@B@B@@@B@@BB@@BB@@DD@@BBD@B@@@@@@B@@@@@BBB@@@BB@@B@@B@BB@@B@B@B@B@B@B@BBBBBBB@@BB@@@@D@@AB@@@BBD@@@@@BB@B@@B@@@B@@@B@@@@B@@B@@B@@B@B@BAB@@A@A@@@A@A@@@ABA@A@@B@@ABA@@@@B@@@@@B@DA@@B@B@@A@@@A@@BA@@@ABAB@@AB@@@BA@@@@@C@@BA@@BA@AA@@A@@@AB@@@@@B@@@@@@A@@@BB@@A@A@A@@@@@@@A@C@AA@@AAA@@A@AAB@@@B@@AA@BA@@B@@@@A@C@CB@B@AA@CCAA@@AC@@AAA@@@@A@@@AA@@@@@AB@@@@@@ABA@A@AA@AABCFAB@B@@DD@@BD@@@@AB@@@@@BB@BFBBADA@@@AAA@AAACAB@@IAC@@@@BA@@B@@@@A@@AA@@A@@@AAACBA@AAABAB@@BBAB@@@@A@A@A@@@@@@@@@A@@BA@A@@@A@AB@B@@@BABA@A@A@@AA@A@@BA@AD@@A@AB@@A@@@AA@@A@@B@@@@@@@BA@AB@B@@@@@BABA@@@A@AB@@@BB@@@AB@B@@@@ABC@@@@B@@@@@BBB@B@@B@AD@B@@@B@@@@@BA@@B@B@@@B@B@@B@@@@@B@@B@B@B@@BD@@B@BBB@@B@@@B@@@@@@A@@@@@@@A@A@@BC@A@@@@B@@JD@B@@B@ABB@@B@@ADA@CBA@A@CC@BADAB@@AB@@@BAA@DE@BAGA@@C@@B@@AB@@@@D@B@BBD@ADA@@@A@AAA@A@@@@@@BA@@@A@@@@B@@@@@@@B@@DD@@B@BD@@@B@B@B@@@@A@CBAB@B@B@DA@EJAD@@@@CDA@@@AB@@@@A@@@A@@@A@@@@@@@A@A@@@A@@A@@A@@A@A@@EBEBA@@@@BADC@@B@@@@AB@B@@@@B@ABB@@@ABA@@@@@@@@B@BC@@@@@@@@@@@@BBD@@@B@@@BA@@@@@@B@@@@@@@@AB@@@@@@@B@@@B@BA@CB@A@@A@@@@@C@@B@@A@A@@B@B@B@BA@ABB@B@@@@BB@B@@D@@@B@BB@@B@@B@B@@BB@@B@@@B@D@@@@A@@BC@A@@AAB@@@@@@BD@@@B@B@BCN@FAJ@DA@AL@@AB@@BBAJINCLCL_cOdRD|RVLjVNH\\LxPTDRDLHFFTNDJLV\\m«FM@@C@CCA@AACA@@A@AAAACAA@AAAAAC@@@@@AAA@A@C@C@AAA@@A@]KA@CCIG@@@@@@BGB@BBB@LDRF@@@@@@PD@@DB@@HBHD@@@@DQ@@D@BDB@@CBABI@IBABKBA@@@MBIG@@AA@E@@A@A@ABC@@B@@AA@@A@@@C@AGA@@BI@C@@HD@ABK@E@@@EB@@AD@BBB@DB@CD@@@@A@CBA@@AA@A@A@A@@@@AC@@@@@@DA@AB@BD@BD@@C@@@@@A@A@@B@@@@@@BB@@@BABB@@@BB@AE@@@ABBB@@@@@@A@@@AAA@A@AD@B@BHD@@B@DB@BBB@H@@CL@@@D@@AAB@A@@@AD@A@B@@AD@@@@@@D@B@DB@@@@@@@@B@@@BF@@DDAD@@@B@B@B@D@D@D@JAH@@@@B@BB@@@@@B@@A@@BAB@B@B@B@@AD@@B@@@@B@B@@AB@LA@@B@B@BB@@B@@@@@@D@@BB@@@B@BD@B@@@@@B@@@@@@B@@B@@@@@@@D@AA@@B@@@B@@@@@@@B@@@@@B@@B@@B@@BB@@@@@@@B@B@@@B@B@B@B@@@AC@@B@F@D@D@@@DA@@BB@AB@B@BB@@B@@@@@@A@@@AA@A@@A@@BC@@@AB@BAAC@@@@@A@@A@@AA@@A@@B@@AB@B@B@D@@AB@BABABA@@B@@@BA@@@C@@@A@@@A@AA@@AA@A@C@@@A@AAA@@@@@@@@CCAA@@B@@AB@@A@A@@@@@AACC@@A@@AA@BA@@B@@AA@@A@A@@@AC@@@@@@A@@@@@@A@@@A@@@B@@A@@@@B@@AA@@@@@@@@AB@A@@A@@B@@@AAA@A@@AA@@B@B@@@@AB@A@@AA@@@@AA@AA@@@@@@A@@AACA@@A@AA@@@AA@@@@@@BA@A@A@A@A@A@A@@@@@AA@@@AA@@@A@A@@BA@AB@@A@@@A@A@A@AA@AA@@AA@AA@@@@AA@AA@BAA@@@@A@A@@A@@AA@@@@AB@DADABA@@B@@@BA@ABBDABA@@A@AA@@BACADCAAA@@ABA@@@ADCBAB@B@@@@CAC@@CC@AAA@@AC@@AA@@B@B@DA@BB@BB@@BAB@@ABA@@BE@@@AB@B@@BBBB@B@BB@@B@@@BA@@D@@AB@@@@A@@BA@@B@B@@@B@D@AA@@CA@AB@@@@@@@@@B@@@@@@@B@@@@@@A@@@@B@@A@@@@@@@A@@@@@@B@@@@@@@B@@@BB@@@@@@@@@@@@B@@@@A@@B@@@@@@@@@@@@A@@B@@@@@@A@@@@@@@@@AB@@@@@A@@@@@@@A@@@@@@AA@@@@@@A@@@@@@@@@@A@@@@@@@@@@A@@A@@@@@A@@@@@@@@A@@B@@@@@@@B@@@@A@@@@B@@@@A@@@@B@@@B@@@@@B@@@@@B@@@@@@@@@@@BA@@@@@A@@@@@@@@B@@@@@BB@@B@BAB@@BB@@@BB@@@B@@@@B@B@@@@BB@BB@@@D@@BBB@@AB@@@BAB@@@B@@@B@B@@@B@B@@@B@@@@A@@BB@@@@@@@@BA@@@@B@@@BBBAB@@@BAB@@AB@@@BAB@BA@@B@@@@A@@B@B@B@@@B@B@@@@@BBABBB@@@@@@B@@B@@B@@@@AB@@@@@B@BB@B@@AB@B@@@@B@@@B@BA@@@@@A@@B@BA@@B@B@@@@@@@B@@@@@B@B@B@B@BBB@@@B@@BB@D@@@B@BBB@@B@@@@B@B@BA@@D@@@B@@@BAB@B@BBB@@@B@B@@@@AB@B@@@B@FAB@BBB@@@@@B@@@B@@B@@@@@B@@D@@B@@@@B@BB@@@@D@B@B@BAB@BABBBA@BB@BABB@@B@B@@@@@B@@@BAB@B@B@B@@A@@B@@A@@B@@@B@@@B@B@BAB@@@BABB@A@AD@@@B@@BA@BB@BDAD@@@BB@@@@@BB@@AD@@AB@@ABA@@@@B@B@@AB@@@@@B@@@B@B@BAB@@@@ABA@@BA@@B@B@B@@AB@B@@@BBB@B@@@BB@@HABB@BB@@@BA@@B@B@B@B@@@B@@AB@@@BB@@D@@BB@B@D@@@@@BA@@@@BB@B@@@B@BA@@@@@BB@@B@BBB@@@BB@@B@@@@@BA@@@ABA@@@@D@@@BAB@BAB@BAB@@@BA@ABA@@@@@@@A@@A@AAA@@@@AA@@A@@@@DCA@@@@A@@A@@AAA@@BA@@AA@@A@@AA@D@@A@@@@@@BA@@D@BA@@DA@@B@@@B@@A@AB@B@B@B@@@B@@@B@BA@AB@BB@@B@B@@@@@@AB@AA@@@@@AB@@@@@@A@@@@@A@@@@@@@A@@B@@@B@@AB@@AA@@AA@A@@AA@@AA@@@@BA@@BA@AA@A@@A@@@AA@@A@@AA@A@@@E@AA@@@BA@@@A@AAAA@A@@AA@@A@@BA@AAA@@C@@@C@BB@FD@@@A@@@AA@@@CA@@B@@@AAB@@@@@A@@@@A@@@A@AB@@@@@@@AAA@AA@@@AAAA@A@@A@@@@B@@@BAFCACB@CECEBAB@@AB@@@@@@A@@@@B@B@@@B@@B@@@AB@@@@@@@@B@CB@@@@@BA@@@@@@@A@CA@@@@@@CC@@@@@@A@@@@C@A@A@@@@@A@@A@@@@@A@@BC@@AA@@ABA@AE@@@@@@@@@AB@@@B@@@@@A@AA@@A@A@AB@@CDAB@@ABA@A@@@AAAAAA@@@CAC@C@@@CAA@@@A@A@A@A@@@@@@@A@A@AAAAA@@BA@@@@BA@CAA@@@@@@AACC@@AAA@EA@@AB@@A@A@A@@@A@@A@@A@A@@@AA@@C@A@@@@AAA@@A@@@AB@BA@A@@@A@@AB@B@FCJCJCDAB@B@B@BBFBBBB@BBB@DADABADAFEAAA@@@@A@@@@A@@@A@@@@@A@@@A@@@B@AB@@C@A@@@AA@@@@@ABB@A@@BA@@@@BB@@@A@@@AA@@@@A@C@@C@AA@A@AAAAA@@@@@A@@@A@A@@@AA@@@A@@AAAAA@@A@@A@A@@@@@CA@@@AAAA@@D@@@B@@AB@B@AA@A@@AAA@B@@@BA@@AA@A@A@@@A@AA@@C@A@@@@@@ACAA@AA@AAA@@CA@AAAA@@AAAA@@AA@ABA@@A@C@@@@@A@AAA@A@CAA@ABC@A@@@@@@@BD@@@B@@@B@B@@A@A@@@CA@@@@A@ABABA@@@B@@BBBBB@@ADCBBB@@B@@@B@FBB@@@A@@B@@@BC@@@A@AA@@@@ABA@A@@B@@AAA@@@AAA@CAA@@AAAAAAA@@C@@@AB@@ADA@@@A@ACA@@@@@A@A@@B@@@@@BBBBB@@BBB@BB@B@@@@ABA@@BA@@AAA@@A@@@@BA@@BA@AB@@A@A@A@@@@B@@BB@@@B@@@@@B@@@BB@@@BB@@@B@@@B@@@@@B@B@@@@A@AAAA@@A@AB@@@@@@@@@A@@AA@@@AA@@@A@A@A@@A@@A@AACABAA@@A@@A@C@ACAAAA@ACA@@@AA@AC@@A@A@@@@A@@@A@@BAB@@@@A@@DA@@@@E@A@A@A@@@A@CAA@AA@@B@@AAC@A@@B@@@B@B@B@@@AAA@@@A@ABA@@A@@@@AA@ABA@@@@@AA@A@@@A@A@@@AA@@A@@B@@@@@B@@@@AB@@A@A@A@@@A@EB@@@@A@@@A@A@A@CAA@@@@BA@@@@A@@A@AB@@A@@AA@AAA@@BA@@AAAA@AAA@ABA@@@@@@A@AAEBA@A@AAB@@A@CA@@A@@@@AA@@AAA@@C@@@@@A@@@@@@A@A@@ABAC@@@@A@@@A@CB@@A@@@@A@@@@@@AA@A@A@AA@@@BAB@@ABBB@BB@AB@BA@@@A@@@AA@AA@@@@@AAAA@@AAA@@@AB@@AAA@A@@@@@@B@@A@A@@@@B@@@@@AAABA@@@A@@@@C@@@A@A@A@@CC@A@@@@CA@@A@@AA@@@@A@@B@@A@@A@AAA@@AAA@AC@A@AAA@AA@BA@@A@@@A@ACBA@@@AB@@AA@@A@AB@@@A@@A@AB@@@AA@@@@A@@@@A@@@AAA@C@@@A@@@@@A@C@@@A@AABA@A@@CCA@AA@@B@BAB@@@@AA@@@A@A@@@@A@@@A@@@@CA@@A@@@AAAB@AC@@AA@A@AB@@@A@@BA@@@AA@@AA@@@B@@ABA@@@AA@@A@@@@@@B@@BB@@B@@@@@@B@@@@@@A@AA@BA@@@A@@@A@@ACA@@C@A@@AAA@A@A@A@@@@@@@ABAB@@A@@BAA@BA@@@AB@@@AA@@AAAAA@@@A@@@A@@@@AA@@@@A@@AA@@@BA@@@@ACAA@@BA@@AA@A@A@@BAB@BA@A@@A@@@@@@A@A@@@@@@AA@@@A@AAA@@B@@@BAB@BA@@@A@@BABC@@@@@@AAA@@@@@A@C@@@@@@@@@A@@@A@@@@@CDA@A@A@@@@@@@@@DC@@B@AA@@@@C@A@A@@@@DA@BB@@@@@B@B@@BB@@@BA@A@A@@B@B@@A@A@@@C@AAA@A@@@@BA@@@CC@CA@@@@@AA@@@@@@A@@@@BA@@AA@@AAAA@A@@@A@@@@A@@@ABA@@A@@@@ABB@A@@@ABA@@@@@A@A@@A@@A@@A@ABA@@A@@BE@@@@B@B@B@@@@B@@BBB@B@@@B@@@@AA@@A@EAA@AB@@B@@@A@A@@BBBAAA@@B@DA@@BABA@@BA@A@@@AA@A@@@@A@@@A@@@A@@AA@@@A@AAA@A@@BAAA@@@@@A@@@@@A@A@@@A@@@A@A@@@@@AB@@@FAB@@@B@B@@@@@BB@@B@BB@@B@@@B@@@@A@@@@BAB@@CA@A@AAA@@@@A@@@AA@@@BC@A@@@@@A@@D@@@@@@@B@@@B@@A@@@@@A@@@@B@BAB@@B@@@B@@BCBABABA@BBA@@B@@@B@@BB@@@@BAB@BB@B@B@BB@BBB@@BBB@B@@@B@@@@BBB@@@B@@@BAB@@@B@@@@B@@@B@B@B@@@BA@AB@B@@@@A@@A@@AAAB@@@B@BBB@B@BBBBB@B@B@BAA@@@A@@AA@AA@@A@BA@@@B@A@@BAA@@A@AAC@@BA@@BA@@AA@@AA@A@A@@@@E@@@@@AAA@A@@@A@@@AA@BA@C@@@@B@@A@@@@@A@@AB@A@@AA@@A@@@AA@A@A@A@@@A@@A@@@@AB@@@@@@A@@A@@@@BA@@@@A@AA@@@A@@B@@A@@AA@@@@@A@@CA@@@A@@@@BA@@@A@AB@@@A@ACAA@@@AA@AA@A@@@@@@AB@@@@A@@BAB@B@BCAA@@@@@@@@BA@ABAAA@@@A@AAA@@@@@@A@@DA@A@@@@@@AA@AAA@AA@@AA@@AB@A@A@@@@@A@A@C@@A@A@A@AAA@A@@A@A@A@A@A@AA@@A@@A@@@@@@@@AAB@@@BA@AAA@@@@A@B@AA@@@A@A@@BA@A@ABA@@@@CA@BA@@@@BA@A@@@@@@@AAA@B@AAB@@@AA@@@A@@A@AB@@A@@BA@CBA@@@A@@@A@A@@@AAA@@A@@AA@@@BAB@BBB@@@B@@@@@@A@A@@AB@@@@@@@AAA@AA@@BA@@@@@@@A@@@@@A@A@A@@A@A@ABAB@@@@AB@B@@A@@BA@@@AB@@@@@@@@ABABABAB@@BB@@ADAB@@@B@@A@@@E@A@@BA@A@@@@BAD@@"],encodeOffsets:[[124003,30405]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
@@ -0,0 +1,4 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("衢州",{type:"FeatureCollection",features:[{type:"Feature",id:"330802",properties:{name:"柯城区",cp:[118.871516,28.96862],childNum:1},geometry:{type:"Polygon",coordinates:["@@@D@@BB@@@BA@AB@@@@@B@@@B@@@BB@@@AB@@@B@DA@@@@@@BB@@@@@@@B@@@@@@@@@@@B@@@@B@@AB@@@A@@@@@@AAABB@@@@B@@@B@@@B@@@BAB@@@@A@C@A@@@@@A@@@@@A@A@@@A@@BAB@@@B@@BB@@A@@@AB@@A@@B@@B@BB@@@@@B@@@B@@A@@B@AABA@A@@BB@@@@@@@@B@B@@@@AB@@@A@@AB@BA@@@@@@B@@@@@@A@AB@@@B@@@BA@@@@B@@@BA@@@@@A@@@A@@@@B@@@@@@A@A@@B@@@@A@@@AB@B@@@@@B@@@@AB@@A@@@@A@@@BA@A@@@@@@@@@@@B@AAB@A@ABA@@@@@AB@@@@A@A@ABB@AB@@@@A@@@@@@A@@A@@@@@@A@@A@@@@@AB@A@@@@@@@@A@@@@@A@AB@@@B@@A@@@@@AA@@@@@B@@@@A@@@@A@@A@@B@@@@@@@BB@@@@@@@BB@@AB@@B@@B@@@AB@@@@@@B@@@@@@@B@@BB@@A@@@A@@@@BA@@@@A@@@@A@@@@@A@@@@@@B@@B@@@@B@@BA@@@BA@@@@@@B@@@@C@@BB@@@@BB@A@@BB@ABB@@@@@B@@B@@@@B@@@@@@A@@BB@B@@@@@@A@@@AB@@@@@@A@@@@@A@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@BB@@@@@@@@@@B@@@@@BB@@B@B@@A@@@BBA@@@@@@BC@@@B@@@@@AB@@A@@@@BB@@@@B@@A@@@@@@@ABAB@@@@@@D@@@@@@BA@A@@@A@@B@@ABCB@@AB@@@@BDDB@BB@BB@@BB@@@BA@@@@@@B@BAB@B@@@@@@A@@A@@@A@A@@AB@AAD@@@@C@A@EB@@@@@A@@A@@@@@@@@@A@@A@@A@@BB@@@A@A@@@@@@@BA@@@@@@@A@@@@@A@@A@@@B@AA@@AA@AA@@@@A@@@@A@@@@@@@@AA@@BAA@@@AB@@A@@@@A@@BA@@AA@@@@AA@@A@A@@AA@@A@@@@A@@B@@@A@@@A@@@AAABA@A@@@@@@@@@A@@@@@@@A@@@@A@@@@A@AA@@@@@@BA@@@@AA@@@@@A@A@@@A@@@@BA@@@A@@@A@@@@A@@@@@@@@BA@@@@@@A@@AA@@@@@@@B@B@@A@@A@B@A@@@@@@@@@@@AABA@@@A@@@@@@@AA@@AA@@A@A@AA@A@@@A@@@@@A@@@@A@@@A@@BA@@@@@A@@@@@@A@@@@@A@@@@A@C@@@@@A@A@@@@A@@@AA@@BA@@@@@A@@A@A@A@A@A@A@@@@A@@@@@@@A@@@@@@BA@@@@@@@@B@@@@@@AA@@@@A@@A@A@@@@@@@@@@A@AB@@A@@AA@@A@@@@AA@@@@@ABCBA@@B@@AB@@AB@@A@@B@@A@@@AA@A@@@@@@@B@@@@AA@@@@A@@BAA@A@@@@@A@AAC@@A@@AAB@@A@C@A@@CCCAGCAAEAGCAA@@@@@A@A@ABC@C@AAA@@AACAAA@@A@AAA@@AA@@@@AA@@A@@C@E@@@AACBA@@@@@AB@@BB@@@B@BAB@@@@@BB@@@@B@@@@@@@@A@BB@@@@@@@@@@A@@@A@@@@@@B@@@B@@@@@@@@A@@@@A@@A@A@@BA@@@@B@@B@@@B@@A@@B@@@@BBA@BA@@@@@@@@B@BA@@B@@BB@@AB@B@@AB@BA@@B@@B@ABAB@BAB@@@@A@@@@@@@A@@@@@@B@@A@@@@@@@@@A@@@B@@BB@@@@@@@@@A@BBA@@B@@@A@@@B@@@BA@@@@@A@@@@A@@@@A@@@@@@@@@@@@BA@@B@B@@@@A@@A@BA@@@@@A@@@BAA@@@@@ABBB@@@@B@@@AB@@CB@@BBA@@@@@A@AB@@@AA@@@@@@@B@@A@@BA@@@@A@@@A@@@B@@@BA@@@@A@@@@@ABABABA@@B@@@@@@@@@B@A@BA@@@A@@@@@@@@@@@@BA@B@@A@@@B@@@@@B@@A@@@@@@@@@@B@@A@CD@B@@A@BB@@ADB@BB@@@B@@@B@@@@@@@@B@@@@@BABBB@@B@B@@@@A@@@ABA@@@A@@A@A@@@AA@@B@@@BAB@@AB@AA@@B@@AB@AAB@@@@A@@@@@@B@@A@A@@A@@A@@A@@A@@BA@@B@BA@@@@@@@@BA@A@@@@@@A@@A@@@@A@@ABA@A@AB@@JHB@@@B@@@@@B@B@B@@@@@@@D@@B@@B@@BB@ABB@@B@@@@B@@@A@BB@@@B@@@@@BB@@B@@@@BB@@@@@BB@@@BB@@B@@@@@B@@BB@B@B@@@B@@@@@@@@BB@@B@@@B@@@B@@B@B@@B@A@@BBA@BB@BBBB@@B@B@B@@@@@B@BA@@@B@B@@D@@B@@B@@@@@@B@@B@@@@@B@@ABA@AB@@@@@@@@B@@B@@@@B@@@@@@B@@A@@@B@@@@B@@@@@@AA@@@@@@A@@A@@A@@@@@AA@@@@@@@A@@@@@@AB@@@@@A@@@A@@AB@@@B@@@@@@@@@B@@A@BB@B@@@@B@@A@A@@B@@@@@@B@@@@B@@@@BDB@@@@A@@@@B@@@@@AA@@@@@@@@@BB@@@@B@B@@BB@@@@B@@@@@@@@A@@AA@BBA@B@@@B@@B@@A@@@@@@@@@B@@BB@@@BBB@@@B@@BB@@@AB@@@@BBA@@@@@@@@@@@@@A@BBA@@@@@A@@@@@A@@@@A@@@B@@CAA@@@@AA@CAA@A@C@B@@@@B@@@@@@@@@BB@@BB@@A@@BB@@B@@@@@@B@@@BB@DBFB@D@@A@@@@D@@@BB@@@@BBBB@BB@@AB@@AB@@A@@@A@@@@@@@@B@@@BABA@@A@DA@AB@B@@@B@B@@ABA@A@A@@B@@@@BA@@@@B@@@@B@@@B@@@@@B@BA@@@AA@@@@@@@@C@A@@@@@A@@@A@@BB@@@A@@B@B@@@@@B@@@@A@@BA@@@A@@@@@@@AB@@@B@@@BA@@@@BA@@@@BA@@@@B@@A@@B@@AA@@@BAA@@@@@A@@C@@AA@A@@@AD@B@@@@AB@@@@@@@B@@@@B@@BAB@B@@@BA@@B@BB@@@@@A@ABB@@B@@@BB@@AB@@@@B@@@@A@@@@B@@@@@@@@@B@@@@@@B@@@@@@B@@B@@@@@@@B@@@@@@@@@BB@@@@@B@@AB@B@@@@@@@@@A@@@@@A@@@@@@AB@@@BA@AB@@@@@@@@B@@@B@@@@@@@A@@@B@@B@@@@@@A@@@@@@B@@AD@@@@@@@@@@@@@@@@@CBAA@@@@@@BA@@B@BAD@BA@@BBA@@@@@BAB@@@@FBB@@@@BA@@@C@@@BBB@@@B@@B@@@@A@A@CBA@@@B@@@A@AB@@@@BB@@@@@@@BA@AB@@@@@@@B@@BB@@@@@B@@@@@B@@@@B@@@@BBB@@BB@@@B@@B@@B@B@B@@@@BBBB@@@@BD@@@BABB@@@B@@@B@B@@@@B@@@B@@@@@B@B@BAB@@AB@BAB@@@@@@@B@BBB@@BB@@@@@@@@@B@@@@@B@@BBA@BB@B@@@@BBBB@@@B@@@@B@@D@D@@ABBB@@@B@@A@@B@@@B@@@BA@@@BBA@@B@@BB@B@@@@@BA@@@@B@@@B@@@@A@@B@@@@@BBB@BB@BBBB@B@@@@BBB@B@AB@B@@@@@@BB@@@BBB@@@@@BB@@@@B@@@DA@@BB@@B@@@@@B@@@BB@@@@B@B@B@BB@A@@BA@@B@@@@@B@@BB@@@B@@@@@B@B@@@@ABB@@@BB@@@@BB@@BB@@B@B@@@@@BB@@@B@@@@@BBB@BB@@BBBBA@@@@B@DB@@@@B@BB@@@@B@@@@@@@@BDB@@@B@B@@@@@B@@@@@BB@@@@@BBABB@@B@BB@B@@BB@BB@@D@@@@BB@@@B@@BB@@@B@@@@@@B@B@@@B@@@BBBB@@@@B@@BD@@@@@B@@@B@@@B@@@B@BA@@BAB@@AB@@@D@BBB@@@B@B@@@@AB@B@@BBB@@@@@AB@B@B@@@@@BB@@@BB@@B@@@@@B@@@B@@@B@@BB@@BA@BBA@@B@@@B@B@@@@B@@@@B@@@@@B@@ADB@@@AB@BBBB@B@@BB@@@B@@@DBBB@@B@@@@B@@@BB@B@@B@@@@B@@@BBBA@BB@@@@BAB@@BB@@@BAB@@BB@B@@@@@B@@@@@BA@@@@@@@@BA@@B@@@@BB@@@@A@@BA@@@@BB@@@AB@@@@@B@@B@@BDABB@@@BB@BB@@@BB@@B@@BB@AD@B@B@B@B@B@@@@@@@BA@@BAB@BA@A@@B@@AD@BA@@B@B@D@@@B@B@BA@@@@D@DA@@B@@@B@@@@@DB@@B@BBB@@B@@@@@@@@BBB@BA@ADABAB@B@@A@@AA@@@AB@@@@A@@BA@@@ABA@AB@@@@B@@BB@@AB@@B@@BB@@BBA@@B@F@@@@@BA@@DDB@@BBCBAFA@@@B@@BBDB@BDAB@@@DB@@DA@@@A@@AA@A@@AA@A@@@AB@@@@AAAAAAA@AAA@@A@@A@@@A@@B@BABA@@B@BA@@@AB@@CA@@@A@@@AAAA@@@@@@@A@ABA@@@C@A@A@AAA@@@ABA@@@AA@@@@C@A@A@@@@BA@@BA@AB@@@@@BBB@B@B@B@B@B@BBB@BB@B@@DB@B@@@@BB@@B@@@@A@A@@@AAA@@@@@A@@@A@A@A@@@@@AAA@AAA@@@A@@@AAAAA@@@AA@@A@@@@BA@@@AB@@ABA@@BA@@B@BA@A@@@@BA@A@@BAB@B@@@BA@@@A@@BA@ABA@@@@B@BA@@@@@A@AA@@A@A@@AA@AB@@@@A@@@A@@@A@A@@@@BA@A@A@@AA@@@ABA@A@@@ABA@@@AD@B@BABA@@BA@ABA@ABA@@@ABA@A@A@A@A@@@@D@B@B@@A@@@@@@@@@A@@@@@A@@@@@@@@@@B@@@BB@B@B@@@@@@@@@@@AB@@AB@BA@@@@@@@BBA@@@A@@@@B@@@@@BA@@@AB@@@B@@@ABCB@B@@@@ABA@@@@BB@@@@AB@@@@AB@BA@@@@@@B@@@B@BA@@@@@@B@@@@@BA@A@@@@A@@@@@@@@@D@@@@A@@@@@@@@@@A@@@@@@@@A@A@@@@A@@@@@BAB@@A@@@@DA@@@A@@@@@A@@@@@@@@@A@@@@BB@@B@@@B@@@@@B@@A@@@@@@@@B@@A@@B@@@@AB@@@@A@@A@@@@@A@@@@@@@@A@@@@ABA@@@A@@@@@@@B@@AB@@@@AA@@@@@@A@@@@@@@@B@@AB@BA@@@@A@@A@@@@@@@@@@@A@@B@@@@BBA@@AAA@A@@@A@A@@A@@@@@A@@@@@AA@@@@@@A@@AA@@B@@@B@@A@A@@@@@@@@@@@@@@@A@@B@@@B@@A@@@@@@@@@@@@@A@A@@@@@A@AA@@BA@@AA@@@@A@@@@@A@AA@@@@A@@@@AA@@A@@@@@@@B@@@@@@@@@@@B@@@@@B@@@@ABA@@@@@A@@@@@AA@@A@@@@A@@@@@B@@@@@BA@@@@@A@@B@@@AB@@@@BB@@@@@AB@@@@@@BB@@@@A@@@A@@B@@@@@B@@A@@@@@A@@@@@AB@@B@@@B@B@@@@@AB@@B@@@@BB@A@@@@B@@A@@B@@@B@@@B@@A@@@B@AB@@@@@B@@@@@B@@@@A@@B@@AB@@@@A@@AA@@A@A@A@@@@BAA@@@A@@A@A@@@AAC@A@A@AA@@A@A@@@@@AAA@AAA@@@AA@@A@@@@A@A@@@@@A@@@AA@@@@AA@@@A@@@@@@AA@@@A@@A@AB@BA@A@@@AA@@@A@@@BA@@BBB@@AB@@@@A@@@A@A@@@@AAA@@A@@A@A@AAAA@@@A@@@@@@@@@@B@@@@@B@@@@@B@B@B@@A@A@@@A@A@@@AD@@@DABA@@BC@@@AB@@AAAA@CA@@@A@ADE@A@AAAA@DCBA@AB@DABAB@@@DBB@DF@@BBB@@@B@BB@@BBB@BBBBFBDDBBD@BB@@B@F@DB@AD@FCFA@AAA@A@C@C@@AA@@@A@AAAAAAA@@A@AAA@@@AA@AAAIGCAA@CC]Q@@D@NA@E@@R@BA@@D@BC@@BA@@@AB@B@@A@@JHBBDDFDDB@@@@@@@ABCBABABA@ABCBC@A@AA@@AAAAA@@@@@AAAAAA@AACAAABABA@A@@@A@AD@BAAC@APB@BB@@BB@@@@@@BB@B@@@@BBA@@@B@@@@B@@CL@dB@@B@@A@@@C@@@ES@@IO@BA@@@@AA@@@AA@@AA@@@@@@AB@@@@@BBA@@@B@@@@BB@B@@@@@@@B@@@@@@A@@@@@AB@@@B@@C@@B@@@B@@A@@@@@A@@@@A@@B@A@@A@A@@@@A@@B@@A@@@@@B@B@@B@@@@AA@@A@A@AA@@@@AA@@@A@@A@@@A@@A@AB@@@@BA@@@@@@A@@@@@AB@@@@@A@@@@A@@@AAA@A@A@A@@@@@AB@@AB@@A@@@@@@@@@@@ACB@@A@A@@@A@@B@@AA@A@@@A@@A@C@@@@A@@@@AAA@@@A@@@A@@@AB@B@AABAB@@AA@@@A@@@@@B@@@@AA@BA@@@AB@@@@BB@@@@BB@@@AC@@BB@BD@BAAA@@A@A@@@A@@AB@@@BAB@@AA@@@@@A@@@@B@@A@@@@B@@@A@@AB@@AB@@@AB@@A@@DA@@@@BA@@AAA@B@@AB@@@@@B@@BB@@@@B@@B@@A@@AA@C@@B@@@@A@@@AA@@A@@@@A@@A@A@B@@A@@BA@@@@AB@AA@@@@AA@@@A@@@A@@B@@A@@@@@A@@@@@@@@BC@@@AB@@A@@@@@A@@@A@@AC@AB@@@@@BA@@@@@B@BB@@@BA@@B@B@@@@@@AA@@@@@@@B@@A@@@@@@@A@@@@@BB@@@@A@@@@AA@@@AB@@@@A@@@A@A@@ACA@@AA@@@@@DA@@@A@@@A@@@@A@@@@@B@@@@A@@@@@@@@A@@@A@@@BB@@@@A@@@@@@@@A@@A@@@@@B@@@@@@@@@AA@@@A@@@@@AA@@@@AA@@@A@@AA@C@@BA@A@@AA@@@@BABA@@@@A@@@BAA@@AB@@@@@@A@@@@@A@@@@@@@@A@@@@@@A@@B@@A@@@@@AA@@AB@@A@A@@A@@AB@@@AA@A@@@AA@@@AA@@@@@@@AAA@@BA@@@@@A@@@@@@@A@A@@BA@@@ABA@@@AB@@A@@@@AA@@@@@A@@B@@AB@@@BA@@B@@@B@@A@@@@CBA@@AC@ACCAC@A@A@AA@@AA@@A@@AAA@A@C@A@@@CAA@AB"],encodeOffsets:[[121743,29516]]}},{type:"Feature",id:"330822",properties:{name:"常山县",cp:[118.511235,28.901462],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@@@@A@A@AA@AB@@@@@@A@AAA@AAA@@BA@@D@@@BBBB@@@@BA@@B@B@B@B@@CD@@@@BB@@@@@@B@@@@@B@BB@@@BB@@@@@@@@@@@@BABA@A@ADAB@@AB@BABA@@@A@@@@@AB@@@D@@AD@@@@@@@BA@@@@B@@B@@@@B@@B@B@@@@@@BA@@@A@@BAB@@A@@B@@@B@B@@AB@@ABABA@@@A@@@@AA@@@@B@@@BA@@@@@E@A@@@A@AD@@A@A@@@@@A@@@@@A@@@@AG@@@@@E@E@EBA@A@A@CAE@CBABCBCDA@@@EB@@A@@@ABABA@A@@@G@E@CBEB@@ADAB@@A@@@C@@@@AA@@@@@@D@B@@@@@BAB@@ABABCB@@@B@@@B@@B@DBBB@@BBDBDB@@@@@B@@CB@B@@@@B@@BA@@@@BA@A@CDAB@B@B@D@BABADAF@DA@ADA@ABC@@@A@@BBFAFAHADCDAD@B@H@DAHABCFEB@B@DAD@F@DADAF@BCD@D@D@@ABCBA@E@AB@@@@A@AA@@CDCD@@A@BB@BBD@BB@@B@@@@B@@BBB@BB@B@@@@@B@@@@A@@BAB@BA@@@AB@B@@@B@@A@AB@@@B@@@AA@@BAB@@@@BB@@B@@B@@@B@B@B@@@@@B@@@BBB@BB@@B@@@B@B@@@B@@ABA@@BA@@BA@@@A@@BAB@@@B@@@@BB@@B@@B@@@@@@B@@B@B@@@AB@@B@B@DA@A@@@A@@B@B@B@@@B@B@@@DB@B@@B@@@BBB@@@@ADAB@BA@@B@@@@@D@D@@BB@@@BA@@BBB@B@@BB@@B@@B@@@B@@@B@B@@@BB@@B@@B@@@@@@BB@B@@@@B@B@B@BA@@B@@@D@@@B@@AB@@@BA@@@@B@B@@@BBB@@B@BB@@@BB@@@@@B@@BBB@@@@@@BB@@@B@B@BAB@@@B@@@BA@@B@@@@BDB@B@BB@@@@BB@@BB@@B@B@@B@@@@@@BA@@@@@ABAB@@@@ABCB@@@BABA@ABBBBB@@@B@@BD@BBBABB@@BBB@@@BB@@B@@@BB@@B@@@BBB@@DBBB@@AB@@BB@BB@B@B@@@@BB@B@@@BB@@BBB@@@@@B@BB@@B@@@BB@@@B@@@@@BB@B@BBB@@@B@@@BBB@B@@@B@B@@@@@@B@B@@@B@@B@B@D@B@JB@@@BB@@BB@BB@@B@B@@@DAB@@@B@BBDA@@B@B@@@B@B@BB@@BA@@BABA@@@AB@@@@ABA@@@A@@@@BA@@B@@ABABA@@B@@@BA@@B@@B@@B@B@@B@@B@D@BBB@@AB@@BBA@@@@@@BBB@@@B@@BBB@@@B@BA@@BBBBB@B@B@@@@@B@@@@@B@@@@DD@@B@@@B@B@BB@@@@B@@B@@@BBBBBBB@@@BB@@@@@@B@@B@@B@@B@@@@@@B@@@BB@@BA@@BA@ABAB@B@@@@@B@@A@@B@@BB@B@@BB@@BD@@B@BB@@@BB@BB@@@BB@@@@B@B@@C@@@AB@@@@BB@B@B@@@@@BA@@B@@@BB@@@@B@@@@@B@BB@@B@@@B@@@BB@@B@BB@BBB@BB@@B@@B@@B@@@@B@@@@B@@@@@B@@@@@B@@@@@@A@A@@@@@A@@@@@AA@DA@@@@B@B@D@B@@@B@B@B@BB@@B@@@BBB@B@@@BB@@BB@@BAB@@@BBB@@B@@B@@@@@B@@@@@B@@@@B@@@@@B@@@B@B@@B@B@B@D@DB@B@@@BB@@@@@B@@@@B@@@B@@@B@B@B@@BB@@BB@B@@@BBB@@@@@B@@B@@@@B@@B@BB@@@B@@B@B@@@@@D@B@B@@BBB@@B@@@BB@@@BBB@@B@@BBBB@@@B@@BB@BD@@B@A@@BB@@@@B@@BB@@B@BBB@@@@@@@@BBB@@@BBB@@B@@@@BB@B@@BB@B@@@@BB@B@B@@B@@@BB@@@@BB@@@AB@@@@B@AB@B@B@@@@@B@@@@@@@@@@B@B@B@A@@B@@@B@B@B@@@@@@BA@@@@@@@@B@@@@@@@@@B@@@ABB@@AB@@@@B@@B@@B@@@BB@@@@@BB@@@@BB@@@A@@@@B@@A@@@@B@B@@@BB@@B@@@@B@@@@BBB@@@B@@@B@@B@@BB@@@@BB@@B@@@DB@@@BBB@@B@B@@BB@@@@B@@@B@@B@B@@@BB@@B@@@@@BB@B@@B@@B@@D@@@BBD@@B@@BA@BB@@@BB@@DB@@B@@@@B@@@B@BB@@BB@@@@B@BB@@BDB@BB@@@@BB@@B@@@@@B@@@BB@@@@BAB@B@B@BAB@BAB@@@@AB@@@@@@@B@@BB@@@@@B@B@B@@@BBB@@@B@@B@@@BBB@@B@BB@@@BB@@@@@B@BB@@B@@@@BB@@@BB@@BB@@@B@@@BB@@B@BAB@@@B@@@B@BB@@@@@BB@@BB@@@BBBB@@@DB@@@BBB@@@BBB@@BB@@@@B@BB@@BBBB@@B@@@AB@@@B@@@B@@B@B@@DB@@@@@BB@@BB@@@BBB@@@@BB@@B@@@BB@@BBB@@@@A@@B@@C@@BA@@@A@@B@@BDB@B@B@@BBBB@@BB@@BAB@@BB@@AD@B@BA@@D@BB@B@@BFBB@BBB@B@B@B@B@DAB@BB@@@@B@@@B@B@DBB@B@@@@@B@@BF@@@BBB@@@B@@@D@@@D@BBB@BBB@@AB@@BB@D@B@BB@@B@B@BBB@BBB@@@B@B@B@B@@@DA@BB@B@B@BBB@B@@@BB@@@@D@@@BA@@B@@AB@@BB@@@@A@@B@@A@@BA@@@@B@@ABAB@BA@@B@BAB@@@B@BABAB@DCB@B@B@BAB@BABABABA@@B@@@@@@B@@BBB@@BBB@@@@@@BBB@B@ABB@@@@BB@@@BB@B@B@@@B@B@@@BB@@@B@B@B@B@B@@@B@@@B@B@B@B@@B@BBB@B@@@B@B@B@@B@AB@BB@@@@@D@BB@@B@@@@BDBDB@BBBB@B@@@B@DB@AB@B@@@B@@@B@@@B@BB@A@BBA@@B@@@@AB@@A@@B@@@@AB@@A@@A@@A@@@A@AA@@A@A@@@AB@DA@A@@BA@@BA@AB@@@AA@@BA@@@A@A@@BA@@BC@AB@@@AA@@@AA@@A@@AAA@@A@@AACB@AA@@@@A@@@@BA@@A@@A@@B@@AB@@@@@AA@@@@@AB@@A@@@@@@B@@A@@@@@A@@@@@AAA@@BA@A@@AA@@BA@A@@A@@AABAA@@A@@@@@@AA@A@@A@@@A@@A@@@AACA@@A@@@A@@AA@A@AA@ABA@@A@BC@@@A@@@@@A@@A@@@@@@A@A@@@AB@AAB@@AA@@AA@@@A@@@A@@@@@A@@@AA@@A@@A@@@@@A@ABA@@@@A@AA@@@ABA@@@@@A@A@@AA@A@C@@BA@@BA@AB@@A@A@@@A@@@A@@@A@@@@AC@@@A@@A@AA@A@@@A@@@A@A@@@@A@@@A@@AA@@@A@@A@@C@@@AAA@@AA@A@@A@AA@BAAA@@@@A@@A@@@@@A@@@@@A@A@@CA@A@@@@@@A@@@@@AAA@@@@@CAA@@@@@ABAA@AA@@AAA@A@@@@@A@@AA@@@@A@A@@@AA@@AA@@@@AA@@A@BA@@@@@A@A@@@@@A@@AA@@@A@@@@@AB@@AB@A@@A@A@A@A@@A@@A@@@A@@@@@AA@@AB@@C@@@A@@A@@A@@@@AA@A@@AA@@@@@@@ABAA@A@AA@@@@@AAAAAA@@AAA@A@@@@@AB@@@@@@A@@@A@@B@@A@@@@@AAA@@@AB@AA@@B@@A@@@A@@@AB@@@@A@@AABA@@@C@CA@@@@@@A@@AAAA@@@@@AAAB@AA@@@A@@@@@A@@@@@@@@AA@@AA@A@A@@@@@@BA@ABA@@BA@A@A@A@@@@@A@@@A@@A@A@@@A@@@A@BA@A@@AC@@@@AAAA@@@@@A@A@AA@@@@A@@AA@@AA@A@@A@@@@@@A@@@@@A@@@@AA@@@A@@@@@@BAB@@A@@@@@@AA@@@@BAB@@@A@@@B@DAB@B@@@@@@AA@@@A@AA@@D@@@B@@A@@A@EA@@@@BA@A@@@@AB@AB@@ABC@A@AB@@A@@@@B@AB@D@@@@@@@@@@@@@@@@BC@@@A@@@@B@@@@@@@@AA@@@B@@@@@@@A@@@A@@@@@@@@@BAB@@A@@BA@@@@@@@B@@@@@B@@@@@@@@@ABA@@@A@@@@AA@@@@@@@@A@@@@@@@A@@@@A@@@@A@@@@@@@@A@@@@@@@@@A@@B@@@@@@A@@A@@BA@@A@@@AA@BAB@@@@@A@@A@AB@@A@@@ABA@AA@@@@@@A@@@@@@BA@@@@@ABC@@B@B@@BD@@@@B@@@@BB@A@@BB@@@AB@@@@A@@B@@A@@B@@A@@B@@A@@@A@@BA@@@@@@B@@@B@@AB@@@@@@A@@@@@A@AB@@@A@@AB@@@B@@@@@B@D@@@@@@@@@BB@@B@@A@A@@@@@A@@@A@@A@@@@@AB@@@@@AB@B@B@BA@@@A@A@@@ABAB@@C@BB@BA@A@@@A@@@@@@B@@@B@@@BA@@BA@@AAA@AA@A@@A@@A@@@C@@B@@@@CEACAA@@A@@@A@@@@A@@@AA@@@BA@@AA@@A@@@@@@@@@A@@A@D@B@B@DBB@@B@@B@DB@@@A@@@B@@B@@@@@B@@@@@B@AAB@@@@@@@@@@@@@B@AA@@@@BA@@A@@AA@@@A@AA@@A@@AA@@@@@@@@@B@@@@AA@@@A@B@AAB@@BB@@@@@@@@@@A@@A@@AA@A@@@@@AA@@@@@@@@B@@B@@@@@A@@B@@@@@CA@A@@A@@@@@@A@@@@A@@@@B@BA@@@@@@AAAB@@@@A@@@@@@@@@A@@BA@@@B@@@B@@@@BA@@@@@@@B@@@@@@BB@@@@B@@@@BB@@@@@@@BB@@@@@@@A@@A@@@B@@@@A@@@@A@@@@@@AA@@@@@@@@@BAB@BA@@@A@@@@@AA@@@@@@@@AA@@@@CA@A@@@B@@A@A@@@@@A@A@AA@AA@AAAB@AA@@@B@AA@A@@@@A@@@A@@@AA@@A@@@@@@A@@@A@A@A@@AA@@@@@A@@@AA@@A@@A@@@@AA@@@@@AA@@A@@@@@A@@AAB@@@A@@@@@@AA@BAA@@AA@@@@AC@@@@@@@A@A@A@@@@@A@@@A@IG@@AA@AA@AA@@AAA@@ACA@@@@@A@@@AAAAA@@@A@@B@B@@A@@@A@AAA@CA@@BA@@A@@A@@@AAA@@@AAC@A@A@AAC@AAA@A@@@A@C@A@@@A@AA@@A@A@@@AAA@@@@@A@AA@@A@@@@@@@@@AAA@@@@A@A@@@AA@@@A@AB@@A@@@@AA@A@@@@@A@@@@AA@A@A@@@A@@@A@@@A@@@@A@@A@@B@@@@A@@@@@AB@@@@@BAACB@@AB@@@BA@ABEB@@@@A@@A@@A@@@AB@@@BA@@@AB@BA@@@A@@@A@@@A@@@A@@A@@A@@A@@@@@@EA@@@@@A@@@A@@@A@@@ABABAB@@CBAB@@A@AAA@@B@@AB@@@@@@A@AAA@@@AA@@@@AA@@@@@@@A@@@@@CC@@@@B@@A@@E@AA@@AA@ACA@@@@BAAA@@A@@@@@A@A@@@@@A@A@@@@AAB@AABA@A@ABA@@BABAA@@@A@@@@@@AAABA@AB@AA@C@ABA@CB@@@@@@A@@B@@A@ABAAA@ABA@@@@@@B@@CBA@@@@BA@@@C@A@AA@@@@AABA@ABAA@@@@@A@AC@@AA@A@@A@@@@A@@A@A@A@A@ABA@@@A@A@@A@@@AC@A@@@A@@@@@@AA@AACA@@@B@@AB@B@@@@AB@@A@ABA@ABA@A@@@AAAA@@A@A@@AA@A@@@@B@@@@ABA@@@@A@@BA@AAA@AB@@A@@AA@@BEA@@@AA@AAA@AA@@@AA@@AAA@@@ABA@@BCB@@AB@@@B@B@B@BABAB@BCD@@AA@@A@@@@A@@AAC@@@CB@@AAA@@@@B@@@@@B@@@@@A@@@@ABAAA@@@A@@A@AA@C@A@C@@@A@@@AC@@@@@@@A@@@ABA@AAAB@BABA@@@A@A@@BA@@@@AA@@A@@A@@@@@A@@@@AAA@@@@@A@@@@A@A@AA@@@@ABA@A@@C@@ACA@@B@@A@@AA@@@@@A@@ABA@@@@AB@@AB@@@@AAA@@A@@AA@CAAA@@@@@ABA@@AA@@@@@A@@@@@@@@@@@AAA@AA@A@@AB@BAA@@A@AA@A@@AA@@A@@@A@AA@A@@A@@@@@A@@AAA@@A@@AA@@@@AA@@@A@@A@@AA@@@BA@A@@@@@@@C@A@@@@@@@A@@@@@@@A@@BA@A@@BAABA@@@A@@@AB@@@@@A@@@A@@A@@@A@@@A@@@AB@@AA@@@@@@@@@B@@@@A@@@@@A@@@CB@@A@@BABA@@@@BABB@@DBBB@@B@@@@@BA@@@@@@@@BAB@BB@@B@B@@AB@@CA@@@@A@@AB@B@@@@AA@A@@@A@@@A@@@A@A@@@A@@B@BA@@@A@A@@BABAB@@A@BB@@@@B@@BA@@@A@AA@@@@A@@@A@@@A@@BA@@@A@AB@@@@@B@@A@@@A@C@A@CBA@A@AA@@AAA@@@A@AA@@AA@@@@AA@@A@ABA@ABA@@B@@@@@BA@BBA@B@@@AB@B@@@@@B@B@@@@A@@@A@@@@B@@@@@@A@@@@AAB@@AA@@B@A@A@AA@@@@C@@@C@@@A@@@@B@@ABAAA@@@@@@@AA@@AAA@@@AB@@@@A@ABA@A@@@@@@@@@@@@AA@@A@A@@A@@@@@@@B@@AA@@A@@@@@AB@@@AA@@@@AA@@BA@@@A@@@@@@A@@@@@@@@@BA@@A@@@@@@@@@@A@@@@BA@@@@@@@@@B@@@B@AB@@A@@A@@A@@@@AB@@AA@C@@@@@AA@@A@@@@@@@B@@A@@@@@A@@@@@@A@@@@AAA@@@@B"],encodeOffsets:[[121230,29468]]}},{type:"Feature",id:"330824",properties:{name:"开化县",cp:[118.415495,29.137336],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAA@A@@@A@A@@@CBAAA@@@A@CB@@A@A@@@AAA@@AA@@A@@IAA@C@A@A@@@@A@@@A@A@@@@A@A@@@A@A@AA@@A@@@A@AAA@A@@A@@@@@A@@AA@@A@@@AAA@@@@@A@AA@@AA@@A@A@@A@@A@A@A@@AAA@@BA@@AACA@@AA@A@@@AA@@A@@@AA@@A@@AA@AA@BAAA@AAC@@@A@@AAAABAB@BA@A@@DABA@@@@BABA@@@@B@@A@@@@A@@@@A@AA@@AA@@A@@A@@A@ACA@A@@A@@@AB@@A@@@A@ABA@A@@@A@@A@@@@A@AA@@@A@@@@AA@@A@@A@AA@AA@@A@A@@@@@AB@@A@@BA@@@C@@@A@@@ABA@A@A@@@@@@AAA@@@@@@@AA@@@AA@@A@A@@@A@@@A@@@@AA@@AA@A@AA@@AB@@A@@AC@C@@@@@A@@@ABA@CB@B@@A@AA@@A@@@@ACA@@A@A@@@A@A@A@@@@B@@@BCBA@A@@@BA@@A@A@@@@A@@@@A@@@@AA@@A@@A@@@A@AB@@@B@@AB@@AB@@AB@BA@@@A@A@@@A@@@AAA@AA@@A@@@@@A@A@A@@@A@@@@AA@@A@@A@AB@@BB@@A@@@A@@B@BA@@@A@A@@B@@ABA@AB@@@B@@A@@@@@A@A@@AAA@AA@@@@@@AA@@AAC@AAAB@A@A@A@@@AAACA@AAC@CBE@A@CAEAGCAAC@CAAACAAAE@A@CACC@@ICEAA@@AE@GAAAA@GBA@AF@D@F@BBBDDBBFDB@B@HBBBDFDFHHBBFDBBDBDD@B@BBBBBDD@@@@@B@@AB@@A@@@@B@@@BA@@BA@CBC@@@A@EAA@@@EBAB@@@BAB@B@B@@@BA@A@A@CAAA@@A@EDA@@@@@A@@B@D@DADABAFABADEDABADCF@BC@A@A@@BA@CF@@A@A@AAA@A@@@A@AB@@AD@@@@AB@@A@@@@@@@AB@@A@AB@@@@@@A@A@@@@BA@@@AAAA@@A@@@ABA@@@@@AAAA@@A@@@A@@@@BBB@@@@A@@@A@@B@@@@@@A@AAC@C@A@@AA@@@@A@@@@@A@@@@@ABC@C@AA@A@@@@@@D@BA@@@C@@@A@AB@@A@CBCB@@@BA@A@A@@@CFADEHCD@B@BBBB@@@@@@@@@@@B@@@@B@@@@B@@@@B@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@B@@@@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@BB@@@@@@@@B@@@@@@@@@@B@@@@@@@@B@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@A@@@@B@@@@@@@@@@AB@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@A@@@@@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@A@@@@@@A@@@@@@@@@@@@@AA@@@@@@@@A@@@@@@@@A@@@@A@@@@@@@@@@@@AA@@@@@@@@AA@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@A@@@@@@@@@@@@A@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@A@@@@@@@@@@@A@@A@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@AB@@@@@@@@A@@@@@@@@@@AA@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@A@@@@@@AE@C@A@@C@C@A@AA@@A@A@C@@@DDBB@B@@BB@BBB@@@@@@@@B@@@@@@@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@B@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@@@@@B@@@@A@@@@@@@@B@@@@@@A@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@B@@@@B@@A@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@@@B@@A@@@@@@@@@@@@@@@AA@@@@@@@@A@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@A@@A@@@@@@@@@@@@A@@@@A@@A@@@@@@@@@@@@@@@A@@@@@@A@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@AA@@@@@@A@@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@@A@@@@@@A@@@@@@A@@@@@@@@ABCDADAFAFAB@D@D@H@D@D@DABAF@DEBAB@@@@ADCDCBCBC@CBC@@B@D@DB@AB@BC@CDAB@@AD@DBB@BB@BBDDDDBBBBBB@@BB@@@B@@ADAB@B@BBB@F@B@BCDCBABABCBG@ABC@ABABEDCBCBC@EBC@ADABABCB@B@@A@CF@D@B@DBBBDDFBB@BB@DBF@B@DDB@BB@@@B@DCBEFC@EDCDABCB@B@B@@DHBDBB@BBD@F@D@D@B@BBBBB@@AB@DABBB@D@DCDABCBA@CBABCBC@ABA@ABADBBBDBBBDBDBB@DBF@D@DB@@FBD@B@D@BBB@BD@BB@@@D@@@@@B@B@B@@@@@B@BBB@DBD@BBBB@@DFDDDB@B@B@BADAB@DADBD@D@B@FBD@B@D@BB@B@DBBBDBB@BBDBBBFBBBBBBAB@BA@AFBD@BBBABA@CBABAB@B@BBF@DBF@F@B@BBDBBBB@BABABA@@B@D@D@FBBAD@BAFA@@@CDABAB@B@BBBDBD@D@D@DAFAFAD@LCD@BAD@D@D@DBBBBB@BFDBDBBB@B@D@BABEBABAD@FAJAFADAB@D@@BFBDB@@BBD@BAD@B@D@DBDBDDD@BDDBDBBDD@BBD@D@D@B@DB@B@BAFAFABCDCDEBABAB@B@@D@FBDBDDDBBBDBBDDD@B@D@D@@@@@DDB@@DDD@@BBBBBDBBBDBD@D@DBDBDBBBBB@B@BBB@FBF@D@BAFCFADABABBBBDBDBB@BBD@BCDCBEDABGFABCB@BAB@@AD@DBBD@DCD@D@B@BBDBDH@DBBBBFJ@BDDDD@B@D@DBDDBDBDBDB@@BBD@@@B@D@F@F@DDDBDBHDBBD@B@DABCDABAB@F@DBH@FBDAB@@@DABABABA@@D@BBDBD@BBF@D@BCBC@CB@BA@@D@F@D@BBDBB@D@B@FAHBD@BB@B@B@D@@@FAF@D@B@H@F@HBD@B@D@D@DABADAD@DAF@DADABAD@DAF@BCD@DBB@B@D@BAF@@@D@BBBBDFBB@D@D@D@D@FBD@D@D@BCBABEBCBADCBCDCDAB@DA@@BA@A@CB@@A@@@@BA@@@A@AAA@A@@@ABC@@BA@@@AAA@@AA@@@A@@@A@@B@BAB@BAB@@@BA@@BA@@@A@@@AB@@A@@@A@@@A@@@@BAB@B@@@B@@@D@B@@@B@BA@@B@@@D@@@@@@AB@@A@@B@@A@ABA@@B@@@B@B@@C@ABAA@BA@@@CB@BA@@B@@A@@@@BA@@BC@@@@BAD@B@BA@B@@B@B@B@@@@@@@@AB@@A@@BA@@@ABA@@@@@ABA@@BA@@@AB@@AB@B@B@@@@@B@BA@BBA@BB@@BB@@@@@B@A@@@B@@BB@@AB@B@B@B@B@@@BAB@B@BABAB@BAB@@ABBBA@@@A@@@@@A@@A@@@@@@@@AA@@@A@@AAC@@@@@@@@@@@A@@C@@A@A@@A@@A@@@AA@@@@A@@A@@A@@@@B@@AB@A@@@@A@@BABAB@B@D@@@@@BB@@B@@@B@@@B@@C@@BA@@@@@A@@A@@A@@@@AA@@@A@AA@@A@@@A@@@@@AB@@@B@A@@D@@@@@B@BB@@@@@B@@@AA@@@@@AA@@A@@BA@A@@@@B@@@@@@B@@@BB@@@@@@@@@@@@A@@@@BA@@@@@@@@@@@@@@B@@@@@A@@@@@@@AAAB@AA@@@B@BA@AB@B@BBB@@@@ABA@@BA@AB@BA@@@@B@@@@ABA@@B@@AB@@ABAB@@@B@@@B@@AB@@@@AB@BA@@DAB@BA@@BBB@@@BBB@BA@@@@AAB@B@B@@AB@FA@@B@D@BC@@B@@@@A@@@@@A@@@@@AA@@A@@B@BA@@@ABA@ABAB@BABA@@@B@A@@@ABA@@@@BBB@@@@@B@@@BBB@@@B@@@B@@ADB@@@@@@BBB@@BB@@@@BB@BB@@BB@@B@B@@@B@@@@BB@@B@BB@@@BABB@@B@B@@@B@@A@@@@B@B@@@@@BC@@BABA@C@@@@BA@@@@B@B@@@B@@@D@@@B@@@@BBB@@BB@@@AB@@AB@B@B@@@BA@A@@@@B@@B@@B@@B@B@@@@B@@@AB@@@@D@BB@@BA@@BA@@@B@@@@BBB@@@B@@@BBB@@B@@B@@@BA@@BA@@@ABAA@@@@A@@@@@@B@@@B@B@@@B@@@B@AC@@@A@@@AA@AA@@@ABA@A@@A@@AA@@@@ABA@@B@@@@@B@B@BA@ABA@@@@@A@@@A@@B@BA@@B@@@@@@@B@@@BA@@@A@@@AB@BA@@@BB@B@@A@@DBBB@@B@B@@AB@AA@@@@@ABA@AA@B@AABA@@B@@BB@@@@@B@@@F@@@B@@@@BB@@@@@@@@@@@B@@@@@@B@@BBBB@@B@B@@@B@@@B@@@BA@ABA@@B@@@@@@@B@@@B@@@@@@B@@@@B@@@@@@B@@B@@@@@B@@@@@B@@@@A@@@@BAB@@BB@B@@@@@B@BAB@@@B@@ABA@@@AB@@@B@@AB@@AB@@A@@B@BA@@BB@@@@BB@@@B@@DABAB@@@@@B@@A@@@@@A@@@@@@@A@@A@@@@@@@BA@A@@@@B@@@BBB@BB@@BB@A@@BB@@B@@AB@B@@@D@@@BA@@DC@AB@@@B@@@@A@@@A@@@A@@@@BA@@AA@A@@@@@A@AAA@AB@D@@ABA@@BB@@@A@@B@@@@@@@BABA@CB@BAB@@ABAB@B@@@@@B@@A@@@@@A@@@A@@@@@A@@@AAA@CAAAA@@@@B@B@BABEBADADA@@@@@@@A@A@@@AB@@A@@BAD@@@@@B@@A@@A@@@@B@AA@@@@ABABA@@B@@AB@@A@@@@BABA@A@@@AB@@A@ABA@A@@BA@A@@@C@@B@BA@A@A@@@ABA@@ACA@@@ABABADA@@@CB@@A@A@CA@@ABA@@@@BABA@ADA@@@AB@@@B@@@BAB@@@B@B@BAB@@@@ABA@ABA@@@@B@@AAA@@@AA@@@ABA@AAA@@@A@@@C@@@AA@AAA@@AA@@ABA@AB@@ABA@@@A@@BA@A@@@@@ABA@A@@BA@A@A@@BA@@BCBA@@@A@@@A@A@@@@@AAA@AA@@AA@@@@A@@@AA@AAA@AA@@@AA@@@@A@@@@BA@@B@@ABCB@@@BA@A@@@ABABA@@BA@@@@@A@@@A@@B@BA@A@@@A@@BABA@@@ABCBA@@B@@@B@@BB@B@@@@@B@@BB@D@B@@@B@@AB@@@BA@@AA@@@A@@@A@@@A@A@@@AB@@A@@@AB@@A@A@@AA@@@A@A@A@@@@@A@@@AA@@AA@AA@@@@AA@@A@@A@@AA@@@BA@BA@A@@@@BA@@B@@A@@@@AABA@@A@AAA@EA@AA@A@@A@CB@@A@ABC@@AA@@BA@AA@@AA@AA@AA@A@A@AC@@@AB@@@B@@AD@@@@AB@@@@@AA@AA@@A@@@AA@@A@@A@AA@@A@@AA@@A@@@@CA@@@A@AA@@@A@@@A@@B@@@AA@AA@AA@@A@A@@A@@AA@AA@@A@AA@@A@@C@@AAAA@@A@@AA@@A@@@@AAA@@@A@@@A@ABA@@@AA@@A@@@A@@AA@@A@@AA@@@@@AA@@A@A@@@@AA@@A@@A@AA@AA@@A@@@@A@@AA@A@@@A@A@A@@@@AA@@@A@@@@@@BA@@@@BA@ABA@A@A@ABA@A@@A@@A@@@A@@@@@AA@@A@@A@@ACA@AA@@A@A@@A@@AA@@A@A@@@A@@A@@@CA@@AA@@A@@AAB@@@AC@AA@@C@@@@AA@@@@AAA@@@@A@@@AA@@A@A@@@@A@@@A@@A@@AA@A@@@AA@A@@CA@@A@@@AA@@@@AA@@@AA@@@A@@@A@AA@@@@@A@@A@@@AA@@A@A@@@@@@BA@@@@@@B@@AA@@@@AA@@@@A@@A@@@AA@@@@A@@A@@BA@BA@@A@@@@@@@@@A@@@@@@@@@AB@@@@@@@A@A@A@@@AB@A@A@A@@@@@@@@@@@@A@@@@@A@ABAA@@@@@BA@@A@@A@@A@@A@@@AA@A@A@@A@@A@A@@AA@A@@A@@A@@@AA@A@@AA@A@@@@@@A@AAA@@@AA@@@A@@A@@AB@A@@@ACA@@AA@@@A@AA@AA@@@AA@A@@AA@@A@@@AA@AA@A@C@@@@@A@A@@@@A@@AAA@@@@A@@A@@@@A@@@@AA@A@@@AAA@@AA@@@A@A@A@@@A@@@A@@A@@@@@A@@A@@@ACAC@A@A@A@@@@A@A@@@A@@@@@A@@A@@@@@A@@@@@A@@@@AA@AA@@A@AB@@AA@@AA@@A@A@AA@@A@@@AAA@A@A@@@A@C@A@A@@@@@CBB@@B@@@@@B@@@@@B@B@@@@A@@@@@A@@@@@A@@@@@@A@@A@@@@AA@@@AAA@AAA@@A@AA@@A@@@A@@@AA@@A@A@@@@@A@@A@@A@@@AB@@A@@@@@A@AAA@@@@BA@@D@@@@A@A@@A@@A@@AAA@@A@@AAA@@@AC@@AA@@@AAA@@@AB@@@@A@@@@@ABABAB@@AB@@AA@@A@@@A@@@@A@@@@AA@@@@A@@@@A@@A@@AAAAAA@A@@@AA@@@@@AAA@A@@@A@@@CC@@@@@A@@@@@A@@@@@A@AAAAA@AB@@A@A@@AA@AA@@@A@AA@@@@@@AB@AA@@BA@AAC@A@@@@AA@A@@@@AA@@@AB@@A@@@ABAB@BA@@@AB@@@@@B@@AB@B@@A@@B@@ABAB@@AB@@"],encodeOffsets:[[121253,29680]]}},{type:"Feature",id:"330803",properties:{name:"衢江区",cp:[118.95946,28.97978],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@ABA@A@C@@@AA@A@@@@AA@AA@@A@A@@AC@A@@@AC@AAA@A@@@AAAAA@A@A@@AA@@A@A@@A@@AA@@@A@@AAA@AAA@@AAAA@@@@@@BAB@BAB@BAB@@@BBD@@@BA@@B@@BB@@@B@B@BA@@BA@@@@BC@@@A@@@@@@@@@A@@@@BA@@@@A@AABA@AA@BAC@A@@@@A@A@@BBB@BAB@@@B@DAB@@AB@@@@@B@@@B@@@B@@@B@@@@AB@@@BA@@@A@A@@@@@@@A@A@A@A@AAC@AAA@@@A@@@ABA@@@AB@@AD@@@BA@C@@BA@@BC@A@AB@@ABA@A@A@A@AAA@@A@A@@AAA@A@@@AA@@A@C@@A@@@AA@B@AA@A@@@AA@AA@A@@AA@@C@AA@@@A@AA@@@@@A@@B@@A@@@A@@@ABA@ABA@@@@B@@@B@BAB@B@@@B@@@B@@@B@@@B@@A@@@@B@@@BBD@B@@AB@@CB@B@@@@A@@BB@@@@BB@@BB@@@BBBBB@BB@BCB@@@@A@@@AACA@AA@@@@@AB@@@B@BB@DBBB@@@@A@C@A@A@@@C@@@@@@BB@@@B@BB@BB@@@@BB@D@BBB@@BA@A@A@@@@@A@BBA@BB@@@B@@A@@@@BA@ABBB@@@B@@@BB@@B@@@B@B@@@@BB@B@@@@@B@@A@B@@BB@@@B@B@@@@@BB@A@B@@@B@@@B@@AB@@BBBB@@D@@@@@BB@B@@@@@BB@BB@@@BB@@@@B@BBB@@B@BB@B@@@@@@@B@@@BA@@B@B@@@@@B@B@@@DD@B@@@B@@BBB@@D@@B@B@@@DA@A@A@@@@@A@@A@A@@A@@@A@@AA@@@@@@B@B@BB@B@BB@@@B@@@@A@@@ADA@@@AB@@@@A@@AA@@@@B@BBB@@BBBBB@BB@@@@@BB@B@B@@@@@@B@@@BBBBB@@@BB@@BBBB@@@@B@B@@B@@B@@@BA@@BAB@B@@@B@@@@AB@@@DB@@B@B@@@BA@@B@BB@@B@@@@@BA@@@A@ABA@@@A@@BA@@@@B@@A@@@@@@BA@AB@@A@@@A@@@ABA@A@@B@@@@@D@BB@@D@@@B@B@@@B@@@@@@@@@@@@@@@B@@A@@@A@@@A@A@ABAAAA@@AAAB@@@A@A@@@@A@@@CB@D@B@@@@AB@@@@A@@B@@@B@@B@AB@@@@@B@BA@@@A@@@@@@B@@@B@B@@AF@B@@@B@@A@@@@@@B@@@@@B@@B@@B@B@B@@@@@@A@@@@BBB@@@@@B@@@@@BBB@@@@AB@@@DB@@@@B@@@B@@B@BBBBDB@B@B@@@@@D@@AB@BA@@@@@@@B@@AB@@@B@@BBB@D@@@BB@ABA@@@C@@@C@@@A@BBA@@B@@B@@@A@@@A@@A@@@B@B@B@@@BA@B@B@@B@B@@@@@@A@@@@@@@A@@B@@@@@@@B@@@@@@A@@BB@@@@B@@@@@@@B@@@@A@AB@@@BA@@@@BBBBB@BBB@@A@@B@@@@@@@B@@@@AB@@@@B@A@BBA@@@A@@B@B@B@@B@@@@@@BB@@B@@B@@@@@A@@@BB@@@B@@B@@@@DBB@@@@@B@@@@@@@B@A@@@@AB@@@@A@@BA@@@A@BB@BBB@@@B@@@@@D@@@@B@@@B@@@B@@@@B@@AA@@@@@@@B@@@@@BA@@@@B@@@BA@@B@@@BA@@@@D@@@B@@@B@@@@@B@@AB@@@@A@@BA@@@AB@BA@A@A@@@A@@B@@@AA@A@@@@BA@@@@@A@@B@@@@A@@@AB@@@@@BA@@@@@@@@B@@@B@B@@@@@@@@@@@B@@@@@@@@@@A@@@ABB@@@BA@BB@ABA@@@@B@@@B@B@@A@AA@BAB@@@B@@A@@@@@B@@BA@AB@@A@@B@@AB@@A@DB@@BBB@@@BAB@@@BB@@BA@@AB@@@@@@BB@@@A@@B@@@AB@B@@AA@B@@@@@@@@A@@A@@A@@@AA@B@@@@@@@BB@@@B@@BA@@@@@ABAB@@@@A@A@A@@@A@@@AB@@ABAB@@@@@B@@@B@@@@@BAB@@@@AAA@@B@@@@@@@@@@@@@@@@@AA@@B@@@@A@@@@@@@AB@@@BAA@@A@@B@@BD@@@BB@@@@B@@@@AB@@@@@A@B@@A@@@@@@@@@AB@B@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@AB@@@@A@@AA@@@@BB@A@@@BB@@@B@@@@A@@@@@@A@B@@@@@@A@@@@@@@@@@@A@@@@B@@@@B@A@A@@B@@@B@@@@@@@B@@AB@D@@B@@@@@B@@@@@@@@@@@@BB@B@@A@@@@@A@@@B@@BBBA@@@@@BA@@B@@B@@BB@@@@@B@@@@AB@@A@B@@@BA@@B@@@@@@@BAB@@@@A@@@A@@@@@AA@BA@@@A@@@@@BBBA@BB@@@@@@B@AA@A@A@@@@@@D@@@BB@@B@@@@B@@@@B@@@@@@@@@@A@AA@@A@AA@@A@@BB@A@@@@BA@@B@BA@B@@B@B@@@@@B@@A@A@@B@@@@@@@BA@@@@@@@@@B@@@@@@B@@@BAB@@@@@@@@B@DABB@@F@D@@@@BB@@B@@B@@BB@BBB@@@BBDBBB@@BB@B@DAD@B@B@B@@@@BBHDFBBBHDDBDD@@@B@D@BA@BB@@@BD@BBB@@@@@B@B@AB@@@B@@B@@B@@A@@@@@@@B@B@@B@@@BA@@@@BA@@BA@@BA@@@ABAD@B@@@@BB@@@@@BB@@BB@@@BAB@@@@@@@@@@@@B@BB@@@@@BB@@@@@@@A@@@@@@B@@A@@@@B@@@@@@@B@@@@@@B@B@B@B@B@BB@@@@@B@@AB@@B@@@B@@B@B@@@@@D@B@@@@@@B@@@@@B@@@@B@@@@@B@@AB@@@B@@@@@@B@@@@@B@@@BBBB@B@@@BB@@BB@@@@@@B@@@B@BA@B@@@@@@@@@@@B@A@BB@@@@A@A@@@@@@BB@@@B@@@@B@@A@@@@@@B@@@@@@B@@@B@@AB@@@@@B@@@B@B@@@@BB@@@@AB@@@@@@BBB@@@@@@B@@B@@@@@@@B@@@@@@@@@B@B@BABB@@B@@@B@@@A@@@@B@@B@@@BB@@@B@BB@@B@@B@@BB@@AB@@@@@@BA@@B@@BB@AB@@B@@@@@@B@@@@@@B@@B@@BBB@@BBA@@@B@@@@B@@@@@B@@@@@@AB@@@@@@B@B@@@A@@AB@@@@BB@@@@@@@@@B@@@@B@@@@FAB@D@@@@@BC@BBA@@@B@B@@@BB@@@@@@@@ABA@A@A@@@@B@@A@@AA@@AAA@@ACAAC@@@@BA@@DABA@@@AB@@@B@B@@A@@@@C@@@@@@@BABA@@@@@@B@@@@A@@A@@A@@B@@@BA@@@@A@@@D@@A@@@@B@AA@@B@@@@A@AA@@A@@@@@A@@@@@@@@A@@A@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@B@@@@@B@@@@@@@BA@@B@@@@@@@@AAA@@@B@@@@A@@@@@@AA@@@@@A@BAA@@AB@A@@A@@A@@AD@@@@@@A@@@@B@@A@@AB@@@A@@A@@@@A@@@@B@@@@@B@@@@@@B@@B@@A@@B@@@B@@@AA@@@A@@@@@@@A@@@@A@@B@@@AA@@@BA@@AA@@@@@@A@@A@@@@@@@AB@@@@B@@B@@@@@@A@@@@BB@@@@B@@@@A@@BAB@@@@@B@@@@@@@@@@BBA@@@@B@@@@B@@@@B@@@@B@@@@B@@@@@BAA@BAB@B@@@@@BA@@@@B@BAB@A@BBA@@@@@@@@@@@B@B@@A@@@B@@B@@@BA@@@@@A@@@@@ABA@@B@@@@@@AB@B@@@@@@@@A@@B@@@B@@@@@B@@A@@@A@@B@@A@@@A@@BAB@@@@@@@@A@@@@B@@ABA@@@B@@BA@@@@@A@A@@@@@@A@@AB@B@BA@B@AB@@@@A@@@A@@@@AAA@@@@AB@@@BA@@B@@@AA@@@A@@BA@AB@@@B@B@@@@@B@@@@@B@D@B@@@@@BA@A@@@A@@@A@@@A@@A@BABB@@@@@@@B@@BA@@@A@@A@@@@@@@@@@@A@@@@@@@A@@A@@@@B@@C@A@@BA@@A@@A@@@A@@@A@@@@BAB@@A@@AA@@@CBAB@DB@@B@D@B@B@BB@@@BB@@BB@@B@B@BBDDD@BBD@@AB@D@@B@@@@A@@@AB@@A@@BA@@@AB@@@@@B@@B@@B@@@BA@@B@BA@@B@@AB@B@@@@@@@B@@@@@B@@AB@BB@@@@@@B@@B@@BB@@B@B@@B@@BA@@@BB@B@@@BA@@BB@@@@B@@@@AB@@@@@@@@B@@@@@@B@@@@@B@@@@@@@BA@@BB@A@@@B@@B@BA@A@@B@@BB@B@@AD@B@@BB@@@B@@B@@B@@B@@@@@B@@BB@@@@@@@@A@@@@@B@@@@B@@@@@@B@@@@@AA@@B@@@B@@@@@@@@@@B@@A@@@@@B@@@@@@B@@@B@@CB@@@@B@@BB@BD@@@B@B@@@B@@A@@B@@BB@@@@@B@@A@@A@@@@@B@@@@@@@BA@@@@@@@B@@B@@@@A@A@@@AB@@A@@A@A@@@@AB@@@@A@@BBD@@@B@@@B@@@@@BA@@B@@AD@@@@@@@@@B@@@@@BA@@@@B@@@B@@BB@@@@BBA@@B@@B@@AB@@@@A@B@BB@@@@@@BB@@B@@@B@@A@@@@DBB@@@BA@@@@A@@A@@AA@@@@@A@@BA@B@BB@@AB@@@@CB@@@BA@@B@@BA@@BA@@@B@@@A@@B@@@@A@@B@@@@@B@@BA@AB@@A@@BB@@@B@B@@@BBABC@@AAA@@BD@@A@@A@@A@@A@@A@@B@@ABB@@B@@A@@@@@B@@@B@@BA@ABBBA@A@@B@@@B@@@B@@BB@B@@B@@@@@@D@BB@@@B@B@@BA@@@@B@@@B@BA@BD@@@@@@@@@@B@@@BA@@BA@@@@B@B@B@B@BB@@B@@@@@@B@@@@BA@@@@B@@@@@@@AB@@@@BAB@@@@B@@@BB@@@B@@B@@B@@B@B@BB@@B@@A@@@@A@A@@@@@BA@@@@B@@B@B@@@@B@AB@@@@@@B@@@@@BA@@@A@@@@DA@@@A@@B@@@@@B@@@@A@@@@@@@A@A@@A@@A@@@B@AA@@@@A@@B@@@@B@@BB@@B@@BB@@@@ABP@@JT@@F@@@D@@@BA@@@cAK@@DA@@@@@@A@@AB@A@@A@A@@A@@@@A@@AA@@AOA@BBDABC@@B@B@@@BABABBBDBBBB@BBBB@B@@@@BBBB@BB@@B@BADAD@BABABABAD@B@@@@@@CAECCCAAIG@@@BA@A@@B@@AB@@ADC@@@ABQ@@@@FMBC@@@^RDDB@DBJHBB@BBB@@B@BBB@@@BBBBBB@B@B@@BB@@@D@D@BBB@BEBEDC@@BCAE@A@@@AAC@AACCEAAAAAA@AA@@AAA@@@A@AA@@CEA@CA@@A@ABCBA@@BABCDB@BB@B@BCF@B@B@@DBB@BB@BA@@B@@AD@@ABCB@@C@@B@@@B@B@@@B@BA@A@A@@@@@A@@@@@A@@@@@@@@@@@@B@@BBBBB@B@@@@BB@BB@@@@@B@B@@@B@@A@@BA@AA@@AB@@B@@@B@@B@@@BABA@@B@BB@@@B@@B@@@@B@@@B@@B@@B@@B@@@B@@@@@B@B@@B@@@BB@@B@BBB@BB@@@@B@B@@@BBB@B@D@BB@@B@B@@@@B@@BB@A@@B@B@B@@@BB@@@B@@A@@BA@@@@B@@A@@@@@A@@@@@A@@B@A@@@BA@@@A@@@A@@@@BA@@@@@@BAA@@@@@AA@@B@@@@@A@A@@@AA@@B@@@@@B@@@@@BA@@@@@A@@@@B@@@B@@A@@A@@@@A@@B@@@@AA@@@@BA@@A@@@@B@@@@AB@@@@A@@@@@B@@@@@@BB@@B@@@@@B@@@@AB@B@@A@@@@@A@@@@@@@@@@@A@@@@@@@B@@@BB@@@@@B@@B@@B@B@@@@@B@@B@@BB@@AB@@B@B@@@@@B@B@@@@@@@@@@@@@BA@@@A@@@@B@@@@@@@@@@@@@@@B@BA@@@A@@@BB@@@B@@@@B@@B@@@@@B@@@@@BB@B@@@B@B@BB@@AB@A@@A@@@@B@@@@@@@@@@@BB@@@@@ABA@@BA@@@@@@@@@@B@@@@B@@B@@A@@BA@@@@@@@B@@@B@BA@@@@@B@@@@@@B@@@@@B@@@@B@@A@@B@@A@@@@BA@@@@@@@@@@BA@@@@@A@@@A@@@AA@@@@@B@@@@@@@@@B@@@@@B@@CB@@@@@BA@AB@@@@B@@@@@@B@B@@@@@@B@@@@@@@@@@@@B@@C@@@@@@@@@B@@@@@@BAB@@@@A@@@@@@@ABA@@@A@@@@@@@ABA@@B@@A@@B@@A@@A@@B@BA@@@@@ADABA@@A@@@A@@B@@AB@@@@A@@@@@@B@@AB@A@@@@@@ABA@@BA@@B@@@@@@@@@@@A@AAA@@A@@@@@@@@@@@@B@@@@@B@@@@@@@@@BA@A@C@@@@@@B@B@B@BAB@B@@AB@BAB@BAB@@ABABA@C@@B@@AB@B@@@BAB@B@@BB@@@B@BAB@@@@@B@B@@@B@@@B@@A@@BBB@@@B@BB@@B@B@@@@ABA@@@@@AB@BAB@@@B@@AB@@A@A@AB@@@BAB@@@@@BABA@@@AB@@AB@BA@@B@@AB@@@@@BB@@B@@BBBB@B@@@B@@BB@BBB@B@@@@@B@B@B@@@B@@@@BB@B@@@B@B@@A@@@AA@@@@@ACA@@@AAAA@AAA@A@A@A@A@A@AA@@@@A@@BAB@@AB@@@@@B@B@D@@B@@B@@AB@B@@BB@B@B@B@D@@AB@B@B@@@@@@BBBB@@B@@@B@@DA@@B@@ABA@@@ABABA@@@@B@@@BB@@@BB@BBBBBBB@B@@A@@B@@@BBB@@@BBB@@@B@@CB@@CA@@A@CB@ACAAA@@@A@@EBABAD@AA@CC@@AB@@@@E@A@@@AB@AA@@AA@@@BA@@AA@@@A@@A@@BAB@B@@AB@@@B@@A@@B@@BB@@@BA@A@ABCB@BABA@AA@@@@@@@@@AA@AAA@@@CA@@@@A@@@A@@@CBC@@@@@ABA@A@@@C@A@A@@@ABC@@BA@@@@BABA@AB@@AB@@@@@@A@A@A@A@A@C@@B@@A@@BAD@@AB@@@B@B@@AB@@BB@@A@@BAB@@AB@@@BCBA@@B@@@B@BB@@B@B@@@BB@@@@BA@@B@@A@@@@BA@@B@@@@B@@BDBB@@B@BB@@BD@BB@@D@B@@B@@@@BB@BB@@B@@@B@@@B@@B@@@@@ABAB@@@B@@@B@B@@@@@B@B@B@@B@ABBB@B@@A@ABA@@BA@@@@BABA@@@@B@@@BBBBB@B@@@B@D@@@B@@B@@@BB@@BBBB@@@@B@B@BAD@@@B@@BB@@@B@B@B@@@BBB@@BA@@@@BD@@@BB@B@@@B@B@@B@@@B@@@B@@@@@@BB@BD@B@B@@AD@@B@@B@@BBB@B@@@@@B@@@F@@@BBB@@@BB@@BB@BB@B@@@@BB@@@B@BDDB@@BB@@B@BB@@B@@@B@B@@@BB@@B@@BBB@@@@@BB@@@B@B@B@@BB@@@@@B@BABAD@BA@@B@@BB@@@B@@BB@@@BB@B@B@@@B@B@@BB@B@@@B@@@@B@@@B@B@BBB@@@DD@@B@@@B@@@@@@@BB@@@B@@BD@@B@@@@BB@@BB@@BB@B@@@B@B@@@@@B@@@@@B@B@BBB@@@B@@@@B@B@@@B@@B@@@@@@@BB@@@@B@@@@@@BBB@B@@@@@B@@@B@@@@B@@B@@@BBB@@@B@@B@@B@@BB@@@BB@@@BB@@@@B@@@@B@@@@BB@@@@B@@B@@B@@B@@@@@B@@B@@@D@@B@B@@@@B@@@BB@@BB@@B@@BB@B@B@@@BBB@@@@@@@@@B@@@@AB@@@@@B@@@B@@@B@@A@@@@B@BABAB@@@@BBABB@A@B@@B@B@B@@@@B@@@@@B@BB@@DBB@@@@@B@@@@B@@@B@@@B@B@BB@@B@BB@@B@@@B@@@BC@@@@BAB@@A@AB@@A@@BCB@B@B@B@@@BA@@BAB@@A@AB@@@BA@AB@@@DA@@B@@@B@@A@@BA@@BAB@BA@ABABA@@DCBB@ADB@@B@B@@@@AB@B@@@BAB@@@@@BAB@@@@ABBB@@@B@@BBA@@BC@@@@B@BCBAB@@A@AB@B@@@B@BA@@B@@@BABB@@DA@@AA@@B@BA@@B@B@@@BBB@B@@@@@@A@@DA@A@@@A@@A@@@AA@@AA@@AAAAA@@A@A@AA@@@AB@AA@@AA@@@@@@BA@@@@AB@@@@@@@C@@@@A@A@@A@@A@@AA@@@@BB@@@@@@B@@@@@@AA@@A@AAAB@@@@@DB@@A@@B@@B@@BB@B@AA@@BA@@@@@A@@@@B@@@@@@A@@@A@@@@BA@@@@@AB@@@@A@A@A@ABA@@BA@@AC@@@@B@B@B@B@@BB@B@@@B@@ABA@ABAB@@@B@B@BA@A@@B@@@B@B@BA@@BAA@@@B@@@@@A@@A@@@@AA@@@@BBB@@@@AB@BB@@BB@@B@@@@@A@@A@@@@@AB@@@@@@A@@@BB@@@A@@@B@@B@@@@@@B@@@@CBA@A@@BA@A@@@A@A@@BA@@@AB@@A@@BA@@@A@@@A@@@A@@@@@A@@@@@@@A@@AA@@@@A@AA@@@@AA@@@@@@@A@@@A@@@@BA@A@@B@@A@@A@@A@@@A@@BA@@@@@@@A@CAA@AA@@A@@DA@B@@@@@@B@@@@@BA@@@A@@AA@@AA@@@AAAB@@BDB@@@@BA@B@@B@@B@@@@B@@@@@@A@@A@@A@@@@@A@@@@@@A@@A@@@@BAB@@BB@@A@@@A@@B@@@@@@BB@@@@A@@B@@A@@@@B@@@@@B@B@@BB@@A@AB@@A@@@@@A@A@@BABAB@B@@@@@@@AA@A@@@A@@@@B@@D@@BB@@@@@A@A@ABB@@@@D@@@B@@B@@@@B@@@@@BB@@B@@BB@@@@@B@B@D@@BB@B@B@B@@BBA@@@BB@@@B@B@@B@@B@B@@B@@@B@BB@@@@B@@@BB@@@@BB@@@B@@AB@BA@BD@B@D@B@@@B@@@B@@@@@@@BBB@@@@@@@B@@BB@@@B@@@B@@@AA@@@@@A@@@ABAAABA@@@@@A@@@@@@AA@@@ABA@A@@@@@A@@B@@@BA@@@@A@@@A@A@A@@A@@BAB@@@@@BA@A@@@@@A@@BA@@BA@@@@@BB@B@B@@AB@@@@CBA@@@AAABABA@@BABAD@AA@@@@@@@AA@@@BA@@A@@A@@@@@@@AB@@@A@@@A@A@A@@@A@BAAA@A@@@@BA@@@A@@@@@@@@BA@@@@@@BDBA@@B@@B@@B@@@@@@@@A@@@AA@@A@A@AA@A@@A@A@@@@@A@@BA@@AA@@@@A@@B@@@@@B@@AC@@A@@AA@A@@@A@@@A@@@@A@@B@@AB@@@DABCBABA@@@A@@@@B@@@B@@A@A@@@A@@@@BA@@B@BAB@B@AA@A@AB@@A@@@@B@@AB@BA@@@@BB@@@@BA@@B@B@@BB@@@@@B@@@@BB@@@B@BA@@DB@@@AB@@@@B@@@@@@@A@@@@@A@@@@B@@@A@B@AA@@@@B@@@@@B@@@@@B@@@@@@@@@@@@AB@@@@A@@@@@@@@@A@@@A@@B@@A@A@@@ABAA@@@@@@@@@@@BAA@@@@A@@@@@@AA@@@@@@@@@@@@@@@@@@AA@@@@AA@@@A@@A@@A@@AAA@@AA@A@A@@B@AA@@@AA@@@@@AAA@@BA@A@@@A@AA@B@BA@@@@@@A@@@@AB@@@BA@ABA@@BA@@@@BA@@@A@A@@BA@A@@@@B@@A@@@@@@B@@A@@B@@@@@@@A@@@@@B@@A@@@@@B@@B@@@@A@@@@@@@AB@@A@@@A@@@BBA@@@@@@B@@@@@B@B@@AA@@@B@@@@@@AA@@@A@@@@C@@A@@@@@@@BA@@@A@@@ABB@ABA@A@@@A@@@@B@@@BA@@@@@@@@B@@@@D@@@@B@@@B@@@B@@@@@AA@@BB@@@@B@A@@@B@@A@@@@@@@C@@B@@A@@@@B@@@@@@@@@B@@@@A@AB@@A@@@AA@@BAA@@@@@@B@@@@@@A@@A@@@@@@@@@A@@A@A@@A@@A@@@@@@A@@@@@ABA@B@@A@@@@CB@@@@@@@@@AB@@AB@A@@@A@A@@@B@@@A@@@@@@A@@@@A@@@@@B@@@A@@A@@@@@@A@A@@@A@@@@@B@@@BAB@@@BA@BB@@A@@@@@@@@D@@AB@@@@AA@@ACA@@A@@@@@@B@@@@@@@@@A@@C@@@A@@@@@@@A@@@@@@@@@AB@@@@AB@A@@@@AA@@@@@@C@A@@@A@@@@@@A@@@A@@@@@@AAA@@BA@@A@A@@@@@AA@@@A@@@@@A@@C@A@@@@A@@@AA@@A@@@@AAB@@A@@@@A@@@@AB@A@@A@@BA@B@@B@@@@@@@@@BA@@@B@@@B@@@B@@@@@B@@@ABB@@@B@@@@@@@B@@@B@@@@@AA@@@@@@B@@@@@@B@@BB@@C@@B@@@B@@@@@@AAA@@DCA@B@B@B@@B@BAB@@B@@A@@@@@@@A@@B@@A@@@@B@@D@B@@@@AB@@B@@@@@@@B@@@BAAA@@BA@@@@AB@@@@@@@@AB@@@C@CBA@C@@@@@@@@@@A@@@@@A@@@A@@@A@@@@B@@@AA@A@@@@@@@@A@@@@@@A@@AA@@@BABB@C@@A@@@A@B@@@D@B@BA@@A@@@@@@@AA@@@@@@@@@BA@@@@@@@A@AB@@@B@@AA@@@@@@@@@B@BA@@@@A@@@BA@@@@@@@AB@@AAAB@@@BA@@@@@@B@B@@@@AA@@A@@@A@@A@@@@@@A@@@@@@AA@B@AA@@@@@@AA@@@@AA@@@@B@B@@AA@@@@@A@@@@A@@@AB@@B@A@@@@D@B@@@B@@@@@B@@A@@BBBBB@B@@@@AA@@AAAA@@A@@@@@@A@@@@@@B@@@@A@A@@@ABC@@A@@@@@@@@@@AB@@@@A@@@@@AA@AB@@C@@B@@A@@@@@@@@@@A@@A@@@AB@@@@CB@@A@@@@@@ADA@@@A@@@@@@@@B@@@B@@@@@@AA@@@@A@BB@B@@BD@@@@AAAB@@BB@@@@@B@@A@@@AA@@ABB@@@@@BB@@@@@@BB@@@BB@@@@AA@@@@B@B@@B@@@@BA@@@B@@@@@@BB@@@B@@@@@A@@@@B@@@@@@@AA@@@@B@@B@A@@@@@AA@@@AA@@A@@@BA@@@AA@B@A@@@@@A@@@@AB@@@A@@@AA@@@@BA@@@B@@@@BB@@@A@@@@@@A@@A@@@@@A@@A@A@@@@@@@@@@@B@@@@@@@@@@@@@BA@A@BAA@B@@@@A@@@A@@A@@A@@@A@@@A@@@@@@B@@@@A@@A@@@@AB@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@B@@@@A@BBA@@@@@@@@@A@@@@@@A@@AA@@A@@@A@BAA@@@@@@@A@@@@@@@A@BA@@A@@B@@@A@@@@@AB@@@B@@@@@A@@ABB@@@@B@@@BA@@@@@AB@@@@@@A@@A@@@@@@@B@@AAAB@@@A@@A@@@ABA@@B@@@@@@@@AA@AB@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@BA@@B@@@D@@@B@@@@@@A@@@A@@@@@@@@@@BAB@D@@@@A@@A@A@A@A@@B@@@A@@@BA@@@@@AA@@AB@@A@@AA@CD@@@B@@@@@BC@@@@@@@A@@@@BB@@@A@@B@@B@@@@@B@@@AB@@BB@@@@@@@B@@@D@@@@@CAB@@AB@A@BB@@B@@@@@AA@@@@@@B@B@@@@@@@@@@AA@@@@@DAAC@A@@@A@AB@@@@@B@@@@B@@@@@BA@@BBBB@@B@@BA@@@@@A@A@@@BB@@A@@@@@B@@BB@@@@@@@@BB@@A@@@@@@@A@@@B@@B@@@@AB@@BAB@@@@@B@@@@@@@@@@@BA@@@@@BBB@@@@@BBB@B@BB@@@@B@@@@A@@AA@@BB@@@@B@@@B@@B@@AB@A@@AB@@@@@B@@@@@@@@@AA@@@A@@B@@A@@B@@A@AE@BG@@BA@@B@@A@@@A@@H@B@F@DB@@@C@A@@@CA@@A@CB@AABA@BBA@@AAB@@@BA@@@@B@@@@@@@@@@@AAA@@A@@@@@A@A@AAA@@@BB@@@@A@@B@@@B@@@B@B@BA@@B@B@@C@@BA@@@@@A@@@A@@@@BA@@@@BB@@B@@@B@BA@@@@B@@B@@@@BA@@@@@@@@B@@@@@B@@@B@D@@@@@@A@@AC@@@@DA@@B@@@D@@ABB@@@@@A@@@AAA@@@AB@B@@@@AA@@@@@BA@@@A@@@AC@@B@@@@@FC@@A@A@AA@@A@@B@A@@AA@@@A@@@@A@@B@@@@A@@@A@B@@@A@@@@B@@B@@A@B@@@@BD@@@@CBA@@B@@@@A@A@@@@AB@A@@@@A@BC@@BA@@@@@AA@@@A@@@@@B@@@@A@@@@@A@@@A@@@@AAA@@@@BAB@B@@AD@@B@AB@@AA@B@@B@@BAA@B@BA@@@@@A@@A@@@B@@A@@B@@@AA@A@@@@@@@@@A@A@CA@@@@@@@B@@A@@@A@@@@@@@A@@@BA@@@@AA@@@@A@@@@B@@A@@@BC@@B@@@AA@@AB@A@@@@@@@AA@@@A@@@@@@@@@@A@@@@AA@@@@AB@@@AAB@A@@@@@AB@@ABAB@@@@A@BBA@@@@@@A@@A@@A@BAA@@AA@AAA@@@@@@@@@@@@@@@A@@@@@@BA@@@@@A@@B@@ABAB@@@@A@@@@@C@@@@@B@@@@A@@@@@@@@B@@@@@BAB@BA@@@@@AA@@@AB@BA@@@@@@@@@@AB@@@A@@@A@@B@@@@@@@A@@@@@@B@@@BA@@@A@@CB@@@@@@BA@@@A@@@A@@@@@A@@@@@A@A@BB@B@@AA@@@@A@@@@B@@@@@B@@@@A@@@A@A@AB@@@@A@@@@@AB@@@@@B@@@@@@@B@@@@@@@B@@@@@@A@@B@@@@@@B@@@@@@B@@AB@@@BA@B@A@@@@@@B@BA@@@@@@B@@B@@@B@@B@@@BB@@@A@@B@BA@@@@BA@@@@BA@A@A@AAA@@B@AA@A@@@@@A@A@A@@B@@BB@@B@@JB@@@@@@B@@@@A@@@B@ABC@@A@@@@@@A@@@A@@@@BAB@B@@@@@@@A@@@A@A@@@@@@AA@B@@@B@BA@BB@@@@@@@AAB@@@A@@@@@@@A@A@@@@@@@@@@@AB@A@A@@@ABB@@BA@@@B@@@@@@B@AA@@@@@@@@B@@BB@@A@@BB@@@B@@@@@@@B@@@B@A@@@A@@@@BB@@B@A@@A@@@@@@B@@@B@@B@@@@ABAB@@@A@@@@@@@@@B@@@@A@@@B@@B@@AB@@@BB@@A@@@A@A@@B@@@@@@AB@@A@@@AB@BC@@B@@@@B@@B@@@@@BA@A@ABA@@B@B@BABA@@@@B@@@@@BAB@@A@A@@@@B@@A@@B@@@@AD@@@@A@AA@@@AC@@@AAA@@B@@@B@@@B@@@B@@A@@BA@@AA@A@@AA@@AA@AAA@@@@@AA@BA@AB@A@A@AA@@A@@@AAA@DA@A@@A@@A@A@@@A@@@@AA@@AAA@@@AA@A@@@@B@@AB@@AAAA@@@@@DA@@D@@ABA@@D@B@@@@AB@@A@@@A@@A@BA@@ACA@@@B@AA@E@@@@@AA@@@@A@A@@BA@AA@@A@@@AB@@A@@@AB@AAB@B@AA@@B@@A@@@A@A@A@A@@AA@@A@@@@@AAACA@ACA@A@A@@@AAAA@A@@@@@ABA@A@@@A@@@A@@@@A@@A@@@A@@@@BA@AA@@A@@@A@A@@B@BAB@@AB@AA@@@AAA@@@@@@@A@A@@AA@@@A@@@@@A@@@AA@@@@C@@@A@@@A@@@AA@@AA@@AA@@@AA@@@AA@AA@@@@@A@@@A@@@A@@BA@A@@@A@@BAA@@A@@@CBA@AA@@@AAA@@@AAA@@@A@A@C@@@@AA@@@@@A@AA@A@A@AA@@@AAA@@A@@AA@AA@@A@@@@A@@@AA@@CA@@A@@@AB@@CA@@AA@@@@A@@@@@AAA@A@@@@@A@@A@@AA@@A@@AA@AA@@A@BA@@AA@@@A@@@A@@@AB@@A@@@A@@@@@A@@B@@A@@@A@@@CA@@@@ABA@@@@@A@A@@@A@A@@@CA@DABA@@B@@@BA@@BAB@@A@@@A@@B@@@B@@@@A@@BABA@@BA@A@CBA@@BC@@@@AAAA@A@@@AA@@AB@@A@@@AA@@A@A@@AA@AA@@@@@A@AAA@AA@ABAAA@@@@@A@@@A@@A@@@A@@AAAA@ABA@@@@AAA@@@AA@@A@@@@@A@@AC@@@A@A@@BA@ABA@@AA@@@@@A@A@@@A@@@@@@CC@@AAA@@A@AA@@@A@@@@@@AA@@@C@@B@@A@@AA@@@@@@@@A@@@A@@@@@AAA@@@A@@C@@@A@@@A@AAA@@AB@BA@@A@@AA@@A@@@A@@@AA@@A@@@A@@@A@@@@AAA@AAA@A@@@CC@@AAA@AB@@A@@@@@A@C@A@@@@@@@A@A@@@@@@AA@@@@@AAA@@BA@@@@A@@@@@A@@@@@AAAA@AAA@@@@@@@AA@@A@@@A@@A@@AA@@A@@A@@AA@@@A@@@A@A@@A@@@AA@@B@@A@@@@A@A@CAA@B@CC@@@BA@AAAA@@@A@@A@@AAAAB@A@CAAACA@@AA@@@A@@@A@@@A@@@@AA@@AA@@AA@@@AA@@@A@AAA@A@@A@@@@@@AACA@@A@AA@@@@@A@@@@A@@@A@AA@@AAA@@A@A@@@AA@@A@@@@A@@A@@@@A@@A@@A@@AA@@@@AA@A@@A@@A@@AA@@A@@@AAAA@@@A@@@@AAA@AA@A@@@AAAA@@@AAA@@A@@AA@A@@AA@@@@@@AB@DA@ABA@@@@BA@@@@B@@A@@@A@A@@@AB@@@@@AA@@@@AA@@@AA@@A@@@A@@@AAAA@@A@AB@@A@@BC@@@A@AAA@AA@@@@AA@@A@@@AAA@A@AA@@A@@AA@@@@@@A@@AA@@@@AAAAAA@A@@A@@@@A@@@A@@@@AAB@@A@@BA@@@@@A@@BA@A@@@A@@@@@A@AAA@@@@A@@A@@A@@A@@AA@@AAA@@AA@@AAAAAA@AA@@A@@ABA@AB@@A@A@@@A@A@A@@@A@A@CB@@@@AAA@AA@AAA@A@A@A@A@@AA@@AB@@A@@@@BA@@@@@AAAA@@A@A@A@A@@@AB@@@AA@@@@@A@@@@BAA@@@BA@@@@@@AA@@@@@A@A@@@ABA@@@A@@AA@@@@@AA@@@A@@@@AA@@A@@D@B@@A@@B@BADA@@BABA@@@AAA@@AA@A@@@@@A@AAA@AA@@AA@@@AACAA@@AA@@AAC@@@A@@@AB@AA@@A@@A@A@@A@A@@A@@@A@@@A@AAA@@AAA@@AA@@@@@A@@@@@AAA@@@C@@@AA@@AA@@@@A@@@"],encodeOffsets:[[121744,29225]]}},{type:"Feature",id:"330825",properties:{name:"龙游县",cp:[119.172189,29.028439],childNum:1},geometry:{type:"Polygon",coordinates:["@@@AA@@AA@@@@B@B@B@@@@@BBBA@@@BB@B@B@BAB@@@@AB@@@@ABA@@@@B@@AA@@A@@@@A@@@AA@@@@@@@ABA@@@@@@@@B@AAB@@A@@BA@@@@@@@@A@@@@A@@@@B@@@@A@@@@@@@A@@@@@@@A@@@@@A@@B@@@@@B@@A@@B@B@B@@@B@@A@@@@@A@@@@@@B@@B@@BA@A@@A@B@B@@@@@B@@@@@B@@@B@@AB@@@@@@DA@@@B@@AB@@A@@@@@@@@B@@@@@@@AB@@@B@@@A@@B@@@@@@@@B@@ABA@@B@@B@@@@ABA@AB@@@@A@@@@@@@@@@B@@A@@@@@D@@@@@B@@@@@BABA@@@AB@@@@@B@@A@@@@B@@@@@@@@@@@@@@@B@BBB@@BB@ABB@@@@BB@@@@@@@AB@A@B@@A@AB@BA@@B@@@@@BBA@B@@BA@@@@BB@@@@@B@@@@@@@@B@@@B@@B@@@@@@@BBA@@BB@@A@@@AD@@B@@@@A@@B@@@@@BB@@@@AB@@B@@@@@@@B@@@B@@@@A@@@@@@DBB@B@@@@@@@@@B@B@@B@@@AB@@@@A@@@BB@@@@@B@@A@ABB@AA@@@@ABB@@BAA@@@BC@@@ABA@A@@B@BB@@@@@B@@@B@@@@@B@@A@@@@@B@@@B@@B@@@@AB@@ADB@@@@@@BBA@@@@@B@B@@A@@@AB@D@@C@@A@@A@B@@@@AA@@@@@@B@@@A@B@@@B@@A@@@@B@@B@@@B@@BB@A@@@@BB@@B@B@BD@@E@@@@@AD@@B@@@B@@AB@@@@B@@B@@A@A@@B@@BB@B@@@B@@@@AA@BC@@@A@@@CB@@@@BD@@@B@@@@C@A@@@A@@@@@A@@@@@@@@@AB@@@@@AA@@@@@ABA@@@A@@@AA@@@@AB@@@@@B@@@B@@@@AB@@@DA@A@@@ABA@A@@@A@@@A@@@@B@@A@@A@@BB@B@B@B@@@@@BB@BB@@@@@@@@@@A@@@@@AB@@A@BB@@AB@AABBBA@@D@BB@@D@@@B@D@@CAE@A@G@@@@B@@@BA@@@AB@@AHF@@B@BA@@@@BA@@@@B@@BB@@@@@@@@A@@@@@A@@BB@A@@BA@@@@A@@@A@@A@@AB@@BB@@@@@@A@@A@@A@AAA@A@@@@AA@A@@@@AB@@@@@@@@@@A@@@@@A@AB@@BA@@@@@AA@@@B@@@@@@@B@@@AA@@@@@@@@AA@@@A@@@@@BA@@A@@@B@B@@@@AB@@@AA@AA@AB@@A@@@@@A@@A@@@@@A@@B@B@@@BBDCB@@@@B@@B@@@@@@@@A@A@@@@@@@BB@@@@A@@@AAB@A@@BA@DB@@@@C@@@A@@@@@@@A@@AA@@B@@@A@@@@@AA@@@@B@@AA@@@@@B@@@@@@AD@@@@A@@@C@@DBB@@@BA@@BB@@B@@@@AB@@B@@@A@@@@B@B@B@BB@@@@@@CBA@A@@@@@@@@B@@@B@@@@@@@@A@@@C@@@AB@@A@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@B@@@BAB@@B@@@@@@A@@@AB@B@@@BB@@@A@BB@BA@@@@@@@B@@@@B@@@@A@@B@@@@AB@@A@@@@@AA@BB@@@@@A@@@A@@B@@@@@B@@@AB@@@ABB@@@@@@@B@@@@@@@B@ABB@@@B@@@BB@@@B@@@@B@@@@@@@@@B@AAB@@@@@@A@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@BA@@@@@BB@@@@@@A@@@@B@@@B@@@B@@@@BB@@@B@@@@@@ABB@A@BAB@@@@@@@@@@@@A@@@@@@@@@@@B@B@@@@B@@@@@BB@@@@@@@@B@@AA@@@@@A@@AB@@@@BB@@B@@@A@@B@@B@@@@@B@A@B@@B@@AB@@B@@@BB@@B@@B@@@@@B@AA@@@@@BB@@@@@@A@@@@@@B@@@@@A@@AA@@@@@@@A@@AB@@@@@AA@A@@@@@BB@@@@AA@@A@@A@@@@A@@A@@@@AA@BB@@B@@@BA@@@@@A@@AA@BB@B@@C@@AA@A@@A@B@@B@@B@@@@A@@@A@@@@@@@@@@B@@CB@B@@@@B@@@DA@@@@BA@@B@@@@B@@@@@@@@B@@@@AD@@@BAB@@B@@@@@B@@A@@B@@@@@@@@B@@@AD@B@@@B@B@@A@@@@@@@@B@@@@B@@@BBBB@@BB@@@@@AAAAA@AB@@@@A@@@@@A@@@A@C@@B@A@@@BA@@B@@@@@@B@@@@BB@@@A@A@@B@@B@@B@@B@@@@B@@B@ABB@@@@@@@B@@@@B@@@@B@@@BB@@B@@A@A@@@@@@@AB@@A@BB@BA@@B@@@@@@AB@@B@@@@@ABA@@@@@@@@@B@@BA@@@A@@B@B@@@@@@AB@@@@@@@@B@@B@@@@@@@BB@@A@A@C@@@A@B@@@BD@A@BA@A@@BB@@@B@@@@B@@@@@@@@@@BBB@@A@@@@@@B@@@B@@@B@@@@@B@@@@@@@@D@B@DAD@@@A@@B@@@@@@A@@B@@B@@AB@BB@A@@@A@@@@@@@AA@@B@@A@C@@@@A@@B@@@@AB@@@@@@@B@@@@AA@ABA@@@@A@A@ADB@CB@BB@@@@@@@A@@@AD@@@AA@@@A@@@@A@@@@@@@BB@@@@A@@@A@@@@@@@A@@@A@BA@@A@@@@@A@@@A@@@A@@@B@@A@@@@@@@@@AA@B@@AB@@@@BBA@@@@@B@@B@@@BA@B@@B@@@BB@@B@@@@@@B@DB@@@@@B@@@B@@B@@@@@B@BB@@AB@BB@@@@@@@B@@@B@@@@B@@@B@D@@@@@@@BB@@@@@BBA@@@@BA@@@@@@@@B@@@@@@@B@@@D@@@@B@@@@@@@@@A@@@@B@@@DB@BB@@B@@A@@BC@@@@@@@@@@BA@@AAB@@A@AB@@A@@@@@B@@@B@B@@@@@@@@BB@@@A@@@@@B@@@@@@B@@@@B@@@A@@@B@B@@@B@A@@BA@@B@@@@@@@@DA@@@@@BA@B@BA@@@@B@@@@@@@@BB@@@@B@BB@@@@@@@@@B@@@@B@@@@A@@@@@@@BB@AB@@B@@@BA@@B@B@@A@@@@@@@@@A@@@@@@BA@@@@D@@@@@@@BA@@@B@A@@@@@AA@@BB@@@@A@@@A@@@A@@@@@@C@@A@@@@@@@@@AB@@A@@@@@@B@@@BAB@BAA@B@@@B@@AB@@@@@@B@@@@D@@B@@@B@@B@@@@A@@@B@@BA@A@@@@@A@@@@@@@AB@A@@@B@@@BA@@B@@@@@@@B@@A@@@@A@@@@@BA@@@@@B@@@@@@@A@@@@BA@@@@@@@@BA@@@@@@BAB@@@B@B@@AB@@@@AB@@AB@BAB@@A@@B@@B@@@@@@@ABA@B@@B@B@@@BAB@@BB@B@@@@BB@@B@@B@AB@B@B@@BB@BB@@@BB@@@@B@@BB@@@@BB@@@@@@@@@@@@@@@@@@BB@@@@@@@B@@B@AB@@@@@@@@@@B@AB@B@@@B@BA@@@@B@@@B@@@@@@@@@B@@A@@B@@@@@@@@@@A@@@@@A@@@@@A@@@@@BBA@B@@@A@@@@@@B@@@@@B@@@@@@@A@@A@@B@@CA@@ABA@@@A@@A@@A@@@@@A@@AA@A@@@AB@@@@AA@@@@ABA@@BA@@@@@@BA@@B@BBBA@A@ABA@@@AB@@@@@B@@@B@BA@@@A@@@@@@B@@ABABADCB@@A@@BA@@@@B@@B@@@B@@@B@B@@BB@@@BD@@@A@@@@@AB@@@@@BB@@AB@@@B@@@@@B@BB@B@@B@B@BB@@B@@@B@@@@@@A@@@@AA@@@ABAC@@@@@@AB@@@@@@@@@B@@AB@@@@@BBBABB@@@B@B@B@@@B@@@A@@B@@@@@@@BB@@@AB@@B@@B@@@@@@BBC@ABAB@@ABABBB@B@@AB@D@@A@@BA@A@A@@A@@@@AB@@AB@@@B@@@@@BAB@@@@A@AB@@@BB@B@B@@@B@@@@@AB@@A@@@@B@@@@@BAB@B@@BB@@@@@@@B@@@@ABBBAB@B@@@B@@@@BB@@@@BB@@B@DAB@@@B@B@BB@@@@B@@A@@@BBB@@@BB@@@B@@@@BB@@@@@BB@@B@@@@@B@@B@@D@@B@@@AB@@AB@B@B@B@BAB@@@B@B@B@DA@@B@@AB@@@@@@@B@@@BB@@@BB@@@BB@@BBBB@BB@BB@@B@B@@@B@BA@BB@@AB@@BB@@B@@B@B@@@@A@@BABA@@BAB@@BB@@@BA@@@BB@B@B@DA@@B@@@B@DAB@@@@@@B@@@B@B@@@@@@BB@@B@BB@@D@B@@@@@@BBB@@B@@@B@@BB@B@@BB@@@BD@@BA@@B@@@@@BB@@B@@@B@DA@@@AB@B@B@@A@AAA@AA@@AA@@A@A@@@@@A@ABA@@@@BA@@DABAD@@A@AB@@@B@B@@@B@@@@B@BB@@BB@@@B@B@@@B@@@B@@@@BB@@@@@@@B@@A@@@@@BB@@B@@@@@BB@@@BBB@@@B@B@@@@@BA@@@@@A@@@@BA@@B@@ABABAB@@A@@@BB@@@@A@@@@AAB@@@BA@@@A@@@AA@@@@@BA@@BB@A@@@@A@@A@@@@@@@A@@@@@@B@@@@BB@BB@@B@@@@BB@@BDB@@B@B@@@@A@A@@@A@@BA@@@@@@@B@@@@B@@@@@@@@@B@@AA@AA@@@@@@B@B@@@@BB@DA@@@A@@BC@A@@BAA@AA@@@@@@BABA@@@A@@@ABB@@BA@@@@B@@@B@@B@@@B@@@B@@@@B@@@BB@@@A@@@@@@@A@@B@@@B@@@@@B@AA@@@@@@@@@@BB@@@@@@@A@@@A@@@A@@@B@@@@B@BBB@D@@B@@AB@@@@@@@BB@@@@B@@@@A@@B@@@@@@@@B@@B@@@@A@@@@B@BB@@@@AB@@@B@@@@@AD@@B@@B@@BB@@@BB@B@@@@BA@@DA@@B@@A@@@@@A@@@@@A@@@@B@@@BA@@B@@@@@B@B@@@B@@@@@B@@@@BBB@@BB@@@B@@@B@@A@@BA@@B@@@@BB@B@B@@@@@@@@@BB@A@B@@BBB@@A@@@AA@@A@@B@@@@@@@B@@@@@AA@B@@@@@AA@@@@@BA@@@@BA@@BB@@@@@@@@@A@@B@B@BA@@@@B@@A@B@@BA@@@@B@@@@@@@A@@BB@B@B@@@@A@@@@B@BB@@@B@BA@@@BB@@@@@@@@A@@@@@A@@@@B@@A@A@@BBB@@AB@@@@@BB@@@B@@@@@A@@B@@@A@@@@A@@B@@@@@B@@BD@@A@@@CB@@@AB@@@A@@@AB@@@B@@B@B@D@@AB@@@@AA@@@BA@B@@@@@@@B@@@@B@@B@@@@@@@@B@B@@AB@@BBA@B@@@@@@@BA@AB@@@BA@@B@@@BA@@B@@@@@@@BA@@@@@@@@@A@@@@@@BB@@@BB@@@@BBB@@@@B@@@@@@A@@@@@A@@BA@@@A@@@@@A@AA@@@@A@@BB@ABB@A@@B@BA@@B@@A@@A@B@BA@A@AB@B@@ABA@@BAB@@@@A@A@@@@AAAA@@AAB@@A@A@@@@B@@ABA@@B@B@@@BA@@@A@@BB@@B@@@B@@@@A@ABAB@BA@@@@@@BAB@@B@BBB@@BB@@@@@@B@@B@@AB@@@@@BBB@@@B@@@@@@@BB@@@B@BB@@@@B@F@D@@BB@@@BBB@@@B@B@BB@@B@@@B@@@B@@B@BB@@BAB@@@BAB@AB@@BB@@BAB@@@BA@@@@B@@@BB@@@D@DAB@@ABB@B@B@@A@@B@B@BA@@@B@@BBBA@@BAB@@@BBB@@@DA@@BA@ADD@@B@BAA@BAD@@@@@@AB@@@BA@@@AA@@A@@A@@@AC@@BAB@@@B@@@AA@@@@@A@A@@@ABCA@A@@@@@@A@A@@@@@AA@@AB@@A@@@@@A@A@@@@B@@@@@@@@AA@@@@@@@@@B@@@B@@B@@@@B@@B@@BABA@@@A@A@A@@A@@@AAA@BA@AB@@ABAB@@ABA@@@@@@@@@A@@@@@@@@@AB@@@A@@@@@@ABBD@@@A@B@@@B@@@A@AA@@@@@@@@AA@A@@A@@@@@@@@@@A@@@@@@@AA@@@@@@@A@@@@BAAAB@@B@@@CB@A@@BC@@B@@@B@@@@A@@@@A@@@@@A@@BA@@@@@@A@@B@BA@@B@@@@B@@B@@AA@@AB@@@@@A@@AB@@@BA@@AA@@@@B@@@@@@@BB@@@B@@@@B@@B@@@@B@@@B@@@@@@@BB@@BA@@@B@A@@BA@@@@@B@@@@@@B@@@@@@@@BA@B@B@@@@@@@B@@A@@B@@A@@@@@@BB@@@@@@A@@@@@@B@@A@@B@BA@@@@@BA@@@@A@@@@D@@@@BA@@@@@@B@B@@A@@@@B@@@@@@@@@B@B@@AB@@@@A@@@@@BB@@A@@@@A@@@B@BA@@@@B@@@@@BAB@B@@AB@B@EC@C@A@@AA@AA@AA@@@@@AAA@@@A@A@@A@@AA@@@AAA@B@AA@@A@@@@@AAA@@A@A@@@@AA@@@A@@AAC@@AA@A@@A@@A@A@@@AA@@@AA@@@@@C@@@A@BAB@@@@@A@@AB@@A@@@@AA@@BA@@@@@@BAB@A@@@BA@A@@@@@@CA@@A@AA@@@A@A@@@@@@@ABA@@A@BA@@@AB@@@B@@AB@@@@A@@@AA@@@B@@@@@@A@@@B@@B@@@@@@@@@AAB@@@@@AA@A@@@BAA@@@AA@A@@@@@@ABC@@AAA@@@A@@@A@@@A@@@CAA@AB@@A@@@A@@@A@@@A@B@@AAA@@B@@@A@A@BC@@@@@BB@@AB@@@@@@AB@@@@@@@@@@@B@@@@@@@@@B@@@@@BBBAB@@@@A@A@@BAB@@@@BB@@@B@@@B@@@@@@@@@@@@BA@@A@@@B@@@@B@@@@B@@A@@@@A@B@@A@B@@@BB@@B@@@B@@AB@@@BCBB@@@@@@B@@@@@@A@@B@@@A@@B@@B@@@@@@AB@B@@@@@@@A@@@@@AAB@@@@A@@@A@@@@@A@@A@@@@@@@@@@A@@@@BB@AB@@@AA@@@@@@@@@@D@A@@A@@B@@@@@B@@@@@@A@@@@@@B@@@@@AA@@@@@@@@@@A@@A@@@A@@AA@@@@B@@@@@AAB@@@@AB@@@BA@@@@@@@@A@@@AB@@AAB@@@@@@@B@@AA@@@@@@A@@@A@@@@@A@BA@@@@@@@@B@@AA@@@@A@B@@A@@@@@@A@@@@@@@@@@@@A@@@@@@@A@@@@@@@@BA@@@@@@A@@AB@BA@@@@@AB@@@@AA@@B@@@@@@@@@@@A@@B@@@@@@@@@@@@A@@@@B@@@@AA@@@@BAA@@A@@@@@@A@@B@@@@@@@@AB@@@@@@@@AB@@A@@B@@A@@@BBAA@@A@@AB@@@A@@@@@@@@@@B@@@@@@@B@@A@@@@B@@@@A@@@@@@@@B@@@@@@@@@@@B@B@@ABA@@A@@@@@A@@@@A@@@@@@@@@@@@A@B@@@@A@@AB@@@@@@A@@@BA@@@@@@BA@@A@@@@@@@B@@@@@@A@@@@@@@@B@@@@@@A@@@@@@@@@A@@B@A@@@@@@@@A@@@@@@@@A@@@@@@@@@@A@@@@@@@A@@A@@@@@@@@@A@@@@@@A@A@@@@B@@@@@@@@@AA@B@A@@@@B@@@@@B@@@@A@@@@A@@AB@@@@B@A@@@@@@B@@A@@@@@@@@@@@@@A@@@B@@B@@AB@@@@A@@AB@A@@@@A@BA@@@@@ABA@@@BA@@@BB@@@A@BB@@@@@B@@B@@A@@@@@@@B@@@@@@@B@@B@@@A@@@@B@@ABBBA@@@@B@@@@@@@@@@@@@@@@@B@@@@BB@@@@@@@B@A@@@B@AB@AB@@@@@@@B@@@A@@AB@@@@B@@@@B@@A@@@@@@@@@@@A@@@@BBB@@@@A@@@@@@@@A@@B@A@@@@@@@@@@@A@@@@@@@@B@@B@AB@@@@@@A@@@@B@@A@@@@@@@@@@@A@@@@B@@@A@@@@@@@@@B@AAB@@@@@@A@@@@@@@A@@@@@@@@A@@BA@@A@@@@B@@@@AA@@@@@AA@@B@@@@@@@@AB@@@AA@@@A@@@@B@DA@@A@@@@@A@@@A@BA@@@@B@@@B@@@@CA@@@@AB@@A@B@@@@@@@BF@B@@B@@@A@@@@@@AAA@@@@@B@@@B@B@@@@A@@@B@@@@A@@B@@@@@@B@@@@@@@@@@A@@@@@@BB@@@@@@@@@A@@@AB@@B@@@@A@@B@@@@B@@@@@@A@@@A@@@@@@D@@@@@@@@BB@B@@@@@@AA@@C@@@@@@@@@@B@@@@@@B@@B@@@AA@@@@B@@@@@@@@B@@@@@@B@@@AA@@B@@@@@@@@@@@@@B@@@B@@@@@@@@@@@BB@@@A@@@@@A@@@@@@B@@@B@@@@@@@@@@A@@@@A@@A@@@@@A@A@@A@B@A@@@@@@AA@@@@@@A@@@@@@B@@A@@B@@@@B@@@@BB@@@@@AB@@@@@@@@A@@@@B@@@@@@@@@@@B@@A@@A@@@BCB@@A@@@@@@BB@@@AA@@@@AB@@@@B@@BBB@@@@BB@AA@@@@B@@@@@@A@@@@@@@@BA@@@@@B@@@@@@BB@@@A@@@@@A@@@@@@@@@@BA@@@@@B@@@A@@B@@@B@@@AA@@B@@@@@BAA@B@@A@@@@A@@@@@B@@@@@@A@@@@@@@A@@@BA@@@@@@@@@@@AA@@@A@@@@A@@@@@@AB@@@@@@AB@@@@@@A@@@@A@@AB@@B@@@@@@@@@BB@@A@@@A@@@AA@@@BA@@A@@@@@@@@@A@@@A@BA@@B@@ABB@@A@B@@@@@@@@@B@@@@@@@@B@B@@B@@@AAB@@@@@@@@@@@@AB@@@@@@@B@@@B@@@BA@@B@@@A@@@@A@BA@@A@@@@A@@@@@@@@@@A@@@@@@@@@@@@@ABADDB@B@@BB@B@D@@AB@@@BDFHBBBBB@B@BBDCAEBCB@BCBA@AB@@A@@@@BA@@@@@AB@B@@A@@B@@@@ABBBC@C@@@A@@@A@A@@@A@@@@AAA@@A@@@@@@@AA@@@@@A@@@@@@@AB@A@BA@@A@@C@@A@@@@@@@A@@@@@AA@@A@A@A@@AA@C@@A@@A@@A@@A@@@A@BBAA@DCFCA@@@@@@@@@@@@A@@@@B@@C@A@@@AAAB@@BB@@@BA@@B@@@BA@@@@BA@@@CA@@@@@@@@@@@BA@@A@@A@@A@@@@@@AA@A@@AA@@@@@BAB@B@@@@A@@@A@@@A@@@A@C@A@A@AA@@@@B@B@@A@@@@BB@@@@B@@@B@@A@A@@@@@AD@@@@@@@BA@@BABA@@A@A@@@@BA@@B@@C@@@AAAAEAB@@@@@@@AB@@@@@@A@C@A@@@A@@@@@@BA@A@@@@B@@@@BB@@@@@@@@AAA@@@A@@@A@A@@A@@@@B@@A@@AA@@@A@@@@AAC@A@@@C@@BB@@@A@@@@@A@A@AA@@@@@A@AAA@@A@@B@@@@A@A@@AA@@@A@@@@@A@@B@BB@A@@@@@A@@AA@@AA@@B@B@@@@@@@AA@@@@BA@@@@B@@@@AB@B@B@A@@C@@B@@@@@@@B@@@@@@@@@AA@@@@@A@@@@@@@@@B@@A@@@A@@@@@@A@@A@@AB@@@@A@@@@@@@A@@AB@@A@@A@@@CB@@@B@D@@@@A@@B@B@@@DAAA@@@A@@AA@@@@@A@@B@AA@A@@@@A@@@@C@@A@@@@A@@@AAAA@@@@A@@AA@@@@@A@@@A@@@ABA@@@@@AB@@A@@@@AAAABBB@B@@AB@B@@@@AB@@AB@@@A@@@@@ABAB@@A@@@A@A@@@A@@@@B@@AA@@@B@@@@@@@@@@AA@@@@AA@@@CA@@AAAA@@AA@@A@@BA@@@AA@@AAA@@A@@A@@@@@@@C@@@A@@B@@ABA@@BC@@CA@ACAB@@A@@B@@B@@@BB@B@BA@@@AA@@AA@@A@@@@BA@AB@B@@@B@D@AA@A@@@AB@D@@@@AB@B@B@@A@@@AB@@A@@@ABA@AAA@A@AAA@@@A@AB@AA@AAA@@A@A@@@@A@@B@@@BA@@D@@@B@BABA@@A@CADA@@DA@@@@@A@AB@@A@A@@@A@A@AB@BA@@@BB@@@FAB@@@@AB@@@@AB@B@@A@AB@@A@@BABA@@B@@@BA@@CAA@@@@@DC@A@@AC@A@@@A@A@AAA@A@@ABAB@@@B@@@@A@@@BCBAB@@@A@A@@BABA@@@AAB@@@@AB@@@B@D@@AA@AB@AABA@BA@@@@A@@A@@A@@C@@@CA@@AA@@A@@@@AB@@@@@BA@CB@@A@@@@@A@@@@A@@@@@@@@@AA@@@BA@@BBB@BAB@B@@ABA@@AABAA@BA@@@A@@@AA@@CAA@@A@@A@A@A@AAA@@A@ABA@A@A@@@A@@@ADA@A@@@@A@@AAA@BA@@A@@@@@@BA@@@@A@@@@@A@BA@@B@@@@@BA@B@@@AB@@@@@@A@AA@@A@@@@@@A@@CC@BA@@BA@@@@B@B@B@A@AAA@@@A@A@@@@@@AA@@A@@@@@@@@A@@@@@@@@B@@@@ADB@@B@@@@@@C@CA@@AACA@@B@A@@@@@A@@B@@A@@@AA@@@@A@@@A@A@A@A@CAA@A@@@@BA@@D@BB@@BB@@D@B@BBB@D@B@BBB@DBB@B@@@@ABAB@@@B@@B@B@@@@BA@A@@@@B@@@@@@@@@B@@@@@@@@@A@@@@@@@@AB@B@@@@@@@A@@@A@B@@AB@D@B@@@@A@@@@@@@@@@A@AB@@A@@@@@AA@@B@@@D@@AA@@A@@@@A@@@@@@@A@@@@@@@A@@B@@@A@@B@@A@AB@@@A@@@@@@@B@@A@A@@BA@@BA@@@@BA@@BA@@B@B@@AD@@@@@@A@AB@@AB@@A@@@@@A@A@@A@A@@A@@@@C@AAA@@@@A@@AABCB@AA@@@A@@A@@@AA@@A@@A@@@A@AA@@A@@G@@@A@@A@@@A@A@@@@@ABA@@GBA@@@@A@A@@EC@@A@CA@B@B@@@B@@AB@B@@@BAB@@@BA@A@A@C@@@AB@@AA@@@@A@@@@AAAA@@@AA@AA@@@@@A@@@@@A@@A@@@@@@@AAA@@AA@@@@A@A@AA@@@B@@A@@@@@A@@@AB@@ABC@A@A@AB@@A@A@@@A@A@A@AAA@@@ABA@AA@@@AA@@AA@@@A@AA@@A@@BA@ABA@ABAB@@AA@AA@A@A@@@A@@AACBAB@@@B@@@@@@B@@@@@BB@@BB@@@D@@@@A@@@@@@BA@@B@@ABA@@@A@AB@@@@@@A@@@@AA@A@@@AA@@@@@AA@@@@AAA@ABA@@@CB@@A@@@@A@@AA@CCAAA@@A@@@@A@@@@@@@@@@@@@@@@@A@@B@@@@@DBDB@@AA@@A@@AC@@@@AB@@@@@D@@@@B@@B@B@@AA@AA@@C@@@@@@@@ABB@@B@@@AA@@A@@@@A@@@@@@@@@@A@@@A@@@@@B@@@@A@@AB@@A@@B@@@A@@@@A@B@@@@@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@A@@@A@AA@@A@A@@@@@AA@@@AB@@@@A@@AA@@@@@A@@@AA@A@@AAAAA@@@@@AA@@@@A@A@@@AB@@@@AA@@A@@A@@AABA@A@@@@@AA@@AA@A@@A@ABA@@@A@A@C@@@A@A@@@CD@@A@@BAACB@@A@@@A@C@ABA@@@A@@@@@A@CDCHA@AB@@A@AAA@@@@@A@@B@@A@@AA@@@@@@BA@@@@@@ABA@@AABA@@@@@A@@A@AA@@@BAB@@@@@BBB@@A@AC@@@A@@A@@@A@A@@@@DBD@@@BB@@B@B@BA@A@@@CBA@@BA@@@C@A@ABA@@@@CA@@AA@@@C@@@@@A@AA@@@A@@@A@A@AAAA@A@@@A@@A@A@@A@@A@@@A@@A@AA@@@A@@A@A@A@C@@@A@@A@@@ABA@A@A@@@A@@AA@@@@@A@@@@BAA@AAA@AB@@AAA@@@@@@@@@@@A@BA@@@@A@BA@@@@@@@@BA@@@AA@@@@@@AA@@@@@@B@@AB@@A@ABAB@@@@@@@A@@@A@@@A@@@@AA@@@A@@@ABA@@ABA@@@AB@@@@AB@@AA@@A@@@@@CA@A@@@@AA@@@@A@@@@A@@@@@@@B@@@@@AA@@@@@B@@@C@@@BA@@A@A@@@@@A@@@AAA@AA@@@A@@A@@@A@@@A@@@A@@@A@@@@AA@@@@@A@@@A@@@AA@@AA@@A@CB@AA@@@@@@@A@@@@@A@AB@@@AA@A@A@@@@@C@@@A@A@@@A@@B@@@@A@AB@@A@AA@AA@@@@@A@@@@BA@ABA@@@CBA@@@@@@@C@A@ABCBA@@BC@C@A@@@@B@BA@@@AB@@@@@BAB@@AA@@@@@A@A@AA@@A@@A@AAAA@A@A@@@A@@A@A@@@@AA@@AC@@@@AA@@@@@A@@@@DA@@@A@@BA@@B@B@@A@@@A@@BA@AA@@A@@@AC@@AAA@@AAAA@A@@AA@A@@@C@AAA@A@@@A@A@@@@@A@@@@@@B@@@B@B@@@@BB@@AB@BAB@@@B@B@@BD@@@B@@@@@BB@@B@@BB@B@@B@BAB@BB@BB@@@B@@@@B@@@B@@@@BBAB@BBBB@BBB@@@@@B@@BBB@@@B@BB@@B@@@BA@@BB@@B@@@BBBBB@@@@AD@@AB@D@BAB@@ABAB@@@B@@A@@@A@@@@B@@@BA@AB@@AB@@A@@@ABCBB@@D@@@B@B@@@B@B@@@@AB@B@@B@@D@@@B@@@BA@@@@B@@@@@B@@@BA@@B@@@B@@@B@@BB@@ABB@@@BBB@@BB@@@BB@@@BB@@@@@B@B@BB@@@@B@@@@@BB@@DB@@BA@@B@@@DB@@BB@@B@@@@@@BB@@BBB@@@BB@BB@@B@@B@B@BBBB@@@@@B@@B@@D@B@B@@@B@BB@@B@BB@@B@@BAB@D@@@BB@AB@@@B@@@BAB@@@B@@@B@@@B@@@@BBB@@B@@BB@@B@@BB@@BB@@B@@@B@@@B@@@D@@B@@B@@@B@@@@@B@@BB@@@B@B@@@@@@BB@B@@BBA@@BA@ABA@@@@B@B@@@BB@@BAB@@@@@B@@@BB@@@@@@B@@@B@@@BAB@B@@@@@BBBBB@@B@B@B@BDB@BDBB@@@@B@@@BB@@@B@B@B@B@@@BA@@@BBA@A@BBA@@B@@@BA@@B@@@BB@@BAB@@@B@B@@B@@B@@@@@FBBA@@@B@BD@@ABB@@@@B@@@BA@@B@@A@C@@@AB@BC@@@CB@@@@B@BB@BA@@BA@@@@@@BBB@@B@BB@@BB@@@@@B@@@B@BB@@@@BCBB@BB@@B@@@BBB@B@A@@BABB@@B@@@@BB@BBB@@BB@@@BBB@@AB@@@BA@@@A@@@A@@@A@@@BB@B@@BD@@B@@B@B@@C@@B@@A@@@@BA@@@@@@B@BA@AB@@@@A@@@@@ABABA@A@@@AB@B@BAB@@@@A@@@@A@@A@@@ADA@@B@@@BA@@B@@@@A@@@@B@B@@@BA@@A@@BA@@@AA@@@B@@@@@@A@@@@@@@@@B@@BABA@@@@@AA@@@A@@@@@@@@BB@A@@@AA@@@@@B@@@B@A@@@A@@@@@@@A@@AA@@@BA@@AA@@@@@@@@@BBA@@@@@@@@A@@AB@@AA@B@@@B@BBA@@@@@@@@@@B@B@@@@@@@B@@@A@BB@@@@@@A@@AABA@@@A@B@@B@@@@B@B@@@B@@@@@@@A@A@AB@@@@@B@@@B@@@@B@@@AD@B@A@@@B@@A@@@@@@@IA@@"],encodeOffsets:[[121946,29648]]}},{type:"Feature",id:"330881",properties:{name:"江山市",cp:[118.626991,28.737331],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@D@B@@BB@@@@@@@BA@C@@@ABCB@@@@B@@@@@B@DBB@B@@@@@@BB@AD@@@@B@@@@@@B@@A@@@@@@B@@B@@@B@B@@BB@@BA@@B@@@@CA@@@@@@@@@@@@AB@@@@@@@B@@@@@@@@A@@@@@@@AAA@A@@@AB@@@BB@@@BB@@@@@@A@@@@@A@@AA@@C@AA@@@AB@@@@BB@B@BAD@B@B@@@@A@@@@@AB@@@BB@@B@@@B@DB@@BA@@@@@@@@@A@@@@@@@@B@@BB@@@BA@@B@@@@B@@B@@@@A@@@BBB@@@@@@@BAB@@B@B@B@@B@@A@@B@BB@@@@@B@@@@B@@@@@B@@@@A@@@@@@@BB@@B@@@@@DBB@@BA@@@@@BB@@@@BA@@B@A@@@A@@@@@@@@AB@@@@@B@@@@@@@@B@@@AB@@@@@@@@B@@@@@@@@B@@AB@@BB@@@@BB@@A@@B@@@@@BB@@BA@@@@@@@B@@@@B@BB@@B@@@@@@@@@@B@B@BAB@@@@@BA@@B@BB@@BB@@@@@@B@BBBA@@@A@@B@@@D@@@D@@@@@BBB@B@A@@@BB@@BA@B@@B@@@@@@@@A@@B@@@B@@@@@@A@A@@@@@ABA@@A@B@AAB@@A@@@@@AB@BAB@BAB@@@BB@@@@BB@@BBB@@@B@BB@@BBB@B@DAB@D@B@@@B@@@@A@@@@BAB@@@B@@AB@@@B@@@B@@@@@BBB@@@B@@AA@@@@@AAB@@@BABA@AB@B@@@B@@A@AB@@@B@B@@@B@@@B@@@B@B@@B@@A@A@@BB@@@@@DB@@BA@@@A@AA@@ABA@A@@@@@@B@@A@@@@@AA@AA@CA@BA@A@@B@BA@AB@@@DA@@B@@@@@B@@@@@@A@@@@@@@@BB@@BA@@B@@@B@@@B@@@@B@@@B@@@@BA@@B@@@B@BAAB@@@BAB@@@B@@@@@@@B@@@@@@@B@D@@@@@@@BAB@@B@@BB@@@@B@@BB@@@@BB@@@BB@BB@@@B@@@@@BB@B@@B@B@@@BB@@BB@B@@B@BB@ABA@@BB@B@@BBB@B@@@@@@@@@@@B@@@@BB@@AB@B@@@@BBDBB@@BB@@@BB@B@@A@@BA@@B@@B@BA@@@B@@@@BB@@@BA@@@DB@BD@@@@BAB@B@@B@@B@B@B@@B@@@@@B@BB@@@@@B@@@@@BB@@@BB@@@@AB@@@B@B@@ABABA@BB@BAB@B@@@B@@@@@@BD@@B@@@D@B@D@B@@B@BB@@@B@BBBA@@@@@B@@@@@A@@@@@A@@B@BB@@DA@@D@BB@@@B@@B@@@BB@@DC@ABABA@A@A@A@A@@BA@@DA@AB@BA@@B@BB@@BB@@B@@BBB@BBB@@B@AF@@BB@@@BA@@BBB@BAB@@@B@@B@BA@@@@@A@@B@B@@BB@B@@@BBBB@@B@B@BAB@BAB@@@BA@@@@@ABA@@@A@@DBBBB@@B@@@@B@@@B@D@@B@@@BB@B@@@B@BAB@B@B@B@@@@B@@B@@@@BBB@@BDB@@@@@B@AB@BABBB@@@@BBB@D@@@B@@A@@B@DA@@@A@@@@B@BAB@BBBAB@@@@AB@@@@@@@DAB@BAD@B@@BBAB@BABB@@@@@@@B@@BBBA@AB@BAB@B@BA@BBA@B@@B@B@@@@@B@B@@@@@B@@@BBAB@@@@DB@BBB@@BBF@@@@BA@@@@@DD@@@@B@@@@@@@B@@B@@B@@B@@BB@B@B@@@@A@@BA@@@BB@B@BA@AB@DA@ABAB@B@@@B@@@B@@@B@@@@FB@@@@@@@BB@@@@BB@@@B@@@B@@@B@@@B@@ABA@@B@@A@@BA@@B@@@@BB@@@@@FABAB@@A@@BA@@DABB@A@@@@BA@@@@B@@@@@@AB@@@@B@@B@@@B@@@B@@@B@B@B@@B@@B@@@@@B@B@@B@@B@@@BAB@@@B@@B@@@B@B@@B@BB@@@@@@@@B@@@BBB@@@@@B@BB@@B@B@@@BBB@@@B@D@B@@@B@B@BBD@BBB@B@D@BB@@B@BB@@B@@@@BB@@AB@@DBB@B@B@@@BA@A@@@@B@@BBBB@B@@@B@@@@DB@BB@BB@@BBB@@BBB@@@@BAB@B@BA@@@B@@B@@@@B@@@@B@B@@A@@@@@@B@@A@AB@@AB@@@@BB@@@@BB@B@@@@A@@@@B@@@@@BA@BBA@@@AB@@BBA@@BA@A@@@AB@@B@@@B@BB@@@B@BA@@B@@@@@@A@AA@AAAB@@@@A@@@@@@@@@@A@@@A@@AAA@BC@@AAB@@@@ADCB@@@@A@@@@@@@@B@@@@A@@@@@A@@@BA@B@@A@@@@@@@@@@B@@@B@@A@B@A@@@@@@@@@AB@BABABA@@@@B@@@@@AB@@A@@@B@@@B@@@@@AB@@@BA@@@@@@@B@@B@@BAB@@@@@B@AA@@DA@@BA@@A@@@@@AABA@@@@B@AB@@B@@@@@B@@A@BB@@@@@@A@AB@@A@@@@@@@@@@B@@@@@@B@@B@@@@@B@@A@@@A@@@B@@@AB@AAB@@@@@@@@@A@@AA@@@B@@@@@@@@@B@@@@A@@@@B@@@@@@@B@@@@@BA@ABABAA@@@@AB@@ABA@@@ABA@@AA@@@AB@@A@A@@@@@@B@@AAB@A@@A@@@@BA@@@A@@@@A@@B@@AB@B@@@@B@@B@@@@@@@@@@A@@@A@@@@B@@@B@@@@@@@@@@@AAB@@@@@@@@@@A@@A@@A@@@@BA@A@A@@AA@@BA@@@@BA@A@@@A@@@@A@@@@@@@@@B@@A@@@@@@@AB@B@@@@A@@@@@A@AA@B@@A@AB@@A@@B@A@@AB@@@BBB@@@BBB@@@@@@@@@@@@A@@A@@@@@@AA@@A@@@C@@@@B@B@B@@B@A@@@@A@@AABAA@@@@B@@@B@@ABB@@@@B@@@B@@@@@BA@A@@@@@@@AB@@A@@@A@BA@@B@@A@@@@@A@@AA@@@@AB@@A@@@@ABAA@@@A@@@B@@@@@BA@A@@A@@@@@@@@@@A@@@@@A@@@@CBA@@@A@@@@@@@A@@@AB@B@A@@@@@@A@@B@@@@@@@@@@@B@@@@@@@@A@B@@@@B@@@@@@A@@AA@@B@A@@A@@B@@BB@@@@@BA@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@@@ABA@@@@@@@@B@@@@A@B@@@@BA@@@@@A@@A@@A@@AC@@@AB@@@BB@A@@BA@@@@@@B@@@@@@AB@@B@@@@@@@@@@@@@@@@@AB@BB@@@@BA@A@@@@@A@@@A@@@@BABA@@BA@@B@@@B@B@B@@@@@BABA@@@@B@@AA@@@A@@A@@@@@@@ABB@@B@@@@BB@@@@@@@@@@ABB@@@ABA@@A@@@@B@@AA@@@@@@BA@@AB@@AA@@A@AB@@A@AA@@CAB@@@BA@@@AB@@@BAB@@AA@@@@@B@@@@A@@BA@ABBB@@@@A@A@@@A@@B@BAA@@AAB@@A@BA@@B@@@@@@@@@@@@A@@@@@@@@@@@A@A@@@A@@@@@@B@@A@@@@BA@@B@@@@@@AB@@@@@B@@A@@B@B@@B@@@AB@@@B@B@B@@ABA@@B@@AB@@@@@BA@@@A@@@@@A@@@A@@@C@@B@@A@@@AB@@A@@@A@@B@@A@@@@@A@@@@@@BB@@@A@@A@@@A@@@A@@@@@@C@@@@@A@@AA@AAAB@@@B@@AB@@@@@BA@@@@@B@A@@@@@@@A@@@@AA@C@@A@@@@A@@AA@@B@@@@@A@@@@AA@@A@@@@A@@@@A@A@AB@@@B@AAB@A@@@@@BA@@@@@A@@@@@@@AB@@@AA@AAAAA@A@@B@@A@@BAB@@@@@@A@@@@@@@A@@A@@AB@@@@@@@@A@@@@@@@AB@@@@@@@B@@@@@@@@A@AA@A@B@@A@@@A@A@A@@@BB@@@B@@@A@@@@AB@AAB@@@D@@@D@@@B@BAA@@A@@@CAA@AA@@@A@@BA@@@@@@@B@@ABA@@@C@@@@@A@ACAAAAAA@@@@A@@@A@@A@@C@@BA@@@@AA@A@@@@@A@@@@AA@A@@B@@@@@@@@A@A@AA@@@@A@@@@@A@@@@B@@@@A@@@ABE@@@A@A@@@A@@@@B@@@B@@A@A@@@@BAA@@@@A@@@AB@@@@@BA@@@@@A@CDA@@B@@@@@@B@B@@BABB@@BBBBBAB@B@@@B@@@B@@@@A@@@@@@@@@@@@@@@A@@@A@A@@@CA@@A@C@@@@@AB@B@BA@@B@@@B@@@BAB@@A@@@@B@@@@A@@B@@AB@@@B@BAB@@@B@@A@@@@@AA@@A@AB@@A@@@A@AA@@C@@BA@@@@@A@@@ABA@AB@@A@@@AA@@@@A@A@@A@AA@AA@@A@@AAAA@A@@@A@@@@A@A@A@@A@@@@AAA@AAAA@@AA@A@A@@B@@BB@@@@@BA@@B@BC@@B@@@@@@A@@AAA@A@@A@A@A@@@@B@@BB@@@B@@@@B@BB@@@@@B@B@B@@C@@@A@AC@@@AA@AA@@@A@C@@C@@@A@A@@@@@A@AB@@A@@@A@@@@@@@AAAA@@@AA@A@A@@A@@A@@AAA@@A@@@@@AAA@@@@C@@@AAAA@@BA@@@A@@@A@@@A@BAA@@@@A@A@@@A@@AA@B@@@@A@@@@@AAA@@@@@A@A@@@AA@@A@@@A@@AABAB@@A@@B@@@@A@@AAB@AAB@@@@@B@B@B@@AA@AAC@A@@A@@A@@AAAA@@@A@@A@@@@D@@@B@B@D@B@@@@@AACAA@@A@A@@BA@@@@B@@BDBBB@@B@@@@@DA@AAAA@AAAA@@A@@AA@@A@@A@@AB@@@@@@ADA@@BA@@@AAC@A@@@A@@B@@@@A@@@A@@@A@@@A@@@ABA@A@A@@@A@@@@A@@A@@ABA@AA@@@@A@A@@@@CAAAAC@@@@@C@A@@AA@AA@@AA@@A@@AA@@@@A@@AA@@@@@A@A@AA@@A@@AA@A@@AA@@A@A@A@@@@@A@AB@BA@@B@@AAA@ABADCBA@@AA@@@A@A@@@A@@@CBA@AB@@C@@@C@@@@@A@@@AA@@@@AC@@@A@@@AA@@A@AA@AAB@ACA@@A@A@@@@@@A@@AAA@A@@AAEA@@AA@@A@@@AACA@@@AA@AC@C@@A@@AA@@@A@@@E@@@A@@@@@AA@@@A@A@AA@@A@@AA@@@A@@@A@@@@@A@A@@BCB@B@@@@@B@@A@AB@AABA@A@@@A@@BAB@@@@A@@ACAA@@@@@A@@@A@@AA@@A@@A@A@@@@AA@A@@@A@@@C@@@@@A@@@@@A@@@A@@@@@A@@@A@@@ABABAB@@@@AB@B@@AB@@A@@A@@A@C@AA@@AB@BAAA@A@@AA@AB@@A@AAA@@@C@@AA@@@AA@@AAA@@@@@AAA@@AA@@@@@A@@@AA@@@B@@A@A@@B@BAB@BAB@@@@@@AA@@AAA@@A@@@@@A@@AA@@@@A@@@A@@@AACBA@A@@AA@AAA@A@@@A@@@A@@@@B@BA@@B@@@@@B@@@B@@@B@@@B@@@@@B@B@BB@@BBBB@@@B@@@B@@@@B@B@@AB@@@@@BA@@B@BB@@B@B@D@B@B@B@@BB@@@@BBAB@B@@@F@@@B@B@@@@@@A@@AA@A@@AA@@AAA@A@@AAAAA@@BB@@B@@@BB@A@@@@@@@AB@@@@@B@@@B@@@@@@@@@@@B@@AAA@@BAA@@A@@BA@@@@@A@@A@@A@@@@B@@@B@@@BA@@@A@A@AAA@AA@@@@@A@AA@AA@@@A@@@AA@@@AA@@@@@@@AA@C@@AA@@AA@@@@B@@@BA@@AA@AAAAA@AAA@@@A@@@@AA@@AA@@AAAA@@@@BA@@@AA@@A@@AAA@AABABA@@@AB@@@BA@A@@@AB@@@A@@A@@@A@A@@A@@AA@@A@A@@@@A@@A@@@@@@AA@@B@AA@@@@AA@@ACA@@@@A@@A@@@@@@AA@@@@A@@AC@A@@@A@@C@A@@@A@@@@A@@@@@AAAA@@A@@@@AA@A@A@@A@@A@@A@AA@@A@AB@@@@A@@@@@AB@@@@@A@@A@@@@AA@AA@@AA@@AA@A@@AAA@@BA@@@A@@BA@@@@AAA@@@@@AA@@@@AA@@@@AA@AA@@@@A@AA@A@@@A@A@A@@BA@@AA@A@A@@@A@@@AA@@A@@@A@@A@@@@BABAB@@A@@B@@@B@@A@@@AA@A@@B@@A@@@AB@@@BCB@BA@@@A@AB@@@@AA@@A@@EGEGGUKQCGIKCCA@CAE@E@C@CBEBADABCBAB@@C@A@@@GCAAA@C@A@@@C@A@AC@ACCCG@AAAACA@A@A@A@A@CAAAEEC@C@E@EBE@C@CA@@CAAAA@@@A@C@ABABABA@@B@F@FDD@BBD@DABADCBEFCFADA@ABCBC@A@C@EAC@A@EBCBAAC@ICE@A@C@GDCDC@A@@A@C@E@G@CA@@AC@EAC@GAEACAG@A@EAG@C@CBABCDCFCFA@@@@@@@@BA@@@A@A@A@@A@AAAAAA@A@A@AAAA@A@AAIACAC@@BADAB@@CB@@CB@@@@@B@@@B@@@BAAA@A@@AA@@@@BA@@B@@@B@@B@@D@@@@A@@ACAA@@@A@@@A@A@@A@A@CAEAACAAAC@AAA@@@@ABA@EDCDGFABAA@@AA@@AAA@ABA@@BC@C@A@CA@@AA@@AAA@ABA@@BA@C@@@@BA@@BA@A@AA@BA@A@ABAB@@A@AD@@AB@@CAA@A@@B@B@B@@@BDDBBDB@B@B@BA@@@A@C@@@E@E@@@A@@@@@@@AB@@@@B@@BBBBB@@@BBBAB@@ABA@A@A@A@@A@@@@A@@@@@@B@B@@AB@FAFA@@BABAB@@@B@@@@BDBFBB@B@B@@B@FDDBLDDBHDDBHDBB@@B@@B@@B@@@B@@@@@B@@@BBBDBBHHDFBD@BABBBBBF@BBB@BBBBB@DE@@B@@BADBDBDB@BB@BBD@B@@ABA@ADADA@A@@@A@@@@@@AB@@A@@@A@AAC@A@@@AA@AB@@BB@B@@A@A@@@@A@A@@@AAA@AA@@@@@@B@B@BBB@@@@A@@@A@@@@@AAC@A@@@A@@@@BB@B@FDBBDBBB@@@@@@A@AB@@@A@@@@AAAA@@@@A@AB@B@@B@@DBD@DAF@@EB@@@FA@@J@F@D@BABC@@BA@A@AB@B@JAFIT@BCJIDEBCBA@CDADA@@B@DAFABABA@C@CAAAA@@B@@BD@BBBB@DBBDD@@B@@@B@D@@BD@@JDB@BBB@@ADAB@B@@BA@@B@BB@B@B@@@B@B@B@@B@@@BCBA@@@A@AAA@A@@BB@BDBFB@BBD@@@BFDF@D@B@@BB@B@DBFBB@B@@DFDFAB@BABAFBBAB@@@@B@B@@ABAB@BABA@@B@@@@D@BCDCD@@@B@@@BB@B@@@@@B@@@B@@@@BAB@@@@BB@@@AB@@BB@@@@B@@@@FF@@B@BAB@BB@@B@B@@@@@BD@B@B@@@@A@A@A@A@@@A@@@@B@B@B@@B@@B@@CD@@A@@@AD@@@@@@@@A@@@A@@@@@@B@B@@BB@@@@@B@@A@@@@@ACAAA@@BA@A@A@@@A@A@A@@@@@@@@B@@AB@@A@@@@A@AA@A@@@@@A@@@@A@@BAA@@AA@@@AACA@@CBAB@BCF@BAB@B@@A@@@@@A@@@@@A@ABABADADABC@ABAB@@A@A@@A@@A@A@A@@@AA@@ABA@@@A@@@@@AA@@@@A@CBAB@@@@AAAA@BABCBAB@@CDA@@BDBBDBBB@@@BBB@B@BABA@@@A@@B@BA@B@@@@AB@@B@@@F@BA@@B@@B@@@B@@B@@B@@B@@@B@@@@BB@@@BB@A@@@@@@@@@@@@@@@@@A@@B@@@BA@@B@@@@@@@@@@B@B@@@@AB@@@B@@@@BBB@D@@@@B@B@@BBBBBBB@@BAD@@AB@@CBCBAD@BAB@@@@A@@ACCAAAA@AC@@AA@@BA@@@@@@@@@DB@B@@@@@B@@@B@BA@A@AB@@A@@B@B@@@B@@@@AA@@A@@@@@@@@B@@@@@BB@B@@@@B@@@@A@@@@@@BA@@@@@@BA@@B@@@BBA@@@A@@B@@@@@@@@@@BAB@@AB@@@B@@@@@@@@BA@@B@@@@@@@@BA@@@@B@@AF@@@@@@@@B@@@@@@@BC@@@A@@@A@@BA@@@@@@B@@B@B@BADA@@BA@@@@@@@AB@@@BA@@@@B@@@@@@AAAB@D@BAB@BB@@@@@B@F@B@BBB@@B@@@@ABC@C@@@CBA@@B@@@B@BA@A@@BA@@@A@CB@@AB@@AB@B@@@@@BBB@BB@ABAB@@@@@@BB@@@BABA@A@@B@BAB@B@@@@@B@@@@B@@@@@@B@BBB@@@BB@@@JA@@@@@@@BBB@B@@ABA@@B@@@D@@@@BB@B@@ABA@@B@@B@B@BDB@@@BAB@B@@@@@BB@@@B@@@@@@B@@@B@@@@@@@@@@B@@@@@@AB@@@@A@@@@A@@@@@@@@@@@@A@@@AB@@@@@B@B@B@BAB@B@@@@BD@@@@AB@@A@@@@@CC@@C@A@AB@@@BB@@@@B@@AB@B@BFH@@@@A@A@@BA@@B@D@@BBDBDB@@@@B@@A@@@@@AAAA@@A@@@@B@DB@@B@BA@B@@B@@@@@A@@B@@AB@@B@@@@@@@B@@A@@@@BB@@@@@BB@@@@@@@@@@BB@A@@@A@@B@@B@@@BB@@@D@@@B@@B@@A@@@@@@@BB@@@@A@@@@@@@@@B@@@B@@@AA@@@@BB@@@@@@@B@@@@@BA@@B@@D@FBD@@@@B@@@BB@@@@@B@@AB@@A@A@@@AB@@@BA@@BA@@B@@@B@@@@@@@BA@A@@B@@@B@BCBAF@@@@@B@B@@@@@BA@@@@BBB@@B@@@@@ABA@@@@BB@BBD@B@BBB@BBB@BB@@BBC@@@@@A@@@C@@B@@@@DBBB@B@@@D@BADBBBD@@@BAB@B@@BB@@@B@@@@A@AB@@@F@D@B@@D@BB@@AD@BA@EDA@C@ECA@@BCDA@C@@B@@BBBBAB@@EB@@@@@D@@@BB@@B@@FAB@@@@B@BA@CDCBCB@B@BA@@@@@CBA@@@AA@@A@@@@@@@ABCF@@AB@@A@CAE@A@@ACAA@A@@BA@@@BD@@ADA@@BB@B@@B@@@D@DB@@BB@D@DB@@@@B@BB@@B@BB@@@@@B@BA@@@@@@B@@@B@@@B@@@@@B@@@B@@@@AB@@ACA@@@ABAB@@AB@@@@BB@@@@@@@BB@@@@@@@@@B@BAB@BA@@@@B@@@@B@@@@AB@@A@@@@@@B@@@@@B@BA@@@ABA@@@@B@@AB@@@@@@AAA@A@@@@@A@@@@BB@@@@@@B@@@@A@@@@@@@@B@@BA@BB@@B@@@@B@@@@@D@@@@@@@@@B@B@@@B@B@@B@@@@@@AB@@@BA@@@@@@AA@@BA@@@CBA@@B@@@@A@A@@@@B"],encodeOffsets:[[121234,29430]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
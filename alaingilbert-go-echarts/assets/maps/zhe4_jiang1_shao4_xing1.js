@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var C=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("绍兴",{type:"FeatureCollection",features:[{type:"Feature",id:"330602",properties:{name:"越城区",cp:[120.582633,29.988244],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@EAA@@AA@@@@@AAA@A@@BAB@@@@A@@B@@ABC@AB@@@B@@@BA@@B@@A@@@@@A@AA@AA@@@@AABA@A@ABAC@@@A@@@BA@@A@@AA@@@@@D@@A@@@@AAA@@@@B@@@@@@A@@@@AB@@@@@@@@@A@@@A@AA@@A@@AAA@AA@AA@@AA@@BA@@A@@@@@A@@AA@A@@@A@EAAAA@ABA@@AC@@@AAA@A@@BA@AA@BA@ABA@A@@@A@@@A@@@@B@@A@A@A@@@A@A@@AA@@@CBC@A@@@@@@A@@@@@AA@@@@@@BA@@@AAA@@@AC@@@@ABA@@@CB@BA@@B@B@@ABA@@@@BA@@@@B@@A@A@@@BB@@B@@@@B@@@@@BAB@B@BAB@@@FA@@BA@@B@@CDA@A@@@AA@@AAA@AAA@@@@A@@A@AB@@@@AA@AA@A@@@AB@@ABA@@@@@@B@@A@A@@@CBA@@@AB@@@@@BB@@@@B@BDA@@@@BD@@@B@@@@@@@B@@@@BBBA@@B@@@B@@@B@@@A@ABA@@B@@@@@@BB@@@@@@@B@@B@B@@@AB@BA@@@@CA@@@@BA@@B@@@@@B@@@@@B@@B@@@@B@@@@@D@@B@@@@@@BBB@@BBBB@B@@@@BBBB@BD@@BB@@B@DBBBB@@@@@@B@BBAB@@@@@BB@@@A@ABBBB@B@@B@BBD@B@@@@B@@@@B@@@A@@@@BB@@@@A@@B@@B@B@@@A@@@@@@@B@@@B@@@@@@B@@B@@@@BBB@@A@@BB@@@B@@BA@@@@@@B@@@B@@B@@A@@B@@@B@@@@B@@B@@@B@@BB@B@@@A@@@@@@B@@@@@B@@@@@BB@A@@@B@@@@B@@A@@@@@@BB@@@@@A@@@@BA@@@@@A@@@@@@D@@@@ABB@@@@@B@@@A@AB@@A@@@@B@B@FBHA@EDA@@@@@@B@@@@AB@@@B@@AB@@@B@@A@A@A@@@@BA@@@@@@@C@@@@@@B@@@@@AA@@@@@@@@@@B@BB@@@@@@@@B@@@@B@@B@@@@B@@@@BA@@@@@@B@@A@BBA@@BA@ABB@@@B@B@AB@B@@@@@B@BB@@@B@ABA@@@@@@@A@@@@B@@A@AB@A@@@@AB@@@@A@BB@@@@@BB@@@@B@B@@@BB@@@@B@@AB@@A@@BA@@BA@@ACB@@AA@@AB@@@@@@AA@@A@@B@@AB@@A@@@A@AB@@AA@@@@@@AA@@AB@@@@@@AA@@@@AB@@AA@@@@@B@@@BA@@@@B@@A@@@@@@B@@@@@@@@BBA@@@@B@@@BA@ABA@@@@@A@@B@@A@BB@@@@A@AB@@A@@@@@@A@@A@@@A@@@AB@@A@AB@@ABA@@B@@@@AB@@B@@@A@@@@@@B@@@B@@BB@@@@B@@@@@@AB@@B@@BD@@@B@B@@@@BB@@BB@@@@BB@@B@B@@A@@BBBBBAB@BB@@@BB@@A@@BD@@@@A@@B@@@@@BBB@@ABA@@BB@@@ABBB@B@@@B@@@@@@BBB@@@B@B@BD@@@@IFB@AB@@BDBBGDBDDFB@AD@BBABDB@BF@@@@@B@@@BB@B@@@@@@B@@@@@BEBBDBA@BA@@DA@@B@@@@A@@@A@@@@B@@@@A@@@A@@@AB@@B@BB@BBB@B@BBB@BB@@BD@BFB@D@D@D@@H@BC@@BD@BD@B@@@@@@A@@@A@@@@@@B@@@@@B@@@@C@@@GB@B@@B@@@@@B@@BBA@BA@@@@@BBA@BBB@D@@B@@@BABB@F@BDDABBBA@@@@@@@@BBPEBBA@B@B@BA@@BBA@@B@@@@B@@@B@@@@@@@B@@A@@@@@@@@@@@@@A@@@@B@DBBA@A@@DABB@@@B@@B@DAD@B@@A@AAAD@@A@@@AB@AA@@@@BA@@B@@B@@B@@@B@@@@@BB@FHA@D@@B@@@AD@@B@@B@@@@@B@@B@@@@@B@@@@@@B@@@B@@@@@@@@@B@@@B@@A@A@@@A@A@@B@@B@@@A@@B@@@@@@B@DB@@BDB@@@@B@B@@B@@@@BB@@@B@@@@@@@AB@@@@@BBA@@B@@@@B@@@@@D@@@B@@@B@@@@BB@ABB@@B@D@B@@@@@A@@B@AA@@@@D@DAB@@D@B@@B@@A@@B@@@@@BB@@@@@B@BA@@BAB@@@B@BAB@B@@AB@@A@@B@@@BB@@@@BB@@BAB@@@BBB@@@D@B@@@DA@AB@@@BAB@@@@AB@@A@@AA@@BA@@@@B@@@BB@@B@B@@BB@@@B@B@@@B@@@B@LD@BB@BC@@B@LDFBHDHBAA@A@A@ABCBA@CBA@@DAHCFAHCDADAB@BCDCBAJGDCDCFCBABADADABAB@DABAB@BA@@DAD@BAD@HA@@DA@@B@HAF@B@DAB@D@D@J@D@D@B@@@@C@A@@@A@@@C@@D@@@@AD@@@BA@@B@@@@A@@A@@@@A@@@A@@B@AAB@AA@@B@AA@AB@@@@@DA@@@@@@@ABB@@@AA@@@@@ACB@ACB@@A@@@@@A@A@@B@AAB@@@@BB@B@@A@@@A@@AA@ADA@@@AAA@AAAAAA@CA@AA@AAA@AAAAC@@@AI@CAGDBB@B@@AB@DBFBBB@B@@D@@@@A@@B@AA@A@@B@@@B@@AF@@@@@@A@A@@B@@@BB@AB@@BD@@B@@DCDC@A@@A@A@@@@A@@@A@@@AB@@@B@@A@A@@@@@AAB@AB@@@@A@@@@BA@@@@@@B@AABA@@BB@ABB@@@@@A@@B@@@@A@@@A@@BA@@@@@@AA@ABA@@B@B@ACDAB@D@@AD@HA@@B@@@@@B@@@@@F@B@@@@E@A@@A@@AB@@@A@@AA@@A@AAB@@@A@@A@BAA@@@B@AC@@AA@@C@AB@@@A@@@CBAA@@A@@A@ACAC@@@@B@BA@@D@@@@@@B@@@@@@@@@B@@@@B@@@@@@A@@@@@@@A@@@@AA@@B@D@@@@B@@BA@@BA@@@@@B@@FABA@@@@BAAA@@@@CABAA@AA@@@@@@@A@@@@B@@@@@@@BA@@AAB@@@@@@@AA@@@@BC@@BAB@@@@@BA@@A@@AA@@A@@A@@AA@@@@@@A@A@@@A@@@@@AA@@@@@B@@A@@@@@@A@@@@A@@AB@@@A@@@@@@AA@@@@@@@A@@B@@@B@@@C@@@@@A@@@@@@@@B@@@@AB@@@@A@@@@@AA@A@@@@B@BB@@@AB@@@@@@@@@@@@AA@ABA@@AF@@@@AAA@@A@@@@@B@@A@@@@C@@B@@@@BBA@A@AB@@@@AB@AAA@@@@@AB@@@BAB@@A@@AB@@AAA@@A@A@AB@@@B@@@AC@A@@A@@A@@BA@@BA@AB@@ABA@@B@@AB@B@BAB@@@CA@@A@AA@@@A@@@ABA@@AC@@B@@@AAA@AA@@@A@@@C@@AA@@@@@A@@B@@A@@EACE@@B@B@@@@@@A@AB@@AB@@@B@@@BBBB@A@A@@AAA@@A@@BB@A@@@@A@@@@@@A@@@A@@@A@@@A@@@@@BB@@@@B@A@@@A@A@@@@@A@AB@@A@@@A@@A@A@@@@@@A@@A@A@AA@@@@@CA@@AA@A@@@CAA@@A@AA@C@C@@@ABA@@B@@@AA@AA@@@ADA@@@@A@ABAAAA@@@ABA@@BAB@BA@@@@BA@@@A@A@@AA@AB@@A@@@A@A@A@@@@BA@@@@AAAA@@@AAA@@A@A@AA@@AA@@BABB@@@@@@@@@A@@@@@@@A@@@@BB@@BAB@@@A@A@AAABAA@@@BA@@A@@A@@@A@@@BA@@AA@@@@A@@@@C@@@A@AA@@BA@@D@@@BB@@@BB@B@@@@A@@@A@@@A@A@@@AAA@@@A@@@@@AAAA@ACAA@AA@@A@AB@@AA@@A@AA@@@A@AA@@@AA@@E@@@ABA@A@@@A@@@A@@@@@AD@B@@A@A@@B@@@@DBAB@@AAAA@@ABA@A@A@@B@@@@A@ABA@ABBB@BA@AB@BA@@BC@@B@BA@BB@@AD@@A@@@A@@BAAA@@@ABBDBB@@@@@@@BABA@@@ADAB@BA@@@AD@@BB@@@BA@@B@@@@C@@B@@@B@@BB@@@B@@A@@@@@@@@B@@AB@@@B@B@B@@@BABA@AD@@ABAB@B@@@B@BA@@BAB@BA@@@A@@@A@@@@@@B@BA@@@@B@B@@@@@@@@B@BB@@B@@B@B@@@B@@AB@@A@A@@@@BABABA@@BA@ABAB@@ABC@A@@@@B@B@@@AA@@B@@@B@@@@B@@@@@A@@@@@BB@@A@@AA@AA@@A@@BA@A@@@@@@@@@A@@@@@@@@@AB@@AAAB@@@@@@@@@@A@@@A@@@@@@B@@@@@BA@@@@@A@@BB@BB@A@@DD@@@B@BA@@BBB@@@@@@@BB@@@@BAA@@@B@@@@@@@B@@@@AB@AA@@BA@@@@AB@@A@@@@AB@@AB@@A@AB@@A@@@@@A@@B@@AA@@@AA@C@@@A@@@A@@@@@ABA@@@A@AAAA@@A@@@@BA@A@A@@@@@@AAA@A@@@A@@BE@A@@BA@A@@@@@@A@@@@@B@BA@@@@@@AAA@@@@@AB@@A@@B@@ADADC@AA@BA@@@@@A@CB@@@AA@@A@@A@C@@@E@AA@@AA@AC@A@@@@@AA@@ABA@@A@@@@@A@@@A@@BA@@@A@AA@@A@A@A@@BA@@BCAA@A@AA@@AAA@@AAA@@A@@BA@@B@@A@@A@A@@A@@@@A@A@CAAC@@AA"],encodeOffsets:[[123499,30638]]}},{type:"Feature",id:"330604",properties:{name:"上虞区",cp:[120.868122,30.03312],childNum:1},geometry:{type:"Polygon",coordinates:["@@@A@A@@AACA@@@@@BA@@B@@A@@BABAB@@@@C@AA@@AB@@@@@@A@@@@@@@A@@@@@A@@@@@A@@@A@C@@A@AA@@@AB@A@@@A@@A@@B@@AA@@@A@@@A@@@@B@@A@@@AB@@@@@BAB@@A@@B@@@B@BA@@@@CA@@@C@@@@@@@@@A@@@@B@B@@@@@BA@A@@BADC@@AA@@@ABA@A@@@@@@@@D@@@@@@@AAAA@@AAA@@@A@CB@@@@@@A@AB@@B@@B@@AB@B@@@@@@A@@A@@@B@A@@@@CA@A@@@@A@@@A@AAA@A@C@@@A@AB@@A@A@@@@ADA@A@@BBB@@@@@@@@@B@@@@@@@@@@@@@B@@A@@B@@@@@@@A@@@B@@AB@@@@@A@@@A@@@C@@@A@AB@@A@AB@@@AC@@@@A@A@A@AAA@A@@AA@AA@@@A@CAA@@@@B@@@BAB@@@@@@AA@AB@@A@@@@A@A@@@AA@AA@A@A@ABAB@@@@@BB@B@B@BB@@BB@B@B@@@BAB@@@BA@A@@@A@A@@AA@@@A@AB@@@@ABABA@A@A@@@AB@@C@A@GB@@@A@AA@@AA@@A@@@A@@AACA@@A@@AA@@AA@AAA@A@@@A@A@AAA@ABEAA@C@A@A@A@@BCB@BA@@@@B@B@B@B@@@B@BA@@BA@@@@B@@A@ABAB@B@@@@EA@AC@A@@@@A@@A@@@@@@A@@@AA@@@A@@@@@@@A@A@AB@@BB@BA@@@@@@@AAAAA@@A@B@@A@BA@AE@@@@C@@AA@@@A@@AAAA@@A@@@A@@A@AA@A@AA@@ADBB@B@BAAA@A@A@@@@B@BDB@@BB@BA@A@A@A@@@CA@BAB@ACA@@AA@@A@@@A@@AA@A@AAAA@@@@@ABACA@@@@A@@@AB@B@BA@@@CA@@A@@B@@@BAB@B@@DBB@BB@@@@@BABCHCDABAB@BBBCBABA@@BA@CBA@@@A@A@AAAAAA@@A@@A@A@AA@@C@@B@@@@BB@@@B@@@@AB@@@@AB@B@@AB@@A@@B@@@@@AAC@@@A@@@@AB@A@@@@@A@EBA@A@@@@AABAAC@A@A@A@A@A@EBAA@@@BA@@@AB@@ABCDADAB@@@BA@C@A@@@A@@BB@CBA@@@AB@@AB@@BB@@BAB@@@@@@@BAB@@B@@@@BA@AD@B@BB@B@@FFA@A@@AA@A@@@@@@B@B@B@@B@D@B@B@BBB@B@B@@BB@@B@@@B@B@@ABA@ABA@A@@@A@@@A@@AA@@A@@@@A@@@AA@@ABA@A@A@@F@B@D@F@B@BAHA@A@AAA@@B@B@@@@@@@@CB@@@@@B@B@BBB@BA@@@@@A@@B@@@@@@B@@@@BA@@BCB@@AB@B@@B@A@AB@B@@@@@@A@@@AB@B@@@@A@@B@@@BABAB@@@BB@@@BB@@@B@@A@B@@@B@@F@@@A@@B@@@@B@B@@BBA@A@A@@@@@A@@@@@AB@@@B@@@@@@AB@@AB@B@@@B@@AB@@B@B@ADA@@B@B@B@BBBBBDD@BBB@@BBA@AAA@A@A@@@A@AA@@@@CAA@@@A@A@@@A@ABA@A@CAA@AB@@A@AAA@@BA@A@@@@@A@@@@@A@A@C@@@AB@@A@A@A@AA@@A@@AA@@@A@A@AA@@A@@@A@@AAA@@@@@@AAA@@@@AA@@A@@A@A@AAA@ABA@@AA@@ACAA@@@AC@@A@@@@A@@AA@@AAA@AAA@@@A@@AA@A@@@AAA@A@@@A@@A@@A@C@A@E@ABA@@@@@AA@@A@@@@@AA@@@B@@@BA@@AE@@@@@BA@@B@BAA@AA@@A@AB@@A@CBA@@BAA@BA@@ABC@A@@@ABABA@@B@B@BBBA@@@@@AA@@@A@AA@@@A@A@@B@@ABA@AA@@A@AA@C@A@@@@@@A@@@@@BA@@@@@@BA@@A@@@@@@@@@@@B@@A@AB@@@A@@A@AB@@AAA@@A@@@A@@A@A@A@@BA@@@@D@B@@@B@B@@@BA@A@@B@D@@A@@@A@@B@@@@@@AA@@@@AB@@@B@@@@@@BB@B@B@@A@@@@B@@@B@@BB@@@B@@BB@B@@B@@@BD@@A@@BBBBBDBB@D@@@@B@@@@AB@@AB@@@BAA@B@B@@AB@@B@@@CB@@@@@@@@@@@B@@@BBABB@B@@@@AB@@@@@@@B@B@@BB@B@@@B@@A@@@A@@@@@BBBB@@@BA@@@AA@@A@A@@B@@B@@@@B@@AA@BB@@@@@BB@@@@B@@@@B@@@@@B@BA@@@B@@@@@AB@@B@@BA@CB@@@@D@@@@@@@@@AB@@@@@@@@@B@@@@@@DDA@@AA@@B@@A@@@@@@BABBBA@@B@@AB@@@@ABBB@@@@@B@@@B@BABB@@@B@B@@@@A@@BBBAAB@@@@@B@@@@@@@B@@@AA@@@@@@BA@A@@BA@@@AB@BAB@@AB@@@@@DA@@@CBA@@@@@@B@B@@BBB@@@BB@@BAB@@@BBB@DB@BBBBB@@@@B@@@B@BB@@B@B@@@B@@@B@@@@@@AAA@@A@@A@@@CB@@AB@@B@B@@@D@@B@@@@@BB@@AB@@B@@@B@@@@BB@@A@@BBBABBB@B@@@A@AB@@AA@@@@@B@@@@@@@B@@@@@@@@AAAB@@BB@@BBB@B@@@BB@B@@BBBB@@@@AB@@@@@B@B@B@@@BA@@BBB@@@B@B@@AB@@@@ABA@AB@@AB@B@@BBBBBAB@@@@@CB@B@@BBB@@B@@@AB@BA@@D@D@B@@B@BB@DB@@B@B@@BB@@D@@@@BBB@B@@@@B@@@@B@B@@@@B@@@BA@@B@B@@@@@B@B@@@B@A@@A@@A@@@@@B@@@B@@@B@@@B@@@@B@@@@@@BAA@@@BB@BB@@@B@BAAAA@@A@@@A@@BA@@B@B@@@@A@A@@@DFFB@@@BA@@@@B@@@@BB@@@D@@@B@@BBB@BB@@A@@@BD@@AB@B@@@B@@BBB@@@DB@@A@ABA@A@@BA@@@AB@BA@@BAB@@AB@@@BB@@@@BBD@@A@@@A@@B@B@BB@BB@@BA@@@BA@AB@@A@@B@@@@BB@BBA@@@@BAB@B@AA@@@@@AD@@@@@@BA@@@@@B@@@BB@B@@E@@BB@BAB@@B@@@@@@@@@@A@@B@@AAA@@@@@@BBB@@@@B@@@@@BA@@@@@A@@@@@@B@@@@@D@@@A@@@A@@@@B@@@@@@BB@@@@@@@B@@BA@@@B@@B@@@@@@@@BA@@@@@B@@B@@@@@B@@@B@B@@@@B@@BB@@@@BB@@BB@@@AB@@@@A@AB@@AD@@@@BB@@@@@@A@BB@@AB@@@@@@A@@@@@@B@@@@@@BBB@ABDB@@@@BBAB@@@@ABEB@@@A@@@@AB@@AB@@@A@@C@A@@@BB@@@@@B@@@@@@@B@@@@A@@@@@@A@@@@@@@@@A@@@@C@@@ABA@@@@@BDBDB@@@@BB@AB@D@@@B@@BAD@@@BB@@BDA@@@B@ABB@@@@B@@BA@B@BB@@BB@@@A@@BB@@@@B@F@@A@E@@@@@A@@@@@A@@@GBC@@BC@A@CBBDA@A@@@AB@BBB@@@@@@AB@@@B@@@B@@A@@@@B@@@@AA@BAA@@ABBBA@@@@@@@AB@@@@@B@@A@@BBA@B@@@@@B@BA@@@A@@B@@@B@@@B@@B@B@@@@BCDCD@@@AC@@AA@@BAA@@A@@@@B@B@@@@E@@BA@@@A@@@@BBBA@@@@B@@C@@@@AAAEACAA@@BA@A@CABH@DBJ@@D@BBBBB@BBB@@BDBB@BBBB@BBB@B@@CB@BBB@@@B@@@BA@A@@A@@A@BBA@@@@B@B@@@@@BA@BDA@BD@@@@B@@B@@AA@B@@@@@@CB@@@@A@@BBBA@@@BBA@BBA@@@@B@@@B@@B@@@@B@@A@@@AB@@C@@B@@C@@@@D@@@B@@@B@D@@A@C@C@I@C@C@A@CBA@E@GBA@@@CB@@GBC@ABC@CB@@ABA@ABCBA@ABCBCBABABEDCDCDIHABCDADA@CBCBGDEBGDCB@@AB@DABAD@B@B@BBBB@@BDDDBBBFF@@DDBBDB@BFDFFHHBBFFDDHJBBBBBB@BRTDDTZRTRL@BB@HFXNDBFDLH@B@@PTBB~z^Ar@nJjJTFPcd`DKDKJMBIAA@@BA@@BKB@@CBI@EDM@A@A@A@@AC@@@@@@BA@BB@D@@AB@@@@@@C@A@@@AA@@AA@A@@@@AA@@A@A@@@CA@A@@A@@A@A@BAB@@A@A@A@AB@B@@@@AD@@@@@B@@@@BDAB@@A@A@@@A@@@@@@BA@@@@@@@@@A@@@@B@@A@@@A@@AC@A@@@@@@@@@@D@@A@A@@@@@@B@BA@@A@BAA@@@@@@ABA@@@@@AD@BC@A@@B@FAFA@@@B@BB@@@@BB@@@B@B@@@@@@@B@@@B@@@B@@@@@BA@@B@DC@@@@BCFIB@@C@A@ABADAB@@@@@@A@A@A@@ACA@@@CC@@@A@@@@@@@A@@B@@@B@@A@@@@B@B@BBB@@@B@BCC@AAA@C@@@@@BA@@@AD@@@HBABF@@CBB@A@@BA@@BABC@ADDB@B@DAB@BC@@@AA@BAA@@@@AIC@@@A@@B@D@@AB@B@@@@@@@B@@@@@@@@A@@@AA@AAA@@@AC@@@A@A@AA@@@@@A@@@@A@A@@@A@AB@@A@@@@@A@@@ABCA@@@@AAA@A@@@@@A@@D@BA@@@@@ABA@@A@@A@@BAB@@@B@BA@A@@@@@ABAB@@A@@@@@@@AB@@@BB@@B@@@BAB@@@BCB@@AB@B@@BB@B@B@BA@A@@@ABAB@@@B@B@@AB@@@@@@@@@B@B@B@@@@@BAAA@@BABABBB@DABB@B@@@BB@@BB@@@@@@AB@@A@@D@JB@@BABDBBB@BB@@B@BCAAAEA@@A@@@@BA@@@@AC@@CC@@@ABADEBA@BBBB@B@BA@@@@@@BA@@@@B@@B@@@B@@B@BB@@BD@@BBDDB@@B@ADAD@B@@@@@@AB@@ABB@@@A@@BA@B@BB@BB@@BBD@B@@@@@@@B@B@B@@@AA@@B@@@@@@@@A@@@@BA@@B@@@BBB@@AB@@AD@@@@@B@@A@@BA@@BABA@@B@@AB@@@B@@@@A@AB@@C@A@@@@@A@@B@BA@@@AB@B@BA@@B@B@@@B@B@@@BA@A@A@AA@@@@AA@@@@@@A@@@A@@@AA@A@@A@@@@AC@A@@BA@@@C@@A@@AA@AAAAAAA@A@A@A@A@A@@@AAA@@@@AA@@A@@AA@A@@@@@A@@@@A@C@AA@@CC@@AA@@AA@@@A@@@A@A@@A@AA@@@BA@@@AB@@ABA@@@@@ABA@AAA@CAAAA@@B@@@BA@@@@@@A@@@@A@@@@AA@@@@A@@A@@AA@@ABA@@@AA@@AA@@@AA@@@A@@@@@C@@@A@AAA@@AA@A@@@A@A@AAA@@@@@AA@BA@@@@@A@@@@@A@A@AA@@A@@@@ACAA@@@@BAAA@@@A@@A@A@@@A@@A@@@@A@A@@AA@@@@A@AA@@@@AA@@A@@@A@@A@@AA@@A@@@@B@@@DCBC@A@@A@@AAA@AA@AAA@AA@@@@@@@@AAA@A@@@@@@@@@A@@@@@@@AA@@A@@@@@@BA@@@@@A@@B@@@@A@@@@@@@@@@BA@@A@@AA@@@@AAAA@@@@BA@@@@B@DA@A@@@AB@@@@@@A@@A@@@@@AAA@@@@B@@BB@@C@@@@@@AA@@B@@A@@C@@@@@@@@@A@@B@@A@@BAB@@A@ABAB@@@@@@@@A@@A@EADC@@@A@@@@B@@A@@BAB@DABB@@BAB@@@B@@AB@@A@@@@@A@@@A@@@AB@@@@@@@B@@AB@BB@@B@B@@@@@@A@@@@AC@@@A@@@AD@@ABA@ABAB@@@@A@@@@@C@ABA@AB@B@@@B@B@@BBA@@@@BADAB@B@@AB@@@B@@ABC@@@@BB@@@@@A@A@A@C@@@@@AB@@@B@@@@@B@@A@A@@BA@@B@@@B@@AB@@@@@B@@@@B@@BB@B@@@@BB@@BB@@B@@BB@@BB@@B@@BB@@@@@@B@@ABA@@@ABCBA@@BA@@B@BC@AB@@@B@B@B@B@@@B@B@B@@@B@@@BB@@B@@@BABAB@BA@@@A@@BA@AB@D@B@BA@@B@BBBBB@@@B@@AB@BA@@FAB@BA@A@@@@AA@@@A@@@@@A@@AA@@@AB@@@@ABABA@@A@@A@AA@@@AA@A@@@@BA@@@AB@@AA@@A@@@@@@AAA@A@A@AA@@@AA@@@AA@@@@@A@@ACB@@A@A@@@AAA@A@A@@@@@A@@B@@@B@@@D@B@B@BA@@@A@AB@@@B@B@@@@@@A@@B@AABAAABABA@@C@AAA@@A@A@@@AA@@@@@@@A@@@@@A@@@@AAB@@C@@@@AA@@ABA@@A@@A@B@@A@A@@@A@@AAAA@A@@@@@@AB@@@@@B@@@@@@AA@@@@@BA@@@A@@@A@@A@AAA@AA@@AA@@@BA@@@@AA@@AA@A@C@A@@BAC@@@@@@BAB@@@@@@@AC@@@A@A@@@A@@BAAAA@@AA@@A@A@@A@CB@A@@A@@@@@AB@@@@A@@A@@@AA@@@@@@@@@@ACAA@@@A@A@@@@@@@AA@@@@@@@@@ABA@A@@@@A@AAAA@@ABA@@@AB@@AA@@A@@A@@@@@A@@@@@A@@@@@@@A@@@A@@@@A@AA@@A@@BA@@@@@A@@@AB@@A@@@BDA@CAA@@@@BBB@@ABA@@BAB@@@BA@@@@@A@@AA@"],encodeOffsets:[[123951,30482]]}},{type:"Feature",id:"330624",properties:{name:"新昌县",cp:[120.903866,29.499831],childNum:1},geometry:{type:"Polygon",coordinates:["@@AAA@@@A@@A@@C@@A@@@AC@@AA@AA@AA@@AA@A@@@A@A@A@A@@@@@A@AA@@A@@AAB@AA@@@A@@@A@@@@@EB@@A@C@@DB@ABBB@D@@@B@@@@@B@B@@A@@BBBBA@BA@@BDA@B@@@@@@@@A@@@B@@BA@@@@AA@@BBBB@@@B@@B@@@@@B@@@@@B@@@B@@@@@@CBBBD@ABA@AB@@A@@BA@@D@@B@B@B@@@AB@@@@@B@@BB@@AB@@@BAD@B@@ABC@ABBD@D@BBB@BB@B@BDBB@@A@ABA@@@ABA@@BBB@@@@B@@@BA@A@@BBBB@@BB@@@@@@@BBB@BB@@B@@@@@B@@@@@B@@A@@AA@A@A@A@@@AA@@@@AB@@AA@@A@A@A@@@@B@@A@@@A@@@@BCA@@A@C@@BC@AA@@C@@@A@@@@B@@B@B@B@@@@B@@@@@B@@@@A@@A@@@@@@@@A@@@@@@B@@B@@@@@@B@@@AD@B@B@@@@BA@@@AB@@A@@@@BBA@B@@@@@@@AB@@@@BBB@@BA@@BB@@A@@@@@@B@@@@@@@B@@@@@@A@@@A@@@@@B@B@@B@@A@A@A@@@@@@BBB@@BB@B@BBBB@@@@BB@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@AA@@@@@B@BA@@@A@A@@@@BABB@AB@@@B@BBBA@@@@@B@@A@@@A@@BA@@BAD@@@@@@B@@BB@@BB@@@@B@@@B@@@@@@B@@@@@@BABBB@@@B@@A@@@A@@@@@AB@@@B@@@BA@@@@@BB@@AB@@@@@@@A@BAA@@@@ABC@@@@@@@@@BBB@@@A@AB@@@B@@@@A@@@BB@@@@@@A@@@@@A@@@@@@@AB@@A@@@@@A@A@@@@B@@A@A@@@BBA@@@A@A@@@AA@BCB@A@@@@@@@@ABAB@B@B@@A@@@AB@@@A@@@A@A@@@@@@@A@@@A@@@AA@@@C@@AA@AA@A@@A@A@@@@A@@@@BA@@@@@A@@@@A@@BA@C@@DA@@@A@@AA@@@CA@@@BA@@@@@BB@B@B@@A@AA@@A@@BAB@@BB@@@@CA@@@@A@@D@@@@A@@AAA@@E@@A@@@A@AA@@AAAA@EB@@@A@@BA@@AA@@@@@AAA@@A@@AA@@B@@@B@@A@@@@@@AA@@A@A@@@@@AA@@BAAA@@D@@A@AA@@AB@@@B@D@B@@@B@@@@AB@@BBA@@B@@A@@A@@@BA@@BAB@@AB@B@@@@@@@B@B@B@B@@@@A@@@@CA@@@@@@@@A@@@@A@@@@@@@@@@@@B@@@BABA@@@@A@A@@@@A@@B@@@B@@@@@B@@AB@@BB@@@@B@@@@@@B@BBB@A@A@A@@B@BD@@@B@DB@@BBB@@@@@B@@@BB@@@@DBB@B@B@B@@@@ABCB@B@@@B@@@@@@B@BA@@B@CB@B@@@B@@@@A@@B@B@@B@@B@@BB@@@@@@@B@@AB@BA@AB@B@@@BBB@@@@@@AB@B@B@@B@BB@@@BFB@@D@@@B@BEBB@@@@BAB@@BB@B@@@DB@BB@BD@@@B@B@B@@@B@@BB@@B@@@B@@@@D@BB@@B@@@@BBBB@BB@@B@@BB@@BBA@@BBBB@B@B@@BB@@BBB@BBB@@@BAB@B@BA@@@@B@B@@B@@BB@@BD@@BBB@@@@BB@B@@@BAB@BBB@@@BBB@@BB@@@BABAB@BB@@BB@B@BBDDB@BB@@AB@@@@AB@BA@@@@BBBBB@BAB@@BBABBBB@@@A@@B@B@@@B@@BD@@@@@BB@BB@@@B@BA@@BBB@@AB@@BB@@@B@@B@@B@@B@@@@@@@@@@@@@AA@@@@@@B@@@@BB@A@B@B@@A@@@B@@@ABB@A@@B@@@@B@@@@@@B@@@@@@A@A@@@@A@@@@@@@@@AAA@@@@@@@@@@@@@AA@@@@B@@@@@BB@@BA@B@@B@@@@@BA@@@@@A@A@@@@@A@@@@@@@@@A@A@@@@@A@@A@@@@@@ABB@@@@BB@B@B@B@B@B@@@BAB@@BB@@@@@B@@@@@B@@@BB@@@@B@@@@@@@@@@@B@@A@@B@@B@B@A@@@@@@BA@@@@B@@@@A@@@@@A@@@D@@@BB@@@@A@@@@BBA@@B@@B@@@@@@B@@BA@B@@B@@@@@AB@@A@@B@@@@@B@@@@@B@@B@@@A@@@@BA@@B@@@B@B@@@@A@A@@BCAABCB@BB@@B@B@@@B@@@B@@@ABB@@@@BB@@BBB@@@B@@@@ABB@@@@@BB@B@B@BBB@B@B@@AB@BAB@B@@@D@@BD@B@B@B@@ABB@@@@@@BA@A@@@@B@B@B@@BB@@B@BD@@BDBB@B@BBBBD@@B@B@@D@@BB@@B@@@B@DB@B@@@B@B@B@@@BAB@BB@FB@B@B@@A@@@A@C@CAC@C@G@A@@B@D@B@B@B@BDB@@FD@@B@@@BBB@@@@BB@@@@@DB@@@@@@BB@BB@@@@@B@@@@AB@@@BB@@@@@@@@BB@@@@B@@B@@@@D@BB@@@@BBB@@@BB@@B@@AB@B@B@@BBABB@@@@@ABA@@BB@@@@BBB@BB@@B@BAB@B@@A@@B@@AB@@@@@@@B@@@@B@@@B@@A@@@@@@B@@@@@B@@@B@@AA@@@B@@ABB@@@BB@@BA@@B@@BB@@BB@@A@@@A@@B@B@B@@@BBB@@@@B@@@@@BBBB@@BB@@@@B@@DDLH@B@@@B@@B@@@BB@@@@@@@@BB@@AB@@BBBB@DF@D@B@BB@@@@@B@@@D@@@@BBBBB@BBDBB@@A@@@@@A@@@@BABA@@@@D@BBBABAAABA@@B@B@BBD@B@D@@@@@B@@@@@@B@@B@B@@@BB@@A@@@@@@BB@@@@@B@@B@@BAD@@@@@@A@@D@@@@@@@B@@@BA@@B@@@BABA@@@A@A@@BA@@@B@@@@B@@@D@B@@@@@B@@BB@@@BBA@@@B@@@@@BA@@B@@ABBB@B@BB@@BB@@@@@BB@BDB@B@@@@B@@@BB@@@B@@@@B@@B@B@@@B@@@@BAB@@@@@B@@B@@A@@@B@@@B@@@B@@@BA@@BA@@B@@@@BD@@@BB@B@@@B@@@@@B@@@B@@@B@@@B@B@@@@@B@@BB@@BB@@B@@B@@@B@@B@@@@DA@@BBB@B@@@B@AA@@@@@@B@BA@@B@B@B@@@F@B@@AB@@A@@B@@A@@FCBA@@BAB@BB@@BB@AB@@@B@@A@@B@@@BA@@BA@A@@B@@AB@@@BABA@@BC@@BBB@@@@@AJAFABAB@@@B@B@@@B@B@@BCB@@@B@@@@@BA@@@A@@@A@@A@@@@@A@@AB@@@@A@@@@BB@@@@@@B@@@BA@BBA@BB@@@B@AB@@@@A@@A@@@@A@@B@@@B@@@@AB@@@B@@B@@@@@@A@@@@@@B@@@@@@A@@@@@@@@@AB@B@@@@D@@@B@@@@@@D@@@B@@@@B@B@@@@DA@@B@@@BA@@@A@@AA@@@AB@@BB@@@@@B@@@B@@A@A@@BA@@B@@@BB@@@@BA@@@@BA@@DABA@@@A@A@@@@B@B@@@@A@@B@B@@@B@B@BB@@BBB@BB@@@@B@@@BA@@D@@@@B@@@B@B@B@BAB@BA@@@@BBB@B@@@@@B@B@B@@BB@@HA@@BA@@B@BA@@B@@@@@B@@AB@@@@@@B@@BB@@@A@@@AB@@@@@BA@@@ADA@A@@@C@A@@AA@@@AB@BABBB@@@@A@@@A@@AA@@@@@@B@@B@@@@@B@@FB@@BABC@@B@B@@@B@B@DAB@D@B@BAB@BB@@@@@@@A@@BAB@@@@@@A@@@A@@@@BA@@@A@@@A@@@AB@@@@ABA@@@@@AA@@@A@A@@@@@A@@@@@@@A@A@@@ACA@AA@@AA@A@@@@B@BAB@@A@@@@@A@@@A@@@AA@@@@@@@@AB@@@B@@AB@@@B@@@DBB@@@@@@B@@B@B@@@BB@@B@BAB@BA@@BA@@BB@@D@@@@BB@BAB@@AB@@AB@@@@@B@@@B@BBB@B@@@B@B@BABAB@B@B@@@BA@@@A@@B@BB@@B@BB@BB@@@BBD@B@@A@@B@@@BBBB@A@@BA@@@AB@@@B@@@B@B@@@B@BAB@@@BB@@@@B@@@@@B@@@@A@@B@@@B@B@B@@@@@@A@@@@@@@A@@@@@@B@@B@@@@@@@@@B@@BA@@DCB@@@B@@@B@B@B@@@@@@BB@@@B@@A@@@B@B@@B@@B@@@B@@B@@@@B@@@BBB@@BB@BB@BB@@DBB@@@@BA@@BB@@BA@@B@@BBBB@@@@@@@D@D@@@B@@B@@B@@BB@@B@@BB@B@@B@B@@@B@@@B@@BB@@AB@@@@@@@B@@BBAB@B@@@B@B@B@@BB@@BBB@BBBAB@BABAB@@@B@@@@A@@B@@@D@BBFBFBBBB@@B@B@@@B@@B@B@@@@ABA@A@@B@BA@@BA@@BC@@B@B@B@@A@@BB@@BAAA@A@@BA@A@@BC@@B@@@@@@@B@@BB@@A@@BBBA@@@@B@BB@@B@@@B@@BB@@@BA@@B@@@FABA@@BA@@@@BA@@@@@@BBB@@BDA@A@@B@@AB@BABBB@@@B@@@B@@@BA@@A@@@@@BA@@@A@@@@BB@AB@B@B@@@D@@BB@@@AB@B@@DBB@@@@@@BB@@@B@@@@@B@@AB@@@BABA@@B@B@@@B@@@@@@B@@BBB@B@@@B@BB@A@@B@@@@@@A@@B@B@BA@@B@BABABBBA@@B@@@@B@@A@@@A@@@@@@@@B@@B@@@BA@@BA@@BA@@@@A@@A@@A@@@@@B@@@@@A@@@AA@@BA@@B@@B@@D@B@A@B@@A@@@@@@@@B@BB@@BA@@@@@AB@@A@@@@@A@@BB@@B@@AD@@BA@@B@@B@BBBA@B@@@B@@@@@BB@@BB@BB@@B@@@@@BB@@@@@@B@@AB@A@@@AA@A@A@@AA@@@A@@AAB@@AB@@@@@@A@@B@@A@@@@@A@A@A@@BA@@@@B@BA@@@AB@B@@AB@@CA@AA@@@@@ACAAA@@@A@@AAA@@A@A@@@@@A@@A@@@@AA@@@@A@@@A@@@A@@@A@@@@A@@@@A@@A@@@A@A@@@AB@@AAA@@@A@@A@@@A@@@@@@@@@AB@@@B@@@B@@AA@@@@ABA@@B@FA@@BAB@@AB@B@BA@@@@@AB@@A@@BA@@@@@A@@@@@@@A@@A@A@@@@@AA@@@@@@@A@@AA@@@AAA@@A@@@A@@@@@A@@@@A@@A@@@@A@@A@@A@@B@@A@@A@@@@@@BA@@AA@A@BC@A@@B@@@AA@A@@@@@AB@@BB@@@@@@@@A@@@@AA@@@@@@@AB@@A@@@A@@@@AAB@@A@A@@@@@@AA@AAA@@A@AA@@B@@AA@@A@@@C@A@@BA@A@@@@@A@@@A@@A@@A@@@@DA@A@@@AD@@@@A@@B@BBB@B@@@B@@@B@AAB@@AB@@A@AAA@@@@@@A@AA@B@@AA@@@@@@@AA@@A@A@@AAA@AA@@@@ABA@@BAA@@AAC@@@A@@@A@@A@@@@@@@@@A@@@A@@B@@AB@BA@@A@@@@@B@@AB@@@@A@@@@@@@@DC@@@A@@@@@@@A@A@A@AB@@A@A@AB@@AB@@@@ACA@@@A@@AA@@@@B@B@@@BAD@B@B@@@BABA@BB@@@@@B@@@BA@A@@@@B@BA@@@@@AB@@A@@@@AA@@@A@AA@@A@@@A@@BA@A@@@A@C@@@A@ABAB@@ABAAC@A@@@A@@@A@@A@@@A@@@A@@@CA@@AA@A@@BA@@BC@A@@AA@@BABA@@@@@C@@AA@@@A@A@@@@DA@@B@@@@A@@BA@A@AAA@@AB@@@@A@@AA@A@@AB@@@AAA@@AB@@@@AB@B@@@@A@@A@@@@A@@A@@@@AA@@AAA@@@@@A@@BA@@A@@@B@@A@@@@AA@@@AB@A@AAA@@@@@A@@B@@C@@A@@CAC@C@@@@A@@@AA@@AA@AA@@AA@@@A@@@A@@A@ABA@@@A@@A@AA@@@AAAAAA@@@AA@@@A@@AAAC@C@@@@@@@BA@AA@BA@@@ABA@@AAB@@@@AAAB@AA@@@@A@@A@@@@@@BA@@BA@@AA@AB@@A@A@@@A@@@@@A@@@@@@CAA@@@@@A@@@A@A@A@ABA@@@@@@@A@@BA@@B@@AB@@@@A@ABAB@@@@A@@@@@A@@A@@EAA@@@@@@@@@@A@@A@@@@@AC@@@@@A@@A@A@@@@@A@@@A@@BA@A@@@AB@@ABA@C@@B@@@B@@@B@@@B@BA@@BBD@B@@@@@B@B@B@BB@@@@B@B@@BB@@B@@B@@@B@B@@A@A@@AA@ACA@AA@@@AA@@AA@@AAA@A@@AA@@A@@A@AA@@@@AA@@@AB@@AAA@@AA@A@A@@BA@CB@BA@A@@B@BA@A@ABA@@@A@@@A@@BA@ABA@A@@@C@@@A@@@A@@@C@C@A@@@A@@AA@@A@@@AA@@@@@AACA@@@@AA@A@@@@@A@@@A@@@A@@@A@A@A@ABABC@@@AD@@A@@@AAA@@ACA@@@@A@@@ABAAA@@@C@A@A@@AA@A@@AAAA@@AA@@A@C@A@@@A@@@A@@BA@A@C@ABA@@@A@ABAB@@ABBB@@@@@BB@@@AB@@A@@@@@@@@@C@@@@B@@@B@@B@@@@B@@BDCB@BAB@@@@A@A@AACB@@@@CBAB@@@@AAA@A@A@@@@@CA@A@@@@@A@AABC@@BA@@BA@A@@B@@@B@BA@@@A@AB@@A@A@@@A@A@AA@@ABA@C@A@A@@@A@@@@@@@ABC@AB@@AB@A@@@@AAA@@@@B@@C@@@@AA@@@@AB@@@@@@A@@AA@@BAA@@@@@@@@@@A@A@@@@AAA@@@@@A@A@BC@@AA@@CAAA@AA@A@@@AA@@AA@@A@ABA@@@C@AA@@A@A@AACBA@AAA@A@ABA@@@BBAB@B@@@BBB@@@BAB@@AB@@@B@@A@@@A@@@@@@DA@@BA@AB@@@@CAAA@@AA@@A@@@@DCAC@A@AAA@AB@@@@@B@@A@A@A@@@A@A@A@@@@A@@@AB@@AB@@AB@@ABA@@CCA@E@C@@AA@@@BABA@AA@AA@@AAABC@@@@@A@A@@A@@@@AA@@@@A@@@AB@@CBA@A@AB@B@@@BBB@@BB@@B@BB@@@BAB@@@BA@@@@B@@A@@@ABAA@@A@@@@@A@@@@@@AA@ABAB@@AA@@@@BAA@@A@CA@@@@@@@A@@@AA@@@A@@@ABABA@@AA@@AA@A@AAA@@@@AAA@@@@@@C@@@BA@ABA@@ABAA@BA@@@A@@@@A@@AA@@@A@AB@B@@A@@@CB@@@@AA@A@A@@AA@AA@AA@@@A@A@A@@AAA@A@ABC@@@@@@A@@@A@@@@@A@@AA@@@A@A@C@@A@A@@A@@ABA@AB@D@@ABB@A@@B@@@BA@A@@@ADA@@A@@A@A@A@AB@@@@@@A@@@@AAA@@AA@@@@ABA@@BAA@@A@@@@@A@AB@A@@AB@@AA@B@B@B@@@@@B@@B@@@@@@@@B@@@B@@@@@@@BA@@@@@@B@@A@@B@@@@@@AA@@@@A@@@AB@@A@@@@BA@A@@B@@@B@@B@@@A@@@@@A@@@@@@@@@@@@ABAC@@A@@AA@@@@A@AA@@@@@@AB@B@@@B@@@BA@@@@@AA@AA@@@@AAA@@AAA@AA@@@AAA@@@A@A@@AAA@@@B@@ABAAA@A@@@@@@@@AA@@@@A@@@@A@@@@@@AABA@ABC@A@A@C@A@@@@@AA@AAAA@BAA@@A@@AA@@BA@A@@@A@@AAACB@A@A@@AA@@AAA@@AAA@AB@@@@A@@BABA@@AA@@DEB@@@BA@@BBFC@A@@A@C@C@@@BABABAB@@A@@@@A@A@@@@A@@BA@@@A@@@AA@@A@@CA@@@A@@@@@A@@@AA@@AB@@@@@AA@@A@A@C@AAA@@@@A@@A@A@@@@@ABAB@@ABCDA@A@@B@@A@@A@@A@@AA@@@@@@BAAAB@@C@AB@@A@A@@@@@ABBB@B@@@@@@ADA@@@@B@@@BA@@B@@ABAB@@AB@@A@@@AA@B@AA@@BA@A@@@@AA@@@@@A@@@A@@CA@@@@A@@A@A@@A@@AA@A@@A@@@A@@@AC@A@@@@@A@AA@@@@@@@@@@A@@A@A@AC@@AA@@A@@A@@@A@@A@@@AA@B@@A@@@A@AAAA@@A@AAAA@@A@@AA@@@CAA@@@A@@@AA@@@@A@A@@B@@@@AAA@EAAB@BAB@B@@@B@@A@A@A@A@A@@@A@@B@B@@@BA@@@AAAB@@AA@@A@@AAAC@"],encodeOffsets:[[123702,29936]]}},{type:"Feature",id:"330603",properties:{name:"柯桥区",cp:[120.495085,30.081929],childNum:1},geometry:{type:"Polygon",coordinates:["@@A@C@@@A@AA@@A@AB@@AA@@@@AA@@A@@@@BA@@BA@A@ABA@@@A@AB@@ABA@@@@@A@@A@@@@@AB@@@@AA@C@@@BA@C@C@@B@@B@AB@@@@@A@C@A@@@AA@BAA@@@@@A@@@A@@@C@@@@@A@@A@@@AB@A@@@@BA@@@@@@A@@@A@@A@@A@@@@A@A@@A@AC@@CAA@@@@@@@@AB@@@A@@@@AB@B@@@B@B@@@@A@@@A@@@@@@@@@A@@@A@@@@A@@@@@A@@@@A@@@@@AA@@@BC@@A@@@@CGB@EAA@@@@A@@@A@@@@AA@@@AB@@@@BBA@@B@@@BC@BB@B@BA@C@CBA@@@@A@@AACB@@@BABCAA@@@@@@B@@@@@@@@@@@@@BA@@@@@@@A@@@A@@@@@@AB@AA@@ABA@A@B@AAOFAA@@@@@@@@ABAACBACE@A@BA@A@@@AC@A@AAB@AA@@@@B@@AAB@AA@@@@@A@@@@AHA@@D@@@@@@A@@@@@A@@@@B@@@B@@@@@@@@AACC@@AD@@A@GC@C@C@A@AEC@@AA@@AAA@A@AAA@AAAA@@@BA@@B@@@B@@@@@@A@@B@@@B@@@@@@AB@@CB@@AABACFA@A@@@@@A@@@@A@A@@A@@@A@@@@AEA@ACAB@ABCA@CEACHCAAAC@@BAA@JE@@@@ACA@A@@@A@AA@@@@@@@A@@@AAABA@@A@@AB@BA@@AA@A@@@@@AB@@@@@AC@@@BA@@A@@AAA@ABAAAA@@@BA@A@@@AA@@@@AA@@AA@@@@@A@A@@AC@@@AA@@B@@@@A@@@@@AA@@@A@@@A@@@@B@@@A@@@BA@@@@@AB@BA@@BAB@@@BA@@B@@@B@@@@B@@@@B@@@BAB@@@@@AAB@@@@AB@@@@@B@BAB@@A@@@A@@B@AA@@@@@@@@@A@@@@B@@@@A@@B@@A@@@A@@@@BB@@BA@@@@BB@@@@@@BA@@BB@@@@@@BB@@BAB@@@B@@@BA@@@AB@@@BB@@@@@@BA@@BB@@DA@BB@@AB@@AB@@@BA@@@A@@A@@A@@@A@A@@A@@A@@@@AAB@@@@@BA@@@@@BBAB@@@@A@@B@@@@@@@B@BAA@@@A@@A@A@@@@@ABAA@A@@@A@BAB@@AB@AAB@@@@A@@@@B@@A@@A@@@@@@AA@@@@@@A@@@@@@A@@A@A@@@@@@@@B@@B@@@@@A@@@@D@@@@@@@B@@A@@B@B@B@@@@A@@BA@@@A@@BA@@@@@A@@@@B@FCB@AG@E@A@A@@B@@@BAB@@@A@@@@@A@BA@@@@@C@@@@B@@@@@B@@A@@B@@@@@A@@A@@@@B@@@@A@@A@@@B@A@@A@@@@@A@@@@@A@@@@B@@@A@A@@AA@@@A@@@@A@@A@@@A@@@@BA@@@@A@@@A@@@@B@@AA@@@A@@AB@@@AA@A@@A@@@@A@@@@A@@@A@@@@@@@B@@@A@A@@@@AB@@@@@AA@@@@@B@@@A@@A@@@@@@AAC@A@AA@A@AABAB@@@A@@A@@@@BAAAA@@@@@@@AAAA@C@AA@@AC@@AAAAA@@@@@AAAAA@@AA@A@@@@A@@@@C@@@@@A@@A@@@@A@@@@@A@@@@@AB@@A@@B@@D@@B@@ABA@@A@A@@@@A@@@@@@AA@@@@@@@AB@BAB@@@A@@@A@@@A@@@ABAA@@@@@A@@@@@@@A@@AC@@@@CB@A@A@@A@@A@@@@BA@@B@DA@@B@B@@@@A@@@@B@BA@@BA@@B@B@@BBB@@@@BAB@@@@B@@B@BBB@BB@@BB@@B@B@DC@@@AB@@AB@@E@@BA@A@ABA@A@@@@@A@@A@@@AA@@B@B@@@@A@@B@@A@@B@BA@@@A@AB@@ADA@@B@BA@@@@BD@@B@BB@@B@@A@@@@B@@B@@@@@B@@@@B@D@DA@@B@@BB@B@@@B@B@B@@@@A@@B@@@B@@@B@B@BAB@@ABBB@@AB@B@BB@@D@@BB@BAB@BBFBB@@@B@B@@BB@@@@@B@@@AB@@BB@@BBB@@BBB@BB@@@BBB@@@B@@@@@@@@@A@@B@@B@@@@@@@@A@@B@BB@@@@@BC@@@@@B@@BB@@@AB@@B@@@D@AB@B@BABBB@@@@BBB@@B@B@@@@@BA@@@AB@@A@@@A@@BAD@BA@@@@B@@A@AB@@@BBB@B@@@@BB@@BB@F@@BB@@BDDBB@B@@@@@@BB@B@@@@BA@@@AB@@@BB@BB@@BB@BB@@B@BBBAD@@AB@@@B@B@BB@@B@B@@AB@@@B@@@B@@@@@BB@BA@@BB@@@@B@D@@BBB@@BBF@@@D@B@@@@BB@@B@@DAB@@@@@B@@ABBD@BCBC@@@AB@@@BA@@@@B@BB@@@@@@ABA@@@@@B@@@@@@@@BAB@@@BAF@@@B@@@BBB@B@@@@B@B@B@@A@@B@@@BBBBB@@@B@BA@@@@B@@@B@@@D@B@@B@@BB@@@AB@@@@@B@@@BAB@@@BA@@BA@@@@@BA@@B@@B@@AB@@BBA@@@@@A@@@@@@@A@@BB@A@@A@@A@@@@@@AA@AB@@A@A@@CC@@@BAAA@@AB@@@@@B@@A@@@@@A@@@@B@@@B@@@@@@@@@@@BABB@@BA@@@@@@@@B@@@@@@@@@B@B@@AB@@@BBB@@BB@@@AA@@@@B@@@@@A@@@@@@A@@@AB@@B@@@A@A@@B@D@BA@@BABAB@@AB@BABA@A@@B@B@@@BA@@@A@@@A@AA@@@AAA@@@@@@@@@@A@A@@B@@A@A@@@@B@@@B@@@B@@ABA@AB@@A@A@@@ABABA@@BCB@BA@A@@@A@A@A@@BA@@@A@@@@@@B@@@@A@@AA@@@A@@@AD@@@@@@AB@@A@@AA@@BC@@B@@ABABC@@B@BA@A@@@@@@AAACBA@@B@BB@AB@@@B@@@BC@@AAB@@A@AD@@AB@@ABAB@@AAABAB@BAB@@@@@@AB@B@B@BA@@BBBB@@BACA@@@@@AB@B@@@@ABC@@@@B@@@B@@@B@B@BA@@F@@@BB@@B@@@@@B@DA@@B@@C@@@@BA@@BA@ABA@@B@@AB@B@@A@@@@B@@B@@@A@@@@@@@A@@@@BAABAA@@@B@@A@A@@@A@BA@A@A@@@A@@@@AABA@@@@BA@@@AB@AABA@A@@@@B@@@@AB@@BB@CC@@@@@@@A@@@@@@@@BA@@@@@@@@C@@@@@DAB@@AA@@@BA@@@@A@@@B@@A@A@@@@@A@@A@@@@@AA@@@@A@@ABB@@@A@@A@@@@AB@B@@@BB@@B@@A@@AAAA@@@@B@@@B@@@@A@@@AAA@@@A@A@@@@@@BA@@@@@AAAAB@A@@@A@@@@@@@@@@DA@@A@@@BA@@@A@ABB@A@@BA@@BA@@@@@A@@C@A@CAAAAA@AB@@@AC@@A@@@@AAA@@@A@@AA@@@A@@@A@@B@@@@A@AAA@@@@@@@A@@BA@@@@BB@@@@@@@AB@@@B@@@@C@AB@B@@A@@@A@A@@@A@C@@B@@AB@B@B@@@@B@@@BB@BB@@BAB@@@@B@@BAB@@@@A@@@@@@@@@@@BB@@A@@@AAAAABAB@BA@A@@@A@A@@A@@@@A@@@AA@@A@@@A@@@@AA@@A@@A@@@@A@@ABABAB@@@B@@@@@B@@@@@@@@A@@@A@@B@@@@A@@A@@@A@@@AAA@@@@AA@@A@@A@@@@A@@@A@@@A@A@@@A@@@@AA@@B@BA@@@A@AA@A@AAA@@A@@@@@A@@@@AA@@A@@@@@@@@A@AB@@@@AA@@@BA@@BB@A@@D@@@AAB@A@A@A@C@AAA@@@A@CAA@@@A@AA@B@@@@@@@@@BB@@@A@AB@@@BA@@@@@@AA@A@@AAA@A@A@A@@@@@AA@@A@AA@@AA@@B@@AA@AA@@@A@@@@@A@@@A@@@@@A@@@@@A@AA@AB@@AB@@A@@@@@AB@AA@A@@AA@@BA@@@@A@@@@@A@@@@@@@A@@@A@@@AB@@@@@@@A@AB@@@BA@A@A@@BA@A@@B@@@@@BA@@@@B@B@@B@@B@@A@@@@B@@@@B@@@@B@BBB@@@BB@A@@AA@@@@BB@@BB@@@@@@@@@A@@@AA@A@@@@ABB@AA@AA@@B@@@@@@@A@@@@A@@@@B@@@B@@@@@@AA@AAB@@@A@@AA@@A@@AAB@@@@A@@@@@AA@@B@@@@A@BA@@@@@@A@@@@@@@@@AA@@@@A@@A@@@@B@AAB@@@@@@@BA@@BAAA@@@@A@@@@@@A@@@@@B@B@@@@AA@@A@@A@@@AA@@@@@@@@@@@@AA@@@@@BA@@@@AB@@@@@AB@@@@A@@@A@@ABA@@A@@@@@@@@@@@@@@@A@@A@@B@@AB@AA@@@@AB@@@@@A@@@@@@@AB@@@@@A@@ABA@@@@@AA@@@@@@AAA@@@@AC@@AA@A@A@AAAAAAAA@@AACAABA@A@AB@BAA@AA@@@@AA@@D@@@B@BA@@@A@@BC@A@A@A@@@B@BB@@ABA@@BA@AB@@AB@@@@@@@AA@AB@BAB@B@@@B@BAAAB@@@@@@@@@@@@@@A@@@@@@@@@A@@@AD@BBD@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@B@@B@@@@@@@@@@B@@@@@@@@@B@@@@@@@@@@B@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@BB@@@@@@@B@@@@@@@@@B@@@@@@@@@BA@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@B@@@@@@@@@A@@@@@@B@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@A@@B@@A@@@B@@B@@@@@@@B@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@B@@@BAAA@@@@BA@B@@@@@@@@@@@ABB@@@@BB@@A@@@@@@@B@@B@B@@BA@@@C@@@@@@@@B@@B@ABC@@@@@B@BAA@AB@@AA@@@@@@@A@@@@A@@@@A@@@@A@AA@@@@A@@@A@@AA@BB@@A@@@A@@A@@B@@@@@@@@@@@@@A@@@@@AA@@@@@@A@@B@@AA@@A@A@@BA@@B@B@@A@AA@@B@@@AAA@@@C@@@A@A@@@@@@@@@BB@@A@@@AA@@A@@@BB@@@@@@@@CBEB@ACAA@A@A@@@@@@@B@BBA@@@@@@@@@@BAB@@@@@BB@@@@BA@AB@@A@@AA@@@A@AA@@AACA@@@@AA@@ABA@AA@@@@@@AAA@AB@@@@A@@@@@@@@@@@@A@@@@A@AA@@B@@A@@A@@@@A@@@@A@A@A@AC@@A@AAAAA@@AAAAA@@@@@@BA@@@@A@@AAB@A@A@@@@@@@@A@@@@A@@@@@@@@@@A@@@@@@@@@@@@@A@A@@@@AB@@@@@@@@A@A@@@BA@@B@BA@@A@@@@A@@B@@A@@AB@@AAB@@@ABAA@@@@B@@AB@@@AA@@@A@@@@@A@@@@BA@A@@@AB@@@@@@ACA@@A@@AB@@@@AB@@AA@@@@@@A@AA@@AA@A@@AA@@@@A@@@@@A@@@B@@@AACA@@@A@@@AB@B@@@@@@@BA@@@@@A@AAA@AA@@A@@AAA@AAAAAA@A@@A@@@A@A@@@AAA@@@@A@@@A@@B@@@@AA@A@AA@B@B@@@@BB@BA@@BAAAB@B@@AB@BAB@@AAAB@@A@A@A@@@@@B@B@@AA@A@@@@AB@@@@@AAA@@@@BA@@@A@@B@@BB@BB@@@@@@BA@@@AAA@A@@AA@@BA@@@ABA@@@@@@B@@A@@@@AB@@A@@@@@A@A@@B@@@A@@@@@@@A@@A@@@@@@@A@@AA@@AA@@@A@@@@@@B@D@B@@@B@BA@@B@B@@@AAAA@@BA@@@@@@AC@@@DA@A@@@A@@A@BA@@@@@@B@@@@A@@B@@@@@@AB@BAAA@@@A@@@@@AA@@B@BA@@@@@@AAA@AA@@A@@@A@@@@@A@@@A@A@A@A@@@CAA@@@@BA@@@AB@@A@@BA@BB@BB@@BBBDD@@@@BA@@BBB@@@@BBD@@BB@@@@@@@B@@BBBB@@@@@B@@@BAB@@A@@@AB@@AB@@@@BB@@@@@BCD@BABA@@BA@@@ABA@@BA@@@@BA@@BAB@@@BA@@B@@A@@BAB@@ABA@@B@@AB@@@B@@@BA@@B@@CD@@AA@@AA@@AA@@@BA@BBAB@@A@@B@@@B@B@D@@@BB@@@A@@BA@AB@BA@@B@BA@@B@@@BA@@B@BA@@B@@BB@BB@@@@B@DBB@DBBBB@B@B@@AB@BABA@@@A@@BABB@@BABB@@B@@@B@BBB@B@@ABA@BB@@BB@@@@B@AB@@AB@@@@BB@@BB@@@@AB@B@@E@CBC@@B@@@@ABB@@@B@AB@@@B@@B@@@BBABC@ABA@@B@@@@@B@@@@B@@B@@B@@BB@@@D@@@BB@@B@B@@@ADAB@B@@DBBAB@@@@@@@@BB@@@B@BA@@@@B@@B@@A@@BA@BBAB@BB@@@BBB@BB@@BF@@B@B@B@B@@@BB@@B@BB@@@B@BBD@@@DAB@@@BA@@BBD@@@B@@@@@BB@@B@@@@@BB@ABB@@B@@@@@@B@@B@@BBB@@@B@@AB@@@B@@@B@@@@B@@B@@@@BB@BBB@@B@@@BABB@@@@D@@BB@@@B@@@@A@ABC@@B@@@@B@AB@@@B@@A@@@@@CAA@@@A@A@@@@@ABA@A@A@A@AA@@ABA@A@A@@@@@@@@ABA@@@@A@@B@@@@@B@@A@@@@B@B@@A@@@@A@@@C@@A@@@@D@@@BA@@A@@@A@@A@@B@@@@@B@B@@@@@@AA@B@@A@@@A@@BB@@@AB@BAAA@@@@D@B@BB@@@@F@B@B@@@@A@@@@@AD@@@@@@@@AA@A@@A@@@BA@C@@@C@@@@CA@B@@BD@@@@@@@DA@@B@@@@A@AA@CA@@@@A@@BAA@@@@A@@A@@BAAA@@@@@@@BB@B@@A@@@@B@B@@@@AA@A@BA@A@@B@@C@@BA@@@AB@@A@@@AB@@@@BBBB@BB@AB@@BB@@@@A@@@A@@@@@@BBA@@@B@B@@@@@DAAA@CB@@@B@@BD@B@@ABA@@@A@@@@@@B@B@@@@A@@@@A@A@@@@A@@AA@AB@BAB@@@@@@@B@@A@@@@@AA@@@@@@A@A@AB@@@@@BA@@@@@@@@BA@@B@BA@@@ABAB@@A@@@@@A@A@CAA@A@@@BB@B@D@@@BA@AB@@A@@@@AB@@@@A@@C@AB@B@@@@@B@@BBBA@@B@BBB@@@B@A@@@ABA@@@@BA@@@A@C@@@A@AB@@@BA@@@@B@B@@A@@@AB@@@B@@AB@@BDABB@@BB@@B@@B@BB@@@@A@@B@B@B@B@@@@@@AA@B@AAAAA@@AA@B@@@@BBB@BD@@A@@@AAA@AAA@@@@@AB@@@B@@A@@@A@@B@@BBBB@B@@@D@BA@@@@B@@@@@@@B@@@BA@A@A@@@AA@@BA@@A@@AA@@@AA@@A@A@@@@@@AA@@@A@@@@@@BB@@B@@@@C@@@A@AA@@@A@@A@A@AB@@@BB@@B@@B@@B@@@@@B@@A@@@A@@@A@@@@@@@@B@B@@CBA@A@@AB@@@AAA@CB@B@@CAAAAAA@AAA@@@@@@B@@@@@AA@E@@A@BA@@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@A@@A@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@BB@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@B@@@@B@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@A@@@@@@@@@A@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@BB@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@A@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@B@@ED@@B@@@@@@B@@@B@BA@A@@@@A@@A@@@@B@@@@BA@@@D@@C@A@ACAC@@A@A@@@@BA@@BA@@D@BA@@@@@A@AA@@AB@@B@@@B@@B@@@@@@@@AB@@@@@@ABA@CB@@AA@@@@A@@AA@@@@B@@BB@@@BA@@BB@@BB@BB@@@B@@A@@BA@AB@@AAA@@@@B@@@@@BB@@D@@@B@B@@ABABB@@B@@AD@B@B@@@@D@@@B@@@@@@B@@@B@@@BB@@BA@@B@@@BB@@@@@@BAB@@AB@@@BA@@@@@AAA@@@@@@A@@@@@A@A@@@@@BA@@B@@@@@@ACA@A@@@@BB@@BB@@@AB@@@B@@@@@BA@@@@@@B@@@@@BB@@@@BA@AB@@@@@A@@@AAA@@A@@@ADAB@@@@A@@@AA@AAAA@@@@@A@@@@@@BABAB@@@@A@A@@@C@@@@AA@BAA@@A@@@@AA@@AB@@A@@AABC@AB@@A@@@A@@B@@AB@B@@BB@@AB@@@@BB@@@@@@@BAB@@AAA@@@@B@B@@ABEBA@CB@@@@BB@@D@D@BB@@BB@B@BAD@@@@B@@B@@B@@@@B@BA@@@@@@B@@@B@B@@A@@@@B@B@@@@A@@@@@@@CB@@@@@@@B@@@B@@BBBB@@@@@B@@@@A@@@@A@BAB@@AB@@BBA@@BC@AB@@@B@B@@AA@@@@A@A@@BAAC@AB@@@@A@@B@BB@@BB@@BA@@BAD@@B@@B@@D@@@@B@B@@B@@@BB@@@@A@@B@@@BBB@@@@AB@@@@AA@AA@A@@BA@A@@@A@@@@@@AB@@A@@@A@@A@@B@@AD@BBB@B@@@@@@A@@@A@@AA@@AA@@@B@@D@@A@@@ABA@@@@@@@A@@@A@@BA@@@ABAB@@@@@@@DA@@@@@@@@BAB@@@@C@@@A@A@AB@@AB@BB@@BA@@@@@BB@@@B@BBB@@B@@@B@@@B@@@B@B@B@@@@B@BB@@B@@@@@BB@B@@B@BBB@@@@B@@@B@@B@@@B@B@@A@@@@@A@@B@@B@@@@@@BA@@@A@A@@@A@@@@B@@@@B@@B@@@B@@@B@@@B@@BB@@ABBB@@@@@B@BAB@BA@@@A@@BA@@@BBB@@B@B@@@@@@@@@@@BB@@@ABA@@B@@D@@@B@AB@@B@@@DB@B@@A@@@CA@B@@@B@@@BD@@@@@@@@D@@@B@B@@ABB@@BBBD@@@@@@@A@@BA@@@A@@@@@CDAB@@@@@@AB@DAB@BA@@@AACAA@@AA@@@@@AA@@@@ABA@@B@@A@@@@@CBAB@@AB@BA@AB@@@B@@@BA@@B@@@B@BA@@@AAA@@A@@@@@A@@A@@@@A@@ACAAA@@@@@C@@@AA@@@A@@@@@A@@A@EAABA@A@@@@@@A@A@@A@A@ABA@@@AB@BA@A@A@@@@@@@B@@AA@@A@@AB@@@B@@@BCF@D@@@@BBBBDBD@BB@BDF@@BBBBDBB@@BB@@@@B@@BDBFBB@B@@@@A@A@E@A@@@ABBB@@DDHFBBHDHB@@B@BA@ABA@ABC@C@ABA@C@@@AB@@@BB@@DD@@B@@@FCBAB@@@@BDDFHBBBDBBBB@@FB@@BB@@@@@BAB@B@@@BB@@@D@D@B@B@D@D@D@D@@@@@@B@@@BCD@B@@@B@D@B@@BB@@@@B@BABADC@AB@@@BB@BBB@BBBB@BBFB@@FBHB@@DAD@F@@@B@BBBB@@DBB@@@BA@@@@BA@ABA@CBCBCBABABADADA@@@@@A@@@A@CAA@@BA@@BAB@@@DBD@B@@@@@BA@@@A@AAC@A@CB@@@B@D@@B@B@@@@@@@@B@@@B@@@@@B@@@@F@F@D@BAB@D@D@@@B@B@@@@@D@@@BD@BA@@B@@@@@@A@@@@B@@@BB@@B@@@B@@@A@@@@BBBBB@@@@@B@@@@B@B@@A@A@@A@A@@@@AB@@@BC@@@@@@BD@BB@@@@A@@@@@A@@@B@@@A@@@@@AA@@@@@@@@@@A@@@@@@B@@@@@@@@@@AB@@@@@A@@@@@@A@@@@B@@B@@@@@@@A@@B@@@@@BA@AACAE@@@A@@@A@@@@@A@@@@@A@@BE@A@@B@@@@@B@@BB@@@@@@@@@@@B@@@@@@@B@@@@@B@@B@@@BJA@@BA@@@B@@@B@@BBB@@@@@B@@@@@@A@@@@B@@@@A@@@@B@@@@AB@@@@A@@@@@B@@@@B@@@B@@@@BB@@@@@@@B@@@@B@@@@@@@B@B@B@B@@@@@B@@@BA@@@A@@@B@B@@@@@@DA@B@@@B@B@@@@@B@@@A@BBA@@@@@@@@B@@@@@@B@@@B@@B@D@@@@@@@@@BBBBA@B@@A@@@A@@B@@@B@@B@@@@@B@@@@BD@H@@A@@B@@A@A@D@@@D@@@B@@D@@BD@@@@@A@@@A@@B@@@@@@@B@@@@@@@B@@@B@@A@@@@D@@B@BAB@@D@B@B@@@@@@@@AB@B@@@B@@A@@B@A@B@B@@@B@@B@@@B@@@B@@@@B@@B@@@D@@@B@B@@@B@B@D@@@B@B@@@@@@@D@@@B@@AB@@@@@BBBA@@@D@@B@@@D@@@@B@@B@A@@B@@@@B@F@@A@@B@@A@@@@@@B@@@@A@@@BB@@@@@@@B@@B@@@A@@B@@A@@@@@@@@B@@B@@@@@@@@@@B@@A@@@@BB@@BBA@@@@BBA@B@ABB@@@@@@B@@@@@BA@B@@BB@@@A@@@@@@@@B@@AA@@@@@@AB@@@AAA@@@@@@A@@@@@@@A@@@ABA@@BA@@@@@@DD@AB@@A@@@@@A@@B@@BBB@@@@BA@@@AB@@ADAB@@AAA@@@AD@@@BABAB@@@@@@ABA@@D@B@H@B@@BBA@@B@@@@B@@@@B@@BB@@B@B@@@@B@B@@@@@@@B@BA@@@@B@@@@@B@@B@@D@@@@AB@@B@@@A@@BB@@@B@@@@@@@@B@@@@@@@@A@@A@B@@@@@BA@@@@B@@@@@@@B@@@B@@@B@@@@@@AB@@@@@@@DB@@@@@@B@@@D@B@@@B@B@@@BA@@B@@@@@BA@@@@B@@@@@@@@@B@BABA@@AA@@@CB@B@@AAA@@A@ABA@@@@@@@@@B@BA@@B@B@@@AAB@DA@@@BBD@@BAB@BD@@DAB@@@@D@@B@B@@@B@B@B@@@B@@@D@B@@BB@BA@@@BBAB@@@DAB@@@@@@C@AB@BAB@@B@@B@@A@@B@@@BA@@@@B@@F@@B@@@@@@@@B@@@A@@@CD@D@BA@@@@B@@@B@@@@@@@@@B@@@@@@BB@@@@@@@@B@BDAB@@BBBD@B@DB@@@B@@BLBD@B@@B@A@@BBFBB@B@@@BBDBBBD@BBDBB@BFHBFB@@@@@BDBB@@@@FH@B@@@@DFLVNRDF@@A@BBVLXNBBBBBBPRJJBDBBPRDF@BZ^XT@BVMTIJCLCJAxQH@}yAAOS@@@AKGECCAWMGEA@@AQKQSSYCCQS@AAAAAAAGICCEEAAGGEEEC@ACAAACC@@EEAACACC@AA@GAGCEAKCA@@@ADA@@AKCA@@@A@@@A@A@@@A@@AA@A@@@AA@@A@@@@@AB@@BB@@@BA@@B@@A@AB@@A@@BCB@@"],encodeOffsets:[[123501,30851]]}},{type:"Feature",id:"330681",properties:{name:"诸暨市",cp:[120.246863,29.708692],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@CBAB@@A@C@@@@@@@@@A@@@A@@@@B@@@B@@@@@@ABA@@@@@@@@B@@@@AB@@C@@@@@@BABA@@@@AA@@@@BB@@@@BBBA@A@@@@@@BAB@@B@@@B@@@@@@@@B@@@B@@AA@@@BCA@BCB@@@@AB@@@B@DB@@@ABBB@@ABAF@@A@@@AB@BA@@B@@@B@@AD@@@DB@@B@@@BA@BB@DB@BB@@@@B@BB@@B@DD@@BB@@BB@@@B@@@B@@BBBB@BB@@@@BB@@@B@B@@D@BDBB@B@@@AB@@@DB@B@@@@@@BA@@@AB@B@BB@@BA@BB@@@B@B@@@@@@@@AB@B@@B@@@AB@B@@@B@@@B@@@D@@@@@BBBDB@@@B@@A@@@@B@B@@BB@@@BB@@@@@@D@@@@@ACA@@@@EBB@B@@@@@ABA@@@@B@B@B@@@@C@A@@@@@@BB@@B@B@B@@A@AAA@@@@D@@@B@@BBABABCD@@@B@@A@A@A@BB@@A@A@A@A@A@AA@@@B@@@B@@B@@@@B@@@@B@@@BB@@AB@BAB@@A@@B@B@@@B@@@B@@@@@B@@@@@BAB@@@@@B@@B@@@B@@B@BB@BB@@@BB@BB@@@@@B@B@@BDB@@@@@D@B@B@BAB@@@DD@@@B@@BBB@DAB@B@B@BBB@@@BBA@ABC@@BC@BDBDABA@ABA@@@BB@@@@C@@@@@@@@@@@@BB@B@@@@BC@CB@@A@AA@@@@BA@@B@@@@@@@A@AB@@@@@B@B@@@@AB@B@@A@@A@@A@@@@@@@@AB@@@@@@@@@@@BA@ABA@@BAB@@@@@@A@@A@A@@@@B@@CB@@@@@@B@@@@@@BA@A@@@@@A@AB@BAB@@AB@@@@AB@@@@@@@@@A@@@AB@@@A@A@A@@A@@@ABA@@@A@@AB@@A@@AA@@@AB@@A@A@@@@@C@@@@BA@@@A@A@A@@@BA@@@A@@@@AB@@AA@AA@@@A@@@@B@@@@@BAB@@@@@B@@A@@@@BA@@@A@@@@BBB@@BB@@@B@@@BB@@@AB@@B@@@@@A@AB@@A@@B@BA@ABB@@BB@A@A@@@AB@@BB@@@@A@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@B@@@@@@A@@@@@@@@@A@@@@A@@@@@@A@@@@@@@A@@@@A@@@@@@A@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@B@@A@@@@@@@A@@@@@@@@BA@@@@@@@@@@@@@@@@@A@@@@@@@@@AB@@@@@@A@@@@@@@@@A@@@@@@@@@@@A@@@@@@B@@@@@@@B@@@@@@@@@B@@B@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@B@@@@@@@@@@@@AB@@A@AB@@C@AB@@A@AA@@A@E@@@@@AAA@A@@D@@@@@B@B@B@@@B@@@@AB@@BB@B@@@BB@@@@@B@@@@B@BAD@D@@@@@@@B@@@@B@@B@@BB@@@@BD@@B@@@ABAD@@C@@@@@@@BBB@BB@@@@ABDDB@@B@@A@@AA@@@@B@@@@AA@BAAABA@@@AB@@AAABCAABC@CAADA@A@A@@@BB@BB@@@@BA@@@A@AA@@A@A@@@CA@@@A@@CC@@A@@@AABA@AA@@@@AB@@A@@@A@A@@@A@@@@AAAAACAA@@@AA@@A@@AA@@@AAA@A@@@A@@@A@@@A@AB@@A@@@@@A@AA@@@C@@@A@@A@@@@ABBBA@BBADAB@@@@@@BA@@@@@A@@@A@A@@A@@@@@@@@A@@@@@@@@A@BA@@B@@@@@@A@@@@A@C@@A@A@@@@BAAA@@@@@@@A@@@@A@A@AB@@@@@@@A@@@@@@@@A@@@@@A@@@@@AAAA@A@@AA@@@A@@@@A@@@@@@@A@A@@@ABA@@@AB@@@BA@@@C@A@@@@@@A@@A@@A@@@A@@@A@@AAA@@@@AA@@@@@@@AD@@BB@@@@@@CA@@B@@A@@@A@@A@@A@@BB@@@A@@@AAA@@AA@@AB@@C@AB@@A@AAA@@BA@@@@@A@@@AAAA@@@A@@AA@@@@@ABA@@@@A@A@@AA@A@@BAAA@AAA@@AB@@@@A@@A@AA@@A@AA@@@@@A@@@@@@@@@B@@B@@B@@BAA@@A@@@@@@B@@@@@@B@B@@B@@@@C@A@@ACAB@@@BA@@@@@AA@B@@AA@A@DA@@AA@@A@@A@@BA@@@AB@@@@@@@A@@A@@@AB@@@AB@@AB@@A@@B@@@@A@@AAAA@@@@AB@@A@A@@B@@A@@A@@A@@AB@@@@A@@@@A@@@@@AD@A@@@@@A@@@@AAABA@AB@@@@AC@@ABA@@@A@@A@B@@@@A@@B@@@@@@@@@@B@@@@@@BB@@B@@BCB@@BA@@B@@AB@@@@B@@@B@@@BB@@ABBBBB@@@@@BABA@@@A@@@@@@@BBBBB@@@@@@@B@A@AB@@ABA@@@A@@B@BA@@BA@@D@@A@@@AA@@A@@@AB@@A@@@AAAAAAABACAA@AAA@AA@@@@@A@ABA@@@A@@B@@@@@@AB@A@AA@@B@@AB@@@B@@@@AA@@AB@@@A@@A@@@@@@@@@A@@@@B@@@@AB@@@@@B@@@@AAA@@B@@@A@@@@@@@@A@@@ABBBA@@@BB@@B@A@@BA@@@AB@@@@B@@@@@@B@@@@ABA@A@AB@@@@BB@@@BB@@@@@@B@@B@@B@A@@@@@@@@@@@@@@@A@@@@B@@B@@@@@@@A@A@@@A@@@@@@@@@@BB@@ABBB@@@@@A@@@@@@@A@@B@@@AB@@B@B@@@@@@@@B@@@@@ACB@@@@@B@@B@@@BB@@@A@@@A@@@@@@@@B@@@D@@@@ABBB@B@@A@@A@@@@@B@@@@@@AB@@B@@B@AB@@@@BB@@@@@@@@@DA@@@@B@@@D@@@B@@BB@BB@@B@@@B@B@BBB@BB@BB@@A@@@@BD@@BBB@@AB@@@@A@AA@@@BA@ADC@@@A@A@@@AB@@@@AAA@CBA@AA@@@@A@AAAA@@AB@@@BAB@@A@ABA@@BA@ABAB@@A@C@@@ABA@A@@@A@@@@@AB@@A@@@@A@@AA@ABA@@@A@@ADAB@B@@@B@@@BBB@@@B@@A@@BA@A@ABCB@@@@@BB@@B@@@@@BBBA@@@@BBB@@BB@@@@@@@B@@B@@B@@@@@BA@@BA@A@A@A@@@A@A@A@@B@B@@CB@BA@AB@@@B@@A@BB@D@@@B@@@B@@A@@@A@A@A@@@AA@@A@AA@@A@@A@@A@@A@@A@@@A@AAA@@@@@@@A@@BA@@@@B@@A@@@A@@@CD@@@AA@A@@@A@A@ABA@@@@@C@@@A@@@ABA@@B@@@BA@@@A@A@A@@@A@@BA@C@AB@@@B@@@BB@@@@B@@BB@B@B@@@B@B@@@@A@@@@@AA@@@B@@@@@@@@@@BBB@@@@@@@@@BBBB@@@BA@@BA@@D@B@@A@A@@@@BD@@@AB@@B@@BABBB@BBB@@BB@@@B@BB@@D@B@@BBBBBB@BB@BB@@B@@@@@@B@@BBAB@@BB@B@@@B@@@B@@@B@@B@AD@@B@@@BBB@B@BBB@B@@@D@B@DB@@@A@AB@@A@@B@B@@@B@@@@@BABB@@@BB@@B@@DB@@@@@A@@B@@@BBBAB@@@@@B@@@@B@@@@@@@@@B@B@@@@@@B@B@@@A@@B@@B@@B@BB@@B@B@@@@@@ABB@@B@@A@@B@@@D@@A@CB@@@@A@@@ABA@@B@@@@C@ABA@A@ABABAB@@@B@@@BAB@@A@AB@@A@@B@BA@@@@A@@A@@@A@@B@B@@@@@B@BBB@@B@@@B@D@@@BBB@BBD@@B@@@@BB@B@@BB@@B@@@B@@AB@@@@BB@B@@A@@@B@@@@@B@@@@A@@@@B@@B@@@A@@B@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@A@@@@@@@@@@@@B@@@@A@@@@@@B@@B@@@@@@@@B@@A@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@BA@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@A@@B@@@@@@@@@@@B@@@@@@@@@@BB@@@@@@@@@@@@B@@@@B@@@@@@ABABAA@@@@B@@AB@@@A@@@@@A@@@@@@@@@A@AB@@@@A@BA@@A@@@@@@@A@B@@A@@@BB@@A@AB@@A@A@@@@@A@@@A@@@@@A@@AAB@@@@C@@AA@A@@@A@A@@@@AA@AA@@@A@A@AA@@@@@A@@A@@A@@AA@@@@A@@AA@@@A@@@A@@BE@@@A@AB@@C@@@ABA@A@@B@BA@A@@B@@A@A@@@AB@@@B@@A@@@C@A@@@A@@@@@C@A@A@A@@AA@A@@BA@@@A@A@BFA@@B@B@BA@@@AB@BA@A@@@AB@@ABA@@@AB@@A@@B@@@@A@@@@BA@@@A@AB@BA@ABC@CBBB@B@@@B@BBB@@@B@@@B@@@BA@@@DD@@@@@B@@AB@DBB@@B@@BA@@B@@A@@B@@AB@BAAEAA@@@BB@@D@@B@@@@@@@@CA@@@@@B@@@D@@@@A@@B@@ABA@A@AB@@@B@BAB@@@BB@A@ABC@A@@@@@AA@@AB@@A@ABAB@@@@@BA@@@A@AB@@@@@BAB@@ABA@@@@@@A@@@A@@@@AA@B@@@@@@@@BD@@@@@@A@A@@@@@@@AAD@@@@@@A@@AB@@A@A@@@@BB@@@@BAA@@@@@B@@B@@@@@@B@@@@@@BB@@@AB@B@@@@B@B@BA@BBB@@A@@@A@@@@@@B@@@B@@@@AA@@@AA@@@@B@B@@@@B@@B@@@@@@AB@B@BB@@@@@@@BA@@@@B@BAAA@C@@B@@@@B@B@B@D@@BB@@@@@AB@B@@A@AA@@@@A@@@C@@@A@AB@@A@A@A@@@@@@AAC@@@@B@@A@@A@@@AA@@@A@@@@CACAAB@@A@@@A@BB@B@@@BA@@AAA@@A@@@@A@@@@A@A@@@@A@@BA@@@@@@A@A@@@@@@ABA@@@@BA@@@A@@A@@@@A@@@@AA@@@B@@B@AB@@@@@B@B@BA@A@@@BA@@A@@A@@@@@@@AA@ABCBA@@BE@@@A@A@C@@@BD@@A@@@A@@@A@@A@@@@@@A@@B@@A@@B@@AB@B@@@B@B@@@@@B@@AB@@@B@@@D@@B@@B@@@B@B@@@B@@A@@@AB@@A@AB@@A@@@@@AB@B@@@@ABA@A@@@@BA@A@@@CB@@@B@BBB@@A@@@@B@@@B@@@@BB@BA@AD@B@@@B@@@B@@BB@BB@@@@@@B@@@@A@ABAB@@A@@@CD@@CB@@ABABA@@BAB@@@D@@@BB@@B@BBB@B@@@B@@@BBB@@BAB@BAB@@@@@BA@@B@@AB@@@@A@@B@@@BA@@B@@ABABA@@BA@@BBBAB@BBB@@@@@BB@@D@B@B@@@@CBAB@B@BAB@@@B@B@@@DA@CBA@@@A@A@@@AD@BA@@@BB@@@BB@B@@BB@@B@BB@@DBD@B@@BB@@@@B@@BB@@BB@@@BB@B@@@DBB@@@@@BBF@@@@@@@BAB@BBB@DAB@@@B@B@@AB@@@@@BBDDB@BBBD@@BAB@@@BB@@@@BB@B@BBBB@@@B@@B@@B@@@B@B@@@@BB@@@B@B@@@@@BAD@AC@@@A@A@A@@DABA@@@@B@BAB@B@B@@@BBB@@BB@@B@@@@AB@@CD@@@@@B@@@B@@B@@@BA@@@AB@BCB@@ABA@@B@B@DBB@@@ABBBB@@BB@@BB@DA@@BA@@B@@@B@B@B@@BB@@@@@B@BA@BDBDBB@@@B@@BB@@@B@B@@AB@BB@@@B@@@BB@@@BBB@BB@@@@@BB@@@BAB@B@@@@@BBBB@@@DAB@@BDAB@@@B@B@BAB@@BB@@@B@B@@D@B@BB@B@@@@B@@@@BB@@@BB@@@@BB@@@@AB@@@@@B@@@@A@A@@BA@@@@BBB@B@BABBB@@@B@@@B@BAB@@@@AB@@@BAB@@@@A@@@@@AB@@@@BF@B@@@@@BBB@BB@@BB@@BBB@BBBB@BB@@@D@@@@B@@@B@@@B@@@@B@@B@@BB@B@@B@@@@B@B@@@B@@@@@B@BBB@@@B@@@@A@@B@@BB@@@@@BAB@@@@@BA@@@@@@BB@AB@@@D@@@BAD@@@B@BA@BB@@BB@@@@@B@BB@@BBDB@@@BB@@@@@@@@A@@BBD@BB@@B@@AB@@A@@DDBA@@@A@@BA@@BA@AB@BAB@BABA@@@AD@@AB@@@B@@@B@@@BA@@@@@A@@@ABBBB@@B@@@BBBBD@@@BD@@@B@@@B@@B@@B@@BB@@@@A@@@@B@B@@ABBB@@@BABA@@B@@B@@BBBBD@@B@B@@BA@@B@B@@@@BB@@@@@B@@B@@B@@BB@@BB@B@@@@B@BCB@@@@@BBABB@@@DA@@B@@@@@@B@B@@A@@@AB@BB@BBBB@B@@@@@BBBBBBABBBAB@BBD@BB@@BB@@BBBB@@@@@BABAB@B@BAB@@@@B@@ABA@@B@@@AB@B@@@@BADE@@@@B@B@BB@@@@BD@B@@BB@B@@BBBBB@@B@@BBB@BBBBBB@BB@@@BD@@A@@B@@@@@@B@@@BB@@@B@@BB@B@@B@B@@B@@@B@@@BBB@@@@@B@@ABA@A@A@@B@@A@A@@@@@@B@@B@@@@@@@F@B@@@@@AB@B@BB@@@@B@B@@ABA@A@A@@@@@BBBB@@@@@B@@B@@@@B@@@@@BB@B@BBAD@@@B@@A@BB@B@@@B@@BB@BBB@@B@@@@A@@@@@BB@@@@@@@B@@A@@@AB@@BB@B@@@DA@@@B@@@@C@@@@@@B@@DA@@@BBB@BBB@@BA@@@BB@@@AB@B@@@@@@@@B@@@@B@@@B@@B@@B@@BB@@BBBB@@@AAA@@BABBB@B@@B@@BA@@B@@@@@B@@@@@@AB@@@B@@A@@B@@@@@@@@@@A@@@@B@@@@@@C@@AA@@A@@@@A@@BA@A@@@@@@@AA@AA@@@@B@@@B@B@BDBB@@B@@@A@@@BCB@B@@@B@@ABA@@B@@@@A@AB@@@@@B@@@A@@B@B@B@@BA@@@@BAA@@A@@BB@BBBB@@@AB@@@@BBD@@BB@@@B@@B@@@@@B@@AAA@A@A@@@AB@@@B@@BB@@B@B@@@@BB@BA@@BB@BB@@A@@B@@@@B@@@@ABA@@@@@@B@@B@D@@@B@@@@@AB@@A@B@B@@@BD@@@@B@BA@@B@BAF@B@@@@@AB@@BB@@@@@@AB@@AB@@@BA@A@A@@@@@@@@BB@@@@@BD@@AA@@@@@BA@AB@BCD@B@BB@BBBB@@@@@@B@BA@@@@DBBB@@B@B@DA@A@AB@B@@@B@@@B@@@B@@BB@@@BA@@BA@AB@@@@BBB@@@B@B@@@@B@A@@BA@@@CB@@A@@@@@@@AAA@A@@@@@BB@B@@@@A@@@AA@@@A@@@BAB@@@@@@@@AA@@A@@@BDB@B@@@@B@@@@A@@@A@@@@@B@@B@@B@@A@@@BB@@B@@@@@@@A@@@AB@@@@D@@B@AC@@B@@@@A@@BB@@@B@B@@@@B@@@B@@@BBB@@BB@@A@@@@@@B@@@AB@@@@@@BB@A@AB@@A@@BBA@@B@@B@@@BAB@@@@A@@AA@@BA@@@@B@@B@@@@BB@@@@B@@@@@@@CB@@@@AB@@@@@@B@DA@@@BB@B@@ABA@@B@B@BB@@BAB@D@BB@@F@B@@A@@@@AA@A@@@A@@B@@ABB@@@@B@@BBA@@@@@@@A@@@@B@@@@@@B@@@@@@B@@@@BB@B@@B@@@@BB@@@BB@@@@@@@@B@@@@BB@@B@B@@@@@@A@@B@BA@@B@BA@@BA@@@@A@@A@@B@B@@@@AA@@ABA@@@@@@BAA@@ABB@AB@BA@@@@@A@A@AAA@A@@@A@C@@BA@A@@BBBAB@B@@@B@B@@ABAB@@@D@B@B@BBD@B@B@BB@@BB@@A@@B@@@@@@DA@BBB@B@@CA@@@@@BB@@@BB@@@@@B@@@@DB@@@BBB@B@@@B@@B@BBBB@@@@A@@B@BB@@@B@@@B@@@@@@ABABABA@@@@B@B@BB@@@AB@@@BC@AB@B@@@@@@@B@B@B@D@B@BADAB@@B@@BA@BB@@@@@@@@B@@B@DB@@@BBD@@@BBBBB@@B@BB@@B@DB@@@@B@AB@DABBB@BB@@@@BB@@BAB@@B@B@@@B@@@B@@BB@@@@@BB@@@@@B@B@@BBB@BBB@@@B@BB@@B@BB@BB@@B@B@B@@@B@B@@BB@@@B@@@B@@BB@BBB@B@@@BB@@@CD@@@@@B@B@BB@@@D@B@B@B@@@@@@BF@B@BAB@B@D@@@B@@@B@BB@@BA@@BB@@BB@@BB@B@B@B@@BAD@BA@BB@@@BA@@BB@@@@@BB@ABB@@B@@D@@@B@B@@@@@BABA@A@@@@B@@@@@B@BB@BBB@@B@@@@@BABC@@B@@@BB@B@@@B@@@@BAB@@A@@A@@A@@@@@A@@@@B@@A@@@@@A@@BA@@A@@AA@@A@@B@B@BD@@@@@@@AB@@A@@@@@B@B@@@@@B@@@@B@BB@@@@B@@A@@BA@@BA@A@@@@A@@A@@@AB@@AA@@A@@@A@@@A@@@@A@@@C@@@@@@A@ABC@@@AA@BABA@@@A@A@@@CA@@A@@@@@A@@B@BB@@BAB@@AB@@@@A@@AAA@@AA@@AB@@A@@AA@@@A@@B@@BB@@@@@BB@@DAB@BA@@@@@@BA@@@@@@@@@AA@@@A@@@BA@@BBB@@@@@B@@A@CB@@AB@@A@@B@B@@@BDBDB@D@@@@C@@AB@@@@@A@@B@@@@B@@B@B@@A@A@@@A@@@@A@@@FC@@@A@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@@@@@@@@@A@@@@A@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@A@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@AB@@@@@@@@@B@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@A@@A@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@A@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@A@@@@@@@@@@@@@A@@@@@@@@@@B@@@@B@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@A@@@@@@A@@@@@@@@@@@@@@@@B@@A@BF@B@@B@@@@@A@@@@B@BBB@BBBBDB@@@ADAB@BB@@A@@BB@B@DA@@@A@A@@@@@@B@@@B@@@B@@@@A@@@@@AA@@@@AA@@A@@BAB@B@@@@B@@BBB@@@D@@@@@@AA@@A@@@@B@@@B@@B@@@@B@B@@@BB@@B@@BB@@@AB@@BB@@B@B@B@@A@@@A@@@@@@@A@@B@@A@C@@@AAAAA@@@AB@@@B@@@@A@@BA@@@@B@BBB@BB@@B@@@ACA@AA@@@@@ABB@@BBBB@B@ABB@@@@@@@A@A@A@AB@@@@@AAA@@@@AA@@AA@BAAC@@BA@@@A@@BA@@B@@@@A@A@@B@@A@@BAB@@@D@B@@@B@@A@@B@BA@@B@A@@@A@AAA@@@ABAA@@@A@@@@@ABAD@@@@B@@A@@B@@B@@@BAB@@A@@@C@AAA@@B@B@DBB@B@@@@@B@@@BABA@@B@@A@AB@@A@@@@@@B@@A@@@@BAB@B@@@@@@@BB@@@@B@@@@A@@@@@@BA@ABAB@@BB@@@@@@B@B@@B@@@@@@A@A@@@@B@@@B@BA@@@AAC@@@A@@DAB@BB@C@@@@@A@A@@AB@A@@@@B@@@B@@@@@AA@@BAA@@AAAAA@@@@BA@@B@@@BA@@B@@AD@@@@AB@B@@A@BBB@@@@@A@A@@B@@@@AAA@@@@@@B@BB@AB@@@@B@@B@AB@@@B@@B@@DBBB@@@@@@AB@@C@@@@@@AC@@@ADB@@@@@D@@@DAB@@B@@@@BBB@@@@@@@@BC@@@@B@@@@@@A@A@E@@A@@A@A@C@@B@BB@ABA@@A@@AB@@@B@@@@ABB@@@@@@@A@A@@@@@AB@@@@B@@@BB@@A@@@C@@B@@@@D@@@B@@B@@@@A@A@@B@@@@A@@@@@AB@@@@@AB@B@@@@@@B@B@B@BA@@BBB@B@B@B@BA@@@@B@B@@@B@DB@@@@B@@@@A@@BAA@@@@@@AD@BAB@@@@@@A@@AA@@@C@@A@BA@A@@@AA@AAA@@A@@A@@@@A@@A@@@A@@@A@@BA@@@A@AA@@@AA@@@@@@@@AA@BAA@@A@@@@@AA@@A@@@@@A@@AC@AB@@A@@BA@C@@AC@A@A@@AAA@@@AA@@A@A@A@A@@@AE@@AAA@AA@@A@@ABAAAB@@AB@@@@AA@@@@@ABA@@@A@@A@@@@@@A@ABCA@@@ABABC@@A@A@@@AA@@C@@@A@@AA@@@@AA@@@@@@A@@@@@AB@BAD@BAAA@@A@@@@A@@BAA@@@A@BA@@@@@AD@DAF@@@@ABA@@@@AA@@AA@@@@BA@@BAA@@@@@AA@@AAB@BA@@@AAA@A@A@@@AA@BA@AA@BA@AB@@@B@BA@ABA@@@A@AAAAA@CAA@C@A@@A@@AAA@@@AB@@A@AB@@A@@@AB@@A@AB@@ABAB@@AB@@@A@@A@@@C@A@A@@@AB@@@BAAAB@@A@@BB@@BB@@BB@@DC@@@AB@@A@@@A@@BA@@@AB@BA@@BA@AB@@@@AB@@A@@BA@AB@@A@@B@@AB@BA@@B@@AB@BA@ADC@A@@@@AA@@@@BA@@BA@@B@@@BA@A@@@A@@@@AAAA@@@A@@@@@@AA@@AC@A@@A@AA@@AB@@@@CCAA@AA@@AAAB@@AB@@@BA@@B@@A@@@@BAA@@A@A@@AAAA@@BC@ABA@AB@@ABAAA@@@@A@@@@@@@ABA@@@@A@@@A@@@@CAA@A@@A@@@A@A@A@@@@@A@@@@B@@@AA@@AA@@@@BA@@A@@A@@A@@BAA@@@@@@@A@@AA@@A@B@@A@@BA@@A@@@A@@A@@@A@A@AA@@A@@@@C@A@@@@@A@@@@@@AA@@A@A@@@ACA@@BABA@A@@BA@@AA@@@A@@@A@@@@@@BA@@@@AA@@@@AAA@@@A@A@@@BABAB@A@A@@@@@BA@@BA@@A@@@@@@@B@AAB@@@@@@@@@@@DABA@AB@@@@@@A@@A@@@@A@@@@@@@@A@@A@@@@A@@@@@@@B@B@@@@@@A@@B@@@@B@@A@@@@@@@B@@@@@@AAA@@AA@A@@@A@@@@BA@A@A@A@@@A@@B@@A@AB@@A@@@@BA@@AA@@@@A@AB@@AB@@@@@@AB@@@@@AB@BA@@@A@@A@@@@A@@@@@BB@@@B@@A@@@@@@@A@@@@@@@@BA@@@AA@@@@@@A@@@@B@@@AA@@@@@@@A@@@ABA@@@@@@@A@@@@@@@A@@@@@AAB@@@C@@@@@B@@@B@@@B@@@@A@@@@@@A@@@A@@A@BA@@@AB@BA@A@@B@@AA@@@A@@@A@@AB@A@B@@A@@@@@AB@A@@AB@@@AAA@@BA@@@@@A@@@A@A@A@AA@B@@BA@@A@@@@@A@@@A@@A@@@AA@@@@A@@DABA@A@@@@A@ABA@@@@BC@@@A@@@@@@@A@@@@@@A@A@@@@@@A@@A@@A@BA@A@@@@A@@@@@@@@A@AB@@@@@A@A@AA@@AA@@@@A@@A@@A@@A@@C@CB@@@@@@A@@BA@@@@@@@@A@@@A@AA@@@@A@@B@@@@@@@@@B@@@@A@@BABA@@BA@@@@B@@@@@B@@@@@@A@@@A@@@@@@AB@@A@@@AA@@A@A@A@@@@@@A@A@@@@B@BB@@B@@@@@@A@@@A@A@@@@B@B@@@B@@A@@@@@AB@B@D@@@@@AA@@@@B@@AA@@@@@@@@@A@@BC@A@ABA@@AA@@@@AB@B@@C@AB@@@@@BB@@@@@@B@@@@AB@@@@@B@FA@@@@@A@A@@B@@A@@A@@@A@@@@A@@ABA@@A@@B@@@@@DC@@@@A@AAA@@A@@@@@@@@B@@@B@@@B@@AA@@@@AA@@ABA@@@ABA@B@@B@@BB@@@BCB@AA@C@@@@A@A@A@@@@A@@@@A@@@@@A@@@@@@@@@@@A@A@@@@BA@@@@@@@BCB@@@@A@AB@@@@@@B@@A@B@@@@B@@BA@@B@@A@A@@@@ABA@@@@AAB@AB@@A@@B@BAB@@@@B@B@@B@BA@@@ABA@@@AB@AA@@AA@A@@@@@A@@@@@@@@@A@@A@@A@@BA@@A@AA@@@C@A@@AA@@@A@@BBB@@BB@BB@@@@@A@@B@B@BBB@B@@A@@DB@@BCBC@A@@@ACAA@@@@A@@B@@A@@AA@@A@@@A@@AC@AA@@@AA@A@@BA@@@@@C@@AA@@@@A@@@AAA@@AAECAAAACA@@A@@@A@@BA@@@A@@AABA@@@A@@B@@AA@CA@@@A@A@A@A@@AAA@@A@AAA@AAA@A@@@@AA@A@AA@@@@DADA@@@@@AAA@A@AAA@@@@@@A@@@A@CAA@AA@C@@BA@A@@@@B@@@@A@@@@@A@A@A@A@AA@@@@AC@C@A@A@@@@A@@AA@C@@ECAACA@BA@@@A@C@@AC@ABCCA@@@A@A@@@AAA@A@A@AAAAC@CB@@C@@@C@@@C@A@@@AAA@@@BA@C@@AA@@AA@@@AA@ACAA@AAAAA@@AA@A@A@@A@BAAA@AA@@@@AAAAA@@@@@AA@AC@A@@AC@A@@@@AAA@@@@@ABCB@@A@@@A@CB@@AA@@CB@@@@ABAB@@AB@@C@AA@@A@C@A@CB@@A@@BAAABA@A@@A@@AAA@@AA@@@A@AABA@@A@@@BA@@A@@A@@@@B@BA@@AAB@@@@@AA@@AA@BA@AB@@@@BB@@@@A@AA@@BA@@@@@A@@A@@A@@AB@@@BBB@@@@A@@@A@AA@BA@@BAA@@@@@@@@BAAC@@A@ABA@AABA@@@AB@@@@A@@A@AA@A@@@B@@AAA@@A@@A@@A@@A@BAA@BA@@AA@A@@@A@@DA@@@AFA@A@AB@@@BA@@@@@A@@@A@@@AB@@A@A@@@@BAACA@@A@A@A@@@AA@@@CBAC@@@AA@AB@@A@@B@@A@@@C@@AABA@A@A@AA@@@A@@EAC@@AA@@@A@A@@@A@@@A@@@A@@@AA@@A@@B@@A@@A@@@@A@@@A@@@@@@B@@A@A@@@@@A@@@@@A@@@@A@@@@B@@@B@B@@A@@A@A@A@@@AAA@@@@@@A@@B@BB@@BA@C@AA@A@CA@C@AAA@A@C@@@A@@@A@A@@@@@AAA@@C@A@@@BC@@@A@@A@@AAA@A@@AA@A@AAA@@@A@A@@A@AB@@@@A@@A@@A@@@AAA@@@@@AB@@@@A@AAA@@@@@CCA@@A@@AAAA@C@AAA@@BA@@AA@@@@AAA@@A@C@@@@@@AB@@A@@A@@@@A@AA@@@B@@A@@@@@A@@A@AA@@A@@A@@@@@AB@@@A@@@@@@@@@@@B@@@AA@@@A@@@A@@@@AA@@AB@BAB@@A@@@A@@D@@@A@@@@AA@@@@@A@@@@@A@A@@@@AA@@@@@AB@@@@@@A@AAA@A@@BAB@@@BAB@B@@@@ABA@"],encodeOffsets:[[123173,30066]]}},{type:"Feature",id:"330683",properties:{name:"嵊州市",cp:[120.831025,29.56141],childNum:1},geometry:{type:"Polygon",coordinates:["@@ACA@@@@A@@AB@@@A@@B@A@@@@@AAAA@@A@@@@B@@A@@@A@@A@@@@@@@BA@@A@@@@A@@@@@@@@@@@A@@@@@@B@@@@A@@@@@@BA@@AAB@@@@A@@@@@AB@@@@@BBB@@@@@B@@@BA@ABA@@B@@@@@B@B@BAB@BA@@@@@A@@A@@@AA@@B@@@@@BB@@BA@@@@@AA@@@B@AA@@B@@A@@AAB@@ABAA@B@@@@@A@@A@@@A@@A@@@@A@AA@AA@@@AC@@@A@@BB@BB@@@@B@@@@@A@@@@@@@@AA@@@AAAB@@@@@@@@@@@B@B@@A@@@@AA@B@@@@A@@@A@@@@@@A@@@@@@ABAA@@@AA@@@@@@@AB@A@@@A@@AB@@A@AB@@@B@@B@@B@@@@AB@@A@@BA@ABBBB@@B@@@B@@A@@B@@@@@B@@B@@B@@@@@@BB@B@@@@@@B@@@@@B@BBB@@BBBA@@@DB@B@B@BCB@@@@A@A@@@AB@@@BABA@@@A@AB@@@@A@@AA@@@BB@@DBDABB@@@@AB@@A@A@@BAAAB@@A@@@A@@@@@@@A@@@@@@AA@@AB@A@@@@BA@@@@@A@@AB@B@@@@@A@@@@@@@@AA@@@A@A@@@@@@@@A@@@@@@@BA@@@@A@@@@@@@@A@@B@@@@B@@@@@AB@@@B@B@@@@@AA@@@CA@D@@AB@@@@@@@@@B@@A@@@A@@@BA@@A@@@@@@B@B@BB@@@AB@@@B@@B@@@@@@B@@@BB@@@BC@@B@@D@@@@BB@@B@@@AB@@@AA@@@@BB@B@@B@@AB@@@@AB@@B@@AB@@@@@@@@B@@@@@@@@@B@@@BA@@B@@@AB@BA@A@@@@@@@B@BABBBA@@B@@@@@@@AA@AAAA@@@B@@@BB@@@@@@@BB@@BB@@@@A@@@@@AA@@AA@@AB@@A@@BA@@B@@@BB@@@A@A@@@@@BB@B@@@B@B@@@@@AA@@@@A@@@@@@@A@@@BA@@@@@@B@BC@@B@@@@A@A@AC@@A@@AABAAABGAA@@@BD@@B@@@@@@@A@A@@@BBB@@@@@@@A@@@A@A@AB@@@@@B@@@BA@ABA@@BA@@@@B@B@@@@CB@@B@@BA@@BAAA@@@@@B@@@@A@A@@@@@A@@@@@@AB@BA@@@@@@@@B@@A@@B@@AA@@A@A@@B@AABB@@@ABA@AB@@B@AB@@@AA@@@@@A@@@A@@B@@@@A@BB@@@@@@@@A@@B@@B@B@@@@@@B@@@@B@A@@BA@@@@@@@@@A@@@@@@@@@@B@@@BB@@@B@ABA@B@ABABB@@@AB@@AB@@@BA@@@@B@@@BB@@BB@@@@@B@@@BB@@@B@@@@@@@BB@@@@BA@BB@@A@A@CAA@@@A@@B@@AB@@B@BD@@@@@BAB@D@B@@AB@@@@@D@@ABB@B@@D@B@@@B@@@@@B@@B@@B@@@AB@BBB@@@C@@@ABAB@@BBDB@@@@B@@@@@AB@D@@A@@@@@@B@@@BBB@@@@B@D@B@@B@B@@B@B@@@@@A@@B@@@@B@A@@@@@@@B@@@@B@@@@@@@B@@BA@@@@@@B@@@@@@B@@@@@@A@@B@@@B@@@@A@@@@@A@@B@@@B@@@BA@@@B@@BA@@@@@@@@BA@@@A@@@@@@BB@@@@@AB@@@@A@AB@@@@@BA@@B@@AB@@@@A@A@@@C@@@A@@@A@AAA@@BA@@B@AA@@@A@AA@@AAA@A@AAC@@@A@@@@@BBB@@B@@@DBBBB@BAB@B@@A@AB@BABAB@B@B@@A@@@A@@@@@AAA@@B@B@@@@@@A@@B@@@B@BA@DD@@B@AB@@@AA@@@A@@@A@@@AA@@A@CA@@AB@@@@@BA@@F@@B@@@@BCAA@@@A@@BABCBA@@BA@@@A@ABA@@B@B@@A@@@@@@BAB@@@DBBB@DBB@@@B@@@@@@@BB@B@BBB@B@@@@CBCB@@@@BBB@B@@B@@B@B@BBB@BBB@@@BB@BB@B@B@B@@@B@@DBB@@@AB@@@B@BA@BB@@@B@@AB@@@B@@@DBBBBBFDBB@@BB@B@@@B@@B@@BD@@@@@B@@AB@B@@B@@BBD@@BB@@@B@@@BB@@@BA@@@@B@@B@DB@B@@@BADAD@@CA@@@BA@A@AAA@A@@@@B@@@@AAA@@AA@AA@@@B@@BB@@@B@D@@BBB@@@AB@@@BB@@@@B@@@@@@@@@B@@@@@BBB@@BBA@@B@@AB@B@@ABA@@@@A@A@@A@ABA@@@@BA@@BBA@B@@B@BA@@@@@B@BA@@@AB@@@A@@A@B@@@@A@@@@A@@B@B@@A@AD@@@@@@B@@A@@B@B@@@@@@@@@@@B@@@@@B@@@@@@B@@B@B@B@@@@@@DBBA@AD@@A@@AA@@@@AAB@B@@AB@BB@@B@@B@@BA@@@A@@@A@@@@@@@@@@BB@BBB@@@@@CD@@@@A@@@@BB@BA@@@B@@B@@@B@@@@BA@@@@B@B@@@@EBA@@@@@A@@B@@A@@@@@@@AA@@@@A@@B@DA@A@@B@@B@@BB@BAB@D@@AB@@@@@@@@@B@@BA@@@@@BB@@@@C@A@A@@B@@@@@BA@@@A@A@@@@@@B@B@@@B@@@@A@@@AAA@@@@@@B@B@@@@B@B@B@@@BB@@B@@@BA@@@@@@@B@@@B@@@@A@@@@@A@@@@@AB@@ABAB@@@B@@A@@@@@@@@@A@@@@B@@B@@B@B@@@B@@@@@@B@@AB@@@@@@@DAD@@@@BB@@@@BB@@@@@BB@@BBB@B@@@@@A@@B@B@@@@@@B@@@@@@BABB@@@@BB@@@@@@@@B@B@@@@B@@@@@@@B@@@D@@A@@B@BAB@@@@@@BABCB@@@B@@B@@B@@@BB@@@B@@@@@B@@@AB@@@ABBB@B@B@@@B@@@@@B@@AB@BB@@A@@BB@A@@B@@@@@BA@B@A@@BB@@@B@@@B@@BA@@@@BABA@@B@@ABB@@@@B@@@B@@@@B@@@@@@A@@@A@@@A@@@@@D@@BA@B@@@@@B@@@@@@@B@@@@@@AB@B@@@B@@@@@@BB@@A@@@@@@B@@@@B@@B@@AB@@@@@@@@@B@@@@@@@BA@@@A@@A@@@@@B@@B@@@@B@@ABA@@B@@@@BA@@@@@@BA@@BAB@@@@@BB@@AB@@@@@BA@@B@BA@@@@B@@@B@B@BAB@@@@@B@@@BBB@@BB@B@@@@A@@@@@@@B@@@@A@@A@@@@B@@@@A@A@@@@@@@B@@@@@@BB@@@@@@@@@@B@@B@@@@B@@@@A@@BABCB@@@@@@@@@@A@BBA@@@@@@@B@@@AB@@AB@@@@B@B@A@ABAB@@B@B@@@B@BB@@@@BB@@@@AB@@@@@@@B@@@B@@BB@@AB@@@BABAB@@DB@B@@@B@BB@@B@@@@B@@@@@B@D@@@@@@BB@@B@B@B@@@BB@@@B@@@AB@@@BA@B@@@BB@@@B@@@@@@BB@AB@@@@BB@@@AB@@@@BB@@BB@@A@@@@@@B@@@@@B@B@B@@@BB@B@DB@@@@@B@@@B@@B@BA@@@@@@B@@@@@BBAB@BA@@BAB@BAD@@BBBB@@@B@BB@AB@@B@DB@@B@B@B@B@@@B@@@@@B@@@B@@@BBB@BB@@@@@@ABA@@@BB@@@@B@@@B@BB@ABA@@@@@@@AB@@@@@@A@@@@B@@A@BB@@@B@B@@C@@BD@@@@@@AB@@BBBB@@A@A@@@ABA@@@A@C@A@@@@@@@@B@@BB@@BB@@@B@@@@@@@BB@@@@@@@B@@@A@@@@B@B@@@@@BA@@B@@B@@@@A@@@@B@BA@@B@@AB@@BB@B@BB@@B@@A@@@@A@@AAA@@@AB@@@B@@A@@B@BB@@@@A@@B@@B@B@@BA@A@@@@@B@B@B@@@BABB@@BA@ABA@@@ABABB@AB@@AAA@@@@@A@ABBB@B@@B@@A@@@@B@@@B@@B@BB@@B@B@@@B@@@@BBBBBBBB@BB@@@BB@@BBB@B@B@@@@AB@@@@@@A@A@@B@@@B@@DBBB@@A@@@B@@@@@B@@@@@BB@@@BBB@@BBB@@@@@@@BB@@BA@@@@BA@@@BB@BD@@@@@@BA@@B@B@@A@@B@@@@@B@@@B@@B@@BA@@@A@@B@AB@B@@BA@BA@@BB@@@@AB@@@@@@BB@@A@AB@@A@@@B@B@@@@@@A@@B@@B@B@@@@@@@@@@@@@B@@@@@@@@@@@@B@@B@@@@@@@@@@B@BBA@BB@@@@@AB@@@@@@BBBB@BB@BBBBB@@@BDB@B@B@@@@@@B@@B@@@@BA@@@BBB@@@@@@B@@@@@@@@@@B@@@@@BAB@BB@@@@@@BBB@BA@@BB@@@@DBBB@@BBB@@@B@@BB@@@BAB@@A@@A@@A@@@@BA@A@@@@@@@@B@AAA@@@@@@@B@B@B@DB@BFADA@@@@@@@@AA@@B@@@BB@@B@@@AA@@@@@@@@B@B@@@D@@@B@BB@@A@@@BBB@@@@A@AB@@AB@B@@@BB@@@AB@@@@@@@BB@@@@B@@@@@@@@@@@@@A@@@@BB@@@B@@@AAB@@BB@@@B@@@@@BBB@@@@@@B@@B@@@@@@B@@@@@@BB@@BAB@ABA@@@@@D@BAA@@@@A@@@@@@D@@@B@@AA@A@@@@A@@@@@@@BA@@A@@A@BA@@@@@@@@@@A@B@@A@@B@BB@A@@@A@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@A@@@@@@@AA@@@B@@@@AB@@@@@@@@@@@@@B@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@A@@@@B@@@@@@@@@A@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@B@@A@@@@@@@@@A@@@@@@@@@A@@@@@@AA@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@B@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@A@@@@@@@@@@@@A@@@@@@@@@A@@@@@@@@A@@@@A@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@A@@@@AC@ABC@@B@@@@@@@@@B@@@@@@@@@@@@@@@BABB@A@A@@@ABA@ABAB@@B@@@@@@BA@@BAB@@AB@BA@@AAA@@@B@B@B@D@@AB@@@B@@A@A@@@CB@@B@@B@@BBB@ABAB@B@BADBBB@@BBBBBBBBB@B@B@@BD@@B@@B@BB@@@@@@BB@@@@B@BA@@@B@@@@BA@@@@@@B@@@@@A@@B@@B@@BBA@@@AB@@@@B@@@@@@@@@@@@@@@BB@BA@@@B@@@B@@A@@B@@@@BA@@@@AB@@@@B@@B@@@@@@@@@@B@@B@@@BB@@@BB@@@@@A@A@@@@@B@@@@B@@@@@BBAB@@AB@@@@@@A@BBA@@@@@@BB@@@@@BB@@@@@@@@B@@@@@@@ABB@@@@@@AB@@B@@@@@B@@A@BB@@@BB@@BB@@@A@BBB@@B@@@@A@@@A@@@@@@B@@B@@@@@@@A@@@BBB@@BAA@B@@B@B@@B@@@B@@@@@@@@AA@@AA@@@@BB@@@BAA@@A@AAA@@@@@@A@@A@@@@@@BA@@@@AA@A@@@@@AB@@@@A@@@@BAB@@@B@BAB@@A@@B@B@@@@@@BA@@B@@@B@@@@@@@B@@@@@B@@@@@AB@@BB@@@BBBA@@B@@@@@BA@@BA@@BBBB@@@@@B@@@@@B@@@B@@@@@B@@@B@@BBB@@@AB@@BB@@B@BB@@B@@@@@B@B@BBB@BB@B@@B@@@@B@@A@@BAB@@@A@@A@@@@@@@@@ABBB@@@B@DBB@@@B@BBD@B@B@B@A@BB@@C@@@@BAA@@AB@@B@@B@@A@@B@B@@@@@@B@@@BB@@@@@B@@@@@BB@BBB@B@@B@B@@ABA@@@BB@@@@@B@@@B@B@@@B@@@B@@B@@@@BB@@B@@B@BB@@B@@@B@@@@B@@A@@@@B@@@B@@@@@@A@@@@@A@@@A@ABAB@BB@@@@@@BB@@@BB@@@@@B@@@BB@@B@@@B@@B@@@@B@B@@@BABA@ABBBBB@B@@@B@A@@@@B@@A@@@@@B@@@@B@D@B@@B@BB@@BAB@BA@@@@B@B@@BBB@@@B@@B@@@@ABAAA@A@@@ABAB@B@@@BAD@BB@@ABB@AB@DAB@@@BAB@@@BBB@ABA@@@AB@@@@F@@BB@@A@@@A@@BB@@@@B@@@BB@@@@B@BAF@B@D@B@@@@BB@@@B@B@BB@@B@B@@BB@@@B@BBB@BB@@BB@@@B@@B@@@BD@@B@DB@BB@@BB@BAB@BBB@B@@@@BB@@B@@B@BB@@@@@@BB@BB@@@B@@@BBB@B@@@B@@BB@@@BBB@B@B@@@BA@@D@B@B@@@@@B@@@@@B@B@@AB@BBB@@@BAB@DBB@B@BAB@@@B@B@@@B@DB@@@@BBB@@@B@B@B@BBB@AA@@AA@ACCAAAA@A@A@A@AB@BCA@A@@@BA@@@A@@@ABA@@BA@@@@@@@A@@BA@@@@B@@@@@B@B@B@AA@@@A@A@@A@@@@B@@@EA@@@A@B@@@@A@@AA@@A@@A@@BABA@A@@@AB@@@@@@ABA@@B@@@@@@@@ABAB@A@@@@ABA@@DA@AB@@A@@A@@@@@@@@AB@@@@@B@@AAA@A@A@A@@@@DA@@@@@@@@@A@AB@BBB@B@BG@A@A@E@C@A@EB@B@B@BA@@BB@@B@@@@@@BB@@BB@@@B@@@B@B@BAB@BA@@@A@A@@@AA@@AA@A@A@AAA@A@C@A@@@@A@A@A@@@@B@B@@BB@B@EE@@@AAAA@C@@BAB@@@@@AA@AB@@@@@@A@AB@@AA@@BA@@BA@@B@DAA@@AB@@@B@D@B@@A@@BABCDCBA@@BA@@B@@A@@BBFAB@B@B@B@B@D@BBBA@B@@B@B@FAB@@@@@B@A@@B@@B@@@D@BB@@@@A@@@@BA@@BA@A@@B@@A@@B@@A@@@A@@A@@A@@@@DB@@B@B@BB@@@BBBBBBB@B@@@B@DAB@@AB@BADAAA@ABABADCDGBA@A@@@@AAA@CA@@@ABA@A@@@AB@@@DB@@B@@A@ABA@@B@@@@@DBAB@B@@@@BBBBB@B@@BB@@@B@@@BB@@DB@BBA@ADB@@B@B@B@B@@AAA@@CA@A@A@@B@B@B@BB@A@AAABC@@BBB@B@@B@BB@@@B@@@BBBB@@@B@@BB@@@D@@F@@BABB@@@@A@BB@BBBB@@@@@@B@@AAA@@BAB@B@@@@@@@B@@@B@@B@@@B@@@@B@@@@B@@B@D@@BFB@@@@@ABABAB@@@@A@@B@@AB@@A@A@@@A@A@A@A@@B@@ADA@AB@B@B@D@B@FBBAB@BBB@B@@@B@B@BBB@@BB@@BB@@@DBBB@@@B@@@BB@@BB@@B@B@@HAB@D@@@BA@@B@B@B@BABA@@@@BAB@@@B@@BB@B@@@B@B@@A@@BA@A@@@A@AAA@@AAA@A@A@@A@@@@BABAB@B@B@@BBB@@B@B@@@@@@BA@@BBB@@@@@@BA@A@@@A@@B@DBB@@@B@@B@A@A@@B@@AB@@A@ABC@A@@@@B@B@B@@B@@BB@@@A@@@@@A@AA@AA@B@@AA@@B@B@@@B@@@AA@@@@@@@@@@@A@@@@B@B@@@B@@@A@@AB@@A@@@BB@B@@@AB@@D@@BB@B@BB@@@@@@@A@@@@@@@@@A@@@@@@BBB@@B@@@@@@@@AA@ABBB@@@BAD@D@@@@@@@@@@@B@@@@@B@B@@A@@@@@@@@A@A@C@@@@@@@BA@@@@@@DA@@@@@@DB@@@@@@AA@@@@B@@@B@@@@@@@@@B@@A@@@@@@C@A@@@@@BAB@B@@@@@@@@@@A@@B@@@B@@A@@@@@@A@@@A@@@@@@A@@@B@@@@A@@B@@A@@B@AA@B@@AA@@@@@@@@B@@@B@@@@@@@@A@@@@A@@A@AA@@@@A@@@A@A@@@@AA@C@A@@@@@A@@BA@ABA@A@@@AA@@@@BA@@CAA@@A@@@A@@@AA@@@@@AAABDB@@@@BBC@@@A@@@A@@AA@AAA@AA@AAAAADA@@@A@@@A@@@E@@@@@@B@@B@@@@@A@@B@B@BA@@A@@@BA@@B@@@@AAAB@@@BB@@@@B@A@AA@A@@BABABADAD@@@BA@@A@BA@@@AA@@@@@@@@A@@@@@AA@@AA@@@@@@@A@@B@@AA@@@@AA@@@@@A@@@@BAA@@A@@B@@@@@@A@@@A@@@@@@AA@@A@ABA@@B@B@@@@AAAAA@@@@B@@@BA@A@@@@@@A@@@@@@A@AB@@@AA@@@@@BAA@@A@@AB@@@@AA@@@@@@AA@@A@@@A@A@@B@@@@B@@BA@@B@BCAA@AA@@@@@B@@B@BB@B@B@@@@@BA@@@AD@@A@@@A@A@@@AA@@AA@AA@@AAA@A@A@@B@@@BAA@@@BA@@@@@AB@@@@@AA@@@AA@@AD@BA@@B@@@@A@@B@@AB@B@@A@@@BBB@@B@@@@A@@@@B@BA@@BB@@B@@A@@B@@@@@@@BA@@@@BAB@@B@@B@@@BAD@B@@@B@B@@@DB@@@BBB@@@@BAB@@@B@@@D@@A@@B@@@@@B@@@A@@@B@@BBA@BBBB@BCB@@A@@B@BA@A@@@@@A@@B@@@@@@@@@@AB@@@@AA@@@@B@A@@A@@A@A@@A@@A@@@@B@@AB@B@@@B@@B@@@B@@BBBB@@@B@BAB@B@@B@B@@BB@B@@@BA@@@B@@@BB@@B@@@B@@@@BB@B@@B@@@A@A@A@@@A@@B@@@@DB@B@@@BB@@BB@B@B@@@BB@@@@@@BB@@@@@BBB@B@@@BB@@@@@BB@@BB@@BB@@@B@D@B@@B@@@@BBB@@@@BB@@@BBB@@@BBB@@@@BB@B@ABBB@@A@@B@@AB@@@@C@@@AAA@@@A@@@@@AA@@@@@@AB@@AACBB@AB@@@@AB@@AA@AA@@@AD@F@D@B@@@@A@A@A@@@@A@@AA@@A@@B@@CD@A@A@@@A@A@@AA@@AA@@@@@BA@A@@@A@A@AB@B@B@@@BA@@@@@AB@BADC@@B@AA@@@@B@D@@@@A@A@AABAA@@AA@@AA@@B@BBD@BAAAB@@A@A@@@@@A@@@@@C@@@A@@BA@A@@BA@@AA@AA@@A@A@@@A@ABABA@@@@AA@@AAA@@@@@@@A@BA@A@@AA@@BA@A@AB@B@@A@@B@@A@@@@B@B@@AB@@@D@B@B@@A@@BA@AB@@@B@@@DB@@BB@A@BB@@AB@BBB@@@B@@A@@@@@AB@BAB@BA@@B@@@B@BA@@@@AB@@@@B@@@B@@@B@@@@AB@@AB@@@B@@AB@@@@A@@@@@A@@AA@@@BA@@A@@@A@@AA@@@ABA@@@@B@@@@@@B@@@@@@@AB@@@B@@@@@B@DCB@@A@A@@A@@A@@@@BA@AAAAA@AA@@A@@@@B@@A@@B@@@B@BBB@@A@@@@BAB@@@@B@@B@@@@A@AB@B@@@@@@AA@@@AAEB@@A@@ABA@C@@BAA@@AA@@@C@A@@@AA@@@AA@@A@@@@B@@A@@B@@A@@@@AA@@@@@@BA@@@@AA@@A@AAA@A@@@AA@@A@@AB@BAB@@A@@@BB@@BB@@@BCBA@@@BDBB@@BB@B@@B@@BABA@@A@@A@A@@BA@A@A@ABA@A@@B@@@@CBA@@@@@AA@@@CA@A@@@A@A@A@AA@@@A@@AA@@@A@@@@A@@@A@@AA@A@@@A@A@@@@@A@A@A@@@A@@@A@AA@@AB@@@@ABA@@AAA@AA@@AA@@@A@A@A@@@ABAAA@@@A@@@@@@BA@@AA@@@A@@@A@@@A@AA@A@@AA@@@AA@@@AA@@@@A@@@C@C@@@@@@AAAA@@@AB@@AA@@AB@@A@@A@CA@@AAA@@AAA@@AA@A@@@A@@A@@@@A@@@AA@@@@A@A@@@BA@@@A@@A@@@@A@A@A@@@A@@@A@CD@@AB@@@A@@@@@@@@@AA@@@@@@@@B@@@@@@@B@@@@A@A@A@@@A@@@@B@@A@@@@@A@@@@@AA@@A@ABA@@@A@A@@@A@@@A@@B@@AB@@@BAAAA@@A@@@@BA@C@AA@@A@@AAAA@@@AAA@@@@B@@AB@@A@A@A@ABABA@A@@@A@A@AAA@@@A@@@@@A@@BA@@BA@ABA@@A@@C@@@AA@@AB@@ABA@ABA@@@AA@@A@A@@@@A@@@@A@CA@@A@@@A@@BA@@@A@@B@@@@@@B@@B@@@B@@@B@@@@@BA@ABA@@@@@@BBB@@BBB@BD@@B@B@@@@@@@B@@@@@B@B@@@B@@B@@@@AB@B@@A@@B@@@B@@@B@@AB@@@@@B@@@B@@@@A@AB@@@B@@@@@@AAA@ABA@C@A@CBA@A@@@A@A@@@ADAB@@EA@@@A@@@@@AA@@@@@@@BB@@@B@@@B@@A@AAABA@@B@@BB@@@B@D@@@BCB@B@@AB@@@@A@@B@@@B@@AA@@@A@@@@A@@BA@@@@@A@@@ABA@@@AB@@GB@@AA@@@A@A@A@@@@@AAA@A@@B@@ABA@A@A@A@A@@@A@@C@@@AB@@A@@@@@AAA@AA@@AAA@A@@@A@A@@@@B@@A@A@@@@@@B@B@@ABCB@@AB@@@@AB@@@@AA@@A@@@AB@@@B@BA@@@A@@@@@A@@AA@@B@@BB@@@B@@AB@@A@@@CB@@@@@A@A@@A@@@C@@@@@@@@A@@@C@@A@A@@B@@@@@@@@@B@@@@A@@@@@@@@B@@@@A@@@@A@@BA@@@@@A@@@AB@@@@@@BB@@@@@BAA@@@A@@AAB@AAB@@A@@@@@@@AA@@@@@B@@A@@BB@@@@@B@@@@B@@@B@@AB@@@@A@@@A@AD@@@A@A@@@A@A@@BABABEBI@@@@A@AA@@AD@@ABAB@@A@@BA@@@@BAB@@AB@@A@@@@BA@@@A@@BAA@@AAA@AB@@ABED@@@BA@@@@BA@@BA@E@@@A@A@A@@@ABA@@@@@@@BBA@@@A@A@AA@@CB@@@@@AA@@@A@@@@AA@@AA@@AA@@@@@A@A@@@A@@@A@@@A@@@@@A@@@A@A@@A@@AC@@@@@AB@@AB@@A@@@A@@@A@@@A@@@BA@@@@A@@@@BA@A@@A@@@A@A@@@@A@@A@@@A@@A@@@A@@A@A@ACA@@A@@@@AA@@AAA@A@AA@BA@@@AB@@@@A@@@B@AA@@A@@AA@@@@@A@C@@@A@@@@@@A@@AB@@@B@B@@ABAB@@A@@@AB@@A@@@@@@@C@@@@B@@@@C@AB@@@AA@@@@@A@@A@@@@B@@@AA@@A@A@@@@A@@@@A@@@@@C@A@C@AAA@A@@@ABBBABABAAC@@@@@ABAB@@@@@B@@@@@BA@CAAAA@AAAA@@@@@C@@@A@@@@AAA@C@E@@CAAAA@@BA@@AA@@@@@@@@AA@@A@@@@A@@@AKGCC@@@A@@A@@AA@AA@A@@@@@A@@A@AA@@A@A@A@@@@B@@@BA@@AA@@AA@@@AB@@AA@@A@BA@@@A@@BB@@@A@@@A@@@@@A@@@@B@@@@A@@@A@@A@@@@@@@A@@BA@@@@BA@A@ABA@@@AAA@AA@@@@AA@@AB@B@@@@AAAB@AA@A@A@@BA@@@AA@@A@AA@@@@AAC@@@@@@AA@@@@@AA@@@@@@@@AA@@A@@B@@A@@@@@A@@AAA@@@@@@CA@@@@A@@A@@A@AA@@A@@@EC@@CA@A@A@A@A@C@AB@H@D@D@DBD@B@@@B@@@@A@AEAA@@ABA@A@@@A@A@A@@@ACAA@@@A@@@AA@@@CA@A@@@ACAA@A@AAAAC@@ACA@@@AA@@@A@A@A@@B@B@@A@@@@A@BA@@@A@A@AAC@@@C@@@ABA@ABA@@@A@AAA@A@A@AAA@@@@A@BA@@@@@A@@AA@AA@@A@@A@BA@@A@@@A@@@A@A@@@AAA@ADBBAD@@@B@B@@A@A@@@A@@@AB@@@@@B@@@AA@@@@@A@@@@@A@@@@BA@@B@@@@@AA@B@@AA@@@@@@@@AA@@@AB@A@@B@@@@@AA@@C@@@B@@@@@B@@@@@@A@@B@@A@@@@B@A@A@@@@AB@@@@A@@@@@@@@@@@A@@A@@A@@@A@@@@@A@@@@AA@@BA@A@@@A@A@A@A@AAA@@@@AA@B@@@@B@@@@B@@@@@B@B@@@@@@@@@B@@@@@B@B@@@@AB@@@@A@@@@AAB@@AA@@@@A@@@@@BB@@@@@@@@@@@@B@BB@@@@@@@@B@@@@@@B@B@@@@A@@@@@@@@A@@A@@@@BAA@B@@@A@@@BA@A@B@A@@A@@A@@@@@@@BB@@@@@@@@@@@@A@@@@AA@@@@A@@AA@@BA@@AA@AB@@A@A@@AAA@@A@@@@AC@@@A@@@A@AB@@@A@AABAAA@@BA@AAAAA@A@@B@@ABA@@@@BA@@AAA@CCAAA@A@@AA@@ABABA@A@@AA@@AA@A@@AA@ABA@A@@@AAA@@@@AA@AC@@AA@@AA@@@@A@A@@B@@A@ABA@A@@AA@AAA@AA@@AA@A@A@AA@AB@AA@@AA@@@AA@@AAAAA@@@@@AA@@A@C@@A@@@A@@@AA@@@A@@@A@A@A@@ACA@@ACA@@A@A@@AA@AB@@@@AAAFA@@@C@@@EA@A@@AAA@@@@A@ABA@@@@@@AA@A@@@ABAB@@ABA@@@A@@@@@@AA@@@AA@@@@A@AB@AAB@AA@@@B@@AA@@@BA@@@@@@B@@ABB@@@@BA@C@@@A@A@A@AC@@@@D@B@@@B@BBB@@@@A@@@@@A@@@@@A@@@@@A@@A@AA@BA@@@@B@@A@AAABA@@@A@@@E@@@CB@@@AAAAAA@AA@@A@AA@@AACB@AAA@@@@@A@AA@@@A@@A@@AC@@@AAA@@A@@AA@CAA@@@A@CB@@C@@@A@@A@@@A@@@@AB@@@@@@@A@@@@A@@@AB@AABAA@B@@@@@BBB@@ABAB@BA@@@@AA@A@@@@@@B@@DB@B@@@@@B@@@@ABA@@B@@@@@@C@@@AA@@@A@A@@@BA@@@A@@A@@@A@AD@@@@@A@A@AA@@BA@@@@AB@@@B@@A@@@@@@@AAAA@AA@@A@@@@A@AB@@B@@@AB@BA@@@AC@@A@@@@AA@@A@A@@@@A@@A@ABA@@C@@@A@A@@ACBA@A@@B@B@@ABBD@@BBB@@@@@@B@@@B@@@@@@AB@@@@AB@@@@A@@@ABA@@@A@@@A@@BA@@@@@AB@@@@@@@B@@A@@@@@A@A@@@@@AB@@@@@A@@A@ABAA@@A@@A@B@@A@ABA@A@@@BB@@@@A@@@"],encodeOffsets:[[123508,30043]]}}],UTF8Encoding:!0}):void C("ECharts Map is not loaded"):void C("ECharts is not Loaded")})
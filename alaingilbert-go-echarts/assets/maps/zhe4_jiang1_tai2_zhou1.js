@@ -0,0 +1,4 @@
+!function(B,A){"function"==typeof define&&define.amd?define(["exports","echarts"],A):"object"==typeof exports&&"string"!=typeof exports.nodeName?A(exports,require("echarts")):A({},B.echarts)}(this,function(B,A){var D=function(B){"undefined"!=typeof console&&console&&console.error&&console.error(B)}
+return A?A.registerMap?void A.registerMap("台州",{type:"FeatureCollection",features:[{type:"Feature",id:"331002",properties:{name:"椒江区",cp:[121.442978,28.672981],childNum:16},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@A@B@@B@@@@@A"],["@@@@@@@@@@@@A@@@@@@BB@@@@@@A"],["@@@@@@@@@AA@A@ACA@@@A@@@@@@BBBB@@@BBB@B@"],["@@@AA@A@@B@@@DB@@BB@BBB@@@AA@A@AAA"],["@@B@@@B@@A@@@A@AA@@@@@@AA@A@A@A@@B@@@@@B@@DB@@@@BB@@"],["@@@@AAA@C@@@A@@BBDBDBBD@@BBAB@@@@@AAAA@A@@@A@A"],["@@@@B@BBB@@@@@BA@@@AA@AAA@CAA@A@@@C@AAA@A@@@AB@@@@B@@BB@B@@@@B@@@B@@@@B@@@@@B@@@@A@@@@AAB@@@DBB@BB"],["@@@@@AAC@AA@A@AA@@AB@B@B@BBF@@BBDB@@D@@@BAB@@AA@@ACA"],["@@ACA@C@@@AB@@@B@BDBBBD@DBF@@@DA@@@A@@AAC@C@CA"],["@@@@@A@@@@@A@@@@@A@AA@@@A@A@@@A@@@A@@@@@AA@@A@A@@A@@@@@@AAA@AB@@@BBB@@BB@BB@@@B@@B@BBB@@BB@@B@@A@A@@@@B@@@@@B@B@@@B@"],["@@@ABA@@D@@@DAB@B@@@BAA@A@@AA@AACBA@CBAB@BC@AD@BBB@@AB@D@@BBB@B@DAB@@AAAA@@AA@@AB@@@BA"],["@@@CBI@AAAA@A@ADAF@D@P@B@@D@D@@@BA@AAI"],["@@A@A@@@@@@B@@@BDDDDB@D@B@BBDD@B@D@@@@B@B@BAD@D@BA@ABAAA@A@ACAA@@@ABA@A@AAC@IGE@"],["@@@BB@BBFCD@FABB@B@B@@@BD@T@B@B@DBB@F@D@@A@CACCEMKAAE@GAEAECACAACCAACBA@ABAD@BA@CAAAACAAAAE@C@CCCCC@C@@B@BDJBBBBB@BADBDBDD@BBBAB@@@DBBBDDBHDBD@B"],["@@ACBABCDA@CAAA@E@C@C@GEGAEAMBI@A@CD@B@DBBDDDDHD@B@DABABADBDDB@B@BABCAMCA@ABAB@F@DBBB@H@H@DBHFBB@BAD@BBBDBF@JDB@BA@A@CEC@A@ADABA@CBCBAD@F@BBD@HAD@F@BA@AACCCCCEI@CC@C@A@"],["@@PeIB£CEAA@@@IAA@C@A@GABC@CBABCEAA@CA@@A@AD@@@@@B@@@DAB@B@@AB@@@@KAEAA@@@@@@AIAA@@B@@@@A@A@@@C@CA@@E@A@AAK@EA@@@BABC@@A@ACAA@C@@@AA@@C@A@@AA@@@@@C@A@A@@@@AA@@@A@@@@@AAA@@@A@@@C@A@@@@@A@A@A@A@A@@@@B@BA@A@A@AA@@CB@@@@@D@@A@@@@B@@@@BB@@AB@B@@@B@@@D@@@@B@@B@@@B@@ABB@ABB@@B@BA@@@B@@@@@@B@@@B@@@@@@AA@@@@@@@@@@A@@B@@A@@@AAA@@@@@EAA@A@A@C@@@AAA@@@A@A@C@A@A@AAA@I@AA@@@@@C@@@A@B@@@@A@BC@A@@A@@@@A@@A@@@@AA@A@@@A@@@@@@@B@@B@@@@C@@B@@@JA@GAA@CAA@@@@@@BAD@D@DBB@@@@A@@@@@A@A@@@A@A@@D@@@@@@AA@@A@@@@@@BA@@AA@@@@@A@A@@B@@@@@@B@@BA@@@B@@BB@@@@@@BAB@B@@A@@@A@A@C@@@@@@BA@A@@A@@C@@BA@@@@@@@@@AA@@@BA@@@@@@@@@A@@@@@@@@@@BA@@B@@@@C@@@@@@@@@@@@@A@@@@@@BC@@@@@E@@FA@ABC@@@@@A@AB@B@B@B@@@B@BB@@@@@@BB@@@@@@B@B@@@@@D@@A@AB@@@B@BAD@@@B@@A@@@A@A@@@@@@@@@@@AB@@@@A@@@@@A@@@AB@@A@A@@@@@A@B@@B@@DBBBB@BB@B@B@@@@B@@@A@@@@@@@@B@B@@@@@@@B@@@@@@A@A@@@AB@B@@@@@B@@@@@@@@A@B@@@ADA@@B@@B@@@@B@@@B@@@@A@@@AA@@@@ABAB@@@@BB@@@@@@BA@@BBB@B@A@AB@@A@A@@BB@BB@@@BBB@@DD@@@B@@BBB@@@B@@BBA@BB@@@@B@B@@@@BB@@A@@@@BA@B@@@@@@B@@@@@@@B@@B@@@@@@@B@@B@@@@@@@@@@B@@@@@B@@@@@@B@@B@B@@@B@@@@B@@@B@@B@@@@B@@@B@B@B@@@B@@@@A@AB@B@@AB@@AB@@@@@BB@@@A@@B@@AB@BA@@D@@A@@@A@B@@@@BB@@@@B@@@@BB@@@@@@B@A@@@@@@B@@@@@B@@A@B@AB@@@@@@@@@@@B@@@@@@B@@BA@@@@@AB@@D@@@@@@BA@@BA@@B@@AB@B@D@@@H@@I@A@AB@B@B@@@BA@@B@@@@@@BB@@@@@@@@@B@@B@@@@B@@B@@B@@@@@@@B@@@@@@@B@@@@@@@B@@@@@@@@B@@@@@@@@@@@@B@@@@@B@@@@@B@B@@@@@@@@@@@@@@@@@@@D@B@B@@@B@@@B@@@@B@BD@@@D@D@HC@A@@B@@@@AB@B@@A@AB@@@@@D@@AB@BA@@B@@@@@B@@AB@@@BA@@@@B@@@@DBBBB@B@@@@B@BB@@B@@BBB@B@@@B@@B@@@BB@F@B@@BB@BBB@DBB@@@B@@A@@B@@@@ABA@@BAB@DA@@BBB@BAB@B@@@B@@@@@BBBBBBDB@@B@@@@BA@@@A@@@A@@@A@@BA@@@BB@@@@@@@@@BB@@@@@AB@B@@A@A@A@@@@@A@@@A@@@A@@B@@@B@@@@@BBBA@@@BB@@@@@B@@@B@@@@@B@@@BA@A@@@BB@@@@@B@@@BB@@@@@AB@@@@BB@@BB@@A@@@CD@@@@@BB@BB@@@@D@B@B@@@B@@BB@@BBB@B@@@@@A@@BA@@@@B@BBBAB@DCB@@AFADA@@@@B@B@B@@AB@@@BAA@@@@AB@AA@@@AB@@A@@BA@AB@@@@A@@@@@AB@@@B@B@@@B@BAB@B@@@@@B@@A@@B@BA@@@@ACB@B@@@F@@@B@@@@@@@@B@@@B@@@@B@@@B@BAB@@@B@B@@@@@B@@@B@@@@@B@@@B@@B@@@B@@B@B@@@B@B@@@@@BB@@B@BB@@@A@@BA@@@@@@@A@@@@@AB@@@@@@A@@@A@@@A@@@@@AB@@@B@@@@A@@BA@@@AB@@@@@@@@A@@A@@@@A@@BA@@@@@@B@AAB@@@@@@@@@B@@@B@@@@B@@BA@B@@B@B@@BBA@@BBB@@@@@B@@BB@@BB@@@B@@@@C@ADB@A@A@@BAAA@@@@@AB@B@BB@A@@@@B@@@BA@BBA@@@@BCB@@@@@@@@@@A@@@@B@@AA@@@A@@AB@@@@A@@A@AA@@@@B@@@@ABAAA@@@@@@@@B@@@@A@@BA@@@@B@BA@@B@@@BA@@D@B@@@@@@A@@@A@AB@@@@@BB@@B@@@@@B@@@BA@@@@B@BB@ADD@ABD@@B@@@B@BBBB@B@@@@BBFC@@DA@AB@@@B@@@B@DB@AB@@@@@BA@@@@@@@@@@B@@A@@B@@BB@@@BAB@@@@@B@@@BA@@@@BBB@@B@@@@B@@B@@B@DCBA@A@@@@@@@ADAAA@@DCB@BC@@@@B@@@@@@@@@B@B@BA@@BC@CB@@@DGBA@AFG@ADCBEB@@@BBB@@B@AB@@CB@@@@B@@AB@B@@DBB@@@B@B@B@@@@@BCA@@@@@@A@@@BA@@@BCCAGCC@AA@@B@@CFB@@@CBKBMTAJ@^A`ANC_GAbUXeTk"]],encodeOffsets:[[[124723,29296]],[[124675,29303]],[[124833,29081]],[[124841,29093]],[[124851,29225]],[[124821,29086]],[[124795,29301]],[[124842,29074]],[[124738,29285]],[[124786,29113]],[[124847,29141]],[[124871,29127]],[[124730,29286]],[[124814,29133]],[[124832,29169]],[[124497,29292]]]}},{type:"Feature",id:"331004",properties:{name:"路桥区",cp:[121.365123,28.582654],childNum:3},geometry:{type:"MultiPolygon",coordinates:[["@@@@A@@@A@A@@AA@@B@@@@@B@@A@@@A@@B@@@@@BB@@@B@B@@@DBB@DAB@@AB@@AA@@A@@A@A@@@A@@@"],["@@B@@A@@A@@A@A@A@AAA@@BAAAAC@@CA@@@@@B@@@@@BBB@B@B@@@BB@@BCA@@@@@@@B@B@@BBDDB@@@@@"],["@@SA_@SAI@E@A@CBEHM\\@BLJBFCFELOBABC@A@EBGDCDA@EFAD@@EDABABC@CBA@C@A@C@@@AAA@@@AA@@AC@AAAA@EAA@C@A@A@ABA@AB@BAB@@AB@@CBA@CAA@AAA@ACA@AA@@C@@@C@@BA@@B@@AB@@@B@@BB@B@@@B@@ABABCB@@A@CBA@@@C@@@@A@@A@CA@@AA@@A@@@@@@BC@C@AA@@@A@@A@ABE@@@AAA@EA@@A@@B@B@@@@C@CAG@CA@@G@CC@@AACAA@@AA@A@DE@@@@@@@A@@@A@@B@@A@@@@@@BA@@@A@@@AB@@A@@@@@@A@A@@BA@@@A@@@@@A@@CAAAAACA@@AAD@B@@A@@@AB@@@B@B@B@@@@B@@@B@@@@@@BA@@B@@@@@@B@@@@@BBB@@B@B@@AB@@@B@@A@AAA@A@@@A@A@@@@A@@@A@@AA@@@@C@AB@@@B@@A@@A@@@AA@@@@@@@@@@@@@@AA@@@@@@BA@@@@@A@@@@@A@@@@@@@A@@@@@@@@@AB@@@@@@A@@@@@@@A@@A@BA@@@@B@@A@@@@@@@A@@@@@@A@@@@AB@B@@@@@@@@@@AB@A@@@@A@@@@@AA@@@A@@A@@@@@@@@@A@BBA@@@@@@@@AAB@@@@@B@@A@@@@@@@A@@@@@@@@@@@@@@B@@@@@B@@@@BBBF@@@BA@@@BB@@@@@@@@@BA@@@@B@@@@@@A@@B@@ABA@@@ABABA@AB@@@@C@@B@@@B@@@BA@AAA@@D@@A@@@A@@@@@@@@@@@C@@B@@A@@B@@AB@@@@A@@@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@A@@AA@BAA@@AB@@A@AB@@AA@@@A@A@@@B@A@@A@@@@AB@@AA@@@BAB@@@@A@@@@@A@BAA@@@@@A@BAA@@@@@@@@@@@@@A@CB@@@@@@A@@AB@@@@@AACB@@C@@BB@@@@@@@AB@@C@@@@A@@@@AAB@A@@AA@BAA@@@@@@@@A@AA@A@@@A@@@AA@@CB@@A@@@@@@A@@A@@B@AA@@@@@AA@A@@A@@@A@@@@@@@@@A@@B@@@B@@@B@@@@@BB@@B@@A@A@A@@@A@EBA@@@@BA@B@@@@BEB@B@@AB@AA@@@@BB@A@@@@@@@A@A@A@@@@B@AA@@BAB@@@@ABA@EB@@@@@B@@@@A@@B@@@@@B@@@@A@@BA@@BCB@@@@A@@BA@@@@D@@A@@@@B@@@AABA@AEA@C@C@C@@@A@C@@@@A@@AAAE@@A@@@A@@@@B@@@@@@@BA@@@@@@@A@AB@@@@@@A@@@A@A@@A@@AA@@@@@@A@@A@@@@A@@@A@@A@@@@A@@@@@@BB@@@B@@B@@@@BABDAB@@@B@@A@@@@@@@@B@A@@A@@@B@AAA@@A@@@@A@@D@@@@@B@@@@@BC@@BBB@@@B@@BB@@@B@@A@@@A@@@@@@@@@AB@@AB@D@B@@@@AA@@@@@B@@AAA@@A@@@AA@@@@@@A@@@@@@@@@@@BA@@@A@@@@B@@@@@BA@@BA@@@A@@B@@B@@@@@A@@@ABA@B@BB@BB@A@@BBB@B@@AB@BA@BB@@@@ABA@AB@@@B@@@@@@A@@@A@@@@@@BA@AB@B@@A@@@A@@@@B@@@@@@B@@@@D@B@@A@@@@@@@AB@@A@@DA@@@@@C@@@@@@@@@@@A@@A@@@@A@@@@B@@@B@@@B@@@@@@AB@@@@BB@@@BBB@BBB@@AB@@B@A@@B@@@@@@@B@BA@AB@@@@ABA@@BA@@@AB@@@@@BB@@@@@@@@@@B@@@@@@@@@BB@@B@@A@@B@BB@BB@@AB@@@@A@@@@@@BAB@@@@A@AB@@A@A@@@@B@@@@@B@BCB@B@@@@A@@@A@A@@@@@BB@B@@AB@B@@B@B@@@BB@@@A@@@@@AB@@B@@B@@@@@@@AB@@@@@@B@@@D@@BB@@@@@@B@D@@@B@B@B@BB@@@@B@@B@@@@@@@@@@@@B@@@@B@@@AB@@@@@@@B@@A@A@@AA@A@CB@@@AA@@@@@@@@B@@A@@BA@@@@@@@A@@@@B@@@@A@@B@@@B@@A@AB@@@BAB@@BB@@@@@B@BA@@BA@@BB@@BB@BB@@@B@B@@B@@BB@@BDB@@@@BA@B@@BBAB@B@@@B@@@BABAB@@ABA@A@@BAA@@A@A@@B@@@B@B@@B@@B@@@@@B@@@B@@@@@B@B@B@@@@@@@@B@@@B@@@@@@B@B@@B@@@B@@@B@@@BA@@BABAB@@@@A@@@A@@@A@@@@B@@@@@B@B@@@BAB@@BBAB@@@B@@@B@@@B@@BB@B@@BB@@@B@@@B@@BB@@BB@BBB@@@BB@@B@@B@@BBBD@@B@@@B@@BBBB@@@B@B@@@BBB@@@@@@@B@@@BAB@B@B@@@B@BB@@B@@AD@@@BB@@B@B@@B@B@@B@@@BA@@BABA@@@@BB@@B@@@@@@A@@@A@@A@@@@AB@BC@@A@B@@@@@@@@@@A@@@@@ABA@@B@B@@@@@@@@A@@@@@@@A@A@@@@@@B@@@A@@@@@@A@AAAA@AACA@@@AA@B@@@@@B@B@@@BA@@B@@@@@B@@@@@BA@@@@@@@@@@B@B@@@B@@@@A@@BC@A@A@@BAB@@@@C@@@@@A@A@@@@A@@A@@@@A@@A@A@@@A@A@ABAB@@@@@D@BAB@@EF@@@@@D@@A@@@@B@@@@@@@@@@@@@D@@@@@@AB@@A@@@@@@@@B@@@@@@@@@B@@A@@BB@@@@@@@@B@@AD@@@@BB@B@@A@@@@D@B@B@@@B@@@@ABA@A@@@@A@@AA@@@B@@AA@@@@@@@@AB@B@@@@@B@@BB@@A@@@@B@@@BB@@@@@@@CB@B@@@B@B@@@@@B@@@@@AA@C@CBC@A@@@@B@DBB@HBB@@I@@@AD@@@@@@AA@@@@@@@B@@@B@B@@B@@B@@@@B@@B@@@@BADB@@@@@@A@B@@@D@@@@BBJ@B@BBB@B@D@B@B@@@B@BB@@D@B@B@B@FB@@@@B@BB@@B@@@@AB@@@@@@@@@@@BB@@@@@@@A@@@A@@@@A@@@B@@A@AA@BAA@BA@@@A@@@AA@@@@@@C@@@A@@@ABA@@AA@@@@@A@@B@@@@C@@@@DA@@BBB@B@B@@A@A@@B@B@B@B@B@@@@@B@D@@@B@@@B@BB@@@@B@@@B@@B@@B@B@D@@@@@B@@BB@D@@@BB@@D@B@DB@B@BD@BA@A@@FBL@BBB@F@@@DBD@@@B@B@@@@@@AB@JB@B@@@@B@FBLB@@@@BA@@@ABA@C@@@A@@@@BCB@@@DBB@FBADAB@DADHBB@D@B@JB@@B@FB¤DJAPSDAfG\\MLCDGDKBGFyBEFMNQFGXYFK@EBIMw"]],encodeOffsets:[[[124572,29228]],[[124573,29220]],[[124585,29128]]]}},{type:"Feature",id:"331021",properties:{name:"玉环县",cp:[121.231805,28.135929],childNum:20},geometry:{type:"MultiPolygon",coordinates:[["@@@@A@@@@@@@@@@@B@@@@@@@@@@@@@"],["@@B@@A@@@@@@AA@B@@@@@B@@"],["@@@@@@@@@@B@@@@@@@B@A@@A@@A@@@@@A@@@@@@B@@@@@@@@@@@@B@@@@@"],["@@@@@AAAA@A@@@@B@@@@BB@@B@@BBA"],["@@@@@A@@@@@@@@@@@@@AA@@@@BA@@@@@@B@@@@@@@BB@@@@@B@@@B@@@@@@@@@@@@A@@@@A@"],["@@B@B@@@@AA@@@@@@A@@@@A@A@@@A@@AAB@@@@@@B@BB@@@@@@@@@@@B@@@@@@@@B@"],["@@@ABAAAA@A@A@@B@B@@DBB@"],["@@BA@@@@@AAA@AAA@@@AA@@AA@@@@B@@@B@B@BB@@DB@@@BB@@@@"],["@@@@@A@A@@@@@AA@@@@@@A@@A@A@@B@@@@@@A@@@@@@@@@A@@@@B@@@@@@@B@@@@B@@B@@@@B@@@@@@@@@@B@@@@B@@@@@@@B@@@@@@@@@@@B@@@@@@A@@@@@@"],["@@AACAA@@B@BBBDDFB@@B@@@@AACAAA@"],["@@@A@@@A@@@AA@A@E@A@@B@B@BDBB@B@B@@@B@B@@AA@"],["@@@AAAA@@B@@@B@@@B@@A@@@@@@@@@@@@B@@@@ABA@@@@BA@@@@BB@BB@@@@@BB@@AB@@@BBB@@AB@B@BA@@@A@@A@@@A@@AA@@AB@@@@@@A@@@AA@@@"],["@@B@BBD@BA@@AA@@B@@AA@B@@A@@@A@@@@@A@AA@A@A@@@@B@@@B@@A@CAAAA@AAAB@@ABAAA@A@@@@BBB@@@BB@B@@@B@@@@@BB@BB@B@@@B@BB"],["@@@@A@@B@@@BB@@@@BB@@@B@@@@@@A@@@@@@@@B@@@@@B@@@@@B@@BB@@@@@@B@@@@@@B@@@@@@B@@@@B@@@@B@@@BA@A@@@@@@@B@@BB@@@B@@@B@@@@@BA@@@@@B@@B@@@@@B@@@AB@@@@@@@@B@BB@@@@BBB@B@@A@@@A@@AAA@@@@@@@@@AA@@@@@@B@@@AA@@@@@@@@@@@A@@@@B@@A@@@@@@@AA@ABA@@A@@@@@@AA@@A@AAC@A@@@A@AA@@A@@AC@AB"],["@@@@ECCAEAEAA@@ACAC@A@AA@AA@C@@@AB@BBBBBBDBBBBD@HBDBF@@@F@B@B@B@@A"],["@@DDFFFB@A@A@AAA@AB@@A@A@ABA@AAA@@A@@ABA@@DBB@DA@A@@AA@@A@BA@@A@@A@@@A@AACCCEAEAA@@B@@@BA@@B@D@BB@@BA@@B@@DB@B@@ABA@@@BBB@DBB@@BA@C@A@A@@BA@@AAB@@BDBD"],["@@ECAAC@CBA@A@EAAAABCFABBBBDBBB@H@FBBBFABBD@BBDDBBBD@BB@DB@BDDDBB@B@BA@AAGAGAAACC@A@A@AAAA@ACAAAC@@A"],["@@HK@A@CKMAAA@ABA@ABABC@C@AAACCCAEAE@ACACACACAAAABA@@B@DBHDFBFHHDDBBHBFBB@BBBD@B@BBBHDBBB@BA"],["@@ECICGEGCCEIKCCIIGCeIGAoCyEOAEASBO@QDIDGJER@@CT@R@D@D@FAJADCPCPG`ETANAJINQNDBJFLFFDNTJLBDFfDVDV@P@BBD@HBP@B@@DF@@BB@@AB@BAB@J@L@F@FBPBH@BBB@DBDBBFDBBB@DBVDLBHAD@D@D@HALCDAFAH@FAJ@H@@@H@BBDBB@DDFDT@L@@@FARA@@B@B@@ABA@@PEBB@B@BBBBDBBBBB@BB@@@@@BB@@@@@DA@@@A@A@@@ABAA@@A@@BB@@BBB@@@@@@@@B@B@@@@BBB@@@B@BB@@@@@@@@@B@@B@B@@@@B@@BDB@@@@@D@@@@@B@@@B@@BB@B@@@@B@@@@@@@@B@A@@@B@@@@@@A@@@@B@@@@@@@B@@@B@@@@BB@@@@@B@@A@@B@@@@@@@@@@ABA@@@@B@@@B@@@@@B@@DA@@@@@A@@B@@B@@BB@@@B@@@B@BB@@BB@@@B@B@@@B@@B@@B@@BB@@@BBB@@B@@BB@@@B@@@@@BB@@B@BA@@DA@ABB@@A@@B@@ABB@@@@@B@@@B@B@@@B@@@BBB@BBD@B@@@@B@@@@BB@B@@@@@B@@B@BB@@B@B@@@BA@@DB@BBBBBB@B@BABA@@@@@@@@BA@@@@B@@B@@A@@@@BB@D@BBBD@@BB@@BB@@@@DBDB@@@B@@B@@@B@@A@BB@@@@B@@@@@B@@@@@BB@@@@BBB@@BA@@BB@A@@B@B@@B@@@@@B@@B@@BB@@A@@B@@@@@@A@@@@@AB@@@@@A@@A@@@@A@@A@@@A@AA@@AAA@AAA@ABA@@@A@@@AA@@@C@@A@@@@B@@@@@@@@AAAB@@@@A@@BB@@@@B@@@@AB@A@@AB@@@@@@A@@A@E@@@@AA@@ABA@@@A@@AA@@AAA@@@@A@@@@BB@@@@B@@@@@B@@@BAB@@@BA@@B@B@B@AA@@CA@AA@@@@AB@B@@@@@@@@@@@@@@A@@@AA@A@@@@@@A@@@@@@A@A@@@@@@@@@@@@@@B@@@@@@BBA@@@@@@B@@@@@AAA@@@A@A@@@@AA@@@@AA@@@@@AA@@A@@@@@A@@@@@ACEB@@@@AB@BAB@@@@AB@@@BA@@@@@@@@@AB@B@@A@@@B@@@A@@B@@@@BBABBBABB@@B@@@D@@@BAD@@@@@BA@A@@@A@@@@B@@@B@@@@BB@BBDABB@@B@@@@@B@@@@AB@B@@@BA@A@C@ABABC@@B@@A@@BABC@@@@@C@@BA@@B@@@@@@B@@@BDB@@@@@@@A@AB@@@BB@@B@BB@@B@@@@@B@@A@@BB@@@@@B@@@@@B@@@@@@B@@@@@@@@@AB@@AB@B@@@BB@B@@@B@@@A@B@@BB@@@@@@@A@@B@@@BB@B@@@B@@@@BBA@BBA@@@@A@@@@A@@@@@@@A@@@@@A@@@BB@@B@@BB@@@@@@@A@@@@@@@AA@@@@AB@@@@A@@@@@@@A@A@@@@BA@@@AB@B@@B@@@@@BB@@BB@B@@@@@BA@AB@@A@@@@@AB@@@@@@@@AA@@@@@B@@A@@B@@BB@@@B@@@@B@@B@@@@@@@BAB@BB@@B@B@@@DAB@@@JCRCPGPHAAQWSMGEAAOMIEKKQYCCE]GKEK@I@ACMAAACAAIC@@KEAA@CAUA@AEACGCECIAKAG@A@GBEBCDCFEJEFGDGDIFOFIDK@K@A@GCCCA@@E@E@CBEBABIBABCFIFGHI@AFMDM@C@GAKEOCKAG@@CECEACCC"],["@@A@@@@@@A@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@B@@B@@@@@@@B@@@@@@@@A@@@@@@AA@@@@@@@@@@@@@@@@@@"]],encodeOffsets:[[[124449,28781]],[[124440,28779]],[[124407,28808]],[[124452,28743]],[[124404,28804]],[[124314,28808]],[[124336,28891]],[[124450,28748]],[[124408,28773]],[[124244,28808]],[[124338,28885]],[[124332,28707]],[[124328,28730]],[[124301,28795]],[[124075,28694]],[[124317,28771]],[[124273,28766]],[[124446,28777]],[[124206,28735]],[[124326,28784]]]}},{type:"Feature",id:"331003",properties:{name:"黄岩区",cp:[121.261972,28.650083],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@CC@@@CAAAA@A@@@@AAA@AAA@A@@@EA@@C@AAA@A@@@@@A@A@AACAC@@@A@@@@@AB@BC@@@@BBB@@@B@@C@@@@@AB@@@@A@AAA@AA@@AB@@@@A@@@A@@@@A@@A@@@@B@@ABA@@@@@@@@@A@ABA@C@C@@BA@@@@@@B@@AB@@AD@@@@C@@@ABAB@@ADA@@FABBB@@@D@@@B@D@@@@ABA@@@@@@B@@@@@@CBA@@DA@@@@B@@C@@@A@@@AAA@EA@@ABA@A@@B@@@@@BA@@@@@@@@@@B@@@@A@@@@@@@@B@@AAA@A@@@A@@@@@@@@@@B@@A@@@@@@B@@@@A@@@@@@B@@@@@@@@AB@@@BA@@@@@@@@@@@@@AB@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@B@@@@@@@@@@@BA@@@B@@@@@@@@B@@@@@@@@@@@B@@@B@@@@@@@@@BB@@@@B@@@@@@@B@@@@B@@@@@A@@@A@A@@@@@@@@B@@@@@@@@@B@@@@B@@@@@@B@@@@@@@@@@BB@@@@@@B@@A@@@@@@B@@@B@@@@@B@@@@@@@@@B@@@@@@B@@@B@@@B@@B@@@@@@B@B@@BB@@@@B@@@@@@B@@@@@BB@@@@BBB@@@@@@@@@B@@A@@B@@@@@B@@@@AB@@@@@@@@@@A@@B@@@@A@@@A@@@@B@A@@@@@A@@@@@@AB@@@BA@@@B@@@AB@@A@ABA@@@AB@B@@@BAB@@AB@@A@@@AB@B@@@B@B@@@@@B@@@B@@@@AB@B@B@B@@ABABC@ABCBA@AA@@A@ABABA@AA@@A@A@AB@@A@CBA@A@A@@B@BA@AAA@@@@BA@ABABAB@@@B@@@@@BBDB@@A@B@@@B@@@B@B@@@@@@@@BB@@@B@B@B@@AB@@AB@@@@@@@@@@@BA@@B@@@B@@AB@@@@@B@@@@@@@@@@B@BB@@@BB@@@BBB@@@B@D@B@B@@@B@B@@@B@@B@@@B@B@@@B@@BFA@@@AB@B@D@B@@@B@BB@BBB@@B@@@@@@@B@@@D@@BBB@B@B@B@B@B@B@B@B@B@B@@@DB@@BBBB@@B@@AB@B@B@B@B@@@B@B@B@B@DA@@@ABBB@@@B@B@B@@@@@DBBB@@B@B@BBB@B@B@B@B@@@B@B@B@@@@@D@@@@@@BBB@@@@B@B@BB@@B@BBBB@@@@@@@B@@@@@B@@DD@B@@@@F@B@BAB@@@BAB@B@@AB@B@BA@@BBB@D@B@@@B@B@BAB@B@D@@@BBB@@@B@B@BA@@B@@BB@BAB@@A@@BAB@@BDAB@B@B@B@D@BAB@@@B@@A@@@B@B@@BB@@@B@@@B@@@@@@@@@BA@@BA@ABB@@@@BA@@B@@AB@D@@@@B@@B@@@@@@@B@@@B@@@@A@@@A@@@@@@@A@@BA@@BA@@@A@@@A@@@A@@@@@A@@@AB@@A@@B@@@@BB@@@@BBB@@@B@@@@@A@A@A@CB@BA@@@A@@@@@@BA@@AAB@A@@AB@@@@AB@@A@A@@@A@A@@@@B@@A@@@@D@@@D@@B@BD@B@@@@@B@@A@A@@@A@AA@B@@@@@@@@@B@@@B@@AB@@@BA@@@@@A@C@@@A@@@C@@BAB@BA@@@@B@@B@@@BBB@@@@@@@B@@@@@BBDD@@BA@@B@@@@@@AB@@@B@BA@A@@@AB@@@BB@BB@@BB@@B@@@@@@@BB@@@BA@@BA@@B@@A@@@@@@@A@@@@@A@@AAAA@@BABAB@B@@@@@@@@BB@@@@@@@@B@@AB@@B@@@@@BA@@BA@BB@@@@@@@B@@@BB@@@B@@@@@@@BAB@@@BB@BB@@@B@@@@A@@B@B@B@@@B@B@@BB@@@BB@@@@@BB@@@B@@BB@@BB@@BBB@@BA@@B@@@@@D@@BB@@@BAB@BA@@@@B@@@B@B@@AB@@@B@@@B@@@BBB@B@BBB@@@BA@B@@B@BB@@@@B@BA@@B@@@@AB@@BB@@@@@@BBB@@@B@@BBAB@@BBBBB@@@B@@DBB@BB@@BB@@BD@B@B@@@@BBBB@BB@@B@B@DBADA@@@@BB@AB@B@B@@AB@@@@A@B@@@@@B@@@BB@@@BB@@@@DA@@@AB@@BB@@@@B@@DA@@@@A@A@AA@A@@BAB@@@BB@A@@B@BA@@@@B@@@B@@B@@B@B@BBD@@@B@@@BABA@@B@@A@@BA@C@@BA@A@@@@@AB@D@@@@A@@@AB@@A@@@AB@@ABA@@BA@@@AD@@@@BDA@@B@@@B@@@@A@@B@@@B@B@@@@@BABA@@@@B@D@@@@@B@@@B@@AB@@@@@@@BA@@@@@@B@@A@@@@B@@AB@@@B@B@BA@@BA@A@@B@@AB@@@B@@@BA@@@@@AB@@ABC@@@@B@@AB@@@@@B@@@@@B@@@B@@@@AB@BAB@BAB@@@@@B@B@@@D@BA@@@@@@@@@A@A@A@@@A@@@@AA@@@@@A@A@@@@@A@@@AB@D@@@@B@@B@@@B@@BB@BB@@B@BB@@BABBB@@@@@B@@BB@@@@B@@@@B@@@DB@@@@DBBA@@BB@@@@@AB@@@B@@@B@AA@@B@@@@ABAA@BADC@@B@@@@A@A@A@A@@AA@A@@@A@@BA@@B@@A@A@@B@BA@@@@BDB@@BB@@@DB@@B@B@BAB@@AB@@@@A@@@@@A@@@@@ABBD@@@B@@@BAB@B@B@@@B@@@@@@AB@@B@B@B@@B@@D@BBD@BB@B@B@BB@@@B@@B@@@B@@A@@B@BA@@BA@@B@@@@@@A@@@@B@AA@@B@@@B@B@B@@@@BB@BB@B@@B@@@DB@@B@B@@BB@BA@@BB@@D@@BB@@@@B@@DB@B@B@BBB@@@BB@@BB@@B@@@BBB@B@@BA@@@AB@BA@@B@@@DABA@@@AAA@@B@@A@@B@@@BB@BB@BB@@B@@@BB@@B@B@BB@@@@BB@@B@@B@@BB@@B@B@B@BB@@B@@B@@B@@@ABBB@@@@BB@@B@@BB@@@D@@@B@@BB@B@@@@@B@@B@@BDB@@@@@BB@@BA@@@A@A@@@@B@@@@A@@B@@@@B@@@@@@@B@@@@@@B@@@@@@AB@@@@AB@@AB@BA@@B@@@@D@@B@B@@@BB@@@@@B@@@B@@@@@DB@@@B@@D@@@@@@B@@B@@@BA@@@@B@@@@@@@@B@@@@@B@@@@@@@@@@B@@BA@@@B@@B@@@@@@BB@B@@@@@B@@@BAB@@@@@B@BB@@B@B@DBB@@BB@@@BBBAB@@AB@@BBAB@@@BA@@@B@B@@@BB@@B@@@@DB@@@@B@BB@@@BB@@@@B@@@B@B@@BB@@@@@B@@@@B@@@B@@@BBB@B@BBB@BA@@B@B@B@B@@B@@@@@B@@B@B@@B@@@@@@@@B@@@@@@A@BB@@@@@@@@@B@@@@@@@@@B@B@B@@B@@@@B@@@@B@@@B@@@@@A@AB@@@@@@BB@@@B@@@B@@@B@@B@@@BAB@@@BB@@@BA@@@A@@@AB@@@@B@@B@@A@@@B@@@@BBBA@@@ABB@@BA@@@A@A@@@A@@@@@@@A@A@@@A@@BABA@@B@@B@@BB@@BB@@@@B@B@@@@@A@@BB@B@@@AB@@@BB@@@BBBBBAB@@BBAB@B@@@BA@@@@@A@A@@B@@@B@BA@ADA@@@A@@@@A@@@@@B@A@@A@@@@@@@@AB@@@A@@@BA@@A@@@@@BA@@@@@@@BAA@@@A@@@@@@@@@B@@@B@@@BA@BBA@@@AAA@@@BA@@@@@A@@A@@ABA@@@A@@AA@@@@@B@@@B@B@@@B@@@BA@@@@@@@@B@BBBB@B@@B@B@@AB@@AB@B@A@@B@@AD@@@B@@B@@A@@B@B@@BAB@@B@@B@@@@@AB@BABA@@@A@@B@@A@@BA@@@@@@@@@@BBB@@@@A@@AAAA@@@@@@BA@@@@A@@AA@@@@A@A@@B@B@@A@A@A@@BA@@@A@@@@@A@@@@@AB@BB@@BAB@@BB@B@@@@@@@B@@BB@@@AA@AB@@@@AB@@@@@@@D@BAB@@ABAB@@A@AB@@@B@@B@@@@BB@A@@BB@BB@BB@@B@B@@@B@B@@BB@@@B@B@@@@@B@B@@@BB@@BB@@BBBB@@B@BB@@B@@BB@B@BBB@BA@@BBBB@@@@B@@B@@@B@@@@B@B@@BB@@@B@@@B@@BB@B@B@@ABA@@B@@@@AD@B@@@D@@A@@@A@AA@@@@A@A@@@@@@@@@AA@@A@@B@@A@A@ABA@A@@@@@@@ABC@@@@@A@@@@B@B@@@@@B@@@B@@@B@@@@A@@B@@B@@@@BBB@B@@@B@@A@@B@@@@@B@@@BA@@BAB@BA@@B@DA@A@@@@BABA@@@@@@B@@B@@BA@A@@DA@@@@@@B@@@@@@@@A@A@@@@@A@ABA@BBBBBBF@@BFBB@B@@@@@@@BB@@@@B@@B@@@@@@@@@@@@@B@@@\\HF@HBB@J@@@@G@@@C@ABA@@@AB@@AB@@A@@@@C@@@BA@@@@B@@AA@@@@@@@@A@@@@@@@@@@BAA@B@@@@A@@@@@A@@@@B@A@@@@@@@AA@@@@@A@@A@@A@@A@B@@@B@@@@CB@@ABA@@@AB@@@A@@A@@@@BA@@BA@@@ABAB@@@@@@A@@@A@A@A@@@A@@A@@@@A@@@A@@A@@@A@A@@@@A@@@@A@@@@@A@@@@@@@@@@@@AA@@@@@@@A@@@@A@@@@@@@A@@@@A@B@@A@@B@@@AA@@@@@A@A@@A@@AAB@AA@@@A@AA@@@A@@CC@@AA@A@@AAA@@AB@B@@@BAB@A@A@AA@@AB@@@@@@AA@@AB@@A@@@@A@AA@A@@@AA@@C@@BA@@@AAA@@@A@A@A@AB@@A@@@@@@@A@AA@@A@A@@@A@AA@AA@@@A@@@ACAA@@@AA@@@AA@@A@AAA@@AA@@AA@@@A@@@A@@AA@A@@AA@@@A@@@A@@@A@AB@AA@AB@@A@A@@@@@A@@@@@@B@@@B@@@B@@A@ABAB@@AB@@A@@@A@@@A@@@@A@A@@@@A@@@A@@@@@@@@@@A@A@A@@@@@A@@@A@@@@@AA@@@@A@A@@@AB@B@@@BB@AB@B@BA@@BABA@A@@@A@@@ABAAA@@@AAB@@@@CA@AA@@AA@@@@A@A@@AAA@@AA@@AB@@AB@@A@A@@@@AA@@BA@A@@BAB@@@@A@@@AB@@@@@@A@@B@@@@@@@B@@AB@@@@A@@@@@@B@@B@@DAB@B@@BB@B@@@@A@@@@@@BA@@A@@@@@@A@@@@@@@@@@A@@@@A@@A@@A@A@A@A@@@C@A@@@@A@@AC@@@A@@@@@@@BA@@@@@@A@@@@AA@@B@@@@@B@@AA@@A@A@@@@ABA@@@AAA@@@@B@B@@@B@@@@@@ADA@A@A@@@@@A@@B@B@@@BAB@@@@@BA@A@@@@B@@@@@BA@@AAA@@A@AB@@@@AA@@A@@@@@@@@@A@@@@@@@@A@@A@@@@BA@@B@@AB@BA@@@@BAB@@A@A@@@@@@@AB@A@@@BA@@AA@AAA@A@@AA@@@@BA@@@@@@@A@@@A@@@A@@B@@@@@@BB@@@@@@@@@@@D@@@@@B@@CB@@@BA@@@@@@B@@@@A@C@@A@@@@@@@@A@@B@@@B@@@@ABAB@@A@@@@B@@@B@@@@@@@@A@@BAB@BA@@@@AAB@@ABA@@@AAA@AB@A@@AAAA@A@A@@@AB@@A@@@A@A@A@A@@@A@@A@@AB@AAA@@A@@@A@@@AB@@@@@@C@@@@A@@AAA@A@@AA@@@A@A@A@A@@@@@A@AB@@A@@@@@AB@@@@A@@AABA@@@A@A@@@@@@@A@A@A@@@A@@@@@A@@@A@@@A@@@@@@AB@A@@@A@@@@A@@A@@@@@@A@@@@B@@AA@@A@@A@AA@AA@@@@AC@@@@@@@@@@@@A@@@AB@A@@@A@@AA@A@@BA@@@@@@A@@A@@@A@@@@@A@@@A@@@A@@@@@@@@A@@@@@@@@A@@@@BA@@@@A@@AB@@A@@@@B@@@BA@@@@B@@@@A@@@@@AA@@BA@@AA@@@@@AB@@A@@D@B@@@@A@@A@@@@@@@DA@@@@A@A@@@@A@@@@@@B@@@@@@@@A@@@AA@@@@B@@A@@B@@@AAAA@@@AB@@A@A@A@@@@B@@D@B@@B@@A@@@A@A@@@@AA@@A@A@@A@@@AB@@A@@@@AA@@AAA@A@@AA@@A@@@A@@@A@@@A@@BA@@BA@@@ABA@@@A@A@@@A@@@A@@BABAAA@@AAA@@@@@A@@AA@@AAA@@@A@@@AB@@C@@@A@A@A@CB@@@@@@A@@@@@@@@@@@CBA@A@@@AB@@@AA@A@@@@@A@@@@@BBB@@@BB@@@@A@@@@BAB@@C@@@A@AAA@A@@BAA@BA@A@@@A@@@@AA@AA@@A@@B@@A@A@A@@@A@AAA@@B@@A@@A@BAB@BB@C@@A@A@@A@@@@B@@@B@@@@@@B@@@@@@@AB@@@@@@A@@@AA@@@@@A@@@@@@@@@A@@AAA@@AA@@@@A@@C@@@CAA@@@A@A@A@A@C@@@AA@@CAA@A@A@@@@@C@AAAB@D@@@@B@@@@@@@@B@@A@@@AB@@A@@@@@@@@B@@AB@@@B@@A@@BA@@@A@A@@@AB@@@@@@AA@@A@@B@@@BAA@B@@BB@@A@@B@@AB@@@@BB@@A@@@@B@@@@@BA@@@@@@@A@@BB@@@@@@@A@@@@@AA@@@@@@A@@@@@@A@B@@@@@B@B@@AA@AA@@@AA@@@@@@A@@@@@@@A@@@A@@@A@@@A@@B@@BBA@@@@B@@B@@B@@@@ABA@@@C@@AA@@BA@@@@A@@@@B@@@AA@@@A@BC@@@A@@@@@AA@@AB@@@@@@@A@@A@@@@@@A@@AB@@A@@@@@C@A@@@@@@@ADBB@@A@@A@@A@@BA@@B@@AB@@@BABBB@@A@@BA@@BA@A@A@@@A@@@@@@@@@@@AB@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@AB@@@@@A@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@A@@B@@AAA@@@AAA@A@@@@@AB@@@B@BA@@@A@AAAA@@@AAAAA@A@A@AA@@A@A@@@A@AA@@@@AABA@AB@AAA@@@A@@AA@A@BA@@@A@@@AAAAAA@A@AA@@AAA@@B@@@A@@@@@@@@A@A@A@@@@@A@@@A@B@A@@BA@@@@@@BAB@@@@@@@AA@@@@@BA@@@@A@@@@@AAA@@A@@@@@@AB@@A@BD@@BBABA@@@@A@@@@@CA@@B@@A@@@@A@@@AA@@@AA@@AA@@@@@@@@B@@A@@@A@@@A@@@@@AABA@@BA@@B@@@@@@A@@A@AA@@A@A@AAA@@@@@A@@@@AAAA@A@C@@@@BA@A@@@@A@@A@AA@@@@B@@AB@BB@ABBB@B@B@B@@AB@AA@@@@@AA@@@AC@@@@BA@C@@BA@AA@A@A@@@AA@@A@AA@@AA@@A@@@ABA@@AA@ABA@@AA@@@@BA@AAC@A@A@CAAA@@@@AAA@@AA@A@@@A@@@AA@@@@@@@B@@@B@@B@@@@B@@@B@@A@@@A@A@@@@@A@@@AA@@A@@@A@@A@@@AA@A@@@A@@@@B@B@@@@@@A@@B@@B@BB@@@@A@@BA@@@@AB@@A@@@@AB@@@@@B@@A@@BC@@@@@A@@A@@@@@A@@ABAA@@@@@@@A@@AA@@@@@@A@@@AB@@A@@AA@AA@AB@@@B@@@@@AA@@A@@@@B@@@@A@@@@A@AA@@@@A@@@@B@@@@@A@@@@AABC@A@@@@@A@A@@@AB@B@BA@@B@B@@@BA@@@A@@@A@@@A@@@AAA@@@A@@@A@A@@@AB@@A@@@A@@@ABA@@@@@A@@@@AA@@A@@B@@@@@@A@@@@A@@A@@@A@@@A@A@@A@@@@AA@AB@AAB@@@@AA@@@@@B@@A@@@A@@@@@@@@@@@AB@@@B@@B@@B@@A@@@@@A@@@A@@@@@@@@@@BA@@@@B@@@@AB@@AB@@CBAB@@@@A@@@@@AAA@A@A@@@@@@@AB@@A@@@@AAA@@@@A@@@A@AB@@@@AB@@@BA@@B@@AA@@@@@@A@@@A@@@@@@@@@A@@@@@@@@@A@A@A@@@A@@@A@@@@B@@AB@@AB@B@BABAB@D@@@@A@@@@@ABA@@@@BAB@@BB@B@@@BB@B@@B@@@B@@BF@@AB@BBBBB@B@B@@@B@@@@A@@B@B@@AB@AA@@AA@A@AAA@A@AA@@@A@@@CD@@AB@@A@@@A@@AA@@@@@AA@@@@A@@@AA@A@@BA@@B@@@@@BA@@@AAA@AB@@A@CDC@@@@BAAA@AB@@@@@A@@A@@BA@A@A@@BA@A@A@A@@B@@CAA@A@AA@@C@@@@@AACA@C@@CAAAAAEA"],encodeOffsets:[[123818,29181]]}},{type:"Feature",id:"331022",properties:{name:"三门县",cp:[121.395711,29.104789],childNum:15},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@A@@@@A@@@@A@@@@B@@B@@B@@B@@A"],["@@B@@@@A@@AA@@@@A@@@A@@@@@@@A@@@@@@B@@B@@B@@@@B@@@B@@@@@"],["@@A@@@@B@@B@@@B@B@@@B@@AB@@@BA@@AA@@AB@@A@@@@@A@AB"],["@@@@A@@@A@@@@A@@A@@B@@A@@@@@@@BB@@@@@@@BB@@@@@@@B@@@@B@@BA@B@@B@@@B@@A@AA@@@@@AA@@"],["@@@@B@@@B@@@@AA@@@A@@@@AA@@@A@@B@@@@@@@@@@A@A@@@A@@@@@B@@B@@B@@BB@@@B@B@@@@@@@@A@@A@@@@@B@"],["@@@@@@@@@A@@B@B@B@@@@AB@@@A@@AA@A@A@A@AD@@@B@@@B@@A@@@@@@B@@@@@@B@@@@@B@@@@@BA@@@A@@"],["@@B@@@B@@AAA@AA@A@G@ABA@@B@BB@@@D@F@B@@A@B"],["@@@@@C@@A@@@@B@BA@@AA@@@A@@@@B@BB@@B@@@B@B@@BB@@BBB@@@B@@A@@@AA@@A@@@A@@@A@@@A"],["@@@AA@@@@A@@@AAA@CA@A@A@AB@BAB@B@@BBBBB@D@BBBA"],["@@AAA@A@@@A@AA@@A@ABA@@B@@@@A@@@@@A@@@@D@@B@DBB@@@BAB@B@BBB@B@BA@@@A@@AA"],["@@@A@A@@BA@@B@@A@AA@A@@@A@@BA@EBCB@@AB@D@@BBBBD@B@@@B@@@@A@@B@BA@@@A@@"],["@@A@@CAA@A@@A@A@A@@BBB@BA@@@AB@@@BA@C@@@ABA@A@@BC@AAA@@@@B@@BBBB@@ABA@B@@B@@@B@@@BB@@@BBBB@@B@BAB@@AAA@A@ADABAB@@@B@BA@ABB@@B@@@@AB@BA@A"],["@@ABABAB@@@BAB@@BBB@BA@@B@BA@@@AB@BB@ABB@@@B@BB@B@BAB@B@B@@ABABABAB@DBB@B@B@@@@AAC@A@ABA@@@AC@CAEBA@A@ABCDC@@B@BABAAA@C@"],["@@BA@BB@B@@A@@@@BE@A@A@@BAB@B@@A@@@@AAA@A@@A@A@@@A@A@ACAA@C@EFA@A@@B@@BBBBABA@ABABC@ABAB@B@BB@@B@D@B@BD@D@@@B@@@@@D@@BB@B@B@@@@@@AAABA@AB@"],["@@@@@A@@AB@@@@@B@@A@@A@@A@@A@AC@A@C@@ACB@BA@@@AB@@@BABA@@@AB@@B@A@@BABA@A@@@@@A@@AA@@@@@A@@@@A@@@@@@@AA@@@A@A@@@@@AA@@@@@BA@@B@@@@A@@A@@@@A@@@AA@AA@C@@@@@C@A@@@AB@@A@A@A@C@@B@@AA@@ABA@A@A@@@AB@@@@A@A@C@@@@@A@AA@@C@A@@AC@C@@@CB@AA@ABA@@@A@@AAA@@@@@A@@BA@@@@@@A@A@@B@@A@@@AB@@@@@@@@@BA@AAA@AAA@@BA@@@AAC@A@@@@@@@A@C@@@A@@@B@@@@@@@BBBB@@@@A@@@A@B@@B@B@@A@@@@@@AB@@@@@A@AAAB@AA@A@@A@@@@@@@B@@A@@@A@@@B@@@@B@@@@@BB@@@A@@@A@A@A@@@@@@BB@A@@@BB@@BB@@BB@@@@@@@@BB@@@BB@@@ABA@AB@@@@B@BBA@@@@@B@@@@@A@@B@@@@BB@@@@AB@@@B@@@@BBB@@@@@A@@B@@@@A@@B@@@A@B@@@@@@@BABA@@B@@@@A@@@ABA@@@@@@B@@@B@@@@CD@B@B@B@@BB@@@@BB@@@B@@A@@BA@@@A@C@@@A@AB@@@@@@A@@BA@A@C@@@@@A@@@ABC@@@@@@@@B@@ABAB@@@B@@A@BB@@A@ABA@@@A@C@@@@@AB@BB@@B@@@BB@@@BB@@@B@@@BA@ABAB@B@@A@@B@@@D@@@@@@@B@@@BB@ABB@@@ABA@@B@B@B@BA@@B@@@BB@@@BB@@@@ABA@AB@@@B@@BB@@@@BB@B@@@@@@@B@@@BABB@@@B@@B@@C@@A@@A@AB@A@@@AAA@B@A@BA@A@@@@BA@@@A@A@@@A@@@A@@@A@A@C@@B@@AB@@AB@@AB@@@BA@@@@BA@@@AB@@A@@@AB@@AB@BA@@BAB@BAB@@BBAB@@@@@F@@AB@@@B@B@@@@AB@@@BB@@B@@BB@@@BA@@@A@@@A@A@A@@@@@A@@B@@A@A@@@@@A@@B@@A@@BA@ABA@@B@@@@@B@@A@@B@B@B@@@B@@AA@@@@@@@@@A@@@A@@@@@@A@@@AB@@@@@@@@@@AB@@@@@@A@@@@@A@@@@@@BB@B@@@@@@B@@@@@@@@@@BB@@@@@@@@BA@B@@B@@@@A@@@@@BB@@@BBB@@@@@@BA@@BA@@@@@@@@B@@B@@B@@ABCB@@@@ABA@CB@@A@@BA@A@@@@@A@ABA@@B@B@@@@@@@B@@@@@@@B@@@BA@@@@@AA@@A@@@@AA@@@@@@A@@@@@@@@@@@@@@A@@@@@@@A@@@A@@B@@@@@@A@@A@@@@@BA@@@A@@@@@@@@@@@C@@B@@AB@@E@A@A@@@A@C@@@A@@A@AA@BAAA@A@A@@B@@@@A@@@@@A@@@A@@@@A@@A@@B@@@@@@@@A@@@@@A@@@AAABA@@@@CAA@C@@@@@AAC@@@@@@@AAA@@@CA@@@A@@A@@@@@@A@@@A@@@@A@@A@@@@BABC@ABA@@@@@A@@@A@@@A@ACAA@@AAA@@AA@@@AA@@@AA@@C@@@@@A@CAEAA@@@@@@@ABA@AB@BAB@@A@@@AB@@A@@BAA@BA@@B@@@@A@@BA@@BC@ABAB@B@BBB@@BB@@@B@B@@@B@@@BB@ABAB@@C@@@AB@@@B@B@@@BA@@@@B@B@@@B@@A@@D@@@BA@@B@@@@A@AB@@AB@@A@A@@@AA@A@@A@@@A@@@ABA@A@@A@@A@@@B@@@AA@@A@@@A@AB@@C@@@A@A@@@A@@AAB@@@@A@@@AA@@@@@BA@A@@@A@A@A@AB@@A@AB@@A@@@@@AB@@A@@BA@@B@@A@@BA@A@@@@B@@@BBB@BA@@B@@@B@@A@@@@@@@@@@B@@B@A@@BB@@@@BABB@@@BD@@@@@BA@@@@@@@@BA@@B@@A@@AAB@@@@@B@@A@@@@@@@@@@@@@@@A@@@AB@@@B@@AB@@A@A@@@AAA@@@A@@@AB@@AB@@@@@B@BA@@B@@@B@@@BB@@B@@@@@BBB@@@BB@@B@@B@@@ABBB@@AB@@@@@B@@ABAB@@C@@BA@@BA@ABA@@BA@@@AB@@@@@@AA@@@@@A@@@AB@@@B@@@@A@A@@BA@@@@AAABA@A@@A@@@@A@@@A@@@AA@@A@@@A@@AA@@A@@@A@@AA@@AB@@A@@@A@@@A@A@@@C@@@AA@@A@@@AB@AA@@@@@CB@@A@A@@@@@A@A@A@@BC@A@A@@@A@AAA@AB@@A@@B@@ABA@AA@@@@@@@@@A@@A@A@A@A@@@@@@@ABAAA@@@A@@@AB@D@B@@@BA@@B@@@@A@A@@@@@A@AB@@BD@@A@@A@@@@A@A@@A@@@@@BABB@DB@B@BA@@B@@A@@@AB@@@@A@@BA@@@@@@B@@A@AAA@@@A@@@ABBB@@@BA@@@@A@A@B@B@@@@AA@@@@@@@B@@@@@@A@B@@@B@@@BB@@@@@B@@@@@@@@CBAA@@AAAB@B@@AA@@A@@@@@A@@@@BA@@@@@@@BB@@@B@@@B@@@BD@@@BB@@@D@B@B@B@D@@@B@BBBB@@@@B@BB@BBBB@@B@@@@B@@@BB@@@@@B@@B@B@BB@@@B@@BB@@@@B@@B@@BB@@@@@@B@@@BBA@B@@@@@@DD@@@@@@A@@BC@@@CD@@@@@B@@@B@@B@B@@@@@BB@@@BA@@@AB@@B@@@@AB@BB@@@@@@@@@@BB@@@B@@@B@@BB@BB@BB@@@@@D@@B@@@B@B@@@@@@B@B@@ABA@@@@@A@@@@BB@@A@@B@@BB@B@B@B@@@@@@@@BB@@@@BBB@@@B@@BDBBBBBBBBBB@@BBB@B@@BB@B@@@@@D@@AB@@BB@@@B@@@D@AB@@C@@B@@@@@BBB@@@@@@@B@@@@@B@B@BB@@@B@BB@@@B@B@@@B@@B@@B@@@B@@@B@@@@A@@@@@@B@@@@@@@B@@A@@@@@@@BBAB@@A@@@@@BB@@@@@BB@@@@B@BAB@B@@B@@BB@@@B@@@B@B@@@BA@@B@@@BA@@@@@B@@A@@@B@B@@@@B@AB@@@@@B@@@@A@@@BB@@A@A@@@@B@B@@A@@BB@@@A@@@@B@B@@@@A@@@A@@@@@@AA@@@B@@A@@@@@@@A@@@@@@@@@A@@@B@AAB@@@@@AA@@@A@@@@B@B@@@A@BA@@@@@@@@B@@A@@@AA@@A@A@@B@B@F@B@@@B@BA@@B@@A@@@AB@@AB@A@BA@@@@B@@@@@B@@@@@@@B@@@@A@@B@@@B@B@@@BA@@@A@A@@@@@A@@@@@AB@@BBB@@@@@AB@@@@@@@@@@A@BB@@@@@@A@@B@@@@B@@B@B@D@@@@@@B@@@BAB@@@@@B@BAB@B@@DAB@@B@@DD@@@@@BA@@B@@@B@@@B@B@BB@@@B@@B@@@@@@@B@@@@@BA@@@A@@@@B@@AA@B@B@BABC@@B@@@B@@B@@B@@@@@BB@B@@@@@@@B@@AA@@A@@@@B@@@@A@@@@BA@@B@@A@@B@@@BB@BA@@@@B@@@@BB@@@@@@B@@@BAB@@@@@FA@@B@@A@@@B@B@@BB@@@@@@@B@@@B@@@@B@@@BB@@@A@@@@A@@AB@@@A@@A@@@@B@@@@AB@A@A@@AB@@@@@@@@@@@@AA@@@@@@@@A@@@@@@@@@AB@@@B@@A@@@@@@@AA@@@@A@@@@A@@@@@@A@@@A@@@@@ABB@@@@@@B@@@@A@@@@B@@@B@DB@@@AB@BB@@@@@B@BAB@@BB@BB@BA@@@B@@B@@BB@@B@@BB@DB@BB@@@@@@@B@@@BB@@BA@BB@@@@B@BBB@B@D@@@@@@@@A@@@@D@@BB@BBB@@@B@B@@A@@BB@@@B@@BB@BBB@@BBBA@@BBBB@@AD@@@BBB@@B@@BB@@@BBBB@@ABA@@B@@@B@@BB@@AB@@AB@@@@AB@BA@@@@B@@@@@B@@BBA@BBAB@@B@@@B@DD@B@@@BAD@@BBB@@@@@@@@@B@@BA@AA@B@@@@@@@@@B@B@@@@B@BA@@BB@@BBBB@@@AB@@@BBBB@B@@@B@BB@A@@B@@BB@@AB@@@B@@@B@@@BB@@@@@@A@@@@B@@ADB@B@@B@B@@@@B@@B@BB@@@@@@@B@@@B@@@B@BB@A@@B@@@@@@B@@@@@BBAB@@@BA@@@@@A@@A@@@@@@@@C@BB@B@@@@@@@@A@@@AA@@A@@@A@@@A@A@A@A@@@@B@@@B@@@@@B@@@B@@@B@BB@@@@BB@@B@@BBB@BB@@B@BAB@@@B@BBB@@@@@BA@A@@@@@AB@B@@@@B@@BBDB@B@@@@BB@@@@@B@@@BBB@B@@@@B@BA@@@@@@@BBB@B@BB@@@@@@@B@@@@@@@B@@@@B@BB@@BD@@@@@@@@A@@B@B@A@B@@@@@@@@A@@@A@@@@B@@@@@B@@@@@@A@@@C@@B@@AB@@@BA@@BBB@@@B@@A@@B@B@@A@@@@@@A@@@@@@@@AA@@@@@@@@@@AB@BABA@@@@@A@@B@B@@@@A@@AA@ABA@AB@@@B@@@B@B@B@@A@@B@@A@@@@B@BABA@@@@AA@@@AA@@AB@@@@A@@@A@@@@@A@A@@B@@ABC@@@A@A@@CA@@@@C@@@@AA@B@AA@@B@@@@@BA@@@@@@@@@@@A@@@@@@A@@BAA@@@@@@BA@@BA@@@@@AB@@@@@BA@@@@@@BAB@@@@@BB@@@@BAB@@@@@DB@@B@@AB@@@B@@@BB@@B@@BA@@@@@@B@@B@@@B@@@@AB@@@BABBB@@A@@@A@@B@FBABPD@@D@PAH@J@DAF@FCDABAFCBAHGB@JEDANANA^AT@JATAF@D@NBHB@BF|B@^ADDRAF@CJeBKDCJCr[DAXODE@@@G@KGOCKE[AEBIBKDEFG]PGPIJKBGBGBC@MAMAKC[@@BIBIJKDA`SPIHO^yBERcHSBQASAKGG_BU@CBJY@QBkJG@IEAIA@CAA@AC@AA@AA@@EACA@AA@@@CAA@AAA@AA@A@A@@@A@@AA@@AAC@@@C@@@@@AA@@@C@@@AA@A@A@AAAAA@@@@AB@@@@A@@AAA@AAA@AA@@@AA@@A@@A@A@AAA@ADA@@@AA@@A@@B@@AB@A@@A@A@A@@@A@@A@@@@A@A@ABA@@@AB@@@BA@B@@@B@@@@B@@A@AB@B@@@@AAAB@@@@@B@@@@A@@@AAA@@@A@@@A@@A@@@@AA@@A@@@A@@AABA@@@@C@@C@@BBB@BA@A@A@@@@BB@@@@@@@@BAB@@A@@@A@@@CAAAA@@AAAA@AAA@@AA@A@@@AB@@@BBB@@BB@@@B@BAB@@@BA@@B@@A@@@A@@@@B@@A@A@@B@@@@AB@@"]],encodeOffsets:[[[124722,29655]],[[124690,29687]],[[124604,29824]],[[124702,29672]],[[124723,29658]],[[124729,29654]],[[124609,29760]],[[124672,29789]],[[124597,29770]],[[124700,29677]],[[124614,29817]],[[124632,29781]],[[124582,29757]],[[124614,29806]],[[124489,29543]]]}},{type:"Feature",id:"331023",properties:{name:"天台县",cp:[121.006595,29.144064],childNum:1},geometry:{type:"Polygon",
+coordinates:["@@@A@A@A@@BABA@@@@A@@AA@@@@ABA@A@A@@A@@A@@@@AA@@@@B@@@BAAA@@@@@@B@@@@A@@@@@@@A@@@@B@@@@@@A@@@A@@@AA@@@@A@@@A@A@@AAA@@@A@@A@A@A@@@@@A@@@@@@AA@A@@@@@AD@@@BAC@@@A@@@A@@AA@@BC@@@@@A@A@@AA@A@AA@@AAAAAAAAAAAC@@@A@@AA@A@@A@@A@@@@@@A@A@A@A@@AA@@@@BA@@A@@B@@@@@B@BA@@@A@A@@@@A@A@@@A@@@@C@@@@AAA@@AAA@@@A@@@A@@AA@@@@@@@@@@AAA@@B@@A@@@BA@@B@@A@@AA@@@@A@A@@@@A@@@A@@@@DC@@D@@AB@@@@@@@CC@@@@@@@AAB@A@@@A@@@@A@@AA@@@@A@@A@@AA@@@A@@A@A@AA@@@@@A@@A@@@A@@A@@@AAAAA@@A@A@@A@AA@A@A@@@C@A@A@A@C@@AA@@C@@A@@@A@@@A@@AA@@@@@@@@AA@@AA@@@A@@A@A@@@A@@@@A@@A@A@@@AB@@A@@@@@C@@@A@@@A@@@@@ABBB@@@B@B@B@@A@@@@@@A@@AA@@A@A@AA@@@@@A@@@@A@@@@@A@A@@@AA@BA@A@@@@@@@A@@@A@@@A@@BAA@@AB@BABB@@BB@@BAB@BAB@@@BB@BDA@A@@B@@BB@@@B@@ABAB@B@B@@C@A@@@@@AAC@@@A@@@@@A@@@A@@@@B@@CBA@@BAAABA@@@@@A@@@@B@@@B@@AA@@A@@@AA@AAAA@AAA@@A@@@A@@@A@@A@@AAAA@@@@@AB@BAB@B@B@BAB@@C@@BAB@BA@@@@B@@AB@@A@@AA@AAA@CA@@@A@@A@@@A@A@A@@AA@@@@AA@A@ABA@@@AA@@ABA@CA@@ABA@A@AB@@@B@@ABCB@@@@BDB@@BB@@B@@A@@B@B@@AB@@@BA@AB@@AA@@A@A@C@@@AB@@@@A@@BA@@@C@@@@@CBA@@@AB@@AB@@A@A@@@CBA@A@A@@@AA@@A@@BA@@BA@@@@@@B@@@B@@@BA@AB@@AA@@A@A@A@AA@BA@@@AAA@@@A@A@@A@A@AA@@@@@A@A@AAC@@A@A@@@@A@AA@@AA@@@@@AA@@A@AA@BAAA@@A@@AAA@@BA@AAA@@@C@@@AA@AA@@AA@AAAA@@@AA@C@A@A@@A@A@ECA@@@C@@AA@AA@A@@@A@AA@@@A@A@@BAA@@@@A@@@A@@AA@@@A@@@A@@AA@CA@CAAA@A@@@@@A@@@@@A@@@A@@AA@@@A@A@@AA@@A@@ABA@@AA@A@A@@@AB@B@@AB@BA@@@@BABBBBB@@CBA@@@A@@@A@@@A@A@@@A@@@@B@@AB@BADA@AA@@A@A@@@@@@A@@A@A@AB@@ABC@AA@AA@@@A@A@A@C@@@A@@B@B@BA@@B@@@@AA@BA@@@@@AAAAA@@@C@@@C@A@CB@@A@AAC@@@AB@@@@AB@@AB@@A@AA@@@@@A@@AA@@@A@@@@A@A@AAA@A@C@@@A@@@AA@@@@A@AB@@AB@@@BA@@B@@A@@BAAABA@@B@@@@@@@B@@A@A@AB@@AA@@ABAA@@@A@@@@AA@@AB@@CBAA@@A@@A@@AAA@A@A@@@ACAA@C@@AA@@AA@@@C@@BA@@AAA@@@CCAA@A@@@@@A@@AAAA@A@A@@AA@@@@A@A@A@AA@@A@A@@AA@@@AA@@A@@@A@@@@@A@AA@AA@@@A@A@@A@@A@@@@@A@@B@@ABA@@@A@AAA@A@@@AAA@@B@AA@AB@AA@A@@@AAA@A@@BA@@@A@@@@@ACAA@@@AA@@@A@@@A@A@@@AAA@@@AA@@A@A@@AA@@@@AA@AAAA@AA@AAAAAA@@AA@@AAAA@@@A@C@@@A@@CC@@@AA@AA@A@@@AA@@@@@C@AA@@@@A@ECA@@AA@A@A@@A@@A@@@A@A@AA@@@AA@@AA@AAA@AAAAA@A@A@@@AA@@@AA@@A@@@A@@AABA@@@AA@@@@AA@@AB@@AA@@ACA@A@A@@@@AAA@A@@AAA@@AA@@EBA@A@@@A@AACA@@AB@@A@AAC@@B@@CA@@@@@BAB@@@B@@@@@@A@@B@@AB@@@@A@@@ABA@@@@B@@@@@@@@A@BB@@AB@@A@@AAB@@AA@@@A@BAB@@@@A@@BA@@@@@A@@@A@@@A@AAA@@@AB@@A@@@A@A@@@@B@@A@@B@@AB@@@BAB@@@B@BA@@B@@AB@@A@@@@BA@AB@@CAA@A@@DAB@B@BA@@B@@@B@BB@@@@B@@@B@@@DB@@@ABA@@@@B@B@BA@A@@@A@@@AB@B@@@@BB@@@B@@ABAD@B@@A@@@@@ACA@@@A@@@A@A@@@C@A@@B@@AA@@AA@AA@@@@@@@A@@@AA@@A@@@@@@B@B@@@BA@@CAAA@AA@@AAA@@A@@AA@@AA@@@@BAA@@AB@AA@A@@@@C@@@A@AA@@@@A@@@@@@@A@A@@@A@@@@BAB@@@B@@@BA@@@@BA@@@@@ABA@@A@@AA@@A@@AAA@@@AA@@@A@@AA@@@@AC@@A@@A@@@@@@@A@A@AAA@@@ABA@@AE@ABA@A@@AA@EAAAABB@@@@BAB@B@@@@BBB@@@B@@@B@FBD@@@@B@@DB@@@B@@@B@@@DA@@BBBA@@@AB@@A@AB@D@@A@@A@@@@ABABAD@@BB@@@B@@BB@@@@@BB@A@@B@@@@@B@@B@B@@B@B@@@BB@@BBB@@B@BB@@@@@B@B@BA@@BA@@@@@@B@B@@@@@@AB@@A@@@@A@@@@A@@@@A@@AA@@@@@@@A@@@@@@A@ABA@@@@B@@@BBB@@A@@B@@@@@BB@@B@@@B@@BB@@B@@B@B@@B@@B@@@@BD@B@BA@EA@@A@A@@B@@AB@BA@@@BD@@@BA@@C@@A@@@A@@@@@@B@@@B@@@D@BBA@@@@@@ABDB@@ABAD@@@@@@AA@@BAA@@@EA@BA@@@A@AAAA@A@@@A@@@A@@B@@A@@@A@A@@@A@@A@A@@@@A@@B@BA@AAAC@@AB@@@@@@AC@@@A@A@@B@@A@@BAB@@@B@@@@A@@@AD@@@@@@AB@@A@A@@@@B@@@B@B@@AB@@@DBB@@ABAB@B@B@@@B@@AB@@@@A@@@@@@@@@@B@@BBB@@@@A@@@@B@BA@@BA@A@@@@@B@@DBB@@@@BB@@@@@@B@@BDA@@BA@@BA@@@A@AB@@ABC@@@ABABCB@@@B@@B@B@BA@@BAB@B@B@@@@@@@@B@@@B@@@B@@ABA@B@B@@BB@D@B@@@DB@@@@A@@BABB@@BB@BB@@A@@B@@@@AB@B@B@BB@@@BBBB@B@D@B@B@B@@B@@BAD@B@@A@@@ABAB@B@@ABA@@@@@AB@@ABABABA@@BA@@B@@@B@@@B@B@@@@@BBB@B@@@@@@@BBA@@BB@@BB@@@@BB@ABBB@@BBBB@B@BB@@BABBB@B@D@@@@@@B@@BB@BB@@BB@@B@@B@@B@BB@BBB@B@BBAB@@B@@ABB@@@@B@@@@@@@BBB@A@@@@@CB@@@@@@AD@BB@BBBB@BBB@B@@@BB@AB@@BB@@BA@@B@@@@AB@@@@A@ABA@@BA@@@@@AB@D@@@DBB@BB@AB@AA@@@A@@@BB@@@B@B@B@@A@@BBB@B@@@BB@B@@@BB@BB@@@@@B@BBB@@@@BB@@@BAB@@@BAB@B@@A@A@AB@AE@C@@@A@@B@B@@@BA@@B@@@@A@A@@@@A@AAB@B@@A@A@A@@@@@AB@B@D@@@@AAA@@@AAAB@AA@@ACAA@@A@@@@A@@B@@@D@B@@@@@BA@@B@@@B@@@@@@@@E@ABAA@B@@A@@BB@@B@@B@B@@@@@@B@@ABABA@@BA@@B@B@BB@@@@@@B@BBD@@@@@@B@@@B@B@@@B@D@@@B@@@@@@BAB@@@@@B@@B@@BBBB@@B@@@@AB@BD@@@B@@@BBBB@@@B@@AB@@@@AA@@AB@@@B@@B@@@B@@@B@B@BB@@@@D@@BBBBABBB@BB@@@BB@B@BB@@B@@@B@@@@B@@BB@@B@@@AB@BB@DBB@@@BB@@B@@BB@@@@B@@B@@ABB@@BB@@@@@B@@B@@@BB@@B@B@@@@@@@@B@DB@@B@@ABB@ABBB@B@B@@BBB@B@B@B@BB@@B@BB@@@@@B@@@@B@@B@@@B@@ABA@@B@@AB@BA@@BDABA@@@A@@BA@@@@@BBB@B@@@@BB@@@DB@@@B@@@@@@@@@@@@@@@@@@A@@@A@@BA@@B@@@BCBA@@@@@@BB@@BB@@B@BBBB@@@@@@@BA@@@@B@@@@A@@B@@@@A@@BA@@B@@@@AB@B@@@@BBA@@@@BB@BBB@@@B@B@@B@@BB@@A@ABA@@@@D@@@@@B@@B@B@B@@B@B@@B@B@@@B@B@@@@A@@B@@B@@@BB@AB@@A@@@@@@@BB@BB@@BAB@B@@A@A@@B@@B@@BBA@BB@@@BBB@@@@@B@B@B@B@@@B@B@@BB@@BBBB@@BD@@B@@@BD@@@@BB@@@B@BBD@BB@BB@@@BB@@BABB@@B@@A@@@A@AB@@@B@B@B@B@B@@@@A@@@ABA@ABAFBB@BB@@@@@AB@B@@@@@BB@@B@@@B@DB@@B@@BB@@@BBBBB@@@BBBBB@@@B@@@@ABB@@B@@@@B@@@BB@@@BB@@BDB@B@@@@B@@@@@@@@B@@B@B@@@@@BBD@@B@@@B@@@@BBB@@@BB@B@@@@B@@B@@DB@@@B@@@@@B@@B@@B@B@@AB@@B@ABB@@B@@@BA@@BABA@@@AB@@A@@@A@@B@BC@@@@@@@AAABA@@@@B@B@@@BAD@@@BABB@A@@@@B@@BB@@@@BB@@@@AB@B@DCBA@@BABA@@@@B@B@@@@B@@B@BBD@B@B@@@BB@@@@A@@BB@@B@@@B@@@@@B@@DB@@@BB@@B@@@B@@AB@@@B@@B@B@@@@@@BA@ABABAB@@D@D@B@@@@BEDAA@@AB@@A@CF@@BB@@ABAB@@@B@@A@@BBB@BB@BB@@BB@@@B@BDABB@BB@@@B@B@@AB@@BB@@@BB@ABBBBB@@B@@@@@B@D@B@BAD@BABBB@@@@@@@B@@B@@@@@BB@@@@@@@@@BBBAB@BA@@@B@BB@@@B@B@@BB@B@@BBB@BB@@BB@B@@B@@BBB@@@@B@@A@@@A@@@ABA@@@@@@BBB@@@@@BB@@@BD@AB@B@@@@@@@@@@B@@@@@B@@@A@@@@A@@@AB@B@@A@@B@@@BA@@B@@@@@BB@@@@@@@AB@@@@A@@@@B@@A@@@@@@@A@@@A@@@@@@A@@@@A@@@@@A@A@ABB@@BA@@@BBAB@@@@@B@@@BB@AB@BA@@@@BB@@BB@B@@B@@@@@@@BAB@B@B@@@@BB@BC@@B@B@@A@@@AB@A@BA@@@CBAB@BA@@@BB@B@@@@D@B@B@@BB@@@B@@@@@B@@@B@@@@D@BAB@B@BB@@@B@B@B@@BBB@@BBB@@@B@BBB@@@@DA@@B@@@@ABAB@@@B@@BB@@@@@@B@@ABB@AB@BB@BAB@@A@@@D@@@@B@BB@@@@BB@B@BBB@@BB@@ABAB@B@@@B@@BB@@B@@@@@@@B@@D@BB@AB@@@@BB@@BABAB@@B@@@@B@@@@@B@@@BBBA@@B@@@@A@@B@@A@@BA@A@@AAA@@@AA@@AA@A@@@ABAB@B@DA@@BA@@B@@@@@BB@@@@@BB@B@@@@@D@BABB@@BBB@@BABAB@@B@@BD@F@B@DD@@AB@BA@@BA@@BA@@B@@@B@@B@B@B@@@B@B@B@@@@A@@@@BAB@BBB@D@DB@C@@B@@@BB@@BBDB@@@@BAB@@AB@@C@@@@B@@@B@@@@A@@BA@@BA@A@@AA@A@@@ABAAA@@B@BAB@B@BBB@DABBB@B@@@BBD@@@B@BAB@@@BB@@BB@@B@B@@BBBDB@@BB@@ADB@B@@@@@B@BB@@@@@B@B@@@@@@@@B@AB@@BB@@@B@@@@A@@B@@B@@B@@D@@@@A@@B@BB@@@@@BBA@@BAD@BA@@@@@@B@@@B@B@D@B@BA@@BBB@B@@@B@B@@@BAB@@@B@@A@A@@@AB@B@@AB@@AD@BA@B@B@@@@@BDB@@@@B@B@B@BB@@@@BADA@@@@DABBB@B@@@@@BA@ADAAC@@@A@@A@@@@A@@@A@@D@@@@@@@@@B@@@BA@@A@@A@@@@AABA@@BABAB@@@B@BAD@B@B@@AB@@@B@@@B@D@B@@@BB@@BBBB@@@BBB@@@B@B@D@@BBAB@B@@@B@@B@BD@@BB@B@@@BC@@B@@ADAB@B@B@B@B@@@B@@@B@@@B@@@@@BBB@@@@DBBB@@@@B@@B@@@BB@@BB@@@B@D@D@@@B@@@B@@@D@@@B@B@BAB@@AB@@@B@@@B@BAB@B@@A@AB@B@@ADAB@@AB@B@B@@BB@BB@@BA@@B@@B@@B@@B@BB@@@BB@@@BBB@BB@@BB@@B@@BBB@BDB@@BB@B@@@@A@A@@@AA@@@AA@@@A@A@@A@@A@A@A@A@@@@@AAC@AB@@A@A@@@A@@@A@@@AD@B@BA@@BA@@B@B@@AB@@@B@@@@@B@B@@@@B@@@@BD@@@@B@@@@B@@@@@@@@B@FB@@@BB@@@@@B@@@@@BABAB@@@@@BA@@@AB@@AB@@@@@@@B@BAB@B@B@@@B@@@@@@AA@@@@AA@@@@A@@@@A@@@@@@@A@C@A@@A@A@@BABEBC@@@@@@DBHDBB@@B@@@BAB@BC@@BA@AACAA@A@A@@@@@AB@@A@@A@AAA@ECA@AAAA@@@@A@@@A@@@A@@@A@@@@@A@@A@@ACACC@@ACAEC@@B@@AB@AA@@BA@@BB@A@@BAA@@@@@A@@@@AA@@@@@@A@@B@@@@@B@@@AA@@A@@A@@@@A@@@@@@A@AA@@@@AAA@A@A@A@@A@BAAA@@BCBA@@BC@A@@@@D@@@@@BA@@A@@@@A@@B@@@B@@A@@A@@@A@@@@A@C@AAA@@A@@@A@@BB@A@@@@B@@AA@@@B@@AB@@A@AB@@A@A@A@@@A@AA@@A@@@@AB@@A@C@AAAA@AA@A@AB@@@AABC@AA@AA@@AA@@AABAB@B@B@B@B@D@@AB@@AAAAABA@@@A@A@@AA@@@@B@BAB@AA@A@@BA@A@A@AA@@@@@@BA@@@A@@BA@ABA@@@@@@@@A@@B@@ABA@A@ABA@C@@AA@A@@B@@A@AB@@AB@@A@@ACAABAA@@AA@AA@AAA@A@A@@@@@A@@@A@A@@B@B@BAB@@@B@B@DC@@@@BAB@@@BB@@DBB@B@D@B@B@@AB@@@@@B@@@B@B@@@D@@AB@BA@BB@@@@BB@@@@BB@@@B@@@@@@@B@BA@@@@@@@AB@@@B@B@@@@@B@@@BB@@@BB@B@@BBA@@@@@@B@B@B@B@@B@@@BB@@@B@@@@@B@BA@A@B@@@B@@B@@BB@@BB@@@BA@BB@@@@@B@B@@AB@@@AA@@@A@@@@@@AA@@@A@@A@@AB@B@B@BAB@@@@@B@@@B@@@@@@A@@A@@AB@@@@A@A@@BA@@BAB@@@@@BA@@@@D@BA@@B@B@AA@C@@AA@A@C@@BA@@A@@A@A@@AA@A@A@A@AB@@@@@@A@@@AD@BA@AB@@AB@B@@AB@@@B@@A@@DA@@BA@@@AB@@@BA@A@@@@@@@AB@@@@@B@@AB@@@@@@@@@BAB@@@BA@@@@@A@A@A@@AA@@@A@@@@@@@@@@BA@@@@B@@@BABA@@BAB@@@BB@@B@@@@@BA@@@@B@@@@@@@@AB@B@BAB@@@AAAC@AA@AAA@A@@@AA@@AA@@BA@A@@@AD@BA@@@@BA@@@A@AA@@@@@@A@@AA@@B@A@@A@@A@@@@ABC@@@A@A@A@EA@@@A@@CAA@A@@@AB@@A@@BA@@@AB@A@@@C@AA@A@@A@@@"],encodeOffsets:[[124165,29819]]}},{type:"Feature",id:"331081",properties:{name:"温岭市",cp:[121.385604,28.372506],childNum:25},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@@@@@@@@@@@A@@BB@@@@@B@@@@@@@@@@@@A@@A@"],["@@@A@@AA@@@BA@@@BB@@@@@@B@@@@@@@@@@@@@@@@@"],["@@@@@B@@@@@@AA@@@@@@A@@@@@@@@@@@@@A@@@@B@@B@@BB@@@@@B@@@@@@@B@@@@@B@@A@@AA@@A@"],["@@@A@@AAA@@@A@@@A@@@@@@@@BB@BBB@B@"],["@@A@@@B@AA@@A@A@A@@BBB@@BBB@@@@@@A@@B@@@@A"],["@@@@@@AB@@@@@@A@@@A@@@@B@@@@A@@@@@@B@@B@BB@@BA@@@@@A@@@@B@BA@@BAA@@@A@"],["@@AA@AEC@@A@@B@@BD@@B@BBB@@@B@"],["@@A@@A@@AB@@@@A@@@A@@@@@@B@@@B@@@B@@B@B@D@@A@@@A@A"],["@@@@@@A@@A@@A@@@AB@AAB@AA@@B@@@@BB@@@BB@B@B@@BB@@@B@BA@@@AA@@@AA"],["@@@@@@A@@@@@A@@@@AAAA@A@A@@@@@@@@B@@@@@B@@B@@BBBB@BAB@@@B@@@@A"],["@@BA@@@@AA@@@@B@@@AA@AA@@@@@A@A@@B@@A@A@A@@B@@@@BBB@@@BB@@B@B@@@@@@@@@@AB@@B@@"],["@@BC@@@A@@AAA@AAA@AB@@BD@@@B@BBBB@B@@A@@"],["@@B@@A@AAA@AAA@@AA@@ABABABAB@BB@BBB@@@BA@@BB@@B@@@"],["@@@A@@@@@@@AA@@AA@A@@@@@AA@@@@@@A@@@ABB@A@@@@@@@A@@BB@@@@BA@@@A@@@BB@@B@@@@BB@@@B@B@@@B@@@@@B@@@B@B@@@@A@@A@"],["@@@@@@@A@@@@@@@@@A@AA@@AA@@@A@@@A@@@@BAB@@A@@@A@A@@B@@@BB@BBB@B@B@BBBA@@@@B@@@@A@@"],["@@A@@A@@AA@@@@AA@@A@@@AB@@AA@@@@A@@@A@@@@B@@@@@B@@B@BB@@@B@@@B@@@@B@@@B@@@B@@BB@B@@A@@@@@@@@@AB@@@@@@A"],["@@@A@@B@B@@@B@@A@A@@A@AA@ACAA@A@A@@B@@@BBB@B@@AB@B@BBB@@BBDBB@@@B@B@@@@A@@AAA@@A@AA@@@@@"],["@@@@@@AA@@A@@@@@@@@@A@@@@@A@@@@@AAA@AA@@A@@@@@@B@@@@@@@B@@@@BB@@@@@AB@@@@@@B@@BB@B@BB@@B@B@@AB@@@@B@AB@@@@A@A@@@@B@@@@@BB@B@@@B@@A@@@@@@B@@A@@B@@A@@@@@@@@@A@@B@@@@@@@@@@A@@@@@@B@B@B@@@@A@@@@@@A@@A@@B@@@@@@@@AA@@@AA@@@@"],["@@@A@@@A@@A@AA@@A@@AAB@@A@@B@@A@A@@@AB@@@@BB@B@@A@A@A@@B@@A@@AA@AB@@@@BBB@DBDBB@@AB@@BB@B@@A@@B@@@BA@@@A@A@@@A@@@@@@B@@@"],["@@@A@AAAAAAA@AA@CAC@ABA@BB@@BB@BABB@@@DBBBA@@B@@@BBBBBB@@ABA@@@ADB@@BAAA@@"],["@@B@B@AA@@A@BA@@@A@@@ACACAC@CBAD@@@BBB@BBBBBB@DBB@BA@A@A"],["@@DBBBB@B@@B@@@@@B@@@@@B@@@@@BB@@@@@B@@@@B@@B@@@BB@@A@@@@B@@B@@@@@BA@@@@B@@BA@B@@B@@B@@@@B@@@@@B@@B@@BB@@B@@@B@@B@@@@@B@@@@@@@@A@@@A@@@@@@@@BBB@@@B@@BB@@A@@@A@@A@@@AA@@A@A@@@A@@@@A@@@A@@AA@@B@@A@@A@@A@@B@@A@@@@A@@@A@@AA@@@A@AAA@@AAAA@@AAAC@AAA@A@A@A@@B@@BB@@@A"],["@@B@B@B@BA@@@AAAC@CAAACB@@A@C@A@A@ABBB@BD@BB@@@@A@ABA@@BBBDBBBB@B@HDBBB@B@BAB@@A@@CAAA@AC@AA@A@@@A"],["@@@@B@B@B@@A@@@ABA@@@@AA@AAAB@@AB@AA@A@@@AA@@AA@ABAB@B@B@@A@A@@@A@A@AAAB@@@B@@@@@@ABAB@@@BBBD@@B@@@BA@A@@@AB@@@B@@BBB@B@B@@@@BBB@@B@B@B@@@BB@@D@@@@A@@@AA@@AAA@A@@@A"],["@@@@@@A@A@@AA@@A@@@@@AA@A@@B@@AB@@@@@B@B@@@@@@@B@@A@@B@@B@@B@@@@@@@B@@@@@@AA@@@AA@@A@@@B@@@@@B@@@@@@@B@@B@@@@@AB@AAB@A@@A@@@A@A@@A@@@AB@@@@@@@A@@AA@B@@@A@@@A@A@@A@@@ABA@@BA@@@@@@@@A@@@@@@@@A@@@@@A@@@@AA@@@BA@@@@@A@@@AAA@@@AA@@A@@B@B@@@@@@CA@A@@@A@@@@A@@@AB@@@D@@@@ADAB@@@BA@@@ADAB@B@D@BAB@@A@A@@B@@A@@@@@A@@@AACBAAA@@A@@A@@@A@@@@@@B@@@BAB@@@@C@AB@@C@@@A@@@AAABAAAB@A@@A@@@@B@@@A@@@BA@A@@B@@@@@@@@AB@@A@@B@@A@ABA@@B@@A@DF@B@@@@@B@@@@@BB@@B@@@@BB@@@@BB@@@@@B@B@@BB@B@@@@@A@@@@B@AA@@@@@@@A@@@@@@@@@@@@B@B@@@@@@@@B@@@@B@B@@B@@@B@@@@@@@@@@@@A@A@@B@@B@@BDB@@BBA@A@A@@@AB@@A@AB@@A@@@@@A@@@@@AA@@@@@B@@B@BB@@BB@@@B@@AB@BB@@B@@F@B@@@@B@@@@A@@BB@A@@B@@A@@@@@AA@@@B@@A@BB@B@@@@@@A@@@@@@BD@@@B@@B@@@B@@AB@BBB@BBB@BB@@B@B@@@BB@@@@@@BB@@@@@A@@B@@@@@B@@@@A@@@@BA@@AA@@@@A@@@@@AA@A@@@@BAA@@AB@@AA@A@@A@@A@@@@@A@@@@@A@@A@@A@BA@@@A@@@@A@@CACA@@@@AA@@AA@@ACAAC@A@@A@@B@@@@AA@@@@@AB@@@@@@@@ABABA@A@AAAA@ACA@@AB@@A@A@@@AAA@@@@A@@@@@AAA@@@@@A@@A@C@AAA@AA@@A@@@A@A@@@A@@@@@A@BA@@@AB@@@AA@BCB@@ABA@@@AA@@@@A@@@A@@AA@@@AA@A@@AA@@@AA@@@@A@@@A@A@@AA@@AAA@@@A@@@A@@AA@@@@AB@@@@@B@@CA@@@@@A@@@A@@@@@AB@B@@@@@@@@A@@@@BA@@@@@A@@A@@A@@@A@@@@@@@A@@@@@@B@@@@A@@@B@A@@@@@@@@@@A@@A@A@@AA@@@A@@@@@C@@@@@A@AC@@@A@@A@A@@@@A@@@@@@@@AAA@@@A@AA@@@@@A@A@@@@@@A@AA@@AA@@@BB@AB@B@@@B@B@@CB@@@@A@@A@@@@AAA@AAAAACAA@A@AAAOF@@AB@BA@A@@@QBEB@@K@S@ECCCA@CAAAG@@@G@I@@fBP@L@dBD@BBBBB@@B@AB@B@@@B@D@@DL@@BF@DBD@B@DB@BD@B@@@@@DBJEFEHILFB@B@B@B@@BB@@B@@@@@@A@@@@@A@@@@DADBBA@BD@BB@@@@B@@@@@B@B@B@B@@HA@A@@@A@@B@B@@@@BBBBB@@@@@@B@@@@B@@@@@B@@B@@@@B@@@@@@@@@B@AB@@B@@@@@@@@B@@B@@@@@AB@@@@@@@@BB@@@@@@B@AA@@B@@@@@@@@@@AD@@B@@DAB@B@B@B@B@B@@@B@B@B@@@B@BB@@B@B@@@@BB@@BA@@B@@BB@@@B@@@B@@@@BB@BB@BB@@@@AB@@BB@BB@@B@@BB@@AB@@@@@B@@B@B@BBA@C@AB@@AB@B@BA@@@DA@@D@B@BBBBB@B@@@B@@BB@@@ABCB@@@B@@ABCB@@A@@BA@@@A@A@CB@@@B@@@B@@A@@@A@@@@@@@@@A@AB@@@@@@AB@@AB@@A@@@B@@BA@@BE@@BB@@@A@@@A@@BA@@@AA@@@@@BA@@B@@@@A@@@@A@@@@@@@@AA@@@B@@@@@@A@@@@A@@ABB@@@@B@@@@A@@@@A@@@@@@@@A@@@@@@@@@A@@@@@@@ABA@@@@@B@@@@BA@@@A@@@@A@@@@@@@A@@A@@@@@B@@@@AAA@@@BA@@@AB@@@@BBA@@@@BA@@@@@@BBB@B@@B@@@@@@@B@@@@B@B@@@BBB@@@@@B@@@@@@@@B@@@@@B@@BB@@B@BAB@B@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@AB@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@@A@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@A@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@A@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@@@@@@@@@@A@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@A@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@B@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@B@@@@@@B@@@B@@BA@@@ABA@@@ABA@AA@@@BA@A@@@@BA@@@AB@@A@@@@@@@@BA@@BA@AB@@CNAB@@A@@AAB@@@@@@@@C@@@B@@B@@@B@@A@@A@@A@@@AA@@@B@@A@@AA@@@@@AD@BAB@@C@@@@@@@@B@A@BAB@@@B@B@@@@A@@@A@@BB@@@@@B@@B@@A@AB@@@BB@@@@BA@@@@@@BABAB@B@@@B@@AB@@@BA@@@AB@AAB@B@@A@@@A@@@@@@B@@@@@@BB@@@BB@@BBAAB@B@@@B@B@B@D@@B@B@B@DB@@BB@@D@B@B@B@B@@@B@DB@@D@@@@B@@B@@BB@BB@@@B@@@@@@@@@B@@@@BB@@B@@@@@@@BA@@@@@@A@@@@@@@@A@@@A@@B@@@@B@BD@A@@ABA@A@BB@@@@AB@BBB@@@B@B@B@@@@AB@@@BBB@@B@@B@@@B@B@@ABB@AB@B@BBB@@@D@@@BA@A@@B@@@@@AA@@A@AA@@@@B@@@@@B@B@@B@@BA@@B@B@DA@@@@@@@@@@B@@@@@@@DAB@B@B@@@D@@@BA@@B@@@B@BB@@BB@@@B@@@@BB@BB@BBBA@AB@@@B@@@B@B@@@B@BA@@B@@AB@@AB@@@B@@@B@@@B@@@BB@@@BBB@BB@@B@@B@@@BA@@B@@@@B@BB@@B@@B@B@@@B@@@@AA@C@@@@A@@B@B@B@@@BA@@B@BB@B@@@AB@@@@A@@B@@B@@@B@@@@@@A@@@@@@@@B@@B@B@@@@@CB@@@@@@B@@@@B@@A@C@@@@BA@@B@@@@BB@@AB@@BB@@@@B@@@@@@A@@B@@A@@@A@@B@@@BA@@@B@@B@@A@@B@@@@@@@@@@B@@@@@@B@@@B@@@B@@@@@B@@@B@@@@B@@@@B@@AB@B@@BB@@@B@A@@B@@@B@@@@@@@@@@D@@B@@B@@BBBB@@@@BB@@BA@@@@@@B@@@@B@@@@B@@B@@@B@A@@B@@@@B@@@B@@@B@@@@@B@@@B@B@B@@@@@@@B@B@@@B@BA@BB@@@@@BA@@@@B@@@BAB@@@@@B@B@B@B@@@B@@BB@B@BB@@@B@@D@@@@@@@BA@@B@@@B@@@BB@BBA@@@BB@@@B@B@B@B@@@B@@@BA@@B@B@B@BA@@B@@@@@A@@@@AB@@@B@@AB@@A@@@@@A@@B@@@B@@A@@@@@@@@@@@B@@@@B@@B@@@BB@BB@@@A@@@@BB@@@@@A@CBA@@BA@@@@@@@@B@@@B@@@@A@@AA@@@A@@AA@AD@@A@@@@@A@@@@@CB@@@@@@BB@BBA@@@B@@@@B@A@@@@@@B@@@@A@@BAACAB@@@@@AA@@@A@@A@@@@B@@@@@@BB@@@B@@@@@@BB@@@@@@@BB@@@BB@B@@@B@@@@@@@BAB@@@@@@@B@@A@@@@@@@A@@B@@@B@@@BFBB@@@B@@D@B@@@D@D@D@B@BFB@BA@B@@@A@@B@@@@C@@B@@AB@@@@@DA@AB@@AB@@@@@@A@@@@@AB@@@@@@A@@@@FAB@BA@@@@BA@AB@@B@A@@B@B@B@@@@@@@B@A@@A@@B@@BBA@@@AFA@A@@A@B@@A@@B@FAB@@@B@B@B@@@@AA@@A@@@@@A@@@A@@@AB@@@@@@@@@B@@@B@@@@BBB@@@@B@@B@AB@@@@B@@@@B@@@DA@@BB@@B@@@B@B@@B@B@@@@@@B@ABB@@BB@A@BB@@@@@B@@D@@@BA@@@@@@A@@AD@@@DABB@@@@A@@BB@@@@@@@DAB@@@@@@@@@@@@@B@ABB@@@@@B@ABB@@@@@B@@@@@BA@A@@BB@@BA@@@@@BB@A@@@B@B@@@B@@BA@@B@BA@@BB@ABB@@BB@@@@@@@BB@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@B@@@@@@@@@B@@@@@BA@@@AB@@@@AD@@@@@@@@@@@B@@@B@@@@CB@BBB@@A@@@A@@@AD@@@@@BAB@BABA@@B@BA@@@AB@@@@@@@@A@@B@@A@@@@@@@@AA@@B@@A@@AEAA@@@@@A@@@@@A@@@@@@@@@@@@B@@@@@@@B@@@@A@@@@BA@B@@@@@@B@AAB@@@@@@@@@B@@@@B@@BB@@@@B@@@@@@BBA@@@@@@@@@@@ABA@@@@@B@@@@B@@@@@@@B@@@@A@@B@@A@BB@@@@@@@B@@@@@@@BA@@@@@@@@B@@@@@@@B@@@@@B@@@@@B@@A@@@@B@@B@@@@@@@@@@@@B@@B@@@BB@@@@A@@BAD@@@@@BB@@@B@@@B@@B@B@@@B@B@BBB@@@@A@@BA@@@A@AA@AA@@@@A@@@@@@@@AB@@A@@@@A@@@A@@@@@@A@A@A@@BA@@B@@@@ABC@BB@BDBBBB@DB@@@@@B@@@B@@AB@B@@@@@@@@BA@@B@@@B@@AB@@@@@@@BA@@@@B@@@B@@@@@@CFB@B@@BB@DBBB@@DDH@@@DBH@DBD@@@@@@A@AB@@@FBB@BB@@F@BAB@@@@B@@BBD@D@@A@@@@B@@@BB@@DBB@@@@B@@D@@@B@DAB@@@DABABA@@@A@@@AAA@@@A@@BA@@@AB@@AD@@@D@@@BBB@BDB@BBB@DBB@DA@@BA@@BA@ABAB@BAB@B@D@B@FBB@BB@BBD@@BB@@B@BB@@D@B@D@B@DAD@BABAFC@@BCFEB@DCHCFAB@D@BAPAFKDEAEKI@AN[FGDAB@F@J@TB`@TBVCNEJODIDKBEBUCIGC]IMCMCCCBC@ABCBA@A@EACCCGKMKGCAAICCG@@AE@C@CBADAD@LBRHPHD@BA@CBMBABALHDABCAACACA@C@C@EACEGSU@AEGAICEACCGCE@ACGBGDEDG@EACAACACAEAAACCAE@@@E@AAC@@ACEGGEECQAKAKAAAC@MAICGECAC@ABABAFALCDABCHABALANBNBDABAFKHMH_NEBUHC@[FY@ICKEGIAACIAAEIQKMIECCGAG@C@IBEAEOGOHQDID@@A@CB@@A@A@@@AAA@AB@@@@@@A@@@@A@@A@@@A@@AA@@@@BA@@@@@B@@B@@@@@@A@@B@@@@@BA@@BAB"]],encodeOffsets:[[[124626,28920]],[[124630,28928]],[[124565,28928]],[[124587,28981]],[[124534,28925]],[[124482,28924]],[[124517,28924]],[[124609,29068]],[[124588,28888]],[[124476,28957]],[[124611,28952]],[[124643,29086]],[[124661,28949]],[[124621,28946]],[[124492,28926]],[[124575,28897]],[[124582,28972]],[[124615,29059]],[[124611,29051]],[[124575,28978]],[[124653,29062]],[[124563,29031]],[[124561,28903]],[[124607,28963]],[[124327,28939]]]}},{type:"Feature",id:"331024",properties:{name:"仙居县",cp:[120.728801,28.846966],childNum:1},geometry:{type:"Polygon",coordinates:["@@@@@@D@BA@@@@@@@A@@B@@A@@@@@@B@@A@@@@@AA@@@@A@@@A@@C@@@@A@@A@@@@@AB@@@@AA@A@A@@@@B@@@BABA@@@A@@@@@@@A@@@@A@@A@AB@@@@@B@@@@A@@@@@AB@A@B@@@B@@@@AA@@ABB@AB@@@A@BA@@@@@@A@@A@@@@@AA@@@@AA@@@@@@@A@A@@AAB@@@CA@@@AAA@A@@@AB@@AA@@@@@A@@@A@@@A@@@@AAB@@@@@@A@AD@@@B@@@@A@@B@AA@@A@@@A@@@@@A@@@@@@@@B@@AB@@@@@@@@A@@@@@AB@@@@@@A@@@@@@@ABA@AA@A@ACA@C@@@ADA@@B@@@@@B@@@@A@@@A@@@@@@@AA@@A@@@@@AAAAA@@@AAA@@A@A@A@@B@@AD@@ABB@AB@B@@A@A@A@AB@@AB@@@@@B@@@@@B@@@@BB@@@BA@@@@B@B@@A@@@AB@@@B@@AB@B@@@B@@@B@B@@AB@@A@@BAB@@@B@@@D@BB@@B@@A@A@@BAB@@@B@BA@@@ABA@@AAB@@A@A@AAAAA@@AA@@@A@A@@B@@@@@B@@ABABA@@@AB@@@AA@@@A@A@A@@@@@A@@A@@A@A@@@A@AAAB@ACBA@@@A@@@CAA@A@AAC@EAA@@@@@AD@@@@A@AD@@@@ABABA@B@@@AD@@@B@@@@@BB@BB@@@@@B@@@B@BA@@@@@@@A@@@@@@@@@A@@@@@A@@@@@A@@@@@@BA@@@@AA@@@@@@@@@AB@@@@@@@@AA@@@@@@@@@@@@AB@@@BB@@@@@@@@B@AA@@@@@A@@@@@@@A@@@A@@AA@@@A@B@BB@@@BB@@@@@BB@@@@A@@@C@A@@@C@@@@@@BB@@BAB@B@BB@A@@@@@@@A@@BA@@@AD@@@BA@AB@@C@A@@@@AAC@@@A@@@A@AA@@@@A@@A@@@@A@AAA@@AA@@@AAA@@@AAAAAA@@@A@A@A@@@A@@BAB@@A@@@@BA@@@AB@@A@A@A@@@@@A@@BB@@BA@@BDD@@@@AB@@@B@B@@B@@@@B@@ABA@@BA@@B@@@B@@@B@@BB@B@@ADABA@@B@@B@@B@@@B@B@@@BA@BB@B@@BB@BA@@@@BB@@@B@BD@@B@BB@@@@B@@BB@@@@@B@@BBB@@BBDBBBB@BBBBBB@@A@@BBBB@@BB@@@@B@@@@DB@@BBBB@@@@@AB@@A@@BBB@@A@@@@BA@@B@@AB@@@B@@@B@@@B@@@BB@@@B@@ABBB@D@@@@@@B@@@A@@@B@@@@B@@BB@@BB@@B@@B@@@@AB@@@B@@@@@B@B@@ABA@AA@@AA@@A@@BAB@@@B@@BB@BB@BB@@A@@BB@DBBD@@D@BBB@BB@@BB@@BB@@@@B@@@@@BA@@BAB@@@@@B@@@BA@AB@@@@@B@@@B@@AB@@@@A@@B@B@BB@@B@@@B@@A@@BA@ABDB@B@@@B@BD@@B@B@@@BB@@B@@BBBB@@@@@FB@@@@BA@@@A@@@@@@@@AB@@@A@@B@@@AA@@@@@@BAB@@@@@@@@BBB@@B@@A@@BA@@DBB@@@B@@@B@@@@A@@@@@A@A@C@A@@AC@@@@BBBBB@@@@B@@BDB@@B@@B@@B@BB@B@@B@@@@BBB@@B@B@@@BB@@@BB@@@@@B@BAB@@BBABA@@@BBB@@B@@@B@@A@AB@@@B@@A@@@AB@BDB@B@@@@@@@@BB@@B@@BABAD@BA@A@@DBB@@@@A@C@AAAB@A@@A@A@@@@@@@@B@@@@@B@@@@AB@@AA@@A@@BB@@@@BA@A@@B@@@B@B@B@@@@@BB@@B@@DB@B@@C@@BAB@@@BA@@@@@A@@@AA@@A@@B@@@B@B@@@B@@AA@B@@@B@@A@A@@@@B@@@@C@@@ABAA@@AB@@A@A@@@ABA@@@@B@@AB@B@@B@@@B@@B@@@@@@@@AB@@ABA@A@A@@@@@@B@@@B@@@@BBB@@@B@@@AB@@@@@@@B@@A@@AA@@B@@@@@@B@@@@@@@@@B@@BA@@@@@D@@@BA@@@@B@@@@@B@@@@A@@B@@B@@@@@@@@BBB@@@AB@@@@@@BB@B@B@D@@A@@B@@BB@@@BA@A@A@A@@@A@AB@@A@@A@@A@@B@BAB@@@@@B@@@@@@@@@@A@@@@@A@A@@@A@@@A@A@ABAB@@C@@@@@A@@@@@@@A@@@@@A@@@@@@@@@AA@BA@@@@B@B@@BB@@AB@@A@@B@AA@@A@@A@@BBB@@@BB@@@A@A@@BA@@B@@A@A@@@AB@B@@@@@@@@A@@B@B@B@@@@@@A@@BA@C@@B@D@@@@@B@B@B@B@BBB@@@BA@@@@@BBBB@@@@@B@@B@@BB@@@@@@B@@@BB@@@@B@@@BA@AA@@AB@@A@@@@@@D@BA@B@B@@@@@AB@B@@@B@@B@@@@@@@@@@@B@@@@BAB@B@B@@@BB@BB@@BABA@@BA@@A@@AB@@@BABB@B@@@@@@@@@@B@B@@@A@@BAB@@@@@@@@@@AA@@A@@B@@@D@@A@@BA@@@@@B@@@BBBB@@@@BB@@@@@@@BA@@@@B@@A@@@@@B@@@B@@A@@@@@@@B@@D@B@AD@BAB@@@@@@@@B@@A@@B@@A@@B@@@@@@A@ABA@@BA@@@@B@@@@@@@@@@BBB@B@@A@@@@@B@BB@@BBB@BB@@B@BA@@BAD@B@@@B@@@@@@BB@@@B@BB@@BB@@B@@@AB@@BB@@@B@D@B@B@@AA@@@B@@A@@@@@@B@@@@@B@@@@@@A@@@@B@@@@@B@@A@@@@@BB@B@@@@@@@B@@B@@B@@@@@@@B@@@@@@A@@@@B@@A@@@@@@@@@A@@AA@@@@@A@@@@@A@@@A@A@AAAA@@@@@@@B@@@B@@@BBB@B@B@@@B@@@@B@BBB@@DB@BA@@BB@@B@@@B@B@BBB@@@B@@BB@@BD@B@@@BBBA@@BB@@B@BB@@@BBB@BD@@BB@@@B@@BB@@@@BABAB@@BB@B@B@BB@@D@B@@@B@B@AA@@@@@A@@B@B@@@@@B@B@@@@@@@BA@@@BB@@@DBB@@@@B@AB@B@@@@@@@B@@@B@@@B@BB@@@@B@@B@@B@@@B@BB@@B@@BB@@BBBDB@@BD@BDB@@B@BB@@BB@@BB@@BB@BD@B@B@@B@BB@@B@@@BB@@BA@@BA@@B@BA@@B@@@B@D@@@B@@@B@@A@@BB@@B@@@BB@@BB@@B@@BA@@B@@BB@@@B@@AB@@BBB@@BB@@@B@@@@@B@@@BB@@@BB@@BBB@@@@CDAB@@AB@@@@@@@B@@@@BD@BBBBBBB@B@@B@@B@@B@@@@BB@@B@@B@@@@B@B@@@@@B@@@B@@@D@BB@@@AB@B@@@@BBB@@@@B@BB@BB@@@BBB@@BB@B@@B@@@@@B@@@D@@B@@B@BB@@@@D@BBB@@@@BB@@@@BBB@@BB@@BB@@B@B@@B@@B@@@B@@@DDB@@BB@@@B@@BB@B@D@@@D@B@@@B@B@DAD@B@@AB@B@B@B@@@BABABB@@@B@@D@BB@@@BHA@AB@B@@@B@@@B@@A@@@A@ABA@@BA@ABA@@@AA@@C@AB@DA@@BAB@@@@BBBDDBB@BA@@@@@@B@@B@@@AB@@@@A@A@BBBB@BB@BBBBB@@@@B@BAB@@A@@B@@@@@BBB@@D@@@BBB@D@BB@@D@BA@@@@BBB@@B@@@@@B@@@@AB@@A@@B@@A@@@@B@@@@@BB@@@BB@@BA@@BBDBBB@@ABAB@B@@@@@BA@@@@@@@@@@B@@A@@@@@@BA@@@AB@@@@AB@BA@@BB@@B@@AB@BBBB@@@BBB@B@B@B@B@F@@BBBB@B@B@B@@@@B@@BB@@@BA@B@B@@@B@BAB@D@@@@@ABA@@BA@A@@@ABA@@@@B@@BB@@AB@DA@@@BD@@BB@BB@@BB@@@@B@@@DB@BA@@@@BAB@@@B@BC@@CC@A@@@A@@DA@@@@@@DA@ABA@@@@@@B@B@BA@@B@@@B@@@BABB@DB@@@B@B@@@BA@@@@@A@@B@@@B@@@BA@@AAAAA@@@A@@@A@@A@@@AACBA@@@A@@D@@BB@@A@@AA@@@A@@A@BABA@@B@BA@@@@B@B@@@@@B@BBB@BB@@@BB@@@B@@BB@@A@@@ABA@AB@BB@A@@B@@@BABA@@B@@AB@@@B@B@@@BB@@@@BAB@B@@@B@BABBB@@@@@B@@@DBB@BBBB@@@@@@BBB@@@@DB@@@B@B@BBB@B@BBBA@@B@@BB@BB@@B@@BB@@B@@BB@@BBBB@@BB@B@B@@BB@BB@BB@@B@FFBA@BD@@@@@B@BBBBB@@@@@BB@@@B@@@@BB@@BD@@B@B@@A@@B@@@@BB@B@@@B@@B@@B@@@@@B@@@B@@@BB@B@@B@@@B@B@@@BBBB@@BB@@BA@@B@B@B@B@B@B@@@FB@@B@B@@@B@B@BA@ABBB@B@D@@B@@@@@BA@@B@@AB@@@@BB@@@@DBB@B@BA@AF@@@BBB@@@B@@@B@A@@B@B@@@@BB@BD@@B@BB@D@BB@@B@@@@@@B@@B@@B@@@@BB@BB@A@@B@@BB@@@@BB@@@@B@@@DDB@@B@@@B@B@B@B@@@B@B@B@@@B@@@@AB@@@B@@A@@@@B@BA@AB@BAD@@BBA@@B@@@B@D@@BB@@@B@B@@B@@BB@BBBB@@A@@BA@@B@@BBB@B@B@DB@B@@@B@@B@@BB@@@@@@B@@BB@@@B@@@B@@@@@BB@@BB@@BBD@BA@@DAD@BBB@B@B@@@@@@@@@B@BA@@B@@@@BD@@@@BDFB@@@B@@B@B@B@B@BB@@B@@@B@D@@@B@@@@@DB@@BBB@BBD@BA@BFA@@AA@@@A@A@@@@@@@@@A@@B@@@B@@ABAA@BA@@C@BA@@@@BBBA@@@@DB@B@@BB@BBA@@@A@@BB@AB@@@@BDA@@A@@AB@@@@B@@B@ACB@B@@@BB@@@@@B@@@@B@@@B@BBBB@@@A@@@@@C@@B@@@BBB@@@@@@B@@@@@BBABBFBB@@BB@B@BAF@@BB@BA@@B@BBB@B@@@@@@@B@@@@BD@@B@@B@@BB@@@B@@B@@BB@BB@@@BB@@@BB@BA@@@@B@@A@@B@@A@@@A@@BA@A@@B@@@B@B@@@@@@@B@@@@@BBB@@@D@@@@@@BBBA@@BB@AB@@@@BB@@BB@@@BB@BB@@BBB@BB@DB@@A@@@A@A@@@@B@@@BB@@B@@@@@@@B@@BBB@@BB@@@AB@D@@@B@B@@@B@@@B@BD@@@@B@@@@ABCBA@@@A@@AA@@@@@ABA@@B@@@B@B@@A@A@A@@B@BA@@A@@C@@@A@@@A@@A@@A@A@@@AB@@A@ABA@CB@B@DB@@BAB@@A@@B@@@BA@@@AB@@A@A@@BA@A@@BA@@@AB@@@@A@@B@B@@@B@@@BA@@B@BBB@@@B@@@B@@@@@B@@AB@@@@@BA@A@B@@BB@@BA@BB@@@BA@@AAB@@@@@@@@@@A@@B@BA@@B@@@@@BA@@@AB@@@@@@@@A@@BA@A@@@@DB@@@AD@BBB@@@BA@@BC@@@AA@BAAC@@BA@A@@@@BABA@@BA@@@AB@B@@@B@@@BA@@B@@@@A@@BA@@B@@ABA@@@AB@@A@@@@@C@AB@@A@AB@@A@AB@@A@@B@@A@A@@A@BA@@AA@@BA@@@@B@B@B@B@B@BA@@B@B@BA@@B@@@@@DAB@@@D@@B@@@@B@BABA@@@B@@B@@AB@@A@@@@@@@AB@@AD@@@BABA@A@AA@@@@@BAB@@C@@@AA@BA@A@@@@B@@@BA@@@A@@@@B@@ABA@@@@BB@@BAB@@@@@B@B@@A@@@@@A@@B@@AB@@@AA@@BA@A@A@@@@BA@AB@@A@@@A@@A@@@CC@@A@AAAA@@@@BABABABADEBADC@@B@D@B@B@D@B@D@B@B@@@@@@AB@BABAB@@@B@@AB@@@B@@A@A@@AA@@@@BA@A@@B@BB@AB@@A@@@@@@A@@AAA@@AA@@A@@@A@A@@C@@A@@A@@@@BA@@B@B@@@B@B@@@@AA@@AB@@AB@BA@A@@B@BBB@@@@A@@@@BA@A@@@A@A@@@@@@@A@@@@@AB@@A@@AA@@BA@@@@@A@@B@@@BA@A@@AA@@AA@AA@@A@A@@B@@A@@AA@AB@@@@A@A@@@@@@@A@@@AB@BA@@@A@@B@@@@@BAB@BA@@BCB@BA@@@@AA@@@A@A@@B@@A@@BA@@@ABA@A@@@ABA@@@A@@@A@@BA@@@ABC@A@@@@@AA@@@A@@A@@@A@@B@@AAA@A@AA@@BA@@@@A@@@A@@@A@A@@AA@A@AA@A@@AA@@@@A@A@@@@@A@@@A@@@A@@@ABA@A@A@A@A@@BA@A@@BABABA@A@A@@B@@A@A@A@A@@BC@A@A@@BAAC@A@@@A@@B@@ABA@ABA@@@@@@B@@A@@B@AAB@@@B@@@BA@BB@@AB@@AB@DA@@@@@@@@@AB@@A@@@@AAA@@@C@@A@@AC@@@@@@@A@@@@@A@@A@@AA@A@@@A@@A@@A@@A@@A@A@@AA@@AA@A@@AA@@AA@@@@A@@AAA@CB@AA@@@@A@@@@BAB@B@@A@@B@B@@A@@@@B@@@@ABABA@@@A@@@@BC@@@A@@B@@AAAB@@AB@@@B@@C@A@AA@@AAAAA@@@@@A@AAC@@AA@@AAA@CA@@@A@@AAAA@AA@AB@AA@@@A@AA@@@@@@AA@@BA@@@@@AB@@A@A@@A@@A@AA@B@@A@@AA@A@AAA@A@@@A@@@A@@BA@@@A@A@@@A@@B@@ABA@A@@AA@@@C@@@@@AB@@AA@AA@@AA@@AA@@@A@@AA@@@@A@@A@@AA@@@A@A@@@A@A@AB@@@@@@A@@AAA@@A@@BA@A@@@@@@@A@@AA@@A@@@@@@@A@@AAB@@AB@@@@A@@@BA@@@A@@@@@@A@@A@@@@@@A@A@ABAB@@BBBB@@@B@@@@@B@@@@@@@BA@@@AB@@AB@@A@@A@@@@@@@AA@@AA@@AAA@@A@@B@@@BABA@@@A@@B@@@@A@@@AB@@CCAA@@@@A@@@@@@@A@AA@@A@@@@A@@B@@ABA@AD@@@B@@@D@B@@@@@B@@A@@BA@@@A@@@A@@@@@@@@@ABBB@@@B@B@@@@A@@@@@AACA@@@@C@@@C@@B@@@@A@@B@B@@@B@B@@@BA@@@@BA@@@BBA@BB@@A@@@@B@@@B@@ADAB@B@B@@@@@A@@@A@AA@@@@AA@@@@@AB@@@BA@@B@@@@@B@@@B@@@B@@@B@@AB@@AB@@@@@@@B@@@B@@@@@@A@@@A@@@@@@@AA@@@@@@CBA@@@AB@@A@@A@BAB@@AB@@A@@@@@@@@@A@@@A@@AA@@@A@A@@@BA@@@A@ABC@A@A@A@A@CB@AA@AB@@@BA@ABA@@AA@@@ABA@A@@@A@AA@@C@A@A@ABA@A@@@A@C@A@AA@@ABA@A@@BA@A@AB@@A@ABA@E@@@@@@ACC@@@A@@@@@A@@@@@@AAAAA@@@AAA@A@@@@@AA@A@@@@C@@@@@A@A@A@@@A@A@A@A@A@AAA@A@@@AACA@@@@A@A@A@@@A@AA@B@@CBA@A@A@A@@@A@A@A@A@A@@BA@@@AAAA@@CA@@A@A@A@A@A@A@A@A@A@A@A@AA@@@C@@@A@@@@@@@AA@AAA@@A@A@@@A@C@ABA@@B@AE@@@A@@@A@A@@@AA@@@A@A@@@A@A@C@A@@@A@AA@@A@@A@@AAA@@@@@@@@@@@@A@@@@BA@@@A@@@AB@@A@@@@@@CA@@AA@@@AAAAAA@AA@@@B@@A@@@C@@@@BA@@@A@@@A@@@@@@B@B@BA@@B@@@@@B@@@@A@@BA@A@A@@B@@AA@@A@@@@A@@@@AAA@@@A@@@AB@@AB@@@@@@A@@BA@@@ABAB@@@B@@@@A@AAA@A@A@A@A@A@@AA@AA@@A@@@A@@@@@A@A@@@A@@@B@@BB@@BAB@@AB@B@@@B@@A@@B@@B@@B@BB@AB@@B@@B@@BD@@A@@BA@A@@BA@@@A@@@AAABA@@@B@@@@B@@@B@@@BCB@@ABA@@@@@A@A@A@@@@@AB@@@@A@AA@@C@@@A@A@A@A@@@@@@ABA@@A@@@@A@@AA@@@@A@@A@@@@AAA@@@AAA@@@AAABA@@BA@@@@@A@@@AAA@A@@@A@@B@@A@A@A@@B@@AB@@A@@BA@@@@BA@@@@@ABA@A@@@@@AB@@A@@BA@A@@@@@A@@@@@BABA@@@A@A@A@AA@@A@@AA@@ABA@@B@@@B@@@BA@A@@@A@A@@@@A@A@@@@@A@@@ABAB@@@AA@CA@@A@@A@AA@A@A@@@@@@FA@@@@@@AA@A@@@A@@@@@AB@A@AA@@A@@BA@@@AA@ABA@@AA@@@@CA@@AB@@A@@@@@A@A@AB@B@BAB@@@A@@A@@@@AA@AA@@B@BABAB@@@@A@@AA@@@A@A@@A@A@@@A@B@@@A@@AA@@@@@@@A@A@ABCAA@C@A@ABC@@@@A@@@@AA@@A@A@A@@A@@A@@@C@AB@@ABA@A@A@@@@BA@@BA@@BA@C@A@A@@@@@AB@@@@AAA@@@AA@AA@AA@@A@A@@@@A@AA@E@@@@@A@AA@BA@@AA@@@BA@@@@AAB@@@@@BABABA@@B@@AB@@@BA@@A@DABA@A@@B@AA@@@C@A@@@@B@@@AA@@DA@@@AA@@@EA@@A@A@@@@AA@AA@@A@@A@A@@A@@BA@@@@BAA@B@@@B@@AB@@A@A@A@@@A@@@@B@@AB@@@@@BA@A@@@A@@AA@@A@@A@@@AA@@@@A@@A@AAA@@@@@@A@AAA@@@@A@ABA@@BA@@B@@@A@@A@A@@@A@@B@@@B@@ABA@@BA@@@@AE@@@@BA@@@A@@@@@A@@A@@@@C@A@@@@BABA@@A@AA@@A@@A@@@A@@@@A@A@@A@BA@@@@@CAA@@@@@A@A@AB@@A@ABAAA@@@@@A@A@A@AAAB@@@@A@A@@@@@A@@B@@@A@@A@@@@@@@A@@B@@A@@@AAA@@@@@A@@@A@A@A@@AA@BAA@@@@@A@@BA@@@A@@@C@@@A@@@@BAAAA@@@@AA@@@@A@A@A@@@AB@@ABA@@@@B@B@@@@AB@AABA@@@@@@@AB@@@B@@A@A@@@A@A@@@A@@@@A@AA@AAAA@@@A@@@A@@C@@@AB@@@@A@@@AAA@@AAAA@@@@@@@@BA@@@A@@BBB@BA@A@@BAB@@@@A@A@@@@@A@@@AAA@A@@AA@A@@@A@A@@@@BB@ABABA@@BA@@B@@@@@BA@@@@BA@@B@BA@AB@BA@@B@@A@A@@@A@@BA@@BA@@@A@A@@@@@@@ABA@@@AA@@@BA@AB@@AB@B@B@@A@@@AB@@@D@@@B@@@@A@AA@@AAA@@@@A@@ABAB@BA@@@@@@BA@ABA@CB@@@@@@@@A@@@@@AA@@AAC@ABAA@B@@A@@@@@@BA@@@A@@@@@ABAB@@@@CB@BAB@@@@B@@B@@@@BB@@BBAB@@@@A@@BADA@@DABA@@@A@@B@B@@@@AB@@@B@@@@AB@@B@@@AB@@@@@@AB@@A@@AAB@@AB@@A@ABCBAB@@@AC@@@@E@@A@@@A@@@@@ABA@@@AB@@A@AB@@@B@@A@@@@B@@@@A@@BA@@BAA@BA@@@AAA@@@@AA@A@@@ABA@C@@@AAA@@@A@@@A@@@A@@@A@@@A@AAA@@@A@@@AAA@@@A@@@A@ABA@@@A@@AA@AAA@@@@A@@@@@A@@@A@@@A@AA@@A@A@@@A@C@@@@A@@@A@@@@@A@@@AB@@A@@@A@@@@@@AAA@@@@@AA@A@@@AAB@@@AA@@A@@B@@@@AA@A@B@A@BAD@@@B@@AA@@@@@ABA@@BA@AB@@A@@@@AA@@@@@A@A@A@A@@@@@@B@@A@@@@@@@AB@@@@@BA@A@ADAA@@A@@@@@A@@@AA@@A@@CC@@@A@@@AA@@A@@@A@@@AB@BC@@@E@@@AACA@@@@AB@@A@@AAA@A@A@A@@@A@@@AB@@AAA@@@@@@A@@B@@@@@@@B@@A@@@@B@@@AAA@@A@@BA@@A@@@@A@@@@@@@@AB@@@@A@@C@@@@AB@@@A@@@A@@@A@@BA@@BA@@@A@@@@AA@B@A@@@A@@@@@A@@@@@@A@@@@A@@AB@AAAAA@A@@A@@@@AA@@B@@A@@AB@@@@A@@@@@@A@AA"],encodeOffsets:[[123304,29197]]}},{type:"Feature",id:"331082",properties:{name:"临海市",cp:[121.144556,28.858881],childNum:23},geometry:{type:"MultiPolygon",
+coordinates:[["@@@@@@@@@@@AA@@B@@@@@@@@@@@BB@@A"],["@@A@@B@@@@@@BBB@@@@@@A@@@@A@@A"],["@@@@A@@@@@A@@BB@@@B@@BB@@A@@@A@@A@@@"],["@@A@@B@@@@A@@@@@@B@@B@@@BBB@@@BA@@@@@@@A@@@A@@A@A@"],["@@@@B@@A@A@@A@@AA@ABA@@@@BBB@@B@@@B@"],["@@@AAAA@@@AB@@@@@B@BBBB@B@B@@A@@@@@@AA"],["@@D@@@BA@@CACAA@A@@BA@@@@B@@@@@@B@@@@@B@@BB@B@"],["@@A@@@@A@B@@@@@@@B@@@@@@@@@@A@@@@@A@@B@@@@@@@@@@@@@B@@@@@@@@@@@@@B@A@@A@@@@B@@@@BB@@BA@@B@@@B@@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@B@@@@@@AA@@@@@@@"],["@@B@@@@A@@B@@@@AB@@@@AAAC@A@AB@B@BB@@@@B@@B@@@"],["@@@B@@BBBBB@B@@A@A@A@@@@AAA@A@@@AB"],["@@@@A@@AA@B@@A@@AAA@A@@@A@@@A@A@@AA@@@CAAB@@@BB@@@BBBB@@D@@@B@@BBB@@B@BBB@B@@A@@@A"],["@@@CAAAAC@A@CB@@@B@D@@BBD@D@D@@@@A@@"],["@@@@A@@@@@AAA@A@@@A@A@@A@@AA@AA@A@@@AA@@AAA@@@AB@BB@@BBB@B@@B@@@B@@@B@@BFBBBBAB@@BB@BAB@@A@@A@"],["@@B@@B@B@BB@B@B@BBB@@BA@BB@BB@B@@A@@@A@@B@D@B@@@B@@A@AC@CAC@AAAA@A@C@A@A@AAAA@AA@AAA@@A@@@@B@@@B@@A@A@AB@@@BDBB@BB@B@@@B@BAB@@"],["@@B@BB@@@@@BA@@B@@@BA@AA@BA@@@@BB@B@@@@@@@@B@@@@A@@@AAA@AB@@A@A@@B@@@BB@B@BB@@@BB@B@B@B@B@@B@@@B@@@@B@@@@A@@@@@@@AB@@@B@B@D@@@@@B@@@B@BBB@@BB@@BB@F@B@@@B@B@@@@BB@D@BA@@@ACA@@@AB@@AA@A@C@@@AAAAA@A@@A@@BA@@@A@AA@@AC@C@@BA@@BAA@@AA@A@@AAA@A@@@AA@A@@A@AA@@A@@B@@AB@@@B@@"],["@@DAV@`AICMCU@SBN]HRAZ@I"],["@@A@@@BBB@@B@@A@@@AB@@BBB@BBB@B@@@BAB@@B@@B@B@@B@@@B@BB@B@@@B@@BA@@B@B@@BBBB@@BB@B@BA@C@CBAB@D@BBDD@BBBADADBB@@BDB@BABA@AB@B@@BBB@DB@D@@ABC@@BC@CB@BBBFDF@D@@BB@@BBBBBB@BAB@B@B@B@@CAAB@D@B@DA@AACCAAA@ABAD@F@DA@AAACAE@IBA@@C@A@AAA@C@@DA@AAAEAAA@ABABA@EMGEACAGEGCCAGB"],["@@@B@BB@H@D@@D@BE@C@AB@BB@@B@BA@CAA@ABDDDBB@@D@BBB@@BA@AB@@B@B@BB@D@@@DBB@@AAABA@@BAB@B@DBD@J@F@BBDBB@B@BDB@DDF@B@AD@BD@B@DDBB@BBBBBDADABBH@BAF@FBBABA@AAAE@E@C@CAAAABC@A@@ACA@@@@@ANAB@@AEACAA@EDC@AAOCCAAA@@@AAAA@@A@AA@AACA@@AACBA@AAAACC@ABA@@D@B@DC@A@A@A@@DA@A@@@CC@A@AA@AAAABA@AA@A@A@@E@A@@BC@A@A@@B@B@BBBBBA@@BC@C@C@AAC@C@A@@B@@BBB@@BBBB@BB@@@BA@A@A@"],["@@AE@@AEAAAA@AAB@B@B@@@@@B@B@@@@@@A@@@@@@@CB@@@BAB@@@AA@@@@@@@ABAB@@@@@BCBA@@@ABA@AB@@AB@@A@@@@@A@@@@BA@@@A@A@AA@@@@@AA@@@@B@@A@@@A@@@A@@@@@A@A@@@@@@B@@@@AD@B@@@@@@@BAB@B@B@BA@@@@BB@@B@@@@@@@@@B@B@@B@@B@B@@@BC@@@A@C@@B@@A@@@AB@BA@A@A@@AA@@@A@@@A@@AA@A@@@@@@A@@@AA@@@@@AAAA@@ABA@AAA@A@@AA@@@AAA@@@AAAA@@AA@@AA@@A@A@@@@@A@A@@@A@@AA@A@@@A@A@@@AAA@@AAA@@@BAA@@@@@AA@@@A@@B@BA@AB@BA@ABC@@@@@@@A@@B@@A@@BBB@@A@@AA@@@@@@@A@A@@AA@AB@@AAA@@B@@@@@B@@@@@B@@AB@@@B@B@BA@A@@@@B@B@@B@@BB@@@@@AB@@@@@@BBBB@@@B@@A@AA@@@@@@@@@@AB@@@BA@@@@B@@ABABA@@B@@@@@AA@@@BA@AA@A@@@@BA@@@@A@@BC@@@AB@A@A@@BA@@BA@A@@@@AAAAAA@@@@@@@@@AB@@A@@@A@A@@@A@@@@@B@@BB@@@B@BA@@@BB@@@@@B@@A@@BB@B@@AB@AAB@@A@@@A@@@@@@@@@B@@@B@AB@@@@@@B@@A@@@@@BB@@A@@@B@@BA@@@@@@@@@BB@A@@@@@B@@@@@@B@@CB@BABA@@@A@@@@B@B@@@@AB@@A@A@AB@AA@ABAAAA@@A@@A@@BA@@A@A@@AB@@@@@A@A@@@@@AA@@AA@@@AA@@@ABAB@@@B@@@B@B@@@@@@@B@@@B@B@@AB@@AA@B@@ABAA@@@@@A@@@BA@@@@A@@A@@B@@@B@@AB@@A@@A@@BA@A@@@AA@@@A@@@A@@@A@@A@@@@A@@B@B@@@@@A@@@A@@A@@@@@@AA@A@A@@@@@@@@@A@@@@@@@@@A@@A@B@@@@A@@@@@@@@@@AA@A@@@@A@@@@@AA@A@A@A@@@ABA@AAA@A@AA@@A@@@A@@@@@@A@@@@AA@@@A@A@@@A@@A@@A@@AAA@@@@@CA@@@@@AA@@A@@@A@A@@AB@@A@AB@AA@@BA@ABAA@@A@@AA@CAA@A@@@AAA@@@@@A@AB@@A@@@@@A@A@@A@@@@A@@@@A@@AB@@@A@@@@@@@@A@@@@@A@@@@@@@@@@A@@B@@A@@@AA@@@@@@@@CA@@@A@@C@@@@@A@@@A@@@@AA@@A@A@@@@C@@A@@@ABA@@BA@@B@@A@@B@@@@A@@@@@@@@A@@@@@@@A@@A@@@@B@@A@@@@@@B@B@@AB@@AA@@@@A@AC@@@AA@@@@@A@A@@AA@@@C@@@A@@AA@@@AA@@@@AABA@@A@@@@AA@@@AAA@A@A@@@AA@@@AA@@@AA@@@@AAA@A@@@AA@@A@@@AAA@@AAA@@A@@@@BA@@@BB@B@@ABCB@@A@@@ABA@@B@@AB@@@AAA@A@@@AA@@AA@@A@@AA@A@A@ACA@@@A@@A@@AC@@@AA@@ABA@@AA@A@@@CA@@A@@@@AAAA@@A@@A@A@A@@@A@@@BBA@@@@@@B@@@@A@@@AB@@ABA@@@@BA@@@A@@@@A@@AAA@A@A@@AAC@A@CA@@@@A@A@AA@@B@@@@A@@@A@A@A@AB@@A@@@C@AA@B@@@@@B@@@@@B@@A@@BA@ABA@A@@@CA@@A@@AA@AC@@@@ABA@@@@B@BA@@@AB@@@B@@@BBB@@@B@B@B@B@@A@@@CDABB@AB@B@@A@@@BBA@@@A@@@A@@B@@@@AA@@ABCA@@@@CA@@A@@@@@@A@@A@@AA@@@@@A@AAAB@@AAA@@@AAA@@AA@@@A@@@@A@@C@A@@B@@@B@@@@@B@B@@@@BB@@@@@B@@@B@B@B@@@@@@@@ABC@@@A@A@@@@@A@ABA@ABA@@B@@A@@@A@@@@@A@@@@@A@@BA@@@@@AD@BA@@B@@@@AB@@A@@@A@@BA@@@@BAB@@ABA@A@@@A@@BA@@@@@@BA@@@@@@@AB@@@@@@A@@BA@@@A@@@@@C@A@@@@@ABAB@@@@A@A@@@A@@@@B@@A@@@A@@@CB@A@@C@@B@@AB@@AB@BA@@B@@@BA@@B@@@B@@C@A@@B@@@@@BAB@@@DAB@@@BA@@@ABAB@@A@@@C@AAA@A@@@@AA@@@A@@@@@ABA@@@@BAA@@A@AB@@@BBBB@B@@@@@CB@@@A@@A@@AA@@B@@CB@@@@AA@@A@@A@@@A@@@@@A@B@@A@@BA@A@A@@BAA@@@@CBABA@@@A@@BA@BB@BA@@@@B@@AD@@@@@B@@ABAB@B@@A@@@@@@BA@A@@@@BA@A@AB@@@@@B@@B@@BDAB@BB@@@B@@B@@BB@@BB@B@@BB@@BB@B@@@@BB@@@@BB@@@B@B@@BB@@@@B@@@@@B@@@@@@BD@@@BD@@@B@BB@@@@@BA@@B@@@@@@@@CBA@@BA@@BA@@AAB@@A@@@A@BBA@@@@BA@@@@@@@AB@BAB@BA@@@@B@@@BBDAB@@@B@BAD@@@B@B@B@BA@@@@B@BABABAB@@@BAB@@@B@B@B@BAB@B@@@B@@@B@@@B@@@@@B@B@@B@@BB@B@@B@BBB@@@B@B@@@B@@@B@@B@@AB@@B@BBB@BA@@@@B@@@BB@@@B@@B@@@@@BAD@B@@AB@@@B@@@B@@AB@B@@@BAB@B@@AB@@@BA@@@@B@B@@BB@@@@ABA@AD@@ABA@AB@@@@A@@@@B@@ABA@@B@@@B@@@@@@@B@B@@A@@BBB@@@BA@@@@B@BB@@BBB@@BB@@@BAB@@A@@@@B@@@@AB@@BB@@@BA@@B@@@@@B@@@@@@@B@B@@@BAB@@@@@B@@A@AAA@@@@BABA@@BA@@BB@@B@@A@A@@@A@A@@@AB@@@@@BB@@@@DB@B@@@B@@@BB@@BB@BB@@@@@@@@BA@@BAAA@@@@BAB@@@@BB@@@B@BA@@@A@@BA@@@A@ABABA@@B@@@@A@A@C@A@C@A@A@C@A@@@CDABCFABABABAB@@@@BBBBB@@@DD@@B@@@@B@@@BA@@BAB@@@@@B@BAB@@BB@@A@@BA@@@@B@@@@@BA@A@@@@@A@AB@@AA@@@@AB@BA@@@@@@B@@AB@@A@@@@@@BABB@@B@@@DA@AB@@@@B@@B@BABAB@@C@@BA@@B@@@@@@@BA@@BA@@@@A@@ABABA@@@@@@AC@@@A@CB@@@@A@@@ABA@A@@@ABA@A@A@A@A@@@@@AB@@BB@@ABB@@@@B@BA@@@@BA@@B@BA@@B@BA@@B@D@@@@@BA@@B@@AB@BA@@@AB@@@B@@A@@@AB@@A@@@A@A@@B@@AB@@ABAB@@@@@BAB@@BDABB@@B@@ADDBBBB@@@B@B@FA@@BB@@BB@BB@B@BB@@@@@B@BDB@BB@@BA@@BB@@B@@B@@B@@ABBB@@@B@@@BB@@B@@BB@@B@B@B@BBBBB@BBB@@BB@@B@@BBB@B@@@B@@@@BB@B@B@@BB@FDB@@@@@BBD@@@@@B@@B@@@BBBB@@B@@DD@@@B@@@D@B@@BBBB@@BB@@BBBBBBB@@BBBBBB@@B@@B@@BB@B@@@BB@@B@BB@@B@B@@@B@@@B@@B@@BBBD@@@@B@@@B@@AB@B@BB@@B@B@@BBAB@@B@AB@BB@@B@B@BBB@@@B@BA@@@AB@@@@@B@@@@BB@B@@@B@@BBBB@@@@@B@@@B@@@BB@@B@@BB@B@@@BBB@B@B@@@@@BB@@@B@BBBBB@@@B@@@@@BBBDD@@B@BB@@AB@@@D@@BB@@BB@@@DBBBD@@B@B@B@BB@@@BB@@@BBDA@@BA@@BB@@@@@B@@BBBA@@BB@@BAB@B@@@@A@@@@@@@AB@BABB@AB@@@@AB@@A@@BA@@BAB@@@@@BB@@B@@@D@B@B@BBB@B@@@@@@B@@BB@@@B@@@@BBB@@@BA@@BA@@@@BA@@D@BBB@@@DAB@D@@@D@@@B@BBBB@@@@B@@ABB@@@@@AB@@A@A@AB@@@D@B@B@B@@@B@@BBBD@BA@@BAB@B@@@@B@@@@B@B@@@BBB@BC@ABA@@@A@@B@@@B@B@@@B@@@B@@@B@DA@@AAAABA@A@@B@@ABA@@@ABA@@B@B@B@@BB@BA@@@BB@@BB@B@@@B@@BB@@@B@@@@@B@@@@@B@B@BB@DDBB@@BB@@@B@@@B@@BB@@@B@@@@@BB@AB@B@@@B@@B@B@@@BBBB@@BD@@@B@FDB@B@@@@B@B@DBB@@B@BB@BBB@@BBB@@B@@@D@@BB@BAB@@BB@BB@@@BBABB@@B@BB@@B@@@@BB@@BBB@@@@@@B@BD@BBB@B@@@@@B@@B@B@BB@B@@@B@BB@@B@@ABBB@B@B@@@BB@@BAB@@A@@@A@@@A@@@@B@@AB@@AB@@@BB@@B@B@B@DA@@B@B@@@BA@@BA@@B@DA@@@@D@@@B@@AB@@@@@BA@@D@B@B@@@BB@@BAB@@A@@BA@@@A@AB@@@@AA@@AA@AC@@@@DABA@@@A@@BAB@B@BA@@DBB@BA@@BB@@B@BAB@B@@B@@B@@BB@B@B@@@B@@@@B@@DBB@BBB@@BB@@@BA@@@A@@B@@ABA@AD@@@BA@A@A@ABA@ABA@@@@B@BB@BB@@@@B@@@B@@@BB@BBB@BB@BBB@@B@@@BB@@@A@@@A@@B@@@@@B@BABB@AB@DA@@@A@@B@@@B@@@@@B@@@D@BB@@@@B@D@@@@A@ABABA@@@A@@AA@@@AB@B@ACA@@A@@BA@ABA@AA@@AA@BA@ABA@@BB@AB@@@B@@@B@@@@@@@B@B@@ABB@@B@B@@@@@B@@@@@@B@@@@BBB@B@@@BB@@@B@@@@B@@@@A@A@A@@AABA@@@@B@@@B@@@D@@@@@B@@@BA@@B@B@@@@B@@B@@@B@B@@@@B@@BB@@BB@@B@@A@@B@@@@@B@@@BB@@@ABABB@@BBDA@@@@@@@@@A@@@@AA@@A@@@A@B@@@@@@@@A@@@@@@BB@@@@@A@A@B@B@@B@@A@@AABA@@B@@@B@BBB@@@@A@@@@B@@AB@@@@@BA@@B@@@@AB@@A@ACAA@BA@A@@@@@BB@B@@@@@@BB@@@AC@@BAB@@@@@B@B@@@@@@AB@@A@@@A@CBA@@B@@@B@BBBA@@@@@@B@B@B@B@@@@B@@@@@@@@BBB@BA@@@AB@@@BAB@BBB@@@B@B@D@@AB@B@B@@@@@B@B@@@DA@@@@B@@BBA@@B@@@BB@@D@@@B@B@@@B@@@B@@@BA@@BB@@@B@@@BB@@BB@@@B@@@BB@@B@@@B@@@@@@BB@B@BABB@@@@AB@@@B@B@@A@@@A@@B@@@B@@@@BB@@@@@@BA@@B@@AB@BAB@@AB@@AD@@@BABA@@@A@@@@BA@@AABA@@A@@@@AA@@A@@AA@A@@@@@AA@@A@@@A@@@AB@@A@A@@@@BA@@BA@@B@@@B@BB@@B@B@@@BA@@@A@@BA@@B@@@@@@@@@@@@@@@B@@@@A@@@@BA@BB@@@@AB@@A@@@@@@B@@A@@@@AC@@A@BA@A@@A@@AB@A@@@@A@@@@@@@@B@@@@A@@@AB@@AAA@A@@@A@@B@B@@AB@@@@AB@@AB@@@BA@@@@B@@@BAB@@@BAB@B@B@@@B@B@@A@@@@BB@@B@@@@@BA@BB@@@B@B@@@D@@@BAB@@@B@@@BB@@A@@@B@@@@BB@B@BA@@B@@@B@@@@BBB@@B@B@@@BA@@BAB@@@@@@AB@@A@@@CB@@@@A@@@A@A@@B@@A@@@A@A@@BA@@D@@@BABAA@@A@@@A@@@A@A@@AA@@AA@A@ABABAD@@AB@@AB@@@@@@AB@@ABB@AB@@@BA@@B@@@BA@ABAB@BA@@@@@@B@FBDBB@@@@@D@@@BB@@B@@B@@BB@@BB@BB@DB@B@B@@@B@@@B@@@@AB@BADAB@@@@@BB@@@@@@B@@@B@@@@B@@@@B@@DB@@B@BB@@@@@@D@BB@@@@D@B@DB@@@@ABBB@B@@@B@@@@@B@@@@@@A@@@@BB@@@@@@B@@@B@@@@@B@@A@@@@B@BBBABB@@B@BB@@@D@B@@@B@B@F@@@BA@@@AD@@@@@@@@@@@B@@@B@@A@@@@@BB@@@@@@@@AB@@@B@@@@@@@B@@@@@@@@@@@@@@@@B@@@@B@@B@@B@@@BB@@@@B@@A@@@A@@@@@@@A@@@@@@@A@AB@BAB@@@@@B@B@@AB@@@DAB@BA@@@@DABA@@@AA@@@@A@@@@@@B@@AB@@A@@@@A@AA@@A@@A@@@@@B@@A@@@@AAB@@@@@@@@AA@@@@@@@@@@@A@@@@A@A@@A@@@@B@@@@@B@@@@@@@BA@@@@@@@@@@BA@@B@@@@@@@@B@@@B@@@@@@@@BB@@@A@@@A@A@AB@@@@A@@@@@AB@BAB@@AB@@@@AB@@@@@B@B@@@@AB@@@@@B@B@B@@@B@@@B@@A@@AA@@@AA@@A@@BA@@@@@A@A@@BA@@@E@@@@BAAA@@BA@ABA@AB@@ABA@@BA@@B@@@BA@@B@@A@@B@@A@@BA@@BA@@BA@@@AD@B@B@@@B@@@B@@@B@B@@@B@@A@@B@B@@A@B@ABB@B@@@BBAB@@@@BD@@@@AA@@@A@BA@A@@@A@@@@@@@AAA@@@@AA@@@A@@BAB@BA@@@@AA@@A@@A@@@AB@@A@A@A@AB@BA@@A@BAA@@A@@@A@@@@@@@C@@@AB@@@@ABABAB@@A@@@A@@AA@@A@@A@@@AA@@ABA@@@@D@B@@@B@BAB@@@AAB@@@@A@@BABA@@@A@@@@@@D@BA@@B@@@@@D@B@B@@AB@@@@@@@BAB@@@D@B@@@B@@AB@@@@A@@AA@@@@AA@@@A@A@ADC@@@@@A@@@A@@@@B@BA@@B@@@@@@AB@BA@A@@@@@@@A@B@@@AB@@@@@@AB@@@@@A@AA@@@@@A@@BA@@@@AA@@@@@AB@@@@@A@@@@@B@AAA@@@@@BAB@BA@@A@@A@@AA@@@@@@@@AA@@AA@@AA@@B@A@@A@@@@B@B@B@@@B@@@A@@A@@@@@A@@A@@@B@@@B@@@@A@@@@@@@BB@B@@BBABBB@@@@@A@@B@@@@B@@@@A@AA@B@@@B@@@@@AAAA@@@@@@A@@@B@@@D@B@@@@@@@B@D@BB@@B@@AB@BBB@BBB@@A@@@@@@@@BA@@B@@@@AB@B@@@@@@@AB@@@B@@@@BB@BB@@@B@BAB@@BDA@@D@D@@BB@D@@@BBB@@@@@D@B@B@@@@@BA@@B@B@B@BA@@BB@@@AD@B@B@B@@@BA@@B@D@@@@@D@B@@BBB@@B@@@@@@BB@@@@@@AB@@A@@@@BB@@@@B@B@@@B@@B@@@@@@@B@@B@@@@@B@@BB@@@@@B@B@BA@AB@A@@@BA@@B@BA@A@@BA@@B@@ADA@BD@B@D@@B@BB@@@@BB@@@@A@@@@BA@@@B@@@@BA@@@@@AB@B@@@@A@@B@@@B@@@@AB@@A@@BA@A@A@@AA@@AA@A@@BA@@B@B@@BB@BBB@BB@BB@BBDB@@B@@@B@@@BA@A@@@@@@A@@A@@B@B@B@@AAA@AD@@@@D@@B@BA@BB@@@B@@@BB@@@@@BB@@@B@@@B@BB@@B@@@@@@A@@@@BABB@@@@@ABAB@@@@A@@A@@@A@B@@A@@BA@@B@BAB@B@@@@@@BB@@@B@B@B@@@@BBA@@@AB@@@BB@@B@BCB@BBB@B@@@@BB@@B@@BBB@BBB@BB@@@B@@A@@B@@B@BBBBB@B@B@@B@@@D@@BB@@@@D@@@D@BB@@BB@@@B@@@B@BBBB@BBB@DB@@B@@BDBFB@@BBB@@BBDB@DBB@BJnGJCJQHUDIFODKDS@K@KAMACGOG]C]A]AK@MCWGUKSWMSGWIKAwIsIMD_B]BI@SBANAL@D@@EA@DA@@@BBD@HDDBAD@@B@@A@@@B@@@@B@AD@@@@A@A@A@@@A@CA@@@ABA@@@A@@A@@DA@@B@AA@AA@@A@AFCD@BEH@BABCH@@A@@DAD@@ABA@A@@@@@@@@@A@@@@@ADA@CD@@BBCB@B@@@@@@@BABCDA@@@@AA@@@@@@AA@AA@@@@AB@@A@@@@@A@AB@@A@@AA@@@@BA@@@@@@@@@@@AB@@@@A@@BCAA@@@A@@@A@@BCB@@EDAA@@@@@AAAAAA@@@A@@@AC@BCC@BAAA@@@@@AB@@A@@@@@A@@@AA@@@@A@@B@B@@@B@@@@A@C@@@AB@@A@@@ABA@@@@@AB@@@B@@A@@@@@@@@@BBAB@B@@A@@@@@BBB@@@@B@@A@@BB@@@B@@BA@@@@@@B@@@@@@@@A@AD@@@@AB@AAB@@A@@@@@@BAAA@A@@B@@@@BBAB@@@B@BCA@B@D@@A@@@A@@AA@@AA@@@@@A@AA@@AB@AA@A@@@@AAB@@@A@@A@@@A@@@@@@@@@A@BBA@@@@@@@AB@@@B@@B@@@@B@@@@@@A@@B@@AB@@@B@@A@@@A@@B@@@@@B@@@B@@@B@@@@A@@B@@@@@B@@@@@@AB@@@B@@AAA@@@AA@@@@A@A@@@A@A@@@@A@@@AA@@@A@@@@@A@@@A@@@@@A@A@@@A@ABA@@@A@@@@@@A@@@A@@@@@@A@@@E@@@A@A@BD@@@@ABA@@@@BA@@@@@A@A@ABA@@@A@A@@@A@@B@@@@@B@@A@@BAB@@@BA@@B@@BBA@@B@@B@AB@@A@@BA@A@A@@@@@CBEB@BA@CDA@ABAAA@@@@@AB@@@B@@@@@AAA@AA@@AA@@@A@A@C@@@@@AAA@@A@@@@DC@@B@@@AA@@AA@@@@BA@@@@A@@A@@@A@@@@AA@@B@B@@A@@@A@@@@@A@@@A@@@@AA@@B@AA@A@@@@@A@@@AB@@@B@@@B@@@@@B@B@B@@@@ABA@@@@A@@A@@@@@@@@AA@@B@@AB@@@B@@@B@@@B@@A@@A@@@CAAAAAAA@@@@A@@@A@A@ABA@AA@@CBA@AB@@AB@B@@A@@@@BA@@@A@CAA@AAA@@AA@E@A@@A@@@AA@@@A@A@AA@@@AA@@A@A@@A@A@AACA@@@@@A@@B@@A@@BA@@@A@@@@@AB@@ABA@@@C@@@@BAB@@@@ABA@@@@@AB@D@@G@C@C@@ACA@@@@@@A@@@A@@@A@A@C@@@@@@@@@@@@@@@@@@@A@A@@@@@A@@@@@A@@@@@@@@@@A@@@@@@@@@@A@@@@@@@A@@@@@@@A@@@@@@@AA@@@@A@@A@@@@A@@@@@@@@AA@@@@@@@AB@@A@@@A@ABAGAE@[G@@A@@@@@@@@@@@@@A@@@@A@@A@@A@@@@@@@AAA"],["@@@BB@DB@@B@@A@@AAA@@@A@A@"],["@@A@ABAB@@BB@@B@F@@@DB@@BA@@ECCA@@"],["@@@@B@@@AA@A@@A@AB@@B@@BB@@@"],["@@BBDJBBA@A@@D@BBBB@B@B@@B@B@BABCBA@@BB@BBLAJAFCDE@@AAA@C@A@CCA@@A@ABBD@DABABA@A@@B@BBB@B@BAB@@@@BB@B@BAB@B@@DD@@BBBDBDDB@BBBBBD@BB@B@B@BDB@BAB@@ABAACAAA@CACACCAA@CAA@A@A@A@@BAAAAAAAA@BA@@@AA@AA@A@AC@C@AAA@A@A@AD@@AAC@A@@B@BBDABA@A@AF@BC@EBEDC@A@AA@ACCAA@@C@@@AA@@C@A@IC@@A@ABIJ@B@BBBFDB@B@@@@@@BB@D@F@B@@AAAEAC@A@A@A@AA@@@AFAB@@@DBBBBADCD@"]],encodeOffsets:[[[124721,29337]],[[124716,29329]],[[124715,29327]],[[124787,29408]],[[124787,29382]],[[124760,29346]],[[124791,29392]],[[124824,29355]],[[124848,29394]],[[124712,29489]],[[124781,29535]],[[124705,29479]],[[124740,29447]],[[124744,29455]],[[124846,29397]],[[124665,29555]],[[124762,29462]],[[124774,29424]],[[124239,29376]],[[124678,29407]],[[124654,29401]],[[124683,29383]],[[124684,29377]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
@@ -0,0 +1,3 @@
+!function(A,B){"function"==typeof define&&define.amd?define(["exports","echarts"],B):"object"==typeof exports&&"string"!=typeof exports.nodeName?B(exports,require("echarts")):B({},A.echarts)}(this,function(A,B){var D=function(A){"undefined"!=typeof console&&console&&console.error&&console.error(A)}
+return B?B.registerMap?void B.registerMap("舟山",{type:"FeatureCollection",features:[{type:"Feature",id:"330902",properties:{name:"定海区",cp:[122.106773,30.019858],childNum:55},geometry:{type:"MultiPolygon",coordinates:[["@@@@A@@@@@@@@B@@@@@@@@B@@A"],["@@@AA@@@A@@B@@@@B@@@B@"],["@@@@@@@@A@@@@B@@B@B@@B@@@A@@@@AA"],["@@@@@@@B@@@@B@@@A@@@@@B@@@@@B@@@@@@@@@@@AA@@A@@@@@"],["@@@@@@@@A@@@@@@@@B@@B@@@@@B@@@@@@@@A@@@@A@"],["@@@AABA@A@@@@@@BB@@@B@BA@@@@@@@@@@"],["@@@@@A@@@@@@@@@@AA@@@@@@@@A@@@@B@@@@@@BB@@@@@@@@@B@@B@@@@@@A@@"],["@@A@@B@@@@@@AB@B@@@@@@B@@AB@@@B@@@@@B@@@@@@@AA@@AA@@"],["@@A@@@@B@@@@A@AB@@@@@B@@B@B@B@@AB@@A@@@AA@@@"],["@@@AA@@BA@@@A@@@@@AB@AA@@B@@@@BB@@B@@@B@@@B@@@@A@@B@@@BA@@@@A@"],["@@@AA@A@AB@@@B@B@@BBBA@@@@B@@ABAA@"],["@@@@@@@@@@@@@@A@@@BB@@BDBF@@B@@@@@@@@@B@@AA@@ACE@AA@"],["@@@@A@AB@@AB@B@@AB@@@@@B@@B@BA@@B@BABA@A@@AA"],["@@B@@@@A@@@@@A@@B@@@B@@@@A@@A@A@@AA@A@@@A@@AA@@@C@@B@@B@B@@@@@@B@@B@@@B@@B@@@BB@@@@B@@"],["@@B@B@@A@A@@@AA@@B@A@@A@@@@@ABAB@@AB@BBB@@@B@@@@B@@@@A@@B@@@@A@@@@@@@A"],["@@@@A@A@@@AB@@AB@@@B@@@B@@@BB@@@B@B@@ABA@A@@@A@@@A"],["@@A@@@A@AAA@A@@B@BB@@BDBB@BBB@@A@C@@@AA@"],["@@A@@BAB@B@B@BB@@BBAB@BA@A@A@A@A@@@AA@AB"],["@@@A@A@A@A@AAAA@A@@@AB@@BBBD@B@@AB@@@BB@BB@@B@@A@A"],["@@B@@@@@@A@@@AA@A@@@@@A@@@@@AAA@@A@@@@@@AA@@@@@AAB@@AA@@A@A@@BDB@@@BB@@@@@BB@@@@BBB@@@@@@B@@@@B@@@BA@B@@B@@@@@"],["@@BA@@@AA@EAA@A@@B@BAB@BB@B@B@B@B@BA"],["@@K@E@C@BDDBFAPCC@A@"],["@@@@A@CBADAB@@@BB@DBD@BA@@@A@C@AAA"],["@@@@@AA@@@@@CAA@@@A@A@@@A@A@@B@@@B@BD@D@@@B@BBB@@@B@@C"],["@@CBCDAB@B@B@@DAF@BAD@@A@@@CAAA@A@"],["@@A@@AA@A@A@A@@BA@@B@B@@@BFB@@B@@@B@@@@@BA@A@A@A"],["@@A@@B@@B@@@@@ABA@@@B@A@KD@B@@@@B@NC@@B@@@B@BB@@B@@@@A@@B@@@B@@BB@@AA@@A@@@@A@AAAAAA@A@@A@@@AD@@@@"],["@@A@AB@DAB@BBBBB@@B@B@B@@A@A@C@CAA@@A@"],["@@A@A@@@AB@@@BBDBBFBD@@@B@@@@A@ACCAACA"],["@@@@A@ABAB@BABADAB@B@@@BD@BBB@@@BCBABC@A@CBAA@AA"],["@@B@DAB@D@BA@@@AA@@@A@@A@A@@@AC@A@EBADA@@@A@A@@D@@BBB@BA@@@@BBB@"],["@@A@@A@@A@A@@@A@@BABA@@@@@@B@B@@@B@B@@A@@@@@@BB@@@@@BB@@B@BBB@D@@A@@@A@@BA@@@CA@@@@A@@@@@@@A@@@@B@@A@@A@"],["@@CB@@CD@BAB@B@@@BBBB@D@D@BABA@ABC@CAAA@C@"],["@@A@AAA@A@A@ABA@ABAB@B@@BB@@@BB@B@@@BABBB@BB@B@@B@B@B@@ABABA@A@@@AA@@A@AA@@@A@"],["@@@@BA@@@A@AAAAAA@C@A@A@AB@B@D@D@@@BB@BBBB@@@BB@B@@@B@@@B@@@B@@A@A@AAA@A"],["@@A@AB@@AB@BA@@@A@A@A@ED@@@B@@DBNDD@B@B@BA@A@AACACAA@AA@"],["@@@BAB@B@BBDBD@@B@D@B@B@@@@C@CAA@ABA@A@A@CAC@AAA@@CB@BA@@B@DAD"],["@@@@AAA@AAA@EAC@A@@B@B@BA@BB@@@B@@@BDBB@@B@BAB@@AB@BBBB@D@D@@A@@@AB@@@@@@A@AA@@A@@BA@@@A@@@A@@BBB@@@@A@A@A@@"],["@@B@DBD@B@@@@A@A@@B@@@B@BBB@DAB@BA@AB@BB@@B@B@@AB@AAAAA@ICC@IAC@C@A@@B@B@F@B@BBB"],["@@@C@E@CCCE@EBIDAD@DDDDFFBF@DADE"],["@@@C@EAAA@I@CDCD@DAH@BBDDDFBB@B@DABA@A@@@E@A@AB@@A@A"],["@@ABCDAFA@@@A@@B@@@B@B@@BBB@F@@@BB@BB@D@F@B@@C@ADABAB@@A@CAACCEACCAA@AA@AB@B@@@B@B"],["@@B@BAACAABA@ABABAB@@@BA@@@A@CACCAEACACAA@CBCBADAB@B@B@BBDDBD@@B@BBFBBB@D@BBB@@@"],["@@BFDDBBF@DBBBF@DCBABC@G@CBAFCBAAAACMEE@CAEBCBCF@DAF@H"],["@@BABCAEAQACAAC@IAG@C@A@ADCF@F@DBDBFFJBBBBD@D@HADADA"],["@@DABABC@@B@B@@BB@DABA@ABABBD@BCBABA@A@A@@B@FCBCACCEEAEAEBGDGFA@CAA@G@C@AB@BBBB@BB@@ABBBD@@B@BCFA@AB@D@BA@AB@BDBD@B@BA@@B@B@@@BBB@"],["@@@@AAA@CACACACECACACBAB@BBDABCH@D@F@D@F@DADBBBBFFDBB@FADADCVU@A@A@ACCAAA@A@@@A@"],["@@C@EAC@C@AD@BCBEFAD@DBDBBDBDBDBH@BBBBB@@BB@@@B@DBB@DB@@BBB@B@B@D@D@BADAFEFG@C@GAEAAAAA@IAEAEAG@EBA@"],["@@GFCBMDC@C@ABABA@A@C@A@AA@@CA@@CAC@CB@BA@BH@B@@DBFBD@DBDBDBNNBBB@DBB@D@BAFCFGDEBANGLMDCFG@A@CAACAE@E@A@AAC@C@ADADEFCB"],["@@EGACGKGEGCE@EDEHENAN@DDHDDDBLHHFFBF@F@FEFCBG@GEM"],["@@A@A@IEOGCAABABAAC@CBCHBBBB@BKTC@ABCBBBD@BBABDDDDH@D@@@BBADC@ADBBB@D@BB@DDBDABA@@BABB@DD@DADABB@FBDHAH@H@D@BCDENG@ABC@MAAACGAC@KG@AEEEC"],["@@DBDDH@H@FABEBADAD@FAFCBECGAAACAC@K@CACGEGM@C@ABCEGCAKACBC@C@GBCB@BBDDD@FCHCFCDCBC@EAC@CB@FCBA@ABBDAFBBHJFFDDFDD@JCBB@BABBBJF"],["@@QBUFGBAH@H@HCBEBOHCLFNHHDJAN@\\ERATJNBDPRBB@DAHEFAHDHDBDDN@F@FAD@D@BBHBHA@CBAD@D@DBFBFC@ECG@CF@B@DCAEJMF@FA@AACBCF@LBLAFG@KBQFYLYDQEQGE[OYGWKMGSK"],["@@CD@B@@B@B@DCFC@@FCDCDCBAAA@@A@A@CBCF@@EDEF"],["@@BBFFDBB@B@BBBB@HBDF@FCPGVGHGPIPGLC\\EjORAFAPFbHDBH@dFVCHAHCHCBCBABE@C@C@@JCNEDABCBCBCBCFIFGFCFCDCH@F@F@DBBBDDB@B@B@DCH@F@BDD@DADEFEFAP@H@XAZ@FBD@DEF@FDDA`QHBdHD_BUAB_ACAIEA@CA@AAC@A@ABABAFC@@AAACCBCBA@@AA@@A@@B@@A@AAA@A@A@@@AA@@@A@@@AAAAAA@@A@A@@@AA@@A@AAA@AACA@A@@@@BABAAA@C@@@A@AB@@AB@BA@AB@@@B@@AB@B@D@@@BA@@B@@A@A@@B@@@@@B@B@BAB@@@B@B@B@B@@A@@@@B@@@@ABA@A@AA@@@BA@@AA@@@A@@A@A@@@C@@A@@A@@AAA@@@@@A@A@@B@@@@A@@@@@A@@A@AAAAA@@@A@AAAAA@@ACA@A@@AAA@A@@@@A@@@A@@AA@@@@@@@A@A@@@@@@AA@@BA@A@@AA@AA@@A@@@@@A@ABABA@@BA@@@@@@BA@AB@@@@A@@CAA@@BA@@@A@A@@A@@@A@@@@@A@A@@B@@A@CE@A@@@BA@@@A@@@@@@@@@A@@@@@@@AA@AAA@@@AA@@BA@@@@@A@@@@@ABA@@@@@CAC@@@@@@@@@A@@@A@@AC@@CAWHIBA@EGCAAB@B@F@DMDOFABOJGFCBGBEBE@C@E@GAA@K@@@G@I@GAIAECCAA@EAEBEBABADCHGTCDABADC@KBM@C@A@CACAE@MBG@GAEACCGCOGECKAC@AACAAAA@A@A@ABA@C@A@A@CBABAB@DABADENIRCFCH[bCDEFEBKFGFADAFAF@DBDBFDDDBHDFDBDBDAB@@ABABA@AB@BBB@B@D@DAB@DAFAJCHCDKPCDAF]fEJCHILURCFAD@HBDLH"]],encodeOffsets:[[[124962,30932]],[[124829,30956]],[[124826,30949]],[[124820,30951]],[[124824,30954]],[[124963,30929]],[[125017,30969]],[[124986,30971]],[[125087,30688]],[[125085,30705]],[[125082,30700]],[[124761,30770]],[[125085,30700]],[[124873,30922]],[[125084,30847]],[[125052,30665]],[[125015,30691]],[[125e3,30666]],[[125018,30681]],[[124824,30944]],[[125069,30699]],[[125161,30684]],[[125072,30690]],[[124768,30821]],[[125130,30690]],[[125033,30713]],[[124806,30812]],[[124999,30691]],[[125038,30697]],[[125026,30680]],[[124782,30824]],[[125016,30929]],[[125036,30714]],[[125026,30695]],[[124992,30661]],[[125074,30698]],[[125002,30675]],[[124796,30830]],[[125061,30688]],[[124986,30706]],[[124903,30776]],[[124900,30811]],[[124915,30807]],[[125009,30668]],[[125058,30697]],[[124756,30806]],[[125019,30700]],[[125080,30664]],[[125107,30683]],[[124985,30665]],[[124977,30893]],[[124863,30835]],[[124800,30684]],[[125111,30703]],[[124869,30896]]]}},{type:"Feature",id:"330921",properties:{name:"岱山县",cp:[122.226237,30.264139],childNum:72},geometry:{type:"MultiPolygon",coordinates:[["@@@BB@@B@@BBB@@@@ABAB@A@@A@@A@C@@@A@"],["@@E@@@AB@@@B@@BBB@@@B@B@B@@A@@@A@@A@@A"],["@@@BB@B@@B@@ADAB@BB@@BB@DA@@DC@@BA@A@@B@AAA@AAEE@@A@@@A@@DA@@BB@"],["@@@@@@A@@@@@@BB@@@@A@@@@"],["@@@@@@@@@@@B@@@@@B@@B@@AA@@A@@"],["@@@A@@@B@@@@A@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@A@@@@@@"],["@@@@@@A@@@@@@@A@@@@@B@BB@@@A"],["@@B@@AA@@@@A@@@@A@@B@@@@B@@B@@@@@@"],["@@@@@@@AA@@@@@@B@@@@@B@@@@@@@@@@B@@A"],["@@@B@@@@@@@B@@@@B@@@@A@@@@@A@@A@@@@@"],["@@@@A@@@@A@@@@A@@@@@@B@@B@@@B@@@"],["@@BA@@@@A@@@A@@@A@@@@@@@@@DB"],["@@@AA@@@ABA@@@BB@@@@B@@@@@@@BA@@@@"],["@@@@A@A@A@@@@@@@B@@BB@@@@B@@@@@@@@B@@@B@@B@@BA@@@@A@@AA@@@@@@A"],["@@@A@@@@@A@@@A@A@@@@AA@@A@@@@AA@@@@B@@B@@@@@@B@@@B@B@@B@@B@@@@BB@@"],["@@A@@@@@@@@B@@@@B@@@@B@@@BB@@@@@@B@@B@@@@@BA@@@@@@@A@@A@@@@AA@A@@A@@"],["@@@A@@A@@@A@@CA@@@@@AB@@@BBB@@BBB@@@B@@A@@"],["@@@@BB@@B@@@@@@AB@@@@@@A@@@A@@A@@@@@@BA@@@@AA@@@@@@B@@A@@@@BB@"],["@@@A@@A@@BA@@@@B@@@@@B@@B@@@BB@@BA@@BAA@@A@@A@"],["@@@@@A@@@@CAAA@@AB@@AA@@A@@B@@BBB@DB@@@@@@B@@@@@@@B@"],["@@A@@@@@@A@@@@A@A@@@@BA@@@@B@@@@B@@@@@@@@@@BB@@@@@D@@@@@@@BB@A@@@@@@@@@A@@@@A@@A@@"],["@@@@@ABB@A@@@@@@A@@@A@@AA@@@A@C@@@@@A@@@@@A@A@@@@@@BB@@A@@B@@@@BB@@@B@@@@@@@@@@BB@@@B@@@@@B@@@B@@@@@"],["@@@@A@@@A@@@A@@B@@AB@@@@@BB@@@@@B@@@B@@@B@@@@A@@@A@@@@@A"],["@@A@@@@@@@A@@@@@@@@@@@@@@@A@@@@B@@B@@@@@@@@@B@B@BA@@@@B@@B@@@@@@B@@@@@@@B@@@@AA@@@@A@@@AA@@@A@@@A@@B@@@B"],["@@@@@@AA@@@A@@AAA@@@@@A@@@@@@@BB@@@B@@@@@B@@@@@B@@@@@@@@B@@@@@B@@@B@@A"],["@@B@@@@@@B@@@B@@B@@@A@@B@@@@@@@@B@@@B@@@@@@A@C@@AA@@@@@@@@@A@@@AAB@@@@@@@@AA@B@@@BB@@@@@AB@@@@"],["@@@@A@@BAB@@A@ABBB@BB@@@B@BA@A@C@A@@"],["@@@@@@A@@@@@@@@@@@@@@@@@@B@@@B@@A@@@AB@@@B@@@@@@@@@@@@@B@@@@@@B@@@@@B@@A@@@@@@@@@@@@B@@A@@B@@BBA@@@AA@@@@@AA@@B@@@@A@@A@@@"],["@@B@B@@@@@@AA@@@@@C@AA@@A@@@A@@@AB@@@@@B@@@@BB@@B@B@@@@@B@@A@@@@B@"],["@@@@@@@@@B@@@@BB@@@@@@A@@@AB@@B@@B@@BAB@@@DB@AB@@@@AA@@@@@@@@AA@@@@AA@@@AA@@AB@@"],["@@@AA@@BA@@@@@@@A@@@@@@@A@@@A@@@@BA@@@@B@@B@D@B@B@B@D@@AB@@@A@@@@A@@A@@@A@@@"],["@@@@@B@@A@@@A@@@@@@@AAA@A@@@BDDB@@BB@@@@BA@@@AB@@@BA@A@@A@@@A@"],["@@@@BC@AA@@AA@@@A@@@@@@@AA@@@A@@A@A@A@A@@B@@BBB@B@@@@B@@B@@@B@@@BB@@@@@@@B@@@BB@"],["@@@AA@@@@@@A@@@A@@@@@A@@A@A@@@@BA@@@@@A@A@@@AA@@AB@BB@@@B@B@@B@@B@B@@@@@@B@@B@B@BB@@@A@@"],["@@@AB@@@@@@@@@A@@@@A@@@@A@@@@@@@@A@@@@A@@@@@@AA@@@@B@@@@@@A@@@A@@@@B@@@B@@B@@@@@@B@@B@@@@B@@@@B@@@@@B@@@@@B@@@@@B@@@@@@A@@@@@@A@"],["@@A@@BA@@D@@A@@@A@@@@@@@@D@@FD@@B@@E@@@A@A@A@@@A"],["@@@A@@@@@AA@@@@B@@A@CA@@CC@@@@A@@B@B@@@@@@A@BB@@@B@@BBB@B@BABBB@@@@@@@@A@@@@@@@@B@"],["@@A@@@@@A@@AA@@AB@@@@@@A@@@@A@@B@@@@A@@@@B@@A@@@@@A@@@@@@B@@B@BB@B@@BBB@@@@@@@@@BA@@B@@@B@@@B@@@@A@@AA@@A@@@@@"],["@@A@@@@B@B@B@@@B@@@@@BB@@@B@@@BB@@B@@@@A@C@@@@AA@A@AA@A@"],["@@AA@@@@BA@AAA@@A@@B@@A@A@@@A@@@A@@B@@B@@@AB@@@B@@B@@@B@@@@BB@@@B@@@@@BD@@@@B@@AA@BA@A@@@@"],["@@@@B@@A@@@@BBB@BA@@@@@AA@AAA@A@@@A@A@@@@AA@@@AA@@A@AB@B@@B@@B@@@BB@B@@@B@@B@@@@@A@@B@@@@@BB"],["@@@@@B@BB@AB@@@@@B@@@@ABA@BBDBB@B@@A@@@A@ADA@ACAAAA@A@"],["@@@@B@@@D@B@B@@@@AA@@@AAA@@@AB@@@AA@C@ABBB@@@@@B@BA@A@A@A@@@AB@B@@B@@@B@B@@A@@BBB@@A@@B@@B@@B@@@@A@@@A@@@@B@@AA@@A"],["@@B@@@B@@@@AB@@@@BB@@@B@@@B@@@@B@@B@@@@@B@@A@A@A@@@A@@A@@@A@@AA@@@AB@@@@@BA@@AA@@@@@A@@@@B@@A@@@@@A@@@A@@BB@@B@@B@@@"],["@@A@@@@@@AA@@@A@A@C@A@@@A@@@@@A@@@C@AB@@AB@@@@DB@@BAB@B@BB@@@BB@B@@@BAB@@A@@B@B@@@BA@@@@@@"],["@@@@@@A@@@@B@@@@A@@@@@A@@@A@@@@@A@@@A@@@@@AA@@@AA@@@@@@B@B@@@B@@BBDBB@@@B@B@B@@@B@@@BAB@@A@@@AAC@@@@A@@B"],["@@@B@@@@A@@B@@@@@BBB@@@@@@@@@@@B@@@@@B@@@@@@@@@@B@@B@@B@@@@@@B@@B@@BBA@@@@B@BB@A@@@@BA@@B@@A@@AAA@A@AAA@@@@@AAAA@A@A@@@AA@@@@@ABB@"],["@@@B@@B@@@@A@@B@@@@@AA@@CA@@@A@@@AB@@@@@@A@A@@A@A@ADCBA@@@A@@@A@@@A@@B@@B@@@@B@@@@@B@@B@@@B@@B@@B@@@@@@@B@@@BA@@AA@AB@@BB@@BB@B@"],["@@@@A@@@@@A@@@@@@AAA@@A@@@AB@@@B@@A@@@@@@@A@@@@@@BB@@@@B@@@@@@@B@@B@@B@@AB@B@@@@@@B@B@@@BA@@@A@@B@DA@AB@B@BA@@@AA@A@@B@AA@"],["@@@AA@@B@@@@A@@@@AA@A@@@@@@@@B@@A@@AA@@@@@A@@A@@@@@@@@@@@AA@@@@@A@@@@A@@AA@@A@@BAAA@@@AB@@B@@@@BBB@BBBB@@@B@@@AB@@@@BB@@@@@B@@@B@@BA@@BABA@@@@B@@@D@@@B@@@B@@@B@@@@A@@@@"],["@@@B@BA@A@@AA@AB@@A@@AAB@@@BB@B@@@@BA@@BA@A@@B@@BBD@B@@@DA@@BBBBB@D@@A@A@A@@A@@@@A@AAA@A@AC@"],["@@@B@@B@BB@B@@@@ABB@@B@@@@@@A@A@@@A@@@AB@BB@@B@@B@BAB@@@B@BB@BDBB@BA@AAA@@AA@@@A@A@C@C@AAAA@A@@@@B@B@@A@AB@@AA@@"],["@@A@@@AB@@A@@BA@AA@@AAA@A@A@A@@AAA@@A@@B@BA@A@@B@@A@@@AA@@A@@@@B@@BBA@@BA@A@A@@@A@AB@@B@@B@@@@@BA@A@@B@@B@DB@@@@BBB@@@BAA@AA@@@A@@@@@AB@B@BAB@@@B@@@@BB@BA@@@@@@@@AA@@@@B@@AB@DAB@@B@@B@@@B@DBB@B@DA@A@@@A"],["@@@A@@@@@AB@@@CEC@EAA@A@A@@@AAC@@@AAA@CBC@A@@BB@@@@B@@@B@@AB@@BB@@B@B@@A@@@@B@B@B@@@@A@@@@@@D@@@@AB@@@@@BBB@BBB@B@B@BBB@BD@@B@"],["@@B@@@@A@@E@@AA@BA@@@AA@@@@@@AB@AA@@A@AAA@A@@A@@@AA@A@@@AB@B@@@BA@E@A@A@@B@@B@B@B@@BBBB@BA@@B@B@@BABB@@BBA@@@@B@@@BB@BA@@B@@B@B@@@@@B@BAD@"],["@@AA@AA@ABA@@@@@AA@@A@A@@@@BBB@@@B@BAB@@AA@AA@AB@@A@@B@@@BBB@@@@B@@@BA@BB@@@@BB@@@B@@@B@BBB@@@B@@@BA@@@@B@@@@A@AA@AA@@@@@@BABA@@AA"],["@@B@@AB@@@BAB@BBB@B@B@B@B@BA@@B@@BDBB@@@BA@A@@AA@AAAAB@BC@@AA@@A@@@A@@@AB@@@@AA@AA@AAAA@A@@@@B@B@@@@A@@B@@BBB@@B@@@B@@@@A@@@A@@@A@@B@@@@@BA@@@@@AB@@A@AA@@A@AB@@@BB@@@B@@B"],["@@E@CAAB@AA@@@AAA@A@A@A@A@@@@B@BBB@BBBD@B@B@DB@BBB@@B@B@@@B@BBB@@@B@DB@@B@@ABAACCEEA"],["@@@BA@ABAAAB@@A@A@@@@BAB@@A@A@C@@@AB@@A@AB@@@@@BB@B@@B@@ABA@C@@BBB@@@@@BB@@@BB@@B@B@BA@AAAA@@AB@BB@@BA@@B@@@@@BB@@FADA@A@@A@A@@@@A@@@A@@B@HADCBA@AA@A@@@AAAB@@@@"],["@@A@A@@B@@A@@@EAA@@A@AAC@@C@A@AAEAA@CAA@C@@B@BBB@DAB@DBFDBFDHDJ@DABAB@B@@BB@@ABAB@BCB@B@D@@A@@CAC@A@A@@AA@@A"],["@@@A@ABAAAAAC@AAAAC@ABCAABE@C@CB@@@DBBB@@BA@@B@DBBB@B@@@@BBBHFBB@BD@B@BAB@F@B@BAB@@A@AAAAA@A@AAA@A"],["@@@A@AAABC@AB@@ACACAABABGBOB@B@B@BA@A@AA@@E@IEAAA@A@C@CB@B@@BB@@ABA@@@@B@BB@F@@A@@@AB@@@B@@ABA@BD@BBBBB@DBB@BB@F@BBBFBBBBBB@D@F@BAHIB@BCB@"],["@@B@@A@EAAG@ABCBAAED@@ABA@@BA@@@@A@@@A@@@@A@C@@BCFAB@AAA@@@@A@@B@@@BA@AD@@@B@B@@@@A@@@CAAA@@A@A@@B@@DDBBBB@@BABB@@@@@BB@@@@@BB@@B@BAB@B@@@B@B@B@@@B@@@B@@@@A@AAABCB@@@BB@BBBB@BB@ABA@@B@@@B@B@B@@AB@@AB@B@B@BCAAAA@A@A"],["@@@AB@AAEAA@C@A@@AA@C@A@ABA@@@@AA@CBABBB@BABABA@AAAAA@A@@AA@@ACBAB@DBF@F@D@DB@JDDB@AB@D@B@D@DA@A@@@A@@BBDAF@@C@A@A@@@A@@B@D@@@BA@AAAA@@AB@B@@A@@@A"],["@@@AA@C@@@@BA@A@@BA@@@A@E@A@AB@@ABA@@AA@@@AB@@EBMFA@AB@@BB@@A@A@A@A@A@@BB@B@B@@@BB@@AB@B@@@BB@@@@BBBB@B@@AB@B@@BB@B@B@AA@@B@BAH@FDD@@B@BB@BAD@@B@B@B@BB@B@@B@@@BB@@@@ABBB@@BBA@A@C@A@@@AB@BAA@CAA@@@@ABC@A@G@AB@@A@@@AA@AAAB@AA@@A@@BAB@@@AA@@B@@@B@B@@A@@"],["@@BB@@BA@A@A@@BA@@A@@@@A@@AAA@A@@A@@BC@AAAE@A@A@@ABA@A@A@@A@E@AB@DABCBA@A@ACC@A@ADA@A@@B@@IJA@CAA@@@C@A@A@@B@@ABA@@D@BD@B@D@D@D@BB@@AB@DBDFFBDDBBBBB@@B@B@BAAEB@@@B@BBB@@@@AB@B@@@@AAA@@AA@A@@BA@@AA@@@AB@BAB@B@BCBABA@C@AA@@AB@B@B@@BBBFF"],["@@B@B@@A@C@AAAAAACAAA@@ADC@@@AAAAAC@GAA@@ABA@A@A@ABAAA@AAAE@AA@A@A@ABA@AD@BA@@@AAAC@@A@ABA@A@AACCACAA@AABCAAAAE@AAAAACACAAC@A@AB@D@F@BBBCRA@ABBBBBFB@BCDAAAACAA@@B@BB@@F@DBADAFBFDDFBDCH@DBBBDJFB@D@BABA@AB@BBBFAB@B@BBBBBBB@F@@BBD@D@BA@A@AB@@@B@BB"],["@@G@ICIGGEKEG@CBAD@F@R@HDLJPFFj@RBN@DAACBCACBAFABBBDHBDA@AAABGBCDBB@@A@A@A@A@EAAGAOAI@E@I@MC"],["@@AB@D@FABAD@B@D@DBBB@BD@DCDBDBB@DBBDD@DABA@AB@@@BB@BABB@BBBDBJBD@DDBDBBD@@BBBB@BAB@B@B@@BB@HBD@@A@@AA@A@@@A@@FB@A@@@AAAAA@@@ABA@BD@B@@@B@@BHBF@H@F@BADAB@@ADABBF@DAB@J@BBD@@A@@BA@@@AC@@@A@@ACA@@ABAAA@@@BA@@A@@@@@A@@@@A@@B@BA@@@@@@@A@@@@@@A@@@@@A@@@@@A@@@@@A@@@AB@@@@A@@AB@@AA@@@A@@@@B@@AA@@@ABA@@@AA@@@A@@@@@@AB@B@@AHA@A@AA@AACAAB@@BB@@ABA@@@AA@@A@@BA@A@@B@BA@@@AA@@@A@AA@@@ADA@@AAA@A@@BA@A@@@ABAA@@AAB@@A@A@AB@BA@A@AA@CAAAAA@@A@A@@F@B@@AAAB@D@B@@AEAEAA@A@AA@AB@D@@A@AA@C@A@@@A@A@@@C@A@AAAABAB@@A@AA@@AB@@AC@@@A@@A@A@AACAAA@@@A@@CB@@AAAA@A@ABA@@@@A@@@@AACBOLABA@CD@BABAFCF"],["@@BDADBDBBBBADBBD@BD@DABC@C@AD@B@BFBD@BABCDAD@DA@B@BCB@DB@D@@@@BDDB@BC@EB@B@B@BBNAD@BADBDDLBRBJAJBHAJ@FBFBLDDBDABCD@F@@A@ECA@AB@BADABEAE@CBABB@BB@B@BAACBC@@B@DBBABAAAB@B@F@BABBHABA@AA@E@C@CCCA@BA@A@@CAKGCEBC@AA@AF@BCGKKEI@AHAF@DBBBBBBCDEBC@ACAAC@EBC@AB@BB@BB@BCB@BBBD@@B@BG@C@@DDFBDA@CDCACCCAGBCB@BCBA@AABAACA@@C@ABCBCAGAACA@@@AACC@CBCBABAAAA@ACEI@CBCB@BCBAA@C@AAACCG@ABADC@C@A@AB@DABC@CGC@ABAF@BC@AAAC@E@AGCEBED@BABA@@AEEEGG@EBCF@BA@A@CDEF@@BDAB@B@B@BDBdFPDTBJB"],["@@BA@CB@B@@BDBBBABBBB@JC@AB@DDB@BCFADABA@AAAC@AAE@AA@A@CBA@@@CBCDEHIFEBADBBABAD@DDBDD@DC@CB@BBBAAAACAACBC@@ABACAACIAA@A@@AEAGCCA@A@CJMDEDADBDABA@ECMA@CCCACEASBKBABA@ECA@CDABAAAAAA@ECGACBABA@CCIAE@@B@DBDADG@CAAAC@ADAHBB@BA@ABEBG@Y@SBGBE@MAODGDOFCDWRKHQLIF]JGBQLODOFAF@B@@AB@B@@CBCDADDBFABB@BB@F@DCDAD@B@@DBDBDF@JCBAACAGBEHEHAH@BBFDBDCDBDFFFBB@D@F@DBADBDFFNJHBBAD@@BBDBBBA@CBAB@@ADAF@DBDDAD@DD@FEFEFBFFDAD@BB@B@D@BFDJHVPBD@F@DBB@BAAAD@@@BAB@B@BB@B@@BD@DCDE@A@AD@BAA@ACBABAFEDAB@@DB@B@BA@A@AAA@ABAB@@AAAAA@@D@B@@CB@BBBBHBD@BABB@BDB"],["@@D@DB@DDBJDFFDBDHBBB@DABCBALCBC@AACC@E@AA@AACAAAAAABCBCDAFAB@BBBDB@D@DA@A@C@ADAF@BB@D@DBBBBD@JAFCFCFCFAF@FADABC@ADADBD@DAB@BCAACCACAE@KBEDIDCBAD@DAFE@CAAACGAIAM@UDCBCBCBADADCDC@AAAAAAAAEBEBG@CAAAACE@C@ABA@@BBD@@ADA@A@@ACCCAGBCBEFADCBC@E@CACKCEGC]CEAIAGAGBEBIDID_JGBADAD@FBD@D@FAH@L@F@FDH@J@D@DCLCJ@DBBDBHDF@F@HCDCDEDCBAFAH@FBDBDDH@J@LCPEX@JA"]],encodeOffsets:[[[125893,31167]],[[125885,31156]],[[125886,31164]],[[125504,30952]],[[125435,30969]],[[124511,30986]],[[124506,31013]],[[124643,30990]],[[125251,30903]],[[124540,31004]],[[124958,31038]],[[125501,30953]],[[124858,31079]],[[124528,30972]],[[124967,31015]],[[124520,31002]],[[125391,31169]],[[125432,30974]],[[125323,30957]],[[124852,31057]],[[125355,31215]],[[124523,30973]],[[124504,31006]],[[125470,31164]],[[125540,30935]],[[125510,30934]],[[125080,30958]],[[125327,31330]],[[124865,31049]],[[125509,30942]],[[125214,31212]],[[125459,31165]],[[125212,31170]],[[125062,30893]],[[125506,30947]],[[125397,31162]],[[124574,30984]],[[125095,30962]],[[125446,31166]],[[125103,30856]],[[124646,30976]],[[124824,31061]],[[125154,30907]],[[125402,30970]],[[125320,31215]],[[125448,31137]],[[125378,30965]],[[125031,31055]],[[125437,31139]],[[125218,30953]],[[125414,31133]],[[125528,30921]],[[125262,31225]],[[125314,31010]],[[124863,31043]],[[125271,30948]],[[125459,30958]],[[125275,31327]],[[125308,31322]],[[125079,30940]],[[125210,31250]],[[125179,30951]],[[125443,30946]],[[125138,30942]],[[125283,31238]],[[125427,31156]],[[124915,31070]],[[125220,30973]],[[125079,30879]],[[125254,30976]],[[125140,31061]],[[125291,31196]]]}},{type:"Feature",id:"330922",properties:{name:"嵊泗县",cp:[122.451382,30.725686],childNum:49},geometry:{type:"MultiPolygon",coordinates:[["@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@B@@@AA@@@@@@@@@@@@@@@@@@@@@@"],["@@@@@B@@@@@@@@@@@@A@@@B@@@@@@@A@@@@B@@@@@@B@@@B@BC@@@@@@@@A@@@A@@@@@"],["@@@B@@@@A@@@@@@@@@BB@@A@@@@BB@@@@@@A@@@@B@A@B@@@@@BA@@A@@@@@@@AA@@@@@@"],["@@@@@@@@@@@@@A@@A@@@@@@@@@@B@@@@@@@@@@@B@@A@@B@@@@@@B@@@@@@@BA@@@@@A"],["@@@@B@@@@AB@@@A@@@@@@@@@@A@@A@@@@@A@@@@@@@AB@@@@B@@@@@@B@@B@"],["@@@@B@@@@A@@@@@@A@@@@@@@ABAB@@@@@@@@@@@B@@@@@@B@@@B@@A@A@@@@@@B@@@A@@@"],["@@@@A@@@@BA@@@@@@@@@@@@@A@@AA@@@@@@@@@@@@B@@@@@@@@@@A@@@@BB@@@@@B@@@@@@A@@@@B@@@@@@@@@D@@@B@@@@@@@@@@@@@@@@@@@@@A@@A"],["@@@@@@CA@@AB@@@B@BB@@@@@BAB@@A@@"],["@@@@@@B@@@@A@@A@@@AA@@@@A@@@@@@@@@@@@@A@@@@@@B@@@B@@B@@@@@@@@@@@B@@@@@@@@@B@"],["@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@AB@@@B@@@@B@@@@@B@@@@@@@BA@@@@@@@@@@@@@@@@@@B@@@@@@B@@@@BA@@@@@@@@@@@@@@@@@A@@@@@@@@@@@AA@@@@@@@@B@@@@@@@@@@@AA@@@AB@@@@"],["@@@@B@@@DA@@@@@@B@@@BA@@@@@A@@@AA@@@AB@@AB@@A@@@@@@@@BA@@@@@@@A@@@@@@@A@@@@@BB@@B@@@@@"],["@@@B@@B@A@@BB@@@@@B@@AB@@@@A@A@@A@@@@@A@@@@@@@@@A@@B@@@@@@AA@@@@A@@@@@AB@@@@@@@BB@@@@@B@@@BA@@@@"],["@@A@AA@@AB@@@@@@@B@@@@@BB@B@BBB@@BB@BA@@AA@@AA@@A@@@@A@@"],["@@@@A@A@AB@@A@@B@@@@@B@@B@@BB@@@B@@AB@@@BA@A@@AA@@@@"],["@@B@@@@A@A@A@@@A@@A@@@A@@@@BA@BB@@AD@@@@@B@@BB@@BA@@@A@@"],["@@@@B@@@B@AA@A@@A@@@@@@@@@@@A@AA@@@@@@@@BA@@A@B@@@@@@@@AA@@@A@@B@@A@@@A@@@@@A@@@@@@@A@@BB@@@BB@@@@B@@@@@@B@@B@@@B@@@@@@B@@B@@@@@@@@@@@B@@@"],["@@@A@@C@A@A@@BB@@B@@@B@@BBBDBBB@@@@A@@@A@AA@@AA@@AB@@A@@"],["@@@@@A@@AA@@@@AAA@ABA@@B@@AB@@@B@@BB@@@@B@@@B@DA@@BA"],["@@@@@@@@@@@@@B@@A@@@@@@@@@@@@BA@@@@@@@@@@B@@@@A@@@@@@@BB@@B@@@B@@@@A@@@@@@BAB@@@@@@@@@@@@@@A@@@@@@@@B@@@@@@@B@@A@@@@A@@@@@@A@@@@AA@@@@@@@@@A@@B@@@@@@@AA@@@B@@@@@@A@@@@@@B@@A@B@@@@@@@@@@BA@@@@B@@@@@@@@"],["@@@A@@@@A@@A@@@@AA@@@@A@@@AA@@A@A@@@@B@@A@@@@@@BB@@@@@B@@@BB@BB@@@AB@@BB@@B@@A@@B@B@@@@A@@@@@@"],["@@DAD@@@@@B@@A@@@@A@@@@A@@@@@@@@A@@@@@B@@@@A@@A@@@A@ABA@@@A@@@@@@@@@A@@@@@@@@@A@@@@@@@A@BBA@@@@@@@A@@B@@@@@@@@@@@@@@@@@@BB@@@@@@B@@@@@AA@@B@@@@@B@@@B@@@BB@@"],["@@AB@@@@@AA@@B@@@@@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@BB@@@@@@@@@@@B@@@@@@@@@B@@@@@@B@@@BB@@@@B@BB@@@@@@BA@@@@@@BB@@B@@@BA@@@@AA@@AA@A@@@@@@A@@@@A@@@@@@@@A@@@@@@@B@@B@@@@@@@@@@@@@BA@@@@A@@@@@@@@@A@@@@"],["@@@@AAB@AA@@@@A@@@@@A@@D@B@@@@AB@@@BA@A@@@@B@@@B@@@B@@B@@@B@@@@@B@@@@A@@@@A@@@BA@@@@B@@@BABA@A@A"],["@@@A@@@@@AA@A@A@A@AB@@@@@B@@@@@@@BBB@@BB@@@@BA@@B@@@B@BDBB@@@@B@CE@@@AAA"],["@@@@@AB@@@@@@A@@A@@AA@@@ABA@@@@@A@@AA@A@@BA@A@@B@BB@BB@@F@B@@B@@BA@@@@B@@@B@@AA@"],["@@@@B@BA@@@A@A@@@@A@@@AB@@A@@@@@A@@@@@AB@@@AA@@@@@A@@@AB@@@@A@A@@BA@@B@@@BA@@B@@B@@@@@BB@@@@BB@A@@@A@AB@@@@@@AB@@@@@@A@BB@@@B@@@@@@@@@B@@@@@@@B@@@@A@@B@"],["@@@AB@@A@@B@@AA@A@@@@A@@@@@AA@AAA@@@@AA@A@@@@B@@@B@@A@@@A@@B@@BBBB@B@@BBB@D@@@B@@@"],["@@@@@@@@AAE@@@A@@@@@AAA@@@A@@AA@@@A@A@@@C@@A@@@A@@A@AB@B@B@@@BHDB@B@B@BA@BB@B@@@@@@@BA@@BB@@B@@AB@B@"],["@@BABA@@@@AAA@@A@AAA@@AAABAAA@AA@@A@A@AB@@BDABB@@@B@B@B@@@B@@@BB@@@@BBAB@@@BB@B@B@"],["@@@@A@@B@@@@@@BBA@BB@@@BB@@@@@@@@@@@@B@@AA@@@B@@A@@@@@@B@@B@@@B@@B@@B@B@B@BBB@DABABA@AA@A@@A@@AA@@A@@@A@A@A@@@@@A@@@@@@@@A@@@A@@@@@@A@@@A@@@"],["@@@@AA@@AB@@BD@@AB@B@BA@AB@A@@AA@@@@AAA@AB@B@B@B@@@BABA@BB@@BB@@B@B@B@@@@AB@@AB@@AB@@@BCB@B@@@@A@A@@A@@@@A@ABA@@B@@@@A@@A@A@"],["@@A@@@A@@@AA@@A@@A@AA@@@@A@@@A@@@@AA@@A@@BA@ABBDBB@B@B@@@@@B@@BB@@@BA@@B@@@B@@@B@@B@B@@@@AB@@@@@B@DBB@@AB@@@@@@A@@@@@A@@CA@@@@@@BAB@@@@A@@AA@@"],["@@@@@B@@B@@@@@B@BAB@@A@A@@A@AA@@AAAAA@@AB@BAB@@@@@@A@@A@A@@@A@C@C@ABA@A@A@A@@@@B@@@BAB@B@@B@B@B@BAB@@B@@@BB@@BB@@A@@B@DBB@@B"],["@@@@HDBBB@BA@@@AAC@A@@B@H@@@BA@@AAA@CAC@AAE@A@IAC@A@AAA@@@A@@B@@BB@@@B@B@@B@B@D@BB@@B@BB@AB@B@BBB@@@@B@@A@A@@B"],["@@@ABG@@BA@@@@AA@@@@AC@E@A@A@A@EAACCA@@@A@@B@H@BBD@B@BCFEJ@B@BB@JDB@B@BA"],["@@BBB@@@B@@@@@B@B@@@B@BA@@B@@@@A@@B@@@@A@@A@A@@A@@@@@A@@@AB@B@@@DA@@@ABCBA@@A@@@@@@@@@@A@@BA@@@@@@@ABA@@@@@A@@A@@B@@@AA@@B@@@@@@@@@AA@@BA@@@@B@B@@@@A@@@@@@@@@@B@@@@@@A@@@AA@@@@@@@BA@@@BBB@@B@@@@@@@@@@A@@BA@@B@B@@BB@@A@@@A@@@A@ABA@@AA@@A@AA@A@@AAB@@EB@@A@@@B@B@@@@B@@@@@@CBAB@B@@A@@@B@@B@@@B@D@@@@@@@@@B@@B@@@@A@@@A@A@@A@B@@A@@@@B@@@@@B@B@B@DB"],["@@@@@A@@@@B@@@FA@@@@@A@@@A@AD@B@@@BA@@CA@@@@@@@AAB@@CAA@@@@@@@@@@@@@@@@@AB@@@@@@@@A@@@@@@@@@@@A@@@@@@@A@@@@@@@@@@@@@@@@@@@A@@@@@AB@@@@@@@B@@@@A@AB@@@@@@@@@@A@@@A@@@@@@@@B@@@@@B@@@@@@@@@@@@AB@@@@@A@@A@@@@B@@@@@@@@@@A@@A@B@@A@@B@@@@@@A@@@@@A@@@@@@@@@@@@AA@@@@@@@@@@@@@@AA@A@@@@@@@@@@@@@@A@@@A@@AB@@@@@@@@@@@@@@A@@@@@AB@@@@@@@@@A@@@@A@@@A@@@@@@@@@@@@@AA@@@@@@@@@@@@@@@@@@@@@@@@AB@@@@@B@@B@@B@A@B@@@@@@@@@@@@B@@@B@@@BA@@@@@BB@@@@@@@@@@@@@B@@@@@@@@@@B@@@@@@@@@@@@@@@B@@@@BB@B@@B@@BBA@@DA@@@@@@BD@@B@B@@@@@@@@A@@A@@@@@BA@@AA@@B@@@B@@@B@@@@@ABB@@B@@B@@@@@@@@@@@B@@@@@@A@@@@@@@@@@B@BA@@B@@@BA@@@@@@@@@@BB@@BA"],["@@ABABAB@@AB@@@@AAA@@AA@@@AA@@AB@@@B@@ABC@@B@BA@CDAB@DB@BBBAB@B@B@D@@@BBB@B@@ABABA@@BC@@@AB@BAB@B@BA@AD@B@BABCAAAAAAE@AD@@@BA@"],["@@B@BAHG@A@ACE@AAAC@A@A@A@@@CEA@@@C@G@EBA@@B@BDDBBHF@@@B@B@BBBHBBBD@"],["@@@@ABAH@@@BB@BB@@@@@B@B@@AB@@@B@BBB@@AB@@@B@@@@@B@B@@@@@@@B@@@@@@B@@@@E@@B@BADCB@@@BBBAD@B@DBBAD@@ADAB@@@@A@AA@@@@@@A@@BA@@@AAA@AA@@A@A@E@CBKDC@A@@@@@@CDABATA@@@@BAA@@C@C@AAA@A@A@CDA@@AA@A@"],["@@@AAAA@A@A@AACAABKB@@@@A@CAAAAB@@ABA@C@AB@@@BDBFF@JB@BBB@@@JB@BBBD@B@BAB@@AA@A@@A@A@A@A@@B@B@B@D@BCBADABA@A@@@AC@A@"],["@@@AB@@@B@B@BA@B@@B@@@@A@@@A@@@A@@B@BCBAD@BBB@@BAB@B@@AB@B@@@BDC@AB@@@@@@BB@@ABAB@B@@D@@@@B@@@@A@@BA@@B@BAB@BC@A@@@@A@@@@AAA@A@@@@@@ABAB@@C@ADA@@@AA@@@A@@BA@@@A@AAAAA@CAA@@ADA@A@CA@@@@AA@@A@ABCD@@A@@@A@@@CAA@ABA@AB@@A@@@A@AB@B@@@B@@AAA@CB@@A@@@@B@B@@@@@BE@@@@BBBB@D@@AB@BADBB@@AB@@@@BB@AB@B@@A@@@@B@B@@@BABAB@BB@@BB@@@@@@B@BB@B@BBB@@@BAAA@@BAB@B@BA@A@A@@@@"],["@@DUAEAACAE@EDCFCLABABEBE@G@A@E@EDGJADBB@BVTBBBABA@CB@@@HAD@DBD@FADGFKDG@A"],["@@BBDBB@BB@BEF@B@@@BBBBBDBD@DB@B@@@BAF@B@DHHB@BBBABA@CBADCHADA@CBC@@FCD@H@H@D@B@@C@AAACAAA@A@CAAAA@@E@C@A@G@C@C@AA@ABCBAHE@@@AAACAGBEBCDCBC@CAC@IDCBAB@D@B"],["@@GAE@EBADAJ@@CFCBG@GCEEE@ABAHAB@F@FBDLHDBLFFD@@BBBFDBB@FBT@BADAHEBCBIACAAE@EDA@A@CAAA@A@C@ADK@ADKACCC"],["@@@CACAGDCBA@AEGAACBKBCB@@M@I@MDKB@ACAMBEDOHQJQNKHEHEDQJ]TEDGBI@e\\y|IRCRCp@FARCZAHGNOZtZfF[@GAUC[BOHOfuDCRGDAN@HARG\\ILET@FCDEHK@AEABCPGBCVIFCH@J@D@BAAE@EBCZGF@FBBBBBDA"],["@@BBD@@@B@@@BBB@@@F@FCBCB@@A@AHEHKB@D@DBB@BA@A@A@A@A@CBAD@BABABA@A@A@C@ADAFAF@B@D@BCD@B@F@D@D@B@DBB@DBF@D@@B@D@DBDFBFAD@BCBA@CB@D@@B@D@DBBB@BAD@BBBFFBDABAB@FADA@CACEACAC@C@A@@A@CBABCBCF@FAFABC@AAAC@AC@AAACAC@ADABA@C@AAA@A@@B@D@BCB@ACCA@C@ABA@A@AABC@A@CA@CACBABABE@A@@B@D@B@DC@A@ACAAAAAAAACAAAA@CBAD@BADABA@AAAAA@C@A@IAEAGEAA@A@CACCAAAABCDAD@BABA@A@AC@ACAC@AB@BBB@B@BCAE@A@@BAB@@CACAC@AA@A@AAAA@@BABABA@ABC@CB@DADDDBDBB@DB@D@FBBBDDBB@DBBDD@DAFAFBD@BBD@DADCBE@C@AB@DBB@BA@@@A@AAE@CBAAC@AB@B@BB@B@DABB@B@B@BB@D@@AB@B@D@D@D@"],["@@BA@@@CBABAD@D@FABAB@@A@@AAIAE@CAC@@AAAA@@@E@GDAB@B@BBBDDBB@BAF@@@BDBB@@@DCB@B@B@"],["@@H@D@BC@AD@BBB@BBDADADAH@B@@A@A@ABABA@AAAGD@B@B@@AB@@A@C@KA@@ACA@C@A@AA@CDAB@@@@ABC@A@AA@C@AAAA@ACA@A@@C@A@AAAACBGF@B@@MLA@A@A@EF@DABBB@B@B@@BB@BBB@@B@B@D@F@D@FBRB"]],encodeOffsets:[[[125644,31572]],[[125601,31506]],[[124985,31447]],[[125609,31495]],[[125643,31575]],[[125415,31486]],[[125590,31508]],[[125748,31430]],[[125696,31448]],[[125683,31549]],[[125700,31549]],[[125703,31539]],[[125368,31427]],[[124508,31303]],[[125389,31505]],[[125614,31536]],[[124493,31310]],[[124512,31329]],[[125671,31528]],[[125337,31506]],[[125616,31527]],[[125612,31545]],[[125426,31403]],[[125371,31423]],[[125078,31299]],[[125406,31357]],[[125067,31326]],[[125100,31318]],[[124652,31310]],[[125711,31528]],[[125463,31374]],[[125371,31352]],[[125079,31354]],[[124546,31348]],[[125333,31500]],[[125730,31529]],[[125576,31562]],[[125480,31397]],[[125227,31387]],[[125392,31481]],[[125371,31409]],[[125628,31596]],[[125787,31452]],[[125488,31391]],[[125736,31432]],[[125068,31347]],[[125378,31481]],[[125595,31566]],[[125008,31331]]]}},{type:"Feature",id:"330903",properties:{name:"普陀区",cp:[122.323867,29.97176],childNum:76},geometry:{type:"MultiPolygon",coordinates:[["@@@@@A@@@@@@@@@@AB@@@@@@@@B@@@@@"],["@@@@@@@@@@A@@@@@@@@@B@@@@@@@"],["@@A@@B@@@@@BB@@@@@@A@@@@@@@A@@"],["@@A@@@@@@@@@@@@@@@@@@@@@@@@@@B@@@@@@@@@@@@@@@@@@@@@@B@@@@@@A@@@@@@@@@@@@@@@@@@"],["@@@@@@@A@@@@@@@@@@@@A@@@@@@B@@@@B@@@@@"],["@@@@@@AB@@B@@@@@B@@@@@@AA@@@"],["@@@BA@@B@@B@@@BA@A@@@@A@@@"],["@@@A@@@@B@@AA@@@@BA@@B@@@@@@B@@@@@"],["@@@@AA@@@@@@@@@@@@@@@@@B@@A@@BB@@@@@B@@@@A@@@@@@"],["@@@@@A@@@@A@@A@@@@A@@@@B@@@@@@@@@@@@B@@@@@@@@@@B@@@@B@@@"],["@@B@@A@@@@@@A@@@A@@@@@@B@@@@B@@@"],["@@@@@AA@A@@@@@@@@@A@@@@@A@BB@@@@@@@@B@@@@@@@@@B@@@@@B@@@@@@@"],["@@@@B@@@@A@@@@@A@@@@@@A@@@@B@@@@@B@@@@@@@@"],["@@AA@@@@@@@@A@@@@@@B@@@B@@@@B@@@@@BA"],["@@A@A@@@@BA@@@B@@@@@BBBA@@@@@@@@@@@A@@@@"],["@@A@@@@@@BA@@@@@@@@@@B@@@@B@@@@@@A@@@@B@@@@@@@@@@A@@"],["@@@A@@AA@@@@A@AB@@@BB@B@@@B@"],["@@@@@@A@@@@@@@@@A@@@@B@@@@@@@@B@B@@@@@@@B@@@@@B@@@@A@@A@@@@@A@"],["@@@@@@@AA@@@@@A@@@@BA@@@@B@@B@@@@@B@B@@A"],["@@@@A@@@A@@B@@@B@@A@B@@@@@BBB@@@@A@@@@@@@A@@@@@A@@"],["@@A@@@@@@B@@@@A@@B@@@@BB@@@@B@@A@@@@@A@@@@B@@A@@A@@@"],["@@@AA@@@@BA@@@@@AB@@A@@B@@B@@@@@B@@@@@B@@A@@B@@A@@@@"],["@@B@@A@@B@@@BA@@AA@@A@@@ABA@@@@B@@@@A@@@@BB@B@"],["@@@@@A@@@@@@@@@@@@@@BA@@@A@@@A@@@@@@A@@@@BA@@BAB@@@@B@@@@BA@@@B@@@@@@@B@@@"],["@@AAA@AB@@@BAB@@@BB@B@B@BA@A@@@A@@"],["@@@@A@@@AA@@A@@@A@@B@BBB@@@@@BBB@@B@@@@A@@@A@@@@@A@@@@BA@@@@"],["@@B@@A@@@@@@B@@@BA@@@@A@@@A@@@A@A@@@A@@B@@A@@@A@@BB@A@@BBBD@@@@A@@@@A@@A@@B@@@B@@@@@"],["@@A@@@@@A@B@@AA@@@A@@@@@@@A@@@@@A@@@@@A@@BB@@BB@@@@BB@@@B@B@@@B@BA@@@@AA"],["@@A@@@A@@B@@@@@@@@AA@@@@A@A@@A@@@@A@@@@@@B@@@@@@BBB@@BB@@@@@D@@@B@@@@@@@BA@@@@@@@AA@@@@@@@"],["@@@A@AAB@@@B@D@@@@@B@@@B@@@@@BA@@@@BB@@@@@@BB@@@@@@@B@@@B@@A@@@@@AA@@@A@@@B@@A@A@A@A@@AA@@"],["@@B@A@@A@B@@A@@A@@@@@A@@A@A@@@@@@BA@A@@@BBBB@B@BB@@@B@@@B@@@BA@@@AA@@@@@@A"],["@@@@@AA@@@@@@@@A@@@A@@A@@@A@@@@@@@@AABA@@@A@@B@@@BB@BB@@@@BB@@@@@@B@@@@BB@@@B@@@@A@@@A"],["@@@@A@@B@BA@A@AB@B@@BB@@B@BA@A@@DADA@ABA@@A@@@AAA@@AA@@@@B@B@B"],["@@@@BA@@@ACG@@AAA@@BA@@BBD@DBBB@B@"],["@@@@@AA@@@A@A@AB@@@BB@BA@@@@BB@@@@@@BB@@AB@B@B@@B@@@B@@@B@@@BABA@A@@A@@@AA@@@@A@A@@A@@"],["@@@@A@@@@AB@@@@A@@AA@@AA@@A@@@@B@@@B@@@@@B@@@B@@@@@@@B@@@B@@B@B@@B@@@B@@B@B@@@@A@@@A@@@A@@@A@@@@A@@@"],["@@@@AB@B@@BBBBB@B@B@B@@AB@@A@AA@AA@@A@C@A@"],["@@B@@@@A@@@@C@@A@@@@A@@@@B@@@B@B@@A@A@AB@@A@A@@BA@A@@@@@AB@@B@@@B@BBB@@@D@@@D@B@@A@@@@DA@BBA@@@AA@@@@A@@A@@@@A@@"],["@@BABEBCAAA@A@CBCB@@AB@@@B@BB@B@@B@BBB@@B@@@B@@A@@"],["@@AAA@@@@@@B@@BBBB@@@@@B@@BBDAB@@@@B@@@@@B@@B@@@B@B@@A@@@A@@@A@@AA@@AA@A@@@AAA@AA@ABA@@BAB@@@B"],["@@@A@@B@B@@A@AA@@@A@A@@@@BA@@BA@AA@@A@@@@B@@@BA@@@@@@@@B@@@@ABA@A@A@A@A@ABA@A@AB@B@@B@@@B@@B@@B@B@B@BA@@BA@@BA@BB@@@@@B@B@B@@A@@B@@@BBBA@@@AB@@@@A@A@@@@"],["@@BA@AAA@AGAA@A@@@A@@BABCD@B@B@@@BB@@@B@DBB@B@DAB@@ABA"],["@@A@@@A@AAA@CA@AB@@AA@A@AA@BA@@@@@A@@@BB@@@BABA@AB@BB@AB@@@B@@@@B@DB@@BB@D@@BB@@@B@BB@@@B@BA@@@A@@AA@A@@@AB@B@@A@A@AFA@@@A@@"],["@@@@A@A@A@@@A@@@A@@@A@@@@@@A@@C@ABA@@B@B@@A@@@A@@BBBBB@BB@BD@@B@@@B@@@B@@@@A@AB@B@DAB@BA@A@AB@BA@@B@@A@A@AA@A@@B@@ABA@"],["@@@AB@BC@C@@@@BAB@@A@A@AA@A@@B@BA@A@@A@@@A@@@@A@A@ADAB@BA@ABBBA@@B@B@@ADCDAB@BB@@@B@@@B@D@@@@@@AA@A@@AB@@@B@D@@@@@@@@A@@B@BABA@@"],["@@@@ABA@AA@AA@AB@BA@@@A@@@A@@B@@BB@@AB@@@@A@@BB@@BB@@BA@@@@BAB@@A@@BB@@@@@@BA@@@BBB@B@@@BA@A@AB@@ADAB@@C@@B@B@@@BA@A@A@A@@@AD@BABB@AB@AA@@C@@@@A@@BA@A@AA@CBADBBAD"],["@@A@AA@@C@C@@B@@B@@@@B@@A@A@@@@B@@@@A@@B@@B@@B@@ABC@AB@BBBDBD@D@DADCBAAA@AB@B@@AB@@AB@DA@A@@AA@@A@CB@B@@A@A@"],["@@ABAB@B@B@@@BA@@@@B@B@@A@@BBBB@B@@@@@BBF@B@D@BA@@@A@@B@B@@A@ABA@A@@CACAA@A@@@@@AAAAC@"],["@@@BAA@@@@AA@@AAA@@@@@A@@@@@AA@@A@@@A@@@@@A@@@@@A@A@@@@BADA@@BA@@B@@@B@@@AB@@B@@B@@@@B@@@B@@@@@@@@D@@@B@@@@B@BB@B@B@BA@AAA@AAA@@@AB@B@@BB@@B@@B@@@@AB@@@@@@BB@@B@@B@B@@@B@@A@@BABA@@@A@@@AA@@B@@A@A@@@A@"],["@@@@B@DA@@@AA@@@@A@@@@@A@@@A@A@A@@B@B@B@@@@@@AACE@@@ABCB@BA@A@A@@B@@BB@@BB@@@B@@@B@@@B@@AB@@A@@@AB@@@@@BAA@BA@@B@@@B@@@@@B@B@B@BB@@@B@@@@@@B@@BB@@B@@A@A@@@ABA@@@AB@@A@@@AB@BA@A@@A@@A@@@@@@B@"],["@@@@@AABA@A@@@A@A@@BDB@BBBB@@@BA@@B@ABB@@@B@@BB@@@@B@B@@@B@BBBBBB@@@BB@@@@B@@AB@@@B@B@@BB@@@@CAAAC@AAAAA@ABAAAAAA@A@A@@A@@A@@@@BA@@BA@AA"],["@@E@A@CBA@C@@B@@BB@FDHDDD@D@FA@A@CBE@C@CCAC@"],["@@B@@C@@@@BAD@@AAAAACCAAGAC@C@ABABABEDABBDB@BBBBB@B@DAD@BBDBBBBA@@BA"],["@@@@@A@E@@@AB@B@@ABABA@@@AB@@A@AA@@@@AB@@AA@A@ABBBA@@@AAA@@AA@@@AD@D@@@BA@@@@@A@@AAB@BA@AB@@BB@DAD@B@BBDB@@@B@@@@@B@@@ABAD@BB@B@BA@@@@BB@@BBB@@@BB@@B@@AB@@AA@A@@@@A@@BA@A@A@@@CAA@@A@@@@BA@@A"],["@@A@@AB@AAAAAB@A@@A@AB@@A@A@A@GBIFCBC@ABA@BB@BA@A@@@@B@BD@B@D@B@@@DBB@BAFCD@D@B@@A@AB@B@D@DA@A@@B@@A@@AA@@"],["@@@@@@@@B@BA@@@AB@B@BABABC@AAA@@ABA@@@@@@A@A@A@@BABA@A@@@AA@A@@B@@AB@@A@@@A@AA@@A@CBABB@AB@@A@ADEDAB@B@B@@A@@@A@@@@B@BBBB@BA@@B@@@@@@BB@BA@@B@@@@B@@@@@B@@ABBBB@@@B@@AB@@@@@B@@B@@BAB@@AA@@A"],["@@@A@@AA@AC@@@CBABA@A@A@ADABAB@BB@@B@BABA@@BA@A@AA@@ABA@@AA@A@A@@B@BB@D@@BB@@BAD@@BBB@BABAD@B@@B@BDBD@HABA@@@ACAEA@@AA@ABAB@B@B@F@BABC@@AA@@A@@AB@@A@A@@"],["@@@B@B@@ABBB@B@BA@ABA@@B@BBB@@B@BB@@@BA@@DBB@@B@B@B@@B@@BBDBB@BA@A@@AA@@@A@AD@@A@AAAAA@ACAA@@AD@F@BABAAAAAA@@@BAB@FA@ADC@A@@A@GBCBC@A@@A@A@AA@CDAB@BA@ABB@"],["@@A@KDE@GFCD@@CB@D@BBBDADAB@B@@@@BDBB@FCBAFABBABDBB@D@BA@A@AB@DA@@BABAB@AA@@A@@@@AA@AA@A@AAAE@EB"],["@@AE@@@A@AA@@@AD@@@@A@A@AACBA@@B@D@B@BA@AB@BA@A@@@@BBD@@BBABABAB@BA@@@A@BD@@AB@@AAA@ABAD@@BBDABD@B@F@@B@B@@CBBB@B@B@B@B@B@@ABA@@BB@@@BBA@@@ABC@@AABEAA@@@ADGBEAA@EB@B@@A"],["@@B@B@B@BABE@KBC@A@GAA@AAAAAA@AB@F@BADABBBBBAB@BA@CAA@AAAA@BABAHABED@BAF@@A@AB@L@@B@B@@AD@B@HAB@B@@@@AB@B@@BBA@A@A@A@ABA"],["@@@AE@GAG@ABA@AAC@A@A@A@BAAAA@A@CBABAD@BABABA@AF@BBD@BAD@B@BBBD@B@FC@AB@@@B@F@B@@@@BB@FADCBEDCDCD@FA@A@A"],["@@AGAGGEECG@KBKBADCJAF@DB@ZLLBJADCDI"],["@@FCPKFADEDC@@@AAAA@E@GDSFIDWHCBIBGDGFWTAF@DD@F@L@B@D@JANGRIBATK"],["@@A@ADBBBBB@BBAD@BCDBBBBBBFAF@DA@CBAB@DBBBDBB@B@@A@A@AA@BA@@@@BA@@@BBB@B@@B@BABA@@@@@@@@A@AA@@@@@@B@@A@@@AB@BAB@@A@@EC@@AA@AB@B@B@B@BABA@ABAB@@@@B@BB@B@B@B@BAA@@A@@@@B@@CB@B@B@DAB@B@@@BA@A@@B@B@BAB@@AB@B@B@B@DAD@@A@@BAD@D@DBB@D@F@D@DAD@BBB@BA@CBAAA@@A@@@ABA@A@@@@C@A@ABA@@A@@@A@@A@A@AB@B@@@B@@A@AA@A@A@CB@@@BAA@@A@AB@B@BB@@B@@ABA@AD@B@B@@A@A@A@A@@BC@@AA@A@@AAB@BA@A@@@A@GB@B@@A@AAC@AB@@A@@@@A@@@@AA@A@@A@AA@A@@B@BAB@@AA@AAA@@@A@@B@@@BAB@B@@ABAB@@A@A@AACBC@@B@BAB@@A@A@@BA@AB@@@B@BA@@@ABA@A@@@AB@@A@A@A@ABA@EBEBAB@@@B@@A@A@A@AB@B@BAB@@A@A@"],["@@BAAE@EBEB@@AAACA@AAAC@@AG@@BA@A@CBCLADBJBB@BABAD@F@HABADBDAB@DLFBDDDHHD@F@HDDBD@DABED@BAB@BCACBC@E@ABA@ACAGAC@CC@AEAAA@A@AAECCAC"],["@@@EBCACECGAA@KBODKHEH@D@BBDBBD@BDBZ@FDFBFBLBL@DDBDAFBB@HBFAFBDBLLB@D@DADGBCACIECE@E@A@ABADANEJCBC@CACCACAK@C@EBI@C@CEAI@A@@BAAAAA@A@A@ADADCBC"],["@@FC@CCAIAQAACIIIASCMBQDOHEJAVBFDB^LBBBDFA@AF@RCFCJ@LBJDLDFADEFADA@CCA@CACEBAAAA@C@C"],["@@D@XFF@F@DAPIHGBIAEEEE@UBC@ECAEEAC@_NE@S@K@GDCFOLajOTAD@FDHDBFBH@HCDBDBFDH@H@JARKLGBEAGCCECAC@EBEDCHCJCVED@"],["@@CGCCAAC@EDGBE@CBEAE@CAA@C@AAKCGAEAA@E@C@GFEDCBGFYRABCBULCBMDDB@@BD@@@B@@@B@@@@@@@@BD@D@@@@AB@B@@@@@B@@@@AB@@BB@@B@BBB@@B@@@@@@@B@@@@@@@@@B@@AB@@B@F@@D@BA@@@@B@B@@@@@B@@@BB@B@@@B@@AB@DB@@@B@@A@@BAB@@@@@@AB@@ABAB@B@B@@@@@BB@@BBB@@@BAB@@BB@@@@@@@B@B@@@@@@BB@@@B@@@B@@B@B@BB@@@BDB@BB@BBBBB@@@B@BBBBB@@@@B@@@@@B@@A@@@@B@B@@@@BB@BB@@@@BD@@@B@B@@@@B@@BB@@AB@@B@@B@BAB@B@@A@@@@@@BA@A@A@A@@@A@ABA@A@@@@@A@@@@B@BA@@@AB@@C@A@A@@BA@@@A@@BABA@@BA@@B@B@@@DBBABAB@@@@@BDBBBB@BBB@@@BB@@B@B@@@BBBBBB@@B@@@B@@B@@@B@BBB@B@BA@@@@BB@@BB@DADABDBB@@JAF@D@HDHBX@D@BABEDADGHGDEDEJMDAJCLEHCDCFIBEDAB@F@F@FABABCBE@A@CAAC@CAAAAC@EBGFG@E@IAICKGKKQ@CACAI@ECG"],["@@@@B@@@B@@@@A@@AAAAA@@@A@@A@@A@A@C@AB@B@BBA@@BBB@@B@@BA@@@BBBB@BA@@"],["@@FCD@BBB@ACBAD@DBDBDABCBEBCDA@CCCE@GDCDA@AA@AAAG@GBE@ACCE@CBCF@J@JALG@AAAA@AA@CBABC@AAAEBCBACBCHIDCBCD@D@DCBAFAFG@CCCI@EBCA@C@C@EA@EAEBAB@DABGDGFGFEBECKDOLQNgXEJ@DALGHEBCBK@EHKL@FBBBBJJHDFD\\FPAJ@HEHAJCDDDBJ@FAJENCJABA@C@CBA"],["@@@BDL@FCHIJABIFKBCDCDAVAFCDE@A@G@E@GCGMIGGCK@MJUJC@EBCDAH@B@DFDDDDFLZHRFVBJDLBDFHBBFDFDH@NCD@FBFDD@HBF@XNHDFAFABCN[@EBMDOLOJETKDA\\QJCRONKDEBAFSDEFGFEBAAEOYEGKWCGGEMCC@C@ADCJ@BABCBC@IGICEBCBGFGBGAEBEDILCF@D"],["@@AECCAADCBACC@ABADADG@CEEGAGBGBCACC@CBCBAAEC@E@CBE@CCACAACBCACCAGDE@CEA@C@CFCLANEDC@ECAA@@CDA@GAAA@AA@ADAF@BCAAG@IAGDCFC@AAA@K@KFOREJBD@BADKR@FNNBDBHCHAFBFADABCFEBM@QLADFF@JCBCBGDI@CCC@IHMPANBBBBBB@FBDddBDBDD@BC@CBABADEDARFB@DBDBBCBCFCXEDEBC@ABADBB@D@B@AD@DHDHADCBBDBAFA@AB@@@BB@@DF@D@JCPGBCAEGCCC@CFCDC@AAC@CBCD@H@DIACCAO@G@ADG@EA@A@AFEH@NAJAHCDE"],["@@@@@@@@@A@@@ABAD@BE@C@AB@@BB@@@BAB@B@@@BAAA@@@A@@@AA@A@A@ACCAAAAAA@EICCAAA@C@C@A@AB@B@BAVC`\\EB@@@@@@@@@@@B@@@@A"],["@@HEDE@CGEKAOAABABABABIFGH@DBBBBF@D@D@FDF@JCJC"]],
+encodeOffsets:[[[125346,30767]],[[125418,30772]],[[125169,30590]],[[125374,30582]],[[125465,30596]],[[125428,30776]],[[125900,30891]],[[125900,30895]],[[125078,30311]],[[125823,30884]],[[125223,30401]],[[125099,30333]],[[125344,30770]],[[125229,30406]],[[125302,30820]],[[125467,30593]],[[125400,30785]],[[125091,30519]],[[125425,30777]],[[125406,30667]],[[125361,30766]],[[125414,30644]],[[125261,30502]],[[125322,30510]],[[125273,30806]],[[125362,30762]],[[125145,30544]],[[125184,30578]],[[125101,30317]],[[125255,30769]],[[125045,30390]],[[124976,30478]],[[125363,30588]],[[124969,30487]],[[125340,30755]],[[125082,30341]],[[124938,30422]],[[125170,30357]],[[125251,30817]],[[125386,30688]],[[125179,30370]],[[125172,30429]],[[125674,30897]],[[125169,30517]],[[125265,30551]],[[125176,30384]],[[125170,30446]],[[125388,30666]],[[125161,30345]],[[125371,30753]],[[125081,30319]],[[125131,30469]],[[125109,30522]],[[125655,30926]],[[125155,30434]],[[125129,30534]],[[125316,30545]],[[125392,30650]],[[125125,30501]],[[125599,30915]],[[125633,30926]],[[125735,30851]],[[125252,30654]],[[125196,30667]],[[125141,30417]],[[124957,30442]],[[125336,30700]],[[125279,30583]],[[125215,30461]],[[125264,30666]],[[124984,30474]],[[125240,30549]],[[125063,30386]],[[125374,30624]],[[125231,30822]],[[125207,30591]]]}}],UTF8Encoding:!0}):void D("ECharts Map is not loaded"):void D("ECharts is not Loaded")})
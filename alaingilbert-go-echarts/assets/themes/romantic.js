@@ -0,0 +1 @@
+!function(e,o){"function"==typeof define&&define.amd?define(["exports","echarts"],o):"object"==typeof exports&&"string"!=typeof exports.nodeName?o(exports,require("echarts")):o({},e.echarts)}(this,function(e,o){var r;if(!o)return r="ECharts is not Loaded",void("undefined"!=typeof console&&console&&console.error&&console.error(r));o.registerTheme("romantic",{color:["#fb2831","#fd948c","#f1d1d2","#8fcbf0","#c4e7f7","#dfeef8"],backgroundColor:"#f0e8cd",textStyle:{},title:{textStyle:{color:"#330022"},subtextStyle:{color:"#3d2f1b"}},line:{itemStyle:{normal:{borderWidth:"2"}},lineStyle:{normal:{width:"2"}},symbolSize:"6",symbol:"emptyCircle",smooth:!0},radar:{itemStyle:{normal:{borderWidth:"2"}},lineStyle:{normal:{width:"2"}},symbolSize:"6",symbol:"emptyCircle",smooth:!0},bar:{itemStyle:{normal:{barBorderWidth:"1",barBorderColor:"#cccccc"},emphasis:{barBorderWidth:"1",barBorderColor:"#cccccc"}}},pie:{itemStyle:{normal:{borderWidth:"1",borderColor:"#cccccc"},emphasis:{borderWidth:"1",borderColor:"#cccccc"}}},scatter:{itemStyle:{normal:{borderWidth:"1",borderColor:"#cccccc"},emphasis:{borderWidth:"1",borderColor:"#cccccc"}}},boxplot:{itemStyle:{normal:{borderWidth:"1",borderColor:"#cccccc"},emphasis:{borderWidth:"1",borderColor:"#cccccc"}}},parallel:{itemStyle:{normal:{borderWidth:"1",borderColor:"#cccccc"},emphasis:{borderWidth:"1",borderColor:"#cccccc"}}},sankey:{itemStyle:{normal:{borderWidth:"1",borderColor:"#cccccc"},emphasis:{borderWidth:"1",borderColor:"#cccccc"}}},funnel:{itemStyle:{normal:{borderWidth:"1",borderColor:"#cccccc"},emphasis:{borderWidth:"1",borderColor:"#cccccc"}}},gauge:{itemStyle:{normal:{borderWidth:"1",borderColor:"#cccccc"},emphasis:{borderWidth:"1",borderColor:"#cccccc"}}},candlestick:{itemStyle:{normal:{color:"#fb2831",color0:"transparent",borderColor:"#fd948c",borderColor0:"#8fcbf0",borderWidth:"2"}}},graph:{itemStyle:{normal:{borderWidth:"1",borderColor:"#cccccc"}},lineStyle:{normal:{width:1,color:"#512f04"}},symbolSize:"6",symbol:"emptyCircle",smooth:!0,color:["#fb2831","#fd948c","#f1d1d2","#8fcbf0","#c4e7f7","#dfeef8"],label:{normal:{textStyle:{color:"#eeeeee"}}}},map:{itemStyle:{normal:{areaColor:"#fdfffe",borderColor:"#8fcbf0",borderWidth:.5},emphasis:{areaColor:"rgba(165,231,240,1)",borderColor:"#512f04",borderWidth:1}},label:{normal:{textStyle:{color:"#000000"}},emphasis:{textStyle:{color:"rgb(81,107,145)"}}}},geo:{itemStyle:{normal:{areaColor:"#fdfffe",borderColor:"#8fcbf0",borderWidth:.5},emphasis:{areaColor:"rgba(165,231,240,1)",borderColor:"#512f04",borderWidth:1}},label:{normal:{textStyle:{color:"#000000"}},emphasis:{textStyle:{color:"rgb(81,107,145)"}}}},categoryAxis:{axisLine:{show:!0,lineStyle:{color:"#cccccc"}},axisTick:{show:!1,lineStyle:{color:"#333"}},axisLabel:{show:!0,textStyle:{color:"#512f04"}},splitLine:{show:!0,lineStyle:{color:["#eeeeee"]}},splitArea:{show:!1,areaStyle:{color:["rgba(250,250,250,0.05)","rgba(200,200,200,0.02)"]}}},valueAxis:{axisLine:{show:!0,lineStyle:{color:"#cccccc"}},axisTick:{show:!1,lineStyle:{color:"#333"}},axisLabel:{show:!0,textStyle:{color:"#512f04"}},splitLine:{show:!0,lineStyle:{color:["#eeeeee"]}},splitArea:{show:!1,areaStyle:{color:["rgba(250,250,250,0.05)","rgba(200,200,200,0.02)"]}}},logAxis:{axisLine:{show:!0,lineStyle:{color:"#cccccc"}},axisTick:{show:!1,lineStyle:{color:"#333"}},axisLabel:{show:!0,textStyle:{color:"#512f04"}},splitLine:{show:!0,lineStyle:{color:["#eeeeee"]}},splitArea:{show:!1,areaStyle:{color:["rgba(250,250,250,0.05)","rgba(200,200,200,0.02)"]}}},timeAxis:{axisLine:{show:!0,lineStyle:{color:"#cccccc"}},axisTick:{show:!1,lineStyle:{color:"#333"}},axisLabel:{show:!0,textStyle:{color:"#512f04"}},splitLine:{show:!0,lineStyle:{color:["#eeeeee"]}},splitArea:{show:!1,areaStyle:{color:["rgba(250,250,250,0.05)","rgba(200,200,200,0.02)"]}}},toolbox:{iconStyle:{normal:{borderColor:"#999999"},emphasis:{borderColor:"#666666"}}},legend:{textStyle:{color:"#512f04"}},tooltip:{axisPointer:{lineStyle:{color:"#cccccc",width:1},crossStyle:{color:"#cccccc",width:1}}},timeline:{lineStyle:{color:"#8fd3e8",width:1},itemStyle:{normal:{color:"#8fd3e8",borderWidth:1},emphasis:{color:"#8fd3e8"}},controlStyle:{normal:{color:"#8fd3e8",borderColor:"#8fd3e8",borderWidth:.5},emphasis:{color:"#8fd3e8",borderColor:"#8fd3e8",borderWidth:.5}},checkpointStyle:{color:"#8fd3e8",borderColor:"rgba(138,124,168,0.37)"},label:{normal:{textStyle:{color:"#8fd3e8"}},emphasis:{textStyle:{color:"#8fd3e8"}}}},visualMap:{color:["#8fcbf0","#c4e7f7","#dfeef8"]},dataZoom:{backgroundColor:"rgba(0,0,0,0)",dataBackgroundColor:"rgba(255,255,255,0.3)",fillerColor:"rgba(167,183,204,0.4)",handleColor:"#a7b7cc",handleSize:"100%",textStyle:{color:"#8fd3e8"}},markPoint:{label:{normal:{textStyle:{color:"#eeeeee"}},emphasis:{textStyle:{color:"#eeeeee"}}}}})});
\ No newline at end of file
@@ -53,11 +53,12 @@ func (c *Bar) XYReversal() *Bar {
 }
 
 // Validate validates the given configuration.
-func (c *Bar) Validate() {
+func (c *Bar) Validate() error {
 	c.XAxisList[0].Data = c.xAxisData
 	if c.isXYReversal {
 		c.YAxisList[0].Data = c.xAxisData
 		c.XAxisList[0].Data = nil
 	}
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
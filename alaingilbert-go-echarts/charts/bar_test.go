@@ -1,9 +1,14 @@
 package charts
 
 import (
+	"bytes"
 	"io/ioutil"
+	"strings"
 	"testing"
 
+	_ "github.com/bygui86/go-csv-view/alaingilbert-go-echarts/assets/inline"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -30,3 +35,25 @@ func TestBarDefaultValue(t *testing.T) {
 	assert.Equal(t, "Awesome go-echarts", bar.PageTitle)
 	assert.Equal(t, host, bar.AssetsHost)
 }
+
+func TestBarInlineAssets(t *testing.T) {
+	bar := NewBar()
+	bar.SetGlobalOptions(WithInitializationOpts(opts.Initialization{Theme: types.ThemeChalk, InlineAssets: true}))
+	bar.AddCustomizedJSAssets("https://example.com/custom.js")
+
+	var buf bytes.Buffer
+	assert.NoError(t, bar.Render(&buf))
+	html := buf.String()
+	assert.NotContains(t, html, host)
+	assert.Contains(t, html, `<script src="https://example.com/custom.js"></script>`)
+	assert.Equal(t, 2, strings.Count(html, `<script src="data:text/javascript;base64,`))
+	assert.Equal(t, 3, strings.Count(html, "<script src="))
+}
+
+func TestBarInlineAssetsMissing(t *testing.T) {
+	bar := NewBar()
+	bar.SetGlobalOptions(WithInitializationOpts(opts.Initialization{InlineAssets: true}))
+	bar.JSAssets.Add("missing.js")
+
+	assert.Error(t, bar.Render(ioutil.Discard))
+}
@@ -59,9 +59,17 @@ type BaseConfiguration struct {
 	hasParallel   bool
 	hasSingleAxis bool
 	hasPolar      bool
+
+	// issues found while setting the options, see Validate
+	issues []Issue
+
+	// pageIndex is the index of the chart on its page and pageIDStrategy the strategy of the page, see SetPage
+	pageIndex      int
+	pageIDStrategy opts.ChartIDStrategy
 }
 
-// JSON wraps all the options to a map so that it could be used in the base template
+// JSON wraps all the options to a map so that it could be used in the base template,
+// which marshals it with sorted keys: the same options always give the same JSON.
 //
 // Get data in bytes
 // bs, _ : = json.Marshal(bar.JSON())
@@ -136,6 +144,14 @@ func (bc *BaseConfiguration) JSON() map[string]interface{} {
 	return obj
 }
 
+// SetPage sets the index of the chart on its page and the chart ID strategy of the page, from which its ID is
+// derived when rendering, see opts.Initialization.DeriveChartID. Page.AddCharts and Page.Validate set them.
+func (bc *BaseConfiguration) SetPage(index int, strategy opts.ChartIDStrategy) {
+	bc.pageIndex = index
+	bc.pageIDStrategy = strategy
+	bc.Initialization.DeriveChartID(bc.Title.Title, bc.pageIndex, bc.pageIDStrategy)
+}
+
 // GetAssets returns the Assets options
 func (bc *BaseConfiguration) GetAssets() opts.Assets {
 	return bc.Assets
@@ -148,11 +164,14 @@ func (bc *BaseConfiguration) initBaseConfiguration() {
 	bc.Initialization.Validate()
 }
 
+// DefaultColors is the echarts default palette, the colors of the series when none are set.
+var DefaultColors = []string{
+	"#5470c6", "#91cc75", "#fac858", "#ee6666", "#73c0de",
+	"#3ba272", "#fc8452", "#9a60b4", "#ea7ccc",
+}
+
 func (bc *BaseConfiguration) initSeriesColors() {
-	bc.Colors = []string{
-		"#5470c6", "#91cc75", "#fac858", "#ee6666", "#73c0de",
-		"#3ba272", "#fc8452", "#9a60b4", "#ea7ccc",
-	}
+	bc.Colors = append([]string(nil), DefaultColors...)
 }
 
 func (bc *BaseConfiguration) insertSeriesColors(colors []string) {
@@ -239,6 +258,13 @@ func WithInitializationOpts(opt opts.Initialization) GlobalOpts {
 	}
 }
 
+// WithDatasetOpts
+func WithDatasetOpts(opt opts.Dataset) GlobalOpts {
+	return func(bc *BaseConfiguration) {
+		bc.Dataset = opt
+	}
+}
+
 // WithDataZoomOpts
 func WithDataZoomOpts(opt ...opts.DataZoom) GlobalOpts {
 	return func(bc *BaseConfiguration) {
@@ -0,0 +1,82 @@
+package charts
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/components"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPage() *components.Page {
+	prices := NewLine()
+	prices.SetGlobalOptions(
+		WithTitleOpts(opts.Title{Title: "prices"}),
+		WithTooltipOpts(opts.Tooltip{Show: true, Formatter: opts.FuncOpts("function (p) { return p.value; }")}),
+	)
+	prices.SetXAxis([]string{"a", "b"}).AddSeries("prices", []opts.LineData{{Value: 1}, {Value: 2}})
+
+	volumes := NewBar()
+	volumes.SetGlobalOptions(WithTitleOpts(opts.Title{Title: "volumes"}))
+	volumes.SetXAxis([]string{"a", "b"}).AddSeries("volumes", []opts.BarData{{Value: 3}, {Value: 4}})
+
+	return components.NewPage().AddCharts(prices, volumes, NewPie())
+}
+
+func TestReproducibleRender(t *testing.T) {
+	var first, second bytes.Buffer
+	// the page strategy applies to the charts added before it is set
+	page := newPage().SetChartIDStrategy(opts.HashChartID)
+	assert.NoError(t, page.Render(&first))
+	assert.NoError(t, newPage().SetChartIDStrategy(opts.HashChartID).Render(&second))
+	assert.Equal(t, first.String(), second.String())
+
+	line := page.Charts[0].(*Line)
+	assert.Equal(t, opts.HashChartID("prices", 0), line.ChartID)
+	assert.Equal(t, opts.HashChartID("volumes", 1), page.Charts[1].(*Bar).ChartID)
+	assert.Equal(t, opts.HashChartID("", 2), page.Charts[2].(*Pie).ChartID)
+	assert.Contains(t, first.String(), `id="`+line.ChartID+`"`)
+
+	line.SetGlobalOptions(WithTitleOpts(opts.Title{Title: "close prices"}))
+	first.Reset()
+	assert.NoError(t, page.Render(&first))
+	assert.Equal(t, opts.HashChartID("close prices", 0), line.ChartID)
+
+	bar := NewBar()
+	bar.SetGlobalOptions(WithInitializationOpts(opts.Initialization{ChartID: "volumes"}))
+	bar.SetXAxis([]string{"a"}).AddSeries("volumes", []opts.BarData{{Value: 3}})
+	assert.NoError(t, components.NewPage().SetChartIDStrategy(opts.HashChartID).AddCharts(bar).Render(&first))
+	assert.Equal(t, "volumes", bar.ChartID)
+}
+
+func TestChartIDStrategy(t *testing.T) {
+	fixed := func(id string) opts.ChartIDStrategy {
+		return func(title string, index int) string { return id + title }
+	}
+
+	// the strategy of a chart rendered alone
+	line := NewLine()
+	line.SetGlobalOptions(
+		WithInitializationOpts(opts.Initialization{ChartIDStrategy: fixed("chart")}),
+		WithTitleOpts(opts.Title{Title: "prices"}),
+	)
+	line.SetXAxis([]string{"a"}).AddSeries("prices", []opts.LineData{{Value: 1}})
+	assert.NoError(t, line.Render(&bytes.Buffer{}))
+	assert.Equal(t, "chartprices", line.ChartID)
+
+	// the strategy of a chart takes precedence over the page strategy, which takes precedence over the default
+	opts.SetChartIDStrategy(fixed("default"))
+	defer opts.SetChartIDStrategy(nil)
+	bar := NewBar()
+	bar.SetXAxis([]string{"a"}).AddSeries("volumes", []opts.BarData{{Value: 3}})
+	page := components.NewPage().SetChartIDStrategy(fixed("page")).AddCharts(line, bar)
+	assert.NoError(t, page.Render(&bytes.Buffer{}))
+	assert.Equal(t, "chartprices", line.ChartID)
+	assert.Equal(t, "page", bar.ChartID)
+
+	alone := NewBar()
+	alone.SetXAxis([]string{"a"}).AddSeries("volumes", []opts.BarData{{Value: 3}})
+	assert.NoError(t, alone.Render(&bytes.Buffer{}))
+	assert.Equal(t, "default", alone.ChartID)
+}
@@ -38,7 +38,8 @@ func (c *BoxPlot) AddSeries(name string, data []opts.BoxPlotData, options ...Ser
 }
 
 // Validate validates the given configuration.
-func (c *BoxPlot) Validate() {
+func (c *BoxPlot) Validate() error {
 	c.XAxisList[0].Data = c.xAxisData
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
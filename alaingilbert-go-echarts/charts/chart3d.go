@@ -61,6 +61,7 @@ func (c *Chart3D) SetGlobalOptions(options ...GlobalOpts) *Chart3D {
 }
 
 // Validate
-func (c *Chart3D) Validate() {
+func (c *Chart3D) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(types.ChartCartesian3D)
 }
@@ -0,0 +1,45 @@
+package charts
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EncodeError lists the series whose Encode references columns missing from the dataset.
+type EncodeError struct {
+	Series []SeriesEncodeError
+}
+
+// SeriesEncodeError is the mismatch between the Encode of a series and the dataset.
+type SeriesEncodeError struct {
+	Index int
+	Name  string
+	Err   error
+}
+
+func (e *EncodeError) Error() string {
+	mismatches := make([]string, 0, len(e.Series))
+	for _, s := range e.Series {
+		mismatches = append(mismatches, fmt.Sprintf("series %d (%s): %s", s.Index, s.Name, s.Err))
+	}
+	return "encode does not match the dataset: " + strings.Join(mismatches, "; ")
+}
+
+// ValidateDataset checks that the columns referenced by index or by name in the Encode of the series
+// exist in the dataset, the returned *EncodeError lists the mismatched series.
+func (bc *BaseConfiguration) ValidateDataset() error {
+	e := &EncodeError{}
+	columns := bc.Dataset.ColumnSet()
+	for i, s := range bc.MultiSeries {
+		if s.Encode == nil {
+			continue
+		}
+		if err := columns.ValidateEncode(s.Encode); err != nil {
+			e.Series = append(e.Series, SeriesEncodeError{Index: i, Name: s.Name, Err: err})
+		}
+	}
+	if len(e.Series) > 0 {
+		return e
+	}
+	return nil
+}
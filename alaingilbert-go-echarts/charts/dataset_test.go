@@ -0,0 +1,32 @@
+package charts
+
+import (
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDataset(t *testing.T) {
+	kline := NewKLine()
+	kline.SetGlobalOptions(WithDatasetOpts(opts.Dataset{Source: [][]interface{}{
+		{"TIME", "OPEN", "CLOSE", "LOW", "HIGH", "VOLUME"},
+		{"10:00", 10, 12, 9, 13, 5},
+	}}))
+	kline.AddSeries("BTCUSDT", nil, WithEncodeOpts(opts.Encode{X: "TIME", Y: []string{"OPEN", "CLOSE", "LOW", "HIGH"}}))
+	kline.AddSeries("volume", nil, WithEncodeOpts(opts.Encode{X: "TIME", Y: "VOLUME"}))
+	assert.NoError(t, kline.ValidateDataset())
+
+	kline.AddSeries("RSI", nil, WithEncodeOpts(opts.Encode{X: 0, Y: 13}))
+	kline.AddSeries("EMA", nil, WithEncodeOpts(opts.Encode{X: "TIME", Y: "EMA10"}))
+
+	err := kline.ValidateDataset()
+	encodeErr := &EncodeError{}
+	if assert.ErrorAs(t, err, &encodeErr) {
+		assert.Len(t, encodeErr.Series, 2)
+		assert.Equal(t, 2, encodeErr.Series[0].Index)
+		assert.Equal(t, "EMA", encodeErr.Series[1].Name)
+	}
+	assert.EqualError(t, err, `encode does not match the dataset: `+
+		`series 2 (RSI): column 13 out of range, the dataset has 6 columns; series 3 (EMA): column "EMA10" not found`)
+}
@@ -38,7 +38,8 @@ func (c *EffectScatter) AddSeries(name string, data []opts.EffectScatterData, op
 }
 
 // Validate validates the given configuration.
-func (c *EffectScatter) Validate() {
+func (c *EffectScatter) Validate() error {
 	c.XAxisList[0].Data = c.xAxisData
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
@@ -37,6 +37,7 @@ func (c *Funnel) SetGlobalOptions(options ...GlobalOpts) *Funnel {
 }
 
 // Validate
-func (c *Funnel) Validate() {
+func (c *Funnel) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
@@ -37,6 +37,7 @@ func (c *Gauge) SetGlobalOptions(options ...GlobalOpts) *Gauge {
 }
 
 // Validate
-func (c *Gauge) Validate() {
+func (c *Gauge) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
@@ -60,9 +60,10 @@ func (c *Geo) SetGlobalOptions(options ...GlobalOpts) *Geo {
 }
 
 // Validate
-func (c *Geo) Validate() {
+func (c *Geo) Validate() error {
 	if c.Tooltip.Formatter == "" {
 		c.Tooltip.Formatter = opts.FuncOpts(geoFormatter)
 	}
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
@@ -35,7 +35,7 @@ func (c *Graph) SetGlobalOptions(options ...GlobalOpts) *Graph {
 }
 
 // Validate validates the given configuration.
-func (c *Graph) Validate() {
+func (c *Graph) Validate() error {
 	// If there is no layout setting, default layout is set to "force".
 	for i := 0; i < len(c.MultiSeries); i++ {
 		if c.MultiSeries[i].Layout == "" {
@@ -43,4 +43,5 @@ func (c *Graph) Validate() {
 		}
 	}
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
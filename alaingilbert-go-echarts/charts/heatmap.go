@@ -38,6 +38,7 @@ func (c *HeatMap) AddSeries(name string, data []opts.HeatMapData, options ...Ser
 }
 
 // Validate
-func (c *HeatMap) Validate() {
+func (c *HeatMap) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
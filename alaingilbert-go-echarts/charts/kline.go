@@ -38,7 +38,8 @@ func (c *Kline) AddSeries(name string, data []opts.KlineData, options ...SeriesO
 }
 
 // Validate
-func (c *Kline) Validate() {
+func (c *Kline) Validate() error {
 	c.XAxisList[0].Data = c.xAxisData
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
@@ -38,7 +38,8 @@ func (c *Line) AddSeries(name string, data []opts.LineData, options ...SeriesOpt
 }
 
 // Validate validates the given configuration.
-func (c *Line) Validate() {
+func (c *Line) Validate() error {
 	c.XAxisList[0].Data = c.xAxisData
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
@@ -38,6 +38,7 @@ func (c *Liquid) SetGlobalOptions(options ...GlobalOpts) *Liquid {
 }
 
 // Validate
-func (c *Liquid) Validate() {
+func (c *Liquid) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
@@ -46,6 +46,7 @@ func (c *Map) SetGlobalOptions(options ...GlobalOpts) *Map {
 }
 
 // Validate
-func (c *Map) Validate() {
+func (c *Map) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
@@ -38,6 +38,7 @@ func (c *Parallel) SetGlobalOptions(options ...GlobalOpts) *Parallel {
 }
 
 // Validate
-func (c *Parallel) Validate() {
+func (c *Parallel) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
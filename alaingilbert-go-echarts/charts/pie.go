@@ -37,6 +37,7 @@ func (c *Pie) SetGlobalOptions(options ...GlobalOpts) *Pie {
 }
 
 // Validate
-func (c *Pie) Validate() {
+func (c *Pie) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
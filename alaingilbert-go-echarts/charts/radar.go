@@ -39,7 +39,8 @@ func (c *Radar) SetGlobalOptions(options ...GlobalOpts) *Radar {
 }
 
 // Validate
-func (c *Radar) Validate() {
+func (c *Radar) Validate() error {
 	c.Legend.Data = c.legends
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
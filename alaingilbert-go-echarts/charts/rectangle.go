@@ -1,6 +1,8 @@
 package charts
 
 import (
+	"fmt"
+
 	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
 )
 
@@ -36,6 +38,10 @@ func WithXAxisOpts(opt opts.XAxis, index ...int) GlobalOpts {
 			index = []int{0}
 		}
 		for i := 0; i < len(index); i++ {
+			if index[i] < 0 || index[i] >= len(bc.XYAxis.XAxisList) {
+				bc.addIssue(fmt.Sprintf("xAxis[%d]", index[i]), "options set on a missing axis, the chart has %d x axes, see ExtendXAxis", len(bc.XYAxis.XAxisList))
+				continue
+			}
 			bc.XYAxis.XAxisList[index[i]] = opt
 		}
 	}
@@ -48,6 +54,10 @@ func WithYAxisOpts(opt opts.YAxis, index ...int) GlobalOpts {
 			index = []int{0}
 		}
 		for i := 0; i < len(index); i++ {
+			if index[i] < 0 || index[i] >= len(bc.XYAxis.YAxisList) {
+				bc.addIssue(fmt.Sprintf("yAxis[%d]", index[i]), "options set on a missing axis, the chart has %d y axes, see ExtendYAxis", len(bc.XYAxis.YAxisList))
+				continue
+			}
 			bc.XYAxis.YAxisList[index[i]] = opt
 		}
 	}
@@ -89,12 +99,16 @@ func (rc *RectChart) Overlap(a ...Overlaper) {
 }
 
 // Validate
-func (rc *RectChart) Validate() {
+func (rc *RectChart) Validate() error {
 	// Make sure that the data of X axis won't be cleaned for XAxisOpts
 	rc.XAxisList[0].Data = rc.xAxisData
 	// Make sure that the labels of Y axis show correctly
 	for i := 0; i < len(rc.YAxisList); i++ {
+		if rc.YAxisList[i].AxisLabel == nil {
+			rc.YAxisList[i].AxisLabel = &opts.AxisLabel{}
+		}
 		rc.YAxisList[i].AxisLabel.Show = true
 	}
 	rc.Assets.Validate(rc.AssetsHost)
+	return rc.validate("rect")
 }
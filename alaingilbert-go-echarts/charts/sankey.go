@@ -37,6 +37,7 @@ func (c *Sankey) SetGlobalOptions(options ...GlobalOpts) *Sankey {
 }
 
 // Validate
-func (c *Sankey) Validate() {
+func (c *Sankey) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
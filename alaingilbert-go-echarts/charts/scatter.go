@@ -38,7 +38,8 @@ func (c *Scatter) AddSeries(name string, data []opts.ScatterData, options ...Ser
 }
 
 // Validate validates the given configuration.
-func (c *Scatter) Validate() {
+func (c *Scatter) Validate() error {
 	c.XAxisList[0].Data = c.xAxisData
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
@@ -37,6 +37,7 @@ func (c *Sunburst) SetGlobalOptions(options ...GlobalOpts) *Sunburst {
 }
 
 // Validate validates the given configuration.
-func (c *Sunburst) Validate() {
+func (c *Sunburst) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
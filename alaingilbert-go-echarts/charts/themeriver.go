@@ -42,6 +42,7 @@ func (c *ThemeRiver) SetGlobalOptions(options ...GlobalOpts) *ThemeRiver {
 }
 
 // Validate
-func (c *ThemeRiver) Validate() {
+func (c *ThemeRiver) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
@@ -36,6 +36,7 @@ func (c *Tree) SetGlobalOptions(options ...GlobalOpts) *Tree {
 }
 
 // Validate validates the given configuration.
-func (c *Tree) Validate() {
+func (c *Tree) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
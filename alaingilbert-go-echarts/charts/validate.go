@@ -0,0 +1,180 @@
+package charts
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Issue is a problem found in the options of a chart.
+// Option is the path of the faulty option in the echarts configuration, like "series[1].yAxisIndex".
+type Issue struct {
+	Option  string
+	Message string
+}
+
+// ValidationError lists the issues found by the validation of a chart, which would otherwise render blank
+// or broken in the browser: indexes of axes, grids and data zooms out of range, series referencing missing
+// axes or dataset columns, visual maps beyond the dataset and series without data.
+type ValidationError struct {
+	Chart  string
+	Issues []Issue
+}
+
+func (e *ValidationError) Error() string {
+	issues := make([]string, 0, len(e.Issues))
+	for _, i := range e.Issues {
+		issues = append(issues, i.Option+": "+i.Message)
+	}
+	return fmt.Sprintf("%s chart not valid: %s", e.Chart, strings.Join(issues, "; "))
+}
+
+// addIssue records an issue found while setting the options, reported by Validate
+func (bc *BaseConfiguration) addIssue(option, format string, a ...interface{}) {
+	bc.issues = append(bc.issues, Issue{Option: option, Message: fmt.Sprintf(format, a...)})
+}
+
+// validate checks the options shared by the charts, returning a *ValidationError listing the issues.
+// It also derives the ID of the chart, see SetPage.
+func (bc *BaseConfiguration) validate(chart string) error {
+	bc.Initialization.DeriveChartID(bc.Title.Title, bc.pageIndex, bc.pageIDStrategy)
+
+	issues := append([]Issue{}, bc.issues...)
+	if bc.hasXYAxis {
+		issues = append(issues, bc.validateXYAxis()...)
+	}
+	issues = append(issues, bc.validateSeries()...)
+	issues = append(issues, bc.validateVisualMaps()...)
+
+	if len(issues) > 0 {
+		return &ValidationError{Chart: chart, Issues: issues}
+	}
+	return nil
+}
+
+// validateXYAxis checks the grids of the axes and the axes of the series and of the data zooms
+func (bc *BaseConfiguration) validateXYAxis() []Issue {
+	var issues []Issue
+	grids := len(bc.GridList)
+	if grids == 0 {
+		grids = 1
+	}
+	for i, x := range bc.XAxisList {
+		if x.GridIndex < 0 || x.GridIndex >= grids {
+			issues = append(issues, Issue{fmt.Sprintf("xAxis[%d].gridIndex", i), fmt.Sprintf("grid %d not found, the chart has %d grids", x.GridIndex, grids)})
+		}
+	}
+	for i, y := range bc.YAxisList {
+		if y.GridIndex < 0 || y.GridIndex >= grids {
+			issues = append(issues, Issue{fmt.Sprintf("yAxis[%d].gridIndex", i), fmt.Sprintf("grid %d not found, the chart has %d grids", y.GridIndex, grids)})
+		}
+	}
+
+	for i, s := range bc.MultiSeries {
+		if s.XAxisIndex < 0 || s.XAxisIndex >= len(bc.XAxisList) {
+			issues = append(issues, Issue{fmt.Sprintf("series[%d].xAxisIndex", i), fmt.Sprintf("x axis %d not found, the chart has %d x axes, see ExtendXAxis", s.XAxisIndex, len(bc.XAxisList))})
+		}
+		if s.YAxisIndex < 0 || s.YAxisIndex >= len(bc.YAxisList) {
+			issues = append(issues, Issue{fmt.Sprintf("series[%d].yAxisIndex", i), fmt.Sprintf("y axis %d not found, the chart has %d y axes, see ExtendYAxis", s.YAxisIndex, len(bc.YAxisList))})
+		}
+	}
+
+	for i, dz := range bc.DataZoomList {
+		issues = append(issues, axisIndexIssues(fmt.Sprintf("dataZoom[%d].xAxisIndex", i), dz.XAxisIndex, "x", len(bc.XAxisList))...)
+		issues = append(issues, axisIndexIssues(fmt.Sprintf("dataZoom[%d].yAxisIndex", i), dz.YAxisIndex, "y", len(bc.YAxisList))...)
+	}
+	return issues
+}
+
+// axisIndexIssues checks the axis indexes of a data zoom: an index or a list of indexes
+func axisIndexIssues(option string, index interface{}, name string, axes int) []Issue {
+	if index == nil {
+		return nil
+	}
+
+	var indexes []interface{}
+	v := reflect.ValueOf(index)
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		for i := 0; i < v.Len(); i++ {
+			indexes = append(indexes, v.Index(i).Interface())
+		}
+	} else {
+		indexes = []interface{}{index}
+	}
+
+	var issues []Issue
+	for _, i := range indexes {
+		n, ok := axisIndex(i)
+		if !ok {
+			issues = append(issues, Issue{option, fmt.Sprintf("%v is not an axis index", i)})
+			continue
+		}
+		if n < 0 || n >= axes {
+			issues = append(issues, Issue{option, fmt.Sprintf("%s axis %d not found, the chart has %d %s axes", name, n, axes, name)})
+		}
+	}
+	return issues
+}
+
+func axisIndex(v interface{}) (int, bool) {
+	switch i := v.(type) {
+	case int:
+		return i, true
+	case int32:
+		return int(i), true
+	case int64:
+		return int(i), true
+	case float64:
+		return int(i), float64(int(i)) == i
+	}
+	return 0, false
+}
+
+// validateSeries checks that the series have data, their own or the dataset rows, and that their encode
+// matches the dataset
+func (bc *BaseConfiguration) validateSeries() []Issue {
+	var issues []Issue
+	_, columns := bc.Dataset.Columns()
+	for i, s := range bc.MultiSeries {
+		if isEmpty(s.Data) && columns == 0 {
+			issues = append(issues, Issue{fmt.Sprintf("series[%d].data", i), fmt.Sprintf("series %q has no data", s.Name)})
+		}
+	}
+
+	if err := bc.ValidateDataset(); err != nil {
+		for _, s := range err.(*EncodeError).Series {
+			issues = append(issues, Issue{fmt.Sprintf("series[%d].encode", s.Index), s.Err.Error()})
+		}
+	}
+	return issues
+}
+
+// validateVisualMaps checks the series and the dataset column of the visual maps
+func (bc *BaseConfiguration) validateVisualMaps() []Issue {
+	var issues []Issue
+	_, columns := bc.Dataset.Columns()
+	for i, vm := range bc.VisualMapList {
+		if vm.SeriesIndex < 0 || vm.SeriesIndex >= len(bc.MultiSeries) {
+			issues = append(issues, Issue{fmt.Sprintf("visualMap[%d].seriesIndex", i), fmt.Sprintf("series %d not found, the chart has %d series", vm.SeriesIndex, len(bc.MultiSeries))})
+		}
+		if columns > 0 && (vm.Dimension < 0 || vm.Dimension >= columns) {
+			issues = append(issues, Issue{fmt.Sprintf("visualMap[%d].dimension", i), fmt.Sprintf("dimension %d beyond the %d columns of the dataset", vm.Dimension, columns)})
+		}
+	}
+	return issues
+}
+
+// isEmpty tells if the data of a series is nil or an empty list
+func isEmpty(data interface{}) bool {
+	if data == nil {
+		return true
+	}
+	v := reflect.ValueOf(data)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
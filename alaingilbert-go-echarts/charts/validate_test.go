@@ -0,0 +1,96 @@
+package charts
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/components"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/stretchr/testify/assert"
+)
+
+func issues(t *testing.T, err error) []Issue {
+	validationErr := &ValidationError{}
+	if !assert.ErrorAs(t, err, &validationErr) {
+		return nil
+	}
+	return validationErr.Issues
+}
+
+func TestValidateAxes(t *testing.T) {
+	line := NewLine()
+	line.SetGlobalOptions(
+		WithXAxisOpts(opts.XAxis{Name: "time"}, 0, 2),
+		WithYAxisOpts(opts.YAxis{GridIndex: 1}),
+		WithDataZoomOpts(opts.DataZoom{XAxisIndex: []int{0, 1}}, opts.DataZoom{YAxisIndex: "all"}),
+	)
+	line.SetXAxis([]string{"a", "b"}).
+		AddSeries("prices", []opts.LineData{{Value: 1}, {Value: 2}}).
+		AddSeries("volumes", []opts.LineData{{Value: 3}, {Value: 4}}, func(s *SingleSeries) { s.YAxisIndex = 1 })
+
+	err := line.Validate()
+	assert.Equal(t, []Issue{
+		{"xAxis[2]", "options set on a missing axis, the chart has 1 x axes, see ExtendXAxis"},
+		{"yAxis[0].gridIndex", "grid 1 not found, the chart has 1 grids"},
+		{"series[1].yAxisIndex", "y axis 1 not found, the chart has 1 y axes, see ExtendYAxis"},
+		{"dataZoom[0].xAxisIndex", "x axis 1 not found, the chart has 1 x axes"},
+		{"dataZoom[1].yAxisIndex", "all is not an axis index"},
+	}, issues(t, err))
+	assert.Equal(t, "time", line.XAxisList[0].Name)
+	assert.Contains(t, err.Error(), "line chart not valid: xAxis[2]: options set on a missing axis")
+
+	assert.Equal(t, err, line.Render(ioutil.Discard))
+}
+
+func TestValidateData(t *testing.T) {
+	bar := NewBar()
+	bar.AddSeries("empty", nil)
+	assert.Equal(t, []Issue{{"series[0].data", `series "empty" has no data`}}, issues(t, bar.Validate()))
+
+	kline := NewKLine()
+	kline.SetGlobalOptions(
+		WithDatasetOpts(opts.Dataset{Source: [][]interface{}{
+			{"TIME", "OPEN", "CLOSE", "LOW", "HIGH"},
+			{"10:00", 10, 12, 9, 13},
+		}}),
+		WithVisualMapOpts(opts.VisualMap{SeriesIndex: 0, Dimension: 4}),
+	)
+	kline.AddSeries("candles", nil, WithEncodeOpts(opts.Encode{X: "TIME", Y: []string{"OPEN", "CLOSE", "LOW", "HIGH"}}))
+	assert.NoError(t, kline.Validate())
+
+	kline.SetGlobalOptions(WithVisualMapOpts(opts.VisualMap{SeriesIndex: 1, Dimension: 5}))
+	kline.AddSeries("volume", nil, WithEncodeOpts(opts.Encode{X: "TIME", Y: "VOLUME"}))
+	assert.Equal(t, []Issue{
+		{"series[1].encode", `column "VOLUME" not found`},
+		{"visualMap[1].dimension", "dimension 5 beyond the 5 columns of the dataset"},
+	}, issues(t, kline.Validate()))
+}
+
+func TestWithAxisOptsOutOfRange(t *testing.T) {
+	bar := NewBar()
+	assert.NotPanics(t, func() {
+		bar.SetGlobalOptions(WithXAxisOpts(opts.XAxis{}, 1), WithYAxisOpts(opts.YAxis{}, -1))
+	})
+	assert.Len(t, issues(t, bar.Validate()), 2)
+
+	bar = NewBar()
+	bar.ExtendYAxis(opts.YAxis{})
+	bar.SetGlobalOptions(WithYAxisOpts(opts.YAxis{Name: "right"}, 1))
+	assert.NoError(t, bar.Validate())
+	assert.Equal(t, "right", bar.YAxisList[1].Name)
+}
+
+func TestPageRenderValidation(t *testing.T) {
+	valid := NewBar()
+	valid.SetXAxis([]string{"a"}).AddSeries("a", []opts.BarData{{Value: 1}})
+	broken := NewLine()
+	broken.AddSeries("b", []opts.LineData{{Value: 1}}, func(s *SingleSeries) { s.XAxisIndex = 1 })
+
+	page := components.NewPage()
+	page.AddCharts(valid, broken)
+	err := page.Render(ioutil.Discard)
+	assert.EqualError(t, err, "chart 1: line chart not valid: series[0].xAxisIndex: x axis 1 not found, the chart has 1 x axes, see ExtendXAxis")
+	assert.Len(t, issues(t, err), 1)
+
+	assert.NoError(t, components.NewPage().AddCharts(valid).Render(ioutil.Discard))
+}
@@ -54,6 +54,7 @@ func (c *WordCloud) SetGlobalOptions(options ...GlobalOpts) *WordCloud {
 }
 
 // Validate
-func (c *WordCloud) Validate() {
+func (c *WordCloud) Validate() error {
 	c.Assets.Validate(c.AssetsHost)
+	return c.validate(c.Type())
 }
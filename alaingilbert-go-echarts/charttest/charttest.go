@@ -0,0 +1,112 @@
+// Package charttest compares the options of charts with golden files checked in testdata, for the
+// regression tests of the programs building charts.
+//
+// The golden file of a chart holds its option JSON, as given to echarts by Render, indented and with the
+// map keys sorted. Run the tests with -update to write the golden files from the current charts:
+//
+//	go test ./... -update
+package charttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "write the golden files of the charts instead of comparing them")
+
+// Dir is the directory of the golden files, relative to the package under test.
+const Dir = "testdata"
+
+// Chart is a chart giving its options, like the charts of this module and of go-echarts.
+type Chart interface {
+	JSON() map[string]interface{}
+}
+
+// funcMarkers are the markers around the JS functions in the options, see opts.FuncOpts
+var funcMarkers = regexp.MustCompile(`__f__`)
+
+// JSON returns the option JSON of a chart, validated like Render does, indented with sorted map keys.
+// The markers of the JS functions are removed, leaving the function code as a string.
+func JSON(chart Chart) ([]byte, error) {
+	switch c := chart.(type) {
+	case interface{ Validate() error }:
+		if err := c.Validate(); err != nil {
+			return nil, err
+		}
+	case interface{ Validate() }:
+		c.Validate()
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(chart.JSON()); err != nil {
+		return nil, err
+	}
+	return funcMarkers.ReplaceAll(buf.Bytes(), nil), nil
+}
+
+// Path returns the path of the golden file of the given name.
+func Path(name string) string {
+	return filepath.Join(Dir, name+".golden.json")
+}
+
+// AssertGolden checks that the option JSON of a chart matches the golden file of the given name, or writes
+// the golden file when the tests run with -update.
+func AssertGolden(t testing.TB, name string, chart Chart) bool {
+	t.Helper()
+
+	got, err := JSON(chart)
+	if !assert.NoError(t, err, "chart %s", name) {
+		return false
+	}
+
+	path := Path(name)
+	if *update {
+		if err := os.MkdirAll(Dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return true
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Errorf("golden file of chart %s not readable, run the tests with -update to write it: %v", name, err)
+		return false
+	}
+	return assert.Equal(t, string(want), string(got), "chart %s differs from %s, run the tests with -update to accept the changes", name, path)
+}
+
+// HeadCSV copies the header and the first rows of a CSV file to a temporary file and returns its path, for
+// charts of a few rows, small enough to review their golden file.
+func HeadCSV(t testing.TB, path string, rows int) string {
+	t.Helper()
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.SplitAfterN(string(content), "\n", rows+2)
+	if len(lines) > rows+1 {
+		lines = lines[:rows+1]
+	}
+
+	headPath := filepath.Join(t.TempDir(), filepath.Base(path))
+	if err := ioutil.WriteFile(headPath, []byte(strings.Join(lines, "")), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return headPath
+}
@@ -0,0 +1,80 @@
+package charttest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder records the failures of AssertGolden
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func newLine(title string) *charts.Line {
+	line := charts.NewLine()
+	line.SetGlobalOptions(
+		charts.WithTitleOpts(opts.Title{Title: title}),
+		charts.WithTooltipOpts(opts.Tooltip{Show: true, Formatter: opts.FuncOpts("function (params) {\n\treturn params.value;\n}")}),
+	)
+	line.SetXAxis([]string{"a", "b"}).
+		AddSeries("prices", []opts.LineData{{Value: 1}, {Value: 2.5}})
+	return line
+}
+
+func TestJSON(t *testing.T) {
+	b, err := JSON(newLine("<prices>"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"formatter": "function (params) {return params.value;}"`)
+	assert.Contains(t, string(b), `"text": "<prices>"`)
+
+	broken := charts.NewLine()
+	broken.AddSeries("empty", nil)
+	_, err = JSON(broken)
+	assert.EqualError(t, err, `line chart not valid: series[0].data: series "empty" has no data`)
+}
+
+func TestAssertGolden(t *testing.T) {
+	assert.True(t, AssertGolden(t, "line", newLine("prices")))
+	if *update {
+		return
+	}
+
+	r := &recorder{TB: t}
+	assert.False(t, AssertGolden(r, "line", newLine("volumes")))
+	if assert.Len(t, r.errors, 1) {
+		assert.Contains(t, r.errors[0], `-    "text": "prices"`)
+		assert.Contains(t, r.errors[0], `+    "text": "volumes"`)
+	}
+
+	r = &recorder{TB: t}
+	assert.False(t, AssertGolden(r, "missing", newLine("prices")))
+	assert.Len(t, r.errors, 1)
+}
+
+func TestHeadCSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.csv")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("TIME,PRICE\n1,10\n2,11\n3,12\n"), 0644))
+
+	for rows, want := range map[int]string{
+		0:  "TIME,PRICE\n",
+		2:  "TIME,PRICE\n1,10\n2,11\n",
+		10: "TIME,PRICE\n1,10\n2,11\n3,12\n",
+	} {
+		content, err := ioutil.ReadFile(HeadCSV(t, path, rows))
+		assert.NoError(t, err)
+		assert.Equal(t, want, string(content), rows)
+	}
+}
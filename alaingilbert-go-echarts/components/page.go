@@ -1,6 +1,8 @@
 package components
 
 import (
+	"fmt"
+
 	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
 	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/render"
 )
@@ -17,7 +19,13 @@ const (
 type Charter interface {
 	Type() string
 	GetAssets() opts.Assets
-	Validate()
+	// Validate prepares the options for rendering, returning the issues which would break the chart
+	Validate() error
+}
+
+// pagedChart is implemented by the charts embedding charts.BaseConfiguration, whose ID derives from the page
+type pagedChart interface {
+	SetPage(index int, strategy opts.ChartIDStrategy)
 }
 
 // Page represents a page chart.
@@ -44,6 +52,13 @@ func (page *Page) SetLayout(layout Layout) *Page {
 	return page
 }
 
+// SetChartIDStrategy sets the strategy deriving the IDs of the charts of the page without their own strategy,
+// see opts.Initialization.ChartIDStrategy.
+func (page *Page) SetChartIDStrategy(strategy opts.ChartIDStrategy) *Page {
+	page.ChartIDStrategy = strategy
+	return page
+}
+
 // AddCharts adds new charts to the page.
 func (page *Page) AddCharts(charts ...Charter) *Page {
 	for i := 0; i < len(charts); i++ {
@@ -55,14 +70,29 @@ func (page *Page) AddCharts(charts ...Charter) *Page {
 		for _, v := range assets.CSSAssets.Values {
 			page.CSSAssets.Add(v)
 		}
-		charts[i].Validate()
+		if paged, ok := charts[i].(pagedChart); ok {
+			paged.SetPage(len(page.Charts), page.ChartIDStrategy)
+		}
+		// the issues of the chart are returned by Render, which validates the charts again
+		_ = charts[i].Validate()
 		page.Charts = append(page.Charts, charts[i])
 	}
 	return page
 }
 
-// Validate
-func (page *Page) Validate() {
+// Validate validates the page and its charts, returning the issues of the first chart not valid.
+func (page *Page) Validate() error {
 	page.Initialization.Validate()
 	page.Assets.Validate(page.AssetsHost)
+	for i, c := range page.Charts {
+		if paged, ok := c.(pagedChart); ok {
+			paged.SetPage(i, page.ChartIDStrategy)
+		}
+		if chart, ok := c.(Charter); ok {
+			if err := chart.Validate(); err != nil {
+				return fmt.Errorf("chart %d: %w", i, err)
+			}
+		}
+	}
+	return nil
 }
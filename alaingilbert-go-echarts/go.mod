@@ -2,7 +2,10 @@ module github.com/bygui86/go-csv-view/alaingilbert-go-echarts
 
 go 1.17
 
-require github.com/stretchr/testify v1.7.0
+require (
+	github.com/stretchr/testify v1.7.0
+	golang.org/x/image v0.18.0
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.0 // indirect
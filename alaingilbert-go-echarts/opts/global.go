@@ -1,21 +1,20 @@
 package opts
 
 import (
+	"errors"
 	"fmt"
+	"hash/fnv"
 	"math/rand"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/types"
 )
 
-func init() {
-	rand.Seed(time.Now().UnixNano())
-}
-
 // Initialization contains options for the canvas.
 type Initialization struct {
 	// HTML title
@@ -30,14 +29,32 @@ type Initialization struct {
 	// BackgroundColor of canvas
 	BackgroundColor string
 
-	// Chart unique ID
+	// Chart unique ID, generated when empty, see ChartIDStrategy
 	ChartID string
 
+	// ChartIDStrategy derives the generated ChartID from the title of the chart and its index on the page
+	// when it is rendered, see DeriveChartID. On a page, it is the strategy of the charts without their own.
+	ChartIDStrategy ChartIDStrategy `json:"-"`
+
 	// Assets host
 	AssetsHost string `default:"https://go-echarts.github.io/go-echarts-assets/assets/"`
 
+	// InlineAssets inlines the JS and CSS assets (echarts, themes, maps) in the rendered HTML, read from the
+	// assets registered with render.RegisterAssets instead of AssetsHost, to get a single self-contained
+	// file working offline. Import the assets/inline package to register the embedded assets.
+	// Customized assets are still linked.
+	InlineAssets bool
+
 	// Theme of chart
 	Theme string `default:"white"`
+
+	// generatedID is the ChartID generated by Validate, which a ChartIDStrategy may replace
+	generatedID string
+}
+
+// AssetsInlined tells the renderers to inline the assets, see InlineAssets.
+func (opt *Initialization) AssetsInlined() bool {
+	return opt.InlineAssets
 }
 
 // Validate validates the initialization configurations.
@@ -45,9 +62,31 @@ func (opt *Initialization) Validate() {
 	setDefaultValue(opt)
 	if opt.ChartID == "" {
 		opt.ChartID = generateUniqueID()
+		opt.generatedID = opt.ChartID
 	}
 }
 
+// DeriveChartID replaces the generated ChartID with the ID given by a strategy, from the title of the chart
+// and its index on the page. The strategy is the ChartIDStrategy of the chart, else the one of its page,
+// else the default set with SetChartIDStrategy. The IDs set by the caller are kept.
+func (opt *Initialization) DeriveChartID(title string, index int, page ChartIDStrategy) {
+	strategy := opt.ChartIDStrategy
+	if strategy == nil {
+		strategy = page
+	}
+	if strategy == nil {
+		chartIDs.Lock()
+		strategy = chartIDs.strategy
+		chartIDs.Unlock()
+	}
+
+	if strategy == nil || opt.ChartID == "" || opt.ChartID != opt.generatedID {
+		return
+	}
+	opt.ChartID = strategy(title, index)
+	opt.generatedID = opt.ChartID
+}
+
 // set default values for the struct field.
 // origin from: https://github.com/mcuadros/go-defaults
 func setDefaultValue(ptr interface{}) {
@@ -80,21 +119,68 @@ const (
 	chartIDSize = 12
 )
 
+// ChartIDStrategy returns the ID of a chart from its title and its index on the page, the index being 0 for
+// the charts rendered alone. The ID is used in the HTML and JS of the chart, made of letters and digits.
+type ChartIDStrategy func(title string, index int) string
+
+// chartIDs draws the unique IDs of the charts, seeded with the time unless SeedChartIDs is called,
+// and holds the default strategy replacing them when rendering
+var chartIDs = struct {
+	sync.Mutex
+	rand     *rand.Rand
+	strategy ChartIDStrategy
+}{rand: rand.New(rand.NewSource(time.Now().UnixNano()))}
+
+// SetChartIDStrategy sets the default strategy deriving the IDs of the charts without ChartID when they are
+// rendered, instead of the random IDs drawn at their creation, so that rendering the same charts gives the same
+// HTML. It applies to every chart of the process without its own strategy or a page strategy, see
+// Initialization.ChartIDStrategy, which libraries should prefer. A nil strategy restores the random IDs.
+func SetChartIDStrategy(strategy ChartIDStrategy) {
+	chartIDs.Lock()
+	defer chartIDs.Unlock()
+	chartIDs.strategy = strategy
+}
+
+// HashChartID is a ChartIDStrategy deriving the ID from a hash of the title and of the index of the chart,
+// the ID changes only when the chart is renamed or moved on its page.
+func HashChartID(title string, index int) string {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s/%d", title, index)
+	r := rand.New(rand.NewSource(int64(h.Sum64())))
+
+	var b [chartIDSize]byte
+	for i := range b {
+		b[i] = randByte(r)
+	}
+	return string(b[:])
+}
+
+// SeedChartIDs seeds the generator of the chart IDs, used when ChartID is empty, so that the charts
+// created afterwards get the same IDs at every run, for tests comparing rendered charts.
+func SeedChartIDs(seed int64) {
+	chartIDs.Lock()
+	defer chartIDs.Unlock()
+	chartIDs.rand = rand.New(rand.NewSource(seed))
+}
+
 // generate the unique ID for each chart.
 func generateUniqueID() string {
+	chartIDs.Lock()
+	defer chartIDs.Unlock()
+
 	var b [chartIDSize]byte
 	for i := range b {
-		b[i] = randByte()
+		b[i] = randByte(chartIDs.rand)
 	}
 	return string(b[:])
 }
 
-func randByte() byte {
+func randByte(r *rand.Rand) byte {
 	c := 65 // A
-	if rand.Intn(10) > 5 {
+	if r.Intn(10) > 5 {
 		c = 97 // a
 	}
-	return byte(c + rand.Intn(26))
+	return byte(c + r.Intn(26))
 }
 
 // Title is the option set for a title component.
@@ -798,8 +884,130 @@ type VisualMapInRange struct {
 
 // Dataset
 type Dataset struct {
-	// Source
+	// Source holds the rows of the dataset.
+	// Its first row is a header naming the columns when it holds only strings.
 	Source interface{} `json:"source"`
+
+	// Dimensions names the columns of a source without header row, taking precedence over the header.
+	// Series reference the named columns in their Encode.
+	Dimensions []string `json:"dimensions,omitempty"`
+}
+
+// Columns returns the names of the columns, from the dimensions or from the header row of the source,
+// and the number of columns of the longest row.
+func (ds *Dataset) Columns() ([]string, int) {
+	names := ds.Dimensions
+	count := len(names)
+
+	rows := reflect.ValueOf(ds.Source)
+	if rows.Kind() != reflect.Slice && rows.Kind() != reflect.Array {
+		return names, count
+	}
+	for i := 0; i < rows.Len(); i++ {
+		row := reflect.Indirect(rows.Index(i))
+		for row.Kind() == reflect.Interface {
+			row = row.Elem()
+		}
+		if row.Kind() != reflect.Slice && row.Kind() != reflect.Array {
+			continue
+		}
+		if row.Len() > count {
+			count = row.Len()
+		}
+		if i == 0 && len(ds.Dimensions) == 0 {
+			names = header(row)
+		}
+	}
+	return names, count
+}
+
+// header returns the names of a row holding only strings
+func header(row reflect.Value) []string {
+	names := make([]string, 0, row.Len())
+	for i := 0; i < row.Len(); i++ {
+		v := row.Index(i)
+		for v.Kind() == reflect.Interface {
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.String {
+			return nil
+		}
+		names = append(names, v.String())
+	}
+	return names
+}
+
+// ColumnSet holds the columns of a dataset, read once from its source to resolve the columns referenced
+// by several encodes, see Dataset.ColumnSet.
+type ColumnSet struct {
+	// Names of the columns, from the dimensions or from the header row of the source
+	Names []string
+	// Count of columns of the longest row
+	Count int
+}
+
+// ColumnSet returns the columns of the dataset, see Columns.
+func (ds *Dataset) ColumnSet() ColumnSet {
+	names, count := ds.Columns()
+	return ColumnSet{Names: names, Count: count}
+}
+
+// Column returns the index of a column referenced by index or by name, like the columns of an Encode.
+// Every call reads the source, use ColumnSet to resolve several columns.
+func (ds *Dataset) Column(ref interface{}) (int, error) {
+	return ds.ColumnSet().Column(ref)
+}
+
+// ValidateEncode checks that the columns referenced by the encode exist in the dataset.
+// Every call reads the source, use ColumnSet to validate several encodes.
+func (ds *Dataset) ValidateEncode(e *Encode) error {
+	return ds.ColumnSet().ValidateEncode(e)
+}
+
+// Column returns the index of a column referenced by index or by name, like the columns of an Encode.
+func (cs ColumnSet) Column(ref interface{}) (int, error) {
+	var index int
+	switch r := ref.(type) {
+	case string:
+		for i, name := range cs.Names {
+			if name == r {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("column %q not found", r)
+	case int:
+		index = r
+	case int32:
+		index = int(r)
+	case int64:
+		index = int(r)
+	case float64:
+		index = int(r)
+		if float64(index) != r {
+			return 0, fmt.Errorf("column %v is not an index", r)
+		}
+	default:
+		return 0, fmt.Errorf("column %v is neither an index nor a name", ref)
+	}
+
+	if index < 0 || index >= cs.Count {
+		return 0, fmt.Errorf("column %d out of range, the dataset has %d columns", index, cs.Count)
+	}
+	return index, nil
+}
+
+// ValidateEncode checks that the columns referenced by the encode exist.
+func (cs ColumnSet) ValidateEncode(e *Encode) error {
+	var missing []string
+	for _, ref := range e.Columns() {
+		if _, err := cs.Column(ref); err != nil {
+			missing = append(missing, err.Error())
+		}
+	}
+	if len(missing) > 0 {
+		return errors.New(strings.Join(missing, ", "))
+	}
+	return nil
 }
 
 // DataZoom is the option set for a zoom component.
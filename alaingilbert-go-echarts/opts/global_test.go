@@ -31,3 +31,104 @@ func TestGenerateUniqueID(t *testing.T) {
 		old = new
 	}
 }
+
+func TestSeedChartIDs(t *testing.T) {
+	SeedChartIDs(1)
+	first := []string{generateUniqueID(), generateUniqueID()}
+	assert.NotEqual(t, first[0], first[1])
+
+	SeedChartIDs(1)
+	assert.Equal(t, first, []string{generateUniqueID(), generateUniqueID()})
+}
+
+func TestHashChartID(t *testing.T) {
+	id := HashChartID("prices", 0)
+	assert.Regexp(t, `^[A-Za-z]{12}$`, id)
+	assert.Equal(t, id, HashChartID("prices", 0))
+	assert.NotEqual(t, id, HashChartID("prices", 1))
+	assert.NotEqual(t, id, HashChartID("volumes", 0))
+}
+
+func TestDeriveChartID(t *testing.T) {
+	generated, custom := &Initialization{}, &Initialization{ChartID: "prices"}
+	generated.Validate()
+	custom.Validate()
+	random := generated.ChartID
+
+	generated.DeriveChartID("prices", 0, nil)
+	assert.Equal(t, random, generated.ChartID)
+
+	generated.DeriveChartID("prices", 0, HashChartID)
+	custom.DeriveChartID("prices", 0, HashChartID)
+	assert.Equal(t, HashChartID("prices", 0), generated.ChartID)
+	assert.Equal(t, "prices", custom.ChartID)
+
+	generated.DeriveChartID("prices", 2, HashChartID)
+	assert.Equal(t, HashChartID("prices", 2), generated.ChartID)
+
+	// the strategy of the chart, then the page strategy, then the default one
+	SetChartIDStrategy(func(string, int) string { return "default" })
+	defer SetChartIDStrategy(nil)
+	generated.DeriveChartID("prices", 0, nil)
+	assert.Equal(t, "default", generated.ChartID)
+	generated.ChartIDStrategy = HashChartID
+	generated.DeriveChartID("prices", 1, func(string, int) string { return "page" })
+	assert.Equal(t, HashChartID("prices", 1), generated.ChartID)
+}
+
+func TestDatasetColumns(t *testing.T) {
+	withHeader := &Dataset{Source: [][]interface{}{
+		{"TIME", "OPEN", "CLOSE"},
+		{"10:00", 10, 12},
+		{"10:01", 12, 11, 3},
+	}}
+	names, count := withHeader.Columns()
+	assert.Equal(t, []string{"TIME", "OPEN", "CLOSE"}, names)
+	assert.Equal(t, 4, count)
+
+	index, err := withHeader.Column("CLOSE")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, index)
+	index, err = withHeader.Column(3)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, index)
+	_, err = withHeader.Column("HIGH")
+	assert.EqualError(t, err, `column "HIGH" not found`)
+	_, err = withHeader.Column(4)
+	assert.EqualError(t, err, "column 4 out of range, the dataset has 4 columns")
+
+	withDimensions := &Dataset{Source: [][]float64{{1, 2}, {3, 4}}, Dimensions: []string{"X", "Y"}}
+	index, err = withDimensions.Column("Y")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, index)
+
+	noHeader := &Dataset{Source: [][]float64{{1, 2}, {3, 4}}}
+	names, count = noHeader.Columns()
+	assert.Empty(t, names)
+	assert.Equal(t, 2, count)
+}
+
+func TestValidateEncode(t *testing.T) {
+	ds := &Dataset{Source: [][]interface{}{{"TIME", "OPEN", "CLOSE", "LOW", "HIGH"}}}
+
+	assert.NoError(t, ds.ValidateEncode(&Encode{X: "TIME", Y: []string{"OPEN", "CLOSE", "LOW", "HIGH"}}))
+	assert.NoError(t, ds.ValidateEncode(&Encode{X: 0, Y: []interface{}{1, "CLOSE", 3.0, 4}}))
+	assert.EqualError(t, ds.ValidateEncode(&Encode{X: "TIME", Y: []interface{}{"OPEN", 13}}),
+		"column 13 out of range, the dataset has 5 columns")
+	assert.EqualError(t, ds.ValidateEncode(&Encode{X: "DATE", Y: "VOLUME"}),
+		`column "DATE" not found, column "VOLUME" not found`)
+}
+
+func TestColumnSet(t *testing.T) {
+	ds := &Dataset{Source: [][]interface{}{{"TIME", "OPEN", "CLOSE"}, {"10:00", 10, 12, 3}}}
+	columns := ds.ColumnSet()
+	assert.Equal(t, ColumnSet{Names: []string{"TIME", "OPEN", "CLOSE"}, Count: 4}, columns)
+
+	// the set resolves the columns without the source
+	ds.Source = nil
+	index, err := columns.Column("CLOSE")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, index)
+	assert.NoError(t, columns.ValidateEncode(&Encode{X: "TIME", Y: []interface{}{"OPEN", 3}}))
+	assert.EqualError(t, columns.ValidateEncode(&Encode{X: "TIME", Y: 4}), "column 4 out of range, the dataset has 4 columns")
+}
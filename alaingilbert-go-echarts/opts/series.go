@@ -73,14 +73,39 @@ type Emphasis struct {
 	ItemStyle *ItemStyle `json:"itemStyle,omitempty"`
 }
 
-// Encode
+// Encode maps the dimensions of a series to the columns of the dataset.
+// Columns are referenced by index or, when the dataset has a header row or dimensions, by name.
+// https://echarts.apache.org/en/option.html#series-candlestick.encode
 type Encode struct {
-	// X
+	// X is the column of the X axis
 	X interface{} `json:"x"`
-	// Y
+	// Y is the column of the Y axis, or the list of the open, close, lowest and highest columns of the candlesticks
 	Y interface{} `json:"y"`
 }
 
+// Columns returns the columns referenced by X and Y, the lists being flattened.
+func (e *Encode) Columns() []interface{} {
+	columns := make([]interface{}, 0, 5)
+	for _, ref := range []interface{}{e.X, e.Y} {
+		switch r := ref.(type) {
+		case nil:
+		case []interface{}:
+			columns = append(columns, r...)
+		case []string:
+			for _, c := range r {
+				columns = append(columns, c)
+			}
+		case []int:
+			for _, c := range r {
+				columns = append(columns, c)
+			}
+		default:
+			columns = append(columns, r)
+		}
+	}
+	return columns
+}
+
 // ItemStyle
 type ItemStyle struct {
 	// Color of chart
@@ -2,10 +2,15 @@ package render
 
 import (
 	"bytes"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"html/template"
 	"io"
+	"io/fs"
 	"regexp"
+	"strings"
+	"sync"
 
 	tpls "github.com/bygui86/go-csv-view/alaingilbert-go-echarts/templates"
 )
@@ -24,18 +29,21 @@ const (
 
 type pageRender struct {
 	c      interface{}
-	before []func()
+	before []func() error
 }
 
 // NewPageRender returns a render implementation for Page.
-func NewPageRender(c interface{}, before ...func()) Renderer {
+// The before functions validate the page, their first error is returned by Render.
+func NewPageRender(c interface{}, before ...func() error) Renderer {
 	return &pageRender{c: c, before: before}
 }
 
 // Render
 func (r *pageRender) Render(w io.Writer) error {
 	for _, fn := range r.before {
-		fn()
+		if err := fn(); err != nil {
+			return err
+		}
 	}
 
 	contents := []string{tpls.HeaderTpl, tpls.BaseTpl, tpls.PageTpl}
@@ -53,27 +61,41 @@ func (r *pageRender) Render(w io.Writer) error {
 	return err
 }
 
+// inliner is implemented by the charts embedding opts.Initialization
+type inliner interface {
+	AssetsInlined() bool
+}
+
 type chartRender struct {
 	c      interface{}
-	before []func()
+	before []func() error
 }
 
 // NewChartRender returns a render implementation for Chart.
-func NewChartRender(c interface{}, before ...func()) Renderer {
+// The before functions validate the chart, their first error is returned by Render.
+func NewChartRender(c interface{}, before ...func() error) Renderer {
 	return &chartRender{c: c, before: before}
 }
 
 // Render
 func (r *chartRender) Render(w io.Writer) error {
 	for _, fn := range r.before {
-		fn()
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+
+	// a chart with inlined assets is rendered as a complete document, the assets being in its header
+	name := "base"
+	if i, ok := r.c.(inliner); ok && i.AssetsInlined() {
+		name = ModChart
 	}
 
 	contents := []string{tpls.HeaderTpl, tpls.BaseTpl, tpls.ChartTpl}
-	tpl := MustTemplate("base", contents)
+	tpl := MustTemplate(name, contents)
 
 	var buf bytes.Buffer
-	if err := tpl.ExecuteTemplate(&buf, "base", r.c); err != nil {
+	if err := tpl.ExecuteTemplate(&buf, name, r.c); err != nil {
 		return err
 	}
 
@@ -86,14 +108,57 @@ func (r *chartRender) Render(w io.Writer) error {
 
 // MustTemplate
 func MustTemplate(name string, contents []string) *template.Template {
-	tpl := template.Must(template.New(name).Parse(contents[0])).Funcs(template.FuncMap{
+	tpl := template.New(name).Funcs(template.FuncMap{
 		"safeJS": func(s interface{}) template.JS {
 			return template.JS(fmt.Sprint(s))
 		},
+		"inlineJS": func(host, url string) (template.URL, error) {
+			return inlineAsset(host, url, "text/javascript")
+		},
+		"inlineCSS": func(host, url string) (template.URL, error) {
+			return inlineAsset(host, url, "text/css")
+		},
 	})
 
-	for _, cont := range contents[1:] {
+	for _, cont := range contents {
 		tpl = template.Must(tpl.Parse(cont))
 	}
 	return tpl
 }
+
+var (
+	assetsMu sync.RWMutex
+	assetsFS fs.FS
+)
+
+// ErrNoAssets is returned when rendering inlined assets while no assets are registered, see RegisterAssets.
+var ErrNoAssets = errors.New("no assets registered, import github.com/bygui86/go-csv-view/alaingilbert-go-echarts/assets/inline")
+
+// RegisterAssets registers the files inlined by the charts with opts.Initialization.InlineAssets, named
+// like the JSAssets entries. The assets/inline package registers the embedded assets when imported,
+// so only the programs inlining the assets link them:
+//
+//	import _ "github.com/bygui86/go-csv-view/alaingilbert-go-echarts/assets/inline"
+func RegisterAssets(fsys fs.FS) {
+	assetsMu.Lock()
+	defer assetsMu.Unlock()
+	assetsFS = fsys
+}
+
+// inlineAsset returns a data URL holding an asset of the registered assets, url is the name of the asset
+// prefixed by the assets host, see opts.Assets.Validate. The content is base64 encoded, so it cannot end
+// the script or style element holding it, whatever it contains.
+func inlineAsset(host, url, mediaType string) (template.URL, error) {
+	assetsMu.RLock()
+	fsys := assetsFS
+	assetsMu.RUnlock()
+	if fsys == nil {
+		return "", fmt.Errorf("asset %s cannot be inlined: %w", url, ErrNoAssets)
+	}
+
+	content, err := fs.ReadFile(fsys, strings.TrimPrefix(url, host))
+	if err != nil {
+		return "", fmt.Errorf("asset %s cannot be inlined: %w", url, err)
+	}
+	return template.URL("data:" + mediaType + ";base64," + base64.StdEncoding.EncodeToString(content)), nil
+}
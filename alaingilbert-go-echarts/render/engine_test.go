@@ -0,0 +1,73 @@
+package render
+
+import (
+	"bytes"
+	"encoding/base64"
+	"html"
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	tpls "github.com/bygui86/go-csv-view/alaingilbert-go-echarts/templates"
+	"github.com/stretchr/testify/assert"
+)
+
+const host = "https://example.com/assets/"
+
+// page is the data of the header template
+type page struct {
+	PageTitle    string
+	AssetsHost   string
+	InlineAssets bool
+	JSAssets     struct{ Values []string }
+	CSSAssets    struct{ Values []string }
+
+	CustomizedJSAssets, CustomizedCSSAssets struct{ Values []string }
+}
+
+func renderHeader(p *page) (string, error) {
+	var buf bytes.Buffer
+	err := MustTemplate("header", []string{tpls.HeaderTpl}).ExecuteTemplate(&buf, "header", p)
+	return buf.String(), err
+}
+
+func TestInlineAssets(t *testing.T) {
+	script := `document.write("</script><script>alert(1)</script>"); <!-- x`
+	RegisterAssets(fstest.MapFS{
+		"echarts.min.js": {Data: []byte(script)},
+		"style.css":      {Data: []byte(`a { content: "</style>" }`)},
+	})
+	defer RegisterAssets(nil)
+
+	p := &page{AssetsHost: host, InlineAssets: true}
+	p.JSAssets.Values = []string{host + "echarts.min.js"}
+	p.CSSAssets.Values = []string{host + "style.css"}
+	out, err := renderHeader(p)
+	assert.NoError(t, err)
+
+	scripts := regexp.MustCompile(`<script src="data:text/javascript;base64,([^"]+)"></script>`).FindAllStringSubmatch(out, -1)
+	if assert.Len(t, scripts, 1) {
+		content, err := base64.StdEncoding.DecodeString(html.UnescapeString(scripts[0][1]))
+		assert.NoError(t, err)
+		assert.Equal(t, script, string(content))
+	}
+	assert.Contains(t, out, `<link href="data:text/css;base64,`)
+	assert.NotContains(t, out, "</style>")
+	assert.NotContains(t, out, "alert")
+
+	p.JSAssets.Values = []string{host + "missing.js"}
+	_, err = renderHeader(p)
+	assert.Error(t, err)
+}
+
+func TestInlineAssetsNotRegistered(t *testing.T) {
+	p := &page{AssetsHost: host, InlineAssets: true}
+	p.JSAssets.Values = []string{host + "echarts.min.js"}
+	_, err := renderHeader(p)
+	assert.ErrorIs(t, err, ErrNoAssets)
+
+	p.InlineAssets = false
+	out, err := renderHeader(p)
+	assert.NoError(t, err)
+	assert.Contains(t, out, `<script src="https://example.com/assets/echarts.min.js"></script>`)
+}
@@ -0,0 +1,104 @@
+package snapshot
+
+import (
+	"image/color"
+	"strconv"
+	"strings"
+)
+
+const (
+	fontSize  = 12
+	charWidth = 7 // INFO width of the characters of the PNG font, close to the width of the SVG sans-serif font
+)
+
+type anchor int
+
+const (
+	anchorStart anchor = iota
+	anchorMiddle
+	anchorEnd
+)
+
+type pt struct {
+	x, y float64
+}
+
+// canvas is the surface the model is drawn on, an SVG document or a PNG image
+type canvas interface {
+	fillRect(r rect, color string)
+	// polyline strokes the segments joining the points
+	polyline(points []pt, color string, width float64)
+	polygon(points []pt, color string)
+	circle(center pt, radius float64, color string)
+	// text writes a line of text vertically centered on p, aligned on p.x by anchor
+	text(p pt, s string, color string, a anchor, bold bool)
+}
+
+func textWidth(s string) float64 {
+	return float64(len([]rune(s)) * charWidth)
+}
+
+var namedColors = map[string]color.RGBA{
+	"black":       {0, 0, 0, 255},
+	"white":       {255, 255, 255, 255},
+	"red":         {255, 0, 0, 255},
+	"green":       {0, 128, 0, 255},
+	"blue":        {0, 0, 255, 255},
+	"yellow":      {255, 255, 0, 255},
+	"orange":      {255, 165, 0, 255},
+	"purple":      {128, 0, 128, 255},
+	"gray":        {128, 128, 128, 255},
+	"grey":        {128, 128, 128, 255},
+	"transparent": {0, 0, 0, 0},
+}
+
+// parseColor parses the CSS colors used in the chart options: #rgb, #rrggbb, rgb(), rgba() and a few names.
+// Colors which cannot be parsed are black.
+func parseColor(s string) color.RGBA {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if c, ok := namedColors[s]; ok {
+		return c
+	}
+
+	if strings.HasPrefix(s, "#") {
+		hex := s[1:]
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+		if len(hex) == 6 {
+			if v, err := strconv.ParseUint(hex, 16, 32); err == nil {
+				return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 255}
+			}
+		}
+		return color.RGBA{A: 255}
+	}
+
+	if open, end := strings.Index(s, "("), strings.LastIndex(s, ")"); open > 0 && end > open {
+		parts := strings.Split(s[open+1:end], ",")
+		if len(parts) < 3 {
+			return color.RGBA{A: 255}
+		}
+		c := color.RGBA{A: 255}
+		channels := []*uint8{&c.R, &c.G, &c.B}
+		for i, ch := range channels {
+			v, _ := strconv.ParseFloat(strings.TrimSpace(parts[i]), 64)
+			*ch = uint8(clamp(v, 0, 255))
+		}
+		if len(parts) > 3 {
+			a, _ := strconv.ParseFloat(strings.TrimSpace(parts[3]), 64)
+			c.A = uint8(clamp(a, 0, 1) * 255)
+		}
+		return c
+	}
+	return color.RGBA{A: 255}
+}
+
+func clamp(v, lower, upper float64) float64 {
+	if v < lower {
+		return lower
+	}
+	if v > upper {
+		return upper
+	}
+	return v
+}
@@ -0,0 +1,182 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/types"
+)
+
+// dataset holds the rows of opts.Dataset, without its header row
+type dataset struct {
+	opts.ColumnSet
+	rows [][]interface{}
+}
+
+func newDataset(ds opts.Dataset) (*dataset, error) {
+	b, err := json.Marshal(ds.Source)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([][]interface{}, 0)
+	if err := json.Unmarshal(b, &rows); err != nil {
+		return nil, errors.New("dataset source is not a list of rows")
+	}
+
+	d := &dataset{ColumnSet: ds.ColumnSet(), rows: rows}
+	if len(rows) > 0 && len(rows[0]) > 0 {
+		for _, v := range rows[0] {
+			if _, ok := v.(string); !ok {
+				return d, nil
+			}
+		}
+		d.rows = rows[1:]
+	}
+	return d, nil
+}
+
+// columns returns the columns of the y encode of a series: open, close, lowest and highest for the candles.
+// Without encode the series i uses the column i+1, or the columns 1 to 4 for the candles.
+func (d *dataset) columns(i int, s *charts.SingleSeries) (int, []int, error) {
+	if s.Encode == nil {
+		if s.Type == types.ChartKline {
+			return 0, []int{1, 2, 3, 4}, nil
+		}
+		return 0, []int{i + 1}, nil
+	}
+
+	x := 0
+	if s.Encode.X != nil {
+		var err error
+		if x, err = d.Column(s.Encode.X); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	refs := s.Encode.Columns()
+	if s.Encode.X != nil {
+		refs = refs[1:]
+	}
+	if s.Type == types.ChartKline && len(refs) != 4 {
+		return 0, nil, errors.New("candlestick encode needs 4 y columns")
+	}
+	if s.Type != types.ChartKline && len(refs) > 1 {
+		refs = refs[:1]
+	}
+	if len(refs) == 0 {
+		return 0, nil, errors.New("encode has no y column")
+	}
+
+	ys := make([]int, 0, len(refs))
+	for _, r := range refs {
+		c, err := d.Column(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		ys = append(ys, c)
+	}
+	return x, ys, nil
+}
+
+// points reads the points of the series i from the dataset rows
+func (d *dataset) points(i int, s *charts.SingleSeries, x *axis) ([]point, error) {
+	xc, ycs, err := d.columns(i, s)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]point, 0, len(d.rows))
+	for _, row := range d.rows {
+		p := point{values: make([]float64, 0, len(ycs))}
+		if xc < len(row) {
+			p.x = x.coordinate(row[xc])
+		}
+		for _, c := range ycs {
+			v := math.NaN()
+			if c < len(row) {
+				v = value(row[c])
+			}
+			p.values = append(p.values, v)
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
+
+// dataPoints reads the points of a series from its data: values, [x, y] pairs, candles or items with a value
+func dataPoints(s *charts.SingleSeries, x *axis) ([]point, error) {
+	data := items(s.Data)
+	points := make([]point, 0, len(data))
+	for i, item := range data {
+		p := point{x: float64(i)}
+		v := item
+		if obj, ok := item.(map[string]interface{}); ok {
+			v = obj["value"]
+			p.symbol, _ = obj["symbol"].(string)
+			p.size, _ = number(obj["symbolSize"])
+			p.rotate, _ = number(obj["symbolRotate"])
+		}
+
+		values, isList := v.([]interface{})
+		switch {
+		case s.Type == types.ChartKline:
+			if !isList || len(values) < 4 {
+				return nil, fmt.Errorf("candle %d needs open, close, lowest and highest", i)
+			}
+			p.values = []float64{value(values[0]), value(values[1]), value(values[2]), value(values[3])}
+		case isList && len(values) >= 2:
+			p.x = x.coordinate(values[0])
+			p.values = []float64{value(values[1])}
+		case isList && len(values) == 1:
+			p.values = []float64{value(values[0])}
+		default:
+			p.values = []float64{value(v)}
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
+
+// coordinate returns the position of a data value along a category axis, appending the unknown labels,
+// or the value for a value axis
+func (a *axis) coordinate(v interface{}) float64 {
+	if !a.category {
+		return value(v)
+	}
+
+	label := text(v)
+	if a.index == nil {
+		a.index = make(map[string]int, len(a.labels))
+		for i, l := range a.labels {
+			if _, ok := a.index[l]; !ok {
+				a.index[l] = i
+			}
+		}
+	}
+	if i, ok := a.index[label]; ok {
+		return float64(i)
+	}
+	if n, ok := v.(float64); ok && n >= 0 && n == math.Trunc(n) {
+		// INFO echarts reads numbers as category indexes
+		a.extend(int(n) + 1)
+		return n
+	}
+	a.index[label] = len(a.labels)
+	a.labels = append(a.labels, label)
+	return float64(len(a.labels) - 1)
+}
+
+// extend names the categories up to n by their index, for the axes without labels
+func (a *axis) extend(n int) {
+	for i := len(a.labels); i < n; i++ {
+		a.labels = append(a.labels, strconv.Itoa(i))
+		if a.index != nil {
+			a.index[a.labels[i]] = i
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package snapshot
+
+import (
+	"math"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/types"
+)
+
+const (
+	textColor      = "#333333"
+	subtextColor   = "#aaaaaa"
+	axisColor      = "#6e7079"
+	splitLineColor = "#e0e6f1"
+
+	tickLength  = 5
+	labelMargin = 8
+	axisOffset  = 60 // INFO distance between the axes of the same side of a grid
+)
+
+func (m *model) draw(c canvas) {
+	for _, a := range m.yAxes {
+		m.drawValueSplitLines(c, a, false)
+	}
+	for _, a := range m.xAxes {
+		m.drawValueSplitLines(c, a, true)
+	}
+	for _, a := range m.xAxes {
+		m.drawAxis(c, a, true)
+	}
+	for _, a := range m.yAxes {
+		m.drawAxis(c, a, false)
+	}
+	for _, s := range m.series {
+		switch s.kind {
+		case types.ChartLine:
+			drawLine(c, s)
+		case types.ChartBar:
+			drawBars(c, s)
+		case types.ChartKline:
+			drawCandles(c, s)
+		default:
+			drawSymbols(c, s)
+		}
+	}
+	m.drawTitle(c)
+	m.drawLegend(c)
+}
+
+func (m *model) drawTitle(c canvas) {
+	if m.title != "" {
+		c.text(pt{10, 16}, m.title, textColor, anchorStart, true)
+	}
+	if m.subtitle != "" {
+		c.text(pt{10, 34}, m.subtitle, subtextColor, anchorStart, false)
+	}
+}
+
+// drawLegend draws the series names centered at the top of the chart, after a swatch of their color
+func (m *model) drawLegend(c canvas) {
+	const swatch, gap = 25, 10
+
+	width := 0.0
+	for i, s := range m.legend {
+		if i > 0 {
+			width += gap
+		}
+		width += swatch + 5 + textWidth(s.name)
+	}
+
+	x, y := (m.width-width)/2, 16.0
+	for _, s := range m.legend {
+		if s.kind == types.ChartLine {
+			c.polyline([]pt{{x, y}, {x + swatch, y}}, s.color, 2)
+			c.circle(pt{x + swatch/2, y}, 4, s.color)
+		} else {
+			c.fillRect(rect{x: x, y: y - 7, w: swatch, h: 14}, s.color)
+		}
+		c.text(pt{x + swatch + 5, y}, s.name, textColor, anchorStart, false)
+		x += swatch + 5 + textWidth(s.name) + gap
+	}
+}
+
+// drawValueSplitLines draws the lines of the ticks across the grid of a value axis
+func (m *model) drawValueSplitLines(c canvas, a *axis, horizontal bool) {
+	if a.category || !a.showSplitLine {
+		return
+	}
+	for _, t := range a.ticks {
+		p := a.pos(t, horizontal)
+		if horizontal {
+			c.polyline([]pt{{p, a.grid.y}, {p, a.grid.y + a.grid.h}}, splitLineColor, 1)
+		} else {
+			c.polyline([]pt{{a.grid.x, p}, {a.grid.x + a.grid.w, p}}, splitLineColor, 1)
+		}
+	}
+}
+
+// drawAxis draws the line, the ticks and the labels of an axis, on the bottom or the top of its grid for
+// a horizontal axis, on the left or the right for a vertical axis
+func (m *model) drawAxis(c canvas, a *axis, horizontal bool) {
+	g := a.grid
+	side := m.side(a, horizontal)
+
+	if a.category {
+		// INFO echarts draws the line of the category axes only, with ticks between the categories
+		if horizontal {
+			c.polyline([]pt{{g.x, side}, {g.x + g.w, side}}, axisColor, 1)
+		} else {
+			c.polyline([]pt{{side, g.y}, {side, g.y + g.h}}, axisColor, 1)
+		}
+		band := a.band(horizontal)
+		for i := 0; i <= a.to-a.from; i++ {
+			if horizontal {
+				x := g.x + float64(i)*band
+				c.polyline([]pt{{x, side}, {x, side + m.outwards(a, horizontal)*tickLength}}, axisColor, 1)
+			} else {
+				y := g.y + g.h - float64(i)*band
+				c.polyline([]pt{{side, y}, {side + m.outwards(a, horizontal)*tickLength, y}}, axisColor, 1)
+			}
+		}
+		if a.showLabels {
+			m.drawCategoryLabels(c, a, horizontal, side)
+		}
+	} else if a.showLabels {
+		for _, t := range a.ticks {
+			m.drawLabel(c, a, horizontal, side, a.pos(t, horizontal), a.label(t))
+		}
+	}
+
+	if a.name != "" {
+		if horizontal {
+			c.text(pt{g.x + g.w + labelMargin, side}, a.name, axisColor, anchorStart, false)
+		} else {
+			c.text(pt{side, g.y - 15}, a.name, axisColor, anchorMiddle, false)
+		}
+	}
+}
+
+// drawCategoryLabels skips labels to keep them from overlapping
+func (m *model) drawCategoryLabels(c canvas, a *axis, horizontal bool, side float64) {
+	longest := 0.0
+	for _, l := range a.labels[a.from:a.to] {
+		longest = math.Max(longest, textWidth(l))
+	}
+	size := float64(fontSize)
+	if horizontal {
+		size = longest
+	}
+	interval := int(math.Ceil((size + labelMargin) / a.band(horizontal)))
+	if interval < 1 {
+		interval = 1
+	}
+
+	for i := a.from; i < a.to; i += interval {
+		m.drawLabel(c, a, horizontal, side, a.pos(float64(i), horizontal), a.labels[i])
+	}
+}
+
+func (m *model) drawLabel(c canvas, a *axis, horizontal bool, side, pos float64, label string) {
+	out := m.outwards(a, horizontal)
+	if horizontal {
+		// INFO the labels at the ends of the axis are kept inside the image
+		half := textWidth(label) / 2
+		pos = clamp(pos, half, math.Max(half, m.width-half))
+		c.text(pt{pos, side + out*(labelMargin+fontSize/2)}, label, axisColor, anchorMiddle, false)
+		return
+	}
+	an := anchorEnd
+	if out > 0 {
+		an = anchorStart
+	}
+	c.text(pt{side + out*labelMargin, pos}, label, axisColor, an, false)
+}
+
+// side returns the position of the axis line: the first axes of a grid are on its bottom and left sides,
+// the next ones on its top and right sides, shifted outwards after the second one
+func (m *model) side(a *axis, horizontal bool) float64 {
+	axes := m.yAxes
+	if horizontal {
+		axes = m.xAxes
+	}
+	rank := 0
+	for _, other := range axes {
+		if other == a {
+			break
+		}
+		if other.grid == a.grid {
+			rank++
+		}
+	}
+	shift := 0.0
+	if rank > 1 {
+		shift = float64(rank-1) * axisOffset
+	}
+
+	g := a.grid
+	switch {
+	case horizontal && a.opposite:
+		return g.y - shift
+	case horizontal:
+		return g.y + g.h
+	case a.opposite:
+		return g.x + g.w + shift
+	}
+	return g.x
+}
+
+// outwards returns the direction away from the grid, 1 for the bottom and right axes, -1 for the others
+func (m *model) outwards(a *axis, horizontal bool) float64 {
+	if horizontal == a.opposite {
+		return -1
+	}
+	return 1
+}
+
+// drawLine draws the segments of the series, missing values break the line
+func drawLine(c canvas, s *series) {
+	segment := make([]pt, 0)
+	flush := func() {
+		if len(segment) == 1 {
+			c.circle(segment[0], s.lineWidth, s.color)
+		} else if len(segment) > 1 {
+			c.polyline(segment, s.color, s.lineWidth)
+		}
+		segment = segment[:0]
+	}
+
+	for _, p := range s.points {
+		if !s.visible(p) || math.IsNaN(p.values[0]) || math.IsNaN(p.x) {
+			flush()
+			continue
+		}
+		segment = append(segment, pt{s.x.pos(p.x, true), s.y.pos(p.values[0], false)})
+	}
+	flush()
+}
+
+// drawBars draws the bars from the zero line, or from the bound of the axis closest to zero
+func drawBars(c canvas, s *series) {
+	band := s.x.band(true)
+	if !s.x.category {
+		band = s.x.grid.w / math.Max(float64(len(s.points)), 1)
+	}
+	width := band * 0.6 / float64(s.bars)
+	base := s.y.pos(clamp(0, s.y.lower, s.y.upper), false)
+
+	for _, p := range s.points {
+		if !s.visible(p) || math.IsNaN(p.values[0]) || math.IsNaN(p.x) {
+			continue
+		}
+		x := s.x.pos(p.x, true) - band*0.3 + float64(s.bar)*width
+		y := s.y.pos(p.values[0], false)
+		c.fillRect(rect{x: x, y: math.Min(y, base), w: width, h: math.Abs(base - y)}, s.color)
+	}
+}
+
+// drawCandles draws the bodies from open to close and the wicks from lowest to highest,
+// rising candles in the color of the series and falling ones in its color0
+func drawCandles(c canvas, s *series) {
+	width := s.x.band(true) * 0.6
+	for _, p := range s.points {
+		if !s.visible(p) || math.IsNaN(p.values[0]) || math.IsNaN(p.values[1]) {
+			continue
+		}
+		open, close, low, high := p.values[0], p.values[1], p.values[2], p.values[3]
+		fill, border := s.color, s.border
+		if close < open {
+			fill, border = s.color0, s.border0
+		}
+
+		x := s.x.pos(p.x, true)
+		if !math.IsNaN(low) && !math.IsNaN(high) {
+			c.polyline([]pt{{x, s.y.pos(high, false)}, {x, s.y.pos(low, false)}}, border, 1)
+		}
+		top, bottom := s.y.pos(math.Max(open, close), false), s.y.pos(math.Min(open, close), false)
+		c.fillRect(rect{x: x - width/2, y: top, w: width, h: math.Max(bottom-top, 1)}, fill)
+	}
+}
+
+// drawSymbols draws the points of scatter series: circles, rectangles, diamonds or triangles,
+// a triangle rotated of 180 degrees points down
+func drawSymbols(c canvas, s *series) {
+	for _, p := range s.points {
+		if !s.visible(p) || math.IsNaN(p.values[0]) || math.IsNaN(p.x) {
+			continue
+		}
+		symbol, size := s.symbol, s.symbolSize
+		if p.symbol != "" {
+			symbol = p.symbol
+		}
+		if p.size > 0 {
+			size = p.size
+		}
+		center := pt{s.x.pos(p.x, true), s.y.pos(p.values[0], false)}
+		r := size / 2
+
+		switch symbol {
+		case "rect", "roundRect":
+			c.fillRect(rect{x: center.x - r, y: center.y - r, w: size, h: size}, s.color)
+		case "diamond":
+			c.polygon([]pt{{center.x, center.y - r}, {center.x + r, center.y}, {center.x, center.y + r}, {center.x - r, center.y}}, s.color)
+		case "triangle":
+			points := []pt{{0, -r}, {r, r}, {-r, r}}
+			sin, cos := math.Sincos(p.rotate * math.Pi / 180)
+			for i, q := range points {
+				points[i] = pt{center.x + q.x*cos + q.y*sin, center.y - q.x*sin + q.y*cos}
+			}
+			c.polygon(points, s.color)
+		default:
+			c.circle(center, r, s.color)
+		}
+	}
+}
@@ -0,0 +1,596 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/components"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/types"
+)
+
+const (
+	defaultWidth      = 900
+	defaultHeight     = 500
+	defaultBackground = "#ffffff"
+
+	defaultGridTop    = 60
+	defaultGridBottom = 60
+	defaultGridSide   = "10%"
+
+	defaultSplitNumber = 5
+	defaultSymbolSize  = 10
+	defaultLineWidth   = 2
+
+	// colors of the candles, as in echarts
+	defaultUpColor   = "#eb5454"
+	defaultDownColor = "#47b262"
+)
+
+// model holds the chart laid out in the image: the grids, their axes and the series with their points
+type model struct {
+	width, height float64
+	background    string
+
+	title, subtitle string
+	legend          []*series
+
+	grids  []rect
+	xAxes  []*axis
+	yAxes  []*axis
+	series []*series
+}
+
+type rect struct {
+	x, y, w, h float64
+}
+
+type axis struct {
+	name     string
+	grid     rect
+	category bool
+	// labels of the category axis, from and to the window of the data zoom
+	labels   []string
+	index    map[string]int
+	from, to int
+	// bounds of the value axis, from its options or from the data
+	min, max      interface{}
+	scale         bool
+	splitNumber   int
+	lower, upper  float64
+	ticks         []float64
+	step          float64
+	formatter     string
+	showLabels    bool
+	showSplitLine bool
+	// position of the axis in its grid: bottom or top, left or right
+	opposite bool
+}
+
+type series struct {
+	name, kind string
+	color      string
+	// down color and border colors of the candles
+	color0, border, border0 string
+	lineWidth               float64
+	symbol                  string
+	symbolSize              float64
+	x, y                    *axis
+	points                  []point
+	// place of the series among the bars of its x axis
+	bar, bars int
+}
+
+type point struct {
+	x float64
+	// values holds the y value or, for the candles, open, close, lowest and highest
+	values []float64
+	symbol string
+	size   float64
+	rotate float64
+}
+
+func newModel(chart components.Charter) (*model, error) {
+	bc, err := baseConfiguration(chart)
+	if err != nil {
+		return nil, err
+	}
+	if err := chart.Validate(); err != nil {
+		return nil, err
+	}
+
+	m := &model{
+		width:      pixels(bc.Initialization.Width, defaultWidth),
+		height:     pixels(bc.Initialization.Height, defaultHeight),
+		background: bc.Initialization.BackgroundColor,
+		title:      bc.Title.Title,
+		subtitle:   bc.Title.Subtitle,
+	}
+	if m.background == "" {
+		m.background = defaultBackground
+	}
+
+	m.layoutGrids(bc)
+	if err := m.layoutAxes(bc); err != nil {
+		return nil, err
+	}
+	if err := m.addSeries(bc); err != nil {
+		return nil, err
+	}
+	m.zoom(bc)
+	for _, a := range m.xAxes {
+		if !a.category {
+			m.scaleAxis(a, func(s *series) *axis { return s.x }, func(p point) []float64 { return []float64{p.x} })
+		}
+	}
+	for _, a := range m.yAxes {
+		m.scaleAxis(a, func(s *series) *axis { return s.y }, func(p point) []float64 { return p.values })
+	}
+
+	if bc.Legend.Show {
+		names := make(map[string]bool)
+		for _, s := range m.series {
+			if s.name != "" && !names[s.name] {
+				names[s.name] = true
+				m.legend = append(m.legend, s)
+			}
+		}
+	}
+	return m, nil
+}
+
+// layoutGrids places the grids: echarts grids default to 60px from the top and the bottom of the chart and
+// to 10% from its sides, the fork grids set their height from the bottom
+func (m *model) layoutGrids(bc *charts.BaseConfiguration) {
+	if len(bc.GridList) == 0 {
+		m.grids = []rect{{
+			x: size(defaultGridSide, m.width, 0),
+			y: defaultGridTop,
+			w: m.width - 2*size(defaultGridSide, m.width, 0),
+			h: m.height - defaultGridTop - defaultGridBottom,
+		}}
+		return
+	}
+
+	for _, g := range bc.GridList {
+		left := size(g.Left, m.width, size(defaultGridSide, m.width, 0))
+		right := size(g.Right, m.width, size(defaultGridSide, m.width, 0))
+		bottom := size(g.Bottom, m.height, defaultGridBottom)
+		height := size(g.Height, m.height, m.height-defaultGridTop-bottom)
+		m.grids = append(m.grids, rect{x: left, y: m.height - bottom - height, w: m.width - left - right, h: height})
+	}
+}
+
+func (m *model) layoutAxes(bc *charts.BaseConfiguration) error {
+	xAxes := make(map[int]int)
+	for i, x := range bc.XAxisList {
+		if x.GridIndex < 0 || x.GridIndex >= len(m.grids) {
+			return fmt.Errorf("x axis %d: grid %d not found", i, x.GridIndex)
+		}
+		a := &axis{
+			name:          x.Name,
+			grid:          m.grids[x.GridIndex],
+			category:      x.Type == "" || x.Type == "category",
+			labels:        labels(x.Data),
+			min:           x.Min,
+			max:           x.Max,
+			scale:         x.Scale,
+			splitNumber:   x.SplitNumber,
+			showLabels:    x.AxisLabel == nil || x.AxisLabel.Show,
+			showSplitLine: x.SplitLine != nil && x.SplitLine.Show,
+			opposite:      xAxes[x.GridIndex] > 0,
+		}
+		if x.AxisLabel != nil {
+			a.formatter = x.AxisLabel.Formatter
+		}
+		xAxes[x.GridIndex]++
+		m.xAxes = append(m.xAxes, a)
+	}
+
+	yAxes := make(map[int]int)
+	for i, y := range bc.YAxisList {
+		if y.GridIndex < 0 || y.GridIndex >= len(m.grids) {
+			return fmt.Errorf("y axis %d: grid %d not found", i, y.GridIndex)
+		}
+		a := &axis{
+			name:          y.Name,
+			grid:          m.grids[y.GridIndex],
+			category:      y.Type == "category",
+			labels:        labels(y.Data),
+			min:           y.Min,
+			max:           y.Max,
+			scale:         y.Scale,
+			splitNumber:   y.SplitNumber,
+			showLabels:    y.AxisLabel == nil || y.AxisLabel.Show,
+			showSplitLine: y.SplitLine == nil || y.SplitLine.Show,
+			opposite:      yAxes[y.GridIndex] > 0,
+		}
+		if y.AxisLabel != nil {
+			a.formatter = y.AxisLabel.Formatter
+		}
+		yAxes[y.GridIndex]++
+		m.yAxes = append(m.yAxes, a)
+	}
+	return nil
+}
+
+func (m *model) addSeries(bc *charts.BaseConfiguration) error {
+	colors := bc.Colors
+	if len(colors) == 0 {
+		colors = charts.DefaultColors
+	}
+
+	var data *dataset
+	for i := range bc.MultiSeries {
+		s := &bc.MultiSeries[i]
+		switch s.Type {
+		case types.ChartLine, types.ChartBar, types.ChartScatter, types.ChartEffectScatter, types.ChartKline:
+		default:
+			return fmt.Errorf("%w: series %d (%s) of type %s", ErrUnsupportedChart, i, s.Name, s.Type)
+		}
+		if s.XAxisIndex < 0 || s.XAxisIndex >= len(m.xAxes) {
+			return fmt.Errorf("series %d (%s): x axis %d not found", i, s.Name, s.XAxisIndex)
+		}
+		if s.YAxisIndex < 0 || s.YAxisIndex >= len(m.yAxes) {
+			return fmt.Errorf("series %d (%s): y axis %d not found", i, s.Name, s.YAxisIndex)
+		}
+
+		ser := &series{
+			name:       s.Name,
+			kind:       s.Type,
+			color:      colors[i%len(colors)],
+			color0:     defaultDownColor,
+			lineWidth:  defaultLineWidth,
+			symbol:     s.Symbol,
+			symbolSize: float64(s.SymbolSize),
+			x:          m.xAxes[s.XAxisIndex],
+			y:          m.yAxes[s.YAxisIndex],
+		}
+		if s.Type == types.ChartKline {
+			ser.color = defaultUpColor
+		}
+		if s.LineStyle != nil {
+			if s.LineStyle.Color != "" {
+				ser.color = s.LineStyle.Color
+			}
+			if s.LineStyle.Width > 0 {
+				ser.lineWidth = float64(s.LineStyle.Width)
+			}
+		}
+		if s.ItemStyle != nil {
+			if s.ItemStyle.Color != "" {
+				ser.color = s.ItemStyle.Color
+			}
+			if s.ItemStyle.Color0 != "" {
+				ser.color0 = s.ItemStyle.Color0
+			}
+			ser.border, ser.border0 = s.ItemStyle.BorderColor, s.ItemStyle.BorderColor0
+		}
+		if ser.border == "" {
+			ser.border = ser.color
+		}
+		if ser.border0 == "" {
+			ser.border0 = ser.color0
+		}
+		if ser.symbolSize <= 0 {
+			ser.symbolSize = defaultSymbolSize
+		}
+
+		var err error
+		if isEmpty(s.Data) && bc.Dataset.Source != nil {
+			if data == nil {
+				if data, err = newDataset(bc.Dataset); err != nil {
+					return err
+				}
+			}
+			ser.points, err = data.points(i, s, ser.x)
+		} else {
+			ser.points, err = dataPoints(s, ser.x)
+		}
+		if err != nil {
+			return fmt.Errorf("series %d (%s): %w", i, s.Name, err)
+		}
+		m.series = append(m.series, ser)
+	}
+
+	// INFO bars of the same x axis are drawn side by side
+	for _, x := range m.xAxes {
+		bars := make([]*series, 0)
+		for _, s := range m.series {
+			if s.x != x {
+				continue
+			}
+			if x.category {
+				x.extend(len(s.points))
+			}
+			if s.kind == types.ChartBar {
+				bars = append(bars, s)
+			}
+		}
+		for i, s := range bars {
+			s.bar, s.bars = i, len(bars)
+		}
+	}
+	return nil
+}
+
+// zoom applies the window of the data zooms to the category axes, the first data zoom of an axis wins
+func (m *model) zoom(bc *charts.BaseConfiguration) {
+	for _, a := range m.xAxes {
+		a.from, a.to = 0, len(a.labels)
+	}
+
+	zoomed := make(map[int]bool)
+	for _, dz := range bc.DataZoomList {
+		if dz.Start == 0 && dz.End == 0 {
+			continue
+		}
+		end := dz.End
+		if end == 0 {
+			end = 100
+		}
+		for _, i := range indexes(dz.XAxisIndex) {
+			if i < 0 || i >= len(m.xAxes) || zoomed[i] || !m.xAxes[i].category {
+				continue
+			}
+			zoomed[i] = true
+			a := m.xAxes[i]
+			n := float64(len(a.labels))
+			a.from = int(math.Floor(n * float64(dz.Start) / 100))
+			a.to = int(math.Ceil(n * float64(end) / 100))
+			if a.to > len(a.labels) {
+				a.to = len(a.labels)
+			}
+			if a.from >= a.to {
+				a.from = 0
+			}
+		}
+	}
+}
+
+// scaleAxis computes the bounds and the ticks of a value axis from the points of its series in the zoom window
+func (m *model) scaleAxis(a *axis, of func(*series) *axis, values func(point) []float64) {
+	if a.category {
+		return
+	}
+
+	lower, upper := math.Inf(1), math.Inf(-1)
+	for _, s := range m.series {
+		if of(s) != a {
+			continue
+		}
+		for _, p := range s.points {
+			if s.x.category && (p.x < float64(s.x.from) || p.x >= float64(s.x.to)) {
+				continue
+			}
+			for _, v := range values(p) {
+				if !math.IsNaN(v) {
+					lower, upper = math.Min(lower, v), math.Max(upper, v)
+				}
+			}
+		}
+	}
+	if math.IsInf(lower, 1) {
+		lower, upper = 0, 1
+	}
+	if !a.scale {
+		lower, upper = math.Min(lower, 0), math.Max(upper, 0)
+	}
+
+	n := a.splitNumber
+	if n <= 0 {
+		n = defaultSplitNumber
+	}
+	a.lower, a.upper, a.step = niceBounds(lower, upper, n)
+	if v, ok := number(a.min); ok {
+		a.lower = v
+	}
+	if v, ok := number(a.max); ok {
+		a.upper = v
+	}
+	if a.upper <= a.lower {
+		a.upper = a.lower + a.step
+	}
+
+	a.ticks = a.ticks[:0]
+	for t := math.Ceil(a.lower/a.step) * a.step; t <= a.upper+a.step/1e6; t += a.step {
+		a.ticks = append(a.ticks, t)
+	}
+}
+
+// niceBounds rounds the bounds to a step of 1, 2 or 5 times a power of 10, splitting them in about n intervals
+func niceBounds(lower, upper float64, n int) (float64, float64, float64) {
+	if upper == lower {
+		pad := math.Abs(lower) / 10
+		if pad == 0 {
+			pad = 1
+		}
+		lower, upper = lower-pad, upper+pad
+	}
+
+	raw := (upper - lower) / float64(n)
+	exp := math.Pow(10, math.Floor(math.Log10(raw)))
+	var step float64
+	switch f := raw / exp; {
+	case f <= 1:
+		step = exp
+	case f <= 2:
+		step = 2 * exp
+	case f <= 5:
+		step = 5 * exp
+	default:
+		step = 10 * exp
+	}
+	return math.Floor(lower/step) * step, math.Ceil(upper/step) * step, step
+}
+
+// label formats a tick of a value axis, the "{value}" placeholder of the formatter is replaced by the tick.
+// JavaScript formatters cannot be run and are ignored.
+func (a *axis) label(v float64) string {
+	decimals := 0
+	if a.step < 1 {
+		decimals = int(math.Ceil(-math.Log10(a.step)))
+	}
+	s := strconv.FormatFloat(v, 'f', decimals, 64)
+	if strings.Contains(a.formatter, "{value}") && !strings.Contains(a.formatter, "function") {
+		s = strings.ReplaceAll(a.formatter, "{value}", s)
+	}
+	return s
+}
+
+// pos returns the position of a value along the axis, a category is centered in its band
+func (a *axis) pos(v float64, horizontal bool) float64 {
+	if a.category {
+		if horizontal {
+			return a.grid.x + (v-float64(a.from)+0.5)*a.band(horizontal)
+		}
+		return a.grid.y + a.grid.h - (v-float64(a.from)+0.5)*a.band(horizontal)
+	}
+
+	ratio := (v - a.lower) / (a.upper - a.lower)
+	if horizontal {
+		return a.grid.x + ratio*a.grid.w
+	}
+	return a.grid.y + a.grid.h - ratio*a.grid.h
+}
+
+// band returns the width of a category
+func (a *axis) band(horizontal bool) float64 {
+	n := float64(a.to - a.from)
+	if n <= 0 {
+		n = 1
+	}
+	if horizontal {
+		return a.grid.w / n
+	}
+	return a.grid.h / n
+}
+
+// visible tells if a point of the series is in the zoom window of its x axis
+func (s *series) visible(p point) bool {
+	return !s.x.category || (p.x >= float64(s.x.from) && p.x < float64(s.x.to))
+}
+
+// pixels parses a size in pixels like "900px", falling back to def
+func pixels(s string, def float64) float64 {
+	v, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(s), "px"), 64)
+	if err != nil || v <= 0 {
+		return def
+	}
+	return v
+}
+
+// size parses a size in pixels or in percent of total, falling back to def
+func size(s string, total, def float64) float64 {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, "%") {
+		v, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+		if err != nil {
+			return def
+		}
+		return total * v / 100
+	}
+	v, err := strconv.ParseFloat(strings.TrimSuffix(s, "px"), 64)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// indexes returns the axis indexes of a data zoom, the first axis when not set
+func indexes(v interface{}) []int {
+	switch i := v.(type) {
+	case nil:
+		return []int{0}
+	case int:
+		return []int{i}
+	case []int:
+		return i
+	case float64:
+		return []int{int(i)}
+	case []interface{}:
+		result := make([]int, 0, len(i))
+		for _, x := range i {
+			if n, ok := number(x); ok {
+				result = append(result, int(n))
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// labels converts the data of a category axis to its labels
+func labels(data interface{}) []string {
+	values := items(data)
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, text(v))
+	}
+	return result
+}
+
+// items converts a slice of any type to the generic values of its JSON
+func items(data interface{}) []interface{} {
+	if data == nil {
+		return nil
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil
+	}
+	values := make([]interface{}, 0)
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil
+	}
+	return values
+}
+
+func isEmpty(data interface{}) bool {
+	return len(items(data)) == 0
+}
+
+func text(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// number converts a JSON or Go number, or a numeric string, to a float; "-" and null are missing values
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func value(v interface{}) float64 {
+	if n, ok := number(v); ok {
+		return n
+	}
+	return math.NaN()
+}
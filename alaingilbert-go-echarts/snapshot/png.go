@@ -0,0 +1,143 @@
+package snapshot
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"sort"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+type pngCanvas struct {
+	img *image.RGBA
+}
+
+func newPNGCanvas(width, height float64, background string) *pngCanvas {
+	c := &pngCanvas{img: image.NewRGBA(image.Rect(0, 0, int(math.Round(width)), int(math.Round(height))))}
+	c.fillRect(rect{w: width, h: height}, background)
+	return c
+}
+
+// set blends the color over the pixel
+func (c *pngCanvas) set(x, y int, col color.RGBA) {
+	if !(image.Point{X: x, Y: y}.In(c.img.Rect)) || col.A == 0 {
+		return
+	}
+	if col.A == 255 {
+		c.img.SetRGBA(x, y, col)
+		return
+	}
+	dst := c.img.RGBAAt(x, y)
+	a := float64(col.A) / 255
+	mix := func(s, d uint8) uint8 { return uint8(float64(s)*a + float64(d)*(1-a) + 0.5) }
+	c.img.SetRGBA(x, y, color.RGBA{R: mix(col.R, dst.R), G: mix(col.G, dst.G), B: mix(col.B, dst.B), A: 255})
+}
+
+func (c *pngCanvas) fillRect(r rect, col string) {
+	rgba := parseColor(col)
+	x0, y0 := int(math.Round(r.x)), int(math.Round(r.y))
+	x1, y1 := int(math.Round(r.x+r.w)), int(math.Round(r.y+r.h))
+	if x1 == x0 {
+		x1++
+	}
+	if y1 == y0 {
+		y1++
+	}
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			c.set(x, y, rgba)
+		}
+	}
+}
+
+// polyline strokes every segment as the pixels closer than half the width to it
+func (c *pngCanvas) polyline(points []pt, col string, width float64) {
+	rgba := parseColor(col)
+	half := math.Max(width, 1) / 2
+	for i := 1; i < len(points); i++ {
+		a, b := points[i-1], points[i]
+		x0, x1 := int(math.Floor(math.Min(a.x, b.x)-half)), int(math.Ceil(math.Max(a.x, b.x)+half))
+		y0, y1 := int(math.Floor(math.Min(a.y, b.y)-half)), int(math.Ceil(math.Max(a.y, b.y)+half))
+		for y := y0; y <= y1; y++ {
+			for x := x0; x <= x1; x++ {
+				if distance(pt{float64(x) + 0.5, float64(y) + 0.5}, a, b) <= half {
+					c.set(x, y, rgba)
+				}
+			}
+		}
+	}
+}
+
+// polygon fills the polygon scanline by scanline, between pairs of crossed edges
+func (c *pngCanvas) polygon(points []pt, col string) {
+	if len(points) < 3 {
+		return
+	}
+	rgba := parseColor(col)
+	y0, y1 := points[0].y, points[0].y
+	for _, p := range points {
+		y0, y1 = math.Min(y0, p.y), math.Max(y1, p.y)
+	}
+
+	for y := int(math.Floor(y0)); y <= int(math.Ceil(y1)); y++ {
+		cy := float64(y) + 0.5
+		crossings := make([]float64, 0, 4)
+		for i := range points {
+			a, b := points[i], points[(i+1)%len(points)]
+			if (a.y <= cy) != (b.y <= cy) {
+				crossings = append(crossings, a.x+(cy-a.y)/(b.y-a.y)*(b.x-a.x))
+			}
+		}
+		sort.Float64s(crossings)
+		for i := 0; i+1 < len(crossings); i += 2 {
+			for x := int(math.Round(crossings[i])); x < int(math.Round(crossings[i+1])); x++ {
+				c.set(x, y, rgba)
+			}
+		}
+	}
+}
+
+func (c *pngCanvas) circle(center pt, radius float64, col string) {
+	rgba := parseColor(col)
+	for y := int(math.Floor(center.y - radius)); y <= int(math.Ceil(center.y+radius)); y++ {
+		for x := int(math.Floor(center.x - radius)); x <= int(math.Ceil(center.x+radius)); x++ {
+			if math.Hypot(float64(x)+0.5-center.x, float64(y)+0.5-center.y) <= radius {
+				c.set(x, y, rgba)
+			}
+		}
+	}
+}
+
+// text draws with the 7x13 basic font, the bold text is drawn twice with an offset of one pixel
+func (c *pngCanvas) text(p pt, s string, col string, a anchor, bold bool) {
+	face := basicfont.Face7x13
+	width := font.MeasureString(face, s).Ceil()
+	x := int(math.Round(p.x))
+	switch a {
+	case anchorMiddle:
+		x -= width / 2
+	case anchorEnd:
+		x -= width
+	}
+
+	d := font.Drawer{Dst: c.img, Src: image.NewUniform(parseColor(col)), Face: face}
+	d.Dot = fixed.P(x, int(math.Round(p.y))+face.Ascent/2)
+	d.DrawString(s)
+	if bold {
+		d.Dot = fixed.P(x+1, int(math.Round(p.y))+face.Ascent/2)
+		d.DrawString(s)
+	}
+}
+
+// distance returns the distance from p to the segment ab
+func distance(p, a, b pt) float64 {
+	dx, dy := b.x-a.x, b.y-a.y
+	if dx == 0 && dy == 0 {
+		return math.Hypot(p.x-a.x, p.y-a.y)
+	}
+	t := clamp(((p.x-a.x)*dx+(p.y-a.y)*dy)/(dx*dx+dy*dy), 0, 1)
+	return math.Hypot(p.x-a.x-t*dx, p.y-a.y-t*dy)
+}
@@ -0,0 +1,62 @@
+// Package snapshot draws charts to SVG and PNG images without a browser, for reports and CI artifacts.
+//
+// It supports the rectangular charts (line, bar, scatter, effect scatter and kline, whose series can be mixed)
+// and draws their title, legend, axes and series, reading the data from the series or from the dataset.
+// Images do not match echarts pixel-for-pixel, but honor the colors, the grids, the axis indexes of the
+// series and the window of the data zoom.
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"image/png"
+	"io"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/components"
+)
+
+// ErrUnsupportedChart is returned for the charts and the series types which cannot be drawn.
+var ErrUnsupportedChart = errors.New("chart type not supported")
+
+// SVG draws the chart as an SVG image, after validating it like Render.
+// The size of the image is the size of the chart when given in pixels, 900x500 otherwise.
+func SVG(w io.Writer, chart components.Charter) error {
+	m, err := newModel(chart)
+	if err != nil {
+		return err
+	}
+
+	c := newSVGCanvas(m.width, m.height, m.background)
+	m.draw(c)
+	return c.write(w)
+}
+
+// PNG draws the chart as a PNG image, see SVG.
+func PNG(w io.Writer, chart components.Charter) error {
+	m, err := newModel(chart)
+	if err != nil {
+		return err
+	}
+
+	c := newPNGCanvas(m.width, m.height, m.background)
+	m.draw(c)
+	return png.Encode(w, c.img)
+}
+
+// baseConfiguration returns the options of the supported charts.
+func baseConfiguration(chart components.Charter) (*charts.BaseConfiguration, error) {
+	switch c := chart.(type) {
+	case *charts.Line:
+		return &c.BaseConfiguration, nil
+	case *charts.Bar:
+		return &c.BaseConfiguration, nil
+	case *charts.Scatter:
+		return &c.BaseConfiguration, nil
+	case *charts.EffectScatter:
+		return &c.BaseConfiguration, nil
+	case *charts.Kline:
+		return &c.BaseConfiguration, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedChart, chart.Type())
+}
@@ -0,0 +1,151 @@
+package snapshot
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLine() *charts.Line {
+	line := charts.NewLine()
+	line.SetGlobalOptions(
+		charts.WithTitleOpts(opts.Title{Title: "Prices", Subtitle: "daily"}),
+		charts.WithLegendOpts(opts.Legend{Show: true}),
+		charts.WithInitializationOpts(opts.Initialization{Width: "600px", Height: "300px"}),
+		charts.WithColorsOpts(opts.Colors{"#ff0000", "#0000ff"}),
+	)
+	line.SetXAxis([]string{"mon", "tue", "wed", "thu"}).
+		AddSeries("BTC", []opts.LineData{{Value: 10}, {Value: 12}, {Value: "-"}, {Value: 11}}).
+		AddSeries("ETH", []opts.LineData{{Value: 3}, {Value: 4}, {Value: 5}, {Value: 6}})
+	return line
+}
+
+// newKline mirrors the financial charts: a dataset with a header row, encodes by column name,
+// a second grid for the volumes and a data zoom
+func newKline() *charts.Kline {
+	kline := charts.NewKLine()
+	kline.SetGlobalOptions(
+		charts.WithTitleOpts(opts.Title{Title: "BTCUSDT"}),
+		charts.WithXAxisOpts(opts.XAxis{Type: "category"}),
+		charts.WithYAxisOpts(opts.YAxis{Scale: true}),
+		charts.WithDataZoomOpts(opts.DataZoom{Type: "inside", Start: 50, End: 100, XAxisIndex: []int{0, 1}}),
+		charts.WithGridOpts(opts.Grid{Left: "10%", Right: "10%", Bottom: "120px", Height: "200px"}),
+		charts.WithGridOpts(opts.Grid{Left: "10%", Right: "10%", Bottom: "40px", Height: "60px"}),
+	)
+	kline.ExtendXAxis(opts.XAxis{Type: "category", GridIndex: 1})
+	kline.ExtendYAxis(opts.YAxis{GridIndex: 1, SplitNumber: 2})
+	kline.Dataset = opts.Dataset{Source: [][]interface{}{
+		{"TIME", "OPEN", "CLOSE", "LOW", "HIGH", "VOLUME"},
+		{"10:00", 10, 12, 9, 13, 5},
+		{"10:01", 12, 11, 10, 12, 7},
+		{"10:02", 11, 15, 11, 16, 9},
+		{"10:03", 15, 13, 12, 15, 4},
+	}}
+	kline.AddSeries("BTCUSDT", nil,
+		charts.WithEncodeOpts(opts.Encode{X: "TIME", Y: []string{"OPEN", "CLOSE", "LOW", "HIGH"}}),
+		charts.WithItemStyleOpts(opts.ItemStyle{Color: "#00da3c", Color0: "#ec0000"}),
+	)
+	kline.MultiSeries = append(kline.MultiSeries, charts.SingleSeries{
+		Name: "Volume", Type: "bar", XAxisIndex: 1, YAxisIndex: 1, Data: nil,
+		Encode: &opts.Encode{X: "TIME", Y: "VOLUME"},
+	})
+	kline.MultiSeries = append(kline.MultiSeries, charts.SingleSeries{
+		Name: "Sell", Type: "scatter", Symbol: "triangle", SymbolSize: 12,
+		Data: []opts.ScatterData{{Value: []interface{}{"10:03", 16}, SymbolRotate: 180}},
+	})
+	return kline
+}
+
+func TestSVGLine(t *testing.T) {
+	buf := bytes.Buffer{}
+	assert.NoError(t, SVG(&buf, newLine()))
+	svg := buf.String()
+
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="600" height="300"`))
+	assert.Contains(t, svg, ">Prices</text>")
+	assert.Contains(t, svg, ">daily</text>")
+	assert.Contains(t, svg, ">BTC</text>")
+	assert.Contains(t, svg, ">wed</text>")
+	// the missing value breaks the first line in a segment and a point
+	assert.Equal(t, 2, strings.Count(svg, `stroke="#ff0000" stroke-width="2"`), "line and legend")
+	assert.Contains(t, svg, `<circle cx="480" cy="108" r="2" fill="#ff0000"/>`)
+	assert.Equal(t, 2, strings.Count(svg, `stroke="#0000ff" stroke-width="2"`))
+	assert.Contains(t, svg, ">15</text>", "y axis ticks")
+}
+
+func TestSVGKline(t *testing.T) {
+	buf := bytes.Buffer{}
+	assert.NoError(t, SVG(&buf, newKline()))
+	svg := buf.String()
+
+	// the zoom shows the last 2 candles, rising in green and falling in red, and their volumes
+	assert.Equal(t, 1, strings.Count(svg, `fill="#00da3c"`))
+	assert.Equal(t, 1, strings.Count(svg, `fill="#ec0000"`))
+	assert.NotContains(t, svg, ">10:00</text>")
+	assert.Equal(t, 2, strings.Count(svg, ">10:03</text>"))
+	assert.Equal(t, 2, strings.Count(svg, `fill="#91cc75"`), "volume bars in the second color")
+	assert.Contains(t, svg, `<polygon points="`, "event marker")
+	assert.Contains(t, svg, ">BTCUSDT</text>")
+}
+
+func TestSVGDefaultColors(t *testing.T) {
+	line := newLine()
+	line.Colors = nil
+
+	buf := bytes.Buffer{}
+	assert.NoError(t, SVG(&buf, line))
+	svg := buf.String()
+	assert.Equal(t, 2, strings.Count(svg, `stroke="#5470c6" stroke-width="2"`))
+	assert.Equal(t, 2, strings.Count(svg, `stroke="#91cc75" stroke-width="2"`))
+}
+
+func TestPNG(t *testing.T) {
+	buf := bytes.Buffer{}
+	assert.NoError(t, PNG(&buf, newLine()))
+	img, err := png.Decode(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 600, img.Bounds().Dx())
+	assert.Equal(t, 300, img.Bounds().Dy())
+
+	colors := make(map[color.RGBA]int)
+	for y := 0; y < img.Bounds().Dy(); y++ {
+		for x := 0; x < img.Bounds().Dx(); x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			colors[color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: uint8(a >> 8)}]++
+		}
+	}
+	assert.Greater(t, colors[color.RGBA{R: 255, A: 255}], 100)
+	assert.Greater(t, colors[color.RGBA{B: 255, A: 255}], 100)
+
+	buf.Reset()
+	assert.NoError(t, PNG(&buf, newKline()))
+}
+
+func TestErrors(t *testing.T) {
+	assert.ErrorIs(t, SVG(&bytes.Buffer{}, charts.NewPie()), ErrUnsupportedChart)
+
+	line := newLine()
+	line.MultiSeries[1].YAxisIndex = 1
+	validationErr := &charts.ValidationError{}
+	if assert.ErrorAs(t, SVG(&bytes.Buffer{}, line), &validationErr) {
+		assert.Equal(t, "series[1].yAxisIndex", validationErr.Issues[0].Option)
+	}
+
+	kline := newKline()
+	kline.MultiSeries[0].Encode.Y = []string{"OPEN", "CLOSE", "LOW", "MAX"}
+	assert.ErrorAs(t, SVG(&bytes.Buffer{}, kline), &validationErr)
+}
+
+func TestParseColor(t *testing.T) {
+	assert.Equal(t, color.RGBA{R: 0x54, G: 0x70, B: 0xc6, A: 255}, parseColor("#5470c6"))
+	assert.Equal(t, color.RGBA{R: 255, G: 255, A: 255}, parseColor("#ff0"))
+	assert.Equal(t, color.RGBA{R: 10, G: 20, B: 30, A: 127}, parseColor("rgba(10, 20, 30, 0.5)"))
+	assert.Equal(t, color.RGBA{A: 0}, parseColor("transparent"))
+	assert.Equal(t, color.RGBA{A: 255}, parseColor("not a color"))
+}
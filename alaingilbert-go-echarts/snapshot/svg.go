@@ -0,0 +1,79 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+type svgCanvas struct {
+	buf bytes.Buffer
+}
+
+func newSVGCanvas(width, height float64, background string) *svgCanvas {
+	c := &svgCanvas{}
+	fmt.Fprintf(&c.buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`+"\n",
+		num(width), num(height), num(width), num(height))
+	c.fillRect(rect{w: width, h: height}, background)
+	return c
+}
+
+func (c *svgCanvas) write(w io.Writer) error {
+	c.buf.WriteString("</svg>\n")
+	_, err := c.buf.WriteTo(w)
+	return err
+}
+
+func (c *svgCanvas) fillRect(r rect, color string) {
+	fmt.Fprintf(&c.buf, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s"/>`+"\n",
+		num(r.x), num(r.y), num(r.w), num(r.h), escape(color))
+}
+
+func (c *svgCanvas) polyline(points []pt, color string, width float64) {
+	fmt.Fprintf(&c.buf, `<polyline points="%s" fill="none" stroke="%s" stroke-width="%s" stroke-linejoin="round"/>`+"\n",
+		coordinates(points), escape(color), num(width))
+}
+
+func (c *svgCanvas) polygon(points []pt, color string) {
+	fmt.Fprintf(&c.buf, `<polygon points="%s" fill="%s"/>`+"\n", coordinates(points), escape(color))
+}
+
+func (c *svgCanvas) circle(center pt, radius float64, color string) {
+	fmt.Fprintf(&c.buf, `<circle cx="%s" cy="%s" r="%s" fill="%s"/>`+"\n",
+		num(center.x), num(center.y), num(radius), escape(color))
+}
+
+func (c *svgCanvas) text(p pt, s string, color string, a anchor, bold bool) {
+	anchors := map[anchor]string{anchorStart: "start", anchorMiddle: "middle", anchorEnd: "end"}
+	weight := ""
+	if bold {
+		weight = ` font-weight="bold"`
+	}
+	fmt.Fprintf(&c.buf, `<text x="%s" y="%s" font-family="sans-serif" font-size="%d" fill="%s" text-anchor="%s" dominant-baseline="middle"%s>%s</text>`+"\n",
+		num(p.x), num(p.y), fontSize, escape(color), anchors[a], weight, escape(s))
+}
+
+func coordinates(points []pt) string {
+	buf := bytes.Buffer{}
+	for i, p := range points {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(num(p.x) + "," + num(p.y))
+	}
+	return buf.String()
+}
+
+// num formats a coordinate with 2 decimals at most
+func num(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+func escape(s string) string {
+	buf := bytes.Buffer{}
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
@@ -6,14 +6,22 @@ var HeaderTpl = `
     <meta charset="utf-8">
     <title>{{ .PageTitle }}</title>
 {{- range .JSAssets.Values }}
+{{- if $.InlineAssets }}
+    <script src="{{ inlineJS $.AssetsHost . }}"></script>
+{{- else }}
     <script src="{{ . }}"></script>
 {{- end }}
+{{- end }}
 {{- range .CustomizedJSAssets.Values }}
     <script src="{{ . }}"></script>
 {{- end }}
 {{- range .CSSAssets.Values }}
+{{- if $.InlineAssets }}
+    <link href="{{ inlineCSS $.AssetsHost . }}" rel="stylesheet">
+{{- else }}
     <link href="{{ . }}" rel="stylesheet">
 {{- end }}
+{{- end }}
 {{- range .CustomizedCSSAssets.Values }}
     <link href="{{ . }}" rel="stylesheet">
 {{- end }}
@@ -0,0 +1,99 @@
+// Command go-csv-view renders charts of a CSV file to a standalone HTML file, or a chart to an SVG or PNG image,
+// or serves them over HTTP.
+//
+// Usage:
+//
+//	go-csv-view render [flags]
+//	go-csv-view serve [flags]
+//
+// Charts are described either by a spec file (-spec) or by the column and chart flags.
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	_ "github.com/bygui86/go-csv-view/alaingilbert-go-echarts/assets/inline"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+)
+
+const usage = `Usage:
+  go-csv-view render [flags]   render the charts to a standalone HTML file, or a chart to an SVG or PNG image
+  go-csv-view serve [flags]    serve the charts over HTTP
+
+Run 'go-csv-view <command> -h' for the flags of a command.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	// the same spec renders the same HTML, so the rendered files can be committed and diffed
+	opts.SetChartIDStrategy(opts.HashChartID)
+
+	var err error
+	switch os.Args[1] {
+	case "render":
+		err = render(os.Args[2:])
+	case "serve":
+		err = serve(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		fmt.Fprint(os.Stdout, usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func render(args []string) error {
+	o := &options{}
+	if parseErr := o.flagSet("render", false).Parse(args); parseErr != nil {
+		return parseErr
+	}
+
+	s, specErr := o.spec()
+	if specErr != nil {
+		return specErr
+	}
+
+	if renderErr := s.RenderFile(); renderErr != nil {
+		return renderErr
+	}
+	log.Printf("charts rendered to %s", s.Output)
+	return nil
+}
+
+func serve(args []string) error {
+	o := &options{}
+	if parseErr := o.flagSet("serve", true).Parse(args); parseErr != nil {
+		return parseErr
+	}
+
+	s, specErr := o.spec()
+	if specErr != nil {
+		return specErr
+	}
+
+	// the CSV file is loaded on every request, so a reload of the page shows the latest data
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		if renderErr := s.Render(w); renderErr != nil {
+			log.Printf("charts rendering failed: %v", renderErr)
+			http.Error(w, renderErr.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	log.Printf("serving charts on %s", o.addr)
+	return http.ListenAndServe(o.addr, nil)
+}
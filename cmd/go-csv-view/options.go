@@ -0,0 +1,136 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"path/filepath"
+	"strings"
+
+	"github.com/bygui86/go-csv-view/spec"
+)
+
+const (
+	defaultChartType = spec.SeriesLine
+	defaultAddr      = ":8081"
+)
+
+// options are the flags shared by the render and serve commands.
+type options struct {
+	specFile  string
+	csvFile   string
+	x         string
+	y         string
+	chartType string
+	ohlc      string
+	title     string
+	output    string
+	addr      string
+	inline    bool
+}
+
+func (o *options) flagSet(name string, server bool) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.StringVar(&o.specFile, "spec", "", "YAML or JSON spec file describing the charts, other chart flags are ignored")
+	fs.StringVar(&o.csvFile, "csv", "", "CSV file to load")
+	fs.StringVar(&o.x, "x", "", "column used as X axis")
+	fs.StringVar(&o.y, "y", "", "comma separated columns drawn as series")
+	fs.StringVar(&o.chartType, "type", defaultChartType, "chart type: line, bar, scatter or kline")
+	fs.StringVar(&o.ohlc, "ohlc", "", "comma separated open,high,low,close columns of a kline chart")
+	fs.StringVar(&o.title, "title", "", "chart title, defaults to the CSV file name")
+	fs.BoolVar(&o.inline, "inline", false, "inline echarts and the themes in the HTML, to get a single file working offline")
+	if server {
+		fs.StringVar(&o.addr, "addr", defaultAddr, "HTTP listen address")
+	} else {
+		fs.StringVar(&o.output, "o", "", "output HTML file, or SVG or PNG image with the .svg or .png extension, defaults to the CSV file name with .html extension")
+	}
+	return fs
+}
+
+// spec returns the spec loaded from the spec file or built from the chart flags.
+func (o *options) spec() (*spec.Spec, error) {
+	var s *spec.Spec
+	if o.specFile != "" {
+		loaded, loadErr := spec.Load(o.specFile)
+		if loadErr != nil {
+			return nil, loadErr
+		}
+		s = loaded
+	} else {
+		built, buildErr := o.buildSpec()
+		if buildErr != nil {
+			return nil, buildErr
+		}
+		s = built
+	}
+
+	if o.output != "" {
+		// the flag is relative to the working directory, not to the spec file
+		output, absErr := filepath.Abs(o.output)
+		if absErr != nil {
+			return nil, absErr
+		}
+		s.Output = output
+	}
+	if o.inline {
+		s.Inline = true
+	}
+	return s, nil
+}
+
+func (o *options) buildSpec() (*spec.Spec, error) {
+	if o.csvFile == "" {
+		return nil, errors.New("either -spec or -csv must be set")
+	}
+
+	title := o.title
+	if title == "" {
+		title = filepath.Base(o.csvFile)
+	}
+	chart := spec.Chart{
+		Title: title,
+		X:     o.x,
+		DataZoom: []spec.DataZoom{
+			{Start: 0, End: 100},
+		},
+	}
+
+	if o.chartType == spec.SeriesKline {
+		ohlc := splitColumns(o.ohlc)
+		if len(ohlc) != 4 {
+			return nil, errors.New("-ohlc must list the open,high,low,close columns")
+		}
+		chart.YAxes = []spec.Axis{{Scale: true}}
+		chart.Series = append(chart.Series, spec.Series{
+			Type: spec.SeriesKline,
+			OHLC: &spec.OHLC{Open: ohlc[0], High: ohlc[1], Low: ohlc[2], Close: ohlc[3]},
+		})
+		// other columns, like moving averages, are drawn as lines over the candles
+		for _, column := range splitColumns(o.y) {
+			chart.Series = append(chart.Series, spec.Series{Type: spec.SeriesLine, Column: column})
+		}
+	} else {
+		for _, column := range splitColumns(o.y) {
+			chart.Series = append(chart.Series, spec.Series{Type: o.chartType, Column: column})
+		}
+	}
+
+	s := &spec.Spec{
+		CSV:    o.csvFile,
+		Output: strings.TrimSuffix(o.csvFile, filepath.Ext(o.csvFile)) + ".html",
+		Charts: []spec.Chart{chart},
+	}
+	if validErr := s.Validate(); validErr != nil {
+		return nil, validErr
+	}
+	return s, nil
+}
+
+func splitColumns(list string) []string {
+	var columns []string
+	for _, column := range strings.Split(list, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
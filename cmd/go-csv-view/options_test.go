@@ -0,0 +1,65 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionsSpecFromFlags(t *testing.T) {
+	o := &options{}
+	err := o.flagSet("render", false).Parse([]string{
+		"-csv", "data/ohlcv.csv", "-x", "OPENED_AT", "-y", "CLOSE, OPEN", "-type", "bar",
+	})
+	assert.NoError(t, err)
+
+	s, err := o.spec()
+	assert.NoError(t, err)
+	assert.Equal(t, "data/ohlcv.html", s.Output)
+	assert.Equal(t, "ohlcv.csv", s.Charts[0].Title)
+	assert.Equal(t, []spec.Series{
+		{Type: spec.SeriesBar, Column: "CLOSE"},
+		{Type: spec.SeriesBar, Column: "OPEN"},
+	}, s.Charts[0].Series)
+}
+
+func TestOptionsKline(t *testing.T) {
+	o := &options{
+		csvFile:   "ohlcv.csv",
+		x:         "OPENED_AT",
+		chartType: spec.SeriesKline,
+		ohlc:      "OPEN,HIGH,LOW,CLOSE",
+		y:         "EMA",
+	}
+	s, err := o.spec()
+	assert.NoError(t, err)
+	assert.Equal(t, &spec.OHLC{Open: "OPEN", High: "HIGH", Low: "LOW", Close: "CLOSE"}, s.Charts[0].Series[0].OHLC)
+	assert.Equal(t, spec.Series{Type: spec.SeriesLine, Column: "EMA"}, s.Charts[0].Series[1])
+
+	o.ohlc = "OPEN,HIGH"
+	_, err = o.spec()
+	assert.Error(t, err)
+}
+
+func TestOptionsSpecFile(t *testing.T) {
+	o := &options{specFile: "../../spec/testdata/ohlcv.yaml", output: "out.html"}
+	s, err := o.spec()
+	assert.NoError(t, err)
+
+	abs, _ := filepath.Abs("out.html")
+	assert.Equal(t, abs, s.Output)
+	assert.Equal(t, spec.SeriesKline, s.Charts[0].Series[0].Type)
+	assert.False(t, s.Inline)
+
+	o.inline = true
+	s, err = o.spec()
+	assert.NoError(t, err)
+	assert.True(t, s.Inline)
+}
+
+func TestOptionsMissingCSV(t *testing.T) {
+	_, err := (&options{chartType: defaultChartType}).spec()
+	assert.Error(t, err)
+}
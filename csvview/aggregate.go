@@ -0,0 +1,202 @@
+package csvview
+
+import (
+	"math"
+	"time"
+)
+
+// AggFunc reduces the values of a group to a single value.
+type AggFunc int
+
+const (
+	Sum AggFunc = iota
+	Mean
+	Min
+	Max
+	First
+	Last
+	Count
+)
+
+// String returns the name of the aggregation function.
+func (f AggFunc) String() string {
+	switch f {
+	case Mean:
+		return "mean"
+	case Min:
+		return "min"
+	case Max:
+		return "max"
+	case First:
+		return "first"
+	case Last:
+		return "last"
+	case Count:
+		return "count"
+	default:
+		return "sum"
+	}
+}
+
+// Aggregate is a reduction of a float column computed for every group.
+type Aggregate struct {
+	// Column holding the values to aggregate, not needed by Count.
+	Column string
+	Func   AggFunc
+
+	// Name of the aggregated column in the result table, defaults to <Column>_<Func>.
+	Name string
+}
+
+func (a Aggregate) name() string {
+	if a.Name != "" {
+		return a.Name
+	}
+	if a.Column == "" {
+		return a.Func.String()
+	}
+	return a.Column + "_" + a.Func.String()
+}
+
+// KeyFunc returns the group key of a row.
+type KeyFunc func(row *Row) (string, error)
+
+// ByColumn groups the rows by the raw value of the given column.
+func ByColumn(name string) KeyFunc {
+	return func(row *Row) (string, error) {
+		return row.String(name)
+	}
+}
+
+// ByInterval groups the rows by the value of the given timestamp column truncated to the interval.
+// Keys are formatted as RFC3339.
+func ByInterval(name string, interval time.Duration) KeyFunc {
+	return func(row *Row) (string, error) {
+		t, err := row.Time(name)
+		if err != nil {
+			return "", err
+		}
+		return t.Truncate(interval).Format(time.RFC3339), nil
+	}
+}
+
+// GroupBy aggregates rows by key while they are read, so memory is bounded by the number of groups.
+// Groups keep the order in which their keys are first seen.
+type GroupBy struct {
+	key        KeyFunc
+	aggregates []Aggregate
+	keys       []string
+	groups     map[string][]accumulator
+}
+
+// accumulator holds the running state of an aggregate for a group.
+type accumulator struct {
+	count int
+	sum   float64
+	min   float64
+	max   float64
+	first float64
+	last  float64
+}
+
+// NewGroupBy creates a GroupBy computing the given aggregates for every key.
+func NewGroupBy(key KeyFunc, aggregates ...Aggregate) *GroupBy {
+	return &GroupBy{
+		key:        key,
+		aggregates: aggregates,
+		groups:     make(map[string][]accumulator),
+	}
+}
+
+// Add accumulates the row into its group. Empty cells are ignored by all functions but Count.
+func (g *GroupBy) Add(row *Row) error {
+	key, keyErr := g.key(row)
+	if keyErr != nil {
+		return keyErr
+	}
+
+	accs, found := g.groups[key]
+	if !found {
+		accs = make([]accumulator, len(g.aggregates))
+		g.keys = append(g.keys, key)
+	}
+
+	for i, a := range g.aggregates {
+		if a.Func == Count {
+			accs[i].count++
+			continue
+		}
+		v, valueErr := row.Float(a.Column)
+		if valueErr != nil {
+			return valueErr
+		}
+		if !math.IsNaN(v) {
+			accs[i].add(v)
+		}
+	}
+	g.groups[key] = accs
+	return nil
+}
+
+// Len returns the number of groups.
+func (g *GroupBy) Len() int {
+	return len(g.keys)
+}
+
+// Keys returns the group keys in the order they were first seen.
+func (g *GroupBy) Keys() []string {
+	return g.keys
+}
+
+// Values returns the values of the i-th aggregate, one per group. Groups without values are NaN.
+func (g *GroupBy) Values(i int) []float64 {
+	values := make([]float64, 0, len(g.keys))
+	for _, key := range g.keys {
+		values = append(values, g.groups[key][i].value(g.aggregates[i].Func))
+	}
+	return values
+}
+
+// Table returns the groups as a table, with a key column followed by one column per aggregate.
+func (g *GroupBy) Table(keyName string) (*Table, error) {
+	columns := make([]*Column, 0, len(g.aggregates)+1)
+	columns = append(columns, newColumn(keyName, g.keys))
+	for i, a := range g.aggregates {
+		columns = append(columns, NewFloatColumn(a.name(), g.Values(i)))
+	}
+	return NewTable(columns...)
+}
+
+func (a *accumulator) add(v float64) {
+	if a.count == 0 {
+		a.min, a.max, a.first = v, v, v
+	}
+	a.count++
+	a.sum += v
+	a.min = math.Min(a.min, v)
+	a.max = math.Max(a.max, v)
+	a.last = v
+}
+
+func (a *accumulator) value(f AggFunc) float64 {
+	if f == Count {
+		return float64(a.count)
+	}
+	if a.count == 0 {
+		return math.NaN()
+	}
+	switch f {
+	case Mean:
+		return a.sum / float64(a.count)
+	case Min:
+		return a.min
+	case Max:
+		return a.max
+	case First:
+		return a.first
+	case Last:
+		return a.last
+	default:
+		return a.sum
+	}
+}
@@ -0,0 +1,66 @@
+package csvview
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupByColumn(t *testing.T) {
+	group := NewGroupBy(ByColumn("BUCKET"),
+		Aggregate{Column: "SIZE", Func: Sum},
+		Aggregate{Column: "PRICE", Func: Mean, Name: "AVG_PRICE"},
+		Aggregate{Func: Count},
+	)
+	assert.NoError(t, Each("testdata/trades.csv", group.Add))
+
+	assert.Equal(t, 2, group.Len())
+	assert.Equal(t, []string{"2022-01-01-00", "2022-01-01-01"}, group.Keys())
+	assert.InDeltaSlice(t, []float64{0.3075, 0.5}, group.Values(0), 1e-9)
+	assert.InDeltaSlice(t, []float64{46220.9625, 46300}, group.Values(1), 1e-9)
+	assert.Equal(t, []float64{4, 1}, group.Values(2))
+
+	table, err := group.Table("BUCKET")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"BUCKET", "SIZE_sum", "AVG_PRICE", "count"}, table.Names())
+	prices, err := table.Floats("AVG_PRICE")
+	assert.NoError(t, err)
+	assert.InDelta(t, 46300, prices[1], 1e-9)
+}
+
+func TestGroupByInterval(t *testing.T) {
+	group := NewGroupBy(ByInterval("TIMESTAMP", time.Minute),
+		Aggregate{Column: "PRICE", Func: First},
+		Aggregate{Column: "PRICE", Func: Max},
+		Aggregate{Column: "PRICE", Func: Min},
+		Aggregate{Column: "PRICE", Func: Last},
+	)
+	assert.NoError(t, Each("testdata/trades.csv", group.Add, Equal("BUCKET", "2022-01-01-00")))
+
+	assert.Equal(t, []string{"2022-01-01T00:00:00Z", "2022-01-01T00:01:00Z"}, group.Keys())
+	assert.Equal(t, []float64{46216.93, 46230}, group.Values(0))
+	assert.Equal(t, []float64{46220, 46230}, group.Values(1))
+	assert.Equal(t, []float64{46216.92, 46230}, group.Values(2))
+	assert.Equal(t, []float64{46220, 46230}, group.Values(3))
+}
+
+func TestGroupByEmptyValues(t *testing.T) {
+	stream, err := NewStream(strings.NewReader("K,V\na,\na,\nb,2\n"))
+	assert.NoError(t, err)
+
+	group := NewGroupBy(ByColumn("K"), Aggregate{Column: "V", Func: Mean})
+	assert.NoError(t, stream.Each(group.Add))
+
+	values := group.Values(0)
+	assert.True(t, math.IsNaN(values[0]))
+	assert.Equal(t, 2.0, values[1])
+
+	table, err := group.Table("K")
+	assert.NoError(t, err)
+	raw, err := table.Strings("V_mean")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "2"}, raw)
+}
@@ -0,0 +1,186 @@
+package csvview
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// ColumnType is the type inferred for the values of a column.
+type ColumnType int
+
+const (
+	TypeString ColumnType = iota
+	TypeInt
+	TypeFloat
+	TypeTime
+)
+
+// String returns the name of the column type.
+func (ct ColumnType) String() string {
+	switch ct {
+	case TypeInt:
+		return "int"
+	case TypeFloat:
+		return "float"
+	case TypeTime:
+		return "time"
+	default:
+		return "string"
+	}
+}
+
+// Column is a named column of a Table.
+type Column struct {
+	Name string
+	Type ColumnType
+
+	raw    []string
+	ints   []int64
+	floats []float64
+	times  []time.Time
+}
+
+// NewColumn creates a column from raw values, inferring its type.
+// Empty values are ignored while inferring, a column with only empty values is a string column.
+func NewColumn(name string, values []string) *Column {
+	return newColumn(name, values)
+}
+
+// NewFloatColumn creates a float column from computed values, NaN values are empty cells.
+func NewFloatColumn(name string, values []float64) *Column {
+	raw := make([]string, 0, len(values))
+	for _, v := range values {
+		if math.IsNaN(v) {
+			raw = append(raw, "")
+			continue
+		}
+		raw = append(raw, strconv.FormatFloat(v, 'f', -1, 64))
+	}
+	return &Column{Name: name, Type: TypeFloat, raw: raw, floats: values}
+}
+
+func newColumn(name string, values []string) *Column {
+	c := &Column{Name: name, Type: TypeString, raw: values}
+	switch {
+	case c.parseInts():
+		c.Type = TypeInt
+	case c.parseFloats():
+		c.Type = TypeFloat
+	case c.parseTimes():
+		c.Type = TypeTime
+	}
+	return c
+}
+
+// Len returns the number of values of the column.
+func (c *Column) Len() int {
+	return len(c.raw)
+}
+
+// Strings returns the raw values of the column.
+func (c *Column) Strings() []string {
+	return c.raw
+}
+
+// Floats returns the values of an int or float column, empty cells are NaN.
+func (c *Column) Floats() ([]float64, error) {
+	switch c.Type {
+	case TypeFloat:
+		return c.floats, nil
+	case TypeInt:
+		floats := make([]float64, len(c.ints))
+		for i, v := range c.ints {
+			if c.raw[i] == "" {
+				floats[i] = math.NaN()
+				continue
+			}
+			floats[i] = float64(v)
+		}
+		return floats, nil
+	default:
+		return nil, c.typeErr(TypeFloat)
+	}
+}
+
+// Ints returns the values of an int column, empty cells are 0.
+func (c *Column) Ints() ([]int64, error) {
+	if c.Type != TypeInt {
+		return nil, c.typeErr(TypeInt)
+	}
+	return c.ints, nil
+}
+
+// Times returns the values of a timestamp column, empty cells are zero time.
+func (c *Column) Times() ([]time.Time, error) {
+	if c.Type != TypeTime {
+		return nil, c.typeErr(TypeTime)
+	}
+	return c.times, nil
+}
+
+func (c *Column) typeErr(expected ColumnType) error {
+	return fmt.Errorf("%w: column %q is %s, not %s", ErrColumnType, c.Name, c.Type, expected)
+}
+
+func (c *Column) parseInts() bool {
+	ints := make([]int64, len(c.raw))
+	found := false
+	for i, v := range c.raw {
+		if v == "" {
+			continue
+		}
+		val, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return false
+		}
+		ints[i] = val
+		found = true
+	}
+	if found {
+		c.ints = ints
+	}
+	return found
+}
+
+func (c *Column) parseFloats() bool {
+	floats := make([]float64, len(c.raw))
+	found := false
+	for i, v := range c.raw {
+		if v == "" {
+			floats[i] = math.NaN()
+			continue
+		}
+		val, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return false
+		}
+		floats[i] = val
+		found = true
+	}
+	if found {
+		c.floats = floats
+	}
+	return found
+}
+
+func (c *Column) parseTimes() bool {
+	times := make([]time.Time, len(c.raw))
+	found := false
+	for i, v := range c.raw {
+		if v == "" {
+			continue
+		}
+		val, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return false
+		}
+		times[i] = val
+		found = true
+	}
+	if found {
+		c.times = times
+	}
+	return found
+}
@@ -0,0 +1,212 @@
+package csvview
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Stream reads a CSV row by row, so only the values the caller keeps are held in memory.
+// The first record is used as header.
+type Stream struct {
+	reader  *csv.Reader
+	header  []string
+	index   map[string]int
+	filters []Filter
+	row     Row
+}
+
+// Row is the current record of a Stream, addressed by header name.
+// A Row is only valid until the next call to Stream.Next: copy the values to keep them.
+type Row struct {
+	index  map[string]int
+	record []string
+	line   int
+}
+
+// Filter tells if a row is kept by a Stream.
+type Filter func(row *Row) (bool, error)
+
+// NewStream reads the header from the given reader and returns a Stream over the remaining rows.
+// Rows not matching all the given filters are skipped.
+func NewStream(r io.Reader, filters ...Filter) (*Stream, error) {
+	reader := csv.NewReader(r)
+	reader.LazyQuotes = true
+	reader.ReuseRecord = true
+
+	record, readErr := reader.Read()
+	if errors.Is(readErr, io.EOF) {
+		return nil, ErrEmptyFile
+	}
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	header := cleanHeader(record)
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[name] = i
+	}
+	return &Stream{
+		reader:  reader,
+		header:  header,
+		index:   index,
+		filters: filters,
+		row:     Row{index: index},
+	}, nil
+}
+
+// Each opens the CSV file at the given path and calls fn for every row matching all the filters.
+func Each(filePath string, fn func(row *Row) error, filters ...Filter) error {
+	file, openErr := os.Open(filePath)
+	if openErr != nil {
+		return openErr
+	}
+	defer file.Close()
+
+	stream, streamErr := NewStream(file, filters...)
+	if streamErr != nil {
+		return streamErr
+	}
+	return stream.Each(fn)
+}
+
+// Header returns the column names in header order.
+func (s *Stream) Header() []string {
+	return s.header
+}
+
+// Next returns the next row matching all the filters, or io.EOF when the input is over.
+func (s *Stream) Next() (*Row, error) {
+	for {
+		record, readErr := s.reader.Read()
+		if readErr != nil {
+			return nil, readErr
+		}
+		s.row.record = record
+		s.row.line, _ = s.reader.FieldPos(0)
+
+		keep, filterErr := s.keep(&s.row)
+		if filterErr != nil {
+			return nil, fmt.Errorf("line %d: %w", s.row.line, filterErr)
+		}
+		if keep {
+			return &s.row, nil
+		}
+	}
+}
+
+// Each calls fn for every remaining row matching all the filters, stopping at the first error.
+func (s *Stream) Each(fn func(row *Row) error) error {
+	for {
+		row, nextErr := s.Next()
+		if errors.Is(nextErr, io.EOF) {
+			return nil
+		}
+		if nextErr != nil {
+			return nextErr
+		}
+		if fnErr := fn(row); fnErr != nil {
+			return fmt.Errorf("line %d: %w", row.line, fnErr)
+		}
+	}
+}
+
+func (s *Stream) keep(row *Row) (bool, error) {
+	for _, filter := range s.filters {
+		keep, err := filter(row)
+		if err != nil || !keep {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Line returns the line number of the row in the file, header included.
+func (r *Row) Line() int {
+	return r.line
+}
+
+// String returns the raw value of the given column.
+func (r *Row) String(name string) (string, error) {
+	i, found := r.index[name]
+	if !found {
+		return "", fmt.Errorf("%w %q", ErrUnknownColumn, name)
+	}
+	return r.record[i], nil
+}
+
+// Float returns the value of the given column as float, an empty cell is NaN.
+func (r *Row) Float(name string) (float64, error) {
+	v, err := r.String(name)
+	if err != nil {
+		return 0, err
+	}
+	if v == "" {
+		return math.NaN(), nil
+	}
+	val, parseErr := strconv.ParseFloat(v, 64)
+	if parseErr != nil {
+		return 0, fmt.Errorf("%w: column %q value %q is not %s", ErrColumnType, name, v, TypeFloat)
+	}
+	return val, nil
+}
+
+// Int returns the value of the given column as int, an empty cell is 0.
+func (r *Row) Int(name string) (int64, error) {
+	v, err := r.String(name)
+	if err != nil || v == "" {
+		return 0, err
+	}
+	val, parseErr := strconv.ParseInt(v, 10, 64)
+	if parseErr != nil {
+		return 0, fmt.Errorf("%w: column %q value %q is not %s", ErrColumnType, name, v, TypeInt)
+	}
+	return val, nil
+}
+
+// Time returns the value of the given column as RFC3339 timestamp, an empty cell is zero time.
+func (r *Row) Time(name string) (time.Time, error) {
+	v, err := r.String(name)
+	if err != nil || v == "" {
+		return time.Time{}, err
+	}
+	val, parseErr := time.Parse(time.RFC3339, v)
+	if parseErr != nil {
+		return time.Time{}, fmt.Errorf("%w: column %q value %q is not %s", ErrColumnType, name, v, TypeTime)
+	}
+	return val, nil
+}
+
+// Equal keeps the rows where the given column has exactly the given value.
+func Equal(name, value string) Filter {
+	return func(row *Row) (bool, error) {
+		v, err := row.String(name)
+		return v == value, err
+	}
+}
+
+// Between keeps the rows where the given float column is in [min, max]. Empty cells are skipped.
+func Between(name string, min, max float64) Filter {
+	return func(row *Row) (bool, error) {
+		v, err := row.Float(name)
+		return v >= min && v <= max, err
+	}
+}
+
+// TimeRange keeps the rows where the given timestamp column is in [from, to).
+// A zero from or to leaves the range open on that side. Empty cells are skipped.
+func TimeRange(name string, from, to time.Time) Filter {
+	return func(row *Row) (bool, error) {
+		v, err := row.Time(name)
+		if err != nil || v.IsZero() {
+			return false, err
+		}
+		return (from.IsZero() || !v.Before(from)) && (to.IsZero() || v.Before(to)), nil
+	}
+}
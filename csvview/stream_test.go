@@ -0,0 +1,77 @@
+package csvview
+
+import (
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamNext(t *testing.T) {
+	stream, err := NewStream(strings.NewReader("\ufeffName,Sales\nfoo,1.5\nbar,\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Name", "Sales"}, stream.Header())
+
+	row, err := stream.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, row.Line())
+	name, err := row.String("Name")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", name)
+	sales, err := row.Float("Sales")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, sales)
+
+	row, err = stream.Next()
+	assert.NoError(t, err)
+	sales, err = row.Float("Sales")
+	assert.NoError(t, err)
+	assert.True(t, math.IsNaN(sales))
+	_, err = row.Float("Name")
+	assert.ErrorIs(t, err, ErrColumnType)
+	_, err = row.String("MISSING")
+	assert.ErrorIs(t, err, ErrUnknownColumn)
+
+	_, err = stream.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestStreamEmpty(t *testing.T) {
+	_, err := NewStream(strings.NewReader(""))
+	assert.ErrorIs(t, err, ErrEmptyFile)
+}
+
+func TestEachWithFilters(t *testing.T) {
+	var ids []int64
+	err := Each("testdata/trades.csv", func(row *Row) error {
+		id, idErr := row.Int("TRADE_ID")
+		ids = append(ids, id)
+		return idErr
+	},
+		Equal("SIDE", "ASK"),
+		TimeRange("TIMESTAMP", time.Time{}, time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1207691977, 1207691979}, ids)
+
+	var sizes []float64
+	err = Each("testdata/trades.csv", func(row *Row) error {
+		size, sizeErr := row.Float("SIZE")
+		sizes = append(sizes, size)
+		return sizeErr
+	}, Between("SIZE", 0.1, 0.2))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{0.1, 0.2}, sizes)
+}
+
+func TestEachErrorHasLine(t *testing.T) {
+	err := Each("testdata/trades.csv", func(row *Row) error {
+		_, timeErr := row.Time("SIDE")
+		return timeErr
+	})
+	assert.ErrorIs(t, err, ErrColumnType)
+	assert.Contains(t, err.Error(), "line 2")
+}
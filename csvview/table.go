@@ -0,0 +1,181 @@
+// Package csvview loads CSV files into tables of typed columns addressed by header name.
+package csvview
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+const utf8BOM = "\ufeff"
+
+var (
+	// ErrEmptyFile is returned when the CSV has no header row.
+	ErrEmptyFile = errors.New("csv file is empty")
+
+	// ErrUnknownColumn is returned when a column name is not part of the header.
+	ErrUnknownColumn = errors.New("unknown column")
+
+	// ErrColumnType is returned when a column is read with an accessor not matching its type.
+	ErrColumnType = errors.New("wrong column type")
+)
+
+// Table is a CSV file loaded in memory, with column names taken from the header
+// and a type inferred for every column.
+type Table struct {
+	columns []*Column
+	index   map[string]int
+	rows    int
+}
+
+// Load opens the CSV file at the given path and reads it into a Table.
+func Load(filePath string) (*Table, error) {
+	file, openErr := os.Open(filePath)
+	if openErr != nil {
+		return nil, openErr
+	}
+	defer file.Close()
+
+	return Read(file)
+}
+
+// Read reads a CSV from the given reader into a Table.
+// The first record is used as header.
+func Read(r io.Reader) (*Table, error) {
+	reader := csv.NewReader(r)
+	reader.LazyQuotes = true
+	records, readErr := reader.ReadAll()
+	if readErr != nil {
+		return nil, readErr
+	}
+	if len(records) == 0 {
+		return nil, ErrEmptyFile
+	}
+
+	header := cleanHeader(records[0])
+	values := make([][]string, len(header))
+	for i := range values {
+		values[i] = make([]string, 0, len(records)-1)
+	}
+	for _, record := range records[1:] {
+		for i := range header {
+			values[i] = append(values[i], record[i])
+		}
+	}
+
+	columns := make([]*Column, 0, len(header))
+	for i, name := range header {
+		columns = append(columns, newColumn(name, values[i]))
+	}
+	return NewTable(columns...)
+}
+
+// NewTable creates a Table from the given columns, which must all have the same length.
+func NewTable(columns ...*Column) (*Table, error) {
+	t := &Table{index: make(map[string]int, len(columns))}
+	for _, c := range columns {
+		if _, found := t.index[c.Name]; found {
+			return nil, fmt.Errorf("duplicated column %q", c.Name)
+		}
+		if len(t.columns) > 0 && c.Len() != t.rows {
+			return nil, fmt.Errorf("column %q has %d rows, expected %d", c.Name, c.Len(), t.rows)
+		}
+		t.index[c.Name] = len(t.columns)
+		t.columns = append(t.columns, c)
+		t.rows = c.Len()
+	}
+	return t, nil
+}
+
+// Len returns the number of rows, header excluded.
+func (t *Table) Len() int {
+	return t.rows
+}
+
+// Names returns the column names in header order.
+func (t *Table) Names() []string {
+	names := make([]string, 0, len(t.columns))
+	for _, c := range t.columns {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+// Has checks if the table contains the given column.
+func (t *Table) Has(name string) bool {
+	_, found := t.index[name]
+	return found
+}
+
+// Column returns the column with the given name.
+func (t *Table) Column(name string) (*Column, error) {
+	i, found := t.index[name]
+	if !found {
+		return nil, fmt.Errorf("%w %q", ErrUnknownColumn, name)
+	}
+	return t.columns[i], nil
+}
+
+// Type returns the inferred type of the given column.
+func (t *Table) Type(name string) (ColumnType, error) {
+	c, err := t.Column(name)
+	if err != nil {
+		return TypeString, err
+	}
+	return c.Type, nil
+}
+
+// Strings returns the raw values of the given column, whatever its type.
+func (t *Table) Strings(name string) ([]string, error) {
+	c, err := t.Column(name)
+	if err != nil {
+		return nil, err
+	}
+	return c.Strings(), nil
+}
+
+// Floats returns the values of the given int or float column.
+// Empty cells are returned as NaN.
+func (t *Table) Floats(name string) ([]float64, error) {
+	c, err := t.Column(name)
+	if err != nil {
+		return nil, err
+	}
+	return c.Floats()
+}
+
+// Ints returns the values of the given int column.
+// Empty cells are returned as 0.
+func (t *Table) Ints(name string) ([]int64, error) {
+	c, err := t.Column(name)
+	if err != nil {
+		return nil, err
+	}
+	return c.Ints()
+}
+
+// Times returns the values of the given RFC3339 timestamp column.
+// Empty cells are returned as zero time.
+func (t *Table) Times(name string) ([]time.Time, error) {
+	c, err := t.Column(name)
+	if err != nil {
+		return nil, err
+	}
+	return c.Times()
+}
+
+// cleanHeader strips the UTF-8 BOM some editors prepend to the file and surrounding spaces.
+func cleanHeader(header []string) []string {
+	names := make([]string, 0, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, utf8BOM)
+		}
+		names = append(names, strings.TrimSpace(name))
+	}
+	return names
+}
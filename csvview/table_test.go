@@ -0,0 +1,99 @@
+package csvview
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadInfersColumnTypes(t *testing.T) {
+	table, err := Load("testdata/ohlcv.csv")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, table.Len())
+	assert.Equal(t,
+		[]string{"CLOSED_AT", "OPENED_AT", "OPEN", "HIGH", "LOW", "CLOSE", "VOLUME", "COMPONENT", "BUCKET"},
+		table.Names())
+
+	expected := map[string]ColumnType{
+		"CLOSED_AT": TypeTime,
+		"OPENED_AT": TypeTime,
+		"OPEN":      TypeFloat,
+		"VOLUME":    TypeFloat,
+		"COMPONENT": TypeString,
+		"BUCKET":    TypeInt,
+	}
+	for name, colType := range expected {
+		actual, typeErr := table.Type(name)
+		assert.NoError(t, typeErr)
+		assert.Equal(t, colType, actual, name)
+	}
+}
+
+func TestTableAccessors(t *testing.T) {
+	table, err := Load("testdata/ohlcv.csv")
+	assert.NoError(t, err)
+
+	opens, err := table.Floats("OPEN")
+	assert.NoError(t, err)
+	assert.Equal(t, 46216.93, opens[0])
+
+	buckets, err := table.Ints("BUCKET")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2022), buckets[4])
+
+	bucketsAsFloats, err := table.Floats("BUCKET")
+	assert.NoError(t, err)
+	assert.Equal(t, 2022.0, bucketsAsFloats[4])
+
+	times, err := table.Times("OPENED_AT")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 1, 1, 0, 1, 0, 0, time.UTC), times[1])
+
+	labels, err := table.Strings("OPENED_AT")
+	assert.NoError(t, err)
+	assert.Equal(t, "2022-01-01T00:01:00Z", labels[1])
+
+	_, err = table.Floats("MISSING")
+	assert.ErrorIs(t, err, ErrUnknownColumn)
+
+	_, err = table.Ints("OPEN")
+	assert.ErrorIs(t, err, ErrColumnType)
+
+	_, err = table.Floats("COMPONENT")
+	assert.ErrorIs(t, err, ErrColumnType)
+}
+
+func TestReadEmptyCellsAndBOM(t *testing.T) {
+	input := "\ufeffName,Sales\nfoo,1.5\nbar,\nbaz,2\n"
+	table, err := Read(strings.NewReader(input))
+	assert.NoError(t, err)
+	assert.True(t, table.Has("Name"))
+
+	sales, err := table.Floats("Sales")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, sales[0])
+	assert.True(t, math.IsNaN(sales[1]))
+	assert.Equal(t, 2.0, sales[2])
+}
+
+func TestReadEmptyFile(t *testing.T) {
+	_, err := Read(strings.NewReader(""))
+	assert.ErrorIs(t, err, ErrEmptyFile)
+}
+
+func TestNewTableMismatchedColumns(t *testing.T) {
+	_, err := NewTable(
+		NewColumn("a", []string{"1", "2"}),
+		NewColumn("b", []string{"1"}),
+	)
+	assert.Error(t, err)
+
+	_, err = NewTable(
+		NewColumn("a", []string{"1"}),
+		NewColumn("a", []string{"1"}),
+	)
+	assert.Error(t, err)
+}
@@ -0,0 +1,223 @@
+// Package downsample reduces the number of points of a series before it is added to a chart,
+// keeping its visual shape and the X axis labels of the kept points.
+//
+// LTTB and MinMax select points of the original series and return their indexes, so that
+// the same selection can be applied to the X axis labels and to the other series sharing it.
+// Average computes a new value per bucket, labeled with the first point of the bucket.
+package downsample
+
+import (
+	"fmt"
+	"math"
+)
+
+// Method is a downsampling algorithm.
+type Method int
+
+const (
+	// LTTB is Largest-Triangle-Three-Buckets, keeping the points with the largest visual effect.
+	LTTB Method = iota
+	// MinMax keeps the minimum and the maximum of every bucket, preserving spikes.
+	MinMax
+	// Average replaces every bucket with the mean of its values.
+	Average
+)
+
+// String returns the name of the method.
+func (m Method) String() string {
+	switch m {
+	case MinMax:
+		return "minmax"
+	case Average:
+		return "average"
+	default:
+		return "lttb"
+	}
+}
+
+// ParseMethod returns the method with the given name.
+func ParseMethod(name string) (Method, error) {
+	for _, m := range []Method{LTTB, MinMax, Average} {
+		if m.String() == name {
+			return m, nil
+		}
+	}
+	return LTTB, fmt.Errorf("unknown downsampling method %q", name)
+}
+
+// Downsample reduces the series to about threshold points with the given method,
+// returning the X labels and the values of the kept points.
+// Series already shorter than threshold, or a threshold lower than 3, are returned unchanged.
+func Downsample(method Method, x []string, y []float64, threshold int) ([]string, []float64) {
+	switch method {
+	case MinMax:
+		indexes := MinMaxIndexes(y, threshold)
+		return SelectStrings(x, indexes), SelectFloats(y, indexes)
+	case Average:
+		indexes, values := AverageBuckets(y, threshold)
+		return SelectStrings(x, indexes), values
+	default:
+		indexes := LTTBIndexes(y, threshold)
+		return SelectStrings(x, indexes), SelectFloats(y, indexes)
+	}
+}
+
+// LTTBIndexes returns the indexes of the points kept by Largest-Triangle-Three-Buckets.
+// The first and the last points are always kept, the others are picked one per bucket.
+// The point index is used as X coordinate, NaN values are picked only for buckets without other values.
+func LTTBIndexes(y []float64, threshold int) []int {
+	if threshold >= len(y) || threshold < 3 {
+		return all(len(y))
+	}
+
+	indexes := make([]int, 0, threshold)
+	indexes = append(indexes, 0)
+
+	// first and last points are kept, the others are split into threshold-2 buckets
+	bucketSize := float64(len(y)-2) / float64(threshold-2)
+	a := 0
+	for b := 0; b < threshold-2; b++ {
+		start, end := bucket(b, bucketSize)
+
+		// average of the next bucket, the last point for the last bucket
+		nextStart, nextEnd := bucket(b+1, bucketSize)
+		if nextEnd > len(y)-1 {
+			nextEnd = len(y) - 1
+		}
+		if nextStart >= nextEnd {
+			nextStart, nextEnd = len(y)-1, len(y)
+		}
+		avgX, avgY := mean(y, nextStart, nextEnd)
+
+		maxArea := -1.0
+		picked := start
+		for i := start; i < end; i++ {
+			if math.IsNaN(y[i]) {
+				continue
+			}
+			area := math.Abs((float64(a)-avgX)*(y[i]-y[a]) - (float64(a)-float64(i))*(avgY-y[a]))
+			if area > maxArea {
+				maxArea = area
+				picked = i
+			}
+		}
+		indexes = append(indexes, picked)
+		a = picked
+	}
+
+	return append(indexes, len(y)-1)
+}
+
+// MinMaxIndexes returns the indexes of the minimum and the maximum of every bucket, in index order.
+// About threshold points are kept, two per bucket, NaN values are ignored.
+func MinMaxIndexes(y []float64, threshold int) []int {
+	if threshold >= len(y) || threshold < 3 {
+		return all(len(y))
+	}
+
+	buckets := threshold / 2
+	bucketSize := float64(len(y)) / float64(buckets)
+	indexes := make([]int, 0, threshold)
+	for b := 0; b < buckets; b++ {
+		start, end := int(float64(b)*bucketSize), int(float64(b+1)*bucketSize)
+		if b == buckets-1 {
+			end = len(y)
+		}
+
+		minIndex, maxIndex := -1, -1
+		for i := start; i < end; i++ {
+			if math.IsNaN(y[i]) {
+				continue
+			}
+			if minIndex < 0 || y[i] < y[minIndex] {
+				minIndex = i
+			}
+			if maxIndex < 0 || y[i] > y[maxIndex] {
+				maxIndex = i
+			}
+		}
+
+		switch {
+		case minIndex < 0:
+			// only NaN values, keep the gap
+			indexes = append(indexes, start)
+		case minIndex == maxIndex:
+			indexes = append(indexes, minIndex)
+		case minIndex < maxIndex:
+			indexes = append(indexes, minIndex, maxIndex)
+		default:
+			indexes = append(indexes, maxIndex, minIndex)
+		}
+	}
+	return indexes
+}
+
+// AverageBuckets splits the series into threshold buckets and returns the index of the first point
+// and the mean of every bucket. NaN values are ignored, a bucket of only NaN values has NaN mean.
+func AverageBuckets(y []float64, threshold int) ([]int, []float64) {
+	if threshold >= len(y) || threshold < 3 {
+		return all(len(y)), y
+	}
+
+	bucketSize := float64(len(y)) / float64(threshold)
+	indexes := make([]int, 0, threshold)
+	values := make([]float64, 0, threshold)
+	for b := 0; b < threshold; b++ {
+		start, end := int(float64(b)*bucketSize), int(float64(b+1)*bucketSize)
+		if b == threshold-1 {
+			end = len(y)
+		}
+		_, avg := mean(y, start, end)
+		indexes = append(indexes, start)
+		values = append(values, avg)
+	}
+	return indexes, values
+}
+
+// SelectStrings returns the values at the given indexes, typically the X axis labels.
+func SelectStrings(values []string, indexes []int) []string {
+	selected := make([]string, 0, len(indexes))
+	for _, i := range indexes {
+		selected = append(selected, values[i])
+	}
+	return selected
+}
+
+// SelectFloats returns the values at the given indexes.
+func SelectFloats(values []float64, indexes []int) []float64 {
+	selected := make([]float64, 0, len(indexes))
+	for _, i := range indexes {
+		selected = append(selected, values[i])
+	}
+	return selected
+}
+
+// bucket returns the [start, end) indexes of the b-th LTTB bucket, skipping the first point.
+func bucket(b int, size float64) (int, int) {
+	return int(float64(b)*size) + 1, int(float64(b+1)*size) + 1
+}
+
+// mean returns the mean index and the mean of the non NaN values in [start, end).
+func mean(y []float64, start, end int) (float64, float64) {
+	sumX, sumY, count := 0.0, 0.0, 0
+	for i := start; i < end; i++ {
+		if math.IsNaN(y[i]) {
+			continue
+		}
+		sumX += float64(i)
+		sumY += y[i]
+		count++
+	}
+	if count == 0 {
+		return float64(start+end-1) / 2, math.NaN()
+	}
+	return sumX / float64(count), sumY / float64(count)
+}
+
+func all(n int) []int {
+	indexes := make([]int, n)
+	for i := range indexes {
+		indexes[i] = i
+	}
+	return indexes
+}
@@ -0,0 +1,91 @@
+package downsample
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func series(n int, f func(i int) float64) ([]string, []float64) {
+	x := make([]string, n)
+	y := make([]float64, n)
+	for i := range y {
+		x[i] = strconv.Itoa(i)
+		y[i] = f(i)
+	}
+	return x, y
+}
+
+func TestLTTBIndexes(t *testing.T) {
+	_, y := series(1000, func(i int) float64 { return math.Sin(float64(i) / 50) })
+	y[500] = 10 // spike
+
+	indexes := LTTBIndexes(y, 100)
+	assert.Len(t, indexes, 100)
+	assert.Equal(t, 0, indexes[0])
+	assert.Equal(t, 999, indexes[99])
+	assert.Contains(t, indexes, 500)
+	for i := 1; i < len(indexes); i++ {
+		assert.Greater(t, indexes[i], indexes[i-1])
+	}
+}
+
+func TestLTTBIndexesShortSeries(t *testing.T) {
+	assert.Equal(t, []int{0, 1, 2}, LTTBIndexes([]float64{1, 2, 3}, 10))
+	assert.Equal(t, []int{0, 1, 2, 3}, LTTBIndexes([]float64{1, 2, 3, 4}, 2))
+}
+
+func TestLTTBIndexesSkipsNaN(t *testing.T) {
+	_, y := series(100, func(i int) float64 {
+		if i%2 == 0 {
+			return math.NaN()
+		}
+		return float64(i)
+	})
+	y[0], y[99] = 0, 99
+	for _, i := range LTTBIndexes(y, 10) {
+		assert.False(t, math.IsNaN(y[i]), i)
+	}
+}
+
+func TestMinMaxIndexes(t *testing.T) {
+	y := []float64{1, 5, 3, 2, 0, 4, 9, 8, math.NaN(), 7, 6, 1}
+	assert.Equal(t, []int{0, 1, 4, 5, 6, 7, 9, 11}, MinMaxIndexes(y, 8))
+}
+
+func TestAverageBuckets(t *testing.T) {
+	y := []float64{1, 3, 5, 7, math.NaN(), 2, math.NaN(), math.NaN(), 4}
+	indexes, values := AverageBuckets(y, 3)
+	assert.Equal(t, []int{0, 3, 6}, indexes)
+	assert.Equal(t, 3.0, values[0])
+	assert.Equal(t, 4.5, values[1])
+	assert.Equal(t, 4.0, values[2])
+}
+
+func TestDownsampleKeepsLabels(t *testing.T) {
+	x, y := series(300, func(i int) float64 { return float64(i % 7) })
+
+	for _, method := range []Method{LTTB, MinMax, Average} {
+		dx, dy := Downsample(method, x, y, 30)
+		assert.Len(t, dx, len(dy), method.String())
+		assert.LessOrEqual(t, len(dy), 30, method.String())
+		if method != Average {
+			for i, label := range dx {
+				index, _ := strconv.Atoi(label)
+				assert.Equal(t, y[index], dy[i], method.String())
+			}
+		}
+	}
+}
+
+func TestParseMethod(t *testing.T) {
+	for _, method := range []Method{LTTB, MinMax, Average} {
+		parsed, err := ParseMethod(method.String())
+		assert.NoError(t, err)
+		assert.Equal(t, method, parsed)
+	}
+	_, err := ParseMethod("median")
+	assert.Error(t, err)
+}
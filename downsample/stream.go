@@ -0,0 +1,109 @@
+package downsample
+
+import "math"
+
+// Point is a point kept by a Stream: its X label and its values, the first value picking the point.
+type Point struct {
+	Label  string
+	Values []float64
+
+	index int
+}
+
+// Stream downsamples a series while its points are read, for series too long to be held in memory.
+// Like MinMaxIndexes it keeps the minimum and the maximum of every bucket of consecutive points, but
+// the buckets have a fixed size: when they reach threshold/2, adjacent buckets are merged in pairs and
+// the size of the buckets doubles. Memory is bounded by threshold, whatever the number of points.
+type Stream struct {
+	buckets    []streamBucket
+	maxBuckets int
+	size       int
+	count      int
+}
+
+// streamBucket holds the points of a bucket with the minimum and the maximum first value
+type streamBucket struct {
+	count    int
+	min, max *Point
+}
+
+// NewStream creates a Stream keeping about threshold points, at least 2.
+func NewStream(threshold int) *Stream {
+	maxBuckets := threshold / 2
+	if maxBuckets < 1 {
+		maxBuckets = 1
+	}
+	return &Stream{maxBuckets: maxBuckets, size: 1}
+}
+
+// Add reads the next point of the series, NaN first values are ignored.
+func (s *Stream) Add(label string, values ...float64) {
+	index := s.count
+	s.count++
+
+	if len(s.buckets) == 0 || s.buckets[len(s.buckets)-1].count == s.size {
+		if len(s.buckets) == s.maxBuckets {
+			s.merge()
+		}
+		if len(s.buckets) == 0 || s.buckets[len(s.buckets)-1].count == s.size {
+			s.buckets = append(s.buckets, streamBucket{})
+		}
+	}
+
+	b := &s.buckets[len(s.buckets)-1]
+	b.count++
+	if len(values) == 0 || math.IsNaN(values[0]) {
+		return
+	}
+	p := &Point{Label: label, Values: append([]float64(nil), values...), index: index}
+	if b.min == nil || p.Values[0] < b.min.Values[0] {
+		b.min = p
+	}
+	if b.max == nil || p.Values[0] > b.max.Values[0] {
+		b.max = p
+	}
+}
+
+// merge merges the buckets in pairs, doubling their size
+func (s *Stream) merge() {
+	merged := s.buckets[:0]
+	for i := 0; i < len(s.buckets); i += 2 {
+		b := s.buckets[i]
+		if i+1 < len(s.buckets) {
+			next := s.buckets[i+1]
+			b.count += next.count
+			if b.min == nil || (next.min != nil && next.min.Values[0] < b.min.Values[0]) {
+				b.min = next.min
+			}
+			if b.max == nil || (next.max != nil && next.max.Values[0] > b.max.Values[0]) {
+				b.max = next.max
+			}
+		}
+		merged = append(merged, b)
+	}
+	s.buckets = merged
+	s.size *= 2
+}
+
+// Len returns the number of points read.
+func (s *Stream) Len() int {
+	return s.count
+}
+
+// Points returns the points kept, in the order they were read.
+func (s *Stream) Points() []Point {
+	points := make([]Point, 0, 2*len(s.buckets))
+	for _, b := range s.buckets {
+		switch {
+		case b.min == nil:
+			// only NaN values
+		case b.min == b.max:
+			points = append(points, *b.min)
+		case b.min.index < b.max.index:
+			points = append(points, *b.min, *b.max)
+		default:
+			points = append(points, *b.max, *b.min)
+		}
+	}
+	return points
+}
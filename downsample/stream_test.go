@@ -0,0 +1,44 @@
+package downsample
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream(t *testing.T) {
+	x, y := series(10000, func(i int) float64 { return math.Sin(float64(i) / 500) })
+	y[5000] = 10 // spike
+	y[7000] = math.NaN()
+
+	s := NewStream(100)
+	for i := range y {
+		s.Add(x[i], y[i], float64(i))
+	}
+	assert.Equal(t, 10000, s.Len())
+	assert.LessOrEqual(t, len(s.buckets), 50)
+
+	points := s.Points()
+	assert.LessOrEqual(t, len(points), 100)
+	assert.Greater(t, len(points), 50)
+	assert.Contains(t, points, Point{Label: "5000", Values: []float64{10, 5000}, index: 5000})
+	for i, p := range points {
+		assert.Equal(t, p.Label, x[int(p.Values[1])])
+		if i > 0 {
+			assert.Greater(t, p.index, points[i-1].index)
+		}
+	}
+}
+
+func TestStreamShortSeries(t *testing.T) {
+	s := NewStream(10)
+	for i, v := range []float64{3, 1, math.NaN(), 2} {
+		s.Add(string(rune('a'+i)), v)
+	}
+	labels := make([]string, 0)
+	for _, p := range s.Points() {
+		labels = append(labels, p.Label)
+	}
+	assert.Equal(t, []string{"a", "b", "d"}, labels)
+}
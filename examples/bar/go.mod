@@ -2,4 +2,14 @@ module github.com/bygui86/go-csv-view/examples/bar
 
 go 1.17
 
-require github.com/go-echarts/go-echarts/v2 v2.2.4
+require (
+	github.com/bygui86/go-csv-view v0.0.0
+	github.com/bygui86/go-csv-view/alaingilbert-go-echarts v0.0.0
+)
+
+require github.com/davecgh/go-spew v1.1.1 // indirect
+
+replace (
+	github.com/bygui86/go-csv-view => ../..
+	github.com/bygui86/go-csv-view/alaingilbert-go-echarts => ../../alaingilbert-go-echarts
+)
@@ -1,36 +1,29 @@
 package main
 
 import (
-	"encoding/csv"
 	"log"
+	"math"
 	"os"
 	"sort"
-	"strconv"
 
-	"github.com/go-echarts/go-echarts/v2/charts"
-	"github.com/go-echarts/go-echarts/v2/opts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/components"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/csvview"
 )
 
 // *** MAIN
 
 func main() {
-	file, err := os.Open("games.csv")
+	table, err := csvview.Load("games.csv")
 	if err != nil {
 		log.Fatal(err)
 	}
-	defer file.Close()
 
-	reader := csv.NewReader(file)
-	reader.LazyQuotes = true
-	records, err := reader.ReadAll()
+	names, sales, err := formatRecords(table)
 	if err != nil {
 		log.Fatal(err)
 	}
-
-	//var names []string
-	//var sales []float64
-	//names, sales = formatRecords(records)
-	names, sales := formatRecords(records)
 	sortedData := mapData(names, sales)
 	err = createChart(sortedData)
 	if err != nil {
@@ -55,27 +48,36 @@ func (d DataList) Swap(i, j int) {
 	d[i], d[j] = d[j], d[i]
 }
 
+// Less orders by value, then the ties by name, reversed like the values, so that the games of equal sales
+// keep the same order whatever the order of the map they are read from
 func (d DataList) Less(i, j int) bool {
-	return d[i].Value < d[j].Value
+	if d[i].Value != d[j].Value {
+		return d[i].Value < d[j].Value
+	}
+	return d[i].Key > d[j].Key
 }
 
 // *** FUNCTIONS
 
-func formatRecords(records [][]string) ([]string, []float64) {
-	gameNames := []string{}
-	sales := []float64{}
-	for _, r := range records[1:] {
-		gameNames = append(gameNames, r[0])
-		val, _ := strconv.ParseFloat(r[1], 64)
-		sales = append(sales, val)
+func formatRecords(table *csvview.Table) ([]string, []float64, error) {
+	gameNames, err := table.Strings("Name")
+	if err != nil {
+		return nil, nil, err
+	}
+	sales, err := table.Floats("Sales")
+	if err != nil {
+		return nil, nil, err
 	}
-	return gameNames, sales
+	return gameNames, sales, nil
 }
 
 func mapData(gameNames []string, sales []float64) DataList {
 	dataMap := map[string]float64{}
 	for index, value := range gameNames {
-		dataMap[value] = sales[index]
+		// games without sales are not ranked
+		if !math.IsNaN(sales[index]) {
+			dataMap[value] = sales[index]
+		}
 	}
 	data := make(DataList, len(dataMap))
 	iterator := 0
@@ -95,8 +97,7 @@ func createChart(sortedData DataList) error {
 			Subtitle: "Best selling PC games",
 		}),
 		charts.WithInitializationOpts(opts.Initialization{
-			PageTitle: "go-echarts bar example",
-			Theme:     "dark",
+			Theme: "dark",
 		}),
 	)
 	bar.SetXAxis([]string{
@@ -104,8 +105,17 @@ func createChart(sortedData DataList) error {
 		sortedData[4].Key[:4], sortedData[5].Key[:4], sortedData[6].Key[:4],
 		sortedData[7].Key[:4], sortedData[8].Key[:4], sortedData[9].Key[:4],
 	}).AddSeries("Values", generateBarItems(sortedData))
-	f, _ := os.Create("games.html")
-	return bar.Render(f)
+
+	// the chart ID is derived from the title, regenerating the unchanged chart gives the same HTML file
+	page := components.NewPage().SetChartIDStrategy(opts.HashChartID)
+	page.PageTitle = "go-echarts bar example"
+	page.AddCharts(bar)
+
+	f, createErr := os.Create("games.html")
+	if createErr != nil {
+		return createErr
+	}
+	return page.Render(f)
 }
 
 func generateBarItems(data DataList) []opts.BarData {
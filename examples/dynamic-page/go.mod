@@ -3,12 +3,23 @@ module github.com/bygui86/go-csv-view/examples/dynamic-page
 go 1.17
 
 require (
+	github.com/bygui86/go-csv-view v0.0.0
+	github.com/bygui86/go-csv-view/alaingilbert-go-echarts v0.0.0
 	github.com/go-echarts/go-echarts/v2 v2.2.4
 	github.com/rs/cors v1.8.2
+	github.com/stretchr/testify v1.7.0
 	go.uber.org/zap v1.20.0
 )
 
 require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/multierr v1.6.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace (
+	github.com/bygui86/go-csv-view => ../..
+	github.com/bygui86/go-csv-view/alaingilbert-go-echarts => ../../alaingilbert-go-echarts
 )
@@ -2,8 +2,15 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/bygui86/go-csv-view/examples/dynamic-page/manager"
 	"github.com/bygui86/go-csv-view/examples/dynamic-page/viewer"
@@ -22,27 +29,97 @@ const (
 	address         = "localhost:8080"
 	pagePath        = "/page"
 	viewPath        = pagePath + "/view/%s"
-	interval        = 2000 // milliseconds
-	shutdownTimeout = 10   // seconds
+	interval        = 2000             // milliseconds
+	heapCandle      = 10 * time.Second // INFO duration of the candles of the heap viewer
+	shutdownTimeout = 10               // seconds
+)
+
+var (
+	tailFile    = flag.String("tail", "", "CSV file to follow while it is appended to, e.g. BINANCE_trades_spot_60_BTC-USDT_2022-01-01_00.csv")
+	tailTime    = flag.String("tail-time", "TIMESTAMP", "RFC3339 time column of the followed CSV file")
+	tailColumns = flag.String("tail-columns", "PRICE", "comma separated float columns of the followed CSV file")
+	tailType    = flag.String("tail-type", viewer.ChartLine, "chart type of the followed CSV file: line, bar, scatter, gauge or kline (needs OPEN,HIGH,LOW,CLOSE columns)")
+	adminDir    = flag.String("admin-data-dir", "", "directory of the CSV files which the admin endpoint can follow, the endpoint is disabled when empty")
 )
 
 func main() {
+	flag.Parse()
+
 	setupLogger()
 
 	zap.L().Info("Starting dynamic page")
 
-	ctx, cancel := context.WithCancel(context.Background())
+	// INFO the manager stops gracefully on CTRL+C
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
 	viewerName := "stack"
-	stackViewer := viewer.NewViewer(
+	stackViewer, stackErr := viewer.NewViewer(
+		viewerName,
+		address,
+		fmt.Sprintf(viewPath, viewerName),
+		viewer.NewMemStatsSource(),
+		viewer.Options{Units: "MB", Interval: interval},
+		ctx,
+		shutdownTimeout,
+	)
+	if stackErr != nil {
+		zap.S().Fatalf("viewer creation failed: %s", stackErr.Error())
+	}
+
+	viewerName = "goroutines"
+	goroutinesViewer, goroutinesErr := viewer.NewViewer(
+		viewerName,
+		address,
+		fmt.Sprintf(viewPath, viewerName),
+		viewer.NewFuncSource(
+			func() []float64 { return []float64{float64(runtime.NumGoroutine())} },
+			"Goroutines",
+		),
+		viewer.Options{Type: viewer.ChartGauge, Interval: interval},
+		ctx,
+		shutdownTimeout,
+	)
+	if goroutinesErr != nil {
+		zap.S().Fatalf("viewer creation failed: %s", goroutinesErr.Error())
+	}
+
+	// INFO live candlestick: the last candle is updated at every fetch until the next one starts
+	viewerName = "heap"
+	heapViewer, heapErr := viewer.NewViewer(
 		viewerName,
 		address,
 		fmt.Sprintf(viewPath, viewerName),
+		viewer.NewCandleSource(
+			viewer.NewFuncSource(func() []float64 { return []float64{heapAlloc()} }, "Heap"),
+			heapCandle,
+		),
+		viewer.Options{Type: viewer.ChartKline, Units: "MB", Interval: interval},
 		ctx,
-		interval,
 		shutdownTimeout,
 	)
+	if heapErr != nil {
+		zap.S().Fatalf("viewer creation failed: %s", heapErr.Error())
+	}
+
+	viewers := []*viewer.Viewer{stackViewer, goroutinesViewer, heapViewer}
+
+	if *tailFile != "" {
+		viewerName = "tail"
+		tailViewer, tailErr := viewer.NewViewer(
+			viewerName,
+			address,
+			fmt.Sprintf(viewPath, viewerName),
+			viewer.NewCSVSource(*tailFile, *tailTime, strings.Split(*tailColumns, ",")...),
+			viewer.Options{Type: *tailType, Interval: interval},
+			ctx,
+			shutdownTimeout,
+		)
+		if tailErr != nil {
+			zap.S().Fatalf("viewer creation failed: %s", tailErr.Error())
+		}
+		viewers = append(viewers, tailViewer)
+	}
 
 	zap.L().Info("Viewers created")
 
@@ -51,15 +128,30 @@ func main() {
 		pagePath,
 		ctx,
 		shutdownTimeout,
-		stackViewer,
+		viewers...,
 	)
 
+	if *adminDir != "" {
+		if adminErr := mng.EnableAdmin(*adminDir); adminErr != nil {
+			zap.S().Fatalf("admin endpoint setup failed: %s", adminErr.Error())
+		}
+	}
+
 	zap.L().Info("Manager created")
 
 	err := mng.Start()
 	if err != nil {
-		zap.S().Fatalf("manager start failed: %s", err.Error())
+		zap.S().Fatalf("manager failed: %s", err.Error())
 	}
+
+	zap.L().Info("Dynamic page stopped")
+}
+
+// heapAlloc returns the bytes of allocated heap objects in MB
+func heapAlloc() float64 {
+	memStats := runtime.MemStats{}
+	runtime.ReadMemStats(&memStats)
+	return float64(memStats.HeapAlloc) / 1024 / 1024
 }
 
 func setupLogger() {
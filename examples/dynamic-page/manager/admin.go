@@ -0,0 +1,198 @@
+package manager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bygui86/go-csv-view/examples/dynamic-page/viewer"
+	"go.uber.org/zap"
+)
+
+// ViewerInfo describes a viewer in the admin endpoint responses
+type ViewerInfo struct {
+	Name    string   `json:"name"`
+	Path    string   `json:"path"`
+	ChartID string   `json:"chartId"`
+	Type    string   `json:"type"`
+	Series  []string `json:"series"`
+}
+
+// viewerName is the pattern of the names of the viewers added by the admin endpoint, part of their path
+var viewerName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// newCSVSource creates the sources of the viewers added by the admin endpoint, replaced by the tests
+var newCSVSource = func(filePath, timeColumn string, columns ...string) viewer.Source {
+	return viewer.NewCSVSource(filePath, timeColumn, columns...)
+}
+
+// NewCSVViewer is the body of the admin endpoint request adding a viewer following a CSV file, see viewer.CSVSource.
+// CSV is the path of the file relative to the data directory, see EnableAdmin.
+// The chart options are optional, a kline chart needs the open, high, low and close columns.
+type NewCSVViewer struct {
+	Name       string   `json:"name"`
+	CSV        string   `json:"csv"`
+	TimeColumn string   `json:"timeColumn"`
+	Columns    []string `json:"columns"`
+	viewer.Options
+}
+
+// EnableAdmin serves the admin endpoint, disabled by default, following the CSV files of the given directory.
+// It must be called before Start.
+func (m *Manager) EnableAdmin(dataDir string) error {
+	absDir, absErr := filepath.Abs(dataDir)
+	if absErr != nil {
+		return absErr
+	}
+	realDir, evalErr := filepath.EvalSymlinks(absDir)
+	if evalErr != nil {
+		return fmt.Errorf("admin data directory: %w", evalErr)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dataDir = realDir
+
+	zap.S().Infof("Admin endpoint enabled on %s - data directory %s", m.adminPath, m.dataDir)
+
+	return nil
+}
+
+// adminHandler lists the viewers (GET), adds a viewer following a CSV file (POST with a NewCSVViewer body)
+// and removes a viewer (DELETE with the "name" query parameter).
+// INFO the endpoint is not covered by CORS and the POST body must be JSON, so that the pages of other sites
+// cannot call it from the browser of the operator, see setupMux
+func (m *Manager) adminHandler(w http.ResponseWriter, r *http.Request) {
+	zap.S().Infof("Admin handler - %s", r.Method)
+
+	m.mu.Lock()
+	dataDir := m.dataDir
+	m.mu.Unlock()
+	if dataDir == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		m.writeViewers(w, http.StatusOK)
+
+	case http.MethodPost:
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+			http.Error(w, "request body must be application/json", http.StatusUnsupportedMediaType)
+			return
+		}
+		req := NewCSVViewer{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request: %s", err.Error()), http.StatusBadRequest)
+			return
+		}
+		if req.Name == "" || req.CSV == "" || len(req.Columns) == 0 {
+			http.Error(w, "name, csv and columns are required", http.StatusBadRequest)
+			return
+		}
+		if !viewerName.MatchString(req.Name) {
+			http.Error(w, "name must contain only letters, digits, _ and -", http.StatusBadRequest)
+			return
+		}
+		csvPath, pathErr := csvPath(dataDir, req.CSV)
+		if pathErr != nil {
+			http.Error(w, pathErr.Error(), http.StatusBadRequest)
+			return
+		}
+
+		v, viewerErr := viewer.NewViewer(
+			req.Name,
+			m.address,
+			fmt.Sprintf("%s/view/%s", m.pagePath, req.Name),
+			newCSVSource(csvPath, req.TimeColumn, req.Columns...),
+			req.Options,
+			m.ctx,
+			m.shutdownTimeout,
+		)
+		if viewerErr != nil {
+			http.Error(w, viewerErr.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := m.AddViewer(v); err != nil {
+			v.Stop() // INFO not registered, nothing else would stop it and close its file
+			http.Error(w, err.Error(), adminStatus(err))
+			return
+		}
+		m.writeViewers(w, http.StatusCreated)
+
+	case http.MethodDelete:
+		if err := m.RemoveViewer(r.URL.Query().Get("name")); err != nil {
+			http.Error(w, err.Error(), adminStatus(err))
+			return
+		}
+		m.writeViewers(w, http.StatusOK)
+
+	default:
+		w.Header().Set("Allow", "GET, POST, DELETE")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (m *Manager) writeViewers(w http.ResponseWriter, status int) {
+	viewers := m.Viewers()
+	infos := make([]ViewerInfo, 0, len(viewers))
+	for _, v := range viewers {
+		infos = append(infos, ViewerInfo{
+			Name:    v.Name,
+			Path:    v.AddressPath,
+			ChartID: v.ChartID,
+			Type:    v.Options().Type,
+			Series:  v.Series(),
+		})
+	}
+
+	infosBytes, jsonErr := json.Marshal(infos)
+	if jsonErr != nil {
+		zap.S().Errorf("viewers json marshal failed: %s", jsonErr.Error())
+		http.Error(w, jsonErr.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, wrErr := w.Write(infosBytes)
+	if wrErr != nil {
+		zap.S().Errorf("viewers writing failed: %s", wrErr.Error())
+	}
+}
+
+// csvPath returns the path of a CSV file of the data directory, symbolic links resolved, refusing the files
+// outside the directory
+func csvPath(dataDir, name string) (string, error) {
+	if filepath.IsAbs(name) {
+		return "", fmt.Errorf("csv %s must be relative to the data directory", name)
+	}
+	realPath, evalErr := filepath.EvalSymlinks(filepath.Join(dataDir, name))
+	if evalErr != nil {
+		return "", fmt.Errorf("csv %s not found in the data directory", name)
+	}
+	rel, relErr := filepath.Rel(dataDir, realPath)
+	if relErr != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("csv %s is outside the data directory", name)
+	}
+	return realPath, nil
+}
+
+func adminStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrViewerExists):
+		return http.StatusConflict
+	case errors.Is(err, ErrViewerNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrStopped):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,12 +1,15 @@
 package manager
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
-	"log"
 	"net/http"
+	"sync"
 	"time"
 
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/assets"
 	"github.com/bygui86/go-csv-view/examples/dynamic-page/statics"
 	"github.com/bygui86/go-csv-view/examples/dynamic-page/viewer"
 	"github.com/go-echarts/go-echarts/v2/components"
@@ -15,17 +18,31 @@ import (
 	"go.uber.org/zap"
 )
 
+var (
+	ErrViewerExists   = errors.New("viewer already exists")
+	ErrViewerNotFound = errors.New("viewer not found")
+	ErrStopped        = errors.New("manager stopped")
+)
+
 type Manager struct {
 	address         string
 	pagePath        string
+	eventsPath      string
+	adminPath       string
 	staticsPath     string
 	srv             *http.Server
 	ctx             context.Context
 	cancel          context.CancelFunc
 	shutdownTimeout int
 	mux             *http.ServeMux
+	mu              sync.Mutex // INFO: guards page, viewers and started, viewers are added and removed at runtime
 	page            *components.Page
 	viewers         []*viewer.Viewer
+	started         bool
+	stopOnce        sync.Once
+	done            chan struct{} // INFO: closed when the manager is stopped
+	err             error         // INFO: error which stopped the manager, set before closing done
+	dataDir         string        // INFO: directory of the CSV files of the admin endpoint, disabled when empty
 }
 
 func NewManager(address, pagePath string,
@@ -39,7 +56,6 @@ func NewManager(address, pagePath string,
 		setupGeneral(address, pagePath, shutdownTimeout).
 		setupHttpServer().
 		setupCtx(ctx).
-		setupPage().
 		registerViewers(viewers...).
 		setupMux()
 }
@@ -48,20 +64,22 @@ func (m *Manager) setupGeneral(address, pagePath string, shutdownTimeout int) *M
 	templates.PageTpl = statics.PageTemplate
 	m.address = address
 	m.pagePath = pagePath
+	m.eventsPath = m.pagePath + "/events"
+	m.adminPath = m.pagePath + "/admin/viewers"
 	m.staticsPath = m.pagePath + "/statics"
 	m.shutdownTimeout = shutdownTimeout
 
-	zap.S().Debugf("Setup general - address %s, pagePath %s, staticsPath %s, shutdownTimeout %d",
-		m.address, m.pagePath, m.staticsPath, m.shutdownTimeout)
+	zap.S().Debugf("Setup general - address %s, pagePath %s, eventsPath %s, staticsPath %s, shutdownTimeout %d",
+		m.address, m.pagePath, m.eventsPath, m.staticsPath, m.shutdownTimeout)
 
 	return m
 }
 
 func (m *Manager) setupHttpServer() *Manager {
 	m.srv = &http.Server{
-		Addr:         m.address,
-		ReadTimeout:  time.Minute,
-		WriteTimeout: time.Minute,
+		Addr:        m.address,
+		ReadTimeout: time.Minute,
+		// WriteTimeout not set as events streams stay open as long as the page
 		//MaxHeaderBytes: 1 << 20,
 	}
 
@@ -76,39 +94,117 @@ func (m *Manager) setupCtx(ctx context.Context) *Manager {
 	} else {
 		m.ctx, m.cancel = context.WithCancel(context.Background())
 	}
+	m.done = make(chan struct{})
 
 	zap.S().Debug("Setup ctx")
 
 	return m
 }
 
+// setupPage builds the page with the charts of the viewers, the caller must hold m.mu
 func (m *Manager) setupPage() *Manager {
 	m.page = components.NewPage()
 	m.page.PageTitle = "Dynamic page example"
 	m.page.AssetsHost = fmt.Sprintf("http://%s%s/", m.address, m.staticsPath)
-	//m.page.Assets.JSAssets.Add("echarts.min.js") // TODO why not required?
-	m.page.Assets.JSAssets.Add("jquery.min.js")
-	//m.page.Assets.JSAssets.Add(""westeros.js") // TODO why not required?
-	//m.page.Assets.JSAssets.Add(""macarons.js") // TODO why not required?
+	// INFO echarts.min.js and the themes of the charts are added to the assets by AddCharts, see assetsHandler
+
+	for _, v := range m.viewers {
+		m.page.AddCharts(v.Graph)
+	}
 
-	zap.S().Debugf("Setup page - title %s, assetsHosts %s",
-		m.page.PageTitle, m.page.AssetsHost)
+	zap.S().Debugf("Setup page - title %s, assetsHosts %s, charts %d",
+		m.page.PageTitle, m.page.AssetsHost, len(m.viewers))
 
 	return m
 }
 
 func (m *Manager) registerViewers(viewers ...*viewer.Viewer) *Manager {
-	m.viewers = append(m.viewers, viewers...)
-	zap.S().Infof("Registering %d viewers", len(m.viewers))
+	zap.S().Infof("Registering %d viewers", len(viewers))
 
 	for _, v := range viewers {
-		zap.S().Infof("Registering viewer - name %s, addressPath %s, graphID %s",
-			v.Name, v.AddressPath, v.Graph.ChartID)
+		if err := m.AddViewer(v); err != nil {
+			zap.S().Errorf("Viewer %s not registered: %s", v.Name, err.Error())
+		}
+	}
 
-		m.page.AddCharts(v.Graph)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.setupPage()
+}
+
+// AddViewer adds the chart of the viewer to the page and serves its endpoints, the viewer is started when
+// the manager is already running
+func (m *Manager) AddViewer(v *viewer.Viewer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.ctx.Err() != nil {
+		return ErrStopped
+	}
+	for _, registered := range m.viewers {
+		if registered.Name == v.Name || registered.AddressPath == v.AddressPath {
+			return fmt.Errorf("%w: name %s, addressPath %s", ErrViewerExists, v.Name, v.AddressPath)
+		}
 	}
 
-	return m
+	zap.S().Infof("Registering viewer - name %s, addressPath %s, graphID %s",
+		v.Name, v.AddressPath, v.ChartID)
+
+	m.viewers = append(m.viewers, v)
+	if m.page != nil { // INFO nil while NewManager registers the first viewers
+		m.setupPage()
+	}
+	if m.started {
+		v.Start()
+	}
+	return nil
+}
+
+// RemoveViewer stops the viewer with the given name, closing its events streams, and removes its chart from the page
+func (m *Manager) RemoveViewer(name string) error {
+	m.mu.Lock()
+	var removed *viewer.Viewer
+	for i, v := range m.viewers {
+		if v.Name == name {
+			removed = v
+			m.viewers = append(m.viewers[:i:i], m.viewers[i+1:]...)
+			m.setupPage()
+			break
+		}
+	}
+	m.mu.Unlock()
+
+	if removed == nil {
+		return fmt.Errorf("%w: %s", ErrViewerNotFound, name)
+	}
+
+	zap.S().Infof("Removing viewer - name %s", name)
+
+	removed.Stop()
+	return nil
+}
+
+// Viewers returns the viewers in page order
+func (m *Manager) Viewers() []*viewer.Viewer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	viewers := make([]*viewer.Viewer, len(m.viewers))
+	copy(viewers, m.viewers)
+	return viewers
+}
+
+// viewer returns the viewer matching the given condition, if any
+func (m *Manager) viewer(match func(v *viewer.Viewer) bool) *viewer.Viewer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, v := range m.viewers {
+		if match(v) {
+			return v
+		}
+	}
+	return nil
 }
 
 func (m *Manager) setupMux() *Manager {
@@ -118,69 +214,155 @@ func (m *Manager) setupMux() *Manager {
 
 	zap.S().Debug("Listening on %s", m.pagePath)
 
-	for _, v := range m.viewers {
-		//m.mux.HandleFunc(fmt.Sprintf(viewPath, v.Name), v.Handler)
-		m.mux.HandleFunc(v.AddressPath, v.Handler)
-		zap.S().Debug("Listening on %s", v.AddressPath)
-	}
+	m.mux.HandleFunc(m.eventsPath, m.eventsHandler)
+
+	zap.S().Debug("Listening on %s", m.eventsPath)
+
+	// INFO viewers are added and removed at runtime, their paths are matched at every request
+	m.mux.HandleFunc("/", m.viewerHandler)
 
-	echartsPath := fmt.Sprintf("%s/%s", m.staticsPath, "echarts.min.js")
-	jqueryPath := fmt.Sprintf("%s/%s", m.staticsPath, "jquery.min.js")
-	themeWesteros := fmt.Sprintf("%s/%s", m.staticsPath, "themes/westeros.js")
-	themeMacarons := fmt.Sprintf("%s/%s", m.staticsPath, "themes/macarons.js")
+	// INFO every echarts script, theme and map is embedded, so the page works offline whatever the theme
+	m.mux.Handle(m.staticsPath+"/", http.StripPrefix(m.staticsPath+"/", newAssetsHandler(assets.FS)))
 
-	m.mux.HandleFunc(echartsPath, echartJsHandler)
-	m.mux.HandleFunc(jqueryPath, jqueryJsHandler)
-	m.mux.HandleFunc(themeWesteros, westerosJsHandler)
-	m.mux.HandleFunc(themeMacarons, macaronsJsHandler)
+	zap.S().Debugf("Listening on %s/", m.staticsPath)
 
-	zap.S().Debug("Listening on %s", echartsPath)
-	zap.S().Debug("Listening on %s", jqueryPath)
-	zap.S().Debug("Listening on %s", themeWesteros)
-	zap.S().Debug("Listening on %s", themeMacarons)
+	// INFO the admin endpoint is left out of CORS, so that the pages of other sites cannot add viewers
+	handler := http.NewServeMux()
+	handler.Handle("/", cors.AllowAll().Handler(m.mux))
+	handler.HandleFunc(m.adminPath, m.adminHandler)
+	m.srv.Handler = handler
 
-	m.srv.Handler = cors.AllowAll().Handler(m.mux)
+	zap.S().Debugf("Listening on %s", m.adminPath)
 
 	zap.S().Debug("Setup mux")
 
 	return m
 }
 
+// Start starts the viewers and serves the page until the context passed to NewManager is cancelled, Stop is
+// called or the HTTP server fails, then it stops the manager and returns the error which stopped it, if any.
 func (m *Manager) Start() error {
 	zap.S().Info("Start...")
 
+	m.mu.Lock()
+	m.started = true
 	for _, v := range m.viewers {
 		v.Start()
 	}
+	m.mu.Unlock()
+
+	srvErr := make(chan error, 1)
+	go func() {
+		srvErr <- m.srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-srvErr:
+		if errors.Is(err, http.ErrServerClosed) { // INFO stopped by Stop
+			err = nil
+		}
+		m.stop(err)
+	case <-m.ctx.Done():
+		m.stop(nil)
+	}
 
-	return m.srv.ListenAndServe()
+	return m.Wait()
 }
 
+// Stop stops the viewers and shuts the HTTP server down, waiting at most shutdownTimeout seconds for the
+// running requests. Calling it more than once is safe.
 func (m *Manager) Stop() {
-	zap.S().Info("Stop...")
+	m.stop(nil)
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(m.shutdownTimeout)*time.Second)
-	defer cancel()
+func (m *Manager) stop(cause error) {
+	m.stopOnce.Do(func() {
+		zap.S().Info("Stop...")
 
-	for _, v := range m.viewers {
-		v.Stop()
-	}
+		m.cancel()
 
-	err := m.srv.Shutdown(ctx)
-	if err != nil {
-		log.Printf("Error shutting down HTTP server: %s", err.Error())
-	}
+		// INFO viewers first, their events streams end when they stop so the server does not wait for them
+		for _, v := range m.Viewers() {
+			v.Stop()
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(m.shutdownTimeout)*time.Second)
+		defer cancel()
+
+		shutdownErr := m.srv.Shutdown(ctx)
+		if shutdownErr != nil {
+			zap.S().Errorf("HTTP server shutdown failed: %s", shutdownErr.Error())
+			if cause == nil {
+				cause = shutdownErr
+			}
+		}
 
-	time.Sleep(time.Duration(m.shutdownTimeout) * time.Second)
+		m.err = cause
+		close(m.done)
+
+		zap.S().Info("Stopped")
+	})
+}
+
+// Done returns a channel closed when the manager is stopped
+func (m *Manager) Done() <-chan struct{} {
+	return m.done
+}
 
-	m.cancel()
+// Wait blocks until the manager is stopped and returns the error which stopped it, if any
+func (m *Manager) Wait() error {
+	<-m.done
+	return m.err
 }
 
 func (m *Manager) pageHandler(w http.ResponseWriter, _ *http.Request) {
 	zap.S().Info("Page handler")
 
-	err := m.page.Render(w)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, v := range m.viewers {
+		v.EmbedHistory(m.eventsPath)
+	}
+
+	// INFO rendered to a buffer first, so a failure can still be reported with the status code
+	buf := bytes.Buffer{}
+	err := m.page.Render(&buf)
 	if err != nil {
-		zap.S().Fatalf("page rendering failed: %s", err.Error())
+		zap.S().Errorf("page rendering failed: %s", err.Error())
+		http.Error(w, "page rendering failed", http.StatusInternalServerError)
+		return
 	}
+
+	_, wrErr := buf.WriteTo(w)
+	if wrErr != nil {
+		zap.S().Errorf("page writing failed: %s", wrErr.Error())
+	}
+}
+
+// eventsHandler streams the points of the viewer whose chart ID is in the "chart" query parameter
+func (m *Manager) eventsHandler(w http.ResponseWriter, r *http.Request) {
+	chartID := r.URL.Query().Get("chart")
+	v := m.viewer(func(v *viewer.Viewer) bool { return v.ChartID == chartID })
+	if v == nil {
+		zap.S().Warnf("Events handler - unknown chart %s", chartID)
+		http.NotFound(w, r)
+		return
+	}
+
+	v.Stream(w, r)
+}
+
+// viewerHandler serves the points and the history of the viewer matching the request path
+func (m *Manager) viewerHandler(w http.ResponseWriter, r *http.Request) {
+	if v := m.viewer(func(v *viewer.Viewer) bool { return v.AddressPath == r.URL.Path }); v != nil {
+		v.Handler(w, r)
+		return
+	}
+	if v := m.viewer(func(v *viewer.Viewer) bool { return v.AddressPath+"/history" == r.URL.Path }); v != nil {
+		v.HistoryHandler(w, r)
+		return
+	}
+
+	http.NotFound(w, r)
 }
@@ -0,0 +1,377 @@
+package manager
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bygui86/go-csv-view/examples/dynamic-page/viewer"
+	"github.com/stretchr/testify/assert"
+)
+
+func freeAddress(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func newTestViewer(ctx context.Context, name, address string) *viewer.Viewer {
+	source := viewer.NewFuncSource(func() []float64 { return []float64{1} }, "N")
+	v, err := viewer.NewViewer(name, address, "/page/view/"+name, source, viewer.Options{Interval: 10}, ctx, 1)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func newTestManager(ctx context.Context, address string) *Manager {
+	return NewManager(address, "/page", ctx, 1, newTestViewer(ctx, "test", address))
+}
+
+func serve(m *Manager, method, target string, body io.Reader) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	m.srv.Handler.ServeHTTP(rec, httptest.NewRequest(method, target, body))
+	return rec
+}
+
+// postJSON posts a JSON body to the manager
+func postJSON(m *Manager, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	m.srv.Handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// start runs Manager.Start in background, returning a channel receiving its result
+func start(m *Manager) chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- m.Start()
+	}()
+	return result
+}
+
+func waitResult(t *testing.T, result chan error) error {
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("manager not stopped")
+		return nil
+	}
+}
+
+func TestStartReturnsWhenContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	m := newTestManager(ctx, freeAddress(t))
+	result := start(m)
+
+	cancel()
+	assert.NoError(t, waitResult(t, result))
+
+	select {
+	case <-m.Done():
+	default:
+		t.Fatal("manager not done")
+	}
+}
+
+func TestStopUnblocksWait(t *testing.T) {
+	address := freeAddress(t)
+	m := newTestManager(context.Background(), address)
+	result := start(m)
+
+	// the page is served until the manager is stopped
+	assert.Eventually(t, func() bool {
+		resp, err := http.Get("http://" + address + "/page")
+		if err != nil {
+			return false
+		}
+		resp.Body.Close()
+		return resp.StatusCode == http.StatusOK
+	}, 5*time.Second, 10*time.Millisecond)
+
+	m.Stop()
+	m.Stop()
+	assert.NoError(t, m.Wait())
+	assert.NoError(t, waitResult(t, result))
+}
+
+func TestStartFails(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer l.Close()
+
+	m := newTestManager(context.Background(), l.Addr().String())
+	assert.Error(t, waitResult(t, start(m)))
+	assert.Error(t, m.Wait())
+}
+
+type failingRenderer struct{}
+
+func (failingRenderer) Render(io.Writer) error {
+	return errors.New("broken template")
+}
+
+func TestPageHandler(t *testing.T) {
+	m := newTestManager(context.Background(), "localhost:8080")
+
+	rec := httptest.NewRecorder()
+	m.pageHandler(rec, httptest.NewRequest(http.MethodGet, "/page", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "/page/events?chart="+m.viewers[0].ChartID)
+
+	m.page.Renderer = failingRenderer{}
+	rec = httptest.NewRecorder()
+	m.pageHandler(rec, httptest.NewRequest(http.MethodGet, "/page", nil))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestAddRemoveViewer(t *testing.T) {
+	address := freeAddress(t)
+	m := newTestManager(context.Background(), address)
+	result := start(m)
+	defer func() {
+		m.Stop()
+		assert.NoError(t, waitResult(t, result))
+	}()
+
+	added := newTestViewer(context.Background(), "added", address)
+	assert.NoError(t, m.AddViewer(added))
+	assert.ErrorIs(t, m.AddViewer(newTestViewer(context.Background(), "added", address)), ErrViewerExists)
+	assert.Len(t, m.Viewers(), 2)
+
+	assert.Contains(t, serve(m, http.MethodGet, "/page", nil).Body.String(), added.ChartID)
+	assert.Equal(t, http.StatusOK, serve(m, http.MethodGet, "/page/view/added", nil).Code)
+	assert.Equal(t, http.StatusOK, serve(m, http.MethodGet, "/page/view/added/history", nil).Code)
+
+	assert.NoError(t, m.RemoveViewer("added"))
+	assert.ErrorIs(t, m.RemoveViewer("added"), ErrViewerNotFound)
+	assert.NotContains(t, serve(m, http.MethodGet, "/page", nil).Body.String(), added.ChartID)
+	assert.Equal(t, http.StatusNotFound, serve(m, http.MethodGet, "/page/view/added", nil).Code)
+	assert.Equal(t, http.StatusNotFound, serve(m, http.MethodGet, "/page/events?chart="+added.ChartID, nil).Code)
+	assert.Equal(t, http.StatusOK, serve(m, http.MethodGet, "/page/view/test", nil).Code)
+}
+
+func TestAdminHandler(t *testing.T) {
+	dataDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dataDir, "trades.csv"), []byte("TIMESTAMP,PRICE\n2022-01-01T00:00:00Z,100\n"), 0644))
+
+	m := newTestManager(context.Background(), "localhost:8080")
+	defer m.Stop()
+
+	// disabled by default
+	assert.Equal(t, http.StatusNotFound, serve(m, http.MethodGet, "/page/admin/viewers", nil).Code)
+	assert.NoError(t, m.EnableAdmin(dataDir))
+
+	viewers := func(rec *httptest.ResponseRecorder) []ViewerInfo {
+		infos := make([]ViewerInfo, 0)
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &infos))
+		return infos
+	}
+
+	rec := serve(m, http.MethodGet, "/page/admin/viewers", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []ViewerInfo{{Name: "test", Path: "/page/view/test", ChartID: m.viewers[0].ChartID, Type: viewer.ChartLine, Series: []string{"N"}}}, viewers(rec))
+
+	body := `{"name": "btc", "csv": "trades.csv", "timeColumn": "TIMESTAMP", "columns": ["PRICE"]}`
+	rec = postJSON(m, "/page/admin/viewers", body)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Len(t, viewers(rec), 2)
+	assert.Contains(t, serve(m, http.MethodGet, "/page/view/btc", nil).Body.String(), `"values":[100]`)
+
+	assert.Equal(t, http.StatusConflict, postJSON(m, "/page/admin/viewers", body).Code)
+	assert.Equal(t, http.StatusBadRequest, postJSON(m, "/page/admin/viewers", `{"name": "eth"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, postJSON(m, "/page/admin/viewers", `{`).Code)
+	kline := `{"name": "eth", "csv": "trades.csv", "columns": ["PRICE"], "type": "kline"}`
+	assert.Equal(t, http.StatusBadRequest, postJSON(m, "/page/admin/viewers", kline).Code)
+
+	rec = serve(m, http.MethodDelete, "/page/admin/viewers?name=btc", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Len(t, viewers(rec), 1)
+	assert.Equal(t, http.StatusNotFound, serve(m, http.MethodDelete, "/page/admin/viewers?name=btc", nil).Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(m, http.MethodPut, "/page/admin/viewers", nil).Code)
+
+	m.Stop()
+	assert.ErrorIs(t, m.AddViewer(newTestViewer(context.Background(), "late", "localhost:8080")), ErrStopped)
+}
+
+// closingSource records whether the viewer closed its CSV source
+type closingSource struct {
+	*viewer.CSVSource
+	closed bool
+}
+
+func (s *closingSource) Close() error {
+	s.closed = true
+	return s.CSVSource.Close()
+}
+
+func TestAdminHandlerStopsDuplicate(t *testing.T) {
+	dataDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dataDir, "trades.csv"), []byte("PRICE\n100\n"), 0644))
+
+	sources := make([]*closingSource, 0)
+	defer func(restore func(string, string, ...string) viewer.Source) { newCSVSource = restore }(newCSVSource)
+	newCSVSource = func(filePath, timeColumn string, columns ...string) viewer.Source {
+		s := &closingSource{CSVSource: viewer.NewCSVSource(filePath, timeColumn, columns...)}
+		sources = append(sources, s)
+		return s
+	}
+
+	m := newTestManager(context.Background(), "localhost:8080")
+	defer m.Stop()
+	assert.NoError(t, m.EnableAdmin(dataDir))
+
+	body := `{"name": "btc", "csv": "trades.csv", "columns": ["PRICE"]}`
+	assert.Equal(t, http.StatusCreated, postJSON(m, "/page/admin/viewers", body).Code)
+	assert.Equal(t, http.StatusConflict, postJSON(m, "/page/admin/viewers", body).Code)
+
+	assert.Len(t, sources, 2)
+	assert.False(t, sources[0].closed)
+	assert.True(t, sources[1].closed)
+}
+
+func TestAdminHandlerRestrictions(t *testing.T) {
+	root := t.TempDir()
+	dataDir := filepath.Join(root, "data")
+	assert.NoError(t, os.Mkdir(dataDir, 0755))
+	secret := filepath.Join(root, "secret.csv")
+	assert.NoError(t, os.WriteFile(secret, []byte("PRICE\n1\n"), 0644))
+	assert.NoError(t, os.Symlink(secret, filepath.Join(dataDir, "link.csv")))
+
+	m := newTestManager(context.Background(), "localhost:8080")
+	defer m.Stop()
+	assert.NoError(t, m.EnableAdmin(dataDir))
+
+	for _, csv := range []string{secret, "../secret.csv", "link.csv", "missing.csv"} {
+		rec := postJSON(m, "/page/admin/viewers", `{"name": "leak", "csv": "`+csv+`", "columns": ["PRICE"]}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, csv)
+	}
+	for _, name := range []string{"a/b", "../x", "a b", "é"} {
+		rec := postJSON(m, "/page/admin/viewers", `{"name": "`+name+`", "csv": "x.csv", "columns": ["PRICE"]}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, name)
+		assert.Contains(t, rec.Body.String(), "name must contain", name)
+	}
+
+	// a cross-site form or a text body is refused, and no CORS header lets other origins read the responses
+	rec := serve(m, http.MethodPost, "/page/admin/viewers", strings.NewReader(`{"name": "x", "csv": "x.csv", "columns": ["PRICE"]}`))
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+	preflight := httptest.NewRequest(http.MethodOptions, "/page/admin/viewers", nil)
+	preflight.Header.Set("Origin", "http://evil.example")
+	preflight.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	rec = httptest.NewRecorder()
+	m.srv.Handler.ServeHTTP(rec, preflight)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+
+	viewerReq := httptest.NewRequest(http.MethodGet, "/page/view/test", nil)
+	viewerReq.Header.Set("Origin", "http://other.example")
+	rec = httptest.NewRecorder()
+	m.srv.Handler.ServeHTTP(rec, viewerReq)
+	assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestAssetsHandler(t *testing.T) {
+	m := newTestManager(context.Background(), "localhost:8080")
+	defer m.Stop()
+
+	rec := serve(m, http.MethodGet, "/page/statics/echarts.min.js", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/javascript; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Empty(t, rec.Header().Get("Content-Encoding"))
+	assert.Contains(t, rec.Body.String(), "echarts")
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	req := httptest.NewRequest(http.MethodGet, "/page/statics/echarts.min.js", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	m.srv.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.Bytes())
+
+	// the gzip variant has its own ETag, the one of the identity variant does not validate it
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec = httptest.NewRecorder()
+	m.srv.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	assert.Equal(t, "Accept-Encoding", rec.Header().Get("Vary"))
+	gzipEtag := rec.Header().Get("ETag")
+	assert.Equal(t, strings.TrimSuffix(etag, `"`)+`-gz"`, gzipEtag)
+
+	req.Header.Set("If-None-Match", gzipEtag)
+	rec = httptest.NewRecorder()
+	m.srv.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Equal(t, gzipEtag, rec.Header().Get("ETag"))
+
+	req.Header.Del("Accept-Encoding")
+	rec = httptest.NewRecorder()
+	m.srv.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, etag, rec.Header().Get("ETag"))
+
+	for _, name := range []string{"themes/chalk.js", "themes/macarons.js", "maps/china.js"} {
+		req = httptest.NewRequest(http.MethodGet, "/page/statics/"+name, nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rec = httptest.NewRecorder()
+		m.srv.Handler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code, name)
+		assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"), name)
+
+		gz, err := gzip.NewReader(rec.Body)
+		if assert.NoError(t, err, name) {
+			content, readErr := io.ReadAll(gz)
+			assert.NoError(t, readErr, name)
+			assert.Contains(t, string(content), "echarts", name)
+		}
+	}
+
+	assert.Equal(t, http.StatusNotFound, serve(m, http.MethodGet, "/page/statics/themes/missing.js", nil).Code)
+	assert.Equal(t, http.StatusNotFound, serve(m, http.MethodGet, "/page/statics/themes", nil).Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(m, http.MethodPost, "/page/statics/echarts.min.js", nil).Code)
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	for header, want := range map[string]bool{
+		"":                          false,
+		"gzip":                      true,
+		"deflate, GZIP":             true,
+		"gzip;q=0":                  false,
+		"gzip; q=0.0, br":           false,
+		"gzip;q=0.5":                true,
+		"*":                         true,
+		"*;q=0":                     false,
+		"gzip;q=0, *":               false,
+		"br;q=1, *;q=0.1":           true,
+		"identity":                  false,
+		"gzip;q=invalid":            false,
+		"deflate;q=1, gzip;q=0.001": true,
+	} {
+		assert.Equal(t, want, acceptsGzip([]string{header}), header)
+	}
+	assert.True(t, acceptsGzip([]string{"br", "gzip"}))
+
+	m := newTestManager(context.Background(), "localhost:8080")
+	defer m.Stop()
+	req := httptest.NewRequest(http.MethodGet, "/page/statics/echarts.min.js", nil)
+	req.Header.Set("Accept-Encoding", "gzip;q=0")
+	rec := httptest.NewRecorder()
+	m.srv.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("Content-Encoding"))
+	assert.Contains(t, rec.Body.String(), "echarts")
+}
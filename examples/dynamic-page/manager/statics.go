@@ -1,36 +1,154 @@
 package manager
 
 import (
-	"log"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"mime"
 	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
 
-	"github.com/bygui86/go-csv-view/examples/dynamic-page/statics"
+	"go.uber.org/zap"
 )
 
-func echartJsHandler(w http.ResponseWriter, _ *http.Request) {
-	_, err := w.Write([]byte(statics.EchartJS))
-	if err != nil {
-		log.Fatal(err)
-	}
+// assetsHandler serves the files of an fs.FS, like the echarts scripts, themes and maps embedded by the
+// go-echarts fork, with their content type and an ETag, compressed when the client accepts gzip. The compressed
+// variant has its own ETag, so that a cache never validates one encoding with the other.
+// Files are read and compressed once, at the first request.
+type assetsHandler struct {
+	fsys fs.FS
+
+	mu     sync.Mutex
+	assets map[string]*asset
+}
+
+type asset struct {
+	content     []byte
+	gzipped     []byte
+	contentType string
+	etag        string
+	gzipEtag    string
+}
+
+func newAssetsHandler(fsys fs.FS) *assetsHandler {
+	return &assetsHandler{fsys: fsys, assets: make(map[string]*asset)}
 }
 
-func jqueryJsHandler(w http.ResponseWriter, _ *http.Request) {
-	_, err := w.Write([]byte(statics.JqueryJS))
+// ServeHTTP serves the file named by the request path, the handler is mounted with http.StripPrefix
+func (h *assetsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	a, err := h.asset(strings.TrimPrefix(r.URL.Path, "/"))
 	if err != nil {
-		log.Fatal(err)
+		zap.S().Warnf("static file %s not served: %s", r.URL.Path, err.Error())
+		http.NotFound(w, r)
+		return
+	}
+
+	content, etag := a.content, a.etag
+	gzipped := acceptsGzip(r.Header.Values("Accept-Encoding"))
+	if gzipped {
+		content, etag = a.gzipped, a.gzipEtag
+	}
+
+	w.Header().Set("Content-Type", a.contentType)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	w.Header().Set("Vary", "Accept-Encoding")
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	if gzipped {
+		w.Header().Set("Content-Encoding", "gzip")
+	}
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, wrErr := w.Write(content); wrErr != nil {
+		zap.S().Errorf("static file writing failed: %s", wrErr.Error())
 	}
 }
 
-func westerosJsHandler(w http.ResponseWriter, _ *http.Request) {
-	_, err := w.Write([]byte(statics.WesterosJS))
-	if err != nil {
-		log.Fatal(err)
+// acceptsGzip tells whether the Accept-Encoding header values accept gzip, with a quality value above 0
+// for gzip or, when gzip is not listed, for "*" (RFC 9110, section 12.5.3)
+func acceptsGzip(values []string) bool {
+	gzipQ, anyQ := -1.0, -1.0
+	for _, value := range values {
+		for _, coding := range strings.Split(value, ",") {
+			params := strings.Split(coding, ";")
+			q := 1.0
+			for _, param := range params[1:] {
+				kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+				if len(kv) == 2 && strings.EqualFold(kv[0], "q") {
+					parsed, err := strconv.ParseFloat(strings.TrimSpace(kv[1]), 64)
+					if err != nil {
+						parsed = 0
+					}
+					q = parsed
+				}
+			}
+			switch strings.ToLower(strings.TrimSpace(params[0])) {
+			case "gzip", "x-gzip":
+				gzipQ = q
+			case "*":
+				anyQ = q
+			}
+		}
+	}
+	if gzipQ >= 0 {
+		return gzipQ > 0
 	}
+	return anyQ > 0
 }
 
-func macaronsJsHandler(w http.ResponseWriter, _ *http.Request) {
-	_, err := w.Write([]byte(statics.MacaronsJS))
-	if err != nil {
-		log.Fatal(err)
+func (h *assetsHandler) asset(name string) (*asset, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if a, ok := h.assets[name]; ok {
+		return a, nil
+	}
+
+	content, readErr := fs.ReadFile(h.fsys, name)
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	gzipped := bytes.Buffer{}
+	gz := gzip.NewWriter(&gzipped)
+	if _, err := gz.Write(content); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(name))
+	if contentType == "" {
+		contentType = http.DetectContentType(content)
+	}
+
+	sum := sha256.Sum256(content)
+	hash := hex.EncodeToString(sum[:16])
+	a := &asset{
+		content:     content,
+		gzipped:     gzipped.Bytes(),
+		contentType: contentType,
+		etag:        `"` + hash + `"`,
+		gzipEtag:    `"` + hash + `-gz"`,
 	}
+	h.assets[name] = a
+	return a, nil
 }
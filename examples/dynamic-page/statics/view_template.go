@@ -1,32 +1,89 @@
 package statics
 
+// ViewTemplate subscribes a chart to the events stream of its viewer, the points are applied by the "update"
+// template of the chart type: AxisUpdateTemplate, GaugeUpdateTemplate or KlineUpdateTemplate
 const ViewTemplate = `
-$(function () { setInterval({{ .ViewID }}_sync, {{ .Interval }}); });
-function {{ .ViewID }}_sync() {
-    $.ajax({
-        type: "GET",
-        url: "http://{{ .Address }}{{ .ViewPath }}",
-        dataType: "json",
-        success: function (result) {
-            let opt = goecharts_{{ .ViewID }}.getOption();
-
-            let x = opt.xAxis[0].data;
-            x.push(result.time);
-            if (x.length > {{ .MaxPoints }}) {
-                x = x.slice(1);
+(function () {
+    {{ template "update" . }}
+
+    /* INFO EventSource reconnects by itself sending the id of the last event received, the server sends the missed points */
+    let source = new EventSource("http://{{ .Address }}{{ .EventsPath }}?chart={{ .ViewID }}&since={{ .Seq }}");
+    source.onerror = function () {
+        console.log("{{ .ViewID }} events stream interrupted, reconnecting...");
+    };
+    source.onmessage = function (event) {
+        let result = JSON.parse(event.data);
+        let opt = goecharts_{{ .ViewID }}.getOption();
+        update(opt, result);
+        goecharts_{{ .ViewID }}.setOption(opt);
+    };
+})();`
+
+// AxisUpdateTemplate appends the points to the series of a line, bar or scatter chart
+const AxisUpdateTemplate = `
+    function update(opt, result) {
+        let x = result.reset ? [] : opt.xAxis[0].data;
+        for (let i = 0; i < opt.series.length; i++) {
+            if (result.reset) {
+                opt.series[i].data = [];
+            }
+        }
+
+        for (let p = 0; p < result.points.length; p++) {
+            x.push(result.points[p].time);
+            for (let i = 0; i < result.points[p].values.length; i++) {
+                opt.series[i].data.push({ value: result.points[p].values[i] });
+            }
+        }
+
+        if (x.length > {{ .MaxPoints }}) {
+            x = x.slice(x.length - {{ .MaxPoints }});
+        }
+        opt.xAxis[0].data = x;
+
+        for (let i = 0; i < opt.series.length; i++) {
+            let y = opt.series[i].data;
+            if (y.length > {{ .MaxPoints }}) {
+                y = y.slice(y.length - {{ .MaxPoints }});
             }
-            opt.xAxis[0].data = x;
+            opt.series[i].data = y;
+        }
+    }`
+
+// GaugeUpdateTemplate shows the values of the last point, one pointer per series
+const GaugeUpdateTemplate = `
+    function update(opt, result) {
+        if (result.points.length === 0) {
+            return;
+        }
+        let values = result.points[result.points.length - 1].values;
+        for (let i = 0; i < values.length; i++) {
+            opt.series[0].data[i].value = values[i];
+        }
+    }`
 
-            for (let i = 0; i < result.values.length; i++) {
-                let y = opt.series[i].data;
-                y.push({ value: result.values[i] });
-                if (y.length > {{ .MaxPoints }}) {
-                    y = y.slice(1);
-                }
-                opt.series[i].data = y;
+// KlineUpdateTemplate updates the last candle while its time does not change and appends the next one.
+// The points hold open, high, low and close, echarts candles open, close, lowest and highest.
+const KlineUpdateTemplate = `
+    function update(opt, result) {
+        let x = result.reset ? [] : opt.xAxis[0].data;
+        let y = result.reset ? [] : opt.series[0].data;
 
-                goecharts_{{ .ViewID }}.setOption(opt);
+        for (let p = 0; p < result.points.length; p++) {
+            let v = result.points[p].values;
+            let candle = { value: [v[0], v[3], v[2], v[1]] };
+            if (x.length > 0 && x[x.length - 1] === result.points[p].time) {
+                y[y.length - 1] = candle;
+            } else {
+                x.push(result.points[p].time);
+                y.push(candle);
             }
         }
-    });
-}`
+
+        if (x.length > {{ .MaxPoints }}) {
+            x = x.slice(x.length - {{ .MaxPoints }});
+            y = y.slice(y.length - {{ .MaxPoints }});
+        }
+        opt.xAxis[0].data = x;
+        opt.series[0].data = y;
+    }`
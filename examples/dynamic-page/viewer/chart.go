@@ -0,0 +1,175 @@
+package viewer
+
+import (
+	"github.com/go-echarts/go-echarts/v2/charts"
+	"github.com/go-echarts/go-echarts/v2/opts"
+	"go.uber.org/zap"
+)
+
+func (v *Viewer) setupGraph() *Viewer {
+	global := []charts.GlobalOpts{
+		charts.WithTitleOpts(opts.Title{Title: v.options.Title}),
+		charts.WithLegendOpts(opts.Legend{Show: true}),
+		charts.WithInitializationOpts(opts.Initialization{
+			Width:  v.options.Width,
+			Height: v.options.Height,
+			Theme:  v.options.Theme,
+		}),
+	}
+
+	if v.options.Type == ChartGauge {
+		global = append(global, charts.WithTooltipOpts(opts.Tooltip{Show: true, Trigger: "item", Formatter: "{b}: {c} " + v.options.Units}))
+
+		data := make([]opts.GaugeData, 0, len(v.source.Names()))
+		for _, name := range v.source.Names() {
+			data = append(data, opts.GaugeData{Name: name})
+		}
+		gauge := charts.NewGauge()
+		gauge.SetGlobalOptions(global...)
+		gauge.AddSeries(v.Name, data)
+		v.Graph, v.ChartID = gauge, gauge.ChartID
+
+		zap.S().Debugf("Setup graph - type %s", v.options.Type)
+		return v
+	}
+
+	yAxis := opts.YAxis{Scale: v.options.Type == ChartKline}
+	if v.options.Units != "" {
+		yAxis.AxisLabel = &opts.AxisLabel{Show: true, Formatter: "{value} " + v.options.Units}
+	}
+	global = append(global,
+		charts.WithTooltipOpts(opts.Tooltip{Show: true, Trigger: "axis"}),
+		charts.WithXAxisOpts(opts.XAxis{Name: "Time"}),
+		charts.WithYAxisOpts(yAxis),
+	)
+
+	switch v.options.Type {
+	case ChartBar:
+		bar := charts.NewBar()
+		bar.SetGlobalOptions(global...)
+		bar.SetXAxis([]string{})
+		for _, name := range v.source.Names() {
+			bar.AddSeries(name, []opts.BarData{})
+		}
+		v.Graph, v.ChartID = bar, bar.ChartID
+
+	case ChartScatter:
+		scatter := charts.NewScatter()
+		scatter.SetGlobalOptions(global...)
+		scatter.SetXAxis([]string{})
+		for _, name := range v.source.Names() {
+			scatter.AddSeries(name, []opts.ScatterData{})
+		}
+		v.Graph, v.ChartID = scatter, scatter.ChartID
+
+	case ChartKline:
+		kline := charts.NewKLine()
+		kline.SetGlobalOptions(global...)
+		kline.SetXAxis([]string{})
+		kline.AddSeries(v.Name, []opts.KlineData{})
+		v.Graph, v.ChartID = kline, kline.ChartID
+
+	default:
+		line := charts.NewLine()
+		line.SetGlobalOptions(global...)
+		line.SetXAxis([]string{}).SetSeriesOptions(charts.WithLineChartOpts(opts.LineChart{Smooth: true}))
+		for _, name := range v.source.Names() {
+			line.AddSeries(name, []opts.LineData{})
+		}
+		v.Graph, v.ChartID = line, line.ChartID
+	}
+
+	zap.S().Debugf("Setup graph - type %s", v.options.Type)
+
+	return v
+}
+
+// base returns the configuration shared by all the chart types
+func (v *Viewer) base() *charts.BaseConfiguration {
+	switch graph := v.Graph.(type) {
+	case *charts.Bar:
+		return &graph.BaseConfiguration
+	case *charts.Scatter:
+		return &graph.BaseConfiguration
+	case *charts.Kline:
+		return &graph.BaseConfiguration
+	case *charts.Gauge:
+		return &graph.BaseConfiguration
+	default:
+		return &v.Graph.(*charts.Line).BaseConfiguration
+	}
+}
+
+// embedPoints replaces the data of the chart with the given points
+func (v *Viewer) embedPoints(points []Point) {
+	base := v.base()
+
+	switch v.options.Type {
+	case ChartGauge:
+		data := make([]opts.GaugeData, 0, len(v.source.Names()))
+		for i, name := range v.source.Names() {
+			item := opts.GaugeData{Name: name}
+			if len(points) > 0 {
+				item.Value = jsonValue(points[len(points)-1].Values[i])
+			}
+			data = append(data, item)
+		}
+		base.MultiSeries[0].Data = data
+		return
+
+	case ChartKline:
+		points = candles(points)
+		if len(points) > v.options.MaxPoints {
+			points = points[len(points)-v.options.MaxPoints:]
+		}
+		data := make([]opts.KlineData, 0, len(points))
+		for _, p := range points {
+			// INFO echarts candles are open, close, lowest, highest
+			data = append(data, opts.KlineData{Value: jsonValues([]float64{p.Values[0], p.Values[3], p.Values[2], p.Values[1]})})
+		}
+		base.MultiSeries[0].Data = data
+	}
+
+	xAxis := make([]string, 0, len(points))
+	for _, p := range points {
+		xAxis = append(xAxis, p.Time.Format(defaultTimeFormat))
+	}
+	base.XAxisList[0].Data = xAxis // INFO the graph is validated only when added to the page, see Line.Validate
+
+	for i := range base.MultiSeries {
+		switch v.options.Type {
+		case ChartBar:
+			data := make([]opts.BarData, 0, len(points))
+			for _, p := range points {
+				data = append(data, opts.BarData{Value: jsonValue(p.Values[i])})
+			}
+			base.MultiSeries[i].Data = data
+		case ChartScatter:
+			data := make([]opts.ScatterData, 0, len(points))
+			for _, p := range points {
+				data = append(data, opts.ScatterData{Value: jsonValue(p.Values[i])})
+			}
+			base.MultiSeries[i].Data = data
+		case ChartLine:
+			data := make([]opts.LineData, 0, len(points))
+			for _, p := range points {
+				data = append(data, opts.LineData{Value: jsonValue(p.Values[i])})
+			}
+			base.MultiSeries[i].Data = data
+		}
+	}
+}
+
+// candles keeps the last version of every candle: a kline source returns the current candle at every fetch,
+// so consecutive points with the same time are updates of the same candle
+func candles(points []Point) []Point {
+	merged := make([]Point, 0, len(points))
+	for _, p := range points {
+		if len(merged) > 0 && merged[len(merged)-1].Time.Equal(p.Time) {
+			merged[len(merged)-1] = p
+			continue
+		}
+		merged = append(merged, p)
+	}
+	return merged
+}
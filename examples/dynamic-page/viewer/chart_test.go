@@ -0,0 +1,143 @@
+package viewer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-echarts/go-echarts/v2/charts"
+	"github.com/go-echarts/go-echarts/v2/opts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewViewerOptions(t *testing.T) {
+	source := NewFuncSource(func() []float64 { return []float64{1} }, "N")
+
+	v, err := NewViewer("defaults", "localhost:8080", "/page/view/defaults", source, Options{}, context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, Options{
+		Type:      ChartLine,
+		Title:     "defaults",
+		Theme:     defaultTheme,
+		Width:     defaultWidth,
+		Height:    defaultHeight,
+		Interval:  defaultInterval,
+		MaxPoints: defaultMaxPoints,
+	}, v.Options())
+	assert.IsType(t, &charts.Line{}, v.Graph)
+
+	for chartType, graph := range map[string]interface{}{
+		ChartBar:     &charts.Bar{},
+		ChartScatter: &charts.Scatter{},
+		ChartGauge:   &charts.Gauge{},
+	} {
+		v, err = NewViewer(chartType, "localhost:8080", "/page/view/"+chartType, source, Options{Type: chartType}, context.Background(), 1)
+		assert.NoError(t, err, chartType)
+		assert.IsType(t, graph, v.Graph, chartType)
+	}
+
+	_, err = NewViewer("pie", "localhost:8080", "/page/view/pie", source, Options{Type: "pie"}, context.Background(), 1)
+	assert.Error(t, err)
+	_, err = NewViewer("kline", "localhost:8080", "/page/view/kline", source, Options{Type: ChartKline}, context.Background(), 1)
+	assert.Error(t, err)
+}
+
+func TestEmbedHistoryMaxPoints(t *testing.T) {
+	var counter int64
+	v, err := NewViewer("window", "localhost:8080", "/page/view/window", counterSource(&counter, "N"),
+		Options{Type: ChartBar, MaxPoints: 5, Units: "MB"}, context.Background(), 1)
+	assert.NoError(t, err)
+	for i := 0; i < 8; i++ {
+		assert.NoError(t, v.fetch())
+	}
+
+	v.EmbedHistory("/page/events")
+
+	base := v.base()
+	assert.Equal(t, "{value} MB", base.YAxisList[0].AxisLabel.Formatter)
+	data := base.MultiSeries[0].Data.([]opts.BarData)
+	assert.Len(t, data, 5)
+	assert.Equal(t, 4.0, data[0].Value)
+	assert.Contains(t, base.JSFunctions.Fns[0], "x.length > 5")
+}
+
+func TestEmbedHistoryGauge(t *testing.T) {
+	var counter int64
+	v, err := NewViewer("gauge", "localhost:8080", "/page/view/gauge", counterSource(&counter, "A", "B"),
+		Options{Type: ChartGauge}, context.Background(), 1)
+	assert.NoError(t, err)
+
+	v.EmbedHistory("/page/events")
+	assert.Equal(t, []opts.GaugeData{{Name: "A"}, {Name: "B"}}, v.base().MultiSeries[0].Data)
+
+	assert.NoError(t, v.fetch())
+	assert.NoError(t, v.fetch())
+	v.EmbedHistory("/page/events")
+	assert.Equal(t, []opts.GaugeData{{Name: "A", Value: 2.0}, {Name: "B", Value: 2.0}}, v.base().MultiSeries[0].Data)
+	assert.Contains(t, v.base().JSFunctions.Fns[0], "opt.series[0].data[i].value = values[i]")
+}
+
+func TestEmbedHistoryKline(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ticks := []float64{10, 12, 9, 11, 20}
+	var tick int
+	source := NewCandleSource(&tickSource{fetch: func() (float64, time.Time) {
+		defer func() { tick++ }()
+		return ticks[tick], start.Add(time.Duration(tick) * 4 * time.Second)
+	}}, 10*time.Second)
+
+	v, err := NewViewer("kline", "localhost:8080", "/page/view/kline", source, Options{Type: ChartKline}, context.Background(), 1)
+	assert.NoError(t, err)
+	for range ticks {
+		assert.NoError(t, v.fetch())
+	}
+
+	v.EmbedHistory("/page/events")
+
+	base := v.base()
+	assert.Equal(t, []string{"00:00:00", "00:00:10"}, base.XAxisList[0].Data)
+	assert.Equal(t, []opts.KlineData{
+		{Value: []interface{}{10.0, 9.0, 9.0, 12.0}}, // INFO ticks at 0s, 4s and 8s: open 10, high 12, low 9, close 9
+		{Value: []interface{}{11.0, 20.0, 11.0, 20.0}},
+	}, base.MultiSeries[0].Data)
+	assert.Contains(t, base.JSFunctions.Fns[0], "y[y.length - 1] = candle")
+}
+
+func TestEmbedHistoryKlineMaxPoints(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	var tick int
+	source := NewCandleSource(&tickSource{fetch: func() (float64, time.Time) {
+		defer func() { tick++ }()
+		return float64(tick), start.Add(time.Duration(tick) * 2 * time.Second)
+	}}, 10*time.Second)
+
+	v, err := NewViewer("kline", "localhost:8080", "/page/view/kline", source,
+		Options{Type: ChartKline, MaxPoints: 3}, context.Background(), 1)
+	assert.NoError(t, err)
+	for i := 0; i < 20; i++ { // INFO 5 updates per candle, 4 candles
+		assert.NoError(t, v.fetch())
+	}
+
+	v.EmbedHistory("/page/events")
+
+	base := v.base()
+	assert.Equal(t, []string{"00:00:10", "00:00:20", "00:00:30"}, base.XAxisList[0].Data)
+	assert.Equal(t, []opts.KlineData{
+		{Value: []interface{}{5.0, 9.0, 5.0, 9.0}},
+		{Value: []interface{}{10.0, 14.0, 10.0, 14.0}},
+		{Value: []interface{}{15.0, 19.0, 15.0, 19.0}},
+	}, base.MultiSeries[0].Data)
+}
+
+type tickSource struct {
+	fetch func() (float64, time.Time)
+}
+
+func (s *tickSource) Names() []string {
+	return []string{"Price"}
+}
+
+func (s *tickSource) Fetch() ([]float64, time.Time, error) {
+	value, valueTime := s.fetch()
+	return []float64{value}, valueTime, nil
+}
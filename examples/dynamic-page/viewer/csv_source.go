@@ -0,0 +1,269 @@
+package viewer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/bygui86/go-csv-view/csvview"
+	"go.uber.org/zap"
+)
+
+// defaultReadLimit is the number of bytes read by a Follow at most, the following calls read the rest
+const defaultReadLimit = 1 << 20
+
+// CSVSource follows a CSV file still being appended to, like `tail -f`.
+// Every fetch returns the rows appended since the previous one, a truncated file is read again from the
+// beginning and a rotated file (a new file with the same path) is reopened.
+// Malformed rows are skipped, see Tail to skip the old rows of a long file.
+type CSVSource struct {
+	filePath   string
+	timeColumn string
+	columns    []string
+	tail       int   // INFO: rows read at the first Follow of a file, all the rows when 0
+	readLimit  int64 // INFO: bytes read by a Follow at most
+
+	file    *os.File
+	info    os.FileInfo
+	offset  int64  // INFO: offset of the first byte not read yet
+	header  []byte // INFO: header line, prepended to the new rows to parse them by column name
+	partial []byte // INFO: last line read without its trailing newline, still being written
+	last    *Point
+}
+
+// NewCSVSource follows the given float columns of a CSV file, the time of every row is read from
+// timeColumn (RFC3339), the time of the fetch is used when timeColumn is empty.
+func NewCSVSource(filePath, timeColumn string, columns ...string) *CSVSource {
+	return &CSVSource{
+		filePath:   filePath,
+		timeColumn: timeColumn,
+		columns:    columns,
+		readLimit:  defaultReadLimit,
+	}
+}
+
+func (s *CSVSource) Names() []string {
+	return s.columns
+}
+
+// Tail limits the first Follow of the file to its last rows, instead of reading the whole file
+func (s *CSVSource) Tail(rows int) {
+	s.tail = rows
+}
+
+// Close closes the file, a later Follow opens it again and reads it from the beginning
+func (s *CSVSource) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// Fetch returns the last row of the file, see Follow to get all the new rows
+func (s *CSVSource) Fetch() ([]float64, time.Time, error) {
+	if _, err := s.Follow(); err != nil {
+		return nil, time.Time{}, err
+	}
+	if s.last == nil {
+		return nil, time.Time{}, fmt.Errorf("no rows in %s yet", s.filePath)
+	}
+	return s.last.Values, s.last.Time, nil
+}
+
+// Follow returns the rows appended to the file since the previous call, reading 1 MiB at most: after a long
+// pause the rows are caught up by the following calls
+func (s *CSVSource) Follow() ([]Point, error) {
+	if err := s.reopen(); err != nil {
+		return nil, err
+	}
+
+	if s.header == nil && s.offset == 0 && s.tail > 0 {
+		if err := s.skipToTail(); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := s.file.Seek(s.offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	content, readErr := io.ReadAll(io.LimitReader(s.file, s.readLimit))
+	if readErr != nil {
+		return nil, readErr
+	}
+	s.offset += int64(len(content))
+	content = append(s.partial, content...)
+
+	if s.header == nil {
+		end := bytes.IndexByte(content, '\n')
+		if end < 0 { // INFO header not complete yet
+			s.partial = content
+			return nil, nil
+		}
+		s.header = content[:end+1]
+		content = content[end+1:]
+	}
+
+	// INFO only complete lines are parsed, the rest is kept for the next call
+	end := bytes.LastIndexByte(content, '\n')
+	s.partial = append([]byte{}, content[end+1:]...)
+	if end < 0 {
+		return nil, nil
+	}
+	return s.parse(content[:end+1])
+}
+
+// reopen opens the file when needed and starts over when it was truncated or rotated
+func (s *CSVSource) reopen() error {
+	info, statErr := os.Stat(s.filePath)
+	if statErr != nil {
+		return statErr
+	}
+
+	switch {
+	case s.file == nil:
+	case !os.SameFile(s.info, info):
+		zap.S().Infof("CSV file %s rotated, reading the new file", s.filePath)
+		s.file.Close()
+		s.file = nil
+	case info.Size() < s.offset:
+		zap.S().Infof("CSV file %s truncated, reading it again", s.filePath)
+		s.reset()
+		s.info = info
+		return nil
+	default:
+		s.info = info
+		return nil
+	}
+
+	file, openErr := os.Open(s.filePath)
+	if openErr != nil {
+		return openErr
+	}
+	s.file = file
+	s.info = info
+	s.reset()
+	return nil
+}
+
+// skipToTail reads the header and moves the offset to the last tail rows, reading the file backwards by blocks
+func (s *CSVSource) skipToTail() error {
+	const blockSize = 64 * 1024
+	block := make([]byte, blockSize)
+	size := s.info.Size()
+
+	// INFO header first
+	headerEnd := int64(-1)
+	for start := int64(0); start < size && headerEnd < 0; start += blockSize {
+		n, err := s.file.ReadAt(block, start)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		if i := bytes.IndexByte(block[:n], '\n'); i >= 0 {
+			headerEnd = start + int64(i) + 1
+		}
+	}
+	if headerEnd < 0 { // INFO header not complete yet, read by Follow
+		return nil
+	}
+	header := make([]byte, headerEnd)
+	if _, err := s.file.ReadAt(header, 0); err != nil {
+		return err
+	}
+	s.header = header
+	s.offset = headerEnd
+
+	// INFO then the newline before the last tail rows, the newline ending the file does not start a row
+	rows := 0
+	for end := size; end > headerEnd; {
+		start := end - blockSize
+		if start < headerEnd {
+			start = headerEnd
+		}
+		n, err := s.file.ReadAt(block[:end-start], start)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		for i := n - 1; i >= 0; i-- {
+			if block[i] != '\n' || start+int64(i) == size-1 {
+				continue
+			}
+			if rows++; rows == s.tail {
+				s.offset = start + int64(i) + 1
+				return nil
+			}
+		}
+		end = start
+	}
+	return nil
+}
+
+func (s *CSVSource) reset() {
+	s.offset = 0
+	s.header = nil
+	s.partial = nil
+}
+
+func (s *CSVSource) parse(lines []byte) ([]Point, error) {
+	stream, streamErr := csvview.NewStream(io.MultiReader(bytes.NewReader(s.header), bytes.NewReader(lines)))
+	if streamErr != nil {
+		return nil, streamErr
+	}
+
+	points := make([]Point, 0)
+	for {
+		row, nextErr := stream.Next()
+		if errors.Is(nextErr, io.EOF) {
+			break
+		}
+		parseErr := &csv.ParseError{}
+		if errors.As(nextErr, &parseErr) {
+			// INFO the reader goes on with the next line, the other rows of the batch are kept
+			zap.S().Warnf("CSV file %s malformed row skipped: %s", s.filePath, nextErr.Error())
+			continue
+		}
+		if nextErr != nil {
+			return nil, fmt.Errorf("CSV file %s parsing failed: %w", s.filePath, nextErr)
+		}
+
+		p, pointErr := s.point(row)
+		if errors.Is(pointErr, csvview.ErrUnknownColumn) {
+			return nil, fmt.Errorf("CSV file %s parsing failed: %w", s.filePath, pointErr)
+		}
+		if pointErr != nil {
+			zap.S().Warnf("CSV file %s row skipped: %s", s.filePath, pointErr.Error())
+			continue
+		}
+		points = append(points, p)
+	}
+
+	if len(points) > 0 {
+		s.last = &points[len(points)-1]
+	}
+	return points, nil
+}
+
+// point reads the time and the values of a row
+func (s *CSVSource) point(row *csvview.Row) (Point, error) {
+	p := Point{Time: time.Now(), Values: make([]float64, 0, len(s.columns))}
+	if s.timeColumn != "" {
+		t, timeErr := row.Time(s.timeColumn)
+		if timeErr != nil {
+			return p, timeErr
+		}
+		p.Time = t
+	}
+	for _, column := range s.columns {
+		v, floatErr := row.Float(column)
+		if floatErr != nil {
+			return p, floatErr
+		}
+		p.Values = append(p.Values, v)
+	}
+	return p, nil
+}
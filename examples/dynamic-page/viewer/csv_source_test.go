@@ -0,0 +1,166 @@
+package viewer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func appendFile(t *testing.T, filePath, content string) {
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	assert.NoError(t, err)
+	_, err = file.WriteString(content)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+}
+
+func TestCSVSourceFollow(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "trades.csv")
+	appendFile(t, filePath, "TIMESTAMP,PRICE,SIZE\n2022-01-01T00:00:00Z,100,1\n2022-01-01T00:00:01Z,101")
+
+	s := NewCSVSource(filePath, "TIMESTAMP", "PRICE", "SIZE")
+	assert.Equal(t, []string{"PRICE", "SIZE"}, s.Names())
+
+	// the last line is still being written
+	points, err := s.Follow()
+	assert.NoError(t, err)
+	assert.Equal(t, []Point{{Values: []float64{100, 1}, Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}}, points)
+
+	appendFile(t, filePath, ",2\n2022-01-01T00:00:02Z,102,\n")
+	points, err = s.Follow()
+	assert.NoError(t, err)
+	assert.Len(t, points, 2)
+	assert.Equal(t, []float64{101, 2}, points[0].Values)
+	assert.True(t, math.IsNaN(points[1].Values[1]))
+
+	points, err = s.Follow()
+	assert.NoError(t, err)
+	assert.Empty(t, points)
+
+	values, _, err := s.Fetch()
+	assert.NoError(t, err)
+	assert.Equal(t, 102.0, values[0])
+}
+
+func TestStopClosesCSVSource(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "trades.csv")
+	appendFile(t, filePath, "PRICE\n100\n")
+
+	s := NewCSVSource(filePath, "", "PRICE")
+	v, err := NewViewer("csv", "localhost:8080", "/page/view/csv", s, Options{}, context.Background(), 1)
+	assert.NoError(t, err)
+	assert.NoError(t, v.fetch())
+	assert.NotNil(t, s.file)
+
+	v.Stop()
+	assert.Nil(t, s.file)
+	assert.NoError(t, s.Close())
+}
+
+func TestCSVSourceSkipsMalformedRows(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "trades.csv")
+	appendFile(t, filePath, "TIMESTAMP,PRICE\n2022-01-01T00:00:00Z,100\nyesterday,101\n2022-01-01T00:00:02Z,102,3\n2022-01-01T00:00:03Z,103\n")
+
+	s := NewCSVSource(filePath, "TIMESTAMP", "PRICE")
+	points, err := s.Follow()
+	assert.NoError(t, err)
+	if assert.Len(t, points, 2) {
+		assert.Equal(t, []float64{100}, points[0].Values)
+		assert.Equal(t, []float64{103}, points[1].Values)
+	}
+
+	appendFile(t, filePath, "2022-01-01T00:00:04Z,104\n")
+	points, err = s.Follow()
+	assert.NoError(t, err)
+	assert.Len(t, points, 1)
+}
+
+func TestCSVSourceTail(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "prices.csv")
+	var content strings.Builder
+	content.WriteString("PRICE\n")
+	for i := 0; i < 50000; i++ { // INFO larger than a block
+		fmt.Fprintf(&content, "%d\n", i)
+	}
+	appendFile(t, filePath, content.String()+"50000")
+
+	s := NewCSVSource(filePath, "", "PRICE")
+	s.Tail(3)
+	points, err := s.Follow()
+	assert.NoError(t, err)
+	if assert.Len(t, points, 2) { // INFO the last row is still being written
+		assert.Equal(t, []float64{49998}, points[0].Values)
+		assert.Equal(t, []float64{49999}, points[1].Values)
+	}
+
+	appendFile(t, filePath, "\n50001\n")
+	points, err = s.Follow()
+	assert.NoError(t, err)
+	assert.Len(t, points, 2)
+
+	short := NewCSVSource(filePath, "", "PRICE")
+	short.Tail(100000)
+	points, err = short.Follow()
+	assert.NoError(t, err)
+	assert.Len(t, points, 50002)
+}
+
+func TestCSVSourceReadLimit(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "prices.csv")
+	appendFile(t, filePath, "PRICE\n100\n101\n102\n103\n")
+
+	s := NewCSVSource(filePath, "", "PRICE")
+	s.readLimit = 10
+	var values []float64
+	for _, rows := range []int{1, 2, 1, 0} { // INFO "PRICE\n100\n1", "01\n102\n10", "3\n", nothing left
+		points, err := s.Follow()
+		assert.NoError(t, err)
+		assert.Len(t, points, rows)
+		for _, p := range points {
+			values = append(values, p.Values...)
+		}
+	}
+	assert.Equal(t, []float64{100, 101, 102, 103}, values)
+}
+
+func TestCSVSourceTruncateAndRotate(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "trades.csv")
+	appendFile(t, filePath, "PRICE\n1\n2\n3\n")
+
+	s := NewCSVSource(filePath, "", "PRICE")
+	points, err := s.Follow()
+	assert.NoError(t, err)
+	assert.Len(t, points, 3)
+
+	// truncated
+	assert.NoError(t, os.WriteFile(filePath, []byte("PRICE\n4\n"), 0644))
+	points, err = s.Follow()
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{4}, points[0].Values)
+
+	// rotated
+	assert.NoError(t, os.Rename(filePath, filePath+".1"))
+	appendFile(t, filePath, "PRICE\n5\n6\n")
+	points, err = s.Follow()
+	assert.NoError(t, err)
+	assert.Len(t, points, 2)
+	assert.Equal(t, []float64{5}, points[0].Values)
+}
+
+func TestCSVSourceUnknownColumn(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "trades.csv")
+	appendFile(t, filePath, "PRICE\n1\n")
+
+	_, err := NewCSVSource(filePath, "", "SIZE").Follow()
+	assert.Error(t, err)
+
+	_, err = NewCSVSource(filepath.Join(t.TempDir(), "missing.csv"), "", "PRICE").Follow()
+	assert.Error(t, err)
+}
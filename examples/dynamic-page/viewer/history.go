@@ -0,0 +1,77 @@
+package viewer
+
+import (
+	"time"
+)
+
+// history is a ring buffer of the last points fetched from a source, the oldest are overwritten when full
+type history struct {
+	points []Point // INFO: fixed size storage
+	start  int     // INFO: index of the oldest point
+	size   int
+}
+
+func newHistory(capacity int) *history {
+	return &history{points: make([]Point, capacity)}
+}
+
+func (h *history) add(points ...Point) {
+	for _, p := range points {
+		if h.size < len(h.points) {
+			h.points[(h.start+h.size)%len(h.points)] = p
+			h.size++
+		} else {
+			h.points[h.start] = p
+			h.start = (h.start + 1) % len(h.points)
+		}
+	}
+}
+
+func (h *history) len() int {
+	return h.size
+}
+
+// at returns the i-th oldest point
+func (h *history) at(i int) Point {
+	return h.points[(h.start+i)%len(h.points)]
+}
+
+// last returns a copy of the last n points, oldest first
+func (h *history) last(n int) []Point {
+	if n > h.size {
+		n = h.size
+	}
+	points := make([]Point, 0, n)
+	for i := h.size - n; i < h.size; i++ {
+		points = append(points, h.at(i))
+	}
+	return points
+}
+
+// lastTimes returns a copy of the last points with the n last distinct times, oldest first: the updates of
+// the last n candles of a kline source
+func (h *history) lastTimes(n int) []Point {
+	count, i := 0, h.size
+	for ; i > 0; i-- {
+		if i == h.size || !h.at(i-1).Time.Equal(h.at(i).Time) {
+			if count == n {
+				break
+			}
+			count++
+		}
+	}
+	return h.last(h.size - i)
+}
+
+// between returns a copy of the points in [from, to), a zero from or to leaves the range open on that side
+func (h *history) between(from, to time.Time) []Point {
+	points := make([]Point, 0)
+	for i := 0; i < h.size; i++ {
+		p := h.at(i)
+		if (!from.IsZero() && p.Time.Before(from)) || (!to.IsZero() && !p.Time.Before(to)) {
+			continue
+		}
+		points = append(points, p)
+	}
+	return points
+}
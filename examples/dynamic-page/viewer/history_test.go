@@ -0,0 +1,61 @@
+package viewer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testPoints(base time.Time, values ...float64) []Point {
+	points := make([]Point, 0, len(values))
+	for i, v := range values {
+		points = append(points, Point{Values: []float64{v}, Time: base.Add(time.Duration(i) * time.Second)})
+	}
+	return points
+}
+
+func TestHistoryRing(t *testing.T) {
+	h := newHistory(3)
+	assert.Empty(t, h.last(2))
+
+	base := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	h.add(testPoints(base, 1, 2)...)
+	assert.Equal(t, 2, h.len())
+	assert.Equal(t, testPoints(base, 1, 2), h.last(5))
+
+	// the oldest points are overwritten
+	h.add(testPoints(base.Add(2*time.Second), 3, 4, 5)...)
+	assert.Equal(t, 3, h.len())
+	assert.Equal(t, testPoints(base.Add(2*time.Second), 3, 4, 5), h.last(3))
+	assert.Equal(t, testPoints(base.Add(3*time.Second), 4, 5), h.last(2))
+}
+
+func TestHistoryBetween(t *testing.T) {
+	base := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := newHistory(10)
+	h.add(testPoints(base, 1, 2, 3, 4)...)
+
+	assert.Equal(t, testPoints(base.Add(time.Second), 2, 3), h.between(base.Add(time.Second), base.Add(3*time.Second)))
+	assert.Len(t, h.between(time.Time{}, base.Add(time.Second)), 1)
+	assert.Len(t, h.between(base.Add(2*time.Second), time.Time{}), 2)
+	assert.Len(t, h.between(time.Time{}, time.Time{}), 4)
+}
+
+func TestHistoryLastTimes(t *testing.T) {
+	base := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := newHistory(10)
+	assert.Empty(t, h.lastTimes(2))
+
+	// INFO three updates of a candle, two of the next one and one of the last one
+	points := testPoints(base, 1, 2, 3, 4, 5, 6)
+	for i := range points {
+		points[i].Time = base.Add(time.Duration(i/3+i/5) * time.Minute)
+	}
+	h.add(points...)
+
+	assert.Equal(t, points[5:], h.lastTimes(1))
+	assert.Equal(t, points[3:], h.lastTimes(2))
+	assert.Equal(t, points, h.lastTimes(3))
+	assert.Equal(t, points, h.lastTimes(10))
+}
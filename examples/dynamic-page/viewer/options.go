@@ -0,0 +1,86 @@
+package viewer
+
+import (
+	"fmt"
+
+	"github.com/go-echarts/go-echarts/v2/types"
+)
+
+// Chart types supported by a Viewer
+const (
+	ChartLine    = "line"
+	ChartBar     = "bar"
+	ChartScatter = "scatter"
+	ChartGauge   = "gauge" // INFO shows the last values only, one pointer per series
+	ChartKline   = "kline" // INFO the source returns open, high, low and close, see CandleSource
+
+	klineSeries = 4 // INFO values of every point of a kline source
+)
+
+const (
+	defaultType     = ChartLine
+	defaultInterval = 2000 // milliseconds
+	defaultWidth    = "800px"
+	defaultHeight   = "600px"
+	//defaultTheme  = types.ThemeWesteros
+	defaultTheme = types.ThemeMacarons
+
+	defaultMaxPoints   = 30   // INFO: points shown by the chart
+	defaultHistorySize = 1800 // INFO: points kept by the server, 1 hour with an interval of 2 seconds
+)
+
+// Options configures the chart of a Viewer, zero values are replaced by defaults
+type Options struct {
+	Type      string `json:"type"`      // INFO one of the Chart* constants, defaults to ChartLine
+	Title     string `json:"title"`     // INFO defaults to the viewer name
+	Units     string `json:"units"`     // INFO appended to the values on the Y axis, in the tooltip for ChartGauge
+	Theme     string `json:"theme"`     // INFO one of the go-echarts types.Theme* constants
+	Width     string `json:"width"`     // INFO CSS width of the chart
+	Height    string `json:"height"`    // INFO CSS height of the chart
+	Interval  int    `json:"interval"`  // INFO milliseconds between two fetches of the source
+	MaxPoints int    `json:"maxPoints"` // INFO points shown by the chart, the window sliding over the history
+}
+
+// withDefaults returns a copy of the options with the zero values replaced by the defaults
+func (o Options) withDefaults(name string) Options {
+	if o.Type == "" {
+		o.Type = defaultType
+	}
+	if o.Title == "" {
+		o.Title = name
+	}
+	if o.Theme == "" {
+		o.Theme = defaultTheme
+	}
+	if o.Width == "" {
+		o.Width = defaultWidth
+	}
+	if o.Height == "" {
+		o.Height = defaultHeight
+	}
+	if o.Interval <= 0 {
+		o.Interval = defaultInterval
+	}
+	if o.MaxPoints <= 0 {
+		o.MaxPoints = defaultMaxPoints
+	}
+	return o
+}
+
+// validate checks the chart type against the series of the source
+func (o Options) validate(source Source) error {
+	switch o.Type {
+	case ChartLine, ChartBar, ChartScatter, ChartGauge:
+	case ChartKline:
+		if len(source.Names()) != klineSeries {
+			return fmt.Errorf("%s chart needs %d series (open, high, low, close), source has %d",
+				o.Type, klineSeries, len(source.Names()))
+		}
+	default:
+		return fmt.Errorf("unknown chart type %q", o.Type)
+	}
+	if len(source.Names()) == 0 {
+		return fmt.Errorf("source has no series")
+	}
+	return nil
+}
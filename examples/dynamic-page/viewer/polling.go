@@ -0,0 +1,202 @@
+package viewer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTimeFormat = "15:04:05"
+)
+
+func (v *Viewer) setupPolling(ctx context.Context, interval, shutdownTimeout int) *Viewer {
+	if ctx != nil {
+		v.ctx, v.cancel = context.WithCancel(ctx)
+	} else {
+		v.ctx, v.cancel = context.WithCancel(context.Background())
+	}
+	v.interval = interval
+	v.shutdownTimeout = shutdownTimeout
+	v.history = newHistory(defaultHistorySize)
+	if v.options.MaxPoints > defaultHistorySize {
+		v.history = newHistory(v.options.MaxPoints)
+	}
+	if tailer, ok := v.source.(Tailer); ok { // INFO older points would be overwritten in the history anyway
+		tailer.Tail(len(v.history.points))
+	}
+	v.subscribers = make(map[chan struct{}]bool)
+
+	zap.S().Debugf("Setup polling - interval %d, shutdownTimeout %d",
+		interval, shutdownTimeout)
+
+	return v
+}
+
+func (v *Viewer) Start() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.pollingDone != nil {
+		zap.S().Warnf("%s Viewer already polling", v.Name)
+		return
+	}
+
+	zap.S().Infof("%s Viewer start...", v.Name)
+	v.pollingDone = make(chan struct{})
+	go v.polling(v.pollingDone)
+}
+
+// Stop stops polling the source and closes the events streams, waiting at most shutdownTimeout seconds, then
+// closes the source when it is an io.Closer
+func (v *Viewer) Stop() {
+	zap.S().Infof("%s Viewer stop...", v.Name)
+	v.cancel()
+
+	v.mu.Lock()
+	done := v.pollingDone
+	v.mu.Unlock()
+
+	if done == nil {
+		zap.S().Debugf("%s Viewer was not polling", v.Name)
+	} else {
+		select {
+		case <-done:
+		case <-time.After(time.Duration(v.shutdownTimeout) * time.Second):
+			zap.S().Warnf("%s Viewer polling not stopped after %d seconds", v.Name, v.shutdownTimeout)
+		}
+	}
+
+	if closer, ok := v.source.(io.Closer); ok {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		if err := closer.Close(); err != nil {
+			zap.S().Errorf("%s Viewer source closing failed: %s", v.Name, err.Error())
+		}
+	}
+}
+
+func (v *Viewer) polling(done chan struct{}) {
+	defer close(done)
+
+	zap.S().Infof("%s Viewer polling...", v.Name)
+
+	ticker := time.NewTicker(time.Duration(v.interval) * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if v.subscribed() { // INFO fetch new values only while some chart is listening
+				zap.S().Debugf("%s Viewer fetching new metrics", v.Name)
+				if err := v.fetch(); err != nil {
+					zap.S().Errorf("%s Viewer metrics fetching failed: %s", v.Name, err.Error())
+				}
+			} else {
+				zap.S().Debugf("%s Viewer no subscribers, not fetching new metrics", v.Name)
+			}
+		case <-v.ctx.Done():
+			zap.S().Warnf("%s Viewer stop polling", v.Name)
+			return
+		}
+	}
+}
+
+// fetch reads the new points from the source, a Follower may return none or many, and notifies the subscribers
+func (v *Viewer) fetch() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	var points []Point
+	if follower, ok := v.source.(Follower); ok {
+		var err error
+		if points, err = follower.Follow(); err != nil {
+			return err
+		}
+	} else {
+		values, pointTime, err := v.source.Fetch()
+		if err != nil {
+			return err
+		}
+		points = []Point{{Values: values, Time: pointTime}}
+	}
+
+	for _, p := range points {
+		if len(p.Values) != len(v.source.Names()) {
+			return fmt.Errorf("source returned %d values for %d series", len(p.Values), len(v.source.Names()))
+		}
+	}
+
+	v.history.add(points...)
+	v.seq += int64(len(points))
+
+	if len(points) > 0 {
+		for notify := range v.subscribers {
+			select {
+			case notify <- struct{}{}:
+			default: // INFO already notified, the subscriber reads all the new points at once
+			}
+		}
+	}
+	return nil
+}
+
+// since returns the points fetched after the given sequence number, along with the current one.
+// When the given sequence number does not continue the points of the chart, like 0, one from a previous run or
+// one older than the points shown by the chart, the last Options.MaxPoints points are returned and reset is
+// true: the chart has to drop the points it has. The points of a kline are its last Options.MaxPoints candles,
+// with their updates.
+func (v *Viewer) since(seq int64) (points []Point, last int64, reset bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	count := v.seq - seq
+	if seq <= 0 || count < 0 || count > int64(v.options.MaxPoints) {
+		if v.options.Type == ChartKline {
+			return v.history.lastTimes(v.options.MaxPoints), v.seq, true
+		}
+		count = int64(v.options.MaxPoints)
+		reset = true
+	}
+	return v.history.last(int(count)), v.seq, reset
+}
+
+// between returns the points of the history in [from, to)
+func (v *Viewer) between(from, to time.Time) []Point {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.history.between(from, to)
+}
+
+// subscribe returns a channel notified when new points are fetched, call unsubscribe when done
+func (v *Viewer) subscribe() (notify chan struct{}, unsubscribe func()) {
+	notify = make(chan struct{}, 1)
+
+	v.mu.Lock()
+	v.subscribers[notify] = true
+	v.mu.Unlock()
+
+	return notify, func() {
+		v.mu.Lock()
+		delete(v.subscribers, notify)
+		v.mu.Unlock()
+	}
+}
+
+func (v *Viewer) subscribed() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return len(v.subscribers) > 0
+}
+
+func (v *Viewer) fetched() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.seq > 0
+}
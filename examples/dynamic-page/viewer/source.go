@@ -0,0 +1,119 @@
+package viewer
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"time"
+)
+
+// Source provides the values shown by a Viewer, one series per name
+type Source interface {
+	// Names returns the names of the series, in the same order as the values returned by Fetch
+	Names() []string
+
+	// Fetch returns the current values and the time they refer to
+	Fetch() ([]float64, time.Time, error)
+}
+
+// Follower is a Source producing several points between two fetches, like a file being appended to
+type Follower interface {
+	Source
+
+	// Follow returns the points produced since the previous call, possibly none
+	Follow() ([]Point, error)
+}
+
+// Tailer is a Follower able to skip the old points at the first Follow, keeping only the last ones
+type Tailer interface {
+	Follower
+
+	// Tail limits the first Follow to the last rows
+	Tail(rows int)
+}
+
+// Point holds the values of all the series at a given time
+type Point struct {
+	Values []float64
+	Time   time.Time
+}
+
+// MemStatsSource reads stack and mspan sizes (in MB) from runtime.ReadMemStats
+type MemStatsSource struct {
+	memStats runtime.MemStats
+}
+
+func NewMemStatsSource() *MemStatsSource {
+	return &MemStatsSource{}
+}
+
+func (s *MemStatsSource) Names() []string {
+	return []string{"Sys", "Inuse", "MSpan Sys", "MSpan Inuse"}
+}
+
+func (s *MemStatsSource) Fetch() ([]float64, time.Time, error) {
+	runtime.ReadMemStats(&s.memStats)
+
+	return []float64{
+		fixedPrecision(float64(s.memStats.StackSys)/1024/1024, 2),   // "Sys" series
+		fixedPrecision(float64(s.memStats.StackInuse)/1024/1024, 2), // "Inuse" series
+		fixedPrecision(float64(s.memStats.MSpanSys)/1024/1024, 2),   // "MSpan Sys" series
+		fixedPrecision(float64(s.memStats.MSpanInuse)/1024/1024, 2), // "MSpan Inuse" series
+	}, time.Now(), nil
+}
+
+// FuncSource wraps a function returning the current values, like in-process counters
+type FuncSource struct {
+	names []string
+	fetch func() []float64
+}
+
+func NewFuncSource(fetch func() []float64, names ...string) *FuncSource {
+	return &FuncSource{names: names, fetch: fetch}
+}
+
+func (s *FuncSource) Names() []string {
+	return s.names
+}
+
+func (s *FuncSource) Fetch() ([]float64, time.Time, error) {
+	return s.fetch(), time.Now(), nil
+}
+
+// CandleSource aggregates the first value of a source into open, high, low and close candles of the given
+// duration, every fetch returns the current candle, updated until the next one starts. See ChartKline.
+type CandleSource struct {
+	source   Source
+	duration time.Duration
+	candle   *Point
+}
+
+func NewCandleSource(source Source, duration time.Duration) *CandleSource {
+	return &CandleSource{source: source, duration: duration}
+}
+
+func (s *CandleSource) Names() []string {
+	return []string{"Open", "High", "Low", "Close"}
+}
+
+func (s *CandleSource) Fetch() ([]float64, time.Time, error) {
+	values, valuesTime, err := s.source.Fetch()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if len(values) == 0 {
+		return nil, time.Time{}, fmt.Errorf("source returned no values")
+	}
+
+	value := values[0]
+	start := valuesTime.Truncate(s.duration)
+	if s.candle == nil || !s.candle.Time.Equal(start) {
+		s.candle = &Point{Values: []float64{value, value, value, value}, Time: start}
+	} else {
+		candle := s.candle.Values
+		candle[1] = math.Max(candle[1], value)
+		candle[2] = math.Min(candle[2], value)
+		candle[3] = value
+	}
+	return append([]float64{}, s.candle.Values...), start, nil
+}
@@ -6,66 +6,98 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"net/http"
 	"strconv"
+	"sync"
 	"text/template"
+	"time"
 
 	"github.com/bygui86/go-csv-view/examples/dynamic-page/statics"
-	"github.com/go-echarts/go-echarts/v2/charts"
-	"github.com/go-echarts/go-echarts/v2/opts"
-	"github.com/go-echarts/go-echarts/v2/types"
+	"github.com/go-echarts/go-echarts/v2/components"
 	"go.uber.org/zap"
 )
 
-const (
-	defaultWidth  = "800px"
-	defaultHeight = "600px"
-	//defaultTheme  = types.ThemeWesteros
-	defaultTheme = types.ThemeMacarons
-
-	defaultInterval  = 2000
-	defaultMaxPoints = 30
-)
-
 type Viewer struct { // INFO fields globally visible because used by manager.Manager
 	Name        string
 	Address     string
 	AddressPath string
-	Graph       *charts.Line
-	updater     *Updater
+	Graph       components.Charter
+	ChartID     string
+	source      Source
+	options     Options
+
+	ctx             context.Context
+	cancel          context.CancelFunc
+	interval        int // milliseconds
+	shutdownTimeout int
+
+	mu          sync.Mutex             // INFO: guards the fields below and the source, used by polling and HTTP handlers
+	pollingDone chan struct{}          // INFO: closed when polling returns, nil until Start
+	history     *history               // INFO: last points fetched from the source, at least defaultHistorySize
+	seq         int64                  // INFO: number of points fetched since the start, identifies the last point
+	subscribers map[chan struct{}]bool // INFO: notified when new points are fetched
 }
 
 // ViewerTemplate defines fields used in the default view template
-// WARN: changing names or types, please remember to change also the content of statics.ViewTemplate const
+// WARN: changing names or types, please remember to change also the content of statics.ViewTemplate and of the
+// update templates
 type ViewerTemplate struct { // INFO fields globally visible because used in statics.ViewTemplate
-	Interval  int
-	MaxPoints int
-	Address   string
-	ViewPath  string
-	ViewID    string
+	MaxPoints  int
+	Address    string
+	EventsPath string
+	ViewID     string
+	Seq        int64 // INFO: sequence number of the last point embedded in the page
 }
 
+// Metrics holds the points fetched after the sequence number sent by the browser
 type Metrics struct {
-	Values []float64 `json:"values"`
-	Time   string    `json:"time"`
+	Points []MetricsPoint `json:"points"`
+	Seq    int64          `json:"seq"`
+	Reset  bool           `json:"reset"` // INFO the points do not follow the ones of the browser, which has to drop them
+}
+
+type MetricsPoint struct {
+	Values []interface{} `json:"values"` // INFO missing values are "-", the echarts placeholder, as NaN is not valid JSON
+	Time   string        `json:"time"`
+}
+
+// History holds the points of a time range of the history kept by the server
+type History struct {
+	Names  []string       `json:"names"`
+	Points []HistoryPoint `json:"points"`
+}
+
+type HistoryPoint struct {
+	Time   time.Time     `json:"time"`
+	Values []interface{} `json:"values"`
 }
 
-func NewViewer(name, address, addressPath string,
-	ctx context.Context, interval, shutdownTimeout int) *Viewer {
+// NewViewer creates a viewer plotting a chart of the given type with a series for every name declared by the
+// source, a kline chart needs a source declaring open, high, low and close
+func NewViewer(name, address, addressPath string, source Source, options Options,
+	ctx context.Context, shutdownTimeout int) (*Viewer, error) {
+
+	options = options.withDefaults(name)
+	zap.S().Debugf("New Viewer - name %s, address %s, addressPath %s, series %v, options %+v, shutdownTimeout %d",
+		name, address, addressPath, source.Names(), options, shutdownTimeout)
 
-	zap.S().Debugf("New Viewer - name %s, address %s, addressPath %s, interval %d, shutdownTimeout %d",
-		name, address, addressPath, interval, shutdownTimeout)
+	if err := options.validate(source); err != nil {
+		return nil, fmt.Errorf("%s viewer: %w", name, err)
+	}
 
 	return (&Viewer{}).
-		setupGeneral(name, address, addressPath).
+		setupGeneral(name, address, addressPath, source, options).
 		setupGraph().
-		setupUpdater(ctx, interval, shutdownTimeout)
+		setupPolling(ctx, options.Interval, shutdownTimeout), nil
 }
 
-func (v *Viewer) setupGeneral(name, address, addressPath string) *Viewer {
+func (v *Viewer) setupGeneral(name, address, addressPath string, source Source, options Options) *Viewer {
 	v.Name = name
 	v.Address = address
 	v.AddressPath = addressPath
+	v.source = source
+	v.options = options
 
 	zap.S().Debugf("Setup general - name %s, address %s, addressPath %s",
 		name, address, addressPath)
@@ -73,60 +105,46 @@ func (v *Viewer) setupGeneral(name, address, addressPath string) *Viewer {
 	return v
 }
 
-func (v *Viewer) setupGraph() *Viewer {
-	v.Graph = charts.NewLine()
-	v.Graph.SetGlobalOptions(
-		charts.WithTitleOpts(opts.Title{Title: "Line sample"}),
-		charts.WithLegendOpts(opts.Legend{Show: true}),
-		charts.WithTooltipOpts(opts.Tooltip{Show: true, Trigger: "axis"}),
-		charts.WithInitializationOpts(opts.Initialization{
-			Width:  defaultWidth,
-			Height: defaultHeight,
-			Theme:  defaultTheme,
-		}),
-		charts.WithXAxisOpts(opts.XAxis{Name: "Time"}),
-		charts.WithYAxisOpts(opts.YAxis{Name: "Size", AxisLabel: &opts.AxisLabel{Formatter: "{value} MB"}}),
-	)
-	v.Graph.SetXAxis([]string{}).SetSeriesOptions(charts.WithLineChartOpts(opts.LineChart{Smooth: true}))
-
-	// v.Name NOT ENOUGH!!
-	v.Graph.AddJSFuncs(v.generateViewTemplate())
-
-	v.Graph.
-		AddSeries("Sys", []opts.LineData{}).
-		AddSeries("Inuse", []opts.LineData{}).
-		AddSeries("MSpan Sys", []opts.LineData{}).
-		AddSeries("MSpan Inuse", []opts.LineData{})
-
-	zap.S().Debugf("Setup graph")
-
-	return v
+// Options returns the options of the chart, defaults included
+func (v *Viewer) Options() Options {
+	return v.options
 }
 
-func (v *Viewer) setupUpdater(ctx context.Context, interval, shutdownTimeout int) *Viewer {
-	v.updater = newUpdater(ctx, interval, shutdownTimeout)
-
-	zap.S().Debugf("Setup updater")
-
-	return v
+// Series returns the names of the series of the chart
+func (v *Viewer) Series() []string {
+	return v.source.Names()
 }
 
-func (v *Viewer) Start() {
-	zap.S().Infof("%s Viewer start...", v.Name)
+// EmbedHistory fills the graph with the last points of the history, along with the script subscribing the
+// chart to the next points pushed by the events endpoint served at eventsPath, see Stream.
+// The graph is changed in place: calls must not overlap with the rendering of the graph.
+func (v *Viewer) EmbedHistory(eventsPath string) {
+	points, seq, _ := v.since(0)
 
-	v.updater.Start()
-}
+	v.embedPoints(points)
 
-func (v *Viewer) Stop() {
-	zap.S().Infof("%s Viewer stop...", v.Name)
+	base := v.base()
+	base.JSFunctions.Fns = nil
+	base.AddJSFuncs(v.generateViewTemplate(eventsPath, seq))
 
-	v.updater.Stop()
+	zap.S().Debugf("%s Viewer history embedded - %d points, seq %d", v.Name, len(points), seq)
 }
 
-func (v *Viewer) generateViewTemplate() string {
+func (v *Viewer) generateViewTemplate(eventsPath string, seq int64) string {
 	zap.S().Debugf("Generate view template")
 
+	update := statics.AxisUpdateTemplate
+	switch v.options.Type {
+	case ChartGauge:
+		update = statics.GaugeUpdateTemplate
+	case ChartKline:
+		update = statics.KlineUpdateTemplate
+	}
+
 	tpl, tplErr := template.New("view").Parse(statics.ViewTemplate)
+	if tplErr == nil {
+		_, tplErr = tpl.New("update").Parse(update)
+	}
 	if tplErr != nil {
 		log.Fatalf("template parsing failed: %s" + tplErr.Error())
 	}
@@ -135,11 +153,11 @@ func (v *Viewer) generateViewTemplate() string {
 	execErr := tpl.Execute(
 		&buf,
 		&ViewerTemplate{ // TODO what about & ?
-			Interval:  defaultInterval,
-			MaxPoints: defaultMaxPoints,
-			Address:   v.Address,
-			ViewPath:  v.AddressPath,
-			ViewID:    v.Graph.ChartID,
+			MaxPoints:  v.options.MaxPoints,
+			Address:    v.Address,
+			EventsPath: eventsPath,
+			ViewID:     v.ChartID,
+			Seq:        seq,
 		},
 	)
 	if execErr != nil {
@@ -149,36 +167,156 @@ func (v *Viewer) generateViewTemplate() string {
 	return buf.String()
 }
 
-func (v *Viewer) Handler(w http.ResponseWriter, _ *http.Request) {
+// Handler returns the points fetched after the sequence number in the "since" query parameter
+func (v *Viewer) Handler(w http.ResponseWriter, r *http.Request) {
 	zap.S().Infof("%s Viewer handler", v.Name)
 
-	v.updater.ViewerRefresh() // INFO let the updater know last time the view was refreshed
-
-	metrics := Metrics{
-		Values: []float64{
-			fixedPrecision(float64(goMemStats.MemStats.StackSys)/1024/1024, 2),   // "Sys" series
-			fixedPrecision(float64(goMemStats.MemStats.StackInuse)/1024/1024, 2), // "Inuse" series
-			fixedPrecision(float64(goMemStats.MemStats.MSpanSys)/1024/1024, 2),   // "MSpan Sys" series
-			fixedPrecision(float64(goMemStats.MemStats.MSpanInuse)/1024/1024, 2), // "MSpan Inuse" series
-		},
-		Time: goMemStats.PointTime,
+	if !v.subscribed() || !v.fetched() { // INFO not polling, fetch the new points right away
+		if err := v.fetch(); err != nil {
+			zap.S().Errorf("%s Viewer metrics fetching failed: %s", v.Name, err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
 	}
 
-	zap.S().Debugf("%s Viewer new metrics: Sys %.2f, Inuse %.2f, MSpan Sys %.2f, MSpan Inuse %.2f, time %s",
-		v.Name, metrics.Values[0], metrics.Values[1], metrics.Values[2], metrics.Values[3], metrics.Time)
-
-	metricsBytes, jsonErr := json.Marshal(metrics)
+	since, _ := strconv.ParseInt(r.URL.Query().Get("since"), 10, 64)
+	metricsBytes, jsonErr := json.Marshal(newMetrics(v.since(since)))
 	if jsonErr != nil {
 		zap.S().Errorf("view json marhal failed: %s", jsonErr.Error())
+		http.Error(w, jsonErr.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	w.Header().Set("Content-Type", "application/json")
 	_, wrErr := w.Write(metricsBytes)
 	if wrErr != nil {
 		zap.S().Errorf("view rendering failed: %s", wrErr.Error())
 	}
 }
 
+// Stream pushes the new points as Server-Sent Events, the id of every event is the sequence number of its
+// last point. Browsers reconnect by themselves sending the last id received, so the missed points are sent first.
+func (v *Viewer) Stream(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// INFO the page sends the last point it embeds, the browser the last event received when reconnecting
+	lastID := r.Header.Get("Last-Event-ID")
+	if lastID == "" {
+		lastID = r.URL.Query().Get("since")
+	}
+	since, _ := strconv.ParseInt(lastID, 10, 64)
+	zap.S().Infof("%s Viewer stream - since %d", v.Name, since)
+
+	notify, unsubscribe := v.subscribe()
+	defer unsubscribe()
+
+	if !v.fetched() { // INFO first subscriber, do not wait for the next tick
+		if err := v.fetch(); err != nil {
+			zap.S().Errorf("%s Viewer metrics fetching failed: %s", v.Name, err.Error())
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", v.interval); err != nil {
+		return
+	}
+	flusher.Flush()
+
+	for {
+		points, seq, reset := v.since(since)
+		if len(points) > 0 || reset {
+			metricsBytes, jsonErr := json.Marshal(newMetrics(points, seq, reset))
+			if jsonErr != nil {
+				zap.S().Errorf("%s Viewer stream json marshal failed: %s", v.Name, jsonErr.Error())
+				return
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\ndata: %s\n\n", seq, metricsBytes); err != nil {
+				zap.S().Debugf("%s Viewer stream closed: %s", v.Name, err.Error())
+				return
+			}
+			flusher.Flush()
+			since = seq
+		}
+
+		select {
+		case <-notify:
+		case <-r.Context().Done():
+			zap.S().Infof("%s Viewer stream closed by client", v.Name)
+			return
+		case <-v.ctx.Done():
+			return
+		}
+	}
+}
+
+func newMetrics(points []Point, seq int64, reset bool) *Metrics {
+	metrics := &Metrics{Points: make([]MetricsPoint, 0, len(points)), Seq: seq, Reset: reset}
+	for _, p := range points {
+		metrics.Points = append(metrics.Points, MetricsPoint{Values: jsonValues(p.Values), Time: p.Time.Format(defaultTimeFormat)})
+	}
+	return metrics
+}
+
+// HistoryHandler returns the points of the history in the time range given by the "from" (included) and "to"
+// (excluded) RFC3339 query parameters, both optional
+func (v *Viewer) HistoryHandler(w http.ResponseWriter, r *http.Request) {
+	zap.S().Infof("%s Viewer history handler", v.Name)
+
+	var from, to time.Time
+	for param, t := range map[string]*time.Time{"from": &from, "to": &to} {
+		value := r.URL.Query().Get(param)
+		if value == "" {
+			continue
+		}
+		var parseErr error
+		if *t, parseErr = time.Parse(time.RFC3339, value); parseErr != nil {
+			http.Error(w, fmt.Sprintf("%s is not a RFC3339 time: %s", param, value), http.StatusBadRequest)
+			return
+		}
+	}
+
+	points := v.between(from, to)
+	history := History{Names: v.source.Names(), Points: make([]HistoryPoint, 0, len(points))}
+	for _, p := range points {
+		history.Points = append(history.Points, HistoryPoint{Time: p.Time, Values: jsonValues(p.Values)})
+	}
+
+	historyBytes, jsonErr := json.Marshal(history)
+	if jsonErr != nil {
+		zap.S().Errorf("history json marhal failed: %s", jsonErr.Error())
+		http.Error(w, jsonErr.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, wrErr := w.Write(historyBytes)
+	if wrErr != nil {
+		zap.S().Errorf("history rendering failed: %s", wrErr.Error())
+	}
+}
+
+// jsonValue converts a missing value to the echarts placeholder, NaN is not valid JSON
+func jsonValue(value float64) interface{} {
+	if math.IsNaN(value) {
+		return "-"
+	}
+	return value
+}
+
+func jsonValues(values []float64) []interface{} {
+	converted := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		converted = append(converted, jsonValue(value))
+	}
+	return converted
+}
+
 func fixedPrecision(n float64, p int) float64 {
 	var r float64
 	switch p {
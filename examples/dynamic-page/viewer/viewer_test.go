@@ -0,0 +1,220 @@
+package viewer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-echarts/go-echarts/v2/opts"
+	"github.com/stretchr/testify/assert"
+)
+
+func counterSource(counter *int64, names ...string) Source {
+	return NewFuncSource(func() []float64 {
+		n := float64(atomic.AddInt64(counter, 1))
+		values := make([]float64, len(names))
+		for i := range values {
+			values[i] = n
+		}
+		return values
+	}, names...)
+}
+
+func newTestViewer(name string, source Source, interval int) *Viewer {
+	v, err := NewViewer(name, "localhost:8080", "/page/view/"+name, source, Options{Interval: interval}, context.Background(), 1)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func getMetrics(t *testing.T, handler http.HandlerFunc, query string) *Metrics {
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/"+query, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	metrics := &Metrics{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), metrics))
+	return metrics
+}
+
+func TestViewersDoNotShareState(t *testing.T) {
+	var a, b int64
+	viewerA := newTestViewer("a", counterSource(&a, "A"), 1000)
+	viewerB := newTestViewer("b", counterSource(&b, "B1", "B2"), 1000)
+
+	assert.Equal(t, []interface{}{1.0}, getMetrics(t, viewerA.Handler, "").Points[0].Values)
+	assert.Equal(t, []interface{}{2.0}, getMetrics(t, viewerA.Handler, "?since=1").Points[0].Values)
+	assert.Equal(t, []interface{}{1.0, 1.0}, getMetrics(t, viewerB.Handler, "").Points[0].Values)
+}
+
+func TestSince(t *testing.T) {
+	var counter int64
+	v := newTestViewer("since", counterSource(&counter, "N"), 1000)
+	for i := 0; i < defaultMaxPoints+5; i++ {
+		assert.NoError(t, v.fetch())
+	}
+
+	_, seq, _ := v.since(0)
+	assert.Equal(t, int64(defaultMaxPoints+5), seq)
+
+	points, _, reset := v.since(seq)
+	assert.Empty(t, points)
+	assert.False(t, reset)
+
+	points, _, reset = v.since(seq - 2)
+	assert.Len(t, points, 2)
+	assert.False(t, reset)
+
+	for _, since := range []int64{0, 1, seq + 10} {
+		points, _, reset = v.since(since)
+		assert.Len(t, points, defaultMaxPoints, since)
+		assert.True(t, reset, since)
+	}
+}
+
+func TestStreamBackfill(t *testing.T) {
+	var counter int64
+	v := newTestViewer("stream", counterSource(&counter, "N"), 1000)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, v.fetch())
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(v.Stream))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	events := readEvents(bufio.NewReader(resp.Body), 1)
+	assert.Len(t, events, 1)
+	assert.Equal(t, int64(3), events[0].Seq)
+	assert.False(t, events[0].Reset)
+	assert.Len(t, events[0].Points, 2)
+
+	assert.NoError(t, v.fetch())
+	events = readEvents(bufio.NewReader(resp.Body), 1)
+	assert.Equal(t, int64(4), events[0].Seq)
+	assert.Len(t, events[0].Points, 1)
+}
+
+// readEvents reads count events of a Server-Sent Events stream
+func readEvents(r *bufio.Reader, count int) []*Metrics {
+	events := make([]*Metrics, 0, count)
+	for len(events) < count {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return events
+		}
+		if data := strings.TrimPrefix(line, "data: "); data != line {
+			metrics := &Metrics{}
+			if json.Unmarshal([]byte(data), metrics) == nil {
+				events = append(events, metrics)
+			}
+		}
+	}
+	return events
+}
+
+func TestConcurrentHandlers(t *testing.T) {
+	var counter int64
+	v := newTestViewer("race", counterSource(&counter, "N"), 1)
+	v.Start()
+	defer v.Stop()
+
+	srv := httptest.NewServer(http.HandlerFunc(v.Stream))
+	defer srv.Close()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				rec := httptest.NewRecorder()
+				v.Handler(rec, httptest.NewRequest(http.MethodGet, "/?since=1", nil))
+				assert.Equal(t, http.StatusOK, rec.Code)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+			resp, err := http.DefaultClient.Do(req)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer resp.Body.Close()
+			assert.Len(t, readEvents(bufio.NewReader(resp.Body), 5), 5)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestEmbedHistory(t *testing.T) {
+	var counter int64
+	v := newTestViewer("embed", counterSource(&counter, "A", "B"), 1000)
+	for i := 0; i < defaultMaxPoints+5; i++ {
+		assert.NoError(t, v.fetch())
+	}
+
+	v.EmbedHistory("/page/events")
+	v.EmbedHistory("/page/events")
+
+	base := v.base()
+	assert.Len(t, base.XAxisList[0].Data, defaultMaxPoints)
+	assert.Len(t, base.MultiSeries, 2)
+	for _, series := range base.MultiSeries {
+		data := series.Data.([]opts.LineData)
+		assert.Len(t, data, defaultMaxPoints)
+		assert.Equal(t, 6.0, data[0].Value)
+		assert.Equal(t, float64(defaultMaxPoints+5), data[defaultMaxPoints-1].Value)
+	}
+
+	assert.Len(t, base.JSFunctions.Fns, 1)
+	assert.Contains(t, base.JSFunctions.Fns[0], "/page/events?chart="+v.ChartID+"&since=35")
+}
+
+func TestHistoryHandler(t *testing.T) {
+	var counter int64
+	v := newTestViewer("history", counterSource(&counter, "N"), 1000)
+	from := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, v.fetch())
+	}
+
+	rec := httptest.NewRecorder()
+	v.HistoryHandler(rec, httptest.NewRequest(http.MethodGet, "/history?from="+from.Add(-time.Second).Format(time.RFC3339), nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	history := &History{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), history))
+	assert.Equal(t, []string{"N"}, history.Names)
+	assert.Len(t, history.Points, 3)
+	assert.Equal(t, []interface{}{3.0}, history.Points[2].Values)
+
+	rec = httptest.NewRecorder()
+	v.HistoryHandler(rec, httptest.NewRequest(http.MethodGet, "/history?to="+from.Add(-time.Hour).Format(time.RFC3339), nil))
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), history))
+	assert.Empty(t, history.Points)
+
+	rec = httptest.NewRecorder()
+	v.HistoryHandler(rec, httptest.NewRequest(http.MethodGet, "/history?from=yesterday", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
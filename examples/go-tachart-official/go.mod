@@ -2,6 +2,14 @@ module github.com/bygui86/go-csv-view/examples/go-tachart-official
 
 go 1.17
 
-require github.com/iamjinlei/go-tachart v0.0.0-20210729041122-12052a3368c8
+require (
+	github.com/bygui86/go-csv-view v0.0.0
+	github.com/iamjinlei/go-tachart v0.0.0-20210729041122-12052a3368c8
+)
 
 require github.com/iamjinlei/go-tart v0.0.0-20210623083942-ceb57e98706b // indirect
+
+replace (
+	github.com/bygui86/go-csv-view => ../..
+	github.com/bygui86/go-csv-view/alaingilbert-go-echarts => ../../alaingilbert-go-echarts
+)
@@ -1,12 +1,9 @@
 package main
 
 import (
-	"encoding/csv"
 	"log"
-	"os"
-	"strconv"
-	"strings"
 
+	"github.com/bygui86/go-csv-view/csvview"
 	"github.com/iamjinlei/go-tachart/tachart"
 )
 
@@ -16,12 +13,15 @@ const (
 )
 
 func main() {
-	records, loadErr := loadCsv(csvFilePath)
+	table, loadErr := csvview.Load(csvFilePath)
 	if loadErr != nil {
 		log.Fatal(loadErr)
 	}
 
-	cdls := prepareData(records)
+	cdls, prepareErr := prepareData(table)
+	if prepareErr != nil {
+		log.Fatal(prepareErr)
+	}
 
 	events := []tachart.Event{
 		{
@@ -50,52 +50,31 @@ func main() {
 	}
 }
 
-func prepareData(records [][]string) []tachart.Candle {
-	start := 0
-	if strings.Contains(records[0][0], "CLOSED_AT") {
-		start = 1
+func prepareData(table *csvview.Table) ([]tachart.Candle, error) {
+	// CLOSED_AT,OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME,COMPONENT,BUCKET
+	labels, err := table.Strings("OPENED_AT")
+	if err != nil {
+		return nil, err
 	}
-	candles := make([]tachart.Candle, 0)
-	for _, record := range records[start:] {
-		// CLOSED_AT,OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME,COMPONENT,BUCKET
-		openVal, err := strconv.ParseFloat(record[2], 64)
-		if err != nil {
-			continue
-		}
-		closeVal, err := strconv.ParseFloat(record[5], 64)
-		if err != nil {
-			continue
-		}
-		lowVal, err := strconv.ParseFloat(record[4], 64)
-		if err != nil {
-			continue
-		}
-		highVal, err := strconv.ParseFloat(record[3], 64)
+	columns := make(map[string][]float64, 5)
+	for _, name := range []string{"OPEN", "HIGH", "LOW", "CLOSE", "VOLUME"} {
+		columns[name], err = table.Floats(name)
 		if err != nil {
-			continue
+			return nil, err
 		}
-		volumeVal, err := strconv.ParseFloat(record[6], 64)
-		if err != nil {
-			continue
-		}
-
-		candles = append(candles,
-			tachart.Candle{Label: record[1], O: openVal, C: closeVal, L: lowVal, H: highVal, V: volumeVal})
 	}
 
-	return candles
-}
-
-func loadCsv(filePath string) ([][]string, error) {
-	file, openErr := os.Open(filePath)
-	if openErr != nil {
-		return nil, openErr
-	}
-	reader := csv.NewReader(file)
-	reader.LazyQuotes = true
-	records, readErr := reader.ReadAll()
-	if readErr != nil {
-		return nil, readErr
+	candles := make([]tachart.Candle, 0, table.Len())
+	for i, label := range labels {
+		candles = append(candles, tachart.Candle{
+			Label: label,
+			O:     columns["OPEN"][i],
+			C:     columns["CLOSE"][i],
+			L:     columns["LOW"][i],
+			H:     columns["HIGH"][i],
+			V:     columns["VOLUME"][i],
+		})
 	}
-	return records, nil
+
+	return candles, nil
 }
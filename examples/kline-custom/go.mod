@@ -2,4 +2,19 @@ module github.com/bygui86/go-csv-view/examples/kline-custom
 
 go 1.17
 
-require github.com/bygui86/go-csv-view/alaingilbert-go-echarts v0.0.0-20220118220303-fcf8854ce59a
+require (
+	github.com/bygui86/go-csv-view v0.0.0
+	github.com/bygui86/go-csv-view/alaingilbert-go-echarts v0.0.0
+	github.com/stretchr/testify v1.7.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace (
+	github.com/bygui86/go-csv-view => ../..
+	github.com/bygui86/go-csv-view/alaingilbert-go-echarts => ../../alaingilbert-go-echarts
+)
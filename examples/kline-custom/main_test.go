@@ -0,0 +1,24 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charttest"
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/bygui86/go-csv-view/finchart"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChart(t *testing.T) {
+	// enough candles for the MACD signal, the EMA200 stays empty to keep the golden file small
+	table, err := csvview.Load(charttest.HeadCSV(t, csvFilePath, 40))
+	require.NoError(t, err)
+	dataset, err := prepareOhlcvData(table)
+	require.NoError(t, err)
+	events, err := finchart.LoadEvents("testdata/events.csv", finchart.DefaultEventColumns)
+	require.NoError(t, err)
+
+	kline, err := plotChart(dataset, events)
+	require.NoError(t, err)
+	charttest.AssertGolden(t, "ohlcv", kline)
+}
@@ -0,0 +1,15 @@
+module github.com/bygui86/go-csv-view/examples/kline-trades
+
+go 1.17
+
+require (
+	github.com/bygui86/go-csv-view v0.0.0
+	github.com/bygui86/go-csv-view/alaingilbert-go-echarts v0.0.0
+)
+
+require github.com/davecgh/go-spew v1.1.1 // indirect
+
+replace (
+	github.com/bygui86/go-csv-view => ../..
+	github.com/bygui86/go-csv-view/alaingilbert-go-echarts => ../../alaingilbert-go-echarts
+)
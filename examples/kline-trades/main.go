@@ -0,0 +1,185 @@
+package main
+
+import (
+	"io"
+	"log"
+	"math"
+	"os"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/components"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/types"
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/bygui86/go-csv-view/resample"
+)
+
+const (
+	csvFilePath  = "trades.csv"
+	htmlFilePath = "candles.html"
+
+	bidSide = "BID"
+	askSide = "ASK"
+)
+
+// intervals of the candles, one chart each
+var intervals = []string{"5s", "15s", "1m"}
+
+func main() {
+	klines := make([]components.Charter, 0, len(intervals))
+	for _, interval := range intervals {
+		kline, plotErr := plotInterval(interval)
+		if plotErr != nil {
+			log.Fatal(plotErr)
+		}
+		klines = append(klines, kline)
+	}
+
+	pageErr := createHtml(htmlFilePath, klines...)
+	if pageErr != nil {
+		log.Fatal(pageErr)
+	}
+}
+
+func createHtml(filePath string, charts ...components.Charter) error {
+	// the chart IDs are derived from the titles, regenerating the unchanged charts gives the same HTML file
+	page := components.NewPage().SetChartIDStrategy(opts.HashChartID)
+	page.AddCharts(charts...)
+
+	file, createErr := os.Create(filePath)
+	if createErr != nil {
+		return createErr
+	}
+	return page.Render(io.MultiWriter(file))
+}
+
+// plotInterval resamples the trades into candles of the given interval and plots them with the volume by side.
+func plotInterval(interval string) (*charts.Kline, error) {
+	duration, parseErr := resample.ParseInterval(interval)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	// gaps are filled, so candles and side volumes share the same X axis
+	resampler, resampleErr := resample.File(csvFilePath, resample.Options{
+		Interval: duration,
+		BySide:   true,
+		FillGaps: true,
+	})
+	if resampleErr != nil {
+		return nil, resampleErr
+	}
+
+	table, tableErr := resample.Table(resampler.Candles())
+	if tableErr != nil {
+		return nil, tableErr
+	}
+
+	xAxe, ohlcYaxe, prepareErr := prepareOhlcData(table)
+	if prepareErr != nil {
+		return nil, prepareErr
+	}
+
+	return plotChart(interval, xAxe, ohlcYaxe,
+		prepareVolumeData(resampler.Side(bidSide)),
+		prepareVolumeData(resampler.Side(askSide)),
+	), nil
+}
+
+func plotChart(interval string, xAxe []string, ohlcYaxe []opts.KlineData, bidYaxe, askYaxe []opts.BarData) *charts.Kline {
+	kline := charts.NewKLine()
+	kline.SetGlobalOptions(
+		charts.WithTitleOpts(opts.Title{
+			Title:    "Binance | TRADES | BTC-USDT | 2022-01-01",
+			Subtitle: "OHLCV resampled every " + interval,
+		}),
+		charts.WithDataZoomOpts(opts.DataZoom{
+			Start:      0,
+			End:        100,
+			XAxisIndex: []int{0},
+		}),
+		charts.WithLegendOpts(opts.Legend{
+			Show:         true,
+			SelectedMode: "multiple",
+		}),
+		charts.WithTooltipOpts(opts.Tooltip{
+			Show:    true,
+			Trigger: "axis",
+			AxisPointer: &opts.AxisPointer{
+				Type: "cross",
+				Snap: true,
+			},
+		}),
+		// AXIS
+		charts.WithXAxisOpts(opts.XAxis{
+			SplitNumber: 20,
+		}),
+		charts.WithYAxisOpts(opts.YAxis{
+			Name:  "Price",
+			Type:  "value",
+			Show:  true,
+			Scale: true,
+		}),
+	)
+
+	kline.ExtendYAxis(opts.YAxis{
+		Name:  "Volume",
+		Type:  "value",
+		Show:  true,
+		Scale: true,
+	})
+
+	kline.SetXAxis(xAxe).AddSeries("ohlc", ohlcYaxe)
+
+	volume := charts.NewBar()
+	volume.AddSeries("bid", bidYaxe, charts.WithBarChartOpts(opts.BarChart{Type: types.ChartBar, Stack: "volume", YAxisIndex: 1})).
+		AddSeries("ask", askYaxe, charts.WithBarChartOpts(opts.BarChart{Type: types.ChartBar, Stack: "volume", YAxisIndex: 1}))
+	kline.Overlap(volume)
+
+	return kline
+}
+
+func prepareOhlcData(table *csvview.Table) ([]string, []opts.KlineData, error) {
+	// CLOSED_AT,OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME,TRADES
+	times, err := table.Times("OPENED_AT")
+	if err != nil {
+		return nil, nil, err
+	}
+	columns := make(map[string][]float64, 4)
+	for _, name := range []string{"OPEN", "HIGH", "LOW", "CLOSE"} {
+		columns[name], err = table.Floats(name)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	x := make([]string, 0, table.Len())
+	ohlcY := make([]opts.KlineData, 0, table.Len())
+	for i := 0; i < table.Len(); i++ {
+		x = append(x, times[i].Format("15:04:05"))
+		ohlcY = append(ohlcY, opts.KlineData{
+			Value: candle(columns["OPEN"][i], columns["CLOSE"][i], columns["LOW"][i], columns["HIGH"][i]),
+		})
+	}
+
+	return x, ohlcY, nil
+}
+
+func prepareVolumeData(candles []resample.Candle) []opts.BarData {
+	y := make([]opts.BarData, 0, len(candles))
+	for _, c := range candles {
+		y = append(y, opts.BarData{Value: c.Volume})
+	}
+	return y
+}
+
+// candle returns the value of a candle, [open, close, lowest, highest], or the echarts placeholder "-"
+// when a price is missing
+func candle(open, close, low, high float64) interface{} {
+	for _, v := range []float64{open, close, low, high} {
+		if math.IsNaN(v) {
+			return "-"
+		}
+	}
+	return [4]float64{open, close, low, high}
+}
@@ -1,16 +1,15 @@
 package main
 
 import (
-	"encoding/csv"
 	"io"
 	"log"
+	"math"
 	"os"
-	"strconv"
-	"strings"
 
-	"github.com/go-echarts/go-echarts/v2/charts"
-	"github.com/go-echarts/go-echarts/v2/components"
-	"github.com/go-echarts/go-echarts/v2/opts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/components"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/csvview"
 )
 
 const (
@@ -19,12 +18,15 @@ const (
 )
 
 func main() {
-	records, loadErr := loadCsv(csvFilePath)
+	table, loadErr := csvview.Load(csvFilePath)
 	if loadErr != nil {
 		log.Fatal(loadErr)
 	}
 
-	xAxe, ohlcYaxe, volLineYaxe, volBarYaxe := prepareOhlcvData(records)
+	xAxe, ohlcYaxe, volLineYaxe, volBarYaxe, prepareErr := prepareOhlcvData(table)
+	if prepareErr != nil {
+		log.Fatal(prepareErr)
+	}
 
 	simpleChart := plotSimpleChart(xAxe, ohlcYaxe)
 
@@ -41,7 +43,8 @@ func main() {
 }
 
 func createHtml(filePath string, charts ...components.Charter) error {
-	page := components.NewPage()
+	// the chart IDs are derived from the titles, regenerating the unchanged charts gives the same HTML file
+	page := components.NewPage().SetChartIDStrategy(opts.HashChartID)
 	page.AddCharts(charts...)
 
 	file, createErr := os.Create(filePath)
@@ -246,62 +249,50 @@ func plotSimpleChart(xAxe []string, yAxe []opts.KlineData) *charts.Kline {
 	return kline
 }
 
-func prepareOhlcvData(records [][]string) ([]string, []opts.KlineData, []opts.LineData, []opts.BarData) {
-	start := 0
-	if strings.Contains(records[0][0], "CLOSED_AT") {
-		start = 1
+func prepareOhlcvData(table *csvview.Table) ([]string, []opts.KlineData, []opts.LineData, []opts.BarData, error) {
+	// CLOSED_AT,OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME,COMPONENT,BUCKET
+	x, err := table.Strings("OPENED_AT")
+	if err != nil {
+		return nil, nil, nil, nil, err
 	}
-	x := make([]string, 0)
-	ohlcY := make([]opts.KlineData, 0)
-	volLineY := make([]opts.LineData, 0)
-	volBarY := make([]opts.BarData, 0)
-	for _, record := range records[start:] {
-		// CLOSED_AT,OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME,COMPONENT,BUCKET
-		openVal, err := strconv.ParseFloat(record[2], 64)
-		if err != nil {
-			continue
-		}
-		closeVal, err := strconv.ParseFloat(record[5], 64)
-		if err != nil {
-			continue
-		}
-		lowVal, err := strconv.ParseFloat(record[4], 64)
-		if err != nil {
-			continue
-		}
-		highVal, err := strconv.ParseFloat(record[3], 64)
-		if err != nil {
-			continue
-		}
-		volumeVal, err := strconv.ParseFloat(record[6], 64)
+	columns := make(map[string][]float64, 5)
+	for _, name := range []string{"OPEN", "HIGH", "LOW", "CLOSE", "VOLUME"} {
+		columns[name], err = table.Floats(name)
 		if err != nil {
-			continue
+			return nil, nil, nil, nil, err
 		}
+	}
 
-		x = append(x, record[1])
+	ohlcY := make([]opts.KlineData, 0, table.Len())
+	volLineY := make([]opts.LineData, 0, table.Len())
+	volBarY := make([]opts.BarData, 0, table.Len())
+	for i := 0; i < table.Len(); i++ {
 		ohlcY = append(ohlcY, opts.KlineData{
-			// [open, close, lowest, highest]
-			Value: [4]float64{openVal, closeVal, lowVal, highVal},
+			Value: candle(columns["OPEN"][i], columns["CLOSE"][i], columns["LOW"][i], columns["HIGH"][i]),
 		})
-		volLineY = append(volLineY, opts.LineData{Value: volumeVal, YAxisIndex: 1})
-		volBarY = append(volBarY, opts.BarData{Value: volumeVal})
+		volLineY = append(volLineY, opts.LineData{Value: value(columns["VOLUME"][i]), YAxisIndex: 1})
+		volBarY = append(volBarY, opts.BarData{Value: value(columns["VOLUME"][i])})
 	}
 
-	return x, ohlcY, volLineY, volBarY
+	return x, ohlcY, volLineY, volBarY, nil
 }
 
-func loadCsv(filePath string) ([][]string, error) {
-	file, openErr := os.Open(filePath)
-	if openErr != nil {
-		return nil, openErr
+// candle returns the value of a candle, [open, close, lowest, highest], or the echarts placeholder "-"
+// when a price is missing
+func candle(open, close, low, high float64) interface{} {
+	for _, v := range []float64{open, close, low, high} {
+		if math.IsNaN(v) {
+			return "-"
+		}
 	}
-	defer file.Close()
+	return [4]float64{open, close, low, high}
+}
 
-	reader := csv.NewReader(file)
-	reader.LazyQuotes = true
-	records, readErr := reader.ReadAll()
-	if readErr != nil {
-		return nil, readErr
+// value returns the value of a data point, or the echarts placeholder "-" for the NaN of an empty cell,
+// NaN not being valid JSON
+func value(v float64) interface{} {
+	if math.IsNaN(v) {
+		return "-"
 	}
-	return records, nil
+	return v
 }
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charttest"
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/stretchr/testify/require"
+)
+
+const emptyCellCSV = "CLOSED_AT,OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME,COMPONENT,BUCKET\n" +
+	"2022-01-01T00:00:59.999Z,2022-01-01T00:00:00Z,10,13,9,12,5,,2022\n" +
+	"2022-01-01T00:01:59.999Z,2022-01-01T00:01:00Z,12,12,10,,,,2022\n"
+
+func TestEmptyCell(t *testing.T) {
+	table, err := csvview.Read(strings.NewReader(emptyCellCSV))
+	require.NoError(t, err)
+	xAxe, ohlcYAxe, volLineYAxe, volBarYAxe, err := prepareOhlcvData(table)
+	require.NoError(t, err)
+	require.Equal(t, [4]float64{10, 12, 9, 13}, ohlcYAxe[0].Value)
+	require.Equal(t, "-", ohlcYAxe[1].Value)
+	require.Equal(t, "-", volLineYAxe[1].Value)
+	require.Equal(t, "-", volBarYAxe[1].Value)
+
+	_, err = charttest.JSON(plotOverlapChart(xAxe, ohlcYAxe, plotVolumeBarChart(xAxe, volBarYAxe)))
+	require.NoError(t, err)
+	_, err = charttest.JSON(plotVolumeLineChart(xAxe, volLineYAxe))
+	require.NoError(t, err)
+}
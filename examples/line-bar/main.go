@@ -1,31 +1,32 @@
 package main
 
 import (
-	"encoding/csv"
 	"io"
 	"log"
+	"math"
 	"os"
-	"strconv"
-	"strings"
 
-	"github.com/go-echarts/go-echarts/v2/charts"
-	"github.com/go-echarts/go-echarts/v2/components"
-	"github.com/go-echarts/go-echarts/v2/opts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/components"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/bygui86/go-csv-view/downsample"
 )
 
 const (
 	csvFilePath  = "trades.csv"
 	htmlFilePath = "trades.html"
+
+	// maxPoints is the number of trades kept in the charts
+	maxPoints = 1000
 )
 
 func main() {
-	records, loadErr := loadCsv(csvFilePath)
-	if loadErr != nil {
-		log.Fatal(loadErr)
+	xAxe, lineYAxe, barYAxe, prepareErr := prepareData(csvFilePath)
+	if prepareErr != nil {
+		log.Fatal(prepareErr)
 	}
 
-	xAxe, lineYAxe, barYAxe := prepareData(records)
-
 	line := plotLine(xAxe, lineYAxe)
 	bar := plotBar(xAxe, barYAxe, nil)
 	lineBar := plotBar(xAxe, barYAxe, line)
@@ -37,7 +38,8 @@ func main() {
 }
 
 func createHtml(filePath string, charts ...components.Charter) error {
-	page := components.NewPage()
+	// the chart IDs are derived from the titles, regenerating the unchanged charts gives the same HTML file
+	page := components.NewPage().SetChartIDStrategy(opts.HashChartID)
 	page.AddCharts(charts...)
 
 	file, createErr := os.Create(filePath)
@@ -154,44 +156,52 @@ func plotLine(xAxe []string, yAxe []opts.LineData) *charts.Line {
 	return line
 }
 
-func prepareData(records [][]string) ([]string, []opts.LineData, []opts.BarData) {
-	start := 0
-	if strings.Contains(records[0][0], "TIMESTAMP") {
-		start = 1
-	}
-	x := make([]string, 0)
-	lineY := make([]opts.LineData, 0)
-	barY := make([]opts.BarData, 0)
-	for _, record := range records[start:] {
-		// TIMESTAMP,TRADE_ID,PRICE,SIDE,SIZE,BUYER_ORDER_ID,SELLER_ORDER_ID,COMPONENT,BUCKET
-		priceVal, err := strconv.ParseFloat(record[2], 64)
+// prepareData streams the trades and downsamples them while they are read, keeping the lowest and the
+// highest price of buckets of consecutive trades: memory is bounded by maxPoints, not by the file size.
+func prepareData(filePath string) ([]string, []opts.LineData, []opts.BarData, error) {
+	// TIMESTAMP,TRADE_ID,PRICE,SIDE,SIZE,BUYER_ORDER_ID,SELLER_ORDER_ID,COMPONENT,BUCKET
+	stream := downsample.NewStream(maxPoints)
+
+	err := csvview.Each(filePath, func(row *csvview.Row) error {
+		timestamp, err := row.String("TIMESTAMP")
 		if err != nil {
-			continue
+			return err
 		}
-		sizeVal, err := strconv.ParseFloat(record[4], 64)
+		price, err := row.Float("PRICE")
 		if err != nil {
-			continue
+			return err
+		}
+		size, err := row.Float("SIZE")
+		if err != nil {
+			return err
 		}
-		x = append(x, record[0])
-		lineY = append(lineY, opts.LineData{Value: priceVal, YAxisIndex: 1})
-		barY = append(barY, opts.BarData{Value: sizeVal})
-	}
 
-	return x, lineY, barY
-}
+		// the points are picked on the price line, bars share its X axis so they keep the same trades
+		stream.Add(timestamp, price, size)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
-func loadCsv(filePath string) ([][]string, error) {
-	file, openErr := os.Open(filePath)
-	if openErr != nil {
-		return nil, openErr
+	points := stream.Points()
+	x := make([]string, 0, len(points))
+	lineY := make([]opts.LineData, 0, len(points))
+	barY := make([]opts.BarData, 0, len(points))
+	for _, p := range points {
+		x = append(x, p.Label)
+		lineY = append(lineY, opts.LineData{Value: p.Values[0], YAxisIndex: 1})
+		barY = append(barY, opts.BarData{Value: value(p.Values[1])})
 	}
-	defer file.Close()
 
-	reader := csv.NewReader(file)
-	reader.LazyQuotes = true
-	records, readErr := reader.ReadAll()
-	if readErr != nil {
-		return nil, readErr
+	return x, lineY, barY, nil
+}
+
+// value returns the value of a data point, or the echarts placeholder "-" for the NaN of an empty cell,
+// NaN not being valid JSON
+func value(v float64) interface{} {
+	if math.IsNaN(v) {
+		return "-"
 	}
-	return records, nil
+	return v
 }
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charttest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharts(t *testing.T) {
+	xAxe, lineYAxe, barYAxe, err := prepareData(charttest.HeadCSV(t, csvFilePath, 50))
+	require.NoError(t, err)
+
+	charttest.AssertGolden(t, "line", plotLine(xAxe, lineYAxe))
+	charttest.AssertGolden(t, "bar", plotBar(xAxe, barYAxe, nil))
+	charttest.AssertGolden(t, "line-bar", plotBar(xAxe, barYAxe, plotLine(xAxe, lineYAxe)))
+}
@@ -1,16 +1,15 @@
 package main
 
 import (
-	"encoding/csv"
 	"io"
 	"log"
+	"math"
 	"os"
-	"strconv"
-	"strings"
 
-	"github.com/go-echarts/go-echarts/v2/charts"
-	"github.com/go-echarts/go-echarts/v2/components"
-	"github.com/go-echarts/go-echarts/v2/opts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/components"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/csvview"
 )
 
 const (
@@ -24,12 +23,15 @@ const (
 )
 
 func main() {
-	records, loadErr := loadCsv(csvFilePath)
+	table, loadErr := csvview.Load(csvFilePath)
 	if loadErr != nil {
 		log.Fatal(loadErr)
 	}
 
-	xAxe, yAxe := prepareLineData(records)
+	xAxe, yAxe, prepareErr := prepareLineData(table)
+	if prepareErr != nil {
+		log.Fatal(prepareErr)
+	}
 
 	lineChartA := plotLineA(xAxe, yAxe)
 	lineChartB := plotLineB(xAxe, yAxe)
@@ -41,7 +43,8 @@ func main() {
 }
 
 func createHtml(filePath string, charts ...components.Charter) error {
-	page := components.NewPage()
+	// the chart IDs are derived from the titles, regenerating the unchanged charts gives the same HTML file
+	page := components.NewPage().SetChartIDStrategy(opts.HashChartID)
 	page.AddCharts(charts...)
 
 	file, createErr := os.Create(filePath)
@@ -182,53 +185,32 @@ func plotLineB(xAxe []string, yAxe map[string][]opts.LineData) *charts.Line {
 	return line
 }
 
-func prepareLineData(records [][]string) ([]string, map[string][]opts.LineData) {
-	start := 0
-	if strings.Contains(records[0][0], "CLOSED_AT") {
-		start = 1
+func prepareLineData(table *csvview.Table) ([]string, map[string][]opts.LineData, error) {
+	// CLOSED_AT,OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME,COMPONENT,BUCKET
+	x, err := table.Strings("OPENED_AT")
+	if err != nil {
+		return nil, nil, err
 	}
-	x := make([]string, 0)
-	y := make(map[string][]opts.LineData, 0)
-	for _, record := range records[start:] {
-		// CLOSED_AT,OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME,COMPONENT,BUCKET
-		openVal, err := strconv.ParseFloat(record[2], 64)
-		if err != nil {
-			continue
-		}
-		closeVal, err := strconv.ParseFloat(record[5], 64)
-		if err != nil {
-			continue
-		}
-		lowVal, err := strconv.ParseFloat(record[4], 64)
+	y := make(map[string][]opts.LineData, 4)
+	for label, column := range map[string]string{openLabel: "OPEN", closeLabel: "CLOSE", lowLabel: "LOW", highLabel: "HIGH"} {
+		values, err := table.Floats(column)
 		if err != nil {
-			continue
+			return nil, nil, err
 		}
-		highVal, err := strconv.ParseFloat(record[3], 64)
-		if err != nil {
-			continue
+		y[label] = make([]opts.LineData, 0, len(values))
+		for _, v := range values {
+			y[label] = append(y[label], opts.LineData{Value: value(v)})
 		}
-		x = append(x, record[1])
-		y[openLabel] = append(y[openLabel], opts.LineData{Value: openVal})
-		y[closeLabel] = append(y[closeLabel], opts.LineData{Value: closeVal})
-		y[lowLabel] = append(y[lowLabel], opts.LineData{Value: lowVal})
-		y[highLabel] = append(y[highLabel], opts.LineData{Value: highVal})
 	}
 
-	return x, y
+	return x, y, nil
 }
 
-func loadCsv(filePath string) ([][]string, error) {
-	file, openErr := os.Open(filePath)
-	if openErr != nil {
-		return nil, openErr
-	}
-	defer file.Close()
-
-	reader := csv.NewReader(file)
-	reader.LazyQuotes = true
-	records, readErr := reader.ReadAll()
-	if readErr != nil {
-		return nil, readErr
+// value returns the value of a data point, or the echarts placeholder "-" for the NaN of an empty cell,
+// NaN not being valid JSON
+func value(v float64) interface{} {
+	if math.IsNaN(v) {
+		return "-"
 	}
-	return records, nil
+	return v
 }
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charttest"
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharts(t *testing.T) {
+	table, err := csvview.Load(charttest.HeadCSV(t, csvFilePath, 30))
+	require.NoError(t, err)
+	xAxe, yAxe, err := prepareLineData(table)
+	require.NoError(t, err)
+
+	charttest.AssertGolden(t, "line-a", plotLineA(xAxe, yAxe))
+	charttest.AssertGolden(t, "line-b", plotLineB(xAxe, yAxe))
+}
+
+const emptyCellCSV = "CLOSED_AT,OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME,COMPONENT,BUCKET\n" +
+	"2022-01-01T00:00:59.999Z,2022-01-01T00:00:00Z,10,13,9,12,5,,2022\n" +
+	"2022-01-01T00:01:59.999Z,2022-01-01T00:01:00Z,12,12,10,,,,2022\n"
+
+func TestEmptyCell(t *testing.T) {
+	table, err := csvview.Read(strings.NewReader(emptyCellCSV))
+	require.NoError(t, err)
+	xAxe, yAxe, err := prepareLineData(table)
+	require.NoError(t, err)
+	require.Equal(t, "-", yAxe[closeLabel][1].Value)
+	require.Equal(t, 12.0, yAxe[closeLabel][0].Value)
+
+	_, err = charttest.JSON(plotLineA(xAxe, yAxe))
+	require.NoError(t, err)
+}
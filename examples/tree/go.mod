@@ -2,4 +2,16 @@ module github.com/bygui86/go-csv-view/examples/tree
 
 go 1.17
 
-require github.com/go-echarts/go-echarts/v2 v2.2.4
+require (
+	github.com/bygui86/go-csv-view/alaingilbert-go-echarts v0.0.0
+	github.com/go-echarts/go-echarts/v2 v2.2.4
+	github.com/stretchr/testify v1.7.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
+
+replace github.com/bygui86/go-csv-view/alaingilbert-go-echarts => ../../alaingilbert-go-echarts
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charttest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree(t *testing.T) {
+	records, err := loadTxt("go-mod-graph_short.txt")
+	require.NoError(t, err)
+	mapRecords, root := prepareMapData(records)
+
+	charttest.AssertGolden(t, "tree", plotTree(buildTreeResursive(mapRecords, root)))
+}
@@ -1,16 +1,17 @@
 package main
 
 import (
-	"encoding/csv"
 	"io"
 	"log"
+	"math"
 	"os"
-	"strconv"
-	"strings"
+	"time"
 
-	"github.com/go-echarts/go-echarts/v2/charts"
-	"github.com/go-echarts/go-echarts/v2/components"
-	"github.com/go-echarts/go-echarts/v2/opts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/components"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/bygui86/go-csv-view/resample"
 )
 
 const (
@@ -22,23 +23,30 @@ const (
 	closeLabel = "close"
 	lowLabel   = "low"
 	highLabel  = "high"
-	sizeLabel  = "size"
+	sizeLabel  = "trades size"
 )
 
 func main() {
-	ohlcvRecords, ohlcvErr := loadCsv(ohlcvFilePath)
+	ohlcvTable, ohlcvErr := csvview.Load(ohlcvFilePath)
 	if ohlcvErr != nil {
 		log.Fatal(ohlcvErr)
 	}
 
-	ohlcXaxe, ohlcYaxe := prepareOhlcData(ohlcvRecords)
+	ohlcXaxe, ohlcYaxe, ohlcErr := prepareOhlcData(ohlcvTable)
+	if ohlcErr != nil {
+		log.Fatal(ohlcErr)
+	}
 
-	tradesRecords, tradesErr := loadCsv(tradesFilePath)
+	tradesTable, tradesErr := csvview.Load(tradesFilePath)
 	if tradesErr != nil {
 		log.Fatal(tradesErr)
 	}
 
-	_, tradesYaxe := prepareTradesData(tradesRecords)
+	// trades are aggregated into the ohlcv candles by timestamp, to share their X axis
+	tradesYaxe, sizeErr := prepareTradesData(tradesTable, ohlcXaxe)
+	if sizeErr != nil {
+		log.Fatal(sizeErr)
+	}
 
 	line := plotChart(ohlcXaxe, ohlcYaxe, tradesYaxe)
 
@@ -49,7 +57,8 @@ func main() {
 }
 
 func createHtml(filePath string, charts ...components.Charter) error {
-	page := components.NewPage()
+	// the chart IDs are derived from the titles, regenerating the unchanged charts gives the same HTML file
+	page := components.NewPage().SetChartIDStrategy(opts.HashChartID)
 	page.AddCharts(charts...)
 
 	file, createErr := os.Create(filePath)
@@ -97,7 +106,7 @@ func plotChart(ohlcXaxe []string, ohlcYaxe map[string][]opts.LineData, tradesYax
 	)
 
 	line.ExtendYAxis(opts.YAxis{
-		Name:  "Size",
+		Name:  "Trades size",
 		Type:  "value",
 		Show:  true,
 		Scale: true,
@@ -117,74 +126,84 @@ func plotChart(ohlcXaxe []string, ohlcYaxe map[string][]opts.LineData, tradesYax
 	return line
 }
 
-func prepareTradesData(records [][]string) ([]string, []opts.LineData) {
-	start := 0
-	if strings.Contains(records[0][0], "TIMESTAMP") {
-		start = 1
+// prepareTradesData sums the size of the trades of every candle, the candles are identified by their
+// opening time. The candles without trades have no point.
+func prepareTradesData(table *csvview.Table, candles []string) ([]opts.LineData, error) {
+	// TIMESTAMP,TRADE_ID,PRICE,SIDE,SIZE,BUYER_ORDER_ID,SELLER_ORDER_ID,COMPONENT,BUCKET
+	interval, err := candleInterval(candles)
+	if err != nil {
+		return nil, err
+	}
+	resampler, err := resample.Trades(table, resample.Options{Interval: interval})
+	if err != nil {
+		return nil, err
 	}
-	x := make([]string, 0)
-	y := make([]opts.LineData, 0)
-	for _, record := range records[start:] {
-		// TIMESTAMP,TRADE_ID,PRICE,SIDE,SIZE,BUYER_ORDER_ID,SELLER_ORDER_ID,COMPONENT,BUCKET
-		sizeVal, err := strconv.ParseFloat(record[4], 64)
+	sizes := make(map[int64]float64)
+	for _, candle := range resampler.Candles() {
+		sizes[candle.OpenedAt.Unix()] = candle.Volume
+	}
+
+	y := make([]opts.LineData, 0, len(candles))
+	for _, label := range candles {
+		openedAt, err := time.Parse(time.RFC3339, label)
 		if err != nil {
+			return nil, err
+		}
+		size, ok := sizes[openedAt.Unix()]
+		if !ok {
+			// INFO echarts reads "-" as a missing value
+			y = append(y, opts.LineData{Value: "-", YAxisIndex: 1})
 			continue
 		}
-		x = append(x, record[0])
-		y = append(y, opts.LineData{Value: sizeVal, YAxisIndex: 1})
+		y = append(y, opts.LineData{Value: size, YAxisIndex: 1})
 	}
 
-	return x, y
+	return y, nil
 }
 
-func prepareOhlcData(records [][]string) ([]string, map[string][]opts.LineData) {
-	start := 0
-	if strings.Contains(records[0][0], "CLOSED_AT") {
-		start = 1
+// candleInterval returns the interval of the candles from the opening times of the first two
+func candleInterval(candles []string) (time.Duration, error) {
+	if len(candles) < 2 {
+		return time.Minute, nil
 	}
-	x := make([]string, 0)
-	y := make(map[string][]opts.LineData, 0)
-	for _, record := range records[start:] {
-		// CLOSED_AT,OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME,COMPONENT,BUCKET
-		openVal, err := strconv.ParseFloat(record[2], 64)
-		if err != nil {
-			continue
-		}
-		closeVal, err := strconv.ParseFloat(record[5], 64)
-		if err != nil {
-			continue
-		}
-		lowVal, err := strconv.ParseFloat(record[4], 64)
+	first, err := time.Parse(time.RFC3339, candles[0])
+	if err != nil {
+		return 0, err
+	}
+	second, err := time.Parse(time.RFC3339, candles[1])
+	if err != nil {
+		return 0, err
+	}
+	return second.Sub(first), nil
+}
+
+func prepareOhlcData(table *csvview.Table) ([]string, map[string][]opts.LineData, error) {
+	// CLOSED_AT,OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME,COMPONENT,BUCKET
+	x, err := table.Strings("OPENED_AT")
+	if err != nil {
+		return nil, nil, err
+	}
+	y := make(map[string][]opts.LineData, 4)
+	for label, column := range map[string]string{openLabel: "OPEN", closeLabel: "CLOSE", lowLabel: "LOW", highLabel: "HIGH"} {
+		values, err := table.Floats(column)
 		if err != nil {
-			continue
+			return nil, nil, err
 		}
-		highVal, err := strconv.ParseFloat(record[3], 64)
-		if err != nil {
-			continue
+		y[label] = make([]opts.LineData, 0, len(values))
+		for _, v := range values {
+			//y[label] = append(y[label], opts.LineData{Value: value(v), YAxisIndex: 0}) // YAxisIndex not required if referring to index 0
+			y[label] = append(y[label], opts.LineData{Value: value(v)})
 		}
-		x = append(x, record[1])
-		y[openLabel] = append(y[openLabel], opts.LineData{Value: openVal})
-		//y[openLabel] = append(y[openLabel], opts.LineData{Value: openVal, YAxisIndex: 0}) // YAxisIndex not required if referring to index 0
-		y[closeLabel] = append(y[closeLabel], opts.LineData{Value: closeVal})
-		y[lowLabel] = append(y[lowLabel], opts.LineData{Value: lowVal})
-		y[highLabel] = append(y[highLabel], opts.LineData{Value: highVal})
 	}
 
-	return x, y
+	return x, y, nil
 }
 
-func loadCsv(filePath string) ([][]string, error) {
-	file, openErr := os.Open(filePath)
-	if openErr != nil {
-		return nil, openErr
-	}
-	defer file.Close()
-
-	reader := csv.NewReader(file)
-	reader.LazyQuotes = true
-	records, readErr := reader.ReadAll()
-	if readErr != nil {
-		return nil, readErr
+// value returns the value of a data point, or the echarts placeholder "-" for the NaN of an empty cell,
+// NaN not being valid JSON
+func value(v float64) interface{} {
+	if math.IsNaN(v) {
+		return "-"
 	}
-	return records, nil
+	return v
 }
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charttest"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChart(t *testing.T) {
+	ohlcvTable, err := csvview.Load(charttest.HeadCSV(t, ohlcvFilePath, 30))
+	require.NoError(t, err)
+	ohlcXaxe, ohlcYaxe, err := prepareOhlcData(ohlcvTable)
+	require.NoError(t, err)
+
+	tradesTable, err := csvview.Load(charttest.HeadCSV(t, tradesFilePath, 200))
+	require.NoError(t, err)
+	tradesYaxe, err := prepareTradesData(tradesTable, ohlcXaxe)
+	require.NoError(t, err)
+	require.Len(t, tradesYaxe, len(ohlcXaxe))
+	require.NotEqual(t, "-", tradesYaxe[0].Value, "the trades start with the first candle")
+	require.Equal(t, "-", tradesYaxe[len(tradesYaxe)-1].Value)
+
+	charttest.AssertGolden(t, "two-y-axis", plotChart(ohlcXaxe, ohlcYaxe, tradesYaxe))
+}
+
+const emptyCellCSV = "CLOSED_AT,OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME,COMPONENT,BUCKET\n" +
+	"2022-01-01T00:00:59.999Z,2022-01-01T00:00:00Z,10,13,9,12,5,,2022\n" +
+	"2022-01-01T00:01:59.999Z,2022-01-01T00:01:00Z,12,12,10,,,,2022\n"
+
+func TestEmptyCell(t *testing.T) {
+	table, err := csvview.Read(strings.NewReader(emptyCellCSV))
+	require.NoError(t, err)
+	xAxe, yAxe, err := prepareOhlcData(table)
+	require.NoError(t, err)
+	require.Equal(t, "-", yAxe[closeLabel][1].Value)
+
+	_, err = charttest.JSON(plotChart(xAxe, yAxe, []opts.LineData{{Value: 3, YAxisIndex: 1}, {Value: "-", YAxisIndex: 1}}))
+	require.NoError(t, err)
+}
@@ -0,0 +1,260 @@
+package finchart
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/types"
+	"github.com/bygui86/go-csv-view/csvview"
+)
+
+// Layout of the panes, in pixels.
+const (
+	gridLeft  = "50"
+	gridRight = "10"
+
+	// bottomMargin leaves room for the slider data zoom
+	bottomMargin = 40
+	paneGap      = 10
+
+	// DefaultPaneHeight is the height of indicator panes.
+	DefaultPaneHeight = 80
+)
+
+// FinancialChart is a candlestick chart of a dataset with a main price pane and indicator panes,
+// all sharing the same X axis and data zoom.
+//
+// Panes added with AddPane are stacked below the main pane, each with its own grid and axes.
+// The volume pane added with AddVolume overlays the bottom of the main pane.
+type FinancialChart struct {
+	dataset *Dataset
+	columns KlineColumns
+	colors  Colors
+	options []charts.GlobalOpts
+
+	zoomStart float32
+	zoomEnd   float32
+
+	panes  []*Pane
+	volume *Pane
+}
+
+// Pane is an area of a FinancialChart with its own axes, holding overlay series.
+type Pane struct {
+	height  int
+	overlay bool
+	series  []charts.SingleSeries
+
+	// events are series with their own data, see FinancialChart.AddEvents
+	events []charts.SingleSeries
+}
+
+// NewFinancialChart creates a chart of the dataset with the candles in the main pane.
+func NewFinancialChart(dataset *Dataset, columns KlineColumns) *FinancialChart {
+	return &FinancialChart{
+		dataset:   dataset,
+		columns:   columns,
+		colors:    DefaultColors,
+		zoomStart: 0,
+		zoomEnd:   100,
+		panes:     []*Pane{{}},
+	}
+}
+
+// SetColors sets the colors of the candles and the volume bars.
+func (c *FinancialChart) SetColors(colors Colors) *FinancialChart {
+	c.colors = colors
+	return c
+}
+
+// SetZoom sets the initial range of the data zoom, in percent.
+func (c *FinancialChart) SetZoom(start, end float32) *FinancialChart {
+	c.zoomStart = start
+	c.zoomEnd = end
+	return c
+}
+
+// SetGlobalOptions adds options applied after the ones of the chart layout, like title or theme.
+func (c *FinancialChart) SetGlobalOptions(options ...charts.GlobalOpts) *FinancialChart {
+	c.options = append(c.options, options...)
+	return c
+}
+
+// Main returns the price pane, to add overlays like moving averages.
+func (c *FinancialChart) Main() *Pane {
+	return c.panes[0]
+}
+
+// AddPane adds a pane of the given height, in pixels, below the others.
+func (c *FinancialChart) AddPane(height int) *Pane {
+	p := &Pane{height: height}
+	c.panes = append(c.panes, p)
+	return p
+}
+
+// AddVolume adds a pane of the given height, in pixels, overlaying the bottom of the main pane
+// with the volume bars colored as the candles. The dataset must be created by NewKlineDataset.
+// Calling it again returns the existing volume pane.
+func (c *FinancialChart) AddVolume(height int) *Pane {
+	if c.volume != nil {
+		return c.volume
+	}
+	c.volume = &Pane{height: height, overlay: true}
+	c.panes = append(c.panes, c.volume)
+	return c.volume.Bar("Volume", c.columns.Volume)
+}
+
+// Line adds a line series of the given dataset column.
+func (p *Pane) Line(name, column string, options ...charts.SeriesOpts) *Pane {
+	return p.add(types.ChartLine, name, column, options)
+}
+
+// Bar adds a bar series of the given dataset column.
+func (p *Pane) Bar(name, column string, options ...charts.SeriesOpts) *Pane {
+	return p.add(types.ChartBar, name, column, options)
+}
+
+// Scatter adds a scatter series of the given dataset column, missing values are not drawn.
+func (p *Pane) Scatter(name, column string, options ...charts.SeriesOpts) *Pane {
+	return p.add(types.ChartScatter, name, column, options)
+}
+
+func (p *Pane) add(chartType, name, column string, options []charts.SeriesOpts) *Pane {
+	series := charts.SingleSeries{Name: name, Type: chartType}
+	for _, option := range options {
+		option(&series)
+	}
+	series.Encode = &opts.Encode{Y: column}
+	p.series = append(p.series, series)
+	return p
+}
+
+// Kline builds the chart. It fails when the dataset misses the volume column or SignColumn of the volume pane,
+// the bars would be drawn empty or uncolored.
+func (c *FinancialChart) Kline() (*charts.Kline, error) {
+	kline := charts.NewKLine()
+	kline.Dataset = c.dataset.Options()
+
+	xAxisIndexes := make([]int, 0, len(c.panes))
+	for i := range c.panes {
+		xAxisIndexes = append(xAxisIndexes, i)
+	}
+
+	options := []charts.GlobalOpts{
+		charts.WithLegendOpts(opts.Legend{
+			Show:         true,
+			SelectedMode: "multiple",
+		}),
+		charts.WithTooltipOpts(opts.Tooltip{
+			Show:        true,
+			Trigger:     "axis",
+			AxisPointer: &opts.AxisPointer{Type: "line"},
+		}),
+		charts.WithGridOpts(c.grids()...),
+		charts.WithDataZoomOpts(opts.DataZoom{
+			Type:       "inside",
+			Start:      c.zoomStart,
+			End:        c.zoomEnd,
+			XAxisIndex: xAxisIndexes,
+		}),
+		charts.WithDataZoomOpts(opts.DataZoom{
+			Type:       "slider",
+			Start:      c.zoomStart,
+			End:        c.zoomEnd,
+			XAxisIndex: xAxisIndexes,
+		}),
+		charts.WithXAxisOpts(opts.XAxis{
+			Type:        "category",
+			SplitNumber: 20,
+			GridIndex:   0,
+		}),
+		charts.WithYAxisOpts(opts.YAxis{
+			Scale:     true,
+			GridIndex: 0,
+		}),
+	}
+	kline.SetGlobalOptions(append(options, c.options...)...)
+
+	for i, p := range c.panes[1:] {
+		kline.ExtendXAxis(opts.XAxis{
+			Type:        "category",
+			SplitNumber: 20,
+			GridIndex:   i + 1,
+			AxisTick:    &opts.AxisTick{Show: false},
+			AxisLabel:   &opts.AxisLabel{Show: false},
+		})
+		// the axis of an overlay pane is hidden, its values would be drawn over the prices
+		kline.ExtendYAxis(opts.YAxis{
+			Scale:       true,
+			GridIndex:   i + 1,
+			SplitNumber: 2,
+			AxisLabel:   &opts.AxisLabel{Show: !p.overlay},
+			AxisLine:    &opts.AxisLine{Show: !p.overlay},
+			AxisTick:    &opts.AxisTick{Show: !p.overlay},
+			SplitLine:   &opts.SplitLine{Show: false},
+		})
+	}
+
+	kline.AddSeries("candlestick", nil,
+		charts.WithItemStyleOpts(CandleItemStyle(c.colors)),
+		charts.WithEncodeOpts(opts.Encode{
+			X: c.columns.Time,
+			Y: []string{c.columns.Open, c.columns.Close, c.columns.Low, c.columns.High},
+		}),
+	)
+
+	for i, p := range c.panes {
+		for j, series := range p.series {
+			series.XAxisIndex = i
+			series.YAxisIndex = i
+			series.Encode = &opts.Encode{X: c.columns.Time, Y: series.Encode.Y}
+			// the first series of the volume pane holds the volume bars, colored by SignColumn
+			if p == c.volume && j == 0 {
+				if _, found := c.dataset.Index(c.columns.Volume); !found {
+					return nil, fmt.Errorf("volume pane: %w %q", csvview.ErrUnknownColumn, c.columns.Volume)
+				}
+				visualMap, err := VolumeVisualMap(c.dataset, len(kline.MultiSeries), c.colors)
+				if err != nil {
+					return nil, fmt.Errorf("volume pane: %w", err)
+				}
+				kline.SetGlobalOptions(charts.WithVisualMapOpts(visualMap))
+			}
+			kline.MultiSeries = append(kline.MultiSeries, series)
+		}
+		for _, series := range p.events {
+			series.XAxisIndex = i
+			series.YAxisIndex = i
+			kline.MultiSeries = append(kline.MultiSeries, series)
+		}
+	}
+
+	return kline, nil
+}
+
+// grids returns the grid of every pane: stacked panes from the bottom up, then the main pane above them.
+// Overlay panes share the bottom of the main pane.
+func (c *FinancialChart) grids() []opts.Grid {
+	grids := make([]opts.Grid, len(c.panes))
+
+	bottom := bottomMargin
+	for i := len(c.panes) - 1; i > 0; i-- {
+		if c.panes[i].overlay {
+			continue
+		}
+		grids[i] = opts.Grid{Height: px(c.panes[i].height), Bottom: px(bottom), Left: gridLeft, Right: gridRight}
+		bottom += c.panes[i].height + paneGap
+	}
+	grids[0] = opts.Grid{Bottom: px(bottom), Left: gridLeft, Right: gridRight}
+	for i, p := range c.panes[1:] {
+		if p.overlay {
+			grids[i+1] = opts.Grid{Height: px(p.height), Bottom: px(bottom), Left: gridLeft, Right: gridRight}
+		}
+	}
+	return grids
+}
+
+func px(v int) string {
+	return strconv.Itoa(v)
+}
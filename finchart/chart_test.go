@@ -0,0 +1,130 @@
+package finchart
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/stretchr/testify/assert"
+)
+
+func testKlineDataset(t *testing.T) *Dataset {
+	table, err := csvview.Read(strings.NewReader(
+		"OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME\n" +
+			"2022-01-01T00:00:00Z,10,13,9,12,5\n" +
+			"2022-01-01T00:01:00Z,12,12,10,11,7\n"))
+	assert.NoError(t, err)
+	d, err := NewKlineDataset(table, DefaultKlineColumns, CloseOverOpen)
+	assert.NoError(t, err)
+	assert.NoError(t, d.Add("EMA", []float64{11, 11.5}))
+	return d
+}
+
+func TestFinancialChart(t *testing.T) {
+	chart := NewFinancialChart(testKlineDataset(t), DefaultKlineColumns).
+		SetZoom(30, 70).
+		SetGlobalOptions(charts.WithTitleOpts(opts.Title{Title: "BTC-USDT"}))
+	chart.Main().Line("EMA", "EMA")
+	chart.AddVolume(DefaultPaneHeight)
+	chart.AddPane(100).Line("A", "OPEN").Line("B", "CLOSE")
+	chart.AddPane(DefaultPaneHeight).Bar("C", "HIGH")
+
+	kline, err := chart.Kline()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []opts.Grid{
+		{Bottom: "240", Left: gridLeft, Right: gridRight},
+		{Height: "80", Bottom: "240", Left: gridLeft, Right: gridRight},
+		{Height: "100", Bottom: "130", Left: gridLeft, Right: gridRight},
+		{Height: "80", Bottom: "40", Left: gridLeft, Right: gridRight},
+	}, kline.GridList)
+	assert.Len(t, kline.XAxisList, 4)
+	assert.Len(t, kline.YAxisList, 4)
+	assert.False(t, kline.YAxisList[1].AxisLabel.Show)
+	assert.True(t, kline.YAxisList[2].AxisLabel.Show)
+
+	for _, dz := range kline.DataZoomList {
+		assert.Equal(t, []int{0, 1, 2, 3}, dz.XAxisIndex)
+		assert.Equal(t, float32(30), dz.Start)
+	}
+	assert.Equal(t, "BTC-USDT", kline.Title.Title)
+
+	type route struct {
+		name, chartType string
+		axis            int
+		y               interface{}
+	}
+	routes := make([]route, 0, len(kline.MultiSeries))
+	for _, s := range kline.MultiSeries {
+		assert.Equal(t, s.XAxisIndex, s.YAxisIndex)
+		routes = append(routes, route{s.Name, s.Type, s.YAxisIndex, s.Encode.Y})
+	}
+	assert.Equal(t, []route{
+		{"candlestick", "candlestick", 0, []string{"OPEN", "CLOSE", "LOW", "HIGH"}},
+		{"EMA", "line", 0, "EMA"},
+		{"Volume", "bar", 1, "VOLUME"},
+		{"A", "line", 2, "OPEN"},
+		{"B", "line", 2, "CLOSE"},
+		{"C", "bar", 3, "HIGH"},
+	}, routes)
+
+	assert.Len(t, kline.VisualMapList, 1)
+	assert.Equal(t, 2, kline.VisualMapList[0].SeriesIndex)
+	assert.Equal(t, 6, kline.VisualMapList[0].Dimension)
+
+	_, err = json.Marshal(kline.JSON())
+	assert.NoError(t, err)
+	assert.NoError(t, kline.ValidateDataset())
+}
+
+func TestFinancialChartUnknownColumn(t *testing.T) {
+	chart := NewFinancialChart(testKlineDataset(t), DefaultKlineColumns)
+	chart.Main().Line("EMA", "EMA10")
+
+	kline, err := chart.Kline()
+	assert.NoError(t, err)
+	err = kline.ValidateDataset()
+	encodeErr := &charts.EncodeError{}
+	if assert.ErrorAs(t, err, &encodeErr) {
+		assert.Equal(t, "EMA", encodeErr.Series[0].Name)
+	}
+}
+
+func TestFinancialChartMainOnly(t *testing.T) {
+	kline, err := NewFinancialChart(testKlineDataset(t), DefaultKlineColumns).Kline()
+	assert.NoError(t, err)
+	assert.Equal(t, []opts.Grid{{Bottom: "40", Left: gridLeft, Right: gridRight}}, kline.GridList)
+	assert.Len(t, kline.MultiSeries, 1)
+	assert.Empty(t, kline.VisualMapList)
+}
+
+func TestFinancialChartVolumeWithoutSign(t *testing.T) {
+	table, err := csvview.Read(strings.NewReader(
+		"OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME\n" +
+			"2022-01-01T00:00:00Z,10,13,9,12,5\n"))
+	assert.NoError(t, err)
+	d, err := NewDataset(table, "OPENED_AT", "OPEN", "CLOSE", "LOW", "HIGH", "VOLUME")
+	assert.NoError(t, err)
+
+	chart := NewFinancialChart(d, DefaultKlineColumns)
+	chart.AddVolume(DefaultPaneHeight)
+	_, err = chart.Kline()
+	assert.ErrorIs(t, err, csvview.ErrUnknownColumn)
+	assert.EqualError(t, err, `volume pane: unknown column "SIGN", see NewKlineDataset`)
+
+	// without volume pane the dataset needs no sign
+	_, err = NewFinancialChart(d, DefaultKlineColumns).Kline()
+	assert.NoError(t, err)
+}
+
+func TestFinancialChartVolumeUnknownColumn(t *testing.T) {
+	chart := NewFinancialChart(testKlineDataset(t), KlineColumns{
+		Time: "OPENED_AT", Open: "OPEN", High: "HIGH", Low: "LOW", Close: "CLOSE", Volume: "QUANTITY",
+	})
+	chart.AddVolume(DefaultPaneHeight)
+	_, err := chart.Kline()
+	assert.EqualError(t, err, `volume pane: unknown column "QUANTITY"`)
+}
@@ -0,0 +1,147 @@
+// Package finchart builds financial charts, like candles with volume and indicators,
+// on the go-echarts fork from OHLCV tables.
+package finchart
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/csvview"
+)
+
+// emptyValue is the echarts placeholder for a missing data point, NaN is not valid JSON.
+const emptyValue = "-"
+
+// Dataset is an echarts dataset whose columns are addressed by name, so series can be
+// encoded by column name and computed columns, like indicators, can be appended to it.
+type Dataset struct {
+	names   []string
+	index   map[string]int
+	columns [][]interface{}
+	rows    int
+}
+
+// NewDataset creates a dataset from the given columns of the table, in the given order.
+func NewDataset(table *csvview.Table, columns ...string) (*Dataset, error) {
+	d := &Dataset{index: make(map[string]int, len(columns)), rows: table.Len()}
+	for _, name := range columns {
+		values, err := tableValues(table, name)
+		if err != nil {
+			return nil, err
+		}
+		if addErr := d.add(name, values); addErr != nil {
+			return nil, addErr
+		}
+	}
+	return d, nil
+}
+
+// Add appends a computed column, NaN values are missing points.
+func (d *Dataset) Add(name string, values []float64) error {
+	column := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		column = append(column, value(v))
+	}
+	return d.add(name, column)
+}
+
+func (d *Dataset) add(name string, column []interface{}) error {
+	if _, found := d.index[name]; found {
+		return fmt.Errorf("duplicated dataset column %q", name)
+	}
+	if len(column) != d.rows {
+		return fmt.Errorf("dataset column %q has %d rows, expected %d", name, len(column), d.rows)
+	}
+	d.index[name] = len(d.names)
+	d.names = append(d.names, name)
+	d.columns = append(d.columns, column)
+	return nil
+}
+
+// Len returns the number of rows, header excluded.
+func (d *Dataset) Len() int {
+	return d.rows
+}
+
+// Names returns the column names in order.
+func (d *Dataset) Names() []string {
+	return d.names
+}
+
+// Index returns the position of the given column, which is its echarts dimension index.
+func (d *Dataset) Index(name string) (int, bool) {
+	i, found := d.index[name]
+	return i, found
+}
+
+// column returns the values of the given column.
+func (d *Dataset) column(name string) ([]interface{}, error) {
+	i, found := d.index[name]
+	if !found {
+		return nil, fmt.Errorf("%w %q", csvview.ErrUnknownColumn, name)
+	}
+	return d.columns[i], nil
+}
+
+// Source returns the echarts dataset source: a header row with the column names, then the data rows.
+func (d *Dataset) Source() [][]interface{} {
+	source := make([][]interface{}, 0, d.rows+1)
+
+	header := make([]interface{}, 0, len(d.names))
+	for _, name := range d.names {
+		header = append(header, name)
+	}
+	source = append(source, header)
+
+	for r := 0; r < d.rows; r++ {
+		row := make([]interface{}, 0, len(d.columns))
+		for _, column := range d.columns {
+			row = append(row, column[r])
+		}
+		source = append(source, row)
+	}
+	return source
+}
+
+// Options returns the dataset as chart option.
+func (d *Dataset) Options() opts.Dataset {
+	return opts.Dataset{Source: d.Source()}
+}
+
+// tableValues returns the values of a table column: numbers for int and float columns,
+// the raw values otherwise.
+func tableValues(table *csvview.Table, name string) ([]interface{}, error) {
+	columnType, typeErr := table.Type(name)
+	if typeErr != nil {
+		return nil, typeErr
+	}
+
+	values := make([]interface{}, 0, table.Len())
+	switch columnType {
+	case csvview.TypeInt, csvview.TypeFloat:
+		floats, err := table.Floats(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range floats {
+			values = append(values, value(v))
+		}
+	default:
+		raw, err := table.Strings(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range raw {
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
+
+func value(v float64) interface{} {
+	if math.IsNaN(v) {
+		return emptyValue
+	}
+	return v
+}
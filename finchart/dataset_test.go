@@ -0,0 +1,49 @@
+package finchart
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTable(t *testing.T) *csvview.Table {
+	table, err := csvview.Read(strings.NewReader(
+		"OPENED_AT,OPEN,CLOSE,TRADES\n" +
+			"2022-01-01T00:00:00Z,1.5,2,3\n" +
+			"2022-01-01T00:01:00Z,2,,4\n"))
+	assert.NoError(t, err)
+	return table
+}
+
+func TestDataset(t *testing.T) {
+	d, err := NewDataset(testTable(t), "OPENED_AT", "OPEN", "CLOSE", "TRADES")
+	assert.NoError(t, err)
+	assert.NoError(t, d.Add("EMA", []float64{math.NaN(), 1.75}))
+
+	assert.Equal(t, 2, d.Len())
+	assert.Equal(t, []string{"OPENED_AT", "OPEN", "CLOSE", "TRADES", "EMA"}, d.Names())
+	i, found := d.Index("EMA")
+	assert.True(t, found)
+	assert.Equal(t, 4, i)
+	_, found = d.Index("MISSING")
+	assert.False(t, found)
+
+	assert.Equal(t, [][]interface{}{
+		{"OPENED_AT", "OPEN", "CLOSE", "TRADES", "EMA"},
+		{"2022-01-01T00:00:00Z", 1.5, 2.0, 3.0, "-"},
+		{"2022-01-01T00:01:00Z", 2.0, "-", 4.0, 1.75},
+	}, d.Options().Source)
+}
+
+func TestDatasetErrors(t *testing.T) {
+	_, err := NewDataset(testTable(t), "MISSING")
+	assert.ErrorIs(t, err, csvview.ErrUnknownColumn)
+
+	d, err := NewDataset(testTable(t), "OPEN")
+	assert.NoError(t, err)
+	assert.Error(t, d.Add("OPEN", []float64{1, 2}))
+	assert.Error(t, d.Add("SHORT", []float64{1}))
+}
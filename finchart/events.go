@@ -0,0 +1,197 @@
+package finchart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/types"
+	"github.com/bygui86/go-csv-view/csvview"
+)
+
+// Event sides.
+const (
+	Buy  = "BUY"
+	Sell = "SELL"
+)
+
+// Event is a trade or order of a strategy, drawn as a marker over the candles.
+type Event struct {
+	Time        time.Time
+	Side        string
+	Price       float64
+	Label       string
+	Description string
+}
+
+// EventColumns names the columns of an events CSV, Label and Description are optional.
+type EventColumns struct {
+	Time        string
+	Side        string
+	Price       string
+	Label       string
+	Description string
+}
+
+// DefaultEventColumns are the columns of an events CSV.
+var DefaultEventColumns = EventColumns{
+	Time:        "TIMESTAMP",
+	Side:        "SIDE",
+	Price:       "PRICE",
+	Label:       "LABEL",
+	Description: "DESCRIPTION",
+}
+
+// LoadEvents reads the events CSV file at the given path.
+// Sides are BUY or SELL, BID and ASK are accepted as synonyms, case is ignored.
+// An empty price places the marker at the close of its candle.
+func LoadEvents(filePath string, columns EventColumns) ([]Event, error) {
+	events := make([]Event, 0)
+	err := csvview.Each(filePath, func(row *csvview.Row) error {
+		e, parseErr := parseEvent(row, columns)
+		if parseErr != nil {
+			return parseErr
+		}
+		events = append(events, e)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("events %s loading failed: %w", filePath, err)
+	}
+	return events, nil
+}
+
+func parseEvent(row *csvview.Row, columns EventColumns) (Event, error) {
+	e := Event{}
+
+	var err error
+	if e.Time, err = row.Time(columns.Time); err != nil {
+		return e, err
+	}
+	if e.Price, err = row.Float(columns.Price); err != nil {
+		return e, err
+	}
+
+	side, err := row.String(columns.Side)
+	if err != nil {
+		return e, err
+	}
+	switch strings.ToUpper(strings.TrimSpace(side)) {
+	case Buy, "BID":
+		e.Side = Buy
+	case Sell, "ASK":
+		e.Side = Sell
+	default:
+		return e, fmt.Errorf("unknown event side %q", side)
+	}
+
+	// optional columns
+	e.Label, _ = row.String(columns.Label)
+	e.Description, _ = row.String(columns.Description)
+	return e, nil
+}
+
+// Align returns the index of the candle of every event, given the sorted open times of the candles: the
+// candle i holds the events from its open time times[i] included to the open time of the next candle
+// excluded. The last candle lasts as long as the one before it, a single candle holds only the events
+// at its open time. Events before the first candle or after the last one are out of the chart, their
+// index is -1.
+func Align(events []Event, times []time.Time) []int {
+	indexes := make([]int, len(events))
+	if len(times) == 0 {
+		for i := range indexes {
+			indexes[i] = -1
+		}
+		return indexes
+	}
+
+	end := times[len(times)-1]
+	if len(times) > 1 {
+		end = end.Add(end.Sub(times[len(times)-2]))
+	}
+
+	for i, e := range events {
+		if e.Time.Before(times[0]) || (!e.Time.Before(end) && !e.Time.Equal(times[len(times)-1])) {
+			indexes[i] = -1
+			continue
+		}
+		// the candle of the event is the one before the first candle opened after the event
+		next := sort.Search(len(times), func(j int) bool { return times[j].After(e.Time) })
+		indexes[i] = next - 1
+	}
+	return indexes
+}
+
+// AddEvents draws the events over the candles of the main pane, as Buy and Sell markers labeled with
+// the event label, on the candle holding them, see Align. The description is part of the marker value shown by tooltips.
+func (c *FinancialChart) AddEvents(events []Event) error {
+	labels, labelsErr := c.dataset.column(c.columns.Time)
+	if labelsErr != nil {
+		return labelsErr
+	}
+	closePrices, closeErr := c.dataset.column(c.columns.Close)
+	if closeErr != nil {
+		return closeErr
+	}
+	times, timesErr := parseTimes(labels)
+	if timesErr != nil {
+		return timesErr
+	}
+
+	markers := map[string][]opts.ScatterData{Buy: {}, Sell: {}}
+	for i, candle := range Align(events, times) {
+		if candle < 0 {
+			continue
+		}
+		e := events[i]
+
+		var price interface{} = e.Price
+		if math.IsNaN(e.Price) {
+			price = closePrices[candle]
+		}
+
+		marker := opts.ScatterData{
+			Name:   e.Label,
+			Value:  []interface{}{labels[candle], price, e.Description},
+			Symbol: "triangle",
+		}
+		if e.Side == Sell {
+			marker.SymbolRotate = 180
+		}
+		markers[e.Side] = append(markers[e.Side], marker)
+	}
+
+	main := c.Main()
+	for _, side := range []string{Buy, Sell} {
+		name, color, position := "Buy", c.colors.Up, "bottom"
+		if side == Sell {
+			name, color, position = "Sell", c.colors.Down, "top"
+		}
+		main.events = append(main.events, charts.SingleSeries{
+			Name:       name,
+			Type:       types.ChartScatter,
+			SymbolSize: 12,
+			Data:       markers[side],
+			ItemStyle:  &opts.ItemStyle{Color: color},
+			Label:      &opts.Label{Show: true, Position: position, Formatter: "{b}"},
+		})
+	}
+	return nil
+}
+
+// parseTimes parses the values of a dataset time column.
+func parseTimes(labels []interface{}) ([]time.Time, error) {
+	times := make([]time.Time, 0, len(labels))
+	for _, label := range labels {
+		t, err := time.Parse(time.RFC3339, fmt.Sprint(label))
+		if err != nil {
+			return nil, fmt.Errorf("%w: time column value %v is not %s", csvview.ErrColumnType, label, csvview.TypeTime)
+		}
+		times = append(times, t)
+	}
+	return times, nil
+}
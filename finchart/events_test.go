@@ -0,0 +1,94 @@
+package finchart
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadEvents(t *testing.T) {
+	events, err := LoadEvents("testdata/events.csv", DefaultEventColumns)
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	assert.Equal(t, Event{
+		Time:        time.Date(2022, 1, 1, 0, 0, 20, 0, time.UTC),
+		Side:        Buy,
+		Price:       10.5,
+		Label:       "entry",
+		Description: "EMA crossed over",
+	}, events[0])
+	assert.Equal(t, Sell, events[1].Side)
+	assert.True(t, math.IsNaN(events[1].Price))
+	assert.Empty(t, events[1].Description)
+}
+
+func TestLoadEventsUnknownSide(t *testing.T) {
+	_, err := LoadEvents("testdata/events.csv", EventColumns{Time: "TIMESTAMP", Side: "LABEL", Price: "PRICE"})
+	assert.Error(t, err)
+}
+
+func TestAlign(t *testing.T) {
+	base := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	times := []time.Time{base, base.Add(time.Minute), base.Add(2 * time.Minute)}
+	at := func(d time.Duration) Event { return Event{Time: base.Add(d)} }
+
+	events := []Event{
+		at(-30 * time.Second),
+		at(0),
+		at(20 * time.Second),
+		at(48 * time.Second), // 80% of the first candle
+		at(time.Minute),
+		at(150 * time.Second),
+		at(3*time.Minute - time.Nanosecond),
+		at(3 * time.Minute),
+		at(-2 * time.Minute),
+	}
+	assert.Equal(t, []int{-1, 0, 0, 0, 1, 2, 2, -1, -1}, Align(events, times))
+	assert.Equal(t, []int{-1}, Align(events[:1], nil))
+	assert.Equal(t, []int{-1, 0, -1}, Align(events[:3], times[:1]))
+}
+
+func TestAddEvents(t *testing.T) {
+	events, err := LoadEvents("testdata/events.csv", DefaultEventColumns)
+	assert.NoError(t, err)
+
+	chart := NewFinancialChart(testKlineDataset(t), DefaultKlineColumns)
+	assert.NoError(t, chart.AddEvents(events))
+	chart.AddVolume(DefaultPaneHeight)
+	kline, err := chart.Kline()
+	assert.NoError(t, err)
+
+	assert.Len(t, kline.MultiSeries, 4)
+	buy, sell := kline.MultiSeries[1], kline.MultiSeries[2]
+	assert.Equal(t, "Buy", buy.Name)
+	assert.Equal(t, []opts.ScatterData{{
+		Name:   "entry",
+		Value:  []interface{}{"2022-01-01T00:00:00Z", 10.5, "EMA crossed over"},
+		Symbol: "triangle",
+	}}, buy.Data)
+	assert.Equal(t, DefaultColors.Up, buy.ItemStyle.Color)
+
+	// the price defaults to the close of the candle
+	assert.Equal(t, "Sell", sell.Name)
+	assert.Equal(t, []opts.ScatterData{{
+		Name:         "exit",
+		Value:        []interface{}{"2022-01-01T00:01:00Z", 11.0, ""},
+		Symbol:       "triangle",
+		SymbolRotate: 180,
+	}}, sell.Data)
+
+	assert.Equal(t, 3, kline.VisualMapList[0].SeriesIndex)
+
+	_, err = json.Marshal(kline.JSON())
+	assert.NoError(t, err)
+}
+
+func TestAddEventsNoTimeColumn(t *testing.T) {
+	chart := NewFinancialChart(testKlineDataset(t), KlineColumns{Time: "MISSING", Close: "CLOSE"})
+	assert.Error(t, chart.AddEvents(nil))
+}
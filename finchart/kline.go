@@ -0,0 +1,149 @@
+package finchart
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/csvview"
+)
+
+// SignColumn is the name of the dataset column holding the direction of every candle: 1 up, -1 down.
+const SignColumn = "SIGN"
+
+// Direction tells how a candle is classified as up or down.
+type Direction int
+
+const (
+	// CloseOverOpen: a candle is up when it closes above its open,
+	// a candle closing at its open is compared with the previous close.
+	CloseOverOpen Direction = iota
+	// CloseOverPreviousClose: a candle is up when it closes at or above the previous close,
+	// the first candle is compared with its open.
+	CloseOverPreviousClose
+)
+
+// KlineColumns names the OHLCV columns of a table.
+type KlineColumns struct {
+	Time   string
+	Open   string
+	High   string
+	Low    string
+	Close  string
+	Volume string
+}
+
+// DefaultKlineColumns are the columns of an ohlcv CSV.
+var DefaultKlineColumns = KlineColumns{
+	Time:   "OPENED_AT",
+	Open:   "OPEN",
+	High:   "HIGH",
+	Low:    "LOW",
+	Close:  "CLOSE",
+	Volume: "VOLUME",
+}
+
+// Colors of up and down candles and volume bars.
+type Colors struct {
+	Up         string
+	Down       string
+	UpBorder   string
+	DownBorder string
+	VolumeUp   string
+	VolumeDown string
+}
+
+// DefaultColors are green up and red down candles.
+var DefaultColors = Colors{
+	Up:         "#00da3c",
+	Down:       "#ec0000",
+	UpBorder:   "#008F28",
+	DownBorder: "#8A0000",
+	VolumeUp:   "rgba(0, 218, 60, 0.7)",
+	VolumeDown: "rgba(236, 0, 0, 0.7)",
+}
+
+// NewKlineDataset creates a dataset with the time, open, close, low, high and volume columns of the table,
+// in echarts candlestick order, followed by the SignColumn computed with the given direction.
+func NewKlineDataset(table *csvview.Table, columns KlineColumns, direction Direction) (*Dataset, error) {
+	d, datasetErr := NewDataset(table, columns.Time, columns.Open, columns.Close, columns.Low, columns.High, columns.Volume)
+	if datasetErr != nil {
+		return nil, datasetErr
+	}
+
+	open, openErr := table.Floats(columns.Open)
+	if openErr != nil {
+		return nil, openErr
+	}
+	closePrices, closeErr := table.Floats(columns.Close)
+	if closeErr != nil {
+		return nil, closeErr
+	}
+
+	if addErr := d.Add(SignColumn, Signs(open, closePrices, direction)); addErr != nil {
+		return nil, addErr
+	}
+	return d, nil
+}
+
+// Signs returns the direction of every candle: 1 up, -1 down, NaN when open or close are missing.
+func Signs(open, close []float64, direction Direction) []float64 {
+	signs := make([]float64, len(close))
+	for i := range close {
+		if math.IsNaN(open[i]) || math.IsNaN(close[i]) {
+			signs[i] = math.NaN()
+			continue
+		}
+
+		prevClose := math.NaN()
+		if i > 0 {
+			prevClose = close[i-1]
+		}
+
+		switch {
+		case direction == CloseOverPreviousClose && !math.IsNaN(prevClose):
+			signs[i] = sign(close[i] >= prevClose)
+		case close[i] != open[i] || math.IsNaN(prevClose):
+			signs[i] = sign(close[i] >= open[i])
+		default:
+			signs[i] = sign(close[i] >= prevClose)
+		}
+	}
+	return signs
+}
+
+func sign(up bool) float64 {
+	if up {
+		return 1
+	}
+	return -1
+}
+
+// CandleItemStyle returns the style of candlestick series with the given colors.
+func CandleItemStyle(colors Colors) opts.ItemStyle {
+	return opts.ItemStyle{
+		Color:        colors.Up,
+		Color0:       colors.Down,
+		BorderColor:  colors.UpBorder,
+		BorderColor0: colors.DownBorder,
+	}
+}
+
+// VolumeVisualMap colors the bars of the volume series at seriesIndex as the candles,
+// using the SignColumn of a dataset created by NewKlineDataset, an error is returned without it.
+func VolumeVisualMap(d *Dataset, seriesIndex int, colors Colors) (opts.VisualMap, error) {
+	dimension, found := d.Index(SignColumn)
+	if !found {
+		return opts.VisualMap{}, fmt.Errorf("%w %q, see NewKlineDataset", csvview.ErrUnknownColumn, SignColumn)
+	}
+	return opts.VisualMap{
+		Type: "piecewise",
+		Pieces: []opts.Piece{
+			{Value: 1, Color: colors.VolumeUp},
+			{Value: -1, Color: colors.VolumeDown},
+		},
+		Dimension:   dimension,
+		SeriesIndex: seriesIndex,
+		Show:        false,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package finchart
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigns(t *testing.T) {
+	open := []float64{10, 12, 11, 11, 13, math.NaN()}
+	closePrices := []float64{12, 11, 11, 10, 12, 12}
+
+	assert.Equal(t, []float64{1, -1, 1, -1, -1}, Signs(open, closePrices, CloseOverOpen)[:5])
+	assert.Equal(t, []float64{1, -1, 1, -1, 1}, Signs(open, closePrices, CloseOverPreviousClose)[:5])
+	assert.True(t, math.IsNaN(Signs(open, closePrices, CloseOverOpen)[5]))
+
+	// flat candle compared with a previous higher close
+	assert.Equal(t, []float64{1, -1}, Signs([]float64{10, 11}, []float64{12, 11}, CloseOverOpen))
+}
+
+func TestNewKlineDataset(t *testing.T) {
+	table, err := csvview.Read(strings.NewReader(
+		"OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME\n" +
+			"2022-01-01T00:00:00Z,10,13,9,12,5\n" +
+			"2022-01-01T00:01:00Z,12,12,10,11,7\n"))
+	assert.NoError(t, err)
+
+	d, err := NewKlineDataset(table, DefaultKlineColumns, CloseOverOpen)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"OPENED_AT", "OPEN", "CLOSE", "LOW", "HIGH", "VOLUME", SignColumn}, d.Names())
+	assert.Equal(t, []interface{}{"2022-01-01T00:01:00Z", 12.0, 11.0, 10.0, 12.0, 7.0, -1.0}, d.Source()[2])
+
+	visualMap, err := VolumeVisualMap(d, 1, DefaultColors)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, visualMap.Dimension)
+	assert.Equal(t, 1, visualMap.SeriesIndex)
+	assert.Equal(t, DefaultColors.VolumeDown, visualMap.Pieces[1].Color)
+
+	withoutSign, err := NewDataset(table, "OPENED_AT", "VOLUME")
+	assert.NoError(t, err)
+	_, err = VolumeVisualMap(withoutSign, 1, DefaultColors)
+	assert.ErrorIs(t, err, csvview.ErrUnknownColumn)
+
+	_, err = NewKlineDataset(table, KlineColumns{Time: "OPENED_AT"}, CloseOverOpen)
+	assert.ErrorIs(t, err, csvview.ErrUnknownColumn)
+}
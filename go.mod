@@ -0,0 +1,17 @@
+module github.com/bygui86/go-csv-view
+
+go 1.17
+
+require (
+	github.com/bygui86/go-csv-view/alaingilbert-go-echarts v0.0.0
+	github.com/stretchr/testify v1.7.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/image v0.18.0 // indirect
+)
+
+replace github.com/bygui86/go-csv-view/alaingilbert-go-echarts => ./alaingilbert-go-echarts
@@ -0,0 +1,289 @@
+// Package indicators computes technical indicators over the columns of an OHLCV table.
+//
+// Every indicator returns series of the same length as its inputs, values which cannot be
+// computed yet, like the first period-1 values of a moving average, are NaN.
+// A NaN input only makes NaN the values computed from it: the moving windows containing it, while the
+// smoothed indicators, like EMA, start over once period values follow it. Leading NaN inputs are therefore
+// skipped, so indicators can be chained, like the signal line of MACD.
+package indicators
+
+import (
+	"math"
+
+	"github.com/bygui86/go-csv-view/csvview"
+)
+
+// OHLCV holds the columns of an OHLCV table.
+type OHLCV struct {
+	Open   []float64
+	High   []float64
+	Low    []float64
+	Close  []float64
+	Volume []float64
+}
+
+// FromTable reads the OPEN, HIGH, LOW, CLOSE and VOLUME columns of an OHLCV table.
+func FromTable(table *csvview.Table) (*OHLCV, error) {
+	o := &OHLCV{}
+	for name, column := range map[string]*[]float64{
+		"OPEN":   &o.Open,
+		"HIGH":   &o.High,
+		"LOW":    &o.Low,
+		"CLOSE":  &o.Close,
+		"VOLUME": &o.Volume,
+	} {
+		values, err := table.Floats(name)
+		if err != nil {
+			return nil, err
+		}
+		*column = values
+	}
+	return o, nil
+}
+
+// SMA is the simple moving average over period values.
+func SMA(values []float64, period int) []float64 {
+	out := nans(len(values))
+	if period <= 0 {
+		return out
+	}
+
+	// INFO the NaN values are counted instead of summed, the sum is valid again once they leave the window
+	sum, missing := 0.0, 0
+	for i, v := range values {
+		if math.IsNaN(v) {
+			missing++
+		} else {
+			sum += v
+		}
+		if i >= period {
+			if old := values[i-period]; math.IsNaN(old) {
+				missing--
+			} else {
+				sum -= old
+			}
+		}
+		if i >= period-1 && missing == 0 {
+			out[i] = sum / float64(period)
+		}
+	}
+	return out
+}
+
+// EMA is the exponential moving average over period values, seeded with the SMA of the first period values.
+func EMA(values []float64, period int) []float64 {
+	k := 2 / float64(period+1)
+	return smooth(values, period, func(prev, value float64) float64 {
+		return value*k + prev*(1-k)
+	})
+}
+
+// WMA is the linearly weighted moving average over period values, the latest value has weight period.
+func WMA(values []float64, period int) []float64 {
+	out := nans(len(values))
+	start := firstValid(values)
+	if period <= 0 || start+period > len(values) {
+		return out
+	}
+
+	weights := float64(period*(period+1)) / 2
+	for i := start + period - 1; i < len(values); i++ {
+		sum := 0.0
+		for w := 1; w <= period; w++ {
+			sum += values[i-period+w] * float64(w)
+		}
+		out[i] = sum / weights
+	}
+	return out
+}
+
+// MACD is the moving average convergence divergence: the difference between the fast and slow EMA,
+// its EMA over signal values and the histogram of their difference.
+func MACD(values []float64, fast, slow, signal int) (macd, signalLine, histogram []float64) {
+	fastEMA := EMA(values, fast)
+	slowEMA := EMA(values, slow)
+
+	macd = make([]float64, len(values))
+	for i := range values {
+		macd[i] = fastEMA[i] - slowEMA[i]
+	}
+	signalLine = EMA(macd, signal)
+
+	histogram = make([]float64, len(values))
+	for i := range values {
+		histogram[i] = macd[i] - signalLine[i]
+	}
+	return macd, signalLine, histogram
+}
+
+// RSI is the relative strength index over period values, using Wilder smoothing. It ranges from 0 to 100,
+// a flat window without gains nor losses being neutral at 50.
+func RSI(values []float64, period int) []float64 {
+	gains, losses := nans(len(values)), nans(len(values))
+	for i := 1; i < len(values); i++ {
+		if !math.IsNaN(values[i-1]) && !math.IsNaN(values[i]) {
+			gains[i], losses[i] = change(values[i-1], values[i])
+		}
+	}
+
+	gain, loss := smooth(gains, period, wilder(period)), smooth(losses, period, wilder(period))
+	out := nans(len(values))
+	for i := range values {
+		if !math.IsNaN(gain[i]) {
+			out[i] = rsi(gain[i], loss[i])
+		}
+	}
+	return out
+}
+
+// Bollinger returns the Bollinger bands: the SMA over period values and the bands
+// k population standard deviations above and below it.
+func Bollinger(values []float64, period int, k float64) (middle, upper, lower []float64) {
+	middle = SMA(values, period)
+	upper = nans(len(values))
+	lower = nans(len(values))
+	for i, m := range middle {
+		if math.IsNaN(m) {
+			continue
+		}
+		variance := 0.0
+		for _, v := range values[i-period+1 : i+1] {
+			variance += (v - m) * (v - m)
+		}
+		deviation := math.Sqrt(variance / float64(period))
+		upper[i] = m + k*deviation
+		lower[i] = m - k*deviation
+	}
+	return middle, upper, lower
+}
+
+// ATR is the average true range over period values, using Wilder smoothing.
+func ATR(high, low, close []float64, period int) []float64 {
+	trueRange := make([]float64, len(close))
+	for i := range close {
+		trueRange[i] = high[i] - low[i]
+		if i > 0 && !math.IsNaN(close[i-1]) {
+			trueRange[i] = math.Max(trueRange[i], math.Max(math.Abs(high[i]-close[i-1]), math.Abs(low[i]-close[i-1])))
+		}
+	}
+
+	return smooth(trueRange, period, wilder(period))
+}
+
+// VWAP is the cumulative volume weighted average of the typical price (high+low+close)/3.
+// It is NaN until some volume is traded, the rows missing a price or the volume are left out.
+func VWAP(high, low, close, volume []float64) []float64 {
+	out := nans(len(close))
+	priceVolume, totalVolume := 0.0, 0.0
+	for i := range close {
+		if !math.IsNaN(high[i]) && !math.IsNaN(low[i]) && !math.IsNaN(close[i]) && !math.IsNaN(volume[i]) {
+			priceVolume += (high[i] + low[i] + close[i]) / 3 * volume[i]
+			totalVolume += volume[i]
+		}
+		if totalVolume > 0 {
+			out[i] = priceVolume / totalVolume
+		}
+	}
+	return out
+}
+
+// OBV is the on-balance volume: the running sum of the volume, added when the close rises
+// and subtracted when it falls. It starts at 0, a row missing its close or volume is NaN and is left out,
+// the next close is compared to the previous one.
+func OBV(close, volume []float64) []float64 {
+	out := make([]float64, len(close))
+	obv, prev := 0.0, math.NaN()
+	for i := range close {
+		if math.IsNaN(close[i]) || math.IsNaN(volume[i]) {
+			out[i] = math.NaN()
+			continue
+		}
+		switch {
+		case close[i] > prev:
+			obv += volume[i]
+		case close[i] < prev:
+			obv -= volume[i]
+		}
+		out[i] = obv
+		prev = close[i]
+	}
+	return out
+}
+
+// smooth applies the recursive smoothing next to values, seeded with the mean of the first period values.
+// After a NaN, which stays NaN, it is seeded again once period values follow it.
+func smooth(values []float64, period int, next func(prev, value float64) float64) []float64 {
+	out := nans(len(values))
+	if period <= 0 {
+		return out
+	}
+
+	valid := 0 // INFO: values since the last NaN
+	for i, v := range values {
+		if math.IsNaN(v) {
+			valid = 0
+			continue
+		}
+		valid++
+		switch {
+		case valid > period:
+			out[i] = next(out[i-1], v)
+		case valid == period:
+			out[i] = mean(values[i-period+1 : i+1])
+		}
+	}
+	return out
+}
+
+// wilder is the smoothing of Wilder, an EMA with factor 1/period.
+func wilder(period int) func(prev, value float64) float64 {
+	return func(prev, value float64) float64 {
+		return (prev*float64(period-1) + value) / float64(period)
+	}
+}
+
+// change splits the difference between two values into gain and loss, both positive.
+func change(prev, cur float64) (float64, float64) {
+	diff := cur - prev
+	if diff > 0 {
+		return diff, 0
+	}
+	return 0, -diff
+}
+
+func rsi(gain, loss float64) float64 {
+	if loss == 0 {
+		if gain == 0 {
+			// flat window, neither overbought nor oversold
+			return 50
+		}
+		return 100
+	}
+	return 100 - 100/(1+gain/loss)
+}
+
+func mean(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// firstValid returns the index of the first non NaN value, len(values) if there is none.
+func firstValid(values []float64) int {
+	for i, v := range values {
+		if !math.IsNaN(v) {
+			return i
+		}
+	}
+	return len(values)
+}
+
+func nans(n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = math.NaN()
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+package indicators
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/stretchr/testify/assert"
+)
+
+var nan = math.NaN()
+
+// assertSeries compares series where NaN values are expected to match NaN.
+func assertSeries(t *testing.T, expected, actual []float64) {
+	t.Helper()
+	assert.Len(t, actual, len(expected))
+	for i := range expected {
+		if math.IsNaN(expected[i]) {
+			assert.True(t, math.IsNaN(actual[i]), "index %d: expected NaN, got %v", i, actual[i])
+			continue
+		}
+		assert.InDelta(t, expected[i], actual[i], 1e-9, "index %d", i)
+	}
+}
+
+func TestSMA(t *testing.T) {
+	assertSeries(t, []float64{nan, nan, 2, 3, 4}, SMA([]float64{1, 2, 3, 4, 5}, 3))
+	assertSeries(t, []float64{nan, nan, 2.5, 3.5}, SMA([]float64{nan, 2, 3, 4}, 2))
+	assertSeries(t, []float64{nan, nan}, SMA([]float64{1, 2}, 3))
+	assertSeries(t, []float64{nan, nan}, SMA([]float64{1, 2}, 0))
+}
+
+func TestEMA(t *testing.T) {
+	assertSeries(t, []float64{nan, 3, 5, 7, 10 + 1.0/3}, EMA([]float64{2, 4, 6, 8, 12}, 2))
+}
+
+func TestWMA(t *testing.T) {
+	assertSeries(t, []float64{nan, nan, 14.0 / 6, 20.0 / 6}, WMA([]float64{1, 2, 3, 4}, 3))
+}
+
+func TestMACD(t *testing.T) {
+	macd, signal, histogram := MACD([]float64{1, 2, 3, 4, 5, 6}, 2, 3, 2)
+	assertSeries(t, []float64{nan, nan, 0.5, 0.5, 0.5, 0.5}, macd)
+	assertSeries(t, []float64{nan, nan, nan, 0.5, 0.5, 0.5}, signal)
+	assertSeries(t, []float64{nan, nan, nan, 0, 0, 0}, histogram)
+}
+
+func TestRSI(t *testing.T) {
+	assertSeries(t, []float64{nan, nan, 50, 75, 87.5}, RSI([]float64{1, 2, 1, 2, 3}, 2))
+	assertSeries(t, []float64{nan, nan, 100}, RSI([]float64{1, 2, 3}, 2))
+}
+
+func TestRSIFlat(t *testing.T) {
+	assertSeries(t, []float64{nan, nan, 50, 50, 100}, RSI([]float64{2, 2, 2, 2, 3}, 2))
+}
+
+func TestBollinger(t *testing.T) {
+	middle, upper, lower := Bollinger([]float64{1, 2, 3}, 3, 2)
+	deviation := math.Sqrt(2.0 / 3)
+	assertSeries(t, []float64{nan, nan, 2}, middle)
+	assertSeries(t, []float64{nan, nan, 2 + 2*deviation}, upper)
+	assertSeries(t, []float64{nan, nan, 2 - 2*deviation}, lower)
+}
+
+func TestATR(t *testing.T) {
+	atr := ATR([]float64{10, 11, 12}, []float64{8, 9, 9}, []float64{9, 10, 11}, 2)
+	assertSeries(t, []float64{nan, 2, 2.5}, atr)
+}
+
+func TestVWAP(t *testing.T) {
+	high, low, close := []float64{3, 6}, []float64{1, 2}, []float64{2, 4}
+	assertSeries(t, []float64{2, 3.5}, VWAP(high, low, close, []float64{1, 3}))
+	assertSeries(t, []float64{nan, 4}, VWAP(high, low, close, []float64{0, 2}))
+}
+
+func TestOBV(t *testing.T) {
+	assert.Equal(t, []float64{0, 3, 3, 1}, OBV([]float64{1, 2, 2, 1}, []float64{5, 3, 4, 2}))
+}
+
+func TestNaNInside(t *testing.T) {
+	// INFO a NaN only spoils the values computed from it, the smoothed indicators start over after it
+	assertSeries(t, []float64{nan, 1.5, nan, nan, 4.5, 5.5, 6.5}, SMA([]float64{1, 2, nan, 4, 5, 6, 7}, 2))
+	assertSeries(t, []float64{nan, 3, nan, nan, 3, 5}, EMA([]float64{2, 4, nan, 2, 4, 6}, 2))
+	assertSeries(t, []float64{nan, nan, nan, nan, nan, 100, 50}, RSI([]float64{1, 2, nan, 1, 2, 3, 2}, 2))
+
+	high := []float64{10, 11, nan, 12, 14, 14}
+	low := []float64{8, 9, nan, 10, 11, 12}
+	close := []float64{9, 10, nan, 11, 12, 13}
+	assertSeries(t, []float64{nan, 2, nan, nan, 2.5, 2.25}, ATR(high, low, close, 2))
+	assertSeries(t, []float64{0, 3, nan, 1, nan}, OBV([]float64{1, 2, nan, 1, 2}, []float64{5, 3, 4, 2, nan}))
+	assertSeries(t, []float64{2, 2, 3.5}, VWAP([]float64{3, nan, 6}, []float64{1, 2, 2}, []float64{2, 4, 4}, []float64{1, 5, 3}))
+}
+
+func TestFromTable(t *testing.T) {
+	table, err := csvview.Read(strings.NewReader("OPENED_AT,OPEN,HIGH,LOW,CLOSE,VOLUME\n2022-01-01T00:00:00Z,1,3,0.5,2,10\n"))
+	assert.NoError(t, err)
+
+	o, err := FromTable(table)
+	assert.NoError(t, err)
+	assert.Equal(t, &OHLCV{Open: []float64{1}, High: []float64{3}, Low: []float64{0.5}, Close: []float64{2}, Volume: []float64{10}}, o)
+
+	table, err = csvview.Read(strings.NewReader("OPEN,HIGH\n1,2\n"))
+	assert.NoError(t, err)
+	_, err = FromTable(table)
+	assert.ErrorIs(t, err, csvview.ErrUnknownColumn)
+}
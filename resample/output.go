@@ -0,0 +1,58 @@
+package resample
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/csvview"
+)
+
+// Values returns the candle in echarts order: [open, close, lowest, highest].
+// It can be used as value of the opts.KlineData of both go-echarts and its fork.
+func (c Candle) Values() [4]float64 {
+	return [4]float64{c.Open, c.Close, c.Low, c.High}
+}
+
+// KlineData returns the candles as kline series data.
+func KlineData(candles []Candle) []opts.KlineData {
+	data := make([]opts.KlineData, 0, len(candles))
+	for _, c := range candles {
+		data = append(data, opts.KlineData{Value: c.Values()})
+	}
+	return data
+}
+
+// Table returns the candles as a table with the columns of an ohlcv CSV:
+// CLOSED_AT, OPENED_AT, OPEN, HIGH, LOW, CLOSE, VOLUME, plus TRADES.
+func Table(candles []Candle) (*csvview.Table, error) {
+	closedAt := make([]string, 0, len(candles))
+	openedAt := make([]string, 0, len(candles))
+	open := make([]float64, 0, len(candles))
+	high := make([]float64, 0, len(candles))
+	low := make([]float64, 0, len(candles))
+	closePrices := make([]float64, 0, len(candles))
+	volume := make([]float64, 0, len(candles))
+	trades := make([]string, 0, len(candles))
+	for _, c := range candles {
+		closedAt = append(closedAt, c.ClosedAt.Format(time.RFC3339Nano))
+		openedAt = append(openedAt, c.OpenedAt.Format(time.RFC3339))
+		open = append(open, c.Open)
+		high = append(high, c.High)
+		low = append(low, c.Low)
+		closePrices = append(closePrices, c.Close)
+		volume = append(volume, c.Volume)
+		trades = append(trades, strconv.Itoa(c.Trades))
+	}
+
+	return csvview.NewTable(
+		csvview.NewColumn("CLOSED_AT", closedAt),
+		csvview.NewColumn("OPENED_AT", openedAt),
+		csvview.NewFloatColumn("OPEN", open),
+		csvview.NewFloatColumn("HIGH", high),
+		csvview.NewFloatColumn("LOW", low),
+		csvview.NewFloatColumn("CLOSE", closePrices),
+		csvview.NewFloatColumn("VOLUME", volume),
+		csvview.NewColumn("TRADES", trades),
+	)
+}
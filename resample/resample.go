@@ -0,0 +1,326 @@
+// Package resample aggregates trades into OHLCV candles of a fixed interval,
+// so a single trades dump can be charted as candles at any resolution.
+package resample
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bygui86/go-csv-view/csvview"
+)
+
+// Default columns of a trades CSV.
+const (
+	DefaultTimeColumn  = "TIMESTAMP"
+	DefaultPriceColumn = "PRICE"
+	DefaultSizeColumn  = "SIZE"
+	DefaultSideColumn  = "SIDE"
+)
+
+// Options configures a Resampler.
+type Options struct {
+	// Interval of the candles, see ParseInterval.
+	Interval time.Duration
+
+	// Columns of the trades, default to the Default*Column constants.
+	TimeColumn  string
+	PriceColumn string
+	SizeColumn  string
+	SideColumn  string
+
+	// BySide also computes candles for every value of the side column, like BID and ASK.
+	BySide bool
+
+	// FillGaps adds a flat candle with no volume for every interval without trades,
+	// so that candles of all the sides share the same X axis.
+	FillGaps bool
+}
+
+// Candle is the OHLCV summary of the trades of an interval.
+type Candle struct {
+	OpenedAt time.Time
+	ClosedAt time.Time
+	Open     float64
+	High     float64
+	Low      float64
+	Close    float64
+	Volume   float64
+	Trades   int
+
+	// openTime and closeTime are the timestamps of the trades giving Open and Close.
+	openTime  time.Time
+	closeTime time.Time
+}
+
+// Resampler accumulates trades into candles while they are read.
+type Resampler struct {
+	options Options
+	all     map[int64]*Candle
+	sides   map[string]map[int64]*Candle
+}
+
+// New creates a Resampler with the given options.
+func New(options Options) (*Resampler, error) {
+	if options.Interval <= 0 {
+		return nil, errors.New("resample interval must be positive")
+	}
+	if options.TimeColumn == "" {
+		options.TimeColumn = DefaultTimeColumn
+	}
+	if options.PriceColumn == "" {
+		options.PriceColumn = DefaultPriceColumn
+	}
+	if options.SizeColumn == "" {
+		options.SizeColumn = DefaultSizeColumn
+	}
+	if options.SideColumn == "" {
+		options.SideColumn = DefaultSideColumn
+	}
+	return &Resampler{
+		options: options,
+		all:     make(map[int64]*Candle),
+		sides:   make(map[string]map[int64]*Candle),
+	}, nil
+}
+
+// File streams the trades CSV file at the given path into candles, keeping only the rows matching the filters.
+func File(filePath string, options Options, filters ...csvview.Filter) (*Resampler, error) {
+	r, newErr := New(options)
+	if newErr != nil {
+		return nil, newErr
+	}
+	if eachErr := csvview.Each(filePath, r.Add, filters...); eachErr != nil {
+		return nil, eachErr
+	}
+	return r, nil
+}
+
+// Trades aggregates a trades table into candles.
+func Trades(table *csvview.Table, options Options) (*Resampler, error) {
+	r, newErr := New(options)
+	if newErr != nil {
+		return nil, newErr
+	}
+
+	times, err := table.Times(r.options.TimeColumn)
+	if err != nil {
+		return nil, err
+	}
+	prices, err := table.Floats(r.options.PriceColumn)
+	if err != nil {
+		return nil, err
+	}
+	sizes, err := table.Floats(r.options.SizeColumn)
+	if err != nil {
+		return nil, err
+	}
+	var sides []string
+	if r.options.BySide {
+		sides, err = table.Strings(r.options.SideColumn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range times {
+		side := ""
+		if sides != nil {
+			side = sides[i]
+		}
+		r.add(times[i], prices[i], sizes[i], side)
+	}
+	return r, nil
+}
+
+// Add accumulates a trade row, it can be used as callback of csvview.Each.
+// Rows without timestamp or price are skipped.
+func (r *Resampler) Add(row *csvview.Row) error {
+	t, err := row.Time(r.options.TimeColumn)
+	if err != nil {
+		return err
+	}
+	price, err := row.Float(r.options.PriceColumn)
+	if err != nil {
+		return err
+	}
+	size, err := row.Float(r.options.SizeColumn)
+	if err != nil {
+		return err
+	}
+	side := ""
+	if r.options.BySide {
+		side, err = row.String(r.options.SideColumn)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.add(t, price, size, side)
+	return nil
+}
+
+func (r *Resampler) add(t time.Time, price, size float64, side string) {
+	if t.IsZero() || math.IsNaN(price) {
+		return
+	}
+	if math.IsNaN(size) {
+		size = 0
+	}
+	t = t.UTC()
+
+	r.addTo(r.all, t, price, size)
+	if r.options.BySide {
+		candles, found := r.sides[side]
+		if !found {
+			candles = make(map[int64]*Candle)
+			r.sides[side] = candles
+		}
+		r.addTo(candles, t, price, size)
+	}
+}
+
+func (r *Resampler) addTo(candles map[int64]*Candle, t time.Time, price, size float64) {
+	openedAt := t.Truncate(r.options.Interval)
+	key := openedAt.UnixNano()
+
+	c, found := candles[key]
+	if !found {
+		candles[key] = &Candle{
+			OpenedAt:  openedAt,
+			ClosedAt:  openedAt.Add(r.options.Interval - time.Millisecond),
+			Open:      price,
+			High:      price,
+			Low:       price,
+			Close:     price,
+			Volume:    size,
+			Trades:    1,
+			openTime:  t,
+			closeTime: t,
+		}
+		return
+	}
+
+	// trades are not required to be sorted, open and close follow the timestamps,
+	// trades with the same timestamp keep the reading order
+	if t.Before(c.openTime) {
+		c.Open, c.openTime = price, t
+	}
+	if !t.Before(c.closeTime) {
+		c.Close, c.closeTime = price, t
+	}
+	if price > c.High {
+		c.High = price
+	}
+	if price < c.Low {
+		c.Low = price
+	}
+	c.Volume += size
+	c.Trades++
+}
+
+// Interval returns the interval of the candles.
+func (r *Resampler) Interval() time.Duration {
+	return r.options.Interval
+}
+
+// Candles returns the candles of all the trades, sorted by time.
+func (r *Resampler) Candles() []Candle {
+	return r.sorted(r.all, nil)
+}
+
+// Sides returns the sorted values of the side column, empty unless Options.BySide is set.
+func (r *Resampler) Sides() []string {
+	sides := make([]string, 0, len(r.sides))
+	for side := range r.sides {
+		sides = append(sides, side)
+	}
+	sort.Strings(sides)
+	return sides
+}
+
+// Side returns the candles of the trades of the given side, sorted by time.
+// With Options.FillGaps the candles are aligned to the ones returned by Candles,
+// intervals without trades of the side take the prices of all the trades and no volume.
+func (r *Resampler) Side(side string) []Candle {
+	candles, found := r.sides[side]
+	if !found {
+		return nil
+	}
+	return r.sorted(candles, r.all)
+}
+
+// sorted returns the candles sorted by time, filling the gaps if requested.
+// Gaps are filled from the reference candles when available, otherwise with the previous close.
+func (r *Resampler) sorted(candles map[int64]*Candle, reference map[int64]*Candle) []Candle {
+	if len(candles) == 0 {
+		return []Candle{}
+	}
+
+	keys := make([]int64, 0, len(candles))
+	for key := range candles {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	if !r.options.FillGaps {
+		sorted := make([]Candle, 0, len(keys))
+		for _, key := range keys {
+			sorted = append(sorted, *candles[key])
+		}
+		return sorted
+	}
+
+	first, last := keys[0], keys[len(keys)-1]
+	for key := range reference {
+		if key < first {
+			first = key
+		}
+		if key > last {
+			last = key
+		}
+	}
+
+	step := r.options.Interval.Nanoseconds()
+	sorted := make([]Candle, 0, (last-first)/step+1)
+	for key := first; key <= last; key += step {
+		if c, found := candles[key]; found {
+			sorted = append(sorted, *c)
+			continue
+		}
+
+		// the first key always has a candle or a reference candle, so a previous close exists
+		openedAt := time.Unix(0, key).UTC()
+		gap := Candle{OpenedAt: openedAt, ClosedAt: openedAt.Add(r.options.Interval - time.Millisecond)}
+		if ref, found := reference[key]; found {
+			gap.Open, gap.High, gap.Low, gap.Close = ref.Open, ref.High, ref.Low, ref.Close
+		} else {
+			prev := sorted[len(sorted)-1].Close
+			gap.Open, gap.High, gap.Low, gap.Close = prev, prev, prev, prev
+		}
+		sorted = append(sorted, gap)
+	}
+	return sorted
+}
+
+// ParseInterval parses an interval like 1s, 1m, 5m, 1h or 1d.
+// Besides the time.ParseDuration units, d is accepted for days.
+func ParseInterval(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil || days <= 0 {
+			return 0, fmt.Errorf("invalid interval %q", s)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid interval %q", s)
+	}
+	return d, nil
+}
@@ -0,0 +1,124 @@
+package resample
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/stretchr/testify/assert"
+)
+
+func minute(m int) time.Time {
+	return time.Date(2022, 1, 1, 0, m, 0, 0, time.UTC)
+}
+
+func TestFile(t *testing.T) {
+	r, err := File("testdata/trades.csv", Options{Interval: time.Minute})
+	assert.NoError(t, err)
+
+	candles := r.Candles()
+	assert.Len(t, candles, 3)
+	assert.Equal(t, Candle{
+		OpenedAt:  minute(0),
+		ClosedAt:  minute(1).Add(-time.Millisecond),
+		Open:      100,
+		High:      103,
+		Low:       98,
+		Close:     101,
+		Volume:    5,
+		Trades:    4,
+		openTime:  minute(0),
+		closeTime: minute(0).Add(20 * time.Second),
+	}, candles[0])
+	assert.Equal(t, minute(1), candles[1].OpenedAt)
+	assert.Equal(t, minute(3), candles[2].OpenedAt)
+	assert.Equal(t, [4]float64{99, 99, 99, 99}, candles[2].Values())
+}
+
+func TestTradesTableMatchesFile(t *testing.T) {
+	table, err := csvview.Load("testdata/trades.csv")
+	assert.NoError(t, err)
+
+	fromTable, err := Trades(table, Options{Interval: 5 * time.Minute, BySide: true})
+	assert.NoError(t, err)
+	fromFile, err := File("testdata/trades.csv", Options{Interval: 5 * time.Minute, BySide: true})
+	assert.NoError(t, err)
+
+	assert.Equal(t, fromFile.Candles(), fromTable.Candles())
+	assert.Equal(t, fromFile.Side("BID"), fromTable.Side("BID"))
+	assert.Len(t, fromTable.Candles(), 1)
+}
+
+func TestFillGapsBySide(t *testing.T) {
+	r, err := File("testdata/trades.csv", Options{Interval: time.Minute, BySide: true, FillGaps: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ASK", "BID"}, r.Sides())
+
+	all := r.Candles()
+	assert.Len(t, all, 4)
+	// minute 2 has no trades, it is flat at the previous close
+	assert.Equal(t, [4]float64{102, 102, 102, 102}, all[2].Values())
+	assert.Equal(t, 0.0, all[2].Volume)
+
+	ask := r.Side("ASK")
+	assert.Len(t, ask, 4)
+	assert.Equal(t, 4.5, ask[0].Volume)
+	// minute 1 has only a BID trade, ASK takes its prices with no volume
+	assert.Equal(t, all[1].Values(), ask[1].Values())
+	assert.Equal(t, 0.0, ask[1].Volume)
+
+	bid := r.Side("BID")
+	assert.Len(t, bid, 4)
+	assert.Equal(t, [4]float64{103, 103, 103, 103}, bid[0].Values())
+	assert.Equal(t, 3.0, bid[3].Volume)
+
+	assert.Nil(t, r.Side("MISSING"))
+}
+
+func TestTable(t *testing.T) {
+	r, err := File("testdata/trades.csv", Options{Interval: time.Minute})
+	assert.NoError(t, err)
+
+	table, err := Table(r.Candles())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CLOSED_AT", "OPENED_AT", "OPEN", "HIGH", "LOW", "CLOSE", "VOLUME", "TRADES"}, table.Names())
+
+	closedAt, err := table.Strings("CLOSED_AT")
+	assert.NoError(t, err)
+	assert.Equal(t, "2022-01-01T00:00:59.999Z", closedAt[0])
+	openedAt, err := table.Times("OPENED_AT")
+	assert.NoError(t, err)
+	assert.Equal(t, minute(1), openedAt[1])
+	trades, err := table.Ints("TRADES")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 1, 1}, trades)
+
+	data := KlineData(r.Candles())
+	assert.Equal(t, [4]float64{100, 101, 98, 103}, data[0].Value)
+}
+
+func TestNewInvalidInterval(t *testing.T) {
+	_, err := New(Options{})
+	assert.Error(t, err)
+}
+
+func TestParseInterval(t *testing.T) {
+	tests := map[string]time.Duration{
+		"1s": time.Second,
+		"1m": time.Minute,
+		"5m": 5 * time.Minute,
+		"1h": time.Hour,
+		"1d": 24 * time.Hour,
+		"7d": 7 * 24 * time.Hour,
+	}
+	for s, expected := range tests {
+		d, err := ParseInterval(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, d, s)
+	}
+
+	for _, s := range []string{"", "0s", "-1m", "xd", "0d", "1y"} {
+		_, err := ParseInterval(s)
+		assert.Error(t, err, s)
+	}
+}
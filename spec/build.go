@@ -0,0 +1,343 @@
+package spec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/components"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/snapshot"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/types"
+	"github.com/bygui86/go-csv-view/csvview"
+)
+
+// Image formats of RenderImage.
+const (
+	ImageSVG = "svg"
+	ImagePNG = "png"
+)
+
+const (
+	defaultDataZoomType = "inside"
+	defaultSelectedMode = "multiple"
+
+	// emptyValue is the echarts placeholder for a missing data point.
+	emptyValue = "-"
+)
+
+// rectChart is the part of the rectangular charts API used to compose a chart from a spec.
+type rectChart interface {
+	components.Charter
+	SetGlobalOptions(options ...charts.GlobalOpts) *charts.RectChart
+	ExtendYAxis(yAxis ...opts.YAxis)
+	Overlap(a ...charts.Overlaper)
+}
+
+// Render loads the CSV file of the spec and renders all its charts as a page.
+func (s *Spec) Render(w io.Writer) error {
+	table, loadErr := csvview.Load(s.path(s.CSV))
+	if loadErr != nil {
+		return loadErr
+	}
+
+	built, buildErr := s.Build(table)
+	if buildErr != nil {
+		return buildErr
+	}
+
+	page := components.NewPage()
+	if s.PageTitle != "" {
+		page.PageTitle = s.PageTitle
+	}
+	page.InlineAssets = s.Inline
+	page.AddCharts(built...)
+	return page.Render(w)
+}
+
+// RenderImage loads the CSV file of the spec and draws its chart as an SVG or PNG image, without a browser.
+// An image holds a single chart.
+func (s *Spec) RenderImage(w io.Writer, format string) error {
+	if format != ImageSVG && format != ImagePNG {
+		return fmt.Errorf("image format %q not supported", format)
+	}
+	if len(s.Charts) != 1 {
+		return fmt.Errorf("an image holds a single chart, the spec has %d", len(s.Charts))
+	}
+
+	table, loadErr := csvview.Load(s.path(s.CSV))
+	if loadErr != nil {
+		return loadErr
+	}
+
+	built, buildErr := s.Build(table)
+	if buildErr != nil {
+		return buildErr
+	}
+
+	if format == ImagePNG {
+		return snapshot.PNG(w, built[0])
+	}
+	return snapshot.SVG(w, built[0])
+}
+
+// RenderFile renders the page into the spec output file,
+// or draws the chart as an image when the file has the .svg or .png extension.
+// The output file is left untouched when rendering fails.
+func (s *Spec) RenderFile() error {
+	if s.Output == "" {
+		return errors.New("output file not set")
+	}
+
+	// the file is written once rendered, a failure keeps the previous file
+	var buf bytes.Buffer
+	var renderErr error
+	switch format := strings.ToLower(strings.TrimPrefix(filepath.Ext(s.Output), ".")); format {
+	case ImageSVG, ImagePNG:
+		renderErr = s.RenderImage(&buf, format)
+	default:
+		renderErr = s.Render(&buf)
+	}
+	if renderErr != nil {
+		return renderErr
+	}
+	return os.WriteFile(s.path(s.Output), buf.Bytes(), 0644)
+}
+
+// Build compiles the charts of the spec using the columns of the given table.
+func (s *Spec) Build(table *csvview.Table) ([]components.Charter, error) {
+	built := make([]components.Charter, 0, len(s.Charts))
+	for _, c := range s.Charts {
+		chart, err := c.Build(table)
+		if err != nil {
+			return nil, err
+		}
+		built = append(built, chart)
+	}
+	return built, nil
+}
+
+// Build compiles the chart using the columns of the given table.
+// The first series defines the chart type, the others are overlapped on it.
+func (c *Chart) Build(table *csvview.Table) (components.Charter, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	xAxe, err := table.Strings(c.X)
+	if err != nil {
+		return nil, err
+	}
+
+	base := newRectChart(c.Series[0].Type, xAxe)
+	base.SetGlobalOptions(c.globalOptions()...)
+	for _, axis := range c.yAxes()[1:] {
+		base.ExtendYAxis(axis)
+	}
+
+	for _, s := range c.Series {
+		series, seriesErr := s.build(table, xAxe)
+		if seriesErr != nil {
+			return nil, seriesErr
+		}
+		base.Overlap(series)
+	}
+	return base, nil
+}
+
+func newRectChart(seriesType string, xAxe []string) rectChart {
+	switch seriesType {
+	case SeriesKline:
+		return charts.NewKLine().SetXAxis(xAxe)
+	case SeriesBar:
+		return charts.NewBar().SetXAxis(xAxe)
+	case SeriesScatter:
+		return charts.NewScatter().SetXAxis(xAxe)
+	default:
+		return charts.NewLine().SetXAxis(xAxe)
+	}
+}
+
+func (c *Chart) globalOptions() []charts.GlobalOpts {
+	options := []charts.GlobalOpts{
+		charts.WithTitleOpts(opts.Title{
+			Title:    c.Title,
+			Subtitle: c.Subtitle,
+		}),
+		charts.WithTooltipOpts(opts.Tooltip{
+			Show:    true,
+			Trigger: "axis",
+			AxisPointer: &opts.AxisPointer{
+				Type: "cross",
+				Snap: true,
+			},
+		}),
+		charts.WithLegendOpts(c.legend()),
+		charts.WithYAxisOpts(c.yAxes()[0]),
+	}
+
+	if c.Theme != "" || c.Width != "" || c.Height != "" {
+		options = append(options, charts.WithInitializationOpts(opts.Initialization{
+			Theme:  c.Theme,
+			Width:  c.Width,
+			Height: c.Height,
+		}))
+	}
+
+	for _, dz := range c.DataZoom {
+		zoomType := dz.Type
+		if zoomType == "" {
+			zoomType = defaultDataZoomType
+		}
+		options = append(options, charts.WithDataZoomOpts(opts.DataZoom{
+			Type:       zoomType,
+			Start:      dz.Start,
+			End:        dz.End,
+			XAxisIndex: []int{0},
+		}))
+	}
+
+	if len(c.Colors) > 0 {
+		options = append(options, charts.WithColorsOpts(c.Colors))
+	}
+
+	return options
+}
+
+func (c *Chart) legend() opts.Legend {
+	legend := opts.Legend{Show: true, SelectedMode: defaultSelectedMode}
+	if c.Legend != nil {
+		legend.Show = !c.Legend.Hide
+		if c.Legend.SelectedMode != "" {
+			legend.SelectedMode = c.Legend.SelectedMode
+		}
+	}
+	return legend
+}
+
+func (c *Chart) yAxes() []opts.YAxis {
+	if len(c.YAxes) == 0 {
+		return []opts.YAxis{{Scale: true}}
+	}
+	yAxes := make([]opts.YAxis, 0, len(c.YAxes))
+	for _, axis := range c.YAxes {
+		yAxes = append(yAxes, opts.YAxis{
+			Name:  axis.Name,
+			Type:  "value",
+			Scale: axis.Scale,
+		})
+	}
+	return yAxes
+}
+
+func (s *Series) name() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	if s.OHLC != nil {
+		return "ohlc"
+	}
+	return s.Column
+}
+
+func (s *Series) build(table *csvview.Table, xAxe []string) (charts.Overlaper, error) {
+	if s.Type == SeriesKline {
+		return s.buildKline(table)
+	}
+
+	values, err := table.Floats(s.Column)
+	if err != nil {
+		return nil, err
+	}
+
+	switch s.Type {
+	case SeriesBar:
+		data := make([]opts.BarData, 0, len(values))
+		for _, v := range values {
+			data = append(data, opts.BarData{Value: value(v)})
+		}
+		bar := charts.NewBar()
+		bar.AddSeries(s.name(), data,
+			charts.WithBarChartOpts(opts.BarChart{Type: types.ChartBar, YAxisIndex: s.YAxisIndex}),
+			charts.WithItemStyleOpts(opts.ItemStyle{Color: s.Color}),
+		)
+		return bar, nil
+
+	case SeriesScatter:
+		data := make([]opts.ScatterData, 0, len(values))
+		for i, v := range values {
+			data = append(data, opts.ScatterData{Value: []interface{}{xAxe[i], value(v)}})
+		}
+		scatter := charts.NewScatter()
+		scatter.AddSeries(s.name(), data,
+			charts.WithScatterChartOpts(opts.ScatterChart{YAxisIndex: s.YAxisIndex}),
+			charts.WithItemStyleOpts(opts.ItemStyle{Color: s.Color}),
+		)
+		return scatter, nil
+
+	default:
+		data := make([]opts.LineData, 0, len(values))
+		for _, v := range values {
+			data = append(data, opts.LineData{Value: value(v)})
+		}
+		line := charts.NewLine()
+		line.AddSeries(s.name(), data,
+			charts.WithLineChartOpts(opts.LineChart{Smooth: s.Smooth, YAxisIndex: s.YAxisIndex}),
+			charts.WithLineStyleOpts(opts.LineStyle{Color: s.Color}),
+			charts.WithItemStyleOpts(opts.ItemStyle{Color: s.Color}),
+		)
+		return line, nil
+	}
+}
+
+func (s *Series) buildKline(table *csvview.Table) (charts.Overlaper, error) {
+	columns := make([][]float64, 0, 4)
+	// echarts order: [open, close, lowest, highest]
+	for _, name := range []string{s.OHLC.Open, s.OHLC.Close, s.OHLC.Low, s.OHLC.High} {
+		values, err := table.Floats(name)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, values)
+	}
+
+	data := make([]opts.KlineData, 0, table.Len())
+	for i := 0; i < table.Len(); i++ {
+		data = append(data, opts.KlineData{
+			Value: [4]interface{}{value(columns[0][i]), value(columns[1][i]), value(columns[2][i]), value(columns[3][i])},
+		})
+	}
+
+	kline := charts.NewKLine()
+	kline.AddSeries(s.name(), data,
+		withYAxisIndex(s.YAxisIndex),
+		charts.WithItemStyleOpts(opts.ItemStyle{
+			Color:        s.Color,
+			Color0:       s.DownColor,
+			BorderColor:  s.Color,
+			BorderColor0: s.DownColor,
+		}),
+	)
+	return kline, nil
+}
+
+// withYAxisIndex sets the Y axis of series which have no chart options to do it, like kline.
+func withYAxisIndex(index int) charts.SeriesOpts {
+	return func(s *charts.SingleSeries) {
+		s.YAxisIndex = index
+	}
+}
+
+// value converts a missing value to the echarts placeholder, NaN is not valid JSON.
+func value(v float64) interface{} {
+	if math.IsNaN(v) {
+		return emptyValue
+	}
+	return v
+}
@@ -0,0 +1,208 @@
+// Package spec describes charts of a CSV file in a YAML or JSON file, so they can be rendered to HTML
+// without writing Go code.
+package spec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Series types supported by a spec.
+const (
+	SeriesLine    = "line"
+	SeriesBar     = "bar"
+	SeriesKline   = "kline"
+	SeriesScatter = "scatter"
+)
+
+// Spec is the content of a chart spec file.
+type Spec struct {
+	// CSV file to load, relative paths are resolved against the spec file directory.
+	CSV string `json:"csv" yaml:"csv"`
+
+	// Output HTML file, or SVG or PNG image of the chart when it has the .svg or .png extension.
+	// Relative paths are resolved against the spec file directory.
+	Output string `json:"output" yaml:"output"`
+
+	// PageTitle is the HTML title of the page.
+	PageTitle string `json:"pageTitle,omitempty" yaml:"pageTitle,omitempty"`
+
+	// Inline embeds echarts and the themes in the page, which then works offline as a single file.
+	// The program rendering the spec must import the assets/inline package of the go-echarts fork.
+	Inline bool `json:"inline,omitempty" yaml:"inline,omitempty"`
+
+	// Charts rendered in the page, in order.
+	Charts []Chart `json:"charts" yaml:"charts"`
+
+	// dir is the directory of the spec file, used to resolve relative paths.
+	dir string
+}
+
+// Chart describes a single chart of the page.
+type Chart struct {
+	Title    string `json:"title,omitempty" yaml:"title,omitempty"`
+	Subtitle string `json:"subtitle,omitempty" yaml:"subtitle,omitempty"`
+
+	// Theme of the chart, see types.Theme* constants.
+	Theme  string `json:"theme,omitempty" yaml:"theme,omitempty"`
+	Width  string `json:"width,omitempty" yaml:"width,omitempty"`
+	Height string `json:"height,omitempty" yaml:"height,omitempty"`
+
+	// X is the column used as X axis.
+	X string `json:"x" yaml:"x"`
+
+	// YAxes are the Y axes referenced by the series through YAxisIndex.
+	// When empty a single default Y axis is used.
+	YAxes []Axis `json:"yAxes,omitempty" yaml:"yAxes,omitempty"`
+
+	Series []Series `json:"series" yaml:"series"`
+
+	// Colors is the palette used for series without an explicit color.
+	Colors []string `json:"colors,omitempty" yaml:"colors,omitempty"`
+
+	DataZoom []DataZoom `json:"dataZoom,omitempty" yaml:"dataZoom,omitempty"`
+	Legend   *Legend    `json:"legend,omitempty" yaml:"legend,omitempty"`
+}
+
+// Axis describes a Y axis.
+type Axis struct {
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Scale lets the axis not contain the zero position, useful for prices.
+	Scale bool `json:"scale,omitempty" yaml:"scale,omitempty"`
+}
+
+// Series describes a series of a chart.
+type Series struct {
+	// Name of the series, defaults to the column name.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Type of the series: line, bar, kline or scatter.
+	Type string `json:"type" yaml:"type"`
+
+	// Column holding the values of line, bar and scatter series.
+	Column string `json:"column,omitempty" yaml:"column,omitempty"`
+
+	// OHLC columns of kline series.
+	OHLC *OHLC `json:"ohlc,omitempty" yaml:"ohlc,omitempty"`
+
+	// YAxisIndex is the index in Chart.YAxes of the axis the series is drawn on.
+	YAxisIndex int `json:"yAxisIndex,omitempty" yaml:"yAxisIndex,omitempty"`
+
+	// Color of the series, for kline series it is the color of up candles.
+	Color string `json:"color,omitempty" yaml:"color,omitempty"`
+
+	// DownColor is the color of down candles of kline series.
+	DownColor string `json:"downColor,omitempty" yaml:"downColor,omitempty"`
+
+	// Smooth draws line series as smooth curves.
+	Smooth bool `json:"smooth,omitempty" yaml:"smooth,omitempty"`
+}
+
+// OHLC names the columns of a kline series.
+type OHLC struct {
+	Open  string `json:"open" yaml:"open"`
+	High  string `json:"high" yaml:"high"`
+	Low   string `json:"low" yaml:"low"`
+	Close string `json:"close" yaml:"close"`
+}
+
+// DataZoom describes a zoom component on the X axis.
+type DataZoom struct {
+	// Type of zoom: inside or slider.
+	Type  string  `json:"type,omitempty" yaml:"type,omitempty"`
+	Start float32 `json:"start,omitempty" yaml:"start,omitempty"`
+	End   float32 `json:"end,omitempty" yaml:"end,omitempty"`
+}
+
+// Legend describes the legend component.
+type Legend struct {
+	Hide         bool   `json:"hide,omitempty" yaml:"hide,omitempty"`
+	SelectedMode string `json:"selectedMode,omitempty" yaml:"selectedMode,omitempty"`
+}
+
+// Load reads a spec file, YAML unless the extension is .json.
+func Load(filePath string) (*Spec, error) {
+	content, readErr := os.ReadFile(filePath)
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	s := &Spec{}
+	var decodeErr error
+	if strings.EqualFold(filepath.Ext(filePath), ".json") {
+		decodeErr = json.Unmarshal(content, s)
+	} else {
+		decodeErr = yaml.Unmarshal(content, s)
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("spec %s decoding failed: %w", filePath, decodeErr)
+	}
+	s.dir = filepath.Dir(filePath)
+
+	if validErr := s.Validate(); validErr != nil {
+		return nil, fmt.Errorf("spec %s is not valid: %w", filePath, validErr)
+	}
+	return s, nil
+}
+
+// Validate checks that the spec is complete and consistent.
+func (s *Spec) Validate() error {
+	if s.CSV == "" {
+		return errors.New("csv file not set")
+	}
+	if len(s.Charts) == 0 {
+		return errors.New("no charts")
+	}
+	for i, c := range s.Charts {
+		if err := c.validate(); err != nil {
+			return fmt.Errorf("chart %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *Chart) validate() error {
+	if c.X == "" {
+		return errors.New("x column not set")
+	}
+	if len(c.Series) == 0 {
+		return errors.New("no series")
+	}
+	yAxes := len(c.YAxes)
+	if yAxes == 0 {
+		yAxes = 1
+	}
+	for i, s := range c.Series {
+		switch s.Type {
+		case SeriesLine, SeriesBar, SeriesScatter:
+			if s.Column == "" {
+				return fmt.Errorf("series %d: column not set", i)
+			}
+		case SeriesKline:
+			if s.OHLC == nil || s.OHLC.Open == "" || s.OHLC.High == "" || s.OHLC.Low == "" || s.OHLC.Close == "" {
+				return fmt.Errorf("series %d: ohlc columns not set", i)
+			}
+		default:
+			return fmt.Errorf("series %d: unknown type %q", i, s.Type)
+		}
+		if s.YAxisIndex < 0 || s.YAxisIndex >= yAxes {
+			return fmt.Errorf("series %d: y axis %d not defined", i, s.YAxisIndex)
+		}
+	}
+	return nil
+}
+
+// path resolves a path of the spec against the spec file directory.
+func (s *Spec) path(p string) string {
+	if p == "" || filepath.IsAbs(p) || s.dir == "" {
+		return p
+	}
+	return filepath.Join(s.dir, p)
+}
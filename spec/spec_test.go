@@ -0,0 +1,182 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/bygui86/go-csv-view/alaingilbert-go-echarts/assets/inline"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/charts"
+	"github.com/bygui86/go-csv-view/alaingilbert-go-echarts/opts"
+	"github.com/bygui86/go-csv-view/csvview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadYAML(t *testing.T) {
+	s, err := Load("testdata/ohlcv.yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, "ohlcv.csv", s.CSV)
+	assert.Equal(t, filepath.Join("testdata", "ohlcv.html"), s.path(s.Output))
+	assert.Len(t, s.Charts, 1)
+
+	c := s.Charts[0]
+	assert.Equal(t, "OPENED_AT", c.X)
+	assert.Len(t, c.YAxes, 2)
+	assert.Equal(t, SeriesKline, c.Series[0].Type)
+	assert.Equal(t, "CLOSE", c.Series[0].OHLC.Close)
+	assert.Equal(t, 1, c.Series[1].YAxisIndex)
+	assert.Equal(t, []DataZoom{{Type: "slider", Start: 50, End: 100}}, c.DataZoom)
+}
+
+func TestLoadJSON(t *testing.T) {
+	s, err := Load("testdata/close.json")
+	assert.NoError(t, err)
+	assert.Equal(t, "single", s.Charts[0].Legend.SelectedMode)
+	assert.True(t, s.Charts[0].Series[0].Smooth)
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() *Spec {
+		return &Spec{
+			CSV: "ohlcv.csv",
+			Charts: []Chart{{
+				X:      "OPENED_AT",
+				Series: []Series{{Type: SeriesLine, Column: "CLOSE"}},
+			}},
+		}
+	}
+	assert.NoError(t, valid().Validate())
+
+	tests := map[string]func(s *Spec){
+		"no csv":        func(s *Spec) { s.CSV = "" },
+		"no charts":     func(s *Spec) { s.Charts = nil },
+		"no x":          func(s *Spec) { s.Charts[0].X = "" },
+		"no series":     func(s *Spec) { s.Charts[0].Series = nil },
+		"unknown type":  func(s *Spec) { s.Charts[0].Series[0].Type = "pie" },
+		"no column":     func(s *Spec) { s.Charts[0].Series[0].Column = "" },
+		"no ohlc":       func(s *Spec) { s.Charts[0].Series[0].Type = SeriesKline },
+		"missing yAxis": func(s *Spec) { s.Charts[0].Series[0].YAxisIndex = 1 },
+	}
+	for name, breakSpec := range tests {
+		s := valid()
+		breakSpec(s)
+		assert.Error(t, s.Validate(), name)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	s, err := Load("testdata/ohlcv.yaml")
+	assert.NoError(t, err)
+	table, err := csvview.Load("testdata/ohlcv.csv")
+	assert.NoError(t, err)
+
+	built, err := s.Build(table)
+	assert.NoError(t, err)
+	assert.Len(t, built, 1)
+
+	kline, ok := built[0].(*charts.Kline)
+	assert.True(t, ok)
+	kline.Validate()
+
+	assert.Len(t, kline.MultiSeries, 2)
+	assert.Equal(t, "candlestick", kline.MultiSeries[0].Type)
+	assert.Equal(t, "bar", kline.MultiSeries[1].Type)
+	assert.Equal(t, 1, kline.MultiSeries[1].YAxisIndex)
+	assert.Len(t, kline.YAxisList, 2)
+	assert.Equal(t, "Volume", kline.YAxisList[1].Name)
+	assert.Len(t, kline.XAxisList[0].Data, 5)
+
+	_, err = json.Marshal(kline.JSON())
+	assert.NoError(t, err)
+}
+
+func TestBuildUnknownColumn(t *testing.T) {
+	s, err := Load("testdata/close.json")
+	assert.NoError(t, err)
+	s.Charts[0].Series[0].Column = "MISSING"
+
+	table, err := csvview.Load("testdata/ohlcv.csv")
+	assert.NoError(t, err)
+
+	_, err = s.Build(table)
+	assert.ErrorIs(t, err, csvview.ErrUnknownColumn)
+}
+
+func TestRender(t *testing.T) {
+	s, err := Load("testdata/close.json")
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	assert.NoError(t, s.Render(&buf))
+	assert.Contains(t, buf.String(), `"type":"line"`)
+	assert.Contains(t, buf.String(), `"type":"scatter"`)
+}
+
+func TestRenderReproducible(t *testing.T) {
+	opts.SetChartIDStrategy(opts.HashChartID)
+	defer opts.SetChartIDStrategy(nil)
+
+	s, err := Load("testdata/close.json")
+	assert.NoError(t, err)
+
+	var first, second bytes.Buffer
+	assert.NoError(t, s.Render(&first))
+	assert.NoError(t, s.Render(&second))
+	assert.Equal(t, first.String(), second.String())
+}
+
+func TestRenderInline(t *testing.T) {
+	s, err := Load("testdata/close.json")
+	assert.NoError(t, err)
+	s.Inline = true
+
+	var buf bytes.Buffer
+	assert.NoError(t, s.Render(&buf))
+	assert.NotContains(t, buf.String(), "https://go-echarts.github.io")
+	assert.Contains(t, buf.String(), `<script src="data:text/javascript;base64,`)
+}
+
+func TestRenderImage(t *testing.T) {
+	s, err := Load("testdata/close.json")
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	assert.NoError(t, s.RenderImage(&buf, ImageSVG))
+	assert.Contains(t, buf.String(), ">Close</text>")
+	assert.Contains(t, buf.String(), `stroke="orange"`)
+
+	buf.Reset()
+	assert.NoError(t, s.RenderImage(&buf, ImagePNG))
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("\x89PNG")))
+
+	assert.Error(t, s.RenderImage(&buf, "gif"))
+	s.Charts = append(s.Charts, s.Charts[0])
+	assert.Error(t, s.RenderImage(&buf, ImageSVG))
+
+	s, err = Load("testdata/close.json")
+	assert.NoError(t, err)
+	s.Output = filepath.Join(t.TempDir(), "close.png")
+	assert.NoError(t, s.RenderFile())
+}
+
+func TestRenderFileFailure(t *testing.T) {
+	s, err := Load("testdata/close.json")
+	assert.NoError(t, err)
+	s.Charts[0].X = "NOPE"
+
+	for _, output := range []string{"close.html", "close.svg"} {
+		s.Output = filepath.Join(t.TempDir(), output)
+		assert.ErrorIs(t, s.RenderFile(), csvview.ErrUnknownColumn, output)
+		_, statErr := os.Stat(s.Output)
+		assert.True(t, os.IsNotExist(statErr), output)
+	}
+
+	// a previous output is kept
+	assert.NoError(t, os.WriteFile(s.Output, []byte("previous"), 0644))
+	assert.Error(t, s.RenderFile())
+	content, err := os.ReadFile(s.Output)
+	assert.NoError(t, err)
+	assert.Equal(t, "previous", string(content))
+}